@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // AccountTtl Contains information about the period of inactivity after which the current user's account will automatically be deleted
@@ -28,6 +28,108 @@ func NewAccountTtl(days int32) *AccountTtl {
 	return &accountTtlTemp
 }
 
+// MarshalJSON marshals to json
+func (accountTtl *AccountTtl) MarshalJSON() ([]byte, error) {
+	return accountTtl.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (accountTtl *AccountTtl) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, accountTtl)
+}
+
+func (accountTtl *AccountTtl) appendJSON(b []byte) ([]byte, error) {
+	if accountTtl == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, accountTtl.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, accountTtl.tdCommon.Extra)
+	b = append(b, `,"days":`...)
+	b = appendJSONInt(b, int64(accountTtl.Days))
+	return append(b, '}'), nil
+}
+
+func (accountTtl *AccountTtl) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			accountTtl.tdCommon.Type, err = r.readString()
+		case "@extra":
+			accountTtl.tdCommon.Extra, err = r.readString()
+		case "days":
+			accountTtl.Days, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (accountTtl *AccountTtl) Clone() *AccountTtl {
+	if accountTtl == nil {
+		return nil
+	}
+	clone := *accountTtl
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (accountTtl *AccountTtl) Equal(other *AccountTtl) bool {
+	if accountTtl == nil || other == nil {
+		return accountTtl == other
+	}
+	if accountTtl.Days != other.Days {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (accountTtl *AccountTtl) Diff(other *AccountTtl) []Change {
+	return accountTtl.diff("", other, nil)
+}
+
+func (accountTtl *AccountTtl) diff(path string, other *AccountTtl, changes []Change) []Change {
+	if accountTtl == nil || other == nil {
+		if accountTtl != other {
+			changes = append(changes, Change{Path: path, Old: accountTtl, New: other})
+		}
+		return changes
+	}
+	if accountTtl.Days != other.Days {
+		changes = append(changes, Change{Path: fieldPath(path, "days"), Old: accountTtl.Days, New: other.Days})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (accountTtl *AccountTtl) String() string {
+	return objectString(accountTtl)
+}
+
+// LogValue logs the object as its summary
+func (accountTtl *AccountTtl) LogValue() slog.Value {
+	return slog.StringValue(objectString(accountTtl))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (accountTtl *AccountTtl) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, accountTtl)
+}
+
+func (accountTtl *AccountTtl) printText(p *printer) {
+	if accountTtl == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("AccountTtl")
+	p.int("days", int64(accountTtl.Days))
+	p.endObject(state)
+}
+
 // GetAccountTtl Returns the period of inactivity after which the account of the current user will automatically be deleted
 func (client *Client) GetAccountTtl() (*AccountTtl, error) {
 	result, err := client.SendAndCatch(UpdateData{
@@ -38,11 +140,11 @@ func (client *Client) GetAccountTtl() (*AccountTtl, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var accountTtl AccountTtl
-	err = json.Unmarshal(result.Raw, &accountTtl)
+	err = jsonUnmarshal(result.Raw, &accountTtl)
 	return &accountTtl, err
 }
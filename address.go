@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // Address Describes an address
 type Address struct {
 	tdCommon
@@ -37,3 +42,160 @@ func NewAddress(countryCode string, state string, city string, streetLine1 strin
 
 	return &addressTemp
 }
+
+// MarshalJSON marshals to json
+func (address *Address) MarshalJSON() ([]byte, error) {
+	return address.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (address *Address) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, address)
+}
+
+func (address *Address) appendJSON(b []byte) ([]byte, error) {
+	if address == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, address.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, address.tdCommon.Extra)
+	b = append(b, `,"country_code":`...)
+	b = appendJSONString(b, address.CountryCode)
+	b = append(b, `,"state":`...)
+	b = appendJSONString(b, address.State)
+	b = append(b, `,"city":`...)
+	b = appendJSONString(b, address.City)
+	b = append(b, `,"street_line1":`...)
+	b = appendJSONString(b, address.StreetLine1)
+	b = append(b, `,"street_line2":`...)
+	b = appendJSONString(b, address.StreetLine2)
+	b = append(b, `,"postal_code":`...)
+	b = appendJSONString(b, address.PostalCode)
+	return append(b, '}'), nil
+}
+
+func (address *Address) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			address.tdCommon.Type, err = r.readString()
+		case "@extra":
+			address.tdCommon.Extra, err = r.readString()
+		case "country_code":
+			address.CountryCode, err = r.readString()
+		case "state":
+			address.State, err = r.readString()
+		case "city":
+			address.City, err = r.readString()
+		case "street_line1":
+			address.StreetLine1, err = r.readString()
+		case "street_line2":
+			address.StreetLine2, err = r.readString()
+		case "postal_code":
+			address.PostalCode, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (address *Address) Clone() *Address {
+	if address == nil {
+		return nil
+	}
+	clone := *address
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (address *Address) Equal(other *Address) bool {
+	if address == nil || other == nil {
+		return address == other
+	}
+	if address.CountryCode != other.CountryCode {
+		return false
+	}
+	if address.State != other.State {
+		return false
+	}
+	if address.City != other.City {
+		return false
+	}
+	if address.StreetLine1 != other.StreetLine1 {
+		return false
+	}
+	if address.StreetLine2 != other.StreetLine2 {
+		return false
+	}
+	if address.PostalCode != other.PostalCode {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (address *Address) Diff(other *Address) []Change {
+	return address.diff("", other, nil)
+}
+
+func (address *Address) diff(path string, other *Address, changes []Change) []Change {
+	if address == nil || other == nil {
+		if address != other {
+			changes = append(changes, Change{Path: path, Old: address, New: other})
+		}
+		return changes
+	}
+	if address.CountryCode != other.CountryCode {
+		changes = append(changes, Change{Path: fieldPath(path, "country_code"), Old: address.CountryCode, New: other.CountryCode})
+	}
+	if address.State != other.State {
+		changes = append(changes, Change{Path: fieldPath(path, "state"), Old: address.State, New: other.State})
+	}
+	if address.City != other.City {
+		changes = append(changes, Change{Path: fieldPath(path, "city"), Old: address.City, New: other.City})
+	}
+	if address.StreetLine1 != other.StreetLine1 {
+		changes = append(changes, Change{Path: fieldPath(path, "street_line1"), Old: address.StreetLine1, New: other.StreetLine1})
+	}
+	if address.StreetLine2 != other.StreetLine2 {
+		changes = append(changes, Change{Path: fieldPath(path, "street_line2"), Old: address.StreetLine2, New: other.StreetLine2})
+	}
+	if address.PostalCode != other.PostalCode {
+		changes = append(changes, Change{Path: fieldPath(path, "postal_code"), Old: address.PostalCode, New: other.PostalCode})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (address *Address) String() string {
+	return objectString(address)
+}
+
+// LogValue logs the object as its summary
+func (address *Address) LogValue() slog.Value {
+	return slog.StringValue(objectString(address))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (address *Address) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, address)
+}
+
+func (address *Address) printText(p *printer) {
+	if address == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Address")
+	p.string("country_code", address.CountryCode)
+	p.string("state", address.State)
+	p.string("city", address.City)
+	p.string("street_line1", address.StreetLine1)
+	p.string("street_line2", address.StreetLine2)
+	p.string("postal_code", address.PostalCode)
+	p.endObject(state)
+}
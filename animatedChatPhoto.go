@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // AnimatedChatPhoto Animated variant of a chat photo in MPEG4 format
 type AnimatedChatPhoto struct {
 	tdCommon
@@ -28,3 +33,138 @@ func NewAnimatedChatPhoto(length int32, file *File, mainFrameTimestamp float64)
 
 	return &animatedChatPhotoTemp
 }
+
+// MarshalJSON marshals to json
+func (animatedChatPhoto *AnimatedChatPhoto) MarshalJSON() ([]byte, error) {
+	return animatedChatPhoto.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (animatedChatPhoto *AnimatedChatPhoto) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, animatedChatPhoto)
+}
+
+func (animatedChatPhoto *AnimatedChatPhoto) appendJSON(b []byte) ([]byte, error) {
+	if animatedChatPhoto == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, animatedChatPhoto.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, animatedChatPhoto.tdCommon.Extra)
+	b = append(b, `,"length":`...)
+	b = appendJSONInt(b, int64(animatedChatPhoto.Length))
+	b = append(b, `,"file":`...)
+	b, err = animatedChatPhoto.File.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"main_frame_timestamp":`...)
+	b, err = appendJSONFloat(b, animatedChatPhoto.MainFrameTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (animatedChatPhoto *AnimatedChatPhoto) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			animatedChatPhoto.tdCommon.Type, err = r.readString()
+		case "@extra":
+			animatedChatPhoto.tdCommon.Extra, err = r.readString()
+		case "length":
+			animatedChatPhoto.Length, err = r.readInt32()
+		case "file":
+			if r.readNull() {
+				animatedChatPhoto.File = nil
+			} else {
+				animatedChatPhoto.File = new(File)
+				err = animatedChatPhoto.File.decodeJSON(r)
+			}
+		case "main_frame_timestamp":
+			animatedChatPhoto.MainFrameTimestamp, err = r.readFloat64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (animatedChatPhoto *AnimatedChatPhoto) Clone() *AnimatedChatPhoto {
+	if animatedChatPhoto == nil {
+		return nil
+	}
+	clone := *animatedChatPhoto
+	clone.File = animatedChatPhoto.File.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (animatedChatPhoto *AnimatedChatPhoto) Equal(other *AnimatedChatPhoto) bool {
+	if animatedChatPhoto == nil || other == nil {
+		return animatedChatPhoto == other
+	}
+	if animatedChatPhoto.Length != other.Length {
+		return false
+	}
+	if !animatedChatPhoto.File.Equal(other.File) {
+		return false
+	}
+	if animatedChatPhoto.MainFrameTimestamp != other.MainFrameTimestamp {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (animatedChatPhoto *AnimatedChatPhoto) Diff(other *AnimatedChatPhoto) []Change {
+	return animatedChatPhoto.diff("", other, nil)
+}
+
+func (animatedChatPhoto *AnimatedChatPhoto) diff(path string, other *AnimatedChatPhoto, changes []Change) []Change {
+	if animatedChatPhoto == nil || other == nil {
+		if animatedChatPhoto != other {
+			changes = append(changes, Change{Path: path, Old: animatedChatPhoto, New: other})
+		}
+		return changes
+	}
+	if animatedChatPhoto.Length != other.Length {
+		changes = append(changes, Change{Path: fieldPath(path, "length"), Old: animatedChatPhoto.Length, New: other.Length})
+	}
+	changes = animatedChatPhoto.File.diff(fieldPath(path, "file"), other.File, changes)
+	if animatedChatPhoto.MainFrameTimestamp != other.MainFrameTimestamp {
+		changes = append(changes, Change{Path: fieldPath(path, "main_frame_timestamp"), Old: animatedChatPhoto.MainFrameTimestamp, New: other.MainFrameTimestamp})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (animatedChatPhoto *AnimatedChatPhoto) String() string {
+	return objectString(animatedChatPhoto)
+}
+
+// LogValue logs the object as its summary
+func (animatedChatPhoto *AnimatedChatPhoto) LogValue() slog.Value {
+	return slog.StringValue(objectString(animatedChatPhoto))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (animatedChatPhoto *AnimatedChatPhoto) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, animatedChatPhoto)
+}
+
+func (animatedChatPhoto *AnimatedChatPhoto) printText(p *printer) {
+	if animatedChatPhoto == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("AnimatedChatPhoto")
+	p.int("length", int64(animatedChatPhoto.Length))
+	p.object("file", animatedChatPhoto.File)
+	p.float("main_frame_timestamp", animatedChatPhoto.MainFrameTimestamp)
+	p.endObject(state)
+}
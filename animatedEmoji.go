@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // AnimatedEmoji Describes an animated representation of an emoji
@@ -34,6 +34,145 @@ func NewAnimatedEmoji(sticker *Sticker, fitzpatrickType int32, sound *File) *Ani
 	return &animatedEmojiTemp
 }
 
+// MarshalJSON marshals to json
+func (animatedEmoji *AnimatedEmoji) MarshalJSON() ([]byte, error) {
+	return animatedEmoji.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (animatedEmoji *AnimatedEmoji) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, animatedEmoji)
+}
+
+func (animatedEmoji *AnimatedEmoji) appendJSON(b []byte) ([]byte, error) {
+	if animatedEmoji == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, animatedEmoji.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, animatedEmoji.tdCommon.Extra)
+	b = append(b, `,"sticker":`...)
+	b, err = animatedEmoji.Sticker.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"fitzpatrick_type":`...)
+	b = appendJSONInt(b, int64(animatedEmoji.FitzpatrickType))
+	b = append(b, `,"sound":`...)
+	b, err = animatedEmoji.Sound.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (animatedEmoji *AnimatedEmoji) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			animatedEmoji.tdCommon.Type, err = r.readString()
+		case "@extra":
+			animatedEmoji.tdCommon.Extra, err = r.readString()
+		case "sticker":
+			if r.readNull() {
+				animatedEmoji.Sticker = nil
+			} else {
+				animatedEmoji.Sticker = new(Sticker)
+				err = animatedEmoji.Sticker.decodeJSON(r)
+			}
+		case "fitzpatrick_type":
+			animatedEmoji.FitzpatrickType, err = r.readInt32()
+		case "sound":
+			if r.readNull() {
+				animatedEmoji.Sound = nil
+			} else {
+				animatedEmoji.Sound = new(File)
+				err = animatedEmoji.Sound.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (animatedEmoji *AnimatedEmoji) Clone() *AnimatedEmoji {
+	if animatedEmoji == nil {
+		return nil
+	}
+	clone := *animatedEmoji
+	clone.Sticker = animatedEmoji.Sticker.Clone()
+	clone.Sound = animatedEmoji.Sound.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (animatedEmoji *AnimatedEmoji) Equal(other *AnimatedEmoji) bool {
+	if animatedEmoji == nil || other == nil {
+		return animatedEmoji == other
+	}
+	if !animatedEmoji.Sticker.Equal(other.Sticker) {
+		return false
+	}
+	if animatedEmoji.FitzpatrickType != other.FitzpatrickType {
+		return false
+	}
+	if !animatedEmoji.Sound.Equal(other.Sound) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (animatedEmoji *AnimatedEmoji) Diff(other *AnimatedEmoji) []Change {
+	return animatedEmoji.diff("", other, nil)
+}
+
+func (animatedEmoji *AnimatedEmoji) diff(path string, other *AnimatedEmoji, changes []Change) []Change {
+	if animatedEmoji == nil || other == nil {
+		if animatedEmoji != other {
+			changes = append(changes, Change{Path: path, Old: animatedEmoji, New: other})
+		}
+		return changes
+	}
+	changes = animatedEmoji.Sticker.diff(fieldPath(path, "sticker"), other.Sticker, changes)
+	if animatedEmoji.FitzpatrickType != other.FitzpatrickType {
+		changes = append(changes, Change{Path: fieldPath(path, "fitzpatrick_type"), Old: animatedEmoji.FitzpatrickType, New: other.FitzpatrickType})
+	}
+	changes = animatedEmoji.Sound.diff(fieldPath(path, "sound"), other.Sound, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (animatedEmoji *AnimatedEmoji) String() string {
+	return objectString(animatedEmoji)
+}
+
+// LogValue logs the object as its summary
+func (animatedEmoji *AnimatedEmoji) LogValue() slog.Value {
+	return slog.StringValue(objectString(animatedEmoji))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (animatedEmoji *AnimatedEmoji) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, animatedEmoji)
+}
+
+func (animatedEmoji *AnimatedEmoji) printText(p *printer) {
+	if animatedEmoji == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("AnimatedEmoji")
+	p.object("sticker", animatedEmoji.Sticker)
+	p.int("fitzpatrick_type", int64(animatedEmoji.FitzpatrickType))
+	p.object("sound", animatedEmoji.Sound)
+	p.endObject(state)
+}
+
 // GetAnimatedEmoji Returns an animated emoji corresponding to a given emoji. Returns a 404 error if the emoji has no animated emoji
 // @param emoji The emoji
 func (client *Client) GetAnimatedEmoji(emoji string) (*AnimatedEmoji, error) {
@@ -46,11 +185,11 @@ func (client *Client) GetAnimatedEmoji(emoji string) (*AnimatedEmoji, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var animatedEmoji AnimatedEmoji
-	err = json.Unmarshal(result.Raw, &animatedEmoji)
+	err = jsonUnmarshal(result.Raw, &animatedEmoji)
 	return &animatedEmoji, err
 }
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // Animation Describes an animation file. The animation must be encoded in GIF or MPEG4 format
 type Animation struct {
 	tdCommon
@@ -46,3 +51,215 @@ func NewAnimation(duration int32, width int32, height int32, fileName string, mi
 
 	return &animationTemp
 }
+
+// MarshalJSON marshals to json
+func (animation *Animation) MarshalJSON() ([]byte, error) {
+	return animation.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (animation *Animation) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, animation)
+}
+
+func (animation *Animation) appendJSON(b []byte) ([]byte, error) {
+	if animation == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, animation.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, animation.tdCommon.Extra)
+	b = append(b, `,"duration":`...)
+	b = appendJSONInt(b, int64(animation.Duration))
+	b = append(b, `,"width":`...)
+	b = appendJSONInt(b, int64(animation.Width))
+	b = append(b, `,"height":`...)
+	b = appendJSONInt(b, int64(animation.Height))
+	b = append(b, `,"file_name":`...)
+	b = appendJSONString(b, animation.FileName)
+	b = append(b, `,"mime_type":`...)
+	b = appendJSONString(b, animation.MimeType)
+	b = append(b, `,"has_stickers":`...)
+	b = appendJSONBool(b, animation.HasStickers)
+	b = append(b, `,"minithumbnail":`...)
+	b, err = animation.Minithumbnail.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"thumbnail":`...)
+	b, err = animation.Thumbnail.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"animation":`...)
+	b, err = animation.Animation.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (animation *Animation) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			animation.tdCommon.Type, err = r.readString()
+		case "@extra":
+			animation.tdCommon.Extra, err = r.readString()
+		case "duration":
+			animation.Duration, err = r.readInt32()
+		case "width":
+			animation.Width, err = r.readInt32()
+		case "height":
+			animation.Height, err = r.readInt32()
+		case "file_name":
+			animation.FileName, err = r.readString()
+		case "mime_type":
+			animation.MimeType, err = r.readString()
+		case "has_stickers":
+			animation.HasStickers, err = r.readBool()
+		case "minithumbnail":
+			if r.readNull() {
+				animation.Minithumbnail = nil
+			} else {
+				animation.Minithumbnail = new(Minithumbnail)
+				err = animation.Minithumbnail.decodeJSON(r)
+			}
+		case "thumbnail":
+			if r.readNull() {
+				animation.Thumbnail = nil
+			} else {
+				animation.Thumbnail = new(Thumbnail)
+				err = animation.Thumbnail.decodeJSON(r)
+			}
+		case "animation":
+			if r.readNull() {
+				animation.Animation = nil
+			} else {
+				animation.Animation = new(File)
+				err = animation.Animation.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (animation *Animation) Clone() *Animation {
+	if animation == nil {
+		return nil
+	}
+	clone := *animation
+	clone.Minithumbnail = animation.Minithumbnail.Clone()
+	clone.Thumbnail = animation.Thumbnail.Clone()
+	clone.Animation = animation.Animation.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (animation *Animation) Equal(other *Animation) bool {
+	if animation == nil || other == nil {
+		return animation == other
+	}
+	if animation.Duration != other.Duration {
+		return false
+	}
+	if animation.Width != other.Width {
+		return false
+	}
+	if animation.Height != other.Height {
+		return false
+	}
+	if animation.FileName != other.FileName {
+		return false
+	}
+	if animation.MimeType != other.MimeType {
+		return false
+	}
+	if animation.HasStickers != other.HasStickers {
+		return false
+	}
+	if !animation.Minithumbnail.Equal(other.Minithumbnail) {
+		return false
+	}
+	if !animation.Thumbnail.Equal(other.Thumbnail) {
+		return false
+	}
+	if !animation.Animation.Equal(other.Animation) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (animation *Animation) Diff(other *Animation) []Change {
+	return animation.diff("", other, nil)
+}
+
+func (animation *Animation) diff(path string, other *Animation, changes []Change) []Change {
+	if animation == nil || other == nil {
+		if animation != other {
+			changes = append(changes, Change{Path: path, Old: animation, New: other})
+		}
+		return changes
+	}
+	if animation.Duration != other.Duration {
+		changes = append(changes, Change{Path: fieldPath(path, "duration"), Old: animation.Duration, New: other.Duration})
+	}
+	if animation.Width != other.Width {
+		changes = append(changes, Change{Path: fieldPath(path, "width"), Old: animation.Width, New: other.Width})
+	}
+	if animation.Height != other.Height {
+		changes = append(changes, Change{Path: fieldPath(path, "height"), Old: animation.Height, New: other.Height})
+	}
+	if animation.FileName != other.FileName {
+		changes = append(changes, Change{Path: fieldPath(path, "file_name"), Old: animation.FileName, New: other.FileName})
+	}
+	if animation.MimeType != other.MimeType {
+		changes = append(changes, Change{Path: fieldPath(path, "mime_type"), Old: animation.MimeType, New: other.MimeType})
+	}
+	if animation.HasStickers != other.HasStickers {
+		changes = append(changes, Change{Path: fieldPath(path, "has_stickers"), Old: animation.HasStickers, New: other.HasStickers})
+	}
+	changes = animation.Minithumbnail.diff(fieldPath(path, "minithumbnail"), other.Minithumbnail, changes)
+	changes = animation.Thumbnail.diff(fieldPath(path, "thumbnail"), other.Thumbnail, changes)
+	changes = animation.Animation.diff(fieldPath(path, "animation"), other.Animation, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (animation *Animation) String() string {
+	return objectString(animation)
+}
+
+// LogValue logs the object as its summary
+func (animation *Animation) LogValue() slog.Value {
+	return slog.StringValue(objectString(animation))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (animation *Animation) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, animation)
+}
+
+func (animation *Animation) printText(p *printer) {
+	if animation == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Animation")
+	p.int("duration", int64(animation.Duration))
+	p.int("width", int64(animation.Width))
+	p.int("height", int64(animation.Height))
+	p.string("file_name", animation.FileName)
+	p.string("mime_type", animation.MimeType)
+	p.bool("has_stickers", animation.HasStickers)
+	p.object("minithumbnail", animation.Minithumbnail)
+	p.object("thumbnail", animation.Thumbnail)
+	p.object("animation", animation.Animation)
+	p.endObject(state)
+}
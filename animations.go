@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // Animations Represents a list of animations
@@ -28,6 +28,150 @@ func NewAnimations(animations []Animation) *Animations {
 	return &animationsTemp
 }
 
+// MarshalJSON marshals to json
+func (animations *Animations) MarshalJSON() ([]byte, error) {
+	return animations.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (animations *Animations) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, animations)
+}
+
+func (animations *Animations) appendJSON(b []byte) ([]byte, error) {
+	if animations == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, animations.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, animations.tdCommon.Extra)
+	b = append(b, `,"animations":`...)
+	if animations.Animations == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range animations.Animations {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = animations.Animations[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (animations *Animations) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			animations.tdCommon.Type, err = r.readString()
+		case "@extra":
+			animations.tdCommon.Extra, err = r.readString()
+		case "animations":
+			if r.readNull() {
+				animations.Animations = nil
+			} else {
+				animations.Animations = make([]Animation, 0)
+				err = r.readArray(func() (err error) {
+					var item0 Animation
+					err = item0.decodeJSON(r)
+					animations.Animations = append(animations.Animations, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (animations *Animations) Clone() *Animations {
+	if animations == nil {
+		return nil
+	}
+	clone := *animations
+	if animations.Animations != nil {
+		clone.Animations = make([]Animation, len(animations.Animations))
+		for i0 := range animations.Animations {
+			clone.Animations[i0] = *animations.Animations[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (animations *Animations) Equal(other *Animations) bool {
+	if animations == nil || other == nil {
+		return animations == other
+	}
+	if len(animations.Animations) != len(other.Animations) {
+		return false
+	}
+	for i0 := range animations.Animations {
+		if !animations.Animations[i0].Equal(&other.Animations[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (animations *Animations) Diff(other *Animations) []Change {
+	return animations.diff("", other, nil)
+}
+
+func (animations *Animations) diff(path string, other *Animations, changes []Change) []Change {
+	if animations == nil || other == nil {
+		if animations != other {
+			changes = append(changes, Change{Path: path, Old: animations, New: other})
+		}
+		return changes
+	}
+	if len(animations.Animations) != len(other.Animations) {
+		changes = append(changes, Change{Path: fieldPath(path, "animations"), Old: animations.Animations, New: other.Animations})
+	} else {
+		for i0 := range animations.Animations {
+			changes = animations.Animations[i0].diff(indexPath(fieldPath(path, "animations"), i0), &other.Animations[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (animations *Animations) String() string {
+	return objectString(animations)
+}
+
+// LogValue logs the object as its summary
+func (animations *Animations) LogValue() slog.Value {
+	return slog.StringValue(objectString(animations))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (animations *Animations) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, animations)
+}
+
+func (animations *Animations) printText(p *printer) {
+	if animations == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Animations")
+	p.vector("animations", len(animations.Animations), func(i0 int) {
+		animations.Animations[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetSavedAnimations Returns saved animations
 func (client *Client) GetSavedAnimations() (*Animations, error) {
 	result, err := client.SendAndCatch(UpdateData{
@@ -38,11 +182,11 @@ func (client *Client) GetSavedAnimations() (*Animations, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var animations Animations
-	err = json.Unmarshal(result.Raw, &animations)
+	err = jsonUnmarshal(result.Raw, &animations)
 	return &animations, err
 }
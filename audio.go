@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // Audio Describes an audio file. Audio is usually in MP3 or M4A format
 type Audio struct {
 	tdCommon
@@ -43,3 +48,204 @@ func NewAudio(duration int32, title string, performer string, fileName string, m
 
 	return &audioTemp
 }
+
+// MarshalJSON marshals to json
+func (audio *Audio) MarshalJSON() ([]byte, error) {
+	return audio.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (audio *Audio) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, audio)
+}
+
+func (audio *Audio) appendJSON(b []byte) ([]byte, error) {
+	if audio == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, audio.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, audio.tdCommon.Extra)
+	b = append(b, `,"duration":`...)
+	b = appendJSONInt(b, int64(audio.Duration))
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, audio.Title)
+	b = append(b, `,"performer":`...)
+	b = appendJSONString(b, audio.Performer)
+	b = append(b, `,"file_name":`...)
+	b = appendJSONString(b, audio.FileName)
+	b = append(b, `,"mime_type":`...)
+	b = appendJSONString(b, audio.MimeType)
+	b = append(b, `,"album_cover_minithumbnail":`...)
+	b, err = audio.AlbumCoverMinithumbnail.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"album_cover_thumbnail":`...)
+	b, err = audio.AlbumCoverThumbnail.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"audio":`...)
+	b, err = audio.Audio.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (audio *Audio) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			audio.tdCommon.Type, err = r.readString()
+		case "@extra":
+			audio.tdCommon.Extra, err = r.readString()
+		case "duration":
+			audio.Duration, err = r.readInt32()
+		case "title":
+			audio.Title, err = r.readString()
+		case "performer":
+			audio.Performer, err = r.readString()
+		case "file_name":
+			audio.FileName, err = r.readString()
+		case "mime_type":
+			audio.MimeType, err = r.readString()
+		case "album_cover_minithumbnail":
+			if r.readNull() {
+				audio.AlbumCoverMinithumbnail = nil
+			} else {
+				audio.AlbumCoverMinithumbnail = new(Minithumbnail)
+				err = audio.AlbumCoverMinithumbnail.decodeJSON(r)
+			}
+		case "album_cover_thumbnail":
+			if r.readNull() {
+				audio.AlbumCoverThumbnail = nil
+			} else {
+				audio.AlbumCoverThumbnail = new(Thumbnail)
+				err = audio.AlbumCoverThumbnail.decodeJSON(r)
+			}
+		case "audio":
+			if r.readNull() {
+				audio.Audio = nil
+			} else {
+				audio.Audio = new(File)
+				err = audio.Audio.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (audio *Audio) Clone() *Audio {
+	if audio == nil {
+		return nil
+	}
+	clone := *audio
+	clone.AlbumCoverMinithumbnail = audio.AlbumCoverMinithumbnail.Clone()
+	clone.AlbumCoverThumbnail = audio.AlbumCoverThumbnail.Clone()
+	clone.Audio = audio.Audio.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (audio *Audio) Equal(other *Audio) bool {
+	if audio == nil || other == nil {
+		return audio == other
+	}
+	if audio.Duration != other.Duration {
+		return false
+	}
+	if audio.Title != other.Title {
+		return false
+	}
+	if audio.Performer != other.Performer {
+		return false
+	}
+	if audio.FileName != other.FileName {
+		return false
+	}
+	if audio.MimeType != other.MimeType {
+		return false
+	}
+	if !audio.AlbumCoverMinithumbnail.Equal(other.AlbumCoverMinithumbnail) {
+		return false
+	}
+	if !audio.AlbumCoverThumbnail.Equal(other.AlbumCoverThumbnail) {
+		return false
+	}
+	if !audio.Audio.Equal(other.Audio) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (audio *Audio) Diff(other *Audio) []Change {
+	return audio.diff("", other, nil)
+}
+
+func (audio *Audio) diff(path string, other *Audio, changes []Change) []Change {
+	if audio == nil || other == nil {
+		if audio != other {
+			changes = append(changes, Change{Path: path, Old: audio, New: other})
+		}
+		return changes
+	}
+	if audio.Duration != other.Duration {
+		changes = append(changes, Change{Path: fieldPath(path, "duration"), Old: audio.Duration, New: other.Duration})
+	}
+	if audio.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: audio.Title, New: other.Title})
+	}
+	if audio.Performer != other.Performer {
+		changes = append(changes, Change{Path: fieldPath(path, "performer"), Old: audio.Performer, New: other.Performer})
+	}
+	if audio.FileName != other.FileName {
+		changes = append(changes, Change{Path: fieldPath(path, "file_name"), Old: audio.FileName, New: other.FileName})
+	}
+	if audio.MimeType != other.MimeType {
+		changes = append(changes, Change{Path: fieldPath(path, "mime_type"), Old: audio.MimeType, New: other.MimeType})
+	}
+	changes = audio.AlbumCoverMinithumbnail.diff(fieldPath(path, "album_cover_minithumbnail"), other.AlbumCoverMinithumbnail, changes)
+	changes = audio.AlbumCoverThumbnail.diff(fieldPath(path, "album_cover_thumbnail"), other.AlbumCoverThumbnail, changes)
+	changes = audio.Audio.diff(fieldPath(path, "audio"), other.Audio, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (audio *Audio) String() string {
+	return objectString(audio)
+}
+
+// LogValue logs the object as its summary
+func (audio *Audio) LogValue() slog.Value {
+	return slog.StringValue(objectString(audio))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (audio *Audio) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, audio)
+}
+
+func (audio *Audio) printText(p *printer) {
+	if audio == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Audio")
+	p.int("duration", int64(audio.Duration))
+	p.string("title", audio.Title)
+	p.string("performer", audio.Performer)
+	p.string("file_name", audio.FileName)
+	p.string("mime_type", audio.MimeType)
+	p.object("album_cover_minithumbnail", audio.AlbumCoverMinithumbnail)
+	p.object("album_cover_thumbnail", audio.AlbumCoverThumbnail)
+	p.object("audio", audio.Audio)
+	p.endObject(state)
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // AuthenticationCodeInfo Information about the authentication code that was sent
@@ -37,34 +37,144 @@ func NewAuthenticationCodeInfo(phoneNumber string, typeParam AuthenticationCodeT
 	return &authenticationCodeInfoTemp
 }
 
+// MarshalJSON marshals to json
+func (authenticationCodeInfo *AuthenticationCodeInfo) MarshalJSON() ([]byte, error) {
+	return authenticationCodeInfo.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (authenticationCodeInfo *AuthenticationCodeInfo) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, authenticationCodeInfo)
+}
+
+func (authenticationCodeInfo *AuthenticationCodeInfo) appendJSON(b []byte) ([]byte, error) {
+	if authenticationCodeInfo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, authenticationCodeInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, authenticationCodeInfo.tdCommon.Extra)
+	b = append(b, `,"phone_number":`...)
+	b = appendJSONString(b, authenticationCodeInfo.PhoneNumber)
+	b = append(b, `,"type":`...)
+	b, err = appendJSONValue(b, authenticationCodeInfo.Type)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		PhoneNumber string `json:"phone_number"` // A phone number that is being authenticated
-		Timeout     int32  `json:"timeout"`      // Timeout before the code can be re-sent, in seconds
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"next_type":`...)
+	b, err = appendJSONValue(b, authenticationCodeInfo.NextType)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	b = append(b, `,"timeout":`...)
+	b = appendJSONInt(b, int64(authenticationCodeInfo.Timeout))
+	return append(b, '}'), nil
+}
 
-	authenticationCodeInfo.tdCommon = tempObj.tdCommon
-	authenticationCodeInfo.PhoneNumber = tempObj.PhoneNumber
-	authenticationCodeInfo.Timeout = tempObj.Timeout
+func (authenticationCodeInfo *AuthenticationCodeInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			authenticationCodeInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			authenticationCodeInfo.tdCommon.Extra, err = r.readString()
+		case "phone_number":
+			authenticationCodeInfo.PhoneNumber, err = r.readString()
+		case "type":
+			authenticationCodeInfo.Type, err = decodeAuthenticationCodeType(r)
+		case "next_type":
+			authenticationCodeInfo.NextType, err = decodeAuthenticationCodeType(r)
+		case "timeout":
+			authenticationCodeInfo.Timeout, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	fieldType, _ := unmarshalAuthenticationCodeType(objMap["type"])
-	authenticationCodeInfo.Type = fieldType
+// Clone returns a deep copy of the object
+func (authenticationCodeInfo *AuthenticationCodeInfo) Clone() *AuthenticationCodeInfo {
+	if authenticationCodeInfo == nil {
+		return nil
+	}
+	clone := *authenticationCodeInfo
+	clone.Type = cloneAuthenticationCodeType(authenticationCodeInfo.Type)
+	clone.NextType = cloneAuthenticationCodeType(authenticationCodeInfo.NextType)
+	return &clone
+}
 
-	fieldNextType, _ := unmarshalAuthenticationCodeType(objMap["next_type"])
-	authenticationCodeInfo.NextType = fieldNextType
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (authenticationCodeInfo *AuthenticationCodeInfo) Equal(other *AuthenticationCodeInfo) bool {
+	if authenticationCodeInfo == nil || other == nil {
+		return authenticationCodeInfo == other
+	}
+	if authenticationCodeInfo.PhoneNumber != other.PhoneNumber {
+		return false
+	}
+	if !equalAuthenticationCodeType(authenticationCodeInfo.Type, other.Type) {
+		return false
+	}
+	if !equalAuthenticationCodeType(authenticationCodeInfo.NextType, other.NextType) {
+		return false
+	}
+	if authenticationCodeInfo.Timeout != other.Timeout {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (authenticationCodeInfo *AuthenticationCodeInfo) Diff(other *AuthenticationCodeInfo) []Change {
+	return authenticationCodeInfo.diff("", other, nil)
+}
+
+func (authenticationCodeInfo *AuthenticationCodeInfo) diff(path string, other *AuthenticationCodeInfo, changes []Change) []Change {
+	if authenticationCodeInfo == nil || other == nil {
+		if authenticationCodeInfo != other {
+			changes = append(changes, Change{Path: path, Old: authenticationCodeInfo, New: other})
+		}
+		return changes
+	}
+	if authenticationCodeInfo.PhoneNumber != other.PhoneNumber {
+		changes = append(changes, Change{Path: fieldPath(path, "phone_number"), Old: authenticationCodeInfo.PhoneNumber, New: other.PhoneNumber})
+	}
+	changes = diffAuthenticationCodeType(fieldPath(path, "type"), authenticationCodeInfo.Type, other.Type, changes)
+	changes = diffAuthenticationCodeType(fieldPath(path, "next_type"), authenticationCodeInfo.NextType, other.NextType, changes)
+	if authenticationCodeInfo.Timeout != other.Timeout {
+		changes = append(changes, Change{Path: fieldPath(path, "timeout"), Old: authenticationCodeInfo.Timeout, New: other.Timeout})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (authenticationCodeInfo *AuthenticationCodeInfo) String() string {
+	return objectString(authenticationCodeInfo)
+}
+
+// LogValue logs the object as its summary
+func (authenticationCodeInfo *AuthenticationCodeInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(authenticationCodeInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (authenticationCodeInfo *AuthenticationCodeInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, authenticationCodeInfo)
+}
 
-	return nil
+func (authenticationCodeInfo *AuthenticationCodeInfo) printText(p *printer) {
+	if authenticationCodeInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("AuthenticationCodeInfo")
+	p.string("phone_number", authenticationCodeInfo.PhoneNumber)
+	p.object("type", authenticationCodeInfo.Type)
+	p.object("next_type", authenticationCodeInfo.NextType)
+	p.int("timeout", int64(authenticationCodeInfo.Timeout))
+	p.endObject(state)
 }
 
 // ChangePhoneNumber Changes the phone number of the user and sends an authentication code to the user's new phone number. On success, returns information about the sent code
@@ -81,12 +191,12 @@ func (client *Client) ChangePhoneNumber(phoneNumber string, settings *PhoneNumbe
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var authenticationCodeInfo AuthenticationCodeInfo
-	err = json.Unmarshal(result.Raw, &authenticationCodeInfo)
+	err = jsonUnmarshal(result.Raw, &authenticationCodeInfo)
 	return &authenticationCodeInfo, err
 }
 
@@ -100,12 +210,12 @@ func (client *Client) ResendChangePhoneNumberCode() (*AuthenticationCodeInfo, er
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var authenticationCodeInfo AuthenticationCodeInfo
-	err = json.Unmarshal(result.Raw, &authenticationCodeInfo)
+	err = jsonUnmarshal(result.Raw, &authenticationCodeInfo)
 	return &authenticationCodeInfo, err
 }
 
@@ -123,12 +233,12 @@ func (client *Client) SendPhoneNumberVerificationCode(phoneNumber string, settin
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var authenticationCodeInfo AuthenticationCodeInfo
-	err = json.Unmarshal(result.Raw, &authenticationCodeInfo)
+	err = jsonUnmarshal(result.Raw, &authenticationCodeInfo)
 	return &authenticationCodeInfo, err
 }
 
@@ -142,12 +252,12 @@ func (client *Client) ResendPhoneNumberVerificationCode() (*AuthenticationCodeIn
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var authenticationCodeInfo AuthenticationCodeInfo
-	err = json.Unmarshal(result.Raw, &authenticationCodeInfo)
+	err = jsonUnmarshal(result.Raw, &authenticationCodeInfo)
 	return &authenticationCodeInfo, err
 }
 
@@ -167,12 +277,12 @@ func (client *Client) SendPhoneNumberConfirmationCode(hash string, phoneNumber s
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var authenticationCodeInfo AuthenticationCodeInfo
-	err = json.Unmarshal(result.Raw, &authenticationCodeInfo)
+	err = jsonUnmarshal(result.Raw, &authenticationCodeInfo)
 	return &authenticationCodeInfo, err
 }
 
@@ -186,11 +296,11 @@ func (client *Client) ResendPhoneNumberConfirmationCode() (*AuthenticationCodeIn
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var authenticationCodeInfo AuthenticationCodeInfo
-	err = json.Unmarshal(result.Raw, &authenticationCodeInfo)
+	err = jsonUnmarshal(result.Raw, &authenticationCodeInfo)
 	return &authenticationCodeInfo, err
 }
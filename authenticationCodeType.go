@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // AuthenticationCodeType Provides information about the method by which an authentication code is delivered to the user
 type AuthenticationCodeType interface {
 	GetAuthenticationCodeTypeEnum() AuthenticationCodeTypeEnum
+	Accept(visitor AuthenticationCodeTypeVisitor)
 }
 
 // AuthenticationCodeTypeEnum Alias for abstract AuthenticationCodeType 'Sub-Classes', used as constant-enum here
@@ -22,46 +24,97 @@ const (
 	AuthenticationCodeTypeMissedCallType      AuthenticationCodeTypeEnum = "authenticationCodeTypeMissedCall"
 )
 
+// UnknownAuthenticationCodeType is an AuthenticationCodeType of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownAuthenticationCodeType struct {
+	Unknown
+}
+
+// GetAuthenticationCodeTypeEnum return the enum type of this object
+func (unknownAuthenticationCodeType *UnknownAuthenticationCodeType) GetAuthenticationCodeTypeEnum() AuthenticationCodeTypeEnum {
+	return AuthenticationCodeTypeEnum(unknownAuthenticationCodeType.Type)
+}
+
+// Accept calls the method of the visitor for UnknownAuthenticationCodeType
+func (unknownAuthenticationCodeType *UnknownAuthenticationCodeType) Accept(visitor AuthenticationCodeTypeVisitor) {
+	visitor.VisitUnknownAuthenticationCodeType(unknownAuthenticationCodeType)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownAuthenticationCodeType *UnknownAuthenticationCodeType) String() string {
+	return objectString(unknownAuthenticationCodeType)
+}
+
+// LogValue logs the object as its summary
+func (unknownAuthenticationCodeType *UnknownAuthenticationCodeType) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownAuthenticationCodeType))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownAuthenticationCodeType *UnknownAuthenticationCodeType) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownAuthenticationCodeType)
+}
+
+func (unknownAuthenticationCodeType *UnknownAuthenticationCodeType) printText(p *printer) {
+	if unknownAuthenticationCodeType == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownAuthenticationCodeType")
+	p.string("@type", unknownAuthenticationCodeType.Type)
+	p.endObject(state)
+}
+
 func unmarshalAuthenticationCodeType(rawMsg *json.RawMessage) (AuthenticationCodeType, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch AuthenticationCodeTypeEnum(objMap["@type"].(string)) {
+	switch AuthenticationCodeTypeEnum(typeName) {
 	case AuthenticationCodeTypeTelegramMessageType:
 		var authenticationCodeTypeTelegramMessage AuthenticationCodeTypeTelegramMessage
-		err := json.Unmarshal(*rawMsg, &authenticationCodeTypeTelegramMessage)
+		err := jsonUnmarshal(*rawMsg, &authenticationCodeTypeTelegramMessage)
 		return &authenticationCodeTypeTelegramMessage, err
 
 	case AuthenticationCodeTypeSmsType:
 		var authenticationCodeTypeSms AuthenticationCodeTypeSms
-		err := json.Unmarshal(*rawMsg, &authenticationCodeTypeSms)
+		err := jsonUnmarshal(*rawMsg, &authenticationCodeTypeSms)
 		return &authenticationCodeTypeSms, err
 
 	case AuthenticationCodeTypeCallType:
 		var authenticationCodeTypeCall AuthenticationCodeTypeCall
-		err := json.Unmarshal(*rawMsg, &authenticationCodeTypeCall)
+		err := jsonUnmarshal(*rawMsg, &authenticationCodeTypeCall)
 		return &authenticationCodeTypeCall, err
 
 	case AuthenticationCodeTypeFlashCallType:
 		var authenticationCodeTypeFlashCall AuthenticationCodeTypeFlashCall
-		err := json.Unmarshal(*rawMsg, &authenticationCodeTypeFlashCall)
+		err := jsonUnmarshal(*rawMsg, &authenticationCodeTypeFlashCall)
 		return &authenticationCodeTypeFlashCall, err
 
 	case AuthenticationCodeTypeMissedCallType:
 		var authenticationCodeTypeMissedCall AuthenticationCodeTypeMissedCall
-		err := json.Unmarshal(*rawMsg, &authenticationCodeTypeMissedCall)
+		err := jsonUnmarshal(*rawMsg, &authenticationCodeTypeMissedCall)
 		return &authenticationCodeTypeMissedCall, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownAuthenticationCodeType{Unknown: unknown}, nil
+	}
+}
+
+func decodeAuthenticationCodeType(r *jsonReader) (AuthenticationCodeType, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
 	}
+	return unmarshalAuthenticationCodeType(rawMsg)
 }
 
 // AuthenticationCodeTypeTelegramMessage An authentication code is delivered via a private Telegram message, which can be viewed from another active session
@@ -87,11 +140,118 @@ func NewAuthenticationCodeTypeTelegramMessage(length int32) *AuthenticationCodeT
 	return &authenticationCodeTypeTelegramMessageTemp
 }
 
+// MarshalJSON marshals to json
+func (authenticationCodeTypeTelegramMessage *AuthenticationCodeTypeTelegramMessage) MarshalJSON() ([]byte, error) {
+	return authenticationCodeTypeTelegramMessage.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (authenticationCodeTypeTelegramMessage *AuthenticationCodeTypeTelegramMessage) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, authenticationCodeTypeTelegramMessage)
+}
+
+func (authenticationCodeTypeTelegramMessage *AuthenticationCodeTypeTelegramMessage) appendJSON(b []byte) ([]byte, error) {
+	if authenticationCodeTypeTelegramMessage == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, authenticationCodeTypeTelegramMessage.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, authenticationCodeTypeTelegramMessage.tdCommon.Extra)
+	b = append(b, `,"length":`...)
+	b = appendJSONInt(b, int64(authenticationCodeTypeTelegramMessage.Length))
+	return append(b, '}'), nil
+}
+
+func (authenticationCodeTypeTelegramMessage *AuthenticationCodeTypeTelegramMessage) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			authenticationCodeTypeTelegramMessage.tdCommon.Type, err = r.readString()
+		case "@extra":
+			authenticationCodeTypeTelegramMessage.tdCommon.Extra, err = r.readString()
+		case "length":
+			authenticationCodeTypeTelegramMessage.Length, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (authenticationCodeTypeTelegramMessage *AuthenticationCodeTypeTelegramMessage) Clone() *AuthenticationCodeTypeTelegramMessage {
+	if authenticationCodeTypeTelegramMessage == nil {
+		return nil
+	}
+	clone := *authenticationCodeTypeTelegramMessage
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (authenticationCodeTypeTelegramMessage *AuthenticationCodeTypeTelegramMessage) Equal(other *AuthenticationCodeTypeTelegramMessage) bool {
+	if authenticationCodeTypeTelegramMessage == nil || other == nil {
+		return authenticationCodeTypeTelegramMessage == other
+	}
+	if authenticationCodeTypeTelegramMessage.Length != other.Length {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (authenticationCodeTypeTelegramMessage *AuthenticationCodeTypeTelegramMessage) Diff(other *AuthenticationCodeTypeTelegramMessage) []Change {
+	return authenticationCodeTypeTelegramMessage.diff("", other, nil)
+}
+
+func (authenticationCodeTypeTelegramMessage *AuthenticationCodeTypeTelegramMessage) diff(path string, other *AuthenticationCodeTypeTelegramMessage, changes []Change) []Change {
+	if authenticationCodeTypeTelegramMessage == nil || other == nil {
+		if authenticationCodeTypeTelegramMessage != other {
+			changes = append(changes, Change{Path: path, Old: authenticationCodeTypeTelegramMessage, New: other})
+		}
+		return changes
+	}
+	if authenticationCodeTypeTelegramMessage.Length != other.Length {
+		changes = append(changes, Change{Path: fieldPath(path, "length"), Old: authenticationCodeTypeTelegramMessage.Length, New: other.Length})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (authenticationCodeTypeTelegramMessage *AuthenticationCodeTypeTelegramMessage) String() string {
+	return objectString(authenticationCodeTypeTelegramMessage)
+}
+
+// LogValue logs the object as its summary
+func (authenticationCodeTypeTelegramMessage *AuthenticationCodeTypeTelegramMessage) LogValue() slog.Value {
+	return slog.StringValue(objectString(authenticationCodeTypeTelegramMessage))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (authenticationCodeTypeTelegramMessage *AuthenticationCodeTypeTelegramMessage) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, authenticationCodeTypeTelegramMessage)
+}
+
+func (authenticationCodeTypeTelegramMessage *AuthenticationCodeTypeTelegramMessage) printText(p *printer) {
+	if authenticationCodeTypeTelegramMessage == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("AuthenticationCodeTypeTelegramMessage")
+	p.int("length", int64(authenticationCodeTypeTelegramMessage.Length))
+	p.endObject(state)
+}
+
 // GetAuthenticationCodeTypeEnum return the enum type of this object
 func (authenticationCodeTypeTelegramMessage *AuthenticationCodeTypeTelegramMessage) GetAuthenticationCodeTypeEnum() AuthenticationCodeTypeEnum {
 	return AuthenticationCodeTypeTelegramMessageType
 }
 
+// Accept calls the method of the visitor for AuthenticationCodeTypeTelegramMessage
+func (authenticationCodeTypeTelegramMessage *AuthenticationCodeTypeTelegramMessage) Accept(visitor AuthenticationCodeTypeVisitor) {
+	visitor.VisitAuthenticationCodeTypeTelegramMessage(authenticationCodeTypeTelegramMessage)
+}
+
 // AuthenticationCodeTypeSms An authentication code is delivered via an SMS message to the specified phone number
 type AuthenticationCodeTypeSms struct {
 	tdCommon
@@ -115,11 +275,118 @@ func NewAuthenticationCodeTypeSms(length int32) *AuthenticationCodeTypeSms {
 	return &authenticationCodeTypeSmsTemp
 }
 
+// MarshalJSON marshals to json
+func (authenticationCodeTypeSms *AuthenticationCodeTypeSms) MarshalJSON() ([]byte, error) {
+	return authenticationCodeTypeSms.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (authenticationCodeTypeSms *AuthenticationCodeTypeSms) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, authenticationCodeTypeSms)
+}
+
+func (authenticationCodeTypeSms *AuthenticationCodeTypeSms) appendJSON(b []byte) ([]byte, error) {
+	if authenticationCodeTypeSms == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, authenticationCodeTypeSms.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, authenticationCodeTypeSms.tdCommon.Extra)
+	b = append(b, `,"length":`...)
+	b = appendJSONInt(b, int64(authenticationCodeTypeSms.Length))
+	return append(b, '}'), nil
+}
+
+func (authenticationCodeTypeSms *AuthenticationCodeTypeSms) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			authenticationCodeTypeSms.tdCommon.Type, err = r.readString()
+		case "@extra":
+			authenticationCodeTypeSms.tdCommon.Extra, err = r.readString()
+		case "length":
+			authenticationCodeTypeSms.Length, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (authenticationCodeTypeSms *AuthenticationCodeTypeSms) Clone() *AuthenticationCodeTypeSms {
+	if authenticationCodeTypeSms == nil {
+		return nil
+	}
+	clone := *authenticationCodeTypeSms
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (authenticationCodeTypeSms *AuthenticationCodeTypeSms) Equal(other *AuthenticationCodeTypeSms) bool {
+	if authenticationCodeTypeSms == nil || other == nil {
+		return authenticationCodeTypeSms == other
+	}
+	if authenticationCodeTypeSms.Length != other.Length {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (authenticationCodeTypeSms *AuthenticationCodeTypeSms) Diff(other *AuthenticationCodeTypeSms) []Change {
+	return authenticationCodeTypeSms.diff("", other, nil)
+}
+
+func (authenticationCodeTypeSms *AuthenticationCodeTypeSms) diff(path string, other *AuthenticationCodeTypeSms, changes []Change) []Change {
+	if authenticationCodeTypeSms == nil || other == nil {
+		if authenticationCodeTypeSms != other {
+			changes = append(changes, Change{Path: path, Old: authenticationCodeTypeSms, New: other})
+		}
+		return changes
+	}
+	if authenticationCodeTypeSms.Length != other.Length {
+		changes = append(changes, Change{Path: fieldPath(path, "length"), Old: authenticationCodeTypeSms.Length, New: other.Length})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (authenticationCodeTypeSms *AuthenticationCodeTypeSms) String() string {
+	return objectString(authenticationCodeTypeSms)
+}
+
+// LogValue logs the object as its summary
+func (authenticationCodeTypeSms *AuthenticationCodeTypeSms) LogValue() slog.Value {
+	return slog.StringValue(objectString(authenticationCodeTypeSms))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (authenticationCodeTypeSms *AuthenticationCodeTypeSms) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, authenticationCodeTypeSms)
+}
+
+func (authenticationCodeTypeSms *AuthenticationCodeTypeSms) printText(p *printer) {
+	if authenticationCodeTypeSms == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("AuthenticationCodeTypeSms")
+	p.int("length", int64(authenticationCodeTypeSms.Length))
+	p.endObject(state)
+}
+
 // GetAuthenticationCodeTypeEnum return the enum type of this object
 func (authenticationCodeTypeSms *AuthenticationCodeTypeSms) GetAuthenticationCodeTypeEnum() AuthenticationCodeTypeEnum {
 	return AuthenticationCodeTypeSmsType
 }
 
+// Accept calls the method of the visitor for AuthenticationCodeTypeSms
+func (authenticationCodeTypeSms *AuthenticationCodeTypeSms) Accept(visitor AuthenticationCodeTypeVisitor) {
+	visitor.VisitAuthenticationCodeTypeSms(authenticationCodeTypeSms)
+}
+
 // AuthenticationCodeTypeCall An authentication code is delivered via a phone call to the specified phone number
 type AuthenticationCodeTypeCall struct {
 	tdCommon
@@ -143,11 +410,118 @@ func NewAuthenticationCodeTypeCall(length int32) *AuthenticationCodeTypeCall {
 	return &authenticationCodeTypeCallTemp
 }
 
+// MarshalJSON marshals to json
+func (authenticationCodeTypeCall *AuthenticationCodeTypeCall) MarshalJSON() ([]byte, error) {
+	return authenticationCodeTypeCall.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (authenticationCodeTypeCall *AuthenticationCodeTypeCall) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, authenticationCodeTypeCall)
+}
+
+func (authenticationCodeTypeCall *AuthenticationCodeTypeCall) appendJSON(b []byte) ([]byte, error) {
+	if authenticationCodeTypeCall == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, authenticationCodeTypeCall.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, authenticationCodeTypeCall.tdCommon.Extra)
+	b = append(b, `,"length":`...)
+	b = appendJSONInt(b, int64(authenticationCodeTypeCall.Length))
+	return append(b, '}'), nil
+}
+
+func (authenticationCodeTypeCall *AuthenticationCodeTypeCall) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			authenticationCodeTypeCall.tdCommon.Type, err = r.readString()
+		case "@extra":
+			authenticationCodeTypeCall.tdCommon.Extra, err = r.readString()
+		case "length":
+			authenticationCodeTypeCall.Length, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (authenticationCodeTypeCall *AuthenticationCodeTypeCall) Clone() *AuthenticationCodeTypeCall {
+	if authenticationCodeTypeCall == nil {
+		return nil
+	}
+	clone := *authenticationCodeTypeCall
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (authenticationCodeTypeCall *AuthenticationCodeTypeCall) Equal(other *AuthenticationCodeTypeCall) bool {
+	if authenticationCodeTypeCall == nil || other == nil {
+		return authenticationCodeTypeCall == other
+	}
+	if authenticationCodeTypeCall.Length != other.Length {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (authenticationCodeTypeCall *AuthenticationCodeTypeCall) Diff(other *AuthenticationCodeTypeCall) []Change {
+	return authenticationCodeTypeCall.diff("", other, nil)
+}
+
+func (authenticationCodeTypeCall *AuthenticationCodeTypeCall) diff(path string, other *AuthenticationCodeTypeCall, changes []Change) []Change {
+	if authenticationCodeTypeCall == nil || other == nil {
+		if authenticationCodeTypeCall != other {
+			changes = append(changes, Change{Path: path, Old: authenticationCodeTypeCall, New: other})
+		}
+		return changes
+	}
+	if authenticationCodeTypeCall.Length != other.Length {
+		changes = append(changes, Change{Path: fieldPath(path, "length"), Old: authenticationCodeTypeCall.Length, New: other.Length})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (authenticationCodeTypeCall *AuthenticationCodeTypeCall) String() string {
+	return objectString(authenticationCodeTypeCall)
+}
+
+// LogValue logs the object as its summary
+func (authenticationCodeTypeCall *AuthenticationCodeTypeCall) LogValue() slog.Value {
+	return slog.StringValue(objectString(authenticationCodeTypeCall))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (authenticationCodeTypeCall *AuthenticationCodeTypeCall) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, authenticationCodeTypeCall)
+}
+
+func (authenticationCodeTypeCall *AuthenticationCodeTypeCall) printText(p *printer) {
+	if authenticationCodeTypeCall == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("AuthenticationCodeTypeCall")
+	p.int("length", int64(authenticationCodeTypeCall.Length))
+	p.endObject(state)
+}
+
 // GetAuthenticationCodeTypeEnum return the enum type of this object
 func (authenticationCodeTypeCall *AuthenticationCodeTypeCall) GetAuthenticationCodeTypeEnum() AuthenticationCodeTypeEnum {
 	return AuthenticationCodeTypeCallType
 }
 
+// Accept calls the method of the visitor for AuthenticationCodeTypeCall
+func (authenticationCodeTypeCall *AuthenticationCodeTypeCall) Accept(visitor AuthenticationCodeTypeVisitor) {
+	visitor.VisitAuthenticationCodeTypeCall(authenticationCodeTypeCall)
+}
+
 // AuthenticationCodeTypeFlashCall An authentication code is delivered by an immediately canceled call to the specified phone number. The phone number that calls is the code that must be entered automatically
 type AuthenticationCodeTypeFlashCall struct {
 	tdCommon
@@ -171,11 +545,118 @@ func NewAuthenticationCodeTypeFlashCall(pattern string) *AuthenticationCodeTypeF
 	return &authenticationCodeTypeFlashCallTemp
 }
 
+// MarshalJSON marshals to json
+func (authenticationCodeTypeFlashCall *AuthenticationCodeTypeFlashCall) MarshalJSON() ([]byte, error) {
+	return authenticationCodeTypeFlashCall.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (authenticationCodeTypeFlashCall *AuthenticationCodeTypeFlashCall) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, authenticationCodeTypeFlashCall)
+}
+
+func (authenticationCodeTypeFlashCall *AuthenticationCodeTypeFlashCall) appendJSON(b []byte) ([]byte, error) {
+	if authenticationCodeTypeFlashCall == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, authenticationCodeTypeFlashCall.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, authenticationCodeTypeFlashCall.tdCommon.Extra)
+	b = append(b, `,"pattern":`...)
+	b = appendJSONString(b, authenticationCodeTypeFlashCall.Pattern)
+	return append(b, '}'), nil
+}
+
+func (authenticationCodeTypeFlashCall *AuthenticationCodeTypeFlashCall) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			authenticationCodeTypeFlashCall.tdCommon.Type, err = r.readString()
+		case "@extra":
+			authenticationCodeTypeFlashCall.tdCommon.Extra, err = r.readString()
+		case "pattern":
+			authenticationCodeTypeFlashCall.Pattern, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (authenticationCodeTypeFlashCall *AuthenticationCodeTypeFlashCall) Clone() *AuthenticationCodeTypeFlashCall {
+	if authenticationCodeTypeFlashCall == nil {
+		return nil
+	}
+	clone := *authenticationCodeTypeFlashCall
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (authenticationCodeTypeFlashCall *AuthenticationCodeTypeFlashCall) Equal(other *AuthenticationCodeTypeFlashCall) bool {
+	if authenticationCodeTypeFlashCall == nil || other == nil {
+		return authenticationCodeTypeFlashCall == other
+	}
+	if authenticationCodeTypeFlashCall.Pattern != other.Pattern {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (authenticationCodeTypeFlashCall *AuthenticationCodeTypeFlashCall) Diff(other *AuthenticationCodeTypeFlashCall) []Change {
+	return authenticationCodeTypeFlashCall.diff("", other, nil)
+}
+
+func (authenticationCodeTypeFlashCall *AuthenticationCodeTypeFlashCall) diff(path string, other *AuthenticationCodeTypeFlashCall, changes []Change) []Change {
+	if authenticationCodeTypeFlashCall == nil || other == nil {
+		if authenticationCodeTypeFlashCall != other {
+			changes = append(changes, Change{Path: path, Old: authenticationCodeTypeFlashCall, New: other})
+		}
+		return changes
+	}
+	if authenticationCodeTypeFlashCall.Pattern != other.Pattern {
+		changes = append(changes, Change{Path: fieldPath(path, "pattern"), Old: authenticationCodeTypeFlashCall.Pattern, New: other.Pattern})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (authenticationCodeTypeFlashCall *AuthenticationCodeTypeFlashCall) String() string {
+	return objectString(authenticationCodeTypeFlashCall)
+}
+
+// LogValue logs the object as its summary
+func (authenticationCodeTypeFlashCall *AuthenticationCodeTypeFlashCall) LogValue() slog.Value {
+	return slog.StringValue(objectString(authenticationCodeTypeFlashCall))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (authenticationCodeTypeFlashCall *AuthenticationCodeTypeFlashCall) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, authenticationCodeTypeFlashCall)
+}
+
+func (authenticationCodeTypeFlashCall *AuthenticationCodeTypeFlashCall) printText(p *printer) {
+	if authenticationCodeTypeFlashCall == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("AuthenticationCodeTypeFlashCall")
+	p.string("pattern", authenticationCodeTypeFlashCall.Pattern)
+	p.endObject(state)
+}
+
 // GetAuthenticationCodeTypeEnum return the enum type of this object
 func (authenticationCodeTypeFlashCall *AuthenticationCodeTypeFlashCall) GetAuthenticationCodeTypeEnum() AuthenticationCodeTypeEnum {
 	return AuthenticationCodeTypeFlashCallType
 }
 
+// Accept calls the method of the visitor for AuthenticationCodeTypeFlashCall
+func (authenticationCodeTypeFlashCall *AuthenticationCodeTypeFlashCall) Accept(visitor AuthenticationCodeTypeVisitor) {
+	visitor.VisitAuthenticationCodeTypeFlashCall(authenticationCodeTypeFlashCall)
+}
+
 // AuthenticationCodeTypeMissedCall An authentication code is delivered by an immediately canceled call to the specified phone number. The last digits of the phone number that calls are the code that must be entered manually by the user
 type AuthenticationCodeTypeMissedCall struct {
 	tdCommon
@@ -202,7 +683,265 @@ func NewAuthenticationCodeTypeMissedCall(phoneNumberPrefix string, length int32)
 	return &authenticationCodeTypeMissedCallTemp
 }
 
+// MarshalJSON marshals to json
+func (authenticationCodeTypeMissedCall *AuthenticationCodeTypeMissedCall) MarshalJSON() ([]byte, error) {
+	return authenticationCodeTypeMissedCall.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (authenticationCodeTypeMissedCall *AuthenticationCodeTypeMissedCall) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, authenticationCodeTypeMissedCall)
+}
+
+func (authenticationCodeTypeMissedCall *AuthenticationCodeTypeMissedCall) appendJSON(b []byte) ([]byte, error) {
+	if authenticationCodeTypeMissedCall == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, authenticationCodeTypeMissedCall.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, authenticationCodeTypeMissedCall.tdCommon.Extra)
+	b = append(b, `,"phone_number_prefix":`...)
+	b = appendJSONString(b, authenticationCodeTypeMissedCall.PhoneNumberPrefix)
+	b = append(b, `,"length":`...)
+	b = appendJSONInt(b, int64(authenticationCodeTypeMissedCall.Length))
+	return append(b, '}'), nil
+}
+
+func (authenticationCodeTypeMissedCall *AuthenticationCodeTypeMissedCall) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			authenticationCodeTypeMissedCall.tdCommon.Type, err = r.readString()
+		case "@extra":
+			authenticationCodeTypeMissedCall.tdCommon.Extra, err = r.readString()
+		case "phone_number_prefix":
+			authenticationCodeTypeMissedCall.PhoneNumberPrefix, err = r.readString()
+		case "length":
+			authenticationCodeTypeMissedCall.Length, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (authenticationCodeTypeMissedCall *AuthenticationCodeTypeMissedCall) Clone() *AuthenticationCodeTypeMissedCall {
+	if authenticationCodeTypeMissedCall == nil {
+		return nil
+	}
+	clone := *authenticationCodeTypeMissedCall
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (authenticationCodeTypeMissedCall *AuthenticationCodeTypeMissedCall) Equal(other *AuthenticationCodeTypeMissedCall) bool {
+	if authenticationCodeTypeMissedCall == nil || other == nil {
+		return authenticationCodeTypeMissedCall == other
+	}
+	if authenticationCodeTypeMissedCall.PhoneNumberPrefix != other.PhoneNumberPrefix {
+		return false
+	}
+	if authenticationCodeTypeMissedCall.Length != other.Length {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (authenticationCodeTypeMissedCall *AuthenticationCodeTypeMissedCall) Diff(other *AuthenticationCodeTypeMissedCall) []Change {
+	return authenticationCodeTypeMissedCall.diff("", other, nil)
+}
+
+func (authenticationCodeTypeMissedCall *AuthenticationCodeTypeMissedCall) diff(path string, other *AuthenticationCodeTypeMissedCall, changes []Change) []Change {
+	if authenticationCodeTypeMissedCall == nil || other == nil {
+		if authenticationCodeTypeMissedCall != other {
+			changes = append(changes, Change{Path: path, Old: authenticationCodeTypeMissedCall, New: other})
+		}
+		return changes
+	}
+	if authenticationCodeTypeMissedCall.PhoneNumberPrefix != other.PhoneNumberPrefix {
+		changes = append(changes, Change{Path: fieldPath(path, "phone_number_prefix"), Old: authenticationCodeTypeMissedCall.PhoneNumberPrefix, New: other.PhoneNumberPrefix})
+	}
+	if authenticationCodeTypeMissedCall.Length != other.Length {
+		changes = append(changes, Change{Path: fieldPath(path, "length"), Old: authenticationCodeTypeMissedCall.Length, New: other.Length})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (authenticationCodeTypeMissedCall *AuthenticationCodeTypeMissedCall) String() string {
+	return objectString(authenticationCodeTypeMissedCall)
+}
+
+// LogValue logs the object as its summary
+func (authenticationCodeTypeMissedCall *AuthenticationCodeTypeMissedCall) LogValue() slog.Value {
+	return slog.StringValue(objectString(authenticationCodeTypeMissedCall))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (authenticationCodeTypeMissedCall *AuthenticationCodeTypeMissedCall) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, authenticationCodeTypeMissedCall)
+}
+
+func (authenticationCodeTypeMissedCall *AuthenticationCodeTypeMissedCall) printText(p *printer) {
+	if authenticationCodeTypeMissedCall == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("AuthenticationCodeTypeMissedCall")
+	p.string("phone_number_prefix", authenticationCodeTypeMissedCall.PhoneNumberPrefix)
+	p.int("length", int64(authenticationCodeTypeMissedCall.Length))
+	p.endObject(state)
+}
+
 // GetAuthenticationCodeTypeEnum return the enum type of this object
 func (authenticationCodeTypeMissedCall *AuthenticationCodeTypeMissedCall) GetAuthenticationCodeTypeEnum() AuthenticationCodeTypeEnum {
 	return AuthenticationCodeTypeMissedCallType
 }
+
+// Accept calls the method of the visitor for AuthenticationCodeTypeMissedCall
+func (authenticationCodeTypeMissedCall *AuthenticationCodeTypeMissedCall) Accept(visitor AuthenticationCodeTypeVisitor) {
+	visitor.VisitAuthenticationCodeTypeMissedCall(authenticationCodeTypeMissedCall)
+}
+
+// AuthenticationCodeTypeVisitor visits every type of AuthenticationCodeType, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to AuthenticationCodeType, until they handle it too.
+type AuthenticationCodeTypeVisitor interface {
+	VisitAuthenticationCodeTypeTelegramMessage(authenticationCodeTypeTelegramMessage *AuthenticationCodeTypeTelegramMessage)
+	VisitAuthenticationCodeTypeSms(authenticationCodeTypeSms *AuthenticationCodeTypeSms)
+	VisitAuthenticationCodeTypeCall(authenticationCodeTypeCall *AuthenticationCodeTypeCall)
+	VisitAuthenticationCodeTypeFlashCall(authenticationCodeTypeFlashCall *AuthenticationCodeTypeFlashCall)
+	VisitAuthenticationCodeTypeMissedCall(authenticationCodeTypeMissedCall *AuthenticationCodeTypeMissedCall)
+	VisitUnknownAuthenticationCodeType(unknownAuthenticationCodeType *UnknownAuthenticationCodeType)
+}
+
+// AuthenticationCodeTypeCases holds the function MatchAuthenticationCodeType calls for each type of AuthenticationCodeType;
+// types without a function are passed to the default case
+type AuthenticationCodeTypeCases[R any] struct {
+	AuthenticationCodeTypeTelegramMessage func(authenticationCodeTypeTelegramMessage *AuthenticationCodeTypeTelegramMessage) R
+	AuthenticationCodeTypeSms             func(authenticationCodeTypeSms *AuthenticationCodeTypeSms) R
+	AuthenticationCodeTypeCall            func(authenticationCodeTypeCall *AuthenticationCodeTypeCall) R
+	AuthenticationCodeTypeFlashCall       func(authenticationCodeTypeFlashCall *AuthenticationCodeTypeFlashCall) R
+	AuthenticationCodeTypeMissedCall      func(authenticationCodeTypeMissedCall *AuthenticationCodeTypeMissedCall) R
+}
+
+// MatchAuthenticationCodeType calls the case of the type of authenticationCodeType, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement AuthenticationCodeTypeVisitor
+// to have the compiler check every type is handled.
+func MatchAuthenticationCodeType[R any](authenticationCodeType AuthenticationCodeType, cases AuthenticationCodeTypeCases[R], defaultCase func(authenticationCodeType AuthenticationCodeType) R) R {
+	switch value := authenticationCodeType.(type) {
+	case *AuthenticationCodeTypeTelegramMessage:
+		if cases.AuthenticationCodeTypeTelegramMessage != nil {
+			return cases.AuthenticationCodeTypeTelegramMessage(value)
+		}
+	case *AuthenticationCodeTypeSms:
+		if cases.AuthenticationCodeTypeSms != nil {
+			return cases.AuthenticationCodeTypeSms(value)
+		}
+	case *AuthenticationCodeTypeCall:
+		if cases.AuthenticationCodeTypeCall != nil {
+			return cases.AuthenticationCodeTypeCall(value)
+		}
+	case *AuthenticationCodeTypeFlashCall:
+		if cases.AuthenticationCodeTypeFlashCall != nil {
+			return cases.AuthenticationCodeTypeFlashCall(value)
+		}
+	case *AuthenticationCodeTypeMissedCall:
+		if cases.AuthenticationCodeTypeMissedCall != nil {
+			return cases.AuthenticationCodeTypeMissedCall(value)
+		}
+	}
+	return defaultCase(authenticationCodeType)
+}
+
+// Clone returns a deep copy of the object
+func (unknownAuthenticationCodeType *UnknownAuthenticationCodeType) Clone() *UnknownAuthenticationCodeType {
+	if unknownAuthenticationCodeType == nil {
+		return nil
+	}
+	return &UnknownAuthenticationCodeType{Unknown: unknownAuthenticationCodeType.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownAuthenticationCodeType *UnknownAuthenticationCodeType) Equal(other *UnknownAuthenticationCodeType) bool {
+	if unknownAuthenticationCodeType == nil || other == nil {
+		return unknownAuthenticationCodeType == other
+	}
+	return unknownAuthenticationCodeType.Unknown.equal(other.Unknown)
+}
+
+func cloneAuthenticationCodeType(authenticationCodeType AuthenticationCodeType) AuthenticationCodeType {
+	switch value := authenticationCodeType.(type) {
+	case *AuthenticationCodeTypeTelegramMessage:
+		return value.Clone()
+	case *AuthenticationCodeTypeSms:
+		return value.Clone()
+	case *AuthenticationCodeTypeCall:
+		return value.Clone()
+	case *AuthenticationCodeTypeFlashCall:
+		return value.Clone()
+	case *AuthenticationCodeTypeMissedCall:
+		return value.Clone()
+	case *UnknownAuthenticationCodeType:
+		return value.Clone()
+	}
+	return authenticationCodeType
+}
+
+func equalAuthenticationCodeType(a AuthenticationCodeType, b AuthenticationCodeType) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *AuthenticationCodeTypeTelegramMessage:
+		b, ok := b.(*AuthenticationCodeTypeTelegramMessage)
+		return ok && a.Equal(b)
+	case *AuthenticationCodeTypeSms:
+		b, ok := b.(*AuthenticationCodeTypeSms)
+		return ok && a.Equal(b)
+	case *AuthenticationCodeTypeCall:
+		b, ok := b.(*AuthenticationCodeTypeCall)
+		return ok && a.Equal(b)
+	case *AuthenticationCodeTypeFlashCall:
+		b, ok := b.(*AuthenticationCodeTypeFlashCall)
+		return ok && a.Equal(b)
+	case *AuthenticationCodeTypeMissedCall:
+		b, ok := b.(*AuthenticationCodeTypeMissedCall)
+		return ok && a.Equal(b)
+	case *UnknownAuthenticationCodeType:
+		b, ok := b.(*UnknownAuthenticationCodeType)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffAuthenticationCodeType(path string, a AuthenticationCodeType, b AuthenticationCodeType, changes []Change) []Change {
+	switch a := a.(type) {
+	case *AuthenticationCodeTypeTelegramMessage:
+		if b, ok := b.(*AuthenticationCodeTypeTelegramMessage); ok {
+			return a.diff(path, b, changes)
+		}
+	case *AuthenticationCodeTypeSms:
+		if b, ok := b.(*AuthenticationCodeTypeSms); ok {
+			return a.diff(path, b, changes)
+		}
+	case *AuthenticationCodeTypeCall:
+		if b, ok := b.(*AuthenticationCodeTypeCall); ok {
+			return a.diff(path, b, changes)
+		}
+	case *AuthenticationCodeTypeFlashCall:
+		if b, ok := b.(*AuthenticationCodeTypeFlashCall); ok {
+			return a.diff(path, b, changes)
+		}
+	case *AuthenticationCodeTypeMissedCall:
+		if b, ok := b.(*AuthenticationCodeTypeMissedCall); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalAuthenticationCodeType(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
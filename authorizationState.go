@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // AuthorizationState Represents the current authorization state of the TDLib client
 type AuthorizationState interface {
 	GetAuthorizationStateEnum() AuthorizationStateEnum
+	Accept(visitor AuthorizationStateVisitor)
 }
 
 // AuthorizationStateEnum Alias for abstract AuthorizationState 'Sub-Classes', used as constant-enum here
@@ -28,76 +30,127 @@ const (
 	AuthorizationStateClosedType                      AuthorizationStateEnum = "authorizationStateClosed"
 )
 
+// UnknownAuthorizationState is an AuthorizationState of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownAuthorizationState struct {
+	Unknown
+}
+
+// GetAuthorizationStateEnum return the enum type of this object
+func (unknownAuthorizationState *UnknownAuthorizationState) GetAuthorizationStateEnum() AuthorizationStateEnum {
+	return AuthorizationStateEnum(unknownAuthorizationState.Type)
+}
+
+// Accept calls the method of the visitor for UnknownAuthorizationState
+func (unknownAuthorizationState *UnknownAuthorizationState) Accept(visitor AuthorizationStateVisitor) {
+	visitor.VisitUnknownAuthorizationState(unknownAuthorizationState)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownAuthorizationState *UnknownAuthorizationState) String() string {
+	return objectString(unknownAuthorizationState)
+}
+
+// LogValue logs the object as its summary
+func (unknownAuthorizationState *UnknownAuthorizationState) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownAuthorizationState))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownAuthorizationState *UnknownAuthorizationState) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownAuthorizationState)
+}
+
+func (unknownAuthorizationState *UnknownAuthorizationState) printText(p *printer) {
+	if unknownAuthorizationState == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownAuthorizationState")
+	p.string("@type", unknownAuthorizationState.Type)
+	p.endObject(state)
+}
+
 func unmarshalAuthorizationState(rawMsg *json.RawMessage) (AuthorizationState, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch AuthorizationStateEnum(objMap["@type"].(string)) {
+	switch AuthorizationStateEnum(typeName) {
 	case AuthorizationStateWaitTdlibParametersType:
 		var authorizationStateWaitTdlibParameters AuthorizationStateWaitTdlibParameters
-		err := json.Unmarshal(*rawMsg, &authorizationStateWaitTdlibParameters)
+		err := jsonUnmarshal(*rawMsg, &authorizationStateWaitTdlibParameters)
 		return &authorizationStateWaitTdlibParameters, err
 
 	case AuthorizationStateWaitEncryptionKeyType:
 		var authorizationStateWaitEncryptionKey AuthorizationStateWaitEncryptionKey
-		err := json.Unmarshal(*rawMsg, &authorizationStateWaitEncryptionKey)
+		err := jsonUnmarshal(*rawMsg, &authorizationStateWaitEncryptionKey)
 		return &authorizationStateWaitEncryptionKey, err
 
 	case AuthorizationStateWaitPhoneNumberType:
 		var authorizationStateWaitPhoneNumber AuthorizationStateWaitPhoneNumber
-		err := json.Unmarshal(*rawMsg, &authorizationStateWaitPhoneNumber)
+		err := jsonUnmarshal(*rawMsg, &authorizationStateWaitPhoneNumber)
 		return &authorizationStateWaitPhoneNumber, err
 
 	case AuthorizationStateWaitCodeType:
 		var authorizationStateWaitCode AuthorizationStateWaitCode
-		err := json.Unmarshal(*rawMsg, &authorizationStateWaitCode)
+		err := jsonUnmarshal(*rawMsg, &authorizationStateWaitCode)
 		return &authorizationStateWaitCode, err
 
 	case AuthorizationStateWaitOtherDeviceConfirmationType:
 		var authorizationStateWaitOtherDeviceConfirmation AuthorizationStateWaitOtherDeviceConfirmation
-		err := json.Unmarshal(*rawMsg, &authorizationStateWaitOtherDeviceConfirmation)
+		err := jsonUnmarshal(*rawMsg, &authorizationStateWaitOtherDeviceConfirmation)
 		return &authorizationStateWaitOtherDeviceConfirmation, err
 
 	case AuthorizationStateWaitRegistrationType:
 		var authorizationStateWaitRegistration AuthorizationStateWaitRegistration
-		err := json.Unmarshal(*rawMsg, &authorizationStateWaitRegistration)
+		err := jsonUnmarshal(*rawMsg, &authorizationStateWaitRegistration)
 		return &authorizationStateWaitRegistration, err
 
 	case AuthorizationStateWaitPasswordType:
 		var authorizationStateWaitPassword AuthorizationStateWaitPassword
-		err := json.Unmarshal(*rawMsg, &authorizationStateWaitPassword)
+		err := jsonUnmarshal(*rawMsg, &authorizationStateWaitPassword)
 		return &authorizationStateWaitPassword, err
 
 	case AuthorizationStateReadyType:
 		var authorizationStateReady AuthorizationStateReady
-		err := json.Unmarshal(*rawMsg, &authorizationStateReady)
+		err := jsonUnmarshal(*rawMsg, &authorizationStateReady)
 		return &authorizationStateReady, err
 
 	case AuthorizationStateLoggingOutType:
 		var authorizationStateLoggingOut AuthorizationStateLoggingOut
-		err := json.Unmarshal(*rawMsg, &authorizationStateLoggingOut)
+		err := jsonUnmarshal(*rawMsg, &authorizationStateLoggingOut)
 		return &authorizationStateLoggingOut, err
 
 	case AuthorizationStateClosingType:
 		var authorizationStateClosing AuthorizationStateClosing
-		err := json.Unmarshal(*rawMsg, &authorizationStateClosing)
+		err := jsonUnmarshal(*rawMsg, &authorizationStateClosing)
 		return &authorizationStateClosing, err
 
 	case AuthorizationStateClosedType:
 		var authorizationStateClosed AuthorizationStateClosed
-		err := json.Unmarshal(*rawMsg, &authorizationStateClosed)
+		err := jsonUnmarshal(*rawMsg, &authorizationStateClosed)
 		return &authorizationStateClosed, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownAuthorizationState{Unknown: unknown}, nil
+	}
+}
+
+func decodeAuthorizationState(r *jsonReader) (AuthorizationState, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
 	}
+	return unmarshalAuthorizationState(rawMsg)
 }
 
 // AuthorizationStateWaitTdlibParameters TDLib needs TdlibParameters for initialization
@@ -111,7 +164,6 @@ func (authorizationStateWaitTdlibParameters *AuthorizationStateWaitTdlibParamete
 }
 
 // NewAuthorizationStateWaitTdlibParameters creates a new AuthorizationStateWaitTdlibParameters
-//
 func NewAuthorizationStateWaitTdlibParameters() *AuthorizationStateWaitTdlibParameters {
 	authorizationStateWaitTdlibParametersTemp := AuthorizationStateWaitTdlibParameters{
 		tdCommon: tdCommon{Type: "authorizationStateWaitTdlibParameters"},
@@ -120,11 +172,107 @@ func NewAuthorizationStateWaitTdlibParameters() *AuthorizationStateWaitTdlibPara
 	return &authorizationStateWaitTdlibParametersTemp
 }
 
+// MarshalJSON marshals to json
+func (authorizationStateWaitTdlibParameters *AuthorizationStateWaitTdlibParameters) MarshalJSON() ([]byte, error) {
+	return authorizationStateWaitTdlibParameters.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (authorizationStateWaitTdlibParameters *AuthorizationStateWaitTdlibParameters) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, authorizationStateWaitTdlibParameters)
+}
+
+func (authorizationStateWaitTdlibParameters *AuthorizationStateWaitTdlibParameters) appendJSON(b []byte) ([]byte, error) {
+	if authorizationStateWaitTdlibParameters == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, authorizationStateWaitTdlibParameters.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, authorizationStateWaitTdlibParameters.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (authorizationStateWaitTdlibParameters *AuthorizationStateWaitTdlibParameters) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			authorizationStateWaitTdlibParameters.tdCommon.Type, err = r.readString()
+		case "@extra":
+			authorizationStateWaitTdlibParameters.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (authorizationStateWaitTdlibParameters *AuthorizationStateWaitTdlibParameters) Clone() *AuthorizationStateWaitTdlibParameters {
+	if authorizationStateWaitTdlibParameters == nil {
+		return nil
+	}
+	clone := *authorizationStateWaitTdlibParameters
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (authorizationStateWaitTdlibParameters *AuthorizationStateWaitTdlibParameters) Equal(other *AuthorizationStateWaitTdlibParameters) bool {
+	if authorizationStateWaitTdlibParameters == nil || other == nil {
+		return authorizationStateWaitTdlibParameters == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (authorizationStateWaitTdlibParameters *AuthorizationStateWaitTdlibParameters) Diff(other *AuthorizationStateWaitTdlibParameters) []Change {
+	return authorizationStateWaitTdlibParameters.diff("", other, nil)
+}
+
+func (authorizationStateWaitTdlibParameters *AuthorizationStateWaitTdlibParameters) diff(path string, other *AuthorizationStateWaitTdlibParameters, changes []Change) []Change {
+	if authorizationStateWaitTdlibParameters == nil || other == nil {
+		if authorizationStateWaitTdlibParameters != other {
+			changes = append(changes, Change{Path: path, Old: authorizationStateWaitTdlibParameters, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (authorizationStateWaitTdlibParameters *AuthorizationStateWaitTdlibParameters) String() string {
+	return objectString(authorizationStateWaitTdlibParameters)
+}
+
+// LogValue logs the object as its summary
+func (authorizationStateWaitTdlibParameters *AuthorizationStateWaitTdlibParameters) LogValue() slog.Value {
+	return slog.StringValue(objectString(authorizationStateWaitTdlibParameters))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (authorizationStateWaitTdlibParameters *AuthorizationStateWaitTdlibParameters) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, authorizationStateWaitTdlibParameters)
+}
+
+func (authorizationStateWaitTdlibParameters *AuthorizationStateWaitTdlibParameters) printText(p *printer) {
+	if authorizationStateWaitTdlibParameters == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("AuthorizationStateWaitTdlibParameters")
+	p.endObject(state)
+}
+
 // GetAuthorizationStateEnum return the enum type of this object
 func (authorizationStateWaitTdlibParameters *AuthorizationStateWaitTdlibParameters) GetAuthorizationStateEnum() AuthorizationStateEnum {
 	return AuthorizationStateWaitTdlibParametersType
 }
 
+// Accept calls the method of the visitor for AuthorizationStateWaitTdlibParameters
+func (authorizationStateWaitTdlibParameters *AuthorizationStateWaitTdlibParameters) Accept(visitor AuthorizationStateVisitor) {
+	visitor.VisitAuthorizationStateWaitTdlibParameters(authorizationStateWaitTdlibParameters)
+}
+
 // AuthorizationStateWaitEncryptionKey TDLib needs an encryption key to decrypt the local database
 type AuthorizationStateWaitEncryptionKey struct {
 	tdCommon
@@ -148,11 +296,118 @@ func NewAuthorizationStateWaitEncryptionKey(isEncrypted bool) *AuthorizationStat
 	return &authorizationStateWaitEncryptionKeyTemp
 }
 
+// MarshalJSON marshals to json
+func (authorizationStateWaitEncryptionKey *AuthorizationStateWaitEncryptionKey) MarshalJSON() ([]byte, error) {
+	return authorizationStateWaitEncryptionKey.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (authorizationStateWaitEncryptionKey *AuthorizationStateWaitEncryptionKey) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, authorizationStateWaitEncryptionKey)
+}
+
+func (authorizationStateWaitEncryptionKey *AuthorizationStateWaitEncryptionKey) appendJSON(b []byte) ([]byte, error) {
+	if authorizationStateWaitEncryptionKey == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, authorizationStateWaitEncryptionKey.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, authorizationStateWaitEncryptionKey.tdCommon.Extra)
+	b = append(b, `,"is_encrypted":`...)
+	b = appendJSONBool(b, authorizationStateWaitEncryptionKey.IsEncrypted)
+	return append(b, '}'), nil
+}
+
+func (authorizationStateWaitEncryptionKey *AuthorizationStateWaitEncryptionKey) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			authorizationStateWaitEncryptionKey.tdCommon.Type, err = r.readString()
+		case "@extra":
+			authorizationStateWaitEncryptionKey.tdCommon.Extra, err = r.readString()
+		case "is_encrypted":
+			authorizationStateWaitEncryptionKey.IsEncrypted, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (authorizationStateWaitEncryptionKey *AuthorizationStateWaitEncryptionKey) Clone() *AuthorizationStateWaitEncryptionKey {
+	if authorizationStateWaitEncryptionKey == nil {
+		return nil
+	}
+	clone := *authorizationStateWaitEncryptionKey
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (authorizationStateWaitEncryptionKey *AuthorizationStateWaitEncryptionKey) Equal(other *AuthorizationStateWaitEncryptionKey) bool {
+	if authorizationStateWaitEncryptionKey == nil || other == nil {
+		return authorizationStateWaitEncryptionKey == other
+	}
+	if authorizationStateWaitEncryptionKey.IsEncrypted != other.IsEncrypted {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (authorizationStateWaitEncryptionKey *AuthorizationStateWaitEncryptionKey) Diff(other *AuthorizationStateWaitEncryptionKey) []Change {
+	return authorizationStateWaitEncryptionKey.diff("", other, nil)
+}
+
+func (authorizationStateWaitEncryptionKey *AuthorizationStateWaitEncryptionKey) diff(path string, other *AuthorizationStateWaitEncryptionKey, changes []Change) []Change {
+	if authorizationStateWaitEncryptionKey == nil || other == nil {
+		if authorizationStateWaitEncryptionKey != other {
+			changes = append(changes, Change{Path: path, Old: authorizationStateWaitEncryptionKey, New: other})
+		}
+		return changes
+	}
+	if authorizationStateWaitEncryptionKey.IsEncrypted != other.IsEncrypted {
+		changes = append(changes, Change{Path: fieldPath(path, "is_encrypted"), Old: authorizationStateWaitEncryptionKey.IsEncrypted, New: other.IsEncrypted})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (authorizationStateWaitEncryptionKey *AuthorizationStateWaitEncryptionKey) String() string {
+	return objectString(authorizationStateWaitEncryptionKey)
+}
+
+// LogValue logs the object as its summary
+func (authorizationStateWaitEncryptionKey *AuthorizationStateWaitEncryptionKey) LogValue() slog.Value {
+	return slog.StringValue(objectString(authorizationStateWaitEncryptionKey))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (authorizationStateWaitEncryptionKey *AuthorizationStateWaitEncryptionKey) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, authorizationStateWaitEncryptionKey)
+}
+
+func (authorizationStateWaitEncryptionKey *AuthorizationStateWaitEncryptionKey) printText(p *printer) {
+	if authorizationStateWaitEncryptionKey == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("AuthorizationStateWaitEncryptionKey")
+	p.bool("is_encrypted", authorizationStateWaitEncryptionKey.IsEncrypted)
+	p.endObject(state)
+}
+
 // GetAuthorizationStateEnum return the enum type of this object
 func (authorizationStateWaitEncryptionKey *AuthorizationStateWaitEncryptionKey) GetAuthorizationStateEnum() AuthorizationStateEnum {
 	return AuthorizationStateWaitEncryptionKeyType
 }
 
+// Accept calls the method of the visitor for AuthorizationStateWaitEncryptionKey
+func (authorizationStateWaitEncryptionKey *AuthorizationStateWaitEncryptionKey) Accept(visitor AuthorizationStateVisitor) {
+	visitor.VisitAuthorizationStateWaitEncryptionKey(authorizationStateWaitEncryptionKey)
+}
+
 // AuthorizationStateWaitPhoneNumber TDLib needs the user's phone number to authorize. Call `setAuthenticationPhoneNumber` to provide the phone number, or use `requestQrCodeAuthentication`, or `checkAuthenticationBotToken` for other authentication options
 type AuthorizationStateWaitPhoneNumber struct {
 	tdCommon
@@ -164,7 +419,6 @@ func (authorizationStateWaitPhoneNumber *AuthorizationStateWaitPhoneNumber) Mess
 }
 
 // NewAuthorizationStateWaitPhoneNumber creates a new AuthorizationStateWaitPhoneNumber
-//
 func NewAuthorizationStateWaitPhoneNumber() *AuthorizationStateWaitPhoneNumber {
 	authorizationStateWaitPhoneNumberTemp := AuthorizationStateWaitPhoneNumber{
 		tdCommon: tdCommon{Type: "authorizationStateWaitPhoneNumber"},
@@ -173,11 +427,107 @@ func NewAuthorizationStateWaitPhoneNumber() *AuthorizationStateWaitPhoneNumber {
 	return &authorizationStateWaitPhoneNumberTemp
 }
 
+// MarshalJSON marshals to json
+func (authorizationStateWaitPhoneNumber *AuthorizationStateWaitPhoneNumber) MarshalJSON() ([]byte, error) {
+	return authorizationStateWaitPhoneNumber.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (authorizationStateWaitPhoneNumber *AuthorizationStateWaitPhoneNumber) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, authorizationStateWaitPhoneNumber)
+}
+
+func (authorizationStateWaitPhoneNumber *AuthorizationStateWaitPhoneNumber) appendJSON(b []byte) ([]byte, error) {
+	if authorizationStateWaitPhoneNumber == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, authorizationStateWaitPhoneNumber.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, authorizationStateWaitPhoneNumber.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (authorizationStateWaitPhoneNumber *AuthorizationStateWaitPhoneNumber) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			authorizationStateWaitPhoneNumber.tdCommon.Type, err = r.readString()
+		case "@extra":
+			authorizationStateWaitPhoneNumber.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (authorizationStateWaitPhoneNumber *AuthorizationStateWaitPhoneNumber) Clone() *AuthorizationStateWaitPhoneNumber {
+	if authorizationStateWaitPhoneNumber == nil {
+		return nil
+	}
+	clone := *authorizationStateWaitPhoneNumber
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (authorizationStateWaitPhoneNumber *AuthorizationStateWaitPhoneNumber) Equal(other *AuthorizationStateWaitPhoneNumber) bool {
+	if authorizationStateWaitPhoneNumber == nil || other == nil {
+		return authorizationStateWaitPhoneNumber == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (authorizationStateWaitPhoneNumber *AuthorizationStateWaitPhoneNumber) Diff(other *AuthorizationStateWaitPhoneNumber) []Change {
+	return authorizationStateWaitPhoneNumber.diff("", other, nil)
+}
+
+func (authorizationStateWaitPhoneNumber *AuthorizationStateWaitPhoneNumber) diff(path string, other *AuthorizationStateWaitPhoneNumber, changes []Change) []Change {
+	if authorizationStateWaitPhoneNumber == nil || other == nil {
+		if authorizationStateWaitPhoneNumber != other {
+			changes = append(changes, Change{Path: path, Old: authorizationStateWaitPhoneNumber, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (authorizationStateWaitPhoneNumber *AuthorizationStateWaitPhoneNumber) String() string {
+	return objectString(authorizationStateWaitPhoneNumber)
+}
+
+// LogValue logs the object as its summary
+func (authorizationStateWaitPhoneNumber *AuthorizationStateWaitPhoneNumber) LogValue() slog.Value {
+	return slog.StringValue(objectString(authorizationStateWaitPhoneNumber))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (authorizationStateWaitPhoneNumber *AuthorizationStateWaitPhoneNumber) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, authorizationStateWaitPhoneNumber)
+}
+
+func (authorizationStateWaitPhoneNumber *AuthorizationStateWaitPhoneNumber) printText(p *printer) {
+	if authorizationStateWaitPhoneNumber == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("AuthorizationStateWaitPhoneNumber")
+	p.endObject(state)
+}
+
 // GetAuthorizationStateEnum return the enum type of this object
 func (authorizationStateWaitPhoneNumber *AuthorizationStateWaitPhoneNumber) GetAuthorizationStateEnum() AuthorizationStateEnum {
 	return AuthorizationStateWaitPhoneNumberType
 }
 
+// Accept calls the method of the visitor for AuthorizationStateWaitPhoneNumber
+func (authorizationStateWaitPhoneNumber *AuthorizationStateWaitPhoneNumber) Accept(visitor AuthorizationStateVisitor) {
+	visitor.VisitAuthorizationStateWaitPhoneNumber(authorizationStateWaitPhoneNumber)
+}
+
 // AuthorizationStateWaitCode TDLib needs the user's authentication code to authorize
 type AuthorizationStateWaitCode struct {
 	tdCommon
@@ -201,11 +551,126 @@ func NewAuthorizationStateWaitCode(codeInfo *AuthenticationCodeInfo) *Authorizat
 	return &authorizationStateWaitCodeTemp
 }
 
+// MarshalJSON marshals to json
+func (authorizationStateWaitCode *AuthorizationStateWaitCode) MarshalJSON() ([]byte, error) {
+	return authorizationStateWaitCode.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (authorizationStateWaitCode *AuthorizationStateWaitCode) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, authorizationStateWaitCode)
+}
+
+func (authorizationStateWaitCode *AuthorizationStateWaitCode) appendJSON(b []byte) ([]byte, error) {
+	if authorizationStateWaitCode == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, authorizationStateWaitCode.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, authorizationStateWaitCode.tdCommon.Extra)
+	b = append(b, `,"code_info":`...)
+	b, err = authorizationStateWaitCode.CodeInfo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (authorizationStateWaitCode *AuthorizationStateWaitCode) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			authorizationStateWaitCode.tdCommon.Type, err = r.readString()
+		case "@extra":
+			authorizationStateWaitCode.tdCommon.Extra, err = r.readString()
+		case "code_info":
+			if r.readNull() {
+				authorizationStateWaitCode.CodeInfo = nil
+			} else {
+				authorizationStateWaitCode.CodeInfo = new(AuthenticationCodeInfo)
+				err = authorizationStateWaitCode.CodeInfo.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (authorizationStateWaitCode *AuthorizationStateWaitCode) Clone() *AuthorizationStateWaitCode {
+	if authorizationStateWaitCode == nil {
+		return nil
+	}
+	clone := *authorizationStateWaitCode
+	clone.CodeInfo = authorizationStateWaitCode.CodeInfo.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (authorizationStateWaitCode *AuthorizationStateWaitCode) Equal(other *AuthorizationStateWaitCode) bool {
+	if authorizationStateWaitCode == nil || other == nil {
+		return authorizationStateWaitCode == other
+	}
+	if !authorizationStateWaitCode.CodeInfo.Equal(other.CodeInfo) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (authorizationStateWaitCode *AuthorizationStateWaitCode) Diff(other *AuthorizationStateWaitCode) []Change {
+	return authorizationStateWaitCode.diff("", other, nil)
+}
+
+func (authorizationStateWaitCode *AuthorizationStateWaitCode) diff(path string, other *AuthorizationStateWaitCode, changes []Change) []Change {
+	if authorizationStateWaitCode == nil || other == nil {
+		if authorizationStateWaitCode != other {
+			changes = append(changes, Change{Path: path, Old: authorizationStateWaitCode, New: other})
+		}
+		return changes
+	}
+	changes = authorizationStateWaitCode.CodeInfo.diff(fieldPath(path, "code_info"), other.CodeInfo, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (authorizationStateWaitCode *AuthorizationStateWaitCode) String() string {
+	return objectString(authorizationStateWaitCode)
+}
+
+// LogValue logs the object as its summary
+func (authorizationStateWaitCode *AuthorizationStateWaitCode) LogValue() slog.Value {
+	return slog.StringValue(objectString(authorizationStateWaitCode))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (authorizationStateWaitCode *AuthorizationStateWaitCode) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, authorizationStateWaitCode)
+}
+
+func (authorizationStateWaitCode *AuthorizationStateWaitCode) printText(p *printer) {
+	if authorizationStateWaitCode == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("AuthorizationStateWaitCode")
+	p.object("code_info", authorizationStateWaitCode.CodeInfo)
+	p.endObject(state)
+}
+
 // GetAuthorizationStateEnum return the enum type of this object
 func (authorizationStateWaitCode *AuthorizationStateWaitCode) GetAuthorizationStateEnum() AuthorizationStateEnum {
 	return AuthorizationStateWaitCodeType
 }
 
+// Accept calls the method of the visitor for AuthorizationStateWaitCode
+func (authorizationStateWaitCode *AuthorizationStateWaitCode) Accept(visitor AuthorizationStateVisitor) {
+	visitor.VisitAuthorizationStateWaitCode(authorizationStateWaitCode)
+}
+
 // AuthorizationStateWaitOtherDeviceConfirmation The user needs to confirm authorization on another logged in device by scanning a QR code with the provided link
 type AuthorizationStateWaitOtherDeviceConfirmation struct {
 	tdCommon
@@ -229,11 +694,118 @@ func NewAuthorizationStateWaitOtherDeviceConfirmation(link string) *Authorizatio
 	return &authorizationStateWaitOtherDeviceConfirmationTemp
 }
 
+// MarshalJSON marshals to json
+func (authorizationStateWaitOtherDeviceConfirmation *AuthorizationStateWaitOtherDeviceConfirmation) MarshalJSON() ([]byte, error) {
+	return authorizationStateWaitOtherDeviceConfirmation.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (authorizationStateWaitOtherDeviceConfirmation *AuthorizationStateWaitOtherDeviceConfirmation) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, authorizationStateWaitOtherDeviceConfirmation)
+}
+
+func (authorizationStateWaitOtherDeviceConfirmation *AuthorizationStateWaitOtherDeviceConfirmation) appendJSON(b []byte) ([]byte, error) {
+	if authorizationStateWaitOtherDeviceConfirmation == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, authorizationStateWaitOtherDeviceConfirmation.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, authorizationStateWaitOtherDeviceConfirmation.tdCommon.Extra)
+	b = append(b, `,"link":`...)
+	b = appendJSONString(b, authorizationStateWaitOtherDeviceConfirmation.Link)
+	return append(b, '}'), nil
+}
+
+func (authorizationStateWaitOtherDeviceConfirmation *AuthorizationStateWaitOtherDeviceConfirmation) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			authorizationStateWaitOtherDeviceConfirmation.tdCommon.Type, err = r.readString()
+		case "@extra":
+			authorizationStateWaitOtherDeviceConfirmation.tdCommon.Extra, err = r.readString()
+		case "link":
+			authorizationStateWaitOtherDeviceConfirmation.Link, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (authorizationStateWaitOtherDeviceConfirmation *AuthorizationStateWaitOtherDeviceConfirmation) Clone() *AuthorizationStateWaitOtherDeviceConfirmation {
+	if authorizationStateWaitOtherDeviceConfirmation == nil {
+		return nil
+	}
+	clone := *authorizationStateWaitOtherDeviceConfirmation
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (authorizationStateWaitOtherDeviceConfirmation *AuthorizationStateWaitOtherDeviceConfirmation) Equal(other *AuthorizationStateWaitOtherDeviceConfirmation) bool {
+	if authorizationStateWaitOtherDeviceConfirmation == nil || other == nil {
+		return authorizationStateWaitOtherDeviceConfirmation == other
+	}
+	if authorizationStateWaitOtherDeviceConfirmation.Link != other.Link {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (authorizationStateWaitOtherDeviceConfirmation *AuthorizationStateWaitOtherDeviceConfirmation) Diff(other *AuthorizationStateWaitOtherDeviceConfirmation) []Change {
+	return authorizationStateWaitOtherDeviceConfirmation.diff("", other, nil)
+}
+
+func (authorizationStateWaitOtherDeviceConfirmation *AuthorizationStateWaitOtherDeviceConfirmation) diff(path string, other *AuthorizationStateWaitOtherDeviceConfirmation, changes []Change) []Change {
+	if authorizationStateWaitOtherDeviceConfirmation == nil || other == nil {
+		if authorizationStateWaitOtherDeviceConfirmation != other {
+			changes = append(changes, Change{Path: path, Old: authorizationStateWaitOtherDeviceConfirmation, New: other})
+		}
+		return changes
+	}
+	if authorizationStateWaitOtherDeviceConfirmation.Link != other.Link {
+		changes = append(changes, Change{Path: fieldPath(path, "link"), Old: authorizationStateWaitOtherDeviceConfirmation.Link, New: other.Link})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (authorizationStateWaitOtherDeviceConfirmation *AuthorizationStateWaitOtherDeviceConfirmation) String() string {
+	return objectString(authorizationStateWaitOtherDeviceConfirmation)
+}
+
+// LogValue logs the object as its summary
+func (authorizationStateWaitOtherDeviceConfirmation *AuthorizationStateWaitOtherDeviceConfirmation) LogValue() slog.Value {
+	return slog.StringValue(objectString(authorizationStateWaitOtherDeviceConfirmation))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (authorizationStateWaitOtherDeviceConfirmation *AuthorizationStateWaitOtherDeviceConfirmation) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, authorizationStateWaitOtherDeviceConfirmation)
+}
+
+func (authorizationStateWaitOtherDeviceConfirmation *AuthorizationStateWaitOtherDeviceConfirmation) printText(p *printer) {
+	if authorizationStateWaitOtherDeviceConfirmation == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("AuthorizationStateWaitOtherDeviceConfirmation")
+	p.string("link", authorizationStateWaitOtherDeviceConfirmation.Link)
+	p.endObject(state)
+}
+
 // GetAuthorizationStateEnum return the enum type of this object
 func (authorizationStateWaitOtherDeviceConfirmation *AuthorizationStateWaitOtherDeviceConfirmation) GetAuthorizationStateEnum() AuthorizationStateEnum {
 	return AuthorizationStateWaitOtherDeviceConfirmationType
 }
 
+// Accept calls the method of the visitor for AuthorizationStateWaitOtherDeviceConfirmation
+func (authorizationStateWaitOtherDeviceConfirmation *AuthorizationStateWaitOtherDeviceConfirmation) Accept(visitor AuthorizationStateVisitor) {
+	visitor.VisitAuthorizationStateWaitOtherDeviceConfirmation(authorizationStateWaitOtherDeviceConfirmation)
+}
+
 // AuthorizationStateWaitRegistration The user is unregistered and need to accept terms of service and enter their first name and last name to finish registration
 type AuthorizationStateWaitRegistration struct {
 	tdCommon
@@ -257,11 +829,126 @@ func NewAuthorizationStateWaitRegistration(termsOfService *TermsOfService) *Auth
 	return &authorizationStateWaitRegistrationTemp
 }
 
+// MarshalJSON marshals to json
+func (authorizationStateWaitRegistration *AuthorizationStateWaitRegistration) MarshalJSON() ([]byte, error) {
+	return authorizationStateWaitRegistration.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (authorizationStateWaitRegistration *AuthorizationStateWaitRegistration) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, authorizationStateWaitRegistration)
+}
+
+func (authorizationStateWaitRegistration *AuthorizationStateWaitRegistration) appendJSON(b []byte) ([]byte, error) {
+	if authorizationStateWaitRegistration == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, authorizationStateWaitRegistration.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, authorizationStateWaitRegistration.tdCommon.Extra)
+	b = append(b, `,"terms_of_service":`...)
+	b, err = authorizationStateWaitRegistration.TermsOfService.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (authorizationStateWaitRegistration *AuthorizationStateWaitRegistration) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			authorizationStateWaitRegistration.tdCommon.Type, err = r.readString()
+		case "@extra":
+			authorizationStateWaitRegistration.tdCommon.Extra, err = r.readString()
+		case "terms_of_service":
+			if r.readNull() {
+				authorizationStateWaitRegistration.TermsOfService = nil
+			} else {
+				authorizationStateWaitRegistration.TermsOfService = new(TermsOfService)
+				err = authorizationStateWaitRegistration.TermsOfService.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (authorizationStateWaitRegistration *AuthorizationStateWaitRegistration) Clone() *AuthorizationStateWaitRegistration {
+	if authorizationStateWaitRegistration == nil {
+		return nil
+	}
+	clone := *authorizationStateWaitRegistration
+	clone.TermsOfService = authorizationStateWaitRegistration.TermsOfService.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (authorizationStateWaitRegistration *AuthorizationStateWaitRegistration) Equal(other *AuthorizationStateWaitRegistration) bool {
+	if authorizationStateWaitRegistration == nil || other == nil {
+		return authorizationStateWaitRegistration == other
+	}
+	if !authorizationStateWaitRegistration.TermsOfService.Equal(other.TermsOfService) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (authorizationStateWaitRegistration *AuthorizationStateWaitRegistration) Diff(other *AuthorizationStateWaitRegistration) []Change {
+	return authorizationStateWaitRegistration.diff("", other, nil)
+}
+
+func (authorizationStateWaitRegistration *AuthorizationStateWaitRegistration) diff(path string, other *AuthorizationStateWaitRegistration, changes []Change) []Change {
+	if authorizationStateWaitRegistration == nil || other == nil {
+		if authorizationStateWaitRegistration != other {
+			changes = append(changes, Change{Path: path, Old: authorizationStateWaitRegistration, New: other})
+		}
+		return changes
+	}
+	changes = authorizationStateWaitRegistration.TermsOfService.diff(fieldPath(path, "terms_of_service"), other.TermsOfService, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (authorizationStateWaitRegistration *AuthorizationStateWaitRegistration) String() string {
+	return objectString(authorizationStateWaitRegistration)
+}
+
+// LogValue logs the object as its summary
+func (authorizationStateWaitRegistration *AuthorizationStateWaitRegistration) LogValue() slog.Value {
+	return slog.StringValue(objectString(authorizationStateWaitRegistration))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (authorizationStateWaitRegistration *AuthorizationStateWaitRegistration) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, authorizationStateWaitRegistration)
+}
+
+func (authorizationStateWaitRegistration *AuthorizationStateWaitRegistration) printText(p *printer) {
+	if authorizationStateWaitRegistration == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("AuthorizationStateWaitRegistration")
+	p.object("terms_of_service", authorizationStateWaitRegistration.TermsOfService)
+	p.endObject(state)
+}
+
 // GetAuthorizationStateEnum return the enum type of this object
 func (authorizationStateWaitRegistration *AuthorizationStateWaitRegistration) GetAuthorizationStateEnum() AuthorizationStateEnum {
 	return AuthorizationStateWaitRegistrationType
 }
 
+// Accept calls the method of the visitor for AuthorizationStateWaitRegistration
+func (authorizationStateWaitRegistration *AuthorizationStateWaitRegistration) Accept(visitor AuthorizationStateVisitor) {
+	visitor.VisitAuthorizationStateWaitRegistration(authorizationStateWaitRegistration)
+}
+
 // AuthorizationStateWaitPassword The user has been authorized, but needs to enter a password to start using the application
 type AuthorizationStateWaitPassword struct {
 	tdCommon
@@ -291,11 +978,140 @@ func NewAuthorizationStateWaitPassword(passwordHint string, hasRecoveryEmailAddr
 	return &authorizationStateWaitPasswordTemp
 }
 
+// MarshalJSON marshals to json
+func (authorizationStateWaitPassword *AuthorizationStateWaitPassword) MarshalJSON() ([]byte, error) {
+	return authorizationStateWaitPassword.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (authorizationStateWaitPassword *AuthorizationStateWaitPassword) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, authorizationStateWaitPassword)
+}
+
+func (authorizationStateWaitPassword *AuthorizationStateWaitPassword) appendJSON(b []byte) ([]byte, error) {
+	if authorizationStateWaitPassword == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, authorizationStateWaitPassword.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, authorizationStateWaitPassword.tdCommon.Extra)
+	b = append(b, `,"password_hint":`...)
+	b = appendJSONString(b, authorizationStateWaitPassword.PasswordHint)
+	b = append(b, `,"has_recovery_email_address":`...)
+	b = appendJSONBool(b, authorizationStateWaitPassword.HasRecoveryEmailAddress)
+	b = append(b, `,"recovery_email_address_pattern":`...)
+	b = appendJSONString(b, authorizationStateWaitPassword.RecoveryEmailAddressPattern)
+	return append(b, '}'), nil
+}
+
+func (authorizationStateWaitPassword *AuthorizationStateWaitPassword) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			authorizationStateWaitPassword.tdCommon.Type, err = r.readString()
+		case "@extra":
+			authorizationStateWaitPassword.tdCommon.Extra, err = r.readString()
+		case "password_hint":
+			authorizationStateWaitPassword.PasswordHint, err = r.readString()
+		case "has_recovery_email_address":
+			authorizationStateWaitPassword.HasRecoveryEmailAddress, err = r.readBool()
+		case "recovery_email_address_pattern":
+			authorizationStateWaitPassword.RecoveryEmailAddressPattern, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (authorizationStateWaitPassword *AuthorizationStateWaitPassword) Clone() *AuthorizationStateWaitPassword {
+	if authorizationStateWaitPassword == nil {
+		return nil
+	}
+	clone := *authorizationStateWaitPassword
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (authorizationStateWaitPassword *AuthorizationStateWaitPassword) Equal(other *AuthorizationStateWaitPassword) bool {
+	if authorizationStateWaitPassword == nil || other == nil {
+		return authorizationStateWaitPassword == other
+	}
+	if authorizationStateWaitPassword.PasswordHint != other.PasswordHint {
+		return false
+	}
+	if authorizationStateWaitPassword.HasRecoveryEmailAddress != other.HasRecoveryEmailAddress {
+		return false
+	}
+	if authorizationStateWaitPassword.RecoveryEmailAddressPattern != other.RecoveryEmailAddressPattern {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (authorizationStateWaitPassword *AuthorizationStateWaitPassword) Diff(other *AuthorizationStateWaitPassword) []Change {
+	return authorizationStateWaitPassword.diff("", other, nil)
+}
+
+func (authorizationStateWaitPassword *AuthorizationStateWaitPassword) diff(path string, other *AuthorizationStateWaitPassword, changes []Change) []Change {
+	if authorizationStateWaitPassword == nil || other == nil {
+		if authorizationStateWaitPassword != other {
+			changes = append(changes, Change{Path: path, Old: authorizationStateWaitPassword, New: other})
+		}
+		return changes
+	}
+	if authorizationStateWaitPassword.PasswordHint != other.PasswordHint {
+		changes = append(changes, Change{Path: fieldPath(path, "password_hint"), Old: authorizationStateWaitPassword.PasswordHint, New: other.PasswordHint})
+	}
+	if authorizationStateWaitPassword.HasRecoveryEmailAddress != other.HasRecoveryEmailAddress {
+		changes = append(changes, Change{Path: fieldPath(path, "has_recovery_email_address"), Old: authorizationStateWaitPassword.HasRecoveryEmailAddress, New: other.HasRecoveryEmailAddress})
+	}
+	if authorizationStateWaitPassword.RecoveryEmailAddressPattern != other.RecoveryEmailAddressPattern {
+		changes = append(changes, Change{Path: fieldPath(path, "recovery_email_address_pattern"), Old: authorizationStateWaitPassword.RecoveryEmailAddressPattern, New: other.RecoveryEmailAddressPattern})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (authorizationStateWaitPassword *AuthorizationStateWaitPassword) String() string {
+	return objectString(authorizationStateWaitPassword)
+}
+
+// LogValue logs the object as its summary
+func (authorizationStateWaitPassword *AuthorizationStateWaitPassword) LogValue() slog.Value {
+	return slog.StringValue(objectString(authorizationStateWaitPassword))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (authorizationStateWaitPassword *AuthorizationStateWaitPassword) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, authorizationStateWaitPassword)
+}
+
+func (authorizationStateWaitPassword *AuthorizationStateWaitPassword) printText(p *printer) {
+	if authorizationStateWaitPassword == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("AuthorizationStateWaitPassword")
+	p.string("password_hint", authorizationStateWaitPassword.PasswordHint)
+	p.bool("has_recovery_email_address", authorizationStateWaitPassword.HasRecoveryEmailAddress)
+	p.string("recovery_email_address_pattern", authorizationStateWaitPassword.RecoveryEmailAddressPattern)
+	p.endObject(state)
+}
+
 // GetAuthorizationStateEnum return the enum type of this object
 func (authorizationStateWaitPassword *AuthorizationStateWaitPassword) GetAuthorizationStateEnum() AuthorizationStateEnum {
 	return AuthorizationStateWaitPasswordType
 }
 
+// Accept calls the method of the visitor for AuthorizationStateWaitPassword
+func (authorizationStateWaitPassword *AuthorizationStateWaitPassword) Accept(visitor AuthorizationStateVisitor) {
+	visitor.VisitAuthorizationStateWaitPassword(authorizationStateWaitPassword)
+}
+
 // AuthorizationStateReady The user has been successfully authorized. TDLib is now ready to answer queries
 type AuthorizationStateReady struct {
 	tdCommon
@@ -307,7 +1123,6 @@ func (authorizationStateReady *AuthorizationStateReady) MessageType() string {
 }
 
 // NewAuthorizationStateReady creates a new AuthorizationStateReady
-//
 func NewAuthorizationStateReady() *AuthorizationStateReady {
 	authorizationStateReadyTemp := AuthorizationStateReady{
 		tdCommon: tdCommon{Type: "authorizationStateReady"},
@@ -316,11 +1131,107 @@ func NewAuthorizationStateReady() *AuthorizationStateReady {
 	return &authorizationStateReadyTemp
 }
 
+// MarshalJSON marshals to json
+func (authorizationStateReady *AuthorizationStateReady) MarshalJSON() ([]byte, error) {
+	return authorizationStateReady.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (authorizationStateReady *AuthorizationStateReady) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, authorizationStateReady)
+}
+
+func (authorizationStateReady *AuthorizationStateReady) appendJSON(b []byte) ([]byte, error) {
+	if authorizationStateReady == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, authorizationStateReady.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, authorizationStateReady.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (authorizationStateReady *AuthorizationStateReady) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			authorizationStateReady.tdCommon.Type, err = r.readString()
+		case "@extra":
+			authorizationStateReady.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (authorizationStateReady *AuthorizationStateReady) Clone() *AuthorizationStateReady {
+	if authorizationStateReady == nil {
+		return nil
+	}
+	clone := *authorizationStateReady
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (authorizationStateReady *AuthorizationStateReady) Equal(other *AuthorizationStateReady) bool {
+	if authorizationStateReady == nil || other == nil {
+		return authorizationStateReady == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (authorizationStateReady *AuthorizationStateReady) Diff(other *AuthorizationStateReady) []Change {
+	return authorizationStateReady.diff("", other, nil)
+}
+
+func (authorizationStateReady *AuthorizationStateReady) diff(path string, other *AuthorizationStateReady, changes []Change) []Change {
+	if authorizationStateReady == nil || other == nil {
+		if authorizationStateReady != other {
+			changes = append(changes, Change{Path: path, Old: authorizationStateReady, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (authorizationStateReady *AuthorizationStateReady) String() string {
+	return objectString(authorizationStateReady)
+}
+
+// LogValue logs the object as its summary
+func (authorizationStateReady *AuthorizationStateReady) LogValue() slog.Value {
+	return slog.StringValue(objectString(authorizationStateReady))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (authorizationStateReady *AuthorizationStateReady) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, authorizationStateReady)
+}
+
+func (authorizationStateReady *AuthorizationStateReady) printText(p *printer) {
+	if authorizationStateReady == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("AuthorizationStateReady")
+	p.endObject(state)
+}
+
 // GetAuthorizationStateEnum return the enum type of this object
 func (authorizationStateReady *AuthorizationStateReady) GetAuthorizationStateEnum() AuthorizationStateEnum {
 	return AuthorizationStateReadyType
 }
 
+// Accept calls the method of the visitor for AuthorizationStateReady
+func (authorizationStateReady *AuthorizationStateReady) Accept(visitor AuthorizationStateVisitor) {
+	visitor.VisitAuthorizationStateReady(authorizationStateReady)
+}
+
 // AuthorizationStateLoggingOut The user is currently logging out
 type AuthorizationStateLoggingOut struct {
 	tdCommon
@@ -332,7 +1243,6 @@ func (authorizationStateLoggingOut *AuthorizationStateLoggingOut) MessageType()
 }
 
 // NewAuthorizationStateLoggingOut creates a new AuthorizationStateLoggingOut
-//
 func NewAuthorizationStateLoggingOut() *AuthorizationStateLoggingOut {
 	authorizationStateLoggingOutTemp := AuthorizationStateLoggingOut{
 		tdCommon: tdCommon{Type: "authorizationStateLoggingOut"},
@@ -341,11 +1251,107 @@ func NewAuthorizationStateLoggingOut() *AuthorizationStateLoggingOut {
 	return &authorizationStateLoggingOutTemp
 }
 
+// MarshalJSON marshals to json
+func (authorizationStateLoggingOut *AuthorizationStateLoggingOut) MarshalJSON() ([]byte, error) {
+	return authorizationStateLoggingOut.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (authorizationStateLoggingOut *AuthorizationStateLoggingOut) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, authorizationStateLoggingOut)
+}
+
+func (authorizationStateLoggingOut *AuthorizationStateLoggingOut) appendJSON(b []byte) ([]byte, error) {
+	if authorizationStateLoggingOut == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, authorizationStateLoggingOut.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, authorizationStateLoggingOut.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (authorizationStateLoggingOut *AuthorizationStateLoggingOut) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			authorizationStateLoggingOut.tdCommon.Type, err = r.readString()
+		case "@extra":
+			authorizationStateLoggingOut.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (authorizationStateLoggingOut *AuthorizationStateLoggingOut) Clone() *AuthorizationStateLoggingOut {
+	if authorizationStateLoggingOut == nil {
+		return nil
+	}
+	clone := *authorizationStateLoggingOut
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (authorizationStateLoggingOut *AuthorizationStateLoggingOut) Equal(other *AuthorizationStateLoggingOut) bool {
+	if authorizationStateLoggingOut == nil || other == nil {
+		return authorizationStateLoggingOut == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (authorizationStateLoggingOut *AuthorizationStateLoggingOut) Diff(other *AuthorizationStateLoggingOut) []Change {
+	return authorizationStateLoggingOut.diff("", other, nil)
+}
+
+func (authorizationStateLoggingOut *AuthorizationStateLoggingOut) diff(path string, other *AuthorizationStateLoggingOut, changes []Change) []Change {
+	if authorizationStateLoggingOut == nil || other == nil {
+		if authorizationStateLoggingOut != other {
+			changes = append(changes, Change{Path: path, Old: authorizationStateLoggingOut, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (authorizationStateLoggingOut *AuthorizationStateLoggingOut) String() string {
+	return objectString(authorizationStateLoggingOut)
+}
+
+// LogValue logs the object as its summary
+func (authorizationStateLoggingOut *AuthorizationStateLoggingOut) LogValue() slog.Value {
+	return slog.StringValue(objectString(authorizationStateLoggingOut))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (authorizationStateLoggingOut *AuthorizationStateLoggingOut) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, authorizationStateLoggingOut)
+}
+
+func (authorizationStateLoggingOut *AuthorizationStateLoggingOut) printText(p *printer) {
+	if authorizationStateLoggingOut == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("AuthorizationStateLoggingOut")
+	p.endObject(state)
+}
+
 // GetAuthorizationStateEnum return the enum type of this object
 func (authorizationStateLoggingOut *AuthorizationStateLoggingOut) GetAuthorizationStateEnum() AuthorizationStateEnum {
 	return AuthorizationStateLoggingOutType
 }
 
+// Accept calls the method of the visitor for AuthorizationStateLoggingOut
+func (authorizationStateLoggingOut *AuthorizationStateLoggingOut) Accept(visitor AuthorizationStateVisitor) {
+	visitor.VisitAuthorizationStateLoggingOut(authorizationStateLoggingOut)
+}
+
 // AuthorizationStateClosing TDLib is closing, all subsequent queries will be answered with the error 500. Note that closing TDLib can take a while. All resources will be freed only after authorizationStateClosed has been received
 type AuthorizationStateClosing struct {
 	tdCommon
@@ -357,7 +1363,6 @@ func (authorizationStateClosing *AuthorizationStateClosing) MessageType() string
 }
 
 // NewAuthorizationStateClosing creates a new AuthorizationStateClosing
-//
 func NewAuthorizationStateClosing() *AuthorizationStateClosing {
 	authorizationStateClosingTemp := AuthorizationStateClosing{
 		tdCommon: tdCommon{Type: "authorizationStateClosing"},
@@ -366,11 +1371,107 @@ func NewAuthorizationStateClosing() *AuthorizationStateClosing {
 	return &authorizationStateClosingTemp
 }
 
+// MarshalJSON marshals to json
+func (authorizationStateClosing *AuthorizationStateClosing) MarshalJSON() ([]byte, error) {
+	return authorizationStateClosing.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (authorizationStateClosing *AuthorizationStateClosing) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, authorizationStateClosing)
+}
+
+func (authorizationStateClosing *AuthorizationStateClosing) appendJSON(b []byte) ([]byte, error) {
+	if authorizationStateClosing == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, authorizationStateClosing.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, authorizationStateClosing.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (authorizationStateClosing *AuthorizationStateClosing) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			authorizationStateClosing.tdCommon.Type, err = r.readString()
+		case "@extra":
+			authorizationStateClosing.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (authorizationStateClosing *AuthorizationStateClosing) Clone() *AuthorizationStateClosing {
+	if authorizationStateClosing == nil {
+		return nil
+	}
+	clone := *authorizationStateClosing
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (authorizationStateClosing *AuthorizationStateClosing) Equal(other *AuthorizationStateClosing) bool {
+	if authorizationStateClosing == nil || other == nil {
+		return authorizationStateClosing == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (authorizationStateClosing *AuthorizationStateClosing) Diff(other *AuthorizationStateClosing) []Change {
+	return authorizationStateClosing.diff("", other, nil)
+}
+
+func (authorizationStateClosing *AuthorizationStateClosing) diff(path string, other *AuthorizationStateClosing, changes []Change) []Change {
+	if authorizationStateClosing == nil || other == nil {
+		if authorizationStateClosing != other {
+			changes = append(changes, Change{Path: path, Old: authorizationStateClosing, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (authorizationStateClosing *AuthorizationStateClosing) String() string {
+	return objectString(authorizationStateClosing)
+}
+
+// LogValue logs the object as its summary
+func (authorizationStateClosing *AuthorizationStateClosing) LogValue() slog.Value {
+	return slog.StringValue(objectString(authorizationStateClosing))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (authorizationStateClosing *AuthorizationStateClosing) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, authorizationStateClosing)
+}
+
+func (authorizationStateClosing *AuthorizationStateClosing) printText(p *printer) {
+	if authorizationStateClosing == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("AuthorizationStateClosing")
+	p.endObject(state)
+}
+
 // GetAuthorizationStateEnum return the enum type of this object
 func (authorizationStateClosing *AuthorizationStateClosing) GetAuthorizationStateEnum() AuthorizationStateEnum {
 	return AuthorizationStateClosingType
 }
 
+// Accept calls the method of the visitor for AuthorizationStateClosing
+func (authorizationStateClosing *AuthorizationStateClosing) Accept(visitor AuthorizationStateVisitor) {
+	visitor.VisitAuthorizationStateClosing(authorizationStateClosing)
+}
+
 // AuthorizationStateClosed TDLib client is in its final state. All databases are closed and all resources are released. No other updates will be received after this. All queries will be responded to with error code 500. To continue working, one must create a new instance of the TDLib client
 type AuthorizationStateClosed struct {
 	tdCommon
@@ -382,7 +1483,6 @@ func (authorizationStateClosed *AuthorizationStateClosed) MessageType() string {
 }
 
 // NewAuthorizationStateClosed creates a new AuthorizationStateClosed
-//
 func NewAuthorizationStateClosed() *AuthorizationStateClosed {
 	authorizationStateClosedTemp := AuthorizationStateClosed{
 		tdCommon: tdCommon{Type: "authorizationStateClosed"},
@@ -391,11 +1491,337 @@ func NewAuthorizationStateClosed() *AuthorizationStateClosed {
 	return &authorizationStateClosedTemp
 }
 
+// MarshalJSON marshals to json
+func (authorizationStateClosed *AuthorizationStateClosed) MarshalJSON() ([]byte, error) {
+	return authorizationStateClosed.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (authorizationStateClosed *AuthorizationStateClosed) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, authorizationStateClosed)
+}
+
+func (authorizationStateClosed *AuthorizationStateClosed) appendJSON(b []byte) ([]byte, error) {
+	if authorizationStateClosed == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, authorizationStateClosed.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, authorizationStateClosed.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (authorizationStateClosed *AuthorizationStateClosed) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			authorizationStateClosed.tdCommon.Type, err = r.readString()
+		case "@extra":
+			authorizationStateClosed.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (authorizationStateClosed *AuthorizationStateClosed) Clone() *AuthorizationStateClosed {
+	if authorizationStateClosed == nil {
+		return nil
+	}
+	clone := *authorizationStateClosed
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (authorizationStateClosed *AuthorizationStateClosed) Equal(other *AuthorizationStateClosed) bool {
+	if authorizationStateClosed == nil || other == nil {
+		return authorizationStateClosed == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (authorizationStateClosed *AuthorizationStateClosed) Diff(other *AuthorizationStateClosed) []Change {
+	return authorizationStateClosed.diff("", other, nil)
+}
+
+func (authorizationStateClosed *AuthorizationStateClosed) diff(path string, other *AuthorizationStateClosed, changes []Change) []Change {
+	if authorizationStateClosed == nil || other == nil {
+		if authorizationStateClosed != other {
+			changes = append(changes, Change{Path: path, Old: authorizationStateClosed, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (authorizationStateClosed *AuthorizationStateClosed) String() string {
+	return objectString(authorizationStateClosed)
+}
+
+// LogValue logs the object as its summary
+func (authorizationStateClosed *AuthorizationStateClosed) LogValue() slog.Value {
+	return slog.StringValue(objectString(authorizationStateClosed))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (authorizationStateClosed *AuthorizationStateClosed) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, authorizationStateClosed)
+}
+
+func (authorizationStateClosed *AuthorizationStateClosed) printText(p *printer) {
+	if authorizationStateClosed == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("AuthorizationStateClosed")
+	p.endObject(state)
+}
+
 // GetAuthorizationStateEnum return the enum type of this object
 func (authorizationStateClosed *AuthorizationStateClosed) GetAuthorizationStateEnum() AuthorizationStateEnum {
 	return AuthorizationStateClosedType
 }
 
+// Accept calls the method of the visitor for AuthorizationStateClosed
+func (authorizationStateClosed *AuthorizationStateClosed) Accept(visitor AuthorizationStateVisitor) {
+	visitor.VisitAuthorizationStateClosed(authorizationStateClosed)
+}
+
+// AuthorizationStateVisitor visits every type of AuthorizationState, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to AuthorizationState, until they handle it too.
+type AuthorizationStateVisitor interface {
+	VisitAuthorizationStateWaitTdlibParameters(authorizationStateWaitTdlibParameters *AuthorizationStateWaitTdlibParameters)
+	VisitAuthorizationStateWaitEncryptionKey(authorizationStateWaitEncryptionKey *AuthorizationStateWaitEncryptionKey)
+	VisitAuthorizationStateWaitPhoneNumber(authorizationStateWaitPhoneNumber *AuthorizationStateWaitPhoneNumber)
+	VisitAuthorizationStateWaitCode(authorizationStateWaitCode *AuthorizationStateWaitCode)
+	VisitAuthorizationStateWaitOtherDeviceConfirmation(authorizationStateWaitOtherDeviceConfirmation *AuthorizationStateWaitOtherDeviceConfirmation)
+	VisitAuthorizationStateWaitRegistration(authorizationStateWaitRegistration *AuthorizationStateWaitRegistration)
+	VisitAuthorizationStateWaitPassword(authorizationStateWaitPassword *AuthorizationStateWaitPassword)
+	VisitAuthorizationStateReady(authorizationStateReady *AuthorizationStateReady)
+	VisitAuthorizationStateLoggingOut(authorizationStateLoggingOut *AuthorizationStateLoggingOut)
+	VisitAuthorizationStateClosing(authorizationStateClosing *AuthorizationStateClosing)
+	VisitAuthorizationStateClosed(authorizationStateClosed *AuthorizationStateClosed)
+	VisitUnknownAuthorizationState(unknownAuthorizationState *UnknownAuthorizationState)
+}
+
+// AuthorizationStateCases holds the function MatchAuthorizationState calls for each type of AuthorizationState;
+// types without a function are passed to the default case
+type AuthorizationStateCases[R any] struct {
+	AuthorizationStateWaitTdlibParameters         func(authorizationStateWaitTdlibParameters *AuthorizationStateWaitTdlibParameters) R
+	AuthorizationStateWaitEncryptionKey           func(authorizationStateWaitEncryptionKey *AuthorizationStateWaitEncryptionKey) R
+	AuthorizationStateWaitPhoneNumber             func(authorizationStateWaitPhoneNumber *AuthorizationStateWaitPhoneNumber) R
+	AuthorizationStateWaitCode                    func(authorizationStateWaitCode *AuthorizationStateWaitCode) R
+	AuthorizationStateWaitOtherDeviceConfirmation func(authorizationStateWaitOtherDeviceConfirmation *AuthorizationStateWaitOtherDeviceConfirmation) R
+	AuthorizationStateWaitRegistration            func(authorizationStateWaitRegistration *AuthorizationStateWaitRegistration) R
+	AuthorizationStateWaitPassword                func(authorizationStateWaitPassword *AuthorizationStateWaitPassword) R
+	AuthorizationStateReady                       func(authorizationStateReady *AuthorizationStateReady) R
+	AuthorizationStateLoggingOut                  func(authorizationStateLoggingOut *AuthorizationStateLoggingOut) R
+	AuthorizationStateClosing                     func(authorizationStateClosing *AuthorizationStateClosing) R
+	AuthorizationStateClosed                      func(authorizationStateClosed *AuthorizationStateClosed) R
+}
+
+// MatchAuthorizationState calls the case of the type of authorizationState, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement AuthorizationStateVisitor
+// to have the compiler check every type is handled.
+func MatchAuthorizationState[R any](authorizationState AuthorizationState, cases AuthorizationStateCases[R], defaultCase func(authorizationState AuthorizationState) R) R {
+	switch value := authorizationState.(type) {
+	case *AuthorizationStateWaitTdlibParameters:
+		if cases.AuthorizationStateWaitTdlibParameters != nil {
+			return cases.AuthorizationStateWaitTdlibParameters(value)
+		}
+	case *AuthorizationStateWaitEncryptionKey:
+		if cases.AuthorizationStateWaitEncryptionKey != nil {
+			return cases.AuthorizationStateWaitEncryptionKey(value)
+		}
+	case *AuthorizationStateWaitPhoneNumber:
+		if cases.AuthorizationStateWaitPhoneNumber != nil {
+			return cases.AuthorizationStateWaitPhoneNumber(value)
+		}
+	case *AuthorizationStateWaitCode:
+		if cases.AuthorizationStateWaitCode != nil {
+			return cases.AuthorizationStateWaitCode(value)
+		}
+	case *AuthorizationStateWaitOtherDeviceConfirmation:
+		if cases.AuthorizationStateWaitOtherDeviceConfirmation != nil {
+			return cases.AuthorizationStateWaitOtherDeviceConfirmation(value)
+		}
+	case *AuthorizationStateWaitRegistration:
+		if cases.AuthorizationStateWaitRegistration != nil {
+			return cases.AuthorizationStateWaitRegistration(value)
+		}
+	case *AuthorizationStateWaitPassword:
+		if cases.AuthorizationStateWaitPassword != nil {
+			return cases.AuthorizationStateWaitPassword(value)
+		}
+	case *AuthorizationStateReady:
+		if cases.AuthorizationStateReady != nil {
+			return cases.AuthorizationStateReady(value)
+		}
+	case *AuthorizationStateLoggingOut:
+		if cases.AuthorizationStateLoggingOut != nil {
+			return cases.AuthorizationStateLoggingOut(value)
+		}
+	case *AuthorizationStateClosing:
+		if cases.AuthorizationStateClosing != nil {
+			return cases.AuthorizationStateClosing(value)
+		}
+	case *AuthorizationStateClosed:
+		if cases.AuthorizationStateClosed != nil {
+			return cases.AuthorizationStateClosed(value)
+		}
+	}
+	return defaultCase(authorizationState)
+}
+
+// Clone returns a deep copy of the object
+func (unknownAuthorizationState *UnknownAuthorizationState) Clone() *UnknownAuthorizationState {
+	if unknownAuthorizationState == nil {
+		return nil
+	}
+	return &UnknownAuthorizationState{Unknown: unknownAuthorizationState.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownAuthorizationState *UnknownAuthorizationState) Equal(other *UnknownAuthorizationState) bool {
+	if unknownAuthorizationState == nil || other == nil {
+		return unknownAuthorizationState == other
+	}
+	return unknownAuthorizationState.Unknown.equal(other.Unknown)
+}
+
+func cloneAuthorizationState(authorizationState AuthorizationState) AuthorizationState {
+	switch value := authorizationState.(type) {
+	case *AuthorizationStateWaitTdlibParameters:
+		return value.Clone()
+	case *AuthorizationStateWaitEncryptionKey:
+		return value.Clone()
+	case *AuthorizationStateWaitPhoneNumber:
+		return value.Clone()
+	case *AuthorizationStateWaitCode:
+		return value.Clone()
+	case *AuthorizationStateWaitOtherDeviceConfirmation:
+		return value.Clone()
+	case *AuthorizationStateWaitRegistration:
+		return value.Clone()
+	case *AuthorizationStateWaitPassword:
+		return value.Clone()
+	case *AuthorizationStateReady:
+		return value.Clone()
+	case *AuthorizationStateLoggingOut:
+		return value.Clone()
+	case *AuthorizationStateClosing:
+		return value.Clone()
+	case *AuthorizationStateClosed:
+		return value.Clone()
+	case *UnknownAuthorizationState:
+		return value.Clone()
+	}
+	return authorizationState
+}
+
+func equalAuthorizationState(a AuthorizationState, b AuthorizationState) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *AuthorizationStateWaitTdlibParameters:
+		b, ok := b.(*AuthorizationStateWaitTdlibParameters)
+		return ok && a.Equal(b)
+	case *AuthorizationStateWaitEncryptionKey:
+		b, ok := b.(*AuthorizationStateWaitEncryptionKey)
+		return ok && a.Equal(b)
+	case *AuthorizationStateWaitPhoneNumber:
+		b, ok := b.(*AuthorizationStateWaitPhoneNumber)
+		return ok && a.Equal(b)
+	case *AuthorizationStateWaitCode:
+		b, ok := b.(*AuthorizationStateWaitCode)
+		return ok && a.Equal(b)
+	case *AuthorizationStateWaitOtherDeviceConfirmation:
+		b, ok := b.(*AuthorizationStateWaitOtherDeviceConfirmation)
+		return ok && a.Equal(b)
+	case *AuthorizationStateWaitRegistration:
+		b, ok := b.(*AuthorizationStateWaitRegistration)
+		return ok && a.Equal(b)
+	case *AuthorizationStateWaitPassword:
+		b, ok := b.(*AuthorizationStateWaitPassword)
+		return ok && a.Equal(b)
+	case *AuthorizationStateReady:
+		b, ok := b.(*AuthorizationStateReady)
+		return ok && a.Equal(b)
+	case *AuthorizationStateLoggingOut:
+		b, ok := b.(*AuthorizationStateLoggingOut)
+		return ok && a.Equal(b)
+	case *AuthorizationStateClosing:
+		b, ok := b.(*AuthorizationStateClosing)
+		return ok && a.Equal(b)
+	case *AuthorizationStateClosed:
+		b, ok := b.(*AuthorizationStateClosed)
+		return ok && a.Equal(b)
+	case *UnknownAuthorizationState:
+		b, ok := b.(*UnknownAuthorizationState)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffAuthorizationState(path string, a AuthorizationState, b AuthorizationState, changes []Change) []Change {
+	switch a := a.(type) {
+	case *AuthorizationStateWaitTdlibParameters:
+		if b, ok := b.(*AuthorizationStateWaitTdlibParameters); ok {
+			return a.diff(path, b, changes)
+		}
+	case *AuthorizationStateWaitEncryptionKey:
+		if b, ok := b.(*AuthorizationStateWaitEncryptionKey); ok {
+			return a.diff(path, b, changes)
+		}
+	case *AuthorizationStateWaitPhoneNumber:
+		if b, ok := b.(*AuthorizationStateWaitPhoneNumber); ok {
+			return a.diff(path, b, changes)
+		}
+	case *AuthorizationStateWaitCode:
+		if b, ok := b.(*AuthorizationStateWaitCode); ok {
+			return a.diff(path, b, changes)
+		}
+	case *AuthorizationStateWaitOtherDeviceConfirmation:
+		if b, ok := b.(*AuthorizationStateWaitOtherDeviceConfirmation); ok {
+			return a.diff(path, b, changes)
+		}
+	case *AuthorizationStateWaitRegistration:
+		if b, ok := b.(*AuthorizationStateWaitRegistration); ok {
+			return a.diff(path, b, changes)
+		}
+	case *AuthorizationStateWaitPassword:
+		if b, ok := b.(*AuthorizationStateWaitPassword); ok {
+			return a.diff(path, b, changes)
+		}
+	case *AuthorizationStateReady:
+		if b, ok := b.(*AuthorizationStateReady); ok {
+			return a.diff(path, b, changes)
+		}
+	case *AuthorizationStateLoggingOut:
+		if b, ok := b.(*AuthorizationStateLoggingOut); ok {
+			return a.diff(path, b, changes)
+		}
+	case *AuthorizationStateClosing:
+		if b, ok := b.(*AuthorizationStateClosing); ok {
+			return a.diff(path, b, changes)
+		}
+	case *AuthorizationStateClosed:
+		if b, ok := b.(*AuthorizationStateClosed); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalAuthorizationState(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
+
 // GetAuthorizationState Returns the current authorization state; this is an offline request. For informational purposes only. Use updateAuthorizationState instead to maintain the current authorization state. Can be called before initialization
 func (client *Client) GetAuthorizationState() (AuthorizationState, error) {
 	result, err := client.SendAndCatch(UpdateData{
@@ -406,65 +1832,66 @@ func (client *Client) GetAuthorizationState() (AuthorizationState, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
-	switch AuthorizationStateEnum(result.Data["@type"].(string)) {
+	resultType, _ := result.Data["@type"].(string)
+	switch AuthorizationStateEnum(resultType) {
 
 	case AuthorizationStateWaitTdlibParametersType:
 		var authorizationState AuthorizationStateWaitTdlibParameters
-		err = json.Unmarshal(result.Raw, &authorizationState)
+		err = jsonUnmarshal(result.Raw, &authorizationState)
 		return &authorizationState, err
 
 	case AuthorizationStateWaitEncryptionKeyType:
 		var authorizationState AuthorizationStateWaitEncryptionKey
-		err = json.Unmarshal(result.Raw, &authorizationState)
+		err = jsonUnmarshal(result.Raw, &authorizationState)
 		return &authorizationState, err
 
 	case AuthorizationStateWaitPhoneNumberType:
 		var authorizationState AuthorizationStateWaitPhoneNumber
-		err = json.Unmarshal(result.Raw, &authorizationState)
+		err = jsonUnmarshal(result.Raw, &authorizationState)
 		return &authorizationState, err
 
 	case AuthorizationStateWaitCodeType:
 		var authorizationState AuthorizationStateWaitCode
-		err = json.Unmarshal(result.Raw, &authorizationState)
+		err = jsonUnmarshal(result.Raw, &authorizationState)
 		return &authorizationState, err
 
 	case AuthorizationStateWaitOtherDeviceConfirmationType:
 		var authorizationState AuthorizationStateWaitOtherDeviceConfirmation
-		err = json.Unmarshal(result.Raw, &authorizationState)
+		err = jsonUnmarshal(result.Raw, &authorizationState)
 		return &authorizationState, err
 
 	case AuthorizationStateWaitRegistrationType:
 		var authorizationState AuthorizationStateWaitRegistration
-		err = json.Unmarshal(result.Raw, &authorizationState)
+		err = jsonUnmarshal(result.Raw, &authorizationState)
 		return &authorizationState, err
 
 	case AuthorizationStateWaitPasswordType:
 		var authorizationState AuthorizationStateWaitPassword
-		err = json.Unmarshal(result.Raw, &authorizationState)
+		err = jsonUnmarshal(result.Raw, &authorizationState)
 		return &authorizationState, err
 
 	case AuthorizationStateReadyType:
 		var authorizationState AuthorizationStateReady
-		err = json.Unmarshal(result.Raw, &authorizationState)
+		err = jsonUnmarshal(result.Raw, &authorizationState)
 		return &authorizationState, err
 
 	case AuthorizationStateLoggingOutType:
 		var authorizationState AuthorizationStateLoggingOut
-		err = json.Unmarshal(result.Raw, &authorizationState)
+		err = jsonUnmarshal(result.Raw, &authorizationState)
 		return &authorizationState, err
 
 	case AuthorizationStateClosingType:
 		var authorizationState AuthorizationStateClosing
-		err = json.Unmarshal(result.Raw, &authorizationState)
+		err = jsonUnmarshal(result.Raw, &authorizationState)
 		return &authorizationState, err
 
 	case AuthorizationStateClosedType:
 		var authorizationState AuthorizationStateClosed
-		err = json.Unmarshal(result.Raw, &authorizationState)
+		err = jsonUnmarshal(result.Raw, &authorizationState)
 		return &authorizationState, err
 
 	default:
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // AutoDownloadSettings Contains auto-download settings
 type AutoDownloadSettings struct {
 	tdCommon
@@ -43,3 +48,182 @@ func NewAutoDownloadSettings(isAutoDownloadEnabled bool, maxPhotoFileSize int32,
 
 	return &autoDownloadSettingsTemp
 }
+
+// MarshalJSON marshals to json
+func (autoDownloadSettings *AutoDownloadSettings) MarshalJSON() ([]byte, error) {
+	return autoDownloadSettings.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (autoDownloadSettings *AutoDownloadSettings) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, autoDownloadSettings)
+}
+
+func (autoDownloadSettings *AutoDownloadSettings) appendJSON(b []byte) ([]byte, error) {
+	if autoDownloadSettings == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, autoDownloadSettings.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, autoDownloadSettings.tdCommon.Extra)
+	b = append(b, `,"is_auto_download_enabled":`...)
+	b = appendJSONBool(b, autoDownloadSettings.IsAutoDownloadEnabled)
+	b = append(b, `,"max_photo_file_size":`...)
+	b = appendJSONInt(b, int64(autoDownloadSettings.MaxPhotoFileSize))
+	b = append(b, `,"max_video_file_size":`...)
+	b = appendJSONInt(b, int64(autoDownloadSettings.MaxVideoFileSize))
+	b = append(b, `,"max_other_file_size":`...)
+	b = appendJSONInt(b, int64(autoDownloadSettings.MaxOtherFileSize))
+	b = append(b, `,"video_upload_bitrate":`...)
+	b = appendJSONInt(b, int64(autoDownloadSettings.VideoUploadBitrate))
+	b = append(b, `,"preload_large_videos":`...)
+	b = appendJSONBool(b, autoDownloadSettings.PreloadLargeVideos)
+	b = append(b, `,"preload_next_audio":`...)
+	b = appendJSONBool(b, autoDownloadSettings.PreloadNextAudio)
+	b = append(b, `,"use_less_data_for_calls":`...)
+	b = appendJSONBool(b, autoDownloadSettings.UseLessDataForCalls)
+	return append(b, '}'), nil
+}
+
+func (autoDownloadSettings *AutoDownloadSettings) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			autoDownloadSettings.tdCommon.Type, err = r.readString()
+		case "@extra":
+			autoDownloadSettings.tdCommon.Extra, err = r.readString()
+		case "is_auto_download_enabled":
+			autoDownloadSettings.IsAutoDownloadEnabled, err = r.readBool()
+		case "max_photo_file_size":
+			autoDownloadSettings.MaxPhotoFileSize, err = r.readInt32()
+		case "max_video_file_size":
+			autoDownloadSettings.MaxVideoFileSize, err = r.readInt32()
+		case "max_other_file_size":
+			autoDownloadSettings.MaxOtherFileSize, err = r.readInt32()
+		case "video_upload_bitrate":
+			autoDownloadSettings.VideoUploadBitrate, err = r.readInt32()
+		case "preload_large_videos":
+			autoDownloadSettings.PreloadLargeVideos, err = r.readBool()
+		case "preload_next_audio":
+			autoDownloadSettings.PreloadNextAudio, err = r.readBool()
+		case "use_less_data_for_calls":
+			autoDownloadSettings.UseLessDataForCalls, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (autoDownloadSettings *AutoDownloadSettings) Clone() *AutoDownloadSettings {
+	if autoDownloadSettings == nil {
+		return nil
+	}
+	clone := *autoDownloadSettings
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (autoDownloadSettings *AutoDownloadSettings) Equal(other *AutoDownloadSettings) bool {
+	if autoDownloadSettings == nil || other == nil {
+		return autoDownloadSettings == other
+	}
+	if autoDownloadSettings.IsAutoDownloadEnabled != other.IsAutoDownloadEnabled {
+		return false
+	}
+	if autoDownloadSettings.MaxPhotoFileSize != other.MaxPhotoFileSize {
+		return false
+	}
+	if autoDownloadSettings.MaxVideoFileSize != other.MaxVideoFileSize {
+		return false
+	}
+	if autoDownloadSettings.MaxOtherFileSize != other.MaxOtherFileSize {
+		return false
+	}
+	if autoDownloadSettings.VideoUploadBitrate != other.VideoUploadBitrate {
+		return false
+	}
+	if autoDownloadSettings.PreloadLargeVideos != other.PreloadLargeVideos {
+		return false
+	}
+	if autoDownloadSettings.PreloadNextAudio != other.PreloadNextAudio {
+		return false
+	}
+	if autoDownloadSettings.UseLessDataForCalls != other.UseLessDataForCalls {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (autoDownloadSettings *AutoDownloadSettings) Diff(other *AutoDownloadSettings) []Change {
+	return autoDownloadSettings.diff("", other, nil)
+}
+
+func (autoDownloadSettings *AutoDownloadSettings) diff(path string, other *AutoDownloadSettings, changes []Change) []Change {
+	if autoDownloadSettings == nil || other == nil {
+		if autoDownloadSettings != other {
+			changes = append(changes, Change{Path: path, Old: autoDownloadSettings, New: other})
+		}
+		return changes
+	}
+	if autoDownloadSettings.IsAutoDownloadEnabled != other.IsAutoDownloadEnabled {
+		changes = append(changes, Change{Path: fieldPath(path, "is_auto_download_enabled"), Old: autoDownloadSettings.IsAutoDownloadEnabled, New: other.IsAutoDownloadEnabled})
+	}
+	if autoDownloadSettings.MaxPhotoFileSize != other.MaxPhotoFileSize {
+		changes = append(changes, Change{Path: fieldPath(path, "max_photo_file_size"), Old: autoDownloadSettings.MaxPhotoFileSize, New: other.MaxPhotoFileSize})
+	}
+	if autoDownloadSettings.MaxVideoFileSize != other.MaxVideoFileSize {
+		changes = append(changes, Change{Path: fieldPath(path, "max_video_file_size"), Old: autoDownloadSettings.MaxVideoFileSize, New: other.MaxVideoFileSize})
+	}
+	if autoDownloadSettings.MaxOtherFileSize != other.MaxOtherFileSize {
+		changes = append(changes, Change{Path: fieldPath(path, "max_other_file_size"), Old: autoDownloadSettings.MaxOtherFileSize, New: other.MaxOtherFileSize})
+	}
+	if autoDownloadSettings.VideoUploadBitrate != other.VideoUploadBitrate {
+		changes = append(changes, Change{Path: fieldPath(path, "video_upload_bitrate"), Old: autoDownloadSettings.VideoUploadBitrate, New: other.VideoUploadBitrate})
+	}
+	if autoDownloadSettings.PreloadLargeVideos != other.PreloadLargeVideos {
+		changes = append(changes, Change{Path: fieldPath(path, "preload_large_videos"), Old: autoDownloadSettings.PreloadLargeVideos, New: other.PreloadLargeVideos})
+	}
+	if autoDownloadSettings.PreloadNextAudio != other.PreloadNextAudio {
+		changes = append(changes, Change{Path: fieldPath(path, "preload_next_audio"), Old: autoDownloadSettings.PreloadNextAudio, New: other.PreloadNextAudio})
+	}
+	if autoDownloadSettings.UseLessDataForCalls != other.UseLessDataForCalls {
+		changes = append(changes, Change{Path: fieldPath(path, "use_less_data_for_calls"), Old: autoDownloadSettings.UseLessDataForCalls, New: other.UseLessDataForCalls})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (autoDownloadSettings *AutoDownloadSettings) String() string {
+	return objectString(autoDownloadSettings)
+}
+
+// LogValue logs the object as its summary
+func (autoDownloadSettings *AutoDownloadSettings) LogValue() slog.Value {
+	return slog.StringValue(objectString(autoDownloadSettings))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (autoDownloadSettings *AutoDownloadSettings) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, autoDownloadSettings)
+}
+
+func (autoDownloadSettings *AutoDownloadSettings) printText(p *printer) {
+	if autoDownloadSettings == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("AutoDownloadSettings")
+	p.bool("is_auto_download_enabled", autoDownloadSettings.IsAutoDownloadEnabled)
+	p.int("max_photo_file_size", int64(autoDownloadSettings.MaxPhotoFileSize))
+	p.int("max_video_file_size", int64(autoDownloadSettings.MaxVideoFileSize))
+	p.int("max_other_file_size", int64(autoDownloadSettings.MaxOtherFileSize))
+	p.int("video_upload_bitrate", int64(autoDownloadSettings.VideoUploadBitrate))
+	p.bool("preload_large_videos", autoDownloadSettings.PreloadLargeVideos)
+	p.bool("preload_next_audio", autoDownloadSettings.PreloadNextAudio)
+	p.bool("use_less_data_for_calls", autoDownloadSettings.UseLessDataForCalls)
+	p.endObject(state)
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // AutoDownloadSettingsPresets Contains auto-download settings presets for the current user
@@ -34,6 +34,152 @@ func NewAutoDownloadSettingsPresets(low *AutoDownloadSettings, medium *AutoDownl
 	return &autoDownloadSettingsPresetsTemp
 }
 
+// MarshalJSON marshals to json
+func (autoDownloadSettingsPresets *AutoDownloadSettingsPresets) MarshalJSON() ([]byte, error) {
+	return autoDownloadSettingsPresets.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (autoDownloadSettingsPresets *AutoDownloadSettingsPresets) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, autoDownloadSettingsPresets)
+}
+
+func (autoDownloadSettingsPresets *AutoDownloadSettingsPresets) appendJSON(b []byte) ([]byte, error) {
+	if autoDownloadSettingsPresets == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, autoDownloadSettingsPresets.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, autoDownloadSettingsPresets.tdCommon.Extra)
+	b = append(b, `,"low":`...)
+	b, err = autoDownloadSettingsPresets.Low.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"medium":`...)
+	b, err = autoDownloadSettingsPresets.Medium.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"high":`...)
+	b, err = autoDownloadSettingsPresets.High.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (autoDownloadSettingsPresets *AutoDownloadSettingsPresets) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			autoDownloadSettingsPresets.tdCommon.Type, err = r.readString()
+		case "@extra":
+			autoDownloadSettingsPresets.tdCommon.Extra, err = r.readString()
+		case "low":
+			if r.readNull() {
+				autoDownloadSettingsPresets.Low = nil
+			} else {
+				autoDownloadSettingsPresets.Low = new(AutoDownloadSettings)
+				err = autoDownloadSettingsPresets.Low.decodeJSON(r)
+			}
+		case "medium":
+			if r.readNull() {
+				autoDownloadSettingsPresets.Medium = nil
+			} else {
+				autoDownloadSettingsPresets.Medium = new(AutoDownloadSettings)
+				err = autoDownloadSettingsPresets.Medium.decodeJSON(r)
+			}
+		case "high":
+			if r.readNull() {
+				autoDownloadSettingsPresets.High = nil
+			} else {
+				autoDownloadSettingsPresets.High = new(AutoDownloadSettings)
+				err = autoDownloadSettingsPresets.High.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (autoDownloadSettingsPresets *AutoDownloadSettingsPresets) Clone() *AutoDownloadSettingsPresets {
+	if autoDownloadSettingsPresets == nil {
+		return nil
+	}
+	clone := *autoDownloadSettingsPresets
+	clone.Low = autoDownloadSettingsPresets.Low.Clone()
+	clone.Medium = autoDownloadSettingsPresets.Medium.Clone()
+	clone.High = autoDownloadSettingsPresets.High.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (autoDownloadSettingsPresets *AutoDownloadSettingsPresets) Equal(other *AutoDownloadSettingsPresets) bool {
+	if autoDownloadSettingsPresets == nil || other == nil {
+		return autoDownloadSettingsPresets == other
+	}
+	if !autoDownloadSettingsPresets.Low.Equal(other.Low) {
+		return false
+	}
+	if !autoDownloadSettingsPresets.Medium.Equal(other.Medium) {
+		return false
+	}
+	if !autoDownloadSettingsPresets.High.Equal(other.High) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (autoDownloadSettingsPresets *AutoDownloadSettingsPresets) Diff(other *AutoDownloadSettingsPresets) []Change {
+	return autoDownloadSettingsPresets.diff("", other, nil)
+}
+
+func (autoDownloadSettingsPresets *AutoDownloadSettingsPresets) diff(path string, other *AutoDownloadSettingsPresets, changes []Change) []Change {
+	if autoDownloadSettingsPresets == nil || other == nil {
+		if autoDownloadSettingsPresets != other {
+			changes = append(changes, Change{Path: path, Old: autoDownloadSettingsPresets, New: other})
+		}
+		return changes
+	}
+	changes = autoDownloadSettingsPresets.Low.diff(fieldPath(path, "low"), other.Low, changes)
+	changes = autoDownloadSettingsPresets.Medium.diff(fieldPath(path, "medium"), other.Medium, changes)
+	changes = autoDownloadSettingsPresets.High.diff(fieldPath(path, "high"), other.High, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (autoDownloadSettingsPresets *AutoDownloadSettingsPresets) String() string {
+	return objectString(autoDownloadSettingsPresets)
+}
+
+// LogValue logs the object as its summary
+func (autoDownloadSettingsPresets *AutoDownloadSettingsPresets) LogValue() slog.Value {
+	return slog.StringValue(objectString(autoDownloadSettingsPresets))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (autoDownloadSettingsPresets *AutoDownloadSettingsPresets) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, autoDownloadSettingsPresets)
+}
+
+func (autoDownloadSettingsPresets *AutoDownloadSettingsPresets) printText(p *printer) {
+	if autoDownloadSettingsPresets == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("AutoDownloadSettingsPresets")
+	p.object("low", autoDownloadSettingsPresets.Low)
+	p.object("medium", autoDownloadSettingsPresets.Medium)
+	p.object("high", autoDownloadSettingsPresets.High)
+	p.endObject(state)
+}
+
 // GetAutoDownloadSettingsPresets Returns auto-download settings presets for the current user
 func (client *Client) GetAutoDownloadSettingsPresets() (*AutoDownloadSettingsPresets, error) {
 	result, err := client.SendAndCatch(UpdateData{
@@ -44,11 +190,11 @@ func (client *Client) GetAutoDownloadSettingsPresets() (*AutoDownloadSettingsPre
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var autoDownloadSettingsPresets AutoDownloadSettingsPresets
-	err = json.Unmarshal(result.Raw, &autoDownloadSettingsPresets)
+	err = jsonUnmarshal(result.Raw, &autoDownloadSettingsPresets)
 	return &autoDownloadSettingsPresets, err
 }
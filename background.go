@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // Background Describes a chat background
@@ -43,38 +43,171 @@ func NewBackground(id JSONInt64, isDefault bool, isDark bool, name string, docum
 	return &backgroundTemp
 }
 
+// MarshalJSON marshals to json
+func (background *Background) MarshalJSON() ([]byte, error) {
+	return background.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (background *Background) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, background)
+}
+
+func (background *Background) appendJSON(b []byte) ([]byte, error) {
+	if background == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, background.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, background.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt64String(b, int64(background.Id))
+	b = append(b, `,"is_default":`...)
+	b = appendJSONBool(b, background.IsDefault)
+	b = append(b, `,"is_dark":`...)
+	b = appendJSONBool(b, background.IsDark)
+	b = append(b, `,"name":`...)
+	b = appendJSONString(b, background.Name)
+	b = append(b, `,"document":`...)
+	b, err = background.Document.appendJSON(b)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Id        JSONInt64 `json:"id"`         // Unique background identifier
-		IsDefault bool      `json:"is_default"` // True, if this is one of default backgrounds
-		IsDark    bool      `json:"is_dark"`    // True, if the background is dark and is recommended to be used with dark theme
-		Name      string    `json:"name"`       // Unique background name
-		Document  *Document `json:"document"`   // Document with the background; may be null. Null only for filled backgrounds
-
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"type":`...)
+	b, err = appendJSONValue(b, background.Type)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (background *Background) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			background.tdCommon.Type, err = r.readString()
+		case "@extra":
+			background.tdCommon.Extra, err = r.readString()
+		case "id":
+			background.Id, err = r.readJSONInt64()
+		case "is_default":
+			background.IsDefault, err = r.readBool()
+		case "is_dark":
+			background.IsDark, err = r.readBool()
+		case "name":
+			background.Name, err = r.readString()
+		case "document":
+			if r.readNull() {
+				background.Document = nil
+			} else {
+				background.Document = new(Document)
+				err = background.Document.decodeJSON(r)
+			}
+		case "type":
+			background.Type, err = decodeBackgroundType(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (background *Background) Clone() *Background {
+	if background == nil {
+		return nil
+	}
+	clone := *background
+	clone.Document = background.Document.Clone()
+	clone.Type = cloneBackgroundType(background.Type)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (background *Background) Equal(other *Background) bool {
+	if background == nil || other == nil {
+		return background == other
+	}
+	if background.Id != other.Id {
+		return false
+	}
+	if background.IsDefault != other.IsDefault {
+		return false
 	}
+	if background.IsDark != other.IsDark {
+		return false
+	}
+	if background.Name != other.Name {
+		return false
+	}
+	if !background.Document.Equal(other.Document) {
+		return false
+	}
+	if !equalBackgroundType(background.Type, other.Type) {
+		return false
+	}
+	return true
+}
 
-	background.tdCommon = tempObj.tdCommon
-	background.Id = tempObj.Id
-	background.IsDefault = tempObj.IsDefault
-	background.IsDark = tempObj.IsDark
-	background.Name = tempObj.Name
-	background.Document = tempObj.Document
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (background *Background) Diff(other *Background) []Change {
+	return background.diff("", other, nil)
+}
 
-	fieldType, _ := unmarshalBackgroundType(objMap["type"])
-	background.Type = fieldType
+func (background *Background) diff(path string, other *Background, changes []Change) []Change {
+	if background == nil || other == nil {
+		if background != other {
+			changes = append(changes, Change{Path: path, Old: background, New: other})
+		}
+		return changes
+	}
+	if background.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: background.Id, New: other.Id})
+	}
+	if background.IsDefault != other.IsDefault {
+		changes = append(changes, Change{Path: fieldPath(path, "is_default"), Old: background.IsDefault, New: other.IsDefault})
+	}
+	if background.IsDark != other.IsDark {
+		changes = append(changes, Change{Path: fieldPath(path, "is_dark"), Old: background.IsDark, New: other.IsDark})
+	}
+	if background.Name != other.Name {
+		changes = append(changes, Change{Path: fieldPath(path, "name"), Old: background.Name, New: other.Name})
+	}
+	changes = background.Document.diff(fieldPath(path, "document"), other.Document, changes)
+	changes = diffBackgroundType(fieldPath(path, "type"), background.Type, other.Type, changes)
+	return changes
+}
 
-	return nil
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (background *Background) String() string {
+	return objectString(background)
+}
+
+// LogValue logs the object as its summary
+func (background *Background) LogValue() slog.Value {
+	return slog.StringValue(objectString(background))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (background *Background) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, background)
+}
+
+func (background *Background) printText(p *printer) {
+	if background == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Background")
+	p.int("id", int64(background.Id))
+	p.bool("is_default", background.IsDefault)
+	p.bool("is_dark", background.IsDark)
+	p.string("name", background.Name)
+	p.object("document", background.Document)
+	p.object("type", background.Type)
+	p.endObject(state)
 }
 
 // SearchBackground Searches for a background by its name
@@ -89,12 +222,12 @@ func (client *Client) SearchBackground(name string) (*Background, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var background Background
-	err = json.Unmarshal(result.Raw, &background)
+	err = jsonUnmarshal(result.Raw, &background)
 	return &background, err
 }
 
@@ -114,11 +247,11 @@ func (client *Client) SetBackground(background InputBackground, typeParam Backgr
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var backgroundDummy Background
-	err = json.Unmarshal(result.Raw, &backgroundDummy)
+	err = jsonUnmarshal(result.Raw, &backgroundDummy)
 	return &backgroundDummy, err
 }
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // BackgroundFill Describes a fill of a background
 type BackgroundFill interface {
 	GetBackgroundFillEnum() BackgroundFillEnum
+	Accept(visitor BackgroundFillVisitor)
 }
 
 // BackgroundFillEnum Alias for abstract BackgroundFill 'Sub-Classes', used as constant-enum here
@@ -20,36 +22,87 @@ const (
 	BackgroundFillFreeformGradientType BackgroundFillEnum = "backgroundFillFreeformGradient"
 )
 
+// UnknownBackgroundFill is a BackgroundFill of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownBackgroundFill struct {
+	Unknown
+}
+
+// GetBackgroundFillEnum return the enum type of this object
+func (unknownBackgroundFill *UnknownBackgroundFill) GetBackgroundFillEnum() BackgroundFillEnum {
+	return BackgroundFillEnum(unknownBackgroundFill.Type)
+}
+
+// Accept calls the method of the visitor for UnknownBackgroundFill
+func (unknownBackgroundFill *UnknownBackgroundFill) Accept(visitor BackgroundFillVisitor) {
+	visitor.VisitUnknownBackgroundFill(unknownBackgroundFill)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownBackgroundFill *UnknownBackgroundFill) String() string {
+	return objectString(unknownBackgroundFill)
+}
+
+// LogValue logs the object as its summary
+func (unknownBackgroundFill *UnknownBackgroundFill) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownBackgroundFill))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownBackgroundFill *UnknownBackgroundFill) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownBackgroundFill)
+}
+
+func (unknownBackgroundFill *UnknownBackgroundFill) printText(p *printer) {
+	if unknownBackgroundFill == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownBackgroundFill")
+	p.string("@type", unknownBackgroundFill.Type)
+	p.endObject(state)
+}
+
 func unmarshalBackgroundFill(rawMsg *json.RawMessage) (BackgroundFill, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch BackgroundFillEnum(objMap["@type"].(string)) {
+	switch BackgroundFillEnum(typeName) {
 	case BackgroundFillSolidType:
 		var backgroundFillSolid BackgroundFillSolid
-		err := json.Unmarshal(*rawMsg, &backgroundFillSolid)
+		err := jsonUnmarshal(*rawMsg, &backgroundFillSolid)
 		return &backgroundFillSolid, err
 
 	case BackgroundFillGradientType:
 		var backgroundFillGradient BackgroundFillGradient
-		err := json.Unmarshal(*rawMsg, &backgroundFillGradient)
+		err := jsonUnmarshal(*rawMsg, &backgroundFillGradient)
 		return &backgroundFillGradient, err
 
 	case BackgroundFillFreeformGradientType:
 		var backgroundFillFreeformGradient BackgroundFillFreeformGradient
-		err := json.Unmarshal(*rawMsg, &backgroundFillFreeformGradient)
+		err := jsonUnmarshal(*rawMsg, &backgroundFillFreeformGradient)
 		return &backgroundFillFreeformGradient, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownBackgroundFill{Unknown: unknown}, nil
+	}
+}
+
+func decodeBackgroundFill(r *jsonReader) (BackgroundFill, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
 	}
+	return unmarshalBackgroundFill(rawMsg)
 }
 
 // BackgroundFillSolid Describes a solid fill of a background
@@ -75,11 +128,118 @@ func NewBackgroundFillSolid(color int32) *BackgroundFillSolid {
 	return &backgroundFillSolidTemp
 }
 
+// MarshalJSON marshals to json
+func (backgroundFillSolid *BackgroundFillSolid) MarshalJSON() ([]byte, error) {
+	return backgroundFillSolid.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (backgroundFillSolid *BackgroundFillSolid) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, backgroundFillSolid)
+}
+
+func (backgroundFillSolid *BackgroundFillSolid) appendJSON(b []byte) ([]byte, error) {
+	if backgroundFillSolid == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, backgroundFillSolid.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, backgroundFillSolid.tdCommon.Extra)
+	b = append(b, `,"color":`...)
+	b = appendJSONInt(b, int64(backgroundFillSolid.Color))
+	return append(b, '}'), nil
+}
+
+func (backgroundFillSolid *BackgroundFillSolid) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			backgroundFillSolid.tdCommon.Type, err = r.readString()
+		case "@extra":
+			backgroundFillSolid.tdCommon.Extra, err = r.readString()
+		case "color":
+			backgroundFillSolid.Color, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (backgroundFillSolid *BackgroundFillSolid) Clone() *BackgroundFillSolid {
+	if backgroundFillSolid == nil {
+		return nil
+	}
+	clone := *backgroundFillSolid
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (backgroundFillSolid *BackgroundFillSolid) Equal(other *BackgroundFillSolid) bool {
+	if backgroundFillSolid == nil || other == nil {
+		return backgroundFillSolid == other
+	}
+	if backgroundFillSolid.Color != other.Color {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (backgroundFillSolid *BackgroundFillSolid) Diff(other *BackgroundFillSolid) []Change {
+	return backgroundFillSolid.diff("", other, nil)
+}
+
+func (backgroundFillSolid *BackgroundFillSolid) diff(path string, other *BackgroundFillSolid, changes []Change) []Change {
+	if backgroundFillSolid == nil || other == nil {
+		if backgroundFillSolid != other {
+			changes = append(changes, Change{Path: path, Old: backgroundFillSolid, New: other})
+		}
+		return changes
+	}
+	if backgroundFillSolid.Color != other.Color {
+		changes = append(changes, Change{Path: fieldPath(path, "color"), Old: backgroundFillSolid.Color, New: other.Color})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (backgroundFillSolid *BackgroundFillSolid) String() string {
+	return objectString(backgroundFillSolid)
+}
+
+// LogValue logs the object as its summary
+func (backgroundFillSolid *BackgroundFillSolid) LogValue() slog.Value {
+	return slog.StringValue(objectString(backgroundFillSolid))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (backgroundFillSolid *BackgroundFillSolid) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, backgroundFillSolid)
+}
+
+func (backgroundFillSolid *BackgroundFillSolid) printText(p *printer) {
+	if backgroundFillSolid == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("BackgroundFillSolid")
+	p.int("color", int64(backgroundFillSolid.Color))
+	p.endObject(state)
+}
+
 // GetBackgroundFillEnum return the enum type of this object
 func (backgroundFillSolid *BackgroundFillSolid) GetBackgroundFillEnum() BackgroundFillEnum {
 	return BackgroundFillSolidType
 }
 
+// Accept calls the method of the visitor for BackgroundFillSolid
+func (backgroundFillSolid *BackgroundFillSolid) Accept(visitor BackgroundFillVisitor) {
+	visitor.VisitBackgroundFillSolid(backgroundFillSolid)
+}
+
 // BackgroundFillGradient Describes a gradient fill of a background
 type BackgroundFillGradient struct {
 	tdCommon
@@ -109,11 +269,140 @@ func NewBackgroundFillGradient(topColor int32, bottomColor int32, rotationAngle
 	return &backgroundFillGradientTemp
 }
 
+// MarshalJSON marshals to json
+func (backgroundFillGradient *BackgroundFillGradient) MarshalJSON() ([]byte, error) {
+	return backgroundFillGradient.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (backgroundFillGradient *BackgroundFillGradient) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, backgroundFillGradient)
+}
+
+func (backgroundFillGradient *BackgroundFillGradient) appendJSON(b []byte) ([]byte, error) {
+	if backgroundFillGradient == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, backgroundFillGradient.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, backgroundFillGradient.tdCommon.Extra)
+	b = append(b, `,"top_color":`...)
+	b = appendJSONInt(b, int64(backgroundFillGradient.TopColor))
+	b = append(b, `,"bottom_color":`...)
+	b = appendJSONInt(b, int64(backgroundFillGradient.BottomColor))
+	b = append(b, `,"rotation_angle":`...)
+	b = appendJSONInt(b, int64(backgroundFillGradient.RotationAngle))
+	return append(b, '}'), nil
+}
+
+func (backgroundFillGradient *BackgroundFillGradient) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			backgroundFillGradient.tdCommon.Type, err = r.readString()
+		case "@extra":
+			backgroundFillGradient.tdCommon.Extra, err = r.readString()
+		case "top_color":
+			backgroundFillGradient.TopColor, err = r.readInt32()
+		case "bottom_color":
+			backgroundFillGradient.BottomColor, err = r.readInt32()
+		case "rotation_angle":
+			backgroundFillGradient.RotationAngle, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (backgroundFillGradient *BackgroundFillGradient) Clone() *BackgroundFillGradient {
+	if backgroundFillGradient == nil {
+		return nil
+	}
+	clone := *backgroundFillGradient
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (backgroundFillGradient *BackgroundFillGradient) Equal(other *BackgroundFillGradient) bool {
+	if backgroundFillGradient == nil || other == nil {
+		return backgroundFillGradient == other
+	}
+	if backgroundFillGradient.TopColor != other.TopColor {
+		return false
+	}
+	if backgroundFillGradient.BottomColor != other.BottomColor {
+		return false
+	}
+	if backgroundFillGradient.RotationAngle != other.RotationAngle {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (backgroundFillGradient *BackgroundFillGradient) Diff(other *BackgroundFillGradient) []Change {
+	return backgroundFillGradient.diff("", other, nil)
+}
+
+func (backgroundFillGradient *BackgroundFillGradient) diff(path string, other *BackgroundFillGradient, changes []Change) []Change {
+	if backgroundFillGradient == nil || other == nil {
+		if backgroundFillGradient != other {
+			changes = append(changes, Change{Path: path, Old: backgroundFillGradient, New: other})
+		}
+		return changes
+	}
+	if backgroundFillGradient.TopColor != other.TopColor {
+		changes = append(changes, Change{Path: fieldPath(path, "top_color"), Old: backgroundFillGradient.TopColor, New: other.TopColor})
+	}
+	if backgroundFillGradient.BottomColor != other.BottomColor {
+		changes = append(changes, Change{Path: fieldPath(path, "bottom_color"), Old: backgroundFillGradient.BottomColor, New: other.BottomColor})
+	}
+	if backgroundFillGradient.RotationAngle != other.RotationAngle {
+		changes = append(changes, Change{Path: fieldPath(path, "rotation_angle"), Old: backgroundFillGradient.RotationAngle, New: other.RotationAngle})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (backgroundFillGradient *BackgroundFillGradient) String() string {
+	return objectString(backgroundFillGradient)
+}
+
+// LogValue logs the object as its summary
+func (backgroundFillGradient *BackgroundFillGradient) LogValue() slog.Value {
+	return slog.StringValue(objectString(backgroundFillGradient))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (backgroundFillGradient *BackgroundFillGradient) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, backgroundFillGradient)
+}
+
+func (backgroundFillGradient *BackgroundFillGradient) printText(p *printer) {
+	if backgroundFillGradient == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("BackgroundFillGradient")
+	p.int("top_color", int64(backgroundFillGradient.TopColor))
+	p.int("bottom_color", int64(backgroundFillGradient.BottomColor))
+	p.int("rotation_angle", int64(backgroundFillGradient.RotationAngle))
+	p.endObject(state)
+}
+
 // GetBackgroundFillEnum return the enum type of this object
 func (backgroundFillGradient *BackgroundFillGradient) GetBackgroundFillEnum() BackgroundFillEnum {
 	return BackgroundFillGradientType
 }
 
+// Accept calls the method of the visitor for BackgroundFillGradient
+func (backgroundFillGradient *BackgroundFillGradient) Accept(visitor BackgroundFillVisitor) {
+	visitor.VisitBackgroundFillGradient(backgroundFillGradient)
+}
+
 // BackgroundFillFreeformGradient Describes a freeform gradient fill of a background
 type BackgroundFillFreeformGradient struct {
 	tdCommon
@@ -137,7 +426,262 @@ func NewBackgroundFillFreeformGradient(colors []int32) *BackgroundFillFreeformGr
 	return &backgroundFillFreeformGradientTemp
 }
 
+// MarshalJSON marshals to json
+func (backgroundFillFreeformGradient *BackgroundFillFreeformGradient) MarshalJSON() ([]byte, error) {
+	return backgroundFillFreeformGradient.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (backgroundFillFreeformGradient *BackgroundFillFreeformGradient) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, backgroundFillFreeformGradient)
+}
+
+func (backgroundFillFreeformGradient *BackgroundFillFreeformGradient) appendJSON(b []byte) ([]byte, error) {
+	if backgroundFillFreeformGradient == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, backgroundFillFreeformGradient.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, backgroundFillFreeformGradient.tdCommon.Extra)
+	b = append(b, `,"colors":`...)
+	if backgroundFillFreeformGradient.Colors == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range backgroundFillFreeformGradient.Colors {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(backgroundFillFreeformGradient.Colors[i0]))
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (backgroundFillFreeformGradient *BackgroundFillFreeformGradient) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			backgroundFillFreeformGradient.tdCommon.Type, err = r.readString()
+		case "@extra":
+			backgroundFillFreeformGradient.tdCommon.Extra, err = r.readString()
+		case "colors":
+			if r.readNull() {
+				backgroundFillFreeformGradient.Colors = nil
+			} else {
+				backgroundFillFreeformGradient.Colors = make([]int32, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int32
+					item0, err = r.readInt32()
+					backgroundFillFreeformGradient.Colors = append(backgroundFillFreeformGradient.Colors, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (backgroundFillFreeformGradient *BackgroundFillFreeformGradient) Clone() *BackgroundFillFreeformGradient {
+	if backgroundFillFreeformGradient == nil {
+		return nil
+	}
+	clone := *backgroundFillFreeformGradient
+	if backgroundFillFreeformGradient.Colors != nil {
+		clone.Colors = make([]int32, len(backgroundFillFreeformGradient.Colors))
+		copy(clone.Colors, backgroundFillFreeformGradient.Colors)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (backgroundFillFreeformGradient *BackgroundFillFreeformGradient) Equal(other *BackgroundFillFreeformGradient) bool {
+	if backgroundFillFreeformGradient == nil || other == nil {
+		return backgroundFillFreeformGradient == other
+	}
+	if len(backgroundFillFreeformGradient.Colors) != len(other.Colors) {
+		return false
+	}
+	for i0 := range backgroundFillFreeformGradient.Colors {
+		if backgroundFillFreeformGradient.Colors[i0] != other.Colors[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (backgroundFillFreeformGradient *BackgroundFillFreeformGradient) Diff(other *BackgroundFillFreeformGradient) []Change {
+	return backgroundFillFreeformGradient.diff("", other, nil)
+}
+
+func (backgroundFillFreeformGradient *BackgroundFillFreeformGradient) diff(path string, other *BackgroundFillFreeformGradient, changes []Change) []Change {
+	if backgroundFillFreeformGradient == nil || other == nil {
+		if backgroundFillFreeformGradient != other {
+			changes = append(changes, Change{Path: path, Old: backgroundFillFreeformGradient, New: other})
+		}
+		return changes
+	}
+	if len(backgroundFillFreeformGradient.Colors) != len(other.Colors) {
+		changes = append(changes, Change{Path: fieldPath(path, "colors"), Old: backgroundFillFreeformGradient.Colors, New: other.Colors})
+	} else {
+		for i0 := range backgroundFillFreeformGradient.Colors {
+			if backgroundFillFreeformGradient.Colors[i0] != other.Colors[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "colors"), i0), Old: backgroundFillFreeformGradient.Colors[i0], New: other.Colors[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (backgroundFillFreeformGradient *BackgroundFillFreeformGradient) String() string {
+	return objectString(backgroundFillFreeformGradient)
+}
+
+// LogValue logs the object as its summary
+func (backgroundFillFreeformGradient *BackgroundFillFreeformGradient) LogValue() slog.Value {
+	return slog.StringValue(objectString(backgroundFillFreeformGradient))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (backgroundFillFreeformGradient *BackgroundFillFreeformGradient) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, backgroundFillFreeformGradient)
+}
+
+func (backgroundFillFreeformGradient *BackgroundFillFreeformGradient) printText(p *printer) {
+	if backgroundFillFreeformGradient == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("BackgroundFillFreeformGradient")
+	p.vector("colors", len(backgroundFillFreeformGradient.Colors), func(i0 int) {
+		p.int("colors", int64(backgroundFillFreeformGradient.Colors[i0]))
+	})
+	p.endObject(state)
+}
+
 // GetBackgroundFillEnum return the enum type of this object
 func (backgroundFillFreeformGradient *BackgroundFillFreeformGradient) GetBackgroundFillEnum() BackgroundFillEnum {
 	return BackgroundFillFreeformGradientType
 }
+
+// Accept calls the method of the visitor for BackgroundFillFreeformGradient
+func (backgroundFillFreeformGradient *BackgroundFillFreeformGradient) Accept(visitor BackgroundFillVisitor) {
+	visitor.VisitBackgroundFillFreeformGradient(backgroundFillFreeformGradient)
+}
+
+// BackgroundFillVisitor visits every type of BackgroundFill, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to BackgroundFill, until they handle it too.
+type BackgroundFillVisitor interface {
+	VisitBackgroundFillSolid(backgroundFillSolid *BackgroundFillSolid)
+	VisitBackgroundFillGradient(backgroundFillGradient *BackgroundFillGradient)
+	VisitBackgroundFillFreeformGradient(backgroundFillFreeformGradient *BackgroundFillFreeformGradient)
+	VisitUnknownBackgroundFill(unknownBackgroundFill *UnknownBackgroundFill)
+}
+
+// BackgroundFillCases holds the function MatchBackgroundFill calls for each type of BackgroundFill;
+// types without a function are passed to the default case
+type BackgroundFillCases[R any] struct {
+	BackgroundFillSolid            func(backgroundFillSolid *BackgroundFillSolid) R
+	BackgroundFillGradient         func(backgroundFillGradient *BackgroundFillGradient) R
+	BackgroundFillFreeformGradient func(backgroundFillFreeformGradient *BackgroundFillFreeformGradient) R
+}
+
+// MatchBackgroundFill calls the case of the type of backgroundFill, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement BackgroundFillVisitor
+// to have the compiler check every type is handled.
+func MatchBackgroundFill[R any](backgroundFill BackgroundFill, cases BackgroundFillCases[R], defaultCase func(backgroundFill BackgroundFill) R) R {
+	switch value := backgroundFill.(type) {
+	case *BackgroundFillSolid:
+		if cases.BackgroundFillSolid != nil {
+			return cases.BackgroundFillSolid(value)
+		}
+	case *BackgroundFillGradient:
+		if cases.BackgroundFillGradient != nil {
+			return cases.BackgroundFillGradient(value)
+		}
+	case *BackgroundFillFreeformGradient:
+		if cases.BackgroundFillFreeformGradient != nil {
+			return cases.BackgroundFillFreeformGradient(value)
+		}
+	}
+	return defaultCase(backgroundFill)
+}
+
+// Clone returns a deep copy of the object
+func (unknownBackgroundFill *UnknownBackgroundFill) Clone() *UnknownBackgroundFill {
+	if unknownBackgroundFill == nil {
+		return nil
+	}
+	return &UnknownBackgroundFill{Unknown: unknownBackgroundFill.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownBackgroundFill *UnknownBackgroundFill) Equal(other *UnknownBackgroundFill) bool {
+	if unknownBackgroundFill == nil || other == nil {
+		return unknownBackgroundFill == other
+	}
+	return unknownBackgroundFill.Unknown.equal(other.Unknown)
+}
+
+func cloneBackgroundFill(backgroundFill BackgroundFill) BackgroundFill {
+	switch value := backgroundFill.(type) {
+	case *BackgroundFillSolid:
+		return value.Clone()
+	case *BackgroundFillGradient:
+		return value.Clone()
+	case *BackgroundFillFreeformGradient:
+		return value.Clone()
+	case *UnknownBackgroundFill:
+		return value.Clone()
+	}
+	return backgroundFill
+}
+
+func equalBackgroundFill(a BackgroundFill, b BackgroundFill) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *BackgroundFillSolid:
+		b, ok := b.(*BackgroundFillSolid)
+		return ok && a.Equal(b)
+	case *BackgroundFillGradient:
+		b, ok := b.(*BackgroundFillGradient)
+		return ok && a.Equal(b)
+	case *BackgroundFillFreeformGradient:
+		b, ok := b.(*BackgroundFillFreeformGradient)
+		return ok && a.Equal(b)
+	case *UnknownBackgroundFill:
+		b, ok := b.(*UnknownBackgroundFill)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffBackgroundFill(path string, a BackgroundFill, b BackgroundFill, changes []Change) []Change {
+	switch a := a.(type) {
+	case *BackgroundFillSolid:
+		if b, ok := b.(*BackgroundFillSolid); ok {
+			return a.diff(path, b, changes)
+		}
+	case *BackgroundFillGradient:
+		if b, ok := b.(*BackgroundFillGradient); ok {
+			return a.diff(path, b, changes)
+		}
+	case *BackgroundFillFreeformGradient:
+		if b, ok := b.(*BackgroundFillFreeformGradient); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalBackgroundFill(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
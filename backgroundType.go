@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // BackgroundType Describes the type of a background
 type BackgroundType interface {
 	GetBackgroundTypeEnum() BackgroundTypeEnum
+	Accept(visitor BackgroundTypeVisitor)
 }
 
 // BackgroundTypeEnum Alias for abstract BackgroundType 'Sub-Classes', used as constant-enum here
@@ -20,36 +22,87 @@ const (
 	BackgroundTypeFillType      BackgroundTypeEnum = "backgroundTypeFill"
 )
 
+// UnknownBackgroundType is a BackgroundType of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownBackgroundType struct {
+	Unknown
+}
+
+// GetBackgroundTypeEnum return the enum type of this object
+func (unknownBackgroundType *UnknownBackgroundType) GetBackgroundTypeEnum() BackgroundTypeEnum {
+	return BackgroundTypeEnum(unknownBackgroundType.Type)
+}
+
+// Accept calls the method of the visitor for UnknownBackgroundType
+func (unknownBackgroundType *UnknownBackgroundType) Accept(visitor BackgroundTypeVisitor) {
+	visitor.VisitUnknownBackgroundType(unknownBackgroundType)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownBackgroundType *UnknownBackgroundType) String() string {
+	return objectString(unknownBackgroundType)
+}
+
+// LogValue logs the object as its summary
+func (unknownBackgroundType *UnknownBackgroundType) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownBackgroundType))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownBackgroundType *UnknownBackgroundType) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownBackgroundType)
+}
+
+func (unknownBackgroundType *UnknownBackgroundType) printText(p *printer) {
+	if unknownBackgroundType == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownBackgroundType")
+	p.string("@type", unknownBackgroundType.Type)
+	p.endObject(state)
+}
+
 func unmarshalBackgroundType(rawMsg *json.RawMessage) (BackgroundType, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch BackgroundTypeEnum(objMap["@type"].(string)) {
+	switch BackgroundTypeEnum(typeName) {
 	case BackgroundTypeWallpaperType:
 		var backgroundTypeWallpaper BackgroundTypeWallpaper
-		err := json.Unmarshal(*rawMsg, &backgroundTypeWallpaper)
+		err := jsonUnmarshal(*rawMsg, &backgroundTypeWallpaper)
 		return &backgroundTypeWallpaper, err
 
 	case BackgroundTypePatternType:
 		var backgroundTypePattern BackgroundTypePattern
-		err := json.Unmarshal(*rawMsg, &backgroundTypePattern)
+		err := jsonUnmarshal(*rawMsg, &backgroundTypePattern)
 		return &backgroundTypePattern, err
 
 	case BackgroundTypeFillType:
 		var backgroundTypeFill BackgroundTypeFill
-		err := json.Unmarshal(*rawMsg, &backgroundTypeFill)
+		err := jsonUnmarshal(*rawMsg, &backgroundTypeFill)
 		return &backgroundTypeFill, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownBackgroundType{Unknown: unknown}, nil
+	}
+}
+
+func decodeBackgroundType(r *jsonReader) (BackgroundType, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
 	}
+	return unmarshalBackgroundType(rawMsg)
 }
 
 // BackgroundTypeWallpaper A wallpaper in JPEG format
@@ -78,11 +131,129 @@ func NewBackgroundTypeWallpaper(isBlurred bool, isMoving bool) *BackgroundTypeWa
 	return &backgroundTypeWallpaperTemp
 }
 
+// MarshalJSON marshals to json
+func (backgroundTypeWallpaper *BackgroundTypeWallpaper) MarshalJSON() ([]byte, error) {
+	return backgroundTypeWallpaper.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (backgroundTypeWallpaper *BackgroundTypeWallpaper) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, backgroundTypeWallpaper)
+}
+
+func (backgroundTypeWallpaper *BackgroundTypeWallpaper) appendJSON(b []byte) ([]byte, error) {
+	if backgroundTypeWallpaper == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, backgroundTypeWallpaper.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, backgroundTypeWallpaper.tdCommon.Extra)
+	b = append(b, `,"is_blurred":`...)
+	b = appendJSONBool(b, backgroundTypeWallpaper.IsBlurred)
+	b = append(b, `,"is_moving":`...)
+	b = appendJSONBool(b, backgroundTypeWallpaper.IsMoving)
+	return append(b, '}'), nil
+}
+
+func (backgroundTypeWallpaper *BackgroundTypeWallpaper) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			backgroundTypeWallpaper.tdCommon.Type, err = r.readString()
+		case "@extra":
+			backgroundTypeWallpaper.tdCommon.Extra, err = r.readString()
+		case "is_blurred":
+			backgroundTypeWallpaper.IsBlurred, err = r.readBool()
+		case "is_moving":
+			backgroundTypeWallpaper.IsMoving, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (backgroundTypeWallpaper *BackgroundTypeWallpaper) Clone() *BackgroundTypeWallpaper {
+	if backgroundTypeWallpaper == nil {
+		return nil
+	}
+	clone := *backgroundTypeWallpaper
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (backgroundTypeWallpaper *BackgroundTypeWallpaper) Equal(other *BackgroundTypeWallpaper) bool {
+	if backgroundTypeWallpaper == nil || other == nil {
+		return backgroundTypeWallpaper == other
+	}
+	if backgroundTypeWallpaper.IsBlurred != other.IsBlurred {
+		return false
+	}
+	if backgroundTypeWallpaper.IsMoving != other.IsMoving {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (backgroundTypeWallpaper *BackgroundTypeWallpaper) Diff(other *BackgroundTypeWallpaper) []Change {
+	return backgroundTypeWallpaper.diff("", other, nil)
+}
+
+func (backgroundTypeWallpaper *BackgroundTypeWallpaper) diff(path string, other *BackgroundTypeWallpaper, changes []Change) []Change {
+	if backgroundTypeWallpaper == nil || other == nil {
+		if backgroundTypeWallpaper != other {
+			changes = append(changes, Change{Path: path, Old: backgroundTypeWallpaper, New: other})
+		}
+		return changes
+	}
+	if backgroundTypeWallpaper.IsBlurred != other.IsBlurred {
+		changes = append(changes, Change{Path: fieldPath(path, "is_blurred"), Old: backgroundTypeWallpaper.IsBlurred, New: other.IsBlurred})
+	}
+	if backgroundTypeWallpaper.IsMoving != other.IsMoving {
+		changes = append(changes, Change{Path: fieldPath(path, "is_moving"), Old: backgroundTypeWallpaper.IsMoving, New: other.IsMoving})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (backgroundTypeWallpaper *BackgroundTypeWallpaper) String() string {
+	return objectString(backgroundTypeWallpaper)
+}
+
+// LogValue logs the object as its summary
+func (backgroundTypeWallpaper *BackgroundTypeWallpaper) LogValue() slog.Value {
+	return slog.StringValue(objectString(backgroundTypeWallpaper))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (backgroundTypeWallpaper *BackgroundTypeWallpaper) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, backgroundTypeWallpaper)
+}
+
+func (backgroundTypeWallpaper *BackgroundTypeWallpaper) printText(p *printer) {
+	if backgroundTypeWallpaper == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("BackgroundTypeWallpaper")
+	p.bool("is_blurred", backgroundTypeWallpaper.IsBlurred)
+	p.bool("is_moving", backgroundTypeWallpaper.IsMoving)
+	p.endObject(state)
+}
+
 // GetBackgroundTypeEnum return the enum type of this object
 func (backgroundTypeWallpaper *BackgroundTypeWallpaper) GetBackgroundTypeEnum() BackgroundTypeEnum {
 	return BackgroundTypeWallpaperType
 }
 
+// Accept calls the method of the visitor for BackgroundTypeWallpaper
+func (backgroundTypeWallpaper *BackgroundTypeWallpaper) Accept(visitor BackgroundTypeVisitor) {
+	visitor.VisitBackgroundTypeWallpaper(backgroundTypeWallpaper)
+}
+
 // BackgroundTypePattern A PNG or TGV (gzipped subset of SVG with MIME type "application/x-tgwallpattern") pattern to be combined with the background fill chosen by the user
 type BackgroundTypePattern struct {
 	tdCommon
@@ -115,33 +286,142 @@ func NewBackgroundTypePattern(fill BackgroundFill, intensity int32, isInverted b
 	return &backgroundTypePatternTemp
 }
 
+// MarshalJSON marshals to json
+func (backgroundTypePattern *BackgroundTypePattern) MarshalJSON() ([]byte, error) {
+	return backgroundTypePattern.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (backgroundTypePattern *BackgroundTypePattern) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Intensity  int32 `json:"intensity"`   // Intensity of the pattern when it is shown above the filled background; 0-100.
-		IsInverted bool  `json:"is_inverted"` // True, if the background fill must be applied only to the pattern itself. All other pixels are black in this case. For dark themes only
-		IsMoving   bool  `json:"is_moving"`   // True, if the background needs to be slightly moved when device is tilted
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	return unmarshalFast(b, backgroundTypePattern)
+}
+
+func (backgroundTypePattern *BackgroundTypePattern) appendJSON(b []byte) ([]byte, error) {
+	if backgroundTypePattern == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, backgroundTypePattern.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, backgroundTypePattern.tdCommon.Extra)
+	b = append(b, `,"fill":`...)
+	b, err = appendJSONValue(b, backgroundTypePattern.Fill)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	b = append(b, `,"intensity":`...)
+	b = appendJSONInt(b, int64(backgroundTypePattern.Intensity))
+	b = append(b, `,"is_inverted":`...)
+	b = appendJSONBool(b, backgroundTypePattern.IsInverted)
+	b = append(b, `,"is_moving":`...)
+	b = appendJSONBool(b, backgroundTypePattern.IsMoving)
+	return append(b, '}'), nil
+}
 
-	backgroundTypePattern.tdCommon = tempObj.tdCommon
-	backgroundTypePattern.Intensity = tempObj.Intensity
-	backgroundTypePattern.IsInverted = tempObj.IsInverted
-	backgroundTypePattern.IsMoving = tempObj.IsMoving
+func (backgroundTypePattern *BackgroundTypePattern) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			backgroundTypePattern.tdCommon.Type, err = r.readString()
+		case "@extra":
+			backgroundTypePattern.tdCommon.Extra, err = r.readString()
+		case "fill":
+			backgroundTypePattern.Fill, err = decodeBackgroundFill(r)
+		case "intensity":
+			backgroundTypePattern.Intensity, err = r.readInt32()
+		case "is_inverted":
+			backgroundTypePattern.IsInverted, err = r.readBool()
+		case "is_moving":
+			backgroundTypePattern.IsMoving, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	fieldFill, _ := unmarshalBackgroundFill(objMap["fill"])
-	backgroundTypePattern.Fill = fieldFill
+// Clone returns a deep copy of the object
+func (backgroundTypePattern *BackgroundTypePattern) Clone() *BackgroundTypePattern {
+	if backgroundTypePattern == nil {
+		return nil
+	}
+	clone := *backgroundTypePattern
+	clone.Fill = cloneBackgroundFill(backgroundTypePattern.Fill)
+	return &clone
+}
 
-	return nil
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (backgroundTypePattern *BackgroundTypePattern) Equal(other *BackgroundTypePattern) bool {
+	if backgroundTypePattern == nil || other == nil {
+		return backgroundTypePattern == other
+	}
+	if !equalBackgroundFill(backgroundTypePattern.Fill, other.Fill) {
+		return false
+	}
+	if backgroundTypePattern.Intensity != other.Intensity {
+		return false
+	}
+	if backgroundTypePattern.IsInverted != other.IsInverted {
+		return false
+	}
+	if backgroundTypePattern.IsMoving != other.IsMoving {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (backgroundTypePattern *BackgroundTypePattern) Diff(other *BackgroundTypePattern) []Change {
+	return backgroundTypePattern.diff("", other, nil)
+}
+
+func (backgroundTypePattern *BackgroundTypePattern) diff(path string, other *BackgroundTypePattern, changes []Change) []Change {
+	if backgroundTypePattern == nil || other == nil {
+		if backgroundTypePattern != other {
+			changes = append(changes, Change{Path: path, Old: backgroundTypePattern, New: other})
+		}
+		return changes
+	}
+	changes = diffBackgroundFill(fieldPath(path, "fill"), backgroundTypePattern.Fill, other.Fill, changes)
+	if backgroundTypePattern.Intensity != other.Intensity {
+		changes = append(changes, Change{Path: fieldPath(path, "intensity"), Old: backgroundTypePattern.Intensity, New: other.Intensity})
+	}
+	if backgroundTypePattern.IsInverted != other.IsInverted {
+		changes = append(changes, Change{Path: fieldPath(path, "is_inverted"), Old: backgroundTypePattern.IsInverted, New: other.IsInverted})
+	}
+	if backgroundTypePattern.IsMoving != other.IsMoving {
+		changes = append(changes, Change{Path: fieldPath(path, "is_moving"), Old: backgroundTypePattern.IsMoving, New: other.IsMoving})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (backgroundTypePattern *BackgroundTypePattern) String() string {
+	return objectString(backgroundTypePattern)
+}
+
+// LogValue logs the object as its summary
+func (backgroundTypePattern *BackgroundTypePattern) LogValue() slog.Value {
+	return slog.StringValue(objectString(backgroundTypePattern))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (backgroundTypePattern *BackgroundTypePattern) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, backgroundTypePattern)
+}
+
+func (backgroundTypePattern *BackgroundTypePattern) printText(p *printer) {
+	if backgroundTypePattern == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("BackgroundTypePattern")
+	p.object("fill", backgroundTypePattern.Fill)
+	p.int("intensity", int64(backgroundTypePattern.Intensity))
+	p.bool("is_inverted", backgroundTypePattern.IsInverted)
+	p.bool("is_moving", backgroundTypePattern.IsMoving)
+	p.endObject(state)
 }
 
 // GetBackgroundTypeEnum return the enum type of this object
@@ -149,6 +429,11 @@ func (backgroundTypePattern *BackgroundTypePattern) GetBackgroundTypeEnum() Back
 	return BackgroundTypePatternType
 }
 
+// Accept calls the method of the visitor for BackgroundTypePattern
+func (backgroundTypePattern *BackgroundTypePattern) Accept(visitor BackgroundTypeVisitor) {
+	visitor.VisitBackgroundTypePattern(backgroundTypePattern)
+}
+
 // BackgroundTypeFill A filled background
 type BackgroundTypeFill struct {
 	tdCommon
@@ -172,30 +457,227 @@ func NewBackgroundTypeFill(fill BackgroundFill) *BackgroundTypeFill {
 	return &backgroundTypeFillTemp
 }
 
+// MarshalJSON marshals to json
+func (backgroundTypeFill *BackgroundTypeFill) MarshalJSON() ([]byte, error) {
+	return backgroundTypeFill.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (backgroundTypeFill *BackgroundTypeFill) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, backgroundTypeFill)
+}
+
+func (backgroundTypeFill *BackgroundTypeFill) appendJSON(b []byte) ([]byte, error) {
+	if backgroundTypeFill == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, backgroundTypeFill.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, backgroundTypeFill.tdCommon.Extra)
+	b = append(b, `,"fill":`...)
+	b, err = appendJSONValue(b, backgroundTypeFill.Fill)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return append(b, '}'), nil
+}
 
-	backgroundTypeFill.tdCommon = tempObj.tdCommon
+func (backgroundTypeFill *BackgroundTypeFill) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			backgroundTypeFill.tdCommon.Type, err = r.readString()
+		case "@extra":
+			backgroundTypeFill.tdCommon.Extra, err = r.readString()
+		case "fill":
+			backgroundTypeFill.Fill, err = decodeBackgroundFill(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	fieldFill, _ := unmarshalBackgroundFill(objMap["fill"])
-	backgroundTypeFill.Fill = fieldFill
+// Clone returns a deep copy of the object
+func (backgroundTypeFill *BackgroundTypeFill) Clone() *BackgroundTypeFill {
+	if backgroundTypeFill == nil {
+		return nil
+	}
+	clone := *backgroundTypeFill
+	clone.Fill = cloneBackgroundFill(backgroundTypeFill.Fill)
+	return &clone
+}
 
-	return nil
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (backgroundTypeFill *BackgroundTypeFill) Equal(other *BackgroundTypeFill) bool {
+	if backgroundTypeFill == nil || other == nil {
+		return backgroundTypeFill == other
+	}
+	if !equalBackgroundFill(backgroundTypeFill.Fill, other.Fill) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (backgroundTypeFill *BackgroundTypeFill) Diff(other *BackgroundTypeFill) []Change {
+	return backgroundTypeFill.diff("", other, nil)
+}
+
+func (backgroundTypeFill *BackgroundTypeFill) diff(path string, other *BackgroundTypeFill, changes []Change) []Change {
+	if backgroundTypeFill == nil || other == nil {
+		if backgroundTypeFill != other {
+			changes = append(changes, Change{Path: path, Old: backgroundTypeFill, New: other})
+		}
+		return changes
+	}
+	changes = diffBackgroundFill(fieldPath(path, "fill"), backgroundTypeFill.Fill, other.Fill, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (backgroundTypeFill *BackgroundTypeFill) String() string {
+	return objectString(backgroundTypeFill)
+}
+
+// LogValue logs the object as its summary
+func (backgroundTypeFill *BackgroundTypeFill) LogValue() slog.Value {
+	return slog.StringValue(objectString(backgroundTypeFill))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (backgroundTypeFill *BackgroundTypeFill) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, backgroundTypeFill)
+}
+
+func (backgroundTypeFill *BackgroundTypeFill) printText(p *printer) {
+	if backgroundTypeFill == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("BackgroundTypeFill")
+	p.object("fill", backgroundTypeFill.Fill)
+	p.endObject(state)
 }
 
 // GetBackgroundTypeEnum return the enum type of this object
 func (backgroundTypeFill *BackgroundTypeFill) GetBackgroundTypeEnum() BackgroundTypeEnum {
 	return BackgroundTypeFillType
 }
+
+// Accept calls the method of the visitor for BackgroundTypeFill
+func (backgroundTypeFill *BackgroundTypeFill) Accept(visitor BackgroundTypeVisitor) {
+	visitor.VisitBackgroundTypeFill(backgroundTypeFill)
+}
+
+// BackgroundTypeVisitor visits every type of BackgroundType, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to BackgroundType, until they handle it too.
+type BackgroundTypeVisitor interface {
+	VisitBackgroundTypeWallpaper(backgroundTypeWallpaper *BackgroundTypeWallpaper)
+	VisitBackgroundTypePattern(backgroundTypePattern *BackgroundTypePattern)
+	VisitBackgroundTypeFill(backgroundTypeFill *BackgroundTypeFill)
+	VisitUnknownBackgroundType(unknownBackgroundType *UnknownBackgroundType)
+}
+
+// BackgroundTypeCases holds the function MatchBackgroundType calls for each type of BackgroundType;
+// types without a function are passed to the default case
+type BackgroundTypeCases[R any] struct {
+	BackgroundTypeWallpaper func(backgroundTypeWallpaper *BackgroundTypeWallpaper) R
+	BackgroundTypePattern   func(backgroundTypePattern *BackgroundTypePattern) R
+	BackgroundTypeFill      func(backgroundTypeFill *BackgroundTypeFill) R
+}
+
+// MatchBackgroundType calls the case of the type of backgroundType, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement BackgroundTypeVisitor
+// to have the compiler check every type is handled.
+func MatchBackgroundType[R any](backgroundType BackgroundType, cases BackgroundTypeCases[R], defaultCase func(backgroundType BackgroundType) R) R {
+	switch value := backgroundType.(type) {
+	case *BackgroundTypeWallpaper:
+		if cases.BackgroundTypeWallpaper != nil {
+			return cases.BackgroundTypeWallpaper(value)
+		}
+	case *BackgroundTypePattern:
+		if cases.BackgroundTypePattern != nil {
+			return cases.BackgroundTypePattern(value)
+		}
+	case *BackgroundTypeFill:
+		if cases.BackgroundTypeFill != nil {
+			return cases.BackgroundTypeFill(value)
+		}
+	}
+	return defaultCase(backgroundType)
+}
+
+// Clone returns a deep copy of the object
+func (unknownBackgroundType *UnknownBackgroundType) Clone() *UnknownBackgroundType {
+	if unknownBackgroundType == nil {
+		return nil
+	}
+	return &UnknownBackgroundType{Unknown: unknownBackgroundType.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownBackgroundType *UnknownBackgroundType) Equal(other *UnknownBackgroundType) bool {
+	if unknownBackgroundType == nil || other == nil {
+		return unknownBackgroundType == other
+	}
+	return unknownBackgroundType.Unknown.equal(other.Unknown)
+}
+
+func cloneBackgroundType(backgroundType BackgroundType) BackgroundType {
+	switch value := backgroundType.(type) {
+	case *BackgroundTypeWallpaper:
+		return value.Clone()
+	case *BackgroundTypePattern:
+		return value.Clone()
+	case *BackgroundTypeFill:
+		return value.Clone()
+	case *UnknownBackgroundType:
+		return value.Clone()
+	}
+	return backgroundType
+}
+
+func equalBackgroundType(a BackgroundType, b BackgroundType) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *BackgroundTypeWallpaper:
+		b, ok := b.(*BackgroundTypeWallpaper)
+		return ok && a.Equal(b)
+	case *BackgroundTypePattern:
+		b, ok := b.(*BackgroundTypePattern)
+		return ok && a.Equal(b)
+	case *BackgroundTypeFill:
+		b, ok := b.(*BackgroundTypeFill)
+		return ok && a.Equal(b)
+	case *UnknownBackgroundType:
+		b, ok := b.(*UnknownBackgroundType)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffBackgroundType(path string, a BackgroundType, b BackgroundType, changes []Change) []Change {
+	switch a := a.(type) {
+	case *BackgroundTypeWallpaper:
+		if b, ok := b.(*BackgroundTypeWallpaper); ok {
+			return a.diff(path, b, changes)
+		}
+	case *BackgroundTypePattern:
+		if b, ok := b.(*BackgroundTypePattern); ok {
+			return a.diff(path, b, changes)
+		}
+	case *BackgroundTypeFill:
+		if b, ok := b.(*BackgroundTypeFill); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalBackgroundType(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // Backgrounds Contains a list of backgrounds
@@ -28,6 +28,150 @@ func NewBackgrounds(backgrounds []Background) *Backgrounds {
 	return &backgroundsTemp
 }
 
+// MarshalJSON marshals to json
+func (backgrounds *Backgrounds) MarshalJSON() ([]byte, error) {
+	return backgrounds.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (backgrounds *Backgrounds) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, backgrounds)
+}
+
+func (backgrounds *Backgrounds) appendJSON(b []byte) ([]byte, error) {
+	if backgrounds == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, backgrounds.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, backgrounds.tdCommon.Extra)
+	b = append(b, `,"backgrounds":`...)
+	if backgrounds.Backgrounds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range backgrounds.Backgrounds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = backgrounds.Backgrounds[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (backgrounds *Backgrounds) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			backgrounds.tdCommon.Type, err = r.readString()
+		case "@extra":
+			backgrounds.tdCommon.Extra, err = r.readString()
+		case "backgrounds":
+			if r.readNull() {
+				backgrounds.Backgrounds = nil
+			} else {
+				backgrounds.Backgrounds = make([]Background, 0)
+				err = r.readArray(func() (err error) {
+					var item0 Background
+					err = item0.decodeJSON(r)
+					backgrounds.Backgrounds = append(backgrounds.Backgrounds, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (backgrounds *Backgrounds) Clone() *Backgrounds {
+	if backgrounds == nil {
+		return nil
+	}
+	clone := *backgrounds
+	if backgrounds.Backgrounds != nil {
+		clone.Backgrounds = make([]Background, len(backgrounds.Backgrounds))
+		for i0 := range backgrounds.Backgrounds {
+			clone.Backgrounds[i0] = *backgrounds.Backgrounds[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (backgrounds *Backgrounds) Equal(other *Backgrounds) bool {
+	if backgrounds == nil || other == nil {
+		return backgrounds == other
+	}
+	if len(backgrounds.Backgrounds) != len(other.Backgrounds) {
+		return false
+	}
+	for i0 := range backgrounds.Backgrounds {
+		if !backgrounds.Backgrounds[i0].Equal(&other.Backgrounds[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (backgrounds *Backgrounds) Diff(other *Backgrounds) []Change {
+	return backgrounds.diff("", other, nil)
+}
+
+func (backgrounds *Backgrounds) diff(path string, other *Backgrounds, changes []Change) []Change {
+	if backgrounds == nil || other == nil {
+		if backgrounds != other {
+			changes = append(changes, Change{Path: path, Old: backgrounds, New: other})
+		}
+		return changes
+	}
+	if len(backgrounds.Backgrounds) != len(other.Backgrounds) {
+		changes = append(changes, Change{Path: fieldPath(path, "backgrounds"), Old: backgrounds.Backgrounds, New: other.Backgrounds})
+	} else {
+		for i0 := range backgrounds.Backgrounds {
+			changes = backgrounds.Backgrounds[i0].diff(indexPath(fieldPath(path, "backgrounds"), i0), &other.Backgrounds[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (backgrounds *Backgrounds) String() string {
+	return objectString(backgrounds)
+}
+
+// LogValue logs the object as its summary
+func (backgrounds *Backgrounds) LogValue() slog.Value {
+	return slog.StringValue(objectString(backgrounds))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (backgrounds *Backgrounds) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, backgrounds)
+}
+
+func (backgrounds *Backgrounds) printText(p *printer) {
+	if backgrounds == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Backgrounds")
+	p.vector("backgrounds", len(backgrounds.Backgrounds), func(i0 int) {
+		backgrounds.Backgrounds[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetBackgrounds Returns backgrounds installed by the user
 // @param forDarkTheme True, if the backgrounds must be ordered for dark theme
 func (client *Client) GetBackgrounds(forDarkTheme bool) (*Backgrounds, error) {
@@ -40,11 +184,11 @@ func (client *Client) GetBackgrounds(forDarkTheme bool) (*Backgrounds, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var backgrounds Backgrounds
-	err = json.Unmarshal(result.Raw, &backgrounds)
+	err = jsonUnmarshal(result.Raw, &backgrounds)
 	return &backgrounds, err
 }
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // BankCardActionOpenUrl Describes an action associated with a bank card number
 type BankCardActionOpenUrl struct {
 	tdCommon
@@ -25,3 +30,116 @@ func NewBankCardActionOpenUrl(text string, url string) *BankCardActionOpenUrl {
 
 	return &bankCardActionOpenUrlTemp
 }
+
+// MarshalJSON marshals to json
+func (bankCardActionOpenUrl *BankCardActionOpenUrl) MarshalJSON() ([]byte, error) {
+	return bankCardActionOpenUrl.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (bankCardActionOpenUrl *BankCardActionOpenUrl) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, bankCardActionOpenUrl)
+}
+
+func (bankCardActionOpenUrl *BankCardActionOpenUrl) appendJSON(b []byte) ([]byte, error) {
+	if bankCardActionOpenUrl == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, bankCardActionOpenUrl.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, bankCardActionOpenUrl.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b = appendJSONString(b, bankCardActionOpenUrl.Text)
+	b = append(b, `,"url":`...)
+	b = appendJSONString(b, bankCardActionOpenUrl.Url)
+	return append(b, '}'), nil
+}
+
+func (bankCardActionOpenUrl *BankCardActionOpenUrl) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			bankCardActionOpenUrl.tdCommon.Type, err = r.readString()
+		case "@extra":
+			bankCardActionOpenUrl.tdCommon.Extra, err = r.readString()
+		case "text":
+			bankCardActionOpenUrl.Text, err = r.readString()
+		case "url":
+			bankCardActionOpenUrl.Url, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (bankCardActionOpenUrl *BankCardActionOpenUrl) Clone() *BankCardActionOpenUrl {
+	if bankCardActionOpenUrl == nil {
+		return nil
+	}
+	clone := *bankCardActionOpenUrl
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (bankCardActionOpenUrl *BankCardActionOpenUrl) Equal(other *BankCardActionOpenUrl) bool {
+	if bankCardActionOpenUrl == nil || other == nil {
+		return bankCardActionOpenUrl == other
+	}
+	if bankCardActionOpenUrl.Text != other.Text {
+		return false
+	}
+	if bankCardActionOpenUrl.Url != other.Url {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (bankCardActionOpenUrl *BankCardActionOpenUrl) Diff(other *BankCardActionOpenUrl) []Change {
+	return bankCardActionOpenUrl.diff("", other, nil)
+}
+
+func (bankCardActionOpenUrl *BankCardActionOpenUrl) diff(path string, other *BankCardActionOpenUrl, changes []Change) []Change {
+	if bankCardActionOpenUrl == nil || other == nil {
+		if bankCardActionOpenUrl != other {
+			changes = append(changes, Change{Path: path, Old: bankCardActionOpenUrl, New: other})
+		}
+		return changes
+	}
+	if bankCardActionOpenUrl.Text != other.Text {
+		changes = append(changes, Change{Path: fieldPath(path, "text"), Old: bankCardActionOpenUrl.Text, New: other.Text})
+	}
+	if bankCardActionOpenUrl.Url != other.Url {
+		changes = append(changes, Change{Path: fieldPath(path, "url"), Old: bankCardActionOpenUrl.Url, New: other.Url})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (bankCardActionOpenUrl *BankCardActionOpenUrl) String() string {
+	return objectString(bankCardActionOpenUrl)
+}
+
+// LogValue logs the object as its summary
+func (bankCardActionOpenUrl *BankCardActionOpenUrl) LogValue() slog.Value {
+	return slog.StringValue(objectString(bankCardActionOpenUrl))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (bankCardActionOpenUrl *BankCardActionOpenUrl) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, bankCardActionOpenUrl)
+}
+
+func (bankCardActionOpenUrl *BankCardActionOpenUrl) printText(p *printer) {
+	if bankCardActionOpenUrl == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("BankCardActionOpenUrl")
+	p.string("text", bankCardActionOpenUrl.Text)
+	p.string("url", bankCardActionOpenUrl.Url)
+	p.endObject(state)
+}
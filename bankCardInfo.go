@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // BankCardInfo Information about a bank card
@@ -31,6 +31,161 @@ func NewBankCardInfo(title string, actions []BankCardActionOpenUrl) *BankCardInf
 	return &bankCardInfoTemp
 }
 
+// MarshalJSON marshals to json
+func (bankCardInfo *BankCardInfo) MarshalJSON() ([]byte, error) {
+	return bankCardInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (bankCardInfo *BankCardInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, bankCardInfo)
+}
+
+func (bankCardInfo *BankCardInfo) appendJSON(b []byte) ([]byte, error) {
+	if bankCardInfo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, bankCardInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, bankCardInfo.tdCommon.Extra)
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, bankCardInfo.Title)
+	b = append(b, `,"actions":`...)
+	if bankCardInfo.Actions == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range bankCardInfo.Actions {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = bankCardInfo.Actions[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (bankCardInfo *BankCardInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			bankCardInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			bankCardInfo.tdCommon.Extra, err = r.readString()
+		case "title":
+			bankCardInfo.Title, err = r.readString()
+		case "actions":
+			if r.readNull() {
+				bankCardInfo.Actions = nil
+			} else {
+				bankCardInfo.Actions = make([]BankCardActionOpenUrl, 0)
+				err = r.readArray(func() (err error) {
+					var item0 BankCardActionOpenUrl
+					err = item0.decodeJSON(r)
+					bankCardInfo.Actions = append(bankCardInfo.Actions, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (bankCardInfo *BankCardInfo) Clone() *BankCardInfo {
+	if bankCardInfo == nil {
+		return nil
+	}
+	clone := *bankCardInfo
+	if bankCardInfo.Actions != nil {
+		clone.Actions = make([]BankCardActionOpenUrl, len(bankCardInfo.Actions))
+		for i0 := range bankCardInfo.Actions {
+			clone.Actions[i0] = *bankCardInfo.Actions[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (bankCardInfo *BankCardInfo) Equal(other *BankCardInfo) bool {
+	if bankCardInfo == nil || other == nil {
+		return bankCardInfo == other
+	}
+	if bankCardInfo.Title != other.Title {
+		return false
+	}
+	if len(bankCardInfo.Actions) != len(other.Actions) {
+		return false
+	}
+	for i0 := range bankCardInfo.Actions {
+		if !bankCardInfo.Actions[i0].Equal(&other.Actions[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (bankCardInfo *BankCardInfo) Diff(other *BankCardInfo) []Change {
+	return bankCardInfo.diff("", other, nil)
+}
+
+func (bankCardInfo *BankCardInfo) diff(path string, other *BankCardInfo, changes []Change) []Change {
+	if bankCardInfo == nil || other == nil {
+		if bankCardInfo != other {
+			changes = append(changes, Change{Path: path, Old: bankCardInfo, New: other})
+		}
+		return changes
+	}
+	if bankCardInfo.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: bankCardInfo.Title, New: other.Title})
+	}
+	if len(bankCardInfo.Actions) != len(other.Actions) {
+		changes = append(changes, Change{Path: fieldPath(path, "actions"), Old: bankCardInfo.Actions, New: other.Actions})
+	} else {
+		for i0 := range bankCardInfo.Actions {
+			changes = bankCardInfo.Actions[i0].diff(indexPath(fieldPath(path, "actions"), i0), &other.Actions[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (bankCardInfo *BankCardInfo) String() string {
+	return objectString(bankCardInfo)
+}
+
+// LogValue logs the object as its summary
+func (bankCardInfo *BankCardInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(bankCardInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (bankCardInfo *BankCardInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, bankCardInfo)
+}
+
+func (bankCardInfo *BankCardInfo) printText(p *printer) {
+	if bankCardInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("BankCardInfo")
+	p.string("title", bankCardInfo.Title)
+	p.vector("actions", len(bankCardInfo.Actions), func(i0 int) {
+		bankCardInfo.Actions[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetBankCardInfo Returns information about a bank card
 // @param bankCardNumber The bank card number
 func (client *Client) GetBankCardInfo(bankCardNumber string) (*BankCardInfo, error) {
@@ -43,11 +198,11 @@ func (client *Client) GetBankCardInfo(bankCardNumber string) (*BankCardInfo, err
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var bankCardInfo BankCardInfo
-	err = json.Unmarshal(result.Raw, &bankCardInfo)
+	err = jsonUnmarshal(result.Raw, &bankCardInfo)
 	return &bankCardInfo, err
 }
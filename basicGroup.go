@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // BasicGroup Represents a basic group of 0-200 users (must be upgraded to a supergroup to accommodate more than 200 users)
@@ -43,37 +43,164 @@ func NewBasicGroup(id int64, accessHash JSONInt64, memberCount int32, status Cha
 	return &basicGroupTemp
 }
 
+// MarshalJSON marshals to json
+func (basicGroup *BasicGroup) MarshalJSON() ([]byte, error) {
+	return basicGroup.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (basicGroup *BasicGroup) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Id                     int64     `json:"id"`                        // Group identifier
-		AccessHash             JSONInt64 `json:"access_hash"`               // Group access hash
-		MemberCount            int32     `json:"member_count"`              // Number of members in the group
-		IsActive               bool      `json:"is_active"`                 // True, if the group is active
-		UpgradedToSupergroupId int32     `json:"upgraded_to_supergroup_id"` // Identifier of the supergroup to which this group was upgraded; 0 if none
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	return unmarshalFast(b, basicGroup)
+}
+
+func (basicGroup *BasicGroup) appendJSON(b []byte) ([]byte, error) {
+	if basicGroup == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, basicGroup.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, basicGroup.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt(b, int64(basicGroup.Id))
+	b = append(b, `,"access_hash":`...)
+	b = appendJSONInt64String(b, int64(basicGroup.AccessHash))
+	b = append(b, `,"member_count":`...)
+	b = appendJSONInt(b, int64(basicGroup.MemberCount))
+	b = append(b, `,"status":`...)
+	b, err = appendJSONValue(b, basicGroup.Status)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"is_active":`...)
+	b = appendJSONBool(b, basicGroup.IsActive)
+	b = append(b, `,"upgraded_to_supergroup_id":`...)
+	b = appendJSONInt(b, int64(basicGroup.UpgradedToSupergroupId))
+	return append(b, '}'), nil
+}
+
+func (basicGroup *BasicGroup) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			basicGroup.tdCommon.Type, err = r.readString()
+		case "@extra":
+			basicGroup.tdCommon.Extra, err = r.readString()
+		case "id":
+			basicGroup.Id, err = r.readInt64()
+		case "access_hash":
+			basicGroup.AccessHash, err = r.readJSONInt64()
+		case "member_count":
+			basicGroup.MemberCount, err = r.readInt32()
+		case "status":
+			basicGroup.Status, err = decodeChatMemberStatus(r)
+		case "is_active":
+			basicGroup.IsActive, err = r.readBool()
+		case "upgraded_to_supergroup_id":
+			basicGroup.UpgradedToSupergroupId, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (basicGroup *BasicGroup) Clone() *BasicGroup {
+	if basicGroup == nil {
+		return nil
+	}
+	clone := *basicGroup
+	clone.Status = cloneChatMemberStatus(basicGroup.Status)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (basicGroup *BasicGroup) Equal(other *BasicGroup) bool {
+	if basicGroup == nil || other == nil {
+		return basicGroup == other
+	}
+	if basicGroup.Id != other.Id {
+		return false
+	}
+	if basicGroup.AccessHash != other.AccessHash {
+		return false
+	}
+	if basicGroup.MemberCount != other.MemberCount {
+		return false
 	}
+	if !equalChatMemberStatus(basicGroup.Status, other.Status) {
+		return false
+	}
+	if basicGroup.IsActive != other.IsActive {
+		return false
+	}
+	if basicGroup.UpgradedToSupergroupId != other.UpgradedToSupergroupId {
+		return false
+	}
+	return true
+}
 
-	basicGroup.tdCommon = tempObj.tdCommon
-	basicGroup.Id = tempObj.Id
-	basicGroup.AccessHash = tempObj.AccessHash
-	basicGroup.MemberCount = tempObj.MemberCount
-	basicGroup.IsActive = tempObj.IsActive
-	basicGroup.UpgradedToSupergroupId = tempObj.UpgradedToSupergroupId
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (basicGroup *BasicGroup) Diff(other *BasicGroup) []Change {
+	return basicGroup.diff("", other, nil)
+}
 
-	fieldStatus, _ := unmarshalChatMemberStatus(objMap["status"])
-	basicGroup.Status = fieldStatus
+func (basicGroup *BasicGroup) diff(path string, other *BasicGroup, changes []Change) []Change {
+	if basicGroup == nil || other == nil {
+		if basicGroup != other {
+			changes = append(changes, Change{Path: path, Old: basicGroup, New: other})
+		}
+		return changes
+	}
+	if basicGroup.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: basicGroup.Id, New: other.Id})
+	}
+	if basicGroup.AccessHash != other.AccessHash {
+		changes = append(changes, Change{Path: fieldPath(path, "access_hash"), Old: basicGroup.AccessHash, New: other.AccessHash})
+	}
+	if basicGroup.MemberCount != other.MemberCount {
+		changes = append(changes, Change{Path: fieldPath(path, "member_count"), Old: basicGroup.MemberCount, New: other.MemberCount})
+	}
+	changes = diffChatMemberStatus(fieldPath(path, "status"), basicGroup.Status, other.Status, changes)
+	if basicGroup.IsActive != other.IsActive {
+		changes = append(changes, Change{Path: fieldPath(path, "is_active"), Old: basicGroup.IsActive, New: other.IsActive})
+	}
+	if basicGroup.UpgradedToSupergroupId != other.UpgradedToSupergroupId {
+		changes = append(changes, Change{Path: fieldPath(path, "upgraded_to_supergroup_id"), Old: basicGroup.UpgradedToSupergroupId, New: other.UpgradedToSupergroupId})
+	}
+	return changes
+}
 
-	return nil
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (basicGroup *BasicGroup) String() string {
+	return objectString(basicGroup)
+}
+
+// LogValue logs the object as its summary
+func (basicGroup *BasicGroup) LogValue() slog.Value {
+	return slog.StringValue(objectString(basicGroup))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (basicGroup *BasicGroup) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, basicGroup)
+}
+
+func (basicGroup *BasicGroup) printText(p *printer) {
+	if basicGroup == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("BasicGroup")
+	p.int("id", int64(basicGroup.Id))
+	p.int("access_hash", int64(basicGroup.AccessHash))
+	p.int("member_count", int64(basicGroup.MemberCount))
+	p.object("status", basicGroup.Status)
+	p.bool("is_active", basicGroup.IsActive)
+	p.int("upgraded_to_supergroup_id", int64(basicGroup.UpgradedToSupergroupId))
+	p.endObject(state)
 }
 
 // GetBasicGroup Returns information about a basic group by its identifier. This is an offline request if the current user is not a bot
@@ -88,11 +215,11 @@ func (client *Client) GetBasicGroup(basicGroupId int64) (*BasicGroup, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var basicGroupDummy BasicGroup
-	err = json.Unmarshal(result.Raw, &basicGroupDummy)
+	err = jsonUnmarshal(result.Raw, &basicGroupDummy)
 	return &basicGroupDummy, err
 }
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // BasicGroupFullInfo Contains full information about a basic group
@@ -43,6 +43,260 @@ func NewBasicGroupFullInfo(photo *ChatPhoto, description string, creatorUserId i
 	return &basicGroupFullInfoTemp
 }
 
+// MarshalJSON marshals to json
+func (basicGroupFullInfo *BasicGroupFullInfo) MarshalJSON() ([]byte, error) {
+	return basicGroupFullInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (basicGroupFullInfo *BasicGroupFullInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, basicGroupFullInfo)
+}
+
+func (basicGroupFullInfo *BasicGroupFullInfo) appendJSON(b []byte) ([]byte, error) {
+	if basicGroupFullInfo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, basicGroupFullInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, basicGroupFullInfo.tdCommon.Extra)
+	b = append(b, `,"photo":`...)
+	b, err = basicGroupFullInfo.Photo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"description":`...)
+	b = appendJSONString(b, basicGroupFullInfo.Description)
+	b = append(b, `,"creator_user_id":`...)
+	b = appendJSONInt(b, int64(basicGroupFullInfo.CreatorUserId))
+	b = append(b, `,"members":`...)
+	if basicGroupFullInfo.Members == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range basicGroupFullInfo.Members {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = basicGroupFullInfo.Members[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"invite_link":`...)
+	b, err = basicGroupFullInfo.InviteLink.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"bot_commands":`...)
+	if basicGroupFullInfo.BotCommands == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range basicGroupFullInfo.BotCommands {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = basicGroupFullInfo.BotCommands[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (basicGroupFullInfo *BasicGroupFullInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			basicGroupFullInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			basicGroupFullInfo.tdCommon.Extra, err = r.readString()
+		case "photo":
+			if r.readNull() {
+				basicGroupFullInfo.Photo = nil
+			} else {
+				basicGroupFullInfo.Photo = new(ChatPhoto)
+				err = basicGroupFullInfo.Photo.decodeJSON(r)
+			}
+		case "description":
+			basicGroupFullInfo.Description, err = r.readString()
+		case "creator_user_id":
+			basicGroupFullInfo.CreatorUserId, err = r.readInt64()
+		case "members":
+			if r.readNull() {
+				basicGroupFullInfo.Members = nil
+			} else {
+				basicGroupFullInfo.Members = make([]ChatMember, 0)
+				err = r.readArray(func() (err error) {
+					var item0 ChatMember
+					err = item0.decodeJSON(r)
+					basicGroupFullInfo.Members = append(basicGroupFullInfo.Members, item0)
+					return
+				})
+			}
+		case "invite_link":
+			if r.readNull() {
+				basicGroupFullInfo.InviteLink = nil
+			} else {
+				basicGroupFullInfo.InviteLink = new(ChatInviteLink)
+				err = basicGroupFullInfo.InviteLink.decodeJSON(r)
+			}
+		case "bot_commands":
+			if r.readNull() {
+				basicGroupFullInfo.BotCommands = nil
+			} else {
+				basicGroupFullInfo.BotCommands = make([]BotCommands, 0)
+				err = r.readArray(func() (err error) {
+					var item0 BotCommands
+					err = item0.decodeJSON(r)
+					basicGroupFullInfo.BotCommands = append(basicGroupFullInfo.BotCommands, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (basicGroupFullInfo *BasicGroupFullInfo) Clone() *BasicGroupFullInfo {
+	if basicGroupFullInfo == nil {
+		return nil
+	}
+	clone := *basicGroupFullInfo
+	clone.Photo = basicGroupFullInfo.Photo.Clone()
+	if basicGroupFullInfo.Members != nil {
+		clone.Members = make([]ChatMember, len(basicGroupFullInfo.Members))
+		for i0 := range basicGroupFullInfo.Members {
+			clone.Members[i0] = *basicGroupFullInfo.Members[i0].Clone()
+		}
+	}
+	clone.InviteLink = basicGroupFullInfo.InviteLink.Clone()
+	if basicGroupFullInfo.BotCommands != nil {
+		clone.BotCommands = make([]BotCommands, len(basicGroupFullInfo.BotCommands))
+		for i0 := range basicGroupFullInfo.BotCommands {
+			clone.BotCommands[i0] = *basicGroupFullInfo.BotCommands[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (basicGroupFullInfo *BasicGroupFullInfo) Equal(other *BasicGroupFullInfo) bool {
+	if basicGroupFullInfo == nil || other == nil {
+		return basicGroupFullInfo == other
+	}
+	if !basicGroupFullInfo.Photo.Equal(other.Photo) {
+		return false
+	}
+	if basicGroupFullInfo.Description != other.Description {
+		return false
+	}
+	if basicGroupFullInfo.CreatorUserId != other.CreatorUserId {
+		return false
+	}
+	if len(basicGroupFullInfo.Members) != len(other.Members) {
+		return false
+	}
+	for i0 := range basicGroupFullInfo.Members {
+		if !basicGroupFullInfo.Members[i0].Equal(&other.Members[i0]) {
+			return false
+		}
+	}
+	if !basicGroupFullInfo.InviteLink.Equal(other.InviteLink) {
+		return false
+	}
+	if len(basicGroupFullInfo.BotCommands) != len(other.BotCommands) {
+		return false
+	}
+	for i0 := range basicGroupFullInfo.BotCommands {
+		if !basicGroupFullInfo.BotCommands[i0].Equal(&other.BotCommands[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (basicGroupFullInfo *BasicGroupFullInfo) Diff(other *BasicGroupFullInfo) []Change {
+	return basicGroupFullInfo.diff("", other, nil)
+}
+
+func (basicGroupFullInfo *BasicGroupFullInfo) diff(path string, other *BasicGroupFullInfo, changes []Change) []Change {
+	if basicGroupFullInfo == nil || other == nil {
+		if basicGroupFullInfo != other {
+			changes = append(changes, Change{Path: path, Old: basicGroupFullInfo, New: other})
+		}
+		return changes
+	}
+	changes = basicGroupFullInfo.Photo.diff(fieldPath(path, "photo"), other.Photo, changes)
+	if basicGroupFullInfo.Description != other.Description {
+		changes = append(changes, Change{Path: fieldPath(path, "description"), Old: basicGroupFullInfo.Description, New: other.Description})
+	}
+	if basicGroupFullInfo.CreatorUserId != other.CreatorUserId {
+		changes = append(changes, Change{Path: fieldPath(path, "creator_user_id"), Old: basicGroupFullInfo.CreatorUserId, New: other.CreatorUserId})
+	}
+	if len(basicGroupFullInfo.Members) != len(other.Members) {
+		changes = append(changes, Change{Path: fieldPath(path, "members"), Old: basicGroupFullInfo.Members, New: other.Members})
+	} else {
+		for i0 := range basicGroupFullInfo.Members {
+			changes = basicGroupFullInfo.Members[i0].diff(indexPath(fieldPath(path, "members"), i0), &other.Members[i0], changes)
+		}
+	}
+	changes = basicGroupFullInfo.InviteLink.diff(fieldPath(path, "invite_link"), other.InviteLink, changes)
+	if len(basicGroupFullInfo.BotCommands) != len(other.BotCommands) {
+		changes = append(changes, Change{Path: fieldPath(path, "bot_commands"), Old: basicGroupFullInfo.BotCommands, New: other.BotCommands})
+	} else {
+		for i0 := range basicGroupFullInfo.BotCommands {
+			changes = basicGroupFullInfo.BotCommands[i0].diff(indexPath(fieldPath(path, "bot_commands"), i0), &other.BotCommands[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (basicGroupFullInfo *BasicGroupFullInfo) String() string {
+	return objectString(basicGroupFullInfo)
+}
+
+// LogValue logs the object as its summary
+func (basicGroupFullInfo *BasicGroupFullInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(basicGroupFullInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (basicGroupFullInfo *BasicGroupFullInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, basicGroupFullInfo)
+}
+
+func (basicGroupFullInfo *BasicGroupFullInfo) printText(p *printer) {
+	if basicGroupFullInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("BasicGroupFullInfo")
+	p.object("photo", basicGroupFullInfo.Photo)
+	p.string("description", basicGroupFullInfo.Description)
+	p.int("creator_user_id", int64(basicGroupFullInfo.CreatorUserId))
+	p.vector("members", len(basicGroupFullInfo.Members), func(i0 int) {
+		basicGroupFullInfo.Members[i0].printText(p)
+	})
+	p.object("invite_link", basicGroupFullInfo.InviteLink)
+	p.vector("bot_commands", len(basicGroupFullInfo.BotCommands), func(i0 int) {
+		basicGroupFullInfo.BotCommands[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetBasicGroupFullInfo Returns full information about a basic group by its identifier
 // @param basicGroupId Basic group identifier
 func (client *Client) GetBasicGroupFullInfo(basicGroupId int64) (*BasicGroupFullInfo, error) {
@@ -55,11 +309,11 @@ func (client *Client) GetBasicGroupFullInfo(basicGroupId int64) (*BasicGroupFull
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var basicGroupFullInfo BasicGroupFullInfo
-	err = json.Unmarshal(result.Raw, &basicGroupFullInfo)
+	err = jsonUnmarshal(result.Raw, &basicGroupFullInfo)
 	return &basicGroupFullInfo, err
 }
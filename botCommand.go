@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // BotCommand Represents a command supported by a bot
 type BotCommand struct {
 	tdCommon
@@ -25,3 +30,116 @@ func NewBotCommand(command string, description string) *BotCommand {
 
 	return &botCommandTemp
 }
+
+// MarshalJSON marshals to json
+func (botCommand *BotCommand) MarshalJSON() ([]byte, error) {
+	return botCommand.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (botCommand *BotCommand) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, botCommand)
+}
+
+func (botCommand *BotCommand) appendJSON(b []byte) ([]byte, error) {
+	if botCommand == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, botCommand.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, botCommand.tdCommon.Extra)
+	b = append(b, `,"command":`...)
+	b = appendJSONString(b, botCommand.Command)
+	b = append(b, `,"description":`...)
+	b = appendJSONString(b, botCommand.Description)
+	return append(b, '}'), nil
+}
+
+func (botCommand *BotCommand) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			botCommand.tdCommon.Type, err = r.readString()
+		case "@extra":
+			botCommand.tdCommon.Extra, err = r.readString()
+		case "command":
+			botCommand.Command, err = r.readString()
+		case "description":
+			botCommand.Description, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (botCommand *BotCommand) Clone() *BotCommand {
+	if botCommand == nil {
+		return nil
+	}
+	clone := *botCommand
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (botCommand *BotCommand) Equal(other *BotCommand) bool {
+	if botCommand == nil || other == nil {
+		return botCommand == other
+	}
+	if botCommand.Command != other.Command {
+		return false
+	}
+	if botCommand.Description != other.Description {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (botCommand *BotCommand) Diff(other *BotCommand) []Change {
+	return botCommand.diff("", other, nil)
+}
+
+func (botCommand *BotCommand) diff(path string, other *BotCommand, changes []Change) []Change {
+	if botCommand == nil || other == nil {
+		if botCommand != other {
+			changes = append(changes, Change{Path: path, Old: botCommand, New: other})
+		}
+		return changes
+	}
+	if botCommand.Command != other.Command {
+		changes = append(changes, Change{Path: fieldPath(path, "command"), Old: botCommand.Command, New: other.Command})
+	}
+	if botCommand.Description != other.Description {
+		changes = append(changes, Change{Path: fieldPath(path, "description"), Old: botCommand.Description, New: other.Description})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (botCommand *BotCommand) String() string {
+	return objectString(botCommand)
+}
+
+// LogValue logs the object as its summary
+func (botCommand *BotCommand) LogValue() slog.Value {
+	return slog.StringValue(objectString(botCommand))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (botCommand *BotCommand) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, botCommand)
+}
+
+func (botCommand *BotCommand) printText(p *printer) {
+	if botCommand == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("BotCommand")
+	p.string("command", botCommand.Command)
+	p.string("description", botCommand.Description)
+	p.endObject(state)
+}
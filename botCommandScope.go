@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // BotCommandScope Represents the scope to which bot commands are relevant
 type BotCommandScope interface {
 	GetBotCommandScopeEnum() BotCommandScopeEnum
+	Accept(visitor BotCommandScopeVisitor)
 }
 
 // BotCommandScopeEnum Alias for abstract BotCommandScope 'Sub-Classes', used as constant-enum here
@@ -24,55 +26,98 @@ const (
 	BotCommandScopeChatMemberType            BotCommandScopeEnum = "botCommandScopeChatMember"
 )
 
+// UnknownBotCommandScope is a BotCommandScope of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownBotCommandScope struct {
+	Unknown
+}
+
+// GetBotCommandScopeEnum return the enum type of this object
+func (unknownBotCommandScope *UnknownBotCommandScope) GetBotCommandScopeEnum() BotCommandScopeEnum {
+	return BotCommandScopeEnum(unknownBotCommandScope.Type)
+}
+
+// Accept calls the method of the visitor for UnknownBotCommandScope
+func (unknownBotCommandScope *UnknownBotCommandScope) Accept(visitor BotCommandScopeVisitor) {
+	visitor.VisitUnknownBotCommandScope(unknownBotCommandScope)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownBotCommandScope *UnknownBotCommandScope) String() string {
+	return objectString(unknownBotCommandScope)
+}
+
+// LogValue logs the object as its summary
+func (unknownBotCommandScope *UnknownBotCommandScope) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownBotCommandScope))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownBotCommandScope *UnknownBotCommandScope) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownBotCommandScope)
+}
+
+func (unknownBotCommandScope *UnknownBotCommandScope) printText(p *printer) {
+	if unknownBotCommandScope == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownBotCommandScope")
+	p.string("@type", unknownBotCommandScope.Type)
+	p.endObject(state)
+}
+
 func unmarshalBotCommandScope(rawMsg *json.RawMessage) (BotCommandScope, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch BotCommandScopeEnum(objMap["@type"].(string)) {
+	switch BotCommandScopeEnum(typeName) {
 	case BotCommandScopeDefaultType:
 		var botCommandScopeDefault BotCommandScopeDefault
-		err := json.Unmarshal(*rawMsg, &botCommandScopeDefault)
+		err := jsonUnmarshal(*rawMsg, &botCommandScopeDefault)
 		return &botCommandScopeDefault, err
 
 	case BotCommandScopeAllPrivateChatsType:
 		var botCommandScopeAllPrivateChats BotCommandScopeAllPrivateChats
-		err := json.Unmarshal(*rawMsg, &botCommandScopeAllPrivateChats)
+		err := jsonUnmarshal(*rawMsg, &botCommandScopeAllPrivateChats)
 		return &botCommandScopeAllPrivateChats, err
 
 	case BotCommandScopeAllGroupChatsType:
 		var botCommandScopeAllGroupChats BotCommandScopeAllGroupChats
-		err := json.Unmarshal(*rawMsg, &botCommandScopeAllGroupChats)
+		err := jsonUnmarshal(*rawMsg, &botCommandScopeAllGroupChats)
 		return &botCommandScopeAllGroupChats, err
 
 	case BotCommandScopeAllChatAdministratorsType:
 		var botCommandScopeAllChatAdministrators BotCommandScopeAllChatAdministrators
-		err := json.Unmarshal(*rawMsg, &botCommandScopeAllChatAdministrators)
+		err := jsonUnmarshal(*rawMsg, &botCommandScopeAllChatAdministrators)
 		return &botCommandScopeAllChatAdministrators, err
 
 	case BotCommandScopeChatType:
 		var botCommandScopeChat BotCommandScopeChat
-		err := json.Unmarshal(*rawMsg, &botCommandScopeChat)
+		err := jsonUnmarshal(*rawMsg, &botCommandScopeChat)
 		return &botCommandScopeChat, err
 
 	case BotCommandScopeChatAdministratorsType:
 		var botCommandScopeChatAdministrators BotCommandScopeChatAdministrators
-		err := json.Unmarshal(*rawMsg, &botCommandScopeChatAdministrators)
+		err := jsonUnmarshal(*rawMsg, &botCommandScopeChatAdministrators)
 		return &botCommandScopeChatAdministrators, err
 
 	case BotCommandScopeChatMemberType:
 		var botCommandScopeChatMember BotCommandScopeChatMember
-		err := json.Unmarshal(*rawMsg, &botCommandScopeChatMember)
+		err := jsonUnmarshal(*rawMsg, &botCommandScopeChatMember)
 		return &botCommandScopeChatMember, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownBotCommandScope{Unknown: unknown}, nil
 	}
 }
 
@@ -87,7 +132,6 @@ func (botCommandScopeDefault *BotCommandScopeDefault) MessageType() string {
 }
 
 // NewBotCommandScopeDefault creates a new BotCommandScopeDefault
-//
 func NewBotCommandScopeDefault() *BotCommandScopeDefault {
 	botCommandScopeDefaultTemp := BotCommandScopeDefault{
 		tdCommon: tdCommon{Type: "botCommandScopeDefault"},
@@ -96,11 +140,107 @@ func NewBotCommandScopeDefault() *BotCommandScopeDefault {
 	return &botCommandScopeDefaultTemp
 }
 
+// MarshalJSON marshals to json
+func (botCommandScopeDefault *BotCommandScopeDefault) MarshalJSON() ([]byte, error) {
+	return botCommandScopeDefault.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (botCommandScopeDefault *BotCommandScopeDefault) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, botCommandScopeDefault)
+}
+
+func (botCommandScopeDefault *BotCommandScopeDefault) appendJSON(b []byte) ([]byte, error) {
+	if botCommandScopeDefault == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, botCommandScopeDefault.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, botCommandScopeDefault.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (botCommandScopeDefault *BotCommandScopeDefault) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			botCommandScopeDefault.tdCommon.Type, err = r.readString()
+		case "@extra":
+			botCommandScopeDefault.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (botCommandScopeDefault *BotCommandScopeDefault) Clone() *BotCommandScopeDefault {
+	if botCommandScopeDefault == nil {
+		return nil
+	}
+	clone := *botCommandScopeDefault
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (botCommandScopeDefault *BotCommandScopeDefault) Equal(other *BotCommandScopeDefault) bool {
+	if botCommandScopeDefault == nil || other == nil {
+		return botCommandScopeDefault == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (botCommandScopeDefault *BotCommandScopeDefault) Diff(other *BotCommandScopeDefault) []Change {
+	return botCommandScopeDefault.diff("", other, nil)
+}
+
+func (botCommandScopeDefault *BotCommandScopeDefault) diff(path string, other *BotCommandScopeDefault, changes []Change) []Change {
+	if botCommandScopeDefault == nil || other == nil {
+		if botCommandScopeDefault != other {
+			changes = append(changes, Change{Path: path, Old: botCommandScopeDefault, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (botCommandScopeDefault *BotCommandScopeDefault) String() string {
+	return objectString(botCommandScopeDefault)
+}
+
+// LogValue logs the object as its summary
+func (botCommandScopeDefault *BotCommandScopeDefault) LogValue() slog.Value {
+	return slog.StringValue(objectString(botCommandScopeDefault))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (botCommandScopeDefault *BotCommandScopeDefault) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, botCommandScopeDefault)
+}
+
+func (botCommandScopeDefault *BotCommandScopeDefault) printText(p *printer) {
+	if botCommandScopeDefault == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("BotCommandScopeDefault")
+	p.endObject(state)
+}
+
 // GetBotCommandScopeEnum return the enum type of this object
 func (botCommandScopeDefault *BotCommandScopeDefault) GetBotCommandScopeEnum() BotCommandScopeEnum {
 	return BotCommandScopeDefaultType
 }
 
+// Accept calls the method of the visitor for BotCommandScopeDefault
+func (botCommandScopeDefault *BotCommandScopeDefault) Accept(visitor BotCommandScopeVisitor) {
+	visitor.VisitBotCommandScopeDefault(botCommandScopeDefault)
+}
+
 // BotCommandScopeAllPrivateChats A scope covering all private chats
 type BotCommandScopeAllPrivateChats struct {
 	tdCommon
@@ -112,7 +252,6 @@ func (botCommandScopeAllPrivateChats *BotCommandScopeAllPrivateChats) MessageTyp
 }
 
 // NewBotCommandScopeAllPrivateChats creates a new BotCommandScopeAllPrivateChats
-//
 func NewBotCommandScopeAllPrivateChats() *BotCommandScopeAllPrivateChats {
 	botCommandScopeAllPrivateChatsTemp := BotCommandScopeAllPrivateChats{
 		tdCommon: tdCommon{Type: "botCommandScopeAllPrivateChats"},
@@ -121,11 +260,107 @@ func NewBotCommandScopeAllPrivateChats() *BotCommandScopeAllPrivateChats {
 	return &botCommandScopeAllPrivateChatsTemp
 }
 
+// MarshalJSON marshals to json
+func (botCommandScopeAllPrivateChats *BotCommandScopeAllPrivateChats) MarshalJSON() ([]byte, error) {
+	return botCommandScopeAllPrivateChats.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (botCommandScopeAllPrivateChats *BotCommandScopeAllPrivateChats) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, botCommandScopeAllPrivateChats)
+}
+
+func (botCommandScopeAllPrivateChats *BotCommandScopeAllPrivateChats) appendJSON(b []byte) ([]byte, error) {
+	if botCommandScopeAllPrivateChats == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, botCommandScopeAllPrivateChats.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, botCommandScopeAllPrivateChats.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (botCommandScopeAllPrivateChats *BotCommandScopeAllPrivateChats) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			botCommandScopeAllPrivateChats.tdCommon.Type, err = r.readString()
+		case "@extra":
+			botCommandScopeAllPrivateChats.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (botCommandScopeAllPrivateChats *BotCommandScopeAllPrivateChats) Clone() *BotCommandScopeAllPrivateChats {
+	if botCommandScopeAllPrivateChats == nil {
+		return nil
+	}
+	clone := *botCommandScopeAllPrivateChats
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (botCommandScopeAllPrivateChats *BotCommandScopeAllPrivateChats) Equal(other *BotCommandScopeAllPrivateChats) bool {
+	if botCommandScopeAllPrivateChats == nil || other == nil {
+		return botCommandScopeAllPrivateChats == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (botCommandScopeAllPrivateChats *BotCommandScopeAllPrivateChats) Diff(other *BotCommandScopeAllPrivateChats) []Change {
+	return botCommandScopeAllPrivateChats.diff("", other, nil)
+}
+
+func (botCommandScopeAllPrivateChats *BotCommandScopeAllPrivateChats) diff(path string, other *BotCommandScopeAllPrivateChats, changes []Change) []Change {
+	if botCommandScopeAllPrivateChats == nil || other == nil {
+		if botCommandScopeAllPrivateChats != other {
+			changes = append(changes, Change{Path: path, Old: botCommandScopeAllPrivateChats, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (botCommandScopeAllPrivateChats *BotCommandScopeAllPrivateChats) String() string {
+	return objectString(botCommandScopeAllPrivateChats)
+}
+
+// LogValue logs the object as its summary
+func (botCommandScopeAllPrivateChats *BotCommandScopeAllPrivateChats) LogValue() slog.Value {
+	return slog.StringValue(objectString(botCommandScopeAllPrivateChats))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (botCommandScopeAllPrivateChats *BotCommandScopeAllPrivateChats) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, botCommandScopeAllPrivateChats)
+}
+
+func (botCommandScopeAllPrivateChats *BotCommandScopeAllPrivateChats) printText(p *printer) {
+	if botCommandScopeAllPrivateChats == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("BotCommandScopeAllPrivateChats")
+	p.endObject(state)
+}
+
 // GetBotCommandScopeEnum return the enum type of this object
 func (botCommandScopeAllPrivateChats *BotCommandScopeAllPrivateChats) GetBotCommandScopeEnum() BotCommandScopeEnum {
 	return BotCommandScopeAllPrivateChatsType
 }
 
+// Accept calls the method of the visitor for BotCommandScopeAllPrivateChats
+func (botCommandScopeAllPrivateChats *BotCommandScopeAllPrivateChats) Accept(visitor BotCommandScopeVisitor) {
+	visitor.VisitBotCommandScopeAllPrivateChats(botCommandScopeAllPrivateChats)
+}
+
 // BotCommandScopeAllGroupChats A scope covering all group and supergroup chats
 type BotCommandScopeAllGroupChats struct {
 	tdCommon
@@ -137,7 +372,6 @@ func (botCommandScopeAllGroupChats *BotCommandScopeAllGroupChats) MessageType()
 }
 
 // NewBotCommandScopeAllGroupChats creates a new BotCommandScopeAllGroupChats
-//
 func NewBotCommandScopeAllGroupChats() *BotCommandScopeAllGroupChats {
 	botCommandScopeAllGroupChatsTemp := BotCommandScopeAllGroupChats{
 		tdCommon: tdCommon{Type: "botCommandScopeAllGroupChats"},
@@ -146,11 +380,107 @@ func NewBotCommandScopeAllGroupChats() *BotCommandScopeAllGroupChats {
 	return &botCommandScopeAllGroupChatsTemp
 }
 
+// MarshalJSON marshals to json
+func (botCommandScopeAllGroupChats *BotCommandScopeAllGroupChats) MarshalJSON() ([]byte, error) {
+	return botCommandScopeAllGroupChats.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (botCommandScopeAllGroupChats *BotCommandScopeAllGroupChats) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, botCommandScopeAllGroupChats)
+}
+
+func (botCommandScopeAllGroupChats *BotCommandScopeAllGroupChats) appendJSON(b []byte) ([]byte, error) {
+	if botCommandScopeAllGroupChats == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, botCommandScopeAllGroupChats.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, botCommandScopeAllGroupChats.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (botCommandScopeAllGroupChats *BotCommandScopeAllGroupChats) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			botCommandScopeAllGroupChats.tdCommon.Type, err = r.readString()
+		case "@extra":
+			botCommandScopeAllGroupChats.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (botCommandScopeAllGroupChats *BotCommandScopeAllGroupChats) Clone() *BotCommandScopeAllGroupChats {
+	if botCommandScopeAllGroupChats == nil {
+		return nil
+	}
+	clone := *botCommandScopeAllGroupChats
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (botCommandScopeAllGroupChats *BotCommandScopeAllGroupChats) Equal(other *BotCommandScopeAllGroupChats) bool {
+	if botCommandScopeAllGroupChats == nil || other == nil {
+		return botCommandScopeAllGroupChats == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (botCommandScopeAllGroupChats *BotCommandScopeAllGroupChats) Diff(other *BotCommandScopeAllGroupChats) []Change {
+	return botCommandScopeAllGroupChats.diff("", other, nil)
+}
+
+func (botCommandScopeAllGroupChats *BotCommandScopeAllGroupChats) diff(path string, other *BotCommandScopeAllGroupChats, changes []Change) []Change {
+	if botCommandScopeAllGroupChats == nil || other == nil {
+		if botCommandScopeAllGroupChats != other {
+			changes = append(changes, Change{Path: path, Old: botCommandScopeAllGroupChats, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (botCommandScopeAllGroupChats *BotCommandScopeAllGroupChats) String() string {
+	return objectString(botCommandScopeAllGroupChats)
+}
+
+// LogValue logs the object as its summary
+func (botCommandScopeAllGroupChats *BotCommandScopeAllGroupChats) LogValue() slog.Value {
+	return slog.StringValue(objectString(botCommandScopeAllGroupChats))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (botCommandScopeAllGroupChats *BotCommandScopeAllGroupChats) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, botCommandScopeAllGroupChats)
+}
+
+func (botCommandScopeAllGroupChats *BotCommandScopeAllGroupChats) printText(p *printer) {
+	if botCommandScopeAllGroupChats == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("BotCommandScopeAllGroupChats")
+	p.endObject(state)
+}
+
 // GetBotCommandScopeEnum return the enum type of this object
 func (botCommandScopeAllGroupChats *BotCommandScopeAllGroupChats) GetBotCommandScopeEnum() BotCommandScopeEnum {
 	return BotCommandScopeAllGroupChatsType
 }
 
+// Accept calls the method of the visitor for BotCommandScopeAllGroupChats
+func (botCommandScopeAllGroupChats *BotCommandScopeAllGroupChats) Accept(visitor BotCommandScopeVisitor) {
+	visitor.VisitBotCommandScopeAllGroupChats(botCommandScopeAllGroupChats)
+}
+
 // BotCommandScopeAllChatAdministrators A scope covering all group and supergroup chat administrators
 type BotCommandScopeAllChatAdministrators struct {
 	tdCommon
@@ -162,7 +492,6 @@ func (botCommandScopeAllChatAdministrators *BotCommandScopeAllChatAdministrators
 }
 
 // NewBotCommandScopeAllChatAdministrators creates a new BotCommandScopeAllChatAdministrators
-//
 func NewBotCommandScopeAllChatAdministrators() *BotCommandScopeAllChatAdministrators {
 	botCommandScopeAllChatAdministratorsTemp := BotCommandScopeAllChatAdministrators{
 		tdCommon: tdCommon{Type: "botCommandScopeAllChatAdministrators"},
@@ -171,11 +500,107 @@ func NewBotCommandScopeAllChatAdministrators() *BotCommandScopeAllChatAdministra
 	return &botCommandScopeAllChatAdministratorsTemp
 }
 
+// MarshalJSON marshals to json
+func (botCommandScopeAllChatAdministrators *BotCommandScopeAllChatAdministrators) MarshalJSON() ([]byte, error) {
+	return botCommandScopeAllChatAdministrators.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (botCommandScopeAllChatAdministrators *BotCommandScopeAllChatAdministrators) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, botCommandScopeAllChatAdministrators)
+}
+
+func (botCommandScopeAllChatAdministrators *BotCommandScopeAllChatAdministrators) appendJSON(b []byte) ([]byte, error) {
+	if botCommandScopeAllChatAdministrators == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, botCommandScopeAllChatAdministrators.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, botCommandScopeAllChatAdministrators.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (botCommandScopeAllChatAdministrators *BotCommandScopeAllChatAdministrators) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			botCommandScopeAllChatAdministrators.tdCommon.Type, err = r.readString()
+		case "@extra":
+			botCommandScopeAllChatAdministrators.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (botCommandScopeAllChatAdministrators *BotCommandScopeAllChatAdministrators) Clone() *BotCommandScopeAllChatAdministrators {
+	if botCommandScopeAllChatAdministrators == nil {
+		return nil
+	}
+	clone := *botCommandScopeAllChatAdministrators
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (botCommandScopeAllChatAdministrators *BotCommandScopeAllChatAdministrators) Equal(other *BotCommandScopeAllChatAdministrators) bool {
+	if botCommandScopeAllChatAdministrators == nil || other == nil {
+		return botCommandScopeAllChatAdministrators == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (botCommandScopeAllChatAdministrators *BotCommandScopeAllChatAdministrators) Diff(other *BotCommandScopeAllChatAdministrators) []Change {
+	return botCommandScopeAllChatAdministrators.diff("", other, nil)
+}
+
+func (botCommandScopeAllChatAdministrators *BotCommandScopeAllChatAdministrators) diff(path string, other *BotCommandScopeAllChatAdministrators, changes []Change) []Change {
+	if botCommandScopeAllChatAdministrators == nil || other == nil {
+		if botCommandScopeAllChatAdministrators != other {
+			changes = append(changes, Change{Path: path, Old: botCommandScopeAllChatAdministrators, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (botCommandScopeAllChatAdministrators *BotCommandScopeAllChatAdministrators) String() string {
+	return objectString(botCommandScopeAllChatAdministrators)
+}
+
+// LogValue logs the object as its summary
+func (botCommandScopeAllChatAdministrators *BotCommandScopeAllChatAdministrators) LogValue() slog.Value {
+	return slog.StringValue(objectString(botCommandScopeAllChatAdministrators))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (botCommandScopeAllChatAdministrators *BotCommandScopeAllChatAdministrators) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, botCommandScopeAllChatAdministrators)
+}
+
+func (botCommandScopeAllChatAdministrators *BotCommandScopeAllChatAdministrators) printText(p *printer) {
+	if botCommandScopeAllChatAdministrators == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("BotCommandScopeAllChatAdministrators")
+	p.endObject(state)
+}
+
 // GetBotCommandScopeEnum return the enum type of this object
 func (botCommandScopeAllChatAdministrators *BotCommandScopeAllChatAdministrators) GetBotCommandScopeEnum() BotCommandScopeEnum {
 	return BotCommandScopeAllChatAdministratorsType
 }
 
+// Accept calls the method of the visitor for BotCommandScopeAllChatAdministrators
+func (botCommandScopeAllChatAdministrators *BotCommandScopeAllChatAdministrators) Accept(visitor BotCommandScopeVisitor) {
+	visitor.VisitBotCommandScopeAllChatAdministrators(botCommandScopeAllChatAdministrators)
+}
+
 // BotCommandScopeChat A scope covering all members of a chat
 type BotCommandScopeChat struct {
 	tdCommon
@@ -199,11 +624,118 @@ func NewBotCommandScopeChat(chatId int64) *BotCommandScopeChat {
 	return &botCommandScopeChatTemp
 }
 
+// MarshalJSON marshals to json
+func (botCommandScopeChat *BotCommandScopeChat) MarshalJSON() ([]byte, error) {
+	return botCommandScopeChat.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (botCommandScopeChat *BotCommandScopeChat) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, botCommandScopeChat)
+}
+
+func (botCommandScopeChat *BotCommandScopeChat) appendJSON(b []byte) ([]byte, error) {
+	if botCommandScopeChat == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, botCommandScopeChat.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, botCommandScopeChat.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(botCommandScopeChat.ChatId))
+	return append(b, '}'), nil
+}
+
+func (botCommandScopeChat *BotCommandScopeChat) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			botCommandScopeChat.tdCommon.Type, err = r.readString()
+		case "@extra":
+			botCommandScopeChat.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			botCommandScopeChat.ChatId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (botCommandScopeChat *BotCommandScopeChat) Clone() *BotCommandScopeChat {
+	if botCommandScopeChat == nil {
+		return nil
+	}
+	clone := *botCommandScopeChat
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (botCommandScopeChat *BotCommandScopeChat) Equal(other *BotCommandScopeChat) bool {
+	if botCommandScopeChat == nil || other == nil {
+		return botCommandScopeChat == other
+	}
+	if botCommandScopeChat.ChatId != other.ChatId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (botCommandScopeChat *BotCommandScopeChat) Diff(other *BotCommandScopeChat) []Change {
+	return botCommandScopeChat.diff("", other, nil)
+}
+
+func (botCommandScopeChat *BotCommandScopeChat) diff(path string, other *BotCommandScopeChat, changes []Change) []Change {
+	if botCommandScopeChat == nil || other == nil {
+		if botCommandScopeChat != other {
+			changes = append(changes, Change{Path: path, Old: botCommandScopeChat, New: other})
+		}
+		return changes
+	}
+	if botCommandScopeChat.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: botCommandScopeChat.ChatId, New: other.ChatId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (botCommandScopeChat *BotCommandScopeChat) String() string {
+	return objectString(botCommandScopeChat)
+}
+
+// LogValue logs the object as its summary
+func (botCommandScopeChat *BotCommandScopeChat) LogValue() slog.Value {
+	return slog.StringValue(objectString(botCommandScopeChat))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (botCommandScopeChat *BotCommandScopeChat) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, botCommandScopeChat)
+}
+
+func (botCommandScopeChat *BotCommandScopeChat) printText(p *printer) {
+	if botCommandScopeChat == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("BotCommandScopeChat")
+	p.int("chat_id", int64(botCommandScopeChat.ChatId))
+	p.endObject(state)
+}
+
 // GetBotCommandScopeEnum return the enum type of this object
 func (botCommandScopeChat *BotCommandScopeChat) GetBotCommandScopeEnum() BotCommandScopeEnum {
 	return BotCommandScopeChatType
 }
 
+// Accept calls the method of the visitor for BotCommandScopeChat
+func (botCommandScopeChat *BotCommandScopeChat) Accept(visitor BotCommandScopeVisitor) {
+	visitor.VisitBotCommandScopeChat(botCommandScopeChat)
+}
+
 // BotCommandScopeChatAdministrators A scope covering all administrators of a chat
 type BotCommandScopeChatAdministrators struct {
 	tdCommon
@@ -227,11 +759,118 @@ func NewBotCommandScopeChatAdministrators(chatId int64) *BotCommandScopeChatAdmi
 	return &botCommandScopeChatAdministratorsTemp
 }
 
+// MarshalJSON marshals to json
+func (botCommandScopeChatAdministrators *BotCommandScopeChatAdministrators) MarshalJSON() ([]byte, error) {
+	return botCommandScopeChatAdministrators.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (botCommandScopeChatAdministrators *BotCommandScopeChatAdministrators) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, botCommandScopeChatAdministrators)
+}
+
+func (botCommandScopeChatAdministrators *BotCommandScopeChatAdministrators) appendJSON(b []byte) ([]byte, error) {
+	if botCommandScopeChatAdministrators == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, botCommandScopeChatAdministrators.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, botCommandScopeChatAdministrators.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(botCommandScopeChatAdministrators.ChatId))
+	return append(b, '}'), nil
+}
+
+func (botCommandScopeChatAdministrators *BotCommandScopeChatAdministrators) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			botCommandScopeChatAdministrators.tdCommon.Type, err = r.readString()
+		case "@extra":
+			botCommandScopeChatAdministrators.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			botCommandScopeChatAdministrators.ChatId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (botCommandScopeChatAdministrators *BotCommandScopeChatAdministrators) Clone() *BotCommandScopeChatAdministrators {
+	if botCommandScopeChatAdministrators == nil {
+		return nil
+	}
+	clone := *botCommandScopeChatAdministrators
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (botCommandScopeChatAdministrators *BotCommandScopeChatAdministrators) Equal(other *BotCommandScopeChatAdministrators) bool {
+	if botCommandScopeChatAdministrators == nil || other == nil {
+		return botCommandScopeChatAdministrators == other
+	}
+	if botCommandScopeChatAdministrators.ChatId != other.ChatId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (botCommandScopeChatAdministrators *BotCommandScopeChatAdministrators) Diff(other *BotCommandScopeChatAdministrators) []Change {
+	return botCommandScopeChatAdministrators.diff("", other, nil)
+}
+
+func (botCommandScopeChatAdministrators *BotCommandScopeChatAdministrators) diff(path string, other *BotCommandScopeChatAdministrators, changes []Change) []Change {
+	if botCommandScopeChatAdministrators == nil || other == nil {
+		if botCommandScopeChatAdministrators != other {
+			changes = append(changes, Change{Path: path, Old: botCommandScopeChatAdministrators, New: other})
+		}
+		return changes
+	}
+	if botCommandScopeChatAdministrators.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: botCommandScopeChatAdministrators.ChatId, New: other.ChatId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (botCommandScopeChatAdministrators *BotCommandScopeChatAdministrators) String() string {
+	return objectString(botCommandScopeChatAdministrators)
+}
+
+// LogValue logs the object as its summary
+func (botCommandScopeChatAdministrators *BotCommandScopeChatAdministrators) LogValue() slog.Value {
+	return slog.StringValue(objectString(botCommandScopeChatAdministrators))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (botCommandScopeChatAdministrators *BotCommandScopeChatAdministrators) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, botCommandScopeChatAdministrators)
+}
+
+func (botCommandScopeChatAdministrators *BotCommandScopeChatAdministrators) printText(p *printer) {
+	if botCommandScopeChatAdministrators == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("BotCommandScopeChatAdministrators")
+	p.int("chat_id", int64(botCommandScopeChatAdministrators.ChatId))
+	p.endObject(state)
+}
+
 // GetBotCommandScopeEnum return the enum type of this object
 func (botCommandScopeChatAdministrators *BotCommandScopeChatAdministrators) GetBotCommandScopeEnum() BotCommandScopeEnum {
 	return BotCommandScopeChatAdministratorsType
 }
 
+// Accept calls the method of the visitor for BotCommandScopeChatAdministrators
+func (botCommandScopeChatAdministrators *BotCommandScopeChatAdministrators) Accept(visitor BotCommandScopeVisitor) {
+	visitor.VisitBotCommandScopeChatAdministrators(botCommandScopeChatAdministrators)
+}
+
 // BotCommandScopeChatMember A scope covering a member of a chat
 type BotCommandScopeChatMember struct {
 	tdCommon
@@ -258,7 +897,295 @@ func NewBotCommandScopeChatMember(chatId int64, userId int64) *BotCommandScopeCh
 	return &botCommandScopeChatMemberTemp
 }
 
+// MarshalJSON marshals to json
+func (botCommandScopeChatMember *BotCommandScopeChatMember) MarshalJSON() ([]byte, error) {
+	return botCommandScopeChatMember.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (botCommandScopeChatMember *BotCommandScopeChatMember) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, botCommandScopeChatMember)
+}
+
+func (botCommandScopeChatMember *BotCommandScopeChatMember) appendJSON(b []byte) ([]byte, error) {
+	if botCommandScopeChatMember == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, botCommandScopeChatMember.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, botCommandScopeChatMember.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(botCommandScopeChatMember.ChatId))
+	b = append(b, `,"user_id":`...)
+	b = appendJSONInt(b, int64(botCommandScopeChatMember.UserId))
+	return append(b, '}'), nil
+}
+
+func (botCommandScopeChatMember *BotCommandScopeChatMember) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			botCommandScopeChatMember.tdCommon.Type, err = r.readString()
+		case "@extra":
+			botCommandScopeChatMember.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			botCommandScopeChatMember.ChatId, err = r.readInt64()
+		case "user_id":
+			botCommandScopeChatMember.UserId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (botCommandScopeChatMember *BotCommandScopeChatMember) Clone() *BotCommandScopeChatMember {
+	if botCommandScopeChatMember == nil {
+		return nil
+	}
+	clone := *botCommandScopeChatMember
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (botCommandScopeChatMember *BotCommandScopeChatMember) Equal(other *BotCommandScopeChatMember) bool {
+	if botCommandScopeChatMember == nil || other == nil {
+		return botCommandScopeChatMember == other
+	}
+	if botCommandScopeChatMember.ChatId != other.ChatId {
+		return false
+	}
+	if botCommandScopeChatMember.UserId != other.UserId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (botCommandScopeChatMember *BotCommandScopeChatMember) Diff(other *BotCommandScopeChatMember) []Change {
+	return botCommandScopeChatMember.diff("", other, nil)
+}
+
+func (botCommandScopeChatMember *BotCommandScopeChatMember) diff(path string, other *BotCommandScopeChatMember, changes []Change) []Change {
+	if botCommandScopeChatMember == nil || other == nil {
+		if botCommandScopeChatMember != other {
+			changes = append(changes, Change{Path: path, Old: botCommandScopeChatMember, New: other})
+		}
+		return changes
+	}
+	if botCommandScopeChatMember.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: botCommandScopeChatMember.ChatId, New: other.ChatId})
+	}
+	if botCommandScopeChatMember.UserId != other.UserId {
+		changes = append(changes, Change{Path: fieldPath(path, "user_id"), Old: botCommandScopeChatMember.UserId, New: other.UserId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (botCommandScopeChatMember *BotCommandScopeChatMember) String() string {
+	return objectString(botCommandScopeChatMember)
+}
+
+// LogValue logs the object as its summary
+func (botCommandScopeChatMember *BotCommandScopeChatMember) LogValue() slog.Value {
+	return slog.StringValue(objectString(botCommandScopeChatMember))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (botCommandScopeChatMember *BotCommandScopeChatMember) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, botCommandScopeChatMember)
+}
+
+func (botCommandScopeChatMember *BotCommandScopeChatMember) printText(p *printer) {
+	if botCommandScopeChatMember == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("BotCommandScopeChatMember")
+	p.int("chat_id", int64(botCommandScopeChatMember.ChatId))
+	p.int("user_id", int64(botCommandScopeChatMember.UserId))
+	p.endObject(state)
+}
+
 // GetBotCommandScopeEnum return the enum type of this object
 func (botCommandScopeChatMember *BotCommandScopeChatMember) GetBotCommandScopeEnum() BotCommandScopeEnum {
 	return BotCommandScopeChatMemberType
 }
+
+// Accept calls the method of the visitor for BotCommandScopeChatMember
+func (botCommandScopeChatMember *BotCommandScopeChatMember) Accept(visitor BotCommandScopeVisitor) {
+	visitor.VisitBotCommandScopeChatMember(botCommandScopeChatMember)
+}
+
+// BotCommandScopeVisitor visits every type of BotCommandScope, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to BotCommandScope, until they handle it too.
+type BotCommandScopeVisitor interface {
+	VisitBotCommandScopeDefault(botCommandScopeDefault *BotCommandScopeDefault)
+	VisitBotCommandScopeAllPrivateChats(botCommandScopeAllPrivateChats *BotCommandScopeAllPrivateChats)
+	VisitBotCommandScopeAllGroupChats(botCommandScopeAllGroupChats *BotCommandScopeAllGroupChats)
+	VisitBotCommandScopeAllChatAdministrators(botCommandScopeAllChatAdministrators *BotCommandScopeAllChatAdministrators)
+	VisitBotCommandScopeChat(botCommandScopeChat *BotCommandScopeChat)
+	VisitBotCommandScopeChatAdministrators(botCommandScopeChatAdministrators *BotCommandScopeChatAdministrators)
+	VisitBotCommandScopeChatMember(botCommandScopeChatMember *BotCommandScopeChatMember)
+	VisitUnknownBotCommandScope(unknownBotCommandScope *UnknownBotCommandScope)
+}
+
+// BotCommandScopeCases holds the function MatchBotCommandScope calls for each type of BotCommandScope;
+// types without a function are passed to the default case
+type BotCommandScopeCases[R any] struct {
+	BotCommandScopeDefault               func(botCommandScopeDefault *BotCommandScopeDefault) R
+	BotCommandScopeAllPrivateChats       func(botCommandScopeAllPrivateChats *BotCommandScopeAllPrivateChats) R
+	BotCommandScopeAllGroupChats         func(botCommandScopeAllGroupChats *BotCommandScopeAllGroupChats) R
+	BotCommandScopeAllChatAdministrators func(botCommandScopeAllChatAdministrators *BotCommandScopeAllChatAdministrators) R
+	BotCommandScopeChat                  func(botCommandScopeChat *BotCommandScopeChat) R
+	BotCommandScopeChatAdministrators    func(botCommandScopeChatAdministrators *BotCommandScopeChatAdministrators) R
+	BotCommandScopeChatMember            func(botCommandScopeChatMember *BotCommandScopeChatMember) R
+}
+
+// MatchBotCommandScope calls the case of the type of botCommandScope, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement BotCommandScopeVisitor
+// to have the compiler check every type is handled.
+func MatchBotCommandScope[R any](botCommandScope BotCommandScope, cases BotCommandScopeCases[R], defaultCase func(botCommandScope BotCommandScope) R) R {
+	switch value := botCommandScope.(type) {
+	case *BotCommandScopeDefault:
+		if cases.BotCommandScopeDefault != nil {
+			return cases.BotCommandScopeDefault(value)
+		}
+	case *BotCommandScopeAllPrivateChats:
+		if cases.BotCommandScopeAllPrivateChats != nil {
+			return cases.BotCommandScopeAllPrivateChats(value)
+		}
+	case *BotCommandScopeAllGroupChats:
+		if cases.BotCommandScopeAllGroupChats != nil {
+			return cases.BotCommandScopeAllGroupChats(value)
+		}
+	case *BotCommandScopeAllChatAdministrators:
+		if cases.BotCommandScopeAllChatAdministrators != nil {
+			return cases.BotCommandScopeAllChatAdministrators(value)
+		}
+	case *BotCommandScopeChat:
+		if cases.BotCommandScopeChat != nil {
+			return cases.BotCommandScopeChat(value)
+		}
+	case *BotCommandScopeChatAdministrators:
+		if cases.BotCommandScopeChatAdministrators != nil {
+			return cases.BotCommandScopeChatAdministrators(value)
+		}
+	case *BotCommandScopeChatMember:
+		if cases.BotCommandScopeChatMember != nil {
+			return cases.BotCommandScopeChatMember(value)
+		}
+	}
+	return defaultCase(botCommandScope)
+}
+
+// Clone returns a deep copy of the object
+func (unknownBotCommandScope *UnknownBotCommandScope) Clone() *UnknownBotCommandScope {
+	if unknownBotCommandScope == nil {
+		return nil
+	}
+	return &UnknownBotCommandScope{Unknown: unknownBotCommandScope.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownBotCommandScope *UnknownBotCommandScope) Equal(other *UnknownBotCommandScope) bool {
+	if unknownBotCommandScope == nil || other == nil {
+		return unknownBotCommandScope == other
+	}
+	return unknownBotCommandScope.Unknown.equal(other.Unknown)
+}
+
+func cloneBotCommandScope(botCommandScope BotCommandScope) BotCommandScope {
+	switch value := botCommandScope.(type) {
+	case *BotCommandScopeDefault:
+		return value.Clone()
+	case *BotCommandScopeAllPrivateChats:
+		return value.Clone()
+	case *BotCommandScopeAllGroupChats:
+		return value.Clone()
+	case *BotCommandScopeAllChatAdministrators:
+		return value.Clone()
+	case *BotCommandScopeChat:
+		return value.Clone()
+	case *BotCommandScopeChatAdministrators:
+		return value.Clone()
+	case *BotCommandScopeChatMember:
+		return value.Clone()
+	case *UnknownBotCommandScope:
+		return value.Clone()
+	}
+	return botCommandScope
+}
+
+func equalBotCommandScope(a BotCommandScope, b BotCommandScope) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *BotCommandScopeDefault:
+		b, ok := b.(*BotCommandScopeDefault)
+		return ok && a.Equal(b)
+	case *BotCommandScopeAllPrivateChats:
+		b, ok := b.(*BotCommandScopeAllPrivateChats)
+		return ok && a.Equal(b)
+	case *BotCommandScopeAllGroupChats:
+		b, ok := b.(*BotCommandScopeAllGroupChats)
+		return ok && a.Equal(b)
+	case *BotCommandScopeAllChatAdministrators:
+		b, ok := b.(*BotCommandScopeAllChatAdministrators)
+		return ok && a.Equal(b)
+	case *BotCommandScopeChat:
+		b, ok := b.(*BotCommandScopeChat)
+		return ok && a.Equal(b)
+	case *BotCommandScopeChatAdministrators:
+		b, ok := b.(*BotCommandScopeChatAdministrators)
+		return ok && a.Equal(b)
+	case *BotCommandScopeChatMember:
+		b, ok := b.(*BotCommandScopeChatMember)
+		return ok && a.Equal(b)
+	case *UnknownBotCommandScope:
+		b, ok := b.(*UnknownBotCommandScope)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffBotCommandScope(path string, a BotCommandScope, b BotCommandScope, changes []Change) []Change {
+	switch a := a.(type) {
+	case *BotCommandScopeDefault:
+		if b, ok := b.(*BotCommandScopeDefault); ok {
+			return a.diff(path, b, changes)
+		}
+	case *BotCommandScopeAllPrivateChats:
+		if b, ok := b.(*BotCommandScopeAllPrivateChats); ok {
+			return a.diff(path, b, changes)
+		}
+	case *BotCommandScopeAllGroupChats:
+		if b, ok := b.(*BotCommandScopeAllGroupChats); ok {
+			return a.diff(path, b, changes)
+		}
+	case *BotCommandScopeAllChatAdministrators:
+		if b, ok := b.(*BotCommandScopeAllChatAdministrators); ok {
+			return a.diff(path, b, changes)
+		}
+	case *BotCommandScopeChat:
+		if b, ok := b.(*BotCommandScopeChat); ok {
+			return a.diff(path, b, changes)
+		}
+	case *BotCommandScopeChatAdministrators:
+		if b, ok := b.(*BotCommandScopeChatAdministrators); ok {
+			return a.diff(path, b, changes)
+		}
+	case *BotCommandScopeChatMember:
+		if b, ok := b.(*BotCommandScopeChatMember); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalBotCommandScope(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // BotCommands Contains a list of bot commands
@@ -31,6 +31,161 @@ func NewBotCommands(botUserId int64, commands []BotCommand) *BotCommands {
 	return &botCommandsTemp
 }
 
+// MarshalJSON marshals to json
+func (botCommands *BotCommands) MarshalJSON() ([]byte, error) {
+	return botCommands.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (botCommands *BotCommands) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, botCommands)
+}
+
+func (botCommands *BotCommands) appendJSON(b []byte) ([]byte, error) {
+	if botCommands == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, botCommands.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, botCommands.tdCommon.Extra)
+	b = append(b, `,"bot_user_id":`...)
+	b = appendJSONInt(b, int64(botCommands.BotUserId))
+	b = append(b, `,"commands":`...)
+	if botCommands.Commands == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range botCommands.Commands {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = botCommands.Commands[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (botCommands *BotCommands) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			botCommands.tdCommon.Type, err = r.readString()
+		case "@extra":
+			botCommands.tdCommon.Extra, err = r.readString()
+		case "bot_user_id":
+			botCommands.BotUserId, err = r.readInt64()
+		case "commands":
+			if r.readNull() {
+				botCommands.Commands = nil
+			} else {
+				botCommands.Commands = make([]BotCommand, 0)
+				err = r.readArray(func() (err error) {
+					var item0 BotCommand
+					err = item0.decodeJSON(r)
+					botCommands.Commands = append(botCommands.Commands, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (botCommands *BotCommands) Clone() *BotCommands {
+	if botCommands == nil {
+		return nil
+	}
+	clone := *botCommands
+	if botCommands.Commands != nil {
+		clone.Commands = make([]BotCommand, len(botCommands.Commands))
+		for i0 := range botCommands.Commands {
+			clone.Commands[i0] = *botCommands.Commands[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (botCommands *BotCommands) Equal(other *BotCommands) bool {
+	if botCommands == nil || other == nil {
+		return botCommands == other
+	}
+	if botCommands.BotUserId != other.BotUserId {
+		return false
+	}
+	if len(botCommands.Commands) != len(other.Commands) {
+		return false
+	}
+	for i0 := range botCommands.Commands {
+		if !botCommands.Commands[i0].Equal(&other.Commands[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (botCommands *BotCommands) Diff(other *BotCommands) []Change {
+	return botCommands.diff("", other, nil)
+}
+
+func (botCommands *BotCommands) diff(path string, other *BotCommands, changes []Change) []Change {
+	if botCommands == nil || other == nil {
+		if botCommands != other {
+			changes = append(changes, Change{Path: path, Old: botCommands, New: other})
+		}
+		return changes
+	}
+	if botCommands.BotUserId != other.BotUserId {
+		changes = append(changes, Change{Path: fieldPath(path, "bot_user_id"), Old: botCommands.BotUserId, New: other.BotUserId})
+	}
+	if len(botCommands.Commands) != len(other.Commands) {
+		changes = append(changes, Change{Path: fieldPath(path, "commands"), Old: botCommands.Commands, New: other.Commands})
+	} else {
+		for i0 := range botCommands.Commands {
+			changes = botCommands.Commands[i0].diff(indexPath(fieldPath(path, "commands"), i0), &other.Commands[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (botCommands *BotCommands) String() string {
+	return objectString(botCommands)
+}
+
+// LogValue logs the object as its summary
+func (botCommands *BotCommands) LogValue() slog.Value {
+	return slog.StringValue(objectString(botCommands))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (botCommands *BotCommands) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, botCommands)
+}
+
+func (botCommands *BotCommands) printText(p *printer) {
+	if botCommands == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("BotCommands")
+	p.int("bot_user_id", int64(botCommands.BotUserId))
+	p.vector("commands", len(botCommands.Commands), func(i0 int) {
+		botCommands.Commands[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetCommands Returns the list of commands supported by the bot for the given user scope and language; for bots only
 // @param scope The scope to which the commands are relevant; pass null to get commands in the default bot command scope
 // @param languageCode A two-letter ISO 639-1 country code or an empty string
@@ -45,11 +200,11 @@ func (client *Client) GetCommands(scope BotCommandScope, languageCode string) (*
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var botCommands BotCommands
-	err = json.Unmarshal(result.Raw, &botCommands)
+	err = jsonUnmarshal(result.Raw, &botCommands)
 	return &botCommands, err
 }
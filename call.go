@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // Call Describes a call
@@ -39,34 +40,151 @@ func NewCall(id int32, userId int64, isOutgoing bool, isVideo bool, state CallSt
 	return &callTemp
 }
 
+// MarshalJSON marshals to json
+func (call *Call) MarshalJSON() ([]byte, error) {
+	return call.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (call *Call) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, call)
+}
+
+func (call *Call) appendJSON(b []byte) ([]byte, error) {
+	if call == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, call.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, call.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt(b, int64(call.Id))
+	b = append(b, `,"user_id":`...)
+	b = appendJSONInt(b, int64(call.UserId))
+	b = append(b, `,"is_outgoing":`...)
+	b = appendJSONBool(b, call.IsOutgoing)
+	b = append(b, `,"is_video":`...)
+	b = appendJSONBool(b, call.IsVideo)
+	b = append(b, `,"state":`...)
+	b, err = appendJSONValue(b, call.State)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-		Id         int32 `json:"id"`          // Call identifier, not persistent
-		UserId     int64 `json:"user_id"`     // Peer user identifier
-		IsOutgoing bool  `json:"is_outgoing"` // True, if the call is outgoing
-		IsVideo    bool  `json:"is_video"`    // True, if the call is a video call
+	return append(b, '}'), nil
+}
 
-	}{}
-	err = json.Unmarshal(b, &tempObj)
-	if err != nil {
-		return err
+func (call *Call) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			call.tdCommon.Type, err = r.readString()
+		case "@extra":
+			call.tdCommon.Extra, err = r.readString()
+		case "id":
+			call.Id, err = r.readInt32()
+		case "user_id":
+			call.UserId, err = r.readInt64()
+		case "is_outgoing":
+			call.IsOutgoing, err = r.readBool()
+		case "is_video":
+			call.IsVideo, err = r.readBool()
+		case "state":
+			call.State, err = decodeCallState(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (call *Call) Clone() *Call {
+	if call == nil {
+		return nil
+	}
+	clone := *call
+	clone.State = cloneCallState(call.State)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (call *Call) Equal(other *Call) bool {
+	if call == nil || other == nil {
+		return call == other
+	}
+	if call.Id != other.Id {
+		return false
+	}
+	if call.UserId != other.UserId {
+		return false
+	}
+	if call.IsOutgoing != other.IsOutgoing {
+		return false
+	}
+	if call.IsVideo != other.IsVideo {
+		return false
 	}
+	if !equalCallState(call.State, other.State) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (call *Call) Diff(other *Call) []Change {
+	return call.diff("", other, nil)
+}
+
+func (call *Call) diff(path string, other *Call, changes []Change) []Change {
+	if call == nil || other == nil {
+		if call != other {
+			changes = append(changes, Change{Path: path, Old: call, New: other})
+		}
+		return changes
+	}
+	if call.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: call.Id, New: other.Id})
+	}
+	if call.UserId != other.UserId {
+		changes = append(changes, Change{Path: fieldPath(path, "user_id"), Old: call.UserId, New: other.UserId})
+	}
+	if call.IsOutgoing != other.IsOutgoing {
+		changes = append(changes, Change{Path: fieldPath(path, "is_outgoing"), Old: call.IsOutgoing, New: other.IsOutgoing})
+	}
+	if call.IsVideo != other.IsVideo {
+		changes = append(changes, Change{Path: fieldPath(path, "is_video"), Old: call.IsVideo, New: other.IsVideo})
+	}
+	changes = diffCallState(fieldPath(path, "state"), call.State, other.State, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (call *Call) String() string {
+	return objectString(call)
+}
 
-	call.tdCommon = tempObj.tdCommon
-	call.Id = tempObj.Id
-	call.UserId = tempObj.UserId
-	call.IsOutgoing = tempObj.IsOutgoing
-	call.IsVideo = tempObj.IsVideo
+// LogValue logs the object as its summary
+func (call *Call) LogValue() slog.Value {
+	return slog.StringValue(objectString(call))
+}
 
-	fieldState, _ := unmarshalCallState(objMap["state"])
-	call.State = fieldState
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (call *Call) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, call)
+}
 
-	return nil
+func (call *Call) printText(p *printer) {
+	if call == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Call")
+	p.int("id", int64(call.Id))
+	p.int("user_id", int64(call.UserId))
+	p.bool("is_outgoing", call.IsOutgoing)
+	p.bool("is_video", call.IsVideo)
+	p.object("state", call.State)
+	p.endObject(state)
 }
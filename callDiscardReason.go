@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // CallDiscardReason Describes the reason why a call was discarded
 type CallDiscardReason interface {
 	GetCallDiscardReasonEnum() CallDiscardReasonEnum
+	Accept(visitor CallDiscardReasonVisitor)
 }
 
 // CallDiscardReasonEnum Alias for abstract CallDiscardReason 'Sub-Classes', used as constant-enum here
@@ -22,46 +24,97 @@ const (
 	CallDiscardReasonHungUpType       CallDiscardReasonEnum = "callDiscardReasonHungUp"
 )
 
+// UnknownCallDiscardReason is a CallDiscardReason of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownCallDiscardReason struct {
+	Unknown
+}
+
+// GetCallDiscardReasonEnum return the enum type of this object
+func (unknownCallDiscardReason *UnknownCallDiscardReason) GetCallDiscardReasonEnum() CallDiscardReasonEnum {
+	return CallDiscardReasonEnum(unknownCallDiscardReason.Type)
+}
+
+// Accept calls the method of the visitor for UnknownCallDiscardReason
+func (unknownCallDiscardReason *UnknownCallDiscardReason) Accept(visitor CallDiscardReasonVisitor) {
+	visitor.VisitUnknownCallDiscardReason(unknownCallDiscardReason)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownCallDiscardReason *UnknownCallDiscardReason) String() string {
+	return objectString(unknownCallDiscardReason)
+}
+
+// LogValue logs the object as its summary
+func (unknownCallDiscardReason *UnknownCallDiscardReason) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownCallDiscardReason))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownCallDiscardReason *UnknownCallDiscardReason) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownCallDiscardReason)
+}
+
+func (unknownCallDiscardReason *UnknownCallDiscardReason) printText(p *printer) {
+	if unknownCallDiscardReason == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownCallDiscardReason")
+	p.string("@type", unknownCallDiscardReason.Type)
+	p.endObject(state)
+}
+
 func unmarshalCallDiscardReason(rawMsg *json.RawMessage) (CallDiscardReason, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch CallDiscardReasonEnum(objMap["@type"].(string)) {
+	switch CallDiscardReasonEnum(typeName) {
 	case CallDiscardReasonEmptyType:
 		var callDiscardReasonEmpty CallDiscardReasonEmpty
-		err := json.Unmarshal(*rawMsg, &callDiscardReasonEmpty)
+		err := jsonUnmarshal(*rawMsg, &callDiscardReasonEmpty)
 		return &callDiscardReasonEmpty, err
 
 	case CallDiscardReasonMissedType:
 		var callDiscardReasonMissed CallDiscardReasonMissed
-		err := json.Unmarshal(*rawMsg, &callDiscardReasonMissed)
+		err := jsonUnmarshal(*rawMsg, &callDiscardReasonMissed)
 		return &callDiscardReasonMissed, err
 
 	case CallDiscardReasonDeclinedType:
 		var callDiscardReasonDeclined CallDiscardReasonDeclined
-		err := json.Unmarshal(*rawMsg, &callDiscardReasonDeclined)
+		err := jsonUnmarshal(*rawMsg, &callDiscardReasonDeclined)
 		return &callDiscardReasonDeclined, err
 
 	case CallDiscardReasonDisconnectedType:
 		var callDiscardReasonDisconnected CallDiscardReasonDisconnected
-		err := json.Unmarshal(*rawMsg, &callDiscardReasonDisconnected)
+		err := jsonUnmarshal(*rawMsg, &callDiscardReasonDisconnected)
 		return &callDiscardReasonDisconnected, err
 
 	case CallDiscardReasonHungUpType:
 		var callDiscardReasonHungUp CallDiscardReasonHungUp
-		err := json.Unmarshal(*rawMsg, &callDiscardReasonHungUp)
+		err := jsonUnmarshal(*rawMsg, &callDiscardReasonHungUp)
 		return &callDiscardReasonHungUp, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownCallDiscardReason{Unknown: unknown}, nil
+	}
+}
+
+func decodeCallDiscardReason(r *jsonReader) (CallDiscardReason, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
 	}
+	return unmarshalCallDiscardReason(rawMsg)
 }
 
 // CallDiscardReasonEmpty The call wasn't discarded, or the reason is unknown
@@ -75,7 +128,6 @@ func (callDiscardReasonEmpty *CallDiscardReasonEmpty) MessageType() string {
 }
 
 // NewCallDiscardReasonEmpty creates a new CallDiscardReasonEmpty
-//
 func NewCallDiscardReasonEmpty() *CallDiscardReasonEmpty {
 	callDiscardReasonEmptyTemp := CallDiscardReasonEmpty{
 		tdCommon: tdCommon{Type: "callDiscardReasonEmpty"},
@@ -84,11 +136,107 @@ func NewCallDiscardReasonEmpty() *CallDiscardReasonEmpty {
 	return &callDiscardReasonEmptyTemp
 }
 
+// MarshalJSON marshals to json
+func (callDiscardReasonEmpty *CallDiscardReasonEmpty) MarshalJSON() ([]byte, error) {
+	return callDiscardReasonEmpty.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (callDiscardReasonEmpty *CallDiscardReasonEmpty) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, callDiscardReasonEmpty)
+}
+
+func (callDiscardReasonEmpty *CallDiscardReasonEmpty) appendJSON(b []byte) ([]byte, error) {
+	if callDiscardReasonEmpty == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callDiscardReasonEmpty.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callDiscardReasonEmpty.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (callDiscardReasonEmpty *CallDiscardReasonEmpty) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callDiscardReasonEmpty.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callDiscardReasonEmpty.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callDiscardReasonEmpty *CallDiscardReasonEmpty) Clone() *CallDiscardReasonEmpty {
+	if callDiscardReasonEmpty == nil {
+		return nil
+	}
+	clone := *callDiscardReasonEmpty
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callDiscardReasonEmpty *CallDiscardReasonEmpty) Equal(other *CallDiscardReasonEmpty) bool {
+	if callDiscardReasonEmpty == nil || other == nil {
+		return callDiscardReasonEmpty == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callDiscardReasonEmpty *CallDiscardReasonEmpty) Diff(other *CallDiscardReasonEmpty) []Change {
+	return callDiscardReasonEmpty.diff("", other, nil)
+}
+
+func (callDiscardReasonEmpty *CallDiscardReasonEmpty) diff(path string, other *CallDiscardReasonEmpty, changes []Change) []Change {
+	if callDiscardReasonEmpty == nil || other == nil {
+		if callDiscardReasonEmpty != other {
+			changes = append(changes, Change{Path: path, Old: callDiscardReasonEmpty, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callDiscardReasonEmpty *CallDiscardReasonEmpty) String() string {
+	return objectString(callDiscardReasonEmpty)
+}
+
+// LogValue logs the object as its summary
+func (callDiscardReasonEmpty *CallDiscardReasonEmpty) LogValue() slog.Value {
+	return slog.StringValue(objectString(callDiscardReasonEmpty))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callDiscardReasonEmpty *CallDiscardReasonEmpty) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callDiscardReasonEmpty)
+}
+
+func (callDiscardReasonEmpty *CallDiscardReasonEmpty) printText(p *printer) {
+	if callDiscardReasonEmpty == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallDiscardReasonEmpty")
+	p.endObject(state)
+}
+
 // GetCallDiscardReasonEnum return the enum type of this object
 func (callDiscardReasonEmpty *CallDiscardReasonEmpty) GetCallDiscardReasonEnum() CallDiscardReasonEnum {
 	return CallDiscardReasonEmptyType
 }
 
+// Accept calls the method of the visitor for CallDiscardReasonEmpty
+func (callDiscardReasonEmpty *CallDiscardReasonEmpty) Accept(visitor CallDiscardReasonVisitor) {
+	visitor.VisitCallDiscardReasonEmpty(callDiscardReasonEmpty)
+}
+
 // CallDiscardReasonMissed The call was ended before the conversation started. It was canceled by the caller or missed by the other party
 type CallDiscardReasonMissed struct {
 	tdCommon
@@ -100,7 +248,6 @@ func (callDiscardReasonMissed *CallDiscardReasonMissed) MessageType() string {
 }
 
 // NewCallDiscardReasonMissed creates a new CallDiscardReasonMissed
-//
 func NewCallDiscardReasonMissed() *CallDiscardReasonMissed {
 	callDiscardReasonMissedTemp := CallDiscardReasonMissed{
 		tdCommon: tdCommon{Type: "callDiscardReasonMissed"},
@@ -109,11 +256,107 @@ func NewCallDiscardReasonMissed() *CallDiscardReasonMissed {
 	return &callDiscardReasonMissedTemp
 }
 
+// MarshalJSON marshals to json
+func (callDiscardReasonMissed *CallDiscardReasonMissed) MarshalJSON() ([]byte, error) {
+	return callDiscardReasonMissed.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (callDiscardReasonMissed *CallDiscardReasonMissed) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, callDiscardReasonMissed)
+}
+
+func (callDiscardReasonMissed *CallDiscardReasonMissed) appendJSON(b []byte) ([]byte, error) {
+	if callDiscardReasonMissed == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callDiscardReasonMissed.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callDiscardReasonMissed.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (callDiscardReasonMissed *CallDiscardReasonMissed) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callDiscardReasonMissed.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callDiscardReasonMissed.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callDiscardReasonMissed *CallDiscardReasonMissed) Clone() *CallDiscardReasonMissed {
+	if callDiscardReasonMissed == nil {
+		return nil
+	}
+	clone := *callDiscardReasonMissed
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callDiscardReasonMissed *CallDiscardReasonMissed) Equal(other *CallDiscardReasonMissed) bool {
+	if callDiscardReasonMissed == nil || other == nil {
+		return callDiscardReasonMissed == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callDiscardReasonMissed *CallDiscardReasonMissed) Diff(other *CallDiscardReasonMissed) []Change {
+	return callDiscardReasonMissed.diff("", other, nil)
+}
+
+func (callDiscardReasonMissed *CallDiscardReasonMissed) diff(path string, other *CallDiscardReasonMissed, changes []Change) []Change {
+	if callDiscardReasonMissed == nil || other == nil {
+		if callDiscardReasonMissed != other {
+			changes = append(changes, Change{Path: path, Old: callDiscardReasonMissed, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callDiscardReasonMissed *CallDiscardReasonMissed) String() string {
+	return objectString(callDiscardReasonMissed)
+}
+
+// LogValue logs the object as its summary
+func (callDiscardReasonMissed *CallDiscardReasonMissed) LogValue() slog.Value {
+	return slog.StringValue(objectString(callDiscardReasonMissed))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callDiscardReasonMissed *CallDiscardReasonMissed) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callDiscardReasonMissed)
+}
+
+func (callDiscardReasonMissed *CallDiscardReasonMissed) printText(p *printer) {
+	if callDiscardReasonMissed == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallDiscardReasonMissed")
+	p.endObject(state)
+}
+
 // GetCallDiscardReasonEnum return the enum type of this object
 func (callDiscardReasonMissed *CallDiscardReasonMissed) GetCallDiscardReasonEnum() CallDiscardReasonEnum {
 	return CallDiscardReasonMissedType
 }
 
+// Accept calls the method of the visitor for CallDiscardReasonMissed
+func (callDiscardReasonMissed *CallDiscardReasonMissed) Accept(visitor CallDiscardReasonVisitor) {
+	visitor.VisitCallDiscardReasonMissed(callDiscardReasonMissed)
+}
+
 // CallDiscardReasonDeclined The call was ended before the conversation started. It was declined by the other party
 type CallDiscardReasonDeclined struct {
 	tdCommon
@@ -125,7 +368,6 @@ func (callDiscardReasonDeclined *CallDiscardReasonDeclined) MessageType() string
 }
 
 // NewCallDiscardReasonDeclined creates a new CallDiscardReasonDeclined
-//
 func NewCallDiscardReasonDeclined() *CallDiscardReasonDeclined {
 	callDiscardReasonDeclinedTemp := CallDiscardReasonDeclined{
 		tdCommon: tdCommon{Type: "callDiscardReasonDeclined"},
@@ -134,11 +376,107 @@ func NewCallDiscardReasonDeclined() *CallDiscardReasonDeclined {
 	return &callDiscardReasonDeclinedTemp
 }
 
+// MarshalJSON marshals to json
+func (callDiscardReasonDeclined *CallDiscardReasonDeclined) MarshalJSON() ([]byte, error) {
+	return callDiscardReasonDeclined.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (callDiscardReasonDeclined *CallDiscardReasonDeclined) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, callDiscardReasonDeclined)
+}
+
+func (callDiscardReasonDeclined *CallDiscardReasonDeclined) appendJSON(b []byte) ([]byte, error) {
+	if callDiscardReasonDeclined == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callDiscardReasonDeclined.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callDiscardReasonDeclined.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (callDiscardReasonDeclined *CallDiscardReasonDeclined) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callDiscardReasonDeclined.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callDiscardReasonDeclined.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callDiscardReasonDeclined *CallDiscardReasonDeclined) Clone() *CallDiscardReasonDeclined {
+	if callDiscardReasonDeclined == nil {
+		return nil
+	}
+	clone := *callDiscardReasonDeclined
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callDiscardReasonDeclined *CallDiscardReasonDeclined) Equal(other *CallDiscardReasonDeclined) bool {
+	if callDiscardReasonDeclined == nil || other == nil {
+		return callDiscardReasonDeclined == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callDiscardReasonDeclined *CallDiscardReasonDeclined) Diff(other *CallDiscardReasonDeclined) []Change {
+	return callDiscardReasonDeclined.diff("", other, nil)
+}
+
+func (callDiscardReasonDeclined *CallDiscardReasonDeclined) diff(path string, other *CallDiscardReasonDeclined, changes []Change) []Change {
+	if callDiscardReasonDeclined == nil || other == nil {
+		if callDiscardReasonDeclined != other {
+			changes = append(changes, Change{Path: path, Old: callDiscardReasonDeclined, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callDiscardReasonDeclined *CallDiscardReasonDeclined) String() string {
+	return objectString(callDiscardReasonDeclined)
+}
+
+// LogValue logs the object as its summary
+func (callDiscardReasonDeclined *CallDiscardReasonDeclined) LogValue() slog.Value {
+	return slog.StringValue(objectString(callDiscardReasonDeclined))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callDiscardReasonDeclined *CallDiscardReasonDeclined) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callDiscardReasonDeclined)
+}
+
+func (callDiscardReasonDeclined *CallDiscardReasonDeclined) printText(p *printer) {
+	if callDiscardReasonDeclined == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallDiscardReasonDeclined")
+	p.endObject(state)
+}
+
 // GetCallDiscardReasonEnum return the enum type of this object
 func (callDiscardReasonDeclined *CallDiscardReasonDeclined) GetCallDiscardReasonEnum() CallDiscardReasonEnum {
 	return CallDiscardReasonDeclinedType
 }
 
+// Accept calls the method of the visitor for CallDiscardReasonDeclined
+func (callDiscardReasonDeclined *CallDiscardReasonDeclined) Accept(visitor CallDiscardReasonVisitor) {
+	visitor.VisitCallDiscardReasonDeclined(callDiscardReasonDeclined)
+}
+
 // CallDiscardReasonDisconnected The call was ended during the conversation because the users were disconnected
 type CallDiscardReasonDisconnected struct {
 	tdCommon
@@ -150,7 +488,6 @@ func (callDiscardReasonDisconnected *CallDiscardReasonDisconnected) MessageType(
 }
 
 // NewCallDiscardReasonDisconnected creates a new CallDiscardReasonDisconnected
-//
 func NewCallDiscardReasonDisconnected() *CallDiscardReasonDisconnected {
 	callDiscardReasonDisconnectedTemp := CallDiscardReasonDisconnected{
 		tdCommon: tdCommon{Type: "callDiscardReasonDisconnected"},
@@ -159,11 +496,107 @@ func NewCallDiscardReasonDisconnected() *CallDiscardReasonDisconnected {
 	return &callDiscardReasonDisconnectedTemp
 }
 
+// MarshalJSON marshals to json
+func (callDiscardReasonDisconnected *CallDiscardReasonDisconnected) MarshalJSON() ([]byte, error) {
+	return callDiscardReasonDisconnected.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (callDiscardReasonDisconnected *CallDiscardReasonDisconnected) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, callDiscardReasonDisconnected)
+}
+
+func (callDiscardReasonDisconnected *CallDiscardReasonDisconnected) appendJSON(b []byte) ([]byte, error) {
+	if callDiscardReasonDisconnected == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callDiscardReasonDisconnected.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callDiscardReasonDisconnected.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (callDiscardReasonDisconnected *CallDiscardReasonDisconnected) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callDiscardReasonDisconnected.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callDiscardReasonDisconnected.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callDiscardReasonDisconnected *CallDiscardReasonDisconnected) Clone() *CallDiscardReasonDisconnected {
+	if callDiscardReasonDisconnected == nil {
+		return nil
+	}
+	clone := *callDiscardReasonDisconnected
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callDiscardReasonDisconnected *CallDiscardReasonDisconnected) Equal(other *CallDiscardReasonDisconnected) bool {
+	if callDiscardReasonDisconnected == nil || other == nil {
+		return callDiscardReasonDisconnected == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callDiscardReasonDisconnected *CallDiscardReasonDisconnected) Diff(other *CallDiscardReasonDisconnected) []Change {
+	return callDiscardReasonDisconnected.diff("", other, nil)
+}
+
+func (callDiscardReasonDisconnected *CallDiscardReasonDisconnected) diff(path string, other *CallDiscardReasonDisconnected, changes []Change) []Change {
+	if callDiscardReasonDisconnected == nil || other == nil {
+		if callDiscardReasonDisconnected != other {
+			changes = append(changes, Change{Path: path, Old: callDiscardReasonDisconnected, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callDiscardReasonDisconnected *CallDiscardReasonDisconnected) String() string {
+	return objectString(callDiscardReasonDisconnected)
+}
+
+// LogValue logs the object as its summary
+func (callDiscardReasonDisconnected *CallDiscardReasonDisconnected) LogValue() slog.Value {
+	return slog.StringValue(objectString(callDiscardReasonDisconnected))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callDiscardReasonDisconnected *CallDiscardReasonDisconnected) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callDiscardReasonDisconnected)
+}
+
+func (callDiscardReasonDisconnected *CallDiscardReasonDisconnected) printText(p *printer) {
+	if callDiscardReasonDisconnected == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallDiscardReasonDisconnected")
+	p.endObject(state)
+}
+
 // GetCallDiscardReasonEnum return the enum type of this object
 func (callDiscardReasonDisconnected *CallDiscardReasonDisconnected) GetCallDiscardReasonEnum() CallDiscardReasonEnum {
 	return CallDiscardReasonDisconnectedType
 }
 
+// Accept calls the method of the visitor for CallDiscardReasonDisconnected
+func (callDiscardReasonDisconnected *CallDiscardReasonDisconnected) Accept(visitor CallDiscardReasonVisitor) {
+	visitor.VisitCallDiscardReasonDisconnected(callDiscardReasonDisconnected)
+}
+
 // CallDiscardReasonHungUp The call was ended because one of the parties hung up
 type CallDiscardReasonHungUp struct {
 	tdCommon
@@ -175,7 +608,6 @@ func (callDiscardReasonHungUp *CallDiscardReasonHungUp) MessageType() string {
 }
 
 // NewCallDiscardReasonHungUp creates a new CallDiscardReasonHungUp
-//
 func NewCallDiscardReasonHungUp() *CallDiscardReasonHungUp {
 	callDiscardReasonHungUpTemp := CallDiscardReasonHungUp{
 		tdCommon: tdCommon{Type: "callDiscardReasonHungUp"},
@@ -184,7 +616,243 @@ func NewCallDiscardReasonHungUp() *CallDiscardReasonHungUp {
 	return &callDiscardReasonHungUpTemp
 }
 
+// MarshalJSON marshals to json
+func (callDiscardReasonHungUp *CallDiscardReasonHungUp) MarshalJSON() ([]byte, error) {
+	return callDiscardReasonHungUp.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (callDiscardReasonHungUp *CallDiscardReasonHungUp) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, callDiscardReasonHungUp)
+}
+
+func (callDiscardReasonHungUp *CallDiscardReasonHungUp) appendJSON(b []byte) ([]byte, error) {
+	if callDiscardReasonHungUp == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callDiscardReasonHungUp.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callDiscardReasonHungUp.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (callDiscardReasonHungUp *CallDiscardReasonHungUp) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callDiscardReasonHungUp.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callDiscardReasonHungUp.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callDiscardReasonHungUp *CallDiscardReasonHungUp) Clone() *CallDiscardReasonHungUp {
+	if callDiscardReasonHungUp == nil {
+		return nil
+	}
+	clone := *callDiscardReasonHungUp
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callDiscardReasonHungUp *CallDiscardReasonHungUp) Equal(other *CallDiscardReasonHungUp) bool {
+	if callDiscardReasonHungUp == nil || other == nil {
+		return callDiscardReasonHungUp == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callDiscardReasonHungUp *CallDiscardReasonHungUp) Diff(other *CallDiscardReasonHungUp) []Change {
+	return callDiscardReasonHungUp.diff("", other, nil)
+}
+
+func (callDiscardReasonHungUp *CallDiscardReasonHungUp) diff(path string, other *CallDiscardReasonHungUp, changes []Change) []Change {
+	if callDiscardReasonHungUp == nil || other == nil {
+		if callDiscardReasonHungUp != other {
+			changes = append(changes, Change{Path: path, Old: callDiscardReasonHungUp, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callDiscardReasonHungUp *CallDiscardReasonHungUp) String() string {
+	return objectString(callDiscardReasonHungUp)
+}
+
+// LogValue logs the object as its summary
+func (callDiscardReasonHungUp *CallDiscardReasonHungUp) LogValue() slog.Value {
+	return slog.StringValue(objectString(callDiscardReasonHungUp))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callDiscardReasonHungUp *CallDiscardReasonHungUp) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callDiscardReasonHungUp)
+}
+
+func (callDiscardReasonHungUp *CallDiscardReasonHungUp) printText(p *printer) {
+	if callDiscardReasonHungUp == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallDiscardReasonHungUp")
+	p.endObject(state)
+}
+
 // GetCallDiscardReasonEnum return the enum type of this object
 func (callDiscardReasonHungUp *CallDiscardReasonHungUp) GetCallDiscardReasonEnum() CallDiscardReasonEnum {
 	return CallDiscardReasonHungUpType
 }
+
+// Accept calls the method of the visitor for CallDiscardReasonHungUp
+func (callDiscardReasonHungUp *CallDiscardReasonHungUp) Accept(visitor CallDiscardReasonVisitor) {
+	visitor.VisitCallDiscardReasonHungUp(callDiscardReasonHungUp)
+}
+
+// CallDiscardReasonVisitor visits every type of CallDiscardReason, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to CallDiscardReason, until they handle it too.
+type CallDiscardReasonVisitor interface {
+	VisitCallDiscardReasonEmpty(callDiscardReasonEmpty *CallDiscardReasonEmpty)
+	VisitCallDiscardReasonMissed(callDiscardReasonMissed *CallDiscardReasonMissed)
+	VisitCallDiscardReasonDeclined(callDiscardReasonDeclined *CallDiscardReasonDeclined)
+	VisitCallDiscardReasonDisconnected(callDiscardReasonDisconnected *CallDiscardReasonDisconnected)
+	VisitCallDiscardReasonHungUp(callDiscardReasonHungUp *CallDiscardReasonHungUp)
+	VisitUnknownCallDiscardReason(unknownCallDiscardReason *UnknownCallDiscardReason)
+}
+
+// CallDiscardReasonCases holds the function MatchCallDiscardReason calls for each type of CallDiscardReason;
+// types without a function are passed to the default case
+type CallDiscardReasonCases[R any] struct {
+	CallDiscardReasonEmpty        func(callDiscardReasonEmpty *CallDiscardReasonEmpty) R
+	CallDiscardReasonMissed       func(callDiscardReasonMissed *CallDiscardReasonMissed) R
+	CallDiscardReasonDeclined     func(callDiscardReasonDeclined *CallDiscardReasonDeclined) R
+	CallDiscardReasonDisconnected func(callDiscardReasonDisconnected *CallDiscardReasonDisconnected) R
+	CallDiscardReasonHungUp       func(callDiscardReasonHungUp *CallDiscardReasonHungUp) R
+}
+
+// MatchCallDiscardReason calls the case of the type of callDiscardReason, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement CallDiscardReasonVisitor
+// to have the compiler check every type is handled.
+func MatchCallDiscardReason[R any](callDiscardReason CallDiscardReason, cases CallDiscardReasonCases[R], defaultCase func(callDiscardReason CallDiscardReason) R) R {
+	switch value := callDiscardReason.(type) {
+	case *CallDiscardReasonEmpty:
+		if cases.CallDiscardReasonEmpty != nil {
+			return cases.CallDiscardReasonEmpty(value)
+		}
+	case *CallDiscardReasonMissed:
+		if cases.CallDiscardReasonMissed != nil {
+			return cases.CallDiscardReasonMissed(value)
+		}
+	case *CallDiscardReasonDeclined:
+		if cases.CallDiscardReasonDeclined != nil {
+			return cases.CallDiscardReasonDeclined(value)
+		}
+	case *CallDiscardReasonDisconnected:
+		if cases.CallDiscardReasonDisconnected != nil {
+			return cases.CallDiscardReasonDisconnected(value)
+		}
+	case *CallDiscardReasonHungUp:
+		if cases.CallDiscardReasonHungUp != nil {
+			return cases.CallDiscardReasonHungUp(value)
+		}
+	}
+	return defaultCase(callDiscardReason)
+}
+
+// Clone returns a deep copy of the object
+func (unknownCallDiscardReason *UnknownCallDiscardReason) Clone() *UnknownCallDiscardReason {
+	if unknownCallDiscardReason == nil {
+		return nil
+	}
+	return &UnknownCallDiscardReason{Unknown: unknownCallDiscardReason.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownCallDiscardReason *UnknownCallDiscardReason) Equal(other *UnknownCallDiscardReason) bool {
+	if unknownCallDiscardReason == nil || other == nil {
+		return unknownCallDiscardReason == other
+	}
+	return unknownCallDiscardReason.Unknown.equal(other.Unknown)
+}
+
+func cloneCallDiscardReason(callDiscardReason CallDiscardReason) CallDiscardReason {
+	switch value := callDiscardReason.(type) {
+	case *CallDiscardReasonEmpty:
+		return value.Clone()
+	case *CallDiscardReasonMissed:
+		return value.Clone()
+	case *CallDiscardReasonDeclined:
+		return value.Clone()
+	case *CallDiscardReasonDisconnected:
+		return value.Clone()
+	case *CallDiscardReasonHungUp:
+		return value.Clone()
+	case *UnknownCallDiscardReason:
+		return value.Clone()
+	}
+	return callDiscardReason
+}
+
+func equalCallDiscardReason(a CallDiscardReason, b CallDiscardReason) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *CallDiscardReasonEmpty:
+		b, ok := b.(*CallDiscardReasonEmpty)
+		return ok && a.Equal(b)
+	case *CallDiscardReasonMissed:
+		b, ok := b.(*CallDiscardReasonMissed)
+		return ok && a.Equal(b)
+	case *CallDiscardReasonDeclined:
+		b, ok := b.(*CallDiscardReasonDeclined)
+		return ok && a.Equal(b)
+	case *CallDiscardReasonDisconnected:
+		b, ok := b.(*CallDiscardReasonDisconnected)
+		return ok && a.Equal(b)
+	case *CallDiscardReasonHungUp:
+		b, ok := b.(*CallDiscardReasonHungUp)
+		return ok && a.Equal(b)
+	case *UnknownCallDiscardReason:
+		b, ok := b.(*UnknownCallDiscardReason)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffCallDiscardReason(path string, a CallDiscardReason, b CallDiscardReason, changes []Change) []Change {
+	switch a := a.(type) {
+	case *CallDiscardReasonEmpty:
+		if b, ok := b.(*CallDiscardReasonEmpty); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CallDiscardReasonMissed:
+		if b, ok := b.(*CallDiscardReasonMissed); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CallDiscardReasonDeclined:
+		if b, ok := b.(*CallDiscardReasonDeclined); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CallDiscardReasonDisconnected:
+		if b, ok := b.(*CallDiscardReasonDisconnected); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CallDiscardReasonHungUp:
+		if b, ok := b.(*CallDiscardReasonHungUp); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalCallDiscardReason(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // CallId Contains the call identifier
@@ -28,6 +28,108 @@ func NewCallId(id int32) *CallId {
 	return &callIdTemp
 }
 
+// MarshalJSON marshals to json
+func (callId *CallId) MarshalJSON() ([]byte, error) {
+	return callId.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (callId *CallId) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, callId)
+}
+
+func (callId *CallId) appendJSON(b []byte) ([]byte, error) {
+	if callId == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callId.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callId.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt(b, int64(callId.Id))
+	return append(b, '}'), nil
+}
+
+func (callId *CallId) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callId.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callId.tdCommon.Extra, err = r.readString()
+		case "id":
+			callId.Id, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callId *CallId) Clone() *CallId {
+	if callId == nil {
+		return nil
+	}
+	clone := *callId
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callId *CallId) Equal(other *CallId) bool {
+	if callId == nil || other == nil {
+		return callId == other
+	}
+	if callId.Id != other.Id {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callId *CallId) Diff(other *CallId) []Change {
+	return callId.diff("", other, nil)
+}
+
+func (callId *CallId) diff(path string, other *CallId, changes []Change) []Change {
+	if callId == nil || other == nil {
+		if callId != other {
+			changes = append(changes, Change{Path: path, Old: callId, New: other})
+		}
+		return changes
+	}
+	if callId.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: callId.Id, New: other.Id})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callId *CallId) String() string {
+	return objectString(callId)
+}
+
+// LogValue logs the object as its summary
+func (callId *CallId) LogValue() slog.Value {
+	return slog.StringValue(objectString(callId))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callId *CallId) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callId)
+}
+
+func (callId *CallId) printText(p *printer) {
+	if callId == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallId")
+	p.int("id", int64(callId.Id))
+	p.endObject(state)
+}
+
 // CreateCall Creates a new call
 // @param userId Identifier of the user to be called
 // @param protocol The call protocols supported by the application
@@ -44,11 +146,11 @@ func (client *Client) CreateCall(userId int64, protocol *CallProtocol, isVideo b
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var callId CallId
-	err = json.Unmarshal(result.Raw, &callId)
+	err = jsonUnmarshal(result.Raw, &callId)
 	return &callId, err
 }
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // CallProblem Describes the exact type of a problem with a call
 type CallProblem interface {
 	GetCallProblemEnum() CallProblemEnum
+	Accept(visitor CallProblemVisitor)
 }
 
 // CallProblemEnum Alias for abstract CallProblem 'Sub-Classes', used as constant-enum here
@@ -26,65 +28,108 @@ const (
 	CallProblemPixelatedVideoType  CallProblemEnum = "callProblemPixelatedVideo"
 )
 
+// UnknownCallProblem is a CallProblem of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownCallProblem struct {
+	Unknown
+}
+
+// GetCallProblemEnum return the enum type of this object
+func (unknownCallProblem *UnknownCallProblem) GetCallProblemEnum() CallProblemEnum {
+	return CallProblemEnum(unknownCallProblem.Type)
+}
+
+// Accept calls the method of the visitor for UnknownCallProblem
+func (unknownCallProblem *UnknownCallProblem) Accept(visitor CallProblemVisitor) {
+	visitor.VisitUnknownCallProblem(unknownCallProblem)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownCallProblem *UnknownCallProblem) String() string {
+	return objectString(unknownCallProblem)
+}
+
+// LogValue logs the object as its summary
+func (unknownCallProblem *UnknownCallProblem) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownCallProblem))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownCallProblem *UnknownCallProblem) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownCallProblem)
+}
+
+func (unknownCallProblem *UnknownCallProblem) printText(p *printer) {
+	if unknownCallProblem == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownCallProblem")
+	p.string("@type", unknownCallProblem.Type)
+	p.endObject(state)
+}
+
 func unmarshalCallProblem(rawMsg *json.RawMessage) (CallProblem, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch CallProblemEnum(objMap["@type"].(string)) {
+	switch CallProblemEnum(typeName) {
 	case CallProblemEchoType:
 		var callProblemEcho CallProblemEcho
-		err := json.Unmarshal(*rawMsg, &callProblemEcho)
+		err := jsonUnmarshal(*rawMsg, &callProblemEcho)
 		return &callProblemEcho, err
 
 	case CallProblemNoiseType:
 		var callProblemNoise CallProblemNoise
-		err := json.Unmarshal(*rawMsg, &callProblemNoise)
+		err := jsonUnmarshal(*rawMsg, &callProblemNoise)
 		return &callProblemNoise, err
 
 	case CallProblemInterruptionsType:
 		var callProblemInterruptions CallProblemInterruptions
-		err := json.Unmarshal(*rawMsg, &callProblemInterruptions)
+		err := jsonUnmarshal(*rawMsg, &callProblemInterruptions)
 		return &callProblemInterruptions, err
 
 	case CallProblemDistortedSpeechType:
 		var callProblemDistortedSpeech CallProblemDistortedSpeech
-		err := json.Unmarshal(*rawMsg, &callProblemDistortedSpeech)
+		err := jsonUnmarshal(*rawMsg, &callProblemDistortedSpeech)
 		return &callProblemDistortedSpeech, err
 
 	case CallProblemSilentLocalType:
 		var callProblemSilentLocal CallProblemSilentLocal
-		err := json.Unmarshal(*rawMsg, &callProblemSilentLocal)
+		err := jsonUnmarshal(*rawMsg, &callProblemSilentLocal)
 		return &callProblemSilentLocal, err
 
 	case CallProblemSilentRemoteType:
 		var callProblemSilentRemote CallProblemSilentRemote
-		err := json.Unmarshal(*rawMsg, &callProblemSilentRemote)
+		err := jsonUnmarshal(*rawMsg, &callProblemSilentRemote)
 		return &callProblemSilentRemote, err
 
 	case CallProblemDroppedType:
 		var callProblemDropped CallProblemDropped
-		err := json.Unmarshal(*rawMsg, &callProblemDropped)
+		err := jsonUnmarshal(*rawMsg, &callProblemDropped)
 		return &callProblemDropped, err
 
 	case CallProblemDistortedVideoType:
 		var callProblemDistortedVideo CallProblemDistortedVideo
-		err := json.Unmarshal(*rawMsg, &callProblemDistortedVideo)
+		err := jsonUnmarshal(*rawMsg, &callProblemDistortedVideo)
 		return &callProblemDistortedVideo, err
 
 	case CallProblemPixelatedVideoType:
 		var callProblemPixelatedVideo CallProblemPixelatedVideo
-		err := json.Unmarshal(*rawMsg, &callProblemPixelatedVideo)
+		err := jsonUnmarshal(*rawMsg, &callProblemPixelatedVideo)
 		return &callProblemPixelatedVideo, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownCallProblem{Unknown: unknown}, nil
 	}
 }
 
@@ -99,7 +144,6 @@ func (callProblemEcho *CallProblemEcho) MessageType() string {
 }
 
 // NewCallProblemEcho creates a new CallProblemEcho
-//
 func NewCallProblemEcho() *CallProblemEcho {
 	callProblemEchoTemp := CallProblemEcho{
 		tdCommon: tdCommon{Type: "callProblemEcho"},
@@ -108,11 +152,107 @@ func NewCallProblemEcho() *CallProblemEcho {
 	return &callProblemEchoTemp
 }
 
+// MarshalJSON marshals to json
+func (callProblemEcho *CallProblemEcho) MarshalJSON() ([]byte, error) {
+	return callProblemEcho.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (callProblemEcho *CallProblemEcho) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, callProblemEcho)
+}
+
+func (callProblemEcho *CallProblemEcho) appendJSON(b []byte) ([]byte, error) {
+	if callProblemEcho == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callProblemEcho.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callProblemEcho.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (callProblemEcho *CallProblemEcho) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callProblemEcho.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callProblemEcho.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callProblemEcho *CallProblemEcho) Clone() *CallProblemEcho {
+	if callProblemEcho == nil {
+		return nil
+	}
+	clone := *callProblemEcho
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callProblemEcho *CallProblemEcho) Equal(other *CallProblemEcho) bool {
+	if callProblemEcho == nil || other == nil {
+		return callProblemEcho == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callProblemEcho *CallProblemEcho) Diff(other *CallProblemEcho) []Change {
+	return callProblemEcho.diff("", other, nil)
+}
+
+func (callProblemEcho *CallProblemEcho) diff(path string, other *CallProblemEcho, changes []Change) []Change {
+	if callProblemEcho == nil || other == nil {
+		if callProblemEcho != other {
+			changes = append(changes, Change{Path: path, Old: callProblemEcho, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callProblemEcho *CallProblemEcho) String() string {
+	return objectString(callProblemEcho)
+}
+
+// LogValue logs the object as its summary
+func (callProblemEcho *CallProblemEcho) LogValue() slog.Value {
+	return slog.StringValue(objectString(callProblemEcho))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callProblemEcho *CallProblemEcho) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callProblemEcho)
+}
+
+func (callProblemEcho *CallProblemEcho) printText(p *printer) {
+	if callProblemEcho == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallProblemEcho")
+	p.endObject(state)
+}
+
 // GetCallProblemEnum return the enum type of this object
 func (callProblemEcho *CallProblemEcho) GetCallProblemEnum() CallProblemEnum {
 	return CallProblemEchoType
 }
 
+// Accept calls the method of the visitor for CallProblemEcho
+func (callProblemEcho *CallProblemEcho) Accept(visitor CallProblemVisitor) {
+	visitor.VisitCallProblemEcho(callProblemEcho)
+}
+
 // CallProblemNoise The user heard background noise
 type CallProblemNoise struct {
 	tdCommon
@@ -124,7 +264,6 @@ func (callProblemNoise *CallProblemNoise) MessageType() string {
 }
 
 // NewCallProblemNoise creates a new CallProblemNoise
-//
 func NewCallProblemNoise() *CallProblemNoise {
 	callProblemNoiseTemp := CallProblemNoise{
 		tdCommon: tdCommon{Type: "callProblemNoise"},
@@ -133,11 +272,107 @@ func NewCallProblemNoise() *CallProblemNoise {
 	return &callProblemNoiseTemp
 }
 
+// MarshalJSON marshals to json
+func (callProblemNoise *CallProblemNoise) MarshalJSON() ([]byte, error) {
+	return callProblemNoise.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (callProblemNoise *CallProblemNoise) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, callProblemNoise)
+}
+
+func (callProblemNoise *CallProblemNoise) appendJSON(b []byte) ([]byte, error) {
+	if callProblemNoise == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callProblemNoise.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callProblemNoise.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (callProblemNoise *CallProblemNoise) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callProblemNoise.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callProblemNoise.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callProblemNoise *CallProblemNoise) Clone() *CallProblemNoise {
+	if callProblemNoise == nil {
+		return nil
+	}
+	clone := *callProblemNoise
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callProblemNoise *CallProblemNoise) Equal(other *CallProblemNoise) bool {
+	if callProblemNoise == nil || other == nil {
+		return callProblemNoise == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callProblemNoise *CallProblemNoise) Diff(other *CallProblemNoise) []Change {
+	return callProblemNoise.diff("", other, nil)
+}
+
+func (callProblemNoise *CallProblemNoise) diff(path string, other *CallProblemNoise, changes []Change) []Change {
+	if callProblemNoise == nil || other == nil {
+		if callProblemNoise != other {
+			changes = append(changes, Change{Path: path, Old: callProblemNoise, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callProblemNoise *CallProblemNoise) String() string {
+	return objectString(callProblemNoise)
+}
+
+// LogValue logs the object as its summary
+func (callProblemNoise *CallProblemNoise) LogValue() slog.Value {
+	return slog.StringValue(objectString(callProblemNoise))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callProblemNoise *CallProblemNoise) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callProblemNoise)
+}
+
+func (callProblemNoise *CallProblemNoise) printText(p *printer) {
+	if callProblemNoise == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallProblemNoise")
+	p.endObject(state)
+}
+
 // GetCallProblemEnum return the enum type of this object
 func (callProblemNoise *CallProblemNoise) GetCallProblemEnum() CallProblemEnum {
 	return CallProblemNoiseType
 }
 
+// Accept calls the method of the visitor for CallProblemNoise
+func (callProblemNoise *CallProblemNoise) Accept(visitor CallProblemVisitor) {
+	visitor.VisitCallProblemNoise(callProblemNoise)
+}
+
 // CallProblemInterruptions The other side kept disappearing
 type CallProblemInterruptions struct {
 	tdCommon
@@ -149,7 +384,6 @@ func (callProblemInterruptions *CallProblemInterruptions) MessageType() string {
 }
 
 // NewCallProblemInterruptions creates a new CallProblemInterruptions
-//
 func NewCallProblemInterruptions() *CallProblemInterruptions {
 	callProblemInterruptionsTemp := CallProblemInterruptions{
 		tdCommon: tdCommon{Type: "callProblemInterruptions"},
@@ -158,11 +392,107 @@ func NewCallProblemInterruptions() *CallProblemInterruptions {
 	return &callProblemInterruptionsTemp
 }
 
+// MarshalJSON marshals to json
+func (callProblemInterruptions *CallProblemInterruptions) MarshalJSON() ([]byte, error) {
+	return callProblemInterruptions.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (callProblemInterruptions *CallProblemInterruptions) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, callProblemInterruptions)
+}
+
+func (callProblemInterruptions *CallProblemInterruptions) appendJSON(b []byte) ([]byte, error) {
+	if callProblemInterruptions == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callProblemInterruptions.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callProblemInterruptions.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (callProblemInterruptions *CallProblemInterruptions) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callProblemInterruptions.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callProblemInterruptions.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callProblemInterruptions *CallProblemInterruptions) Clone() *CallProblemInterruptions {
+	if callProblemInterruptions == nil {
+		return nil
+	}
+	clone := *callProblemInterruptions
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callProblemInterruptions *CallProblemInterruptions) Equal(other *CallProblemInterruptions) bool {
+	if callProblemInterruptions == nil || other == nil {
+		return callProblemInterruptions == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callProblemInterruptions *CallProblemInterruptions) Diff(other *CallProblemInterruptions) []Change {
+	return callProblemInterruptions.diff("", other, nil)
+}
+
+func (callProblemInterruptions *CallProblemInterruptions) diff(path string, other *CallProblemInterruptions, changes []Change) []Change {
+	if callProblemInterruptions == nil || other == nil {
+		if callProblemInterruptions != other {
+			changes = append(changes, Change{Path: path, Old: callProblemInterruptions, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callProblemInterruptions *CallProblemInterruptions) String() string {
+	return objectString(callProblemInterruptions)
+}
+
+// LogValue logs the object as its summary
+func (callProblemInterruptions *CallProblemInterruptions) LogValue() slog.Value {
+	return slog.StringValue(objectString(callProblemInterruptions))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callProblemInterruptions *CallProblemInterruptions) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callProblemInterruptions)
+}
+
+func (callProblemInterruptions *CallProblemInterruptions) printText(p *printer) {
+	if callProblemInterruptions == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallProblemInterruptions")
+	p.endObject(state)
+}
+
 // GetCallProblemEnum return the enum type of this object
 func (callProblemInterruptions *CallProblemInterruptions) GetCallProblemEnum() CallProblemEnum {
 	return CallProblemInterruptionsType
 }
 
+// Accept calls the method of the visitor for CallProblemInterruptions
+func (callProblemInterruptions *CallProblemInterruptions) Accept(visitor CallProblemVisitor) {
+	visitor.VisitCallProblemInterruptions(callProblemInterruptions)
+}
+
 // CallProblemDistortedSpeech The speech was distorted
 type CallProblemDistortedSpeech struct {
 	tdCommon
@@ -174,7 +504,6 @@ func (callProblemDistortedSpeech *CallProblemDistortedSpeech) MessageType() stri
 }
 
 // NewCallProblemDistortedSpeech creates a new CallProblemDistortedSpeech
-//
 func NewCallProblemDistortedSpeech() *CallProblemDistortedSpeech {
 	callProblemDistortedSpeechTemp := CallProblemDistortedSpeech{
 		tdCommon: tdCommon{Type: "callProblemDistortedSpeech"},
@@ -183,11 +512,107 @@ func NewCallProblemDistortedSpeech() *CallProblemDistortedSpeech {
 	return &callProblemDistortedSpeechTemp
 }
 
+// MarshalJSON marshals to json
+func (callProblemDistortedSpeech *CallProblemDistortedSpeech) MarshalJSON() ([]byte, error) {
+	return callProblemDistortedSpeech.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (callProblemDistortedSpeech *CallProblemDistortedSpeech) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, callProblemDistortedSpeech)
+}
+
+func (callProblemDistortedSpeech *CallProblemDistortedSpeech) appendJSON(b []byte) ([]byte, error) {
+	if callProblemDistortedSpeech == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callProblemDistortedSpeech.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callProblemDistortedSpeech.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (callProblemDistortedSpeech *CallProblemDistortedSpeech) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callProblemDistortedSpeech.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callProblemDistortedSpeech.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callProblemDistortedSpeech *CallProblemDistortedSpeech) Clone() *CallProblemDistortedSpeech {
+	if callProblemDistortedSpeech == nil {
+		return nil
+	}
+	clone := *callProblemDistortedSpeech
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callProblemDistortedSpeech *CallProblemDistortedSpeech) Equal(other *CallProblemDistortedSpeech) bool {
+	if callProblemDistortedSpeech == nil || other == nil {
+		return callProblemDistortedSpeech == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callProblemDistortedSpeech *CallProblemDistortedSpeech) Diff(other *CallProblemDistortedSpeech) []Change {
+	return callProblemDistortedSpeech.diff("", other, nil)
+}
+
+func (callProblemDistortedSpeech *CallProblemDistortedSpeech) diff(path string, other *CallProblemDistortedSpeech, changes []Change) []Change {
+	if callProblemDistortedSpeech == nil || other == nil {
+		if callProblemDistortedSpeech != other {
+			changes = append(changes, Change{Path: path, Old: callProblemDistortedSpeech, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callProblemDistortedSpeech *CallProblemDistortedSpeech) String() string {
+	return objectString(callProblemDistortedSpeech)
+}
+
+// LogValue logs the object as its summary
+func (callProblemDistortedSpeech *CallProblemDistortedSpeech) LogValue() slog.Value {
+	return slog.StringValue(objectString(callProblemDistortedSpeech))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callProblemDistortedSpeech *CallProblemDistortedSpeech) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callProblemDistortedSpeech)
+}
+
+func (callProblemDistortedSpeech *CallProblemDistortedSpeech) printText(p *printer) {
+	if callProblemDistortedSpeech == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallProblemDistortedSpeech")
+	p.endObject(state)
+}
+
 // GetCallProblemEnum return the enum type of this object
 func (callProblemDistortedSpeech *CallProblemDistortedSpeech) GetCallProblemEnum() CallProblemEnum {
 	return CallProblemDistortedSpeechType
 }
 
+// Accept calls the method of the visitor for CallProblemDistortedSpeech
+func (callProblemDistortedSpeech *CallProblemDistortedSpeech) Accept(visitor CallProblemVisitor) {
+	visitor.VisitCallProblemDistortedSpeech(callProblemDistortedSpeech)
+}
+
 // CallProblemSilentLocal The user couldn't hear the other side
 type CallProblemSilentLocal struct {
 	tdCommon
@@ -199,7 +624,6 @@ func (callProblemSilentLocal *CallProblemSilentLocal) MessageType() string {
 }
 
 // NewCallProblemSilentLocal creates a new CallProblemSilentLocal
-//
 func NewCallProblemSilentLocal() *CallProblemSilentLocal {
 	callProblemSilentLocalTemp := CallProblemSilentLocal{
 		tdCommon: tdCommon{Type: "callProblemSilentLocal"},
@@ -208,11 +632,107 @@ func NewCallProblemSilentLocal() *CallProblemSilentLocal {
 	return &callProblemSilentLocalTemp
 }
 
+// MarshalJSON marshals to json
+func (callProblemSilentLocal *CallProblemSilentLocal) MarshalJSON() ([]byte, error) {
+	return callProblemSilentLocal.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (callProblemSilentLocal *CallProblemSilentLocal) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, callProblemSilentLocal)
+}
+
+func (callProblemSilentLocal *CallProblemSilentLocal) appendJSON(b []byte) ([]byte, error) {
+	if callProblemSilentLocal == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callProblemSilentLocal.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callProblemSilentLocal.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (callProblemSilentLocal *CallProblemSilentLocal) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callProblemSilentLocal.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callProblemSilentLocal.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callProblemSilentLocal *CallProblemSilentLocal) Clone() *CallProblemSilentLocal {
+	if callProblemSilentLocal == nil {
+		return nil
+	}
+	clone := *callProblemSilentLocal
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callProblemSilentLocal *CallProblemSilentLocal) Equal(other *CallProblemSilentLocal) bool {
+	if callProblemSilentLocal == nil || other == nil {
+		return callProblemSilentLocal == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callProblemSilentLocal *CallProblemSilentLocal) Diff(other *CallProblemSilentLocal) []Change {
+	return callProblemSilentLocal.diff("", other, nil)
+}
+
+func (callProblemSilentLocal *CallProblemSilentLocal) diff(path string, other *CallProblemSilentLocal, changes []Change) []Change {
+	if callProblemSilentLocal == nil || other == nil {
+		if callProblemSilentLocal != other {
+			changes = append(changes, Change{Path: path, Old: callProblemSilentLocal, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callProblemSilentLocal *CallProblemSilentLocal) String() string {
+	return objectString(callProblemSilentLocal)
+}
+
+// LogValue logs the object as its summary
+func (callProblemSilentLocal *CallProblemSilentLocal) LogValue() slog.Value {
+	return slog.StringValue(objectString(callProblemSilentLocal))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callProblemSilentLocal *CallProblemSilentLocal) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callProblemSilentLocal)
+}
+
+func (callProblemSilentLocal *CallProblemSilentLocal) printText(p *printer) {
+	if callProblemSilentLocal == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallProblemSilentLocal")
+	p.endObject(state)
+}
+
 // GetCallProblemEnum return the enum type of this object
 func (callProblemSilentLocal *CallProblemSilentLocal) GetCallProblemEnum() CallProblemEnum {
 	return CallProblemSilentLocalType
 }
 
+// Accept calls the method of the visitor for CallProblemSilentLocal
+func (callProblemSilentLocal *CallProblemSilentLocal) Accept(visitor CallProblemVisitor) {
+	visitor.VisitCallProblemSilentLocal(callProblemSilentLocal)
+}
+
 // CallProblemSilentRemote The other side couldn't hear the user
 type CallProblemSilentRemote struct {
 	tdCommon
@@ -224,7 +744,6 @@ func (callProblemSilentRemote *CallProblemSilentRemote) MessageType() string {
 }
 
 // NewCallProblemSilentRemote creates a new CallProblemSilentRemote
-//
 func NewCallProblemSilentRemote() *CallProblemSilentRemote {
 	callProblemSilentRemoteTemp := CallProblemSilentRemote{
 		tdCommon: tdCommon{Type: "callProblemSilentRemote"},
@@ -233,11 +752,107 @@ func NewCallProblemSilentRemote() *CallProblemSilentRemote {
 	return &callProblemSilentRemoteTemp
 }
 
+// MarshalJSON marshals to json
+func (callProblemSilentRemote *CallProblemSilentRemote) MarshalJSON() ([]byte, error) {
+	return callProblemSilentRemote.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (callProblemSilentRemote *CallProblemSilentRemote) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, callProblemSilentRemote)
+}
+
+func (callProblemSilentRemote *CallProblemSilentRemote) appendJSON(b []byte) ([]byte, error) {
+	if callProblemSilentRemote == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callProblemSilentRemote.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callProblemSilentRemote.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (callProblemSilentRemote *CallProblemSilentRemote) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callProblemSilentRemote.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callProblemSilentRemote.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callProblemSilentRemote *CallProblemSilentRemote) Clone() *CallProblemSilentRemote {
+	if callProblemSilentRemote == nil {
+		return nil
+	}
+	clone := *callProblemSilentRemote
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callProblemSilentRemote *CallProblemSilentRemote) Equal(other *CallProblemSilentRemote) bool {
+	if callProblemSilentRemote == nil || other == nil {
+		return callProblemSilentRemote == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callProblemSilentRemote *CallProblemSilentRemote) Diff(other *CallProblemSilentRemote) []Change {
+	return callProblemSilentRemote.diff("", other, nil)
+}
+
+func (callProblemSilentRemote *CallProblemSilentRemote) diff(path string, other *CallProblemSilentRemote, changes []Change) []Change {
+	if callProblemSilentRemote == nil || other == nil {
+		if callProblemSilentRemote != other {
+			changes = append(changes, Change{Path: path, Old: callProblemSilentRemote, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callProblemSilentRemote *CallProblemSilentRemote) String() string {
+	return objectString(callProblemSilentRemote)
+}
+
+// LogValue logs the object as its summary
+func (callProblemSilentRemote *CallProblemSilentRemote) LogValue() slog.Value {
+	return slog.StringValue(objectString(callProblemSilentRemote))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callProblemSilentRemote *CallProblemSilentRemote) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callProblemSilentRemote)
+}
+
+func (callProblemSilentRemote *CallProblemSilentRemote) printText(p *printer) {
+	if callProblemSilentRemote == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallProblemSilentRemote")
+	p.endObject(state)
+}
+
 // GetCallProblemEnum return the enum type of this object
 func (callProblemSilentRemote *CallProblemSilentRemote) GetCallProblemEnum() CallProblemEnum {
 	return CallProblemSilentRemoteType
 }
 
+// Accept calls the method of the visitor for CallProblemSilentRemote
+func (callProblemSilentRemote *CallProblemSilentRemote) Accept(visitor CallProblemVisitor) {
+	visitor.VisitCallProblemSilentRemote(callProblemSilentRemote)
+}
+
 // CallProblemDropped The call ended unexpectedly
 type CallProblemDropped struct {
 	tdCommon
@@ -249,7 +864,6 @@ func (callProblemDropped *CallProblemDropped) MessageType() string {
 }
 
 // NewCallProblemDropped creates a new CallProblemDropped
-//
 func NewCallProblemDropped() *CallProblemDropped {
 	callProblemDroppedTemp := CallProblemDropped{
 		tdCommon: tdCommon{Type: "callProblemDropped"},
@@ -258,11 +872,107 @@ func NewCallProblemDropped() *CallProblemDropped {
 	return &callProblemDroppedTemp
 }
 
+// MarshalJSON marshals to json
+func (callProblemDropped *CallProblemDropped) MarshalJSON() ([]byte, error) {
+	return callProblemDropped.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (callProblemDropped *CallProblemDropped) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, callProblemDropped)
+}
+
+func (callProblemDropped *CallProblemDropped) appendJSON(b []byte) ([]byte, error) {
+	if callProblemDropped == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callProblemDropped.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callProblemDropped.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (callProblemDropped *CallProblemDropped) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callProblemDropped.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callProblemDropped.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callProblemDropped *CallProblemDropped) Clone() *CallProblemDropped {
+	if callProblemDropped == nil {
+		return nil
+	}
+	clone := *callProblemDropped
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callProblemDropped *CallProblemDropped) Equal(other *CallProblemDropped) bool {
+	if callProblemDropped == nil || other == nil {
+		return callProblemDropped == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callProblemDropped *CallProblemDropped) Diff(other *CallProblemDropped) []Change {
+	return callProblemDropped.diff("", other, nil)
+}
+
+func (callProblemDropped *CallProblemDropped) diff(path string, other *CallProblemDropped, changes []Change) []Change {
+	if callProblemDropped == nil || other == nil {
+		if callProblemDropped != other {
+			changes = append(changes, Change{Path: path, Old: callProblemDropped, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callProblemDropped *CallProblemDropped) String() string {
+	return objectString(callProblemDropped)
+}
+
+// LogValue logs the object as its summary
+func (callProblemDropped *CallProblemDropped) LogValue() slog.Value {
+	return slog.StringValue(objectString(callProblemDropped))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callProblemDropped *CallProblemDropped) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callProblemDropped)
+}
+
+func (callProblemDropped *CallProblemDropped) printText(p *printer) {
+	if callProblemDropped == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallProblemDropped")
+	p.endObject(state)
+}
+
 // GetCallProblemEnum return the enum type of this object
 func (callProblemDropped *CallProblemDropped) GetCallProblemEnum() CallProblemEnum {
 	return CallProblemDroppedType
 }
 
+// Accept calls the method of the visitor for CallProblemDropped
+func (callProblemDropped *CallProblemDropped) Accept(visitor CallProblemVisitor) {
+	visitor.VisitCallProblemDropped(callProblemDropped)
+}
+
 // CallProblemDistortedVideo The video was distorted
 type CallProblemDistortedVideo struct {
 	tdCommon
@@ -274,7 +984,6 @@ func (callProblemDistortedVideo *CallProblemDistortedVideo) MessageType() string
 }
 
 // NewCallProblemDistortedVideo creates a new CallProblemDistortedVideo
-//
 func NewCallProblemDistortedVideo() *CallProblemDistortedVideo {
 	callProblemDistortedVideoTemp := CallProblemDistortedVideo{
 		tdCommon: tdCommon{Type: "callProblemDistortedVideo"},
@@ -283,11 +992,107 @@ func NewCallProblemDistortedVideo() *CallProblemDistortedVideo {
 	return &callProblemDistortedVideoTemp
 }
 
+// MarshalJSON marshals to json
+func (callProblemDistortedVideo *CallProblemDistortedVideo) MarshalJSON() ([]byte, error) {
+	return callProblemDistortedVideo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (callProblemDistortedVideo *CallProblemDistortedVideo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, callProblemDistortedVideo)
+}
+
+func (callProblemDistortedVideo *CallProblemDistortedVideo) appendJSON(b []byte) ([]byte, error) {
+	if callProblemDistortedVideo == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callProblemDistortedVideo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callProblemDistortedVideo.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (callProblemDistortedVideo *CallProblemDistortedVideo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callProblemDistortedVideo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callProblemDistortedVideo.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callProblemDistortedVideo *CallProblemDistortedVideo) Clone() *CallProblemDistortedVideo {
+	if callProblemDistortedVideo == nil {
+		return nil
+	}
+	clone := *callProblemDistortedVideo
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callProblemDistortedVideo *CallProblemDistortedVideo) Equal(other *CallProblemDistortedVideo) bool {
+	if callProblemDistortedVideo == nil || other == nil {
+		return callProblemDistortedVideo == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callProblemDistortedVideo *CallProblemDistortedVideo) Diff(other *CallProblemDistortedVideo) []Change {
+	return callProblemDistortedVideo.diff("", other, nil)
+}
+
+func (callProblemDistortedVideo *CallProblemDistortedVideo) diff(path string, other *CallProblemDistortedVideo, changes []Change) []Change {
+	if callProblemDistortedVideo == nil || other == nil {
+		if callProblemDistortedVideo != other {
+			changes = append(changes, Change{Path: path, Old: callProblemDistortedVideo, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callProblemDistortedVideo *CallProblemDistortedVideo) String() string {
+	return objectString(callProblemDistortedVideo)
+}
+
+// LogValue logs the object as its summary
+func (callProblemDistortedVideo *CallProblemDistortedVideo) LogValue() slog.Value {
+	return slog.StringValue(objectString(callProblemDistortedVideo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callProblemDistortedVideo *CallProblemDistortedVideo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callProblemDistortedVideo)
+}
+
+func (callProblemDistortedVideo *CallProblemDistortedVideo) printText(p *printer) {
+	if callProblemDistortedVideo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallProblemDistortedVideo")
+	p.endObject(state)
+}
+
 // GetCallProblemEnum return the enum type of this object
 func (callProblemDistortedVideo *CallProblemDistortedVideo) GetCallProblemEnum() CallProblemEnum {
 	return CallProblemDistortedVideoType
 }
 
+// Accept calls the method of the visitor for CallProblemDistortedVideo
+func (callProblemDistortedVideo *CallProblemDistortedVideo) Accept(visitor CallProblemVisitor) {
+	visitor.VisitCallProblemDistortedVideo(callProblemDistortedVideo)
+}
+
 // CallProblemPixelatedVideo The video was pixelated
 type CallProblemPixelatedVideo struct {
 	tdCommon
@@ -299,7 +1104,6 @@ func (callProblemPixelatedVideo *CallProblemPixelatedVideo) MessageType() string
 }
 
 // NewCallProblemPixelatedVideo creates a new CallProblemPixelatedVideo
-//
 func NewCallProblemPixelatedVideo() *CallProblemPixelatedVideo {
 	callProblemPixelatedVideoTemp := CallProblemPixelatedVideo{
 		tdCommon: tdCommon{Type: "callProblemPixelatedVideo"},
@@ -308,7 +1112,303 @@ func NewCallProblemPixelatedVideo() *CallProblemPixelatedVideo {
 	return &callProblemPixelatedVideoTemp
 }
 
+// MarshalJSON marshals to json
+func (callProblemPixelatedVideo *CallProblemPixelatedVideo) MarshalJSON() ([]byte, error) {
+	return callProblemPixelatedVideo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (callProblemPixelatedVideo *CallProblemPixelatedVideo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, callProblemPixelatedVideo)
+}
+
+func (callProblemPixelatedVideo *CallProblemPixelatedVideo) appendJSON(b []byte) ([]byte, error) {
+	if callProblemPixelatedVideo == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callProblemPixelatedVideo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callProblemPixelatedVideo.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (callProblemPixelatedVideo *CallProblemPixelatedVideo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callProblemPixelatedVideo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callProblemPixelatedVideo.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callProblemPixelatedVideo *CallProblemPixelatedVideo) Clone() *CallProblemPixelatedVideo {
+	if callProblemPixelatedVideo == nil {
+		return nil
+	}
+	clone := *callProblemPixelatedVideo
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callProblemPixelatedVideo *CallProblemPixelatedVideo) Equal(other *CallProblemPixelatedVideo) bool {
+	if callProblemPixelatedVideo == nil || other == nil {
+		return callProblemPixelatedVideo == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callProblemPixelatedVideo *CallProblemPixelatedVideo) Diff(other *CallProblemPixelatedVideo) []Change {
+	return callProblemPixelatedVideo.diff("", other, nil)
+}
+
+func (callProblemPixelatedVideo *CallProblemPixelatedVideo) diff(path string, other *CallProblemPixelatedVideo, changes []Change) []Change {
+	if callProblemPixelatedVideo == nil || other == nil {
+		if callProblemPixelatedVideo != other {
+			changes = append(changes, Change{Path: path, Old: callProblemPixelatedVideo, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callProblemPixelatedVideo *CallProblemPixelatedVideo) String() string {
+	return objectString(callProblemPixelatedVideo)
+}
+
+// LogValue logs the object as its summary
+func (callProblemPixelatedVideo *CallProblemPixelatedVideo) LogValue() slog.Value {
+	return slog.StringValue(objectString(callProblemPixelatedVideo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callProblemPixelatedVideo *CallProblemPixelatedVideo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callProblemPixelatedVideo)
+}
+
+func (callProblemPixelatedVideo *CallProblemPixelatedVideo) printText(p *printer) {
+	if callProblemPixelatedVideo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallProblemPixelatedVideo")
+	p.endObject(state)
+}
+
 // GetCallProblemEnum return the enum type of this object
 func (callProblemPixelatedVideo *CallProblemPixelatedVideo) GetCallProblemEnum() CallProblemEnum {
 	return CallProblemPixelatedVideoType
 }
+
+// Accept calls the method of the visitor for CallProblemPixelatedVideo
+func (callProblemPixelatedVideo *CallProblemPixelatedVideo) Accept(visitor CallProblemVisitor) {
+	visitor.VisitCallProblemPixelatedVideo(callProblemPixelatedVideo)
+}
+
+// CallProblemVisitor visits every type of CallProblem, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to CallProblem, until they handle it too.
+type CallProblemVisitor interface {
+	VisitCallProblemEcho(callProblemEcho *CallProblemEcho)
+	VisitCallProblemNoise(callProblemNoise *CallProblemNoise)
+	VisitCallProblemInterruptions(callProblemInterruptions *CallProblemInterruptions)
+	VisitCallProblemDistortedSpeech(callProblemDistortedSpeech *CallProblemDistortedSpeech)
+	VisitCallProblemSilentLocal(callProblemSilentLocal *CallProblemSilentLocal)
+	VisitCallProblemSilentRemote(callProblemSilentRemote *CallProblemSilentRemote)
+	VisitCallProblemDropped(callProblemDropped *CallProblemDropped)
+	VisitCallProblemDistortedVideo(callProblemDistortedVideo *CallProblemDistortedVideo)
+	VisitCallProblemPixelatedVideo(callProblemPixelatedVideo *CallProblemPixelatedVideo)
+	VisitUnknownCallProblem(unknownCallProblem *UnknownCallProblem)
+}
+
+// CallProblemCases holds the function MatchCallProblem calls for each type of CallProblem;
+// types without a function are passed to the default case
+type CallProblemCases[R any] struct {
+	CallProblemEcho            func(callProblemEcho *CallProblemEcho) R
+	CallProblemNoise           func(callProblemNoise *CallProblemNoise) R
+	CallProblemInterruptions   func(callProblemInterruptions *CallProblemInterruptions) R
+	CallProblemDistortedSpeech func(callProblemDistortedSpeech *CallProblemDistortedSpeech) R
+	CallProblemSilentLocal     func(callProblemSilentLocal *CallProblemSilentLocal) R
+	CallProblemSilentRemote    func(callProblemSilentRemote *CallProblemSilentRemote) R
+	CallProblemDropped         func(callProblemDropped *CallProblemDropped) R
+	CallProblemDistortedVideo  func(callProblemDistortedVideo *CallProblemDistortedVideo) R
+	CallProblemPixelatedVideo  func(callProblemPixelatedVideo *CallProblemPixelatedVideo) R
+}
+
+// MatchCallProblem calls the case of the type of callProblem, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement CallProblemVisitor
+// to have the compiler check every type is handled.
+func MatchCallProblem[R any](callProblem CallProblem, cases CallProblemCases[R], defaultCase func(callProblem CallProblem) R) R {
+	switch value := callProblem.(type) {
+	case *CallProblemEcho:
+		if cases.CallProblemEcho != nil {
+			return cases.CallProblemEcho(value)
+		}
+	case *CallProblemNoise:
+		if cases.CallProblemNoise != nil {
+			return cases.CallProblemNoise(value)
+		}
+	case *CallProblemInterruptions:
+		if cases.CallProblemInterruptions != nil {
+			return cases.CallProblemInterruptions(value)
+		}
+	case *CallProblemDistortedSpeech:
+		if cases.CallProblemDistortedSpeech != nil {
+			return cases.CallProblemDistortedSpeech(value)
+		}
+	case *CallProblemSilentLocal:
+		if cases.CallProblemSilentLocal != nil {
+			return cases.CallProblemSilentLocal(value)
+		}
+	case *CallProblemSilentRemote:
+		if cases.CallProblemSilentRemote != nil {
+			return cases.CallProblemSilentRemote(value)
+		}
+	case *CallProblemDropped:
+		if cases.CallProblemDropped != nil {
+			return cases.CallProblemDropped(value)
+		}
+	case *CallProblemDistortedVideo:
+		if cases.CallProblemDistortedVideo != nil {
+			return cases.CallProblemDistortedVideo(value)
+		}
+	case *CallProblemPixelatedVideo:
+		if cases.CallProblemPixelatedVideo != nil {
+			return cases.CallProblemPixelatedVideo(value)
+		}
+	}
+	return defaultCase(callProblem)
+}
+
+// Clone returns a deep copy of the object
+func (unknownCallProblem *UnknownCallProblem) Clone() *UnknownCallProblem {
+	if unknownCallProblem == nil {
+		return nil
+	}
+	return &UnknownCallProblem{Unknown: unknownCallProblem.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownCallProblem *UnknownCallProblem) Equal(other *UnknownCallProblem) bool {
+	if unknownCallProblem == nil || other == nil {
+		return unknownCallProblem == other
+	}
+	return unknownCallProblem.Unknown.equal(other.Unknown)
+}
+
+func cloneCallProblem(callProblem CallProblem) CallProblem {
+	switch value := callProblem.(type) {
+	case *CallProblemEcho:
+		return value.Clone()
+	case *CallProblemNoise:
+		return value.Clone()
+	case *CallProblemInterruptions:
+		return value.Clone()
+	case *CallProblemDistortedSpeech:
+		return value.Clone()
+	case *CallProblemSilentLocal:
+		return value.Clone()
+	case *CallProblemSilentRemote:
+		return value.Clone()
+	case *CallProblemDropped:
+		return value.Clone()
+	case *CallProblemDistortedVideo:
+		return value.Clone()
+	case *CallProblemPixelatedVideo:
+		return value.Clone()
+	case *UnknownCallProblem:
+		return value.Clone()
+	}
+	return callProblem
+}
+
+func equalCallProblem(a CallProblem, b CallProblem) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *CallProblemEcho:
+		b, ok := b.(*CallProblemEcho)
+		return ok && a.Equal(b)
+	case *CallProblemNoise:
+		b, ok := b.(*CallProblemNoise)
+		return ok && a.Equal(b)
+	case *CallProblemInterruptions:
+		b, ok := b.(*CallProblemInterruptions)
+		return ok && a.Equal(b)
+	case *CallProblemDistortedSpeech:
+		b, ok := b.(*CallProblemDistortedSpeech)
+		return ok && a.Equal(b)
+	case *CallProblemSilentLocal:
+		b, ok := b.(*CallProblemSilentLocal)
+		return ok && a.Equal(b)
+	case *CallProblemSilentRemote:
+		b, ok := b.(*CallProblemSilentRemote)
+		return ok && a.Equal(b)
+	case *CallProblemDropped:
+		b, ok := b.(*CallProblemDropped)
+		return ok && a.Equal(b)
+	case *CallProblemDistortedVideo:
+		b, ok := b.(*CallProblemDistortedVideo)
+		return ok && a.Equal(b)
+	case *CallProblemPixelatedVideo:
+		b, ok := b.(*CallProblemPixelatedVideo)
+		return ok && a.Equal(b)
+	case *UnknownCallProblem:
+		b, ok := b.(*UnknownCallProblem)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffCallProblem(path string, a CallProblem, b CallProblem, changes []Change) []Change {
+	switch a := a.(type) {
+	case *CallProblemEcho:
+		if b, ok := b.(*CallProblemEcho); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CallProblemNoise:
+		if b, ok := b.(*CallProblemNoise); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CallProblemInterruptions:
+		if b, ok := b.(*CallProblemInterruptions); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CallProblemDistortedSpeech:
+		if b, ok := b.(*CallProblemDistortedSpeech); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CallProblemSilentLocal:
+		if b, ok := b.(*CallProblemSilentLocal); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CallProblemSilentRemote:
+		if b, ok := b.(*CallProblemSilentRemote); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CallProblemDropped:
+		if b, ok := b.(*CallProblemDropped); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CallProblemDistortedVideo:
+		if b, ok := b.(*CallProblemDistortedVideo); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CallProblemPixelatedVideo:
+		if b, ok := b.(*CallProblemPixelatedVideo); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalCallProblem(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // CallProtocol Specifies the supported call protocols
 type CallProtocol struct {
 	tdCommon
@@ -34,3 +39,187 @@ func NewCallProtocol(udpP2p bool, udpReflector bool, minLayer int32, maxLayer in
 
 	return &callProtocolTemp
 }
+
+// MarshalJSON marshals to json
+func (callProtocol *CallProtocol) MarshalJSON() ([]byte, error) {
+	return callProtocol.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (callProtocol *CallProtocol) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, callProtocol)
+}
+
+func (callProtocol *CallProtocol) appendJSON(b []byte) ([]byte, error) {
+	if callProtocol == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callProtocol.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callProtocol.tdCommon.Extra)
+	b = append(b, `,"udp_p2p":`...)
+	b = appendJSONBool(b, callProtocol.UdpP2p)
+	b = append(b, `,"udp_reflector":`...)
+	b = appendJSONBool(b, callProtocol.UdpReflector)
+	b = append(b, `,"min_layer":`...)
+	b = appendJSONInt(b, int64(callProtocol.MinLayer))
+	b = append(b, `,"max_layer":`...)
+	b = appendJSONInt(b, int64(callProtocol.MaxLayer))
+	b = append(b, `,"library_versions":`...)
+	if callProtocol.LibraryVersions == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range callProtocol.LibraryVersions {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONString(b, callProtocol.LibraryVersions[i0])
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (callProtocol *CallProtocol) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callProtocol.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callProtocol.tdCommon.Extra, err = r.readString()
+		case "udp_p2p":
+			callProtocol.UdpP2p, err = r.readBool()
+		case "udp_reflector":
+			callProtocol.UdpReflector, err = r.readBool()
+		case "min_layer":
+			callProtocol.MinLayer, err = r.readInt32()
+		case "max_layer":
+			callProtocol.MaxLayer, err = r.readInt32()
+		case "library_versions":
+			if r.readNull() {
+				callProtocol.LibraryVersions = nil
+			} else {
+				callProtocol.LibraryVersions = make([]string, 0)
+				err = r.readArray(func() (err error) {
+					var item0 string
+					item0, err = r.readString()
+					callProtocol.LibraryVersions = append(callProtocol.LibraryVersions, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callProtocol *CallProtocol) Clone() *CallProtocol {
+	if callProtocol == nil {
+		return nil
+	}
+	clone := *callProtocol
+	if callProtocol.LibraryVersions != nil {
+		clone.LibraryVersions = make([]string, len(callProtocol.LibraryVersions))
+		copy(clone.LibraryVersions, callProtocol.LibraryVersions)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callProtocol *CallProtocol) Equal(other *CallProtocol) bool {
+	if callProtocol == nil || other == nil {
+		return callProtocol == other
+	}
+	if callProtocol.UdpP2p != other.UdpP2p {
+		return false
+	}
+	if callProtocol.UdpReflector != other.UdpReflector {
+		return false
+	}
+	if callProtocol.MinLayer != other.MinLayer {
+		return false
+	}
+	if callProtocol.MaxLayer != other.MaxLayer {
+		return false
+	}
+	if len(callProtocol.LibraryVersions) != len(other.LibraryVersions) {
+		return false
+	}
+	for i0 := range callProtocol.LibraryVersions {
+		if callProtocol.LibraryVersions[i0] != other.LibraryVersions[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callProtocol *CallProtocol) Diff(other *CallProtocol) []Change {
+	return callProtocol.diff("", other, nil)
+}
+
+func (callProtocol *CallProtocol) diff(path string, other *CallProtocol, changes []Change) []Change {
+	if callProtocol == nil || other == nil {
+		if callProtocol != other {
+			changes = append(changes, Change{Path: path, Old: callProtocol, New: other})
+		}
+		return changes
+	}
+	if callProtocol.UdpP2p != other.UdpP2p {
+		changes = append(changes, Change{Path: fieldPath(path, "udp_p2p"), Old: callProtocol.UdpP2p, New: other.UdpP2p})
+	}
+	if callProtocol.UdpReflector != other.UdpReflector {
+		changes = append(changes, Change{Path: fieldPath(path, "udp_reflector"), Old: callProtocol.UdpReflector, New: other.UdpReflector})
+	}
+	if callProtocol.MinLayer != other.MinLayer {
+		changes = append(changes, Change{Path: fieldPath(path, "min_layer"), Old: callProtocol.MinLayer, New: other.MinLayer})
+	}
+	if callProtocol.MaxLayer != other.MaxLayer {
+		changes = append(changes, Change{Path: fieldPath(path, "max_layer"), Old: callProtocol.MaxLayer, New: other.MaxLayer})
+	}
+	if len(callProtocol.LibraryVersions) != len(other.LibraryVersions) {
+		changes = append(changes, Change{Path: fieldPath(path, "library_versions"), Old: callProtocol.LibraryVersions, New: other.LibraryVersions})
+	} else {
+		for i0 := range callProtocol.LibraryVersions {
+			if callProtocol.LibraryVersions[i0] != other.LibraryVersions[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "library_versions"), i0), Old: callProtocol.LibraryVersions[i0], New: other.LibraryVersions[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callProtocol *CallProtocol) String() string {
+	return objectString(callProtocol)
+}
+
+// LogValue logs the object as its summary
+func (callProtocol *CallProtocol) LogValue() slog.Value {
+	return slog.StringValue(objectString(callProtocol))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callProtocol *CallProtocol) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callProtocol)
+}
+
+func (callProtocol *CallProtocol) printText(p *printer) {
+	if callProtocol == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallProtocol")
+	p.bool("udp_p2p", callProtocol.UdpP2p)
+	p.bool("udp_reflector", callProtocol.UdpReflector)
+	p.int("min_layer", int64(callProtocol.MinLayer))
+	p.int("max_layer", int64(callProtocol.MaxLayer))
+	p.vector("library_versions", len(callProtocol.LibraryVersions), func(i0 int) {
+		p.string("library_versions", callProtocol.LibraryVersions[i0])
+	})
+	p.endObject(state)
+}
@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // CallServer Describes a server for relaying call data
@@ -39,34 +40,151 @@ func NewCallServer(id JSONInt64, ipAddress string, ipv6Address string, port int3
 	return &callServerTemp
 }
 
+// MarshalJSON marshals to json
+func (callServer *CallServer) MarshalJSON() ([]byte, error) {
+	return callServer.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (callServer *CallServer) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, callServer)
+}
+
+func (callServer *CallServer) appendJSON(b []byte) ([]byte, error) {
+	if callServer == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callServer.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callServer.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt64String(b, int64(callServer.Id))
+	b = append(b, `,"ip_address":`...)
+	b = appendJSONString(b, callServer.IpAddress)
+	b = append(b, `,"ipv6_address":`...)
+	b = appendJSONString(b, callServer.Ipv6Address)
+	b = append(b, `,"port":`...)
+	b = appendJSONInt(b, int64(callServer.Port))
+	b = append(b, `,"type":`...)
+	b, err = appendJSONValue(b, callServer.Type)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-		Id          JSONInt64 `json:"id"`           // Server identifier
-		IpAddress   string    `json:"ip_address"`   // Server IPv4 address
-		Ipv6Address string    `json:"ipv6_address"` // Server IPv6 address
-		Port        int32     `json:"port"`         // Server port number
+	return append(b, '}'), nil
+}
 
-	}{}
-	err = json.Unmarshal(b, &tempObj)
-	if err != nil {
-		return err
+func (callServer *CallServer) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callServer.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callServer.tdCommon.Extra, err = r.readString()
+		case "id":
+			callServer.Id, err = r.readJSONInt64()
+		case "ip_address":
+			callServer.IpAddress, err = r.readString()
+		case "ipv6_address":
+			callServer.Ipv6Address, err = r.readString()
+		case "port":
+			callServer.Port, err = r.readInt32()
+		case "type":
+			callServer.Type, err = decodeCallServerType(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callServer *CallServer) Clone() *CallServer {
+	if callServer == nil {
+		return nil
+	}
+	clone := *callServer
+	clone.Type = cloneCallServerType(callServer.Type)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callServer *CallServer) Equal(other *CallServer) bool {
+	if callServer == nil || other == nil {
+		return callServer == other
+	}
+	if callServer.Id != other.Id {
+		return false
+	}
+	if callServer.IpAddress != other.IpAddress {
+		return false
+	}
+	if callServer.Ipv6Address != other.Ipv6Address {
+		return false
+	}
+	if callServer.Port != other.Port {
+		return false
 	}
+	if !equalCallServerType(callServer.Type, other.Type) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callServer *CallServer) Diff(other *CallServer) []Change {
+	return callServer.diff("", other, nil)
+}
+
+func (callServer *CallServer) diff(path string, other *CallServer, changes []Change) []Change {
+	if callServer == nil || other == nil {
+		if callServer != other {
+			changes = append(changes, Change{Path: path, Old: callServer, New: other})
+		}
+		return changes
+	}
+	if callServer.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: callServer.Id, New: other.Id})
+	}
+	if callServer.IpAddress != other.IpAddress {
+		changes = append(changes, Change{Path: fieldPath(path, "ip_address"), Old: callServer.IpAddress, New: other.IpAddress})
+	}
+	if callServer.Ipv6Address != other.Ipv6Address {
+		changes = append(changes, Change{Path: fieldPath(path, "ipv6_address"), Old: callServer.Ipv6Address, New: other.Ipv6Address})
+	}
+	if callServer.Port != other.Port {
+		changes = append(changes, Change{Path: fieldPath(path, "port"), Old: callServer.Port, New: other.Port})
+	}
+	changes = diffCallServerType(fieldPath(path, "type"), callServer.Type, other.Type, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callServer *CallServer) String() string {
+	return objectString(callServer)
+}
 
-	callServer.tdCommon = tempObj.tdCommon
-	callServer.Id = tempObj.Id
-	callServer.IpAddress = tempObj.IpAddress
-	callServer.Ipv6Address = tempObj.Ipv6Address
-	callServer.Port = tempObj.Port
+// LogValue logs the object as its summary
+func (callServer *CallServer) LogValue() slog.Value {
+	return slog.StringValue(objectString(callServer))
+}
 
-	fieldType, _ := unmarshalCallServerType(objMap["type"])
-	callServer.Type = fieldType
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callServer *CallServer) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callServer)
+}
 
-	return nil
+func (callServer *CallServer) printText(p *printer) {
+	if callServer == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallServer")
+	p.int("id", int64(callServer.Id))
+	p.string("ip_address", callServer.IpAddress)
+	p.string("ipv6_address", callServer.Ipv6Address)
+	p.int("port", int64(callServer.Port))
+	p.object("type", callServer.Type)
+	p.endObject(state)
 }
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // CallServerType Describes the type of a call server
 type CallServerType interface {
 	GetCallServerTypeEnum() CallServerTypeEnum
+	Accept(visitor CallServerTypeVisitor)
 }
 
 // CallServerTypeEnum Alias for abstract CallServerType 'Sub-Classes', used as constant-enum here
@@ -19,31 +21,82 @@ const (
 	CallServerTypeWebrtcType            CallServerTypeEnum = "callServerTypeWebrtc"
 )
 
+// UnknownCallServerType is a CallServerType of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownCallServerType struct {
+	Unknown
+}
+
+// GetCallServerTypeEnum return the enum type of this object
+func (unknownCallServerType *UnknownCallServerType) GetCallServerTypeEnum() CallServerTypeEnum {
+	return CallServerTypeEnum(unknownCallServerType.Type)
+}
+
+// Accept calls the method of the visitor for UnknownCallServerType
+func (unknownCallServerType *UnknownCallServerType) Accept(visitor CallServerTypeVisitor) {
+	visitor.VisitUnknownCallServerType(unknownCallServerType)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownCallServerType *UnknownCallServerType) String() string {
+	return objectString(unknownCallServerType)
+}
+
+// LogValue logs the object as its summary
+func (unknownCallServerType *UnknownCallServerType) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownCallServerType))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownCallServerType *UnknownCallServerType) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownCallServerType)
+}
+
+func (unknownCallServerType *UnknownCallServerType) printText(p *printer) {
+	if unknownCallServerType == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownCallServerType")
+	p.string("@type", unknownCallServerType.Type)
+	p.endObject(state)
+}
+
 func unmarshalCallServerType(rawMsg *json.RawMessage) (CallServerType, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch CallServerTypeEnum(objMap["@type"].(string)) {
+	switch CallServerTypeEnum(typeName) {
 	case CallServerTypeTelegramReflectorType:
 		var callServerTypeTelegramReflector CallServerTypeTelegramReflector
-		err := json.Unmarshal(*rawMsg, &callServerTypeTelegramReflector)
+		err := jsonUnmarshal(*rawMsg, &callServerTypeTelegramReflector)
 		return &callServerTypeTelegramReflector, err
 
 	case CallServerTypeWebrtcType:
 		var callServerTypeWebrtc CallServerTypeWebrtc
-		err := json.Unmarshal(*rawMsg, &callServerTypeWebrtc)
+		err := jsonUnmarshal(*rawMsg, &callServerTypeWebrtc)
 		return &callServerTypeWebrtc, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownCallServerType{Unknown: unknown}, nil
+	}
+}
+
+func decodeCallServerType(r *jsonReader) (CallServerType, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
 	}
+	return unmarshalCallServerType(rawMsg)
 }
 
 // CallServerTypeTelegramReflector A Telegram call reflector
@@ -69,11 +122,121 @@ func NewCallServerTypeTelegramReflector(peerTag []byte) *CallServerTypeTelegramR
 	return &callServerTypeTelegramReflectorTemp
 }
 
+// MarshalJSON marshals to json
+func (callServerTypeTelegramReflector *CallServerTypeTelegramReflector) MarshalJSON() ([]byte, error) {
+	return callServerTypeTelegramReflector.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (callServerTypeTelegramReflector *CallServerTypeTelegramReflector) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, callServerTypeTelegramReflector)
+}
+
+func (callServerTypeTelegramReflector *CallServerTypeTelegramReflector) appendJSON(b []byte) ([]byte, error) {
+	if callServerTypeTelegramReflector == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callServerTypeTelegramReflector.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callServerTypeTelegramReflector.tdCommon.Extra)
+	b = append(b, `,"peer_tag":`...)
+	b = appendJSONBytes(b, callServerTypeTelegramReflector.PeerTag)
+	return append(b, '}'), nil
+}
+
+func (callServerTypeTelegramReflector *CallServerTypeTelegramReflector) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callServerTypeTelegramReflector.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callServerTypeTelegramReflector.tdCommon.Extra, err = r.readString()
+		case "peer_tag":
+			callServerTypeTelegramReflector.PeerTag, err = r.readBytes()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callServerTypeTelegramReflector *CallServerTypeTelegramReflector) Clone() *CallServerTypeTelegramReflector {
+	if callServerTypeTelegramReflector == nil {
+		return nil
+	}
+	clone := *callServerTypeTelegramReflector
+	if callServerTypeTelegramReflector.PeerTag != nil {
+		clone.PeerTag = append([]byte{}, callServerTypeTelegramReflector.PeerTag...)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callServerTypeTelegramReflector *CallServerTypeTelegramReflector) Equal(other *CallServerTypeTelegramReflector) bool {
+	if callServerTypeTelegramReflector == nil || other == nil {
+		return callServerTypeTelegramReflector == other
+	}
+	if !equalBytes(callServerTypeTelegramReflector.PeerTag, other.PeerTag) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callServerTypeTelegramReflector *CallServerTypeTelegramReflector) Diff(other *CallServerTypeTelegramReflector) []Change {
+	return callServerTypeTelegramReflector.diff("", other, nil)
+}
+
+func (callServerTypeTelegramReflector *CallServerTypeTelegramReflector) diff(path string, other *CallServerTypeTelegramReflector, changes []Change) []Change {
+	if callServerTypeTelegramReflector == nil || other == nil {
+		if callServerTypeTelegramReflector != other {
+			changes = append(changes, Change{Path: path, Old: callServerTypeTelegramReflector, New: other})
+		}
+		return changes
+	}
+	if !equalBytes(callServerTypeTelegramReflector.PeerTag, other.PeerTag) {
+		changes = append(changes, Change{Path: fieldPath(path, "peer_tag"), Old: callServerTypeTelegramReflector.PeerTag, New: other.PeerTag})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callServerTypeTelegramReflector *CallServerTypeTelegramReflector) String() string {
+	return objectString(callServerTypeTelegramReflector)
+}
+
+// LogValue logs the object as its summary
+func (callServerTypeTelegramReflector *CallServerTypeTelegramReflector) LogValue() slog.Value {
+	return slog.StringValue(objectString(callServerTypeTelegramReflector))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callServerTypeTelegramReflector *CallServerTypeTelegramReflector) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callServerTypeTelegramReflector)
+}
+
+func (callServerTypeTelegramReflector *CallServerTypeTelegramReflector) printText(p *printer) {
+	if callServerTypeTelegramReflector == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallServerTypeTelegramReflector")
+	p.bytes("peer_tag", callServerTypeTelegramReflector.PeerTag)
+	p.endObject(state)
+}
+
 // GetCallServerTypeEnum return the enum type of this object
 func (callServerTypeTelegramReflector *CallServerTypeTelegramReflector) GetCallServerTypeEnum() CallServerTypeEnum {
 	return CallServerTypeTelegramReflectorType
 }
 
+// Accept calls the method of the visitor for CallServerTypeTelegramReflector
+func (callServerTypeTelegramReflector *CallServerTypeTelegramReflector) Accept(visitor CallServerTypeVisitor) {
+	visitor.VisitCallServerTypeTelegramReflector(callServerTypeTelegramReflector)
+}
+
 // CallServerTypeWebrtc A WebRTC server
 type CallServerTypeWebrtc struct {
 	tdCommon
@@ -106,7 +269,242 @@ func NewCallServerTypeWebrtc(username string, password string, supportsTurn bool
 	return &callServerTypeWebrtcTemp
 }
 
+// MarshalJSON marshals to json
+func (callServerTypeWebrtc *CallServerTypeWebrtc) MarshalJSON() ([]byte, error) {
+	return callServerTypeWebrtc.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (callServerTypeWebrtc *CallServerTypeWebrtc) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, callServerTypeWebrtc)
+}
+
+func (callServerTypeWebrtc *CallServerTypeWebrtc) appendJSON(b []byte) ([]byte, error) {
+	if callServerTypeWebrtc == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callServerTypeWebrtc.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callServerTypeWebrtc.tdCommon.Extra)
+	b = append(b, `,"username":`...)
+	b = appendJSONString(b, callServerTypeWebrtc.Username)
+	b = append(b, `,"password":`...)
+	b = appendJSONString(b, callServerTypeWebrtc.Password)
+	b = append(b, `,"supports_turn":`...)
+	b = appendJSONBool(b, callServerTypeWebrtc.SupportsTurn)
+	b = append(b, `,"supports_stun":`...)
+	b = appendJSONBool(b, callServerTypeWebrtc.SupportsStun)
+	return append(b, '}'), nil
+}
+
+func (callServerTypeWebrtc *CallServerTypeWebrtc) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callServerTypeWebrtc.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callServerTypeWebrtc.tdCommon.Extra, err = r.readString()
+		case "username":
+			callServerTypeWebrtc.Username, err = r.readString()
+		case "password":
+			callServerTypeWebrtc.Password, err = r.readString()
+		case "supports_turn":
+			callServerTypeWebrtc.SupportsTurn, err = r.readBool()
+		case "supports_stun":
+			callServerTypeWebrtc.SupportsStun, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callServerTypeWebrtc *CallServerTypeWebrtc) Clone() *CallServerTypeWebrtc {
+	if callServerTypeWebrtc == nil {
+		return nil
+	}
+	clone := *callServerTypeWebrtc
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callServerTypeWebrtc *CallServerTypeWebrtc) Equal(other *CallServerTypeWebrtc) bool {
+	if callServerTypeWebrtc == nil || other == nil {
+		return callServerTypeWebrtc == other
+	}
+	if callServerTypeWebrtc.Username != other.Username {
+		return false
+	}
+	if callServerTypeWebrtc.Password != other.Password {
+		return false
+	}
+	if callServerTypeWebrtc.SupportsTurn != other.SupportsTurn {
+		return false
+	}
+	if callServerTypeWebrtc.SupportsStun != other.SupportsStun {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callServerTypeWebrtc *CallServerTypeWebrtc) Diff(other *CallServerTypeWebrtc) []Change {
+	return callServerTypeWebrtc.diff("", other, nil)
+}
+
+func (callServerTypeWebrtc *CallServerTypeWebrtc) diff(path string, other *CallServerTypeWebrtc, changes []Change) []Change {
+	if callServerTypeWebrtc == nil || other == nil {
+		if callServerTypeWebrtc != other {
+			changes = append(changes, Change{Path: path, Old: callServerTypeWebrtc, New: other})
+		}
+		return changes
+	}
+	if callServerTypeWebrtc.Username != other.Username {
+		changes = append(changes, Change{Path: fieldPath(path, "username"), Old: callServerTypeWebrtc.Username, New: other.Username})
+	}
+	if callServerTypeWebrtc.Password != other.Password {
+		changes = append(changes, Change{Path: fieldPath(path, "password"), Old: callServerTypeWebrtc.Password, New: other.Password})
+	}
+	if callServerTypeWebrtc.SupportsTurn != other.SupportsTurn {
+		changes = append(changes, Change{Path: fieldPath(path, "supports_turn"), Old: callServerTypeWebrtc.SupportsTurn, New: other.SupportsTurn})
+	}
+	if callServerTypeWebrtc.SupportsStun != other.SupportsStun {
+		changes = append(changes, Change{Path: fieldPath(path, "supports_stun"), Old: callServerTypeWebrtc.SupportsStun, New: other.SupportsStun})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callServerTypeWebrtc *CallServerTypeWebrtc) String() string {
+	return objectString(callServerTypeWebrtc)
+}
+
+// LogValue logs the object as its summary
+func (callServerTypeWebrtc *CallServerTypeWebrtc) LogValue() slog.Value {
+	return slog.StringValue(objectString(callServerTypeWebrtc))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callServerTypeWebrtc *CallServerTypeWebrtc) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callServerTypeWebrtc)
+}
+
+func (callServerTypeWebrtc *CallServerTypeWebrtc) printText(p *printer) {
+	if callServerTypeWebrtc == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallServerTypeWebrtc")
+	p.string("username", callServerTypeWebrtc.Username)
+	p.string("password", callServerTypeWebrtc.Password)
+	p.bool("supports_turn", callServerTypeWebrtc.SupportsTurn)
+	p.bool("supports_stun", callServerTypeWebrtc.SupportsStun)
+	p.endObject(state)
+}
+
 // GetCallServerTypeEnum return the enum type of this object
 func (callServerTypeWebrtc *CallServerTypeWebrtc) GetCallServerTypeEnum() CallServerTypeEnum {
 	return CallServerTypeWebrtcType
 }
+
+// Accept calls the method of the visitor for CallServerTypeWebrtc
+func (callServerTypeWebrtc *CallServerTypeWebrtc) Accept(visitor CallServerTypeVisitor) {
+	visitor.VisitCallServerTypeWebrtc(callServerTypeWebrtc)
+}
+
+// CallServerTypeVisitor visits every type of CallServerType, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to CallServerType, until they handle it too.
+type CallServerTypeVisitor interface {
+	VisitCallServerTypeTelegramReflector(callServerTypeTelegramReflector *CallServerTypeTelegramReflector)
+	VisitCallServerTypeWebrtc(callServerTypeWebrtc *CallServerTypeWebrtc)
+	VisitUnknownCallServerType(unknownCallServerType *UnknownCallServerType)
+}
+
+// CallServerTypeCases holds the function MatchCallServerType calls for each type of CallServerType;
+// types without a function are passed to the default case
+type CallServerTypeCases[R any] struct {
+	CallServerTypeTelegramReflector func(callServerTypeTelegramReflector *CallServerTypeTelegramReflector) R
+	CallServerTypeWebrtc            func(callServerTypeWebrtc *CallServerTypeWebrtc) R
+}
+
+// MatchCallServerType calls the case of the type of callServerType, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement CallServerTypeVisitor
+// to have the compiler check every type is handled.
+func MatchCallServerType[R any](callServerType CallServerType, cases CallServerTypeCases[R], defaultCase func(callServerType CallServerType) R) R {
+	switch value := callServerType.(type) {
+	case *CallServerTypeTelegramReflector:
+		if cases.CallServerTypeTelegramReflector != nil {
+			return cases.CallServerTypeTelegramReflector(value)
+		}
+	case *CallServerTypeWebrtc:
+		if cases.CallServerTypeWebrtc != nil {
+			return cases.CallServerTypeWebrtc(value)
+		}
+	}
+	return defaultCase(callServerType)
+}
+
+// Clone returns a deep copy of the object
+func (unknownCallServerType *UnknownCallServerType) Clone() *UnknownCallServerType {
+	if unknownCallServerType == nil {
+		return nil
+	}
+	return &UnknownCallServerType{Unknown: unknownCallServerType.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownCallServerType *UnknownCallServerType) Equal(other *UnknownCallServerType) bool {
+	if unknownCallServerType == nil || other == nil {
+		return unknownCallServerType == other
+	}
+	return unknownCallServerType.Unknown.equal(other.Unknown)
+}
+
+func cloneCallServerType(callServerType CallServerType) CallServerType {
+	switch value := callServerType.(type) {
+	case *CallServerTypeTelegramReflector:
+		return value.Clone()
+	case *CallServerTypeWebrtc:
+		return value.Clone()
+	case *UnknownCallServerType:
+		return value.Clone()
+	}
+	return callServerType
+}
+
+func equalCallServerType(a CallServerType, b CallServerType) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *CallServerTypeTelegramReflector:
+		b, ok := b.(*CallServerTypeTelegramReflector)
+		return ok && a.Equal(b)
+	case *CallServerTypeWebrtc:
+		b, ok := b.(*CallServerTypeWebrtc)
+		return ok && a.Equal(b)
+	case *UnknownCallServerType:
+		b, ok := b.(*UnknownCallServerType)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffCallServerType(path string, a CallServerType, b CallServerType, changes []Change) []Change {
+	switch a := a.(type) {
+	case *CallServerTypeTelegramReflector:
+		if b, ok := b.(*CallServerTypeTelegramReflector); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CallServerTypeWebrtc:
+		if b, ok := b.(*CallServerTypeWebrtc); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalCallServerType(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // CallState Describes the current call state
 type CallState interface {
 	GetCallStateEnum() CallStateEnum
+	Accept(visitor CallStateVisitor)
 }
 
 // CallStateEnum Alias for abstract CallState 'Sub-Classes', used as constant-enum here
@@ -23,51 +25,102 @@ const (
 	CallStateErrorType          CallStateEnum = "callStateError"
 )
 
+// UnknownCallState is a CallState of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownCallState struct {
+	Unknown
+}
+
+// GetCallStateEnum return the enum type of this object
+func (unknownCallState *UnknownCallState) GetCallStateEnum() CallStateEnum {
+	return CallStateEnum(unknownCallState.Type)
+}
+
+// Accept calls the method of the visitor for UnknownCallState
+func (unknownCallState *UnknownCallState) Accept(visitor CallStateVisitor) {
+	visitor.VisitUnknownCallState(unknownCallState)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownCallState *UnknownCallState) String() string {
+	return objectString(unknownCallState)
+}
+
+// LogValue logs the object as its summary
+func (unknownCallState *UnknownCallState) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownCallState))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownCallState *UnknownCallState) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownCallState)
+}
+
+func (unknownCallState *UnknownCallState) printText(p *printer) {
+	if unknownCallState == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownCallState")
+	p.string("@type", unknownCallState.Type)
+	p.endObject(state)
+}
+
 func unmarshalCallState(rawMsg *json.RawMessage) (CallState, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch CallStateEnum(objMap["@type"].(string)) {
+	switch CallStateEnum(typeName) {
 	case CallStatePendingType:
 		var callStatePending CallStatePending
-		err := json.Unmarshal(*rawMsg, &callStatePending)
+		err := jsonUnmarshal(*rawMsg, &callStatePending)
 		return &callStatePending, err
 
 	case CallStateExchangingKeysType:
 		var callStateExchangingKeys CallStateExchangingKeys
-		err := json.Unmarshal(*rawMsg, &callStateExchangingKeys)
+		err := jsonUnmarshal(*rawMsg, &callStateExchangingKeys)
 		return &callStateExchangingKeys, err
 
 	case CallStateReadyType:
 		var callStateReady CallStateReady
-		err := json.Unmarshal(*rawMsg, &callStateReady)
+		err := jsonUnmarshal(*rawMsg, &callStateReady)
 		return &callStateReady, err
 
 	case CallStateHangingUpType:
 		var callStateHangingUp CallStateHangingUp
-		err := json.Unmarshal(*rawMsg, &callStateHangingUp)
+		err := jsonUnmarshal(*rawMsg, &callStateHangingUp)
 		return &callStateHangingUp, err
 
 	case CallStateDiscardedType:
 		var callStateDiscarded CallStateDiscarded
-		err := json.Unmarshal(*rawMsg, &callStateDiscarded)
+		err := jsonUnmarshal(*rawMsg, &callStateDiscarded)
 		return &callStateDiscarded, err
 
 	case CallStateErrorType:
 		var callStateError CallStateError
-		err := json.Unmarshal(*rawMsg, &callStateError)
+		err := jsonUnmarshal(*rawMsg, &callStateError)
 		return &callStateError, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownCallState{Unknown: unknown}, nil
+	}
+}
+
+func decodeCallState(r *jsonReader) (CallState, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
 	}
+	return unmarshalCallState(rawMsg)
 }
 
 // CallStatePending The call is pending, waiting to be accepted by a user
@@ -96,11 +149,129 @@ func NewCallStatePending(isCreated bool, isReceived bool) *CallStatePending {
 	return &callStatePendingTemp
 }
 
+// MarshalJSON marshals to json
+func (callStatePending *CallStatePending) MarshalJSON() ([]byte, error) {
+	return callStatePending.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (callStatePending *CallStatePending) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, callStatePending)
+}
+
+func (callStatePending *CallStatePending) appendJSON(b []byte) ([]byte, error) {
+	if callStatePending == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callStatePending.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callStatePending.tdCommon.Extra)
+	b = append(b, `,"is_created":`...)
+	b = appendJSONBool(b, callStatePending.IsCreated)
+	b = append(b, `,"is_received":`...)
+	b = appendJSONBool(b, callStatePending.IsReceived)
+	return append(b, '}'), nil
+}
+
+func (callStatePending *CallStatePending) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callStatePending.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callStatePending.tdCommon.Extra, err = r.readString()
+		case "is_created":
+			callStatePending.IsCreated, err = r.readBool()
+		case "is_received":
+			callStatePending.IsReceived, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callStatePending *CallStatePending) Clone() *CallStatePending {
+	if callStatePending == nil {
+		return nil
+	}
+	clone := *callStatePending
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callStatePending *CallStatePending) Equal(other *CallStatePending) bool {
+	if callStatePending == nil || other == nil {
+		return callStatePending == other
+	}
+	if callStatePending.IsCreated != other.IsCreated {
+		return false
+	}
+	if callStatePending.IsReceived != other.IsReceived {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callStatePending *CallStatePending) Diff(other *CallStatePending) []Change {
+	return callStatePending.diff("", other, nil)
+}
+
+func (callStatePending *CallStatePending) diff(path string, other *CallStatePending, changes []Change) []Change {
+	if callStatePending == nil || other == nil {
+		if callStatePending != other {
+			changes = append(changes, Change{Path: path, Old: callStatePending, New: other})
+		}
+		return changes
+	}
+	if callStatePending.IsCreated != other.IsCreated {
+		changes = append(changes, Change{Path: fieldPath(path, "is_created"), Old: callStatePending.IsCreated, New: other.IsCreated})
+	}
+	if callStatePending.IsReceived != other.IsReceived {
+		changes = append(changes, Change{Path: fieldPath(path, "is_received"), Old: callStatePending.IsReceived, New: other.IsReceived})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callStatePending *CallStatePending) String() string {
+	return objectString(callStatePending)
+}
+
+// LogValue logs the object as its summary
+func (callStatePending *CallStatePending) LogValue() slog.Value {
+	return slog.StringValue(objectString(callStatePending))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callStatePending *CallStatePending) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callStatePending)
+}
+
+func (callStatePending *CallStatePending) printText(p *printer) {
+	if callStatePending == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallStatePending")
+	p.bool("is_created", callStatePending.IsCreated)
+	p.bool("is_received", callStatePending.IsReceived)
+	p.endObject(state)
+}
+
 // GetCallStateEnum return the enum type of this object
 func (callStatePending *CallStatePending) GetCallStateEnum() CallStateEnum {
 	return CallStatePendingType
 }
 
+// Accept calls the method of the visitor for CallStatePending
+func (callStatePending *CallStatePending) Accept(visitor CallStateVisitor) {
+	visitor.VisitCallStatePending(callStatePending)
+}
+
 // CallStateExchangingKeys The call has been answered and encryption keys are being exchanged
 type CallStateExchangingKeys struct {
 	tdCommon
@@ -112,7 +283,6 @@ func (callStateExchangingKeys *CallStateExchangingKeys) MessageType() string {
 }
 
 // NewCallStateExchangingKeys creates a new CallStateExchangingKeys
-//
 func NewCallStateExchangingKeys() *CallStateExchangingKeys {
 	callStateExchangingKeysTemp := CallStateExchangingKeys{
 		tdCommon: tdCommon{Type: "callStateExchangingKeys"},
@@ -121,11 +291,107 @@ func NewCallStateExchangingKeys() *CallStateExchangingKeys {
 	return &callStateExchangingKeysTemp
 }
 
+// MarshalJSON marshals to json
+func (callStateExchangingKeys *CallStateExchangingKeys) MarshalJSON() ([]byte, error) {
+	return callStateExchangingKeys.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (callStateExchangingKeys *CallStateExchangingKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, callStateExchangingKeys)
+}
+
+func (callStateExchangingKeys *CallStateExchangingKeys) appendJSON(b []byte) ([]byte, error) {
+	if callStateExchangingKeys == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callStateExchangingKeys.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callStateExchangingKeys.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (callStateExchangingKeys *CallStateExchangingKeys) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callStateExchangingKeys.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callStateExchangingKeys.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callStateExchangingKeys *CallStateExchangingKeys) Clone() *CallStateExchangingKeys {
+	if callStateExchangingKeys == nil {
+		return nil
+	}
+	clone := *callStateExchangingKeys
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callStateExchangingKeys *CallStateExchangingKeys) Equal(other *CallStateExchangingKeys) bool {
+	if callStateExchangingKeys == nil || other == nil {
+		return callStateExchangingKeys == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callStateExchangingKeys *CallStateExchangingKeys) Diff(other *CallStateExchangingKeys) []Change {
+	return callStateExchangingKeys.diff("", other, nil)
+}
+
+func (callStateExchangingKeys *CallStateExchangingKeys) diff(path string, other *CallStateExchangingKeys, changes []Change) []Change {
+	if callStateExchangingKeys == nil || other == nil {
+		if callStateExchangingKeys != other {
+			changes = append(changes, Change{Path: path, Old: callStateExchangingKeys, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callStateExchangingKeys *CallStateExchangingKeys) String() string {
+	return objectString(callStateExchangingKeys)
+}
+
+// LogValue logs the object as its summary
+func (callStateExchangingKeys *CallStateExchangingKeys) LogValue() slog.Value {
+	return slog.StringValue(objectString(callStateExchangingKeys))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callStateExchangingKeys *CallStateExchangingKeys) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callStateExchangingKeys)
+}
+
+func (callStateExchangingKeys *CallStateExchangingKeys) printText(p *printer) {
+	if callStateExchangingKeys == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallStateExchangingKeys")
+	p.endObject(state)
+}
+
 // GetCallStateEnum return the enum type of this object
 func (callStateExchangingKeys *CallStateExchangingKeys) GetCallStateEnum() CallStateEnum {
 	return CallStateExchangingKeysType
 }
 
+// Accept calls the method of the visitor for CallStateExchangingKeys
+func (callStateExchangingKeys *CallStateExchangingKeys) Accept(visitor CallStateVisitor) {
+	visitor.VisitCallStateExchangingKeys(callStateExchangingKeys)
+}
+
 // CallStateReady The call is ready to use
 type CallStateReady struct {
 	tdCommon
@@ -164,11 +430,263 @@ func NewCallStateReady(protocol *CallProtocol, servers []CallServer, config stri
 	return &callStateReadyTemp
 }
 
+// MarshalJSON marshals to json
+func (callStateReady *CallStateReady) MarshalJSON() ([]byte, error) {
+	return callStateReady.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (callStateReady *CallStateReady) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, callStateReady)
+}
+
+func (callStateReady *CallStateReady) appendJSON(b []byte) ([]byte, error) {
+	if callStateReady == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callStateReady.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callStateReady.tdCommon.Extra)
+	b = append(b, `,"protocol":`...)
+	b, err = callStateReady.Protocol.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"servers":`...)
+	if callStateReady.Servers == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range callStateReady.Servers {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = callStateReady.Servers[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"config":`...)
+	b = appendJSONString(b, callStateReady.Config)
+	b = append(b, `,"encryption_key":`...)
+	b = appendJSONBytes(b, callStateReady.EncryptionKey)
+	b = append(b, `,"emojis":`...)
+	if callStateReady.Emojis == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range callStateReady.Emojis {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONString(b, callStateReady.Emojis[i0])
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"allow_p2p":`...)
+	b = appendJSONBool(b, callStateReady.AllowP2p)
+	return append(b, '}'), nil
+}
+
+func (callStateReady *CallStateReady) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callStateReady.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callStateReady.tdCommon.Extra, err = r.readString()
+		case "protocol":
+			if r.readNull() {
+				callStateReady.Protocol = nil
+			} else {
+				callStateReady.Protocol = new(CallProtocol)
+				err = callStateReady.Protocol.decodeJSON(r)
+			}
+		case "servers":
+			if r.readNull() {
+				callStateReady.Servers = nil
+			} else {
+				callStateReady.Servers = make([]CallServer, 0)
+				err = r.readArray(func() (err error) {
+					var item0 CallServer
+					err = item0.decodeJSON(r)
+					callStateReady.Servers = append(callStateReady.Servers, item0)
+					return
+				})
+			}
+		case "config":
+			callStateReady.Config, err = r.readString()
+		case "encryption_key":
+			callStateReady.EncryptionKey, err = r.readBytes()
+		case "emojis":
+			if r.readNull() {
+				callStateReady.Emojis = nil
+			} else {
+				callStateReady.Emojis = make([]string, 0)
+				err = r.readArray(func() (err error) {
+					var item0 string
+					item0, err = r.readString()
+					callStateReady.Emojis = append(callStateReady.Emojis, item0)
+					return
+				})
+			}
+		case "allow_p2p":
+			callStateReady.AllowP2p, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callStateReady *CallStateReady) Clone() *CallStateReady {
+	if callStateReady == nil {
+		return nil
+	}
+	clone := *callStateReady
+	clone.Protocol = callStateReady.Protocol.Clone()
+	if callStateReady.Servers != nil {
+		clone.Servers = make([]CallServer, len(callStateReady.Servers))
+		for i0 := range callStateReady.Servers {
+			clone.Servers[i0] = *callStateReady.Servers[i0].Clone()
+		}
+	}
+	if callStateReady.EncryptionKey != nil {
+		clone.EncryptionKey = append([]byte{}, callStateReady.EncryptionKey...)
+	}
+	if callStateReady.Emojis != nil {
+		clone.Emojis = make([]string, len(callStateReady.Emojis))
+		copy(clone.Emojis, callStateReady.Emojis)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callStateReady *CallStateReady) Equal(other *CallStateReady) bool {
+	if callStateReady == nil || other == nil {
+		return callStateReady == other
+	}
+	if !callStateReady.Protocol.Equal(other.Protocol) {
+		return false
+	}
+	if len(callStateReady.Servers) != len(other.Servers) {
+		return false
+	}
+	for i0 := range callStateReady.Servers {
+		if !callStateReady.Servers[i0].Equal(&other.Servers[i0]) {
+			return false
+		}
+	}
+	if callStateReady.Config != other.Config {
+		return false
+	}
+	if !equalBytes(callStateReady.EncryptionKey, other.EncryptionKey) {
+		return false
+	}
+	if len(callStateReady.Emojis) != len(other.Emojis) {
+		return false
+	}
+	for i0 := range callStateReady.Emojis {
+		if callStateReady.Emojis[i0] != other.Emojis[i0] {
+			return false
+		}
+	}
+	if callStateReady.AllowP2p != other.AllowP2p {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callStateReady *CallStateReady) Diff(other *CallStateReady) []Change {
+	return callStateReady.diff("", other, nil)
+}
+
+func (callStateReady *CallStateReady) diff(path string, other *CallStateReady, changes []Change) []Change {
+	if callStateReady == nil || other == nil {
+		if callStateReady != other {
+			changes = append(changes, Change{Path: path, Old: callStateReady, New: other})
+		}
+		return changes
+	}
+	changes = callStateReady.Protocol.diff(fieldPath(path, "protocol"), other.Protocol, changes)
+	if len(callStateReady.Servers) != len(other.Servers) {
+		changes = append(changes, Change{Path: fieldPath(path, "servers"), Old: callStateReady.Servers, New: other.Servers})
+	} else {
+		for i0 := range callStateReady.Servers {
+			changes = callStateReady.Servers[i0].diff(indexPath(fieldPath(path, "servers"), i0), &other.Servers[i0], changes)
+		}
+	}
+	if callStateReady.Config != other.Config {
+		changes = append(changes, Change{Path: fieldPath(path, "config"), Old: callStateReady.Config, New: other.Config})
+	}
+	if !equalBytes(callStateReady.EncryptionKey, other.EncryptionKey) {
+		changes = append(changes, Change{Path: fieldPath(path, "encryption_key"), Old: callStateReady.EncryptionKey, New: other.EncryptionKey})
+	}
+	if len(callStateReady.Emojis) != len(other.Emojis) {
+		changes = append(changes, Change{Path: fieldPath(path, "emojis"), Old: callStateReady.Emojis, New: other.Emojis})
+	} else {
+		for i0 := range callStateReady.Emojis {
+			if callStateReady.Emojis[i0] != other.Emojis[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "emojis"), i0), Old: callStateReady.Emojis[i0], New: other.Emojis[i0]})
+			}
+		}
+	}
+	if callStateReady.AllowP2p != other.AllowP2p {
+		changes = append(changes, Change{Path: fieldPath(path, "allow_p2p"), Old: callStateReady.AllowP2p, New: other.AllowP2p})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callStateReady *CallStateReady) String() string {
+	return objectString(callStateReady)
+}
+
+// LogValue logs the object as its summary
+func (callStateReady *CallStateReady) LogValue() slog.Value {
+	return slog.StringValue(objectString(callStateReady))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callStateReady *CallStateReady) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callStateReady)
+}
+
+func (callStateReady *CallStateReady) printText(p *printer) {
+	if callStateReady == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallStateReady")
+	p.object("protocol", callStateReady.Protocol)
+	p.vector("servers", len(callStateReady.Servers), func(i0 int) {
+		callStateReady.Servers[i0].printText(p)
+	})
+	p.string("config", callStateReady.Config)
+	p.bytes("encryption_key", callStateReady.EncryptionKey)
+	p.vector("emojis", len(callStateReady.Emojis), func(i0 int) {
+		p.string("emojis", callStateReady.Emojis[i0])
+	})
+	p.bool("allow_p2p", callStateReady.AllowP2p)
+	p.endObject(state)
+}
+
 // GetCallStateEnum return the enum type of this object
 func (callStateReady *CallStateReady) GetCallStateEnum() CallStateEnum {
 	return CallStateReadyType
 }
 
+// Accept calls the method of the visitor for CallStateReady
+func (callStateReady *CallStateReady) Accept(visitor CallStateVisitor) {
+	visitor.VisitCallStateReady(callStateReady)
+}
+
 // CallStateHangingUp The call is hanging up after discardCall has been called
 type CallStateHangingUp struct {
 	tdCommon
@@ -180,7 +698,6 @@ func (callStateHangingUp *CallStateHangingUp) MessageType() string {
 }
 
 // NewCallStateHangingUp creates a new CallStateHangingUp
-//
 func NewCallStateHangingUp() *CallStateHangingUp {
 	callStateHangingUpTemp := CallStateHangingUp{
 		tdCommon: tdCommon{Type: "callStateHangingUp"},
@@ -189,11 +706,107 @@ func NewCallStateHangingUp() *CallStateHangingUp {
 	return &callStateHangingUpTemp
 }
 
+// MarshalJSON marshals to json
+func (callStateHangingUp *CallStateHangingUp) MarshalJSON() ([]byte, error) {
+	return callStateHangingUp.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (callStateHangingUp *CallStateHangingUp) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, callStateHangingUp)
+}
+
+func (callStateHangingUp *CallStateHangingUp) appendJSON(b []byte) ([]byte, error) {
+	if callStateHangingUp == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callStateHangingUp.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callStateHangingUp.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (callStateHangingUp *CallStateHangingUp) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callStateHangingUp.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callStateHangingUp.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callStateHangingUp *CallStateHangingUp) Clone() *CallStateHangingUp {
+	if callStateHangingUp == nil {
+		return nil
+	}
+	clone := *callStateHangingUp
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callStateHangingUp *CallStateHangingUp) Equal(other *CallStateHangingUp) bool {
+	if callStateHangingUp == nil || other == nil {
+		return callStateHangingUp == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callStateHangingUp *CallStateHangingUp) Diff(other *CallStateHangingUp) []Change {
+	return callStateHangingUp.diff("", other, nil)
+}
+
+func (callStateHangingUp *CallStateHangingUp) diff(path string, other *CallStateHangingUp, changes []Change) []Change {
+	if callStateHangingUp == nil || other == nil {
+		if callStateHangingUp != other {
+			changes = append(changes, Change{Path: path, Old: callStateHangingUp, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callStateHangingUp *CallStateHangingUp) String() string {
+	return objectString(callStateHangingUp)
+}
+
+// LogValue logs the object as its summary
+func (callStateHangingUp *CallStateHangingUp) LogValue() slog.Value {
+	return slog.StringValue(objectString(callStateHangingUp))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callStateHangingUp *CallStateHangingUp) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callStateHangingUp)
+}
+
+func (callStateHangingUp *CallStateHangingUp) printText(p *printer) {
+	if callStateHangingUp == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallStateHangingUp")
+	p.endObject(state)
+}
+
 // GetCallStateEnum return the enum type of this object
 func (callStateHangingUp *CallStateHangingUp) GetCallStateEnum() CallStateEnum {
 	return CallStateHangingUpType
 }
 
+// Accept calls the method of the visitor for CallStateHangingUp
+func (callStateHangingUp *CallStateHangingUp) Accept(visitor CallStateVisitor) {
+	visitor.VisitCallStateHangingUp(callStateHangingUp)
+}
+
 // CallStateDiscarded The call has ended successfully
 type CallStateDiscarded struct {
 	tdCommon
@@ -223,31 +836,131 @@ func NewCallStateDiscarded(reason CallDiscardReason, needRating bool, needDebugI
 	return &callStateDiscardedTemp
 }
 
+// MarshalJSON marshals to json
+func (callStateDiscarded *CallStateDiscarded) MarshalJSON() ([]byte, error) {
+	return callStateDiscarded.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (callStateDiscarded *CallStateDiscarded) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		NeedRating           bool `json:"need_rating"`            // True, if the call rating must be sent to the server
-		NeedDebugInformation bool `json:"need_debug_information"` // True, if the call debug information must be sent to the server
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	return unmarshalFast(b, callStateDiscarded)
+}
+
+func (callStateDiscarded *CallStateDiscarded) appendJSON(b []byte) ([]byte, error) {
+	if callStateDiscarded == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callStateDiscarded.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callStateDiscarded.tdCommon.Extra)
+	b = append(b, `,"reason":`...)
+	b, err = appendJSONValue(b, callStateDiscarded.Reason)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"need_rating":`...)
+	b = appendJSONBool(b, callStateDiscarded.NeedRating)
+	b = append(b, `,"need_debug_information":`...)
+	b = appendJSONBool(b, callStateDiscarded.NeedDebugInformation)
+	return append(b, '}'), nil
+}
+
+func (callStateDiscarded *CallStateDiscarded) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callStateDiscarded.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callStateDiscarded.tdCommon.Extra, err = r.readString()
+		case "reason":
+			callStateDiscarded.Reason, err = decodeCallDiscardReason(r)
+		case "need_rating":
+			callStateDiscarded.NeedRating, err = r.readBool()
+		case "need_debug_information":
+			callStateDiscarded.NeedDebugInformation, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callStateDiscarded *CallStateDiscarded) Clone() *CallStateDiscarded {
+	if callStateDiscarded == nil {
+		return nil
+	}
+	clone := *callStateDiscarded
+	clone.Reason = cloneCallDiscardReason(callStateDiscarded.Reason)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callStateDiscarded *CallStateDiscarded) Equal(other *CallStateDiscarded) bool {
+	if callStateDiscarded == nil || other == nil {
+		return callStateDiscarded == other
+	}
+	if !equalCallDiscardReason(callStateDiscarded.Reason, other.Reason) {
+		return false
+	}
+	if callStateDiscarded.NeedRating != other.NeedRating {
+		return false
+	}
+	if callStateDiscarded.NeedDebugInformation != other.NeedDebugInformation {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callStateDiscarded *CallStateDiscarded) Diff(other *CallStateDiscarded) []Change {
+	return callStateDiscarded.diff("", other, nil)
+}
+
+func (callStateDiscarded *CallStateDiscarded) diff(path string, other *CallStateDiscarded, changes []Change) []Change {
+	if callStateDiscarded == nil || other == nil {
+		if callStateDiscarded != other {
+			changes = append(changes, Change{Path: path, Old: callStateDiscarded, New: other})
+		}
+		return changes
+	}
+	changes = diffCallDiscardReason(fieldPath(path, "reason"), callStateDiscarded.Reason, other.Reason, changes)
+	if callStateDiscarded.NeedRating != other.NeedRating {
+		changes = append(changes, Change{Path: fieldPath(path, "need_rating"), Old: callStateDiscarded.NeedRating, New: other.NeedRating})
+	}
+	if callStateDiscarded.NeedDebugInformation != other.NeedDebugInformation {
+		changes = append(changes, Change{Path: fieldPath(path, "need_debug_information"), Old: callStateDiscarded.NeedDebugInformation, New: other.NeedDebugInformation})
 	}
+	return changes
+}
 
-	callStateDiscarded.tdCommon = tempObj.tdCommon
-	callStateDiscarded.NeedRating = tempObj.NeedRating
-	callStateDiscarded.NeedDebugInformation = tempObj.NeedDebugInformation
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callStateDiscarded *CallStateDiscarded) String() string {
+	return objectString(callStateDiscarded)
+}
 
-	fieldReason, _ := unmarshalCallDiscardReason(objMap["reason"])
-	callStateDiscarded.Reason = fieldReason
+// LogValue logs the object as its summary
+func (callStateDiscarded *CallStateDiscarded) LogValue() slog.Value {
+	return slog.StringValue(objectString(callStateDiscarded))
+}
 
-	return nil
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callStateDiscarded *CallStateDiscarded) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callStateDiscarded)
+}
+
+func (callStateDiscarded *CallStateDiscarded) printText(p *printer) {
+	if callStateDiscarded == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallStateDiscarded")
+	p.object("reason", callStateDiscarded.Reason)
+	p.bool("need_rating", callStateDiscarded.NeedRating)
+	p.bool("need_debug_information", callStateDiscarded.NeedDebugInformation)
+	p.endObject(state)
 }
 
 // GetCallStateEnum return the enum type of this object
@@ -255,6 +968,11 @@ func (callStateDiscarded *CallStateDiscarded) GetCallStateEnum() CallStateEnum {
 	return CallStateDiscardedType
 }
 
+// Accept calls the method of the visitor for CallStateDiscarded
+func (callStateDiscarded *CallStateDiscarded) Accept(visitor CallStateVisitor) {
+	visitor.VisitCallStateDiscarded(callStateDiscarded)
+}
+
 // CallStateError The call has ended with an error
 type CallStateError struct {
 	tdCommon
@@ -278,7 +996,277 @@ func NewCallStateError(error *Error) *CallStateError {
 	return &callStateErrorTemp
 }
 
+// MarshalJSON marshals to json
+func (callStateError *CallStateError) MarshalJSON() ([]byte, error) {
+	return callStateError.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (callStateError *CallStateError) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, callStateError)
+}
+
+func (callStateError *CallStateError) appendJSON(b []byte) ([]byte, error) {
+	if callStateError == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callStateError.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callStateError.tdCommon.Extra)
+	b = append(b, `,"error":`...)
+	b, err = callStateError.Error.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (callStateError *CallStateError) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callStateError.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callStateError.tdCommon.Extra, err = r.readString()
+		case "error":
+			if r.readNull() {
+				callStateError.Error = nil
+			} else {
+				callStateError.Error = new(Error)
+				err = callStateError.Error.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callStateError *CallStateError) Clone() *CallStateError {
+	if callStateError == nil {
+		return nil
+	}
+	clone := *callStateError
+	clone.Error = callStateError.Error.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callStateError *CallStateError) Equal(other *CallStateError) bool {
+	if callStateError == nil || other == nil {
+		return callStateError == other
+	}
+	if !callStateError.Error.Equal(other.Error) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callStateError *CallStateError) Diff(other *CallStateError) []Change {
+	return callStateError.diff("", other, nil)
+}
+
+func (callStateError *CallStateError) diff(path string, other *CallStateError, changes []Change) []Change {
+	if callStateError == nil || other == nil {
+		if callStateError != other {
+			changes = append(changes, Change{Path: path, Old: callStateError, New: other})
+		}
+		return changes
+	}
+	changes = callStateError.Error.diff(fieldPath(path, "error"), other.Error, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callStateError *CallStateError) String() string {
+	return objectString(callStateError)
+}
+
+// LogValue logs the object as its summary
+func (callStateError *CallStateError) LogValue() slog.Value {
+	return slog.StringValue(objectString(callStateError))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callStateError *CallStateError) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callStateError)
+}
+
+func (callStateError *CallStateError) printText(p *printer) {
+	if callStateError == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallStateError")
+	p.object("error", callStateError.Error)
+	p.endObject(state)
+}
+
 // GetCallStateEnum return the enum type of this object
 func (callStateError *CallStateError) GetCallStateEnum() CallStateEnum {
 	return CallStateErrorType
 }
+
+// Accept calls the method of the visitor for CallStateError
+func (callStateError *CallStateError) Accept(visitor CallStateVisitor) {
+	visitor.VisitCallStateError(callStateError)
+}
+
+// CallStateVisitor visits every type of CallState, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to CallState, until they handle it too.
+type CallStateVisitor interface {
+	VisitCallStatePending(callStatePending *CallStatePending)
+	VisitCallStateExchangingKeys(callStateExchangingKeys *CallStateExchangingKeys)
+	VisitCallStateReady(callStateReady *CallStateReady)
+	VisitCallStateHangingUp(callStateHangingUp *CallStateHangingUp)
+	VisitCallStateDiscarded(callStateDiscarded *CallStateDiscarded)
+	VisitCallStateError(callStateError *CallStateError)
+	VisitUnknownCallState(unknownCallState *UnknownCallState)
+}
+
+// CallStateCases holds the function MatchCallState calls for each type of CallState;
+// types without a function are passed to the default case
+type CallStateCases[R any] struct {
+	CallStatePending        func(callStatePending *CallStatePending) R
+	CallStateExchangingKeys func(callStateExchangingKeys *CallStateExchangingKeys) R
+	CallStateReady          func(callStateReady *CallStateReady) R
+	CallStateHangingUp      func(callStateHangingUp *CallStateHangingUp) R
+	CallStateDiscarded      func(callStateDiscarded *CallStateDiscarded) R
+	CallStateError          func(callStateError *CallStateError) R
+}
+
+// MatchCallState calls the case of the type of callState, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement CallStateVisitor
+// to have the compiler check every type is handled.
+func MatchCallState[R any](callState CallState, cases CallStateCases[R], defaultCase func(callState CallState) R) R {
+	switch value := callState.(type) {
+	case *CallStatePending:
+		if cases.CallStatePending != nil {
+			return cases.CallStatePending(value)
+		}
+	case *CallStateExchangingKeys:
+		if cases.CallStateExchangingKeys != nil {
+			return cases.CallStateExchangingKeys(value)
+		}
+	case *CallStateReady:
+		if cases.CallStateReady != nil {
+			return cases.CallStateReady(value)
+		}
+	case *CallStateHangingUp:
+		if cases.CallStateHangingUp != nil {
+			return cases.CallStateHangingUp(value)
+		}
+	case *CallStateDiscarded:
+		if cases.CallStateDiscarded != nil {
+			return cases.CallStateDiscarded(value)
+		}
+	case *CallStateError:
+		if cases.CallStateError != nil {
+			return cases.CallStateError(value)
+		}
+	}
+	return defaultCase(callState)
+}
+
+// Clone returns a deep copy of the object
+func (unknownCallState *UnknownCallState) Clone() *UnknownCallState {
+	if unknownCallState == nil {
+		return nil
+	}
+	return &UnknownCallState{Unknown: unknownCallState.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownCallState *UnknownCallState) Equal(other *UnknownCallState) bool {
+	if unknownCallState == nil || other == nil {
+		return unknownCallState == other
+	}
+	return unknownCallState.Unknown.equal(other.Unknown)
+}
+
+func cloneCallState(callState CallState) CallState {
+	switch value := callState.(type) {
+	case *CallStatePending:
+		return value.Clone()
+	case *CallStateExchangingKeys:
+		return value.Clone()
+	case *CallStateReady:
+		return value.Clone()
+	case *CallStateHangingUp:
+		return value.Clone()
+	case *CallStateDiscarded:
+		return value.Clone()
+	case *CallStateError:
+		return value.Clone()
+	case *UnknownCallState:
+		return value.Clone()
+	}
+	return callState
+}
+
+func equalCallState(a CallState, b CallState) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *CallStatePending:
+		b, ok := b.(*CallStatePending)
+		return ok && a.Equal(b)
+	case *CallStateExchangingKeys:
+		b, ok := b.(*CallStateExchangingKeys)
+		return ok && a.Equal(b)
+	case *CallStateReady:
+		b, ok := b.(*CallStateReady)
+		return ok && a.Equal(b)
+	case *CallStateHangingUp:
+		b, ok := b.(*CallStateHangingUp)
+		return ok && a.Equal(b)
+	case *CallStateDiscarded:
+		b, ok := b.(*CallStateDiscarded)
+		return ok && a.Equal(b)
+	case *CallStateError:
+		b, ok := b.(*CallStateError)
+		return ok && a.Equal(b)
+	case *UnknownCallState:
+		b, ok := b.(*UnknownCallState)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffCallState(path string, a CallState, b CallState, changes []Change) []Change {
+	switch a := a.(type) {
+	case *CallStatePending:
+		if b, ok := b.(*CallStatePending); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CallStateExchangingKeys:
+		if b, ok := b.(*CallStateExchangingKeys); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CallStateReady:
+		if b, ok := b.(*CallStateReady); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CallStateHangingUp:
+		if b, ok := b.(*CallStateHangingUp); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CallStateDiscarded:
+		if b, ok := b.(*CallStateDiscarded); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CallStateError:
+		if b, ok := b.(*CallStateError); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalCallState(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -0,0 +1,403 @@
+package tdlib
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// callbackValue marks callback data holding the encoded value
+	callbackValue = 'v'
+	// callbackStored marks callback data holding the key of the encoded value in a CallbackStore
+	callbackStored = 'k'
+	// callbackTagLength is the number of bytes of the HMAC-SHA256 appended to signed callback data
+	callbackTagLength = 8
+	// callbackKeyLength is the number of random bytes of a MemoryCallbackStore key
+	callbackKeyLength = 8
+)
+
+var (
+	// ErrInvalidCallbackData is returned when decoding callback data which is malformed, has a wrong signature
+	// or was encoded for another type
+	ErrInvalidCallbackData = errors.New("invalid callback data")
+	// ErrCallbackDataExpired is returned when decoding callback data whose value is no longer in the CallbackStore
+	ErrCallbackDataExpired = errors.New("callback data expired")
+)
+
+// CallbackCodec encodes Go values as the data of callback buttons, which is at most 64 bytes long.
+// Data starts with a prefix and a colon, e.g. vote:, followed by the exported fields of the value in a compact
+// binary form, without their names. Data is signed with HMAC-SHA256 if the codec has a key, so that forged data
+// is rejected. Values too long for a button are saved in the store of the codec, if it has one, and the data
+// holds their key.
+//
+// Fields may be booleans, integers, floats, strings, byte slices, and pointers, slices and structs of them.
+// Fields tagged `callback:"-"` are skipped. Adding, removing or reordering fields invalidates data of
+// buttons sent before.
+type CallbackCodec struct {
+	key   []byte
+	store CallbackStore
+}
+
+// NewCallbackCodec creates a callback codec which signs data with key, unless it's empty,
+// and saves values too long for a button in store, unless it's nil
+func NewCallbackCodec(key []byte, store CallbackStore) *CallbackCodec {
+	return &CallbackCodec{key: key, store: store}
+}
+
+// CallbackStore saves values too long for the data of a callback button, see CallbackCodec
+type CallbackStore interface {
+	// Save saves data and returns its key, which must be short, e.g. 8 bytes
+	Save(data []byte) (key string, err error)
+	// Load returns the data saved with a key, or ErrCallbackDataExpired
+	Load(key string) ([]byte, error)
+}
+
+// Encode returns the callback data of a value, a struct or a pointer to one, or of no value if it's nil
+func (codec *CallbackCodec) Encode(prefix string, value interface{}) ([]byte, error) {
+	if strings.Contains(prefix, ":") {
+		return nil, fmt.Errorf("callback prefix %q contains a colon", prefix)
+	}
+
+	var payload []byte
+	encoded := reflect.ValueOf(value)
+	for encoded.Kind() == reflect.Pointer && !encoded.IsNil() {
+		encoded = encoded.Elem()
+	}
+	if encoded.IsValid() && encoded.Kind() != reflect.Pointer {
+		var err error
+		payload, err = appendCallbackValue(nil, encoded)
+		if err != nil {
+			return nil, fmt.Errorf("can't encode %T: %v", value, err)
+		}
+	}
+
+	data := codec.sign(callbackData(prefix, callbackValue, payload))
+	if len(data) <= DefaultLimits.CallbackDataLength {
+		return data, nil
+	}
+	if codec.store == nil {
+		return nil, fmt.Errorf("callback data of %T is %d bytes long, at most %d are allowed without a CallbackStore", value, len(data), DefaultLimits.CallbackDataLength)
+	}
+
+	key, err := codec.store.Save(payload)
+	if err != nil {
+		return nil, fmt.Errorf("can't save callback data: %v", err)
+	}
+	data = codec.sign(callbackData(prefix, callbackStored, []byte(key)))
+	if len(data) > DefaultLimits.CallbackDataLength {
+		return nil, fmt.Errorf("callback data with a stored key is %d bytes long, at most %d are allowed", len(data), DefaultLimits.CallbackDataLength)
+	}
+	return data, nil
+}
+
+// Decode decodes callback data into a value, a pointer to a struct, unless it's nil, and returns its prefix
+func (codec *CallbackCodec) Decode(data []byte, value interface{}) (string, error) {
+	prefix, kind, payload, err := codec.split(data)
+	if err != nil {
+		return "", err
+	}
+	if kind == callbackStored {
+		if codec.store == nil {
+			return prefix, fmt.Errorf("%w: stored callback data without a CallbackStore", ErrInvalidCallbackData)
+		}
+		payload, err = codec.store.Load(string(payload))
+		if err != nil {
+			return prefix, err
+		}
+	}
+	if value == nil {
+		return prefix, nil
+	}
+
+	target := reflect.ValueOf(value)
+	if target.Kind() != reflect.Pointer || target.IsNil() {
+		return prefix, fmt.Errorf("can't decode callback data into %T, a non-nil pointer is required", value)
+	}
+	rest, err := readCallbackValue(payload, target.Elem())
+	if err == nil && len(rest) != 0 {
+		err = fmt.Errorf("%d bytes left over", len(rest))
+	}
+	if err != nil {
+		return prefix, fmt.Errorf("%w: %s: %v", ErrInvalidCallbackData, prefix, err)
+	}
+	return prefix, nil
+}
+
+// Prefix returns the prefix of callback data, after checking its signature
+func (codec *CallbackCodec) Prefix(data []byte) (string, error) {
+	prefix, _, _, err := codec.split(data)
+	return prefix, err
+}
+
+// split checks the signature of callback data and splits it into its prefix, kind and payload
+func (codec *CallbackCodec) split(data []byte) (prefix string, kind byte, payload []byte, err error) {
+	if len(codec.key) != 0 {
+		if len(data) < callbackTagLength {
+			return "", 0, nil, fmt.Errorf("%w: no signature", ErrInvalidCallbackData)
+		}
+		tag := data[len(data)-callbackTagLength:]
+		data = data[:len(data)-callbackTagLength]
+		if !hmac.Equal(tag, codec.tag(data)) {
+			return "", 0, nil, fmt.Errorf("%w: wrong signature", ErrInvalidCallbackData)
+		}
+	}
+
+	colon := bytes.IndexByte(data, ':')
+	if colon < 0 || colon+1 == len(data) {
+		return "", 0, nil, fmt.Errorf("%w: %q has no prefix", ErrInvalidCallbackData, data)
+	}
+	prefix, kind, payload = string(data[:colon]), data[colon+1], data[colon+2:]
+	if kind != callbackValue && kind != callbackStored {
+		return prefix, 0, nil, fmt.Errorf("%w: %s: unknown kind %q", ErrInvalidCallbackData, prefix, kind)
+	}
+	return prefix, kind, payload, nil
+}
+
+func callbackData(prefix string, kind byte, payload []byte) []byte {
+	data := make([]byte, 0, len(prefix)+2+len(payload)+callbackTagLength)
+	data = append(data, prefix...)
+	data = append(data, ':', kind)
+	return append(data, payload...)
+}
+
+// sign appends the signature of data, if the codec has a key
+func (codec *CallbackCodec) sign(data []byte) []byte {
+	if len(codec.key) == 0 {
+		return data
+	}
+	return append(data, codec.tag(data)...)
+}
+
+func (codec *CallbackCodec) tag(data []byte) []byte {
+	mac := hmac.New(sha256.New, codec.key)
+	mac.Write(data)
+	return mac.Sum(nil)[:callbackTagLength]
+}
+
+// appendCallbackValue appends the compact binary form of a value
+func appendCallbackValue(b []byte, value reflect.Value) ([]byte, error) {
+	switch value.Kind() {
+	case reflect.Bool:
+		if value.Bool() {
+			return append(b, 1), nil
+		}
+		return append(b, 0), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return binary.AppendVarint(b, value.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return binary.AppendUvarint(b, value.Uint()), nil
+	case reflect.Float32:
+		return binary.LittleEndian.AppendUint32(b, math.Float32bits(float32(value.Float()))), nil
+	case reflect.Float64:
+		return binary.LittleEndian.AppendUint64(b, math.Float64bits(value.Float())), nil
+	case reflect.String:
+		b = binary.AppendUvarint(b, uint64(value.Len()))
+		return append(b, value.String()...), nil
+	case reflect.Slice:
+		b = binary.AppendUvarint(b, uint64(value.Len()))
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return append(b, value.Bytes()...), nil
+		}
+		fallthrough
+	case reflect.Array:
+		var err error
+		for i := 0; i < value.Len() && err == nil; i++ {
+			b, err = appendCallbackValue(b, value.Index(i))
+		}
+		return b, err
+	case reflect.Pointer:
+		if value.IsNil() {
+			return append(b, 0), nil
+		}
+		return appendCallbackValue(append(b, 1), value.Elem())
+	case reflect.Struct:
+		var err error
+		for _, i := range callbackFields(value.Type()) {
+			b, err = appendCallbackValue(b, value.Field(i))
+			if err != nil {
+				return b, fmt.Errorf("%s: %v", value.Type().Field(i).Name, err)
+			}
+		}
+		return b, nil
+	}
+	return b, fmt.Errorf("unsupported type %s", value.Type())
+}
+
+// readCallbackValue reads the compact binary form of a value and returns the bytes after it
+func readCallbackValue(b []byte, value reflect.Value) ([]byte, error) {
+	switch value.Kind() {
+	case reflect.Bool:
+		if len(b) == 0 || b[0] > 1 {
+			return b, errors.New("expected a boolean")
+		}
+		value.SetBool(b[0] == 1)
+		return b[1:], nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, n := binary.Varint(b)
+		if n <= 0 || value.OverflowInt(v) {
+			return b, fmt.Errorf("expected %s", value.Type())
+		}
+		value.SetInt(v)
+		return b[n:], nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v, n := binary.Uvarint(b)
+		if n <= 0 || value.OverflowUint(v) {
+			return b, fmt.Errorf("expected %s", value.Type())
+		}
+		value.SetUint(v)
+		return b[n:], nil
+	case reflect.Float32:
+		if len(b) < 4 {
+			return b, errors.New("expected float32")
+		}
+		value.SetFloat(float64(math.Float32frombits(binary.LittleEndian.Uint32(b))))
+		return b[4:], nil
+	case reflect.Float64:
+		if len(b) < 8 {
+			return b, errors.New("expected float64")
+		}
+		value.SetFloat(math.Float64frombits(binary.LittleEndian.Uint64(b)))
+		return b[8:], nil
+	case reflect.String:
+		s, rest, err := readCallbackBytes(b)
+		if err != nil {
+			return b, err
+		}
+		value.SetString(string(s))
+		return rest, nil
+	case reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			s, rest, err := readCallbackBytes(b)
+			if err != nil {
+				return b, err
+			}
+			value.SetBytes(append([]byte{}, s...))
+			return rest, nil
+		}
+		length, n := binary.Uvarint(b)
+		if n <= 0 || length > uint64(len(b)-n) {
+			return b, errors.New("expected a slice length")
+		}
+		b = b[n:]
+		value.Set(reflect.MakeSlice(value.Type(), int(length), int(length)))
+		fallthrough
+	case reflect.Array:
+		var err error
+		for i := 0; i < value.Len(); i++ {
+			b, err = readCallbackValue(b, value.Index(i))
+			if err != nil {
+				return b, fmt.Errorf("[%d]: %v", i, err)
+			}
+		}
+		return b, nil
+	case reflect.Pointer:
+		if len(b) == 0 || b[0] > 1 {
+			return b, errors.New("expected a pointer")
+		}
+		if b[0] == 0 {
+			value.SetZero()
+			return b[1:], nil
+		}
+		value.Set(reflect.New(value.Type().Elem()))
+		return readCallbackValue(b[1:], value.Elem())
+	case reflect.Struct:
+		var err error
+		for _, i := range callbackFields(value.Type()) {
+			b, err = readCallbackValue(b, value.Field(i))
+			if err != nil {
+				return b, fmt.Errorf("%s: %v", value.Type().Field(i).Name, err)
+			}
+		}
+		return b, nil
+	}
+	return b, fmt.Errorf("unsupported type %s", value.Type())
+}
+
+func readCallbackBytes(b []byte) ([]byte, []byte, error) {
+	length, n := binary.Uvarint(b)
+	if n <= 0 || length > uint64(len(b)-n) {
+		return nil, b, errors.New("expected a length")
+	}
+	end := n + int(length)
+	return b[n:end], b[end:], nil
+}
+
+// callbackFields returns the indexes of the encoded fields of a struct
+func callbackFields(structType reflect.Type) []int {
+	var fields []int
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if field.IsExported() && field.Tag.Get("callback") != "-" {
+			fields = append(fields, i)
+		}
+	}
+	return fields
+}
+
+// MemoryCallbackStore is a CallbackStore in memory, which forgets values after a time to live.
+// Buttons of values it forgot, e.g. because the bot restarted, fail with ErrCallbackDataExpired.
+type MemoryCallbackStore struct {
+	ttl       time.Duration
+	lock      sync.Mutex
+	values    map[string]memoryCallbackValue
+	lastSweep time.Time
+}
+
+type memoryCallbackValue struct {
+	data    []byte
+	expires time.Time
+}
+
+// NewMemoryCallbackStore creates a callback store in memory, which forgets values after ttl unless it's 0
+func NewMemoryCallbackStore(ttl time.Duration) *MemoryCallbackStore {
+	return &MemoryCallbackStore{ttl: ttl, values: make(map[string]memoryCallbackValue), lastSweep: time.Now()}
+}
+
+// Save saves data under a random 8 bytes key
+func (store *MemoryCallbackStore) Save(data []byte) (string, error) {
+	key := make([]byte, callbackKeyLength)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	now := time.Now()
+	value := memoryCallbackValue{data: append([]byte{}, data...)}
+	if store.ttl > 0 {
+		value.expires = now.Add(store.ttl)
+		if now.Sub(store.lastSweep) > store.ttl {
+			for key, value := range store.values {
+				if now.After(value.expires) {
+					delete(store.values, key)
+				}
+			}
+			store.lastSweep = now
+		}
+	}
+	store.values[string(key)] = value
+	return string(key), nil
+}
+
+// Load returns the data saved under a key, or ErrCallbackDataExpired
+func (store *MemoryCallbackStore) Load(key string) ([]byte, error) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	value, ok := store.values[key]
+	if !ok || (!value.expires.IsZero() && time.Now().After(value.expires)) {
+		return nil, ErrCallbackDataExpired
+	}
+	return value.data, nil
+}
@@ -0,0 +1,258 @@
+package tdlib
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// callbackKinds has fields of every kind a CallbackCodec encodes
+type callbackKinds struct {
+	Bool    bool
+	Int     int
+	Int8    int8
+	Int16   int16
+	Int32   int32
+	Int64   int64
+	Uint    uint
+	Uint8   uint8
+	Uint16  uint16
+	Uint32  uint32
+	Uint64  uint64
+	Float32 float32
+	Float64 float64
+	String  string
+	Bytes   []byte
+	Ints    []int32
+	Array   [2]string
+	Pointer *int64
+	Nil     *string
+	Struct  struct {
+		A bool
+		B []string
+	}
+	Skipped    string `callback:"-"`
+	unexported string
+}
+
+func TestCallbackCodecRoundTrip(t *testing.T) {
+	pointer := int64(-42)
+	value := callbackKinds{
+		Bool: true, Int: -1, Int8: math.MinInt8, Int16: math.MaxInt16, Int32: math.MinInt32, Int64: math.MaxInt64,
+		Uint: 1, Uint8: math.MaxUint8, Uint16: math.MaxUint16, Uint32: math.MaxUint32, Uint64: math.MaxUint64,
+		Float32: 1.5, Float64: -math.Pi, String: "é😀", Bytes: []byte{0, 0xff}, Ints: []int32{1, -2},
+		Array: [2]string{"a", ""}, Pointer: &pointer,
+	}
+	value.Struct.A, value.Struct.B = true, []string{"x"}
+	withSkipped := value
+	withSkipped.Skipped, withSkipped.unexported = "skipped", "unexported"
+
+	// the value is too long for a button, so it's stored
+	for _, codec := range []*CallbackCodec{
+		NewCallbackCodec(nil, NewMemoryCallbackStore(time.Minute)),
+		NewCallbackCodec([]byte("secret"), NewMemoryCallbackStore(time.Minute)),
+	} {
+		data, err := codec.Encode("kinds", &withSkipped)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded callbackKinds
+		prefix, err := codec.Decode(data, &decoded)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if prefix != "kinds" || !reflect.DeepEqual(decoded, value) {
+			t.Errorf("decoded %s %+v, want kinds %+v", prefix, decoded, value)
+		}
+	}
+}
+
+func TestCallbackCodecNoValue(t *testing.T) {
+	codec := NewCallbackCodec([]byte("secret"), nil)
+	data, err := codec.Encode("refresh", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prefix, err := codec.Decode(data, nil); err != nil || prefix != "refresh" {
+		t.Errorf("decoded prefix %q and error %v, want refresh", prefix, err)
+	}
+	if _, err := codec.Encode("a:b", nil); err == nil {
+		t.Error("encoded a prefix with a colon")
+	}
+}
+
+func TestCallbackCodecSignature(t *testing.T) {
+	type vote struct {
+		Option int
+	}
+	codec := NewCallbackCodec([]byte("secret"), nil)
+	data, err := codec.Encode("vote", vote{Option: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tamperedTag := append([]byte{}, data...)
+	tamperedTag[len(tamperedTag)-1] ^= 1
+	tamperedValue := append([]byte{}, data...)
+	tamperedValue[len("vote:v")] ^= 1
+	forgedPrefix := append([]byte("veto"), data[len("vote"):]...)
+	unsigned, err := NewCallbackCodec(nil, nil).Encode("vote", vote{Option: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := NewCallbackCodec([]byte("other"), nil).Encode("vote", vote{Option: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, data := range map[string][]byte{
+		"tampered tag":   tamperedTag,
+		"tampered value": tamperedValue,
+		"forged prefix":  forgedPrefix,
+		"unsigned":       unsigned,
+		"other key":      otherKey,
+		"too short":      data[:callbackTagLength-1],
+		"empty":          {},
+	} {
+		var decoded vote
+		if _, err := codec.Decode(data, &decoded); !errors.Is(err, ErrInvalidCallbackData) {
+			t.Errorf("%s: Decode returns %v, want ErrInvalidCallbackData", name, err)
+		}
+		if _, err := codec.Prefix(data); !errors.Is(err, ErrInvalidCallbackData) {
+			t.Errorf("%s: Prefix returns %v, want ErrInvalidCallbackData", name, err)
+		}
+	}
+}
+
+func TestCallbackCodecInvalidData(t *testing.T) {
+	type vote struct {
+		Option int
+		Poll   string
+	}
+	codec := NewCallbackCodec(nil, nil)
+	data, err := codec.Encode("vote", vote{Option: 1, Poll: "poll"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	documents := map[string][]byte{
+		"no prefix":    []byte("vote"),
+		"no kind":      []byte("vote:"),
+		"unknown kind": []byte("vote:x"),
+		"left over":    append(append([]byte{}, data...), 0),
+	}
+	// every truncation of the value
+	for i := len("vote:v"); i < len(data); i++ {
+		documents[string(data[:i])] = data[:i]
+	}
+	for name, data := range documents {
+		var decoded vote
+		if _, err := codec.Decode(data, &decoded); !errors.Is(err, ErrInvalidCallbackData) {
+			t.Errorf("%q: Decode returns %v, want ErrInvalidCallbackData", name, err)
+		}
+	}
+
+	// data of another type
+	var other struct{ Flag bool }
+	if _, err := codec.Decode(data, &other); !errors.Is(err, ErrInvalidCallbackData) {
+		t.Errorf("Decode into another type returns %v, want ErrInvalidCallbackData", err)
+	}
+	if _, err := codec.Decode(data, other); err == nil {
+		t.Error("Decode into a value which isn't a pointer returns no error")
+	}
+}
+
+func TestCallbackCodecStore(t *testing.T) {
+	type long struct {
+		Text string
+	}
+	value := long{Text: strings.Repeat("a", 100)}
+	store := NewMemoryCallbackStore(time.Minute)
+	codec := NewCallbackCodec([]byte("secret"), store)
+
+	data, err := codec.Encode("long", value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) > DefaultLimits.CallbackDataLength || !bytes.HasPrefix(data, []byte("long:k")) {
+		t.Errorf("data %q isn't a stored key", data)
+	}
+	var decoded long
+	if _, err := codec.Decode(data, &decoded); err != nil || decoded != value {
+		t.Errorf("decoded %+v with error %v, want %+v", decoded, err, value)
+	}
+
+	// short values aren't stored
+	short, err := codec.Encode("short", long{Text: "a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(short, []byte("short:v")) {
+		t.Errorf("short data %q isn't the value", short)
+	}
+
+	// values too long without a store, and stored data decoded without one
+	if _, err := NewCallbackCodec([]byte("secret"), nil).Encode("long", value); err == nil {
+		t.Error("encoded a long value without a store")
+	}
+	if _, err := NewCallbackCodec([]byte("secret"), nil).Decode(data, &decoded); !errors.Is(err, ErrInvalidCallbackData) {
+		t.Errorf("Decode of stored data without a store returns %v, want ErrInvalidCallbackData", err)
+	}
+
+	// values forgotten by the store, e.g. after a restart
+	restarted := NewCallbackCodec([]byte("secret"), NewMemoryCallbackStore(time.Minute))
+	if _, err := restarted.Decode(data, &decoded); !errors.Is(err, ErrCallbackDataExpired) {
+		t.Errorf("Decode of a forgotten key returns %v, want ErrCallbackDataExpired", err)
+	}
+}
+
+func TestMemoryCallbackStoreExpiry(t *testing.T) {
+	store := NewMemoryCallbackStore(20 * time.Millisecond)
+	key, err := store.Save([]byte("data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(key) != callbackKeyLength {
+		t.Errorf("key is %d bytes long, want %d", len(key), callbackKeyLength)
+	}
+	if data, err := store.Load(key); err != nil || string(data) != "data" {
+		t.Errorf("loaded %q with error %v, want data", data, err)
+	}
+
+	time.Sleep(30 * time.Millisecond)
+	if _, err := store.Load(key); !errors.Is(err, ErrCallbackDataExpired) {
+		t.Errorf("Load of an expired key returns %v, want ErrCallbackDataExpired", err)
+	}
+
+	// saving after the time to live evicts the expired values
+	if _, err := store.Save([]byte("other")); err != nil {
+		t.Fatal(err)
+	}
+	store.lock.Lock()
+	_, found := store.values[key]
+	store.lock.Unlock()
+	if found {
+		t.Error("expired value wasn't evicted")
+	}
+	if _, err := store.Load(key); !errors.Is(err, ErrCallbackDataExpired) {
+		t.Errorf("Load of an evicted key returns %v, want ErrCallbackDataExpired", err)
+	}
+
+	if _, err := store.Load("unknown"); !errors.Is(err, ErrCallbackDataExpired) {
+		t.Errorf("Load of an unknown key returns %v, want ErrCallbackDataExpired", err)
+	}
+
+	// values are kept forever without a time to live
+	forever := NewMemoryCallbackStore(0)
+	key, err = forever.Save([]byte("data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := forever.Load(key); err != nil {
+		t.Errorf("Load without a time to live returns %v", err)
+	}
+}
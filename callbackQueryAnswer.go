@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // CallbackQueryAnswer Contains a bot's answer to a callback query
@@ -34,6 +34,130 @@ func NewCallbackQueryAnswer(text string, showAlert bool, url string) *CallbackQu
 	return &callbackQueryAnswerTemp
 }
 
+// MarshalJSON marshals to json
+func (callbackQueryAnswer *CallbackQueryAnswer) MarshalJSON() ([]byte, error) {
+	return callbackQueryAnswer.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (callbackQueryAnswer *CallbackQueryAnswer) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, callbackQueryAnswer)
+}
+
+func (callbackQueryAnswer *CallbackQueryAnswer) appendJSON(b []byte) ([]byte, error) {
+	if callbackQueryAnswer == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callbackQueryAnswer.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callbackQueryAnswer.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b = appendJSONString(b, callbackQueryAnswer.Text)
+	b = append(b, `,"show_alert":`...)
+	b = appendJSONBool(b, callbackQueryAnswer.ShowAlert)
+	b = append(b, `,"url":`...)
+	b = appendJSONString(b, callbackQueryAnswer.Url)
+	return append(b, '}'), nil
+}
+
+func (callbackQueryAnswer *CallbackQueryAnswer) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callbackQueryAnswer.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callbackQueryAnswer.tdCommon.Extra, err = r.readString()
+		case "text":
+			callbackQueryAnswer.Text, err = r.readString()
+		case "show_alert":
+			callbackQueryAnswer.ShowAlert, err = r.readBool()
+		case "url":
+			callbackQueryAnswer.Url, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callbackQueryAnswer *CallbackQueryAnswer) Clone() *CallbackQueryAnswer {
+	if callbackQueryAnswer == nil {
+		return nil
+	}
+	clone := *callbackQueryAnswer
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callbackQueryAnswer *CallbackQueryAnswer) Equal(other *CallbackQueryAnswer) bool {
+	if callbackQueryAnswer == nil || other == nil {
+		return callbackQueryAnswer == other
+	}
+	if callbackQueryAnswer.Text != other.Text {
+		return false
+	}
+	if callbackQueryAnswer.ShowAlert != other.ShowAlert {
+		return false
+	}
+	if callbackQueryAnswer.Url != other.Url {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callbackQueryAnswer *CallbackQueryAnswer) Diff(other *CallbackQueryAnswer) []Change {
+	return callbackQueryAnswer.diff("", other, nil)
+}
+
+func (callbackQueryAnswer *CallbackQueryAnswer) diff(path string, other *CallbackQueryAnswer, changes []Change) []Change {
+	if callbackQueryAnswer == nil || other == nil {
+		if callbackQueryAnswer != other {
+			changes = append(changes, Change{Path: path, Old: callbackQueryAnswer, New: other})
+		}
+		return changes
+	}
+	if callbackQueryAnswer.Text != other.Text {
+		changes = append(changes, Change{Path: fieldPath(path, "text"), Old: callbackQueryAnswer.Text, New: other.Text})
+	}
+	if callbackQueryAnswer.ShowAlert != other.ShowAlert {
+		changes = append(changes, Change{Path: fieldPath(path, "show_alert"), Old: callbackQueryAnswer.ShowAlert, New: other.ShowAlert})
+	}
+	if callbackQueryAnswer.Url != other.Url {
+		changes = append(changes, Change{Path: fieldPath(path, "url"), Old: callbackQueryAnswer.Url, New: other.Url})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callbackQueryAnswer *CallbackQueryAnswer) String() string {
+	return objectString(callbackQueryAnswer)
+}
+
+// LogValue logs the object as its summary
+func (callbackQueryAnswer *CallbackQueryAnswer) LogValue() slog.Value {
+	return slog.StringValue(objectString(callbackQueryAnswer))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callbackQueryAnswer *CallbackQueryAnswer) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callbackQueryAnswer)
+}
+
+func (callbackQueryAnswer *CallbackQueryAnswer) printText(p *printer) {
+	if callbackQueryAnswer == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallbackQueryAnswer")
+	p.string("text", callbackQueryAnswer.Text)
+	p.bool("show_alert", callbackQueryAnswer.ShowAlert)
+	p.string("url", callbackQueryAnswer.Url)
+	p.endObject(state)
+}
+
 // GetCallbackQueryAnswer Sends a callback query to a bot and returns an answer. Returns an error with code 502 if the bot fails to answer the query before the query timeout expires
 // @param chatId Identifier of the chat with the message
 // @param messageId Identifier of the message from which the query originated
@@ -50,11 +174,11 @@ func (client *Client) GetCallbackQueryAnswer(chatId int64, messageId int64, payl
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var callbackQueryAnswer CallbackQueryAnswer
-	err = json.Unmarshal(result.Raw, &callbackQueryAnswer)
+	err = jsonUnmarshal(result.Raw, &callbackQueryAnswer)
 	return &callbackQueryAnswer, err
 }
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // CallbackQueryPayload Represents a payload of a callback query
 type CallbackQueryPayload interface {
 	GetCallbackQueryPayloadEnum() CallbackQueryPayloadEnum
+	Accept(visitor CallbackQueryPayloadVisitor)
 }
 
 // CallbackQueryPayloadEnum Alias for abstract CallbackQueryPayload 'Sub-Classes', used as constant-enum here
@@ -20,38 +22,89 @@ const (
 	CallbackQueryPayloadGameType             CallbackQueryPayloadEnum = "callbackQueryPayloadGame"
 )
 
+// UnknownCallbackQueryPayload is a CallbackQueryPayload of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownCallbackQueryPayload struct {
+	Unknown
+}
+
+// GetCallbackQueryPayloadEnum return the enum type of this object
+func (unknownCallbackQueryPayload *UnknownCallbackQueryPayload) GetCallbackQueryPayloadEnum() CallbackQueryPayloadEnum {
+	return CallbackQueryPayloadEnum(unknownCallbackQueryPayload.Type)
+}
+
+// Accept calls the method of the visitor for UnknownCallbackQueryPayload
+func (unknownCallbackQueryPayload *UnknownCallbackQueryPayload) Accept(visitor CallbackQueryPayloadVisitor) {
+	visitor.VisitUnknownCallbackQueryPayload(unknownCallbackQueryPayload)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownCallbackQueryPayload *UnknownCallbackQueryPayload) String() string {
+	return objectString(unknownCallbackQueryPayload)
+}
+
+// LogValue logs the object as its summary
+func (unknownCallbackQueryPayload *UnknownCallbackQueryPayload) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownCallbackQueryPayload))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownCallbackQueryPayload *UnknownCallbackQueryPayload) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownCallbackQueryPayload)
+}
+
+func (unknownCallbackQueryPayload *UnknownCallbackQueryPayload) printText(p *printer) {
+	if unknownCallbackQueryPayload == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownCallbackQueryPayload")
+	p.string("@type", unknownCallbackQueryPayload.Type)
+	p.endObject(state)
+}
+
 func unmarshalCallbackQueryPayload(rawMsg *json.RawMessage) (CallbackQueryPayload, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch CallbackQueryPayloadEnum(objMap["@type"].(string)) {
+	switch CallbackQueryPayloadEnum(typeName) {
 	case CallbackQueryPayloadDataType:
 		var callbackQueryPayloadData CallbackQueryPayloadData
-		err := json.Unmarshal(*rawMsg, &callbackQueryPayloadData)
+		err := jsonUnmarshal(*rawMsg, &callbackQueryPayloadData)
 		return &callbackQueryPayloadData, err
 
 	case CallbackQueryPayloadDataWithPasswordType:
 		var callbackQueryPayloadDataWithPassword CallbackQueryPayloadDataWithPassword
-		err := json.Unmarshal(*rawMsg, &callbackQueryPayloadDataWithPassword)
+		err := jsonUnmarshal(*rawMsg, &callbackQueryPayloadDataWithPassword)
 		return &callbackQueryPayloadDataWithPassword, err
 
 	case CallbackQueryPayloadGameType:
 		var callbackQueryPayloadGame CallbackQueryPayloadGame
-		err := json.Unmarshal(*rawMsg, &callbackQueryPayloadGame)
+		err := jsonUnmarshal(*rawMsg, &callbackQueryPayloadGame)
 		return &callbackQueryPayloadGame, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownCallbackQueryPayload{Unknown: unknown}, nil
 	}
 }
 
+func decodeCallbackQueryPayload(r *jsonReader) (CallbackQueryPayload, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalCallbackQueryPayload(rawMsg)
+}
+
 // CallbackQueryPayloadData The payload for a general callback button
 type CallbackQueryPayloadData struct {
 	tdCommon
@@ -75,11 +128,121 @@ func NewCallbackQueryPayloadData(data []byte) *CallbackQueryPayloadData {
 	return &callbackQueryPayloadDataTemp
 }
 
+// MarshalJSON marshals to json
+func (callbackQueryPayloadData *CallbackQueryPayloadData) MarshalJSON() ([]byte, error) {
+	return callbackQueryPayloadData.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (callbackQueryPayloadData *CallbackQueryPayloadData) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, callbackQueryPayloadData)
+}
+
+func (callbackQueryPayloadData *CallbackQueryPayloadData) appendJSON(b []byte) ([]byte, error) {
+	if callbackQueryPayloadData == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callbackQueryPayloadData.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callbackQueryPayloadData.tdCommon.Extra)
+	b = append(b, `,"data":`...)
+	b = appendJSONBytes(b, callbackQueryPayloadData.Data)
+	return append(b, '}'), nil
+}
+
+func (callbackQueryPayloadData *CallbackQueryPayloadData) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callbackQueryPayloadData.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callbackQueryPayloadData.tdCommon.Extra, err = r.readString()
+		case "data":
+			callbackQueryPayloadData.Data, err = r.readBytes()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callbackQueryPayloadData *CallbackQueryPayloadData) Clone() *CallbackQueryPayloadData {
+	if callbackQueryPayloadData == nil {
+		return nil
+	}
+	clone := *callbackQueryPayloadData
+	if callbackQueryPayloadData.Data != nil {
+		clone.Data = append([]byte{}, callbackQueryPayloadData.Data...)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callbackQueryPayloadData *CallbackQueryPayloadData) Equal(other *CallbackQueryPayloadData) bool {
+	if callbackQueryPayloadData == nil || other == nil {
+		return callbackQueryPayloadData == other
+	}
+	if !equalBytes(callbackQueryPayloadData.Data, other.Data) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callbackQueryPayloadData *CallbackQueryPayloadData) Diff(other *CallbackQueryPayloadData) []Change {
+	return callbackQueryPayloadData.diff("", other, nil)
+}
+
+func (callbackQueryPayloadData *CallbackQueryPayloadData) diff(path string, other *CallbackQueryPayloadData, changes []Change) []Change {
+	if callbackQueryPayloadData == nil || other == nil {
+		if callbackQueryPayloadData != other {
+			changes = append(changes, Change{Path: path, Old: callbackQueryPayloadData, New: other})
+		}
+		return changes
+	}
+	if !equalBytes(callbackQueryPayloadData.Data, other.Data) {
+		changes = append(changes, Change{Path: fieldPath(path, "data"), Old: callbackQueryPayloadData.Data, New: other.Data})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callbackQueryPayloadData *CallbackQueryPayloadData) String() string {
+	return objectString(callbackQueryPayloadData)
+}
+
+// LogValue logs the object as its summary
+func (callbackQueryPayloadData *CallbackQueryPayloadData) LogValue() slog.Value {
+	return slog.StringValue(objectString(callbackQueryPayloadData))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callbackQueryPayloadData *CallbackQueryPayloadData) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callbackQueryPayloadData)
+}
+
+func (callbackQueryPayloadData *CallbackQueryPayloadData) printText(p *printer) {
+	if callbackQueryPayloadData == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallbackQueryPayloadData")
+	p.bytes("data", callbackQueryPayloadData.Data)
+	p.endObject(state)
+}
+
 // GetCallbackQueryPayloadEnum return the enum type of this object
 func (callbackQueryPayloadData *CallbackQueryPayloadData) GetCallbackQueryPayloadEnum() CallbackQueryPayloadEnum {
 	return CallbackQueryPayloadDataType
 }
 
+// Accept calls the method of the visitor for CallbackQueryPayloadData
+func (callbackQueryPayloadData *CallbackQueryPayloadData) Accept(visitor CallbackQueryPayloadVisitor) {
+	visitor.VisitCallbackQueryPayloadData(callbackQueryPayloadData)
+}
+
 // CallbackQueryPayloadDataWithPassword The payload for a callback button requiring password
 type CallbackQueryPayloadDataWithPassword struct {
 	tdCommon
@@ -106,11 +269,132 @@ func NewCallbackQueryPayloadDataWithPassword(password string, data []byte) *Call
 	return &callbackQueryPayloadDataWithPasswordTemp
 }
 
+// MarshalJSON marshals to json
+func (callbackQueryPayloadDataWithPassword *CallbackQueryPayloadDataWithPassword) MarshalJSON() ([]byte, error) {
+	return callbackQueryPayloadDataWithPassword.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (callbackQueryPayloadDataWithPassword *CallbackQueryPayloadDataWithPassword) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, callbackQueryPayloadDataWithPassword)
+}
+
+func (callbackQueryPayloadDataWithPassword *CallbackQueryPayloadDataWithPassword) appendJSON(b []byte) ([]byte, error) {
+	if callbackQueryPayloadDataWithPassword == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callbackQueryPayloadDataWithPassword.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callbackQueryPayloadDataWithPassword.tdCommon.Extra)
+	b = append(b, `,"password":`...)
+	b = appendJSONString(b, callbackQueryPayloadDataWithPassword.Password)
+	b = append(b, `,"data":`...)
+	b = appendJSONBytes(b, callbackQueryPayloadDataWithPassword.Data)
+	return append(b, '}'), nil
+}
+
+func (callbackQueryPayloadDataWithPassword *CallbackQueryPayloadDataWithPassword) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callbackQueryPayloadDataWithPassword.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callbackQueryPayloadDataWithPassword.tdCommon.Extra, err = r.readString()
+		case "password":
+			callbackQueryPayloadDataWithPassword.Password, err = r.readString()
+		case "data":
+			callbackQueryPayloadDataWithPassword.Data, err = r.readBytes()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callbackQueryPayloadDataWithPassword *CallbackQueryPayloadDataWithPassword) Clone() *CallbackQueryPayloadDataWithPassword {
+	if callbackQueryPayloadDataWithPassword == nil {
+		return nil
+	}
+	clone := *callbackQueryPayloadDataWithPassword
+	if callbackQueryPayloadDataWithPassword.Data != nil {
+		clone.Data = append([]byte{}, callbackQueryPayloadDataWithPassword.Data...)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callbackQueryPayloadDataWithPassword *CallbackQueryPayloadDataWithPassword) Equal(other *CallbackQueryPayloadDataWithPassword) bool {
+	if callbackQueryPayloadDataWithPassword == nil || other == nil {
+		return callbackQueryPayloadDataWithPassword == other
+	}
+	if callbackQueryPayloadDataWithPassword.Password != other.Password {
+		return false
+	}
+	if !equalBytes(callbackQueryPayloadDataWithPassword.Data, other.Data) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callbackQueryPayloadDataWithPassword *CallbackQueryPayloadDataWithPassword) Diff(other *CallbackQueryPayloadDataWithPassword) []Change {
+	return callbackQueryPayloadDataWithPassword.diff("", other, nil)
+}
+
+func (callbackQueryPayloadDataWithPassword *CallbackQueryPayloadDataWithPassword) diff(path string, other *CallbackQueryPayloadDataWithPassword, changes []Change) []Change {
+	if callbackQueryPayloadDataWithPassword == nil || other == nil {
+		if callbackQueryPayloadDataWithPassword != other {
+			changes = append(changes, Change{Path: path, Old: callbackQueryPayloadDataWithPassword, New: other})
+		}
+		return changes
+	}
+	if callbackQueryPayloadDataWithPassword.Password != other.Password {
+		changes = append(changes, Change{Path: fieldPath(path, "password"), Old: callbackQueryPayloadDataWithPassword.Password, New: other.Password})
+	}
+	if !equalBytes(callbackQueryPayloadDataWithPassword.Data, other.Data) {
+		changes = append(changes, Change{Path: fieldPath(path, "data"), Old: callbackQueryPayloadDataWithPassword.Data, New: other.Data})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callbackQueryPayloadDataWithPassword *CallbackQueryPayloadDataWithPassword) String() string {
+	return objectString(callbackQueryPayloadDataWithPassword)
+}
+
+// LogValue logs the object as its summary
+func (callbackQueryPayloadDataWithPassword *CallbackQueryPayloadDataWithPassword) LogValue() slog.Value {
+	return slog.StringValue(objectString(callbackQueryPayloadDataWithPassword))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callbackQueryPayloadDataWithPassword *CallbackQueryPayloadDataWithPassword) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callbackQueryPayloadDataWithPassword)
+}
+
+func (callbackQueryPayloadDataWithPassword *CallbackQueryPayloadDataWithPassword) printText(p *printer) {
+	if callbackQueryPayloadDataWithPassword == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallbackQueryPayloadDataWithPassword")
+	p.string("password", callbackQueryPayloadDataWithPassword.Password)
+	p.bytes("data", callbackQueryPayloadDataWithPassword.Data)
+	p.endObject(state)
+}
+
 // GetCallbackQueryPayloadEnum return the enum type of this object
 func (callbackQueryPayloadDataWithPassword *CallbackQueryPayloadDataWithPassword) GetCallbackQueryPayloadEnum() CallbackQueryPayloadEnum {
 	return CallbackQueryPayloadDataWithPasswordType
 }
 
+// Accept calls the method of the visitor for CallbackQueryPayloadDataWithPassword
+func (callbackQueryPayloadDataWithPassword *CallbackQueryPayloadDataWithPassword) Accept(visitor CallbackQueryPayloadVisitor) {
+	visitor.VisitCallbackQueryPayloadDataWithPassword(callbackQueryPayloadDataWithPassword)
+}
+
 // CallbackQueryPayloadGame The payload for a game callback button
 type CallbackQueryPayloadGame struct {
 	tdCommon
@@ -134,7 +418,224 @@ func NewCallbackQueryPayloadGame(gameShortName string) *CallbackQueryPayloadGame
 	return &callbackQueryPayloadGameTemp
 }
 
+// MarshalJSON marshals to json
+func (callbackQueryPayloadGame *CallbackQueryPayloadGame) MarshalJSON() ([]byte, error) {
+	return callbackQueryPayloadGame.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (callbackQueryPayloadGame *CallbackQueryPayloadGame) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, callbackQueryPayloadGame)
+}
+
+func (callbackQueryPayloadGame *CallbackQueryPayloadGame) appendJSON(b []byte) ([]byte, error) {
+	if callbackQueryPayloadGame == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, callbackQueryPayloadGame.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, callbackQueryPayloadGame.tdCommon.Extra)
+	b = append(b, `,"game_short_name":`...)
+	b = appendJSONString(b, callbackQueryPayloadGame.GameShortName)
+	return append(b, '}'), nil
+}
+
+func (callbackQueryPayloadGame *CallbackQueryPayloadGame) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			callbackQueryPayloadGame.tdCommon.Type, err = r.readString()
+		case "@extra":
+			callbackQueryPayloadGame.tdCommon.Extra, err = r.readString()
+		case "game_short_name":
+			callbackQueryPayloadGame.GameShortName, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (callbackQueryPayloadGame *CallbackQueryPayloadGame) Clone() *CallbackQueryPayloadGame {
+	if callbackQueryPayloadGame == nil {
+		return nil
+	}
+	clone := *callbackQueryPayloadGame
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (callbackQueryPayloadGame *CallbackQueryPayloadGame) Equal(other *CallbackQueryPayloadGame) bool {
+	if callbackQueryPayloadGame == nil || other == nil {
+		return callbackQueryPayloadGame == other
+	}
+	if callbackQueryPayloadGame.GameShortName != other.GameShortName {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (callbackQueryPayloadGame *CallbackQueryPayloadGame) Diff(other *CallbackQueryPayloadGame) []Change {
+	return callbackQueryPayloadGame.diff("", other, nil)
+}
+
+func (callbackQueryPayloadGame *CallbackQueryPayloadGame) diff(path string, other *CallbackQueryPayloadGame, changes []Change) []Change {
+	if callbackQueryPayloadGame == nil || other == nil {
+		if callbackQueryPayloadGame != other {
+			changes = append(changes, Change{Path: path, Old: callbackQueryPayloadGame, New: other})
+		}
+		return changes
+	}
+	if callbackQueryPayloadGame.GameShortName != other.GameShortName {
+		changes = append(changes, Change{Path: fieldPath(path, "game_short_name"), Old: callbackQueryPayloadGame.GameShortName, New: other.GameShortName})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (callbackQueryPayloadGame *CallbackQueryPayloadGame) String() string {
+	return objectString(callbackQueryPayloadGame)
+}
+
+// LogValue logs the object as its summary
+func (callbackQueryPayloadGame *CallbackQueryPayloadGame) LogValue() slog.Value {
+	return slog.StringValue(objectString(callbackQueryPayloadGame))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (callbackQueryPayloadGame *CallbackQueryPayloadGame) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, callbackQueryPayloadGame)
+}
+
+func (callbackQueryPayloadGame *CallbackQueryPayloadGame) printText(p *printer) {
+	if callbackQueryPayloadGame == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CallbackQueryPayloadGame")
+	p.string("game_short_name", callbackQueryPayloadGame.GameShortName)
+	p.endObject(state)
+}
+
 // GetCallbackQueryPayloadEnum return the enum type of this object
 func (callbackQueryPayloadGame *CallbackQueryPayloadGame) GetCallbackQueryPayloadEnum() CallbackQueryPayloadEnum {
 	return CallbackQueryPayloadGameType
 }
+
+// Accept calls the method of the visitor for CallbackQueryPayloadGame
+func (callbackQueryPayloadGame *CallbackQueryPayloadGame) Accept(visitor CallbackQueryPayloadVisitor) {
+	visitor.VisitCallbackQueryPayloadGame(callbackQueryPayloadGame)
+}
+
+// CallbackQueryPayloadVisitor visits every type of CallbackQueryPayload, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to CallbackQueryPayload, until they handle it too.
+type CallbackQueryPayloadVisitor interface {
+	VisitCallbackQueryPayloadData(callbackQueryPayloadData *CallbackQueryPayloadData)
+	VisitCallbackQueryPayloadDataWithPassword(callbackQueryPayloadDataWithPassword *CallbackQueryPayloadDataWithPassword)
+	VisitCallbackQueryPayloadGame(callbackQueryPayloadGame *CallbackQueryPayloadGame)
+	VisitUnknownCallbackQueryPayload(unknownCallbackQueryPayload *UnknownCallbackQueryPayload)
+}
+
+// CallbackQueryPayloadCases holds the function MatchCallbackQueryPayload calls for each type of CallbackQueryPayload;
+// types without a function are passed to the default case
+type CallbackQueryPayloadCases[R any] struct {
+	CallbackQueryPayloadData             func(callbackQueryPayloadData *CallbackQueryPayloadData) R
+	CallbackQueryPayloadDataWithPassword func(callbackQueryPayloadDataWithPassword *CallbackQueryPayloadDataWithPassword) R
+	CallbackQueryPayloadGame             func(callbackQueryPayloadGame *CallbackQueryPayloadGame) R
+}
+
+// MatchCallbackQueryPayload calls the case of the type of callbackQueryPayload, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement CallbackQueryPayloadVisitor
+// to have the compiler check every type is handled.
+func MatchCallbackQueryPayload[R any](callbackQueryPayload CallbackQueryPayload, cases CallbackQueryPayloadCases[R], defaultCase func(callbackQueryPayload CallbackQueryPayload) R) R {
+	switch value := callbackQueryPayload.(type) {
+	case *CallbackQueryPayloadData:
+		if cases.CallbackQueryPayloadData != nil {
+			return cases.CallbackQueryPayloadData(value)
+		}
+	case *CallbackQueryPayloadDataWithPassword:
+		if cases.CallbackQueryPayloadDataWithPassword != nil {
+			return cases.CallbackQueryPayloadDataWithPassword(value)
+		}
+	case *CallbackQueryPayloadGame:
+		if cases.CallbackQueryPayloadGame != nil {
+			return cases.CallbackQueryPayloadGame(value)
+		}
+	}
+	return defaultCase(callbackQueryPayload)
+}
+
+// Clone returns a deep copy of the object
+func (unknownCallbackQueryPayload *UnknownCallbackQueryPayload) Clone() *UnknownCallbackQueryPayload {
+	if unknownCallbackQueryPayload == nil {
+		return nil
+	}
+	return &UnknownCallbackQueryPayload{Unknown: unknownCallbackQueryPayload.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownCallbackQueryPayload *UnknownCallbackQueryPayload) Equal(other *UnknownCallbackQueryPayload) bool {
+	if unknownCallbackQueryPayload == nil || other == nil {
+		return unknownCallbackQueryPayload == other
+	}
+	return unknownCallbackQueryPayload.Unknown.equal(other.Unknown)
+}
+
+func cloneCallbackQueryPayload(callbackQueryPayload CallbackQueryPayload) CallbackQueryPayload {
+	switch value := callbackQueryPayload.(type) {
+	case *CallbackQueryPayloadData:
+		return value.Clone()
+	case *CallbackQueryPayloadDataWithPassword:
+		return value.Clone()
+	case *CallbackQueryPayloadGame:
+		return value.Clone()
+	case *UnknownCallbackQueryPayload:
+		return value.Clone()
+	}
+	return callbackQueryPayload
+}
+
+func equalCallbackQueryPayload(a CallbackQueryPayload, b CallbackQueryPayload) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *CallbackQueryPayloadData:
+		b, ok := b.(*CallbackQueryPayloadData)
+		return ok && a.Equal(b)
+	case *CallbackQueryPayloadDataWithPassword:
+		b, ok := b.(*CallbackQueryPayloadDataWithPassword)
+		return ok && a.Equal(b)
+	case *CallbackQueryPayloadGame:
+		b, ok := b.(*CallbackQueryPayloadGame)
+		return ok && a.Equal(b)
+	case *UnknownCallbackQueryPayload:
+		b, ok := b.(*UnknownCallbackQueryPayload)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffCallbackQueryPayload(path string, a CallbackQueryPayload, b CallbackQueryPayload, changes []Change) []Change {
+	switch a := a.(type) {
+	case *CallbackQueryPayloadData:
+		if b, ok := b.(*CallbackQueryPayloadData); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CallbackQueryPayloadDataWithPassword:
+		if b, ok := b.(*CallbackQueryPayloadDataWithPassword); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CallbackQueryPayloadGame:
+		if b, ok := b.(*CallbackQueryPayloadGame); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalCallbackQueryPayload(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
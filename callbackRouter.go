@@ -0,0 +1,356 @@
+package tdlib
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultCallbackTimeout is the time a callback handler has to answer its query before the router answers it,
+// see CallbackRouter.SetTimeout
+const DefaultCallbackTimeout = 10 * time.Second
+
+// ErrCallbackAnswered is returned when answering a callback query which was already answered,
+// by the handler or by the router after the handler timed out
+var ErrCallbackAnswered = errors.New("callback query already answered")
+
+// CallbackHandler handles a callback query. Queries the handler doesn't answer are answered with no text
+// when it returns.
+type CallbackHandler func(query *CallbackQuery) error
+
+// CallbackQuery is a callback query from updateNewCallbackQuery or updateNewInlineCallbackQuery,
+// passed to a CallbackHandler
+type CallbackQuery struct {
+	ID              JSONInt64 // Unique query identifier
+	SenderUserID    int64     // Identifier of the user who pressed the button
+	ChatID          int64     // Identifier of the chat of the message; 0 for inline messages
+	MessageID       int64     // Identifier of the message; 0 for inline messages
+	InlineMessageID string    // Identifier of the inline message; empty for other messages
+	ChatInstance    JSONInt64 // Identifier uniquely corresponding to the chat the message was sent to
+	Data            []byte    // Data of the button, if it's a callback button
+	Password        string    // Password of the user, if it's a callback button with password
+	GameShortName   string    // Short name of the game, if it's a game button
+	Prefix          string    // Prefix of the data, if it was encoded by the CallbackCodec of the router
+	Update          TdMessage // The *UpdateNewCallbackQuery or *UpdateNewInlineCallbackQuery
+
+	ctx      context.Context
+	router   *CallbackRouter
+	answered atomic.Bool
+}
+
+// Context returns the context of the query, which is done when the handler times out. Answering the query
+// and editing its message aren't canceled with it, see requestContext.
+func (query *CallbackQuery) Context() context.Context {
+	return query.ctx
+}
+
+// requestContext returns the context of the requests answering the query and editing its message. It keeps the
+// values of the query context, e.g. the tracing span, but isn't canceled when the handler times out: the router
+// answers the query after that, and a handler finishing late should still update the message the user sees.
+func (query *CallbackQuery) requestContext() context.Context {
+	return context.WithoutCancel(query.ctx)
+}
+
+// Client returns the client which received the query
+func (query *CallbackQuery) Client() *Client {
+	return query.router.client
+}
+
+// IsInline reports whether the query is from a message sent via the bot in inline mode
+func (query *CallbackQuery) IsInline() bool {
+	return query.InlineMessageID != ""
+}
+
+// Decode decodes the data of the query with the CallbackCodec of the router
+func (query *CallbackQuery) Decode(value interface{}) error {
+	_, err := query.router.codec.Decode(query.Data, value)
+	return err
+}
+
+// Answer answers the query with a notification, or an alert if showAlert is set, or opens url,
+// e.g. a t.me link to start the bot or launch a game. Queries can be answered once; a query whose answer
+// failed can be answered again, and is answered by the router otherwise.
+func (query *CallbackQuery) Answer(text string, showAlert bool, url string) error {
+	if !query.answered.CompareAndSwap(false, true) {
+		return ErrCallbackAnswered
+	}
+	_, err := query.Client().Invoke(query.requestContext(), "answerCallbackQuery", UpdateData{
+		"callback_query_id": query.ID,
+		"text":              text,
+		"show_alert":        showAlert,
+		"url":               url,
+		"cache_time":        0,
+	})
+	if err != nil {
+		query.answered.Store(false)
+	}
+	return err
+}
+
+// EditMessage replaces the text and the reply markup of the message of the query
+func (query *CallbackQuery) EditMessage(text *FormattedText, replyMarkup ReplyMarkup) error {
+	content := NewInputMessageText(text, false, false)
+	if query.IsInline() {
+		return query.edit("editInlineMessageText", UpdateData{"reply_markup": replyMarkup, "input_message_content": content})
+	}
+	return query.edit("editMessageText", UpdateData{"reply_markup": replyMarkup, "input_message_content": content})
+}
+
+// EditReplyMarkup replaces the reply markup of the message of the query, e.g. to update an inline keyboard
+func (query *CallbackQuery) EditReplyMarkup(replyMarkup ReplyMarkup) error {
+	if query.IsInline() {
+		return query.edit("editInlineMessageReplyMarkup", UpdateData{"reply_markup": replyMarkup})
+	}
+	return query.edit("editMessageReplyMarkup", UpdateData{"reply_markup": replyMarkup})
+}
+
+// edit sends an edit of the message of the query
+func (query *CallbackQuery) edit(method string, params UpdateData) error {
+	if query.IsInline() {
+		params["inline_message_id"] = query.InlineMessageID
+	} else {
+		params["chat_id"] = query.ChatID
+		params["message_id"] = query.MessageID
+	}
+	_, err := query.Client().Invoke(query.requestContext(), method, params)
+	return err
+}
+
+// callbackRoute returns the handler of a query if the route matches it
+type callbackRoute func(query *CallbackQuery) (CallbackHandler, error)
+
+// CallbackRouter passes callback queries to the handler of the first matching route, and answers the queries
+// handlers don't answer when they return or time out, so users don't see a spinning button
+//
+//	router := tdlib.NewCallbackRouter(client, codec)
+//	tdlib.HandleCallback(router, "vote", func(query *tdlib.CallbackQuery, vote Vote) error {
+//		return query.Answer("Thanks for voting", false, "")
+//	})
+//	go router.Run(ctx)
+type CallbackRouter struct {
+	client      *Client
+	codec       *CallbackCodec
+	lock        sync.RWMutex
+	routes      []callbackRoute
+	fallback    CallbackHandler
+	timeout     time.Duration
+	expiredText string
+}
+
+// NewCallbackRouter creates a callback router for the queries the client receives, which decodes typed data
+// with codec, unless it's nil
+func NewCallbackRouter(client *Client, codec *CallbackCodec) *CallbackRouter {
+	if codec == nil {
+		codec = NewCallbackCodec(nil, nil)
+	}
+	return &CallbackRouter{
+		client:      client,
+		codec:       codec,
+		timeout:     DefaultCallbackTimeout,
+		expiredText: "This button has expired",
+	}
+}
+
+// Codec returns the codec the router decodes typed data with, to encode the data of the buttons it handles
+func (router *CallbackRouter) Codec() *CallbackCodec {
+	return router.codec
+}
+
+// SetTimeout sets the time handlers have to answer their query before the router answers it
+func (router *CallbackRouter) SetTimeout(timeout time.Duration) {
+	router.lock.Lock()
+	defer router.lock.Unlock()
+	router.timeout = timeout
+}
+
+// SetExpiredText sets the notification answering queries whose data expired from the CallbackStore of the codec
+func (router *CallbackRouter) SetExpiredText(text string) {
+	router.lock.Lock()
+	defer router.lock.Unlock()
+	router.expiredText = text
+}
+
+func (router *CallbackRouter) addRoute(route callbackRoute) {
+	router.lock.Lock()
+	defer router.lock.Unlock()
+	router.routes = append(router.routes, route)
+}
+
+// Handle handles queries whose raw data starts with prefix, e.g. data set with InlineKeyboardBuilder.CallbackData
+func (router *CallbackRouter) Handle(prefix string, handler CallbackHandler) {
+	router.addRoute(func(query *CallbackQuery) (CallbackHandler, error) {
+		if query.Data == nil || !bytes.HasPrefix(query.Data, []byte(prefix)) {
+			return nil, nil
+		}
+		return handler, nil
+	})
+}
+
+// HandleGame handles queries from game buttons
+func (router *CallbackRouter) HandleGame(handler CallbackHandler) {
+	router.addRoute(func(query *CallbackQuery) (CallbackHandler, error) {
+		if query.GameShortName == "" {
+			return nil, nil
+		}
+		return handler, nil
+	})
+}
+
+// HandleDefault handles the queries no route matches, which are answered with no text otherwise
+func (router *CallbackRouter) HandleDefault(handler CallbackHandler) {
+	router.lock.Lock()
+	defer router.lock.Unlock()
+	router.fallback = handler
+}
+
+// HandleCallback handles queries whose data was encoded under prefix by the CallbackCodec of the router,
+// and passes their data decoded into a T to the handler
+func HandleCallback[T any](router *CallbackRouter, prefix string, handler func(query *CallbackQuery, value T) error) {
+	router.addRoute(func(query *CallbackQuery) (CallbackHandler, error) {
+		if query.Prefix != prefix {
+			return nil, nil
+		}
+		var value T
+		if err := query.Decode(&value); err != nil {
+			return nil, err
+		}
+		return func(query *CallbackQuery) error {
+			return handler(query, value)
+		}, nil
+	})
+}
+
+// Run passes the callback queries the client receives to the handlers until ctx is done
+func (router *CallbackRouter) Run(ctx context.Context) error {
+	queries := router.client.AddEventReceiver(&UpdateNewCallbackQuery{}, acceptUpdate, 100)
+	inlineQueries := router.client.AddEventReceiver(&UpdateNewInlineCallbackQuery{}, acceptUpdate, 100)
+	defer func() {
+		removed := make(chan struct{})
+		go func() {
+			router.client.RemoveEventReceiver(queries)
+			router.client.RemoveEventReceiver(inlineQueries)
+			close(removed)
+		}()
+		for {
+			select {
+			case <-queries.Chan:
+			case <-inlineQueries.Chan:
+			case <-removed:
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case update := <-queries.Chan:
+			go router.HandleUpdate(ctx, update)
+		case update := <-inlineQueries.Chan:
+			go router.HandleUpdate(ctx, update)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// acceptUpdate is the EventFilterFunc of receivers which get every update of their type
+func acceptUpdate(msg *TdMessage) bool {
+	return true
+}
+
+// HandleUpdate handles an updateNewCallbackQuery or updateNewInlineCallbackQuery, e.g. received by an own receiver
+// instead of Run, and returns when the handler returns or times out. Other updates are ignored.
+func (router *CallbackRouter) HandleUpdate(ctx context.Context, update TdMessage) {
+	query := &CallbackQuery{Update: update, router: router}
+	var payload CallbackQueryPayload
+	switch update := update.(type) {
+	case *UpdateNewCallbackQuery:
+		query.ID, query.SenderUserID, query.ChatID, query.MessageID = update.Id, update.SenderUserId, update.ChatId, update.MessageId
+		query.ChatInstance, payload = update.ChatInstance, update.Payload
+	case *UpdateNewInlineCallbackQuery:
+		query.ID, query.SenderUserID, query.InlineMessageID = update.Id, update.SenderUserId, update.InlineMessageId
+		query.ChatInstance, payload = update.ChatInstance, update.Payload
+	default:
+		return
+	}
+	switch payload := payload.(type) {
+	case *CallbackQueryPayloadData:
+		query.Data = payload.Data
+	case *CallbackQueryPayloadDataWithPassword:
+		query.Data, query.Password = payload.Data, payload.Password
+	case *CallbackQueryPayloadGame:
+		query.GameShortName = payload.GameShortName
+	}
+	if query.Data != nil {
+		query.Prefix, _ = router.codec.Prefix(query.Data)
+	}
+
+	router.lock.RLock()
+	timeout, expiredText := router.timeout, router.expiredText
+	router.lock.RUnlock()
+
+	var cancel context.CancelFunc
+	query.ctx, cancel = context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	handler, err := router.route(query)
+	if err != nil {
+		logger := router.client.Logger()
+		if errors.Is(err, ErrCallbackDataExpired) {
+			logger.Info("callback data expired", "prefix", query.Prefix, "sender_user_id", query.SenderUserID)
+			router.answer(query, expiredText)
+		} else {
+			logger.Warn("rejected callback data", "prefix", query.Prefix, "sender_user_id", query.SenderUserID, "error", err)
+			router.answer(query, "")
+		}
+		return
+	}
+
+	if handler != nil {
+		done := make(chan error, 1)
+		go func() {
+			defer func() {
+				if r := recover(); r != nil {
+					router.client.reportPanic(update.MessageType(), r)
+					done <- nil
+				}
+			}()
+			done <- handler(query)
+		}()
+
+		select {
+		case err := <-done:
+			if err != nil {
+				router.client.Logger().Error("callback handler failed", "prefix", query.Prefix, "sender_user_id", query.SenderUserID, "error", err)
+			}
+		case <-query.ctx.Done():
+			router.client.Logger().Warn("callback handler timed out", "prefix", query.Prefix, "sender_user_id", query.SenderUserID, "timeout", timeout)
+		}
+	}
+	router.answer(query, "")
+}
+
+// route returns the handler of the first route matching a query, or the default handler
+func (router *CallbackRouter) route(query *CallbackQuery) (CallbackHandler, error) {
+	router.lock.RLock()
+	defer router.lock.RUnlock()
+
+	for _, route := range router.routes {
+		handler, err := route(query)
+		if err != nil || handler != nil {
+			return handler, err
+		}
+	}
+	return router.fallback, nil
+}
+
+// answer answers a query the handler didn't answer
+func (router *CallbackRouter) answer(query *CallbackQuery, text string) {
+	err := query.Answer(text, false, "")
+	if err != nil && !errors.Is(err, ErrCallbackAnswered) {
+		router.client.Logger().Warn("failed to answer callback query", "sender_user_id", query.SenderUserID, "error", err)
+	}
+}
@@ -0,0 +1,65 @@
+package tdlib
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// callbackQueryUpdate returns an updateNewCallbackQuery with data
+func callbackQueryUpdate(data string) *UpdateNewCallbackQuery {
+	return &UpdateNewCallbackQuery{Id: 1, SenderUserId: 2, ChatId: 3, MessageId: 4, Payload: NewCallbackQueryPayloadData([]byte(data))}
+}
+
+func TestCallbackQueryAnswerFailed(t *testing.T) {
+	failures := 1
+	transport := newFakeTransport(func(request map[string]interface{}) []map[string]interface{} {
+		if request["@type"] == "answerCallbackQuery" && failures > 0 {
+			failures--
+			return []map[string]interface{}{{"@type": "error", "@extra": request["@extra"], "code": 400, "message": "QUERY_ID_INVALID"}}
+		}
+		return []map[string]interface{}{{"@type": "ok", "@extra": request["@extra"]}}
+	})
+	router := NewCallbackRouter(NewClientWithTransport(Config{}, transport), nil)
+	router.Handle("vote", func(query *CallbackQuery) error {
+		if err := query.Answer("Thanks", false, ""); err == nil {
+			t.Error("Answer returns no error")
+		}
+		return nil
+	})
+
+	router.HandleUpdate(context.Background(), callbackQueryUpdate("vote"))
+
+	// the query whose answer failed is answered by the router
+	answers := transport.sent("answerCallbackQuery")
+	if len(answers) != 2 || answers[0]["text"] != "Thanks" || answers[1]["text"] != "" {
+		t.Errorf("answers are %v, want the failed answer and the answer of the router", answers)
+	}
+}
+
+func TestCallbackQueryEditAfterTimeout(t *testing.T) {
+	transport := newFakeTransport(func(request map[string]interface{}) []map[string]interface{} {
+		if request["@type"] == "editMessageReplyMarkup" {
+			return []map[string]interface{}{{"@type": "message", "@extra": request["@extra"], "id": 4, "chat_id": 3}}
+		}
+		return []map[string]interface{}{{"@type": "ok", "@extra": request["@extra"]}}
+	})
+	router := NewCallbackRouter(NewClientWithTransport(Config{}, transport), nil)
+	router.SetTimeout(10 * time.Millisecond)
+	edited := make(chan error, 1)
+	router.Handle("slow", func(query *CallbackQuery) error {
+		<-query.Context().Done()
+		edited <- query.EditReplyMarkup(nil)
+		return nil
+	})
+
+	router.HandleUpdate(context.Background(), callbackQueryUpdate("slow"))
+
+	// the router answers the query when the handler times out, and the handler still edits the message
+	if answers := transport.sent("answerCallbackQuery"); len(answers) != 1 {
+		t.Errorf("%d answers were sent, want the answer of the router", len(answers))
+	}
+	if err := <-edited; err != nil {
+		t.Errorf("edit after the timeout failed: %v", err)
+	}
+}
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // CanTransferOwnershipResult Represents result of checking whether the current session can be used to transfer a chat ownership to another user
 type CanTransferOwnershipResult interface {
 	GetCanTransferOwnershipResultEnum() CanTransferOwnershipResultEnum
+	Accept(visitor CanTransferOwnershipResultVisitor)
 }
 
 // CanTransferOwnershipResultEnum Alias for abstract CanTransferOwnershipResult 'Sub-Classes', used as constant-enum here
@@ -21,40 +23,83 @@ const (
 	CanTransferOwnershipResultSessionTooFreshType  CanTransferOwnershipResultEnum = "canTransferOwnershipResultSessionTooFresh"
 )
 
+// UnknownCanTransferOwnershipResult is a CanTransferOwnershipResult of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownCanTransferOwnershipResult struct {
+	Unknown
+}
+
+// GetCanTransferOwnershipResultEnum return the enum type of this object
+func (unknownCanTransferOwnershipResult *UnknownCanTransferOwnershipResult) GetCanTransferOwnershipResultEnum() CanTransferOwnershipResultEnum {
+	return CanTransferOwnershipResultEnum(unknownCanTransferOwnershipResult.Type)
+}
+
+// Accept calls the method of the visitor for UnknownCanTransferOwnershipResult
+func (unknownCanTransferOwnershipResult *UnknownCanTransferOwnershipResult) Accept(visitor CanTransferOwnershipResultVisitor) {
+	visitor.VisitUnknownCanTransferOwnershipResult(unknownCanTransferOwnershipResult)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownCanTransferOwnershipResult *UnknownCanTransferOwnershipResult) String() string {
+	return objectString(unknownCanTransferOwnershipResult)
+}
+
+// LogValue logs the object as its summary
+func (unknownCanTransferOwnershipResult *UnknownCanTransferOwnershipResult) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownCanTransferOwnershipResult))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownCanTransferOwnershipResult *UnknownCanTransferOwnershipResult) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownCanTransferOwnershipResult)
+}
+
+func (unknownCanTransferOwnershipResult *UnknownCanTransferOwnershipResult) printText(p *printer) {
+	if unknownCanTransferOwnershipResult == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownCanTransferOwnershipResult")
+	p.string("@type", unknownCanTransferOwnershipResult.Type)
+	p.endObject(state)
+}
+
 func unmarshalCanTransferOwnershipResult(rawMsg *json.RawMessage) (CanTransferOwnershipResult, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch CanTransferOwnershipResultEnum(objMap["@type"].(string)) {
+	switch CanTransferOwnershipResultEnum(typeName) {
 	case CanTransferOwnershipResultOkType:
 		var canTransferOwnershipResultOk CanTransferOwnershipResultOk
-		err := json.Unmarshal(*rawMsg, &canTransferOwnershipResultOk)
+		err := jsonUnmarshal(*rawMsg, &canTransferOwnershipResultOk)
 		return &canTransferOwnershipResultOk, err
 
 	case CanTransferOwnershipResultPasswordNeededType:
 		var canTransferOwnershipResultPasswordNeeded CanTransferOwnershipResultPasswordNeeded
-		err := json.Unmarshal(*rawMsg, &canTransferOwnershipResultPasswordNeeded)
+		err := jsonUnmarshal(*rawMsg, &canTransferOwnershipResultPasswordNeeded)
 		return &canTransferOwnershipResultPasswordNeeded, err
 
 	case CanTransferOwnershipResultPasswordTooFreshType:
 		var canTransferOwnershipResultPasswordTooFresh CanTransferOwnershipResultPasswordTooFresh
-		err := json.Unmarshal(*rawMsg, &canTransferOwnershipResultPasswordTooFresh)
+		err := jsonUnmarshal(*rawMsg, &canTransferOwnershipResultPasswordTooFresh)
 		return &canTransferOwnershipResultPasswordTooFresh, err
 
 	case CanTransferOwnershipResultSessionTooFreshType:
 		var canTransferOwnershipResultSessionTooFresh CanTransferOwnershipResultSessionTooFresh
-		err := json.Unmarshal(*rawMsg, &canTransferOwnershipResultSessionTooFresh)
+		err := jsonUnmarshal(*rawMsg, &canTransferOwnershipResultSessionTooFresh)
 		return &canTransferOwnershipResultSessionTooFresh, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownCanTransferOwnershipResult{Unknown: unknown}, nil
 	}
 }
 
@@ -69,7 +114,6 @@ func (canTransferOwnershipResultOk *CanTransferOwnershipResultOk) MessageType()
 }
 
 // NewCanTransferOwnershipResultOk creates a new CanTransferOwnershipResultOk
-//
 func NewCanTransferOwnershipResultOk() *CanTransferOwnershipResultOk {
 	canTransferOwnershipResultOkTemp := CanTransferOwnershipResultOk{
 		tdCommon: tdCommon{Type: "canTransferOwnershipResultOk"},
@@ -78,11 +122,107 @@ func NewCanTransferOwnershipResultOk() *CanTransferOwnershipResultOk {
 	return &canTransferOwnershipResultOkTemp
 }
 
+// MarshalJSON marshals to json
+func (canTransferOwnershipResultOk *CanTransferOwnershipResultOk) MarshalJSON() ([]byte, error) {
+	return canTransferOwnershipResultOk.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (canTransferOwnershipResultOk *CanTransferOwnershipResultOk) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, canTransferOwnershipResultOk)
+}
+
+func (canTransferOwnershipResultOk *CanTransferOwnershipResultOk) appendJSON(b []byte) ([]byte, error) {
+	if canTransferOwnershipResultOk == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, canTransferOwnershipResultOk.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, canTransferOwnershipResultOk.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (canTransferOwnershipResultOk *CanTransferOwnershipResultOk) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			canTransferOwnershipResultOk.tdCommon.Type, err = r.readString()
+		case "@extra":
+			canTransferOwnershipResultOk.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (canTransferOwnershipResultOk *CanTransferOwnershipResultOk) Clone() *CanTransferOwnershipResultOk {
+	if canTransferOwnershipResultOk == nil {
+		return nil
+	}
+	clone := *canTransferOwnershipResultOk
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (canTransferOwnershipResultOk *CanTransferOwnershipResultOk) Equal(other *CanTransferOwnershipResultOk) bool {
+	if canTransferOwnershipResultOk == nil || other == nil {
+		return canTransferOwnershipResultOk == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (canTransferOwnershipResultOk *CanTransferOwnershipResultOk) Diff(other *CanTransferOwnershipResultOk) []Change {
+	return canTransferOwnershipResultOk.diff("", other, nil)
+}
+
+func (canTransferOwnershipResultOk *CanTransferOwnershipResultOk) diff(path string, other *CanTransferOwnershipResultOk, changes []Change) []Change {
+	if canTransferOwnershipResultOk == nil || other == nil {
+		if canTransferOwnershipResultOk != other {
+			changes = append(changes, Change{Path: path, Old: canTransferOwnershipResultOk, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (canTransferOwnershipResultOk *CanTransferOwnershipResultOk) String() string {
+	return objectString(canTransferOwnershipResultOk)
+}
+
+// LogValue logs the object as its summary
+func (canTransferOwnershipResultOk *CanTransferOwnershipResultOk) LogValue() slog.Value {
+	return slog.StringValue(objectString(canTransferOwnershipResultOk))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (canTransferOwnershipResultOk *CanTransferOwnershipResultOk) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, canTransferOwnershipResultOk)
+}
+
+func (canTransferOwnershipResultOk *CanTransferOwnershipResultOk) printText(p *printer) {
+	if canTransferOwnershipResultOk == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CanTransferOwnershipResultOk")
+	p.endObject(state)
+}
+
 // GetCanTransferOwnershipResultEnum return the enum type of this object
 func (canTransferOwnershipResultOk *CanTransferOwnershipResultOk) GetCanTransferOwnershipResultEnum() CanTransferOwnershipResultEnum {
 	return CanTransferOwnershipResultOkType
 }
 
+// Accept calls the method of the visitor for CanTransferOwnershipResultOk
+func (canTransferOwnershipResultOk *CanTransferOwnershipResultOk) Accept(visitor CanTransferOwnershipResultVisitor) {
+	visitor.VisitCanTransferOwnershipResultOk(canTransferOwnershipResultOk)
+}
+
 // CanTransferOwnershipResultPasswordNeeded The 2-step verification needs to be enabled first
 type CanTransferOwnershipResultPasswordNeeded struct {
 	tdCommon
@@ -94,7 +234,6 @@ func (canTransferOwnershipResultPasswordNeeded *CanTransferOwnershipResultPasswo
 }
 
 // NewCanTransferOwnershipResultPasswordNeeded creates a new CanTransferOwnershipResultPasswordNeeded
-//
 func NewCanTransferOwnershipResultPasswordNeeded() *CanTransferOwnershipResultPasswordNeeded {
 	canTransferOwnershipResultPasswordNeededTemp := CanTransferOwnershipResultPasswordNeeded{
 		tdCommon: tdCommon{Type: "canTransferOwnershipResultPasswordNeeded"},
@@ -103,11 +242,107 @@ func NewCanTransferOwnershipResultPasswordNeeded() *CanTransferOwnershipResultPa
 	return &canTransferOwnershipResultPasswordNeededTemp
 }
 
+// MarshalJSON marshals to json
+func (canTransferOwnershipResultPasswordNeeded *CanTransferOwnershipResultPasswordNeeded) MarshalJSON() ([]byte, error) {
+	return canTransferOwnershipResultPasswordNeeded.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (canTransferOwnershipResultPasswordNeeded *CanTransferOwnershipResultPasswordNeeded) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, canTransferOwnershipResultPasswordNeeded)
+}
+
+func (canTransferOwnershipResultPasswordNeeded *CanTransferOwnershipResultPasswordNeeded) appendJSON(b []byte) ([]byte, error) {
+	if canTransferOwnershipResultPasswordNeeded == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, canTransferOwnershipResultPasswordNeeded.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, canTransferOwnershipResultPasswordNeeded.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (canTransferOwnershipResultPasswordNeeded *CanTransferOwnershipResultPasswordNeeded) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			canTransferOwnershipResultPasswordNeeded.tdCommon.Type, err = r.readString()
+		case "@extra":
+			canTransferOwnershipResultPasswordNeeded.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (canTransferOwnershipResultPasswordNeeded *CanTransferOwnershipResultPasswordNeeded) Clone() *CanTransferOwnershipResultPasswordNeeded {
+	if canTransferOwnershipResultPasswordNeeded == nil {
+		return nil
+	}
+	clone := *canTransferOwnershipResultPasswordNeeded
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (canTransferOwnershipResultPasswordNeeded *CanTransferOwnershipResultPasswordNeeded) Equal(other *CanTransferOwnershipResultPasswordNeeded) bool {
+	if canTransferOwnershipResultPasswordNeeded == nil || other == nil {
+		return canTransferOwnershipResultPasswordNeeded == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (canTransferOwnershipResultPasswordNeeded *CanTransferOwnershipResultPasswordNeeded) Diff(other *CanTransferOwnershipResultPasswordNeeded) []Change {
+	return canTransferOwnershipResultPasswordNeeded.diff("", other, nil)
+}
+
+func (canTransferOwnershipResultPasswordNeeded *CanTransferOwnershipResultPasswordNeeded) diff(path string, other *CanTransferOwnershipResultPasswordNeeded, changes []Change) []Change {
+	if canTransferOwnershipResultPasswordNeeded == nil || other == nil {
+		if canTransferOwnershipResultPasswordNeeded != other {
+			changes = append(changes, Change{Path: path, Old: canTransferOwnershipResultPasswordNeeded, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (canTransferOwnershipResultPasswordNeeded *CanTransferOwnershipResultPasswordNeeded) String() string {
+	return objectString(canTransferOwnershipResultPasswordNeeded)
+}
+
+// LogValue logs the object as its summary
+func (canTransferOwnershipResultPasswordNeeded *CanTransferOwnershipResultPasswordNeeded) LogValue() slog.Value {
+	return slog.StringValue(objectString(canTransferOwnershipResultPasswordNeeded))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (canTransferOwnershipResultPasswordNeeded *CanTransferOwnershipResultPasswordNeeded) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, canTransferOwnershipResultPasswordNeeded)
+}
+
+func (canTransferOwnershipResultPasswordNeeded *CanTransferOwnershipResultPasswordNeeded) printText(p *printer) {
+	if canTransferOwnershipResultPasswordNeeded == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CanTransferOwnershipResultPasswordNeeded")
+	p.endObject(state)
+}
+
 // GetCanTransferOwnershipResultEnum return the enum type of this object
 func (canTransferOwnershipResultPasswordNeeded *CanTransferOwnershipResultPasswordNeeded) GetCanTransferOwnershipResultEnum() CanTransferOwnershipResultEnum {
 	return CanTransferOwnershipResultPasswordNeededType
 }
 
+// Accept calls the method of the visitor for CanTransferOwnershipResultPasswordNeeded
+func (canTransferOwnershipResultPasswordNeeded *CanTransferOwnershipResultPasswordNeeded) Accept(visitor CanTransferOwnershipResultVisitor) {
+	visitor.VisitCanTransferOwnershipResultPasswordNeeded(canTransferOwnershipResultPasswordNeeded)
+}
+
 // CanTransferOwnershipResultPasswordTooFresh The 2-step verification was enabled recently, user needs to wait
 type CanTransferOwnershipResultPasswordTooFresh struct {
 	tdCommon
@@ -131,11 +366,118 @@ func NewCanTransferOwnershipResultPasswordTooFresh(retryAfter int32) *CanTransfe
 	return &canTransferOwnershipResultPasswordTooFreshTemp
 }
 
+// MarshalJSON marshals to json
+func (canTransferOwnershipResultPasswordTooFresh *CanTransferOwnershipResultPasswordTooFresh) MarshalJSON() ([]byte, error) {
+	return canTransferOwnershipResultPasswordTooFresh.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (canTransferOwnershipResultPasswordTooFresh *CanTransferOwnershipResultPasswordTooFresh) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, canTransferOwnershipResultPasswordTooFresh)
+}
+
+func (canTransferOwnershipResultPasswordTooFresh *CanTransferOwnershipResultPasswordTooFresh) appendJSON(b []byte) ([]byte, error) {
+	if canTransferOwnershipResultPasswordTooFresh == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, canTransferOwnershipResultPasswordTooFresh.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, canTransferOwnershipResultPasswordTooFresh.tdCommon.Extra)
+	b = append(b, `,"retry_after":`...)
+	b = appendJSONInt(b, int64(canTransferOwnershipResultPasswordTooFresh.RetryAfter))
+	return append(b, '}'), nil
+}
+
+func (canTransferOwnershipResultPasswordTooFresh *CanTransferOwnershipResultPasswordTooFresh) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			canTransferOwnershipResultPasswordTooFresh.tdCommon.Type, err = r.readString()
+		case "@extra":
+			canTransferOwnershipResultPasswordTooFresh.tdCommon.Extra, err = r.readString()
+		case "retry_after":
+			canTransferOwnershipResultPasswordTooFresh.RetryAfter, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (canTransferOwnershipResultPasswordTooFresh *CanTransferOwnershipResultPasswordTooFresh) Clone() *CanTransferOwnershipResultPasswordTooFresh {
+	if canTransferOwnershipResultPasswordTooFresh == nil {
+		return nil
+	}
+	clone := *canTransferOwnershipResultPasswordTooFresh
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (canTransferOwnershipResultPasswordTooFresh *CanTransferOwnershipResultPasswordTooFresh) Equal(other *CanTransferOwnershipResultPasswordTooFresh) bool {
+	if canTransferOwnershipResultPasswordTooFresh == nil || other == nil {
+		return canTransferOwnershipResultPasswordTooFresh == other
+	}
+	if canTransferOwnershipResultPasswordTooFresh.RetryAfter != other.RetryAfter {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (canTransferOwnershipResultPasswordTooFresh *CanTransferOwnershipResultPasswordTooFresh) Diff(other *CanTransferOwnershipResultPasswordTooFresh) []Change {
+	return canTransferOwnershipResultPasswordTooFresh.diff("", other, nil)
+}
+
+func (canTransferOwnershipResultPasswordTooFresh *CanTransferOwnershipResultPasswordTooFresh) diff(path string, other *CanTransferOwnershipResultPasswordTooFresh, changes []Change) []Change {
+	if canTransferOwnershipResultPasswordTooFresh == nil || other == nil {
+		if canTransferOwnershipResultPasswordTooFresh != other {
+			changes = append(changes, Change{Path: path, Old: canTransferOwnershipResultPasswordTooFresh, New: other})
+		}
+		return changes
+	}
+	if canTransferOwnershipResultPasswordTooFresh.RetryAfter != other.RetryAfter {
+		changes = append(changes, Change{Path: fieldPath(path, "retry_after"), Old: canTransferOwnershipResultPasswordTooFresh.RetryAfter, New: other.RetryAfter})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (canTransferOwnershipResultPasswordTooFresh *CanTransferOwnershipResultPasswordTooFresh) String() string {
+	return objectString(canTransferOwnershipResultPasswordTooFresh)
+}
+
+// LogValue logs the object as its summary
+func (canTransferOwnershipResultPasswordTooFresh *CanTransferOwnershipResultPasswordTooFresh) LogValue() slog.Value {
+	return slog.StringValue(objectString(canTransferOwnershipResultPasswordTooFresh))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (canTransferOwnershipResultPasswordTooFresh *CanTransferOwnershipResultPasswordTooFresh) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, canTransferOwnershipResultPasswordTooFresh)
+}
+
+func (canTransferOwnershipResultPasswordTooFresh *CanTransferOwnershipResultPasswordTooFresh) printText(p *printer) {
+	if canTransferOwnershipResultPasswordTooFresh == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CanTransferOwnershipResultPasswordTooFresh")
+	p.int("retry_after", int64(canTransferOwnershipResultPasswordTooFresh.RetryAfter))
+	p.endObject(state)
+}
+
 // GetCanTransferOwnershipResultEnum return the enum type of this object
 func (canTransferOwnershipResultPasswordTooFresh *CanTransferOwnershipResultPasswordTooFresh) GetCanTransferOwnershipResultEnum() CanTransferOwnershipResultEnum {
 	return CanTransferOwnershipResultPasswordTooFreshType
 }
 
+// Accept calls the method of the visitor for CanTransferOwnershipResultPasswordTooFresh
+func (canTransferOwnershipResultPasswordTooFresh *CanTransferOwnershipResultPasswordTooFresh) Accept(visitor CanTransferOwnershipResultVisitor) {
+	visitor.VisitCanTransferOwnershipResultPasswordTooFresh(canTransferOwnershipResultPasswordTooFresh)
+}
+
 // CanTransferOwnershipResultSessionTooFresh The session was created recently, user needs to wait
 type CanTransferOwnershipResultSessionTooFresh struct {
 	tdCommon
@@ -159,11 +501,243 @@ func NewCanTransferOwnershipResultSessionTooFresh(retryAfter int32) *CanTransfer
 	return &canTransferOwnershipResultSessionTooFreshTemp
 }
 
+// MarshalJSON marshals to json
+func (canTransferOwnershipResultSessionTooFresh *CanTransferOwnershipResultSessionTooFresh) MarshalJSON() ([]byte, error) {
+	return canTransferOwnershipResultSessionTooFresh.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (canTransferOwnershipResultSessionTooFresh *CanTransferOwnershipResultSessionTooFresh) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, canTransferOwnershipResultSessionTooFresh)
+}
+
+func (canTransferOwnershipResultSessionTooFresh *CanTransferOwnershipResultSessionTooFresh) appendJSON(b []byte) ([]byte, error) {
+	if canTransferOwnershipResultSessionTooFresh == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, canTransferOwnershipResultSessionTooFresh.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, canTransferOwnershipResultSessionTooFresh.tdCommon.Extra)
+	b = append(b, `,"retry_after":`...)
+	b = appendJSONInt(b, int64(canTransferOwnershipResultSessionTooFresh.RetryAfter))
+	return append(b, '}'), nil
+}
+
+func (canTransferOwnershipResultSessionTooFresh *CanTransferOwnershipResultSessionTooFresh) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			canTransferOwnershipResultSessionTooFresh.tdCommon.Type, err = r.readString()
+		case "@extra":
+			canTransferOwnershipResultSessionTooFresh.tdCommon.Extra, err = r.readString()
+		case "retry_after":
+			canTransferOwnershipResultSessionTooFresh.RetryAfter, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (canTransferOwnershipResultSessionTooFresh *CanTransferOwnershipResultSessionTooFresh) Clone() *CanTransferOwnershipResultSessionTooFresh {
+	if canTransferOwnershipResultSessionTooFresh == nil {
+		return nil
+	}
+	clone := *canTransferOwnershipResultSessionTooFresh
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (canTransferOwnershipResultSessionTooFresh *CanTransferOwnershipResultSessionTooFresh) Equal(other *CanTransferOwnershipResultSessionTooFresh) bool {
+	if canTransferOwnershipResultSessionTooFresh == nil || other == nil {
+		return canTransferOwnershipResultSessionTooFresh == other
+	}
+	if canTransferOwnershipResultSessionTooFresh.RetryAfter != other.RetryAfter {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (canTransferOwnershipResultSessionTooFresh *CanTransferOwnershipResultSessionTooFresh) Diff(other *CanTransferOwnershipResultSessionTooFresh) []Change {
+	return canTransferOwnershipResultSessionTooFresh.diff("", other, nil)
+}
+
+func (canTransferOwnershipResultSessionTooFresh *CanTransferOwnershipResultSessionTooFresh) diff(path string, other *CanTransferOwnershipResultSessionTooFresh, changes []Change) []Change {
+	if canTransferOwnershipResultSessionTooFresh == nil || other == nil {
+		if canTransferOwnershipResultSessionTooFresh != other {
+			changes = append(changes, Change{Path: path, Old: canTransferOwnershipResultSessionTooFresh, New: other})
+		}
+		return changes
+	}
+	if canTransferOwnershipResultSessionTooFresh.RetryAfter != other.RetryAfter {
+		changes = append(changes, Change{Path: fieldPath(path, "retry_after"), Old: canTransferOwnershipResultSessionTooFresh.RetryAfter, New: other.RetryAfter})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (canTransferOwnershipResultSessionTooFresh *CanTransferOwnershipResultSessionTooFresh) String() string {
+	return objectString(canTransferOwnershipResultSessionTooFresh)
+}
+
+// LogValue logs the object as its summary
+func (canTransferOwnershipResultSessionTooFresh *CanTransferOwnershipResultSessionTooFresh) LogValue() slog.Value {
+	return slog.StringValue(objectString(canTransferOwnershipResultSessionTooFresh))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (canTransferOwnershipResultSessionTooFresh *CanTransferOwnershipResultSessionTooFresh) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, canTransferOwnershipResultSessionTooFresh)
+}
+
+func (canTransferOwnershipResultSessionTooFresh *CanTransferOwnershipResultSessionTooFresh) printText(p *printer) {
+	if canTransferOwnershipResultSessionTooFresh == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CanTransferOwnershipResultSessionTooFresh")
+	p.int("retry_after", int64(canTransferOwnershipResultSessionTooFresh.RetryAfter))
+	p.endObject(state)
+}
+
 // GetCanTransferOwnershipResultEnum return the enum type of this object
 func (canTransferOwnershipResultSessionTooFresh *CanTransferOwnershipResultSessionTooFresh) GetCanTransferOwnershipResultEnum() CanTransferOwnershipResultEnum {
 	return CanTransferOwnershipResultSessionTooFreshType
 }
 
+// Accept calls the method of the visitor for CanTransferOwnershipResultSessionTooFresh
+func (canTransferOwnershipResultSessionTooFresh *CanTransferOwnershipResultSessionTooFresh) Accept(visitor CanTransferOwnershipResultVisitor) {
+	visitor.VisitCanTransferOwnershipResultSessionTooFresh(canTransferOwnershipResultSessionTooFresh)
+}
+
+// CanTransferOwnershipResultVisitor visits every type of CanTransferOwnershipResult, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to CanTransferOwnershipResult, until they handle it too.
+type CanTransferOwnershipResultVisitor interface {
+	VisitCanTransferOwnershipResultOk(canTransferOwnershipResultOk *CanTransferOwnershipResultOk)
+	VisitCanTransferOwnershipResultPasswordNeeded(canTransferOwnershipResultPasswordNeeded *CanTransferOwnershipResultPasswordNeeded)
+	VisitCanTransferOwnershipResultPasswordTooFresh(canTransferOwnershipResultPasswordTooFresh *CanTransferOwnershipResultPasswordTooFresh)
+	VisitCanTransferOwnershipResultSessionTooFresh(canTransferOwnershipResultSessionTooFresh *CanTransferOwnershipResultSessionTooFresh)
+	VisitUnknownCanTransferOwnershipResult(unknownCanTransferOwnershipResult *UnknownCanTransferOwnershipResult)
+}
+
+// CanTransferOwnershipResultCases holds the function MatchCanTransferOwnershipResult calls for each type of CanTransferOwnershipResult;
+// types without a function are passed to the default case
+type CanTransferOwnershipResultCases[R any] struct {
+	CanTransferOwnershipResultOk               func(canTransferOwnershipResultOk *CanTransferOwnershipResultOk) R
+	CanTransferOwnershipResultPasswordNeeded   func(canTransferOwnershipResultPasswordNeeded *CanTransferOwnershipResultPasswordNeeded) R
+	CanTransferOwnershipResultPasswordTooFresh func(canTransferOwnershipResultPasswordTooFresh *CanTransferOwnershipResultPasswordTooFresh) R
+	CanTransferOwnershipResultSessionTooFresh  func(canTransferOwnershipResultSessionTooFresh *CanTransferOwnershipResultSessionTooFresh) R
+}
+
+// MatchCanTransferOwnershipResult calls the case of the type of canTransferOwnershipResult, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement CanTransferOwnershipResultVisitor
+// to have the compiler check every type is handled.
+func MatchCanTransferOwnershipResult[R any](canTransferOwnershipResult CanTransferOwnershipResult, cases CanTransferOwnershipResultCases[R], defaultCase func(canTransferOwnershipResult CanTransferOwnershipResult) R) R {
+	switch value := canTransferOwnershipResult.(type) {
+	case *CanTransferOwnershipResultOk:
+		if cases.CanTransferOwnershipResultOk != nil {
+			return cases.CanTransferOwnershipResultOk(value)
+		}
+	case *CanTransferOwnershipResultPasswordNeeded:
+		if cases.CanTransferOwnershipResultPasswordNeeded != nil {
+			return cases.CanTransferOwnershipResultPasswordNeeded(value)
+		}
+	case *CanTransferOwnershipResultPasswordTooFresh:
+		if cases.CanTransferOwnershipResultPasswordTooFresh != nil {
+			return cases.CanTransferOwnershipResultPasswordTooFresh(value)
+		}
+	case *CanTransferOwnershipResultSessionTooFresh:
+		if cases.CanTransferOwnershipResultSessionTooFresh != nil {
+			return cases.CanTransferOwnershipResultSessionTooFresh(value)
+		}
+	}
+	return defaultCase(canTransferOwnershipResult)
+}
+
+// Clone returns a deep copy of the object
+func (unknownCanTransferOwnershipResult *UnknownCanTransferOwnershipResult) Clone() *UnknownCanTransferOwnershipResult {
+	if unknownCanTransferOwnershipResult == nil {
+		return nil
+	}
+	return &UnknownCanTransferOwnershipResult{Unknown: unknownCanTransferOwnershipResult.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownCanTransferOwnershipResult *UnknownCanTransferOwnershipResult) Equal(other *UnknownCanTransferOwnershipResult) bool {
+	if unknownCanTransferOwnershipResult == nil || other == nil {
+		return unknownCanTransferOwnershipResult == other
+	}
+	return unknownCanTransferOwnershipResult.Unknown.equal(other.Unknown)
+}
+
+func cloneCanTransferOwnershipResult(canTransferOwnershipResult CanTransferOwnershipResult) CanTransferOwnershipResult {
+	switch value := canTransferOwnershipResult.(type) {
+	case *CanTransferOwnershipResultOk:
+		return value.Clone()
+	case *CanTransferOwnershipResultPasswordNeeded:
+		return value.Clone()
+	case *CanTransferOwnershipResultPasswordTooFresh:
+		return value.Clone()
+	case *CanTransferOwnershipResultSessionTooFresh:
+		return value.Clone()
+	case *UnknownCanTransferOwnershipResult:
+		return value.Clone()
+	}
+	return canTransferOwnershipResult
+}
+
+func equalCanTransferOwnershipResult(a CanTransferOwnershipResult, b CanTransferOwnershipResult) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *CanTransferOwnershipResultOk:
+		b, ok := b.(*CanTransferOwnershipResultOk)
+		return ok && a.Equal(b)
+	case *CanTransferOwnershipResultPasswordNeeded:
+		b, ok := b.(*CanTransferOwnershipResultPasswordNeeded)
+		return ok && a.Equal(b)
+	case *CanTransferOwnershipResultPasswordTooFresh:
+		b, ok := b.(*CanTransferOwnershipResultPasswordTooFresh)
+		return ok && a.Equal(b)
+	case *CanTransferOwnershipResultSessionTooFresh:
+		b, ok := b.(*CanTransferOwnershipResultSessionTooFresh)
+		return ok && a.Equal(b)
+	case *UnknownCanTransferOwnershipResult:
+		b, ok := b.(*UnknownCanTransferOwnershipResult)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffCanTransferOwnershipResult(path string, a CanTransferOwnershipResult, b CanTransferOwnershipResult, changes []Change) []Change {
+	switch a := a.(type) {
+	case *CanTransferOwnershipResultOk:
+		if b, ok := b.(*CanTransferOwnershipResultOk); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CanTransferOwnershipResultPasswordNeeded:
+		if b, ok := b.(*CanTransferOwnershipResultPasswordNeeded); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CanTransferOwnershipResultPasswordTooFresh:
+		if b, ok := b.(*CanTransferOwnershipResultPasswordTooFresh); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CanTransferOwnershipResultSessionTooFresh:
+		if b, ok := b.(*CanTransferOwnershipResultSessionTooFresh); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalCanTransferOwnershipResult(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
+
 // CanTransferOwnership Checks whether the current session can be used to transfer a chat ownership to another user
 func (client *Client) CanTransferOwnership() (CanTransferOwnershipResult, error) {
 	result, err := client.SendAndCatch(UpdateData{
@@ -174,30 +748,31 @@ func (client *Client) CanTransferOwnership() (CanTransferOwnershipResult, error)
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
-	switch CanTransferOwnershipResultEnum(result.Data["@type"].(string)) {
+	resultType, _ := result.Data["@type"].(string)
+	switch CanTransferOwnershipResultEnum(resultType) {
 
 	case CanTransferOwnershipResultOkType:
 		var canTransferOwnershipResult CanTransferOwnershipResultOk
-		err = json.Unmarshal(result.Raw, &canTransferOwnershipResult)
+		err = jsonUnmarshal(result.Raw, &canTransferOwnershipResult)
 		return &canTransferOwnershipResult, err
 
 	case CanTransferOwnershipResultPasswordNeededType:
 		var canTransferOwnershipResult CanTransferOwnershipResultPasswordNeeded
-		err = json.Unmarshal(result.Raw, &canTransferOwnershipResult)
+		err = jsonUnmarshal(result.Raw, &canTransferOwnershipResult)
 		return &canTransferOwnershipResult, err
 
 	case CanTransferOwnershipResultPasswordTooFreshType:
 		var canTransferOwnershipResult CanTransferOwnershipResultPasswordTooFresh
-		err = json.Unmarshal(result.Raw, &canTransferOwnershipResult)
+		err = jsonUnmarshal(result.Raw, &canTransferOwnershipResult)
 		return &canTransferOwnershipResult, err
 
 	case CanTransferOwnershipResultSessionTooFreshType:
 		var canTransferOwnershipResult CanTransferOwnershipResultSessionTooFresh
-		err = json.Unmarshal(result.Raw, &canTransferOwnershipResult)
+		err = jsonUnmarshal(result.Raw, &canTransferOwnershipResult)
 		return &canTransferOwnershipResult, err
 
 	default:
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // Chat A chat. (Can be a private chat, basic group, supergroup, or secret chat)
@@ -112,85 +112,511 @@ func NewChat(id int64, typeParam ChatType, title string, photo *ChatPhotoInfo, p
 	return &chatTemp
 }
 
+// MarshalJSON marshals to json
+func (chat *Chat) MarshalJSON() ([]byte, error) {
+	return chat.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (chat *Chat) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, chat)
+}
+
+func (chat *Chat) appendJSON(b []byte) ([]byte, error) {
+	if chat == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chat.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chat.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt(b, int64(chat.Id))
+	b = append(b, `,"type":`...)
+	b, err = appendJSONValue(b, chat.Type)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, chat.Title)
+	b = append(b, `,"photo":`...)
+	b, err = chat.Photo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"permissions":`...)
+	b, err = chat.Permissions.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"last_message":`...)
+	b, err = chat.LastMessage.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"positions":`...)
+	if chat.Positions == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range chat.Positions {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = chat.Positions[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"message_sender_id":`...)
+	b, err = appendJSONValue(b, chat.MessageSenderId)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"has_protected_content":`...)
+	b = appendJSONBool(b, chat.HasProtectedContent)
+	b = append(b, `,"is_marked_as_unread":`...)
+	b = appendJSONBool(b, chat.IsMarkedAsUnread)
+	b = append(b, `,"is_blocked":`...)
+	b = appendJSONBool(b, chat.IsBlocked)
+	b = append(b, `,"has_scheduled_messages":`...)
+	b = appendJSONBool(b, chat.HasScheduledMessages)
+	b = append(b, `,"can_be_deleted_only_for_self":`...)
+	b = appendJSONBool(b, chat.CanBeDeletedOnlyForSelf)
+	b = append(b, `,"can_be_deleted_for_all_users":`...)
+	b = appendJSONBool(b, chat.CanBeDeletedForAllUsers)
+	b = append(b, `,"can_be_reported":`...)
+	b = appendJSONBool(b, chat.CanBeReported)
+	b = append(b, `,"default_disable_notification":`...)
+	b = appendJSONBool(b, chat.DefaultDisableNotification)
+	b = append(b, `,"unread_count":`...)
+	b = appendJSONInt(b, int64(chat.UnreadCount))
+	b = append(b, `,"last_read_inbox_message_id":`...)
+	b = appendJSONInt(b, int64(chat.LastReadInboxMessageId))
+	b = append(b, `,"last_read_outbox_message_id":`...)
+	b = appendJSONInt(b, int64(chat.LastReadOutboxMessageId))
+	b = append(b, `,"unread_mention_count":`...)
+	b = appendJSONInt(b, int64(chat.UnreadMentionCount))
+	b = append(b, `,"notification_settings":`...)
+	b, err = chat.NotificationSettings.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"message_ttl":`...)
+	b = appendJSONInt(b, int64(chat.MessageTtl))
+	b = append(b, `,"theme_name":`...)
+	b = appendJSONString(b, chat.ThemeName)
+	b = append(b, `,"action_bar":`...)
+	b, err = appendJSONValue(b, chat.ActionBar)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"video_chat":`...)
+	b, err = chat.VideoChat.appendJSON(b)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Id                         int64                     `json:"id"`                           // Chat unique identifier
-		Title                      string                    `json:"title"`                        // Chat title
-		Photo                      *ChatPhotoInfo            `json:"photo"`                        // Chat photo; may be null
-		Permissions                *ChatPermissions          `json:"permissions"`                  // Actions that non-administrator chat members are allowed to take in the chat
-		LastMessage                *Message                  `json:"last_message"`                 // Last message in the chat; may be null
-		Positions                  []ChatPosition            `json:"positions"`                    // Positions of the chat in chat lists
-		HasProtectedContent        bool                      `json:"has_protected_content"`        // True, if chat content can't be saved locally, forwarded, or copied
-		IsMarkedAsUnread           bool                      `json:"is_marked_as_unread"`          // True, if the chat is marked as unread
-		IsBlocked                  bool                      `json:"is_blocked"`                   // True, if the chat is blocked by the current user and private messages from the chat can't be received
-		HasScheduledMessages       bool                      `json:"has_scheduled_messages"`       // True, if the chat has scheduled messages
-		CanBeDeletedOnlyForSelf    bool                      `json:"can_be_deleted_only_for_self"` // True, if the chat messages can be deleted only for the current user while other users will continue to see the messages
-		CanBeDeletedForAllUsers    bool                      `json:"can_be_deleted_for_all_users"` // True, if the chat messages can be deleted for all users
-		CanBeReported              bool                      `json:"can_be_reported"`              // True, if the chat can be reported to Telegram moderators through reportChat or reportChatPhoto
-		DefaultDisableNotification bool                      `json:"default_disable_notification"` // Default value of the disable_notification parameter, used when a message is sent to the chat
-		UnreadCount                int32                     `json:"unread_count"`                 // Number of unread messages in the chat
-		LastReadInboxMessageId     int64                     `json:"last_read_inbox_message_id"`   // Identifier of the last read incoming message
-		LastReadOutboxMessageId    int64                     `json:"last_read_outbox_message_id"`  // Identifier of the last read outgoing message
-		UnreadMentionCount         int32                     `json:"unread_mention_count"`         // Number of unread messages with a mention/reply in the chat
-		NotificationSettings       *ChatNotificationSettings `json:"notification_settings"`        // Notification settings for this chat
-		MessageTtl                 int32                     `json:"message_ttl"`                  // Current message Time To Live setting (self-destruct timer) for the chat; 0 if not defined. TTL is counted from the time message or its content is viewed in secret chats and from the send date in other chats
-		ThemeName                  string                    `json:"theme_name"`                   // If non-empty, name of a theme, set for the chat
-		VideoChat                  *VideoChat                `json:"video_chat"`                   // Information about video chat of the chat
-		PendingJoinRequests        *ChatJoinRequestsInfo     `json:"pending_join_requests"`        // Information about pending join requests; may be null
-		ReplyMarkupMessageId       int64                     `json:"reply_markup_message_id"`      // Identifier of the message from which reply markup needs to be used; 0 if there is no default custom reply markup in the chat
-		DraftMessage               *DraftMessage             `json:"draft_message"`                // A draft of a message in the chat; may be null
-		ClientData                 string                    `json:"client_data"`                  // Application-specific data associated with the chat. (For example, the chat scroll position or local chat notification settings can be stored here.) Persistent if the message database is used
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"pending_join_requests":`...)
+	b, err = chat.PendingJoinRequests.appendJSON(b)
 	if err != nil {
-		return err
-	}
-
-	chat.tdCommon = tempObj.tdCommon
-	chat.Id = tempObj.Id
-	chat.Title = tempObj.Title
-	chat.Photo = tempObj.Photo
-	chat.Permissions = tempObj.Permissions
-	chat.LastMessage = tempObj.LastMessage
-	chat.Positions = tempObj.Positions
-	chat.HasProtectedContent = tempObj.HasProtectedContent
-	chat.IsMarkedAsUnread = tempObj.IsMarkedAsUnread
-	chat.IsBlocked = tempObj.IsBlocked
-	chat.HasScheduledMessages = tempObj.HasScheduledMessages
-	chat.CanBeDeletedOnlyForSelf = tempObj.CanBeDeletedOnlyForSelf
-	chat.CanBeDeletedForAllUsers = tempObj.CanBeDeletedForAllUsers
-	chat.CanBeReported = tempObj.CanBeReported
-	chat.DefaultDisableNotification = tempObj.DefaultDisableNotification
-	chat.UnreadCount = tempObj.UnreadCount
-	chat.LastReadInboxMessageId = tempObj.LastReadInboxMessageId
-	chat.LastReadOutboxMessageId = tempObj.LastReadOutboxMessageId
-	chat.UnreadMentionCount = tempObj.UnreadMentionCount
-	chat.NotificationSettings = tempObj.NotificationSettings
-	chat.MessageTtl = tempObj.MessageTtl
-	chat.ThemeName = tempObj.ThemeName
-	chat.VideoChat = tempObj.VideoChat
-	chat.PendingJoinRequests = tempObj.PendingJoinRequests
-	chat.ReplyMarkupMessageId = tempObj.ReplyMarkupMessageId
-	chat.DraftMessage = tempObj.DraftMessage
-	chat.ClientData = tempObj.ClientData
-
-	fieldType, _ := unmarshalChatType(objMap["type"])
-	chat.Type = fieldType
-
-	fieldMessageSenderId, _ := unmarshalMessageSender(objMap["message_sender_id"])
-	chat.MessageSenderId = fieldMessageSenderId
-
-	fieldActionBar, _ := unmarshalChatActionBar(objMap["action_bar"])
-	chat.ActionBar = fieldActionBar
-
-	return nil
+		return nil, err
+	}
+	b = append(b, `,"reply_markup_message_id":`...)
+	b = appendJSONInt(b, int64(chat.ReplyMarkupMessageId))
+	b = append(b, `,"draft_message":`...)
+	b, err = chat.DraftMessage.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"client_data":`...)
+	b = appendJSONString(b, chat.ClientData)
+	return append(b, '}'), nil
+}
+
+func (chat *Chat) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chat.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chat.tdCommon.Extra, err = r.readString()
+		case "id":
+			chat.Id, err = r.readInt64()
+		case "type":
+			chat.Type, err = decodeChatType(r)
+		case "title":
+			chat.Title, err = r.readString()
+		case "photo":
+			if r.readNull() {
+				chat.Photo = nil
+			} else {
+				chat.Photo = new(ChatPhotoInfo)
+				err = chat.Photo.decodeJSON(r)
+			}
+		case "permissions":
+			if r.readNull() {
+				chat.Permissions = nil
+			} else {
+				chat.Permissions = new(ChatPermissions)
+				err = chat.Permissions.decodeJSON(r)
+			}
+		case "last_message":
+			if r.readNull() {
+				chat.LastMessage = nil
+			} else {
+				chat.LastMessage = new(Message)
+				err = chat.LastMessage.decodeJSON(r)
+			}
+		case "positions":
+			if r.readNull() {
+				chat.Positions = nil
+			} else {
+				chat.Positions = make([]ChatPosition, 0)
+				err = r.readArray(func() (err error) {
+					var item0 ChatPosition
+					err = item0.decodeJSON(r)
+					chat.Positions = append(chat.Positions, item0)
+					return
+				})
+			}
+		case "message_sender_id":
+			chat.MessageSenderId, err = decodeMessageSender(r)
+		case "has_protected_content":
+			chat.HasProtectedContent, err = r.readBool()
+		case "is_marked_as_unread":
+			chat.IsMarkedAsUnread, err = r.readBool()
+		case "is_blocked":
+			chat.IsBlocked, err = r.readBool()
+		case "has_scheduled_messages":
+			chat.HasScheduledMessages, err = r.readBool()
+		case "can_be_deleted_only_for_self":
+			chat.CanBeDeletedOnlyForSelf, err = r.readBool()
+		case "can_be_deleted_for_all_users":
+			chat.CanBeDeletedForAllUsers, err = r.readBool()
+		case "can_be_reported":
+			chat.CanBeReported, err = r.readBool()
+		case "default_disable_notification":
+			chat.DefaultDisableNotification, err = r.readBool()
+		case "unread_count":
+			chat.UnreadCount, err = r.readInt32()
+		case "last_read_inbox_message_id":
+			chat.LastReadInboxMessageId, err = r.readInt64()
+		case "last_read_outbox_message_id":
+			chat.LastReadOutboxMessageId, err = r.readInt64()
+		case "unread_mention_count":
+			chat.UnreadMentionCount, err = r.readInt32()
+		case "notification_settings":
+			if r.readNull() {
+				chat.NotificationSettings = nil
+			} else {
+				chat.NotificationSettings = new(ChatNotificationSettings)
+				err = chat.NotificationSettings.decodeJSON(r)
+			}
+		case "message_ttl":
+			chat.MessageTtl, err = r.readInt32()
+		case "theme_name":
+			chat.ThemeName, err = r.readString()
+		case "action_bar":
+			chat.ActionBar, err = decodeChatActionBar(r)
+		case "video_chat":
+			if r.readNull() {
+				chat.VideoChat = nil
+			} else {
+				chat.VideoChat = new(VideoChat)
+				err = chat.VideoChat.decodeJSON(r)
+			}
+		case "pending_join_requests":
+			if r.readNull() {
+				chat.PendingJoinRequests = nil
+			} else {
+				chat.PendingJoinRequests = new(ChatJoinRequestsInfo)
+				err = chat.PendingJoinRequests.decodeJSON(r)
+			}
+		case "reply_markup_message_id":
+			chat.ReplyMarkupMessageId, err = r.readInt64()
+		case "draft_message":
+			if r.readNull() {
+				chat.DraftMessage = nil
+			} else {
+				chat.DraftMessage = new(DraftMessage)
+				err = chat.DraftMessage.decodeJSON(r)
+			}
+		case "client_data":
+			chat.ClientData, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chat *Chat) Clone() *Chat {
+	if chat == nil {
+		return nil
+	}
+	clone := *chat
+	clone.Type = cloneChatType(chat.Type)
+	clone.Photo = chat.Photo.Clone()
+	clone.Permissions = chat.Permissions.Clone()
+	clone.LastMessage = chat.LastMessage.Clone()
+	if chat.Positions != nil {
+		clone.Positions = make([]ChatPosition, len(chat.Positions))
+		for i0 := range chat.Positions {
+			clone.Positions[i0] = *chat.Positions[i0].Clone()
+		}
+	}
+	clone.MessageSenderId = cloneMessageSender(chat.MessageSenderId)
+	clone.NotificationSettings = chat.NotificationSettings.Clone()
+	clone.ActionBar = cloneChatActionBar(chat.ActionBar)
+	clone.VideoChat = chat.VideoChat.Clone()
+	clone.PendingJoinRequests = chat.PendingJoinRequests.Clone()
+	clone.DraftMessage = chat.DraftMessage.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chat *Chat) Equal(other *Chat) bool {
+	if chat == nil || other == nil {
+		return chat == other
+	}
+	if chat.Id != other.Id {
+		return false
+	}
+	if !equalChatType(chat.Type, other.Type) {
+		return false
+	}
+	if chat.Title != other.Title {
+		return false
+	}
+	if !chat.Photo.Equal(other.Photo) {
+		return false
+	}
+	if !chat.Permissions.Equal(other.Permissions) {
+		return false
+	}
+	if !chat.LastMessage.Equal(other.LastMessage) {
+		return false
+	}
+	if len(chat.Positions) != len(other.Positions) {
+		return false
+	}
+	for i0 := range chat.Positions {
+		if !chat.Positions[i0].Equal(&other.Positions[i0]) {
+			return false
+		}
+	}
+	if !equalMessageSender(chat.MessageSenderId, other.MessageSenderId) {
+		return false
+	}
+	if chat.HasProtectedContent != other.HasProtectedContent {
+		return false
+	}
+	if chat.IsMarkedAsUnread != other.IsMarkedAsUnread {
+		return false
+	}
+	if chat.IsBlocked != other.IsBlocked {
+		return false
+	}
+	if chat.HasScheduledMessages != other.HasScheduledMessages {
+		return false
+	}
+	if chat.CanBeDeletedOnlyForSelf != other.CanBeDeletedOnlyForSelf {
+		return false
+	}
+	if chat.CanBeDeletedForAllUsers != other.CanBeDeletedForAllUsers {
+		return false
+	}
+	if chat.CanBeReported != other.CanBeReported {
+		return false
+	}
+	if chat.DefaultDisableNotification != other.DefaultDisableNotification {
+		return false
+	}
+	if chat.UnreadCount != other.UnreadCount {
+		return false
+	}
+	if chat.LastReadInboxMessageId != other.LastReadInboxMessageId {
+		return false
+	}
+	if chat.LastReadOutboxMessageId != other.LastReadOutboxMessageId {
+		return false
+	}
+	if chat.UnreadMentionCount != other.UnreadMentionCount {
+		return false
+	}
+	if !chat.NotificationSettings.Equal(other.NotificationSettings) {
+		return false
+	}
+	if chat.MessageTtl != other.MessageTtl {
+		return false
+	}
+	if chat.ThemeName != other.ThemeName {
+		return false
+	}
+	if !equalChatActionBar(chat.ActionBar, other.ActionBar) {
+		return false
+	}
+	if !chat.VideoChat.Equal(other.VideoChat) {
+		return false
+	}
+	if !chat.PendingJoinRequests.Equal(other.PendingJoinRequests) {
+		return false
+	}
+	if chat.ReplyMarkupMessageId != other.ReplyMarkupMessageId {
+		return false
+	}
+	if !chat.DraftMessage.Equal(other.DraftMessage) {
+		return false
+	}
+	if chat.ClientData != other.ClientData {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chat *Chat) Diff(other *Chat) []Change {
+	return chat.diff("", other, nil)
+}
+
+func (chat *Chat) diff(path string, other *Chat, changes []Change) []Change {
+	if chat == nil || other == nil {
+		if chat != other {
+			changes = append(changes, Change{Path: path, Old: chat, New: other})
+		}
+		return changes
+	}
+	if chat.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: chat.Id, New: other.Id})
+	}
+	changes = diffChatType(fieldPath(path, "type"), chat.Type, other.Type, changes)
+	if chat.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: chat.Title, New: other.Title})
+	}
+	changes = chat.Photo.diff(fieldPath(path, "photo"), other.Photo, changes)
+	changes = chat.Permissions.diff(fieldPath(path, "permissions"), other.Permissions, changes)
+	changes = chat.LastMessage.diff(fieldPath(path, "last_message"), other.LastMessage, changes)
+	if len(chat.Positions) != len(other.Positions) {
+		changes = append(changes, Change{Path: fieldPath(path, "positions"), Old: chat.Positions, New: other.Positions})
+	} else {
+		for i0 := range chat.Positions {
+			changes = chat.Positions[i0].diff(indexPath(fieldPath(path, "positions"), i0), &other.Positions[i0], changes)
+		}
+	}
+	changes = diffMessageSender(fieldPath(path, "message_sender_id"), chat.MessageSenderId, other.MessageSenderId, changes)
+	if chat.HasProtectedContent != other.HasProtectedContent {
+		changes = append(changes, Change{Path: fieldPath(path, "has_protected_content"), Old: chat.HasProtectedContent, New: other.HasProtectedContent})
+	}
+	if chat.IsMarkedAsUnread != other.IsMarkedAsUnread {
+		changes = append(changes, Change{Path: fieldPath(path, "is_marked_as_unread"), Old: chat.IsMarkedAsUnread, New: other.IsMarkedAsUnread})
+	}
+	if chat.IsBlocked != other.IsBlocked {
+		changes = append(changes, Change{Path: fieldPath(path, "is_blocked"), Old: chat.IsBlocked, New: other.IsBlocked})
+	}
+	if chat.HasScheduledMessages != other.HasScheduledMessages {
+		changes = append(changes, Change{Path: fieldPath(path, "has_scheduled_messages"), Old: chat.HasScheduledMessages, New: other.HasScheduledMessages})
+	}
+	if chat.CanBeDeletedOnlyForSelf != other.CanBeDeletedOnlyForSelf {
+		changes = append(changes, Change{Path: fieldPath(path, "can_be_deleted_only_for_self"), Old: chat.CanBeDeletedOnlyForSelf, New: other.CanBeDeletedOnlyForSelf})
+	}
+	if chat.CanBeDeletedForAllUsers != other.CanBeDeletedForAllUsers {
+		changes = append(changes, Change{Path: fieldPath(path, "can_be_deleted_for_all_users"), Old: chat.CanBeDeletedForAllUsers, New: other.CanBeDeletedForAllUsers})
+	}
+	if chat.CanBeReported != other.CanBeReported {
+		changes = append(changes, Change{Path: fieldPath(path, "can_be_reported"), Old: chat.CanBeReported, New: other.CanBeReported})
+	}
+	if chat.DefaultDisableNotification != other.DefaultDisableNotification {
+		changes = append(changes, Change{Path: fieldPath(path, "default_disable_notification"), Old: chat.DefaultDisableNotification, New: other.DefaultDisableNotification})
+	}
+	if chat.UnreadCount != other.UnreadCount {
+		changes = append(changes, Change{Path: fieldPath(path, "unread_count"), Old: chat.UnreadCount, New: other.UnreadCount})
+	}
+	if chat.LastReadInboxMessageId != other.LastReadInboxMessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "last_read_inbox_message_id"), Old: chat.LastReadInboxMessageId, New: other.LastReadInboxMessageId})
+	}
+	if chat.LastReadOutboxMessageId != other.LastReadOutboxMessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "last_read_outbox_message_id"), Old: chat.LastReadOutboxMessageId, New: other.LastReadOutboxMessageId})
+	}
+	if chat.UnreadMentionCount != other.UnreadMentionCount {
+		changes = append(changes, Change{Path: fieldPath(path, "unread_mention_count"), Old: chat.UnreadMentionCount, New: other.UnreadMentionCount})
+	}
+	changes = chat.NotificationSettings.diff(fieldPath(path, "notification_settings"), other.NotificationSettings, changes)
+	if chat.MessageTtl != other.MessageTtl {
+		changes = append(changes, Change{Path: fieldPath(path, "message_ttl"), Old: chat.MessageTtl, New: other.MessageTtl})
+	}
+	if chat.ThemeName != other.ThemeName {
+		changes = append(changes, Change{Path: fieldPath(path, "theme_name"), Old: chat.ThemeName, New: other.ThemeName})
+	}
+	changes = diffChatActionBar(fieldPath(path, "action_bar"), chat.ActionBar, other.ActionBar, changes)
+	changes = chat.VideoChat.diff(fieldPath(path, "video_chat"), other.VideoChat, changes)
+	changes = chat.PendingJoinRequests.diff(fieldPath(path, "pending_join_requests"), other.PendingJoinRequests, changes)
+	if chat.ReplyMarkupMessageId != other.ReplyMarkupMessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "reply_markup_message_id"), Old: chat.ReplyMarkupMessageId, New: other.ReplyMarkupMessageId})
+	}
+	changes = chat.DraftMessage.diff(fieldPath(path, "draft_message"), other.DraftMessage, changes)
+	if chat.ClientData != other.ClientData {
+		changes = append(changes, Change{Path: fieldPath(path, "client_data"), Old: chat.ClientData, New: other.ClientData})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chat *Chat) String() string {
+	return objectString(chat)
+}
+
+// LogValue logs the object as its summary
+func (chat *Chat) LogValue() slog.Value {
+	return slog.StringValue(objectString(chat))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chat *Chat) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chat)
+}
+
+func (chat *Chat) printText(p *printer) {
+	if chat == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Chat")
+	p.int("id", int64(chat.Id))
+	p.object("type", chat.Type)
+	p.string("title", chat.Title)
+	p.object("photo", chat.Photo)
+	p.object("permissions", chat.Permissions)
+	p.object("last_message", chat.LastMessage)
+	p.vector("positions", len(chat.Positions), func(i0 int) {
+		chat.Positions[i0].printText(p)
+	})
+	p.object("message_sender_id", chat.MessageSenderId)
+	p.bool("has_protected_content", chat.HasProtectedContent)
+	p.bool("is_marked_as_unread", chat.IsMarkedAsUnread)
+	p.bool("is_blocked", chat.IsBlocked)
+	p.bool("has_scheduled_messages", chat.HasScheduledMessages)
+	p.bool("can_be_deleted_only_for_self", chat.CanBeDeletedOnlyForSelf)
+	p.bool("can_be_deleted_for_all_users", chat.CanBeDeletedForAllUsers)
+	p.bool("can_be_reported", chat.CanBeReported)
+	p.bool("default_disable_notification", chat.DefaultDisableNotification)
+	p.int("unread_count", int64(chat.UnreadCount))
+	p.int("last_read_inbox_message_id", int64(chat.LastReadInboxMessageId))
+	p.int("last_read_outbox_message_id", int64(chat.LastReadOutboxMessageId))
+	p.int("unread_mention_count", int64(chat.UnreadMentionCount))
+	p.object("notification_settings", chat.NotificationSettings)
+	p.int("message_ttl", int64(chat.MessageTtl))
+	p.string("theme_name", chat.ThemeName)
+	p.object("action_bar", chat.ActionBar)
+	p.object("video_chat", chat.VideoChat)
+	p.object("pending_join_requests", chat.PendingJoinRequests)
+	p.int("reply_markup_message_id", int64(chat.ReplyMarkupMessageId))
+	p.object("draft_message", chat.DraftMessage)
+	p.string("client_data", chat.ClientData)
+	p.endObject(state)
 }
 
 // GetChat Returns information about a chat by its identifier, this is an offline request if the current user is not a bot
@@ -205,12 +631,12 @@ func (client *Client) GetChat(chatId int64) (*Chat, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chatDummy Chat
-	err = json.Unmarshal(result.Raw, &chatDummy)
+	err = jsonUnmarshal(result.Raw, &chatDummy)
 	return &chatDummy, err
 }
 
@@ -226,12 +652,12 @@ func (client *Client) SearchPublicChat(username string) (*Chat, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chat Chat
-	err = json.Unmarshal(result.Raw, &chat)
+	err = jsonUnmarshal(result.Raw, &chat)
 	return &chat, err
 }
 
@@ -249,12 +675,12 @@ func (client *Client) CreatePrivateChat(userId int64, force bool) (*Chat, error)
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chat Chat
-	err = json.Unmarshal(result.Raw, &chat)
+	err = jsonUnmarshal(result.Raw, &chat)
 	return &chat, err
 }
 
@@ -272,12 +698,12 @@ func (client *Client) CreateBasicGroupChat(basicGroupId int64, force bool) (*Cha
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chat Chat
-	err = json.Unmarshal(result.Raw, &chat)
+	err = jsonUnmarshal(result.Raw, &chat)
 	return &chat, err
 }
 
@@ -295,12 +721,12 @@ func (client *Client) CreateSupergroupChat(supergroupId int64, force bool) (*Cha
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chat Chat
-	err = json.Unmarshal(result.Raw, &chat)
+	err = jsonUnmarshal(result.Raw, &chat)
 	return &chat, err
 }
 
@@ -316,12 +742,12 @@ func (client *Client) CreateSecretChat(secretChatId int32) (*Chat, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chatDummy Chat
-	err = json.Unmarshal(result.Raw, &chatDummy)
+	err = jsonUnmarshal(result.Raw, &chatDummy)
 	return &chatDummy, err
 }
 
@@ -339,12 +765,12 @@ func (client *Client) CreateNewBasicGroupChat(userIds []int64, title string) (*C
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chat Chat
-	err = json.Unmarshal(result.Raw, &chat)
+	err = jsonUnmarshal(result.Raw, &chat)
 	return &chat, err
 }
 
@@ -368,12 +794,12 @@ func (client *Client) CreateNewSupergroupChat(title string, isChannel bool, desc
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chat Chat
-	err = json.Unmarshal(result.Raw, &chat)
+	err = jsonUnmarshal(result.Raw, &chat)
 	return &chat, err
 }
 
@@ -389,12 +815,12 @@ func (client *Client) CreateNewSecretChat(userId int64) (*Chat, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chat Chat
-	err = json.Unmarshal(result.Raw, &chat)
+	err = jsonUnmarshal(result.Raw, &chat)
 	return &chat, err
 }
 
@@ -410,12 +836,12 @@ func (client *Client) UpgradeBasicGroupChatToSupergroupChat(chatId int64) (*Chat
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chatDummy Chat
-	err = json.Unmarshal(result.Raw, &chatDummy)
+	err = jsonUnmarshal(result.Raw, &chatDummy)
 	return &chatDummy, err
 }
 
@@ -431,11 +857,11 @@ func (client *Client) JoinChatByInviteLink(inviteLink string) (*Chat, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chat Chat
-	err = json.Unmarshal(result.Raw, &chat)
+	err = jsonUnmarshal(result.Raw, &chat)
 	return &chat, err
 }
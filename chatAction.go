@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ChatAction Describes the different types of activity in a chat
 type ChatAction interface {
 	GetChatActionEnum() ChatActionEnum
+	Accept(visitor ChatActionVisitor)
 }
 
 // ChatActionEnum Alias for abstract ChatAction 'Sub-Classes', used as constant-enum here
@@ -32,98 +34,149 @@ const (
 	ChatActionCancelType             ChatActionEnum = "chatActionCancel"
 )
 
+// UnknownChatAction is a ChatAction of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownChatAction struct {
+	Unknown
+}
+
+// GetChatActionEnum return the enum type of this object
+func (unknownChatAction *UnknownChatAction) GetChatActionEnum() ChatActionEnum {
+	return ChatActionEnum(unknownChatAction.Type)
+}
+
+// Accept calls the method of the visitor for UnknownChatAction
+func (unknownChatAction *UnknownChatAction) Accept(visitor ChatActionVisitor) {
+	visitor.VisitUnknownChatAction(unknownChatAction)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownChatAction *UnknownChatAction) String() string {
+	return objectString(unknownChatAction)
+}
+
+// LogValue logs the object as its summary
+func (unknownChatAction *UnknownChatAction) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownChatAction))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownChatAction *UnknownChatAction) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownChatAction)
+}
+
+func (unknownChatAction *UnknownChatAction) printText(p *printer) {
+	if unknownChatAction == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownChatAction")
+	p.string("@type", unknownChatAction.Type)
+	p.endObject(state)
+}
+
 func unmarshalChatAction(rawMsg *json.RawMessage) (ChatAction, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch ChatActionEnum(objMap["@type"].(string)) {
+	switch ChatActionEnum(typeName) {
 	case ChatActionTypingType:
 		var chatActionTyping ChatActionTyping
-		err := json.Unmarshal(*rawMsg, &chatActionTyping)
+		err := jsonUnmarshal(*rawMsg, &chatActionTyping)
 		return &chatActionTyping, err
 
 	case ChatActionRecordingVideoType:
 		var chatActionRecordingVideo ChatActionRecordingVideo
-		err := json.Unmarshal(*rawMsg, &chatActionRecordingVideo)
+		err := jsonUnmarshal(*rawMsg, &chatActionRecordingVideo)
 		return &chatActionRecordingVideo, err
 
 	case ChatActionUploadingVideoType:
 		var chatActionUploadingVideo ChatActionUploadingVideo
-		err := json.Unmarshal(*rawMsg, &chatActionUploadingVideo)
+		err := jsonUnmarshal(*rawMsg, &chatActionUploadingVideo)
 		return &chatActionUploadingVideo, err
 
 	case ChatActionRecordingVoiceNoteType:
 		var chatActionRecordingVoiceNote ChatActionRecordingVoiceNote
-		err := json.Unmarshal(*rawMsg, &chatActionRecordingVoiceNote)
+		err := jsonUnmarshal(*rawMsg, &chatActionRecordingVoiceNote)
 		return &chatActionRecordingVoiceNote, err
 
 	case ChatActionUploadingVoiceNoteType:
 		var chatActionUploadingVoiceNote ChatActionUploadingVoiceNote
-		err := json.Unmarshal(*rawMsg, &chatActionUploadingVoiceNote)
+		err := jsonUnmarshal(*rawMsg, &chatActionUploadingVoiceNote)
 		return &chatActionUploadingVoiceNote, err
 
 	case ChatActionUploadingPhotoType:
 		var chatActionUploadingPhoto ChatActionUploadingPhoto
-		err := json.Unmarshal(*rawMsg, &chatActionUploadingPhoto)
+		err := jsonUnmarshal(*rawMsg, &chatActionUploadingPhoto)
 		return &chatActionUploadingPhoto, err
 
 	case ChatActionUploadingDocumentType:
 		var chatActionUploadingDocument ChatActionUploadingDocument
-		err := json.Unmarshal(*rawMsg, &chatActionUploadingDocument)
+		err := jsonUnmarshal(*rawMsg, &chatActionUploadingDocument)
 		return &chatActionUploadingDocument, err
 
 	case ChatActionChoosingStickerType:
 		var chatActionChoosingSticker ChatActionChoosingSticker
-		err := json.Unmarshal(*rawMsg, &chatActionChoosingSticker)
+		err := jsonUnmarshal(*rawMsg, &chatActionChoosingSticker)
 		return &chatActionChoosingSticker, err
 
 	case ChatActionChoosingLocationType:
 		var chatActionChoosingLocation ChatActionChoosingLocation
-		err := json.Unmarshal(*rawMsg, &chatActionChoosingLocation)
+		err := jsonUnmarshal(*rawMsg, &chatActionChoosingLocation)
 		return &chatActionChoosingLocation, err
 
 	case ChatActionChoosingContactType:
 		var chatActionChoosingContact ChatActionChoosingContact
-		err := json.Unmarshal(*rawMsg, &chatActionChoosingContact)
+		err := jsonUnmarshal(*rawMsg, &chatActionChoosingContact)
 		return &chatActionChoosingContact, err
 
 	case ChatActionStartPlayingGameType:
 		var chatActionStartPlayingGame ChatActionStartPlayingGame
-		err := json.Unmarshal(*rawMsg, &chatActionStartPlayingGame)
+		err := jsonUnmarshal(*rawMsg, &chatActionStartPlayingGame)
 		return &chatActionStartPlayingGame, err
 
 	case ChatActionRecordingVideoNoteType:
 		var chatActionRecordingVideoNote ChatActionRecordingVideoNote
-		err := json.Unmarshal(*rawMsg, &chatActionRecordingVideoNote)
+		err := jsonUnmarshal(*rawMsg, &chatActionRecordingVideoNote)
 		return &chatActionRecordingVideoNote, err
 
 	case ChatActionUploadingVideoNoteType:
 		var chatActionUploadingVideoNote ChatActionUploadingVideoNote
-		err := json.Unmarshal(*rawMsg, &chatActionUploadingVideoNote)
+		err := jsonUnmarshal(*rawMsg, &chatActionUploadingVideoNote)
 		return &chatActionUploadingVideoNote, err
 
 	case ChatActionWatchingAnimationsType:
 		var chatActionWatchingAnimations ChatActionWatchingAnimations
-		err := json.Unmarshal(*rawMsg, &chatActionWatchingAnimations)
+		err := jsonUnmarshal(*rawMsg, &chatActionWatchingAnimations)
 		return &chatActionWatchingAnimations, err
 
 	case ChatActionCancelType:
 		var chatActionCancel ChatActionCancel
-		err := json.Unmarshal(*rawMsg, &chatActionCancel)
+		err := jsonUnmarshal(*rawMsg, &chatActionCancel)
 		return &chatActionCancel, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownChatAction{Unknown: unknown}, nil
 	}
 }
 
+func decodeChatAction(r *jsonReader) (ChatAction, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalChatAction(rawMsg)
+}
+
 // ChatActionTyping The user is typing a message
 type ChatActionTyping struct {
 	tdCommon
@@ -135,7 +188,6 @@ func (chatActionTyping *ChatActionTyping) MessageType() string {
 }
 
 // NewChatActionTyping creates a new ChatActionTyping
-//
 func NewChatActionTyping() *ChatActionTyping {
 	chatActionTypingTemp := ChatActionTyping{
 		tdCommon: tdCommon{Type: "chatActionTyping"},
@@ -144,11 +196,107 @@ func NewChatActionTyping() *ChatActionTyping {
 	return &chatActionTypingTemp
 }
 
+// MarshalJSON marshals to json
+func (chatActionTyping *ChatActionTyping) MarshalJSON() ([]byte, error) {
+	return chatActionTyping.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatActionTyping *ChatActionTyping) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatActionTyping)
+}
+
+func (chatActionTyping *ChatActionTyping) appendJSON(b []byte) ([]byte, error) {
+	if chatActionTyping == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatActionTyping.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatActionTyping.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatActionTyping *ChatActionTyping) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatActionTyping.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatActionTyping.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatActionTyping *ChatActionTyping) Clone() *ChatActionTyping {
+	if chatActionTyping == nil {
+		return nil
+	}
+	clone := *chatActionTyping
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatActionTyping *ChatActionTyping) Equal(other *ChatActionTyping) bool {
+	if chatActionTyping == nil || other == nil {
+		return chatActionTyping == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatActionTyping *ChatActionTyping) Diff(other *ChatActionTyping) []Change {
+	return chatActionTyping.diff("", other, nil)
+}
+
+func (chatActionTyping *ChatActionTyping) diff(path string, other *ChatActionTyping, changes []Change) []Change {
+	if chatActionTyping == nil || other == nil {
+		if chatActionTyping != other {
+			changes = append(changes, Change{Path: path, Old: chatActionTyping, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatActionTyping *ChatActionTyping) String() string {
+	return objectString(chatActionTyping)
+}
+
+// LogValue logs the object as its summary
+func (chatActionTyping *ChatActionTyping) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatActionTyping))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatActionTyping *ChatActionTyping) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatActionTyping)
+}
+
+func (chatActionTyping *ChatActionTyping) printText(p *printer) {
+	if chatActionTyping == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatActionTyping")
+	p.endObject(state)
+}
+
 // GetChatActionEnum return the enum type of this object
 func (chatActionTyping *ChatActionTyping) GetChatActionEnum() ChatActionEnum {
 	return ChatActionTypingType
 }
 
+// Accept calls the method of the visitor for ChatActionTyping
+func (chatActionTyping *ChatActionTyping) Accept(visitor ChatActionVisitor) {
+	visitor.VisitChatActionTyping(chatActionTyping)
+}
+
 // ChatActionRecordingVideo The user is recording a video
 type ChatActionRecordingVideo struct {
 	tdCommon
@@ -160,7 +308,6 @@ func (chatActionRecordingVideo *ChatActionRecordingVideo) MessageType() string {
 }
 
 // NewChatActionRecordingVideo creates a new ChatActionRecordingVideo
-//
 func NewChatActionRecordingVideo() *ChatActionRecordingVideo {
 	chatActionRecordingVideoTemp := ChatActionRecordingVideo{
 		tdCommon: tdCommon{Type: "chatActionRecordingVideo"},
@@ -169,11 +316,107 @@ func NewChatActionRecordingVideo() *ChatActionRecordingVideo {
 	return &chatActionRecordingVideoTemp
 }
 
+// MarshalJSON marshals to json
+func (chatActionRecordingVideo *ChatActionRecordingVideo) MarshalJSON() ([]byte, error) {
+	return chatActionRecordingVideo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatActionRecordingVideo *ChatActionRecordingVideo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatActionRecordingVideo)
+}
+
+func (chatActionRecordingVideo *ChatActionRecordingVideo) appendJSON(b []byte) ([]byte, error) {
+	if chatActionRecordingVideo == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatActionRecordingVideo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatActionRecordingVideo.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatActionRecordingVideo *ChatActionRecordingVideo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatActionRecordingVideo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatActionRecordingVideo.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatActionRecordingVideo *ChatActionRecordingVideo) Clone() *ChatActionRecordingVideo {
+	if chatActionRecordingVideo == nil {
+		return nil
+	}
+	clone := *chatActionRecordingVideo
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatActionRecordingVideo *ChatActionRecordingVideo) Equal(other *ChatActionRecordingVideo) bool {
+	if chatActionRecordingVideo == nil || other == nil {
+		return chatActionRecordingVideo == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatActionRecordingVideo *ChatActionRecordingVideo) Diff(other *ChatActionRecordingVideo) []Change {
+	return chatActionRecordingVideo.diff("", other, nil)
+}
+
+func (chatActionRecordingVideo *ChatActionRecordingVideo) diff(path string, other *ChatActionRecordingVideo, changes []Change) []Change {
+	if chatActionRecordingVideo == nil || other == nil {
+		if chatActionRecordingVideo != other {
+			changes = append(changes, Change{Path: path, Old: chatActionRecordingVideo, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatActionRecordingVideo *ChatActionRecordingVideo) String() string {
+	return objectString(chatActionRecordingVideo)
+}
+
+// LogValue logs the object as its summary
+func (chatActionRecordingVideo *ChatActionRecordingVideo) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatActionRecordingVideo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatActionRecordingVideo *ChatActionRecordingVideo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatActionRecordingVideo)
+}
+
+func (chatActionRecordingVideo *ChatActionRecordingVideo) printText(p *printer) {
+	if chatActionRecordingVideo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatActionRecordingVideo")
+	p.endObject(state)
+}
+
 // GetChatActionEnum return the enum type of this object
 func (chatActionRecordingVideo *ChatActionRecordingVideo) GetChatActionEnum() ChatActionEnum {
 	return ChatActionRecordingVideoType
 }
 
+// Accept calls the method of the visitor for ChatActionRecordingVideo
+func (chatActionRecordingVideo *ChatActionRecordingVideo) Accept(visitor ChatActionVisitor) {
+	visitor.VisitChatActionRecordingVideo(chatActionRecordingVideo)
+}
+
 // ChatActionUploadingVideo The user is uploading a video
 type ChatActionUploadingVideo struct {
 	tdCommon
@@ -197,11 +440,118 @@ func NewChatActionUploadingVideo(progress int32) *ChatActionUploadingVideo {
 	return &chatActionUploadingVideoTemp
 }
 
+// MarshalJSON marshals to json
+func (chatActionUploadingVideo *ChatActionUploadingVideo) MarshalJSON() ([]byte, error) {
+	return chatActionUploadingVideo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatActionUploadingVideo *ChatActionUploadingVideo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatActionUploadingVideo)
+}
+
+func (chatActionUploadingVideo *ChatActionUploadingVideo) appendJSON(b []byte) ([]byte, error) {
+	if chatActionUploadingVideo == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatActionUploadingVideo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatActionUploadingVideo.tdCommon.Extra)
+	b = append(b, `,"progress":`...)
+	b = appendJSONInt(b, int64(chatActionUploadingVideo.Progress))
+	return append(b, '}'), nil
+}
+
+func (chatActionUploadingVideo *ChatActionUploadingVideo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatActionUploadingVideo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatActionUploadingVideo.tdCommon.Extra, err = r.readString()
+		case "progress":
+			chatActionUploadingVideo.Progress, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatActionUploadingVideo *ChatActionUploadingVideo) Clone() *ChatActionUploadingVideo {
+	if chatActionUploadingVideo == nil {
+		return nil
+	}
+	clone := *chatActionUploadingVideo
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatActionUploadingVideo *ChatActionUploadingVideo) Equal(other *ChatActionUploadingVideo) bool {
+	if chatActionUploadingVideo == nil || other == nil {
+		return chatActionUploadingVideo == other
+	}
+	if chatActionUploadingVideo.Progress != other.Progress {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatActionUploadingVideo *ChatActionUploadingVideo) Diff(other *ChatActionUploadingVideo) []Change {
+	return chatActionUploadingVideo.diff("", other, nil)
+}
+
+func (chatActionUploadingVideo *ChatActionUploadingVideo) diff(path string, other *ChatActionUploadingVideo, changes []Change) []Change {
+	if chatActionUploadingVideo == nil || other == nil {
+		if chatActionUploadingVideo != other {
+			changes = append(changes, Change{Path: path, Old: chatActionUploadingVideo, New: other})
+		}
+		return changes
+	}
+	if chatActionUploadingVideo.Progress != other.Progress {
+		changes = append(changes, Change{Path: fieldPath(path, "progress"), Old: chatActionUploadingVideo.Progress, New: other.Progress})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatActionUploadingVideo *ChatActionUploadingVideo) String() string {
+	return objectString(chatActionUploadingVideo)
+}
+
+// LogValue logs the object as its summary
+func (chatActionUploadingVideo *ChatActionUploadingVideo) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatActionUploadingVideo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatActionUploadingVideo *ChatActionUploadingVideo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatActionUploadingVideo)
+}
+
+func (chatActionUploadingVideo *ChatActionUploadingVideo) printText(p *printer) {
+	if chatActionUploadingVideo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatActionUploadingVideo")
+	p.int("progress", int64(chatActionUploadingVideo.Progress))
+	p.endObject(state)
+}
+
 // GetChatActionEnum return the enum type of this object
 func (chatActionUploadingVideo *ChatActionUploadingVideo) GetChatActionEnum() ChatActionEnum {
 	return ChatActionUploadingVideoType
 }
 
+// Accept calls the method of the visitor for ChatActionUploadingVideo
+func (chatActionUploadingVideo *ChatActionUploadingVideo) Accept(visitor ChatActionVisitor) {
+	visitor.VisitChatActionUploadingVideo(chatActionUploadingVideo)
+}
+
 // ChatActionRecordingVoiceNote The user is recording a voice note
 type ChatActionRecordingVoiceNote struct {
 	tdCommon
@@ -213,7 +563,6 @@ func (chatActionRecordingVoiceNote *ChatActionRecordingVoiceNote) MessageType()
 }
 
 // NewChatActionRecordingVoiceNote creates a new ChatActionRecordingVoiceNote
-//
 func NewChatActionRecordingVoiceNote() *ChatActionRecordingVoiceNote {
 	chatActionRecordingVoiceNoteTemp := ChatActionRecordingVoiceNote{
 		tdCommon: tdCommon{Type: "chatActionRecordingVoiceNote"},
@@ -222,11 +571,107 @@ func NewChatActionRecordingVoiceNote() *ChatActionRecordingVoiceNote {
 	return &chatActionRecordingVoiceNoteTemp
 }
 
+// MarshalJSON marshals to json
+func (chatActionRecordingVoiceNote *ChatActionRecordingVoiceNote) MarshalJSON() ([]byte, error) {
+	return chatActionRecordingVoiceNote.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatActionRecordingVoiceNote *ChatActionRecordingVoiceNote) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatActionRecordingVoiceNote)
+}
+
+func (chatActionRecordingVoiceNote *ChatActionRecordingVoiceNote) appendJSON(b []byte) ([]byte, error) {
+	if chatActionRecordingVoiceNote == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatActionRecordingVoiceNote.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatActionRecordingVoiceNote.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatActionRecordingVoiceNote *ChatActionRecordingVoiceNote) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatActionRecordingVoiceNote.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatActionRecordingVoiceNote.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatActionRecordingVoiceNote *ChatActionRecordingVoiceNote) Clone() *ChatActionRecordingVoiceNote {
+	if chatActionRecordingVoiceNote == nil {
+		return nil
+	}
+	clone := *chatActionRecordingVoiceNote
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatActionRecordingVoiceNote *ChatActionRecordingVoiceNote) Equal(other *ChatActionRecordingVoiceNote) bool {
+	if chatActionRecordingVoiceNote == nil || other == nil {
+		return chatActionRecordingVoiceNote == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatActionRecordingVoiceNote *ChatActionRecordingVoiceNote) Diff(other *ChatActionRecordingVoiceNote) []Change {
+	return chatActionRecordingVoiceNote.diff("", other, nil)
+}
+
+func (chatActionRecordingVoiceNote *ChatActionRecordingVoiceNote) diff(path string, other *ChatActionRecordingVoiceNote, changes []Change) []Change {
+	if chatActionRecordingVoiceNote == nil || other == nil {
+		if chatActionRecordingVoiceNote != other {
+			changes = append(changes, Change{Path: path, Old: chatActionRecordingVoiceNote, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatActionRecordingVoiceNote *ChatActionRecordingVoiceNote) String() string {
+	return objectString(chatActionRecordingVoiceNote)
+}
+
+// LogValue logs the object as its summary
+func (chatActionRecordingVoiceNote *ChatActionRecordingVoiceNote) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatActionRecordingVoiceNote))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatActionRecordingVoiceNote *ChatActionRecordingVoiceNote) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatActionRecordingVoiceNote)
+}
+
+func (chatActionRecordingVoiceNote *ChatActionRecordingVoiceNote) printText(p *printer) {
+	if chatActionRecordingVoiceNote == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatActionRecordingVoiceNote")
+	p.endObject(state)
+}
+
 // GetChatActionEnum return the enum type of this object
 func (chatActionRecordingVoiceNote *ChatActionRecordingVoiceNote) GetChatActionEnum() ChatActionEnum {
 	return ChatActionRecordingVoiceNoteType
 }
 
+// Accept calls the method of the visitor for ChatActionRecordingVoiceNote
+func (chatActionRecordingVoiceNote *ChatActionRecordingVoiceNote) Accept(visitor ChatActionVisitor) {
+	visitor.VisitChatActionRecordingVoiceNote(chatActionRecordingVoiceNote)
+}
+
 // ChatActionUploadingVoiceNote The user is uploading a voice note
 type ChatActionUploadingVoiceNote struct {
 	tdCommon
@@ -250,11 +695,118 @@ func NewChatActionUploadingVoiceNote(progress int32) *ChatActionUploadingVoiceNo
 	return &chatActionUploadingVoiceNoteTemp
 }
 
+// MarshalJSON marshals to json
+func (chatActionUploadingVoiceNote *ChatActionUploadingVoiceNote) MarshalJSON() ([]byte, error) {
+	return chatActionUploadingVoiceNote.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatActionUploadingVoiceNote *ChatActionUploadingVoiceNote) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatActionUploadingVoiceNote)
+}
+
+func (chatActionUploadingVoiceNote *ChatActionUploadingVoiceNote) appendJSON(b []byte) ([]byte, error) {
+	if chatActionUploadingVoiceNote == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatActionUploadingVoiceNote.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatActionUploadingVoiceNote.tdCommon.Extra)
+	b = append(b, `,"progress":`...)
+	b = appendJSONInt(b, int64(chatActionUploadingVoiceNote.Progress))
+	return append(b, '}'), nil
+}
+
+func (chatActionUploadingVoiceNote *ChatActionUploadingVoiceNote) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatActionUploadingVoiceNote.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatActionUploadingVoiceNote.tdCommon.Extra, err = r.readString()
+		case "progress":
+			chatActionUploadingVoiceNote.Progress, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatActionUploadingVoiceNote *ChatActionUploadingVoiceNote) Clone() *ChatActionUploadingVoiceNote {
+	if chatActionUploadingVoiceNote == nil {
+		return nil
+	}
+	clone := *chatActionUploadingVoiceNote
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatActionUploadingVoiceNote *ChatActionUploadingVoiceNote) Equal(other *ChatActionUploadingVoiceNote) bool {
+	if chatActionUploadingVoiceNote == nil || other == nil {
+		return chatActionUploadingVoiceNote == other
+	}
+	if chatActionUploadingVoiceNote.Progress != other.Progress {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatActionUploadingVoiceNote *ChatActionUploadingVoiceNote) Diff(other *ChatActionUploadingVoiceNote) []Change {
+	return chatActionUploadingVoiceNote.diff("", other, nil)
+}
+
+func (chatActionUploadingVoiceNote *ChatActionUploadingVoiceNote) diff(path string, other *ChatActionUploadingVoiceNote, changes []Change) []Change {
+	if chatActionUploadingVoiceNote == nil || other == nil {
+		if chatActionUploadingVoiceNote != other {
+			changes = append(changes, Change{Path: path, Old: chatActionUploadingVoiceNote, New: other})
+		}
+		return changes
+	}
+	if chatActionUploadingVoiceNote.Progress != other.Progress {
+		changes = append(changes, Change{Path: fieldPath(path, "progress"), Old: chatActionUploadingVoiceNote.Progress, New: other.Progress})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatActionUploadingVoiceNote *ChatActionUploadingVoiceNote) String() string {
+	return objectString(chatActionUploadingVoiceNote)
+}
+
+// LogValue logs the object as its summary
+func (chatActionUploadingVoiceNote *ChatActionUploadingVoiceNote) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatActionUploadingVoiceNote))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatActionUploadingVoiceNote *ChatActionUploadingVoiceNote) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatActionUploadingVoiceNote)
+}
+
+func (chatActionUploadingVoiceNote *ChatActionUploadingVoiceNote) printText(p *printer) {
+	if chatActionUploadingVoiceNote == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatActionUploadingVoiceNote")
+	p.int("progress", int64(chatActionUploadingVoiceNote.Progress))
+	p.endObject(state)
+}
+
 // GetChatActionEnum return the enum type of this object
 func (chatActionUploadingVoiceNote *ChatActionUploadingVoiceNote) GetChatActionEnum() ChatActionEnum {
 	return ChatActionUploadingVoiceNoteType
 }
 
+// Accept calls the method of the visitor for ChatActionUploadingVoiceNote
+func (chatActionUploadingVoiceNote *ChatActionUploadingVoiceNote) Accept(visitor ChatActionVisitor) {
+	visitor.VisitChatActionUploadingVoiceNote(chatActionUploadingVoiceNote)
+}
+
 // ChatActionUploadingPhoto The user is uploading a photo
 type ChatActionUploadingPhoto struct {
 	tdCommon
@@ -278,11 +830,118 @@ func NewChatActionUploadingPhoto(progress int32) *ChatActionUploadingPhoto {
 	return &chatActionUploadingPhotoTemp
 }
 
+// MarshalJSON marshals to json
+func (chatActionUploadingPhoto *ChatActionUploadingPhoto) MarshalJSON() ([]byte, error) {
+	return chatActionUploadingPhoto.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatActionUploadingPhoto *ChatActionUploadingPhoto) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatActionUploadingPhoto)
+}
+
+func (chatActionUploadingPhoto *ChatActionUploadingPhoto) appendJSON(b []byte) ([]byte, error) {
+	if chatActionUploadingPhoto == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatActionUploadingPhoto.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatActionUploadingPhoto.tdCommon.Extra)
+	b = append(b, `,"progress":`...)
+	b = appendJSONInt(b, int64(chatActionUploadingPhoto.Progress))
+	return append(b, '}'), nil
+}
+
+func (chatActionUploadingPhoto *ChatActionUploadingPhoto) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatActionUploadingPhoto.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatActionUploadingPhoto.tdCommon.Extra, err = r.readString()
+		case "progress":
+			chatActionUploadingPhoto.Progress, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatActionUploadingPhoto *ChatActionUploadingPhoto) Clone() *ChatActionUploadingPhoto {
+	if chatActionUploadingPhoto == nil {
+		return nil
+	}
+	clone := *chatActionUploadingPhoto
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatActionUploadingPhoto *ChatActionUploadingPhoto) Equal(other *ChatActionUploadingPhoto) bool {
+	if chatActionUploadingPhoto == nil || other == nil {
+		return chatActionUploadingPhoto == other
+	}
+	if chatActionUploadingPhoto.Progress != other.Progress {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatActionUploadingPhoto *ChatActionUploadingPhoto) Diff(other *ChatActionUploadingPhoto) []Change {
+	return chatActionUploadingPhoto.diff("", other, nil)
+}
+
+func (chatActionUploadingPhoto *ChatActionUploadingPhoto) diff(path string, other *ChatActionUploadingPhoto, changes []Change) []Change {
+	if chatActionUploadingPhoto == nil || other == nil {
+		if chatActionUploadingPhoto != other {
+			changes = append(changes, Change{Path: path, Old: chatActionUploadingPhoto, New: other})
+		}
+		return changes
+	}
+	if chatActionUploadingPhoto.Progress != other.Progress {
+		changes = append(changes, Change{Path: fieldPath(path, "progress"), Old: chatActionUploadingPhoto.Progress, New: other.Progress})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatActionUploadingPhoto *ChatActionUploadingPhoto) String() string {
+	return objectString(chatActionUploadingPhoto)
+}
+
+// LogValue logs the object as its summary
+func (chatActionUploadingPhoto *ChatActionUploadingPhoto) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatActionUploadingPhoto))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatActionUploadingPhoto *ChatActionUploadingPhoto) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatActionUploadingPhoto)
+}
+
+func (chatActionUploadingPhoto *ChatActionUploadingPhoto) printText(p *printer) {
+	if chatActionUploadingPhoto == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatActionUploadingPhoto")
+	p.int("progress", int64(chatActionUploadingPhoto.Progress))
+	p.endObject(state)
+}
+
 // GetChatActionEnum return the enum type of this object
 func (chatActionUploadingPhoto *ChatActionUploadingPhoto) GetChatActionEnum() ChatActionEnum {
 	return ChatActionUploadingPhotoType
 }
 
+// Accept calls the method of the visitor for ChatActionUploadingPhoto
+func (chatActionUploadingPhoto *ChatActionUploadingPhoto) Accept(visitor ChatActionVisitor) {
+	visitor.VisitChatActionUploadingPhoto(chatActionUploadingPhoto)
+}
+
 // ChatActionUploadingDocument The user is uploading a document
 type ChatActionUploadingDocument struct {
 	tdCommon
@@ -306,11 +965,118 @@ func NewChatActionUploadingDocument(progress int32) *ChatActionUploadingDocument
 	return &chatActionUploadingDocumentTemp
 }
 
+// MarshalJSON marshals to json
+func (chatActionUploadingDocument *ChatActionUploadingDocument) MarshalJSON() ([]byte, error) {
+	return chatActionUploadingDocument.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatActionUploadingDocument *ChatActionUploadingDocument) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatActionUploadingDocument)
+}
+
+func (chatActionUploadingDocument *ChatActionUploadingDocument) appendJSON(b []byte) ([]byte, error) {
+	if chatActionUploadingDocument == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatActionUploadingDocument.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatActionUploadingDocument.tdCommon.Extra)
+	b = append(b, `,"progress":`...)
+	b = appendJSONInt(b, int64(chatActionUploadingDocument.Progress))
+	return append(b, '}'), nil
+}
+
+func (chatActionUploadingDocument *ChatActionUploadingDocument) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatActionUploadingDocument.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatActionUploadingDocument.tdCommon.Extra, err = r.readString()
+		case "progress":
+			chatActionUploadingDocument.Progress, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatActionUploadingDocument *ChatActionUploadingDocument) Clone() *ChatActionUploadingDocument {
+	if chatActionUploadingDocument == nil {
+		return nil
+	}
+	clone := *chatActionUploadingDocument
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatActionUploadingDocument *ChatActionUploadingDocument) Equal(other *ChatActionUploadingDocument) bool {
+	if chatActionUploadingDocument == nil || other == nil {
+		return chatActionUploadingDocument == other
+	}
+	if chatActionUploadingDocument.Progress != other.Progress {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatActionUploadingDocument *ChatActionUploadingDocument) Diff(other *ChatActionUploadingDocument) []Change {
+	return chatActionUploadingDocument.diff("", other, nil)
+}
+
+func (chatActionUploadingDocument *ChatActionUploadingDocument) diff(path string, other *ChatActionUploadingDocument, changes []Change) []Change {
+	if chatActionUploadingDocument == nil || other == nil {
+		if chatActionUploadingDocument != other {
+			changes = append(changes, Change{Path: path, Old: chatActionUploadingDocument, New: other})
+		}
+		return changes
+	}
+	if chatActionUploadingDocument.Progress != other.Progress {
+		changes = append(changes, Change{Path: fieldPath(path, "progress"), Old: chatActionUploadingDocument.Progress, New: other.Progress})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatActionUploadingDocument *ChatActionUploadingDocument) String() string {
+	return objectString(chatActionUploadingDocument)
+}
+
+// LogValue logs the object as its summary
+func (chatActionUploadingDocument *ChatActionUploadingDocument) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatActionUploadingDocument))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatActionUploadingDocument *ChatActionUploadingDocument) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatActionUploadingDocument)
+}
+
+func (chatActionUploadingDocument *ChatActionUploadingDocument) printText(p *printer) {
+	if chatActionUploadingDocument == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatActionUploadingDocument")
+	p.int("progress", int64(chatActionUploadingDocument.Progress))
+	p.endObject(state)
+}
+
 // GetChatActionEnum return the enum type of this object
 func (chatActionUploadingDocument *ChatActionUploadingDocument) GetChatActionEnum() ChatActionEnum {
 	return ChatActionUploadingDocumentType
 }
 
+// Accept calls the method of the visitor for ChatActionUploadingDocument
+func (chatActionUploadingDocument *ChatActionUploadingDocument) Accept(visitor ChatActionVisitor) {
+	visitor.VisitChatActionUploadingDocument(chatActionUploadingDocument)
+}
+
 // ChatActionChoosingSticker The user is picking a sticker to send
 type ChatActionChoosingSticker struct {
 	tdCommon
@@ -322,7 +1088,6 @@ func (chatActionChoosingSticker *ChatActionChoosingSticker) MessageType() string
 }
 
 // NewChatActionChoosingSticker creates a new ChatActionChoosingSticker
-//
 func NewChatActionChoosingSticker() *ChatActionChoosingSticker {
 	chatActionChoosingStickerTemp := ChatActionChoosingSticker{
 		tdCommon: tdCommon{Type: "chatActionChoosingSticker"},
@@ -331,14 +1096,110 @@ func NewChatActionChoosingSticker() *ChatActionChoosingSticker {
 	return &chatActionChoosingStickerTemp
 }
 
-// GetChatActionEnum return the enum type of this object
-func (chatActionChoosingSticker *ChatActionChoosingSticker) GetChatActionEnum() ChatActionEnum {
-	return ChatActionChoosingStickerType
+// MarshalJSON marshals to json
+func (chatActionChoosingSticker *ChatActionChoosingSticker) MarshalJSON() ([]byte, error) {
+	return chatActionChoosingSticker.appendJSON(nil)
 }
 
-// ChatActionChoosingLocation The user is picking a location or venue to send
-type ChatActionChoosingLocation struct {
-	tdCommon
+// UnmarshalJSON unmarshal to json
+func (chatActionChoosingSticker *ChatActionChoosingSticker) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatActionChoosingSticker)
+}
+
+func (chatActionChoosingSticker *ChatActionChoosingSticker) appendJSON(b []byte) ([]byte, error) {
+	if chatActionChoosingSticker == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatActionChoosingSticker.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatActionChoosingSticker.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatActionChoosingSticker *ChatActionChoosingSticker) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatActionChoosingSticker.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatActionChoosingSticker.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatActionChoosingSticker *ChatActionChoosingSticker) Clone() *ChatActionChoosingSticker {
+	if chatActionChoosingSticker == nil {
+		return nil
+	}
+	clone := *chatActionChoosingSticker
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatActionChoosingSticker *ChatActionChoosingSticker) Equal(other *ChatActionChoosingSticker) bool {
+	if chatActionChoosingSticker == nil || other == nil {
+		return chatActionChoosingSticker == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatActionChoosingSticker *ChatActionChoosingSticker) Diff(other *ChatActionChoosingSticker) []Change {
+	return chatActionChoosingSticker.diff("", other, nil)
+}
+
+func (chatActionChoosingSticker *ChatActionChoosingSticker) diff(path string, other *ChatActionChoosingSticker, changes []Change) []Change {
+	if chatActionChoosingSticker == nil || other == nil {
+		if chatActionChoosingSticker != other {
+			changes = append(changes, Change{Path: path, Old: chatActionChoosingSticker, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatActionChoosingSticker *ChatActionChoosingSticker) String() string {
+	return objectString(chatActionChoosingSticker)
+}
+
+// LogValue logs the object as its summary
+func (chatActionChoosingSticker *ChatActionChoosingSticker) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatActionChoosingSticker))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatActionChoosingSticker *ChatActionChoosingSticker) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatActionChoosingSticker)
+}
+
+func (chatActionChoosingSticker *ChatActionChoosingSticker) printText(p *printer) {
+	if chatActionChoosingSticker == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatActionChoosingSticker")
+	p.endObject(state)
+}
+
+// GetChatActionEnum return the enum type of this object
+func (chatActionChoosingSticker *ChatActionChoosingSticker) GetChatActionEnum() ChatActionEnum {
+	return ChatActionChoosingStickerType
+}
+
+// Accept calls the method of the visitor for ChatActionChoosingSticker
+func (chatActionChoosingSticker *ChatActionChoosingSticker) Accept(visitor ChatActionVisitor) {
+	visitor.VisitChatActionChoosingSticker(chatActionChoosingSticker)
+}
+
+// ChatActionChoosingLocation The user is picking a location or venue to send
+type ChatActionChoosingLocation struct {
+	tdCommon
 }
 
 // MessageType return the string telegram-type of ChatActionChoosingLocation
@@ -347,7 +1208,6 @@ func (chatActionChoosingLocation *ChatActionChoosingLocation) MessageType() stri
 }
 
 // NewChatActionChoosingLocation creates a new ChatActionChoosingLocation
-//
 func NewChatActionChoosingLocation() *ChatActionChoosingLocation {
 	chatActionChoosingLocationTemp := ChatActionChoosingLocation{
 		tdCommon: tdCommon{Type: "chatActionChoosingLocation"},
@@ -356,11 +1216,107 @@ func NewChatActionChoosingLocation() *ChatActionChoosingLocation {
 	return &chatActionChoosingLocationTemp
 }
 
+// MarshalJSON marshals to json
+func (chatActionChoosingLocation *ChatActionChoosingLocation) MarshalJSON() ([]byte, error) {
+	return chatActionChoosingLocation.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatActionChoosingLocation *ChatActionChoosingLocation) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatActionChoosingLocation)
+}
+
+func (chatActionChoosingLocation *ChatActionChoosingLocation) appendJSON(b []byte) ([]byte, error) {
+	if chatActionChoosingLocation == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatActionChoosingLocation.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatActionChoosingLocation.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatActionChoosingLocation *ChatActionChoosingLocation) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatActionChoosingLocation.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatActionChoosingLocation.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatActionChoosingLocation *ChatActionChoosingLocation) Clone() *ChatActionChoosingLocation {
+	if chatActionChoosingLocation == nil {
+		return nil
+	}
+	clone := *chatActionChoosingLocation
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatActionChoosingLocation *ChatActionChoosingLocation) Equal(other *ChatActionChoosingLocation) bool {
+	if chatActionChoosingLocation == nil || other == nil {
+		return chatActionChoosingLocation == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatActionChoosingLocation *ChatActionChoosingLocation) Diff(other *ChatActionChoosingLocation) []Change {
+	return chatActionChoosingLocation.diff("", other, nil)
+}
+
+func (chatActionChoosingLocation *ChatActionChoosingLocation) diff(path string, other *ChatActionChoosingLocation, changes []Change) []Change {
+	if chatActionChoosingLocation == nil || other == nil {
+		if chatActionChoosingLocation != other {
+			changes = append(changes, Change{Path: path, Old: chatActionChoosingLocation, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatActionChoosingLocation *ChatActionChoosingLocation) String() string {
+	return objectString(chatActionChoosingLocation)
+}
+
+// LogValue logs the object as its summary
+func (chatActionChoosingLocation *ChatActionChoosingLocation) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatActionChoosingLocation))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatActionChoosingLocation *ChatActionChoosingLocation) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatActionChoosingLocation)
+}
+
+func (chatActionChoosingLocation *ChatActionChoosingLocation) printText(p *printer) {
+	if chatActionChoosingLocation == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatActionChoosingLocation")
+	p.endObject(state)
+}
+
 // GetChatActionEnum return the enum type of this object
 func (chatActionChoosingLocation *ChatActionChoosingLocation) GetChatActionEnum() ChatActionEnum {
 	return ChatActionChoosingLocationType
 }
 
+// Accept calls the method of the visitor for ChatActionChoosingLocation
+func (chatActionChoosingLocation *ChatActionChoosingLocation) Accept(visitor ChatActionVisitor) {
+	visitor.VisitChatActionChoosingLocation(chatActionChoosingLocation)
+}
+
 // ChatActionChoosingContact The user is picking a contact to send
 type ChatActionChoosingContact struct {
 	tdCommon
@@ -372,7 +1328,6 @@ func (chatActionChoosingContact *ChatActionChoosingContact) MessageType() string
 }
 
 // NewChatActionChoosingContact creates a new ChatActionChoosingContact
-//
 func NewChatActionChoosingContact() *ChatActionChoosingContact {
 	chatActionChoosingContactTemp := ChatActionChoosingContact{
 		tdCommon: tdCommon{Type: "chatActionChoosingContact"},
@@ -381,11 +1336,107 @@ func NewChatActionChoosingContact() *ChatActionChoosingContact {
 	return &chatActionChoosingContactTemp
 }
 
+// MarshalJSON marshals to json
+func (chatActionChoosingContact *ChatActionChoosingContact) MarshalJSON() ([]byte, error) {
+	return chatActionChoosingContact.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatActionChoosingContact *ChatActionChoosingContact) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatActionChoosingContact)
+}
+
+func (chatActionChoosingContact *ChatActionChoosingContact) appendJSON(b []byte) ([]byte, error) {
+	if chatActionChoosingContact == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatActionChoosingContact.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatActionChoosingContact.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatActionChoosingContact *ChatActionChoosingContact) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatActionChoosingContact.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatActionChoosingContact.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatActionChoosingContact *ChatActionChoosingContact) Clone() *ChatActionChoosingContact {
+	if chatActionChoosingContact == nil {
+		return nil
+	}
+	clone := *chatActionChoosingContact
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatActionChoosingContact *ChatActionChoosingContact) Equal(other *ChatActionChoosingContact) bool {
+	if chatActionChoosingContact == nil || other == nil {
+		return chatActionChoosingContact == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatActionChoosingContact *ChatActionChoosingContact) Diff(other *ChatActionChoosingContact) []Change {
+	return chatActionChoosingContact.diff("", other, nil)
+}
+
+func (chatActionChoosingContact *ChatActionChoosingContact) diff(path string, other *ChatActionChoosingContact, changes []Change) []Change {
+	if chatActionChoosingContact == nil || other == nil {
+		if chatActionChoosingContact != other {
+			changes = append(changes, Change{Path: path, Old: chatActionChoosingContact, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatActionChoosingContact *ChatActionChoosingContact) String() string {
+	return objectString(chatActionChoosingContact)
+}
+
+// LogValue logs the object as its summary
+func (chatActionChoosingContact *ChatActionChoosingContact) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatActionChoosingContact))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatActionChoosingContact *ChatActionChoosingContact) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatActionChoosingContact)
+}
+
+func (chatActionChoosingContact *ChatActionChoosingContact) printText(p *printer) {
+	if chatActionChoosingContact == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatActionChoosingContact")
+	p.endObject(state)
+}
+
 // GetChatActionEnum return the enum type of this object
 func (chatActionChoosingContact *ChatActionChoosingContact) GetChatActionEnum() ChatActionEnum {
 	return ChatActionChoosingContactType
 }
 
+// Accept calls the method of the visitor for ChatActionChoosingContact
+func (chatActionChoosingContact *ChatActionChoosingContact) Accept(visitor ChatActionVisitor) {
+	visitor.VisitChatActionChoosingContact(chatActionChoosingContact)
+}
+
 // ChatActionStartPlayingGame The user has started to play a game
 type ChatActionStartPlayingGame struct {
 	tdCommon
@@ -397,7 +1448,6 @@ func (chatActionStartPlayingGame *ChatActionStartPlayingGame) MessageType() stri
 }
 
 // NewChatActionStartPlayingGame creates a new ChatActionStartPlayingGame
-//
 func NewChatActionStartPlayingGame() *ChatActionStartPlayingGame {
 	chatActionStartPlayingGameTemp := ChatActionStartPlayingGame{
 		tdCommon: tdCommon{Type: "chatActionStartPlayingGame"},
@@ -406,11 +1456,107 @@ func NewChatActionStartPlayingGame() *ChatActionStartPlayingGame {
 	return &chatActionStartPlayingGameTemp
 }
 
+// MarshalJSON marshals to json
+func (chatActionStartPlayingGame *ChatActionStartPlayingGame) MarshalJSON() ([]byte, error) {
+	return chatActionStartPlayingGame.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatActionStartPlayingGame *ChatActionStartPlayingGame) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatActionStartPlayingGame)
+}
+
+func (chatActionStartPlayingGame *ChatActionStartPlayingGame) appendJSON(b []byte) ([]byte, error) {
+	if chatActionStartPlayingGame == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatActionStartPlayingGame.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatActionStartPlayingGame.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatActionStartPlayingGame *ChatActionStartPlayingGame) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatActionStartPlayingGame.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatActionStartPlayingGame.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatActionStartPlayingGame *ChatActionStartPlayingGame) Clone() *ChatActionStartPlayingGame {
+	if chatActionStartPlayingGame == nil {
+		return nil
+	}
+	clone := *chatActionStartPlayingGame
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatActionStartPlayingGame *ChatActionStartPlayingGame) Equal(other *ChatActionStartPlayingGame) bool {
+	if chatActionStartPlayingGame == nil || other == nil {
+		return chatActionStartPlayingGame == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatActionStartPlayingGame *ChatActionStartPlayingGame) Diff(other *ChatActionStartPlayingGame) []Change {
+	return chatActionStartPlayingGame.diff("", other, nil)
+}
+
+func (chatActionStartPlayingGame *ChatActionStartPlayingGame) diff(path string, other *ChatActionStartPlayingGame, changes []Change) []Change {
+	if chatActionStartPlayingGame == nil || other == nil {
+		if chatActionStartPlayingGame != other {
+			changes = append(changes, Change{Path: path, Old: chatActionStartPlayingGame, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatActionStartPlayingGame *ChatActionStartPlayingGame) String() string {
+	return objectString(chatActionStartPlayingGame)
+}
+
+// LogValue logs the object as its summary
+func (chatActionStartPlayingGame *ChatActionStartPlayingGame) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatActionStartPlayingGame))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatActionStartPlayingGame *ChatActionStartPlayingGame) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatActionStartPlayingGame)
+}
+
+func (chatActionStartPlayingGame *ChatActionStartPlayingGame) printText(p *printer) {
+	if chatActionStartPlayingGame == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatActionStartPlayingGame")
+	p.endObject(state)
+}
+
 // GetChatActionEnum return the enum type of this object
 func (chatActionStartPlayingGame *ChatActionStartPlayingGame) GetChatActionEnum() ChatActionEnum {
 	return ChatActionStartPlayingGameType
 }
 
+// Accept calls the method of the visitor for ChatActionStartPlayingGame
+func (chatActionStartPlayingGame *ChatActionStartPlayingGame) Accept(visitor ChatActionVisitor) {
+	visitor.VisitChatActionStartPlayingGame(chatActionStartPlayingGame)
+}
+
 // ChatActionRecordingVideoNote The user is recording a video note
 type ChatActionRecordingVideoNote struct {
 	tdCommon
@@ -422,7 +1568,6 @@ func (chatActionRecordingVideoNote *ChatActionRecordingVideoNote) MessageType()
 }
 
 // NewChatActionRecordingVideoNote creates a new ChatActionRecordingVideoNote
-//
 func NewChatActionRecordingVideoNote() *ChatActionRecordingVideoNote {
 	chatActionRecordingVideoNoteTemp := ChatActionRecordingVideoNote{
 		tdCommon: tdCommon{Type: "chatActionRecordingVideoNote"},
@@ -431,11 +1576,107 @@ func NewChatActionRecordingVideoNote() *ChatActionRecordingVideoNote {
 	return &chatActionRecordingVideoNoteTemp
 }
 
+// MarshalJSON marshals to json
+func (chatActionRecordingVideoNote *ChatActionRecordingVideoNote) MarshalJSON() ([]byte, error) {
+	return chatActionRecordingVideoNote.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatActionRecordingVideoNote *ChatActionRecordingVideoNote) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatActionRecordingVideoNote)
+}
+
+func (chatActionRecordingVideoNote *ChatActionRecordingVideoNote) appendJSON(b []byte) ([]byte, error) {
+	if chatActionRecordingVideoNote == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatActionRecordingVideoNote.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatActionRecordingVideoNote.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatActionRecordingVideoNote *ChatActionRecordingVideoNote) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatActionRecordingVideoNote.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatActionRecordingVideoNote.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatActionRecordingVideoNote *ChatActionRecordingVideoNote) Clone() *ChatActionRecordingVideoNote {
+	if chatActionRecordingVideoNote == nil {
+		return nil
+	}
+	clone := *chatActionRecordingVideoNote
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatActionRecordingVideoNote *ChatActionRecordingVideoNote) Equal(other *ChatActionRecordingVideoNote) bool {
+	if chatActionRecordingVideoNote == nil || other == nil {
+		return chatActionRecordingVideoNote == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatActionRecordingVideoNote *ChatActionRecordingVideoNote) Diff(other *ChatActionRecordingVideoNote) []Change {
+	return chatActionRecordingVideoNote.diff("", other, nil)
+}
+
+func (chatActionRecordingVideoNote *ChatActionRecordingVideoNote) diff(path string, other *ChatActionRecordingVideoNote, changes []Change) []Change {
+	if chatActionRecordingVideoNote == nil || other == nil {
+		if chatActionRecordingVideoNote != other {
+			changes = append(changes, Change{Path: path, Old: chatActionRecordingVideoNote, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatActionRecordingVideoNote *ChatActionRecordingVideoNote) String() string {
+	return objectString(chatActionRecordingVideoNote)
+}
+
+// LogValue logs the object as its summary
+func (chatActionRecordingVideoNote *ChatActionRecordingVideoNote) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatActionRecordingVideoNote))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatActionRecordingVideoNote *ChatActionRecordingVideoNote) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatActionRecordingVideoNote)
+}
+
+func (chatActionRecordingVideoNote *ChatActionRecordingVideoNote) printText(p *printer) {
+	if chatActionRecordingVideoNote == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatActionRecordingVideoNote")
+	p.endObject(state)
+}
+
 // GetChatActionEnum return the enum type of this object
 func (chatActionRecordingVideoNote *ChatActionRecordingVideoNote) GetChatActionEnum() ChatActionEnum {
 	return ChatActionRecordingVideoNoteType
 }
 
+// Accept calls the method of the visitor for ChatActionRecordingVideoNote
+func (chatActionRecordingVideoNote *ChatActionRecordingVideoNote) Accept(visitor ChatActionVisitor) {
+	visitor.VisitChatActionRecordingVideoNote(chatActionRecordingVideoNote)
+}
+
 // ChatActionUploadingVideoNote The user is uploading a video note
 type ChatActionUploadingVideoNote struct {
 	tdCommon
@@ -459,11 +1700,118 @@ func NewChatActionUploadingVideoNote(progress int32) *ChatActionUploadingVideoNo
 	return &chatActionUploadingVideoNoteTemp
 }
 
+// MarshalJSON marshals to json
+func (chatActionUploadingVideoNote *ChatActionUploadingVideoNote) MarshalJSON() ([]byte, error) {
+	return chatActionUploadingVideoNote.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatActionUploadingVideoNote *ChatActionUploadingVideoNote) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatActionUploadingVideoNote)
+}
+
+func (chatActionUploadingVideoNote *ChatActionUploadingVideoNote) appendJSON(b []byte) ([]byte, error) {
+	if chatActionUploadingVideoNote == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatActionUploadingVideoNote.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatActionUploadingVideoNote.tdCommon.Extra)
+	b = append(b, `,"progress":`...)
+	b = appendJSONInt(b, int64(chatActionUploadingVideoNote.Progress))
+	return append(b, '}'), nil
+}
+
+func (chatActionUploadingVideoNote *ChatActionUploadingVideoNote) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatActionUploadingVideoNote.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatActionUploadingVideoNote.tdCommon.Extra, err = r.readString()
+		case "progress":
+			chatActionUploadingVideoNote.Progress, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatActionUploadingVideoNote *ChatActionUploadingVideoNote) Clone() *ChatActionUploadingVideoNote {
+	if chatActionUploadingVideoNote == nil {
+		return nil
+	}
+	clone := *chatActionUploadingVideoNote
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatActionUploadingVideoNote *ChatActionUploadingVideoNote) Equal(other *ChatActionUploadingVideoNote) bool {
+	if chatActionUploadingVideoNote == nil || other == nil {
+		return chatActionUploadingVideoNote == other
+	}
+	if chatActionUploadingVideoNote.Progress != other.Progress {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatActionUploadingVideoNote *ChatActionUploadingVideoNote) Diff(other *ChatActionUploadingVideoNote) []Change {
+	return chatActionUploadingVideoNote.diff("", other, nil)
+}
+
+func (chatActionUploadingVideoNote *ChatActionUploadingVideoNote) diff(path string, other *ChatActionUploadingVideoNote, changes []Change) []Change {
+	if chatActionUploadingVideoNote == nil || other == nil {
+		if chatActionUploadingVideoNote != other {
+			changes = append(changes, Change{Path: path, Old: chatActionUploadingVideoNote, New: other})
+		}
+		return changes
+	}
+	if chatActionUploadingVideoNote.Progress != other.Progress {
+		changes = append(changes, Change{Path: fieldPath(path, "progress"), Old: chatActionUploadingVideoNote.Progress, New: other.Progress})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatActionUploadingVideoNote *ChatActionUploadingVideoNote) String() string {
+	return objectString(chatActionUploadingVideoNote)
+}
+
+// LogValue logs the object as its summary
+func (chatActionUploadingVideoNote *ChatActionUploadingVideoNote) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatActionUploadingVideoNote))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatActionUploadingVideoNote *ChatActionUploadingVideoNote) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatActionUploadingVideoNote)
+}
+
+func (chatActionUploadingVideoNote *ChatActionUploadingVideoNote) printText(p *printer) {
+	if chatActionUploadingVideoNote == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatActionUploadingVideoNote")
+	p.int("progress", int64(chatActionUploadingVideoNote.Progress))
+	p.endObject(state)
+}
+
 // GetChatActionEnum return the enum type of this object
 func (chatActionUploadingVideoNote *ChatActionUploadingVideoNote) GetChatActionEnum() ChatActionEnum {
 	return ChatActionUploadingVideoNoteType
 }
 
+// Accept calls the method of the visitor for ChatActionUploadingVideoNote
+func (chatActionUploadingVideoNote *ChatActionUploadingVideoNote) Accept(visitor ChatActionVisitor) {
+	visitor.VisitChatActionUploadingVideoNote(chatActionUploadingVideoNote)
+}
+
 // ChatActionWatchingAnimations The user is watching animations sent by the other party by clicking on an animated emoji
 type ChatActionWatchingAnimations struct {
 	tdCommon
@@ -487,11 +1835,118 @@ func NewChatActionWatchingAnimations(emoji string) *ChatActionWatchingAnimations
 	return &chatActionWatchingAnimationsTemp
 }
 
+// MarshalJSON marshals to json
+func (chatActionWatchingAnimations *ChatActionWatchingAnimations) MarshalJSON() ([]byte, error) {
+	return chatActionWatchingAnimations.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatActionWatchingAnimations *ChatActionWatchingAnimations) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatActionWatchingAnimations)
+}
+
+func (chatActionWatchingAnimations *ChatActionWatchingAnimations) appendJSON(b []byte) ([]byte, error) {
+	if chatActionWatchingAnimations == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatActionWatchingAnimations.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatActionWatchingAnimations.tdCommon.Extra)
+	b = append(b, `,"emoji":`...)
+	b = appendJSONString(b, chatActionWatchingAnimations.Emoji)
+	return append(b, '}'), nil
+}
+
+func (chatActionWatchingAnimations *ChatActionWatchingAnimations) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatActionWatchingAnimations.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatActionWatchingAnimations.tdCommon.Extra, err = r.readString()
+		case "emoji":
+			chatActionWatchingAnimations.Emoji, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatActionWatchingAnimations *ChatActionWatchingAnimations) Clone() *ChatActionWatchingAnimations {
+	if chatActionWatchingAnimations == nil {
+		return nil
+	}
+	clone := *chatActionWatchingAnimations
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatActionWatchingAnimations *ChatActionWatchingAnimations) Equal(other *ChatActionWatchingAnimations) bool {
+	if chatActionWatchingAnimations == nil || other == nil {
+		return chatActionWatchingAnimations == other
+	}
+	if chatActionWatchingAnimations.Emoji != other.Emoji {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatActionWatchingAnimations *ChatActionWatchingAnimations) Diff(other *ChatActionWatchingAnimations) []Change {
+	return chatActionWatchingAnimations.diff("", other, nil)
+}
+
+func (chatActionWatchingAnimations *ChatActionWatchingAnimations) diff(path string, other *ChatActionWatchingAnimations, changes []Change) []Change {
+	if chatActionWatchingAnimations == nil || other == nil {
+		if chatActionWatchingAnimations != other {
+			changes = append(changes, Change{Path: path, Old: chatActionWatchingAnimations, New: other})
+		}
+		return changes
+	}
+	if chatActionWatchingAnimations.Emoji != other.Emoji {
+		changes = append(changes, Change{Path: fieldPath(path, "emoji"), Old: chatActionWatchingAnimations.Emoji, New: other.Emoji})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatActionWatchingAnimations *ChatActionWatchingAnimations) String() string {
+	return objectString(chatActionWatchingAnimations)
+}
+
+// LogValue logs the object as its summary
+func (chatActionWatchingAnimations *ChatActionWatchingAnimations) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatActionWatchingAnimations))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatActionWatchingAnimations *ChatActionWatchingAnimations) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatActionWatchingAnimations)
+}
+
+func (chatActionWatchingAnimations *ChatActionWatchingAnimations) printText(p *printer) {
+	if chatActionWatchingAnimations == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatActionWatchingAnimations")
+	p.string("emoji", chatActionWatchingAnimations.Emoji)
+	p.endObject(state)
+}
+
 // GetChatActionEnum return the enum type of this object
 func (chatActionWatchingAnimations *ChatActionWatchingAnimations) GetChatActionEnum() ChatActionEnum {
 	return ChatActionWatchingAnimationsType
 }
 
+// Accept calls the method of the visitor for ChatActionWatchingAnimations
+func (chatActionWatchingAnimations *ChatActionWatchingAnimations) Accept(visitor ChatActionVisitor) {
+	visitor.VisitChatActionWatchingAnimations(chatActionWatchingAnimations)
+}
+
 // ChatActionCancel The user has canceled the previous action
 type ChatActionCancel struct {
 	tdCommon
@@ -503,7 +1958,6 @@ func (chatActionCancel *ChatActionCancel) MessageType() string {
 }
 
 // NewChatActionCancel creates a new ChatActionCancel
-//
 func NewChatActionCancel() *ChatActionCancel {
 	chatActionCancelTemp := ChatActionCancel{
 		tdCommon: tdCommon{Type: "chatActionCancel"},
@@ -512,7 +1966,393 @@ func NewChatActionCancel() *ChatActionCancel {
 	return &chatActionCancelTemp
 }
 
+// MarshalJSON marshals to json
+func (chatActionCancel *ChatActionCancel) MarshalJSON() ([]byte, error) {
+	return chatActionCancel.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatActionCancel *ChatActionCancel) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatActionCancel)
+}
+
+func (chatActionCancel *ChatActionCancel) appendJSON(b []byte) ([]byte, error) {
+	if chatActionCancel == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatActionCancel.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatActionCancel.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatActionCancel *ChatActionCancel) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatActionCancel.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatActionCancel.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatActionCancel *ChatActionCancel) Clone() *ChatActionCancel {
+	if chatActionCancel == nil {
+		return nil
+	}
+	clone := *chatActionCancel
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatActionCancel *ChatActionCancel) Equal(other *ChatActionCancel) bool {
+	if chatActionCancel == nil || other == nil {
+		return chatActionCancel == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatActionCancel *ChatActionCancel) Diff(other *ChatActionCancel) []Change {
+	return chatActionCancel.diff("", other, nil)
+}
+
+func (chatActionCancel *ChatActionCancel) diff(path string, other *ChatActionCancel, changes []Change) []Change {
+	if chatActionCancel == nil || other == nil {
+		if chatActionCancel != other {
+			changes = append(changes, Change{Path: path, Old: chatActionCancel, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatActionCancel *ChatActionCancel) String() string {
+	return objectString(chatActionCancel)
+}
+
+// LogValue logs the object as its summary
+func (chatActionCancel *ChatActionCancel) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatActionCancel))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatActionCancel *ChatActionCancel) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatActionCancel)
+}
+
+func (chatActionCancel *ChatActionCancel) printText(p *printer) {
+	if chatActionCancel == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatActionCancel")
+	p.endObject(state)
+}
+
 // GetChatActionEnum return the enum type of this object
 func (chatActionCancel *ChatActionCancel) GetChatActionEnum() ChatActionEnum {
 	return ChatActionCancelType
 }
+
+// Accept calls the method of the visitor for ChatActionCancel
+func (chatActionCancel *ChatActionCancel) Accept(visitor ChatActionVisitor) {
+	visitor.VisitChatActionCancel(chatActionCancel)
+}
+
+// ChatActionVisitor visits every type of ChatAction, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to ChatAction, until they handle it too.
+type ChatActionVisitor interface {
+	VisitChatActionTyping(chatActionTyping *ChatActionTyping)
+	VisitChatActionRecordingVideo(chatActionRecordingVideo *ChatActionRecordingVideo)
+	VisitChatActionUploadingVideo(chatActionUploadingVideo *ChatActionUploadingVideo)
+	VisitChatActionRecordingVoiceNote(chatActionRecordingVoiceNote *ChatActionRecordingVoiceNote)
+	VisitChatActionUploadingVoiceNote(chatActionUploadingVoiceNote *ChatActionUploadingVoiceNote)
+	VisitChatActionUploadingPhoto(chatActionUploadingPhoto *ChatActionUploadingPhoto)
+	VisitChatActionUploadingDocument(chatActionUploadingDocument *ChatActionUploadingDocument)
+	VisitChatActionChoosingSticker(chatActionChoosingSticker *ChatActionChoosingSticker)
+	VisitChatActionChoosingLocation(chatActionChoosingLocation *ChatActionChoosingLocation)
+	VisitChatActionChoosingContact(chatActionChoosingContact *ChatActionChoosingContact)
+	VisitChatActionStartPlayingGame(chatActionStartPlayingGame *ChatActionStartPlayingGame)
+	VisitChatActionRecordingVideoNote(chatActionRecordingVideoNote *ChatActionRecordingVideoNote)
+	VisitChatActionUploadingVideoNote(chatActionUploadingVideoNote *ChatActionUploadingVideoNote)
+	VisitChatActionWatchingAnimations(chatActionWatchingAnimations *ChatActionWatchingAnimations)
+	VisitChatActionCancel(chatActionCancel *ChatActionCancel)
+	VisitUnknownChatAction(unknownChatAction *UnknownChatAction)
+}
+
+// ChatActionCases holds the function MatchChatAction calls for each type of ChatAction;
+// types without a function are passed to the default case
+type ChatActionCases[R any] struct {
+	ChatActionTyping             func(chatActionTyping *ChatActionTyping) R
+	ChatActionRecordingVideo     func(chatActionRecordingVideo *ChatActionRecordingVideo) R
+	ChatActionUploadingVideo     func(chatActionUploadingVideo *ChatActionUploadingVideo) R
+	ChatActionRecordingVoiceNote func(chatActionRecordingVoiceNote *ChatActionRecordingVoiceNote) R
+	ChatActionUploadingVoiceNote func(chatActionUploadingVoiceNote *ChatActionUploadingVoiceNote) R
+	ChatActionUploadingPhoto     func(chatActionUploadingPhoto *ChatActionUploadingPhoto) R
+	ChatActionUploadingDocument  func(chatActionUploadingDocument *ChatActionUploadingDocument) R
+	ChatActionChoosingSticker    func(chatActionChoosingSticker *ChatActionChoosingSticker) R
+	ChatActionChoosingLocation   func(chatActionChoosingLocation *ChatActionChoosingLocation) R
+	ChatActionChoosingContact    func(chatActionChoosingContact *ChatActionChoosingContact) R
+	ChatActionStartPlayingGame   func(chatActionStartPlayingGame *ChatActionStartPlayingGame) R
+	ChatActionRecordingVideoNote func(chatActionRecordingVideoNote *ChatActionRecordingVideoNote) R
+	ChatActionUploadingVideoNote func(chatActionUploadingVideoNote *ChatActionUploadingVideoNote) R
+	ChatActionWatchingAnimations func(chatActionWatchingAnimations *ChatActionWatchingAnimations) R
+	ChatActionCancel             func(chatActionCancel *ChatActionCancel) R
+}
+
+// MatchChatAction calls the case of the type of chatAction, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement ChatActionVisitor
+// to have the compiler check every type is handled.
+func MatchChatAction[R any](chatAction ChatAction, cases ChatActionCases[R], defaultCase func(chatAction ChatAction) R) R {
+	switch value := chatAction.(type) {
+	case *ChatActionTyping:
+		if cases.ChatActionTyping != nil {
+			return cases.ChatActionTyping(value)
+		}
+	case *ChatActionRecordingVideo:
+		if cases.ChatActionRecordingVideo != nil {
+			return cases.ChatActionRecordingVideo(value)
+		}
+	case *ChatActionUploadingVideo:
+		if cases.ChatActionUploadingVideo != nil {
+			return cases.ChatActionUploadingVideo(value)
+		}
+	case *ChatActionRecordingVoiceNote:
+		if cases.ChatActionRecordingVoiceNote != nil {
+			return cases.ChatActionRecordingVoiceNote(value)
+		}
+	case *ChatActionUploadingVoiceNote:
+		if cases.ChatActionUploadingVoiceNote != nil {
+			return cases.ChatActionUploadingVoiceNote(value)
+		}
+	case *ChatActionUploadingPhoto:
+		if cases.ChatActionUploadingPhoto != nil {
+			return cases.ChatActionUploadingPhoto(value)
+		}
+	case *ChatActionUploadingDocument:
+		if cases.ChatActionUploadingDocument != nil {
+			return cases.ChatActionUploadingDocument(value)
+		}
+	case *ChatActionChoosingSticker:
+		if cases.ChatActionChoosingSticker != nil {
+			return cases.ChatActionChoosingSticker(value)
+		}
+	case *ChatActionChoosingLocation:
+		if cases.ChatActionChoosingLocation != nil {
+			return cases.ChatActionChoosingLocation(value)
+		}
+	case *ChatActionChoosingContact:
+		if cases.ChatActionChoosingContact != nil {
+			return cases.ChatActionChoosingContact(value)
+		}
+	case *ChatActionStartPlayingGame:
+		if cases.ChatActionStartPlayingGame != nil {
+			return cases.ChatActionStartPlayingGame(value)
+		}
+	case *ChatActionRecordingVideoNote:
+		if cases.ChatActionRecordingVideoNote != nil {
+			return cases.ChatActionRecordingVideoNote(value)
+		}
+	case *ChatActionUploadingVideoNote:
+		if cases.ChatActionUploadingVideoNote != nil {
+			return cases.ChatActionUploadingVideoNote(value)
+		}
+	case *ChatActionWatchingAnimations:
+		if cases.ChatActionWatchingAnimations != nil {
+			return cases.ChatActionWatchingAnimations(value)
+		}
+	case *ChatActionCancel:
+		if cases.ChatActionCancel != nil {
+			return cases.ChatActionCancel(value)
+		}
+	}
+	return defaultCase(chatAction)
+}
+
+// Clone returns a deep copy of the object
+func (unknownChatAction *UnknownChatAction) Clone() *UnknownChatAction {
+	if unknownChatAction == nil {
+		return nil
+	}
+	return &UnknownChatAction{Unknown: unknownChatAction.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownChatAction *UnknownChatAction) Equal(other *UnknownChatAction) bool {
+	if unknownChatAction == nil || other == nil {
+		return unknownChatAction == other
+	}
+	return unknownChatAction.Unknown.equal(other.Unknown)
+}
+
+func cloneChatAction(chatAction ChatAction) ChatAction {
+	switch value := chatAction.(type) {
+	case *ChatActionTyping:
+		return value.Clone()
+	case *ChatActionRecordingVideo:
+		return value.Clone()
+	case *ChatActionUploadingVideo:
+		return value.Clone()
+	case *ChatActionRecordingVoiceNote:
+		return value.Clone()
+	case *ChatActionUploadingVoiceNote:
+		return value.Clone()
+	case *ChatActionUploadingPhoto:
+		return value.Clone()
+	case *ChatActionUploadingDocument:
+		return value.Clone()
+	case *ChatActionChoosingSticker:
+		return value.Clone()
+	case *ChatActionChoosingLocation:
+		return value.Clone()
+	case *ChatActionChoosingContact:
+		return value.Clone()
+	case *ChatActionStartPlayingGame:
+		return value.Clone()
+	case *ChatActionRecordingVideoNote:
+		return value.Clone()
+	case *ChatActionUploadingVideoNote:
+		return value.Clone()
+	case *ChatActionWatchingAnimations:
+		return value.Clone()
+	case *ChatActionCancel:
+		return value.Clone()
+	case *UnknownChatAction:
+		return value.Clone()
+	}
+	return chatAction
+}
+
+func equalChatAction(a ChatAction, b ChatAction) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *ChatActionTyping:
+		b, ok := b.(*ChatActionTyping)
+		return ok && a.Equal(b)
+	case *ChatActionRecordingVideo:
+		b, ok := b.(*ChatActionRecordingVideo)
+		return ok && a.Equal(b)
+	case *ChatActionUploadingVideo:
+		b, ok := b.(*ChatActionUploadingVideo)
+		return ok && a.Equal(b)
+	case *ChatActionRecordingVoiceNote:
+		b, ok := b.(*ChatActionRecordingVoiceNote)
+		return ok && a.Equal(b)
+	case *ChatActionUploadingVoiceNote:
+		b, ok := b.(*ChatActionUploadingVoiceNote)
+		return ok && a.Equal(b)
+	case *ChatActionUploadingPhoto:
+		b, ok := b.(*ChatActionUploadingPhoto)
+		return ok && a.Equal(b)
+	case *ChatActionUploadingDocument:
+		b, ok := b.(*ChatActionUploadingDocument)
+		return ok && a.Equal(b)
+	case *ChatActionChoosingSticker:
+		b, ok := b.(*ChatActionChoosingSticker)
+		return ok && a.Equal(b)
+	case *ChatActionChoosingLocation:
+		b, ok := b.(*ChatActionChoosingLocation)
+		return ok && a.Equal(b)
+	case *ChatActionChoosingContact:
+		b, ok := b.(*ChatActionChoosingContact)
+		return ok && a.Equal(b)
+	case *ChatActionStartPlayingGame:
+		b, ok := b.(*ChatActionStartPlayingGame)
+		return ok && a.Equal(b)
+	case *ChatActionRecordingVideoNote:
+		b, ok := b.(*ChatActionRecordingVideoNote)
+		return ok && a.Equal(b)
+	case *ChatActionUploadingVideoNote:
+		b, ok := b.(*ChatActionUploadingVideoNote)
+		return ok && a.Equal(b)
+	case *ChatActionWatchingAnimations:
+		b, ok := b.(*ChatActionWatchingAnimations)
+		return ok && a.Equal(b)
+	case *ChatActionCancel:
+		b, ok := b.(*ChatActionCancel)
+		return ok && a.Equal(b)
+	case *UnknownChatAction:
+		b, ok := b.(*UnknownChatAction)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffChatAction(path string, a ChatAction, b ChatAction, changes []Change) []Change {
+	switch a := a.(type) {
+	case *ChatActionTyping:
+		if b, ok := b.(*ChatActionTyping); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatActionRecordingVideo:
+		if b, ok := b.(*ChatActionRecordingVideo); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatActionUploadingVideo:
+		if b, ok := b.(*ChatActionUploadingVideo); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatActionRecordingVoiceNote:
+		if b, ok := b.(*ChatActionRecordingVoiceNote); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatActionUploadingVoiceNote:
+		if b, ok := b.(*ChatActionUploadingVoiceNote); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatActionUploadingPhoto:
+		if b, ok := b.(*ChatActionUploadingPhoto); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatActionUploadingDocument:
+		if b, ok := b.(*ChatActionUploadingDocument); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatActionChoosingSticker:
+		if b, ok := b.(*ChatActionChoosingSticker); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatActionChoosingLocation:
+		if b, ok := b.(*ChatActionChoosingLocation); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatActionChoosingContact:
+		if b, ok := b.(*ChatActionChoosingContact); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatActionStartPlayingGame:
+		if b, ok := b.(*ChatActionStartPlayingGame); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatActionRecordingVideoNote:
+		if b, ok := b.(*ChatActionRecordingVideoNote); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatActionUploadingVideoNote:
+		if b, ok := b.(*ChatActionUploadingVideoNote); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatActionWatchingAnimations:
+		if b, ok := b.(*ChatActionWatchingAnimations); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatActionCancel:
+		if b, ok := b.(*ChatActionCancel); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalChatAction(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ChatActionBar Describes actions which must be possible to do through a chat action bar
 type ChatActionBar interface {
 	GetChatActionBarEnum() ChatActionBarEnum
+	Accept(visitor ChatActionBarVisitor)
 }
 
 // ChatActionBarEnum Alias for abstract ChatActionBar 'Sub-Classes', used as constant-enum here
@@ -24,58 +26,109 @@ const (
 	ChatActionBarJoinRequestType             ChatActionBarEnum = "chatActionBarJoinRequest"
 )
 
+// UnknownChatActionBar is a ChatActionBar of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownChatActionBar struct {
+	Unknown
+}
+
+// GetChatActionBarEnum return the enum type of this object
+func (unknownChatActionBar *UnknownChatActionBar) GetChatActionBarEnum() ChatActionBarEnum {
+	return ChatActionBarEnum(unknownChatActionBar.Type)
+}
+
+// Accept calls the method of the visitor for UnknownChatActionBar
+func (unknownChatActionBar *UnknownChatActionBar) Accept(visitor ChatActionBarVisitor) {
+	visitor.VisitUnknownChatActionBar(unknownChatActionBar)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownChatActionBar *UnknownChatActionBar) String() string {
+	return objectString(unknownChatActionBar)
+}
+
+// LogValue logs the object as its summary
+func (unknownChatActionBar *UnknownChatActionBar) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownChatActionBar))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownChatActionBar *UnknownChatActionBar) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownChatActionBar)
+}
+
+func (unknownChatActionBar *UnknownChatActionBar) printText(p *printer) {
+	if unknownChatActionBar == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownChatActionBar")
+	p.string("@type", unknownChatActionBar.Type)
+	p.endObject(state)
+}
+
 func unmarshalChatActionBar(rawMsg *json.RawMessage) (ChatActionBar, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch ChatActionBarEnum(objMap["@type"].(string)) {
+	switch ChatActionBarEnum(typeName) {
 	case ChatActionBarReportSpamType:
 		var chatActionBarReportSpam ChatActionBarReportSpam
-		err := json.Unmarshal(*rawMsg, &chatActionBarReportSpam)
+		err := jsonUnmarshal(*rawMsg, &chatActionBarReportSpam)
 		return &chatActionBarReportSpam, err
 
 	case ChatActionBarReportUnrelatedLocationType:
 		var chatActionBarReportUnrelatedLocation ChatActionBarReportUnrelatedLocation
-		err := json.Unmarshal(*rawMsg, &chatActionBarReportUnrelatedLocation)
+		err := jsonUnmarshal(*rawMsg, &chatActionBarReportUnrelatedLocation)
 		return &chatActionBarReportUnrelatedLocation, err
 
 	case ChatActionBarInviteMembersType:
 		var chatActionBarInviteMembers ChatActionBarInviteMembers
-		err := json.Unmarshal(*rawMsg, &chatActionBarInviteMembers)
+		err := jsonUnmarshal(*rawMsg, &chatActionBarInviteMembers)
 		return &chatActionBarInviteMembers, err
 
 	case ChatActionBarReportAddBlockType:
 		var chatActionBarReportAddBlock ChatActionBarReportAddBlock
-		err := json.Unmarshal(*rawMsg, &chatActionBarReportAddBlock)
+		err := jsonUnmarshal(*rawMsg, &chatActionBarReportAddBlock)
 		return &chatActionBarReportAddBlock, err
 
 	case ChatActionBarAddContactType:
 		var chatActionBarAddContact ChatActionBarAddContact
-		err := json.Unmarshal(*rawMsg, &chatActionBarAddContact)
+		err := jsonUnmarshal(*rawMsg, &chatActionBarAddContact)
 		return &chatActionBarAddContact, err
 
 	case ChatActionBarSharePhoneNumberType:
 		var chatActionBarSharePhoneNumber ChatActionBarSharePhoneNumber
-		err := json.Unmarshal(*rawMsg, &chatActionBarSharePhoneNumber)
+		err := jsonUnmarshal(*rawMsg, &chatActionBarSharePhoneNumber)
 		return &chatActionBarSharePhoneNumber, err
 
 	case ChatActionBarJoinRequestType:
 		var chatActionBarJoinRequest ChatActionBarJoinRequest
-		err := json.Unmarshal(*rawMsg, &chatActionBarJoinRequest)
+		err := jsonUnmarshal(*rawMsg, &chatActionBarJoinRequest)
 		return &chatActionBarJoinRequest, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownChatActionBar{Unknown: unknown}, nil
 	}
 }
 
+func decodeChatActionBar(r *jsonReader) (ChatActionBar, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalChatActionBar(rawMsg)
+}
+
 // ChatActionBarReportSpam The chat can be reported as spam using the method reportChat with the reason chatReportReasonSpam
 type ChatActionBarReportSpam struct {
 	tdCommon
@@ -99,11 +152,118 @@ func NewChatActionBarReportSpam(canUnarchive bool) *ChatActionBarReportSpam {
 	return &chatActionBarReportSpamTemp
 }
 
+// MarshalJSON marshals to json
+func (chatActionBarReportSpam *ChatActionBarReportSpam) MarshalJSON() ([]byte, error) {
+	return chatActionBarReportSpam.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatActionBarReportSpam *ChatActionBarReportSpam) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatActionBarReportSpam)
+}
+
+func (chatActionBarReportSpam *ChatActionBarReportSpam) appendJSON(b []byte) ([]byte, error) {
+	if chatActionBarReportSpam == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatActionBarReportSpam.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatActionBarReportSpam.tdCommon.Extra)
+	b = append(b, `,"can_unarchive":`...)
+	b = appendJSONBool(b, chatActionBarReportSpam.CanUnarchive)
+	return append(b, '}'), nil
+}
+
+func (chatActionBarReportSpam *ChatActionBarReportSpam) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatActionBarReportSpam.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatActionBarReportSpam.tdCommon.Extra, err = r.readString()
+		case "can_unarchive":
+			chatActionBarReportSpam.CanUnarchive, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatActionBarReportSpam *ChatActionBarReportSpam) Clone() *ChatActionBarReportSpam {
+	if chatActionBarReportSpam == nil {
+		return nil
+	}
+	clone := *chatActionBarReportSpam
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatActionBarReportSpam *ChatActionBarReportSpam) Equal(other *ChatActionBarReportSpam) bool {
+	if chatActionBarReportSpam == nil || other == nil {
+		return chatActionBarReportSpam == other
+	}
+	if chatActionBarReportSpam.CanUnarchive != other.CanUnarchive {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatActionBarReportSpam *ChatActionBarReportSpam) Diff(other *ChatActionBarReportSpam) []Change {
+	return chatActionBarReportSpam.diff("", other, nil)
+}
+
+func (chatActionBarReportSpam *ChatActionBarReportSpam) diff(path string, other *ChatActionBarReportSpam, changes []Change) []Change {
+	if chatActionBarReportSpam == nil || other == nil {
+		if chatActionBarReportSpam != other {
+			changes = append(changes, Change{Path: path, Old: chatActionBarReportSpam, New: other})
+		}
+		return changes
+	}
+	if chatActionBarReportSpam.CanUnarchive != other.CanUnarchive {
+		changes = append(changes, Change{Path: fieldPath(path, "can_unarchive"), Old: chatActionBarReportSpam.CanUnarchive, New: other.CanUnarchive})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatActionBarReportSpam *ChatActionBarReportSpam) String() string {
+	return objectString(chatActionBarReportSpam)
+}
+
+// LogValue logs the object as its summary
+func (chatActionBarReportSpam *ChatActionBarReportSpam) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatActionBarReportSpam))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatActionBarReportSpam *ChatActionBarReportSpam) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatActionBarReportSpam)
+}
+
+func (chatActionBarReportSpam *ChatActionBarReportSpam) printText(p *printer) {
+	if chatActionBarReportSpam == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatActionBarReportSpam")
+	p.bool("can_unarchive", chatActionBarReportSpam.CanUnarchive)
+	p.endObject(state)
+}
+
 // GetChatActionBarEnum return the enum type of this object
 func (chatActionBarReportSpam *ChatActionBarReportSpam) GetChatActionBarEnum() ChatActionBarEnum {
 	return ChatActionBarReportSpamType
 }
 
+// Accept calls the method of the visitor for ChatActionBarReportSpam
+func (chatActionBarReportSpam *ChatActionBarReportSpam) Accept(visitor ChatActionBarVisitor) {
+	visitor.VisitChatActionBarReportSpam(chatActionBarReportSpam)
+}
+
 // ChatActionBarReportUnrelatedLocation The chat is a location-based supergroup, which can be reported as having unrelated location using the method reportChat with the reason chatReportReasonUnrelatedLocation
 type ChatActionBarReportUnrelatedLocation struct {
 	tdCommon
@@ -115,7 +275,6 @@ func (chatActionBarReportUnrelatedLocation *ChatActionBarReportUnrelatedLocation
 }
 
 // NewChatActionBarReportUnrelatedLocation creates a new ChatActionBarReportUnrelatedLocation
-//
 func NewChatActionBarReportUnrelatedLocation() *ChatActionBarReportUnrelatedLocation {
 	chatActionBarReportUnrelatedLocationTemp := ChatActionBarReportUnrelatedLocation{
 		tdCommon: tdCommon{Type: "chatActionBarReportUnrelatedLocation"},
@@ -124,11 +283,107 @@ func NewChatActionBarReportUnrelatedLocation() *ChatActionBarReportUnrelatedLoca
 	return &chatActionBarReportUnrelatedLocationTemp
 }
 
+// MarshalJSON marshals to json
+func (chatActionBarReportUnrelatedLocation *ChatActionBarReportUnrelatedLocation) MarshalJSON() ([]byte, error) {
+	return chatActionBarReportUnrelatedLocation.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatActionBarReportUnrelatedLocation *ChatActionBarReportUnrelatedLocation) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatActionBarReportUnrelatedLocation)
+}
+
+func (chatActionBarReportUnrelatedLocation *ChatActionBarReportUnrelatedLocation) appendJSON(b []byte) ([]byte, error) {
+	if chatActionBarReportUnrelatedLocation == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatActionBarReportUnrelatedLocation.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatActionBarReportUnrelatedLocation.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatActionBarReportUnrelatedLocation *ChatActionBarReportUnrelatedLocation) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatActionBarReportUnrelatedLocation.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatActionBarReportUnrelatedLocation.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatActionBarReportUnrelatedLocation *ChatActionBarReportUnrelatedLocation) Clone() *ChatActionBarReportUnrelatedLocation {
+	if chatActionBarReportUnrelatedLocation == nil {
+		return nil
+	}
+	clone := *chatActionBarReportUnrelatedLocation
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatActionBarReportUnrelatedLocation *ChatActionBarReportUnrelatedLocation) Equal(other *ChatActionBarReportUnrelatedLocation) bool {
+	if chatActionBarReportUnrelatedLocation == nil || other == nil {
+		return chatActionBarReportUnrelatedLocation == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatActionBarReportUnrelatedLocation *ChatActionBarReportUnrelatedLocation) Diff(other *ChatActionBarReportUnrelatedLocation) []Change {
+	return chatActionBarReportUnrelatedLocation.diff("", other, nil)
+}
+
+func (chatActionBarReportUnrelatedLocation *ChatActionBarReportUnrelatedLocation) diff(path string, other *ChatActionBarReportUnrelatedLocation, changes []Change) []Change {
+	if chatActionBarReportUnrelatedLocation == nil || other == nil {
+		if chatActionBarReportUnrelatedLocation != other {
+			changes = append(changes, Change{Path: path, Old: chatActionBarReportUnrelatedLocation, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatActionBarReportUnrelatedLocation *ChatActionBarReportUnrelatedLocation) String() string {
+	return objectString(chatActionBarReportUnrelatedLocation)
+}
+
+// LogValue logs the object as its summary
+func (chatActionBarReportUnrelatedLocation *ChatActionBarReportUnrelatedLocation) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatActionBarReportUnrelatedLocation))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatActionBarReportUnrelatedLocation *ChatActionBarReportUnrelatedLocation) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatActionBarReportUnrelatedLocation)
+}
+
+func (chatActionBarReportUnrelatedLocation *ChatActionBarReportUnrelatedLocation) printText(p *printer) {
+	if chatActionBarReportUnrelatedLocation == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatActionBarReportUnrelatedLocation")
+	p.endObject(state)
+}
+
 // GetChatActionBarEnum return the enum type of this object
 func (chatActionBarReportUnrelatedLocation *ChatActionBarReportUnrelatedLocation) GetChatActionBarEnum() ChatActionBarEnum {
 	return ChatActionBarReportUnrelatedLocationType
 }
 
+// Accept calls the method of the visitor for ChatActionBarReportUnrelatedLocation
+func (chatActionBarReportUnrelatedLocation *ChatActionBarReportUnrelatedLocation) Accept(visitor ChatActionBarVisitor) {
+	visitor.VisitChatActionBarReportUnrelatedLocation(chatActionBarReportUnrelatedLocation)
+}
+
 // ChatActionBarInviteMembers The chat is a recently created group chat to which new members can be invited
 type ChatActionBarInviteMembers struct {
 	tdCommon
@@ -140,7 +395,6 @@ func (chatActionBarInviteMembers *ChatActionBarInviteMembers) MessageType() stri
 }
 
 // NewChatActionBarInviteMembers creates a new ChatActionBarInviteMembers
-//
 func NewChatActionBarInviteMembers() *ChatActionBarInviteMembers {
 	chatActionBarInviteMembersTemp := ChatActionBarInviteMembers{
 		tdCommon: tdCommon{Type: "chatActionBarInviteMembers"},
@@ -149,11 +403,107 @@ func NewChatActionBarInviteMembers() *ChatActionBarInviteMembers {
 	return &chatActionBarInviteMembersTemp
 }
 
+// MarshalJSON marshals to json
+func (chatActionBarInviteMembers *ChatActionBarInviteMembers) MarshalJSON() ([]byte, error) {
+	return chatActionBarInviteMembers.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatActionBarInviteMembers *ChatActionBarInviteMembers) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatActionBarInviteMembers)
+}
+
+func (chatActionBarInviteMembers *ChatActionBarInviteMembers) appendJSON(b []byte) ([]byte, error) {
+	if chatActionBarInviteMembers == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatActionBarInviteMembers.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatActionBarInviteMembers.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatActionBarInviteMembers *ChatActionBarInviteMembers) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatActionBarInviteMembers.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatActionBarInviteMembers.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatActionBarInviteMembers *ChatActionBarInviteMembers) Clone() *ChatActionBarInviteMembers {
+	if chatActionBarInviteMembers == nil {
+		return nil
+	}
+	clone := *chatActionBarInviteMembers
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatActionBarInviteMembers *ChatActionBarInviteMembers) Equal(other *ChatActionBarInviteMembers) bool {
+	if chatActionBarInviteMembers == nil || other == nil {
+		return chatActionBarInviteMembers == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatActionBarInviteMembers *ChatActionBarInviteMembers) Diff(other *ChatActionBarInviteMembers) []Change {
+	return chatActionBarInviteMembers.diff("", other, nil)
+}
+
+func (chatActionBarInviteMembers *ChatActionBarInviteMembers) diff(path string, other *ChatActionBarInviteMembers, changes []Change) []Change {
+	if chatActionBarInviteMembers == nil || other == nil {
+		if chatActionBarInviteMembers != other {
+			changes = append(changes, Change{Path: path, Old: chatActionBarInviteMembers, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatActionBarInviteMembers *ChatActionBarInviteMembers) String() string {
+	return objectString(chatActionBarInviteMembers)
+}
+
+// LogValue logs the object as its summary
+func (chatActionBarInviteMembers *ChatActionBarInviteMembers) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatActionBarInviteMembers))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatActionBarInviteMembers *ChatActionBarInviteMembers) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatActionBarInviteMembers)
+}
+
+func (chatActionBarInviteMembers *ChatActionBarInviteMembers) printText(p *printer) {
+	if chatActionBarInviteMembers == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatActionBarInviteMembers")
+	p.endObject(state)
+}
+
 // GetChatActionBarEnum return the enum type of this object
 func (chatActionBarInviteMembers *ChatActionBarInviteMembers) GetChatActionBarEnum() ChatActionBarEnum {
 	return ChatActionBarInviteMembersType
 }
 
+// Accept calls the method of the visitor for ChatActionBarInviteMembers
+func (chatActionBarInviteMembers *ChatActionBarInviteMembers) Accept(visitor ChatActionBarVisitor) {
+	visitor.VisitChatActionBarInviteMembers(chatActionBarInviteMembers)
+}
+
 // ChatActionBarReportAddBlock The chat is a private or secret chat, which can be reported using the method reportChat, or the other user can be blocked using the method toggleMessageSenderIsBlocked, or the other user can be added to the contact list using the method addContact
 type ChatActionBarReportAddBlock struct {
 	tdCommon
@@ -180,11 +530,129 @@ func NewChatActionBarReportAddBlock(canUnarchive bool, distance int32) *ChatActi
 	return &chatActionBarReportAddBlockTemp
 }
 
+// MarshalJSON marshals to json
+func (chatActionBarReportAddBlock *ChatActionBarReportAddBlock) MarshalJSON() ([]byte, error) {
+	return chatActionBarReportAddBlock.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatActionBarReportAddBlock *ChatActionBarReportAddBlock) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatActionBarReportAddBlock)
+}
+
+func (chatActionBarReportAddBlock *ChatActionBarReportAddBlock) appendJSON(b []byte) ([]byte, error) {
+	if chatActionBarReportAddBlock == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatActionBarReportAddBlock.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatActionBarReportAddBlock.tdCommon.Extra)
+	b = append(b, `,"can_unarchive":`...)
+	b = appendJSONBool(b, chatActionBarReportAddBlock.CanUnarchive)
+	b = append(b, `,"distance":`...)
+	b = appendJSONInt(b, int64(chatActionBarReportAddBlock.Distance))
+	return append(b, '}'), nil
+}
+
+func (chatActionBarReportAddBlock *ChatActionBarReportAddBlock) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatActionBarReportAddBlock.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatActionBarReportAddBlock.tdCommon.Extra, err = r.readString()
+		case "can_unarchive":
+			chatActionBarReportAddBlock.CanUnarchive, err = r.readBool()
+		case "distance":
+			chatActionBarReportAddBlock.Distance, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatActionBarReportAddBlock *ChatActionBarReportAddBlock) Clone() *ChatActionBarReportAddBlock {
+	if chatActionBarReportAddBlock == nil {
+		return nil
+	}
+	clone := *chatActionBarReportAddBlock
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatActionBarReportAddBlock *ChatActionBarReportAddBlock) Equal(other *ChatActionBarReportAddBlock) bool {
+	if chatActionBarReportAddBlock == nil || other == nil {
+		return chatActionBarReportAddBlock == other
+	}
+	if chatActionBarReportAddBlock.CanUnarchive != other.CanUnarchive {
+		return false
+	}
+	if chatActionBarReportAddBlock.Distance != other.Distance {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatActionBarReportAddBlock *ChatActionBarReportAddBlock) Diff(other *ChatActionBarReportAddBlock) []Change {
+	return chatActionBarReportAddBlock.diff("", other, nil)
+}
+
+func (chatActionBarReportAddBlock *ChatActionBarReportAddBlock) diff(path string, other *ChatActionBarReportAddBlock, changes []Change) []Change {
+	if chatActionBarReportAddBlock == nil || other == nil {
+		if chatActionBarReportAddBlock != other {
+			changes = append(changes, Change{Path: path, Old: chatActionBarReportAddBlock, New: other})
+		}
+		return changes
+	}
+	if chatActionBarReportAddBlock.CanUnarchive != other.CanUnarchive {
+		changes = append(changes, Change{Path: fieldPath(path, "can_unarchive"), Old: chatActionBarReportAddBlock.CanUnarchive, New: other.CanUnarchive})
+	}
+	if chatActionBarReportAddBlock.Distance != other.Distance {
+		changes = append(changes, Change{Path: fieldPath(path, "distance"), Old: chatActionBarReportAddBlock.Distance, New: other.Distance})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatActionBarReportAddBlock *ChatActionBarReportAddBlock) String() string {
+	return objectString(chatActionBarReportAddBlock)
+}
+
+// LogValue logs the object as its summary
+func (chatActionBarReportAddBlock *ChatActionBarReportAddBlock) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatActionBarReportAddBlock))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatActionBarReportAddBlock *ChatActionBarReportAddBlock) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatActionBarReportAddBlock)
+}
+
+func (chatActionBarReportAddBlock *ChatActionBarReportAddBlock) printText(p *printer) {
+	if chatActionBarReportAddBlock == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatActionBarReportAddBlock")
+	p.bool("can_unarchive", chatActionBarReportAddBlock.CanUnarchive)
+	p.int("distance", int64(chatActionBarReportAddBlock.Distance))
+	p.endObject(state)
+}
+
 // GetChatActionBarEnum return the enum type of this object
 func (chatActionBarReportAddBlock *ChatActionBarReportAddBlock) GetChatActionBarEnum() ChatActionBarEnum {
 	return ChatActionBarReportAddBlockType
 }
 
+// Accept calls the method of the visitor for ChatActionBarReportAddBlock
+func (chatActionBarReportAddBlock *ChatActionBarReportAddBlock) Accept(visitor ChatActionBarVisitor) {
+	visitor.VisitChatActionBarReportAddBlock(chatActionBarReportAddBlock)
+}
+
 // ChatActionBarAddContact The chat is a private or secret chat and the other user can be added to the contact list using the method addContact
 type ChatActionBarAddContact struct {
 	tdCommon
@@ -196,7 +664,6 @@ func (chatActionBarAddContact *ChatActionBarAddContact) MessageType() string {
 }
 
 // NewChatActionBarAddContact creates a new ChatActionBarAddContact
-//
 func NewChatActionBarAddContact() *ChatActionBarAddContact {
 	chatActionBarAddContactTemp := ChatActionBarAddContact{
 		tdCommon: tdCommon{Type: "chatActionBarAddContact"},
@@ -205,11 +672,107 @@ func NewChatActionBarAddContact() *ChatActionBarAddContact {
 	return &chatActionBarAddContactTemp
 }
 
+// MarshalJSON marshals to json
+func (chatActionBarAddContact *ChatActionBarAddContact) MarshalJSON() ([]byte, error) {
+	return chatActionBarAddContact.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatActionBarAddContact *ChatActionBarAddContact) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatActionBarAddContact)
+}
+
+func (chatActionBarAddContact *ChatActionBarAddContact) appendJSON(b []byte) ([]byte, error) {
+	if chatActionBarAddContact == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatActionBarAddContact.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatActionBarAddContact.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatActionBarAddContact *ChatActionBarAddContact) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatActionBarAddContact.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatActionBarAddContact.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatActionBarAddContact *ChatActionBarAddContact) Clone() *ChatActionBarAddContact {
+	if chatActionBarAddContact == nil {
+		return nil
+	}
+	clone := *chatActionBarAddContact
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatActionBarAddContact *ChatActionBarAddContact) Equal(other *ChatActionBarAddContact) bool {
+	if chatActionBarAddContact == nil || other == nil {
+		return chatActionBarAddContact == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatActionBarAddContact *ChatActionBarAddContact) Diff(other *ChatActionBarAddContact) []Change {
+	return chatActionBarAddContact.diff("", other, nil)
+}
+
+func (chatActionBarAddContact *ChatActionBarAddContact) diff(path string, other *ChatActionBarAddContact, changes []Change) []Change {
+	if chatActionBarAddContact == nil || other == nil {
+		if chatActionBarAddContact != other {
+			changes = append(changes, Change{Path: path, Old: chatActionBarAddContact, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatActionBarAddContact *ChatActionBarAddContact) String() string {
+	return objectString(chatActionBarAddContact)
+}
+
+// LogValue logs the object as its summary
+func (chatActionBarAddContact *ChatActionBarAddContact) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatActionBarAddContact))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatActionBarAddContact *ChatActionBarAddContact) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatActionBarAddContact)
+}
+
+func (chatActionBarAddContact *ChatActionBarAddContact) printText(p *printer) {
+	if chatActionBarAddContact == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatActionBarAddContact")
+	p.endObject(state)
+}
+
 // GetChatActionBarEnum return the enum type of this object
 func (chatActionBarAddContact *ChatActionBarAddContact) GetChatActionBarEnum() ChatActionBarEnum {
 	return ChatActionBarAddContactType
 }
 
+// Accept calls the method of the visitor for ChatActionBarAddContact
+func (chatActionBarAddContact *ChatActionBarAddContact) Accept(visitor ChatActionBarVisitor) {
+	visitor.VisitChatActionBarAddContact(chatActionBarAddContact)
+}
+
 // ChatActionBarSharePhoneNumber The chat is a private or secret chat with a mutual contact and the user's phone number can be shared with the other user using the method sharePhoneNumber
 type ChatActionBarSharePhoneNumber struct {
 	tdCommon
@@ -221,7 +784,6 @@ func (chatActionBarSharePhoneNumber *ChatActionBarSharePhoneNumber) MessageType(
 }
 
 // NewChatActionBarSharePhoneNumber creates a new ChatActionBarSharePhoneNumber
-//
 func NewChatActionBarSharePhoneNumber() *ChatActionBarSharePhoneNumber {
 	chatActionBarSharePhoneNumberTemp := ChatActionBarSharePhoneNumber{
 		tdCommon: tdCommon{Type: "chatActionBarSharePhoneNumber"},
@@ -230,11 +792,107 @@ func NewChatActionBarSharePhoneNumber() *ChatActionBarSharePhoneNumber {
 	return &chatActionBarSharePhoneNumberTemp
 }
 
+// MarshalJSON marshals to json
+func (chatActionBarSharePhoneNumber *ChatActionBarSharePhoneNumber) MarshalJSON() ([]byte, error) {
+	return chatActionBarSharePhoneNumber.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatActionBarSharePhoneNumber *ChatActionBarSharePhoneNumber) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatActionBarSharePhoneNumber)
+}
+
+func (chatActionBarSharePhoneNumber *ChatActionBarSharePhoneNumber) appendJSON(b []byte) ([]byte, error) {
+	if chatActionBarSharePhoneNumber == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatActionBarSharePhoneNumber.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatActionBarSharePhoneNumber.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatActionBarSharePhoneNumber *ChatActionBarSharePhoneNumber) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatActionBarSharePhoneNumber.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatActionBarSharePhoneNumber.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatActionBarSharePhoneNumber *ChatActionBarSharePhoneNumber) Clone() *ChatActionBarSharePhoneNumber {
+	if chatActionBarSharePhoneNumber == nil {
+		return nil
+	}
+	clone := *chatActionBarSharePhoneNumber
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatActionBarSharePhoneNumber *ChatActionBarSharePhoneNumber) Equal(other *ChatActionBarSharePhoneNumber) bool {
+	if chatActionBarSharePhoneNumber == nil || other == nil {
+		return chatActionBarSharePhoneNumber == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatActionBarSharePhoneNumber *ChatActionBarSharePhoneNumber) Diff(other *ChatActionBarSharePhoneNumber) []Change {
+	return chatActionBarSharePhoneNumber.diff("", other, nil)
+}
+
+func (chatActionBarSharePhoneNumber *ChatActionBarSharePhoneNumber) diff(path string, other *ChatActionBarSharePhoneNumber, changes []Change) []Change {
+	if chatActionBarSharePhoneNumber == nil || other == nil {
+		if chatActionBarSharePhoneNumber != other {
+			changes = append(changes, Change{Path: path, Old: chatActionBarSharePhoneNumber, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatActionBarSharePhoneNumber *ChatActionBarSharePhoneNumber) String() string {
+	return objectString(chatActionBarSharePhoneNumber)
+}
+
+// LogValue logs the object as its summary
+func (chatActionBarSharePhoneNumber *ChatActionBarSharePhoneNumber) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatActionBarSharePhoneNumber))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatActionBarSharePhoneNumber *ChatActionBarSharePhoneNumber) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatActionBarSharePhoneNumber)
+}
+
+func (chatActionBarSharePhoneNumber *ChatActionBarSharePhoneNumber) printText(p *printer) {
+	if chatActionBarSharePhoneNumber == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatActionBarSharePhoneNumber")
+	p.endObject(state)
+}
+
 // GetChatActionBarEnum return the enum type of this object
 func (chatActionBarSharePhoneNumber *ChatActionBarSharePhoneNumber) GetChatActionBarEnum() ChatActionBarEnum {
 	return ChatActionBarSharePhoneNumberType
 }
 
+// Accept calls the method of the visitor for ChatActionBarSharePhoneNumber
+func (chatActionBarSharePhoneNumber *ChatActionBarSharePhoneNumber) Accept(visitor ChatActionBarVisitor) {
+	visitor.VisitChatActionBarSharePhoneNumber(chatActionBarSharePhoneNumber)
+}
+
 // ChatActionBarJoinRequest The chat is a private chat with an administrator of a chat to which the user sent join request
 type ChatActionBarJoinRequest struct {
 	tdCommon
@@ -264,7 +922,306 @@ func NewChatActionBarJoinRequest(title string, isChannel bool, requestDate int32
 	return &chatActionBarJoinRequestTemp
 }
 
+// MarshalJSON marshals to json
+func (chatActionBarJoinRequest *ChatActionBarJoinRequest) MarshalJSON() ([]byte, error) {
+	return chatActionBarJoinRequest.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatActionBarJoinRequest *ChatActionBarJoinRequest) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatActionBarJoinRequest)
+}
+
+func (chatActionBarJoinRequest *ChatActionBarJoinRequest) appendJSON(b []byte) ([]byte, error) {
+	if chatActionBarJoinRequest == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatActionBarJoinRequest.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatActionBarJoinRequest.tdCommon.Extra)
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, chatActionBarJoinRequest.Title)
+	b = append(b, `,"is_channel":`...)
+	b = appendJSONBool(b, chatActionBarJoinRequest.IsChannel)
+	b = append(b, `,"request_date":`...)
+	b = appendJSONInt(b, int64(chatActionBarJoinRequest.RequestDate))
+	return append(b, '}'), nil
+}
+
+func (chatActionBarJoinRequest *ChatActionBarJoinRequest) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatActionBarJoinRequest.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatActionBarJoinRequest.tdCommon.Extra, err = r.readString()
+		case "title":
+			chatActionBarJoinRequest.Title, err = r.readString()
+		case "is_channel":
+			chatActionBarJoinRequest.IsChannel, err = r.readBool()
+		case "request_date":
+			chatActionBarJoinRequest.RequestDate, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatActionBarJoinRequest *ChatActionBarJoinRequest) Clone() *ChatActionBarJoinRequest {
+	if chatActionBarJoinRequest == nil {
+		return nil
+	}
+	clone := *chatActionBarJoinRequest
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatActionBarJoinRequest *ChatActionBarJoinRequest) Equal(other *ChatActionBarJoinRequest) bool {
+	if chatActionBarJoinRequest == nil || other == nil {
+		return chatActionBarJoinRequest == other
+	}
+	if chatActionBarJoinRequest.Title != other.Title {
+		return false
+	}
+	if chatActionBarJoinRequest.IsChannel != other.IsChannel {
+		return false
+	}
+	if chatActionBarJoinRequest.RequestDate != other.RequestDate {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatActionBarJoinRequest *ChatActionBarJoinRequest) Diff(other *ChatActionBarJoinRequest) []Change {
+	return chatActionBarJoinRequest.diff("", other, nil)
+}
+
+func (chatActionBarJoinRequest *ChatActionBarJoinRequest) diff(path string, other *ChatActionBarJoinRequest, changes []Change) []Change {
+	if chatActionBarJoinRequest == nil || other == nil {
+		if chatActionBarJoinRequest != other {
+			changes = append(changes, Change{Path: path, Old: chatActionBarJoinRequest, New: other})
+		}
+		return changes
+	}
+	if chatActionBarJoinRequest.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: chatActionBarJoinRequest.Title, New: other.Title})
+	}
+	if chatActionBarJoinRequest.IsChannel != other.IsChannel {
+		changes = append(changes, Change{Path: fieldPath(path, "is_channel"), Old: chatActionBarJoinRequest.IsChannel, New: other.IsChannel})
+	}
+	if chatActionBarJoinRequest.RequestDate != other.RequestDate {
+		changes = append(changes, Change{Path: fieldPath(path, "request_date"), Old: chatActionBarJoinRequest.RequestDate, New: other.RequestDate})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatActionBarJoinRequest *ChatActionBarJoinRequest) String() string {
+	return objectString(chatActionBarJoinRequest)
+}
+
+// LogValue logs the object as its summary
+func (chatActionBarJoinRequest *ChatActionBarJoinRequest) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatActionBarJoinRequest))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatActionBarJoinRequest *ChatActionBarJoinRequest) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatActionBarJoinRequest)
+}
+
+func (chatActionBarJoinRequest *ChatActionBarJoinRequest) printText(p *printer) {
+	if chatActionBarJoinRequest == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatActionBarJoinRequest")
+	p.string("title", chatActionBarJoinRequest.Title)
+	p.bool("is_channel", chatActionBarJoinRequest.IsChannel)
+	p.int("request_date", int64(chatActionBarJoinRequest.RequestDate))
+	p.endObject(state)
+}
+
 // GetChatActionBarEnum return the enum type of this object
 func (chatActionBarJoinRequest *ChatActionBarJoinRequest) GetChatActionBarEnum() ChatActionBarEnum {
 	return ChatActionBarJoinRequestType
 }
+
+// Accept calls the method of the visitor for ChatActionBarJoinRequest
+func (chatActionBarJoinRequest *ChatActionBarJoinRequest) Accept(visitor ChatActionBarVisitor) {
+	visitor.VisitChatActionBarJoinRequest(chatActionBarJoinRequest)
+}
+
+// ChatActionBarVisitor visits every type of ChatActionBar, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to ChatActionBar, until they handle it too.
+type ChatActionBarVisitor interface {
+	VisitChatActionBarReportSpam(chatActionBarReportSpam *ChatActionBarReportSpam)
+	VisitChatActionBarReportUnrelatedLocation(chatActionBarReportUnrelatedLocation *ChatActionBarReportUnrelatedLocation)
+	VisitChatActionBarInviteMembers(chatActionBarInviteMembers *ChatActionBarInviteMembers)
+	VisitChatActionBarReportAddBlock(chatActionBarReportAddBlock *ChatActionBarReportAddBlock)
+	VisitChatActionBarAddContact(chatActionBarAddContact *ChatActionBarAddContact)
+	VisitChatActionBarSharePhoneNumber(chatActionBarSharePhoneNumber *ChatActionBarSharePhoneNumber)
+	VisitChatActionBarJoinRequest(chatActionBarJoinRequest *ChatActionBarJoinRequest)
+	VisitUnknownChatActionBar(unknownChatActionBar *UnknownChatActionBar)
+}
+
+// ChatActionBarCases holds the function MatchChatActionBar calls for each type of ChatActionBar;
+// types without a function are passed to the default case
+type ChatActionBarCases[R any] struct {
+	ChatActionBarReportSpam              func(chatActionBarReportSpam *ChatActionBarReportSpam) R
+	ChatActionBarReportUnrelatedLocation func(chatActionBarReportUnrelatedLocation *ChatActionBarReportUnrelatedLocation) R
+	ChatActionBarInviteMembers           func(chatActionBarInviteMembers *ChatActionBarInviteMembers) R
+	ChatActionBarReportAddBlock          func(chatActionBarReportAddBlock *ChatActionBarReportAddBlock) R
+	ChatActionBarAddContact              func(chatActionBarAddContact *ChatActionBarAddContact) R
+	ChatActionBarSharePhoneNumber        func(chatActionBarSharePhoneNumber *ChatActionBarSharePhoneNumber) R
+	ChatActionBarJoinRequest             func(chatActionBarJoinRequest *ChatActionBarJoinRequest) R
+}
+
+// MatchChatActionBar calls the case of the type of chatActionBar, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement ChatActionBarVisitor
+// to have the compiler check every type is handled.
+func MatchChatActionBar[R any](chatActionBar ChatActionBar, cases ChatActionBarCases[R], defaultCase func(chatActionBar ChatActionBar) R) R {
+	switch value := chatActionBar.(type) {
+	case *ChatActionBarReportSpam:
+		if cases.ChatActionBarReportSpam != nil {
+			return cases.ChatActionBarReportSpam(value)
+		}
+	case *ChatActionBarReportUnrelatedLocation:
+		if cases.ChatActionBarReportUnrelatedLocation != nil {
+			return cases.ChatActionBarReportUnrelatedLocation(value)
+		}
+	case *ChatActionBarInviteMembers:
+		if cases.ChatActionBarInviteMembers != nil {
+			return cases.ChatActionBarInviteMembers(value)
+		}
+	case *ChatActionBarReportAddBlock:
+		if cases.ChatActionBarReportAddBlock != nil {
+			return cases.ChatActionBarReportAddBlock(value)
+		}
+	case *ChatActionBarAddContact:
+		if cases.ChatActionBarAddContact != nil {
+			return cases.ChatActionBarAddContact(value)
+		}
+	case *ChatActionBarSharePhoneNumber:
+		if cases.ChatActionBarSharePhoneNumber != nil {
+			return cases.ChatActionBarSharePhoneNumber(value)
+		}
+	case *ChatActionBarJoinRequest:
+		if cases.ChatActionBarJoinRequest != nil {
+			return cases.ChatActionBarJoinRequest(value)
+		}
+	}
+	return defaultCase(chatActionBar)
+}
+
+// Clone returns a deep copy of the object
+func (unknownChatActionBar *UnknownChatActionBar) Clone() *UnknownChatActionBar {
+	if unknownChatActionBar == nil {
+		return nil
+	}
+	return &UnknownChatActionBar{Unknown: unknownChatActionBar.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownChatActionBar *UnknownChatActionBar) Equal(other *UnknownChatActionBar) bool {
+	if unknownChatActionBar == nil || other == nil {
+		return unknownChatActionBar == other
+	}
+	return unknownChatActionBar.Unknown.equal(other.Unknown)
+}
+
+func cloneChatActionBar(chatActionBar ChatActionBar) ChatActionBar {
+	switch value := chatActionBar.(type) {
+	case *ChatActionBarReportSpam:
+		return value.Clone()
+	case *ChatActionBarReportUnrelatedLocation:
+		return value.Clone()
+	case *ChatActionBarInviteMembers:
+		return value.Clone()
+	case *ChatActionBarReportAddBlock:
+		return value.Clone()
+	case *ChatActionBarAddContact:
+		return value.Clone()
+	case *ChatActionBarSharePhoneNumber:
+		return value.Clone()
+	case *ChatActionBarJoinRequest:
+		return value.Clone()
+	case *UnknownChatActionBar:
+		return value.Clone()
+	}
+	return chatActionBar
+}
+
+func equalChatActionBar(a ChatActionBar, b ChatActionBar) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *ChatActionBarReportSpam:
+		b, ok := b.(*ChatActionBarReportSpam)
+		return ok && a.Equal(b)
+	case *ChatActionBarReportUnrelatedLocation:
+		b, ok := b.(*ChatActionBarReportUnrelatedLocation)
+		return ok && a.Equal(b)
+	case *ChatActionBarInviteMembers:
+		b, ok := b.(*ChatActionBarInviteMembers)
+		return ok && a.Equal(b)
+	case *ChatActionBarReportAddBlock:
+		b, ok := b.(*ChatActionBarReportAddBlock)
+		return ok && a.Equal(b)
+	case *ChatActionBarAddContact:
+		b, ok := b.(*ChatActionBarAddContact)
+		return ok && a.Equal(b)
+	case *ChatActionBarSharePhoneNumber:
+		b, ok := b.(*ChatActionBarSharePhoneNumber)
+		return ok && a.Equal(b)
+	case *ChatActionBarJoinRequest:
+		b, ok := b.(*ChatActionBarJoinRequest)
+		return ok && a.Equal(b)
+	case *UnknownChatActionBar:
+		b, ok := b.(*UnknownChatActionBar)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffChatActionBar(path string, a ChatActionBar, b ChatActionBar, changes []Change) []Change {
+	switch a := a.(type) {
+	case *ChatActionBarReportSpam:
+		if b, ok := b.(*ChatActionBarReportSpam); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatActionBarReportUnrelatedLocation:
+		if b, ok := b.(*ChatActionBarReportUnrelatedLocation); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatActionBarInviteMembers:
+		if b, ok := b.(*ChatActionBarInviteMembers); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatActionBarReportAddBlock:
+		if b, ok := b.(*ChatActionBarReportAddBlock); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatActionBarAddContact:
+		if b, ok := b.(*ChatActionBarAddContact); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatActionBarSharePhoneNumber:
+		if b, ok := b.(*ChatActionBarSharePhoneNumber); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatActionBarJoinRequest:
+		if b, ok := b.(*ChatActionBarJoinRequest); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalChatActionBar(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // ChatAdministrator Contains information about a chat administrator
 type ChatAdministrator struct {
 	tdCommon
@@ -28,3 +33,127 @@ func NewChatAdministrator(userId int64, customTitle string, isOwner bool) *ChatA
 
 	return &chatAdministratorTemp
 }
+
+// MarshalJSON marshals to json
+func (chatAdministrator *ChatAdministrator) MarshalJSON() ([]byte, error) {
+	return chatAdministrator.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatAdministrator *ChatAdministrator) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatAdministrator)
+}
+
+func (chatAdministrator *ChatAdministrator) appendJSON(b []byte) ([]byte, error) {
+	if chatAdministrator == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatAdministrator.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatAdministrator.tdCommon.Extra)
+	b = append(b, `,"user_id":`...)
+	b = appendJSONInt(b, int64(chatAdministrator.UserId))
+	b = append(b, `,"custom_title":`...)
+	b = appendJSONString(b, chatAdministrator.CustomTitle)
+	b = append(b, `,"is_owner":`...)
+	b = appendJSONBool(b, chatAdministrator.IsOwner)
+	return append(b, '}'), nil
+}
+
+func (chatAdministrator *ChatAdministrator) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatAdministrator.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatAdministrator.tdCommon.Extra, err = r.readString()
+		case "user_id":
+			chatAdministrator.UserId, err = r.readInt64()
+		case "custom_title":
+			chatAdministrator.CustomTitle, err = r.readString()
+		case "is_owner":
+			chatAdministrator.IsOwner, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatAdministrator *ChatAdministrator) Clone() *ChatAdministrator {
+	if chatAdministrator == nil {
+		return nil
+	}
+	clone := *chatAdministrator
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatAdministrator *ChatAdministrator) Equal(other *ChatAdministrator) bool {
+	if chatAdministrator == nil || other == nil {
+		return chatAdministrator == other
+	}
+	if chatAdministrator.UserId != other.UserId {
+		return false
+	}
+	if chatAdministrator.CustomTitle != other.CustomTitle {
+		return false
+	}
+	if chatAdministrator.IsOwner != other.IsOwner {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatAdministrator *ChatAdministrator) Diff(other *ChatAdministrator) []Change {
+	return chatAdministrator.diff("", other, nil)
+}
+
+func (chatAdministrator *ChatAdministrator) diff(path string, other *ChatAdministrator, changes []Change) []Change {
+	if chatAdministrator == nil || other == nil {
+		if chatAdministrator != other {
+			changes = append(changes, Change{Path: path, Old: chatAdministrator, New: other})
+		}
+		return changes
+	}
+	if chatAdministrator.UserId != other.UserId {
+		changes = append(changes, Change{Path: fieldPath(path, "user_id"), Old: chatAdministrator.UserId, New: other.UserId})
+	}
+	if chatAdministrator.CustomTitle != other.CustomTitle {
+		changes = append(changes, Change{Path: fieldPath(path, "custom_title"), Old: chatAdministrator.CustomTitle, New: other.CustomTitle})
+	}
+	if chatAdministrator.IsOwner != other.IsOwner {
+		changes = append(changes, Change{Path: fieldPath(path, "is_owner"), Old: chatAdministrator.IsOwner, New: other.IsOwner})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatAdministrator *ChatAdministrator) String() string {
+	return objectString(chatAdministrator)
+}
+
+// LogValue logs the object as its summary
+func (chatAdministrator *ChatAdministrator) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatAdministrator))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatAdministrator *ChatAdministrator) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatAdministrator)
+}
+
+func (chatAdministrator *ChatAdministrator) printText(p *printer) {
+	if chatAdministrator == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatAdministrator")
+	p.int("user_id", int64(chatAdministrator.UserId))
+	p.string("custom_title", chatAdministrator.CustomTitle)
+	p.bool("is_owner", chatAdministrator.IsOwner)
+	p.endObject(state)
+}
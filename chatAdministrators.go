@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ChatAdministrators Represents a list of chat administrators
@@ -28,6 +28,150 @@ func NewChatAdministrators(administrators []ChatAdministrator) *ChatAdministrato
 	return &chatAdministratorsTemp
 }
 
+// MarshalJSON marshals to json
+func (chatAdministrators *ChatAdministrators) MarshalJSON() ([]byte, error) {
+	return chatAdministrators.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatAdministrators *ChatAdministrators) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatAdministrators)
+}
+
+func (chatAdministrators *ChatAdministrators) appendJSON(b []byte) ([]byte, error) {
+	if chatAdministrators == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatAdministrators.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatAdministrators.tdCommon.Extra)
+	b = append(b, `,"administrators":`...)
+	if chatAdministrators.Administrators == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range chatAdministrators.Administrators {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = chatAdministrators.Administrators[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (chatAdministrators *ChatAdministrators) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatAdministrators.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatAdministrators.tdCommon.Extra, err = r.readString()
+		case "administrators":
+			if r.readNull() {
+				chatAdministrators.Administrators = nil
+			} else {
+				chatAdministrators.Administrators = make([]ChatAdministrator, 0)
+				err = r.readArray(func() (err error) {
+					var item0 ChatAdministrator
+					err = item0.decodeJSON(r)
+					chatAdministrators.Administrators = append(chatAdministrators.Administrators, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatAdministrators *ChatAdministrators) Clone() *ChatAdministrators {
+	if chatAdministrators == nil {
+		return nil
+	}
+	clone := *chatAdministrators
+	if chatAdministrators.Administrators != nil {
+		clone.Administrators = make([]ChatAdministrator, len(chatAdministrators.Administrators))
+		for i0 := range chatAdministrators.Administrators {
+			clone.Administrators[i0] = *chatAdministrators.Administrators[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatAdministrators *ChatAdministrators) Equal(other *ChatAdministrators) bool {
+	if chatAdministrators == nil || other == nil {
+		return chatAdministrators == other
+	}
+	if len(chatAdministrators.Administrators) != len(other.Administrators) {
+		return false
+	}
+	for i0 := range chatAdministrators.Administrators {
+		if !chatAdministrators.Administrators[i0].Equal(&other.Administrators[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatAdministrators *ChatAdministrators) Diff(other *ChatAdministrators) []Change {
+	return chatAdministrators.diff("", other, nil)
+}
+
+func (chatAdministrators *ChatAdministrators) diff(path string, other *ChatAdministrators, changes []Change) []Change {
+	if chatAdministrators == nil || other == nil {
+		if chatAdministrators != other {
+			changes = append(changes, Change{Path: path, Old: chatAdministrators, New: other})
+		}
+		return changes
+	}
+	if len(chatAdministrators.Administrators) != len(other.Administrators) {
+		changes = append(changes, Change{Path: fieldPath(path, "administrators"), Old: chatAdministrators.Administrators, New: other.Administrators})
+	} else {
+		for i0 := range chatAdministrators.Administrators {
+			changes = chatAdministrators.Administrators[i0].diff(indexPath(fieldPath(path, "administrators"), i0), &other.Administrators[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatAdministrators *ChatAdministrators) String() string {
+	return objectString(chatAdministrators)
+}
+
+// LogValue logs the object as its summary
+func (chatAdministrators *ChatAdministrators) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatAdministrators))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatAdministrators *ChatAdministrators) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatAdministrators)
+}
+
+func (chatAdministrators *ChatAdministrators) printText(p *printer) {
+	if chatAdministrators == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatAdministrators")
+	p.vector("administrators", len(chatAdministrators.Administrators), func(i0 int) {
+		chatAdministrators.Administrators[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetChatAdministrators Returns a list of administrators of the chat with their custom titles
 // @param chatId Chat identifier
 func (client *Client) GetChatAdministrators(chatId int64) (*ChatAdministrators, error) {
@@ -40,11 +184,11 @@ func (client *Client) GetChatAdministrators(chatId int64) (*ChatAdministrators,
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chatAdministrators ChatAdministrators
-	err = json.Unmarshal(result.Raw, &chatAdministrators)
+	err = jsonUnmarshal(result.Raw, &chatAdministrators)
 	return &chatAdministrators, err
 }
@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // ChatEvent Represents a chat event
@@ -36,33 +37,142 @@ func NewChatEvent(id JSONInt64, date int32, memberId MessageSender, action ChatE
 	return &chatEventTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEvent *ChatEvent) MarshalJSON() ([]byte, error) {
+	return chatEvent.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (chatEvent *ChatEvent) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, chatEvent)
+}
+
+func (chatEvent *ChatEvent) appendJSON(b []byte) ([]byte, error) {
+	if chatEvent == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEvent.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEvent.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt64String(b, int64(chatEvent.Id))
+	b = append(b, `,"date":`...)
+	b = appendJSONInt(b, int64(chatEvent.Date))
+	b = append(b, `,"member_id":`...)
+	b, err = appendJSONValue(b, chatEvent.MemberId)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-		Id   JSONInt64 `json:"id"`   // Chat event identifier
-		Date int32     `json:"date"` // Point in time (Unix timestamp) when the event happened
-
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	b = append(b, `,"action":`...)
+	b, err = appendJSONValue(b, chatEvent.Action)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (chatEvent *ChatEvent) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEvent.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEvent.tdCommon.Extra, err = r.readString()
+		case "id":
+			chatEvent.Id, err = r.readJSONInt64()
+		case "date":
+			chatEvent.Date, err = r.readInt32()
+		case "member_id":
+			chatEvent.MemberId, err = decodeMessageSender(r)
+		case "action":
+			chatEvent.Action, err = decodeChatEventAction(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEvent *ChatEvent) Clone() *ChatEvent {
+	if chatEvent == nil {
+		return nil
+	}
+	clone := *chatEvent
+	clone.MemberId = cloneMessageSender(chatEvent.MemberId)
+	clone.Action = cloneChatEventAction(chatEvent.Action)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEvent *ChatEvent) Equal(other *ChatEvent) bool {
+	if chatEvent == nil || other == nil {
+		return chatEvent == other
+	}
+	if chatEvent.Id != other.Id {
+		return false
 	}
+	if chatEvent.Date != other.Date {
+		return false
+	}
+	if !equalMessageSender(chatEvent.MemberId, other.MemberId) {
+		return false
+	}
+	if !equalChatEventAction(chatEvent.Action, other.Action) {
+		return false
+	}
+	return true
+}
 
-	chatEvent.tdCommon = tempObj.tdCommon
-	chatEvent.Id = tempObj.Id
-	chatEvent.Date = tempObj.Date
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEvent *ChatEvent) Diff(other *ChatEvent) []Change {
+	return chatEvent.diff("", other, nil)
+}
 
-	fieldMemberId, _ := unmarshalMessageSender(objMap["member_id"])
-	chatEvent.MemberId = fieldMemberId
+func (chatEvent *ChatEvent) diff(path string, other *ChatEvent, changes []Change) []Change {
+	if chatEvent == nil || other == nil {
+		if chatEvent != other {
+			changes = append(changes, Change{Path: path, Old: chatEvent, New: other})
+		}
+		return changes
+	}
+	if chatEvent.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: chatEvent.Id, New: other.Id})
+	}
+	if chatEvent.Date != other.Date {
+		changes = append(changes, Change{Path: fieldPath(path, "date"), Old: chatEvent.Date, New: other.Date})
+	}
+	changes = diffMessageSender(fieldPath(path, "member_id"), chatEvent.MemberId, other.MemberId, changes)
+	changes = diffChatEventAction(fieldPath(path, "action"), chatEvent.Action, other.Action, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEvent *ChatEvent) String() string {
+	return objectString(chatEvent)
+}
 
-	fieldAction, _ := unmarshalChatEventAction(objMap["action"])
-	chatEvent.Action = fieldAction
+// LogValue logs the object as its summary
+func (chatEvent *ChatEvent) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEvent))
+}
 
-	return nil
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEvent *ChatEvent) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEvent)
+}
+
+func (chatEvent *ChatEvent) printText(p *printer) {
+	if chatEvent == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEvent")
+	p.int("id", int64(chatEvent.Id))
+	p.int("date", int64(chatEvent.Date))
+	p.object("member_id", chatEvent.MemberId)
+	p.object("action", chatEvent.Action)
+	p.endObject(state)
 }
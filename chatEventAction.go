@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ChatEventAction Represents a chat event
 type ChatEventAction interface {
 	GetChatEventActionEnum() ChatEventActionEnum
+	Accept(visitor ChatEventActionVisitor)
 }
 
 // ChatEventActionEnum Alias for abstract ChatEventAction 'Sub-Classes', used as constant-enum here
@@ -51,193 +53,244 @@ const (
 	ChatEventVideoChatMuteNewParticipantsToggledType    ChatEventActionEnum = "chatEventVideoChatMuteNewParticipantsToggled"
 )
 
+// UnknownChatEventAction is a ChatEventAction of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownChatEventAction struct {
+	Unknown
+}
+
+// GetChatEventActionEnum return the enum type of this object
+func (unknownChatEventAction *UnknownChatEventAction) GetChatEventActionEnum() ChatEventActionEnum {
+	return ChatEventActionEnum(unknownChatEventAction.Type)
+}
+
+// Accept calls the method of the visitor for UnknownChatEventAction
+func (unknownChatEventAction *UnknownChatEventAction) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitUnknownChatEventAction(unknownChatEventAction)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownChatEventAction *UnknownChatEventAction) String() string {
+	return objectString(unknownChatEventAction)
+}
+
+// LogValue logs the object as its summary
+func (unknownChatEventAction *UnknownChatEventAction) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownChatEventAction))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownChatEventAction *UnknownChatEventAction) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownChatEventAction)
+}
+
+func (unknownChatEventAction *UnknownChatEventAction) printText(p *printer) {
+	if unknownChatEventAction == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownChatEventAction")
+	p.string("@type", unknownChatEventAction.Type)
+	p.endObject(state)
+}
+
 func unmarshalChatEventAction(rawMsg *json.RawMessage) (ChatEventAction, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch ChatEventActionEnum(objMap["@type"].(string)) {
+	switch ChatEventActionEnum(typeName) {
 	case ChatEventMessageEditedType:
 		var chatEventMessageEdited ChatEventMessageEdited
-		err := json.Unmarshal(*rawMsg, &chatEventMessageEdited)
+		err := jsonUnmarshal(*rawMsg, &chatEventMessageEdited)
 		return &chatEventMessageEdited, err
 
 	case ChatEventMessageDeletedType:
 		var chatEventMessageDeleted ChatEventMessageDeleted
-		err := json.Unmarshal(*rawMsg, &chatEventMessageDeleted)
+		err := jsonUnmarshal(*rawMsg, &chatEventMessageDeleted)
 		return &chatEventMessageDeleted, err
 
 	case ChatEventPollStoppedType:
 		var chatEventPollStopped ChatEventPollStopped
-		err := json.Unmarshal(*rawMsg, &chatEventPollStopped)
+		err := jsonUnmarshal(*rawMsg, &chatEventPollStopped)
 		return &chatEventPollStopped, err
 
 	case ChatEventMessagePinnedType:
 		var chatEventMessagePinned ChatEventMessagePinned
-		err := json.Unmarshal(*rawMsg, &chatEventMessagePinned)
+		err := jsonUnmarshal(*rawMsg, &chatEventMessagePinned)
 		return &chatEventMessagePinned, err
 
 	case ChatEventMessageUnpinnedType:
 		var chatEventMessageUnpinned ChatEventMessageUnpinned
-		err := json.Unmarshal(*rawMsg, &chatEventMessageUnpinned)
+		err := jsonUnmarshal(*rawMsg, &chatEventMessageUnpinned)
 		return &chatEventMessageUnpinned, err
 
 	case ChatEventMemberJoinedType:
 		var chatEventMemberJoined ChatEventMemberJoined
-		err := json.Unmarshal(*rawMsg, &chatEventMemberJoined)
+		err := jsonUnmarshal(*rawMsg, &chatEventMemberJoined)
 		return &chatEventMemberJoined, err
 
 	case ChatEventMemberJoinedByInviteLinkType:
 		var chatEventMemberJoinedByInviteLink ChatEventMemberJoinedByInviteLink
-		err := json.Unmarshal(*rawMsg, &chatEventMemberJoinedByInviteLink)
+		err := jsonUnmarshal(*rawMsg, &chatEventMemberJoinedByInviteLink)
 		return &chatEventMemberJoinedByInviteLink, err
 
 	case ChatEventMemberJoinedByRequestType:
 		var chatEventMemberJoinedByRequest ChatEventMemberJoinedByRequest
-		err := json.Unmarshal(*rawMsg, &chatEventMemberJoinedByRequest)
+		err := jsonUnmarshal(*rawMsg, &chatEventMemberJoinedByRequest)
 		return &chatEventMemberJoinedByRequest, err
 
 	case ChatEventMemberLeftType:
 		var chatEventMemberLeft ChatEventMemberLeft
-		err := json.Unmarshal(*rawMsg, &chatEventMemberLeft)
+		err := jsonUnmarshal(*rawMsg, &chatEventMemberLeft)
 		return &chatEventMemberLeft, err
 
 	case ChatEventMemberInvitedType:
 		var chatEventMemberInvited ChatEventMemberInvited
-		err := json.Unmarshal(*rawMsg, &chatEventMemberInvited)
+		err := jsonUnmarshal(*rawMsg, &chatEventMemberInvited)
 		return &chatEventMemberInvited, err
 
 	case ChatEventMemberPromotedType:
 		var chatEventMemberPromoted ChatEventMemberPromoted
-		err := json.Unmarshal(*rawMsg, &chatEventMemberPromoted)
+		err := jsonUnmarshal(*rawMsg, &chatEventMemberPromoted)
 		return &chatEventMemberPromoted, err
 
 	case ChatEventMemberRestrictedType:
 		var chatEventMemberRestricted ChatEventMemberRestricted
-		err := json.Unmarshal(*rawMsg, &chatEventMemberRestricted)
+		err := jsonUnmarshal(*rawMsg, &chatEventMemberRestricted)
 		return &chatEventMemberRestricted, err
 
 	case ChatEventTitleChangedType:
 		var chatEventTitleChanged ChatEventTitleChanged
-		err := json.Unmarshal(*rawMsg, &chatEventTitleChanged)
+		err := jsonUnmarshal(*rawMsg, &chatEventTitleChanged)
 		return &chatEventTitleChanged, err
 
 	case ChatEventPermissionsChangedType:
 		var chatEventPermissionsChanged ChatEventPermissionsChanged
-		err := json.Unmarshal(*rawMsg, &chatEventPermissionsChanged)
+		err := jsonUnmarshal(*rawMsg, &chatEventPermissionsChanged)
 		return &chatEventPermissionsChanged, err
 
 	case ChatEventDescriptionChangedType:
 		var chatEventDescriptionChanged ChatEventDescriptionChanged
-		err := json.Unmarshal(*rawMsg, &chatEventDescriptionChanged)
+		err := jsonUnmarshal(*rawMsg, &chatEventDescriptionChanged)
 		return &chatEventDescriptionChanged, err
 
 	case ChatEventUsernameChangedType:
 		var chatEventUsernameChanged ChatEventUsernameChanged
-		err := json.Unmarshal(*rawMsg, &chatEventUsernameChanged)
+		err := jsonUnmarshal(*rawMsg, &chatEventUsernameChanged)
 		return &chatEventUsernameChanged, err
 
 	case ChatEventPhotoChangedType:
 		var chatEventPhotoChanged ChatEventPhotoChanged
-		err := json.Unmarshal(*rawMsg, &chatEventPhotoChanged)
+		err := jsonUnmarshal(*rawMsg, &chatEventPhotoChanged)
 		return &chatEventPhotoChanged, err
 
 	case ChatEventInvitesToggledType:
 		var chatEventInvitesToggled ChatEventInvitesToggled
-		err := json.Unmarshal(*rawMsg, &chatEventInvitesToggled)
+		err := jsonUnmarshal(*rawMsg, &chatEventInvitesToggled)
 		return &chatEventInvitesToggled, err
 
 	case ChatEventLinkedChatChangedType:
 		var chatEventLinkedChatChanged ChatEventLinkedChatChanged
-		err := json.Unmarshal(*rawMsg, &chatEventLinkedChatChanged)
+		err := jsonUnmarshal(*rawMsg, &chatEventLinkedChatChanged)
 		return &chatEventLinkedChatChanged, err
 
 	case ChatEventSlowModeDelayChangedType:
 		var chatEventSlowModeDelayChanged ChatEventSlowModeDelayChanged
-		err := json.Unmarshal(*rawMsg, &chatEventSlowModeDelayChanged)
+		err := jsonUnmarshal(*rawMsg, &chatEventSlowModeDelayChanged)
 		return &chatEventSlowModeDelayChanged, err
 
 	case ChatEventMessageTtlChangedType:
 		var chatEventMessageTtlChanged ChatEventMessageTtlChanged
-		err := json.Unmarshal(*rawMsg, &chatEventMessageTtlChanged)
+		err := jsonUnmarshal(*rawMsg, &chatEventMessageTtlChanged)
 		return &chatEventMessageTtlChanged, err
 
 	case ChatEventSignMessagesToggledType:
 		var chatEventSignMessagesToggled ChatEventSignMessagesToggled
-		err := json.Unmarshal(*rawMsg, &chatEventSignMessagesToggled)
+		err := jsonUnmarshal(*rawMsg, &chatEventSignMessagesToggled)
 		return &chatEventSignMessagesToggled, err
 
 	case ChatEventHasProtectedContentToggledType:
 		var chatEventHasProtectedContentToggled ChatEventHasProtectedContentToggled
-		err := json.Unmarshal(*rawMsg, &chatEventHasProtectedContentToggled)
+		err := jsonUnmarshal(*rawMsg, &chatEventHasProtectedContentToggled)
 		return &chatEventHasProtectedContentToggled, err
 
 	case ChatEventStickerSetChangedType:
 		var chatEventStickerSetChanged ChatEventStickerSetChanged
-		err := json.Unmarshal(*rawMsg, &chatEventStickerSetChanged)
+		err := jsonUnmarshal(*rawMsg, &chatEventStickerSetChanged)
 		return &chatEventStickerSetChanged, err
 
 	case ChatEventLocationChangedType:
 		var chatEventLocationChanged ChatEventLocationChanged
-		err := json.Unmarshal(*rawMsg, &chatEventLocationChanged)
+		err := jsonUnmarshal(*rawMsg, &chatEventLocationChanged)
 		return &chatEventLocationChanged, err
 
 	case ChatEventIsAllHistoryAvailableToggledType:
 		var chatEventIsAllHistoryAvailableToggled ChatEventIsAllHistoryAvailableToggled
-		err := json.Unmarshal(*rawMsg, &chatEventIsAllHistoryAvailableToggled)
+		err := jsonUnmarshal(*rawMsg, &chatEventIsAllHistoryAvailableToggled)
 		return &chatEventIsAllHistoryAvailableToggled, err
 
 	case ChatEventInviteLinkEditedType:
 		var chatEventInviteLinkEdited ChatEventInviteLinkEdited
-		err := json.Unmarshal(*rawMsg, &chatEventInviteLinkEdited)
+		err := jsonUnmarshal(*rawMsg, &chatEventInviteLinkEdited)
 		return &chatEventInviteLinkEdited, err
 
 	case ChatEventInviteLinkRevokedType:
 		var chatEventInviteLinkRevoked ChatEventInviteLinkRevoked
-		err := json.Unmarshal(*rawMsg, &chatEventInviteLinkRevoked)
+		err := jsonUnmarshal(*rawMsg, &chatEventInviteLinkRevoked)
 		return &chatEventInviteLinkRevoked, err
 
 	case ChatEventInviteLinkDeletedType:
 		var chatEventInviteLinkDeleted ChatEventInviteLinkDeleted
-		err := json.Unmarshal(*rawMsg, &chatEventInviteLinkDeleted)
+		err := jsonUnmarshal(*rawMsg, &chatEventInviteLinkDeleted)
 		return &chatEventInviteLinkDeleted, err
 
 	case ChatEventVideoChatCreatedType:
 		var chatEventVideoChatCreated ChatEventVideoChatCreated
-		err := json.Unmarshal(*rawMsg, &chatEventVideoChatCreated)
+		err := jsonUnmarshal(*rawMsg, &chatEventVideoChatCreated)
 		return &chatEventVideoChatCreated, err
 
 	case ChatEventVideoChatEndedType:
 		var chatEventVideoChatEnded ChatEventVideoChatEnded
-		err := json.Unmarshal(*rawMsg, &chatEventVideoChatEnded)
+		err := jsonUnmarshal(*rawMsg, &chatEventVideoChatEnded)
 		return &chatEventVideoChatEnded, err
 
 	case ChatEventVideoChatParticipantIsMutedToggledType:
 		var chatEventVideoChatParticipantIsMutedToggled ChatEventVideoChatParticipantIsMutedToggled
-		err := json.Unmarshal(*rawMsg, &chatEventVideoChatParticipantIsMutedToggled)
+		err := jsonUnmarshal(*rawMsg, &chatEventVideoChatParticipantIsMutedToggled)
 		return &chatEventVideoChatParticipantIsMutedToggled, err
 
 	case ChatEventVideoChatParticipantVolumeLevelChangedType:
 		var chatEventVideoChatParticipantVolumeLevelChanged ChatEventVideoChatParticipantVolumeLevelChanged
-		err := json.Unmarshal(*rawMsg, &chatEventVideoChatParticipantVolumeLevelChanged)
+		err := jsonUnmarshal(*rawMsg, &chatEventVideoChatParticipantVolumeLevelChanged)
 		return &chatEventVideoChatParticipantVolumeLevelChanged, err
 
 	case ChatEventVideoChatMuteNewParticipantsToggledType:
 		var chatEventVideoChatMuteNewParticipantsToggled ChatEventVideoChatMuteNewParticipantsToggled
-		err := json.Unmarshal(*rawMsg, &chatEventVideoChatMuteNewParticipantsToggled)
+		err := jsonUnmarshal(*rawMsg, &chatEventVideoChatMuteNewParticipantsToggled)
 		return &chatEventVideoChatMuteNewParticipantsToggled, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownChatEventAction{Unknown: unknown}, nil
 	}
 }
 
+func decodeChatEventAction(r *jsonReader) (ChatEventAction, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalChatEventAction(rawMsg)
+}
+
 // ChatEventMessageEdited A message was edited
 type ChatEventMessageEdited struct {
 	tdCommon
@@ -264,11 +317,144 @@ func NewChatEventMessageEdited(oldMessage *Message, newMessage *Message) *ChatEv
 	return &chatEventMessageEditedTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventMessageEdited *ChatEventMessageEdited) MarshalJSON() ([]byte, error) {
+	return chatEventMessageEdited.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEventMessageEdited *ChatEventMessageEdited) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventMessageEdited)
+}
+
+func (chatEventMessageEdited *ChatEventMessageEdited) appendJSON(b []byte) ([]byte, error) {
+	if chatEventMessageEdited == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventMessageEdited.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventMessageEdited.tdCommon.Extra)
+	b = append(b, `,"old_message":`...)
+	b, err = chatEventMessageEdited.OldMessage.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"new_message":`...)
+	b, err = chatEventMessageEdited.NewMessage.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (chatEventMessageEdited *ChatEventMessageEdited) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventMessageEdited.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventMessageEdited.tdCommon.Extra, err = r.readString()
+		case "old_message":
+			if r.readNull() {
+				chatEventMessageEdited.OldMessage = nil
+			} else {
+				chatEventMessageEdited.OldMessage = new(Message)
+				err = chatEventMessageEdited.OldMessage.decodeJSON(r)
+			}
+		case "new_message":
+			if r.readNull() {
+				chatEventMessageEdited.NewMessage = nil
+			} else {
+				chatEventMessageEdited.NewMessage = new(Message)
+				err = chatEventMessageEdited.NewMessage.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventMessageEdited *ChatEventMessageEdited) Clone() *ChatEventMessageEdited {
+	if chatEventMessageEdited == nil {
+		return nil
+	}
+	clone := *chatEventMessageEdited
+	clone.OldMessage = chatEventMessageEdited.OldMessage.Clone()
+	clone.NewMessage = chatEventMessageEdited.NewMessage.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventMessageEdited *ChatEventMessageEdited) Equal(other *ChatEventMessageEdited) bool {
+	if chatEventMessageEdited == nil || other == nil {
+		return chatEventMessageEdited == other
+	}
+	if !chatEventMessageEdited.OldMessage.Equal(other.OldMessage) {
+		return false
+	}
+	if !chatEventMessageEdited.NewMessage.Equal(other.NewMessage) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventMessageEdited *ChatEventMessageEdited) Diff(other *ChatEventMessageEdited) []Change {
+	return chatEventMessageEdited.diff("", other, nil)
+}
+
+func (chatEventMessageEdited *ChatEventMessageEdited) diff(path string, other *ChatEventMessageEdited, changes []Change) []Change {
+	if chatEventMessageEdited == nil || other == nil {
+		if chatEventMessageEdited != other {
+			changes = append(changes, Change{Path: path, Old: chatEventMessageEdited, New: other})
+		}
+		return changes
+	}
+	changes = chatEventMessageEdited.OldMessage.diff(fieldPath(path, "old_message"), other.OldMessage, changes)
+	changes = chatEventMessageEdited.NewMessage.diff(fieldPath(path, "new_message"), other.NewMessage, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventMessageEdited *ChatEventMessageEdited) String() string {
+	return objectString(chatEventMessageEdited)
+}
+
+// LogValue logs the object as its summary
+func (chatEventMessageEdited *ChatEventMessageEdited) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventMessageEdited))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventMessageEdited *ChatEventMessageEdited) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventMessageEdited)
+}
+
+func (chatEventMessageEdited *ChatEventMessageEdited) printText(p *printer) {
+	if chatEventMessageEdited == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventMessageEdited")
+	p.object("old_message", chatEventMessageEdited.OldMessage)
+	p.object("new_message", chatEventMessageEdited.NewMessage)
+	p.endObject(state)
+}
+
 // GetChatEventActionEnum return the enum type of this object
 func (chatEventMessageEdited *ChatEventMessageEdited) GetChatEventActionEnum() ChatEventActionEnum {
 	return ChatEventMessageEditedType
 }
 
+// Accept calls the method of the visitor for ChatEventMessageEdited
+func (chatEventMessageEdited *ChatEventMessageEdited) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventMessageEdited(chatEventMessageEdited)
+}
+
 // ChatEventMessageDeleted A message was deleted
 type ChatEventMessageDeleted struct {
 	tdCommon
@@ -292,11 +478,126 @@ func NewChatEventMessageDeleted(message *Message) *ChatEventMessageDeleted {
 	return &chatEventMessageDeletedTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventMessageDeleted *ChatEventMessageDeleted) MarshalJSON() ([]byte, error) {
+	return chatEventMessageDeleted.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEventMessageDeleted *ChatEventMessageDeleted) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventMessageDeleted)
+}
+
+func (chatEventMessageDeleted *ChatEventMessageDeleted) appendJSON(b []byte) ([]byte, error) {
+	if chatEventMessageDeleted == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventMessageDeleted.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventMessageDeleted.tdCommon.Extra)
+	b = append(b, `,"message":`...)
+	b, err = chatEventMessageDeleted.Message.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (chatEventMessageDeleted *ChatEventMessageDeleted) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventMessageDeleted.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventMessageDeleted.tdCommon.Extra, err = r.readString()
+		case "message":
+			if r.readNull() {
+				chatEventMessageDeleted.Message = nil
+			} else {
+				chatEventMessageDeleted.Message = new(Message)
+				err = chatEventMessageDeleted.Message.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventMessageDeleted *ChatEventMessageDeleted) Clone() *ChatEventMessageDeleted {
+	if chatEventMessageDeleted == nil {
+		return nil
+	}
+	clone := *chatEventMessageDeleted
+	clone.Message = chatEventMessageDeleted.Message.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventMessageDeleted *ChatEventMessageDeleted) Equal(other *ChatEventMessageDeleted) bool {
+	if chatEventMessageDeleted == nil || other == nil {
+		return chatEventMessageDeleted == other
+	}
+	if !chatEventMessageDeleted.Message.Equal(other.Message) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventMessageDeleted *ChatEventMessageDeleted) Diff(other *ChatEventMessageDeleted) []Change {
+	return chatEventMessageDeleted.diff("", other, nil)
+}
+
+func (chatEventMessageDeleted *ChatEventMessageDeleted) diff(path string, other *ChatEventMessageDeleted, changes []Change) []Change {
+	if chatEventMessageDeleted == nil || other == nil {
+		if chatEventMessageDeleted != other {
+			changes = append(changes, Change{Path: path, Old: chatEventMessageDeleted, New: other})
+		}
+		return changes
+	}
+	changes = chatEventMessageDeleted.Message.diff(fieldPath(path, "message"), other.Message, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventMessageDeleted *ChatEventMessageDeleted) String() string {
+	return objectString(chatEventMessageDeleted)
+}
+
+// LogValue logs the object as its summary
+func (chatEventMessageDeleted *ChatEventMessageDeleted) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventMessageDeleted))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventMessageDeleted *ChatEventMessageDeleted) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventMessageDeleted)
+}
+
+func (chatEventMessageDeleted *ChatEventMessageDeleted) printText(p *printer) {
+	if chatEventMessageDeleted == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventMessageDeleted")
+	p.object("message", chatEventMessageDeleted.Message)
+	p.endObject(state)
+}
+
 // GetChatEventActionEnum return the enum type of this object
 func (chatEventMessageDeleted *ChatEventMessageDeleted) GetChatEventActionEnum() ChatEventActionEnum {
 	return ChatEventMessageDeletedType
 }
 
+// Accept calls the method of the visitor for ChatEventMessageDeleted
+func (chatEventMessageDeleted *ChatEventMessageDeleted) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventMessageDeleted(chatEventMessageDeleted)
+}
+
 // ChatEventPollStopped A poll in a message was stopped
 type ChatEventPollStopped struct {
 	tdCommon
@@ -320,11 +621,126 @@ func NewChatEventPollStopped(message *Message) *ChatEventPollStopped {
 	return &chatEventPollStoppedTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventPollStopped *ChatEventPollStopped) MarshalJSON() ([]byte, error) {
+	return chatEventPollStopped.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEventPollStopped *ChatEventPollStopped) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventPollStopped)
+}
+
+func (chatEventPollStopped *ChatEventPollStopped) appendJSON(b []byte) ([]byte, error) {
+	if chatEventPollStopped == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventPollStopped.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventPollStopped.tdCommon.Extra)
+	b = append(b, `,"message":`...)
+	b, err = chatEventPollStopped.Message.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (chatEventPollStopped *ChatEventPollStopped) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventPollStopped.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventPollStopped.tdCommon.Extra, err = r.readString()
+		case "message":
+			if r.readNull() {
+				chatEventPollStopped.Message = nil
+			} else {
+				chatEventPollStopped.Message = new(Message)
+				err = chatEventPollStopped.Message.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventPollStopped *ChatEventPollStopped) Clone() *ChatEventPollStopped {
+	if chatEventPollStopped == nil {
+		return nil
+	}
+	clone := *chatEventPollStopped
+	clone.Message = chatEventPollStopped.Message.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventPollStopped *ChatEventPollStopped) Equal(other *ChatEventPollStopped) bool {
+	if chatEventPollStopped == nil || other == nil {
+		return chatEventPollStopped == other
+	}
+	if !chatEventPollStopped.Message.Equal(other.Message) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventPollStopped *ChatEventPollStopped) Diff(other *ChatEventPollStopped) []Change {
+	return chatEventPollStopped.diff("", other, nil)
+}
+
+func (chatEventPollStopped *ChatEventPollStopped) diff(path string, other *ChatEventPollStopped, changes []Change) []Change {
+	if chatEventPollStopped == nil || other == nil {
+		if chatEventPollStopped != other {
+			changes = append(changes, Change{Path: path, Old: chatEventPollStopped, New: other})
+		}
+		return changes
+	}
+	changes = chatEventPollStopped.Message.diff(fieldPath(path, "message"), other.Message, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventPollStopped *ChatEventPollStopped) String() string {
+	return objectString(chatEventPollStopped)
+}
+
+// LogValue logs the object as its summary
+func (chatEventPollStopped *ChatEventPollStopped) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventPollStopped))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventPollStopped *ChatEventPollStopped) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventPollStopped)
+}
+
+func (chatEventPollStopped *ChatEventPollStopped) printText(p *printer) {
+	if chatEventPollStopped == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventPollStopped")
+	p.object("message", chatEventPollStopped.Message)
+	p.endObject(state)
+}
+
 // GetChatEventActionEnum return the enum type of this object
 func (chatEventPollStopped *ChatEventPollStopped) GetChatEventActionEnum() ChatEventActionEnum {
 	return ChatEventPollStoppedType
 }
 
+// Accept calls the method of the visitor for ChatEventPollStopped
+func (chatEventPollStopped *ChatEventPollStopped) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventPollStopped(chatEventPollStopped)
+}
+
 // ChatEventMessagePinned A message was pinned
 type ChatEventMessagePinned struct {
 	tdCommon
@@ -348,11 +764,126 @@ func NewChatEventMessagePinned(message *Message) *ChatEventMessagePinned {
 	return &chatEventMessagePinnedTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventMessagePinned *ChatEventMessagePinned) MarshalJSON() ([]byte, error) {
+	return chatEventMessagePinned.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEventMessagePinned *ChatEventMessagePinned) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventMessagePinned)
+}
+
+func (chatEventMessagePinned *ChatEventMessagePinned) appendJSON(b []byte) ([]byte, error) {
+	if chatEventMessagePinned == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventMessagePinned.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventMessagePinned.tdCommon.Extra)
+	b = append(b, `,"message":`...)
+	b, err = chatEventMessagePinned.Message.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (chatEventMessagePinned *ChatEventMessagePinned) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventMessagePinned.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventMessagePinned.tdCommon.Extra, err = r.readString()
+		case "message":
+			if r.readNull() {
+				chatEventMessagePinned.Message = nil
+			} else {
+				chatEventMessagePinned.Message = new(Message)
+				err = chatEventMessagePinned.Message.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventMessagePinned *ChatEventMessagePinned) Clone() *ChatEventMessagePinned {
+	if chatEventMessagePinned == nil {
+		return nil
+	}
+	clone := *chatEventMessagePinned
+	clone.Message = chatEventMessagePinned.Message.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventMessagePinned *ChatEventMessagePinned) Equal(other *ChatEventMessagePinned) bool {
+	if chatEventMessagePinned == nil || other == nil {
+		return chatEventMessagePinned == other
+	}
+	if !chatEventMessagePinned.Message.Equal(other.Message) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventMessagePinned *ChatEventMessagePinned) Diff(other *ChatEventMessagePinned) []Change {
+	return chatEventMessagePinned.diff("", other, nil)
+}
+
+func (chatEventMessagePinned *ChatEventMessagePinned) diff(path string, other *ChatEventMessagePinned, changes []Change) []Change {
+	if chatEventMessagePinned == nil || other == nil {
+		if chatEventMessagePinned != other {
+			changes = append(changes, Change{Path: path, Old: chatEventMessagePinned, New: other})
+		}
+		return changes
+	}
+	changes = chatEventMessagePinned.Message.diff(fieldPath(path, "message"), other.Message, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventMessagePinned *ChatEventMessagePinned) String() string {
+	return objectString(chatEventMessagePinned)
+}
+
+// LogValue logs the object as its summary
+func (chatEventMessagePinned *ChatEventMessagePinned) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventMessagePinned))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventMessagePinned *ChatEventMessagePinned) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventMessagePinned)
+}
+
+func (chatEventMessagePinned *ChatEventMessagePinned) printText(p *printer) {
+	if chatEventMessagePinned == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventMessagePinned")
+	p.object("message", chatEventMessagePinned.Message)
+	p.endObject(state)
+}
+
 // GetChatEventActionEnum return the enum type of this object
 func (chatEventMessagePinned *ChatEventMessagePinned) GetChatEventActionEnum() ChatEventActionEnum {
 	return ChatEventMessagePinnedType
 }
 
+// Accept calls the method of the visitor for ChatEventMessagePinned
+func (chatEventMessagePinned *ChatEventMessagePinned) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventMessagePinned(chatEventMessagePinned)
+}
+
 // ChatEventMessageUnpinned A message was unpinned
 type ChatEventMessageUnpinned struct {
 	tdCommon
@@ -376,11 +907,126 @@ func NewChatEventMessageUnpinned(message *Message) *ChatEventMessageUnpinned {
 	return &chatEventMessageUnpinnedTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventMessageUnpinned *ChatEventMessageUnpinned) MarshalJSON() ([]byte, error) {
+	return chatEventMessageUnpinned.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEventMessageUnpinned *ChatEventMessageUnpinned) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventMessageUnpinned)
+}
+
+func (chatEventMessageUnpinned *ChatEventMessageUnpinned) appendJSON(b []byte) ([]byte, error) {
+	if chatEventMessageUnpinned == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventMessageUnpinned.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventMessageUnpinned.tdCommon.Extra)
+	b = append(b, `,"message":`...)
+	b, err = chatEventMessageUnpinned.Message.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (chatEventMessageUnpinned *ChatEventMessageUnpinned) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventMessageUnpinned.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventMessageUnpinned.tdCommon.Extra, err = r.readString()
+		case "message":
+			if r.readNull() {
+				chatEventMessageUnpinned.Message = nil
+			} else {
+				chatEventMessageUnpinned.Message = new(Message)
+				err = chatEventMessageUnpinned.Message.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventMessageUnpinned *ChatEventMessageUnpinned) Clone() *ChatEventMessageUnpinned {
+	if chatEventMessageUnpinned == nil {
+		return nil
+	}
+	clone := *chatEventMessageUnpinned
+	clone.Message = chatEventMessageUnpinned.Message.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventMessageUnpinned *ChatEventMessageUnpinned) Equal(other *ChatEventMessageUnpinned) bool {
+	if chatEventMessageUnpinned == nil || other == nil {
+		return chatEventMessageUnpinned == other
+	}
+	if !chatEventMessageUnpinned.Message.Equal(other.Message) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventMessageUnpinned *ChatEventMessageUnpinned) Diff(other *ChatEventMessageUnpinned) []Change {
+	return chatEventMessageUnpinned.diff("", other, nil)
+}
+
+func (chatEventMessageUnpinned *ChatEventMessageUnpinned) diff(path string, other *ChatEventMessageUnpinned, changes []Change) []Change {
+	if chatEventMessageUnpinned == nil || other == nil {
+		if chatEventMessageUnpinned != other {
+			changes = append(changes, Change{Path: path, Old: chatEventMessageUnpinned, New: other})
+		}
+		return changes
+	}
+	changes = chatEventMessageUnpinned.Message.diff(fieldPath(path, "message"), other.Message, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventMessageUnpinned *ChatEventMessageUnpinned) String() string {
+	return objectString(chatEventMessageUnpinned)
+}
+
+// LogValue logs the object as its summary
+func (chatEventMessageUnpinned *ChatEventMessageUnpinned) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventMessageUnpinned))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventMessageUnpinned *ChatEventMessageUnpinned) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventMessageUnpinned)
+}
+
+func (chatEventMessageUnpinned *ChatEventMessageUnpinned) printText(p *printer) {
+	if chatEventMessageUnpinned == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventMessageUnpinned")
+	p.object("message", chatEventMessageUnpinned.Message)
+	p.endObject(state)
+}
+
 // GetChatEventActionEnum return the enum type of this object
 func (chatEventMessageUnpinned *ChatEventMessageUnpinned) GetChatEventActionEnum() ChatEventActionEnum {
 	return ChatEventMessageUnpinnedType
 }
 
+// Accept calls the method of the visitor for ChatEventMessageUnpinned
+func (chatEventMessageUnpinned *ChatEventMessageUnpinned) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventMessageUnpinned(chatEventMessageUnpinned)
+}
+
 // ChatEventMemberJoined A new member joined the chat
 type ChatEventMemberJoined struct {
 	tdCommon
@@ -392,7 +1038,6 @@ func (chatEventMemberJoined *ChatEventMemberJoined) MessageType() string {
 }
 
 // NewChatEventMemberJoined creates a new ChatEventMemberJoined
-//
 func NewChatEventMemberJoined() *ChatEventMemberJoined {
 	chatEventMemberJoinedTemp := ChatEventMemberJoined{
 		tdCommon: tdCommon{Type: "chatEventMemberJoined"},
@@ -401,11 +1046,107 @@ func NewChatEventMemberJoined() *ChatEventMemberJoined {
 	return &chatEventMemberJoinedTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventMemberJoined *ChatEventMemberJoined) MarshalJSON() ([]byte, error) {
+	return chatEventMemberJoined.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEventMemberJoined *ChatEventMemberJoined) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventMemberJoined)
+}
+
+func (chatEventMemberJoined *ChatEventMemberJoined) appendJSON(b []byte) ([]byte, error) {
+	if chatEventMemberJoined == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventMemberJoined.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventMemberJoined.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatEventMemberJoined *ChatEventMemberJoined) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventMemberJoined.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventMemberJoined.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventMemberJoined *ChatEventMemberJoined) Clone() *ChatEventMemberJoined {
+	if chatEventMemberJoined == nil {
+		return nil
+	}
+	clone := *chatEventMemberJoined
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventMemberJoined *ChatEventMemberJoined) Equal(other *ChatEventMemberJoined) bool {
+	if chatEventMemberJoined == nil || other == nil {
+		return chatEventMemberJoined == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventMemberJoined *ChatEventMemberJoined) Diff(other *ChatEventMemberJoined) []Change {
+	return chatEventMemberJoined.diff("", other, nil)
+}
+
+func (chatEventMemberJoined *ChatEventMemberJoined) diff(path string, other *ChatEventMemberJoined, changes []Change) []Change {
+	if chatEventMemberJoined == nil || other == nil {
+		if chatEventMemberJoined != other {
+			changes = append(changes, Change{Path: path, Old: chatEventMemberJoined, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventMemberJoined *ChatEventMemberJoined) String() string {
+	return objectString(chatEventMemberJoined)
+}
+
+// LogValue logs the object as its summary
+func (chatEventMemberJoined *ChatEventMemberJoined) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventMemberJoined))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventMemberJoined *ChatEventMemberJoined) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventMemberJoined)
+}
+
+func (chatEventMemberJoined *ChatEventMemberJoined) printText(p *printer) {
+	if chatEventMemberJoined == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventMemberJoined")
+	p.endObject(state)
+}
+
 // GetChatEventActionEnum return the enum type of this object
 func (chatEventMemberJoined *ChatEventMemberJoined) GetChatEventActionEnum() ChatEventActionEnum {
 	return ChatEventMemberJoinedType
 }
 
+// Accept calls the method of the visitor for ChatEventMemberJoined
+func (chatEventMemberJoined *ChatEventMemberJoined) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventMemberJoined(chatEventMemberJoined)
+}
+
 // ChatEventMemberJoinedByInviteLink A new member joined the chat via an invite link
 type ChatEventMemberJoinedByInviteLink struct {
 	tdCommon
@@ -429,11 +1170,126 @@ func NewChatEventMemberJoinedByInviteLink(inviteLink *ChatInviteLink) *ChatEvent
 	return &chatEventMemberJoinedByInviteLinkTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventMemberJoinedByInviteLink *ChatEventMemberJoinedByInviteLink) MarshalJSON() ([]byte, error) {
+	return chatEventMemberJoinedByInviteLink.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEventMemberJoinedByInviteLink *ChatEventMemberJoinedByInviteLink) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventMemberJoinedByInviteLink)
+}
+
+func (chatEventMemberJoinedByInviteLink *ChatEventMemberJoinedByInviteLink) appendJSON(b []byte) ([]byte, error) {
+	if chatEventMemberJoinedByInviteLink == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventMemberJoinedByInviteLink.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventMemberJoinedByInviteLink.tdCommon.Extra)
+	b = append(b, `,"invite_link":`...)
+	b, err = chatEventMemberJoinedByInviteLink.InviteLink.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (chatEventMemberJoinedByInviteLink *ChatEventMemberJoinedByInviteLink) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventMemberJoinedByInviteLink.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventMemberJoinedByInviteLink.tdCommon.Extra, err = r.readString()
+		case "invite_link":
+			if r.readNull() {
+				chatEventMemberJoinedByInviteLink.InviteLink = nil
+			} else {
+				chatEventMemberJoinedByInviteLink.InviteLink = new(ChatInviteLink)
+				err = chatEventMemberJoinedByInviteLink.InviteLink.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventMemberJoinedByInviteLink *ChatEventMemberJoinedByInviteLink) Clone() *ChatEventMemberJoinedByInviteLink {
+	if chatEventMemberJoinedByInviteLink == nil {
+		return nil
+	}
+	clone := *chatEventMemberJoinedByInviteLink
+	clone.InviteLink = chatEventMemberJoinedByInviteLink.InviteLink.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventMemberJoinedByInviteLink *ChatEventMemberJoinedByInviteLink) Equal(other *ChatEventMemberJoinedByInviteLink) bool {
+	if chatEventMemberJoinedByInviteLink == nil || other == nil {
+		return chatEventMemberJoinedByInviteLink == other
+	}
+	if !chatEventMemberJoinedByInviteLink.InviteLink.Equal(other.InviteLink) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventMemberJoinedByInviteLink *ChatEventMemberJoinedByInviteLink) Diff(other *ChatEventMemberJoinedByInviteLink) []Change {
+	return chatEventMemberJoinedByInviteLink.diff("", other, nil)
+}
+
+func (chatEventMemberJoinedByInviteLink *ChatEventMemberJoinedByInviteLink) diff(path string, other *ChatEventMemberJoinedByInviteLink, changes []Change) []Change {
+	if chatEventMemberJoinedByInviteLink == nil || other == nil {
+		if chatEventMemberJoinedByInviteLink != other {
+			changes = append(changes, Change{Path: path, Old: chatEventMemberJoinedByInviteLink, New: other})
+		}
+		return changes
+	}
+	changes = chatEventMemberJoinedByInviteLink.InviteLink.diff(fieldPath(path, "invite_link"), other.InviteLink, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventMemberJoinedByInviteLink *ChatEventMemberJoinedByInviteLink) String() string {
+	return objectString(chatEventMemberJoinedByInviteLink)
+}
+
+// LogValue logs the object as its summary
+func (chatEventMemberJoinedByInviteLink *ChatEventMemberJoinedByInviteLink) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventMemberJoinedByInviteLink))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventMemberJoinedByInviteLink *ChatEventMemberJoinedByInviteLink) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventMemberJoinedByInviteLink)
+}
+
+func (chatEventMemberJoinedByInviteLink *ChatEventMemberJoinedByInviteLink) printText(p *printer) {
+	if chatEventMemberJoinedByInviteLink == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventMemberJoinedByInviteLink")
+	p.object("invite_link", chatEventMemberJoinedByInviteLink.InviteLink)
+	p.endObject(state)
+}
+
 // GetChatEventActionEnum return the enum type of this object
 func (chatEventMemberJoinedByInviteLink *ChatEventMemberJoinedByInviteLink) GetChatEventActionEnum() ChatEventActionEnum {
 	return ChatEventMemberJoinedByInviteLinkType
 }
 
+// Accept calls the method of the visitor for ChatEventMemberJoinedByInviteLink
+func (chatEventMemberJoinedByInviteLink *ChatEventMemberJoinedByInviteLink) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventMemberJoinedByInviteLink(chatEventMemberJoinedByInviteLink)
+}
+
 // ChatEventMemberJoinedByRequest A new member was accepted to the chat by an administrator
 type ChatEventMemberJoinedByRequest struct {
 	tdCommon
@@ -460,23 +1316,148 @@ func NewChatEventMemberJoinedByRequest(approverUserId int64, inviteLink *ChatInv
 	return &chatEventMemberJoinedByRequestTemp
 }
 
-// GetChatEventActionEnum return the enum type of this object
-func (chatEventMemberJoinedByRequest *ChatEventMemberJoinedByRequest) GetChatEventActionEnum() ChatEventActionEnum {
-	return ChatEventMemberJoinedByRequestType
+// MarshalJSON marshals to json
+func (chatEventMemberJoinedByRequest *ChatEventMemberJoinedByRequest) MarshalJSON() ([]byte, error) {
+	return chatEventMemberJoinedByRequest.appendJSON(nil)
 }
 
-// ChatEventMemberLeft A member left the chat
-type ChatEventMemberLeft struct {
-	tdCommon
+// UnmarshalJSON unmarshal to json
+func (chatEventMemberJoinedByRequest *ChatEventMemberJoinedByRequest) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventMemberJoinedByRequest)
 }
 
-// MessageType return the string telegram-type of ChatEventMemberLeft
-func (chatEventMemberLeft *ChatEventMemberLeft) MessageType() string {
-	return "chatEventMemberLeft"
+func (chatEventMemberJoinedByRequest *ChatEventMemberJoinedByRequest) appendJSON(b []byte) ([]byte, error) {
+	if chatEventMemberJoinedByRequest == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventMemberJoinedByRequest.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventMemberJoinedByRequest.tdCommon.Extra)
+	b = append(b, `,"approver_user_id":`...)
+	b = appendJSONInt(b, int64(chatEventMemberJoinedByRequest.ApproverUserId))
+	b = append(b, `,"invite_link":`...)
+	b, err = chatEventMemberJoinedByRequest.InviteLink.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (chatEventMemberJoinedByRequest *ChatEventMemberJoinedByRequest) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventMemberJoinedByRequest.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventMemberJoinedByRequest.tdCommon.Extra, err = r.readString()
+		case "approver_user_id":
+			chatEventMemberJoinedByRequest.ApproverUserId, err = r.readInt64()
+		case "invite_link":
+			if r.readNull() {
+				chatEventMemberJoinedByRequest.InviteLink = nil
+			} else {
+				chatEventMemberJoinedByRequest.InviteLink = new(ChatInviteLink)
+				err = chatEventMemberJoinedByRequest.InviteLink.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventMemberJoinedByRequest *ChatEventMemberJoinedByRequest) Clone() *ChatEventMemberJoinedByRequest {
+	if chatEventMemberJoinedByRequest == nil {
+		return nil
+	}
+	clone := *chatEventMemberJoinedByRequest
+	clone.InviteLink = chatEventMemberJoinedByRequest.InviteLink.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventMemberJoinedByRequest *ChatEventMemberJoinedByRequest) Equal(other *ChatEventMemberJoinedByRequest) bool {
+	if chatEventMemberJoinedByRequest == nil || other == nil {
+		return chatEventMemberJoinedByRequest == other
+	}
+	if chatEventMemberJoinedByRequest.ApproverUserId != other.ApproverUserId {
+		return false
+	}
+	if !chatEventMemberJoinedByRequest.InviteLink.Equal(other.InviteLink) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventMemberJoinedByRequest *ChatEventMemberJoinedByRequest) Diff(other *ChatEventMemberJoinedByRequest) []Change {
+	return chatEventMemberJoinedByRequest.diff("", other, nil)
+}
+
+func (chatEventMemberJoinedByRequest *ChatEventMemberJoinedByRequest) diff(path string, other *ChatEventMemberJoinedByRequest, changes []Change) []Change {
+	if chatEventMemberJoinedByRequest == nil || other == nil {
+		if chatEventMemberJoinedByRequest != other {
+			changes = append(changes, Change{Path: path, Old: chatEventMemberJoinedByRequest, New: other})
+		}
+		return changes
+	}
+	if chatEventMemberJoinedByRequest.ApproverUserId != other.ApproverUserId {
+		changes = append(changes, Change{Path: fieldPath(path, "approver_user_id"), Old: chatEventMemberJoinedByRequest.ApproverUserId, New: other.ApproverUserId})
+	}
+	changes = chatEventMemberJoinedByRequest.InviteLink.diff(fieldPath(path, "invite_link"), other.InviteLink, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventMemberJoinedByRequest *ChatEventMemberJoinedByRequest) String() string {
+	return objectString(chatEventMemberJoinedByRequest)
+}
+
+// LogValue logs the object as its summary
+func (chatEventMemberJoinedByRequest *ChatEventMemberJoinedByRequest) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventMemberJoinedByRequest))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventMemberJoinedByRequest *ChatEventMemberJoinedByRequest) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventMemberJoinedByRequest)
+}
+
+func (chatEventMemberJoinedByRequest *ChatEventMemberJoinedByRequest) printText(p *printer) {
+	if chatEventMemberJoinedByRequest == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventMemberJoinedByRequest")
+	p.int("approver_user_id", int64(chatEventMemberJoinedByRequest.ApproverUserId))
+	p.object("invite_link", chatEventMemberJoinedByRequest.InviteLink)
+	p.endObject(state)
+}
+
+// GetChatEventActionEnum return the enum type of this object
+func (chatEventMemberJoinedByRequest *ChatEventMemberJoinedByRequest) GetChatEventActionEnum() ChatEventActionEnum {
+	return ChatEventMemberJoinedByRequestType
+}
+
+// Accept calls the method of the visitor for ChatEventMemberJoinedByRequest
+func (chatEventMemberJoinedByRequest *ChatEventMemberJoinedByRequest) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventMemberJoinedByRequest(chatEventMemberJoinedByRequest)
+}
+
+// ChatEventMemberLeft A member left the chat
+type ChatEventMemberLeft struct {
+	tdCommon
+}
+
+// MessageType return the string telegram-type of ChatEventMemberLeft
+func (chatEventMemberLeft *ChatEventMemberLeft) MessageType() string {
+	return "chatEventMemberLeft"
 }
 
 // NewChatEventMemberLeft creates a new ChatEventMemberLeft
-//
 func NewChatEventMemberLeft() *ChatEventMemberLeft {
 	chatEventMemberLeftTemp := ChatEventMemberLeft{
 		tdCommon: tdCommon{Type: "chatEventMemberLeft"},
@@ -485,11 +1466,107 @@ func NewChatEventMemberLeft() *ChatEventMemberLeft {
 	return &chatEventMemberLeftTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventMemberLeft *ChatEventMemberLeft) MarshalJSON() ([]byte, error) {
+	return chatEventMemberLeft.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEventMemberLeft *ChatEventMemberLeft) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventMemberLeft)
+}
+
+func (chatEventMemberLeft *ChatEventMemberLeft) appendJSON(b []byte) ([]byte, error) {
+	if chatEventMemberLeft == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventMemberLeft.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventMemberLeft.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatEventMemberLeft *ChatEventMemberLeft) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventMemberLeft.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventMemberLeft.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventMemberLeft *ChatEventMemberLeft) Clone() *ChatEventMemberLeft {
+	if chatEventMemberLeft == nil {
+		return nil
+	}
+	clone := *chatEventMemberLeft
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventMemberLeft *ChatEventMemberLeft) Equal(other *ChatEventMemberLeft) bool {
+	if chatEventMemberLeft == nil || other == nil {
+		return chatEventMemberLeft == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventMemberLeft *ChatEventMemberLeft) Diff(other *ChatEventMemberLeft) []Change {
+	return chatEventMemberLeft.diff("", other, nil)
+}
+
+func (chatEventMemberLeft *ChatEventMemberLeft) diff(path string, other *ChatEventMemberLeft, changes []Change) []Change {
+	if chatEventMemberLeft == nil || other == nil {
+		if chatEventMemberLeft != other {
+			changes = append(changes, Change{Path: path, Old: chatEventMemberLeft, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventMemberLeft *ChatEventMemberLeft) String() string {
+	return objectString(chatEventMemberLeft)
+}
+
+// LogValue logs the object as its summary
+func (chatEventMemberLeft *ChatEventMemberLeft) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventMemberLeft))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventMemberLeft *ChatEventMemberLeft) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventMemberLeft)
+}
+
+func (chatEventMemberLeft *ChatEventMemberLeft) printText(p *printer) {
+	if chatEventMemberLeft == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventMemberLeft")
+	p.endObject(state)
+}
+
 // GetChatEventActionEnum return the enum type of this object
 func (chatEventMemberLeft *ChatEventMemberLeft) GetChatEventActionEnum() ChatEventActionEnum {
 	return ChatEventMemberLeftType
 }
 
+// Accept calls the method of the visitor for ChatEventMemberLeft
+func (chatEventMemberLeft *ChatEventMemberLeft) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventMemberLeft(chatEventMemberLeft)
+}
+
 // ChatEventMemberInvited A new chat member was invited
 type ChatEventMemberInvited struct {
 	tdCommon
@@ -516,30 +1593,120 @@ func NewChatEventMemberInvited(userId int64, status ChatMemberStatus) *ChatEvent
 	return &chatEventMemberInvitedTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventMemberInvited *ChatEventMemberInvited) MarshalJSON() ([]byte, error) {
+	return chatEventMemberInvited.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (chatEventMemberInvited *ChatEventMemberInvited) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, chatEventMemberInvited)
+}
+
+func (chatEventMemberInvited *ChatEventMemberInvited) appendJSON(b []byte) ([]byte, error) {
+	if chatEventMemberInvited == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventMemberInvited.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventMemberInvited.tdCommon.Extra)
+	b = append(b, `,"user_id":`...)
+	b = appendJSONInt(b, int64(chatEventMemberInvited.UserId))
+	b = append(b, `,"status":`...)
+	b, err = appendJSONValue(b, chatEventMemberInvited.Status)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (chatEventMemberInvited *ChatEventMemberInvited) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventMemberInvited.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventMemberInvited.tdCommon.Extra, err = r.readString()
+		case "user_id":
+			chatEventMemberInvited.UserId, err = r.readInt64()
+		case "status":
+			chatEventMemberInvited.Status, err = decodeChatMemberStatus(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventMemberInvited *ChatEventMemberInvited) Clone() *ChatEventMemberInvited {
+	if chatEventMemberInvited == nil {
+		return nil
 	}
-	tempObj := struct {
-		tdCommon
-		UserId int64 `json:"user_id"` // New member user identifier
+	clone := *chatEventMemberInvited
+	clone.Status = cloneChatMemberStatus(chatEventMemberInvited.Status)
+	return &clone
+}
 
-	}{}
-	err = json.Unmarshal(b, &tempObj)
-	if err != nil {
-		return err
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventMemberInvited *ChatEventMemberInvited) Equal(other *ChatEventMemberInvited) bool {
+	if chatEventMemberInvited == nil || other == nil {
+		return chatEventMemberInvited == other
 	}
+	if chatEventMemberInvited.UserId != other.UserId {
+		return false
+	}
+	if !equalChatMemberStatus(chatEventMemberInvited.Status, other.Status) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventMemberInvited *ChatEventMemberInvited) Diff(other *ChatEventMemberInvited) []Change {
+	return chatEventMemberInvited.diff("", other, nil)
+}
+
+func (chatEventMemberInvited *ChatEventMemberInvited) diff(path string, other *ChatEventMemberInvited, changes []Change) []Change {
+	if chatEventMemberInvited == nil || other == nil {
+		if chatEventMemberInvited != other {
+			changes = append(changes, Change{Path: path, Old: chatEventMemberInvited, New: other})
+		}
+		return changes
+	}
+	if chatEventMemberInvited.UserId != other.UserId {
+		changes = append(changes, Change{Path: fieldPath(path, "user_id"), Old: chatEventMemberInvited.UserId, New: other.UserId})
+	}
+	changes = diffChatMemberStatus(fieldPath(path, "status"), chatEventMemberInvited.Status, other.Status, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventMemberInvited *ChatEventMemberInvited) String() string {
+	return objectString(chatEventMemberInvited)
+}
 
-	chatEventMemberInvited.tdCommon = tempObj.tdCommon
-	chatEventMemberInvited.UserId = tempObj.UserId
+// LogValue logs the object as its summary
+func (chatEventMemberInvited *ChatEventMemberInvited) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventMemberInvited))
+}
 
-	fieldStatus, _ := unmarshalChatMemberStatus(objMap["status"])
-	chatEventMemberInvited.Status = fieldStatus
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventMemberInvited *ChatEventMemberInvited) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventMemberInvited)
+}
 
-	return nil
+func (chatEventMemberInvited *ChatEventMemberInvited) printText(p *printer) {
+	if chatEventMemberInvited == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventMemberInvited")
+	p.int("user_id", int64(chatEventMemberInvited.UserId))
+	p.object("status", chatEventMemberInvited.Status)
+	p.endObject(state)
 }
 
 // GetChatEventActionEnum return the enum type of this object
@@ -547,6 +1714,11 @@ func (chatEventMemberInvited *ChatEventMemberInvited) GetChatEventActionEnum() C
 	return ChatEventMemberInvitedType
 }
 
+// Accept calls the method of the visitor for ChatEventMemberInvited
+func (chatEventMemberInvited *ChatEventMemberInvited) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventMemberInvited(chatEventMemberInvited)
+}
+
 // ChatEventMemberPromoted A chat member has gained/lost administrator status, or the list of their administrator privileges has changed
 type ChatEventMemberPromoted struct {
 	tdCommon
@@ -576,33 +1748,133 @@ func NewChatEventMemberPromoted(userId int64, oldStatus ChatMemberStatus, newSta
 	return &chatEventMemberPromotedTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventMemberPromoted *ChatEventMemberPromoted) MarshalJSON() ([]byte, error) {
+	return chatEventMemberPromoted.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (chatEventMemberPromoted *ChatEventMemberPromoted) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, chatEventMemberPromoted)
+}
+
+func (chatEventMemberPromoted *ChatEventMemberPromoted) appendJSON(b []byte) ([]byte, error) {
+	if chatEventMemberPromoted == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventMemberPromoted.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventMemberPromoted.tdCommon.Extra)
+	b = append(b, `,"user_id":`...)
+	b = appendJSONInt(b, int64(chatEventMemberPromoted.UserId))
+	b = append(b, `,"old_status":`...)
+	b, err = appendJSONValue(b, chatEventMemberPromoted.OldStatus)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-		UserId int64 `json:"user_id"` // Affected chat member user identifier
-
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	b = append(b, `,"new_status":`...)
+	b, err = appendJSONValue(b, chatEventMemberPromoted.NewStatus)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (chatEventMemberPromoted *ChatEventMemberPromoted) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventMemberPromoted.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventMemberPromoted.tdCommon.Extra, err = r.readString()
+		case "user_id":
+			chatEventMemberPromoted.UserId, err = r.readInt64()
+		case "old_status":
+			chatEventMemberPromoted.OldStatus, err = decodeChatMemberStatus(r)
+		case "new_status":
+			chatEventMemberPromoted.NewStatus, err = decodeChatMemberStatus(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventMemberPromoted *ChatEventMemberPromoted) Clone() *ChatEventMemberPromoted {
+	if chatEventMemberPromoted == nil {
+		return nil
+	}
+	clone := *chatEventMemberPromoted
+	clone.OldStatus = cloneChatMemberStatus(chatEventMemberPromoted.OldStatus)
+	clone.NewStatus = cloneChatMemberStatus(chatEventMemberPromoted.NewStatus)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventMemberPromoted *ChatEventMemberPromoted) Equal(other *ChatEventMemberPromoted) bool {
+	if chatEventMemberPromoted == nil || other == nil {
+		return chatEventMemberPromoted == other
+	}
+	if chatEventMemberPromoted.UserId != other.UserId {
+		return false
+	}
+	if !equalChatMemberStatus(chatEventMemberPromoted.OldStatus, other.OldStatus) {
+		return false
+	}
+	if !equalChatMemberStatus(chatEventMemberPromoted.NewStatus, other.NewStatus) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventMemberPromoted *ChatEventMemberPromoted) Diff(other *ChatEventMemberPromoted) []Change {
+	return chatEventMemberPromoted.diff("", other, nil)
+}
+
+func (chatEventMemberPromoted *ChatEventMemberPromoted) diff(path string, other *ChatEventMemberPromoted, changes []Change) []Change {
+	if chatEventMemberPromoted == nil || other == nil {
+		if chatEventMemberPromoted != other {
+			changes = append(changes, Change{Path: path, Old: chatEventMemberPromoted, New: other})
+		}
+		return changes
 	}
+	if chatEventMemberPromoted.UserId != other.UserId {
+		changes = append(changes, Change{Path: fieldPath(path, "user_id"), Old: chatEventMemberPromoted.UserId, New: other.UserId})
+	}
+	changes = diffChatMemberStatus(fieldPath(path, "old_status"), chatEventMemberPromoted.OldStatus, other.OldStatus, changes)
+	changes = diffChatMemberStatus(fieldPath(path, "new_status"), chatEventMemberPromoted.NewStatus, other.NewStatus, changes)
+	return changes
+}
 
-	chatEventMemberPromoted.tdCommon = tempObj.tdCommon
-	chatEventMemberPromoted.UserId = tempObj.UserId
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventMemberPromoted *ChatEventMemberPromoted) String() string {
+	return objectString(chatEventMemberPromoted)
+}
 
-	fieldOldStatus, _ := unmarshalChatMemberStatus(objMap["old_status"])
-	chatEventMemberPromoted.OldStatus = fieldOldStatus
+// LogValue logs the object as its summary
+func (chatEventMemberPromoted *ChatEventMemberPromoted) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventMemberPromoted))
+}
 
-	fieldNewStatus, _ := unmarshalChatMemberStatus(objMap["new_status"])
-	chatEventMemberPromoted.NewStatus = fieldNewStatus
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventMemberPromoted *ChatEventMemberPromoted) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventMemberPromoted)
+}
 
-	return nil
+func (chatEventMemberPromoted *ChatEventMemberPromoted) printText(p *printer) {
+	if chatEventMemberPromoted == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventMemberPromoted")
+	p.int("user_id", int64(chatEventMemberPromoted.UserId))
+	p.object("old_status", chatEventMemberPromoted.OldStatus)
+	p.object("new_status", chatEventMemberPromoted.NewStatus)
+	p.endObject(state)
 }
 
 // GetChatEventActionEnum return the enum type of this object
@@ -610,6 +1882,11 @@ func (chatEventMemberPromoted *ChatEventMemberPromoted) GetChatEventActionEnum()
 	return ChatEventMemberPromotedType
 }
 
+// Accept calls the method of the visitor for ChatEventMemberPromoted
+func (chatEventMemberPromoted *ChatEventMemberPromoted) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventMemberPromoted(chatEventMemberPromoted)
+}
+
 // ChatEventMemberRestricted A chat member was restricted/unrestricted or banned/unbanned, or the list of their restrictions has changed
 type ChatEventMemberRestricted struct {
 	tdCommon
@@ -639,33 +1916,135 @@ func NewChatEventMemberRestricted(memberId MessageSender, oldStatus ChatMemberSt
 	return &chatEventMemberRestrictedTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventMemberRestricted *ChatEventMemberRestricted) MarshalJSON() ([]byte, error) {
+	return chatEventMemberRestricted.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (chatEventMemberRestricted *ChatEventMemberRestricted) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, chatEventMemberRestricted)
+}
+
+func (chatEventMemberRestricted *ChatEventMemberRestricted) appendJSON(b []byte) ([]byte, error) {
+	if chatEventMemberRestricted == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventMemberRestricted.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventMemberRestricted.tdCommon.Extra)
+	b = append(b, `,"member_id":`...)
+	b, err = appendJSONValue(b, chatEventMemberRestricted.MemberId)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"old_status":`...)
+	b, err = appendJSONValue(b, chatEventMemberRestricted.OldStatus)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	b = append(b, `,"new_status":`...)
+	b, err = appendJSONValue(b, chatEventMemberRestricted.NewStatus)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (chatEventMemberRestricted *ChatEventMemberRestricted) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventMemberRestricted.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventMemberRestricted.tdCommon.Extra, err = r.readString()
+		case "member_id":
+			chatEventMemberRestricted.MemberId, err = decodeMessageSender(r)
+		case "old_status":
+			chatEventMemberRestricted.OldStatus, err = decodeChatMemberStatus(r)
+		case "new_status":
+			chatEventMemberRestricted.NewStatus, err = decodeChatMemberStatus(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventMemberRestricted *ChatEventMemberRestricted) Clone() *ChatEventMemberRestricted {
+	if chatEventMemberRestricted == nil {
+		return nil
+	}
+	clone := *chatEventMemberRestricted
+	clone.MemberId = cloneMessageSender(chatEventMemberRestricted.MemberId)
+	clone.OldStatus = cloneChatMemberStatus(chatEventMemberRestricted.OldStatus)
+	clone.NewStatus = cloneChatMemberStatus(chatEventMemberRestricted.NewStatus)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventMemberRestricted *ChatEventMemberRestricted) Equal(other *ChatEventMemberRestricted) bool {
+	if chatEventMemberRestricted == nil || other == nil {
+		return chatEventMemberRestricted == other
+	}
+	if !equalMessageSender(chatEventMemberRestricted.MemberId, other.MemberId) {
+		return false
+	}
+	if !equalChatMemberStatus(chatEventMemberRestricted.OldStatus, other.OldStatus) {
+		return false
 	}
+	if !equalChatMemberStatus(chatEventMemberRestricted.NewStatus, other.NewStatus) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventMemberRestricted *ChatEventMemberRestricted) Diff(other *ChatEventMemberRestricted) []Change {
+	return chatEventMemberRestricted.diff("", other, nil)
+}
 
-	chatEventMemberRestricted.tdCommon = tempObj.tdCommon
+func (chatEventMemberRestricted *ChatEventMemberRestricted) diff(path string, other *ChatEventMemberRestricted, changes []Change) []Change {
+	if chatEventMemberRestricted == nil || other == nil {
+		if chatEventMemberRestricted != other {
+			changes = append(changes, Change{Path: path, Old: chatEventMemberRestricted, New: other})
+		}
+		return changes
+	}
+	changes = diffMessageSender(fieldPath(path, "member_id"), chatEventMemberRestricted.MemberId, other.MemberId, changes)
+	changes = diffChatMemberStatus(fieldPath(path, "old_status"), chatEventMemberRestricted.OldStatus, other.OldStatus, changes)
+	changes = diffChatMemberStatus(fieldPath(path, "new_status"), chatEventMemberRestricted.NewStatus, other.NewStatus, changes)
+	return changes
+}
 
-	fieldMemberId, _ := unmarshalMessageSender(objMap["member_id"])
-	chatEventMemberRestricted.MemberId = fieldMemberId
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventMemberRestricted *ChatEventMemberRestricted) String() string {
+	return objectString(chatEventMemberRestricted)
+}
 
-	fieldOldStatus, _ := unmarshalChatMemberStatus(objMap["old_status"])
-	chatEventMemberRestricted.OldStatus = fieldOldStatus
+// LogValue logs the object as its summary
+func (chatEventMemberRestricted *ChatEventMemberRestricted) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventMemberRestricted))
+}
 
-	fieldNewStatus, _ := unmarshalChatMemberStatus(objMap["new_status"])
-	chatEventMemberRestricted.NewStatus = fieldNewStatus
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventMemberRestricted *ChatEventMemberRestricted) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventMemberRestricted)
+}
 
-	return nil
+func (chatEventMemberRestricted *ChatEventMemberRestricted) printText(p *printer) {
+	if chatEventMemberRestricted == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventMemberRestricted")
+	p.object("member_id", chatEventMemberRestricted.MemberId)
+	p.object("old_status", chatEventMemberRestricted.OldStatus)
+	p.object("new_status", chatEventMemberRestricted.NewStatus)
+	p.endObject(state)
 }
 
 // GetChatEventActionEnum return the enum type of this object
@@ -673,6 +2052,11 @@ func (chatEventMemberRestricted *ChatEventMemberRestricted) GetChatEventActionEn
 	return ChatEventMemberRestrictedType
 }
 
+// Accept calls the method of the visitor for ChatEventMemberRestricted
+func (chatEventMemberRestricted *ChatEventMemberRestricted) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventMemberRestricted(chatEventMemberRestricted)
+}
+
 // ChatEventTitleChanged The chat title was changed
 type ChatEventTitleChanged struct {
 	tdCommon
@@ -699,11 +2083,129 @@ func NewChatEventTitleChanged(oldTitle string, newTitle string) *ChatEventTitleC
 	return &chatEventTitleChangedTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventTitleChanged *ChatEventTitleChanged) MarshalJSON() ([]byte, error) {
+	return chatEventTitleChanged.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEventTitleChanged *ChatEventTitleChanged) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventTitleChanged)
+}
+
+func (chatEventTitleChanged *ChatEventTitleChanged) appendJSON(b []byte) ([]byte, error) {
+	if chatEventTitleChanged == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventTitleChanged.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventTitleChanged.tdCommon.Extra)
+	b = append(b, `,"old_title":`...)
+	b = appendJSONString(b, chatEventTitleChanged.OldTitle)
+	b = append(b, `,"new_title":`...)
+	b = appendJSONString(b, chatEventTitleChanged.NewTitle)
+	return append(b, '}'), nil
+}
+
+func (chatEventTitleChanged *ChatEventTitleChanged) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventTitleChanged.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventTitleChanged.tdCommon.Extra, err = r.readString()
+		case "old_title":
+			chatEventTitleChanged.OldTitle, err = r.readString()
+		case "new_title":
+			chatEventTitleChanged.NewTitle, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventTitleChanged *ChatEventTitleChanged) Clone() *ChatEventTitleChanged {
+	if chatEventTitleChanged == nil {
+		return nil
+	}
+	clone := *chatEventTitleChanged
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventTitleChanged *ChatEventTitleChanged) Equal(other *ChatEventTitleChanged) bool {
+	if chatEventTitleChanged == nil || other == nil {
+		return chatEventTitleChanged == other
+	}
+	if chatEventTitleChanged.OldTitle != other.OldTitle {
+		return false
+	}
+	if chatEventTitleChanged.NewTitle != other.NewTitle {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventTitleChanged *ChatEventTitleChanged) Diff(other *ChatEventTitleChanged) []Change {
+	return chatEventTitleChanged.diff("", other, nil)
+}
+
+func (chatEventTitleChanged *ChatEventTitleChanged) diff(path string, other *ChatEventTitleChanged, changes []Change) []Change {
+	if chatEventTitleChanged == nil || other == nil {
+		if chatEventTitleChanged != other {
+			changes = append(changes, Change{Path: path, Old: chatEventTitleChanged, New: other})
+		}
+		return changes
+	}
+	if chatEventTitleChanged.OldTitle != other.OldTitle {
+		changes = append(changes, Change{Path: fieldPath(path, "old_title"), Old: chatEventTitleChanged.OldTitle, New: other.OldTitle})
+	}
+	if chatEventTitleChanged.NewTitle != other.NewTitle {
+		changes = append(changes, Change{Path: fieldPath(path, "new_title"), Old: chatEventTitleChanged.NewTitle, New: other.NewTitle})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventTitleChanged *ChatEventTitleChanged) String() string {
+	return objectString(chatEventTitleChanged)
+}
+
+// LogValue logs the object as its summary
+func (chatEventTitleChanged *ChatEventTitleChanged) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventTitleChanged))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventTitleChanged *ChatEventTitleChanged) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventTitleChanged)
+}
+
+func (chatEventTitleChanged *ChatEventTitleChanged) printText(p *printer) {
+	if chatEventTitleChanged == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventTitleChanged")
+	p.string("old_title", chatEventTitleChanged.OldTitle)
+	p.string("new_title", chatEventTitleChanged.NewTitle)
+	p.endObject(state)
+}
+
 // GetChatEventActionEnum return the enum type of this object
 func (chatEventTitleChanged *ChatEventTitleChanged) GetChatEventActionEnum() ChatEventActionEnum {
 	return ChatEventTitleChangedType
 }
 
+// Accept calls the method of the visitor for ChatEventTitleChanged
+func (chatEventTitleChanged *ChatEventTitleChanged) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventTitleChanged(chatEventTitleChanged)
+}
+
 // ChatEventPermissionsChanged The chat permissions was changed
 type ChatEventPermissionsChanged struct {
 	tdCommon
@@ -730,11 +2232,144 @@ func NewChatEventPermissionsChanged(oldPermissions *ChatPermissions, newPermissi
 	return &chatEventPermissionsChangedTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventPermissionsChanged *ChatEventPermissionsChanged) MarshalJSON() ([]byte, error) {
+	return chatEventPermissionsChanged.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEventPermissionsChanged *ChatEventPermissionsChanged) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventPermissionsChanged)
+}
+
+func (chatEventPermissionsChanged *ChatEventPermissionsChanged) appendJSON(b []byte) ([]byte, error) {
+	if chatEventPermissionsChanged == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventPermissionsChanged.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventPermissionsChanged.tdCommon.Extra)
+	b = append(b, `,"old_permissions":`...)
+	b, err = chatEventPermissionsChanged.OldPermissions.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"new_permissions":`...)
+	b, err = chatEventPermissionsChanged.NewPermissions.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (chatEventPermissionsChanged *ChatEventPermissionsChanged) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventPermissionsChanged.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventPermissionsChanged.tdCommon.Extra, err = r.readString()
+		case "old_permissions":
+			if r.readNull() {
+				chatEventPermissionsChanged.OldPermissions = nil
+			} else {
+				chatEventPermissionsChanged.OldPermissions = new(ChatPermissions)
+				err = chatEventPermissionsChanged.OldPermissions.decodeJSON(r)
+			}
+		case "new_permissions":
+			if r.readNull() {
+				chatEventPermissionsChanged.NewPermissions = nil
+			} else {
+				chatEventPermissionsChanged.NewPermissions = new(ChatPermissions)
+				err = chatEventPermissionsChanged.NewPermissions.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventPermissionsChanged *ChatEventPermissionsChanged) Clone() *ChatEventPermissionsChanged {
+	if chatEventPermissionsChanged == nil {
+		return nil
+	}
+	clone := *chatEventPermissionsChanged
+	clone.OldPermissions = chatEventPermissionsChanged.OldPermissions.Clone()
+	clone.NewPermissions = chatEventPermissionsChanged.NewPermissions.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventPermissionsChanged *ChatEventPermissionsChanged) Equal(other *ChatEventPermissionsChanged) bool {
+	if chatEventPermissionsChanged == nil || other == nil {
+		return chatEventPermissionsChanged == other
+	}
+	if !chatEventPermissionsChanged.OldPermissions.Equal(other.OldPermissions) {
+		return false
+	}
+	if !chatEventPermissionsChanged.NewPermissions.Equal(other.NewPermissions) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventPermissionsChanged *ChatEventPermissionsChanged) Diff(other *ChatEventPermissionsChanged) []Change {
+	return chatEventPermissionsChanged.diff("", other, nil)
+}
+
+func (chatEventPermissionsChanged *ChatEventPermissionsChanged) diff(path string, other *ChatEventPermissionsChanged, changes []Change) []Change {
+	if chatEventPermissionsChanged == nil || other == nil {
+		if chatEventPermissionsChanged != other {
+			changes = append(changes, Change{Path: path, Old: chatEventPermissionsChanged, New: other})
+		}
+		return changes
+	}
+	changes = chatEventPermissionsChanged.OldPermissions.diff(fieldPath(path, "old_permissions"), other.OldPermissions, changes)
+	changes = chatEventPermissionsChanged.NewPermissions.diff(fieldPath(path, "new_permissions"), other.NewPermissions, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventPermissionsChanged *ChatEventPermissionsChanged) String() string {
+	return objectString(chatEventPermissionsChanged)
+}
+
+// LogValue logs the object as its summary
+func (chatEventPermissionsChanged *ChatEventPermissionsChanged) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventPermissionsChanged))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventPermissionsChanged *ChatEventPermissionsChanged) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventPermissionsChanged)
+}
+
+func (chatEventPermissionsChanged *ChatEventPermissionsChanged) printText(p *printer) {
+	if chatEventPermissionsChanged == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventPermissionsChanged")
+	p.object("old_permissions", chatEventPermissionsChanged.OldPermissions)
+	p.object("new_permissions", chatEventPermissionsChanged.NewPermissions)
+	p.endObject(state)
+}
+
 // GetChatEventActionEnum return the enum type of this object
 func (chatEventPermissionsChanged *ChatEventPermissionsChanged) GetChatEventActionEnum() ChatEventActionEnum {
 	return ChatEventPermissionsChangedType
 }
 
+// Accept calls the method of the visitor for ChatEventPermissionsChanged
+func (chatEventPermissionsChanged *ChatEventPermissionsChanged) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventPermissionsChanged(chatEventPermissionsChanged)
+}
+
 // ChatEventDescriptionChanged The chat description was changed
 type ChatEventDescriptionChanged struct {
 	tdCommon
@@ -761,11 +2396,129 @@ func NewChatEventDescriptionChanged(oldDescription string, newDescription string
 	return &chatEventDescriptionChangedTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventDescriptionChanged *ChatEventDescriptionChanged) MarshalJSON() ([]byte, error) {
+	return chatEventDescriptionChanged.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEventDescriptionChanged *ChatEventDescriptionChanged) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventDescriptionChanged)
+}
+
+func (chatEventDescriptionChanged *ChatEventDescriptionChanged) appendJSON(b []byte) ([]byte, error) {
+	if chatEventDescriptionChanged == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventDescriptionChanged.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventDescriptionChanged.tdCommon.Extra)
+	b = append(b, `,"old_description":`...)
+	b = appendJSONString(b, chatEventDescriptionChanged.OldDescription)
+	b = append(b, `,"new_description":`...)
+	b = appendJSONString(b, chatEventDescriptionChanged.NewDescription)
+	return append(b, '}'), nil
+}
+
+func (chatEventDescriptionChanged *ChatEventDescriptionChanged) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventDescriptionChanged.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventDescriptionChanged.tdCommon.Extra, err = r.readString()
+		case "old_description":
+			chatEventDescriptionChanged.OldDescription, err = r.readString()
+		case "new_description":
+			chatEventDescriptionChanged.NewDescription, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventDescriptionChanged *ChatEventDescriptionChanged) Clone() *ChatEventDescriptionChanged {
+	if chatEventDescriptionChanged == nil {
+		return nil
+	}
+	clone := *chatEventDescriptionChanged
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventDescriptionChanged *ChatEventDescriptionChanged) Equal(other *ChatEventDescriptionChanged) bool {
+	if chatEventDescriptionChanged == nil || other == nil {
+		return chatEventDescriptionChanged == other
+	}
+	if chatEventDescriptionChanged.OldDescription != other.OldDescription {
+		return false
+	}
+	if chatEventDescriptionChanged.NewDescription != other.NewDescription {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventDescriptionChanged *ChatEventDescriptionChanged) Diff(other *ChatEventDescriptionChanged) []Change {
+	return chatEventDescriptionChanged.diff("", other, nil)
+}
+
+func (chatEventDescriptionChanged *ChatEventDescriptionChanged) diff(path string, other *ChatEventDescriptionChanged, changes []Change) []Change {
+	if chatEventDescriptionChanged == nil || other == nil {
+		if chatEventDescriptionChanged != other {
+			changes = append(changes, Change{Path: path, Old: chatEventDescriptionChanged, New: other})
+		}
+		return changes
+	}
+	if chatEventDescriptionChanged.OldDescription != other.OldDescription {
+		changes = append(changes, Change{Path: fieldPath(path, "old_description"), Old: chatEventDescriptionChanged.OldDescription, New: other.OldDescription})
+	}
+	if chatEventDescriptionChanged.NewDescription != other.NewDescription {
+		changes = append(changes, Change{Path: fieldPath(path, "new_description"), Old: chatEventDescriptionChanged.NewDescription, New: other.NewDescription})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventDescriptionChanged *ChatEventDescriptionChanged) String() string {
+	return objectString(chatEventDescriptionChanged)
+}
+
+// LogValue logs the object as its summary
+func (chatEventDescriptionChanged *ChatEventDescriptionChanged) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventDescriptionChanged))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventDescriptionChanged *ChatEventDescriptionChanged) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventDescriptionChanged)
+}
+
+func (chatEventDescriptionChanged *ChatEventDescriptionChanged) printText(p *printer) {
+	if chatEventDescriptionChanged == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventDescriptionChanged")
+	p.string("old_description", chatEventDescriptionChanged.OldDescription)
+	p.string("new_description", chatEventDescriptionChanged.NewDescription)
+	p.endObject(state)
+}
+
 // GetChatEventActionEnum return the enum type of this object
 func (chatEventDescriptionChanged *ChatEventDescriptionChanged) GetChatEventActionEnum() ChatEventActionEnum {
 	return ChatEventDescriptionChangedType
 }
 
+// Accept calls the method of the visitor for ChatEventDescriptionChanged
+func (chatEventDescriptionChanged *ChatEventDescriptionChanged) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventDescriptionChanged(chatEventDescriptionChanged)
+}
+
 // ChatEventUsernameChanged The chat username was changed
 type ChatEventUsernameChanged struct {
 	tdCommon
@@ -792,12 +2545,130 @@ func NewChatEventUsernameChanged(oldUsername string, newUsername string) *ChatEv
 	return &chatEventUsernameChangedTemp
 }
 
-// GetChatEventActionEnum return the enum type of this object
-func (chatEventUsernameChanged *ChatEventUsernameChanged) GetChatEventActionEnum() ChatEventActionEnum {
-	return ChatEventUsernameChangedType
+// MarshalJSON marshals to json
+func (chatEventUsernameChanged *ChatEventUsernameChanged) MarshalJSON() ([]byte, error) {
+	return chatEventUsernameChanged.appendJSON(nil)
 }
 
-// ChatEventPhotoChanged The chat photo was changed
+// UnmarshalJSON unmarshal to json
+func (chatEventUsernameChanged *ChatEventUsernameChanged) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventUsernameChanged)
+}
+
+func (chatEventUsernameChanged *ChatEventUsernameChanged) appendJSON(b []byte) ([]byte, error) {
+	if chatEventUsernameChanged == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventUsernameChanged.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventUsernameChanged.tdCommon.Extra)
+	b = append(b, `,"old_username":`...)
+	b = appendJSONString(b, chatEventUsernameChanged.OldUsername)
+	b = append(b, `,"new_username":`...)
+	b = appendJSONString(b, chatEventUsernameChanged.NewUsername)
+	return append(b, '}'), nil
+}
+
+func (chatEventUsernameChanged *ChatEventUsernameChanged) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventUsernameChanged.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventUsernameChanged.tdCommon.Extra, err = r.readString()
+		case "old_username":
+			chatEventUsernameChanged.OldUsername, err = r.readString()
+		case "new_username":
+			chatEventUsernameChanged.NewUsername, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventUsernameChanged *ChatEventUsernameChanged) Clone() *ChatEventUsernameChanged {
+	if chatEventUsernameChanged == nil {
+		return nil
+	}
+	clone := *chatEventUsernameChanged
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventUsernameChanged *ChatEventUsernameChanged) Equal(other *ChatEventUsernameChanged) bool {
+	if chatEventUsernameChanged == nil || other == nil {
+		return chatEventUsernameChanged == other
+	}
+	if chatEventUsernameChanged.OldUsername != other.OldUsername {
+		return false
+	}
+	if chatEventUsernameChanged.NewUsername != other.NewUsername {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventUsernameChanged *ChatEventUsernameChanged) Diff(other *ChatEventUsernameChanged) []Change {
+	return chatEventUsernameChanged.diff("", other, nil)
+}
+
+func (chatEventUsernameChanged *ChatEventUsernameChanged) diff(path string, other *ChatEventUsernameChanged, changes []Change) []Change {
+	if chatEventUsernameChanged == nil || other == nil {
+		if chatEventUsernameChanged != other {
+			changes = append(changes, Change{Path: path, Old: chatEventUsernameChanged, New: other})
+		}
+		return changes
+	}
+	if chatEventUsernameChanged.OldUsername != other.OldUsername {
+		changes = append(changes, Change{Path: fieldPath(path, "old_username"), Old: chatEventUsernameChanged.OldUsername, New: other.OldUsername})
+	}
+	if chatEventUsernameChanged.NewUsername != other.NewUsername {
+		changes = append(changes, Change{Path: fieldPath(path, "new_username"), Old: chatEventUsernameChanged.NewUsername, New: other.NewUsername})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventUsernameChanged *ChatEventUsernameChanged) String() string {
+	return objectString(chatEventUsernameChanged)
+}
+
+// LogValue logs the object as its summary
+func (chatEventUsernameChanged *ChatEventUsernameChanged) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventUsernameChanged))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventUsernameChanged *ChatEventUsernameChanged) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventUsernameChanged)
+}
+
+func (chatEventUsernameChanged *ChatEventUsernameChanged) printText(p *printer) {
+	if chatEventUsernameChanged == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventUsernameChanged")
+	p.string("old_username", chatEventUsernameChanged.OldUsername)
+	p.string("new_username", chatEventUsernameChanged.NewUsername)
+	p.endObject(state)
+}
+
+// GetChatEventActionEnum return the enum type of this object
+func (chatEventUsernameChanged *ChatEventUsernameChanged) GetChatEventActionEnum() ChatEventActionEnum {
+	return ChatEventUsernameChangedType
+}
+
+// Accept calls the method of the visitor for ChatEventUsernameChanged
+func (chatEventUsernameChanged *ChatEventUsernameChanged) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventUsernameChanged(chatEventUsernameChanged)
+}
+
+// ChatEventPhotoChanged The chat photo was changed
 type ChatEventPhotoChanged struct {
 	tdCommon
 	OldPhoto *ChatPhoto `json:"old_photo"` // Previous chat photo value; may be null
@@ -823,11 +2694,144 @@ func NewChatEventPhotoChanged(oldPhoto *ChatPhoto, newPhoto *ChatPhoto) *ChatEve
 	return &chatEventPhotoChangedTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventPhotoChanged *ChatEventPhotoChanged) MarshalJSON() ([]byte, error) {
+	return chatEventPhotoChanged.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEventPhotoChanged *ChatEventPhotoChanged) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventPhotoChanged)
+}
+
+func (chatEventPhotoChanged *ChatEventPhotoChanged) appendJSON(b []byte) ([]byte, error) {
+	if chatEventPhotoChanged == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventPhotoChanged.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventPhotoChanged.tdCommon.Extra)
+	b = append(b, `,"old_photo":`...)
+	b, err = chatEventPhotoChanged.OldPhoto.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"new_photo":`...)
+	b, err = chatEventPhotoChanged.NewPhoto.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (chatEventPhotoChanged *ChatEventPhotoChanged) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventPhotoChanged.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventPhotoChanged.tdCommon.Extra, err = r.readString()
+		case "old_photo":
+			if r.readNull() {
+				chatEventPhotoChanged.OldPhoto = nil
+			} else {
+				chatEventPhotoChanged.OldPhoto = new(ChatPhoto)
+				err = chatEventPhotoChanged.OldPhoto.decodeJSON(r)
+			}
+		case "new_photo":
+			if r.readNull() {
+				chatEventPhotoChanged.NewPhoto = nil
+			} else {
+				chatEventPhotoChanged.NewPhoto = new(ChatPhoto)
+				err = chatEventPhotoChanged.NewPhoto.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventPhotoChanged *ChatEventPhotoChanged) Clone() *ChatEventPhotoChanged {
+	if chatEventPhotoChanged == nil {
+		return nil
+	}
+	clone := *chatEventPhotoChanged
+	clone.OldPhoto = chatEventPhotoChanged.OldPhoto.Clone()
+	clone.NewPhoto = chatEventPhotoChanged.NewPhoto.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventPhotoChanged *ChatEventPhotoChanged) Equal(other *ChatEventPhotoChanged) bool {
+	if chatEventPhotoChanged == nil || other == nil {
+		return chatEventPhotoChanged == other
+	}
+	if !chatEventPhotoChanged.OldPhoto.Equal(other.OldPhoto) {
+		return false
+	}
+	if !chatEventPhotoChanged.NewPhoto.Equal(other.NewPhoto) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventPhotoChanged *ChatEventPhotoChanged) Diff(other *ChatEventPhotoChanged) []Change {
+	return chatEventPhotoChanged.diff("", other, nil)
+}
+
+func (chatEventPhotoChanged *ChatEventPhotoChanged) diff(path string, other *ChatEventPhotoChanged, changes []Change) []Change {
+	if chatEventPhotoChanged == nil || other == nil {
+		if chatEventPhotoChanged != other {
+			changes = append(changes, Change{Path: path, Old: chatEventPhotoChanged, New: other})
+		}
+		return changes
+	}
+	changes = chatEventPhotoChanged.OldPhoto.diff(fieldPath(path, "old_photo"), other.OldPhoto, changes)
+	changes = chatEventPhotoChanged.NewPhoto.diff(fieldPath(path, "new_photo"), other.NewPhoto, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventPhotoChanged *ChatEventPhotoChanged) String() string {
+	return objectString(chatEventPhotoChanged)
+}
+
+// LogValue logs the object as its summary
+func (chatEventPhotoChanged *ChatEventPhotoChanged) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventPhotoChanged))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventPhotoChanged *ChatEventPhotoChanged) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventPhotoChanged)
+}
+
+func (chatEventPhotoChanged *ChatEventPhotoChanged) printText(p *printer) {
+	if chatEventPhotoChanged == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventPhotoChanged")
+	p.object("old_photo", chatEventPhotoChanged.OldPhoto)
+	p.object("new_photo", chatEventPhotoChanged.NewPhoto)
+	p.endObject(state)
+}
+
 // GetChatEventActionEnum return the enum type of this object
 func (chatEventPhotoChanged *ChatEventPhotoChanged) GetChatEventActionEnum() ChatEventActionEnum {
 	return ChatEventPhotoChangedType
 }
 
+// Accept calls the method of the visitor for ChatEventPhotoChanged
+func (chatEventPhotoChanged *ChatEventPhotoChanged) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventPhotoChanged(chatEventPhotoChanged)
+}
+
 // ChatEventInvitesToggled The can_invite_users permission of a supergroup chat was toggled
 type ChatEventInvitesToggled struct {
 	tdCommon
@@ -851,11 +2855,118 @@ func NewChatEventInvitesToggled(canInviteUsers bool) *ChatEventInvitesToggled {
 	return &chatEventInvitesToggledTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventInvitesToggled *ChatEventInvitesToggled) MarshalJSON() ([]byte, error) {
+	return chatEventInvitesToggled.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEventInvitesToggled *ChatEventInvitesToggled) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventInvitesToggled)
+}
+
+func (chatEventInvitesToggled *ChatEventInvitesToggled) appendJSON(b []byte) ([]byte, error) {
+	if chatEventInvitesToggled == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventInvitesToggled.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventInvitesToggled.tdCommon.Extra)
+	b = append(b, `,"can_invite_users":`...)
+	b = appendJSONBool(b, chatEventInvitesToggled.CanInviteUsers)
+	return append(b, '}'), nil
+}
+
+func (chatEventInvitesToggled *ChatEventInvitesToggled) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventInvitesToggled.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventInvitesToggled.tdCommon.Extra, err = r.readString()
+		case "can_invite_users":
+			chatEventInvitesToggled.CanInviteUsers, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventInvitesToggled *ChatEventInvitesToggled) Clone() *ChatEventInvitesToggled {
+	if chatEventInvitesToggled == nil {
+		return nil
+	}
+	clone := *chatEventInvitesToggled
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventInvitesToggled *ChatEventInvitesToggled) Equal(other *ChatEventInvitesToggled) bool {
+	if chatEventInvitesToggled == nil || other == nil {
+		return chatEventInvitesToggled == other
+	}
+	if chatEventInvitesToggled.CanInviteUsers != other.CanInviteUsers {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventInvitesToggled *ChatEventInvitesToggled) Diff(other *ChatEventInvitesToggled) []Change {
+	return chatEventInvitesToggled.diff("", other, nil)
+}
+
+func (chatEventInvitesToggled *ChatEventInvitesToggled) diff(path string, other *ChatEventInvitesToggled, changes []Change) []Change {
+	if chatEventInvitesToggled == nil || other == nil {
+		if chatEventInvitesToggled != other {
+			changes = append(changes, Change{Path: path, Old: chatEventInvitesToggled, New: other})
+		}
+		return changes
+	}
+	if chatEventInvitesToggled.CanInviteUsers != other.CanInviteUsers {
+		changes = append(changes, Change{Path: fieldPath(path, "can_invite_users"), Old: chatEventInvitesToggled.CanInviteUsers, New: other.CanInviteUsers})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventInvitesToggled *ChatEventInvitesToggled) String() string {
+	return objectString(chatEventInvitesToggled)
+}
+
+// LogValue logs the object as its summary
+func (chatEventInvitesToggled *ChatEventInvitesToggled) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventInvitesToggled))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventInvitesToggled *ChatEventInvitesToggled) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventInvitesToggled)
+}
+
+func (chatEventInvitesToggled *ChatEventInvitesToggled) printText(p *printer) {
+	if chatEventInvitesToggled == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventInvitesToggled")
+	p.bool("can_invite_users", chatEventInvitesToggled.CanInviteUsers)
+	p.endObject(state)
+}
+
 // GetChatEventActionEnum return the enum type of this object
 func (chatEventInvitesToggled *ChatEventInvitesToggled) GetChatEventActionEnum() ChatEventActionEnum {
 	return ChatEventInvitesToggledType
 }
 
+// Accept calls the method of the visitor for ChatEventInvitesToggled
+func (chatEventInvitesToggled *ChatEventInvitesToggled) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventInvitesToggled(chatEventInvitesToggled)
+}
+
 // ChatEventLinkedChatChanged The linked chat of a supergroup was changed
 type ChatEventLinkedChatChanged struct {
 	tdCommon
@@ -882,11 +2993,129 @@ func NewChatEventLinkedChatChanged(oldLinkedChatId int64, newLinkedChatId int64)
 	return &chatEventLinkedChatChangedTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventLinkedChatChanged *ChatEventLinkedChatChanged) MarshalJSON() ([]byte, error) {
+	return chatEventLinkedChatChanged.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEventLinkedChatChanged *ChatEventLinkedChatChanged) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventLinkedChatChanged)
+}
+
+func (chatEventLinkedChatChanged *ChatEventLinkedChatChanged) appendJSON(b []byte) ([]byte, error) {
+	if chatEventLinkedChatChanged == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventLinkedChatChanged.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventLinkedChatChanged.tdCommon.Extra)
+	b = append(b, `,"old_linked_chat_id":`...)
+	b = appendJSONInt(b, int64(chatEventLinkedChatChanged.OldLinkedChatId))
+	b = append(b, `,"new_linked_chat_id":`...)
+	b = appendJSONInt(b, int64(chatEventLinkedChatChanged.NewLinkedChatId))
+	return append(b, '}'), nil
+}
+
+func (chatEventLinkedChatChanged *ChatEventLinkedChatChanged) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventLinkedChatChanged.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventLinkedChatChanged.tdCommon.Extra, err = r.readString()
+		case "old_linked_chat_id":
+			chatEventLinkedChatChanged.OldLinkedChatId, err = r.readInt64()
+		case "new_linked_chat_id":
+			chatEventLinkedChatChanged.NewLinkedChatId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventLinkedChatChanged *ChatEventLinkedChatChanged) Clone() *ChatEventLinkedChatChanged {
+	if chatEventLinkedChatChanged == nil {
+		return nil
+	}
+	clone := *chatEventLinkedChatChanged
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventLinkedChatChanged *ChatEventLinkedChatChanged) Equal(other *ChatEventLinkedChatChanged) bool {
+	if chatEventLinkedChatChanged == nil || other == nil {
+		return chatEventLinkedChatChanged == other
+	}
+	if chatEventLinkedChatChanged.OldLinkedChatId != other.OldLinkedChatId {
+		return false
+	}
+	if chatEventLinkedChatChanged.NewLinkedChatId != other.NewLinkedChatId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventLinkedChatChanged *ChatEventLinkedChatChanged) Diff(other *ChatEventLinkedChatChanged) []Change {
+	return chatEventLinkedChatChanged.diff("", other, nil)
+}
+
+func (chatEventLinkedChatChanged *ChatEventLinkedChatChanged) diff(path string, other *ChatEventLinkedChatChanged, changes []Change) []Change {
+	if chatEventLinkedChatChanged == nil || other == nil {
+		if chatEventLinkedChatChanged != other {
+			changes = append(changes, Change{Path: path, Old: chatEventLinkedChatChanged, New: other})
+		}
+		return changes
+	}
+	if chatEventLinkedChatChanged.OldLinkedChatId != other.OldLinkedChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "old_linked_chat_id"), Old: chatEventLinkedChatChanged.OldLinkedChatId, New: other.OldLinkedChatId})
+	}
+	if chatEventLinkedChatChanged.NewLinkedChatId != other.NewLinkedChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "new_linked_chat_id"), Old: chatEventLinkedChatChanged.NewLinkedChatId, New: other.NewLinkedChatId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventLinkedChatChanged *ChatEventLinkedChatChanged) String() string {
+	return objectString(chatEventLinkedChatChanged)
+}
+
+// LogValue logs the object as its summary
+func (chatEventLinkedChatChanged *ChatEventLinkedChatChanged) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventLinkedChatChanged))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventLinkedChatChanged *ChatEventLinkedChatChanged) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventLinkedChatChanged)
+}
+
+func (chatEventLinkedChatChanged *ChatEventLinkedChatChanged) printText(p *printer) {
+	if chatEventLinkedChatChanged == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventLinkedChatChanged")
+	p.int("old_linked_chat_id", int64(chatEventLinkedChatChanged.OldLinkedChatId))
+	p.int("new_linked_chat_id", int64(chatEventLinkedChatChanged.NewLinkedChatId))
+	p.endObject(state)
+}
+
 // GetChatEventActionEnum return the enum type of this object
 func (chatEventLinkedChatChanged *ChatEventLinkedChatChanged) GetChatEventActionEnum() ChatEventActionEnum {
 	return ChatEventLinkedChatChangedType
 }
 
+// Accept calls the method of the visitor for ChatEventLinkedChatChanged
+func (chatEventLinkedChatChanged *ChatEventLinkedChatChanged) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventLinkedChatChanged(chatEventLinkedChatChanged)
+}
+
 // ChatEventSlowModeDelayChanged The slow_mode_delay setting of a supergroup was changed
 type ChatEventSlowModeDelayChanged struct {
 	tdCommon
@@ -913,11 +3142,129 @@ func NewChatEventSlowModeDelayChanged(oldSlowModeDelay int32, newSlowModeDelay i
 	return &chatEventSlowModeDelayChangedTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventSlowModeDelayChanged *ChatEventSlowModeDelayChanged) MarshalJSON() ([]byte, error) {
+	return chatEventSlowModeDelayChanged.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEventSlowModeDelayChanged *ChatEventSlowModeDelayChanged) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventSlowModeDelayChanged)
+}
+
+func (chatEventSlowModeDelayChanged *ChatEventSlowModeDelayChanged) appendJSON(b []byte) ([]byte, error) {
+	if chatEventSlowModeDelayChanged == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventSlowModeDelayChanged.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventSlowModeDelayChanged.tdCommon.Extra)
+	b = append(b, `,"old_slow_mode_delay":`...)
+	b = appendJSONInt(b, int64(chatEventSlowModeDelayChanged.OldSlowModeDelay))
+	b = append(b, `,"new_slow_mode_delay":`...)
+	b = appendJSONInt(b, int64(chatEventSlowModeDelayChanged.NewSlowModeDelay))
+	return append(b, '}'), nil
+}
+
+func (chatEventSlowModeDelayChanged *ChatEventSlowModeDelayChanged) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventSlowModeDelayChanged.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventSlowModeDelayChanged.tdCommon.Extra, err = r.readString()
+		case "old_slow_mode_delay":
+			chatEventSlowModeDelayChanged.OldSlowModeDelay, err = r.readInt32()
+		case "new_slow_mode_delay":
+			chatEventSlowModeDelayChanged.NewSlowModeDelay, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventSlowModeDelayChanged *ChatEventSlowModeDelayChanged) Clone() *ChatEventSlowModeDelayChanged {
+	if chatEventSlowModeDelayChanged == nil {
+		return nil
+	}
+	clone := *chatEventSlowModeDelayChanged
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventSlowModeDelayChanged *ChatEventSlowModeDelayChanged) Equal(other *ChatEventSlowModeDelayChanged) bool {
+	if chatEventSlowModeDelayChanged == nil || other == nil {
+		return chatEventSlowModeDelayChanged == other
+	}
+	if chatEventSlowModeDelayChanged.OldSlowModeDelay != other.OldSlowModeDelay {
+		return false
+	}
+	if chatEventSlowModeDelayChanged.NewSlowModeDelay != other.NewSlowModeDelay {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventSlowModeDelayChanged *ChatEventSlowModeDelayChanged) Diff(other *ChatEventSlowModeDelayChanged) []Change {
+	return chatEventSlowModeDelayChanged.diff("", other, nil)
+}
+
+func (chatEventSlowModeDelayChanged *ChatEventSlowModeDelayChanged) diff(path string, other *ChatEventSlowModeDelayChanged, changes []Change) []Change {
+	if chatEventSlowModeDelayChanged == nil || other == nil {
+		if chatEventSlowModeDelayChanged != other {
+			changes = append(changes, Change{Path: path, Old: chatEventSlowModeDelayChanged, New: other})
+		}
+		return changes
+	}
+	if chatEventSlowModeDelayChanged.OldSlowModeDelay != other.OldSlowModeDelay {
+		changes = append(changes, Change{Path: fieldPath(path, "old_slow_mode_delay"), Old: chatEventSlowModeDelayChanged.OldSlowModeDelay, New: other.OldSlowModeDelay})
+	}
+	if chatEventSlowModeDelayChanged.NewSlowModeDelay != other.NewSlowModeDelay {
+		changes = append(changes, Change{Path: fieldPath(path, "new_slow_mode_delay"), Old: chatEventSlowModeDelayChanged.NewSlowModeDelay, New: other.NewSlowModeDelay})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventSlowModeDelayChanged *ChatEventSlowModeDelayChanged) String() string {
+	return objectString(chatEventSlowModeDelayChanged)
+}
+
+// LogValue logs the object as its summary
+func (chatEventSlowModeDelayChanged *ChatEventSlowModeDelayChanged) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventSlowModeDelayChanged))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventSlowModeDelayChanged *ChatEventSlowModeDelayChanged) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventSlowModeDelayChanged)
+}
+
+func (chatEventSlowModeDelayChanged *ChatEventSlowModeDelayChanged) printText(p *printer) {
+	if chatEventSlowModeDelayChanged == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventSlowModeDelayChanged")
+	p.int("old_slow_mode_delay", int64(chatEventSlowModeDelayChanged.OldSlowModeDelay))
+	p.int("new_slow_mode_delay", int64(chatEventSlowModeDelayChanged.NewSlowModeDelay))
+	p.endObject(state)
+}
+
 // GetChatEventActionEnum return the enum type of this object
 func (chatEventSlowModeDelayChanged *ChatEventSlowModeDelayChanged) GetChatEventActionEnum() ChatEventActionEnum {
 	return ChatEventSlowModeDelayChangedType
 }
 
+// Accept calls the method of the visitor for ChatEventSlowModeDelayChanged
+func (chatEventSlowModeDelayChanged *ChatEventSlowModeDelayChanged) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventSlowModeDelayChanged(chatEventSlowModeDelayChanged)
+}
+
 // ChatEventMessageTtlChanged The message TTL was changed
 type ChatEventMessageTtlChanged struct {
 	tdCommon
@@ -944,11 +3291,129 @@ func NewChatEventMessageTtlChanged(oldMessageTtl int32, newMessageTtl int32) *Ch
 	return &chatEventMessageTtlChangedTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventMessageTtlChanged *ChatEventMessageTtlChanged) MarshalJSON() ([]byte, error) {
+	return chatEventMessageTtlChanged.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEventMessageTtlChanged *ChatEventMessageTtlChanged) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventMessageTtlChanged)
+}
+
+func (chatEventMessageTtlChanged *ChatEventMessageTtlChanged) appendJSON(b []byte) ([]byte, error) {
+	if chatEventMessageTtlChanged == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventMessageTtlChanged.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventMessageTtlChanged.tdCommon.Extra)
+	b = append(b, `,"old_message_ttl":`...)
+	b = appendJSONInt(b, int64(chatEventMessageTtlChanged.OldMessageTtl))
+	b = append(b, `,"new_message_ttl":`...)
+	b = appendJSONInt(b, int64(chatEventMessageTtlChanged.NewMessageTtl))
+	return append(b, '}'), nil
+}
+
+func (chatEventMessageTtlChanged *ChatEventMessageTtlChanged) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventMessageTtlChanged.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventMessageTtlChanged.tdCommon.Extra, err = r.readString()
+		case "old_message_ttl":
+			chatEventMessageTtlChanged.OldMessageTtl, err = r.readInt32()
+		case "new_message_ttl":
+			chatEventMessageTtlChanged.NewMessageTtl, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventMessageTtlChanged *ChatEventMessageTtlChanged) Clone() *ChatEventMessageTtlChanged {
+	if chatEventMessageTtlChanged == nil {
+		return nil
+	}
+	clone := *chatEventMessageTtlChanged
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventMessageTtlChanged *ChatEventMessageTtlChanged) Equal(other *ChatEventMessageTtlChanged) bool {
+	if chatEventMessageTtlChanged == nil || other == nil {
+		return chatEventMessageTtlChanged == other
+	}
+	if chatEventMessageTtlChanged.OldMessageTtl != other.OldMessageTtl {
+		return false
+	}
+	if chatEventMessageTtlChanged.NewMessageTtl != other.NewMessageTtl {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventMessageTtlChanged *ChatEventMessageTtlChanged) Diff(other *ChatEventMessageTtlChanged) []Change {
+	return chatEventMessageTtlChanged.diff("", other, nil)
+}
+
+func (chatEventMessageTtlChanged *ChatEventMessageTtlChanged) diff(path string, other *ChatEventMessageTtlChanged, changes []Change) []Change {
+	if chatEventMessageTtlChanged == nil || other == nil {
+		if chatEventMessageTtlChanged != other {
+			changes = append(changes, Change{Path: path, Old: chatEventMessageTtlChanged, New: other})
+		}
+		return changes
+	}
+	if chatEventMessageTtlChanged.OldMessageTtl != other.OldMessageTtl {
+		changes = append(changes, Change{Path: fieldPath(path, "old_message_ttl"), Old: chatEventMessageTtlChanged.OldMessageTtl, New: other.OldMessageTtl})
+	}
+	if chatEventMessageTtlChanged.NewMessageTtl != other.NewMessageTtl {
+		changes = append(changes, Change{Path: fieldPath(path, "new_message_ttl"), Old: chatEventMessageTtlChanged.NewMessageTtl, New: other.NewMessageTtl})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventMessageTtlChanged *ChatEventMessageTtlChanged) String() string {
+	return objectString(chatEventMessageTtlChanged)
+}
+
+// LogValue logs the object as its summary
+func (chatEventMessageTtlChanged *ChatEventMessageTtlChanged) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventMessageTtlChanged))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventMessageTtlChanged *ChatEventMessageTtlChanged) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventMessageTtlChanged)
+}
+
+func (chatEventMessageTtlChanged *ChatEventMessageTtlChanged) printText(p *printer) {
+	if chatEventMessageTtlChanged == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventMessageTtlChanged")
+	p.int("old_message_ttl", int64(chatEventMessageTtlChanged.OldMessageTtl))
+	p.int("new_message_ttl", int64(chatEventMessageTtlChanged.NewMessageTtl))
+	p.endObject(state)
+}
+
 // GetChatEventActionEnum return the enum type of this object
 func (chatEventMessageTtlChanged *ChatEventMessageTtlChanged) GetChatEventActionEnum() ChatEventActionEnum {
 	return ChatEventMessageTtlChangedType
 }
 
+// Accept calls the method of the visitor for ChatEventMessageTtlChanged
+func (chatEventMessageTtlChanged *ChatEventMessageTtlChanged) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventMessageTtlChanged(chatEventMessageTtlChanged)
+}
+
 // ChatEventSignMessagesToggled The sign_messages setting of a channel was toggled
 type ChatEventSignMessagesToggled struct {
 	tdCommon
@@ -972,11 +3437,118 @@ func NewChatEventSignMessagesToggled(signMessages bool) *ChatEventSignMessagesTo
 	return &chatEventSignMessagesToggledTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventSignMessagesToggled *ChatEventSignMessagesToggled) MarshalJSON() ([]byte, error) {
+	return chatEventSignMessagesToggled.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEventSignMessagesToggled *ChatEventSignMessagesToggled) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventSignMessagesToggled)
+}
+
+func (chatEventSignMessagesToggled *ChatEventSignMessagesToggled) appendJSON(b []byte) ([]byte, error) {
+	if chatEventSignMessagesToggled == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventSignMessagesToggled.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventSignMessagesToggled.tdCommon.Extra)
+	b = append(b, `,"sign_messages":`...)
+	b = appendJSONBool(b, chatEventSignMessagesToggled.SignMessages)
+	return append(b, '}'), nil
+}
+
+func (chatEventSignMessagesToggled *ChatEventSignMessagesToggled) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventSignMessagesToggled.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventSignMessagesToggled.tdCommon.Extra, err = r.readString()
+		case "sign_messages":
+			chatEventSignMessagesToggled.SignMessages, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventSignMessagesToggled *ChatEventSignMessagesToggled) Clone() *ChatEventSignMessagesToggled {
+	if chatEventSignMessagesToggled == nil {
+		return nil
+	}
+	clone := *chatEventSignMessagesToggled
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventSignMessagesToggled *ChatEventSignMessagesToggled) Equal(other *ChatEventSignMessagesToggled) bool {
+	if chatEventSignMessagesToggled == nil || other == nil {
+		return chatEventSignMessagesToggled == other
+	}
+	if chatEventSignMessagesToggled.SignMessages != other.SignMessages {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventSignMessagesToggled *ChatEventSignMessagesToggled) Diff(other *ChatEventSignMessagesToggled) []Change {
+	return chatEventSignMessagesToggled.diff("", other, nil)
+}
+
+func (chatEventSignMessagesToggled *ChatEventSignMessagesToggled) diff(path string, other *ChatEventSignMessagesToggled, changes []Change) []Change {
+	if chatEventSignMessagesToggled == nil || other == nil {
+		if chatEventSignMessagesToggled != other {
+			changes = append(changes, Change{Path: path, Old: chatEventSignMessagesToggled, New: other})
+		}
+		return changes
+	}
+	if chatEventSignMessagesToggled.SignMessages != other.SignMessages {
+		changes = append(changes, Change{Path: fieldPath(path, "sign_messages"), Old: chatEventSignMessagesToggled.SignMessages, New: other.SignMessages})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventSignMessagesToggled *ChatEventSignMessagesToggled) String() string {
+	return objectString(chatEventSignMessagesToggled)
+}
+
+// LogValue logs the object as its summary
+func (chatEventSignMessagesToggled *ChatEventSignMessagesToggled) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventSignMessagesToggled))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventSignMessagesToggled *ChatEventSignMessagesToggled) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventSignMessagesToggled)
+}
+
+func (chatEventSignMessagesToggled *ChatEventSignMessagesToggled) printText(p *printer) {
+	if chatEventSignMessagesToggled == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventSignMessagesToggled")
+	p.bool("sign_messages", chatEventSignMessagesToggled.SignMessages)
+	p.endObject(state)
+}
+
 // GetChatEventActionEnum return the enum type of this object
 func (chatEventSignMessagesToggled *ChatEventSignMessagesToggled) GetChatEventActionEnum() ChatEventActionEnum {
 	return ChatEventSignMessagesToggledType
 }
 
+// Accept calls the method of the visitor for ChatEventSignMessagesToggled
+func (chatEventSignMessagesToggled *ChatEventSignMessagesToggled) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventSignMessagesToggled(chatEventSignMessagesToggled)
+}
+
 // ChatEventHasProtectedContentToggled The has_protected_content setting of a channel was toggled
 type ChatEventHasProtectedContentToggled struct {
 	tdCommon
@@ -1000,11 +3572,118 @@ func NewChatEventHasProtectedContentToggled(hasProtectedContent bool) *ChatEvent
 	return &chatEventHasProtectedContentToggledTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventHasProtectedContentToggled *ChatEventHasProtectedContentToggled) MarshalJSON() ([]byte, error) {
+	return chatEventHasProtectedContentToggled.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEventHasProtectedContentToggled *ChatEventHasProtectedContentToggled) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventHasProtectedContentToggled)
+}
+
+func (chatEventHasProtectedContentToggled *ChatEventHasProtectedContentToggled) appendJSON(b []byte) ([]byte, error) {
+	if chatEventHasProtectedContentToggled == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventHasProtectedContentToggled.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventHasProtectedContentToggled.tdCommon.Extra)
+	b = append(b, `,"has_protected_content":`...)
+	b = appendJSONBool(b, chatEventHasProtectedContentToggled.HasProtectedContent)
+	return append(b, '}'), nil
+}
+
+func (chatEventHasProtectedContentToggled *ChatEventHasProtectedContentToggled) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventHasProtectedContentToggled.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventHasProtectedContentToggled.tdCommon.Extra, err = r.readString()
+		case "has_protected_content":
+			chatEventHasProtectedContentToggled.HasProtectedContent, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventHasProtectedContentToggled *ChatEventHasProtectedContentToggled) Clone() *ChatEventHasProtectedContentToggled {
+	if chatEventHasProtectedContentToggled == nil {
+		return nil
+	}
+	clone := *chatEventHasProtectedContentToggled
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventHasProtectedContentToggled *ChatEventHasProtectedContentToggled) Equal(other *ChatEventHasProtectedContentToggled) bool {
+	if chatEventHasProtectedContentToggled == nil || other == nil {
+		return chatEventHasProtectedContentToggled == other
+	}
+	if chatEventHasProtectedContentToggled.HasProtectedContent != other.HasProtectedContent {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventHasProtectedContentToggled *ChatEventHasProtectedContentToggled) Diff(other *ChatEventHasProtectedContentToggled) []Change {
+	return chatEventHasProtectedContentToggled.diff("", other, nil)
+}
+
+func (chatEventHasProtectedContentToggled *ChatEventHasProtectedContentToggled) diff(path string, other *ChatEventHasProtectedContentToggled, changes []Change) []Change {
+	if chatEventHasProtectedContentToggled == nil || other == nil {
+		if chatEventHasProtectedContentToggled != other {
+			changes = append(changes, Change{Path: path, Old: chatEventHasProtectedContentToggled, New: other})
+		}
+		return changes
+	}
+	if chatEventHasProtectedContentToggled.HasProtectedContent != other.HasProtectedContent {
+		changes = append(changes, Change{Path: fieldPath(path, "has_protected_content"), Old: chatEventHasProtectedContentToggled.HasProtectedContent, New: other.HasProtectedContent})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventHasProtectedContentToggled *ChatEventHasProtectedContentToggled) String() string {
+	return objectString(chatEventHasProtectedContentToggled)
+}
+
+// LogValue logs the object as its summary
+func (chatEventHasProtectedContentToggled *ChatEventHasProtectedContentToggled) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventHasProtectedContentToggled))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventHasProtectedContentToggled *ChatEventHasProtectedContentToggled) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventHasProtectedContentToggled)
+}
+
+func (chatEventHasProtectedContentToggled *ChatEventHasProtectedContentToggled) printText(p *printer) {
+	if chatEventHasProtectedContentToggled == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventHasProtectedContentToggled")
+	p.bool("has_protected_content", chatEventHasProtectedContentToggled.HasProtectedContent)
+	p.endObject(state)
+}
+
 // GetChatEventActionEnum return the enum type of this object
 func (chatEventHasProtectedContentToggled *ChatEventHasProtectedContentToggled) GetChatEventActionEnum() ChatEventActionEnum {
 	return ChatEventHasProtectedContentToggledType
 }
 
+// Accept calls the method of the visitor for ChatEventHasProtectedContentToggled
+func (chatEventHasProtectedContentToggled *ChatEventHasProtectedContentToggled) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventHasProtectedContentToggled(chatEventHasProtectedContentToggled)
+}
+
 // ChatEventStickerSetChanged The supergroup sticker set was changed
 type ChatEventStickerSetChanged struct {
 	tdCommon
@@ -1031,11 +3710,129 @@ func NewChatEventStickerSetChanged(oldStickerSetId JSONInt64, newStickerSetId JS
 	return &chatEventStickerSetChangedTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventStickerSetChanged *ChatEventStickerSetChanged) MarshalJSON() ([]byte, error) {
+	return chatEventStickerSetChanged.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEventStickerSetChanged *ChatEventStickerSetChanged) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventStickerSetChanged)
+}
+
+func (chatEventStickerSetChanged *ChatEventStickerSetChanged) appendJSON(b []byte) ([]byte, error) {
+	if chatEventStickerSetChanged == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventStickerSetChanged.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventStickerSetChanged.tdCommon.Extra)
+	b = append(b, `,"old_sticker_set_id":`...)
+	b = appendJSONInt64String(b, int64(chatEventStickerSetChanged.OldStickerSetId))
+	b = append(b, `,"new_sticker_set_id":`...)
+	b = appendJSONInt64String(b, int64(chatEventStickerSetChanged.NewStickerSetId))
+	return append(b, '}'), nil
+}
+
+func (chatEventStickerSetChanged *ChatEventStickerSetChanged) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventStickerSetChanged.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventStickerSetChanged.tdCommon.Extra, err = r.readString()
+		case "old_sticker_set_id":
+			chatEventStickerSetChanged.OldStickerSetId, err = r.readJSONInt64()
+		case "new_sticker_set_id":
+			chatEventStickerSetChanged.NewStickerSetId, err = r.readJSONInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventStickerSetChanged *ChatEventStickerSetChanged) Clone() *ChatEventStickerSetChanged {
+	if chatEventStickerSetChanged == nil {
+		return nil
+	}
+	clone := *chatEventStickerSetChanged
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventStickerSetChanged *ChatEventStickerSetChanged) Equal(other *ChatEventStickerSetChanged) bool {
+	if chatEventStickerSetChanged == nil || other == nil {
+		return chatEventStickerSetChanged == other
+	}
+	if chatEventStickerSetChanged.OldStickerSetId != other.OldStickerSetId {
+		return false
+	}
+	if chatEventStickerSetChanged.NewStickerSetId != other.NewStickerSetId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventStickerSetChanged *ChatEventStickerSetChanged) Diff(other *ChatEventStickerSetChanged) []Change {
+	return chatEventStickerSetChanged.diff("", other, nil)
+}
+
+func (chatEventStickerSetChanged *ChatEventStickerSetChanged) diff(path string, other *ChatEventStickerSetChanged, changes []Change) []Change {
+	if chatEventStickerSetChanged == nil || other == nil {
+		if chatEventStickerSetChanged != other {
+			changes = append(changes, Change{Path: path, Old: chatEventStickerSetChanged, New: other})
+		}
+		return changes
+	}
+	if chatEventStickerSetChanged.OldStickerSetId != other.OldStickerSetId {
+		changes = append(changes, Change{Path: fieldPath(path, "old_sticker_set_id"), Old: chatEventStickerSetChanged.OldStickerSetId, New: other.OldStickerSetId})
+	}
+	if chatEventStickerSetChanged.NewStickerSetId != other.NewStickerSetId {
+		changes = append(changes, Change{Path: fieldPath(path, "new_sticker_set_id"), Old: chatEventStickerSetChanged.NewStickerSetId, New: other.NewStickerSetId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventStickerSetChanged *ChatEventStickerSetChanged) String() string {
+	return objectString(chatEventStickerSetChanged)
+}
+
+// LogValue logs the object as its summary
+func (chatEventStickerSetChanged *ChatEventStickerSetChanged) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventStickerSetChanged))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventStickerSetChanged *ChatEventStickerSetChanged) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventStickerSetChanged)
+}
+
+func (chatEventStickerSetChanged *ChatEventStickerSetChanged) printText(p *printer) {
+	if chatEventStickerSetChanged == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventStickerSetChanged")
+	p.int("old_sticker_set_id", int64(chatEventStickerSetChanged.OldStickerSetId))
+	p.int("new_sticker_set_id", int64(chatEventStickerSetChanged.NewStickerSetId))
+	p.endObject(state)
+}
+
 // GetChatEventActionEnum return the enum type of this object
 func (chatEventStickerSetChanged *ChatEventStickerSetChanged) GetChatEventActionEnum() ChatEventActionEnum {
 	return ChatEventStickerSetChangedType
 }
 
+// Accept calls the method of the visitor for ChatEventStickerSetChanged
+func (chatEventStickerSetChanged *ChatEventStickerSetChanged) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventStickerSetChanged(chatEventStickerSetChanged)
+}
+
 // ChatEventLocationChanged The supergroup location was changed
 type ChatEventLocationChanged struct {
 	tdCommon
@@ -1062,11 +3859,144 @@ func NewChatEventLocationChanged(oldLocation *ChatLocation, newLocation *ChatLoc
 	return &chatEventLocationChangedTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventLocationChanged *ChatEventLocationChanged) MarshalJSON() ([]byte, error) {
+	return chatEventLocationChanged.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEventLocationChanged *ChatEventLocationChanged) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventLocationChanged)
+}
+
+func (chatEventLocationChanged *ChatEventLocationChanged) appendJSON(b []byte) ([]byte, error) {
+	if chatEventLocationChanged == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventLocationChanged.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventLocationChanged.tdCommon.Extra)
+	b = append(b, `,"old_location":`...)
+	b, err = chatEventLocationChanged.OldLocation.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"new_location":`...)
+	b, err = chatEventLocationChanged.NewLocation.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (chatEventLocationChanged *ChatEventLocationChanged) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventLocationChanged.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventLocationChanged.tdCommon.Extra, err = r.readString()
+		case "old_location":
+			if r.readNull() {
+				chatEventLocationChanged.OldLocation = nil
+			} else {
+				chatEventLocationChanged.OldLocation = new(ChatLocation)
+				err = chatEventLocationChanged.OldLocation.decodeJSON(r)
+			}
+		case "new_location":
+			if r.readNull() {
+				chatEventLocationChanged.NewLocation = nil
+			} else {
+				chatEventLocationChanged.NewLocation = new(ChatLocation)
+				err = chatEventLocationChanged.NewLocation.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventLocationChanged *ChatEventLocationChanged) Clone() *ChatEventLocationChanged {
+	if chatEventLocationChanged == nil {
+		return nil
+	}
+	clone := *chatEventLocationChanged
+	clone.OldLocation = chatEventLocationChanged.OldLocation.Clone()
+	clone.NewLocation = chatEventLocationChanged.NewLocation.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventLocationChanged *ChatEventLocationChanged) Equal(other *ChatEventLocationChanged) bool {
+	if chatEventLocationChanged == nil || other == nil {
+		return chatEventLocationChanged == other
+	}
+	if !chatEventLocationChanged.OldLocation.Equal(other.OldLocation) {
+		return false
+	}
+	if !chatEventLocationChanged.NewLocation.Equal(other.NewLocation) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventLocationChanged *ChatEventLocationChanged) Diff(other *ChatEventLocationChanged) []Change {
+	return chatEventLocationChanged.diff("", other, nil)
+}
+
+func (chatEventLocationChanged *ChatEventLocationChanged) diff(path string, other *ChatEventLocationChanged, changes []Change) []Change {
+	if chatEventLocationChanged == nil || other == nil {
+		if chatEventLocationChanged != other {
+			changes = append(changes, Change{Path: path, Old: chatEventLocationChanged, New: other})
+		}
+		return changes
+	}
+	changes = chatEventLocationChanged.OldLocation.diff(fieldPath(path, "old_location"), other.OldLocation, changes)
+	changes = chatEventLocationChanged.NewLocation.diff(fieldPath(path, "new_location"), other.NewLocation, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventLocationChanged *ChatEventLocationChanged) String() string {
+	return objectString(chatEventLocationChanged)
+}
+
+// LogValue logs the object as its summary
+func (chatEventLocationChanged *ChatEventLocationChanged) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventLocationChanged))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventLocationChanged *ChatEventLocationChanged) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventLocationChanged)
+}
+
+func (chatEventLocationChanged *ChatEventLocationChanged) printText(p *printer) {
+	if chatEventLocationChanged == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventLocationChanged")
+	p.object("old_location", chatEventLocationChanged.OldLocation)
+	p.object("new_location", chatEventLocationChanged.NewLocation)
+	p.endObject(state)
+}
+
 // GetChatEventActionEnum return the enum type of this object
 func (chatEventLocationChanged *ChatEventLocationChanged) GetChatEventActionEnum() ChatEventActionEnum {
 	return ChatEventLocationChangedType
 }
 
+// Accept calls the method of the visitor for ChatEventLocationChanged
+func (chatEventLocationChanged *ChatEventLocationChanged) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventLocationChanged(chatEventLocationChanged)
+}
+
 // ChatEventIsAllHistoryAvailableToggled The is_all_history_available setting of a supergroup was toggled
 type ChatEventIsAllHistoryAvailableToggled struct {
 	tdCommon
@@ -1090,11 +4020,118 @@ func NewChatEventIsAllHistoryAvailableToggled(isAllHistoryAvailable bool) *ChatE
 	return &chatEventIsAllHistoryAvailableToggledTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventIsAllHistoryAvailableToggled *ChatEventIsAllHistoryAvailableToggled) MarshalJSON() ([]byte, error) {
+	return chatEventIsAllHistoryAvailableToggled.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEventIsAllHistoryAvailableToggled *ChatEventIsAllHistoryAvailableToggled) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventIsAllHistoryAvailableToggled)
+}
+
+func (chatEventIsAllHistoryAvailableToggled *ChatEventIsAllHistoryAvailableToggled) appendJSON(b []byte) ([]byte, error) {
+	if chatEventIsAllHistoryAvailableToggled == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventIsAllHistoryAvailableToggled.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventIsAllHistoryAvailableToggled.tdCommon.Extra)
+	b = append(b, `,"is_all_history_available":`...)
+	b = appendJSONBool(b, chatEventIsAllHistoryAvailableToggled.IsAllHistoryAvailable)
+	return append(b, '}'), nil
+}
+
+func (chatEventIsAllHistoryAvailableToggled *ChatEventIsAllHistoryAvailableToggled) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventIsAllHistoryAvailableToggled.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventIsAllHistoryAvailableToggled.tdCommon.Extra, err = r.readString()
+		case "is_all_history_available":
+			chatEventIsAllHistoryAvailableToggled.IsAllHistoryAvailable, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventIsAllHistoryAvailableToggled *ChatEventIsAllHistoryAvailableToggled) Clone() *ChatEventIsAllHistoryAvailableToggled {
+	if chatEventIsAllHistoryAvailableToggled == nil {
+		return nil
+	}
+	clone := *chatEventIsAllHistoryAvailableToggled
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventIsAllHistoryAvailableToggled *ChatEventIsAllHistoryAvailableToggled) Equal(other *ChatEventIsAllHistoryAvailableToggled) bool {
+	if chatEventIsAllHistoryAvailableToggled == nil || other == nil {
+		return chatEventIsAllHistoryAvailableToggled == other
+	}
+	if chatEventIsAllHistoryAvailableToggled.IsAllHistoryAvailable != other.IsAllHistoryAvailable {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventIsAllHistoryAvailableToggled *ChatEventIsAllHistoryAvailableToggled) Diff(other *ChatEventIsAllHistoryAvailableToggled) []Change {
+	return chatEventIsAllHistoryAvailableToggled.diff("", other, nil)
+}
+
+func (chatEventIsAllHistoryAvailableToggled *ChatEventIsAllHistoryAvailableToggled) diff(path string, other *ChatEventIsAllHistoryAvailableToggled, changes []Change) []Change {
+	if chatEventIsAllHistoryAvailableToggled == nil || other == nil {
+		if chatEventIsAllHistoryAvailableToggled != other {
+			changes = append(changes, Change{Path: path, Old: chatEventIsAllHistoryAvailableToggled, New: other})
+		}
+		return changes
+	}
+	if chatEventIsAllHistoryAvailableToggled.IsAllHistoryAvailable != other.IsAllHistoryAvailable {
+		changes = append(changes, Change{Path: fieldPath(path, "is_all_history_available"), Old: chatEventIsAllHistoryAvailableToggled.IsAllHistoryAvailable, New: other.IsAllHistoryAvailable})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventIsAllHistoryAvailableToggled *ChatEventIsAllHistoryAvailableToggled) String() string {
+	return objectString(chatEventIsAllHistoryAvailableToggled)
+}
+
+// LogValue logs the object as its summary
+func (chatEventIsAllHistoryAvailableToggled *ChatEventIsAllHistoryAvailableToggled) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventIsAllHistoryAvailableToggled))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventIsAllHistoryAvailableToggled *ChatEventIsAllHistoryAvailableToggled) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventIsAllHistoryAvailableToggled)
+}
+
+func (chatEventIsAllHistoryAvailableToggled *ChatEventIsAllHistoryAvailableToggled) printText(p *printer) {
+	if chatEventIsAllHistoryAvailableToggled == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventIsAllHistoryAvailableToggled")
+	p.bool("is_all_history_available", chatEventIsAllHistoryAvailableToggled.IsAllHistoryAvailable)
+	p.endObject(state)
+}
+
 // GetChatEventActionEnum return the enum type of this object
 func (chatEventIsAllHistoryAvailableToggled *ChatEventIsAllHistoryAvailableToggled) GetChatEventActionEnum() ChatEventActionEnum {
 	return ChatEventIsAllHistoryAvailableToggledType
 }
 
+// Accept calls the method of the visitor for ChatEventIsAllHistoryAvailableToggled
+func (chatEventIsAllHistoryAvailableToggled *ChatEventIsAllHistoryAvailableToggled) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventIsAllHistoryAvailableToggled(chatEventIsAllHistoryAvailableToggled)
+}
+
 // ChatEventInviteLinkEdited A chat invite link was edited
 type ChatEventInviteLinkEdited struct {
 	tdCommon
@@ -1121,11 +4158,144 @@ func NewChatEventInviteLinkEdited(oldInviteLink *ChatInviteLink, newInviteLink *
 	return &chatEventInviteLinkEditedTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventInviteLinkEdited *ChatEventInviteLinkEdited) MarshalJSON() ([]byte, error) {
+	return chatEventInviteLinkEdited.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEventInviteLinkEdited *ChatEventInviteLinkEdited) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventInviteLinkEdited)
+}
+
+func (chatEventInviteLinkEdited *ChatEventInviteLinkEdited) appendJSON(b []byte) ([]byte, error) {
+	if chatEventInviteLinkEdited == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventInviteLinkEdited.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventInviteLinkEdited.tdCommon.Extra)
+	b = append(b, `,"old_invite_link":`...)
+	b, err = chatEventInviteLinkEdited.OldInviteLink.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"new_invite_link":`...)
+	b, err = chatEventInviteLinkEdited.NewInviteLink.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (chatEventInviteLinkEdited *ChatEventInviteLinkEdited) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventInviteLinkEdited.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventInviteLinkEdited.tdCommon.Extra, err = r.readString()
+		case "old_invite_link":
+			if r.readNull() {
+				chatEventInviteLinkEdited.OldInviteLink = nil
+			} else {
+				chatEventInviteLinkEdited.OldInviteLink = new(ChatInviteLink)
+				err = chatEventInviteLinkEdited.OldInviteLink.decodeJSON(r)
+			}
+		case "new_invite_link":
+			if r.readNull() {
+				chatEventInviteLinkEdited.NewInviteLink = nil
+			} else {
+				chatEventInviteLinkEdited.NewInviteLink = new(ChatInviteLink)
+				err = chatEventInviteLinkEdited.NewInviteLink.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventInviteLinkEdited *ChatEventInviteLinkEdited) Clone() *ChatEventInviteLinkEdited {
+	if chatEventInviteLinkEdited == nil {
+		return nil
+	}
+	clone := *chatEventInviteLinkEdited
+	clone.OldInviteLink = chatEventInviteLinkEdited.OldInviteLink.Clone()
+	clone.NewInviteLink = chatEventInviteLinkEdited.NewInviteLink.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventInviteLinkEdited *ChatEventInviteLinkEdited) Equal(other *ChatEventInviteLinkEdited) bool {
+	if chatEventInviteLinkEdited == nil || other == nil {
+		return chatEventInviteLinkEdited == other
+	}
+	if !chatEventInviteLinkEdited.OldInviteLink.Equal(other.OldInviteLink) {
+		return false
+	}
+	if !chatEventInviteLinkEdited.NewInviteLink.Equal(other.NewInviteLink) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventInviteLinkEdited *ChatEventInviteLinkEdited) Diff(other *ChatEventInviteLinkEdited) []Change {
+	return chatEventInviteLinkEdited.diff("", other, nil)
+}
+
+func (chatEventInviteLinkEdited *ChatEventInviteLinkEdited) diff(path string, other *ChatEventInviteLinkEdited, changes []Change) []Change {
+	if chatEventInviteLinkEdited == nil || other == nil {
+		if chatEventInviteLinkEdited != other {
+			changes = append(changes, Change{Path: path, Old: chatEventInviteLinkEdited, New: other})
+		}
+		return changes
+	}
+	changes = chatEventInviteLinkEdited.OldInviteLink.diff(fieldPath(path, "old_invite_link"), other.OldInviteLink, changes)
+	changes = chatEventInviteLinkEdited.NewInviteLink.diff(fieldPath(path, "new_invite_link"), other.NewInviteLink, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventInviteLinkEdited *ChatEventInviteLinkEdited) String() string {
+	return objectString(chatEventInviteLinkEdited)
+}
+
+// LogValue logs the object as its summary
+func (chatEventInviteLinkEdited *ChatEventInviteLinkEdited) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventInviteLinkEdited))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventInviteLinkEdited *ChatEventInviteLinkEdited) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventInviteLinkEdited)
+}
+
+func (chatEventInviteLinkEdited *ChatEventInviteLinkEdited) printText(p *printer) {
+	if chatEventInviteLinkEdited == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventInviteLinkEdited")
+	p.object("old_invite_link", chatEventInviteLinkEdited.OldInviteLink)
+	p.object("new_invite_link", chatEventInviteLinkEdited.NewInviteLink)
+	p.endObject(state)
+}
+
 // GetChatEventActionEnum return the enum type of this object
 func (chatEventInviteLinkEdited *ChatEventInviteLinkEdited) GetChatEventActionEnum() ChatEventActionEnum {
 	return ChatEventInviteLinkEditedType
 }
 
+// Accept calls the method of the visitor for ChatEventInviteLinkEdited
+func (chatEventInviteLinkEdited *ChatEventInviteLinkEdited) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventInviteLinkEdited(chatEventInviteLinkEdited)
+}
+
 // ChatEventInviteLinkRevoked A chat invite link was revoked
 type ChatEventInviteLinkRevoked struct {
 	tdCommon
@@ -1149,11 +4319,126 @@ func NewChatEventInviteLinkRevoked(inviteLink *ChatInviteLink) *ChatEventInviteL
 	return &chatEventInviteLinkRevokedTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventInviteLinkRevoked *ChatEventInviteLinkRevoked) MarshalJSON() ([]byte, error) {
+	return chatEventInviteLinkRevoked.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEventInviteLinkRevoked *ChatEventInviteLinkRevoked) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventInviteLinkRevoked)
+}
+
+func (chatEventInviteLinkRevoked *ChatEventInviteLinkRevoked) appendJSON(b []byte) ([]byte, error) {
+	if chatEventInviteLinkRevoked == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventInviteLinkRevoked.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventInviteLinkRevoked.tdCommon.Extra)
+	b = append(b, `,"invite_link":`...)
+	b, err = chatEventInviteLinkRevoked.InviteLink.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (chatEventInviteLinkRevoked *ChatEventInviteLinkRevoked) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventInviteLinkRevoked.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventInviteLinkRevoked.tdCommon.Extra, err = r.readString()
+		case "invite_link":
+			if r.readNull() {
+				chatEventInviteLinkRevoked.InviteLink = nil
+			} else {
+				chatEventInviteLinkRevoked.InviteLink = new(ChatInviteLink)
+				err = chatEventInviteLinkRevoked.InviteLink.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventInviteLinkRevoked *ChatEventInviteLinkRevoked) Clone() *ChatEventInviteLinkRevoked {
+	if chatEventInviteLinkRevoked == nil {
+		return nil
+	}
+	clone := *chatEventInviteLinkRevoked
+	clone.InviteLink = chatEventInviteLinkRevoked.InviteLink.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventInviteLinkRevoked *ChatEventInviteLinkRevoked) Equal(other *ChatEventInviteLinkRevoked) bool {
+	if chatEventInviteLinkRevoked == nil || other == nil {
+		return chatEventInviteLinkRevoked == other
+	}
+	if !chatEventInviteLinkRevoked.InviteLink.Equal(other.InviteLink) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventInviteLinkRevoked *ChatEventInviteLinkRevoked) Diff(other *ChatEventInviteLinkRevoked) []Change {
+	return chatEventInviteLinkRevoked.diff("", other, nil)
+}
+
+func (chatEventInviteLinkRevoked *ChatEventInviteLinkRevoked) diff(path string, other *ChatEventInviteLinkRevoked, changes []Change) []Change {
+	if chatEventInviteLinkRevoked == nil || other == nil {
+		if chatEventInviteLinkRevoked != other {
+			changes = append(changes, Change{Path: path, Old: chatEventInviteLinkRevoked, New: other})
+		}
+		return changes
+	}
+	changes = chatEventInviteLinkRevoked.InviteLink.diff(fieldPath(path, "invite_link"), other.InviteLink, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventInviteLinkRevoked *ChatEventInviteLinkRevoked) String() string {
+	return objectString(chatEventInviteLinkRevoked)
+}
+
+// LogValue logs the object as its summary
+func (chatEventInviteLinkRevoked *ChatEventInviteLinkRevoked) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventInviteLinkRevoked))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventInviteLinkRevoked *ChatEventInviteLinkRevoked) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventInviteLinkRevoked)
+}
+
+func (chatEventInviteLinkRevoked *ChatEventInviteLinkRevoked) printText(p *printer) {
+	if chatEventInviteLinkRevoked == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventInviteLinkRevoked")
+	p.object("invite_link", chatEventInviteLinkRevoked.InviteLink)
+	p.endObject(state)
+}
+
 // GetChatEventActionEnum return the enum type of this object
 func (chatEventInviteLinkRevoked *ChatEventInviteLinkRevoked) GetChatEventActionEnum() ChatEventActionEnum {
 	return ChatEventInviteLinkRevokedType
 }
 
+// Accept calls the method of the visitor for ChatEventInviteLinkRevoked
+func (chatEventInviteLinkRevoked *ChatEventInviteLinkRevoked) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventInviteLinkRevoked(chatEventInviteLinkRevoked)
+}
+
 // ChatEventInviteLinkDeleted A revoked chat invite link was deleted
 type ChatEventInviteLinkDeleted struct {
 	tdCommon
@@ -1177,11 +4462,126 @@ func NewChatEventInviteLinkDeleted(inviteLink *ChatInviteLink) *ChatEventInviteL
 	return &chatEventInviteLinkDeletedTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventInviteLinkDeleted *ChatEventInviteLinkDeleted) MarshalJSON() ([]byte, error) {
+	return chatEventInviteLinkDeleted.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEventInviteLinkDeleted *ChatEventInviteLinkDeleted) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventInviteLinkDeleted)
+}
+
+func (chatEventInviteLinkDeleted *ChatEventInviteLinkDeleted) appendJSON(b []byte) ([]byte, error) {
+	if chatEventInviteLinkDeleted == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventInviteLinkDeleted.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventInviteLinkDeleted.tdCommon.Extra)
+	b = append(b, `,"invite_link":`...)
+	b, err = chatEventInviteLinkDeleted.InviteLink.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (chatEventInviteLinkDeleted *ChatEventInviteLinkDeleted) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventInviteLinkDeleted.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventInviteLinkDeleted.tdCommon.Extra, err = r.readString()
+		case "invite_link":
+			if r.readNull() {
+				chatEventInviteLinkDeleted.InviteLink = nil
+			} else {
+				chatEventInviteLinkDeleted.InviteLink = new(ChatInviteLink)
+				err = chatEventInviteLinkDeleted.InviteLink.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventInviteLinkDeleted *ChatEventInviteLinkDeleted) Clone() *ChatEventInviteLinkDeleted {
+	if chatEventInviteLinkDeleted == nil {
+		return nil
+	}
+	clone := *chatEventInviteLinkDeleted
+	clone.InviteLink = chatEventInviteLinkDeleted.InviteLink.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventInviteLinkDeleted *ChatEventInviteLinkDeleted) Equal(other *ChatEventInviteLinkDeleted) bool {
+	if chatEventInviteLinkDeleted == nil || other == nil {
+		return chatEventInviteLinkDeleted == other
+	}
+	if !chatEventInviteLinkDeleted.InviteLink.Equal(other.InviteLink) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventInviteLinkDeleted *ChatEventInviteLinkDeleted) Diff(other *ChatEventInviteLinkDeleted) []Change {
+	return chatEventInviteLinkDeleted.diff("", other, nil)
+}
+
+func (chatEventInviteLinkDeleted *ChatEventInviteLinkDeleted) diff(path string, other *ChatEventInviteLinkDeleted, changes []Change) []Change {
+	if chatEventInviteLinkDeleted == nil || other == nil {
+		if chatEventInviteLinkDeleted != other {
+			changes = append(changes, Change{Path: path, Old: chatEventInviteLinkDeleted, New: other})
+		}
+		return changes
+	}
+	changes = chatEventInviteLinkDeleted.InviteLink.diff(fieldPath(path, "invite_link"), other.InviteLink, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventInviteLinkDeleted *ChatEventInviteLinkDeleted) String() string {
+	return objectString(chatEventInviteLinkDeleted)
+}
+
+// LogValue logs the object as its summary
+func (chatEventInviteLinkDeleted *ChatEventInviteLinkDeleted) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventInviteLinkDeleted))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventInviteLinkDeleted *ChatEventInviteLinkDeleted) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventInviteLinkDeleted)
+}
+
+func (chatEventInviteLinkDeleted *ChatEventInviteLinkDeleted) printText(p *printer) {
+	if chatEventInviteLinkDeleted == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventInviteLinkDeleted")
+	p.object("invite_link", chatEventInviteLinkDeleted.InviteLink)
+	p.endObject(state)
+}
+
 // GetChatEventActionEnum return the enum type of this object
 func (chatEventInviteLinkDeleted *ChatEventInviteLinkDeleted) GetChatEventActionEnum() ChatEventActionEnum {
 	return ChatEventInviteLinkDeletedType
 }
 
+// Accept calls the method of the visitor for ChatEventInviteLinkDeleted
+func (chatEventInviteLinkDeleted *ChatEventInviteLinkDeleted) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventInviteLinkDeleted(chatEventInviteLinkDeleted)
+}
+
 // ChatEventVideoChatCreated A video chat was created
 type ChatEventVideoChatCreated struct {
 	tdCommon
@@ -1205,11 +4605,118 @@ func NewChatEventVideoChatCreated(groupCallId int32) *ChatEventVideoChatCreated
 	return &chatEventVideoChatCreatedTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventVideoChatCreated *ChatEventVideoChatCreated) MarshalJSON() ([]byte, error) {
+	return chatEventVideoChatCreated.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEventVideoChatCreated *ChatEventVideoChatCreated) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventVideoChatCreated)
+}
+
+func (chatEventVideoChatCreated *ChatEventVideoChatCreated) appendJSON(b []byte) ([]byte, error) {
+	if chatEventVideoChatCreated == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventVideoChatCreated.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventVideoChatCreated.tdCommon.Extra)
+	b = append(b, `,"group_call_id":`...)
+	b = appendJSONInt(b, int64(chatEventVideoChatCreated.GroupCallId))
+	return append(b, '}'), nil
+}
+
+func (chatEventVideoChatCreated *ChatEventVideoChatCreated) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventVideoChatCreated.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventVideoChatCreated.tdCommon.Extra, err = r.readString()
+		case "group_call_id":
+			chatEventVideoChatCreated.GroupCallId, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventVideoChatCreated *ChatEventVideoChatCreated) Clone() *ChatEventVideoChatCreated {
+	if chatEventVideoChatCreated == nil {
+		return nil
+	}
+	clone := *chatEventVideoChatCreated
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventVideoChatCreated *ChatEventVideoChatCreated) Equal(other *ChatEventVideoChatCreated) bool {
+	if chatEventVideoChatCreated == nil || other == nil {
+		return chatEventVideoChatCreated == other
+	}
+	if chatEventVideoChatCreated.GroupCallId != other.GroupCallId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventVideoChatCreated *ChatEventVideoChatCreated) Diff(other *ChatEventVideoChatCreated) []Change {
+	return chatEventVideoChatCreated.diff("", other, nil)
+}
+
+func (chatEventVideoChatCreated *ChatEventVideoChatCreated) diff(path string, other *ChatEventVideoChatCreated, changes []Change) []Change {
+	if chatEventVideoChatCreated == nil || other == nil {
+		if chatEventVideoChatCreated != other {
+			changes = append(changes, Change{Path: path, Old: chatEventVideoChatCreated, New: other})
+		}
+		return changes
+	}
+	if chatEventVideoChatCreated.GroupCallId != other.GroupCallId {
+		changes = append(changes, Change{Path: fieldPath(path, "group_call_id"), Old: chatEventVideoChatCreated.GroupCallId, New: other.GroupCallId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventVideoChatCreated *ChatEventVideoChatCreated) String() string {
+	return objectString(chatEventVideoChatCreated)
+}
+
+// LogValue logs the object as its summary
+func (chatEventVideoChatCreated *ChatEventVideoChatCreated) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventVideoChatCreated))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventVideoChatCreated *ChatEventVideoChatCreated) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventVideoChatCreated)
+}
+
+func (chatEventVideoChatCreated *ChatEventVideoChatCreated) printText(p *printer) {
+	if chatEventVideoChatCreated == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventVideoChatCreated")
+	p.int("group_call_id", int64(chatEventVideoChatCreated.GroupCallId))
+	p.endObject(state)
+}
+
 // GetChatEventActionEnum return the enum type of this object
 func (chatEventVideoChatCreated *ChatEventVideoChatCreated) GetChatEventActionEnum() ChatEventActionEnum {
 	return ChatEventVideoChatCreatedType
 }
 
+// Accept calls the method of the visitor for ChatEventVideoChatCreated
+func (chatEventVideoChatCreated *ChatEventVideoChatCreated) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventVideoChatCreated(chatEventVideoChatCreated)
+}
+
 // ChatEventVideoChatEnded A video chat was ended
 type ChatEventVideoChatEnded struct {
 	tdCommon
@@ -1233,11 +4740,118 @@ func NewChatEventVideoChatEnded(groupCallId int32) *ChatEventVideoChatEnded {
 	return &chatEventVideoChatEndedTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventVideoChatEnded *ChatEventVideoChatEnded) MarshalJSON() ([]byte, error) {
+	return chatEventVideoChatEnded.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEventVideoChatEnded *ChatEventVideoChatEnded) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventVideoChatEnded)
+}
+
+func (chatEventVideoChatEnded *ChatEventVideoChatEnded) appendJSON(b []byte) ([]byte, error) {
+	if chatEventVideoChatEnded == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventVideoChatEnded.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventVideoChatEnded.tdCommon.Extra)
+	b = append(b, `,"group_call_id":`...)
+	b = appendJSONInt(b, int64(chatEventVideoChatEnded.GroupCallId))
+	return append(b, '}'), nil
+}
+
+func (chatEventVideoChatEnded *ChatEventVideoChatEnded) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventVideoChatEnded.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventVideoChatEnded.tdCommon.Extra, err = r.readString()
+		case "group_call_id":
+			chatEventVideoChatEnded.GroupCallId, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventVideoChatEnded *ChatEventVideoChatEnded) Clone() *ChatEventVideoChatEnded {
+	if chatEventVideoChatEnded == nil {
+		return nil
+	}
+	clone := *chatEventVideoChatEnded
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventVideoChatEnded *ChatEventVideoChatEnded) Equal(other *ChatEventVideoChatEnded) bool {
+	if chatEventVideoChatEnded == nil || other == nil {
+		return chatEventVideoChatEnded == other
+	}
+	if chatEventVideoChatEnded.GroupCallId != other.GroupCallId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventVideoChatEnded *ChatEventVideoChatEnded) Diff(other *ChatEventVideoChatEnded) []Change {
+	return chatEventVideoChatEnded.diff("", other, nil)
+}
+
+func (chatEventVideoChatEnded *ChatEventVideoChatEnded) diff(path string, other *ChatEventVideoChatEnded, changes []Change) []Change {
+	if chatEventVideoChatEnded == nil || other == nil {
+		if chatEventVideoChatEnded != other {
+			changes = append(changes, Change{Path: path, Old: chatEventVideoChatEnded, New: other})
+		}
+		return changes
+	}
+	if chatEventVideoChatEnded.GroupCallId != other.GroupCallId {
+		changes = append(changes, Change{Path: fieldPath(path, "group_call_id"), Old: chatEventVideoChatEnded.GroupCallId, New: other.GroupCallId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventVideoChatEnded *ChatEventVideoChatEnded) String() string {
+	return objectString(chatEventVideoChatEnded)
+}
+
+// LogValue logs the object as its summary
+func (chatEventVideoChatEnded *ChatEventVideoChatEnded) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventVideoChatEnded))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventVideoChatEnded *ChatEventVideoChatEnded) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventVideoChatEnded)
+}
+
+func (chatEventVideoChatEnded *ChatEventVideoChatEnded) printText(p *printer) {
+	if chatEventVideoChatEnded == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventVideoChatEnded")
+	p.int("group_call_id", int64(chatEventVideoChatEnded.GroupCallId))
+	p.endObject(state)
+}
+
 // GetChatEventActionEnum return the enum type of this object
 func (chatEventVideoChatEnded *ChatEventVideoChatEnded) GetChatEventActionEnum() ChatEventActionEnum {
 	return ChatEventVideoChatEndedType
 }
 
+// Accept calls the method of the visitor for ChatEventVideoChatEnded
+func (chatEventVideoChatEnded *ChatEventVideoChatEnded) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventVideoChatEnded(chatEventVideoChatEnded)
+}
+
 // ChatEventVideoChatParticipantIsMutedToggled A video chat participant was muted or unmuted
 type ChatEventVideoChatParticipantIsMutedToggled struct {
 	tdCommon
@@ -1264,29 +4878,120 @@ func NewChatEventVideoChatParticipantIsMutedToggled(participantId MessageSender,
 	return &chatEventVideoChatParticipantIsMutedToggledTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventVideoChatParticipantIsMutedToggled *ChatEventVideoChatParticipantIsMutedToggled) MarshalJSON() ([]byte, error) {
+	return chatEventVideoChatParticipantIsMutedToggled.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (chatEventVideoChatParticipantIsMutedToggled *ChatEventVideoChatParticipantIsMutedToggled) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, chatEventVideoChatParticipantIsMutedToggled)
+}
+
+func (chatEventVideoChatParticipantIsMutedToggled *ChatEventVideoChatParticipantIsMutedToggled) appendJSON(b []byte) ([]byte, error) {
+	if chatEventVideoChatParticipantIsMutedToggled == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-		IsMuted bool `json:"is_muted"` // New value of is_muted
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventVideoChatParticipantIsMutedToggled.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventVideoChatParticipantIsMutedToggled.tdCommon.Extra)
+	b = append(b, `,"participant_id":`...)
+	b, err = appendJSONValue(b, chatEventVideoChatParticipantIsMutedToggled.ParticipantId)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"is_muted":`...)
+	b = appendJSONBool(b, chatEventVideoChatParticipantIsMutedToggled.IsMuted)
+	return append(b, '}'), nil
+}
+
+func (chatEventVideoChatParticipantIsMutedToggled *ChatEventVideoChatParticipantIsMutedToggled) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventVideoChatParticipantIsMutedToggled.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventVideoChatParticipantIsMutedToggled.tdCommon.Extra, err = r.readString()
+		case "participant_id":
+			chatEventVideoChatParticipantIsMutedToggled.ParticipantId, err = decodeMessageSender(r)
+		case "is_muted":
+			chatEventVideoChatParticipantIsMutedToggled.IsMuted, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventVideoChatParticipantIsMutedToggled *ChatEventVideoChatParticipantIsMutedToggled) Clone() *ChatEventVideoChatParticipantIsMutedToggled {
+	if chatEventVideoChatParticipantIsMutedToggled == nil {
+		return nil
+	}
+	clone := *chatEventVideoChatParticipantIsMutedToggled
+	clone.ParticipantId = cloneMessageSender(chatEventVideoChatParticipantIsMutedToggled.ParticipantId)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventVideoChatParticipantIsMutedToggled *ChatEventVideoChatParticipantIsMutedToggled) Equal(other *ChatEventVideoChatParticipantIsMutedToggled) bool {
+	if chatEventVideoChatParticipantIsMutedToggled == nil || other == nil {
+		return chatEventVideoChatParticipantIsMutedToggled == other
+	}
+	if !equalMessageSender(chatEventVideoChatParticipantIsMutedToggled.ParticipantId, other.ParticipantId) {
+		return false
+	}
+	if chatEventVideoChatParticipantIsMutedToggled.IsMuted != other.IsMuted {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventVideoChatParticipantIsMutedToggled *ChatEventVideoChatParticipantIsMutedToggled) Diff(other *ChatEventVideoChatParticipantIsMutedToggled) []Change {
+	return chatEventVideoChatParticipantIsMutedToggled.diff("", other, nil)
+}
+
+func (chatEventVideoChatParticipantIsMutedToggled *ChatEventVideoChatParticipantIsMutedToggled) diff(path string, other *ChatEventVideoChatParticipantIsMutedToggled, changes []Change) []Change {
+	if chatEventVideoChatParticipantIsMutedToggled == nil || other == nil {
+		if chatEventVideoChatParticipantIsMutedToggled != other {
+			changes = append(changes, Change{Path: path, Old: chatEventVideoChatParticipantIsMutedToggled, New: other})
+		}
+		return changes
 	}
+	changes = diffMessageSender(fieldPath(path, "participant_id"), chatEventVideoChatParticipantIsMutedToggled.ParticipantId, other.ParticipantId, changes)
+	if chatEventVideoChatParticipantIsMutedToggled.IsMuted != other.IsMuted {
+		changes = append(changes, Change{Path: fieldPath(path, "is_muted"), Old: chatEventVideoChatParticipantIsMutedToggled.IsMuted, New: other.IsMuted})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventVideoChatParticipantIsMutedToggled *ChatEventVideoChatParticipantIsMutedToggled) String() string {
+	return objectString(chatEventVideoChatParticipantIsMutedToggled)
+}
 
-	chatEventVideoChatParticipantIsMutedToggled.tdCommon = tempObj.tdCommon
-	chatEventVideoChatParticipantIsMutedToggled.IsMuted = tempObj.IsMuted
+// LogValue logs the object as its summary
+func (chatEventVideoChatParticipantIsMutedToggled *ChatEventVideoChatParticipantIsMutedToggled) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventVideoChatParticipantIsMutedToggled))
+}
 
-	fieldParticipantId, _ := unmarshalMessageSender(objMap["participant_id"])
-	chatEventVideoChatParticipantIsMutedToggled.ParticipantId = fieldParticipantId
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventVideoChatParticipantIsMutedToggled *ChatEventVideoChatParticipantIsMutedToggled) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventVideoChatParticipantIsMutedToggled)
+}
 
-	return nil
+func (chatEventVideoChatParticipantIsMutedToggled *ChatEventVideoChatParticipantIsMutedToggled) printText(p *printer) {
+	if chatEventVideoChatParticipantIsMutedToggled == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventVideoChatParticipantIsMutedToggled")
+	p.object("participant_id", chatEventVideoChatParticipantIsMutedToggled.ParticipantId)
+	p.bool("is_muted", chatEventVideoChatParticipantIsMutedToggled.IsMuted)
+	p.endObject(state)
 }
 
 // GetChatEventActionEnum return the enum type of this object
@@ -1294,6 +4999,11 @@ func (chatEventVideoChatParticipantIsMutedToggled *ChatEventVideoChatParticipant
 	return ChatEventVideoChatParticipantIsMutedToggledType
 }
 
+// Accept calls the method of the visitor for ChatEventVideoChatParticipantIsMutedToggled
+func (chatEventVideoChatParticipantIsMutedToggled *ChatEventVideoChatParticipantIsMutedToggled) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventVideoChatParticipantIsMutedToggled(chatEventVideoChatParticipantIsMutedToggled)
+}
+
 // ChatEventVideoChatParticipantVolumeLevelChanged A video chat participant volume level was changed
 type ChatEventVideoChatParticipantVolumeLevelChanged struct {
 	tdCommon
@@ -1320,29 +5030,120 @@ func NewChatEventVideoChatParticipantVolumeLevelChanged(participantId MessageSen
 	return &chatEventVideoChatParticipantVolumeLevelChangedTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventVideoChatParticipantVolumeLevelChanged *ChatEventVideoChatParticipantVolumeLevelChanged) MarshalJSON() ([]byte, error) {
+	return chatEventVideoChatParticipantVolumeLevelChanged.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (chatEventVideoChatParticipantVolumeLevelChanged *ChatEventVideoChatParticipantVolumeLevelChanged) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, chatEventVideoChatParticipantVolumeLevelChanged)
+}
+
+func (chatEventVideoChatParticipantVolumeLevelChanged *ChatEventVideoChatParticipantVolumeLevelChanged) appendJSON(b []byte) ([]byte, error) {
+	if chatEventVideoChatParticipantVolumeLevelChanged == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-		VolumeLevel int32 `json:"volume_level"` // New value of volume_level; 1-20000 in hundreds of percents
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventVideoChatParticipantVolumeLevelChanged.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventVideoChatParticipantVolumeLevelChanged.tdCommon.Extra)
+	b = append(b, `,"participant_id":`...)
+	b, err = appendJSONValue(b, chatEventVideoChatParticipantVolumeLevelChanged.ParticipantId)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"volume_level":`...)
+	b = appendJSONInt(b, int64(chatEventVideoChatParticipantVolumeLevelChanged.VolumeLevel))
+	return append(b, '}'), nil
+}
+
+func (chatEventVideoChatParticipantVolumeLevelChanged *ChatEventVideoChatParticipantVolumeLevelChanged) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventVideoChatParticipantVolumeLevelChanged.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventVideoChatParticipantVolumeLevelChanged.tdCommon.Extra, err = r.readString()
+		case "participant_id":
+			chatEventVideoChatParticipantVolumeLevelChanged.ParticipantId, err = decodeMessageSender(r)
+		case "volume_level":
+			chatEventVideoChatParticipantVolumeLevelChanged.VolumeLevel, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventVideoChatParticipantVolumeLevelChanged *ChatEventVideoChatParticipantVolumeLevelChanged) Clone() *ChatEventVideoChatParticipantVolumeLevelChanged {
+	if chatEventVideoChatParticipantVolumeLevelChanged == nil {
+		return nil
+	}
+	clone := *chatEventVideoChatParticipantVolumeLevelChanged
+	clone.ParticipantId = cloneMessageSender(chatEventVideoChatParticipantVolumeLevelChanged.ParticipantId)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventVideoChatParticipantVolumeLevelChanged *ChatEventVideoChatParticipantVolumeLevelChanged) Equal(other *ChatEventVideoChatParticipantVolumeLevelChanged) bool {
+	if chatEventVideoChatParticipantVolumeLevelChanged == nil || other == nil {
+		return chatEventVideoChatParticipantVolumeLevelChanged == other
+	}
+	if !equalMessageSender(chatEventVideoChatParticipantVolumeLevelChanged.ParticipantId, other.ParticipantId) {
+		return false
 	}
+	if chatEventVideoChatParticipantVolumeLevelChanged.VolumeLevel != other.VolumeLevel {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventVideoChatParticipantVolumeLevelChanged *ChatEventVideoChatParticipantVolumeLevelChanged) Diff(other *ChatEventVideoChatParticipantVolumeLevelChanged) []Change {
+	return chatEventVideoChatParticipantVolumeLevelChanged.diff("", other, nil)
+}
+
+func (chatEventVideoChatParticipantVolumeLevelChanged *ChatEventVideoChatParticipantVolumeLevelChanged) diff(path string, other *ChatEventVideoChatParticipantVolumeLevelChanged, changes []Change) []Change {
+	if chatEventVideoChatParticipantVolumeLevelChanged == nil || other == nil {
+		if chatEventVideoChatParticipantVolumeLevelChanged != other {
+			changes = append(changes, Change{Path: path, Old: chatEventVideoChatParticipantVolumeLevelChanged, New: other})
+		}
+		return changes
+	}
+	changes = diffMessageSender(fieldPath(path, "participant_id"), chatEventVideoChatParticipantVolumeLevelChanged.ParticipantId, other.ParticipantId, changes)
+	if chatEventVideoChatParticipantVolumeLevelChanged.VolumeLevel != other.VolumeLevel {
+		changes = append(changes, Change{Path: fieldPath(path, "volume_level"), Old: chatEventVideoChatParticipantVolumeLevelChanged.VolumeLevel, New: other.VolumeLevel})
+	}
+	return changes
+}
 
-	chatEventVideoChatParticipantVolumeLevelChanged.tdCommon = tempObj.tdCommon
-	chatEventVideoChatParticipantVolumeLevelChanged.VolumeLevel = tempObj.VolumeLevel
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventVideoChatParticipantVolumeLevelChanged *ChatEventVideoChatParticipantVolumeLevelChanged) String() string {
+	return objectString(chatEventVideoChatParticipantVolumeLevelChanged)
+}
+
+// LogValue logs the object as its summary
+func (chatEventVideoChatParticipantVolumeLevelChanged *ChatEventVideoChatParticipantVolumeLevelChanged) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventVideoChatParticipantVolumeLevelChanged))
+}
 
-	fieldParticipantId, _ := unmarshalMessageSender(objMap["participant_id"])
-	chatEventVideoChatParticipantVolumeLevelChanged.ParticipantId = fieldParticipantId
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventVideoChatParticipantVolumeLevelChanged *ChatEventVideoChatParticipantVolumeLevelChanged) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventVideoChatParticipantVolumeLevelChanged)
+}
 
-	return nil
+func (chatEventVideoChatParticipantVolumeLevelChanged *ChatEventVideoChatParticipantVolumeLevelChanged) printText(p *printer) {
+	if chatEventVideoChatParticipantVolumeLevelChanged == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventVideoChatParticipantVolumeLevelChanged")
+	p.object("participant_id", chatEventVideoChatParticipantVolumeLevelChanged.ParticipantId)
+	p.int("volume_level", int64(chatEventVideoChatParticipantVolumeLevelChanged.VolumeLevel))
+	p.endObject(state)
 }
 
 // GetChatEventActionEnum return the enum type of this object
@@ -1350,6 +5151,11 @@ func (chatEventVideoChatParticipantVolumeLevelChanged *ChatEventVideoChatPartici
 	return ChatEventVideoChatParticipantVolumeLevelChangedType
 }
 
+// Accept calls the method of the visitor for ChatEventVideoChatParticipantVolumeLevelChanged
+func (chatEventVideoChatParticipantVolumeLevelChanged *ChatEventVideoChatParticipantVolumeLevelChanged) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventVideoChatParticipantVolumeLevelChanged(chatEventVideoChatParticipantVolumeLevelChanged)
+}
+
 // ChatEventVideoChatMuteNewParticipantsToggled The mute_new_participants setting of a video chat was toggled
 type ChatEventVideoChatMuteNewParticipantsToggled struct {
 	tdCommon
@@ -1373,7 +5179,689 @@ func NewChatEventVideoChatMuteNewParticipantsToggled(muteNewParticipants bool) *
 	return &chatEventVideoChatMuteNewParticipantsToggledTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEventVideoChatMuteNewParticipantsToggled *ChatEventVideoChatMuteNewParticipantsToggled) MarshalJSON() ([]byte, error) {
+	return chatEventVideoChatMuteNewParticipantsToggled.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEventVideoChatMuteNewParticipantsToggled *ChatEventVideoChatMuteNewParticipantsToggled) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventVideoChatMuteNewParticipantsToggled)
+}
+
+func (chatEventVideoChatMuteNewParticipantsToggled *ChatEventVideoChatMuteNewParticipantsToggled) appendJSON(b []byte) ([]byte, error) {
+	if chatEventVideoChatMuteNewParticipantsToggled == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventVideoChatMuteNewParticipantsToggled.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventVideoChatMuteNewParticipantsToggled.tdCommon.Extra)
+	b = append(b, `,"mute_new_participants":`...)
+	b = appendJSONBool(b, chatEventVideoChatMuteNewParticipantsToggled.MuteNewParticipants)
+	return append(b, '}'), nil
+}
+
+func (chatEventVideoChatMuteNewParticipantsToggled *ChatEventVideoChatMuteNewParticipantsToggled) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventVideoChatMuteNewParticipantsToggled.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventVideoChatMuteNewParticipantsToggled.tdCommon.Extra, err = r.readString()
+		case "mute_new_participants":
+			chatEventVideoChatMuteNewParticipantsToggled.MuteNewParticipants, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventVideoChatMuteNewParticipantsToggled *ChatEventVideoChatMuteNewParticipantsToggled) Clone() *ChatEventVideoChatMuteNewParticipantsToggled {
+	if chatEventVideoChatMuteNewParticipantsToggled == nil {
+		return nil
+	}
+	clone := *chatEventVideoChatMuteNewParticipantsToggled
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventVideoChatMuteNewParticipantsToggled *ChatEventVideoChatMuteNewParticipantsToggled) Equal(other *ChatEventVideoChatMuteNewParticipantsToggled) bool {
+	if chatEventVideoChatMuteNewParticipantsToggled == nil || other == nil {
+		return chatEventVideoChatMuteNewParticipantsToggled == other
+	}
+	if chatEventVideoChatMuteNewParticipantsToggled.MuteNewParticipants != other.MuteNewParticipants {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventVideoChatMuteNewParticipantsToggled *ChatEventVideoChatMuteNewParticipantsToggled) Diff(other *ChatEventVideoChatMuteNewParticipantsToggled) []Change {
+	return chatEventVideoChatMuteNewParticipantsToggled.diff("", other, nil)
+}
+
+func (chatEventVideoChatMuteNewParticipantsToggled *ChatEventVideoChatMuteNewParticipantsToggled) diff(path string, other *ChatEventVideoChatMuteNewParticipantsToggled, changes []Change) []Change {
+	if chatEventVideoChatMuteNewParticipantsToggled == nil || other == nil {
+		if chatEventVideoChatMuteNewParticipantsToggled != other {
+			changes = append(changes, Change{Path: path, Old: chatEventVideoChatMuteNewParticipantsToggled, New: other})
+		}
+		return changes
+	}
+	if chatEventVideoChatMuteNewParticipantsToggled.MuteNewParticipants != other.MuteNewParticipants {
+		changes = append(changes, Change{Path: fieldPath(path, "mute_new_participants"), Old: chatEventVideoChatMuteNewParticipantsToggled.MuteNewParticipants, New: other.MuteNewParticipants})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventVideoChatMuteNewParticipantsToggled *ChatEventVideoChatMuteNewParticipantsToggled) String() string {
+	return objectString(chatEventVideoChatMuteNewParticipantsToggled)
+}
+
+// LogValue logs the object as its summary
+func (chatEventVideoChatMuteNewParticipantsToggled *ChatEventVideoChatMuteNewParticipantsToggled) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventVideoChatMuteNewParticipantsToggled))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventVideoChatMuteNewParticipantsToggled *ChatEventVideoChatMuteNewParticipantsToggled) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventVideoChatMuteNewParticipantsToggled)
+}
+
+func (chatEventVideoChatMuteNewParticipantsToggled *ChatEventVideoChatMuteNewParticipantsToggled) printText(p *printer) {
+	if chatEventVideoChatMuteNewParticipantsToggled == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventVideoChatMuteNewParticipantsToggled")
+	p.bool("mute_new_participants", chatEventVideoChatMuteNewParticipantsToggled.MuteNewParticipants)
+	p.endObject(state)
+}
+
 // GetChatEventActionEnum return the enum type of this object
 func (chatEventVideoChatMuteNewParticipantsToggled *ChatEventVideoChatMuteNewParticipantsToggled) GetChatEventActionEnum() ChatEventActionEnum {
 	return ChatEventVideoChatMuteNewParticipantsToggledType
 }
+
+// Accept calls the method of the visitor for ChatEventVideoChatMuteNewParticipantsToggled
+func (chatEventVideoChatMuteNewParticipantsToggled *ChatEventVideoChatMuteNewParticipantsToggled) Accept(visitor ChatEventActionVisitor) {
+	visitor.VisitChatEventVideoChatMuteNewParticipantsToggled(chatEventVideoChatMuteNewParticipantsToggled)
+}
+
+// ChatEventActionVisitor visits every type of ChatEventAction, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to ChatEventAction, until they handle it too.
+type ChatEventActionVisitor interface {
+	VisitChatEventMessageEdited(chatEventMessageEdited *ChatEventMessageEdited)
+	VisitChatEventMessageDeleted(chatEventMessageDeleted *ChatEventMessageDeleted)
+	VisitChatEventPollStopped(chatEventPollStopped *ChatEventPollStopped)
+	VisitChatEventMessagePinned(chatEventMessagePinned *ChatEventMessagePinned)
+	VisitChatEventMessageUnpinned(chatEventMessageUnpinned *ChatEventMessageUnpinned)
+	VisitChatEventMemberJoined(chatEventMemberJoined *ChatEventMemberJoined)
+	VisitChatEventMemberJoinedByInviteLink(chatEventMemberJoinedByInviteLink *ChatEventMemberJoinedByInviteLink)
+	VisitChatEventMemberJoinedByRequest(chatEventMemberJoinedByRequest *ChatEventMemberJoinedByRequest)
+	VisitChatEventMemberLeft(chatEventMemberLeft *ChatEventMemberLeft)
+	VisitChatEventMemberInvited(chatEventMemberInvited *ChatEventMemberInvited)
+	VisitChatEventMemberPromoted(chatEventMemberPromoted *ChatEventMemberPromoted)
+	VisitChatEventMemberRestricted(chatEventMemberRestricted *ChatEventMemberRestricted)
+	VisitChatEventTitleChanged(chatEventTitleChanged *ChatEventTitleChanged)
+	VisitChatEventPermissionsChanged(chatEventPermissionsChanged *ChatEventPermissionsChanged)
+	VisitChatEventDescriptionChanged(chatEventDescriptionChanged *ChatEventDescriptionChanged)
+	VisitChatEventUsernameChanged(chatEventUsernameChanged *ChatEventUsernameChanged)
+	VisitChatEventPhotoChanged(chatEventPhotoChanged *ChatEventPhotoChanged)
+	VisitChatEventInvitesToggled(chatEventInvitesToggled *ChatEventInvitesToggled)
+	VisitChatEventLinkedChatChanged(chatEventLinkedChatChanged *ChatEventLinkedChatChanged)
+	VisitChatEventSlowModeDelayChanged(chatEventSlowModeDelayChanged *ChatEventSlowModeDelayChanged)
+	VisitChatEventMessageTtlChanged(chatEventMessageTtlChanged *ChatEventMessageTtlChanged)
+	VisitChatEventSignMessagesToggled(chatEventSignMessagesToggled *ChatEventSignMessagesToggled)
+	VisitChatEventHasProtectedContentToggled(chatEventHasProtectedContentToggled *ChatEventHasProtectedContentToggled)
+	VisitChatEventStickerSetChanged(chatEventStickerSetChanged *ChatEventStickerSetChanged)
+	VisitChatEventLocationChanged(chatEventLocationChanged *ChatEventLocationChanged)
+	VisitChatEventIsAllHistoryAvailableToggled(chatEventIsAllHistoryAvailableToggled *ChatEventIsAllHistoryAvailableToggled)
+	VisitChatEventInviteLinkEdited(chatEventInviteLinkEdited *ChatEventInviteLinkEdited)
+	VisitChatEventInviteLinkRevoked(chatEventInviteLinkRevoked *ChatEventInviteLinkRevoked)
+	VisitChatEventInviteLinkDeleted(chatEventInviteLinkDeleted *ChatEventInviteLinkDeleted)
+	VisitChatEventVideoChatCreated(chatEventVideoChatCreated *ChatEventVideoChatCreated)
+	VisitChatEventVideoChatEnded(chatEventVideoChatEnded *ChatEventVideoChatEnded)
+	VisitChatEventVideoChatParticipantIsMutedToggled(chatEventVideoChatParticipantIsMutedToggled *ChatEventVideoChatParticipantIsMutedToggled)
+	VisitChatEventVideoChatParticipantVolumeLevelChanged(chatEventVideoChatParticipantVolumeLevelChanged *ChatEventVideoChatParticipantVolumeLevelChanged)
+	VisitChatEventVideoChatMuteNewParticipantsToggled(chatEventVideoChatMuteNewParticipantsToggled *ChatEventVideoChatMuteNewParticipantsToggled)
+	VisitUnknownChatEventAction(unknownChatEventAction *UnknownChatEventAction)
+}
+
+// ChatEventActionCases holds the function MatchChatEventAction calls for each type of ChatEventAction;
+// types without a function are passed to the default case
+type ChatEventActionCases[R any] struct {
+	ChatEventMessageEdited                          func(chatEventMessageEdited *ChatEventMessageEdited) R
+	ChatEventMessageDeleted                         func(chatEventMessageDeleted *ChatEventMessageDeleted) R
+	ChatEventPollStopped                            func(chatEventPollStopped *ChatEventPollStopped) R
+	ChatEventMessagePinned                          func(chatEventMessagePinned *ChatEventMessagePinned) R
+	ChatEventMessageUnpinned                        func(chatEventMessageUnpinned *ChatEventMessageUnpinned) R
+	ChatEventMemberJoined                           func(chatEventMemberJoined *ChatEventMemberJoined) R
+	ChatEventMemberJoinedByInviteLink               func(chatEventMemberJoinedByInviteLink *ChatEventMemberJoinedByInviteLink) R
+	ChatEventMemberJoinedByRequest                  func(chatEventMemberJoinedByRequest *ChatEventMemberJoinedByRequest) R
+	ChatEventMemberLeft                             func(chatEventMemberLeft *ChatEventMemberLeft) R
+	ChatEventMemberInvited                          func(chatEventMemberInvited *ChatEventMemberInvited) R
+	ChatEventMemberPromoted                         func(chatEventMemberPromoted *ChatEventMemberPromoted) R
+	ChatEventMemberRestricted                       func(chatEventMemberRestricted *ChatEventMemberRestricted) R
+	ChatEventTitleChanged                           func(chatEventTitleChanged *ChatEventTitleChanged) R
+	ChatEventPermissionsChanged                     func(chatEventPermissionsChanged *ChatEventPermissionsChanged) R
+	ChatEventDescriptionChanged                     func(chatEventDescriptionChanged *ChatEventDescriptionChanged) R
+	ChatEventUsernameChanged                        func(chatEventUsernameChanged *ChatEventUsernameChanged) R
+	ChatEventPhotoChanged                           func(chatEventPhotoChanged *ChatEventPhotoChanged) R
+	ChatEventInvitesToggled                         func(chatEventInvitesToggled *ChatEventInvitesToggled) R
+	ChatEventLinkedChatChanged                      func(chatEventLinkedChatChanged *ChatEventLinkedChatChanged) R
+	ChatEventSlowModeDelayChanged                   func(chatEventSlowModeDelayChanged *ChatEventSlowModeDelayChanged) R
+	ChatEventMessageTtlChanged                      func(chatEventMessageTtlChanged *ChatEventMessageTtlChanged) R
+	ChatEventSignMessagesToggled                    func(chatEventSignMessagesToggled *ChatEventSignMessagesToggled) R
+	ChatEventHasProtectedContentToggled             func(chatEventHasProtectedContentToggled *ChatEventHasProtectedContentToggled) R
+	ChatEventStickerSetChanged                      func(chatEventStickerSetChanged *ChatEventStickerSetChanged) R
+	ChatEventLocationChanged                        func(chatEventLocationChanged *ChatEventLocationChanged) R
+	ChatEventIsAllHistoryAvailableToggled           func(chatEventIsAllHistoryAvailableToggled *ChatEventIsAllHistoryAvailableToggled) R
+	ChatEventInviteLinkEdited                       func(chatEventInviteLinkEdited *ChatEventInviteLinkEdited) R
+	ChatEventInviteLinkRevoked                      func(chatEventInviteLinkRevoked *ChatEventInviteLinkRevoked) R
+	ChatEventInviteLinkDeleted                      func(chatEventInviteLinkDeleted *ChatEventInviteLinkDeleted) R
+	ChatEventVideoChatCreated                       func(chatEventVideoChatCreated *ChatEventVideoChatCreated) R
+	ChatEventVideoChatEnded                         func(chatEventVideoChatEnded *ChatEventVideoChatEnded) R
+	ChatEventVideoChatParticipantIsMutedToggled     func(chatEventVideoChatParticipantIsMutedToggled *ChatEventVideoChatParticipantIsMutedToggled) R
+	ChatEventVideoChatParticipantVolumeLevelChanged func(chatEventVideoChatParticipantVolumeLevelChanged *ChatEventVideoChatParticipantVolumeLevelChanged) R
+	ChatEventVideoChatMuteNewParticipantsToggled    func(chatEventVideoChatMuteNewParticipantsToggled *ChatEventVideoChatMuteNewParticipantsToggled) R
+}
+
+// MatchChatEventAction calls the case of the type of chatEventAction, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement ChatEventActionVisitor
+// to have the compiler check every type is handled.
+func MatchChatEventAction[R any](chatEventAction ChatEventAction, cases ChatEventActionCases[R], defaultCase func(chatEventAction ChatEventAction) R) R {
+	switch value := chatEventAction.(type) {
+	case *ChatEventMessageEdited:
+		if cases.ChatEventMessageEdited != nil {
+			return cases.ChatEventMessageEdited(value)
+		}
+	case *ChatEventMessageDeleted:
+		if cases.ChatEventMessageDeleted != nil {
+			return cases.ChatEventMessageDeleted(value)
+		}
+	case *ChatEventPollStopped:
+		if cases.ChatEventPollStopped != nil {
+			return cases.ChatEventPollStopped(value)
+		}
+	case *ChatEventMessagePinned:
+		if cases.ChatEventMessagePinned != nil {
+			return cases.ChatEventMessagePinned(value)
+		}
+	case *ChatEventMessageUnpinned:
+		if cases.ChatEventMessageUnpinned != nil {
+			return cases.ChatEventMessageUnpinned(value)
+		}
+	case *ChatEventMemberJoined:
+		if cases.ChatEventMemberJoined != nil {
+			return cases.ChatEventMemberJoined(value)
+		}
+	case *ChatEventMemberJoinedByInviteLink:
+		if cases.ChatEventMemberJoinedByInviteLink != nil {
+			return cases.ChatEventMemberJoinedByInviteLink(value)
+		}
+	case *ChatEventMemberJoinedByRequest:
+		if cases.ChatEventMemberJoinedByRequest != nil {
+			return cases.ChatEventMemberJoinedByRequest(value)
+		}
+	case *ChatEventMemberLeft:
+		if cases.ChatEventMemberLeft != nil {
+			return cases.ChatEventMemberLeft(value)
+		}
+	case *ChatEventMemberInvited:
+		if cases.ChatEventMemberInvited != nil {
+			return cases.ChatEventMemberInvited(value)
+		}
+	case *ChatEventMemberPromoted:
+		if cases.ChatEventMemberPromoted != nil {
+			return cases.ChatEventMemberPromoted(value)
+		}
+	case *ChatEventMemberRestricted:
+		if cases.ChatEventMemberRestricted != nil {
+			return cases.ChatEventMemberRestricted(value)
+		}
+	case *ChatEventTitleChanged:
+		if cases.ChatEventTitleChanged != nil {
+			return cases.ChatEventTitleChanged(value)
+		}
+	case *ChatEventPermissionsChanged:
+		if cases.ChatEventPermissionsChanged != nil {
+			return cases.ChatEventPermissionsChanged(value)
+		}
+	case *ChatEventDescriptionChanged:
+		if cases.ChatEventDescriptionChanged != nil {
+			return cases.ChatEventDescriptionChanged(value)
+		}
+	case *ChatEventUsernameChanged:
+		if cases.ChatEventUsernameChanged != nil {
+			return cases.ChatEventUsernameChanged(value)
+		}
+	case *ChatEventPhotoChanged:
+		if cases.ChatEventPhotoChanged != nil {
+			return cases.ChatEventPhotoChanged(value)
+		}
+	case *ChatEventInvitesToggled:
+		if cases.ChatEventInvitesToggled != nil {
+			return cases.ChatEventInvitesToggled(value)
+		}
+	case *ChatEventLinkedChatChanged:
+		if cases.ChatEventLinkedChatChanged != nil {
+			return cases.ChatEventLinkedChatChanged(value)
+		}
+	case *ChatEventSlowModeDelayChanged:
+		if cases.ChatEventSlowModeDelayChanged != nil {
+			return cases.ChatEventSlowModeDelayChanged(value)
+		}
+	case *ChatEventMessageTtlChanged:
+		if cases.ChatEventMessageTtlChanged != nil {
+			return cases.ChatEventMessageTtlChanged(value)
+		}
+	case *ChatEventSignMessagesToggled:
+		if cases.ChatEventSignMessagesToggled != nil {
+			return cases.ChatEventSignMessagesToggled(value)
+		}
+	case *ChatEventHasProtectedContentToggled:
+		if cases.ChatEventHasProtectedContentToggled != nil {
+			return cases.ChatEventHasProtectedContentToggled(value)
+		}
+	case *ChatEventStickerSetChanged:
+		if cases.ChatEventStickerSetChanged != nil {
+			return cases.ChatEventStickerSetChanged(value)
+		}
+	case *ChatEventLocationChanged:
+		if cases.ChatEventLocationChanged != nil {
+			return cases.ChatEventLocationChanged(value)
+		}
+	case *ChatEventIsAllHistoryAvailableToggled:
+		if cases.ChatEventIsAllHistoryAvailableToggled != nil {
+			return cases.ChatEventIsAllHistoryAvailableToggled(value)
+		}
+	case *ChatEventInviteLinkEdited:
+		if cases.ChatEventInviteLinkEdited != nil {
+			return cases.ChatEventInviteLinkEdited(value)
+		}
+	case *ChatEventInviteLinkRevoked:
+		if cases.ChatEventInviteLinkRevoked != nil {
+			return cases.ChatEventInviteLinkRevoked(value)
+		}
+	case *ChatEventInviteLinkDeleted:
+		if cases.ChatEventInviteLinkDeleted != nil {
+			return cases.ChatEventInviteLinkDeleted(value)
+		}
+	case *ChatEventVideoChatCreated:
+		if cases.ChatEventVideoChatCreated != nil {
+			return cases.ChatEventVideoChatCreated(value)
+		}
+	case *ChatEventVideoChatEnded:
+		if cases.ChatEventVideoChatEnded != nil {
+			return cases.ChatEventVideoChatEnded(value)
+		}
+	case *ChatEventVideoChatParticipantIsMutedToggled:
+		if cases.ChatEventVideoChatParticipantIsMutedToggled != nil {
+			return cases.ChatEventVideoChatParticipantIsMutedToggled(value)
+		}
+	case *ChatEventVideoChatParticipantVolumeLevelChanged:
+		if cases.ChatEventVideoChatParticipantVolumeLevelChanged != nil {
+			return cases.ChatEventVideoChatParticipantVolumeLevelChanged(value)
+		}
+	case *ChatEventVideoChatMuteNewParticipantsToggled:
+		if cases.ChatEventVideoChatMuteNewParticipantsToggled != nil {
+			return cases.ChatEventVideoChatMuteNewParticipantsToggled(value)
+		}
+	}
+	return defaultCase(chatEventAction)
+}
+
+// Clone returns a deep copy of the object
+func (unknownChatEventAction *UnknownChatEventAction) Clone() *UnknownChatEventAction {
+	if unknownChatEventAction == nil {
+		return nil
+	}
+	return &UnknownChatEventAction{Unknown: unknownChatEventAction.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownChatEventAction *UnknownChatEventAction) Equal(other *UnknownChatEventAction) bool {
+	if unknownChatEventAction == nil || other == nil {
+		return unknownChatEventAction == other
+	}
+	return unknownChatEventAction.Unknown.equal(other.Unknown)
+}
+
+func cloneChatEventAction(chatEventAction ChatEventAction) ChatEventAction {
+	switch value := chatEventAction.(type) {
+	case *ChatEventMessageEdited:
+		return value.Clone()
+	case *ChatEventMessageDeleted:
+		return value.Clone()
+	case *ChatEventPollStopped:
+		return value.Clone()
+	case *ChatEventMessagePinned:
+		return value.Clone()
+	case *ChatEventMessageUnpinned:
+		return value.Clone()
+	case *ChatEventMemberJoined:
+		return value.Clone()
+	case *ChatEventMemberJoinedByInviteLink:
+		return value.Clone()
+	case *ChatEventMemberJoinedByRequest:
+		return value.Clone()
+	case *ChatEventMemberLeft:
+		return value.Clone()
+	case *ChatEventMemberInvited:
+		return value.Clone()
+	case *ChatEventMemberPromoted:
+		return value.Clone()
+	case *ChatEventMemberRestricted:
+		return value.Clone()
+	case *ChatEventTitleChanged:
+		return value.Clone()
+	case *ChatEventPermissionsChanged:
+		return value.Clone()
+	case *ChatEventDescriptionChanged:
+		return value.Clone()
+	case *ChatEventUsernameChanged:
+		return value.Clone()
+	case *ChatEventPhotoChanged:
+		return value.Clone()
+	case *ChatEventInvitesToggled:
+		return value.Clone()
+	case *ChatEventLinkedChatChanged:
+		return value.Clone()
+	case *ChatEventSlowModeDelayChanged:
+		return value.Clone()
+	case *ChatEventMessageTtlChanged:
+		return value.Clone()
+	case *ChatEventSignMessagesToggled:
+		return value.Clone()
+	case *ChatEventHasProtectedContentToggled:
+		return value.Clone()
+	case *ChatEventStickerSetChanged:
+		return value.Clone()
+	case *ChatEventLocationChanged:
+		return value.Clone()
+	case *ChatEventIsAllHistoryAvailableToggled:
+		return value.Clone()
+	case *ChatEventInviteLinkEdited:
+		return value.Clone()
+	case *ChatEventInviteLinkRevoked:
+		return value.Clone()
+	case *ChatEventInviteLinkDeleted:
+		return value.Clone()
+	case *ChatEventVideoChatCreated:
+		return value.Clone()
+	case *ChatEventVideoChatEnded:
+		return value.Clone()
+	case *ChatEventVideoChatParticipantIsMutedToggled:
+		return value.Clone()
+	case *ChatEventVideoChatParticipantVolumeLevelChanged:
+		return value.Clone()
+	case *ChatEventVideoChatMuteNewParticipantsToggled:
+		return value.Clone()
+	case *UnknownChatEventAction:
+		return value.Clone()
+	}
+	return chatEventAction
+}
+
+func equalChatEventAction(a ChatEventAction, b ChatEventAction) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *ChatEventMessageEdited:
+		b, ok := b.(*ChatEventMessageEdited)
+		return ok && a.Equal(b)
+	case *ChatEventMessageDeleted:
+		b, ok := b.(*ChatEventMessageDeleted)
+		return ok && a.Equal(b)
+	case *ChatEventPollStopped:
+		b, ok := b.(*ChatEventPollStopped)
+		return ok && a.Equal(b)
+	case *ChatEventMessagePinned:
+		b, ok := b.(*ChatEventMessagePinned)
+		return ok && a.Equal(b)
+	case *ChatEventMessageUnpinned:
+		b, ok := b.(*ChatEventMessageUnpinned)
+		return ok && a.Equal(b)
+	case *ChatEventMemberJoined:
+		b, ok := b.(*ChatEventMemberJoined)
+		return ok && a.Equal(b)
+	case *ChatEventMemberJoinedByInviteLink:
+		b, ok := b.(*ChatEventMemberJoinedByInviteLink)
+		return ok && a.Equal(b)
+	case *ChatEventMemberJoinedByRequest:
+		b, ok := b.(*ChatEventMemberJoinedByRequest)
+		return ok && a.Equal(b)
+	case *ChatEventMemberLeft:
+		b, ok := b.(*ChatEventMemberLeft)
+		return ok && a.Equal(b)
+	case *ChatEventMemberInvited:
+		b, ok := b.(*ChatEventMemberInvited)
+		return ok && a.Equal(b)
+	case *ChatEventMemberPromoted:
+		b, ok := b.(*ChatEventMemberPromoted)
+		return ok && a.Equal(b)
+	case *ChatEventMemberRestricted:
+		b, ok := b.(*ChatEventMemberRestricted)
+		return ok && a.Equal(b)
+	case *ChatEventTitleChanged:
+		b, ok := b.(*ChatEventTitleChanged)
+		return ok && a.Equal(b)
+	case *ChatEventPermissionsChanged:
+		b, ok := b.(*ChatEventPermissionsChanged)
+		return ok && a.Equal(b)
+	case *ChatEventDescriptionChanged:
+		b, ok := b.(*ChatEventDescriptionChanged)
+		return ok && a.Equal(b)
+	case *ChatEventUsernameChanged:
+		b, ok := b.(*ChatEventUsernameChanged)
+		return ok && a.Equal(b)
+	case *ChatEventPhotoChanged:
+		b, ok := b.(*ChatEventPhotoChanged)
+		return ok && a.Equal(b)
+	case *ChatEventInvitesToggled:
+		b, ok := b.(*ChatEventInvitesToggled)
+		return ok && a.Equal(b)
+	case *ChatEventLinkedChatChanged:
+		b, ok := b.(*ChatEventLinkedChatChanged)
+		return ok && a.Equal(b)
+	case *ChatEventSlowModeDelayChanged:
+		b, ok := b.(*ChatEventSlowModeDelayChanged)
+		return ok && a.Equal(b)
+	case *ChatEventMessageTtlChanged:
+		b, ok := b.(*ChatEventMessageTtlChanged)
+		return ok && a.Equal(b)
+	case *ChatEventSignMessagesToggled:
+		b, ok := b.(*ChatEventSignMessagesToggled)
+		return ok && a.Equal(b)
+	case *ChatEventHasProtectedContentToggled:
+		b, ok := b.(*ChatEventHasProtectedContentToggled)
+		return ok && a.Equal(b)
+	case *ChatEventStickerSetChanged:
+		b, ok := b.(*ChatEventStickerSetChanged)
+		return ok && a.Equal(b)
+	case *ChatEventLocationChanged:
+		b, ok := b.(*ChatEventLocationChanged)
+		return ok && a.Equal(b)
+	case *ChatEventIsAllHistoryAvailableToggled:
+		b, ok := b.(*ChatEventIsAllHistoryAvailableToggled)
+		return ok && a.Equal(b)
+	case *ChatEventInviteLinkEdited:
+		b, ok := b.(*ChatEventInviteLinkEdited)
+		return ok && a.Equal(b)
+	case *ChatEventInviteLinkRevoked:
+		b, ok := b.(*ChatEventInviteLinkRevoked)
+		return ok && a.Equal(b)
+	case *ChatEventInviteLinkDeleted:
+		b, ok := b.(*ChatEventInviteLinkDeleted)
+		return ok && a.Equal(b)
+	case *ChatEventVideoChatCreated:
+		b, ok := b.(*ChatEventVideoChatCreated)
+		return ok && a.Equal(b)
+	case *ChatEventVideoChatEnded:
+		b, ok := b.(*ChatEventVideoChatEnded)
+		return ok && a.Equal(b)
+	case *ChatEventVideoChatParticipantIsMutedToggled:
+		b, ok := b.(*ChatEventVideoChatParticipantIsMutedToggled)
+		return ok && a.Equal(b)
+	case *ChatEventVideoChatParticipantVolumeLevelChanged:
+		b, ok := b.(*ChatEventVideoChatParticipantVolumeLevelChanged)
+		return ok && a.Equal(b)
+	case *ChatEventVideoChatMuteNewParticipantsToggled:
+		b, ok := b.(*ChatEventVideoChatMuteNewParticipantsToggled)
+		return ok && a.Equal(b)
+	case *UnknownChatEventAction:
+		b, ok := b.(*UnknownChatEventAction)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffChatEventAction(path string, a ChatEventAction, b ChatEventAction, changes []Change) []Change {
+	switch a := a.(type) {
+	case *ChatEventMessageEdited:
+		if b, ok := b.(*ChatEventMessageEdited); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventMessageDeleted:
+		if b, ok := b.(*ChatEventMessageDeleted); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventPollStopped:
+		if b, ok := b.(*ChatEventPollStopped); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventMessagePinned:
+		if b, ok := b.(*ChatEventMessagePinned); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventMessageUnpinned:
+		if b, ok := b.(*ChatEventMessageUnpinned); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventMemberJoined:
+		if b, ok := b.(*ChatEventMemberJoined); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventMemberJoinedByInviteLink:
+		if b, ok := b.(*ChatEventMemberJoinedByInviteLink); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventMemberJoinedByRequest:
+		if b, ok := b.(*ChatEventMemberJoinedByRequest); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventMemberLeft:
+		if b, ok := b.(*ChatEventMemberLeft); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventMemberInvited:
+		if b, ok := b.(*ChatEventMemberInvited); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventMemberPromoted:
+		if b, ok := b.(*ChatEventMemberPromoted); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventMemberRestricted:
+		if b, ok := b.(*ChatEventMemberRestricted); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventTitleChanged:
+		if b, ok := b.(*ChatEventTitleChanged); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventPermissionsChanged:
+		if b, ok := b.(*ChatEventPermissionsChanged); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventDescriptionChanged:
+		if b, ok := b.(*ChatEventDescriptionChanged); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventUsernameChanged:
+		if b, ok := b.(*ChatEventUsernameChanged); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventPhotoChanged:
+		if b, ok := b.(*ChatEventPhotoChanged); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventInvitesToggled:
+		if b, ok := b.(*ChatEventInvitesToggled); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventLinkedChatChanged:
+		if b, ok := b.(*ChatEventLinkedChatChanged); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventSlowModeDelayChanged:
+		if b, ok := b.(*ChatEventSlowModeDelayChanged); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventMessageTtlChanged:
+		if b, ok := b.(*ChatEventMessageTtlChanged); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventSignMessagesToggled:
+		if b, ok := b.(*ChatEventSignMessagesToggled); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventHasProtectedContentToggled:
+		if b, ok := b.(*ChatEventHasProtectedContentToggled); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventStickerSetChanged:
+		if b, ok := b.(*ChatEventStickerSetChanged); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventLocationChanged:
+		if b, ok := b.(*ChatEventLocationChanged); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventIsAllHistoryAvailableToggled:
+		if b, ok := b.(*ChatEventIsAllHistoryAvailableToggled); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventInviteLinkEdited:
+		if b, ok := b.(*ChatEventInviteLinkEdited); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventInviteLinkRevoked:
+		if b, ok := b.(*ChatEventInviteLinkRevoked); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventInviteLinkDeleted:
+		if b, ok := b.(*ChatEventInviteLinkDeleted); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventVideoChatCreated:
+		if b, ok := b.(*ChatEventVideoChatCreated); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventVideoChatEnded:
+		if b, ok := b.(*ChatEventVideoChatEnded); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventVideoChatParticipantIsMutedToggled:
+		if b, ok := b.(*ChatEventVideoChatParticipantIsMutedToggled); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventVideoChatParticipantVolumeLevelChanged:
+		if b, ok := b.(*ChatEventVideoChatParticipantVolumeLevelChanged); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatEventVideoChatMuteNewParticipantsToggled:
+		if b, ok := b.(*ChatEventVideoChatMuteNewParticipantsToggled); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalChatEventAction(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // ChatEventLogFilters Represents a set of filters used to obtain a chat event log
 type ChatEventLogFilters struct {
 	tdCommon
@@ -55,3 +60,226 @@ func NewChatEventLogFilters(messageEdits bool, messageDeletions bool, messagePin
 
 	return &chatEventLogFiltersTemp
 }
+
+// MarshalJSON marshals to json
+func (chatEventLogFilters *ChatEventLogFilters) MarshalJSON() ([]byte, error) {
+	return chatEventLogFilters.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEventLogFilters *ChatEventLogFilters) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEventLogFilters)
+}
+
+func (chatEventLogFilters *ChatEventLogFilters) appendJSON(b []byte) ([]byte, error) {
+	if chatEventLogFilters == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEventLogFilters.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEventLogFilters.tdCommon.Extra)
+	b = append(b, `,"message_edits":`...)
+	b = appendJSONBool(b, chatEventLogFilters.MessageEdits)
+	b = append(b, `,"message_deletions":`...)
+	b = appendJSONBool(b, chatEventLogFilters.MessageDeletions)
+	b = append(b, `,"message_pins":`...)
+	b = appendJSONBool(b, chatEventLogFilters.MessagePins)
+	b = append(b, `,"member_joins":`...)
+	b = appendJSONBool(b, chatEventLogFilters.MemberJoins)
+	b = append(b, `,"member_leaves":`...)
+	b = appendJSONBool(b, chatEventLogFilters.MemberLeaves)
+	b = append(b, `,"member_invites":`...)
+	b = appendJSONBool(b, chatEventLogFilters.MemberInvites)
+	b = append(b, `,"member_promotions":`...)
+	b = appendJSONBool(b, chatEventLogFilters.MemberPromotions)
+	b = append(b, `,"member_restrictions":`...)
+	b = appendJSONBool(b, chatEventLogFilters.MemberRestrictions)
+	b = append(b, `,"info_changes":`...)
+	b = appendJSONBool(b, chatEventLogFilters.InfoChanges)
+	b = append(b, `,"setting_changes":`...)
+	b = appendJSONBool(b, chatEventLogFilters.SettingChanges)
+	b = append(b, `,"invite_link_changes":`...)
+	b = appendJSONBool(b, chatEventLogFilters.InviteLinkChanges)
+	b = append(b, `,"video_chat_changes":`...)
+	b = appendJSONBool(b, chatEventLogFilters.VideoChatChanges)
+	return append(b, '}'), nil
+}
+
+func (chatEventLogFilters *ChatEventLogFilters) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEventLogFilters.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEventLogFilters.tdCommon.Extra, err = r.readString()
+		case "message_edits":
+			chatEventLogFilters.MessageEdits, err = r.readBool()
+		case "message_deletions":
+			chatEventLogFilters.MessageDeletions, err = r.readBool()
+		case "message_pins":
+			chatEventLogFilters.MessagePins, err = r.readBool()
+		case "member_joins":
+			chatEventLogFilters.MemberJoins, err = r.readBool()
+		case "member_leaves":
+			chatEventLogFilters.MemberLeaves, err = r.readBool()
+		case "member_invites":
+			chatEventLogFilters.MemberInvites, err = r.readBool()
+		case "member_promotions":
+			chatEventLogFilters.MemberPromotions, err = r.readBool()
+		case "member_restrictions":
+			chatEventLogFilters.MemberRestrictions, err = r.readBool()
+		case "info_changes":
+			chatEventLogFilters.InfoChanges, err = r.readBool()
+		case "setting_changes":
+			chatEventLogFilters.SettingChanges, err = r.readBool()
+		case "invite_link_changes":
+			chatEventLogFilters.InviteLinkChanges, err = r.readBool()
+		case "video_chat_changes":
+			chatEventLogFilters.VideoChatChanges, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEventLogFilters *ChatEventLogFilters) Clone() *ChatEventLogFilters {
+	if chatEventLogFilters == nil {
+		return nil
+	}
+	clone := *chatEventLogFilters
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEventLogFilters *ChatEventLogFilters) Equal(other *ChatEventLogFilters) bool {
+	if chatEventLogFilters == nil || other == nil {
+		return chatEventLogFilters == other
+	}
+	if chatEventLogFilters.MessageEdits != other.MessageEdits {
+		return false
+	}
+	if chatEventLogFilters.MessageDeletions != other.MessageDeletions {
+		return false
+	}
+	if chatEventLogFilters.MessagePins != other.MessagePins {
+		return false
+	}
+	if chatEventLogFilters.MemberJoins != other.MemberJoins {
+		return false
+	}
+	if chatEventLogFilters.MemberLeaves != other.MemberLeaves {
+		return false
+	}
+	if chatEventLogFilters.MemberInvites != other.MemberInvites {
+		return false
+	}
+	if chatEventLogFilters.MemberPromotions != other.MemberPromotions {
+		return false
+	}
+	if chatEventLogFilters.MemberRestrictions != other.MemberRestrictions {
+		return false
+	}
+	if chatEventLogFilters.InfoChanges != other.InfoChanges {
+		return false
+	}
+	if chatEventLogFilters.SettingChanges != other.SettingChanges {
+		return false
+	}
+	if chatEventLogFilters.InviteLinkChanges != other.InviteLinkChanges {
+		return false
+	}
+	if chatEventLogFilters.VideoChatChanges != other.VideoChatChanges {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEventLogFilters *ChatEventLogFilters) Diff(other *ChatEventLogFilters) []Change {
+	return chatEventLogFilters.diff("", other, nil)
+}
+
+func (chatEventLogFilters *ChatEventLogFilters) diff(path string, other *ChatEventLogFilters, changes []Change) []Change {
+	if chatEventLogFilters == nil || other == nil {
+		if chatEventLogFilters != other {
+			changes = append(changes, Change{Path: path, Old: chatEventLogFilters, New: other})
+		}
+		return changes
+	}
+	if chatEventLogFilters.MessageEdits != other.MessageEdits {
+		changes = append(changes, Change{Path: fieldPath(path, "message_edits"), Old: chatEventLogFilters.MessageEdits, New: other.MessageEdits})
+	}
+	if chatEventLogFilters.MessageDeletions != other.MessageDeletions {
+		changes = append(changes, Change{Path: fieldPath(path, "message_deletions"), Old: chatEventLogFilters.MessageDeletions, New: other.MessageDeletions})
+	}
+	if chatEventLogFilters.MessagePins != other.MessagePins {
+		changes = append(changes, Change{Path: fieldPath(path, "message_pins"), Old: chatEventLogFilters.MessagePins, New: other.MessagePins})
+	}
+	if chatEventLogFilters.MemberJoins != other.MemberJoins {
+		changes = append(changes, Change{Path: fieldPath(path, "member_joins"), Old: chatEventLogFilters.MemberJoins, New: other.MemberJoins})
+	}
+	if chatEventLogFilters.MemberLeaves != other.MemberLeaves {
+		changes = append(changes, Change{Path: fieldPath(path, "member_leaves"), Old: chatEventLogFilters.MemberLeaves, New: other.MemberLeaves})
+	}
+	if chatEventLogFilters.MemberInvites != other.MemberInvites {
+		changes = append(changes, Change{Path: fieldPath(path, "member_invites"), Old: chatEventLogFilters.MemberInvites, New: other.MemberInvites})
+	}
+	if chatEventLogFilters.MemberPromotions != other.MemberPromotions {
+		changes = append(changes, Change{Path: fieldPath(path, "member_promotions"), Old: chatEventLogFilters.MemberPromotions, New: other.MemberPromotions})
+	}
+	if chatEventLogFilters.MemberRestrictions != other.MemberRestrictions {
+		changes = append(changes, Change{Path: fieldPath(path, "member_restrictions"), Old: chatEventLogFilters.MemberRestrictions, New: other.MemberRestrictions})
+	}
+	if chatEventLogFilters.InfoChanges != other.InfoChanges {
+		changes = append(changes, Change{Path: fieldPath(path, "info_changes"), Old: chatEventLogFilters.InfoChanges, New: other.InfoChanges})
+	}
+	if chatEventLogFilters.SettingChanges != other.SettingChanges {
+		changes = append(changes, Change{Path: fieldPath(path, "setting_changes"), Old: chatEventLogFilters.SettingChanges, New: other.SettingChanges})
+	}
+	if chatEventLogFilters.InviteLinkChanges != other.InviteLinkChanges {
+		changes = append(changes, Change{Path: fieldPath(path, "invite_link_changes"), Old: chatEventLogFilters.InviteLinkChanges, New: other.InviteLinkChanges})
+	}
+	if chatEventLogFilters.VideoChatChanges != other.VideoChatChanges {
+		changes = append(changes, Change{Path: fieldPath(path, "video_chat_changes"), Old: chatEventLogFilters.VideoChatChanges, New: other.VideoChatChanges})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEventLogFilters *ChatEventLogFilters) String() string {
+	return objectString(chatEventLogFilters)
+}
+
+// LogValue logs the object as its summary
+func (chatEventLogFilters *ChatEventLogFilters) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEventLogFilters))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEventLogFilters *ChatEventLogFilters) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEventLogFilters)
+}
+
+func (chatEventLogFilters *ChatEventLogFilters) printText(p *printer) {
+	if chatEventLogFilters == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEventLogFilters")
+	p.bool("message_edits", chatEventLogFilters.MessageEdits)
+	p.bool("message_deletions", chatEventLogFilters.MessageDeletions)
+	p.bool("message_pins", chatEventLogFilters.MessagePins)
+	p.bool("member_joins", chatEventLogFilters.MemberJoins)
+	p.bool("member_leaves", chatEventLogFilters.MemberLeaves)
+	p.bool("member_invites", chatEventLogFilters.MemberInvites)
+	p.bool("member_promotions", chatEventLogFilters.MemberPromotions)
+	p.bool("member_restrictions", chatEventLogFilters.MemberRestrictions)
+	p.bool("info_changes", chatEventLogFilters.InfoChanges)
+	p.bool("setting_changes", chatEventLogFilters.SettingChanges)
+	p.bool("invite_link_changes", chatEventLogFilters.InviteLinkChanges)
+	p.bool("video_chat_changes", chatEventLogFilters.VideoChatChanges)
+	p.endObject(state)
+}
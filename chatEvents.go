@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ChatEvents Contains a list of chat events
@@ -28,6 +28,150 @@ func NewChatEvents(events []ChatEvent) *ChatEvents {
 	return &chatEventsTemp
 }
 
+// MarshalJSON marshals to json
+func (chatEvents *ChatEvents) MarshalJSON() ([]byte, error) {
+	return chatEvents.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatEvents *ChatEvents) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatEvents)
+}
+
+func (chatEvents *ChatEvents) appendJSON(b []byte) ([]byte, error) {
+	if chatEvents == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatEvents.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatEvents.tdCommon.Extra)
+	b = append(b, `,"events":`...)
+	if chatEvents.Events == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range chatEvents.Events {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = chatEvents.Events[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (chatEvents *ChatEvents) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatEvents.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatEvents.tdCommon.Extra, err = r.readString()
+		case "events":
+			if r.readNull() {
+				chatEvents.Events = nil
+			} else {
+				chatEvents.Events = make([]ChatEvent, 0)
+				err = r.readArray(func() (err error) {
+					var item0 ChatEvent
+					err = item0.decodeJSON(r)
+					chatEvents.Events = append(chatEvents.Events, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatEvents *ChatEvents) Clone() *ChatEvents {
+	if chatEvents == nil {
+		return nil
+	}
+	clone := *chatEvents
+	if chatEvents.Events != nil {
+		clone.Events = make([]ChatEvent, len(chatEvents.Events))
+		for i0 := range chatEvents.Events {
+			clone.Events[i0] = *chatEvents.Events[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatEvents *ChatEvents) Equal(other *ChatEvents) bool {
+	if chatEvents == nil || other == nil {
+		return chatEvents == other
+	}
+	if len(chatEvents.Events) != len(other.Events) {
+		return false
+	}
+	for i0 := range chatEvents.Events {
+		if !chatEvents.Events[i0].Equal(&other.Events[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatEvents *ChatEvents) Diff(other *ChatEvents) []Change {
+	return chatEvents.diff("", other, nil)
+}
+
+func (chatEvents *ChatEvents) diff(path string, other *ChatEvents, changes []Change) []Change {
+	if chatEvents == nil || other == nil {
+		if chatEvents != other {
+			changes = append(changes, Change{Path: path, Old: chatEvents, New: other})
+		}
+		return changes
+	}
+	if len(chatEvents.Events) != len(other.Events) {
+		changes = append(changes, Change{Path: fieldPath(path, "events"), Old: chatEvents.Events, New: other.Events})
+	} else {
+		for i0 := range chatEvents.Events {
+			changes = chatEvents.Events[i0].diff(indexPath(fieldPath(path, "events"), i0), &other.Events[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatEvents *ChatEvents) String() string {
+	return objectString(chatEvents)
+}
+
+// LogValue logs the object as its summary
+func (chatEvents *ChatEvents) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatEvents))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatEvents *ChatEvents) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatEvents)
+}
+
+func (chatEvents *ChatEvents) printText(p *printer) {
+	if chatEvents == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatEvents")
+	p.vector("events", len(chatEvents.Events), func(i0 int) {
+		chatEvents.Events[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetChatEventLog Returns a list of service actions taken by chat members and administrators in the last 48 hours. Available only for supergroups and channels. Requires administrator rights. Returns results in reverse chronological order (i. e., in order of decreasing event_id)
 // @param chatId Chat identifier
 // @param query Search query by which to filter events
@@ -50,11 +194,11 @@ func (client *Client) GetChatEventLog(chatId int64, query string, fromEventId JS
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chatEvents ChatEvents
-	err = json.Unmarshal(result.Raw, &chatEvents)
+	err = jsonUnmarshal(result.Raw, &chatEvents)
 	return &chatEvents, err
 }
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ChatFilter Represents a filter of user chats
@@ -64,6 +64,354 @@ func NewChatFilter(title string, iconName string, pinnedChatIds []int64, include
 	return &chatFilterTemp
 }
 
+// MarshalJSON marshals to json
+func (chatFilter *ChatFilter) MarshalJSON() ([]byte, error) {
+	return chatFilter.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatFilter *ChatFilter) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatFilter)
+}
+
+func (chatFilter *ChatFilter) appendJSON(b []byte) ([]byte, error) {
+	if chatFilter == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatFilter.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatFilter.tdCommon.Extra)
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, chatFilter.Title)
+	b = append(b, `,"icon_name":`...)
+	b = appendJSONString(b, chatFilter.IconName)
+	b = append(b, `,"pinned_chat_ids":`...)
+	if chatFilter.PinnedChatIds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range chatFilter.PinnedChatIds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(chatFilter.PinnedChatIds[i0]))
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"included_chat_ids":`...)
+	if chatFilter.IncludedChatIds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range chatFilter.IncludedChatIds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(chatFilter.IncludedChatIds[i0]))
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"excluded_chat_ids":`...)
+	if chatFilter.ExcludedChatIds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range chatFilter.ExcludedChatIds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(chatFilter.ExcludedChatIds[i0]))
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"exclude_muted":`...)
+	b = appendJSONBool(b, chatFilter.ExcludeMuted)
+	b = append(b, `,"exclude_read":`...)
+	b = appendJSONBool(b, chatFilter.ExcludeRead)
+	b = append(b, `,"exclude_archived":`...)
+	b = appendJSONBool(b, chatFilter.ExcludeArchived)
+	b = append(b, `,"include_contacts":`...)
+	b = appendJSONBool(b, chatFilter.IncludeContacts)
+	b = append(b, `,"include_non_contacts":`...)
+	b = appendJSONBool(b, chatFilter.IncludeNonContacts)
+	b = append(b, `,"include_bots":`...)
+	b = appendJSONBool(b, chatFilter.IncludeBots)
+	b = append(b, `,"include_groups":`...)
+	b = appendJSONBool(b, chatFilter.IncludeGroups)
+	b = append(b, `,"include_channels":`...)
+	b = appendJSONBool(b, chatFilter.IncludeChannels)
+	return append(b, '}'), nil
+}
+
+func (chatFilter *ChatFilter) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatFilter.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatFilter.tdCommon.Extra, err = r.readString()
+		case "title":
+			chatFilter.Title, err = r.readString()
+		case "icon_name":
+			chatFilter.IconName, err = r.readString()
+		case "pinned_chat_ids":
+			if r.readNull() {
+				chatFilter.PinnedChatIds = nil
+			} else {
+				chatFilter.PinnedChatIds = make([]int64, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int64
+					item0, err = r.readInt64()
+					chatFilter.PinnedChatIds = append(chatFilter.PinnedChatIds, item0)
+					return
+				})
+			}
+		case "included_chat_ids":
+			if r.readNull() {
+				chatFilter.IncludedChatIds = nil
+			} else {
+				chatFilter.IncludedChatIds = make([]int64, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int64
+					item0, err = r.readInt64()
+					chatFilter.IncludedChatIds = append(chatFilter.IncludedChatIds, item0)
+					return
+				})
+			}
+		case "excluded_chat_ids":
+			if r.readNull() {
+				chatFilter.ExcludedChatIds = nil
+			} else {
+				chatFilter.ExcludedChatIds = make([]int64, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int64
+					item0, err = r.readInt64()
+					chatFilter.ExcludedChatIds = append(chatFilter.ExcludedChatIds, item0)
+					return
+				})
+			}
+		case "exclude_muted":
+			chatFilter.ExcludeMuted, err = r.readBool()
+		case "exclude_read":
+			chatFilter.ExcludeRead, err = r.readBool()
+		case "exclude_archived":
+			chatFilter.ExcludeArchived, err = r.readBool()
+		case "include_contacts":
+			chatFilter.IncludeContacts, err = r.readBool()
+		case "include_non_contacts":
+			chatFilter.IncludeNonContacts, err = r.readBool()
+		case "include_bots":
+			chatFilter.IncludeBots, err = r.readBool()
+		case "include_groups":
+			chatFilter.IncludeGroups, err = r.readBool()
+		case "include_channels":
+			chatFilter.IncludeChannels, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatFilter *ChatFilter) Clone() *ChatFilter {
+	if chatFilter == nil {
+		return nil
+	}
+	clone := *chatFilter
+	if chatFilter.PinnedChatIds != nil {
+		clone.PinnedChatIds = make([]int64, len(chatFilter.PinnedChatIds))
+		copy(clone.PinnedChatIds, chatFilter.PinnedChatIds)
+	}
+	if chatFilter.IncludedChatIds != nil {
+		clone.IncludedChatIds = make([]int64, len(chatFilter.IncludedChatIds))
+		copy(clone.IncludedChatIds, chatFilter.IncludedChatIds)
+	}
+	if chatFilter.ExcludedChatIds != nil {
+		clone.ExcludedChatIds = make([]int64, len(chatFilter.ExcludedChatIds))
+		copy(clone.ExcludedChatIds, chatFilter.ExcludedChatIds)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatFilter *ChatFilter) Equal(other *ChatFilter) bool {
+	if chatFilter == nil || other == nil {
+		return chatFilter == other
+	}
+	if chatFilter.Title != other.Title {
+		return false
+	}
+	if chatFilter.IconName != other.IconName {
+		return false
+	}
+	if len(chatFilter.PinnedChatIds) != len(other.PinnedChatIds) {
+		return false
+	}
+	for i0 := range chatFilter.PinnedChatIds {
+		if chatFilter.PinnedChatIds[i0] != other.PinnedChatIds[i0] {
+			return false
+		}
+	}
+	if len(chatFilter.IncludedChatIds) != len(other.IncludedChatIds) {
+		return false
+	}
+	for i0 := range chatFilter.IncludedChatIds {
+		if chatFilter.IncludedChatIds[i0] != other.IncludedChatIds[i0] {
+			return false
+		}
+	}
+	if len(chatFilter.ExcludedChatIds) != len(other.ExcludedChatIds) {
+		return false
+	}
+	for i0 := range chatFilter.ExcludedChatIds {
+		if chatFilter.ExcludedChatIds[i0] != other.ExcludedChatIds[i0] {
+			return false
+		}
+	}
+	if chatFilter.ExcludeMuted != other.ExcludeMuted {
+		return false
+	}
+	if chatFilter.ExcludeRead != other.ExcludeRead {
+		return false
+	}
+	if chatFilter.ExcludeArchived != other.ExcludeArchived {
+		return false
+	}
+	if chatFilter.IncludeContacts != other.IncludeContacts {
+		return false
+	}
+	if chatFilter.IncludeNonContacts != other.IncludeNonContacts {
+		return false
+	}
+	if chatFilter.IncludeBots != other.IncludeBots {
+		return false
+	}
+	if chatFilter.IncludeGroups != other.IncludeGroups {
+		return false
+	}
+	if chatFilter.IncludeChannels != other.IncludeChannels {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatFilter *ChatFilter) Diff(other *ChatFilter) []Change {
+	return chatFilter.diff("", other, nil)
+}
+
+func (chatFilter *ChatFilter) diff(path string, other *ChatFilter, changes []Change) []Change {
+	if chatFilter == nil || other == nil {
+		if chatFilter != other {
+			changes = append(changes, Change{Path: path, Old: chatFilter, New: other})
+		}
+		return changes
+	}
+	if chatFilter.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: chatFilter.Title, New: other.Title})
+	}
+	if chatFilter.IconName != other.IconName {
+		changes = append(changes, Change{Path: fieldPath(path, "icon_name"), Old: chatFilter.IconName, New: other.IconName})
+	}
+	if len(chatFilter.PinnedChatIds) != len(other.PinnedChatIds) {
+		changes = append(changes, Change{Path: fieldPath(path, "pinned_chat_ids"), Old: chatFilter.PinnedChatIds, New: other.PinnedChatIds})
+	} else {
+		for i0 := range chatFilter.PinnedChatIds {
+			if chatFilter.PinnedChatIds[i0] != other.PinnedChatIds[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "pinned_chat_ids"), i0), Old: chatFilter.PinnedChatIds[i0], New: other.PinnedChatIds[i0]})
+			}
+		}
+	}
+	if len(chatFilter.IncludedChatIds) != len(other.IncludedChatIds) {
+		changes = append(changes, Change{Path: fieldPath(path, "included_chat_ids"), Old: chatFilter.IncludedChatIds, New: other.IncludedChatIds})
+	} else {
+		for i0 := range chatFilter.IncludedChatIds {
+			if chatFilter.IncludedChatIds[i0] != other.IncludedChatIds[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "included_chat_ids"), i0), Old: chatFilter.IncludedChatIds[i0], New: other.IncludedChatIds[i0]})
+			}
+		}
+	}
+	if len(chatFilter.ExcludedChatIds) != len(other.ExcludedChatIds) {
+		changes = append(changes, Change{Path: fieldPath(path, "excluded_chat_ids"), Old: chatFilter.ExcludedChatIds, New: other.ExcludedChatIds})
+	} else {
+		for i0 := range chatFilter.ExcludedChatIds {
+			if chatFilter.ExcludedChatIds[i0] != other.ExcludedChatIds[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "excluded_chat_ids"), i0), Old: chatFilter.ExcludedChatIds[i0], New: other.ExcludedChatIds[i0]})
+			}
+		}
+	}
+	if chatFilter.ExcludeMuted != other.ExcludeMuted {
+		changes = append(changes, Change{Path: fieldPath(path, "exclude_muted"), Old: chatFilter.ExcludeMuted, New: other.ExcludeMuted})
+	}
+	if chatFilter.ExcludeRead != other.ExcludeRead {
+		changes = append(changes, Change{Path: fieldPath(path, "exclude_read"), Old: chatFilter.ExcludeRead, New: other.ExcludeRead})
+	}
+	if chatFilter.ExcludeArchived != other.ExcludeArchived {
+		changes = append(changes, Change{Path: fieldPath(path, "exclude_archived"), Old: chatFilter.ExcludeArchived, New: other.ExcludeArchived})
+	}
+	if chatFilter.IncludeContacts != other.IncludeContacts {
+		changes = append(changes, Change{Path: fieldPath(path, "include_contacts"), Old: chatFilter.IncludeContacts, New: other.IncludeContacts})
+	}
+	if chatFilter.IncludeNonContacts != other.IncludeNonContacts {
+		changes = append(changes, Change{Path: fieldPath(path, "include_non_contacts"), Old: chatFilter.IncludeNonContacts, New: other.IncludeNonContacts})
+	}
+	if chatFilter.IncludeBots != other.IncludeBots {
+		changes = append(changes, Change{Path: fieldPath(path, "include_bots"), Old: chatFilter.IncludeBots, New: other.IncludeBots})
+	}
+	if chatFilter.IncludeGroups != other.IncludeGroups {
+		changes = append(changes, Change{Path: fieldPath(path, "include_groups"), Old: chatFilter.IncludeGroups, New: other.IncludeGroups})
+	}
+	if chatFilter.IncludeChannels != other.IncludeChannels {
+		changes = append(changes, Change{Path: fieldPath(path, "include_channels"), Old: chatFilter.IncludeChannels, New: other.IncludeChannels})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatFilter *ChatFilter) String() string {
+	return objectString(chatFilter)
+}
+
+// LogValue logs the object as its summary
+func (chatFilter *ChatFilter) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatFilter))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatFilter *ChatFilter) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatFilter)
+}
+
+func (chatFilter *ChatFilter) printText(p *printer) {
+	if chatFilter == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatFilter")
+	p.string("title", chatFilter.Title)
+	p.string("icon_name", chatFilter.IconName)
+	p.vector("pinned_chat_ids", len(chatFilter.PinnedChatIds), func(i0 int) {
+		p.int("pinned_chat_ids", int64(chatFilter.PinnedChatIds[i0]))
+	})
+	p.vector("included_chat_ids", len(chatFilter.IncludedChatIds), func(i0 int) {
+		p.int("included_chat_ids", int64(chatFilter.IncludedChatIds[i0]))
+	})
+	p.vector("excluded_chat_ids", len(chatFilter.ExcludedChatIds), func(i0 int) {
+		p.int("excluded_chat_ids", int64(chatFilter.ExcludedChatIds[i0]))
+	})
+	p.bool("exclude_muted", chatFilter.ExcludeMuted)
+	p.bool("exclude_read", chatFilter.ExcludeRead)
+	p.bool("exclude_archived", chatFilter.ExcludeArchived)
+	p.bool("include_contacts", chatFilter.IncludeContacts)
+	p.bool("include_non_contacts", chatFilter.IncludeNonContacts)
+	p.bool("include_bots", chatFilter.IncludeBots)
+	p.bool("include_groups", chatFilter.IncludeGroups)
+	p.bool("include_channels", chatFilter.IncludeChannels)
+	p.endObject(state)
+}
+
 // GetChatFilter Returns information about a chat filter by its identifier
 // @param chatFilterId Chat filter identifier
 func (client *Client) GetChatFilter(chatFilterId int32) (*ChatFilter, error) {
@@ -76,11 +424,11 @@ func (client *Client) GetChatFilter(chatFilterId int32) (*ChatFilter, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chatFilterDummy ChatFilter
-	err = json.Unmarshal(result.Raw, &chatFilterDummy)
+	err = jsonUnmarshal(result.Raw, &chatFilterDummy)
 	return &chatFilterDummy, err
 }
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ChatFilterInfo Contains basic information about a chat filter
@@ -34,6 +34,130 @@ func NewChatFilterInfo(id int32, title string, iconName string) *ChatFilterInfo
 	return &chatFilterInfoTemp
 }
 
+// MarshalJSON marshals to json
+func (chatFilterInfo *ChatFilterInfo) MarshalJSON() ([]byte, error) {
+	return chatFilterInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatFilterInfo *ChatFilterInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatFilterInfo)
+}
+
+func (chatFilterInfo *ChatFilterInfo) appendJSON(b []byte) ([]byte, error) {
+	if chatFilterInfo == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatFilterInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatFilterInfo.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt(b, int64(chatFilterInfo.Id))
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, chatFilterInfo.Title)
+	b = append(b, `,"icon_name":`...)
+	b = appendJSONString(b, chatFilterInfo.IconName)
+	return append(b, '}'), nil
+}
+
+func (chatFilterInfo *ChatFilterInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatFilterInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatFilterInfo.tdCommon.Extra, err = r.readString()
+		case "id":
+			chatFilterInfo.Id, err = r.readInt32()
+		case "title":
+			chatFilterInfo.Title, err = r.readString()
+		case "icon_name":
+			chatFilterInfo.IconName, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatFilterInfo *ChatFilterInfo) Clone() *ChatFilterInfo {
+	if chatFilterInfo == nil {
+		return nil
+	}
+	clone := *chatFilterInfo
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatFilterInfo *ChatFilterInfo) Equal(other *ChatFilterInfo) bool {
+	if chatFilterInfo == nil || other == nil {
+		return chatFilterInfo == other
+	}
+	if chatFilterInfo.Id != other.Id {
+		return false
+	}
+	if chatFilterInfo.Title != other.Title {
+		return false
+	}
+	if chatFilterInfo.IconName != other.IconName {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatFilterInfo *ChatFilterInfo) Diff(other *ChatFilterInfo) []Change {
+	return chatFilterInfo.diff("", other, nil)
+}
+
+func (chatFilterInfo *ChatFilterInfo) diff(path string, other *ChatFilterInfo, changes []Change) []Change {
+	if chatFilterInfo == nil || other == nil {
+		if chatFilterInfo != other {
+			changes = append(changes, Change{Path: path, Old: chatFilterInfo, New: other})
+		}
+		return changes
+	}
+	if chatFilterInfo.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: chatFilterInfo.Id, New: other.Id})
+	}
+	if chatFilterInfo.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: chatFilterInfo.Title, New: other.Title})
+	}
+	if chatFilterInfo.IconName != other.IconName {
+		changes = append(changes, Change{Path: fieldPath(path, "icon_name"), Old: chatFilterInfo.IconName, New: other.IconName})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatFilterInfo *ChatFilterInfo) String() string {
+	return objectString(chatFilterInfo)
+}
+
+// LogValue logs the object as its summary
+func (chatFilterInfo *ChatFilterInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatFilterInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatFilterInfo *ChatFilterInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatFilterInfo)
+}
+
+func (chatFilterInfo *ChatFilterInfo) printText(p *printer) {
+	if chatFilterInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatFilterInfo")
+	p.int("id", int64(chatFilterInfo.Id))
+	p.string("title", chatFilterInfo.Title)
+	p.string("icon_name", chatFilterInfo.IconName)
+	p.endObject(state)
+}
+
 // CreateChatFilter Creates new chat filter. Returns information about the created chat filter
 // @param filter Chat filter
 func (client *Client) CreateChatFilter(filter *ChatFilter) (*ChatFilterInfo, error) {
@@ -46,12 +170,12 @@ func (client *Client) CreateChatFilter(filter *ChatFilter) (*ChatFilterInfo, err
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chatFilterInfo ChatFilterInfo
-	err = json.Unmarshal(result.Raw, &chatFilterInfo)
+	err = jsonUnmarshal(result.Raw, &chatFilterInfo)
 	return &chatFilterInfo, err
 }
 
@@ -69,11 +193,11 @@ func (client *Client) EditChatFilter(chatFilterId int32, filter *ChatFilter) (*C
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chatFilterInfo ChatFilterInfo
-	err = json.Unmarshal(result.Raw, &chatFilterInfo)
+	err = jsonUnmarshal(result.Raw, &chatFilterInfo)
 	return &chatFilterInfo, err
 }
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ChatInviteLink Contains a chat invite link
@@ -61,6 +61,229 @@ func NewChatInviteLink(inviteLink string, name string, creatorUserId int64, date
 	return &chatInviteLinkTemp
 }
 
+// MarshalJSON marshals to json
+func (chatInviteLink *ChatInviteLink) MarshalJSON() ([]byte, error) {
+	return chatInviteLink.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatInviteLink *ChatInviteLink) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatInviteLink)
+}
+
+func (chatInviteLink *ChatInviteLink) appendJSON(b []byte) ([]byte, error) {
+	if chatInviteLink == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatInviteLink.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatInviteLink.tdCommon.Extra)
+	b = append(b, `,"invite_link":`...)
+	b = appendJSONString(b, chatInviteLink.InviteLink)
+	b = append(b, `,"name":`...)
+	b = appendJSONString(b, chatInviteLink.Name)
+	b = append(b, `,"creator_user_id":`...)
+	b = appendJSONInt(b, int64(chatInviteLink.CreatorUserId))
+	b = append(b, `,"date":`...)
+	b = appendJSONInt(b, int64(chatInviteLink.Date))
+	b = append(b, `,"edit_date":`...)
+	b = appendJSONInt(b, int64(chatInviteLink.EditDate))
+	b = append(b, `,"expiration_date":`...)
+	b = appendJSONInt(b, int64(chatInviteLink.ExpirationDate))
+	b = append(b, `,"member_limit":`...)
+	b = appendJSONInt(b, int64(chatInviteLink.MemberLimit))
+	b = append(b, `,"member_count":`...)
+	b = appendJSONInt(b, int64(chatInviteLink.MemberCount))
+	b = append(b, `,"pending_join_request_count":`...)
+	b = appendJSONInt(b, int64(chatInviteLink.PendingJoinRequestCount))
+	b = append(b, `,"creates_join_request":`...)
+	b = appendJSONBool(b, chatInviteLink.CreatesJoinRequest)
+	b = append(b, `,"is_primary":`...)
+	b = appendJSONBool(b, chatInviteLink.IsPrimary)
+	b = append(b, `,"is_revoked":`...)
+	b = appendJSONBool(b, chatInviteLink.IsRevoked)
+	return append(b, '}'), nil
+}
+
+func (chatInviteLink *ChatInviteLink) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatInviteLink.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatInviteLink.tdCommon.Extra, err = r.readString()
+		case "invite_link":
+			chatInviteLink.InviteLink, err = r.readString()
+		case "name":
+			chatInviteLink.Name, err = r.readString()
+		case "creator_user_id":
+			chatInviteLink.CreatorUserId, err = r.readInt64()
+		case "date":
+			chatInviteLink.Date, err = r.readInt32()
+		case "edit_date":
+			chatInviteLink.EditDate, err = r.readInt32()
+		case "expiration_date":
+			chatInviteLink.ExpirationDate, err = r.readInt32()
+		case "member_limit":
+			chatInviteLink.MemberLimit, err = r.readInt32()
+		case "member_count":
+			chatInviteLink.MemberCount, err = r.readInt32()
+		case "pending_join_request_count":
+			chatInviteLink.PendingJoinRequestCount, err = r.readInt32()
+		case "creates_join_request":
+			chatInviteLink.CreatesJoinRequest, err = r.readBool()
+		case "is_primary":
+			chatInviteLink.IsPrimary, err = r.readBool()
+		case "is_revoked":
+			chatInviteLink.IsRevoked, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatInviteLink *ChatInviteLink) Clone() *ChatInviteLink {
+	if chatInviteLink == nil {
+		return nil
+	}
+	clone := *chatInviteLink
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatInviteLink *ChatInviteLink) Equal(other *ChatInviteLink) bool {
+	if chatInviteLink == nil || other == nil {
+		return chatInviteLink == other
+	}
+	if chatInviteLink.InviteLink != other.InviteLink {
+		return false
+	}
+	if chatInviteLink.Name != other.Name {
+		return false
+	}
+	if chatInviteLink.CreatorUserId != other.CreatorUserId {
+		return false
+	}
+	if chatInviteLink.Date != other.Date {
+		return false
+	}
+	if chatInviteLink.EditDate != other.EditDate {
+		return false
+	}
+	if chatInviteLink.ExpirationDate != other.ExpirationDate {
+		return false
+	}
+	if chatInviteLink.MemberLimit != other.MemberLimit {
+		return false
+	}
+	if chatInviteLink.MemberCount != other.MemberCount {
+		return false
+	}
+	if chatInviteLink.PendingJoinRequestCount != other.PendingJoinRequestCount {
+		return false
+	}
+	if chatInviteLink.CreatesJoinRequest != other.CreatesJoinRequest {
+		return false
+	}
+	if chatInviteLink.IsPrimary != other.IsPrimary {
+		return false
+	}
+	if chatInviteLink.IsRevoked != other.IsRevoked {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatInviteLink *ChatInviteLink) Diff(other *ChatInviteLink) []Change {
+	return chatInviteLink.diff("", other, nil)
+}
+
+func (chatInviteLink *ChatInviteLink) diff(path string, other *ChatInviteLink, changes []Change) []Change {
+	if chatInviteLink == nil || other == nil {
+		if chatInviteLink != other {
+			changes = append(changes, Change{Path: path, Old: chatInviteLink, New: other})
+		}
+		return changes
+	}
+	if chatInviteLink.InviteLink != other.InviteLink {
+		changes = append(changes, Change{Path: fieldPath(path, "invite_link"), Old: chatInviteLink.InviteLink, New: other.InviteLink})
+	}
+	if chatInviteLink.Name != other.Name {
+		changes = append(changes, Change{Path: fieldPath(path, "name"), Old: chatInviteLink.Name, New: other.Name})
+	}
+	if chatInviteLink.CreatorUserId != other.CreatorUserId {
+		changes = append(changes, Change{Path: fieldPath(path, "creator_user_id"), Old: chatInviteLink.CreatorUserId, New: other.CreatorUserId})
+	}
+	if chatInviteLink.Date != other.Date {
+		changes = append(changes, Change{Path: fieldPath(path, "date"), Old: chatInviteLink.Date, New: other.Date})
+	}
+	if chatInviteLink.EditDate != other.EditDate {
+		changes = append(changes, Change{Path: fieldPath(path, "edit_date"), Old: chatInviteLink.EditDate, New: other.EditDate})
+	}
+	if chatInviteLink.ExpirationDate != other.ExpirationDate {
+		changes = append(changes, Change{Path: fieldPath(path, "expiration_date"), Old: chatInviteLink.ExpirationDate, New: other.ExpirationDate})
+	}
+	if chatInviteLink.MemberLimit != other.MemberLimit {
+		changes = append(changes, Change{Path: fieldPath(path, "member_limit"), Old: chatInviteLink.MemberLimit, New: other.MemberLimit})
+	}
+	if chatInviteLink.MemberCount != other.MemberCount {
+		changes = append(changes, Change{Path: fieldPath(path, "member_count"), Old: chatInviteLink.MemberCount, New: other.MemberCount})
+	}
+	if chatInviteLink.PendingJoinRequestCount != other.PendingJoinRequestCount {
+		changes = append(changes, Change{Path: fieldPath(path, "pending_join_request_count"), Old: chatInviteLink.PendingJoinRequestCount, New: other.PendingJoinRequestCount})
+	}
+	if chatInviteLink.CreatesJoinRequest != other.CreatesJoinRequest {
+		changes = append(changes, Change{Path: fieldPath(path, "creates_join_request"), Old: chatInviteLink.CreatesJoinRequest, New: other.CreatesJoinRequest})
+	}
+	if chatInviteLink.IsPrimary != other.IsPrimary {
+		changes = append(changes, Change{Path: fieldPath(path, "is_primary"), Old: chatInviteLink.IsPrimary, New: other.IsPrimary})
+	}
+	if chatInviteLink.IsRevoked != other.IsRevoked {
+		changes = append(changes, Change{Path: fieldPath(path, "is_revoked"), Old: chatInviteLink.IsRevoked, New: other.IsRevoked})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatInviteLink *ChatInviteLink) String() string {
+	return objectString(chatInviteLink)
+}
+
+// LogValue logs the object as its summary
+func (chatInviteLink *ChatInviteLink) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatInviteLink))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatInviteLink *ChatInviteLink) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatInviteLink)
+}
+
+func (chatInviteLink *ChatInviteLink) printText(p *printer) {
+	if chatInviteLink == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatInviteLink")
+	p.string("invite_link", chatInviteLink.InviteLink)
+	p.string("name", chatInviteLink.Name)
+	p.int("creator_user_id", int64(chatInviteLink.CreatorUserId))
+	p.int("date", int64(chatInviteLink.Date))
+	p.int("edit_date", int64(chatInviteLink.EditDate))
+	p.int("expiration_date", int64(chatInviteLink.ExpirationDate))
+	p.int("member_limit", int64(chatInviteLink.MemberLimit))
+	p.int("member_count", int64(chatInviteLink.MemberCount))
+	p.int("pending_join_request_count", int64(chatInviteLink.PendingJoinRequestCount))
+	p.bool("creates_join_request", chatInviteLink.CreatesJoinRequest)
+	p.bool("is_primary", chatInviteLink.IsPrimary)
+	p.bool("is_revoked", chatInviteLink.IsRevoked)
+	p.endObject(state)
+}
+
 // ReplacePrimaryChatInviteLink Replaces current primary invite link for a chat with a new primary invite link. Available for basic groups, supergroups, and channels. Requires administrator privileges and can_invite_users right
 // @param chatId Chat identifier
 func (client *Client) ReplacePrimaryChatInviteLink(chatId int64) (*ChatInviteLink, error) {
@@ -73,12 +296,12 @@ func (client *Client) ReplacePrimaryChatInviteLink(chatId int64) (*ChatInviteLin
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chatInviteLink ChatInviteLink
-	err = json.Unmarshal(result.Raw, &chatInviteLink)
+	err = jsonUnmarshal(result.Raw, &chatInviteLink)
 	return &chatInviteLink, err
 }
 
@@ -102,12 +325,12 @@ func (client *Client) CreateChatInviteLink(chatId int64, name string, expiration
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chatInviteLink ChatInviteLink
-	err = json.Unmarshal(result.Raw, &chatInviteLink)
+	err = jsonUnmarshal(result.Raw, &chatInviteLink)
 	return &chatInviteLink, err
 }
 
@@ -133,12 +356,12 @@ func (client *Client) EditChatInviteLink(chatId int64, inviteLink string, name s
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chatInviteLink ChatInviteLink
-	err = json.Unmarshal(result.Raw, &chatInviteLink)
+	err = jsonUnmarshal(result.Raw, &chatInviteLink)
 	return &chatInviteLink, err
 }
 
@@ -156,11 +379,11 @@ func (client *Client) GetChatInviteLink(chatId int64, inviteLink string) (*ChatI
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chatInviteLink ChatInviteLink
-	err = json.Unmarshal(result.Raw, &chatInviteLink)
+	err = jsonUnmarshal(result.Raw, &chatInviteLink)
 	return &chatInviteLink, err
 }
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // ChatInviteLinkCount Describes a chat administrator with a number of active and revoked chat invite links
 type ChatInviteLinkCount struct {
 	tdCommon
@@ -28,3 +33,127 @@ func NewChatInviteLinkCount(userId int64, inviteLinkCount int32, revokedInviteLi
 
 	return &chatInviteLinkCountTemp
 }
+
+// MarshalJSON marshals to json
+func (chatInviteLinkCount *ChatInviteLinkCount) MarshalJSON() ([]byte, error) {
+	return chatInviteLinkCount.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatInviteLinkCount *ChatInviteLinkCount) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatInviteLinkCount)
+}
+
+func (chatInviteLinkCount *ChatInviteLinkCount) appendJSON(b []byte) ([]byte, error) {
+	if chatInviteLinkCount == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatInviteLinkCount.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatInviteLinkCount.tdCommon.Extra)
+	b = append(b, `,"user_id":`...)
+	b = appendJSONInt(b, int64(chatInviteLinkCount.UserId))
+	b = append(b, `,"invite_link_count":`...)
+	b = appendJSONInt(b, int64(chatInviteLinkCount.InviteLinkCount))
+	b = append(b, `,"revoked_invite_link_count":`...)
+	b = appendJSONInt(b, int64(chatInviteLinkCount.RevokedInviteLinkCount))
+	return append(b, '}'), nil
+}
+
+func (chatInviteLinkCount *ChatInviteLinkCount) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatInviteLinkCount.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatInviteLinkCount.tdCommon.Extra, err = r.readString()
+		case "user_id":
+			chatInviteLinkCount.UserId, err = r.readInt64()
+		case "invite_link_count":
+			chatInviteLinkCount.InviteLinkCount, err = r.readInt32()
+		case "revoked_invite_link_count":
+			chatInviteLinkCount.RevokedInviteLinkCount, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatInviteLinkCount *ChatInviteLinkCount) Clone() *ChatInviteLinkCount {
+	if chatInviteLinkCount == nil {
+		return nil
+	}
+	clone := *chatInviteLinkCount
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatInviteLinkCount *ChatInviteLinkCount) Equal(other *ChatInviteLinkCount) bool {
+	if chatInviteLinkCount == nil || other == nil {
+		return chatInviteLinkCount == other
+	}
+	if chatInviteLinkCount.UserId != other.UserId {
+		return false
+	}
+	if chatInviteLinkCount.InviteLinkCount != other.InviteLinkCount {
+		return false
+	}
+	if chatInviteLinkCount.RevokedInviteLinkCount != other.RevokedInviteLinkCount {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatInviteLinkCount *ChatInviteLinkCount) Diff(other *ChatInviteLinkCount) []Change {
+	return chatInviteLinkCount.diff("", other, nil)
+}
+
+func (chatInviteLinkCount *ChatInviteLinkCount) diff(path string, other *ChatInviteLinkCount, changes []Change) []Change {
+	if chatInviteLinkCount == nil || other == nil {
+		if chatInviteLinkCount != other {
+			changes = append(changes, Change{Path: path, Old: chatInviteLinkCount, New: other})
+		}
+		return changes
+	}
+	if chatInviteLinkCount.UserId != other.UserId {
+		changes = append(changes, Change{Path: fieldPath(path, "user_id"), Old: chatInviteLinkCount.UserId, New: other.UserId})
+	}
+	if chatInviteLinkCount.InviteLinkCount != other.InviteLinkCount {
+		changes = append(changes, Change{Path: fieldPath(path, "invite_link_count"), Old: chatInviteLinkCount.InviteLinkCount, New: other.InviteLinkCount})
+	}
+	if chatInviteLinkCount.RevokedInviteLinkCount != other.RevokedInviteLinkCount {
+		changes = append(changes, Change{Path: fieldPath(path, "revoked_invite_link_count"), Old: chatInviteLinkCount.RevokedInviteLinkCount, New: other.RevokedInviteLinkCount})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatInviteLinkCount *ChatInviteLinkCount) String() string {
+	return objectString(chatInviteLinkCount)
+}
+
+// LogValue logs the object as its summary
+func (chatInviteLinkCount *ChatInviteLinkCount) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatInviteLinkCount))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatInviteLinkCount *ChatInviteLinkCount) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatInviteLinkCount)
+}
+
+func (chatInviteLinkCount *ChatInviteLinkCount) printText(p *printer) {
+	if chatInviteLinkCount == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatInviteLinkCount")
+	p.int("user_id", int64(chatInviteLinkCount.UserId))
+	p.int("invite_link_count", int64(chatInviteLinkCount.InviteLinkCount))
+	p.int("revoked_invite_link_count", int64(chatInviteLinkCount.RevokedInviteLinkCount))
+	p.endObject(state)
+}
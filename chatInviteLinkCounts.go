@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ChatInviteLinkCounts Contains a list of chat invite link counts
@@ -28,6 +28,150 @@ func NewChatInviteLinkCounts(inviteLinkCounts []ChatInviteLinkCount) *ChatInvite
 	return &chatInviteLinkCountsTemp
 }
 
+// MarshalJSON marshals to json
+func (chatInviteLinkCounts *ChatInviteLinkCounts) MarshalJSON() ([]byte, error) {
+	return chatInviteLinkCounts.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatInviteLinkCounts *ChatInviteLinkCounts) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatInviteLinkCounts)
+}
+
+func (chatInviteLinkCounts *ChatInviteLinkCounts) appendJSON(b []byte) ([]byte, error) {
+	if chatInviteLinkCounts == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatInviteLinkCounts.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatInviteLinkCounts.tdCommon.Extra)
+	b = append(b, `,"invite_link_counts":`...)
+	if chatInviteLinkCounts.InviteLinkCounts == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range chatInviteLinkCounts.InviteLinkCounts {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = chatInviteLinkCounts.InviteLinkCounts[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (chatInviteLinkCounts *ChatInviteLinkCounts) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatInviteLinkCounts.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatInviteLinkCounts.tdCommon.Extra, err = r.readString()
+		case "invite_link_counts":
+			if r.readNull() {
+				chatInviteLinkCounts.InviteLinkCounts = nil
+			} else {
+				chatInviteLinkCounts.InviteLinkCounts = make([]ChatInviteLinkCount, 0)
+				err = r.readArray(func() (err error) {
+					var item0 ChatInviteLinkCount
+					err = item0.decodeJSON(r)
+					chatInviteLinkCounts.InviteLinkCounts = append(chatInviteLinkCounts.InviteLinkCounts, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatInviteLinkCounts *ChatInviteLinkCounts) Clone() *ChatInviteLinkCounts {
+	if chatInviteLinkCounts == nil {
+		return nil
+	}
+	clone := *chatInviteLinkCounts
+	if chatInviteLinkCounts.InviteLinkCounts != nil {
+		clone.InviteLinkCounts = make([]ChatInviteLinkCount, len(chatInviteLinkCounts.InviteLinkCounts))
+		for i0 := range chatInviteLinkCounts.InviteLinkCounts {
+			clone.InviteLinkCounts[i0] = *chatInviteLinkCounts.InviteLinkCounts[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatInviteLinkCounts *ChatInviteLinkCounts) Equal(other *ChatInviteLinkCounts) bool {
+	if chatInviteLinkCounts == nil || other == nil {
+		return chatInviteLinkCounts == other
+	}
+	if len(chatInviteLinkCounts.InviteLinkCounts) != len(other.InviteLinkCounts) {
+		return false
+	}
+	for i0 := range chatInviteLinkCounts.InviteLinkCounts {
+		if !chatInviteLinkCounts.InviteLinkCounts[i0].Equal(&other.InviteLinkCounts[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatInviteLinkCounts *ChatInviteLinkCounts) Diff(other *ChatInviteLinkCounts) []Change {
+	return chatInviteLinkCounts.diff("", other, nil)
+}
+
+func (chatInviteLinkCounts *ChatInviteLinkCounts) diff(path string, other *ChatInviteLinkCounts, changes []Change) []Change {
+	if chatInviteLinkCounts == nil || other == nil {
+		if chatInviteLinkCounts != other {
+			changes = append(changes, Change{Path: path, Old: chatInviteLinkCounts, New: other})
+		}
+		return changes
+	}
+	if len(chatInviteLinkCounts.InviteLinkCounts) != len(other.InviteLinkCounts) {
+		changes = append(changes, Change{Path: fieldPath(path, "invite_link_counts"), Old: chatInviteLinkCounts.InviteLinkCounts, New: other.InviteLinkCounts})
+	} else {
+		for i0 := range chatInviteLinkCounts.InviteLinkCounts {
+			changes = chatInviteLinkCounts.InviteLinkCounts[i0].diff(indexPath(fieldPath(path, "invite_link_counts"), i0), &other.InviteLinkCounts[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatInviteLinkCounts *ChatInviteLinkCounts) String() string {
+	return objectString(chatInviteLinkCounts)
+}
+
+// LogValue logs the object as its summary
+func (chatInviteLinkCounts *ChatInviteLinkCounts) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatInviteLinkCounts))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatInviteLinkCounts *ChatInviteLinkCounts) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatInviteLinkCounts)
+}
+
+func (chatInviteLinkCounts *ChatInviteLinkCounts) printText(p *printer) {
+	if chatInviteLinkCounts == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatInviteLinkCounts")
+	p.vector("invite_link_counts", len(chatInviteLinkCounts.InviteLinkCounts), func(i0 int) {
+		chatInviteLinkCounts.InviteLinkCounts[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetChatInviteLinkCounts Returns list of chat administrators with number of their invite links. Requires owner privileges in the chat
 // @param chatId Chat identifier
 func (client *Client) GetChatInviteLinkCounts(chatId int64) (*ChatInviteLinkCounts, error) {
@@ -40,11 +184,11 @@ func (client *Client) GetChatInviteLinkCounts(chatId int64) (*ChatInviteLinkCoun
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chatInviteLinkCounts ChatInviteLinkCounts
-	err = json.Unmarshal(result.Raw, &chatInviteLinkCounts)
+	err = jsonUnmarshal(result.Raw, &chatInviteLinkCounts)
 	return &chatInviteLinkCounts, err
 }
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ChatInviteLinkInfo Contains information about a chat invite link
@@ -55,45 +55,253 @@ func NewChatInviteLinkInfo(chatId int64, accessibleFor int32, typeParam ChatType
 	return &chatInviteLinkInfoTemp
 }
 
+// MarshalJSON marshals to json
+func (chatInviteLinkInfo *ChatInviteLinkInfo) MarshalJSON() ([]byte, error) {
+	return chatInviteLinkInfo.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (chatInviteLinkInfo *ChatInviteLinkInfo) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, chatInviteLinkInfo)
+}
+
+func (chatInviteLinkInfo *ChatInviteLinkInfo) appendJSON(b []byte) ([]byte, error) {
+	if chatInviteLinkInfo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatInviteLinkInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatInviteLinkInfo.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(chatInviteLinkInfo.ChatId))
+	b = append(b, `,"accessible_for":`...)
+	b = appendJSONInt(b, int64(chatInviteLinkInfo.AccessibleFor))
+	b = append(b, `,"type":`...)
+	b, err = appendJSONValue(b, chatInviteLinkInfo.Type)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		ChatId             int64          `json:"chat_id"`              // Chat identifier of the invite link; 0 if the user has no access to the chat before joining
-		AccessibleFor      int32          `json:"accessible_for"`       // If non-zero, the amount of time for which read access to the chat will remain available, in seconds
-		Title              string         `json:"title"`                // Title of the chat
-		Photo              *ChatPhotoInfo `json:"photo"`                // Chat photo; may be null
-		Description        string         `json:"description"`          // Chat description
-		MemberCount        int32          `json:"member_count"`         // Number of members in the chat
-		MemberUserIds      []int64        `json:"member_user_ids"`      // User identifiers of some chat members that may be known to the current user
-		CreatesJoinRequest bool           `json:"creates_join_request"` // True, if the link only creates join request
-		IsPublic           bool           `json:"is_public"`            // True, if the chat is a public supergroup or channel, i.e. it has a username or it is a location-based supergroup
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, chatInviteLinkInfo.Title)
+	b = append(b, `,"photo":`...)
+	b, err = chatInviteLinkInfo.Photo.appendJSON(b)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"description":`...)
+	b = appendJSONString(b, chatInviteLinkInfo.Description)
+	b = append(b, `,"member_count":`...)
+	b = appendJSONInt(b, int64(chatInviteLinkInfo.MemberCount))
+	b = append(b, `,"member_user_ids":`...)
+	if chatInviteLinkInfo.MemberUserIds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range chatInviteLinkInfo.MemberUserIds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(chatInviteLinkInfo.MemberUserIds[i0]))
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"creates_join_request":`...)
+	b = appendJSONBool(b, chatInviteLinkInfo.CreatesJoinRequest)
+	b = append(b, `,"is_public":`...)
+	b = appendJSONBool(b, chatInviteLinkInfo.IsPublic)
+	return append(b, '}'), nil
+}
+
+func (chatInviteLinkInfo *ChatInviteLinkInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatInviteLinkInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatInviteLinkInfo.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			chatInviteLinkInfo.ChatId, err = r.readInt64()
+		case "accessible_for":
+			chatInviteLinkInfo.AccessibleFor, err = r.readInt32()
+		case "type":
+			chatInviteLinkInfo.Type, err = decodeChatType(r)
+		case "title":
+			chatInviteLinkInfo.Title, err = r.readString()
+		case "photo":
+			if r.readNull() {
+				chatInviteLinkInfo.Photo = nil
+			} else {
+				chatInviteLinkInfo.Photo = new(ChatPhotoInfo)
+				err = chatInviteLinkInfo.Photo.decodeJSON(r)
+			}
+		case "description":
+			chatInviteLinkInfo.Description, err = r.readString()
+		case "member_count":
+			chatInviteLinkInfo.MemberCount, err = r.readInt32()
+		case "member_user_ids":
+			if r.readNull() {
+				chatInviteLinkInfo.MemberUserIds = nil
+			} else {
+				chatInviteLinkInfo.MemberUserIds = make([]int64, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int64
+					item0, err = r.readInt64()
+					chatInviteLinkInfo.MemberUserIds = append(chatInviteLinkInfo.MemberUserIds, item0)
+					return
+				})
+			}
+		case "creates_join_request":
+			chatInviteLinkInfo.CreatesJoinRequest, err = r.readBool()
+		case "is_public":
+			chatInviteLinkInfo.IsPublic, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatInviteLinkInfo *ChatInviteLinkInfo) Clone() *ChatInviteLinkInfo {
+	if chatInviteLinkInfo == nil {
+		return nil
+	}
+	clone := *chatInviteLinkInfo
+	clone.Type = cloneChatType(chatInviteLinkInfo.Type)
+	clone.Photo = chatInviteLinkInfo.Photo.Clone()
+	if chatInviteLinkInfo.MemberUserIds != nil {
+		clone.MemberUserIds = make([]int64, len(chatInviteLinkInfo.MemberUserIds))
+		copy(clone.MemberUserIds, chatInviteLinkInfo.MemberUserIds)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatInviteLinkInfo *ChatInviteLinkInfo) Equal(other *ChatInviteLinkInfo) bool {
+	if chatInviteLinkInfo == nil || other == nil {
+		return chatInviteLinkInfo == other
+	}
+	if chatInviteLinkInfo.ChatId != other.ChatId {
+		return false
+	}
+	if chatInviteLinkInfo.AccessibleFor != other.AccessibleFor {
+		return false
+	}
+	if !equalChatType(chatInviteLinkInfo.Type, other.Type) {
+		return false
+	}
+	if chatInviteLinkInfo.Title != other.Title {
+		return false
+	}
+	if !chatInviteLinkInfo.Photo.Equal(other.Photo) {
+		return false
+	}
+	if chatInviteLinkInfo.Description != other.Description {
+		return false
 	}
+	if chatInviteLinkInfo.MemberCount != other.MemberCount {
+		return false
+	}
+	if len(chatInviteLinkInfo.MemberUserIds) != len(other.MemberUserIds) {
+		return false
+	}
+	for i0 := range chatInviteLinkInfo.MemberUserIds {
+		if chatInviteLinkInfo.MemberUserIds[i0] != other.MemberUserIds[i0] {
+			return false
+		}
+	}
+	if chatInviteLinkInfo.CreatesJoinRequest != other.CreatesJoinRequest {
+		return false
+	}
+	if chatInviteLinkInfo.IsPublic != other.IsPublic {
+		return false
+	}
+	return true
+}
 
-	chatInviteLinkInfo.tdCommon = tempObj.tdCommon
-	chatInviteLinkInfo.ChatId = tempObj.ChatId
-	chatInviteLinkInfo.AccessibleFor = tempObj.AccessibleFor
-	chatInviteLinkInfo.Title = tempObj.Title
-	chatInviteLinkInfo.Photo = tempObj.Photo
-	chatInviteLinkInfo.Description = tempObj.Description
-	chatInviteLinkInfo.MemberCount = tempObj.MemberCount
-	chatInviteLinkInfo.MemberUserIds = tempObj.MemberUserIds
-	chatInviteLinkInfo.CreatesJoinRequest = tempObj.CreatesJoinRequest
-	chatInviteLinkInfo.IsPublic = tempObj.IsPublic
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatInviteLinkInfo *ChatInviteLinkInfo) Diff(other *ChatInviteLinkInfo) []Change {
+	return chatInviteLinkInfo.diff("", other, nil)
+}
 
-	fieldType, _ := unmarshalChatType(objMap["type"])
-	chatInviteLinkInfo.Type = fieldType
+func (chatInviteLinkInfo *ChatInviteLinkInfo) diff(path string, other *ChatInviteLinkInfo, changes []Change) []Change {
+	if chatInviteLinkInfo == nil || other == nil {
+		if chatInviteLinkInfo != other {
+			changes = append(changes, Change{Path: path, Old: chatInviteLinkInfo, New: other})
+		}
+		return changes
+	}
+	if chatInviteLinkInfo.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: chatInviteLinkInfo.ChatId, New: other.ChatId})
+	}
+	if chatInviteLinkInfo.AccessibleFor != other.AccessibleFor {
+		changes = append(changes, Change{Path: fieldPath(path, "accessible_for"), Old: chatInviteLinkInfo.AccessibleFor, New: other.AccessibleFor})
+	}
+	changes = diffChatType(fieldPath(path, "type"), chatInviteLinkInfo.Type, other.Type, changes)
+	if chatInviteLinkInfo.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: chatInviteLinkInfo.Title, New: other.Title})
+	}
+	changes = chatInviteLinkInfo.Photo.diff(fieldPath(path, "photo"), other.Photo, changes)
+	if chatInviteLinkInfo.Description != other.Description {
+		changes = append(changes, Change{Path: fieldPath(path, "description"), Old: chatInviteLinkInfo.Description, New: other.Description})
+	}
+	if chatInviteLinkInfo.MemberCount != other.MemberCount {
+		changes = append(changes, Change{Path: fieldPath(path, "member_count"), Old: chatInviteLinkInfo.MemberCount, New: other.MemberCount})
+	}
+	if len(chatInviteLinkInfo.MemberUserIds) != len(other.MemberUserIds) {
+		changes = append(changes, Change{Path: fieldPath(path, "member_user_ids"), Old: chatInviteLinkInfo.MemberUserIds, New: other.MemberUserIds})
+	} else {
+		for i0 := range chatInviteLinkInfo.MemberUserIds {
+			if chatInviteLinkInfo.MemberUserIds[i0] != other.MemberUserIds[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "member_user_ids"), i0), Old: chatInviteLinkInfo.MemberUserIds[i0], New: other.MemberUserIds[i0]})
+			}
+		}
+	}
+	if chatInviteLinkInfo.CreatesJoinRequest != other.CreatesJoinRequest {
+		changes = append(changes, Change{Path: fieldPath(path, "creates_join_request"), Old: chatInviteLinkInfo.CreatesJoinRequest, New: other.CreatesJoinRequest})
+	}
+	if chatInviteLinkInfo.IsPublic != other.IsPublic {
+		changes = append(changes, Change{Path: fieldPath(path, "is_public"), Old: chatInviteLinkInfo.IsPublic, New: other.IsPublic})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatInviteLinkInfo *ChatInviteLinkInfo) String() string {
+	return objectString(chatInviteLinkInfo)
+}
 
-	return nil
+// LogValue logs the object as its summary
+func (chatInviteLinkInfo *ChatInviteLinkInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatInviteLinkInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatInviteLinkInfo *ChatInviteLinkInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatInviteLinkInfo)
+}
+
+func (chatInviteLinkInfo *ChatInviteLinkInfo) printText(p *printer) {
+	if chatInviteLinkInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatInviteLinkInfo")
+	p.int("chat_id", int64(chatInviteLinkInfo.ChatId))
+	p.int("accessible_for", int64(chatInviteLinkInfo.AccessibleFor))
+	p.object("type", chatInviteLinkInfo.Type)
+	p.string("title", chatInviteLinkInfo.Title)
+	p.object("photo", chatInviteLinkInfo.Photo)
+	p.string("description", chatInviteLinkInfo.Description)
+	p.int("member_count", int64(chatInviteLinkInfo.MemberCount))
+	p.vector("member_user_ids", len(chatInviteLinkInfo.MemberUserIds), func(i0 int) {
+		p.int("member_user_ids", int64(chatInviteLinkInfo.MemberUserIds[i0]))
+	})
+	p.bool("creates_join_request", chatInviteLinkInfo.CreatesJoinRequest)
+	p.bool("is_public", chatInviteLinkInfo.IsPublic)
+	p.endObject(state)
 }
 
 // CheckChatInviteLink Checks the validity of an invite link for a chat and returns information about the corresponding chat
@@ -108,11 +316,11 @@ func (client *Client) CheckChatInviteLink(inviteLink string) (*ChatInviteLinkInf
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chatInviteLinkInfo ChatInviteLinkInfo
-	err = json.Unmarshal(result.Raw, &chatInviteLinkInfo)
+	err = jsonUnmarshal(result.Raw, &chatInviteLinkInfo)
 	return &chatInviteLinkInfo, err
 }
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // ChatInviteLinkMember Describes a chat member joined a chat via an invite link
 type ChatInviteLinkMember struct {
 	tdCommon
@@ -28,3 +33,127 @@ func NewChatInviteLinkMember(userId int64, joinedChatDate int32, approverUserId
 
 	return &chatInviteLinkMemberTemp
 }
+
+// MarshalJSON marshals to json
+func (chatInviteLinkMember *ChatInviteLinkMember) MarshalJSON() ([]byte, error) {
+	return chatInviteLinkMember.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatInviteLinkMember *ChatInviteLinkMember) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatInviteLinkMember)
+}
+
+func (chatInviteLinkMember *ChatInviteLinkMember) appendJSON(b []byte) ([]byte, error) {
+	if chatInviteLinkMember == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatInviteLinkMember.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatInviteLinkMember.tdCommon.Extra)
+	b = append(b, `,"user_id":`...)
+	b = appendJSONInt(b, int64(chatInviteLinkMember.UserId))
+	b = append(b, `,"joined_chat_date":`...)
+	b = appendJSONInt(b, int64(chatInviteLinkMember.JoinedChatDate))
+	b = append(b, `,"approver_user_id":`...)
+	b = appendJSONInt(b, int64(chatInviteLinkMember.ApproverUserId))
+	return append(b, '}'), nil
+}
+
+func (chatInviteLinkMember *ChatInviteLinkMember) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatInviteLinkMember.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatInviteLinkMember.tdCommon.Extra, err = r.readString()
+		case "user_id":
+			chatInviteLinkMember.UserId, err = r.readInt64()
+		case "joined_chat_date":
+			chatInviteLinkMember.JoinedChatDate, err = r.readInt32()
+		case "approver_user_id":
+			chatInviteLinkMember.ApproverUserId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatInviteLinkMember *ChatInviteLinkMember) Clone() *ChatInviteLinkMember {
+	if chatInviteLinkMember == nil {
+		return nil
+	}
+	clone := *chatInviteLinkMember
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatInviteLinkMember *ChatInviteLinkMember) Equal(other *ChatInviteLinkMember) bool {
+	if chatInviteLinkMember == nil || other == nil {
+		return chatInviteLinkMember == other
+	}
+	if chatInviteLinkMember.UserId != other.UserId {
+		return false
+	}
+	if chatInviteLinkMember.JoinedChatDate != other.JoinedChatDate {
+		return false
+	}
+	if chatInviteLinkMember.ApproverUserId != other.ApproverUserId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatInviteLinkMember *ChatInviteLinkMember) Diff(other *ChatInviteLinkMember) []Change {
+	return chatInviteLinkMember.diff("", other, nil)
+}
+
+func (chatInviteLinkMember *ChatInviteLinkMember) diff(path string, other *ChatInviteLinkMember, changes []Change) []Change {
+	if chatInviteLinkMember == nil || other == nil {
+		if chatInviteLinkMember != other {
+			changes = append(changes, Change{Path: path, Old: chatInviteLinkMember, New: other})
+		}
+		return changes
+	}
+	if chatInviteLinkMember.UserId != other.UserId {
+		changes = append(changes, Change{Path: fieldPath(path, "user_id"), Old: chatInviteLinkMember.UserId, New: other.UserId})
+	}
+	if chatInviteLinkMember.JoinedChatDate != other.JoinedChatDate {
+		changes = append(changes, Change{Path: fieldPath(path, "joined_chat_date"), Old: chatInviteLinkMember.JoinedChatDate, New: other.JoinedChatDate})
+	}
+	if chatInviteLinkMember.ApproverUserId != other.ApproverUserId {
+		changes = append(changes, Change{Path: fieldPath(path, "approver_user_id"), Old: chatInviteLinkMember.ApproverUserId, New: other.ApproverUserId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatInviteLinkMember *ChatInviteLinkMember) String() string {
+	return objectString(chatInviteLinkMember)
+}
+
+// LogValue logs the object as its summary
+func (chatInviteLinkMember *ChatInviteLinkMember) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatInviteLinkMember))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatInviteLinkMember *ChatInviteLinkMember) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatInviteLinkMember)
+}
+
+func (chatInviteLinkMember *ChatInviteLinkMember) printText(p *printer) {
+	if chatInviteLinkMember == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatInviteLinkMember")
+	p.int("user_id", int64(chatInviteLinkMember.UserId))
+	p.int("joined_chat_date", int64(chatInviteLinkMember.JoinedChatDate))
+	p.int("approver_user_id", int64(chatInviteLinkMember.ApproverUserId))
+	p.endObject(state)
+}
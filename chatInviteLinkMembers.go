@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ChatInviteLinkMembers Contains a list of chat members joined a chat via an invite link
@@ -31,6 +31,161 @@ func NewChatInviteLinkMembers(totalCount int32, members []ChatInviteLinkMember)
 	return &chatInviteLinkMembersTemp
 }
 
+// MarshalJSON marshals to json
+func (chatInviteLinkMembers *ChatInviteLinkMembers) MarshalJSON() ([]byte, error) {
+	return chatInviteLinkMembers.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatInviteLinkMembers *ChatInviteLinkMembers) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatInviteLinkMembers)
+}
+
+func (chatInviteLinkMembers *ChatInviteLinkMembers) appendJSON(b []byte) ([]byte, error) {
+	if chatInviteLinkMembers == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatInviteLinkMembers.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatInviteLinkMembers.tdCommon.Extra)
+	b = append(b, `,"total_count":`...)
+	b = appendJSONInt(b, int64(chatInviteLinkMembers.TotalCount))
+	b = append(b, `,"members":`...)
+	if chatInviteLinkMembers.Members == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range chatInviteLinkMembers.Members {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = chatInviteLinkMembers.Members[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (chatInviteLinkMembers *ChatInviteLinkMembers) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatInviteLinkMembers.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatInviteLinkMembers.tdCommon.Extra, err = r.readString()
+		case "total_count":
+			chatInviteLinkMembers.TotalCount, err = r.readInt32()
+		case "members":
+			if r.readNull() {
+				chatInviteLinkMembers.Members = nil
+			} else {
+				chatInviteLinkMembers.Members = make([]ChatInviteLinkMember, 0)
+				err = r.readArray(func() (err error) {
+					var item0 ChatInviteLinkMember
+					err = item0.decodeJSON(r)
+					chatInviteLinkMembers.Members = append(chatInviteLinkMembers.Members, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatInviteLinkMembers *ChatInviteLinkMembers) Clone() *ChatInviteLinkMembers {
+	if chatInviteLinkMembers == nil {
+		return nil
+	}
+	clone := *chatInviteLinkMembers
+	if chatInviteLinkMembers.Members != nil {
+		clone.Members = make([]ChatInviteLinkMember, len(chatInviteLinkMembers.Members))
+		for i0 := range chatInviteLinkMembers.Members {
+			clone.Members[i0] = *chatInviteLinkMembers.Members[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatInviteLinkMembers *ChatInviteLinkMembers) Equal(other *ChatInviteLinkMembers) bool {
+	if chatInviteLinkMembers == nil || other == nil {
+		return chatInviteLinkMembers == other
+	}
+	if chatInviteLinkMembers.TotalCount != other.TotalCount {
+		return false
+	}
+	if len(chatInviteLinkMembers.Members) != len(other.Members) {
+		return false
+	}
+	for i0 := range chatInviteLinkMembers.Members {
+		if !chatInviteLinkMembers.Members[i0].Equal(&other.Members[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatInviteLinkMembers *ChatInviteLinkMembers) Diff(other *ChatInviteLinkMembers) []Change {
+	return chatInviteLinkMembers.diff("", other, nil)
+}
+
+func (chatInviteLinkMembers *ChatInviteLinkMembers) diff(path string, other *ChatInviteLinkMembers, changes []Change) []Change {
+	if chatInviteLinkMembers == nil || other == nil {
+		if chatInviteLinkMembers != other {
+			changes = append(changes, Change{Path: path, Old: chatInviteLinkMembers, New: other})
+		}
+		return changes
+	}
+	if chatInviteLinkMembers.TotalCount != other.TotalCount {
+		changes = append(changes, Change{Path: fieldPath(path, "total_count"), Old: chatInviteLinkMembers.TotalCount, New: other.TotalCount})
+	}
+	if len(chatInviteLinkMembers.Members) != len(other.Members) {
+		changes = append(changes, Change{Path: fieldPath(path, "members"), Old: chatInviteLinkMembers.Members, New: other.Members})
+	} else {
+		for i0 := range chatInviteLinkMembers.Members {
+			changes = chatInviteLinkMembers.Members[i0].diff(indexPath(fieldPath(path, "members"), i0), &other.Members[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatInviteLinkMembers *ChatInviteLinkMembers) String() string {
+	return objectString(chatInviteLinkMembers)
+}
+
+// LogValue logs the object as its summary
+func (chatInviteLinkMembers *ChatInviteLinkMembers) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatInviteLinkMembers))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatInviteLinkMembers *ChatInviteLinkMembers) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatInviteLinkMembers)
+}
+
+func (chatInviteLinkMembers *ChatInviteLinkMembers) printText(p *printer) {
+	if chatInviteLinkMembers == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatInviteLinkMembers")
+	p.int("total_count", int64(chatInviteLinkMembers.TotalCount))
+	p.vector("members", len(chatInviteLinkMembers.Members), func(i0 int) {
+		chatInviteLinkMembers.Members[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetChatInviteLinkMembers Returns chat members joined a chat via an invite link. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links
 // @param chatId Chat identifier
 // @param inviteLink Invite link for which to return chat members
@@ -49,11 +204,11 @@ func (client *Client) GetChatInviteLinkMembers(chatId int64, inviteLink string,
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chatInviteLinkMembers ChatInviteLinkMembers
-	err = json.Unmarshal(result.Raw, &chatInviteLinkMembers)
+	err = jsonUnmarshal(result.Raw, &chatInviteLinkMembers)
 	return &chatInviteLinkMembers, err
 }
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ChatInviteLinks Contains a list of chat invite links
@@ -31,6 +31,161 @@ func NewChatInviteLinks(totalCount int32, inviteLinks []ChatInviteLink) *ChatInv
 	return &chatInviteLinksTemp
 }
 
+// MarshalJSON marshals to json
+func (chatInviteLinks *ChatInviteLinks) MarshalJSON() ([]byte, error) {
+	return chatInviteLinks.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatInviteLinks *ChatInviteLinks) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatInviteLinks)
+}
+
+func (chatInviteLinks *ChatInviteLinks) appendJSON(b []byte) ([]byte, error) {
+	if chatInviteLinks == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatInviteLinks.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatInviteLinks.tdCommon.Extra)
+	b = append(b, `,"total_count":`...)
+	b = appendJSONInt(b, int64(chatInviteLinks.TotalCount))
+	b = append(b, `,"invite_links":`...)
+	if chatInviteLinks.InviteLinks == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range chatInviteLinks.InviteLinks {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = chatInviteLinks.InviteLinks[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (chatInviteLinks *ChatInviteLinks) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatInviteLinks.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatInviteLinks.tdCommon.Extra, err = r.readString()
+		case "total_count":
+			chatInviteLinks.TotalCount, err = r.readInt32()
+		case "invite_links":
+			if r.readNull() {
+				chatInviteLinks.InviteLinks = nil
+			} else {
+				chatInviteLinks.InviteLinks = make([]ChatInviteLink, 0)
+				err = r.readArray(func() (err error) {
+					var item0 ChatInviteLink
+					err = item0.decodeJSON(r)
+					chatInviteLinks.InviteLinks = append(chatInviteLinks.InviteLinks, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatInviteLinks *ChatInviteLinks) Clone() *ChatInviteLinks {
+	if chatInviteLinks == nil {
+		return nil
+	}
+	clone := *chatInviteLinks
+	if chatInviteLinks.InviteLinks != nil {
+		clone.InviteLinks = make([]ChatInviteLink, len(chatInviteLinks.InviteLinks))
+		for i0 := range chatInviteLinks.InviteLinks {
+			clone.InviteLinks[i0] = *chatInviteLinks.InviteLinks[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatInviteLinks *ChatInviteLinks) Equal(other *ChatInviteLinks) bool {
+	if chatInviteLinks == nil || other == nil {
+		return chatInviteLinks == other
+	}
+	if chatInviteLinks.TotalCount != other.TotalCount {
+		return false
+	}
+	if len(chatInviteLinks.InviteLinks) != len(other.InviteLinks) {
+		return false
+	}
+	for i0 := range chatInviteLinks.InviteLinks {
+		if !chatInviteLinks.InviteLinks[i0].Equal(&other.InviteLinks[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatInviteLinks *ChatInviteLinks) Diff(other *ChatInviteLinks) []Change {
+	return chatInviteLinks.diff("", other, nil)
+}
+
+func (chatInviteLinks *ChatInviteLinks) diff(path string, other *ChatInviteLinks, changes []Change) []Change {
+	if chatInviteLinks == nil || other == nil {
+		if chatInviteLinks != other {
+			changes = append(changes, Change{Path: path, Old: chatInviteLinks, New: other})
+		}
+		return changes
+	}
+	if chatInviteLinks.TotalCount != other.TotalCount {
+		changes = append(changes, Change{Path: fieldPath(path, "total_count"), Old: chatInviteLinks.TotalCount, New: other.TotalCount})
+	}
+	if len(chatInviteLinks.InviteLinks) != len(other.InviteLinks) {
+		changes = append(changes, Change{Path: fieldPath(path, "invite_links"), Old: chatInviteLinks.InviteLinks, New: other.InviteLinks})
+	} else {
+		for i0 := range chatInviteLinks.InviteLinks {
+			changes = chatInviteLinks.InviteLinks[i0].diff(indexPath(fieldPath(path, "invite_links"), i0), &other.InviteLinks[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatInviteLinks *ChatInviteLinks) String() string {
+	return objectString(chatInviteLinks)
+}
+
+// LogValue logs the object as its summary
+func (chatInviteLinks *ChatInviteLinks) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatInviteLinks))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatInviteLinks *ChatInviteLinks) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatInviteLinks)
+}
+
+func (chatInviteLinks *ChatInviteLinks) printText(p *printer) {
+	if chatInviteLinks == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatInviteLinks")
+	p.int("total_count", int64(chatInviteLinks.TotalCount))
+	p.vector("invite_links", len(chatInviteLinks.InviteLinks), func(i0 int) {
+		chatInviteLinks.InviteLinks[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetChatInviteLinks Returns invite links for a chat created by specified administrator. Requires administrator privileges and can_invite_users right in the chat to get own links and owner privileges to get other links
 // @param chatId Chat identifier
 // @param creatorUserId User identifier of a chat administrator. Must be an identifier of the current user for non-owner
@@ -53,12 +208,12 @@ func (client *Client) GetChatInviteLinks(chatId int64, creatorUserId int64, isRe
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chatInviteLinks ChatInviteLinks
-	err = json.Unmarshal(result.Raw, &chatInviteLinks)
+	err = jsonUnmarshal(result.Raw, &chatInviteLinks)
 	return &chatInviteLinks, err
 }
 
@@ -76,11 +231,11 @@ func (client *Client) RevokeChatInviteLink(chatId int64, inviteLink string) (*Ch
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chatInviteLinks ChatInviteLinks
-	err = json.Unmarshal(result.Raw, &chatInviteLinks)
+	err = jsonUnmarshal(result.Raw, &chatInviteLinks)
 	return &chatInviteLinks, err
 }
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // ChatJoinRequest Describes a user that sent a join request and waits for administrator approval
 type ChatJoinRequest struct {
 	tdCommon
@@ -28,3 +33,127 @@ func NewChatJoinRequest(userId int64, date int32, bio string) *ChatJoinRequest {
 
 	return &chatJoinRequestTemp
 }
+
+// MarshalJSON marshals to json
+func (chatJoinRequest *ChatJoinRequest) MarshalJSON() ([]byte, error) {
+	return chatJoinRequest.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatJoinRequest *ChatJoinRequest) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatJoinRequest)
+}
+
+func (chatJoinRequest *ChatJoinRequest) appendJSON(b []byte) ([]byte, error) {
+	if chatJoinRequest == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatJoinRequest.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatJoinRequest.tdCommon.Extra)
+	b = append(b, `,"user_id":`...)
+	b = appendJSONInt(b, int64(chatJoinRequest.UserId))
+	b = append(b, `,"date":`...)
+	b = appendJSONInt(b, int64(chatJoinRequest.Date))
+	b = append(b, `,"bio":`...)
+	b = appendJSONString(b, chatJoinRequest.Bio)
+	return append(b, '}'), nil
+}
+
+func (chatJoinRequest *ChatJoinRequest) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatJoinRequest.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatJoinRequest.tdCommon.Extra, err = r.readString()
+		case "user_id":
+			chatJoinRequest.UserId, err = r.readInt64()
+		case "date":
+			chatJoinRequest.Date, err = r.readInt32()
+		case "bio":
+			chatJoinRequest.Bio, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatJoinRequest *ChatJoinRequest) Clone() *ChatJoinRequest {
+	if chatJoinRequest == nil {
+		return nil
+	}
+	clone := *chatJoinRequest
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatJoinRequest *ChatJoinRequest) Equal(other *ChatJoinRequest) bool {
+	if chatJoinRequest == nil || other == nil {
+		return chatJoinRequest == other
+	}
+	if chatJoinRequest.UserId != other.UserId {
+		return false
+	}
+	if chatJoinRequest.Date != other.Date {
+		return false
+	}
+	if chatJoinRequest.Bio != other.Bio {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatJoinRequest *ChatJoinRequest) Diff(other *ChatJoinRequest) []Change {
+	return chatJoinRequest.diff("", other, nil)
+}
+
+func (chatJoinRequest *ChatJoinRequest) diff(path string, other *ChatJoinRequest, changes []Change) []Change {
+	if chatJoinRequest == nil || other == nil {
+		if chatJoinRequest != other {
+			changes = append(changes, Change{Path: path, Old: chatJoinRequest, New: other})
+		}
+		return changes
+	}
+	if chatJoinRequest.UserId != other.UserId {
+		changes = append(changes, Change{Path: fieldPath(path, "user_id"), Old: chatJoinRequest.UserId, New: other.UserId})
+	}
+	if chatJoinRequest.Date != other.Date {
+		changes = append(changes, Change{Path: fieldPath(path, "date"), Old: chatJoinRequest.Date, New: other.Date})
+	}
+	if chatJoinRequest.Bio != other.Bio {
+		changes = append(changes, Change{Path: fieldPath(path, "bio"), Old: chatJoinRequest.Bio, New: other.Bio})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatJoinRequest *ChatJoinRequest) String() string {
+	return objectString(chatJoinRequest)
+}
+
+// LogValue logs the object as its summary
+func (chatJoinRequest *ChatJoinRequest) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatJoinRequest))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatJoinRequest *ChatJoinRequest) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatJoinRequest)
+}
+
+func (chatJoinRequest *ChatJoinRequest) printText(p *printer) {
+	if chatJoinRequest == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatJoinRequest")
+	p.int("user_id", int64(chatJoinRequest.UserId))
+	p.int("date", int64(chatJoinRequest.Date))
+	p.string("bio", chatJoinRequest.Bio)
+	p.endObject(state)
+}
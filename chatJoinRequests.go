@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ChatJoinRequests Contains a list of requests to join a chat
@@ -31,6 +31,161 @@ func NewChatJoinRequests(totalCount int32, requests []ChatJoinRequest) *ChatJoin
 	return &chatJoinRequestsTemp
 }
 
+// MarshalJSON marshals to json
+func (chatJoinRequests *ChatJoinRequests) MarshalJSON() ([]byte, error) {
+	return chatJoinRequests.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatJoinRequests *ChatJoinRequests) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatJoinRequests)
+}
+
+func (chatJoinRequests *ChatJoinRequests) appendJSON(b []byte) ([]byte, error) {
+	if chatJoinRequests == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatJoinRequests.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatJoinRequests.tdCommon.Extra)
+	b = append(b, `,"total_count":`...)
+	b = appendJSONInt(b, int64(chatJoinRequests.TotalCount))
+	b = append(b, `,"requests":`...)
+	if chatJoinRequests.Requests == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range chatJoinRequests.Requests {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = chatJoinRequests.Requests[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (chatJoinRequests *ChatJoinRequests) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatJoinRequests.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatJoinRequests.tdCommon.Extra, err = r.readString()
+		case "total_count":
+			chatJoinRequests.TotalCount, err = r.readInt32()
+		case "requests":
+			if r.readNull() {
+				chatJoinRequests.Requests = nil
+			} else {
+				chatJoinRequests.Requests = make([]ChatJoinRequest, 0)
+				err = r.readArray(func() (err error) {
+					var item0 ChatJoinRequest
+					err = item0.decodeJSON(r)
+					chatJoinRequests.Requests = append(chatJoinRequests.Requests, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatJoinRequests *ChatJoinRequests) Clone() *ChatJoinRequests {
+	if chatJoinRequests == nil {
+		return nil
+	}
+	clone := *chatJoinRequests
+	if chatJoinRequests.Requests != nil {
+		clone.Requests = make([]ChatJoinRequest, len(chatJoinRequests.Requests))
+		for i0 := range chatJoinRequests.Requests {
+			clone.Requests[i0] = *chatJoinRequests.Requests[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatJoinRequests *ChatJoinRequests) Equal(other *ChatJoinRequests) bool {
+	if chatJoinRequests == nil || other == nil {
+		return chatJoinRequests == other
+	}
+	if chatJoinRequests.TotalCount != other.TotalCount {
+		return false
+	}
+	if len(chatJoinRequests.Requests) != len(other.Requests) {
+		return false
+	}
+	for i0 := range chatJoinRequests.Requests {
+		if !chatJoinRequests.Requests[i0].Equal(&other.Requests[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatJoinRequests *ChatJoinRequests) Diff(other *ChatJoinRequests) []Change {
+	return chatJoinRequests.diff("", other, nil)
+}
+
+func (chatJoinRequests *ChatJoinRequests) diff(path string, other *ChatJoinRequests, changes []Change) []Change {
+	if chatJoinRequests == nil || other == nil {
+		if chatJoinRequests != other {
+			changes = append(changes, Change{Path: path, Old: chatJoinRequests, New: other})
+		}
+		return changes
+	}
+	if chatJoinRequests.TotalCount != other.TotalCount {
+		changes = append(changes, Change{Path: fieldPath(path, "total_count"), Old: chatJoinRequests.TotalCount, New: other.TotalCount})
+	}
+	if len(chatJoinRequests.Requests) != len(other.Requests) {
+		changes = append(changes, Change{Path: fieldPath(path, "requests"), Old: chatJoinRequests.Requests, New: other.Requests})
+	} else {
+		for i0 := range chatJoinRequests.Requests {
+			changes = chatJoinRequests.Requests[i0].diff(indexPath(fieldPath(path, "requests"), i0), &other.Requests[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatJoinRequests *ChatJoinRequests) String() string {
+	return objectString(chatJoinRequests)
+}
+
+// LogValue logs the object as its summary
+func (chatJoinRequests *ChatJoinRequests) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatJoinRequests))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatJoinRequests *ChatJoinRequests) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatJoinRequests)
+}
+
+func (chatJoinRequests *ChatJoinRequests) printText(p *printer) {
+	if chatJoinRequests == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatJoinRequests")
+	p.int("total_count", int64(chatJoinRequests.TotalCount))
+	p.vector("requests", len(chatJoinRequests.Requests), func(i0 int) {
+		chatJoinRequests.Requests[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetChatJoinRequests Returns pending join requests in a chat
 // @param chatId Chat identifier
 // @param inviteLink Invite link for which to return join requests. If empty, all join requests will be returned. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links
@@ -51,11 +206,11 @@ func (client *Client) GetChatJoinRequests(chatId int64, inviteLink string, query
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chatJoinRequests ChatJoinRequests
-	err = json.Unmarshal(result.Raw, &chatJoinRequests)
+	err = jsonUnmarshal(result.Raw, &chatJoinRequests)
 	return &chatJoinRequests, err
 }
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // ChatJoinRequestsInfo Contains information about pending join requests for a chat
 type ChatJoinRequestsInfo struct {
 	tdCommon
@@ -25,3 +30,154 @@ func NewChatJoinRequestsInfo(totalCount int32, userIds []int64) *ChatJoinRequest
 
 	return &chatJoinRequestsInfoTemp
 }
+
+// MarshalJSON marshals to json
+func (chatJoinRequestsInfo *ChatJoinRequestsInfo) MarshalJSON() ([]byte, error) {
+	return chatJoinRequestsInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatJoinRequestsInfo *ChatJoinRequestsInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatJoinRequestsInfo)
+}
+
+func (chatJoinRequestsInfo *ChatJoinRequestsInfo) appendJSON(b []byte) ([]byte, error) {
+	if chatJoinRequestsInfo == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatJoinRequestsInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatJoinRequestsInfo.tdCommon.Extra)
+	b = append(b, `,"total_count":`...)
+	b = appendJSONInt(b, int64(chatJoinRequestsInfo.TotalCount))
+	b = append(b, `,"user_ids":`...)
+	if chatJoinRequestsInfo.UserIds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range chatJoinRequestsInfo.UserIds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(chatJoinRequestsInfo.UserIds[i0]))
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (chatJoinRequestsInfo *ChatJoinRequestsInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatJoinRequestsInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatJoinRequestsInfo.tdCommon.Extra, err = r.readString()
+		case "total_count":
+			chatJoinRequestsInfo.TotalCount, err = r.readInt32()
+		case "user_ids":
+			if r.readNull() {
+				chatJoinRequestsInfo.UserIds = nil
+			} else {
+				chatJoinRequestsInfo.UserIds = make([]int64, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int64
+					item0, err = r.readInt64()
+					chatJoinRequestsInfo.UserIds = append(chatJoinRequestsInfo.UserIds, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatJoinRequestsInfo *ChatJoinRequestsInfo) Clone() *ChatJoinRequestsInfo {
+	if chatJoinRequestsInfo == nil {
+		return nil
+	}
+	clone := *chatJoinRequestsInfo
+	if chatJoinRequestsInfo.UserIds != nil {
+		clone.UserIds = make([]int64, len(chatJoinRequestsInfo.UserIds))
+		copy(clone.UserIds, chatJoinRequestsInfo.UserIds)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatJoinRequestsInfo *ChatJoinRequestsInfo) Equal(other *ChatJoinRequestsInfo) bool {
+	if chatJoinRequestsInfo == nil || other == nil {
+		return chatJoinRequestsInfo == other
+	}
+	if chatJoinRequestsInfo.TotalCount != other.TotalCount {
+		return false
+	}
+	if len(chatJoinRequestsInfo.UserIds) != len(other.UserIds) {
+		return false
+	}
+	for i0 := range chatJoinRequestsInfo.UserIds {
+		if chatJoinRequestsInfo.UserIds[i0] != other.UserIds[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatJoinRequestsInfo *ChatJoinRequestsInfo) Diff(other *ChatJoinRequestsInfo) []Change {
+	return chatJoinRequestsInfo.diff("", other, nil)
+}
+
+func (chatJoinRequestsInfo *ChatJoinRequestsInfo) diff(path string, other *ChatJoinRequestsInfo, changes []Change) []Change {
+	if chatJoinRequestsInfo == nil || other == nil {
+		if chatJoinRequestsInfo != other {
+			changes = append(changes, Change{Path: path, Old: chatJoinRequestsInfo, New: other})
+		}
+		return changes
+	}
+	if chatJoinRequestsInfo.TotalCount != other.TotalCount {
+		changes = append(changes, Change{Path: fieldPath(path, "total_count"), Old: chatJoinRequestsInfo.TotalCount, New: other.TotalCount})
+	}
+	if len(chatJoinRequestsInfo.UserIds) != len(other.UserIds) {
+		changes = append(changes, Change{Path: fieldPath(path, "user_ids"), Old: chatJoinRequestsInfo.UserIds, New: other.UserIds})
+	} else {
+		for i0 := range chatJoinRequestsInfo.UserIds {
+			if chatJoinRequestsInfo.UserIds[i0] != other.UserIds[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "user_ids"), i0), Old: chatJoinRequestsInfo.UserIds[i0], New: other.UserIds[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatJoinRequestsInfo *ChatJoinRequestsInfo) String() string {
+	return objectString(chatJoinRequestsInfo)
+}
+
+// LogValue logs the object as its summary
+func (chatJoinRequestsInfo *ChatJoinRequestsInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatJoinRequestsInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatJoinRequestsInfo *ChatJoinRequestsInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatJoinRequestsInfo)
+}
+
+func (chatJoinRequestsInfo *ChatJoinRequestsInfo) printText(p *printer) {
+	if chatJoinRequestsInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatJoinRequestsInfo")
+	p.int("total_count", int64(chatJoinRequestsInfo.TotalCount))
+	p.vector("user_ids", len(chatJoinRequestsInfo.UserIds), func(i0 int) {
+		p.int("user_ids", int64(chatJoinRequestsInfo.UserIds[i0]))
+	})
+	p.endObject(state)
+}
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ChatList Describes a list of chats
 type ChatList interface {
 	GetChatListEnum() ChatListEnum
+	Accept(visitor ChatListVisitor)
 }
 
 // ChatListEnum Alias for abstract ChatList 'Sub-Classes', used as constant-enum here
@@ -20,38 +22,112 @@ const (
 	ChatListFilterType  ChatListEnum = "chatListFilter"
 )
 
+// UnknownChatList is a ChatList of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownChatList struct {
+	Unknown
+}
+
+// GetChatListEnum return the enum type of this object
+func (unknownChatList *UnknownChatList) GetChatListEnum() ChatListEnum {
+	return ChatListEnum(unknownChatList.Type)
+}
+
+// Accept calls the method of the visitor for UnknownChatList
+func (unknownChatList *UnknownChatList) Accept(visitor ChatListVisitor) {
+	visitor.VisitUnknownChatList(unknownChatList)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownChatList *UnknownChatList) String() string {
+	return objectString(unknownChatList)
+}
+
+// LogValue logs the object as its summary
+func (unknownChatList *UnknownChatList) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownChatList))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownChatList *UnknownChatList) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownChatList)
+}
+
+func (unknownChatList *UnknownChatList) printText(p *printer) {
+	if unknownChatList == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownChatList")
+	p.string("@type", unknownChatList.Type)
+	p.endObject(state)
+}
+
 func unmarshalChatList(rawMsg *json.RawMessage) (ChatList, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch ChatListEnum(objMap["@type"].(string)) {
+	switch ChatListEnum(typeName) {
 	case ChatListMainType:
 		var chatListMain ChatListMain
-		err := json.Unmarshal(*rawMsg, &chatListMain)
+		err := jsonUnmarshal(*rawMsg, &chatListMain)
 		return &chatListMain, err
 
 	case ChatListArchiveType:
 		var chatListArchive ChatListArchive
-		err := json.Unmarshal(*rawMsg, &chatListArchive)
+		err := jsonUnmarshal(*rawMsg, &chatListArchive)
 		return &chatListArchive, err
 
 	case ChatListFilterType:
 		var chatListFilter ChatListFilter
-		err := json.Unmarshal(*rawMsg, &chatListFilter)
+		err := jsonUnmarshal(*rawMsg, &chatListFilter)
 		return &chatListFilter, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownChatList{Unknown: unknown}, nil
 	}
 }
 
+func unmarshalChatListSlice(rawMsg *json.RawMessage) ([]ChatList, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var rawItems []*json.RawMessage
+	err := jsonUnmarshal(*rawMsg, &rawItems)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]ChatList, 0, len(rawItems))
+	for _, rawItem := range rawItems {
+		item, err := unmarshalChatList(rawItem)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func decodeChatList(r *jsonReader) (ChatList, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalChatList(rawMsg)
+}
+
 // ChatListMain A main list of chats
 type ChatListMain struct {
 	tdCommon
@@ -63,7 +139,6 @@ func (chatListMain *ChatListMain) MessageType() string {
 }
 
 // NewChatListMain creates a new ChatListMain
-//
 func NewChatListMain() *ChatListMain {
 	chatListMainTemp := ChatListMain{
 		tdCommon: tdCommon{Type: "chatListMain"},
@@ -72,11 +147,107 @@ func NewChatListMain() *ChatListMain {
 	return &chatListMainTemp
 }
 
+// MarshalJSON marshals to json
+func (chatListMain *ChatListMain) MarshalJSON() ([]byte, error) {
+	return chatListMain.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatListMain *ChatListMain) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatListMain)
+}
+
+func (chatListMain *ChatListMain) appendJSON(b []byte) ([]byte, error) {
+	if chatListMain == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatListMain.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatListMain.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatListMain *ChatListMain) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatListMain.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatListMain.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatListMain *ChatListMain) Clone() *ChatListMain {
+	if chatListMain == nil {
+		return nil
+	}
+	clone := *chatListMain
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatListMain *ChatListMain) Equal(other *ChatListMain) bool {
+	if chatListMain == nil || other == nil {
+		return chatListMain == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatListMain *ChatListMain) Diff(other *ChatListMain) []Change {
+	return chatListMain.diff("", other, nil)
+}
+
+func (chatListMain *ChatListMain) diff(path string, other *ChatListMain, changes []Change) []Change {
+	if chatListMain == nil || other == nil {
+		if chatListMain != other {
+			changes = append(changes, Change{Path: path, Old: chatListMain, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatListMain *ChatListMain) String() string {
+	return objectString(chatListMain)
+}
+
+// LogValue logs the object as its summary
+func (chatListMain *ChatListMain) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatListMain))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatListMain *ChatListMain) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatListMain)
+}
+
+func (chatListMain *ChatListMain) printText(p *printer) {
+	if chatListMain == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatListMain")
+	p.endObject(state)
+}
+
 // GetChatListEnum return the enum type of this object
 func (chatListMain *ChatListMain) GetChatListEnum() ChatListEnum {
 	return ChatListMainType
 }
 
+// Accept calls the method of the visitor for ChatListMain
+func (chatListMain *ChatListMain) Accept(visitor ChatListVisitor) {
+	visitor.VisitChatListMain(chatListMain)
+}
+
 // ChatListArchive A list of chats usually located at the top of the main chat list. Unmuted chats are automatically moved from the Archive to the Main chat list when a new message arrives
 type ChatListArchive struct {
 	tdCommon
@@ -88,7 +259,6 @@ func (chatListArchive *ChatListArchive) MessageType() string {
 }
 
 // NewChatListArchive creates a new ChatListArchive
-//
 func NewChatListArchive() *ChatListArchive {
 	chatListArchiveTemp := ChatListArchive{
 		tdCommon: tdCommon{Type: "chatListArchive"},
@@ -97,11 +267,107 @@ func NewChatListArchive() *ChatListArchive {
 	return &chatListArchiveTemp
 }
 
+// MarshalJSON marshals to json
+func (chatListArchive *ChatListArchive) MarshalJSON() ([]byte, error) {
+	return chatListArchive.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatListArchive *ChatListArchive) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatListArchive)
+}
+
+func (chatListArchive *ChatListArchive) appendJSON(b []byte) ([]byte, error) {
+	if chatListArchive == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatListArchive.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatListArchive.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatListArchive *ChatListArchive) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatListArchive.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatListArchive.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatListArchive *ChatListArchive) Clone() *ChatListArchive {
+	if chatListArchive == nil {
+		return nil
+	}
+	clone := *chatListArchive
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatListArchive *ChatListArchive) Equal(other *ChatListArchive) bool {
+	if chatListArchive == nil || other == nil {
+		return chatListArchive == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatListArchive *ChatListArchive) Diff(other *ChatListArchive) []Change {
+	return chatListArchive.diff("", other, nil)
+}
+
+func (chatListArchive *ChatListArchive) diff(path string, other *ChatListArchive, changes []Change) []Change {
+	if chatListArchive == nil || other == nil {
+		if chatListArchive != other {
+			changes = append(changes, Change{Path: path, Old: chatListArchive, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatListArchive *ChatListArchive) String() string {
+	return objectString(chatListArchive)
+}
+
+// LogValue logs the object as its summary
+func (chatListArchive *ChatListArchive) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatListArchive))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatListArchive *ChatListArchive) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatListArchive)
+}
+
+func (chatListArchive *ChatListArchive) printText(p *printer) {
+	if chatListArchive == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatListArchive")
+	p.endObject(state)
+}
+
 // GetChatListEnum return the enum type of this object
 func (chatListArchive *ChatListArchive) GetChatListEnum() ChatListEnum {
 	return ChatListArchiveType
 }
 
+// Accept calls the method of the visitor for ChatListArchive
+func (chatListArchive *ChatListArchive) Accept(visitor ChatListVisitor) {
+	visitor.VisitChatListArchive(chatListArchive)
+}
+
 // ChatListFilter A list of chats belonging to a chat filter
 type ChatListFilter struct {
 	tdCommon
@@ -125,7 +391,224 @@ func NewChatListFilter(chatFilterId int32) *ChatListFilter {
 	return &chatListFilterTemp
 }
 
+// MarshalJSON marshals to json
+func (chatListFilter *ChatListFilter) MarshalJSON() ([]byte, error) {
+	return chatListFilter.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatListFilter *ChatListFilter) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatListFilter)
+}
+
+func (chatListFilter *ChatListFilter) appendJSON(b []byte) ([]byte, error) {
+	if chatListFilter == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatListFilter.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatListFilter.tdCommon.Extra)
+	b = append(b, `,"chat_filter_id":`...)
+	b = appendJSONInt(b, int64(chatListFilter.ChatFilterId))
+	return append(b, '}'), nil
+}
+
+func (chatListFilter *ChatListFilter) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatListFilter.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatListFilter.tdCommon.Extra, err = r.readString()
+		case "chat_filter_id":
+			chatListFilter.ChatFilterId, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatListFilter *ChatListFilter) Clone() *ChatListFilter {
+	if chatListFilter == nil {
+		return nil
+	}
+	clone := *chatListFilter
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatListFilter *ChatListFilter) Equal(other *ChatListFilter) bool {
+	if chatListFilter == nil || other == nil {
+		return chatListFilter == other
+	}
+	if chatListFilter.ChatFilterId != other.ChatFilterId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatListFilter *ChatListFilter) Diff(other *ChatListFilter) []Change {
+	return chatListFilter.diff("", other, nil)
+}
+
+func (chatListFilter *ChatListFilter) diff(path string, other *ChatListFilter, changes []Change) []Change {
+	if chatListFilter == nil || other == nil {
+		if chatListFilter != other {
+			changes = append(changes, Change{Path: path, Old: chatListFilter, New: other})
+		}
+		return changes
+	}
+	if chatListFilter.ChatFilterId != other.ChatFilterId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_filter_id"), Old: chatListFilter.ChatFilterId, New: other.ChatFilterId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatListFilter *ChatListFilter) String() string {
+	return objectString(chatListFilter)
+}
+
+// LogValue logs the object as its summary
+func (chatListFilter *ChatListFilter) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatListFilter))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatListFilter *ChatListFilter) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatListFilter)
+}
+
+func (chatListFilter *ChatListFilter) printText(p *printer) {
+	if chatListFilter == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatListFilter")
+	p.int("chat_filter_id", int64(chatListFilter.ChatFilterId))
+	p.endObject(state)
+}
+
 // GetChatListEnum return the enum type of this object
 func (chatListFilter *ChatListFilter) GetChatListEnum() ChatListEnum {
 	return ChatListFilterType
 }
+
+// Accept calls the method of the visitor for ChatListFilter
+func (chatListFilter *ChatListFilter) Accept(visitor ChatListVisitor) {
+	visitor.VisitChatListFilter(chatListFilter)
+}
+
+// ChatListVisitor visits every type of ChatList, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to ChatList, until they handle it too.
+type ChatListVisitor interface {
+	VisitChatListMain(chatListMain *ChatListMain)
+	VisitChatListArchive(chatListArchive *ChatListArchive)
+	VisitChatListFilter(chatListFilter *ChatListFilter)
+	VisitUnknownChatList(unknownChatList *UnknownChatList)
+}
+
+// ChatListCases holds the function MatchChatList calls for each type of ChatList;
+// types without a function are passed to the default case
+type ChatListCases[R any] struct {
+	ChatListMain    func(chatListMain *ChatListMain) R
+	ChatListArchive func(chatListArchive *ChatListArchive) R
+	ChatListFilter  func(chatListFilter *ChatListFilter) R
+}
+
+// MatchChatList calls the case of the type of chatList, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement ChatListVisitor
+// to have the compiler check every type is handled.
+func MatchChatList[R any](chatList ChatList, cases ChatListCases[R], defaultCase func(chatList ChatList) R) R {
+	switch value := chatList.(type) {
+	case *ChatListMain:
+		if cases.ChatListMain != nil {
+			return cases.ChatListMain(value)
+		}
+	case *ChatListArchive:
+		if cases.ChatListArchive != nil {
+			return cases.ChatListArchive(value)
+		}
+	case *ChatListFilter:
+		if cases.ChatListFilter != nil {
+			return cases.ChatListFilter(value)
+		}
+	}
+	return defaultCase(chatList)
+}
+
+// Clone returns a deep copy of the object
+func (unknownChatList *UnknownChatList) Clone() *UnknownChatList {
+	if unknownChatList == nil {
+		return nil
+	}
+	return &UnknownChatList{Unknown: unknownChatList.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownChatList *UnknownChatList) Equal(other *UnknownChatList) bool {
+	if unknownChatList == nil || other == nil {
+		return unknownChatList == other
+	}
+	return unknownChatList.Unknown.equal(other.Unknown)
+}
+
+func cloneChatList(chatList ChatList) ChatList {
+	switch value := chatList.(type) {
+	case *ChatListMain:
+		return value.Clone()
+	case *ChatListArchive:
+		return value.Clone()
+	case *ChatListFilter:
+		return value.Clone()
+	case *UnknownChatList:
+		return value.Clone()
+	}
+	return chatList
+}
+
+func equalChatList(a ChatList, b ChatList) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *ChatListMain:
+		b, ok := b.(*ChatListMain)
+		return ok && a.Equal(b)
+	case *ChatListArchive:
+		b, ok := b.(*ChatListArchive)
+		return ok && a.Equal(b)
+	case *ChatListFilter:
+		b, ok := b.(*ChatListFilter)
+		return ok && a.Equal(b)
+	case *UnknownChatList:
+		b, ok := b.(*UnknownChatList)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffChatList(path string, a ChatList, b ChatList, changes []Change) []Change {
+	switch a := a.(type) {
+	case *ChatListMain:
+		if b, ok := b.(*ChatListMain); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatListArchive:
+		if b, ok := b.(*ChatListArchive); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatListFilter:
+		if b, ok := b.(*ChatListFilter); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalChatList(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
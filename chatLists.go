@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ChatLists Contains a list of chat lists
@@ -28,6 +28,150 @@ func NewChatLists(chatLists []ChatList) *ChatLists {
 	return &chatListsTemp
 }
 
+// MarshalJSON marshals to json
+func (chatLists *ChatLists) MarshalJSON() ([]byte, error) {
+	return chatLists.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatLists *ChatLists) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatLists)
+}
+
+func (chatLists *ChatLists) appendJSON(b []byte) ([]byte, error) {
+	if chatLists == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatLists.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatLists.tdCommon.Extra)
+	b = append(b, `,"chat_lists":`...)
+	if chatLists.ChatLists == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range chatLists.ChatLists {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = appendJSONValue(b, chatLists.ChatLists[i0])
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (chatLists *ChatLists) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatLists.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatLists.tdCommon.Extra, err = r.readString()
+		case "chat_lists":
+			if r.readNull() {
+				chatLists.ChatLists = nil
+			} else {
+				chatLists.ChatLists = make([]ChatList, 0)
+				err = r.readArray(func() (err error) {
+					var item0 ChatList
+					item0, err = decodeChatList(r)
+					chatLists.ChatLists = append(chatLists.ChatLists, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatLists *ChatLists) Clone() *ChatLists {
+	if chatLists == nil {
+		return nil
+	}
+	clone := *chatLists
+	if chatLists.ChatLists != nil {
+		clone.ChatLists = make([]ChatList, len(chatLists.ChatLists))
+		for i0 := range chatLists.ChatLists {
+			clone.ChatLists[i0] = cloneChatList(chatLists.ChatLists[i0])
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatLists *ChatLists) Equal(other *ChatLists) bool {
+	if chatLists == nil || other == nil {
+		return chatLists == other
+	}
+	if len(chatLists.ChatLists) != len(other.ChatLists) {
+		return false
+	}
+	for i0 := range chatLists.ChatLists {
+		if !equalChatList(chatLists.ChatLists[i0], other.ChatLists[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatLists *ChatLists) Diff(other *ChatLists) []Change {
+	return chatLists.diff("", other, nil)
+}
+
+func (chatLists *ChatLists) diff(path string, other *ChatLists, changes []Change) []Change {
+	if chatLists == nil || other == nil {
+		if chatLists != other {
+			changes = append(changes, Change{Path: path, Old: chatLists, New: other})
+		}
+		return changes
+	}
+	if len(chatLists.ChatLists) != len(other.ChatLists) {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_lists"), Old: chatLists.ChatLists, New: other.ChatLists})
+	} else {
+		for i0 := range chatLists.ChatLists {
+			changes = diffChatList(indexPath(fieldPath(path, "chat_lists"), i0), chatLists.ChatLists[i0], other.ChatLists[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatLists *ChatLists) String() string {
+	return objectString(chatLists)
+}
+
+// LogValue logs the object as its summary
+func (chatLists *ChatLists) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatLists))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatLists *ChatLists) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatLists)
+}
+
+func (chatLists *ChatLists) printText(p *printer) {
+	if chatLists == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatLists")
+	p.vector("chat_lists", len(chatLists.ChatLists), func(i0 int) {
+		p.object("chat_lists", chatLists.ChatLists[i0])
+	})
+	p.endObject(state)
+}
+
 // GetChatListsToAddChat Returns chat lists to which the chat can be added. This is an offline request
 // @param chatId Chat identifier
 func (client *Client) GetChatListsToAddChat(chatId int64) (*ChatLists, error) {
@@ -40,11 +184,11 @@ func (client *Client) GetChatListsToAddChat(chatId int64) (*ChatLists, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chatLists ChatLists
-	err = json.Unmarshal(result.Raw, &chatLists)
+	err = jsonUnmarshal(result.Raw, &chatLists)
 	return &chatLists, err
 }
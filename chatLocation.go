@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // ChatLocation Represents a location to which a chat is connected
 type ChatLocation struct {
 	tdCommon
@@ -25,3 +30,124 @@ func NewChatLocation(location *Location, address string) *ChatLocation {
 
 	return &chatLocationTemp
 }
+
+// MarshalJSON marshals to json
+func (chatLocation *ChatLocation) MarshalJSON() ([]byte, error) {
+	return chatLocation.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatLocation *ChatLocation) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatLocation)
+}
+
+func (chatLocation *ChatLocation) appendJSON(b []byte) ([]byte, error) {
+	if chatLocation == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatLocation.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatLocation.tdCommon.Extra)
+	b = append(b, `,"location":`...)
+	b, err = chatLocation.Location.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"address":`...)
+	b = appendJSONString(b, chatLocation.Address)
+	return append(b, '}'), nil
+}
+
+func (chatLocation *ChatLocation) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatLocation.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatLocation.tdCommon.Extra, err = r.readString()
+		case "location":
+			if r.readNull() {
+				chatLocation.Location = nil
+			} else {
+				chatLocation.Location = new(Location)
+				err = chatLocation.Location.decodeJSON(r)
+			}
+		case "address":
+			chatLocation.Address, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatLocation *ChatLocation) Clone() *ChatLocation {
+	if chatLocation == nil {
+		return nil
+	}
+	clone := *chatLocation
+	clone.Location = chatLocation.Location.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatLocation *ChatLocation) Equal(other *ChatLocation) bool {
+	if chatLocation == nil || other == nil {
+		return chatLocation == other
+	}
+	if !chatLocation.Location.Equal(other.Location) {
+		return false
+	}
+	if chatLocation.Address != other.Address {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatLocation *ChatLocation) Diff(other *ChatLocation) []Change {
+	return chatLocation.diff("", other, nil)
+}
+
+func (chatLocation *ChatLocation) diff(path string, other *ChatLocation, changes []Change) []Change {
+	if chatLocation == nil || other == nil {
+		if chatLocation != other {
+			changes = append(changes, Change{Path: path, Old: chatLocation, New: other})
+		}
+		return changes
+	}
+	changes = chatLocation.Location.diff(fieldPath(path, "location"), other.Location, changes)
+	if chatLocation.Address != other.Address {
+		changes = append(changes, Change{Path: fieldPath(path, "address"), Old: chatLocation.Address, New: other.Address})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatLocation *ChatLocation) String() string {
+	return objectString(chatLocation)
+}
+
+// LogValue logs the object as its summary
+func (chatLocation *ChatLocation) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatLocation))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatLocation *ChatLocation) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatLocation)
+}
+
+func (chatLocation *ChatLocation) printText(p *printer) {
+	if chatLocation == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatLocation")
+	p.object("location", chatLocation.Location)
+	p.string("address", chatLocation.Address)
+	p.endObject(state)
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ChatMember Describes a user or a chat as a member of another chat
@@ -37,35 +37,144 @@ func NewChatMember(memberId MessageSender, inviterUserId int64, joinedChatDate i
 	return &chatMemberTemp
 }
 
+// MarshalJSON marshals to json
+func (chatMember *ChatMember) MarshalJSON() ([]byte, error) {
+	return chatMember.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (chatMember *ChatMember) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, chatMember)
+}
+
+func (chatMember *ChatMember) appendJSON(b []byte) ([]byte, error) {
+	if chatMember == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatMember.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatMember.tdCommon.Extra)
+	b = append(b, `,"member_id":`...)
+	b, err = appendJSONValue(b, chatMember.MemberId)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-		InviterUserId  int64 `json:"inviter_user_id"`  // Identifier of a user that invited/promoted/banned this member in the chat; 0 if unknown
-		JoinedChatDate int32 `json:"joined_chat_date"` // Point in time (Unix timestamp) when the user joined the chat
-
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	b = append(b, `,"inviter_user_id":`...)
+	b = appendJSONInt(b, int64(chatMember.InviterUserId))
+	b = append(b, `,"joined_chat_date":`...)
+	b = appendJSONInt(b, int64(chatMember.JoinedChatDate))
+	b = append(b, `,"status":`...)
+	b, err = appendJSONValue(b, chatMember.Status)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return append(b, '}'), nil
+}
 
-	chatMember.tdCommon = tempObj.tdCommon
-	chatMember.InviterUserId = tempObj.InviterUserId
-	chatMember.JoinedChatDate = tempObj.JoinedChatDate
+func (chatMember *ChatMember) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatMember.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatMember.tdCommon.Extra, err = r.readString()
+		case "member_id":
+			chatMember.MemberId, err = decodeMessageSender(r)
+		case "inviter_user_id":
+			chatMember.InviterUserId, err = r.readInt64()
+		case "joined_chat_date":
+			chatMember.JoinedChatDate, err = r.readInt32()
+		case "status":
+			chatMember.Status, err = decodeChatMemberStatus(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	fieldMemberId, _ := unmarshalMessageSender(objMap["member_id"])
-	chatMember.MemberId = fieldMemberId
+// Clone returns a deep copy of the object
+func (chatMember *ChatMember) Clone() *ChatMember {
+	if chatMember == nil {
+		return nil
+	}
+	clone := *chatMember
+	clone.MemberId = cloneMessageSender(chatMember.MemberId)
+	clone.Status = cloneChatMemberStatus(chatMember.Status)
+	return &clone
+}
 
-	fieldStatus, _ := unmarshalChatMemberStatus(objMap["status"])
-	chatMember.Status = fieldStatus
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatMember *ChatMember) Equal(other *ChatMember) bool {
+	if chatMember == nil || other == nil {
+		return chatMember == other
+	}
+	if !equalMessageSender(chatMember.MemberId, other.MemberId) {
+		return false
+	}
+	if chatMember.InviterUserId != other.InviterUserId {
+		return false
+	}
+	if chatMember.JoinedChatDate != other.JoinedChatDate {
+		return false
+	}
+	if !equalChatMemberStatus(chatMember.Status, other.Status) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatMember *ChatMember) Diff(other *ChatMember) []Change {
+	return chatMember.diff("", other, nil)
+}
+
+func (chatMember *ChatMember) diff(path string, other *ChatMember, changes []Change) []Change {
+	if chatMember == nil || other == nil {
+		if chatMember != other {
+			changes = append(changes, Change{Path: path, Old: chatMember, New: other})
+		}
+		return changes
+	}
+	changes = diffMessageSender(fieldPath(path, "member_id"), chatMember.MemberId, other.MemberId, changes)
+	if chatMember.InviterUserId != other.InviterUserId {
+		changes = append(changes, Change{Path: fieldPath(path, "inviter_user_id"), Old: chatMember.InviterUserId, New: other.InviterUserId})
+	}
+	if chatMember.JoinedChatDate != other.JoinedChatDate {
+		changes = append(changes, Change{Path: fieldPath(path, "joined_chat_date"), Old: chatMember.JoinedChatDate, New: other.JoinedChatDate})
+	}
+	changes = diffChatMemberStatus(fieldPath(path, "status"), chatMember.Status, other.Status, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatMember *ChatMember) String() string {
+	return objectString(chatMember)
+}
+
+// LogValue logs the object as its summary
+func (chatMember *ChatMember) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatMember))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatMember *ChatMember) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatMember)
+}
 
-	return nil
+func (chatMember *ChatMember) printText(p *printer) {
+	if chatMember == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatMember")
+	p.object("member_id", chatMember.MemberId)
+	p.int("inviter_user_id", int64(chatMember.InviterUserId))
+	p.int("joined_chat_date", int64(chatMember.JoinedChatDate))
+	p.object("status", chatMember.Status)
+	p.endObject(state)
 }
 
 // GetChatMember Returns information about a single member of a chat
@@ -82,11 +191,11 @@ func (client *Client) GetChatMember(chatId int64, memberId MessageSender) (*Chat
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chatMember ChatMember
-	err = json.Unmarshal(result.Raw, &chatMember)
+	err = jsonUnmarshal(result.Raw, &chatMember)
 	return &chatMember, err
 }
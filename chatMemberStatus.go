@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ChatMemberStatus Provides information about the status of a member in a chat
 type ChatMemberStatus interface {
 	GetChatMemberStatusEnum() ChatMemberStatusEnum
+	Accept(visitor ChatMemberStatusVisitor)
 }
 
 // ChatMemberStatusEnum Alias for abstract ChatMemberStatus 'Sub-Classes', used as constant-enum here
@@ -23,53 +25,104 @@ const (
 	ChatMemberStatusBannedType        ChatMemberStatusEnum = "chatMemberStatusBanned"
 )
 
+// UnknownChatMemberStatus is a ChatMemberStatus of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownChatMemberStatus struct {
+	Unknown
+}
+
+// GetChatMemberStatusEnum return the enum type of this object
+func (unknownChatMemberStatus *UnknownChatMemberStatus) GetChatMemberStatusEnum() ChatMemberStatusEnum {
+	return ChatMemberStatusEnum(unknownChatMemberStatus.Type)
+}
+
+// Accept calls the method of the visitor for UnknownChatMemberStatus
+func (unknownChatMemberStatus *UnknownChatMemberStatus) Accept(visitor ChatMemberStatusVisitor) {
+	visitor.VisitUnknownChatMemberStatus(unknownChatMemberStatus)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownChatMemberStatus *UnknownChatMemberStatus) String() string {
+	return objectString(unknownChatMemberStatus)
+}
+
+// LogValue logs the object as its summary
+func (unknownChatMemberStatus *UnknownChatMemberStatus) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownChatMemberStatus))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownChatMemberStatus *UnknownChatMemberStatus) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownChatMemberStatus)
+}
+
+func (unknownChatMemberStatus *UnknownChatMemberStatus) printText(p *printer) {
+	if unknownChatMemberStatus == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownChatMemberStatus")
+	p.string("@type", unknownChatMemberStatus.Type)
+	p.endObject(state)
+}
+
 func unmarshalChatMemberStatus(rawMsg *json.RawMessage) (ChatMemberStatus, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch ChatMemberStatusEnum(objMap["@type"].(string)) {
+	switch ChatMemberStatusEnum(typeName) {
 	case ChatMemberStatusCreatorType:
 		var chatMemberStatusCreator ChatMemberStatusCreator
-		err := json.Unmarshal(*rawMsg, &chatMemberStatusCreator)
+		err := jsonUnmarshal(*rawMsg, &chatMemberStatusCreator)
 		return &chatMemberStatusCreator, err
 
 	case ChatMemberStatusAdministratorType:
 		var chatMemberStatusAdministrator ChatMemberStatusAdministrator
-		err := json.Unmarshal(*rawMsg, &chatMemberStatusAdministrator)
+		err := jsonUnmarshal(*rawMsg, &chatMemberStatusAdministrator)
 		return &chatMemberStatusAdministrator, err
 
 	case ChatMemberStatusMemberType:
 		var chatMemberStatusMember ChatMemberStatusMember
-		err := json.Unmarshal(*rawMsg, &chatMemberStatusMember)
+		err := jsonUnmarshal(*rawMsg, &chatMemberStatusMember)
 		return &chatMemberStatusMember, err
 
 	case ChatMemberStatusRestrictedType:
 		var chatMemberStatusRestricted ChatMemberStatusRestricted
-		err := json.Unmarshal(*rawMsg, &chatMemberStatusRestricted)
+		err := jsonUnmarshal(*rawMsg, &chatMemberStatusRestricted)
 		return &chatMemberStatusRestricted, err
 
 	case ChatMemberStatusLeftType:
 		var chatMemberStatusLeft ChatMemberStatusLeft
-		err := json.Unmarshal(*rawMsg, &chatMemberStatusLeft)
+		err := jsonUnmarshal(*rawMsg, &chatMemberStatusLeft)
 		return &chatMemberStatusLeft, err
 
 	case ChatMemberStatusBannedType:
 		var chatMemberStatusBanned ChatMemberStatusBanned
-		err := json.Unmarshal(*rawMsg, &chatMemberStatusBanned)
+		err := jsonUnmarshal(*rawMsg, &chatMemberStatusBanned)
 		return &chatMemberStatusBanned, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownChatMemberStatus{Unknown: unknown}, nil
 	}
 }
 
+func decodeChatMemberStatus(r *jsonReader) (ChatMemberStatus, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalChatMemberStatus(rawMsg)
+}
+
 // ChatMemberStatusCreator The user is the owner of the chat and has all the administrator privileges
 type ChatMemberStatusCreator struct {
 	tdCommon
@@ -99,11 +152,140 @@ func NewChatMemberStatusCreator(customTitle string, isAnonymous bool, isMember b
 	return &chatMemberStatusCreatorTemp
 }
 
+// MarshalJSON marshals to json
+func (chatMemberStatusCreator *ChatMemberStatusCreator) MarshalJSON() ([]byte, error) {
+	return chatMemberStatusCreator.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatMemberStatusCreator *ChatMemberStatusCreator) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatMemberStatusCreator)
+}
+
+func (chatMemberStatusCreator *ChatMemberStatusCreator) appendJSON(b []byte) ([]byte, error) {
+	if chatMemberStatusCreator == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatMemberStatusCreator.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatMemberStatusCreator.tdCommon.Extra)
+	b = append(b, `,"custom_title":`...)
+	b = appendJSONString(b, chatMemberStatusCreator.CustomTitle)
+	b = append(b, `,"is_anonymous":`...)
+	b = appendJSONBool(b, chatMemberStatusCreator.IsAnonymous)
+	b = append(b, `,"is_member":`...)
+	b = appendJSONBool(b, chatMemberStatusCreator.IsMember)
+	return append(b, '}'), nil
+}
+
+func (chatMemberStatusCreator *ChatMemberStatusCreator) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatMemberStatusCreator.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatMemberStatusCreator.tdCommon.Extra, err = r.readString()
+		case "custom_title":
+			chatMemberStatusCreator.CustomTitle, err = r.readString()
+		case "is_anonymous":
+			chatMemberStatusCreator.IsAnonymous, err = r.readBool()
+		case "is_member":
+			chatMemberStatusCreator.IsMember, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatMemberStatusCreator *ChatMemberStatusCreator) Clone() *ChatMemberStatusCreator {
+	if chatMemberStatusCreator == nil {
+		return nil
+	}
+	clone := *chatMemberStatusCreator
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatMemberStatusCreator *ChatMemberStatusCreator) Equal(other *ChatMemberStatusCreator) bool {
+	if chatMemberStatusCreator == nil || other == nil {
+		return chatMemberStatusCreator == other
+	}
+	if chatMemberStatusCreator.CustomTitle != other.CustomTitle {
+		return false
+	}
+	if chatMemberStatusCreator.IsAnonymous != other.IsAnonymous {
+		return false
+	}
+	if chatMemberStatusCreator.IsMember != other.IsMember {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatMemberStatusCreator *ChatMemberStatusCreator) Diff(other *ChatMemberStatusCreator) []Change {
+	return chatMemberStatusCreator.diff("", other, nil)
+}
+
+func (chatMemberStatusCreator *ChatMemberStatusCreator) diff(path string, other *ChatMemberStatusCreator, changes []Change) []Change {
+	if chatMemberStatusCreator == nil || other == nil {
+		if chatMemberStatusCreator != other {
+			changes = append(changes, Change{Path: path, Old: chatMemberStatusCreator, New: other})
+		}
+		return changes
+	}
+	if chatMemberStatusCreator.CustomTitle != other.CustomTitle {
+		changes = append(changes, Change{Path: fieldPath(path, "custom_title"), Old: chatMemberStatusCreator.CustomTitle, New: other.CustomTitle})
+	}
+	if chatMemberStatusCreator.IsAnonymous != other.IsAnonymous {
+		changes = append(changes, Change{Path: fieldPath(path, "is_anonymous"), Old: chatMemberStatusCreator.IsAnonymous, New: other.IsAnonymous})
+	}
+	if chatMemberStatusCreator.IsMember != other.IsMember {
+		changes = append(changes, Change{Path: fieldPath(path, "is_member"), Old: chatMemberStatusCreator.IsMember, New: other.IsMember})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatMemberStatusCreator *ChatMemberStatusCreator) String() string {
+	return objectString(chatMemberStatusCreator)
+}
+
+// LogValue logs the object as its summary
+func (chatMemberStatusCreator *ChatMemberStatusCreator) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatMemberStatusCreator))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatMemberStatusCreator *ChatMemberStatusCreator) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatMemberStatusCreator)
+}
+
+func (chatMemberStatusCreator *ChatMemberStatusCreator) printText(p *printer) {
+	if chatMemberStatusCreator == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatMemberStatusCreator")
+	p.string("custom_title", chatMemberStatusCreator.CustomTitle)
+	p.bool("is_anonymous", chatMemberStatusCreator.IsAnonymous)
+	p.bool("is_member", chatMemberStatusCreator.IsMember)
+	p.endObject(state)
+}
+
 // GetChatMemberStatusEnum return the enum type of this object
 func (chatMemberStatusCreator *ChatMemberStatusCreator) GetChatMemberStatusEnum() ChatMemberStatusEnum {
 	return ChatMemberStatusCreatorType
 }
 
+// Accept calls the method of the visitor for ChatMemberStatusCreator
+func (chatMemberStatusCreator *ChatMemberStatusCreator) Accept(visitor ChatMemberStatusVisitor) {
+	visitor.VisitChatMemberStatusCreator(chatMemberStatusCreator)
+}
+
 // ChatMemberStatusAdministrator The user is a member of the chat and has some additional privileges. In basic groups, administrators can edit and delete messages sent by others, add new members, ban unprivileged members, and manage video chats. In supergroups and channels, there are more detailed options for administrator privileges
 type ChatMemberStatusAdministrator struct {
 	tdCommon
@@ -163,11 +345,250 @@ func NewChatMemberStatusAdministrator(customTitle string, canBeEdited bool, canM
 	return &chatMemberStatusAdministratorTemp
 }
 
+// MarshalJSON marshals to json
+func (chatMemberStatusAdministrator *ChatMemberStatusAdministrator) MarshalJSON() ([]byte, error) {
+	return chatMemberStatusAdministrator.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatMemberStatusAdministrator *ChatMemberStatusAdministrator) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatMemberStatusAdministrator)
+}
+
+func (chatMemberStatusAdministrator *ChatMemberStatusAdministrator) appendJSON(b []byte) ([]byte, error) {
+	if chatMemberStatusAdministrator == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatMemberStatusAdministrator.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatMemberStatusAdministrator.tdCommon.Extra)
+	b = append(b, `,"custom_title":`...)
+	b = appendJSONString(b, chatMemberStatusAdministrator.CustomTitle)
+	b = append(b, `,"can_be_edited":`...)
+	b = appendJSONBool(b, chatMemberStatusAdministrator.CanBeEdited)
+	b = append(b, `,"can_manage_chat":`...)
+	b = appendJSONBool(b, chatMemberStatusAdministrator.CanManageChat)
+	b = append(b, `,"can_change_info":`...)
+	b = appendJSONBool(b, chatMemberStatusAdministrator.CanChangeInfo)
+	b = append(b, `,"can_post_messages":`...)
+	b = appendJSONBool(b, chatMemberStatusAdministrator.CanPostMessages)
+	b = append(b, `,"can_edit_messages":`...)
+	b = appendJSONBool(b, chatMemberStatusAdministrator.CanEditMessages)
+	b = append(b, `,"can_delete_messages":`...)
+	b = appendJSONBool(b, chatMemberStatusAdministrator.CanDeleteMessages)
+	b = append(b, `,"can_invite_users":`...)
+	b = appendJSONBool(b, chatMemberStatusAdministrator.CanInviteUsers)
+	b = append(b, `,"can_restrict_members":`...)
+	b = appendJSONBool(b, chatMemberStatusAdministrator.CanRestrictMembers)
+	b = append(b, `,"can_pin_messages":`...)
+	b = appendJSONBool(b, chatMemberStatusAdministrator.CanPinMessages)
+	b = append(b, `,"can_promote_members":`...)
+	b = appendJSONBool(b, chatMemberStatusAdministrator.CanPromoteMembers)
+	b = append(b, `,"can_manage_video_chats":`...)
+	b = appendJSONBool(b, chatMemberStatusAdministrator.CanManageVideoChats)
+	b = append(b, `,"is_anonymous":`...)
+	b = appendJSONBool(b, chatMemberStatusAdministrator.IsAnonymous)
+	return append(b, '}'), nil
+}
+
+func (chatMemberStatusAdministrator *ChatMemberStatusAdministrator) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatMemberStatusAdministrator.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatMemberStatusAdministrator.tdCommon.Extra, err = r.readString()
+		case "custom_title":
+			chatMemberStatusAdministrator.CustomTitle, err = r.readString()
+		case "can_be_edited":
+			chatMemberStatusAdministrator.CanBeEdited, err = r.readBool()
+		case "can_manage_chat":
+			chatMemberStatusAdministrator.CanManageChat, err = r.readBool()
+		case "can_change_info":
+			chatMemberStatusAdministrator.CanChangeInfo, err = r.readBool()
+		case "can_post_messages":
+			chatMemberStatusAdministrator.CanPostMessages, err = r.readBool()
+		case "can_edit_messages":
+			chatMemberStatusAdministrator.CanEditMessages, err = r.readBool()
+		case "can_delete_messages":
+			chatMemberStatusAdministrator.CanDeleteMessages, err = r.readBool()
+		case "can_invite_users":
+			chatMemberStatusAdministrator.CanInviteUsers, err = r.readBool()
+		case "can_restrict_members":
+			chatMemberStatusAdministrator.CanRestrictMembers, err = r.readBool()
+		case "can_pin_messages":
+			chatMemberStatusAdministrator.CanPinMessages, err = r.readBool()
+		case "can_promote_members":
+			chatMemberStatusAdministrator.CanPromoteMembers, err = r.readBool()
+		case "can_manage_video_chats":
+			chatMemberStatusAdministrator.CanManageVideoChats, err = r.readBool()
+		case "is_anonymous":
+			chatMemberStatusAdministrator.IsAnonymous, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatMemberStatusAdministrator *ChatMemberStatusAdministrator) Clone() *ChatMemberStatusAdministrator {
+	if chatMemberStatusAdministrator == nil {
+		return nil
+	}
+	clone := *chatMemberStatusAdministrator
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatMemberStatusAdministrator *ChatMemberStatusAdministrator) Equal(other *ChatMemberStatusAdministrator) bool {
+	if chatMemberStatusAdministrator == nil || other == nil {
+		return chatMemberStatusAdministrator == other
+	}
+	if chatMemberStatusAdministrator.CustomTitle != other.CustomTitle {
+		return false
+	}
+	if chatMemberStatusAdministrator.CanBeEdited != other.CanBeEdited {
+		return false
+	}
+	if chatMemberStatusAdministrator.CanManageChat != other.CanManageChat {
+		return false
+	}
+	if chatMemberStatusAdministrator.CanChangeInfo != other.CanChangeInfo {
+		return false
+	}
+	if chatMemberStatusAdministrator.CanPostMessages != other.CanPostMessages {
+		return false
+	}
+	if chatMemberStatusAdministrator.CanEditMessages != other.CanEditMessages {
+		return false
+	}
+	if chatMemberStatusAdministrator.CanDeleteMessages != other.CanDeleteMessages {
+		return false
+	}
+	if chatMemberStatusAdministrator.CanInviteUsers != other.CanInviteUsers {
+		return false
+	}
+	if chatMemberStatusAdministrator.CanRestrictMembers != other.CanRestrictMembers {
+		return false
+	}
+	if chatMemberStatusAdministrator.CanPinMessages != other.CanPinMessages {
+		return false
+	}
+	if chatMemberStatusAdministrator.CanPromoteMembers != other.CanPromoteMembers {
+		return false
+	}
+	if chatMemberStatusAdministrator.CanManageVideoChats != other.CanManageVideoChats {
+		return false
+	}
+	if chatMemberStatusAdministrator.IsAnonymous != other.IsAnonymous {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatMemberStatusAdministrator *ChatMemberStatusAdministrator) Diff(other *ChatMemberStatusAdministrator) []Change {
+	return chatMemberStatusAdministrator.diff("", other, nil)
+}
+
+func (chatMemberStatusAdministrator *ChatMemberStatusAdministrator) diff(path string, other *ChatMemberStatusAdministrator, changes []Change) []Change {
+	if chatMemberStatusAdministrator == nil || other == nil {
+		if chatMemberStatusAdministrator != other {
+			changes = append(changes, Change{Path: path, Old: chatMemberStatusAdministrator, New: other})
+		}
+		return changes
+	}
+	if chatMemberStatusAdministrator.CustomTitle != other.CustomTitle {
+		changes = append(changes, Change{Path: fieldPath(path, "custom_title"), Old: chatMemberStatusAdministrator.CustomTitle, New: other.CustomTitle})
+	}
+	if chatMemberStatusAdministrator.CanBeEdited != other.CanBeEdited {
+		changes = append(changes, Change{Path: fieldPath(path, "can_be_edited"), Old: chatMemberStatusAdministrator.CanBeEdited, New: other.CanBeEdited})
+	}
+	if chatMemberStatusAdministrator.CanManageChat != other.CanManageChat {
+		changes = append(changes, Change{Path: fieldPath(path, "can_manage_chat"), Old: chatMemberStatusAdministrator.CanManageChat, New: other.CanManageChat})
+	}
+	if chatMemberStatusAdministrator.CanChangeInfo != other.CanChangeInfo {
+		changes = append(changes, Change{Path: fieldPath(path, "can_change_info"), Old: chatMemberStatusAdministrator.CanChangeInfo, New: other.CanChangeInfo})
+	}
+	if chatMemberStatusAdministrator.CanPostMessages != other.CanPostMessages {
+		changes = append(changes, Change{Path: fieldPath(path, "can_post_messages"), Old: chatMemberStatusAdministrator.CanPostMessages, New: other.CanPostMessages})
+	}
+	if chatMemberStatusAdministrator.CanEditMessages != other.CanEditMessages {
+		changes = append(changes, Change{Path: fieldPath(path, "can_edit_messages"), Old: chatMemberStatusAdministrator.CanEditMessages, New: other.CanEditMessages})
+	}
+	if chatMemberStatusAdministrator.CanDeleteMessages != other.CanDeleteMessages {
+		changes = append(changes, Change{Path: fieldPath(path, "can_delete_messages"), Old: chatMemberStatusAdministrator.CanDeleteMessages, New: other.CanDeleteMessages})
+	}
+	if chatMemberStatusAdministrator.CanInviteUsers != other.CanInviteUsers {
+		changes = append(changes, Change{Path: fieldPath(path, "can_invite_users"), Old: chatMemberStatusAdministrator.CanInviteUsers, New: other.CanInviteUsers})
+	}
+	if chatMemberStatusAdministrator.CanRestrictMembers != other.CanRestrictMembers {
+		changes = append(changes, Change{Path: fieldPath(path, "can_restrict_members"), Old: chatMemberStatusAdministrator.CanRestrictMembers, New: other.CanRestrictMembers})
+	}
+	if chatMemberStatusAdministrator.CanPinMessages != other.CanPinMessages {
+		changes = append(changes, Change{Path: fieldPath(path, "can_pin_messages"), Old: chatMemberStatusAdministrator.CanPinMessages, New: other.CanPinMessages})
+	}
+	if chatMemberStatusAdministrator.CanPromoteMembers != other.CanPromoteMembers {
+		changes = append(changes, Change{Path: fieldPath(path, "can_promote_members"), Old: chatMemberStatusAdministrator.CanPromoteMembers, New: other.CanPromoteMembers})
+	}
+	if chatMemberStatusAdministrator.CanManageVideoChats != other.CanManageVideoChats {
+		changes = append(changes, Change{Path: fieldPath(path, "can_manage_video_chats"), Old: chatMemberStatusAdministrator.CanManageVideoChats, New: other.CanManageVideoChats})
+	}
+	if chatMemberStatusAdministrator.IsAnonymous != other.IsAnonymous {
+		changes = append(changes, Change{Path: fieldPath(path, "is_anonymous"), Old: chatMemberStatusAdministrator.IsAnonymous, New: other.IsAnonymous})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatMemberStatusAdministrator *ChatMemberStatusAdministrator) String() string {
+	return objectString(chatMemberStatusAdministrator)
+}
+
+// LogValue logs the object as its summary
+func (chatMemberStatusAdministrator *ChatMemberStatusAdministrator) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatMemberStatusAdministrator))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatMemberStatusAdministrator *ChatMemberStatusAdministrator) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatMemberStatusAdministrator)
+}
+
+func (chatMemberStatusAdministrator *ChatMemberStatusAdministrator) printText(p *printer) {
+	if chatMemberStatusAdministrator == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatMemberStatusAdministrator")
+	p.string("custom_title", chatMemberStatusAdministrator.CustomTitle)
+	p.bool("can_be_edited", chatMemberStatusAdministrator.CanBeEdited)
+	p.bool("can_manage_chat", chatMemberStatusAdministrator.CanManageChat)
+	p.bool("can_change_info", chatMemberStatusAdministrator.CanChangeInfo)
+	p.bool("can_post_messages", chatMemberStatusAdministrator.CanPostMessages)
+	p.bool("can_edit_messages", chatMemberStatusAdministrator.CanEditMessages)
+	p.bool("can_delete_messages", chatMemberStatusAdministrator.CanDeleteMessages)
+	p.bool("can_invite_users", chatMemberStatusAdministrator.CanInviteUsers)
+	p.bool("can_restrict_members", chatMemberStatusAdministrator.CanRestrictMembers)
+	p.bool("can_pin_messages", chatMemberStatusAdministrator.CanPinMessages)
+	p.bool("can_promote_members", chatMemberStatusAdministrator.CanPromoteMembers)
+	p.bool("can_manage_video_chats", chatMemberStatusAdministrator.CanManageVideoChats)
+	p.bool("is_anonymous", chatMemberStatusAdministrator.IsAnonymous)
+	p.endObject(state)
+}
+
 // GetChatMemberStatusEnum return the enum type of this object
 func (chatMemberStatusAdministrator *ChatMemberStatusAdministrator) GetChatMemberStatusEnum() ChatMemberStatusEnum {
 	return ChatMemberStatusAdministratorType
 }
 
+// Accept calls the method of the visitor for ChatMemberStatusAdministrator
+func (chatMemberStatusAdministrator *ChatMemberStatusAdministrator) Accept(visitor ChatMemberStatusVisitor) {
+	visitor.VisitChatMemberStatusAdministrator(chatMemberStatusAdministrator)
+}
+
 // ChatMemberStatusMember The user is a member of the chat, without any additional privileges or restrictions
 type ChatMemberStatusMember struct {
 	tdCommon
@@ -179,7 +600,6 @@ func (chatMemberStatusMember *ChatMemberStatusMember) MessageType() string {
 }
 
 // NewChatMemberStatusMember creates a new ChatMemberStatusMember
-//
 func NewChatMemberStatusMember() *ChatMemberStatusMember {
 	chatMemberStatusMemberTemp := ChatMemberStatusMember{
 		tdCommon: tdCommon{Type: "chatMemberStatusMember"},
@@ -188,11 +608,107 @@ func NewChatMemberStatusMember() *ChatMemberStatusMember {
 	return &chatMemberStatusMemberTemp
 }
 
+// MarshalJSON marshals to json
+func (chatMemberStatusMember *ChatMemberStatusMember) MarshalJSON() ([]byte, error) {
+	return chatMemberStatusMember.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatMemberStatusMember *ChatMemberStatusMember) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatMemberStatusMember)
+}
+
+func (chatMemberStatusMember *ChatMemberStatusMember) appendJSON(b []byte) ([]byte, error) {
+	if chatMemberStatusMember == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatMemberStatusMember.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatMemberStatusMember.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatMemberStatusMember *ChatMemberStatusMember) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatMemberStatusMember.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatMemberStatusMember.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatMemberStatusMember *ChatMemberStatusMember) Clone() *ChatMemberStatusMember {
+	if chatMemberStatusMember == nil {
+		return nil
+	}
+	clone := *chatMemberStatusMember
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatMemberStatusMember *ChatMemberStatusMember) Equal(other *ChatMemberStatusMember) bool {
+	if chatMemberStatusMember == nil || other == nil {
+		return chatMemberStatusMember == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatMemberStatusMember *ChatMemberStatusMember) Diff(other *ChatMemberStatusMember) []Change {
+	return chatMemberStatusMember.diff("", other, nil)
+}
+
+func (chatMemberStatusMember *ChatMemberStatusMember) diff(path string, other *ChatMemberStatusMember, changes []Change) []Change {
+	if chatMemberStatusMember == nil || other == nil {
+		if chatMemberStatusMember != other {
+			changes = append(changes, Change{Path: path, Old: chatMemberStatusMember, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatMemberStatusMember *ChatMemberStatusMember) String() string {
+	return objectString(chatMemberStatusMember)
+}
+
+// LogValue logs the object as its summary
+func (chatMemberStatusMember *ChatMemberStatusMember) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatMemberStatusMember))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatMemberStatusMember *ChatMemberStatusMember) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatMemberStatusMember)
+}
+
+func (chatMemberStatusMember *ChatMemberStatusMember) printText(p *printer) {
+	if chatMemberStatusMember == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatMemberStatusMember")
+	p.endObject(state)
+}
+
 // GetChatMemberStatusEnum return the enum type of this object
 func (chatMemberStatusMember *ChatMemberStatusMember) GetChatMemberStatusEnum() ChatMemberStatusEnum {
 	return ChatMemberStatusMemberType
 }
 
+// Accept calls the method of the visitor for ChatMemberStatusMember
+func (chatMemberStatusMember *ChatMemberStatusMember) Accept(visitor ChatMemberStatusVisitor) {
+	visitor.VisitChatMemberStatusMember(chatMemberStatusMember)
+}
+
 // ChatMemberStatusRestricted The user is under certain restrictions in the chat. Not supported in basic groups and channels
 type ChatMemberStatusRestricted struct {
 	tdCommon
@@ -222,11 +738,148 @@ func NewChatMemberStatusRestricted(isMember bool, restrictedUntilDate int32, per
 	return &chatMemberStatusRestrictedTemp
 }
 
+// MarshalJSON marshals to json
+func (chatMemberStatusRestricted *ChatMemberStatusRestricted) MarshalJSON() ([]byte, error) {
+	return chatMemberStatusRestricted.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatMemberStatusRestricted *ChatMemberStatusRestricted) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatMemberStatusRestricted)
+}
+
+func (chatMemberStatusRestricted *ChatMemberStatusRestricted) appendJSON(b []byte) ([]byte, error) {
+	if chatMemberStatusRestricted == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatMemberStatusRestricted.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatMemberStatusRestricted.tdCommon.Extra)
+	b = append(b, `,"is_member":`...)
+	b = appendJSONBool(b, chatMemberStatusRestricted.IsMember)
+	b = append(b, `,"restricted_until_date":`...)
+	b = appendJSONInt(b, int64(chatMemberStatusRestricted.RestrictedUntilDate))
+	b = append(b, `,"permissions":`...)
+	b, err = chatMemberStatusRestricted.Permissions.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (chatMemberStatusRestricted *ChatMemberStatusRestricted) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatMemberStatusRestricted.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatMemberStatusRestricted.tdCommon.Extra, err = r.readString()
+		case "is_member":
+			chatMemberStatusRestricted.IsMember, err = r.readBool()
+		case "restricted_until_date":
+			chatMemberStatusRestricted.RestrictedUntilDate, err = r.readInt32()
+		case "permissions":
+			if r.readNull() {
+				chatMemberStatusRestricted.Permissions = nil
+			} else {
+				chatMemberStatusRestricted.Permissions = new(ChatPermissions)
+				err = chatMemberStatusRestricted.Permissions.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatMemberStatusRestricted *ChatMemberStatusRestricted) Clone() *ChatMemberStatusRestricted {
+	if chatMemberStatusRestricted == nil {
+		return nil
+	}
+	clone := *chatMemberStatusRestricted
+	clone.Permissions = chatMemberStatusRestricted.Permissions.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatMemberStatusRestricted *ChatMemberStatusRestricted) Equal(other *ChatMemberStatusRestricted) bool {
+	if chatMemberStatusRestricted == nil || other == nil {
+		return chatMemberStatusRestricted == other
+	}
+	if chatMemberStatusRestricted.IsMember != other.IsMember {
+		return false
+	}
+	if chatMemberStatusRestricted.RestrictedUntilDate != other.RestrictedUntilDate {
+		return false
+	}
+	if !chatMemberStatusRestricted.Permissions.Equal(other.Permissions) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatMemberStatusRestricted *ChatMemberStatusRestricted) Diff(other *ChatMemberStatusRestricted) []Change {
+	return chatMemberStatusRestricted.diff("", other, nil)
+}
+
+func (chatMemberStatusRestricted *ChatMemberStatusRestricted) diff(path string, other *ChatMemberStatusRestricted, changes []Change) []Change {
+	if chatMemberStatusRestricted == nil || other == nil {
+		if chatMemberStatusRestricted != other {
+			changes = append(changes, Change{Path: path, Old: chatMemberStatusRestricted, New: other})
+		}
+		return changes
+	}
+	if chatMemberStatusRestricted.IsMember != other.IsMember {
+		changes = append(changes, Change{Path: fieldPath(path, "is_member"), Old: chatMemberStatusRestricted.IsMember, New: other.IsMember})
+	}
+	if chatMemberStatusRestricted.RestrictedUntilDate != other.RestrictedUntilDate {
+		changes = append(changes, Change{Path: fieldPath(path, "restricted_until_date"), Old: chatMemberStatusRestricted.RestrictedUntilDate, New: other.RestrictedUntilDate})
+	}
+	changes = chatMemberStatusRestricted.Permissions.diff(fieldPath(path, "permissions"), other.Permissions, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatMemberStatusRestricted *ChatMemberStatusRestricted) String() string {
+	return objectString(chatMemberStatusRestricted)
+}
+
+// LogValue logs the object as its summary
+func (chatMemberStatusRestricted *ChatMemberStatusRestricted) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatMemberStatusRestricted))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatMemberStatusRestricted *ChatMemberStatusRestricted) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatMemberStatusRestricted)
+}
+
+func (chatMemberStatusRestricted *ChatMemberStatusRestricted) printText(p *printer) {
+	if chatMemberStatusRestricted == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatMemberStatusRestricted")
+	p.bool("is_member", chatMemberStatusRestricted.IsMember)
+	p.int("restricted_until_date", int64(chatMemberStatusRestricted.RestrictedUntilDate))
+	p.object("permissions", chatMemberStatusRestricted.Permissions)
+	p.endObject(state)
+}
+
 // GetChatMemberStatusEnum return the enum type of this object
 func (chatMemberStatusRestricted *ChatMemberStatusRestricted) GetChatMemberStatusEnum() ChatMemberStatusEnum {
 	return ChatMemberStatusRestrictedType
 }
 
+// Accept calls the method of the visitor for ChatMemberStatusRestricted
+func (chatMemberStatusRestricted *ChatMemberStatusRestricted) Accept(visitor ChatMemberStatusVisitor) {
+	visitor.VisitChatMemberStatusRestricted(chatMemberStatusRestricted)
+}
+
 // ChatMemberStatusLeft The user or the chat is not a chat member
 type ChatMemberStatusLeft struct {
 	tdCommon
@@ -238,7 +891,6 @@ func (chatMemberStatusLeft *ChatMemberStatusLeft) MessageType() string {
 }
 
 // NewChatMemberStatusLeft creates a new ChatMemberStatusLeft
-//
 func NewChatMemberStatusLeft() *ChatMemberStatusLeft {
 	chatMemberStatusLeftTemp := ChatMemberStatusLeft{
 		tdCommon: tdCommon{Type: "chatMemberStatusLeft"},
@@ -247,11 +899,107 @@ func NewChatMemberStatusLeft() *ChatMemberStatusLeft {
 	return &chatMemberStatusLeftTemp
 }
 
+// MarshalJSON marshals to json
+func (chatMemberStatusLeft *ChatMemberStatusLeft) MarshalJSON() ([]byte, error) {
+	return chatMemberStatusLeft.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatMemberStatusLeft *ChatMemberStatusLeft) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatMemberStatusLeft)
+}
+
+func (chatMemberStatusLeft *ChatMemberStatusLeft) appendJSON(b []byte) ([]byte, error) {
+	if chatMemberStatusLeft == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatMemberStatusLeft.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatMemberStatusLeft.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatMemberStatusLeft *ChatMemberStatusLeft) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatMemberStatusLeft.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatMemberStatusLeft.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatMemberStatusLeft *ChatMemberStatusLeft) Clone() *ChatMemberStatusLeft {
+	if chatMemberStatusLeft == nil {
+		return nil
+	}
+	clone := *chatMemberStatusLeft
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatMemberStatusLeft *ChatMemberStatusLeft) Equal(other *ChatMemberStatusLeft) bool {
+	if chatMemberStatusLeft == nil || other == nil {
+		return chatMemberStatusLeft == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatMemberStatusLeft *ChatMemberStatusLeft) Diff(other *ChatMemberStatusLeft) []Change {
+	return chatMemberStatusLeft.diff("", other, nil)
+}
+
+func (chatMemberStatusLeft *ChatMemberStatusLeft) diff(path string, other *ChatMemberStatusLeft, changes []Change) []Change {
+	if chatMemberStatusLeft == nil || other == nil {
+		if chatMemberStatusLeft != other {
+			changes = append(changes, Change{Path: path, Old: chatMemberStatusLeft, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatMemberStatusLeft *ChatMemberStatusLeft) String() string {
+	return objectString(chatMemberStatusLeft)
+}
+
+// LogValue logs the object as its summary
+func (chatMemberStatusLeft *ChatMemberStatusLeft) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatMemberStatusLeft))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatMemberStatusLeft *ChatMemberStatusLeft) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatMemberStatusLeft)
+}
+
+func (chatMemberStatusLeft *ChatMemberStatusLeft) printText(p *printer) {
+	if chatMemberStatusLeft == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatMemberStatusLeft")
+	p.endObject(state)
+}
+
 // GetChatMemberStatusEnum return the enum type of this object
 func (chatMemberStatusLeft *ChatMemberStatusLeft) GetChatMemberStatusEnum() ChatMemberStatusEnum {
 	return ChatMemberStatusLeftType
 }
 
+// Accept calls the method of the visitor for ChatMemberStatusLeft
+func (chatMemberStatusLeft *ChatMemberStatusLeft) Accept(visitor ChatMemberStatusVisitor) {
+	visitor.VisitChatMemberStatusLeft(chatMemberStatusLeft)
+}
+
 // ChatMemberStatusBanned The user or the chat was banned (and hence is not a member of the chat). Implies the user can't return to the chat, view messages, or be used as a participant identifier to join a video chat of the chat
 type ChatMemberStatusBanned struct {
 	tdCommon
@@ -275,7 +1023,269 @@ func NewChatMemberStatusBanned(bannedUntilDate int32) *ChatMemberStatusBanned {
 	return &chatMemberStatusBannedTemp
 }
 
+// MarshalJSON marshals to json
+func (chatMemberStatusBanned *ChatMemberStatusBanned) MarshalJSON() ([]byte, error) {
+	return chatMemberStatusBanned.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatMemberStatusBanned *ChatMemberStatusBanned) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatMemberStatusBanned)
+}
+
+func (chatMemberStatusBanned *ChatMemberStatusBanned) appendJSON(b []byte) ([]byte, error) {
+	if chatMemberStatusBanned == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatMemberStatusBanned.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatMemberStatusBanned.tdCommon.Extra)
+	b = append(b, `,"banned_until_date":`...)
+	b = appendJSONInt(b, int64(chatMemberStatusBanned.BannedUntilDate))
+	return append(b, '}'), nil
+}
+
+func (chatMemberStatusBanned *ChatMemberStatusBanned) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatMemberStatusBanned.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatMemberStatusBanned.tdCommon.Extra, err = r.readString()
+		case "banned_until_date":
+			chatMemberStatusBanned.BannedUntilDate, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatMemberStatusBanned *ChatMemberStatusBanned) Clone() *ChatMemberStatusBanned {
+	if chatMemberStatusBanned == nil {
+		return nil
+	}
+	clone := *chatMemberStatusBanned
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatMemberStatusBanned *ChatMemberStatusBanned) Equal(other *ChatMemberStatusBanned) bool {
+	if chatMemberStatusBanned == nil || other == nil {
+		return chatMemberStatusBanned == other
+	}
+	if chatMemberStatusBanned.BannedUntilDate != other.BannedUntilDate {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatMemberStatusBanned *ChatMemberStatusBanned) Diff(other *ChatMemberStatusBanned) []Change {
+	return chatMemberStatusBanned.diff("", other, nil)
+}
+
+func (chatMemberStatusBanned *ChatMemberStatusBanned) diff(path string, other *ChatMemberStatusBanned, changes []Change) []Change {
+	if chatMemberStatusBanned == nil || other == nil {
+		if chatMemberStatusBanned != other {
+			changes = append(changes, Change{Path: path, Old: chatMemberStatusBanned, New: other})
+		}
+		return changes
+	}
+	if chatMemberStatusBanned.BannedUntilDate != other.BannedUntilDate {
+		changes = append(changes, Change{Path: fieldPath(path, "banned_until_date"), Old: chatMemberStatusBanned.BannedUntilDate, New: other.BannedUntilDate})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatMemberStatusBanned *ChatMemberStatusBanned) String() string {
+	return objectString(chatMemberStatusBanned)
+}
+
+// LogValue logs the object as its summary
+func (chatMemberStatusBanned *ChatMemberStatusBanned) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatMemberStatusBanned))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatMemberStatusBanned *ChatMemberStatusBanned) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatMemberStatusBanned)
+}
+
+func (chatMemberStatusBanned *ChatMemberStatusBanned) printText(p *printer) {
+	if chatMemberStatusBanned == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatMemberStatusBanned")
+	p.int("banned_until_date", int64(chatMemberStatusBanned.BannedUntilDate))
+	p.endObject(state)
+}
+
 // GetChatMemberStatusEnum return the enum type of this object
 func (chatMemberStatusBanned *ChatMemberStatusBanned) GetChatMemberStatusEnum() ChatMemberStatusEnum {
 	return ChatMemberStatusBannedType
 }
+
+// Accept calls the method of the visitor for ChatMemberStatusBanned
+func (chatMemberStatusBanned *ChatMemberStatusBanned) Accept(visitor ChatMemberStatusVisitor) {
+	visitor.VisitChatMemberStatusBanned(chatMemberStatusBanned)
+}
+
+// ChatMemberStatusVisitor visits every type of ChatMemberStatus, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to ChatMemberStatus, until they handle it too.
+type ChatMemberStatusVisitor interface {
+	VisitChatMemberStatusCreator(chatMemberStatusCreator *ChatMemberStatusCreator)
+	VisitChatMemberStatusAdministrator(chatMemberStatusAdministrator *ChatMemberStatusAdministrator)
+	VisitChatMemberStatusMember(chatMemberStatusMember *ChatMemberStatusMember)
+	VisitChatMemberStatusRestricted(chatMemberStatusRestricted *ChatMemberStatusRestricted)
+	VisitChatMemberStatusLeft(chatMemberStatusLeft *ChatMemberStatusLeft)
+	VisitChatMemberStatusBanned(chatMemberStatusBanned *ChatMemberStatusBanned)
+	VisitUnknownChatMemberStatus(unknownChatMemberStatus *UnknownChatMemberStatus)
+}
+
+// ChatMemberStatusCases holds the function MatchChatMemberStatus calls for each type of ChatMemberStatus;
+// types without a function are passed to the default case
+type ChatMemberStatusCases[R any] struct {
+	ChatMemberStatusCreator       func(chatMemberStatusCreator *ChatMemberStatusCreator) R
+	ChatMemberStatusAdministrator func(chatMemberStatusAdministrator *ChatMemberStatusAdministrator) R
+	ChatMemberStatusMember        func(chatMemberStatusMember *ChatMemberStatusMember) R
+	ChatMemberStatusRestricted    func(chatMemberStatusRestricted *ChatMemberStatusRestricted) R
+	ChatMemberStatusLeft          func(chatMemberStatusLeft *ChatMemberStatusLeft) R
+	ChatMemberStatusBanned        func(chatMemberStatusBanned *ChatMemberStatusBanned) R
+}
+
+// MatchChatMemberStatus calls the case of the type of chatMemberStatus, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement ChatMemberStatusVisitor
+// to have the compiler check every type is handled.
+func MatchChatMemberStatus[R any](chatMemberStatus ChatMemberStatus, cases ChatMemberStatusCases[R], defaultCase func(chatMemberStatus ChatMemberStatus) R) R {
+	switch value := chatMemberStatus.(type) {
+	case *ChatMemberStatusCreator:
+		if cases.ChatMemberStatusCreator != nil {
+			return cases.ChatMemberStatusCreator(value)
+		}
+	case *ChatMemberStatusAdministrator:
+		if cases.ChatMemberStatusAdministrator != nil {
+			return cases.ChatMemberStatusAdministrator(value)
+		}
+	case *ChatMemberStatusMember:
+		if cases.ChatMemberStatusMember != nil {
+			return cases.ChatMemberStatusMember(value)
+		}
+	case *ChatMemberStatusRestricted:
+		if cases.ChatMemberStatusRestricted != nil {
+			return cases.ChatMemberStatusRestricted(value)
+		}
+	case *ChatMemberStatusLeft:
+		if cases.ChatMemberStatusLeft != nil {
+			return cases.ChatMemberStatusLeft(value)
+		}
+	case *ChatMemberStatusBanned:
+		if cases.ChatMemberStatusBanned != nil {
+			return cases.ChatMemberStatusBanned(value)
+		}
+	}
+	return defaultCase(chatMemberStatus)
+}
+
+// Clone returns a deep copy of the object
+func (unknownChatMemberStatus *UnknownChatMemberStatus) Clone() *UnknownChatMemberStatus {
+	if unknownChatMemberStatus == nil {
+		return nil
+	}
+	return &UnknownChatMemberStatus{Unknown: unknownChatMemberStatus.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownChatMemberStatus *UnknownChatMemberStatus) Equal(other *UnknownChatMemberStatus) bool {
+	if unknownChatMemberStatus == nil || other == nil {
+		return unknownChatMemberStatus == other
+	}
+	return unknownChatMemberStatus.Unknown.equal(other.Unknown)
+}
+
+func cloneChatMemberStatus(chatMemberStatus ChatMemberStatus) ChatMemberStatus {
+	switch value := chatMemberStatus.(type) {
+	case *ChatMemberStatusCreator:
+		return value.Clone()
+	case *ChatMemberStatusAdministrator:
+		return value.Clone()
+	case *ChatMemberStatusMember:
+		return value.Clone()
+	case *ChatMemberStatusRestricted:
+		return value.Clone()
+	case *ChatMemberStatusLeft:
+		return value.Clone()
+	case *ChatMemberStatusBanned:
+		return value.Clone()
+	case *UnknownChatMemberStatus:
+		return value.Clone()
+	}
+	return chatMemberStatus
+}
+
+func equalChatMemberStatus(a ChatMemberStatus, b ChatMemberStatus) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *ChatMemberStatusCreator:
+		b, ok := b.(*ChatMemberStatusCreator)
+		return ok && a.Equal(b)
+	case *ChatMemberStatusAdministrator:
+		b, ok := b.(*ChatMemberStatusAdministrator)
+		return ok && a.Equal(b)
+	case *ChatMemberStatusMember:
+		b, ok := b.(*ChatMemberStatusMember)
+		return ok && a.Equal(b)
+	case *ChatMemberStatusRestricted:
+		b, ok := b.(*ChatMemberStatusRestricted)
+		return ok && a.Equal(b)
+	case *ChatMemberStatusLeft:
+		b, ok := b.(*ChatMemberStatusLeft)
+		return ok && a.Equal(b)
+	case *ChatMemberStatusBanned:
+		b, ok := b.(*ChatMemberStatusBanned)
+		return ok && a.Equal(b)
+	case *UnknownChatMemberStatus:
+		b, ok := b.(*UnknownChatMemberStatus)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffChatMemberStatus(path string, a ChatMemberStatus, b ChatMemberStatus, changes []Change) []Change {
+	switch a := a.(type) {
+	case *ChatMemberStatusCreator:
+		if b, ok := b.(*ChatMemberStatusCreator); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatMemberStatusAdministrator:
+		if b, ok := b.(*ChatMemberStatusAdministrator); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatMemberStatusMember:
+		if b, ok := b.(*ChatMemberStatusMember); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatMemberStatusRestricted:
+		if b, ok := b.(*ChatMemberStatusRestricted); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatMemberStatusLeft:
+		if b, ok := b.(*ChatMemberStatusLeft); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatMemberStatusBanned:
+		if b, ok := b.(*ChatMemberStatusBanned); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalChatMemberStatus(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
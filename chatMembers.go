@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ChatMembers Contains a list of chat members
@@ -31,6 +31,161 @@ func NewChatMembers(totalCount int32, members []ChatMember) *ChatMembers {
 	return &chatMembersTemp
 }
 
+// MarshalJSON marshals to json
+func (chatMembers *ChatMembers) MarshalJSON() ([]byte, error) {
+	return chatMembers.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatMembers *ChatMembers) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatMembers)
+}
+
+func (chatMembers *ChatMembers) appendJSON(b []byte) ([]byte, error) {
+	if chatMembers == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatMembers.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatMembers.tdCommon.Extra)
+	b = append(b, `,"total_count":`...)
+	b = appendJSONInt(b, int64(chatMembers.TotalCount))
+	b = append(b, `,"members":`...)
+	if chatMembers.Members == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range chatMembers.Members {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = chatMembers.Members[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (chatMembers *ChatMembers) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatMembers.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatMembers.tdCommon.Extra, err = r.readString()
+		case "total_count":
+			chatMembers.TotalCount, err = r.readInt32()
+		case "members":
+			if r.readNull() {
+				chatMembers.Members = nil
+			} else {
+				chatMembers.Members = make([]ChatMember, 0)
+				err = r.readArray(func() (err error) {
+					var item0 ChatMember
+					err = item0.decodeJSON(r)
+					chatMembers.Members = append(chatMembers.Members, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatMembers *ChatMembers) Clone() *ChatMembers {
+	if chatMembers == nil {
+		return nil
+	}
+	clone := *chatMembers
+	if chatMembers.Members != nil {
+		clone.Members = make([]ChatMember, len(chatMembers.Members))
+		for i0 := range chatMembers.Members {
+			clone.Members[i0] = *chatMembers.Members[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatMembers *ChatMembers) Equal(other *ChatMembers) bool {
+	if chatMembers == nil || other == nil {
+		return chatMembers == other
+	}
+	if chatMembers.TotalCount != other.TotalCount {
+		return false
+	}
+	if len(chatMembers.Members) != len(other.Members) {
+		return false
+	}
+	for i0 := range chatMembers.Members {
+		if !chatMembers.Members[i0].Equal(&other.Members[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatMembers *ChatMembers) Diff(other *ChatMembers) []Change {
+	return chatMembers.diff("", other, nil)
+}
+
+func (chatMembers *ChatMembers) diff(path string, other *ChatMembers, changes []Change) []Change {
+	if chatMembers == nil || other == nil {
+		if chatMembers != other {
+			changes = append(changes, Change{Path: path, Old: chatMembers, New: other})
+		}
+		return changes
+	}
+	if chatMembers.TotalCount != other.TotalCount {
+		changes = append(changes, Change{Path: fieldPath(path, "total_count"), Old: chatMembers.TotalCount, New: other.TotalCount})
+	}
+	if len(chatMembers.Members) != len(other.Members) {
+		changes = append(changes, Change{Path: fieldPath(path, "members"), Old: chatMembers.Members, New: other.Members})
+	} else {
+		for i0 := range chatMembers.Members {
+			changes = chatMembers.Members[i0].diff(indexPath(fieldPath(path, "members"), i0), &other.Members[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatMembers *ChatMembers) String() string {
+	return objectString(chatMembers)
+}
+
+// LogValue logs the object as its summary
+func (chatMembers *ChatMembers) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatMembers))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatMembers *ChatMembers) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatMembers)
+}
+
+func (chatMembers *ChatMembers) printText(p *printer) {
+	if chatMembers == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatMembers")
+	p.int("total_count", int64(chatMembers.TotalCount))
+	p.vector("members", len(chatMembers.Members), func(i0 int) {
+		chatMembers.Members[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // SearchChatMembers Searches for a specified query in the first name, last name and username of the members of a specified chat. Requires administrator rights in channels
 // @param chatId Chat identifier
 // @param query Query to search for
@@ -49,12 +204,12 @@ func (client *Client) SearchChatMembers(chatId int64, query string, limit int32,
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chatMembers ChatMembers
-	err = json.Unmarshal(result.Raw, &chatMembers)
+	err = jsonUnmarshal(result.Raw, &chatMembers)
 	return &chatMembers, err
 }
 
@@ -76,11 +231,11 @@ func (client *Client) GetSupergroupMembers(supergroupId int64, filter Supergroup
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chatMembers ChatMembers
-	err = json.Unmarshal(result.Raw, &chatMembers)
+	err = jsonUnmarshal(result.Raw, &chatMembers)
 	return &chatMembers, err
 }
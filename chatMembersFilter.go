@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ChatMembersFilter Specifies the kind of chat members to return in searchChatMembers
 type ChatMembersFilter interface {
 	GetChatMembersFilterEnum() ChatMembersFilterEnum
+	Accept(visitor ChatMembersFilterVisitor)
 }
 
 // ChatMembersFilterEnum Alias for abstract ChatMembersFilter 'Sub-Classes', used as constant-enum here
@@ -24,55 +26,98 @@ const (
 	ChatMembersFilterBotsType           ChatMembersFilterEnum = "chatMembersFilterBots"
 )
 
+// UnknownChatMembersFilter is a ChatMembersFilter of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownChatMembersFilter struct {
+	Unknown
+}
+
+// GetChatMembersFilterEnum return the enum type of this object
+func (unknownChatMembersFilter *UnknownChatMembersFilter) GetChatMembersFilterEnum() ChatMembersFilterEnum {
+	return ChatMembersFilterEnum(unknownChatMembersFilter.Type)
+}
+
+// Accept calls the method of the visitor for UnknownChatMembersFilter
+func (unknownChatMembersFilter *UnknownChatMembersFilter) Accept(visitor ChatMembersFilterVisitor) {
+	visitor.VisitUnknownChatMembersFilter(unknownChatMembersFilter)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownChatMembersFilter *UnknownChatMembersFilter) String() string {
+	return objectString(unknownChatMembersFilter)
+}
+
+// LogValue logs the object as its summary
+func (unknownChatMembersFilter *UnknownChatMembersFilter) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownChatMembersFilter))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownChatMembersFilter *UnknownChatMembersFilter) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownChatMembersFilter)
+}
+
+func (unknownChatMembersFilter *UnknownChatMembersFilter) printText(p *printer) {
+	if unknownChatMembersFilter == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownChatMembersFilter")
+	p.string("@type", unknownChatMembersFilter.Type)
+	p.endObject(state)
+}
+
 func unmarshalChatMembersFilter(rawMsg *json.RawMessage) (ChatMembersFilter, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch ChatMembersFilterEnum(objMap["@type"].(string)) {
+	switch ChatMembersFilterEnum(typeName) {
 	case ChatMembersFilterContactsType:
 		var chatMembersFilterContacts ChatMembersFilterContacts
-		err := json.Unmarshal(*rawMsg, &chatMembersFilterContacts)
+		err := jsonUnmarshal(*rawMsg, &chatMembersFilterContacts)
 		return &chatMembersFilterContacts, err
 
 	case ChatMembersFilterAdministratorsType:
 		var chatMembersFilterAdministrators ChatMembersFilterAdministrators
-		err := json.Unmarshal(*rawMsg, &chatMembersFilterAdministrators)
+		err := jsonUnmarshal(*rawMsg, &chatMembersFilterAdministrators)
 		return &chatMembersFilterAdministrators, err
 
 	case ChatMembersFilterMembersType:
 		var chatMembersFilterMembers ChatMembersFilterMembers
-		err := json.Unmarshal(*rawMsg, &chatMembersFilterMembers)
+		err := jsonUnmarshal(*rawMsg, &chatMembersFilterMembers)
 		return &chatMembersFilterMembers, err
 
 	case ChatMembersFilterMentionType:
 		var chatMembersFilterMention ChatMembersFilterMention
-		err := json.Unmarshal(*rawMsg, &chatMembersFilterMention)
+		err := jsonUnmarshal(*rawMsg, &chatMembersFilterMention)
 		return &chatMembersFilterMention, err
 
 	case ChatMembersFilterRestrictedType:
 		var chatMembersFilterRestricted ChatMembersFilterRestricted
-		err := json.Unmarshal(*rawMsg, &chatMembersFilterRestricted)
+		err := jsonUnmarshal(*rawMsg, &chatMembersFilterRestricted)
 		return &chatMembersFilterRestricted, err
 
 	case ChatMembersFilterBannedType:
 		var chatMembersFilterBanned ChatMembersFilterBanned
-		err := json.Unmarshal(*rawMsg, &chatMembersFilterBanned)
+		err := jsonUnmarshal(*rawMsg, &chatMembersFilterBanned)
 		return &chatMembersFilterBanned, err
 
 	case ChatMembersFilterBotsType:
 		var chatMembersFilterBots ChatMembersFilterBots
-		err := json.Unmarshal(*rawMsg, &chatMembersFilterBots)
+		err := jsonUnmarshal(*rawMsg, &chatMembersFilterBots)
 		return &chatMembersFilterBots, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownChatMembersFilter{Unknown: unknown}, nil
 	}
 }
 
@@ -87,7 +132,6 @@ func (chatMembersFilterContacts *ChatMembersFilterContacts) MessageType() string
 }
 
 // NewChatMembersFilterContacts creates a new ChatMembersFilterContacts
-//
 func NewChatMembersFilterContacts() *ChatMembersFilterContacts {
 	chatMembersFilterContactsTemp := ChatMembersFilterContacts{
 		tdCommon: tdCommon{Type: "chatMembersFilterContacts"},
@@ -96,11 +140,107 @@ func NewChatMembersFilterContacts() *ChatMembersFilterContacts {
 	return &chatMembersFilterContactsTemp
 }
 
+// MarshalJSON marshals to json
+func (chatMembersFilterContacts *ChatMembersFilterContacts) MarshalJSON() ([]byte, error) {
+	return chatMembersFilterContacts.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatMembersFilterContacts *ChatMembersFilterContacts) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatMembersFilterContacts)
+}
+
+func (chatMembersFilterContacts *ChatMembersFilterContacts) appendJSON(b []byte) ([]byte, error) {
+	if chatMembersFilterContacts == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatMembersFilterContacts.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatMembersFilterContacts.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatMembersFilterContacts *ChatMembersFilterContacts) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatMembersFilterContacts.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatMembersFilterContacts.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatMembersFilterContacts *ChatMembersFilterContacts) Clone() *ChatMembersFilterContacts {
+	if chatMembersFilterContacts == nil {
+		return nil
+	}
+	clone := *chatMembersFilterContacts
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatMembersFilterContacts *ChatMembersFilterContacts) Equal(other *ChatMembersFilterContacts) bool {
+	if chatMembersFilterContacts == nil || other == nil {
+		return chatMembersFilterContacts == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatMembersFilterContacts *ChatMembersFilterContacts) Diff(other *ChatMembersFilterContacts) []Change {
+	return chatMembersFilterContacts.diff("", other, nil)
+}
+
+func (chatMembersFilterContacts *ChatMembersFilterContacts) diff(path string, other *ChatMembersFilterContacts, changes []Change) []Change {
+	if chatMembersFilterContacts == nil || other == nil {
+		if chatMembersFilterContacts != other {
+			changes = append(changes, Change{Path: path, Old: chatMembersFilterContacts, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatMembersFilterContacts *ChatMembersFilterContacts) String() string {
+	return objectString(chatMembersFilterContacts)
+}
+
+// LogValue logs the object as its summary
+func (chatMembersFilterContacts *ChatMembersFilterContacts) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatMembersFilterContacts))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatMembersFilterContacts *ChatMembersFilterContacts) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatMembersFilterContacts)
+}
+
+func (chatMembersFilterContacts *ChatMembersFilterContacts) printText(p *printer) {
+	if chatMembersFilterContacts == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatMembersFilterContacts")
+	p.endObject(state)
+}
+
 // GetChatMembersFilterEnum return the enum type of this object
 func (chatMembersFilterContacts *ChatMembersFilterContacts) GetChatMembersFilterEnum() ChatMembersFilterEnum {
 	return ChatMembersFilterContactsType
 }
 
+// Accept calls the method of the visitor for ChatMembersFilterContacts
+func (chatMembersFilterContacts *ChatMembersFilterContacts) Accept(visitor ChatMembersFilterVisitor) {
+	visitor.VisitChatMembersFilterContacts(chatMembersFilterContacts)
+}
+
 // ChatMembersFilterAdministrators Returns the owner and administrators
 type ChatMembersFilterAdministrators struct {
 	tdCommon
@@ -112,7 +252,6 @@ func (chatMembersFilterAdministrators *ChatMembersFilterAdministrators) MessageT
 }
 
 // NewChatMembersFilterAdministrators creates a new ChatMembersFilterAdministrators
-//
 func NewChatMembersFilterAdministrators() *ChatMembersFilterAdministrators {
 	chatMembersFilterAdministratorsTemp := ChatMembersFilterAdministrators{
 		tdCommon: tdCommon{Type: "chatMembersFilterAdministrators"},
@@ -121,11 +260,107 @@ func NewChatMembersFilterAdministrators() *ChatMembersFilterAdministrators {
 	return &chatMembersFilterAdministratorsTemp
 }
 
+// MarshalJSON marshals to json
+func (chatMembersFilterAdministrators *ChatMembersFilterAdministrators) MarshalJSON() ([]byte, error) {
+	return chatMembersFilterAdministrators.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatMembersFilterAdministrators *ChatMembersFilterAdministrators) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatMembersFilterAdministrators)
+}
+
+func (chatMembersFilterAdministrators *ChatMembersFilterAdministrators) appendJSON(b []byte) ([]byte, error) {
+	if chatMembersFilterAdministrators == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatMembersFilterAdministrators.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatMembersFilterAdministrators.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatMembersFilterAdministrators *ChatMembersFilterAdministrators) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatMembersFilterAdministrators.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatMembersFilterAdministrators.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatMembersFilterAdministrators *ChatMembersFilterAdministrators) Clone() *ChatMembersFilterAdministrators {
+	if chatMembersFilterAdministrators == nil {
+		return nil
+	}
+	clone := *chatMembersFilterAdministrators
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatMembersFilterAdministrators *ChatMembersFilterAdministrators) Equal(other *ChatMembersFilterAdministrators) bool {
+	if chatMembersFilterAdministrators == nil || other == nil {
+		return chatMembersFilterAdministrators == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatMembersFilterAdministrators *ChatMembersFilterAdministrators) Diff(other *ChatMembersFilterAdministrators) []Change {
+	return chatMembersFilterAdministrators.diff("", other, nil)
+}
+
+func (chatMembersFilterAdministrators *ChatMembersFilterAdministrators) diff(path string, other *ChatMembersFilterAdministrators, changes []Change) []Change {
+	if chatMembersFilterAdministrators == nil || other == nil {
+		if chatMembersFilterAdministrators != other {
+			changes = append(changes, Change{Path: path, Old: chatMembersFilterAdministrators, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatMembersFilterAdministrators *ChatMembersFilterAdministrators) String() string {
+	return objectString(chatMembersFilterAdministrators)
+}
+
+// LogValue logs the object as its summary
+func (chatMembersFilterAdministrators *ChatMembersFilterAdministrators) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatMembersFilterAdministrators))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatMembersFilterAdministrators *ChatMembersFilterAdministrators) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatMembersFilterAdministrators)
+}
+
+func (chatMembersFilterAdministrators *ChatMembersFilterAdministrators) printText(p *printer) {
+	if chatMembersFilterAdministrators == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatMembersFilterAdministrators")
+	p.endObject(state)
+}
+
 // GetChatMembersFilterEnum return the enum type of this object
 func (chatMembersFilterAdministrators *ChatMembersFilterAdministrators) GetChatMembersFilterEnum() ChatMembersFilterEnum {
 	return ChatMembersFilterAdministratorsType
 }
 
+// Accept calls the method of the visitor for ChatMembersFilterAdministrators
+func (chatMembersFilterAdministrators *ChatMembersFilterAdministrators) Accept(visitor ChatMembersFilterVisitor) {
+	visitor.VisitChatMembersFilterAdministrators(chatMembersFilterAdministrators)
+}
+
 // ChatMembersFilterMembers Returns all chat members, including restricted chat members
 type ChatMembersFilterMembers struct {
 	tdCommon
@@ -137,7 +372,6 @@ func (chatMembersFilterMembers *ChatMembersFilterMembers) MessageType() string {
 }
 
 // NewChatMembersFilterMembers creates a new ChatMembersFilterMembers
-//
 func NewChatMembersFilterMembers() *ChatMembersFilterMembers {
 	chatMembersFilterMembersTemp := ChatMembersFilterMembers{
 		tdCommon: tdCommon{Type: "chatMembersFilterMembers"},
@@ -146,11 +380,107 @@ func NewChatMembersFilterMembers() *ChatMembersFilterMembers {
 	return &chatMembersFilterMembersTemp
 }
 
+// MarshalJSON marshals to json
+func (chatMembersFilterMembers *ChatMembersFilterMembers) MarshalJSON() ([]byte, error) {
+	return chatMembersFilterMembers.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatMembersFilterMembers *ChatMembersFilterMembers) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatMembersFilterMembers)
+}
+
+func (chatMembersFilterMembers *ChatMembersFilterMembers) appendJSON(b []byte) ([]byte, error) {
+	if chatMembersFilterMembers == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatMembersFilterMembers.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatMembersFilterMembers.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatMembersFilterMembers *ChatMembersFilterMembers) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatMembersFilterMembers.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatMembersFilterMembers.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatMembersFilterMembers *ChatMembersFilterMembers) Clone() *ChatMembersFilterMembers {
+	if chatMembersFilterMembers == nil {
+		return nil
+	}
+	clone := *chatMembersFilterMembers
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatMembersFilterMembers *ChatMembersFilterMembers) Equal(other *ChatMembersFilterMembers) bool {
+	if chatMembersFilterMembers == nil || other == nil {
+		return chatMembersFilterMembers == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatMembersFilterMembers *ChatMembersFilterMembers) Diff(other *ChatMembersFilterMembers) []Change {
+	return chatMembersFilterMembers.diff("", other, nil)
+}
+
+func (chatMembersFilterMembers *ChatMembersFilterMembers) diff(path string, other *ChatMembersFilterMembers, changes []Change) []Change {
+	if chatMembersFilterMembers == nil || other == nil {
+		if chatMembersFilterMembers != other {
+			changes = append(changes, Change{Path: path, Old: chatMembersFilterMembers, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatMembersFilterMembers *ChatMembersFilterMembers) String() string {
+	return objectString(chatMembersFilterMembers)
+}
+
+// LogValue logs the object as its summary
+func (chatMembersFilterMembers *ChatMembersFilterMembers) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatMembersFilterMembers))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatMembersFilterMembers *ChatMembersFilterMembers) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatMembersFilterMembers)
+}
+
+func (chatMembersFilterMembers *ChatMembersFilterMembers) printText(p *printer) {
+	if chatMembersFilterMembers == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatMembersFilterMembers")
+	p.endObject(state)
+}
+
 // GetChatMembersFilterEnum return the enum type of this object
 func (chatMembersFilterMembers *ChatMembersFilterMembers) GetChatMembersFilterEnum() ChatMembersFilterEnum {
 	return ChatMembersFilterMembersType
 }
 
+// Accept calls the method of the visitor for ChatMembersFilterMembers
+func (chatMembersFilterMembers *ChatMembersFilterMembers) Accept(visitor ChatMembersFilterVisitor) {
+	visitor.VisitChatMembersFilterMembers(chatMembersFilterMembers)
+}
+
 // ChatMembersFilterMention Returns users which can be mentioned in the chat
 type ChatMembersFilterMention struct {
 	tdCommon
@@ -174,11 +504,118 @@ func NewChatMembersFilterMention(messageThreadId int64) *ChatMembersFilterMentio
 	return &chatMembersFilterMentionTemp
 }
 
+// MarshalJSON marshals to json
+func (chatMembersFilterMention *ChatMembersFilterMention) MarshalJSON() ([]byte, error) {
+	return chatMembersFilterMention.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatMembersFilterMention *ChatMembersFilterMention) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatMembersFilterMention)
+}
+
+func (chatMembersFilterMention *ChatMembersFilterMention) appendJSON(b []byte) ([]byte, error) {
+	if chatMembersFilterMention == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatMembersFilterMention.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatMembersFilterMention.tdCommon.Extra)
+	b = append(b, `,"message_thread_id":`...)
+	b = appendJSONInt(b, int64(chatMembersFilterMention.MessageThreadId))
+	return append(b, '}'), nil
+}
+
+func (chatMembersFilterMention *ChatMembersFilterMention) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatMembersFilterMention.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatMembersFilterMention.tdCommon.Extra, err = r.readString()
+		case "message_thread_id":
+			chatMembersFilterMention.MessageThreadId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatMembersFilterMention *ChatMembersFilterMention) Clone() *ChatMembersFilterMention {
+	if chatMembersFilterMention == nil {
+		return nil
+	}
+	clone := *chatMembersFilterMention
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatMembersFilterMention *ChatMembersFilterMention) Equal(other *ChatMembersFilterMention) bool {
+	if chatMembersFilterMention == nil || other == nil {
+		return chatMembersFilterMention == other
+	}
+	if chatMembersFilterMention.MessageThreadId != other.MessageThreadId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatMembersFilterMention *ChatMembersFilterMention) Diff(other *ChatMembersFilterMention) []Change {
+	return chatMembersFilterMention.diff("", other, nil)
+}
+
+func (chatMembersFilterMention *ChatMembersFilterMention) diff(path string, other *ChatMembersFilterMention, changes []Change) []Change {
+	if chatMembersFilterMention == nil || other == nil {
+		if chatMembersFilterMention != other {
+			changes = append(changes, Change{Path: path, Old: chatMembersFilterMention, New: other})
+		}
+		return changes
+	}
+	if chatMembersFilterMention.MessageThreadId != other.MessageThreadId {
+		changes = append(changes, Change{Path: fieldPath(path, "message_thread_id"), Old: chatMembersFilterMention.MessageThreadId, New: other.MessageThreadId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatMembersFilterMention *ChatMembersFilterMention) String() string {
+	return objectString(chatMembersFilterMention)
+}
+
+// LogValue logs the object as its summary
+func (chatMembersFilterMention *ChatMembersFilterMention) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatMembersFilterMention))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatMembersFilterMention *ChatMembersFilterMention) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatMembersFilterMention)
+}
+
+func (chatMembersFilterMention *ChatMembersFilterMention) printText(p *printer) {
+	if chatMembersFilterMention == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatMembersFilterMention")
+	p.int("message_thread_id", int64(chatMembersFilterMention.MessageThreadId))
+	p.endObject(state)
+}
+
 // GetChatMembersFilterEnum return the enum type of this object
 func (chatMembersFilterMention *ChatMembersFilterMention) GetChatMembersFilterEnum() ChatMembersFilterEnum {
 	return ChatMembersFilterMentionType
 }
 
+// Accept calls the method of the visitor for ChatMembersFilterMention
+func (chatMembersFilterMention *ChatMembersFilterMention) Accept(visitor ChatMembersFilterVisitor) {
+	visitor.VisitChatMembersFilterMention(chatMembersFilterMention)
+}
+
 // ChatMembersFilterRestricted Returns users under certain restrictions in the chat; can be used only by administrators in a supergroup
 type ChatMembersFilterRestricted struct {
 	tdCommon
@@ -190,7 +627,6 @@ func (chatMembersFilterRestricted *ChatMembersFilterRestricted) MessageType() st
 }
 
 // NewChatMembersFilterRestricted creates a new ChatMembersFilterRestricted
-//
 func NewChatMembersFilterRestricted() *ChatMembersFilterRestricted {
 	chatMembersFilterRestrictedTemp := ChatMembersFilterRestricted{
 		tdCommon: tdCommon{Type: "chatMembersFilterRestricted"},
@@ -199,11 +635,107 @@ func NewChatMembersFilterRestricted() *ChatMembersFilterRestricted {
 	return &chatMembersFilterRestrictedTemp
 }
 
+// MarshalJSON marshals to json
+func (chatMembersFilterRestricted *ChatMembersFilterRestricted) MarshalJSON() ([]byte, error) {
+	return chatMembersFilterRestricted.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatMembersFilterRestricted *ChatMembersFilterRestricted) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatMembersFilterRestricted)
+}
+
+func (chatMembersFilterRestricted *ChatMembersFilterRestricted) appendJSON(b []byte) ([]byte, error) {
+	if chatMembersFilterRestricted == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatMembersFilterRestricted.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatMembersFilterRestricted.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatMembersFilterRestricted *ChatMembersFilterRestricted) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatMembersFilterRestricted.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatMembersFilterRestricted.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatMembersFilterRestricted *ChatMembersFilterRestricted) Clone() *ChatMembersFilterRestricted {
+	if chatMembersFilterRestricted == nil {
+		return nil
+	}
+	clone := *chatMembersFilterRestricted
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatMembersFilterRestricted *ChatMembersFilterRestricted) Equal(other *ChatMembersFilterRestricted) bool {
+	if chatMembersFilterRestricted == nil || other == nil {
+		return chatMembersFilterRestricted == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatMembersFilterRestricted *ChatMembersFilterRestricted) Diff(other *ChatMembersFilterRestricted) []Change {
+	return chatMembersFilterRestricted.diff("", other, nil)
+}
+
+func (chatMembersFilterRestricted *ChatMembersFilterRestricted) diff(path string, other *ChatMembersFilterRestricted, changes []Change) []Change {
+	if chatMembersFilterRestricted == nil || other == nil {
+		if chatMembersFilterRestricted != other {
+			changes = append(changes, Change{Path: path, Old: chatMembersFilterRestricted, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatMembersFilterRestricted *ChatMembersFilterRestricted) String() string {
+	return objectString(chatMembersFilterRestricted)
+}
+
+// LogValue logs the object as its summary
+func (chatMembersFilterRestricted *ChatMembersFilterRestricted) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatMembersFilterRestricted))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatMembersFilterRestricted *ChatMembersFilterRestricted) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatMembersFilterRestricted)
+}
+
+func (chatMembersFilterRestricted *ChatMembersFilterRestricted) printText(p *printer) {
+	if chatMembersFilterRestricted == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatMembersFilterRestricted")
+	p.endObject(state)
+}
+
 // GetChatMembersFilterEnum return the enum type of this object
 func (chatMembersFilterRestricted *ChatMembersFilterRestricted) GetChatMembersFilterEnum() ChatMembersFilterEnum {
 	return ChatMembersFilterRestrictedType
 }
 
+// Accept calls the method of the visitor for ChatMembersFilterRestricted
+func (chatMembersFilterRestricted *ChatMembersFilterRestricted) Accept(visitor ChatMembersFilterVisitor) {
+	visitor.VisitChatMembersFilterRestricted(chatMembersFilterRestricted)
+}
+
 // ChatMembersFilterBanned Returns users banned from the chat; can be used only by administrators in a supergroup or in a channel
 type ChatMembersFilterBanned struct {
 	tdCommon
@@ -215,7 +747,6 @@ func (chatMembersFilterBanned *ChatMembersFilterBanned) MessageType() string {
 }
 
 // NewChatMembersFilterBanned creates a new ChatMembersFilterBanned
-//
 func NewChatMembersFilterBanned() *ChatMembersFilterBanned {
 	chatMembersFilterBannedTemp := ChatMembersFilterBanned{
 		tdCommon: tdCommon{Type: "chatMembersFilterBanned"},
@@ -224,11 +755,107 @@ func NewChatMembersFilterBanned() *ChatMembersFilterBanned {
 	return &chatMembersFilterBannedTemp
 }
 
+// MarshalJSON marshals to json
+func (chatMembersFilterBanned *ChatMembersFilterBanned) MarshalJSON() ([]byte, error) {
+	return chatMembersFilterBanned.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatMembersFilterBanned *ChatMembersFilterBanned) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatMembersFilterBanned)
+}
+
+func (chatMembersFilterBanned *ChatMembersFilterBanned) appendJSON(b []byte) ([]byte, error) {
+	if chatMembersFilterBanned == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatMembersFilterBanned.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatMembersFilterBanned.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatMembersFilterBanned *ChatMembersFilterBanned) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatMembersFilterBanned.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatMembersFilterBanned.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatMembersFilterBanned *ChatMembersFilterBanned) Clone() *ChatMembersFilterBanned {
+	if chatMembersFilterBanned == nil {
+		return nil
+	}
+	clone := *chatMembersFilterBanned
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatMembersFilterBanned *ChatMembersFilterBanned) Equal(other *ChatMembersFilterBanned) bool {
+	if chatMembersFilterBanned == nil || other == nil {
+		return chatMembersFilterBanned == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatMembersFilterBanned *ChatMembersFilterBanned) Diff(other *ChatMembersFilterBanned) []Change {
+	return chatMembersFilterBanned.diff("", other, nil)
+}
+
+func (chatMembersFilterBanned *ChatMembersFilterBanned) diff(path string, other *ChatMembersFilterBanned, changes []Change) []Change {
+	if chatMembersFilterBanned == nil || other == nil {
+		if chatMembersFilterBanned != other {
+			changes = append(changes, Change{Path: path, Old: chatMembersFilterBanned, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatMembersFilterBanned *ChatMembersFilterBanned) String() string {
+	return objectString(chatMembersFilterBanned)
+}
+
+// LogValue logs the object as its summary
+func (chatMembersFilterBanned *ChatMembersFilterBanned) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatMembersFilterBanned))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatMembersFilterBanned *ChatMembersFilterBanned) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatMembersFilterBanned)
+}
+
+func (chatMembersFilterBanned *ChatMembersFilterBanned) printText(p *printer) {
+	if chatMembersFilterBanned == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatMembersFilterBanned")
+	p.endObject(state)
+}
+
 // GetChatMembersFilterEnum return the enum type of this object
 func (chatMembersFilterBanned *ChatMembersFilterBanned) GetChatMembersFilterEnum() ChatMembersFilterEnum {
 	return ChatMembersFilterBannedType
 }
 
+// Accept calls the method of the visitor for ChatMembersFilterBanned
+func (chatMembersFilterBanned *ChatMembersFilterBanned) Accept(visitor ChatMembersFilterVisitor) {
+	visitor.VisitChatMembersFilterBanned(chatMembersFilterBanned)
+}
+
 // ChatMembersFilterBots Returns bot members of the chat
 type ChatMembersFilterBots struct {
 	tdCommon
@@ -240,7 +867,6 @@ func (chatMembersFilterBots *ChatMembersFilterBots) MessageType() string {
 }
 
 // NewChatMembersFilterBots creates a new ChatMembersFilterBots
-//
 func NewChatMembersFilterBots() *ChatMembersFilterBots {
 	chatMembersFilterBotsTemp := ChatMembersFilterBots{
 		tdCommon: tdCommon{Type: "chatMembersFilterBots"},
@@ -249,7 +875,273 @@ func NewChatMembersFilterBots() *ChatMembersFilterBots {
 	return &chatMembersFilterBotsTemp
 }
 
+// MarshalJSON marshals to json
+func (chatMembersFilterBots *ChatMembersFilterBots) MarshalJSON() ([]byte, error) {
+	return chatMembersFilterBots.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatMembersFilterBots *ChatMembersFilterBots) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatMembersFilterBots)
+}
+
+func (chatMembersFilterBots *ChatMembersFilterBots) appendJSON(b []byte) ([]byte, error) {
+	if chatMembersFilterBots == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatMembersFilterBots.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatMembersFilterBots.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatMembersFilterBots *ChatMembersFilterBots) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatMembersFilterBots.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatMembersFilterBots.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatMembersFilterBots *ChatMembersFilterBots) Clone() *ChatMembersFilterBots {
+	if chatMembersFilterBots == nil {
+		return nil
+	}
+	clone := *chatMembersFilterBots
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatMembersFilterBots *ChatMembersFilterBots) Equal(other *ChatMembersFilterBots) bool {
+	if chatMembersFilterBots == nil || other == nil {
+		return chatMembersFilterBots == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatMembersFilterBots *ChatMembersFilterBots) Diff(other *ChatMembersFilterBots) []Change {
+	return chatMembersFilterBots.diff("", other, nil)
+}
+
+func (chatMembersFilterBots *ChatMembersFilterBots) diff(path string, other *ChatMembersFilterBots, changes []Change) []Change {
+	if chatMembersFilterBots == nil || other == nil {
+		if chatMembersFilterBots != other {
+			changes = append(changes, Change{Path: path, Old: chatMembersFilterBots, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatMembersFilterBots *ChatMembersFilterBots) String() string {
+	return objectString(chatMembersFilterBots)
+}
+
+// LogValue logs the object as its summary
+func (chatMembersFilterBots *ChatMembersFilterBots) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatMembersFilterBots))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatMembersFilterBots *ChatMembersFilterBots) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatMembersFilterBots)
+}
+
+func (chatMembersFilterBots *ChatMembersFilterBots) printText(p *printer) {
+	if chatMembersFilterBots == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatMembersFilterBots")
+	p.endObject(state)
+}
+
 // GetChatMembersFilterEnum return the enum type of this object
 func (chatMembersFilterBots *ChatMembersFilterBots) GetChatMembersFilterEnum() ChatMembersFilterEnum {
 	return ChatMembersFilterBotsType
 }
+
+// Accept calls the method of the visitor for ChatMembersFilterBots
+func (chatMembersFilterBots *ChatMembersFilterBots) Accept(visitor ChatMembersFilterVisitor) {
+	visitor.VisitChatMembersFilterBots(chatMembersFilterBots)
+}
+
+// ChatMembersFilterVisitor visits every type of ChatMembersFilter, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to ChatMembersFilter, until they handle it too.
+type ChatMembersFilterVisitor interface {
+	VisitChatMembersFilterContacts(chatMembersFilterContacts *ChatMembersFilterContacts)
+	VisitChatMembersFilterAdministrators(chatMembersFilterAdministrators *ChatMembersFilterAdministrators)
+	VisitChatMembersFilterMembers(chatMembersFilterMembers *ChatMembersFilterMembers)
+	VisitChatMembersFilterMention(chatMembersFilterMention *ChatMembersFilterMention)
+	VisitChatMembersFilterRestricted(chatMembersFilterRestricted *ChatMembersFilterRestricted)
+	VisitChatMembersFilterBanned(chatMembersFilterBanned *ChatMembersFilterBanned)
+	VisitChatMembersFilterBots(chatMembersFilterBots *ChatMembersFilterBots)
+	VisitUnknownChatMembersFilter(unknownChatMembersFilter *UnknownChatMembersFilter)
+}
+
+// ChatMembersFilterCases holds the function MatchChatMembersFilter calls for each type of ChatMembersFilter;
+// types without a function are passed to the default case
+type ChatMembersFilterCases[R any] struct {
+	ChatMembersFilterContacts       func(chatMembersFilterContacts *ChatMembersFilterContacts) R
+	ChatMembersFilterAdministrators func(chatMembersFilterAdministrators *ChatMembersFilterAdministrators) R
+	ChatMembersFilterMembers        func(chatMembersFilterMembers *ChatMembersFilterMembers) R
+	ChatMembersFilterMention        func(chatMembersFilterMention *ChatMembersFilterMention) R
+	ChatMembersFilterRestricted     func(chatMembersFilterRestricted *ChatMembersFilterRestricted) R
+	ChatMembersFilterBanned         func(chatMembersFilterBanned *ChatMembersFilterBanned) R
+	ChatMembersFilterBots           func(chatMembersFilterBots *ChatMembersFilterBots) R
+}
+
+// MatchChatMembersFilter calls the case of the type of chatMembersFilter, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement ChatMembersFilterVisitor
+// to have the compiler check every type is handled.
+func MatchChatMembersFilter[R any](chatMembersFilter ChatMembersFilter, cases ChatMembersFilterCases[R], defaultCase func(chatMembersFilter ChatMembersFilter) R) R {
+	switch value := chatMembersFilter.(type) {
+	case *ChatMembersFilterContacts:
+		if cases.ChatMembersFilterContacts != nil {
+			return cases.ChatMembersFilterContacts(value)
+		}
+	case *ChatMembersFilterAdministrators:
+		if cases.ChatMembersFilterAdministrators != nil {
+			return cases.ChatMembersFilterAdministrators(value)
+		}
+	case *ChatMembersFilterMembers:
+		if cases.ChatMembersFilterMembers != nil {
+			return cases.ChatMembersFilterMembers(value)
+		}
+	case *ChatMembersFilterMention:
+		if cases.ChatMembersFilterMention != nil {
+			return cases.ChatMembersFilterMention(value)
+		}
+	case *ChatMembersFilterRestricted:
+		if cases.ChatMembersFilterRestricted != nil {
+			return cases.ChatMembersFilterRestricted(value)
+		}
+	case *ChatMembersFilterBanned:
+		if cases.ChatMembersFilterBanned != nil {
+			return cases.ChatMembersFilterBanned(value)
+		}
+	case *ChatMembersFilterBots:
+		if cases.ChatMembersFilterBots != nil {
+			return cases.ChatMembersFilterBots(value)
+		}
+	}
+	return defaultCase(chatMembersFilter)
+}
+
+// Clone returns a deep copy of the object
+func (unknownChatMembersFilter *UnknownChatMembersFilter) Clone() *UnknownChatMembersFilter {
+	if unknownChatMembersFilter == nil {
+		return nil
+	}
+	return &UnknownChatMembersFilter{Unknown: unknownChatMembersFilter.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownChatMembersFilter *UnknownChatMembersFilter) Equal(other *UnknownChatMembersFilter) bool {
+	if unknownChatMembersFilter == nil || other == nil {
+		return unknownChatMembersFilter == other
+	}
+	return unknownChatMembersFilter.Unknown.equal(other.Unknown)
+}
+
+func cloneChatMembersFilter(chatMembersFilter ChatMembersFilter) ChatMembersFilter {
+	switch value := chatMembersFilter.(type) {
+	case *ChatMembersFilterContacts:
+		return value.Clone()
+	case *ChatMembersFilterAdministrators:
+		return value.Clone()
+	case *ChatMembersFilterMembers:
+		return value.Clone()
+	case *ChatMembersFilterMention:
+		return value.Clone()
+	case *ChatMembersFilterRestricted:
+		return value.Clone()
+	case *ChatMembersFilterBanned:
+		return value.Clone()
+	case *ChatMembersFilterBots:
+		return value.Clone()
+	case *UnknownChatMembersFilter:
+		return value.Clone()
+	}
+	return chatMembersFilter
+}
+
+func equalChatMembersFilter(a ChatMembersFilter, b ChatMembersFilter) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *ChatMembersFilterContacts:
+		b, ok := b.(*ChatMembersFilterContacts)
+		return ok && a.Equal(b)
+	case *ChatMembersFilterAdministrators:
+		b, ok := b.(*ChatMembersFilterAdministrators)
+		return ok && a.Equal(b)
+	case *ChatMembersFilterMembers:
+		b, ok := b.(*ChatMembersFilterMembers)
+		return ok && a.Equal(b)
+	case *ChatMembersFilterMention:
+		b, ok := b.(*ChatMembersFilterMention)
+		return ok && a.Equal(b)
+	case *ChatMembersFilterRestricted:
+		b, ok := b.(*ChatMembersFilterRestricted)
+		return ok && a.Equal(b)
+	case *ChatMembersFilterBanned:
+		b, ok := b.(*ChatMembersFilterBanned)
+		return ok && a.Equal(b)
+	case *ChatMembersFilterBots:
+		b, ok := b.(*ChatMembersFilterBots)
+		return ok && a.Equal(b)
+	case *UnknownChatMembersFilter:
+		b, ok := b.(*UnknownChatMembersFilter)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffChatMembersFilter(path string, a ChatMembersFilter, b ChatMembersFilter, changes []Change) []Change {
+	switch a := a.(type) {
+	case *ChatMembersFilterContacts:
+		if b, ok := b.(*ChatMembersFilterContacts); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatMembersFilterAdministrators:
+		if b, ok := b.(*ChatMembersFilterAdministrators); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatMembersFilterMembers:
+		if b, ok := b.(*ChatMembersFilterMembers); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatMembersFilterMention:
+		if b, ok := b.(*ChatMembersFilterMention); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatMembersFilterRestricted:
+		if b, ok := b.(*ChatMembersFilterRestricted); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatMembersFilterBanned:
+		if b, ok := b.(*ChatMembersFilterBanned); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatMembersFilterBots:
+		if b, ok := b.(*ChatMembersFilterBots); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalChatMembersFilter(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
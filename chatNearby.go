@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // ChatNearby Describes a chat located nearby
 type ChatNearby struct {
 	tdCommon
@@ -25,3 +30,116 @@ func NewChatNearby(chatId int64, distance int32) *ChatNearby {
 
 	return &chatNearbyTemp
 }
+
+// MarshalJSON marshals to json
+func (chatNearby *ChatNearby) MarshalJSON() ([]byte, error) {
+	return chatNearby.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatNearby *ChatNearby) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatNearby)
+}
+
+func (chatNearby *ChatNearby) appendJSON(b []byte) ([]byte, error) {
+	if chatNearby == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatNearby.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatNearby.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(chatNearby.ChatId))
+	b = append(b, `,"distance":`...)
+	b = appendJSONInt(b, int64(chatNearby.Distance))
+	return append(b, '}'), nil
+}
+
+func (chatNearby *ChatNearby) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatNearby.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatNearby.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			chatNearby.ChatId, err = r.readInt64()
+		case "distance":
+			chatNearby.Distance, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatNearby *ChatNearby) Clone() *ChatNearby {
+	if chatNearby == nil {
+		return nil
+	}
+	clone := *chatNearby
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatNearby *ChatNearby) Equal(other *ChatNearby) bool {
+	if chatNearby == nil || other == nil {
+		return chatNearby == other
+	}
+	if chatNearby.ChatId != other.ChatId {
+		return false
+	}
+	if chatNearby.Distance != other.Distance {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatNearby *ChatNearby) Diff(other *ChatNearby) []Change {
+	return chatNearby.diff("", other, nil)
+}
+
+func (chatNearby *ChatNearby) diff(path string, other *ChatNearby, changes []Change) []Change {
+	if chatNearby == nil || other == nil {
+		if chatNearby != other {
+			changes = append(changes, Change{Path: path, Old: chatNearby, New: other})
+		}
+		return changes
+	}
+	if chatNearby.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: chatNearby.ChatId, New: other.ChatId})
+	}
+	if chatNearby.Distance != other.Distance {
+		changes = append(changes, Change{Path: fieldPath(path, "distance"), Old: chatNearby.Distance, New: other.Distance})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatNearby *ChatNearby) String() string {
+	return objectString(chatNearby)
+}
+
+// LogValue logs the object as its summary
+func (chatNearby *ChatNearby) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatNearby))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatNearby *ChatNearby) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatNearby)
+}
+
+func (chatNearby *ChatNearby) printText(p *printer) {
+	if chatNearby == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatNearby")
+	p.int("chat_id", int64(chatNearby.ChatId))
+	p.int("distance", int64(chatNearby.Distance))
+	p.endObject(state)
+}
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // ChatNotificationSettings Contains information about notification settings for a chat
 type ChatNotificationSettings struct {
 	tdCommon
@@ -49,3 +54,204 @@ func NewChatNotificationSettings(useDefaultMuteFor bool, muteFor int32, useDefau
 
 	return &chatNotificationSettingsTemp
 }
+
+// MarshalJSON marshals to json
+func (chatNotificationSettings *ChatNotificationSettings) MarshalJSON() ([]byte, error) {
+	return chatNotificationSettings.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatNotificationSettings *ChatNotificationSettings) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatNotificationSettings)
+}
+
+func (chatNotificationSettings *ChatNotificationSettings) appendJSON(b []byte) ([]byte, error) {
+	if chatNotificationSettings == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatNotificationSettings.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatNotificationSettings.tdCommon.Extra)
+	b = append(b, `,"use_default_mute_for":`...)
+	b = appendJSONBool(b, chatNotificationSettings.UseDefaultMuteFor)
+	b = append(b, `,"mute_for":`...)
+	b = appendJSONInt(b, int64(chatNotificationSettings.MuteFor))
+	b = append(b, `,"use_default_sound":`...)
+	b = appendJSONBool(b, chatNotificationSettings.UseDefaultSound)
+	b = append(b, `,"sound":`...)
+	b = appendJSONString(b, chatNotificationSettings.Sound)
+	b = append(b, `,"use_default_show_preview":`...)
+	b = appendJSONBool(b, chatNotificationSettings.UseDefaultShowPreview)
+	b = append(b, `,"show_preview":`...)
+	b = appendJSONBool(b, chatNotificationSettings.ShowPreview)
+	b = append(b, `,"use_default_disable_pinned_message_notifications":`...)
+	b = appendJSONBool(b, chatNotificationSettings.UseDefaultDisablePinnedMessageNotifications)
+	b = append(b, `,"disable_pinned_message_notifications":`...)
+	b = appendJSONBool(b, chatNotificationSettings.DisablePinnedMessageNotifications)
+	b = append(b, `,"use_default_disable_mention_notifications":`...)
+	b = appendJSONBool(b, chatNotificationSettings.UseDefaultDisableMentionNotifications)
+	b = append(b, `,"disable_mention_notifications":`...)
+	b = appendJSONBool(b, chatNotificationSettings.DisableMentionNotifications)
+	return append(b, '}'), nil
+}
+
+func (chatNotificationSettings *ChatNotificationSettings) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatNotificationSettings.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatNotificationSettings.tdCommon.Extra, err = r.readString()
+		case "use_default_mute_for":
+			chatNotificationSettings.UseDefaultMuteFor, err = r.readBool()
+		case "mute_for":
+			chatNotificationSettings.MuteFor, err = r.readInt32()
+		case "use_default_sound":
+			chatNotificationSettings.UseDefaultSound, err = r.readBool()
+		case "sound":
+			chatNotificationSettings.Sound, err = r.readString()
+		case "use_default_show_preview":
+			chatNotificationSettings.UseDefaultShowPreview, err = r.readBool()
+		case "show_preview":
+			chatNotificationSettings.ShowPreview, err = r.readBool()
+		case "use_default_disable_pinned_message_notifications":
+			chatNotificationSettings.UseDefaultDisablePinnedMessageNotifications, err = r.readBool()
+		case "disable_pinned_message_notifications":
+			chatNotificationSettings.DisablePinnedMessageNotifications, err = r.readBool()
+		case "use_default_disable_mention_notifications":
+			chatNotificationSettings.UseDefaultDisableMentionNotifications, err = r.readBool()
+		case "disable_mention_notifications":
+			chatNotificationSettings.DisableMentionNotifications, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatNotificationSettings *ChatNotificationSettings) Clone() *ChatNotificationSettings {
+	if chatNotificationSettings == nil {
+		return nil
+	}
+	clone := *chatNotificationSettings
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatNotificationSettings *ChatNotificationSettings) Equal(other *ChatNotificationSettings) bool {
+	if chatNotificationSettings == nil || other == nil {
+		return chatNotificationSettings == other
+	}
+	if chatNotificationSettings.UseDefaultMuteFor != other.UseDefaultMuteFor {
+		return false
+	}
+	if chatNotificationSettings.MuteFor != other.MuteFor {
+		return false
+	}
+	if chatNotificationSettings.UseDefaultSound != other.UseDefaultSound {
+		return false
+	}
+	if chatNotificationSettings.Sound != other.Sound {
+		return false
+	}
+	if chatNotificationSettings.UseDefaultShowPreview != other.UseDefaultShowPreview {
+		return false
+	}
+	if chatNotificationSettings.ShowPreview != other.ShowPreview {
+		return false
+	}
+	if chatNotificationSettings.UseDefaultDisablePinnedMessageNotifications != other.UseDefaultDisablePinnedMessageNotifications {
+		return false
+	}
+	if chatNotificationSettings.DisablePinnedMessageNotifications != other.DisablePinnedMessageNotifications {
+		return false
+	}
+	if chatNotificationSettings.UseDefaultDisableMentionNotifications != other.UseDefaultDisableMentionNotifications {
+		return false
+	}
+	if chatNotificationSettings.DisableMentionNotifications != other.DisableMentionNotifications {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatNotificationSettings *ChatNotificationSettings) Diff(other *ChatNotificationSettings) []Change {
+	return chatNotificationSettings.diff("", other, nil)
+}
+
+func (chatNotificationSettings *ChatNotificationSettings) diff(path string, other *ChatNotificationSettings, changes []Change) []Change {
+	if chatNotificationSettings == nil || other == nil {
+		if chatNotificationSettings != other {
+			changes = append(changes, Change{Path: path, Old: chatNotificationSettings, New: other})
+		}
+		return changes
+	}
+	if chatNotificationSettings.UseDefaultMuteFor != other.UseDefaultMuteFor {
+		changes = append(changes, Change{Path: fieldPath(path, "use_default_mute_for"), Old: chatNotificationSettings.UseDefaultMuteFor, New: other.UseDefaultMuteFor})
+	}
+	if chatNotificationSettings.MuteFor != other.MuteFor {
+		changes = append(changes, Change{Path: fieldPath(path, "mute_for"), Old: chatNotificationSettings.MuteFor, New: other.MuteFor})
+	}
+	if chatNotificationSettings.UseDefaultSound != other.UseDefaultSound {
+		changes = append(changes, Change{Path: fieldPath(path, "use_default_sound"), Old: chatNotificationSettings.UseDefaultSound, New: other.UseDefaultSound})
+	}
+	if chatNotificationSettings.Sound != other.Sound {
+		changes = append(changes, Change{Path: fieldPath(path, "sound"), Old: chatNotificationSettings.Sound, New: other.Sound})
+	}
+	if chatNotificationSettings.UseDefaultShowPreview != other.UseDefaultShowPreview {
+		changes = append(changes, Change{Path: fieldPath(path, "use_default_show_preview"), Old: chatNotificationSettings.UseDefaultShowPreview, New: other.UseDefaultShowPreview})
+	}
+	if chatNotificationSettings.ShowPreview != other.ShowPreview {
+		changes = append(changes, Change{Path: fieldPath(path, "show_preview"), Old: chatNotificationSettings.ShowPreview, New: other.ShowPreview})
+	}
+	if chatNotificationSettings.UseDefaultDisablePinnedMessageNotifications != other.UseDefaultDisablePinnedMessageNotifications {
+		changes = append(changes, Change{Path: fieldPath(path, "use_default_disable_pinned_message_notifications"), Old: chatNotificationSettings.UseDefaultDisablePinnedMessageNotifications, New: other.UseDefaultDisablePinnedMessageNotifications})
+	}
+	if chatNotificationSettings.DisablePinnedMessageNotifications != other.DisablePinnedMessageNotifications {
+		changes = append(changes, Change{Path: fieldPath(path, "disable_pinned_message_notifications"), Old: chatNotificationSettings.DisablePinnedMessageNotifications, New: other.DisablePinnedMessageNotifications})
+	}
+	if chatNotificationSettings.UseDefaultDisableMentionNotifications != other.UseDefaultDisableMentionNotifications {
+		changes = append(changes, Change{Path: fieldPath(path, "use_default_disable_mention_notifications"), Old: chatNotificationSettings.UseDefaultDisableMentionNotifications, New: other.UseDefaultDisableMentionNotifications})
+	}
+	if chatNotificationSettings.DisableMentionNotifications != other.DisableMentionNotifications {
+		changes = append(changes, Change{Path: fieldPath(path, "disable_mention_notifications"), Old: chatNotificationSettings.DisableMentionNotifications, New: other.DisableMentionNotifications})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatNotificationSettings *ChatNotificationSettings) String() string {
+	return objectString(chatNotificationSettings)
+}
+
+// LogValue logs the object as its summary
+func (chatNotificationSettings *ChatNotificationSettings) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatNotificationSettings))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatNotificationSettings *ChatNotificationSettings) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatNotificationSettings)
+}
+
+func (chatNotificationSettings *ChatNotificationSettings) printText(p *printer) {
+	if chatNotificationSettings == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatNotificationSettings")
+	p.bool("use_default_mute_for", chatNotificationSettings.UseDefaultMuteFor)
+	p.int("mute_for", int64(chatNotificationSettings.MuteFor))
+	p.bool("use_default_sound", chatNotificationSettings.UseDefaultSound)
+	p.string("sound", chatNotificationSettings.Sound)
+	p.bool("use_default_show_preview", chatNotificationSettings.UseDefaultShowPreview)
+	p.bool("show_preview", chatNotificationSettings.ShowPreview)
+	p.bool("use_default_disable_pinned_message_notifications", chatNotificationSettings.UseDefaultDisablePinnedMessageNotifications)
+	p.bool("disable_pinned_message_notifications", chatNotificationSettings.DisablePinnedMessageNotifications)
+	p.bool("use_default_disable_mention_notifications", chatNotificationSettings.UseDefaultDisableMentionNotifications)
+	p.bool("disable_mention_notifications", chatNotificationSettings.DisableMentionNotifications)
+	p.endObject(state)
+}
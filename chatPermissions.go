@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // ChatPermissions Describes actions that a user is allowed to take in a chat
 type ChatPermissions struct {
 	tdCommon
@@ -52,3 +57,215 @@ func NewChatPermissions(canSendMessages bool, canSendMediaMessages bool, canSend
 
 	return &chatPermissionsTemp
 }
+
+// MarshalJSON marshals to json
+func (chatPermissions *ChatPermissions) MarshalJSON() ([]byte, error) {
+	return chatPermissions.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatPermissions *ChatPermissions) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatPermissions)
+}
+
+func (chatPermissions *ChatPermissions) appendJSON(b []byte) ([]byte, error) {
+	if chatPermissions == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatPermissions.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatPermissions.tdCommon.Extra)
+	b = append(b, `,"can_send_messages":`...)
+	b = appendJSONBool(b, chatPermissions.CanSendMessages)
+	b = append(b, `,"can_send_media_messages":`...)
+	b = appendJSONBool(b, chatPermissions.CanSendMediaMessages)
+	b = append(b, `,"can_send_polls":`...)
+	b = appendJSONBool(b, chatPermissions.CanSendPolls)
+	b = append(b, `,"can_send_stickers":`...)
+	b = appendJSONBool(b, chatPermissions.CanSendStickers)
+	b = append(b, `,"can_send_animations":`...)
+	b = appendJSONBool(b, chatPermissions.CanSendAnimations)
+	b = append(b, `,"can_send_games":`...)
+	b = appendJSONBool(b, chatPermissions.CanSendGames)
+	b = append(b, `,"can_use_inline_bots":`...)
+	b = appendJSONBool(b, chatPermissions.CanUseInlineBots)
+	b = append(b, `,"can_add_web_page_previews":`...)
+	b = appendJSONBool(b, chatPermissions.CanAddWebPagePreviews)
+	b = append(b, `,"can_change_info":`...)
+	b = appendJSONBool(b, chatPermissions.CanChangeInfo)
+	b = append(b, `,"can_invite_users":`...)
+	b = appendJSONBool(b, chatPermissions.CanInviteUsers)
+	b = append(b, `,"can_pin_messages":`...)
+	b = appendJSONBool(b, chatPermissions.CanPinMessages)
+	return append(b, '}'), nil
+}
+
+func (chatPermissions *ChatPermissions) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatPermissions.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatPermissions.tdCommon.Extra, err = r.readString()
+		case "can_send_messages":
+			chatPermissions.CanSendMessages, err = r.readBool()
+		case "can_send_media_messages":
+			chatPermissions.CanSendMediaMessages, err = r.readBool()
+		case "can_send_polls":
+			chatPermissions.CanSendPolls, err = r.readBool()
+		case "can_send_stickers":
+			chatPermissions.CanSendStickers, err = r.readBool()
+		case "can_send_animations":
+			chatPermissions.CanSendAnimations, err = r.readBool()
+		case "can_send_games":
+			chatPermissions.CanSendGames, err = r.readBool()
+		case "can_use_inline_bots":
+			chatPermissions.CanUseInlineBots, err = r.readBool()
+		case "can_add_web_page_previews":
+			chatPermissions.CanAddWebPagePreviews, err = r.readBool()
+		case "can_change_info":
+			chatPermissions.CanChangeInfo, err = r.readBool()
+		case "can_invite_users":
+			chatPermissions.CanInviteUsers, err = r.readBool()
+		case "can_pin_messages":
+			chatPermissions.CanPinMessages, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatPermissions *ChatPermissions) Clone() *ChatPermissions {
+	if chatPermissions == nil {
+		return nil
+	}
+	clone := *chatPermissions
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatPermissions *ChatPermissions) Equal(other *ChatPermissions) bool {
+	if chatPermissions == nil || other == nil {
+		return chatPermissions == other
+	}
+	if chatPermissions.CanSendMessages != other.CanSendMessages {
+		return false
+	}
+	if chatPermissions.CanSendMediaMessages != other.CanSendMediaMessages {
+		return false
+	}
+	if chatPermissions.CanSendPolls != other.CanSendPolls {
+		return false
+	}
+	if chatPermissions.CanSendStickers != other.CanSendStickers {
+		return false
+	}
+	if chatPermissions.CanSendAnimations != other.CanSendAnimations {
+		return false
+	}
+	if chatPermissions.CanSendGames != other.CanSendGames {
+		return false
+	}
+	if chatPermissions.CanUseInlineBots != other.CanUseInlineBots {
+		return false
+	}
+	if chatPermissions.CanAddWebPagePreviews != other.CanAddWebPagePreviews {
+		return false
+	}
+	if chatPermissions.CanChangeInfo != other.CanChangeInfo {
+		return false
+	}
+	if chatPermissions.CanInviteUsers != other.CanInviteUsers {
+		return false
+	}
+	if chatPermissions.CanPinMessages != other.CanPinMessages {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatPermissions *ChatPermissions) Diff(other *ChatPermissions) []Change {
+	return chatPermissions.diff("", other, nil)
+}
+
+func (chatPermissions *ChatPermissions) diff(path string, other *ChatPermissions, changes []Change) []Change {
+	if chatPermissions == nil || other == nil {
+		if chatPermissions != other {
+			changes = append(changes, Change{Path: path, Old: chatPermissions, New: other})
+		}
+		return changes
+	}
+	if chatPermissions.CanSendMessages != other.CanSendMessages {
+		changes = append(changes, Change{Path: fieldPath(path, "can_send_messages"), Old: chatPermissions.CanSendMessages, New: other.CanSendMessages})
+	}
+	if chatPermissions.CanSendMediaMessages != other.CanSendMediaMessages {
+		changes = append(changes, Change{Path: fieldPath(path, "can_send_media_messages"), Old: chatPermissions.CanSendMediaMessages, New: other.CanSendMediaMessages})
+	}
+	if chatPermissions.CanSendPolls != other.CanSendPolls {
+		changes = append(changes, Change{Path: fieldPath(path, "can_send_polls"), Old: chatPermissions.CanSendPolls, New: other.CanSendPolls})
+	}
+	if chatPermissions.CanSendStickers != other.CanSendStickers {
+		changes = append(changes, Change{Path: fieldPath(path, "can_send_stickers"), Old: chatPermissions.CanSendStickers, New: other.CanSendStickers})
+	}
+	if chatPermissions.CanSendAnimations != other.CanSendAnimations {
+		changes = append(changes, Change{Path: fieldPath(path, "can_send_animations"), Old: chatPermissions.CanSendAnimations, New: other.CanSendAnimations})
+	}
+	if chatPermissions.CanSendGames != other.CanSendGames {
+		changes = append(changes, Change{Path: fieldPath(path, "can_send_games"), Old: chatPermissions.CanSendGames, New: other.CanSendGames})
+	}
+	if chatPermissions.CanUseInlineBots != other.CanUseInlineBots {
+		changes = append(changes, Change{Path: fieldPath(path, "can_use_inline_bots"), Old: chatPermissions.CanUseInlineBots, New: other.CanUseInlineBots})
+	}
+	if chatPermissions.CanAddWebPagePreviews != other.CanAddWebPagePreviews {
+		changes = append(changes, Change{Path: fieldPath(path, "can_add_web_page_previews"), Old: chatPermissions.CanAddWebPagePreviews, New: other.CanAddWebPagePreviews})
+	}
+	if chatPermissions.CanChangeInfo != other.CanChangeInfo {
+		changes = append(changes, Change{Path: fieldPath(path, "can_change_info"), Old: chatPermissions.CanChangeInfo, New: other.CanChangeInfo})
+	}
+	if chatPermissions.CanInviteUsers != other.CanInviteUsers {
+		changes = append(changes, Change{Path: fieldPath(path, "can_invite_users"), Old: chatPermissions.CanInviteUsers, New: other.CanInviteUsers})
+	}
+	if chatPermissions.CanPinMessages != other.CanPinMessages {
+		changes = append(changes, Change{Path: fieldPath(path, "can_pin_messages"), Old: chatPermissions.CanPinMessages, New: other.CanPinMessages})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatPermissions *ChatPermissions) String() string {
+	return objectString(chatPermissions)
+}
+
+// LogValue logs the object as its summary
+func (chatPermissions *ChatPermissions) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatPermissions))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatPermissions *ChatPermissions) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatPermissions)
+}
+
+func (chatPermissions *ChatPermissions) printText(p *printer) {
+	if chatPermissions == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatPermissions")
+	p.bool("can_send_messages", chatPermissions.CanSendMessages)
+	p.bool("can_send_media_messages", chatPermissions.CanSendMediaMessages)
+	p.bool("can_send_polls", chatPermissions.CanSendPolls)
+	p.bool("can_send_stickers", chatPermissions.CanSendStickers)
+	p.bool("can_send_animations", chatPermissions.CanSendAnimations)
+	p.bool("can_send_games", chatPermissions.CanSendGames)
+	p.bool("can_use_inline_bots", chatPermissions.CanUseInlineBots)
+	p.bool("can_add_web_page_previews", chatPermissions.CanAddWebPagePreviews)
+	p.bool("can_change_info", chatPermissions.CanChangeInfo)
+	p.bool("can_invite_users", chatPermissions.CanInviteUsers)
+	p.bool("can_pin_messages", chatPermissions.CanPinMessages)
+	p.endObject(state)
+}
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // ChatPhoto Describes a chat or user profile photo
 type ChatPhoto struct {
 	tdCommon
@@ -34,3 +39,205 @@ func NewChatPhoto(id JSONInt64, addedDate int32, minithumbnail *Minithumbnail, s
 
 	return &chatPhotoTemp
 }
+
+// MarshalJSON marshals to json
+func (chatPhoto *ChatPhoto) MarshalJSON() ([]byte, error) {
+	return chatPhoto.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatPhoto *ChatPhoto) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatPhoto)
+}
+
+func (chatPhoto *ChatPhoto) appendJSON(b []byte) ([]byte, error) {
+	if chatPhoto == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatPhoto.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatPhoto.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt64String(b, int64(chatPhoto.Id))
+	b = append(b, `,"added_date":`...)
+	b = appendJSONInt(b, int64(chatPhoto.AddedDate))
+	b = append(b, `,"minithumbnail":`...)
+	b, err = chatPhoto.Minithumbnail.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"sizes":`...)
+	if chatPhoto.Sizes == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range chatPhoto.Sizes {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = chatPhoto.Sizes[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"animation":`...)
+	b, err = chatPhoto.Animation.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (chatPhoto *ChatPhoto) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatPhoto.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatPhoto.tdCommon.Extra, err = r.readString()
+		case "id":
+			chatPhoto.Id, err = r.readJSONInt64()
+		case "added_date":
+			chatPhoto.AddedDate, err = r.readInt32()
+		case "minithumbnail":
+			if r.readNull() {
+				chatPhoto.Minithumbnail = nil
+			} else {
+				chatPhoto.Minithumbnail = new(Minithumbnail)
+				err = chatPhoto.Minithumbnail.decodeJSON(r)
+			}
+		case "sizes":
+			if r.readNull() {
+				chatPhoto.Sizes = nil
+			} else {
+				chatPhoto.Sizes = make([]PhotoSize, 0)
+				err = r.readArray(func() (err error) {
+					var item0 PhotoSize
+					err = item0.decodeJSON(r)
+					chatPhoto.Sizes = append(chatPhoto.Sizes, item0)
+					return
+				})
+			}
+		case "animation":
+			if r.readNull() {
+				chatPhoto.Animation = nil
+			} else {
+				chatPhoto.Animation = new(AnimatedChatPhoto)
+				err = chatPhoto.Animation.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatPhoto *ChatPhoto) Clone() *ChatPhoto {
+	if chatPhoto == nil {
+		return nil
+	}
+	clone := *chatPhoto
+	clone.Minithumbnail = chatPhoto.Minithumbnail.Clone()
+	if chatPhoto.Sizes != nil {
+		clone.Sizes = make([]PhotoSize, len(chatPhoto.Sizes))
+		for i0 := range chatPhoto.Sizes {
+			clone.Sizes[i0] = *chatPhoto.Sizes[i0].Clone()
+		}
+	}
+	clone.Animation = chatPhoto.Animation.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatPhoto *ChatPhoto) Equal(other *ChatPhoto) bool {
+	if chatPhoto == nil || other == nil {
+		return chatPhoto == other
+	}
+	if chatPhoto.Id != other.Id {
+		return false
+	}
+	if chatPhoto.AddedDate != other.AddedDate {
+		return false
+	}
+	if !chatPhoto.Minithumbnail.Equal(other.Minithumbnail) {
+		return false
+	}
+	if len(chatPhoto.Sizes) != len(other.Sizes) {
+		return false
+	}
+	for i0 := range chatPhoto.Sizes {
+		if !chatPhoto.Sizes[i0].Equal(&other.Sizes[i0]) {
+			return false
+		}
+	}
+	if !chatPhoto.Animation.Equal(other.Animation) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatPhoto *ChatPhoto) Diff(other *ChatPhoto) []Change {
+	return chatPhoto.diff("", other, nil)
+}
+
+func (chatPhoto *ChatPhoto) diff(path string, other *ChatPhoto, changes []Change) []Change {
+	if chatPhoto == nil || other == nil {
+		if chatPhoto != other {
+			changes = append(changes, Change{Path: path, Old: chatPhoto, New: other})
+		}
+		return changes
+	}
+	if chatPhoto.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: chatPhoto.Id, New: other.Id})
+	}
+	if chatPhoto.AddedDate != other.AddedDate {
+		changes = append(changes, Change{Path: fieldPath(path, "added_date"), Old: chatPhoto.AddedDate, New: other.AddedDate})
+	}
+	changes = chatPhoto.Minithumbnail.diff(fieldPath(path, "minithumbnail"), other.Minithumbnail, changes)
+	if len(chatPhoto.Sizes) != len(other.Sizes) {
+		changes = append(changes, Change{Path: fieldPath(path, "sizes"), Old: chatPhoto.Sizes, New: other.Sizes})
+	} else {
+		for i0 := range chatPhoto.Sizes {
+			changes = chatPhoto.Sizes[i0].diff(indexPath(fieldPath(path, "sizes"), i0), &other.Sizes[i0], changes)
+		}
+	}
+	changes = chatPhoto.Animation.diff(fieldPath(path, "animation"), other.Animation, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatPhoto *ChatPhoto) String() string {
+	return objectString(chatPhoto)
+}
+
+// LogValue logs the object as its summary
+func (chatPhoto *ChatPhoto) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatPhoto))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatPhoto *ChatPhoto) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatPhoto)
+}
+
+func (chatPhoto *ChatPhoto) printText(p *printer) {
+	if chatPhoto == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatPhoto")
+	p.int("id", int64(chatPhoto.Id))
+	p.int("added_date", int64(chatPhoto.AddedDate))
+	p.object("minithumbnail", chatPhoto.Minithumbnail)
+	p.vector("sizes", len(chatPhoto.Sizes), func(i0 int) {
+		chatPhoto.Sizes[i0].printText(p)
+	})
+	p.object("animation", chatPhoto.Animation)
+	p.endObject(state)
+}
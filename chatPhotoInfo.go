@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // ChatPhotoInfo Contains basic information about the photo of a chat
 type ChatPhotoInfo struct {
 	tdCommon
@@ -31,3 +36,160 @@ func NewChatPhotoInfo(small *File, big *File, minithumbnail *Minithumbnail, hasA
 
 	return &chatPhotoInfoTemp
 }
+
+// MarshalJSON marshals to json
+func (chatPhotoInfo *ChatPhotoInfo) MarshalJSON() ([]byte, error) {
+	return chatPhotoInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatPhotoInfo *ChatPhotoInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatPhotoInfo)
+}
+
+func (chatPhotoInfo *ChatPhotoInfo) appendJSON(b []byte) ([]byte, error) {
+	if chatPhotoInfo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatPhotoInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatPhotoInfo.tdCommon.Extra)
+	b = append(b, `,"small":`...)
+	b, err = chatPhotoInfo.Small.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"big":`...)
+	b, err = chatPhotoInfo.Big.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"minithumbnail":`...)
+	b, err = chatPhotoInfo.Minithumbnail.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"has_animation":`...)
+	b = appendJSONBool(b, chatPhotoInfo.HasAnimation)
+	return append(b, '}'), nil
+}
+
+func (chatPhotoInfo *ChatPhotoInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatPhotoInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatPhotoInfo.tdCommon.Extra, err = r.readString()
+		case "small":
+			if r.readNull() {
+				chatPhotoInfo.Small = nil
+			} else {
+				chatPhotoInfo.Small = new(File)
+				err = chatPhotoInfo.Small.decodeJSON(r)
+			}
+		case "big":
+			if r.readNull() {
+				chatPhotoInfo.Big = nil
+			} else {
+				chatPhotoInfo.Big = new(File)
+				err = chatPhotoInfo.Big.decodeJSON(r)
+			}
+		case "minithumbnail":
+			if r.readNull() {
+				chatPhotoInfo.Minithumbnail = nil
+			} else {
+				chatPhotoInfo.Minithumbnail = new(Minithumbnail)
+				err = chatPhotoInfo.Minithumbnail.decodeJSON(r)
+			}
+		case "has_animation":
+			chatPhotoInfo.HasAnimation, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatPhotoInfo *ChatPhotoInfo) Clone() *ChatPhotoInfo {
+	if chatPhotoInfo == nil {
+		return nil
+	}
+	clone := *chatPhotoInfo
+	clone.Small = chatPhotoInfo.Small.Clone()
+	clone.Big = chatPhotoInfo.Big.Clone()
+	clone.Minithumbnail = chatPhotoInfo.Minithumbnail.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatPhotoInfo *ChatPhotoInfo) Equal(other *ChatPhotoInfo) bool {
+	if chatPhotoInfo == nil || other == nil {
+		return chatPhotoInfo == other
+	}
+	if !chatPhotoInfo.Small.Equal(other.Small) {
+		return false
+	}
+	if !chatPhotoInfo.Big.Equal(other.Big) {
+		return false
+	}
+	if !chatPhotoInfo.Minithumbnail.Equal(other.Minithumbnail) {
+		return false
+	}
+	if chatPhotoInfo.HasAnimation != other.HasAnimation {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatPhotoInfo *ChatPhotoInfo) Diff(other *ChatPhotoInfo) []Change {
+	return chatPhotoInfo.diff("", other, nil)
+}
+
+func (chatPhotoInfo *ChatPhotoInfo) diff(path string, other *ChatPhotoInfo, changes []Change) []Change {
+	if chatPhotoInfo == nil || other == nil {
+		if chatPhotoInfo != other {
+			changes = append(changes, Change{Path: path, Old: chatPhotoInfo, New: other})
+		}
+		return changes
+	}
+	changes = chatPhotoInfo.Small.diff(fieldPath(path, "small"), other.Small, changes)
+	changes = chatPhotoInfo.Big.diff(fieldPath(path, "big"), other.Big, changes)
+	changes = chatPhotoInfo.Minithumbnail.diff(fieldPath(path, "minithumbnail"), other.Minithumbnail, changes)
+	if chatPhotoInfo.HasAnimation != other.HasAnimation {
+		changes = append(changes, Change{Path: fieldPath(path, "has_animation"), Old: chatPhotoInfo.HasAnimation, New: other.HasAnimation})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatPhotoInfo *ChatPhotoInfo) String() string {
+	return objectString(chatPhotoInfo)
+}
+
+// LogValue logs the object as its summary
+func (chatPhotoInfo *ChatPhotoInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatPhotoInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatPhotoInfo *ChatPhotoInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatPhotoInfo)
+}
+
+func (chatPhotoInfo *ChatPhotoInfo) printText(p *printer) {
+	if chatPhotoInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatPhotoInfo")
+	p.object("small", chatPhotoInfo.Small)
+	p.object("big", chatPhotoInfo.Big)
+	p.object("minithumbnail", chatPhotoInfo.Minithumbnail)
+	p.bool("has_animation", chatPhotoInfo.HasAnimation)
+	p.endObject(state)
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ChatPhotos Contains a list of chat or user profile photos
@@ -31,6 +31,161 @@ func NewChatPhotos(totalCount int32, photos []ChatPhoto) *ChatPhotos {
 	return &chatPhotosTemp
 }
 
+// MarshalJSON marshals to json
+func (chatPhotos *ChatPhotos) MarshalJSON() ([]byte, error) {
+	return chatPhotos.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatPhotos *ChatPhotos) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatPhotos)
+}
+
+func (chatPhotos *ChatPhotos) appendJSON(b []byte) ([]byte, error) {
+	if chatPhotos == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatPhotos.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatPhotos.tdCommon.Extra)
+	b = append(b, `,"total_count":`...)
+	b = appendJSONInt(b, int64(chatPhotos.TotalCount))
+	b = append(b, `,"photos":`...)
+	if chatPhotos.Photos == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range chatPhotos.Photos {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = chatPhotos.Photos[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (chatPhotos *ChatPhotos) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatPhotos.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatPhotos.tdCommon.Extra, err = r.readString()
+		case "total_count":
+			chatPhotos.TotalCount, err = r.readInt32()
+		case "photos":
+			if r.readNull() {
+				chatPhotos.Photos = nil
+			} else {
+				chatPhotos.Photos = make([]ChatPhoto, 0)
+				err = r.readArray(func() (err error) {
+					var item0 ChatPhoto
+					err = item0.decodeJSON(r)
+					chatPhotos.Photos = append(chatPhotos.Photos, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatPhotos *ChatPhotos) Clone() *ChatPhotos {
+	if chatPhotos == nil {
+		return nil
+	}
+	clone := *chatPhotos
+	if chatPhotos.Photos != nil {
+		clone.Photos = make([]ChatPhoto, len(chatPhotos.Photos))
+		for i0 := range chatPhotos.Photos {
+			clone.Photos[i0] = *chatPhotos.Photos[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatPhotos *ChatPhotos) Equal(other *ChatPhotos) bool {
+	if chatPhotos == nil || other == nil {
+		return chatPhotos == other
+	}
+	if chatPhotos.TotalCount != other.TotalCount {
+		return false
+	}
+	if len(chatPhotos.Photos) != len(other.Photos) {
+		return false
+	}
+	for i0 := range chatPhotos.Photos {
+		if !chatPhotos.Photos[i0].Equal(&other.Photos[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatPhotos *ChatPhotos) Diff(other *ChatPhotos) []Change {
+	return chatPhotos.diff("", other, nil)
+}
+
+func (chatPhotos *ChatPhotos) diff(path string, other *ChatPhotos, changes []Change) []Change {
+	if chatPhotos == nil || other == nil {
+		if chatPhotos != other {
+			changes = append(changes, Change{Path: path, Old: chatPhotos, New: other})
+		}
+		return changes
+	}
+	if chatPhotos.TotalCount != other.TotalCount {
+		changes = append(changes, Change{Path: fieldPath(path, "total_count"), Old: chatPhotos.TotalCount, New: other.TotalCount})
+	}
+	if len(chatPhotos.Photos) != len(other.Photos) {
+		changes = append(changes, Change{Path: fieldPath(path, "photos"), Old: chatPhotos.Photos, New: other.Photos})
+	} else {
+		for i0 := range chatPhotos.Photos {
+			changes = chatPhotos.Photos[i0].diff(indexPath(fieldPath(path, "photos"), i0), &other.Photos[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatPhotos *ChatPhotos) String() string {
+	return objectString(chatPhotos)
+}
+
+// LogValue logs the object as its summary
+func (chatPhotos *ChatPhotos) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatPhotos))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatPhotos *ChatPhotos) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatPhotos)
+}
+
+func (chatPhotos *ChatPhotos) printText(p *printer) {
+	if chatPhotos == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatPhotos")
+	p.int("total_count", int64(chatPhotos.TotalCount))
+	p.vector("photos", len(chatPhotos.Photos), func(i0 int) {
+		chatPhotos.Photos[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetUserProfilePhotos Returns the profile photos of a user. The result of this query may be outdated: some photos might have been deleted already
 // @param userId User identifier
 // @param offset The number of photos to skip; must be non-negative
@@ -47,11 +202,11 @@ func (client *Client) GetUserProfilePhotos(userId int64, offset int32, limit int
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chatPhotos ChatPhotos
-	err = json.Unmarshal(result.Raw, &chatPhotos)
+	err = jsonUnmarshal(result.Raw, &chatPhotos)
 	return &chatPhotos, err
 }
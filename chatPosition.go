@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // ChatPosition Describes a position of a chat in a chat list
@@ -36,33 +37,142 @@ func NewChatPosition(list ChatList, order JSONInt64, isPinned bool, source ChatS
 	return &chatPositionTemp
 }
 
+// MarshalJSON marshals to json
+func (chatPosition *ChatPosition) MarshalJSON() ([]byte, error) {
+	return chatPosition.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (chatPosition *ChatPosition) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, chatPosition)
+}
+
+func (chatPosition *ChatPosition) appendJSON(b []byte) ([]byte, error) {
+	if chatPosition == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatPosition.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatPosition.tdCommon.Extra)
+	b = append(b, `,"list":`...)
+	b, err = appendJSONValue(b, chatPosition.List)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-		Order    JSONInt64 `json:"order"`     // A parameter used to determine order of the chat in the chat list. Chats must be sorted by the pair (order, chat.id) in descending order
-		IsPinned bool      `json:"is_pinned"` // True, if the chat is pinned in the chat list
-
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	b = append(b, `,"order":`...)
+	b = appendJSONInt64String(b, int64(chatPosition.Order))
+	b = append(b, `,"is_pinned":`...)
+	b = appendJSONBool(b, chatPosition.IsPinned)
+	b = append(b, `,"source":`...)
+	b, err = appendJSONValue(b, chatPosition.Source)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (chatPosition *ChatPosition) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatPosition.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatPosition.tdCommon.Extra, err = r.readString()
+		case "list":
+			chatPosition.List, err = decodeChatList(r)
+		case "order":
+			chatPosition.Order, err = r.readJSONInt64()
+		case "is_pinned":
+			chatPosition.IsPinned, err = r.readBool()
+		case "source":
+			chatPosition.Source, err = decodeChatSource(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatPosition *ChatPosition) Clone() *ChatPosition {
+	if chatPosition == nil {
+		return nil
+	}
+	clone := *chatPosition
+	clone.List = cloneChatList(chatPosition.List)
+	clone.Source = cloneChatSource(chatPosition.Source)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatPosition *ChatPosition) Equal(other *ChatPosition) bool {
+	if chatPosition == nil || other == nil {
+		return chatPosition == other
+	}
+	if !equalChatList(chatPosition.List, other.List) {
+		return false
 	}
+	if chatPosition.Order != other.Order {
+		return false
+	}
+	if chatPosition.IsPinned != other.IsPinned {
+		return false
+	}
+	if !equalChatSource(chatPosition.Source, other.Source) {
+		return false
+	}
+	return true
+}
 
-	chatPosition.tdCommon = tempObj.tdCommon
-	chatPosition.Order = tempObj.Order
-	chatPosition.IsPinned = tempObj.IsPinned
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatPosition *ChatPosition) Diff(other *ChatPosition) []Change {
+	return chatPosition.diff("", other, nil)
+}
 
-	fieldList, _ := unmarshalChatList(objMap["list"])
-	chatPosition.List = fieldList
+func (chatPosition *ChatPosition) diff(path string, other *ChatPosition, changes []Change) []Change {
+	if chatPosition == nil || other == nil {
+		if chatPosition != other {
+			changes = append(changes, Change{Path: path, Old: chatPosition, New: other})
+		}
+		return changes
+	}
+	changes = diffChatList(fieldPath(path, "list"), chatPosition.List, other.List, changes)
+	if chatPosition.Order != other.Order {
+		changes = append(changes, Change{Path: fieldPath(path, "order"), Old: chatPosition.Order, New: other.Order})
+	}
+	if chatPosition.IsPinned != other.IsPinned {
+		changes = append(changes, Change{Path: fieldPath(path, "is_pinned"), Old: chatPosition.IsPinned, New: other.IsPinned})
+	}
+	changes = diffChatSource(fieldPath(path, "source"), chatPosition.Source, other.Source, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatPosition *ChatPosition) String() string {
+	return objectString(chatPosition)
+}
 
-	fieldSource, _ := unmarshalChatSource(objMap["source"])
-	chatPosition.Source = fieldSource
+// LogValue logs the object as its summary
+func (chatPosition *ChatPosition) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatPosition))
+}
 
-	return nil
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatPosition *ChatPosition) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatPosition)
+}
+
+func (chatPosition *ChatPosition) printText(p *printer) {
+	if chatPosition == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatPosition")
+	p.object("list", chatPosition.List)
+	p.int("order", int64(chatPosition.Order))
+	p.bool("is_pinned", chatPosition.IsPinned)
+	p.object("source", chatPosition.Source)
+	p.endObject(state)
 }
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ChatReportReason Describes the reason why a chat is reported
 type ChatReportReason interface {
 	GetChatReportReasonEnum() ChatReportReasonEnum
+	Accept(visitor ChatReportReasonVisitor)
 }
 
 // ChatReportReasonEnum Alias for abstract ChatReportReason 'Sub-Classes', used as constant-enum here
@@ -25,60 +27,103 @@ const (
 	ChatReportReasonCustomType            ChatReportReasonEnum = "chatReportReasonCustom"
 )
 
+// UnknownChatReportReason is a ChatReportReason of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownChatReportReason struct {
+	Unknown
+}
+
+// GetChatReportReasonEnum return the enum type of this object
+func (unknownChatReportReason *UnknownChatReportReason) GetChatReportReasonEnum() ChatReportReasonEnum {
+	return ChatReportReasonEnum(unknownChatReportReason.Type)
+}
+
+// Accept calls the method of the visitor for UnknownChatReportReason
+func (unknownChatReportReason *UnknownChatReportReason) Accept(visitor ChatReportReasonVisitor) {
+	visitor.VisitUnknownChatReportReason(unknownChatReportReason)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownChatReportReason *UnknownChatReportReason) String() string {
+	return objectString(unknownChatReportReason)
+}
+
+// LogValue logs the object as its summary
+func (unknownChatReportReason *UnknownChatReportReason) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownChatReportReason))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownChatReportReason *UnknownChatReportReason) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownChatReportReason)
+}
+
+func (unknownChatReportReason *UnknownChatReportReason) printText(p *printer) {
+	if unknownChatReportReason == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownChatReportReason")
+	p.string("@type", unknownChatReportReason.Type)
+	p.endObject(state)
+}
+
 func unmarshalChatReportReason(rawMsg *json.RawMessage) (ChatReportReason, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch ChatReportReasonEnum(objMap["@type"].(string)) {
+	switch ChatReportReasonEnum(typeName) {
 	case ChatReportReasonSpamType:
 		var chatReportReasonSpam ChatReportReasonSpam
-		err := json.Unmarshal(*rawMsg, &chatReportReasonSpam)
+		err := jsonUnmarshal(*rawMsg, &chatReportReasonSpam)
 		return &chatReportReasonSpam, err
 
 	case ChatReportReasonViolenceType:
 		var chatReportReasonViolence ChatReportReasonViolence
-		err := json.Unmarshal(*rawMsg, &chatReportReasonViolence)
+		err := jsonUnmarshal(*rawMsg, &chatReportReasonViolence)
 		return &chatReportReasonViolence, err
 
 	case ChatReportReasonPornographyType:
 		var chatReportReasonPornography ChatReportReasonPornography
-		err := json.Unmarshal(*rawMsg, &chatReportReasonPornography)
+		err := jsonUnmarshal(*rawMsg, &chatReportReasonPornography)
 		return &chatReportReasonPornography, err
 
 	case ChatReportReasonChildAbuseType:
 		var chatReportReasonChildAbuse ChatReportReasonChildAbuse
-		err := json.Unmarshal(*rawMsg, &chatReportReasonChildAbuse)
+		err := jsonUnmarshal(*rawMsg, &chatReportReasonChildAbuse)
 		return &chatReportReasonChildAbuse, err
 
 	case ChatReportReasonCopyrightType:
 		var chatReportReasonCopyright ChatReportReasonCopyright
-		err := json.Unmarshal(*rawMsg, &chatReportReasonCopyright)
+		err := jsonUnmarshal(*rawMsg, &chatReportReasonCopyright)
 		return &chatReportReasonCopyright, err
 
 	case ChatReportReasonUnrelatedLocationType:
 		var chatReportReasonUnrelatedLocation ChatReportReasonUnrelatedLocation
-		err := json.Unmarshal(*rawMsg, &chatReportReasonUnrelatedLocation)
+		err := jsonUnmarshal(*rawMsg, &chatReportReasonUnrelatedLocation)
 		return &chatReportReasonUnrelatedLocation, err
 
 	case ChatReportReasonFakeType:
 		var chatReportReasonFake ChatReportReasonFake
-		err := json.Unmarshal(*rawMsg, &chatReportReasonFake)
+		err := jsonUnmarshal(*rawMsg, &chatReportReasonFake)
 		return &chatReportReasonFake, err
 
 	case ChatReportReasonCustomType:
 		var chatReportReasonCustom ChatReportReasonCustom
-		err := json.Unmarshal(*rawMsg, &chatReportReasonCustom)
+		err := jsonUnmarshal(*rawMsg, &chatReportReasonCustom)
 		return &chatReportReasonCustom, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownChatReportReason{Unknown: unknown}, nil
 	}
 }
 
@@ -93,7 +138,6 @@ func (chatReportReasonSpam *ChatReportReasonSpam) MessageType() string {
 }
 
 // NewChatReportReasonSpam creates a new ChatReportReasonSpam
-//
 func NewChatReportReasonSpam() *ChatReportReasonSpam {
 	chatReportReasonSpamTemp := ChatReportReasonSpam{
 		tdCommon: tdCommon{Type: "chatReportReasonSpam"},
@@ -102,11 +146,107 @@ func NewChatReportReasonSpam() *ChatReportReasonSpam {
 	return &chatReportReasonSpamTemp
 }
 
+// MarshalJSON marshals to json
+func (chatReportReasonSpam *ChatReportReasonSpam) MarshalJSON() ([]byte, error) {
+	return chatReportReasonSpam.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatReportReasonSpam *ChatReportReasonSpam) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatReportReasonSpam)
+}
+
+func (chatReportReasonSpam *ChatReportReasonSpam) appendJSON(b []byte) ([]byte, error) {
+	if chatReportReasonSpam == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatReportReasonSpam.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatReportReasonSpam.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatReportReasonSpam *ChatReportReasonSpam) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatReportReasonSpam.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatReportReasonSpam.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatReportReasonSpam *ChatReportReasonSpam) Clone() *ChatReportReasonSpam {
+	if chatReportReasonSpam == nil {
+		return nil
+	}
+	clone := *chatReportReasonSpam
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatReportReasonSpam *ChatReportReasonSpam) Equal(other *ChatReportReasonSpam) bool {
+	if chatReportReasonSpam == nil || other == nil {
+		return chatReportReasonSpam == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatReportReasonSpam *ChatReportReasonSpam) Diff(other *ChatReportReasonSpam) []Change {
+	return chatReportReasonSpam.diff("", other, nil)
+}
+
+func (chatReportReasonSpam *ChatReportReasonSpam) diff(path string, other *ChatReportReasonSpam, changes []Change) []Change {
+	if chatReportReasonSpam == nil || other == nil {
+		if chatReportReasonSpam != other {
+			changes = append(changes, Change{Path: path, Old: chatReportReasonSpam, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatReportReasonSpam *ChatReportReasonSpam) String() string {
+	return objectString(chatReportReasonSpam)
+}
+
+// LogValue logs the object as its summary
+func (chatReportReasonSpam *ChatReportReasonSpam) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatReportReasonSpam))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatReportReasonSpam *ChatReportReasonSpam) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatReportReasonSpam)
+}
+
+func (chatReportReasonSpam *ChatReportReasonSpam) printText(p *printer) {
+	if chatReportReasonSpam == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatReportReasonSpam")
+	p.endObject(state)
+}
+
 // GetChatReportReasonEnum return the enum type of this object
 func (chatReportReasonSpam *ChatReportReasonSpam) GetChatReportReasonEnum() ChatReportReasonEnum {
 	return ChatReportReasonSpamType
 }
 
+// Accept calls the method of the visitor for ChatReportReasonSpam
+func (chatReportReasonSpam *ChatReportReasonSpam) Accept(visitor ChatReportReasonVisitor) {
+	visitor.VisitChatReportReasonSpam(chatReportReasonSpam)
+}
+
 // ChatReportReasonViolence The chat promotes violence
 type ChatReportReasonViolence struct {
 	tdCommon
@@ -118,7 +258,6 @@ func (chatReportReasonViolence *ChatReportReasonViolence) MessageType() string {
 }
 
 // NewChatReportReasonViolence creates a new ChatReportReasonViolence
-//
 func NewChatReportReasonViolence() *ChatReportReasonViolence {
 	chatReportReasonViolenceTemp := ChatReportReasonViolence{
 		tdCommon: tdCommon{Type: "chatReportReasonViolence"},
@@ -127,11 +266,107 @@ func NewChatReportReasonViolence() *ChatReportReasonViolence {
 	return &chatReportReasonViolenceTemp
 }
 
+// MarshalJSON marshals to json
+func (chatReportReasonViolence *ChatReportReasonViolence) MarshalJSON() ([]byte, error) {
+	return chatReportReasonViolence.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatReportReasonViolence *ChatReportReasonViolence) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatReportReasonViolence)
+}
+
+func (chatReportReasonViolence *ChatReportReasonViolence) appendJSON(b []byte) ([]byte, error) {
+	if chatReportReasonViolence == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatReportReasonViolence.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatReportReasonViolence.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatReportReasonViolence *ChatReportReasonViolence) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatReportReasonViolence.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatReportReasonViolence.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatReportReasonViolence *ChatReportReasonViolence) Clone() *ChatReportReasonViolence {
+	if chatReportReasonViolence == nil {
+		return nil
+	}
+	clone := *chatReportReasonViolence
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatReportReasonViolence *ChatReportReasonViolence) Equal(other *ChatReportReasonViolence) bool {
+	if chatReportReasonViolence == nil || other == nil {
+		return chatReportReasonViolence == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatReportReasonViolence *ChatReportReasonViolence) Diff(other *ChatReportReasonViolence) []Change {
+	return chatReportReasonViolence.diff("", other, nil)
+}
+
+func (chatReportReasonViolence *ChatReportReasonViolence) diff(path string, other *ChatReportReasonViolence, changes []Change) []Change {
+	if chatReportReasonViolence == nil || other == nil {
+		if chatReportReasonViolence != other {
+			changes = append(changes, Change{Path: path, Old: chatReportReasonViolence, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatReportReasonViolence *ChatReportReasonViolence) String() string {
+	return objectString(chatReportReasonViolence)
+}
+
+// LogValue logs the object as its summary
+func (chatReportReasonViolence *ChatReportReasonViolence) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatReportReasonViolence))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatReportReasonViolence *ChatReportReasonViolence) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatReportReasonViolence)
+}
+
+func (chatReportReasonViolence *ChatReportReasonViolence) printText(p *printer) {
+	if chatReportReasonViolence == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatReportReasonViolence")
+	p.endObject(state)
+}
+
 // GetChatReportReasonEnum return the enum type of this object
 func (chatReportReasonViolence *ChatReportReasonViolence) GetChatReportReasonEnum() ChatReportReasonEnum {
 	return ChatReportReasonViolenceType
 }
 
+// Accept calls the method of the visitor for ChatReportReasonViolence
+func (chatReportReasonViolence *ChatReportReasonViolence) Accept(visitor ChatReportReasonVisitor) {
+	visitor.VisitChatReportReasonViolence(chatReportReasonViolence)
+}
+
 // ChatReportReasonPornography The chat contains pornographic messages
 type ChatReportReasonPornography struct {
 	tdCommon
@@ -143,7 +378,6 @@ func (chatReportReasonPornography *ChatReportReasonPornography) MessageType() st
 }
 
 // NewChatReportReasonPornography creates a new ChatReportReasonPornography
-//
 func NewChatReportReasonPornography() *ChatReportReasonPornography {
 	chatReportReasonPornographyTemp := ChatReportReasonPornography{
 		tdCommon: tdCommon{Type: "chatReportReasonPornography"},
@@ -152,11 +386,107 @@ func NewChatReportReasonPornography() *ChatReportReasonPornography {
 	return &chatReportReasonPornographyTemp
 }
 
+// MarshalJSON marshals to json
+func (chatReportReasonPornography *ChatReportReasonPornography) MarshalJSON() ([]byte, error) {
+	return chatReportReasonPornography.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatReportReasonPornography *ChatReportReasonPornography) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatReportReasonPornography)
+}
+
+func (chatReportReasonPornography *ChatReportReasonPornography) appendJSON(b []byte) ([]byte, error) {
+	if chatReportReasonPornography == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatReportReasonPornography.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatReportReasonPornography.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatReportReasonPornography *ChatReportReasonPornography) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatReportReasonPornography.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatReportReasonPornography.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatReportReasonPornography *ChatReportReasonPornography) Clone() *ChatReportReasonPornography {
+	if chatReportReasonPornography == nil {
+		return nil
+	}
+	clone := *chatReportReasonPornography
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatReportReasonPornography *ChatReportReasonPornography) Equal(other *ChatReportReasonPornography) bool {
+	if chatReportReasonPornography == nil || other == nil {
+		return chatReportReasonPornography == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatReportReasonPornography *ChatReportReasonPornography) Diff(other *ChatReportReasonPornography) []Change {
+	return chatReportReasonPornography.diff("", other, nil)
+}
+
+func (chatReportReasonPornography *ChatReportReasonPornography) diff(path string, other *ChatReportReasonPornography, changes []Change) []Change {
+	if chatReportReasonPornography == nil || other == nil {
+		if chatReportReasonPornography != other {
+			changes = append(changes, Change{Path: path, Old: chatReportReasonPornography, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatReportReasonPornography *ChatReportReasonPornography) String() string {
+	return objectString(chatReportReasonPornography)
+}
+
+// LogValue logs the object as its summary
+func (chatReportReasonPornography *ChatReportReasonPornography) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatReportReasonPornography))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatReportReasonPornography *ChatReportReasonPornography) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatReportReasonPornography)
+}
+
+func (chatReportReasonPornography *ChatReportReasonPornography) printText(p *printer) {
+	if chatReportReasonPornography == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatReportReasonPornography")
+	p.endObject(state)
+}
+
 // GetChatReportReasonEnum return the enum type of this object
 func (chatReportReasonPornography *ChatReportReasonPornography) GetChatReportReasonEnum() ChatReportReasonEnum {
 	return ChatReportReasonPornographyType
 }
 
+// Accept calls the method of the visitor for ChatReportReasonPornography
+func (chatReportReasonPornography *ChatReportReasonPornography) Accept(visitor ChatReportReasonVisitor) {
+	visitor.VisitChatReportReasonPornography(chatReportReasonPornography)
+}
+
 // ChatReportReasonChildAbuse The chat has child abuse related content
 type ChatReportReasonChildAbuse struct {
 	tdCommon
@@ -168,7 +498,6 @@ func (chatReportReasonChildAbuse *ChatReportReasonChildAbuse) MessageType() stri
 }
 
 // NewChatReportReasonChildAbuse creates a new ChatReportReasonChildAbuse
-//
 func NewChatReportReasonChildAbuse() *ChatReportReasonChildAbuse {
 	chatReportReasonChildAbuseTemp := ChatReportReasonChildAbuse{
 		tdCommon: tdCommon{Type: "chatReportReasonChildAbuse"},
@@ -177,11 +506,107 @@ func NewChatReportReasonChildAbuse() *ChatReportReasonChildAbuse {
 	return &chatReportReasonChildAbuseTemp
 }
 
+// MarshalJSON marshals to json
+func (chatReportReasonChildAbuse *ChatReportReasonChildAbuse) MarshalJSON() ([]byte, error) {
+	return chatReportReasonChildAbuse.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatReportReasonChildAbuse *ChatReportReasonChildAbuse) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatReportReasonChildAbuse)
+}
+
+func (chatReportReasonChildAbuse *ChatReportReasonChildAbuse) appendJSON(b []byte) ([]byte, error) {
+	if chatReportReasonChildAbuse == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatReportReasonChildAbuse.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatReportReasonChildAbuse.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatReportReasonChildAbuse *ChatReportReasonChildAbuse) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatReportReasonChildAbuse.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatReportReasonChildAbuse.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatReportReasonChildAbuse *ChatReportReasonChildAbuse) Clone() *ChatReportReasonChildAbuse {
+	if chatReportReasonChildAbuse == nil {
+		return nil
+	}
+	clone := *chatReportReasonChildAbuse
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatReportReasonChildAbuse *ChatReportReasonChildAbuse) Equal(other *ChatReportReasonChildAbuse) bool {
+	if chatReportReasonChildAbuse == nil || other == nil {
+		return chatReportReasonChildAbuse == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatReportReasonChildAbuse *ChatReportReasonChildAbuse) Diff(other *ChatReportReasonChildAbuse) []Change {
+	return chatReportReasonChildAbuse.diff("", other, nil)
+}
+
+func (chatReportReasonChildAbuse *ChatReportReasonChildAbuse) diff(path string, other *ChatReportReasonChildAbuse, changes []Change) []Change {
+	if chatReportReasonChildAbuse == nil || other == nil {
+		if chatReportReasonChildAbuse != other {
+			changes = append(changes, Change{Path: path, Old: chatReportReasonChildAbuse, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatReportReasonChildAbuse *ChatReportReasonChildAbuse) String() string {
+	return objectString(chatReportReasonChildAbuse)
+}
+
+// LogValue logs the object as its summary
+func (chatReportReasonChildAbuse *ChatReportReasonChildAbuse) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatReportReasonChildAbuse))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatReportReasonChildAbuse *ChatReportReasonChildAbuse) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatReportReasonChildAbuse)
+}
+
+func (chatReportReasonChildAbuse *ChatReportReasonChildAbuse) printText(p *printer) {
+	if chatReportReasonChildAbuse == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatReportReasonChildAbuse")
+	p.endObject(state)
+}
+
 // GetChatReportReasonEnum return the enum type of this object
 func (chatReportReasonChildAbuse *ChatReportReasonChildAbuse) GetChatReportReasonEnum() ChatReportReasonEnum {
 	return ChatReportReasonChildAbuseType
 }
 
+// Accept calls the method of the visitor for ChatReportReasonChildAbuse
+func (chatReportReasonChildAbuse *ChatReportReasonChildAbuse) Accept(visitor ChatReportReasonVisitor) {
+	visitor.VisitChatReportReasonChildAbuse(chatReportReasonChildAbuse)
+}
+
 // ChatReportReasonCopyright The chat contains copyrighted content
 type ChatReportReasonCopyright struct {
 	tdCommon
@@ -193,7 +618,6 @@ func (chatReportReasonCopyright *ChatReportReasonCopyright) MessageType() string
 }
 
 // NewChatReportReasonCopyright creates a new ChatReportReasonCopyright
-//
 func NewChatReportReasonCopyright() *ChatReportReasonCopyright {
 	chatReportReasonCopyrightTemp := ChatReportReasonCopyright{
 		tdCommon: tdCommon{Type: "chatReportReasonCopyright"},
@@ -202,11 +626,107 @@ func NewChatReportReasonCopyright() *ChatReportReasonCopyright {
 	return &chatReportReasonCopyrightTemp
 }
 
+// MarshalJSON marshals to json
+func (chatReportReasonCopyright *ChatReportReasonCopyright) MarshalJSON() ([]byte, error) {
+	return chatReportReasonCopyright.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatReportReasonCopyright *ChatReportReasonCopyright) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatReportReasonCopyright)
+}
+
+func (chatReportReasonCopyright *ChatReportReasonCopyright) appendJSON(b []byte) ([]byte, error) {
+	if chatReportReasonCopyright == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatReportReasonCopyright.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatReportReasonCopyright.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatReportReasonCopyright *ChatReportReasonCopyright) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatReportReasonCopyright.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatReportReasonCopyright.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatReportReasonCopyright *ChatReportReasonCopyright) Clone() *ChatReportReasonCopyright {
+	if chatReportReasonCopyright == nil {
+		return nil
+	}
+	clone := *chatReportReasonCopyright
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatReportReasonCopyright *ChatReportReasonCopyright) Equal(other *ChatReportReasonCopyright) bool {
+	if chatReportReasonCopyright == nil || other == nil {
+		return chatReportReasonCopyright == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatReportReasonCopyright *ChatReportReasonCopyright) Diff(other *ChatReportReasonCopyright) []Change {
+	return chatReportReasonCopyright.diff("", other, nil)
+}
+
+func (chatReportReasonCopyright *ChatReportReasonCopyright) diff(path string, other *ChatReportReasonCopyright, changes []Change) []Change {
+	if chatReportReasonCopyright == nil || other == nil {
+		if chatReportReasonCopyright != other {
+			changes = append(changes, Change{Path: path, Old: chatReportReasonCopyright, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatReportReasonCopyright *ChatReportReasonCopyright) String() string {
+	return objectString(chatReportReasonCopyright)
+}
+
+// LogValue logs the object as its summary
+func (chatReportReasonCopyright *ChatReportReasonCopyright) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatReportReasonCopyright))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatReportReasonCopyright *ChatReportReasonCopyright) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatReportReasonCopyright)
+}
+
+func (chatReportReasonCopyright *ChatReportReasonCopyright) printText(p *printer) {
+	if chatReportReasonCopyright == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatReportReasonCopyright")
+	p.endObject(state)
+}
+
 // GetChatReportReasonEnum return the enum type of this object
 func (chatReportReasonCopyright *ChatReportReasonCopyright) GetChatReportReasonEnum() ChatReportReasonEnum {
 	return ChatReportReasonCopyrightType
 }
 
+// Accept calls the method of the visitor for ChatReportReasonCopyright
+func (chatReportReasonCopyright *ChatReportReasonCopyright) Accept(visitor ChatReportReasonVisitor) {
+	visitor.VisitChatReportReasonCopyright(chatReportReasonCopyright)
+}
+
 // ChatReportReasonUnrelatedLocation The location-based chat is unrelated to its stated location
 type ChatReportReasonUnrelatedLocation struct {
 	tdCommon
@@ -218,7 +738,6 @@ func (chatReportReasonUnrelatedLocation *ChatReportReasonUnrelatedLocation) Mess
 }
 
 // NewChatReportReasonUnrelatedLocation creates a new ChatReportReasonUnrelatedLocation
-//
 func NewChatReportReasonUnrelatedLocation() *ChatReportReasonUnrelatedLocation {
 	chatReportReasonUnrelatedLocationTemp := ChatReportReasonUnrelatedLocation{
 		tdCommon: tdCommon{Type: "chatReportReasonUnrelatedLocation"},
@@ -227,11 +746,107 @@ func NewChatReportReasonUnrelatedLocation() *ChatReportReasonUnrelatedLocation {
 	return &chatReportReasonUnrelatedLocationTemp
 }
 
+// MarshalJSON marshals to json
+func (chatReportReasonUnrelatedLocation *ChatReportReasonUnrelatedLocation) MarshalJSON() ([]byte, error) {
+	return chatReportReasonUnrelatedLocation.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatReportReasonUnrelatedLocation *ChatReportReasonUnrelatedLocation) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatReportReasonUnrelatedLocation)
+}
+
+func (chatReportReasonUnrelatedLocation *ChatReportReasonUnrelatedLocation) appendJSON(b []byte) ([]byte, error) {
+	if chatReportReasonUnrelatedLocation == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatReportReasonUnrelatedLocation.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatReportReasonUnrelatedLocation.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatReportReasonUnrelatedLocation *ChatReportReasonUnrelatedLocation) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatReportReasonUnrelatedLocation.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatReportReasonUnrelatedLocation.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatReportReasonUnrelatedLocation *ChatReportReasonUnrelatedLocation) Clone() *ChatReportReasonUnrelatedLocation {
+	if chatReportReasonUnrelatedLocation == nil {
+		return nil
+	}
+	clone := *chatReportReasonUnrelatedLocation
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatReportReasonUnrelatedLocation *ChatReportReasonUnrelatedLocation) Equal(other *ChatReportReasonUnrelatedLocation) bool {
+	if chatReportReasonUnrelatedLocation == nil || other == nil {
+		return chatReportReasonUnrelatedLocation == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatReportReasonUnrelatedLocation *ChatReportReasonUnrelatedLocation) Diff(other *ChatReportReasonUnrelatedLocation) []Change {
+	return chatReportReasonUnrelatedLocation.diff("", other, nil)
+}
+
+func (chatReportReasonUnrelatedLocation *ChatReportReasonUnrelatedLocation) diff(path string, other *ChatReportReasonUnrelatedLocation, changes []Change) []Change {
+	if chatReportReasonUnrelatedLocation == nil || other == nil {
+		if chatReportReasonUnrelatedLocation != other {
+			changes = append(changes, Change{Path: path, Old: chatReportReasonUnrelatedLocation, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatReportReasonUnrelatedLocation *ChatReportReasonUnrelatedLocation) String() string {
+	return objectString(chatReportReasonUnrelatedLocation)
+}
+
+// LogValue logs the object as its summary
+func (chatReportReasonUnrelatedLocation *ChatReportReasonUnrelatedLocation) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatReportReasonUnrelatedLocation))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatReportReasonUnrelatedLocation *ChatReportReasonUnrelatedLocation) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatReportReasonUnrelatedLocation)
+}
+
+func (chatReportReasonUnrelatedLocation *ChatReportReasonUnrelatedLocation) printText(p *printer) {
+	if chatReportReasonUnrelatedLocation == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatReportReasonUnrelatedLocation")
+	p.endObject(state)
+}
+
 // GetChatReportReasonEnum return the enum type of this object
 func (chatReportReasonUnrelatedLocation *ChatReportReasonUnrelatedLocation) GetChatReportReasonEnum() ChatReportReasonEnum {
 	return ChatReportReasonUnrelatedLocationType
 }
 
+// Accept calls the method of the visitor for ChatReportReasonUnrelatedLocation
+func (chatReportReasonUnrelatedLocation *ChatReportReasonUnrelatedLocation) Accept(visitor ChatReportReasonVisitor) {
+	visitor.VisitChatReportReasonUnrelatedLocation(chatReportReasonUnrelatedLocation)
+}
+
 // ChatReportReasonFake The chat represents a fake account
 type ChatReportReasonFake struct {
 	tdCommon
@@ -243,7 +858,6 @@ func (chatReportReasonFake *ChatReportReasonFake) MessageType() string {
 }
 
 // NewChatReportReasonFake creates a new ChatReportReasonFake
-//
 func NewChatReportReasonFake() *ChatReportReasonFake {
 	chatReportReasonFakeTemp := ChatReportReasonFake{
 		tdCommon: tdCommon{Type: "chatReportReasonFake"},
@@ -252,11 +866,107 @@ func NewChatReportReasonFake() *ChatReportReasonFake {
 	return &chatReportReasonFakeTemp
 }
 
+// MarshalJSON marshals to json
+func (chatReportReasonFake *ChatReportReasonFake) MarshalJSON() ([]byte, error) {
+	return chatReportReasonFake.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatReportReasonFake *ChatReportReasonFake) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatReportReasonFake)
+}
+
+func (chatReportReasonFake *ChatReportReasonFake) appendJSON(b []byte) ([]byte, error) {
+	if chatReportReasonFake == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatReportReasonFake.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatReportReasonFake.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatReportReasonFake *ChatReportReasonFake) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatReportReasonFake.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatReportReasonFake.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatReportReasonFake *ChatReportReasonFake) Clone() *ChatReportReasonFake {
+	if chatReportReasonFake == nil {
+		return nil
+	}
+	clone := *chatReportReasonFake
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatReportReasonFake *ChatReportReasonFake) Equal(other *ChatReportReasonFake) bool {
+	if chatReportReasonFake == nil || other == nil {
+		return chatReportReasonFake == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatReportReasonFake *ChatReportReasonFake) Diff(other *ChatReportReasonFake) []Change {
+	return chatReportReasonFake.diff("", other, nil)
+}
+
+func (chatReportReasonFake *ChatReportReasonFake) diff(path string, other *ChatReportReasonFake, changes []Change) []Change {
+	if chatReportReasonFake == nil || other == nil {
+		if chatReportReasonFake != other {
+			changes = append(changes, Change{Path: path, Old: chatReportReasonFake, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatReportReasonFake *ChatReportReasonFake) String() string {
+	return objectString(chatReportReasonFake)
+}
+
+// LogValue logs the object as its summary
+func (chatReportReasonFake *ChatReportReasonFake) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatReportReasonFake))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatReportReasonFake *ChatReportReasonFake) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatReportReasonFake)
+}
+
+func (chatReportReasonFake *ChatReportReasonFake) printText(p *printer) {
+	if chatReportReasonFake == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatReportReasonFake")
+	p.endObject(state)
+}
+
 // GetChatReportReasonEnum return the enum type of this object
 func (chatReportReasonFake *ChatReportReasonFake) GetChatReportReasonEnum() ChatReportReasonEnum {
 	return ChatReportReasonFakeType
 }
 
+// Accept calls the method of the visitor for ChatReportReasonFake
+func (chatReportReasonFake *ChatReportReasonFake) Accept(visitor ChatReportReasonVisitor) {
+	visitor.VisitChatReportReasonFake(chatReportReasonFake)
+}
+
 // ChatReportReasonCustom A custom reason provided by the user
 type ChatReportReasonCustom struct {
 	tdCommon
@@ -268,7 +978,6 @@ func (chatReportReasonCustom *ChatReportReasonCustom) MessageType() string {
 }
 
 // NewChatReportReasonCustom creates a new ChatReportReasonCustom
-//
 func NewChatReportReasonCustom() *ChatReportReasonCustom {
 	chatReportReasonCustomTemp := ChatReportReasonCustom{
 		tdCommon: tdCommon{Type: "chatReportReasonCustom"},
@@ -277,7 +986,288 @@ func NewChatReportReasonCustom() *ChatReportReasonCustom {
 	return &chatReportReasonCustomTemp
 }
 
+// MarshalJSON marshals to json
+func (chatReportReasonCustom *ChatReportReasonCustom) MarshalJSON() ([]byte, error) {
+	return chatReportReasonCustom.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatReportReasonCustom *ChatReportReasonCustom) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatReportReasonCustom)
+}
+
+func (chatReportReasonCustom *ChatReportReasonCustom) appendJSON(b []byte) ([]byte, error) {
+	if chatReportReasonCustom == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatReportReasonCustom.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatReportReasonCustom.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatReportReasonCustom *ChatReportReasonCustom) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatReportReasonCustom.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatReportReasonCustom.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatReportReasonCustom *ChatReportReasonCustom) Clone() *ChatReportReasonCustom {
+	if chatReportReasonCustom == nil {
+		return nil
+	}
+	clone := *chatReportReasonCustom
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatReportReasonCustom *ChatReportReasonCustom) Equal(other *ChatReportReasonCustom) bool {
+	if chatReportReasonCustom == nil || other == nil {
+		return chatReportReasonCustom == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatReportReasonCustom *ChatReportReasonCustom) Diff(other *ChatReportReasonCustom) []Change {
+	return chatReportReasonCustom.diff("", other, nil)
+}
+
+func (chatReportReasonCustom *ChatReportReasonCustom) diff(path string, other *ChatReportReasonCustom, changes []Change) []Change {
+	if chatReportReasonCustom == nil || other == nil {
+		if chatReportReasonCustom != other {
+			changes = append(changes, Change{Path: path, Old: chatReportReasonCustom, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatReportReasonCustom *ChatReportReasonCustom) String() string {
+	return objectString(chatReportReasonCustom)
+}
+
+// LogValue logs the object as its summary
+func (chatReportReasonCustom *ChatReportReasonCustom) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatReportReasonCustom))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatReportReasonCustom *ChatReportReasonCustom) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatReportReasonCustom)
+}
+
+func (chatReportReasonCustom *ChatReportReasonCustom) printText(p *printer) {
+	if chatReportReasonCustom == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatReportReasonCustom")
+	p.endObject(state)
+}
+
 // GetChatReportReasonEnum return the enum type of this object
 func (chatReportReasonCustom *ChatReportReasonCustom) GetChatReportReasonEnum() ChatReportReasonEnum {
 	return ChatReportReasonCustomType
 }
+
+// Accept calls the method of the visitor for ChatReportReasonCustom
+func (chatReportReasonCustom *ChatReportReasonCustom) Accept(visitor ChatReportReasonVisitor) {
+	visitor.VisitChatReportReasonCustom(chatReportReasonCustom)
+}
+
+// ChatReportReasonVisitor visits every type of ChatReportReason, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to ChatReportReason, until they handle it too.
+type ChatReportReasonVisitor interface {
+	VisitChatReportReasonSpam(chatReportReasonSpam *ChatReportReasonSpam)
+	VisitChatReportReasonViolence(chatReportReasonViolence *ChatReportReasonViolence)
+	VisitChatReportReasonPornography(chatReportReasonPornography *ChatReportReasonPornography)
+	VisitChatReportReasonChildAbuse(chatReportReasonChildAbuse *ChatReportReasonChildAbuse)
+	VisitChatReportReasonCopyright(chatReportReasonCopyright *ChatReportReasonCopyright)
+	VisitChatReportReasonUnrelatedLocation(chatReportReasonUnrelatedLocation *ChatReportReasonUnrelatedLocation)
+	VisitChatReportReasonFake(chatReportReasonFake *ChatReportReasonFake)
+	VisitChatReportReasonCustom(chatReportReasonCustom *ChatReportReasonCustom)
+	VisitUnknownChatReportReason(unknownChatReportReason *UnknownChatReportReason)
+}
+
+// ChatReportReasonCases holds the function MatchChatReportReason calls for each type of ChatReportReason;
+// types without a function are passed to the default case
+type ChatReportReasonCases[R any] struct {
+	ChatReportReasonSpam              func(chatReportReasonSpam *ChatReportReasonSpam) R
+	ChatReportReasonViolence          func(chatReportReasonViolence *ChatReportReasonViolence) R
+	ChatReportReasonPornography       func(chatReportReasonPornography *ChatReportReasonPornography) R
+	ChatReportReasonChildAbuse        func(chatReportReasonChildAbuse *ChatReportReasonChildAbuse) R
+	ChatReportReasonCopyright         func(chatReportReasonCopyright *ChatReportReasonCopyright) R
+	ChatReportReasonUnrelatedLocation func(chatReportReasonUnrelatedLocation *ChatReportReasonUnrelatedLocation) R
+	ChatReportReasonFake              func(chatReportReasonFake *ChatReportReasonFake) R
+	ChatReportReasonCustom            func(chatReportReasonCustom *ChatReportReasonCustom) R
+}
+
+// MatchChatReportReason calls the case of the type of chatReportReason, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement ChatReportReasonVisitor
+// to have the compiler check every type is handled.
+func MatchChatReportReason[R any](chatReportReason ChatReportReason, cases ChatReportReasonCases[R], defaultCase func(chatReportReason ChatReportReason) R) R {
+	switch value := chatReportReason.(type) {
+	case *ChatReportReasonSpam:
+		if cases.ChatReportReasonSpam != nil {
+			return cases.ChatReportReasonSpam(value)
+		}
+	case *ChatReportReasonViolence:
+		if cases.ChatReportReasonViolence != nil {
+			return cases.ChatReportReasonViolence(value)
+		}
+	case *ChatReportReasonPornography:
+		if cases.ChatReportReasonPornography != nil {
+			return cases.ChatReportReasonPornography(value)
+		}
+	case *ChatReportReasonChildAbuse:
+		if cases.ChatReportReasonChildAbuse != nil {
+			return cases.ChatReportReasonChildAbuse(value)
+		}
+	case *ChatReportReasonCopyright:
+		if cases.ChatReportReasonCopyright != nil {
+			return cases.ChatReportReasonCopyright(value)
+		}
+	case *ChatReportReasonUnrelatedLocation:
+		if cases.ChatReportReasonUnrelatedLocation != nil {
+			return cases.ChatReportReasonUnrelatedLocation(value)
+		}
+	case *ChatReportReasonFake:
+		if cases.ChatReportReasonFake != nil {
+			return cases.ChatReportReasonFake(value)
+		}
+	case *ChatReportReasonCustom:
+		if cases.ChatReportReasonCustom != nil {
+			return cases.ChatReportReasonCustom(value)
+		}
+	}
+	return defaultCase(chatReportReason)
+}
+
+// Clone returns a deep copy of the object
+func (unknownChatReportReason *UnknownChatReportReason) Clone() *UnknownChatReportReason {
+	if unknownChatReportReason == nil {
+		return nil
+	}
+	return &UnknownChatReportReason{Unknown: unknownChatReportReason.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownChatReportReason *UnknownChatReportReason) Equal(other *UnknownChatReportReason) bool {
+	if unknownChatReportReason == nil || other == nil {
+		return unknownChatReportReason == other
+	}
+	return unknownChatReportReason.Unknown.equal(other.Unknown)
+}
+
+func cloneChatReportReason(chatReportReason ChatReportReason) ChatReportReason {
+	switch value := chatReportReason.(type) {
+	case *ChatReportReasonSpam:
+		return value.Clone()
+	case *ChatReportReasonViolence:
+		return value.Clone()
+	case *ChatReportReasonPornography:
+		return value.Clone()
+	case *ChatReportReasonChildAbuse:
+		return value.Clone()
+	case *ChatReportReasonCopyright:
+		return value.Clone()
+	case *ChatReportReasonUnrelatedLocation:
+		return value.Clone()
+	case *ChatReportReasonFake:
+		return value.Clone()
+	case *ChatReportReasonCustom:
+		return value.Clone()
+	case *UnknownChatReportReason:
+		return value.Clone()
+	}
+	return chatReportReason
+}
+
+func equalChatReportReason(a ChatReportReason, b ChatReportReason) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *ChatReportReasonSpam:
+		b, ok := b.(*ChatReportReasonSpam)
+		return ok && a.Equal(b)
+	case *ChatReportReasonViolence:
+		b, ok := b.(*ChatReportReasonViolence)
+		return ok && a.Equal(b)
+	case *ChatReportReasonPornography:
+		b, ok := b.(*ChatReportReasonPornography)
+		return ok && a.Equal(b)
+	case *ChatReportReasonChildAbuse:
+		b, ok := b.(*ChatReportReasonChildAbuse)
+		return ok && a.Equal(b)
+	case *ChatReportReasonCopyright:
+		b, ok := b.(*ChatReportReasonCopyright)
+		return ok && a.Equal(b)
+	case *ChatReportReasonUnrelatedLocation:
+		b, ok := b.(*ChatReportReasonUnrelatedLocation)
+		return ok && a.Equal(b)
+	case *ChatReportReasonFake:
+		b, ok := b.(*ChatReportReasonFake)
+		return ok && a.Equal(b)
+	case *ChatReportReasonCustom:
+		b, ok := b.(*ChatReportReasonCustom)
+		return ok && a.Equal(b)
+	case *UnknownChatReportReason:
+		b, ok := b.(*UnknownChatReportReason)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffChatReportReason(path string, a ChatReportReason, b ChatReportReason, changes []Change) []Change {
+	switch a := a.(type) {
+	case *ChatReportReasonSpam:
+		if b, ok := b.(*ChatReportReasonSpam); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatReportReasonViolence:
+		if b, ok := b.(*ChatReportReasonViolence); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatReportReasonPornography:
+		if b, ok := b.(*ChatReportReasonPornography); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatReportReasonChildAbuse:
+		if b, ok := b.(*ChatReportReasonChildAbuse); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatReportReasonCopyright:
+		if b, ok := b.(*ChatReportReasonCopyright); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatReportReasonUnrelatedLocation:
+		if b, ok := b.(*ChatReportReasonUnrelatedLocation); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatReportReasonFake:
+		if b, ok := b.(*ChatReportReasonFake); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatReportReasonCustom:
+		if b, ok := b.(*ChatReportReasonCustom); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalChatReportReason(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
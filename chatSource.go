@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ChatSource Describes a reason why an external chat is shown in a chat list
 type ChatSource interface {
 	GetChatSourceEnum() ChatSourceEnum
+	Accept(visitor ChatSourceVisitor)
 }
 
 // ChatSourceEnum Alias for abstract ChatSource 'Sub-Classes', used as constant-enum here
@@ -19,31 +21,82 @@ const (
 	ChatSourcePublicServiceAnnouncementType ChatSourceEnum = "chatSourcePublicServiceAnnouncement"
 )
 
+// UnknownChatSource is a ChatSource of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownChatSource struct {
+	Unknown
+}
+
+// GetChatSourceEnum return the enum type of this object
+func (unknownChatSource *UnknownChatSource) GetChatSourceEnum() ChatSourceEnum {
+	return ChatSourceEnum(unknownChatSource.Type)
+}
+
+// Accept calls the method of the visitor for UnknownChatSource
+func (unknownChatSource *UnknownChatSource) Accept(visitor ChatSourceVisitor) {
+	visitor.VisitUnknownChatSource(unknownChatSource)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownChatSource *UnknownChatSource) String() string {
+	return objectString(unknownChatSource)
+}
+
+// LogValue logs the object as its summary
+func (unknownChatSource *UnknownChatSource) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownChatSource))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownChatSource *UnknownChatSource) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownChatSource)
+}
+
+func (unknownChatSource *UnknownChatSource) printText(p *printer) {
+	if unknownChatSource == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownChatSource")
+	p.string("@type", unknownChatSource.Type)
+	p.endObject(state)
+}
+
 func unmarshalChatSource(rawMsg *json.RawMessage) (ChatSource, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch ChatSourceEnum(objMap["@type"].(string)) {
+	switch ChatSourceEnum(typeName) {
 	case ChatSourceMtprotoProxyType:
 		var chatSourceMtprotoProxy ChatSourceMtprotoProxy
-		err := json.Unmarshal(*rawMsg, &chatSourceMtprotoProxy)
+		err := jsonUnmarshal(*rawMsg, &chatSourceMtprotoProxy)
 		return &chatSourceMtprotoProxy, err
 
 	case ChatSourcePublicServiceAnnouncementType:
 		var chatSourcePublicServiceAnnouncement ChatSourcePublicServiceAnnouncement
-		err := json.Unmarshal(*rawMsg, &chatSourcePublicServiceAnnouncement)
+		err := jsonUnmarshal(*rawMsg, &chatSourcePublicServiceAnnouncement)
 		return &chatSourcePublicServiceAnnouncement, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownChatSource{Unknown: unknown}, nil
+	}
+}
+
+func decodeChatSource(r *jsonReader) (ChatSource, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
 	}
+	return unmarshalChatSource(rawMsg)
 }
 
 // ChatSourceMtprotoProxy The chat is sponsored by the user's MTProxy server
@@ -57,7 +110,6 @@ func (chatSourceMtprotoProxy *ChatSourceMtprotoProxy) MessageType() string {
 }
 
 // NewChatSourceMtprotoProxy creates a new ChatSourceMtprotoProxy
-//
 func NewChatSourceMtprotoProxy() *ChatSourceMtprotoProxy {
 	chatSourceMtprotoProxyTemp := ChatSourceMtprotoProxy{
 		tdCommon: tdCommon{Type: "chatSourceMtprotoProxy"},
@@ -66,11 +118,107 @@ func NewChatSourceMtprotoProxy() *ChatSourceMtprotoProxy {
 	return &chatSourceMtprotoProxyTemp
 }
 
+// MarshalJSON marshals to json
+func (chatSourceMtprotoProxy *ChatSourceMtprotoProxy) MarshalJSON() ([]byte, error) {
+	return chatSourceMtprotoProxy.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatSourceMtprotoProxy *ChatSourceMtprotoProxy) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatSourceMtprotoProxy)
+}
+
+func (chatSourceMtprotoProxy *ChatSourceMtprotoProxy) appendJSON(b []byte) ([]byte, error) {
+	if chatSourceMtprotoProxy == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatSourceMtprotoProxy.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatSourceMtprotoProxy.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (chatSourceMtprotoProxy *ChatSourceMtprotoProxy) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatSourceMtprotoProxy.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatSourceMtprotoProxy.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatSourceMtprotoProxy *ChatSourceMtprotoProxy) Clone() *ChatSourceMtprotoProxy {
+	if chatSourceMtprotoProxy == nil {
+		return nil
+	}
+	clone := *chatSourceMtprotoProxy
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatSourceMtprotoProxy *ChatSourceMtprotoProxy) Equal(other *ChatSourceMtprotoProxy) bool {
+	if chatSourceMtprotoProxy == nil || other == nil {
+		return chatSourceMtprotoProxy == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatSourceMtprotoProxy *ChatSourceMtprotoProxy) Diff(other *ChatSourceMtprotoProxy) []Change {
+	return chatSourceMtprotoProxy.diff("", other, nil)
+}
+
+func (chatSourceMtprotoProxy *ChatSourceMtprotoProxy) diff(path string, other *ChatSourceMtprotoProxy, changes []Change) []Change {
+	if chatSourceMtprotoProxy == nil || other == nil {
+		if chatSourceMtprotoProxy != other {
+			changes = append(changes, Change{Path: path, Old: chatSourceMtprotoProxy, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatSourceMtprotoProxy *ChatSourceMtprotoProxy) String() string {
+	return objectString(chatSourceMtprotoProxy)
+}
+
+// LogValue logs the object as its summary
+func (chatSourceMtprotoProxy *ChatSourceMtprotoProxy) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatSourceMtprotoProxy))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatSourceMtprotoProxy *ChatSourceMtprotoProxy) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatSourceMtprotoProxy)
+}
+
+func (chatSourceMtprotoProxy *ChatSourceMtprotoProxy) printText(p *printer) {
+	if chatSourceMtprotoProxy == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatSourceMtprotoProxy")
+	p.endObject(state)
+}
+
 // GetChatSourceEnum return the enum type of this object
 func (chatSourceMtprotoProxy *ChatSourceMtprotoProxy) GetChatSourceEnum() ChatSourceEnum {
 	return ChatSourceMtprotoProxyType
 }
 
+// Accept calls the method of the visitor for ChatSourceMtprotoProxy
+func (chatSourceMtprotoProxy *ChatSourceMtprotoProxy) Accept(visitor ChatSourceVisitor) {
+	visitor.VisitChatSourceMtprotoProxy(chatSourceMtprotoProxy)
+}
+
 // ChatSourcePublicServiceAnnouncement The chat contains a public service announcement
 type ChatSourcePublicServiceAnnouncement struct {
 	tdCommon
@@ -97,7 +245,220 @@ func NewChatSourcePublicServiceAnnouncement(typeParam string, text string) *Chat
 	return &chatSourcePublicServiceAnnouncementTemp
 }
 
+// MarshalJSON marshals to json
+func (chatSourcePublicServiceAnnouncement *ChatSourcePublicServiceAnnouncement) MarshalJSON() ([]byte, error) {
+	return chatSourcePublicServiceAnnouncement.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatSourcePublicServiceAnnouncement *ChatSourcePublicServiceAnnouncement) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatSourcePublicServiceAnnouncement)
+}
+
+func (chatSourcePublicServiceAnnouncement *ChatSourcePublicServiceAnnouncement) appendJSON(b []byte) ([]byte, error) {
+	if chatSourcePublicServiceAnnouncement == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatSourcePublicServiceAnnouncement.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatSourcePublicServiceAnnouncement.tdCommon.Extra)
+	b = append(b, `,"type":`...)
+	b = appendJSONString(b, chatSourcePublicServiceAnnouncement.Type)
+	b = append(b, `,"text":`...)
+	b = appendJSONString(b, chatSourcePublicServiceAnnouncement.Text)
+	return append(b, '}'), nil
+}
+
+func (chatSourcePublicServiceAnnouncement *ChatSourcePublicServiceAnnouncement) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatSourcePublicServiceAnnouncement.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatSourcePublicServiceAnnouncement.tdCommon.Extra, err = r.readString()
+		case "type":
+			chatSourcePublicServiceAnnouncement.Type, err = r.readString()
+		case "text":
+			chatSourcePublicServiceAnnouncement.Text, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatSourcePublicServiceAnnouncement *ChatSourcePublicServiceAnnouncement) Clone() *ChatSourcePublicServiceAnnouncement {
+	if chatSourcePublicServiceAnnouncement == nil {
+		return nil
+	}
+	clone := *chatSourcePublicServiceAnnouncement
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatSourcePublicServiceAnnouncement *ChatSourcePublicServiceAnnouncement) Equal(other *ChatSourcePublicServiceAnnouncement) bool {
+	if chatSourcePublicServiceAnnouncement == nil || other == nil {
+		return chatSourcePublicServiceAnnouncement == other
+	}
+	if chatSourcePublicServiceAnnouncement.Type != other.Type {
+		return false
+	}
+	if chatSourcePublicServiceAnnouncement.Text != other.Text {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatSourcePublicServiceAnnouncement *ChatSourcePublicServiceAnnouncement) Diff(other *ChatSourcePublicServiceAnnouncement) []Change {
+	return chatSourcePublicServiceAnnouncement.diff("", other, nil)
+}
+
+func (chatSourcePublicServiceAnnouncement *ChatSourcePublicServiceAnnouncement) diff(path string, other *ChatSourcePublicServiceAnnouncement, changes []Change) []Change {
+	if chatSourcePublicServiceAnnouncement == nil || other == nil {
+		if chatSourcePublicServiceAnnouncement != other {
+			changes = append(changes, Change{Path: path, Old: chatSourcePublicServiceAnnouncement, New: other})
+		}
+		return changes
+	}
+	if chatSourcePublicServiceAnnouncement.Type != other.Type {
+		changes = append(changes, Change{Path: fieldPath(path, "type"), Old: chatSourcePublicServiceAnnouncement.Type, New: other.Type})
+	}
+	if chatSourcePublicServiceAnnouncement.Text != other.Text {
+		changes = append(changes, Change{Path: fieldPath(path, "text"), Old: chatSourcePublicServiceAnnouncement.Text, New: other.Text})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatSourcePublicServiceAnnouncement *ChatSourcePublicServiceAnnouncement) String() string {
+	return objectString(chatSourcePublicServiceAnnouncement)
+}
+
+// LogValue logs the object as its summary
+func (chatSourcePublicServiceAnnouncement *ChatSourcePublicServiceAnnouncement) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatSourcePublicServiceAnnouncement))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatSourcePublicServiceAnnouncement *ChatSourcePublicServiceAnnouncement) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatSourcePublicServiceAnnouncement)
+}
+
+func (chatSourcePublicServiceAnnouncement *ChatSourcePublicServiceAnnouncement) printText(p *printer) {
+	if chatSourcePublicServiceAnnouncement == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatSourcePublicServiceAnnouncement")
+	p.string("type", chatSourcePublicServiceAnnouncement.Type)
+	p.string("text", chatSourcePublicServiceAnnouncement.Text)
+	p.endObject(state)
+}
+
 // GetChatSourceEnum return the enum type of this object
 func (chatSourcePublicServiceAnnouncement *ChatSourcePublicServiceAnnouncement) GetChatSourceEnum() ChatSourceEnum {
 	return ChatSourcePublicServiceAnnouncementType
 }
+
+// Accept calls the method of the visitor for ChatSourcePublicServiceAnnouncement
+func (chatSourcePublicServiceAnnouncement *ChatSourcePublicServiceAnnouncement) Accept(visitor ChatSourceVisitor) {
+	visitor.VisitChatSourcePublicServiceAnnouncement(chatSourcePublicServiceAnnouncement)
+}
+
+// ChatSourceVisitor visits every type of ChatSource, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to ChatSource, until they handle it too.
+type ChatSourceVisitor interface {
+	VisitChatSourceMtprotoProxy(chatSourceMtprotoProxy *ChatSourceMtprotoProxy)
+	VisitChatSourcePublicServiceAnnouncement(chatSourcePublicServiceAnnouncement *ChatSourcePublicServiceAnnouncement)
+	VisitUnknownChatSource(unknownChatSource *UnknownChatSource)
+}
+
+// ChatSourceCases holds the function MatchChatSource calls for each type of ChatSource;
+// types without a function are passed to the default case
+type ChatSourceCases[R any] struct {
+	ChatSourceMtprotoProxy              func(chatSourceMtprotoProxy *ChatSourceMtprotoProxy) R
+	ChatSourcePublicServiceAnnouncement func(chatSourcePublicServiceAnnouncement *ChatSourcePublicServiceAnnouncement) R
+}
+
+// MatchChatSource calls the case of the type of chatSource, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement ChatSourceVisitor
+// to have the compiler check every type is handled.
+func MatchChatSource[R any](chatSource ChatSource, cases ChatSourceCases[R], defaultCase func(chatSource ChatSource) R) R {
+	switch value := chatSource.(type) {
+	case *ChatSourceMtprotoProxy:
+		if cases.ChatSourceMtprotoProxy != nil {
+			return cases.ChatSourceMtprotoProxy(value)
+		}
+	case *ChatSourcePublicServiceAnnouncement:
+		if cases.ChatSourcePublicServiceAnnouncement != nil {
+			return cases.ChatSourcePublicServiceAnnouncement(value)
+		}
+	}
+	return defaultCase(chatSource)
+}
+
+// Clone returns a deep copy of the object
+func (unknownChatSource *UnknownChatSource) Clone() *UnknownChatSource {
+	if unknownChatSource == nil {
+		return nil
+	}
+	return &UnknownChatSource{Unknown: unknownChatSource.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownChatSource *UnknownChatSource) Equal(other *UnknownChatSource) bool {
+	if unknownChatSource == nil || other == nil {
+		return unknownChatSource == other
+	}
+	return unknownChatSource.Unknown.equal(other.Unknown)
+}
+
+func cloneChatSource(chatSource ChatSource) ChatSource {
+	switch value := chatSource.(type) {
+	case *ChatSourceMtprotoProxy:
+		return value.Clone()
+	case *ChatSourcePublicServiceAnnouncement:
+		return value.Clone()
+	case *UnknownChatSource:
+		return value.Clone()
+	}
+	return chatSource
+}
+
+func equalChatSource(a ChatSource, b ChatSource) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *ChatSourceMtprotoProxy:
+		b, ok := b.(*ChatSourceMtprotoProxy)
+		return ok && a.Equal(b)
+	case *ChatSourcePublicServiceAnnouncement:
+		b, ok := b.(*ChatSourcePublicServiceAnnouncement)
+		return ok && a.Equal(b)
+	case *UnknownChatSource:
+		b, ok := b.(*UnknownChatSource)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffChatSource(path string, a ChatSource, b ChatSource, changes []Change) []Change {
+	switch a := a.(type) {
+	case *ChatSourceMtprotoProxy:
+		if b, ok := b.(*ChatSourceMtprotoProxy); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatSourcePublicServiceAnnouncement:
+		if b, ok := b.(*ChatSourcePublicServiceAnnouncement); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalChatSource(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
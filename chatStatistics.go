@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ChatStatistics Contains a detailed statistics about a chat
 type ChatStatistics interface {
 	GetChatStatisticsEnum() ChatStatisticsEnum
+	Accept(visitor ChatStatisticsVisitor)
 }
 
 // ChatStatisticsEnum Alias for abstract ChatStatistics 'Sub-Classes', used as constant-enum here
@@ -19,30 +21,73 @@ const (
 	ChatStatisticsChannelType    ChatStatisticsEnum = "chatStatisticsChannel"
 )
 
+// UnknownChatStatistics is a ChatStatistics of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownChatStatistics struct {
+	Unknown
+}
+
+// GetChatStatisticsEnum return the enum type of this object
+func (unknownChatStatistics *UnknownChatStatistics) GetChatStatisticsEnum() ChatStatisticsEnum {
+	return ChatStatisticsEnum(unknownChatStatistics.Type)
+}
+
+// Accept calls the method of the visitor for UnknownChatStatistics
+func (unknownChatStatistics *UnknownChatStatistics) Accept(visitor ChatStatisticsVisitor) {
+	visitor.VisitUnknownChatStatistics(unknownChatStatistics)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownChatStatistics *UnknownChatStatistics) String() string {
+	return objectString(unknownChatStatistics)
+}
+
+// LogValue logs the object as its summary
+func (unknownChatStatistics *UnknownChatStatistics) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownChatStatistics))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownChatStatistics *UnknownChatStatistics) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownChatStatistics)
+}
+
+func (unknownChatStatistics *UnknownChatStatistics) printText(p *printer) {
+	if unknownChatStatistics == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownChatStatistics")
+	p.string("@type", unknownChatStatistics.Type)
+	p.endObject(state)
+}
+
 func unmarshalChatStatistics(rawMsg *json.RawMessage) (ChatStatistics, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch ChatStatisticsEnum(objMap["@type"].(string)) {
+	switch ChatStatisticsEnum(typeName) {
 	case ChatStatisticsSupergroupType:
 		var chatStatisticsSupergroup ChatStatisticsSupergroup
-		err := json.Unmarshal(*rawMsg, &chatStatisticsSupergroup)
+		err := jsonUnmarshal(*rawMsg, &chatStatisticsSupergroup)
 		return &chatStatisticsSupergroup, err
 
 	case ChatStatisticsChannelType:
 		var chatStatisticsChannel ChatStatisticsChannel
-		err := json.Unmarshal(*rawMsg, &chatStatisticsChannel)
+		err := jsonUnmarshal(*rawMsg, &chatStatisticsChannel)
 		return &chatStatisticsChannel, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownChatStatistics{Unknown: unknown}, nil
 	}
 }
 
@@ -114,64 +159,446 @@ func NewChatStatisticsSupergroup(period *DateRange, memberCount *StatisticalValu
 	return &chatStatisticsSupergroupTemp
 }
 
+// MarshalJSON marshals to json
+func (chatStatisticsSupergroup *ChatStatisticsSupergroup) MarshalJSON() ([]byte, error) {
+	return chatStatisticsSupergroup.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (chatStatisticsSupergroup *ChatStatisticsSupergroup) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, chatStatisticsSupergroup)
+}
+
+func (chatStatisticsSupergroup *ChatStatisticsSupergroup) appendJSON(b []byte) ([]byte, error) {
+	if chatStatisticsSupergroup == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatStatisticsSupergroup.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatStatisticsSupergroup.tdCommon.Extra)
+	b = append(b, `,"period":`...)
+	b, err = chatStatisticsSupergroup.Period.appendJSON(b)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Period            *DateRange                               `json:"period"`             // A period to which the statistics applies
-		MemberCount       *StatisticalValue                        `json:"member_count"`       // Number of members in the chat
-		MessageCount      *StatisticalValue                        `json:"message_count"`      // Number of messages sent to the chat
-		ViewerCount       *StatisticalValue                        `json:"viewer_count"`       // Number of users who viewed messages in the chat
-		SenderCount       *StatisticalValue                        `json:"sender_count"`       // Number of users who sent messages to the chat
-		TopSenders        []ChatStatisticsMessageSenderInfo        `json:"top_senders"`        // List of users sent most messages in the last week
-		TopAdministrators []ChatStatisticsAdministratorActionsInfo `json:"top_administrators"` // List of most active administrators in the last week
-		TopInviters       []ChatStatisticsInviterInfo              `json:"top_inviters"`       // List of most active inviters of new members in the last week
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"member_count":`...)
+	b, err = chatStatisticsSupergroup.MemberCount.appendJSON(b)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	b = append(b, `,"message_count":`...)
+	b, err = chatStatisticsSupergroup.MessageCount.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"viewer_count":`...)
+	b, err = chatStatisticsSupergroup.ViewerCount.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"sender_count":`...)
+	b, err = chatStatisticsSupergroup.SenderCount.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"member_count_graph":`...)
+	b, err = appendJSONValue(b, chatStatisticsSupergroup.MemberCountGraph)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"join_graph":`...)
+	b, err = appendJSONValue(b, chatStatisticsSupergroup.JoinGraph)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"join_by_source_graph":`...)
+	b, err = appendJSONValue(b, chatStatisticsSupergroup.JoinBySourceGraph)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"language_graph":`...)
+	b, err = appendJSONValue(b, chatStatisticsSupergroup.LanguageGraph)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"message_content_graph":`...)
+	b, err = appendJSONValue(b, chatStatisticsSupergroup.MessageContentGraph)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"action_graph":`...)
+	b, err = appendJSONValue(b, chatStatisticsSupergroup.ActionGraph)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"day_graph":`...)
+	b, err = appendJSONValue(b, chatStatisticsSupergroup.DayGraph)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"week_graph":`...)
+	b, err = appendJSONValue(b, chatStatisticsSupergroup.WeekGraph)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"top_senders":`...)
+	if chatStatisticsSupergroup.TopSenders == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range chatStatisticsSupergroup.TopSenders {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = chatStatisticsSupergroup.TopSenders[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"top_administrators":`...)
+	if chatStatisticsSupergroup.TopAdministrators == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range chatStatisticsSupergroup.TopAdministrators {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = chatStatisticsSupergroup.TopAdministrators[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"top_inviters":`...)
+	if chatStatisticsSupergroup.TopInviters == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range chatStatisticsSupergroup.TopInviters {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = chatStatisticsSupergroup.TopInviters[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
 
-	chatStatisticsSupergroup.tdCommon = tempObj.tdCommon
-	chatStatisticsSupergroup.Period = tempObj.Period
-	chatStatisticsSupergroup.MemberCount = tempObj.MemberCount
-	chatStatisticsSupergroup.MessageCount = tempObj.MessageCount
-	chatStatisticsSupergroup.ViewerCount = tempObj.ViewerCount
-	chatStatisticsSupergroup.SenderCount = tempObj.SenderCount
-	chatStatisticsSupergroup.TopSenders = tempObj.TopSenders
-	chatStatisticsSupergroup.TopAdministrators = tempObj.TopAdministrators
-	chatStatisticsSupergroup.TopInviters = tempObj.TopInviters
-
-	fieldMemberCountGraph, _ := unmarshalStatisticalGraph(objMap["member_count_graph"])
-	chatStatisticsSupergroup.MemberCountGraph = fieldMemberCountGraph
+func (chatStatisticsSupergroup *ChatStatisticsSupergroup) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatStatisticsSupergroup.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatStatisticsSupergroup.tdCommon.Extra, err = r.readString()
+		case "period":
+			if r.readNull() {
+				chatStatisticsSupergroup.Period = nil
+			} else {
+				chatStatisticsSupergroup.Period = new(DateRange)
+				err = chatStatisticsSupergroup.Period.decodeJSON(r)
+			}
+		case "member_count":
+			if r.readNull() {
+				chatStatisticsSupergroup.MemberCount = nil
+			} else {
+				chatStatisticsSupergroup.MemberCount = new(StatisticalValue)
+				err = chatStatisticsSupergroup.MemberCount.decodeJSON(r)
+			}
+		case "message_count":
+			if r.readNull() {
+				chatStatisticsSupergroup.MessageCount = nil
+			} else {
+				chatStatisticsSupergroup.MessageCount = new(StatisticalValue)
+				err = chatStatisticsSupergroup.MessageCount.decodeJSON(r)
+			}
+		case "viewer_count":
+			if r.readNull() {
+				chatStatisticsSupergroup.ViewerCount = nil
+			} else {
+				chatStatisticsSupergroup.ViewerCount = new(StatisticalValue)
+				err = chatStatisticsSupergroup.ViewerCount.decodeJSON(r)
+			}
+		case "sender_count":
+			if r.readNull() {
+				chatStatisticsSupergroup.SenderCount = nil
+			} else {
+				chatStatisticsSupergroup.SenderCount = new(StatisticalValue)
+				err = chatStatisticsSupergroup.SenderCount.decodeJSON(r)
+			}
+		case "member_count_graph":
+			chatStatisticsSupergroup.MemberCountGraph, err = decodeStatisticalGraph(r)
+		case "join_graph":
+			chatStatisticsSupergroup.JoinGraph, err = decodeStatisticalGraph(r)
+		case "join_by_source_graph":
+			chatStatisticsSupergroup.JoinBySourceGraph, err = decodeStatisticalGraph(r)
+		case "language_graph":
+			chatStatisticsSupergroup.LanguageGraph, err = decodeStatisticalGraph(r)
+		case "message_content_graph":
+			chatStatisticsSupergroup.MessageContentGraph, err = decodeStatisticalGraph(r)
+		case "action_graph":
+			chatStatisticsSupergroup.ActionGraph, err = decodeStatisticalGraph(r)
+		case "day_graph":
+			chatStatisticsSupergroup.DayGraph, err = decodeStatisticalGraph(r)
+		case "week_graph":
+			chatStatisticsSupergroup.WeekGraph, err = decodeStatisticalGraph(r)
+		case "top_senders":
+			if r.readNull() {
+				chatStatisticsSupergroup.TopSenders = nil
+			} else {
+				chatStatisticsSupergroup.TopSenders = make([]ChatStatisticsMessageSenderInfo, 0)
+				err = r.readArray(func() (err error) {
+					var item0 ChatStatisticsMessageSenderInfo
+					err = item0.decodeJSON(r)
+					chatStatisticsSupergroup.TopSenders = append(chatStatisticsSupergroup.TopSenders, item0)
+					return
+				})
+			}
+		case "top_administrators":
+			if r.readNull() {
+				chatStatisticsSupergroup.TopAdministrators = nil
+			} else {
+				chatStatisticsSupergroup.TopAdministrators = make([]ChatStatisticsAdministratorActionsInfo, 0)
+				err = r.readArray(func() (err error) {
+					var item0 ChatStatisticsAdministratorActionsInfo
+					err = item0.decodeJSON(r)
+					chatStatisticsSupergroup.TopAdministrators = append(chatStatisticsSupergroup.TopAdministrators, item0)
+					return
+				})
+			}
+		case "top_inviters":
+			if r.readNull() {
+				chatStatisticsSupergroup.TopInviters = nil
+			} else {
+				chatStatisticsSupergroup.TopInviters = make([]ChatStatisticsInviterInfo, 0)
+				err = r.readArray(func() (err error) {
+					var item0 ChatStatisticsInviterInfo
+					err = item0.decodeJSON(r)
+					chatStatisticsSupergroup.TopInviters = append(chatStatisticsSupergroup.TopInviters, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	fieldJoinGraph, _ := unmarshalStatisticalGraph(objMap["join_graph"])
-	chatStatisticsSupergroup.JoinGraph = fieldJoinGraph
+// Clone returns a deep copy of the object
+func (chatStatisticsSupergroup *ChatStatisticsSupergroup) Clone() *ChatStatisticsSupergroup {
+	if chatStatisticsSupergroup == nil {
+		return nil
+	}
+	clone := *chatStatisticsSupergroup
+	clone.Period = chatStatisticsSupergroup.Period.Clone()
+	clone.MemberCount = chatStatisticsSupergroup.MemberCount.Clone()
+	clone.MessageCount = chatStatisticsSupergroup.MessageCount.Clone()
+	clone.ViewerCount = chatStatisticsSupergroup.ViewerCount.Clone()
+	clone.SenderCount = chatStatisticsSupergroup.SenderCount.Clone()
+	clone.MemberCountGraph = cloneStatisticalGraph(chatStatisticsSupergroup.MemberCountGraph)
+	clone.JoinGraph = cloneStatisticalGraph(chatStatisticsSupergroup.JoinGraph)
+	clone.JoinBySourceGraph = cloneStatisticalGraph(chatStatisticsSupergroup.JoinBySourceGraph)
+	clone.LanguageGraph = cloneStatisticalGraph(chatStatisticsSupergroup.LanguageGraph)
+	clone.MessageContentGraph = cloneStatisticalGraph(chatStatisticsSupergroup.MessageContentGraph)
+	clone.ActionGraph = cloneStatisticalGraph(chatStatisticsSupergroup.ActionGraph)
+	clone.DayGraph = cloneStatisticalGraph(chatStatisticsSupergroup.DayGraph)
+	clone.WeekGraph = cloneStatisticalGraph(chatStatisticsSupergroup.WeekGraph)
+	if chatStatisticsSupergroup.TopSenders != nil {
+		clone.TopSenders = make([]ChatStatisticsMessageSenderInfo, len(chatStatisticsSupergroup.TopSenders))
+		for i0 := range chatStatisticsSupergroup.TopSenders {
+			clone.TopSenders[i0] = *chatStatisticsSupergroup.TopSenders[i0].Clone()
+		}
+	}
+	if chatStatisticsSupergroup.TopAdministrators != nil {
+		clone.TopAdministrators = make([]ChatStatisticsAdministratorActionsInfo, len(chatStatisticsSupergroup.TopAdministrators))
+		for i0 := range chatStatisticsSupergroup.TopAdministrators {
+			clone.TopAdministrators[i0] = *chatStatisticsSupergroup.TopAdministrators[i0].Clone()
+		}
+	}
+	if chatStatisticsSupergroup.TopInviters != nil {
+		clone.TopInviters = make([]ChatStatisticsInviterInfo, len(chatStatisticsSupergroup.TopInviters))
+		for i0 := range chatStatisticsSupergroup.TopInviters {
+			clone.TopInviters[i0] = *chatStatisticsSupergroup.TopInviters[i0].Clone()
+		}
+	}
+	return &clone
+}
 
-	fieldJoinBySourceGraph, _ := unmarshalStatisticalGraph(objMap["join_by_source_graph"])
-	chatStatisticsSupergroup.JoinBySourceGraph = fieldJoinBySourceGraph
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatStatisticsSupergroup *ChatStatisticsSupergroup) Equal(other *ChatStatisticsSupergroup) bool {
+	if chatStatisticsSupergroup == nil || other == nil {
+		return chatStatisticsSupergroup == other
+	}
+	if !chatStatisticsSupergroup.Period.Equal(other.Period) {
+		return false
+	}
+	if !chatStatisticsSupergroup.MemberCount.Equal(other.MemberCount) {
+		return false
+	}
+	if !chatStatisticsSupergroup.MessageCount.Equal(other.MessageCount) {
+		return false
+	}
+	if !chatStatisticsSupergroup.ViewerCount.Equal(other.ViewerCount) {
+		return false
+	}
+	if !chatStatisticsSupergroup.SenderCount.Equal(other.SenderCount) {
+		return false
+	}
+	if !equalStatisticalGraph(chatStatisticsSupergroup.MemberCountGraph, other.MemberCountGraph) {
+		return false
+	}
+	if !equalStatisticalGraph(chatStatisticsSupergroup.JoinGraph, other.JoinGraph) {
+		return false
+	}
+	if !equalStatisticalGraph(chatStatisticsSupergroup.JoinBySourceGraph, other.JoinBySourceGraph) {
+		return false
+	}
+	if !equalStatisticalGraph(chatStatisticsSupergroup.LanguageGraph, other.LanguageGraph) {
+		return false
+	}
+	if !equalStatisticalGraph(chatStatisticsSupergroup.MessageContentGraph, other.MessageContentGraph) {
+		return false
+	}
+	if !equalStatisticalGraph(chatStatisticsSupergroup.ActionGraph, other.ActionGraph) {
+		return false
+	}
+	if !equalStatisticalGraph(chatStatisticsSupergroup.DayGraph, other.DayGraph) {
+		return false
+	}
+	if !equalStatisticalGraph(chatStatisticsSupergroup.WeekGraph, other.WeekGraph) {
+		return false
+	}
+	if len(chatStatisticsSupergroup.TopSenders) != len(other.TopSenders) {
+		return false
+	}
+	for i0 := range chatStatisticsSupergroup.TopSenders {
+		if !chatStatisticsSupergroup.TopSenders[i0].Equal(&other.TopSenders[i0]) {
+			return false
+		}
+	}
+	if len(chatStatisticsSupergroup.TopAdministrators) != len(other.TopAdministrators) {
+		return false
+	}
+	for i0 := range chatStatisticsSupergroup.TopAdministrators {
+		if !chatStatisticsSupergroup.TopAdministrators[i0].Equal(&other.TopAdministrators[i0]) {
+			return false
+		}
+	}
+	if len(chatStatisticsSupergroup.TopInviters) != len(other.TopInviters) {
+		return false
+	}
+	for i0 := range chatStatisticsSupergroup.TopInviters {
+		if !chatStatisticsSupergroup.TopInviters[i0].Equal(&other.TopInviters[i0]) {
+			return false
+		}
+	}
+	return true
+}
 
-	fieldLanguageGraph, _ := unmarshalStatisticalGraph(objMap["language_graph"])
-	chatStatisticsSupergroup.LanguageGraph = fieldLanguageGraph
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatStatisticsSupergroup *ChatStatisticsSupergroup) Diff(other *ChatStatisticsSupergroup) []Change {
+	return chatStatisticsSupergroup.diff("", other, nil)
+}
 
-	fieldMessageContentGraph, _ := unmarshalStatisticalGraph(objMap["message_content_graph"])
-	chatStatisticsSupergroup.MessageContentGraph = fieldMessageContentGraph
+func (chatStatisticsSupergroup *ChatStatisticsSupergroup) diff(path string, other *ChatStatisticsSupergroup, changes []Change) []Change {
+	if chatStatisticsSupergroup == nil || other == nil {
+		if chatStatisticsSupergroup != other {
+			changes = append(changes, Change{Path: path, Old: chatStatisticsSupergroup, New: other})
+		}
+		return changes
+	}
+	changes = chatStatisticsSupergroup.Period.diff(fieldPath(path, "period"), other.Period, changes)
+	changes = chatStatisticsSupergroup.MemberCount.diff(fieldPath(path, "member_count"), other.MemberCount, changes)
+	changes = chatStatisticsSupergroup.MessageCount.diff(fieldPath(path, "message_count"), other.MessageCount, changes)
+	changes = chatStatisticsSupergroup.ViewerCount.diff(fieldPath(path, "viewer_count"), other.ViewerCount, changes)
+	changes = chatStatisticsSupergroup.SenderCount.diff(fieldPath(path, "sender_count"), other.SenderCount, changes)
+	changes = diffStatisticalGraph(fieldPath(path, "member_count_graph"), chatStatisticsSupergroup.MemberCountGraph, other.MemberCountGraph, changes)
+	changes = diffStatisticalGraph(fieldPath(path, "join_graph"), chatStatisticsSupergroup.JoinGraph, other.JoinGraph, changes)
+	changes = diffStatisticalGraph(fieldPath(path, "join_by_source_graph"), chatStatisticsSupergroup.JoinBySourceGraph, other.JoinBySourceGraph, changes)
+	changes = diffStatisticalGraph(fieldPath(path, "language_graph"), chatStatisticsSupergroup.LanguageGraph, other.LanguageGraph, changes)
+	changes = diffStatisticalGraph(fieldPath(path, "message_content_graph"), chatStatisticsSupergroup.MessageContentGraph, other.MessageContentGraph, changes)
+	changes = diffStatisticalGraph(fieldPath(path, "action_graph"), chatStatisticsSupergroup.ActionGraph, other.ActionGraph, changes)
+	changes = diffStatisticalGraph(fieldPath(path, "day_graph"), chatStatisticsSupergroup.DayGraph, other.DayGraph, changes)
+	changes = diffStatisticalGraph(fieldPath(path, "week_graph"), chatStatisticsSupergroup.WeekGraph, other.WeekGraph, changes)
+	if len(chatStatisticsSupergroup.TopSenders) != len(other.TopSenders) {
+		changes = append(changes, Change{Path: fieldPath(path, "top_senders"), Old: chatStatisticsSupergroup.TopSenders, New: other.TopSenders})
+	} else {
+		for i0 := range chatStatisticsSupergroup.TopSenders {
+			changes = chatStatisticsSupergroup.TopSenders[i0].diff(indexPath(fieldPath(path, "top_senders"), i0), &other.TopSenders[i0], changes)
+		}
+	}
+	if len(chatStatisticsSupergroup.TopAdministrators) != len(other.TopAdministrators) {
+		changes = append(changes, Change{Path: fieldPath(path, "top_administrators"), Old: chatStatisticsSupergroup.TopAdministrators, New: other.TopAdministrators})
+	} else {
+		for i0 := range chatStatisticsSupergroup.TopAdministrators {
+			changes = chatStatisticsSupergroup.TopAdministrators[i0].diff(indexPath(fieldPath(path, "top_administrators"), i0), &other.TopAdministrators[i0], changes)
+		}
+	}
+	if len(chatStatisticsSupergroup.TopInviters) != len(other.TopInviters) {
+		changes = append(changes, Change{Path: fieldPath(path, "top_inviters"), Old: chatStatisticsSupergroup.TopInviters, New: other.TopInviters})
+	} else {
+		for i0 := range chatStatisticsSupergroup.TopInviters {
+			changes = chatStatisticsSupergroup.TopInviters[i0].diff(indexPath(fieldPath(path, "top_inviters"), i0), &other.TopInviters[i0], changes)
+		}
+	}
+	return changes
+}
 
-	fieldActionGraph, _ := unmarshalStatisticalGraph(objMap["action_graph"])
-	chatStatisticsSupergroup.ActionGraph = fieldActionGraph
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatStatisticsSupergroup *ChatStatisticsSupergroup) String() string {
+	return objectString(chatStatisticsSupergroup)
+}
 
-	fieldDayGraph, _ := unmarshalStatisticalGraph(objMap["day_graph"])
-	chatStatisticsSupergroup.DayGraph = fieldDayGraph
+// LogValue logs the object as its summary
+func (chatStatisticsSupergroup *ChatStatisticsSupergroup) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatStatisticsSupergroup))
+}
 
-	fieldWeekGraph, _ := unmarshalStatisticalGraph(objMap["week_graph"])
-	chatStatisticsSupergroup.WeekGraph = fieldWeekGraph
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatStatisticsSupergroup *ChatStatisticsSupergroup) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatStatisticsSupergroup)
+}
 
-	return nil
+func (chatStatisticsSupergroup *ChatStatisticsSupergroup) printText(p *printer) {
+	if chatStatisticsSupergroup == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatStatisticsSupergroup")
+	p.object("period", chatStatisticsSupergroup.Period)
+	p.object("member_count", chatStatisticsSupergroup.MemberCount)
+	p.object("message_count", chatStatisticsSupergroup.MessageCount)
+	p.object("viewer_count", chatStatisticsSupergroup.ViewerCount)
+	p.object("sender_count", chatStatisticsSupergroup.SenderCount)
+	p.object("member_count_graph", chatStatisticsSupergroup.MemberCountGraph)
+	p.object("join_graph", chatStatisticsSupergroup.JoinGraph)
+	p.object("join_by_source_graph", chatStatisticsSupergroup.JoinBySourceGraph)
+	p.object("language_graph", chatStatisticsSupergroup.LanguageGraph)
+	p.object("message_content_graph", chatStatisticsSupergroup.MessageContentGraph)
+	p.object("action_graph", chatStatisticsSupergroup.ActionGraph)
+	p.object("day_graph", chatStatisticsSupergroup.DayGraph)
+	p.object("week_graph", chatStatisticsSupergroup.WeekGraph)
+	p.vector("top_senders", len(chatStatisticsSupergroup.TopSenders), func(i0 int) {
+		chatStatisticsSupergroup.TopSenders[i0].printText(p)
+	})
+	p.vector("top_administrators", len(chatStatisticsSupergroup.TopAdministrators), func(i0 int) {
+		chatStatisticsSupergroup.TopAdministrators[i0].printText(p)
+	})
+	p.vector("top_inviters", len(chatStatisticsSupergroup.TopInviters), func(i0 int) {
+		chatStatisticsSupergroup.TopInviters[i0].printText(p)
+	})
+	p.endObject(state)
 }
 
 // GetChatStatisticsEnum return the enum type of this object
@@ -179,6 +606,11 @@ func (chatStatisticsSupergroup *ChatStatisticsSupergroup) GetChatStatisticsEnum(
 	return ChatStatisticsSupergroupType
 }
 
+// Accept calls the method of the visitor for ChatStatisticsSupergroup
+func (chatStatisticsSupergroup *ChatStatisticsSupergroup) Accept(visitor ChatStatisticsVisitor) {
+	visitor.VisitChatStatisticsSupergroup(chatStatisticsSupergroup)
+}
+
 // ChatStatisticsChannel A detailed statistics about a channel chat
 type ChatStatisticsChannel struct {
 	tdCommon
@@ -244,63 +676,351 @@ func NewChatStatisticsChannel(period *DateRange, memberCount *StatisticalValue,
 	return &chatStatisticsChannelTemp
 }
 
+// MarshalJSON marshals to json
+func (chatStatisticsChannel *ChatStatisticsChannel) MarshalJSON() ([]byte, error) {
+	return chatStatisticsChannel.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (chatStatisticsChannel *ChatStatisticsChannel) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, chatStatisticsChannel)
+}
+
+func (chatStatisticsChannel *ChatStatisticsChannel) appendJSON(b []byte) ([]byte, error) {
+	if chatStatisticsChannel == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatStatisticsChannel.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatStatisticsChannel.tdCommon.Extra)
+	b = append(b, `,"period":`...)
+	b, err = chatStatisticsChannel.Period.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"member_count":`...)
+	b, err = chatStatisticsChannel.MemberCount.appendJSON(b)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Period                         *DateRange                             `json:"period"`                           // A period to which the statistics applies
-		MemberCount                    *StatisticalValue                      `json:"member_count"`                     // Number of members in the chat
-		MeanViewCount                  *StatisticalValue                      `json:"mean_view_count"`                  // Mean number of times the recently sent messages was viewed
-		MeanShareCount                 *StatisticalValue                      `json:"mean_share_count"`                 // Mean number of times the recently sent messages was shared
-		EnabledNotificationsPercentage float64                                `json:"enabled_notifications_percentage"` // A percentage of users with enabled notifications for the chat
-		RecentMessageInteractions      []ChatStatisticsMessageInteractionInfo `json:"recent_message_interactions"`      // Detailed statistics about number of views and shares of recently sent messages
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"mean_view_count":`...)
+	b, err = chatStatisticsChannel.MeanViewCount.appendJSON(b)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	b = append(b, `,"mean_share_count":`...)
+	b, err = chatStatisticsChannel.MeanShareCount.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"enabled_notifications_percentage":`...)
+	b, err = appendJSONFloat(b, chatStatisticsChannel.EnabledNotificationsPercentage)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"member_count_graph":`...)
+	b, err = appendJSONValue(b, chatStatisticsChannel.MemberCountGraph)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"join_graph":`...)
+	b, err = appendJSONValue(b, chatStatisticsChannel.JoinGraph)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"mute_graph":`...)
+	b, err = appendJSONValue(b, chatStatisticsChannel.MuteGraph)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"view_count_by_hour_graph":`...)
+	b, err = appendJSONValue(b, chatStatisticsChannel.ViewCountByHourGraph)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"view_count_by_source_graph":`...)
+	b, err = appendJSONValue(b, chatStatisticsChannel.ViewCountBySourceGraph)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"join_by_source_graph":`...)
+	b, err = appendJSONValue(b, chatStatisticsChannel.JoinBySourceGraph)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"language_graph":`...)
+	b, err = appendJSONValue(b, chatStatisticsChannel.LanguageGraph)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"message_interaction_graph":`...)
+	b, err = appendJSONValue(b, chatStatisticsChannel.MessageInteractionGraph)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"instant_view_interaction_graph":`...)
+	b, err = appendJSONValue(b, chatStatisticsChannel.InstantViewInteractionGraph)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"recent_message_interactions":`...)
+	if chatStatisticsChannel.RecentMessageInteractions == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range chatStatisticsChannel.RecentMessageInteractions {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = chatStatisticsChannel.RecentMessageInteractions[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
 
-	chatStatisticsChannel.tdCommon = tempObj.tdCommon
-	chatStatisticsChannel.Period = tempObj.Period
-	chatStatisticsChannel.MemberCount = tempObj.MemberCount
-	chatStatisticsChannel.MeanViewCount = tempObj.MeanViewCount
-	chatStatisticsChannel.MeanShareCount = tempObj.MeanShareCount
-	chatStatisticsChannel.EnabledNotificationsPercentage = tempObj.EnabledNotificationsPercentage
-	chatStatisticsChannel.RecentMessageInteractions = tempObj.RecentMessageInteractions
-
-	fieldMemberCountGraph, _ := unmarshalStatisticalGraph(objMap["member_count_graph"])
-	chatStatisticsChannel.MemberCountGraph = fieldMemberCountGraph
-
-	fieldJoinGraph, _ := unmarshalStatisticalGraph(objMap["join_graph"])
-	chatStatisticsChannel.JoinGraph = fieldJoinGraph
+func (chatStatisticsChannel *ChatStatisticsChannel) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatStatisticsChannel.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatStatisticsChannel.tdCommon.Extra, err = r.readString()
+		case "period":
+			if r.readNull() {
+				chatStatisticsChannel.Period = nil
+			} else {
+				chatStatisticsChannel.Period = new(DateRange)
+				err = chatStatisticsChannel.Period.decodeJSON(r)
+			}
+		case "member_count":
+			if r.readNull() {
+				chatStatisticsChannel.MemberCount = nil
+			} else {
+				chatStatisticsChannel.MemberCount = new(StatisticalValue)
+				err = chatStatisticsChannel.MemberCount.decodeJSON(r)
+			}
+		case "mean_view_count":
+			if r.readNull() {
+				chatStatisticsChannel.MeanViewCount = nil
+			} else {
+				chatStatisticsChannel.MeanViewCount = new(StatisticalValue)
+				err = chatStatisticsChannel.MeanViewCount.decodeJSON(r)
+			}
+		case "mean_share_count":
+			if r.readNull() {
+				chatStatisticsChannel.MeanShareCount = nil
+			} else {
+				chatStatisticsChannel.MeanShareCount = new(StatisticalValue)
+				err = chatStatisticsChannel.MeanShareCount.decodeJSON(r)
+			}
+		case "enabled_notifications_percentage":
+			chatStatisticsChannel.EnabledNotificationsPercentage, err = r.readFloat64()
+		case "member_count_graph":
+			chatStatisticsChannel.MemberCountGraph, err = decodeStatisticalGraph(r)
+		case "join_graph":
+			chatStatisticsChannel.JoinGraph, err = decodeStatisticalGraph(r)
+		case "mute_graph":
+			chatStatisticsChannel.MuteGraph, err = decodeStatisticalGraph(r)
+		case "view_count_by_hour_graph":
+			chatStatisticsChannel.ViewCountByHourGraph, err = decodeStatisticalGraph(r)
+		case "view_count_by_source_graph":
+			chatStatisticsChannel.ViewCountBySourceGraph, err = decodeStatisticalGraph(r)
+		case "join_by_source_graph":
+			chatStatisticsChannel.JoinBySourceGraph, err = decodeStatisticalGraph(r)
+		case "language_graph":
+			chatStatisticsChannel.LanguageGraph, err = decodeStatisticalGraph(r)
+		case "message_interaction_graph":
+			chatStatisticsChannel.MessageInteractionGraph, err = decodeStatisticalGraph(r)
+		case "instant_view_interaction_graph":
+			chatStatisticsChannel.InstantViewInteractionGraph, err = decodeStatisticalGraph(r)
+		case "recent_message_interactions":
+			if r.readNull() {
+				chatStatisticsChannel.RecentMessageInteractions = nil
+			} else {
+				chatStatisticsChannel.RecentMessageInteractions = make([]ChatStatisticsMessageInteractionInfo, 0)
+				err = r.readArray(func() (err error) {
+					var item0 ChatStatisticsMessageInteractionInfo
+					err = item0.decodeJSON(r)
+					chatStatisticsChannel.RecentMessageInteractions = append(chatStatisticsChannel.RecentMessageInteractions, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	fieldMuteGraph, _ := unmarshalStatisticalGraph(objMap["mute_graph"])
-	chatStatisticsChannel.MuteGraph = fieldMuteGraph
+// Clone returns a deep copy of the object
+func (chatStatisticsChannel *ChatStatisticsChannel) Clone() *ChatStatisticsChannel {
+	if chatStatisticsChannel == nil {
+		return nil
+	}
+	clone := *chatStatisticsChannel
+	clone.Period = chatStatisticsChannel.Period.Clone()
+	clone.MemberCount = chatStatisticsChannel.MemberCount.Clone()
+	clone.MeanViewCount = chatStatisticsChannel.MeanViewCount.Clone()
+	clone.MeanShareCount = chatStatisticsChannel.MeanShareCount.Clone()
+	clone.MemberCountGraph = cloneStatisticalGraph(chatStatisticsChannel.MemberCountGraph)
+	clone.JoinGraph = cloneStatisticalGraph(chatStatisticsChannel.JoinGraph)
+	clone.MuteGraph = cloneStatisticalGraph(chatStatisticsChannel.MuteGraph)
+	clone.ViewCountByHourGraph = cloneStatisticalGraph(chatStatisticsChannel.ViewCountByHourGraph)
+	clone.ViewCountBySourceGraph = cloneStatisticalGraph(chatStatisticsChannel.ViewCountBySourceGraph)
+	clone.JoinBySourceGraph = cloneStatisticalGraph(chatStatisticsChannel.JoinBySourceGraph)
+	clone.LanguageGraph = cloneStatisticalGraph(chatStatisticsChannel.LanguageGraph)
+	clone.MessageInteractionGraph = cloneStatisticalGraph(chatStatisticsChannel.MessageInteractionGraph)
+	clone.InstantViewInteractionGraph = cloneStatisticalGraph(chatStatisticsChannel.InstantViewInteractionGraph)
+	if chatStatisticsChannel.RecentMessageInteractions != nil {
+		clone.RecentMessageInteractions = make([]ChatStatisticsMessageInteractionInfo, len(chatStatisticsChannel.RecentMessageInteractions))
+		for i0 := range chatStatisticsChannel.RecentMessageInteractions {
+			clone.RecentMessageInteractions[i0] = *chatStatisticsChannel.RecentMessageInteractions[i0].Clone()
+		}
+	}
+	return &clone
+}
 
-	fieldViewCountByHourGraph, _ := unmarshalStatisticalGraph(objMap["view_count_by_hour_graph"])
-	chatStatisticsChannel.ViewCountByHourGraph = fieldViewCountByHourGraph
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatStatisticsChannel *ChatStatisticsChannel) Equal(other *ChatStatisticsChannel) bool {
+	if chatStatisticsChannel == nil || other == nil {
+		return chatStatisticsChannel == other
+	}
+	if !chatStatisticsChannel.Period.Equal(other.Period) {
+		return false
+	}
+	if !chatStatisticsChannel.MemberCount.Equal(other.MemberCount) {
+		return false
+	}
+	if !chatStatisticsChannel.MeanViewCount.Equal(other.MeanViewCount) {
+		return false
+	}
+	if !chatStatisticsChannel.MeanShareCount.Equal(other.MeanShareCount) {
+		return false
+	}
+	if chatStatisticsChannel.EnabledNotificationsPercentage != other.EnabledNotificationsPercentage {
+		return false
+	}
+	if !equalStatisticalGraph(chatStatisticsChannel.MemberCountGraph, other.MemberCountGraph) {
+		return false
+	}
+	if !equalStatisticalGraph(chatStatisticsChannel.JoinGraph, other.JoinGraph) {
+		return false
+	}
+	if !equalStatisticalGraph(chatStatisticsChannel.MuteGraph, other.MuteGraph) {
+		return false
+	}
+	if !equalStatisticalGraph(chatStatisticsChannel.ViewCountByHourGraph, other.ViewCountByHourGraph) {
+		return false
+	}
+	if !equalStatisticalGraph(chatStatisticsChannel.ViewCountBySourceGraph, other.ViewCountBySourceGraph) {
+		return false
+	}
+	if !equalStatisticalGraph(chatStatisticsChannel.JoinBySourceGraph, other.JoinBySourceGraph) {
+		return false
+	}
+	if !equalStatisticalGraph(chatStatisticsChannel.LanguageGraph, other.LanguageGraph) {
+		return false
+	}
+	if !equalStatisticalGraph(chatStatisticsChannel.MessageInteractionGraph, other.MessageInteractionGraph) {
+		return false
+	}
+	if !equalStatisticalGraph(chatStatisticsChannel.InstantViewInteractionGraph, other.InstantViewInteractionGraph) {
+		return false
+	}
+	if len(chatStatisticsChannel.RecentMessageInteractions) != len(other.RecentMessageInteractions) {
+		return false
+	}
+	for i0 := range chatStatisticsChannel.RecentMessageInteractions {
+		if !chatStatisticsChannel.RecentMessageInteractions[i0].Equal(&other.RecentMessageInteractions[i0]) {
+			return false
+		}
+	}
+	return true
+}
 
-	fieldViewCountBySourceGraph, _ := unmarshalStatisticalGraph(objMap["view_count_by_source_graph"])
-	chatStatisticsChannel.ViewCountBySourceGraph = fieldViewCountBySourceGraph
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatStatisticsChannel *ChatStatisticsChannel) Diff(other *ChatStatisticsChannel) []Change {
+	return chatStatisticsChannel.diff("", other, nil)
+}
 
-	fieldJoinBySourceGraph, _ := unmarshalStatisticalGraph(objMap["join_by_source_graph"])
-	chatStatisticsChannel.JoinBySourceGraph = fieldJoinBySourceGraph
+func (chatStatisticsChannel *ChatStatisticsChannel) diff(path string, other *ChatStatisticsChannel, changes []Change) []Change {
+	if chatStatisticsChannel == nil || other == nil {
+		if chatStatisticsChannel != other {
+			changes = append(changes, Change{Path: path, Old: chatStatisticsChannel, New: other})
+		}
+		return changes
+	}
+	changes = chatStatisticsChannel.Period.diff(fieldPath(path, "period"), other.Period, changes)
+	changes = chatStatisticsChannel.MemberCount.diff(fieldPath(path, "member_count"), other.MemberCount, changes)
+	changes = chatStatisticsChannel.MeanViewCount.diff(fieldPath(path, "mean_view_count"), other.MeanViewCount, changes)
+	changes = chatStatisticsChannel.MeanShareCount.diff(fieldPath(path, "mean_share_count"), other.MeanShareCount, changes)
+	if chatStatisticsChannel.EnabledNotificationsPercentage != other.EnabledNotificationsPercentage {
+		changes = append(changes, Change{Path: fieldPath(path, "enabled_notifications_percentage"), Old: chatStatisticsChannel.EnabledNotificationsPercentage, New: other.EnabledNotificationsPercentage})
+	}
+	changes = diffStatisticalGraph(fieldPath(path, "member_count_graph"), chatStatisticsChannel.MemberCountGraph, other.MemberCountGraph, changes)
+	changes = diffStatisticalGraph(fieldPath(path, "join_graph"), chatStatisticsChannel.JoinGraph, other.JoinGraph, changes)
+	changes = diffStatisticalGraph(fieldPath(path, "mute_graph"), chatStatisticsChannel.MuteGraph, other.MuteGraph, changes)
+	changes = diffStatisticalGraph(fieldPath(path, "view_count_by_hour_graph"), chatStatisticsChannel.ViewCountByHourGraph, other.ViewCountByHourGraph, changes)
+	changes = diffStatisticalGraph(fieldPath(path, "view_count_by_source_graph"), chatStatisticsChannel.ViewCountBySourceGraph, other.ViewCountBySourceGraph, changes)
+	changes = diffStatisticalGraph(fieldPath(path, "join_by_source_graph"), chatStatisticsChannel.JoinBySourceGraph, other.JoinBySourceGraph, changes)
+	changes = diffStatisticalGraph(fieldPath(path, "language_graph"), chatStatisticsChannel.LanguageGraph, other.LanguageGraph, changes)
+	changes = diffStatisticalGraph(fieldPath(path, "message_interaction_graph"), chatStatisticsChannel.MessageInteractionGraph, other.MessageInteractionGraph, changes)
+	changes = diffStatisticalGraph(fieldPath(path, "instant_view_interaction_graph"), chatStatisticsChannel.InstantViewInteractionGraph, other.InstantViewInteractionGraph, changes)
+	if len(chatStatisticsChannel.RecentMessageInteractions) != len(other.RecentMessageInteractions) {
+		changes = append(changes, Change{Path: fieldPath(path, "recent_message_interactions"), Old: chatStatisticsChannel.RecentMessageInteractions, New: other.RecentMessageInteractions})
+	} else {
+		for i0 := range chatStatisticsChannel.RecentMessageInteractions {
+			changes = chatStatisticsChannel.RecentMessageInteractions[i0].diff(indexPath(fieldPath(path, "recent_message_interactions"), i0), &other.RecentMessageInteractions[i0], changes)
+		}
+	}
+	return changes
+}
 
-	fieldLanguageGraph, _ := unmarshalStatisticalGraph(objMap["language_graph"])
-	chatStatisticsChannel.LanguageGraph = fieldLanguageGraph
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatStatisticsChannel *ChatStatisticsChannel) String() string {
+	return objectString(chatStatisticsChannel)
+}
 
-	fieldMessageInteractionGraph, _ := unmarshalStatisticalGraph(objMap["message_interaction_graph"])
-	chatStatisticsChannel.MessageInteractionGraph = fieldMessageInteractionGraph
+// LogValue logs the object as its summary
+func (chatStatisticsChannel *ChatStatisticsChannel) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatStatisticsChannel))
+}
 
-	fieldInstantViewInteractionGraph, _ := unmarshalStatisticalGraph(objMap["instant_view_interaction_graph"])
-	chatStatisticsChannel.InstantViewInteractionGraph = fieldInstantViewInteractionGraph
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatStatisticsChannel *ChatStatisticsChannel) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatStatisticsChannel)
+}
 
-	return nil
+func (chatStatisticsChannel *ChatStatisticsChannel) printText(p *printer) {
+	if chatStatisticsChannel == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatStatisticsChannel")
+	p.object("period", chatStatisticsChannel.Period)
+	p.object("member_count", chatStatisticsChannel.MemberCount)
+	p.object("mean_view_count", chatStatisticsChannel.MeanViewCount)
+	p.object("mean_share_count", chatStatisticsChannel.MeanShareCount)
+	p.float("enabled_notifications_percentage", chatStatisticsChannel.EnabledNotificationsPercentage)
+	p.object("member_count_graph", chatStatisticsChannel.MemberCountGraph)
+	p.object("join_graph", chatStatisticsChannel.JoinGraph)
+	p.object("mute_graph", chatStatisticsChannel.MuteGraph)
+	p.object("view_count_by_hour_graph", chatStatisticsChannel.ViewCountByHourGraph)
+	p.object("view_count_by_source_graph", chatStatisticsChannel.ViewCountBySourceGraph)
+	p.object("join_by_source_graph", chatStatisticsChannel.JoinBySourceGraph)
+	p.object("language_graph", chatStatisticsChannel.LanguageGraph)
+	p.object("message_interaction_graph", chatStatisticsChannel.MessageInteractionGraph)
+	p.object("instant_view_interaction_graph", chatStatisticsChannel.InstantViewInteractionGraph)
+	p.vector("recent_message_interactions", len(chatStatisticsChannel.RecentMessageInteractions), func(i0 int) {
+		chatStatisticsChannel.RecentMessageInteractions[i0].printText(p)
+	})
+	p.endObject(state)
 }
 
 // GetChatStatisticsEnum return the enum type of this object
@@ -308,6 +1028,106 @@ func (chatStatisticsChannel *ChatStatisticsChannel) GetChatStatisticsEnum() Chat
 	return ChatStatisticsChannelType
 }
 
+// Accept calls the method of the visitor for ChatStatisticsChannel
+func (chatStatisticsChannel *ChatStatisticsChannel) Accept(visitor ChatStatisticsVisitor) {
+	visitor.VisitChatStatisticsChannel(chatStatisticsChannel)
+}
+
+// ChatStatisticsVisitor visits every type of ChatStatistics, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to ChatStatistics, until they handle it too.
+type ChatStatisticsVisitor interface {
+	VisitChatStatisticsSupergroup(chatStatisticsSupergroup *ChatStatisticsSupergroup)
+	VisitChatStatisticsChannel(chatStatisticsChannel *ChatStatisticsChannel)
+	VisitUnknownChatStatistics(unknownChatStatistics *UnknownChatStatistics)
+}
+
+// ChatStatisticsCases holds the function MatchChatStatistics calls for each type of ChatStatistics;
+// types without a function are passed to the default case
+type ChatStatisticsCases[R any] struct {
+	ChatStatisticsSupergroup func(chatStatisticsSupergroup *ChatStatisticsSupergroup) R
+	ChatStatisticsChannel    func(chatStatisticsChannel *ChatStatisticsChannel) R
+}
+
+// MatchChatStatistics calls the case of the type of chatStatistics, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement ChatStatisticsVisitor
+// to have the compiler check every type is handled.
+func MatchChatStatistics[R any](chatStatistics ChatStatistics, cases ChatStatisticsCases[R], defaultCase func(chatStatistics ChatStatistics) R) R {
+	switch value := chatStatistics.(type) {
+	case *ChatStatisticsSupergroup:
+		if cases.ChatStatisticsSupergroup != nil {
+			return cases.ChatStatisticsSupergroup(value)
+		}
+	case *ChatStatisticsChannel:
+		if cases.ChatStatisticsChannel != nil {
+			return cases.ChatStatisticsChannel(value)
+		}
+	}
+	return defaultCase(chatStatistics)
+}
+
+// Clone returns a deep copy of the object
+func (unknownChatStatistics *UnknownChatStatistics) Clone() *UnknownChatStatistics {
+	if unknownChatStatistics == nil {
+		return nil
+	}
+	return &UnknownChatStatistics{Unknown: unknownChatStatistics.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownChatStatistics *UnknownChatStatistics) Equal(other *UnknownChatStatistics) bool {
+	if unknownChatStatistics == nil || other == nil {
+		return unknownChatStatistics == other
+	}
+	return unknownChatStatistics.Unknown.equal(other.Unknown)
+}
+
+func cloneChatStatistics(chatStatistics ChatStatistics) ChatStatistics {
+	switch value := chatStatistics.(type) {
+	case *ChatStatisticsSupergroup:
+		return value.Clone()
+	case *ChatStatisticsChannel:
+		return value.Clone()
+	case *UnknownChatStatistics:
+		return value.Clone()
+	}
+	return chatStatistics
+}
+
+func equalChatStatistics(a ChatStatistics, b ChatStatistics) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *ChatStatisticsSupergroup:
+		b, ok := b.(*ChatStatisticsSupergroup)
+		return ok && a.Equal(b)
+	case *ChatStatisticsChannel:
+		b, ok := b.(*ChatStatisticsChannel)
+		return ok && a.Equal(b)
+	case *UnknownChatStatistics:
+		b, ok := b.(*UnknownChatStatistics)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffChatStatistics(path string, a ChatStatistics, b ChatStatistics, changes []Change) []Change {
+	switch a := a.(type) {
+	case *ChatStatisticsSupergroup:
+		if b, ok := b.(*ChatStatisticsSupergroup); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatStatisticsChannel:
+		if b, ok := b.(*ChatStatisticsChannel); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalChatStatistics(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
+
 // GetChatStatistics Returns detailed statistics about a chat. Currently, this method can be used only for supergroups and channels. Can be used only if supergroupFullInfo.can_get_statistics == true
 // @param chatId Chat identifier
 // @param isDark Pass true if a dark theme is used by the application
@@ -322,20 +1142,21 @@ func (client *Client) GetChatStatistics(chatId int64, isDark bool) (ChatStatisti
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
-	switch ChatStatisticsEnum(result.Data["@type"].(string)) {
+	resultType, _ := result.Data["@type"].(string)
+	switch ChatStatisticsEnum(resultType) {
 
 	case ChatStatisticsSupergroupType:
 		var chatStatistics ChatStatisticsSupergroup
-		err = json.Unmarshal(result.Raw, &chatStatistics)
+		err = jsonUnmarshal(result.Raw, &chatStatistics)
 		return &chatStatistics, err
 
 	case ChatStatisticsChannelType:
 		var chatStatistics ChatStatisticsChannel
-		err = json.Unmarshal(result.Raw, &chatStatistics)
+		err = jsonUnmarshal(result.Raw, &chatStatistics)
 		return &chatStatistics, err
 
 	default:
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // ChatStatisticsAdministratorActionsInfo Contains statistics about administrator actions done by a user
 type ChatStatisticsAdministratorActionsInfo struct {
 	tdCommon
@@ -31,3 +36,138 @@ func NewChatStatisticsAdministratorActionsInfo(userId int64, deletedMessageCount
 
 	return &chatStatisticsAdministratorActionsInfoTemp
 }
+
+// MarshalJSON marshals to json
+func (chatStatisticsAdministratorActionsInfo *ChatStatisticsAdministratorActionsInfo) MarshalJSON() ([]byte, error) {
+	return chatStatisticsAdministratorActionsInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatStatisticsAdministratorActionsInfo *ChatStatisticsAdministratorActionsInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatStatisticsAdministratorActionsInfo)
+}
+
+func (chatStatisticsAdministratorActionsInfo *ChatStatisticsAdministratorActionsInfo) appendJSON(b []byte) ([]byte, error) {
+	if chatStatisticsAdministratorActionsInfo == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatStatisticsAdministratorActionsInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatStatisticsAdministratorActionsInfo.tdCommon.Extra)
+	b = append(b, `,"user_id":`...)
+	b = appendJSONInt(b, int64(chatStatisticsAdministratorActionsInfo.UserId))
+	b = append(b, `,"deleted_message_count":`...)
+	b = appendJSONInt(b, int64(chatStatisticsAdministratorActionsInfo.DeletedMessageCount))
+	b = append(b, `,"banned_user_count":`...)
+	b = appendJSONInt(b, int64(chatStatisticsAdministratorActionsInfo.BannedUserCount))
+	b = append(b, `,"restricted_user_count":`...)
+	b = appendJSONInt(b, int64(chatStatisticsAdministratorActionsInfo.RestrictedUserCount))
+	return append(b, '}'), nil
+}
+
+func (chatStatisticsAdministratorActionsInfo *ChatStatisticsAdministratorActionsInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatStatisticsAdministratorActionsInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatStatisticsAdministratorActionsInfo.tdCommon.Extra, err = r.readString()
+		case "user_id":
+			chatStatisticsAdministratorActionsInfo.UserId, err = r.readInt64()
+		case "deleted_message_count":
+			chatStatisticsAdministratorActionsInfo.DeletedMessageCount, err = r.readInt32()
+		case "banned_user_count":
+			chatStatisticsAdministratorActionsInfo.BannedUserCount, err = r.readInt32()
+		case "restricted_user_count":
+			chatStatisticsAdministratorActionsInfo.RestrictedUserCount, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatStatisticsAdministratorActionsInfo *ChatStatisticsAdministratorActionsInfo) Clone() *ChatStatisticsAdministratorActionsInfo {
+	if chatStatisticsAdministratorActionsInfo == nil {
+		return nil
+	}
+	clone := *chatStatisticsAdministratorActionsInfo
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatStatisticsAdministratorActionsInfo *ChatStatisticsAdministratorActionsInfo) Equal(other *ChatStatisticsAdministratorActionsInfo) bool {
+	if chatStatisticsAdministratorActionsInfo == nil || other == nil {
+		return chatStatisticsAdministratorActionsInfo == other
+	}
+	if chatStatisticsAdministratorActionsInfo.UserId != other.UserId {
+		return false
+	}
+	if chatStatisticsAdministratorActionsInfo.DeletedMessageCount != other.DeletedMessageCount {
+		return false
+	}
+	if chatStatisticsAdministratorActionsInfo.BannedUserCount != other.BannedUserCount {
+		return false
+	}
+	if chatStatisticsAdministratorActionsInfo.RestrictedUserCount != other.RestrictedUserCount {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatStatisticsAdministratorActionsInfo *ChatStatisticsAdministratorActionsInfo) Diff(other *ChatStatisticsAdministratorActionsInfo) []Change {
+	return chatStatisticsAdministratorActionsInfo.diff("", other, nil)
+}
+
+func (chatStatisticsAdministratorActionsInfo *ChatStatisticsAdministratorActionsInfo) diff(path string, other *ChatStatisticsAdministratorActionsInfo, changes []Change) []Change {
+	if chatStatisticsAdministratorActionsInfo == nil || other == nil {
+		if chatStatisticsAdministratorActionsInfo != other {
+			changes = append(changes, Change{Path: path, Old: chatStatisticsAdministratorActionsInfo, New: other})
+		}
+		return changes
+	}
+	if chatStatisticsAdministratorActionsInfo.UserId != other.UserId {
+		changes = append(changes, Change{Path: fieldPath(path, "user_id"), Old: chatStatisticsAdministratorActionsInfo.UserId, New: other.UserId})
+	}
+	if chatStatisticsAdministratorActionsInfo.DeletedMessageCount != other.DeletedMessageCount {
+		changes = append(changes, Change{Path: fieldPath(path, "deleted_message_count"), Old: chatStatisticsAdministratorActionsInfo.DeletedMessageCount, New: other.DeletedMessageCount})
+	}
+	if chatStatisticsAdministratorActionsInfo.BannedUserCount != other.BannedUserCount {
+		changes = append(changes, Change{Path: fieldPath(path, "banned_user_count"), Old: chatStatisticsAdministratorActionsInfo.BannedUserCount, New: other.BannedUserCount})
+	}
+	if chatStatisticsAdministratorActionsInfo.RestrictedUserCount != other.RestrictedUserCount {
+		changes = append(changes, Change{Path: fieldPath(path, "restricted_user_count"), Old: chatStatisticsAdministratorActionsInfo.RestrictedUserCount, New: other.RestrictedUserCount})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatStatisticsAdministratorActionsInfo *ChatStatisticsAdministratorActionsInfo) String() string {
+	return objectString(chatStatisticsAdministratorActionsInfo)
+}
+
+// LogValue logs the object as its summary
+func (chatStatisticsAdministratorActionsInfo *ChatStatisticsAdministratorActionsInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatStatisticsAdministratorActionsInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatStatisticsAdministratorActionsInfo *ChatStatisticsAdministratorActionsInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatStatisticsAdministratorActionsInfo)
+}
+
+func (chatStatisticsAdministratorActionsInfo *ChatStatisticsAdministratorActionsInfo) printText(p *printer) {
+	if chatStatisticsAdministratorActionsInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatStatisticsAdministratorActionsInfo")
+	p.int("user_id", int64(chatStatisticsAdministratorActionsInfo.UserId))
+	p.int("deleted_message_count", int64(chatStatisticsAdministratorActionsInfo.DeletedMessageCount))
+	p.int("banned_user_count", int64(chatStatisticsAdministratorActionsInfo.BannedUserCount))
+	p.int("restricted_user_count", int64(chatStatisticsAdministratorActionsInfo.RestrictedUserCount))
+	p.endObject(state)
+}
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // ChatStatisticsInviterInfo Contains statistics about number of new members invited by a user
 type ChatStatisticsInviterInfo struct {
 	tdCommon
@@ -25,3 +30,116 @@ func NewChatStatisticsInviterInfo(userId int64, addedMemberCount int32) *ChatSta
 
 	return &chatStatisticsInviterInfoTemp
 }
+
+// MarshalJSON marshals to json
+func (chatStatisticsInviterInfo *ChatStatisticsInviterInfo) MarshalJSON() ([]byte, error) {
+	return chatStatisticsInviterInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatStatisticsInviterInfo *ChatStatisticsInviterInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatStatisticsInviterInfo)
+}
+
+func (chatStatisticsInviterInfo *ChatStatisticsInviterInfo) appendJSON(b []byte) ([]byte, error) {
+	if chatStatisticsInviterInfo == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatStatisticsInviterInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatStatisticsInviterInfo.tdCommon.Extra)
+	b = append(b, `,"user_id":`...)
+	b = appendJSONInt(b, int64(chatStatisticsInviterInfo.UserId))
+	b = append(b, `,"added_member_count":`...)
+	b = appendJSONInt(b, int64(chatStatisticsInviterInfo.AddedMemberCount))
+	return append(b, '}'), nil
+}
+
+func (chatStatisticsInviterInfo *ChatStatisticsInviterInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatStatisticsInviterInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatStatisticsInviterInfo.tdCommon.Extra, err = r.readString()
+		case "user_id":
+			chatStatisticsInviterInfo.UserId, err = r.readInt64()
+		case "added_member_count":
+			chatStatisticsInviterInfo.AddedMemberCount, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatStatisticsInviterInfo *ChatStatisticsInviterInfo) Clone() *ChatStatisticsInviterInfo {
+	if chatStatisticsInviterInfo == nil {
+		return nil
+	}
+	clone := *chatStatisticsInviterInfo
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatStatisticsInviterInfo *ChatStatisticsInviterInfo) Equal(other *ChatStatisticsInviterInfo) bool {
+	if chatStatisticsInviterInfo == nil || other == nil {
+		return chatStatisticsInviterInfo == other
+	}
+	if chatStatisticsInviterInfo.UserId != other.UserId {
+		return false
+	}
+	if chatStatisticsInviterInfo.AddedMemberCount != other.AddedMemberCount {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatStatisticsInviterInfo *ChatStatisticsInviterInfo) Diff(other *ChatStatisticsInviterInfo) []Change {
+	return chatStatisticsInviterInfo.diff("", other, nil)
+}
+
+func (chatStatisticsInviterInfo *ChatStatisticsInviterInfo) diff(path string, other *ChatStatisticsInviterInfo, changes []Change) []Change {
+	if chatStatisticsInviterInfo == nil || other == nil {
+		if chatStatisticsInviterInfo != other {
+			changes = append(changes, Change{Path: path, Old: chatStatisticsInviterInfo, New: other})
+		}
+		return changes
+	}
+	if chatStatisticsInviterInfo.UserId != other.UserId {
+		changes = append(changes, Change{Path: fieldPath(path, "user_id"), Old: chatStatisticsInviterInfo.UserId, New: other.UserId})
+	}
+	if chatStatisticsInviterInfo.AddedMemberCount != other.AddedMemberCount {
+		changes = append(changes, Change{Path: fieldPath(path, "added_member_count"), Old: chatStatisticsInviterInfo.AddedMemberCount, New: other.AddedMemberCount})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatStatisticsInviterInfo *ChatStatisticsInviterInfo) String() string {
+	return objectString(chatStatisticsInviterInfo)
+}
+
+// LogValue logs the object as its summary
+func (chatStatisticsInviterInfo *ChatStatisticsInviterInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatStatisticsInviterInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatStatisticsInviterInfo *ChatStatisticsInviterInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatStatisticsInviterInfo)
+}
+
+func (chatStatisticsInviterInfo *ChatStatisticsInviterInfo) printText(p *printer) {
+	if chatStatisticsInviterInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatStatisticsInviterInfo")
+	p.int("user_id", int64(chatStatisticsInviterInfo.UserId))
+	p.int("added_member_count", int64(chatStatisticsInviterInfo.AddedMemberCount))
+	p.endObject(state)
+}
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // ChatStatisticsMessageInteractionInfo Contains statistics about interactions with a message
 type ChatStatisticsMessageInteractionInfo struct {
 	tdCommon
@@ -28,3 +33,127 @@ func NewChatStatisticsMessageInteractionInfo(messageId int64, viewCount int32, f
 
 	return &chatStatisticsMessageInteractionInfoTemp
 }
+
+// MarshalJSON marshals to json
+func (chatStatisticsMessageInteractionInfo *ChatStatisticsMessageInteractionInfo) MarshalJSON() ([]byte, error) {
+	return chatStatisticsMessageInteractionInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatStatisticsMessageInteractionInfo *ChatStatisticsMessageInteractionInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatStatisticsMessageInteractionInfo)
+}
+
+func (chatStatisticsMessageInteractionInfo *ChatStatisticsMessageInteractionInfo) appendJSON(b []byte) ([]byte, error) {
+	if chatStatisticsMessageInteractionInfo == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatStatisticsMessageInteractionInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatStatisticsMessageInteractionInfo.tdCommon.Extra)
+	b = append(b, `,"message_id":`...)
+	b = appendJSONInt(b, int64(chatStatisticsMessageInteractionInfo.MessageId))
+	b = append(b, `,"view_count":`...)
+	b = appendJSONInt(b, int64(chatStatisticsMessageInteractionInfo.ViewCount))
+	b = append(b, `,"forward_count":`...)
+	b = appendJSONInt(b, int64(chatStatisticsMessageInteractionInfo.ForwardCount))
+	return append(b, '}'), nil
+}
+
+func (chatStatisticsMessageInteractionInfo *ChatStatisticsMessageInteractionInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatStatisticsMessageInteractionInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatStatisticsMessageInteractionInfo.tdCommon.Extra, err = r.readString()
+		case "message_id":
+			chatStatisticsMessageInteractionInfo.MessageId, err = r.readInt64()
+		case "view_count":
+			chatStatisticsMessageInteractionInfo.ViewCount, err = r.readInt32()
+		case "forward_count":
+			chatStatisticsMessageInteractionInfo.ForwardCount, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatStatisticsMessageInteractionInfo *ChatStatisticsMessageInteractionInfo) Clone() *ChatStatisticsMessageInteractionInfo {
+	if chatStatisticsMessageInteractionInfo == nil {
+		return nil
+	}
+	clone := *chatStatisticsMessageInteractionInfo
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatStatisticsMessageInteractionInfo *ChatStatisticsMessageInteractionInfo) Equal(other *ChatStatisticsMessageInteractionInfo) bool {
+	if chatStatisticsMessageInteractionInfo == nil || other == nil {
+		return chatStatisticsMessageInteractionInfo == other
+	}
+	if chatStatisticsMessageInteractionInfo.MessageId != other.MessageId {
+		return false
+	}
+	if chatStatisticsMessageInteractionInfo.ViewCount != other.ViewCount {
+		return false
+	}
+	if chatStatisticsMessageInteractionInfo.ForwardCount != other.ForwardCount {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatStatisticsMessageInteractionInfo *ChatStatisticsMessageInteractionInfo) Diff(other *ChatStatisticsMessageInteractionInfo) []Change {
+	return chatStatisticsMessageInteractionInfo.diff("", other, nil)
+}
+
+func (chatStatisticsMessageInteractionInfo *ChatStatisticsMessageInteractionInfo) diff(path string, other *ChatStatisticsMessageInteractionInfo, changes []Change) []Change {
+	if chatStatisticsMessageInteractionInfo == nil || other == nil {
+		if chatStatisticsMessageInteractionInfo != other {
+			changes = append(changes, Change{Path: path, Old: chatStatisticsMessageInteractionInfo, New: other})
+		}
+		return changes
+	}
+	if chatStatisticsMessageInteractionInfo.MessageId != other.MessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "message_id"), Old: chatStatisticsMessageInteractionInfo.MessageId, New: other.MessageId})
+	}
+	if chatStatisticsMessageInteractionInfo.ViewCount != other.ViewCount {
+		changes = append(changes, Change{Path: fieldPath(path, "view_count"), Old: chatStatisticsMessageInteractionInfo.ViewCount, New: other.ViewCount})
+	}
+	if chatStatisticsMessageInteractionInfo.ForwardCount != other.ForwardCount {
+		changes = append(changes, Change{Path: fieldPath(path, "forward_count"), Old: chatStatisticsMessageInteractionInfo.ForwardCount, New: other.ForwardCount})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatStatisticsMessageInteractionInfo *ChatStatisticsMessageInteractionInfo) String() string {
+	return objectString(chatStatisticsMessageInteractionInfo)
+}
+
+// LogValue logs the object as its summary
+func (chatStatisticsMessageInteractionInfo *ChatStatisticsMessageInteractionInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatStatisticsMessageInteractionInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatStatisticsMessageInteractionInfo *ChatStatisticsMessageInteractionInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatStatisticsMessageInteractionInfo)
+}
+
+func (chatStatisticsMessageInteractionInfo *ChatStatisticsMessageInteractionInfo) printText(p *printer) {
+	if chatStatisticsMessageInteractionInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatStatisticsMessageInteractionInfo")
+	p.int("message_id", int64(chatStatisticsMessageInteractionInfo.MessageId))
+	p.int("view_count", int64(chatStatisticsMessageInteractionInfo.ViewCount))
+	p.int("forward_count", int64(chatStatisticsMessageInteractionInfo.ForwardCount))
+	p.endObject(state)
+}
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // ChatStatisticsMessageSenderInfo Contains statistics about messages sent by a user
 type ChatStatisticsMessageSenderInfo struct {
 	tdCommon
@@ -28,3 +33,127 @@ func NewChatStatisticsMessageSenderInfo(userId int64, sentMessageCount int32, av
 
 	return &chatStatisticsMessageSenderInfoTemp
 }
+
+// MarshalJSON marshals to json
+func (chatStatisticsMessageSenderInfo *ChatStatisticsMessageSenderInfo) MarshalJSON() ([]byte, error) {
+	return chatStatisticsMessageSenderInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatStatisticsMessageSenderInfo *ChatStatisticsMessageSenderInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatStatisticsMessageSenderInfo)
+}
+
+func (chatStatisticsMessageSenderInfo *ChatStatisticsMessageSenderInfo) appendJSON(b []byte) ([]byte, error) {
+	if chatStatisticsMessageSenderInfo == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatStatisticsMessageSenderInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatStatisticsMessageSenderInfo.tdCommon.Extra)
+	b = append(b, `,"user_id":`...)
+	b = appendJSONInt(b, int64(chatStatisticsMessageSenderInfo.UserId))
+	b = append(b, `,"sent_message_count":`...)
+	b = appendJSONInt(b, int64(chatStatisticsMessageSenderInfo.SentMessageCount))
+	b = append(b, `,"average_character_count":`...)
+	b = appendJSONInt(b, int64(chatStatisticsMessageSenderInfo.AverageCharacterCount))
+	return append(b, '}'), nil
+}
+
+func (chatStatisticsMessageSenderInfo *ChatStatisticsMessageSenderInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatStatisticsMessageSenderInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatStatisticsMessageSenderInfo.tdCommon.Extra, err = r.readString()
+		case "user_id":
+			chatStatisticsMessageSenderInfo.UserId, err = r.readInt64()
+		case "sent_message_count":
+			chatStatisticsMessageSenderInfo.SentMessageCount, err = r.readInt32()
+		case "average_character_count":
+			chatStatisticsMessageSenderInfo.AverageCharacterCount, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatStatisticsMessageSenderInfo *ChatStatisticsMessageSenderInfo) Clone() *ChatStatisticsMessageSenderInfo {
+	if chatStatisticsMessageSenderInfo == nil {
+		return nil
+	}
+	clone := *chatStatisticsMessageSenderInfo
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatStatisticsMessageSenderInfo *ChatStatisticsMessageSenderInfo) Equal(other *ChatStatisticsMessageSenderInfo) bool {
+	if chatStatisticsMessageSenderInfo == nil || other == nil {
+		return chatStatisticsMessageSenderInfo == other
+	}
+	if chatStatisticsMessageSenderInfo.UserId != other.UserId {
+		return false
+	}
+	if chatStatisticsMessageSenderInfo.SentMessageCount != other.SentMessageCount {
+		return false
+	}
+	if chatStatisticsMessageSenderInfo.AverageCharacterCount != other.AverageCharacterCount {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatStatisticsMessageSenderInfo *ChatStatisticsMessageSenderInfo) Diff(other *ChatStatisticsMessageSenderInfo) []Change {
+	return chatStatisticsMessageSenderInfo.diff("", other, nil)
+}
+
+func (chatStatisticsMessageSenderInfo *ChatStatisticsMessageSenderInfo) diff(path string, other *ChatStatisticsMessageSenderInfo, changes []Change) []Change {
+	if chatStatisticsMessageSenderInfo == nil || other == nil {
+		if chatStatisticsMessageSenderInfo != other {
+			changes = append(changes, Change{Path: path, Old: chatStatisticsMessageSenderInfo, New: other})
+		}
+		return changes
+	}
+	if chatStatisticsMessageSenderInfo.UserId != other.UserId {
+		changes = append(changes, Change{Path: fieldPath(path, "user_id"), Old: chatStatisticsMessageSenderInfo.UserId, New: other.UserId})
+	}
+	if chatStatisticsMessageSenderInfo.SentMessageCount != other.SentMessageCount {
+		changes = append(changes, Change{Path: fieldPath(path, "sent_message_count"), Old: chatStatisticsMessageSenderInfo.SentMessageCount, New: other.SentMessageCount})
+	}
+	if chatStatisticsMessageSenderInfo.AverageCharacterCount != other.AverageCharacterCount {
+		changes = append(changes, Change{Path: fieldPath(path, "average_character_count"), Old: chatStatisticsMessageSenderInfo.AverageCharacterCount, New: other.AverageCharacterCount})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatStatisticsMessageSenderInfo *ChatStatisticsMessageSenderInfo) String() string {
+	return objectString(chatStatisticsMessageSenderInfo)
+}
+
+// LogValue logs the object as its summary
+func (chatStatisticsMessageSenderInfo *ChatStatisticsMessageSenderInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatStatisticsMessageSenderInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatStatisticsMessageSenderInfo *ChatStatisticsMessageSenderInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatStatisticsMessageSenderInfo)
+}
+
+func (chatStatisticsMessageSenderInfo *ChatStatisticsMessageSenderInfo) printText(p *printer) {
+	if chatStatisticsMessageSenderInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatStatisticsMessageSenderInfo")
+	p.int("user_id", int64(chatStatisticsMessageSenderInfo.UserId))
+	p.int("sent_message_count", int64(chatStatisticsMessageSenderInfo.SentMessageCount))
+	p.int("average_character_count", int64(chatStatisticsMessageSenderInfo.AverageCharacterCount))
+	p.endObject(state)
+}
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // ChatTheme Describes a chat theme
 type ChatTheme struct {
 	tdCommon
@@ -28,3 +33,142 @@ func NewChatTheme(name string, lightSettings *ThemeSettings, darkSettings *Theme
 
 	return &chatThemeTemp
 }
+
+// MarshalJSON marshals to json
+func (chatTheme *ChatTheme) MarshalJSON() ([]byte, error) {
+	return chatTheme.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatTheme *ChatTheme) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatTheme)
+}
+
+func (chatTheme *ChatTheme) appendJSON(b []byte) ([]byte, error) {
+	if chatTheme == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatTheme.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatTheme.tdCommon.Extra)
+	b = append(b, `,"name":`...)
+	b = appendJSONString(b, chatTheme.Name)
+	b = append(b, `,"light_settings":`...)
+	b, err = chatTheme.LightSettings.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"dark_settings":`...)
+	b, err = chatTheme.DarkSettings.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (chatTheme *ChatTheme) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatTheme.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatTheme.tdCommon.Extra, err = r.readString()
+		case "name":
+			chatTheme.Name, err = r.readString()
+		case "light_settings":
+			if r.readNull() {
+				chatTheme.LightSettings = nil
+			} else {
+				chatTheme.LightSettings = new(ThemeSettings)
+				err = chatTheme.LightSettings.decodeJSON(r)
+			}
+		case "dark_settings":
+			if r.readNull() {
+				chatTheme.DarkSettings = nil
+			} else {
+				chatTheme.DarkSettings = new(ThemeSettings)
+				err = chatTheme.DarkSettings.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatTheme *ChatTheme) Clone() *ChatTheme {
+	if chatTheme == nil {
+		return nil
+	}
+	clone := *chatTheme
+	clone.LightSettings = chatTheme.LightSettings.Clone()
+	clone.DarkSettings = chatTheme.DarkSettings.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatTheme *ChatTheme) Equal(other *ChatTheme) bool {
+	if chatTheme == nil || other == nil {
+		return chatTheme == other
+	}
+	if chatTheme.Name != other.Name {
+		return false
+	}
+	if !chatTheme.LightSettings.Equal(other.LightSettings) {
+		return false
+	}
+	if !chatTheme.DarkSettings.Equal(other.DarkSettings) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatTheme *ChatTheme) Diff(other *ChatTheme) []Change {
+	return chatTheme.diff("", other, nil)
+}
+
+func (chatTheme *ChatTheme) diff(path string, other *ChatTheme, changes []Change) []Change {
+	if chatTheme == nil || other == nil {
+		if chatTheme != other {
+			changes = append(changes, Change{Path: path, Old: chatTheme, New: other})
+		}
+		return changes
+	}
+	if chatTheme.Name != other.Name {
+		changes = append(changes, Change{Path: fieldPath(path, "name"), Old: chatTheme.Name, New: other.Name})
+	}
+	changes = chatTheme.LightSettings.diff(fieldPath(path, "light_settings"), other.LightSettings, changes)
+	changes = chatTheme.DarkSettings.diff(fieldPath(path, "dark_settings"), other.DarkSettings, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatTheme *ChatTheme) String() string {
+	return objectString(chatTheme)
+}
+
+// LogValue logs the object as its summary
+func (chatTheme *ChatTheme) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatTheme))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatTheme *ChatTheme) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatTheme)
+}
+
+func (chatTheme *ChatTheme) printText(p *printer) {
+	if chatTheme == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatTheme")
+	p.string("name", chatTheme.Name)
+	p.object("light_settings", chatTheme.LightSettings)
+	p.object("dark_settings", chatTheme.DarkSettings)
+	p.endObject(state)
+}
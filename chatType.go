@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ChatType Describes the type of a chat
 type ChatType interface {
 	GetChatTypeEnum() ChatTypeEnum
+	Accept(visitor ChatTypeVisitor)
 }
 
 // ChatTypeEnum Alias for abstract ChatType 'Sub-Classes', used as constant-enum here
@@ -21,43 +23,94 @@ const (
 	ChatTypeSecretType     ChatTypeEnum = "chatTypeSecret"
 )
 
+// UnknownChatType is a ChatType of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownChatType struct {
+	Unknown
+}
+
+// GetChatTypeEnum return the enum type of this object
+func (unknownChatType *UnknownChatType) GetChatTypeEnum() ChatTypeEnum {
+	return ChatTypeEnum(unknownChatType.Type)
+}
+
+// Accept calls the method of the visitor for UnknownChatType
+func (unknownChatType *UnknownChatType) Accept(visitor ChatTypeVisitor) {
+	visitor.VisitUnknownChatType(unknownChatType)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownChatType *UnknownChatType) String() string {
+	return objectString(unknownChatType)
+}
+
+// LogValue logs the object as its summary
+func (unknownChatType *UnknownChatType) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownChatType))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownChatType *UnknownChatType) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownChatType)
+}
+
+func (unknownChatType *UnknownChatType) printText(p *printer) {
+	if unknownChatType == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownChatType")
+	p.string("@type", unknownChatType.Type)
+	p.endObject(state)
+}
+
 func unmarshalChatType(rawMsg *json.RawMessage) (ChatType, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch ChatTypeEnum(objMap["@type"].(string)) {
+	switch ChatTypeEnum(typeName) {
 	case ChatTypePrivateType:
 		var chatTypePrivate ChatTypePrivate
-		err := json.Unmarshal(*rawMsg, &chatTypePrivate)
+		err := jsonUnmarshal(*rawMsg, &chatTypePrivate)
 		return &chatTypePrivate, err
 
 	case ChatTypeBasicGroupType:
 		var chatTypeBasicGroup ChatTypeBasicGroup
-		err := json.Unmarshal(*rawMsg, &chatTypeBasicGroup)
+		err := jsonUnmarshal(*rawMsg, &chatTypeBasicGroup)
 		return &chatTypeBasicGroup, err
 
 	case ChatTypeSupergroupType:
 		var chatTypeSupergroup ChatTypeSupergroup
-		err := json.Unmarshal(*rawMsg, &chatTypeSupergroup)
+		err := jsonUnmarshal(*rawMsg, &chatTypeSupergroup)
 		return &chatTypeSupergroup, err
 
 	case ChatTypeSecretType:
 		var chatTypeSecret ChatTypeSecret
-		err := json.Unmarshal(*rawMsg, &chatTypeSecret)
+		err := jsonUnmarshal(*rawMsg, &chatTypeSecret)
 		return &chatTypeSecret, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownChatType{Unknown: unknown}, nil
 	}
 }
 
+func decodeChatType(r *jsonReader) (ChatType, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalChatType(rawMsg)
+}
+
 // ChatTypePrivate An ordinary chat with a user
 type ChatTypePrivate struct {
 	tdCommon
@@ -81,11 +134,118 @@ func NewChatTypePrivate(userId int64) *ChatTypePrivate {
 	return &chatTypePrivateTemp
 }
 
+// MarshalJSON marshals to json
+func (chatTypePrivate *ChatTypePrivate) MarshalJSON() ([]byte, error) {
+	return chatTypePrivate.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatTypePrivate *ChatTypePrivate) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatTypePrivate)
+}
+
+func (chatTypePrivate *ChatTypePrivate) appendJSON(b []byte) ([]byte, error) {
+	if chatTypePrivate == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatTypePrivate.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatTypePrivate.tdCommon.Extra)
+	b = append(b, `,"user_id":`...)
+	b = appendJSONInt(b, int64(chatTypePrivate.UserId))
+	return append(b, '}'), nil
+}
+
+func (chatTypePrivate *ChatTypePrivate) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatTypePrivate.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatTypePrivate.tdCommon.Extra, err = r.readString()
+		case "user_id":
+			chatTypePrivate.UserId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatTypePrivate *ChatTypePrivate) Clone() *ChatTypePrivate {
+	if chatTypePrivate == nil {
+		return nil
+	}
+	clone := *chatTypePrivate
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatTypePrivate *ChatTypePrivate) Equal(other *ChatTypePrivate) bool {
+	if chatTypePrivate == nil || other == nil {
+		return chatTypePrivate == other
+	}
+	if chatTypePrivate.UserId != other.UserId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatTypePrivate *ChatTypePrivate) Diff(other *ChatTypePrivate) []Change {
+	return chatTypePrivate.diff("", other, nil)
+}
+
+func (chatTypePrivate *ChatTypePrivate) diff(path string, other *ChatTypePrivate, changes []Change) []Change {
+	if chatTypePrivate == nil || other == nil {
+		if chatTypePrivate != other {
+			changes = append(changes, Change{Path: path, Old: chatTypePrivate, New: other})
+		}
+		return changes
+	}
+	if chatTypePrivate.UserId != other.UserId {
+		changes = append(changes, Change{Path: fieldPath(path, "user_id"), Old: chatTypePrivate.UserId, New: other.UserId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatTypePrivate *ChatTypePrivate) String() string {
+	return objectString(chatTypePrivate)
+}
+
+// LogValue logs the object as its summary
+func (chatTypePrivate *ChatTypePrivate) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatTypePrivate))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatTypePrivate *ChatTypePrivate) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatTypePrivate)
+}
+
+func (chatTypePrivate *ChatTypePrivate) printText(p *printer) {
+	if chatTypePrivate == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatTypePrivate")
+	p.int("user_id", int64(chatTypePrivate.UserId))
+	p.endObject(state)
+}
+
 // GetChatTypeEnum return the enum type of this object
 func (chatTypePrivate *ChatTypePrivate) GetChatTypeEnum() ChatTypeEnum {
 	return ChatTypePrivateType
 }
 
+// Accept calls the method of the visitor for ChatTypePrivate
+func (chatTypePrivate *ChatTypePrivate) Accept(visitor ChatTypeVisitor) {
+	visitor.VisitChatTypePrivate(chatTypePrivate)
+}
+
 // ChatTypeBasicGroup A basic group (a chat with 0-200 other users)
 type ChatTypeBasicGroup struct {
 	tdCommon
@@ -109,11 +269,118 @@ func NewChatTypeBasicGroup(basicGroupId int64) *ChatTypeBasicGroup {
 	return &chatTypeBasicGroupTemp
 }
 
+// MarshalJSON marshals to json
+func (chatTypeBasicGroup *ChatTypeBasicGroup) MarshalJSON() ([]byte, error) {
+	return chatTypeBasicGroup.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatTypeBasicGroup *ChatTypeBasicGroup) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatTypeBasicGroup)
+}
+
+func (chatTypeBasicGroup *ChatTypeBasicGroup) appendJSON(b []byte) ([]byte, error) {
+	if chatTypeBasicGroup == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatTypeBasicGroup.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatTypeBasicGroup.tdCommon.Extra)
+	b = append(b, `,"basic_group_id":`...)
+	b = appendJSONInt(b, int64(chatTypeBasicGroup.BasicGroupId))
+	return append(b, '}'), nil
+}
+
+func (chatTypeBasicGroup *ChatTypeBasicGroup) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatTypeBasicGroup.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatTypeBasicGroup.tdCommon.Extra, err = r.readString()
+		case "basic_group_id":
+			chatTypeBasicGroup.BasicGroupId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatTypeBasicGroup *ChatTypeBasicGroup) Clone() *ChatTypeBasicGroup {
+	if chatTypeBasicGroup == nil {
+		return nil
+	}
+	clone := *chatTypeBasicGroup
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatTypeBasicGroup *ChatTypeBasicGroup) Equal(other *ChatTypeBasicGroup) bool {
+	if chatTypeBasicGroup == nil || other == nil {
+		return chatTypeBasicGroup == other
+	}
+	if chatTypeBasicGroup.BasicGroupId != other.BasicGroupId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatTypeBasicGroup *ChatTypeBasicGroup) Diff(other *ChatTypeBasicGroup) []Change {
+	return chatTypeBasicGroup.diff("", other, nil)
+}
+
+func (chatTypeBasicGroup *ChatTypeBasicGroup) diff(path string, other *ChatTypeBasicGroup, changes []Change) []Change {
+	if chatTypeBasicGroup == nil || other == nil {
+		if chatTypeBasicGroup != other {
+			changes = append(changes, Change{Path: path, Old: chatTypeBasicGroup, New: other})
+		}
+		return changes
+	}
+	if chatTypeBasicGroup.BasicGroupId != other.BasicGroupId {
+		changes = append(changes, Change{Path: fieldPath(path, "basic_group_id"), Old: chatTypeBasicGroup.BasicGroupId, New: other.BasicGroupId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatTypeBasicGroup *ChatTypeBasicGroup) String() string {
+	return objectString(chatTypeBasicGroup)
+}
+
+// LogValue logs the object as its summary
+func (chatTypeBasicGroup *ChatTypeBasicGroup) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatTypeBasicGroup))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatTypeBasicGroup *ChatTypeBasicGroup) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatTypeBasicGroup)
+}
+
+func (chatTypeBasicGroup *ChatTypeBasicGroup) printText(p *printer) {
+	if chatTypeBasicGroup == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatTypeBasicGroup")
+	p.int("basic_group_id", int64(chatTypeBasicGroup.BasicGroupId))
+	p.endObject(state)
+}
+
 // GetChatTypeEnum return the enum type of this object
 func (chatTypeBasicGroup *ChatTypeBasicGroup) GetChatTypeEnum() ChatTypeEnum {
 	return ChatTypeBasicGroupType
 }
 
+// Accept calls the method of the visitor for ChatTypeBasicGroup
+func (chatTypeBasicGroup *ChatTypeBasicGroup) Accept(visitor ChatTypeVisitor) {
+	visitor.VisitChatTypeBasicGroup(chatTypeBasicGroup)
+}
+
 // ChatTypeSupergroup A supergroup or channel (with unlimited members)
 type ChatTypeSupergroup struct {
 	tdCommon
@@ -140,11 +407,129 @@ func NewChatTypeSupergroup(supergroupId int64, isChannel bool) *ChatTypeSupergro
 	return &chatTypeSupergroupTemp
 }
 
+// MarshalJSON marshals to json
+func (chatTypeSupergroup *ChatTypeSupergroup) MarshalJSON() ([]byte, error) {
+	return chatTypeSupergroup.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatTypeSupergroup *ChatTypeSupergroup) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatTypeSupergroup)
+}
+
+func (chatTypeSupergroup *ChatTypeSupergroup) appendJSON(b []byte) ([]byte, error) {
+	if chatTypeSupergroup == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatTypeSupergroup.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatTypeSupergroup.tdCommon.Extra)
+	b = append(b, `,"supergroup_id":`...)
+	b = appendJSONInt(b, int64(chatTypeSupergroup.SupergroupId))
+	b = append(b, `,"is_channel":`...)
+	b = appendJSONBool(b, chatTypeSupergroup.IsChannel)
+	return append(b, '}'), nil
+}
+
+func (chatTypeSupergroup *ChatTypeSupergroup) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatTypeSupergroup.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatTypeSupergroup.tdCommon.Extra, err = r.readString()
+		case "supergroup_id":
+			chatTypeSupergroup.SupergroupId, err = r.readInt64()
+		case "is_channel":
+			chatTypeSupergroup.IsChannel, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatTypeSupergroup *ChatTypeSupergroup) Clone() *ChatTypeSupergroup {
+	if chatTypeSupergroup == nil {
+		return nil
+	}
+	clone := *chatTypeSupergroup
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatTypeSupergroup *ChatTypeSupergroup) Equal(other *ChatTypeSupergroup) bool {
+	if chatTypeSupergroup == nil || other == nil {
+		return chatTypeSupergroup == other
+	}
+	if chatTypeSupergroup.SupergroupId != other.SupergroupId {
+		return false
+	}
+	if chatTypeSupergroup.IsChannel != other.IsChannel {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatTypeSupergroup *ChatTypeSupergroup) Diff(other *ChatTypeSupergroup) []Change {
+	return chatTypeSupergroup.diff("", other, nil)
+}
+
+func (chatTypeSupergroup *ChatTypeSupergroup) diff(path string, other *ChatTypeSupergroup, changes []Change) []Change {
+	if chatTypeSupergroup == nil || other == nil {
+		if chatTypeSupergroup != other {
+			changes = append(changes, Change{Path: path, Old: chatTypeSupergroup, New: other})
+		}
+		return changes
+	}
+	if chatTypeSupergroup.SupergroupId != other.SupergroupId {
+		changes = append(changes, Change{Path: fieldPath(path, "supergroup_id"), Old: chatTypeSupergroup.SupergroupId, New: other.SupergroupId})
+	}
+	if chatTypeSupergroup.IsChannel != other.IsChannel {
+		changes = append(changes, Change{Path: fieldPath(path, "is_channel"), Old: chatTypeSupergroup.IsChannel, New: other.IsChannel})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatTypeSupergroup *ChatTypeSupergroup) String() string {
+	return objectString(chatTypeSupergroup)
+}
+
+// LogValue logs the object as its summary
+func (chatTypeSupergroup *ChatTypeSupergroup) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatTypeSupergroup))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatTypeSupergroup *ChatTypeSupergroup) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatTypeSupergroup)
+}
+
+func (chatTypeSupergroup *ChatTypeSupergroup) printText(p *printer) {
+	if chatTypeSupergroup == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatTypeSupergroup")
+	p.int("supergroup_id", int64(chatTypeSupergroup.SupergroupId))
+	p.bool("is_channel", chatTypeSupergroup.IsChannel)
+	p.endObject(state)
+}
+
 // GetChatTypeEnum return the enum type of this object
 func (chatTypeSupergroup *ChatTypeSupergroup) GetChatTypeEnum() ChatTypeEnum {
 	return ChatTypeSupergroupType
 }
 
+// Accept calls the method of the visitor for ChatTypeSupergroup
+func (chatTypeSupergroup *ChatTypeSupergroup) Accept(visitor ChatTypeVisitor) {
+	visitor.VisitChatTypeSupergroup(chatTypeSupergroup)
+}
+
 // ChatTypeSecret A secret chat with a user
 type ChatTypeSecret struct {
 	tdCommon
@@ -171,7 +556,250 @@ func NewChatTypeSecret(secretChatId int32, userId int64) *ChatTypeSecret {
 	return &chatTypeSecretTemp
 }
 
+// MarshalJSON marshals to json
+func (chatTypeSecret *ChatTypeSecret) MarshalJSON() ([]byte, error) {
+	return chatTypeSecret.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatTypeSecret *ChatTypeSecret) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatTypeSecret)
+}
+
+func (chatTypeSecret *ChatTypeSecret) appendJSON(b []byte) ([]byte, error) {
+	if chatTypeSecret == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatTypeSecret.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatTypeSecret.tdCommon.Extra)
+	b = append(b, `,"secret_chat_id":`...)
+	b = appendJSONInt(b, int64(chatTypeSecret.SecretChatId))
+	b = append(b, `,"user_id":`...)
+	b = appendJSONInt(b, int64(chatTypeSecret.UserId))
+	return append(b, '}'), nil
+}
+
+func (chatTypeSecret *ChatTypeSecret) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatTypeSecret.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatTypeSecret.tdCommon.Extra, err = r.readString()
+		case "secret_chat_id":
+			chatTypeSecret.SecretChatId, err = r.readInt32()
+		case "user_id":
+			chatTypeSecret.UserId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatTypeSecret *ChatTypeSecret) Clone() *ChatTypeSecret {
+	if chatTypeSecret == nil {
+		return nil
+	}
+	clone := *chatTypeSecret
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatTypeSecret *ChatTypeSecret) Equal(other *ChatTypeSecret) bool {
+	if chatTypeSecret == nil || other == nil {
+		return chatTypeSecret == other
+	}
+	if chatTypeSecret.SecretChatId != other.SecretChatId {
+		return false
+	}
+	if chatTypeSecret.UserId != other.UserId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatTypeSecret *ChatTypeSecret) Diff(other *ChatTypeSecret) []Change {
+	return chatTypeSecret.diff("", other, nil)
+}
+
+func (chatTypeSecret *ChatTypeSecret) diff(path string, other *ChatTypeSecret, changes []Change) []Change {
+	if chatTypeSecret == nil || other == nil {
+		if chatTypeSecret != other {
+			changes = append(changes, Change{Path: path, Old: chatTypeSecret, New: other})
+		}
+		return changes
+	}
+	if chatTypeSecret.SecretChatId != other.SecretChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "secret_chat_id"), Old: chatTypeSecret.SecretChatId, New: other.SecretChatId})
+	}
+	if chatTypeSecret.UserId != other.UserId {
+		changes = append(changes, Change{Path: fieldPath(path, "user_id"), Old: chatTypeSecret.UserId, New: other.UserId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatTypeSecret *ChatTypeSecret) String() string {
+	return objectString(chatTypeSecret)
+}
+
+// LogValue logs the object as its summary
+func (chatTypeSecret *ChatTypeSecret) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatTypeSecret))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatTypeSecret *ChatTypeSecret) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatTypeSecret)
+}
+
+func (chatTypeSecret *ChatTypeSecret) printText(p *printer) {
+	if chatTypeSecret == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatTypeSecret")
+	p.int("secret_chat_id", int64(chatTypeSecret.SecretChatId))
+	p.int("user_id", int64(chatTypeSecret.UserId))
+	p.endObject(state)
+}
+
 // GetChatTypeEnum return the enum type of this object
 func (chatTypeSecret *ChatTypeSecret) GetChatTypeEnum() ChatTypeEnum {
 	return ChatTypeSecretType
 }
+
+// Accept calls the method of the visitor for ChatTypeSecret
+func (chatTypeSecret *ChatTypeSecret) Accept(visitor ChatTypeVisitor) {
+	visitor.VisitChatTypeSecret(chatTypeSecret)
+}
+
+// ChatTypeVisitor visits every type of ChatType, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to ChatType, until they handle it too.
+type ChatTypeVisitor interface {
+	VisitChatTypePrivate(chatTypePrivate *ChatTypePrivate)
+	VisitChatTypeBasicGroup(chatTypeBasicGroup *ChatTypeBasicGroup)
+	VisitChatTypeSupergroup(chatTypeSupergroup *ChatTypeSupergroup)
+	VisitChatTypeSecret(chatTypeSecret *ChatTypeSecret)
+	VisitUnknownChatType(unknownChatType *UnknownChatType)
+}
+
+// ChatTypeCases holds the function MatchChatType calls for each type of ChatType;
+// types without a function are passed to the default case
+type ChatTypeCases[R any] struct {
+	ChatTypePrivate    func(chatTypePrivate *ChatTypePrivate) R
+	ChatTypeBasicGroup func(chatTypeBasicGroup *ChatTypeBasicGroup) R
+	ChatTypeSupergroup func(chatTypeSupergroup *ChatTypeSupergroup) R
+	ChatTypeSecret     func(chatTypeSecret *ChatTypeSecret) R
+}
+
+// MatchChatType calls the case of the type of chatType, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement ChatTypeVisitor
+// to have the compiler check every type is handled.
+func MatchChatType[R any](chatType ChatType, cases ChatTypeCases[R], defaultCase func(chatType ChatType) R) R {
+	switch value := chatType.(type) {
+	case *ChatTypePrivate:
+		if cases.ChatTypePrivate != nil {
+			return cases.ChatTypePrivate(value)
+		}
+	case *ChatTypeBasicGroup:
+		if cases.ChatTypeBasicGroup != nil {
+			return cases.ChatTypeBasicGroup(value)
+		}
+	case *ChatTypeSupergroup:
+		if cases.ChatTypeSupergroup != nil {
+			return cases.ChatTypeSupergroup(value)
+		}
+	case *ChatTypeSecret:
+		if cases.ChatTypeSecret != nil {
+			return cases.ChatTypeSecret(value)
+		}
+	}
+	return defaultCase(chatType)
+}
+
+// Clone returns a deep copy of the object
+func (unknownChatType *UnknownChatType) Clone() *UnknownChatType {
+	if unknownChatType == nil {
+		return nil
+	}
+	return &UnknownChatType{Unknown: unknownChatType.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownChatType *UnknownChatType) Equal(other *UnknownChatType) bool {
+	if unknownChatType == nil || other == nil {
+		return unknownChatType == other
+	}
+	return unknownChatType.Unknown.equal(other.Unknown)
+}
+
+func cloneChatType(chatType ChatType) ChatType {
+	switch value := chatType.(type) {
+	case *ChatTypePrivate:
+		return value.Clone()
+	case *ChatTypeBasicGroup:
+		return value.Clone()
+	case *ChatTypeSupergroup:
+		return value.Clone()
+	case *ChatTypeSecret:
+		return value.Clone()
+	case *UnknownChatType:
+		return value.Clone()
+	}
+	return chatType
+}
+
+func equalChatType(a ChatType, b ChatType) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *ChatTypePrivate:
+		b, ok := b.(*ChatTypePrivate)
+		return ok && a.Equal(b)
+	case *ChatTypeBasicGroup:
+		b, ok := b.(*ChatTypeBasicGroup)
+		return ok && a.Equal(b)
+	case *ChatTypeSupergroup:
+		b, ok := b.(*ChatTypeSupergroup)
+		return ok && a.Equal(b)
+	case *ChatTypeSecret:
+		b, ok := b.(*ChatTypeSecret)
+		return ok && a.Equal(b)
+	case *UnknownChatType:
+		b, ok := b.(*UnknownChatType)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffChatType(path string, a ChatType, b ChatType, changes []Change) []Change {
+	switch a := a.(type) {
+	case *ChatTypePrivate:
+		if b, ok := b.(*ChatTypePrivate); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatTypeBasicGroup:
+		if b, ok := b.(*ChatTypeBasicGroup); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatTypeSupergroup:
+		if b, ok := b.(*ChatTypeSupergroup); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ChatTypeSecret:
+		if b, ok := b.(*ChatTypeSecret); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalChatType(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // Chats Represents a list of chats
@@ -31,6 +31,157 @@ func NewChats(totalCount int32, chatIds []int64) *Chats {
 	return &chatsTemp
 }
 
+// MarshalJSON marshals to json
+func (chats *Chats) MarshalJSON() ([]byte, error) {
+	return chats.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chats *Chats) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chats)
+}
+
+func (chats *Chats) appendJSON(b []byte) ([]byte, error) {
+	if chats == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chats.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chats.tdCommon.Extra)
+	b = append(b, `,"total_count":`...)
+	b = appendJSONInt(b, int64(chats.TotalCount))
+	b = append(b, `,"chat_ids":`...)
+	if chats.ChatIds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range chats.ChatIds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(chats.ChatIds[i0]))
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (chats *Chats) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chats.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chats.tdCommon.Extra, err = r.readString()
+		case "total_count":
+			chats.TotalCount, err = r.readInt32()
+		case "chat_ids":
+			if r.readNull() {
+				chats.ChatIds = nil
+			} else {
+				chats.ChatIds = make([]int64, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int64
+					item0, err = r.readInt64()
+					chats.ChatIds = append(chats.ChatIds, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chats *Chats) Clone() *Chats {
+	if chats == nil {
+		return nil
+	}
+	clone := *chats
+	if chats.ChatIds != nil {
+		clone.ChatIds = make([]int64, len(chats.ChatIds))
+		copy(clone.ChatIds, chats.ChatIds)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chats *Chats) Equal(other *Chats) bool {
+	if chats == nil || other == nil {
+		return chats == other
+	}
+	if chats.TotalCount != other.TotalCount {
+		return false
+	}
+	if len(chats.ChatIds) != len(other.ChatIds) {
+		return false
+	}
+	for i0 := range chats.ChatIds {
+		if chats.ChatIds[i0] != other.ChatIds[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chats *Chats) Diff(other *Chats) []Change {
+	return chats.diff("", other, nil)
+}
+
+func (chats *Chats) diff(path string, other *Chats, changes []Change) []Change {
+	if chats == nil || other == nil {
+		if chats != other {
+			changes = append(changes, Change{Path: path, Old: chats, New: other})
+		}
+		return changes
+	}
+	if chats.TotalCount != other.TotalCount {
+		changes = append(changes, Change{Path: fieldPath(path, "total_count"), Old: chats.TotalCount, New: other.TotalCount})
+	}
+	if len(chats.ChatIds) != len(other.ChatIds) {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_ids"), Old: chats.ChatIds, New: other.ChatIds})
+	} else {
+		for i0 := range chats.ChatIds {
+			if chats.ChatIds[i0] != other.ChatIds[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "chat_ids"), i0), Old: chats.ChatIds[i0], New: other.ChatIds[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chats *Chats) String() string {
+	return objectString(chats)
+}
+
+// LogValue logs the object as its summary
+func (chats *Chats) LogValue() slog.Value {
+	return slog.StringValue(objectString(chats))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chats *Chats) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chats)
+}
+
+func (chats *Chats) printText(p *printer) {
+	if chats == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Chats")
+	p.int("total_count", int64(chats.TotalCount))
+	p.vector("chat_ids", len(chats.ChatIds), func(i0 int) {
+		p.int("chat_ids", int64(chats.ChatIds[i0]))
+	})
+	p.endObject(state)
+}
+
 // GetChats Returns an ordered list of chats from the beginning of a chat list. For informational purposes only. Use loadChats and updates processing instead to maintain chat lists in a consistent state
 // @param chatList The chat list in which to return chats; pass null to get chats from the main chat list
 // @param limit The maximum number of chats to be returned
@@ -45,12 +196,12 @@ func (client *Client) GetChats(chatList ChatList, limit int32) (*Chats, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chats Chats
-	err = json.Unmarshal(result.Raw, &chats)
+	err = jsonUnmarshal(result.Raw, &chats)
 	return &chats, err
 }
 
@@ -66,12 +217,12 @@ func (client *Client) SearchPublicChats(query string) (*Chats, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chats Chats
-	err = json.Unmarshal(result.Raw, &chats)
+	err = jsonUnmarshal(result.Raw, &chats)
 	return &chats, err
 }
 
@@ -89,12 +240,12 @@ func (client *Client) SearchChats(query string, limit int32) (*Chats, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chats Chats
-	err = json.Unmarshal(result.Raw, &chats)
+	err = jsonUnmarshal(result.Raw, &chats)
 	return &chats, err
 }
 
@@ -112,12 +263,12 @@ func (client *Client) SearchChatsOnServer(query string, limit int32) (*Chats, er
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chats Chats
-	err = json.Unmarshal(result.Raw, &chats)
+	err = jsonUnmarshal(result.Raw, &chats)
 	return &chats, err
 }
 
@@ -135,12 +286,12 @@ func (client *Client) GetTopChats(category TopChatCategory, limit int32) (*Chats
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chats Chats
-	err = json.Unmarshal(result.Raw, &chats)
+	err = jsonUnmarshal(result.Raw, &chats)
 	return &chats, err
 }
 
@@ -156,12 +307,12 @@ func (client *Client) GetRecentlyOpenedChats(limit int32) (*Chats, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chats Chats
-	err = json.Unmarshal(result.Raw, &chats)
+	err = jsonUnmarshal(result.Raw, &chats)
 	return &chats, err
 }
 
@@ -177,12 +328,12 @@ func (client *Client) GetCreatedPublicChats(typeParam PublicChatType) (*Chats, e
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chats Chats
-	err = json.Unmarshal(result.Raw, &chats)
+	err = jsonUnmarshal(result.Raw, &chats)
 	return &chats, err
 }
 
@@ -196,12 +347,12 @@ func (client *Client) GetSuitableDiscussionChats() (*Chats, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chats Chats
-	err = json.Unmarshal(result.Raw, &chats)
+	err = jsonUnmarshal(result.Raw, &chats)
 	return &chats, err
 }
 
@@ -215,12 +366,12 @@ func (client *Client) GetInactiveSupergroupChats() (*Chats, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chats Chats
-	err = json.Unmarshal(result.Raw, &chats)
+	err = jsonUnmarshal(result.Raw, &chats)
 	return &chats, err
 }
 
@@ -240,12 +391,12 @@ func (client *Client) GetGroupsInCommon(userId int64, offsetChatId int64, limit
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chats Chats
-	err = json.Unmarshal(result.Raw, &chats)
+	err = jsonUnmarshal(result.Raw, &chats)
 	return &chats, err
 }
 
@@ -263,11 +414,11 @@ func (client *Client) GetChatNotificationSettingsExceptions(scope NotificationSe
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chats Chats
-	err = json.Unmarshal(result.Raw, &chats)
+	err = jsonUnmarshal(result.Raw, &chats)
 	return &chats, err
 }
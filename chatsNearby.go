@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ChatsNearby Represents a list of chats located nearby
@@ -31,6 +31,202 @@ func NewChatsNearby(usersNearby []ChatNearby, supergroupsNearby []ChatNearby) *C
 	return &chatsNearbyTemp
 }
 
+// MarshalJSON marshals to json
+func (chatsNearby *ChatsNearby) MarshalJSON() ([]byte, error) {
+	return chatsNearby.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (chatsNearby *ChatsNearby) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, chatsNearby)
+}
+
+func (chatsNearby *ChatsNearby) appendJSON(b []byte) ([]byte, error) {
+	if chatsNearby == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, chatsNearby.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, chatsNearby.tdCommon.Extra)
+	b = append(b, `,"users_nearby":`...)
+	if chatsNearby.UsersNearby == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range chatsNearby.UsersNearby {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = chatsNearby.UsersNearby[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"supergroups_nearby":`...)
+	if chatsNearby.SupergroupsNearby == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range chatsNearby.SupergroupsNearby {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = chatsNearby.SupergroupsNearby[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (chatsNearby *ChatsNearby) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			chatsNearby.tdCommon.Type, err = r.readString()
+		case "@extra":
+			chatsNearby.tdCommon.Extra, err = r.readString()
+		case "users_nearby":
+			if r.readNull() {
+				chatsNearby.UsersNearby = nil
+			} else {
+				chatsNearby.UsersNearby = make([]ChatNearby, 0)
+				err = r.readArray(func() (err error) {
+					var item0 ChatNearby
+					err = item0.decodeJSON(r)
+					chatsNearby.UsersNearby = append(chatsNearby.UsersNearby, item0)
+					return
+				})
+			}
+		case "supergroups_nearby":
+			if r.readNull() {
+				chatsNearby.SupergroupsNearby = nil
+			} else {
+				chatsNearby.SupergroupsNearby = make([]ChatNearby, 0)
+				err = r.readArray(func() (err error) {
+					var item0 ChatNearby
+					err = item0.decodeJSON(r)
+					chatsNearby.SupergroupsNearby = append(chatsNearby.SupergroupsNearby, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (chatsNearby *ChatsNearby) Clone() *ChatsNearby {
+	if chatsNearby == nil {
+		return nil
+	}
+	clone := *chatsNearby
+	if chatsNearby.UsersNearby != nil {
+		clone.UsersNearby = make([]ChatNearby, len(chatsNearby.UsersNearby))
+		for i0 := range chatsNearby.UsersNearby {
+			clone.UsersNearby[i0] = *chatsNearby.UsersNearby[i0].Clone()
+		}
+	}
+	if chatsNearby.SupergroupsNearby != nil {
+		clone.SupergroupsNearby = make([]ChatNearby, len(chatsNearby.SupergroupsNearby))
+		for i0 := range chatsNearby.SupergroupsNearby {
+			clone.SupergroupsNearby[i0] = *chatsNearby.SupergroupsNearby[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (chatsNearby *ChatsNearby) Equal(other *ChatsNearby) bool {
+	if chatsNearby == nil || other == nil {
+		return chatsNearby == other
+	}
+	if len(chatsNearby.UsersNearby) != len(other.UsersNearby) {
+		return false
+	}
+	for i0 := range chatsNearby.UsersNearby {
+		if !chatsNearby.UsersNearby[i0].Equal(&other.UsersNearby[i0]) {
+			return false
+		}
+	}
+	if len(chatsNearby.SupergroupsNearby) != len(other.SupergroupsNearby) {
+		return false
+	}
+	for i0 := range chatsNearby.SupergroupsNearby {
+		if !chatsNearby.SupergroupsNearby[i0].Equal(&other.SupergroupsNearby[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (chatsNearby *ChatsNearby) Diff(other *ChatsNearby) []Change {
+	return chatsNearby.diff("", other, nil)
+}
+
+func (chatsNearby *ChatsNearby) diff(path string, other *ChatsNearby, changes []Change) []Change {
+	if chatsNearby == nil || other == nil {
+		if chatsNearby != other {
+			changes = append(changes, Change{Path: path, Old: chatsNearby, New: other})
+		}
+		return changes
+	}
+	if len(chatsNearby.UsersNearby) != len(other.UsersNearby) {
+		changes = append(changes, Change{Path: fieldPath(path, "users_nearby"), Old: chatsNearby.UsersNearby, New: other.UsersNearby})
+	} else {
+		for i0 := range chatsNearby.UsersNearby {
+			changes = chatsNearby.UsersNearby[i0].diff(indexPath(fieldPath(path, "users_nearby"), i0), &other.UsersNearby[i0], changes)
+		}
+	}
+	if len(chatsNearby.SupergroupsNearby) != len(other.SupergroupsNearby) {
+		changes = append(changes, Change{Path: fieldPath(path, "supergroups_nearby"), Old: chatsNearby.SupergroupsNearby, New: other.SupergroupsNearby})
+	} else {
+		for i0 := range chatsNearby.SupergroupsNearby {
+			changes = chatsNearby.SupergroupsNearby[i0].diff(indexPath(fieldPath(path, "supergroups_nearby"), i0), &other.SupergroupsNearby[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (chatsNearby *ChatsNearby) String() string {
+	return objectString(chatsNearby)
+}
+
+// LogValue logs the object as its summary
+func (chatsNearby *ChatsNearby) LogValue() slog.Value {
+	return slog.StringValue(objectString(chatsNearby))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (chatsNearby *ChatsNearby) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, chatsNearby)
+}
+
+func (chatsNearby *ChatsNearby) printText(p *printer) {
+	if chatsNearby == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ChatsNearby")
+	p.vector("users_nearby", len(chatsNearby.UsersNearby), func(i0 int) {
+		chatsNearby.UsersNearby[i0].printText(p)
+	})
+	p.vector("supergroups_nearby", len(chatsNearby.SupergroupsNearby), func(i0 int) {
+		chatsNearby.SupergroupsNearby[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // SearchChatsNearby Returns a list of users and location-based supergroups nearby. The list of users nearby will be updated for 60 seconds after the request by the updates updateUsersNearby. The request must be sent again every 25 seconds with adjusted location to not miss new chats
 // @param location Current user location
 func (client *Client) SearchChatsNearby(location *Location) (*ChatsNearby, error) {
@@ -43,11 +239,11 @@ func (client *Client) SearchChatsNearby(location *Location) (*ChatsNearby, error
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var chatsNearby ChatsNearby
-	err = json.Unmarshal(result.Raw, &chatsNearby)
+	err = jsonUnmarshal(result.Raw, &chatsNearby)
 	return &chatsNearby, err
 }
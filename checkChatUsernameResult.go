@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // CheckChatUsernameResult Represents result of checking whether a username can be set for a chat
 type CheckChatUsernameResult interface {
 	GetCheckChatUsernameResultEnum() CheckChatUsernameResultEnum
+	Accept(visitor CheckChatUsernameResultVisitor)
 }
 
 // CheckChatUsernameResultEnum Alias for abstract CheckChatUsernameResult 'Sub-Classes', used as constant-enum here
@@ -22,45 +24,88 @@ const (
 	CheckChatUsernameResultPublicGroupsUnavailableType CheckChatUsernameResultEnum = "checkChatUsernameResultPublicGroupsUnavailable"
 )
 
+// UnknownCheckChatUsernameResult is a CheckChatUsernameResult of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownCheckChatUsernameResult struct {
+	Unknown
+}
+
+// GetCheckChatUsernameResultEnum return the enum type of this object
+func (unknownCheckChatUsernameResult *UnknownCheckChatUsernameResult) GetCheckChatUsernameResultEnum() CheckChatUsernameResultEnum {
+	return CheckChatUsernameResultEnum(unknownCheckChatUsernameResult.Type)
+}
+
+// Accept calls the method of the visitor for UnknownCheckChatUsernameResult
+func (unknownCheckChatUsernameResult *UnknownCheckChatUsernameResult) Accept(visitor CheckChatUsernameResultVisitor) {
+	visitor.VisitUnknownCheckChatUsernameResult(unknownCheckChatUsernameResult)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownCheckChatUsernameResult *UnknownCheckChatUsernameResult) String() string {
+	return objectString(unknownCheckChatUsernameResult)
+}
+
+// LogValue logs the object as its summary
+func (unknownCheckChatUsernameResult *UnknownCheckChatUsernameResult) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownCheckChatUsernameResult))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownCheckChatUsernameResult *UnknownCheckChatUsernameResult) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownCheckChatUsernameResult)
+}
+
+func (unknownCheckChatUsernameResult *UnknownCheckChatUsernameResult) printText(p *printer) {
+	if unknownCheckChatUsernameResult == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownCheckChatUsernameResult")
+	p.string("@type", unknownCheckChatUsernameResult.Type)
+	p.endObject(state)
+}
+
 func unmarshalCheckChatUsernameResult(rawMsg *json.RawMessage) (CheckChatUsernameResult, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch CheckChatUsernameResultEnum(objMap["@type"].(string)) {
+	switch CheckChatUsernameResultEnum(typeName) {
 	case CheckChatUsernameResultOkType:
 		var checkChatUsernameResultOk CheckChatUsernameResultOk
-		err := json.Unmarshal(*rawMsg, &checkChatUsernameResultOk)
+		err := jsonUnmarshal(*rawMsg, &checkChatUsernameResultOk)
 		return &checkChatUsernameResultOk, err
 
 	case CheckChatUsernameResultUsernameInvalidType:
 		var checkChatUsernameResultUsernameInvalid CheckChatUsernameResultUsernameInvalid
-		err := json.Unmarshal(*rawMsg, &checkChatUsernameResultUsernameInvalid)
+		err := jsonUnmarshal(*rawMsg, &checkChatUsernameResultUsernameInvalid)
 		return &checkChatUsernameResultUsernameInvalid, err
 
 	case CheckChatUsernameResultUsernameOccupiedType:
 		var checkChatUsernameResultUsernameOccupied CheckChatUsernameResultUsernameOccupied
-		err := json.Unmarshal(*rawMsg, &checkChatUsernameResultUsernameOccupied)
+		err := jsonUnmarshal(*rawMsg, &checkChatUsernameResultUsernameOccupied)
 		return &checkChatUsernameResultUsernameOccupied, err
 
 	case CheckChatUsernameResultPublicChatsTooMuchType:
 		var checkChatUsernameResultPublicChatsTooMuch CheckChatUsernameResultPublicChatsTooMuch
-		err := json.Unmarshal(*rawMsg, &checkChatUsernameResultPublicChatsTooMuch)
+		err := jsonUnmarshal(*rawMsg, &checkChatUsernameResultPublicChatsTooMuch)
 		return &checkChatUsernameResultPublicChatsTooMuch, err
 
 	case CheckChatUsernameResultPublicGroupsUnavailableType:
 		var checkChatUsernameResultPublicGroupsUnavailable CheckChatUsernameResultPublicGroupsUnavailable
-		err := json.Unmarshal(*rawMsg, &checkChatUsernameResultPublicGroupsUnavailable)
+		err := jsonUnmarshal(*rawMsg, &checkChatUsernameResultPublicGroupsUnavailable)
 		return &checkChatUsernameResultPublicGroupsUnavailable, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownCheckChatUsernameResult{Unknown: unknown}, nil
 	}
 }
 
@@ -75,7 +120,6 @@ func (checkChatUsernameResultOk *CheckChatUsernameResultOk) MessageType() string
 }
 
 // NewCheckChatUsernameResultOk creates a new CheckChatUsernameResultOk
-//
 func NewCheckChatUsernameResultOk() *CheckChatUsernameResultOk {
 	checkChatUsernameResultOkTemp := CheckChatUsernameResultOk{
 		tdCommon: tdCommon{Type: "checkChatUsernameResultOk"},
@@ -84,11 +128,107 @@ func NewCheckChatUsernameResultOk() *CheckChatUsernameResultOk {
 	return &checkChatUsernameResultOkTemp
 }
 
+// MarshalJSON marshals to json
+func (checkChatUsernameResultOk *CheckChatUsernameResultOk) MarshalJSON() ([]byte, error) {
+	return checkChatUsernameResultOk.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (checkChatUsernameResultOk *CheckChatUsernameResultOk) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, checkChatUsernameResultOk)
+}
+
+func (checkChatUsernameResultOk *CheckChatUsernameResultOk) appendJSON(b []byte) ([]byte, error) {
+	if checkChatUsernameResultOk == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, checkChatUsernameResultOk.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, checkChatUsernameResultOk.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (checkChatUsernameResultOk *CheckChatUsernameResultOk) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			checkChatUsernameResultOk.tdCommon.Type, err = r.readString()
+		case "@extra":
+			checkChatUsernameResultOk.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (checkChatUsernameResultOk *CheckChatUsernameResultOk) Clone() *CheckChatUsernameResultOk {
+	if checkChatUsernameResultOk == nil {
+		return nil
+	}
+	clone := *checkChatUsernameResultOk
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (checkChatUsernameResultOk *CheckChatUsernameResultOk) Equal(other *CheckChatUsernameResultOk) bool {
+	if checkChatUsernameResultOk == nil || other == nil {
+		return checkChatUsernameResultOk == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (checkChatUsernameResultOk *CheckChatUsernameResultOk) Diff(other *CheckChatUsernameResultOk) []Change {
+	return checkChatUsernameResultOk.diff("", other, nil)
+}
+
+func (checkChatUsernameResultOk *CheckChatUsernameResultOk) diff(path string, other *CheckChatUsernameResultOk, changes []Change) []Change {
+	if checkChatUsernameResultOk == nil || other == nil {
+		if checkChatUsernameResultOk != other {
+			changes = append(changes, Change{Path: path, Old: checkChatUsernameResultOk, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (checkChatUsernameResultOk *CheckChatUsernameResultOk) String() string {
+	return objectString(checkChatUsernameResultOk)
+}
+
+// LogValue logs the object as its summary
+func (checkChatUsernameResultOk *CheckChatUsernameResultOk) LogValue() slog.Value {
+	return slog.StringValue(objectString(checkChatUsernameResultOk))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (checkChatUsernameResultOk *CheckChatUsernameResultOk) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, checkChatUsernameResultOk)
+}
+
+func (checkChatUsernameResultOk *CheckChatUsernameResultOk) printText(p *printer) {
+	if checkChatUsernameResultOk == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CheckChatUsernameResultOk")
+	p.endObject(state)
+}
+
 // GetCheckChatUsernameResultEnum return the enum type of this object
 func (checkChatUsernameResultOk *CheckChatUsernameResultOk) GetCheckChatUsernameResultEnum() CheckChatUsernameResultEnum {
 	return CheckChatUsernameResultOkType
 }
 
+// Accept calls the method of the visitor for CheckChatUsernameResultOk
+func (checkChatUsernameResultOk *CheckChatUsernameResultOk) Accept(visitor CheckChatUsernameResultVisitor) {
+	visitor.VisitCheckChatUsernameResultOk(checkChatUsernameResultOk)
+}
+
 // CheckChatUsernameResultUsernameInvalid The username is invalid
 type CheckChatUsernameResultUsernameInvalid struct {
 	tdCommon
@@ -100,7 +240,6 @@ func (checkChatUsernameResultUsernameInvalid *CheckChatUsernameResultUsernameInv
 }
 
 // NewCheckChatUsernameResultUsernameInvalid creates a new CheckChatUsernameResultUsernameInvalid
-//
 func NewCheckChatUsernameResultUsernameInvalid() *CheckChatUsernameResultUsernameInvalid {
 	checkChatUsernameResultUsernameInvalidTemp := CheckChatUsernameResultUsernameInvalid{
 		tdCommon: tdCommon{Type: "checkChatUsernameResultUsernameInvalid"},
@@ -109,11 +248,107 @@ func NewCheckChatUsernameResultUsernameInvalid() *CheckChatUsernameResultUsernam
 	return &checkChatUsernameResultUsernameInvalidTemp
 }
 
+// MarshalJSON marshals to json
+func (checkChatUsernameResultUsernameInvalid *CheckChatUsernameResultUsernameInvalid) MarshalJSON() ([]byte, error) {
+	return checkChatUsernameResultUsernameInvalid.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (checkChatUsernameResultUsernameInvalid *CheckChatUsernameResultUsernameInvalid) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, checkChatUsernameResultUsernameInvalid)
+}
+
+func (checkChatUsernameResultUsernameInvalid *CheckChatUsernameResultUsernameInvalid) appendJSON(b []byte) ([]byte, error) {
+	if checkChatUsernameResultUsernameInvalid == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, checkChatUsernameResultUsernameInvalid.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, checkChatUsernameResultUsernameInvalid.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (checkChatUsernameResultUsernameInvalid *CheckChatUsernameResultUsernameInvalid) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			checkChatUsernameResultUsernameInvalid.tdCommon.Type, err = r.readString()
+		case "@extra":
+			checkChatUsernameResultUsernameInvalid.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (checkChatUsernameResultUsernameInvalid *CheckChatUsernameResultUsernameInvalid) Clone() *CheckChatUsernameResultUsernameInvalid {
+	if checkChatUsernameResultUsernameInvalid == nil {
+		return nil
+	}
+	clone := *checkChatUsernameResultUsernameInvalid
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (checkChatUsernameResultUsernameInvalid *CheckChatUsernameResultUsernameInvalid) Equal(other *CheckChatUsernameResultUsernameInvalid) bool {
+	if checkChatUsernameResultUsernameInvalid == nil || other == nil {
+		return checkChatUsernameResultUsernameInvalid == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (checkChatUsernameResultUsernameInvalid *CheckChatUsernameResultUsernameInvalid) Diff(other *CheckChatUsernameResultUsernameInvalid) []Change {
+	return checkChatUsernameResultUsernameInvalid.diff("", other, nil)
+}
+
+func (checkChatUsernameResultUsernameInvalid *CheckChatUsernameResultUsernameInvalid) diff(path string, other *CheckChatUsernameResultUsernameInvalid, changes []Change) []Change {
+	if checkChatUsernameResultUsernameInvalid == nil || other == nil {
+		if checkChatUsernameResultUsernameInvalid != other {
+			changes = append(changes, Change{Path: path, Old: checkChatUsernameResultUsernameInvalid, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (checkChatUsernameResultUsernameInvalid *CheckChatUsernameResultUsernameInvalid) String() string {
+	return objectString(checkChatUsernameResultUsernameInvalid)
+}
+
+// LogValue logs the object as its summary
+func (checkChatUsernameResultUsernameInvalid *CheckChatUsernameResultUsernameInvalid) LogValue() slog.Value {
+	return slog.StringValue(objectString(checkChatUsernameResultUsernameInvalid))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (checkChatUsernameResultUsernameInvalid *CheckChatUsernameResultUsernameInvalid) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, checkChatUsernameResultUsernameInvalid)
+}
+
+func (checkChatUsernameResultUsernameInvalid *CheckChatUsernameResultUsernameInvalid) printText(p *printer) {
+	if checkChatUsernameResultUsernameInvalid == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CheckChatUsernameResultUsernameInvalid")
+	p.endObject(state)
+}
+
 // GetCheckChatUsernameResultEnum return the enum type of this object
 func (checkChatUsernameResultUsernameInvalid *CheckChatUsernameResultUsernameInvalid) GetCheckChatUsernameResultEnum() CheckChatUsernameResultEnum {
 	return CheckChatUsernameResultUsernameInvalidType
 }
 
+// Accept calls the method of the visitor for CheckChatUsernameResultUsernameInvalid
+func (checkChatUsernameResultUsernameInvalid *CheckChatUsernameResultUsernameInvalid) Accept(visitor CheckChatUsernameResultVisitor) {
+	visitor.VisitCheckChatUsernameResultUsernameInvalid(checkChatUsernameResultUsernameInvalid)
+}
+
 // CheckChatUsernameResultUsernameOccupied The username is occupied
 type CheckChatUsernameResultUsernameOccupied struct {
 	tdCommon
@@ -125,7 +360,6 @@ func (checkChatUsernameResultUsernameOccupied *CheckChatUsernameResultUsernameOc
 }
 
 // NewCheckChatUsernameResultUsernameOccupied creates a new CheckChatUsernameResultUsernameOccupied
-//
 func NewCheckChatUsernameResultUsernameOccupied() *CheckChatUsernameResultUsernameOccupied {
 	checkChatUsernameResultUsernameOccupiedTemp := CheckChatUsernameResultUsernameOccupied{
 		tdCommon: tdCommon{Type: "checkChatUsernameResultUsernameOccupied"},
@@ -134,11 +368,107 @@ func NewCheckChatUsernameResultUsernameOccupied() *CheckChatUsernameResultUserna
 	return &checkChatUsernameResultUsernameOccupiedTemp
 }
 
+// MarshalJSON marshals to json
+func (checkChatUsernameResultUsernameOccupied *CheckChatUsernameResultUsernameOccupied) MarshalJSON() ([]byte, error) {
+	return checkChatUsernameResultUsernameOccupied.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (checkChatUsernameResultUsernameOccupied *CheckChatUsernameResultUsernameOccupied) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, checkChatUsernameResultUsernameOccupied)
+}
+
+func (checkChatUsernameResultUsernameOccupied *CheckChatUsernameResultUsernameOccupied) appendJSON(b []byte) ([]byte, error) {
+	if checkChatUsernameResultUsernameOccupied == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, checkChatUsernameResultUsernameOccupied.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, checkChatUsernameResultUsernameOccupied.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (checkChatUsernameResultUsernameOccupied *CheckChatUsernameResultUsernameOccupied) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			checkChatUsernameResultUsernameOccupied.tdCommon.Type, err = r.readString()
+		case "@extra":
+			checkChatUsernameResultUsernameOccupied.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (checkChatUsernameResultUsernameOccupied *CheckChatUsernameResultUsernameOccupied) Clone() *CheckChatUsernameResultUsernameOccupied {
+	if checkChatUsernameResultUsernameOccupied == nil {
+		return nil
+	}
+	clone := *checkChatUsernameResultUsernameOccupied
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (checkChatUsernameResultUsernameOccupied *CheckChatUsernameResultUsernameOccupied) Equal(other *CheckChatUsernameResultUsernameOccupied) bool {
+	if checkChatUsernameResultUsernameOccupied == nil || other == nil {
+		return checkChatUsernameResultUsernameOccupied == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (checkChatUsernameResultUsernameOccupied *CheckChatUsernameResultUsernameOccupied) Diff(other *CheckChatUsernameResultUsernameOccupied) []Change {
+	return checkChatUsernameResultUsernameOccupied.diff("", other, nil)
+}
+
+func (checkChatUsernameResultUsernameOccupied *CheckChatUsernameResultUsernameOccupied) diff(path string, other *CheckChatUsernameResultUsernameOccupied, changes []Change) []Change {
+	if checkChatUsernameResultUsernameOccupied == nil || other == nil {
+		if checkChatUsernameResultUsernameOccupied != other {
+			changes = append(changes, Change{Path: path, Old: checkChatUsernameResultUsernameOccupied, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (checkChatUsernameResultUsernameOccupied *CheckChatUsernameResultUsernameOccupied) String() string {
+	return objectString(checkChatUsernameResultUsernameOccupied)
+}
+
+// LogValue logs the object as its summary
+func (checkChatUsernameResultUsernameOccupied *CheckChatUsernameResultUsernameOccupied) LogValue() slog.Value {
+	return slog.StringValue(objectString(checkChatUsernameResultUsernameOccupied))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (checkChatUsernameResultUsernameOccupied *CheckChatUsernameResultUsernameOccupied) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, checkChatUsernameResultUsernameOccupied)
+}
+
+func (checkChatUsernameResultUsernameOccupied *CheckChatUsernameResultUsernameOccupied) printText(p *printer) {
+	if checkChatUsernameResultUsernameOccupied == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CheckChatUsernameResultUsernameOccupied")
+	p.endObject(state)
+}
+
 // GetCheckChatUsernameResultEnum return the enum type of this object
 func (checkChatUsernameResultUsernameOccupied *CheckChatUsernameResultUsernameOccupied) GetCheckChatUsernameResultEnum() CheckChatUsernameResultEnum {
 	return CheckChatUsernameResultUsernameOccupiedType
 }
 
+// Accept calls the method of the visitor for CheckChatUsernameResultUsernameOccupied
+func (checkChatUsernameResultUsernameOccupied *CheckChatUsernameResultUsernameOccupied) Accept(visitor CheckChatUsernameResultVisitor) {
+	visitor.VisitCheckChatUsernameResultUsernameOccupied(checkChatUsernameResultUsernameOccupied)
+}
+
 // CheckChatUsernameResultPublicChatsTooMuch The user has too much chats with username, one of them must be made private first
 type CheckChatUsernameResultPublicChatsTooMuch struct {
 	tdCommon
@@ -150,7 +480,6 @@ func (checkChatUsernameResultPublicChatsTooMuch *CheckChatUsernameResultPublicCh
 }
 
 // NewCheckChatUsernameResultPublicChatsTooMuch creates a new CheckChatUsernameResultPublicChatsTooMuch
-//
 func NewCheckChatUsernameResultPublicChatsTooMuch() *CheckChatUsernameResultPublicChatsTooMuch {
 	checkChatUsernameResultPublicChatsTooMuchTemp := CheckChatUsernameResultPublicChatsTooMuch{
 		tdCommon: tdCommon{Type: "checkChatUsernameResultPublicChatsTooMuch"},
@@ -159,11 +488,107 @@ func NewCheckChatUsernameResultPublicChatsTooMuch() *CheckChatUsernameResultPubl
 	return &checkChatUsernameResultPublicChatsTooMuchTemp
 }
 
+// MarshalJSON marshals to json
+func (checkChatUsernameResultPublicChatsTooMuch *CheckChatUsernameResultPublicChatsTooMuch) MarshalJSON() ([]byte, error) {
+	return checkChatUsernameResultPublicChatsTooMuch.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (checkChatUsernameResultPublicChatsTooMuch *CheckChatUsernameResultPublicChatsTooMuch) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, checkChatUsernameResultPublicChatsTooMuch)
+}
+
+func (checkChatUsernameResultPublicChatsTooMuch *CheckChatUsernameResultPublicChatsTooMuch) appendJSON(b []byte) ([]byte, error) {
+	if checkChatUsernameResultPublicChatsTooMuch == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, checkChatUsernameResultPublicChatsTooMuch.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, checkChatUsernameResultPublicChatsTooMuch.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (checkChatUsernameResultPublicChatsTooMuch *CheckChatUsernameResultPublicChatsTooMuch) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			checkChatUsernameResultPublicChatsTooMuch.tdCommon.Type, err = r.readString()
+		case "@extra":
+			checkChatUsernameResultPublicChatsTooMuch.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (checkChatUsernameResultPublicChatsTooMuch *CheckChatUsernameResultPublicChatsTooMuch) Clone() *CheckChatUsernameResultPublicChatsTooMuch {
+	if checkChatUsernameResultPublicChatsTooMuch == nil {
+		return nil
+	}
+	clone := *checkChatUsernameResultPublicChatsTooMuch
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (checkChatUsernameResultPublicChatsTooMuch *CheckChatUsernameResultPublicChatsTooMuch) Equal(other *CheckChatUsernameResultPublicChatsTooMuch) bool {
+	if checkChatUsernameResultPublicChatsTooMuch == nil || other == nil {
+		return checkChatUsernameResultPublicChatsTooMuch == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (checkChatUsernameResultPublicChatsTooMuch *CheckChatUsernameResultPublicChatsTooMuch) Diff(other *CheckChatUsernameResultPublicChatsTooMuch) []Change {
+	return checkChatUsernameResultPublicChatsTooMuch.diff("", other, nil)
+}
+
+func (checkChatUsernameResultPublicChatsTooMuch *CheckChatUsernameResultPublicChatsTooMuch) diff(path string, other *CheckChatUsernameResultPublicChatsTooMuch, changes []Change) []Change {
+	if checkChatUsernameResultPublicChatsTooMuch == nil || other == nil {
+		if checkChatUsernameResultPublicChatsTooMuch != other {
+			changes = append(changes, Change{Path: path, Old: checkChatUsernameResultPublicChatsTooMuch, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (checkChatUsernameResultPublicChatsTooMuch *CheckChatUsernameResultPublicChatsTooMuch) String() string {
+	return objectString(checkChatUsernameResultPublicChatsTooMuch)
+}
+
+// LogValue logs the object as its summary
+func (checkChatUsernameResultPublicChatsTooMuch *CheckChatUsernameResultPublicChatsTooMuch) LogValue() slog.Value {
+	return slog.StringValue(objectString(checkChatUsernameResultPublicChatsTooMuch))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (checkChatUsernameResultPublicChatsTooMuch *CheckChatUsernameResultPublicChatsTooMuch) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, checkChatUsernameResultPublicChatsTooMuch)
+}
+
+func (checkChatUsernameResultPublicChatsTooMuch *CheckChatUsernameResultPublicChatsTooMuch) printText(p *printer) {
+	if checkChatUsernameResultPublicChatsTooMuch == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CheckChatUsernameResultPublicChatsTooMuch")
+	p.endObject(state)
+}
+
 // GetCheckChatUsernameResultEnum return the enum type of this object
 func (checkChatUsernameResultPublicChatsTooMuch *CheckChatUsernameResultPublicChatsTooMuch) GetCheckChatUsernameResultEnum() CheckChatUsernameResultEnum {
 	return CheckChatUsernameResultPublicChatsTooMuchType
 }
 
+// Accept calls the method of the visitor for CheckChatUsernameResultPublicChatsTooMuch
+func (checkChatUsernameResultPublicChatsTooMuch *CheckChatUsernameResultPublicChatsTooMuch) Accept(visitor CheckChatUsernameResultVisitor) {
+	visitor.VisitCheckChatUsernameResultPublicChatsTooMuch(checkChatUsernameResultPublicChatsTooMuch)
+}
+
 // CheckChatUsernameResultPublicGroupsUnavailable The user can't be a member of a public supergroup
 type CheckChatUsernameResultPublicGroupsUnavailable struct {
 	tdCommon
@@ -175,7 +600,6 @@ func (checkChatUsernameResultPublicGroupsUnavailable *CheckChatUsernameResultPub
 }
 
 // NewCheckChatUsernameResultPublicGroupsUnavailable creates a new CheckChatUsernameResultPublicGroupsUnavailable
-//
 func NewCheckChatUsernameResultPublicGroupsUnavailable() *CheckChatUsernameResultPublicGroupsUnavailable {
 	checkChatUsernameResultPublicGroupsUnavailableTemp := CheckChatUsernameResultPublicGroupsUnavailable{
 		tdCommon: tdCommon{Type: "checkChatUsernameResultPublicGroupsUnavailable"},
@@ -184,11 +608,247 @@ func NewCheckChatUsernameResultPublicGroupsUnavailable() *CheckChatUsernameResul
 	return &checkChatUsernameResultPublicGroupsUnavailableTemp
 }
 
+// MarshalJSON marshals to json
+func (checkChatUsernameResultPublicGroupsUnavailable *CheckChatUsernameResultPublicGroupsUnavailable) MarshalJSON() ([]byte, error) {
+	return checkChatUsernameResultPublicGroupsUnavailable.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (checkChatUsernameResultPublicGroupsUnavailable *CheckChatUsernameResultPublicGroupsUnavailable) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, checkChatUsernameResultPublicGroupsUnavailable)
+}
+
+func (checkChatUsernameResultPublicGroupsUnavailable *CheckChatUsernameResultPublicGroupsUnavailable) appendJSON(b []byte) ([]byte, error) {
+	if checkChatUsernameResultPublicGroupsUnavailable == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, checkChatUsernameResultPublicGroupsUnavailable.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, checkChatUsernameResultPublicGroupsUnavailable.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (checkChatUsernameResultPublicGroupsUnavailable *CheckChatUsernameResultPublicGroupsUnavailable) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			checkChatUsernameResultPublicGroupsUnavailable.tdCommon.Type, err = r.readString()
+		case "@extra":
+			checkChatUsernameResultPublicGroupsUnavailable.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (checkChatUsernameResultPublicGroupsUnavailable *CheckChatUsernameResultPublicGroupsUnavailable) Clone() *CheckChatUsernameResultPublicGroupsUnavailable {
+	if checkChatUsernameResultPublicGroupsUnavailable == nil {
+		return nil
+	}
+	clone := *checkChatUsernameResultPublicGroupsUnavailable
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (checkChatUsernameResultPublicGroupsUnavailable *CheckChatUsernameResultPublicGroupsUnavailable) Equal(other *CheckChatUsernameResultPublicGroupsUnavailable) bool {
+	if checkChatUsernameResultPublicGroupsUnavailable == nil || other == nil {
+		return checkChatUsernameResultPublicGroupsUnavailable == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (checkChatUsernameResultPublicGroupsUnavailable *CheckChatUsernameResultPublicGroupsUnavailable) Diff(other *CheckChatUsernameResultPublicGroupsUnavailable) []Change {
+	return checkChatUsernameResultPublicGroupsUnavailable.diff("", other, nil)
+}
+
+func (checkChatUsernameResultPublicGroupsUnavailable *CheckChatUsernameResultPublicGroupsUnavailable) diff(path string, other *CheckChatUsernameResultPublicGroupsUnavailable, changes []Change) []Change {
+	if checkChatUsernameResultPublicGroupsUnavailable == nil || other == nil {
+		if checkChatUsernameResultPublicGroupsUnavailable != other {
+			changes = append(changes, Change{Path: path, Old: checkChatUsernameResultPublicGroupsUnavailable, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (checkChatUsernameResultPublicGroupsUnavailable *CheckChatUsernameResultPublicGroupsUnavailable) String() string {
+	return objectString(checkChatUsernameResultPublicGroupsUnavailable)
+}
+
+// LogValue logs the object as its summary
+func (checkChatUsernameResultPublicGroupsUnavailable *CheckChatUsernameResultPublicGroupsUnavailable) LogValue() slog.Value {
+	return slog.StringValue(objectString(checkChatUsernameResultPublicGroupsUnavailable))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (checkChatUsernameResultPublicGroupsUnavailable *CheckChatUsernameResultPublicGroupsUnavailable) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, checkChatUsernameResultPublicGroupsUnavailable)
+}
+
+func (checkChatUsernameResultPublicGroupsUnavailable *CheckChatUsernameResultPublicGroupsUnavailable) printText(p *printer) {
+	if checkChatUsernameResultPublicGroupsUnavailable == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CheckChatUsernameResultPublicGroupsUnavailable")
+	p.endObject(state)
+}
+
 // GetCheckChatUsernameResultEnum return the enum type of this object
 func (checkChatUsernameResultPublicGroupsUnavailable *CheckChatUsernameResultPublicGroupsUnavailable) GetCheckChatUsernameResultEnum() CheckChatUsernameResultEnum {
 	return CheckChatUsernameResultPublicGroupsUnavailableType
 }
 
+// Accept calls the method of the visitor for CheckChatUsernameResultPublicGroupsUnavailable
+func (checkChatUsernameResultPublicGroupsUnavailable *CheckChatUsernameResultPublicGroupsUnavailable) Accept(visitor CheckChatUsernameResultVisitor) {
+	visitor.VisitCheckChatUsernameResultPublicGroupsUnavailable(checkChatUsernameResultPublicGroupsUnavailable)
+}
+
+// CheckChatUsernameResultVisitor visits every type of CheckChatUsernameResult, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to CheckChatUsernameResult, until they handle it too.
+type CheckChatUsernameResultVisitor interface {
+	VisitCheckChatUsernameResultOk(checkChatUsernameResultOk *CheckChatUsernameResultOk)
+	VisitCheckChatUsernameResultUsernameInvalid(checkChatUsernameResultUsernameInvalid *CheckChatUsernameResultUsernameInvalid)
+	VisitCheckChatUsernameResultUsernameOccupied(checkChatUsernameResultUsernameOccupied *CheckChatUsernameResultUsernameOccupied)
+	VisitCheckChatUsernameResultPublicChatsTooMuch(checkChatUsernameResultPublicChatsTooMuch *CheckChatUsernameResultPublicChatsTooMuch)
+	VisitCheckChatUsernameResultPublicGroupsUnavailable(checkChatUsernameResultPublicGroupsUnavailable *CheckChatUsernameResultPublicGroupsUnavailable)
+	VisitUnknownCheckChatUsernameResult(unknownCheckChatUsernameResult *UnknownCheckChatUsernameResult)
+}
+
+// CheckChatUsernameResultCases holds the function MatchCheckChatUsernameResult calls for each type of CheckChatUsernameResult;
+// types without a function are passed to the default case
+type CheckChatUsernameResultCases[R any] struct {
+	CheckChatUsernameResultOk                      func(checkChatUsernameResultOk *CheckChatUsernameResultOk) R
+	CheckChatUsernameResultUsernameInvalid         func(checkChatUsernameResultUsernameInvalid *CheckChatUsernameResultUsernameInvalid) R
+	CheckChatUsernameResultUsernameOccupied        func(checkChatUsernameResultUsernameOccupied *CheckChatUsernameResultUsernameOccupied) R
+	CheckChatUsernameResultPublicChatsTooMuch      func(checkChatUsernameResultPublicChatsTooMuch *CheckChatUsernameResultPublicChatsTooMuch) R
+	CheckChatUsernameResultPublicGroupsUnavailable func(checkChatUsernameResultPublicGroupsUnavailable *CheckChatUsernameResultPublicGroupsUnavailable) R
+}
+
+// MatchCheckChatUsernameResult calls the case of the type of checkChatUsernameResult, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement CheckChatUsernameResultVisitor
+// to have the compiler check every type is handled.
+func MatchCheckChatUsernameResult[R any](checkChatUsernameResult CheckChatUsernameResult, cases CheckChatUsernameResultCases[R], defaultCase func(checkChatUsernameResult CheckChatUsernameResult) R) R {
+	switch value := checkChatUsernameResult.(type) {
+	case *CheckChatUsernameResultOk:
+		if cases.CheckChatUsernameResultOk != nil {
+			return cases.CheckChatUsernameResultOk(value)
+		}
+	case *CheckChatUsernameResultUsernameInvalid:
+		if cases.CheckChatUsernameResultUsernameInvalid != nil {
+			return cases.CheckChatUsernameResultUsernameInvalid(value)
+		}
+	case *CheckChatUsernameResultUsernameOccupied:
+		if cases.CheckChatUsernameResultUsernameOccupied != nil {
+			return cases.CheckChatUsernameResultUsernameOccupied(value)
+		}
+	case *CheckChatUsernameResultPublicChatsTooMuch:
+		if cases.CheckChatUsernameResultPublicChatsTooMuch != nil {
+			return cases.CheckChatUsernameResultPublicChatsTooMuch(value)
+		}
+	case *CheckChatUsernameResultPublicGroupsUnavailable:
+		if cases.CheckChatUsernameResultPublicGroupsUnavailable != nil {
+			return cases.CheckChatUsernameResultPublicGroupsUnavailable(value)
+		}
+	}
+	return defaultCase(checkChatUsernameResult)
+}
+
+// Clone returns a deep copy of the object
+func (unknownCheckChatUsernameResult *UnknownCheckChatUsernameResult) Clone() *UnknownCheckChatUsernameResult {
+	if unknownCheckChatUsernameResult == nil {
+		return nil
+	}
+	return &UnknownCheckChatUsernameResult{Unknown: unknownCheckChatUsernameResult.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownCheckChatUsernameResult *UnknownCheckChatUsernameResult) Equal(other *UnknownCheckChatUsernameResult) bool {
+	if unknownCheckChatUsernameResult == nil || other == nil {
+		return unknownCheckChatUsernameResult == other
+	}
+	return unknownCheckChatUsernameResult.Unknown.equal(other.Unknown)
+}
+
+func cloneCheckChatUsernameResult(checkChatUsernameResult CheckChatUsernameResult) CheckChatUsernameResult {
+	switch value := checkChatUsernameResult.(type) {
+	case *CheckChatUsernameResultOk:
+		return value.Clone()
+	case *CheckChatUsernameResultUsernameInvalid:
+		return value.Clone()
+	case *CheckChatUsernameResultUsernameOccupied:
+		return value.Clone()
+	case *CheckChatUsernameResultPublicChatsTooMuch:
+		return value.Clone()
+	case *CheckChatUsernameResultPublicGroupsUnavailable:
+		return value.Clone()
+	case *UnknownCheckChatUsernameResult:
+		return value.Clone()
+	}
+	return checkChatUsernameResult
+}
+
+func equalCheckChatUsernameResult(a CheckChatUsernameResult, b CheckChatUsernameResult) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *CheckChatUsernameResultOk:
+		b, ok := b.(*CheckChatUsernameResultOk)
+		return ok && a.Equal(b)
+	case *CheckChatUsernameResultUsernameInvalid:
+		b, ok := b.(*CheckChatUsernameResultUsernameInvalid)
+		return ok && a.Equal(b)
+	case *CheckChatUsernameResultUsernameOccupied:
+		b, ok := b.(*CheckChatUsernameResultUsernameOccupied)
+		return ok && a.Equal(b)
+	case *CheckChatUsernameResultPublicChatsTooMuch:
+		b, ok := b.(*CheckChatUsernameResultPublicChatsTooMuch)
+		return ok && a.Equal(b)
+	case *CheckChatUsernameResultPublicGroupsUnavailable:
+		b, ok := b.(*CheckChatUsernameResultPublicGroupsUnavailable)
+		return ok && a.Equal(b)
+	case *UnknownCheckChatUsernameResult:
+		b, ok := b.(*UnknownCheckChatUsernameResult)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffCheckChatUsernameResult(path string, a CheckChatUsernameResult, b CheckChatUsernameResult, changes []Change) []Change {
+	switch a := a.(type) {
+	case *CheckChatUsernameResultOk:
+		if b, ok := b.(*CheckChatUsernameResultOk); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CheckChatUsernameResultUsernameInvalid:
+		if b, ok := b.(*CheckChatUsernameResultUsernameInvalid); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CheckChatUsernameResultUsernameOccupied:
+		if b, ok := b.(*CheckChatUsernameResultUsernameOccupied); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CheckChatUsernameResultPublicChatsTooMuch:
+		if b, ok := b.(*CheckChatUsernameResultPublicChatsTooMuch); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CheckChatUsernameResultPublicGroupsUnavailable:
+		if b, ok := b.(*CheckChatUsernameResultPublicGroupsUnavailable); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalCheckChatUsernameResult(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
+
 // CheckChatUsername Checks whether a username can be set for a chat
 // @param chatId Chat identifier; must be identifier of a supergroup chat, or a channel chat, or a private chat with self, or zero if the chat is being created
 // @param username Username to be checked
@@ -203,35 +863,36 @@ func (client *Client) CheckChatUsername(chatId int64, username string) (CheckCha
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
-	switch CheckChatUsernameResultEnum(result.Data["@type"].(string)) {
+	resultType, _ := result.Data["@type"].(string)
+	switch CheckChatUsernameResultEnum(resultType) {
 
 	case CheckChatUsernameResultOkType:
 		var checkChatUsernameResult CheckChatUsernameResultOk
-		err = json.Unmarshal(result.Raw, &checkChatUsernameResult)
+		err = jsonUnmarshal(result.Raw, &checkChatUsernameResult)
 		return &checkChatUsernameResult, err
 
 	case CheckChatUsernameResultUsernameInvalidType:
 		var checkChatUsernameResult CheckChatUsernameResultUsernameInvalid
-		err = json.Unmarshal(result.Raw, &checkChatUsernameResult)
+		err = jsonUnmarshal(result.Raw, &checkChatUsernameResult)
 		return &checkChatUsernameResult, err
 
 	case CheckChatUsernameResultUsernameOccupiedType:
 		var checkChatUsernameResult CheckChatUsernameResultUsernameOccupied
-		err = json.Unmarshal(result.Raw, &checkChatUsernameResult)
+		err = jsonUnmarshal(result.Raw, &checkChatUsernameResult)
 		return &checkChatUsernameResult, err
 
 	case CheckChatUsernameResultPublicChatsTooMuchType:
 		var checkChatUsernameResult CheckChatUsernameResultPublicChatsTooMuch
-		err = json.Unmarshal(result.Raw, &checkChatUsernameResult)
+		err = jsonUnmarshal(result.Raw, &checkChatUsernameResult)
 		return &checkChatUsernameResult, err
 
 	case CheckChatUsernameResultPublicGroupsUnavailableType:
 		var checkChatUsernameResult CheckChatUsernameResultPublicGroupsUnavailable
-		err = json.Unmarshal(result.Raw, &checkChatUsernameResult)
+		err = jsonUnmarshal(result.Raw, &checkChatUsernameResult)
 		return &checkChatUsernameResult, err
 
 	default:
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // CheckStickerSetNameResult Represents result of checking whether a name can be used for a new sticker set
 type CheckStickerSetNameResult interface {
 	GetCheckStickerSetNameResultEnum() CheckStickerSetNameResultEnum
+	Accept(visitor CheckStickerSetNameResultVisitor)
 }
 
 // CheckStickerSetNameResultEnum Alias for abstract CheckStickerSetNameResult 'Sub-Classes', used as constant-enum here
@@ -20,35 +22,78 @@ const (
 	CheckStickerSetNameResultNameOccupiedType CheckStickerSetNameResultEnum = "checkStickerSetNameResultNameOccupied"
 )
 
+// UnknownCheckStickerSetNameResult is a CheckStickerSetNameResult of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownCheckStickerSetNameResult struct {
+	Unknown
+}
+
+// GetCheckStickerSetNameResultEnum return the enum type of this object
+func (unknownCheckStickerSetNameResult *UnknownCheckStickerSetNameResult) GetCheckStickerSetNameResultEnum() CheckStickerSetNameResultEnum {
+	return CheckStickerSetNameResultEnum(unknownCheckStickerSetNameResult.Type)
+}
+
+// Accept calls the method of the visitor for UnknownCheckStickerSetNameResult
+func (unknownCheckStickerSetNameResult *UnknownCheckStickerSetNameResult) Accept(visitor CheckStickerSetNameResultVisitor) {
+	visitor.VisitUnknownCheckStickerSetNameResult(unknownCheckStickerSetNameResult)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownCheckStickerSetNameResult *UnknownCheckStickerSetNameResult) String() string {
+	return objectString(unknownCheckStickerSetNameResult)
+}
+
+// LogValue logs the object as its summary
+func (unknownCheckStickerSetNameResult *UnknownCheckStickerSetNameResult) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownCheckStickerSetNameResult))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownCheckStickerSetNameResult *UnknownCheckStickerSetNameResult) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownCheckStickerSetNameResult)
+}
+
+func (unknownCheckStickerSetNameResult *UnknownCheckStickerSetNameResult) printText(p *printer) {
+	if unknownCheckStickerSetNameResult == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownCheckStickerSetNameResult")
+	p.string("@type", unknownCheckStickerSetNameResult.Type)
+	p.endObject(state)
+}
+
 func unmarshalCheckStickerSetNameResult(rawMsg *json.RawMessage) (CheckStickerSetNameResult, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch CheckStickerSetNameResultEnum(objMap["@type"].(string)) {
+	switch CheckStickerSetNameResultEnum(typeName) {
 	case CheckStickerSetNameResultOkType:
 		var checkStickerSetNameResultOk CheckStickerSetNameResultOk
-		err := json.Unmarshal(*rawMsg, &checkStickerSetNameResultOk)
+		err := jsonUnmarshal(*rawMsg, &checkStickerSetNameResultOk)
 		return &checkStickerSetNameResultOk, err
 
 	case CheckStickerSetNameResultNameInvalidType:
 		var checkStickerSetNameResultNameInvalid CheckStickerSetNameResultNameInvalid
-		err := json.Unmarshal(*rawMsg, &checkStickerSetNameResultNameInvalid)
+		err := jsonUnmarshal(*rawMsg, &checkStickerSetNameResultNameInvalid)
 		return &checkStickerSetNameResultNameInvalid, err
 
 	case CheckStickerSetNameResultNameOccupiedType:
 		var checkStickerSetNameResultNameOccupied CheckStickerSetNameResultNameOccupied
-		err := json.Unmarshal(*rawMsg, &checkStickerSetNameResultNameOccupied)
+		err := jsonUnmarshal(*rawMsg, &checkStickerSetNameResultNameOccupied)
 		return &checkStickerSetNameResultNameOccupied, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownCheckStickerSetNameResult{Unknown: unknown}, nil
 	}
 }
 
@@ -63,7 +108,6 @@ func (checkStickerSetNameResultOk *CheckStickerSetNameResultOk) MessageType() st
 }
 
 // NewCheckStickerSetNameResultOk creates a new CheckStickerSetNameResultOk
-//
 func NewCheckStickerSetNameResultOk() *CheckStickerSetNameResultOk {
 	checkStickerSetNameResultOkTemp := CheckStickerSetNameResultOk{
 		tdCommon: tdCommon{Type: "checkStickerSetNameResultOk"},
@@ -72,11 +116,107 @@ func NewCheckStickerSetNameResultOk() *CheckStickerSetNameResultOk {
 	return &checkStickerSetNameResultOkTemp
 }
 
+// MarshalJSON marshals to json
+func (checkStickerSetNameResultOk *CheckStickerSetNameResultOk) MarshalJSON() ([]byte, error) {
+	return checkStickerSetNameResultOk.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (checkStickerSetNameResultOk *CheckStickerSetNameResultOk) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, checkStickerSetNameResultOk)
+}
+
+func (checkStickerSetNameResultOk *CheckStickerSetNameResultOk) appendJSON(b []byte) ([]byte, error) {
+	if checkStickerSetNameResultOk == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, checkStickerSetNameResultOk.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, checkStickerSetNameResultOk.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (checkStickerSetNameResultOk *CheckStickerSetNameResultOk) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			checkStickerSetNameResultOk.tdCommon.Type, err = r.readString()
+		case "@extra":
+			checkStickerSetNameResultOk.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (checkStickerSetNameResultOk *CheckStickerSetNameResultOk) Clone() *CheckStickerSetNameResultOk {
+	if checkStickerSetNameResultOk == nil {
+		return nil
+	}
+	clone := *checkStickerSetNameResultOk
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (checkStickerSetNameResultOk *CheckStickerSetNameResultOk) Equal(other *CheckStickerSetNameResultOk) bool {
+	if checkStickerSetNameResultOk == nil || other == nil {
+		return checkStickerSetNameResultOk == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (checkStickerSetNameResultOk *CheckStickerSetNameResultOk) Diff(other *CheckStickerSetNameResultOk) []Change {
+	return checkStickerSetNameResultOk.diff("", other, nil)
+}
+
+func (checkStickerSetNameResultOk *CheckStickerSetNameResultOk) diff(path string, other *CheckStickerSetNameResultOk, changes []Change) []Change {
+	if checkStickerSetNameResultOk == nil || other == nil {
+		if checkStickerSetNameResultOk != other {
+			changes = append(changes, Change{Path: path, Old: checkStickerSetNameResultOk, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (checkStickerSetNameResultOk *CheckStickerSetNameResultOk) String() string {
+	return objectString(checkStickerSetNameResultOk)
+}
+
+// LogValue logs the object as its summary
+func (checkStickerSetNameResultOk *CheckStickerSetNameResultOk) LogValue() slog.Value {
+	return slog.StringValue(objectString(checkStickerSetNameResultOk))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (checkStickerSetNameResultOk *CheckStickerSetNameResultOk) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, checkStickerSetNameResultOk)
+}
+
+func (checkStickerSetNameResultOk *CheckStickerSetNameResultOk) printText(p *printer) {
+	if checkStickerSetNameResultOk == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CheckStickerSetNameResultOk")
+	p.endObject(state)
+}
+
 // GetCheckStickerSetNameResultEnum return the enum type of this object
 func (checkStickerSetNameResultOk *CheckStickerSetNameResultOk) GetCheckStickerSetNameResultEnum() CheckStickerSetNameResultEnum {
 	return CheckStickerSetNameResultOkType
 }
 
+// Accept calls the method of the visitor for CheckStickerSetNameResultOk
+func (checkStickerSetNameResultOk *CheckStickerSetNameResultOk) Accept(visitor CheckStickerSetNameResultVisitor) {
+	visitor.VisitCheckStickerSetNameResultOk(checkStickerSetNameResultOk)
+}
+
 // CheckStickerSetNameResultNameInvalid The name is invalid
 type CheckStickerSetNameResultNameInvalid struct {
 	tdCommon
@@ -88,7 +228,6 @@ func (checkStickerSetNameResultNameInvalid *CheckStickerSetNameResultNameInvalid
 }
 
 // NewCheckStickerSetNameResultNameInvalid creates a new CheckStickerSetNameResultNameInvalid
-//
 func NewCheckStickerSetNameResultNameInvalid() *CheckStickerSetNameResultNameInvalid {
 	checkStickerSetNameResultNameInvalidTemp := CheckStickerSetNameResultNameInvalid{
 		tdCommon: tdCommon{Type: "checkStickerSetNameResultNameInvalid"},
@@ -97,11 +236,107 @@ func NewCheckStickerSetNameResultNameInvalid() *CheckStickerSetNameResultNameInv
 	return &checkStickerSetNameResultNameInvalidTemp
 }
 
+// MarshalJSON marshals to json
+func (checkStickerSetNameResultNameInvalid *CheckStickerSetNameResultNameInvalid) MarshalJSON() ([]byte, error) {
+	return checkStickerSetNameResultNameInvalid.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (checkStickerSetNameResultNameInvalid *CheckStickerSetNameResultNameInvalid) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, checkStickerSetNameResultNameInvalid)
+}
+
+func (checkStickerSetNameResultNameInvalid *CheckStickerSetNameResultNameInvalid) appendJSON(b []byte) ([]byte, error) {
+	if checkStickerSetNameResultNameInvalid == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, checkStickerSetNameResultNameInvalid.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, checkStickerSetNameResultNameInvalid.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (checkStickerSetNameResultNameInvalid *CheckStickerSetNameResultNameInvalid) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			checkStickerSetNameResultNameInvalid.tdCommon.Type, err = r.readString()
+		case "@extra":
+			checkStickerSetNameResultNameInvalid.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (checkStickerSetNameResultNameInvalid *CheckStickerSetNameResultNameInvalid) Clone() *CheckStickerSetNameResultNameInvalid {
+	if checkStickerSetNameResultNameInvalid == nil {
+		return nil
+	}
+	clone := *checkStickerSetNameResultNameInvalid
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (checkStickerSetNameResultNameInvalid *CheckStickerSetNameResultNameInvalid) Equal(other *CheckStickerSetNameResultNameInvalid) bool {
+	if checkStickerSetNameResultNameInvalid == nil || other == nil {
+		return checkStickerSetNameResultNameInvalid == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (checkStickerSetNameResultNameInvalid *CheckStickerSetNameResultNameInvalid) Diff(other *CheckStickerSetNameResultNameInvalid) []Change {
+	return checkStickerSetNameResultNameInvalid.diff("", other, nil)
+}
+
+func (checkStickerSetNameResultNameInvalid *CheckStickerSetNameResultNameInvalid) diff(path string, other *CheckStickerSetNameResultNameInvalid, changes []Change) []Change {
+	if checkStickerSetNameResultNameInvalid == nil || other == nil {
+		if checkStickerSetNameResultNameInvalid != other {
+			changes = append(changes, Change{Path: path, Old: checkStickerSetNameResultNameInvalid, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (checkStickerSetNameResultNameInvalid *CheckStickerSetNameResultNameInvalid) String() string {
+	return objectString(checkStickerSetNameResultNameInvalid)
+}
+
+// LogValue logs the object as its summary
+func (checkStickerSetNameResultNameInvalid *CheckStickerSetNameResultNameInvalid) LogValue() slog.Value {
+	return slog.StringValue(objectString(checkStickerSetNameResultNameInvalid))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (checkStickerSetNameResultNameInvalid *CheckStickerSetNameResultNameInvalid) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, checkStickerSetNameResultNameInvalid)
+}
+
+func (checkStickerSetNameResultNameInvalid *CheckStickerSetNameResultNameInvalid) printText(p *printer) {
+	if checkStickerSetNameResultNameInvalid == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CheckStickerSetNameResultNameInvalid")
+	p.endObject(state)
+}
+
 // GetCheckStickerSetNameResultEnum return the enum type of this object
 func (checkStickerSetNameResultNameInvalid *CheckStickerSetNameResultNameInvalid) GetCheckStickerSetNameResultEnum() CheckStickerSetNameResultEnum {
 	return CheckStickerSetNameResultNameInvalidType
 }
 
+// Accept calls the method of the visitor for CheckStickerSetNameResultNameInvalid
+func (checkStickerSetNameResultNameInvalid *CheckStickerSetNameResultNameInvalid) Accept(visitor CheckStickerSetNameResultVisitor) {
+	visitor.VisitCheckStickerSetNameResultNameInvalid(checkStickerSetNameResultNameInvalid)
+}
+
 // CheckStickerSetNameResultNameOccupied The name is occupied
 type CheckStickerSetNameResultNameOccupied struct {
 	tdCommon
@@ -113,7 +348,6 @@ func (checkStickerSetNameResultNameOccupied *CheckStickerSetNameResultNameOccupi
 }
 
 // NewCheckStickerSetNameResultNameOccupied creates a new CheckStickerSetNameResultNameOccupied
-//
 func NewCheckStickerSetNameResultNameOccupied() *CheckStickerSetNameResultNameOccupied {
 	checkStickerSetNameResultNameOccupiedTemp := CheckStickerSetNameResultNameOccupied{
 		tdCommon: tdCommon{Type: "checkStickerSetNameResultNameOccupied"},
@@ -122,11 +356,217 @@ func NewCheckStickerSetNameResultNameOccupied() *CheckStickerSetNameResultNameOc
 	return &checkStickerSetNameResultNameOccupiedTemp
 }
 
+// MarshalJSON marshals to json
+func (checkStickerSetNameResultNameOccupied *CheckStickerSetNameResultNameOccupied) MarshalJSON() ([]byte, error) {
+	return checkStickerSetNameResultNameOccupied.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (checkStickerSetNameResultNameOccupied *CheckStickerSetNameResultNameOccupied) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, checkStickerSetNameResultNameOccupied)
+}
+
+func (checkStickerSetNameResultNameOccupied *CheckStickerSetNameResultNameOccupied) appendJSON(b []byte) ([]byte, error) {
+	if checkStickerSetNameResultNameOccupied == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, checkStickerSetNameResultNameOccupied.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, checkStickerSetNameResultNameOccupied.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (checkStickerSetNameResultNameOccupied *CheckStickerSetNameResultNameOccupied) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			checkStickerSetNameResultNameOccupied.tdCommon.Type, err = r.readString()
+		case "@extra":
+			checkStickerSetNameResultNameOccupied.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (checkStickerSetNameResultNameOccupied *CheckStickerSetNameResultNameOccupied) Clone() *CheckStickerSetNameResultNameOccupied {
+	if checkStickerSetNameResultNameOccupied == nil {
+		return nil
+	}
+	clone := *checkStickerSetNameResultNameOccupied
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (checkStickerSetNameResultNameOccupied *CheckStickerSetNameResultNameOccupied) Equal(other *CheckStickerSetNameResultNameOccupied) bool {
+	if checkStickerSetNameResultNameOccupied == nil || other == nil {
+		return checkStickerSetNameResultNameOccupied == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (checkStickerSetNameResultNameOccupied *CheckStickerSetNameResultNameOccupied) Diff(other *CheckStickerSetNameResultNameOccupied) []Change {
+	return checkStickerSetNameResultNameOccupied.diff("", other, nil)
+}
+
+func (checkStickerSetNameResultNameOccupied *CheckStickerSetNameResultNameOccupied) diff(path string, other *CheckStickerSetNameResultNameOccupied, changes []Change) []Change {
+	if checkStickerSetNameResultNameOccupied == nil || other == nil {
+		if checkStickerSetNameResultNameOccupied != other {
+			changes = append(changes, Change{Path: path, Old: checkStickerSetNameResultNameOccupied, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (checkStickerSetNameResultNameOccupied *CheckStickerSetNameResultNameOccupied) String() string {
+	return objectString(checkStickerSetNameResultNameOccupied)
+}
+
+// LogValue logs the object as its summary
+func (checkStickerSetNameResultNameOccupied *CheckStickerSetNameResultNameOccupied) LogValue() slog.Value {
+	return slog.StringValue(objectString(checkStickerSetNameResultNameOccupied))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (checkStickerSetNameResultNameOccupied *CheckStickerSetNameResultNameOccupied) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, checkStickerSetNameResultNameOccupied)
+}
+
+func (checkStickerSetNameResultNameOccupied *CheckStickerSetNameResultNameOccupied) printText(p *printer) {
+	if checkStickerSetNameResultNameOccupied == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CheckStickerSetNameResultNameOccupied")
+	p.endObject(state)
+}
+
 // GetCheckStickerSetNameResultEnum return the enum type of this object
 func (checkStickerSetNameResultNameOccupied *CheckStickerSetNameResultNameOccupied) GetCheckStickerSetNameResultEnum() CheckStickerSetNameResultEnum {
 	return CheckStickerSetNameResultNameOccupiedType
 }
 
+// Accept calls the method of the visitor for CheckStickerSetNameResultNameOccupied
+func (checkStickerSetNameResultNameOccupied *CheckStickerSetNameResultNameOccupied) Accept(visitor CheckStickerSetNameResultVisitor) {
+	visitor.VisitCheckStickerSetNameResultNameOccupied(checkStickerSetNameResultNameOccupied)
+}
+
+// CheckStickerSetNameResultVisitor visits every type of CheckStickerSetNameResult, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to CheckStickerSetNameResult, until they handle it too.
+type CheckStickerSetNameResultVisitor interface {
+	VisitCheckStickerSetNameResultOk(checkStickerSetNameResultOk *CheckStickerSetNameResultOk)
+	VisitCheckStickerSetNameResultNameInvalid(checkStickerSetNameResultNameInvalid *CheckStickerSetNameResultNameInvalid)
+	VisitCheckStickerSetNameResultNameOccupied(checkStickerSetNameResultNameOccupied *CheckStickerSetNameResultNameOccupied)
+	VisitUnknownCheckStickerSetNameResult(unknownCheckStickerSetNameResult *UnknownCheckStickerSetNameResult)
+}
+
+// CheckStickerSetNameResultCases holds the function MatchCheckStickerSetNameResult calls for each type of CheckStickerSetNameResult;
+// types without a function are passed to the default case
+type CheckStickerSetNameResultCases[R any] struct {
+	CheckStickerSetNameResultOk           func(checkStickerSetNameResultOk *CheckStickerSetNameResultOk) R
+	CheckStickerSetNameResultNameInvalid  func(checkStickerSetNameResultNameInvalid *CheckStickerSetNameResultNameInvalid) R
+	CheckStickerSetNameResultNameOccupied func(checkStickerSetNameResultNameOccupied *CheckStickerSetNameResultNameOccupied) R
+}
+
+// MatchCheckStickerSetNameResult calls the case of the type of checkStickerSetNameResult, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement CheckStickerSetNameResultVisitor
+// to have the compiler check every type is handled.
+func MatchCheckStickerSetNameResult[R any](checkStickerSetNameResult CheckStickerSetNameResult, cases CheckStickerSetNameResultCases[R], defaultCase func(checkStickerSetNameResult CheckStickerSetNameResult) R) R {
+	switch value := checkStickerSetNameResult.(type) {
+	case *CheckStickerSetNameResultOk:
+		if cases.CheckStickerSetNameResultOk != nil {
+			return cases.CheckStickerSetNameResultOk(value)
+		}
+	case *CheckStickerSetNameResultNameInvalid:
+		if cases.CheckStickerSetNameResultNameInvalid != nil {
+			return cases.CheckStickerSetNameResultNameInvalid(value)
+		}
+	case *CheckStickerSetNameResultNameOccupied:
+		if cases.CheckStickerSetNameResultNameOccupied != nil {
+			return cases.CheckStickerSetNameResultNameOccupied(value)
+		}
+	}
+	return defaultCase(checkStickerSetNameResult)
+}
+
+// Clone returns a deep copy of the object
+func (unknownCheckStickerSetNameResult *UnknownCheckStickerSetNameResult) Clone() *UnknownCheckStickerSetNameResult {
+	if unknownCheckStickerSetNameResult == nil {
+		return nil
+	}
+	return &UnknownCheckStickerSetNameResult{Unknown: unknownCheckStickerSetNameResult.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownCheckStickerSetNameResult *UnknownCheckStickerSetNameResult) Equal(other *UnknownCheckStickerSetNameResult) bool {
+	if unknownCheckStickerSetNameResult == nil || other == nil {
+		return unknownCheckStickerSetNameResult == other
+	}
+	return unknownCheckStickerSetNameResult.Unknown.equal(other.Unknown)
+}
+
+func cloneCheckStickerSetNameResult(checkStickerSetNameResult CheckStickerSetNameResult) CheckStickerSetNameResult {
+	switch value := checkStickerSetNameResult.(type) {
+	case *CheckStickerSetNameResultOk:
+		return value.Clone()
+	case *CheckStickerSetNameResultNameInvalid:
+		return value.Clone()
+	case *CheckStickerSetNameResultNameOccupied:
+		return value.Clone()
+	case *UnknownCheckStickerSetNameResult:
+		return value.Clone()
+	}
+	return checkStickerSetNameResult
+}
+
+func equalCheckStickerSetNameResult(a CheckStickerSetNameResult, b CheckStickerSetNameResult) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *CheckStickerSetNameResultOk:
+		b, ok := b.(*CheckStickerSetNameResultOk)
+		return ok && a.Equal(b)
+	case *CheckStickerSetNameResultNameInvalid:
+		b, ok := b.(*CheckStickerSetNameResultNameInvalid)
+		return ok && a.Equal(b)
+	case *CheckStickerSetNameResultNameOccupied:
+		b, ok := b.(*CheckStickerSetNameResultNameOccupied)
+		return ok && a.Equal(b)
+	case *UnknownCheckStickerSetNameResult:
+		b, ok := b.(*UnknownCheckStickerSetNameResult)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffCheckStickerSetNameResult(path string, a CheckStickerSetNameResult, b CheckStickerSetNameResult, changes []Change) []Change {
+	switch a := a.(type) {
+	case *CheckStickerSetNameResultOk:
+		if b, ok := b.(*CheckStickerSetNameResultOk); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CheckStickerSetNameResultNameInvalid:
+		if b, ok := b.(*CheckStickerSetNameResultNameInvalid); ok {
+			return a.diff(path, b, changes)
+		}
+	case *CheckStickerSetNameResultNameOccupied:
+		if b, ok := b.(*CheckStickerSetNameResultNameOccupied); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalCheckStickerSetNameResult(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
+
 // CheckStickerSetName Checks whether a name can be used for a new sticker set
 // @param name Name to be checked
 func (client *Client) CheckStickerSetName(name string) (CheckStickerSetNameResult, error) {
@@ -139,25 +579,26 @@ func (client *Client) CheckStickerSetName(name string) (CheckStickerSetNameResul
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
-	switch CheckStickerSetNameResultEnum(result.Data["@type"].(string)) {
+	resultType, _ := result.Data["@type"].(string)
+	switch CheckStickerSetNameResultEnum(resultType) {
 
 	case CheckStickerSetNameResultOkType:
 		var checkStickerSetNameResult CheckStickerSetNameResultOk
-		err = json.Unmarshal(result.Raw, &checkStickerSetNameResult)
+		err = jsonUnmarshal(result.Raw, &checkStickerSetNameResult)
 		return &checkStickerSetNameResult, err
 
 	case CheckStickerSetNameResultNameInvalidType:
 		var checkStickerSetNameResult CheckStickerSetNameResultNameInvalid
-		err = json.Unmarshal(result.Raw, &checkStickerSetNameResult)
+		err = jsonUnmarshal(result.Raw, &checkStickerSetNameResult)
 		return &checkStickerSetNameResult, err
 
 	case CheckStickerSetNameResultNameOccupiedType:
 		var checkStickerSetNameResult CheckStickerSetNameResultNameOccupied
-		err = json.Unmarshal(result.Raw, &checkStickerSetNameResult)
+		err = jsonUnmarshal(result.Raw, &checkStickerSetNameResult)
 		return &checkStickerSetNameResult, err
 
 	default:
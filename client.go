@@ -12,15 +12,14 @@ package tdlib
 import "C"
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"math/rand"
-	"reflect"
-	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 	"unsafe"
 )
@@ -37,15 +36,31 @@ type EventReceiver struct {
 
 // Client is the Telegram TdLib client
 type Client struct {
-	Client         unsafe.Pointer
-	Config         Config
-	rawUpdates     chan UpdateMsg
-	receivers      []EventReceiver
-	waiters        map[string]chan UpdateMsg
-	msgWaiters     map[int64]chan UpdateMsg
-	receiverLock   *sync.Mutex
-	waitersLock    *sync.RWMutex
-	msgWaitersLock *sync.RWMutex
+	Client           unsafe.Pointer
+	Config           Config
+	rawUpdates       chan UpdateMsg
+	receivers        atomic.Pointer[[]EventReceiver] // replaced, never modified, so it's read without receiverLock
+	waiters          map[string]requestWaiter
+	msgWaiters       map[int64]chan UpdateMsg
+	receiverLock     *sync.Mutex
+	waitersLock      *sync.RWMutex
+	msgWaitersLock   *sync.RWMutex
+	logger           atomic.Pointer[slog.Logger]
+	metrics          atomic.Value
+	connectionState  atomic.Value
+	tracer           atomic.Value
+	sendingSpans     map[int64]sendingSpan
+	sendingSpansLock *sync.Mutex
+	transport        Transport
+	panicHandler     atomic.Value
+	limits           atomic.Pointer[Limits]
+	validation       atomic.Bool
+}
+
+// requestWaiter waits for the response of a request sent with SendAndCatch
+type requestWaiter struct {
+	response chan UpdateMsg
+	span     Span // span of the request, nil if tracing is disabled
 }
 
 // Config holds tdlibParameters
@@ -72,93 +87,227 @@ type Config struct {
 // Has two public fields:
 // Client itself and RawUpdates channel
 func NewClient(config Config) *Client {
+	transport := newTdjsonTransport()
+
+	client := NewClientWithTransport(config, transport)
+	client.Client = transport.client
+	return client
+}
+
+// NewClientWithTransport Creates a new instance of the client which sends and receives
+// through the given transport instead of a TDLib client instance,
+// e.g. a RecordingTransport or a ReplayTransport.
+func NewClientWithTransport(config Config, transport Transport) *Client {
 	// Seed rand with time
 	rand.Seed(time.Now().UnixNano())
 
-	client := Client{Client: C.td_json_client_create()}
-	client.receivers = make([]EventReceiver, 0, 1)
+	client := Client{transport: transport}
+	client.receivers.Store(&[]EventReceiver{})
 	client.receiverLock = &sync.Mutex{}
 	client.waitersLock = &sync.RWMutex{}
 	client.msgWaitersLock = &sync.RWMutex{}
 	client.Config = config
-	client.waiters = make(map[string]chan UpdateMsg)
+	client.waiters = make(map[string]requestWaiter)
 	client.msgWaiters = make(map[int64]chan UpdateMsg)
+	client.sendingSpans = make(map[int64]sendingSpan)
+	client.sendingSpansLock = &sync.Mutex{}
 
 	go func() {
+		authorizationState := ""
+
 		for {
 			// get update
 			updateBytes := client.Receive(10)
-			var updateData UpdateData
-			json.Unmarshal(updateBytes, &updateData)
+			if len(updateBytes) == 0 {
+				// receive timed out
+				continue
+			}
 
-			// does new update has @extra field?
-			if extra, hasExtra := updateData["@extra"].(string); hasExtra {
+			client.handleUpdate(updateBytes, &authorizationState)
+		}
+	}()
 
-				client.waitersLock.RLock()
-				waiter, found := client.waiters[extra]
-				client.waitersLock.RUnlock()
+	return &client
+}
 
-				// trying to load update with this salt
-				if found {
-					// found? send it to waiter channel
-					waiter <- UpdateMsg{Data: updateData, Raw: updateBytes}
+// handleUpdate passes a received update or response to its waiter, the raw updates channel and the receivers.
+// Panics are recovered and reported, so they don't kill the receive loop.
+func (client *Client) handleUpdate(updateBytes []byte, authorizationState *string) {
+	msgType := ""
+	defer func() {
+		if r := recover(); r != nil {
+			client.reportPanic(msgType, r)
+		}
+	}()
 
-					// trying to prevent memory leak
-					close(waiter)
-				}
-			} else {
-				// does new updates has @type field?
-				if msgType, hasType := updateData["@type"]; hasType {
-					if msgType == "updateMessageSendSucceeded" {
-						client.msgWaitersLock.RLock()
-						msgWaiter, found2 := client.msgWaiters[int64(updateData["old_message_id"].(float64))]
-						client.msgWaitersLock.RUnlock()
-
-						if found2 {
-							// found? send it to waiter channel
-							msgWaiter <- UpdateMsg{Data: updateData, Raw: updateBytes}
-
-							// trying to prevent memory leak
-							close(msgWaiter)
-						}
-					}
+	// does new update has @extra field?
+	if extra, hasExtra := sniffExtra(updateBytes); hasExtra {
+		var updateData UpdateData
+		err := jsonUnmarshal(updateBytes, &updateData)
+		if err != nil {
+			client.Logger().Error("failed to decode response", "error", err, "raw", string(updateBytes))
+			return
+		}
 
-					if client.rawUpdates != nil {
-						// if rawUpdates is initialized, send the update in rawUpdates channel
-						client.rawUpdates <- UpdateMsg{Data: updateData, Raw: updateBytes}
-					}
+		client.waitersLock.RLock()
+		waiter, found := client.waiters[extra]
+		client.waitersLock.RUnlock()
 
-					client.receiverLock.Lock()
-					for _, receiver := range client.receivers {
-						if msgType == receiver.Instance.MessageType() {
-							newMsg := reflect.New(reflect.ValueOf(receiver.Instance).Elem().Type()).Interface().(TdMessage)
-
-							err := json.Unmarshal(updateBytes, &newMsg)
-							if err != nil {
-								fmt.Printf("Error unmarhaling to type %v", err)
-							} else {
-								if receiver.FilterFunc(&newMsg) {
-									receiver.Chan <- newMsg
-								}
-							}
-						}
-					}
-					client.receiverLock.Unlock()
-				}
+		// trying to load update with this salt
+		if found {
+			// found? send it to waiter channel. A message being sent gets the waiter of its send result, and
+			// is remembered as sent by the request span, before the response is passed on, as the result may be
+			// received right after the response.
+			client.rememberSendingMessages(waiter.span, updateData)
+			waiter.response <- UpdateMsg{Data: updateData, Raw: updateBytes, sendResult: client.addSendingMessageWaiter(updateData)}
+
+			// trying to prevent memory leak
+			close(waiter.response)
+		} else {
+			client.Logger().Warn("dropped response to an unknown or timed out request", "@type", updateData["@type"], "@extra", extra)
+		}
+		return
+	}
+
+	// does new updates has @type field?
+	msgType, err := sniffType(updateBytes)
+	if err != nil {
+		client.Logger().Warn("dropped update without @type", "error", err, "raw", string(updateBytes))
+		return
+	}
+
+	// decode the update once, the value is shared by the raw updates channel, the receivers and the waiters
+	update, err := unmarshalTdMessage(msgType, updateBytes)
+	if err != nil {
+		client.Logger().Error("failed to decode update", "@type", msgType, "error", err)
+	}
+
+	metrics := client.getMetrics()
+	if metrics != nil {
+		metrics.UpdateReceived(UpdateEnum(msgType))
+	}
+	span := client.startUpdateSpan(update)
+	if span != nil {
+		defer span.End(0, nil)
+	}
+
+	switch update := update.(type) {
+	case *UpdateConnectionState:
+		if update.State != nil {
+			newState := update.State.GetConnectionStateEnum()
+			client.connectionState.Store(newState)
+			if metrics != nil {
+				metrics.ConnectionStateChanged(newState)
+			}
+		}
+
+	case *UpdateAuthorizationState:
+		if update.AuthorizationState != nil {
+			newState := string(update.AuthorizationState.GetAuthorizationStateEnum())
+			client.Logger().Info("authorization state changed", "from", *authorizationState, "to", newState)
+			*authorizationState = newState
+		}
+
+	case *UpdateOption:
+		client.setLimitOption(update.Name, update.Value)
+
+	case *UpdateMessageSendSucceeded:
+		client.passSendResult(update.OldMessageId, UpdateMsg{Raw: updateBytes, Value: update})
+
+	case *UpdateMessageSendFailed:
+		client.passSendResult(update.OldMessageId, UpdateMsg{Raw: updateBytes, Value: update})
+	}
+
+	if client.rawUpdates != nil {
+		// Data is only decoded for clients with a raw updates channel, the receivers use the decoded value
+		var updateData UpdateData
+		jsonUnmarshal(updateBytes, &updateData)
+
+		// if rawUpdates is initialized, send the update in rawUpdates channel
+		client.rawUpdates <- UpdateMsg{Data: updateData, Raw: updateBytes, Value: update}
+	}
+
+	if update != nil {
+		client.dispatchToReceivers(msgType, update)
+	}
+}
+
+// addSendingMessageWaiter adds the waiter of the send result of a response which is a message being sent and
+// returns it, or nil if the response isn't one
+func (client *Client) addSendingMessageWaiter(response UpdateData) chan UpdateMsg {
+	if response["@type"] != "message" {
+		return nil
+	}
+	sendingState, _ := response["sending_state"].(map[string]interface{})
+	messageID, ok := int64Value(response["id"])
+	if sendingState == nil || sendingState["@type"] != "messageSendingStatePending" || !ok {
+		return nil
+	}
+
+	msgWaiter := make(chan UpdateMsg, 1)
+	client.msgWaitersLock.Lock()
+	client.msgWaiters[messageID] = msgWaiter
+	client.msgWaitersLock.Unlock()
+	return msgWaiter
+}
+
+// removeSendingMessageWaiter removes the waiter of the send result of a message and returns it, or nil if
+// the message has none
+func (client *Client) removeSendingMessageWaiter(messageID int64) chan UpdateMsg {
+	client.msgWaitersLock.Lock()
+	defer client.msgWaitersLock.Unlock()
+	msgWaiter := client.msgWaiters[messageID]
+	delete(client.msgWaiters, messageID)
+	return msgWaiter
+}
+
+// passSendResult passes an updateMessageSendSucceeded or an updateMessageSendFailed to the waiter of the message,
+// which only needs the decoded value
+func (client *Client) passSendResult(oldMessageID int64, result UpdateMsg) {
+	if msgWaiter := client.removeSendingMessageWaiter(oldMessageID); msgWaiter != nil {
+		msgWaiter <- result
+		close(msgWaiter)
+	}
+}
+
+// dispatchToReceivers sends an update to the matching receivers whose filter accepts it
+func (client *Client) dispatchToReceivers(msgType string, update TdMessage) {
+	client.receiverLock.Lock()
+	defer client.receiverLock.Unlock()
+
+	for _, receiver := range *client.receivers.Load() {
+		if msgType == receiver.Instance.MessageType() {
+			newMsg := update
+			if client.filterUpdate(receiver, msgType, &newMsg) {
+				receiver.Chan <- newMsg
 			}
 		}
+	}
+}
+
+// filterUpdate calls the filter of a receiver, a panicking filter rejects the update
+func (client *Client) filterUpdate(receiver EventReceiver, msgType string, msg *TdMessage) (accepted bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			client.reportPanic(msgType, r)
+			accepted = false
+		}
 	}()
 
-	return &client
+	return receiver.FilterFunc(msg)
 }
 
-// GetRawUpdatesChannel creates a general channel that fetches every update comming from tdlib
+// GetRawUpdatesChannel creates a general channel that fetches every update comming from tdlib.
+// Filling the Data of the updates decodes them a second time, so receivers added with AddEventReceiver,
+// which get the value decoded once, are cheaper.
 func (client *Client) GetRawUpdatesChannel(capacity int) chan UpdateMsg {
 	client.rawUpdates = make(chan UpdateMsg, capacity)
 	return client.rawUpdates
 }
 
 // AddEventReceiver adds a new receiver to be subscribed in receiver channels
+// Each update is decoded once and the same value is passed to every matching receiver
+// and the raw updates channel, so receivers must not modify it.
 // @param msgInstance what kind of message do you want to receive?
 // @param
 func (client *Client) AddEventReceiver(msgInstance TdMessage, filterFunc EventFilterFunc, channelCapacity int) EventReceiver {
@@ -170,40 +319,58 @@ func (client *Client) AddEventReceiver(msgInstance TdMessage, filterFunc EventFi
 
 	client.receiverLock.Lock()
 	defer client.receiverLock.Unlock()
-	client.receivers = append(client.receivers, receiver)
+	receivers := *client.receivers.Load()
+	updated := make([]EventReceiver, len(receivers), len(receivers)+1)
+	copy(updated, receivers)
+	updated = append(updated, receiver)
+	client.receivers.Store(&updated)
 
 	return receiver
 }
 
+// RemoveEventReceiver unsubscribes a receiver added by AddEventReceiver. Its channel isn't closed, and
+// must be drained until RemoveEventReceiver returns, as an update may be waiting to be sent to it.
+func (client *Client) RemoveEventReceiver(receiver EventReceiver) {
+	client.receiverLock.Lock()
+	defer client.receiverLock.Unlock()
+
+	receivers := *client.receivers.Load()
+	for i := range receivers {
+		if receivers[i].Chan == receiver.Chan {
+			updated := make([]EventReceiver, 0, len(receivers)-1)
+			updated = append(updated, receivers[:i]...)
+			updated = append(updated, receivers[i+1:]...)
+			client.receivers.Store(&updated)
+			return
+		}
+	}
+}
+
 // DestroyInstance Destroys the TDLib client instance.
 // After this is called the client instance shouldn't be used anymore.
 func (client *Client) DestroyInstance() {
-	C.td_json_client_destroy(client.Client)
+	client.transport.Destroy()
 }
 
 // Send Sends request to the TDLib client.
 // You can provide string or UpdateData.
 func (client *Client) Send(jsonQuery interface{}) {
-	var query *C.char
+	var query []byte
 
 	switch jsonQuery.(type) {
 	case string:
-		query = C.CString(jsonQuery.(string))
+		query = []byte(jsonQuery.(string))
 	case UpdateData:
-		jsonBytes, _ := json.Marshal(jsonQuery.(UpdateData))
-		query = C.CString(string(jsonBytes))
+		query, _ = jsonMarshal(jsonQuery.(UpdateData))
 	}
 
-	defer C.free(unsafe.Pointer(query))
-	C.td_json_client_send(client.Client, query)
+	client.transport.Send(query)
 }
 
 // Receive Receives incoming updates and request responses from the TDLib client.
 // You can provide string or UpdateData.
 func (client *Client) Receive(timeout float64) []byte {
-	result := C.td_json_client_receive(client.Client, C.double(timeout))
-
-	return []byte(C.GoString(result))
+	return client.transport.Receive(timeout)
 }
 
 // Execute Synchronously executes TDLib request.
@@ -215,7 +382,7 @@ func (client *Client) Execute(jsonQuery interface{}) UpdateMsg {
 	case string:
 		query = C.CString(jsonQuery.(string))
 	case UpdateData:
-		jsonBytes, _ := json.Marshal(jsonQuery.(UpdateData))
+		jsonBytes, _ := jsonMarshal(jsonQuery.(UpdateData))
 		query = C.CString(string(jsonBytes))
 	}
 
@@ -223,15 +390,37 @@ func (client *Client) Execute(jsonQuery interface{}) UpdateMsg {
 	result := C.td_json_client_execute(client.Client, query)
 
 	var update UpdateData
-	json.Unmarshal([]byte(C.GoString(result)), &update)
+	jsonUnmarshal([]byte(C.GoString(result)), &update)
 	return UpdateMsg{Data: update, Raw: []byte(C.GoString(result))}
 }
 
+// execute Synchronously executes a TDLib request without a client, for the generated Execute functions
+func execute(query UpdateData) (UpdateMsg, error) {
+	jsonBytes, err := jsonMarshal(query)
+	if err != nil {
+		return UpdateMsg{}, err
+	}
+
+	cQuery := C.CString(string(jsonBytes))
+	defer C.free(unsafe.Pointer(cQuery))
+	result := C.td_json_client_execute(nil, cQuery)
+	if result == nil {
+		return UpdateMsg{}, fmt.Errorf("%v can't be executed synchronously", query["@type"])
+	}
+
+	raw := []byte(C.GoString(result))
+	var update UpdateData
+	if err := jsonUnmarshal(raw, &update); err != nil {
+		return UpdateMsg{}, err
+	}
+	return UpdateMsg{Data: update, Raw: raw}, nil
+}
+
 // SetFilePath Sets the path to the file to where the internal TDLib log will be written.
 // By default TDLib writes logs to stderr or an OS specific log.
 // Use this method to write the log to a file instead.
 func SetFilePath(path string) {
-	bytes, _ := json.Marshal(UpdateData{
+	bytes, _ := jsonMarshal(UpdateData{
 		"@type": "setLogStream",
 		"log_stream": UpdateData{
 			"@type":         "logStreamFile",
@@ -248,7 +437,7 @@ func SetFilePath(path string) {
 // SetLogVerbosityLevel Sets the verbosity level of the internal logging of
 // By default the TDLib uses a verbosity level of 5 for logging.
 func SetLogVerbosityLevel(level int) {
-	bytes, _ := json.Marshal(UpdateData{
+	bytes, _ := jsonMarshal(UpdateData{
 		"@type":               "setLogVerbosityLevel",
 		"new_verbosity_level": level,
 	})
@@ -261,14 +450,36 @@ func SetLogVerbosityLevel(level int) {
 // SendAndCatch Sends request to the TDLib client and catches the result in updates channel.
 // You can provide string or UpdateData.
 func (client *Client) SendAndCatch(jsonQuery interface{}) (UpdateMsg, error) {
+	return client.SendAndCatchContext(context.Background(), jsonQuery)
+}
+
+// SendAndCatchContext is SendAndCatch which stops waiting for the result when ctx is done.
+// The request is traced as a child of the span in ctx, see SetTracer.
+func (client *Client) SendAndCatchContext(ctx context.Context, jsonQuery interface{}) (UpdateMsg, error) {
+	return client.sendAndCatch(ctx, jsonQuery, time.Second)
+}
+
+// sendAndCatch is SendAndCatchContext which waits up to sendResultTimeout for the final message of a sent text
+// or dice, or until ctx is done if it's 0. A message whose result wasn't received is returned pending.
+func (client *Client) sendAndCatch(ctx context.Context, jsonQuery interface{}, sendResultTimeout time.Duration) (UpdateMsg, error) {
 	var update UpdateData
 
 	switch jsonQuery.(type) {
 	case string:
 		// unmarshal JSON into map, we don't have @extra field, if user don't set it
-		json.Unmarshal([]byte(jsonQuery.(string)), &update)
+		if err := jsonUnmarshal([]byte(jsonQuery.(string)), &update); err != nil {
+			return UpdateMsg{}, fmt.Errorf("invalid request: %v", err)
+		}
 	case UpdateData:
 		update = jsonQuery.(UpdateData)
+	default:
+		return UpdateMsg{}, fmt.Errorf("invalid request type %T", jsonQuery)
+	}
+	if update == nil {
+		return UpdateMsg{}, errors.New("invalid request: null")
+	}
+	if err := client.validateRequest(update); err != nil {
+		return UpdateMsg{}, err
 	}
 
 	// letters for generating random string
@@ -284,11 +495,18 @@ func (client *Client) SendAndCatch(jsonQuery interface{}) (UpdateMsg, error) {
 	// set @extra field
 	update["@extra"] = randomString
 
+	logger := client.Logger()
+	if logger.Enabled(ctx, slog.LevelDebug) {
+		logger.Debug("sending request", "@type", update["@type"], "@extra", randomString, "request", redactRequest(update))
+	}
+	start := time.Now()
+	span := client.startRequestSpan(ctx, update)
+
 	// create waiter chan and save it in Waiters
 	waiter := make(chan UpdateMsg, 1)
 
 	client.waitersLock.Lock()
-	client.waiters[randomString] = waiter
+	client.waiters[randomString] = requestWaiter{response: waiter, span: span}
 	client.waitersLock.Unlock()
 
 	// send it through already implemented method
@@ -301,38 +519,60 @@ func (client *Client) SendAndCatch(jsonQuery interface{}) (UpdateMsg, error) {
 		delete(client.waiters, randomString)
 		client.waitersLock.Unlock()
 
-		if update["@type"] == "sendMessage" {
-			var messageDummy Message
-			json.Unmarshal(response.Raw, &messageDummy)
-
-			if messageDummy.Content != nil {
-				if messageDummy.Content.GetMessageContentEnum() == "messageText" || messageDummy.Content.GetMessageContentEnum() == "messageDice" {
-					msgWaiter := make(chan UpdateMsg, 1)
-					client.msgWaitersLock.Lock()
-					client.msgWaiters[messageDummy.Id] = msgWaiter
-					client.msgWaitersLock.Unlock()
-
-					select {
-					case updateResp := <-msgWaiter:
-						var successMsg UpdateMessageSendSucceeded
-						json.Unmarshal(updateResp.Raw, &successMsg)
-
-						response.Data = updateResp.Data["message"].(map[string]interface{})
-						if str, err := json.Marshal(updateResp.Data["message"].(map[string]interface{})); err == nil {
-							response.Raw = []byte(str)
+		latency := time.Since(start)
+		logger.Debug("received response", "@type", update["@type"], "@extra", randomString, "response_type", response.Data["@type"], "latency", latency)
+		if metrics := client.getMetrics(); metrics != nil {
+			method, _ := update["@type"].(string)
+			metrics.RequestFinished(method, latency, responseErrorCode(response))
+		}
+		client.endRequestSpan(span, response, nil)
+
+		if response.sendResult != nil {
+			content, _ := response.Data["content"].(map[string]interface{})
+			messageID, _ := int64Value(response.Data["id"])
+
+			// only the results of sent texts and dice, which are sent quickly, are waited for
+			if update["@type"] == "sendMessage" && content != nil && (content["@type"] == "messageText" || content["@type"] == "messageDice") {
+				var timeout <-chan time.Time
+				if sendResultTimeout > 0 {
+					timer := time.NewTimer(sendResultTimeout)
+					defer timer.Stop()
+					timeout = timer.C
+				}
+
+				select {
+				case updateResp := <-response.sendResult:
+					// the update was decoded once by the receive loop, the response is derived from the sent
+					// or failed message
+					var message *Message
+					switch result := updateResp.Value.(type) {
+					case *UpdateMessageSendSucceeded:
+						message = result.Message
+					case *UpdateMessageSendFailed:
+						message = result.Message
+					}
+					if message != nil {
+						var messageData UpdateData
+						raw, err := jsonMarshal(message)
+						if err == nil {
+							err = jsonUnmarshal(raw, &messageData)
+						}
+						if err == nil {
+							response.Data, response.Raw = messageData, raw
 						} else {
-							response.Raw = bytes.Replace(response.Raw, []byte("{\"@type\":\"messageSendingStatePending\"}"), []byte("{\"@type\":\"updateMessageSendSucceeded\"}"), 1)
-							response.Raw = bytes.Replace(response.Raw, []byte(strconv.FormatInt(messageDummy.Id, 10)), []byte(strconv.FormatInt(successMsg.Message.Id, 10)), 1)
+							logger.Warn("failed to encode sent message", "chat_id", message.ChatId, "message_id", message.Id, "error", err)
 						}
-
-						return response, nil
-					case <-time.After(1 * time.Second):
-						client.msgWaitersLock.Lock()
-						delete(client.msgWaiters, messageDummy.Id)
-						client.msgWaitersLock.Unlock()
 					}
+
+					response.sendResult = nil
+					return response, nil
+				case <-timeout:
+					logger.Warn("timed out waiting for updateMessageSendSucceeded", "chat_id", response.Data["chat_id"], "message_id", messageID)
+				case <-ctx.Done():
 				}
 			}
+			client.removeSendingMessageWaiter(messageID)
+			response.sendResult = nil
 		}
 
 		return response, nil
@@ -342,7 +582,23 @@ func (client *Client) SendAndCatch(jsonQuery interface{}) (UpdateMsg, error) {
 		delete(client.waiters, randomString)
 		client.waitersLock.Unlock()
 
-		return UpdateMsg{}, errors.New("timeout")
+		latency := time.Since(start)
+		logger.Warn("request timed out", "@type", update["@type"], "@extra", randomString, "latency", latency)
+		if metrics := client.getMetrics(); metrics != nil {
+			method, _ := update["@type"].(string)
+			metrics.RequestFinished(method, latency, ErrorCodeTimeout)
+		}
+		err := errors.New("timeout")
+		client.endRequestSpan(span, UpdateMsg{}, err)
+		return UpdateMsg{}, err
+		// or the caller gave up
+	case <-ctx.Done():
+		client.waitersLock.Lock()
+		delete(client.waiters, randomString)
+		client.waitersLock.Unlock()
+
+		client.endRequestSpan(span, UpdateMsg{}, ctx.Err())
+		return UpdateMsg{}, ctx.Err()
 	}
 }
 
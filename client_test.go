@@ -0,0 +1,194 @@
+package tdlib
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// largeMessageUpdate returns an updateNewMessage with a long text and many entities
+func largeMessageUpdate() []byte {
+	var text strings.Builder
+	var entities []map[string]interface{}
+	for i := 0; i < 200; i++ {
+		entities = append(entities, map[string]interface{}{
+			"@type": "textEntity", "offset": text.Len(), "length": 5,
+			"type": map[string]interface{}{"@type": "textEntityTypeBold"},
+		})
+		text.WriteString("hello world, this is a fairly long message ")
+	}
+	update, err := json.Marshal(map[string]interface{}{
+		"@type": "updateNewMessage",
+		"message": map[string]interface{}{
+			"@type":     "message",
+			"id":        1048576,
+			"sender_id": map[string]interface{}{"@type": "messageSenderUser", "user_id": 42},
+			"chat_id":   -1001234567890,
+			"date":      1700000000,
+			"content": map[string]interface{}{
+				"@type": "messageText",
+				"text":  map[string]interface{}{"@type": "formattedText", "text": text.String(), "entities": entities},
+			},
+			"reply_markup": map[string]interface{}{
+				"@type": "replyMarkupInlineKeyboard",
+				"rows": [][]map[string]interface{}{{{
+					"@type": "inlineKeyboardButton", "text": "Open",
+					"type": map[string]interface{}{"@type": "inlineKeyboardButtonTypeUrl", "url": "https://example.com"},
+				}}},
+			},
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return update
+}
+
+func BenchmarkHandleUpdate(b *testing.B) {
+	update := largeMessageUpdate()
+	b.SetBytes(int64(len(update)))
+
+	b.Run("decode once", func(b *testing.B) {
+		client := NewClientWithTransport(Config{}, newFakeTransport(nil))
+		client.AddEventReceiver(&UpdateNewMessage{}, func(msg *TdMessage) bool { return false }, 1)
+		state := ""
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			client.handleUpdate(update, &state)
+		}
+	})
+
+	// the path before updates were decoded once: a map decode, then a reflection decode for every receiver
+	b.Run("map", func(b *testing.B) {
+		instance := TdMessage(&UpdateNewMessage{})
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var updateData UpdateData
+			if err := json.Unmarshal(update, &updateData); err != nil {
+				b.Fatal(err)
+			}
+			if updateData["@type"] == instance.MessageType() {
+				msg := reflect.New(reflect.ValueOf(instance).Elem().Type()).Interface().(TdMessage)
+				if err := json.Unmarshal(update, &msg); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
+
+// sendMessageTransport answers sendMessage requests with pending messages with the temporary identifiers 1, 2, ...
+// and sends the update returned by result for each of them after delay; none is sent if result returns nil
+func sendMessageTransport(delay time.Duration, result func(oldID int64, message map[string]interface{}) map[string]interface{}) *fakeTransport {
+	var transport *fakeTransport
+	var lock sync.Mutex
+	var lastID int64
+	transport = newFakeTransport(func(request map[string]interface{}) []map[string]interface{} {
+		if request["@type"] != "sendMessage" {
+			return []map[string]interface{}{{"@type": "ok", "@extra": request["@extra"]}}
+		}
+		lock.Lock()
+		lastID++
+		id := lastID
+		lock.Unlock()
+
+		inputContent, _ := request["input_message_content"].(map[string]interface{})
+		message := map[string]interface{}{
+			"@type": "message", "id": id, "chat_id": request["chat_id"], "reply_to_message_id": request["reply_to_message_id"],
+			"content": map[string]interface{}{"@type": "messageText", "text": inputContent["text"]},
+		}
+		response := map[string]interface{}{"@extra": request["@extra"], "sending_state": map[string]interface{}{"@type": "messageSendingStatePending"}}
+		for key, value := range message {
+			response[key] = value
+		}
+		update := result(id, message)
+		if update == nil {
+			return []map[string]interface{}{response}
+		}
+		if delay == 0 {
+			// the result is received right after the response
+			return []map[string]interface{}{response, update}
+		}
+		go func() {
+			time.Sleep(delay)
+			transport.push(update)
+		}()
+		return []map[string]interface{}{response}
+	})
+	return transport
+}
+
+// sendSucceeded returns the updateMessageSendSucceeded of a message, which gets the identifier oldID<<20
+func sendSucceeded(oldID int64, message map[string]interface{}) map[string]interface{} {
+	sent := map[string]interface{}{}
+	for key, value := range message {
+		sent[key] = value
+	}
+	sent["id"] = oldID << 20
+	return map[string]interface{}{"@type": "updateMessageSendSucceeded", "old_message_id": oldID, "message": sent}
+}
+
+// sendFailed returns the updateMessageSendFailed of a message
+func sendFailed(oldID int64, message map[string]interface{}) map[string]interface{} {
+	failed := map[string]interface{}{
+		"sending_state": map[string]interface{}{"@type": "messageSendingStateFailed", "error_code": 400, "error_message": "CHAT_WRITE_FORBIDDEN"},
+	}
+	for key, value := range message {
+		failed[key] = value
+	}
+	return map[string]interface{}{"@type": "updateMessageSendFailed", "old_message_id": oldID, "message": failed,
+		"error_code": 400, "error_message": "CHAT_WRITE_FORBIDDEN"}
+}
+
+func TestSendMessageWaitsForSendResult(t *testing.T) {
+	tests := []struct {
+		name    string
+		delay   time.Duration
+		result  func(oldID int64, message map[string]interface{}) map[string]interface{}
+		id      int64
+		pending bool
+		failed  bool
+	}{
+		{"succeeded right after the response", 0, sendSucceeded, 1 << 20, false, false},
+		{"succeeded later", 50 * time.Millisecond, sendSucceeded, 1 << 20, false, false},
+		{"failed right after the response", 0, sendFailed, 1, false, true},
+		{"no result", 0, func(int64, map[string]interface{}) map[string]interface{} { return nil }, 1, true, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := NewClientWithTransport(Config{}, sendMessageTransport(test.delay, test.result))
+
+			response, err := client.SendAndCatchContext(context.Background(), UpdateData{
+				"@type":                 "sendMessage",
+				"chat_id":               5,
+				"input_message_content": NewInputMessageText(NewFormattedText("hi", nil), false, false),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if id, _ := int64Value(response.Data["id"]); id != test.id {
+				t.Errorf("response has message id %v, want %d", response.Data["id"], test.id)
+			}
+			var message Message
+			if err := json.Unmarshal(response.Raw, &message); err != nil || message.Id != test.id {
+				t.Errorf("raw response is %s, want the message %d: %v", response.Raw, test.id, err)
+			}
+			_, pending := message.SendingState.(*MessageSendingStatePending)
+			_, failed := message.SendingState.(*MessageSendingStateFailed)
+			if pending != test.pending || failed != test.failed {
+				t.Errorf("message has sending state %v, want pending %v and failed %v", message.SendingState, test.pending, test.failed)
+			}
+
+			client.msgWaitersLock.RLock()
+			defer client.msgWaitersLock.RUnlock()
+			if len(client.msgWaiters) != 0 {
+				t.Errorf("%d message waiters are left", len(client.msgWaiters))
+			}
+		})
+	}
+}
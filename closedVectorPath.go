@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // ClosedVectorPath Represents a closed vector path. The path begins at the end point of the last command
 type ClosedVectorPath struct {
 	tdCommon
@@ -22,3 +27,147 @@ func NewClosedVectorPath(commands []VectorPathCommand) *ClosedVectorPath {
 
 	return &closedVectorPathTemp
 }
+
+// MarshalJSON marshals to json
+func (closedVectorPath *ClosedVectorPath) MarshalJSON() ([]byte, error) {
+	return closedVectorPath.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (closedVectorPath *ClosedVectorPath) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, closedVectorPath)
+}
+
+func (closedVectorPath *ClosedVectorPath) appendJSON(b []byte) ([]byte, error) {
+	if closedVectorPath == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, closedVectorPath.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, closedVectorPath.tdCommon.Extra)
+	b = append(b, `,"commands":`...)
+	if closedVectorPath.Commands == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range closedVectorPath.Commands {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = appendJSONValue(b, closedVectorPath.Commands[i0])
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (closedVectorPath *ClosedVectorPath) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			closedVectorPath.tdCommon.Type, err = r.readString()
+		case "@extra":
+			closedVectorPath.tdCommon.Extra, err = r.readString()
+		case "commands":
+			if r.readNull() {
+				closedVectorPath.Commands = nil
+			} else {
+				closedVectorPath.Commands = make([]VectorPathCommand, 0)
+				err = r.readArray(func() (err error) {
+					var item0 VectorPathCommand
+					item0, err = decodeVectorPathCommand(r)
+					closedVectorPath.Commands = append(closedVectorPath.Commands, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (closedVectorPath *ClosedVectorPath) Clone() *ClosedVectorPath {
+	if closedVectorPath == nil {
+		return nil
+	}
+	clone := *closedVectorPath
+	if closedVectorPath.Commands != nil {
+		clone.Commands = make([]VectorPathCommand, len(closedVectorPath.Commands))
+		for i0 := range closedVectorPath.Commands {
+			clone.Commands[i0] = cloneVectorPathCommand(closedVectorPath.Commands[i0])
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (closedVectorPath *ClosedVectorPath) Equal(other *ClosedVectorPath) bool {
+	if closedVectorPath == nil || other == nil {
+		return closedVectorPath == other
+	}
+	if len(closedVectorPath.Commands) != len(other.Commands) {
+		return false
+	}
+	for i0 := range closedVectorPath.Commands {
+		if !equalVectorPathCommand(closedVectorPath.Commands[i0], other.Commands[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (closedVectorPath *ClosedVectorPath) Diff(other *ClosedVectorPath) []Change {
+	return closedVectorPath.diff("", other, nil)
+}
+
+func (closedVectorPath *ClosedVectorPath) diff(path string, other *ClosedVectorPath, changes []Change) []Change {
+	if closedVectorPath == nil || other == nil {
+		if closedVectorPath != other {
+			changes = append(changes, Change{Path: path, Old: closedVectorPath, New: other})
+		}
+		return changes
+	}
+	if len(closedVectorPath.Commands) != len(other.Commands) {
+		changes = append(changes, Change{Path: fieldPath(path, "commands"), Old: closedVectorPath.Commands, New: other.Commands})
+	} else {
+		for i0 := range closedVectorPath.Commands {
+			changes = diffVectorPathCommand(indexPath(fieldPath(path, "commands"), i0), closedVectorPath.Commands[i0], other.Commands[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (closedVectorPath *ClosedVectorPath) String() string {
+	return objectString(closedVectorPath)
+}
+
+// LogValue logs the object as its summary
+func (closedVectorPath *ClosedVectorPath) LogValue() slog.Value {
+	return slog.StringValue(objectString(closedVectorPath))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (closedVectorPath *ClosedVectorPath) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, closedVectorPath)
+}
+
+func (closedVectorPath *ClosedVectorPath) printText(p *printer) {
+	if closedVectorPath == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ClosedVectorPath")
+	p.vector("commands", len(closedVectorPath.Commands), func(i0 int) {
+		p.object("commands", closedVectorPath.Commands[i0])
+	})
+	p.endObject(state)
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// writeClone writes Clone, Equal and Diff of a type, which follow its interface, pointer and vector fields
+func (g *generator) writeClone(b *bytes.Buffer, t *Type) {
+	structName := upperFirst(t.Name)
+	recv := receiverName(t)
+
+	b.WriteString("// Clone returns a deep copy of the object\n")
+	fmt.Fprintf(b, "func (%s *%s) Clone() *%s {\n", recv, structName, structName)
+	fmt.Fprintf(b, "\tif %s == nil {\n\t\treturn nil\n\t}\n", recv)
+	fmt.Fprintf(b, "\tclone := *%s\n", recv)
+	for _, field := range t.Fields {
+		b.WriteString(indent(g.cloneValue("clone."+goName(field.Name), recv+"."+goName(field.Name), field.Type, 0, false), 1))
+	}
+	b.WriteString("\treturn &clone\n}\n\n")
+
+	b.WriteString("// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra\n")
+	fmt.Fprintf(b, "func (%s *%s) Equal(other *%s) bool {\n", recv, structName, structName)
+	fmt.Fprintf(b, "\tif %s == nil || other == nil {\n\t\treturn %s == other\n\t}\n", recv, recv)
+	for _, field := range t.Fields {
+		b.WriteString(indent(g.equalValue(recv+"."+goName(field.Name), "other."+goName(field.Name), field.Type, 0, false), 1))
+	}
+	b.WriteString("\treturn true\n}\n\n")
+
+	b.WriteString("// Diff returns the fields which changed from the object to other, with their JSON paths\n")
+	fmt.Fprintf(b, "func (%s *%s) Diff(other *%s) []Change {\n", recv, structName, structName)
+	fmt.Fprintf(b, "\treturn %s.diff(\"\", other, nil)\n}\n\n", recv)
+
+	fmt.Fprintf(b, "func (%s *%s) diff(path string, other *%s, changes []Change) []Change {\n", recv, structName, structName)
+	fmt.Fprintf(b, "\tif %s == nil || other == nil {\n", recv)
+	fmt.Fprintf(b, "\t\tif %s != other {\n", recv)
+	fmt.Fprintf(b, "\t\t\tchanges = append(changes, Change{Path: path, Old: %s, New: other})\n\t\t}\n", recv)
+	b.WriteString("\t\treturn changes\n\t}\n")
+	for _, field := range t.Fields {
+		path := fmt.Sprintf("fieldPath(path, %q)", field.Name)
+		b.WriteString(indent(g.diffValue(path, recv+"."+goName(field.Name), "other."+goName(field.Name), field.Type, 0, false), 1))
+	}
+	b.WriteString("\treturn changes\n}\n\n")
+}
+
+// cloneValue returns the statements deep copying src into dst, which already holds a shallow copy of src
+func (g *generator) cloneValue(dst string, src string, ref *TypeRef, depth int, isElem bool) string {
+	if ref.IsVector() {
+		var s strings.Builder
+		fmt.Fprintf(&s, "if %s != nil {\n", src)
+		fmt.Fprintf(&s, "\t%s = make(%s, len(%s))\n", dst, g.goType(ref), src)
+		if elem := g.cloneValue("x", "x", ref.Elem, depth+1, true); elem == "x = x\n" {
+			fmt.Fprintf(&s, "\tcopy(%s, %s)\n", dst, src)
+		} else {
+			index := fmt.Sprintf("i%d", depth)
+			fmt.Fprintf(&s, "\tfor %s := range %s {\n", index, src)
+			s.WriteString(indent(g.cloneValue(dst+"["+index+"]", src+"["+index+"]", ref.Elem, depth+1, true), 2))
+			s.WriteString("\t}\n")
+		}
+		s.WriteString("}\n")
+		return s.String()
+	}
+
+	switch ref.Name {
+	case "bytes":
+		return fmt.Sprintf("if %s != nil {\n\t%s = append([]byte{}, %s...)\n}\n", src, dst, src)
+	case "int32", "int53", "int64", "double", "string", "Bool":
+		if isElem {
+			return fmt.Sprintf("%s = %s\n", dst, src)
+		}
+		return ""
+	}
+
+	if g.isAbstract(ref.Name) {
+		return fmt.Sprintf("%s = clone%s(%s)\n", dst, ref.Name, src)
+	}
+	if isElem {
+		return fmt.Sprintf("%s = *%s.Clone()\n", dst, src)
+	}
+	return fmt.Sprintf("%s = %s.Clone()\n", dst, src)
+}
+
+// equalValue returns the statements returning false if the values of two expressions differ
+func (g *generator) equalValue(a string, b string, ref *TypeRef, depth int, isElem bool) string {
+	if ref.IsVector() {
+		index := fmt.Sprintf("i%d", depth)
+		var s strings.Builder
+		fmt.Fprintf(&s, "if len(%s) != len(%s) {\n\treturn false\n}\n", a, b)
+		fmt.Fprintf(&s, "for %s := range %s {\n", index, a)
+		s.WriteString(indent(g.equalValue(a+"["+index+"]", b+"["+index+"]", ref.Elem, depth+1, true), 1))
+		s.WriteString("}\n")
+		return s.String()
+	}
+
+	switch ref.Name {
+	case "bytes":
+		return fmt.Sprintf("if !equalBytes(%s, %s) {\n\treturn false\n}\n", a, b)
+	case "int32", "int53", "int64", "double", "string", "Bool":
+		return fmt.Sprintf("if %s != %s {\n\treturn false\n}\n", a, b)
+	}
+
+	if g.isAbstract(ref.Name) {
+		return fmt.Sprintf("if !equal%s(%s, %s) {\n\treturn false\n}\n", ref.Name, a, b)
+	}
+	if isElem {
+		return fmt.Sprintf("if !%s.Equal(&%s) {\n\treturn false\n}\n", a, b)
+	}
+	return fmt.Sprintf("if !%s.Equal(%s) {\n\treturn false\n}\n", a, b)
+}
+
+// diffValue returns the statements appending the changes between the values of two expressions to changes
+func (g *generator) diffValue(path string, a string, b string, ref *TypeRef, depth int, isElem bool) string {
+	change := fmt.Sprintf("changes = append(changes, Change{Path: %s, Old: %s, New: %s})\n", path, a, b)
+
+	if ref.IsVector() {
+		index := fmt.Sprintf("i%d", depth)
+		var s strings.Builder
+		fmt.Fprintf(&s, "if len(%s) != len(%s) {\n", a, b)
+		s.WriteString(indent(change, 1))
+		fmt.Fprintf(&s, "} else {\n\tfor %s := range %s {\n", index, a)
+		itemPath := fmt.Sprintf("indexPath(%s, %s)", path, index)
+		s.WriteString(indent(g.diffValue(itemPath, a+"["+index+"]", b+"["+index+"]", ref.Elem, depth+1, true), 2))
+		s.WriteString("\t}\n}\n")
+		return s.String()
+	}
+
+	switch ref.Name {
+	case "bytes":
+		return fmt.Sprintf("if !equalBytes(%s, %s) {\n", a, b) + indent(change, 1) + "}\n"
+	case "int32", "int53", "int64", "double", "string", "Bool":
+		return fmt.Sprintf("if %s != %s {\n", a, b) + indent(change, 1) + "}\n"
+	}
+
+	if g.isAbstract(ref.Name) {
+		return fmt.Sprintf("changes = diff%s(%s, %s, %s, changes)\n", ref.Name, path, a, b)
+	}
+	if isElem {
+		return fmt.Sprintf("changes = %s.diff(%s, &%s, changes)\n", a, path, b)
+	}
+	return fmt.Sprintf("changes = %s.diff(%s, %s, changes)\n", a, path, b)
+}
+
+// writeCloneClass writes the clone, equal and diff helpers of an abstract class, used by the fields of its type
+// and its Unknown type's Clone and Equal
+func (g *generator) writeCloneClass(b *bytes.Buffer, class *Class) {
+	name := class.Name
+	value := lowerFirst(name)
+	unknown := "unknown" + name
+
+	b.WriteString("// Clone returns a deep copy of the object\n")
+	fmt.Fprintf(b, "func (%s *Unknown%s) Clone() *Unknown%s {\n", unknown, name, name)
+	fmt.Fprintf(b, "\tif %s == nil {\n\t\treturn nil\n\t}\n", unknown)
+	fmt.Fprintf(b, "\treturn &Unknown%s{Unknown: %s.Unknown.clone()}\n}\n\n", name, unknown)
+
+	b.WriteString("// Equal reports whether the object has the same type and raw JSON as other\n")
+	fmt.Fprintf(b, "func (%s *Unknown%s) Equal(other *Unknown%s) bool {\n", unknown, name, name)
+	fmt.Fprintf(b, "\tif %s == nil || other == nil {\n\t\treturn %s == other\n\t}\n", unknown, unknown)
+	fmt.Fprintf(b, "\treturn %s.Unknown.equal(other.Unknown)\n}\n\n", unknown)
+
+	fmt.Fprintf(b, "func clone%s(%s %s) %s {\n", name, value, name, name)
+	fmt.Fprintf(b, "\tswitch value := %s.(type) {\n", value)
+	for _, t := range class.Types {
+		fmt.Fprintf(b, "\tcase *%s:\n\t\treturn value.Clone()\n", upperFirst(t.Name))
+	}
+	fmt.Fprintf(b, "\tcase *Unknown%s:\n\t\treturn value.Clone()\n", name)
+	fmt.Fprintf(b, "\t}\n\treturn %s\n}\n\n", value)
+
+	fmt.Fprintf(b, "func equal%s(a %s, b %s) bool {\n", name, name, name)
+	b.WriteString("\tswitch a := a.(type) {\n\tcase nil:\n\t\treturn b == nil\n")
+	for _, t := range class.Types {
+		fmt.Fprintf(b, "\tcase *%s:\n", upperFirst(t.Name))
+		fmt.Fprintf(b, "\t\tb, ok := b.(*%s)\n\t\treturn ok && a.Equal(b)\n", upperFirst(t.Name))
+	}
+	fmt.Fprintf(b, "\tcase *Unknown%s:\n", name)
+	fmt.Fprintf(b, "\t\tb, ok := b.(*Unknown%s)\n\t\treturn ok && a.Equal(b)\n", name)
+	b.WriteString("\t}\n\treturn false\n}\n\n")
+
+	fmt.Fprintf(b, "func diff%s(path string, a %s, b %s, changes []Change) []Change {\n", name, name, name)
+	b.WriteString("\tswitch a := a.(type) {\n")
+	for _, t := range class.Types {
+		fmt.Fprintf(b, "\tcase *%s:\n", upperFirst(t.Name))
+		fmt.Fprintf(b, "\t\tif b, ok := b.(*%s); ok {\n\t\t\treturn a.diff(path, b, changes)\n\t\t}\n", upperFirst(t.Name))
+	}
+	b.WriteString("\t}\n")
+	fmt.Fprintf(b, "\tif !equal%s(a, b) {\n", name)
+	b.WriteString("\t\tchanges = append(changes, Change{Path: path, Old: a, New: b})\n\t}\n")
+	b.WriteString("\treturn changes\n}\n\n")
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// receiverName returns the receiver of the generated methods of a type,
+// renamed if it would shadow a predeclared identifier used in their body
+func receiverName(t *Type) string {
+	recv := lowerFirst(upperFirst(t.Name))
+	if recv == "error" {
+		return "errorObj"
+	}
+	return recv
+}
+
+// writeMarshalers writes MarshalJSON and UnmarshalJSON of a type, based on its reflection-free encoder and decoder
+func (g *generator) writeMarshalers(b *bytes.Buffer, t *Type) {
+	structName := upperFirst(t.Name)
+	recv := receiverName(t)
+
+	b.WriteString("// MarshalJSON marshals to json\n")
+	fmt.Fprintf(b, "func (%s *%s) MarshalJSON() ([]byte, error) {\n", recv, structName)
+	fmt.Fprintf(b, "\treturn %s.appendJSON(nil)\n}\n\n", recv)
+
+	b.WriteString("// UnmarshalJSON unmarshal to json\n")
+	fmt.Fprintf(b, "func (%s *%s) UnmarshalJSON(b []byte) error {\n", recv, structName)
+	fmt.Fprintf(b, "\treturn unmarshalFast(b, %s)\n}\n\n", recv)
+}
+
+// writeEncoder writes appendJSON of a type, which appends its JSON without reflection
+func (g *generator) writeEncoder(b *bytes.Buffer, t *Type) {
+	structName := upperFirst(t.Name)
+	recv := receiverName(t)
+
+	fmt.Fprintf(b, "func (%s *%s) appendJSON(b []byte) ([]byte, error) {\n", recv, structName)
+	fmt.Fprintf(b, "\tif %s == nil {\n\t\treturn append(b, \"null\"...), nil\n\t}\n", recv)
+	if g.encoderUsesErr(t) {
+		b.WriteString("\tvar err error\n")
+	}
+	b.WriteString("\tb = append(b, `{\"@type\":`...)\n")
+	fmt.Fprintf(b, "\tb = appendJSONString(b, %s.tdCommon.Type)\n", recv)
+	b.WriteString("\tb = append(b, `,\"@extra\":`...)\n")
+	fmt.Fprintf(b, "\tb = appendJSONString(b, %s.tdCommon.Extra)\n", recv)
+	for _, field := range t.Fields {
+		fmt.Fprintf(b, "\tb = append(b, `,\"%s\":`...)\n", field.Name)
+		b.WriteString(indent(g.encodeValue(recv+"."+goName(field.Name), field.Type, 0, false), 1))
+	}
+	b.WriteString("\treturn append(b, '}'), nil\n}\n\n")
+}
+
+// encoderUsesErr reports whether the encoder of a type calls encoders which can fail
+func (g *generator) encoderUsesErr(t *Type) bool {
+	for _, field := range t.Fields {
+		if strings.Contains(g.encodeValue("x", field.Type, 0, false), "err") {
+			return true
+		}
+	}
+	return false
+}
+
+// encodeValue returns the statements appending the JSON of the value of an expression
+func (g *generator) encodeValue(expr string, ref *TypeRef, depth int, isElem bool) string {
+	const checkErr = "if err != nil {\n\treturn nil, err\n}\n"
+
+	if ref.IsVector() {
+		index := fmt.Sprintf("i%d", depth)
+		item := fmt.Sprintf("%s[%s]", expr, index)
+		var s strings.Builder
+		fmt.Fprintf(&s, "if %s == nil {\n\tb = append(b, \"null\"...)\n} else {\n", expr)
+		s.WriteString("\tb = append(b, '[')\n")
+		fmt.Fprintf(&s, "\tfor %s := range %s {\n", index, expr)
+		fmt.Fprintf(&s, "\t\tif %s > 0 {\n\t\t\tb = append(b, ',')\n\t\t}\n", index)
+		s.WriteString(indent(g.encodeValue(item, ref.Elem, depth+1, true), 2))
+		s.WriteString("\t}\n\tb = append(b, ']')\n}\n")
+		return s.String()
+	}
+
+	switch ref.Name {
+	case "int32", "int53":
+		return fmt.Sprintf("b = appendJSONInt(b, int64(%s))\n", expr)
+	case "int64":
+		return fmt.Sprintf("b = appendJSONInt64String(b, int64(%s))\n", expr)
+	case "double":
+		return fmt.Sprintf("b, err = appendJSONFloat(b, %s)\n", expr) + checkErr
+	case "string":
+		return fmt.Sprintf("b = appendJSONString(b, %s)\n", expr)
+	case "bytes":
+		return fmt.Sprintf("b = appendJSONBytes(b, %s)\n", expr)
+	case "Bool":
+		return fmt.Sprintf("b = appendJSONBool(b, %s)\n", expr)
+	}
+
+	if g.isAbstract(ref.Name) {
+		return fmt.Sprintf("b, err = appendJSONValue(b, %s)\n", expr) + checkErr
+	}
+	return fmt.Sprintf("b, err = %s.appendJSON(b)\n", expr) + checkErr
+}
+
+// writeDecoder writes decodeJSON of a type, which decodes its JSON without reflection
+func (g *generator) writeDecoder(b *bytes.Buffer, t *Type) {
+	structName := upperFirst(t.Name)
+	recv := receiverName(t)
+
+	fmt.Fprintf(b, "func (%s *%s) decodeJSON(r *jsonReader) error {\n", recv, structName)
+	b.WriteString("\treturn r.readObject(func(key []byte) (err error) {\n")
+	b.WriteString("\t\tswitch string(key) {\n")
+	b.WriteString("\t\tcase \"@type\":\n")
+	fmt.Fprintf(b, "\t\t\t%s.tdCommon.Type, err = r.readString()\n", recv)
+	b.WriteString("\t\tcase \"@extra\":\n")
+	fmt.Fprintf(b, "\t\t\t%s.tdCommon.Extra, err = r.readString()\n", recv)
+	for _, field := range t.Fields {
+		fmt.Fprintf(b, "\t\tcase \"%s\":\n", field.Name)
+		b.WriteString(indent(g.decodeValue(recv+"."+goName(field.Name), field.Type, 0, false), 3))
+	}
+	b.WriteString("\t\tdefault:\n\t\t\terr = r.skip()\n")
+	b.WriteString("\t\t}\n\t\treturn\n\t})\n}\n\n")
+}
+
+// decodeValue returns the statements decoding the next value into an expression, setting err
+func (g *generator) decodeValue(expr string, ref *TypeRef, depth int, isElem bool) string {
+	if ref.IsVector() {
+		item := fmt.Sprintf("item%d", depth)
+		var s strings.Builder
+		fmt.Fprintf(&s, "if r.readNull() {\n\t%s = nil\n} else {\n", expr)
+		fmt.Fprintf(&s, "\t%s = make(%s, 0)\n", expr, g.goType(ref))
+		s.WriteString("\terr = r.readArray(func() (err error) {\n")
+		fmt.Fprintf(&s, "\t\tvar %s %s\n", item, g.goElemType(ref.Elem))
+		s.WriteString(indent(g.decodeValue(item, ref.Elem, depth+1, true), 2))
+		fmt.Fprintf(&s, "\t\t%s = append(%s, %s)\n", expr, expr, item)
+		s.WriteString("\t\treturn\n\t})\n}\n")
+		return s.String()
+	}
+
+	switch ref.Name {
+	case "int32":
+		return fmt.Sprintf("%s, err = r.readInt32()\n", expr)
+	case "int53":
+		return fmt.Sprintf("%s, err = r.readInt64()\n", expr)
+	case "int64":
+		return fmt.Sprintf("%s, err = r.readJSONInt64()\n", expr)
+	case "double":
+		return fmt.Sprintf("%s, err = r.readFloat64()\n", expr)
+	case "string":
+		return fmt.Sprintf("%s, err = r.readString()\n", expr)
+	case "bytes":
+		return fmt.Sprintf("%s, err = r.readBytes()\n", expr)
+	case "Bool":
+		return fmt.Sprintf("%s, err = r.readBool()\n", expr)
+	}
+
+	if g.isAbstract(ref.Name) {
+		return fmt.Sprintf("%s, err = decode%s(r)\n", expr, ref.Name)
+	}
+	if isElem {
+		return fmt.Sprintf("err = %s.decodeJSON(r)\n", expr)
+	}
+	return fmt.Sprintf("if r.readNull() {\n\t%s = nil\n} else {\n\t%s = new(%s)\n\terr = %s.decodeJSON(r)\n}\n",
+		expr, expr, g.typeGoName(ref.Name), expr)
+}
+
+// writeInterfaceDecoder writes the reader based decoder of an abstract class, used by the decoders of the types
+func (g *generator) writeInterfaceDecoder(b *bytes.Buffer, class *Class) {
+	name := class.Name
+
+	fmt.Fprintf(b, "func decode%s(r *jsonReader) (%s, error) {\n", name, name)
+	b.WriteString("\trawMsg, err := r.readRawMessage()\n")
+	b.WriteString("\tif err != nil {\n\t\treturn nil, err\n\t}\n")
+	fmt.Fprintf(b, "\treturn unmarshal%s(rawMsg)\n}\n\n", name)
+}
+
+// indent indents every line of code by n tabs
+func indent(code string, n int) string {
+	prefix := strings.Repeat("\t", n)
+	lines := strings.SplitAfter(code, "\n")
+	for i, line := range lines {
+		if line != "" && line != "\n" {
+			lines[i] = prefix + line
+		}
+	}
+	return strings.Join(lines, "")
+}
@@ -0,0 +1,424 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+)
+
+// generator renders the Go bindings of a parsed schema
+type generator struct {
+	schema    *Schema
+	pkg       string
+	classes   map[string]*Class
+	types     map[string]*Type
+	byClass   map[string]*Type
+	functions map[string][]*Function
+	slices    map[string]bool
+	fields    map[string]bool
+}
+
+func newGenerator(schema *Schema, pkg string) *generator {
+	g := &generator{
+		schema:    schema,
+		pkg:       pkg,
+		classes:   make(map[string]*Class),
+		types:     make(map[string]*Type),
+		byClass:   make(map[string]*Type),
+		functions: make(map[string][]*Function),
+		slices:    make(map[string]bool),
+		fields:    make(map[string]bool),
+	}
+
+	for _, class := range schema.Classes {
+		g.classes[class.Name] = class
+	}
+	for _, t := range schema.Types {
+		g.types[t.Name] = t
+		if _, isAbstract := g.classes[t.Class]; !isAbstract {
+			g.byClass[t.Class] = t
+		}
+		for _, field := range t.Fields {
+			if g.isInterfaceSlice(field.Type) {
+				g.slices[field.Type.Elem.Name] = true
+			}
+			elem := field.Type
+			for elem.IsVector() {
+				elem = elem.Elem
+			}
+			if g.isAbstract(elem.Name) {
+				g.fields[elem.Name] = true
+			}
+		}
+	}
+	for _, function := range schema.Functions {
+		g.functions[function.Result] = append(g.functions[function.Result], function)
+	}
+
+	return g
+}
+
+// Files renders every binding file, keyed by file name
+func (g *generator) Files() (map[string][]byte, error) {
+	files := make(map[string][]byte)
+
+	for _, class := range g.schema.Classes {
+		src, err := g.render(func(b *bytes.Buffer) bool { return g.writeClass(b, class) })
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", class.Name, err)
+		}
+		files[fileName(class.Name)] = src
+	}
+
+	for _, t := range g.schema.Types {
+		if g.isAbstract(t.Class) {
+			continue
+		}
+		src, err := g.render(func(b *bytes.Buffer) bool { return g.writeObject(b, t) })
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", t.Name, err)
+		}
+		files[fileName(t.Class)] = src
+	}
+
+	src, err := g.render(func(b *bytes.Buffer) bool { return g.writeTdMessage(b) })
+	if err != nil {
+		return nil, fmt.Errorf("tdMessage: %v", err)
+	}
+	files["tdMessage.go"] = src
+
+	src, err = g.render(func(b *bytes.Buffer) bool { return g.writeRegistry(b) })
+	if err != nil {
+		return nil, fmt.Errorf("registry: %v", err)
+	}
+	files["registry.go"] = src
+
+	return files, nil
+}
+
+// render writes the package clause and imports in front of the body and gofmts the result
+func (g *generator) render(body func(b *bytes.Buffer) bool) ([]byte, error) {
+	var content bytes.Buffer
+	needsFmt := body(&content) || strings.Contains(content.String(), "fmt.")
+	needsJSON := strings.Contains(content.String(), "json.")
+	needsSlog := strings.Contains(content.String(), "slog.")
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "package %s\n\n", g.pkg)
+	if needsJSON || needsFmt || needsSlog {
+		b.WriteString("import (\n")
+		if needsJSON {
+			b.WriteString("\t\"encoding/json\"\n")
+		}
+		if needsFmt {
+			b.WriteString("\t\"fmt\"\n")
+		}
+		if needsSlog {
+			b.WriteString("\t\"log/slog\"\n")
+		}
+		b.WriteString(")\n\n")
+	}
+	b.Write(content.Bytes())
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%v\n%s", err, b.String())
+	}
+	return src, nil
+}
+
+// writeClass writes an abstract class with all of its variants and the type of its unknown variants;
+// it reports whether fmt is used
+func (g *generator) writeClass(b *bytes.Buffer, class *Class) bool {
+	name := class.Name
+
+	fmt.Fprintf(b, "// %s %s\n", name, class.Description)
+	fmt.Fprintf(b, "type %s interface {\n\tGet%sEnum() %sEnum\n\tAccept(visitor %sVisitor)\n}\n\n", name, name, name, name)
+	fmt.Fprintf(b, "// %sEnum Alias for abstract %s 'Sub-Classes', used as constant-enum here\n", name, name)
+	fmt.Fprintf(b, "type %sEnum string\n\n", name)
+
+	fmt.Fprintf(b, "// %s enums\nconst (\n", name)
+	for _, t := range class.Types {
+		fmt.Fprintf(b, "\t%sType %sEnum = \"%s\"\n", upperFirst(t.Name), name, t.Name)
+	}
+	b.WriteString(")\n\n")
+
+	fmt.Fprintf(b, "// Unknown%s is %s %s of a type unknown to the bindings, e.g. sent by a newer TDLib\n", name, article(name), name)
+	fmt.Fprintf(b, "type Unknown%s struct {\n\tUnknown\n}\n\n", name)
+	fmt.Fprintf(b, "// Get%sEnum return the enum type of this object\n", name)
+	fmt.Fprintf(b, "func (unknown%s *Unknown%s) Get%sEnum() %sEnum {\n", name, name, name, name)
+	fmt.Fprintf(b, "\treturn %sEnum(unknown%s.Type)\n}\n\n", name, name)
+	g.writeAccept(b, class, "unknown"+name, "Unknown"+name)
+	g.writeUnknownPrinter(b, class)
+
+	fmt.Fprintf(b, "func unmarshal%s(rawMsg *json.RawMessage) (%s, error) {\n\n", name, name)
+	b.WriteString("\tif rawMsg == nil {\n\t\treturn nil, nil\n\t}\n")
+	b.WriteString("\ttypeName, err := sniffType(*rawMsg)\n")
+	b.WriteString("\tif err != nil {\n\t\treturn nil, err\n\t}\n\n")
+	fmt.Fprintf(b, "\tswitch %sEnum(typeName) {\n", name)
+	for _, t := range class.Types {
+		varName := t.Name
+		fmt.Fprintf(b, "\tcase %sType:\n", upperFirst(t.Name))
+		fmt.Fprintf(b, "\t\tvar %s %s\n", varName, upperFirst(t.Name))
+		fmt.Fprintf(b, "\t\terr := jsonUnmarshal(*rawMsg, &%s)\n", varName)
+		fmt.Fprintf(b, "\t\treturn &%s, err\n\n", varName)
+	}
+	b.WriteString("\tdefault:\n")
+	b.WriteString("\t\tunknown, err := decodeUnknown(typeName, *rawMsg)\n")
+	b.WriteString("\t\tif err != nil {\n\t\t\treturn nil, err\n\t\t}\n")
+	fmt.Fprintf(b, "\t\treturn &Unknown%s{Unknown: unknown}, nil\n", name)
+	b.WriteString("\t}\n}\n\n")
+
+	if g.slices[name] {
+		g.writeSliceDecoder(b, class)
+	}
+	if g.fields[name] {
+		g.writeInterfaceDecoder(b, class)
+	}
+
+	for _, t := range class.Types {
+		g.writeType(b, t)
+		fmt.Fprintf(b, "// Get%sEnum return the enum type of this object\n", name)
+		fmt.Fprintf(b, "func (%s *%s) Get%sEnum() %sEnum {\n", lowerFirst(t.Name), upperFirst(t.Name), name, name)
+		fmt.Fprintf(b, "\treturn %sType\n}\n\n", upperFirst(t.Name))
+		g.writeAccept(b, class, lowerFirst(t.Name), upperFirst(t.Name))
+	}
+
+	g.writeVisitor(b, class)
+	g.writeMatch(b, class)
+	g.writeCloneClass(b, class)
+
+	for _, function := range g.functions[name] {
+		g.writeFunction(b, function)
+	}
+
+	return len(g.functions[name]) > 0
+}
+
+// writeTdMessage writes the decoder of objects of any type, used by the receive loop
+func (g *generator) writeTdMessage(b *bytes.Buffer) bool {
+	b.WriteString("// unmarshalTdMessage decodes an object of the given @type into its generated type\n")
+	b.WriteString("func unmarshalTdMessage(typeName string, rawMsg []byte) (TdMessage, error) {\n")
+	b.WriteString("\tswitch typeName {\n")
+	for _, t := range g.schema.Types {
+		fmt.Fprintf(b, "\tcase \"%s\":\n", t.Name)
+		fmt.Fprintf(b, "\t\tvar %s %s\n", t.Name, upperFirst(t.Name))
+		fmt.Fprintf(b, "\t\terr := jsonUnmarshal(rawMsg, &%s)\n", t.Name)
+		fmt.Fprintf(b, "\t\treturn &%s, err\n\n", t.Name)
+	}
+	b.WriteString("\tdefault:\n")
+	b.WriteString("\t\tunknown, err := decodeUnknown(typeName, rawMsg)\n")
+	b.WriteString("\t\tif err != nil {\n\t\t\treturn nil, err\n\t\t}\n")
+	b.WriteString("\t\treturn &unknown, nil\n")
+	b.WriteString("\t}\n}\n")
+
+	return false
+}
+
+// writeSliceDecoder writes the decoder of vector<Class> fields
+func (g *generator) writeSliceDecoder(b *bytes.Buffer, class *Class) {
+	name := class.Name
+
+	fmt.Fprintf(b, "func unmarshal%sSlice(rawMsg *json.RawMessage) ([]%s, error) {\n\n", name, name)
+	b.WriteString("\tif rawMsg == nil {\n\t\treturn nil, nil\n\t}\n")
+	b.WriteString("\tvar rawItems []*json.RawMessage\n")
+	b.WriteString("\terr := jsonUnmarshal(*rawMsg, &rawItems)\n")
+	b.WriteString("\tif err != nil {\n\t\treturn nil, err\n\t}\n\n")
+	fmt.Fprintf(b, "\titems := make([]%s, 0, len(rawItems))\n", name)
+	b.WriteString("\tfor _, rawItem := range rawItems {\n")
+	fmt.Fprintf(b, "\t\titem, err := unmarshal%s(rawItem)\n", name)
+	b.WriteString("\t\tif err != nil {\n\t\t\treturn nil, err\n\t\t}\n")
+	b.WriteString("\t\titems = append(items, item)\n\t}\n\n")
+	b.WriteString("\treturn items, nil\n}\n\n")
+}
+
+// writeObject writes a standalone object with the functions returning it; it reports whether fmt is used
+func (g *generator) writeObject(b *bytes.Buffer, t *Type) bool {
+	g.writeType(b, t)
+
+	functions := g.functions[t.Class]
+	for _, function := range functions {
+		g.writeFunction(b, function)
+	}
+
+	return len(functions) > 0
+}
+
+// writeType writes the struct of a constructor with its MessageType, constructor and decoder
+func (g *generator) writeType(b *bytes.Buffer, t *Type) {
+	structName := upperFirst(t.Name)
+	recv := lowerFirst(structName)
+
+	fmt.Fprintf(b, "// %s %s\n", structName, t.Description)
+	fmt.Fprintf(b, "type %s struct {\n\ttdCommon\n", structName)
+	for _, field := range t.Fields {
+		b.WriteString(g.structField(field))
+		b.WriteString("\n")
+	}
+	b.WriteString("}\n\n")
+
+	fmt.Fprintf(b, "// MessageType return the string telegram-type of %s\n", structName)
+	fmt.Fprintf(b, "func (%s *%s) MessageType() string {\n\treturn \"%s\"\n}\n\n", recv, structName, t.Name)
+
+	fmt.Fprintf(b, "// New%s creates a new %s\n//\n", structName, structName)
+	params := make([]string, 0, len(t.Fields))
+	for _, field := range t.Fields {
+		fmt.Fprintf(b, "// @param %s %s\n", paramName(field.Name), field.Description)
+		params = append(params, paramName(field.Name)+" "+g.goType(field.Type))
+	}
+	fmt.Fprintf(b, "func New%s(%s) *%s {\n", structName, strings.Join(params, ", "), structName)
+	fmt.Fprintf(b, "\t%sTemp := %s{\n", recv, structName)
+	fmt.Fprintf(b, "\t\ttdCommon: tdCommon{Type: \"%s\"},\n", t.Name)
+	for _, field := range t.Fields {
+		fmt.Fprintf(b, "\t\t%s: %s,\n", goName(field.Name), paramName(field.Name))
+	}
+	fmt.Fprintf(b, "\t}\n\n\treturn &%sTemp\n}\n\n", recv)
+
+	g.writeMarshalers(b, t)
+	g.writeEncoder(b, t)
+	g.writeDecoder(b, t)
+	g.writeClone(b, t)
+	g.writePrinter(b, t)
+}
+
+// writeFunction writes the Client method sending a TDLib function, and the package-level
+// function executing it if it can be called synchronously
+func (g *generator) writeFunction(b *bytes.Buffer, function *Function) {
+	methodName := upperFirst(function.Name)
+
+	fmt.Fprintf(b, "// %s %s\n", methodName, function.Description)
+	g.writeFunctionBody(b, function, "func (client *Client) "+methodName, "client.SendAndCatch")
+
+	if isSynchronous(function) {
+		fmt.Fprintf(b, "// Execute%s Synchronously executes %s, without a client. %s\n", methodName, methodName, function.Description)
+		g.writeFunctionBody(b, function, "func Execute"+methodName, "execute")
+	}
+}
+
+// isSynchronous reports whether a function can be executed synchronously with td_json_client_execute
+func isSynchronous(function *Function) bool {
+	return strings.Contains(function.Description, "Can be called synchronously")
+}
+
+// writeFunctionBody writes the parameters and the body of a function sending the request with call
+func (g *generator) writeFunctionBody(b *bytes.Buffer, function *Function, signature, call string) {
+	params := make([]string, 0, len(function.Params))
+	for _, param := range function.Params {
+		fmt.Fprintf(b, "// @param %s %s\n", paramName(param.Name), param.Description)
+		params = append(params, paramName(param.Name)+" "+g.goType(param.Type))
+	}
+
+	resultType := "*" + g.typeGoName(function.Result)
+	if g.isAbstract(function.Result) {
+		resultType = function.Result
+	}
+
+	fmt.Fprintf(b, "%s(%s) (%s, error) {\n", signature, strings.Join(params, ", "), resultType)
+	fmt.Fprintf(b, "\tresult, err := %s(UpdateData{\n", call)
+	fmt.Fprintf(b, "\t\t\"@type\": \"%s\",\n", function.Name)
+	for _, param := range function.Params {
+		fmt.Fprintf(b, "\t\t\"%s\": %s,\n", param.Name, paramName(param.Name))
+	}
+	b.WriteString("\t})\n\n")
+	b.WriteString("\tif err != nil {\n\t\treturn nil, err\n\t}\n\n")
+	b.WriteString("\tif result.Data[\"@type\"] == \"error\" {\n")
+	b.WriteString("\t\treturn nil, fmt.Errorf(\"error! code: %v msg: %s\", result.Data[\"code\"], result.Data[\"message\"])\n")
+	b.WriteString("\t}\n\n")
+
+	if class, ok := g.classes[function.Result]; ok {
+		varName := resultVarName(lowerFirst(class.Name), function.Params)
+		b.WriteString("\tresultType, _ := result.Data[\"@type\"].(string)\n")
+		fmt.Fprintf(b, "\tswitch %sEnum(resultType) {\n\n", class.Name)
+		for _, t := range class.Types {
+			fmt.Fprintf(b, "\tcase %sType:\n", upperFirst(t.Name))
+			fmt.Fprintf(b, "\t\tvar %s %s\n", varName, upperFirst(t.Name))
+			fmt.Fprintf(b, "\t\terr = jsonUnmarshal(result.Raw, &%s)\n", varName)
+			fmt.Fprintf(b, "\t\treturn &%s, err\n\n", varName)
+		}
+		b.WriteString("\tdefault:\n\t\treturn nil, fmt.Errorf(\"Invalid type\")\n\t}\n}\n\n")
+		return
+	}
+
+	structName := g.typeGoName(function.Result)
+	varName := resultVarName(lowerFirst(structName), function.Params)
+	fmt.Fprintf(b, "\tvar %s %s\n", varName, structName)
+	fmt.Fprintf(b, "\terr = jsonUnmarshal(result.Raw, &%s)\n", varName)
+	fmt.Fprintf(b, "\treturn &%s, err\n}\n\n", varName)
+}
+
+// resultVarName avoids shadowing parameters such as chatId with the decoded result
+func resultVarName(name string, params []*Field) string {
+	for _, param := range params {
+		if strings.Contains(strings.ToLower(paramName(param.Name)), strings.ToLower(name)) {
+			return name + "Dummy"
+		}
+	}
+	return name
+}
+
+func (g *generator) structField(field *Field) string {
+	return strings.TrimRight(fmt.Sprintf("%s %s `json:\"%s\"` // %s", goName(field.Name), g.goType(field.Type), field.Name, field.Description), " ")
+}
+
+func (g *generator) isAbstract(name string) bool {
+	_, ok := g.classes[name]
+	return ok
+}
+
+func (g *generator) isInterface(ref *TypeRef) bool {
+	return !ref.IsVector() && g.isAbstract(ref.Name)
+}
+
+func (g *generator) isInterfaceSlice(ref *TypeRef) bool {
+	return ref.IsVector() && g.isInterface(ref.Elem)
+}
+
+// typeGoName returns the Go struct name of a constructor, referenced either by itself or by its class
+func (g *generator) typeGoName(name string) string {
+	if t, ok := g.types[name]; ok {
+		return upperFirst(t.Name)
+	}
+	if t, ok := g.byClass[name]; ok {
+		return upperFirst(t.Name)
+	}
+	return upperFirst(name)
+}
+
+var scalarTypes = map[string]string{
+	"int32":  "int32",
+	"int53":  "int64",
+	"int64":  "JSONInt64",
+	"double": "float64",
+	"string": "string",
+	"bytes":  "[]byte",
+	"Bool":   "bool",
+}
+
+// goType returns the Go type of a field or parameter; objects are passed by pointer,
+// while vector elements are stored by value
+func (g *generator) goType(ref *TypeRef) string {
+	if ref.IsVector() {
+		return "[]" + g.goElemType(ref.Elem)
+	}
+	if scalar, ok := scalarTypes[ref.Name]; ok {
+		return scalar
+	}
+	if g.isAbstract(ref.Name) {
+		return ref.Name
+	}
+	return "*" + g.typeGoName(ref.Name)
+}
+
+func (g *generator) goElemType(ref *TypeRef) string {
+	if ref.IsVector() {
+		return "[]" + g.goElemType(ref.Elem)
+	}
+	if scalar, ok := scalarTypes[ref.Name]; ok {
+		return scalar
+	}
+	if g.isAbstract(ref.Name) {
+		return ref.Name
+	}
+	return g.typeGoName(ref.Name)
+}
@@ -0,0 +1,73 @@
+// Command tdgen generates the go-tdlib bindings from a TDLib td_api.tl schema.
+//
+// It writes one file per TL class into the output directory: the struct of
+// every constructor with its reflection-free JSON encoder and decoder and its
+// Clone, Equal and Diff methods, the interface, enum, visitor and Match function
+// of every abstract class with its unmarshal function, and a Client method for
+// every function returning it, plus tdMessage.go which decodes objects of any
+// type for the receive loop, and registry.go which describes every class, type
+// and function for Client.Invoke.
+// Files which are not bindings, such as client.go and common.go, are left alone.
+//
+// Usage:
+//
+//	go run ./cmd/tdgen -schema data/td_api.tl -out .
+//
+// To upgrade to a new TDLib release, replace data/td_api.tl with the schema
+// from td/generate/scheme/td_api.tl of that release and run go generate.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+func main() {
+	schemaPath := flag.String("schema", "data/td_api.tl", "path to td_api.tl")
+	outDir := flag.String("out", ".", "directory to write the bindings to")
+	pkg := flag.String("package", "tdlib", "package name of the bindings")
+	flag.Parse()
+
+	if err := run(*schemaPath, *outDir, *pkg); err != nil {
+		fmt.Fprintf(os.Stderr, "tdgen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(schemaPath string, outDir string, pkg string) error {
+	f, err := os.Open(schemaPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	schema, err := ParseSchema(f)
+	if err != nil {
+		return fmt.Errorf("%s: %v", schemaPath, err)
+	}
+
+	files, err := newGenerator(schema, pkg).Files()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := ioutil.WriteFile(filepath.Join(outDir, name), files[name], 0644); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("tdgen: %d classes, %d types, %d functions, %d files written to %s\n",
+		len(schema.Classes), len(schema.Types), len(schema.Functions), len(files), outDir)
+	return nil
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"strings"
+	"unicode"
+)
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return string(unicode.ToUpper(rune(s[0]))) + s[1:]
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return string(unicode.ToLower(rune(s[0]))) + s[1:]
+}
+
+// goName converts a TL snake_case field name to an exported Go name, e.g. street_line1 -> StreetLine1
+func goName(tlName string) string {
+	parts := strings.Split(tlName, "_")
+	for i, part := range parts {
+		parts[i] = upperFirst(part)
+	}
+	return strings.Join(parts, "")
+}
+
+// paramName converts a TL snake_case field name to a Go parameter name,
+// renaming the ones which would clash with a keyword or an imported package
+func paramName(tlName string) string {
+	name := lowerFirst(goName(tlName))
+	switch {
+	case strings.HasPrefix(name, "type"):
+		return "typeParam" + strings.TrimPrefix(name, "type")
+	case strings.HasPrefix(name, "json"):
+		return "jsonString" + strings.TrimPrefix(name, "json")
+	}
+	return name
+}
+
+// fileName returns the name of the file holding the bindings of a class
+func fileName(className string) string {
+	name := strings.Replace(className, "Id", "ID", -1)
+	name = strings.Replace(name, "Url", "URL", -1)
+	name = strings.Replace(name, "Ttl", "TTL", -1)
+	return lowerFirst(name) + ".go"
+}
+
+// article returns the indefinite article of a name
+func article(name string) string {
+	if strings.ContainsRune("AEIOU", rune(name[0])) {
+		return "an"
+	}
+	return "a"
+}
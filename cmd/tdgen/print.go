@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// writePrinter writes String, LogValue, Format and printText of a type, which print its summary or all of its fields
+func (g *generator) writePrinter(b *bytes.Buffer, t *Type) {
+	structName := upperFirst(t.Name)
+	recv := receiverName(t)
+
+	g.writeStringers(b, recv, structName, hasField(t, "format"))
+
+	fmt.Fprintf(b, "func (%s *%s) printText(p *printer) {\n", recv, structName)
+	fmt.Fprintf(b, "\tif %s == nil {\n\t\tp.null()\n\t\treturn\n\t}\n", recv)
+	fmt.Fprintf(b, "\tstate := p.beginObject(%q)\n", structName)
+	for _, field := range t.Fields {
+		b.WriteString(indent(g.printValue(field.Name, recv+"."+goName(field.Name), field.Type, 0, false), 1))
+	}
+	b.WriteString("\tp.endObject(state)\n}\n\n")
+}
+
+// writeStringers writes String, LogValue and, unless a field is called Format, Format of a type
+func (g *generator) writeStringers(b *bytes.Buffer, recv string, structName string, hasFormatField bool) {
+	b.WriteString("// String returns a one-line summary of the object, with secrets and file paths redacted\n")
+	fmt.Fprintf(b, "func (%s *%s) String() string {\n", recv, structName)
+	fmt.Fprintf(b, "\treturn objectString(%s)\n}\n\n", recv)
+
+	b.WriteString("// LogValue logs the object as its summary\n")
+	fmt.Fprintf(b, "func (%s *%s) LogValue() slog.Value {\n", recv, structName)
+	fmt.Fprintf(b, "\treturn slog.StringValue(objectString(%s))\n}\n\n", recv)
+
+	if hasFormatField {
+		return
+	}
+	b.WriteString("// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines\n")
+	fmt.Fprintf(b, "func (%s *%s) Format(f fmt.State, verb rune) {\n", recv, structName)
+	fmt.Fprintf(b, "\tformatObject(f, verb, %s)\n}\n\n", recv)
+}
+
+// printValue returns the statements printing the value of an expression as the field name
+func (g *generator) printValue(name string, expr string, ref *TypeRef, depth int, isElem bool) string {
+	if ref.IsVector() {
+		index := fmt.Sprintf("i%d", depth)
+		var s strings.Builder
+		fmt.Fprintf(&s, "p.vector(%q, len(%s), func(%s int) {\n", name, expr, index)
+		s.WriteString(indent(g.printValue(name, expr+"["+index+"]", ref.Elem, depth+1, true), 1))
+		s.WriteString("})\n")
+		return s.String()
+	}
+
+	switch ref.Name {
+	case "int32", "int53", "int64":
+		return fmt.Sprintf("p.int(%q, int64(%s))\n", name, expr)
+	case "double":
+		return fmt.Sprintf("p.float(%q, %s)\n", name, expr)
+	case "string":
+		return fmt.Sprintf("p.string(%q, %s)\n", name, expr)
+	case "bytes":
+		return fmt.Sprintf("p.bytes(%q, %s)\n", name, expr)
+	case "Bool":
+		return fmt.Sprintf("p.bool(%q, %s)\n", name, expr)
+	}
+
+	if isElem && !g.isAbstract(ref.Name) {
+		return fmt.Sprintf("%s.printText(p)\n", expr)
+	}
+	return fmt.Sprintf("p.object(%q, %s)\n", name, expr)
+}
+
+// writeUnknownPrinter writes String, LogValue, Format and printText of the Unknown type of an abstract class
+func (g *generator) writeUnknownPrinter(b *bytes.Buffer, class *Class) {
+	structName := "Unknown" + class.Name
+	recv := "unknown" + class.Name
+
+	g.writeStringers(b, recv, structName, false)
+
+	fmt.Fprintf(b, "func (%s *%s) printText(p *printer) {\n", recv, structName)
+	fmt.Fprintf(b, "\tif %s == nil {\n\t\tp.null()\n\t\treturn\n\t}\n", recv)
+	fmt.Fprintf(b, "\tstate := p.beginObject(%q)\n", structName)
+	fmt.Fprintf(b, "\tp.string(\"@type\", %s.Type)\n", recv)
+	b.WriteString("\tp.endObject(state)\n}\n\n")
+}
+
+func hasField(t *Type, name string) bool {
+	for _, field := range t.Fields {
+		if field.Name == name {
+			return true
+		}
+	}
+	return false
+}
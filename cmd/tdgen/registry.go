@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+)
+
+// writeRegistry writes the schemas of every class, type and function, used to look up and invoke methods by name
+func (g *generator) writeRegistry(b *bytes.Buffer) bool {
+	b.WriteString("// classSchemas describes every abstract class, in declaration order\n")
+	b.WriteString("var classSchemas = []*ClassSchema{\n")
+	for _, class := range g.schema.Classes {
+		fmt.Fprintf(b, "\t{\n\t\tName: %q,\n\t\tDescription: %s,\n", class.Name, strconv.Quote(class.Description))
+		b.WriteString("\t\tTypes: []string{")
+		for i, t := range class.Types {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(b, "%q", t.Name)
+		}
+		b.WriteString("},\n\t},\n")
+	}
+	b.WriteString("}\n\n")
+
+	b.WriteString("// typeSchemas describes every type, in declaration order\n")
+	b.WriteString("var typeSchemas = []*TypeSchema{\n")
+	for _, t := range g.schema.Types {
+		fmt.Fprintf(b, "\t{\n\t\tName: %q,\n\t\tClass: %q,\n\t\tDescription: %s,\n", t.Name, t.Class, strconv.Quote(t.Description))
+		g.writeParamSchemas(b, "Fields", t.Fields)
+		b.WriteString("\t},\n")
+	}
+	b.WriteString("}\n\n")
+
+	b.WriteString("// methodSchemas describes every function, in declaration order\n")
+	b.WriteString("var methodSchemas = []*MethodSchema{\n")
+	for _, function := range g.schema.Functions {
+		fmt.Fprintf(b, "\t{\n\t\tName: %q,\n\t\tDescription: %s,\n", function.Name, strconv.Quote(function.Description))
+		g.writeParamSchemas(b, "Params", function.Params)
+		fmt.Fprintf(b, "\t\tResult: %q,\n", function.Result)
+		if isSynchronous(function) {
+			b.WriteString("\t\tSynchronous: true,\n")
+		}
+		b.WriteString("\t},\n")
+	}
+	b.WriteString("}\n")
+
+	return false
+}
+
+// writeParamSchemas writes the ParamSchema list of the fields of a type or the parameters of a function
+func (g *generator) writeParamSchemas(b *bytes.Buffer, name string, fields []*Field) {
+	if len(fields) == 0 {
+		return
+	}
+
+	fmt.Fprintf(b, "\t\t%s: []ParamSchema{\n", name)
+	for _, field := range fields {
+		fmt.Fprintf(b, "\t\t\t{Name: %q, Type: %q, Description: %s},\n",
+			field.Name, field.Type.String(), strconv.Quote(field.Description))
+	}
+	b.WriteString("\t\t},\n")
+}
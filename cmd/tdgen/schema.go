@@ -0,0 +1,240 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// Schema holds everything declared in a td_api.tl file, in declaration order
+type Schema struct {
+	Classes   []*Class
+	Types     []*Type
+	Functions []*Function
+}
+
+// Class is an abstract TL class, declared with //@class
+type Class struct {
+	Name        string
+	Description string
+	Types       []*Type
+}
+
+// Type is a TL constructor, either a variant of an abstract class or a standalone object
+type Type struct {
+	Name        string
+	Class       string
+	Description string
+	Fields      []*Field
+}
+
+// Function is a TL function, which is sent to TDLib as a request
+type Function struct {
+	Name        string
+	Result      string
+	Description string
+	Params      []*Field
+}
+
+// Field is a constructor field or a function parameter
+type Field struct {
+	Name        string
+	Type        *TypeRef
+	Description string
+}
+
+// TypeRef is a reference to a TL type; Elem is set for vectors
+type TypeRef struct {
+	Name string
+	Elem *TypeRef
+}
+
+// IsVector reports whether the reference is a vector<T>
+func (ref *TypeRef) IsVector() bool {
+	return ref.Elem != nil
+}
+
+func (ref *TypeRef) String() string {
+	if ref.IsVector() {
+		return "vector<" + ref.Elem.String() + ">"
+	}
+	return ref.Name
+}
+
+// builtinTypes are declared at the top of td_api.tl and have no bindings of their own
+var builtinTypes = map[string]bool{
+	"double":    true,
+	"string":    true,
+	"int32":     true,
+	"int53":     true,
+	"int64":     true,
+	"bytes":     true,
+	"boolFalse": true,
+	"boolTrue":  true,
+	"vector":    true,
+}
+
+var docTagRegexp = regexp.MustCompile(`@([a-z0-9_]+)`)
+
+// ParseSchema parses a td_api.tl schema
+func ParseSchema(r io.Reader) (*Schema, error) {
+	schema := &Schema{}
+	classes := make(map[string]*Class)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 1024*1024), 1024*1024)
+
+	var doc []string
+	isFunction := false
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case line == "":
+			continue
+
+		case line == "---functions---":
+			isFunction = true
+			doc = nil
+
+		case line == "---types---":
+			isFunction = false
+			doc = nil
+
+		case strings.HasPrefix(line, "//@class "):
+			tags := parseDocTags(strings.TrimPrefix(line, "//"))
+			class := &Class{Name: tags["class"], Description: tags["description"]}
+			classes[class.Name] = class
+			schema.Classes = append(schema.Classes, class)
+			doc = nil
+
+		case strings.HasPrefix(line, "//-"):
+			doc = append(doc, strings.TrimSpace(strings.TrimPrefix(line, "//-")))
+
+		case strings.HasPrefix(line, "//"):
+			doc = append(doc, strings.TrimSpace(strings.TrimPrefix(line, "//")))
+
+		default:
+			name, fields, result, err := parseDeclaration(line)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %v", lineNo, err)
+			}
+			if builtinTypes[name] {
+				doc = nil
+				continue
+			}
+
+			tags := parseDocTags(strings.Join(doc, " "))
+			doc = nil
+			for _, field := range fields {
+				field.Description = tags[docTagName(field.Name)]
+			}
+
+			if isFunction {
+				schema.Functions = append(schema.Functions, &Function{
+					Name:        name,
+					Result:      result,
+					Description: tags["description"],
+					Params:      fields,
+				})
+				continue
+			}
+
+			tlType := &Type{
+				Name:        name,
+				Class:       result,
+				Description: tags["description"],
+				Fields:      fields,
+			}
+			schema.Types = append(schema.Types, tlType)
+			if class, ok := classes[result]; ok {
+				class.Types = append(class.Types, tlType)
+			}
+		}
+	}
+
+	return schema, scanner.Err()
+}
+
+// docTagName returns the documentation tag of a field; fields named description are
+// documented as @param_description, because @description belongs to the object itself
+func docTagName(fieldName string) string {
+	if fieldName == "description" {
+		return "param_description"
+	}
+	return fieldName
+}
+
+func parseDocTags(doc string) map[string]string {
+	tags := make(map[string]string)
+
+	var starts [][]int
+	for _, match := range docTagRegexp.FindAllStringSubmatchIndex(doc, -1) {
+		if match[0] == 0 || doc[match[0]-1] == ' ' {
+			starts = append(starts, match)
+		}
+	}
+	for i, match := range starts {
+		end := len(doc)
+		if i+1 < len(starts) {
+			end = starts[i+1][0]
+		}
+		tags[doc[match[2]:match[3]]] = strings.TrimSpace(doc[match[1]:end])
+	}
+
+	return tags
+}
+
+func parseDeclaration(line string) (string, []*Field, string, error) {
+	line = strings.TrimSuffix(line, ";")
+	parts := strings.SplitN(line, "=", 2)
+	if len(parts) != 2 {
+		return "", nil, "", fmt.Errorf("malformed declaration %q", line)
+	}
+
+	tokens := strings.Fields(parts[0])
+	if len(tokens) == 0 {
+		return "", nil, "", fmt.Errorf("malformed declaration %q", line)
+	}
+	name := tokens[0]
+	result := strings.TrimSpace(parts[1])
+	if builtinTypes[name] {
+		return name, nil, result, nil
+	}
+
+	fields := make([]*Field, 0, len(tokens)-1)
+	for _, token := range tokens[1:] {
+		nameAndType := strings.SplitN(token, ":", 2)
+		if len(nameAndType) != 2 {
+			return "", nil, "", fmt.Errorf("malformed field %q in %s", token, name)
+		}
+		ref, err := parseTypeRef(nameAndType[1])
+		if err != nil {
+			return "", nil, "", fmt.Errorf("field %s of %s: %v", nameAndType[0], name, err)
+		}
+		fields = append(fields, &Field{Name: nameAndType[0], Type: ref})
+	}
+
+	return name, fields, result, nil
+}
+
+func parseTypeRef(s string) (*TypeRef, error) {
+	if strings.HasPrefix(s, "vector<") {
+		if !strings.HasSuffix(s, ">") {
+			return nil, fmt.Errorf("malformed type %q", s)
+		}
+		elem, err := parseTypeRef(s[len("vector<") : len(s)-1])
+		if err != nil {
+			return nil, err
+		}
+		return &TypeRef{Name: "vector", Elem: elem}, nil
+	}
+	if s == "" {
+		return nil, fmt.Errorf("empty type")
+	}
+	return &TypeRef{Name: s}, nil
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// writeVisitor writes the visitor interface of an abstract class, with a method for each of its types
+func (g *generator) writeVisitor(b *bytes.Buffer, class *Class) {
+	name := class.Name
+
+	fmt.Fprintf(b, "// %sVisitor visits every type of %s, see Accept. Implementations stop compiling\n", name, name)
+	fmt.Fprintf(b, "// when a newer TDLib adds a type to %s, until they handle it too.\n", name)
+	fmt.Fprintf(b, "type %sVisitor interface {\n", name)
+	for _, t := range class.Types {
+		fmt.Fprintf(b, "\tVisit%s(%s *%s)\n", upperFirst(t.Name), lowerFirst(t.Name), upperFirst(t.Name))
+	}
+	fmt.Fprintf(b, "\tVisitUnknown%s(unknown%s *Unknown%s)\n", name, name, name)
+	b.WriteString("}\n\n")
+}
+
+// writeAccept writes the Accept method of a type of an abstract class
+func (g *generator) writeAccept(b *bytes.Buffer, class *Class, recv string, structName string) {
+	fmt.Fprintf(b, "// Accept calls the method of the visitor for %s\n", structName)
+	fmt.Fprintf(b, "func (%s *%s) Accept(visitor %sVisitor) {\n", recv, structName, class.Name)
+	fmt.Fprintf(b, "\tvisitor.Visit%s(%s)\n}\n\n", structName, recv)
+}
+
+// writeMatch writes the cases of an abstract class and its Match function, which requires a default case
+func (g *generator) writeMatch(b *bytes.Buffer, class *Class) {
+	name := class.Name
+	value := lowerFirst(name)
+
+	fmt.Fprintf(b, "// %sCases holds the function Match%s calls for each type of %s;\n", name, name, name)
+	b.WriteString("// types without a function are passed to the default case\n")
+	fmt.Fprintf(b, "type %sCases[R any] struct {\n", name)
+	for _, t := range class.Types {
+		fmt.Fprintf(b, "\t%s func(%s *%s) R\n", upperFirst(t.Name), lowerFirst(t.Name), upperFirst(t.Name))
+	}
+	b.WriteString("}\n\n")
+
+	fmt.Fprintf(b, "// Match%s calls the case of the type of %s, or defaultCase if there's none, e.g. for\n", name, value)
+	b.WriteString("// types unknown to the bindings or nil, and returns its result.\n")
+	fmt.Fprintf(b, "// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement %sVisitor\n", name)
+	b.WriteString("// to have the compiler check every type is handled.\n")
+	fmt.Fprintf(b, "func Match%s[R any](%s %s, cases %sCases[R], defaultCase func(%s %s) R) R {\n", name, value, name, name, value, name)
+	fmt.Fprintf(b, "\tswitch value := %s.(type) {\n", value)
+	for _, t := range class.Types {
+		fmt.Fprintf(b, "\tcase *%s:\n", upperFirst(t.Name))
+		fmt.Fprintf(b, "\t\tif cases.%s != nil {\n", upperFirst(t.Name))
+		fmt.Fprintf(b, "\t\t\treturn cases.%s(value)\n\t\t}\n", upperFirst(t.Name))
+	}
+	b.WriteString("\t}\n")
+	fmt.Fprintf(b, "\treturn defaultCase(%s)\n}\n\n", value)
+}
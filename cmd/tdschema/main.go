@@ -0,0 +1,68 @@
+// Command tdschema exports the TDLib types and functions known to go-tdlib as
+// machine-readable contracts for services not written in Go.
+//
+// With -format jsonschema it writes a JSON Schema document defining every type,
+// and every abstract class such as MessageContent as a oneOf of its types,
+// discriminated by @type. With -format openapi it writes an OpenAPI document
+// with an operation for every function, describing its parameters and result,
+// and the same schemas as components. Descriptions are the ones of the generated
+// structs and methods.
+//
+// Usage:
+//
+//	go run ./cmd/tdschema -format jsonschema -out td_api.schema.json
+//	go run ./cmd/tdschema -format openapi -out td_api.openapi.json
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+func main() {
+	format := flag.String("format", "jsonschema", "output format, jsonschema or openapi")
+	out := flag.String("out", "", "file to write to, standard output if empty")
+	flag.Parse()
+
+	if err := run(*format, *out); err != nil {
+		fmt.Fprintf(os.Stderr, "tdschema: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(format string, out string) error {
+	var doc interface{}
+	switch format {
+	case "jsonschema":
+		doc = jsonSchemaDocument()
+	case "openapi":
+		doc = openAPIDocument()
+	default:
+		return fmt.Errorf("unknown format %q, expected jsonschema or openapi", format)
+	}
+
+	if out == "" {
+		return write(os.Stdout, doc)
+	}
+
+	f, err := os.Create(out)
+	if err != nil {
+		return err
+	}
+	if err := write(f, doc); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// write writes a document as indented JSON
+func write(w io.Writer, doc interface{}) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(doc)
+}
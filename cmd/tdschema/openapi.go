@@ -0,0 +1,111 @@
+package main
+
+import (
+	tdlib "github.com/tasi788/go-tdlib"
+)
+
+// OpenAPI is an OpenAPI 3.1 document, restricted to the objects used for the TDLib functions
+type OpenAPI struct {
+	OpenAPI           string               `json:"openapi"`
+	Info              Info                 `json:"info"`
+	JSONSchemaDialect string               `json:"jsonSchemaDialect"`
+	Paths             map[string]*PathItem `json:"paths"`
+	Components        Components           `json:"components"`
+}
+
+// Info describes the API
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Version     string `json:"version"`
+}
+
+// PathItem holds the operation of a function
+type PathItem struct {
+	Post *Operation `json:"post"`
+}
+
+// Operation describes a function, its parameters and its result
+type Operation struct {
+	OperationID string               `json:"operationId"`
+	Description string               `json:"description"`
+	RequestBody *RequestBody         `json:"requestBody"`
+	Responses   map[string]*Response `json:"responses"`
+	Synchronous bool                 `json:"x-tdlib-synchronous,omitempty"`
+}
+
+// RequestBody holds the parameters of a function
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+// Response holds the result of a function
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content"`
+}
+
+// MediaType holds the schema of a request or a response
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components holds the schema of every type and abstract class
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas"`
+}
+
+// openAPIDocument returns the OpenAPI document with an operation for every function
+func openAPIDocument() *OpenAPI {
+	builder := schemaBuilder{refPrefix: "#/components/schemas/", discriminator: true}
+	doc := &OpenAPI{
+		OpenAPI: "3.1.0",
+		Info: Info{
+			Title:       "TDLib API",
+			Description: "Functions of TDLib, sent as JSON objects with the @type of the function and its parameters",
+			Version:     "1.0.0",
+		},
+		JSONSchemaDialect: jsonSchemaDialect,
+		Paths:             make(map[string]*PathItem),
+		Components:        Components{Schemas: builder.definitions()},
+	}
+
+	for _, method := range tdlib.Methods() {
+		doc.Paths["/"+method.Name] = &PathItem{Post: builder.operation(method)}
+	}
+	return doc
+}
+
+// operation returns the operation of a function
+func (builder schemaBuilder) operation(method *tdlib.MethodSchema) *Operation {
+	request := &Schema{
+		Type:       "object",
+		Properties: builder.properties(method.Name, method.Params),
+		Required:   []string{"@type"},
+	}
+
+	return &Operation{
+		OperationID: method.Name,
+		Description: method.Description,
+		RequestBody: &RequestBody{
+			Required: true,
+			Content:  jsonContent(request),
+		},
+		Responses: map[string]*Response{
+			"200": {
+				Description: "The result of the function, " + method.Result,
+				Content:     jsonContent(builder.valueSchema(method.Result)),
+			},
+			"default": {
+				Description: "An error returned by TDLib",
+				Content:     jsonContent(&Schema{Ref: builder.refPrefix + "error"}),
+			},
+		},
+		Synchronous: method.Synchronous,
+	}
+}
+
+func jsonContent(schema *Schema) map[string]*MediaType {
+	return map[string]*MediaType{"application/json": {Schema: schema}}
+}
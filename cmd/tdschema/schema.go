@@ -0,0 +1,160 @@
+package main
+
+import (
+	"strings"
+
+	tdlib "github.com/tasi788/go-tdlib"
+)
+
+const (
+	jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+	maxInt53          = 1<<53 - 1
+	minInt53          = -maxInt53
+)
+
+// Schema is a JSON Schema, restricted to the keywords used for the TDLib types
+type Schema struct {
+	Schema          string             `json:"$schema,omitempty"`
+	ID              string             `json:"$id,omitempty"`
+	Ref             string             `json:"$ref,omitempty"`
+	Title           string             `json:"title,omitempty"`
+	Description     string             `json:"description,omitempty"`
+	Type            string             `json:"type,omitempty"`
+	Format          string             `json:"format,omitempty"`
+	Pattern         string             `json:"pattern,omitempty"`
+	ContentEncoding string             `json:"contentEncoding,omitempty"`
+	Const           string             `json:"const,omitempty"`
+	Minimum         *int64             `json:"minimum,omitempty"`
+	Maximum         *int64             `json:"maximum,omitempty"`
+	Items           *Schema            `json:"items,omitempty"`
+	Properties      map[string]*Schema `json:"properties,omitempty"`
+	Required        []string           `json:"required,omitempty"`
+	OneOf           []*Schema          `json:"oneOf,omitempty"`
+	Discriminator   *Discriminator     `json:"discriminator,omitempty"`
+	Defs            map[string]*Schema `json:"$defs,omitempty"`
+}
+
+// Discriminator is the OpenAPI discriminator of a oneOf, the @type of the TDLib objects
+type Discriminator struct {
+	PropertyName string            `json:"propertyName"`
+	Mapping      map[string]string `json:"mapping,omitempty"`
+}
+
+// schemaBuilder converts the schemas of the bindings, with references relative to refPrefix
+type schemaBuilder struct {
+	refPrefix     string
+	discriminator bool
+}
+
+// jsonSchemaDocument returns the JSON Schema document defining every type and class in $defs
+func jsonSchemaDocument() *Schema {
+	builder := schemaBuilder{refPrefix: "#/$defs/"}
+	return &Schema{
+		Schema:      jsonSchemaDialect,
+		ID:          "https://github.com/tasi788/go-tdlib/td_api.schema.json",
+		Title:       "TDLib API",
+		Description: "Objects sent to and received from TDLib, keyed by their @type or abstract class",
+		Defs:        builder.definitions(),
+	}
+}
+
+// definitions returns the schema of every type and abstract class
+func (builder schemaBuilder) definitions() map[string]*Schema {
+	defs := make(map[string]*Schema)
+	for _, t := range tdlib.Types() {
+		defs[t.Name] = builder.typeSchema(t)
+	}
+	for _, class := range tdlib.Classes() {
+		defs[class.Name] = builder.classSchema(class)
+	}
+	return defs
+}
+
+// typeSchema returns the schema of the objects of a type
+func (builder schemaBuilder) typeSchema(t *tdlib.TypeSchema) *Schema {
+	schema := &Schema{
+		Type:        "object",
+		Description: t.Description,
+		Properties:  builder.properties(t.Name, t.Fields),
+	}
+	if _, isAbstract := tdlib.LookupClass(t.Class); isAbstract {
+		// the @type tells the types of the class apart
+		schema.Required = []string{"@type"}
+	}
+	return schema
+}
+
+// classSchema returns the schema of an abstract class, one of its types
+func (builder schemaBuilder) classSchema(class *tdlib.ClassSchema) *Schema {
+	schema := &Schema{Description: class.Description}
+	for _, name := range class.Types {
+		schema.OneOf = append(schema.OneOf, &Schema{Ref: builder.refPrefix + name})
+	}
+
+	if builder.discriminator {
+		schema.Discriminator = &Discriminator{PropertyName: "@type", Mapping: make(map[string]string)}
+		for _, name := range class.Types {
+			schema.Discriminator.Mapping[name] = builder.refPrefix + name
+		}
+	}
+	return schema
+}
+
+// properties returns the properties of an object of the given @type with the given fields
+func (builder schemaBuilder) properties(typeName string, fields []tdlib.ParamSchema) map[string]*Schema {
+	properties := map[string]*Schema{
+		"@type":  {Type: "string", Const: typeName},
+		"@extra": {Type: "string", Description: "Identifier of the request, returned unchanged in its response"},
+	}
+	for _, field := range fields {
+		property := builder.valueSchema(field.Type)
+		property.Description = field.Description
+		properties[field.Name] = property
+	}
+	return properties
+}
+
+// valueSchema returns the schema of a value of a TL type
+func (builder schemaBuilder) valueSchema(tlType string) *Schema {
+	if strings.HasPrefix(tlType, "vector<") {
+		elemType := tlType[len("vector<") : len(tlType)-1]
+		return &Schema{Type: "array", Items: builder.valueSchema(elemType)}
+	}
+
+	switch tlType {
+	case "int32":
+		return &Schema{Type: "integer", Format: "int32"}
+	case "int53":
+		minimum, maximum := int64(minInt53), int64(maxInt53)
+		return &Schema{Type: "integer", Format: "int64", Minimum: &minimum, Maximum: &maximum}
+	case "int64":
+		// TDLib sends 64-bit integers as strings, as they don't fit in a double
+		return &Schema{Type: "string", Format: "int64", Pattern: "^-?[0-9]+$"}
+	case "double":
+		return &Schema{Type: "number", Format: "double"}
+	case "string":
+		return &Schema{Type: "string"}
+	case "bytes":
+		return &Schema{Type: "string", Format: "byte", ContentEncoding: "base64"}
+	case "Bool":
+		return &Schema{Type: "boolean"}
+	}
+
+	if _, isAbstract := tdlib.LookupClass(tlType); isAbstract {
+		return &Schema{Ref: builder.refPrefix + tlType}
+	}
+	return &Schema{Ref: builder.refPrefix + typeName(tlType)}
+}
+
+// typeName returns the @type of a standalone type referenced by its TL result type, e.g. chat for Chat
+func typeName(tlType string) string {
+	if _, ok := tdlib.LookupType(tlType); ok {
+		return tlType
+	}
+	for _, t := range tdlib.Types() {
+		if t.Class == tlType {
+			return t.Name
+		}
+	}
+	return tlType
+}
@@ -0,0 +1,148 @@
+package tdlib
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"sync/atomic"
+)
+
+// Codec marshals requests to TDLib and unmarshals its responses and updates
+type Codec interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// StdCodec is the Codec based on encoding/json, used by default
+type StdCodec struct{}
+
+// Marshal marshals v with json.Marshal
+func (StdCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// Unmarshal unmarshals data with json.Unmarshal. Numbers in UpdateData and maps are decoded
+// as json.Number, so 64-bit integers keep their precision.
+func (StdCodec) Unmarshal(data []byte, v interface{}) error {
+	switch v.(type) {
+	case *UpdateData, *map[string]interface{}:
+		return unmarshalUseNumber(data, v)
+	}
+	return json.Unmarshal(data, v)
+}
+
+// unmarshalUseNumber unmarshals data like json.Unmarshal, but decodes numbers as json.Number
+func unmarshalUseNumber(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(v); err != nil {
+		return err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return errors.New("invalid character after top-level value")
+	}
+	return nil
+}
+
+// FastCodec is a Codec which encodes and decodes the generated types, UpdateData and
+// maps directly with their generated encoders and decoders, bypassing encoding/json
+// and its validation pass. Other values are passed to encoding/json. Like StdCodec, it
+// decodes numbers in UpdateData and maps as json.Number.
+type FastCodec struct{}
+
+// Marshal marshals v, without encoding/json if possible
+func (FastCodec) Marshal(v interface{}) ([]byte, error) {
+	switch v := v.(type) {
+	case fastMarshaler, UpdateData, map[string]interface{}:
+		return appendJSONValue(nil, v)
+	}
+	return json.Marshal(v)
+}
+
+// Unmarshal unmarshals data, without encoding/json if possible
+func (FastCodec) Unmarshal(data []byte, v interface{}) error {
+	switch v := v.(type) {
+	case fastUnmarshaler:
+		return unmarshalFast(data, v)
+	case *UpdateData:
+		return unmarshalFastMap(data, (*map[string]interface{})(v))
+	case *map[string]interface{}:
+		return unmarshalFastMap(data, v)
+	case *[]*json.RawMessage:
+		r := newJSONReader(data)
+		if r.readNull() {
+			*v = nil
+			return r.end()
+		}
+		items := make([]*json.RawMessage, 0)
+		err := r.readArray(func() error {
+			item, err := r.readRawMessage()
+			items = append(items, item)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		*v = items
+		return r.end()
+	}
+	return json.Unmarshal(data, v)
+}
+
+func unmarshalFastMap(data []byte, v *map[string]interface{}) error {
+	r := newJSONReader(data)
+	value, err := r.readAny()
+	if err != nil {
+		return err
+	}
+	if err := r.end(); err != nil {
+		return err
+	}
+
+	switch value := value.(type) {
+	case nil:
+		*v = nil
+		return nil
+	case map[string]interface{}:
+		*v = value
+		return nil
+	}
+	return errors.New("json: cannot unmarshal non-object into a map")
+}
+
+// codecHolder wraps a Codec so it can be stored in an atomic.Value
+type codecHolder struct {
+	codec Codec
+}
+
+var currentCodec atomic.Value
+
+// SetCodec Sets the Codec used by every client and the generated types to marshal requests
+// and unmarshal responses and updates. Pass nil to restore the default StdCodec.
+//
+//	tdlib.SetCodec(tdlib.FastCodec{})
+func SetCodec(codec Codec) {
+	if codec == nil {
+		codec = StdCodec{}
+	}
+	currentCodec.Store(codecHolder{codec: codec})
+}
+
+// getCodec returns the Codec set with SetCodec
+func getCodec() Codec {
+	if holder, ok := currentCodec.Load().(codecHolder); ok {
+		return holder.codec
+	}
+	return StdCodec{}
+}
+
+// jsonMarshal marshals with the current Codec
+func jsonMarshal(v interface{}) ([]byte, error) {
+	return getCodec().Marshal(v)
+}
+
+// jsonUnmarshal unmarshals with the current Codec
+func jsonUnmarshal(data []byte, v interface{}) error {
+	return getCodec().Unmarshal(data, v)
+}
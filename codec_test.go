@@ -0,0 +1,292 @@
+package tdlib
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// codecDocuments are JSON documents decoded by both codecs, valid or not
+var codecDocuments = []string{
+	// string escapes
+	`{"s":"a\"b\\c\/d\b\f\n\r\t"}`,
+	`{"s":"é中\u0000"}`,
+	`{"s":"<>&  "}`,
+	// surrogate pairs, lone and reversed surrogates
+	`{"s":"😀"}`,
+	`{"s":"😀x"}`,
+	`{"s":"\ud83d"}`,
+	`{"s":"\ud83dx"}`,
+	`{"s":"\ude00\ud83d"}`,
+	`{"s":"\ud83dA"}`,
+	// invalid UTF-8
+	"{\"s\":\"a\xffb\"}",
+	"{\"s\":\"\xed\xa0\x80\"}",
+	"{\"s\":\"\xf0\x9f\x98\"}",
+	"{\"\xff\":1}",
+	// numbers
+	`{"n":9223372036854775807}`,
+	`{"n":-9223372036854775808}`,
+	`{"n":9007199254740993}`,
+	`{"n":1e3}`,
+	`{"n":1.5E-7}`,
+	`{"n":-0}`,
+	`{"n":0.1}`,
+	// null, nesting and unknown types
+	`{"a":null,"b":[1,{"c":true,"d":false}],"e":{}}`,
+	`{"@type":"somethingNew","x":[]}`,
+	` { "a" : [ 1 , 2 ] } `,
+	`null`,
+	// malformed
+	``,
+	` `,
+	`{`,
+	`}`,
+	`{"a":}`,
+	`{"a":1,}`,
+	`{"a" 1}`,
+	`{1:2}`,
+	`{"a":1}x`,
+	`{"a":1}{}`,
+	`[1,2`,
+	`[1,]`,
+	`{"a":"\x"}`,
+	`{"a":"\u12"}`,
+	`{"a":"\u12g4"}`,
+	"{\"a\":\"\x01\"}",
+	`{"a":"abc`,
+	`{"a":01}`,
+	`{"a":1.}`,
+	`{"a":.5}`,
+	`{"a":1e}`,
+	`{"a":-}`,
+	`{"a":+1}`,
+	`{"a":tru}`,
+	`{"a":nul}`,
+	`{"a":NaN}`,
+	`nul`,
+	`[1]`,
+	`"s"`,
+}
+
+func TestFastCodecMapParity(t *testing.T) {
+	for _, document := range codecDocuments {
+		var std, fast UpdateData
+		stdErr := StdCodec{}.Unmarshal([]byte(document), &std)
+		fastErr := FastCodec{}.Unmarshal([]byte(document), &fast)
+		if (stdErr == nil) != (fastErr == nil) {
+			t.Errorf("%q: StdCodec error %v, FastCodec error %v", document, stdErr, fastErr)
+			continue
+		}
+		if stdErr == nil && !reflect.DeepEqual(std, fast) {
+			t.Errorf("%q: StdCodec decodes %#v, FastCodec %#v", document, std, fast)
+		}
+	}
+}
+
+func TestFastCodecMarshalParity(t *testing.T) {
+	values := []UpdateData{
+		{"s": "a\"b\\c/d\b\f\n\r\t\x01\x1f"},
+		{"s": "<>&  é中😀"},
+		{"s": "a\xffb\xed\xa0\x80"},
+		{"\xff": "key"},
+		{"f": 1.5, "g": 1e21, "h": 0.000001, "i": -0.0, "j": 100.0},
+		{"n": json.Number("9223372036854775807"), "m": int64(-9223372036854775808), "k": int32(7)},
+		{"b": true, "z": nil, "l": []interface{}{1, "x", nil, map[string]interface{}{}}},
+		{"nested": UpdateData{"a": []byte("bytes")}},
+	}
+	for _, value := range values {
+		std, stdErr := StdCodec{}.Marshal(value)
+		fast, fastErr := FastCodec{}.Marshal(value)
+		if stdErr != nil || fastErr != nil {
+			t.Errorf("%#v: StdCodec error %v, FastCodec error %v", value, stdErr, fastErr)
+			continue
+		}
+		if string(std) != string(fast) {
+			t.Errorf("%#v: StdCodec encodes %s, FastCodec %s", value, std, fast)
+		}
+	}
+}
+
+// typedDocument is a message with fields of most kinds
+const typedDocument = `{"@type":"message","id":1048576,"chat_id":-1001234567890,"date":1700000000,` +
+	`"sender_id":{"@type":"messageSenderUser","user_id":42},"is_outgoing":true,"media_album_id":"9223372036854775807",` +
+	`"content":{"@type":"messageText","text":{"@type":"formattedText","text":"héllo 😀","entities":[` +
+	`{"@type":"textEntity","offset":0,"length":5,"type":{"@type":"textEntityTypeBold"}}]}},` +
+	`"reply_markup":null,"author_signature":"","restriction_reason":"x"}`
+
+func TestFastCodecTypedParity(t *testing.T) {
+	var std, fast Message
+	if err := (StdCodec{}).Unmarshal([]byte(typedDocument), &std); err != nil {
+		t.Fatal(err)
+	}
+	if err := (FastCodec{}).Unmarshal([]byte(typedDocument), &fast); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(std, fast) {
+		t.Errorf("StdCodec decodes %+v, FastCodec %+v", std, fast)
+	}
+	if fast.MediaAlbumId != 9223372036854775807 || fast.ChatId != -1001234567890 {
+		t.Errorf("decoded media_album_id %d and chat_id %d lost precision", fast.MediaAlbumId, fast.ChatId)
+	}
+	if text := fast.Content.(*MessageText).Text.Text; text != "héllo 😀" {
+		t.Errorf("decoded text %q, want %q", text, "héllo 😀")
+	}
+
+	// re-encoding gives the same document with both codecs
+	stdEncoded, err := StdCodec{}.Marshal(&fast)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fastEncoded, err := FastCodec{}.Marshal(&fast)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(stdEncoded) != string(fastEncoded) {
+		t.Errorf("StdCodec encodes %s, FastCodec %s", stdEncoded, fastEncoded)
+	}
+}
+
+func TestFastCodecIntegers(t *testing.T) {
+	// int64 fields are read from numbers and from strings, like TDLib sends int64 values
+	tests := []struct {
+		document     string
+		chatID       int64
+		date         int32
+		mediaAlbumID JSONInt64
+		fails        bool
+	}{
+		{document: `{"chat_id":9223372036854775807}`, chatID: math.MaxInt64},
+		{document: `{"chat_id":-9223372036854775808}`, chatID: math.MinInt64},
+		{document: `{"chat_id":9223372036854775808}`, fails: true},
+		{document: `{"chat_id":1e3}`, fails: true},
+		{document: `{"chat_id":1.0}`, fails: true},
+		{document: `{"chat_id":"5"}`, chatID: 5},
+		{document: `{"chat_id":"-5"}`, chatID: -5},
+		// above 2^53, where a float64 would lose precision
+		{document: `{"chat_id":9007199254740993}`, chatID: 9007199254740993},
+		{document: `{"chat_id":"9007199254740993"}`, chatID: 9007199254740993},
+		{document: `{"chat_id":"-9007199254740993"}`, chatID: -9007199254740993},
+		{document: `{"chat_id":"9223372036854775808"}`, fails: true},
+		{document: `{"chat_id":"5.0"}`, fails: true},
+		{document: `{"chat_id":""}`, fails: true},
+		{document: `{"date":2147483648}`, fails: true},
+		{document: `{"date":-2147483648}`, date: math.MinInt32},
+		{document: `{"date":"5"}`, fails: true},
+		{document: `{"media_album_id":"9223372036854775807"}`, mediaAlbumID: math.MaxInt64},
+		{document: `{"media_album_id":9223372036854775807}`, mediaAlbumID: math.MaxInt64},
+		{document: `{"media_album_id":"-1"}`, mediaAlbumID: -1},
+		{document: `{"media_album_id":"x"}`, fails: true},
+	}
+	for _, codec := range []Codec{StdCodec{}, FastCodec{}} {
+		for _, test := range tests {
+			var message Message
+			err := codec.Unmarshal([]byte(test.document), &message)
+			if test.fails {
+				if err == nil {
+					t.Errorf("%T %s: decodes %d %d %d, want an error", codec, test.document, message.ChatId, message.Date, message.MediaAlbumId)
+				}
+				continue
+			}
+			if err != nil {
+				t.Errorf("%T %s: %v", codec, test.document, err)
+				continue
+			}
+			if message.ChatId != test.chatID || message.Date != test.date || message.MediaAlbumId != test.mediaAlbumID {
+				t.Errorf("%T %s: decodes %d %d %d, want %d %d %d", codec, test.document,
+					message.ChatId, message.Date, message.MediaAlbumId, test.chatID, test.date, test.mediaAlbumID)
+			}
+		}
+	}
+}
+
+func TestFastCodecNullAndMissing(t *testing.T) {
+	tests := []struct {
+		document string
+		entities []TextEntity
+	}{
+		{`{"@type":"formattedText","text":"a"}`, nil},
+		{`{"@type":"formattedText","text":"a","entities":null}`, nil},
+		{`{"@type":"formattedText","text":"a","entities":[]}`, []TextEntity{}},
+	}
+	for _, test := range tests {
+		for _, codec := range []Codec{StdCodec{}, FastCodec{}} {
+			var text FormattedText
+			if err := codec.Unmarshal([]byte(test.document), &text); err != nil {
+				t.Errorf("%T %s: %v", codec, test.document, err)
+				continue
+			}
+			if !reflect.DeepEqual(text.Entities, test.entities) {
+				t.Errorf("%T %s: decodes entities %#v, want %#v", codec, test.document, text.Entities, test.entities)
+			}
+		}
+	}
+
+	for _, document := range []string{`{"@type":"message","content":null,"reply_markup":null}`, `{"@type":"message"}`} {
+		for _, codec := range []Codec{StdCodec{}, FastCodec{}} {
+			var message Message
+			if err := codec.Unmarshal([]byte(document), &message); err != nil {
+				t.Errorf("%T %s: %v", codec, document, err)
+			} else if message.Content != nil || message.ReplyMarkup != nil {
+				t.Errorf("%T %s: decodes content %v and reply markup %v, want nil", codec, document, message.Content, message.ReplyMarkup)
+			}
+		}
+	}
+}
+
+func TestFastCodecUnknownType(t *testing.T) {
+	document := `{"@type":"message","id":1,"content":{"@type":"messageFromTheFuture","x":[1,"é"]}}`
+	for _, codec := range []Codec{StdCodec{}, FastCodec{}} {
+		var message Message
+		if err := codec.Unmarshal([]byte(document), &message); err != nil {
+			t.Fatalf("%T: %v", codec, err)
+		}
+		unknown, ok := message.Content.(*UnknownMessageContent)
+		if !ok {
+			t.Fatalf("%T: decodes content %T, want *UnknownMessageContent", codec, message.Content)
+		}
+		if unknown.Type != "messageFromTheFuture" || string(unknown.Raw) != `{"@type":"messageFromTheFuture","x":[1,"é"]}` {
+			t.Errorf("%T: decodes unknown content %s %s", codec, unknown.Type, unknown.Raw)
+		}
+	}
+
+	SetStrictDecoding(true)
+	defer SetStrictDecoding(false)
+	for _, codec := range []Codec{StdCodec{}, FastCodec{}} {
+		var message Message
+		var unknownType *UnknownTypeError
+		if err := codec.Unmarshal([]byte(document), &message); !errors.As(err, &unknownType) {
+			t.Errorf("%T: strict decoding error %v, want an UnknownTypeError", codec, err)
+		}
+	}
+}
+
+func TestFastCodecMalformed(t *testing.T) {
+	// every truncation of a valid document, and the malformed documents, fail without panicking
+	var documents []string
+	for i := 0; i < len(typedDocument); i++ {
+		documents = append(documents, typedDocument[:i])
+	}
+	for _, document := range codecDocuments {
+		if json.Valid([]byte(document)) {
+			continue
+		}
+		documents = append(documents, document)
+	}
+
+	for _, document := range documents {
+		var message Message
+		if err := (FastCodec{}).Unmarshal([]byte(document), &message); err == nil {
+			t.Errorf("%q: FastCodec decodes a message without error", document)
+		}
+		var data UpdateData
+		if err := (FastCodec{}).Unmarshal([]byte(document), &data); err == nil {
+			t.Errorf("%q: FastCodec decodes a map without error", document)
+		}
+		if _, err := unmarshalTdMessage("message", []byte(document)); err == nil {
+			t.Errorf("%q: unmarshalTdMessage decodes without error", document)
+		}
+	}
+}
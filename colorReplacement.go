@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // ColorReplacement Describes a color replacement for animated emoji
 type ColorReplacement struct {
 	tdCommon
@@ -25,3 +30,116 @@ func NewColorReplacement(oldColor int32, newColor int32) *ColorReplacement {
 
 	return &colorReplacementTemp
 }
+
+// MarshalJSON marshals to json
+func (colorReplacement *ColorReplacement) MarshalJSON() ([]byte, error) {
+	return colorReplacement.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (colorReplacement *ColorReplacement) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, colorReplacement)
+}
+
+func (colorReplacement *ColorReplacement) appendJSON(b []byte) ([]byte, error) {
+	if colorReplacement == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, colorReplacement.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, colorReplacement.tdCommon.Extra)
+	b = append(b, `,"old_color":`...)
+	b = appendJSONInt(b, int64(colorReplacement.OldColor))
+	b = append(b, `,"new_color":`...)
+	b = appendJSONInt(b, int64(colorReplacement.NewColor))
+	return append(b, '}'), nil
+}
+
+func (colorReplacement *ColorReplacement) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			colorReplacement.tdCommon.Type, err = r.readString()
+		case "@extra":
+			colorReplacement.tdCommon.Extra, err = r.readString()
+		case "old_color":
+			colorReplacement.OldColor, err = r.readInt32()
+		case "new_color":
+			colorReplacement.NewColor, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (colorReplacement *ColorReplacement) Clone() *ColorReplacement {
+	if colorReplacement == nil {
+		return nil
+	}
+	clone := *colorReplacement
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (colorReplacement *ColorReplacement) Equal(other *ColorReplacement) bool {
+	if colorReplacement == nil || other == nil {
+		return colorReplacement == other
+	}
+	if colorReplacement.OldColor != other.OldColor {
+		return false
+	}
+	if colorReplacement.NewColor != other.NewColor {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (colorReplacement *ColorReplacement) Diff(other *ColorReplacement) []Change {
+	return colorReplacement.diff("", other, nil)
+}
+
+func (colorReplacement *ColorReplacement) diff(path string, other *ColorReplacement, changes []Change) []Change {
+	if colorReplacement == nil || other == nil {
+		if colorReplacement != other {
+			changes = append(changes, Change{Path: path, Old: colorReplacement, New: other})
+		}
+		return changes
+	}
+	if colorReplacement.OldColor != other.OldColor {
+		changes = append(changes, Change{Path: fieldPath(path, "old_color"), Old: colorReplacement.OldColor, New: other.OldColor})
+	}
+	if colorReplacement.NewColor != other.NewColor {
+		changes = append(changes, Change{Path: fieldPath(path, "new_color"), Old: colorReplacement.NewColor, New: other.NewColor})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (colorReplacement *ColorReplacement) String() string {
+	return objectString(colorReplacement)
+}
+
+// LogValue logs the object as its summary
+func (colorReplacement *ColorReplacement) LogValue() slog.Value {
+	return slog.StringValue(objectString(colorReplacement))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (colorReplacement *ColorReplacement) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, colorReplacement)
+}
+
+func (colorReplacement *ColorReplacement) printText(p *printer) {
+	if colorReplacement == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ColorReplacement")
+	p.int("old_color", int64(colorReplacement.OldColor))
+	p.int("new_color", int64(colorReplacement.NewColor))
+	p.endObject(state)
+}
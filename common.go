@@ -1,9 +1,6 @@
-package tdlib
+//go:generate go run ./cmd/tdgen -schema data/td_api.tl -out .
 
-import (
-	"strconv"
-	"strings"
-)
+package tdlib
 
 type tdCommon struct {
 	Type  string `json:"@type"`
@@ -23,24 +20,25 @@ type UpdateData map[string]interface{}
 
 // UpdateMsg is used to unmarshal received json strings into
 type UpdateMsg struct {
-	Data UpdateData
-	Raw  []byte
+	Data  UpdateData
+	Raw   []byte
+	Value TdMessage // The update decoded into its generated type, e.g. *UpdateNewMessage; nil for responses to requests
+
+	sendResult chan UpdateMsg // The waiter of the send result of a response which is a message being sent
 }
 
-// MarshalJSON marshals to json
-func (jsonInt *JSONInt64) MarshalJSON() ([]byte, error) {
-	intStr := strconv.FormatInt(int64(*jsonInt), 10)
-	return []byte(intStr), nil
+// MarshalJSON marshals to a json string, like TDLib sends 64-bit integers
+func (jsonInt JSONInt64) MarshalJSON() ([]byte, error) {
+	return appendJSONInt64String(nil, int64(jsonInt)), nil
 }
 
-// UnmarshalJSON unmarshals from json
+// UnmarshalJSON unmarshals from a json string or number
 func (jsonInt *JSONInt64) UnmarshalJSON(b []byte) error {
-	intStr := string(b)
-	intStr = strings.Replace(intStr, "\"", "", 2)
-	jsonBigInt, err := strconv.ParseInt(intStr, 10, 64)
+	r := newJSONReader(b)
+	value, err := r.readJSONInt64()
 	if err != nil {
 		return err
 	}
-	*jsonInt = JSONInt64(jsonBigInt)
-	return nil
+	*jsonInt = value
+	return r.end()
 }
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // ConnectedWebsite Contains information about one website the current user is logged in with Telegram
 type ConnectedWebsite struct {
 	tdCommon
@@ -46,3 +51,193 @@ func NewConnectedWebsite(id JSONInt64, domainName string, botUserId int64, brows
 
 	return &connectedWebsiteTemp
 }
+
+// MarshalJSON marshals to json
+func (connectedWebsite *ConnectedWebsite) MarshalJSON() ([]byte, error) {
+	return connectedWebsite.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (connectedWebsite *ConnectedWebsite) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, connectedWebsite)
+}
+
+func (connectedWebsite *ConnectedWebsite) appendJSON(b []byte) ([]byte, error) {
+	if connectedWebsite == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, connectedWebsite.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, connectedWebsite.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt64String(b, int64(connectedWebsite.Id))
+	b = append(b, `,"domain_name":`...)
+	b = appendJSONString(b, connectedWebsite.DomainName)
+	b = append(b, `,"bot_user_id":`...)
+	b = appendJSONInt(b, int64(connectedWebsite.BotUserId))
+	b = append(b, `,"browser":`...)
+	b = appendJSONString(b, connectedWebsite.Browser)
+	b = append(b, `,"platform":`...)
+	b = appendJSONString(b, connectedWebsite.Platform)
+	b = append(b, `,"log_in_date":`...)
+	b = appendJSONInt(b, int64(connectedWebsite.LogInDate))
+	b = append(b, `,"last_active_date":`...)
+	b = appendJSONInt(b, int64(connectedWebsite.LastActiveDate))
+	b = append(b, `,"ip":`...)
+	b = appendJSONString(b, connectedWebsite.Ip)
+	b = append(b, `,"location":`...)
+	b = appendJSONString(b, connectedWebsite.Location)
+	return append(b, '}'), nil
+}
+
+func (connectedWebsite *ConnectedWebsite) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			connectedWebsite.tdCommon.Type, err = r.readString()
+		case "@extra":
+			connectedWebsite.tdCommon.Extra, err = r.readString()
+		case "id":
+			connectedWebsite.Id, err = r.readJSONInt64()
+		case "domain_name":
+			connectedWebsite.DomainName, err = r.readString()
+		case "bot_user_id":
+			connectedWebsite.BotUserId, err = r.readInt64()
+		case "browser":
+			connectedWebsite.Browser, err = r.readString()
+		case "platform":
+			connectedWebsite.Platform, err = r.readString()
+		case "log_in_date":
+			connectedWebsite.LogInDate, err = r.readInt32()
+		case "last_active_date":
+			connectedWebsite.LastActiveDate, err = r.readInt32()
+		case "ip":
+			connectedWebsite.Ip, err = r.readString()
+		case "location":
+			connectedWebsite.Location, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (connectedWebsite *ConnectedWebsite) Clone() *ConnectedWebsite {
+	if connectedWebsite == nil {
+		return nil
+	}
+	clone := *connectedWebsite
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (connectedWebsite *ConnectedWebsite) Equal(other *ConnectedWebsite) bool {
+	if connectedWebsite == nil || other == nil {
+		return connectedWebsite == other
+	}
+	if connectedWebsite.Id != other.Id {
+		return false
+	}
+	if connectedWebsite.DomainName != other.DomainName {
+		return false
+	}
+	if connectedWebsite.BotUserId != other.BotUserId {
+		return false
+	}
+	if connectedWebsite.Browser != other.Browser {
+		return false
+	}
+	if connectedWebsite.Platform != other.Platform {
+		return false
+	}
+	if connectedWebsite.LogInDate != other.LogInDate {
+		return false
+	}
+	if connectedWebsite.LastActiveDate != other.LastActiveDate {
+		return false
+	}
+	if connectedWebsite.Ip != other.Ip {
+		return false
+	}
+	if connectedWebsite.Location != other.Location {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (connectedWebsite *ConnectedWebsite) Diff(other *ConnectedWebsite) []Change {
+	return connectedWebsite.diff("", other, nil)
+}
+
+func (connectedWebsite *ConnectedWebsite) diff(path string, other *ConnectedWebsite, changes []Change) []Change {
+	if connectedWebsite == nil || other == nil {
+		if connectedWebsite != other {
+			changes = append(changes, Change{Path: path, Old: connectedWebsite, New: other})
+		}
+		return changes
+	}
+	if connectedWebsite.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: connectedWebsite.Id, New: other.Id})
+	}
+	if connectedWebsite.DomainName != other.DomainName {
+		changes = append(changes, Change{Path: fieldPath(path, "domain_name"), Old: connectedWebsite.DomainName, New: other.DomainName})
+	}
+	if connectedWebsite.BotUserId != other.BotUserId {
+		changes = append(changes, Change{Path: fieldPath(path, "bot_user_id"), Old: connectedWebsite.BotUserId, New: other.BotUserId})
+	}
+	if connectedWebsite.Browser != other.Browser {
+		changes = append(changes, Change{Path: fieldPath(path, "browser"), Old: connectedWebsite.Browser, New: other.Browser})
+	}
+	if connectedWebsite.Platform != other.Platform {
+		changes = append(changes, Change{Path: fieldPath(path, "platform"), Old: connectedWebsite.Platform, New: other.Platform})
+	}
+	if connectedWebsite.LogInDate != other.LogInDate {
+		changes = append(changes, Change{Path: fieldPath(path, "log_in_date"), Old: connectedWebsite.LogInDate, New: other.LogInDate})
+	}
+	if connectedWebsite.LastActiveDate != other.LastActiveDate {
+		changes = append(changes, Change{Path: fieldPath(path, "last_active_date"), Old: connectedWebsite.LastActiveDate, New: other.LastActiveDate})
+	}
+	if connectedWebsite.Ip != other.Ip {
+		changes = append(changes, Change{Path: fieldPath(path, "ip"), Old: connectedWebsite.Ip, New: other.Ip})
+	}
+	if connectedWebsite.Location != other.Location {
+		changes = append(changes, Change{Path: fieldPath(path, "location"), Old: connectedWebsite.Location, New: other.Location})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (connectedWebsite *ConnectedWebsite) String() string {
+	return objectString(connectedWebsite)
+}
+
+// LogValue logs the object as its summary
+func (connectedWebsite *ConnectedWebsite) LogValue() slog.Value {
+	return slog.StringValue(objectString(connectedWebsite))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (connectedWebsite *ConnectedWebsite) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, connectedWebsite)
+}
+
+func (connectedWebsite *ConnectedWebsite) printText(p *printer) {
+	if connectedWebsite == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ConnectedWebsite")
+	p.int("id", int64(connectedWebsite.Id))
+	p.string("domain_name", connectedWebsite.DomainName)
+	p.int("bot_user_id", int64(connectedWebsite.BotUserId))
+	p.string("browser", connectedWebsite.Browser)
+	p.string("platform", connectedWebsite.Platform)
+	p.int("log_in_date", int64(connectedWebsite.LogInDate))
+	p.int("last_active_date", int64(connectedWebsite.LastActiveDate))
+	p.string("ip", connectedWebsite.Ip)
+	p.string("location", connectedWebsite.Location)
+	p.endObject(state)
+}
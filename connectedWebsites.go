@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ConnectedWebsites Contains a list of websites the current user is logged in with Telegram
@@ -28,6 +28,150 @@ func NewConnectedWebsites(websites []ConnectedWebsite) *ConnectedWebsites {
 	return &connectedWebsitesTemp
 }
 
+// MarshalJSON marshals to json
+func (connectedWebsites *ConnectedWebsites) MarshalJSON() ([]byte, error) {
+	return connectedWebsites.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (connectedWebsites *ConnectedWebsites) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, connectedWebsites)
+}
+
+func (connectedWebsites *ConnectedWebsites) appendJSON(b []byte) ([]byte, error) {
+	if connectedWebsites == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, connectedWebsites.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, connectedWebsites.tdCommon.Extra)
+	b = append(b, `,"websites":`...)
+	if connectedWebsites.Websites == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range connectedWebsites.Websites {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = connectedWebsites.Websites[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (connectedWebsites *ConnectedWebsites) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			connectedWebsites.tdCommon.Type, err = r.readString()
+		case "@extra":
+			connectedWebsites.tdCommon.Extra, err = r.readString()
+		case "websites":
+			if r.readNull() {
+				connectedWebsites.Websites = nil
+			} else {
+				connectedWebsites.Websites = make([]ConnectedWebsite, 0)
+				err = r.readArray(func() (err error) {
+					var item0 ConnectedWebsite
+					err = item0.decodeJSON(r)
+					connectedWebsites.Websites = append(connectedWebsites.Websites, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (connectedWebsites *ConnectedWebsites) Clone() *ConnectedWebsites {
+	if connectedWebsites == nil {
+		return nil
+	}
+	clone := *connectedWebsites
+	if connectedWebsites.Websites != nil {
+		clone.Websites = make([]ConnectedWebsite, len(connectedWebsites.Websites))
+		for i0 := range connectedWebsites.Websites {
+			clone.Websites[i0] = *connectedWebsites.Websites[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (connectedWebsites *ConnectedWebsites) Equal(other *ConnectedWebsites) bool {
+	if connectedWebsites == nil || other == nil {
+		return connectedWebsites == other
+	}
+	if len(connectedWebsites.Websites) != len(other.Websites) {
+		return false
+	}
+	for i0 := range connectedWebsites.Websites {
+		if !connectedWebsites.Websites[i0].Equal(&other.Websites[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (connectedWebsites *ConnectedWebsites) Diff(other *ConnectedWebsites) []Change {
+	return connectedWebsites.diff("", other, nil)
+}
+
+func (connectedWebsites *ConnectedWebsites) diff(path string, other *ConnectedWebsites, changes []Change) []Change {
+	if connectedWebsites == nil || other == nil {
+		if connectedWebsites != other {
+			changes = append(changes, Change{Path: path, Old: connectedWebsites, New: other})
+		}
+		return changes
+	}
+	if len(connectedWebsites.Websites) != len(other.Websites) {
+		changes = append(changes, Change{Path: fieldPath(path, "websites"), Old: connectedWebsites.Websites, New: other.Websites})
+	} else {
+		for i0 := range connectedWebsites.Websites {
+			changes = connectedWebsites.Websites[i0].diff(indexPath(fieldPath(path, "websites"), i0), &other.Websites[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (connectedWebsites *ConnectedWebsites) String() string {
+	return objectString(connectedWebsites)
+}
+
+// LogValue logs the object as its summary
+func (connectedWebsites *ConnectedWebsites) LogValue() slog.Value {
+	return slog.StringValue(objectString(connectedWebsites))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (connectedWebsites *ConnectedWebsites) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, connectedWebsites)
+}
+
+func (connectedWebsites *ConnectedWebsites) printText(p *printer) {
+	if connectedWebsites == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ConnectedWebsites")
+	p.vector("websites", len(connectedWebsites.Websites), func(i0 int) {
+		connectedWebsites.Websites[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetConnectedWebsites Returns all website where the current user used Telegram to log in
 func (client *Client) GetConnectedWebsites() (*ConnectedWebsites, error) {
 	result, err := client.SendAndCatch(UpdateData{
@@ -38,11 +182,11 @@ func (client *Client) GetConnectedWebsites() (*ConnectedWebsites, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var connectedWebsites ConnectedWebsites
-	err = json.Unmarshal(result.Raw, &connectedWebsites)
+	err = jsonUnmarshal(result.Raw, &connectedWebsites)
 	return &connectedWebsites, err
 }
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ConnectionState Describes the current state of the connection to Telegram servers
 type ConnectionState interface {
 	GetConnectionStateEnum() ConnectionStateEnum
+	Accept(visitor ConnectionStateVisitor)
 }
 
 // ConnectionStateEnum Alias for abstract ConnectionState 'Sub-Classes', used as constant-enum here
@@ -22,46 +24,97 @@ const (
 	ConnectionStateReadyType             ConnectionStateEnum = "connectionStateReady"
 )
 
+// UnknownConnectionState is a ConnectionState of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownConnectionState struct {
+	Unknown
+}
+
+// GetConnectionStateEnum return the enum type of this object
+func (unknownConnectionState *UnknownConnectionState) GetConnectionStateEnum() ConnectionStateEnum {
+	return ConnectionStateEnum(unknownConnectionState.Type)
+}
+
+// Accept calls the method of the visitor for UnknownConnectionState
+func (unknownConnectionState *UnknownConnectionState) Accept(visitor ConnectionStateVisitor) {
+	visitor.VisitUnknownConnectionState(unknownConnectionState)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownConnectionState *UnknownConnectionState) String() string {
+	return objectString(unknownConnectionState)
+}
+
+// LogValue logs the object as its summary
+func (unknownConnectionState *UnknownConnectionState) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownConnectionState))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownConnectionState *UnknownConnectionState) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownConnectionState)
+}
+
+func (unknownConnectionState *UnknownConnectionState) printText(p *printer) {
+	if unknownConnectionState == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownConnectionState")
+	p.string("@type", unknownConnectionState.Type)
+	p.endObject(state)
+}
+
 func unmarshalConnectionState(rawMsg *json.RawMessage) (ConnectionState, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch ConnectionStateEnum(objMap["@type"].(string)) {
+	switch ConnectionStateEnum(typeName) {
 	case ConnectionStateWaitingForNetworkType:
 		var connectionStateWaitingForNetwork ConnectionStateWaitingForNetwork
-		err := json.Unmarshal(*rawMsg, &connectionStateWaitingForNetwork)
+		err := jsonUnmarshal(*rawMsg, &connectionStateWaitingForNetwork)
 		return &connectionStateWaitingForNetwork, err
 
 	case ConnectionStateConnectingToProxyType:
 		var connectionStateConnectingToProxy ConnectionStateConnectingToProxy
-		err := json.Unmarshal(*rawMsg, &connectionStateConnectingToProxy)
+		err := jsonUnmarshal(*rawMsg, &connectionStateConnectingToProxy)
 		return &connectionStateConnectingToProxy, err
 
 	case ConnectionStateConnectingType:
 		var connectionStateConnecting ConnectionStateConnecting
-		err := json.Unmarshal(*rawMsg, &connectionStateConnecting)
+		err := jsonUnmarshal(*rawMsg, &connectionStateConnecting)
 		return &connectionStateConnecting, err
 
 	case ConnectionStateUpdatingType:
 		var connectionStateUpdating ConnectionStateUpdating
-		err := json.Unmarshal(*rawMsg, &connectionStateUpdating)
+		err := jsonUnmarshal(*rawMsg, &connectionStateUpdating)
 		return &connectionStateUpdating, err
 
 	case ConnectionStateReadyType:
 		var connectionStateReady ConnectionStateReady
-		err := json.Unmarshal(*rawMsg, &connectionStateReady)
+		err := jsonUnmarshal(*rawMsg, &connectionStateReady)
 		return &connectionStateReady, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownConnectionState{Unknown: unknown}, nil
+	}
+}
+
+func decodeConnectionState(r *jsonReader) (ConnectionState, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
 	}
+	return unmarshalConnectionState(rawMsg)
 }
 
 // ConnectionStateWaitingForNetwork Currently waiting for the network to become available. Use setNetworkType to change the available network type
@@ -75,7 +128,6 @@ func (connectionStateWaitingForNetwork *ConnectionStateWaitingForNetwork) Messag
 }
 
 // NewConnectionStateWaitingForNetwork creates a new ConnectionStateWaitingForNetwork
-//
 func NewConnectionStateWaitingForNetwork() *ConnectionStateWaitingForNetwork {
 	connectionStateWaitingForNetworkTemp := ConnectionStateWaitingForNetwork{
 		tdCommon: tdCommon{Type: "connectionStateWaitingForNetwork"},
@@ -84,11 +136,107 @@ func NewConnectionStateWaitingForNetwork() *ConnectionStateWaitingForNetwork {
 	return &connectionStateWaitingForNetworkTemp
 }
 
+// MarshalJSON marshals to json
+func (connectionStateWaitingForNetwork *ConnectionStateWaitingForNetwork) MarshalJSON() ([]byte, error) {
+	return connectionStateWaitingForNetwork.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (connectionStateWaitingForNetwork *ConnectionStateWaitingForNetwork) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, connectionStateWaitingForNetwork)
+}
+
+func (connectionStateWaitingForNetwork *ConnectionStateWaitingForNetwork) appendJSON(b []byte) ([]byte, error) {
+	if connectionStateWaitingForNetwork == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, connectionStateWaitingForNetwork.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, connectionStateWaitingForNetwork.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (connectionStateWaitingForNetwork *ConnectionStateWaitingForNetwork) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			connectionStateWaitingForNetwork.tdCommon.Type, err = r.readString()
+		case "@extra":
+			connectionStateWaitingForNetwork.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (connectionStateWaitingForNetwork *ConnectionStateWaitingForNetwork) Clone() *ConnectionStateWaitingForNetwork {
+	if connectionStateWaitingForNetwork == nil {
+		return nil
+	}
+	clone := *connectionStateWaitingForNetwork
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (connectionStateWaitingForNetwork *ConnectionStateWaitingForNetwork) Equal(other *ConnectionStateWaitingForNetwork) bool {
+	if connectionStateWaitingForNetwork == nil || other == nil {
+		return connectionStateWaitingForNetwork == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (connectionStateWaitingForNetwork *ConnectionStateWaitingForNetwork) Diff(other *ConnectionStateWaitingForNetwork) []Change {
+	return connectionStateWaitingForNetwork.diff("", other, nil)
+}
+
+func (connectionStateWaitingForNetwork *ConnectionStateWaitingForNetwork) diff(path string, other *ConnectionStateWaitingForNetwork, changes []Change) []Change {
+	if connectionStateWaitingForNetwork == nil || other == nil {
+		if connectionStateWaitingForNetwork != other {
+			changes = append(changes, Change{Path: path, Old: connectionStateWaitingForNetwork, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (connectionStateWaitingForNetwork *ConnectionStateWaitingForNetwork) String() string {
+	return objectString(connectionStateWaitingForNetwork)
+}
+
+// LogValue logs the object as its summary
+func (connectionStateWaitingForNetwork *ConnectionStateWaitingForNetwork) LogValue() slog.Value {
+	return slog.StringValue(objectString(connectionStateWaitingForNetwork))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (connectionStateWaitingForNetwork *ConnectionStateWaitingForNetwork) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, connectionStateWaitingForNetwork)
+}
+
+func (connectionStateWaitingForNetwork *ConnectionStateWaitingForNetwork) printText(p *printer) {
+	if connectionStateWaitingForNetwork == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ConnectionStateWaitingForNetwork")
+	p.endObject(state)
+}
+
 // GetConnectionStateEnum return the enum type of this object
 func (connectionStateWaitingForNetwork *ConnectionStateWaitingForNetwork) GetConnectionStateEnum() ConnectionStateEnum {
 	return ConnectionStateWaitingForNetworkType
 }
 
+// Accept calls the method of the visitor for ConnectionStateWaitingForNetwork
+func (connectionStateWaitingForNetwork *ConnectionStateWaitingForNetwork) Accept(visitor ConnectionStateVisitor) {
+	visitor.VisitConnectionStateWaitingForNetwork(connectionStateWaitingForNetwork)
+}
+
 // ConnectionStateConnectingToProxy Currently establishing a connection with a proxy server
 type ConnectionStateConnectingToProxy struct {
 	tdCommon
@@ -100,7 +248,6 @@ func (connectionStateConnectingToProxy *ConnectionStateConnectingToProxy) Messag
 }
 
 // NewConnectionStateConnectingToProxy creates a new ConnectionStateConnectingToProxy
-//
 func NewConnectionStateConnectingToProxy() *ConnectionStateConnectingToProxy {
 	connectionStateConnectingToProxyTemp := ConnectionStateConnectingToProxy{
 		tdCommon: tdCommon{Type: "connectionStateConnectingToProxy"},
@@ -109,11 +256,107 @@ func NewConnectionStateConnectingToProxy() *ConnectionStateConnectingToProxy {
 	return &connectionStateConnectingToProxyTemp
 }
 
+// MarshalJSON marshals to json
+func (connectionStateConnectingToProxy *ConnectionStateConnectingToProxy) MarshalJSON() ([]byte, error) {
+	return connectionStateConnectingToProxy.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (connectionStateConnectingToProxy *ConnectionStateConnectingToProxy) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, connectionStateConnectingToProxy)
+}
+
+func (connectionStateConnectingToProxy *ConnectionStateConnectingToProxy) appendJSON(b []byte) ([]byte, error) {
+	if connectionStateConnectingToProxy == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, connectionStateConnectingToProxy.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, connectionStateConnectingToProxy.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (connectionStateConnectingToProxy *ConnectionStateConnectingToProxy) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			connectionStateConnectingToProxy.tdCommon.Type, err = r.readString()
+		case "@extra":
+			connectionStateConnectingToProxy.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (connectionStateConnectingToProxy *ConnectionStateConnectingToProxy) Clone() *ConnectionStateConnectingToProxy {
+	if connectionStateConnectingToProxy == nil {
+		return nil
+	}
+	clone := *connectionStateConnectingToProxy
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (connectionStateConnectingToProxy *ConnectionStateConnectingToProxy) Equal(other *ConnectionStateConnectingToProxy) bool {
+	if connectionStateConnectingToProxy == nil || other == nil {
+		return connectionStateConnectingToProxy == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (connectionStateConnectingToProxy *ConnectionStateConnectingToProxy) Diff(other *ConnectionStateConnectingToProxy) []Change {
+	return connectionStateConnectingToProxy.diff("", other, nil)
+}
+
+func (connectionStateConnectingToProxy *ConnectionStateConnectingToProxy) diff(path string, other *ConnectionStateConnectingToProxy, changes []Change) []Change {
+	if connectionStateConnectingToProxy == nil || other == nil {
+		if connectionStateConnectingToProxy != other {
+			changes = append(changes, Change{Path: path, Old: connectionStateConnectingToProxy, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (connectionStateConnectingToProxy *ConnectionStateConnectingToProxy) String() string {
+	return objectString(connectionStateConnectingToProxy)
+}
+
+// LogValue logs the object as its summary
+func (connectionStateConnectingToProxy *ConnectionStateConnectingToProxy) LogValue() slog.Value {
+	return slog.StringValue(objectString(connectionStateConnectingToProxy))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (connectionStateConnectingToProxy *ConnectionStateConnectingToProxy) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, connectionStateConnectingToProxy)
+}
+
+func (connectionStateConnectingToProxy *ConnectionStateConnectingToProxy) printText(p *printer) {
+	if connectionStateConnectingToProxy == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ConnectionStateConnectingToProxy")
+	p.endObject(state)
+}
+
 // GetConnectionStateEnum return the enum type of this object
 func (connectionStateConnectingToProxy *ConnectionStateConnectingToProxy) GetConnectionStateEnum() ConnectionStateEnum {
 	return ConnectionStateConnectingToProxyType
 }
 
+// Accept calls the method of the visitor for ConnectionStateConnectingToProxy
+func (connectionStateConnectingToProxy *ConnectionStateConnectingToProxy) Accept(visitor ConnectionStateVisitor) {
+	visitor.VisitConnectionStateConnectingToProxy(connectionStateConnectingToProxy)
+}
+
 // ConnectionStateConnecting Currently establishing a connection to the Telegram servers
 type ConnectionStateConnecting struct {
 	tdCommon
@@ -125,7 +368,6 @@ func (connectionStateConnecting *ConnectionStateConnecting) MessageType() string
 }
 
 // NewConnectionStateConnecting creates a new ConnectionStateConnecting
-//
 func NewConnectionStateConnecting() *ConnectionStateConnecting {
 	connectionStateConnectingTemp := ConnectionStateConnecting{
 		tdCommon: tdCommon{Type: "connectionStateConnecting"},
@@ -134,11 +376,107 @@ func NewConnectionStateConnecting() *ConnectionStateConnecting {
 	return &connectionStateConnectingTemp
 }
 
+// MarshalJSON marshals to json
+func (connectionStateConnecting *ConnectionStateConnecting) MarshalJSON() ([]byte, error) {
+	return connectionStateConnecting.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (connectionStateConnecting *ConnectionStateConnecting) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, connectionStateConnecting)
+}
+
+func (connectionStateConnecting *ConnectionStateConnecting) appendJSON(b []byte) ([]byte, error) {
+	if connectionStateConnecting == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, connectionStateConnecting.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, connectionStateConnecting.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (connectionStateConnecting *ConnectionStateConnecting) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			connectionStateConnecting.tdCommon.Type, err = r.readString()
+		case "@extra":
+			connectionStateConnecting.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (connectionStateConnecting *ConnectionStateConnecting) Clone() *ConnectionStateConnecting {
+	if connectionStateConnecting == nil {
+		return nil
+	}
+	clone := *connectionStateConnecting
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (connectionStateConnecting *ConnectionStateConnecting) Equal(other *ConnectionStateConnecting) bool {
+	if connectionStateConnecting == nil || other == nil {
+		return connectionStateConnecting == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (connectionStateConnecting *ConnectionStateConnecting) Diff(other *ConnectionStateConnecting) []Change {
+	return connectionStateConnecting.diff("", other, nil)
+}
+
+func (connectionStateConnecting *ConnectionStateConnecting) diff(path string, other *ConnectionStateConnecting, changes []Change) []Change {
+	if connectionStateConnecting == nil || other == nil {
+		if connectionStateConnecting != other {
+			changes = append(changes, Change{Path: path, Old: connectionStateConnecting, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (connectionStateConnecting *ConnectionStateConnecting) String() string {
+	return objectString(connectionStateConnecting)
+}
+
+// LogValue logs the object as its summary
+func (connectionStateConnecting *ConnectionStateConnecting) LogValue() slog.Value {
+	return slog.StringValue(objectString(connectionStateConnecting))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (connectionStateConnecting *ConnectionStateConnecting) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, connectionStateConnecting)
+}
+
+func (connectionStateConnecting *ConnectionStateConnecting) printText(p *printer) {
+	if connectionStateConnecting == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ConnectionStateConnecting")
+	p.endObject(state)
+}
+
 // GetConnectionStateEnum return the enum type of this object
 func (connectionStateConnecting *ConnectionStateConnecting) GetConnectionStateEnum() ConnectionStateEnum {
 	return ConnectionStateConnectingType
 }
 
+// Accept calls the method of the visitor for ConnectionStateConnecting
+func (connectionStateConnecting *ConnectionStateConnecting) Accept(visitor ConnectionStateVisitor) {
+	visitor.VisitConnectionStateConnecting(connectionStateConnecting)
+}
+
 // ConnectionStateUpdating Downloading data received while the application was offline
 type ConnectionStateUpdating struct {
 	tdCommon
@@ -150,7 +488,6 @@ func (connectionStateUpdating *ConnectionStateUpdating) MessageType() string {
 }
 
 // NewConnectionStateUpdating creates a new ConnectionStateUpdating
-//
 func NewConnectionStateUpdating() *ConnectionStateUpdating {
 	connectionStateUpdatingTemp := ConnectionStateUpdating{
 		tdCommon: tdCommon{Type: "connectionStateUpdating"},
@@ -159,11 +496,107 @@ func NewConnectionStateUpdating() *ConnectionStateUpdating {
 	return &connectionStateUpdatingTemp
 }
 
+// MarshalJSON marshals to json
+func (connectionStateUpdating *ConnectionStateUpdating) MarshalJSON() ([]byte, error) {
+	return connectionStateUpdating.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (connectionStateUpdating *ConnectionStateUpdating) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, connectionStateUpdating)
+}
+
+func (connectionStateUpdating *ConnectionStateUpdating) appendJSON(b []byte) ([]byte, error) {
+	if connectionStateUpdating == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, connectionStateUpdating.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, connectionStateUpdating.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (connectionStateUpdating *ConnectionStateUpdating) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			connectionStateUpdating.tdCommon.Type, err = r.readString()
+		case "@extra":
+			connectionStateUpdating.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (connectionStateUpdating *ConnectionStateUpdating) Clone() *ConnectionStateUpdating {
+	if connectionStateUpdating == nil {
+		return nil
+	}
+	clone := *connectionStateUpdating
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (connectionStateUpdating *ConnectionStateUpdating) Equal(other *ConnectionStateUpdating) bool {
+	if connectionStateUpdating == nil || other == nil {
+		return connectionStateUpdating == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (connectionStateUpdating *ConnectionStateUpdating) Diff(other *ConnectionStateUpdating) []Change {
+	return connectionStateUpdating.diff("", other, nil)
+}
+
+func (connectionStateUpdating *ConnectionStateUpdating) diff(path string, other *ConnectionStateUpdating, changes []Change) []Change {
+	if connectionStateUpdating == nil || other == nil {
+		if connectionStateUpdating != other {
+			changes = append(changes, Change{Path: path, Old: connectionStateUpdating, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (connectionStateUpdating *ConnectionStateUpdating) String() string {
+	return objectString(connectionStateUpdating)
+}
+
+// LogValue logs the object as its summary
+func (connectionStateUpdating *ConnectionStateUpdating) LogValue() slog.Value {
+	return slog.StringValue(objectString(connectionStateUpdating))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (connectionStateUpdating *ConnectionStateUpdating) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, connectionStateUpdating)
+}
+
+func (connectionStateUpdating *ConnectionStateUpdating) printText(p *printer) {
+	if connectionStateUpdating == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ConnectionStateUpdating")
+	p.endObject(state)
+}
+
 // GetConnectionStateEnum return the enum type of this object
 func (connectionStateUpdating *ConnectionStateUpdating) GetConnectionStateEnum() ConnectionStateEnum {
 	return ConnectionStateUpdatingType
 }
 
+// Accept calls the method of the visitor for ConnectionStateUpdating
+func (connectionStateUpdating *ConnectionStateUpdating) Accept(visitor ConnectionStateVisitor) {
+	visitor.VisitConnectionStateUpdating(connectionStateUpdating)
+}
+
 // ConnectionStateReady There is a working connection to the Telegram servers
 type ConnectionStateReady struct {
 	tdCommon
@@ -175,7 +608,6 @@ func (connectionStateReady *ConnectionStateReady) MessageType() string {
 }
 
 // NewConnectionStateReady creates a new ConnectionStateReady
-//
 func NewConnectionStateReady() *ConnectionStateReady {
 	connectionStateReadyTemp := ConnectionStateReady{
 		tdCommon: tdCommon{Type: "connectionStateReady"},
@@ -184,7 +616,243 @@ func NewConnectionStateReady() *ConnectionStateReady {
 	return &connectionStateReadyTemp
 }
 
+// MarshalJSON marshals to json
+func (connectionStateReady *ConnectionStateReady) MarshalJSON() ([]byte, error) {
+	return connectionStateReady.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (connectionStateReady *ConnectionStateReady) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, connectionStateReady)
+}
+
+func (connectionStateReady *ConnectionStateReady) appendJSON(b []byte) ([]byte, error) {
+	if connectionStateReady == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, connectionStateReady.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, connectionStateReady.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (connectionStateReady *ConnectionStateReady) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			connectionStateReady.tdCommon.Type, err = r.readString()
+		case "@extra":
+			connectionStateReady.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (connectionStateReady *ConnectionStateReady) Clone() *ConnectionStateReady {
+	if connectionStateReady == nil {
+		return nil
+	}
+	clone := *connectionStateReady
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (connectionStateReady *ConnectionStateReady) Equal(other *ConnectionStateReady) bool {
+	if connectionStateReady == nil || other == nil {
+		return connectionStateReady == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (connectionStateReady *ConnectionStateReady) Diff(other *ConnectionStateReady) []Change {
+	return connectionStateReady.diff("", other, nil)
+}
+
+func (connectionStateReady *ConnectionStateReady) diff(path string, other *ConnectionStateReady, changes []Change) []Change {
+	if connectionStateReady == nil || other == nil {
+		if connectionStateReady != other {
+			changes = append(changes, Change{Path: path, Old: connectionStateReady, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (connectionStateReady *ConnectionStateReady) String() string {
+	return objectString(connectionStateReady)
+}
+
+// LogValue logs the object as its summary
+func (connectionStateReady *ConnectionStateReady) LogValue() slog.Value {
+	return slog.StringValue(objectString(connectionStateReady))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (connectionStateReady *ConnectionStateReady) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, connectionStateReady)
+}
+
+func (connectionStateReady *ConnectionStateReady) printText(p *printer) {
+	if connectionStateReady == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ConnectionStateReady")
+	p.endObject(state)
+}
+
 // GetConnectionStateEnum return the enum type of this object
 func (connectionStateReady *ConnectionStateReady) GetConnectionStateEnum() ConnectionStateEnum {
 	return ConnectionStateReadyType
 }
+
+// Accept calls the method of the visitor for ConnectionStateReady
+func (connectionStateReady *ConnectionStateReady) Accept(visitor ConnectionStateVisitor) {
+	visitor.VisitConnectionStateReady(connectionStateReady)
+}
+
+// ConnectionStateVisitor visits every type of ConnectionState, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to ConnectionState, until they handle it too.
+type ConnectionStateVisitor interface {
+	VisitConnectionStateWaitingForNetwork(connectionStateWaitingForNetwork *ConnectionStateWaitingForNetwork)
+	VisitConnectionStateConnectingToProxy(connectionStateConnectingToProxy *ConnectionStateConnectingToProxy)
+	VisitConnectionStateConnecting(connectionStateConnecting *ConnectionStateConnecting)
+	VisitConnectionStateUpdating(connectionStateUpdating *ConnectionStateUpdating)
+	VisitConnectionStateReady(connectionStateReady *ConnectionStateReady)
+	VisitUnknownConnectionState(unknownConnectionState *UnknownConnectionState)
+}
+
+// ConnectionStateCases holds the function MatchConnectionState calls for each type of ConnectionState;
+// types without a function are passed to the default case
+type ConnectionStateCases[R any] struct {
+	ConnectionStateWaitingForNetwork func(connectionStateWaitingForNetwork *ConnectionStateWaitingForNetwork) R
+	ConnectionStateConnectingToProxy func(connectionStateConnectingToProxy *ConnectionStateConnectingToProxy) R
+	ConnectionStateConnecting        func(connectionStateConnecting *ConnectionStateConnecting) R
+	ConnectionStateUpdating          func(connectionStateUpdating *ConnectionStateUpdating) R
+	ConnectionStateReady             func(connectionStateReady *ConnectionStateReady) R
+}
+
+// MatchConnectionState calls the case of the type of connectionState, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement ConnectionStateVisitor
+// to have the compiler check every type is handled.
+func MatchConnectionState[R any](connectionState ConnectionState, cases ConnectionStateCases[R], defaultCase func(connectionState ConnectionState) R) R {
+	switch value := connectionState.(type) {
+	case *ConnectionStateWaitingForNetwork:
+		if cases.ConnectionStateWaitingForNetwork != nil {
+			return cases.ConnectionStateWaitingForNetwork(value)
+		}
+	case *ConnectionStateConnectingToProxy:
+		if cases.ConnectionStateConnectingToProxy != nil {
+			return cases.ConnectionStateConnectingToProxy(value)
+		}
+	case *ConnectionStateConnecting:
+		if cases.ConnectionStateConnecting != nil {
+			return cases.ConnectionStateConnecting(value)
+		}
+	case *ConnectionStateUpdating:
+		if cases.ConnectionStateUpdating != nil {
+			return cases.ConnectionStateUpdating(value)
+		}
+	case *ConnectionStateReady:
+		if cases.ConnectionStateReady != nil {
+			return cases.ConnectionStateReady(value)
+		}
+	}
+	return defaultCase(connectionState)
+}
+
+// Clone returns a deep copy of the object
+func (unknownConnectionState *UnknownConnectionState) Clone() *UnknownConnectionState {
+	if unknownConnectionState == nil {
+		return nil
+	}
+	return &UnknownConnectionState{Unknown: unknownConnectionState.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownConnectionState *UnknownConnectionState) Equal(other *UnknownConnectionState) bool {
+	if unknownConnectionState == nil || other == nil {
+		return unknownConnectionState == other
+	}
+	return unknownConnectionState.Unknown.equal(other.Unknown)
+}
+
+func cloneConnectionState(connectionState ConnectionState) ConnectionState {
+	switch value := connectionState.(type) {
+	case *ConnectionStateWaitingForNetwork:
+		return value.Clone()
+	case *ConnectionStateConnectingToProxy:
+		return value.Clone()
+	case *ConnectionStateConnecting:
+		return value.Clone()
+	case *ConnectionStateUpdating:
+		return value.Clone()
+	case *ConnectionStateReady:
+		return value.Clone()
+	case *UnknownConnectionState:
+		return value.Clone()
+	}
+	return connectionState
+}
+
+func equalConnectionState(a ConnectionState, b ConnectionState) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *ConnectionStateWaitingForNetwork:
+		b, ok := b.(*ConnectionStateWaitingForNetwork)
+		return ok && a.Equal(b)
+	case *ConnectionStateConnectingToProxy:
+		b, ok := b.(*ConnectionStateConnectingToProxy)
+		return ok && a.Equal(b)
+	case *ConnectionStateConnecting:
+		b, ok := b.(*ConnectionStateConnecting)
+		return ok && a.Equal(b)
+	case *ConnectionStateUpdating:
+		b, ok := b.(*ConnectionStateUpdating)
+		return ok && a.Equal(b)
+	case *ConnectionStateReady:
+		b, ok := b.(*ConnectionStateReady)
+		return ok && a.Equal(b)
+	case *UnknownConnectionState:
+		b, ok := b.(*UnknownConnectionState)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffConnectionState(path string, a ConnectionState, b ConnectionState, changes []Change) []Change {
+	switch a := a.(type) {
+	case *ConnectionStateWaitingForNetwork:
+		if b, ok := b.(*ConnectionStateWaitingForNetwork); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ConnectionStateConnectingToProxy:
+		if b, ok := b.(*ConnectionStateConnectingToProxy); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ConnectionStateConnecting:
+		if b, ok := b.(*ConnectionStateConnecting); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ConnectionStateUpdating:
+		if b, ok := b.(*ConnectionStateUpdating); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ConnectionStateReady:
+		if b, ok := b.(*ConnectionStateReady); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalConnectionState(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // Contact Describes a user contact
 type Contact struct {
 	tdCommon
@@ -34,3 +39,149 @@ func NewContact(phoneNumber string, firstName string, lastName string, vcard str
 
 	return &contactTemp
 }
+
+// MarshalJSON marshals to json
+func (contact *Contact) MarshalJSON() ([]byte, error) {
+	return contact.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (contact *Contact) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, contact)
+}
+
+func (contact *Contact) appendJSON(b []byte) ([]byte, error) {
+	if contact == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, contact.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, contact.tdCommon.Extra)
+	b = append(b, `,"phone_number":`...)
+	b = appendJSONString(b, contact.PhoneNumber)
+	b = append(b, `,"first_name":`...)
+	b = appendJSONString(b, contact.FirstName)
+	b = append(b, `,"last_name":`...)
+	b = appendJSONString(b, contact.LastName)
+	b = append(b, `,"vcard":`...)
+	b = appendJSONString(b, contact.Vcard)
+	b = append(b, `,"user_id":`...)
+	b = appendJSONInt(b, int64(contact.UserId))
+	return append(b, '}'), nil
+}
+
+func (contact *Contact) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			contact.tdCommon.Type, err = r.readString()
+		case "@extra":
+			contact.tdCommon.Extra, err = r.readString()
+		case "phone_number":
+			contact.PhoneNumber, err = r.readString()
+		case "first_name":
+			contact.FirstName, err = r.readString()
+		case "last_name":
+			contact.LastName, err = r.readString()
+		case "vcard":
+			contact.Vcard, err = r.readString()
+		case "user_id":
+			contact.UserId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (contact *Contact) Clone() *Contact {
+	if contact == nil {
+		return nil
+	}
+	clone := *contact
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (contact *Contact) Equal(other *Contact) bool {
+	if contact == nil || other == nil {
+		return contact == other
+	}
+	if contact.PhoneNumber != other.PhoneNumber {
+		return false
+	}
+	if contact.FirstName != other.FirstName {
+		return false
+	}
+	if contact.LastName != other.LastName {
+		return false
+	}
+	if contact.Vcard != other.Vcard {
+		return false
+	}
+	if contact.UserId != other.UserId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (contact *Contact) Diff(other *Contact) []Change {
+	return contact.diff("", other, nil)
+}
+
+func (contact *Contact) diff(path string, other *Contact, changes []Change) []Change {
+	if contact == nil || other == nil {
+		if contact != other {
+			changes = append(changes, Change{Path: path, Old: contact, New: other})
+		}
+		return changes
+	}
+	if contact.PhoneNumber != other.PhoneNumber {
+		changes = append(changes, Change{Path: fieldPath(path, "phone_number"), Old: contact.PhoneNumber, New: other.PhoneNumber})
+	}
+	if contact.FirstName != other.FirstName {
+		changes = append(changes, Change{Path: fieldPath(path, "first_name"), Old: contact.FirstName, New: other.FirstName})
+	}
+	if contact.LastName != other.LastName {
+		changes = append(changes, Change{Path: fieldPath(path, "last_name"), Old: contact.LastName, New: other.LastName})
+	}
+	if contact.Vcard != other.Vcard {
+		changes = append(changes, Change{Path: fieldPath(path, "vcard"), Old: contact.Vcard, New: other.Vcard})
+	}
+	if contact.UserId != other.UserId {
+		changes = append(changes, Change{Path: fieldPath(path, "user_id"), Old: contact.UserId, New: other.UserId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (contact *Contact) String() string {
+	return objectString(contact)
+}
+
+// LogValue logs the object as its summary
+func (contact *Contact) LogValue() slog.Value {
+	return slog.StringValue(objectString(contact))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (contact *Contact) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, contact)
+}
+
+func (contact *Contact) printText(p *printer) {
+	if contact == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Contact")
+	p.string("phone_number", contact.PhoneNumber)
+	p.string("first_name", contact.FirstName)
+	p.string("last_name", contact.LastName)
+	p.string("vcard", contact.Vcard)
+	p.int("user_id", int64(contact.UserId))
+	p.endObject(state)
+}
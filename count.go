@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // Count Contains a counter
@@ -28,6 +28,108 @@ func NewCount(count int32) *Count {
 	return &countTemp
 }
 
+// MarshalJSON marshals to json
+func (count *Count) MarshalJSON() ([]byte, error) {
+	return count.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (count *Count) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, count)
+}
+
+func (count *Count) appendJSON(b []byte) ([]byte, error) {
+	if count == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, count.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, count.tdCommon.Extra)
+	b = append(b, `,"count":`...)
+	b = appendJSONInt(b, int64(count.Count))
+	return append(b, '}'), nil
+}
+
+func (count *Count) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			count.tdCommon.Type, err = r.readString()
+		case "@extra":
+			count.tdCommon.Extra, err = r.readString()
+		case "count":
+			count.Count, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (count *Count) Clone() *Count {
+	if count == nil {
+		return nil
+	}
+	clone := *count
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (count *Count) Equal(other *Count) bool {
+	if count == nil || other == nil {
+		return count == other
+	}
+	if count.Count != other.Count {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (count *Count) Diff(other *Count) []Change {
+	return count.diff("", other, nil)
+}
+
+func (count *Count) diff(path string, other *Count, changes []Change) []Change {
+	if count == nil || other == nil {
+		if count != other {
+			changes = append(changes, Change{Path: path, Old: count, New: other})
+		}
+		return changes
+	}
+	if count.Count != other.Count {
+		changes = append(changes, Change{Path: fieldPath(path, "count"), Old: count.Count, New: other.Count})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (count *Count) String() string {
+	return objectString(count)
+}
+
+// LogValue logs the object as its summary
+func (count *Count) LogValue() slog.Value {
+	return slog.StringValue(objectString(count))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (count *Count) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, count)
+}
+
+func (count *Count) printText(p *printer) {
+	if count == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Count")
+	p.int("count", int64(count.Count))
+	p.endObject(state)
+}
+
 // GetChatMessageCount Returns approximate number of messages of the specified type in the chat
 // @param chatId Identifier of the chat in which to count messages
 // @param filter Filter for message content; searchMessagesFilterEmpty is unsupported in this function
@@ -44,12 +146,12 @@ func (client *Client) GetChatMessageCount(chatId int64, filter SearchMessagesFil
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var count Count
-	err = json.Unmarshal(result.Raw, &count)
+	err = jsonUnmarshal(result.Raw, &count)
 	return &count, err
 }
 
@@ -67,12 +169,12 @@ func (client *Client) GetFileDownloadedPrefixSize(fileId int32, offset int32) (*
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var count Count
-	err = json.Unmarshal(result.Raw, &count)
+	err = jsonUnmarshal(result.Raw, &count)
 	return &count, err
 }
 
@@ -86,11 +188,11 @@ func (client *Client) GetImportedContactCount() (*Count, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var count Count
-	err = json.Unmarshal(result.Raw, &count)
+	err = jsonUnmarshal(result.Raw, &count)
 	return &count, err
 }
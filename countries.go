@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // Countries Contains information about countries
@@ -28,6 +28,150 @@ func NewCountries(countries []CountryInfo) *Countries {
 	return &countriesTemp
 }
 
+// MarshalJSON marshals to json
+func (countries *Countries) MarshalJSON() ([]byte, error) {
+	return countries.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (countries *Countries) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, countries)
+}
+
+func (countries *Countries) appendJSON(b []byte) ([]byte, error) {
+	if countries == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, countries.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, countries.tdCommon.Extra)
+	b = append(b, `,"countries":`...)
+	if countries.Countries == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range countries.Countries {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = countries.Countries[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (countries *Countries) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			countries.tdCommon.Type, err = r.readString()
+		case "@extra":
+			countries.tdCommon.Extra, err = r.readString()
+		case "countries":
+			if r.readNull() {
+				countries.Countries = nil
+			} else {
+				countries.Countries = make([]CountryInfo, 0)
+				err = r.readArray(func() (err error) {
+					var item0 CountryInfo
+					err = item0.decodeJSON(r)
+					countries.Countries = append(countries.Countries, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (countries *Countries) Clone() *Countries {
+	if countries == nil {
+		return nil
+	}
+	clone := *countries
+	if countries.Countries != nil {
+		clone.Countries = make([]CountryInfo, len(countries.Countries))
+		for i0 := range countries.Countries {
+			clone.Countries[i0] = *countries.Countries[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (countries *Countries) Equal(other *Countries) bool {
+	if countries == nil || other == nil {
+		return countries == other
+	}
+	if len(countries.Countries) != len(other.Countries) {
+		return false
+	}
+	for i0 := range countries.Countries {
+		if !countries.Countries[i0].Equal(&other.Countries[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (countries *Countries) Diff(other *Countries) []Change {
+	return countries.diff("", other, nil)
+}
+
+func (countries *Countries) diff(path string, other *Countries, changes []Change) []Change {
+	if countries == nil || other == nil {
+		if countries != other {
+			changes = append(changes, Change{Path: path, Old: countries, New: other})
+		}
+		return changes
+	}
+	if len(countries.Countries) != len(other.Countries) {
+		changes = append(changes, Change{Path: fieldPath(path, "countries"), Old: countries.Countries, New: other.Countries})
+	} else {
+		for i0 := range countries.Countries {
+			changes = countries.Countries[i0].diff(indexPath(fieldPath(path, "countries"), i0), &other.Countries[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (countries *Countries) String() string {
+	return objectString(countries)
+}
+
+// LogValue logs the object as its summary
+func (countries *Countries) LogValue() slog.Value {
+	return slog.StringValue(objectString(countries))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (countries *Countries) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, countries)
+}
+
+func (countries *Countries) printText(p *printer) {
+	if countries == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Countries")
+	p.vector("countries", len(countries.Countries), func(i0 int) {
+		countries.Countries[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetCountries Returns information about existing countries. Can be called before authorization
 func (client *Client) GetCountries() (*Countries, error) {
 	result, err := client.SendAndCatch(UpdateData{
@@ -38,11 +182,11 @@ func (client *Client) GetCountries() (*Countries, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var countries Countries
-	err = json.Unmarshal(result.Raw, &countries)
+	err = jsonUnmarshal(result.Raw, &countries)
 	return &countries, err
 }
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // CountryInfo Contains information about a country
 type CountryInfo struct {
 	tdCommon
@@ -34,3 +39,187 @@ func NewCountryInfo(countryCode string, name string, englishName string, isHidde
 
 	return &countryInfoTemp
 }
+
+// MarshalJSON marshals to json
+func (countryInfo *CountryInfo) MarshalJSON() ([]byte, error) {
+	return countryInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (countryInfo *CountryInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, countryInfo)
+}
+
+func (countryInfo *CountryInfo) appendJSON(b []byte) ([]byte, error) {
+	if countryInfo == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, countryInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, countryInfo.tdCommon.Extra)
+	b = append(b, `,"country_code":`...)
+	b = appendJSONString(b, countryInfo.CountryCode)
+	b = append(b, `,"name":`...)
+	b = appendJSONString(b, countryInfo.Name)
+	b = append(b, `,"english_name":`...)
+	b = appendJSONString(b, countryInfo.EnglishName)
+	b = append(b, `,"is_hidden":`...)
+	b = appendJSONBool(b, countryInfo.IsHidden)
+	b = append(b, `,"calling_codes":`...)
+	if countryInfo.CallingCodes == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range countryInfo.CallingCodes {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONString(b, countryInfo.CallingCodes[i0])
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (countryInfo *CountryInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			countryInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			countryInfo.tdCommon.Extra, err = r.readString()
+		case "country_code":
+			countryInfo.CountryCode, err = r.readString()
+		case "name":
+			countryInfo.Name, err = r.readString()
+		case "english_name":
+			countryInfo.EnglishName, err = r.readString()
+		case "is_hidden":
+			countryInfo.IsHidden, err = r.readBool()
+		case "calling_codes":
+			if r.readNull() {
+				countryInfo.CallingCodes = nil
+			} else {
+				countryInfo.CallingCodes = make([]string, 0)
+				err = r.readArray(func() (err error) {
+					var item0 string
+					item0, err = r.readString()
+					countryInfo.CallingCodes = append(countryInfo.CallingCodes, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (countryInfo *CountryInfo) Clone() *CountryInfo {
+	if countryInfo == nil {
+		return nil
+	}
+	clone := *countryInfo
+	if countryInfo.CallingCodes != nil {
+		clone.CallingCodes = make([]string, len(countryInfo.CallingCodes))
+		copy(clone.CallingCodes, countryInfo.CallingCodes)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (countryInfo *CountryInfo) Equal(other *CountryInfo) bool {
+	if countryInfo == nil || other == nil {
+		return countryInfo == other
+	}
+	if countryInfo.CountryCode != other.CountryCode {
+		return false
+	}
+	if countryInfo.Name != other.Name {
+		return false
+	}
+	if countryInfo.EnglishName != other.EnglishName {
+		return false
+	}
+	if countryInfo.IsHidden != other.IsHidden {
+		return false
+	}
+	if len(countryInfo.CallingCodes) != len(other.CallingCodes) {
+		return false
+	}
+	for i0 := range countryInfo.CallingCodes {
+		if countryInfo.CallingCodes[i0] != other.CallingCodes[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (countryInfo *CountryInfo) Diff(other *CountryInfo) []Change {
+	return countryInfo.diff("", other, nil)
+}
+
+func (countryInfo *CountryInfo) diff(path string, other *CountryInfo, changes []Change) []Change {
+	if countryInfo == nil || other == nil {
+		if countryInfo != other {
+			changes = append(changes, Change{Path: path, Old: countryInfo, New: other})
+		}
+		return changes
+	}
+	if countryInfo.CountryCode != other.CountryCode {
+		changes = append(changes, Change{Path: fieldPath(path, "country_code"), Old: countryInfo.CountryCode, New: other.CountryCode})
+	}
+	if countryInfo.Name != other.Name {
+		changes = append(changes, Change{Path: fieldPath(path, "name"), Old: countryInfo.Name, New: other.Name})
+	}
+	if countryInfo.EnglishName != other.EnglishName {
+		changes = append(changes, Change{Path: fieldPath(path, "english_name"), Old: countryInfo.EnglishName, New: other.EnglishName})
+	}
+	if countryInfo.IsHidden != other.IsHidden {
+		changes = append(changes, Change{Path: fieldPath(path, "is_hidden"), Old: countryInfo.IsHidden, New: other.IsHidden})
+	}
+	if len(countryInfo.CallingCodes) != len(other.CallingCodes) {
+		changes = append(changes, Change{Path: fieldPath(path, "calling_codes"), Old: countryInfo.CallingCodes, New: other.CallingCodes})
+	} else {
+		for i0 := range countryInfo.CallingCodes {
+			if countryInfo.CallingCodes[i0] != other.CallingCodes[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "calling_codes"), i0), Old: countryInfo.CallingCodes[i0], New: other.CallingCodes[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (countryInfo *CountryInfo) String() string {
+	return objectString(countryInfo)
+}
+
+// LogValue logs the object as its summary
+func (countryInfo *CountryInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(countryInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (countryInfo *CountryInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, countryInfo)
+}
+
+func (countryInfo *CountryInfo) printText(p *printer) {
+	if countryInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CountryInfo")
+	p.string("country_code", countryInfo.CountryCode)
+	p.string("name", countryInfo.Name)
+	p.string("english_name", countryInfo.EnglishName)
+	p.bool("is_hidden", countryInfo.IsHidden)
+	p.vector("calling_codes", len(countryInfo.CallingCodes), func(i0 int) {
+		p.string("calling_codes", countryInfo.CallingCodes[i0])
+	})
+	p.endObject(state)
+}
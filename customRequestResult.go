@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // CustomRequestResult Contains the result of a custom request
@@ -28,6 +28,108 @@ func NewCustomRequestResult(result string) *CustomRequestResult {
 	return &customRequestResultTemp
 }
 
+// MarshalJSON marshals to json
+func (customRequestResult *CustomRequestResult) MarshalJSON() ([]byte, error) {
+	return customRequestResult.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (customRequestResult *CustomRequestResult) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, customRequestResult)
+}
+
+func (customRequestResult *CustomRequestResult) appendJSON(b []byte) ([]byte, error) {
+	if customRequestResult == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, customRequestResult.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, customRequestResult.tdCommon.Extra)
+	b = append(b, `,"result":`...)
+	b = appendJSONString(b, customRequestResult.Result)
+	return append(b, '}'), nil
+}
+
+func (customRequestResult *CustomRequestResult) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			customRequestResult.tdCommon.Type, err = r.readString()
+		case "@extra":
+			customRequestResult.tdCommon.Extra, err = r.readString()
+		case "result":
+			customRequestResult.Result, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (customRequestResult *CustomRequestResult) Clone() *CustomRequestResult {
+	if customRequestResult == nil {
+		return nil
+	}
+	clone := *customRequestResult
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (customRequestResult *CustomRequestResult) Equal(other *CustomRequestResult) bool {
+	if customRequestResult == nil || other == nil {
+		return customRequestResult == other
+	}
+	if customRequestResult.Result != other.Result {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (customRequestResult *CustomRequestResult) Diff(other *CustomRequestResult) []Change {
+	return customRequestResult.diff("", other, nil)
+}
+
+func (customRequestResult *CustomRequestResult) diff(path string, other *CustomRequestResult, changes []Change) []Change {
+	if customRequestResult == nil || other == nil {
+		if customRequestResult != other {
+			changes = append(changes, Change{Path: path, Old: customRequestResult, New: other})
+		}
+		return changes
+	}
+	if customRequestResult.Result != other.Result {
+		changes = append(changes, Change{Path: fieldPath(path, "result"), Old: customRequestResult.Result, New: other.Result})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (customRequestResult *CustomRequestResult) String() string {
+	return objectString(customRequestResult)
+}
+
+// LogValue logs the object as its summary
+func (customRequestResult *CustomRequestResult) LogValue() slog.Value {
+	return slog.StringValue(objectString(customRequestResult))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (customRequestResult *CustomRequestResult) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, customRequestResult)
+}
+
+func (customRequestResult *CustomRequestResult) printText(p *printer) {
+	if customRequestResult == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("CustomRequestResult")
+	p.string("result", customRequestResult.Result)
+	p.endObject(state)
+}
+
 // SendCustomRequest Sends a custom request; for bots only
 // @param method The method name
 // @param parameters JSON-serialized method parameters
@@ -42,11 +144,11 @@ func (client *Client) SendCustomRequest(method string, parameters string) (*Cust
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var customRequestResult CustomRequestResult
-	err = json.Unmarshal(result.Raw, &customRequestResult)
+	err = jsonUnmarshal(result.Raw, &customRequestResult)
 	return &customRequestResult, err
 }
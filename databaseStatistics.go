@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // DatabaseStatistics Contains database statistics
@@ -28,6 +28,108 @@ func NewDatabaseStatistics(statistics string) *DatabaseStatistics {
 	return &databaseStatisticsTemp
 }
 
+// MarshalJSON marshals to json
+func (databaseStatistics *DatabaseStatistics) MarshalJSON() ([]byte, error) {
+	return databaseStatistics.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (databaseStatistics *DatabaseStatistics) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, databaseStatistics)
+}
+
+func (databaseStatistics *DatabaseStatistics) appendJSON(b []byte) ([]byte, error) {
+	if databaseStatistics == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, databaseStatistics.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, databaseStatistics.tdCommon.Extra)
+	b = append(b, `,"statistics":`...)
+	b = appendJSONString(b, databaseStatistics.Statistics)
+	return append(b, '}'), nil
+}
+
+func (databaseStatistics *DatabaseStatistics) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			databaseStatistics.tdCommon.Type, err = r.readString()
+		case "@extra":
+			databaseStatistics.tdCommon.Extra, err = r.readString()
+		case "statistics":
+			databaseStatistics.Statistics, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (databaseStatistics *DatabaseStatistics) Clone() *DatabaseStatistics {
+	if databaseStatistics == nil {
+		return nil
+	}
+	clone := *databaseStatistics
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (databaseStatistics *DatabaseStatistics) Equal(other *DatabaseStatistics) bool {
+	if databaseStatistics == nil || other == nil {
+		return databaseStatistics == other
+	}
+	if databaseStatistics.Statistics != other.Statistics {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (databaseStatistics *DatabaseStatistics) Diff(other *DatabaseStatistics) []Change {
+	return databaseStatistics.diff("", other, nil)
+}
+
+func (databaseStatistics *DatabaseStatistics) diff(path string, other *DatabaseStatistics, changes []Change) []Change {
+	if databaseStatistics == nil || other == nil {
+		if databaseStatistics != other {
+			changes = append(changes, Change{Path: path, Old: databaseStatistics, New: other})
+		}
+		return changes
+	}
+	if databaseStatistics.Statistics != other.Statistics {
+		changes = append(changes, Change{Path: fieldPath(path, "statistics"), Old: databaseStatistics.Statistics, New: other.Statistics})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (databaseStatistics *DatabaseStatistics) String() string {
+	return objectString(databaseStatistics)
+}
+
+// LogValue logs the object as its summary
+func (databaseStatistics *DatabaseStatistics) LogValue() slog.Value {
+	return slog.StringValue(objectString(databaseStatistics))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (databaseStatistics *DatabaseStatistics) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, databaseStatistics)
+}
+
+func (databaseStatistics *DatabaseStatistics) printText(p *printer) {
+	if databaseStatistics == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("DatabaseStatistics")
+	p.string("statistics", databaseStatistics.Statistics)
+	p.endObject(state)
+}
+
 // GetDatabaseStatistics Returns database statistics
 func (client *Client) GetDatabaseStatistics() (*DatabaseStatistics, error) {
 	result, err := client.SendAndCatch(UpdateData{
@@ -38,11 +140,11 @@ func (client *Client) GetDatabaseStatistics() (*DatabaseStatistics, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var databaseStatistics DatabaseStatistics
-	err = json.Unmarshal(result.Raw, &databaseStatistics)
+	err = jsonUnmarshal(result.Raw, &databaseStatistics)
 	return &databaseStatistics, err
 }
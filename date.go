@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // Date Represents a date according to the Gregorian calendar
 type Date struct {
 	tdCommon
@@ -28,3 +33,127 @@ func NewDate(day int32, month int32, year int32) *Date {
 
 	return &dateTemp
 }
+
+// MarshalJSON marshals to json
+func (date *Date) MarshalJSON() ([]byte, error) {
+	return date.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (date *Date) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, date)
+}
+
+func (date *Date) appendJSON(b []byte) ([]byte, error) {
+	if date == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, date.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, date.tdCommon.Extra)
+	b = append(b, `,"day":`...)
+	b = appendJSONInt(b, int64(date.Day))
+	b = append(b, `,"month":`...)
+	b = appendJSONInt(b, int64(date.Month))
+	b = append(b, `,"year":`...)
+	b = appendJSONInt(b, int64(date.Year))
+	return append(b, '}'), nil
+}
+
+func (date *Date) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			date.tdCommon.Type, err = r.readString()
+		case "@extra":
+			date.tdCommon.Extra, err = r.readString()
+		case "day":
+			date.Day, err = r.readInt32()
+		case "month":
+			date.Month, err = r.readInt32()
+		case "year":
+			date.Year, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (date *Date) Clone() *Date {
+	if date == nil {
+		return nil
+	}
+	clone := *date
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (date *Date) Equal(other *Date) bool {
+	if date == nil || other == nil {
+		return date == other
+	}
+	if date.Day != other.Day {
+		return false
+	}
+	if date.Month != other.Month {
+		return false
+	}
+	if date.Year != other.Year {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (date *Date) Diff(other *Date) []Change {
+	return date.diff("", other, nil)
+}
+
+func (date *Date) diff(path string, other *Date, changes []Change) []Change {
+	if date == nil || other == nil {
+		if date != other {
+			changes = append(changes, Change{Path: path, Old: date, New: other})
+		}
+		return changes
+	}
+	if date.Day != other.Day {
+		changes = append(changes, Change{Path: fieldPath(path, "day"), Old: date.Day, New: other.Day})
+	}
+	if date.Month != other.Month {
+		changes = append(changes, Change{Path: fieldPath(path, "month"), Old: date.Month, New: other.Month})
+	}
+	if date.Year != other.Year {
+		changes = append(changes, Change{Path: fieldPath(path, "year"), Old: date.Year, New: other.Year})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (date *Date) String() string {
+	return objectString(date)
+}
+
+// LogValue logs the object as its summary
+func (date *Date) LogValue() slog.Value {
+	return slog.StringValue(objectString(date))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (date *Date) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, date)
+}
+
+func (date *Date) printText(p *printer) {
+	if date == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Date")
+	p.int("day", int64(date.Day))
+	p.int("month", int64(date.Month))
+	p.int("year", int64(date.Year))
+	p.endObject(state)
+}
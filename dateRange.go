@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // DateRange Represents a date range
 type DateRange struct {
 	tdCommon
@@ -25,3 +30,116 @@ func NewDateRange(startDate int32, endDate int32) *DateRange {
 
 	return &dateRangeTemp
 }
+
+// MarshalJSON marshals to json
+func (dateRange *DateRange) MarshalJSON() ([]byte, error) {
+	return dateRange.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (dateRange *DateRange) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, dateRange)
+}
+
+func (dateRange *DateRange) appendJSON(b []byte) ([]byte, error) {
+	if dateRange == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, dateRange.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, dateRange.tdCommon.Extra)
+	b = append(b, `,"start_date":`...)
+	b = appendJSONInt(b, int64(dateRange.StartDate))
+	b = append(b, `,"end_date":`...)
+	b = appendJSONInt(b, int64(dateRange.EndDate))
+	return append(b, '}'), nil
+}
+
+func (dateRange *DateRange) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			dateRange.tdCommon.Type, err = r.readString()
+		case "@extra":
+			dateRange.tdCommon.Extra, err = r.readString()
+		case "start_date":
+			dateRange.StartDate, err = r.readInt32()
+		case "end_date":
+			dateRange.EndDate, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (dateRange *DateRange) Clone() *DateRange {
+	if dateRange == nil {
+		return nil
+	}
+	clone := *dateRange
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (dateRange *DateRange) Equal(other *DateRange) bool {
+	if dateRange == nil || other == nil {
+		return dateRange == other
+	}
+	if dateRange.StartDate != other.StartDate {
+		return false
+	}
+	if dateRange.EndDate != other.EndDate {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (dateRange *DateRange) Diff(other *DateRange) []Change {
+	return dateRange.diff("", other, nil)
+}
+
+func (dateRange *DateRange) diff(path string, other *DateRange, changes []Change) []Change {
+	if dateRange == nil || other == nil {
+		if dateRange != other {
+			changes = append(changes, Change{Path: path, Old: dateRange, New: other})
+		}
+		return changes
+	}
+	if dateRange.StartDate != other.StartDate {
+		changes = append(changes, Change{Path: fieldPath(path, "start_date"), Old: dateRange.StartDate, New: other.StartDate})
+	}
+	if dateRange.EndDate != other.EndDate {
+		changes = append(changes, Change{Path: fieldPath(path, "end_date"), Old: dateRange.EndDate, New: other.EndDate})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (dateRange *DateRange) String() string {
+	return objectString(dateRange)
+}
+
+// LogValue logs the object as its summary
+func (dateRange *DateRange) LogValue() slog.Value {
+	return slog.StringValue(objectString(dateRange))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (dateRange *DateRange) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, dateRange)
+}
+
+func (dateRange *DateRange) printText(p *printer) {
+	if dateRange == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("DateRange")
+	p.int("start_date", int64(dateRange.StartDate))
+	p.int("end_date", int64(dateRange.EndDate))
+	p.endObject(state)
+}
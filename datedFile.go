@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // DatedFile File with the date it was uploaded
 type DatedFile struct {
 	tdCommon
@@ -25,3 +30,124 @@ func NewDatedFile(file *File, date int32) *DatedFile {
 
 	return &datedFileTemp
 }
+
+// MarshalJSON marshals to json
+func (datedFile *DatedFile) MarshalJSON() ([]byte, error) {
+	return datedFile.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (datedFile *DatedFile) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, datedFile)
+}
+
+func (datedFile *DatedFile) appendJSON(b []byte) ([]byte, error) {
+	if datedFile == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, datedFile.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, datedFile.tdCommon.Extra)
+	b = append(b, `,"file":`...)
+	b, err = datedFile.File.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"date":`...)
+	b = appendJSONInt(b, int64(datedFile.Date))
+	return append(b, '}'), nil
+}
+
+func (datedFile *DatedFile) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			datedFile.tdCommon.Type, err = r.readString()
+		case "@extra":
+			datedFile.tdCommon.Extra, err = r.readString()
+		case "file":
+			if r.readNull() {
+				datedFile.File = nil
+			} else {
+				datedFile.File = new(File)
+				err = datedFile.File.decodeJSON(r)
+			}
+		case "date":
+			datedFile.Date, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (datedFile *DatedFile) Clone() *DatedFile {
+	if datedFile == nil {
+		return nil
+	}
+	clone := *datedFile
+	clone.File = datedFile.File.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (datedFile *DatedFile) Equal(other *DatedFile) bool {
+	if datedFile == nil || other == nil {
+		return datedFile == other
+	}
+	if !datedFile.File.Equal(other.File) {
+		return false
+	}
+	if datedFile.Date != other.Date {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (datedFile *DatedFile) Diff(other *DatedFile) []Change {
+	return datedFile.diff("", other, nil)
+}
+
+func (datedFile *DatedFile) diff(path string, other *DatedFile, changes []Change) []Change {
+	if datedFile == nil || other == nil {
+		if datedFile != other {
+			changes = append(changes, Change{Path: path, Old: datedFile, New: other})
+		}
+		return changes
+	}
+	changes = datedFile.File.diff(fieldPath(path, "file"), other.File, changes)
+	if datedFile.Date != other.Date {
+		changes = append(changes, Change{Path: fieldPath(path, "date"), Old: datedFile.Date, New: other.Date})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (datedFile *DatedFile) String() string {
+	return objectString(datedFile)
+}
+
+// LogValue logs the object as its summary
+func (datedFile *DatedFile) LogValue() slog.Value {
+	return slog.StringValue(objectString(datedFile))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (datedFile *DatedFile) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, datedFile)
+}
+
+func (datedFile *DatedFile) printText(p *printer) {
+	if datedFile == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("DatedFile")
+	p.object("file", datedFile.File)
+	p.int("date", int64(datedFile.Date))
+	p.endObject(state)
+}
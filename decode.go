@@ -0,0 +1,60 @@
+package tdlib
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+var (
+	typePrefix = []byte(`{"@type":"`)
+	extraKey   = []byte(`"@extra"`)
+)
+
+// UnmarshalTdMessage decodes an object of any type into its generated type, e.g. an update into *UpdateNewMessage.
+// Objects of unknown types are decoded into *Unknown, unless strict decoding is enabled.
+func UnmarshalTdMessage(rawMsg []byte) (TdMessage, error) {
+	typeName, err := sniffType(rawMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalTdMessage(typeName, rawMsg)
+}
+
+// sniffType returns the @type of a JSON object without decoding the whole object.
+// TDLib writes @type first, so it's usually read right from the beginning of the object.
+func sniffType(rawMsg []byte) (string, error) {
+	if bytes.HasPrefix(rawMsg, typePrefix) {
+		rest := rawMsg[len(typePrefix):]
+		if end := bytes.IndexByte(rest, '"'); end >= 0 && bytes.IndexByte(rest[:end], '\\') < 0 {
+			return string(rest[:end]), nil
+		}
+	}
+
+	var header struct {
+		Type string `json:"@type"`
+	}
+	if err := json.Unmarshal(rawMsg, &header); err != nil {
+		return "", err
+	}
+	if header.Type == "" {
+		return "", errors.New("missing @type")
+	}
+	return header.Type, nil
+}
+
+// sniffExtra returns the @extra of a JSON object, which only responses to requests have
+func sniffExtra(rawMsg []byte) (string, bool) {
+	if !bytes.Contains(rawMsg, extraKey) {
+		return "", false
+	}
+
+	var header struct {
+		Extra *string `json:"@extra"`
+	}
+	if err := json.Unmarshal(rawMsg, &header); err != nil || header.Extra == nil {
+		return "", false
+	}
+	return *header.Extra, true
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // DeepLinkInfo Contains information about a tg: deep link
@@ -31,6 +31,127 @@ func NewDeepLinkInfo(text *FormattedText, needUpdateApplication bool) *DeepLinkI
 	return &deepLinkInfoTemp
 }
 
+// MarshalJSON marshals to json
+func (deepLinkInfo *DeepLinkInfo) MarshalJSON() ([]byte, error) {
+	return deepLinkInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (deepLinkInfo *DeepLinkInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, deepLinkInfo)
+}
+
+func (deepLinkInfo *DeepLinkInfo) appendJSON(b []byte) ([]byte, error) {
+	if deepLinkInfo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, deepLinkInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, deepLinkInfo.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b, err = deepLinkInfo.Text.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"need_update_application":`...)
+	b = appendJSONBool(b, deepLinkInfo.NeedUpdateApplication)
+	return append(b, '}'), nil
+}
+
+func (deepLinkInfo *DeepLinkInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			deepLinkInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			deepLinkInfo.tdCommon.Extra, err = r.readString()
+		case "text":
+			if r.readNull() {
+				deepLinkInfo.Text = nil
+			} else {
+				deepLinkInfo.Text = new(FormattedText)
+				err = deepLinkInfo.Text.decodeJSON(r)
+			}
+		case "need_update_application":
+			deepLinkInfo.NeedUpdateApplication, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (deepLinkInfo *DeepLinkInfo) Clone() *DeepLinkInfo {
+	if deepLinkInfo == nil {
+		return nil
+	}
+	clone := *deepLinkInfo
+	clone.Text = deepLinkInfo.Text.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (deepLinkInfo *DeepLinkInfo) Equal(other *DeepLinkInfo) bool {
+	if deepLinkInfo == nil || other == nil {
+		return deepLinkInfo == other
+	}
+	if !deepLinkInfo.Text.Equal(other.Text) {
+		return false
+	}
+	if deepLinkInfo.NeedUpdateApplication != other.NeedUpdateApplication {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (deepLinkInfo *DeepLinkInfo) Diff(other *DeepLinkInfo) []Change {
+	return deepLinkInfo.diff("", other, nil)
+}
+
+func (deepLinkInfo *DeepLinkInfo) diff(path string, other *DeepLinkInfo, changes []Change) []Change {
+	if deepLinkInfo == nil || other == nil {
+		if deepLinkInfo != other {
+			changes = append(changes, Change{Path: path, Old: deepLinkInfo, New: other})
+		}
+		return changes
+	}
+	changes = deepLinkInfo.Text.diff(fieldPath(path, "text"), other.Text, changes)
+	if deepLinkInfo.NeedUpdateApplication != other.NeedUpdateApplication {
+		changes = append(changes, Change{Path: fieldPath(path, "need_update_application"), Old: deepLinkInfo.NeedUpdateApplication, New: other.NeedUpdateApplication})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (deepLinkInfo *DeepLinkInfo) String() string {
+	return objectString(deepLinkInfo)
+}
+
+// LogValue logs the object as its summary
+func (deepLinkInfo *DeepLinkInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(deepLinkInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (deepLinkInfo *DeepLinkInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, deepLinkInfo)
+}
+
+func (deepLinkInfo *DeepLinkInfo) printText(p *printer) {
+	if deepLinkInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("DeepLinkInfo")
+	p.object("text", deepLinkInfo.Text)
+	p.bool("need_update_application", deepLinkInfo.NeedUpdateApplication)
+	p.endObject(state)
+}
+
 // GetDeepLinkInfo Returns information about a tg:// deep link. Use "tg://need_update_for_some_feature" or "tg:some_unsupported_feature" for testing. Returns a 404 error for unknown links. Can be called before authorization
 // @param link The link
 func (client *Client) GetDeepLinkInfo(link string) (*DeepLinkInfo, error) {
@@ -43,11 +164,11 @@ func (client *Client) GetDeepLinkInfo(link string) (*DeepLinkInfo, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var deepLinkInfo DeepLinkInfo
-	err = json.Unmarshal(result.Raw, &deepLinkInfo)
+	err = jsonUnmarshal(result.Raw, &deepLinkInfo)
 	return &deepLinkInfo, err
 }
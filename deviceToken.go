@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // DeviceToken Represents a data needed to subscribe for push notifications through registerDevice method. To use specific push notification service, the correct application platform must be specified and a valid server authentication data must be uploaded at https://my.telegram.org
 type DeviceToken interface {
 	GetDeviceTokenEnum() DeviceTokenEnum
+	Accept(visitor DeviceTokenVisitor)
 }
 
 // DeviceTokenEnum Alias for abstract DeviceToken 'Sub-Classes', used as constant-enum here
@@ -28,75 +30,118 @@ const (
 	DeviceTokenTizenPushType              DeviceTokenEnum = "deviceTokenTizenPush"
 )
 
+// UnknownDeviceToken is a DeviceToken of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownDeviceToken struct {
+	Unknown
+}
+
+// GetDeviceTokenEnum return the enum type of this object
+func (unknownDeviceToken *UnknownDeviceToken) GetDeviceTokenEnum() DeviceTokenEnum {
+	return DeviceTokenEnum(unknownDeviceToken.Type)
+}
+
+// Accept calls the method of the visitor for UnknownDeviceToken
+func (unknownDeviceToken *UnknownDeviceToken) Accept(visitor DeviceTokenVisitor) {
+	visitor.VisitUnknownDeviceToken(unknownDeviceToken)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownDeviceToken *UnknownDeviceToken) String() string {
+	return objectString(unknownDeviceToken)
+}
+
+// LogValue logs the object as its summary
+func (unknownDeviceToken *UnknownDeviceToken) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownDeviceToken))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownDeviceToken *UnknownDeviceToken) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownDeviceToken)
+}
+
+func (unknownDeviceToken *UnknownDeviceToken) printText(p *printer) {
+	if unknownDeviceToken == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownDeviceToken")
+	p.string("@type", unknownDeviceToken.Type)
+	p.endObject(state)
+}
+
 func unmarshalDeviceToken(rawMsg *json.RawMessage) (DeviceToken, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch DeviceTokenEnum(objMap["@type"].(string)) {
+	switch DeviceTokenEnum(typeName) {
 	case DeviceTokenFirebaseCloudMessagingType:
 		var deviceTokenFirebaseCloudMessaging DeviceTokenFirebaseCloudMessaging
-		err := json.Unmarshal(*rawMsg, &deviceTokenFirebaseCloudMessaging)
+		err := jsonUnmarshal(*rawMsg, &deviceTokenFirebaseCloudMessaging)
 		return &deviceTokenFirebaseCloudMessaging, err
 
 	case DeviceTokenApplePushType:
 		var deviceTokenApplePush DeviceTokenApplePush
-		err := json.Unmarshal(*rawMsg, &deviceTokenApplePush)
+		err := jsonUnmarshal(*rawMsg, &deviceTokenApplePush)
 		return &deviceTokenApplePush, err
 
 	case DeviceTokenApplePushVoIPType:
 		var deviceTokenApplePushVoIP DeviceTokenApplePushVoIP
-		err := json.Unmarshal(*rawMsg, &deviceTokenApplePushVoIP)
+		err := jsonUnmarshal(*rawMsg, &deviceTokenApplePushVoIP)
 		return &deviceTokenApplePushVoIP, err
 
 	case DeviceTokenWindowsPushType:
 		var deviceTokenWindowsPush DeviceTokenWindowsPush
-		err := json.Unmarshal(*rawMsg, &deviceTokenWindowsPush)
+		err := jsonUnmarshal(*rawMsg, &deviceTokenWindowsPush)
 		return &deviceTokenWindowsPush, err
 
 	case DeviceTokenMicrosoftPushType:
 		var deviceTokenMicrosoftPush DeviceTokenMicrosoftPush
-		err := json.Unmarshal(*rawMsg, &deviceTokenMicrosoftPush)
+		err := jsonUnmarshal(*rawMsg, &deviceTokenMicrosoftPush)
 		return &deviceTokenMicrosoftPush, err
 
 	case DeviceTokenMicrosoftPushVoIPType:
 		var deviceTokenMicrosoftPushVoIP DeviceTokenMicrosoftPushVoIP
-		err := json.Unmarshal(*rawMsg, &deviceTokenMicrosoftPushVoIP)
+		err := jsonUnmarshal(*rawMsg, &deviceTokenMicrosoftPushVoIP)
 		return &deviceTokenMicrosoftPushVoIP, err
 
 	case DeviceTokenWebPushType:
 		var deviceTokenWebPush DeviceTokenWebPush
-		err := json.Unmarshal(*rawMsg, &deviceTokenWebPush)
+		err := jsonUnmarshal(*rawMsg, &deviceTokenWebPush)
 		return &deviceTokenWebPush, err
 
 	case DeviceTokenSimplePushType:
 		var deviceTokenSimplePush DeviceTokenSimplePush
-		err := json.Unmarshal(*rawMsg, &deviceTokenSimplePush)
+		err := jsonUnmarshal(*rawMsg, &deviceTokenSimplePush)
 		return &deviceTokenSimplePush, err
 
 	case DeviceTokenUbuntuPushType:
 		var deviceTokenUbuntuPush DeviceTokenUbuntuPush
-		err := json.Unmarshal(*rawMsg, &deviceTokenUbuntuPush)
+		err := jsonUnmarshal(*rawMsg, &deviceTokenUbuntuPush)
 		return &deviceTokenUbuntuPush, err
 
 	case DeviceTokenBlackBerryPushType:
 		var deviceTokenBlackBerryPush DeviceTokenBlackBerryPush
-		err := json.Unmarshal(*rawMsg, &deviceTokenBlackBerryPush)
+		err := jsonUnmarshal(*rawMsg, &deviceTokenBlackBerryPush)
 		return &deviceTokenBlackBerryPush, err
 
 	case DeviceTokenTizenPushType:
 		var deviceTokenTizenPush DeviceTokenTizenPush
-		err := json.Unmarshal(*rawMsg, &deviceTokenTizenPush)
+		err := jsonUnmarshal(*rawMsg, &deviceTokenTizenPush)
 		return &deviceTokenTizenPush, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownDeviceToken{Unknown: unknown}, nil
 	}
 }
 
@@ -126,11 +171,129 @@ func NewDeviceTokenFirebaseCloudMessaging(token string, encrypt bool) *DeviceTok
 	return &deviceTokenFirebaseCloudMessagingTemp
 }
 
+// MarshalJSON marshals to json
+func (deviceTokenFirebaseCloudMessaging *DeviceTokenFirebaseCloudMessaging) MarshalJSON() ([]byte, error) {
+	return deviceTokenFirebaseCloudMessaging.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (deviceTokenFirebaseCloudMessaging *DeviceTokenFirebaseCloudMessaging) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, deviceTokenFirebaseCloudMessaging)
+}
+
+func (deviceTokenFirebaseCloudMessaging *DeviceTokenFirebaseCloudMessaging) appendJSON(b []byte) ([]byte, error) {
+	if deviceTokenFirebaseCloudMessaging == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, deviceTokenFirebaseCloudMessaging.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, deviceTokenFirebaseCloudMessaging.tdCommon.Extra)
+	b = append(b, `,"token":`...)
+	b = appendJSONString(b, deviceTokenFirebaseCloudMessaging.Token)
+	b = append(b, `,"encrypt":`...)
+	b = appendJSONBool(b, deviceTokenFirebaseCloudMessaging.Encrypt)
+	return append(b, '}'), nil
+}
+
+func (deviceTokenFirebaseCloudMessaging *DeviceTokenFirebaseCloudMessaging) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			deviceTokenFirebaseCloudMessaging.tdCommon.Type, err = r.readString()
+		case "@extra":
+			deviceTokenFirebaseCloudMessaging.tdCommon.Extra, err = r.readString()
+		case "token":
+			deviceTokenFirebaseCloudMessaging.Token, err = r.readString()
+		case "encrypt":
+			deviceTokenFirebaseCloudMessaging.Encrypt, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (deviceTokenFirebaseCloudMessaging *DeviceTokenFirebaseCloudMessaging) Clone() *DeviceTokenFirebaseCloudMessaging {
+	if deviceTokenFirebaseCloudMessaging == nil {
+		return nil
+	}
+	clone := *deviceTokenFirebaseCloudMessaging
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (deviceTokenFirebaseCloudMessaging *DeviceTokenFirebaseCloudMessaging) Equal(other *DeviceTokenFirebaseCloudMessaging) bool {
+	if deviceTokenFirebaseCloudMessaging == nil || other == nil {
+		return deviceTokenFirebaseCloudMessaging == other
+	}
+	if deviceTokenFirebaseCloudMessaging.Token != other.Token {
+		return false
+	}
+	if deviceTokenFirebaseCloudMessaging.Encrypt != other.Encrypt {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (deviceTokenFirebaseCloudMessaging *DeviceTokenFirebaseCloudMessaging) Diff(other *DeviceTokenFirebaseCloudMessaging) []Change {
+	return deviceTokenFirebaseCloudMessaging.diff("", other, nil)
+}
+
+func (deviceTokenFirebaseCloudMessaging *DeviceTokenFirebaseCloudMessaging) diff(path string, other *DeviceTokenFirebaseCloudMessaging, changes []Change) []Change {
+	if deviceTokenFirebaseCloudMessaging == nil || other == nil {
+		if deviceTokenFirebaseCloudMessaging != other {
+			changes = append(changes, Change{Path: path, Old: deviceTokenFirebaseCloudMessaging, New: other})
+		}
+		return changes
+	}
+	if deviceTokenFirebaseCloudMessaging.Token != other.Token {
+		changes = append(changes, Change{Path: fieldPath(path, "token"), Old: deviceTokenFirebaseCloudMessaging.Token, New: other.Token})
+	}
+	if deviceTokenFirebaseCloudMessaging.Encrypt != other.Encrypt {
+		changes = append(changes, Change{Path: fieldPath(path, "encrypt"), Old: deviceTokenFirebaseCloudMessaging.Encrypt, New: other.Encrypt})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (deviceTokenFirebaseCloudMessaging *DeviceTokenFirebaseCloudMessaging) String() string {
+	return objectString(deviceTokenFirebaseCloudMessaging)
+}
+
+// LogValue logs the object as its summary
+func (deviceTokenFirebaseCloudMessaging *DeviceTokenFirebaseCloudMessaging) LogValue() slog.Value {
+	return slog.StringValue(objectString(deviceTokenFirebaseCloudMessaging))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (deviceTokenFirebaseCloudMessaging *DeviceTokenFirebaseCloudMessaging) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, deviceTokenFirebaseCloudMessaging)
+}
+
+func (deviceTokenFirebaseCloudMessaging *DeviceTokenFirebaseCloudMessaging) printText(p *printer) {
+	if deviceTokenFirebaseCloudMessaging == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("DeviceTokenFirebaseCloudMessaging")
+	p.string("token", deviceTokenFirebaseCloudMessaging.Token)
+	p.bool("encrypt", deviceTokenFirebaseCloudMessaging.Encrypt)
+	p.endObject(state)
+}
+
 // GetDeviceTokenEnum return the enum type of this object
 func (deviceTokenFirebaseCloudMessaging *DeviceTokenFirebaseCloudMessaging) GetDeviceTokenEnum() DeviceTokenEnum {
 	return DeviceTokenFirebaseCloudMessagingType
 }
 
+// Accept calls the method of the visitor for DeviceTokenFirebaseCloudMessaging
+func (deviceTokenFirebaseCloudMessaging *DeviceTokenFirebaseCloudMessaging) Accept(visitor DeviceTokenVisitor) {
+	visitor.VisitDeviceTokenFirebaseCloudMessaging(deviceTokenFirebaseCloudMessaging)
+}
+
 // DeviceTokenApplePush A token for Apple Push Notification service
 type DeviceTokenApplePush struct {
 	tdCommon
@@ -157,11 +320,129 @@ func NewDeviceTokenApplePush(deviceToken string, isAppSandbox bool) *DeviceToken
 	return &deviceTokenApplePushTemp
 }
 
+// MarshalJSON marshals to json
+func (deviceTokenApplePush *DeviceTokenApplePush) MarshalJSON() ([]byte, error) {
+	return deviceTokenApplePush.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (deviceTokenApplePush *DeviceTokenApplePush) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, deviceTokenApplePush)
+}
+
+func (deviceTokenApplePush *DeviceTokenApplePush) appendJSON(b []byte) ([]byte, error) {
+	if deviceTokenApplePush == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, deviceTokenApplePush.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, deviceTokenApplePush.tdCommon.Extra)
+	b = append(b, `,"device_token":`...)
+	b = appendJSONString(b, deviceTokenApplePush.DeviceToken)
+	b = append(b, `,"is_app_sandbox":`...)
+	b = appendJSONBool(b, deviceTokenApplePush.IsAppSandbox)
+	return append(b, '}'), nil
+}
+
+func (deviceTokenApplePush *DeviceTokenApplePush) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			deviceTokenApplePush.tdCommon.Type, err = r.readString()
+		case "@extra":
+			deviceTokenApplePush.tdCommon.Extra, err = r.readString()
+		case "device_token":
+			deviceTokenApplePush.DeviceToken, err = r.readString()
+		case "is_app_sandbox":
+			deviceTokenApplePush.IsAppSandbox, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (deviceTokenApplePush *DeviceTokenApplePush) Clone() *DeviceTokenApplePush {
+	if deviceTokenApplePush == nil {
+		return nil
+	}
+	clone := *deviceTokenApplePush
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (deviceTokenApplePush *DeviceTokenApplePush) Equal(other *DeviceTokenApplePush) bool {
+	if deviceTokenApplePush == nil || other == nil {
+		return deviceTokenApplePush == other
+	}
+	if deviceTokenApplePush.DeviceToken != other.DeviceToken {
+		return false
+	}
+	if deviceTokenApplePush.IsAppSandbox != other.IsAppSandbox {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (deviceTokenApplePush *DeviceTokenApplePush) Diff(other *DeviceTokenApplePush) []Change {
+	return deviceTokenApplePush.diff("", other, nil)
+}
+
+func (deviceTokenApplePush *DeviceTokenApplePush) diff(path string, other *DeviceTokenApplePush, changes []Change) []Change {
+	if deviceTokenApplePush == nil || other == nil {
+		if deviceTokenApplePush != other {
+			changes = append(changes, Change{Path: path, Old: deviceTokenApplePush, New: other})
+		}
+		return changes
+	}
+	if deviceTokenApplePush.DeviceToken != other.DeviceToken {
+		changes = append(changes, Change{Path: fieldPath(path, "device_token"), Old: deviceTokenApplePush.DeviceToken, New: other.DeviceToken})
+	}
+	if deviceTokenApplePush.IsAppSandbox != other.IsAppSandbox {
+		changes = append(changes, Change{Path: fieldPath(path, "is_app_sandbox"), Old: deviceTokenApplePush.IsAppSandbox, New: other.IsAppSandbox})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (deviceTokenApplePush *DeviceTokenApplePush) String() string {
+	return objectString(deviceTokenApplePush)
+}
+
+// LogValue logs the object as its summary
+func (deviceTokenApplePush *DeviceTokenApplePush) LogValue() slog.Value {
+	return slog.StringValue(objectString(deviceTokenApplePush))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (deviceTokenApplePush *DeviceTokenApplePush) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, deviceTokenApplePush)
+}
+
+func (deviceTokenApplePush *DeviceTokenApplePush) printText(p *printer) {
+	if deviceTokenApplePush == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("DeviceTokenApplePush")
+	p.string("device_token", deviceTokenApplePush.DeviceToken)
+	p.bool("is_app_sandbox", deviceTokenApplePush.IsAppSandbox)
+	p.endObject(state)
+}
+
 // GetDeviceTokenEnum return the enum type of this object
 func (deviceTokenApplePush *DeviceTokenApplePush) GetDeviceTokenEnum() DeviceTokenEnum {
 	return DeviceTokenApplePushType
 }
 
+// Accept calls the method of the visitor for DeviceTokenApplePush
+func (deviceTokenApplePush *DeviceTokenApplePush) Accept(visitor DeviceTokenVisitor) {
+	visitor.VisitDeviceTokenApplePush(deviceTokenApplePush)
+}
+
 // DeviceTokenApplePushVoIP A token for Apple Push Notification service VoIP notifications
 type DeviceTokenApplePushVoIP struct {
 	tdCommon
@@ -191,11 +472,140 @@ func NewDeviceTokenApplePushVoIP(deviceToken string, isAppSandbox bool, encrypt
 	return &deviceTokenApplePushVoIPTemp
 }
 
+// MarshalJSON marshals to json
+func (deviceTokenApplePushVoIP *DeviceTokenApplePushVoIP) MarshalJSON() ([]byte, error) {
+	return deviceTokenApplePushVoIP.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (deviceTokenApplePushVoIP *DeviceTokenApplePushVoIP) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, deviceTokenApplePushVoIP)
+}
+
+func (deviceTokenApplePushVoIP *DeviceTokenApplePushVoIP) appendJSON(b []byte) ([]byte, error) {
+	if deviceTokenApplePushVoIP == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, deviceTokenApplePushVoIP.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, deviceTokenApplePushVoIP.tdCommon.Extra)
+	b = append(b, `,"device_token":`...)
+	b = appendJSONString(b, deviceTokenApplePushVoIP.DeviceToken)
+	b = append(b, `,"is_app_sandbox":`...)
+	b = appendJSONBool(b, deviceTokenApplePushVoIP.IsAppSandbox)
+	b = append(b, `,"encrypt":`...)
+	b = appendJSONBool(b, deviceTokenApplePushVoIP.Encrypt)
+	return append(b, '}'), nil
+}
+
+func (deviceTokenApplePushVoIP *DeviceTokenApplePushVoIP) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			deviceTokenApplePushVoIP.tdCommon.Type, err = r.readString()
+		case "@extra":
+			deviceTokenApplePushVoIP.tdCommon.Extra, err = r.readString()
+		case "device_token":
+			deviceTokenApplePushVoIP.DeviceToken, err = r.readString()
+		case "is_app_sandbox":
+			deviceTokenApplePushVoIP.IsAppSandbox, err = r.readBool()
+		case "encrypt":
+			deviceTokenApplePushVoIP.Encrypt, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (deviceTokenApplePushVoIP *DeviceTokenApplePushVoIP) Clone() *DeviceTokenApplePushVoIP {
+	if deviceTokenApplePushVoIP == nil {
+		return nil
+	}
+	clone := *deviceTokenApplePushVoIP
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (deviceTokenApplePushVoIP *DeviceTokenApplePushVoIP) Equal(other *DeviceTokenApplePushVoIP) bool {
+	if deviceTokenApplePushVoIP == nil || other == nil {
+		return deviceTokenApplePushVoIP == other
+	}
+	if deviceTokenApplePushVoIP.DeviceToken != other.DeviceToken {
+		return false
+	}
+	if deviceTokenApplePushVoIP.IsAppSandbox != other.IsAppSandbox {
+		return false
+	}
+	if deviceTokenApplePushVoIP.Encrypt != other.Encrypt {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (deviceTokenApplePushVoIP *DeviceTokenApplePushVoIP) Diff(other *DeviceTokenApplePushVoIP) []Change {
+	return deviceTokenApplePushVoIP.diff("", other, nil)
+}
+
+func (deviceTokenApplePushVoIP *DeviceTokenApplePushVoIP) diff(path string, other *DeviceTokenApplePushVoIP, changes []Change) []Change {
+	if deviceTokenApplePushVoIP == nil || other == nil {
+		if deviceTokenApplePushVoIP != other {
+			changes = append(changes, Change{Path: path, Old: deviceTokenApplePushVoIP, New: other})
+		}
+		return changes
+	}
+	if deviceTokenApplePushVoIP.DeviceToken != other.DeviceToken {
+		changes = append(changes, Change{Path: fieldPath(path, "device_token"), Old: deviceTokenApplePushVoIP.DeviceToken, New: other.DeviceToken})
+	}
+	if deviceTokenApplePushVoIP.IsAppSandbox != other.IsAppSandbox {
+		changes = append(changes, Change{Path: fieldPath(path, "is_app_sandbox"), Old: deviceTokenApplePushVoIP.IsAppSandbox, New: other.IsAppSandbox})
+	}
+	if deviceTokenApplePushVoIP.Encrypt != other.Encrypt {
+		changes = append(changes, Change{Path: fieldPath(path, "encrypt"), Old: deviceTokenApplePushVoIP.Encrypt, New: other.Encrypt})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (deviceTokenApplePushVoIP *DeviceTokenApplePushVoIP) String() string {
+	return objectString(deviceTokenApplePushVoIP)
+}
+
+// LogValue logs the object as its summary
+func (deviceTokenApplePushVoIP *DeviceTokenApplePushVoIP) LogValue() slog.Value {
+	return slog.StringValue(objectString(deviceTokenApplePushVoIP))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (deviceTokenApplePushVoIP *DeviceTokenApplePushVoIP) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, deviceTokenApplePushVoIP)
+}
+
+func (deviceTokenApplePushVoIP *DeviceTokenApplePushVoIP) printText(p *printer) {
+	if deviceTokenApplePushVoIP == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("DeviceTokenApplePushVoIP")
+	p.string("device_token", deviceTokenApplePushVoIP.DeviceToken)
+	p.bool("is_app_sandbox", deviceTokenApplePushVoIP.IsAppSandbox)
+	p.bool("encrypt", deviceTokenApplePushVoIP.Encrypt)
+	p.endObject(state)
+}
+
 // GetDeviceTokenEnum return the enum type of this object
 func (deviceTokenApplePushVoIP *DeviceTokenApplePushVoIP) GetDeviceTokenEnum() DeviceTokenEnum {
 	return DeviceTokenApplePushVoIPType
 }
 
+// Accept calls the method of the visitor for DeviceTokenApplePushVoIP
+func (deviceTokenApplePushVoIP *DeviceTokenApplePushVoIP) Accept(visitor DeviceTokenVisitor) {
+	visitor.VisitDeviceTokenApplePushVoIP(deviceTokenApplePushVoIP)
+}
+
 // DeviceTokenWindowsPush A token for Windows Push Notification Services
 type DeviceTokenWindowsPush struct {
 	tdCommon
@@ -219,11 +629,118 @@ func NewDeviceTokenWindowsPush(accessToken string) *DeviceTokenWindowsPush {
 	return &deviceTokenWindowsPushTemp
 }
 
+// MarshalJSON marshals to json
+func (deviceTokenWindowsPush *DeviceTokenWindowsPush) MarshalJSON() ([]byte, error) {
+	return deviceTokenWindowsPush.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (deviceTokenWindowsPush *DeviceTokenWindowsPush) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, deviceTokenWindowsPush)
+}
+
+func (deviceTokenWindowsPush *DeviceTokenWindowsPush) appendJSON(b []byte) ([]byte, error) {
+	if deviceTokenWindowsPush == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, deviceTokenWindowsPush.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, deviceTokenWindowsPush.tdCommon.Extra)
+	b = append(b, `,"access_token":`...)
+	b = appendJSONString(b, deviceTokenWindowsPush.AccessToken)
+	return append(b, '}'), nil
+}
+
+func (deviceTokenWindowsPush *DeviceTokenWindowsPush) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			deviceTokenWindowsPush.tdCommon.Type, err = r.readString()
+		case "@extra":
+			deviceTokenWindowsPush.tdCommon.Extra, err = r.readString()
+		case "access_token":
+			deviceTokenWindowsPush.AccessToken, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (deviceTokenWindowsPush *DeviceTokenWindowsPush) Clone() *DeviceTokenWindowsPush {
+	if deviceTokenWindowsPush == nil {
+		return nil
+	}
+	clone := *deviceTokenWindowsPush
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (deviceTokenWindowsPush *DeviceTokenWindowsPush) Equal(other *DeviceTokenWindowsPush) bool {
+	if deviceTokenWindowsPush == nil || other == nil {
+		return deviceTokenWindowsPush == other
+	}
+	if deviceTokenWindowsPush.AccessToken != other.AccessToken {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (deviceTokenWindowsPush *DeviceTokenWindowsPush) Diff(other *DeviceTokenWindowsPush) []Change {
+	return deviceTokenWindowsPush.diff("", other, nil)
+}
+
+func (deviceTokenWindowsPush *DeviceTokenWindowsPush) diff(path string, other *DeviceTokenWindowsPush, changes []Change) []Change {
+	if deviceTokenWindowsPush == nil || other == nil {
+		if deviceTokenWindowsPush != other {
+			changes = append(changes, Change{Path: path, Old: deviceTokenWindowsPush, New: other})
+		}
+		return changes
+	}
+	if deviceTokenWindowsPush.AccessToken != other.AccessToken {
+		changes = append(changes, Change{Path: fieldPath(path, "access_token"), Old: deviceTokenWindowsPush.AccessToken, New: other.AccessToken})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (deviceTokenWindowsPush *DeviceTokenWindowsPush) String() string {
+	return objectString(deviceTokenWindowsPush)
+}
+
+// LogValue logs the object as its summary
+func (deviceTokenWindowsPush *DeviceTokenWindowsPush) LogValue() slog.Value {
+	return slog.StringValue(objectString(deviceTokenWindowsPush))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (deviceTokenWindowsPush *DeviceTokenWindowsPush) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, deviceTokenWindowsPush)
+}
+
+func (deviceTokenWindowsPush *DeviceTokenWindowsPush) printText(p *printer) {
+	if deviceTokenWindowsPush == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("DeviceTokenWindowsPush")
+	p.string("access_token", deviceTokenWindowsPush.AccessToken)
+	p.endObject(state)
+}
+
 // GetDeviceTokenEnum return the enum type of this object
 func (deviceTokenWindowsPush *DeviceTokenWindowsPush) GetDeviceTokenEnum() DeviceTokenEnum {
 	return DeviceTokenWindowsPushType
 }
 
+// Accept calls the method of the visitor for DeviceTokenWindowsPush
+func (deviceTokenWindowsPush *DeviceTokenWindowsPush) Accept(visitor DeviceTokenVisitor) {
+	visitor.VisitDeviceTokenWindowsPush(deviceTokenWindowsPush)
+}
+
 // DeviceTokenMicrosoftPush A token for Microsoft Push Notification Service
 type DeviceTokenMicrosoftPush struct {
 	tdCommon
@@ -247,11 +764,118 @@ func NewDeviceTokenMicrosoftPush(channelUri string) *DeviceTokenMicrosoftPush {
 	return &deviceTokenMicrosoftPushTemp
 }
 
+// MarshalJSON marshals to json
+func (deviceTokenMicrosoftPush *DeviceTokenMicrosoftPush) MarshalJSON() ([]byte, error) {
+	return deviceTokenMicrosoftPush.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (deviceTokenMicrosoftPush *DeviceTokenMicrosoftPush) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, deviceTokenMicrosoftPush)
+}
+
+func (deviceTokenMicrosoftPush *DeviceTokenMicrosoftPush) appendJSON(b []byte) ([]byte, error) {
+	if deviceTokenMicrosoftPush == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, deviceTokenMicrosoftPush.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, deviceTokenMicrosoftPush.tdCommon.Extra)
+	b = append(b, `,"channel_uri":`...)
+	b = appendJSONString(b, deviceTokenMicrosoftPush.ChannelUri)
+	return append(b, '}'), nil
+}
+
+func (deviceTokenMicrosoftPush *DeviceTokenMicrosoftPush) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			deviceTokenMicrosoftPush.tdCommon.Type, err = r.readString()
+		case "@extra":
+			deviceTokenMicrosoftPush.tdCommon.Extra, err = r.readString()
+		case "channel_uri":
+			deviceTokenMicrosoftPush.ChannelUri, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (deviceTokenMicrosoftPush *DeviceTokenMicrosoftPush) Clone() *DeviceTokenMicrosoftPush {
+	if deviceTokenMicrosoftPush == nil {
+		return nil
+	}
+	clone := *deviceTokenMicrosoftPush
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (deviceTokenMicrosoftPush *DeviceTokenMicrosoftPush) Equal(other *DeviceTokenMicrosoftPush) bool {
+	if deviceTokenMicrosoftPush == nil || other == nil {
+		return deviceTokenMicrosoftPush == other
+	}
+	if deviceTokenMicrosoftPush.ChannelUri != other.ChannelUri {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (deviceTokenMicrosoftPush *DeviceTokenMicrosoftPush) Diff(other *DeviceTokenMicrosoftPush) []Change {
+	return deviceTokenMicrosoftPush.diff("", other, nil)
+}
+
+func (deviceTokenMicrosoftPush *DeviceTokenMicrosoftPush) diff(path string, other *DeviceTokenMicrosoftPush, changes []Change) []Change {
+	if deviceTokenMicrosoftPush == nil || other == nil {
+		if deviceTokenMicrosoftPush != other {
+			changes = append(changes, Change{Path: path, Old: deviceTokenMicrosoftPush, New: other})
+		}
+		return changes
+	}
+	if deviceTokenMicrosoftPush.ChannelUri != other.ChannelUri {
+		changes = append(changes, Change{Path: fieldPath(path, "channel_uri"), Old: deviceTokenMicrosoftPush.ChannelUri, New: other.ChannelUri})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (deviceTokenMicrosoftPush *DeviceTokenMicrosoftPush) String() string {
+	return objectString(deviceTokenMicrosoftPush)
+}
+
+// LogValue logs the object as its summary
+func (deviceTokenMicrosoftPush *DeviceTokenMicrosoftPush) LogValue() slog.Value {
+	return slog.StringValue(objectString(deviceTokenMicrosoftPush))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (deviceTokenMicrosoftPush *DeviceTokenMicrosoftPush) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, deviceTokenMicrosoftPush)
+}
+
+func (deviceTokenMicrosoftPush *DeviceTokenMicrosoftPush) printText(p *printer) {
+	if deviceTokenMicrosoftPush == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("DeviceTokenMicrosoftPush")
+	p.string("channel_uri", deviceTokenMicrosoftPush.ChannelUri)
+	p.endObject(state)
+}
+
 // GetDeviceTokenEnum return the enum type of this object
 func (deviceTokenMicrosoftPush *DeviceTokenMicrosoftPush) GetDeviceTokenEnum() DeviceTokenEnum {
 	return DeviceTokenMicrosoftPushType
 }
 
+// Accept calls the method of the visitor for DeviceTokenMicrosoftPush
+func (deviceTokenMicrosoftPush *DeviceTokenMicrosoftPush) Accept(visitor DeviceTokenVisitor) {
+	visitor.VisitDeviceTokenMicrosoftPush(deviceTokenMicrosoftPush)
+}
+
 // DeviceTokenMicrosoftPushVoIP A token for Microsoft Push Notification Service VoIP channel
 type DeviceTokenMicrosoftPushVoIP struct {
 	tdCommon
@@ -275,11 +899,118 @@ func NewDeviceTokenMicrosoftPushVoIP(channelUri string) *DeviceTokenMicrosoftPus
 	return &deviceTokenMicrosoftPushVoIPTemp
 }
 
+// MarshalJSON marshals to json
+func (deviceTokenMicrosoftPushVoIP *DeviceTokenMicrosoftPushVoIP) MarshalJSON() ([]byte, error) {
+	return deviceTokenMicrosoftPushVoIP.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (deviceTokenMicrosoftPushVoIP *DeviceTokenMicrosoftPushVoIP) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, deviceTokenMicrosoftPushVoIP)
+}
+
+func (deviceTokenMicrosoftPushVoIP *DeviceTokenMicrosoftPushVoIP) appendJSON(b []byte) ([]byte, error) {
+	if deviceTokenMicrosoftPushVoIP == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, deviceTokenMicrosoftPushVoIP.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, deviceTokenMicrosoftPushVoIP.tdCommon.Extra)
+	b = append(b, `,"channel_uri":`...)
+	b = appendJSONString(b, deviceTokenMicrosoftPushVoIP.ChannelUri)
+	return append(b, '}'), nil
+}
+
+func (deviceTokenMicrosoftPushVoIP *DeviceTokenMicrosoftPushVoIP) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			deviceTokenMicrosoftPushVoIP.tdCommon.Type, err = r.readString()
+		case "@extra":
+			deviceTokenMicrosoftPushVoIP.tdCommon.Extra, err = r.readString()
+		case "channel_uri":
+			deviceTokenMicrosoftPushVoIP.ChannelUri, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (deviceTokenMicrosoftPushVoIP *DeviceTokenMicrosoftPushVoIP) Clone() *DeviceTokenMicrosoftPushVoIP {
+	if deviceTokenMicrosoftPushVoIP == nil {
+		return nil
+	}
+	clone := *deviceTokenMicrosoftPushVoIP
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (deviceTokenMicrosoftPushVoIP *DeviceTokenMicrosoftPushVoIP) Equal(other *DeviceTokenMicrosoftPushVoIP) bool {
+	if deviceTokenMicrosoftPushVoIP == nil || other == nil {
+		return deviceTokenMicrosoftPushVoIP == other
+	}
+	if deviceTokenMicrosoftPushVoIP.ChannelUri != other.ChannelUri {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (deviceTokenMicrosoftPushVoIP *DeviceTokenMicrosoftPushVoIP) Diff(other *DeviceTokenMicrosoftPushVoIP) []Change {
+	return deviceTokenMicrosoftPushVoIP.diff("", other, nil)
+}
+
+func (deviceTokenMicrosoftPushVoIP *DeviceTokenMicrosoftPushVoIP) diff(path string, other *DeviceTokenMicrosoftPushVoIP, changes []Change) []Change {
+	if deviceTokenMicrosoftPushVoIP == nil || other == nil {
+		if deviceTokenMicrosoftPushVoIP != other {
+			changes = append(changes, Change{Path: path, Old: deviceTokenMicrosoftPushVoIP, New: other})
+		}
+		return changes
+	}
+	if deviceTokenMicrosoftPushVoIP.ChannelUri != other.ChannelUri {
+		changes = append(changes, Change{Path: fieldPath(path, "channel_uri"), Old: deviceTokenMicrosoftPushVoIP.ChannelUri, New: other.ChannelUri})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (deviceTokenMicrosoftPushVoIP *DeviceTokenMicrosoftPushVoIP) String() string {
+	return objectString(deviceTokenMicrosoftPushVoIP)
+}
+
+// LogValue logs the object as its summary
+func (deviceTokenMicrosoftPushVoIP *DeviceTokenMicrosoftPushVoIP) LogValue() slog.Value {
+	return slog.StringValue(objectString(deviceTokenMicrosoftPushVoIP))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (deviceTokenMicrosoftPushVoIP *DeviceTokenMicrosoftPushVoIP) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, deviceTokenMicrosoftPushVoIP)
+}
+
+func (deviceTokenMicrosoftPushVoIP *DeviceTokenMicrosoftPushVoIP) printText(p *printer) {
+	if deviceTokenMicrosoftPushVoIP == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("DeviceTokenMicrosoftPushVoIP")
+	p.string("channel_uri", deviceTokenMicrosoftPushVoIP.ChannelUri)
+	p.endObject(state)
+}
+
 // GetDeviceTokenEnum return the enum type of this object
 func (deviceTokenMicrosoftPushVoIP *DeviceTokenMicrosoftPushVoIP) GetDeviceTokenEnum() DeviceTokenEnum {
 	return DeviceTokenMicrosoftPushVoIPType
 }
 
+// Accept calls the method of the visitor for DeviceTokenMicrosoftPushVoIP
+func (deviceTokenMicrosoftPushVoIP *DeviceTokenMicrosoftPushVoIP) Accept(visitor DeviceTokenVisitor) {
+	visitor.VisitDeviceTokenMicrosoftPushVoIP(deviceTokenMicrosoftPushVoIP)
+}
+
 // DeviceTokenWebPush A token for web Push API
 type DeviceTokenWebPush struct {
 	tdCommon
@@ -309,11 +1040,140 @@ func NewDeviceTokenWebPush(endpoint string, p256dhBase64url string, authBase64ur
 	return &deviceTokenWebPushTemp
 }
 
+// MarshalJSON marshals to json
+func (deviceTokenWebPush *DeviceTokenWebPush) MarshalJSON() ([]byte, error) {
+	return deviceTokenWebPush.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (deviceTokenWebPush *DeviceTokenWebPush) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, deviceTokenWebPush)
+}
+
+func (deviceTokenWebPush *DeviceTokenWebPush) appendJSON(b []byte) ([]byte, error) {
+	if deviceTokenWebPush == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, deviceTokenWebPush.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, deviceTokenWebPush.tdCommon.Extra)
+	b = append(b, `,"endpoint":`...)
+	b = appendJSONString(b, deviceTokenWebPush.Endpoint)
+	b = append(b, `,"p256dh_base64url":`...)
+	b = appendJSONString(b, deviceTokenWebPush.P256dhBase64url)
+	b = append(b, `,"auth_base64url":`...)
+	b = appendJSONString(b, deviceTokenWebPush.AuthBase64url)
+	return append(b, '}'), nil
+}
+
+func (deviceTokenWebPush *DeviceTokenWebPush) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			deviceTokenWebPush.tdCommon.Type, err = r.readString()
+		case "@extra":
+			deviceTokenWebPush.tdCommon.Extra, err = r.readString()
+		case "endpoint":
+			deviceTokenWebPush.Endpoint, err = r.readString()
+		case "p256dh_base64url":
+			deviceTokenWebPush.P256dhBase64url, err = r.readString()
+		case "auth_base64url":
+			deviceTokenWebPush.AuthBase64url, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (deviceTokenWebPush *DeviceTokenWebPush) Clone() *DeviceTokenWebPush {
+	if deviceTokenWebPush == nil {
+		return nil
+	}
+	clone := *deviceTokenWebPush
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (deviceTokenWebPush *DeviceTokenWebPush) Equal(other *DeviceTokenWebPush) bool {
+	if deviceTokenWebPush == nil || other == nil {
+		return deviceTokenWebPush == other
+	}
+	if deviceTokenWebPush.Endpoint != other.Endpoint {
+		return false
+	}
+	if deviceTokenWebPush.P256dhBase64url != other.P256dhBase64url {
+		return false
+	}
+	if deviceTokenWebPush.AuthBase64url != other.AuthBase64url {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (deviceTokenWebPush *DeviceTokenWebPush) Diff(other *DeviceTokenWebPush) []Change {
+	return deviceTokenWebPush.diff("", other, nil)
+}
+
+func (deviceTokenWebPush *DeviceTokenWebPush) diff(path string, other *DeviceTokenWebPush, changes []Change) []Change {
+	if deviceTokenWebPush == nil || other == nil {
+		if deviceTokenWebPush != other {
+			changes = append(changes, Change{Path: path, Old: deviceTokenWebPush, New: other})
+		}
+		return changes
+	}
+	if deviceTokenWebPush.Endpoint != other.Endpoint {
+		changes = append(changes, Change{Path: fieldPath(path, "endpoint"), Old: deviceTokenWebPush.Endpoint, New: other.Endpoint})
+	}
+	if deviceTokenWebPush.P256dhBase64url != other.P256dhBase64url {
+		changes = append(changes, Change{Path: fieldPath(path, "p256dh_base64url"), Old: deviceTokenWebPush.P256dhBase64url, New: other.P256dhBase64url})
+	}
+	if deviceTokenWebPush.AuthBase64url != other.AuthBase64url {
+		changes = append(changes, Change{Path: fieldPath(path, "auth_base64url"), Old: deviceTokenWebPush.AuthBase64url, New: other.AuthBase64url})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (deviceTokenWebPush *DeviceTokenWebPush) String() string {
+	return objectString(deviceTokenWebPush)
+}
+
+// LogValue logs the object as its summary
+func (deviceTokenWebPush *DeviceTokenWebPush) LogValue() slog.Value {
+	return slog.StringValue(objectString(deviceTokenWebPush))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (deviceTokenWebPush *DeviceTokenWebPush) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, deviceTokenWebPush)
+}
+
+func (deviceTokenWebPush *DeviceTokenWebPush) printText(p *printer) {
+	if deviceTokenWebPush == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("DeviceTokenWebPush")
+	p.string("endpoint", deviceTokenWebPush.Endpoint)
+	p.string("p256dh_base64url", deviceTokenWebPush.P256dhBase64url)
+	p.string("auth_base64url", deviceTokenWebPush.AuthBase64url)
+	p.endObject(state)
+}
+
 // GetDeviceTokenEnum return the enum type of this object
 func (deviceTokenWebPush *DeviceTokenWebPush) GetDeviceTokenEnum() DeviceTokenEnum {
 	return DeviceTokenWebPushType
 }
 
+// Accept calls the method of the visitor for DeviceTokenWebPush
+func (deviceTokenWebPush *DeviceTokenWebPush) Accept(visitor DeviceTokenVisitor) {
+	visitor.VisitDeviceTokenWebPush(deviceTokenWebPush)
+}
+
 // DeviceTokenSimplePush A token for Simple Push API for Firefox OS
 type DeviceTokenSimplePush struct {
 	tdCommon
@@ -337,11 +1197,118 @@ func NewDeviceTokenSimplePush(endpoint string) *DeviceTokenSimplePush {
 	return &deviceTokenSimplePushTemp
 }
 
+// MarshalJSON marshals to json
+func (deviceTokenSimplePush *DeviceTokenSimplePush) MarshalJSON() ([]byte, error) {
+	return deviceTokenSimplePush.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (deviceTokenSimplePush *DeviceTokenSimplePush) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, deviceTokenSimplePush)
+}
+
+func (deviceTokenSimplePush *DeviceTokenSimplePush) appendJSON(b []byte) ([]byte, error) {
+	if deviceTokenSimplePush == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, deviceTokenSimplePush.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, deviceTokenSimplePush.tdCommon.Extra)
+	b = append(b, `,"endpoint":`...)
+	b = appendJSONString(b, deviceTokenSimplePush.Endpoint)
+	return append(b, '}'), nil
+}
+
+func (deviceTokenSimplePush *DeviceTokenSimplePush) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			deviceTokenSimplePush.tdCommon.Type, err = r.readString()
+		case "@extra":
+			deviceTokenSimplePush.tdCommon.Extra, err = r.readString()
+		case "endpoint":
+			deviceTokenSimplePush.Endpoint, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (deviceTokenSimplePush *DeviceTokenSimplePush) Clone() *DeviceTokenSimplePush {
+	if deviceTokenSimplePush == nil {
+		return nil
+	}
+	clone := *deviceTokenSimplePush
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (deviceTokenSimplePush *DeviceTokenSimplePush) Equal(other *DeviceTokenSimplePush) bool {
+	if deviceTokenSimplePush == nil || other == nil {
+		return deviceTokenSimplePush == other
+	}
+	if deviceTokenSimplePush.Endpoint != other.Endpoint {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (deviceTokenSimplePush *DeviceTokenSimplePush) Diff(other *DeviceTokenSimplePush) []Change {
+	return deviceTokenSimplePush.diff("", other, nil)
+}
+
+func (deviceTokenSimplePush *DeviceTokenSimplePush) diff(path string, other *DeviceTokenSimplePush, changes []Change) []Change {
+	if deviceTokenSimplePush == nil || other == nil {
+		if deviceTokenSimplePush != other {
+			changes = append(changes, Change{Path: path, Old: deviceTokenSimplePush, New: other})
+		}
+		return changes
+	}
+	if deviceTokenSimplePush.Endpoint != other.Endpoint {
+		changes = append(changes, Change{Path: fieldPath(path, "endpoint"), Old: deviceTokenSimplePush.Endpoint, New: other.Endpoint})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (deviceTokenSimplePush *DeviceTokenSimplePush) String() string {
+	return objectString(deviceTokenSimplePush)
+}
+
+// LogValue logs the object as its summary
+func (deviceTokenSimplePush *DeviceTokenSimplePush) LogValue() slog.Value {
+	return slog.StringValue(objectString(deviceTokenSimplePush))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (deviceTokenSimplePush *DeviceTokenSimplePush) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, deviceTokenSimplePush)
+}
+
+func (deviceTokenSimplePush *DeviceTokenSimplePush) printText(p *printer) {
+	if deviceTokenSimplePush == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("DeviceTokenSimplePush")
+	p.string("endpoint", deviceTokenSimplePush.Endpoint)
+	p.endObject(state)
+}
+
 // GetDeviceTokenEnum return the enum type of this object
 func (deviceTokenSimplePush *DeviceTokenSimplePush) GetDeviceTokenEnum() DeviceTokenEnum {
 	return DeviceTokenSimplePushType
 }
 
+// Accept calls the method of the visitor for DeviceTokenSimplePush
+func (deviceTokenSimplePush *DeviceTokenSimplePush) Accept(visitor DeviceTokenVisitor) {
+	visitor.VisitDeviceTokenSimplePush(deviceTokenSimplePush)
+}
+
 // DeviceTokenUbuntuPush A token for Ubuntu Push Client service
 type DeviceTokenUbuntuPush struct {
 	tdCommon
@@ -365,11 +1332,118 @@ func NewDeviceTokenUbuntuPush(token string) *DeviceTokenUbuntuPush {
 	return &deviceTokenUbuntuPushTemp
 }
 
+// MarshalJSON marshals to json
+func (deviceTokenUbuntuPush *DeviceTokenUbuntuPush) MarshalJSON() ([]byte, error) {
+	return deviceTokenUbuntuPush.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (deviceTokenUbuntuPush *DeviceTokenUbuntuPush) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, deviceTokenUbuntuPush)
+}
+
+func (deviceTokenUbuntuPush *DeviceTokenUbuntuPush) appendJSON(b []byte) ([]byte, error) {
+	if deviceTokenUbuntuPush == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, deviceTokenUbuntuPush.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, deviceTokenUbuntuPush.tdCommon.Extra)
+	b = append(b, `,"token":`...)
+	b = appendJSONString(b, deviceTokenUbuntuPush.Token)
+	return append(b, '}'), nil
+}
+
+func (deviceTokenUbuntuPush *DeviceTokenUbuntuPush) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			deviceTokenUbuntuPush.tdCommon.Type, err = r.readString()
+		case "@extra":
+			deviceTokenUbuntuPush.tdCommon.Extra, err = r.readString()
+		case "token":
+			deviceTokenUbuntuPush.Token, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (deviceTokenUbuntuPush *DeviceTokenUbuntuPush) Clone() *DeviceTokenUbuntuPush {
+	if deviceTokenUbuntuPush == nil {
+		return nil
+	}
+	clone := *deviceTokenUbuntuPush
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (deviceTokenUbuntuPush *DeviceTokenUbuntuPush) Equal(other *DeviceTokenUbuntuPush) bool {
+	if deviceTokenUbuntuPush == nil || other == nil {
+		return deviceTokenUbuntuPush == other
+	}
+	if deviceTokenUbuntuPush.Token != other.Token {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (deviceTokenUbuntuPush *DeviceTokenUbuntuPush) Diff(other *DeviceTokenUbuntuPush) []Change {
+	return deviceTokenUbuntuPush.diff("", other, nil)
+}
+
+func (deviceTokenUbuntuPush *DeviceTokenUbuntuPush) diff(path string, other *DeviceTokenUbuntuPush, changes []Change) []Change {
+	if deviceTokenUbuntuPush == nil || other == nil {
+		if deviceTokenUbuntuPush != other {
+			changes = append(changes, Change{Path: path, Old: deviceTokenUbuntuPush, New: other})
+		}
+		return changes
+	}
+	if deviceTokenUbuntuPush.Token != other.Token {
+		changes = append(changes, Change{Path: fieldPath(path, "token"), Old: deviceTokenUbuntuPush.Token, New: other.Token})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (deviceTokenUbuntuPush *DeviceTokenUbuntuPush) String() string {
+	return objectString(deviceTokenUbuntuPush)
+}
+
+// LogValue logs the object as its summary
+func (deviceTokenUbuntuPush *DeviceTokenUbuntuPush) LogValue() slog.Value {
+	return slog.StringValue(objectString(deviceTokenUbuntuPush))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (deviceTokenUbuntuPush *DeviceTokenUbuntuPush) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, deviceTokenUbuntuPush)
+}
+
+func (deviceTokenUbuntuPush *DeviceTokenUbuntuPush) printText(p *printer) {
+	if deviceTokenUbuntuPush == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("DeviceTokenUbuntuPush")
+	p.string("token", deviceTokenUbuntuPush.Token)
+	p.endObject(state)
+}
+
 // GetDeviceTokenEnum return the enum type of this object
 func (deviceTokenUbuntuPush *DeviceTokenUbuntuPush) GetDeviceTokenEnum() DeviceTokenEnum {
 	return DeviceTokenUbuntuPushType
 }
 
+// Accept calls the method of the visitor for DeviceTokenUbuntuPush
+func (deviceTokenUbuntuPush *DeviceTokenUbuntuPush) Accept(visitor DeviceTokenVisitor) {
+	visitor.VisitDeviceTokenUbuntuPush(deviceTokenUbuntuPush)
+}
+
 // DeviceTokenBlackBerryPush A token for BlackBerry Push Service
 type DeviceTokenBlackBerryPush struct {
 	tdCommon
@@ -393,11 +1467,118 @@ func NewDeviceTokenBlackBerryPush(token string) *DeviceTokenBlackBerryPush {
 	return &deviceTokenBlackBerryPushTemp
 }
 
+// MarshalJSON marshals to json
+func (deviceTokenBlackBerryPush *DeviceTokenBlackBerryPush) MarshalJSON() ([]byte, error) {
+	return deviceTokenBlackBerryPush.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (deviceTokenBlackBerryPush *DeviceTokenBlackBerryPush) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, deviceTokenBlackBerryPush)
+}
+
+func (deviceTokenBlackBerryPush *DeviceTokenBlackBerryPush) appendJSON(b []byte) ([]byte, error) {
+	if deviceTokenBlackBerryPush == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, deviceTokenBlackBerryPush.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, deviceTokenBlackBerryPush.tdCommon.Extra)
+	b = append(b, `,"token":`...)
+	b = appendJSONString(b, deviceTokenBlackBerryPush.Token)
+	return append(b, '}'), nil
+}
+
+func (deviceTokenBlackBerryPush *DeviceTokenBlackBerryPush) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			deviceTokenBlackBerryPush.tdCommon.Type, err = r.readString()
+		case "@extra":
+			deviceTokenBlackBerryPush.tdCommon.Extra, err = r.readString()
+		case "token":
+			deviceTokenBlackBerryPush.Token, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (deviceTokenBlackBerryPush *DeviceTokenBlackBerryPush) Clone() *DeviceTokenBlackBerryPush {
+	if deviceTokenBlackBerryPush == nil {
+		return nil
+	}
+	clone := *deviceTokenBlackBerryPush
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (deviceTokenBlackBerryPush *DeviceTokenBlackBerryPush) Equal(other *DeviceTokenBlackBerryPush) bool {
+	if deviceTokenBlackBerryPush == nil || other == nil {
+		return deviceTokenBlackBerryPush == other
+	}
+	if deviceTokenBlackBerryPush.Token != other.Token {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (deviceTokenBlackBerryPush *DeviceTokenBlackBerryPush) Diff(other *DeviceTokenBlackBerryPush) []Change {
+	return deviceTokenBlackBerryPush.diff("", other, nil)
+}
+
+func (deviceTokenBlackBerryPush *DeviceTokenBlackBerryPush) diff(path string, other *DeviceTokenBlackBerryPush, changes []Change) []Change {
+	if deviceTokenBlackBerryPush == nil || other == nil {
+		if deviceTokenBlackBerryPush != other {
+			changes = append(changes, Change{Path: path, Old: deviceTokenBlackBerryPush, New: other})
+		}
+		return changes
+	}
+	if deviceTokenBlackBerryPush.Token != other.Token {
+		changes = append(changes, Change{Path: fieldPath(path, "token"), Old: deviceTokenBlackBerryPush.Token, New: other.Token})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (deviceTokenBlackBerryPush *DeviceTokenBlackBerryPush) String() string {
+	return objectString(deviceTokenBlackBerryPush)
+}
+
+// LogValue logs the object as its summary
+func (deviceTokenBlackBerryPush *DeviceTokenBlackBerryPush) LogValue() slog.Value {
+	return slog.StringValue(objectString(deviceTokenBlackBerryPush))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (deviceTokenBlackBerryPush *DeviceTokenBlackBerryPush) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, deviceTokenBlackBerryPush)
+}
+
+func (deviceTokenBlackBerryPush *DeviceTokenBlackBerryPush) printText(p *printer) {
+	if deviceTokenBlackBerryPush == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("DeviceTokenBlackBerryPush")
+	p.string("token", deviceTokenBlackBerryPush.Token)
+	p.endObject(state)
+}
+
 // GetDeviceTokenEnum return the enum type of this object
 func (deviceTokenBlackBerryPush *DeviceTokenBlackBerryPush) GetDeviceTokenEnum() DeviceTokenEnum {
 	return DeviceTokenBlackBerryPushType
 }
 
+// Accept calls the method of the visitor for DeviceTokenBlackBerryPush
+func (deviceTokenBlackBerryPush *DeviceTokenBlackBerryPush) Accept(visitor DeviceTokenVisitor) {
+	visitor.VisitDeviceTokenBlackBerryPush(deviceTokenBlackBerryPush)
+}
+
 // DeviceTokenTizenPush A token for Tizen Push Service
 type DeviceTokenTizenPush struct {
 	tdCommon
@@ -421,7 +1602,344 @@ func NewDeviceTokenTizenPush(regId string) *DeviceTokenTizenPush {
 	return &deviceTokenTizenPushTemp
 }
 
+// MarshalJSON marshals to json
+func (deviceTokenTizenPush *DeviceTokenTizenPush) MarshalJSON() ([]byte, error) {
+	return deviceTokenTizenPush.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (deviceTokenTizenPush *DeviceTokenTizenPush) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, deviceTokenTizenPush)
+}
+
+func (deviceTokenTizenPush *DeviceTokenTizenPush) appendJSON(b []byte) ([]byte, error) {
+	if deviceTokenTizenPush == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, deviceTokenTizenPush.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, deviceTokenTizenPush.tdCommon.Extra)
+	b = append(b, `,"reg_id":`...)
+	b = appendJSONString(b, deviceTokenTizenPush.RegId)
+	return append(b, '}'), nil
+}
+
+func (deviceTokenTizenPush *DeviceTokenTizenPush) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			deviceTokenTizenPush.tdCommon.Type, err = r.readString()
+		case "@extra":
+			deviceTokenTizenPush.tdCommon.Extra, err = r.readString()
+		case "reg_id":
+			deviceTokenTizenPush.RegId, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (deviceTokenTizenPush *DeviceTokenTizenPush) Clone() *DeviceTokenTizenPush {
+	if deviceTokenTizenPush == nil {
+		return nil
+	}
+	clone := *deviceTokenTizenPush
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (deviceTokenTizenPush *DeviceTokenTizenPush) Equal(other *DeviceTokenTizenPush) bool {
+	if deviceTokenTizenPush == nil || other == nil {
+		return deviceTokenTizenPush == other
+	}
+	if deviceTokenTizenPush.RegId != other.RegId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (deviceTokenTizenPush *DeviceTokenTizenPush) Diff(other *DeviceTokenTizenPush) []Change {
+	return deviceTokenTizenPush.diff("", other, nil)
+}
+
+func (deviceTokenTizenPush *DeviceTokenTizenPush) diff(path string, other *DeviceTokenTizenPush, changes []Change) []Change {
+	if deviceTokenTizenPush == nil || other == nil {
+		if deviceTokenTizenPush != other {
+			changes = append(changes, Change{Path: path, Old: deviceTokenTizenPush, New: other})
+		}
+		return changes
+	}
+	if deviceTokenTizenPush.RegId != other.RegId {
+		changes = append(changes, Change{Path: fieldPath(path, "reg_id"), Old: deviceTokenTizenPush.RegId, New: other.RegId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (deviceTokenTizenPush *DeviceTokenTizenPush) String() string {
+	return objectString(deviceTokenTizenPush)
+}
+
+// LogValue logs the object as its summary
+func (deviceTokenTizenPush *DeviceTokenTizenPush) LogValue() slog.Value {
+	return slog.StringValue(objectString(deviceTokenTizenPush))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (deviceTokenTizenPush *DeviceTokenTizenPush) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, deviceTokenTizenPush)
+}
+
+func (deviceTokenTizenPush *DeviceTokenTizenPush) printText(p *printer) {
+	if deviceTokenTizenPush == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("DeviceTokenTizenPush")
+	p.string("reg_id", deviceTokenTizenPush.RegId)
+	p.endObject(state)
+}
+
 // GetDeviceTokenEnum return the enum type of this object
 func (deviceTokenTizenPush *DeviceTokenTizenPush) GetDeviceTokenEnum() DeviceTokenEnum {
 	return DeviceTokenTizenPushType
 }
+
+// Accept calls the method of the visitor for DeviceTokenTizenPush
+func (deviceTokenTizenPush *DeviceTokenTizenPush) Accept(visitor DeviceTokenVisitor) {
+	visitor.VisitDeviceTokenTizenPush(deviceTokenTizenPush)
+}
+
+// DeviceTokenVisitor visits every type of DeviceToken, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to DeviceToken, until they handle it too.
+type DeviceTokenVisitor interface {
+	VisitDeviceTokenFirebaseCloudMessaging(deviceTokenFirebaseCloudMessaging *DeviceTokenFirebaseCloudMessaging)
+	VisitDeviceTokenApplePush(deviceTokenApplePush *DeviceTokenApplePush)
+	VisitDeviceTokenApplePushVoIP(deviceTokenApplePushVoIP *DeviceTokenApplePushVoIP)
+	VisitDeviceTokenWindowsPush(deviceTokenWindowsPush *DeviceTokenWindowsPush)
+	VisitDeviceTokenMicrosoftPush(deviceTokenMicrosoftPush *DeviceTokenMicrosoftPush)
+	VisitDeviceTokenMicrosoftPushVoIP(deviceTokenMicrosoftPushVoIP *DeviceTokenMicrosoftPushVoIP)
+	VisitDeviceTokenWebPush(deviceTokenWebPush *DeviceTokenWebPush)
+	VisitDeviceTokenSimplePush(deviceTokenSimplePush *DeviceTokenSimplePush)
+	VisitDeviceTokenUbuntuPush(deviceTokenUbuntuPush *DeviceTokenUbuntuPush)
+	VisitDeviceTokenBlackBerryPush(deviceTokenBlackBerryPush *DeviceTokenBlackBerryPush)
+	VisitDeviceTokenTizenPush(deviceTokenTizenPush *DeviceTokenTizenPush)
+	VisitUnknownDeviceToken(unknownDeviceToken *UnknownDeviceToken)
+}
+
+// DeviceTokenCases holds the function MatchDeviceToken calls for each type of DeviceToken;
+// types without a function are passed to the default case
+type DeviceTokenCases[R any] struct {
+	DeviceTokenFirebaseCloudMessaging func(deviceTokenFirebaseCloudMessaging *DeviceTokenFirebaseCloudMessaging) R
+	DeviceTokenApplePush              func(deviceTokenApplePush *DeviceTokenApplePush) R
+	DeviceTokenApplePushVoIP          func(deviceTokenApplePushVoIP *DeviceTokenApplePushVoIP) R
+	DeviceTokenWindowsPush            func(deviceTokenWindowsPush *DeviceTokenWindowsPush) R
+	DeviceTokenMicrosoftPush          func(deviceTokenMicrosoftPush *DeviceTokenMicrosoftPush) R
+	DeviceTokenMicrosoftPushVoIP      func(deviceTokenMicrosoftPushVoIP *DeviceTokenMicrosoftPushVoIP) R
+	DeviceTokenWebPush                func(deviceTokenWebPush *DeviceTokenWebPush) R
+	DeviceTokenSimplePush             func(deviceTokenSimplePush *DeviceTokenSimplePush) R
+	DeviceTokenUbuntuPush             func(deviceTokenUbuntuPush *DeviceTokenUbuntuPush) R
+	DeviceTokenBlackBerryPush         func(deviceTokenBlackBerryPush *DeviceTokenBlackBerryPush) R
+	DeviceTokenTizenPush              func(deviceTokenTizenPush *DeviceTokenTizenPush) R
+}
+
+// MatchDeviceToken calls the case of the type of deviceToken, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement DeviceTokenVisitor
+// to have the compiler check every type is handled.
+func MatchDeviceToken[R any](deviceToken DeviceToken, cases DeviceTokenCases[R], defaultCase func(deviceToken DeviceToken) R) R {
+	switch value := deviceToken.(type) {
+	case *DeviceTokenFirebaseCloudMessaging:
+		if cases.DeviceTokenFirebaseCloudMessaging != nil {
+			return cases.DeviceTokenFirebaseCloudMessaging(value)
+		}
+	case *DeviceTokenApplePush:
+		if cases.DeviceTokenApplePush != nil {
+			return cases.DeviceTokenApplePush(value)
+		}
+	case *DeviceTokenApplePushVoIP:
+		if cases.DeviceTokenApplePushVoIP != nil {
+			return cases.DeviceTokenApplePushVoIP(value)
+		}
+	case *DeviceTokenWindowsPush:
+		if cases.DeviceTokenWindowsPush != nil {
+			return cases.DeviceTokenWindowsPush(value)
+		}
+	case *DeviceTokenMicrosoftPush:
+		if cases.DeviceTokenMicrosoftPush != nil {
+			return cases.DeviceTokenMicrosoftPush(value)
+		}
+	case *DeviceTokenMicrosoftPushVoIP:
+		if cases.DeviceTokenMicrosoftPushVoIP != nil {
+			return cases.DeviceTokenMicrosoftPushVoIP(value)
+		}
+	case *DeviceTokenWebPush:
+		if cases.DeviceTokenWebPush != nil {
+			return cases.DeviceTokenWebPush(value)
+		}
+	case *DeviceTokenSimplePush:
+		if cases.DeviceTokenSimplePush != nil {
+			return cases.DeviceTokenSimplePush(value)
+		}
+	case *DeviceTokenUbuntuPush:
+		if cases.DeviceTokenUbuntuPush != nil {
+			return cases.DeviceTokenUbuntuPush(value)
+		}
+	case *DeviceTokenBlackBerryPush:
+		if cases.DeviceTokenBlackBerryPush != nil {
+			return cases.DeviceTokenBlackBerryPush(value)
+		}
+	case *DeviceTokenTizenPush:
+		if cases.DeviceTokenTizenPush != nil {
+			return cases.DeviceTokenTizenPush(value)
+		}
+	}
+	return defaultCase(deviceToken)
+}
+
+// Clone returns a deep copy of the object
+func (unknownDeviceToken *UnknownDeviceToken) Clone() *UnknownDeviceToken {
+	if unknownDeviceToken == nil {
+		return nil
+	}
+	return &UnknownDeviceToken{Unknown: unknownDeviceToken.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownDeviceToken *UnknownDeviceToken) Equal(other *UnknownDeviceToken) bool {
+	if unknownDeviceToken == nil || other == nil {
+		return unknownDeviceToken == other
+	}
+	return unknownDeviceToken.Unknown.equal(other.Unknown)
+}
+
+func cloneDeviceToken(deviceToken DeviceToken) DeviceToken {
+	switch value := deviceToken.(type) {
+	case *DeviceTokenFirebaseCloudMessaging:
+		return value.Clone()
+	case *DeviceTokenApplePush:
+		return value.Clone()
+	case *DeviceTokenApplePushVoIP:
+		return value.Clone()
+	case *DeviceTokenWindowsPush:
+		return value.Clone()
+	case *DeviceTokenMicrosoftPush:
+		return value.Clone()
+	case *DeviceTokenMicrosoftPushVoIP:
+		return value.Clone()
+	case *DeviceTokenWebPush:
+		return value.Clone()
+	case *DeviceTokenSimplePush:
+		return value.Clone()
+	case *DeviceTokenUbuntuPush:
+		return value.Clone()
+	case *DeviceTokenBlackBerryPush:
+		return value.Clone()
+	case *DeviceTokenTizenPush:
+		return value.Clone()
+	case *UnknownDeviceToken:
+		return value.Clone()
+	}
+	return deviceToken
+}
+
+func equalDeviceToken(a DeviceToken, b DeviceToken) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *DeviceTokenFirebaseCloudMessaging:
+		b, ok := b.(*DeviceTokenFirebaseCloudMessaging)
+		return ok && a.Equal(b)
+	case *DeviceTokenApplePush:
+		b, ok := b.(*DeviceTokenApplePush)
+		return ok && a.Equal(b)
+	case *DeviceTokenApplePushVoIP:
+		b, ok := b.(*DeviceTokenApplePushVoIP)
+		return ok && a.Equal(b)
+	case *DeviceTokenWindowsPush:
+		b, ok := b.(*DeviceTokenWindowsPush)
+		return ok && a.Equal(b)
+	case *DeviceTokenMicrosoftPush:
+		b, ok := b.(*DeviceTokenMicrosoftPush)
+		return ok && a.Equal(b)
+	case *DeviceTokenMicrosoftPushVoIP:
+		b, ok := b.(*DeviceTokenMicrosoftPushVoIP)
+		return ok && a.Equal(b)
+	case *DeviceTokenWebPush:
+		b, ok := b.(*DeviceTokenWebPush)
+		return ok && a.Equal(b)
+	case *DeviceTokenSimplePush:
+		b, ok := b.(*DeviceTokenSimplePush)
+		return ok && a.Equal(b)
+	case *DeviceTokenUbuntuPush:
+		b, ok := b.(*DeviceTokenUbuntuPush)
+		return ok && a.Equal(b)
+	case *DeviceTokenBlackBerryPush:
+		b, ok := b.(*DeviceTokenBlackBerryPush)
+		return ok && a.Equal(b)
+	case *DeviceTokenTizenPush:
+		b, ok := b.(*DeviceTokenTizenPush)
+		return ok && a.Equal(b)
+	case *UnknownDeviceToken:
+		b, ok := b.(*UnknownDeviceToken)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffDeviceToken(path string, a DeviceToken, b DeviceToken, changes []Change) []Change {
+	switch a := a.(type) {
+	case *DeviceTokenFirebaseCloudMessaging:
+		if b, ok := b.(*DeviceTokenFirebaseCloudMessaging); ok {
+			return a.diff(path, b, changes)
+		}
+	case *DeviceTokenApplePush:
+		if b, ok := b.(*DeviceTokenApplePush); ok {
+			return a.diff(path, b, changes)
+		}
+	case *DeviceTokenApplePushVoIP:
+		if b, ok := b.(*DeviceTokenApplePushVoIP); ok {
+			return a.diff(path, b, changes)
+		}
+	case *DeviceTokenWindowsPush:
+		if b, ok := b.(*DeviceTokenWindowsPush); ok {
+			return a.diff(path, b, changes)
+		}
+	case *DeviceTokenMicrosoftPush:
+		if b, ok := b.(*DeviceTokenMicrosoftPush); ok {
+			return a.diff(path, b, changes)
+		}
+	case *DeviceTokenMicrosoftPushVoIP:
+		if b, ok := b.(*DeviceTokenMicrosoftPushVoIP); ok {
+			return a.diff(path, b, changes)
+		}
+	case *DeviceTokenWebPush:
+		if b, ok := b.(*DeviceTokenWebPush); ok {
+			return a.diff(path, b, changes)
+		}
+	case *DeviceTokenSimplePush:
+		if b, ok := b.(*DeviceTokenSimplePush); ok {
+			return a.diff(path, b, changes)
+		}
+	case *DeviceTokenUbuntuPush:
+		if b, ok := b.(*DeviceTokenUbuntuPush); ok {
+			return a.diff(path, b, changes)
+		}
+	case *DeviceTokenBlackBerryPush:
+		if b, ok := b.(*DeviceTokenBlackBerryPush); ok {
+			return a.diff(path, b, changes)
+		}
+	case *DeviceTokenTizenPush:
+		if b, ok := b.(*DeviceTokenTizenPush); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalDeviceToken(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
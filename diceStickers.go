@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // DiceStickers Contains animated stickers which must be used for dice animation rendering
 type DiceStickers interface {
 	GetDiceStickersEnum() DiceStickersEnum
+	Accept(visitor DiceStickersVisitor)
 }
 
 // DiceStickersEnum Alias for abstract DiceStickers 'Sub-Classes', used as constant-enum here
@@ -19,33 +21,84 @@ const (
 	DiceStickersSlotMachineType DiceStickersEnum = "diceStickersSlotMachine"
 )
 
+// UnknownDiceStickers is a DiceStickers of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownDiceStickers struct {
+	Unknown
+}
+
+// GetDiceStickersEnum return the enum type of this object
+func (unknownDiceStickers *UnknownDiceStickers) GetDiceStickersEnum() DiceStickersEnum {
+	return DiceStickersEnum(unknownDiceStickers.Type)
+}
+
+// Accept calls the method of the visitor for UnknownDiceStickers
+func (unknownDiceStickers *UnknownDiceStickers) Accept(visitor DiceStickersVisitor) {
+	visitor.VisitUnknownDiceStickers(unknownDiceStickers)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownDiceStickers *UnknownDiceStickers) String() string {
+	return objectString(unknownDiceStickers)
+}
+
+// LogValue logs the object as its summary
+func (unknownDiceStickers *UnknownDiceStickers) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownDiceStickers))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownDiceStickers *UnknownDiceStickers) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownDiceStickers)
+}
+
+func (unknownDiceStickers *UnknownDiceStickers) printText(p *printer) {
+	if unknownDiceStickers == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownDiceStickers")
+	p.string("@type", unknownDiceStickers.Type)
+	p.endObject(state)
+}
+
 func unmarshalDiceStickers(rawMsg *json.RawMessage) (DiceStickers, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch DiceStickersEnum(objMap["@type"].(string)) {
+	switch DiceStickersEnum(typeName) {
 	case DiceStickersRegularType:
 		var diceStickersRegular DiceStickersRegular
-		err := json.Unmarshal(*rawMsg, &diceStickersRegular)
+		err := jsonUnmarshal(*rawMsg, &diceStickersRegular)
 		return &diceStickersRegular, err
 
 	case DiceStickersSlotMachineType:
 		var diceStickersSlotMachine DiceStickersSlotMachine
-		err := json.Unmarshal(*rawMsg, &diceStickersSlotMachine)
+		err := jsonUnmarshal(*rawMsg, &diceStickersSlotMachine)
 		return &diceStickersSlotMachine, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownDiceStickers{Unknown: unknown}, nil
 	}
 }
 
+func decodeDiceStickers(r *jsonReader) (DiceStickers, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalDiceStickers(rawMsg)
+}
+
 // DiceStickersRegular A regular animated sticker
 type DiceStickersRegular struct {
 	tdCommon
@@ -69,11 +122,126 @@ func NewDiceStickersRegular(sticker *Sticker) *DiceStickersRegular {
 	return &diceStickersRegularTemp
 }
 
+// MarshalJSON marshals to json
+func (diceStickersRegular *DiceStickersRegular) MarshalJSON() ([]byte, error) {
+	return diceStickersRegular.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (diceStickersRegular *DiceStickersRegular) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, diceStickersRegular)
+}
+
+func (diceStickersRegular *DiceStickersRegular) appendJSON(b []byte) ([]byte, error) {
+	if diceStickersRegular == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, diceStickersRegular.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, diceStickersRegular.tdCommon.Extra)
+	b = append(b, `,"sticker":`...)
+	b, err = diceStickersRegular.Sticker.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (diceStickersRegular *DiceStickersRegular) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			diceStickersRegular.tdCommon.Type, err = r.readString()
+		case "@extra":
+			diceStickersRegular.tdCommon.Extra, err = r.readString()
+		case "sticker":
+			if r.readNull() {
+				diceStickersRegular.Sticker = nil
+			} else {
+				diceStickersRegular.Sticker = new(Sticker)
+				err = diceStickersRegular.Sticker.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (diceStickersRegular *DiceStickersRegular) Clone() *DiceStickersRegular {
+	if diceStickersRegular == nil {
+		return nil
+	}
+	clone := *diceStickersRegular
+	clone.Sticker = diceStickersRegular.Sticker.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (diceStickersRegular *DiceStickersRegular) Equal(other *DiceStickersRegular) bool {
+	if diceStickersRegular == nil || other == nil {
+		return diceStickersRegular == other
+	}
+	if !diceStickersRegular.Sticker.Equal(other.Sticker) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (diceStickersRegular *DiceStickersRegular) Diff(other *DiceStickersRegular) []Change {
+	return diceStickersRegular.diff("", other, nil)
+}
+
+func (diceStickersRegular *DiceStickersRegular) diff(path string, other *DiceStickersRegular, changes []Change) []Change {
+	if diceStickersRegular == nil || other == nil {
+		if diceStickersRegular != other {
+			changes = append(changes, Change{Path: path, Old: diceStickersRegular, New: other})
+		}
+		return changes
+	}
+	changes = diceStickersRegular.Sticker.diff(fieldPath(path, "sticker"), other.Sticker, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (diceStickersRegular *DiceStickersRegular) String() string {
+	return objectString(diceStickersRegular)
+}
+
+// LogValue logs the object as its summary
+func (diceStickersRegular *DiceStickersRegular) LogValue() slog.Value {
+	return slog.StringValue(objectString(diceStickersRegular))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (diceStickersRegular *DiceStickersRegular) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, diceStickersRegular)
+}
+
+func (diceStickersRegular *DiceStickersRegular) printText(p *printer) {
+	if diceStickersRegular == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("DiceStickersRegular")
+	p.object("sticker", diceStickersRegular.Sticker)
+	p.endObject(state)
+}
+
 // GetDiceStickersEnum return the enum type of this object
 func (diceStickersRegular *DiceStickersRegular) GetDiceStickersEnum() DiceStickersEnum {
 	return DiceStickersRegularType
 }
 
+// Accept calls the method of the visitor for DiceStickersRegular
+func (diceStickersRegular *DiceStickersRegular) Accept(visitor DiceStickersVisitor) {
+	visitor.VisitDiceStickersRegular(diceStickersRegular)
+}
+
 // DiceStickersSlotMachine Animated stickers to be combined into a slot machine
 type DiceStickersSlotMachine struct {
 	tdCommon
@@ -109,7 +277,289 @@ func NewDiceStickersSlotMachine(background *Sticker, lever *Sticker, leftReel *S
 	return &diceStickersSlotMachineTemp
 }
 
+// MarshalJSON marshals to json
+func (diceStickersSlotMachine *DiceStickersSlotMachine) MarshalJSON() ([]byte, error) {
+	return diceStickersSlotMachine.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (diceStickersSlotMachine *DiceStickersSlotMachine) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, diceStickersSlotMachine)
+}
+
+func (diceStickersSlotMachine *DiceStickersSlotMachine) appendJSON(b []byte) ([]byte, error) {
+	if diceStickersSlotMachine == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, diceStickersSlotMachine.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, diceStickersSlotMachine.tdCommon.Extra)
+	b = append(b, `,"background":`...)
+	b, err = diceStickersSlotMachine.Background.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"lever":`...)
+	b, err = diceStickersSlotMachine.Lever.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"left_reel":`...)
+	b, err = diceStickersSlotMachine.LeftReel.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"center_reel":`...)
+	b, err = diceStickersSlotMachine.CenterReel.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"right_reel":`...)
+	b, err = diceStickersSlotMachine.RightReel.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (diceStickersSlotMachine *DiceStickersSlotMachine) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			diceStickersSlotMachine.tdCommon.Type, err = r.readString()
+		case "@extra":
+			diceStickersSlotMachine.tdCommon.Extra, err = r.readString()
+		case "background":
+			if r.readNull() {
+				diceStickersSlotMachine.Background = nil
+			} else {
+				diceStickersSlotMachine.Background = new(Sticker)
+				err = diceStickersSlotMachine.Background.decodeJSON(r)
+			}
+		case "lever":
+			if r.readNull() {
+				diceStickersSlotMachine.Lever = nil
+			} else {
+				diceStickersSlotMachine.Lever = new(Sticker)
+				err = diceStickersSlotMachine.Lever.decodeJSON(r)
+			}
+		case "left_reel":
+			if r.readNull() {
+				diceStickersSlotMachine.LeftReel = nil
+			} else {
+				diceStickersSlotMachine.LeftReel = new(Sticker)
+				err = diceStickersSlotMachine.LeftReel.decodeJSON(r)
+			}
+		case "center_reel":
+			if r.readNull() {
+				diceStickersSlotMachine.CenterReel = nil
+			} else {
+				diceStickersSlotMachine.CenterReel = new(Sticker)
+				err = diceStickersSlotMachine.CenterReel.decodeJSON(r)
+			}
+		case "right_reel":
+			if r.readNull() {
+				diceStickersSlotMachine.RightReel = nil
+			} else {
+				diceStickersSlotMachine.RightReel = new(Sticker)
+				err = diceStickersSlotMachine.RightReel.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (diceStickersSlotMachine *DiceStickersSlotMachine) Clone() *DiceStickersSlotMachine {
+	if diceStickersSlotMachine == nil {
+		return nil
+	}
+	clone := *diceStickersSlotMachine
+	clone.Background = diceStickersSlotMachine.Background.Clone()
+	clone.Lever = diceStickersSlotMachine.Lever.Clone()
+	clone.LeftReel = diceStickersSlotMachine.LeftReel.Clone()
+	clone.CenterReel = diceStickersSlotMachine.CenterReel.Clone()
+	clone.RightReel = diceStickersSlotMachine.RightReel.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (diceStickersSlotMachine *DiceStickersSlotMachine) Equal(other *DiceStickersSlotMachine) bool {
+	if diceStickersSlotMachine == nil || other == nil {
+		return diceStickersSlotMachine == other
+	}
+	if !diceStickersSlotMachine.Background.Equal(other.Background) {
+		return false
+	}
+	if !diceStickersSlotMachine.Lever.Equal(other.Lever) {
+		return false
+	}
+	if !diceStickersSlotMachine.LeftReel.Equal(other.LeftReel) {
+		return false
+	}
+	if !diceStickersSlotMachine.CenterReel.Equal(other.CenterReel) {
+		return false
+	}
+	if !diceStickersSlotMachine.RightReel.Equal(other.RightReel) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (diceStickersSlotMachine *DiceStickersSlotMachine) Diff(other *DiceStickersSlotMachine) []Change {
+	return diceStickersSlotMachine.diff("", other, nil)
+}
+
+func (diceStickersSlotMachine *DiceStickersSlotMachine) diff(path string, other *DiceStickersSlotMachine, changes []Change) []Change {
+	if diceStickersSlotMachine == nil || other == nil {
+		if diceStickersSlotMachine != other {
+			changes = append(changes, Change{Path: path, Old: diceStickersSlotMachine, New: other})
+		}
+		return changes
+	}
+	changes = diceStickersSlotMachine.Background.diff(fieldPath(path, "background"), other.Background, changes)
+	changes = diceStickersSlotMachine.Lever.diff(fieldPath(path, "lever"), other.Lever, changes)
+	changes = diceStickersSlotMachine.LeftReel.diff(fieldPath(path, "left_reel"), other.LeftReel, changes)
+	changes = diceStickersSlotMachine.CenterReel.diff(fieldPath(path, "center_reel"), other.CenterReel, changes)
+	changes = diceStickersSlotMachine.RightReel.diff(fieldPath(path, "right_reel"), other.RightReel, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (diceStickersSlotMachine *DiceStickersSlotMachine) String() string {
+	return objectString(diceStickersSlotMachine)
+}
+
+// LogValue logs the object as its summary
+func (diceStickersSlotMachine *DiceStickersSlotMachine) LogValue() slog.Value {
+	return slog.StringValue(objectString(diceStickersSlotMachine))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (diceStickersSlotMachine *DiceStickersSlotMachine) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, diceStickersSlotMachine)
+}
+
+func (diceStickersSlotMachine *DiceStickersSlotMachine) printText(p *printer) {
+	if diceStickersSlotMachine == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("DiceStickersSlotMachine")
+	p.object("background", diceStickersSlotMachine.Background)
+	p.object("lever", diceStickersSlotMachine.Lever)
+	p.object("left_reel", diceStickersSlotMachine.LeftReel)
+	p.object("center_reel", diceStickersSlotMachine.CenterReel)
+	p.object("right_reel", diceStickersSlotMachine.RightReel)
+	p.endObject(state)
+}
+
 // GetDiceStickersEnum return the enum type of this object
 func (diceStickersSlotMachine *DiceStickersSlotMachine) GetDiceStickersEnum() DiceStickersEnum {
 	return DiceStickersSlotMachineType
 }
+
+// Accept calls the method of the visitor for DiceStickersSlotMachine
+func (diceStickersSlotMachine *DiceStickersSlotMachine) Accept(visitor DiceStickersVisitor) {
+	visitor.VisitDiceStickersSlotMachine(diceStickersSlotMachine)
+}
+
+// DiceStickersVisitor visits every type of DiceStickers, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to DiceStickers, until they handle it too.
+type DiceStickersVisitor interface {
+	VisitDiceStickersRegular(diceStickersRegular *DiceStickersRegular)
+	VisitDiceStickersSlotMachine(diceStickersSlotMachine *DiceStickersSlotMachine)
+	VisitUnknownDiceStickers(unknownDiceStickers *UnknownDiceStickers)
+}
+
+// DiceStickersCases holds the function MatchDiceStickers calls for each type of DiceStickers;
+// types without a function are passed to the default case
+type DiceStickersCases[R any] struct {
+	DiceStickersRegular     func(diceStickersRegular *DiceStickersRegular) R
+	DiceStickersSlotMachine func(diceStickersSlotMachine *DiceStickersSlotMachine) R
+}
+
+// MatchDiceStickers calls the case of the type of diceStickers, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement DiceStickersVisitor
+// to have the compiler check every type is handled.
+func MatchDiceStickers[R any](diceStickers DiceStickers, cases DiceStickersCases[R], defaultCase func(diceStickers DiceStickers) R) R {
+	switch value := diceStickers.(type) {
+	case *DiceStickersRegular:
+		if cases.DiceStickersRegular != nil {
+			return cases.DiceStickersRegular(value)
+		}
+	case *DiceStickersSlotMachine:
+		if cases.DiceStickersSlotMachine != nil {
+			return cases.DiceStickersSlotMachine(value)
+		}
+	}
+	return defaultCase(diceStickers)
+}
+
+// Clone returns a deep copy of the object
+func (unknownDiceStickers *UnknownDiceStickers) Clone() *UnknownDiceStickers {
+	if unknownDiceStickers == nil {
+		return nil
+	}
+	return &UnknownDiceStickers{Unknown: unknownDiceStickers.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownDiceStickers *UnknownDiceStickers) Equal(other *UnknownDiceStickers) bool {
+	if unknownDiceStickers == nil || other == nil {
+		return unknownDiceStickers == other
+	}
+	return unknownDiceStickers.Unknown.equal(other.Unknown)
+}
+
+func cloneDiceStickers(diceStickers DiceStickers) DiceStickers {
+	switch value := diceStickers.(type) {
+	case *DiceStickersRegular:
+		return value.Clone()
+	case *DiceStickersSlotMachine:
+		return value.Clone()
+	case *UnknownDiceStickers:
+		return value.Clone()
+	}
+	return diceStickers
+}
+
+func equalDiceStickers(a DiceStickers, b DiceStickers) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *DiceStickersRegular:
+		b, ok := b.(*DiceStickersRegular)
+		return ok && a.Equal(b)
+	case *DiceStickersSlotMachine:
+		b, ok := b.(*DiceStickersSlotMachine)
+		return ok && a.Equal(b)
+	case *UnknownDiceStickers:
+		b, ok := b.(*UnknownDiceStickers)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffDiceStickers(path string, a DiceStickers, b DiceStickers, changes []Change) []Change {
+	switch a := a.(type) {
+	case *DiceStickersRegular:
+		if b, ok := b.(*DiceStickersRegular); ok {
+			return a.diff(path, b, changes)
+		}
+	case *DiceStickersSlotMachine:
+		if b, ok := b.(*DiceStickersSlotMachine); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalDiceStickers(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -0,0 +1,45 @@
+package tdlib
+
+import (
+	"bytes"
+	"strconv"
+)
+
+// Change is a field which differs between two objects, as reported by the generated Diff methods.
+// Old and New hold the values of the field, shared with the objects.
+type Change struct {
+	Path string      // JSON path of the field, e.g. permissions.can_send_messages or positions[0].order
+	Old  interface{} // Value of the field in the old object
+	New  interface{} // Value of the field in the new object
+}
+
+// String formats the change with the values as JSON, e.g. for audit logs
+func (change Change) String() string {
+	return change.Path + ": " + changeValue(change.Old) + " -> " + changeValue(change.New)
+}
+
+func changeValue(value interface{}) string {
+	jsonBytes, err := jsonMarshal(value)
+	if err != nil {
+		return "<" + err.Error() + ">"
+	}
+	return string(jsonBytes)
+}
+
+// fieldPath returns the path of a field of the object at path
+func fieldPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// indexPath returns the path of an item of the vector at path
+func indexPath(path string, i int) string {
+	return path + "[" + strconv.Itoa(i) + "]"
+}
+
+// equalBytes reports whether two bytes fields are equal, a nil and an empty one are
+func equalBytes(a, b []byte) bool {
+	return bytes.Equal(a, b)
+}
@@ -0,0 +1,165 @@
+package tdlib
+
+import (
+	"reflect"
+	"testing"
+)
+
+// testChat returns a chat with pointer, vector and interface fields set
+func testChat(t *testing.T) *Chat {
+	var chat Chat
+	err := jsonUnmarshal([]byte(`{
+		"@type": "chat", "@extra": "extra", "id": -100, "title": "Chat",
+		"type": {"@type": "chatTypeSupergroup", "supergroup_id": 1, "is_channel": false},
+		"permissions": {"@type": "chatPermissions", "can_send_messages": true},
+		"last_message": {"@type": "message", "id": 5, "chat_id": -100,
+			"sender_id": {"@type": "messageSenderUser", "user_id": 7},
+			"content": {"@type": "messageText", "text": {"@type": "formattedText", "text": "hi",
+				"entities": [{"@type": "textEntity", "offset": 0, "length": 2, "type": {"@type": "textEntityTypeBold"}}]}}},
+		"positions": [
+			{"@type": "chatPosition", "list": {"@type": "chatListMain"}, "order": "10", "is_pinned": false},
+			{"@type": "chatPosition", "list": {"@type": "chatListFilter", "chat_filter_id": 3}, "order": "20", "is_pinned": true}
+		],
+		"message_sender_id": {"@type": "messageSenderChat", "chat_id": -100}
+	}`), &chat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &chat
+}
+
+func TestCloneIsDeep(t *testing.T) {
+	original := testChat(t)
+	clone := original.Clone()
+	if !reflect.DeepEqual(clone, original) || !clone.Equal(original) {
+		t.Fatalf("clone %+v differs from the original %+v", clone, original)
+	}
+
+	// mutating the pointers, vectors and interfaces of the clone leaves the original unchanged
+	clone.Permissions.CanSendMessages = false
+	clone.Positions[0].Order = 11
+	clone.Positions[1].List.(*ChatListFilter).ChatFilterId = 4
+	clone.Positions = append(clone.Positions[:1], clone.Positions[0])
+	clone.Type.(*ChatTypeSupergroup).IsChannel = true
+	clone.MessageSenderId.(*MessageSenderChat).ChatId = -200
+	clone.LastMessage.SenderId.(*MessageSenderUser).UserId = 8
+	text := clone.LastMessage.Content.(*MessageText).Text
+	text.Text = "bye"
+	text.Entities[0].Length = 3
+	text.Entities[0].Type = &TextEntityTypeItalic{tdCommon: tdCommon{Type: "textEntityTypeItalic"}}
+
+	if want := testChat(t); !reflect.DeepEqual(original, want) {
+		t.Errorf("original changed to %+v with its clone", original)
+	}
+
+	// bytes are copied too
+	invoice := NewInputMessageInvoice(NewInvoice("USD", nil, 0, nil, false, false, false, false, false, false, false, false),
+		"Coffee", "", "", 0, 0, 0, []byte("order"), "token", "", "")
+	invoiceClone := invoice.Clone()
+	invoiceClone.Payload[0] = 'O'
+	invoiceClone.Invoice.Currency = "EUR"
+	if string(invoice.Payload) != "order" || invoice.Invoice.Currency != "USD" {
+		t.Errorf("original invoice changed to %s %s with its clone", invoice.Payload, invoice.Invoice.Currency)
+	}
+
+	var nilChat *Chat
+	if nilChat.Clone() != nil {
+		t.Error("clone of a nil chat isn't nil")
+	}
+}
+
+func TestEqual(t *testing.T) {
+	chat := testChat(t)
+
+	other := testChat(t)
+	other.Extra = "other"
+	if !chat.Equal(other) {
+		t.Error("chats with different @extra aren't equal")
+	}
+	other.LastMessage.Extra = "other"
+	other.Positions[0].List.(*ChatListMain).Extra = "other"
+	if !chat.Equal(other) {
+		t.Error("chats with different @extra in nested objects aren't equal")
+	}
+
+	for name, change := range map[string]func(chat *Chat){
+		"field":                      func(chat *Chat) { chat.Title = "Other" },
+		"pointer":                    func(chat *Chat) { chat.Permissions.CanSendMessages = false },
+		"nil pointer":                func(chat *Chat) { chat.Permissions = nil },
+		"vector length":              func(chat *Chat) { chat.Positions = chat.Positions[:1] },
+		"vector item":                func(chat *Chat) { chat.Positions[1].IsPinned = false },
+		"interface type":             func(chat *Chat) { chat.MessageSenderId = NewMessageSenderUser(-100) },
+		"interface value":            func(chat *Chat) { chat.Positions[1].List.(*ChatListFilter).ChatFilterId = 4 },
+		"nil interface":              func(chat *Chat) { chat.Type = nil },
+		"nested vector in interface": func(chat *Chat) { chat.LastMessage.Content.(*MessageText).Text.Entities = nil },
+	} {
+		other := testChat(t)
+		change(other)
+		if chat.Equal(other) || other.Equal(chat) {
+			t.Errorf("chats with a different %s are equal", name)
+		}
+	}
+
+	var nilChat *Chat
+	if chat.Equal(nil) || !nilChat.Equal(nil) {
+		t.Error("nil chats are compared wrong")
+	}
+
+	// a nil and an empty bytes field are equal
+	a := NewCallbackQueryPayloadData(nil)
+	b := NewCallbackQueryPayloadData([]byte{})
+	if !a.Equal(b) {
+		t.Error("nil and empty bytes aren't equal")
+	}
+}
+
+func TestDiff(t *testing.T) {
+	chat := testChat(t)
+	other := testChat(t)
+	other.Extra = "other"
+	if changes := chat.Diff(other); len(changes) != 0 {
+		t.Errorf("chats with different @extra have the changes %v", changes)
+	}
+
+	other.Title = "Other"
+	other.Permissions.CanSendMessages = false
+	other.Positions[0].Order = 11
+	other.Positions[1].List.(*ChatListFilter).ChatFilterId = 4
+	other.MessageSenderId = NewMessageSenderUser(7)
+	other.LastMessage.Content.(*MessageText).Text.Entities[0].Length = 1
+	other.Type = nil
+
+	var paths []string
+	for _, change := range chat.Diff(other) {
+		paths = append(paths, change.Path)
+	}
+	want := []string{
+		"type",
+		"title",
+		"permissions.can_send_messages",
+		"last_message.content.text.entities[0].length",
+		"positions[0].order",
+		"positions[1].list.chat_filter_id",
+		"message_sender_id",
+	}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("changes are at %v, want %v", paths, want)
+	}
+
+	changes := chat.Diff(other)
+	if changes[1].Old != "Chat" || changes[1].New != "Other" || changes[1].String() != `title: "Chat" -> "Other"` {
+		t.Errorf("title change is %v", changes[1])
+	}
+
+	// vectors of different lengths are one change
+	other = testChat(t)
+	other.Positions = other.Positions[:1]
+	if changes := chat.Diff(other); len(changes) != 1 || changes[0].Path != "positions" {
+		t.Errorf("changes are %v, want the positions", changes)
+	}
+
+	// a nil object is one change
+	if changes := chat.Diff(nil); len(changes) != 1 || changes[0].Path != "" {
+		t.Errorf("changes are %v, want the whole chat", changes)
+	}
+}
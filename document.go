@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // Document Describes a document of any type
 type Document struct {
 	tdCommon
@@ -34,3 +39,171 @@ func NewDocument(fileName string, mimeType string, minithumbnail *Minithumbnail,
 
 	return &documentTemp
 }
+
+// MarshalJSON marshals to json
+func (document *Document) MarshalJSON() ([]byte, error) {
+	return document.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (document *Document) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, document)
+}
+
+func (document *Document) appendJSON(b []byte) ([]byte, error) {
+	if document == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, document.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, document.tdCommon.Extra)
+	b = append(b, `,"file_name":`...)
+	b = appendJSONString(b, document.FileName)
+	b = append(b, `,"mime_type":`...)
+	b = appendJSONString(b, document.MimeType)
+	b = append(b, `,"minithumbnail":`...)
+	b, err = document.Minithumbnail.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"thumbnail":`...)
+	b, err = document.Thumbnail.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"document":`...)
+	b, err = document.Document.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (document *Document) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			document.tdCommon.Type, err = r.readString()
+		case "@extra":
+			document.tdCommon.Extra, err = r.readString()
+		case "file_name":
+			document.FileName, err = r.readString()
+		case "mime_type":
+			document.MimeType, err = r.readString()
+		case "minithumbnail":
+			if r.readNull() {
+				document.Minithumbnail = nil
+			} else {
+				document.Minithumbnail = new(Minithumbnail)
+				err = document.Minithumbnail.decodeJSON(r)
+			}
+		case "thumbnail":
+			if r.readNull() {
+				document.Thumbnail = nil
+			} else {
+				document.Thumbnail = new(Thumbnail)
+				err = document.Thumbnail.decodeJSON(r)
+			}
+		case "document":
+			if r.readNull() {
+				document.Document = nil
+			} else {
+				document.Document = new(File)
+				err = document.Document.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (document *Document) Clone() *Document {
+	if document == nil {
+		return nil
+	}
+	clone := *document
+	clone.Minithumbnail = document.Minithumbnail.Clone()
+	clone.Thumbnail = document.Thumbnail.Clone()
+	clone.Document = document.Document.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (document *Document) Equal(other *Document) bool {
+	if document == nil || other == nil {
+		return document == other
+	}
+	if document.FileName != other.FileName {
+		return false
+	}
+	if document.MimeType != other.MimeType {
+		return false
+	}
+	if !document.Minithumbnail.Equal(other.Minithumbnail) {
+		return false
+	}
+	if !document.Thumbnail.Equal(other.Thumbnail) {
+		return false
+	}
+	if !document.Document.Equal(other.Document) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (document *Document) Diff(other *Document) []Change {
+	return document.diff("", other, nil)
+}
+
+func (document *Document) diff(path string, other *Document, changes []Change) []Change {
+	if document == nil || other == nil {
+		if document != other {
+			changes = append(changes, Change{Path: path, Old: document, New: other})
+		}
+		return changes
+	}
+	if document.FileName != other.FileName {
+		changes = append(changes, Change{Path: fieldPath(path, "file_name"), Old: document.FileName, New: other.FileName})
+	}
+	if document.MimeType != other.MimeType {
+		changes = append(changes, Change{Path: fieldPath(path, "mime_type"), Old: document.MimeType, New: other.MimeType})
+	}
+	changes = document.Minithumbnail.diff(fieldPath(path, "minithumbnail"), other.Minithumbnail, changes)
+	changes = document.Thumbnail.diff(fieldPath(path, "thumbnail"), other.Thumbnail, changes)
+	changes = document.Document.diff(fieldPath(path, "document"), other.Document, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (document *Document) String() string {
+	return objectString(document)
+}
+
+// LogValue logs the object as its summary
+func (document *Document) LogValue() slog.Value {
+	return slog.StringValue(objectString(document))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (document *Document) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, document)
+}
+
+func (document *Document) printText(p *printer) {
+	if document == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Document")
+	p.string("file_name", document.FileName)
+	p.string("mime_type", document.MimeType)
+	p.object("minithumbnail", document.Minithumbnail)
+	p.object("thumbnail", document.Thumbnail)
+	p.object("document", document.Document)
+	p.endObject(state)
+}
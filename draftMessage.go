@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // DraftMessage Contains information about a message draft
@@ -33,30 +34,129 @@ func NewDraftMessage(replyToMessageId int64, date int32, inputMessageText InputM
 	return &draftMessageTemp
 }
 
+// MarshalJSON marshals to json
+func (draftMessage *DraftMessage) MarshalJSON() ([]byte, error) {
+	return draftMessage.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (draftMessage *DraftMessage) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, draftMessage)
+}
+
+func (draftMessage *DraftMessage) appendJSON(b []byte) ([]byte, error) {
+	if draftMessage == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, draftMessage.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, draftMessage.tdCommon.Extra)
+	b = append(b, `,"reply_to_message_id":`...)
+	b = appendJSONInt(b, int64(draftMessage.ReplyToMessageId))
+	b = append(b, `,"date":`...)
+	b = appendJSONInt(b, int64(draftMessage.Date))
+	b = append(b, `,"input_message_text":`...)
+	b, err = appendJSONValue(b, draftMessage.InputMessageText)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-		ReplyToMessageId int64 `json:"reply_to_message_id"` // Identifier of the message to reply to; 0 if none
-		Date             int32 `json:"date"`                // Point in time (Unix timestamp) when the draft was created
+	return append(b, '}'), nil
+}
 
-	}{}
-	err = json.Unmarshal(b, &tempObj)
-	if err != nil {
-		return err
+func (draftMessage *DraftMessage) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			draftMessage.tdCommon.Type, err = r.readString()
+		case "@extra":
+			draftMessage.tdCommon.Extra, err = r.readString()
+		case "reply_to_message_id":
+			draftMessage.ReplyToMessageId, err = r.readInt64()
+		case "date":
+			draftMessage.Date, err = r.readInt32()
+		case "input_message_text":
+			draftMessage.InputMessageText, err = decodeInputMessageContent(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (draftMessage *DraftMessage) Clone() *DraftMessage {
+	if draftMessage == nil {
+		return nil
+	}
+	clone := *draftMessage
+	clone.InputMessageText = cloneInputMessageContent(draftMessage.InputMessageText)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (draftMessage *DraftMessage) Equal(other *DraftMessage) bool {
+	if draftMessage == nil || other == nil {
+		return draftMessage == other
+	}
+	if draftMessage.ReplyToMessageId != other.ReplyToMessageId {
+		return false
+	}
+	if draftMessage.Date != other.Date {
+		return false
 	}
+	if !equalInputMessageContent(draftMessage.InputMessageText, other.InputMessageText) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (draftMessage *DraftMessage) Diff(other *DraftMessage) []Change {
+	return draftMessage.diff("", other, nil)
+}
+
+func (draftMessage *DraftMessage) diff(path string, other *DraftMessage, changes []Change) []Change {
+	if draftMessage == nil || other == nil {
+		if draftMessage != other {
+			changes = append(changes, Change{Path: path, Old: draftMessage, New: other})
+		}
+		return changes
+	}
+	if draftMessage.ReplyToMessageId != other.ReplyToMessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "reply_to_message_id"), Old: draftMessage.ReplyToMessageId, New: other.ReplyToMessageId})
+	}
+	if draftMessage.Date != other.Date {
+		changes = append(changes, Change{Path: fieldPath(path, "date"), Old: draftMessage.Date, New: other.Date})
+	}
+	changes = diffInputMessageContent(fieldPath(path, "input_message_text"), draftMessage.InputMessageText, other.InputMessageText, changes)
+	return changes
+}
 
-	draftMessage.tdCommon = tempObj.tdCommon
-	draftMessage.ReplyToMessageId = tempObj.ReplyToMessageId
-	draftMessage.Date = tempObj.Date
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (draftMessage *DraftMessage) String() string {
+	return objectString(draftMessage)
+}
 
-	fieldInputMessageText, _ := unmarshalInputMessageContent(objMap["input_message_text"])
-	draftMessage.InputMessageText = fieldInputMessageText
+// LogValue logs the object as its summary
+func (draftMessage *DraftMessage) LogValue() slog.Value {
+	return slog.StringValue(objectString(draftMessage))
+}
 
-	return nil
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (draftMessage *DraftMessage) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, draftMessage)
+}
+
+func (draftMessage *DraftMessage) printText(p *printer) {
+	if draftMessage == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("DraftMessage")
+	p.int("reply_to_message_id", int64(draftMessage.ReplyToMessageId))
+	p.int("date", int64(draftMessage.Date))
+	p.object("input_message_text", draftMessage.InputMessageText)
+	p.endObject(state)
 }
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // EmailAddressAuthenticationCodeInfo Information about the email address authentication code that was sent
@@ -31,6 +31,119 @@ func NewEmailAddressAuthenticationCodeInfo(emailAddressPattern string, length in
 	return &emailAddressAuthenticationCodeInfoTemp
 }
 
+// MarshalJSON marshals to json
+func (emailAddressAuthenticationCodeInfo *EmailAddressAuthenticationCodeInfo) MarshalJSON() ([]byte, error) {
+	return emailAddressAuthenticationCodeInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (emailAddressAuthenticationCodeInfo *EmailAddressAuthenticationCodeInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, emailAddressAuthenticationCodeInfo)
+}
+
+func (emailAddressAuthenticationCodeInfo *EmailAddressAuthenticationCodeInfo) appendJSON(b []byte) ([]byte, error) {
+	if emailAddressAuthenticationCodeInfo == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, emailAddressAuthenticationCodeInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, emailAddressAuthenticationCodeInfo.tdCommon.Extra)
+	b = append(b, `,"email_address_pattern":`...)
+	b = appendJSONString(b, emailAddressAuthenticationCodeInfo.EmailAddressPattern)
+	b = append(b, `,"length":`...)
+	b = appendJSONInt(b, int64(emailAddressAuthenticationCodeInfo.Length))
+	return append(b, '}'), nil
+}
+
+func (emailAddressAuthenticationCodeInfo *EmailAddressAuthenticationCodeInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			emailAddressAuthenticationCodeInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			emailAddressAuthenticationCodeInfo.tdCommon.Extra, err = r.readString()
+		case "email_address_pattern":
+			emailAddressAuthenticationCodeInfo.EmailAddressPattern, err = r.readString()
+		case "length":
+			emailAddressAuthenticationCodeInfo.Length, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (emailAddressAuthenticationCodeInfo *EmailAddressAuthenticationCodeInfo) Clone() *EmailAddressAuthenticationCodeInfo {
+	if emailAddressAuthenticationCodeInfo == nil {
+		return nil
+	}
+	clone := *emailAddressAuthenticationCodeInfo
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (emailAddressAuthenticationCodeInfo *EmailAddressAuthenticationCodeInfo) Equal(other *EmailAddressAuthenticationCodeInfo) bool {
+	if emailAddressAuthenticationCodeInfo == nil || other == nil {
+		return emailAddressAuthenticationCodeInfo == other
+	}
+	if emailAddressAuthenticationCodeInfo.EmailAddressPattern != other.EmailAddressPattern {
+		return false
+	}
+	if emailAddressAuthenticationCodeInfo.Length != other.Length {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (emailAddressAuthenticationCodeInfo *EmailAddressAuthenticationCodeInfo) Diff(other *EmailAddressAuthenticationCodeInfo) []Change {
+	return emailAddressAuthenticationCodeInfo.diff("", other, nil)
+}
+
+func (emailAddressAuthenticationCodeInfo *EmailAddressAuthenticationCodeInfo) diff(path string, other *EmailAddressAuthenticationCodeInfo, changes []Change) []Change {
+	if emailAddressAuthenticationCodeInfo == nil || other == nil {
+		if emailAddressAuthenticationCodeInfo != other {
+			changes = append(changes, Change{Path: path, Old: emailAddressAuthenticationCodeInfo, New: other})
+		}
+		return changes
+	}
+	if emailAddressAuthenticationCodeInfo.EmailAddressPattern != other.EmailAddressPattern {
+		changes = append(changes, Change{Path: fieldPath(path, "email_address_pattern"), Old: emailAddressAuthenticationCodeInfo.EmailAddressPattern, New: other.EmailAddressPattern})
+	}
+	if emailAddressAuthenticationCodeInfo.Length != other.Length {
+		changes = append(changes, Change{Path: fieldPath(path, "length"), Old: emailAddressAuthenticationCodeInfo.Length, New: other.Length})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (emailAddressAuthenticationCodeInfo *EmailAddressAuthenticationCodeInfo) String() string {
+	return objectString(emailAddressAuthenticationCodeInfo)
+}
+
+// LogValue logs the object as its summary
+func (emailAddressAuthenticationCodeInfo *EmailAddressAuthenticationCodeInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(emailAddressAuthenticationCodeInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (emailAddressAuthenticationCodeInfo *EmailAddressAuthenticationCodeInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, emailAddressAuthenticationCodeInfo)
+}
+
+func (emailAddressAuthenticationCodeInfo *EmailAddressAuthenticationCodeInfo) printText(p *printer) {
+	if emailAddressAuthenticationCodeInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("EmailAddressAuthenticationCodeInfo")
+	p.string("email_address_pattern", emailAddressAuthenticationCodeInfo.EmailAddressPattern)
+	p.int("length", int64(emailAddressAuthenticationCodeInfo.Length))
+	p.endObject(state)
+}
+
 // RequestPasswordRecovery Requests to send a 2-step verification password recovery code to an email address that was previously set up
 func (client *Client) RequestPasswordRecovery() (*EmailAddressAuthenticationCodeInfo, error) {
 	result, err := client.SendAndCatch(UpdateData{
@@ -41,12 +154,12 @@ func (client *Client) RequestPasswordRecovery() (*EmailAddressAuthenticationCode
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var emailAddressAuthenticationCodeInfo EmailAddressAuthenticationCodeInfo
-	err = json.Unmarshal(result.Raw, &emailAddressAuthenticationCodeInfo)
+	err = jsonUnmarshal(result.Raw, &emailAddressAuthenticationCodeInfo)
 	return &emailAddressAuthenticationCodeInfo, err
 }
 
@@ -62,12 +175,12 @@ func (client *Client) SendEmailAddressVerificationCode(emailAddress string) (*Em
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var emailAddressAuthenticationCodeInfo EmailAddressAuthenticationCodeInfo
-	err = json.Unmarshal(result.Raw, &emailAddressAuthenticationCodeInfo)
+	err = jsonUnmarshal(result.Raw, &emailAddressAuthenticationCodeInfo)
 	return &emailAddressAuthenticationCodeInfo, err
 }
 
@@ -81,11 +194,11 @@ func (client *Client) ResendEmailAddressVerificationCode() (*EmailAddressAuthent
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var emailAddressAuthenticationCodeInfo EmailAddressAuthenticationCodeInfo
-	err = json.Unmarshal(result.Raw, &emailAddressAuthenticationCodeInfo)
+	err = jsonUnmarshal(result.Raw, &emailAddressAuthenticationCodeInfo)
 	return &emailAddressAuthenticationCodeInfo, err
 }
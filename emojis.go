@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // Emojis Represents a list of emoji
@@ -28,6 +28,146 @@ func NewEmojis(emojis []string) *Emojis {
 	return &emojisTemp
 }
 
+// MarshalJSON marshals to json
+func (emojis *Emojis) MarshalJSON() ([]byte, error) {
+	return emojis.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (emojis *Emojis) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, emojis)
+}
+
+func (emojis *Emojis) appendJSON(b []byte) ([]byte, error) {
+	if emojis == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, emojis.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, emojis.tdCommon.Extra)
+	b = append(b, `,"emojis":`...)
+	if emojis.Emojis == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range emojis.Emojis {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONString(b, emojis.Emojis[i0])
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (emojis *Emojis) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			emojis.tdCommon.Type, err = r.readString()
+		case "@extra":
+			emojis.tdCommon.Extra, err = r.readString()
+		case "emojis":
+			if r.readNull() {
+				emojis.Emojis = nil
+			} else {
+				emojis.Emojis = make([]string, 0)
+				err = r.readArray(func() (err error) {
+					var item0 string
+					item0, err = r.readString()
+					emojis.Emojis = append(emojis.Emojis, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (emojis *Emojis) Clone() *Emojis {
+	if emojis == nil {
+		return nil
+	}
+	clone := *emojis
+	if emojis.Emojis != nil {
+		clone.Emojis = make([]string, len(emojis.Emojis))
+		copy(clone.Emojis, emojis.Emojis)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (emojis *Emojis) Equal(other *Emojis) bool {
+	if emojis == nil || other == nil {
+		return emojis == other
+	}
+	if len(emojis.Emojis) != len(other.Emojis) {
+		return false
+	}
+	for i0 := range emojis.Emojis {
+		if emojis.Emojis[i0] != other.Emojis[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (emojis *Emojis) Diff(other *Emojis) []Change {
+	return emojis.diff("", other, nil)
+}
+
+func (emojis *Emojis) diff(path string, other *Emojis, changes []Change) []Change {
+	if emojis == nil || other == nil {
+		if emojis != other {
+			changes = append(changes, Change{Path: path, Old: emojis, New: other})
+		}
+		return changes
+	}
+	if len(emojis.Emojis) != len(other.Emojis) {
+		changes = append(changes, Change{Path: fieldPath(path, "emojis"), Old: emojis.Emojis, New: other.Emojis})
+	} else {
+		for i0 := range emojis.Emojis {
+			if emojis.Emojis[i0] != other.Emojis[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "emojis"), i0), Old: emojis.Emojis[i0], New: other.Emojis[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (emojis *Emojis) String() string {
+	return objectString(emojis)
+}
+
+// LogValue logs the object as its summary
+func (emojis *Emojis) LogValue() slog.Value {
+	return slog.StringValue(objectString(emojis))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (emojis *Emojis) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, emojis)
+}
+
+func (emojis *Emojis) printText(p *printer) {
+	if emojis == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Emojis")
+	p.vector("emojis", len(emojis.Emojis), func(i0 int) {
+		p.string("emojis", emojis.Emojis[i0])
+	})
+	p.endObject(state)
+}
+
 // GetStickerEmojis Returns emoji corresponding to a sticker. The list is only for informational purposes, because a sticker is always sent with a fixed emoji from the corresponding Sticker object
 // @param sticker Sticker file identifier
 func (client *Client) GetStickerEmojis(sticker InputFile) (*Emojis, error) {
@@ -40,12 +180,12 @@ func (client *Client) GetStickerEmojis(sticker InputFile) (*Emojis, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var emojis Emojis
-	err = json.Unmarshal(result.Raw, &emojis)
+	err = jsonUnmarshal(result.Raw, &emojis)
 	return &emojis, err
 }
 
@@ -65,11 +205,11 @@ func (client *Client) SearchEmojis(text string, exactMatch bool, inputLanguageCo
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var emojis Emojis
-	err = json.Unmarshal(result.Raw, &emojis)
+	err = jsonUnmarshal(result.Raw, &emojis)
 	return &emojis, err
 }
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // EncryptedCredentials Contains encrypted Telegram Passport data credentials
 type EncryptedCredentials struct {
 	tdCommon
@@ -28,3 +33,136 @@ func NewEncryptedCredentials(data []byte, hash []byte, secret []byte) *Encrypted
 
 	return &encryptedCredentialsTemp
 }
+
+// MarshalJSON marshals to json
+func (encryptedCredentials *EncryptedCredentials) MarshalJSON() ([]byte, error) {
+	return encryptedCredentials.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (encryptedCredentials *EncryptedCredentials) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, encryptedCredentials)
+}
+
+func (encryptedCredentials *EncryptedCredentials) appendJSON(b []byte) ([]byte, error) {
+	if encryptedCredentials == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, encryptedCredentials.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, encryptedCredentials.tdCommon.Extra)
+	b = append(b, `,"data":`...)
+	b = appendJSONBytes(b, encryptedCredentials.Data)
+	b = append(b, `,"hash":`...)
+	b = appendJSONBytes(b, encryptedCredentials.Hash)
+	b = append(b, `,"secret":`...)
+	b = appendJSONBytes(b, encryptedCredentials.Secret)
+	return append(b, '}'), nil
+}
+
+func (encryptedCredentials *EncryptedCredentials) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			encryptedCredentials.tdCommon.Type, err = r.readString()
+		case "@extra":
+			encryptedCredentials.tdCommon.Extra, err = r.readString()
+		case "data":
+			encryptedCredentials.Data, err = r.readBytes()
+		case "hash":
+			encryptedCredentials.Hash, err = r.readBytes()
+		case "secret":
+			encryptedCredentials.Secret, err = r.readBytes()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (encryptedCredentials *EncryptedCredentials) Clone() *EncryptedCredentials {
+	if encryptedCredentials == nil {
+		return nil
+	}
+	clone := *encryptedCredentials
+	if encryptedCredentials.Data != nil {
+		clone.Data = append([]byte{}, encryptedCredentials.Data...)
+	}
+	if encryptedCredentials.Hash != nil {
+		clone.Hash = append([]byte{}, encryptedCredentials.Hash...)
+	}
+	if encryptedCredentials.Secret != nil {
+		clone.Secret = append([]byte{}, encryptedCredentials.Secret...)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (encryptedCredentials *EncryptedCredentials) Equal(other *EncryptedCredentials) bool {
+	if encryptedCredentials == nil || other == nil {
+		return encryptedCredentials == other
+	}
+	if !equalBytes(encryptedCredentials.Data, other.Data) {
+		return false
+	}
+	if !equalBytes(encryptedCredentials.Hash, other.Hash) {
+		return false
+	}
+	if !equalBytes(encryptedCredentials.Secret, other.Secret) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (encryptedCredentials *EncryptedCredentials) Diff(other *EncryptedCredentials) []Change {
+	return encryptedCredentials.diff("", other, nil)
+}
+
+func (encryptedCredentials *EncryptedCredentials) diff(path string, other *EncryptedCredentials, changes []Change) []Change {
+	if encryptedCredentials == nil || other == nil {
+		if encryptedCredentials != other {
+			changes = append(changes, Change{Path: path, Old: encryptedCredentials, New: other})
+		}
+		return changes
+	}
+	if !equalBytes(encryptedCredentials.Data, other.Data) {
+		changes = append(changes, Change{Path: fieldPath(path, "data"), Old: encryptedCredentials.Data, New: other.Data})
+	}
+	if !equalBytes(encryptedCredentials.Hash, other.Hash) {
+		changes = append(changes, Change{Path: fieldPath(path, "hash"), Old: encryptedCredentials.Hash, New: other.Hash})
+	}
+	if !equalBytes(encryptedCredentials.Secret, other.Secret) {
+		changes = append(changes, Change{Path: fieldPath(path, "secret"), Old: encryptedCredentials.Secret, New: other.Secret})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (encryptedCredentials *EncryptedCredentials) String() string {
+	return objectString(encryptedCredentials)
+}
+
+// LogValue logs the object as its summary
+func (encryptedCredentials *EncryptedCredentials) LogValue() slog.Value {
+	return slog.StringValue(objectString(encryptedCredentials))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (encryptedCredentials *EncryptedCredentials) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, encryptedCredentials)
+}
+
+func (encryptedCredentials *EncryptedCredentials) printText(p *printer) {
+	if encryptedCredentials == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("EncryptedCredentials")
+	p.bytes("data", encryptedCredentials.Data)
+	p.bytes("hash", encryptedCredentials.Hash)
+	p.bytes("secret", encryptedCredentials.Secret)
+	p.endObject(state)
+}
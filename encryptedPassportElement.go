@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // EncryptedPassportElement Contains information about an encrypted Telegram Passport element; for bots only
@@ -51,41 +52,301 @@ func NewEncryptedPassportElement(typeParam PassportElementType, data []byte, fro
 	return &encryptedPassportElementTemp
 }
 
+// MarshalJSON marshals to json
+func (encryptedPassportElement *EncryptedPassportElement) MarshalJSON() ([]byte, error) {
+	return encryptedPassportElement.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (encryptedPassportElement *EncryptedPassportElement) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, encryptedPassportElement)
+}
+
+func (encryptedPassportElement *EncryptedPassportElement) appendJSON(b []byte) ([]byte, error) {
+	if encryptedPassportElement == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, encryptedPassportElement.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, encryptedPassportElement.tdCommon.Extra)
+	b = append(b, `,"type":`...)
+	b, err = appendJSONValue(b, encryptedPassportElement.Type)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Data        []byte      `json:"data"`         // Encrypted JSON-encoded data about the user
-		FrontSide   *DatedFile  `json:"front_side"`   // The front side of an identity document
-		ReverseSide *DatedFile  `json:"reverse_side"` // The reverse side of an identity document; may be null
-		Selfie      *DatedFile  `json:"selfie"`       // Selfie with the document; may be null
-		Translation []DatedFile `json:"translation"`  // List of files containing a certified English translation of the document
-		Files       []DatedFile `json:"files"`        // List of attached files
-		Value       string      `json:"value"`        // Unencrypted data, phone number or email address
-		Hash        string      `json:"hash"`         // Hash of the entire element
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"data":`...)
+	b = appendJSONBytes(b, encryptedPassportElement.Data)
+	b = append(b, `,"front_side":`...)
+	b, err = encryptedPassportElement.FrontSide.appendJSON(b)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"reverse_side":`...)
+	b, err = encryptedPassportElement.ReverseSide.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"selfie":`...)
+	b, err = encryptedPassportElement.Selfie.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"translation":`...)
+	if encryptedPassportElement.Translation == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range encryptedPassportElement.Translation {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = encryptedPassportElement.Translation[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"files":`...)
+	if encryptedPassportElement.Files == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range encryptedPassportElement.Files {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = encryptedPassportElement.Files[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"value":`...)
+	b = appendJSONString(b, encryptedPassportElement.Value)
+	b = append(b, `,"hash":`...)
+	b = appendJSONString(b, encryptedPassportElement.Hash)
+	return append(b, '}'), nil
+}
+
+func (encryptedPassportElement *EncryptedPassportElement) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			encryptedPassportElement.tdCommon.Type, err = r.readString()
+		case "@extra":
+			encryptedPassportElement.tdCommon.Extra, err = r.readString()
+		case "type":
+			encryptedPassportElement.Type, err = decodePassportElementType(r)
+		case "data":
+			encryptedPassportElement.Data, err = r.readBytes()
+		case "front_side":
+			if r.readNull() {
+				encryptedPassportElement.FrontSide = nil
+			} else {
+				encryptedPassportElement.FrontSide = new(DatedFile)
+				err = encryptedPassportElement.FrontSide.decodeJSON(r)
+			}
+		case "reverse_side":
+			if r.readNull() {
+				encryptedPassportElement.ReverseSide = nil
+			} else {
+				encryptedPassportElement.ReverseSide = new(DatedFile)
+				err = encryptedPassportElement.ReverseSide.decodeJSON(r)
+			}
+		case "selfie":
+			if r.readNull() {
+				encryptedPassportElement.Selfie = nil
+			} else {
+				encryptedPassportElement.Selfie = new(DatedFile)
+				err = encryptedPassportElement.Selfie.decodeJSON(r)
+			}
+		case "translation":
+			if r.readNull() {
+				encryptedPassportElement.Translation = nil
+			} else {
+				encryptedPassportElement.Translation = make([]DatedFile, 0)
+				err = r.readArray(func() (err error) {
+					var item0 DatedFile
+					err = item0.decodeJSON(r)
+					encryptedPassportElement.Translation = append(encryptedPassportElement.Translation, item0)
+					return
+				})
+			}
+		case "files":
+			if r.readNull() {
+				encryptedPassportElement.Files = nil
+			} else {
+				encryptedPassportElement.Files = make([]DatedFile, 0)
+				err = r.readArray(func() (err error) {
+					var item0 DatedFile
+					err = item0.decodeJSON(r)
+					encryptedPassportElement.Files = append(encryptedPassportElement.Files, item0)
+					return
+				})
+			}
+		case "value":
+			encryptedPassportElement.Value, err = r.readString()
+		case "hash":
+			encryptedPassportElement.Hash, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (encryptedPassportElement *EncryptedPassportElement) Clone() *EncryptedPassportElement {
+	if encryptedPassportElement == nil {
+		return nil
+	}
+	clone := *encryptedPassportElement
+	clone.Type = clonePassportElementType(encryptedPassportElement.Type)
+	if encryptedPassportElement.Data != nil {
+		clone.Data = append([]byte{}, encryptedPassportElement.Data...)
+	}
+	clone.FrontSide = encryptedPassportElement.FrontSide.Clone()
+	clone.ReverseSide = encryptedPassportElement.ReverseSide.Clone()
+	clone.Selfie = encryptedPassportElement.Selfie.Clone()
+	if encryptedPassportElement.Translation != nil {
+		clone.Translation = make([]DatedFile, len(encryptedPassportElement.Translation))
+		for i0 := range encryptedPassportElement.Translation {
+			clone.Translation[i0] = *encryptedPassportElement.Translation[i0].Clone()
+		}
+	}
+	if encryptedPassportElement.Files != nil {
+		clone.Files = make([]DatedFile, len(encryptedPassportElement.Files))
+		for i0 := range encryptedPassportElement.Files {
+			clone.Files[i0] = *encryptedPassportElement.Files[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (encryptedPassportElement *EncryptedPassportElement) Equal(other *EncryptedPassportElement) bool {
+	if encryptedPassportElement == nil || other == nil {
+		return encryptedPassportElement == other
+	}
+	if !equalPassportElementType(encryptedPassportElement.Type, other.Type) {
+		return false
+	}
+	if !equalBytes(encryptedPassportElement.Data, other.Data) {
+		return false
 	}
+	if !encryptedPassportElement.FrontSide.Equal(other.FrontSide) {
+		return false
+	}
+	if !encryptedPassportElement.ReverseSide.Equal(other.ReverseSide) {
+		return false
+	}
+	if !encryptedPassportElement.Selfie.Equal(other.Selfie) {
+		return false
+	}
+	if len(encryptedPassportElement.Translation) != len(other.Translation) {
+		return false
+	}
+	for i0 := range encryptedPassportElement.Translation {
+		if !encryptedPassportElement.Translation[i0].Equal(&other.Translation[i0]) {
+			return false
+		}
+	}
+	if len(encryptedPassportElement.Files) != len(other.Files) {
+		return false
+	}
+	for i0 := range encryptedPassportElement.Files {
+		if !encryptedPassportElement.Files[i0].Equal(&other.Files[i0]) {
+			return false
+		}
+	}
+	if encryptedPassportElement.Value != other.Value {
+		return false
+	}
+	if encryptedPassportElement.Hash != other.Hash {
+		return false
+	}
+	return true
+}
 
-	encryptedPassportElement.tdCommon = tempObj.tdCommon
-	encryptedPassportElement.Data = tempObj.Data
-	encryptedPassportElement.FrontSide = tempObj.FrontSide
-	encryptedPassportElement.ReverseSide = tempObj.ReverseSide
-	encryptedPassportElement.Selfie = tempObj.Selfie
-	encryptedPassportElement.Translation = tempObj.Translation
-	encryptedPassportElement.Files = tempObj.Files
-	encryptedPassportElement.Value = tempObj.Value
-	encryptedPassportElement.Hash = tempObj.Hash
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (encryptedPassportElement *EncryptedPassportElement) Diff(other *EncryptedPassportElement) []Change {
+	return encryptedPassportElement.diff("", other, nil)
+}
 
-	fieldType, _ := unmarshalPassportElementType(objMap["type"])
-	encryptedPassportElement.Type = fieldType
+func (encryptedPassportElement *EncryptedPassportElement) diff(path string, other *EncryptedPassportElement, changes []Change) []Change {
+	if encryptedPassportElement == nil || other == nil {
+		if encryptedPassportElement != other {
+			changes = append(changes, Change{Path: path, Old: encryptedPassportElement, New: other})
+		}
+		return changes
+	}
+	changes = diffPassportElementType(fieldPath(path, "type"), encryptedPassportElement.Type, other.Type, changes)
+	if !equalBytes(encryptedPassportElement.Data, other.Data) {
+		changes = append(changes, Change{Path: fieldPath(path, "data"), Old: encryptedPassportElement.Data, New: other.Data})
+	}
+	changes = encryptedPassportElement.FrontSide.diff(fieldPath(path, "front_side"), other.FrontSide, changes)
+	changes = encryptedPassportElement.ReverseSide.diff(fieldPath(path, "reverse_side"), other.ReverseSide, changes)
+	changes = encryptedPassportElement.Selfie.diff(fieldPath(path, "selfie"), other.Selfie, changes)
+	if len(encryptedPassportElement.Translation) != len(other.Translation) {
+		changes = append(changes, Change{Path: fieldPath(path, "translation"), Old: encryptedPassportElement.Translation, New: other.Translation})
+	} else {
+		for i0 := range encryptedPassportElement.Translation {
+			changes = encryptedPassportElement.Translation[i0].diff(indexPath(fieldPath(path, "translation"), i0), &other.Translation[i0], changes)
+		}
+	}
+	if len(encryptedPassportElement.Files) != len(other.Files) {
+		changes = append(changes, Change{Path: fieldPath(path, "files"), Old: encryptedPassportElement.Files, New: other.Files})
+	} else {
+		for i0 := range encryptedPassportElement.Files {
+			changes = encryptedPassportElement.Files[i0].diff(indexPath(fieldPath(path, "files"), i0), &other.Files[i0], changes)
+		}
+	}
+	if encryptedPassportElement.Value != other.Value {
+		changes = append(changes, Change{Path: fieldPath(path, "value"), Old: encryptedPassportElement.Value, New: other.Value})
+	}
+	if encryptedPassportElement.Hash != other.Hash {
+		changes = append(changes, Change{Path: fieldPath(path, "hash"), Old: encryptedPassportElement.Hash, New: other.Hash})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (encryptedPassportElement *EncryptedPassportElement) String() string {
+	return objectString(encryptedPassportElement)
+}
+
+// LogValue logs the object as its summary
+func (encryptedPassportElement *EncryptedPassportElement) LogValue() slog.Value {
+	return slog.StringValue(objectString(encryptedPassportElement))
+}
 
-	return nil
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (encryptedPassportElement *EncryptedPassportElement) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, encryptedPassportElement)
+}
+
+func (encryptedPassportElement *EncryptedPassportElement) printText(p *printer) {
+	if encryptedPassportElement == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("EncryptedPassportElement")
+	p.object("type", encryptedPassportElement.Type)
+	p.bytes("data", encryptedPassportElement.Data)
+	p.object("front_side", encryptedPassportElement.FrontSide)
+	p.object("reverse_side", encryptedPassportElement.ReverseSide)
+	p.object("selfie", encryptedPassportElement.Selfie)
+	p.vector("translation", len(encryptedPassportElement.Translation), func(i0 int) {
+		encryptedPassportElement.Translation[i0].printText(p)
+	})
+	p.vector("files", len(encryptedPassportElement.Files), func(i0 int) {
+		encryptedPassportElement.Files[i0].printText(p)
+	})
+	p.string("value", encryptedPassportElement.Value)
+	p.string("hash", encryptedPassportElement.Hash)
+	p.endObject(state)
 }
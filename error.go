@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // Error An object of this type can be returned on every function call, in case of an error
@@ -31,6 +31,119 @@ func NewError(code int32, message string) *Error {
 	return &errorTemp
 }
 
+// MarshalJSON marshals to json
+func (errorObj *Error) MarshalJSON() ([]byte, error) {
+	return errorObj.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (errorObj *Error) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, errorObj)
+}
+
+func (errorObj *Error) appendJSON(b []byte) ([]byte, error) {
+	if errorObj == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, errorObj.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, errorObj.tdCommon.Extra)
+	b = append(b, `,"code":`...)
+	b = appendJSONInt(b, int64(errorObj.Code))
+	b = append(b, `,"message":`...)
+	b = appendJSONString(b, errorObj.Message)
+	return append(b, '}'), nil
+}
+
+func (errorObj *Error) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			errorObj.tdCommon.Type, err = r.readString()
+		case "@extra":
+			errorObj.tdCommon.Extra, err = r.readString()
+		case "code":
+			errorObj.Code, err = r.readInt32()
+		case "message":
+			errorObj.Message, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (errorObj *Error) Clone() *Error {
+	if errorObj == nil {
+		return nil
+	}
+	clone := *errorObj
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (errorObj *Error) Equal(other *Error) bool {
+	if errorObj == nil || other == nil {
+		return errorObj == other
+	}
+	if errorObj.Code != other.Code {
+		return false
+	}
+	if errorObj.Message != other.Message {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (errorObj *Error) Diff(other *Error) []Change {
+	return errorObj.diff("", other, nil)
+}
+
+func (errorObj *Error) diff(path string, other *Error, changes []Change) []Change {
+	if errorObj == nil || other == nil {
+		if errorObj != other {
+			changes = append(changes, Change{Path: path, Old: errorObj, New: other})
+		}
+		return changes
+	}
+	if errorObj.Code != other.Code {
+		changes = append(changes, Change{Path: fieldPath(path, "code"), Old: errorObj.Code, New: other.Code})
+	}
+	if errorObj.Message != other.Message {
+		changes = append(changes, Change{Path: fieldPath(path, "message"), Old: errorObj.Message, New: other.Message})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (errorObj *Error) String() string {
+	return objectString(errorObj)
+}
+
+// LogValue logs the object as its summary
+func (errorObj *Error) LogValue() slog.Value {
+	return slog.StringValue(objectString(errorObj))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (errorObj *Error) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, errorObj)
+}
+
+func (errorObj *Error) printText(p *printer) {
+	if errorObj == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Error")
+	p.int("code", int64(errorObj.Code))
+	p.string("message", errorObj.Message)
+	p.endObject(state)
+}
+
 // TestReturnError Returns the specified error and ensures that the Error object is used; for testing only. Can be called synchronously
 // @param error The error to be returned
 func (client *Client) TestReturnError(error *Error) (*Error, error) {
@@ -43,11 +156,32 @@ func (client *Client) TestReturnError(error *Error) (*Error, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
+		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
+	}
+
+	var errorDummy Error
+	err = jsonUnmarshal(result.Raw, &errorDummy)
+	return &errorDummy, err
+}
+
+// ExecuteTestReturnError Synchronously executes TestReturnError, without a client. Returns the specified error and ensures that the Error object is used; for testing only. Can be called synchronously
+// @param error The error to be returned
+func ExecuteTestReturnError(error *Error) (*Error, error) {
+	result, err := execute(UpdateData{
+		"@type": "testReturnError",
+		"error": error,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var errorDummy Error
-	err = json.Unmarshal(result.Raw, &errorDummy)
+	err = jsonUnmarshal(result.Raw, &errorDummy)
 	return &errorDummy, err
 }
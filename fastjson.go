@@ -0,0 +1,773 @@
+package tdlib
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// The generated types encode and decode themselves with the helpers of this file,
+// without the reflection encoding/json relies on.
+
+// fastMarshaler is implemented by the generated types
+type fastMarshaler interface {
+	appendJSON(b []byte) ([]byte, error)
+}
+
+// fastUnmarshaler is implemented by pointers to the generated types
+type fastUnmarshaler interface {
+	decodeJSON(r *jsonReader) error
+}
+
+// unmarshalFast decodes a JSON document into a generated type
+func unmarshalFast(data []byte, v fastUnmarshaler) error {
+	r := newJSONReader(data)
+	if err := v.decodeJSON(r); err != nil {
+		return err
+	}
+	return r.end()
+}
+
+const hexDigits = "0123456789abcdef"
+
+// appendJSONString appends a JSON string, escaped like encoding/json does
+func appendJSONString(b []byte, s string) []byte {
+	b = append(b, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if c >= 0x20 && c != '"' && c != '\\' && c != '<' && c != '>' && c != '&' {
+				i++
+				continue
+			}
+			b = append(b, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				b = append(b, '\\', c)
+			case '\n':
+				b = append(b, '\\', 'n')
+			case '\r':
+				b = append(b, '\\', 'r')
+			case '\t':
+				b = append(b, '\\', 't')
+			case '\b':
+				b = append(b, '\\', 'b')
+			case '\f':
+				b = append(b, '\\', 'f')
+			default:
+				b = append(b, '\\', 'u', '0', '0', hexDigits[c>>4], hexDigits[c&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			b = append(b, s[start:i]...)
+			b = append(b, "\ufffd"...)
+			i += size
+			start = i
+			continue
+		}
+		if r == '\u2028' || r == '\u2029' {
+			b = append(b, s[start:i]...)
+			b = append(b, '\\', 'u', '2', '0', '2', hexDigits[r&0xF])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	b = append(b, s[start:]...)
+	return append(b, '"')
+}
+
+// appendJSONInt appends a JSON number of an integer
+func appendJSONInt(b []byte, i int64) []byte {
+	return strconv.AppendInt(b, i, 10)
+}
+
+// appendJSONInt64String appends a 64-bit integer as a JSON string, like TDLib sends them
+func appendJSONInt64String(b []byte, i int64) []byte {
+	b = append(b, '"')
+	b = strconv.AppendInt(b, i, 10)
+	return append(b, '"')
+}
+
+// appendJSONFloat appends a JSON number of a float, formatted like encoding/json does
+func appendJSONFloat(b []byte, f float64) ([]byte, error) {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return nil, &json.UnsupportedValueError{Str: strconv.FormatFloat(f, 'g', -1, 64)}
+	}
+
+	format := byte('f')
+	if abs := math.Abs(f); abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+	b = strconv.AppendFloat(b, f, format, -1, 64)
+	if format == 'e' {
+		// clean up e-09 to e-9
+		n := len(b)
+		if n >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+			b[n-2] = b[n-1]
+			b = b[:n-1]
+		}
+	}
+	return b, nil
+}
+
+// appendJSONBool appends a JSON boolean
+func appendJSONBool(b []byte, v bool) []byte {
+	if v {
+		return append(b, "true"...)
+	}
+	return append(b, "false"...)
+}
+
+// appendJSONBytes appends bytes as a base64 JSON string
+func appendJSONBytes(b []byte, v []byte) []byte {
+	if v == nil {
+		return append(b, "null"...)
+	}
+	b = append(b, '"')
+	n := len(b)
+	b = append(b, make([]byte, base64.StdEncoding.EncodedLen(len(v)))...)
+	base64.StdEncoding.Encode(b[n:], v)
+	return append(b, '"')
+}
+
+// appendJSONValue appends any value, using the generated encoders when possible
+func appendJSONValue(b []byte, v interface{}) ([]byte, error) {
+	switch v := v.(type) {
+	case nil:
+		return append(b, "null"...), nil
+	case JSONInt64:
+		return appendJSONInt64String(b, int64(v)), nil
+	case json.Number:
+		if !validJSONNumber([]byte(v)) {
+			return nil, fmt.Errorf("json: invalid number literal %q", string(v))
+		}
+		return append(b, v...), nil
+	case fastMarshaler:
+		return v.appendJSON(b)
+	case json.Marshaler:
+		jsonBytes, err := v.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		return append(b, jsonBytes...), nil
+	case string:
+		return appendJSONString(b, v), nil
+	case bool:
+		return appendJSONBool(b, v), nil
+	case int:
+		return appendJSONInt(b, int64(v)), nil
+	case int32:
+		return appendJSONInt(b, int64(v)), nil
+	case int64:
+		return appendJSONInt(b, v), nil
+	case float64:
+		return appendJSONFloat(b, v)
+	case []byte:
+		return appendJSONBytes(b, v), nil
+	case UpdateData:
+		return appendJSONMap(b, v)
+	case map[string]interface{}:
+		return appendJSONMap(b, v)
+	case []interface{}:
+		if v == nil {
+			return append(b, "null"...), nil
+		}
+		b = append(b, '[')
+		for i, item := range v {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			var err error
+			if b, err = appendJSONValue(b, item); err != nil {
+				return nil, err
+			}
+		}
+		return append(b, ']'), nil
+	}
+
+	jsonBytes, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, jsonBytes...), nil
+}
+
+// appendJSONMap appends a JSON object with sorted keys, like encoding/json does
+func appendJSONMap(b []byte, m map[string]interface{}) ([]byte, error) {
+	if m == nil {
+		return append(b, "null"...), nil
+	}
+
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	b = append(b, '{')
+	for i, key := range keys {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = appendJSONString(b, key)
+		b = append(b, ':')
+
+		var err error
+		if b, err = appendJSONValue(b, m[key]); err != nil {
+			return nil, err
+		}
+	}
+	return append(b, '}'), nil
+}
+
+// jsonReader reads the values of a JSON document one after another
+type jsonReader struct {
+	data []byte
+	pos  int
+}
+
+func newJSONReader(data []byte) *jsonReader {
+	return &jsonReader{data: data}
+}
+
+func (r *jsonReader) syntaxError(msg string) error {
+	return fmt.Errorf("json: %s at offset %d", msg, r.pos)
+}
+
+func (r *jsonReader) skipSpace() {
+	for r.pos < len(r.data) {
+		switch r.data[r.pos] {
+		case ' ', '\t', '\n', '\r':
+			r.pos++
+		default:
+			return
+		}
+	}
+}
+
+// peek returns the first byte of the next value, or 0 at the end of the document
+func (r *jsonReader) peek() byte {
+	r.skipSpace()
+	if r.pos >= len(r.data) {
+		return 0
+	}
+	return r.data[r.pos]
+}
+
+// end checks that nothing but white space follows the read value
+func (r *jsonReader) end() error {
+	r.skipSpace()
+	if r.pos != len(r.data) {
+		return r.syntaxError("invalid character after top-level value")
+	}
+	return nil
+}
+
+// readLiteral reads true, false or null
+func (r *jsonReader) readLiteral(literal string) error {
+	if len(r.data)-r.pos < len(literal) || string(r.data[r.pos:r.pos+len(literal)]) != literal {
+		return r.syntaxError("invalid literal, expected " + literal)
+	}
+	r.pos += len(literal)
+	return nil
+}
+
+// readNull reads the next value if it's null, and reports whether it was
+func (r *jsonReader) readNull() bool {
+	if r.peek() == 'n' && r.readLiteral("null") == nil {
+		return true
+	}
+	return false
+}
+
+// readObject reads an object, calling fn with every key; fn must read the value of the key.
+// null is read as an empty object.
+func (r *jsonReader) readObject(fn func(key []byte) error) error {
+	switch r.peek() {
+	case 'n':
+		return r.readLiteral("null")
+	case '{':
+		r.pos++
+	default:
+		return r.syntaxError("expected object")
+	}
+
+	if r.peek() == '}' {
+		r.pos++
+		return nil
+	}
+	for {
+		if r.peek() != '"' {
+			return r.syntaxError("expected object key")
+		}
+		key, err := r.readStringBytes()
+		if err != nil {
+			return err
+		}
+		if r.peek() != ':' {
+			return r.syntaxError("expected colon after object key")
+		}
+		r.pos++
+
+		if err := fn(key); err != nil {
+			return err
+		}
+
+		switch r.peek() {
+		case ',':
+			r.pos++
+		case '}':
+			r.pos++
+			return nil
+		default:
+			return r.syntaxError("expected comma or end of object")
+		}
+	}
+}
+
+// readArray reads an array, calling fn for every item; fn must read the item.
+// null is read as an empty array.
+func (r *jsonReader) readArray(fn func() error) error {
+	switch r.peek() {
+	case 'n':
+		return r.readLiteral("null")
+	case '[':
+		r.pos++
+	default:
+		return r.syntaxError("expected array")
+	}
+
+	if r.peek() == ']' {
+		r.pos++
+		return nil
+	}
+	for {
+		if err := fn(); err != nil {
+			return err
+		}
+
+		switch r.peek() {
+		case ',':
+			r.pos++
+		case ']':
+			r.pos++
+			return nil
+		default:
+			return r.syntaxError("expected comma or end of array")
+		}
+	}
+}
+
+// readStringBytes reads a string; the result may point into the document
+func (r *jsonReader) readStringBytes() ([]byte, error) {
+	if r.peek() != '"' {
+		return nil, r.syntaxError("expected string")
+	}
+	r.pos++
+
+	// fast path: no escapes and plain ASCII
+	start := r.pos
+	for i := start; i < len(r.data); i++ {
+		c := r.data[i]
+		if c == '"' {
+			r.pos = i + 1
+			return r.data[start:i], nil
+		}
+		if c == '\\' || c < 0x20 || c >= utf8.RuneSelf {
+			break
+		}
+	}
+
+	var s []byte
+	for i := start; i < len(r.data); {
+		c := r.data[i]
+		switch {
+		case c == '"':
+			r.pos = i + 1
+			return s, nil
+		case c < 0x20:
+			r.pos = i
+			return nil, r.syntaxError("invalid character in string literal")
+		case c == '\\':
+			if i+1 >= len(r.data) {
+				r.pos = i
+				return nil, r.syntaxError("unexpected end of string literal")
+			}
+			switch r.data[i+1] {
+			case '"', '\\', '/':
+				s = append(s, r.data[i+1])
+			case 'b':
+				s = append(s, '\b')
+			case 'f':
+				s = append(s, '\f')
+			case 'n':
+				s = append(s, '\n')
+			case 'r':
+				s = append(s, '\r')
+			case 't':
+				s = append(s, '\t')
+			case 'u':
+				r1, ok := parseHex4(r.data[i+2:])
+				if !ok {
+					r.pos = i
+					return nil, r.syntaxError("invalid escape in string literal")
+				}
+				i += 6
+				if utf16.IsSurrogate(r1) {
+					if i+1 < len(r.data) && r.data[i] == '\\' && r.data[i+1] == 'u' {
+						r2, _ := parseHex4(r.data[i+2:])
+						if decoded := utf16.DecodeRune(r1, r2); decoded != utf8.RuneError {
+							i += 6
+							s = utf8.AppendRune(s, decoded)
+							continue
+						}
+					}
+					r1 = utf8.RuneError
+				}
+				s = utf8.AppendRune(s, r1)
+				continue
+			default:
+				r.pos = i
+				return nil, r.syntaxError("invalid escape in string literal")
+			}
+			i += 2
+		case c < utf8.RuneSelf:
+			s = append(s, c)
+			i++
+		default:
+			decoded, size := utf8.DecodeRune(r.data[i:])
+			if decoded == utf8.RuneError && size == 1 {
+				s = utf8.AppendRune(s, utf8.RuneError)
+			} else {
+				s = append(s, r.data[i:i+size]...)
+			}
+			i += size
+		}
+	}
+
+	r.pos = len(r.data)
+	return nil, r.syntaxError("unexpected end of string literal")
+}
+
+func parseHex4(b []byte) (rune, bool) {
+	if len(b) < 4 {
+		return 0, false
+	}
+	var value rune
+	for _, c := range b[:4] {
+		switch {
+		case '0' <= c && c <= '9':
+			c = c - '0'
+		case 'a' <= c && c <= 'f':
+			c = c - 'a' + 10
+		case 'A' <= c && c <= 'F':
+			c = c - 'A' + 10
+		default:
+			return 0, false
+		}
+		value = value*16 + rune(c)
+	}
+	return value, true
+}
+
+// readString reads a string; null is read as an empty string
+func (r *jsonReader) readString() (string, error) {
+	if r.readNull() {
+		return "", nil
+	}
+	s, err := r.readStringBytes()
+	return string(s), err
+}
+
+// readBool reads a boolean; null is read as false
+func (r *jsonReader) readBool() (bool, error) {
+	switch r.peek() {
+	case 't':
+		return true, r.readLiteral("true")
+	case 'f':
+		return false, r.readLiteral("false")
+	case 'n':
+		return false, r.readLiteral("null")
+	}
+	return false, r.syntaxError("expected boolean")
+}
+
+// readNumber reads a number literal
+func (r *jsonReader) readNumber() ([]byte, error) {
+	r.skipSpace()
+	start := r.pos
+	if r.pos < len(r.data) && r.data[r.pos] == '-' {
+		r.pos++
+	}
+	digits := r.pos
+	for r.pos < len(r.data) {
+		c := r.data[r.pos]
+		if ('0' <= c && c <= '9') || c == '.' || c == 'e' || c == 'E' || ((c == '+' || c == '-') && r.pos > digits) {
+			r.pos++
+			continue
+		}
+		break
+	}
+	if r.pos == digits {
+		return nil, r.syntaxError("expected number")
+	}
+	if !validJSONNumber(r.data[start:r.pos]) {
+		return nil, r.syntaxError("invalid number")
+	}
+	return r.data[start:r.pos], nil
+}
+
+// readInt64 reads a 64-bit integer, either as a number or as a string like TDLib sends them; null is read as 0
+func (r *jsonReader) readInt64() (int64, error) {
+	if r.readNull() {
+		return 0, nil
+	}
+
+	var number []byte
+	var err error
+	if r.peek() == '"' {
+		number, err = r.readStringBytes()
+	} else {
+		number, err = r.readNumber()
+	}
+	if err != nil {
+		return 0, err
+	}
+	return parseJSONInt(number)
+}
+
+func parseJSONInt(number []byte) (int64, error) {
+	// fast path for plain integers which can't overflow
+	if n := len(number); n > 0 && n < 19 {
+		negative := number[0] == '-'
+		digits := number
+		if negative {
+			digits = number[1:]
+		}
+		value := int64(0)
+		valid := len(digits) > 0
+		for _, c := range digits {
+			if c < '0' || c > '9' {
+				valid = false
+				break
+			}
+			value = value*10 + int64(c-'0')
+		}
+		if valid {
+			if negative {
+				value = -value
+			}
+			return value, nil
+		}
+	}
+
+	value, err := strconv.ParseInt(string(number), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("json: cannot unmarshal number %s into an integer", number)
+	}
+	return value, nil
+}
+
+// readInt32 reads a 32-bit integer number; null is read as 0
+func (r *jsonReader) readInt32() (int32, error) {
+	if r.readNull() {
+		return 0, nil
+	}
+	number, err := r.readNumber()
+	if err != nil {
+		return 0, err
+	}
+	value, err := parseJSONInt(number)
+	if err != nil {
+		return 0, err
+	}
+	if value < math.MinInt32 || value > math.MaxInt32 {
+		return 0, fmt.Errorf("json: number %d overflows int32", value)
+	}
+	return int32(value), nil
+}
+
+// readJSONInt64 reads a 64-bit integer, either as a number or as a string like TDLib sends them; null is read as 0
+func (r *jsonReader) readJSONInt64() (JSONInt64, error) {
+	if r.peek() != '"' {
+		value, err := r.readInt64()
+		return JSONInt64(value), err
+	}
+	number, err := r.readStringBytes()
+	if err != nil {
+		return 0, err
+	}
+	value, err := parseJSONInt(number)
+	return JSONInt64(value), err
+}
+
+// readFloat64 reads a number; null is read as 0
+func (r *jsonReader) readFloat64() (float64, error) {
+	if r.readNull() {
+		return 0, nil
+	}
+	number, err := r.readNumber()
+	if err != nil {
+		return 0, err
+	}
+	value, err := strconv.ParseFloat(string(number), 64)
+	if err != nil {
+		return 0, fmt.Errorf("json: cannot unmarshal number %s into a float", number)
+	}
+	return value, nil
+}
+
+// readBytes reads a base64 string; null is read as nil
+func (r *jsonReader) readBytes() ([]byte, error) {
+	if r.readNull() {
+		return nil, nil
+	}
+	s, err := r.readStringBytes()
+	if err != nil {
+		return nil, err
+	}
+	value := make([]byte, base64.StdEncoding.DecodedLen(len(s)))
+	n, err := base64.StdEncoding.Decode(value, s)
+	if err != nil {
+		return nil, err
+	}
+	return value[:n], nil
+}
+
+// readRaw reads the next value without decoding it; the result points into the document
+func (r *jsonReader) readRaw() ([]byte, error) {
+	r.skipSpace()
+	start := r.pos
+	if err := r.skip(); err != nil {
+		return nil, err
+	}
+	return r.data[start:r.pos], nil
+}
+
+// readRawMessage reads the next value without decoding it, as the unmarshal functions of interfaces expect it;
+// null is read as nil
+func (r *jsonReader) readRawMessage() (*json.RawMessage, error) {
+	if r.readNull() {
+		return nil, nil
+	}
+	raw, err := r.readRaw()
+	if err != nil {
+		return nil, err
+	}
+	rawMsg := json.RawMessage(raw)
+	return &rawMsg, nil
+}
+
+// skip reads the next value and discards it
+func (r *jsonReader) skip() error {
+	switch r.peek() {
+	case '{':
+		return r.readObject(func(key []byte) error { return r.skip() })
+	case '[':
+		return r.readArray(r.skip)
+	case '"':
+		_, err := r.readStringBytes()
+		return err
+	case 't':
+		return r.readLiteral("true")
+	case 'f':
+		return r.readLiteral("false")
+	case 'n':
+		return r.readLiteral("null")
+	case 0:
+		return r.syntaxError("unexpected end of JSON input")
+	}
+	_, err := r.readNumber()
+	return err
+}
+
+// readAny reads any value the way encoding/json decodes it into an interface{} with UseNumber
+func (r *jsonReader) readAny() (interface{}, error) {
+	switch r.peek() {
+	case '{':
+		m := make(map[string]interface{})
+		err := r.readObject(func(key []byte) error {
+			value, err := r.readAny()
+			m[string(key)] = value
+			return err
+		})
+		return m, err
+	case '[':
+		items := make([]interface{}, 0)
+		err := r.readArray(func() error {
+			item, err := r.readAny()
+			items = append(items, item)
+			return err
+		})
+		return items, err
+	case '"':
+		return r.readString()
+	case 't', 'f':
+		return r.readBool()
+	case 'n':
+		return nil, r.readLiteral("null")
+	}
+
+	// keep numbers as json.Number, 64-bit integers would lose precision as float64
+	number, err := r.readNumber()
+	if err != nil {
+		return nil, err
+	}
+	return json.Number(number), nil
+}
+
+// validJSONNumber reports whether a number literal is valid JSON
+func validJSONNumber(number []byte) bool {
+	i := 0
+	if i < len(number) && number[i] == '-' {
+		i++
+	}
+
+	switch {
+	case i < len(number) && number[i] == '0':
+		i++
+	case i < len(number) && '1' <= number[i] && number[i] <= '9':
+		for i < len(number) && '0' <= number[i] && number[i] <= '9' {
+			i++
+		}
+	default:
+		return false
+	}
+
+	if i < len(number) && number[i] == '.' {
+		i++
+		if i == len(number) || number[i] < '0' || number[i] > '9' {
+			return false
+		}
+		for i < len(number) && '0' <= number[i] && number[i] <= '9' {
+			i++
+		}
+	}
+
+	if i < len(number) && (number[i] == 'e' || number[i] == 'E') {
+		i++
+		if i < len(number) && (number[i] == '+' || number[i] == '-') {
+			i++
+		}
+		if i == len(number) || number[i] < '0' || number[i] > '9' {
+			return false
+		}
+		for i < len(number) && '0' <= number[i] && number[i] <= '9' {
+			i++
+		}
+	}
+
+	return i == len(number)
+}
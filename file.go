@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // File Represents a file
@@ -43,6 +43,178 @@ func NewFile(id int32, dcId int32, size int32, expectedSize int32, local *LocalF
 	return &fileTemp
 }
 
+// MarshalJSON marshals to json
+func (file *File) MarshalJSON() ([]byte, error) {
+	return file.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (file *File) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, file)
+}
+
+func (file *File) appendJSON(b []byte) ([]byte, error) {
+	if file == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, file.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, file.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt(b, int64(file.Id))
+	b = append(b, `,"dc_id":`...)
+	b = appendJSONInt(b, int64(file.DcId))
+	b = append(b, `,"size":`...)
+	b = appendJSONInt(b, int64(file.Size))
+	b = append(b, `,"expected_size":`...)
+	b = appendJSONInt(b, int64(file.ExpectedSize))
+	b = append(b, `,"local":`...)
+	b, err = file.Local.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"remote":`...)
+	b, err = file.Remote.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (file *File) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			file.tdCommon.Type, err = r.readString()
+		case "@extra":
+			file.tdCommon.Extra, err = r.readString()
+		case "id":
+			file.Id, err = r.readInt32()
+		case "dc_id":
+			file.DcId, err = r.readInt32()
+		case "size":
+			file.Size, err = r.readInt32()
+		case "expected_size":
+			file.ExpectedSize, err = r.readInt32()
+		case "local":
+			if r.readNull() {
+				file.Local = nil
+			} else {
+				file.Local = new(LocalFile)
+				err = file.Local.decodeJSON(r)
+			}
+		case "remote":
+			if r.readNull() {
+				file.Remote = nil
+			} else {
+				file.Remote = new(RemoteFile)
+				err = file.Remote.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (file *File) Clone() *File {
+	if file == nil {
+		return nil
+	}
+	clone := *file
+	clone.Local = file.Local.Clone()
+	clone.Remote = file.Remote.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (file *File) Equal(other *File) bool {
+	if file == nil || other == nil {
+		return file == other
+	}
+	if file.Id != other.Id {
+		return false
+	}
+	if file.DcId != other.DcId {
+		return false
+	}
+	if file.Size != other.Size {
+		return false
+	}
+	if file.ExpectedSize != other.ExpectedSize {
+		return false
+	}
+	if !file.Local.Equal(other.Local) {
+		return false
+	}
+	if !file.Remote.Equal(other.Remote) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (file *File) Diff(other *File) []Change {
+	return file.diff("", other, nil)
+}
+
+func (file *File) diff(path string, other *File, changes []Change) []Change {
+	if file == nil || other == nil {
+		if file != other {
+			changes = append(changes, Change{Path: path, Old: file, New: other})
+		}
+		return changes
+	}
+	if file.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: file.Id, New: other.Id})
+	}
+	if file.DcId != other.DcId {
+		changes = append(changes, Change{Path: fieldPath(path, "dc_id"), Old: file.DcId, New: other.DcId})
+	}
+	if file.Size != other.Size {
+		changes = append(changes, Change{Path: fieldPath(path, "size"), Old: file.Size, New: other.Size})
+	}
+	if file.ExpectedSize != other.ExpectedSize {
+		changes = append(changes, Change{Path: fieldPath(path, "expected_size"), Old: file.ExpectedSize, New: other.ExpectedSize})
+	}
+	changes = file.Local.diff(fieldPath(path, "local"), other.Local, changes)
+	changes = file.Remote.diff(fieldPath(path, "remote"), other.Remote, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (file *File) String() string {
+	return objectString(file)
+}
+
+// LogValue logs the object as its summary
+func (file *File) LogValue() slog.Value {
+	return slog.StringValue(objectString(file))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (file *File) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, file)
+}
+
+func (file *File) printText(p *printer) {
+	if file == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("File")
+	p.int("id", int64(file.Id))
+	p.int("dc_id", int64(file.DcId))
+	p.int("size", int64(file.Size))
+	p.int("expected_size", int64(file.ExpectedSize))
+	p.object("local", file.Local)
+	p.object("remote", file.Remote)
+	p.endObject(state)
+}
+
 // GetFile Returns information about a file; this is an offline request
 // @param fileId Identifier of the file to get
 func (client *Client) GetFile(fileId int32) (*File, error) {
@@ -55,12 +227,12 @@ func (client *Client) GetFile(fileId int32) (*File, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var fileDummy File
-	err = json.Unmarshal(result.Raw, &fileDummy)
+	err = jsonUnmarshal(result.Raw, &fileDummy)
 	return &fileDummy, err
 }
 
@@ -78,12 +250,12 @@ func (client *Client) GetRemoteFile(remoteFileId string, fileType FileType) (*Fi
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var fileDummy File
-	err = json.Unmarshal(result.Raw, &fileDummy)
+	err = jsonUnmarshal(result.Raw, &fileDummy)
 	return &fileDummy, err
 }
 
@@ -107,12 +279,12 @@ func (client *Client) DownloadFile(fileId int32, priority int32, offset int32, l
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var fileDummy File
-	err = json.Unmarshal(result.Raw, &fileDummy)
+	err = jsonUnmarshal(result.Raw, &fileDummy)
 	return &fileDummy, err
 }
 
@@ -132,12 +304,12 @@ func (client *Client) UploadFile(file InputFile, fileType FileType, priority int
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var fileDummy File
-	err = json.Unmarshal(result.Raw, &fileDummy)
+	err = jsonUnmarshal(result.Raw, &fileDummy)
 	return &fileDummy, err
 }
 
@@ -155,12 +327,12 @@ func (client *Client) UploadStickerFile(userId int64, sticker InputSticker) (*Fi
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var file File
-	err = json.Unmarshal(result.Raw, &file)
+	err = jsonUnmarshal(result.Raw, &file)
 	return &file, err
 }
 
@@ -186,11 +358,11 @@ func (client *Client) GetMapThumbnailFile(location *Location, zoom int32, width
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var file File
-	err = json.Unmarshal(result.Raw, &file)
+	err = jsonUnmarshal(result.Raw, &file)
 	return &file, err
 }
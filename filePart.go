@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // FilePart Contains a part of a file
@@ -28,6 +28,111 @@ func NewFilePart(data []byte) *FilePart {
 	return &filePartTemp
 }
 
+// MarshalJSON marshals to json
+func (filePart *FilePart) MarshalJSON() ([]byte, error) {
+	return filePart.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (filePart *FilePart) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, filePart)
+}
+
+func (filePart *FilePart) appendJSON(b []byte) ([]byte, error) {
+	if filePart == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, filePart.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, filePart.tdCommon.Extra)
+	b = append(b, `,"data":`...)
+	b = appendJSONBytes(b, filePart.Data)
+	return append(b, '}'), nil
+}
+
+func (filePart *FilePart) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			filePart.tdCommon.Type, err = r.readString()
+		case "@extra":
+			filePart.tdCommon.Extra, err = r.readString()
+		case "data":
+			filePart.Data, err = r.readBytes()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (filePart *FilePart) Clone() *FilePart {
+	if filePart == nil {
+		return nil
+	}
+	clone := *filePart
+	if filePart.Data != nil {
+		clone.Data = append([]byte{}, filePart.Data...)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (filePart *FilePart) Equal(other *FilePart) bool {
+	if filePart == nil || other == nil {
+		return filePart == other
+	}
+	if !equalBytes(filePart.Data, other.Data) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (filePart *FilePart) Diff(other *FilePart) []Change {
+	return filePart.diff("", other, nil)
+}
+
+func (filePart *FilePart) diff(path string, other *FilePart, changes []Change) []Change {
+	if filePart == nil || other == nil {
+		if filePart != other {
+			changes = append(changes, Change{Path: path, Old: filePart, New: other})
+		}
+		return changes
+	}
+	if !equalBytes(filePart.Data, other.Data) {
+		changes = append(changes, Change{Path: fieldPath(path, "data"), Old: filePart.Data, New: other.Data})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (filePart *FilePart) String() string {
+	return objectString(filePart)
+}
+
+// LogValue logs the object as its summary
+func (filePart *FilePart) LogValue() slog.Value {
+	return slog.StringValue(objectString(filePart))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (filePart *FilePart) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, filePart)
+}
+
+func (filePart *FilePart) printText(p *printer) {
+	if filePart == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("FilePart")
+	p.bytes("data", filePart.Data)
+	p.endObject(state)
+}
+
 // ReadFilePart Reads a part of a file from the TDLib file cache and returns read bytes. This method is intended to be used only if the application has no direct access to TDLib's file system, because it is usually slower than a direct read from the file
 // @param fileId Identifier of the file. The file must be located in the TDLib file cache
 // @param offset The offset from which to read the file
@@ -44,12 +149,12 @@ func (client *Client) ReadFilePart(fileId int32, offset int32, count int32) (*Fi
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var filePart FilePart
-	err = json.Unmarshal(result.Raw, &filePart)
+	err = jsonUnmarshal(result.Raw, &filePart)
 	return &filePart, err
 }
 
@@ -73,11 +178,11 @@ func (client *Client) GetGroupCallStreamSegment(groupCallId int32, timeOffset in
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var filePart FilePart
-	err = json.Unmarshal(result.Raw, &filePart)
+	err = jsonUnmarshal(result.Raw, &filePart)
 	return &filePart, err
 }
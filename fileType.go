@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // FileType Represents the type of a file
 type FileType interface {
 	GetFileTypeEnum() FileTypeEnum
+	Accept(visitor FileTypeVisitor)
 }
 
 // FileTypeEnum Alias for abstract FileType 'Sub-Classes', used as constant-enum here
@@ -33,103 +35,154 @@ const (
 	FileTypeWallpaperType       FileTypeEnum = "fileTypeWallpaper"
 )
 
+// UnknownFileType is a FileType of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownFileType struct {
+	Unknown
+}
+
+// GetFileTypeEnum return the enum type of this object
+func (unknownFileType *UnknownFileType) GetFileTypeEnum() FileTypeEnum {
+	return FileTypeEnum(unknownFileType.Type)
+}
+
+// Accept calls the method of the visitor for UnknownFileType
+func (unknownFileType *UnknownFileType) Accept(visitor FileTypeVisitor) {
+	visitor.VisitUnknownFileType(unknownFileType)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownFileType *UnknownFileType) String() string {
+	return objectString(unknownFileType)
+}
+
+// LogValue logs the object as its summary
+func (unknownFileType *UnknownFileType) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownFileType))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownFileType *UnknownFileType) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownFileType)
+}
+
+func (unknownFileType *UnknownFileType) printText(p *printer) {
+	if unknownFileType == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownFileType")
+	p.string("@type", unknownFileType.Type)
+	p.endObject(state)
+}
+
 func unmarshalFileType(rawMsg *json.RawMessage) (FileType, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch FileTypeEnum(objMap["@type"].(string)) {
+	switch FileTypeEnum(typeName) {
 	case FileTypeNoneType:
 		var fileTypeNone FileTypeNone
-		err := json.Unmarshal(*rawMsg, &fileTypeNone)
+		err := jsonUnmarshal(*rawMsg, &fileTypeNone)
 		return &fileTypeNone, err
 
 	case FileTypeAnimationType:
 		var fileTypeAnimation FileTypeAnimation
-		err := json.Unmarshal(*rawMsg, &fileTypeAnimation)
+		err := jsonUnmarshal(*rawMsg, &fileTypeAnimation)
 		return &fileTypeAnimation, err
 
 	case FileTypeAudioType:
 		var fileTypeAudio FileTypeAudio
-		err := json.Unmarshal(*rawMsg, &fileTypeAudio)
+		err := jsonUnmarshal(*rawMsg, &fileTypeAudio)
 		return &fileTypeAudio, err
 
 	case FileTypeDocumentType:
 		var fileTypeDocument FileTypeDocument
-		err := json.Unmarshal(*rawMsg, &fileTypeDocument)
+		err := jsonUnmarshal(*rawMsg, &fileTypeDocument)
 		return &fileTypeDocument, err
 
 	case FileTypePhotoType:
 		var fileTypePhoto FileTypePhoto
-		err := json.Unmarshal(*rawMsg, &fileTypePhoto)
+		err := jsonUnmarshal(*rawMsg, &fileTypePhoto)
 		return &fileTypePhoto, err
 
 	case FileTypeProfilePhotoType:
 		var fileTypeProfilePhoto FileTypeProfilePhoto
-		err := json.Unmarshal(*rawMsg, &fileTypeProfilePhoto)
+		err := jsonUnmarshal(*rawMsg, &fileTypeProfilePhoto)
 		return &fileTypeProfilePhoto, err
 
 	case FileTypeSecretType:
 		var fileTypeSecret FileTypeSecret
-		err := json.Unmarshal(*rawMsg, &fileTypeSecret)
+		err := jsonUnmarshal(*rawMsg, &fileTypeSecret)
 		return &fileTypeSecret, err
 
 	case FileTypeSecretThumbnailType:
 		var fileTypeSecretThumbnail FileTypeSecretThumbnail
-		err := json.Unmarshal(*rawMsg, &fileTypeSecretThumbnail)
+		err := jsonUnmarshal(*rawMsg, &fileTypeSecretThumbnail)
 		return &fileTypeSecretThumbnail, err
 
 	case FileTypeSecureType:
 		var fileTypeSecure FileTypeSecure
-		err := json.Unmarshal(*rawMsg, &fileTypeSecure)
+		err := jsonUnmarshal(*rawMsg, &fileTypeSecure)
 		return &fileTypeSecure, err
 
 	case FileTypeStickerType:
 		var fileTypeSticker FileTypeSticker
-		err := json.Unmarshal(*rawMsg, &fileTypeSticker)
+		err := jsonUnmarshal(*rawMsg, &fileTypeSticker)
 		return &fileTypeSticker, err
 
 	case FileTypeThumbnailType:
 		var fileTypeThumbnail FileTypeThumbnail
-		err := json.Unmarshal(*rawMsg, &fileTypeThumbnail)
+		err := jsonUnmarshal(*rawMsg, &fileTypeThumbnail)
 		return &fileTypeThumbnail, err
 
 	case FileTypeUnknownType:
 		var fileTypeUnknown FileTypeUnknown
-		err := json.Unmarshal(*rawMsg, &fileTypeUnknown)
+		err := jsonUnmarshal(*rawMsg, &fileTypeUnknown)
 		return &fileTypeUnknown, err
 
 	case FileTypeVideoType:
 		var fileTypeVideo FileTypeVideo
-		err := json.Unmarshal(*rawMsg, &fileTypeVideo)
+		err := jsonUnmarshal(*rawMsg, &fileTypeVideo)
 		return &fileTypeVideo, err
 
 	case FileTypeVideoNoteType:
 		var fileTypeVideoNote FileTypeVideoNote
-		err := json.Unmarshal(*rawMsg, &fileTypeVideoNote)
+		err := jsonUnmarshal(*rawMsg, &fileTypeVideoNote)
 		return &fileTypeVideoNote, err
 
 	case FileTypeVoiceNoteType:
 		var fileTypeVoiceNote FileTypeVoiceNote
-		err := json.Unmarshal(*rawMsg, &fileTypeVoiceNote)
+		err := jsonUnmarshal(*rawMsg, &fileTypeVoiceNote)
 		return &fileTypeVoiceNote, err
 
 	case FileTypeWallpaperType:
 		var fileTypeWallpaper FileTypeWallpaper
-		err := json.Unmarshal(*rawMsg, &fileTypeWallpaper)
+		err := jsonUnmarshal(*rawMsg, &fileTypeWallpaper)
 		return &fileTypeWallpaper, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownFileType{Unknown: unknown}, nil
 	}
 }
 
+func decodeFileType(r *jsonReader) (FileType, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalFileType(rawMsg)
+}
+
 // FileTypeNone The data is not a file
 type FileTypeNone struct {
 	tdCommon
@@ -141,7 +194,6 @@ func (fileTypeNone *FileTypeNone) MessageType() string {
 }
 
 // NewFileTypeNone creates a new FileTypeNone
-//
 func NewFileTypeNone() *FileTypeNone {
 	fileTypeNoneTemp := FileTypeNone{
 		tdCommon: tdCommon{Type: "fileTypeNone"},
@@ -150,11 +202,107 @@ func NewFileTypeNone() *FileTypeNone {
 	return &fileTypeNoneTemp
 }
 
+// MarshalJSON marshals to json
+func (fileTypeNone *FileTypeNone) MarshalJSON() ([]byte, error) {
+	return fileTypeNone.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (fileTypeNone *FileTypeNone) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, fileTypeNone)
+}
+
+func (fileTypeNone *FileTypeNone) appendJSON(b []byte) ([]byte, error) {
+	if fileTypeNone == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, fileTypeNone.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, fileTypeNone.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (fileTypeNone *FileTypeNone) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			fileTypeNone.tdCommon.Type, err = r.readString()
+		case "@extra":
+			fileTypeNone.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (fileTypeNone *FileTypeNone) Clone() *FileTypeNone {
+	if fileTypeNone == nil {
+		return nil
+	}
+	clone := *fileTypeNone
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (fileTypeNone *FileTypeNone) Equal(other *FileTypeNone) bool {
+	if fileTypeNone == nil || other == nil {
+		return fileTypeNone == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (fileTypeNone *FileTypeNone) Diff(other *FileTypeNone) []Change {
+	return fileTypeNone.diff("", other, nil)
+}
+
+func (fileTypeNone *FileTypeNone) diff(path string, other *FileTypeNone, changes []Change) []Change {
+	if fileTypeNone == nil || other == nil {
+		if fileTypeNone != other {
+			changes = append(changes, Change{Path: path, Old: fileTypeNone, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (fileTypeNone *FileTypeNone) String() string {
+	return objectString(fileTypeNone)
+}
+
+// LogValue logs the object as its summary
+func (fileTypeNone *FileTypeNone) LogValue() slog.Value {
+	return slog.StringValue(objectString(fileTypeNone))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (fileTypeNone *FileTypeNone) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, fileTypeNone)
+}
+
+func (fileTypeNone *FileTypeNone) printText(p *printer) {
+	if fileTypeNone == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("FileTypeNone")
+	p.endObject(state)
+}
+
 // GetFileTypeEnum return the enum type of this object
 func (fileTypeNone *FileTypeNone) GetFileTypeEnum() FileTypeEnum {
 	return FileTypeNoneType
 }
 
+// Accept calls the method of the visitor for FileTypeNone
+func (fileTypeNone *FileTypeNone) Accept(visitor FileTypeVisitor) {
+	visitor.VisitFileTypeNone(fileTypeNone)
+}
+
 // FileTypeAnimation The file is an animation
 type FileTypeAnimation struct {
 	tdCommon
@@ -166,7 +314,6 @@ func (fileTypeAnimation *FileTypeAnimation) MessageType() string {
 }
 
 // NewFileTypeAnimation creates a new FileTypeAnimation
-//
 func NewFileTypeAnimation() *FileTypeAnimation {
 	fileTypeAnimationTemp := FileTypeAnimation{
 		tdCommon: tdCommon{Type: "fileTypeAnimation"},
@@ -175,11 +322,107 @@ func NewFileTypeAnimation() *FileTypeAnimation {
 	return &fileTypeAnimationTemp
 }
 
+// MarshalJSON marshals to json
+func (fileTypeAnimation *FileTypeAnimation) MarshalJSON() ([]byte, error) {
+	return fileTypeAnimation.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (fileTypeAnimation *FileTypeAnimation) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, fileTypeAnimation)
+}
+
+func (fileTypeAnimation *FileTypeAnimation) appendJSON(b []byte) ([]byte, error) {
+	if fileTypeAnimation == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, fileTypeAnimation.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, fileTypeAnimation.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (fileTypeAnimation *FileTypeAnimation) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			fileTypeAnimation.tdCommon.Type, err = r.readString()
+		case "@extra":
+			fileTypeAnimation.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (fileTypeAnimation *FileTypeAnimation) Clone() *FileTypeAnimation {
+	if fileTypeAnimation == nil {
+		return nil
+	}
+	clone := *fileTypeAnimation
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (fileTypeAnimation *FileTypeAnimation) Equal(other *FileTypeAnimation) bool {
+	if fileTypeAnimation == nil || other == nil {
+		return fileTypeAnimation == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (fileTypeAnimation *FileTypeAnimation) Diff(other *FileTypeAnimation) []Change {
+	return fileTypeAnimation.diff("", other, nil)
+}
+
+func (fileTypeAnimation *FileTypeAnimation) diff(path string, other *FileTypeAnimation, changes []Change) []Change {
+	if fileTypeAnimation == nil || other == nil {
+		if fileTypeAnimation != other {
+			changes = append(changes, Change{Path: path, Old: fileTypeAnimation, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (fileTypeAnimation *FileTypeAnimation) String() string {
+	return objectString(fileTypeAnimation)
+}
+
+// LogValue logs the object as its summary
+func (fileTypeAnimation *FileTypeAnimation) LogValue() slog.Value {
+	return slog.StringValue(objectString(fileTypeAnimation))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (fileTypeAnimation *FileTypeAnimation) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, fileTypeAnimation)
+}
+
+func (fileTypeAnimation *FileTypeAnimation) printText(p *printer) {
+	if fileTypeAnimation == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("FileTypeAnimation")
+	p.endObject(state)
+}
+
 // GetFileTypeEnum return the enum type of this object
 func (fileTypeAnimation *FileTypeAnimation) GetFileTypeEnum() FileTypeEnum {
 	return FileTypeAnimationType
 }
 
+// Accept calls the method of the visitor for FileTypeAnimation
+func (fileTypeAnimation *FileTypeAnimation) Accept(visitor FileTypeVisitor) {
+	visitor.VisitFileTypeAnimation(fileTypeAnimation)
+}
+
 // FileTypeAudio The file is an audio file
 type FileTypeAudio struct {
 	tdCommon
@@ -191,7 +434,6 @@ func (fileTypeAudio *FileTypeAudio) MessageType() string {
 }
 
 // NewFileTypeAudio creates a new FileTypeAudio
-//
 func NewFileTypeAudio() *FileTypeAudio {
 	fileTypeAudioTemp := FileTypeAudio{
 		tdCommon: tdCommon{Type: "fileTypeAudio"},
@@ -200,11 +442,107 @@ func NewFileTypeAudio() *FileTypeAudio {
 	return &fileTypeAudioTemp
 }
 
+// MarshalJSON marshals to json
+func (fileTypeAudio *FileTypeAudio) MarshalJSON() ([]byte, error) {
+	return fileTypeAudio.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (fileTypeAudio *FileTypeAudio) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, fileTypeAudio)
+}
+
+func (fileTypeAudio *FileTypeAudio) appendJSON(b []byte) ([]byte, error) {
+	if fileTypeAudio == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, fileTypeAudio.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, fileTypeAudio.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (fileTypeAudio *FileTypeAudio) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			fileTypeAudio.tdCommon.Type, err = r.readString()
+		case "@extra":
+			fileTypeAudio.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (fileTypeAudio *FileTypeAudio) Clone() *FileTypeAudio {
+	if fileTypeAudio == nil {
+		return nil
+	}
+	clone := *fileTypeAudio
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (fileTypeAudio *FileTypeAudio) Equal(other *FileTypeAudio) bool {
+	if fileTypeAudio == nil || other == nil {
+		return fileTypeAudio == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (fileTypeAudio *FileTypeAudio) Diff(other *FileTypeAudio) []Change {
+	return fileTypeAudio.diff("", other, nil)
+}
+
+func (fileTypeAudio *FileTypeAudio) diff(path string, other *FileTypeAudio, changes []Change) []Change {
+	if fileTypeAudio == nil || other == nil {
+		if fileTypeAudio != other {
+			changes = append(changes, Change{Path: path, Old: fileTypeAudio, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (fileTypeAudio *FileTypeAudio) String() string {
+	return objectString(fileTypeAudio)
+}
+
+// LogValue logs the object as its summary
+func (fileTypeAudio *FileTypeAudio) LogValue() slog.Value {
+	return slog.StringValue(objectString(fileTypeAudio))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (fileTypeAudio *FileTypeAudio) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, fileTypeAudio)
+}
+
+func (fileTypeAudio *FileTypeAudio) printText(p *printer) {
+	if fileTypeAudio == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("FileTypeAudio")
+	p.endObject(state)
+}
+
 // GetFileTypeEnum return the enum type of this object
 func (fileTypeAudio *FileTypeAudio) GetFileTypeEnum() FileTypeEnum {
 	return FileTypeAudioType
 }
 
+// Accept calls the method of the visitor for FileTypeAudio
+func (fileTypeAudio *FileTypeAudio) Accept(visitor FileTypeVisitor) {
+	visitor.VisitFileTypeAudio(fileTypeAudio)
+}
+
 // FileTypeDocument The file is a document
 type FileTypeDocument struct {
 	tdCommon
@@ -216,7 +554,6 @@ func (fileTypeDocument *FileTypeDocument) MessageType() string {
 }
 
 // NewFileTypeDocument creates a new FileTypeDocument
-//
 func NewFileTypeDocument() *FileTypeDocument {
 	fileTypeDocumentTemp := FileTypeDocument{
 		tdCommon: tdCommon{Type: "fileTypeDocument"},
@@ -225,11 +562,107 @@ func NewFileTypeDocument() *FileTypeDocument {
 	return &fileTypeDocumentTemp
 }
 
+// MarshalJSON marshals to json
+func (fileTypeDocument *FileTypeDocument) MarshalJSON() ([]byte, error) {
+	return fileTypeDocument.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (fileTypeDocument *FileTypeDocument) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, fileTypeDocument)
+}
+
+func (fileTypeDocument *FileTypeDocument) appendJSON(b []byte) ([]byte, error) {
+	if fileTypeDocument == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, fileTypeDocument.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, fileTypeDocument.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (fileTypeDocument *FileTypeDocument) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			fileTypeDocument.tdCommon.Type, err = r.readString()
+		case "@extra":
+			fileTypeDocument.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (fileTypeDocument *FileTypeDocument) Clone() *FileTypeDocument {
+	if fileTypeDocument == nil {
+		return nil
+	}
+	clone := *fileTypeDocument
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (fileTypeDocument *FileTypeDocument) Equal(other *FileTypeDocument) bool {
+	if fileTypeDocument == nil || other == nil {
+		return fileTypeDocument == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (fileTypeDocument *FileTypeDocument) Diff(other *FileTypeDocument) []Change {
+	return fileTypeDocument.diff("", other, nil)
+}
+
+func (fileTypeDocument *FileTypeDocument) diff(path string, other *FileTypeDocument, changes []Change) []Change {
+	if fileTypeDocument == nil || other == nil {
+		if fileTypeDocument != other {
+			changes = append(changes, Change{Path: path, Old: fileTypeDocument, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (fileTypeDocument *FileTypeDocument) String() string {
+	return objectString(fileTypeDocument)
+}
+
+// LogValue logs the object as its summary
+func (fileTypeDocument *FileTypeDocument) LogValue() slog.Value {
+	return slog.StringValue(objectString(fileTypeDocument))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (fileTypeDocument *FileTypeDocument) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, fileTypeDocument)
+}
+
+func (fileTypeDocument *FileTypeDocument) printText(p *printer) {
+	if fileTypeDocument == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("FileTypeDocument")
+	p.endObject(state)
+}
+
 // GetFileTypeEnum return the enum type of this object
 func (fileTypeDocument *FileTypeDocument) GetFileTypeEnum() FileTypeEnum {
 	return FileTypeDocumentType
 }
 
+// Accept calls the method of the visitor for FileTypeDocument
+func (fileTypeDocument *FileTypeDocument) Accept(visitor FileTypeVisitor) {
+	visitor.VisitFileTypeDocument(fileTypeDocument)
+}
+
 // FileTypePhoto The file is a photo
 type FileTypePhoto struct {
 	tdCommon
@@ -241,7 +674,6 @@ func (fileTypePhoto *FileTypePhoto) MessageType() string {
 }
 
 // NewFileTypePhoto creates a new FileTypePhoto
-//
 func NewFileTypePhoto() *FileTypePhoto {
 	fileTypePhotoTemp := FileTypePhoto{
 		tdCommon: tdCommon{Type: "fileTypePhoto"},
@@ -250,11 +682,107 @@ func NewFileTypePhoto() *FileTypePhoto {
 	return &fileTypePhotoTemp
 }
 
+// MarshalJSON marshals to json
+func (fileTypePhoto *FileTypePhoto) MarshalJSON() ([]byte, error) {
+	return fileTypePhoto.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (fileTypePhoto *FileTypePhoto) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, fileTypePhoto)
+}
+
+func (fileTypePhoto *FileTypePhoto) appendJSON(b []byte) ([]byte, error) {
+	if fileTypePhoto == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, fileTypePhoto.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, fileTypePhoto.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (fileTypePhoto *FileTypePhoto) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			fileTypePhoto.tdCommon.Type, err = r.readString()
+		case "@extra":
+			fileTypePhoto.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (fileTypePhoto *FileTypePhoto) Clone() *FileTypePhoto {
+	if fileTypePhoto == nil {
+		return nil
+	}
+	clone := *fileTypePhoto
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (fileTypePhoto *FileTypePhoto) Equal(other *FileTypePhoto) bool {
+	if fileTypePhoto == nil || other == nil {
+		return fileTypePhoto == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (fileTypePhoto *FileTypePhoto) Diff(other *FileTypePhoto) []Change {
+	return fileTypePhoto.diff("", other, nil)
+}
+
+func (fileTypePhoto *FileTypePhoto) diff(path string, other *FileTypePhoto, changes []Change) []Change {
+	if fileTypePhoto == nil || other == nil {
+		if fileTypePhoto != other {
+			changes = append(changes, Change{Path: path, Old: fileTypePhoto, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (fileTypePhoto *FileTypePhoto) String() string {
+	return objectString(fileTypePhoto)
+}
+
+// LogValue logs the object as its summary
+func (fileTypePhoto *FileTypePhoto) LogValue() slog.Value {
+	return slog.StringValue(objectString(fileTypePhoto))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (fileTypePhoto *FileTypePhoto) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, fileTypePhoto)
+}
+
+func (fileTypePhoto *FileTypePhoto) printText(p *printer) {
+	if fileTypePhoto == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("FileTypePhoto")
+	p.endObject(state)
+}
+
 // GetFileTypeEnum return the enum type of this object
 func (fileTypePhoto *FileTypePhoto) GetFileTypeEnum() FileTypeEnum {
 	return FileTypePhotoType
 }
 
+// Accept calls the method of the visitor for FileTypePhoto
+func (fileTypePhoto *FileTypePhoto) Accept(visitor FileTypeVisitor) {
+	visitor.VisitFileTypePhoto(fileTypePhoto)
+}
+
 // FileTypeProfilePhoto The file is a profile photo
 type FileTypeProfilePhoto struct {
 	tdCommon
@@ -266,7 +794,6 @@ func (fileTypeProfilePhoto *FileTypeProfilePhoto) MessageType() string {
 }
 
 // NewFileTypeProfilePhoto creates a new FileTypeProfilePhoto
-//
 func NewFileTypeProfilePhoto() *FileTypeProfilePhoto {
 	fileTypeProfilePhotoTemp := FileTypeProfilePhoto{
 		tdCommon: tdCommon{Type: "fileTypeProfilePhoto"},
@@ -275,11 +802,107 @@ func NewFileTypeProfilePhoto() *FileTypeProfilePhoto {
 	return &fileTypeProfilePhotoTemp
 }
 
+// MarshalJSON marshals to json
+func (fileTypeProfilePhoto *FileTypeProfilePhoto) MarshalJSON() ([]byte, error) {
+	return fileTypeProfilePhoto.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (fileTypeProfilePhoto *FileTypeProfilePhoto) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, fileTypeProfilePhoto)
+}
+
+func (fileTypeProfilePhoto *FileTypeProfilePhoto) appendJSON(b []byte) ([]byte, error) {
+	if fileTypeProfilePhoto == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, fileTypeProfilePhoto.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, fileTypeProfilePhoto.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (fileTypeProfilePhoto *FileTypeProfilePhoto) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			fileTypeProfilePhoto.tdCommon.Type, err = r.readString()
+		case "@extra":
+			fileTypeProfilePhoto.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (fileTypeProfilePhoto *FileTypeProfilePhoto) Clone() *FileTypeProfilePhoto {
+	if fileTypeProfilePhoto == nil {
+		return nil
+	}
+	clone := *fileTypeProfilePhoto
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (fileTypeProfilePhoto *FileTypeProfilePhoto) Equal(other *FileTypeProfilePhoto) bool {
+	if fileTypeProfilePhoto == nil || other == nil {
+		return fileTypeProfilePhoto == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (fileTypeProfilePhoto *FileTypeProfilePhoto) Diff(other *FileTypeProfilePhoto) []Change {
+	return fileTypeProfilePhoto.diff("", other, nil)
+}
+
+func (fileTypeProfilePhoto *FileTypeProfilePhoto) diff(path string, other *FileTypeProfilePhoto, changes []Change) []Change {
+	if fileTypeProfilePhoto == nil || other == nil {
+		if fileTypeProfilePhoto != other {
+			changes = append(changes, Change{Path: path, Old: fileTypeProfilePhoto, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (fileTypeProfilePhoto *FileTypeProfilePhoto) String() string {
+	return objectString(fileTypeProfilePhoto)
+}
+
+// LogValue logs the object as its summary
+func (fileTypeProfilePhoto *FileTypeProfilePhoto) LogValue() slog.Value {
+	return slog.StringValue(objectString(fileTypeProfilePhoto))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (fileTypeProfilePhoto *FileTypeProfilePhoto) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, fileTypeProfilePhoto)
+}
+
+func (fileTypeProfilePhoto *FileTypeProfilePhoto) printText(p *printer) {
+	if fileTypeProfilePhoto == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("FileTypeProfilePhoto")
+	p.endObject(state)
+}
+
 // GetFileTypeEnum return the enum type of this object
 func (fileTypeProfilePhoto *FileTypeProfilePhoto) GetFileTypeEnum() FileTypeEnum {
 	return FileTypeProfilePhotoType
 }
 
+// Accept calls the method of the visitor for FileTypeProfilePhoto
+func (fileTypeProfilePhoto *FileTypeProfilePhoto) Accept(visitor FileTypeVisitor) {
+	visitor.VisitFileTypeProfilePhoto(fileTypeProfilePhoto)
+}
+
 // FileTypeSecret The file was sent to a secret chat (the file type is not known to the server)
 type FileTypeSecret struct {
 	tdCommon
@@ -291,7 +914,6 @@ func (fileTypeSecret *FileTypeSecret) MessageType() string {
 }
 
 // NewFileTypeSecret creates a new FileTypeSecret
-//
 func NewFileTypeSecret() *FileTypeSecret {
 	fileTypeSecretTemp := FileTypeSecret{
 		tdCommon: tdCommon{Type: "fileTypeSecret"},
@@ -300,11 +922,107 @@ func NewFileTypeSecret() *FileTypeSecret {
 	return &fileTypeSecretTemp
 }
 
+// MarshalJSON marshals to json
+func (fileTypeSecret *FileTypeSecret) MarshalJSON() ([]byte, error) {
+	return fileTypeSecret.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (fileTypeSecret *FileTypeSecret) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, fileTypeSecret)
+}
+
+func (fileTypeSecret *FileTypeSecret) appendJSON(b []byte) ([]byte, error) {
+	if fileTypeSecret == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, fileTypeSecret.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, fileTypeSecret.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (fileTypeSecret *FileTypeSecret) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			fileTypeSecret.tdCommon.Type, err = r.readString()
+		case "@extra":
+			fileTypeSecret.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (fileTypeSecret *FileTypeSecret) Clone() *FileTypeSecret {
+	if fileTypeSecret == nil {
+		return nil
+	}
+	clone := *fileTypeSecret
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (fileTypeSecret *FileTypeSecret) Equal(other *FileTypeSecret) bool {
+	if fileTypeSecret == nil || other == nil {
+		return fileTypeSecret == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (fileTypeSecret *FileTypeSecret) Diff(other *FileTypeSecret) []Change {
+	return fileTypeSecret.diff("", other, nil)
+}
+
+func (fileTypeSecret *FileTypeSecret) diff(path string, other *FileTypeSecret, changes []Change) []Change {
+	if fileTypeSecret == nil || other == nil {
+		if fileTypeSecret != other {
+			changes = append(changes, Change{Path: path, Old: fileTypeSecret, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (fileTypeSecret *FileTypeSecret) String() string {
+	return objectString(fileTypeSecret)
+}
+
+// LogValue logs the object as its summary
+func (fileTypeSecret *FileTypeSecret) LogValue() slog.Value {
+	return slog.StringValue(objectString(fileTypeSecret))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (fileTypeSecret *FileTypeSecret) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, fileTypeSecret)
+}
+
+func (fileTypeSecret *FileTypeSecret) printText(p *printer) {
+	if fileTypeSecret == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("FileTypeSecret")
+	p.endObject(state)
+}
+
 // GetFileTypeEnum return the enum type of this object
 func (fileTypeSecret *FileTypeSecret) GetFileTypeEnum() FileTypeEnum {
 	return FileTypeSecretType
 }
 
+// Accept calls the method of the visitor for FileTypeSecret
+func (fileTypeSecret *FileTypeSecret) Accept(visitor FileTypeVisitor) {
+	visitor.VisitFileTypeSecret(fileTypeSecret)
+}
+
 // FileTypeSecretThumbnail The file is a thumbnail of a file from a secret chat
 type FileTypeSecretThumbnail struct {
 	tdCommon
@@ -316,7 +1034,6 @@ func (fileTypeSecretThumbnail *FileTypeSecretThumbnail) MessageType() string {
 }
 
 // NewFileTypeSecretThumbnail creates a new FileTypeSecretThumbnail
-//
 func NewFileTypeSecretThumbnail() *FileTypeSecretThumbnail {
 	fileTypeSecretThumbnailTemp := FileTypeSecretThumbnail{
 		tdCommon: tdCommon{Type: "fileTypeSecretThumbnail"},
@@ -325,11 +1042,107 @@ func NewFileTypeSecretThumbnail() *FileTypeSecretThumbnail {
 	return &fileTypeSecretThumbnailTemp
 }
 
+// MarshalJSON marshals to json
+func (fileTypeSecretThumbnail *FileTypeSecretThumbnail) MarshalJSON() ([]byte, error) {
+	return fileTypeSecretThumbnail.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (fileTypeSecretThumbnail *FileTypeSecretThumbnail) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, fileTypeSecretThumbnail)
+}
+
+func (fileTypeSecretThumbnail *FileTypeSecretThumbnail) appendJSON(b []byte) ([]byte, error) {
+	if fileTypeSecretThumbnail == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, fileTypeSecretThumbnail.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, fileTypeSecretThumbnail.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (fileTypeSecretThumbnail *FileTypeSecretThumbnail) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			fileTypeSecretThumbnail.tdCommon.Type, err = r.readString()
+		case "@extra":
+			fileTypeSecretThumbnail.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (fileTypeSecretThumbnail *FileTypeSecretThumbnail) Clone() *FileTypeSecretThumbnail {
+	if fileTypeSecretThumbnail == nil {
+		return nil
+	}
+	clone := *fileTypeSecretThumbnail
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (fileTypeSecretThumbnail *FileTypeSecretThumbnail) Equal(other *FileTypeSecretThumbnail) bool {
+	if fileTypeSecretThumbnail == nil || other == nil {
+		return fileTypeSecretThumbnail == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (fileTypeSecretThumbnail *FileTypeSecretThumbnail) Diff(other *FileTypeSecretThumbnail) []Change {
+	return fileTypeSecretThumbnail.diff("", other, nil)
+}
+
+func (fileTypeSecretThumbnail *FileTypeSecretThumbnail) diff(path string, other *FileTypeSecretThumbnail, changes []Change) []Change {
+	if fileTypeSecretThumbnail == nil || other == nil {
+		if fileTypeSecretThumbnail != other {
+			changes = append(changes, Change{Path: path, Old: fileTypeSecretThumbnail, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (fileTypeSecretThumbnail *FileTypeSecretThumbnail) String() string {
+	return objectString(fileTypeSecretThumbnail)
+}
+
+// LogValue logs the object as its summary
+func (fileTypeSecretThumbnail *FileTypeSecretThumbnail) LogValue() slog.Value {
+	return slog.StringValue(objectString(fileTypeSecretThumbnail))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (fileTypeSecretThumbnail *FileTypeSecretThumbnail) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, fileTypeSecretThumbnail)
+}
+
+func (fileTypeSecretThumbnail *FileTypeSecretThumbnail) printText(p *printer) {
+	if fileTypeSecretThumbnail == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("FileTypeSecretThumbnail")
+	p.endObject(state)
+}
+
 // GetFileTypeEnum return the enum type of this object
 func (fileTypeSecretThumbnail *FileTypeSecretThumbnail) GetFileTypeEnum() FileTypeEnum {
 	return FileTypeSecretThumbnailType
 }
 
+// Accept calls the method of the visitor for FileTypeSecretThumbnail
+func (fileTypeSecretThumbnail *FileTypeSecretThumbnail) Accept(visitor FileTypeVisitor) {
+	visitor.VisitFileTypeSecretThumbnail(fileTypeSecretThumbnail)
+}
+
 // FileTypeSecure The file is a file from Secure storage used for storing Telegram Passport files
 type FileTypeSecure struct {
 	tdCommon
@@ -341,7 +1154,6 @@ func (fileTypeSecure *FileTypeSecure) MessageType() string {
 }
 
 // NewFileTypeSecure creates a new FileTypeSecure
-//
 func NewFileTypeSecure() *FileTypeSecure {
 	fileTypeSecureTemp := FileTypeSecure{
 		tdCommon: tdCommon{Type: "fileTypeSecure"},
@@ -350,11 +1162,107 @@ func NewFileTypeSecure() *FileTypeSecure {
 	return &fileTypeSecureTemp
 }
 
+// MarshalJSON marshals to json
+func (fileTypeSecure *FileTypeSecure) MarshalJSON() ([]byte, error) {
+	return fileTypeSecure.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (fileTypeSecure *FileTypeSecure) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, fileTypeSecure)
+}
+
+func (fileTypeSecure *FileTypeSecure) appendJSON(b []byte) ([]byte, error) {
+	if fileTypeSecure == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, fileTypeSecure.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, fileTypeSecure.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (fileTypeSecure *FileTypeSecure) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			fileTypeSecure.tdCommon.Type, err = r.readString()
+		case "@extra":
+			fileTypeSecure.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (fileTypeSecure *FileTypeSecure) Clone() *FileTypeSecure {
+	if fileTypeSecure == nil {
+		return nil
+	}
+	clone := *fileTypeSecure
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (fileTypeSecure *FileTypeSecure) Equal(other *FileTypeSecure) bool {
+	if fileTypeSecure == nil || other == nil {
+		return fileTypeSecure == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (fileTypeSecure *FileTypeSecure) Diff(other *FileTypeSecure) []Change {
+	return fileTypeSecure.diff("", other, nil)
+}
+
+func (fileTypeSecure *FileTypeSecure) diff(path string, other *FileTypeSecure, changes []Change) []Change {
+	if fileTypeSecure == nil || other == nil {
+		if fileTypeSecure != other {
+			changes = append(changes, Change{Path: path, Old: fileTypeSecure, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (fileTypeSecure *FileTypeSecure) String() string {
+	return objectString(fileTypeSecure)
+}
+
+// LogValue logs the object as its summary
+func (fileTypeSecure *FileTypeSecure) LogValue() slog.Value {
+	return slog.StringValue(objectString(fileTypeSecure))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (fileTypeSecure *FileTypeSecure) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, fileTypeSecure)
+}
+
+func (fileTypeSecure *FileTypeSecure) printText(p *printer) {
+	if fileTypeSecure == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("FileTypeSecure")
+	p.endObject(state)
+}
+
 // GetFileTypeEnum return the enum type of this object
 func (fileTypeSecure *FileTypeSecure) GetFileTypeEnum() FileTypeEnum {
 	return FileTypeSecureType
 }
 
+// Accept calls the method of the visitor for FileTypeSecure
+func (fileTypeSecure *FileTypeSecure) Accept(visitor FileTypeVisitor) {
+	visitor.VisitFileTypeSecure(fileTypeSecure)
+}
+
 // FileTypeSticker The file is a sticker
 type FileTypeSticker struct {
 	tdCommon
@@ -366,7 +1274,6 @@ func (fileTypeSticker *FileTypeSticker) MessageType() string {
 }
 
 // NewFileTypeSticker creates a new FileTypeSticker
-//
 func NewFileTypeSticker() *FileTypeSticker {
 	fileTypeStickerTemp := FileTypeSticker{
 		tdCommon: tdCommon{Type: "fileTypeSticker"},
@@ -375,11 +1282,107 @@ func NewFileTypeSticker() *FileTypeSticker {
 	return &fileTypeStickerTemp
 }
 
+// MarshalJSON marshals to json
+func (fileTypeSticker *FileTypeSticker) MarshalJSON() ([]byte, error) {
+	return fileTypeSticker.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (fileTypeSticker *FileTypeSticker) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, fileTypeSticker)
+}
+
+func (fileTypeSticker *FileTypeSticker) appendJSON(b []byte) ([]byte, error) {
+	if fileTypeSticker == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, fileTypeSticker.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, fileTypeSticker.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (fileTypeSticker *FileTypeSticker) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			fileTypeSticker.tdCommon.Type, err = r.readString()
+		case "@extra":
+			fileTypeSticker.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (fileTypeSticker *FileTypeSticker) Clone() *FileTypeSticker {
+	if fileTypeSticker == nil {
+		return nil
+	}
+	clone := *fileTypeSticker
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (fileTypeSticker *FileTypeSticker) Equal(other *FileTypeSticker) bool {
+	if fileTypeSticker == nil || other == nil {
+		return fileTypeSticker == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (fileTypeSticker *FileTypeSticker) Diff(other *FileTypeSticker) []Change {
+	return fileTypeSticker.diff("", other, nil)
+}
+
+func (fileTypeSticker *FileTypeSticker) diff(path string, other *FileTypeSticker, changes []Change) []Change {
+	if fileTypeSticker == nil || other == nil {
+		if fileTypeSticker != other {
+			changes = append(changes, Change{Path: path, Old: fileTypeSticker, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (fileTypeSticker *FileTypeSticker) String() string {
+	return objectString(fileTypeSticker)
+}
+
+// LogValue logs the object as its summary
+func (fileTypeSticker *FileTypeSticker) LogValue() slog.Value {
+	return slog.StringValue(objectString(fileTypeSticker))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (fileTypeSticker *FileTypeSticker) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, fileTypeSticker)
+}
+
+func (fileTypeSticker *FileTypeSticker) printText(p *printer) {
+	if fileTypeSticker == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("FileTypeSticker")
+	p.endObject(state)
+}
+
 // GetFileTypeEnum return the enum type of this object
 func (fileTypeSticker *FileTypeSticker) GetFileTypeEnum() FileTypeEnum {
 	return FileTypeStickerType
 }
 
+// Accept calls the method of the visitor for FileTypeSticker
+func (fileTypeSticker *FileTypeSticker) Accept(visitor FileTypeVisitor) {
+	visitor.VisitFileTypeSticker(fileTypeSticker)
+}
+
 // FileTypeThumbnail The file is a thumbnail of another file
 type FileTypeThumbnail struct {
 	tdCommon
@@ -391,7 +1394,6 @@ func (fileTypeThumbnail *FileTypeThumbnail) MessageType() string {
 }
 
 // NewFileTypeThumbnail creates a new FileTypeThumbnail
-//
 func NewFileTypeThumbnail() *FileTypeThumbnail {
 	fileTypeThumbnailTemp := FileTypeThumbnail{
 		tdCommon: tdCommon{Type: "fileTypeThumbnail"},
@@ -400,11 +1402,107 @@ func NewFileTypeThumbnail() *FileTypeThumbnail {
 	return &fileTypeThumbnailTemp
 }
 
+// MarshalJSON marshals to json
+func (fileTypeThumbnail *FileTypeThumbnail) MarshalJSON() ([]byte, error) {
+	return fileTypeThumbnail.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (fileTypeThumbnail *FileTypeThumbnail) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, fileTypeThumbnail)
+}
+
+func (fileTypeThumbnail *FileTypeThumbnail) appendJSON(b []byte) ([]byte, error) {
+	if fileTypeThumbnail == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, fileTypeThumbnail.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, fileTypeThumbnail.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (fileTypeThumbnail *FileTypeThumbnail) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			fileTypeThumbnail.tdCommon.Type, err = r.readString()
+		case "@extra":
+			fileTypeThumbnail.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (fileTypeThumbnail *FileTypeThumbnail) Clone() *FileTypeThumbnail {
+	if fileTypeThumbnail == nil {
+		return nil
+	}
+	clone := *fileTypeThumbnail
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (fileTypeThumbnail *FileTypeThumbnail) Equal(other *FileTypeThumbnail) bool {
+	if fileTypeThumbnail == nil || other == nil {
+		return fileTypeThumbnail == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (fileTypeThumbnail *FileTypeThumbnail) Diff(other *FileTypeThumbnail) []Change {
+	return fileTypeThumbnail.diff("", other, nil)
+}
+
+func (fileTypeThumbnail *FileTypeThumbnail) diff(path string, other *FileTypeThumbnail, changes []Change) []Change {
+	if fileTypeThumbnail == nil || other == nil {
+		if fileTypeThumbnail != other {
+			changes = append(changes, Change{Path: path, Old: fileTypeThumbnail, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (fileTypeThumbnail *FileTypeThumbnail) String() string {
+	return objectString(fileTypeThumbnail)
+}
+
+// LogValue logs the object as its summary
+func (fileTypeThumbnail *FileTypeThumbnail) LogValue() slog.Value {
+	return slog.StringValue(objectString(fileTypeThumbnail))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (fileTypeThumbnail *FileTypeThumbnail) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, fileTypeThumbnail)
+}
+
+func (fileTypeThumbnail *FileTypeThumbnail) printText(p *printer) {
+	if fileTypeThumbnail == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("FileTypeThumbnail")
+	p.endObject(state)
+}
+
 // GetFileTypeEnum return the enum type of this object
 func (fileTypeThumbnail *FileTypeThumbnail) GetFileTypeEnum() FileTypeEnum {
 	return FileTypeThumbnailType
 }
 
+// Accept calls the method of the visitor for FileTypeThumbnail
+func (fileTypeThumbnail *FileTypeThumbnail) Accept(visitor FileTypeVisitor) {
+	visitor.VisitFileTypeThumbnail(fileTypeThumbnail)
+}
+
 // FileTypeUnknown The file type is not yet known
 type FileTypeUnknown struct {
 	tdCommon
@@ -416,7 +1514,6 @@ func (fileTypeUnknown *FileTypeUnknown) MessageType() string {
 }
 
 // NewFileTypeUnknown creates a new FileTypeUnknown
-//
 func NewFileTypeUnknown() *FileTypeUnknown {
 	fileTypeUnknownTemp := FileTypeUnknown{
 		tdCommon: tdCommon{Type: "fileTypeUnknown"},
@@ -425,11 +1522,107 @@ func NewFileTypeUnknown() *FileTypeUnknown {
 	return &fileTypeUnknownTemp
 }
 
+// MarshalJSON marshals to json
+func (fileTypeUnknown *FileTypeUnknown) MarshalJSON() ([]byte, error) {
+	return fileTypeUnknown.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (fileTypeUnknown *FileTypeUnknown) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, fileTypeUnknown)
+}
+
+func (fileTypeUnknown *FileTypeUnknown) appendJSON(b []byte) ([]byte, error) {
+	if fileTypeUnknown == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, fileTypeUnknown.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, fileTypeUnknown.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (fileTypeUnknown *FileTypeUnknown) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			fileTypeUnknown.tdCommon.Type, err = r.readString()
+		case "@extra":
+			fileTypeUnknown.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (fileTypeUnknown *FileTypeUnknown) Clone() *FileTypeUnknown {
+	if fileTypeUnknown == nil {
+		return nil
+	}
+	clone := *fileTypeUnknown
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (fileTypeUnknown *FileTypeUnknown) Equal(other *FileTypeUnknown) bool {
+	if fileTypeUnknown == nil || other == nil {
+		return fileTypeUnknown == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (fileTypeUnknown *FileTypeUnknown) Diff(other *FileTypeUnknown) []Change {
+	return fileTypeUnknown.diff("", other, nil)
+}
+
+func (fileTypeUnknown *FileTypeUnknown) diff(path string, other *FileTypeUnknown, changes []Change) []Change {
+	if fileTypeUnknown == nil || other == nil {
+		if fileTypeUnknown != other {
+			changes = append(changes, Change{Path: path, Old: fileTypeUnknown, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (fileTypeUnknown *FileTypeUnknown) String() string {
+	return objectString(fileTypeUnknown)
+}
+
+// LogValue logs the object as its summary
+func (fileTypeUnknown *FileTypeUnknown) LogValue() slog.Value {
+	return slog.StringValue(objectString(fileTypeUnknown))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (fileTypeUnknown *FileTypeUnknown) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, fileTypeUnknown)
+}
+
+func (fileTypeUnknown *FileTypeUnknown) printText(p *printer) {
+	if fileTypeUnknown == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("FileTypeUnknown")
+	p.endObject(state)
+}
+
 // GetFileTypeEnum return the enum type of this object
 func (fileTypeUnknown *FileTypeUnknown) GetFileTypeEnum() FileTypeEnum {
 	return FileTypeUnknownType
 }
 
+// Accept calls the method of the visitor for FileTypeUnknown
+func (fileTypeUnknown *FileTypeUnknown) Accept(visitor FileTypeVisitor) {
+	visitor.VisitFileTypeUnknown(fileTypeUnknown)
+}
+
 // FileTypeVideo The file is a video
 type FileTypeVideo struct {
 	tdCommon
@@ -441,7 +1634,6 @@ func (fileTypeVideo *FileTypeVideo) MessageType() string {
 }
 
 // NewFileTypeVideo creates a new FileTypeVideo
-//
 func NewFileTypeVideo() *FileTypeVideo {
 	fileTypeVideoTemp := FileTypeVideo{
 		tdCommon: tdCommon{Type: "fileTypeVideo"},
@@ -450,11 +1642,107 @@ func NewFileTypeVideo() *FileTypeVideo {
 	return &fileTypeVideoTemp
 }
 
+// MarshalJSON marshals to json
+func (fileTypeVideo *FileTypeVideo) MarshalJSON() ([]byte, error) {
+	return fileTypeVideo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (fileTypeVideo *FileTypeVideo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, fileTypeVideo)
+}
+
+func (fileTypeVideo *FileTypeVideo) appendJSON(b []byte) ([]byte, error) {
+	if fileTypeVideo == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, fileTypeVideo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, fileTypeVideo.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (fileTypeVideo *FileTypeVideo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			fileTypeVideo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			fileTypeVideo.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (fileTypeVideo *FileTypeVideo) Clone() *FileTypeVideo {
+	if fileTypeVideo == nil {
+		return nil
+	}
+	clone := *fileTypeVideo
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (fileTypeVideo *FileTypeVideo) Equal(other *FileTypeVideo) bool {
+	if fileTypeVideo == nil || other == nil {
+		return fileTypeVideo == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (fileTypeVideo *FileTypeVideo) Diff(other *FileTypeVideo) []Change {
+	return fileTypeVideo.diff("", other, nil)
+}
+
+func (fileTypeVideo *FileTypeVideo) diff(path string, other *FileTypeVideo, changes []Change) []Change {
+	if fileTypeVideo == nil || other == nil {
+		if fileTypeVideo != other {
+			changes = append(changes, Change{Path: path, Old: fileTypeVideo, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (fileTypeVideo *FileTypeVideo) String() string {
+	return objectString(fileTypeVideo)
+}
+
+// LogValue logs the object as its summary
+func (fileTypeVideo *FileTypeVideo) LogValue() slog.Value {
+	return slog.StringValue(objectString(fileTypeVideo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (fileTypeVideo *FileTypeVideo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, fileTypeVideo)
+}
+
+func (fileTypeVideo *FileTypeVideo) printText(p *printer) {
+	if fileTypeVideo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("FileTypeVideo")
+	p.endObject(state)
+}
+
 // GetFileTypeEnum return the enum type of this object
 func (fileTypeVideo *FileTypeVideo) GetFileTypeEnum() FileTypeEnum {
 	return FileTypeVideoType
 }
 
+// Accept calls the method of the visitor for FileTypeVideo
+func (fileTypeVideo *FileTypeVideo) Accept(visitor FileTypeVisitor) {
+	visitor.VisitFileTypeVideo(fileTypeVideo)
+}
+
 // FileTypeVideoNote The file is a video note
 type FileTypeVideoNote struct {
 	tdCommon
@@ -466,7 +1754,6 @@ func (fileTypeVideoNote *FileTypeVideoNote) MessageType() string {
 }
 
 // NewFileTypeVideoNote creates a new FileTypeVideoNote
-//
 func NewFileTypeVideoNote() *FileTypeVideoNote {
 	fileTypeVideoNoteTemp := FileTypeVideoNote{
 		tdCommon: tdCommon{Type: "fileTypeVideoNote"},
@@ -475,11 +1762,107 @@ func NewFileTypeVideoNote() *FileTypeVideoNote {
 	return &fileTypeVideoNoteTemp
 }
 
+// MarshalJSON marshals to json
+func (fileTypeVideoNote *FileTypeVideoNote) MarshalJSON() ([]byte, error) {
+	return fileTypeVideoNote.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (fileTypeVideoNote *FileTypeVideoNote) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, fileTypeVideoNote)
+}
+
+func (fileTypeVideoNote *FileTypeVideoNote) appendJSON(b []byte) ([]byte, error) {
+	if fileTypeVideoNote == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, fileTypeVideoNote.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, fileTypeVideoNote.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (fileTypeVideoNote *FileTypeVideoNote) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			fileTypeVideoNote.tdCommon.Type, err = r.readString()
+		case "@extra":
+			fileTypeVideoNote.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (fileTypeVideoNote *FileTypeVideoNote) Clone() *FileTypeVideoNote {
+	if fileTypeVideoNote == nil {
+		return nil
+	}
+	clone := *fileTypeVideoNote
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (fileTypeVideoNote *FileTypeVideoNote) Equal(other *FileTypeVideoNote) bool {
+	if fileTypeVideoNote == nil || other == nil {
+		return fileTypeVideoNote == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (fileTypeVideoNote *FileTypeVideoNote) Diff(other *FileTypeVideoNote) []Change {
+	return fileTypeVideoNote.diff("", other, nil)
+}
+
+func (fileTypeVideoNote *FileTypeVideoNote) diff(path string, other *FileTypeVideoNote, changes []Change) []Change {
+	if fileTypeVideoNote == nil || other == nil {
+		if fileTypeVideoNote != other {
+			changes = append(changes, Change{Path: path, Old: fileTypeVideoNote, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (fileTypeVideoNote *FileTypeVideoNote) String() string {
+	return objectString(fileTypeVideoNote)
+}
+
+// LogValue logs the object as its summary
+func (fileTypeVideoNote *FileTypeVideoNote) LogValue() slog.Value {
+	return slog.StringValue(objectString(fileTypeVideoNote))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (fileTypeVideoNote *FileTypeVideoNote) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, fileTypeVideoNote)
+}
+
+func (fileTypeVideoNote *FileTypeVideoNote) printText(p *printer) {
+	if fileTypeVideoNote == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("FileTypeVideoNote")
+	p.endObject(state)
+}
+
 // GetFileTypeEnum return the enum type of this object
 func (fileTypeVideoNote *FileTypeVideoNote) GetFileTypeEnum() FileTypeEnum {
 	return FileTypeVideoNoteType
 }
 
+// Accept calls the method of the visitor for FileTypeVideoNote
+func (fileTypeVideoNote *FileTypeVideoNote) Accept(visitor FileTypeVisitor) {
+	visitor.VisitFileTypeVideoNote(fileTypeVideoNote)
+}
+
 // FileTypeVoiceNote The file is a voice note
 type FileTypeVoiceNote struct {
 	tdCommon
@@ -491,7 +1874,6 @@ func (fileTypeVoiceNote *FileTypeVoiceNote) MessageType() string {
 }
 
 // NewFileTypeVoiceNote creates a new FileTypeVoiceNote
-//
 func NewFileTypeVoiceNote() *FileTypeVoiceNote {
 	fileTypeVoiceNoteTemp := FileTypeVoiceNote{
 		tdCommon: tdCommon{Type: "fileTypeVoiceNote"},
@@ -500,11 +1882,107 @@ func NewFileTypeVoiceNote() *FileTypeVoiceNote {
 	return &fileTypeVoiceNoteTemp
 }
 
+// MarshalJSON marshals to json
+func (fileTypeVoiceNote *FileTypeVoiceNote) MarshalJSON() ([]byte, error) {
+	return fileTypeVoiceNote.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (fileTypeVoiceNote *FileTypeVoiceNote) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, fileTypeVoiceNote)
+}
+
+func (fileTypeVoiceNote *FileTypeVoiceNote) appendJSON(b []byte) ([]byte, error) {
+	if fileTypeVoiceNote == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, fileTypeVoiceNote.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, fileTypeVoiceNote.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (fileTypeVoiceNote *FileTypeVoiceNote) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			fileTypeVoiceNote.tdCommon.Type, err = r.readString()
+		case "@extra":
+			fileTypeVoiceNote.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (fileTypeVoiceNote *FileTypeVoiceNote) Clone() *FileTypeVoiceNote {
+	if fileTypeVoiceNote == nil {
+		return nil
+	}
+	clone := *fileTypeVoiceNote
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (fileTypeVoiceNote *FileTypeVoiceNote) Equal(other *FileTypeVoiceNote) bool {
+	if fileTypeVoiceNote == nil || other == nil {
+		return fileTypeVoiceNote == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (fileTypeVoiceNote *FileTypeVoiceNote) Diff(other *FileTypeVoiceNote) []Change {
+	return fileTypeVoiceNote.diff("", other, nil)
+}
+
+func (fileTypeVoiceNote *FileTypeVoiceNote) diff(path string, other *FileTypeVoiceNote, changes []Change) []Change {
+	if fileTypeVoiceNote == nil || other == nil {
+		if fileTypeVoiceNote != other {
+			changes = append(changes, Change{Path: path, Old: fileTypeVoiceNote, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (fileTypeVoiceNote *FileTypeVoiceNote) String() string {
+	return objectString(fileTypeVoiceNote)
+}
+
+// LogValue logs the object as its summary
+func (fileTypeVoiceNote *FileTypeVoiceNote) LogValue() slog.Value {
+	return slog.StringValue(objectString(fileTypeVoiceNote))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (fileTypeVoiceNote *FileTypeVoiceNote) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, fileTypeVoiceNote)
+}
+
+func (fileTypeVoiceNote *FileTypeVoiceNote) printText(p *printer) {
+	if fileTypeVoiceNote == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("FileTypeVoiceNote")
+	p.endObject(state)
+}
+
 // GetFileTypeEnum return the enum type of this object
 func (fileTypeVoiceNote *FileTypeVoiceNote) GetFileTypeEnum() FileTypeEnum {
 	return FileTypeVoiceNoteType
 }
 
+// Accept calls the method of the visitor for FileTypeVoiceNote
+func (fileTypeVoiceNote *FileTypeVoiceNote) Accept(visitor FileTypeVisitor) {
+	visitor.VisitFileTypeVoiceNote(fileTypeVoiceNote)
+}
+
 // FileTypeWallpaper The file is a wallpaper or a background pattern
 type FileTypeWallpaper struct {
 	tdCommon
@@ -516,7 +1994,6 @@ func (fileTypeWallpaper *FileTypeWallpaper) MessageType() string {
 }
 
 // NewFileTypeWallpaper creates a new FileTypeWallpaper
-//
 func NewFileTypeWallpaper() *FileTypeWallpaper {
 	fileTypeWallpaperTemp := FileTypeWallpaper{
 		tdCommon: tdCommon{Type: "fileTypeWallpaper"},
@@ -525,7 +2002,408 @@ func NewFileTypeWallpaper() *FileTypeWallpaper {
 	return &fileTypeWallpaperTemp
 }
 
+// MarshalJSON marshals to json
+func (fileTypeWallpaper *FileTypeWallpaper) MarshalJSON() ([]byte, error) {
+	return fileTypeWallpaper.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (fileTypeWallpaper *FileTypeWallpaper) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, fileTypeWallpaper)
+}
+
+func (fileTypeWallpaper *FileTypeWallpaper) appendJSON(b []byte) ([]byte, error) {
+	if fileTypeWallpaper == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, fileTypeWallpaper.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, fileTypeWallpaper.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (fileTypeWallpaper *FileTypeWallpaper) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			fileTypeWallpaper.tdCommon.Type, err = r.readString()
+		case "@extra":
+			fileTypeWallpaper.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (fileTypeWallpaper *FileTypeWallpaper) Clone() *FileTypeWallpaper {
+	if fileTypeWallpaper == nil {
+		return nil
+	}
+	clone := *fileTypeWallpaper
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (fileTypeWallpaper *FileTypeWallpaper) Equal(other *FileTypeWallpaper) bool {
+	if fileTypeWallpaper == nil || other == nil {
+		return fileTypeWallpaper == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (fileTypeWallpaper *FileTypeWallpaper) Diff(other *FileTypeWallpaper) []Change {
+	return fileTypeWallpaper.diff("", other, nil)
+}
+
+func (fileTypeWallpaper *FileTypeWallpaper) diff(path string, other *FileTypeWallpaper, changes []Change) []Change {
+	if fileTypeWallpaper == nil || other == nil {
+		if fileTypeWallpaper != other {
+			changes = append(changes, Change{Path: path, Old: fileTypeWallpaper, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (fileTypeWallpaper *FileTypeWallpaper) String() string {
+	return objectString(fileTypeWallpaper)
+}
+
+// LogValue logs the object as its summary
+func (fileTypeWallpaper *FileTypeWallpaper) LogValue() slog.Value {
+	return slog.StringValue(objectString(fileTypeWallpaper))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (fileTypeWallpaper *FileTypeWallpaper) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, fileTypeWallpaper)
+}
+
+func (fileTypeWallpaper *FileTypeWallpaper) printText(p *printer) {
+	if fileTypeWallpaper == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("FileTypeWallpaper")
+	p.endObject(state)
+}
+
 // GetFileTypeEnum return the enum type of this object
 func (fileTypeWallpaper *FileTypeWallpaper) GetFileTypeEnum() FileTypeEnum {
 	return FileTypeWallpaperType
 }
+
+// Accept calls the method of the visitor for FileTypeWallpaper
+func (fileTypeWallpaper *FileTypeWallpaper) Accept(visitor FileTypeVisitor) {
+	visitor.VisitFileTypeWallpaper(fileTypeWallpaper)
+}
+
+// FileTypeVisitor visits every type of FileType, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to FileType, until they handle it too.
+type FileTypeVisitor interface {
+	VisitFileTypeNone(fileTypeNone *FileTypeNone)
+	VisitFileTypeAnimation(fileTypeAnimation *FileTypeAnimation)
+	VisitFileTypeAudio(fileTypeAudio *FileTypeAudio)
+	VisitFileTypeDocument(fileTypeDocument *FileTypeDocument)
+	VisitFileTypePhoto(fileTypePhoto *FileTypePhoto)
+	VisitFileTypeProfilePhoto(fileTypeProfilePhoto *FileTypeProfilePhoto)
+	VisitFileTypeSecret(fileTypeSecret *FileTypeSecret)
+	VisitFileTypeSecretThumbnail(fileTypeSecretThumbnail *FileTypeSecretThumbnail)
+	VisitFileTypeSecure(fileTypeSecure *FileTypeSecure)
+	VisitFileTypeSticker(fileTypeSticker *FileTypeSticker)
+	VisitFileTypeThumbnail(fileTypeThumbnail *FileTypeThumbnail)
+	VisitFileTypeUnknown(fileTypeUnknown *FileTypeUnknown)
+	VisitFileTypeVideo(fileTypeVideo *FileTypeVideo)
+	VisitFileTypeVideoNote(fileTypeVideoNote *FileTypeVideoNote)
+	VisitFileTypeVoiceNote(fileTypeVoiceNote *FileTypeVoiceNote)
+	VisitFileTypeWallpaper(fileTypeWallpaper *FileTypeWallpaper)
+	VisitUnknownFileType(unknownFileType *UnknownFileType)
+}
+
+// FileTypeCases holds the function MatchFileType calls for each type of FileType;
+// types without a function are passed to the default case
+type FileTypeCases[R any] struct {
+	FileTypeNone            func(fileTypeNone *FileTypeNone) R
+	FileTypeAnimation       func(fileTypeAnimation *FileTypeAnimation) R
+	FileTypeAudio           func(fileTypeAudio *FileTypeAudio) R
+	FileTypeDocument        func(fileTypeDocument *FileTypeDocument) R
+	FileTypePhoto           func(fileTypePhoto *FileTypePhoto) R
+	FileTypeProfilePhoto    func(fileTypeProfilePhoto *FileTypeProfilePhoto) R
+	FileTypeSecret          func(fileTypeSecret *FileTypeSecret) R
+	FileTypeSecretThumbnail func(fileTypeSecretThumbnail *FileTypeSecretThumbnail) R
+	FileTypeSecure          func(fileTypeSecure *FileTypeSecure) R
+	FileTypeSticker         func(fileTypeSticker *FileTypeSticker) R
+	FileTypeThumbnail       func(fileTypeThumbnail *FileTypeThumbnail) R
+	FileTypeUnknown         func(fileTypeUnknown *FileTypeUnknown) R
+	FileTypeVideo           func(fileTypeVideo *FileTypeVideo) R
+	FileTypeVideoNote       func(fileTypeVideoNote *FileTypeVideoNote) R
+	FileTypeVoiceNote       func(fileTypeVoiceNote *FileTypeVoiceNote) R
+	FileTypeWallpaper       func(fileTypeWallpaper *FileTypeWallpaper) R
+}
+
+// MatchFileType calls the case of the type of fileType, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement FileTypeVisitor
+// to have the compiler check every type is handled.
+func MatchFileType[R any](fileType FileType, cases FileTypeCases[R], defaultCase func(fileType FileType) R) R {
+	switch value := fileType.(type) {
+	case *FileTypeNone:
+		if cases.FileTypeNone != nil {
+			return cases.FileTypeNone(value)
+		}
+	case *FileTypeAnimation:
+		if cases.FileTypeAnimation != nil {
+			return cases.FileTypeAnimation(value)
+		}
+	case *FileTypeAudio:
+		if cases.FileTypeAudio != nil {
+			return cases.FileTypeAudio(value)
+		}
+	case *FileTypeDocument:
+		if cases.FileTypeDocument != nil {
+			return cases.FileTypeDocument(value)
+		}
+	case *FileTypePhoto:
+		if cases.FileTypePhoto != nil {
+			return cases.FileTypePhoto(value)
+		}
+	case *FileTypeProfilePhoto:
+		if cases.FileTypeProfilePhoto != nil {
+			return cases.FileTypeProfilePhoto(value)
+		}
+	case *FileTypeSecret:
+		if cases.FileTypeSecret != nil {
+			return cases.FileTypeSecret(value)
+		}
+	case *FileTypeSecretThumbnail:
+		if cases.FileTypeSecretThumbnail != nil {
+			return cases.FileTypeSecretThumbnail(value)
+		}
+	case *FileTypeSecure:
+		if cases.FileTypeSecure != nil {
+			return cases.FileTypeSecure(value)
+		}
+	case *FileTypeSticker:
+		if cases.FileTypeSticker != nil {
+			return cases.FileTypeSticker(value)
+		}
+	case *FileTypeThumbnail:
+		if cases.FileTypeThumbnail != nil {
+			return cases.FileTypeThumbnail(value)
+		}
+	case *FileTypeUnknown:
+		if cases.FileTypeUnknown != nil {
+			return cases.FileTypeUnknown(value)
+		}
+	case *FileTypeVideo:
+		if cases.FileTypeVideo != nil {
+			return cases.FileTypeVideo(value)
+		}
+	case *FileTypeVideoNote:
+		if cases.FileTypeVideoNote != nil {
+			return cases.FileTypeVideoNote(value)
+		}
+	case *FileTypeVoiceNote:
+		if cases.FileTypeVoiceNote != nil {
+			return cases.FileTypeVoiceNote(value)
+		}
+	case *FileTypeWallpaper:
+		if cases.FileTypeWallpaper != nil {
+			return cases.FileTypeWallpaper(value)
+		}
+	}
+	return defaultCase(fileType)
+}
+
+// Clone returns a deep copy of the object
+func (unknownFileType *UnknownFileType) Clone() *UnknownFileType {
+	if unknownFileType == nil {
+		return nil
+	}
+	return &UnknownFileType{Unknown: unknownFileType.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownFileType *UnknownFileType) Equal(other *UnknownFileType) bool {
+	if unknownFileType == nil || other == nil {
+		return unknownFileType == other
+	}
+	return unknownFileType.Unknown.equal(other.Unknown)
+}
+
+func cloneFileType(fileType FileType) FileType {
+	switch value := fileType.(type) {
+	case *FileTypeNone:
+		return value.Clone()
+	case *FileTypeAnimation:
+		return value.Clone()
+	case *FileTypeAudio:
+		return value.Clone()
+	case *FileTypeDocument:
+		return value.Clone()
+	case *FileTypePhoto:
+		return value.Clone()
+	case *FileTypeProfilePhoto:
+		return value.Clone()
+	case *FileTypeSecret:
+		return value.Clone()
+	case *FileTypeSecretThumbnail:
+		return value.Clone()
+	case *FileTypeSecure:
+		return value.Clone()
+	case *FileTypeSticker:
+		return value.Clone()
+	case *FileTypeThumbnail:
+		return value.Clone()
+	case *FileTypeUnknown:
+		return value.Clone()
+	case *FileTypeVideo:
+		return value.Clone()
+	case *FileTypeVideoNote:
+		return value.Clone()
+	case *FileTypeVoiceNote:
+		return value.Clone()
+	case *FileTypeWallpaper:
+		return value.Clone()
+	case *UnknownFileType:
+		return value.Clone()
+	}
+	return fileType
+}
+
+func equalFileType(a FileType, b FileType) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *FileTypeNone:
+		b, ok := b.(*FileTypeNone)
+		return ok && a.Equal(b)
+	case *FileTypeAnimation:
+		b, ok := b.(*FileTypeAnimation)
+		return ok && a.Equal(b)
+	case *FileTypeAudio:
+		b, ok := b.(*FileTypeAudio)
+		return ok && a.Equal(b)
+	case *FileTypeDocument:
+		b, ok := b.(*FileTypeDocument)
+		return ok && a.Equal(b)
+	case *FileTypePhoto:
+		b, ok := b.(*FileTypePhoto)
+		return ok && a.Equal(b)
+	case *FileTypeProfilePhoto:
+		b, ok := b.(*FileTypeProfilePhoto)
+		return ok && a.Equal(b)
+	case *FileTypeSecret:
+		b, ok := b.(*FileTypeSecret)
+		return ok && a.Equal(b)
+	case *FileTypeSecretThumbnail:
+		b, ok := b.(*FileTypeSecretThumbnail)
+		return ok && a.Equal(b)
+	case *FileTypeSecure:
+		b, ok := b.(*FileTypeSecure)
+		return ok && a.Equal(b)
+	case *FileTypeSticker:
+		b, ok := b.(*FileTypeSticker)
+		return ok && a.Equal(b)
+	case *FileTypeThumbnail:
+		b, ok := b.(*FileTypeThumbnail)
+		return ok && a.Equal(b)
+	case *FileTypeUnknown:
+		b, ok := b.(*FileTypeUnknown)
+		return ok && a.Equal(b)
+	case *FileTypeVideo:
+		b, ok := b.(*FileTypeVideo)
+		return ok && a.Equal(b)
+	case *FileTypeVideoNote:
+		b, ok := b.(*FileTypeVideoNote)
+		return ok && a.Equal(b)
+	case *FileTypeVoiceNote:
+		b, ok := b.(*FileTypeVoiceNote)
+		return ok && a.Equal(b)
+	case *FileTypeWallpaper:
+		b, ok := b.(*FileTypeWallpaper)
+		return ok && a.Equal(b)
+	case *UnknownFileType:
+		b, ok := b.(*UnknownFileType)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffFileType(path string, a FileType, b FileType, changes []Change) []Change {
+	switch a := a.(type) {
+	case *FileTypeNone:
+		if b, ok := b.(*FileTypeNone); ok {
+			return a.diff(path, b, changes)
+		}
+	case *FileTypeAnimation:
+		if b, ok := b.(*FileTypeAnimation); ok {
+			return a.diff(path, b, changes)
+		}
+	case *FileTypeAudio:
+		if b, ok := b.(*FileTypeAudio); ok {
+			return a.diff(path, b, changes)
+		}
+	case *FileTypeDocument:
+		if b, ok := b.(*FileTypeDocument); ok {
+			return a.diff(path, b, changes)
+		}
+	case *FileTypePhoto:
+		if b, ok := b.(*FileTypePhoto); ok {
+			return a.diff(path, b, changes)
+		}
+	case *FileTypeProfilePhoto:
+		if b, ok := b.(*FileTypeProfilePhoto); ok {
+			return a.diff(path, b, changes)
+		}
+	case *FileTypeSecret:
+		if b, ok := b.(*FileTypeSecret); ok {
+			return a.diff(path, b, changes)
+		}
+	case *FileTypeSecretThumbnail:
+		if b, ok := b.(*FileTypeSecretThumbnail); ok {
+			return a.diff(path, b, changes)
+		}
+	case *FileTypeSecure:
+		if b, ok := b.(*FileTypeSecure); ok {
+			return a.diff(path, b, changes)
+		}
+	case *FileTypeSticker:
+		if b, ok := b.(*FileTypeSticker); ok {
+			return a.diff(path, b, changes)
+		}
+	case *FileTypeThumbnail:
+		if b, ok := b.(*FileTypeThumbnail); ok {
+			return a.diff(path, b, changes)
+		}
+	case *FileTypeUnknown:
+		if b, ok := b.(*FileTypeUnknown); ok {
+			return a.diff(path, b, changes)
+		}
+	case *FileTypeVideo:
+		if b, ok := b.(*FileTypeVideo); ok {
+			return a.diff(path, b, changes)
+		}
+	case *FileTypeVideoNote:
+		if b, ok := b.(*FileTypeVideoNote); ok {
+			return a.diff(path, b, changes)
+		}
+	case *FileTypeVoiceNote:
+		if b, ok := b.(*FileTypeVoiceNote); ok {
+			return a.diff(path, b, changes)
+		}
+	case *FileTypeWallpaper:
+		if b, ok := b.(*FileTypeWallpaper); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalFileType(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // FormattedText A text with some entities
@@ -31,6 +31,161 @@ func NewFormattedText(text string, entities []TextEntity) *FormattedText {
 	return &formattedTextTemp
 }
 
+// MarshalJSON marshals to json
+func (formattedText *FormattedText) MarshalJSON() ([]byte, error) {
+	return formattedText.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (formattedText *FormattedText) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, formattedText)
+}
+
+func (formattedText *FormattedText) appendJSON(b []byte) ([]byte, error) {
+	if formattedText == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, formattedText.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, formattedText.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b = appendJSONString(b, formattedText.Text)
+	b = append(b, `,"entities":`...)
+	if formattedText.Entities == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range formattedText.Entities {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = formattedText.Entities[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (formattedText *FormattedText) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			formattedText.tdCommon.Type, err = r.readString()
+		case "@extra":
+			formattedText.tdCommon.Extra, err = r.readString()
+		case "text":
+			formattedText.Text, err = r.readString()
+		case "entities":
+			if r.readNull() {
+				formattedText.Entities = nil
+			} else {
+				formattedText.Entities = make([]TextEntity, 0)
+				err = r.readArray(func() (err error) {
+					var item0 TextEntity
+					err = item0.decodeJSON(r)
+					formattedText.Entities = append(formattedText.Entities, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (formattedText *FormattedText) Clone() *FormattedText {
+	if formattedText == nil {
+		return nil
+	}
+	clone := *formattedText
+	if formattedText.Entities != nil {
+		clone.Entities = make([]TextEntity, len(formattedText.Entities))
+		for i0 := range formattedText.Entities {
+			clone.Entities[i0] = *formattedText.Entities[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (formattedText *FormattedText) Equal(other *FormattedText) bool {
+	if formattedText == nil || other == nil {
+		return formattedText == other
+	}
+	if formattedText.Text != other.Text {
+		return false
+	}
+	if len(formattedText.Entities) != len(other.Entities) {
+		return false
+	}
+	for i0 := range formattedText.Entities {
+		if !formattedText.Entities[i0].Equal(&other.Entities[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (formattedText *FormattedText) Diff(other *FormattedText) []Change {
+	return formattedText.diff("", other, nil)
+}
+
+func (formattedText *FormattedText) diff(path string, other *FormattedText, changes []Change) []Change {
+	if formattedText == nil || other == nil {
+		if formattedText != other {
+			changes = append(changes, Change{Path: path, Old: formattedText, New: other})
+		}
+		return changes
+	}
+	if formattedText.Text != other.Text {
+		changes = append(changes, Change{Path: fieldPath(path, "text"), Old: formattedText.Text, New: other.Text})
+	}
+	if len(formattedText.Entities) != len(other.Entities) {
+		changes = append(changes, Change{Path: fieldPath(path, "entities"), Old: formattedText.Entities, New: other.Entities})
+	} else {
+		for i0 := range formattedText.Entities {
+			changes = formattedText.Entities[i0].diff(indexPath(fieldPath(path, "entities"), i0), &other.Entities[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (formattedText *FormattedText) String() string {
+	return objectString(formattedText)
+}
+
+// LogValue logs the object as its summary
+func (formattedText *FormattedText) LogValue() slog.Value {
+	return slog.StringValue(objectString(formattedText))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (formattedText *FormattedText) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, formattedText)
+}
+
+func (formattedText *FormattedText) printText(p *printer) {
+	if formattedText == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("FormattedText")
+	p.string("text", formattedText.Text)
+	p.vector("entities", len(formattedText.Entities), func(i0 int) {
+		formattedText.Entities[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // ParseTextEntities Parses Bold, Italic, Underline, Strikethrough, Spoiler, Code, Pre, PreCode, TextUrl and MentionName entities contained in the text. Can be called synchronously
 // @param text The text to parse
 // @param parseMode Text parse mode
@@ -45,12 +200,35 @@ func (client *Client) ParseTextEntities(text string, parseMode TextParseMode) (*
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
+		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
+	}
+
+	var formattedText FormattedText
+	err = jsonUnmarshal(result.Raw, &formattedText)
+	return &formattedText, err
+}
+
+// ExecuteParseTextEntities Synchronously executes ParseTextEntities, without a client. Parses Bold, Italic, Underline, Strikethrough, Spoiler, Code, Pre, PreCode, TextUrl and MentionName entities contained in the text. Can be called synchronously
+// @param text The text to parse
+// @param parseMode Text parse mode
+func ExecuteParseTextEntities(text string, parseMode TextParseMode) (*FormattedText, error) {
+	result, err := execute(UpdateData{
+		"@type":      "parseTextEntities",
+		"text":       text,
+		"parse_mode": parseMode,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var formattedText FormattedText
-	err = json.Unmarshal(result.Raw, &formattedText)
+	err = jsonUnmarshal(result.Raw, &formattedText)
 	return &formattedText, err
 }
 
@@ -66,12 +244,33 @@ func (client *Client) ParseMarkdown(text *FormattedText) (*FormattedText, error)
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
+		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
+	}
+
+	var formattedText FormattedText
+	err = jsonUnmarshal(result.Raw, &formattedText)
+	return &formattedText, err
+}
+
+// ExecuteParseMarkdown Synchronously executes ParseMarkdown, without a client. Parses Markdown entities in a human-friendly format, ignoring markup errors. Can be called synchronously
+// @param text The text to parse. For example, "__italic__ ~~strikethrough~~ ||spoiler|| **bold** `code` ```pre``` __[italic__ text_url](telegram.org) __italic**bold italic__bold**"
+func ExecuteParseMarkdown(text *FormattedText) (*FormattedText, error) {
+	result, err := execute(UpdateData{
+		"@type": "parseMarkdown",
+		"text":  text,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var formattedText FormattedText
-	err = json.Unmarshal(result.Raw, &formattedText)
+	err = jsonUnmarshal(result.Raw, &formattedText)
 	return &formattedText, err
 }
 
@@ -87,11 +286,32 @@ func (client *Client) GetMarkdownText(text *FormattedText) (*FormattedText, erro
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
+		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
+	}
+
+	var formattedText FormattedText
+	err = jsonUnmarshal(result.Raw, &formattedText)
+	return &formattedText, err
+}
+
+// ExecuteGetMarkdownText Synchronously executes GetMarkdownText, without a client. Replaces text entities with Markdown formatting in a human-friendly format. Entities that can't be represented in Markdown unambiguously are kept as is. Can be called synchronously
+// @param text The text
+func ExecuteGetMarkdownText(text *FormattedText) (*FormattedText, error) {
+	result, err := execute(UpdateData{
+		"@type": "getMarkdownText",
+		"text":  text,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var formattedText FormattedText
-	err = json.Unmarshal(result.Raw, &formattedText)
+	err = jsonUnmarshal(result.Raw, &formattedText)
 	return &formattedText, err
 }
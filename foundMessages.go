@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // FoundMessages Contains a list of messages found by a search
@@ -34,6 +34,172 @@ func NewFoundMessages(totalCount int32, messages []Message, nextOffset string) *
 	return &foundMessagesTemp
 }
 
+// MarshalJSON marshals to json
+func (foundMessages *FoundMessages) MarshalJSON() ([]byte, error) {
+	return foundMessages.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (foundMessages *FoundMessages) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, foundMessages)
+}
+
+func (foundMessages *FoundMessages) appendJSON(b []byte) ([]byte, error) {
+	if foundMessages == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, foundMessages.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, foundMessages.tdCommon.Extra)
+	b = append(b, `,"total_count":`...)
+	b = appendJSONInt(b, int64(foundMessages.TotalCount))
+	b = append(b, `,"messages":`...)
+	if foundMessages.Messages == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range foundMessages.Messages {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = foundMessages.Messages[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"next_offset":`...)
+	b = appendJSONString(b, foundMessages.NextOffset)
+	return append(b, '}'), nil
+}
+
+func (foundMessages *FoundMessages) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			foundMessages.tdCommon.Type, err = r.readString()
+		case "@extra":
+			foundMessages.tdCommon.Extra, err = r.readString()
+		case "total_count":
+			foundMessages.TotalCount, err = r.readInt32()
+		case "messages":
+			if r.readNull() {
+				foundMessages.Messages = nil
+			} else {
+				foundMessages.Messages = make([]Message, 0)
+				err = r.readArray(func() (err error) {
+					var item0 Message
+					err = item0.decodeJSON(r)
+					foundMessages.Messages = append(foundMessages.Messages, item0)
+					return
+				})
+			}
+		case "next_offset":
+			foundMessages.NextOffset, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (foundMessages *FoundMessages) Clone() *FoundMessages {
+	if foundMessages == nil {
+		return nil
+	}
+	clone := *foundMessages
+	if foundMessages.Messages != nil {
+		clone.Messages = make([]Message, len(foundMessages.Messages))
+		for i0 := range foundMessages.Messages {
+			clone.Messages[i0] = *foundMessages.Messages[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (foundMessages *FoundMessages) Equal(other *FoundMessages) bool {
+	if foundMessages == nil || other == nil {
+		return foundMessages == other
+	}
+	if foundMessages.TotalCount != other.TotalCount {
+		return false
+	}
+	if len(foundMessages.Messages) != len(other.Messages) {
+		return false
+	}
+	for i0 := range foundMessages.Messages {
+		if !foundMessages.Messages[i0].Equal(&other.Messages[i0]) {
+			return false
+		}
+	}
+	if foundMessages.NextOffset != other.NextOffset {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (foundMessages *FoundMessages) Diff(other *FoundMessages) []Change {
+	return foundMessages.diff("", other, nil)
+}
+
+func (foundMessages *FoundMessages) diff(path string, other *FoundMessages, changes []Change) []Change {
+	if foundMessages == nil || other == nil {
+		if foundMessages != other {
+			changes = append(changes, Change{Path: path, Old: foundMessages, New: other})
+		}
+		return changes
+	}
+	if foundMessages.TotalCount != other.TotalCount {
+		changes = append(changes, Change{Path: fieldPath(path, "total_count"), Old: foundMessages.TotalCount, New: other.TotalCount})
+	}
+	if len(foundMessages.Messages) != len(other.Messages) {
+		changes = append(changes, Change{Path: fieldPath(path, "messages"), Old: foundMessages.Messages, New: other.Messages})
+	} else {
+		for i0 := range foundMessages.Messages {
+			changes = foundMessages.Messages[i0].diff(indexPath(fieldPath(path, "messages"), i0), &other.Messages[i0], changes)
+		}
+	}
+	if foundMessages.NextOffset != other.NextOffset {
+		changes = append(changes, Change{Path: fieldPath(path, "next_offset"), Old: foundMessages.NextOffset, New: other.NextOffset})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (foundMessages *FoundMessages) String() string {
+	return objectString(foundMessages)
+}
+
+// LogValue logs the object as its summary
+func (foundMessages *FoundMessages) LogValue() slog.Value {
+	return slog.StringValue(objectString(foundMessages))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (foundMessages *FoundMessages) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, foundMessages)
+}
+
+func (foundMessages *FoundMessages) printText(p *printer) {
+	if foundMessages == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("FoundMessages")
+	p.int("total_count", int64(foundMessages.TotalCount))
+	p.vector("messages", len(foundMessages.Messages), func(i0 int) {
+		foundMessages.Messages[i0].printText(p)
+	})
+	p.string("next_offset", foundMessages.NextOffset)
+	p.endObject(state)
+}
+
 // SearchSecretMessages Searches for messages in secret chats. Returns the results in reverse chronological order. For optimal performance, the number of returned messages is chosen by TDLib
 // @param chatId Identifier of the chat in which to search. Specify 0 to search in all secret chats
 // @param query Query to search for. If empty, searchChatMessages must be used instead
@@ -54,12 +220,12 @@ func (client *Client) SearchSecretMessages(chatId int64, query string, offset st
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var foundMessages FoundMessages
-	err = json.Unmarshal(result.Raw, &foundMessages)
+	err = jsonUnmarshal(result.Raw, &foundMessages)
 	return &foundMessages, err
 }
 
@@ -81,11 +247,11 @@ func (client *Client) GetMessagePublicForwards(chatId int64, messageId int64, of
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var foundMessages FoundMessages
-	err = json.Unmarshal(result.Raw, &foundMessages)
+	err = jsonUnmarshal(result.Raw, &foundMessages)
 	return &foundMessages, err
 }
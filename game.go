@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // Game Describes a game
 type Game struct {
 	tdCommon
@@ -40,3 +45,193 @@ func NewGame(id JSONInt64, shortName string, title string, text *FormattedText,
 
 	return &gameTemp
 }
+
+// MarshalJSON marshals to json
+func (game *Game) MarshalJSON() ([]byte, error) {
+	return game.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (game *Game) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, game)
+}
+
+func (game *Game) appendJSON(b []byte) ([]byte, error) {
+	if game == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, game.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, game.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt64String(b, int64(game.Id))
+	b = append(b, `,"short_name":`...)
+	b = appendJSONString(b, game.ShortName)
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, game.Title)
+	b = append(b, `,"text":`...)
+	b, err = game.Text.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"description":`...)
+	b = appendJSONString(b, game.Description)
+	b = append(b, `,"photo":`...)
+	b, err = game.Photo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"animation":`...)
+	b, err = game.Animation.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (game *Game) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			game.tdCommon.Type, err = r.readString()
+		case "@extra":
+			game.tdCommon.Extra, err = r.readString()
+		case "id":
+			game.Id, err = r.readJSONInt64()
+		case "short_name":
+			game.ShortName, err = r.readString()
+		case "title":
+			game.Title, err = r.readString()
+		case "text":
+			if r.readNull() {
+				game.Text = nil
+			} else {
+				game.Text = new(FormattedText)
+				err = game.Text.decodeJSON(r)
+			}
+		case "description":
+			game.Description, err = r.readString()
+		case "photo":
+			if r.readNull() {
+				game.Photo = nil
+			} else {
+				game.Photo = new(Photo)
+				err = game.Photo.decodeJSON(r)
+			}
+		case "animation":
+			if r.readNull() {
+				game.Animation = nil
+			} else {
+				game.Animation = new(Animation)
+				err = game.Animation.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (game *Game) Clone() *Game {
+	if game == nil {
+		return nil
+	}
+	clone := *game
+	clone.Text = game.Text.Clone()
+	clone.Photo = game.Photo.Clone()
+	clone.Animation = game.Animation.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (game *Game) Equal(other *Game) bool {
+	if game == nil || other == nil {
+		return game == other
+	}
+	if game.Id != other.Id {
+		return false
+	}
+	if game.ShortName != other.ShortName {
+		return false
+	}
+	if game.Title != other.Title {
+		return false
+	}
+	if !game.Text.Equal(other.Text) {
+		return false
+	}
+	if game.Description != other.Description {
+		return false
+	}
+	if !game.Photo.Equal(other.Photo) {
+		return false
+	}
+	if !game.Animation.Equal(other.Animation) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (game *Game) Diff(other *Game) []Change {
+	return game.diff("", other, nil)
+}
+
+func (game *Game) diff(path string, other *Game, changes []Change) []Change {
+	if game == nil || other == nil {
+		if game != other {
+			changes = append(changes, Change{Path: path, Old: game, New: other})
+		}
+		return changes
+	}
+	if game.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: game.Id, New: other.Id})
+	}
+	if game.ShortName != other.ShortName {
+		changes = append(changes, Change{Path: fieldPath(path, "short_name"), Old: game.ShortName, New: other.ShortName})
+	}
+	if game.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: game.Title, New: other.Title})
+	}
+	changes = game.Text.diff(fieldPath(path, "text"), other.Text, changes)
+	if game.Description != other.Description {
+		changes = append(changes, Change{Path: fieldPath(path, "description"), Old: game.Description, New: other.Description})
+	}
+	changes = game.Photo.diff(fieldPath(path, "photo"), other.Photo, changes)
+	changes = game.Animation.diff(fieldPath(path, "animation"), other.Animation, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (game *Game) String() string {
+	return objectString(game)
+}
+
+// LogValue logs the object as its summary
+func (game *Game) LogValue() slog.Value {
+	return slog.StringValue(objectString(game))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (game *Game) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, game)
+}
+
+func (game *Game) printText(p *printer) {
+	if game == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Game")
+	p.int("id", int64(game.Id))
+	p.string("short_name", game.ShortName)
+	p.string("title", game.Title)
+	p.object("text", game.Text)
+	p.string("description", game.Description)
+	p.object("photo", game.Photo)
+	p.object("animation", game.Animation)
+	p.endObject(state)
+}
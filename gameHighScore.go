@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // GameHighScore Contains one row of the game high score table
 type GameHighScore struct {
 	tdCommon
@@ -28,3 +33,127 @@ func NewGameHighScore(position int32, userId int64, score int32) *GameHighScore
 
 	return &gameHighScoreTemp
 }
+
+// MarshalJSON marshals to json
+func (gameHighScore *GameHighScore) MarshalJSON() ([]byte, error) {
+	return gameHighScore.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (gameHighScore *GameHighScore) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, gameHighScore)
+}
+
+func (gameHighScore *GameHighScore) appendJSON(b []byte) ([]byte, error) {
+	if gameHighScore == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, gameHighScore.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, gameHighScore.tdCommon.Extra)
+	b = append(b, `,"position":`...)
+	b = appendJSONInt(b, int64(gameHighScore.Position))
+	b = append(b, `,"user_id":`...)
+	b = appendJSONInt(b, int64(gameHighScore.UserId))
+	b = append(b, `,"score":`...)
+	b = appendJSONInt(b, int64(gameHighScore.Score))
+	return append(b, '}'), nil
+}
+
+func (gameHighScore *GameHighScore) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			gameHighScore.tdCommon.Type, err = r.readString()
+		case "@extra":
+			gameHighScore.tdCommon.Extra, err = r.readString()
+		case "position":
+			gameHighScore.Position, err = r.readInt32()
+		case "user_id":
+			gameHighScore.UserId, err = r.readInt64()
+		case "score":
+			gameHighScore.Score, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (gameHighScore *GameHighScore) Clone() *GameHighScore {
+	if gameHighScore == nil {
+		return nil
+	}
+	clone := *gameHighScore
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (gameHighScore *GameHighScore) Equal(other *GameHighScore) bool {
+	if gameHighScore == nil || other == nil {
+		return gameHighScore == other
+	}
+	if gameHighScore.Position != other.Position {
+		return false
+	}
+	if gameHighScore.UserId != other.UserId {
+		return false
+	}
+	if gameHighScore.Score != other.Score {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (gameHighScore *GameHighScore) Diff(other *GameHighScore) []Change {
+	return gameHighScore.diff("", other, nil)
+}
+
+func (gameHighScore *GameHighScore) diff(path string, other *GameHighScore, changes []Change) []Change {
+	if gameHighScore == nil || other == nil {
+		if gameHighScore != other {
+			changes = append(changes, Change{Path: path, Old: gameHighScore, New: other})
+		}
+		return changes
+	}
+	if gameHighScore.Position != other.Position {
+		changes = append(changes, Change{Path: fieldPath(path, "position"), Old: gameHighScore.Position, New: other.Position})
+	}
+	if gameHighScore.UserId != other.UserId {
+		changes = append(changes, Change{Path: fieldPath(path, "user_id"), Old: gameHighScore.UserId, New: other.UserId})
+	}
+	if gameHighScore.Score != other.Score {
+		changes = append(changes, Change{Path: fieldPath(path, "score"), Old: gameHighScore.Score, New: other.Score})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (gameHighScore *GameHighScore) String() string {
+	return objectString(gameHighScore)
+}
+
+// LogValue logs the object as its summary
+func (gameHighScore *GameHighScore) LogValue() slog.Value {
+	return slog.StringValue(objectString(gameHighScore))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (gameHighScore *GameHighScore) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, gameHighScore)
+}
+
+func (gameHighScore *GameHighScore) printText(p *printer) {
+	if gameHighScore == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("GameHighScore")
+	p.int("position", int64(gameHighScore.Position))
+	p.int("user_id", int64(gameHighScore.UserId))
+	p.int("score", int64(gameHighScore.Score))
+	p.endObject(state)
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // GameHighScores Contains a list of game high scores
@@ -28,6 +28,150 @@ func NewGameHighScores(scores []GameHighScore) *GameHighScores {
 	return &gameHighScoresTemp
 }
 
+// MarshalJSON marshals to json
+func (gameHighScores *GameHighScores) MarshalJSON() ([]byte, error) {
+	return gameHighScores.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (gameHighScores *GameHighScores) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, gameHighScores)
+}
+
+func (gameHighScores *GameHighScores) appendJSON(b []byte) ([]byte, error) {
+	if gameHighScores == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, gameHighScores.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, gameHighScores.tdCommon.Extra)
+	b = append(b, `,"scores":`...)
+	if gameHighScores.Scores == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range gameHighScores.Scores {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = gameHighScores.Scores[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (gameHighScores *GameHighScores) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			gameHighScores.tdCommon.Type, err = r.readString()
+		case "@extra":
+			gameHighScores.tdCommon.Extra, err = r.readString()
+		case "scores":
+			if r.readNull() {
+				gameHighScores.Scores = nil
+			} else {
+				gameHighScores.Scores = make([]GameHighScore, 0)
+				err = r.readArray(func() (err error) {
+					var item0 GameHighScore
+					err = item0.decodeJSON(r)
+					gameHighScores.Scores = append(gameHighScores.Scores, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (gameHighScores *GameHighScores) Clone() *GameHighScores {
+	if gameHighScores == nil {
+		return nil
+	}
+	clone := *gameHighScores
+	if gameHighScores.Scores != nil {
+		clone.Scores = make([]GameHighScore, len(gameHighScores.Scores))
+		for i0 := range gameHighScores.Scores {
+			clone.Scores[i0] = *gameHighScores.Scores[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (gameHighScores *GameHighScores) Equal(other *GameHighScores) bool {
+	if gameHighScores == nil || other == nil {
+		return gameHighScores == other
+	}
+	if len(gameHighScores.Scores) != len(other.Scores) {
+		return false
+	}
+	for i0 := range gameHighScores.Scores {
+		if !gameHighScores.Scores[i0].Equal(&other.Scores[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (gameHighScores *GameHighScores) Diff(other *GameHighScores) []Change {
+	return gameHighScores.diff("", other, nil)
+}
+
+func (gameHighScores *GameHighScores) diff(path string, other *GameHighScores, changes []Change) []Change {
+	if gameHighScores == nil || other == nil {
+		if gameHighScores != other {
+			changes = append(changes, Change{Path: path, Old: gameHighScores, New: other})
+		}
+		return changes
+	}
+	if len(gameHighScores.Scores) != len(other.Scores) {
+		changes = append(changes, Change{Path: fieldPath(path, "scores"), Old: gameHighScores.Scores, New: other.Scores})
+	} else {
+		for i0 := range gameHighScores.Scores {
+			changes = gameHighScores.Scores[i0].diff(indexPath(fieldPath(path, "scores"), i0), &other.Scores[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (gameHighScores *GameHighScores) String() string {
+	return objectString(gameHighScores)
+}
+
+// LogValue logs the object as its summary
+func (gameHighScores *GameHighScores) LogValue() slog.Value {
+	return slog.StringValue(objectString(gameHighScores))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (gameHighScores *GameHighScores) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, gameHighScores)
+}
+
+func (gameHighScores *GameHighScores) printText(p *printer) {
+	if gameHighScores == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("GameHighScores")
+	p.vector("scores", len(gameHighScores.Scores), func(i0 int) {
+		gameHighScores.Scores[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetGameHighScores Returns the high scores for a game and some part of the high score table in the range of the specified user; for bots only
 // @param chatId The chat that contains the message with the game
 // @param messageId Identifier of the message
@@ -44,12 +188,12 @@ func (client *Client) GetGameHighScores(chatId int64, messageId int64, userId in
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var gameHighScores GameHighScores
-	err = json.Unmarshal(result.Raw, &gameHighScores)
+	err = jsonUnmarshal(result.Raw, &gameHighScores)
 	return &gameHighScores, err
 }
 
@@ -67,11 +211,11 @@ func (client *Client) GetInlineGameHighScores(inlineMessageId string, userId int
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var gameHighScores GameHighScores
-	err = json.Unmarshal(result.Raw, &gameHighScores)
+	err = jsonUnmarshal(result.Raw, &gameHighScores)
 	return &gameHighScores, err
 }
@@ -1,3 +1,3 @@
 module github.com/tasi788/go-tdlib
 
-go 1.15
+go 1.21
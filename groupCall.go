@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // GroupCall Describes a group call
@@ -82,6 +82,348 @@ func NewGroupCall(id int32, title string, scheduledStartDate int32, enabledStart
 	return &groupCallTemp
 }
 
+// MarshalJSON marshals to json
+func (groupCall *GroupCall) MarshalJSON() ([]byte, error) {
+	return groupCall.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (groupCall *GroupCall) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, groupCall)
+}
+
+func (groupCall *GroupCall) appendJSON(b []byte) ([]byte, error) {
+	if groupCall == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, groupCall.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, groupCall.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt(b, int64(groupCall.Id))
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, groupCall.Title)
+	b = append(b, `,"scheduled_start_date":`...)
+	b = appendJSONInt(b, int64(groupCall.ScheduledStartDate))
+	b = append(b, `,"enabled_start_notification":`...)
+	b = appendJSONBool(b, groupCall.EnabledStartNotification)
+	b = append(b, `,"is_active":`...)
+	b = appendJSONBool(b, groupCall.IsActive)
+	b = append(b, `,"is_joined":`...)
+	b = appendJSONBool(b, groupCall.IsJoined)
+	b = append(b, `,"need_rejoin":`...)
+	b = appendJSONBool(b, groupCall.NeedRejoin)
+	b = append(b, `,"can_be_managed":`...)
+	b = appendJSONBool(b, groupCall.CanBeManaged)
+	b = append(b, `,"participant_count":`...)
+	b = appendJSONInt(b, int64(groupCall.ParticipantCount))
+	b = append(b, `,"loaded_all_participants":`...)
+	b = appendJSONBool(b, groupCall.LoadedAllParticipants)
+	b = append(b, `,"recent_speakers":`...)
+	if groupCall.RecentSpeakers == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range groupCall.RecentSpeakers {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = groupCall.RecentSpeakers[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"is_my_video_enabled":`...)
+	b = appendJSONBool(b, groupCall.IsMyVideoEnabled)
+	b = append(b, `,"is_my_video_paused":`...)
+	b = appendJSONBool(b, groupCall.IsMyVideoPaused)
+	b = append(b, `,"can_enable_video":`...)
+	b = appendJSONBool(b, groupCall.CanEnableVideo)
+	b = append(b, `,"mute_new_participants":`...)
+	b = appendJSONBool(b, groupCall.MuteNewParticipants)
+	b = append(b, `,"can_toggle_mute_new_participants":`...)
+	b = appendJSONBool(b, groupCall.CanToggleMuteNewParticipants)
+	b = append(b, `,"record_duration":`...)
+	b = appendJSONInt(b, int64(groupCall.RecordDuration))
+	b = append(b, `,"is_video_recorded":`...)
+	b = appendJSONBool(b, groupCall.IsVideoRecorded)
+	b = append(b, `,"duration":`...)
+	b = appendJSONInt(b, int64(groupCall.Duration))
+	return append(b, '}'), nil
+}
+
+func (groupCall *GroupCall) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			groupCall.tdCommon.Type, err = r.readString()
+		case "@extra":
+			groupCall.tdCommon.Extra, err = r.readString()
+		case "id":
+			groupCall.Id, err = r.readInt32()
+		case "title":
+			groupCall.Title, err = r.readString()
+		case "scheduled_start_date":
+			groupCall.ScheduledStartDate, err = r.readInt32()
+		case "enabled_start_notification":
+			groupCall.EnabledStartNotification, err = r.readBool()
+		case "is_active":
+			groupCall.IsActive, err = r.readBool()
+		case "is_joined":
+			groupCall.IsJoined, err = r.readBool()
+		case "need_rejoin":
+			groupCall.NeedRejoin, err = r.readBool()
+		case "can_be_managed":
+			groupCall.CanBeManaged, err = r.readBool()
+		case "participant_count":
+			groupCall.ParticipantCount, err = r.readInt32()
+		case "loaded_all_participants":
+			groupCall.LoadedAllParticipants, err = r.readBool()
+		case "recent_speakers":
+			if r.readNull() {
+				groupCall.RecentSpeakers = nil
+			} else {
+				groupCall.RecentSpeakers = make([]GroupCallRecentSpeaker, 0)
+				err = r.readArray(func() (err error) {
+					var item0 GroupCallRecentSpeaker
+					err = item0.decodeJSON(r)
+					groupCall.RecentSpeakers = append(groupCall.RecentSpeakers, item0)
+					return
+				})
+			}
+		case "is_my_video_enabled":
+			groupCall.IsMyVideoEnabled, err = r.readBool()
+		case "is_my_video_paused":
+			groupCall.IsMyVideoPaused, err = r.readBool()
+		case "can_enable_video":
+			groupCall.CanEnableVideo, err = r.readBool()
+		case "mute_new_participants":
+			groupCall.MuteNewParticipants, err = r.readBool()
+		case "can_toggle_mute_new_participants":
+			groupCall.CanToggleMuteNewParticipants, err = r.readBool()
+		case "record_duration":
+			groupCall.RecordDuration, err = r.readInt32()
+		case "is_video_recorded":
+			groupCall.IsVideoRecorded, err = r.readBool()
+		case "duration":
+			groupCall.Duration, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (groupCall *GroupCall) Clone() *GroupCall {
+	if groupCall == nil {
+		return nil
+	}
+	clone := *groupCall
+	if groupCall.RecentSpeakers != nil {
+		clone.RecentSpeakers = make([]GroupCallRecentSpeaker, len(groupCall.RecentSpeakers))
+		for i0 := range groupCall.RecentSpeakers {
+			clone.RecentSpeakers[i0] = *groupCall.RecentSpeakers[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (groupCall *GroupCall) Equal(other *GroupCall) bool {
+	if groupCall == nil || other == nil {
+		return groupCall == other
+	}
+	if groupCall.Id != other.Id {
+		return false
+	}
+	if groupCall.Title != other.Title {
+		return false
+	}
+	if groupCall.ScheduledStartDate != other.ScheduledStartDate {
+		return false
+	}
+	if groupCall.EnabledStartNotification != other.EnabledStartNotification {
+		return false
+	}
+	if groupCall.IsActive != other.IsActive {
+		return false
+	}
+	if groupCall.IsJoined != other.IsJoined {
+		return false
+	}
+	if groupCall.NeedRejoin != other.NeedRejoin {
+		return false
+	}
+	if groupCall.CanBeManaged != other.CanBeManaged {
+		return false
+	}
+	if groupCall.ParticipantCount != other.ParticipantCount {
+		return false
+	}
+	if groupCall.LoadedAllParticipants != other.LoadedAllParticipants {
+		return false
+	}
+	if len(groupCall.RecentSpeakers) != len(other.RecentSpeakers) {
+		return false
+	}
+	for i0 := range groupCall.RecentSpeakers {
+		if !groupCall.RecentSpeakers[i0].Equal(&other.RecentSpeakers[i0]) {
+			return false
+		}
+	}
+	if groupCall.IsMyVideoEnabled != other.IsMyVideoEnabled {
+		return false
+	}
+	if groupCall.IsMyVideoPaused != other.IsMyVideoPaused {
+		return false
+	}
+	if groupCall.CanEnableVideo != other.CanEnableVideo {
+		return false
+	}
+	if groupCall.MuteNewParticipants != other.MuteNewParticipants {
+		return false
+	}
+	if groupCall.CanToggleMuteNewParticipants != other.CanToggleMuteNewParticipants {
+		return false
+	}
+	if groupCall.RecordDuration != other.RecordDuration {
+		return false
+	}
+	if groupCall.IsVideoRecorded != other.IsVideoRecorded {
+		return false
+	}
+	if groupCall.Duration != other.Duration {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (groupCall *GroupCall) Diff(other *GroupCall) []Change {
+	return groupCall.diff("", other, nil)
+}
+
+func (groupCall *GroupCall) diff(path string, other *GroupCall, changes []Change) []Change {
+	if groupCall == nil || other == nil {
+		if groupCall != other {
+			changes = append(changes, Change{Path: path, Old: groupCall, New: other})
+		}
+		return changes
+	}
+	if groupCall.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: groupCall.Id, New: other.Id})
+	}
+	if groupCall.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: groupCall.Title, New: other.Title})
+	}
+	if groupCall.ScheduledStartDate != other.ScheduledStartDate {
+		changes = append(changes, Change{Path: fieldPath(path, "scheduled_start_date"), Old: groupCall.ScheduledStartDate, New: other.ScheduledStartDate})
+	}
+	if groupCall.EnabledStartNotification != other.EnabledStartNotification {
+		changes = append(changes, Change{Path: fieldPath(path, "enabled_start_notification"), Old: groupCall.EnabledStartNotification, New: other.EnabledStartNotification})
+	}
+	if groupCall.IsActive != other.IsActive {
+		changes = append(changes, Change{Path: fieldPath(path, "is_active"), Old: groupCall.IsActive, New: other.IsActive})
+	}
+	if groupCall.IsJoined != other.IsJoined {
+		changes = append(changes, Change{Path: fieldPath(path, "is_joined"), Old: groupCall.IsJoined, New: other.IsJoined})
+	}
+	if groupCall.NeedRejoin != other.NeedRejoin {
+		changes = append(changes, Change{Path: fieldPath(path, "need_rejoin"), Old: groupCall.NeedRejoin, New: other.NeedRejoin})
+	}
+	if groupCall.CanBeManaged != other.CanBeManaged {
+		changes = append(changes, Change{Path: fieldPath(path, "can_be_managed"), Old: groupCall.CanBeManaged, New: other.CanBeManaged})
+	}
+	if groupCall.ParticipantCount != other.ParticipantCount {
+		changes = append(changes, Change{Path: fieldPath(path, "participant_count"), Old: groupCall.ParticipantCount, New: other.ParticipantCount})
+	}
+	if groupCall.LoadedAllParticipants != other.LoadedAllParticipants {
+		changes = append(changes, Change{Path: fieldPath(path, "loaded_all_participants"), Old: groupCall.LoadedAllParticipants, New: other.LoadedAllParticipants})
+	}
+	if len(groupCall.RecentSpeakers) != len(other.RecentSpeakers) {
+		changes = append(changes, Change{Path: fieldPath(path, "recent_speakers"), Old: groupCall.RecentSpeakers, New: other.RecentSpeakers})
+	} else {
+		for i0 := range groupCall.RecentSpeakers {
+			changes = groupCall.RecentSpeakers[i0].diff(indexPath(fieldPath(path, "recent_speakers"), i0), &other.RecentSpeakers[i0], changes)
+		}
+	}
+	if groupCall.IsMyVideoEnabled != other.IsMyVideoEnabled {
+		changes = append(changes, Change{Path: fieldPath(path, "is_my_video_enabled"), Old: groupCall.IsMyVideoEnabled, New: other.IsMyVideoEnabled})
+	}
+	if groupCall.IsMyVideoPaused != other.IsMyVideoPaused {
+		changes = append(changes, Change{Path: fieldPath(path, "is_my_video_paused"), Old: groupCall.IsMyVideoPaused, New: other.IsMyVideoPaused})
+	}
+	if groupCall.CanEnableVideo != other.CanEnableVideo {
+		changes = append(changes, Change{Path: fieldPath(path, "can_enable_video"), Old: groupCall.CanEnableVideo, New: other.CanEnableVideo})
+	}
+	if groupCall.MuteNewParticipants != other.MuteNewParticipants {
+		changes = append(changes, Change{Path: fieldPath(path, "mute_new_participants"), Old: groupCall.MuteNewParticipants, New: other.MuteNewParticipants})
+	}
+	if groupCall.CanToggleMuteNewParticipants != other.CanToggleMuteNewParticipants {
+		changes = append(changes, Change{Path: fieldPath(path, "can_toggle_mute_new_participants"), Old: groupCall.CanToggleMuteNewParticipants, New: other.CanToggleMuteNewParticipants})
+	}
+	if groupCall.RecordDuration != other.RecordDuration {
+		changes = append(changes, Change{Path: fieldPath(path, "record_duration"), Old: groupCall.RecordDuration, New: other.RecordDuration})
+	}
+	if groupCall.IsVideoRecorded != other.IsVideoRecorded {
+		changes = append(changes, Change{Path: fieldPath(path, "is_video_recorded"), Old: groupCall.IsVideoRecorded, New: other.IsVideoRecorded})
+	}
+	if groupCall.Duration != other.Duration {
+		changes = append(changes, Change{Path: fieldPath(path, "duration"), Old: groupCall.Duration, New: other.Duration})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (groupCall *GroupCall) String() string {
+	return objectString(groupCall)
+}
+
+// LogValue logs the object as its summary
+func (groupCall *GroupCall) LogValue() slog.Value {
+	return slog.StringValue(objectString(groupCall))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (groupCall *GroupCall) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, groupCall)
+}
+
+func (groupCall *GroupCall) printText(p *printer) {
+	if groupCall == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("GroupCall")
+	p.int("id", int64(groupCall.Id))
+	p.string("title", groupCall.Title)
+	p.int("scheduled_start_date", int64(groupCall.ScheduledStartDate))
+	p.bool("enabled_start_notification", groupCall.EnabledStartNotification)
+	p.bool("is_active", groupCall.IsActive)
+	p.bool("is_joined", groupCall.IsJoined)
+	p.bool("need_rejoin", groupCall.NeedRejoin)
+	p.bool("can_be_managed", groupCall.CanBeManaged)
+	p.int("participant_count", int64(groupCall.ParticipantCount))
+	p.bool("loaded_all_participants", groupCall.LoadedAllParticipants)
+	p.vector("recent_speakers", len(groupCall.RecentSpeakers), func(i0 int) {
+		groupCall.RecentSpeakers[i0].printText(p)
+	})
+	p.bool("is_my_video_enabled", groupCall.IsMyVideoEnabled)
+	p.bool("is_my_video_paused", groupCall.IsMyVideoPaused)
+	p.bool("can_enable_video", groupCall.CanEnableVideo)
+	p.bool("mute_new_participants", groupCall.MuteNewParticipants)
+	p.bool("can_toggle_mute_new_participants", groupCall.CanToggleMuteNewParticipants)
+	p.int("record_duration", int64(groupCall.RecordDuration))
+	p.bool("is_video_recorded", groupCall.IsVideoRecorded)
+	p.int("duration", int64(groupCall.Duration))
+	p.endObject(state)
+}
+
 // GetGroupCall Returns information about a group call
 // @param groupCallId Group call identifier
 func (client *Client) GetGroupCall(groupCallId int32) (*GroupCall, error) {
@@ -94,11 +436,11 @@ func (client *Client) GetGroupCall(groupCallId int32) (*GroupCall, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var groupCallDummy GroupCall
-	err = json.Unmarshal(result.Raw, &groupCallDummy)
+	err = jsonUnmarshal(result.Raw, &groupCallDummy)
 	return &groupCallDummy, err
 }
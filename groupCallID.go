@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // GroupCallId Contains the group call identifier
@@ -28,6 +28,108 @@ func NewGroupCallId(id int32) *GroupCallId {
 	return &groupCallIdTemp
 }
 
+// MarshalJSON marshals to json
+func (groupCallId *GroupCallId) MarshalJSON() ([]byte, error) {
+	return groupCallId.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (groupCallId *GroupCallId) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, groupCallId)
+}
+
+func (groupCallId *GroupCallId) appendJSON(b []byte) ([]byte, error) {
+	if groupCallId == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, groupCallId.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, groupCallId.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt(b, int64(groupCallId.Id))
+	return append(b, '}'), nil
+}
+
+func (groupCallId *GroupCallId) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			groupCallId.tdCommon.Type, err = r.readString()
+		case "@extra":
+			groupCallId.tdCommon.Extra, err = r.readString()
+		case "id":
+			groupCallId.Id, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (groupCallId *GroupCallId) Clone() *GroupCallId {
+	if groupCallId == nil {
+		return nil
+	}
+	clone := *groupCallId
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (groupCallId *GroupCallId) Equal(other *GroupCallId) bool {
+	if groupCallId == nil || other == nil {
+		return groupCallId == other
+	}
+	if groupCallId.Id != other.Id {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (groupCallId *GroupCallId) Diff(other *GroupCallId) []Change {
+	return groupCallId.diff("", other, nil)
+}
+
+func (groupCallId *GroupCallId) diff(path string, other *GroupCallId, changes []Change) []Change {
+	if groupCallId == nil || other == nil {
+		if groupCallId != other {
+			changes = append(changes, Change{Path: path, Old: groupCallId, New: other})
+		}
+		return changes
+	}
+	if groupCallId.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: groupCallId.Id, New: other.Id})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (groupCallId *GroupCallId) String() string {
+	return objectString(groupCallId)
+}
+
+// LogValue logs the object as its summary
+func (groupCallId *GroupCallId) LogValue() slog.Value {
+	return slog.StringValue(objectString(groupCallId))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (groupCallId *GroupCallId) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, groupCallId)
+}
+
+func (groupCallId *GroupCallId) printText(p *printer) {
+	if groupCallId == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("GroupCallId")
+	p.int("id", int64(groupCallId.Id))
+	p.endObject(state)
+}
+
 // CreateVideoChat Creates a video chat (a group call bound to a chat). Available only for basic groups, supergroups and channels; requires can_manage_video_chats rights
 // @param chatId Chat identifier, in which the video chat will be created
 // @param title Group call title; if empty, chat title will be used
@@ -44,11 +146,11 @@ func (client *Client) CreateVideoChat(chatId int64, title string, startDate int3
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var groupCallId GroupCallId
-	err = json.Unmarshal(result.Raw, &groupCallId)
+	err = jsonUnmarshal(result.Raw, &groupCallId)
 	return &groupCallId, err
 }
@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // GroupCallParticipant Represents a group call participant
@@ -78,59 +79,308 @@ func NewGroupCallParticipant(participantId MessageSender, audioSourceId int32, s
 	return &groupCallParticipantTemp
 }
 
+// MarshalJSON marshals to json
+func (groupCallParticipant *GroupCallParticipant) MarshalJSON() ([]byte, error) {
+	return groupCallParticipant.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (groupCallParticipant *GroupCallParticipant) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, groupCallParticipant)
+}
+
+func (groupCallParticipant *GroupCallParticipant) appendJSON(b []byte) ([]byte, error) {
+	if groupCallParticipant == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, groupCallParticipant.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, groupCallParticipant.tdCommon.Extra)
+	b = append(b, `,"participant_id":`...)
+	b, err = appendJSONValue(b, groupCallParticipant.ParticipantId)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"audio_source_id":`...)
+	b = appendJSONInt(b, int64(groupCallParticipant.AudioSourceId))
+	b = append(b, `,"screen_sharing_audio_source_id":`...)
+	b = appendJSONInt(b, int64(groupCallParticipant.ScreenSharingAudioSourceId))
+	b = append(b, `,"video_info":`...)
+	b, err = groupCallParticipant.VideoInfo.appendJSON(b)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		AudioSourceId              int32                          `json:"audio_source_id"`                 // User's audio channel synchronization source identifier
-		ScreenSharingAudioSourceId int32                          `json:"screen_sharing_audio_source_id"`  // User's screen sharing audio channel synchronization source identifier
-		VideoInfo                  *GroupCallParticipantVideoInfo `json:"video_info"`                      // Information about user's video channel; may be null if there is no active video
-		ScreenSharingVideoInfo     *GroupCallParticipantVideoInfo `json:"screen_sharing_video_info"`       // Information about user's screen sharing video channel; may be null if there is no active screen sharing video
-		Bio                        string                         `json:"bio"`                             // The participant user's bio or the participant chat's description
-		IsCurrentUser              bool                           `json:"is_current_user"`                 // True, if the participant is the current user
-		IsSpeaking                 bool                           `json:"is_speaking"`                     // True, if the participant is speaking as set by setGroupCallParticipantIsSpeaking
-		IsHandRaised               bool                           `json:"is_hand_raised"`                  // True, if the participant hand is raised
-		CanBeMutedForAllUsers      bool                           `json:"can_be_muted_for_all_users"`      // True, if the current user can mute the participant for all other group call participants
-		CanBeUnmutedForAllUsers    bool                           `json:"can_be_unmuted_for_all_users"`    // True, if the current user can allow the participant to unmute themselves or unmute the participant (if the participant is the current user)
-		CanBeMutedForCurrentUser   bool                           `json:"can_be_muted_for_current_user"`   // True, if the current user can mute the participant only for self
-		CanBeUnmutedForCurrentUser bool                           `json:"can_be_unmuted_for_current_user"` // True, if the current user can unmute the participant for self
-		IsMutedForAllUsers         bool                           `json:"is_muted_for_all_users"`          // True, if the participant is muted for all users
-		IsMutedForCurrentUser      bool                           `json:"is_muted_for_current_user"`       // True, if the participant is muted for the current user
-		CanUnmuteSelf              bool                           `json:"can_unmute_self"`                 // True, if the participant is muted for all users, but can unmute themselves
-		VolumeLevel                int32                          `json:"volume_level"`                    // Participant's volume level; 1-20000 in hundreds of percents
-		Order                      string                         `json:"order"`                           // User's order in the group call participant list. Orders must be compared lexicographically. The bigger is order, the higher is user in the list. If order is empty, the user must be removed from the participant list
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"screen_sharing_video_info":`...)
+	b, err = groupCallParticipant.ScreenSharingVideoInfo.appendJSON(b)
 	if err != nil {
-		return err
-	}
-
-	groupCallParticipant.tdCommon = tempObj.tdCommon
-	groupCallParticipant.AudioSourceId = tempObj.AudioSourceId
-	groupCallParticipant.ScreenSharingAudioSourceId = tempObj.ScreenSharingAudioSourceId
-	groupCallParticipant.VideoInfo = tempObj.VideoInfo
-	groupCallParticipant.ScreenSharingVideoInfo = tempObj.ScreenSharingVideoInfo
-	groupCallParticipant.Bio = tempObj.Bio
-	groupCallParticipant.IsCurrentUser = tempObj.IsCurrentUser
-	groupCallParticipant.IsSpeaking = tempObj.IsSpeaking
-	groupCallParticipant.IsHandRaised = tempObj.IsHandRaised
-	groupCallParticipant.CanBeMutedForAllUsers = tempObj.CanBeMutedForAllUsers
-	groupCallParticipant.CanBeUnmutedForAllUsers = tempObj.CanBeUnmutedForAllUsers
-	groupCallParticipant.CanBeMutedForCurrentUser = tempObj.CanBeMutedForCurrentUser
-	groupCallParticipant.CanBeUnmutedForCurrentUser = tempObj.CanBeUnmutedForCurrentUser
-	groupCallParticipant.IsMutedForAllUsers = tempObj.IsMutedForAllUsers
-	groupCallParticipant.IsMutedForCurrentUser = tempObj.IsMutedForCurrentUser
-	groupCallParticipant.CanUnmuteSelf = tempObj.CanUnmuteSelf
-	groupCallParticipant.VolumeLevel = tempObj.VolumeLevel
-	groupCallParticipant.Order = tempObj.Order
-
-	fieldParticipantId, _ := unmarshalMessageSender(objMap["participant_id"])
-	groupCallParticipant.ParticipantId = fieldParticipantId
-
-	return nil
+		return nil, err
+	}
+	b = append(b, `,"bio":`...)
+	b = appendJSONString(b, groupCallParticipant.Bio)
+	b = append(b, `,"is_current_user":`...)
+	b = appendJSONBool(b, groupCallParticipant.IsCurrentUser)
+	b = append(b, `,"is_speaking":`...)
+	b = appendJSONBool(b, groupCallParticipant.IsSpeaking)
+	b = append(b, `,"is_hand_raised":`...)
+	b = appendJSONBool(b, groupCallParticipant.IsHandRaised)
+	b = append(b, `,"can_be_muted_for_all_users":`...)
+	b = appendJSONBool(b, groupCallParticipant.CanBeMutedForAllUsers)
+	b = append(b, `,"can_be_unmuted_for_all_users":`...)
+	b = appendJSONBool(b, groupCallParticipant.CanBeUnmutedForAllUsers)
+	b = append(b, `,"can_be_muted_for_current_user":`...)
+	b = appendJSONBool(b, groupCallParticipant.CanBeMutedForCurrentUser)
+	b = append(b, `,"can_be_unmuted_for_current_user":`...)
+	b = appendJSONBool(b, groupCallParticipant.CanBeUnmutedForCurrentUser)
+	b = append(b, `,"is_muted_for_all_users":`...)
+	b = appendJSONBool(b, groupCallParticipant.IsMutedForAllUsers)
+	b = append(b, `,"is_muted_for_current_user":`...)
+	b = appendJSONBool(b, groupCallParticipant.IsMutedForCurrentUser)
+	b = append(b, `,"can_unmute_self":`...)
+	b = appendJSONBool(b, groupCallParticipant.CanUnmuteSelf)
+	b = append(b, `,"volume_level":`...)
+	b = appendJSONInt(b, int64(groupCallParticipant.VolumeLevel))
+	b = append(b, `,"order":`...)
+	b = appendJSONString(b, groupCallParticipant.Order)
+	return append(b, '}'), nil
+}
+
+func (groupCallParticipant *GroupCallParticipant) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			groupCallParticipant.tdCommon.Type, err = r.readString()
+		case "@extra":
+			groupCallParticipant.tdCommon.Extra, err = r.readString()
+		case "participant_id":
+			groupCallParticipant.ParticipantId, err = decodeMessageSender(r)
+		case "audio_source_id":
+			groupCallParticipant.AudioSourceId, err = r.readInt32()
+		case "screen_sharing_audio_source_id":
+			groupCallParticipant.ScreenSharingAudioSourceId, err = r.readInt32()
+		case "video_info":
+			if r.readNull() {
+				groupCallParticipant.VideoInfo = nil
+			} else {
+				groupCallParticipant.VideoInfo = new(GroupCallParticipantVideoInfo)
+				err = groupCallParticipant.VideoInfo.decodeJSON(r)
+			}
+		case "screen_sharing_video_info":
+			if r.readNull() {
+				groupCallParticipant.ScreenSharingVideoInfo = nil
+			} else {
+				groupCallParticipant.ScreenSharingVideoInfo = new(GroupCallParticipantVideoInfo)
+				err = groupCallParticipant.ScreenSharingVideoInfo.decodeJSON(r)
+			}
+		case "bio":
+			groupCallParticipant.Bio, err = r.readString()
+		case "is_current_user":
+			groupCallParticipant.IsCurrentUser, err = r.readBool()
+		case "is_speaking":
+			groupCallParticipant.IsSpeaking, err = r.readBool()
+		case "is_hand_raised":
+			groupCallParticipant.IsHandRaised, err = r.readBool()
+		case "can_be_muted_for_all_users":
+			groupCallParticipant.CanBeMutedForAllUsers, err = r.readBool()
+		case "can_be_unmuted_for_all_users":
+			groupCallParticipant.CanBeUnmutedForAllUsers, err = r.readBool()
+		case "can_be_muted_for_current_user":
+			groupCallParticipant.CanBeMutedForCurrentUser, err = r.readBool()
+		case "can_be_unmuted_for_current_user":
+			groupCallParticipant.CanBeUnmutedForCurrentUser, err = r.readBool()
+		case "is_muted_for_all_users":
+			groupCallParticipant.IsMutedForAllUsers, err = r.readBool()
+		case "is_muted_for_current_user":
+			groupCallParticipant.IsMutedForCurrentUser, err = r.readBool()
+		case "can_unmute_self":
+			groupCallParticipant.CanUnmuteSelf, err = r.readBool()
+		case "volume_level":
+			groupCallParticipant.VolumeLevel, err = r.readInt32()
+		case "order":
+			groupCallParticipant.Order, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (groupCallParticipant *GroupCallParticipant) Clone() *GroupCallParticipant {
+	if groupCallParticipant == nil {
+		return nil
+	}
+	clone := *groupCallParticipant
+	clone.ParticipantId = cloneMessageSender(groupCallParticipant.ParticipantId)
+	clone.VideoInfo = groupCallParticipant.VideoInfo.Clone()
+	clone.ScreenSharingVideoInfo = groupCallParticipant.ScreenSharingVideoInfo.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (groupCallParticipant *GroupCallParticipant) Equal(other *GroupCallParticipant) bool {
+	if groupCallParticipant == nil || other == nil {
+		return groupCallParticipant == other
+	}
+	if !equalMessageSender(groupCallParticipant.ParticipantId, other.ParticipantId) {
+		return false
+	}
+	if groupCallParticipant.AudioSourceId != other.AudioSourceId {
+		return false
+	}
+	if groupCallParticipant.ScreenSharingAudioSourceId != other.ScreenSharingAudioSourceId {
+		return false
+	}
+	if !groupCallParticipant.VideoInfo.Equal(other.VideoInfo) {
+		return false
+	}
+	if !groupCallParticipant.ScreenSharingVideoInfo.Equal(other.ScreenSharingVideoInfo) {
+		return false
+	}
+	if groupCallParticipant.Bio != other.Bio {
+		return false
+	}
+	if groupCallParticipant.IsCurrentUser != other.IsCurrentUser {
+		return false
+	}
+	if groupCallParticipant.IsSpeaking != other.IsSpeaking {
+		return false
+	}
+	if groupCallParticipant.IsHandRaised != other.IsHandRaised {
+		return false
+	}
+	if groupCallParticipant.CanBeMutedForAllUsers != other.CanBeMutedForAllUsers {
+		return false
+	}
+	if groupCallParticipant.CanBeUnmutedForAllUsers != other.CanBeUnmutedForAllUsers {
+		return false
+	}
+	if groupCallParticipant.CanBeMutedForCurrentUser != other.CanBeMutedForCurrentUser {
+		return false
+	}
+	if groupCallParticipant.CanBeUnmutedForCurrentUser != other.CanBeUnmutedForCurrentUser {
+		return false
+	}
+	if groupCallParticipant.IsMutedForAllUsers != other.IsMutedForAllUsers {
+		return false
+	}
+	if groupCallParticipant.IsMutedForCurrentUser != other.IsMutedForCurrentUser {
+		return false
+	}
+	if groupCallParticipant.CanUnmuteSelf != other.CanUnmuteSelf {
+		return false
+	}
+	if groupCallParticipant.VolumeLevel != other.VolumeLevel {
+		return false
+	}
+	if groupCallParticipant.Order != other.Order {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (groupCallParticipant *GroupCallParticipant) Diff(other *GroupCallParticipant) []Change {
+	return groupCallParticipant.diff("", other, nil)
+}
+
+func (groupCallParticipant *GroupCallParticipant) diff(path string, other *GroupCallParticipant, changes []Change) []Change {
+	if groupCallParticipant == nil || other == nil {
+		if groupCallParticipant != other {
+			changes = append(changes, Change{Path: path, Old: groupCallParticipant, New: other})
+		}
+		return changes
+	}
+	changes = diffMessageSender(fieldPath(path, "participant_id"), groupCallParticipant.ParticipantId, other.ParticipantId, changes)
+	if groupCallParticipant.AudioSourceId != other.AudioSourceId {
+		changes = append(changes, Change{Path: fieldPath(path, "audio_source_id"), Old: groupCallParticipant.AudioSourceId, New: other.AudioSourceId})
+	}
+	if groupCallParticipant.ScreenSharingAudioSourceId != other.ScreenSharingAudioSourceId {
+		changes = append(changes, Change{Path: fieldPath(path, "screen_sharing_audio_source_id"), Old: groupCallParticipant.ScreenSharingAudioSourceId, New: other.ScreenSharingAudioSourceId})
+	}
+	changes = groupCallParticipant.VideoInfo.diff(fieldPath(path, "video_info"), other.VideoInfo, changes)
+	changes = groupCallParticipant.ScreenSharingVideoInfo.diff(fieldPath(path, "screen_sharing_video_info"), other.ScreenSharingVideoInfo, changes)
+	if groupCallParticipant.Bio != other.Bio {
+		changes = append(changes, Change{Path: fieldPath(path, "bio"), Old: groupCallParticipant.Bio, New: other.Bio})
+	}
+	if groupCallParticipant.IsCurrentUser != other.IsCurrentUser {
+		changes = append(changes, Change{Path: fieldPath(path, "is_current_user"), Old: groupCallParticipant.IsCurrentUser, New: other.IsCurrentUser})
+	}
+	if groupCallParticipant.IsSpeaking != other.IsSpeaking {
+		changes = append(changes, Change{Path: fieldPath(path, "is_speaking"), Old: groupCallParticipant.IsSpeaking, New: other.IsSpeaking})
+	}
+	if groupCallParticipant.IsHandRaised != other.IsHandRaised {
+		changes = append(changes, Change{Path: fieldPath(path, "is_hand_raised"), Old: groupCallParticipant.IsHandRaised, New: other.IsHandRaised})
+	}
+	if groupCallParticipant.CanBeMutedForAllUsers != other.CanBeMutedForAllUsers {
+		changes = append(changes, Change{Path: fieldPath(path, "can_be_muted_for_all_users"), Old: groupCallParticipant.CanBeMutedForAllUsers, New: other.CanBeMutedForAllUsers})
+	}
+	if groupCallParticipant.CanBeUnmutedForAllUsers != other.CanBeUnmutedForAllUsers {
+		changes = append(changes, Change{Path: fieldPath(path, "can_be_unmuted_for_all_users"), Old: groupCallParticipant.CanBeUnmutedForAllUsers, New: other.CanBeUnmutedForAllUsers})
+	}
+	if groupCallParticipant.CanBeMutedForCurrentUser != other.CanBeMutedForCurrentUser {
+		changes = append(changes, Change{Path: fieldPath(path, "can_be_muted_for_current_user"), Old: groupCallParticipant.CanBeMutedForCurrentUser, New: other.CanBeMutedForCurrentUser})
+	}
+	if groupCallParticipant.CanBeUnmutedForCurrentUser != other.CanBeUnmutedForCurrentUser {
+		changes = append(changes, Change{Path: fieldPath(path, "can_be_unmuted_for_current_user"), Old: groupCallParticipant.CanBeUnmutedForCurrentUser, New: other.CanBeUnmutedForCurrentUser})
+	}
+	if groupCallParticipant.IsMutedForAllUsers != other.IsMutedForAllUsers {
+		changes = append(changes, Change{Path: fieldPath(path, "is_muted_for_all_users"), Old: groupCallParticipant.IsMutedForAllUsers, New: other.IsMutedForAllUsers})
+	}
+	if groupCallParticipant.IsMutedForCurrentUser != other.IsMutedForCurrentUser {
+		changes = append(changes, Change{Path: fieldPath(path, "is_muted_for_current_user"), Old: groupCallParticipant.IsMutedForCurrentUser, New: other.IsMutedForCurrentUser})
+	}
+	if groupCallParticipant.CanUnmuteSelf != other.CanUnmuteSelf {
+		changes = append(changes, Change{Path: fieldPath(path, "can_unmute_self"), Old: groupCallParticipant.CanUnmuteSelf, New: other.CanUnmuteSelf})
+	}
+	if groupCallParticipant.VolumeLevel != other.VolumeLevel {
+		changes = append(changes, Change{Path: fieldPath(path, "volume_level"), Old: groupCallParticipant.VolumeLevel, New: other.VolumeLevel})
+	}
+	if groupCallParticipant.Order != other.Order {
+		changes = append(changes, Change{Path: fieldPath(path, "order"), Old: groupCallParticipant.Order, New: other.Order})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (groupCallParticipant *GroupCallParticipant) String() string {
+	return objectString(groupCallParticipant)
+}
+
+// LogValue logs the object as its summary
+func (groupCallParticipant *GroupCallParticipant) LogValue() slog.Value {
+	return slog.StringValue(objectString(groupCallParticipant))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (groupCallParticipant *GroupCallParticipant) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, groupCallParticipant)
+}
+
+func (groupCallParticipant *GroupCallParticipant) printText(p *printer) {
+	if groupCallParticipant == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("GroupCallParticipant")
+	p.object("participant_id", groupCallParticipant.ParticipantId)
+	p.int("audio_source_id", int64(groupCallParticipant.AudioSourceId))
+	p.int("screen_sharing_audio_source_id", int64(groupCallParticipant.ScreenSharingAudioSourceId))
+	p.object("video_info", groupCallParticipant.VideoInfo)
+	p.object("screen_sharing_video_info", groupCallParticipant.ScreenSharingVideoInfo)
+	p.string("bio", groupCallParticipant.Bio)
+	p.bool("is_current_user", groupCallParticipant.IsCurrentUser)
+	p.bool("is_speaking", groupCallParticipant.IsSpeaking)
+	p.bool("is_hand_raised", groupCallParticipant.IsHandRaised)
+	p.bool("can_be_muted_for_all_users", groupCallParticipant.CanBeMutedForAllUsers)
+	p.bool("can_be_unmuted_for_all_users", groupCallParticipant.CanBeUnmutedForAllUsers)
+	p.bool("can_be_muted_for_current_user", groupCallParticipant.CanBeMutedForCurrentUser)
+	p.bool("can_be_unmuted_for_current_user", groupCallParticipant.CanBeUnmutedForCurrentUser)
+	p.bool("is_muted_for_all_users", groupCallParticipant.IsMutedForAllUsers)
+	p.bool("is_muted_for_current_user", groupCallParticipant.IsMutedForCurrentUser)
+	p.bool("can_unmute_self", groupCallParticipant.CanUnmuteSelf)
+	p.int("volume_level", int64(groupCallParticipant.VolumeLevel))
+	p.string("order", groupCallParticipant.Order)
+	p.endObject(state)
 }
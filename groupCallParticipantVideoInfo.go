@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // GroupCallParticipantVideoInfo Contains information about a group call participant's video channel
 type GroupCallParticipantVideoInfo struct {
 	tdCommon
@@ -28,3 +33,169 @@ func NewGroupCallParticipantVideoInfo(sourceGroups []GroupCallVideoSourceGroup,
 
 	return &groupCallParticipantVideoInfoTemp
 }
+
+// MarshalJSON marshals to json
+func (groupCallParticipantVideoInfo *GroupCallParticipantVideoInfo) MarshalJSON() ([]byte, error) {
+	return groupCallParticipantVideoInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (groupCallParticipantVideoInfo *GroupCallParticipantVideoInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, groupCallParticipantVideoInfo)
+}
+
+func (groupCallParticipantVideoInfo *GroupCallParticipantVideoInfo) appendJSON(b []byte) ([]byte, error) {
+	if groupCallParticipantVideoInfo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, groupCallParticipantVideoInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, groupCallParticipantVideoInfo.tdCommon.Extra)
+	b = append(b, `,"source_groups":`...)
+	if groupCallParticipantVideoInfo.SourceGroups == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range groupCallParticipantVideoInfo.SourceGroups {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = groupCallParticipantVideoInfo.SourceGroups[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"endpoint_id":`...)
+	b = appendJSONString(b, groupCallParticipantVideoInfo.EndpointId)
+	b = append(b, `,"is_paused":`...)
+	b = appendJSONBool(b, groupCallParticipantVideoInfo.IsPaused)
+	return append(b, '}'), nil
+}
+
+func (groupCallParticipantVideoInfo *GroupCallParticipantVideoInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			groupCallParticipantVideoInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			groupCallParticipantVideoInfo.tdCommon.Extra, err = r.readString()
+		case "source_groups":
+			if r.readNull() {
+				groupCallParticipantVideoInfo.SourceGroups = nil
+			} else {
+				groupCallParticipantVideoInfo.SourceGroups = make([]GroupCallVideoSourceGroup, 0)
+				err = r.readArray(func() (err error) {
+					var item0 GroupCallVideoSourceGroup
+					err = item0.decodeJSON(r)
+					groupCallParticipantVideoInfo.SourceGroups = append(groupCallParticipantVideoInfo.SourceGroups, item0)
+					return
+				})
+			}
+		case "endpoint_id":
+			groupCallParticipantVideoInfo.EndpointId, err = r.readString()
+		case "is_paused":
+			groupCallParticipantVideoInfo.IsPaused, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (groupCallParticipantVideoInfo *GroupCallParticipantVideoInfo) Clone() *GroupCallParticipantVideoInfo {
+	if groupCallParticipantVideoInfo == nil {
+		return nil
+	}
+	clone := *groupCallParticipantVideoInfo
+	if groupCallParticipantVideoInfo.SourceGroups != nil {
+		clone.SourceGroups = make([]GroupCallVideoSourceGroup, len(groupCallParticipantVideoInfo.SourceGroups))
+		for i0 := range groupCallParticipantVideoInfo.SourceGroups {
+			clone.SourceGroups[i0] = *groupCallParticipantVideoInfo.SourceGroups[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (groupCallParticipantVideoInfo *GroupCallParticipantVideoInfo) Equal(other *GroupCallParticipantVideoInfo) bool {
+	if groupCallParticipantVideoInfo == nil || other == nil {
+		return groupCallParticipantVideoInfo == other
+	}
+	if len(groupCallParticipantVideoInfo.SourceGroups) != len(other.SourceGroups) {
+		return false
+	}
+	for i0 := range groupCallParticipantVideoInfo.SourceGroups {
+		if !groupCallParticipantVideoInfo.SourceGroups[i0].Equal(&other.SourceGroups[i0]) {
+			return false
+		}
+	}
+	if groupCallParticipantVideoInfo.EndpointId != other.EndpointId {
+		return false
+	}
+	if groupCallParticipantVideoInfo.IsPaused != other.IsPaused {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (groupCallParticipantVideoInfo *GroupCallParticipantVideoInfo) Diff(other *GroupCallParticipantVideoInfo) []Change {
+	return groupCallParticipantVideoInfo.diff("", other, nil)
+}
+
+func (groupCallParticipantVideoInfo *GroupCallParticipantVideoInfo) diff(path string, other *GroupCallParticipantVideoInfo, changes []Change) []Change {
+	if groupCallParticipantVideoInfo == nil || other == nil {
+		if groupCallParticipantVideoInfo != other {
+			changes = append(changes, Change{Path: path, Old: groupCallParticipantVideoInfo, New: other})
+		}
+		return changes
+	}
+	if len(groupCallParticipantVideoInfo.SourceGroups) != len(other.SourceGroups) {
+		changes = append(changes, Change{Path: fieldPath(path, "source_groups"), Old: groupCallParticipantVideoInfo.SourceGroups, New: other.SourceGroups})
+	} else {
+		for i0 := range groupCallParticipantVideoInfo.SourceGroups {
+			changes = groupCallParticipantVideoInfo.SourceGroups[i0].diff(indexPath(fieldPath(path, "source_groups"), i0), &other.SourceGroups[i0], changes)
+		}
+	}
+	if groupCallParticipantVideoInfo.EndpointId != other.EndpointId {
+		changes = append(changes, Change{Path: fieldPath(path, "endpoint_id"), Old: groupCallParticipantVideoInfo.EndpointId, New: other.EndpointId})
+	}
+	if groupCallParticipantVideoInfo.IsPaused != other.IsPaused {
+		changes = append(changes, Change{Path: fieldPath(path, "is_paused"), Old: groupCallParticipantVideoInfo.IsPaused, New: other.IsPaused})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (groupCallParticipantVideoInfo *GroupCallParticipantVideoInfo) String() string {
+	return objectString(groupCallParticipantVideoInfo)
+}
+
+// LogValue logs the object as its summary
+func (groupCallParticipantVideoInfo *GroupCallParticipantVideoInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(groupCallParticipantVideoInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (groupCallParticipantVideoInfo *GroupCallParticipantVideoInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, groupCallParticipantVideoInfo)
+}
+
+func (groupCallParticipantVideoInfo *GroupCallParticipantVideoInfo) printText(p *printer) {
+	if groupCallParticipantVideoInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("GroupCallParticipantVideoInfo")
+	p.vector("source_groups", len(groupCallParticipantVideoInfo.SourceGroups), func(i0 int) {
+		groupCallParticipantVideoInfo.SourceGroups[i0].printText(p)
+	})
+	p.string("endpoint_id", groupCallParticipantVideoInfo.EndpointId)
+	p.bool("is_paused", groupCallParticipantVideoInfo.IsPaused)
+	p.endObject(state)
+}
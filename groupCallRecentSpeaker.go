@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // GroupCallRecentSpeaker Describes a recently speaking participant in a group call
@@ -30,27 +31,118 @@ func NewGroupCallRecentSpeaker(participantId MessageSender, isSpeaking bool) *Gr
 	return &groupCallRecentSpeakerTemp
 }
 
+// MarshalJSON marshals to json
+func (groupCallRecentSpeaker *GroupCallRecentSpeaker) MarshalJSON() ([]byte, error) {
+	return groupCallRecentSpeaker.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (groupCallRecentSpeaker *GroupCallRecentSpeaker) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, groupCallRecentSpeaker)
+}
+
+func (groupCallRecentSpeaker *GroupCallRecentSpeaker) appendJSON(b []byte) ([]byte, error) {
+	if groupCallRecentSpeaker == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-		IsSpeaking bool `json:"is_speaking"` // True, is the user has spoken recently
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, groupCallRecentSpeaker.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, groupCallRecentSpeaker.tdCommon.Extra)
+	b = append(b, `,"participant_id":`...)
+	b, err = appendJSONValue(b, groupCallRecentSpeaker.ParticipantId)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	b = append(b, `,"is_speaking":`...)
+	b = appendJSONBool(b, groupCallRecentSpeaker.IsSpeaking)
+	return append(b, '}'), nil
+}
+
+func (groupCallRecentSpeaker *GroupCallRecentSpeaker) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			groupCallRecentSpeaker.tdCommon.Type, err = r.readString()
+		case "@extra":
+			groupCallRecentSpeaker.tdCommon.Extra, err = r.readString()
+		case "participant_id":
+			groupCallRecentSpeaker.ParticipantId, err = decodeMessageSender(r)
+		case "is_speaking":
+			groupCallRecentSpeaker.IsSpeaking, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	groupCallRecentSpeaker.tdCommon = tempObj.tdCommon
-	groupCallRecentSpeaker.IsSpeaking = tempObj.IsSpeaking
+// Clone returns a deep copy of the object
+func (groupCallRecentSpeaker *GroupCallRecentSpeaker) Clone() *GroupCallRecentSpeaker {
+	if groupCallRecentSpeaker == nil {
+		return nil
+	}
+	clone := *groupCallRecentSpeaker
+	clone.ParticipantId = cloneMessageSender(groupCallRecentSpeaker.ParticipantId)
+	return &clone
+}
 
-	fieldParticipantId, _ := unmarshalMessageSender(objMap["participant_id"])
-	groupCallRecentSpeaker.ParticipantId = fieldParticipantId
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (groupCallRecentSpeaker *GroupCallRecentSpeaker) Equal(other *GroupCallRecentSpeaker) bool {
+	if groupCallRecentSpeaker == nil || other == nil {
+		return groupCallRecentSpeaker == other
+	}
+	if !equalMessageSender(groupCallRecentSpeaker.ParticipantId, other.ParticipantId) {
+		return false
+	}
+	if groupCallRecentSpeaker.IsSpeaking != other.IsSpeaking {
+		return false
+	}
+	return true
+}
 
-	return nil
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (groupCallRecentSpeaker *GroupCallRecentSpeaker) Diff(other *GroupCallRecentSpeaker) []Change {
+	return groupCallRecentSpeaker.diff("", other, nil)
+}
+
+func (groupCallRecentSpeaker *GroupCallRecentSpeaker) diff(path string, other *GroupCallRecentSpeaker, changes []Change) []Change {
+	if groupCallRecentSpeaker == nil || other == nil {
+		if groupCallRecentSpeaker != other {
+			changes = append(changes, Change{Path: path, Old: groupCallRecentSpeaker, New: other})
+		}
+		return changes
+	}
+	changes = diffMessageSender(fieldPath(path, "participant_id"), groupCallRecentSpeaker.ParticipantId, other.ParticipantId, changes)
+	if groupCallRecentSpeaker.IsSpeaking != other.IsSpeaking {
+		changes = append(changes, Change{Path: fieldPath(path, "is_speaking"), Old: groupCallRecentSpeaker.IsSpeaking, New: other.IsSpeaking})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (groupCallRecentSpeaker *GroupCallRecentSpeaker) String() string {
+	return objectString(groupCallRecentSpeaker)
+}
+
+// LogValue logs the object as its summary
+func (groupCallRecentSpeaker *GroupCallRecentSpeaker) LogValue() slog.Value {
+	return slog.StringValue(objectString(groupCallRecentSpeaker))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (groupCallRecentSpeaker *GroupCallRecentSpeaker) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, groupCallRecentSpeaker)
+}
+
+func (groupCallRecentSpeaker *GroupCallRecentSpeaker) printText(p *printer) {
+	if groupCallRecentSpeaker == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("GroupCallRecentSpeaker")
+	p.object("participant_id", groupCallRecentSpeaker.ParticipantId)
+	p.bool("is_speaking", groupCallRecentSpeaker.IsSpeaking)
+	p.endObject(state)
 }
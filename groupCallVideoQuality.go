@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // GroupCallVideoQuality Describes the quality of a group call video
 type GroupCallVideoQuality interface {
 	GetGroupCallVideoQualityEnum() GroupCallVideoQualityEnum
+	Accept(visitor GroupCallVideoQualityVisitor)
 }
 
 // GroupCallVideoQualityEnum Alias for abstract GroupCallVideoQuality 'Sub-Classes', used as constant-enum here
@@ -20,35 +22,78 @@ const (
 	GroupCallVideoQualityFullType      GroupCallVideoQualityEnum = "groupCallVideoQualityFull"
 )
 
+// UnknownGroupCallVideoQuality is a GroupCallVideoQuality of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownGroupCallVideoQuality struct {
+	Unknown
+}
+
+// GetGroupCallVideoQualityEnum return the enum type of this object
+func (unknownGroupCallVideoQuality *UnknownGroupCallVideoQuality) GetGroupCallVideoQualityEnum() GroupCallVideoQualityEnum {
+	return GroupCallVideoQualityEnum(unknownGroupCallVideoQuality.Type)
+}
+
+// Accept calls the method of the visitor for UnknownGroupCallVideoQuality
+func (unknownGroupCallVideoQuality *UnknownGroupCallVideoQuality) Accept(visitor GroupCallVideoQualityVisitor) {
+	visitor.VisitUnknownGroupCallVideoQuality(unknownGroupCallVideoQuality)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownGroupCallVideoQuality *UnknownGroupCallVideoQuality) String() string {
+	return objectString(unknownGroupCallVideoQuality)
+}
+
+// LogValue logs the object as its summary
+func (unknownGroupCallVideoQuality *UnknownGroupCallVideoQuality) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownGroupCallVideoQuality))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownGroupCallVideoQuality *UnknownGroupCallVideoQuality) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownGroupCallVideoQuality)
+}
+
+func (unknownGroupCallVideoQuality *UnknownGroupCallVideoQuality) printText(p *printer) {
+	if unknownGroupCallVideoQuality == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownGroupCallVideoQuality")
+	p.string("@type", unknownGroupCallVideoQuality.Type)
+	p.endObject(state)
+}
+
 func unmarshalGroupCallVideoQuality(rawMsg *json.RawMessage) (GroupCallVideoQuality, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch GroupCallVideoQualityEnum(objMap["@type"].(string)) {
+	switch GroupCallVideoQualityEnum(typeName) {
 	case GroupCallVideoQualityThumbnailType:
 		var groupCallVideoQualityThumbnail GroupCallVideoQualityThumbnail
-		err := json.Unmarshal(*rawMsg, &groupCallVideoQualityThumbnail)
+		err := jsonUnmarshal(*rawMsg, &groupCallVideoQualityThumbnail)
 		return &groupCallVideoQualityThumbnail, err
 
 	case GroupCallVideoQualityMediumType:
 		var groupCallVideoQualityMedium GroupCallVideoQualityMedium
-		err := json.Unmarshal(*rawMsg, &groupCallVideoQualityMedium)
+		err := jsonUnmarshal(*rawMsg, &groupCallVideoQualityMedium)
 		return &groupCallVideoQualityMedium, err
 
 	case GroupCallVideoQualityFullType:
 		var groupCallVideoQualityFull GroupCallVideoQualityFull
-		err := json.Unmarshal(*rawMsg, &groupCallVideoQualityFull)
+		err := jsonUnmarshal(*rawMsg, &groupCallVideoQualityFull)
 		return &groupCallVideoQualityFull, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownGroupCallVideoQuality{Unknown: unknown}, nil
 	}
 }
 
@@ -63,7 +108,6 @@ func (groupCallVideoQualityThumbnail *GroupCallVideoQualityThumbnail) MessageTyp
 }
 
 // NewGroupCallVideoQualityThumbnail creates a new GroupCallVideoQualityThumbnail
-//
 func NewGroupCallVideoQualityThumbnail() *GroupCallVideoQualityThumbnail {
 	groupCallVideoQualityThumbnailTemp := GroupCallVideoQualityThumbnail{
 		tdCommon: tdCommon{Type: "groupCallVideoQualityThumbnail"},
@@ -72,11 +116,107 @@ func NewGroupCallVideoQualityThumbnail() *GroupCallVideoQualityThumbnail {
 	return &groupCallVideoQualityThumbnailTemp
 }
 
+// MarshalJSON marshals to json
+func (groupCallVideoQualityThumbnail *GroupCallVideoQualityThumbnail) MarshalJSON() ([]byte, error) {
+	return groupCallVideoQualityThumbnail.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (groupCallVideoQualityThumbnail *GroupCallVideoQualityThumbnail) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, groupCallVideoQualityThumbnail)
+}
+
+func (groupCallVideoQualityThumbnail *GroupCallVideoQualityThumbnail) appendJSON(b []byte) ([]byte, error) {
+	if groupCallVideoQualityThumbnail == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, groupCallVideoQualityThumbnail.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, groupCallVideoQualityThumbnail.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (groupCallVideoQualityThumbnail *GroupCallVideoQualityThumbnail) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			groupCallVideoQualityThumbnail.tdCommon.Type, err = r.readString()
+		case "@extra":
+			groupCallVideoQualityThumbnail.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (groupCallVideoQualityThumbnail *GroupCallVideoQualityThumbnail) Clone() *GroupCallVideoQualityThumbnail {
+	if groupCallVideoQualityThumbnail == nil {
+		return nil
+	}
+	clone := *groupCallVideoQualityThumbnail
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (groupCallVideoQualityThumbnail *GroupCallVideoQualityThumbnail) Equal(other *GroupCallVideoQualityThumbnail) bool {
+	if groupCallVideoQualityThumbnail == nil || other == nil {
+		return groupCallVideoQualityThumbnail == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (groupCallVideoQualityThumbnail *GroupCallVideoQualityThumbnail) Diff(other *GroupCallVideoQualityThumbnail) []Change {
+	return groupCallVideoQualityThumbnail.diff("", other, nil)
+}
+
+func (groupCallVideoQualityThumbnail *GroupCallVideoQualityThumbnail) diff(path string, other *GroupCallVideoQualityThumbnail, changes []Change) []Change {
+	if groupCallVideoQualityThumbnail == nil || other == nil {
+		if groupCallVideoQualityThumbnail != other {
+			changes = append(changes, Change{Path: path, Old: groupCallVideoQualityThumbnail, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (groupCallVideoQualityThumbnail *GroupCallVideoQualityThumbnail) String() string {
+	return objectString(groupCallVideoQualityThumbnail)
+}
+
+// LogValue logs the object as its summary
+func (groupCallVideoQualityThumbnail *GroupCallVideoQualityThumbnail) LogValue() slog.Value {
+	return slog.StringValue(objectString(groupCallVideoQualityThumbnail))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (groupCallVideoQualityThumbnail *GroupCallVideoQualityThumbnail) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, groupCallVideoQualityThumbnail)
+}
+
+func (groupCallVideoQualityThumbnail *GroupCallVideoQualityThumbnail) printText(p *printer) {
+	if groupCallVideoQualityThumbnail == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("GroupCallVideoQualityThumbnail")
+	p.endObject(state)
+}
+
 // GetGroupCallVideoQualityEnum return the enum type of this object
 func (groupCallVideoQualityThumbnail *GroupCallVideoQualityThumbnail) GetGroupCallVideoQualityEnum() GroupCallVideoQualityEnum {
 	return GroupCallVideoQualityThumbnailType
 }
 
+// Accept calls the method of the visitor for GroupCallVideoQualityThumbnail
+func (groupCallVideoQualityThumbnail *GroupCallVideoQualityThumbnail) Accept(visitor GroupCallVideoQualityVisitor) {
+	visitor.VisitGroupCallVideoQualityThumbnail(groupCallVideoQualityThumbnail)
+}
+
 // GroupCallVideoQualityMedium The medium video quality
 type GroupCallVideoQualityMedium struct {
 	tdCommon
@@ -88,7 +228,6 @@ func (groupCallVideoQualityMedium *GroupCallVideoQualityMedium) MessageType() st
 }
 
 // NewGroupCallVideoQualityMedium creates a new GroupCallVideoQualityMedium
-//
 func NewGroupCallVideoQualityMedium() *GroupCallVideoQualityMedium {
 	groupCallVideoQualityMediumTemp := GroupCallVideoQualityMedium{
 		tdCommon: tdCommon{Type: "groupCallVideoQualityMedium"},
@@ -97,11 +236,107 @@ func NewGroupCallVideoQualityMedium() *GroupCallVideoQualityMedium {
 	return &groupCallVideoQualityMediumTemp
 }
 
+// MarshalJSON marshals to json
+func (groupCallVideoQualityMedium *GroupCallVideoQualityMedium) MarshalJSON() ([]byte, error) {
+	return groupCallVideoQualityMedium.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (groupCallVideoQualityMedium *GroupCallVideoQualityMedium) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, groupCallVideoQualityMedium)
+}
+
+func (groupCallVideoQualityMedium *GroupCallVideoQualityMedium) appendJSON(b []byte) ([]byte, error) {
+	if groupCallVideoQualityMedium == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, groupCallVideoQualityMedium.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, groupCallVideoQualityMedium.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (groupCallVideoQualityMedium *GroupCallVideoQualityMedium) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			groupCallVideoQualityMedium.tdCommon.Type, err = r.readString()
+		case "@extra":
+			groupCallVideoQualityMedium.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (groupCallVideoQualityMedium *GroupCallVideoQualityMedium) Clone() *GroupCallVideoQualityMedium {
+	if groupCallVideoQualityMedium == nil {
+		return nil
+	}
+	clone := *groupCallVideoQualityMedium
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (groupCallVideoQualityMedium *GroupCallVideoQualityMedium) Equal(other *GroupCallVideoQualityMedium) bool {
+	if groupCallVideoQualityMedium == nil || other == nil {
+		return groupCallVideoQualityMedium == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (groupCallVideoQualityMedium *GroupCallVideoQualityMedium) Diff(other *GroupCallVideoQualityMedium) []Change {
+	return groupCallVideoQualityMedium.diff("", other, nil)
+}
+
+func (groupCallVideoQualityMedium *GroupCallVideoQualityMedium) diff(path string, other *GroupCallVideoQualityMedium, changes []Change) []Change {
+	if groupCallVideoQualityMedium == nil || other == nil {
+		if groupCallVideoQualityMedium != other {
+			changes = append(changes, Change{Path: path, Old: groupCallVideoQualityMedium, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (groupCallVideoQualityMedium *GroupCallVideoQualityMedium) String() string {
+	return objectString(groupCallVideoQualityMedium)
+}
+
+// LogValue logs the object as its summary
+func (groupCallVideoQualityMedium *GroupCallVideoQualityMedium) LogValue() slog.Value {
+	return slog.StringValue(objectString(groupCallVideoQualityMedium))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (groupCallVideoQualityMedium *GroupCallVideoQualityMedium) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, groupCallVideoQualityMedium)
+}
+
+func (groupCallVideoQualityMedium *GroupCallVideoQualityMedium) printText(p *printer) {
+	if groupCallVideoQualityMedium == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("GroupCallVideoQualityMedium")
+	p.endObject(state)
+}
+
 // GetGroupCallVideoQualityEnum return the enum type of this object
 func (groupCallVideoQualityMedium *GroupCallVideoQualityMedium) GetGroupCallVideoQualityEnum() GroupCallVideoQualityEnum {
 	return GroupCallVideoQualityMediumType
 }
 
+// Accept calls the method of the visitor for GroupCallVideoQualityMedium
+func (groupCallVideoQualityMedium *GroupCallVideoQualityMedium) Accept(visitor GroupCallVideoQualityVisitor) {
+	visitor.VisitGroupCallVideoQualityMedium(groupCallVideoQualityMedium)
+}
+
 // GroupCallVideoQualityFull The best available video quality
 type GroupCallVideoQualityFull struct {
 	tdCommon
@@ -113,7 +348,6 @@ func (groupCallVideoQualityFull *GroupCallVideoQualityFull) MessageType() string
 }
 
 // NewGroupCallVideoQualityFull creates a new GroupCallVideoQualityFull
-//
 func NewGroupCallVideoQualityFull() *GroupCallVideoQualityFull {
 	groupCallVideoQualityFullTemp := GroupCallVideoQualityFull{
 		tdCommon: tdCommon{Type: "groupCallVideoQualityFull"},
@@ -122,7 +356,213 @@ func NewGroupCallVideoQualityFull() *GroupCallVideoQualityFull {
 	return &groupCallVideoQualityFullTemp
 }
 
+// MarshalJSON marshals to json
+func (groupCallVideoQualityFull *GroupCallVideoQualityFull) MarshalJSON() ([]byte, error) {
+	return groupCallVideoQualityFull.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (groupCallVideoQualityFull *GroupCallVideoQualityFull) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, groupCallVideoQualityFull)
+}
+
+func (groupCallVideoQualityFull *GroupCallVideoQualityFull) appendJSON(b []byte) ([]byte, error) {
+	if groupCallVideoQualityFull == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, groupCallVideoQualityFull.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, groupCallVideoQualityFull.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (groupCallVideoQualityFull *GroupCallVideoQualityFull) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			groupCallVideoQualityFull.tdCommon.Type, err = r.readString()
+		case "@extra":
+			groupCallVideoQualityFull.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (groupCallVideoQualityFull *GroupCallVideoQualityFull) Clone() *GroupCallVideoQualityFull {
+	if groupCallVideoQualityFull == nil {
+		return nil
+	}
+	clone := *groupCallVideoQualityFull
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (groupCallVideoQualityFull *GroupCallVideoQualityFull) Equal(other *GroupCallVideoQualityFull) bool {
+	if groupCallVideoQualityFull == nil || other == nil {
+		return groupCallVideoQualityFull == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (groupCallVideoQualityFull *GroupCallVideoQualityFull) Diff(other *GroupCallVideoQualityFull) []Change {
+	return groupCallVideoQualityFull.diff("", other, nil)
+}
+
+func (groupCallVideoQualityFull *GroupCallVideoQualityFull) diff(path string, other *GroupCallVideoQualityFull, changes []Change) []Change {
+	if groupCallVideoQualityFull == nil || other == nil {
+		if groupCallVideoQualityFull != other {
+			changes = append(changes, Change{Path: path, Old: groupCallVideoQualityFull, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (groupCallVideoQualityFull *GroupCallVideoQualityFull) String() string {
+	return objectString(groupCallVideoQualityFull)
+}
+
+// LogValue logs the object as its summary
+func (groupCallVideoQualityFull *GroupCallVideoQualityFull) LogValue() slog.Value {
+	return slog.StringValue(objectString(groupCallVideoQualityFull))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (groupCallVideoQualityFull *GroupCallVideoQualityFull) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, groupCallVideoQualityFull)
+}
+
+func (groupCallVideoQualityFull *GroupCallVideoQualityFull) printText(p *printer) {
+	if groupCallVideoQualityFull == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("GroupCallVideoQualityFull")
+	p.endObject(state)
+}
+
 // GetGroupCallVideoQualityEnum return the enum type of this object
 func (groupCallVideoQualityFull *GroupCallVideoQualityFull) GetGroupCallVideoQualityEnum() GroupCallVideoQualityEnum {
 	return GroupCallVideoQualityFullType
 }
+
+// Accept calls the method of the visitor for GroupCallVideoQualityFull
+func (groupCallVideoQualityFull *GroupCallVideoQualityFull) Accept(visitor GroupCallVideoQualityVisitor) {
+	visitor.VisitGroupCallVideoQualityFull(groupCallVideoQualityFull)
+}
+
+// GroupCallVideoQualityVisitor visits every type of GroupCallVideoQuality, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to GroupCallVideoQuality, until they handle it too.
+type GroupCallVideoQualityVisitor interface {
+	VisitGroupCallVideoQualityThumbnail(groupCallVideoQualityThumbnail *GroupCallVideoQualityThumbnail)
+	VisitGroupCallVideoQualityMedium(groupCallVideoQualityMedium *GroupCallVideoQualityMedium)
+	VisitGroupCallVideoQualityFull(groupCallVideoQualityFull *GroupCallVideoQualityFull)
+	VisitUnknownGroupCallVideoQuality(unknownGroupCallVideoQuality *UnknownGroupCallVideoQuality)
+}
+
+// GroupCallVideoQualityCases holds the function MatchGroupCallVideoQuality calls for each type of GroupCallVideoQuality;
+// types without a function are passed to the default case
+type GroupCallVideoQualityCases[R any] struct {
+	GroupCallVideoQualityThumbnail func(groupCallVideoQualityThumbnail *GroupCallVideoQualityThumbnail) R
+	GroupCallVideoQualityMedium    func(groupCallVideoQualityMedium *GroupCallVideoQualityMedium) R
+	GroupCallVideoQualityFull      func(groupCallVideoQualityFull *GroupCallVideoQualityFull) R
+}
+
+// MatchGroupCallVideoQuality calls the case of the type of groupCallVideoQuality, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement GroupCallVideoQualityVisitor
+// to have the compiler check every type is handled.
+func MatchGroupCallVideoQuality[R any](groupCallVideoQuality GroupCallVideoQuality, cases GroupCallVideoQualityCases[R], defaultCase func(groupCallVideoQuality GroupCallVideoQuality) R) R {
+	switch value := groupCallVideoQuality.(type) {
+	case *GroupCallVideoQualityThumbnail:
+		if cases.GroupCallVideoQualityThumbnail != nil {
+			return cases.GroupCallVideoQualityThumbnail(value)
+		}
+	case *GroupCallVideoQualityMedium:
+		if cases.GroupCallVideoQualityMedium != nil {
+			return cases.GroupCallVideoQualityMedium(value)
+		}
+	case *GroupCallVideoQualityFull:
+		if cases.GroupCallVideoQualityFull != nil {
+			return cases.GroupCallVideoQualityFull(value)
+		}
+	}
+	return defaultCase(groupCallVideoQuality)
+}
+
+// Clone returns a deep copy of the object
+func (unknownGroupCallVideoQuality *UnknownGroupCallVideoQuality) Clone() *UnknownGroupCallVideoQuality {
+	if unknownGroupCallVideoQuality == nil {
+		return nil
+	}
+	return &UnknownGroupCallVideoQuality{Unknown: unknownGroupCallVideoQuality.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownGroupCallVideoQuality *UnknownGroupCallVideoQuality) Equal(other *UnknownGroupCallVideoQuality) bool {
+	if unknownGroupCallVideoQuality == nil || other == nil {
+		return unknownGroupCallVideoQuality == other
+	}
+	return unknownGroupCallVideoQuality.Unknown.equal(other.Unknown)
+}
+
+func cloneGroupCallVideoQuality(groupCallVideoQuality GroupCallVideoQuality) GroupCallVideoQuality {
+	switch value := groupCallVideoQuality.(type) {
+	case *GroupCallVideoQualityThumbnail:
+		return value.Clone()
+	case *GroupCallVideoQualityMedium:
+		return value.Clone()
+	case *GroupCallVideoQualityFull:
+		return value.Clone()
+	case *UnknownGroupCallVideoQuality:
+		return value.Clone()
+	}
+	return groupCallVideoQuality
+}
+
+func equalGroupCallVideoQuality(a GroupCallVideoQuality, b GroupCallVideoQuality) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *GroupCallVideoQualityThumbnail:
+		b, ok := b.(*GroupCallVideoQualityThumbnail)
+		return ok && a.Equal(b)
+	case *GroupCallVideoQualityMedium:
+		b, ok := b.(*GroupCallVideoQualityMedium)
+		return ok && a.Equal(b)
+	case *GroupCallVideoQualityFull:
+		b, ok := b.(*GroupCallVideoQualityFull)
+		return ok && a.Equal(b)
+	case *UnknownGroupCallVideoQuality:
+		b, ok := b.(*UnknownGroupCallVideoQuality)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffGroupCallVideoQuality(path string, a GroupCallVideoQuality, b GroupCallVideoQuality, changes []Change) []Change {
+	switch a := a.(type) {
+	case *GroupCallVideoQualityThumbnail:
+		if b, ok := b.(*GroupCallVideoQualityThumbnail); ok {
+			return a.diff(path, b, changes)
+		}
+	case *GroupCallVideoQualityMedium:
+		if b, ok := b.(*GroupCallVideoQualityMedium); ok {
+			return a.diff(path, b, changes)
+		}
+	case *GroupCallVideoQualityFull:
+		if b, ok := b.(*GroupCallVideoQualityFull); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalGroupCallVideoQuality(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // GroupCallVideoSourceGroup Describes a group of video synchronization source identifiers
 type GroupCallVideoSourceGroup struct {
 	tdCommon
@@ -25,3 +30,154 @@ func NewGroupCallVideoSourceGroup(semantics string, sourceIds []int32) *GroupCal
 
 	return &groupCallVideoSourceGroupTemp
 }
+
+// MarshalJSON marshals to json
+func (groupCallVideoSourceGroup *GroupCallVideoSourceGroup) MarshalJSON() ([]byte, error) {
+	return groupCallVideoSourceGroup.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (groupCallVideoSourceGroup *GroupCallVideoSourceGroup) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, groupCallVideoSourceGroup)
+}
+
+func (groupCallVideoSourceGroup *GroupCallVideoSourceGroup) appendJSON(b []byte) ([]byte, error) {
+	if groupCallVideoSourceGroup == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, groupCallVideoSourceGroup.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, groupCallVideoSourceGroup.tdCommon.Extra)
+	b = append(b, `,"semantics":`...)
+	b = appendJSONString(b, groupCallVideoSourceGroup.Semantics)
+	b = append(b, `,"source_ids":`...)
+	if groupCallVideoSourceGroup.SourceIds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range groupCallVideoSourceGroup.SourceIds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(groupCallVideoSourceGroup.SourceIds[i0]))
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (groupCallVideoSourceGroup *GroupCallVideoSourceGroup) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			groupCallVideoSourceGroup.tdCommon.Type, err = r.readString()
+		case "@extra":
+			groupCallVideoSourceGroup.tdCommon.Extra, err = r.readString()
+		case "semantics":
+			groupCallVideoSourceGroup.Semantics, err = r.readString()
+		case "source_ids":
+			if r.readNull() {
+				groupCallVideoSourceGroup.SourceIds = nil
+			} else {
+				groupCallVideoSourceGroup.SourceIds = make([]int32, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int32
+					item0, err = r.readInt32()
+					groupCallVideoSourceGroup.SourceIds = append(groupCallVideoSourceGroup.SourceIds, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (groupCallVideoSourceGroup *GroupCallVideoSourceGroup) Clone() *GroupCallVideoSourceGroup {
+	if groupCallVideoSourceGroup == nil {
+		return nil
+	}
+	clone := *groupCallVideoSourceGroup
+	if groupCallVideoSourceGroup.SourceIds != nil {
+		clone.SourceIds = make([]int32, len(groupCallVideoSourceGroup.SourceIds))
+		copy(clone.SourceIds, groupCallVideoSourceGroup.SourceIds)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (groupCallVideoSourceGroup *GroupCallVideoSourceGroup) Equal(other *GroupCallVideoSourceGroup) bool {
+	if groupCallVideoSourceGroup == nil || other == nil {
+		return groupCallVideoSourceGroup == other
+	}
+	if groupCallVideoSourceGroup.Semantics != other.Semantics {
+		return false
+	}
+	if len(groupCallVideoSourceGroup.SourceIds) != len(other.SourceIds) {
+		return false
+	}
+	for i0 := range groupCallVideoSourceGroup.SourceIds {
+		if groupCallVideoSourceGroup.SourceIds[i0] != other.SourceIds[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (groupCallVideoSourceGroup *GroupCallVideoSourceGroup) Diff(other *GroupCallVideoSourceGroup) []Change {
+	return groupCallVideoSourceGroup.diff("", other, nil)
+}
+
+func (groupCallVideoSourceGroup *GroupCallVideoSourceGroup) diff(path string, other *GroupCallVideoSourceGroup, changes []Change) []Change {
+	if groupCallVideoSourceGroup == nil || other == nil {
+		if groupCallVideoSourceGroup != other {
+			changes = append(changes, Change{Path: path, Old: groupCallVideoSourceGroup, New: other})
+		}
+		return changes
+	}
+	if groupCallVideoSourceGroup.Semantics != other.Semantics {
+		changes = append(changes, Change{Path: fieldPath(path, "semantics"), Old: groupCallVideoSourceGroup.Semantics, New: other.Semantics})
+	}
+	if len(groupCallVideoSourceGroup.SourceIds) != len(other.SourceIds) {
+		changes = append(changes, Change{Path: fieldPath(path, "source_ids"), Old: groupCallVideoSourceGroup.SourceIds, New: other.SourceIds})
+	} else {
+		for i0 := range groupCallVideoSourceGroup.SourceIds {
+			if groupCallVideoSourceGroup.SourceIds[i0] != other.SourceIds[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "source_ids"), i0), Old: groupCallVideoSourceGroup.SourceIds[i0], New: other.SourceIds[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (groupCallVideoSourceGroup *GroupCallVideoSourceGroup) String() string {
+	return objectString(groupCallVideoSourceGroup)
+}
+
+// LogValue logs the object as its summary
+func (groupCallVideoSourceGroup *GroupCallVideoSourceGroup) LogValue() slog.Value {
+	return slog.StringValue(objectString(groupCallVideoSourceGroup))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (groupCallVideoSourceGroup *GroupCallVideoSourceGroup) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, groupCallVideoSourceGroup)
+}
+
+func (groupCallVideoSourceGroup *GroupCallVideoSourceGroup) printText(p *printer) {
+	if groupCallVideoSourceGroup == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("GroupCallVideoSourceGroup")
+	p.string("semantics", groupCallVideoSourceGroup.Semantics)
+	p.vector("source_ids", len(groupCallVideoSourceGroup.SourceIds), func(i0 int) {
+		p.int("source_ids", int64(groupCallVideoSourceGroup.SourceIds[i0]))
+	})
+	p.endObject(state)
+}
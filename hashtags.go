@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // Hashtags Contains a list of hashtags
@@ -28,6 +28,146 @@ func NewHashtags(hashtags []string) *Hashtags {
 	return &hashtagsTemp
 }
 
+// MarshalJSON marshals to json
+func (hashtags *Hashtags) MarshalJSON() ([]byte, error) {
+	return hashtags.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (hashtags *Hashtags) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, hashtags)
+}
+
+func (hashtags *Hashtags) appendJSON(b []byte) ([]byte, error) {
+	if hashtags == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, hashtags.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, hashtags.tdCommon.Extra)
+	b = append(b, `,"hashtags":`...)
+	if hashtags.Hashtags == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range hashtags.Hashtags {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONString(b, hashtags.Hashtags[i0])
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (hashtags *Hashtags) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			hashtags.tdCommon.Type, err = r.readString()
+		case "@extra":
+			hashtags.tdCommon.Extra, err = r.readString()
+		case "hashtags":
+			if r.readNull() {
+				hashtags.Hashtags = nil
+			} else {
+				hashtags.Hashtags = make([]string, 0)
+				err = r.readArray(func() (err error) {
+					var item0 string
+					item0, err = r.readString()
+					hashtags.Hashtags = append(hashtags.Hashtags, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (hashtags *Hashtags) Clone() *Hashtags {
+	if hashtags == nil {
+		return nil
+	}
+	clone := *hashtags
+	if hashtags.Hashtags != nil {
+		clone.Hashtags = make([]string, len(hashtags.Hashtags))
+		copy(clone.Hashtags, hashtags.Hashtags)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (hashtags *Hashtags) Equal(other *Hashtags) bool {
+	if hashtags == nil || other == nil {
+		return hashtags == other
+	}
+	if len(hashtags.Hashtags) != len(other.Hashtags) {
+		return false
+	}
+	for i0 := range hashtags.Hashtags {
+		if hashtags.Hashtags[i0] != other.Hashtags[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (hashtags *Hashtags) Diff(other *Hashtags) []Change {
+	return hashtags.diff("", other, nil)
+}
+
+func (hashtags *Hashtags) diff(path string, other *Hashtags, changes []Change) []Change {
+	if hashtags == nil || other == nil {
+		if hashtags != other {
+			changes = append(changes, Change{Path: path, Old: hashtags, New: other})
+		}
+		return changes
+	}
+	if len(hashtags.Hashtags) != len(other.Hashtags) {
+		changes = append(changes, Change{Path: fieldPath(path, "hashtags"), Old: hashtags.Hashtags, New: other.Hashtags})
+	} else {
+		for i0 := range hashtags.Hashtags {
+			if hashtags.Hashtags[i0] != other.Hashtags[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "hashtags"), i0), Old: hashtags.Hashtags[i0], New: other.Hashtags[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (hashtags *Hashtags) String() string {
+	return objectString(hashtags)
+}
+
+// LogValue logs the object as its summary
+func (hashtags *Hashtags) LogValue() slog.Value {
+	return slog.StringValue(objectString(hashtags))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (hashtags *Hashtags) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, hashtags)
+}
+
+func (hashtags *Hashtags) printText(p *printer) {
+	if hashtags == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Hashtags")
+	p.vector("hashtags", len(hashtags.Hashtags), func(i0 int) {
+		p.string("hashtags", hashtags.Hashtags[i0])
+	})
+	p.endObject(state)
+}
+
 // SearchHashtags Searches for recently used hashtags by their prefix
 // @param prefix Hashtag prefix to search for
 // @param limit The maximum number of hashtags to be returned
@@ -42,11 +182,11 @@ func (client *Client) SearchHashtags(prefix string, limit int32) (*Hashtags, err
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var hashtags Hashtags
-	err = json.Unmarshal(result.Raw, &hashtags)
+	err = jsonUnmarshal(result.Raw, &hashtags)
 	return &hashtags, err
 }
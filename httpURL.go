@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // HttpUrl Contains an HTTP URL
@@ -28,6 +28,108 @@ func NewHttpUrl(url string) *HttpUrl {
 	return &httpUrlTemp
 }
 
+// MarshalJSON marshals to json
+func (httpUrl *HttpUrl) MarshalJSON() ([]byte, error) {
+	return httpUrl.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (httpUrl *HttpUrl) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, httpUrl)
+}
+
+func (httpUrl *HttpUrl) appendJSON(b []byte) ([]byte, error) {
+	if httpUrl == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, httpUrl.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, httpUrl.tdCommon.Extra)
+	b = append(b, `,"url":`...)
+	b = appendJSONString(b, httpUrl.Url)
+	return append(b, '}'), nil
+}
+
+func (httpUrl *HttpUrl) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			httpUrl.tdCommon.Type, err = r.readString()
+		case "@extra":
+			httpUrl.tdCommon.Extra, err = r.readString()
+		case "url":
+			httpUrl.Url, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (httpUrl *HttpUrl) Clone() *HttpUrl {
+	if httpUrl == nil {
+		return nil
+	}
+	clone := *httpUrl
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (httpUrl *HttpUrl) Equal(other *HttpUrl) bool {
+	if httpUrl == nil || other == nil {
+		return httpUrl == other
+	}
+	if httpUrl.Url != other.Url {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (httpUrl *HttpUrl) Diff(other *HttpUrl) []Change {
+	return httpUrl.diff("", other, nil)
+}
+
+func (httpUrl *HttpUrl) diff(path string, other *HttpUrl, changes []Change) []Change {
+	if httpUrl == nil || other == nil {
+		if httpUrl != other {
+			changes = append(changes, Change{Path: path, Old: httpUrl, New: other})
+		}
+		return changes
+	}
+	if httpUrl.Url != other.Url {
+		changes = append(changes, Change{Path: fieldPath(path, "url"), Old: httpUrl.Url, New: other.Url})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (httpUrl *HttpUrl) String() string {
+	return objectString(httpUrl)
+}
+
+// LogValue logs the object as its summary
+func (httpUrl *HttpUrl) LogValue() slog.Value {
+	return slog.StringValue(objectString(httpUrl))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (httpUrl *HttpUrl) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, httpUrl)
+}
+
+func (httpUrl *HttpUrl) printText(p *printer) {
+	if httpUrl == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("HttpUrl")
+	p.string("url", httpUrl.Url)
+	p.endObject(state)
+}
+
 // GetLoginUrl Returns an HTTP URL which can be used to automatically authorize the user on a website after clicking an inline button of type inlineKeyboardButtonTypeLoginUrl. Use the method getLoginUrlInfo to find whether a prior user confirmation is needed. If an error is returned, then the button must be handled as an ordinary URL button
 // @param chatId Chat identifier of the message with the button
 // @param messageId Message identifier of the message with the button
@@ -46,12 +148,12 @@ func (client *Client) GetLoginUrl(chatId int64, messageId int64, buttonId int64,
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var httpUrl HttpUrl
-	err = json.Unmarshal(result.Raw, &httpUrl)
+	err = jsonUnmarshal(result.Raw, &httpUrl)
 	return &httpUrl, err
 }
 
@@ -69,12 +171,12 @@ func (client *Client) GetExternalLink(link string, allowWriteAccess bool) (*Http
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var httpUrl HttpUrl
-	err = json.Unmarshal(result.Raw, &httpUrl)
+	err = jsonUnmarshal(result.Raw, &httpUrl)
 	return &httpUrl, err
 }
 
@@ -92,12 +194,12 @@ func (client *Client) GetGroupCallInviteLink(groupCallId int32, canSelfUnmute bo
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var httpUrl HttpUrl
-	err = json.Unmarshal(result.Raw, &httpUrl)
+	err = jsonUnmarshal(result.Raw, &httpUrl)
 	return &httpUrl, err
 }
 
@@ -113,12 +215,12 @@ func (client *Client) GetEmojiSuggestionsUrl(languageCode string) (*HttpUrl, err
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var httpUrl HttpUrl
-	err = json.Unmarshal(result.Raw, &httpUrl)
+	err = jsonUnmarshal(result.Raw, &httpUrl)
 	return &httpUrl, err
 }
 
@@ -136,12 +238,12 @@ func (client *Client) GetBackgroundUrl(name string, typeParam BackgroundType) (*
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var httpUrl HttpUrl
-	err = json.Unmarshal(result.Raw, &httpUrl)
+	err = jsonUnmarshal(result.Raw, &httpUrl)
 	return &httpUrl, err
 }
 
@@ -155,12 +257,12 @@ func (client *Client) GetApplicationDownloadLink() (*HttpUrl, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var httpUrl HttpUrl
-	err = json.Unmarshal(result.Raw, &httpUrl)
+	err = jsonUnmarshal(result.Raw, &httpUrl)
 	return &httpUrl, err
 }
 
@@ -176,11 +278,11 @@ func (client *Client) GetProxyLink(proxyId int32) (*HttpUrl, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var httpUrl HttpUrl
-	err = json.Unmarshal(result.Raw, &httpUrl)
+	err = jsonUnmarshal(result.Raw, &httpUrl)
 	return &httpUrl, err
 }
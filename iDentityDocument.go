@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // IdentityDocument An identity document
 type IdentityDocument struct {
 	tdCommon
@@ -37,3 +42,230 @@ func NewIdentityDocument(number string, expiryDate *Date, frontSide *DatedFile,
 
 	return &identityDocumentTemp
 }
+
+// MarshalJSON marshals to json
+func (identityDocument *IdentityDocument) MarshalJSON() ([]byte, error) {
+	return identityDocument.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (identityDocument *IdentityDocument) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, identityDocument)
+}
+
+func (identityDocument *IdentityDocument) appendJSON(b []byte) ([]byte, error) {
+	if identityDocument == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, identityDocument.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, identityDocument.tdCommon.Extra)
+	b = append(b, `,"number":`...)
+	b = appendJSONString(b, identityDocument.Number)
+	b = append(b, `,"expiry_date":`...)
+	b, err = identityDocument.ExpiryDate.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"front_side":`...)
+	b, err = identityDocument.FrontSide.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"reverse_side":`...)
+	b, err = identityDocument.ReverseSide.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"selfie":`...)
+	b, err = identityDocument.Selfie.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"translation":`...)
+	if identityDocument.Translation == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range identityDocument.Translation {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = identityDocument.Translation[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (identityDocument *IdentityDocument) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			identityDocument.tdCommon.Type, err = r.readString()
+		case "@extra":
+			identityDocument.tdCommon.Extra, err = r.readString()
+		case "number":
+			identityDocument.Number, err = r.readString()
+		case "expiry_date":
+			if r.readNull() {
+				identityDocument.ExpiryDate = nil
+			} else {
+				identityDocument.ExpiryDate = new(Date)
+				err = identityDocument.ExpiryDate.decodeJSON(r)
+			}
+		case "front_side":
+			if r.readNull() {
+				identityDocument.FrontSide = nil
+			} else {
+				identityDocument.FrontSide = new(DatedFile)
+				err = identityDocument.FrontSide.decodeJSON(r)
+			}
+		case "reverse_side":
+			if r.readNull() {
+				identityDocument.ReverseSide = nil
+			} else {
+				identityDocument.ReverseSide = new(DatedFile)
+				err = identityDocument.ReverseSide.decodeJSON(r)
+			}
+		case "selfie":
+			if r.readNull() {
+				identityDocument.Selfie = nil
+			} else {
+				identityDocument.Selfie = new(DatedFile)
+				err = identityDocument.Selfie.decodeJSON(r)
+			}
+		case "translation":
+			if r.readNull() {
+				identityDocument.Translation = nil
+			} else {
+				identityDocument.Translation = make([]DatedFile, 0)
+				err = r.readArray(func() (err error) {
+					var item0 DatedFile
+					err = item0.decodeJSON(r)
+					identityDocument.Translation = append(identityDocument.Translation, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (identityDocument *IdentityDocument) Clone() *IdentityDocument {
+	if identityDocument == nil {
+		return nil
+	}
+	clone := *identityDocument
+	clone.ExpiryDate = identityDocument.ExpiryDate.Clone()
+	clone.FrontSide = identityDocument.FrontSide.Clone()
+	clone.ReverseSide = identityDocument.ReverseSide.Clone()
+	clone.Selfie = identityDocument.Selfie.Clone()
+	if identityDocument.Translation != nil {
+		clone.Translation = make([]DatedFile, len(identityDocument.Translation))
+		for i0 := range identityDocument.Translation {
+			clone.Translation[i0] = *identityDocument.Translation[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (identityDocument *IdentityDocument) Equal(other *IdentityDocument) bool {
+	if identityDocument == nil || other == nil {
+		return identityDocument == other
+	}
+	if identityDocument.Number != other.Number {
+		return false
+	}
+	if !identityDocument.ExpiryDate.Equal(other.ExpiryDate) {
+		return false
+	}
+	if !identityDocument.FrontSide.Equal(other.FrontSide) {
+		return false
+	}
+	if !identityDocument.ReverseSide.Equal(other.ReverseSide) {
+		return false
+	}
+	if !identityDocument.Selfie.Equal(other.Selfie) {
+		return false
+	}
+	if len(identityDocument.Translation) != len(other.Translation) {
+		return false
+	}
+	for i0 := range identityDocument.Translation {
+		if !identityDocument.Translation[i0].Equal(&other.Translation[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (identityDocument *IdentityDocument) Diff(other *IdentityDocument) []Change {
+	return identityDocument.diff("", other, nil)
+}
+
+func (identityDocument *IdentityDocument) diff(path string, other *IdentityDocument, changes []Change) []Change {
+	if identityDocument == nil || other == nil {
+		if identityDocument != other {
+			changes = append(changes, Change{Path: path, Old: identityDocument, New: other})
+		}
+		return changes
+	}
+	if identityDocument.Number != other.Number {
+		changes = append(changes, Change{Path: fieldPath(path, "number"), Old: identityDocument.Number, New: other.Number})
+	}
+	changes = identityDocument.ExpiryDate.diff(fieldPath(path, "expiry_date"), other.ExpiryDate, changes)
+	changes = identityDocument.FrontSide.diff(fieldPath(path, "front_side"), other.FrontSide, changes)
+	changes = identityDocument.ReverseSide.diff(fieldPath(path, "reverse_side"), other.ReverseSide, changes)
+	changes = identityDocument.Selfie.diff(fieldPath(path, "selfie"), other.Selfie, changes)
+	if len(identityDocument.Translation) != len(other.Translation) {
+		changes = append(changes, Change{Path: fieldPath(path, "translation"), Old: identityDocument.Translation, New: other.Translation})
+	} else {
+		for i0 := range identityDocument.Translation {
+			changes = identityDocument.Translation[i0].diff(indexPath(fieldPath(path, "translation"), i0), &other.Translation[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (identityDocument *IdentityDocument) String() string {
+	return objectString(identityDocument)
+}
+
+// LogValue logs the object as its summary
+func (identityDocument *IdentityDocument) LogValue() slog.Value {
+	return slog.StringValue(objectString(identityDocument))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (identityDocument *IdentityDocument) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, identityDocument)
+}
+
+func (identityDocument *IdentityDocument) printText(p *printer) {
+	if identityDocument == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("IdentityDocument")
+	p.string("number", identityDocument.Number)
+	p.object("expiry_date", identityDocument.ExpiryDate)
+	p.object("front_side", identityDocument.FrontSide)
+	p.object("reverse_side", identityDocument.ReverseSide)
+	p.object("selfie", identityDocument.Selfie)
+	p.vector("translation", len(identityDocument.Translation), func(i0 int) {
+		identityDocument.Translation[i0].printText(p)
+	})
+	p.endObject(state)
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ImportedContacts Represents the result of an ImportContacts request
@@ -31,6 +31,195 @@ func NewImportedContacts(userIds []int64, importerCount []int32) *ImportedContac
 	return &importedContactsTemp
 }
 
+// MarshalJSON marshals to json
+func (importedContacts *ImportedContacts) MarshalJSON() ([]byte, error) {
+	return importedContacts.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (importedContacts *ImportedContacts) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, importedContacts)
+}
+
+func (importedContacts *ImportedContacts) appendJSON(b []byte) ([]byte, error) {
+	if importedContacts == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, importedContacts.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, importedContacts.tdCommon.Extra)
+	b = append(b, `,"user_ids":`...)
+	if importedContacts.UserIds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range importedContacts.UserIds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(importedContacts.UserIds[i0]))
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"importer_count":`...)
+	if importedContacts.ImporterCount == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range importedContacts.ImporterCount {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(importedContacts.ImporterCount[i0]))
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (importedContacts *ImportedContacts) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			importedContacts.tdCommon.Type, err = r.readString()
+		case "@extra":
+			importedContacts.tdCommon.Extra, err = r.readString()
+		case "user_ids":
+			if r.readNull() {
+				importedContacts.UserIds = nil
+			} else {
+				importedContacts.UserIds = make([]int64, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int64
+					item0, err = r.readInt64()
+					importedContacts.UserIds = append(importedContacts.UserIds, item0)
+					return
+				})
+			}
+		case "importer_count":
+			if r.readNull() {
+				importedContacts.ImporterCount = nil
+			} else {
+				importedContacts.ImporterCount = make([]int32, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int32
+					item0, err = r.readInt32()
+					importedContacts.ImporterCount = append(importedContacts.ImporterCount, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (importedContacts *ImportedContacts) Clone() *ImportedContacts {
+	if importedContacts == nil {
+		return nil
+	}
+	clone := *importedContacts
+	if importedContacts.UserIds != nil {
+		clone.UserIds = make([]int64, len(importedContacts.UserIds))
+		copy(clone.UserIds, importedContacts.UserIds)
+	}
+	if importedContacts.ImporterCount != nil {
+		clone.ImporterCount = make([]int32, len(importedContacts.ImporterCount))
+		copy(clone.ImporterCount, importedContacts.ImporterCount)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (importedContacts *ImportedContacts) Equal(other *ImportedContacts) bool {
+	if importedContacts == nil || other == nil {
+		return importedContacts == other
+	}
+	if len(importedContacts.UserIds) != len(other.UserIds) {
+		return false
+	}
+	for i0 := range importedContacts.UserIds {
+		if importedContacts.UserIds[i0] != other.UserIds[i0] {
+			return false
+		}
+	}
+	if len(importedContacts.ImporterCount) != len(other.ImporterCount) {
+		return false
+	}
+	for i0 := range importedContacts.ImporterCount {
+		if importedContacts.ImporterCount[i0] != other.ImporterCount[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (importedContacts *ImportedContacts) Diff(other *ImportedContacts) []Change {
+	return importedContacts.diff("", other, nil)
+}
+
+func (importedContacts *ImportedContacts) diff(path string, other *ImportedContacts, changes []Change) []Change {
+	if importedContacts == nil || other == nil {
+		if importedContacts != other {
+			changes = append(changes, Change{Path: path, Old: importedContacts, New: other})
+		}
+		return changes
+	}
+	if len(importedContacts.UserIds) != len(other.UserIds) {
+		changes = append(changes, Change{Path: fieldPath(path, "user_ids"), Old: importedContacts.UserIds, New: other.UserIds})
+	} else {
+		for i0 := range importedContacts.UserIds {
+			if importedContacts.UserIds[i0] != other.UserIds[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "user_ids"), i0), Old: importedContacts.UserIds[i0], New: other.UserIds[i0]})
+			}
+		}
+	}
+	if len(importedContacts.ImporterCount) != len(other.ImporterCount) {
+		changes = append(changes, Change{Path: fieldPath(path, "importer_count"), Old: importedContacts.ImporterCount, New: other.ImporterCount})
+	} else {
+		for i0 := range importedContacts.ImporterCount {
+			if importedContacts.ImporterCount[i0] != other.ImporterCount[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "importer_count"), i0), Old: importedContacts.ImporterCount[i0], New: other.ImporterCount[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (importedContacts *ImportedContacts) String() string {
+	return objectString(importedContacts)
+}
+
+// LogValue logs the object as its summary
+func (importedContacts *ImportedContacts) LogValue() slog.Value {
+	return slog.StringValue(objectString(importedContacts))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (importedContacts *ImportedContacts) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, importedContacts)
+}
+
+func (importedContacts *ImportedContacts) printText(p *printer) {
+	if importedContacts == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ImportedContacts")
+	p.vector("user_ids", len(importedContacts.UserIds), func(i0 int) {
+		p.int("user_ids", int64(importedContacts.UserIds[i0]))
+	})
+	p.vector("importer_count", len(importedContacts.ImporterCount), func(i0 int) {
+		p.int("importer_count", int64(importedContacts.ImporterCount[i0]))
+	})
+	p.endObject(state)
+}
+
 // ImportContacts Adds new contacts or edits existing contacts by their phone numbers; contacts' user identifiers are ignored
 // @param contacts The list of contacts to import or edit; contacts' vCard are ignored and are not imported
 func (client *Client) ImportContacts(contacts []Contact) (*ImportedContacts, error) {
@@ -43,12 +232,12 @@ func (client *Client) ImportContacts(contacts []Contact) (*ImportedContacts, err
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var importedContacts ImportedContacts
-	err = json.Unmarshal(result.Raw, &importedContacts)
+	err = jsonUnmarshal(result.Raw, &importedContacts)
 	return &importedContacts, err
 }
 
@@ -64,11 +253,11 @@ func (client *Client) ChangeImportedContacts(contacts []Contact) (*ImportedConta
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var importedContacts ImportedContacts
-	err = json.Unmarshal(result.Raw, &importedContacts)
+	err = jsonUnmarshal(result.Raw, &importedContacts)
 	return &importedContacts, err
 }
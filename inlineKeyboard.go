@@ -0,0 +1,148 @@
+package tdlib
+
+import (
+	"errors"
+	"fmt"
+)
+
+// InlineKeyboardBuilder builds an inline keyboard row by row. Buttons are added to the current row,
+// which Row ends; with Columns, rows also end when they're full. Mistakes, e.g. callback data which
+// can't be encoded, are returned by Build.
+//
+//	keyboard, err := tdlib.NewInlineKeyboardBuilder().Codec(codec).Columns(2).
+//		Callback("👍", "vote", Vote{PollID: 1, Up: true}).Callback("👎", "vote", Vote{PollID: 1}).
+//		Row().URL("Results", "https://example.com/results").Build()
+type InlineKeyboardBuilder struct {
+	rows    [][]InlineKeyboardButton
+	columns int
+	codec   *CallbackCodec
+	err     error
+}
+
+// NewInlineKeyboardBuilder creates an empty inline keyboard builder
+func NewInlineKeyboardBuilder() *InlineKeyboardBuilder {
+	return &InlineKeyboardBuilder{codec: NewCallbackCodec(nil, nil)}
+}
+
+// Codec sets the codec Callback encodes values with; by default values are neither signed nor stored
+func (builder *InlineKeyboardBuilder) Codec(codec *CallbackCodec) *InlineKeyboardBuilder {
+	builder.codec = codec
+	return builder
+}
+
+// Columns ends rows when they have the given number of buttons, unless it's 0
+func (builder *InlineKeyboardBuilder) Columns(columns int) *InlineKeyboardBuilder {
+	builder.columns = columns
+	return builder
+}
+
+// Row ends the current row, unless it's empty
+func (builder *InlineKeyboardBuilder) Row() *InlineKeyboardBuilder {
+	if len(builder.rows) != 0 && len(builder.rows[len(builder.rows)-1]) != 0 {
+		builder.rows = append(builder.rows, nil)
+	}
+	return builder
+}
+
+// Button adds a button of any type
+func (builder *InlineKeyboardBuilder) Button(text string, buttonType InlineKeyboardButtonType) *InlineKeyboardBuilder {
+	last := len(builder.rows) - 1
+	if last < 0 || (builder.columns > 0 && len(builder.rows[last]) >= builder.columns) {
+		builder.rows = append(builder.rows, nil)
+		last++
+	}
+	builder.rows[last] = append(builder.rows[last], *NewInlineKeyboardButton(text, buttonType))
+	return builder
+}
+
+// Callback adds a button which sends a callback query with a value encoded by the codec of the builder
+// under a prefix, see CallbackCodec
+func (builder *InlineKeyboardBuilder) Callback(text string, prefix string, value interface{}) *InlineKeyboardBuilder {
+	data, err := builder.codec.Encode(prefix, value)
+	if err != nil {
+		if builder.err == nil {
+			builder.err = fmt.Errorf("button %q: %v", text, err)
+		}
+		return builder
+	}
+	return builder.CallbackData(text, data)
+}
+
+// CallbackData adds a button which sends a callback query with raw data
+func (builder *InlineKeyboardBuilder) CallbackData(text string, data []byte) *InlineKeyboardBuilder {
+	return builder.Button(text, NewInlineKeyboardButtonTypeCallback(data))
+}
+
+// CallbackWithPassword adds a button which asks for the password of the user, then sends a callback query with raw data
+func (builder *InlineKeyboardBuilder) CallbackWithPassword(text string, data []byte) *InlineKeyboardBuilder {
+	return builder.Button(text, NewInlineKeyboardButtonTypeCallbackWithPassword(data))
+}
+
+// URL adds a button which opens an HTTP or tg:// URL
+func (builder *InlineKeyboardBuilder) URL(text string, url string) *InlineKeyboardBuilder {
+	return builder.Button(text, NewInlineKeyboardButtonTypeUrl(url))
+}
+
+// LoginURL adds a button which opens an HTTP URL and authorizes the user on the website; id identifies the button
+func (builder *InlineKeyboardBuilder) LoginURL(text string, url string, id int64, forwardText string) *InlineKeyboardBuilder {
+	return builder.Button(text, NewInlineKeyboardButtonTypeLoginUrl(url, id, forwardText))
+}
+
+// SwitchInline adds a button which lets the user choose a chat and inserts an inline query to the bot in its input field
+func (builder *InlineKeyboardBuilder) SwitchInline(text string, query string) *InlineKeyboardBuilder {
+	return builder.Button(text, NewInlineKeyboardButtonTypeSwitchInline(query, false))
+}
+
+// SwitchInlineCurrentChat adds a button which inserts an inline query to the bot in the input field of the current chat
+func (builder *InlineKeyboardBuilder) SwitchInlineCurrentChat(text string, query string) *InlineKeyboardBuilder {
+	return builder.Button(text, NewInlineKeyboardButtonTypeSwitchInline(query, true))
+}
+
+// Game adds a button which launches the game of the message; it must be the first button of the keyboard
+func (builder *InlineKeyboardBuilder) Game(text string) *InlineKeyboardBuilder {
+	return builder.Button(text, NewInlineKeyboardButtonTypeCallbackGame())
+}
+
+// Buy adds a button which pays the invoice of the message; it must be the first button of the keyboard
+func (builder *InlineKeyboardBuilder) Buy(text string) *InlineKeyboardBuilder {
+	return builder.Button(text, NewInlineKeyboardButtonTypeBuy())
+}
+
+// User adds a button which opens the profile of a user
+func (builder *InlineKeyboardBuilder) User(text string, userID int64) *InlineKeyboardBuilder {
+	return builder.Button(text, NewInlineKeyboardButtonTypeUser(userID))
+}
+
+// Build returns the keyboard, or the first mistake made building it
+func (builder *InlineKeyboardBuilder) Build() (*ReplyMarkupInlineKeyboard, error) {
+	if builder.err != nil {
+		return nil, builder.err
+	}
+
+	var rows [][]InlineKeyboardButton
+	for _, row := range builder.rows {
+		if len(row) != 0 {
+			rows = append(rows, row)
+		}
+	}
+	if len(rows) == 0 {
+		return nil, errors.New("inline keyboard has no buttons")
+	}
+
+	for i, row := range rows {
+		for j, button := range row {
+			switch button.Type.(type) {
+			case *InlineKeyboardButtonTypeCallbackGame, *InlineKeyboardButtonTypeBuy:
+				if i != 0 || j != 0 {
+					return nil, fmt.Errorf("button %q must be the first button of the keyboard", button.Text)
+				}
+			}
+		}
+	}
+
+	keyboard := NewReplyMarkupInlineKeyboard(rows)
+	if err := DefaultLimits.ValidateReplyMarkup(keyboard); err != nil {
+		return nil, err
+	}
+	return keyboard, nil
+}
@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // InlineKeyboardButton Represents a single button in an inline keyboard
@@ -30,28 +31,118 @@ func NewInlineKeyboardButton(text string, typeParam InlineKeyboardButtonType) *I
 	return &inlineKeyboardButtonTemp
 }
 
+// MarshalJSON marshals to json
+func (inlineKeyboardButton *InlineKeyboardButton) MarshalJSON() ([]byte, error) {
+	return inlineKeyboardButton.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inlineKeyboardButton *InlineKeyboardButton) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, inlineKeyboardButton)
+}
+
+func (inlineKeyboardButton *InlineKeyboardButton) appendJSON(b []byte) ([]byte, error) {
+	if inlineKeyboardButton == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inlineKeyboardButton.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inlineKeyboardButton.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b = appendJSONString(b, inlineKeyboardButton.Text)
+	b = append(b, `,"type":`...)
+	b, err = appendJSONValue(b, inlineKeyboardButton.Type)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-		Text string `json:"text"` // Text of the button
+	return append(b, '}'), nil
+}
 
-	}{}
-	err = json.Unmarshal(b, &tempObj)
-	if err != nil {
-		return err
+func (inlineKeyboardButton *InlineKeyboardButton) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inlineKeyboardButton.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inlineKeyboardButton.tdCommon.Extra, err = r.readString()
+		case "text":
+			inlineKeyboardButton.Text, err = r.readString()
+		case "type":
+			inlineKeyboardButton.Type, err = decodeInlineKeyboardButtonType(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inlineKeyboardButton *InlineKeyboardButton) Clone() *InlineKeyboardButton {
+	if inlineKeyboardButton == nil {
+		return nil
+	}
+	clone := *inlineKeyboardButton
+	clone.Type = cloneInlineKeyboardButtonType(inlineKeyboardButton.Type)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inlineKeyboardButton *InlineKeyboardButton) Equal(other *InlineKeyboardButton) bool {
+	if inlineKeyboardButton == nil || other == nil {
+		return inlineKeyboardButton == other
+	}
+	if inlineKeyboardButton.Text != other.Text {
+		return false
 	}
+	if !equalInlineKeyboardButtonType(inlineKeyboardButton.Type, other.Type) {
+		return false
+	}
+	return true
+}
 
-	inlineKeyboardButton.tdCommon = tempObj.tdCommon
-	inlineKeyboardButton.Text = tempObj.Text
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inlineKeyboardButton *InlineKeyboardButton) Diff(other *InlineKeyboardButton) []Change {
+	return inlineKeyboardButton.diff("", other, nil)
+}
 
-	fieldType, _ := unmarshalInlineKeyboardButtonType(objMap["type"])
-	inlineKeyboardButton.Type = fieldType
+func (inlineKeyboardButton *InlineKeyboardButton) diff(path string, other *InlineKeyboardButton, changes []Change) []Change {
+	if inlineKeyboardButton == nil || other == nil {
+		if inlineKeyboardButton != other {
+			changes = append(changes, Change{Path: path, Old: inlineKeyboardButton, New: other})
+		}
+		return changes
+	}
+	if inlineKeyboardButton.Text != other.Text {
+		changes = append(changes, Change{Path: fieldPath(path, "text"), Old: inlineKeyboardButton.Text, New: other.Text})
+	}
+	changes = diffInlineKeyboardButtonType(fieldPath(path, "type"), inlineKeyboardButton.Type, other.Type, changes)
+	return changes
+}
 
-	return nil
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inlineKeyboardButton *InlineKeyboardButton) String() string {
+	return objectString(inlineKeyboardButton)
+}
+
+// LogValue logs the object as its summary
+func (inlineKeyboardButton *InlineKeyboardButton) LogValue() slog.Value {
+	return slog.StringValue(objectString(inlineKeyboardButton))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inlineKeyboardButton *InlineKeyboardButton) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inlineKeyboardButton)
+}
+
+func (inlineKeyboardButton *InlineKeyboardButton) printText(p *printer) {
+	if inlineKeyboardButton == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InlineKeyboardButton")
+	p.string("text", inlineKeyboardButton.Text)
+	p.object("type", inlineKeyboardButton.Type)
+	p.endObject(state)
 }
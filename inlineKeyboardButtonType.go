@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // InlineKeyboardButtonType Describes the type of an inline keyboard button
 type InlineKeyboardButtonType interface {
 	GetInlineKeyboardButtonTypeEnum() InlineKeyboardButtonTypeEnum
+	Accept(visitor InlineKeyboardButtonTypeVisitor)
 }
 
 // InlineKeyboardButtonTypeEnum Alias for abstract InlineKeyboardButtonType 'Sub-Classes', used as constant-enum here
@@ -25,61 +27,112 @@ const (
 	InlineKeyboardButtonTypeUserType                 InlineKeyboardButtonTypeEnum = "inlineKeyboardButtonTypeUser"
 )
 
+// UnknownInlineKeyboardButtonType is an InlineKeyboardButtonType of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownInlineKeyboardButtonType struct {
+	Unknown
+}
+
+// GetInlineKeyboardButtonTypeEnum return the enum type of this object
+func (unknownInlineKeyboardButtonType *UnknownInlineKeyboardButtonType) GetInlineKeyboardButtonTypeEnum() InlineKeyboardButtonTypeEnum {
+	return InlineKeyboardButtonTypeEnum(unknownInlineKeyboardButtonType.Type)
+}
+
+// Accept calls the method of the visitor for UnknownInlineKeyboardButtonType
+func (unknownInlineKeyboardButtonType *UnknownInlineKeyboardButtonType) Accept(visitor InlineKeyboardButtonTypeVisitor) {
+	visitor.VisitUnknownInlineKeyboardButtonType(unknownInlineKeyboardButtonType)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownInlineKeyboardButtonType *UnknownInlineKeyboardButtonType) String() string {
+	return objectString(unknownInlineKeyboardButtonType)
+}
+
+// LogValue logs the object as its summary
+func (unknownInlineKeyboardButtonType *UnknownInlineKeyboardButtonType) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownInlineKeyboardButtonType))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownInlineKeyboardButtonType *UnknownInlineKeyboardButtonType) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownInlineKeyboardButtonType)
+}
+
+func (unknownInlineKeyboardButtonType *UnknownInlineKeyboardButtonType) printText(p *printer) {
+	if unknownInlineKeyboardButtonType == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownInlineKeyboardButtonType")
+	p.string("@type", unknownInlineKeyboardButtonType.Type)
+	p.endObject(state)
+}
+
 func unmarshalInlineKeyboardButtonType(rawMsg *json.RawMessage) (InlineKeyboardButtonType, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch InlineKeyboardButtonTypeEnum(objMap["@type"].(string)) {
+	switch InlineKeyboardButtonTypeEnum(typeName) {
 	case InlineKeyboardButtonTypeUrlType:
 		var inlineKeyboardButtonTypeUrl InlineKeyboardButtonTypeUrl
-		err := json.Unmarshal(*rawMsg, &inlineKeyboardButtonTypeUrl)
+		err := jsonUnmarshal(*rawMsg, &inlineKeyboardButtonTypeUrl)
 		return &inlineKeyboardButtonTypeUrl, err
 
 	case InlineKeyboardButtonTypeLoginUrlType:
 		var inlineKeyboardButtonTypeLoginUrl InlineKeyboardButtonTypeLoginUrl
-		err := json.Unmarshal(*rawMsg, &inlineKeyboardButtonTypeLoginUrl)
+		err := jsonUnmarshal(*rawMsg, &inlineKeyboardButtonTypeLoginUrl)
 		return &inlineKeyboardButtonTypeLoginUrl, err
 
 	case InlineKeyboardButtonTypeCallbackType:
 		var inlineKeyboardButtonTypeCallback InlineKeyboardButtonTypeCallback
-		err := json.Unmarshal(*rawMsg, &inlineKeyboardButtonTypeCallback)
+		err := jsonUnmarshal(*rawMsg, &inlineKeyboardButtonTypeCallback)
 		return &inlineKeyboardButtonTypeCallback, err
 
 	case InlineKeyboardButtonTypeCallbackWithPasswordType:
 		var inlineKeyboardButtonTypeCallbackWithPassword InlineKeyboardButtonTypeCallbackWithPassword
-		err := json.Unmarshal(*rawMsg, &inlineKeyboardButtonTypeCallbackWithPassword)
+		err := jsonUnmarshal(*rawMsg, &inlineKeyboardButtonTypeCallbackWithPassword)
 		return &inlineKeyboardButtonTypeCallbackWithPassword, err
 
 	case InlineKeyboardButtonTypeCallbackGameType:
 		var inlineKeyboardButtonTypeCallbackGame InlineKeyboardButtonTypeCallbackGame
-		err := json.Unmarshal(*rawMsg, &inlineKeyboardButtonTypeCallbackGame)
+		err := jsonUnmarshal(*rawMsg, &inlineKeyboardButtonTypeCallbackGame)
 		return &inlineKeyboardButtonTypeCallbackGame, err
 
 	case InlineKeyboardButtonTypeSwitchInlineType:
 		var inlineKeyboardButtonTypeSwitchInline InlineKeyboardButtonTypeSwitchInline
-		err := json.Unmarshal(*rawMsg, &inlineKeyboardButtonTypeSwitchInline)
+		err := jsonUnmarshal(*rawMsg, &inlineKeyboardButtonTypeSwitchInline)
 		return &inlineKeyboardButtonTypeSwitchInline, err
 
 	case InlineKeyboardButtonTypeBuyType:
 		var inlineKeyboardButtonTypeBuy InlineKeyboardButtonTypeBuy
-		err := json.Unmarshal(*rawMsg, &inlineKeyboardButtonTypeBuy)
+		err := jsonUnmarshal(*rawMsg, &inlineKeyboardButtonTypeBuy)
 		return &inlineKeyboardButtonTypeBuy, err
 
 	case InlineKeyboardButtonTypeUserType:
 		var inlineKeyboardButtonTypeUser InlineKeyboardButtonTypeUser
-		err := json.Unmarshal(*rawMsg, &inlineKeyboardButtonTypeUser)
+		err := jsonUnmarshal(*rawMsg, &inlineKeyboardButtonTypeUser)
 		return &inlineKeyboardButtonTypeUser, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownInlineKeyboardButtonType{Unknown: unknown}, nil
+	}
+}
+
+func decodeInlineKeyboardButtonType(r *jsonReader) (InlineKeyboardButtonType, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
 	}
+	return unmarshalInlineKeyboardButtonType(rawMsg)
 }
 
 // InlineKeyboardButtonTypeUrl A button that opens a specified URL
@@ -105,11 +158,118 @@ func NewInlineKeyboardButtonTypeUrl(url string) *InlineKeyboardButtonTypeUrl {
 	return &inlineKeyboardButtonTypeUrlTemp
 }
 
+// MarshalJSON marshals to json
+func (inlineKeyboardButtonTypeUrl *InlineKeyboardButtonTypeUrl) MarshalJSON() ([]byte, error) {
+	return inlineKeyboardButtonTypeUrl.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inlineKeyboardButtonTypeUrl *InlineKeyboardButtonTypeUrl) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inlineKeyboardButtonTypeUrl)
+}
+
+func (inlineKeyboardButtonTypeUrl *InlineKeyboardButtonTypeUrl) appendJSON(b []byte) ([]byte, error) {
+	if inlineKeyboardButtonTypeUrl == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inlineKeyboardButtonTypeUrl.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inlineKeyboardButtonTypeUrl.tdCommon.Extra)
+	b = append(b, `,"url":`...)
+	b = appendJSONString(b, inlineKeyboardButtonTypeUrl.Url)
+	return append(b, '}'), nil
+}
+
+func (inlineKeyboardButtonTypeUrl *InlineKeyboardButtonTypeUrl) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inlineKeyboardButtonTypeUrl.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inlineKeyboardButtonTypeUrl.tdCommon.Extra, err = r.readString()
+		case "url":
+			inlineKeyboardButtonTypeUrl.Url, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inlineKeyboardButtonTypeUrl *InlineKeyboardButtonTypeUrl) Clone() *InlineKeyboardButtonTypeUrl {
+	if inlineKeyboardButtonTypeUrl == nil {
+		return nil
+	}
+	clone := *inlineKeyboardButtonTypeUrl
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inlineKeyboardButtonTypeUrl *InlineKeyboardButtonTypeUrl) Equal(other *InlineKeyboardButtonTypeUrl) bool {
+	if inlineKeyboardButtonTypeUrl == nil || other == nil {
+		return inlineKeyboardButtonTypeUrl == other
+	}
+	if inlineKeyboardButtonTypeUrl.Url != other.Url {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inlineKeyboardButtonTypeUrl *InlineKeyboardButtonTypeUrl) Diff(other *InlineKeyboardButtonTypeUrl) []Change {
+	return inlineKeyboardButtonTypeUrl.diff("", other, nil)
+}
+
+func (inlineKeyboardButtonTypeUrl *InlineKeyboardButtonTypeUrl) diff(path string, other *InlineKeyboardButtonTypeUrl, changes []Change) []Change {
+	if inlineKeyboardButtonTypeUrl == nil || other == nil {
+		if inlineKeyboardButtonTypeUrl != other {
+			changes = append(changes, Change{Path: path, Old: inlineKeyboardButtonTypeUrl, New: other})
+		}
+		return changes
+	}
+	if inlineKeyboardButtonTypeUrl.Url != other.Url {
+		changes = append(changes, Change{Path: fieldPath(path, "url"), Old: inlineKeyboardButtonTypeUrl.Url, New: other.Url})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inlineKeyboardButtonTypeUrl *InlineKeyboardButtonTypeUrl) String() string {
+	return objectString(inlineKeyboardButtonTypeUrl)
+}
+
+// LogValue logs the object as its summary
+func (inlineKeyboardButtonTypeUrl *InlineKeyboardButtonTypeUrl) LogValue() slog.Value {
+	return slog.StringValue(objectString(inlineKeyboardButtonTypeUrl))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inlineKeyboardButtonTypeUrl *InlineKeyboardButtonTypeUrl) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inlineKeyboardButtonTypeUrl)
+}
+
+func (inlineKeyboardButtonTypeUrl *InlineKeyboardButtonTypeUrl) printText(p *printer) {
+	if inlineKeyboardButtonTypeUrl == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InlineKeyboardButtonTypeUrl")
+	p.string("url", inlineKeyboardButtonTypeUrl.Url)
+	p.endObject(state)
+}
+
 // GetInlineKeyboardButtonTypeEnum return the enum type of this object
 func (inlineKeyboardButtonTypeUrl *InlineKeyboardButtonTypeUrl) GetInlineKeyboardButtonTypeEnum() InlineKeyboardButtonTypeEnum {
 	return InlineKeyboardButtonTypeUrlType
 }
 
+// Accept calls the method of the visitor for InlineKeyboardButtonTypeUrl
+func (inlineKeyboardButtonTypeUrl *InlineKeyboardButtonTypeUrl) Accept(visitor InlineKeyboardButtonTypeVisitor) {
+	visitor.VisitInlineKeyboardButtonTypeUrl(inlineKeyboardButtonTypeUrl)
+}
+
 // InlineKeyboardButtonTypeLoginUrl A button that opens a specified URL and automatically authorize the current user if allowed to do so
 type InlineKeyboardButtonTypeLoginUrl struct {
 	tdCommon
@@ -139,11 +299,140 @@ func NewInlineKeyboardButtonTypeLoginUrl(url string, id int64, forwardText strin
 	return &inlineKeyboardButtonTypeLoginUrlTemp
 }
 
+// MarshalJSON marshals to json
+func (inlineKeyboardButtonTypeLoginUrl *InlineKeyboardButtonTypeLoginUrl) MarshalJSON() ([]byte, error) {
+	return inlineKeyboardButtonTypeLoginUrl.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inlineKeyboardButtonTypeLoginUrl *InlineKeyboardButtonTypeLoginUrl) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inlineKeyboardButtonTypeLoginUrl)
+}
+
+func (inlineKeyboardButtonTypeLoginUrl *InlineKeyboardButtonTypeLoginUrl) appendJSON(b []byte) ([]byte, error) {
+	if inlineKeyboardButtonTypeLoginUrl == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inlineKeyboardButtonTypeLoginUrl.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inlineKeyboardButtonTypeLoginUrl.tdCommon.Extra)
+	b = append(b, `,"url":`...)
+	b = appendJSONString(b, inlineKeyboardButtonTypeLoginUrl.Url)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt(b, int64(inlineKeyboardButtonTypeLoginUrl.Id))
+	b = append(b, `,"forward_text":`...)
+	b = appendJSONString(b, inlineKeyboardButtonTypeLoginUrl.ForwardText)
+	return append(b, '}'), nil
+}
+
+func (inlineKeyboardButtonTypeLoginUrl *InlineKeyboardButtonTypeLoginUrl) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inlineKeyboardButtonTypeLoginUrl.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inlineKeyboardButtonTypeLoginUrl.tdCommon.Extra, err = r.readString()
+		case "url":
+			inlineKeyboardButtonTypeLoginUrl.Url, err = r.readString()
+		case "id":
+			inlineKeyboardButtonTypeLoginUrl.Id, err = r.readInt64()
+		case "forward_text":
+			inlineKeyboardButtonTypeLoginUrl.ForwardText, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inlineKeyboardButtonTypeLoginUrl *InlineKeyboardButtonTypeLoginUrl) Clone() *InlineKeyboardButtonTypeLoginUrl {
+	if inlineKeyboardButtonTypeLoginUrl == nil {
+		return nil
+	}
+	clone := *inlineKeyboardButtonTypeLoginUrl
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inlineKeyboardButtonTypeLoginUrl *InlineKeyboardButtonTypeLoginUrl) Equal(other *InlineKeyboardButtonTypeLoginUrl) bool {
+	if inlineKeyboardButtonTypeLoginUrl == nil || other == nil {
+		return inlineKeyboardButtonTypeLoginUrl == other
+	}
+	if inlineKeyboardButtonTypeLoginUrl.Url != other.Url {
+		return false
+	}
+	if inlineKeyboardButtonTypeLoginUrl.Id != other.Id {
+		return false
+	}
+	if inlineKeyboardButtonTypeLoginUrl.ForwardText != other.ForwardText {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inlineKeyboardButtonTypeLoginUrl *InlineKeyboardButtonTypeLoginUrl) Diff(other *InlineKeyboardButtonTypeLoginUrl) []Change {
+	return inlineKeyboardButtonTypeLoginUrl.diff("", other, nil)
+}
+
+func (inlineKeyboardButtonTypeLoginUrl *InlineKeyboardButtonTypeLoginUrl) diff(path string, other *InlineKeyboardButtonTypeLoginUrl, changes []Change) []Change {
+	if inlineKeyboardButtonTypeLoginUrl == nil || other == nil {
+		if inlineKeyboardButtonTypeLoginUrl != other {
+			changes = append(changes, Change{Path: path, Old: inlineKeyboardButtonTypeLoginUrl, New: other})
+		}
+		return changes
+	}
+	if inlineKeyboardButtonTypeLoginUrl.Url != other.Url {
+		changes = append(changes, Change{Path: fieldPath(path, "url"), Old: inlineKeyboardButtonTypeLoginUrl.Url, New: other.Url})
+	}
+	if inlineKeyboardButtonTypeLoginUrl.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: inlineKeyboardButtonTypeLoginUrl.Id, New: other.Id})
+	}
+	if inlineKeyboardButtonTypeLoginUrl.ForwardText != other.ForwardText {
+		changes = append(changes, Change{Path: fieldPath(path, "forward_text"), Old: inlineKeyboardButtonTypeLoginUrl.ForwardText, New: other.ForwardText})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inlineKeyboardButtonTypeLoginUrl *InlineKeyboardButtonTypeLoginUrl) String() string {
+	return objectString(inlineKeyboardButtonTypeLoginUrl)
+}
+
+// LogValue logs the object as its summary
+func (inlineKeyboardButtonTypeLoginUrl *InlineKeyboardButtonTypeLoginUrl) LogValue() slog.Value {
+	return slog.StringValue(objectString(inlineKeyboardButtonTypeLoginUrl))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inlineKeyboardButtonTypeLoginUrl *InlineKeyboardButtonTypeLoginUrl) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inlineKeyboardButtonTypeLoginUrl)
+}
+
+func (inlineKeyboardButtonTypeLoginUrl *InlineKeyboardButtonTypeLoginUrl) printText(p *printer) {
+	if inlineKeyboardButtonTypeLoginUrl == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InlineKeyboardButtonTypeLoginUrl")
+	p.string("url", inlineKeyboardButtonTypeLoginUrl.Url)
+	p.int("id", int64(inlineKeyboardButtonTypeLoginUrl.Id))
+	p.string("forward_text", inlineKeyboardButtonTypeLoginUrl.ForwardText)
+	p.endObject(state)
+}
+
 // GetInlineKeyboardButtonTypeEnum return the enum type of this object
 func (inlineKeyboardButtonTypeLoginUrl *InlineKeyboardButtonTypeLoginUrl) GetInlineKeyboardButtonTypeEnum() InlineKeyboardButtonTypeEnum {
 	return InlineKeyboardButtonTypeLoginUrlType
 }
 
+// Accept calls the method of the visitor for InlineKeyboardButtonTypeLoginUrl
+func (inlineKeyboardButtonTypeLoginUrl *InlineKeyboardButtonTypeLoginUrl) Accept(visitor InlineKeyboardButtonTypeVisitor) {
+	visitor.VisitInlineKeyboardButtonTypeLoginUrl(inlineKeyboardButtonTypeLoginUrl)
+}
+
 // InlineKeyboardButtonTypeCallback A button that sends a callback query to a bot
 type InlineKeyboardButtonTypeCallback struct {
 	tdCommon
@@ -167,11 +456,121 @@ func NewInlineKeyboardButtonTypeCallback(data []byte) *InlineKeyboardButtonTypeC
 	return &inlineKeyboardButtonTypeCallbackTemp
 }
 
+// MarshalJSON marshals to json
+func (inlineKeyboardButtonTypeCallback *InlineKeyboardButtonTypeCallback) MarshalJSON() ([]byte, error) {
+	return inlineKeyboardButtonTypeCallback.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inlineKeyboardButtonTypeCallback *InlineKeyboardButtonTypeCallback) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inlineKeyboardButtonTypeCallback)
+}
+
+func (inlineKeyboardButtonTypeCallback *InlineKeyboardButtonTypeCallback) appendJSON(b []byte) ([]byte, error) {
+	if inlineKeyboardButtonTypeCallback == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inlineKeyboardButtonTypeCallback.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inlineKeyboardButtonTypeCallback.tdCommon.Extra)
+	b = append(b, `,"data":`...)
+	b = appendJSONBytes(b, inlineKeyboardButtonTypeCallback.Data)
+	return append(b, '}'), nil
+}
+
+func (inlineKeyboardButtonTypeCallback *InlineKeyboardButtonTypeCallback) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inlineKeyboardButtonTypeCallback.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inlineKeyboardButtonTypeCallback.tdCommon.Extra, err = r.readString()
+		case "data":
+			inlineKeyboardButtonTypeCallback.Data, err = r.readBytes()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inlineKeyboardButtonTypeCallback *InlineKeyboardButtonTypeCallback) Clone() *InlineKeyboardButtonTypeCallback {
+	if inlineKeyboardButtonTypeCallback == nil {
+		return nil
+	}
+	clone := *inlineKeyboardButtonTypeCallback
+	if inlineKeyboardButtonTypeCallback.Data != nil {
+		clone.Data = append([]byte{}, inlineKeyboardButtonTypeCallback.Data...)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inlineKeyboardButtonTypeCallback *InlineKeyboardButtonTypeCallback) Equal(other *InlineKeyboardButtonTypeCallback) bool {
+	if inlineKeyboardButtonTypeCallback == nil || other == nil {
+		return inlineKeyboardButtonTypeCallback == other
+	}
+	if !equalBytes(inlineKeyboardButtonTypeCallback.Data, other.Data) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inlineKeyboardButtonTypeCallback *InlineKeyboardButtonTypeCallback) Diff(other *InlineKeyboardButtonTypeCallback) []Change {
+	return inlineKeyboardButtonTypeCallback.diff("", other, nil)
+}
+
+func (inlineKeyboardButtonTypeCallback *InlineKeyboardButtonTypeCallback) diff(path string, other *InlineKeyboardButtonTypeCallback, changes []Change) []Change {
+	if inlineKeyboardButtonTypeCallback == nil || other == nil {
+		if inlineKeyboardButtonTypeCallback != other {
+			changes = append(changes, Change{Path: path, Old: inlineKeyboardButtonTypeCallback, New: other})
+		}
+		return changes
+	}
+	if !equalBytes(inlineKeyboardButtonTypeCallback.Data, other.Data) {
+		changes = append(changes, Change{Path: fieldPath(path, "data"), Old: inlineKeyboardButtonTypeCallback.Data, New: other.Data})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inlineKeyboardButtonTypeCallback *InlineKeyboardButtonTypeCallback) String() string {
+	return objectString(inlineKeyboardButtonTypeCallback)
+}
+
+// LogValue logs the object as its summary
+func (inlineKeyboardButtonTypeCallback *InlineKeyboardButtonTypeCallback) LogValue() slog.Value {
+	return slog.StringValue(objectString(inlineKeyboardButtonTypeCallback))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inlineKeyboardButtonTypeCallback *InlineKeyboardButtonTypeCallback) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inlineKeyboardButtonTypeCallback)
+}
+
+func (inlineKeyboardButtonTypeCallback *InlineKeyboardButtonTypeCallback) printText(p *printer) {
+	if inlineKeyboardButtonTypeCallback == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InlineKeyboardButtonTypeCallback")
+	p.bytes("data", inlineKeyboardButtonTypeCallback.Data)
+	p.endObject(state)
+}
+
 // GetInlineKeyboardButtonTypeEnum return the enum type of this object
 func (inlineKeyboardButtonTypeCallback *InlineKeyboardButtonTypeCallback) GetInlineKeyboardButtonTypeEnum() InlineKeyboardButtonTypeEnum {
 	return InlineKeyboardButtonTypeCallbackType
 }
 
+// Accept calls the method of the visitor for InlineKeyboardButtonTypeCallback
+func (inlineKeyboardButtonTypeCallback *InlineKeyboardButtonTypeCallback) Accept(visitor InlineKeyboardButtonTypeVisitor) {
+	visitor.VisitInlineKeyboardButtonTypeCallback(inlineKeyboardButtonTypeCallback)
+}
+
 // InlineKeyboardButtonTypeCallbackWithPassword A button that asks for password of the current user and then sends a callback query to a bot
 type InlineKeyboardButtonTypeCallbackWithPassword struct {
 	tdCommon
@@ -195,11 +594,121 @@ func NewInlineKeyboardButtonTypeCallbackWithPassword(data []byte) *InlineKeyboar
 	return &inlineKeyboardButtonTypeCallbackWithPasswordTemp
 }
 
+// MarshalJSON marshals to json
+func (inlineKeyboardButtonTypeCallbackWithPassword *InlineKeyboardButtonTypeCallbackWithPassword) MarshalJSON() ([]byte, error) {
+	return inlineKeyboardButtonTypeCallbackWithPassword.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inlineKeyboardButtonTypeCallbackWithPassword *InlineKeyboardButtonTypeCallbackWithPassword) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inlineKeyboardButtonTypeCallbackWithPassword)
+}
+
+func (inlineKeyboardButtonTypeCallbackWithPassword *InlineKeyboardButtonTypeCallbackWithPassword) appendJSON(b []byte) ([]byte, error) {
+	if inlineKeyboardButtonTypeCallbackWithPassword == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inlineKeyboardButtonTypeCallbackWithPassword.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inlineKeyboardButtonTypeCallbackWithPassword.tdCommon.Extra)
+	b = append(b, `,"data":`...)
+	b = appendJSONBytes(b, inlineKeyboardButtonTypeCallbackWithPassword.Data)
+	return append(b, '}'), nil
+}
+
+func (inlineKeyboardButtonTypeCallbackWithPassword *InlineKeyboardButtonTypeCallbackWithPassword) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inlineKeyboardButtonTypeCallbackWithPassword.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inlineKeyboardButtonTypeCallbackWithPassword.tdCommon.Extra, err = r.readString()
+		case "data":
+			inlineKeyboardButtonTypeCallbackWithPassword.Data, err = r.readBytes()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inlineKeyboardButtonTypeCallbackWithPassword *InlineKeyboardButtonTypeCallbackWithPassword) Clone() *InlineKeyboardButtonTypeCallbackWithPassword {
+	if inlineKeyboardButtonTypeCallbackWithPassword == nil {
+		return nil
+	}
+	clone := *inlineKeyboardButtonTypeCallbackWithPassword
+	if inlineKeyboardButtonTypeCallbackWithPassword.Data != nil {
+		clone.Data = append([]byte{}, inlineKeyboardButtonTypeCallbackWithPassword.Data...)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inlineKeyboardButtonTypeCallbackWithPassword *InlineKeyboardButtonTypeCallbackWithPassword) Equal(other *InlineKeyboardButtonTypeCallbackWithPassword) bool {
+	if inlineKeyboardButtonTypeCallbackWithPassword == nil || other == nil {
+		return inlineKeyboardButtonTypeCallbackWithPassword == other
+	}
+	if !equalBytes(inlineKeyboardButtonTypeCallbackWithPassword.Data, other.Data) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inlineKeyboardButtonTypeCallbackWithPassword *InlineKeyboardButtonTypeCallbackWithPassword) Diff(other *InlineKeyboardButtonTypeCallbackWithPassword) []Change {
+	return inlineKeyboardButtonTypeCallbackWithPassword.diff("", other, nil)
+}
+
+func (inlineKeyboardButtonTypeCallbackWithPassword *InlineKeyboardButtonTypeCallbackWithPassword) diff(path string, other *InlineKeyboardButtonTypeCallbackWithPassword, changes []Change) []Change {
+	if inlineKeyboardButtonTypeCallbackWithPassword == nil || other == nil {
+		if inlineKeyboardButtonTypeCallbackWithPassword != other {
+			changes = append(changes, Change{Path: path, Old: inlineKeyboardButtonTypeCallbackWithPassword, New: other})
+		}
+		return changes
+	}
+	if !equalBytes(inlineKeyboardButtonTypeCallbackWithPassword.Data, other.Data) {
+		changes = append(changes, Change{Path: fieldPath(path, "data"), Old: inlineKeyboardButtonTypeCallbackWithPassword.Data, New: other.Data})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inlineKeyboardButtonTypeCallbackWithPassword *InlineKeyboardButtonTypeCallbackWithPassword) String() string {
+	return objectString(inlineKeyboardButtonTypeCallbackWithPassword)
+}
+
+// LogValue logs the object as its summary
+func (inlineKeyboardButtonTypeCallbackWithPassword *InlineKeyboardButtonTypeCallbackWithPassword) LogValue() slog.Value {
+	return slog.StringValue(objectString(inlineKeyboardButtonTypeCallbackWithPassword))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inlineKeyboardButtonTypeCallbackWithPassword *InlineKeyboardButtonTypeCallbackWithPassword) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inlineKeyboardButtonTypeCallbackWithPassword)
+}
+
+func (inlineKeyboardButtonTypeCallbackWithPassword *InlineKeyboardButtonTypeCallbackWithPassword) printText(p *printer) {
+	if inlineKeyboardButtonTypeCallbackWithPassword == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InlineKeyboardButtonTypeCallbackWithPassword")
+	p.bytes("data", inlineKeyboardButtonTypeCallbackWithPassword.Data)
+	p.endObject(state)
+}
+
 // GetInlineKeyboardButtonTypeEnum return the enum type of this object
 func (inlineKeyboardButtonTypeCallbackWithPassword *InlineKeyboardButtonTypeCallbackWithPassword) GetInlineKeyboardButtonTypeEnum() InlineKeyboardButtonTypeEnum {
 	return InlineKeyboardButtonTypeCallbackWithPasswordType
 }
 
+// Accept calls the method of the visitor for InlineKeyboardButtonTypeCallbackWithPassword
+func (inlineKeyboardButtonTypeCallbackWithPassword *InlineKeyboardButtonTypeCallbackWithPassword) Accept(visitor InlineKeyboardButtonTypeVisitor) {
+	visitor.VisitInlineKeyboardButtonTypeCallbackWithPassword(inlineKeyboardButtonTypeCallbackWithPassword)
+}
+
 // InlineKeyboardButtonTypeCallbackGame A button with a game that sends a callback query to a bot. This button must be in the first column and row of the keyboard and can be attached only to a message with content of the type messageGame
 type InlineKeyboardButtonTypeCallbackGame struct {
 	tdCommon
@@ -211,7 +720,6 @@ func (inlineKeyboardButtonTypeCallbackGame *InlineKeyboardButtonTypeCallbackGame
 }
 
 // NewInlineKeyboardButtonTypeCallbackGame creates a new InlineKeyboardButtonTypeCallbackGame
-//
 func NewInlineKeyboardButtonTypeCallbackGame() *InlineKeyboardButtonTypeCallbackGame {
 	inlineKeyboardButtonTypeCallbackGameTemp := InlineKeyboardButtonTypeCallbackGame{
 		tdCommon: tdCommon{Type: "inlineKeyboardButtonTypeCallbackGame"},
@@ -220,11 +728,107 @@ func NewInlineKeyboardButtonTypeCallbackGame() *InlineKeyboardButtonTypeCallback
 	return &inlineKeyboardButtonTypeCallbackGameTemp
 }
 
+// MarshalJSON marshals to json
+func (inlineKeyboardButtonTypeCallbackGame *InlineKeyboardButtonTypeCallbackGame) MarshalJSON() ([]byte, error) {
+	return inlineKeyboardButtonTypeCallbackGame.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inlineKeyboardButtonTypeCallbackGame *InlineKeyboardButtonTypeCallbackGame) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inlineKeyboardButtonTypeCallbackGame)
+}
+
+func (inlineKeyboardButtonTypeCallbackGame *InlineKeyboardButtonTypeCallbackGame) appendJSON(b []byte) ([]byte, error) {
+	if inlineKeyboardButtonTypeCallbackGame == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inlineKeyboardButtonTypeCallbackGame.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inlineKeyboardButtonTypeCallbackGame.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (inlineKeyboardButtonTypeCallbackGame *InlineKeyboardButtonTypeCallbackGame) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inlineKeyboardButtonTypeCallbackGame.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inlineKeyboardButtonTypeCallbackGame.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inlineKeyboardButtonTypeCallbackGame *InlineKeyboardButtonTypeCallbackGame) Clone() *InlineKeyboardButtonTypeCallbackGame {
+	if inlineKeyboardButtonTypeCallbackGame == nil {
+		return nil
+	}
+	clone := *inlineKeyboardButtonTypeCallbackGame
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inlineKeyboardButtonTypeCallbackGame *InlineKeyboardButtonTypeCallbackGame) Equal(other *InlineKeyboardButtonTypeCallbackGame) bool {
+	if inlineKeyboardButtonTypeCallbackGame == nil || other == nil {
+		return inlineKeyboardButtonTypeCallbackGame == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inlineKeyboardButtonTypeCallbackGame *InlineKeyboardButtonTypeCallbackGame) Diff(other *InlineKeyboardButtonTypeCallbackGame) []Change {
+	return inlineKeyboardButtonTypeCallbackGame.diff("", other, nil)
+}
+
+func (inlineKeyboardButtonTypeCallbackGame *InlineKeyboardButtonTypeCallbackGame) diff(path string, other *InlineKeyboardButtonTypeCallbackGame, changes []Change) []Change {
+	if inlineKeyboardButtonTypeCallbackGame == nil || other == nil {
+		if inlineKeyboardButtonTypeCallbackGame != other {
+			changes = append(changes, Change{Path: path, Old: inlineKeyboardButtonTypeCallbackGame, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inlineKeyboardButtonTypeCallbackGame *InlineKeyboardButtonTypeCallbackGame) String() string {
+	return objectString(inlineKeyboardButtonTypeCallbackGame)
+}
+
+// LogValue logs the object as its summary
+func (inlineKeyboardButtonTypeCallbackGame *InlineKeyboardButtonTypeCallbackGame) LogValue() slog.Value {
+	return slog.StringValue(objectString(inlineKeyboardButtonTypeCallbackGame))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inlineKeyboardButtonTypeCallbackGame *InlineKeyboardButtonTypeCallbackGame) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inlineKeyboardButtonTypeCallbackGame)
+}
+
+func (inlineKeyboardButtonTypeCallbackGame *InlineKeyboardButtonTypeCallbackGame) printText(p *printer) {
+	if inlineKeyboardButtonTypeCallbackGame == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InlineKeyboardButtonTypeCallbackGame")
+	p.endObject(state)
+}
+
 // GetInlineKeyboardButtonTypeEnum return the enum type of this object
 func (inlineKeyboardButtonTypeCallbackGame *InlineKeyboardButtonTypeCallbackGame) GetInlineKeyboardButtonTypeEnum() InlineKeyboardButtonTypeEnum {
 	return InlineKeyboardButtonTypeCallbackGameType
 }
 
+// Accept calls the method of the visitor for InlineKeyboardButtonTypeCallbackGame
+func (inlineKeyboardButtonTypeCallbackGame *InlineKeyboardButtonTypeCallbackGame) Accept(visitor InlineKeyboardButtonTypeVisitor) {
+	visitor.VisitInlineKeyboardButtonTypeCallbackGame(inlineKeyboardButtonTypeCallbackGame)
+}
+
 // InlineKeyboardButtonTypeSwitchInline A button that forces an inline query to the bot to be inserted in the input field
 type InlineKeyboardButtonTypeSwitchInline struct {
 	tdCommon
@@ -251,11 +855,129 @@ func NewInlineKeyboardButtonTypeSwitchInline(query string, inCurrentChat bool) *
 	return &inlineKeyboardButtonTypeSwitchInlineTemp
 }
 
+// MarshalJSON marshals to json
+func (inlineKeyboardButtonTypeSwitchInline *InlineKeyboardButtonTypeSwitchInline) MarshalJSON() ([]byte, error) {
+	return inlineKeyboardButtonTypeSwitchInline.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inlineKeyboardButtonTypeSwitchInline *InlineKeyboardButtonTypeSwitchInline) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inlineKeyboardButtonTypeSwitchInline)
+}
+
+func (inlineKeyboardButtonTypeSwitchInline *InlineKeyboardButtonTypeSwitchInline) appendJSON(b []byte) ([]byte, error) {
+	if inlineKeyboardButtonTypeSwitchInline == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inlineKeyboardButtonTypeSwitchInline.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inlineKeyboardButtonTypeSwitchInline.tdCommon.Extra)
+	b = append(b, `,"query":`...)
+	b = appendJSONString(b, inlineKeyboardButtonTypeSwitchInline.Query)
+	b = append(b, `,"in_current_chat":`...)
+	b = appendJSONBool(b, inlineKeyboardButtonTypeSwitchInline.InCurrentChat)
+	return append(b, '}'), nil
+}
+
+func (inlineKeyboardButtonTypeSwitchInline *InlineKeyboardButtonTypeSwitchInline) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inlineKeyboardButtonTypeSwitchInline.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inlineKeyboardButtonTypeSwitchInline.tdCommon.Extra, err = r.readString()
+		case "query":
+			inlineKeyboardButtonTypeSwitchInline.Query, err = r.readString()
+		case "in_current_chat":
+			inlineKeyboardButtonTypeSwitchInline.InCurrentChat, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inlineKeyboardButtonTypeSwitchInline *InlineKeyboardButtonTypeSwitchInline) Clone() *InlineKeyboardButtonTypeSwitchInline {
+	if inlineKeyboardButtonTypeSwitchInline == nil {
+		return nil
+	}
+	clone := *inlineKeyboardButtonTypeSwitchInline
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inlineKeyboardButtonTypeSwitchInline *InlineKeyboardButtonTypeSwitchInline) Equal(other *InlineKeyboardButtonTypeSwitchInline) bool {
+	if inlineKeyboardButtonTypeSwitchInline == nil || other == nil {
+		return inlineKeyboardButtonTypeSwitchInline == other
+	}
+	if inlineKeyboardButtonTypeSwitchInline.Query != other.Query {
+		return false
+	}
+	if inlineKeyboardButtonTypeSwitchInline.InCurrentChat != other.InCurrentChat {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inlineKeyboardButtonTypeSwitchInline *InlineKeyboardButtonTypeSwitchInline) Diff(other *InlineKeyboardButtonTypeSwitchInline) []Change {
+	return inlineKeyboardButtonTypeSwitchInline.diff("", other, nil)
+}
+
+func (inlineKeyboardButtonTypeSwitchInline *InlineKeyboardButtonTypeSwitchInline) diff(path string, other *InlineKeyboardButtonTypeSwitchInline, changes []Change) []Change {
+	if inlineKeyboardButtonTypeSwitchInline == nil || other == nil {
+		if inlineKeyboardButtonTypeSwitchInline != other {
+			changes = append(changes, Change{Path: path, Old: inlineKeyboardButtonTypeSwitchInline, New: other})
+		}
+		return changes
+	}
+	if inlineKeyboardButtonTypeSwitchInline.Query != other.Query {
+		changes = append(changes, Change{Path: fieldPath(path, "query"), Old: inlineKeyboardButtonTypeSwitchInline.Query, New: other.Query})
+	}
+	if inlineKeyboardButtonTypeSwitchInline.InCurrentChat != other.InCurrentChat {
+		changes = append(changes, Change{Path: fieldPath(path, "in_current_chat"), Old: inlineKeyboardButtonTypeSwitchInline.InCurrentChat, New: other.InCurrentChat})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inlineKeyboardButtonTypeSwitchInline *InlineKeyboardButtonTypeSwitchInline) String() string {
+	return objectString(inlineKeyboardButtonTypeSwitchInline)
+}
+
+// LogValue logs the object as its summary
+func (inlineKeyboardButtonTypeSwitchInline *InlineKeyboardButtonTypeSwitchInline) LogValue() slog.Value {
+	return slog.StringValue(objectString(inlineKeyboardButtonTypeSwitchInline))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inlineKeyboardButtonTypeSwitchInline *InlineKeyboardButtonTypeSwitchInline) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inlineKeyboardButtonTypeSwitchInline)
+}
+
+func (inlineKeyboardButtonTypeSwitchInline *InlineKeyboardButtonTypeSwitchInline) printText(p *printer) {
+	if inlineKeyboardButtonTypeSwitchInline == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InlineKeyboardButtonTypeSwitchInline")
+	p.string("query", inlineKeyboardButtonTypeSwitchInline.Query)
+	p.bool("in_current_chat", inlineKeyboardButtonTypeSwitchInline.InCurrentChat)
+	p.endObject(state)
+}
+
 // GetInlineKeyboardButtonTypeEnum return the enum type of this object
 func (inlineKeyboardButtonTypeSwitchInline *InlineKeyboardButtonTypeSwitchInline) GetInlineKeyboardButtonTypeEnum() InlineKeyboardButtonTypeEnum {
 	return InlineKeyboardButtonTypeSwitchInlineType
 }
 
+// Accept calls the method of the visitor for InlineKeyboardButtonTypeSwitchInline
+func (inlineKeyboardButtonTypeSwitchInline *InlineKeyboardButtonTypeSwitchInline) Accept(visitor InlineKeyboardButtonTypeVisitor) {
+	visitor.VisitInlineKeyboardButtonTypeSwitchInline(inlineKeyboardButtonTypeSwitchInline)
+}
+
 // InlineKeyboardButtonTypeBuy A button to buy something. This button must be in the first column and row of the keyboard and can be attached only to a message with content of the type messageInvoice
 type InlineKeyboardButtonTypeBuy struct {
 	tdCommon
@@ -267,7 +989,6 @@ func (inlineKeyboardButtonTypeBuy *InlineKeyboardButtonTypeBuy) MessageType() st
 }
 
 // NewInlineKeyboardButtonTypeBuy creates a new InlineKeyboardButtonTypeBuy
-//
 func NewInlineKeyboardButtonTypeBuy() *InlineKeyboardButtonTypeBuy {
 	inlineKeyboardButtonTypeBuyTemp := InlineKeyboardButtonTypeBuy{
 		tdCommon: tdCommon{Type: "inlineKeyboardButtonTypeBuy"},
@@ -276,11 +997,107 @@ func NewInlineKeyboardButtonTypeBuy() *InlineKeyboardButtonTypeBuy {
 	return &inlineKeyboardButtonTypeBuyTemp
 }
 
+// MarshalJSON marshals to json
+func (inlineKeyboardButtonTypeBuy *InlineKeyboardButtonTypeBuy) MarshalJSON() ([]byte, error) {
+	return inlineKeyboardButtonTypeBuy.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inlineKeyboardButtonTypeBuy *InlineKeyboardButtonTypeBuy) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inlineKeyboardButtonTypeBuy)
+}
+
+func (inlineKeyboardButtonTypeBuy *InlineKeyboardButtonTypeBuy) appendJSON(b []byte) ([]byte, error) {
+	if inlineKeyboardButtonTypeBuy == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inlineKeyboardButtonTypeBuy.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inlineKeyboardButtonTypeBuy.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (inlineKeyboardButtonTypeBuy *InlineKeyboardButtonTypeBuy) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inlineKeyboardButtonTypeBuy.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inlineKeyboardButtonTypeBuy.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inlineKeyboardButtonTypeBuy *InlineKeyboardButtonTypeBuy) Clone() *InlineKeyboardButtonTypeBuy {
+	if inlineKeyboardButtonTypeBuy == nil {
+		return nil
+	}
+	clone := *inlineKeyboardButtonTypeBuy
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inlineKeyboardButtonTypeBuy *InlineKeyboardButtonTypeBuy) Equal(other *InlineKeyboardButtonTypeBuy) bool {
+	if inlineKeyboardButtonTypeBuy == nil || other == nil {
+		return inlineKeyboardButtonTypeBuy == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inlineKeyboardButtonTypeBuy *InlineKeyboardButtonTypeBuy) Diff(other *InlineKeyboardButtonTypeBuy) []Change {
+	return inlineKeyboardButtonTypeBuy.diff("", other, nil)
+}
+
+func (inlineKeyboardButtonTypeBuy *InlineKeyboardButtonTypeBuy) diff(path string, other *InlineKeyboardButtonTypeBuy, changes []Change) []Change {
+	if inlineKeyboardButtonTypeBuy == nil || other == nil {
+		if inlineKeyboardButtonTypeBuy != other {
+			changes = append(changes, Change{Path: path, Old: inlineKeyboardButtonTypeBuy, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inlineKeyboardButtonTypeBuy *InlineKeyboardButtonTypeBuy) String() string {
+	return objectString(inlineKeyboardButtonTypeBuy)
+}
+
+// LogValue logs the object as its summary
+func (inlineKeyboardButtonTypeBuy *InlineKeyboardButtonTypeBuy) LogValue() slog.Value {
+	return slog.StringValue(objectString(inlineKeyboardButtonTypeBuy))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inlineKeyboardButtonTypeBuy *InlineKeyboardButtonTypeBuy) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inlineKeyboardButtonTypeBuy)
+}
+
+func (inlineKeyboardButtonTypeBuy *InlineKeyboardButtonTypeBuy) printText(p *printer) {
+	if inlineKeyboardButtonTypeBuy == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InlineKeyboardButtonTypeBuy")
+	p.endObject(state)
+}
+
 // GetInlineKeyboardButtonTypeEnum return the enum type of this object
 func (inlineKeyboardButtonTypeBuy *InlineKeyboardButtonTypeBuy) GetInlineKeyboardButtonTypeEnum() InlineKeyboardButtonTypeEnum {
 	return InlineKeyboardButtonTypeBuyType
 }
 
+// Accept calls the method of the visitor for InlineKeyboardButtonTypeBuy
+func (inlineKeyboardButtonTypeBuy *InlineKeyboardButtonTypeBuy) Accept(visitor InlineKeyboardButtonTypeVisitor) {
+	visitor.VisitInlineKeyboardButtonTypeBuy(inlineKeyboardButtonTypeBuy)
+}
+
 // InlineKeyboardButtonTypeUser A button with a user reference to be handled in the same way as textEntityTypeMentionName entities
 type InlineKeyboardButtonTypeUser struct {
 	tdCommon
@@ -304,7 +1121,299 @@ func NewInlineKeyboardButtonTypeUser(userId int64) *InlineKeyboardButtonTypeUser
 	return &inlineKeyboardButtonTypeUserTemp
 }
 
+// MarshalJSON marshals to json
+func (inlineKeyboardButtonTypeUser *InlineKeyboardButtonTypeUser) MarshalJSON() ([]byte, error) {
+	return inlineKeyboardButtonTypeUser.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inlineKeyboardButtonTypeUser *InlineKeyboardButtonTypeUser) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inlineKeyboardButtonTypeUser)
+}
+
+func (inlineKeyboardButtonTypeUser *InlineKeyboardButtonTypeUser) appendJSON(b []byte) ([]byte, error) {
+	if inlineKeyboardButtonTypeUser == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inlineKeyboardButtonTypeUser.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inlineKeyboardButtonTypeUser.tdCommon.Extra)
+	b = append(b, `,"user_id":`...)
+	b = appendJSONInt(b, int64(inlineKeyboardButtonTypeUser.UserId))
+	return append(b, '}'), nil
+}
+
+func (inlineKeyboardButtonTypeUser *InlineKeyboardButtonTypeUser) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inlineKeyboardButtonTypeUser.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inlineKeyboardButtonTypeUser.tdCommon.Extra, err = r.readString()
+		case "user_id":
+			inlineKeyboardButtonTypeUser.UserId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inlineKeyboardButtonTypeUser *InlineKeyboardButtonTypeUser) Clone() *InlineKeyboardButtonTypeUser {
+	if inlineKeyboardButtonTypeUser == nil {
+		return nil
+	}
+	clone := *inlineKeyboardButtonTypeUser
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inlineKeyboardButtonTypeUser *InlineKeyboardButtonTypeUser) Equal(other *InlineKeyboardButtonTypeUser) bool {
+	if inlineKeyboardButtonTypeUser == nil || other == nil {
+		return inlineKeyboardButtonTypeUser == other
+	}
+	if inlineKeyboardButtonTypeUser.UserId != other.UserId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inlineKeyboardButtonTypeUser *InlineKeyboardButtonTypeUser) Diff(other *InlineKeyboardButtonTypeUser) []Change {
+	return inlineKeyboardButtonTypeUser.diff("", other, nil)
+}
+
+func (inlineKeyboardButtonTypeUser *InlineKeyboardButtonTypeUser) diff(path string, other *InlineKeyboardButtonTypeUser, changes []Change) []Change {
+	if inlineKeyboardButtonTypeUser == nil || other == nil {
+		if inlineKeyboardButtonTypeUser != other {
+			changes = append(changes, Change{Path: path, Old: inlineKeyboardButtonTypeUser, New: other})
+		}
+		return changes
+	}
+	if inlineKeyboardButtonTypeUser.UserId != other.UserId {
+		changes = append(changes, Change{Path: fieldPath(path, "user_id"), Old: inlineKeyboardButtonTypeUser.UserId, New: other.UserId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inlineKeyboardButtonTypeUser *InlineKeyboardButtonTypeUser) String() string {
+	return objectString(inlineKeyboardButtonTypeUser)
+}
+
+// LogValue logs the object as its summary
+func (inlineKeyboardButtonTypeUser *InlineKeyboardButtonTypeUser) LogValue() slog.Value {
+	return slog.StringValue(objectString(inlineKeyboardButtonTypeUser))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inlineKeyboardButtonTypeUser *InlineKeyboardButtonTypeUser) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inlineKeyboardButtonTypeUser)
+}
+
+func (inlineKeyboardButtonTypeUser *InlineKeyboardButtonTypeUser) printText(p *printer) {
+	if inlineKeyboardButtonTypeUser == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InlineKeyboardButtonTypeUser")
+	p.int("user_id", int64(inlineKeyboardButtonTypeUser.UserId))
+	p.endObject(state)
+}
+
 // GetInlineKeyboardButtonTypeEnum return the enum type of this object
 func (inlineKeyboardButtonTypeUser *InlineKeyboardButtonTypeUser) GetInlineKeyboardButtonTypeEnum() InlineKeyboardButtonTypeEnum {
 	return InlineKeyboardButtonTypeUserType
 }
+
+// Accept calls the method of the visitor for InlineKeyboardButtonTypeUser
+func (inlineKeyboardButtonTypeUser *InlineKeyboardButtonTypeUser) Accept(visitor InlineKeyboardButtonTypeVisitor) {
+	visitor.VisitInlineKeyboardButtonTypeUser(inlineKeyboardButtonTypeUser)
+}
+
+// InlineKeyboardButtonTypeVisitor visits every type of InlineKeyboardButtonType, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to InlineKeyboardButtonType, until they handle it too.
+type InlineKeyboardButtonTypeVisitor interface {
+	VisitInlineKeyboardButtonTypeUrl(inlineKeyboardButtonTypeUrl *InlineKeyboardButtonTypeUrl)
+	VisitInlineKeyboardButtonTypeLoginUrl(inlineKeyboardButtonTypeLoginUrl *InlineKeyboardButtonTypeLoginUrl)
+	VisitInlineKeyboardButtonTypeCallback(inlineKeyboardButtonTypeCallback *InlineKeyboardButtonTypeCallback)
+	VisitInlineKeyboardButtonTypeCallbackWithPassword(inlineKeyboardButtonTypeCallbackWithPassword *InlineKeyboardButtonTypeCallbackWithPassword)
+	VisitInlineKeyboardButtonTypeCallbackGame(inlineKeyboardButtonTypeCallbackGame *InlineKeyboardButtonTypeCallbackGame)
+	VisitInlineKeyboardButtonTypeSwitchInline(inlineKeyboardButtonTypeSwitchInline *InlineKeyboardButtonTypeSwitchInline)
+	VisitInlineKeyboardButtonTypeBuy(inlineKeyboardButtonTypeBuy *InlineKeyboardButtonTypeBuy)
+	VisitInlineKeyboardButtonTypeUser(inlineKeyboardButtonTypeUser *InlineKeyboardButtonTypeUser)
+	VisitUnknownInlineKeyboardButtonType(unknownInlineKeyboardButtonType *UnknownInlineKeyboardButtonType)
+}
+
+// InlineKeyboardButtonTypeCases holds the function MatchInlineKeyboardButtonType calls for each type of InlineKeyboardButtonType;
+// types without a function are passed to the default case
+type InlineKeyboardButtonTypeCases[R any] struct {
+	InlineKeyboardButtonTypeUrl                  func(inlineKeyboardButtonTypeUrl *InlineKeyboardButtonTypeUrl) R
+	InlineKeyboardButtonTypeLoginUrl             func(inlineKeyboardButtonTypeLoginUrl *InlineKeyboardButtonTypeLoginUrl) R
+	InlineKeyboardButtonTypeCallback             func(inlineKeyboardButtonTypeCallback *InlineKeyboardButtonTypeCallback) R
+	InlineKeyboardButtonTypeCallbackWithPassword func(inlineKeyboardButtonTypeCallbackWithPassword *InlineKeyboardButtonTypeCallbackWithPassword) R
+	InlineKeyboardButtonTypeCallbackGame         func(inlineKeyboardButtonTypeCallbackGame *InlineKeyboardButtonTypeCallbackGame) R
+	InlineKeyboardButtonTypeSwitchInline         func(inlineKeyboardButtonTypeSwitchInline *InlineKeyboardButtonTypeSwitchInline) R
+	InlineKeyboardButtonTypeBuy                  func(inlineKeyboardButtonTypeBuy *InlineKeyboardButtonTypeBuy) R
+	InlineKeyboardButtonTypeUser                 func(inlineKeyboardButtonTypeUser *InlineKeyboardButtonTypeUser) R
+}
+
+// MatchInlineKeyboardButtonType calls the case of the type of inlineKeyboardButtonType, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement InlineKeyboardButtonTypeVisitor
+// to have the compiler check every type is handled.
+func MatchInlineKeyboardButtonType[R any](inlineKeyboardButtonType InlineKeyboardButtonType, cases InlineKeyboardButtonTypeCases[R], defaultCase func(inlineKeyboardButtonType InlineKeyboardButtonType) R) R {
+	switch value := inlineKeyboardButtonType.(type) {
+	case *InlineKeyboardButtonTypeUrl:
+		if cases.InlineKeyboardButtonTypeUrl != nil {
+			return cases.InlineKeyboardButtonTypeUrl(value)
+		}
+	case *InlineKeyboardButtonTypeLoginUrl:
+		if cases.InlineKeyboardButtonTypeLoginUrl != nil {
+			return cases.InlineKeyboardButtonTypeLoginUrl(value)
+		}
+	case *InlineKeyboardButtonTypeCallback:
+		if cases.InlineKeyboardButtonTypeCallback != nil {
+			return cases.InlineKeyboardButtonTypeCallback(value)
+		}
+	case *InlineKeyboardButtonTypeCallbackWithPassword:
+		if cases.InlineKeyboardButtonTypeCallbackWithPassword != nil {
+			return cases.InlineKeyboardButtonTypeCallbackWithPassword(value)
+		}
+	case *InlineKeyboardButtonTypeCallbackGame:
+		if cases.InlineKeyboardButtonTypeCallbackGame != nil {
+			return cases.InlineKeyboardButtonTypeCallbackGame(value)
+		}
+	case *InlineKeyboardButtonTypeSwitchInline:
+		if cases.InlineKeyboardButtonTypeSwitchInline != nil {
+			return cases.InlineKeyboardButtonTypeSwitchInline(value)
+		}
+	case *InlineKeyboardButtonTypeBuy:
+		if cases.InlineKeyboardButtonTypeBuy != nil {
+			return cases.InlineKeyboardButtonTypeBuy(value)
+		}
+	case *InlineKeyboardButtonTypeUser:
+		if cases.InlineKeyboardButtonTypeUser != nil {
+			return cases.InlineKeyboardButtonTypeUser(value)
+		}
+	}
+	return defaultCase(inlineKeyboardButtonType)
+}
+
+// Clone returns a deep copy of the object
+func (unknownInlineKeyboardButtonType *UnknownInlineKeyboardButtonType) Clone() *UnknownInlineKeyboardButtonType {
+	if unknownInlineKeyboardButtonType == nil {
+		return nil
+	}
+	return &UnknownInlineKeyboardButtonType{Unknown: unknownInlineKeyboardButtonType.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownInlineKeyboardButtonType *UnknownInlineKeyboardButtonType) Equal(other *UnknownInlineKeyboardButtonType) bool {
+	if unknownInlineKeyboardButtonType == nil || other == nil {
+		return unknownInlineKeyboardButtonType == other
+	}
+	return unknownInlineKeyboardButtonType.Unknown.equal(other.Unknown)
+}
+
+func cloneInlineKeyboardButtonType(inlineKeyboardButtonType InlineKeyboardButtonType) InlineKeyboardButtonType {
+	switch value := inlineKeyboardButtonType.(type) {
+	case *InlineKeyboardButtonTypeUrl:
+		return value.Clone()
+	case *InlineKeyboardButtonTypeLoginUrl:
+		return value.Clone()
+	case *InlineKeyboardButtonTypeCallback:
+		return value.Clone()
+	case *InlineKeyboardButtonTypeCallbackWithPassword:
+		return value.Clone()
+	case *InlineKeyboardButtonTypeCallbackGame:
+		return value.Clone()
+	case *InlineKeyboardButtonTypeSwitchInline:
+		return value.Clone()
+	case *InlineKeyboardButtonTypeBuy:
+		return value.Clone()
+	case *InlineKeyboardButtonTypeUser:
+		return value.Clone()
+	case *UnknownInlineKeyboardButtonType:
+		return value.Clone()
+	}
+	return inlineKeyboardButtonType
+}
+
+func equalInlineKeyboardButtonType(a InlineKeyboardButtonType, b InlineKeyboardButtonType) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *InlineKeyboardButtonTypeUrl:
+		b, ok := b.(*InlineKeyboardButtonTypeUrl)
+		return ok && a.Equal(b)
+	case *InlineKeyboardButtonTypeLoginUrl:
+		b, ok := b.(*InlineKeyboardButtonTypeLoginUrl)
+		return ok && a.Equal(b)
+	case *InlineKeyboardButtonTypeCallback:
+		b, ok := b.(*InlineKeyboardButtonTypeCallback)
+		return ok && a.Equal(b)
+	case *InlineKeyboardButtonTypeCallbackWithPassword:
+		b, ok := b.(*InlineKeyboardButtonTypeCallbackWithPassword)
+		return ok && a.Equal(b)
+	case *InlineKeyboardButtonTypeCallbackGame:
+		b, ok := b.(*InlineKeyboardButtonTypeCallbackGame)
+		return ok && a.Equal(b)
+	case *InlineKeyboardButtonTypeSwitchInline:
+		b, ok := b.(*InlineKeyboardButtonTypeSwitchInline)
+		return ok && a.Equal(b)
+	case *InlineKeyboardButtonTypeBuy:
+		b, ok := b.(*InlineKeyboardButtonTypeBuy)
+		return ok && a.Equal(b)
+	case *InlineKeyboardButtonTypeUser:
+		b, ok := b.(*InlineKeyboardButtonTypeUser)
+		return ok && a.Equal(b)
+	case *UnknownInlineKeyboardButtonType:
+		b, ok := b.(*UnknownInlineKeyboardButtonType)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffInlineKeyboardButtonType(path string, a InlineKeyboardButtonType, b InlineKeyboardButtonType, changes []Change) []Change {
+	switch a := a.(type) {
+	case *InlineKeyboardButtonTypeUrl:
+		if b, ok := b.(*InlineKeyboardButtonTypeUrl); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InlineKeyboardButtonTypeLoginUrl:
+		if b, ok := b.(*InlineKeyboardButtonTypeLoginUrl); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InlineKeyboardButtonTypeCallback:
+		if b, ok := b.(*InlineKeyboardButtonTypeCallback); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InlineKeyboardButtonTypeCallbackWithPassword:
+		if b, ok := b.(*InlineKeyboardButtonTypeCallbackWithPassword); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InlineKeyboardButtonTypeCallbackGame:
+		if b, ok := b.(*InlineKeyboardButtonTypeCallbackGame); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InlineKeyboardButtonTypeSwitchInline:
+		if b, ok := b.(*InlineKeyboardButtonTypeSwitchInline); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InlineKeyboardButtonTypeBuy:
+		if b, ok := b.(*InlineKeyboardButtonTypeBuy); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InlineKeyboardButtonTypeUser:
+		if b, ok := b.(*InlineKeyboardButtonTypeUser); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalInlineKeyboardButtonType(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -0,0 +1,427 @@
+package tdlib
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultInlinePageSize is the number of results answered to an inline query at once, the most Telegram allows
+const DefaultInlinePageSize = 50
+
+// maxInlineResultID is the length in bytes of the longest result identifier Telegram allows
+const maxInlineResultID = 64
+
+// InlineQuery is an inline query from updateNewInlineQuery, passed to an InlineQueryHandler
+type InlineQuery struct {
+	ID           JSONInt64             // Unique query identifier
+	SenderUserID int64                 // Identifier of the user who sent the query
+	UserLocation *Location             // Location of the user; may be nil
+	ChatType     ChatType              // Type of the chat the query was sent from; may be nil if unknown
+	Query        string                // Text of the query, without the prefix of the route
+	Update       *UpdateNewInlineQuery // The update of the query
+
+	ctx context.Context
+}
+
+// Context returns the context of the query
+func (query *InlineQuery) Context() context.Context {
+	return query.ctx
+}
+
+// InlineResultSource produces the results of an inline query lazily, a page at a time, as the user scrolls
+type InlineResultSource interface {
+	// Results returns up to limit results, starting with the result at offset; fewer when there are no more results
+	Results(ctx context.Context, offset int, limit int) ([]InputInlineQueryResult, error)
+}
+
+// InlineResultSourceFunc is an InlineResultSource function
+type InlineResultSourceFunc func(ctx context.Context, offset int, limit int) ([]InputInlineQueryResult, error)
+
+// Results calls the function
+func (f InlineResultSourceFunc) Results(ctx context.Context, offset int, limit int) ([]InputInlineQueryResult, error) {
+	return f(ctx, offset, limit)
+}
+
+// InlineResults returns a source of results which are known beforehand
+func InlineResults(results ...InputInlineQueryResult) InlineResultSource {
+	return InlineResultSourceFunc(func(ctx context.Context, offset int, limit int) ([]InputInlineQueryResult, error) {
+		if offset >= len(results) {
+			return nil, nil
+		}
+		end := offset + limit
+		if end > len(results) {
+			end = len(results)
+		}
+		return results[offset:end], nil
+	})
+}
+
+// InlineAnswer is the answer of an InlineQueryHandler
+type InlineAnswer struct {
+	Results           InlineResultSource // Source of the results, whose identifiers the router makes unique
+	IsPersonal        bool               // Whether the results depend on the user, so they're cached for the user only
+	CacheTime         time.Duration      // How long Telegram and the router may cache the results; 0 disables caching
+	SwitchPmText      string             // If non-empty, text of a button opening a private chat with the bot
+	SwitchPmParameter string             // Parameter of the start message sent by the button
+}
+
+// InlineQueryHandler answers an inline query. The query is sent again for every page of results, and the
+// handler is called again unless the page is cached.
+type InlineQueryHandler func(query *InlineQuery) (*InlineAnswer, error)
+
+// ChosenInlineResult is a result chosen by a user from updateNewChosenInlineResult, passed to a
+// ChosenInlineResultHandler. Telegram only sends them to bots with inline feedback enabled in @BotFather.
+type ChosenInlineResult struct {
+	SenderUserID    int64                        // Identifier of the user who chose the result
+	UserLocation    *Location                    // Location of the user; may be nil
+	Query           string                       // Text of the query, without the prefix of the route
+	ResultID        string                       // Identifier of the result set by the handler, or its position if it had none
+	InlineMessageID string                       // Identifier of the sent inline message, if known
+	Update          *UpdateNewChosenInlineResult // The update of the chosen result
+}
+
+// ChosenInlineResultHandler handles a result chosen from the results of the InlineQueryHandler of its route
+type ChosenInlineResultHandler func(ctx context.Context, result *ChosenInlineResult) error
+
+// inlineRoute is the handlers of the inline queries starting with a prefix
+type inlineRoute struct {
+	prefix string
+	query  InlineQueryHandler
+	chosen ChosenInlineResultHandler
+}
+
+// inlinePage is a page of results answered to an inline query, cached until it expires
+type inlinePage struct {
+	answer     *InlineAnswer
+	results    []InputInlineQueryResult
+	nextOffset string
+	expires    time.Time
+}
+
+// inlineCacheKey identifies the pages of results of a query; userID is 0 for results which aren't personal
+type inlineCacheKey struct {
+	userID int64
+	query  string
+	offset string
+}
+
+// InlineRouter answers inline queries with the handler of the first route whose prefix the query starts with.
+// It pages the results of the handler, gives them unique identifiers, caches them and passes the chosen
+// results back to the route which answered them.
+//
+//	router := tdlib.NewInlineRouter(client)
+//	router.Handle("gif ", func(query *tdlib.InlineQuery) (*tdlib.InlineAnswer, error) {
+//		return &tdlib.InlineAnswer{Results: searchGIFs(query.Query), CacheTime: time.Minute}, nil
+//	}, nil)
+//	go router.Run(ctx)
+type InlineRouter struct {
+	client   *Client
+	lock     sync.RWMutex
+	routes   []inlineRoute
+	pageSize int
+	cache    map[inlineCacheKey]inlinePage
+}
+
+// NewInlineRouter creates an inline query router for the queries the client receives
+func NewInlineRouter(client *Client) *InlineRouter {
+	return &InlineRouter{
+		client:   client,
+		pageSize: DefaultInlinePageSize,
+		cache:    make(map[inlineCacheKey]inlinePage),
+	}
+}
+
+// SetPageSize sets the number of results answered at once, at most DefaultInlinePageSize
+func (router *InlineRouter) SetPageSize(pageSize int) {
+	router.lock.Lock()
+	defer router.lock.Unlock()
+	router.pageSize = pageSize
+}
+
+// Handle answers the queries starting with prefix with handler, or all queries if prefix is empty.
+// The results chosen by users are passed to chosen, unless it's nil. Result identifiers start with the
+// number of the route, so routes must be added in the same order when the bot restarts.
+func (router *InlineRouter) Handle(prefix string, handler InlineQueryHandler, chosen ChosenInlineResultHandler) {
+	router.lock.Lock()
+	defer router.lock.Unlock()
+	router.routes = append(router.routes, inlineRoute{prefix: prefix, query: handler, chosen: chosen})
+}
+
+// Run answers the inline queries the client receives and passes on chosen results until ctx is done
+func (router *InlineRouter) Run(ctx context.Context) error {
+	queries := router.client.AddEventReceiver(&UpdateNewInlineQuery{}, acceptUpdate, 100)
+	chosenResults := router.client.AddEventReceiver(&UpdateNewChosenInlineResult{}, acceptUpdate, 100)
+	defer func() {
+		removed := make(chan struct{})
+		go func() {
+			router.client.RemoveEventReceiver(queries)
+			router.client.RemoveEventReceiver(chosenResults)
+			close(removed)
+		}()
+		for {
+			select {
+			case <-queries.Chan:
+			case <-chosenResults.Chan:
+			case <-removed:
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case update := <-queries.Chan:
+			go router.HandleUpdate(ctx, update)
+		case update := <-chosenResults.Chan:
+			go router.HandleUpdate(ctx, update)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// HandleUpdate handles an updateNewInlineQuery or updateNewChosenInlineResult, e.g. received by an own receiver
+// instead of Run. Other updates are ignored.
+func (router *InlineRouter) HandleUpdate(ctx context.Context, update TdMessage) {
+	var err error
+	switch update := update.(type) {
+	case *UpdateNewInlineQuery:
+		err = router.answer(ctx, update)
+		if err != nil {
+			router.client.Logger().Error("failed to answer inline query", "query", update.Query, "offset", update.Offset, "sender_user_id", update.SenderUserId, "error", err)
+		}
+	case *UpdateNewChosenInlineResult:
+		err = router.chosen(ctx, update)
+		if err != nil {
+			router.client.Logger().Error("chosen inline result handler failed", "query", update.Query, "result_id", update.ResultId, "sender_user_id", update.SenderUserId, "error", err)
+		}
+	}
+}
+
+// route returns the first route matching a query and its number
+func (router *InlineRouter) route(query string) (int, inlineRoute, bool) {
+	router.lock.RLock()
+	defer router.lock.RUnlock()
+
+	for i, route := range router.routes {
+		if strings.HasPrefix(query, route.prefix) {
+			return i, route, true
+		}
+	}
+	return 0, inlineRoute{}, false
+}
+
+// answer answers an inline query with a page of results from the cache or from the handler of its route
+func (router *InlineRouter) answer(ctx context.Context, update *UpdateNewInlineQuery) error {
+	routeNumber, route, ok := router.route(update.Query)
+	if !ok {
+		return nil
+	}
+
+	page, ok := router.cachedPage(update)
+	if !ok {
+		var err error
+		page, err = router.page(ctx, routeNumber, route, update)
+		if err != nil || page.answer == nil {
+			return err
+		}
+	}
+
+	_, err := router.client.Invoke(ctx, "answerInlineQuery", UpdateData{
+		"inline_query_id":     update.Id,
+		"is_personal":         page.answer.IsPersonal,
+		"results":             page.results,
+		"cache_time":          int32(page.answer.CacheTime / time.Second),
+		"next_offset":         page.nextOffset,
+		"switch_pm_text":      page.answer.SwitchPmText,
+		"switch_pm_parameter": page.answer.SwitchPmParameter,
+	})
+	return err
+}
+
+// page calls the handler of a route and gets the page of results the query asks for from its source
+func (router *InlineRouter) page(ctx context.Context, routeNumber int, route inlineRoute, update *UpdateNewInlineQuery) (inlinePage, error) {
+	offset := 0
+	if update.Offset != "" {
+		var err error
+		if offset, err = strconv.Atoi(update.Offset); err != nil || offset < 0 {
+			return inlinePage{}, fmt.Errorf("invalid offset %q", update.Offset)
+		}
+	}
+
+	query := &InlineQuery{
+		ID:           update.Id,
+		SenderUserID: update.SenderUserId,
+		UserLocation: update.UserLocation,
+		ChatType:     update.ChatType,
+		Query:        strings.TrimPrefix(update.Query, route.prefix),
+		Update:       update,
+		ctx:          ctx,
+	}
+	answer, err := route.query(query)
+	if err != nil || answer == nil {
+		return inlinePage{}, err
+	}
+
+	router.lock.RLock()
+	pageSize := router.pageSize
+	router.lock.RUnlock()
+	if pageSize <= 0 || pageSize > DefaultInlinePageSize {
+		pageSize = DefaultInlinePageSize
+	}
+
+	var results []InputInlineQueryResult
+	if answer.Results != nil {
+		results, err = answer.Results.Results(ctx, offset, pageSize)
+		if err != nil {
+			return inlinePage{}, err
+		}
+	}
+	if len(results) > pageSize {
+		results = results[:pageSize]
+	}
+	if results, err = inlineResultIDs(results, routeNumber, offset); err != nil {
+		return inlinePage{}, err
+	}
+
+	page := inlinePage{answer: answer, results: results}
+	if len(results) == pageSize {
+		page.nextOffset = strconv.Itoa(offset + len(results))
+	}
+	if answer.CacheTime > 0 {
+		page.expires = time.Now().Add(answer.CacheTime)
+		router.cachePage(update, page)
+	}
+	return page, nil
+}
+
+// cachedPage returns the cached page of results of a query, for the user or for everyone
+func (router *InlineRouter) cachedPage(update *UpdateNewInlineQuery) (inlinePage, bool) {
+	router.lock.RLock()
+	defer router.lock.RUnlock()
+
+	now := time.Now()
+	for _, userID := range []int64{update.SenderUserId, 0} {
+		page, ok := router.cache[inlineCacheKey{userID: userID, query: update.Query, offset: update.Offset}]
+		if ok && now.Before(page.expires) {
+			return page, true
+		}
+	}
+	return inlinePage{}, false
+}
+
+// cachePage caches a page of results, and forgets expired pages now and then
+func (router *InlineRouter) cachePage(update *UpdateNewInlineQuery, page inlinePage) {
+	key := inlineCacheKey{query: update.Query, offset: update.Offset}
+	if page.answer.IsPersonal {
+		key.userID = update.SenderUserId
+	}
+
+	router.lock.Lock()
+	defer router.lock.Unlock()
+
+	if len(router.cache) >= 1000 {
+		now := time.Now()
+		for key, page := range router.cache {
+			if now.After(page.expires) {
+				delete(router.cache, key)
+			}
+		}
+	}
+	router.cache[key] = page
+}
+
+// chosen passes a chosen result to the route which answered it, found by the number its identifier starts with
+func (router *InlineRouter) chosen(ctx context.Context, update *UpdateNewChosenInlineResult) error {
+	parts := strings.SplitN(update.ResultId, ":", 3)
+	if len(parts) != 3 {
+		return nil
+	}
+	routeNumber, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nil
+	}
+	resultID := parts[2]
+	if resultID == "" {
+		resultID = parts[1]
+	}
+
+	router.lock.RLock()
+	var route inlineRoute
+	if routeNumber >= 0 && routeNumber < len(router.routes) {
+		route = router.routes[routeNumber]
+	}
+	router.lock.RUnlock()
+	if route.chosen == nil {
+		return nil
+	}
+
+	return route.chosen(ctx, &ChosenInlineResult{
+		SenderUserID:    update.SenderUserId,
+		UserLocation:    update.UserLocation,
+		Query:           strings.TrimPrefix(update.Query, route.prefix),
+		ResultID:        resultID,
+		InlineMessageID: update.InlineMessageId,
+		Update:          update,
+	})
+}
+
+// inlineResultIDs returns copies of results with identifiers made of the number of their route, their position
+// and their own identifier, e.g. 0:12:cat, which are unique across all pages of results. Nil results, e.g.
+// (*InputInlineQueryResultArticle)(nil), are an error, so the page isn't answered or cached.
+func inlineResultIDs(results []InputInlineQueryResult, routeNumber int, offset int) ([]InputInlineQueryResult, error) {
+	unique := make([]InputInlineQueryResult, len(results))
+	for i, result := range results {
+		if isNil(result) {
+			return nil, fmt.Errorf("result %d is nil", offset+i)
+		}
+
+		// the results of a source may be answered again, so the identifiers are set on copies
+		unique[i] = cloneInputInlineQueryResult(result)
+		id := inlineResultID(unique[i])
+		if id == nil {
+			return nil, fmt.Errorf("result %d: unsupported result %T", offset+i, result)
+		}
+
+		*id = strconv.Itoa(routeNumber) + ":" + strconv.Itoa(offset+i) + ":" + *id
+		if len(*id) > maxInlineResultID {
+			return nil, fmt.Errorf("result %d: identifier %q is longer than %d bytes", offset+i, *id, maxInlineResultID)
+		}
+	}
+	return unique, nil
+}
+
+// inlineResultID returns the identifier field of a result
+func inlineResultID(result InputInlineQueryResult) *string {
+	switch result := result.(type) {
+	case *InputInlineQueryResultAnimation:
+		return &result.Id
+	case *InputInlineQueryResultArticle:
+		return &result.Id
+	case *InputInlineQueryResultAudio:
+		return &result.Id
+	case *InputInlineQueryResultContact:
+		return &result.Id
+	case *InputInlineQueryResultDocument:
+		return &result.Id
+	case *InputInlineQueryResultGame:
+		return &result.Id
+	case *InputInlineQueryResultLocation:
+		return &result.Id
+	case *InputInlineQueryResultPhoto:
+		return &result.Id
+	case *InputInlineQueryResultSticker:
+		return &result.Id
+	case *InputInlineQueryResultVenue:
+		return &result.Id
+	case *InputInlineQueryResultVideo:
+		return &result.Id
+	case *InputInlineQueryResultVoiceNote:
+		return &result.Id
+	}
+	return nil
+}
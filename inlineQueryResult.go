@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // InlineQueryResult Represents a single result of an inline query
 type InlineQueryResult interface {
 	GetInlineQueryResultEnum() InlineQueryResultEnum
+	Accept(visitor InlineQueryResultVisitor)
 }
 
 // InlineQueryResultEnum Alias for abstract InlineQueryResult 'Sub-Classes', used as constant-enum here
@@ -29,81 +31,155 @@ const (
 	InlineQueryResultVoiceNoteType InlineQueryResultEnum = "inlineQueryResultVoiceNote"
 )
 
+// UnknownInlineQueryResult is an InlineQueryResult of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownInlineQueryResult struct {
+	Unknown
+}
+
+// GetInlineQueryResultEnum return the enum type of this object
+func (unknownInlineQueryResult *UnknownInlineQueryResult) GetInlineQueryResultEnum() InlineQueryResultEnum {
+	return InlineQueryResultEnum(unknownInlineQueryResult.Type)
+}
+
+// Accept calls the method of the visitor for UnknownInlineQueryResult
+func (unknownInlineQueryResult *UnknownInlineQueryResult) Accept(visitor InlineQueryResultVisitor) {
+	visitor.VisitUnknownInlineQueryResult(unknownInlineQueryResult)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownInlineQueryResult *UnknownInlineQueryResult) String() string {
+	return objectString(unknownInlineQueryResult)
+}
+
+// LogValue logs the object as its summary
+func (unknownInlineQueryResult *UnknownInlineQueryResult) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownInlineQueryResult))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownInlineQueryResult *UnknownInlineQueryResult) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownInlineQueryResult)
+}
+
+func (unknownInlineQueryResult *UnknownInlineQueryResult) printText(p *printer) {
+	if unknownInlineQueryResult == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownInlineQueryResult")
+	p.string("@type", unknownInlineQueryResult.Type)
+	p.endObject(state)
+}
+
 func unmarshalInlineQueryResult(rawMsg *json.RawMessage) (InlineQueryResult, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch InlineQueryResultEnum(objMap["@type"].(string)) {
+	switch InlineQueryResultEnum(typeName) {
 	case InlineQueryResultArticleType:
 		var inlineQueryResultArticle InlineQueryResultArticle
-		err := json.Unmarshal(*rawMsg, &inlineQueryResultArticle)
+		err := jsonUnmarshal(*rawMsg, &inlineQueryResultArticle)
 		return &inlineQueryResultArticle, err
 
 	case InlineQueryResultContactType:
 		var inlineQueryResultContact InlineQueryResultContact
-		err := json.Unmarshal(*rawMsg, &inlineQueryResultContact)
+		err := jsonUnmarshal(*rawMsg, &inlineQueryResultContact)
 		return &inlineQueryResultContact, err
 
 	case InlineQueryResultLocationType:
 		var inlineQueryResultLocation InlineQueryResultLocation
-		err := json.Unmarshal(*rawMsg, &inlineQueryResultLocation)
+		err := jsonUnmarshal(*rawMsg, &inlineQueryResultLocation)
 		return &inlineQueryResultLocation, err
 
 	case InlineQueryResultVenueType:
 		var inlineQueryResultVenue InlineQueryResultVenue
-		err := json.Unmarshal(*rawMsg, &inlineQueryResultVenue)
+		err := jsonUnmarshal(*rawMsg, &inlineQueryResultVenue)
 		return &inlineQueryResultVenue, err
 
 	case InlineQueryResultGameType:
 		var inlineQueryResultGame InlineQueryResultGame
-		err := json.Unmarshal(*rawMsg, &inlineQueryResultGame)
+		err := jsonUnmarshal(*rawMsg, &inlineQueryResultGame)
 		return &inlineQueryResultGame, err
 
 	case InlineQueryResultAnimationType:
 		var inlineQueryResultAnimation InlineQueryResultAnimation
-		err := json.Unmarshal(*rawMsg, &inlineQueryResultAnimation)
+		err := jsonUnmarshal(*rawMsg, &inlineQueryResultAnimation)
 		return &inlineQueryResultAnimation, err
 
 	case InlineQueryResultAudioType:
 		var inlineQueryResultAudio InlineQueryResultAudio
-		err := json.Unmarshal(*rawMsg, &inlineQueryResultAudio)
+		err := jsonUnmarshal(*rawMsg, &inlineQueryResultAudio)
 		return &inlineQueryResultAudio, err
 
 	case InlineQueryResultDocumentType:
 		var inlineQueryResultDocument InlineQueryResultDocument
-		err := json.Unmarshal(*rawMsg, &inlineQueryResultDocument)
+		err := jsonUnmarshal(*rawMsg, &inlineQueryResultDocument)
 		return &inlineQueryResultDocument, err
 
 	case InlineQueryResultPhotoType:
 		var inlineQueryResultPhoto InlineQueryResultPhoto
-		err := json.Unmarshal(*rawMsg, &inlineQueryResultPhoto)
+		err := jsonUnmarshal(*rawMsg, &inlineQueryResultPhoto)
 		return &inlineQueryResultPhoto, err
 
 	case InlineQueryResultStickerType:
 		var inlineQueryResultSticker InlineQueryResultSticker
-		err := json.Unmarshal(*rawMsg, &inlineQueryResultSticker)
+		err := jsonUnmarshal(*rawMsg, &inlineQueryResultSticker)
 		return &inlineQueryResultSticker, err
 
 	case InlineQueryResultVideoType:
 		var inlineQueryResultVideo InlineQueryResultVideo
-		err := json.Unmarshal(*rawMsg, &inlineQueryResultVideo)
+		err := jsonUnmarshal(*rawMsg, &inlineQueryResultVideo)
 		return &inlineQueryResultVideo, err
 
 	case InlineQueryResultVoiceNoteType:
 		var inlineQueryResultVoiceNote InlineQueryResultVoiceNote
-		err := json.Unmarshal(*rawMsg, &inlineQueryResultVoiceNote)
+		err := jsonUnmarshal(*rawMsg, &inlineQueryResultVoiceNote)
 		return &inlineQueryResultVoiceNote, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownInlineQueryResult{Unknown: unknown}, nil
+	}
+}
+
+func unmarshalInlineQueryResultSlice(rawMsg *json.RawMessage) ([]InlineQueryResult, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var rawItems []*json.RawMessage
+	err := jsonUnmarshal(*rawMsg, &rawItems)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]InlineQueryResult, 0, len(rawItems))
+	for _, rawItem := range rawItems {
+		item, err := unmarshalInlineQueryResult(rawItem)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func decodeInlineQueryResult(r *jsonReader) (InlineQueryResult, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
 	}
+	return unmarshalInlineQueryResult(rawMsg)
 }
 
 // InlineQueryResultArticle Represents a link to an article or web page
@@ -144,11 +220,181 @@ func NewInlineQueryResultArticle(id string, url string, hideUrl bool, title stri
 	return &inlineQueryResultArticleTemp
 }
 
+// MarshalJSON marshals to json
+func (inlineQueryResultArticle *InlineQueryResultArticle) MarshalJSON() ([]byte, error) {
+	return inlineQueryResultArticle.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inlineQueryResultArticle *InlineQueryResultArticle) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inlineQueryResultArticle)
+}
+
+func (inlineQueryResultArticle *InlineQueryResultArticle) appendJSON(b []byte) ([]byte, error) {
+	if inlineQueryResultArticle == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inlineQueryResultArticle.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inlineQueryResultArticle.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, inlineQueryResultArticle.Id)
+	b = append(b, `,"url":`...)
+	b = appendJSONString(b, inlineQueryResultArticle.Url)
+	b = append(b, `,"hide_url":`...)
+	b = appendJSONBool(b, inlineQueryResultArticle.HideUrl)
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, inlineQueryResultArticle.Title)
+	b = append(b, `,"description":`...)
+	b = appendJSONString(b, inlineQueryResultArticle.Description)
+	b = append(b, `,"thumbnail":`...)
+	b, err = inlineQueryResultArticle.Thumbnail.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inlineQueryResultArticle *InlineQueryResultArticle) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inlineQueryResultArticle.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inlineQueryResultArticle.tdCommon.Extra, err = r.readString()
+		case "id":
+			inlineQueryResultArticle.Id, err = r.readString()
+		case "url":
+			inlineQueryResultArticle.Url, err = r.readString()
+		case "hide_url":
+			inlineQueryResultArticle.HideUrl, err = r.readBool()
+		case "title":
+			inlineQueryResultArticle.Title, err = r.readString()
+		case "description":
+			inlineQueryResultArticle.Description, err = r.readString()
+		case "thumbnail":
+			if r.readNull() {
+				inlineQueryResultArticle.Thumbnail = nil
+			} else {
+				inlineQueryResultArticle.Thumbnail = new(Thumbnail)
+				err = inlineQueryResultArticle.Thumbnail.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inlineQueryResultArticle *InlineQueryResultArticle) Clone() *InlineQueryResultArticle {
+	if inlineQueryResultArticle == nil {
+		return nil
+	}
+	clone := *inlineQueryResultArticle
+	clone.Thumbnail = inlineQueryResultArticle.Thumbnail.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inlineQueryResultArticle *InlineQueryResultArticle) Equal(other *InlineQueryResultArticle) bool {
+	if inlineQueryResultArticle == nil || other == nil {
+		return inlineQueryResultArticle == other
+	}
+	if inlineQueryResultArticle.Id != other.Id {
+		return false
+	}
+	if inlineQueryResultArticle.Url != other.Url {
+		return false
+	}
+	if inlineQueryResultArticle.HideUrl != other.HideUrl {
+		return false
+	}
+	if inlineQueryResultArticle.Title != other.Title {
+		return false
+	}
+	if inlineQueryResultArticle.Description != other.Description {
+		return false
+	}
+	if !inlineQueryResultArticle.Thumbnail.Equal(other.Thumbnail) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inlineQueryResultArticle *InlineQueryResultArticle) Diff(other *InlineQueryResultArticle) []Change {
+	return inlineQueryResultArticle.diff("", other, nil)
+}
+
+func (inlineQueryResultArticle *InlineQueryResultArticle) diff(path string, other *InlineQueryResultArticle, changes []Change) []Change {
+	if inlineQueryResultArticle == nil || other == nil {
+		if inlineQueryResultArticle != other {
+			changes = append(changes, Change{Path: path, Old: inlineQueryResultArticle, New: other})
+		}
+		return changes
+	}
+	if inlineQueryResultArticle.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: inlineQueryResultArticle.Id, New: other.Id})
+	}
+	if inlineQueryResultArticle.Url != other.Url {
+		changes = append(changes, Change{Path: fieldPath(path, "url"), Old: inlineQueryResultArticle.Url, New: other.Url})
+	}
+	if inlineQueryResultArticle.HideUrl != other.HideUrl {
+		changes = append(changes, Change{Path: fieldPath(path, "hide_url"), Old: inlineQueryResultArticle.HideUrl, New: other.HideUrl})
+	}
+	if inlineQueryResultArticle.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: inlineQueryResultArticle.Title, New: other.Title})
+	}
+	if inlineQueryResultArticle.Description != other.Description {
+		changes = append(changes, Change{Path: fieldPath(path, "description"), Old: inlineQueryResultArticle.Description, New: other.Description})
+	}
+	changes = inlineQueryResultArticle.Thumbnail.diff(fieldPath(path, "thumbnail"), other.Thumbnail, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inlineQueryResultArticle *InlineQueryResultArticle) String() string {
+	return objectString(inlineQueryResultArticle)
+}
+
+// LogValue logs the object as its summary
+func (inlineQueryResultArticle *InlineQueryResultArticle) LogValue() slog.Value {
+	return slog.StringValue(objectString(inlineQueryResultArticle))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inlineQueryResultArticle *InlineQueryResultArticle) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inlineQueryResultArticle)
+}
+
+func (inlineQueryResultArticle *InlineQueryResultArticle) printText(p *printer) {
+	if inlineQueryResultArticle == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InlineQueryResultArticle")
+	p.string("id", inlineQueryResultArticle.Id)
+	p.string("url", inlineQueryResultArticle.Url)
+	p.bool("hide_url", inlineQueryResultArticle.HideUrl)
+	p.string("title", inlineQueryResultArticle.Title)
+	p.string("description", inlineQueryResultArticle.Description)
+	p.object("thumbnail", inlineQueryResultArticle.Thumbnail)
+	p.endObject(state)
+}
+
 // GetInlineQueryResultEnum return the enum type of this object
 func (inlineQueryResultArticle *InlineQueryResultArticle) GetInlineQueryResultEnum() InlineQueryResultEnum {
 	return InlineQueryResultArticleType
 }
 
+// Accept calls the method of the visitor for InlineQueryResultArticle
+func (inlineQueryResultArticle *InlineQueryResultArticle) Accept(visitor InlineQueryResultVisitor) {
+	visitor.VisitInlineQueryResultArticle(inlineQueryResultArticle)
+}
+
 // InlineQueryResultContact Represents a user contact
 type InlineQueryResultContact struct {
 	tdCommon
@@ -178,11 +424,155 @@ func NewInlineQueryResultContact(id string, contact *Contact, thumbnail *Thumbna
 	return &inlineQueryResultContactTemp
 }
 
+// MarshalJSON marshals to json
+func (inlineQueryResultContact *InlineQueryResultContact) MarshalJSON() ([]byte, error) {
+	return inlineQueryResultContact.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inlineQueryResultContact *InlineQueryResultContact) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inlineQueryResultContact)
+}
+
+func (inlineQueryResultContact *InlineQueryResultContact) appendJSON(b []byte) ([]byte, error) {
+	if inlineQueryResultContact == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inlineQueryResultContact.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inlineQueryResultContact.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, inlineQueryResultContact.Id)
+	b = append(b, `,"contact":`...)
+	b, err = inlineQueryResultContact.Contact.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"thumbnail":`...)
+	b, err = inlineQueryResultContact.Thumbnail.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inlineQueryResultContact *InlineQueryResultContact) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inlineQueryResultContact.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inlineQueryResultContact.tdCommon.Extra, err = r.readString()
+		case "id":
+			inlineQueryResultContact.Id, err = r.readString()
+		case "contact":
+			if r.readNull() {
+				inlineQueryResultContact.Contact = nil
+			} else {
+				inlineQueryResultContact.Contact = new(Contact)
+				err = inlineQueryResultContact.Contact.decodeJSON(r)
+			}
+		case "thumbnail":
+			if r.readNull() {
+				inlineQueryResultContact.Thumbnail = nil
+			} else {
+				inlineQueryResultContact.Thumbnail = new(Thumbnail)
+				err = inlineQueryResultContact.Thumbnail.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inlineQueryResultContact *InlineQueryResultContact) Clone() *InlineQueryResultContact {
+	if inlineQueryResultContact == nil {
+		return nil
+	}
+	clone := *inlineQueryResultContact
+	clone.Contact = inlineQueryResultContact.Contact.Clone()
+	clone.Thumbnail = inlineQueryResultContact.Thumbnail.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inlineQueryResultContact *InlineQueryResultContact) Equal(other *InlineQueryResultContact) bool {
+	if inlineQueryResultContact == nil || other == nil {
+		return inlineQueryResultContact == other
+	}
+	if inlineQueryResultContact.Id != other.Id {
+		return false
+	}
+	if !inlineQueryResultContact.Contact.Equal(other.Contact) {
+		return false
+	}
+	if !inlineQueryResultContact.Thumbnail.Equal(other.Thumbnail) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inlineQueryResultContact *InlineQueryResultContact) Diff(other *InlineQueryResultContact) []Change {
+	return inlineQueryResultContact.diff("", other, nil)
+}
+
+func (inlineQueryResultContact *InlineQueryResultContact) diff(path string, other *InlineQueryResultContact, changes []Change) []Change {
+	if inlineQueryResultContact == nil || other == nil {
+		if inlineQueryResultContact != other {
+			changes = append(changes, Change{Path: path, Old: inlineQueryResultContact, New: other})
+		}
+		return changes
+	}
+	if inlineQueryResultContact.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: inlineQueryResultContact.Id, New: other.Id})
+	}
+	changes = inlineQueryResultContact.Contact.diff(fieldPath(path, "contact"), other.Contact, changes)
+	changes = inlineQueryResultContact.Thumbnail.diff(fieldPath(path, "thumbnail"), other.Thumbnail, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inlineQueryResultContact *InlineQueryResultContact) String() string {
+	return objectString(inlineQueryResultContact)
+}
+
+// LogValue logs the object as its summary
+func (inlineQueryResultContact *InlineQueryResultContact) LogValue() slog.Value {
+	return slog.StringValue(objectString(inlineQueryResultContact))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inlineQueryResultContact *InlineQueryResultContact) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inlineQueryResultContact)
+}
+
+func (inlineQueryResultContact *InlineQueryResultContact) printText(p *printer) {
+	if inlineQueryResultContact == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InlineQueryResultContact")
+	p.string("id", inlineQueryResultContact.Id)
+	p.object("contact", inlineQueryResultContact.Contact)
+	p.object("thumbnail", inlineQueryResultContact.Thumbnail)
+	p.endObject(state)
+}
+
 // GetInlineQueryResultEnum return the enum type of this object
 func (inlineQueryResultContact *InlineQueryResultContact) GetInlineQueryResultEnum() InlineQueryResultEnum {
 	return InlineQueryResultContactType
 }
 
+// Accept calls the method of the visitor for InlineQueryResultContact
+func (inlineQueryResultContact *InlineQueryResultContact) Accept(visitor InlineQueryResultVisitor) {
+	visitor.VisitInlineQueryResultContact(inlineQueryResultContact)
+}
+
 // InlineQueryResultLocation Represents a point on the map
 type InlineQueryResultLocation struct {
 	tdCommon
@@ -215,11 +605,166 @@ func NewInlineQueryResultLocation(id string, location *Location, title string, t
 	return &inlineQueryResultLocationTemp
 }
 
+// MarshalJSON marshals to json
+func (inlineQueryResultLocation *InlineQueryResultLocation) MarshalJSON() ([]byte, error) {
+	return inlineQueryResultLocation.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inlineQueryResultLocation *InlineQueryResultLocation) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inlineQueryResultLocation)
+}
+
+func (inlineQueryResultLocation *InlineQueryResultLocation) appendJSON(b []byte) ([]byte, error) {
+	if inlineQueryResultLocation == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inlineQueryResultLocation.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inlineQueryResultLocation.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, inlineQueryResultLocation.Id)
+	b = append(b, `,"location":`...)
+	b, err = inlineQueryResultLocation.Location.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, inlineQueryResultLocation.Title)
+	b = append(b, `,"thumbnail":`...)
+	b, err = inlineQueryResultLocation.Thumbnail.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inlineQueryResultLocation *InlineQueryResultLocation) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inlineQueryResultLocation.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inlineQueryResultLocation.tdCommon.Extra, err = r.readString()
+		case "id":
+			inlineQueryResultLocation.Id, err = r.readString()
+		case "location":
+			if r.readNull() {
+				inlineQueryResultLocation.Location = nil
+			} else {
+				inlineQueryResultLocation.Location = new(Location)
+				err = inlineQueryResultLocation.Location.decodeJSON(r)
+			}
+		case "title":
+			inlineQueryResultLocation.Title, err = r.readString()
+		case "thumbnail":
+			if r.readNull() {
+				inlineQueryResultLocation.Thumbnail = nil
+			} else {
+				inlineQueryResultLocation.Thumbnail = new(Thumbnail)
+				err = inlineQueryResultLocation.Thumbnail.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inlineQueryResultLocation *InlineQueryResultLocation) Clone() *InlineQueryResultLocation {
+	if inlineQueryResultLocation == nil {
+		return nil
+	}
+	clone := *inlineQueryResultLocation
+	clone.Location = inlineQueryResultLocation.Location.Clone()
+	clone.Thumbnail = inlineQueryResultLocation.Thumbnail.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inlineQueryResultLocation *InlineQueryResultLocation) Equal(other *InlineQueryResultLocation) bool {
+	if inlineQueryResultLocation == nil || other == nil {
+		return inlineQueryResultLocation == other
+	}
+	if inlineQueryResultLocation.Id != other.Id {
+		return false
+	}
+	if !inlineQueryResultLocation.Location.Equal(other.Location) {
+		return false
+	}
+	if inlineQueryResultLocation.Title != other.Title {
+		return false
+	}
+	if !inlineQueryResultLocation.Thumbnail.Equal(other.Thumbnail) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inlineQueryResultLocation *InlineQueryResultLocation) Diff(other *InlineQueryResultLocation) []Change {
+	return inlineQueryResultLocation.diff("", other, nil)
+}
+
+func (inlineQueryResultLocation *InlineQueryResultLocation) diff(path string, other *InlineQueryResultLocation, changes []Change) []Change {
+	if inlineQueryResultLocation == nil || other == nil {
+		if inlineQueryResultLocation != other {
+			changes = append(changes, Change{Path: path, Old: inlineQueryResultLocation, New: other})
+		}
+		return changes
+	}
+	if inlineQueryResultLocation.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: inlineQueryResultLocation.Id, New: other.Id})
+	}
+	changes = inlineQueryResultLocation.Location.diff(fieldPath(path, "location"), other.Location, changes)
+	if inlineQueryResultLocation.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: inlineQueryResultLocation.Title, New: other.Title})
+	}
+	changes = inlineQueryResultLocation.Thumbnail.diff(fieldPath(path, "thumbnail"), other.Thumbnail, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inlineQueryResultLocation *InlineQueryResultLocation) String() string {
+	return objectString(inlineQueryResultLocation)
+}
+
+// LogValue logs the object as its summary
+func (inlineQueryResultLocation *InlineQueryResultLocation) LogValue() slog.Value {
+	return slog.StringValue(objectString(inlineQueryResultLocation))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inlineQueryResultLocation *InlineQueryResultLocation) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inlineQueryResultLocation)
+}
+
+func (inlineQueryResultLocation *InlineQueryResultLocation) printText(p *printer) {
+	if inlineQueryResultLocation == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InlineQueryResultLocation")
+	p.string("id", inlineQueryResultLocation.Id)
+	p.object("location", inlineQueryResultLocation.Location)
+	p.string("title", inlineQueryResultLocation.Title)
+	p.object("thumbnail", inlineQueryResultLocation.Thumbnail)
+	p.endObject(state)
+}
+
 // GetInlineQueryResultEnum return the enum type of this object
 func (inlineQueryResultLocation *InlineQueryResultLocation) GetInlineQueryResultEnum() InlineQueryResultEnum {
 	return InlineQueryResultLocationType
 }
 
+// Accept calls the method of the visitor for InlineQueryResultLocation
+func (inlineQueryResultLocation *InlineQueryResultLocation) Accept(visitor InlineQueryResultVisitor) {
+	visitor.VisitInlineQueryResultLocation(inlineQueryResultLocation)
+}
+
 // InlineQueryResultVenue Represents information about a venue
 type InlineQueryResultVenue struct {
 	tdCommon
@@ -249,11 +794,155 @@ func NewInlineQueryResultVenue(id string, venue *Venue, thumbnail *Thumbnail) *I
 	return &inlineQueryResultVenueTemp
 }
 
+// MarshalJSON marshals to json
+func (inlineQueryResultVenue *InlineQueryResultVenue) MarshalJSON() ([]byte, error) {
+	return inlineQueryResultVenue.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inlineQueryResultVenue *InlineQueryResultVenue) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inlineQueryResultVenue)
+}
+
+func (inlineQueryResultVenue *InlineQueryResultVenue) appendJSON(b []byte) ([]byte, error) {
+	if inlineQueryResultVenue == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inlineQueryResultVenue.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inlineQueryResultVenue.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, inlineQueryResultVenue.Id)
+	b = append(b, `,"venue":`...)
+	b, err = inlineQueryResultVenue.Venue.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"thumbnail":`...)
+	b, err = inlineQueryResultVenue.Thumbnail.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inlineQueryResultVenue *InlineQueryResultVenue) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inlineQueryResultVenue.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inlineQueryResultVenue.tdCommon.Extra, err = r.readString()
+		case "id":
+			inlineQueryResultVenue.Id, err = r.readString()
+		case "venue":
+			if r.readNull() {
+				inlineQueryResultVenue.Venue = nil
+			} else {
+				inlineQueryResultVenue.Venue = new(Venue)
+				err = inlineQueryResultVenue.Venue.decodeJSON(r)
+			}
+		case "thumbnail":
+			if r.readNull() {
+				inlineQueryResultVenue.Thumbnail = nil
+			} else {
+				inlineQueryResultVenue.Thumbnail = new(Thumbnail)
+				err = inlineQueryResultVenue.Thumbnail.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inlineQueryResultVenue *InlineQueryResultVenue) Clone() *InlineQueryResultVenue {
+	if inlineQueryResultVenue == nil {
+		return nil
+	}
+	clone := *inlineQueryResultVenue
+	clone.Venue = inlineQueryResultVenue.Venue.Clone()
+	clone.Thumbnail = inlineQueryResultVenue.Thumbnail.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inlineQueryResultVenue *InlineQueryResultVenue) Equal(other *InlineQueryResultVenue) bool {
+	if inlineQueryResultVenue == nil || other == nil {
+		return inlineQueryResultVenue == other
+	}
+	if inlineQueryResultVenue.Id != other.Id {
+		return false
+	}
+	if !inlineQueryResultVenue.Venue.Equal(other.Venue) {
+		return false
+	}
+	if !inlineQueryResultVenue.Thumbnail.Equal(other.Thumbnail) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inlineQueryResultVenue *InlineQueryResultVenue) Diff(other *InlineQueryResultVenue) []Change {
+	return inlineQueryResultVenue.diff("", other, nil)
+}
+
+func (inlineQueryResultVenue *InlineQueryResultVenue) diff(path string, other *InlineQueryResultVenue, changes []Change) []Change {
+	if inlineQueryResultVenue == nil || other == nil {
+		if inlineQueryResultVenue != other {
+			changes = append(changes, Change{Path: path, Old: inlineQueryResultVenue, New: other})
+		}
+		return changes
+	}
+	if inlineQueryResultVenue.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: inlineQueryResultVenue.Id, New: other.Id})
+	}
+	changes = inlineQueryResultVenue.Venue.diff(fieldPath(path, "venue"), other.Venue, changes)
+	changes = inlineQueryResultVenue.Thumbnail.diff(fieldPath(path, "thumbnail"), other.Thumbnail, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inlineQueryResultVenue *InlineQueryResultVenue) String() string {
+	return objectString(inlineQueryResultVenue)
+}
+
+// LogValue logs the object as its summary
+func (inlineQueryResultVenue *InlineQueryResultVenue) LogValue() slog.Value {
+	return slog.StringValue(objectString(inlineQueryResultVenue))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inlineQueryResultVenue *InlineQueryResultVenue) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inlineQueryResultVenue)
+}
+
+func (inlineQueryResultVenue *InlineQueryResultVenue) printText(p *printer) {
+	if inlineQueryResultVenue == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InlineQueryResultVenue")
+	p.string("id", inlineQueryResultVenue.Id)
+	p.object("venue", inlineQueryResultVenue.Venue)
+	p.object("thumbnail", inlineQueryResultVenue.Thumbnail)
+	p.endObject(state)
+}
+
 // GetInlineQueryResultEnum return the enum type of this object
 func (inlineQueryResultVenue *InlineQueryResultVenue) GetInlineQueryResultEnum() InlineQueryResultEnum {
 	return InlineQueryResultVenueType
 }
 
+// Accept calls the method of the visitor for InlineQueryResultVenue
+func (inlineQueryResultVenue *InlineQueryResultVenue) Accept(visitor InlineQueryResultVisitor) {
+	visitor.VisitInlineQueryResultVenue(inlineQueryResultVenue)
+}
+
 // InlineQueryResultGame Represents information about a game
 type InlineQueryResultGame struct {
 	tdCommon
@@ -280,11 +969,137 @@ func NewInlineQueryResultGame(id string, game *Game) *InlineQueryResultGame {
 	return &inlineQueryResultGameTemp
 }
 
+// MarshalJSON marshals to json
+func (inlineQueryResultGame *InlineQueryResultGame) MarshalJSON() ([]byte, error) {
+	return inlineQueryResultGame.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inlineQueryResultGame *InlineQueryResultGame) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inlineQueryResultGame)
+}
+
+func (inlineQueryResultGame *InlineQueryResultGame) appendJSON(b []byte) ([]byte, error) {
+	if inlineQueryResultGame == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inlineQueryResultGame.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inlineQueryResultGame.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, inlineQueryResultGame.Id)
+	b = append(b, `,"game":`...)
+	b, err = inlineQueryResultGame.Game.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inlineQueryResultGame *InlineQueryResultGame) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inlineQueryResultGame.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inlineQueryResultGame.tdCommon.Extra, err = r.readString()
+		case "id":
+			inlineQueryResultGame.Id, err = r.readString()
+		case "game":
+			if r.readNull() {
+				inlineQueryResultGame.Game = nil
+			} else {
+				inlineQueryResultGame.Game = new(Game)
+				err = inlineQueryResultGame.Game.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inlineQueryResultGame *InlineQueryResultGame) Clone() *InlineQueryResultGame {
+	if inlineQueryResultGame == nil {
+		return nil
+	}
+	clone := *inlineQueryResultGame
+	clone.Game = inlineQueryResultGame.Game.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inlineQueryResultGame *InlineQueryResultGame) Equal(other *InlineQueryResultGame) bool {
+	if inlineQueryResultGame == nil || other == nil {
+		return inlineQueryResultGame == other
+	}
+	if inlineQueryResultGame.Id != other.Id {
+		return false
+	}
+	if !inlineQueryResultGame.Game.Equal(other.Game) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inlineQueryResultGame *InlineQueryResultGame) Diff(other *InlineQueryResultGame) []Change {
+	return inlineQueryResultGame.diff("", other, nil)
+}
+
+func (inlineQueryResultGame *InlineQueryResultGame) diff(path string, other *InlineQueryResultGame, changes []Change) []Change {
+	if inlineQueryResultGame == nil || other == nil {
+		if inlineQueryResultGame != other {
+			changes = append(changes, Change{Path: path, Old: inlineQueryResultGame, New: other})
+		}
+		return changes
+	}
+	if inlineQueryResultGame.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: inlineQueryResultGame.Id, New: other.Id})
+	}
+	changes = inlineQueryResultGame.Game.diff(fieldPath(path, "game"), other.Game, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inlineQueryResultGame *InlineQueryResultGame) String() string {
+	return objectString(inlineQueryResultGame)
+}
+
+// LogValue logs the object as its summary
+func (inlineQueryResultGame *InlineQueryResultGame) LogValue() slog.Value {
+	return slog.StringValue(objectString(inlineQueryResultGame))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inlineQueryResultGame *InlineQueryResultGame) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inlineQueryResultGame)
+}
+
+func (inlineQueryResultGame *InlineQueryResultGame) printText(p *printer) {
+	if inlineQueryResultGame == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InlineQueryResultGame")
+	p.string("id", inlineQueryResultGame.Id)
+	p.object("game", inlineQueryResultGame.Game)
+	p.endObject(state)
+}
+
 // GetInlineQueryResultEnum return the enum type of this object
 func (inlineQueryResultGame *InlineQueryResultGame) GetInlineQueryResultEnum() InlineQueryResultEnum {
 	return InlineQueryResultGameType
 }
 
+// Accept calls the method of the visitor for InlineQueryResultGame
+func (inlineQueryResultGame *InlineQueryResultGame) Accept(visitor InlineQueryResultVisitor) {
+	visitor.VisitInlineQueryResultGame(inlineQueryResultGame)
+}
+
 // InlineQueryResultAnimation Represents an animation file
 type InlineQueryResultAnimation struct {
 	tdCommon
@@ -314,24 +1129,161 @@ func NewInlineQueryResultAnimation(id string, animation *Animation, title string
 	return &inlineQueryResultAnimationTemp
 }
 
-// GetInlineQueryResultEnum return the enum type of this object
-func (inlineQueryResultAnimation *InlineQueryResultAnimation) GetInlineQueryResultEnum() InlineQueryResultEnum {
-	return InlineQueryResultAnimationType
+// MarshalJSON marshals to json
+func (inlineQueryResultAnimation *InlineQueryResultAnimation) MarshalJSON() ([]byte, error) {
+	return inlineQueryResultAnimation.appendJSON(nil)
 }
 
-// InlineQueryResultAudio Represents an audio file
-type InlineQueryResultAudio struct {
-	tdCommon
-	Id    string `json:"id"`    // Unique identifier of the query result
-	Audio *Audio `json:"audio"` // Audio file
+// UnmarshalJSON unmarshal to json
+func (inlineQueryResultAnimation *InlineQueryResultAnimation) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inlineQueryResultAnimation)
 }
 
-// MessageType return the string telegram-type of InlineQueryResultAudio
-func (inlineQueryResultAudio *InlineQueryResultAudio) MessageType() string {
-	return "inlineQueryResultAudio"
+func (inlineQueryResultAnimation *InlineQueryResultAnimation) appendJSON(b []byte) ([]byte, error) {
+	if inlineQueryResultAnimation == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inlineQueryResultAnimation.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inlineQueryResultAnimation.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, inlineQueryResultAnimation.Id)
+	b = append(b, `,"animation":`...)
+	b, err = inlineQueryResultAnimation.Animation.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, inlineQueryResultAnimation.Title)
+	return append(b, '}'), nil
 }
 
-// NewInlineQueryResultAudio creates a new InlineQueryResultAudio
+func (inlineQueryResultAnimation *InlineQueryResultAnimation) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inlineQueryResultAnimation.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inlineQueryResultAnimation.tdCommon.Extra, err = r.readString()
+		case "id":
+			inlineQueryResultAnimation.Id, err = r.readString()
+		case "animation":
+			if r.readNull() {
+				inlineQueryResultAnimation.Animation = nil
+			} else {
+				inlineQueryResultAnimation.Animation = new(Animation)
+				err = inlineQueryResultAnimation.Animation.decodeJSON(r)
+			}
+		case "title":
+			inlineQueryResultAnimation.Title, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inlineQueryResultAnimation *InlineQueryResultAnimation) Clone() *InlineQueryResultAnimation {
+	if inlineQueryResultAnimation == nil {
+		return nil
+	}
+	clone := *inlineQueryResultAnimation
+	clone.Animation = inlineQueryResultAnimation.Animation.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inlineQueryResultAnimation *InlineQueryResultAnimation) Equal(other *InlineQueryResultAnimation) bool {
+	if inlineQueryResultAnimation == nil || other == nil {
+		return inlineQueryResultAnimation == other
+	}
+	if inlineQueryResultAnimation.Id != other.Id {
+		return false
+	}
+	if !inlineQueryResultAnimation.Animation.Equal(other.Animation) {
+		return false
+	}
+	if inlineQueryResultAnimation.Title != other.Title {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inlineQueryResultAnimation *InlineQueryResultAnimation) Diff(other *InlineQueryResultAnimation) []Change {
+	return inlineQueryResultAnimation.diff("", other, nil)
+}
+
+func (inlineQueryResultAnimation *InlineQueryResultAnimation) diff(path string, other *InlineQueryResultAnimation, changes []Change) []Change {
+	if inlineQueryResultAnimation == nil || other == nil {
+		if inlineQueryResultAnimation != other {
+			changes = append(changes, Change{Path: path, Old: inlineQueryResultAnimation, New: other})
+		}
+		return changes
+	}
+	if inlineQueryResultAnimation.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: inlineQueryResultAnimation.Id, New: other.Id})
+	}
+	changes = inlineQueryResultAnimation.Animation.diff(fieldPath(path, "animation"), other.Animation, changes)
+	if inlineQueryResultAnimation.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: inlineQueryResultAnimation.Title, New: other.Title})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inlineQueryResultAnimation *InlineQueryResultAnimation) String() string {
+	return objectString(inlineQueryResultAnimation)
+}
+
+// LogValue logs the object as its summary
+func (inlineQueryResultAnimation *InlineQueryResultAnimation) LogValue() slog.Value {
+	return slog.StringValue(objectString(inlineQueryResultAnimation))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inlineQueryResultAnimation *InlineQueryResultAnimation) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inlineQueryResultAnimation)
+}
+
+func (inlineQueryResultAnimation *InlineQueryResultAnimation) printText(p *printer) {
+	if inlineQueryResultAnimation == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InlineQueryResultAnimation")
+	p.string("id", inlineQueryResultAnimation.Id)
+	p.object("animation", inlineQueryResultAnimation.Animation)
+	p.string("title", inlineQueryResultAnimation.Title)
+	p.endObject(state)
+}
+
+// GetInlineQueryResultEnum return the enum type of this object
+func (inlineQueryResultAnimation *InlineQueryResultAnimation) GetInlineQueryResultEnum() InlineQueryResultEnum {
+	return InlineQueryResultAnimationType
+}
+
+// Accept calls the method of the visitor for InlineQueryResultAnimation
+func (inlineQueryResultAnimation *InlineQueryResultAnimation) Accept(visitor InlineQueryResultVisitor) {
+	visitor.VisitInlineQueryResultAnimation(inlineQueryResultAnimation)
+}
+
+// InlineQueryResultAudio Represents an audio file
+type InlineQueryResultAudio struct {
+	tdCommon
+	Id    string `json:"id"`    // Unique identifier of the query result
+	Audio *Audio `json:"audio"` // Audio file
+}
+
+// MessageType return the string telegram-type of InlineQueryResultAudio
+func (inlineQueryResultAudio *InlineQueryResultAudio) MessageType() string {
+	return "inlineQueryResultAudio"
+}
+
+// NewInlineQueryResultAudio creates a new InlineQueryResultAudio
 //
 // @param id Unique identifier of the query result
 // @param audio Audio file
@@ -345,11 +1297,137 @@ func NewInlineQueryResultAudio(id string, audio *Audio) *InlineQueryResultAudio
 	return &inlineQueryResultAudioTemp
 }
 
+// MarshalJSON marshals to json
+func (inlineQueryResultAudio *InlineQueryResultAudio) MarshalJSON() ([]byte, error) {
+	return inlineQueryResultAudio.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inlineQueryResultAudio *InlineQueryResultAudio) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inlineQueryResultAudio)
+}
+
+func (inlineQueryResultAudio *InlineQueryResultAudio) appendJSON(b []byte) ([]byte, error) {
+	if inlineQueryResultAudio == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inlineQueryResultAudio.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inlineQueryResultAudio.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, inlineQueryResultAudio.Id)
+	b = append(b, `,"audio":`...)
+	b, err = inlineQueryResultAudio.Audio.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inlineQueryResultAudio *InlineQueryResultAudio) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inlineQueryResultAudio.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inlineQueryResultAudio.tdCommon.Extra, err = r.readString()
+		case "id":
+			inlineQueryResultAudio.Id, err = r.readString()
+		case "audio":
+			if r.readNull() {
+				inlineQueryResultAudio.Audio = nil
+			} else {
+				inlineQueryResultAudio.Audio = new(Audio)
+				err = inlineQueryResultAudio.Audio.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inlineQueryResultAudio *InlineQueryResultAudio) Clone() *InlineQueryResultAudio {
+	if inlineQueryResultAudio == nil {
+		return nil
+	}
+	clone := *inlineQueryResultAudio
+	clone.Audio = inlineQueryResultAudio.Audio.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inlineQueryResultAudio *InlineQueryResultAudio) Equal(other *InlineQueryResultAudio) bool {
+	if inlineQueryResultAudio == nil || other == nil {
+		return inlineQueryResultAudio == other
+	}
+	if inlineQueryResultAudio.Id != other.Id {
+		return false
+	}
+	if !inlineQueryResultAudio.Audio.Equal(other.Audio) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inlineQueryResultAudio *InlineQueryResultAudio) Diff(other *InlineQueryResultAudio) []Change {
+	return inlineQueryResultAudio.diff("", other, nil)
+}
+
+func (inlineQueryResultAudio *InlineQueryResultAudio) diff(path string, other *InlineQueryResultAudio, changes []Change) []Change {
+	if inlineQueryResultAudio == nil || other == nil {
+		if inlineQueryResultAudio != other {
+			changes = append(changes, Change{Path: path, Old: inlineQueryResultAudio, New: other})
+		}
+		return changes
+	}
+	if inlineQueryResultAudio.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: inlineQueryResultAudio.Id, New: other.Id})
+	}
+	changes = inlineQueryResultAudio.Audio.diff(fieldPath(path, "audio"), other.Audio, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inlineQueryResultAudio *InlineQueryResultAudio) String() string {
+	return objectString(inlineQueryResultAudio)
+}
+
+// LogValue logs the object as its summary
+func (inlineQueryResultAudio *InlineQueryResultAudio) LogValue() slog.Value {
+	return slog.StringValue(objectString(inlineQueryResultAudio))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inlineQueryResultAudio *InlineQueryResultAudio) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inlineQueryResultAudio)
+}
+
+func (inlineQueryResultAudio *InlineQueryResultAudio) printText(p *printer) {
+	if inlineQueryResultAudio == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InlineQueryResultAudio")
+	p.string("id", inlineQueryResultAudio.Id)
+	p.object("audio", inlineQueryResultAudio.Audio)
+	p.endObject(state)
+}
+
 // GetInlineQueryResultEnum return the enum type of this object
 func (inlineQueryResultAudio *InlineQueryResultAudio) GetInlineQueryResultEnum() InlineQueryResultEnum {
 	return InlineQueryResultAudioType
 }
 
+// Accept calls the method of the visitor for InlineQueryResultAudio
+func (inlineQueryResultAudio *InlineQueryResultAudio) Accept(visitor InlineQueryResultVisitor) {
+	visitor.VisitInlineQueryResultAudio(inlineQueryResultAudio)
+}
+
 // InlineQueryResultDocument Represents a document
 type InlineQueryResultDocument struct {
 	tdCommon
@@ -382,11 +1460,159 @@ func NewInlineQueryResultDocument(id string, document *Document, title string, d
 	return &inlineQueryResultDocumentTemp
 }
 
+// MarshalJSON marshals to json
+func (inlineQueryResultDocument *InlineQueryResultDocument) MarshalJSON() ([]byte, error) {
+	return inlineQueryResultDocument.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inlineQueryResultDocument *InlineQueryResultDocument) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inlineQueryResultDocument)
+}
+
+func (inlineQueryResultDocument *InlineQueryResultDocument) appendJSON(b []byte) ([]byte, error) {
+	if inlineQueryResultDocument == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inlineQueryResultDocument.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inlineQueryResultDocument.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, inlineQueryResultDocument.Id)
+	b = append(b, `,"document":`...)
+	b, err = inlineQueryResultDocument.Document.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, inlineQueryResultDocument.Title)
+	b = append(b, `,"description":`...)
+	b = appendJSONString(b, inlineQueryResultDocument.Description)
+	return append(b, '}'), nil
+}
+
+func (inlineQueryResultDocument *InlineQueryResultDocument) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inlineQueryResultDocument.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inlineQueryResultDocument.tdCommon.Extra, err = r.readString()
+		case "id":
+			inlineQueryResultDocument.Id, err = r.readString()
+		case "document":
+			if r.readNull() {
+				inlineQueryResultDocument.Document = nil
+			} else {
+				inlineQueryResultDocument.Document = new(Document)
+				err = inlineQueryResultDocument.Document.decodeJSON(r)
+			}
+		case "title":
+			inlineQueryResultDocument.Title, err = r.readString()
+		case "description":
+			inlineQueryResultDocument.Description, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inlineQueryResultDocument *InlineQueryResultDocument) Clone() *InlineQueryResultDocument {
+	if inlineQueryResultDocument == nil {
+		return nil
+	}
+	clone := *inlineQueryResultDocument
+	clone.Document = inlineQueryResultDocument.Document.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inlineQueryResultDocument *InlineQueryResultDocument) Equal(other *InlineQueryResultDocument) bool {
+	if inlineQueryResultDocument == nil || other == nil {
+		return inlineQueryResultDocument == other
+	}
+	if inlineQueryResultDocument.Id != other.Id {
+		return false
+	}
+	if !inlineQueryResultDocument.Document.Equal(other.Document) {
+		return false
+	}
+	if inlineQueryResultDocument.Title != other.Title {
+		return false
+	}
+	if inlineQueryResultDocument.Description != other.Description {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inlineQueryResultDocument *InlineQueryResultDocument) Diff(other *InlineQueryResultDocument) []Change {
+	return inlineQueryResultDocument.diff("", other, nil)
+}
+
+func (inlineQueryResultDocument *InlineQueryResultDocument) diff(path string, other *InlineQueryResultDocument, changes []Change) []Change {
+	if inlineQueryResultDocument == nil || other == nil {
+		if inlineQueryResultDocument != other {
+			changes = append(changes, Change{Path: path, Old: inlineQueryResultDocument, New: other})
+		}
+		return changes
+	}
+	if inlineQueryResultDocument.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: inlineQueryResultDocument.Id, New: other.Id})
+	}
+	changes = inlineQueryResultDocument.Document.diff(fieldPath(path, "document"), other.Document, changes)
+	if inlineQueryResultDocument.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: inlineQueryResultDocument.Title, New: other.Title})
+	}
+	if inlineQueryResultDocument.Description != other.Description {
+		changes = append(changes, Change{Path: fieldPath(path, "description"), Old: inlineQueryResultDocument.Description, New: other.Description})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inlineQueryResultDocument *InlineQueryResultDocument) String() string {
+	return objectString(inlineQueryResultDocument)
+}
+
+// LogValue logs the object as its summary
+func (inlineQueryResultDocument *InlineQueryResultDocument) LogValue() slog.Value {
+	return slog.StringValue(objectString(inlineQueryResultDocument))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inlineQueryResultDocument *InlineQueryResultDocument) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inlineQueryResultDocument)
+}
+
+func (inlineQueryResultDocument *InlineQueryResultDocument) printText(p *printer) {
+	if inlineQueryResultDocument == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InlineQueryResultDocument")
+	p.string("id", inlineQueryResultDocument.Id)
+	p.object("document", inlineQueryResultDocument.Document)
+	p.string("title", inlineQueryResultDocument.Title)
+	p.string("description", inlineQueryResultDocument.Description)
+	p.endObject(state)
+}
+
 // GetInlineQueryResultEnum return the enum type of this object
 func (inlineQueryResultDocument *InlineQueryResultDocument) GetInlineQueryResultEnum() InlineQueryResultEnum {
 	return InlineQueryResultDocumentType
 }
 
+// Accept calls the method of the visitor for InlineQueryResultDocument
+func (inlineQueryResultDocument *InlineQueryResultDocument) Accept(visitor InlineQueryResultVisitor) {
+	visitor.VisitInlineQueryResultDocument(inlineQueryResultDocument)
+}
+
 // InlineQueryResultPhoto Represents a photo
 type InlineQueryResultPhoto struct {
 	tdCommon
@@ -419,11 +1645,159 @@ func NewInlineQueryResultPhoto(id string, photo *Photo, title string, descriptio
 	return &inlineQueryResultPhotoTemp
 }
 
+// MarshalJSON marshals to json
+func (inlineQueryResultPhoto *InlineQueryResultPhoto) MarshalJSON() ([]byte, error) {
+	return inlineQueryResultPhoto.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inlineQueryResultPhoto *InlineQueryResultPhoto) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inlineQueryResultPhoto)
+}
+
+func (inlineQueryResultPhoto *InlineQueryResultPhoto) appendJSON(b []byte) ([]byte, error) {
+	if inlineQueryResultPhoto == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inlineQueryResultPhoto.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inlineQueryResultPhoto.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, inlineQueryResultPhoto.Id)
+	b = append(b, `,"photo":`...)
+	b, err = inlineQueryResultPhoto.Photo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, inlineQueryResultPhoto.Title)
+	b = append(b, `,"description":`...)
+	b = appendJSONString(b, inlineQueryResultPhoto.Description)
+	return append(b, '}'), nil
+}
+
+func (inlineQueryResultPhoto *InlineQueryResultPhoto) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inlineQueryResultPhoto.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inlineQueryResultPhoto.tdCommon.Extra, err = r.readString()
+		case "id":
+			inlineQueryResultPhoto.Id, err = r.readString()
+		case "photo":
+			if r.readNull() {
+				inlineQueryResultPhoto.Photo = nil
+			} else {
+				inlineQueryResultPhoto.Photo = new(Photo)
+				err = inlineQueryResultPhoto.Photo.decodeJSON(r)
+			}
+		case "title":
+			inlineQueryResultPhoto.Title, err = r.readString()
+		case "description":
+			inlineQueryResultPhoto.Description, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inlineQueryResultPhoto *InlineQueryResultPhoto) Clone() *InlineQueryResultPhoto {
+	if inlineQueryResultPhoto == nil {
+		return nil
+	}
+	clone := *inlineQueryResultPhoto
+	clone.Photo = inlineQueryResultPhoto.Photo.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inlineQueryResultPhoto *InlineQueryResultPhoto) Equal(other *InlineQueryResultPhoto) bool {
+	if inlineQueryResultPhoto == nil || other == nil {
+		return inlineQueryResultPhoto == other
+	}
+	if inlineQueryResultPhoto.Id != other.Id {
+		return false
+	}
+	if !inlineQueryResultPhoto.Photo.Equal(other.Photo) {
+		return false
+	}
+	if inlineQueryResultPhoto.Title != other.Title {
+		return false
+	}
+	if inlineQueryResultPhoto.Description != other.Description {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inlineQueryResultPhoto *InlineQueryResultPhoto) Diff(other *InlineQueryResultPhoto) []Change {
+	return inlineQueryResultPhoto.diff("", other, nil)
+}
+
+func (inlineQueryResultPhoto *InlineQueryResultPhoto) diff(path string, other *InlineQueryResultPhoto, changes []Change) []Change {
+	if inlineQueryResultPhoto == nil || other == nil {
+		if inlineQueryResultPhoto != other {
+			changes = append(changes, Change{Path: path, Old: inlineQueryResultPhoto, New: other})
+		}
+		return changes
+	}
+	if inlineQueryResultPhoto.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: inlineQueryResultPhoto.Id, New: other.Id})
+	}
+	changes = inlineQueryResultPhoto.Photo.diff(fieldPath(path, "photo"), other.Photo, changes)
+	if inlineQueryResultPhoto.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: inlineQueryResultPhoto.Title, New: other.Title})
+	}
+	if inlineQueryResultPhoto.Description != other.Description {
+		changes = append(changes, Change{Path: fieldPath(path, "description"), Old: inlineQueryResultPhoto.Description, New: other.Description})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inlineQueryResultPhoto *InlineQueryResultPhoto) String() string {
+	return objectString(inlineQueryResultPhoto)
+}
+
+// LogValue logs the object as its summary
+func (inlineQueryResultPhoto *InlineQueryResultPhoto) LogValue() slog.Value {
+	return slog.StringValue(objectString(inlineQueryResultPhoto))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inlineQueryResultPhoto *InlineQueryResultPhoto) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inlineQueryResultPhoto)
+}
+
+func (inlineQueryResultPhoto *InlineQueryResultPhoto) printText(p *printer) {
+	if inlineQueryResultPhoto == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InlineQueryResultPhoto")
+	p.string("id", inlineQueryResultPhoto.Id)
+	p.object("photo", inlineQueryResultPhoto.Photo)
+	p.string("title", inlineQueryResultPhoto.Title)
+	p.string("description", inlineQueryResultPhoto.Description)
+	p.endObject(state)
+}
+
 // GetInlineQueryResultEnum return the enum type of this object
 func (inlineQueryResultPhoto *InlineQueryResultPhoto) GetInlineQueryResultEnum() InlineQueryResultEnum {
 	return InlineQueryResultPhotoType
 }
 
+// Accept calls the method of the visitor for InlineQueryResultPhoto
+func (inlineQueryResultPhoto *InlineQueryResultPhoto) Accept(visitor InlineQueryResultVisitor) {
+	visitor.VisitInlineQueryResultPhoto(inlineQueryResultPhoto)
+}
+
 // InlineQueryResultSticker Represents a sticker
 type InlineQueryResultSticker struct {
 	tdCommon
@@ -450,11 +1824,137 @@ func NewInlineQueryResultSticker(id string, sticker *Sticker) *InlineQueryResult
 	return &inlineQueryResultStickerTemp
 }
 
+// MarshalJSON marshals to json
+func (inlineQueryResultSticker *InlineQueryResultSticker) MarshalJSON() ([]byte, error) {
+	return inlineQueryResultSticker.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inlineQueryResultSticker *InlineQueryResultSticker) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inlineQueryResultSticker)
+}
+
+func (inlineQueryResultSticker *InlineQueryResultSticker) appendJSON(b []byte) ([]byte, error) {
+	if inlineQueryResultSticker == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inlineQueryResultSticker.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inlineQueryResultSticker.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, inlineQueryResultSticker.Id)
+	b = append(b, `,"sticker":`...)
+	b, err = inlineQueryResultSticker.Sticker.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inlineQueryResultSticker *InlineQueryResultSticker) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inlineQueryResultSticker.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inlineQueryResultSticker.tdCommon.Extra, err = r.readString()
+		case "id":
+			inlineQueryResultSticker.Id, err = r.readString()
+		case "sticker":
+			if r.readNull() {
+				inlineQueryResultSticker.Sticker = nil
+			} else {
+				inlineQueryResultSticker.Sticker = new(Sticker)
+				err = inlineQueryResultSticker.Sticker.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inlineQueryResultSticker *InlineQueryResultSticker) Clone() *InlineQueryResultSticker {
+	if inlineQueryResultSticker == nil {
+		return nil
+	}
+	clone := *inlineQueryResultSticker
+	clone.Sticker = inlineQueryResultSticker.Sticker.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inlineQueryResultSticker *InlineQueryResultSticker) Equal(other *InlineQueryResultSticker) bool {
+	if inlineQueryResultSticker == nil || other == nil {
+		return inlineQueryResultSticker == other
+	}
+	if inlineQueryResultSticker.Id != other.Id {
+		return false
+	}
+	if !inlineQueryResultSticker.Sticker.Equal(other.Sticker) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inlineQueryResultSticker *InlineQueryResultSticker) Diff(other *InlineQueryResultSticker) []Change {
+	return inlineQueryResultSticker.diff("", other, nil)
+}
+
+func (inlineQueryResultSticker *InlineQueryResultSticker) diff(path string, other *InlineQueryResultSticker, changes []Change) []Change {
+	if inlineQueryResultSticker == nil || other == nil {
+		if inlineQueryResultSticker != other {
+			changes = append(changes, Change{Path: path, Old: inlineQueryResultSticker, New: other})
+		}
+		return changes
+	}
+	if inlineQueryResultSticker.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: inlineQueryResultSticker.Id, New: other.Id})
+	}
+	changes = inlineQueryResultSticker.Sticker.diff(fieldPath(path, "sticker"), other.Sticker, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inlineQueryResultSticker *InlineQueryResultSticker) String() string {
+	return objectString(inlineQueryResultSticker)
+}
+
+// LogValue logs the object as its summary
+func (inlineQueryResultSticker *InlineQueryResultSticker) LogValue() slog.Value {
+	return slog.StringValue(objectString(inlineQueryResultSticker))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inlineQueryResultSticker *InlineQueryResultSticker) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inlineQueryResultSticker)
+}
+
+func (inlineQueryResultSticker *InlineQueryResultSticker) printText(p *printer) {
+	if inlineQueryResultSticker == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InlineQueryResultSticker")
+	p.string("id", inlineQueryResultSticker.Id)
+	p.object("sticker", inlineQueryResultSticker.Sticker)
+	p.endObject(state)
+}
+
 // GetInlineQueryResultEnum return the enum type of this object
 func (inlineQueryResultSticker *InlineQueryResultSticker) GetInlineQueryResultEnum() InlineQueryResultEnum {
 	return InlineQueryResultStickerType
 }
 
+// Accept calls the method of the visitor for InlineQueryResultSticker
+func (inlineQueryResultSticker *InlineQueryResultSticker) Accept(visitor InlineQueryResultVisitor) {
+	visitor.VisitInlineQueryResultSticker(inlineQueryResultSticker)
+}
+
 // InlineQueryResultVideo Represents a video
 type InlineQueryResultVideo struct {
 	tdCommon
@@ -487,11 +1987,159 @@ func NewInlineQueryResultVideo(id string, video *Video, title string, descriptio
 	return &inlineQueryResultVideoTemp
 }
 
+// MarshalJSON marshals to json
+func (inlineQueryResultVideo *InlineQueryResultVideo) MarshalJSON() ([]byte, error) {
+	return inlineQueryResultVideo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inlineQueryResultVideo *InlineQueryResultVideo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inlineQueryResultVideo)
+}
+
+func (inlineQueryResultVideo *InlineQueryResultVideo) appendJSON(b []byte) ([]byte, error) {
+	if inlineQueryResultVideo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inlineQueryResultVideo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inlineQueryResultVideo.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, inlineQueryResultVideo.Id)
+	b = append(b, `,"video":`...)
+	b, err = inlineQueryResultVideo.Video.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, inlineQueryResultVideo.Title)
+	b = append(b, `,"description":`...)
+	b = appendJSONString(b, inlineQueryResultVideo.Description)
+	return append(b, '}'), nil
+}
+
+func (inlineQueryResultVideo *InlineQueryResultVideo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inlineQueryResultVideo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inlineQueryResultVideo.tdCommon.Extra, err = r.readString()
+		case "id":
+			inlineQueryResultVideo.Id, err = r.readString()
+		case "video":
+			if r.readNull() {
+				inlineQueryResultVideo.Video = nil
+			} else {
+				inlineQueryResultVideo.Video = new(Video)
+				err = inlineQueryResultVideo.Video.decodeJSON(r)
+			}
+		case "title":
+			inlineQueryResultVideo.Title, err = r.readString()
+		case "description":
+			inlineQueryResultVideo.Description, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inlineQueryResultVideo *InlineQueryResultVideo) Clone() *InlineQueryResultVideo {
+	if inlineQueryResultVideo == nil {
+		return nil
+	}
+	clone := *inlineQueryResultVideo
+	clone.Video = inlineQueryResultVideo.Video.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inlineQueryResultVideo *InlineQueryResultVideo) Equal(other *InlineQueryResultVideo) bool {
+	if inlineQueryResultVideo == nil || other == nil {
+		return inlineQueryResultVideo == other
+	}
+	if inlineQueryResultVideo.Id != other.Id {
+		return false
+	}
+	if !inlineQueryResultVideo.Video.Equal(other.Video) {
+		return false
+	}
+	if inlineQueryResultVideo.Title != other.Title {
+		return false
+	}
+	if inlineQueryResultVideo.Description != other.Description {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inlineQueryResultVideo *InlineQueryResultVideo) Diff(other *InlineQueryResultVideo) []Change {
+	return inlineQueryResultVideo.diff("", other, nil)
+}
+
+func (inlineQueryResultVideo *InlineQueryResultVideo) diff(path string, other *InlineQueryResultVideo, changes []Change) []Change {
+	if inlineQueryResultVideo == nil || other == nil {
+		if inlineQueryResultVideo != other {
+			changes = append(changes, Change{Path: path, Old: inlineQueryResultVideo, New: other})
+		}
+		return changes
+	}
+	if inlineQueryResultVideo.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: inlineQueryResultVideo.Id, New: other.Id})
+	}
+	changes = inlineQueryResultVideo.Video.diff(fieldPath(path, "video"), other.Video, changes)
+	if inlineQueryResultVideo.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: inlineQueryResultVideo.Title, New: other.Title})
+	}
+	if inlineQueryResultVideo.Description != other.Description {
+		changes = append(changes, Change{Path: fieldPath(path, "description"), Old: inlineQueryResultVideo.Description, New: other.Description})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inlineQueryResultVideo *InlineQueryResultVideo) String() string {
+	return objectString(inlineQueryResultVideo)
+}
+
+// LogValue logs the object as its summary
+func (inlineQueryResultVideo *InlineQueryResultVideo) LogValue() slog.Value {
+	return slog.StringValue(objectString(inlineQueryResultVideo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inlineQueryResultVideo *InlineQueryResultVideo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inlineQueryResultVideo)
+}
+
+func (inlineQueryResultVideo *InlineQueryResultVideo) printText(p *printer) {
+	if inlineQueryResultVideo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InlineQueryResultVideo")
+	p.string("id", inlineQueryResultVideo.Id)
+	p.object("video", inlineQueryResultVideo.Video)
+	p.string("title", inlineQueryResultVideo.Title)
+	p.string("description", inlineQueryResultVideo.Description)
+	p.endObject(state)
+}
+
 // GetInlineQueryResultEnum return the enum type of this object
 func (inlineQueryResultVideo *InlineQueryResultVideo) GetInlineQueryResultEnum() InlineQueryResultEnum {
 	return InlineQueryResultVideoType
 }
 
+// Accept calls the method of the visitor for InlineQueryResultVideo
+func (inlineQueryResultVideo *InlineQueryResultVideo) Accept(visitor InlineQueryResultVisitor) {
+	visitor.VisitInlineQueryResultVideo(inlineQueryResultVideo)
+}
+
 // InlineQueryResultVoiceNote Represents a voice note
 type InlineQueryResultVoiceNote struct {
 	tdCommon
@@ -521,7 +2169,389 @@ func NewInlineQueryResultVoiceNote(id string, voiceNote *VoiceNote, title string
 	return &inlineQueryResultVoiceNoteTemp
 }
 
+// MarshalJSON marshals to json
+func (inlineQueryResultVoiceNote *InlineQueryResultVoiceNote) MarshalJSON() ([]byte, error) {
+	return inlineQueryResultVoiceNote.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inlineQueryResultVoiceNote *InlineQueryResultVoiceNote) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inlineQueryResultVoiceNote)
+}
+
+func (inlineQueryResultVoiceNote *InlineQueryResultVoiceNote) appendJSON(b []byte) ([]byte, error) {
+	if inlineQueryResultVoiceNote == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inlineQueryResultVoiceNote.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inlineQueryResultVoiceNote.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, inlineQueryResultVoiceNote.Id)
+	b = append(b, `,"voice_note":`...)
+	b, err = inlineQueryResultVoiceNote.VoiceNote.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, inlineQueryResultVoiceNote.Title)
+	return append(b, '}'), nil
+}
+
+func (inlineQueryResultVoiceNote *InlineQueryResultVoiceNote) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inlineQueryResultVoiceNote.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inlineQueryResultVoiceNote.tdCommon.Extra, err = r.readString()
+		case "id":
+			inlineQueryResultVoiceNote.Id, err = r.readString()
+		case "voice_note":
+			if r.readNull() {
+				inlineQueryResultVoiceNote.VoiceNote = nil
+			} else {
+				inlineQueryResultVoiceNote.VoiceNote = new(VoiceNote)
+				err = inlineQueryResultVoiceNote.VoiceNote.decodeJSON(r)
+			}
+		case "title":
+			inlineQueryResultVoiceNote.Title, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inlineQueryResultVoiceNote *InlineQueryResultVoiceNote) Clone() *InlineQueryResultVoiceNote {
+	if inlineQueryResultVoiceNote == nil {
+		return nil
+	}
+	clone := *inlineQueryResultVoiceNote
+	clone.VoiceNote = inlineQueryResultVoiceNote.VoiceNote.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inlineQueryResultVoiceNote *InlineQueryResultVoiceNote) Equal(other *InlineQueryResultVoiceNote) bool {
+	if inlineQueryResultVoiceNote == nil || other == nil {
+		return inlineQueryResultVoiceNote == other
+	}
+	if inlineQueryResultVoiceNote.Id != other.Id {
+		return false
+	}
+	if !inlineQueryResultVoiceNote.VoiceNote.Equal(other.VoiceNote) {
+		return false
+	}
+	if inlineQueryResultVoiceNote.Title != other.Title {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inlineQueryResultVoiceNote *InlineQueryResultVoiceNote) Diff(other *InlineQueryResultVoiceNote) []Change {
+	return inlineQueryResultVoiceNote.diff("", other, nil)
+}
+
+func (inlineQueryResultVoiceNote *InlineQueryResultVoiceNote) diff(path string, other *InlineQueryResultVoiceNote, changes []Change) []Change {
+	if inlineQueryResultVoiceNote == nil || other == nil {
+		if inlineQueryResultVoiceNote != other {
+			changes = append(changes, Change{Path: path, Old: inlineQueryResultVoiceNote, New: other})
+		}
+		return changes
+	}
+	if inlineQueryResultVoiceNote.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: inlineQueryResultVoiceNote.Id, New: other.Id})
+	}
+	changes = inlineQueryResultVoiceNote.VoiceNote.diff(fieldPath(path, "voice_note"), other.VoiceNote, changes)
+	if inlineQueryResultVoiceNote.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: inlineQueryResultVoiceNote.Title, New: other.Title})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inlineQueryResultVoiceNote *InlineQueryResultVoiceNote) String() string {
+	return objectString(inlineQueryResultVoiceNote)
+}
+
+// LogValue logs the object as its summary
+func (inlineQueryResultVoiceNote *InlineQueryResultVoiceNote) LogValue() slog.Value {
+	return slog.StringValue(objectString(inlineQueryResultVoiceNote))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inlineQueryResultVoiceNote *InlineQueryResultVoiceNote) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inlineQueryResultVoiceNote)
+}
+
+func (inlineQueryResultVoiceNote *InlineQueryResultVoiceNote) printText(p *printer) {
+	if inlineQueryResultVoiceNote == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InlineQueryResultVoiceNote")
+	p.string("id", inlineQueryResultVoiceNote.Id)
+	p.object("voice_note", inlineQueryResultVoiceNote.VoiceNote)
+	p.string("title", inlineQueryResultVoiceNote.Title)
+	p.endObject(state)
+}
+
 // GetInlineQueryResultEnum return the enum type of this object
 func (inlineQueryResultVoiceNote *InlineQueryResultVoiceNote) GetInlineQueryResultEnum() InlineQueryResultEnum {
 	return InlineQueryResultVoiceNoteType
 }
+
+// Accept calls the method of the visitor for InlineQueryResultVoiceNote
+func (inlineQueryResultVoiceNote *InlineQueryResultVoiceNote) Accept(visitor InlineQueryResultVisitor) {
+	visitor.VisitInlineQueryResultVoiceNote(inlineQueryResultVoiceNote)
+}
+
+// InlineQueryResultVisitor visits every type of InlineQueryResult, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to InlineQueryResult, until they handle it too.
+type InlineQueryResultVisitor interface {
+	VisitInlineQueryResultArticle(inlineQueryResultArticle *InlineQueryResultArticle)
+	VisitInlineQueryResultContact(inlineQueryResultContact *InlineQueryResultContact)
+	VisitInlineQueryResultLocation(inlineQueryResultLocation *InlineQueryResultLocation)
+	VisitInlineQueryResultVenue(inlineQueryResultVenue *InlineQueryResultVenue)
+	VisitInlineQueryResultGame(inlineQueryResultGame *InlineQueryResultGame)
+	VisitInlineQueryResultAnimation(inlineQueryResultAnimation *InlineQueryResultAnimation)
+	VisitInlineQueryResultAudio(inlineQueryResultAudio *InlineQueryResultAudio)
+	VisitInlineQueryResultDocument(inlineQueryResultDocument *InlineQueryResultDocument)
+	VisitInlineQueryResultPhoto(inlineQueryResultPhoto *InlineQueryResultPhoto)
+	VisitInlineQueryResultSticker(inlineQueryResultSticker *InlineQueryResultSticker)
+	VisitInlineQueryResultVideo(inlineQueryResultVideo *InlineQueryResultVideo)
+	VisitInlineQueryResultVoiceNote(inlineQueryResultVoiceNote *InlineQueryResultVoiceNote)
+	VisitUnknownInlineQueryResult(unknownInlineQueryResult *UnknownInlineQueryResult)
+}
+
+// InlineQueryResultCases holds the function MatchInlineQueryResult calls for each type of InlineQueryResult;
+// types without a function are passed to the default case
+type InlineQueryResultCases[R any] struct {
+	InlineQueryResultArticle   func(inlineQueryResultArticle *InlineQueryResultArticle) R
+	InlineQueryResultContact   func(inlineQueryResultContact *InlineQueryResultContact) R
+	InlineQueryResultLocation  func(inlineQueryResultLocation *InlineQueryResultLocation) R
+	InlineQueryResultVenue     func(inlineQueryResultVenue *InlineQueryResultVenue) R
+	InlineQueryResultGame      func(inlineQueryResultGame *InlineQueryResultGame) R
+	InlineQueryResultAnimation func(inlineQueryResultAnimation *InlineQueryResultAnimation) R
+	InlineQueryResultAudio     func(inlineQueryResultAudio *InlineQueryResultAudio) R
+	InlineQueryResultDocument  func(inlineQueryResultDocument *InlineQueryResultDocument) R
+	InlineQueryResultPhoto     func(inlineQueryResultPhoto *InlineQueryResultPhoto) R
+	InlineQueryResultSticker   func(inlineQueryResultSticker *InlineQueryResultSticker) R
+	InlineQueryResultVideo     func(inlineQueryResultVideo *InlineQueryResultVideo) R
+	InlineQueryResultVoiceNote func(inlineQueryResultVoiceNote *InlineQueryResultVoiceNote) R
+}
+
+// MatchInlineQueryResult calls the case of the type of inlineQueryResult, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement InlineQueryResultVisitor
+// to have the compiler check every type is handled.
+func MatchInlineQueryResult[R any](inlineQueryResult InlineQueryResult, cases InlineQueryResultCases[R], defaultCase func(inlineQueryResult InlineQueryResult) R) R {
+	switch value := inlineQueryResult.(type) {
+	case *InlineQueryResultArticle:
+		if cases.InlineQueryResultArticle != nil {
+			return cases.InlineQueryResultArticle(value)
+		}
+	case *InlineQueryResultContact:
+		if cases.InlineQueryResultContact != nil {
+			return cases.InlineQueryResultContact(value)
+		}
+	case *InlineQueryResultLocation:
+		if cases.InlineQueryResultLocation != nil {
+			return cases.InlineQueryResultLocation(value)
+		}
+	case *InlineQueryResultVenue:
+		if cases.InlineQueryResultVenue != nil {
+			return cases.InlineQueryResultVenue(value)
+		}
+	case *InlineQueryResultGame:
+		if cases.InlineQueryResultGame != nil {
+			return cases.InlineQueryResultGame(value)
+		}
+	case *InlineQueryResultAnimation:
+		if cases.InlineQueryResultAnimation != nil {
+			return cases.InlineQueryResultAnimation(value)
+		}
+	case *InlineQueryResultAudio:
+		if cases.InlineQueryResultAudio != nil {
+			return cases.InlineQueryResultAudio(value)
+		}
+	case *InlineQueryResultDocument:
+		if cases.InlineQueryResultDocument != nil {
+			return cases.InlineQueryResultDocument(value)
+		}
+	case *InlineQueryResultPhoto:
+		if cases.InlineQueryResultPhoto != nil {
+			return cases.InlineQueryResultPhoto(value)
+		}
+	case *InlineQueryResultSticker:
+		if cases.InlineQueryResultSticker != nil {
+			return cases.InlineQueryResultSticker(value)
+		}
+	case *InlineQueryResultVideo:
+		if cases.InlineQueryResultVideo != nil {
+			return cases.InlineQueryResultVideo(value)
+		}
+	case *InlineQueryResultVoiceNote:
+		if cases.InlineQueryResultVoiceNote != nil {
+			return cases.InlineQueryResultVoiceNote(value)
+		}
+	}
+	return defaultCase(inlineQueryResult)
+}
+
+// Clone returns a deep copy of the object
+func (unknownInlineQueryResult *UnknownInlineQueryResult) Clone() *UnknownInlineQueryResult {
+	if unknownInlineQueryResult == nil {
+		return nil
+	}
+	return &UnknownInlineQueryResult{Unknown: unknownInlineQueryResult.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownInlineQueryResult *UnknownInlineQueryResult) Equal(other *UnknownInlineQueryResult) bool {
+	if unknownInlineQueryResult == nil || other == nil {
+		return unknownInlineQueryResult == other
+	}
+	return unknownInlineQueryResult.Unknown.equal(other.Unknown)
+}
+
+func cloneInlineQueryResult(inlineQueryResult InlineQueryResult) InlineQueryResult {
+	switch value := inlineQueryResult.(type) {
+	case *InlineQueryResultArticle:
+		return value.Clone()
+	case *InlineQueryResultContact:
+		return value.Clone()
+	case *InlineQueryResultLocation:
+		return value.Clone()
+	case *InlineQueryResultVenue:
+		return value.Clone()
+	case *InlineQueryResultGame:
+		return value.Clone()
+	case *InlineQueryResultAnimation:
+		return value.Clone()
+	case *InlineQueryResultAudio:
+		return value.Clone()
+	case *InlineQueryResultDocument:
+		return value.Clone()
+	case *InlineQueryResultPhoto:
+		return value.Clone()
+	case *InlineQueryResultSticker:
+		return value.Clone()
+	case *InlineQueryResultVideo:
+		return value.Clone()
+	case *InlineQueryResultVoiceNote:
+		return value.Clone()
+	case *UnknownInlineQueryResult:
+		return value.Clone()
+	}
+	return inlineQueryResult
+}
+
+func equalInlineQueryResult(a InlineQueryResult, b InlineQueryResult) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *InlineQueryResultArticle:
+		b, ok := b.(*InlineQueryResultArticle)
+		return ok && a.Equal(b)
+	case *InlineQueryResultContact:
+		b, ok := b.(*InlineQueryResultContact)
+		return ok && a.Equal(b)
+	case *InlineQueryResultLocation:
+		b, ok := b.(*InlineQueryResultLocation)
+		return ok && a.Equal(b)
+	case *InlineQueryResultVenue:
+		b, ok := b.(*InlineQueryResultVenue)
+		return ok && a.Equal(b)
+	case *InlineQueryResultGame:
+		b, ok := b.(*InlineQueryResultGame)
+		return ok && a.Equal(b)
+	case *InlineQueryResultAnimation:
+		b, ok := b.(*InlineQueryResultAnimation)
+		return ok && a.Equal(b)
+	case *InlineQueryResultAudio:
+		b, ok := b.(*InlineQueryResultAudio)
+		return ok && a.Equal(b)
+	case *InlineQueryResultDocument:
+		b, ok := b.(*InlineQueryResultDocument)
+		return ok && a.Equal(b)
+	case *InlineQueryResultPhoto:
+		b, ok := b.(*InlineQueryResultPhoto)
+		return ok && a.Equal(b)
+	case *InlineQueryResultSticker:
+		b, ok := b.(*InlineQueryResultSticker)
+		return ok && a.Equal(b)
+	case *InlineQueryResultVideo:
+		b, ok := b.(*InlineQueryResultVideo)
+		return ok && a.Equal(b)
+	case *InlineQueryResultVoiceNote:
+		b, ok := b.(*InlineQueryResultVoiceNote)
+		return ok && a.Equal(b)
+	case *UnknownInlineQueryResult:
+		b, ok := b.(*UnknownInlineQueryResult)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffInlineQueryResult(path string, a InlineQueryResult, b InlineQueryResult, changes []Change) []Change {
+	switch a := a.(type) {
+	case *InlineQueryResultArticle:
+		if b, ok := b.(*InlineQueryResultArticle); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InlineQueryResultContact:
+		if b, ok := b.(*InlineQueryResultContact); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InlineQueryResultLocation:
+		if b, ok := b.(*InlineQueryResultLocation); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InlineQueryResultVenue:
+		if b, ok := b.(*InlineQueryResultVenue); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InlineQueryResultGame:
+		if b, ok := b.(*InlineQueryResultGame); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InlineQueryResultAnimation:
+		if b, ok := b.(*InlineQueryResultAnimation); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InlineQueryResultAudio:
+		if b, ok := b.(*InlineQueryResultAudio); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InlineQueryResultDocument:
+		if b, ok := b.(*InlineQueryResultDocument); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InlineQueryResultPhoto:
+		if b, ok := b.(*InlineQueryResultPhoto); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InlineQueryResultSticker:
+		if b, ok := b.(*InlineQueryResultSticker); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InlineQueryResultVideo:
+		if b, ok := b.(*InlineQueryResultVideo); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InlineQueryResultVoiceNote:
+		if b, ok := b.(*InlineQueryResultVoiceNote); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalInlineQueryResult(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
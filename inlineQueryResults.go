@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // InlineQueryResults Represents the results of the inline query. Use sendInlineQueryResultMessage to send the result of the query
@@ -40,6 +40,194 @@ func NewInlineQueryResults(inlineQueryId JSONInt64, nextOffset string, results [
 	return &inlineQueryResultsTemp
 }
 
+// MarshalJSON marshals to json
+func (inlineQueryResults *InlineQueryResults) MarshalJSON() ([]byte, error) {
+	return inlineQueryResults.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inlineQueryResults *InlineQueryResults) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inlineQueryResults)
+}
+
+func (inlineQueryResults *InlineQueryResults) appendJSON(b []byte) ([]byte, error) {
+	if inlineQueryResults == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inlineQueryResults.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inlineQueryResults.tdCommon.Extra)
+	b = append(b, `,"inline_query_id":`...)
+	b = appendJSONInt64String(b, int64(inlineQueryResults.InlineQueryId))
+	b = append(b, `,"next_offset":`...)
+	b = appendJSONString(b, inlineQueryResults.NextOffset)
+	b = append(b, `,"results":`...)
+	if inlineQueryResults.Results == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range inlineQueryResults.Results {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = appendJSONValue(b, inlineQueryResults.Results[i0])
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"switch_pm_text":`...)
+	b = appendJSONString(b, inlineQueryResults.SwitchPmText)
+	b = append(b, `,"switch_pm_parameter":`...)
+	b = appendJSONString(b, inlineQueryResults.SwitchPmParameter)
+	return append(b, '}'), nil
+}
+
+func (inlineQueryResults *InlineQueryResults) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inlineQueryResults.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inlineQueryResults.tdCommon.Extra, err = r.readString()
+		case "inline_query_id":
+			inlineQueryResults.InlineQueryId, err = r.readJSONInt64()
+		case "next_offset":
+			inlineQueryResults.NextOffset, err = r.readString()
+		case "results":
+			if r.readNull() {
+				inlineQueryResults.Results = nil
+			} else {
+				inlineQueryResults.Results = make([]InlineQueryResult, 0)
+				err = r.readArray(func() (err error) {
+					var item0 InlineQueryResult
+					item0, err = decodeInlineQueryResult(r)
+					inlineQueryResults.Results = append(inlineQueryResults.Results, item0)
+					return
+				})
+			}
+		case "switch_pm_text":
+			inlineQueryResults.SwitchPmText, err = r.readString()
+		case "switch_pm_parameter":
+			inlineQueryResults.SwitchPmParameter, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inlineQueryResults *InlineQueryResults) Clone() *InlineQueryResults {
+	if inlineQueryResults == nil {
+		return nil
+	}
+	clone := *inlineQueryResults
+	if inlineQueryResults.Results != nil {
+		clone.Results = make([]InlineQueryResult, len(inlineQueryResults.Results))
+		for i0 := range inlineQueryResults.Results {
+			clone.Results[i0] = cloneInlineQueryResult(inlineQueryResults.Results[i0])
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inlineQueryResults *InlineQueryResults) Equal(other *InlineQueryResults) bool {
+	if inlineQueryResults == nil || other == nil {
+		return inlineQueryResults == other
+	}
+	if inlineQueryResults.InlineQueryId != other.InlineQueryId {
+		return false
+	}
+	if inlineQueryResults.NextOffset != other.NextOffset {
+		return false
+	}
+	if len(inlineQueryResults.Results) != len(other.Results) {
+		return false
+	}
+	for i0 := range inlineQueryResults.Results {
+		if !equalInlineQueryResult(inlineQueryResults.Results[i0], other.Results[i0]) {
+			return false
+		}
+	}
+	if inlineQueryResults.SwitchPmText != other.SwitchPmText {
+		return false
+	}
+	if inlineQueryResults.SwitchPmParameter != other.SwitchPmParameter {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inlineQueryResults *InlineQueryResults) Diff(other *InlineQueryResults) []Change {
+	return inlineQueryResults.diff("", other, nil)
+}
+
+func (inlineQueryResults *InlineQueryResults) diff(path string, other *InlineQueryResults, changes []Change) []Change {
+	if inlineQueryResults == nil || other == nil {
+		if inlineQueryResults != other {
+			changes = append(changes, Change{Path: path, Old: inlineQueryResults, New: other})
+		}
+		return changes
+	}
+	if inlineQueryResults.InlineQueryId != other.InlineQueryId {
+		changes = append(changes, Change{Path: fieldPath(path, "inline_query_id"), Old: inlineQueryResults.InlineQueryId, New: other.InlineQueryId})
+	}
+	if inlineQueryResults.NextOffset != other.NextOffset {
+		changes = append(changes, Change{Path: fieldPath(path, "next_offset"), Old: inlineQueryResults.NextOffset, New: other.NextOffset})
+	}
+	if len(inlineQueryResults.Results) != len(other.Results) {
+		changes = append(changes, Change{Path: fieldPath(path, "results"), Old: inlineQueryResults.Results, New: other.Results})
+	} else {
+		for i0 := range inlineQueryResults.Results {
+			changes = diffInlineQueryResult(indexPath(fieldPath(path, "results"), i0), inlineQueryResults.Results[i0], other.Results[i0], changes)
+		}
+	}
+	if inlineQueryResults.SwitchPmText != other.SwitchPmText {
+		changes = append(changes, Change{Path: fieldPath(path, "switch_pm_text"), Old: inlineQueryResults.SwitchPmText, New: other.SwitchPmText})
+	}
+	if inlineQueryResults.SwitchPmParameter != other.SwitchPmParameter {
+		changes = append(changes, Change{Path: fieldPath(path, "switch_pm_parameter"), Old: inlineQueryResults.SwitchPmParameter, New: other.SwitchPmParameter})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inlineQueryResults *InlineQueryResults) String() string {
+	return objectString(inlineQueryResults)
+}
+
+// LogValue logs the object as its summary
+func (inlineQueryResults *InlineQueryResults) LogValue() slog.Value {
+	return slog.StringValue(objectString(inlineQueryResults))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inlineQueryResults *InlineQueryResults) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inlineQueryResults)
+}
+
+func (inlineQueryResults *InlineQueryResults) printText(p *printer) {
+	if inlineQueryResults == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InlineQueryResults")
+	p.int("inline_query_id", int64(inlineQueryResults.InlineQueryId))
+	p.string("next_offset", inlineQueryResults.NextOffset)
+	p.vector("results", len(inlineQueryResults.Results), func(i0 int) {
+		p.object("results", inlineQueryResults.Results[i0])
+	})
+	p.string("switch_pm_text", inlineQueryResults.SwitchPmText)
+	p.string("switch_pm_parameter", inlineQueryResults.SwitchPmParameter)
+	p.endObject(state)
+}
+
 // GetInlineQueryResults Sends an inline query to a bot and returns its results. Returns an error with code 502 if the bot fails to answer the query before the query timeout expires
 // @param botUserId The identifier of the target bot
 // @param chatId Identifier of the chat where the query was sent
@@ -60,11 +248,11 @@ func (client *Client) GetInlineQueryResults(botUserId int64, chatId int64, userL
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var inlineQueryResults InlineQueryResults
-	err = json.Unmarshal(result.Raw, &inlineQueryResults)
+	err = jsonUnmarshal(result.Raw, &inlineQueryResults)
 	return &inlineQueryResults, err
 }
@@ -0,0 +1,75 @@
+package tdlib
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// articleResult returns an article result
+func articleResult(id string) *InputInlineQueryResultArticle {
+	return NewInputInlineQueryResultArticle(id, "", false, id, "", "", 0, 0, nil, NewInputMessageText(NewFormattedText(id, nil), false, false))
+}
+
+func TestInlineRouterNilResults(t *testing.T) {
+	tests := []struct {
+		name    string
+		results []InputInlineQueryResult
+	}{
+		{"nil", []InputInlineQueryResult{articleResult("a"), nil}},
+		{"typed nil", []InputInlineQueryResult{articleResult("a"), (*InputInlineQueryResultArticle)(nil)}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			transport := newFakeTransport(nil)
+			router := NewInlineRouter(NewClientWithTransport(Config{}, transport))
+			router.Handle("", func(query *InlineQuery) (*InlineAnswer, error) {
+				return &InlineAnswer{Results: InlineResults(test.results...), CacheTime: time.Minute}, nil
+			}, nil)
+
+			router.HandleUpdate(context.Background(), &UpdateNewInlineQuery{Id: 1, SenderUserId: 2, Query: "q"})
+
+			if answers := transport.sent("answerInlineQuery"); len(answers) != 0 {
+				t.Errorf("answered %v, want the query not to be answered", answers)
+			}
+			router.lock.RLock()
+			defer router.lock.RUnlock()
+			if len(router.cache) != 0 {
+				t.Errorf("%d pages are cached, want none", len(router.cache))
+			}
+		})
+	}
+}
+
+func TestInlineRouterResultIDs(t *testing.T) {
+	transport := newFakeTransport(nil)
+	router := NewInlineRouter(NewClientWithTransport(Config{}, transport))
+	router.SetPageSize(2)
+	source := []InputInlineQueryResult{articleResult("a"), articleResult("b"), articleResult("c")}
+	router.Handle("", func(query *InlineQuery) (*InlineAnswer, error) {
+		return &InlineAnswer{Results: InlineResults(source...)}, nil
+	}, nil)
+
+	router.HandleUpdate(context.Background(), &UpdateNewInlineQuery{Id: 1, SenderUserId: 2, Query: "q"})
+	router.HandleUpdate(context.Background(), &UpdateNewInlineQuery{Id: 2, SenderUserId: 2, Query: "q", Offset: "2"})
+
+	answers := transport.sent("answerInlineQuery")
+	if len(answers) != 2 {
+		t.Fatalf("%d answers were sent, want 2", len(answers))
+	}
+	for i, want := range [][]string{{"0:0:a", "0:1:b"}, {"0:2:c"}} {
+		results, _ := answers[i]["results"].([]interface{})
+		var ids []string
+		for _, result := range results {
+			id, _ := result.(map[string]interface{})["id"].(string)
+			ids = append(ids, id)
+		}
+		if !reflect.DeepEqual(ids, want) {
+			t.Errorf("page %d has results %v, want %v", i, ids, want)
+		}
+	}
+	if source[0].(*InputInlineQueryResultArticle).Id != "a" {
+		t.Errorf("source result identifier changed to %q", source[0].(*InputInlineQueryResultArticle).Id)
+	}
+}
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // InputBackground Contains information about background to set
 type InputBackground interface {
 	GetInputBackgroundEnum() InputBackgroundEnum
+	Accept(visitor InputBackgroundVisitor)
 }
 
 // InputBackgroundEnum Alias for abstract InputBackground 'Sub-Classes', used as constant-enum here
@@ -19,30 +21,73 @@ const (
 	InputBackgroundRemoteType InputBackgroundEnum = "inputBackgroundRemote"
 )
 
+// UnknownInputBackground is an InputBackground of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownInputBackground struct {
+	Unknown
+}
+
+// GetInputBackgroundEnum return the enum type of this object
+func (unknownInputBackground *UnknownInputBackground) GetInputBackgroundEnum() InputBackgroundEnum {
+	return InputBackgroundEnum(unknownInputBackground.Type)
+}
+
+// Accept calls the method of the visitor for UnknownInputBackground
+func (unknownInputBackground *UnknownInputBackground) Accept(visitor InputBackgroundVisitor) {
+	visitor.VisitUnknownInputBackground(unknownInputBackground)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownInputBackground *UnknownInputBackground) String() string {
+	return objectString(unknownInputBackground)
+}
+
+// LogValue logs the object as its summary
+func (unknownInputBackground *UnknownInputBackground) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownInputBackground))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownInputBackground *UnknownInputBackground) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownInputBackground)
+}
+
+func (unknownInputBackground *UnknownInputBackground) printText(p *printer) {
+	if unknownInputBackground == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownInputBackground")
+	p.string("@type", unknownInputBackground.Type)
+	p.endObject(state)
+}
+
 func unmarshalInputBackground(rawMsg *json.RawMessage) (InputBackground, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch InputBackgroundEnum(objMap["@type"].(string)) {
+	switch InputBackgroundEnum(typeName) {
 	case InputBackgroundLocalType:
 		var inputBackgroundLocal InputBackgroundLocal
-		err := json.Unmarshal(*rawMsg, &inputBackgroundLocal)
+		err := jsonUnmarshal(*rawMsg, &inputBackgroundLocal)
 		return &inputBackgroundLocal, err
 
 	case InputBackgroundRemoteType:
 		var inputBackgroundRemote InputBackgroundRemote
-		err := json.Unmarshal(*rawMsg, &inputBackgroundRemote)
+		err := jsonUnmarshal(*rawMsg, &inputBackgroundRemote)
 		return &inputBackgroundRemote, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownInputBackground{Unknown: unknown}, nil
 	}
 }
 
@@ -69,27 +114,109 @@ func NewInputBackgroundLocal(background InputFile) *InputBackgroundLocal {
 	return &inputBackgroundLocalTemp
 }
 
+// MarshalJSON marshals to json
+func (inputBackgroundLocal *InputBackgroundLocal) MarshalJSON() ([]byte, error) {
+	return inputBackgroundLocal.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputBackgroundLocal *InputBackgroundLocal) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, inputBackgroundLocal)
+}
+
+func (inputBackgroundLocal *InputBackgroundLocal) appendJSON(b []byte) ([]byte, error) {
+	if inputBackgroundLocal == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputBackgroundLocal.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputBackgroundLocal.tdCommon.Extra)
+	b = append(b, `,"background":`...)
+	b, err = appendJSONValue(b, inputBackgroundLocal.Background)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return append(b, '}'), nil
+}
 
-	inputBackgroundLocal.tdCommon = tempObj.tdCommon
+func (inputBackgroundLocal *InputBackgroundLocal) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputBackgroundLocal.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputBackgroundLocal.tdCommon.Extra, err = r.readString()
+		case "background":
+			inputBackgroundLocal.Background, err = decodeInputFile(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	fieldBackground, _ := unmarshalInputFile(objMap["background"])
-	inputBackgroundLocal.Background = fieldBackground
+// Clone returns a deep copy of the object
+func (inputBackgroundLocal *InputBackgroundLocal) Clone() *InputBackgroundLocal {
+	if inputBackgroundLocal == nil {
+		return nil
+	}
+	clone := *inputBackgroundLocal
+	clone.Background = cloneInputFile(inputBackgroundLocal.Background)
+	return &clone
+}
 
-	return nil
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputBackgroundLocal *InputBackgroundLocal) Equal(other *InputBackgroundLocal) bool {
+	if inputBackgroundLocal == nil || other == nil {
+		return inputBackgroundLocal == other
+	}
+	if !equalInputFile(inputBackgroundLocal.Background, other.Background) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputBackgroundLocal *InputBackgroundLocal) Diff(other *InputBackgroundLocal) []Change {
+	return inputBackgroundLocal.diff("", other, nil)
+}
+
+func (inputBackgroundLocal *InputBackgroundLocal) diff(path string, other *InputBackgroundLocal, changes []Change) []Change {
+	if inputBackgroundLocal == nil || other == nil {
+		if inputBackgroundLocal != other {
+			changes = append(changes, Change{Path: path, Old: inputBackgroundLocal, New: other})
+		}
+		return changes
+	}
+	changes = diffInputFile(fieldPath(path, "background"), inputBackgroundLocal.Background, other.Background, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputBackgroundLocal *InputBackgroundLocal) String() string {
+	return objectString(inputBackgroundLocal)
+}
+
+// LogValue logs the object as its summary
+func (inputBackgroundLocal *InputBackgroundLocal) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputBackgroundLocal))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputBackgroundLocal *InputBackgroundLocal) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputBackgroundLocal)
+}
+
+func (inputBackgroundLocal *InputBackgroundLocal) printText(p *printer) {
+	if inputBackgroundLocal == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputBackgroundLocal")
+	p.object("background", inputBackgroundLocal.Background)
+	p.endObject(state)
 }
 
 // GetInputBackgroundEnum return the enum type of this object
@@ -97,6 +224,11 @@ func (inputBackgroundLocal *InputBackgroundLocal) GetInputBackgroundEnum() Input
 	return InputBackgroundLocalType
 }
 
+// Accept calls the method of the visitor for InputBackgroundLocal
+func (inputBackgroundLocal *InputBackgroundLocal) Accept(visitor InputBackgroundVisitor) {
+	visitor.VisitInputBackgroundLocal(inputBackgroundLocal)
+}
+
 // InputBackgroundRemote A background from the server
 type InputBackgroundRemote struct {
 	tdCommon
@@ -120,7 +252,209 @@ func NewInputBackgroundRemote(backgroundId JSONInt64) *InputBackgroundRemote {
 	return &inputBackgroundRemoteTemp
 }
 
+// MarshalJSON marshals to json
+func (inputBackgroundRemote *InputBackgroundRemote) MarshalJSON() ([]byte, error) {
+	return inputBackgroundRemote.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputBackgroundRemote *InputBackgroundRemote) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputBackgroundRemote)
+}
+
+func (inputBackgroundRemote *InputBackgroundRemote) appendJSON(b []byte) ([]byte, error) {
+	if inputBackgroundRemote == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputBackgroundRemote.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputBackgroundRemote.tdCommon.Extra)
+	b = append(b, `,"background_id":`...)
+	b = appendJSONInt64String(b, int64(inputBackgroundRemote.BackgroundId))
+	return append(b, '}'), nil
+}
+
+func (inputBackgroundRemote *InputBackgroundRemote) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputBackgroundRemote.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputBackgroundRemote.tdCommon.Extra, err = r.readString()
+		case "background_id":
+			inputBackgroundRemote.BackgroundId, err = r.readJSONInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputBackgroundRemote *InputBackgroundRemote) Clone() *InputBackgroundRemote {
+	if inputBackgroundRemote == nil {
+		return nil
+	}
+	clone := *inputBackgroundRemote
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputBackgroundRemote *InputBackgroundRemote) Equal(other *InputBackgroundRemote) bool {
+	if inputBackgroundRemote == nil || other == nil {
+		return inputBackgroundRemote == other
+	}
+	if inputBackgroundRemote.BackgroundId != other.BackgroundId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputBackgroundRemote *InputBackgroundRemote) Diff(other *InputBackgroundRemote) []Change {
+	return inputBackgroundRemote.diff("", other, nil)
+}
+
+func (inputBackgroundRemote *InputBackgroundRemote) diff(path string, other *InputBackgroundRemote, changes []Change) []Change {
+	if inputBackgroundRemote == nil || other == nil {
+		if inputBackgroundRemote != other {
+			changes = append(changes, Change{Path: path, Old: inputBackgroundRemote, New: other})
+		}
+		return changes
+	}
+	if inputBackgroundRemote.BackgroundId != other.BackgroundId {
+		changes = append(changes, Change{Path: fieldPath(path, "background_id"), Old: inputBackgroundRemote.BackgroundId, New: other.BackgroundId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputBackgroundRemote *InputBackgroundRemote) String() string {
+	return objectString(inputBackgroundRemote)
+}
+
+// LogValue logs the object as its summary
+func (inputBackgroundRemote *InputBackgroundRemote) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputBackgroundRemote))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputBackgroundRemote *InputBackgroundRemote) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputBackgroundRemote)
+}
+
+func (inputBackgroundRemote *InputBackgroundRemote) printText(p *printer) {
+	if inputBackgroundRemote == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputBackgroundRemote")
+	p.int("background_id", int64(inputBackgroundRemote.BackgroundId))
+	p.endObject(state)
+}
+
 // GetInputBackgroundEnum return the enum type of this object
 func (inputBackgroundRemote *InputBackgroundRemote) GetInputBackgroundEnum() InputBackgroundEnum {
 	return InputBackgroundRemoteType
 }
+
+// Accept calls the method of the visitor for InputBackgroundRemote
+func (inputBackgroundRemote *InputBackgroundRemote) Accept(visitor InputBackgroundVisitor) {
+	visitor.VisitInputBackgroundRemote(inputBackgroundRemote)
+}
+
+// InputBackgroundVisitor visits every type of InputBackground, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to InputBackground, until they handle it too.
+type InputBackgroundVisitor interface {
+	VisitInputBackgroundLocal(inputBackgroundLocal *InputBackgroundLocal)
+	VisitInputBackgroundRemote(inputBackgroundRemote *InputBackgroundRemote)
+	VisitUnknownInputBackground(unknownInputBackground *UnknownInputBackground)
+}
+
+// InputBackgroundCases holds the function MatchInputBackground calls for each type of InputBackground;
+// types without a function are passed to the default case
+type InputBackgroundCases[R any] struct {
+	InputBackgroundLocal  func(inputBackgroundLocal *InputBackgroundLocal) R
+	InputBackgroundRemote func(inputBackgroundRemote *InputBackgroundRemote) R
+}
+
+// MatchInputBackground calls the case of the type of inputBackground, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement InputBackgroundVisitor
+// to have the compiler check every type is handled.
+func MatchInputBackground[R any](inputBackground InputBackground, cases InputBackgroundCases[R], defaultCase func(inputBackground InputBackground) R) R {
+	switch value := inputBackground.(type) {
+	case *InputBackgroundLocal:
+		if cases.InputBackgroundLocal != nil {
+			return cases.InputBackgroundLocal(value)
+		}
+	case *InputBackgroundRemote:
+		if cases.InputBackgroundRemote != nil {
+			return cases.InputBackgroundRemote(value)
+		}
+	}
+	return defaultCase(inputBackground)
+}
+
+// Clone returns a deep copy of the object
+func (unknownInputBackground *UnknownInputBackground) Clone() *UnknownInputBackground {
+	if unknownInputBackground == nil {
+		return nil
+	}
+	return &UnknownInputBackground{Unknown: unknownInputBackground.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownInputBackground *UnknownInputBackground) Equal(other *UnknownInputBackground) bool {
+	if unknownInputBackground == nil || other == nil {
+		return unknownInputBackground == other
+	}
+	return unknownInputBackground.Unknown.equal(other.Unknown)
+}
+
+func cloneInputBackground(inputBackground InputBackground) InputBackground {
+	switch value := inputBackground.(type) {
+	case *InputBackgroundLocal:
+		return value.Clone()
+	case *InputBackgroundRemote:
+		return value.Clone()
+	case *UnknownInputBackground:
+		return value.Clone()
+	}
+	return inputBackground
+}
+
+func equalInputBackground(a InputBackground, b InputBackground) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *InputBackgroundLocal:
+		b, ok := b.(*InputBackgroundLocal)
+		return ok && a.Equal(b)
+	case *InputBackgroundRemote:
+		b, ok := b.(*InputBackgroundRemote)
+		return ok && a.Equal(b)
+	case *UnknownInputBackground:
+		b, ok := b.(*UnknownInputBackground)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffInputBackground(path string, a InputBackground, b InputBackground, changes []Change) []Change {
+	switch a := a.(type) {
+	case *InputBackgroundLocal:
+		if b, ok := b.(*InputBackgroundLocal); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputBackgroundRemote:
+		if b, ok := b.(*InputBackgroundRemote); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalInputBackground(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
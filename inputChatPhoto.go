@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // InputChatPhoto Describes a photo to be set as a user profile or chat photo
 type InputChatPhoto interface {
 	GetInputChatPhotoEnum() InputChatPhotoEnum
+	Accept(visitor InputChatPhotoVisitor)
 }
 
 // InputChatPhotoEnum Alias for abstract InputChatPhoto 'Sub-Classes', used as constant-enum here
@@ -20,35 +22,78 @@ const (
 	InputChatPhotoAnimationType InputChatPhotoEnum = "inputChatPhotoAnimation"
 )
 
+// UnknownInputChatPhoto is an InputChatPhoto of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownInputChatPhoto struct {
+	Unknown
+}
+
+// GetInputChatPhotoEnum return the enum type of this object
+func (unknownInputChatPhoto *UnknownInputChatPhoto) GetInputChatPhotoEnum() InputChatPhotoEnum {
+	return InputChatPhotoEnum(unknownInputChatPhoto.Type)
+}
+
+// Accept calls the method of the visitor for UnknownInputChatPhoto
+func (unknownInputChatPhoto *UnknownInputChatPhoto) Accept(visitor InputChatPhotoVisitor) {
+	visitor.VisitUnknownInputChatPhoto(unknownInputChatPhoto)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownInputChatPhoto *UnknownInputChatPhoto) String() string {
+	return objectString(unknownInputChatPhoto)
+}
+
+// LogValue logs the object as its summary
+func (unknownInputChatPhoto *UnknownInputChatPhoto) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownInputChatPhoto))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownInputChatPhoto *UnknownInputChatPhoto) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownInputChatPhoto)
+}
+
+func (unknownInputChatPhoto *UnknownInputChatPhoto) printText(p *printer) {
+	if unknownInputChatPhoto == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownInputChatPhoto")
+	p.string("@type", unknownInputChatPhoto.Type)
+	p.endObject(state)
+}
+
 func unmarshalInputChatPhoto(rawMsg *json.RawMessage) (InputChatPhoto, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch InputChatPhotoEnum(objMap["@type"].(string)) {
+	switch InputChatPhotoEnum(typeName) {
 	case InputChatPhotoPreviousType:
 		var inputChatPhotoPrevious InputChatPhotoPrevious
-		err := json.Unmarshal(*rawMsg, &inputChatPhotoPrevious)
+		err := jsonUnmarshal(*rawMsg, &inputChatPhotoPrevious)
 		return &inputChatPhotoPrevious, err
 
 	case InputChatPhotoStaticType:
 		var inputChatPhotoStatic InputChatPhotoStatic
-		err := json.Unmarshal(*rawMsg, &inputChatPhotoStatic)
+		err := jsonUnmarshal(*rawMsg, &inputChatPhotoStatic)
 		return &inputChatPhotoStatic, err
 
 	case InputChatPhotoAnimationType:
 		var inputChatPhotoAnimation InputChatPhotoAnimation
-		err := json.Unmarshal(*rawMsg, &inputChatPhotoAnimation)
+		err := jsonUnmarshal(*rawMsg, &inputChatPhotoAnimation)
 		return &inputChatPhotoAnimation, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownInputChatPhoto{Unknown: unknown}, nil
 	}
 }
 
@@ -75,11 +120,118 @@ func NewInputChatPhotoPrevious(chatPhotoId JSONInt64) *InputChatPhotoPrevious {
 	return &inputChatPhotoPreviousTemp
 }
 
+// MarshalJSON marshals to json
+func (inputChatPhotoPrevious *InputChatPhotoPrevious) MarshalJSON() ([]byte, error) {
+	return inputChatPhotoPrevious.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputChatPhotoPrevious *InputChatPhotoPrevious) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputChatPhotoPrevious)
+}
+
+func (inputChatPhotoPrevious *InputChatPhotoPrevious) appendJSON(b []byte) ([]byte, error) {
+	if inputChatPhotoPrevious == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputChatPhotoPrevious.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputChatPhotoPrevious.tdCommon.Extra)
+	b = append(b, `,"chat_photo_id":`...)
+	b = appendJSONInt64String(b, int64(inputChatPhotoPrevious.ChatPhotoId))
+	return append(b, '}'), nil
+}
+
+func (inputChatPhotoPrevious *InputChatPhotoPrevious) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputChatPhotoPrevious.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputChatPhotoPrevious.tdCommon.Extra, err = r.readString()
+		case "chat_photo_id":
+			inputChatPhotoPrevious.ChatPhotoId, err = r.readJSONInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputChatPhotoPrevious *InputChatPhotoPrevious) Clone() *InputChatPhotoPrevious {
+	if inputChatPhotoPrevious == nil {
+		return nil
+	}
+	clone := *inputChatPhotoPrevious
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputChatPhotoPrevious *InputChatPhotoPrevious) Equal(other *InputChatPhotoPrevious) bool {
+	if inputChatPhotoPrevious == nil || other == nil {
+		return inputChatPhotoPrevious == other
+	}
+	if inputChatPhotoPrevious.ChatPhotoId != other.ChatPhotoId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputChatPhotoPrevious *InputChatPhotoPrevious) Diff(other *InputChatPhotoPrevious) []Change {
+	return inputChatPhotoPrevious.diff("", other, nil)
+}
+
+func (inputChatPhotoPrevious *InputChatPhotoPrevious) diff(path string, other *InputChatPhotoPrevious, changes []Change) []Change {
+	if inputChatPhotoPrevious == nil || other == nil {
+		if inputChatPhotoPrevious != other {
+			changes = append(changes, Change{Path: path, Old: inputChatPhotoPrevious, New: other})
+		}
+		return changes
+	}
+	if inputChatPhotoPrevious.ChatPhotoId != other.ChatPhotoId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_photo_id"), Old: inputChatPhotoPrevious.ChatPhotoId, New: other.ChatPhotoId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputChatPhotoPrevious *InputChatPhotoPrevious) String() string {
+	return objectString(inputChatPhotoPrevious)
+}
+
+// LogValue logs the object as its summary
+func (inputChatPhotoPrevious *InputChatPhotoPrevious) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputChatPhotoPrevious))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputChatPhotoPrevious *InputChatPhotoPrevious) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputChatPhotoPrevious)
+}
+
+func (inputChatPhotoPrevious *InputChatPhotoPrevious) printText(p *printer) {
+	if inputChatPhotoPrevious == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputChatPhotoPrevious")
+	p.int("chat_photo_id", int64(inputChatPhotoPrevious.ChatPhotoId))
+	p.endObject(state)
+}
+
 // GetInputChatPhotoEnum return the enum type of this object
 func (inputChatPhotoPrevious *InputChatPhotoPrevious) GetInputChatPhotoEnum() InputChatPhotoEnum {
 	return InputChatPhotoPreviousType
 }
 
+// Accept calls the method of the visitor for InputChatPhotoPrevious
+func (inputChatPhotoPrevious *InputChatPhotoPrevious) Accept(visitor InputChatPhotoVisitor) {
+	visitor.VisitInputChatPhotoPrevious(inputChatPhotoPrevious)
+}
+
 // InputChatPhotoStatic A static photo in JPEG format
 type InputChatPhotoStatic struct {
 	tdCommon
@@ -103,27 +255,109 @@ func NewInputChatPhotoStatic(photo InputFile) *InputChatPhotoStatic {
 	return &inputChatPhotoStaticTemp
 }
 
+// MarshalJSON marshals to json
+func (inputChatPhotoStatic *InputChatPhotoStatic) MarshalJSON() ([]byte, error) {
+	return inputChatPhotoStatic.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputChatPhotoStatic *InputChatPhotoStatic) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, inputChatPhotoStatic)
+}
+
+func (inputChatPhotoStatic *InputChatPhotoStatic) appendJSON(b []byte) ([]byte, error) {
+	if inputChatPhotoStatic == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputChatPhotoStatic.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputChatPhotoStatic.tdCommon.Extra)
+	b = append(b, `,"photo":`...)
+	b, err = appendJSONValue(b, inputChatPhotoStatic.Photo)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inputChatPhotoStatic *InputChatPhotoStatic) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputChatPhotoStatic.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputChatPhotoStatic.tdCommon.Extra, err = r.readString()
+		case "photo":
+			inputChatPhotoStatic.Photo, err = decodeInputFile(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputChatPhotoStatic *InputChatPhotoStatic) Clone() *InputChatPhotoStatic {
+	if inputChatPhotoStatic == nil {
+		return nil
+	}
+	clone := *inputChatPhotoStatic
+	clone.Photo = cloneInputFile(inputChatPhotoStatic.Photo)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputChatPhotoStatic *InputChatPhotoStatic) Equal(other *InputChatPhotoStatic) bool {
+	if inputChatPhotoStatic == nil || other == nil {
+		return inputChatPhotoStatic == other
+	}
+	if !equalInputFile(inputChatPhotoStatic.Photo, other.Photo) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputChatPhotoStatic *InputChatPhotoStatic) Diff(other *InputChatPhotoStatic) []Change {
+	return inputChatPhotoStatic.diff("", other, nil)
+}
+
+func (inputChatPhotoStatic *InputChatPhotoStatic) diff(path string, other *InputChatPhotoStatic, changes []Change) []Change {
+	if inputChatPhotoStatic == nil || other == nil {
+		if inputChatPhotoStatic != other {
+			changes = append(changes, Change{Path: path, Old: inputChatPhotoStatic, New: other})
+		}
+		return changes
 	}
+	changes = diffInputFile(fieldPath(path, "photo"), inputChatPhotoStatic.Photo, other.Photo, changes)
+	return changes
+}
 
-	inputChatPhotoStatic.tdCommon = tempObj.tdCommon
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputChatPhotoStatic *InputChatPhotoStatic) String() string {
+	return objectString(inputChatPhotoStatic)
+}
 
-	fieldPhoto, _ := unmarshalInputFile(objMap["photo"])
-	inputChatPhotoStatic.Photo = fieldPhoto
+// LogValue logs the object as its summary
+func (inputChatPhotoStatic *InputChatPhotoStatic) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputChatPhotoStatic))
+}
 
-	return nil
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputChatPhotoStatic *InputChatPhotoStatic) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputChatPhotoStatic)
+}
+
+func (inputChatPhotoStatic *InputChatPhotoStatic) printText(p *printer) {
+	if inputChatPhotoStatic == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputChatPhotoStatic")
+	p.object("photo", inputChatPhotoStatic.Photo)
+	p.endObject(state)
 }
 
 // GetInputChatPhotoEnum return the enum type of this object
@@ -131,6 +365,11 @@ func (inputChatPhotoStatic *InputChatPhotoStatic) GetInputChatPhotoEnum() InputC
 	return InputChatPhotoStaticType
 }
 
+// Accept calls the method of the visitor for InputChatPhotoStatic
+func (inputChatPhotoStatic *InputChatPhotoStatic) Accept(visitor InputChatPhotoVisitor) {
+	visitor.VisitInputChatPhotoStatic(inputChatPhotoStatic)
+}
+
 // InputChatPhotoAnimation An animation in MPEG4 format; must be square, at most 10 seconds long, have width between 160 and 800 and be at most 2MB in size
 type InputChatPhotoAnimation struct {
 	tdCommon
@@ -157,32 +396,241 @@ func NewInputChatPhotoAnimation(animation InputFile, mainFrameTimestamp float64)
 	return &inputChatPhotoAnimationTemp
 }
 
+// MarshalJSON marshals to json
+func (inputChatPhotoAnimation *InputChatPhotoAnimation) MarshalJSON() ([]byte, error) {
+	return inputChatPhotoAnimation.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputChatPhotoAnimation *InputChatPhotoAnimation) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, inputChatPhotoAnimation)
+}
+
+func (inputChatPhotoAnimation *InputChatPhotoAnimation) appendJSON(b []byte) ([]byte, error) {
+	if inputChatPhotoAnimation == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputChatPhotoAnimation.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputChatPhotoAnimation.tdCommon.Extra)
+	b = append(b, `,"animation":`...)
+	b, err = appendJSONValue(b, inputChatPhotoAnimation.Animation)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-		MainFrameTimestamp float64 `json:"main_frame_timestamp"` // Timestamp of the frame, which will be used as static chat photo
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	b = append(b, `,"main_frame_timestamp":`...)
+	b, err = appendJSONFloat(b, inputChatPhotoAnimation.MainFrameTimestamp)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inputChatPhotoAnimation *InputChatPhotoAnimation) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputChatPhotoAnimation.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputChatPhotoAnimation.tdCommon.Extra, err = r.readString()
+		case "animation":
+			inputChatPhotoAnimation.Animation, err = decodeInputFile(r)
+		case "main_frame_timestamp":
+			inputChatPhotoAnimation.MainFrameTimestamp, err = r.readFloat64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputChatPhotoAnimation *InputChatPhotoAnimation) Clone() *InputChatPhotoAnimation {
+	if inputChatPhotoAnimation == nil {
+		return nil
+	}
+	clone := *inputChatPhotoAnimation
+	clone.Animation = cloneInputFile(inputChatPhotoAnimation.Animation)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputChatPhotoAnimation *InputChatPhotoAnimation) Equal(other *InputChatPhotoAnimation) bool {
+	if inputChatPhotoAnimation == nil || other == nil {
+		return inputChatPhotoAnimation == other
+	}
+	if !equalInputFile(inputChatPhotoAnimation.Animation, other.Animation) {
+		return false
+	}
+	if inputChatPhotoAnimation.MainFrameTimestamp != other.MainFrameTimestamp {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputChatPhotoAnimation *InputChatPhotoAnimation) Diff(other *InputChatPhotoAnimation) []Change {
+	return inputChatPhotoAnimation.diff("", other, nil)
+}
+
+func (inputChatPhotoAnimation *InputChatPhotoAnimation) diff(path string, other *InputChatPhotoAnimation, changes []Change) []Change {
+	if inputChatPhotoAnimation == nil || other == nil {
+		if inputChatPhotoAnimation != other {
+			changes = append(changes, Change{Path: path, Old: inputChatPhotoAnimation, New: other})
+		}
+		return changes
+	}
+	changes = diffInputFile(fieldPath(path, "animation"), inputChatPhotoAnimation.Animation, other.Animation, changes)
+	if inputChatPhotoAnimation.MainFrameTimestamp != other.MainFrameTimestamp {
+		changes = append(changes, Change{Path: fieldPath(path, "main_frame_timestamp"), Old: inputChatPhotoAnimation.MainFrameTimestamp, New: other.MainFrameTimestamp})
 	}
+	return changes
+}
 
-	inputChatPhotoAnimation.tdCommon = tempObj.tdCommon
-	inputChatPhotoAnimation.MainFrameTimestamp = tempObj.MainFrameTimestamp
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputChatPhotoAnimation *InputChatPhotoAnimation) String() string {
+	return objectString(inputChatPhotoAnimation)
+}
 
-	fieldAnimation, _ := unmarshalInputFile(objMap["animation"])
-	inputChatPhotoAnimation.Animation = fieldAnimation
+// LogValue logs the object as its summary
+func (inputChatPhotoAnimation *InputChatPhotoAnimation) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputChatPhotoAnimation))
+}
 
-	return nil
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputChatPhotoAnimation *InputChatPhotoAnimation) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputChatPhotoAnimation)
+}
+
+func (inputChatPhotoAnimation *InputChatPhotoAnimation) printText(p *printer) {
+	if inputChatPhotoAnimation == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputChatPhotoAnimation")
+	p.object("animation", inputChatPhotoAnimation.Animation)
+	p.float("main_frame_timestamp", inputChatPhotoAnimation.MainFrameTimestamp)
+	p.endObject(state)
 }
 
 // GetInputChatPhotoEnum return the enum type of this object
 func (inputChatPhotoAnimation *InputChatPhotoAnimation) GetInputChatPhotoEnum() InputChatPhotoEnum {
 	return InputChatPhotoAnimationType
 }
+
+// Accept calls the method of the visitor for InputChatPhotoAnimation
+func (inputChatPhotoAnimation *InputChatPhotoAnimation) Accept(visitor InputChatPhotoVisitor) {
+	visitor.VisitInputChatPhotoAnimation(inputChatPhotoAnimation)
+}
+
+// InputChatPhotoVisitor visits every type of InputChatPhoto, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to InputChatPhoto, until they handle it too.
+type InputChatPhotoVisitor interface {
+	VisitInputChatPhotoPrevious(inputChatPhotoPrevious *InputChatPhotoPrevious)
+	VisitInputChatPhotoStatic(inputChatPhotoStatic *InputChatPhotoStatic)
+	VisitInputChatPhotoAnimation(inputChatPhotoAnimation *InputChatPhotoAnimation)
+	VisitUnknownInputChatPhoto(unknownInputChatPhoto *UnknownInputChatPhoto)
+}
+
+// InputChatPhotoCases holds the function MatchInputChatPhoto calls for each type of InputChatPhoto;
+// types without a function are passed to the default case
+type InputChatPhotoCases[R any] struct {
+	InputChatPhotoPrevious  func(inputChatPhotoPrevious *InputChatPhotoPrevious) R
+	InputChatPhotoStatic    func(inputChatPhotoStatic *InputChatPhotoStatic) R
+	InputChatPhotoAnimation func(inputChatPhotoAnimation *InputChatPhotoAnimation) R
+}
+
+// MatchInputChatPhoto calls the case of the type of inputChatPhoto, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement InputChatPhotoVisitor
+// to have the compiler check every type is handled.
+func MatchInputChatPhoto[R any](inputChatPhoto InputChatPhoto, cases InputChatPhotoCases[R], defaultCase func(inputChatPhoto InputChatPhoto) R) R {
+	switch value := inputChatPhoto.(type) {
+	case *InputChatPhotoPrevious:
+		if cases.InputChatPhotoPrevious != nil {
+			return cases.InputChatPhotoPrevious(value)
+		}
+	case *InputChatPhotoStatic:
+		if cases.InputChatPhotoStatic != nil {
+			return cases.InputChatPhotoStatic(value)
+		}
+	case *InputChatPhotoAnimation:
+		if cases.InputChatPhotoAnimation != nil {
+			return cases.InputChatPhotoAnimation(value)
+		}
+	}
+	return defaultCase(inputChatPhoto)
+}
+
+// Clone returns a deep copy of the object
+func (unknownInputChatPhoto *UnknownInputChatPhoto) Clone() *UnknownInputChatPhoto {
+	if unknownInputChatPhoto == nil {
+		return nil
+	}
+	return &UnknownInputChatPhoto{Unknown: unknownInputChatPhoto.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownInputChatPhoto *UnknownInputChatPhoto) Equal(other *UnknownInputChatPhoto) bool {
+	if unknownInputChatPhoto == nil || other == nil {
+		return unknownInputChatPhoto == other
+	}
+	return unknownInputChatPhoto.Unknown.equal(other.Unknown)
+}
+
+func cloneInputChatPhoto(inputChatPhoto InputChatPhoto) InputChatPhoto {
+	switch value := inputChatPhoto.(type) {
+	case *InputChatPhotoPrevious:
+		return value.Clone()
+	case *InputChatPhotoStatic:
+		return value.Clone()
+	case *InputChatPhotoAnimation:
+		return value.Clone()
+	case *UnknownInputChatPhoto:
+		return value.Clone()
+	}
+	return inputChatPhoto
+}
+
+func equalInputChatPhoto(a InputChatPhoto, b InputChatPhoto) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *InputChatPhotoPrevious:
+		b, ok := b.(*InputChatPhotoPrevious)
+		return ok && a.Equal(b)
+	case *InputChatPhotoStatic:
+		b, ok := b.(*InputChatPhotoStatic)
+		return ok && a.Equal(b)
+	case *InputChatPhotoAnimation:
+		b, ok := b.(*InputChatPhotoAnimation)
+		return ok && a.Equal(b)
+	case *UnknownInputChatPhoto:
+		b, ok := b.(*UnknownInputChatPhoto)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffInputChatPhoto(path string, a InputChatPhoto, b InputChatPhoto, changes []Change) []Change {
+	switch a := a.(type) {
+	case *InputChatPhotoPrevious:
+		if b, ok := b.(*InputChatPhotoPrevious); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputChatPhotoStatic:
+		if b, ok := b.(*InputChatPhotoStatic); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputChatPhotoAnimation:
+		if b, ok := b.(*InputChatPhotoAnimation); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalInputChatPhoto(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // InputCredentials Contains information about the payment method chosen by the user
 type InputCredentials interface {
 	GetInputCredentialsEnum() InputCredentialsEnum
+	Accept(visitor InputCredentialsVisitor)
 }
 
 // InputCredentialsEnum Alias for abstract InputCredentials 'Sub-Classes', used as constant-enum here
@@ -21,40 +23,83 @@ const (
 	InputCredentialsGooglePayType InputCredentialsEnum = "inputCredentialsGooglePay"
 )
 
+// UnknownInputCredentials is an InputCredentials of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownInputCredentials struct {
+	Unknown
+}
+
+// GetInputCredentialsEnum return the enum type of this object
+func (unknownInputCredentials *UnknownInputCredentials) GetInputCredentialsEnum() InputCredentialsEnum {
+	return InputCredentialsEnum(unknownInputCredentials.Type)
+}
+
+// Accept calls the method of the visitor for UnknownInputCredentials
+func (unknownInputCredentials *UnknownInputCredentials) Accept(visitor InputCredentialsVisitor) {
+	visitor.VisitUnknownInputCredentials(unknownInputCredentials)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownInputCredentials *UnknownInputCredentials) String() string {
+	return objectString(unknownInputCredentials)
+}
+
+// LogValue logs the object as its summary
+func (unknownInputCredentials *UnknownInputCredentials) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownInputCredentials))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownInputCredentials *UnknownInputCredentials) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownInputCredentials)
+}
+
+func (unknownInputCredentials *UnknownInputCredentials) printText(p *printer) {
+	if unknownInputCredentials == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownInputCredentials")
+	p.string("@type", unknownInputCredentials.Type)
+	p.endObject(state)
+}
+
 func unmarshalInputCredentials(rawMsg *json.RawMessage) (InputCredentials, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch InputCredentialsEnum(objMap["@type"].(string)) {
+	switch InputCredentialsEnum(typeName) {
 	case InputCredentialsSavedType:
 		var inputCredentialsSaved InputCredentialsSaved
-		err := json.Unmarshal(*rawMsg, &inputCredentialsSaved)
+		err := jsonUnmarshal(*rawMsg, &inputCredentialsSaved)
 		return &inputCredentialsSaved, err
 
 	case InputCredentialsNewType:
 		var inputCredentialsNew InputCredentialsNew
-		err := json.Unmarshal(*rawMsg, &inputCredentialsNew)
+		err := jsonUnmarshal(*rawMsg, &inputCredentialsNew)
 		return &inputCredentialsNew, err
 
 	case InputCredentialsApplePayType:
 		var inputCredentialsApplePay InputCredentialsApplePay
-		err := json.Unmarshal(*rawMsg, &inputCredentialsApplePay)
+		err := jsonUnmarshal(*rawMsg, &inputCredentialsApplePay)
 		return &inputCredentialsApplePay, err
 
 	case InputCredentialsGooglePayType:
 		var inputCredentialsGooglePay InputCredentialsGooglePay
-		err := json.Unmarshal(*rawMsg, &inputCredentialsGooglePay)
+		err := jsonUnmarshal(*rawMsg, &inputCredentialsGooglePay)
 		return &inputCredentialsGooglePay, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownInputCredentials{Unknown: unknown}, nil
 	}
 }
 
@@ -81,11 +126,118 @@ func NewInputCredentialsSaved(savedCredentialsId string) *InputCredentialsSaved
 	return &inputCredentialsSavedTemp
 }
 
+// MarshalJSON marshals to json
+func (inputCredentialsSaved *InputCredentialsSaved) MarshalJSON() ([]byte, error) {
+	return inputCredentialsSaved.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputCredentialsSaved *InputCredentialsSaved) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputCredentialsSaved)
+}
+
+func (inputCredentialsSaved *InputCredentialsSaved) appendJSON(b []byte) ([]byte, error) {
+	if inputCredentialsSaved == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputCredentialsSaved.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputCredentialsSaved.tdCommon.Extra)
+	b = append(b, `,"saved_credentials_id":`...)
+	b = appendJSONString(b, inputCredentialsSaved.SavedCredentialsId)
+	return append(b, '}'), nil
+}
+
+func (inputCredentialsSaved *InputCredentialsSaved) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputCredentialsSaved.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputCredentialsSaved.tdCommon.Extra, err = r.readString()
+		case "saved_credentials_id":
+			inputCredentialsSaved.SavedCredentialsId, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputCredentialsSaved *InputCredentialsSaved) Clone() *InputCredentialsSaved {
+	if inputCredentialsSaved == nil {
+		return nil
+	}
+	clone := *inputCredentialsSaved
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputCredentialsSaved *InputCredentialsSaved) Equal(other *InputCredentialsSaved) bool {
+	if inputCredentialsSaved == nil || other == nil {
+		return inputCredentialsSaved == other
+	}
+	if inputCredentialsSaved.SavedCredentialsId != other.SavedCredentialsId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputCredentialsSaved *InputCredentialsSaved) Diff(other *InputCredentialsSaved) []Change {
+	return inputCredentialsSaved.diff("", other, nil)
+}
+
+func (inputCredentialsSaved *InputCredentialsSaved) diff(path string, other *InputCredentialsSaved, changes []Change) []Change {
+	if inputCredentialsSaved == nil || other == nil {
+		if inputCredentialsSaved != other {
+			changes = append(changes, Change{Path: path, Old: inputCredentialsSaved, New: other})
+		}
+		return changes
+	}
+	if inputCredentialsSaved.SavedCredentialsId != other.SavedCredentialsId {
+		changes = append(changes, Change{Path: fieldPath(path, "saved_credentials_id"), Old: inputCredentialsSaved.SavedCredentialsId, New: other.SavedCredentialsId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputCredentialsSaved *InputCredentialsSaved) String() string {
+	return objectString(inputCredentialsSaved)
+}
+
+// LogValue logs the object as its summary
+func (inputCredentialsSaved *InputCredentialsSaved) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputCredentialsSaved))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputCredentialsSaved *InputCredentialsSaved) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputCredentialsSaved)
+}
+
+func (inputCredentialsSaved *InputCredentialsSaved) printText(p *printer) {
+	if inputCredentialsSaved == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputCredentialsSaved")
+	p.string("saved_credentials_id", inputCredentialsSaved.SavedCredentialsId)
+	p.endObject(state)
+}
+
 // GetInputCredentialsEnum return the enum type of this object
 func (inputCredentialsSaved *InputCredentialsSaved) GetInputCredentialsEnum() InputCredentialsEnum {
 	return InputCredentialsSavedType
 }
 
+// Accept calls the method of the visitor for InputCredentialsSaved
+func (inputCredentialsSaved *InputCredentialsSaved) Accept(visitor InputCredentialsVisitor) {
+	visitor.VisitInputCredentialsSaved(inputCredentialsSaved)
+}
+
 // InputCredentialsNew Applies if a user enters new credentials on a payment provider website
 type InputCredentialsNew struct {
 	tdCommon
@@ -112,11 +264,129 @@ func NewInputCredentialsNew(data string, allowSave bool) *InputCredentialsNew {
 	return &inputCredentialsNewTemp
 }
 
+// MarshalJSON marshals to json
+func (inputCredentialsNew *InputCredentialsNew) MarshalJSON() ([]byte, error) {
+	return inputCredentialsNew.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputCredentialsNew *InputCredentialsNew) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputCredentialsNew)
+}
+
+func (inputCredentialsNew *InputCredentialsNew) appendJSON(b []byte) ([]byte, error) {
+	if inputCredentialsNew == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputCredentialsNew.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputCredentialsNew.tdCommon.Extra)
+	b = append(b, `,"data":`...)
+	b = appendJSONString(b, inputCredentialsNew.Data)
+	b = append(b, `,"allow_save":`...)
+	b = appendJSONBool(b, inputCredentialsNew.AllowSave)
+	return append(b, '}'), nil
+}
+
+func (inputCredentialsNew *InputCredentialsNew) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputCredentialsNew.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputCredentialsNew.tdCommon.Extra, err = r.readString()
+		case "data":
+			inputCredentialsNew.Data, err = r.readString()
+		case "allow_save":
+			inputCredentialsNew.AllowSave, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputCredentialsNew *InputCredentialsNew) Clone() *InputCredentialsNew {
+	if inputCredentialsNew == nil {
+		return nil
+	}
+	clone := *inputCredentialsNew
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputCredentialsNew *InputCredentialsNew) Equal(other *InputCredentialsNew) bool {
+	if inputCredentialsNew == nil || other == nil {
+		return inputCredentialsNew == other
+	}
+	if inputCredentialsNew.Data != other.Data {
+		return false
+	}
+	if inputCredentialsNew.AllowSave != other.AllowSave {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputCredentialsNew *InputCredentialsNew) Diff(other *InputCredentialsNew) []Change {
+	return inputCredentialsNew.diff("", other, nil)
+}
+
+func (inputCredentialsNew *InputCredentialsNew) diff(path string, other *InputCredentialsNew, changes []Change) []Change {
+	if inputCredentialsNew == nil || other == nil {
+		if inputCredentialsNew != other {
+			changes = append(changes, Change{Path: path, Old: inputCredentialsNew, New: other})
+		}
+		return changes
+	}
+	if inputCredentialsNew.Data != other.Data {
+		changes = append(changes, Change{Path: fieldPath(path, "data"), Old: inputCredentialsNew.Data, New: other.Data})
+	}
+	if inputCredentialsNew.AllowSave != other.AllowSave {
+		changes = append(changes, Change{Path: fieldPath(path, "allow_save"), Old: inputCredentialsNew.AllowSave, New: other.AllowSave})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputCredentialsNew *InputCredentialsNew) String() string {
+	return objectString(inputCredentialsNew)
+}
+
+// LogValue logs the object as its summary
+func (inputCredentialsNew *InputCredentialsNew) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputCredentialsNew))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputCredentialsNew *InputCredentialsNew) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputCredentialsNew)
+}
+
+func (inputCredentialsNew *InputCredentialsNew) printText(p *printer) {
+	if inputCredentialsNew == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputCredentialsNew")
+	p.string("data", inputCredentialsNew.Data)
+	p.bool("allow_save", inputCredentialsNew.AllowSave)
+	p.endObject(state)
+}
+
 // GetInputCredentialsEnum return the enum type of this object
 func (inputCredentialsNew *InputCredentialsNew) GetInputCredentialsEnum() InputCredentialsEnum {
 	return InputCredentialsNewType
 }
 
+// Accept calls the method of the visitor for InputCredentialsNew
+func (inputCredentialsNew *InputCredentialsNew) Accept(visitor InputCredentialsVisitor) {
+	visitor.VisitInputCredentialsNew(inputCredentialsNew)
+}
+
 // InputCredentialsApplePay Applies if a user enters new credentials using Apple Pay
 type InputCredentialsApplePay struct {
 	tdCommon
@@ -140,11 +410,118 @@ func NewInputCredentialsApplePay(data string) *InputCredentialsApplePay {
 	return &inputCredentialsApplePayTemp
 }
 
+// MarshalJSON marshals to json
+func (inputCredentialsApplePay *InputCredentialsApplePay) MarshalJSON() ([]byte, error) {
+	return inputCredentialsApplePay.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputCredentialsApplePay *InputCredentialsApplePay) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputCredentialsApplePay)
+}
+
+func (inputCredentialsApplePay *InputCredentialsApplePay) appendJSON(b []byte) ([]byte, error) {
+	if inputCredentialsApplePay == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputCredentialsApplePay.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputCredentialsApplePay.tdCommon.Extra)
+	b = append(b, `,"data":`...)
+	b = appendJSONString(b, inputCredentialsApplePay.Data)
+	return append(b, '}'), nil
+}
+
+func (inputCredentialsApplePay *InputCredentialsApplePay) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputCredentialsApplePay.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputCredentialsApplePay.tdCommon.Extra, err = r.readString()
+		case "data":
+			inputCredentialsApplePay.Data, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputCredentialsApplePay *InputCredentialsApplePay) Clone() *InputCredentialsApplePay {
+	if inputCredentialsApplePay == nil {
+		return nil
+	}
+	clone := *inputCredentialsApplePay
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputCredentialsApplePay *InputCredentialsApplePay) Equal(other *InputCredentialsApplePay) bool {
+	if inputCredentialsApplePay == nil || other == nil {
+		return inputCredentialsApplePay == other
+	}
+	if inputCredentialsApplePay.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputCredentialsApplePay *InputCredentialsApplePay) Diff(other *InputCredentialsApplePay) []Change {
+	return inputCredentialsApplePay.diff("", other, nil)
+}
+
+func (inputCredentialsApplePay *InputCredentialsApplePay) diff(path string, other *InputCredentialsApplePay, changes []Change) []Change {
+	if inputCredentialsApplePay == nil || other == nil {
+		if inputCredentialsApplePay != other {
+			changes = append(changes, Change{Path: path, Old: inputCredentialsApplePay, New: other})
+		}
+		return changes
+	}
+	if inputCredentialsApplePay.Data != other.Data {
+		changes = append(changes, Change{Path: fieldPath(path, "data"), Old: inputCredentialsApplePay.Data, New: other.Data})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputCredentialsApplePay *InputCredentialsApplePay) String() string {
+	return objectString(inputCredentialsApplePay)
+}
+
+// LogValue logs the object as its summary
+func (inputCredentialsApplePay *InputCredentialsApplePay) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputCredentialsApplePay))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputCredentialsApplePay *InputCredentialsApplePay) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputCredentialsApplePay)
+}
+
+func (inputCredentialsApplePay *InputCredentialsApplePay) printText(p *printer) {
+	if inputCredentialsApplePay == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputCredentialsApplePay")
+	p.string("data", inputCredentialsApplePay.Data)
+	p.endObject(state)
+}
+
 // GetInputCredentialsEnum return the enum type of this object
 func (inputCredentialsApplePay *InputCredentialsApplePay) GetInputCredentialsEnum() InputCredentialsEnum {
 	return InputCredentialsApplePayType
 }
 
+// Accept calls the method of the visitor for InputCredentialsApplePay
+func (inputCredentialsApplePay *InputCredentialsApplePay) Accept(visitor InputCredentialsVisitor) {
+	visitor.VisitInputCredentialsApplePay(inputCredentialsApplePay)
+}
+
 // InputCredentialsGooglePay Applies if a user enters new credentials using Google Pay
 type InputCredentialsGooglePay struct {
 	tdCommon
@@ -168,7 +545,239 @@ func NewInputCredentialsGooglePay(data string) *InputCredentialsGooglePay {
 	return &inputCredentialsGooglePayTemp
 }
 
+// MarshalJSON marshals to json
+func (inputCredentialsGooglePay *InputCredentialsGooglePay) MarshalJSON() ([]byte, error) {
+	return inputCredentialsGooglePay.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputCredentialsGooglePay *InputCredentialsGooglePay) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputCredentialsGooglePay)
+}
+
+func (inputCredentialsGooglePay *InputCredentialsGooglePay) appendJSON(b []byte) ([]byte, error) {
+	if inputCredentialsGooglePay == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputCredentialsGooglePay.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputCredentialsGooglePay.tdCommon.Extra)
+	b = append(b, `,"data":`...)
+	b = appendJSONString(b, inputCredentialsGooglePay.Data)
+	return append(b, '}'), nil
+}
+
+func (inputCredentialsGooglePay *InputCredentialsGooglePay) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputCredentialsGooglePay.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputCredentialsGooglePay.tdCommon.Extra, err = r.readString()
+		case "data":
+			inputCredentialsGooglePay.Data, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputCredentialsGooglePay *InputCredentialsGooglePay) Clone() *InputCredentialsGooglePay {
+	if inputCredentialsGooglePay == nil {
+		return nil
+	}
+	clone := *inputCredentialsGooglePay
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputCredentialsGooglePay *InputCredentialsGooglePay) Equal(other *InputCredentialsGooglePay) bool {
+	if inputCredentialsGooglePay == nil || other == nil {
+		return inputCredentialsGooglePay == other
+	}
+	if inputCredentialsGooglePay.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputCredentialsGooglePay *InputCredentialsGooglePay) Diff(other *InputCredentialsGooglePay) []Change {
+	return inputCredentialsGooglePay.diff("", other, nil)
+}
+
+func (inputCredentialsGooglePay *InputCredentialsGooglePay) diff(path string, other *InputCredentialsGooglePay, changes []Change) []Change {
+	if inputCredentialsGooglePay == nil || other == nil {
+		if inputCredentialsGooglePay != other {
+			changes = append(changes, Change{Path: path, Old: inputCredentialsGooglePay, New: other})
+		}
+		return changes
+	}
+	if inputCredentialsGooglePay.Data != other.Data {
+		changes = append(changes, Change{Path: fieldPath(path, "data"), Old: inputCredentialsGooglePay.Data, New: other.Data})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputCredentialsGooglePay *InputCredentialsGooglePay) String() string {
+	return objectString(inputCredentialsGooglePay)
+}
+
+// LogValue logs the object as its summary
+func (inputCredentialsGooglePay *InputCredentialsGooglePay) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputCredentialsGooglePay))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputCredentialsGooglePay *InputCredentialsGooglePay) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputCredentialsGooglePay)
+}
+
+func (inputCredentialsGooglePay *InputCredentialsGooglePay) printText(p *printer) {
+	if inputCredentialsGooglePay == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputCredentialsGooglePay")
+	p.string("data", inputCredentialsGooglePay.Data)
+	p.endObject(state)
+}
+
 // GetInputCredentialsEnum return the enum type of this object
 func (inputCredentialsGooglePay *InputCredentialsGooglePay) GetInputCredentialsEnum() InputCredentialsEnum {
 	return InputCredentialsGooglePayType
 }
+
+// Accept calls the method of the visitor for InputCredentialsGooglePay
+func (inputCredentialsGooglePay *InputCredentialsGooglePay) Accept(visitor InputCredentialsVisitor) {
+	visitor.VisitInputCredentialsGooglePay(inputCredentialsGooglePay)
+}
+
+// InputCredentialsVisitor visits every type of InputCredentials, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to InputCredentials, until they handle it too.
+type InputCredentialsVisitor interface {
+	VisitInputCredentialsSaved(inputCredentialsSaved *InputCredentialsSaved)
+	VisitInputCredentialsNew(inputCredentialsNew *InputCredentialsNew)
+	VisitInputCredentialsApplePay(inputCredentialsApplePay *InputCredentialsApplePay)
+	VisitInputCredentialsGooglePay(inputCredentialsGooglePay *InputCredentialsGooglePay)
+	VisitUnknownInputCredentials(unknownInputCredentials *UnknownInputCredentials)
+}
+
+// InputCredentialsCases holds the function MatchInputCredentials calls for each type of InputCredentials;
+// types without a function are passed to the default case
+type InputCredentialsCases[R any] struct {
+	InputCredentialsSaved     func(inputCredentialsSaved *InputCredentialsSaved) R
+	InputCredentialsNew       func(inputCredentialsNew *InputCredentialsNew) R
+	InputCredentialsApplePay  func(inputCredentialsApplePay *InputCredentialsApplePay) R
+	InputCredentialsGooglePay func(inputCredentialsGooglePay *InputCredentialsGooglePay) R
+}
+
+// MatchInputCredentials calls the case of the type of inputCredentials, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement InputCredentialsVisitor
+// to have the compiler check every type is handled.
+func MatchInputCredentials[R any](inputCredentials InputCredentials, cases InputCredentialsCases[R], defaultCase func(inputCredentials InputCredentials) R) R {
+	switch value := inputCredentials.(type) {
+	case *InputCredentialsSaved:
+		if cases.InputCredentialsSaved != nil {
+			return cases.InputCredentialsSaved(value)
+		}
+	case *InputCredentialsNew:
+		if cases.InputCredentialsNew != nil {
+			return cases.InputCredentialsNew(value)
+		}
+	case *InputCredentialsApplePay:
+		if cases.InputCredentialsApplePay != nil {
+			return cases.InputCredentialsApplePay(value)
+		}
+	case *InputCredentialsGooglePay:
+		if cases.InputCredentialsGooglePay != nil {
+			return cases.InputCredentialsGooglePay(value)
+		}
+	}
+	return defaultCase(inputCredentials)
+}
+
+// Clone returns a deep copy of the object
+func (unknownInputCredentials *UnknownInputCredentials) Clone() *UnknownInputCredentials {
+	if unknownInputCredentials == nil {
+		return nil
+	}
+	return &UnknownInputCredentials{Unknown: unknownInputCredentials.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownInputCredentials *UnknownInputCredentials) Equal(other *UnknownInputCredentials) bool {
+	if unknownInputCredentials == nil || other == nil {
+		return unknownInputCredentials == other
+	}
+	return unknownInputCredentials.Unknown.equal(other.Unknown)
+}
+
+func cloneInputCredentials(inputCredentials InputCredentials) InputCredentials {
+	switch value := inputCredentials.(type) {
+	case *InputCredentialsSaved:
+		return value.Clone()
+	case *InputCredentialsNew:
+		return value.Clone()
+	case *InputCredentialsApplePay:
+		return value.Clone()
+	case *InputCredentialsGooglePay:
+		return value.Clone()
+	case *UnknownInputCredentials:
+		return value.Clone()
+	}
+	return inputCredentials
+}
+
+func equalInputCredentials(a InputCredentials, b InputCredentials) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *InputCredentialsSaved:
+		b, ok := b.(*InputCredentialsSaved)
+		return ok && a.Equal(b)
+	case *InputCredentialsNew:
+		b, ok := b.(*InputCredentialsNew)
+		return ok && a.Equal(b)
+	case *InputCredentialsApplePay:
+		b, ok := b.(*InputCredentialsApplePay)
+		return ok && a.Equal(b)
+	case *InputCredentialsGooglePay:
+		b, ok := b.(*InputCredentialsGooglePay)
+		return ok && a.Equal(b)
+	case *UnknownInputCredentials:
+		b, ok := b.(*UnknownInputCredentials)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffInputCredentials(path string, a InputCredentials, b InputCredentials, changes []Change) []Change {
+	switch a := a.(type) {
+	case *InputCredentialsSaved:
+		if b, ok := b.(*InputCredentialsSaved); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputCredentialsNew:
+		if b, ok := b.(*InputCredentialsNew); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputCredentialsApplePay:
+		if b, ok := b.(*InputCredentialsApplePay); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputCredentialsGooglePay:
+		if b, ok := b.(*InputCredentialsGooglePay); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalInputCredentials(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // InputFile Points to a file
 type InputFile interface {
 	GetInputFileEnum() InputFileEnum
+	Accept(visitor InputFileVisitor)
 }
 
 // InputFileEnum Alias for abstract InputFile 'Sub-Classes', used as constant-enum here
@@ -21,41 +23,115 @@ const (
 	InputFileGeneratedType InputFileEnum = "inputFileGenerated"
 )
 
+// UnknownInputFile is an InputFile of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownInputFile struct {
+	Unknown
+}
+
+// GetInputFileEnum return the enum type of this object
+func (unknownInputFile *UnknownInputFile) GetInputFileEnum() InputFileEnum {
+	return InputFileEnum(unknownInputFile.Type)
+}
+
+// Accept calls the method of the visitor for UnknownInputFile
+func (unknownInputFile *UnknownInputFile) Accept(visitor InputFileVisitor) {
+	visitor.VisitUnknownInputFile(unknownInputFile)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownInputFile *UnknownInputFile) String() string {
+	return objectString(unknownInputFile)
+}
+
+// LogValue logs the object as its summary
+func (unknownInputFile *UnknownInputFile) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownInputFile))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownInputFile *UnknownInputFile) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownInputFile)
+}
+
+func (unknownInputFile *UnknownInputFile) printText(p *printer) {
+	if unknownInputFile == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownInputFile")
+	p.string("@type", unknownInputFile.Type)
+	p.endObject(state)
+}
+
 func unmarshalInputFile(rawMsg *json.RawMessage) (InputFile, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch InputFileEnum(objMap["@type"].(string)) {
+	switch InputFileEnum(typeName) {
 	case InputFileIdType:
 		var inputFileId InputFileId
-		err := json.Unmarshal(*rawMsg, &inputFileId)
+		err := jsonUnmarshal(*rawMsg, &inputFileId)
 		return &inputFileId, err
 
 	case InputFileRemoteType:
 		var inputFileRemote InputFileRemote
-		err := json.Unmarshal(*rawMsg, &inputFileRemote)
+		err := jsonUnmarshal(*rawMsg, &inputFileRemote)
 		return &inputFileRemote, err
 
 	case InputFileLocalType:
 		var inputFileLocal InputFileLocal
-		err := json.Unmarshal(*rawMsg, &inputFileLocal)
+		err := jsonUnmarshal(*rawMsg, &inputFileLocal)
 		return &inputFileLocal, err
 
 	case InputFileGeneratedType:
 		var inputFileGenerated InputFileGenerated
-		err := json.Unmarshal(*rawMsg, &inputFileGenerated)
+		err := jsonUnmarshal(*rawMsg, &inputFileGenerated)
 		return &inputFileGenerated, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownInputFile{Unknown: unknown}, nil
+	}
+}
+
+func unmarshalInputFileSlice(rawMsg *json.RawMessage) ([]InputFile, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var rawItems []*json.RawMessage
+	err := jsonUnmarshal(*rawMsg, &rawItems)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]InputFile, 0, len(rawItems))
+	for _, rawItem := range rawItems {
+		item, err := unmarshalInputFile(rawItem)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func decodeInputFile(r *jsonReader) (InputFile, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
 	}
+	return unmarshalInputFile(rawMsg)
 }
 
 // InputFileId A file defined by its unique ID
@@ -81,11 +157,118 @@ func NewInputFileId(id int32) *InputFileId {
 	return &inputFileIdTemp
 }
 
+// MarshalJSON marshals to json
+func (inputFileId *InputFileId) MarshalJSON() ([]byte, error) {
+	return inputFileId.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputFileId *InputFileId) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputFileId)
+}
+
+func (inputFileId *InputFileId) appendJSON(b []byte) ([]byte, error) {
+	if inputFileId == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputFileId.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputFileId.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt(b, int64(inputFileId.Id))
+	return append(b, '}'), nil
+}
+
+func (inputFileId *InputFileId) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputFileId.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputFileId.tdCommon.Extra, err = r.readString()
+		case "id":
+			inputFileId.Id, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputFileId *InputFileId) Clone() *InputFileId {
+	if inputFileId == nil {
+		return nil
+	}
+	clone := *inputFileId
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputFileId *InputFileId) Equal(other *InputFileId) bool {
+	if inputFileId == nil || other == nil {
+		return inputFileId == other
+	}
+	if inputFileId.Id != other.Id {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputFileId *InputFileId) Diff(other *InputFileId) []Change {
+	return inputFileId.diff("", other, nil)
+}
+
+func (inputFileId *InputFileId) diff(path string, other *InputFileId, changes []Change) []Change {
+	if inputFileId == nil || other == nil {
+		if inputFileId != other {
+			changes = append(changes, Change{Path: path, Old: inputFileId, New: other})
+		}
+		return changes
+	}
+	if inputFileId.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: inputFileId.Id, New: other.Id})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputFileId *InputFileId) String() string {
+	return objectString(inputFileId)
+}
+
+// LogValue logs the object as its summary
+func (inputFileId *InputFileId) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputFileId))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputFileId *InputFileId) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputFileId)
+}
+
+func (inputFileId *InputFileId) printText(p *printer) {
+	if inputFileId == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputFileId")
+	p.int("id", int64(inputFileId.Id))
+	p.endObject(state)
+}
+
 // GetInputFileEnum return the enum type of this object
 func (inputFileId *InputFileId) GetInputFileEnum() InputFileEnum {
 	return InputFileIdType
 }
 
+// Accept calls the method of the visitor for InputFileId
+func (inputFileId *InputFileId) Accept(visitor InputFileVisitor) {
+	visitor.VisitInputFileId(inputFileId)
+}
+
 // InputFileRemote A file defined by its remote ID. The remote ID is guaranteed to be usable only if the corresponding file is still accessible to the user and known to TDLib. For example, if the file is from a message, then the message must be not deleted and accessible to the user. If the file database is disabled, then the corresponding object with the file must be preloaded by the application
 type InputFileRemote struct {
 	tdCommon
@@ -109,11 +292,118 @@ func NewInputFileRemote(id string) *InputFileRemote {
 	return &inputFileRemoteTemp
 }
 
+// MarshalJSON marshals to json
+func (inputFileRemote *InputFileRemote) MarshalJSON() ([]byte, error) {
+	return inputFileRemote.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputFileRemote *InputFileRemote) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputFileRemote)
+}
+
+func (inputFileRemote *InputFileRemote) appendJSON(b []byte) ([]byte, error) {
+	if inputFileRemote == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputFileRemote.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputFileRemote.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, inputFileRemote.Id)
+	return append(b, '}'), nil
+}
+
+func (inputFileRemote *InputFileRemote) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputFileRemote.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputFileRemote.tdCommon.Extra, err = r.readString()
+		case "id":
+			inputFileRemote.Id, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputFileRemote *InputFileRemote) Clone() *InputFileRemote {
+	if inputFileRemote == nil {
+		return nil
+	}
+	clone := *inputFileRemote
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputFileRemote *InputFileRemote) Equal(other *InputFileRemote) bool {
+	if inputFileRemote == nil || other == nil {
+		return inputFileRemote == other
+	}
+	if inputFileRemote.Id != other.Id {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputFileRemote *InputFileRemote) Diff(other *InputFileRemote) []Change {
+	return inputFileRemote.diff("", other, nil)
+}
+
+func (inputFileRemote *InputFileRemote) diff(path string, other *InputFileRemote, changes []Change) []Change {
+	if inputFileRemote == nil || other == nil {
+		if inputFileRemote != other {
+			changes = append(changes, Change{Path: path, Old: inputFileRemote, New: other})
+		}
+		return changes
+	}
+	if inputFileRemote.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: inputFileRemote.Id, New: other.Id})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputFileRemote *InputFileRemote) String() string {
+	return objectString(inputFileRemote)
+}
+
+// LogValue logs the object as its summary
+func (inputFileRemote *InputFileRemote) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputFileRemote))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputFileRemote *InputFileRemote) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputFileRemote)
+}
+
+func (inputFileRemote *InputFileRemote) printText(p *printer) {
+	if inputFileRemote == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputFileRemote")
+	p.string("id", inputFileRemote.Id)
+	p.endObject(state)
+}
+
 // GetInputFileEnum return the enum type of this object
 func (inputFileRemote *InputFileRemote) GetInputFileEnum() InputFileEnum {
 	return InputFileRemoteType
 }
 
+// Accept calls the method of the visitor for InputFileRemote
+func (inputFileRemote *InputFileRemote) Accept(visitor InputFileVisitor) {
+	visitor.VisitInputFileRemote(inputFileRemote)
+}
+
 // InputFileLocal A file defined by a local path
 type InputFileLocal struct {
 	tdCommon
@@ -137,11 +427,118 @@ func NewInputFileLocal(path string) *InputFileLocal {
 	return &inputFileLocalTemp
 }
 
+// MarshalJSON marshals to json
+func (inputFileLocal *InputFileLocal) MarshalJSON() ([]byte, error) {
+	return inputFileLocal.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputFileLocal *InputFileLocal) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputFileLocal)
+}
+
+func (inputFileLocal *InputFileLocal) appendJSON(b []byte) ([]byte, error) {
+	if inputFileLocal == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputFileLocal.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputFileLocal.tdCommon.Extra)
+	b = append(b, `,"path":`...)
+	b = appendJSONString(b, inputFileLocal.Path)
+	return append(b, '}'), nil
+}
+
+func (inputFileLocal *InputFileLocal) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputFileLocal.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputFileLocal.tdCommon.Extra, err = r.readString()
+		case "path":
+			inputFileLocal.Path, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputFileLocal *InputFileLocal) Clone() *InputFileLocal {
+	if inputFileLocal == nil {
+		return nil
+	}
+	clone := *inputFileLocal
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputFileLocal *InputFileLocal) Equal(other *InputFileLocal) bool {
+	if inputFileLocal == nil || other == nil {
+		return inputFileLocal == other
+	}
+	if inputFileLocal.Path != other.Path {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputFileLocal *InputFileLocal) Diff(other *InputFileLocal) []Change {
+	return inputFileLocal.diff("", other, nil)
+}
+
+func (inputFileLocal *InputFileLocal) diff(path string, other *InputFileLocal, changes []Change) []Change {
+	if inputFileLocal == nil || other == nil {
+		if inputFileLocal != other {
+			changes = append(changes, Change{Path: path, Old: inputFileLocal, New: other})
+		}
+		return changes
+	}
+	if inputFileLocal.Path != other.Path {
+		changes = append(changes, Change{Path: fieldPath(path, "path"), Old: inputFileLocal.Path, New: other.Path})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputFileLocal *InputFileLocal) String() string {
+	return objectString(inputFileLocal)
+}
+
+// LogValue logs the object as its summary
+func (inputFileLocal *InputFileLocal) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputFileLocal))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputFileLocal *InputFileLocal) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputFileLocal)
+}
+
+func (inputFileLocal *InputFileLocal) printText(p *printer) {
+	if inputFileLocal == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputFileLocal")
+	p.string("path", inputFileLocal.Path)
+	p.endObject(state)
+}
+
 // GetInputFileEnum return the enum type of this object
 func (inputFileLocal *InputFileLocal) GetInputFileEnum() InputFileEnum {
 	return InputFileLocalType
 }
 
+// Accept calls the method of the visitor for InputFileLocal
+func (inputFileLocal *InputFileLocal) Accept(visitor InputFileVisitor) {
+	visitor.VisitInputFileLocal(inputFileLocal)
+}
+
 // InputFileGenerated A file generated by the application
 type InputFileGenerated struct {
 	tdCommon
@@ -171,7 +568,261 @@ func NewInputFileGenerated(originalPath string, conversion string, expectedSize
 	return &inputFileGeneratedTemp
 }
 
+// MarshalJSON marshals to json
+func (inputFileGenerated *InputFileGenerated) MarshalJSON() ([]byte, error) {
+	return inputFileGenerated.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputFileGenerated *InputFileGenerated) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputFileGenerated)
+}
+
+func (inputFileGenerated *InputFileGenerated) appendJSON(b []byte) ([]byte, error) {
+	if inputFileGenerated == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputFileGenerated.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputFileGenerated.tdCommon.Extra)
+	b = append(b, `,"original_path":`...)
+	b = appendJSONString(b, inputFileGenerated.OriginalPath)
+	b = append(b, `,"conversion":`...)
+	b = appendJSONString(b, inputFileGenerated.Conversion)
+	b = append(b, `,"expected_size":`...)
+	b = appendJSONInt(b, int64(inputFileGenerated.ExpectedSize))
+	return append(b, '}'), nil
+}
+
+func (inputFileGenerated *InputFileGenerated) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputFileGenerated.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputFileGenerated.tdCommon.Extra, err = r.readString()
+		case "original_path":
+			inputFileGenerated.OriginalPath, err = r.readString()
+		case "conversion":
+			inputFileGenerated.Conversion, err = r.readString()
+		case "expected_size":
+			inputFileGenerated.ExpectedSize, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputFileGenerated *InputFileGenerated) Clone() *InputFileGenerated {
+	if inputFileGenerated == nil {
+		return nil
+	}
+	clone := *inputFileGenerated
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputFileGenerated *InputFileGenerated) Equal(other *InputFileGenerated) bool {
+	if inputFileGenerated == nil || other == nil {
+		return inputFileGenerated == other
+	}
+	if inputFileGenerated.OriginalPath != other.OriginalPath {
+		return false
+	}
+	if inputFileGenerated.Conversion != other.Conversion {
+		return false
+	}
+	if inputFileGenerated.ExpectedSize != other.ExpectedSize {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputFileGenerated *InputFileGenerated) Diff(other *InputFileGenerated) []Change {
+	return inputFileGenerated.diff("", other, nil)
+}
+
+func (inputFileGenerated *InputFileGenerated) diff(path string, other *InputFileGenerated, changes []Change) []Change {
+	if inputFileGenerated == nil || other == nil {
+		if inputFileGenerated != other {
+			changes = append(changes, Change{Path: path, Old: inputFileGenerated, New: other})
+		}
+		return changes
+	}
+	if inputFileGenerated.OriginalPath != other.OriginalPath {
+		changes = append(changes, Change{Path: fieldPath(path, "original_path"), Old: inputFileGenerated.OriginalPath, New: other.OriginalPath})
+	}
+	if inputFileGenerated.Conversion != other.Conversion {
+		changes = append(changes, Change{Path: fieldPath(path, "conversion"), Old: inputFileGenerated.Conversion, New: other.Conversion})
+	}
+	if inputFileGenerated.ExpectedSize != other.ExpectedSize {
+		changes = append(changes, Change{Path: fieldPath(path, "expected_size"), Old: inputFileGenerated.ExpectedSize, New: other.ExpectedSize})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputFileGenerated *InputFileGenerated) String() string {
+	return objectString(inputFileGenerated)
+}
+
+// LogValue logs the object as its summary
+func (inputFileGenerated *InputFileGenerated) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputFileGenerated))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputFileGenerated *InputFileGenerated) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputFileGenerated)
+}
+
+func (inputFileGenerated *InputFileGenerated) printText(p *printer) {
+	if inputFileGenerated == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputFileGenerated")
+	p.string("original_path", inputFileGenerated.OriginalPath)
+	p.string("conversion", inputFileGenerated.Conversion)
+	p.int("expected_size", int64(inputFileGenerated.ExpectedSize))
+	p.endObject(state)
+}
+
 // GetInputFileEnum return the enum type of this object
 func (inputFileGenerated *InputFileGenerated) GetInputFileEnum() InputFileEnum {
 	return InputFileGeneratedType
 }
+
+// Accept calls the method of the visitor for InputFileGenerated
+func (inputFileGenerated *InputFileGenerated) Accept(visitor InputFileVisitor) {
+	visitor.VisitInputFileGenerated(inputFileGenerated)
+}
+
+// InputFileVisitor visits every type of InputFile, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to InputFile, until they handle it too.
+type InputFileVisitor interface {
+	VisitInputFileId(inputFileId *InputFileId)
+	VisitInputFileRemote(inputFileRemote *InputFileRemote)
+	VisitInputFileLocal(inputFileLocal *InputFileLocal)
+	VisitInputFileGenerated(inputFileGenerated *InputFileGenerated)
+	VisitUnknownInputFile(unknownInputFile *UnknownInputFile)
+}
+
+// InputFileCases holds the function MatchInputFile calls for each type of InputFile;
+// types without a function are passed to the default case
+type InputFileCases[R any] struct {
+	InputFileId        func(inputFileId *InputFileId) R
+	InputFileRemote    func(inputFileRemote *InputFileRemote) R
+	InputFileLocal     func(inputFileLocal *InputFileLocal) R
+	InputFileGenerated func(inputFileGenerated *InputFileGenerated) R
+}
+
+// MatchInputFile calls the case of the type of inputFile, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement InputFileVisitor
+// to have the compiler check every type is handled.
+func MatchInputFile[R any](inputFile InputFile, cases InputFileCases[R], defaultCase func(inputFile InputFile) R) R {
+	switch value := inputFile.(type) {
+	case *InputFileId:
+		if cases.InputFileId != nil {
+			return cases.InputFileId(value)
+		}
+	case *InputFileRemote:
+		if cases.InputFileRemote != nil {
+			return cases.InputFileRemote(value)
+		}
+	case *InputFileLocal:
+		if cases.InputFileLocal != nil {
+			return cases.InputFileLocal(value)
+		}
+	case *InputFileGenerated:
+		if cases.InputFileGenerated != nil {
+			return cases.InputFileGenerated(value)
+		}
+	}
+	return defaultCase(inputFile)
+}
+
+// Clone returns a deep copy of the object
+func (unknownInputFile *UnknownInputFile) Clone() *UnknownInputFile {
+	if unknownInputFile == nil {
+		return nil
+	}
+	return &UnknownInputFile{Unknown: unknownInputFile.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownInputFile *UnknownInputFile) Equal(other *UnknownInputFile) bool {
+	if unknownInputFile == nil || other == nil {
+		return unknownInputFile == other
+	}
+	return unknownInputFile.Unknown.equal(other.Unknown)
+}
+
+func cloneInputFile(inputFile InputFile) InputFile {
+	switch value := inputFile.(type) {
+	case *InputFileId:
+		return value.Clone()
+	case *InputFileRemote:
+		return value.Clone()
+	case *InputFileLocal:
+		return value.Clone()
+	case *InputFileGenerated:
+		return value.Clone()
+	case *UnknownInputFile:
+		return value.Clone()
+	}
+	return inputFile
+}
+
+func equalInputFile(a InputFile, b InputFile) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *InputFileId:
+		b, ok := b.(*InputFileId)
+		return ok && a.Equal(b)
+	case *InputFileRemote:
+		b, ok := b.(*InputFileRemote)
+		return ok && a.Equal(b)
+	case *InputFileLocal:
+		b, ok := b.(*InputFileLocal)
+		return ok && a.Equal(b)
+	case *InputFileGenerated:
+		b, ok := b.(*InputFileGenerated)
+		return ok && a.Equal(b)
+	case *UnknownInputFile:
+		b, ok := b.(*UnknownInputFile)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffInputFile(path string, a InputFile, b InputFile, changes []Change) []Change {
+	switch a := a.(type) {
+	case *InputFileId:
+		if b, ok := b.(*InputFileId); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputFileRemote:
+		if b, ok := b.(*InputFileRemote); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputFileLocal:
+		if b, ok := b.(*InputFileLocal); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputFileGenerated:
+		if b, ok := b.(*InputFileGenerated); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalInputFile(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // InputIdentityDocument An identity document to be saved to Telegram Passport
@@ -42,37 +43,214 @@ func NewInputIdentityDocument(number string, expiryDate *Date, frontSide InputFi
 	return &inputIdentityDocumentTemp
 }
 
+// MarshalJSON marshals to json
+func (inputIdentityDocument *InputIdentityDocument) MarshalJSON() ([]byte, error) {
+	return inputIdentityDocument.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputIdentityDocument *InputIdentityDocument) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, inputIdentityDocument)
+}
+
+func (inputIdentityDocument *InputIdentityDocument) appendJSON(b []byte) ([]byte, error) {
+	if inputIdentityDocument == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputIdentityDocument.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputIdentityDocument.tdCommon.Extra)
+	b = append(b, `,"number":`...)
+	b = appendJSONString(b, inputIdentityDocument.Number)
+	b = append(b, `,"expiry_date":`...)
+	b, err = inputIdentityDocument.ExpiryDate.appendJSON(b)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Number      string      `json:"number"`      // Document number; 1-24 characters
-		ExpiryDate  *Date       `json:"expiry_date"` // Document expiry date; pass null if not applicable
-		Translation []InputFile `json:"translation"` // List of files containing a certified English translation of the document
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"front_side":`...)
+	b, err = appendJSONValue(b, inputIdentityDocument.FrontSide)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"reverse_side":`...)
+	b, err = appendJSONValue(b, inputIdentityDocument.ReverseSide)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"selfie":`...)
+	b, err = appendJSONValue(b, inputIdentityDocument.Selfie)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"translation":`...)
+	if inputIdentityDocument.Translation == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range inputIdentityDocument.Translation {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = appendJSONValue(b, inputIdentityDocument.Translation[i0])
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (inputIdentityDocument *InputIdentityDocument) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputIdentityDocument.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputIdentityDocument.tdCommon.Extra, err = r.readString()
+		case "number":
+			inputIdentityDocument.Number, err = r.readString()
+		case "expiry_date":
+			if r.readNull() {
+				inputIdentityDocument.ExpiryDate = nil
+			} else {
+				inputIdentityDocument.ExpiryDate = new(Date)
+				err = inputIdentityDocument.ExpiryDate.decodeJSON(r)
+			}
+		case "front_side":
+			inputIdentityDocument.FrontSide, err = decodeInputFile(r)
+		case "reverse_side":
+			inputIdentityDocument.ReverseSide, err = decodeInputFile(r)
+		case "selfie":
+			inputIdentityDocument.Selfie, err = decodeInputFile(r)
+		case "translation":
+			if r.readNull() {
+				inputIdentityDocument.Translation = nil
+			} else {
+				inputIdentityDocument.Translation = make([]InputFile, 0)
+				err = r.readArray(func() (err error) {
+					var item0 InputFile
+					item0, err = decodeInputFile(r)
+					inputIdentityDocument.Translation = append(inputIdentityDocument.Translation, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputIdentityDocument *InputIdentityDocument) Clone() *InputIdentityDocument {
+	if inputIdentityDocument == nil {
+		return nil
+	}
+	clone := *inputIdentityDocument
+	clone.ExpiryDate = inputIdentityDocument.ExpiryDate.Clone()
+	clone.FrontSide = cloneInputFile(inputIdentityDocument.FrontSide)
+	clone.ReverseSide = cloneInputFile(inputIdentityDocument.ReverseSide)
+	clone.Selfie = cloneInputFile(inputIdentityDocument.Selfie)
+	if inputIdentityDocument.Translation != nil {
+		clone.Translation = make([]InputFile, len(inputIdentityDocument.Translation))
+		for i0 := range inputIdentityDocument.Translation {
+			clone.Translation[i0] = cloneInputFile(inputIdentityDocument.Translation[i0])
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputIdentityDocument *InputIdentityDocument) Equal(other *InputIdentityDocument) bool {
+	if inputIdentityDocument == nil || other == nil {
+		return inputIdentityDocument == other
+	}
+	if inputIdentityDocument.Number != other.Number {
+		return false
+	}
+	if !inputIdentityDocument.ExpiryDate.Equal(other.ExpiryDate) {
+		return false
+	}
+	if !equalInputFile(inputIdentityDocument.FrontSide, other.FrontSide) {
+		return false
+	}
+	if !equalInputFile(inputIdentityDocument.ReverseSide, other.ReverseSide) {
+		return false
 	}
+	if !equalInputFile(inputIdentityDocument.Selfie, other.Selfie) {
+		return false
+	}
+	if len(inputIdentityDocument.Translation) != len(other.Translation) {
+		return false
+	}
+	for i0 := range inputIdentityDocument.Translation {
+		if !equalInputFile(inputIdentityDocument.Translation[i0], other.Translation[i0]) {
+			return false
+		}
+	}
+	return true
+}
 
-	inputIdentityDocument.tdCommon = tempObj.tdCommon
-	inputIdentityDocument.Number = tempObj.Number
-	inputIdentityDocument.ExpiryDate = tempObj.ExpiryDate
-	inputIdentityDocument.Translation = tempObj.Translation
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputIdentityDocument *InputIdentityDocument) Diff(other *InputIdentityDocument) []Change {
+	return inputIdentityDocument.diff("", other, nil)
+}
 
-	fieldFrontSide, _ := unmarshalInputFile(objMap["front_side"])
-	inputIdentityDocument.FrontSide = fieldFrontSide
+func (inputIdentityDocument *InputIdentityDocument) diff(path string, other *InputIdentityDocument, changes []Change) []Change {
+	if inputIdentityDocument == nil || other == nil {
+		if inputIdentityDocument != other {
+			changes = append(changes, Change{Path: path, Old: inputIdentityDocument, New: other})
+		}
+		return changes
+	}
+	if inputIdentityDocument.Number != other.Number {
+		changes = append(changes, Change{Path: fieldPath(path, "number"), Old: inputIdentityDocument.Number, New: other.Number})
+	}
+	changes = inputIdentityDocument.ExpiryDate.diff(fieldPath(path, "expiry_date"), other.ExpiryDate, changes)
+	changes = diffInputFile(fieldPath(path, "front_side"), inputIdentityDocument.FrontSide, other.FrontSide, changes)
+	changes = diffInputFile(fieldPath(path, "reverse_side"), inputIdentityDocument.ReverseSide, other.ReverseSide, changes)
+	changes = diffInputFile(fieldPath(path, "selfie"), inputIdentityDocument.Selfie, other.Selfie, changes)
+	if len(inputIdentityDocument.Translation) != len(other.Translation) {
+		changes = append(changes, Change{Path: fieldPath(path, "translation"), Old: inputIdentityDocument.Translation, New: other.Translation})
+	} else {
+		for i0 := range inputIdentityDocument.Translation {
+			changes = diffInputFile(indexPath(fieldPath(path, "translation"), i0), inputIdentityDocument.Translation[i0], other.Translation[i0], changes)
+		}
+	}
+	return changes
+}
 
-	fieldReverseSide, _ := unmarshalInputFile(objMap["reverse_side"])
-	inputIdentityDocument.ReverseSide = fieldReverseSide
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputIdentityDocument *InputIdentityDocument) String() string {
+	return objectString(inputIdentityDocument)
+}
 
-	fieldSelfie, _ := unmarshalInputFile(objMap["selfie"])
-	inputIdentityDocument.Selfie = fieldSelfie
+// LogValue logs the object as its summary
+func (inputIdentityDocument *InputIdentityDocument) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputIdentityDocument))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputIdentityDocument *InputIdentityDocument) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputIdentityDocument)
+}
 
-	return nil
+func (inputIdentityDocument *InputIdentityDocument) printText(p *printer) {
+	if inputIdentityDocument == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputIdentityDocument")
+	p.string("number", inputIdentityDocument.Number)
+	p.object("expiry_date", inputIdentityDocument.ExpiryDate)
+	p.object("front_side", inputIdentityDocument.FrontSide)
+	p.object("reverse_side", inputIdentityDocument.ReverseSide)
+	p.object("selfie", inputIdentityDocument.Selfie)
+	p.vector("translation", len(inputIdentityDocument.Translation), func(i0 int) {
+		p.object("translation", inputIdentityDocument.Translation[i0])
+	})
+	p.endObject(state)
 }
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // InputInlineQueryResult Represents a single result of an inline query; for bots only
 type InputInlineQueryResult interface {
 	GetInputInlineQueryResultEnum() InputInlineQueryResultEnum
+	Accept(visitor InputInlineQueryResultVisitor)
 }
 
 // InputInlineQueryResultEnum Alias for abstract InputInlineQueryResult 'Sub-Classes', used as constant-enum here
@@ -29,80 +31,123 @@ const (
 	InputInlineQueryResultVoiceNoteType InputInlineQueryResultEnum = "inputInlineQueryResultVoiceNote"
 )
 
+// UnknownInputInlineQueryResult is an InputInlineQueryResult of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownInputInlineQueryResult struct {
+	Unknown
+}
+
+// GetInputInlineQueryResultEnum return the enum type of this object
+func (unknownInputInlineQueryResult *UnknownInputInlineQueryResult) GetInputInlineQueryResultEnum() InputInlineQueryResultEnum {
+	return InputInlineQueryResultEnum(unknownInputInlineQueryResult.Type)
+}
+
+// Accept calls the method of the visitor for UnknownInputInlineQueryResult
+func (unknownInputInlineQueryResult *UnknownInputInlineQueryResult) Accept(visitor InputInlineQueryResultVisitor) {
+	visitor.VisitUnknownInputInlineQueryResult(unknownInputInlineQueryResult)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownInputInlineQueryResult *UnknownInputInlineQueryResult) String() string {
+	return objectString(unknownInputInlineQueryResult)
+}
+
+// LogValue logs the object as its summary
+func (unknownInputInlineQueryResult *UnknownInputInlineQueryResult) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownInputInlineQueryResult))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownInputInlineQueryResult *UnknownInputInlineQueryResult) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownInputInlineQueryResult)
+}
+
+func (unknownInputInlineQueryResult *UnknownInputInlineQueryResult) printText(p *printer) {
+	if unknownInputInlineQueryResult == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownInputInlineQueryResult")
+	p.string("@type", unknownInputInlineQueryResult.Type)
+	p.endObject(state)
+}
+
 func unmarshalInputInlineQueryResult(rawMsg *json.RawMessage) (InputInlineQueryResult, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch InputInlineQueryResultEnum(objMap["@type"].(string)) {
+	switch InputInlineQueryResultEnum(typeName) {
 	case InputInlineQueryResultAnimationType:
 		var inputInlineQueryResultAnimation InputInlineQueryResultAnimation
-		err := json.Unmarshal(*rawMsg, &inputInlineQueryResultAnimation)
+		err := jsonUnmarshal(*rawMsg, &inputInlineQueryResultAnimation)
 		return &inputInlineQueryResultAnimation, err
 
 	case InputInlineQueryResultArticleType:
 		var inputInlineQueryResultArticle InputInlineQueryResultArticle
-		err := json.Unmarshal(*rawMsg, &inputInlineQueryResultArticle)
+		err := jsonUnmarshal(*rawMsg, &inputInlineQueryResultArticle)
 		return &inputInlineQueryResultArticle, err
 
 	case InputInlineQueryResultAudioType:
 		var inputInlineQueryResultAudio InputInlineQueryResultAudio
-		err := json.Unmarshal(*rawMsg, &inputInlineQueryResultAudio)
+		err := jsonUnmarshal(*rawMsg, &inputInlineQueryResultAudio)
 		return &inputInlineQueryResultAudio, err
 
 	case InputInlineQueryResultContactType:
 		var inputInlineQueryResultContact InputInlineQueryResultContact
-		err := json.Unmarshal(*rawMsg, &inputInlineQueryResultContact)
+		err := jsonUnmarshal(*rawMsg, &inputInlineQueryResultContact)
 		return &inputInlineQueryResultContact, err
 
 	case InputInlineQueryResultDocumentType:
 		var inputInlineQueryResultDocument InputInlineQueryResultDocument
-		err := json.Unmarshal(*rawMsg, &inputInlineQueryResultDocument)
+		err := jsonUnmarshal(*rawMsg, &inputInlineQueryResultDocument)
 		return &inputInlineQueryResultDocument, err
 
 	case InputInlineQueryResultGameType:
 		var inputInlineQueryResultGame InputInlineQueryResultGame
-		err := json.Unmarshal(*rawMsg, &inputInlineQueryResultGame)
+		err := jsonUnmarshal(*rawMsg, &inputInlineQueryResultGame)
 		return &inputInlineQueryResultGame, err
 
 	case InputInlineQueryResultLocationType:
 		var inputInlineQueryResultLocation InputInlineQueryResultLocation
-		err := json.Unmarshal(*rawMsg, &inputInlineQueryResultLocation)
+		err := jsonUnmarshal(*rawMsg, &inputInlineQueryResultLocation)
 		return &inputInlineQueryResultLocation, err
 
 	case InputInlineQueryResultPhotoType:
 		var inputInlineQueryResultPhoto InputInlineQueryResultPhoto
-		err := json.Unmarshal(*rawMsg, &inputInlineQueryResultPhoto)
+		err := jsonUnmarshal(*rawMsg, &inputInlineQueryResultPhoto)
 		return &inputInlineQueryResultPhoto, err
 
 	case InputInlineQueryResultStickerType:
 		var inputInlineQueryResultSticker InputInlineQueryResultSticker
-		err := json.Unmarshal(*rawMsg, &inputInlineQueryResultSticker)
+		err := jsonUnmarshal(*rawMsg, &inputInlineQueryResultSticker)
 		return &inputInlineQueryResultSticker, err
 
 	case InputInlineQueryResultVenueType:
 		var inputInlineQueryResultVenue InputInlineQueryResultVenue
-		err := json.Unmarshal(*rawMsg, &inputInlineQueryResultVenue)
+		err := jsonUnmarshal(*rawMsg, &inputInlineQueryResultVenue)
 		return &inputInlineQueryResultVenue, err
 
 	case InputInlineQueryResultVideoType:
 		var inputInlineQueryResultVideo InputInlineQueryResultVideo
-		err := json.Unmarshal(*rawMsg, &inputInlineQueryResultVideo)
+		err := jsonUnmarshal(*rawMsg, &inputInlineQueryResultVideo)
 		return &inputInlineQueryResultVideo, err
 
 	case InputInlineQueryResultVoiceNoteType:
 		var inputInlineQueryResultVoiceNote InputInlineQueryResultVoiceNote
-		err := json.Unmarshal(*rawMsg, &inputInlineQueryResultVoiceNote)
+		err := jsonUnmarshal(*rawMsg, &inputInlineQueryResultVoiceNote)
 		return &inputInlineQueryResultVoiceNote, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownInputInlineQueryResult{Unknown: unknown}, nil
 	}
 }
 
@@ -159,49 +204,221 @@ func NewInputInlineQueryResultAnimation(id string, title string, thumbnailUrl st
 	return &inputInlineQueryResultAnimationTemp
 }
 
+// MarshalJSON marshals to json
+func (inputInlineQueryResultAnimation *InputInlineQueryResultAnimation) MarshalJSON() ([]byte, error) {
+	return inputInlineQueryResultAnimation.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputInlineQueryResultAnimation *InputInlineQueryResultAnimation) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, inputInlineQueryResultAnimation)
+}
+
+func (inputInlineQueryResultAnimation *InputInlineQueryResultAnimation) appendJSON(b []byte) ([]byte, error) {
+	if inputInlineQueryResultAnimation == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputInlineQueryResultAnimation.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputInlineQueryResultAnimation.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, inputInlineQueryResultAnimation.Id)
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, inputInlineQueryResultAnimation.Title)
+	b = append(b, `,"thumbnail_url":`...)
+	b = appendJSONString(b, inputInlineQueryResultAnimation.ThumbnailUrl)
+	b = append(b, `,"thumbnail_mime_type":`...)
+	b = appendJSONString(b, inputInlineQueryResultAnimation.ThumbnailMimeType)
+	b = append(b, `,"video_url":`...)
+	b = appendJSONString(b, inputInlineQueryResultAnimation.VideoUrl)
+	b = append(b, `,"video_mime_type":`...)
+	b = appendJSONString(b, inputInlineQueryResultAnimation.VideoMimeType)
+	b = append(b, `,"video_duration":`...)
+	b = appendJSONInt(b, int64(inputInlineQueryResultAnimation.VideoDuration))
+	b = append(b, `,"video_width":`...)
+	b = appendJSONInt(b, int64(inputInlineQueryResultAnimation.VideoWidth))
+	b = append(b, `,"video_height":`...)
+	b = appendJSONInt(b, int64(inputInlineQueryResultAnimation.VideoHeight))
+	b = append(b, `,"reply_markup":`...)
+	b, err = appendJSONValue(b, inputInlineQueryResultAnimation.ReplyMarkup)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Id                string `json:"id"`                  // Unique identifier of the query result
-		Title             string `json:"title"`               // Title of the query result
-		ThumbnailUrl      string `json:"thumbnail_url"`       // URL of the result thumbnail (JPEG, GIF, or MPEG4), if it exists
-		ThumbnailMimeType string `json:"thumbnail_mime_type"` // MIME type of the video thumbnail. If non-empty, must be one of "image/jpeg", "image/gif" and "video/mp4"
-		VideoUrl          string `json:"video_url"`           // The URL of the video file (file size must not exceed 1MB)
-		VideoMimeType     string `json:"video_mime_type"`     // MIME type of the video file. Must be one of "image/gif" and "video/mp4"
-		VideoDuration     int32  `json:"video_duration"`      // Duration of the video, in seconds
-		VideoWidth        int32  `json:"video_width"`         // Width of the video
-		VideoHeight       int32  `json:"video_height"`        // Height of the video
-
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"input_message_content":`...)
+	b, err = appendJSONValue(b, inputInlineQueryResultAnimation.InputMessageContent)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inputInlineQueryResultAnimation *InputInlineQueryResultAnimation) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputInlineQueryResultAnimation.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputInlineQueryResultAnimation.tdCommon.Extra, err = r.readString()
+		case "id":
+			inputInlineQueryResultAnimation.Id, err = r.readString()
+		case "title":
+			inputInlineQueryResultAnimation.Title, err = r.readString()
+		case "thumbnail_url":
+			inputInlineQueryResultAnimation.ThumbnailUrl, err = r.readString()
+		case "thumbnail_mime_type":
+			inputInlineQueryResultAnimation.ThumbnailMimeType, err = r.readString()
+		case "video_url":
+			inputInlineQueryResultAnimation.VideoUrl, err = r.readString()
+		case "video_mime_type":
+			inputInlineQueryResultAnimation.VideoMimeType, err = r.readString()
+		case "video_duration":
+			inputInlineQueryResultAnimation.VideoDuration, err = r.readInt32()
+		case "video_width":
+			inputInlineQueryResultAnimation.VideoWidth, err = r.readInt32()
+		case "video_height":
+			inputInlineQueryResultAnimation.VideoHeight, err = r.readInt32()
+		case "reply_markup":
+			inputInlineQueryResultAnimation.ReplyMarkup, err = decodeReplyMarkup(r)
+		case "input_message_content":
+			inputInlineQueryResultAnimation.InputMessageContent, err = decodeInputMessageContent(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputInlineQueryResultAnimation *InputInlineQueryResultAnimation) Clone() *InputInlineQueryResultAnimation {
+	if inputInlineQueryResultAnimation == nil {
+		return nil
+	}
+	clone := *inputInlineQueryResultAnimation
+	clone.ReplyMarkup = cloneReplyMarkup(inputInlineQueryResultAnimation.ReplyMarkup)
+	clone.InputMessageContent = cloneInputMessageContent(inputInlineQueryResultAnimation.InputMessageContent)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputInlineQueryResultAnimation *InputInlineQueryResultAnimation) Equal(other *InputInlineQueryResultAnimation) bool {
+	if inputInlineQueryResultAnimation == nil || other == nil {
+		return inputInlineQueryResultAnimation == other
 	}
+	if inputInlineQueryResultAnimation.Id != other.Id {
+		return false
+	}
+	if inputInlineQueryResultAnimation.Title != other.Title {
+		return false
+	}
+	if inputInlineQueryResultAnimation.ThumbnailUrl != other.ThumbnailUrl {
+		return false
+	}
+	if inputInlineQueryResultAnimation.ThumbnailMimeType != other.ThumbnailMimeType {
+		return false
+	}
+	if inputInlineQueryResultAnimation.VideoUrl != other.VideoUrl {
+		return false
+	}
+	if inputInlineQueryResultAnimation.VideoMimeType != other.VideoMimeType {
+		return false
+	}
+	if inputInlineQueryResultAnimation.VideoDuration != other.VideoDuration {
+		return false
+	}
+	if inputInlineQueryResultAnimation.VideoWidth != other.VideoWidth {
+		return false
+	}
+	if inputInlineQueryResultAnimation.VideoHeight != other.VideoHeight {
+		return false
+	}
+	if !equalReplyMarkup(inputInlineQueryResultAnimation.ReplyMarkup, other.ReplyMarkup) {
+		return false
+	}
+	if !equalInputMessageContent(inputInlineQueryResultAnimation.InputMessageContent, other.InputMessageContent) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputInlineQueryResultAnimation *InputInlineQueryResultAnimation) Diff(other *InputInlineQueryResultAnimation) []Change {
+	return inputInlineQueryResultAnimation.diff("", other, nil)
+}
+
+func (inputInlineQueryResultAnimation *InputInlineQueryResultAnimation) diff(path string, other *InputInlineQueryResultAnimation, changes []Change) []Change {
+	if inputInlineQueryResultAnimation == nil || other == nil {
+		if inputInlineQueryResultAnimation != other {
+			changes = append(changes, Change{Path: path, Old: inputInlineQueryResultAnimation, New: other})
+		}
+		return changes
+	}
+	if inputInlineQueryResultAnimation.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: inputInlineQueryResultAnimation.Id, New: other.Id})
+	}
+	if inputInlineQueryResultAnimation.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: inputInlineQueryResultAnimation.Title, New: other.Title})
+	}
+	if inputInlineQueryResultAnimation.ThumbnailUrl != other.ThumbnailUrl {
+		changes = append(changes, Change{Path: fieldPath(path, "thumbnail_url"), Old: inputInlineQueryResultAnimation.ThumbnailUrl, New: other.ThumbnailUrl})
+	}
+	if inputInlineQueryResultAnimation.ThumbnailMimeType != other.ThumbnailMimeType {
+		changes = append(changes, Change{Path: fieldPath(path, "thumbnail_mime_type"), Old: inputInlineQueryResultAnimation.ThumbnailMimeType, New: other.ThumbnailMimeType})
+	}
+	if inputInlineQueryResultAnimation.VideoUrl != other.VideoUrl {
+		changes = append(changes, Change{Path: fieldPath(path, "video_url"), Old: inputInlineQueryResultAnimation.VideoUrl, New: other.VideoUrl})
+	}
+	if inputInlineQueryResultAnimation.VideoMimeType != other.VideoMimeType {
+		changes = append(changes, Change{Path: fieldPath(path, "video_mime_type"), Old: inputInlineQueryResultAnimation.VideoMimeType, New: other.VideoMimeType})
+	}
+	if inputInlineQueryResultAnimation.VideoDuration != other.VideoDuration {
+		changes = append(changes, Change{Path: fieldPath(path, "video_duration"), Old: inputInlineQueryResultAnimation.VideoDuration, New: other.VideoDuration})
+	}
+	if inputInlineQueryResultAnimation.VideoWidth != other.VideoWidth {
+		changes = append(changes, Change{Path: fieldPath(path, "video_width"), Old: inputInlineQueryResultAnimation.VideoWidth, New: other.VideoWidth})
+	}
+	if inputInlineQueryResultAnimation.VideoHeight != other.VideoHeight {
+		changes = append(changes, Change{Path: fieldPath(path, "video_height"), Old: inputInlineQueryResultAnimation.VideoHeight, New: other.VideoHeight})
+	}
+	changes = diffReplyMarkup(fieldPath(path, "reply_markup"), inputInlineQueryResultAnimation.ReplyMarkup, other.ReplyMarkup, changes)
+	changes = diffInputMessageContent(fieldPath(path, "input_message_content"), inputInlineQueryResultAnimation.InputMessageContent, other.InputMessageContent, changes)
+	return changes
+}
 
-	inputInlineQueryResultAnimation.tdCommon = tempObj.tdCommon
-	inputInlineQueryResultAnimation.Id = tempObj.Id
-	inputInlineQueryResultAnimation.Title = tempObj.Title
-	inputInlineQueryResultAnimation.ThumbnailUrl = tempObj.ThumbnailUrl
-	inputInlineQueryResultAnimation.ThumbnailMimeType = tempObj.ThumbnailMimeType
-	inputInlineQueryResultAnimation.VideoUrl = tempObj.VideoUrl
-	inputInlineQueryResultAnimation.VideoMimeType = tempObj.VideoMimeType
-	inputInlineQueryResultAnimation.VideoDuration = tempObj.VideoDuration
-	inputInlineQueryResultAnimation.VideoWidth = tempObj.VideoWidth
-	inputInlineQueryResultAnimation.VideoHeight = tempObj.VideoHeight
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputInlineQueryResultAnimation *InputInlineQueryResultAnimation) String() string {
+	return objectString(inputInlineQueryResultAnimation)
+}
 
-	fieldReplyMarkup, _ := unmarshalReplyMarkup(objMap["reply_markup"])
-	inputInlineQueryResultAnimation.ReplyMarkup = fieldReplyMarkup
+// LogValue logs the object as its summary
+func (inputInlineQueryResultAnimation *InputInlineQueryResultAnimation) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputInlineQueryResultAnimation))
+}
 
-	fieldInputMessageContent, _ := unmarshalInputMessageContent(objMap["input_message_content"])
-	inputInlineQueryResultAnimation.InputMessageContent = fieldInputMessageContent
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputInlineQueryResultAnimation *InputInlineQueryResultAnimation) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputInlineQueryResultAnimation)
+}
 
-	return nil
+func (inputInlineQueryResultAnimation *InputInlineQueryResultAnimation) printText(p *printer) {
+	if inputInlineQueryResultAnimation == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputInlineQueryResultAnimation")
+	p.string("id", inputInlineQueryResultAnimation.Id)
+	p.string("title", inputInlineQueryResultAnimation.Title)
+	p.string("thumbnail_url", inputInlineQueryResultAnimation.ThumbnailUrl)
+	p.string("thumbnail_mime_type", inputInlineQueryResultAnimation.ThumbnailMimeType)
+	p.string("video_url", inputInlineQueryResultAnimation.VideoUrl)
+	p.string("video_mime_type", inputInlineQueryResultAnimation.VideoMimeType)
+	p.int("video_duration", int64(inputInlineQueryResultAnimation.VideoDuration))
+	p.int("video_width", int64(inputInlineQueryResultAnimation.VideoWidth))
+	p.int("video_height", int64(inputInlineQueryResultAnimation.VideoHeight))
+	p.object("reply_markup", inputInlineQueryResultAnimation.ReplyMarkup)
+	p.object("input_message_content", inputInlineQueryResultAnimation.InputMessageContent)
+	p.endObject(state)
 }
 
 // GetInputInlineQueryResultEnum return the enum type of this object
@@ -209,6 +426,11 @@ func (inputInlineQueryResultAnimation *InputInlineQueryResultAnimation) GetInput
 	return InputInlineQueryResultAnimationType
 }
 
+// Accept calls the method of the visitor for InputInlineQueryResultAnimation
+func (inputInlineQueryResultAnimation *InputInlineQueryResultAnimation) Accept(visitor InputInlineQueryResultVisitor) {
+	visitor.VisitInputInlineQueryResultAnimation(inputInlineQueryResultAnimation)
+}
+
 // InputInlineQueryResultArticle Represents a link to an article or web page
 type InputInlineQueryResultArticle struct {
 	tdCommon
@@ -259,47 +481,210 @@ func NewInputInlineQueryResultArticle(id string, url string, hideUrl bool, title
 	return &inputInlineQueryResultArticleTemp
 }
 
+// MarshalJSON marshals to json
+func (inputInlineQueryResultArticle *InputInlineQueryResultArticle) MarshalJSON() ([]byte, error) {
+	return inputInlineQueryResultArticle.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputInlineQueryResultArticle *InputInlineQueryResultArticle) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, inputInlineQueryResultArticle)
+}
+
+func (inputInlineQueryResultArticle *InputInlineQueryResultArticle) appendJSON(b []byte) ([]byte, error) {
+	if inputInlineQueryResultArticle == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputInlineQueryResultArticle.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputInlineQueryResultArticle.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, inputInlineQueryResultArticle.Id)
+	b = append(b, `,"url":`...)
+	b = appendJSONString(b, inputInlineQueryResultArticle.Url)
+	b = append(b, `,"hide_url":`...)
+	b = appendJSONBool(b, inputInlineQueryResultArticle.HideUrl)
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, inputInlineQueryResultArticle.Title)
+	b = append(b, `,"description":`...)
+	b = appendJSONString(b, inputInlineQueryResultArticle.Description)
+	b = append(b, `,"thumbnail_url":`...)
+	b = appendJSONString(b, inputInlineQueryResultArticle.ThumbnailUrl)
+	b = append(b, `,"thumbnail_width":`...)
+	b = appendJSONInt(b, int64(inputInlineQueryResultArticle.ThumbnailWidth))
+	b = append(b, `,"thumbnail_height":`...)
+	b = appendJSONInt(b, int64(inputInlineQueryResultArticle.ThumbnailHeight))
+	b = append(b, `,"reply_markup":`...)
+	b, err = appendJSONValue(b, inputInlineQueryResultArticle.ReplyMarkup)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Id              string `json:"id"`               // Unique identifier of the query result
-		Url             string `json:"url"`              // URL of the result, if it exists
-		HideUrl         bool   `json:"hide_url"`         // True, if the URL must be not shown
-		Title           string `json:"title"`            // Title of the result
-		Description     string `json:"description"`      // A short description of the result
-		ThumbnailUrl    string `json:"thumbnail_url"`    // URL of the result thumbnail, if it exists
-		ThumbnailWidth  int32  `json:"thumbnail_width"`  // Thumbnail width, if known
-		ThumbnailHeight int32  `json:"thumbnail_height"` // Thumbnail height, if known
-
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"input_message_content":`...)
+	b, err = appendJSONValue(b, inputInlineQueryResultArticle.InputMessageContent)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inputInlineQueryResultArticle *InputInlineQueryResultArticle) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputInlineQueryResultArticle.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputInlineQueryResultArticle.tdCommon.Extra, err = r.readString()
+		case "id":
+			inputInlineQueryResultArticle.Id, err = r.readString()
+		case "url":
+			inputInlineQueryResultArticle.Url, err = r.readString()
+		case "hide_url":
+			inputInlineQueryResultArticle.HideUrl, err = r.readBool()
+		case "title":
+			inputInlineQueryResultArticle.Title, err = r.readString()
+		case "description":
+			inputInlineQueryResultArticle.Description, err = r.readString()
+		case "thumbnail_url":
+			inputInlineQueryResultArticle.ThumbnailUrl, err = r.readString()
+		case "thumbnail_width":
+			inputInlineQueryResultArticle.ThumbnailWidth, err = r.readInt32()
+		case "thumbnail_height":
+			inputInlineQueryResultArticle.ThumbnailHeight, err = r.readInt32()
+		case "reply_markup":
+			inputInlineQueryResultArticle.ReplyMarkup, err = decodeReplyMarkup(r)
+		case "input_message_content":
+			inputInlineQueryResultArticle.InputMessageContent, err = decodeInputMessageContent(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputInlineQueryResultArticle *InputInlineQueryResultArticle) Clone() *InputInlineQueryResultArticle {
+	if inputInlineQueryResultArticle == nil {
+		return nil
+	}
+	clone := *inputInlineQueryResultArticle
+	clone.ReplyMarkup = cloneReplyMarkup(inputInlineQueryResultArticle.ReplyMarkup)
+	clone.InputMessageContent = cloneInputMessageContent(inputInlineQueryResultArticle.InputMessageContent)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputInlineQueryResultArticle *InputInlineQueryResultArticle) Equal(other *InputInlineQueryResultArticle) bool {
+	if inputInlineQueryResultArticle == nil || other == nil {
+		return inputInlineQueryResultArticle == other
+	}
+	if inputInlineQueryResultArticle.Id != other.Id {
+		return false
+	}
+	if inputInlineQueryResultArticle.Url != other.Url {
+		return false
+	}
+	if inputInlineQueryResultArticle.HideUrl != other.HideUrl {
+		return false
+	}
+	if inputInlineQueryResultArticle.Title != other.Title {
+		return false
+	}
+	if inputInlineQueryResultArticle.Description != other.Description {
+		return false
+	}
+	if inputInlineQueryResultArticle.ThumbnailUrl != other.ThumbnailUrl {
+		return false
+	}
+	if inputInlineQueryResultArticle.ThumbnailWidth != other.ThumbnailWidth {
+		return false
+	}
+	if inputInlineQueryResultArticle.ThumbnailHeight != other.ThumbnailHeight {
+		return false
+	}
+	if !equalReplyMarkup(inputInlineQueryResultArticle.ReplyMarkup, other.ReplyMarkup) {
+		return false
+	}
+	if !equalInputMessageContent(inputInlineQueryResultArticle.InputMessageContent, other.InputMessageContent) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputInlineQueryResultArticle *InputInlineQueryResultArticle) Diff(other *InputInlineQueryResultArticle) []Change {
+	return inputInlineQueryResultArticle.diff("", other, nil)
+}
+
+func (inputInlineQueryResultArticle *InputInlineQueryResultArticle) diff(path string, other *InputInlineQueryResultArticle, changes []Change) []Change {
+	if inputInlineQueryResultArticle == nil || other == nil {
+		if inputInlineQueryResultArticle != other {
+			changes = append(changes, Change{Path: path, Old: inputInlineQueryResultArticle, New: other})
+		}
+		return changes
+	}
+	if inputInlineQueryResultArticle.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: inputInlineQueryResultArticle.Id, New: other.Id})
 	}
+	if inputInlineQueryResultArticle.Url != other.Url {
+		changes = append(changes, Change{Path: fieldPath(path, "url"), Old: inputInlineQueryResultArticle.Url, New: other.Url})
+	}
+	if inputInlineQueryResultArticle.HideUrl != other.HideUrl {
+		changes = append(changes, Change{Path: fieldPath(path, "hide_url"), Old: inputInlineQueryResultArticle.HideUrl, New: other.HideUrl})
+	}
+	if inputInlineQueryResultArticle.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: inputInlineQueryResultArticle.Title, New: other.Title})
+	}
+	if inputInlineQueryResultArticle.Description != other.Description {
+		changes = append(changes, Change{Path: fieldPath(path, "description"), Old: inputInlineQueryResultArticle.Description, New: other.Description})
+	}
+	if inputInlineQueryResultArticle.ThumbnailUrl != other.ThumbnailUrl {
+		changes = append(changes, Change{Path: fieldPath(path, "thumbnail_url"), Old: inputInlineQueryResultArticle.ThumbnailUrl, New: other.ThumbnailUrl})
+	}
+	if inputInlineQueryResultArticle.ThumbnailWidth != other.ThumbnailWidth {
+		changes = append(changes, Change{Path: fieldPath(path, "thumbnail_width"), Old: inputInlineQueryResultArticle.ThumbnailWidth, New: other.ThumbnailWidth})
+	}
+	if inputInlineQueryResultArticle.ThumbnailHeight != other.ThumbnailHeight {
+		changes = append(changes, Change{Path: fieldPath(path, "thumbnail_height"), Old: inputInlineQueryResultArticle.ThumbnailHeight, New: other.ThumbnailHeight})
+	}
+	changes = diffReplyMarkup(fieldPath(path, "reply_markup"), inputInlineQueryResultArticle.ReplyMarkup, other.ReplyMarkup, changes)
+	changes = diffInputMessageContent(fieldPath(path, "input_message_content"), inputInlineQueryResultArticle.InputMessageContent, other.InputMessageContent, changes)
+	return changes
+}
 
-	inputInlineQueryResultArticle.tdCommon = tempObj.tdCommon
-	inputInlineQueryResultArticle.Id = tempObj.Id
-	inputInlineQueryResultArticle.Url = tempObj.Url
-	inputInlineQueryResultArticle.HideUrl = tempObj.HideUrl
-	inputInlineQueryResultArticle.Title = tempObj.Title
-	inputInlineQueryResultArticle.Description = tempObj.Description
-	inputInlineQueryResultArticle.ThumbnailUrl = tempObj.ThumbnailUrl
-	inputInlineQueryResultArticle.ThumbnailWidth = tempObj.ThumbnailWidth
-	inputInlineQueryResultArticle.ThumbnailHeight = tempObj.ThumbnailHeight
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputInlineQueryResultArticle *InputInlineQueryResultArticle) String() string {
+	return objectString(inputInlineQueryResultArticle)
+}
 
-	fieldReplyMarkup, _ := unmarshalReplyMarkup(objMap["reply_markup"])
-	inputInlineQueryResultArticle.ReplyMarkup = fieldReplyMarkup
+// LogValue logs the object as its summary
+func (inputInlineQueryResultArticle *InputInlineQueryResultArticle) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputInlineQueryResultArticle))
+}
 
-	fieldInputMessageContent, _ := unmarshalInputMessageContent(objMap["input_message_content"])
-	inputInlineQueryResultArticle.InputMessageContent = fieldInputMessageContent
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputInlineQueryResultArticle *InputInlineQueryResultArticle) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputInlineQueryResultArticle)
+}
 
-	return nil
+func (inputInlineQueryResultArticle *InputInlineQueryResultArticle) printText(p *printer) {
+	if inputInlineQueryResultArticle == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputInlineQueryResultArticle")
+	p.string("id", inputInlineQueryResultArticle.Id)
+	p.string("url", inputInlineQueryResultArticle.Url)
+	p.bool("hide_url", inputInlineQueryResultArticle.HideUrl)
+	p.string("title", inputInlineQueryResultArticle.Title)
+	p.string("description", inputInlineQueryResultArticle.Description)
+	p.string("thumbnail_url", inputInlineQueryResultArticle.ThumbnailUrl)
+	p.int("thumbnail_width", int64(inputInlineQueryResultArticle.ThumbnailWidth))
+	p.int("thumbnail_height", int64(inputInlineQueryResultArticle.ThumbnailHeight))
+	p.object("reply_markup", inputInlineQueryResultArticle.ReplyMarkup)
+	p.object("input_message_content", inputInlineQueryResultArticle.InputMessageContent)
+	p.endObject(state)
 }
 
 // GetInputInlineQueryResultEnum return the enum type of this object
@@ -307,6 +692,11 @@ func (inputInlineQueryResultArticle *InputInlineQueryResultArticle) GetInputInli
 	return InputInlineQueryResultArticleType
 }
 
+// Accept calls the method of the visitor for InputInlineQueryResultArticle
+func (inputInlineQueryResultArticle *InputInlineQueryResultArticle) Accept(visitor InputInlineQueryResultVisitor) {
+	visitor.VisitInputInlineQueryResultArticle(inputInlineQueryResultArticle)
+}
+
 // InputInlineQueryResultAudio Represents a link to an MP3 audio file
 type InputInlineQueryResultAudio struct {
 	tdCommon
@@ -348,41 +738,177 @@ func NewInputInlineQueryResultAudio(id string, title string, performer string, a
 	return &inputInlineQueryResultAudioTemp
 }
 
+// MarshalJSON marshals to json
+func (inputInlineQueryResultAudio *InputInlineQueryResultAudio) MarshalJSON() ([]byte, error) {
+	return inputInlineQueryResultAudio.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputInlineQueryResultAudio *InputInlineQueryResultAudio) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, inputInlineQueryResultAudio)
+}
+
+func (inputInlineQueryResultAudio *InputInlineQueryResultAudio) appendJSON(b []byte) ([]byte, error) {
+	if inputInlineQueryResultAudio == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputInlineQueryResultAudio.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputInlineQueryResultAudio.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, inputInlineQueryResultAudio.Id)
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, inputInlineQueryResultAudio.Title)
+	b = append(b, `,"performer":`...)
+	b = appendJSONString(b, inputInlineQueryResultAudio.Performer)
+	b = append(b, `,"audio_url":`...)
+	b = appendJSONString(b, inputInlineQueryResultAudio.AudioUrl)
+	b = append(b, `,"audio_duration":`...)
+	b = appendJSONInt(b, int64(inputInlineQueryResultAudio.AudioDuration))
+	b = append(b, `,"reply_markup":`...)
+	b, err = appendJSONValue(b, inputInlineQueryResultAudio.ReplyMarkup)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Id            string `json:"id"`             // Unique identifier of the query result
-		Title         string `json:"title"`          // Title of the audio file
-		Performer     string `json:"performer"`      // Performer of the audio file
-		AudioUrl      string `json:"audio_url"`      // The URL of the audio file
-		AudioDuration int32  `json:"audio_duration"` // Audio file duration, in seconds
-
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"input_message_content":`...)
+	b, err = appendJSONValue(b, inputInlineQueryResultAudio.InputMessageContent)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inputInlineQueryResultAudio *InputInlineQueryResultAudio) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputInlineQueryResultAudio.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputInlineQueryResultAudio.tdCommon.Extra, err = r.readString()
+		case "id":
+			inputInlineQueryResultAudio.Id, err = r.readString()
+		case "title":
+			inputInlineQueryResultAudio.Title, err = r.readString()
+		case "performer":
+			inputInlineQueryResultAudio.Performer, err = r.readString()
+		case "audio_url":
+			inputInlineQueryResultAudio.AudioUrl, err = r.readString()
+		case "audio_duration":
+			inputInlineQueryResultAudio.AudioDuration, err = r.readInt32()
+		case "reply_markup":
+			inputInlineQueryResultAudio.ReplyMarkup, err = decodeReplyMarkup(r)
+		case "input_message_content":
+			inputInlineQueryResultAudio.InputMessageContent, err = decodeInputMessageContent(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputInlineQueryResultAudio *InputInlineQueryResultAudio) Clone() *InputInlineQueryResultAudio {
+	if inputInlineQueryResultAudio == nil {
+		return nil
+	}
+	clone := *inputInlineQueryResultAudio
+	clone.ReplyMarkup = cloneReplyMarkup(inputInlineQueryResultAudio.ReplyMarkup)
+	clone.InputMessageContent = cloneInputMessageContent(inputInlineQueryResultAudio.InputMessageContent)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputInlineQueryResultAudio *InputInlineQueryResultAudio) Equal(other *InputInlineQueryResultAudio) bool {
+	if inputInlineQueryResultAudio == nil || other == nil {
+		return inputInlineQueryResultAudio == other
+	}
+	if inputInlineQueryResultAudio.Id != other.Id {
+		return false
+	}
+	if inputInlineQueryResultAudio.Title != other.Title {
+		return false
 	}
+	if inputInlineQueryResultAudio.Performer != other.Performer {
+		return false
+	}
+	if inputInlineQueryResultAudio.AudioUrl != other.AudioUrl {
+		return false
+	}
+	if inputInlineQueryResultAudio.AudioDuration != other.AudioDuration {
+		return false
+	}
+	if !equalReplyMarkup(inputInlineQueryResultAudio.ReplyMarkup, other.ReplyMarkup) {
+		return false
+	}
+	if !equalInputMessageContent(inputInlineQueryResultAudio.InputMessageContent, other.InputMessageContent) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputInlineQueryResultAudio *InputInlineQueryResultAudio) Diff(other *InputInlineQueryResultAudio) []Change {
+	return inputInlineQueryResultAudio.diff("", other, nil)
+}
+
+func (inputInlineQueryResultAudio *InputInlineQueryResultAudio) diff(path string, other *InputInlineQueryResultAudio, changes []Change) []Change {
+	if inputInlineQueryResultAudio == nil || other == nil {
+		if inputInlineQueryResultAudio != other {
+			changes = append(changes, Change{Path: path, Old: inputInlineQueryResultAudio, New: other})
+		}
+		return changes
+	}
+	if inputInlineQueryResultAudio.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: inputInlineQueryResultAudio.Id, New: other.Id})
+	}
+	if inputInlineQueryResultAudio.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: inputInlineQueryResultAudio.Title, New: other.Title})
+	}
+	if inputInlineQueryResultAudio.Performer != other.Performer {
+		changes = append(changes, Change{Path: fieldPath(path, "performer"), Old: inputInlineQueryResultAudio.Performer, New: other.Performer})
+	}
+	if inputInlineQueryResultAudio.AudioUrl != other.AudioUrl {
+		changes = append(changes, Change{Path: fieldPath(path, "audio_url"), Old: inputInlineQueryResultAudio.AudioUrl, New: other.AudioUrl})
+	}
+	if inputInlineQueryResultAudio.AudioDuration != other.AudioDuration {
+		changes = append(changes, Change{Path: fieldPath(path, "audio_duration"), Old: inputInlineQueryResultAudio.AudioDuration, New: other.AudioDuration})
+	}
+	changes = diffReplyMarkup(fieldPath(path, "reply_markup"), inputInlineQueryResultAudio.ReplyMarkup, other.ReplyMarkup, changes)
+	changes = diffInputMessageContent(fieldPath(path, "input_message_content"), inputInlineQueryResultAudio.InputMessageContent, other.InputMessageContent, changes)
+	return changes
+}
 
-	inputInlineQueryResultAudio.tdCommon = tempObj.tdCommon
-	inputInlineQueryResultAudio.Id = tempObj.Id
-	inputInlineQueryResultAudio.Title = tempObj.Title
-	inputInlineQueryResultAudio.Performer = tempObj.Performer
-	inputInlineQueryResultAudio.AudioUrl = tempObj.AudioUrl
-	inputInlineQueryResultAudio.AudioDuration = tempObj.AudioDuration
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputInlineQueryResultAudio *InputInlineQueryResultAudio) String() string {
+	return objectString(inputInlineQueryResultAudio)
+}
 
-	fieldReplyMarkup, _ := unmarshalReplyMarkup(objMap["reply_markup"])
-	inputInlineQueryResultAudio.ReplyMarkup = fieldReplyMarkup
+// LogValue logs the object as its summary
+func (inputInlineQueryResultAudio *InputInlineQueryResultAudio) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputInlineQueryResultAudio))
+}
 
-	fieldInputMessageContent, _ := unmarshalInputMessageContent(objMap["input_message_content"])
-	inputInlineQueryResultAudio.InputMessageContent = fieldInputMessageContent
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputInlineQueryResultAudio *InputInlineQueryResultAudio) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputInlineQueryResultAudio)
+}
 
-	return nil
+func (inputInlineQueryResultAudio *InputInlineQueryResultAudio) printText(p *printer) {
+	if inputInlineQueryResultAudio == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputInlineQueryResultAudio")
+	p.string("id", inputInlineQueryResultAudio.Id)
+	p.string("title", inputInlineQueryResultAudio.Title)
+	p.string("performer", inputInlineQueryResultAudio.Performer)
+	p.string("audio_url", inputInlineQueryResultAudio.AudioUrl)
+	p.int("audio_duration", int64(inputInlineQueryResultAudio.AudioDuration))
+	p.object("reply_markup", inputInlineQueryResultAudio.ReplyMarkup)
+	p.object("input_message_content", inputInlineQueryResultAudio.InputMessageContent)
+	p.endObject(state)
 }
 
 // GetInputInlineQueryResultEnum return the enum type of this object
@@ -390,6 +916,11 @@ func (inputInlineQueryResultAudio *InputInlineQueryResultAudio) GetInputInlineQu
 	return InputInlineQueryResultAudioType
 }
 
+// Accept calls the method of the visitor for InputInlineQueryResultAudio
+func (inputInlineQueryResultAudio *InputInlineQueryResultAudio) Accept(visitor InputInlineQueryResultVisitor) {
+	visitor.VisitInputInlineQueryResultAudio(inputInlineQueryResultAudio)
+}
+
 // InputInlineQueryResultContact Represents a user contact
 type InputInlineQueryResultContact struct {
 	tdCommon
@@ -431,41 +962,184 @@ func NewInputInlineQueryResultContact(id string, contact *Contact, thumbnailUrl
 	return &inputInlineQueryResultContactTemp
 }
 
+// MarshalJSON marshals to json
+func (inputInlineQueryResultContact *InputInlineQueryResultContact) MarshalJSON() ([]byte, error) {
+	return inputInlineQueryResultContact.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputInlineQueryResultContact *InputInlineQueryResultContact) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, inputInlineQueryResultContact)
+}
+
+func (inputInlineQueryResultContact *InputInlineQueryResultContact) appendJSON(b []byte) ([]byte, error) {
+	if inputInlineQueryResultContact == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputInlineQueryResultContact.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputInlineQueryResultContact.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, inputInlineQueryResultContact.Id)
+	b = append(b, `,"contact":`...)
+	b, err = inputInlineQueryResultContact.Contact.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"thumbnail_url":`...)
+	b = appendJSONString(b, inputInlineQueryResultContact.ThumbnailUrl)
+	b = append(b, `,"thumbnail_width":`...)
+	b = appendJSONInt(b, int64(inputInlineQueryResultContact.ThumbnailWidth))
+	b = append(b, `,"thumbnail_height":`...)
+	b = appendJSONInt(b, int64(inputInlineQueryResultContact.ThumbnailHeight))
+	b = append(b, `,"reply_markup":`...)
+	b, err = appendJSONValue(b, inputInlineQueryResultContact.ReplyMarkup)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Id              string   `json:"id"`               // Unique identifier of the query result
-		Contact         *Contact `json:"contact"`          // User contact
-		ThumbnailUrl    string   `json:"thumbnail_url"`    // URL of the result thumbnail, if it exists
-		ThumbnailWidth  int32    `json:"thumbnail_width"`  // Thumbnail width, if known
-		ThumbnailHeight int32    `json:"thumbnail_height"` // Thumbnail height, if known
-
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"input_message_content":`...)
+	b, err = appendJSONValue(b, inputInlineQueryResultContact.InputMessageContent)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inputInlineQueryResultContact *InputInlineQueryResultContact) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputInlineQueryResultContact.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputInlineQueryResultContact.tdCommon.Extra, err = r.readString()
+		case "id":
+			inputInlineQueryResultContact.Id, err = r.readString()
+		case "contact":
+			if r.readNull() {
+				inputInlineQueryResultContact.Contact = nil
+			} else {
+				inputInlineQueryResultContact.Contact = new(Contact)
+				err = inputInlineQueryResultContact.Contact.decodeJSON(r)
+			}
+		case "thumbnail_url":
+			inputInlineQueryResultContact.ThumbnailUrl, err = r.readString()
+		case "thumbnail_width":
+			inputInlineQueryResultContact.ThumbnailWidth, err = r.readInt32()
+		case "thumbnail_height":
+			inputInlineQueryResultContact.ThumbnailHeight, err = r.readInt32()
+		case "reply_markup":
+			inputInlineQueryResultContact.ReplyMarkup, err = decodeReplyMarkup(r)
+		case "input_message_content":
+			inputInlineQueryResultContact.InputMessageContent, err = decodeInputMessageContent(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputInlineQueryResultContact *InputInlineQueryResultContact) Clone() *InputInlineQueryResultContact {
+	if inputInlineQueryResultContact == nil {
+		return nil
+	}
+	clone := *inputInlineQueryResultContact
+	clone.Contact = inputInlineQueryResultContact.Contact.Clone()
+	clone.ReplyMarkup = cloneReplyMarkup(inputInlineQueryResultContact.ReplyMarkup)
+	clone.InputMessageContent = cloneInputMessageContent(inputInlineQueryResultContact.InputMessageContent)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputInlineQueryResultContact *InputInlineQueryResultContact) Equal(other *InputInlineQueryResultContact) bool {
+	if inputInlineQueryResultContact == nil || other == nil {
+		return inputInlineQueryResultContact == other
+	}
+	if inputInlineQueryResultContact.Id != other.Id {
+		return false
+	}
+	if !inputInlineQueryResultContact.Contact.Equal(other.Contact) {
+		return false
+	}
+	if inputInlineQueryResultContact.ThumbnailUrl != other.ThumbnailUrl {
+		return false
+	}
+	if inputInlineQueryResultContact.ThumbnailWidth != other.ThumbnailWidth {
+		return false
+	}
+	if inputInlineQueryResultContact.ThumbnailHeight != other.ThumbnailHeight {
+		return false
+	}
+	if !equalReplyMarkup(inputInlineQueryResultContact.ReplyMarkup, other.ReplyMarkup) {
+		return false
+	}
+	if !equalInputMessageContent(inputInlineQueryResultContact.InputMessageContent, other.InputMessageContent) {
+		return false
 	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputInlineQueryResultContact *InputInlineQueryResultContact) Diff(other *InputInlineQueryResultContact) []Change {
+	return inputInlineQueryResultContact.diff("", other, nil)
+}
+
+func (inputInlineQueryResultContact *InputInlineQueryResultContact) diff(path string, other *InputInlineQueryResultContact, changes []Change) []Change {
+	if inputInlineQueryResultContact == nil || other == nil {
+		if inputInlineQueryResultContact != other {
+			changes = append(changes, Change{Path: path, Old: inputInlineQueryResultContact, New: other})
+		}
+		return changes
+	}
+	if inputInlineQueryResultContact.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: inputInlineQueryResultContact.Id, New: other.Id})
+	}
+	changes = inputInlineQueryResultContact.Contact.diff(fieldPath(path, "contact"), other.Contact, changes)
+	if inputInlineQueryResultContact.ThumbnailUrl != other.ThumbnailUrl {
+		changes = append(changes, Change{Path: fieldPath(path, "thumbnail_url"), Old: inputInlineQueryResultContact.ThumbnailUrl, New: other.ThumbnailUrl})
+	}
+	if inputInlineQueryResultContact.ThumbnailWidth != other.ThumbnailWidth {
+		changes = append(changes, Change{Path: fieldPath(path, "thumbnail_width"), Old: inputInlineQueryResultContact.ThumbnailWidth, New: other.ThumbnailWidth})
+	}
+	if inputInlineQueryResultContact.ThumbnailHeight != other.ThumbnailHeight {
+		changes = append(changes, Change{Path: fieldPath(path, "thumbnail_height"), Old: inputInlineQueryResultContact.ThumbnailHeight, New: other.ThumbnailHeight})
+	}
+	changes = diffReplyMarkup(fieldPath(path, "reply_markup"), inputInlineQueryResultContact.ReplyMarkup, other.ReplyMarkup, changes)
+	changes = diffInputMessageContent(fieldPath(path, "input_message_content"), inputInlineQueryResultContact.InputMessageContent, other.InputMessageContent, changes)
+	return changes
+}
 
-	inputInlineQueryResultContact.tdCommon = tempObj.tdCommon
-	inputInlineQueryResultContact.Id = tempObj.Id
-	inputInlineQueryResultContact.Contact = tempObj.Contact
-	inputInlineQueryResultContact.ThumbnailUrl = tempObj.ThumbnailUrl
-	inputInlineQueryResultContact.ThumbnailWidth = tempObj.ThumbnailWidth
-	inputInlineQueryResultContact.ThumbnailHeight = tempObj.ThumbnailHeight
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputInlineQueryResultContact *InputInlineQueryResultContact) String() string {
+	return objectString(inputInlineQueryResultContact)
+}
 
-	fieldReplyMarkup, _ := unmarshalReplyMarkup(objMap["reply_markup"])
-	inputInlineQueryResultContact.ReplyMarkup = fieldReplyMarkup
+// LogValue logs the object as its summary
+func (inputInlineQueryResultContact *InputInlineQueryResultContact) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputInlineQueryResultContact))
+}
 
-	fieldInputMessageContent, _ := unmarshalInputMessageContent(objMap["input_message_content"])
-	inputInlineQueryResultContact.InputMessageContent = fieldInputMessageContent
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputInlineQueryResultContact *InputInlineQueryResultContact) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputInlineQueryResultContact)
+}
 
-	return nil
+func (inputInlineQueryResultContact *InputInlineQueryResultContact) printText(p *printer) {
+	if inputInlineQueryResultContact == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputInlineQueryResultContact")
+	p.string("id", inputInlineQueryResultContact.Id)
+	p.object("contact", inputInlineQueryResultContact.Contact)
+	p.string("thumbnail_url", inputInlineQueryResultContact.ThumbnailUrl)
+	p.int("thumbnail_width", int64(inputInlineQueryResultContact.ThumbnailWidth))
+	p.int("thumbnail_height", int64(inputInlineQueryResultContact.ThumbnailHeight))
+	p.object("reply_markup", inputInlineQueryResultContact.ReplyMarkup)
+	p.object("input_message_content", inputInlineQueryResultContact.InputMessageContent)
+	p.endObject(state)
 }
 
 // GetInputInlineQueryResultEnum return the enum type of this object
@@ -473,6 +1147,11 @@ func (inputInlineQueryResultContact *InputInlineQueryResultContact) GetInputInli
 	return InputInlineQueryResultContactType
 }
 
+// Accept calls the method of the visitor for InputInlineQueryResultContact
+func (inputInlineQueryResultContact *InputInlineQueryResultContact) Accept(visitor InputInlineQueryResultVisitor) {
+	visitor.VisitInputInlineQueryResultContact(inputInlineQueryResultContact)
+}
+
 // InputInlineQueryResultDocument Represents a link to a file
 type InputInlineQueryResultDocument struct {
 	tdCommon
@@ -523,47 +1202,210 @@ func NewInputInlineQueryResultDocument(id string, title string, description stri
 	return &inputInlineQueryResultDocumentTemp
 }
 
+// MarshalJSON marshals to json
+func (inputInlineQueryResultDocument *InputInlineQueryResultDocument) MarshalJSON() ([]byte, error) {
+	return inputInlineQueryResultDocument.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputInlineQueryResultDocument *InputInlineQueryResultDocument) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, inputInlineQueryResultDocument)
+}
+
+func (inputInlineQueryResultDocument *InputInlineQueryResultDocument) appendJSON(b []byte) ([]byte, error) {
+	if inputInlineQueryResultDocument == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputInlineQueryResultDocument.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputInlineQueryResultDocument.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, inputInlineQueryResultDocument.Id)
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, inputInlineQueryResultDocument.Title)
+	b = append(b, `,"description":`...)
+	b = appendJSONString(b, inputInlineQueryResultDocument.Description)
+	b = append(b, `,"document_url":`...)
+	b = appendJSONString(b, inputInlineQueryResultDocument.DocumentUrl)
+	b = append(b, `,"mime_type":`...)
+	b = appendJSONString(b, inputInlineQueryResultDocument.MimeType)
+	b = append(b, `,"thumbnail_url":`...)
+	b = appendJSONString(b, inputInlineQueryResultDocument.ThumbnailUrl)
+	b = append(b, `,"thumbnail_width":`...)
+	b = appendJSONInt(b, int64(inputInlineQueryResultDocument.ThumbnailWidth))
+	b = append(b, `,"thumbnail_height":`...)
+	b = appendJSONInt(b, int64(inputInlineQueryResultDocument.ThumbnailHeight))
+	b = append(b, `,"reply_markup":`...)
+	b, err = appendJSONValue(b, inputInlineQueryResultDocument.ReplyMarkup)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Id              string `json:"id"`               // Unique identifier of the query result
-		Title           string `json:"title"`            // Title of the resulting file
-		Description     string `json:"description"`      // Short description of the result, if known
-		DocumentUrl     string `json:"document_url"`     // URL of the file
-		MimeType        string `json:"mime_type"`        // MIME type of the file content; only "application/pdf" and "application/zip" are currently allowed
-		ThumbnailUrl    string `json:"thumbnail_url"`    // The URL of the file thumbnail, if it exists
-		ThumbnailWidth  int32  `json:"thumbnail_width"`  // Width of the thumbnail
-		ThumbnailHeight int32  `json:"thumbnail_height"` // Height of the thumbnail
-
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"input_message_content":`...)
+	b, err = appendJSONValue(b, inputInlineQueryResultDocument.InputMessageContent)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inputInlineQueryResultDocument *InputInlineQueryResultDocument) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputInlineQueryResultDocument.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputInlineQueryResultDocument.tdCommon.Extra, err = r.readString()
+		case "id":
+			inputInlineQueryResultDocument.Id, err = r.readString()
+		case "title":
+			inputInlineQueryResultDocument.Title, err = r.readString()
+		case "description":
+			inputInlineQueryResultDocument.Description, err = r.readString()
+		case "document_url":
+			inputInlineQueryResultDocument.DocumentUrl, err = r.readString()
+		case "mime_type":
+			inputInlineQueryResultDocument.MimeType, err = r.readString()
+		case "thumbnail_url":
+			inputInlineQueryResultDocument.ThumbnailUrl, err = r.readString()
+		case "thumbnail_width":
+			inputInlineQueryResultDocument.ThumbnailWidth, err = r.readInt32()
+		case "thumbnail_height":
+			inputInlineQueryResultDocument.ThumbnailHeight, err = r.readInt32()
+		case "reply_markup":
+			inputInlineQueryResultDocument.ReplyMarkup, err = decodeReplyMarkup(r)
+		case "input_message_content":
+			inputInlineQueryResultDocument.InputMessageContent, err = decodeInputMessageContent(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputInlineQueryResultDocument *InputInlineQueryResultDocument) Clone() *InputInlineQueryResultDocument {
+	if inputInlineQueryResultDocument == nil {
+		return nil
+	}
+	clone := *inputInlineQueryResultDocument
+	clone.ReplyMarkup = cloneReplyMarkup(inputInlineQueryResultDocument.ReplyMarkup)
+	clone.InputMessageContent = cloneInputMessageContent(inputInlineQueryResultDocument.InputMessageContent)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputInlineQueryResultDocument *InputInlineQueryResultDocument) Equal(other *InputInlineQueryResultDocument) bool {
+	if inputInlineQueryResultDocument == nil || other == nil {
+		return inputInlineQueryResultDocument == other
+	}
+	if inputInlineQueryResultDocument.Id != other.Id {
+		return false
+	}
+	if inputInlineQueryResultDocument.Title != other.Title {
+		return false
+	}
+	if inputInlineQueryResultDocument.Description != other.Description {
+		return false
+	}
+	if inputInlineQueryResultDocument.DocumentUrl != other.DocumentUrl {
+		return false
+	}
+	if inputInlineQueryResultDocument.MimeType != other.MimeType {
+		return false
+	}
+	if inputInlineQueryResultDocument.ThumbnailUrl != other.ThumbnailUrl {
+		return false
+	}
+	if inputInlineQueryResultDocument.ThumbnailWidth != other.ThumbnailWidth {
+		return false
+	}
+	if inputInlineQueryResultDocument.ThumbnailHeight != other.ThumbnailHeight {
+		return false
+	}
+	if !equalReplyMarkup(inputInlineQueryResultDocument.ReplyMarkup, other.ReplyMarkup) {
+		return false
+	}
+	if !equalInputMessageContent(inputInlineQueryResultDocument.InputMessageContent, other.InputMessageContent) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputInlineQueryResultDocument *InputInlineQueryResultDocument) Diff(other *InputInlineQueryResultDocument) []Change {
+	return inputInlineQueryResultDocument.diff("", other, nil)
+}
+
+func (inputInlineQueryResultDocument *InputInlineQueryResultDocument) diff(path string, other *InputInlineQueryResultDocument, changes []Change) []Change {
+	if inputInlineQueryResultDocument == nil || other == nil {
+		if inputInlineQueryResultDocument != other {
+			changes = append(changes, Change{Path: path, Old: inputInlineQueryResultDocument, New: other})
+		}
+		return changes
+	}
+	if inputInlineQueryResultDocument.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: inputInlineQueryResultDocument.Id, New: other.Id})
 	}
+	if inputInlineQueryResultDocument.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: inputInlineQueryResultDocument.Title, New: other.Title})
+	}
+	if inputInlineQueryResultDocument.Description != other.Description {
+		changes = append(changes, Change{Path: fieldPath(path, "description"), Old: inputInlineQueryResultDocument.Description, New: other.Description})
+	}
+	if inputInlineQueryResultDocument.DocumentUrl != other.DocumentUrl {
+		changes = append(changes, Change{Path: fieldPath(path, "document_url"), Old: inputInlineQueryResultDocument.DocumentUrl, New: other.DocumentUrl})
+	}
+	if inputInlineQueryResultDocument.MimeType != other.MimeType {
+		changes = append(changes, Change{Path: fieldPath(path, "mime_type"), Old: inputInlineQueryResultDocument.MimeType, New: other.MimeType})
+	}
+	if inputInlineQueryResultDocument.ThumbnailUrl != other.ThumbnailUrl {
+		changes = append(changes, Change{Path: fieldPath(path, "thumbnail_url"), Old: inputInlineQueryResultDocument.ThumbnailUrl, New: other.ThumbnailUrl})
+	}
+	if inputInlineQueryResultDocument.ThumbnailWidth != other.ThumbnailWidth {
+		changes = append(changes, Change{Path: fieldPath(path, "thumbnail_width"), Old: inputInlineQueryResultDocument.ThumbnailWidth, New: other.ThumbnailWidth})
+	}
+	if inputInlineQueryResultDocument.ThumbnailHeight != other.ThumbnailHeight {
+		changes = append(changes, Change{Path: fieldPath(path, "thumbnail_height"), Old: inputInlineQueryResultDocument.ThumbnailHeight, New: other.ThumbnailHeight})
+	}
+	changes = diffReplyMarkup(fieldPath(path, "reply_markup"), inputInlineQueryResultDocument.ReplyMarkup, other.ReplyMarkup, changes)
+	changes = diffInputMessageContent(fieldPath(path, "input_message_content"), inputInlineQueryResultDocument.InputMessageContent, other.InputMessageContent, changes)
+	return changes
+}
 
-	inputInlineQueryResultDocument.tdCommon = tempObj.tdCommon
-	inputInlineQueryResultDocument.Id = tempObj.Id
-	inputInlineQueryResultDocument.Title = tempObj.Title
-	inputInlineQueryResultDocument.Description = tempObj.Description
-	inputInlineQueryResultDocument.DocumentUrl = tempObj.DocumentUrl
-	inputInlineQueryResultDocument.MimeType = tempObj.MimeType
-	inputInlineQueryResultDocument.ThumbnailUrl = tempObj.ThumbnailUrl
-	inputInlineQueryResultDocument.ThumbnailWidth = tempObj.ThumbnailWidth
-	inputInlineQueryResultDocument.ThumbnailHeight = tempObj.ThumbnailHeight
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputInlineQueryResultDocument *InputInlineQueryResultDocument) String() string {
+	return objectString(inputInlineQueryResultDocument)
+}
 
-	fieldReplyMarkup, _ := unmarshalReplyMarkup(objMap["reply_markup"])
-	inputInlineQueryResultDocument.ReplyMarkup = fieldReplyMarkup
+// LogValue logs the object as its summary
+func (inputInlineQueryResultDocument *InputInlineQueryResultDocument) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputInlineQueryResultDocument))
+}
 
-	fieldInputMessageContent, _ := unmarshalInputMessageContent(objMap["input_message_content"])
-	inputInlineQueryResultDocument.InputMessageContent = fieldInputMessageContent
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputInlineQueryResultDocument *InputInlineQueryResultDocument) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputInlineQueryResultDocument)
+}
 
-	return nil
+func (inputInlineQueryResultDocument *InputInlineQueryResultDocument) printText(p *printer) {
+	if inputInlineQueryResultDocument == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputInlineQueryResultDocument")
+	p.string("id", inputInlineQueryResultDocument.Id)
+	p.string("title", inputInlineQueryResultDocument.Title)
+	p.string("description", inputInlineQueryResultDocument.Description)
+	p.string("document_url", inputInlineQueryResultDocument.DocumentUrl)
+	p.string("mime_type", inputInlineQueryResultDocument.MimeType)
+	p.string("thumbnail_url", inputInlineQueryResultDocument.ThumbnailUrl)
+	p.int("thumbnail_width", int64(inputInlineQueryResultDocument.ThumbnailWidth))
+	p.int("thumbnail_height", int64(inputInlineQueryResultDocument.ThumbnailHeight))
+	p.object("reply_markup", inputInlineQueryResultDocument.ReplyMarkup)
+	p.object("input_message_content", inputInlineQueryResultDocument.InputMessageContent)
+	p.endObject(state)
 }
 
 // GetInputInlineQueryResultEnum return the enum type of this object
@@ -571,6 +1413,11 @@ func (inputInlineQueryResultDocument *InputInlineQueryResultDocument) GetInputIn
 	return InputInlineQueryResultDocumentType
 }
 
+// Accept calls the method of the visitor for InputInlineQueryResultDocument
+func (inputInlineQueryResultDocument *InputInlineQueryResultDocument) Accept(visitor InputInlineQueryResultVisitor) {
+	visitor.VisitInputInlineQueryResultDocument(inputInlineQueryResultDocument)
+}
+
 // InputInlineQueryResultGame Represents a game
 type InputInlineQueryResultGame struct {
 	tdCommon
@@ -600,37 +1447,141 @@ func NewInputInlineQueryResultGame(id string, gameShortName string, replyMarkup
 	return &inputInlineQueryResultGameTemp
 }
 
+// MarshalJSON marshals to json
+func (inputInlineQueryResultGame *InputInlineQueryResultGame) MarshalJSON() ([]byte, error) {
+	return inputInlineQueryResultGame.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputInlineQueryResultGame *InputInlineQueryResultGame) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Id            string `json:"id"`              // Unique identifier of the query result
-		GameShortName string `json:"game_short_name"` // Short name of the game
+	return unmarshalFast(b, inputInlineQueryResultGame)
+}
 
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+func (inputInlineQueryResultGame *InputInlineQueryResultGame) appendJSON(b []byte) ([]byte, error) {
+	if inputInlineQueryResultGame == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputInlineQueryResultGame.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputInlineQueryResultGame.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, inputInlineQueryResultGame.Id)
+	b = append(b, `,"game_short_name":`...)
+	b = appendJSONString(b, inputInlineQueryResultGame.GameShortName)
+	b = append(b, `,"reply_markup":`...)
+	b, err = appendJSONValue(b, inputInlineQueryResultGame.ReplyMarkup)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return append(b, '}'), nil
+}
 
-	inputInlineQueryResultGame.tdCommon = tempObj.tdCommon
-	inputInlineQueryResultGame.Id = tempObj.Id
-	inputInlineQueryResultGame.GameShortName = tempObj.GameShortName
+func (inputInlineQueryResultGame *InputInlineQueryResultGame) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputInlineQueryResultGame.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputInlineQueryResultGame.tdCommon.Extra, err = r.readString()
+		case "id":
+			inputInlineQueryResultGame.Id, err = r.readString()
+		case "game_short_name":
+			inputInlineQueryResultGame.GameShortName, err = r.readString()
+		case "reply_markup":
+			inputInlineQueryResultGame.ReplyMarkup, err = decodeReplyMarkup(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	fieldReplyMarkup, _ := unmarshalReplyMarkup(objMap["reply_markup"])
-	inputInlineQueryResultGame.ReplyMarkup = fieldReplyMarkup
+// Clone returns a deep copy of the object
+func (inputInlineQueryResultGame *InputInlineQueryResultGame) Clone() *InputInlineQueryResultGame {
+	if inputInlineQueryResultGame == nil {
+		return nil
+	}
+	clone := *inputInlineQueryResultGame
+	clone.ReplyMarkup = cloneReplyMarkup(inputInlineQueryResultGame.ReplyMarkup)
+	return &clone
+}
 
-	return nil
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputInlineQueryResultGame *InputInlineQueryResultGame) Equal(other *InputInlineQueryResultGame) bool {
+	if inputInlineQueryResultGame == nil || other == nil {
+		return inputInlineQueryResultGame == other
+	}
+	if inputInlineQueryResultGame.Id != other.Id {
+		return false
+	}
+	if inputInlineQueryResultGame.GameShortName != other.GameShortName {
+		return false
+	}
+	if !equalReplyMarkup(inputInlineQueryResultGame.ReplyMarkup, other.ReplyMarkup) {
+		return false
+	}
+	return true
 }
 
-// GetInputInlineQueryResultEnum return the enum type of this object
-func (inputInlineQueryResultGame *InputInlineQueryResultGame) GetInputInlineQueryResultEnum() InputInlineQueryResultEnum {
-	return InputInlineQueryResultGameType
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputInlineQueryResultGame *InputInlineQueryResultGame) Diff(other *InputInlineQueryResultGame) []Change {
+	return inputInlineQueryResultGame.diff("", other, nil)
+}
+
+func (inputInlineQueryResultGame *InputInlineQueryResultGame) diff(path string, other *InputInlineQueryResultGame, changes []Change) []Change {
+	if inputInlineQueryResultGame == nil || other == nil {
+		if inputInlineQueryResultGame != other {
+			changes = append(changes, Change{Path: path, Old: inputInlineQueryResultGame, New: other})
+		}
+		return changes
+	}
+	if inputInlineQueryResultGame.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: inputInlineQueryResultGame.Id, New: other.Id})
+	}
+	if inputInlineQueryResultGame.GameShortName != other.GameShortName {
+		changes = append(changes, Change{Path: fieldPath(path, "game_short_name"), Old: inputInlineQueryResultGame.GameShortName, New: other.GameShortName})
+	}
+	changes = diffReplyMarkup(fieldPath(path, "reply_markup"), inputInlineQueryResultGame.ReplyMarkup, other.ReplyMarkup, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputInlineQueryResultGame *InputInlineQueryResultGame) String() string {
+	return objectString(inputInlineQueryResultGame)
+}
+
+// LogValue logs the object as its summary
+func (inputInlineQueryResultGame *InputInlineQueryResultGame) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputInlineQueryResultGame))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputInlineQueryResultGame *InputInlineQueryResultGame) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputInlineQueryResultGame)
+}
+
+func (inputInlineQueryResultGame *InputInlineQueryResultGame) printText(p *printer) {
+	if inputInlineQueryResultGame == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputInlineQueryResultGame")
+	p.string("id", inputInlineQueryResultGame.Id)
+	p.string("game_short_name", inputInlineQueryResultGame.GameShortName)
+	p.object("reply_markup", inputInlineQueryResultGame.ReplyMarkup)
+	p.endObject(state)
+}
+
+// GetInputInlineQueryResultEnum return the enum type of this object
+func (inputInlineQueryResultGame *InputInlineQueryResultGame) GetInputInlineQueryResultEnum() InputInlineQueryResultEnum {
+	return InputInlineQueryResultGameType
+}
+
+// Accept calls the method of the visitor for InputInlineQueryResultGame
+func (inputInlineQueryResultGame *InputInlineQueryResultGame) Accept(visitor InputInlineQueryResultVisitor) {
+	visitor.VisitInputInlineQueryResultGame(inputInlineQueryResultGame)
 }
 
 // InputInlineQueryResultLocation Represents a point on the map
@@ -680,45 +1631,206 @@ func NewInputInlineQueryResultLocation(id string, location *Location, livePeriod
 	return &inputInlineQueryResultLocationTemp
 }
 
+// MarshalJSON marshals to json
+func (inputInlineQueryResultLocation *InputInlineQueryResultLocation) MarshalJSON() ([]byte, error) {
+	return inputInlineQueryResultLocation.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputInlineQueryResultLocation *InputInlineQueryResultLocation) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, inputInlineQueryResultLocation)
+}
+
+func (inputInlineQueryResultLocation *InputInlineQueryResultLocation) appendJSON(b []byte) ([]byte, error) {
+	if inputInlineQueryResultLocation == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputInlineQueryResultLocation.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputInlineQueryResultLocation.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, inputInlineQueryResultLocation.Id)
+	b = append(b, `,"location":`...)
+	b, err = inputInlineQueryResultLocation.Location.appendJSON(b)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Id              string    `json:"id"`               // Unique identifier of the query result
-		Location        *Location `json:"location"`         // Location result
-		LivePeriod      int32     `json:"live_period"`      // Amount of time relative to the message sent time until the location can be updated, in seconds
-		Title           string    `json:"title"`            // Title of the result
-		ThumbnailUrl    string    `json:"thumbnail_url"`    // URL of the result thumbnail, if it exists
-		ThumbnailWidth  int32     `json:"thumbnail_width"`  // Thumbnail width, if known
-		ThumbnailHeight int32     `json:"thumbnail_height"` // Thumbnail height, if known
-
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"live_period":`...)
+	b = appendJSONInt(b, int64(inputInlineQueryResultLocation.LivePeriod))
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, inputInlineQueryResultLocation.Title)
+	b = append(b, `,"thumbnail_url":`...)
+	b = appendJSONString(b, inputInlineQueryResultLocation.ThumbnailUrl)
+	b = append(b, `,"thumbnail_width":`...)
+	b = appendJSONInt(b, int64(inputInlineQueryResultLocation.ThumbnailWidth))
+	b = append(b, `,"thumbnail_height":`...)
+	b = appendJSONInt(b, int64(inputInlineQueryResultLocation.ThumbnailHeight))
+	b = append(b, `,"reply_markup":`...)
+	b, err = appendJSONValue(b, inputInlineQueryResultLocation.ReplyMarkup)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"input_message_content":`...)
+	b, err = appendJSONValue(b, inputInlineQueryResultLocation.InputMessageContent)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inputInlineQueryResultLocation *InputInlineQueryResultLocation) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputInlineQueryResultLocation.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputInlineQueryResultLocation.tdCommon.Extra, err = r.readString()
+		case "id":
+			inputInlineQueryResultLocation.Id, err = r.readString()
+		case "location":
+			if r.readNull() {
+				inputInlineQueryResultLocation.Location = nil
+			} else {
+				inputInlineQueryResultLocation.Location = new(Location)
+				err = inputInlineQueryResultLocation.Location.decodeJSON(r)
+			}
+		case "live_period":
+			inputInlineQueryResultLocation.LivePeriod, err = r.readInt32()
+		case "title":
+			inputInlineQueryResultLocation.Title, err = r.readString()
+		case "thumbnail_url":
+			inputInlineQueryResultLocation.ThumbnailUrl, err = r.readString()
+		case "thumbnail_width":
+			inputInlineQueryResultLocation.ThumbnailWidth, err = r.readInt32()
+		case "thumbnail_height":
+			inputInlineQueryResultLocation.ThumbnailHeight, err = r.readInt32()
+		case "reply_markup":
+			inputInlineQueryResultLocation.ReplyMarkup, err = decodeReplyMarkup(r)
+		case "input_message_content":
+			inputInlineQueryResultLocation.InputMessageContent, err = decodeInputMessageContent(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputInlineQueryResultLocation *InputInlineQueryResultLocation) Clone() *InputInlineQueryResultLocation {
+	if inputInlineQueryResultLocation == nil {
+		return nil
+	}
+	clone := *inputInlineQueryResultLocation
+	clone.Location = inputInlineQueryResultLocation.Location.Clone()
+	clone.ReplyMarkup = cloneReplyMarkup(inputInlineQueryResultLocation.ReplyMarkup)
+	clone.InputMessageContent = cloneInputMessageContent(inputInlineQueryResultLocation.InputMessageContent)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputInlineQueryResultLocation *InputInlineQueryResultLocation) Equal(other *InputInlineQueryResultLocation) bool {
+	if inputInlineQueryResultLocation == nil || other == nil {
+		return inputInlineQueryResultLocation == other
+	}
+	if inputInlineQueryResultLocation.Id != other.Id {
+		return false
+	}
+	if !inputInlineQueryResultLocation.Location.Equal(other.Location) {
+		return false
+	}
+	if inputInlineQueryResultLocation.LivePeriod != other.LivePeriod {
+		return false
+	}
+	if inputInlineQueryResultLocation.Title != other.Title {
+		return false
+	}
+	if inputInlineQueryResultLocation.ThumbnailUrl != other.ThumbnailUrl {
+		return false
+	}
+	if inputInlineQueryResultLocation.ThumbnailWidth != other.ThumbnailWidth {
+		return false
+	}
+	if inputInlineQueryResultLocation.ThumbnailHeight != other.ThumbnailHeight {
+		return false
+	}
+	if !equalReplyMarkup(inputInlineQueryResultLocation.ReplyMarkup, other.ReplyMarkup) {
+		return false
+	}
+	if !equalInputMessageContent(inputInlineQueryResultLocation.InputMessageContent, other.InputMessageContent) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputInlineQueryResultLocation *InputInlineQueryResultLocation) Diff(other *InputInlineQueryResultLocation) []Change {
+	return inputInlineQueryResultLocation.diff("", other, nil)
+}
+
+func (inputInlineQueryResultLocation *InputInlineQueryResultLocation) diff(path string, other *InputInlineQueryResultLocation, changes []Change) []Change {
+	if inputInlineQueryResultLocation == nil || other == nil {
+		if inputInlineQueryResultLocation != other {
+			changes = append(changes, Change{Path: path, Old: inputInlineQueryResultLocation, New: other})
+		}
+		return changes
+	}
+	if inputInlineQueryResultLocation.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: inputInlineQueryResultLocation.Id, New: other.Id})
+	}
+	changes = inputInlineQueryResultLocation.Location.diff(fieldPath(path, "location"), other.Location, changes)
+	if inputInlineQueryResultLocation.LivePeriod != other.LivePeriod {
+		changes = append(changes, Change{Path: fieldPath(path, "live_period"), Old: inputInlineQueryResultLocation.LivePeriod, New: other.LivePeriod})
+	}
+	if inputInlineQueryResultLocation.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: inputInlineQueryResultLocation.Title, New: other.Title})
 	}
+	if inputInlineQueryResultLocation.ThumbnailUrl != other.ThumbnailUrl {
+		changes = append(changes, Change{Path: fieldPath(path, "thumbnail_url"), Old: inputInlineQueryResultLocation.ThumbnailUrl, New: other.ThumbnailUrl})
+	}
+	if inputInlineQueryResultLocation.ThumbnailWidth != other.ThumbnailWidth {
+		changes = append(changes, Change{Path: fieldPath(path, "thumbnail_width"), Old: inputInlineQueryResultLocation.ThumbnailWidth, New: other.ThumbnailWidth})
+	}
+	if inputInlineQueryResultLocation.ThumbnailHeight != other.ThumbnailHeight {
+		changes = append(changes, Change{Path: fieldPath(path, "thumbnail_height"), Old: inputInlineQueryResultLocation.ThumbnailHeight, New: other.ThumbnailHeight})
+	}
+	changes = diffReplyMarkup(fieldPath(path, "reply_markup"), inputInlineQueryResultLocation.ReplyMarkup, other.ReplyMarkup, changes)
+	changes = diffInputMessageContent(fieldPath(path, "input_message_content"), inputInlineQueryResultLocation.InputMessageContent, other.InputMessageContent, changes)
+	return changes
+}
 
-	inputInlineQueryResultLocation.tdCommon = tempObj.tdCommon
-	inputInlineQueryResultLocation.Id = tempObj.Id
-	inputInlineQueryResultLocation.Location = tempObj.Location
-	inputInlineQueryResultLocation.LivePeriod = tempObj.LivePeriod
-	inputInlineQueryResultLocation.Title = tempObj.Title
-	inputInlineQueryResultLocation.ThumbnailUrl = tempObj.ThumbnailUrl
-	inputInlineQueryResultLocation.ThumbnailWidth = tempObj.ThumbnailWidth
-	inputInlineQueryResultLocation.ThumbnailHeight = tempObj.ThumbnailHeight
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputInlineQueryResultLocation *InputInlineQueryResultLocation) String() string {
+	return objectString(inputInlineQueryResultLocation)
+}
 
-	fieldReplyMarkup, _ := unmarshalReplyMarkup(objMap["reply_markup"])
-	inputInlineQueryResultLocation.ReplyMarkup = fieldReplyMarkup
+// LogValue logs the object as its summary
+func (inputInlineQueryResultLocation *InputInlineQueryResultLocation) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputInlineQueryResultLocation))
+}
 
-	fieldInputMessageContent, _ := unmarshalInputMessageContent(objMap["input_message_content"])
-	inputInlineQueryResultLocation.InputMessageContent = fieldInputMessageContent
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputInlineQueryResultLocation *InputInlineQueryResultLocation) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputInlineQueryResultLocation)
+}
 
-	return nil
+func (inputInlineQueryResultLocation *InputInlineQueryResultLocation) printText(p *printer) {
+	if inputInlineQueryResultLocation == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputInlineQueryResultLocation")
+	p.string("id", inputInlineQueryResultLocation.Id)
+	p.object("location", inputInlineQueryResultLocation.Location)
+	p.int("live_period", int64(inputInlineQueryResultLocation.LivePeriod))
+	p.string("title", inputInlineQueryResultLocation.Title)
+	p.string("thumbnail_url", inputInlineQueryResultLocation.ThumbnailUrl)
+	p.int("thumbnail_width", int64(inputInlineQueryResultLocation.ThumbnailWidth))
+	p.int("thumbnail_height", int64(inputInlineQueryResultLocation.ThumbnailHeight))
+	p.object("reply_markup", inputInlineQueryResultLocation.ReplyMarkup)
+	p.object("input_message_content", inputInlineQueryResultLocation.InputMessageContent)
+	p.endObject(state)
 }
 
 // GetInputInlineQueryResultEnum return the enum type of this object
@@ -726,6 +1838,11 @@ func (inputInlineQueryResultLocation *InputInlineQueryResultLocation) GetInputIn
 	return InputInlineQueryResultLocationType
 }
 
+// Accept calls the method of the visitor for InputInlineQueryResultLocation
+func (inputInlineQueryResultLocation *InputInlineQueryResultLocation) Accept(visitor InputInlineQueryResultVisitor) {
+	visitor.VisitInputInlineQueryResultLocation(inputInlineQueryResultLocation)
+}
+
 // InputInlineQueryResultPhoto Represents link to a JPEG image
 type InputInlineQueryResultPhoto struct {
 	tdCommon
@@ -773,45 +1890,199 @@ func NewInputInlineQueryResultPhoto(id string, title string, description string,
 	return &inputInlineQueryResultPhotoTemp
 }
 
+// MarshalJSON marshals to json
+func (inputInlineQueryResultPhoto *InputInlineQueryResultPhoto) MarshalJSON() ([]byte, error) {
+	return inputInlineQueryResultPhoto.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputInlineQueryResultPhoto *InputInlineQueryResultPhoto) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, inputInlineQueryResultPhoto)
+}
+
+func (inputInlineQueryResultPhoto *InputInlineQueryResultPhoto) appendJSON(b []byte) ([]byte, error) {
+	if inputInlineQueryResultPhoto == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputInlineQueryResultPhoto.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputInlineQueryResultPhoto.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, inputInlineQueryResultPhoto.Id)
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, inputInlineQueryResultPhoto.Title)
+	b = append(b, `,"description":`...)
+	b = appendJSONString(b, inputInlineQueryResultPhoto.Description)
+	b = append(b, `,"thumbnail_url":`...)
+	b = appendJSONString(b, inputInlineQueryResultPhoto.ThumbnailUrl)
+	b = append(b, `,"photo_url":`...)
+	b = appendJSONString(b, inputInlineQueryResultPhoto.PhotoUrl)
+	b = append(b, `,"photo_width":`...)
+	b = appendJSONInt(b, int64(inputInlineQueryResultPhoto.PhotoWidth))
+	b = append(b, `,"photo_height":`...)
+	b = appendJSONInt(b, int64(inputInlineQueryResultPhoto.PhotoHeight))
+	b = append(b, `,"reply_markup":`...)
+	b, err = appendJSONValue(b, inputInlineQueryResultPhoto.ReplyMarkup)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Id           string `json:"id"`            // Unique identifier of the query result
-		Title        string `json:"title"`         // Title of the result, if known
-		Description  string `json:"description"`   // A short description of the result, if known
-		ThumbnailUrl string `json:"thumbnail_url"` // URL of the photo thumbnail, if it exists
-		PhotoUrl     string `json:"photo_url"`     // The URL of the JPEG photo (photo size must not exceed 5MB)
-		PhotoWidth   int32  `json:"photo_width"`   // Width of the photo
-		PhotoHeight  int32  `json:"photo_height"`  // Height of the photo
-
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"input_message_content":`...)
+	b, err = appendJSONValue(b, inputInlineQueryResultPhoto.InputMessageContent)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return append(b, '}'), nil
+}
 
-	inputInlineQueryResultPhoto.tdCommon = tempObj.tdCommon
-	inputInlineQueryResultPhoto.Id = tempObj.Id
-	inputInlineQueryResultPhoto.Title = tempObj.Title
-	inputInlineQueryResultPhoto.Description = tempObj.Description
-	inputInlineQueryResultPhoto.ThumbnailUrl = tempObj.ThumbnailUrl
-	inputInlineQueryResultPhoto.PhotoUrl = tempObj.PhotoUrl
-	inputInlineQueryResultPhoto.PhotoWidth = tempObj.PhotoWidth
-	inputInlineQueryResultPhoto.PhotoHeight = tempObj.PhotoHeight
+func (inputInlineQueryResultPhoto *InputInlineQueryResultPhoto) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputInlineQueryResultPhoto.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputInlineQueryResultPhoto.tdCommon.Extra, err = r.readString()
+		case "id":
+			inputInlineQueryResultPhoto.Id, err = r.readString()
+		case "title":
+			inputInlineQueryResultPhoto.Title, err = r.readString()
+		case "description":
+			inputInlineQueryResultPhoto.Description, err = r.readString()
+		case "thumbnail_url":
+			inputInlineQueryResultPhoto.ThumbnailUrl, err = r.readString()
+		case "photo_url":
+			inputInlineQueryResultPhoto.PhotoUrl, err = r.readString()
+		case "photo_width":
+			inputInlineQueryResultPhoto.PhotoWidth, err = r.readInt32()
+		case "photo_height":
+			inputInlineQueryResultPhoto.PhotoHeight, err = r.readInt32()
+		case "reply_markup":
+			inputInlineQueryResultPhoto.ReplyMarkup, err = decodeReplyMarkup(r)
+		case "input_message_content":
+			inputInlineQueryResultPhoto.InputMessageContent, err = decodeInputMessageContent(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputInlineQueryResultPhoto *InputInlineQueryResultPhoto) Clone() *InputInlineQueryResultPhoto {
+	if inputInlineQueryResultPhoto == nil {
+		return nil
+	}
+	clone := *inputInlineQueryResultPhoto
+	clone.ReplyMarkup = cloneReplyMarkup(inputInlineQueryResultPhoto.ReplyMarkup)
+	clone.InputMessageContent = cloneInputMessageContent(inputInlineQueryResultPhoto.InputMessageContent)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputInlineQueryResultPhoto *InputInlineQueryResultPhoto) Equal(other *InputInlineQueryResultPhoto) bool {
+	if inputInlineQueryResultPhoto == nil || other == nil {
+		return inputInlineQueryResultPhoto == other
+	}
+	if inputInlineQueryResultPhoto.Id != other.Id {
+		return false
+	}
+	if inputInlineQueryResultPhoto.Title != other.Title {
+		return false
+	}
+	if inputInlineQueryResultPhoto.Description != other.Description {
+		return false
+	}
+	if inputInlineQueryResultPhoto.ThumbnailUrl != other.ThumbnailUrl {
+		return false
+	}
+	if inputInlineQueryResultPhoto.PhotoUrl != other.PhotoUrl {
+		return false
+	}
+	if inputInlineQueryResultPhoto.PhotoWidth != other.PhotoWidth {
+		return false
+	}
+	if inputInlineQueryResultPhoto.PhotoHeight != other.PhotoHeight {
+		return false
+	}
+	if !equalReplyMarkup(inputInlineQueryResultPhoto.ReplyMarkup, other.ReplyMarkup) {
+		return false
+	}
+	if !equalInputMessageContent(inputInlineQueryResultPhoto.InputMessageContent, other.InputMessageContent) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputInlineQueryResultPhoto *InputInlineQueryResultPhoto) Diff(other *InputInlineQueryResultPhoto) []Change {
+	return inputInlineQueryResultPhoto.diff("", other, nil)
+}
+
+func (inputInlineQueryResultPhoto *InputInlineQueryResultPhoto) diff(path string, other *InputInlineQueryResultPhoto, changes []Change) []Change {
+	if inputInlineQueryResultPhoto == nil || other == nil {
+		if inputInlineQueryResultPhoto != other {
+			changes = append(changes, Change{Path: path, Old: inputInlineQueryResultPhoto, New: other})
+		}
+		return changes
+	}
+	if inputInlineQueryResultPhoto.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: inputInlineQueryResultPhoto.Id, New: other.Id})
+	}
+	if inputInlineQueryResultPhoto.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: inputInlineQueryResultPhoto.Title, New: other.Title})
+	}
+	if inputInlineQueryResultPhoto.Description != other.Description {
+		changes = append(changes, Change{Path: fieldPath(path, "description"), Old: inputInlineQueryResultPhoto.Description, New: other.Description})
+	}
+	if inputInlineQueryResultPhoto.ThumbnailUrl != other.ThumbnailUrl {
+		changes = append(changes, Change{Path: fieldPath(path, "thumbnail_url"), Old: inputInlineQueryResultPhoto.ThumbnailUrl, New: other.ThumbnailUrl})
+	}
+	if inputInlineQueryResultPhoto.PhotoUrl != other.PhotoUrl {
+		changes = append(changes, Change{Path: fieldPath(path, "photo_url"), Old: inputInlineQueryResultPhoto.PhotoUrl, New: other.PhotoUrl})
+	}
+	if inputInlineQueryResultPhoto.PhotoWidth != other.PhotoWidth {
+		changes = append(changes, Change{Path: fieldPath(path, "photo_width"), Old: inputInlineQueryResultPhoto.PhotoWidth, New: other.PhotoWidth})
+	}
+	if inputInlineQueryResultPhoto.PhotoHeight != other.PhotoHeight {
+		changes = append(changes, Change{Path: fieldPath(path, "photo_height"), Old: inputInlineQueryResultPhoto.PhotoHeight, New: other.PhotoHeight})
+	}
+	changes = diffReplyMarkup(fieldPath(path, "reply_markup"), inputInlineQueryResultPhoto.ReplyMarkup, other.ReplyMarkup, changes)
+	changes = diffInputMessageContent(fieldPath(path, "input_message_content"), inputInlineQueryResultPhoto.InputMessageContent, other.InputMessageContent, changes)
+	return changes
+}
 
-	fieldReplyMarkup, _ := unmarshalReplyMarkup(objMap["reply_markup"])
-	inputInlineQueryResultPhoto.ReplyMarkup = fieldReplyMarkup
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputInlineQueryResultPhoto *InputInlineQueryResultPhoto) String() string {
+	return objectString(inputInlineQueryResultPhoto)
+}
 
-	fieldInputMessageContent, _ := unmarshalInputMessageContent(objMap["input_message_content"])
-	inputInlineQueryResultPhoto.InputMessageContent = fieldInputMessageContent
+// LogValue logs the object as its summary
+func (inputInlineQueryResultPhoto *InputInlineQueryResultPhoto) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputInlineQueryResultPhoto))
+}
 
-	return nil
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputInlineQueryResultPhoto *InputInlineQueryResultPhoto) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputInlineQueryResultPhoto)
+}
+
+func (inputInlineQueryResultPhoto *InputInlineQueryResultPhoto) printText(p *printer) {
+	if inputInlineQueryResultPhoto == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputInlineQueryResultPhoto")
+	p.string("id", inputInlineQueryResultPhoto.Id)
+	p.string("title", inputInlineQueryResultPhoto.Title)
+	p.string("description", inputInlineQueryResultPhoto.Description)
+	p.string("thumbnail_url", inputInlineQueryResultPhoto.ThumbnailUrl)
+	p.string("photo_url", inputInlineQueryResultPhoto.PhotoUrl)
+	p.int("photo_width", int64(inputInlineQueryResultPhoto.PhotoWidth))
+	p.int("photo_height", int64(inputInlineQueryResultPhoto.PhotoHeight))
+	p.object("reply_markup", inputInlineQueryResultPhoto.ReplyMarkup)
+	p.object("input_message_content", inputInlineQueryResultPhoto.InputMessageContent)
+	p.endObject(state)
 }
 
 // GetInputInlineQueryResultEnum return the enum type of this object
@@ -819,6 +2090,11 @@ func (inputInlineQueryResultPhoto *InputInlineQueryResultPhoto) GetInputInlineQu
 	return InputInlineQueryResultPhotoType
 }
 
+// Accept calls the method of the visitor for InputInlineQueryResultPhoto
+func (inputInlineQueryResultPhoto *InputInlineQueryResultPhoto) Accept(visitor InputInlineQueryResultVisitor) {
+	visitor.VisitInputInlineQueryResultPhoto(inputInlineQueryResultPhoto)
+}
+
 // InputInlineQueryResultSticker Represents a link to a WEBP or TGS sticker
 type InputInlineQueryResultSticker struct {
 	tdCommon
@@ -860,41 +2136,177 @@ func NewInputInlineQueryResultSticker(id string, thumbnailUrl string, stickerUrl
 	return &inputInlineQueryResultStickerTemp
 }
 
+// MarshalJSON marshals to json
+func (inputInlineQueryResultSticker *InputInlineQueryResultSticker) MarshalJSON() ([]byte, error) {
+	return inputInlineQueryResultSticker.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputInlineQueryResultSticker *InputInlineQueryResultSticker) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, inputInlineQueryResultSticker)
+}
+
+func (inputInlineQueryResultSticker *InputInlineQueryResultSticker) appendJSON(b []byte) ([]byte, error) {
+	if inputInlineQueryResultSticker == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputInlineQueryResultSticker.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputInlineQueryResultSticker.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, inputInlineQueryResultSticker.Id)
+	b = append(b, `,"thumbnail_url":`...)
+	b = appendJSONString(b, inputInlineQueryResultSticker.ThumbnailUrl)
+	b = append(b, `,"sticker_url":`...)
+	b = appendJSONString(b, inputInlineQueryResultSticker.StickerUrl)
+	b = append(b, `,"sticker_width":`...)
+	b = appendJSONInt(b, int64(inputInlineQueryResultSticker.StickerWidth))
+	b = append(b, `,"sticker_height":`...)
+	b = appendJSONInt(b, int64(inputInlineQueryResultSticker.StickerHeight))
+	b = append(b, `,"reply_markup":`...)
+	b, err = appendJSONValue(b, inputInlineQueryResultSticker.ReplyMarkup)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Id            string `json:"id"`             // Unique identifier of the query result
-		ThumbnailUrl  string `json:"thumbnail_url"`  // URL of the sticker thumbnail, if it exists
-		StickerUrl    string `json:"sticker_url"`    // The URL of the WEBP or TGS sticker (sticker file size must not exceed 5MB)
-		StickerWidth  int32  `json:"sticker_width"`  // Width of the sticker
-		StickerHeight int32  `json:"sticker_height"` // Height of the sticker
-
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"input_message_content":`...)
+	b, err = appendJSONValue(b, inputInlineQueryResultSticker.InputMessageContent)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return append(b, '}'), nil
+}
 
-	inputInlineQueryResultSticker.tdCommon = tempObj.tdCommon
-	inputInlineQueryResultSticker.Id = tempObj.Id
-	inputInlineQueryResultSticker.ThumbnailUrl = tempObj.ThumbnailUrl
-	inputInlineQueryResultSticker.StickerUrl = tempObj.StickerUrl
-	inputInlineQueryResultSticker.StickerWidth = tempObj.StickerWidth
-	inputInlineQueryResultSticker.StickerHeight = tempObj.StickerHeight
+func (inputInlineQueryResultSticker *InputInlineQueryResultSticker) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputInlineQueryResultSticker.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputInlineQueryResultSticker.tdCommon.Extra, err = r.readString()
+		case "id":
+			inputInlineQueryResultSticker.Id, err = r.readString()
+		case "thumbnail_url":
+			inputInlineQueryResultSticker.ThumbnailUrl, err = r.readString()
+		case "sticker_url":
+			inputInlineQueryResultSticker.StickerUrl, err = r.readString()
+		case "sticker_width":
+			inputInlineQueryResultSticker.StickerWidth, err = r.readInt32()
+		case "sticker_height":
+			inputInlineQueryResultSticker.StickerHeight, err = r.readInt32()
+		case "reply_markup":
+			inputInlineQueryResultSticker.ReplyMarkup, err = decodeReplyMarkup(r)
+		case "input_message_content":
+			inputInlineQueryResultSticker.InputMessageContent, err = decodeInputMessageContent(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	fieldReplyMarkup, _ := unmarshalReplyMarkup(objMap["reply_markup"])
-	inputInlineQueryResultSticker.ReplyMarkup = fieldReplyMarkup
+// Clone returns a deep copy of the object
+func (inputInlineQueryResultSticker *InputInlineQueryResultSticker) Clone() *InputInlineQueryResultSticker {
+	if inputInlineQueryResultSticker == nil {
+		return nil
+	}
+	clone := *inputInlineQueryResultSticker
+	clone.ReplyMarkup = cloneReplyMarkup(inputInlineQueryResultSticker.ReplyMarkup)
+	clone.InputMessageContent = cloneInputMessageContent(inputInlineQueryResultSticker.InputMessageContent)
+	return &clone
+}
 
-	fieldInputMessageContent, _ := unmarshalInputMessageContent(objMap["input_message_content"])
-	inputInlineQueryResultSticker.InputMessageContent = fieldInputMessageContent
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputInlineQueryResultSticker *InputInlineQueryResultSticker) Equal(other *InputInlineQueryResultSticker) bool {
+	if inputInlineQueryResultSticker == nil || other == nil {
+		return inputInlineQueryResultSticker == other
+	}
+	if inputInlineQueryResultSticker.Id != other.Id {
+		return false
+	}
+	if inputInlineQueryResultSticker.ThumbnailUrl != other.ThumbnailUrl {
+		return false
+	}
+	if inputInlineQueryResultSticker.StickerUrl != other.StickerUrl {
+		return false
+	}
+	if inputInlineQueryResultSticker.StickerWidth != other.StickerWidth {
+		return false
+	}
+	if inputInlineQueryResultSticker.StickerHeight != other.StickerHeight {
+		return false
+	}
+	if !equalReplyMarkup(inputInlineQueryResultSticker.ReplyMarkup, other.ReplyMarkup) {
+		return false
+	}
+	if !equalInputMessageContent(inputInlineQueryResultSticker.InputMessageContent, other.InputMessageContent) {
+		return false
+	}
+	return true
+}
 
-	return nil
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputInlineQueryResultSticker *InputInlineQueryResultSticker) Diff(other *InputInlineQueryResultSticker) []Change {
+	return inputInlineQueryResultSticker.diff("", other, nil)
+}
+
+func (inputInlineQueryResultSticker *InputInlineQueryResultSticker) diff(path string, other *InputInlineQueryResultSticker, changes []Change) []Change {
+	if inputInlineQueryResultSticker == nil || other == nil {
+		if inputInlineQueryResultSticker != other {
+			changes = append(changes, Change{Path: path, Old: inputInlineQueryResultSticker, New: other})
+		}
+		return changes
+	}
+	if inputInlineQueryResultSticker.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: inputInlineQueryResultSticker.Id, New: other.Id})
+	}
+	if inputInlineQueryResultSticker.ThumbnailUrl != other.ThumbnailUrl {
+		changes = append(changes, Change{Path: fieldPath(path, "thumbnail_url"), Old: inputInlineQueryResultSticker.ThumbnailUrl, New: other.ThumbnailUrl})
+	}
+	if inputInlineQueryResultSticker.StickerUrl != other.StickerUrl {
+		changes = append(changes, Change{Path: fieldPath(path, "sticker_url"), Old: inputInlineQueryResultSticker.StickerUrl, New: other.StickerUrl})
+	}
+	if inputInlineQueryResultSticker.StickerWidth != other.StickerWidth {
+		changes = append(changes, Change{Path: fieldPath(path, "sticker_width"), Old: inputInlineQueryResultSticker.StickerWidth, New: other.StickerWidth})
+	}
+	if inputInlineQueryResultSticker.StickerHeight != other.StickerHeight {
+		changes = append(changes, Change{Path: fieldPath(path, "sticker_height"), Old: inputInlineQueryResultSticker.StickerHeight, New: other.StickerHeight})
+	}
+	changes = diffReplyMarkup(fieldPath(path, "reply_markup"), inputInlineQueryResultSticker.ReplyMarkup, other.ReplyMarkup, changes)
+	changes = diffInputMessageContent(fieldPath(path, "input_message_content"), inputInlineQueryResultSticker.InputMessageContent, other.InputMessageContent, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputInlineQueryResultSticker *InputInlineQueryResultSticker) String() string {
+	return objectString(inputInlineQueryResultSticker)
+}
+
+// LogValue logs the object as its summary
+func (inputInlineQueryResultSticker *InputInlineQueryResultSticker) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputInlineQueryResultSticker))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputInlineQueryResultSticker *InputInlineQueryResultSticker) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputInlineQueryResultSticker)
+}
+
+func (inputInlineQueryResultSticker *InputInlineQueryResultSticker) printText(p *printer) {
+	if inputInlineQueryResultSticker == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputInlineQueryResultSticker")
+	p.string("id", inputInlineQueryResultSticker.Id)
+	p.string("thumbnail_url", inputInlineQueryResultSticker.ThumbnailUrl)
+	p.string("sticker_url", inputInlineQueryResultSticker.StickerUrl)
+	p.int("sticker_width", int64(inputInlineQueryResultSticker.StickerWidth))
+	p.int("sticker_height", int64(inputInlineQueryResultSticker.StickerHeight))
+	p.object("reply_markup", inputInlineQueryResultSticker.ReplyMarkup)
+	p.object("input_message_content", inputInlineQueryResultSticker.InputMessageContent)
+	p.endObject(state)
 }
 
 // GetInputInlineQueryResultEnum return the enum type of this object
@@ -902,6 +2314,11 @@ func (inputInlineQueryResultSticker *InputInlineQueryResultSticker) GetInputInli
 	return InputInlineQueryResultStickerType
 }
 
+// Accept calls the method of the visitor for InputInlineQueryResultSticker
+func (inputInlineQueryResultSticker *InputInlineQueryResultSticker) Accept(visitor InputInlineQueryResultVisitor) {
+	visitor.VisitInputInlineQueryResultSticker(inputInlineQueryResultSticker)
+}
+
 // InputInlineQueryResultVenue Represents information about a venue
 type InputInlineQueryResultVenue struct {
 	tdCommon
@@ -943,41 +2360,184 @@ func NewInputInlineQueryResultVenue(id string, venue *Venue, thumbnailUrl string
 	return &inputInlineQueryResultVenueTemp
 }
 
+// MarshalJSON marshals to json
+func (inputInlineQueryResultVenue *InputInlineQueryResultVenue) MarshalJSON() ([]byte, error) {
+	return inputInlineQueryResultVenue.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputInlineQueryResultVenue *InputInlineQueryResultVenue) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, inputInlineQueryResultVenue)
+}
+
+func (inputInlineQueryResultVenue *InputInlineQueryResultVenue) appendJSON(b []byte) ([]byte, error) {
+	if inputInlineQueryResultVenue == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputInlineQueryResultVenue.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputInlineQueryResultVenue.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, inputInlineQueryResultVenue.Id)
+	b = append(b, `,"venue":`...)
+	b, err = inputInlineQueryResultVenue.Venue.appendJSON(b)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Id              string `json:"id"`               // Unique identifier of the query result
-		Venue           *Venue `json:"venue"`            // Venue result
-		ThumbnailUrl    string `json:"thumbnail_url"`    // URL of the result thumbnail, if it exists
-		ThumbnailWidth  int32  `json:"thumbnail_width"`  // Thumbnail width, if known
-		ThumbnailHeight int32  `json:"thumbnail_height"` // Thumbnail height, if known
-
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"thumbnail_url":`...)
+	b = appendJSONString(b, inputInlineQueryResultVenue.ThumbnailUrl)
+	b = append(b, `,"thumbnail_width":`...)
+	b = appendJSONInt(b, int64(inputInlineQueryResultVenue.ThumbnailWidth))
+	b = append(b, `,"thumbnail_height":`...)
+	b = appendJSONInt(b, int64(inputInlineQueryResultVenue.ThumbnailHeight))
+	b = append(b, `,"reply_markup":`...)
+	b, err = appendJSONValue(b, inputInlineQueryResultVenue.ReplyMarkup)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"input_message_content":`...)
+	b, err = appendJSONValue(b, inputInlineQueryResultVenue.InputMessageContent)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inputInlineQueryResultVenue *InputInlineQueryResultVenue) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputInlineQueryResultVenue.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputInlineQueryResultVenue.tdCommon.Extra, err = r.readString()
+		case "id":
+			inputInlineQueryResultVenue.Id, err = r.readString()
+		case "venue":
+			if r.readNull() {
+				inputInlineQueryResultVenue.Venue = nil
+			} else {
+				inputInlineQueryResultVenue.Venue = new(Venue)
+				err = inputInlineQueryResultVenue.Venue.decodeJSON(r)
+			}
+		case "thumbnail_url":
+			inputInlineQueryResultVenue.ThumbnailUrl, err = r.readString()
+		case "thumbnail_width":
+			inputInlineQueryResultVenue.ThumbnailWidth, err = r.readInt32()
+		case "thumbnail_height":
+			inputInlineQueryResultVenue.ThumbnailHeight, err = r.readInt32()
+		case "reply_markup":
+			inputInlineQueryResultVenue.ReplyMarkup, err = decodeReplyMarkup(r)
+		case "input_message_content":
+			inputInlineQueryResultVenue.InputMessageContent, err = decodeInputMessageContent(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputInlineQueryResultVenue *InputInlineQueryResultVenue) Clone() *InputInlineQueryResultVenue {
+	if inputInlineQueryResultVenue == nil {
+		return nil
+	}
+	clone := *inputInlineQueryResultVenue
+	clone.Venue = inputInlineQueryResultVenue.Venue.Clone()
+	clone.ReplyMarkup = cloneReplyMarkup(inputInlineQueryResultVenue.ReplyMarkup)
+	clone.InputMessageContent = cloneInputMessageContent(inputInlineQueryResultVenue.InputMessageContent)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputInlineQueryResultVenue *InputInlineQueryResultVenue) Equal(other *InputInlineQueryResultVenue) bool {
+	if inputInlineQueryResultVenue == nil || other == nil {
+		return inputInlineQueryResultVenue == other
 	}
+	if inputInlineQueryResultVenue.Id != other.Id {
+		return false
+	}
+	if !inputInlineQueryResultVenue.Venue.Equal(other.Venue) {
+		return false
+	}
+	if inputInlineQueryResultVenue.ThumbnailUrl != other.ThumbnailUrl {
+		return false
+	}
+	if inputInlineQueryResultVenue.ThumbnailWidth != other.ThumbnailWidth {
+		return false
+	}
+	if inputInlineQueryResultVenue.ThumbnailHeight != other.ThumbnailHeight {
+		return false
+	}
+	if !equalReplyMarkup(inputInlineQueryResultVenue.ReplyMarkup, other.ReplyMarkup) {
+		return false
+	}
+	if !equalInputMessageContent(inputInlineQueryResultVenue.InputMessageContent, other.InputMessageContent) {
+		return false
+	}
+	return true
+}
 
-	inputInlineQueryResultVenue.tdCommon = tempObj.tdCommon
-	inputInlineQueryResultVenue.Id = tempObj.Id
-	inputInlineQueryResultVenue.Venue = tempObj.Venue
-	inputInlineQueryResultVenue.ThumbnailUrl = tempObj.ThumbnailUrl
-	inputInlineQueryResultVenue.ThumbnailWidth = tempObj.ThumbnailWidth
-	inputInlineQueryResultVenue.ThumbnailHeight = tempObj.ThumbnailHeight
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputInlineQueryResultVenue *InputInlineQueryResultVenue) Diff(other *InputInlineQueryResultVenue) []Change {
+	return inputInlineQueryResultVenue.diff("", other, nil)
+}
 
-	fieldReplyMarkup, _ := unmarshalReplyMarkup(objMap["reply_markup"])
-	inputInlineQueryResultVenue.ReplyMarkup = fieldReplyMarkup
+func (inputInlineQueryResultVenue *InputInlineQueryResultVenue) diff(path string, other *InputInlineQueryResultVenue, changes []Change) []Change {
+	if inputInlineQueryResultVenue == nil || other == nil {
+		if inputInlineQueryResultVenue != other {
+			changes = append(changes, Change{Path: path, Old: inputInlineQueryResultVenue, New: other})
+		}
+		return changes
+	}
+	if inputInlineQueryResultVenue.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: inputInlineQueryResultVenue.Id, New: other.Id})
+	}
+	changes = inputInlineQueryResultVenue.Venue.diff(fieldPath(path, "venue"), other.Venue, changes)
+	if inputInlineQueryResultVenue.ThumbnailUrl != other.ThumbnailUrl {
+		changes = append(changes, Change{Path: fieldPath(path, "thumbnail_url"), Old: inputInlineQueryResultVenue.ThumbnailUrl, New: other.ThumbnailUrl})
+	}
+	if inputInlineQueryResultVenue.ThumbnailWidth != other.ThumbnailWidth {
+		changes = append(changes, Change{Path: fieldPath(path, "thumbnail_width"), Old: inputInlineQueryResultVenue.ThumbnailWidth, New: other.ThumbnailWidth})
+	}
+	if inputInlineQueryResultVenue.ThumbnailHeight != other.ThumbnailHeight {
+		changes = append(changes, Change{Path: fieldPath(path, "thumbnail_height"), Old: inputInlineQueryResultVenue.ThumbnailHeight, New: other.ThumbnailHeight})
+	}
+	changes = diffReplyMarkup(fieldPath(path, "reply_markup"), inputInlineQueryResultVenue.ReplyMarkup, other.ReplyMarkup, changes)
+	changes = diffInputMessageContent(fieldPath(path, "input_message_content"), inputInlineQueryResultVenue.InputMessageContent, other.InputMessageContent, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputInlineQueryResultVenue *InputInlineQueryResultVenue) String() string {
+	return objectString(inputInlineQueryResultVenue)
+}
+
+// LogValue logs the object as its summary
+func (inputInlineQueryResultVenue *InputInlineQueryResultVenue) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputInlineQueryResultVenue))
+}
 
-	fieldInputMessageContent, _ := unmarshalInputMessageContent(objMap["input_message_content"])
-	inputInlineQueryResultVenue.InputMessageContent = fieldInputMessageContent
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputInlineQueryResultVenue *InputInlineQueryResultVenue) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputInlineQueryResultVenue)
+}
 
-	return nil
+func (inputInlineQueryResultVenue *InputInlineQueryResultVenue) printText(p *printer) {
+	if inputInlineQueryResultVenue == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputInlineQueryResultVenue")
+	p.string("id", inputInlineQueryResultVenue.Id)
+	p.object("venue", inputInlineQueryResultVenue.Venue)
+	p.string("thumbnail_url", inputInlineQueryResultVenue.ThumbnailUrl)
+	p.int("thumbnail_width", int64(inputInlineQueryResultVenue.ThumbnailWidth))
+	p.int("thumbnail_height", int64(inputInlineQueryResultVenue.ThumbnailHeight))
+	p.object("reply_markup", inputInlineQueryResultVenue.ReplyMarkup)
+	p.object("input_message_content", inputInlineQueryResultVenue.InputMessageContent)
+	p.endObject(state)
 }
 
 // GetInputInlineQueryResultEnum return the enum type of this object
@@ -985,6 +2545,11 @@ func (inputInlineQueryResultVenue *InputInlineQueryResultVenue) GetInputInlineQu
 	return InputInlineQueryResultVenueType
 }
 
+// Accept calls the method of the visitor for InputInlineQueryResultVenue
+func (inputInlineQueryResultVenue *InputInlineQueryResultVenue) Accept(visitor InputInlineQueryResultVisitor) {
+	visitor.VisitInputInlineQueryResultVenue(inputInlineQueryResultVenue)
+}
+
 // InputInlineQueryResultVideo Represents a link to a page containing an embedded video player or a video file
 type InputInlineQueryResultVideo struct {
 	tdCommon
@@ -1038,49 +2603,221 @@ func NewInputInlineQueryResultVideo(id string, title string, description string,
 	return &inputInlineQueryResultVideoTemp
 }
 
+// MarshalJSON marshals to json
+func (inputInlineQueryResultVideo *InputInlineQueryResultVideo) MarshalJSON() ([]byte, error) {
+	return inputInlineQueryResultVideo.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputInlineQueryResultVideo *InputInlineQueryResultVideo) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, inputInlineQueryResultVideo)
+}
+
+func (inputInlineQueryResultVideo *InputInlineQueryResultVideo) appendJSON(b []byte) ([]byte, error) {
+	if inputInlineQueryResultVideo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputInlineQueryResultVideo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputInlineQueryResultVideo.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, inputInlineQueryResultVideo.Id)
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, inputInlineQueryResultVideo.Title)
+	b = append(b, `,"description":`...)
+	b = appendJSONString(b, inputInlineQueryResultVideo.Description)
+	b = append(b, `,"thumbnail_url":`...)
+	b = appendJSONString(b, inputInlineQueryResultVideo.ThumbnailUrl)
+	b = append(b, `,"video_url":`...)
+	b = appendJSONString(b, inputInlineQueryResultVideo.VideoUrl)
+	b = append(b, `,"mime_type":`...)
+	b = appendJSONString(b, inputInlineQueryResultVideo.MimeType)
+	b = append(b, `,"video_width":`...)
+	b = appendJSONInt(b, int64(inputInlineQueryResultVideo.VideoWidth))
+	b = append(b, `,"video_height":`...)
+	b = appendJSONInt(b, int64(inputInlineQueryResultVideo.VideoHeight))
+	b = append(b, `,"video_duration":`...)
+	b = appendJSONInt(b, int64(inputInlineQueryResultVideo.VideoDuration))
+	b = append(b, `,"reply_markup":`...)
+	b, err = appendJSONValue(b, inputInlineQueryResultVideo.ReplyMarkup)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Id            string `json:"id"`             // Unique identifier of the query result
-		Title         string `json:"title"`          // Title of the result
-		Description   string `json:"description"`    // A short description of the result, if known
-		ThumbnailUrl  string `json:"thumbnail_url"`  // The URL of the video thumbnail (JPEG), if it exists
-		VideoUrl      string `json:"video_url"`      // URL of the embedded video player or video file
-		MimeType      string `json:"mime_type"`      // MIME type of the content of the video URL, only "text/html" or "video/mp4" are currently supported
-		VideoWidth    int32  `json:"video_width"`    // Width of the video
-		VideoHeight   int32  `json:"video_height"`   // Height of the video
-		VideoDuration int32  `json:"video_duration"` // Video duration, in seconds
-
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"input_message_content":`...)
+	b, err = appendJSONValue(b, inputInlineQueryResultVideo.InputMessageContent)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return append(b, '}'), nil
+}
 
-	inputInlineQueryResultVideo.tdCommon = tempObj.tdCommon
-	inputInlineQueryResultVideo.Id = tempObj.Id
-	inputInlineQueryResultVideo.Title = tempObj.Title
-	inputInlineQueryResultVideo.Description = tempObj.Description
-	inputInlineQueryResultVideo.ThumbnailUrl = tempObj.ThumbnailUrl
-	inputInlineQueryResultVideo.VideoUrl = tempObj.VideoUrl
-	inputInlineQueryResultVideo.MimeType = tempObj.MimeType
-	inputInlineQueryResultVideo.VideoWidth = tempObj.VideoWidth
-	inputInlineQueryResultVideo.VideoHeight = tempObj.VideoHeight
-	inputInlineQueryResultVideo.VideoDuration = tempObj.VideoDuration
+func (inputInlineQueryResultVideo *InputInlineQueryResultVideo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputInlineQueryResultVideo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputInlineQueryResultVideo.tdCommon.Extra, err = r.readString()
+		case "id":
+			inputInlineQueryResultVideo.Id, err = r.readString()
+		case "title":
+			inputInlineQueryResultVideo.Title, err = r.readString()
+		case "description":
+			inputInlineQueryResultVideo.Description, err = r.readString()
+		case "thumbnail_url":
+			inputInlineQueryResultVideo.ThumbnailUrl, err = r.readString()
+		case "video_url":
+			inputInlineQueryResultVideo.VideoUrl, err = r.readString()
+		case "mime_type":
+			inputInlineQueryResultVideo.MimeType, err = r.readString()
+		case "video_width":
+			inputInlineQueryResultVideo.VideoWidth, err = r.readInt32()
+		case "video_height":
+			inputInlineQueryResultVideo.VideoHeight, err = r.readInt32()
+		case "video_duration":
+			inputInlineQueryResultVideo.VideoDuration, err = r.readInt32()
+		case "reply_markup":
+			inputInlineQueryResultVideo.ReplyMarkup, err = decodeReplyMarkup(r)
+		case "input_message_content":
+			inputInlineQueryResultVideo.InputMessageContent, err = decodeInputMessageContent(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	fieldReplyMarkup, _ := unmarshalReplyMarkup(objMap["reply_markup"])
-	inputInlineQueryResultVideo.ReplyMarkup = fieldReplyMarkup
+// Clone returns a deep copy of the object
+func (inputInlineQueryResultVideo *InputInlineQueryResultVideo) Clone() *InputInlineQueryResultVideo {
+	if inputInlineQueryResultVideo == nil {
+		return nil
+	}
+	clone := *inputInlineQueryResultVideo
+	clone.ReplyMarkup = cloneReplyMarkup(inputInlineQueryResultVideo.ReplyMarkup)
+	clone.InputMessageContent = cloneInputMessageContent(inputInlineQueryResultVideo.InputMessageContent)
+	return &clone
+}
 
-	fieldInputMessageContent, _ := unmarshalInputMessageContent(objMap["input_message_content"])
-	inputInlineQueryResultVideo.InputMessageContent = fieldInputMessageContent
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputInlineQueryResultVideo *InputInlineQueryResultVideo) Equal(other *InputInlineQueryResultVideo) bool {
+	if inputInlineQueryResultVideo == nil || other == nil {
+		return inputInlineQueryResultVideo == other
+	}
+	if inputInlineQueryResultVideo.Id != other.Id {
+		return false
+	}
+	if inputInlineQueryResultVideo.Title != other.Title {
+		return false
+	}
+	if inputInlineQueryResultVideo.Description != other.Description {
+		return false
+	}
+	if inputInlineQueryResultVideo.ThumbnailUrl != other.ThumbnailUrl {
+		return false
+	}
+	if inputInlineQueryResultVideo.VideoUrl != other.VideoUrl {
+		return false
+	}
+	if inputInlineQueryResultVideo.MimeType != other.MimeType {
+		return false
+	}
+	if inputInlineQueryResultVideo.VideoWidth != other.VideoWidth {
+		return false
+	}
+	if inputInlineQueryResultVideo.VideoHeight != other.VideoHeight {
+		return false
+	}
+	if inputInlineQueryResultVideo.VideoDuration != other.VideoDuration {
+		return false
+	}
+	if !equalReplyMarkup(inputInlineQueryResultVideo.ReplyMarkup, other.ReplyMarkup) {
+		return false
+	}
+	if !equalInputMessageContent(inputInlineQueryResultVideo.InputMessageContent, other.InputMessageContent) {
+		return false
+	}
+	return true
+}
 
-	return nil
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputInlineQueryResultVideo *InputInlineQueryResultVideo) Diff(other *InputInlineQueryResultVideo) []Change {
+	return inputInlineQueryResultVideo.diff("", other, nil)
+}
+
+func (inputInlineQueryResultVideo *InputInlineQueryResultVideo) diff(path string, other *InputInlineQueryResultVideo, changes []Change) []Change {
+	if inputInlineQueryResultVideo == nil || other == nil {
+		if inputInlineQueryResultVideo != other {
+			changes = append(changes, Change{Path: path, Old: inputInlineQueryResultVideo, New: other})
+		}
+		return changes
+	}
+	if inputInlineQueryResultVideo.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: inputInlineQueryResultVideo.Id, New: other.Id})
+	}
+	if inputInlineQueryResultVideo.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: inputInlineQueryResultVideo.Title, New: other.Title})
+	}
+	if inputInlineQueryResultVideo.Description != other.Description {
+		changes = append(changes, Change{Path: fieldPath(path, "description"), Old: inputInlineQueryResultVideo.Description, New: other.Description})
+	}
+	if inputInlineQueryResultVideo.ThumbnailUrl != other.ThumbnailUrl {
+		changes = append(changes, Change{Path: fieldPath(path, "thumbnail_url"), Old: inputInlineQueryResultVideo.ThumbnailUrl, New: other.ThumbnailUrl})
+	}
+	if inputInlineQueryResultVideo.VideoUrl != other.VideoUrl {
+		changes = append(changes, Change{Path: fieldPath(path, "video_url"), Old: inputInlineQueryResultVideo.VideoUrl, New: other.VideoUrl})
+	}
+	if inputInlineQueryResultVideo.MimeType != other.MimeType {
+		changes = append(changes, Change{Path: fieldPath(path, "mime_type"), Old: inputInlineQueryResultVideo.MimeType, New: other.MimeType})
+	}
+	if inputInlineQueryResultVideo.VideoWidth != other.VideoWidth {
+		changes = append(changes, Change{Path: fieldPath(path, "video_width"), Old: inputInlineQueryResultVideo.VideoWidth, New: other.VideoWidth})
+	}
+	if inputInlineQueryResultVideo.VideoHeight != other.VideoHeight {
+		changes = append(changes, Change{Path: fieldPath(path, "video_height"), Old: inputInlineQueryResultVideo.VideoHeight, New: other.VideoHeight})
+	}
+	if inputInlineQueryResultVideo.VideoDuration != other.VideoDuration {
+		changes = append(changes, Change{Path: fieldPath(path, "video_duration"), Old: inputInlineQueryResultVideo.VideoDuration, New: other.VideoDuration})
+	}
+	changes = diffReplyMarkup(fieldPath(path, "reply_markup"), inputInlineQueryResultVideo.ReplyMarkup, other.ReplyMarkup, changes)
+	changes = diffInputMessageContent(fieldPath(path, "input_message_content"), inputInlineQueryResultVideo.InputMessageContent, other.InputMessageContent, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputInlineQueryResultVideo *InputInlineQueryResultVideo) String() string {
+	return objectString(inputInlineQueryResultVideo)
+}
+
+// LogValue logs the object as its summary
+func (inputInlineQueryResultVideo *InputInlineQueryResultVideo) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputInlineQueryResultVideo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputInlineQueryResultVideo *InputInlineQueryResultVideo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputInlineQueryResultVideo)
+}
+
+func (inputInlineQueryResultVideo *InputInlineQueryResultVideo) printText(p *printer) {
+	if inputInlineQueryResultVideo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputInlineQueryResultVideo")
+	p.string("id", inputInlineQueryResultVideo.Id)
+	p.string("title", inputInlineQueryResultVideo.Title)
+	p.string("description", inputInlineQueryResultVideo.Description)
+	p.string("thumbnail_url", inputInlineQueryResultVideo.ThumbnailUrl)
+	p.string("video_url", inputInlineQueryResultVideo.VideoUrl)
+	p.string("mime_type", inputInlineQueryResultVideo.MimeType)
+	p.int("video_width", int64(inputInlineQueryResultVideo.VideoWidth))
+	p.int("video_height", int64(inputInlineQueryResultVideo.VideoHeight))
+	p.int("video_duration", int64(inputInlineQueryResultVideo.VideoDuration))
+	p.object("reply_markup", inputInlineQueryResultVideo.ReplyMarkup)
+	p.object("input_message_content", inputInlineQueryResultVideo.InputMessageContent)
+	p.endObject(state)
 }
 
 // GetInputInlineQueryResultEnum return the enum type of this object
@@ -1088,6 +2825,11 @@ func (inputInlineQueryResultVideo *InputInlineQueryResultVideo) GetInputInlineQu
 	return InputInlineQueryResultVideoType
 }
 
+// Accept calls the method of the visitor for InputInlineQueryResultVideo
+func (inputInlineQueryResultVideo *InputInlineQueryResultVideo) Accept(visitor InputInlineQueryResultVisitor) {
+	visitor.VisitInputInlineQueryResultVideo(inputInlineQueryResultVideo)
+}
+
 // InputInlineQueryResultVoiceNote Represents a link to an opus-encoded audio file within an OGG container, single channel audio
 type InputInlineQueryResultVoiceNote struct {
 	tdCommon
@@ -1126,42 +2868,419 @@ func NewInputInlineQueryResultVoiceNote(id string, title string, voiceNoteUrl st
 	return &inputInlineQueryResultVoiceNoteTemp
 }
 
+// MarshalJSON marshals to json
+func (inputInlineQueryResultVoiceNote *InputInlineQueryResultVoiceNote) MarshalJSON() ([]byte, error) {
+	return inputInlineQueryResultVoiceNote.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputInlineQueryResultVoiceNote *InputInlineQueryResultVoiceNote) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, inputInlineQueryResultVoiceNote)
+}
+
+func (inputInlineQueryResultVoiceNote *InputInlineQueryResultVoiceNote) appendJSON(b []byte) ([]byte, error) {
+	if inputInlineQueryResultVoiceNote == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputInlineQueryResultVoiceNote.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputInlineQueryResultVoiceNote.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, inputInlineQueryResultVoiceNote.Id)
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, inputInlineQueryResultVoiceNote.Title)
+	b = append(b, `,"voice_note_url":`...)
+	b = appendJSONString(b, inputInlineQueryResultVoiceNote.VoiceNoteUrl)
+	b = append(b, `,"voice_note_duration":`...)
+	b = appendJSONInt(b, int64(inputInlineQueryResultVoiceNote.VoiceNoteDuration))
+	b = append(b, `,"reply_markup":`...)
+	b, err = appendJSONValue(b, inputInlineQueryResultVoiceNote.ReplyMarkup)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-		Id                string `json:"id"`                  // Unique identifier of the query result
-		Title             string `json:"title"`               // Title of the voice note
-		VoiceNoteUrl      string `json:"voice_note_url"`      // The URL of the voice note file
-		VoiceNoteDuration int32  `json:"voice_note_duration"` // Duration of the voice note, in seconds
-
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	b = append(b, `,"input_message_content":`...)
+	b, err = appendJSONValue(b, inputInlineQueryResultVoiceNote.InputMessageContent)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return append(b, '}'), nil
+}
 
-	inputInlineQueryResultVoiceNote.tdCommon = tempObj.tdCommon
-	inputInlineQueryResultVoiceNote.Id = tempObj.Id
-	inputInlineQueryResultVoiceNote.Title = tempObj.Title
-	inputInlineQueryResultVoiceNote.VoiceNoteUrl = tempObj.VoiceNoteUrl
-	inputInlineQueryResultVoiceNote.VoiceNoteDuration = tempObj.VoiceNoteDuration
+func (inputInlineQueryResultVoiceNote *InputInlineQueryResultVoiceNote) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputInlineQueryResultVoiceNote.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputInlineQueryResultVoiceNote.tdCommon.Extra, err = r.readString()
+		case "id":
+			inputInlineQueryResultVoiceNote.Id, err = r.readString()
+		case "title":
+			inputInlineQueryResultVoiceNote.Title, err = r.readString()
+		case "voice_note_url":
+			inputInlineQueryResultVoiceNote.VoiceNoteUrl, err = r.readString()
+		case "voice_note_duration":
+			inputInlineQueryResultVoiceNote.VoiceNoteDuration, err = r.readInt32()
+		case "reply_markup":
+			inputInlineQueryResultVoiceNote.ReplyMarkup, err = decodeReplyMarkup(r)
+		case "input_message_content":
+			inputInlineQueryResultVoiceNote.InputMessageContent, err = decodeInputMessageContent(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	fieldReplyMarkup, _ := unmarshalReplyMarkup(objMap["reply_markup"])
-	inputInlineQueryResultVoiceNote.ReplyMarkup = fieldReplyMarkup
+// Clone returns a deep copy of the object
+func (inputInlineQueryResultVoiceNote *InputInlineQueryResultVoiceNote) Clone() *InputInlineQueryResultVoiceNote {
+	if inputInlineQueryResultVoiceNote == nil {
+		return nil
+	}
+	clone := *inputInlineQueryResultVoiceNote
+	clone.ReplyMarkup = cloneReplyMarkup(inputInlineQueryResultVoiceNote.ReplyMarkup)
+	clone.InputMessageContent = cloneInputMessageContent(inputInlineQueryResultVoiceNote.InputMessageContent)
+	return &clone
+}
 
-	fieldInputMessageContent, _ := unmarshalInputMessageContent(objMap["input_message_content"])
-	inputInlineQueryResultVoiceNote.InputMessageContent = fieldInputMessageContent
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputInlineQueryResultVoiceNote *InputInlineQueryResultVoiceNote) Equal(other *InputInlineQueryResultVoiceNote) bool {
+	if inputInlineQueryResultVoiceNote == nil || other == nil {
+		return inputInlineQueryResultVoiceNote == other
+	}
+	if inputInlineQueryResultVoiceNote.Id != other.Id {
+		return false
+	}
+	if inputInlineQueryResultVoiceNote.Title != other.Title {
+		return false
+	}
+	if inputInlineQueryResultVoiceNote.VoiceNoteUrl != other.VoiceNoteUrl {
+		return false
+	}
+	if inputInlineQueryResultVoiceNote.VoiceNoteDuration != other.VoiceNoteDuration {
+		return false
+	}
+	if !equalReplyMarkup(inputInlineQueryResultVoiceNote.ReplyMarkup, other.ReplyMarkup) {
+		return false
+	}
+	if !equalInputMessageContent(inputInlineQueryResultVoiceNote.InputMessageContent, other.InputMessageContent) {
+		return false
+	}
+	return true
+}
 
-	return nil
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputInlineQueryResultVoiceNote *InputInlineQueryResultVoiceNote) Diff(other *InputInlineQueryResultVoiceNote) []Change {
+	return inputInlineQueryResultVoiceNote.diff("", other, nil)
+}
+
+func (inputInlineQueryResultVoiceNote *InputInlineQueryResultVoiceNote) diff(path string, other *InputInlineQueryResultVoiceNote, changes []Change) []Change {
+	if inputInlineQueryResultVoiceNote == nil || other == nil {
+		if inputInlineQueryResultVoiceNote != other {
+			changes = append(changes, Change{Path: path, Old: inputInlineQueryResultVoiceNote, New: other})
+		}
+		return changes
+	}
+	if inputInlineQueryResultVoiceNote.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: inputInlineQueryResultVoiceNote.Id, New: other.Id})
+	}
+	if inputInlineQueryResultVoiceNote.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: inputInlineQueryResultVoiceNote.Title, New: other.Title})
+	}
+	if inputInlineQueryResultVoiceNote.VoiceNoteUrl != other.VoiceNoteUrl {
+		changes = append(changes, Change{Path: fieldPath(path, "voice_note_url"), Old: inputInlineQueryResultVoiceNote.VoiceNoteUrl, New: other.VoiceNoteUrl})
+	}
+	if inputInlineQueryResultVoiceNote.VoiceNoteDuration != other.VoiceNoteDuration {
+		changes = append(changes, Change{Path: fieldPath(path, "voice_note_duration"), Old: inputInlineQueryResultVoiceNote.VoiceNoteDuration, New: other.VoiceNoteDuration})
+	}
+	changes = diffReplyMarkup(fieldPath(path, "reply_markup"), inputInlineQueryResultVoiceNote.ReplyMarkup, other.ReplyMarkup, changes)
+	changes = diffInputMessageContent(fieldPath(path, "input_message_content"), inputInlineQueryResultVoiceNote.InputMessageContent, other.InputMessageContent, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputInlineQueryResultVoiceNote *InputInlineQueryResultVoiceNote) String() string {
+	return objectString(inputInlineQueryResultVoiceNote)
+}
+
+// LogValue logs the object as its summary
+func (inputInlineQueryResultVoiceNote *InputInlineQueryResultVoiceNote) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputInlineQueryResultVoiceNote))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputInlineQueryResultVoiceNote *InputInlineQueryResultVoiceNote) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputInlineQueryResultVoiceNote)
+}
+
+func (inputInlineQueryResultVoiceNote *InputInlineQueryResultVoiceNote) printText(p *printer) {
+	if inputInlineQueryResultVoiceNote == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputInlineQueryResultVoiceNote")
+	p.string("id", inputInlineQueryResultVoiceNote.Id)
+	p.string("title", inputInlineQueryResultVoiceNote.Title)
+	p.string("voice_note_url", inputInlineQueryResultVoiceNote.VoiceNoteUrl)
+	p.int("voice_note_duration", int64(inputInlineQueryResultVoiceNote.VoiceNoteDuration))
+	p.object("reply_markup", inputInlineQueryResultVoiceNote.ReplyMarkup)
+	p.object("input_message_content", inputInlineQueryResultVoiceNote.InputMessageContent)
+	p.endObject(state)
 }
 
 // GetInputInlineQueryResultEnum return the enum type of this object
 func (inputInlineQueryResultVoiceNote *InputInlineQueryResultVoiceNote) GetInputInlineQueryResultEnum() InputInlineQueryResultEnum {
 	return InputInlineQueryResultVoiceNoteType
 }
+
+// Accept calls the method of the visitor for InputInlineQueryResultVoiceNote
+func (inputInlineQueryResultVoiceNote *InputInlineQueryResultVoiceNote) Accept(visitor InputInlineQueryResultVisitor) {
+	visitor.VisitInputInlineQueryResultVoiceNote(inputInlineQueryResultVoiceNote)
+}
+
+// InputInlineQueryResultVisitor visits every type of InputInlineQueryResult, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to InputInlineQueryResult, until they handle it too.
+type InputInlineQueryResultVisitor interface {
+	VisitInputInlineQueryResultAnimation(inputInlineQueryResultAnimation *InputInlineQueryResultAnimation)
+	VisitInputInlineQueryResultArticle(inputInlineQueryResultArticle *InputInlineQueryResultArticle)
+	VisitInputInlineQueryResultAudio(inputInlineQueryResultAudio *InputInlineQueryResultAudio)
+	VisitInputInlineQueryResultContact(inputInlineQueryResultContact *InputInlineQueryResultContact)
+	VisitInputInlineQueryResultDocument(inputInlineQueryResultDocument *InputInlineQueryResultDocument)
+	VisitInputInlineQueryResultGame(inputInlineQueryResultGame *InputInlineQueryResultGame)
+	VisitInputInlineQueryResultLocation(inputInlineQueryResultLocation *InputInlineQueryResultLocation)
+	VisitInputInlineQueryResultPhoto(inputInlineQueryResultPhoto *InputInlineQueryResultPhoto)
+	VisitInputInlineQueryResultSticker(inputInlineQueryResultSticker *InputInlineQueryResultSticker)
+	VisitInputInlineQueryResultVenue(inputInlineQueryResultVenue *InputInlineQueryResultVenue)
+	VisitInputInlineQueryResultVideo(inputInlineQueryResultVideo *InputInlineQueryResultVideo)
+	VisitInputInlineQueryResultVoiceNote(inputInlineQueryResultVoiceNote *InputInlineQueryResultVoiceNote)
+	VisitUnknownInputInlineQueryResult(unknownInputInlineQueryResult *UnknownInputInlineQueryResult)
+}
+
+// InputInlineQueryResultCases holds the function MatchInputInlineQueryResult calls for each type of InputInlineQueryResult;
+// types without a function are passed to the default case
+type InputInlineQueryResultCases[R any] struct {
+	InputInlineQueryResultAnimation func(inputInlineQueryResultAnimation *InputInlineQueryResultAnimation) R
+	InputInlineQueryResultArticle   func(inputInlineQueryResultArticle *InputInlineQueryResultArticle) R
+	InputInlineQueryResultAudio     func(inputInlineQueryResultAudio *InputInlineQueryResultAudio) R
+	InputInlineQueryResultContact   func(inputInlineQueryResultContact *InputInlineQueryResultContact) R
+	InputInlineQueryResultDocument  func(inputInlineQueryResultDocument *InputInlineQueryResultDocument) R
+	InputInlineQueryResultGame      func(inputInlineQueryResultGame *InputInlineQueryResultGame) R
+	InputInlineQueryResultLocation  func(inputInlineQueryResultLocation *InputInlineQueryResultLocation) R
+	InputInlineQueryResultPhoto     func(inputInlineQueryResultPhoto *InputInlineQueryResultPhoto) R
+	InputInlineQueryResultSticker   func(inputInlineQueryResultSticker *InputInlineQueryResultSticker) R
+	InputInlineQueryResultVenue     func(inputInlineQueryResultVenue *InputInlineQueryResultVenue) R
+	InputInlineQueryResultVideo     func(inputInlineQueryResultVideo *InputInlineQueryResultVideo) R
+	InputInlineQueryResultVoiceNote func(inputInlineQueryResultVoiceNote *InputInlineQueryResultVoiceNote) R
+}
+
+// MatchInputInlineQueryResult calls the case of the type of inputInlineQueryResult, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement InputInlineQueryResultVisitor
+// to have the compiler check every type is handled.
+func MatchInputInlineQueryResult[R any](inputInlineQueryResult InputInlineQueryResult, cases InputInlineQueryResultCases[R], defaultCase func(inputInlineQueryResult InputInlineQueryResult) R) R {
+	switch value := inputInlineQueryResult.(type) {
+	case *InputInlineQueryResultAnimation:
+		if cases.InputInlineQueryResultAnimation != nil {
+			return cases.InputInlineQueryResultAnimation(value)
+		}
+	case *InputInlineQueryResultArticle:
+		if cases.InputInlineQueryResultArticle != nil {
+			return cases.InputInlineQueryResultArticle(value)
+		}
+	case *InputInlineQueryResultAudio:
+		if cases.InputInlineQueryResultAudio != nil {
+			return cases.InputInlineQueryResultAudio(value)
+		}
+	case *InputInlineQueryResultContact:
+		if cases.InputInlineQueryResultContact != nil {
+			return cases.InputInlineQueryResultContact(value)
+		}
+	case *InputInlineQueryResultDocument:
+		if cases.InputInlineQueryResultDocument != nil {
+			return cases.InputInlineQueryResultDocument(value)
+		}
+	case *InputInlineQueryResultGame:
+		if cases.InputInlineQueryResultGame != nil {
+			return cases.InputInlineQueryResultGame(value)
+		}
+	case *InputInlineQueryResultLocation:
+		if cases.InputInlineQueryResultLocation != nil {
+			return cases.InputInlineQueryResultLocation(value)
+		}
+	case *InputInlineQueryResultPhoto:
+		if cases.InputInlineQueryResultPhoto != nil {
+			return cases.InputInlineQueryResultPhoto(value)
+		}
+	case *InputInlineQueryResultSticker:
+		if cases.InputInlineQueryResultSticker != nil {
+			return cases.InputInlineQueryResultSticker(value)
+		}
+	case *InputInlineQueryResultVenue:
+		if cases.InputInlineQueryResultVenue != nil {
+			return cases.InputInlineQueryResultVenue(value)
+		}
+	case *InputInlineQueryResultVideo:
+		if cases.InputInlineQueryResultVideo != nil {
+			return cases.InputInlineQueryResultVideo(value)
+		}
+	case *InputInlineQueryResultVoiceNote:
+		if cases.InputInlineQueryResultVoiceNote != nil {
+			return cases.InputInlineQueryResultVoiceNote(value)
+		}
+	}
+	return defaultCase(inputInlineQueryResult)
+}
+
+// Clone returns a deep copy of the object
+func (unknownInputInlineQueryResult *UnknownInputInlineQueryResult) Clone() *UnknownInputInlineQueryResult {
+	if unknownInputInlineQueryResult == nil {
+		return nil
+	}
+	return &UnknownInputInlineQueryResult{Unknown: unknownInputInlineQueryResult.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownInputInlineQueryResult *UnknownInputInlineQueryResult) Equal(other *UnknownInputInlineQueryResult) bool {
+	if unknownInputInlineQueryResult == nil || other == nil {
+		return unknownInputInlineQueryResult == other
+	}
+	return unknownInputInlineQueryResult.Unknown.equal(other.Unknown)
+}
+
+func cloneInputInlineQueryResult(inputInlineQueryResult InputInlineQueryResult) InputInlineQueryResult {
+	switch value := inputInlineQueryResult.(type) {
+	case *InputInlineQueryResultAnimation:
+		return value.Clone()
+	case *InputInlineQueryResultArticle:
+		return value.Clone()
+	case *InputInlineQueryResultAudio:
+		return value.Clone()
+	case *InputInlineQueryResultContact:
+		return value.Clone()
+	case *InputInlineQueryResultDocument:
+		return value.Clone()
+	case *InputInlineQueryResultGame:
+		return value.Clone()
+	case *InputInlineQueryResultLocation:
+		return value.Clone()
+	case *InputInlineQueryResultPhoto:
+		return value.Clone()
+	case *InputInlineQueryResultSticker:
+		return value.Clone()
+	case *InputInlineQueryResultVenue:
+		return value.Clone()
+	case *InputInlineQueryResultVideo:
+		return value.Clone()
+	case *InputInlineQueryResultVoiceNote:
+		return value.Clone()
+	case *UnknownInputInlineQueryResult:
+		return value.Clone()
+	}
+	return inputInlineQueryResult
+}
+
+func equalInputInlineQueryResult(a InputInlineQueryResult, b InputInlineQueryResult) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *InputInlineQueryResultAnimation:
+		b, ok := b.(*InputInlineQueryResultAnimation)
+		return ok && a.Equal(b)
+	case *InputInlineQueryResultArticle:
+		b, ok := b.(*InputInlineQueryResultArticle)
+		return ok && a.Equal(b)
+	case *InputInlineQueryResultAudio:
+		b, ok := b.(*InputInlineQueryResultAudio)
+		return ok && a.Equal(b)
+	case *InputInlineQueryResultContact:
+		b, ok := b.(*InputInlineQueryResultContact)
+		return ok && a.Equal(b)
+	case *InputInlineQueryResultDocument:
+		b, ok := b.(*InputInlineQueryResultDocument)
+		return ok && a.Equal(b)
+	case *InputInlineQueryResultGame:
+		b, ok := b.(*InputInlineQueryResultGame)
+		return ok && a.Equal(b)
+	case *InputInlineQueryResultLocation:
+		b, ok := b.(*InputInlineQueryResultLocation)
+		return ok && a.Equal(b)
+	case *InputInlineQueryResultPhoto:
+		b, ok := b.(*InputInlineQueryResultPhoto)
+		return ok && a.Equal(b)
+	case *InputInlineQueryResultSticker:
+		b, ok := b.(*InputInlineQueryResultSticker)
+		return ok && a.Equal(b)
+	case *InputInlineQueryResultVenue:
+		b, ok := b.(*InputInlineQueryResultVenue)
+		return ok && a.Equal(b)
+	case *InputInlineQueryResultVideo:
+		b, ok := b.(*InputInlineQueryResultVideo)
+		return ok && a.Equal(b)
+	case *InputInlineQueryResultVoiceNote:
+		b, ok := b.(*InputInlineQueryResultVoiceNote)
+		return ok && a.Equal(b)
+	case *UnknownInputInlineQueryResult:
+		b, ok := b.(*UnknownInputInlineQueryResult)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffInputInlineQueryResult(path string, a InputInlineQueryResult, b InputInlineQueryResult, changes []Change) []Change {
+	switch a := a.(type) {
+	case *InputInlineQueryResultAnimation:
+		if b, ok := b.(*InputInlineQueryResultAnimation); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputInlineQueryResultArticle:
+		if b, ok := b.(*InputInlineQueryResultArticle); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputInlineQueryResultAudio:
+		if b, ok := b.(*InputInlineQueryResultAudio); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputInlineQueryResultContact:
+		if b, ok := b.(*InputInlineQueryResultContact); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputInlineQueryResultDocument:
+		if b, ok := b.(*InputInlineQueryResultDocument); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputInlineQueryResultGame:
+		if b, ok := b.(*InputInlineQueryResultGame); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputInlineQueryResultLocation:
+		if b, ok := b.(*InputInlineQueryResultLocation); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputInlineQueryResultPhoto:
+		if b, ok := b.(*InputInlineQueryResultPhoto); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputInlineQueryResultSticker:
+		if b, ok := b.(*InputInlineQueryResultSticker); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputInlineQueryResultVenue:
+		if b, ok := b.(*InputInlineQueryResultVenue); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputInlineQueryResultVideo:
+		if b, ok := b.(*InputInlineQueryResultVideo); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputInlineQueryResultVoiceNote:
+		if b, ok := b.(*InputInlineQueryResultVoiceNote); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalInputInlineQueryResult(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
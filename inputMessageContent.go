@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // InputMessageContent The content of a message to send
 type InputMessageContent interface {
 	GetInputMessageContentEnum() InputMessageContentEnum
+	Accept(visitor InputMessageContentVisitor)
 }
 
 // InputMessageContentEnum Alias for abstract InputMessageContent 'Sub-Classes', used as constant-enum here
@@ -34,108 +36,159 @@ const (
 	InputMessageForwardedType InputMessageContentEnum = "inputMessageForwarded"
 )
 
+// UnknownInputMessageContent is an InputMessageContent of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownInputMessageContent struct {
+	Unknown
+}
+
+// GetInputMessageContentEnum return the enum type of this object
+func (unknownInputMessageContent *UnknownInputMessageContent) GetInputMessageContentEnum() InputMessageContentEnum {
+	return InputMessageContentEnum(unknownInputMessageContent.Type)
+}
+
+// Accept calls the method of the visitor for UnknownInputMessageContent
+func (unknownInputMessageContent *UnknownInputMessageContent) Accept(visitor InputMessageContentVisitor) {
+	visitor.VisitUnknownInputMessageContent(unknownInputMessageContent)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownInputMessageContent *UnknownInputMessageContent) String() string {
+	return objectString(unknownInputMessageContent)
+}
+
+// LogValue logs the object as its summary
+func (unknownInputMessageContent *UnknownInputMessageContent) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownInputMessageContent))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownInputMessageContent *UnknownInputMessageContent) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownInputMessageContent)
+}
+
+func (unknownInputMessageContent *UnknownInputMessageContent) printText(p *printer) {
+	if unknownInputMessageContent == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownInputMessageContent")
+	p.string("@type", unknownInputMessageContent.Type)
+	p.endObject(state)
+}
+
 func unmarshalInputMessageContent(rawMsg *json.RawMessage) (InputMessageContent, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch InputMessageContentEnum(objMap["@type"].(string)) {
+	switch InputMessageContentEnum(typeName) {
 	case InputMessageTextType:
 		var inputMessageText InputMessageText
-		err := json.Unmarshal(*rawMsg, &inputMessageText)
+		err := jsonUnmarshal(*rawMsg, &inputMessageText)
 		return &inputMessageText, err
 
 	case InputMessageAnimationType:
 		var inputMessageAnimation InputMessageAnimation
-		err := json.Unmarshal(*rawMsg, &inputMessageAnimation)
+		err := jsonUnmarshal(*rawMsg, &inputMessageAnimation)
 		return &inputMessageAnimation, err
 
 	case InputMessageAudioType:
 		var inputMessageAudio InputMessageAudio
-		err := json.Unmarshal(*rawMsg, &inputMessageAudio)
+		err := jsonUnmarshal(*rawMsg, &inputMessageAudio)
 		return &inputMessageAudio, err
 
 	case InputMessageDocumentType:
 		var inputMessageDocument InputMessageDocument
-		err := json.Unmarshal(*rawMsg, &inputMessageDocument)
+		err := jsonUnmarshal(*rawMsg, &inputMessageDocument)
 		return &inputMessageDocument, err
 
 	case InputMessagePhotoType:
 		var inputMessagePhoto InputMessagePhoto
-		err := json.Unmarshal(*rawMsg, &inputMessagePhoto)
+		err := jsonUnmarshal(*rawMsg, &inputMessagePhoto)
 		return &inputMessagePhoto, err
 
 	case InputMessageStickerType:
 		var inputMessageSticker InputMessageSticker
-		err := json.Unmarshal(*rawMsg, &inputMessageSticker)
+		err := jsonUnmarshal(*rawMsg, &inputMessageSticker)
 		return &inputMessageSticker, err
 
 	case InputMessageVideoType:
 		var inputMessageVideo InputMessageVideo
-		err := json.Unmarshal(*rawMsg, &inputMessageVideo)
+		err := jsonUnmarshal(*rawMsg, &inputMessageVideo)
 		return &inputMessageVideo, err
 
 	case InputMessageVideoNoteType:
 		var inputMessageVideoNote InputMessageVideoNote
-		err := json.Unmarshal(*rawMsg, &inputMessageVideoNote)
+		err := jsonUnmarshal(*rawMsg, &inputMessageVideoNote)
 		return &inputMessageVideoNote, err
 
 	case InputMessageVoiceNoteType:
 		var inputMessageVoiceNote InputMessageVoiceNote
-		err := json.Unmarshal(*rawMsg, &inputMessageVoiceNote)
+		err := jsonUnmarshal(*rawMsg, &inputMessageVoiceNote)
 		return &inputMessageVoiceNote, err
 
 	case InputMessageLocationType:
 		var inputMessageLocation InputMessageLocation
-		err := json.Unmarshal(*rawMsg, &inputMessageLocation)
+		err := jsonUnmarshal(*rawMsg, &inputMessageLocation)
 		return &inputMessageLocation, err
 
 	case InputMessageVenueType:
 		var inputMessageVenue InputMessageVenue
-		err := json.Unmarshal(*rawMsg, &inputMessageVenue)
+		err := jsonUnmarshal(*rawMsg, &inputMessageVenue)
 		return &inputMessageVenue, err
 
 	case InputMessageContactType:
 		var inputMessageContact InputMessageContact
-		err := json.Unmarshal(*rawMsg, &inputMessageContact)
+		err := jsonUnmarshal(*rawMsg, &inputMessageContact)
 		return &inputMessageContact, err
 
 	case InputMessageDiceType:
 		var inputMessageDice InputMessageDice
-		err := json.Unmarshal(*rawMsg, &inputMessageDice)
+		err := jsonUnmarshal(*rawMsg, &inputMessageDice)
 		return &inputMessageDice, err
 
 	case InputMessageGameType:
 		var inputMessageGame InputMessageGame
-		err := json.Unmarshal(*rawMsg, &inputMessageGame)
+		err := jsonUnmarshal(*rawMsg, &inputMessageGame)
 		return &inputMessageGame, err
 
 	case InputMessageInvoiceType:
 		var inputMessageInvoice InputMessageInvoice
-		err := json.Unmarshal(*rawMsg, &inputMessageInvoice)
+		err := jsonUnmarshal(*rawMsg, &inputMessageInvoice)
 		return &inputMessageInvoice, err
 
 	case InputMessagePollType:
 		var inputMessagePoll InputMessagePoll
-		err := json.Unmarshal(*rawMsg, &inputMessagePoll)
+		err := jsonUnmarshal(*rawMsg, &inputMessagePoll)
 		return &inputMessagePoll, err
 
 	case InputMessageForwardedType:
 		var inputMessageForwarded InputMessageForwarded
-		err := json.Unmarshal(*rawMsg, &inputMessageForwarded)
+		err := jsonUnmarshal(*rawMsg, &inputMessageForwarded)
 		return &inputMessageForwarded, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownInputMessageContent{Unknown: unknown}, nil
 	}
 }
 
+func decodeInputMessageContent(r *jsonReader) (InputMessageContent, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalInputMessageContent(rawMsg)
+}
+
 // InputMessageText A text message
 type InputMessageText struct {
 	tdCommon
@@ -165,11 +218,148 @@ func NewInputMessageText(text *FormattedText, disableWebPagePreview bool, clearD
 	return &inputMessageTextTemp
 }
 
+// MarshalJSON marshals to json
+func (inputMessageText *InputMessageText) MarshalJSON() ([]byte, error) {
+	return inputMessageText.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputMessageText *InputMessageText) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputMessageText)
+}
+
+func (inputMessageText *InputMessageText) appendJSON(b []byte) ([]byte, error) {
+	if inputMessageText == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputMessageText.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputMessageText.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b, err = inputMessageText.Text.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"disable_web_page_preview":`...)
+	b = appendJSONBool(b, inputMessageText.DisableWebPagePreview)
+	b = append(b, `,"clear_draft":`...)
+	b = appendJSONBool(b, inputMessageText.ClearDraft)
+	return append(b, '}'), nil
+}
+
+func (inputMessageText *InputMessageText) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputMessageText.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputMessageText.tdCommon.Extra, err = r.readString()
+		case "text":
+			if r.readNull() {
+				inputMessageText.Text = nil
+			} else {
+				inputMessageText.Text = new(FormattedText)
+				err = inputMessageText.Text.decodeJSON(r)
+			}
+		case "disable_web_page_preview":
+			inputMessageText.DisableWebPagePreview, err = r.readBool()
+		case "clear_draft":
+			inputMessageText.ClearDraft, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputMessageText *InputMessageText) Clone() *InputMessageText {
+	if inputMessageText == nil {
+		return nil
+	}
+	clone := *inputMessageText
+	clone.Text = inputMessageText.Text.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputMessageText *InputMessageText) Equal(other *InputMessageText) bool {
+	if inputMessageText == nil || other == nil {
+		return inputMessageText == other
+	}
+	if !inputMessageText.Text.Equal(other.Text) {
+		return false
+	}
+	if inputMessageText.DisableWebPagePreview != other.DisableWebPagePreview {
+		return false
+	}
+	if inputMessageText.ClearDraft != other.ClearDraft {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputMessageText *InputMessageText) Diff(other *InputMessageText) []Change {
+	return inputMessageText.diff("", other, nil)
+}
+
+func (inputMessageText *InputMessageText) diff(path string, other *InputMessageText, changes []Change) []Change {
+	if inputMessageText == nil || other == nil {
+		if inputMessageText != other {
+			changes = append(changes, Change{Path: path, Old: inputMessageText, New: other})
+		}
+		return changes
+	}
+	changes = inputMessageText.Text.diff(fieldPath(path, "text"), other.Text, changes)
+	if inputMessageText.DisableWebPagePreview != other.DisableWebPagePreview {
+		changes = append(changes, Change{Path: fieldPath(path, "disable_web_page_preview"), Old: inputMessageText.DisableWebPagePreview, New: other.DisableWebPagePreview})
+	}
+	if inputMessageText.ClearDraft != other.ClearDraft {
+		changes = append(changes, Change{Path: fieldPath(path, "clear_draft"), Old: inputMessageText.ClearDraft, New: other.ClearDraft})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputMessageText *InputMessageText) String() string {
+	return objectString(inputMessageText)
+}
+
+// LogValue logs the object as its summary
+func (inputMessageText *InputMessageText) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputMessageText))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputMessageText *InputMessageText) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputMessageText)
+}
+
+func (inputMessageText *InputMessageText) printText(p *printer) {
+	if inputMessageText == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputMessageText")
+	p.object("text", inputMessageText.Text)
+	p.bool("disable_web_page_preview", inputMessageText.DisableWebPagePreview)
+	p.bool("clear_draft", inputMessageText.ClearDraft)
+	p.endObject(state)
+}
+
 // GetInputMessageContentEnum return the enum type of this object
 func (inputMessageText *InputMessageText) GetInputMessageContentEnum() InputMessageContentEnum {
 	return InputMessageTextType
 }
 
+// Accept calls the method of the visitor for InputMessageText
+func (inputMessageText *InputMessageText) Accept(visitor InputMessageContentVisitor) {
+	visitor.VisitInputMessageText(inputMessageText)
+}
+
 // InputMessageAnimation An animation message (GIF-style).
 type InputMessageAnimation struct {
 	tdCommon
@@ -211,39 +401,227 @@ func NewInputMessageAnimation(animation InputFile, thumbnail *InputThumbnail, ad
 	return &inputMessageAnimationTemp
 }
 
+// MarshalJSON marshals to json
+func (inputMessageAnimation *InputMessageAnimation) MarshalJSON() ([]byte, error) {
+	return inputMessageAnimation.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputMessageAnimation *InputMessageAnimation) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, inputMessageAnimation)
+}
+
+func (inputMessageAnimation *InputMessageAnimation) appendJSON(b []byte) ([]byte, error) {
+	if inputMessageAnimation == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputMessageAnimation.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputMessageAnimation.tdCommon.Extra)
+	b = append(b, `,"animation":`...)
+	b, err = appendJSONValue(b, inputMessageAnimation.Animation)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"thumbnail":`...)
+	b, err = inputMessageAnimation.Thumbnail.appendJSON(b)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Thumbnail           *InputThumbnail `json:"thumbnail"`              // Animation thumbnail; pass null to skip thumbnail uploading
-		AddedStickerFileIds []int32         `json:"added_sticker_file_ids"` // File identifiers of the stickers added to the animation, if applicable
-		Duration            int32           `json:"duration"`               // Duration of the animation, in seconds
-		Width               int32           `json:"width"`                  // Width of the animation; may be replaced by the server
-		Height              int32           `json:"height"`                 // Height of the animation; may be replaced by the server
-		Caption             *FormattedText  `json:"caption"`                // Animation caption; pass null to use an empty caption; 0-GetOption("message_caption_length_max") characters
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"added_sticker_file_ids":`...)
+	if inputMessageAnimation.AddedStickerFileIds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range inputMessageAnimation.AddedStickerFileIds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(inputMessageAnimation.AddedStickerFileIds[i0]))
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"duration":`...)
+	b = appendJSONInt(b, int64(inputMessageAnimation.Duration))
+	b = append(b, `,"width":`...)
+	b = appendJSONInt(b, int64(inputMessageAnimation.Width))
+	b = append(b, `,"height":`...)
+	b = appendJSONInt(b, int64(inputMessageAnimation.Height))
+	b = append(b, `,"caption":`...)
+	b, err = inputMessageAnimation.Caption.appendJSON(b)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inputMessageAnimation *InputMessageAnimation) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputMessageAnimation.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputMessageAnimation.tdCommon.Extra, err = r.readString()
+		case "animation":
+			inputMessageAnimation.Animation, err = decodeInputFile(r)
+		case "thumbnail":
+			if r.readNull() {
+				inputMessageAnimation.Thumbnail = nil
+			} else {
+				inputMessageAnimation.Thumbnail = new(InputThumbnail)
+				err = inputMessageAnimation.Thumbnail.decodeJSON(r)
+			}
+		case "added_sticker_file_ids":
+			if r.readNull() {
+				inputMessageAnimation.AddedStickerFileIds = nil
+			} else {
+				inputMessageAnimation.AddedStickerFileIds = make([]int32, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int32
+					item0, err = r.readInt32()
+					inputMessageAnimation.AddedStickerFileIds = append(inputMessageAnimation.AddedStickerFileIds, item0)
+					return
+				})
+			}
+		case "duration":
+			inputMessageAnimation.Duration, err = r.readInt32()
+		case "width":
+			inputMessageAnimation.Width, err = r.readInt32()
+		case "height":
+			inputMessageAnimation.Height, err = r.readInt32()
+		case "caption":
+			if r.readNull() {
+				inputMessageAnimation.Caption = nil
+			} else {
+				inputMessageAnimation.Caption = new(FormattedText)
+				err = inputMessageAnimation.Caption.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputMessageAnimation *InputMessageAnimation) Clone() *InputMessageAnimation {
+	if inputMessageAnimation == nil {
+		return nil
+	}
+	clone := *inputMessageAnimation
+	clone.Animation = cloneInputFile(inputMessageAnimation.Animation)
+	clone.Thumbnail = inputMessageAnimation.Thumbnail.Clone()
+	if inputMessageAnimation.AddedStickerFileIds != nil {
+		clone.AddedStickerFileIds = make([]int32, len(inputMessageAnimation.AddedStickerFileIds))
+		copy(clone.AddedStickerFileIds, inputMessageAnimation.AddedStickerFileIds)
+	}
+	clone.Caption = inputMessageAnimation.Caption.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputMessageAnimation *InputMessageAnimation) Equal(other *InputMessageAnimation) bool {
+	if inputMessageAnimation == nil || other == nil {
+		return inputMessageAnimation == other
+	}
+	if !equalInputFile(inputMessageAnimation.Animation, other.Animation) {
+		return false
+	}
+	if !inputMessageAnimation.Thumbnail.Equal(other.Thumbnail) {
+		return false
+	}
+	if len(inputMessageAnimation.AddedStickerFileIds) != len(other.AddedStickerFileIds) {
+		return false
+	}
+	for i0 := range inputMessageAnimation.AddedStickerFileIds {
+		if inputMessageAnimation.AddedStickerFileIds[i0] != other.AddedStickerFileIds[i0] {
+			return false
+		}
+	}
+	if inputMessageAnimation.Duration != other.Duration {
+		return false
+	}
+	if inputMessageAnimation.Width != other.Width {
+		return false
 	}
+	if inputMessageAnimation.Height != other.Height {
+		return false
+	}
+	if !inputMessageAnimation.Caption.Equal(other.Caption) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputMessageAnimation *InputMessageAnimation) Diff(other *InputMessageAnimation) []Change {
+	return inputMessageAnimation.diff("", other, nil)
+}
+
+func (inputMessageAnimation *InputMessageAnimation) diff(path string, other *InputMessageAnimation, changes []Change) []Change {
+	if inputMessageAnimation == nil || other == nil {
+		if inputMessageAnimation != other {
+			changes = append(changes, Change{Path: path, Old: inputMessageAnimation, New: other})
+		}
+		return changes
+	}
+	changes = diffInputFile(fieldPath(path, "animation"), inputMessageAnimation.Animation, other.Animation, changes)
+	changes = inputMessageAnimation.Thumbnail.diff(fieldPath(path, "thumbnail"), other.Thumbnail, changes)
+	if len(inputMessageAnimation.AddedStickerFileIds) != len(other.AddedStickerFileIds) {
+		changes = append(changes, Change{Path: fieldPath(path, "added_sticker_file_ids"), Old: inputMessageAnimation.AddedStickerFileIds, New: other.AddedStickerFileIds})
+	} else {
+		for i0 := range inputMessageAnimation.AddedStickerFileIds {
+			if inputMessageAnimation.AddedStickerFileIds[i0] != other.AddedStickerFileIds[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "added_sticker_file_ids"), i0), Old: inputMessageAnimation.AddedStickerFileIds[i0], New: other.AddedStickerFileIds[i0]})
+			}
+		}
+	}
+	if inputMessageAnimation.Duration != other.Duration {
+		changes = append(changes, Change{Path: fieldPath(path, "duration"), Old: inputMessageAnimation.Duration, New: other.Duration})
+	}
+	if inputMessageAnimation.Width != other.Width {
+		changes = append(changes, Change{Path: fieldPath(path, "width"), Old: inputMessageAnimation.Width, New: other.Width})
+	}
+	if inputMessageAnimation.Height != other.Height {
+		changes = append(changes, Change{Path: fieldPath(path, "height"), Old: inputMessageAnimation.Height, New: other.Height})
+	}
+	changes = inputMessageAnimation.Caption.diff(fieldPath(path, "caption"), other.Caption, changes)
+	return changes
+}
 
-	inputMessageAnimation.tdCommon = tempObj.tdCommon
-	inputMessageAnimation.Thumbnail = tempObj.Thumbnail
-	inputMessageAnimation.AddedStickerFileIds = tempObj.AddedStickerFileIds
-	inputMessageAnimation.Duration = tempObj.Duration
-	inputMessageAnimation.Width = tempObj.Width
-	inputMessageAnimation.Height = tempObj.Height
-	inputMessageAnimation.Caption = tempObj.Caption
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputMessageAnimation *InputMessageAnimation) String() string {
+	return objectString(inputMessageAnimation)
+}
+
+// LogValue logs the object as its summary
+func (inputMessageAnimation *InputMessageAnimation) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputMessageAnimation))
+}
 
-	fieldAnimation, _ := unmarshalInputFile(objMap["animation"])
-	inputMessageAnimation.Animation = fieldAnimation
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputMessageAnimation *InputMessageAnimation) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputMessageAnimation)
+}
 
-	return nil
+func (inputMessageAnimation *InputMessageAnimation) printText(p *printer) {
+	if inputMessageAnimation == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputMessageAnimation")
+	p.object("animation", inputMessageAnimation.Animation)
+	p.object("thumbnail", inputMessageAnimation.Thumbnail)
+	p.vector("added_sticker_file_ids", len(inputMessageAnimation.AddedStickerFileIds), func(i0 int) {
+		p.int("added_sticker_file_ids", int64(inputMessageAnimation.AddedStickerFileIds[i0]))
+	})
+	p.int("duration", int64(inputMessageAnimation.Duration))
+	p.int("width", int64(inputMessageAnimation.Width))
+	p.int("height", int64(inputMessageAnimation.Height))
+	p.object("caption", inputMessageAnimation.Caption)
+	p.endObject(state)
 }
 
 // GetInputMessageContentEnum return the enum type of this object
@@ -251,6 +629,11 @@ func (inputMessageAnimation *InputMessageAnimation) GetInputMessageContentEnum()
 	return InputMessageAnimationType
 }
 
+// Accept calls the method of the visitor for InputMessageAnimation
+func (inputMessageAnimation *InputMessageAnimation) Accept(visitor InputMessageContentVisitor) {
+	visitor.VisitInputMessageAnimation(inputMessageAnimation)
+}
+
 // InputMessageAudio An audio message
 type InputMessageAudio struct {
 	tdCommon
@@ -289,37 +672,178 @@ func NewInputMessageAudio(audio InputFile, albumCoverThumbnail *InputThumbnail,
 	return &inputMessageAudioTemp
 }
 
+// MarshalJSON marshals to json
+func (inputMessageAudio *InputMessageAudio) MarshalJSON() ([]byte, error) {
+	return inputMessageAudio.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputMessageAudio *InputMessageAudio) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, inputMessageAudio)
+}
+
+func (inputMessageAudio *InputMessageAudio) appendJSON(b []byte) ([]byte, error) {
+	if inputMessageAudio == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputMessageAudio.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputMessageAudio.tdCommon.Extra)
+	b = append(b, `,"audio":`...)
+	b, err = appendJSONValue(b, inputMessageAudio.Audio)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		AlbumCoverThumbnail *InputThumbnail `json:"album_cover_thumbnail"` // Thumbnail of the cover for the album; pass null to skip thumbnail uploading
-		Duration            int32           `json:"duration"`              // Duration of the audio, in seconds; may be replaced by the server
-		Title               string          `json:"title"`                 // Title of the audio; 0-64 characters; may be replaced by the server
-		Performer           string          `json:"performer"`             // Performer of the audio; 0-64 characters, may be replaced by the server
-		Caption             *FormattedText  `json:"caption"`               // Audio caption; pass null to use an empty caption; 0-GetOption("message_caption_length_max") characters
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"album_cover_thumbnail":`...)
+	b, err = inputMessageAudio.AlbumCoverThumbnail.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"duration":`...)
+	b = appendJSONInt(b, int64(inputMessageAudio.Duration))
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, inputMessageAudio.Title)
+	b = append(b, `,"performer":`...)
+	b = appendJSONString(b, inputMessageAudio.Performer)
+	b = append(b, `,"caption":`...)
+	b, err = inputMessageAudio.Caption.appendJSON(b)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inputMessageAudio *InputMessageAudio) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputMessageAudio.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputMessageAudio.tdCommon.Extra, err = r.readString()
+		case "audio":
+			inputMessageAudio.Audio, err = decodeInputFile(r)
+		case "album_cover_thumbnail":
+			if r.readNull() {
+				inputMessageAudio.AlbumCoverThumbnail = nil
+			} else {
+				inputMessageAudio.AlbumCoverThumbnail = new(InputThumbnail)
+				err = inputMessageAudio.AlbumCoverThumbnail.decodeJSON(r)
+			}
+		case "duration":
+			inputMessageAudio.Duration, err = r.readInt32()
+		case "title":
+			inputMessageAudio.Title, err = r.readString()
+		case "performer":
+			inputMessageAudio.Performer, err = r.readString()
+		case "caption":
+			if r.readNull() {
+				inputMessageAudio.Caption = nil
+			} else {
+				inputMessageAudio.Caption = new(FormattedText)
+				err = inputMessageAudio.Caption.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputMessageAudio *InputMessageAudio) Clone() *InputMessageAudio {
+	if inputMessageAudio == nil {
+		return nil
+	}
+	clone := *inputMessageAudio
+	clone.Audio = cloneInputFile(inputMessageAudio.Audio)
+	clone.AlbumCoverThumbnail = inputMessageAudio.AlbumCoverThumbnail.Clone()
+	clone.Caption = inputMessageAudio.Caption.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputMessageAudio *InputMessageAudio) Equal(other *InputMessageAudio) bool {
+	if inputMessageAudio == nil || other == nil {
+		return inputMessageAudio == other
+	}
+	if !equalInputFile(inputMessageAudio.Audio, other.Audio) {
+		return false
+	}
+	if !inputMessageAudio.AlbumCoverThumbnail.Equal(other.AlbumCoverThumbnail) {
+		return false
+	}
+	if inputMessageAudio.Duration != other.Duration {
+		return false
+	}
+	if inputMessageAudio.Title != other.Title {
+		return false
+	}
+	if inputMessageAudio.Performer != other.Performer {
+		return false
+	}
+	if !inputMessageAudio.Caption.Equal(other.Caption) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputMessageAudio *InputMessageAudio) Diff(other *InputMessageAudio) []Change {
+	return inputMessageAudio.diff("", other, nil)
+}
+
+func (inputMessageAudio *InputMessageAudio) diff(path string, other *InputMessageAudio, changes []Change) []Change {
+	if inputMessageAudio == nil || other == nil {
+		if inputMessageAudio != other {
+			changes = append(changes, Change{Path: path, Old: inputMessageAudio, New: other})
+		}
+		return changes
+	}
+	changes = diffInputFile(fieldPath(path, "audio"), inputMessageAudio.Audio, other.Audio, changes)
+	changes = inputMessageAudio.AlbumCoverThumbnail.diff(fieldPath(path, "album_cover_thumbnail"), other.AlbumCoverThumbnail, changes)
+	if inputMessageAudio.Duration != other.Duration {
+		changes = append(changes, Change{Path: fieldPath(path, "duration"), Old: inputMessageAudio.Duration, New: other.Duration})
+	}
+	if inputMessageAudio.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: inputMessageAudio.Title, New: other.Title})
+	}
+	if inputMessageAudio.Performer != other.Performer {
+		changes = append(changes, Change{Path: fieldPath(path, "performer"), Old: inputMessageAudio.Performer, New: other.Performer})
 	}
+	changes = inputMessageAudio.Caption.diff(fieldPath(path, "caption"), other.Caption, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputMessageAudio *InputMessageAudio) String() string {
+	return objectString(inputMessageAudio)
+}
 
-	inputMessageAudio.tdCommon = tempObj.tdCommon
-	inputMessageAudio.AlbumCoverThumbnail = tempObj.AlbumCoverThumbnail
-	inputMessageAudio.Duration = tempObj.Duration
-	inputMessageAudio.Title = tempObj.Title
-	inputMessageAudio.Performer = tempObj.Performer
-	inputMessageAudio.Caption = tempObj.Caption
+// LogValue logs the object as its summary
+func (inputMessageAudio *InputMessageAudio) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputMessageAudio))
+}
 
-	fieldAudio, _ := unmarshalInputFile(objMap["audio"])
-	inputMessageAudio.Audio = fieldAudio
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputMessageAudio *InputMessageAudio) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputMessageAudio)
+}
 
-	return nil
+func (inputMessageAudio *InputMessageAudio) printText(p *printer) {
+	if inputMessageAudio == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputMessageAudio")
+	p.object("audio", inputMessageAudio.Audio)
+	p.object("album_cover_thumbnail", inputMessageAudio.AlbumCoverThumbnail)
+	p.int("duration", int64(inputMessageAudio.Duration))
+	p.string("title", inputMessageAudio.Title)
+	p.string("performer", inputMessageAudio.Performer)
+	p.object("caption", inputMessageAudio.Caption)
+	p.endObject(state)
 }
 
 // GetInputMessageContentEnum return the enum type of this object
@@ -327,6 +851,11 @@ func (inputMessageAudio *InputMessageAudio) GetInputMessageContentEnum() InputMe
 	return InputMessageAudioType
 }
 
+// Accept calls the method of the visitor for InputMessageAudio
+func (inputMessageAudio *InputMessageAudio) Accept(visitor InputMessageContentVisitor) {
+	visitor.VisitInputMessageAudio(inputMessageAudio)
+}
+
 // InputMessageDocument A document message (general file)
 type InputMessageDocument struct {
 	tdCommon
@@ -359,33 +888,156 @@ func NewInputMessageDocument(document InputFile, thumbnail *InputThumbnail, disa
 	return &inputMessageDocumentTemp
 }
 
+// MarshalJSON marshals to json
+func (inputMessageDocument *InputMessageDocument) MarshalJSON() ([]byte, error) {
+	return inputMessageDocument.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputMessageDocument *InputMessageDocument) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, inputMessageDocument)
+}
+
+func (inputMessageDocument *InputMessageDocument) appendJSON(b []byte) ([]byte, error) {
+	if inputMessageDocument == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputMessageDocument.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputMessageDocument.tdCommon.Extra)
+	b = append(b, `,"document":`...)
+	b, err = appendJSONValue(b, inputMessageDocument.Document)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"thumbnail":`...)
+	b, err = inputMessageDocument.Thumbnail.appendJSON(b)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Thumbnail                   *InputThumbnail `json:"thumbnail"`                      // Document thumbnail; pass null to skip thumbnail uploading
-		DisableContentTypeDetection bool            `json:"disable_content_type_detection"` // If true, automatic file type detection will be disabled and the document will be always sent as file. Always true for files sent to secret chats
-		Caption                     *FormattedText  `json:"caption"`                        // Document caption; pass null to use an empty caption; 0-GetOption("message_caption_length_max") characters
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"disable_content_type_detection":`...)
+	b = appendJSONBool(b, inputMessageDocument.DisableContentTypeDetection)
+	b = append(b, `,"caption":`...)
+	b, err = inputMessageDocument.Caption.appendJSON(b)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inputMessageDocument *InputMessageDocument) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputMessageDocument.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputMessageDocument.tdCommon.Extra, err = r.readString()
+		case "document":
+			inputMessageDocument.Document, err = decodeInputFile(r)
+		case "thumbnail":
+			if r.readNull() {
+				inputMessageDocument.Thumbnail = nil
+			} else {
+				inputMessageDocument.Thumbnail = new(InputThumbnail)
+				err = inputMessageDocument.Thumbnail.decodeJSON(r)
+			}
+		case "disable_content_type_detection":
+			inputMessageDocument.DisableContentTypeDetection, err = r.readBool()
+		case "caption":
+			if r.readNull() {
+				inputMessageDocument.Caption = nil
+			} else {
+				inputMessageDocument.Caption = new(FormattedText)
+				err = inputMessageDocument.Caption.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputMessageDocument *InputMessageDocument) Clone() *InputMessageDocument {
+	if inputMessageDocument == nil {
+		return nil
+	}
+	clone := *inputMessageDocument
+	clone.Document = cloneInputFile(inputMessageDocument.Document)
+	clone.Thumbnail = inputMessageDocument.Thumbnail.Clone()
+	clone.Caption = inputMessageDocument.Caption.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputMessageDocument *InputMessageDocument) Equal(other *InputMessageDocument) bool {
+	if inputMessageDocument == nil || other == nil {
+		return inputMessageDocument == other
+	}
+	if !equalInputFile(inputMessageDocument.Document, other.Document) {
+		return false
+	}
+	if !inputMessageDocument.Thumbnail.Equal(other.Thumbnail) {
+		return false
+	}
+	if inputMessageDocument.DisableContentTypeDetection != other.DisableContentTypeDetection {
+		return false
+	}
+	if !inputMessageDocument.Caption.Equal(other.Caption) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputMessageDocument *InputMessageDocument) Diff(other *InputMessageDocument) []Change {
+	return inputMessageDocument.diff("", other, nil)
+}
+
+func (inputMessageDocument *InputMessageDocument) diff(path string, other *InputMessageDocument, changes []Change) []Change {
+	if inputMessageDocument == nil || other == nil {
+		if inputMessageDocument != other {
+			changes = append(changes, Change{Path: path, Old: inputMessageDocument, New: other})
+		}
+		return changes
 	}
+	changes = diffInputFile(fieldPath(path, "document"), inputMessageDocument.Document, other.Document, changes)
+	changes = inputMessageDocument.Thumbnail.diff(fieldPath(path, "thumbnail"), other.Thumbnail, changes)
+	if inputMessageDocument.DisableContentTypeDetection != other.DisableContentTypeDetection {
+		changes = append(changes, Change{Path: fieldPath(path, "disable_content_type_detection"), Old: inputMessageDocument.DisableContentTypeDetection, New: other.DisableContentTypeDetection})
+	}
+	changes = inputMessageDocument.Caption.diff(fieldPath(path, "caption"), other.Caption, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputMessageDocument *InputMessageDocument) String() string {
+	return objectString(inputMessageDocument)
+}
 
-	inputMessageDocument.tdCommon = tempObj.tdCommon
-	inputMessageDocument.Thumbnail = tempObj.Thumbnail
-	inputMessageDocument.DisableContentTypeDetection = tempObj.DisableContentTypeDetection
-	inputMessageDocument.Caption = tempObj.Caption
+// LogValue logs the object as its summary
+func (inputMessageDocument *InputMessageDocument) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputMessageDocument))
+}
 
-	fieldDocument, _ := unmarshalInputFile(objMap["document"])
-	inputMessageDocument.Document = fieldDocument
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputMessageDocument *InputMessageDocument) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputMessageDocument)
+}
 
-	return nil
+func (inputMessageDocument *InputMessageDocument) printText(p *printer) {
+	if inputMessageDocument == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputMessageDocument")
+	p.object("document", inputMessageDocument.Document)
+	p.object("thumbnail", inputMessageDocument.Thumbnail)
+	p.bool("disable_content_type_detection", inputMessageDocument.DisableContentTypeDetection)
+	p.object("caption", inputMessageDocument.Caption)
+	p.endObject(state)
 }
 
 // GetInputMessageContentEnum return the enum type of this object
@@ -393,6 +1045,11 @@ func (inputMessageDocument *InputMessageDocument) GetInputMessageContentEnum() I
 	return InputMessageDocumentType
 }
 
+// Accept calls the method of the visitor for InputMessageDocument
+func (inputMessageDocument *InputMessageDocument) Accept(visitor InputMessageContentVisitor) {
+	visitor.VisitInputMessageDocument(inputMessageDocument)
+}
+
 // InputMessagePhoto A photo message
 type InputMessagePhoto struct {
 	tdCommon
@@ -434,39 +1091,227 @@ func NewInputMessagePhoto(photo InputFile, thumbnail *InputThumbnail, addedStick
 	return &inputMessagePhotoTemp
 }
 
+// MarshalJSON marshals to json
+func (inputMessagePhoto *InputMessagePhoto) MarshalJSON() ([]byte, error) {
+	return inputMessagePhoto.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputMessagePhoto *InputMessagePhoto) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, inputMessagePhoto)
+}
+
+func (inputMessagePhoto *InputMessagePhoto) appendJSON(b []byte) ([]byte, error) {
+	if inputMessagePhoto == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputMessagePhoto.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputMessagePhoto.tdCommon.Extra)
+	b = append(b, `,"photo":`...)
+	b, err = appendJSONValue(b, inputMessagePhoto.Photo)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"thumbnail":`...)
+	b, err = inputMessagePhoto.Thumbnail.appendJSON(b)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Thumbnail           *InputThumbnail `json:"thumbnail"`              // Photo thumbnail to be sent; pass null to skip thumbnail uploading. The thumbnail is sent to the other party only in secret chats
-		AddedStickerFileIds []int32         `json:"added_sticker_file_ids"` // File identifiers of the stickers added to the photo, if applicable
-		Width               int32           `json:"width"`                  // Photo width
-		Height              int32           `json:"height"`                 // Photo height
-		Caption             *FormattedText  `json:"caption"`                // Photo caption; pass null to use an empty caption; 0-GetOption("message_caption_length_max") characters
-		Ttl                 int32           `json:"ttl"`                    // Photo TTL (Time To Live), in seconds (0-60). A non-zero TTL can be specified only in private chats
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"added_sticker_file_ids":`...)
+	if inputMessagePhoto.AddedStickerFileIds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range inputMessagePhoto.AddedStickerFileIds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(inputMessagePhoto.AddedStickerFileIds[i0]))
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"width":`...)
+	b = appendJSONInt(b, int64(inputMessagePhoto.Width))
+	b = append(b, `,"height":`...)
+	b = appendJSONInt(b, int64(inputMessagePhoto.Height))
+	b = append(b, `,"caption":`...)
+	b, err = inputMessagePhoto.Caption.appendJSON(b)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"ttl":`...)
+	b = appendJSONInt(b, int64(inputMessagePhoto.Ttl))
+	return append(b, '}'), nil
+}
+
+func (inputMessagePhoto *InputMessagePhoto) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputMessagePhoto.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputMessagePhoto.tdCommon.Extra, err = r.readString()
+		case "photo":
+			inputMessagePhoto.Photo, err = decodeInputFile(r)
+		case "thumbnail":
+			if r.readNull() {
+				inputMessagePhoto.Thumbnail = nil
+			} else {
+				inputMessagePhoto.Thumbnail = new(InputThumbnail)
+				err = inputMessagePhoto.Thumbnail.decodeJSON(r)
+			}
+		case "added_sticker_file_ids":
+			if r.readNull() {
+				inputMessagePhoto.AddedStickerFileIds = nil
+			} else {
+				inputMessagePhoto.AddedStickerFileIds = make([]int32, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int32
+					item0, err = r.readInt32()
+					inputMessagePhoto.AddedStickerFileIds = append(inputMessagePhoto.AddedStickerFileIds, item0)
+					return
+				})
+			}
+		case "width":
+			inputMessagePhoto.Width, err = r.readInt32()
+		case "height":
+			inputMessagePhoto.Height, err = r.readInt32()
+		case "caption":
+			if r.readNull() {
+				inputMessagePhoto.Caption = nil
+			} else {
+				inputMessagePhoto.Caption = new(FormattedText)
+				err = inputMessagePhoto.Caption.decodeJSON(r)
+			}
+		case "ttl":
+			inputMessagePhoto.Ttl, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputMessagePhoto *InputMessagePhoto) Clone() *InputMessagePhoto {
+	if inputMessagePhoto == nil {
+		return nil
+	}
+	clone := *inputMessagePhoto
+	clone.Photo = cloneInputFile(inputMessagePhoto.Photo)
+	clone.Thumbnail = inputMessagePhoto.Thumbnail.Clone()
+	if inputMessagePhoto.AddedStickerFileIds != nil {
+		clone.AddedStickerFileIds = make([]int32, len(inputMessagePhoto.AddedStickerFileIds))
+		copy(clone.AddedStickerFileIds, inputMessagePhoto.AddedStickerFileIds)
+	}
+	clone.Caption = inputMessagePhoto.Caption.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputMessagePhoto *InputMessagePhoto) Equal(other *InputMessagePhoto) bool {
+	if inputMessagePhoto == nil || other == nil {
+		return inputMessagePhoto == other
+	}
+	if !equalInputFile(inputMessagePhoto.Photo, other.Photo) {
+		return false
+	}
+	if !inputMessagePhoto.Thumbnail.Equal(other.Thumbnail) {
+		return false
+	}
+	if len(inputMessagePhoto.AddedStickerFileIds) != len(other.AddedStickerFileIds) {
+		return false
+	}
+	for i0 := range inputMessagePhoto.AddedStickerFileIds {
+		if inputMessagePhoto.AddedStickerFileIds[i0] != other.AddedStickerFileIds[i0] {
+			return false
+		}
+	}
+	if inputMessagePhoto.Width != other.Width {
+		return false
+	}
+	if inputMessagePhoto.Height != other.Height {
+		return false
 	}
+	if !inputMessagePhoto.Caption.Equal(other.Caption) {
+		return false
+	}
+	if inputMessagePhoto.Ttl != other.Ttl {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputMessagePhoto *InputMessagePhoto) Diff(other *InputMessagePhoto) []Change {
+	return inputMessagePhoto.diff("", other, nil)
+}
+
+func (inputMessagePhoto *InputMessagePhoto) diff(path string, other *InputMessagePhoto, changes []Change) []Change {
+	if inputMessagePhoto == nil || other == nil {
+		if inputMessagePhoto != other {
+			changes = append(changes, Change{Path: path, Old: inputMessagePhoto, New: other})
+		}
+		return changes
+	}
+	changes = diffInputFile(fieldPath(path, "photo"), inputMessagePhoto.Photo, other.Photo, changes)
+	changes = inputMessagePhoto.Thumbnail.diff(fieldPath(path, "thumbnail"), other.Thumbnail, changes)
+	if len(inputMessagePhoto.AddedStickerFileIds) != len(other.AddedStickerFileIds) {
+		changes = append(changes, Change{Path: fieldPath(path, "added_sticker_file_ids"), Old: inputMessagePhoto.AddedStickerFileIds, New: other.AddedStickerFileIds})
+	} else {
+		for i0 := range inputMessagePhoto.AddedStickerFileIds {
+			if inputMessagePhoto.AddedStickerFileIds[i0] != other.AddedStickerFileIds[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "added_sticker_file_ids"), i0), Old: inputMessagePhoto.AddedStickerFileIds[i0], New: other.AddedStickerFileIds[i0]})
+			}
+		}
+	}
+	if inputMessagePhoto.Width != other.Width {
+		changes = append(changes, Change{Path: fieldPath(path, "width"), Old: inputMessagePhoto.Width, New: other.Width})
+	}
+	if inputMessagePhoto.Height != other.Height {
+		changes = append(changes, Change{Path: fieldPath(path, "height"), Old: inputMessagePhoto.Height, New: other.Height})
+	}
+	changes = inputMessagePhoto.Caption.diff(fieldPath(path, "caption"), other.Caption, changes)
+	if inputMessagePhoto.Ttl != other.Ttl {
+		changes = append(changes, Change{Path: fieldPath(path, "ttl"), Old: inputMessagePhoto.Ttl, New: other.Ttl})
+	}
+	return changes
+}
 
-	inputMessagePhoto.tdCommon = tempObj.tdCommon
-	inputMessagePhoto.Thumbnail = tempObj.Thumbnail
-	inputMessagePhoto.AddedStickerFileIds = tempObj.AddedStickerFileIds
-	inputMessagePhoto.Width = tempObj.Width
-	inputMessagePhoto.Height = tempObj.Height
-	inputMessagePhoto.Caption = tempObj.Caption
-	inputMessagePhoto.Ttl = tempObj.Ttl
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputMessagePhoto *InputMessagePhoto) String() string {
+	return objectString(inputMessagePhoto)
+}
+
+// LogValue logs the object as its summary
+func (inputMessagePhoto *InputMessagePhoto) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputMessagePhoto))
+}
 
-	fieldPhoto, _ := unmarshalInputFile(objMap["photo"])
-	inputMessagePhoto.Photo = fieldPhoto
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputMessagePhoto *InputMessagePhoto) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputMessagePhoto)
+}
 
-	return nil
+func (inputMessagePhoto *InputMessagePhoto) printText(p *printer) {
+	if inputMessagePhoto == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputMessagePhoto")
+	p.object("photo", inputMessagePhoto.Photo)
+	p.object("thumbnail", inputMessagePhoto.Thumbnail)
+	p.vector("added_sticker_file_ids", len(inputMessagePhoto.AddedStickerFileIds), func(i0 int) {
+		p.int("added_sticker_file_ids", int64(inputMessagePhoto.AddedStickerFileIds[i0]))
+	})
+	p.int("width", int64(inputMessagePhoto.Width))
+	p.int("height", int64(inputMessagePhoto.Height))
+	p.object("caption", inputMessagePhoto.Caption)
+	p.int("ttl", int64(inputMessagePhoto.Ttl))
+	p.endObject(state)
 }
 
 // GetInputMessageContentEnum return the enum type of this object
@@ -474,6 +1319,11 @@ func (inputMessagePhoto *InputMessagePhoto) GetInputMessageContentEnum() InputMe
 	return InputMessagePhotoType
 }
 
+// Accept calls the method of the visitor for InputMessagePhoto
+func (inputMessagePhoto *InputMessagePhoto) Accept(visitor InputMessageContentVisitor) {
+	visitor.VisitInputMessagePhoto(inputMessagePhoto)
+}
+
 // InputMessageSticker A sticker message
 type InputMessageSticker struct {
 	tdCommon
@@ -509,35 +1359,160 @@ func NewInputMessageSticker(sticker InputFile, thumbnail *InputThumbnail, width
 	return &inputMessageStickerTemp
 }
 
+// MarshalJSON marshals to json
+func (inputMessageSticker *InputMessageSticker) MarshalJSON() ([]byte, error) {
+	return inputMessageSticker.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputMessageSticker *InputMessageSticker) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, inputMessageSticker)
+}
+
+func (inputMessageSticker *InputMessageSticker) appendJSON(b []byte) ([]byte, error) {
+	if inputMessageSticker == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputMessageSticker.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputMessageSticker.tdCommon.Extra)
+	b = append(b, `,"sticker":`...)
+	b, err = appendJSONValue(b, inputMessageSticker.Sticker)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Thumbnail *InputThumbnail `json:"thumbnail"` // Sticker thumbnail; pass null to skip thumbnail uploading
-		Width     int32           `json:"width"`     // Sticker width
-		Height    int32           `json:"height"`    // Sticker height
-		Emoji     string          `json:"emoji"`     // Emoji used to choose the sticker
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"thumbnail":`...)
+	b, err = inputMessageSticker.Thumbnail.appendJSON(b)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"width":`...)
+	b = appendJSONInt(b, int64(inputMessageSticker.Width))
+	b = append(b, `,"height":`...)
+	b = appendJSONInt(b, int64(inputMessageSticker.Height))
+	b = append(b, `,"emoji":`...)
+	b = appendJSONString(b, inputMessageSticker.Emoji)
+	return append(b, '}'), nil
+}
+
+func (inputMessageSticker *InputMessageSticker) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputMessageSticker.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputMessageSticker.tdCommon.Extra, err = r.readString()
+		case "sticker":
+			inputMessageSticker.Sticker, err = decodeInputFile(r)
+		case "thumbnail":
+			if r.readNull() {
+				inputMessageSticker.Thumbnail = nil
+			} else {
+				inputMessageSticker.Thumbnail = new(InputThumbnail)
+				err = inputMessageSticker.Thumbnail.decodeJSON(r)
+			}
+		case "width":
+			inputMessageSticker.Width, err = r.readInt32()
+		case "height":
+			inputMessageSticker.Height, err = r.readInt32()
+		case "emoji":
+			inputMessageSticker.Emoji, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputMessageSticker *InputMessageSticker) Clone() *InputMessageSticker {
+	if inputMessageSticker == nil {
+		return nil
+	}
+	clone := *inputMessageSticker
+	clone.Sticker = cloneInputFile(inputMessageSticker.Sticker)
+	clone.Thumbnail = inputMessageSticker.Thumbnail.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputMessageSticker *InputMessageSticker) Equal(other *InputMessageSticker) bool {
+	if inputMessageSticker == nil || other == nil {
+		return inputMessageSticker == other
+	}
+	if !equalInputFile(inputMessageSticker.Sticker, other.Sticker) {
+		return false
 	}
+	if !inputMessageSticker.Thumbnail.Equal(other.Thumbnail) {
+		return false
+	}
+	if inputMessageSticker.Width != other.Width {
+		return false
+	}
+	if inputMessageSticker.Height != other.Height {
+		return false
+	}
+	if inputMessageSticker.Emoji != other.Emoji {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputMessageSticker *InputMessageSticker) Diff(other *InputMessageSticker) []Change {
+	return inputMessageSticker.diff("", other, nil)
+}
+
+func (inputMessageSticker *InputMessageSticker) diff(path string, other *InputMessageSticker, changes []Change) []Change {
+	if inputMessageSticker == nil || other == nil {
+		if inputMessageSticker != other {
+			changes = append(changes, Change{Path: path, Old: inputMessageSticker, New: other})
+		}
+		return changes
+	}
+	changes = diffInputFile(fieldPath(path, "sticker"), inputMessageSticker.Sticker, other.Sticker, changes)
+	changes = inputMessageSticker.Thumbnail.diff(fieldPath(path, "thumbnail"), other.Thumbnail, changes)
+	if inputMessageSticker.Width != other.Width {
+		changes = append(changes, Change{Path: fieldPath(path, "width"), Old: inputMessageSticker.Width, New: other.Width})
+	}
+	if inputMessageSticker.Height != other.Height {
+		changes = append(changes, Change{Path: fieldPath(path, "height"), Old: inputMessageSticker.Height, New: other.Height})
+	}
+	if inputMessageSticker.Emoji != other.Emoji {
+		changes = append(changes, Change{Path: fieldPath(path, "emoji"), Old: inputMessageSticker.Emoji, New: other.Emoji})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputMessageSticker *InputMessageSticker) String() string {
+	return objectString(inputMessageSticker)
+}
 
-	inputMessageSticker.tdCommon = tempObj.tdCommon
-	inputMessageSticker.Thumbnail = tempObj.Thumbnail
-	inputMessageSticker.Width = tempObj.Width
-	inputMessageSticker.Height = tempObj.Height
-	inputMessageSticker.Emoji = tempObj.Emoji
+// LogValue logs the object as its summary
+func (inputMessageSticker *InputMessageSticker) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputMessageSticker))
+}
 
-	fieldSticker, _ := unmarshalInputFile(objMap["sticker"])
-	inputMessageSticker.Sticker = fieldSticker
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputMessageSticker *InputMessageSticker) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputMessageSticker)
+}
 
-	return nil
+func (inputMessageSticker *InputMessageSticker) printText(p *printer) {
+	if inputMessageSticker == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputMessageSticker")
+	p.object("sticker", inputMessageSticker.Sticker)
+	p.object("thumbnail", inputMessageSticker.Thumbnail)
+	p.int("width", int64(inputMessageSticker.Width))
+	p.int("height", int64(inputMessageSticker.Height))
+	p.string("emoji", inputMessageSticker.Emoji)
+	p.endObject(state)
 }
 
 // GetInputMessageContentEnum return the enum type of this object
@@ -545,6 +1520,11 @@ func (inputMessageSticker *InputMessageSticker) GetInputMessageContentEnum() Inp
 	return InputMessageStickerType
 }
 
+// Accept calls the method of the visitor for InputMessageSticker
+func (inputMessageSticker *InputMessageSticker) Accept(visitor InputMessageContentVisitor) {
+	visitor.VisitInputMessageSticker(inputMessageSticker)
+}
+
 // InputMessageVideo A video message
 type InputMessageVideo struct {
 	tdCommon
@@ -592,50 +1572,261 @@ func NewInputMessageVideo(video InputFile, thumbnail *InputThumbnail, addedStick
 	return &inputMessageVideoTemp
 }
 
+// MarshalJSON marshals to json
+func (inputMessageVideo *InputMessageVideo) MarshalJSON() ([]byte, error) {
+	return inputMessageVideo.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputMessageVideo *InputMessageVideo) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, inputMessageVideo)
+}
+
+func (inputMessageVideo *InputMessageVideo) appendJSON(b []byte) ([]byte, error) {
+	if inputMessageVideo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputMessageVideo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputMessageVideo.tdCommon.Extra)
+	b = append(b, `,"video":`...)
+	b, err = appendJSONValue(b, inputMessageVideo.Video)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Thumbnail           *InputThumbnail `json:"thumbnail"`              // Video thumbnail; pass null to skip thumbnail uploading
-		AddedStickerFileIds []int32         `json:"added_sticker_file_ids"` // File identifiers of the stickers added to the video, if applicable
-		Duration            int32           `json:"duration"`               // Duration of the video, in seconds
-		Width               int32           `json:"width"`                  // Video width
-		Height              int32           `json:"height"`                 // Video height
-		SupportsStreaming   bool            `json:"supports_streaming"`     // True, if the video is supposed to be streamed
-		Caption             *FormattedText  `json:"caption"`                // Video caption; pass null to use an empty caption; 0-GetOption("message_caption_length_max") characters
-		Ttl                 int32           `json:"ttl"`                    // Video TTL (Time To Live), in seconds (0-60). A non-zero TTL can be specified only in private chats
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"thumbnail":`...)
+	b, err = inputMessageVideo.Thumbnail.appendJSON(b)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	inputMessageVideo.tdCommon = tempObj.tdCommon
-	inputMessageVideo.Thumbnail = tempObj.Thumbnail
-	inputMessageVideo.AddedStickerFileIds = tempObj.AddedStickerFileIds
-	inputMessageVideo.Duration = tempObj.Duration
-	inputMessageVideo.Width = tempObj.Width
-	inputMessageVideo.Height = tempObj.Height
-	inputMessageVideo.SupportsStreaming = tempObj.SupportsStreaming
-	inputMessageVideo.Caption = tempObj.Caption
-	inputMessageVideo.Ttl = tempObj.Ttl
-
-	fieldVideo, _ := unmarshalInputFile(objMap["video"])
-	inputMessageVideo.Video = fieldVideo
-
-	return nil
-}
+	b = append(b, `,"added_sticker_file_ids":`...)
+	if inputMessageVideo.AddedStickerFileIds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range inputMessageVideo.AddedStickerFileIds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(inputMessageVideo.AddedStickerFileIds[i0]))
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"duration":`...)
+	b = appendJSONInt(b, int64(inputMessageVideo.Duration))
+	b = append(b, `,"width":`...)
+	b = appendJSONInt(b, int64(inputMessageVideo.Width))
+	b = append(b, `,"height":`...)
+	b = appendJSONInt(b, int64(inputMessageVideo.Height))
+	b = append(b, `,"supports_streaming":`...)
+	b = appendJSONBool(b, inputMessageVideo.SupportsStreaming)
+	b = append(b, `,"caption":`...)
+	b, err = inputMessageVideo.Caption.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"ttl":`...)
+	b = appendJSONInt(b, int64(inputMessageVideo.Ttl))
+	return append(b, '}'), nil
+}
+
+func (inputMessageVideo *InputMessageVideo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputMessageVideo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputMessageVideo.tdCommon.Extra, err = r.readString()
+		case "video":
+			inputMessageVideo.Video, err = decodeInputFile(r)
+		case "thumbnail":
+			if r.readNull() {
+				inputMessageVideo.Thumbnail = nil
+			} else {
+				inputMessageVideo.Thumbnail = new(InputThumbnail)
+				err = inputMessageVideo.Thumbnail.decodeJSON(r)
+			}
+		case "added_sticker_file_ids":
+			if r.readNull() {
+				inputMessageVideo.AddedStickerFileIds = nil
+			} else {
+				inputMessageVideo.AddedStickerFileIds = make([]int32, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int32
+					item0, err = r.readInt32()
+					inputMessageVideo.AddedStickerFileIds = append(inputMessageVideo.AddedStickerFileIds, item0)
+					return
+				})
+			}
+		case "duration":
+			inputMessageVideo.Duration, err = r.readInt32()
+		case "width":
+			inputMessageVideo.Width, err = r.readInt32()
+		case "height":
+			inputMessageVideo.Height, err = r.readInt32()
+		case "supports_streaming":
+			inputMessageVideo.SupportsStreaming, err = r.readBool()
+		case "caption":
+			if r.readNull() {
+				inputMessageVideo.Caption = nil
+			} else {
+				inputMessageVideo.Caption = new(FormattedText)
+				err = inputMessageVideo.Caption.decodeJSON(r)
+			}
+		case "ttl":
+			inputMessageVideo.Ttl, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputMessageVideo *InputMessageVideo) Clone() *InputMessageVideo {
+	if inputMessageVideo == nil {
+		return nil
+	}
+	clone := *inputMessageVideo
+	clone.Video = cloneInputFile(inputMessageVideo.Video)
+	clone.Thumbnail = inputMessageVideo.Thumbnail.Clone()
+	if inputMessageVideo.AddedStickerFileIds != nil {
+		clone.AddedStickerFileIds = make([]int32, len(inputMessageVideo.AddedStickerFileIds))
+		copy(clone.AddedStickerFileIds, inputMessageVideo.AddedStickerFileIds)
+	}
+	clone.Caption = inputMessageVideo.Caption.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputMessageVideo *InputMessageVideo) Equal(other *InputMessageVideo) bool {
+	if inputMessageVideo == nil || other == nil {
+		return inputMessageVideo == other
+	}
+	if !equalInputFile(inputMessageVideo.Video, other.Video) {
+		return false
+	}
+	if !inputMessageVideo.Thumbnail.Equal(other.Thumbnail) {
+		return false
+	}
+	if len(inputMessageVideo.AddedStickerFileIds) != len(other.AddedStickerFileIds) {
+		return false
+	}
+	for i0 := range inputMessageVideo.AddedStickerFileIds {
+		if inputMessageVideo.AddedStickerFileIds[i0] != other.AddedStickerFileIds[i0] {
+			return false
+		}
+	}
+	if inputMessageVideo.Duration != other.Duration {
+		return false
+	}
+	if inputMessageVideo.Width != other.Width {
+		return false
+	}
+	if inputMessageVideo.Height != other.Height {
+		return false
+	}
+	if inputMessageVideo.SupportsStreaming != other.SupportsStreaming {
+		return false
+	}
+	if !inputMessageVideo.Caption.Equal(other.Caption) {
+		return false
+	}
+	if inputMessageVideo.Ttl != other.Ttl {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputMessageVideo *InputMessageVideo) Diff(other *InputMessageVideo) []Change {
+	return inputMessageVideo.diff("", other, nil)
+}
+
+func (inputMessageVideo *InputMessageVideo) diff(path string, other *InputMessageVideo, changes []Change) []Change {
+	if inputMessageVideo == nil || other == nil {
+		if inputMessageVideo != other {
+			changes = append(changes, Change{Path: path, Old: inputMessageVideo, New: other})
+		}
+		return changes
+	}
+	changes = diffInputFile(fieldPath(path, "video"), inputMessageVideo.Video, other.Video, changes)
+	changes = inputMessageVideo.Thumbnail.diff(fieldPath(path, "thumbnail"), other.Thumbnail, changes)
+	if len(inputMessageVideo.AddedStickerFileIds) != len(other.AddedStickerFileIds) {
+		changes = append(changes, Change{Path: fieldPath(path, "added_sticker_file_ids"), Old: inputMessageVideo.AddedStickerFileIds, New: other.AddedStickerFileIds})
+	} else {
+		for i0 := range inputMessageVideo.AddedStickerFileIds {
+			if inputMessageVideo.AddedStickerFileIds[i0] != other.AddedStickerFileIds[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "added_sticker_file_ids"), i0), Old: inputMessageVideo.AddedStickerFileIds[i0], New: other.AddedStickerFileIds[i0]})
+			}
+		}
+	}
+	if inputMessageVideo.Duration != other.Duration {
+		changes = append(changes, Change{Path: fieldPath(path, "duration"), Old: inputMessageVideo.Duration, New: other.Duration})
+	}
+	if inputMessageVideo.Width != other.Width {
+		changes = append(changes, Change{Path: fieldPath(path, "width"), Old: inputMessageVideo.Width, New: other.Width})
+	}
+	if inputMessageVideo.Height != other.Height {
+		changes = append(changes, Change{Path: fieldPath(path, "height"), Old: inputMessageVideo.Height, New: other.Height})
+	}
+	if inputMessageVideo.SupportsStreaming != other.SupportsStreaming {
+		changes = append(changes, Change{Path: fieldPath(path, "supports_streaming"), Old: inputMessageVideo.SupportsStreaming, New: other.SupportsStreaming})
+	}
+	changes = inputMessageVideo.Caption.diff(fieldPath(path, "caption"), other.Caption, changes)
+	if inputMessageVideo.Ttl != other.Ttl {
+		changes = append(changes, Change{Path: fieldPath(path, "ttl"), Old: inputMessageVideo.Ttl, New: other.Ttl})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputMessageVideo *InputMessageVideo) String() string {
+	return objectString(inputMessageVideo)
+}
+
+// LogValue logs the object as its summary
+func (inputMessageVideo *InputMessageVideo) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputMessageVideo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputMessageVideo *InputMessageVideo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputMessageVideo)
+}
+
+func (inputMessageVideo *InputMessageVideo) printText(p *printer) {
+	if inputMessageVideo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputMessageVideo")
+	p.object("video", inputMessageVideo.Video)
+	p.object("thumbnail", inputMessageVideo.Thumbnail)
+	p.vector("added_sticker_file_ids", len(inputMessageVideo.AddedStickerFileIds), func(i0 int) {
+		p.int("added_sticker_file_ids", int64(inputMessageVideo.AddedStickerFileIds[i0]))
+	})
+	p.int("duration", int64(inputMessageVideo.Duration))
+	p.int("width", int64(inputMessageVideo.Width))
+	p.int("height", int64(inputMessageVideo.Height))
+	p.bool("supports_streaming", inputMessageVideo.SupportsStreaming)
+	p.object("caption", inputMessageVideo.Caption)
+	p.int("ttl", int64(inputMessageVideo.Ttl))
+	p.endObject(state)
+}
 
 // GetInputMessageContentEnum return the enum type of this object
 func (inputMessageVideo *InputMessageVideo) GetInputMessageContentEnum() InputMessageContentEnum {
 	return InputMessageVideoType
 }
 
+// Accept calls the method of the visitor for InputMessageVideo
+func (inputMessageVideo *InputMessageVideo) Accept(visitor InputMessageContentVisitor) {
+	visitor.VisitInputMessageVideo(inputMessageVideo)
+}
+
 // InputMessageVideoNote A video note message
 type InputMessageVideoNote struct {
 	tdCommon
@@ -668,33 +1859,149 @@ func NewInputMessageVideoNote(videoNote InputFile, thumbnail *InputThumbnail, du
 	return &inputMessageVideoNoteTemp
 }
 
+// MarshalJSON marshals to json
+func (inputMessageVideoNote *InputMessageVideoNote) MarshalJSON() ([]byte, error) {
+	return inputMessageVideoNote.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputMessageVideoNote *InputMessageVideoNote) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, inputMessageVideoNote)
+}
+
+func (inputMessageVideoNote *InputMessageVideoNote) appendJSON(b []byte) ([]byte, error) {
+	if inputMessageVideoNote == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputMessageVideoNote.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputMessageVideoNote.tdCommon.Extra)
+	b = append(b, `,"video_note":`...)
+	b, err = appendJSONValue(b, inputMessageVideoNote.VideoNote)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Thumbnail *InputThumbnail `json:"thumbnail"` // Video thumbnail; pass null to skip thumbnail uploading
-		Duration  int32           `json:"duration"`  // Duration of the video, in seconds
-		Length    int32           `json:"length"`    // Video width and height; must be positive and not greater than 640
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"thumbnail":`...)
+	b, err = inputMessageVideoNote.Thumbnail.appendJSON(b)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"duration":`...)
+	b = appendJSONInt(b, int64(inputMessageVideoNote.Duration))
+	b = append(b, `,"length":`...)
+	b = appendJSONInt(b, int64(inputMessageVideoNote.Length))
+	return append(b, '}'), nil
+}
+
+func (inputMessageVideoNote *InputMessageVideoNote) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputMessageVideoNote.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputMessageVideoNote.tdCommon.Extra, err = r.readString()
+		case "video_note":
+			inputMessageVideoNote.VideoNote, err = decodeInputFile(r)
+		case "thumbnail":
+			if r.readNull() {
+				inputMessageVideoNote.Thumbnail = nil
+			} else {
+				inputMessageVideoNote.Thumbnail = new(InputThumbnail)
+				err = inputMessageVideoNote.Thumbnail.decodeJSON(r)
+			}
+		case "duration":
+			inputMessageVideoNote.Duration, err = r.readInt32()
+		case "length":
+			inputMessageVideoNote.Length, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputMessageVideoNote *InputMessageVideoNote) Clone() *InputMessageVideoNote {
+	if inputMessageVideoNote == nil {
+		return nil
+	}
+	clone := *inputMessageVideoNote
+	clone.VideoNote = cloneInputFile(inputMessageVideoNote.VideoNote)
+	clone.Thumbnail = inputMessageVideoNote.Thumbnail.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputMessageVideoNote *InputMessageVideoNote) Equal(other *InputMessageVideoNote) bool {
+	if inputMessageVideoNote == nil || other == nil {
+		return inputMessageVideoNote == other
+	}
+	if !equalInputFile(inputMessageVideoNote.VideoNote, other.VideoNote) {
+		return false
+	}
+	if !inputMessageVideoNote.Thumbnail.Equal(other.Thumbnail) {
+		return false
+	}
+	if inputMessageVideoNote.Duration != other.Duration {
+		return false
+	}
+	if inputMessageVideoNote.Length != other.Length {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputMessageVideoNote *InputMessageVideoNote) Diff(other *InputMessageVideoNote) []Change {
+	return inputMessageVideoNote.diff("", other, nil)
+}
+
+func (inputMessageVideoNote *InputMessageVideoNote) diff(path string, other *InputMessageVideoNote, changes []Change) []Change {
+	if inputMessageVideoNote == nil || other == nil {
+		if inputMessageVideoNote != other {
+			changes = append(changes, Change{Path: path, Old: inputMessageVideoNote, New: other})
+		}
+		return changes
+	}
+	changes = diffInputFile(fieldPath(path, "video_note"), inputMessageVideoNote.VideoNote, other.VideoNote, changes)
+	changes = inputMessageVideoNote.Thumbnail.diff(fieldPath(path, "thumbnail"), other.Thumbnail, changes)
+	if inputMessageVideoNote.Duration != other.Duration {
+		changes = append(changes, Change{Path: fieldPath(path, "duration"), Old: inputMessageVideoNote.Duration, New: other.Duration})
+	}
+	if inputMessageVideoNote.Length != other.Length {
+		changes = append(changes, Change{Path: fieldPath(path, "length"), Old: inputMessageVideoNote.Length, New: other.Length})
 	}
+	return changes
+}
 
-	inputMessageVideoNote.tdCommon = tempObj.tdCommon
-	inputMessageVideoNote.Thumbnail = tempObj.Thumbnail
-	inputMessageVideoNote.Duration = tempObj.Duration
-	inputMessageVideoNote.Length = tempObj.Length
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputMessageVideoNote *InputMessageVideoNote) String() string {
+	return objectString(inputMessageVideoNote)
+}
 
-	fieldVideoNote, _ := unmarshalInputFile(objMap["video_note"])
-	inputMessageVideoNote.VideoNote = fieldVideoNote
+// LogValue logs the object as its summary
+func (inputMessageVideoNote *InputMessageVideoNote) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputMessageVideoNote))
+}
 
-	return nil
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputMessageVideoNote *InputMessageVideoNote) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputMessageVideoNote)
+}
+
+func (inputMessageVideoNote *InputMessageVideoNote) printText(p *printer) {
+	if inputMessageVideoNote == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputMessageVideoNote")
+	p.object("video_note", inputMessageVideoNote.VideoNote)
+	p.object("thumbnail", inputMessageVideoNote.Thumbnail)
+	p.int("duration", int64(inputMessageVideoNote.Duration))
+	p.int("length", int64(inputMessageVideoNote.Length))
+	p.endObject(state)
 }
 
 // GetInputMessageContentEnum return the enum type of this object
@@ -702,6 +2009,11 @@ func (inputMessageVideoNote *InputMessageVideoNote) GetInputMessageContentEnum()
 	return InputMessageVideoNoteType
 }
 
+// Accept calls the method of the visitor for InputMessageVideoNote
+func (inputMessageVideoNote *InputMessageVideoNote) Accept(visitor InputMessageContentVisitor) {
+	visitor.VisitInputMessageVideoNote(inputMessageVideoNote)
+}
+
 // InputMessageVoiceNote A voice note message
 type InputMessageVoiceNote struct {
 	tdCommon
@@ -734,33 +2046,152 @@ func NewInputMessageVoiceNote(voiceNote InputFile, duration int32, waveform []by
 	return &inputMessageVoiceNoteTemp
 }
 
+// MarshalJSON marshals to json
+func (inputMessageVoiceNote *InputMessageVoiceNote) MarshalJSON() ([]byte, error) {
+	return inputMessageVoiceNote.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputMessageVoiceNote *InputMessageVoiceNote) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, inputMessageVoiceNote)
+}
+
+func (inputMessageVoiceNote *InputMessageVoiceNote) appendJSON(b []byte) ([]byte, error) {
+	if inputMessageVoiceNote == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputMessageVoiceNote.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputMessageVoiceNote.tdCommon.Extra)
+	b = append(b, `,"voice_note":`...)
+	b, err = appendJSONValue(b, inputMessageVoiceNote.VoiceNote)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Duration int32          `json:"duration"` // Duration of the voice note, in seconds
-		Waveform []byte         `json:"waveform"` // Waveform representation of the voice note, in 5-bit format
-		Caption  *FormattedText `json:"caption"`  // Voice note caption; pass null to use an empty caption; 0-GetOption("message_caption_length_max") characters
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"duration":`...)
+	b = appendJSONInt(b, int64(inputMessageVoiceNote.Duration))
+	b = append(b, `,"waveform":`...)
+	b = appendJSONBytes(b, inputMessageVoiceNote.Waveform)
+	b = append(b, `,"caption":`...)
+	b, err = inputMessageVoiceNote.Caption.appendJSON(b)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inputMessageVoiceNote *InputMessageVoiceNote) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputMessageVoiceNote.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputMessageVoiceNote.tdCommon.Extra, err = r.readString()
+		case "voice_note":
+			inputMessageVoiceNote.VoiceNote, err = decodeInputFile(r)
+		case "duration":
+			inputMessageVoiceNote.Duration, err = r.readInt32()
+		case "waveform":
+			inputMessageVoiceNote.Waveform, err = r.readBytes()
+		case "caption":
+			if r.readNull() {
+				inputMessageVoiceNote.Caption = nil
+			} else {
+				inputMessageVoiceNote.Caption = new(FormattedText)
+				err = inputMessageVoiceNote.Caption.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputMessageVoiceNote *InputMessageVoiceNote) Clone() *InputMessageVoiceNote {
+	if inputMessageVoiceNote == nil {
+		return nil
+	}
+	clone := *inputMessageVoiceNote
+	clone.VoiceNote = cloneInputFile(inputMessageVoiceNote.VoiceNote)
+	if inputMessageVoiceNote.Waveform != nil {
+		clone.Waveform = append([]byte{}, inputMessageVoiceNote.Waveform...)
+	}
+	clone.Caption = inputMessageVoiceNote.Caption.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputMessageVoiceNote *InputMessageVoiceNote) Equal(other *InputMessageVoiceNote) bool {
+	if inputMessageVoiceNote == nil || other == nil {
+		return inputMessageVoiceNote == other
+	}
+	if !equalInputFile(inputMessageVoiceNote.VoiceNote, other.VoiceNote) {
+		return false
+	}
+	if inputMessageVoiceNote.Duration != other.Duration {
+		return false
+	}
+	if !equalBytes(inputMessageVoiceNote.Waveform, other.Waveform) {
+		return false
+	}
+	if !inputMessageVoiceNote.Caption.Equal(other.Caption) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputMessageVoiceNote *InputMessageVoiceNote) Diff(other *InputMessageVoiceNote) []Change {
+	return inputMessageVoiceNote.diff("", other, nil)
+}
+
+func (inputMessageVoiceNote *InputMessageVoiceNote) diff(path string, other *InputMessageVoiceNote, changes []Change) []Change {
+	if inputMessageVoiceNote == nil || other == nil {
+		if inputMessageVoiceNote != other {
+			changes = append(changes, Change{Path: path, Old: inputMessageVoiceNote, New: other})
+		}
+		return changes
 	}
+	changes = diffInputFile(fieldPath(path, "voice_note"), inputMessageVoiceNote.VoiceNote, other.VoiceNote, changes)
+	if inputMessageVoiceNote.Duration != other.Duration {
+		changes = append(changes, Change{Path: fieldPath(path, "duration"), Old: inputMessageVoiceNote.Duration, New: other.Duration})
+	}
+	if !equalBytes(inputMessageVoiceNote.Waveform, other.Waveform) {
+		changes = append(changes, Change{Path: fieldPath(path, "waveform"), Old: inputMessageVoiceNote.Waveform, New: other.Waveform})
+	}
+	changes = inputMessageVoiceNote.Caption.diff(fieldPath(path, "caption"), other.Caption, changes)
+	return changes
+}
 
-	inputMessageVoiceNote.tdCommon = tempObj.tdCommon
-	inputMessageVoiceNote.Duration = tempObj.Duration
-	inputMessageVoiceNote.Waveform = tempObj.Waveform
-	inputMessageVoiceNote.Caption = tempObj.Caption
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputMessageVoiceNote *InputMessageVoiceNote) String() string {
+	return objectString(inputMessageVoiceNote)
+}
+
+// LogValue logs the object as its summary
+func (inputMessageVoiceNote *InputMessageVoiceNote) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputMessageVoiceNote))
+}
 
-	fieldVoiceNote, _ := unmarshalInputFile(objMap["voice_note"])
-	inputMessageVoiceNote.VoiceNote = fieldVoiceNote
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputMessageVoiceNote *InputMessageVoiceNote) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputMessageVoiceNote)
+}
 
-	return nil
+func (inputMessageVoiceNote *InputMessageVoiceNote) printText(p *printer) {
+	if inputMessageVoiceNote == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputMessageVoiceNote")
+	p.object("voice_note", inputMessageVoiceNote.VoiceNote)
+	p.int("duration", int64(inputMessageVoiceNote.Duration))
+	p.bytes("waveform", inputMessageVoiceNote.Waveform)
+	p.object("caption", inputMessageVoiceNote.Caption)
+	p.endObject(state)
 }
 
 // GetInputMessageContentEnum return the enum type of this object
@@ -768,6 +2199,11 @@ func (inputMessageVoiceNote *InputMessageVoiceNote) GetInputMessageContentEnum()
 	return InputMessageVoiceNoteType
 }
 
+// Accept calls the method of the visitor for InputMessageVoiceNote
+func (inputMessageVoiceNote *InputMessageVoiceNote) Accept(visitor InputMessageContentVisitor) {
+	visitor.VisitInputMessageVoiceNote(inputMessageVoiceNote)
+}
+
 // InputMessageLocation A message with a location
 type InputMessageLocation struct {
 	tdCommon
@@ -800,11 +2236,159 @@ func NewInputMessageLocation(location *Location, livePeriod int32, heading int32
 	return &inputMessageLocationTemp
 }
 
+// MarshalJSON marshals to json
+func (inputMessageLocation *InputMessageLocation) MarshalJSON() ([]byte, error) {
+	return inputMessageLocation.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputMessageLocation *InputMessageLocation) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputMessageLocation)
+}
+
+func (inputMessageLocation *InputMessageLocation) appendJSON(b []byte) ([]byte, error) {
+	if inputMessageLocation == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputMessageLocation.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputMessageLocation.tdCommon.Extra)
+	b = append(b, `,"location":`...)
+	b, err = inputMessageLocation.Location.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"live_period":`...)
+	b = appendJSONInt(b, int64(inputMessageLocation.LivePeriod))
+	b = append(b, `,"heading":`...)
+	b = appendJSONInt(b, int64(inputMessageLocation.Heading))
+	b = append(b, `,"proximity_alert_radius":`...)
+	b = appendJSONInt(b, int64(inputMessageLocation.ProximityAlertRadius))
+	return append(b, '}'), nil
+}
+
+func (inputMessageLocation *InputMessageLocation) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputMessageLocation.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputMessageLocation.tdCommon.Extra, err = r.readString()
+		case "location":
+			if r.readNull() {
+				inputMessageLocation.Location = nil
+			} else {
+				inputMessageLocation.Location = new(Location)
+				err = inputMessageLocation.Location.decodeJSON(r)
+			}
+		case "live_period":
+			inputMessageLocation.LivePeriod, err = r.readInt32()
+		case "heading":
+			inputMessageLocation.Heading, err = r.readInt32()
+		case "proximity_alert_radius":
+			inputMessageLocation.ProximityAlertRadius, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputMessageLocation *InputMessageLocation) Clone() *InputMessageLocation {
+	if inputMessageLocation == nil {
+		return nil
+	}
+	clone := *inputMessageLocation
+	clone.Location = inputMessageLocation.Location.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputMessageLocation *InputMessageLocation) Equal(other *InputMessageLocation) bool {
+	if inputMessageLocation == nil || other == nil {
+		return inputMessageLocation == other
+	}
+	if !inputMessageLocation.Location.Equal(other.Location) {
+		return false
+	}
+	if inputMessageLocation.LivePeriod != other.LivePeriod {
+		return false
+	}
+	if inputMessageLocation.Heading != other.Heading {
+		return false
+	}
+	if inputMessageLocation.ProximityAlertRadius != other.ProximityAlertRadius {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputMessageLocation *InputMessageLocation) Diff(other *InputMessageLocation) []Change {
+	return inputMessageLocation.diff("", other, nil)
+}
+
+func (inputMessageLocation *InputMessageLocation) diff(path string, other *InputMessageLocation, changes []Change) []Change {
+	if inputMessageLocation == nil || other == nil {
+		if inputMessageLocation != other {
+			changes = append(changes, Change{Path: path, Old: inputMessageLocation, New: other})
+		}
+		return changes
+	}
+	changes = inputMessageLocation.Location.diff(fieldPath(path, "location"), other.Location, changes)
+	if inputMessageLocation.LivePeriod != other.LivePeriod {
+		changes = append(changes, Change{Path: fieldPath(path, "live_period"), Old: inputMessageLocation.LivePeriod, New: other.LivePeriod})
+	}
+	if inputMessageLocation.Heading != other.Heading {
+		changes = append(changes, Change{Path: fieldPath(path, "heading"), Old: inputMessageLocation.Heading, New: other.Heading})
+	}
+	if inputMessageLocation.ProximityAlertRadius != other.ProximityAlertRadius {
+		changes = append(changes, Change{Path: fieldPath(path, "proximity_alert_radius"), Old: inputMessageLocation.ProximityAlertRadius, New: other.ProximityAlertRadius})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputMessageLocation *InputMessageLocation) String() string {
+	return objectString(inputMessageLocation)
+}
+
+// LogValue logs the object as its summary
+func (inputMessageLocation *InputMessageLocation) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputMessageLocation))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputMessageLocation *InputMessageLocation) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputMessageLocation)
+}
+
+func (inputMessageLocation *InputMessageLocation) printText(p *printer) {
+	if inputMessageLocation == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputMessageLocation")
+	p.object("location", inputMessageLocation.Location)
+	p.int("live_period", int64(inputMessageLocation.LivePeriod))
+	p.int("heading", int64(inputMessageLocation.Heading))
+	p.int("proximity_alert_radius", int64(inputMessageLocation.ProximityAlertRadius))
+	p.endObject(state)
+}
+
 // GetInputMessageContentEnum return the enum type of this object
 func (inputMessageLocation *InputMessageLocation) GetInputMessageContentEnum() InputMessageContentEnum {
 	return InputMessageLocationType
 }
 
+// Accept calls the method of the visitor for InputMessageLocation
+func (inputMessageLocation *InputMessageLocation) Accept(visitor InputMessageContentVisitor) {
+	visitor.VisitInputMessageLocation(inputMessageLocation)
+}
+
 // InputMessageVenue A message with information about a venue
 type InputMessageVenue struct {
 	tdCommon
@@ -828,11 +2412,126 @@ func NewInputMessageVenue(venue *Venue) *InputMessageVenue {
 	return &inputMessageVenueTemp
 }
 
+// MarshalJSON marshals to json
+func (inputMessageVenue *InputMessageVenue) MarshalJSON() ([]byte, error) {
+	return inputMessageVenue.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputMessageVenue *InputMessageVenue) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputMessageVenue)
+}
+
+func (inputMessageVenue *InputMessageVenue) appendJSON(b []byte) ([]byte, error) {
+	if inputMessageVenue == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputMessageVenue.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputMessageVenue.tdCommon.Extra)
+	b = append(b, `,"venue":`...)
+	b, err = inputMessageVenue.Venue.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inputMessageVenue *InputMessageVenue) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputMessageVenue.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputMessageVenue.tdCommon.Extra, err = r.readString()
+		case "venue":
+			if r.readNull() {
+				inputMessageVenue.Venue = nil
+			} else {
+				inputMessageVenue.Venue = new(Venue)
+				err = inputMessageVenue.Venue.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputMessageVenue *InputMessageVenue) Clone() *InputMessageVenue {
+	if inputMessageVenue == nil {
+		return nil
+	}
+	clone := *inputMessageVenue
+	clone.Venue = inputMessageVenue.Venue.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputMessageVenue *InputMessageVenue) Equal(other *InputMessageVenue) bool {
+	if inputMessageVenue == nil || other == nil {
+		return inputMessageVenue == other
+	}
+	if !inputMessageVenue.Venue.Equal(other.Venue) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputMessageVenue *InputMessageVenue) Diff(other *InputMessageVenue) []Change {
+	return inputMessageVenue.diff("", other, nil)
+}
+
+func (inputMessageVenue *InputMessageVenue) diff(path string, other *InputMessageVenue, changes []Change) []Change {
+	if inputMessageVenue == nil || other == nil {
+		if inputMessageVenue != other {
+			changes = append(changes, Change{Path: path, Old: inputMessageVenue, New: other})
+		}
+		return changes
+	}
+	changes = inputMessageVenue.Venue.diff(fieldPath(path, "venue"), other.Venue, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputMessageVenue *InputMessageVenue) String() string {
+	return objectString(inputMessageVenue)
+}
+
+// LogValue logs the object as its summary
+func (inputMessageVenue *InputMessageVenue) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputMessageVenue))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputMessageVenue *InputMessageVenue) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputMessageVenue)
+}
+
+func (inputMessageVenue *InputMessageVenue) printText(p *printer) {
+	if inputMessageVenue == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputMessageVenue")
+	p.object("venue", inputMessageVenue.Venue)
+	p.endObject(state)
+}
+
 // GetInputMessageContentEnum return the enum type of this object
 func (inputMessageVenue *InputMessageVenue) GetInputMessageContentEnum() InputMessageContentEnum {
 	return InputMessageVenueType
 }
 
+// Accept calls the method of the visitor for InputMessageVenue
+func (inputMessageVenue *InputMessageVenue) Accept(visitor InputMessageContentVisitor) {
+	visitor.VisitInputMessageVenue(inputMessageVenue)
+}
+
 // InputMessageContact A message containing a user contact
 type InputMessageContact struct {
 	tdCommon
@@ -856,11 +2555,126 @@ func NewInputMessageContact(contact *Contact) *InputMessageContact {
 	return &inputMessageContactTemp
 }
 
+// MarshalJSON marshals to json
+func (inputMessageContact *InputMessageContact) MarshalJSON() ([]byte, error) {
+	return inputMessageContact.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputMessageContact *InputMessageContact) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputMessageContact)
+}
+
+func (inputMessageContact *InputMessageContact) appendJSON(b []byte) ([]byte, error) {
+	if inputMessageContact == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputMessageContact.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputMessageContact.tdCommon.Extra)
+	b = append(b, `,"contact":`...)
+	b, err = inputMessageContact.Contact.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inputMessageContact *InputMessageContact) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputMessageContact.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputMessageContact.tdCommon.Extra, err = r.readString()
+		case "contact":
+			if r.readNull() {
+				inputMessageContact.Contact = nil
+			} else {
+				inputMessageContact.Contact = new(Contact)
+				err = inputMessageContact.Contact.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputMessageContact *InputMessageContact) Clone() *InputMessageContact {
+	if inputMessageContact == nil {
+		return nil
+	}
+	clone := *inputMessageContact
+	clone.Contact = inputMessageContact.Contact.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputMessageContact *InputMessageContact) Equal(other *InputMessageContact) bool {
+	if inputMessageContact == nil || other == nil {
+		return inputMessageContact == other
+	}
+	if !inputMessageContact.Contact.Equal(other.Contact) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputMessageContact *InputMessageContact) Diff(other *InputMessageContact) []Change {
+	return inputMessageContact.diff("", other, nil)
+}
+
+func (inputMessageContact *InputMessageContact) diff(path string, other *InputMessageContact, changes []Change) []Change {
+	if inputMessageContact == nil || other == nil {
+		if inputMessageContact != other {
+			changes = append(changes, Change{Path: path, Old: inputMessageContact, New: other})
+		}
+		return changes
+	}
+	changes = inputMessageContact.Contact.diff(fieldPath(path, "contact"), other.Contact, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputMessageContact *InputMessageContact) String() string {
+	return objectString(inputMessageContact)
+}
+
+// LogValue logs the object as its summary
+func (inputMessageContact *InputMessageContact) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputMessageContact))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputMessageContact *InputMessageContact) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputMessageContact)
+}
+
+func (inputMessageContact *InputMessageContact) printText(p *printer) {
+	if inputMessageContact == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputMessageContact")
+	p.object("contact", inputMessageContact.Contact)
+	p.endObject(state)
+}
+
 // GetInputMessageContentEnum return the enum type of this object
 func (inputMessageContact *InputMessageContact) GetInputMessageContentEnum() InputMessageContentEnum {
 	return InputMessageContactType
 }
 
+// Accept calls the method of the visitor for InputMessageContact
+func (inputMessageContact *InputMessageContact) Accept(visitor InputMessageContentVisitor) {
+	visitor.VisitInputMessageContact(inputMessageContact)
+}
+
 // InputMessageDice A dice message
 type InputMessageDice struct {
 	tdCommon
@@ -887,11 +2701,129 @@ func NewInputMessageDice(emoji string, clearDraft bool) *InputMessageDice {
 	return &inputMessageDiceTemp
 }
 
+// MarshalJSON marshals to json
+func (inputMessageDice *InputMessageDice) MarshalJSON() ([]byte, error) {
+	return inputMessageDice.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputMessageDice *InputMessageDice) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputMessageDice)
+}
+
+func (inputMessageDice *InputMessageDice) appendJSON(b []byte) ([]byte, error) {
+	if inputMessageDice == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputMessageDice.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputMessageDice.tdCommon.Extra)
+	b = append(b, `,"emoji":`...)
+	b = appendJSONString(b, inputMessageDice.Emoji)
+	b = append(b, `,"clear_draft":`...)
+	b = appendJSONBool(b, inputMessageDice.ClearDraft)
+	return append(b, '}'), nil
+}
+
+func (inputMessageDice *InputMessageDice) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputMessageDice.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputMessageDice.tdCommon.Extra, err = r.readString()
+		case "emoji":
+			inputMessageDice.Emoji, err = r.readString()
+		case "clear_draft":
+			inputMessageDice.ClearDraft, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputMessageDice *InputMessageDice) Clone() *InputMessageDice {
+	if inputMessageDice == nil {
+		return nil
+	}
+	clone := *inputMessageDice
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputMessageDice *InputMessageDice) Equal(other *InputMessageDice) bool {
+	if inputMessageDice == nil || other == nil {
+		return inputMessageDice == other
+	}
+	if inputMessageDice.Emoji != other.Emoji {
+		return false
+	}
+	if inputMessageDice.ClearDraft != other.ClearDraft {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputMessageDice *InputMessageDice) Diff(other *InputMessageDice) []Change {
+	return inputMessageDice.diff("", other, nil)
+}
+
+func (inputMessageDice *InputMessageDice) diff(path string, other *InputMessageDice, changes []Change) []Change {
+	if inputMessageDice == nil || other == nil {
+		if inputMessageDice != other {
+			changes = append(changes, Change{Path: path, Old: inputMessageDice, New: other})
+		}
+		return changes
+	}
+	if inputMessageDice.Emoji != other.Emoji {
+		changes = append(changes, Change{Path: fieldPath(path, "emoji"), Old: inputMessageDice.Emoji, New: other.Emoji})
+	}
+	if inputMessageDice.ClearDraft != other.ClearDraft {
+		changes = append(changes, Change{Path: fieldPath(path, "clear_draft"), Old: inputMessageDice.ClearDraft, New: other.ClearDraft})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputMessageDice *InputMessageDice) String() string {
+	return objectString(inputMessageDice)
+}
+
+// LogValue logs the object as its summary
+func (inputMessageDice *InputMessageDice) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputMessageDice))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputMessageDice *InputMessageDice) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputMessageDice)
+}
+
+func (inputMessageDice *InputMessageDice) printText(p *printer) {
+	if inputMessageDice == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputMessageDice")
+	p.string("emoji", inputMessageDice.Emoji)
+	p.bool("clear_draft", inputMessageDice.ClearDraft)
+	p.endObject(state)
+}
+
 // GetInputMessageContentEnum return the enum type of this object
 func (inputMessageDice *InputMessageDice) GetInputMessageContentEnum() InputMessageContentEnum {
 	return InputMessageDiceType
 }
 
+// Accept calls the method of the visitor for InputMessageDice
+func (inputMessageDice *InputMessageDice) Accept(visitor InputMessageContentVisitor) {
+	visitor.VisitInputMessageDice(inputMessageDice)
+}
+
 // InputMessageGame A message with a game; not supported for channels or secret chats
 type InputMessageGame struct {
 	tdCommon
@@ -918,11 +2850,129 @@ func NewInputMessageGame(botUserId int64, gameShortName string) *InputMessageGam
 	return &inputMessageGameTemp
 }
 
+// MarshalJSON marshals to json
+func (inputMessageGame *InputMessageGame) MarshalJSON() ([]byte, error) {
+	return inputMessageGame.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputMessageGame *InputMessageGame) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputMessageGame)
+}
+
+func (inputMessageGame *InputMessageGame) appendJSON(b []byte) ([]byte, error) {
+	if inputMessageGame == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputMessageGame.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputMessageGame.tdCommon.Extra)
+	b = append(b, `,"bot_user_id":`...)
+	b = appendJSONInt(b, int64(inputMessageGame.BotUserId))
+	b = append(b, `,"game_short_name":`...)
+	b = appendJSONString(b, inputMessageGame.GameShortName)
+	return append(b, '}'), nil
+}
+
+func (inputMessageGame *InputMessageGame) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputMessageGame.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputMessageGame.tdCommon.Extra, err = r.readString()
+		case "bot_user_id":
+			inputMessageGame.BotUserId, err = r.readInt64()
+		case "game_short_name":
+			inputMessageGame.GameShortName, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputMessageGame *InputMessageGame) Clone() *InputMessageGame {
+	if inputMessageGame == nil {
+		return nil
+	}
+	clone := *inputMessageGame
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputMessageGame *InputMessageGame) Equal(other *InputMessageGame) bool {
+	if inputMessageGame == nil || other == nil {
+		return inputMessageGame == other
+	}
+	if inputMessageGame.BotUserId != other.BotUserId {
+		return false
+	}
+	if inputMessageGame.GameShortName != other.GameShortName {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputMessageGame *InputMessageGame) Diff(other *InputMessageGame) []Change {
+	return inputMessageGame.diff("", other, nil)
+}
+
+func (inputMessageGame *InputMessageGame) diff(path string, other *InputMessageGame, changes []Change) []Change {
+	if inputMessageGame == nil || other == nil {
+		if inputMessageGame != other {
+			changes = append(changes, Change{Path: path, Old: inputMessageGame, New: other})
+		}
+		return changes
+	}
+	if inputMessageGame.BotUserId != other.BotUserId {
+		changes = append(changes, Change{Path: fieldPath(path, "bot_user_id"), Old: inputMessageGame.BotUserId, New: other.BotUserId})
+	}
+	if inputMessageGame.GameShortName != other.GameShortName {
+		changes = append(changes, Change{Path: fieldPath(path, "game_short_name"), Old: inputMessageGame.GameShortName, New: other.GameShortName})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputMessageGame *InputMessageGame) String() string {
+	return objectString(inputMessageGame)
+}
+
+// LogValue logs the object as its summary
+func (inputMessageGame *InputMessageGame) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputMessageGame))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputMessageGame *InputMessageGame) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputMessageGame)
+}
+
+func (inputMessageGame *InputMessageGame) printText(p *printer) {
+	if inputMessageGame == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputMessageGame")
+	p.int("bot_user_id", int64(inputMessageGame.BotUserId))
+	p.string("game_short_name", inputMessageGame.GameShortName)
+	p.endObject(state)
+}
+
 // GetInputMessageContentEnum return the enum type of this object
 func (inputMessageGame *InputMessageGame) GetInputMessageContentEnum() InputMessageContentEnum {
 	return InputMessageGameType
 }
 
+// Accept calls the method of the visitor for InputMessageGame
+func (inputMessageGame *InputMessageGame) Accept(visitor InputMessageContentVisitor) {
+	visitor.VisitInputMessageGame(inputMessageGame)
+}
+
 // InputMessageInvoice A message with an invoice; can be used only by bots
 type InputMessageInvoice struct {
 	tdCommon
@@ -976,11 +3026,239 @@ func NewInputMessageInvoice(invoice *Invoice, title string, description string,
 	return &inputMessageInvoiceTemp
 }
 
+// MarshalJSON marshals to json
+func (inputMessageInvoice *InputMessageInvoice) MarshalJSON() ([]byte, error) {
+	return inputMessageInvoice.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputMessageInvoice *InputMessageInvoice) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputMessageInvoice)
+}
+
+func (inputMessageInvoice *InputMessageInvoice) appendJSON(b []byte) ([]byte, error) {
+	if inputMessageInvoice == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputMessageInvoice.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputMessageInvoice.tdCommon.Extra)
+	b = append(b, `,"invoice":`...)
+	b, err = inputMessageInvoice.Invoice.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, inputMessageInvoice.Title)
+	b = append(b, `,"description":`...)
+	b = appendJSONString(b, inputMessageInvoice.Description)
+	b = append(b, `,"photo_url":`...)
+	b = appendJSONString(b, inputMessageInvoice.PhotoUrl)
+	b = append(b, `,"photo_size":`...)
+	b = appendJSONInt(b, int64(inputMessageInvoice.PhotoSize))
+	b = append(b, `,"photo_width":`...)
+	b = appendJSONInt(b, int64(inputMessageInvoice.PhotoWidth))
+	b = append(b, `,"photo_height":`...)
+	b = appendJSONInt(b, int64(inputMessageInvoice.PhotoHeight))
+	b = append(b, `,"payload":`...)
+	b = appendJSONBytes(b, inputMessageInvoice.Payload)
+	b = append(b, `,"provider_token":`...)
+	b = appendJSONString(b, inputMessageInvoice.ProviderToken)
+	b = append(b, `,"provider_data":`...)
+	b = appendJSONString(b, inputMessageInvoice.ProviderData)
+	b = append(b, `,"start_parameter":`...)
+	b = appendJSONString(b, inputMessageInvoice.StartParameter)
+	return append(b, '}'), nil
+}
+
+func (inputMessageInvoice *InputMessageInvoice) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputMessageInvoice.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputMessageInvoice.tdCommon.Extra, err = r.readString()
+		case "invoice":
+			if r.readNull() {
+				inputMessageInvoice.Invoice = nil
+			} else {
+				inputMessageInvoice.Invoice = new(Invoice)
+				err = inputMessageInvoice.Invoice.decodeJSON(r)
+			}
+		case "title":
+			inputMessageInvoice.Title, err = r.readString()
+		case "description":
+			inputMessageInvoice.Description, err = r.readString()
+		case "photo_url":
+			inputMessageInvoice.PhotoUrl, err = r.readString()
+		case "photo_size":
+			inputMessageInvoice.PhotoSize, err = r.readInt32()
+		case "photo_width":
+			inputMessageInvoice.PhotoWidth, err = r.readInt32()
+		case "photo_height":
+			inputMessageInvoice.PhotoHeight, err = r.readInt32()
+		case "payload":
+			inputMessageInvoice.Payload, err = r.readBytes()
+		case "provider_token":
+			inputMessageInvoice.ProviderToken, err = r.readString()
+		case "provider_data":
+			inputMessageInvoice.ProviderData, err = r.readString()
+		case "start_parameter":
+			inputMessageInvoice.StartParameter, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputMessageInvoice *InputMessageInvoice) Clone() *InputMessageInvoice {
+	if inputMessageInvoice == nil {
+		return nil
+	}
+	clone := *inputMessageInvoice
+	clone.Invoice = inputMessageInvoice.Invoice.Clone()
+	if inputMessageInvoice.Payload != nil {
+		clone.Payload = append([]byte{}, inputMessageInvoice.Payload...)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputMessageInvoice *InputMessageInvoice) Equal(other *InputMessageInvoice) bool {
+	if inputMessageInvoice == nil || other == nil {
+		return inputMessageInvoice == other
+	}
+	if !inputMessageInvoice.Invoice.Equal(other.Invoice) {
+		return false
+	}
+	if inputMessageInvoice.Title != other.Title {
+		return false
+	}
+	if inputMessageInvoice.Description != other.Description {
+		return false
+	}
+	if inputMessageInvoice.PhotoUrl != other.PhotoUrl {
+		return false
+	}
+	if inputMessageInvoice.PhotoSize != other.PhotoSize {
+		return false
+	}
+	if inputMessageInvoice.PhotoWidth != other.PhotoWidth {
+		return false
+	}
+	if inputMessageInvoice.PhotoHeight != other.PhotoHeight {
+		return false
+	}
+	if !equalBytes(inputMessageInvoice.Payload, other.Payload) {
+		return false
+	}
+	if inputMessageInvoice.ProviderToken != other.ProviderToken {
+		return false
+	}
+	if inputMessageInvoice.ProviderData != other.ProviderData {
+		return false
+	}
+	if inputMessageInvoice.StartParameter != other.StartParameter {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputMessageInvoice *InputMessageInvoice) Diff(other *InputMessageInvoice) []Change {
+	return inputMessageInvoice.diff("", other, nil)
+}
+
+func (inputMessageInvoice *InputMessageInvoice) diff(path string, other *InputMessageInvoice, changes []Change) []Change {
+	if inputMessageInvoice == nil || other == nil {
+		if inputMessageInvoice != other {
+			changes = append(changes, Change{Path: path, Old: inputMessageInvoice, New: other})
+		}
+		return changes
+	}
+	changes = inputMessageInvoice.Invoice.diff(fieldPath(path, "invoice"), other.Invoice, changes)
+	if inputMessageInvoice.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: inputMessageInvoice.Title, New: other.Title})
+	}
+	if inputMessageInvoice.Description != other.Description {
+		changes = append(changes, Change{Path: fieldPath(path, "description"), Old: inputMessageInvoice.Description, New: other.Description})
+	}
+	if inputMessageInvoice.PhotoUrl != other.PhotoUrl {
+		changes = append(changes, Change{Path: fieldPath(path, "photo_url"), Old: inputMessageInvoice.PhotoUrl, New: other.PhotoUrl})
+	}
+	if inputMessageInvoice.PhotoSize != other.PhotoSize {
+		changes = append(changes, Change{Path: fieldPath(path, "photo_size"), Old: inputMessageInvoice.PhotoSize, New: other.PhotoSize})
+	}
+	if inputMessageInvoice.PhotoWidth != other.PhotoWidth {
+		changes = append(changes, Change{Path: fieldPath(path, "photo_width"), Old: inputMessageInvoice.PhotoWidth, New: other.PhotoWidth})
+	}
+	if inputMessageInvoice.PhotoHeight != other.PhotoHeight {
+		changes = append(changes, Change{Path: fieldPath(path, "photo_height"), Old: inputMessageInvoice.PhotoHeight, New: other.PhotoHeight})
+	}
+	if !equalBytes(inputMessageInvoice.Payload, other.Payload) {
+		changes = append(changes, Change{Path: fieldPath(path, "payload"), Old: inputMessageInvoice.Payload, New: other.Payload})
+	}
+	if inputMessageInvoice.ProviderToken != other.ProviderToken {
+		changes = append(changes, Change{Path: fieldPath(path, "provider_token"), Old: inputMessageInvoice.ProviderToken, New: other.ProviderToken})
+	}
+	if inputMessageInvoice.ProviderData != other.ProviderData {
+		changes = append(changes, Change{Path: fieldPath(path, "provider_data"), Old: inputMessageInvoice.ProviderData, New: other.ProviderData})
+	}
+	if inputMessageInvoice.StartParameter != other.StartParameter {
+		changes = append(changes, Change{Path: fieldPath(path, "start_parameter"), Old: inputMessageInvoice.StartParameter, New: other.StartParameter})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputMessageInvoice *InputMessageInvoice) String() string {
+	return objectString(inputMessageInvoice)
+}
+
+// LogValue logs the object as its summary
+func (inputMessageInvoice *InputMessageInvoice) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputMessageInvoice))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputMessageInvoice *InputMessageInvoice) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputMessageInvoice)
+}
+
+func (inputMessageInvoice *InputMessageInvoice) printText(p *printer) {
+	if inputMessageInvoice == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputMessageInvoice")
+	p.object("invoice", inputMessageInvoice.Invoice)
+	p.string("title", inputMessageInvoice.Title)
+	p.string("description", inputMessageInvoice.Description)
+	p.string("photo_url", inputMessageInvoice.PhotoUrl)
+	p.int("photo_size", int64(inputMessageInvoice.PhotoSize))
+	p.int("photo_width", int64(inputMessageInvoice.PhotoWidth))
+	p.int("photo_height", int64(inputMessageInvoice.PhotoHeight))
+	p.bytes("payload", inputMessageInvoice.Payload)
+	p.string("provider_token", inputMessageInvoice.ProviderToken)
+	p.string("provider_data", inputMessageInvoice.ProviderData)
+	p.string("start_parameter", inputMessageInvoice.StartParameter)
+	p.endObject(state)
+}
+
 // GetInputMessageContentEnum return the enum type of this object
 func (inputMessageInvoice *InputMessageInvoice) GetInputMessageContentEnum() InputMessageContentEnum {
 	return InputMessageInvoiceType
 }
 
+// Accept calls the method of the visitor for InputMessageInvoice
+func (inputMessageInvoice *InputMessageInvoice) Accept(visitor InputMessageContentVisitor) {
+	visitor.VisitInputMessageInvoice(inputMessageInvoice)
+}
+
 // InputMessagePoll A message with a poll. Polls can't be sent to secret chats. Polls can be sent only to a private chat with a bot
 type InputMessagePoll struct {
 	tdCommon
@@ -1022,39 +3300,213 @@ func NewInputMessagePoll(question string, options []string, isAnonymous bool, ty
 	return &inputMessagePollTemp
 }
 
+// MarshalJSON marshals to json
+func (inputMessagePoll *InputMessagePoll) MarshalJSON() ([]byte, error) {
+	return inputMessagePoll.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputMessagePoll *InputMessagePoll) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Question    string   `json:"question"`     // Poll question; 1-255 characters (up to 300 characters for bots)
-		Options     []string `json:"options"`      // List of poll answer options, 2-10 strings 1-100 characters each
-		IsAnonymous bool     `json:"is_anonymous"` // True, if the poll voters are anonymous. Non-anonymous polls can't be sent or forwarded to channels
-		OpenPeriod  int32    `json:"open_period"`  // Amount of time the poll will be active after creation, in seconds; for bots only
-		CloseDate   int32    `json:"close_date"`   // Point in time (Unix timestamp) when the poll will automatically be closed; for bots only
-		IsClosed    bool     `json:"is_closed"`    // True, if the poll needs to be sent already closed; for bots only
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	return unmarshalFast(b, inputMessagePoll)
+}
+
+func (inputMessagePoll *InputMessagePoll) appendJSON(b []byte) ([]byte, error) {
+	if inputMessagePoll == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputMessagePoll.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputMessagePoll.tdCommon.Extra)
+	b = append(b, `,"question":`...)
+	b = appendJSONString(b, inputMessagePoll.Question)
+	b = append(b, `,"options":`...)
+	if inputMessagePoll.Options == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range inputMessagePoll.Options {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONString(b, inputMessagePoll.Options[i0])
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"is_anonymous":`...)
+	b = appendJSONBool(b, inputMessagePoll.IsAnonymous)
+	b = append(b, `,"type":`...)
+	b, err = appendJSONValue(b, inputMessagePoll.Type)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"open_period":`...)
+	b = appendJSONInt(b, int64(inputMessagePoll.OpenPeriod))
+	b = append(b, `,"close_date":`...)
+	b = appendJSONInt(b, int64(inputMessagePoll.CloseDate))
+	b = append(b, `,"is_closed":`...)
+	b = appendJSONBool(b, inputMessagePoll.IsClosed)
+	return append(b, '}'), nil
+}
+
+func (inputMessagePoll *InputMessagePoll) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputMessagePoll.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputMessagePoll.tdCommon.Extra, err = r.readString()
+		case "question":
+			inputMessagePoll.Question, err = r.readString()
+		case "options":
+			if r.readNull() {
+				inputMessagePoll.Options = nil
+			} else {
+				inputMessagePoll.Options = make([]string, 0)
+				err = r.readArray(func() (err error) {
+					var item0 string
+					item0, err = r.readString()
+					inputMessagePoll.Options = append(inputMessagePoll.Options, item0)
+					return
+				})
+			}
+		case "is_anonymous":
+			inputMessagePoll.IsAnonymous, err = r.readBool()
+		case "type":
+			inputMessagePoll.Type, err = decodePollType(r)
+		case "open_period":
+			inputMessagePoll.OpenPeriod, err = r.readInt32()
+		case "close_date":
+			inputMessagePoll.CloseDate, err = r.readInt32()
+		case "is_closed":
+			inputMessagePoll.IsClosed, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputMessagePoll *InputMessagePoll) Clone() *InputMessagePoll {
+	if inputMessagePoll == nil {
+		return nil
+	}
+	clone := *inputMessagePoll
+	if inputMessagePoll.Options != nil {
+		clone.Options = make([]string, len(inputMessagePoll.Options))
+		copy(clone.Options, inputMessagePoll.Options)
+	}
+	clone.Type = clonePollType(inputMessagePoll.Type)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputMessagePoll *InputMessagePoll) Equal(other *InputMessagePoll) bool {
+	if inputMessagePoll == nil || other == nil {
+		return inputMessagePoll == other
+	}
+	if inputMessagePoll.Question != other.Question {
+		return false
+	}
+	if len(inputMessagePoll.Options) != len(other.Options) {
+		return false
+	}
+	for i0 := range inputMessagePoll.Options {
+		if inputMessagePoll.Options[i0] != other.Options[i0] {
+			return false
+		}
+	}
+	if inputMessagePoll.IsAnonymous != other.IsAnonymous {
+		return false
+	}
+	if !equalPollType(inputMessagePoll.Type, other.Type) {
+		return false
+	}
+	if inputMessagePoll.OpenPeriod != other.OpenPeriod {
+		return false
+	}
+	if inputMessagePoll.CloseDate != other.CloseDate {
+		return false
+	}
+	if inputMessagePoll.IsClosed != other.IsClosed {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputMessagePoll *InputMessagePoll) Diff(other *InputMessagePoll) []Change {
+	return inputMessagePoll.diff("", other, nil)
+}
+
+func (inputMessagePoll *InputMessagePoll) diff(path string, other *InputMessagePoll, changes []Change) []Change {
+	if inputMessagePoll == nil || other == nil {
+		if inputMessagePoll != other {
+			changes = append(changes, Change{Path: path, Old: inputMessagePoll, New: other})
+		}
+		return changes
+	}
+	if inputMessagePoll.Question != other.Question {
+		changes = append(changes, Change{Path: fieldPath(path, "question"), Old: inputMessagePoll.Question, New: other.Question})
+	}
+	if len(inputMessagePoll.Options) != len(other.Options) {
+		changes = append(changes, Change{Path: fieldPath(path, "options"), Old: inputMessagePoll.Options, New: other.Options})
+	} else {
+		for i0 := range inputMessagePoll.Options {
+			if inputMessagePoll.Options[i0] != other.Options[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "options"), i0), Old: inputMessagePoll.Options[i0], New: other.Options[i0]})
+			}
+		}
+	}
+	if inputMessagePoll.IsAnonymous != other.IsAnonymous {
+		changes = append(changes, Change{Path: fieldPath(path, "is_anonymous"), Old: inputMessagePoll.IsAnonymous, New: other.IsAnonymous})
+	}
+	changes = diffPollType(fieldPath(path, "type"), inputMessagePoll.Type, other.Type, changes)
+	if inputMessagePoll.OpenPeriod != other.OpenPeriod {
+		changes = append(changes, Change{Path: fieldPath(path, "open_period"), Old: inputMessagePoll.OpenPeriod, New: other.OpenPeriod})
+	}
+	if inputMessagePoll.CloseDate != other.CloseDate {
+		changes = append(changes, Change{Path: fieldPath(path, "close_date"), Old: inputMessagePoll.CloseDate, New: other.CloseDate})
+	}
+	if inputMessagePoll.IsClosed != other.IsClosed {
+		changes = append(changes, Change{Path: fieldPath(path, "is_closed"), Old: inputMessagePoll.IsClosed, New: other.IsClosed})
 	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputMessagePoll *InputMessagePoll) String() string {
+	return objectString(inputMessagePoll)
+}
 
-	inputMessagePoll.tdCommon = tempObj.tdCommon
-	inputMessagePoll.Question = tempObj.Question
-	inputMessagePoll.Options = tempObj.Options
-	inputMessagePoll.IsAnonymous = tempObj.IsAnonymous
-	inputMessagePoll.OpenPeriod = tempObj.OpenPeriod
-	inputMessagePoll.CloseDate = tempObj.CloseDate
-	inputMessagePoll.IsClosed = tempObj.IsClosed
+// LogValue logs the object as its summary
+func (inputMessagePoll *InputMessagePoll) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputMessagePoll))
+}
 
-	fieldType, _ := unmarshalPollType(objMap["type"])
-	inputMessagePoll.Type = fieldType
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputMessagePoll *InputMessagePoll) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputMessagePoll)
+}
 
-	return nil
+func (inputMessagePoll *InputMessagePoll) printText(p *printer) {
+	if inputMessagePoll == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputMessagePoll")
+	p.string("question", inputMessagePoll.Question)
+	p.vector("options", len(inputMessagePoll.Options), func(i0 int) {
+		p.string("options", inputMessagePoll.Options[i0])
+	})
+	p.bool("is_anonymous", inputMessagePoll.IsAnonymous)
+	p.object("type", inputMessagePoll.Type)
+	p.int("open_period", int64(inputMessagePoll.OpenPeriod))
+	p.int("close_date", int64(inputMessagePoll.CloseDate))
+	p.bool("is_closed", inputMessagePoll.IsClosed)
+	p.endObject(state)
 }
 
 // GetInputMessageContentEnum return the enum type of this object
@@ -1062,6 +3514,11 @@ func (inputMessagePoll *InputMessagePoll) GetInputMessageContentEnum() InputMess
 	return InputMessagePollType
 }
 
+// Accept calls the method of the visitor for InputMessagePoll
+func (inputMessagePoll *InputMessagePoll) Accept(visitor InputMessageContentVisitor) {
+	visitor.VisitInputMessagePoll(inputMessagePoll)
+}
+
 // InputMessageForwarded A forwarded message
 type InputMessageForwarded struct {
 	tdCommon
@@ -1094,7 +3551,475 @@ func NewInputMessageForwarded(fromChatId int64, messageId int64, inGameShare boo
 	return &inputMessageForwardedTemp
 }
 
+// MarshalJSON marshals to json
+func (inputMessageForwarded *InputMessageForwarded) MarshalJSON() ([]byte, error) {
+	return inputMessageForwarded.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputMessageForwarded *InputMessageForwarded) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputMessageForwarded)
+}
+
+func (inputMessageForwarded *InputMessageForwarded) appendJSON(b []byte) ([]byte, error) {
+	if inputMessageForwarded == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputMessageForwarded.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputMessageForwarded.tdCommon.Extra)
+	b = append(b, `,"from_chat_id":`...)
+	b = appendJSONInt(b, int64(inputMessageForwarded.FromChatId))
+	b = append(b, `,"message_id":`...)
+	b = appendJSONInt(b, int64(inputMessageForwarded.MessageId))
+	b = append(b, `,"in_game_share":`...)
+	b = appendJSONBool(b, inputMessageForwarded.InGameShare)
+	b = append(b, `,"copy_options":`...)
+	b, err = inputMessageForwarded.CopyOptions.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inputMessageForwarded *InputMessageForwarded) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputMessageForwarded.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputMessageForwarded.tdCommon.Extra, err = r.readString()
+		case "from_chat_id":
+			inputMessageForwarded.FromChatId, err = r.readInt64()
+		case "message_id":
+			inputMessageForwarded.MessageId, err = r.readInt64()
+		case "in_game_share":
+			inputMessageForwarded.InGameShare, err = r.readBool()
+		case "copy_options":
+			if r.readNull() {
+				inputMessageForwarded.CopyOptions = nil
+			} else {
+				inputMessageForwarded.CopyOptions = new(MessageCopyOptions)
+				err = inputMessageForwarded.CopyOptions.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputMessageForwarded *InputMessageForwarded) Clone() *InputMessageForwarded {
+	if inputMessageForwarded == nil {
+		return nil
+	}
+	clone := *inputMessageForwarded
+	clone.CopyOptions = inputMessageForwarded.CopyOptions.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputMessageForwarded *InputMessageForwarded) Equal(other *InputMessageForwarded) bool {
+	if inputMessageForwarded == nil || other == nil {
+		return inputMessageForwarded == other
+	}
+	if inputMessageForwarded.FromChatId != other.FromChatId {
+		return false
+	}
+	if inputMessageForwarded.MessageId != other.MessageId {
+		return false
+	}
+	if inputMessageForwarded.InGameShare != other.InGameShare {
+		return false
+	}
+	if !inputMessageForwarded.CopyOptions.Equal(other.CopyOptions) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputMessageForwarded *InputMessageForwarded) Diff(other *InputMessageForwarded) []Change {
+	return inputMessageForwarded.diff("", other, nil)
+}
+
+func (inputMessageForwarded *InputMessageForwarded) diff(path string, other *InputMessageForwarded, changes []Change) []Change {
+	if inputMessageForwarded == nil || other == nil {
+		if inputMessageForwarded != other {
+			changes = append(changes, Change{Path: path, Old: inputMessageForwarded, New: other})
+		}
+		return changes
+	}
+	if inputMessageForwarded.FromChatId != other.FromChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "from_chat_id"), Old: inputMessageForwarded.FromChatId, New: other.FromChatId})
+	}
+	if inputMessageForwarded.MessageId != other.MessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "message_id"), Old: inputMessageForwarded.MessageId, New: other.MessageId})
+	}
+	if inputMessageForwarded.InGameShare != other.InGameShare {
+		changes = append(changes, Change{Path: fieldPath(path, "in_game_share"), Old: inputMessageForwarded.InGameShare, New: other.InGameShare})
+	}
+	changes = inputMessageForwarded.CopyOptions.diff(fieldPath(path, "copy_options"), other.CopyOptions, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputMessageForwarded *InputMessageForwarded) String() string {
+	return objectString(inputMessageForwarded)
+}
+
+// LogValue logs the object as its summary
+func (inputMessageForwarded *InputMessageForwarded) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputMessageForwarded))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputMessageForwarded *InputMessageForwarded) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputMessageForwarded)
+}
+
+func (inputMessageForwarded *InputMessageForwarded) printText(p *printer) {
+	if inputMessageForwarded == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputMessageForwarded")
+	p.int("from_chat_id", int64(inputMessageForwarded.FromChatId))
+	p.int("message_id", int64(inputMessageForwarded.MessageId))
+	p.bool("in_game_share", inputMessageForwarded.InGameShare)
+	p.object("copy_options", inputMessageForwarded.CopyOptions)
+	p.endObject(state)
+}
+
 // GetInputMessageContentEnum return the enum type of this object
 func (inputMessageForwarded *InputMessageForwarded) GetInputMessageContentEnum() InputMessageContentEnum {
 	return InputMessageForwardedType
 }
+
+// Accept calls the method of the visitor for InputMessageForwarded
+func (inputMessageForwarded *InputMessageForwarded) Accept(visitor InputMessageContentVisitor) {
+	visitor.VisitInputMessageForwarded(inputMessageForwarded)
+}
+
+// InputMessageContentVisitor visits every type of InputMessageContent, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to InputMessageContent, until they handle it too.
+type InputMessageContentVisitor interface {
+	VisitInputMessageText(inputMessageText *InputMessageText)
+	VisitInputMessageAnimation(inputMessageAnimation *InputMessageAnimation)
+	VisitInputMessageAudio(inputMessageAudio *InputMessageAudio)
+	VisitInputMessageDocument(inputMessageDocument *InputMessageDocument)
+	VisitInputMessagePhoto(inputMessagePhoto *InputMessagePhoto)
+	VisitInputMessageSticker(inputMessageSticker *InputMessageSticker)
+	VisitInputMessageVideo(inputMessageVideo *InputMessageVideo)
+	VisitInputMessageVideoNote(inputMessageVideoNote *InputMessageVideoNote)
+	VisitInputMessageVoiceNote(inputMessageVoiceNote *InputMessageVoiceNote)
+	VisitInputMessageLocation(inputMessageLocation *InputMessageLocation)
+	VisitInputMessageVenue(inputMessageVenue *InputMessageVenue)
+	VisitInputMessageContact(inputMessageContact *InputMessageContact)
+	VisitInputMessageDice(inputMessageDice *InputMessageDice)
+	VisitInputMessageGame(inputMessageGame *InputMessageGame)
+	VisitInputMessageInvoice(inputMessageInvoice *InputMessageInvoice)
+	VisitInputMessagePoll(inputMessagePoll *InputMessagePoll)
+	VisitInputMessageForwarded(inputMessageForwarded *InputMessageForwarded)
+	VisitUnknownInputMessageContent(unknownInputMessageContent *UnknownInputMessageContent)
+}
+
+// InputMessageContentCases holds the function MatchInputMessageContent calls for each type of InputMessageContent;
+// types without a function are passed to the default case
+type InputMessageContentCases[R any] struct {
+	InputMessageText      func(inputMessageText *InputMessageText) R
+	InputMessageAnimation func(inputMessageAnimation *InputMessageAnimation) R
+	InputMessageAudio     func(inputMessageAudio *InputMessageAudio) R
+	InputMessageDocument  func(inputMessageDocument *InputMessageDocument) R
+	InputMessagePhoto     func(inputMessagePhoto *InputMessagePhoto) R
+	InputMessageSticker   func(inputMessageSticker *InputMessageSticker) R
+	InputMessageVideo     func(inputMessageVideo *InputMessageVideo) R
+	InputMessageVideoNote func(inputMessageVideoNote *InputMessageVideoNote) R
+	InputMessageVoiceNote func(inputMessageVoiceNote *InputMessageVoiceNote) R
+	InputMessageLocation  func(inputMessageLocation *InputMessageLocation) R
+	InputMessageVenue     func(inputMessageVenue *InputMessageVenue) R
+	InputMessageContact   func(inputMessageContact *InputMessageContact) R
+	InputMessageDice      func(inputMessageDice *InputMessageDice) R
+	InputMessageGame      func(inputMessageGame *InputMessageGame) R
+	InputMessageInvoice   func(inputMessageInvoice *InputMessageInvoice) R
+	InputMessagePoll      func(inputMessagePoll *InputMessagePoll) R
+	InputMessageForwarded func(inputMessageForwarded *InputMessageForwarded) R
+}
+
+// MatchInputMessageContent calls the case of the type of inputMessageContent, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement InputMessageContentVisitor
+// to have the compiler check every type is handled.
+func MatchInputMessageContent[R any](inputMessageContent InputMessageContent, cases InputMessageContentCases[R], defaultCase func(inputMessageContent InputMessageContent) R) R {
+	switch value := inputMessageContent.(type) {
+	case *InputMessageText:
+		if cases.InputMessageText != nil {
+			return cases.InputMessageText(value)
+		}
+	case *InputMessageAnimation:
+		if cases.InputMessageAnimation != nil {
+			return cases.InputMessageAnimation(value)
+		}
+	case *InputMessageAudio:
+		if cases.InputMessageAudio != nil {
+			return cases.InputMessageAudio(value)
+		}
+	case *InputMessageDocument:
+		if cases.InputMessageDocument != nil {
+			return cases.InputMessageDocument(value)
+		}
+	case *InputMessagePhoto:
+		if cases.InputMessagePhoto != nil {
+			return cases.InputMessagePhoto(value)
+		}
+	case *InputMessageSticker:
+		if cases.InputMessageSticker != nil {
+			return cases.InputMessageSticker(value)
+		}
+	case *InputMessageVideo:
+		if cases.InputMessageVideo != nil {
+			return cases.InputMessageVideo(value)
+		}
+	case *InputMessageVideoNote:
+		if cases.InputMessageVideoNote != nil {
+			return cases.InputMessageVideoNote(value)
+		}
+	case *InputMessageVoiceNote:
+		if cases.InputMessageVoiceNote != nil {
+			return cases.InputMessageVoiceNote(value)
+		}
+	case *InputMessageLocation:
+		if cases.InputMessageLocation != nil {
+			return cases.InputMessageLocation(value)
+		}
+	case *InputMessageVenue:
+		if cases.InputMessageVenue != nil {
+			return cases.InputMessageVenue(value)
+		}
+	case *InputMessageContact:
+		if cases.InputMessageContact != nil {
+			return cases.InputMessageContact(value)
+		}
+	case *InputMessageDice:
+		if cases.InputMessageDice != nil {
+			return cases.InputMessageDice(value)
+		}
+	case *InputMessageGame:
+		if cases.InputMessageGame != nil {
+			return cases.InputMessageGame(value)
+		}
+	case *InputMessageInvoice:
+		if cases.InputMessageInvoice != nil {
+			return cases.InputMessageInvoice(value)
+		}
+	case *InputMessagePoll:
+		if cases.InputMessagePoll != nil {
+			return cases.InputMessagePoll(value)
+		}
+	case *InputMessageForwarded:
+		if cases.InputMessageForwarded != nil {
+			return cases.InputMessageForwarded(value)
+		}
+	}
+	return defaultCase(inputMessageContent)
+}
+
+// Clone returns a deep copy of the object
+func (unknownInputMessageContent *UnknownInputMessageContent) Clone() *UnknownInputMessageContent {
+	if unknownInputMessageContent == nil {
+		return nil
+	}
+	return &UnknownInputMessageContent{Unknown: unknownInputMessageContent.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownInputMessageContent *UnknownInputMessageContent) Equal(other *UnknownInputMessageContent) bool {
+	if unknownInputMessageContent == nil || other == nil {
+		return unknownInputMessageContent == other
+	}
+	return unknownInputMessageContent.Unknown.equal(other.Unknown)
+}
+
+func cloneInputMessageContent(inputMessageContent InputMessageContent) InputMessageContent {
+	switch value := inputMessageContent.(type) {
+	case *InputMessageText:
+		return value.Clone()
+	case *InputMessageAnimation:
+		return value.Clone()
+	case *InputMessageAudio:
+		return value.Clone()
+	case *InputMessageDocument:
+		return value.Clone()
+	case *InputMessagePhoto:
+		return value.Clone()
+	case *InputMessageSticker:
+		return value.Clone()
+	case *InputMessageVideo:
+		return value.Clone()
+	case *InputMessageVideoNote:
+		return value.Clone()
+	case *InputMessageVoiceNote:
+		return value.Clone()
+	case *InputMessageLocation:
+		return value.Clone()
+	case *InputMessageVenue:
+		return value.Clone()
+	case *InputMessageContact:
+		return value.Clone()
+	case *InputMessageDice:
+		return value.Clone()
+	case *InputMessageGame:
+		return value.Clone()
+	case *InputMessageInvoice:
+		return value.Clone()
+	case *InputMessagePoll:
+		return value.Clone()
+	case *InputMessageForwarded:
+		return value.Clone()
+	case *UnknownInputMessageContent:
+		return value.Clone()
+	}
+	return inputMessageContent
+}
+
+func equalInputMessageContent(a InputMessageContent, b InputMessageContent) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *InputMessageText:
+		b, ok := b.(*InputMessageText)
+		return ok && a.Equal(b)
+	case *InputMessageAnimation:
+		b, ok := b.(*InputMessageAnimation)
+		return ok && a.Equal(b)
+	case *InputMessageAudio:
+		b, ok := b.(*InputMessageAudio)
+		return ok && a.Equal(b)
+	case *InputMessageDocument:
+		b, ok := b.(*InputMessageDocument)
+		return ok && a.Equal(b)
+	case *InputMessagePhoto:
+		b, ok := b.(*InputMessagePhoto)
+		return ok && a.Equal(b)
+	case *InputMessageSticker:
+		b, ok := b.(*InputMessageSticker)
+		return ok && a.Equal(b)
+	case *InputMessageVideo:
+		b, ok := b.(*InputMessageVideo)
+		return ok && a.Equal(b)
+	case *InputMessageVideoNote:
+		b, ok := b.(*InputMessageVideoNote)
+		return ok && a.Equal(b)
+	case *InputMessageVoiceNote:
+		b, ok := b.(*InputMessageVoiceNote)
+		return ok && a.Equal(b)
+	case *InputMessageLocation:
+		b, ok := b.(*InputMessageLocation)
+		return ok && a.Equal(b)
+	case *InputMessageVenue:
+		b, ok := b.(*InputMessageVenue)
+		return ok && a.Equal(b)
+	case *InputMessageContact:
+		b, ok := b.(*InputMessageContact)
+		return ok && a.Equal(b)
+	case *InputMessageDice:
+		b, ok := b.(*InputMessageDice)
+		return ok && a.Equal(b)
+	case *InputMessageGame:
+		b, ok := b.(*InputMessageGame)
+		return ok && a.Equal(b)
+	case *InputMessageInvoice:
+		b, ok := b.(*InputMessageInvoice)
+		return ok && a.Equal(b)
+	case *InputMessagePoll:
+		b, ok := b.(*InputMessagePoll)
+		return ok && a.Equal(b)
+	case *InputMessageForwarded:
+		b, ok := b.(*InputMessageForwarded)
+		return ok && a.Equal(b)
+	case *UnknownInputMessageContent:
+		b, ok := b.(*UnknownInputMessageContent)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffInputMessageContent(path string, a InputMessageContent, b InputMessageContent, changes []Change) []Change {
+	switch a := a.(type) {
+	case *InputMessageText:
+		if b, ok := b.(*InputMessageText); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputMessageAnimation:
+		if b, ok := b.(*InputMessageAnimation); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputMessageAudio:
+		if b, ok := b.(*InputMessageAudio); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputMessageDocument:
+		if b, ok := b.(*InputMessageDocument); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputMessagePhoto:
+		if b, ok := b.(*InputMessagePhoto); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputMessageSticker:
+		if b, ok := b.(*InputMessageSticker); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputMessageVideo:
+		if b, ok := b.(*InputMessageVideo); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputMessageVideoNote:
+		if b, ok := b.(*InputMessageVideoNote); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputMessageVoiceNote:
+		if b, ok := b.(*InputMessageVoiceNote); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputMessageLocation:
+		if b, ok := b.(*InputMessageLocation); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputMessageVenue:
+		if b, ok := b.(*InputMessageVenue); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputMessageContact:
+		if b, ok := b.(*InputMessageContact); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputMessageDice:
+		if b, ok := b.(*InputMessageDice); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputMessageGame:
+		if b, ok := b.(*InputMessageGame); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputMessageInvoice:
+		if b, ok := b.(*InputMessageInvoice); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputMessagePoll:
+		if b, ok := b.(*InputMessagePoll); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputMessageForwarded:
+		if b, ok := b.(*InputMessageForwarded); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalInputMessageContent(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // InputPassportElement Contains information about a Telegram Passport element to be saved
 type InputPassportElement interface {
 	GetInputPassportElementEnum() InputPassportElementEnum
+	Accept(visitor InputPassportElementVisitor)
 }
 
 // InputPassportElementEnum Alias for abstract InputPassportElement 'Sub-Classes', used as constant-enum here
@@ -30,85 +32,128 @@ const (
 	InputPassportElementEmailAddressType          InputPassportElementEnum = "inputPassportElementEmailAddress"
 )
 
+// UnknownInputPassportElement is an InputPassportElement of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownInputPassportElement struct {
+	Unknown
+}
+
+// GetInputPassportElementEnum return the enum type of this object
+func (unknownInputPassportElement *UnknownInputPassportElement) GetInputPassportElementEnum() InputPassportElementEnum {
+	return InputPassportElementEnum(unknownInputPassportElement.Type)
+}
+
+// Accept calls the method of the visitor for UnknownInputPassportElement
+func (unknownInputPassportElement *UnknownInputPassportElement) Accept(visitor InputPassportElementVisitor) {
+	visitor.VisitUnknownInputPassportElement(unknownInputPassportElement)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownInputPassportElement *UnknownInputPassportElement) String() string {
+	return objectString(unknownInputPassportElement)
+}
+
+// LogValue logs the object as its summary
+func (unknownInputPassportElement *UnknownInputPassportElement) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownInputPassportElement))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownInputPassportElement *UnknownInputPassportElement) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownInputPassportElement)
+}
+
+func (unknownInputPassportElement *UnknownInputPassportElement) printText(p *printer) {
+	if unknownInputPassportElement == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownInputPassportElement")
+	p.string("@type", unknownInputPassportElement.Type)
+	p.endObject(state)
+}
+
 func unmarshalInputPassportElement(rawMsg *json.RawMessage) (InputPassportElement, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch InputPassportElementEnum(objMap["@type"].(string)) {
+	switch InputPassportElementEnum(typeName) {
 	case InputPassportElementPersonalDetailsType:
 		var inputPassportElementPersonalDetails InputPassportElementPersonalDetails
-		err := json.Unmarshal(*rawMsg, &inputPassportElementPersonalDetails)
+		err := jsonUnmarshal(*rawMsg, &inputPassportElementPersonalDetails)
 		return &inputPassportElementPersonalDetails, err
 
 	case InputPassportElementPassportType:
 		var inputPassportElementPassport InputPassportElementPassport
-		err := json.Unmarshal(*rawMsg, &inputPassportElementPassport)
+		err := jsonUnmarshal(*rawMsg, &inputPassportElementPassport)
 		return &inputPassportElementPassport, err
 
 	case InputPassportElementDriverLicenseType:
 		var inputPassportElementDriverLicense InputPassportElementDriverLicense
-		err := json.Unmarshal(*rawMsg, &inputPassportElementDriverLicense)
+		err := jsonUnmarshal(*rawMsg, &inputPassportElementDriverLicense)
 		return &inputPassportElementDriverLicense, err
 
 	case InputPassportElementIdentityCardType:
 		var inputPassportElementIdentityCard InputPassportElementIdentityCard
-		err := json.Unmarshal(*rawMsg, &inputPassportElementIdentityCard)
+		err := jsonUnmarshal(*rawMsg, &inputPassportElementIdentityCard)
 		return &inputPassportElementIdentityCard, err
 
 	case InputPassportElementInternalPassportType:
 		var inputPassportElementInternalPassport InputPassportElementInternalPassport
-		err := json.Unmarshal(*rawMsg, &inputPassportElementInternalPassport)
+		err := jsonUnmarshal(*rawMsg, &inputPassportElementInternalPassport)
 		return &inputPassportElementInternalPassport, err
 
 	case InputPassportElementAddressType:
 		var inputPassportElementAddress InputPassportElementAddress
-		err := json.Unmarshal(*rawMsg, &inputPassportElementAddress)
+		err := jsonUnmarshal(*rawMsg, &inputPassportElementAddress)
 		return &inputPassportElementAddress, err
 
 	case InputPassportElementUtilityBillType:
 		var inputPassportElementUtilityBill InputPassportElementUtilityBill
-		err := json.Unmarshal(*rawMsg, &inputPassportElementUtilityBill)
+		err := jsonUnmarshal(*rawMsg, &inputPassportElementUtilityBill)
 		return &inputPassportElementUtilityBill, err
 
 	case InputPassportElementBankStatementType:
 		var inputPassportElementBankStatement InputPassportElementBankStatement
-		err := json.Unmarshal(*rawMsg, &inputPassportElementBankStatement)
+		err := jsonUnmarshal(*rawMsg, &inputPassportElementBankStatement)
 		return &inputPassportElementBankStatement, err
 
 	case InputPassportElementRentalAgreementType:
 		var inputPassportElementRentalAgreement InputPassportElementRentalAgreement
-		err := json.Unmarshal(*rawMsg, &inputPassportElementRentalAgreement)
+		err := jsonUnmarshal(*rawMsg, &inputPassportElementRentalAgreement)
 		return &inputPassportElementRentalAgreement, err
 
 	case InputPassportElementPassportRegistrationType:
 		var inputPassportElementPassportRegistration InputPassportElementPassportRegistration
-		err := json.Unmarshal(*rawMsg, &inputPassportElementPassportRegistration)
+		err := jsonUnmarshal(*rawMsg, &inputPassportElementPassportRegistration)
 		return &inputPassportElementPassportRegistration, err
 
 	case InputPassportElementTemporaryRegistrationType:
 		var inputPassportElementTemporaryRegistration InputPassportElementTemporaryRegistration
-		err := json.Unmarshal(*rawMsg, &inputPassportElementTemporaryRegistration)
+		err := jsonUnmarshal(*rawMsg, &inputPassportElementTemporaryRegistration)
 		return &inputPassportElementTemporaryRegistration, err
 
 	case InputPassportElementPhoneNumberType:
 		var inputPassportElementPhoneNumber InputPassportElementPhoneNumber
-		err := json.Unmarshal(*rawMsg, &inputPassportElementPhoneNumber)
+		err := jsonUnmarshal(*rawMsg, &inputPassportElementPhoneNumber)
 		return &inputPassportElementPhoneNumber, err
 
 	case InputPassportElementEmailAddressType:
 		var inputPassportElementEmailAddress InputPassportElementEmailAddress
-		err := json.Unmarshal(*rawMsg, &inputPassportElementEmailAddress)
+		err := jsonUnmarshal(*rawMsg, &inputPassportElementEmailAddress)
 		return &inputPassportElementEmailAddress, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownInputPassportElement{Unknown: unknown}, nil
 	}
 }
 
@@ -135,11 +180,126 @@ func NewInputPassportElementPersonalDetails(personalDetails *PersonalDetails) *I
 	return &inputPassportElementPersonalDetailsTemp
 }
 
+// MarshalJSON marshals to json
+func (inputPassportElementPersonalDetails *InputPassportElementPersonalDetails) MarshalJSON() ([]byte, error) {
+	return inputPassportElementPersonalDetails.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputPassportElementPersonalDetails *InputPassportElementPersonalDetails) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputPassportElementPersonalDetails)
+}
+
+func (inputPassportElementPersonalDetails *InputPassportElementPersonalDetails) appendJSON(b []byte) ([]byte, error) {
+	if inputPassportElementPersonalDetails == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputPassportElementPersonalDetails.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputPassportElementPersonalDetails.tdCommon.Extra)
+	b = append(b, `,"personal_details":`...)
+	b, err = inputPassportElementPersonalDetails.PersonalDetails.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inputPassportElementPersonalDetails *InputPassportElementPersonalDetails) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputPassportElementPersonalDetails.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputPassportElementPersonalDetails.tdCommon.Extra, err = r.readString()
+		case "personal_details":
+			if r.readNull() {
+				inputPassportElementPersonalDetails.PersonalDetails = nil
+			} else {
+				inputPassportElementPersonalDetails.PersonalDetails = new(PersonalDetails)
+				err = inputPassportElementPersonalDetails.PersonalDetails.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputPassportElementPersonalDetails *InputPassportElementPersonalDetails) Clone() *InputPassportElementPersonalDetails {
+	if inputPassportElementPersonalDetails == nil {
+		return nil
+	}
+	clone := *inputPassportElementPersonalDetails
+	clone.PersonalDetails = inputPassportElementPersonalDetails.PersonalDetails.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputPassportElementPersonalDetails *InputPassportElementPersonalDetails) Equal(other *InputPassportElementPersonalDetails) bool {
+	if inputPassportElementPersonalDetails == nil || other == nil {
+		return inputPassportElementPersonalDetails == other
+	}
+	if !inputPassportElementPersonalDetails.PersonalDetails.Equal(other.PersonalDetails) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputPassportElementPersonalDetails *InputPassportElementPersonalDetails) Diff(other *InputPassportElementPersonalDetails) []Change {
+	return inputPassportElementPersonalDetails.diff("", other, nil)
+}
+
+func (inputPassportElementPersonalDetails *InputPassportElementPersonalDetails) diff(path string, other *InputPassportElementPersonalDetails, changes []Change) []Change {
+	if inputPassportElementPersonalDetails == nil || other == nil {
+		if inputPassportElementPersonalDetails != other {
+			changes = append(changes, Change{Path: path, Old: inputPassportElementPersonalDetails, New: other})
+		}
+		return changes
+	}
+	changes = inputPassportElementPersonalDetails.PersonalDetails.diff(fieldPath(path, "personal_details"), other.PersonalDetails, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputPassportElementPersonalDetails *InputPassportElementPersonalDetails) String() string {
+	return objectString(inputPassportElementPersonalDetails)
+}
+
+// LogValue logs the object as its summary
+func (inputPassportElementPersonalDetails *InputPassportElementPersonalDetails) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputPassportElementPersonalDetails))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputPassportElementPersonalDetails *InputPassportElementPersonalDetails) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputPassportElementPersonalDetails)
+}
+
+func (inputPassportElementPersonalDetails *InputPassportElementPersonalDetails) printText(p *printer) {
+	if inputPassportElementPersonalDetails == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputPassportElementPersonalDetails")
+	p.object("personal_details", inputPassportElementPersonalDetails.PersonalDetails)
+	p.endObject(state)
+}
+
 // GetInputPassportElementEnum return the enum type of this object
 func (inputPassportElementPersonalDetails *InputPassportElementPersonalDetails) GetInputPassportElementEnum() InputPassportElementEnum {
 	return InputPassportElementPersonalDetailsType
 }
 
+// Accept calls the method of the visitor for InputPassportElementPersonalDetails
+func (inputPassportElementPersonalDetails *InputPassportElementPersonalDetails) Accept(visitor InputPassportElementVisitor) {
+	visitor.VisitInputPassportElementPersonalDetails(inputPassportElementPersonalDetails)
+}
+
 // InputPassportElementPassport A Telegram Passport element to be saved containing the user's passport
 type InputPassportElementPassport struct {
 	tdCommon
@@ -163,11 +323,126 @@ func NewInputPassportElementPassport(passport *InputIdentityDocument) *InputPass
 	return &inputPassportElementPassportTemp
 }
 
+// MarshalJSON marshals to json
+func (inputPassportElementPassport *InputPassportElementPassport) MarshalJSON() ([]byte, error) {
+	return inputPassportElementPassport.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputPassportElementPassport *InputPassportElementPassport) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputPassportElementPassport)
+}
+
+func (inputPassportElementPassport *InputPassportElementPassport) appendJSON(b []byte) ([]byte, error) {
+	if inputPassportElementPassport == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputPassportElementPassport.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputPassportElementPassport.tdCommon.Extra)
+	b = append(b, `,"passport":`...)
+	b, err = inputPassportElementPassport.Passport.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inputPassportElementPassport *InputPassportElementPassport) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputPassportElementPassport.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputPassportElementPassport.tdCommon.Extra, err = r.readString()
+		case "passport":
+			if r.readNull() {
+				inputPassportElementPassport.Passport = nil
+			} else {
+				inputPassportElementPassport.Passport = new(InputIdentityDocument)
+				err = inputPassportElementPassport.Passport.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputPassportElementPassport *InputPassportElementPassport) Clone() *InputPassportElementPassport {
+	if inputPassportElementPassport == nil {
+		return nil
+	}
+	clone := *inputPassportElementPassport
+	clone.Passport = inputPassportElementPassport.Passport.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputPassportElementPassport *InputPassportElementPassport) Equal(other *InputPassportElementPassport) bool {
+	if inputPassportElementPassport == nil || other == nil {
+		return inputPassportElementPassport == other
+	}
+	if !inputPassportElementPassport.Passport.Equal(other.Passport) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputPassportElementPassport *InputPassportElementPassport) Diff(other *InputPassportElementPassport) []Change {
+	return inputPassportElementPassport.diff("", other, nil)
+}
+
+func (inputPassportElementPassport *InputPassportElementPassport) diff(path string, other *InputPassportElementPassport, changes []Change) []Change {
+	if inputPassportElementPassport == nil || other == nil {
+		if inputPassportElementPassport != other {
+			changes = append(changes, Change{Path: path, Old: inputPassportElementPassport, New: other})
+		}
+		return changes
+	}
+	changes = inputPassportElementPassport.Passport.diff(fieldPath(path, "passport"), other.Passport, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputPassportElementPassport *InputPassportElementPassport) String() string {
+	return objectString(inputPassportElementPassport)
+}
+
+// LogValue logs the object as its summary
+func (inputPassportElementPassport *InputPassportElementPassport) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputPassportElementPassport))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputPassportElementPassport *InputPassportElementPassport) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputPassportElementPassport)
+}
+
+func (inputPassportElementPassport *InputPassportElementPassport) printText(p *printer) {
+	if inputPassportElementPassport == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputPassportElementPassport")
+	p.object("passport", inputPassportElementPassport.Passport)
+	p.endObject(state)
+}
+
 // GetInputPassportElementEnum return the enum type of this object
 func (inputPassportElementPassport *InputPassportElementPassport) GetInputPassportElementEnum() InputPassportElementEnum {
 	return InputPassportElementPassportType
 }
 
+// Accept calls the method of the visitor for InputPassportElementPassport
+func (inputPassportElementPassport *InputPassportElementPassport) Accept(visitor InputPassportElementVisitor) {
+	visitor.VisitInputPassportElementPassport(inputPassportElementPassport)
+}
+
 // InputPassportElementDriverLicense A Telegram Passport element to be saved containing the user's driver license
 type InputPassportElementDriverLicense struct {
 	tdCommon
@@ -191,11 +466,126 @@ func NewInputPassportElementDriverLicense(driverLicense *InputIdentityDocument)
 	return &inputPassportElementDriverLicenseTemp
 }
 
+// MarshalJSON marshals to json
+func (inputPassportElementDriverLicense *InputPassportElementDriverLicense) MarshalJSON() ([]byte, error) {
+	return inputPassportElementDriverLicense.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputPassportElementDriverLicense *InputPassportElementDriverLicense) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputPassportElementDriverLicense)
+}
+
+func (inputPassportElementDriverLicense *InputPassportElementDriverLicense) appendJSON(b []byte) ([]byte, error) {
+	if inputPassportElementDriverLicense == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputPassportElementDriverLicense.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputPassportElementDriverLicense.tdCommon.Extra)
+	b = append(b, `,"driver_license":`...)
+	b, err = inputPassportElementDriverLicense.DriverLicense.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inputPassportElementDriverLicense *InputPassportElementDriverLicense) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputPassportElementDriverLicense.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputPassportElementDriverLicense.tdCommon.Extra, err = r.readString()
+		case "driver_license":
+			if r.readNull() {
+				inputPassportElementDriverLicense.DriverLicense = nil
+			} else {
+				inputPassportElementDriverLicense.DriverLicense = new(InputIdentityDocument)
+				err = inputPassportElementDriverLicense.DriverLicense.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputPassportElementDriverLicense *InputPassportElementDriverLicense) Clone() *InputPassportElementDriverLicense {
+	if inputPassportElementDriverLicense == nil {
+		return nil
+	}
+	clone := *inputPassportElementDriverLicense
+	clone.DriverLicense = inputPassportElementDriverLicense.DriverLicense.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputPassportElementDriverLicense *InputPassportElementDriverLicense) Equal(other *InputPassportElementDriverLicense) bool {
+	if inputPassportElementDriverLicense == nil || other == nil {
+		return inputPassportElementDriverLicense == other
+	}
+	if !inputPassportElementDriverLicense.DriverLicense.Equal(other.DriverLicense) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputPassportElementDriverLicense *InputPassportElementDriverLicense) Diff(other *InputPassportElementDriverLicense) []Change {
+	return inputPassportElementDriverLicense.diff("", other, nil)
+}
+
+func (inputPassportElementDriverLicense *InputPassportElementDriverLicense) diff(path string, other *InputPassportElementDriverLicense, changes []Change) []Change {
+	if inputPassportElementDriverLicense == nil || other == nil {
+		if inputPassportElementDriverLicense != other {
+			changes = append(changes, Change{Path: path, Old: inputPassportElementDriverLicense, New: other})
+		}
+		return changes
+	}
+	changes = inputPassportElementDriverLicense.DriverLicense.diff(fieldPath(path, "driver_license"), other.DriverLicense, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputPassportElementDriverLicense *InputPassportElementDriverLicense) String() string {
+	return objectString(inputPassportElementDriverLicense)
+}
+
+// LogValue logs the object as its summary
+func (inputPassportElementDriverLicense *InputPassportElementDriverLicense) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputPassportElementDriverLicense))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputPassportElementDriverLicense *InputPassportElementDriverLicense) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputPassportElementDriverLicense)
+}
+
+func (inputPassportElementDriverLicense *InputPassportElementDriverLicense) printText(p *printer) {
+	if inputPassportElementDriverLicense == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputPassportElementDriverLicense")
+	p.object("driver_license", inputPassportElementDriverLicense.DriverLicense)
+	p.endObject(state)
+}
+
 // GetInputPassportElementEnum return the enum type of this object
 func (inputPassportElementDriverLicense *InputPassportElementDriverLicense) GetInputPassportElementEnum() InputPassportElementEnum {
 	return InputPassportElementDriverLicenseType
 }
 
+// Accept calls the method of the visitor for InputPassportElementDriverLicense
+func (inputPassportElementDriverLicense *InputPassportElementDriverLicense) Accept(visitor InputPassportElementVisitor) {
+	visitor.VisitInputPassportElementDriverLicense(inputPassportElementDriverLicense)
+}
+
 // InputPassportElementIdentityCard A Telegram Passport element to be saved containing the user's identity card
 type InputPassportElementIdentityCard struct {
 	tdCommon
@@ -219,11 +609,126 @@ func NewInputPassportElementIdentityCard(identityCard *InputIdentityDocument) *I
 	return &inputPassportElementIdentityCardTemp
 }
 
+// MarshalJSON marshals to json
+func (inputPassportElementIdentityCard *InputPassportElementIdentityCard) MarshalJSON() ([]byte, error) {
+	return inputPassportElementIdentityCard.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputPassportElementIdentityCard *InputPassportElementIdentityCard) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputPassportElementIdentityCard)
+}
+
+func (inputPassportElementIdentityCard *InputPassportElementIdentityCard) appendJSON(b []byte) ([]byte, error) {
+	if inputPassportElementIdentityCard == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputPassportElementIdentityCard.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputPassportElementIdentityCard.tdCommon.Extra)
+	b = append(b, `,"identity_card":`...)
+	b, err = inputPassportElementIdentityCard.IdentityCard.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inputPassportElementIdentityCard *InputPassportElementIdentityCard) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputPassportElementIdentityCard.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputPassportElementIdentityCard.tdCommon.Extra, err = r.readString()
+		case "identity_card":
+			if r.readNull() {
+				inputPassportElementIdentityCard.IdentityCard = nil
+			} else {
+				inputPassportElementIdentityCard.IdentityCard = new(InputIdentityDocument)
+				err = inputPassportElementIdentityCard.IdentityCard.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputPassportElementIdentityCard *InputPassportElementIdentityCard) Clone() *InputPassportElementIdentityCard {
+	if inputPassportElementIdentityCard == nil {
+		return nil
+	}
+	clone := *inputPassportElementIdentityCard
+	clone.IdentityCard = inputPassportElementIdentityCard.IdentityCard.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputPassportElementIdentityCard *InputPassportElementIdentityCard) Equal(other *InputPassportElementIdentityCard) bool {
+	if inputPassportElementIdentityCard == nil || other == nil {
+		return inputPassportElementIdentityCard == other
+	}
+	if !inputPassportElementIdentityCard.IdentityCard.Equal(other.IdentityCard) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputPassportElementIdentityCard *InputPassportElementIdentityCard) Diff(other *InputPassportElementIdentityCard) []Change {
+	return inputPassportElementIdentityCard.diff("", other, nil)
+}
+
+func (inputPassportElementIdentityCard *InputPassportElementIdentityCard) diff(path string, other *InputPassportElementIdentityCard, changes []Change) []Change {
+	if inputPassportElementIdentityCard == nil || other == nil {
+		if inputPassportElementIdentityCard != other {
+			changes = append(changes, Change{Path: path, Old: inputPassportElementIdentityCard, New: other})
+		}
+		return changes
+	}
+	changes = inputPassportElementIdentityCard.IdentityCard.diff(fieldPath(path, "identity_card"), other.IdentityCard, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputPassportElementIdentityCard *InputPassportElementIdentityCard) String() string {
+	return objectString(inputPassportElementIdentityCard)
+}
+
+// LogValue logs the object as its summary
+func (inputPassportElementIdentityCard *InputPassportElementIdentityCard) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputPassportElementIdentityCard))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputPassportElementIdentityCard *InputPassportElementIdentityCard) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputPassportElementIdentityCard)
+}
+
+func (inputPassportElementIdentityCard *InputPassportElementIdentityCard) printText(p *printer) {
+	if inputPassportElementIdentityCard == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputPassportElementIdentityCard")
+	p.object("identity_card", inputPassportElementIdentityCard.IdentityCard)
+	p.endObject(state)
+}
+
 // GetInputPassportElementEnum return the enum type of this object
 func (inputPassportElementIdentityCard *InputPassportElementIdentityCard) GetInputPassportElementEnum() InputPassportElementEnum {
 	return InputPassportElementIdentityCardType
 }
 
+// Accept calls the method of the visitor for InputPassportElementIdentityCard
+func (inputPassportElementIdentityCard *InputPassportElementIdentityCard) Accept(visitor InputPassportElementVisitor) {
+	visitor.VisitInputPassportElementIdentityCard(inputPassportElementIdentityCard)
+}
+
 // InputPassportElementInternalPassport A Telegram Passport element to be saved containing the user's internal passport
 type InputPassportElementInternalPassport struct {
 	tdCommon
@@ -247,11 +752,126 @@ func NewInputPassportElementInternalPassport(internalPassport *InputIdentityDocu
 	return &inputPassportElementInternalPassportTemp
 }
 
+// MarshalJSON marshals to json
+func (inputPassportElementInternalPassport *InputPassportElementInternalPassport) MarshalJSON() ([]byte, error) {
+	return inputPassportElementInternalPassport.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputPassportElementInternalPassport *InputPassportElementInternalPassport) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputPassportElementInternalPassport)
+}
+
+func (inputPassportElementInternalPassport *InputPassportElementInternalPassport) appendJSON(b []byte) ([]byte, error) {
+	if inputPassportElementInternalPassport == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputPassportElementInternalPassport.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputPassportElementInternalPassport.tdCommon.Extra)
+	b = append(b, `,"internal_passport":`...)
+	b, err = inputPassportElementInternalPassport.InternalPassport.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inputPassportElementInternalPassport *InputPassportElementInternalPassport) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputPassportElementInternalPassport.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputPassportElementInternalPassport.tdCommon.Extra, err = r.readString()
+		case "internal_passport":
+			if r.readNull() {
+				inputPassportElementInternalPassport.InternalPassport = nil
+			} else {
+				inputPassportElementInternalPassport.InternalPassport = new(InputIdentityDocument)
+				err = inputPassportElementInternalPassport.InternalPassport.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputPassportElementInternalPassport *InputPassportElementInternalPassport) Clone() *InputPassportElementInternalPassport {
+	if inputPassportElementInternalPassport == nil {
+		return nil
+	}
+	clone := *inputPassportElementInternalPassport
+	clone.InternalPassport = inputPassportElementInternalPassport.InternalPassport.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputPassportElementInternalPassport *InputPassportElementInternalPassport) Equal(other *InputPassportElementInternalPassport) bool {
+	if inputPassportElementInternalPassport == nil || other == nil {
+		return inputPassportElementInternalPassport == other
+	}
+	if !inputPassportElementInternalPassport.InternalPassport.Equal(other.InternalPassport) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputPassportElementInternalPassport *InputPassportElementInternalPassport) Diff(other *InputPassportElementInternalPassport) []Change {
+	return inputPassportElementInternalPassport.diff("", other, nil)
+}
+
+func (inputPassportElementInternalPassport *InputPassportElementInternalPassport) diff(path string, other *InputPassportElementInternalPassport, changes []Change) []Change {
+	if inputPassportElementInternalPassport == nil || other == nil {
+		if inputPassportElementInternalPassport != other {
+			changes = append(changes, Change{Path: path, Old: inputPassportElementInternalPassport, New: other})
+		}
+		return changes
+	}
+	changes = inputPassportElementInternalPassport.InternalPassport.diff(fieldPath(path, "internal_passport"), other.InternalPassport, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputPassportElementInternalPassport *InputPassportElementInternalPassport) String() string {
+	return objectString(inputPassportElementInternalPassport)
+}
+
+// LogValue logs the object as its summary
+func (inputPassportElementInternalPassport *InputPassportElementInternalPassport) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputPassportElementInternalPassport))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputPassportElementInternalPassport *InputPassportElementInternalPassport) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputPassportElementInternalPassport)
+}
+
+func (inputPassportElementInternalPassport *InputPassportElementInternalPassport) printText(p *printer) {
+	if inputPassportElementInternalPassport == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputPassportElementInternalPassport")
+	p.object("internal_passport", inputPassportElementInternalPassport.InternalPassport)
+	p.endObject(state)
+}
+
 // GetInputPassportElementEnum return the enum type of this object
 func (inputPassportElementInternalPassport *InputPassportElementInternalPassport) GetInputPassportElementEnum() InputPassportElementEnum {
 	return InputPassportElementInternalPassportType
 }
 
+// Accept calls the method of the visitor for InputPassportElementInternalPassport
+func (inputPassportElementInternalPassport *InputPassportElementInternalPassport) Accept(visitor InputPassportElementVisitor) {
+	visitor.VisitInputPassportElementInternalPassport(inputPassportElementInternalPassport)
+}
+
 // InputPassportElementAddress A Telegram Passport element to be saved containing the user's address
 type InputPassportElementAddress struct {
 	tdCommon
@@ -275,11 +895,126 @@ func NewInputPassportElementAddress(address *Address) *InputPassportElementAddre
 	return &inputPassportElementAddressTemp
 }
 
+// MarshalJSON marshals to json
+func (inputPassportElementAddress *InputPassportElementAddress) MarshalJSON() ([]byte, error) {
+	return inputPassportElementAddress.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputPassportElementAddress *InputPassportElementAddress) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputPassportElementAddress)
+}
+
+func (inputPassportElementAddress *InputPassportElementAddress) appendJSON(b []byte) ([]byte, error) {
+	if inputPassportElementAddress == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputPassportElementAddress.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputPassportElementAddress.tdCommon.Extra)
+	b = append(b, `,"address":`...)
+	b, err = inputPassportElementAddress.Address.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inputPassportElementAddress *InputPassportElementAddress) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputPassportElementAddress.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputPassportElementAddress.tdCommon.Extra, err = r.readString()
+		case "address":
+			if r.readNull() {
+				inputPassportElementAddress.Address = nil
+			} else {
+				inputPassportElementAddress.Address = new(Address)
+				err = inputPassportElementAddress.Address.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputPassportElementAddress *InputPassportElementAddress) Clone() *InputPassportElementAddress {
+	if inputPassportElementAddress == nil {
+		return nil
+	}
+	clone := *inputPassportElementAddress
+	clone.Address = inputPassportElementAddress.Address.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputPassportElementAddress *InputPassportElementAddress) Equal(other *InputPassportElementAddress) bool {
+	if inputPassportElementAddress == nil || other == nil {
+		return inputPassportElementAddress == other
+	}
+	if !inputPassportElementAddress.Address.Equal(other.Address) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputPassportElementAddress *InputPassportElementAddress) Diff(other *InputPassportElementAddress) []Change {
+	return inputPassportElementAddress.diff("", other, nil)
+}
+
+func (inputPassportElementAddress *InputPassportElementAddress) diff(path string, other *InputPassportElementAddress, changes []Change) []Change {
+	if inputPassportElementAddress == nil || other == nil {
+		if inputPassportElementAddress != other {
+			changes = append(changes, Change{Path: path, Old: inputPassportElementAddress, New: other})
+		}
+		return changes
+	}
+	changes = inputPassportElementAddress.Address.diff(fieldPath(path, "address"), other.Address, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputPassportElementAddress *InputPassportElementAddress) String() string {
+	return objectString(inputPassportElementAddress)
+}
+
+// LogValue logs the object as its summary
+func (inputPassportElementAddress *InputPassportElementAddress) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputPassportElementAddress))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputPassportElementAddress *InputPassportElementAddress) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputPassportElementAddress)
+}
+
+func (inputPassportElementAddress *InputPassportElementAddress) printText(p *printer) {
+	if inputPassportElementAddress == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputPassportElementAddress")
+	p.object("address", inputPassportElementAddress.Address)
+	p.endObject(state)
+}
+
 // GetInputPassportElementEnum return the enum type of this object
 func (inputPassportElementAddress *InputPassportElementAddress) GetInputPassportElementEnum() InputPassportElementEnum {
 	return InputPassportElementAddressType
 }
 
+// Accept calls the method of the visitor for InputPassportElementAddress
+func (inputPassportElementAddress *InputPassportElementAddress) Accept(visitor InputPassportElementVisitor) {
+	visitor.VisitInputPassportElementAddress(inputPassportElementAddress)
+}
+
 // InputPassportElementUtilityBill A Telegram Passport element to be saved containing the user's utility bill
 type InputPassportElementUtilityBill struct {
 	tdCommon
@@ -303,11 +1038,126 @@ func NewInputPassportElementUtilityBill(utilityBill *InputPersonalDocument) *Inp
 	return &inputPassportElementUtilityBillTemp
 }
 
+// MarshalJSON marshals to json
+func (inputPassportElementUtilityBill *InputPassportElementUtilityBill) MarshalJSON() ([]byte, error) {
+	return inputPassportElementUtilityBill.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputPassportElementUtilityBill *InputPassportElementUtilityBill) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputPassportElementUtilityBill)
+}
+
+func (inputPassportElementUtilityBill *InputPassportElementUtilityBill) appendJSON(b []byte) ([]byte, error) {
+	if inputPassportElementUtilityBill == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputPassportElementUtilityBill.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputPassportElementUtilityBill.tdCommon.Extra)
+	b = append(b, `,"utility_bill":`...)
+	b, err = inputPassportElementUtilityBill.UtilityBill.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inputPassportElementUtilityBill *InputPassportElementUtilityBill) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputPassportElementUtilityBill.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputPassportElementUtilityBill.tdCommon.Extra, err = r.readString()
+		case "utility_bill":
+			if r.readNull() {
+				inputPassportElementUtilityBill.UtilityBill = nil
+			} else {
+				inputPassportElementUtilityBill.UtilityBill = new(InputPersonalDocument)
+				err = inputPassportElementUtilityBill.UtilityBill.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputPassportElementUtilityBill *InputPassportElementUtilityBill) Clone() *InputPassportElementUtilityBill {
+	if inputPassportElementUtilityBill == nil {
+		return nil
+	}
+	clone := *inputPassportElementUtilityBill
+	clone.UtilityBill = inputPassportElementUtilityBill.UtilityBill.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputPassportElementUtilityBill *InputPassportElementUtilityBill) Equal(other *InputPassportElementUtilityBill) bool {
+	if inputPassportElementUtilityBill == nil || other == nil {
+		return inputPassportElementUtilityBill == other
+	}
+	if !inputPassportElementUtilityBill.UtilityBill.Equal(other.UtilityBill) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputPassportElementUtilityBill *InputPassportElementUtilityBill) Diff(other *InputPassportElementUtilityBill) []Change {
+	return inputPassportElementUtilityBill.diff("", other, nil)
+}
+
+func (inputPassportElementUtilityBill *InputPassportElementUtilityBill) diff(path string, other *InputPassportElementUtilityBill, changes []Change) []Change {
+	if inputPassportElementUtilityBill == nil || other == nil {
+		if inputPassportElementUtilityBill != other {
+			changes = append(changes, Change{Path: path, Old: inputPassportElementUtilityBill, New: other})
+		}
+		return changes
+	}
+	changes = inputPassportElementUtilityBill.UtilityBill.diff(fieldPath(path, "utility_bill"), other.UtilityBill, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputPassportElementUtilityBill *InputPassportElementUtilityBill) String() string {
+	return objectString(inputPassportElementUtilityBill)
+}
+
+// LogValue logs the object as its summary
+func (inputPassportElementUtilityBill *InputPassportElementUtilityBill) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputPassportElementUtilityBill))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputPassportElementUtilityBill *InputPassportElementUtilityBill) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputPassportElementUtilityBill)
+}
+
+func (inputPassportElementUtilityBill *InputPassportElementUtilityBill) printText(p *printer) {
+	if inputPassportElementUtilityBill == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputPassportElementUtilityBill")
+	p.object("utility_bill", inputPassportElementUtilityBill.UtilityBill)
+	p.endObject(state)
+}
+
 // GetInputPassportElementEnum return the enum type of this object
 func (inputPassportElementUtilityBill *InputPassportElementUtilityBill) GetInputPassportElementEnum() InputPassportElementEnum {
 	return InputPassportElementUtilityBillType
 }
 
+// Accept calls the method of the visitor for InputPassportElementUtilityBill
+func (inputPassportElementUtilityBill *InputPassportElementUtilityBill) Accept(visitor InputPassportElementVisitor) {
+	visitor.VisitInputPassportElementUtilityBill(inputPassportElementUtilityBill)
+}
+
 // InputPassportElementBankStatement A Telegram Passport element to be saved containing the user's bank statement
 type InputPassportElementBankStatement struct {
 	tdCommon
@@ -331,11 +1181,126 @@ func NewInputPassportElementBankStatement(bankStatement *InputPersonalDocument)
 	return &inputPassportElementBankStatementTemp
 }
 
+// MarshalJSON marshals to json
+func (inputPassportElementBankStatement *InputPassportElementBankStatement) MarshalJSON() ([]byte, error) {
+	return inputPassportElementBankStatement.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputPassportElementBankStatement *InputPassportElementBankStatement) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputPassportElementBankStatement)
+}
+
+func (inputPassportElementBankStatement *InputPassportElementBankStatement) appendJSON(b []byte) ([]byte, error) {
+	if inputPassportElementBankStatement == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputPassportElementBankStatement.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputPassportElementBankStatement.tdCommon.Extra)
+	b = append(b, `,"bank_statement":`...)
+	b, err = inputPassportElementBankStatement.BankStatement.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inputPassportElementBankStatement *InputPassportElementBankStatement) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputPassportElementBankStatement.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputPassportElementBankStatement.tdCommon.Extra, err = r.readString()
+		case "bank_statement":
+			if r.readNull() {
+				inputPassportElementBankStatement.BankStatement = nil
+			} else {
+				inputPassportElementBankStatement.BankStatement = new(InputPersonalDocument)
+				err = inputPassportElementBankStatement.BankStatement.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputPassportElementBankStatement *InputPassportElementBankStatement) Clone() *InputPassportElementBankStatement {
+	if inputPassportElementBankStatement == nil {
+		return nil
+	}
+	clone := *inputPassportElementBankStatement
+	clone.BankStatement = inputPassportElementBankStatement.BankStatement.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputPassportElementBankStatement *InputPassportElementBankStatement) Equal(other *InputPassportElementBankStatement) bool {
+	if inputPassportElementBankStatement == nil || other == nil {
+		return inputPassportElementBankStatement == other
+	}
+	if !inputPassportElementBankStatement.BankStatement.Equal(other.BankStatement) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputPassportElementBankStatement *InputPassportElementBankStatement) Diff(other *InputPassportElementBankStatement) []Change {
+	return inputPassportElementBankStatement.diff("", other, nil)
+}
+
+func (inputPassportElementBankStatement *InputPassportElementBankStatement) diff(path string, other *InputPassportElementBankStatement, changes []Change) []Change {
+	if inputPassportElementBankStatement == nil || other == nil {
+		if inputPassportElementBankStatement != other {
+			changes = append(changes, Change{Path: path, Old: inputPassportElementBankStatement, New: other})
+		}
+		return changes
+	}
+	changes = inputPassportElementBankStatement.BankStatement.diff(fieldPath(path, "bank_statement"), other.BankStatement, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputPassportElementBankStatement *InputPassportElementBankStatement) String() string {
+	return objectString(inputPassportElementBankStatement)
+}
+
+// LogValue logs the object as its summary
+func (inputPassportElementBankStatement *InputPassportElementBankStatement) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputPassportElementBankStatement))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputPassportElementBankStatement *InputPassportElementBankStatement) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputPassportElementBankStatement)
+}
+
+func (inputPassportElementBankStatement *InputPassportElementBankStatement) printText(p *printer) {
+	if inputPassportElementBankStatement == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputPassportElementBankStatement")
+	p.object("bank_statement", inputPassportElementBankStatement.BankStatement)
+	p.endObject(state)
+}
+
 // GetInputPassportElementEnum return the enum type of this object
 func (inputPassportElementBankStatement *InputPassportElementBankStatement) GetInputPassportElementEnum() InputPassportElementEnum {
 	return InputPassportElementBankStatementType
 }
 
+// Accept calls the method of the visitor for InputPassportElementBankStatement
+func (inputPassportElementBankStatement *InputPassportElementBankStatement) Accept(visitor InputPassportElementVisitor) {
+	visitor.VisitInputPassportElementBankStatement(inputPassportElementBankStatement)
+}
+
 // InputPassportElementRentalAgreement A Telegram Passport element to be saved containing the user's rental agreement
 type InputPassportElementRentalAgreement struct {
 	tdCommon
@@ -359,11 +1324,126 @@ func NewInputPassportElementRentalAgreement(rentalAgreement *InputPersonalDocume
 	return &inputPassportElementRentalAgreementTemp
 }
 
+// MarshalJSON marshals to json
+func (inputPassportElementRentalAgreement *InputPassportElementRentalAgreement) MarshalJSON() ([]byte, error) {
+	return inputPassportElementRentalAgreement.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputPassportElementRentalAgreement *InputPassportElementRentalAgreement) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputPassportElementRentalAgreement)
+}
+
+func (inputPassportElementRentalAgreement *InputPassportElementRentalAgreement) appendJSON(b []byte) ([]byte, error) {
+	if inputPassportElementRentalAgreement == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputPassportElementRentalAgreement.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputPassportElementRentalAgreement.tdCommon.Extra)
+	b = append(b, `,"rental_agreement":`...)
+	b, err = inputPassportElementRentalAgreement.RentalAgreement.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inputPassportElementRentalAgreement *InputPassportElementRentalAgreement) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputPassportElementRentalAgreement.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputPassportElementRentalAgreement.tdCommon.Extra, err = r.readString()
+		case "rental_agreement":
+			if r.readNull() {
+				inputPassportElementRentalAgreement.RentalAgreement = nil
+			} else {
+				inputPassportElementRentalAgreement.RentalAgreement = new(InputPersonalDocument)
+				err = inputPassportElementRentalAgreement.RentalAgreement.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputPassportElementRentalAgreement *InputPassportElementRentalAgreement) Clone() *InputPassportElementRentalAgreement {
+	if inputPassportElementRentalAgreement == nil {
+		return nil
+	}
+	clone := *inputPassportElementRentalAgreement
+	clone.RentalAgreement = inputPassportElementRentalAgreement.RentalAgreement.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputPassportElementRentalAgreement *InputPassportElementRentalAgreement) Equal(other *InputPassportElementRentalAgreement) bool {
+	if inputPassportElementRentalAgreement == nil || other == nil {
+		return inputPassportElementRentalAgreement == other
+	}
+	if !inputPassportElementRentalAgreement.RentalAgreement.Equal(other.RentalAgreement) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputPassportElementRentalAgreement *InputPassportElementRentalAgreement) Diff(other *InputPassportElementRentalAgreement) []Change {
+	return inputPassportElementRentalAgreement.diff("", other, nil)
+}
+
+func (inputPassportElementRentalAgreement *InputPassportElementRentalAgreement) diff(path string, other *InputPassportElementRentalAgreement, changes []Change) []Change {
+	if inputPassportElementRentalAgreement == nil || other == nil {
+		if inputPassportElementRentalAgreement != other {
+			changes = append(changes, Change{Path: path, Old: inputPassportElementRentalAgreement, New: other})
+		}
+		return changes
+	}
+	changes = inputPassportElementRentalAgreement.RentalAgreement.diff(fieldPath(path, "rental_agreement"), other.RentalAgreement, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputPassportElementRentalAgreement *InputPassportElementRentalAgreement) String() string {
+	return objectString(inputPassportElementRentalAgreement)
+}
+
+// LogValue logs the object as its summary
+func (inputPassportElementRentalAgreement *InputPassportElementRentalAgreement) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputPassportElementRentalAgreement))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputPassportElementRentalAgreement *InputPassportElementRentalAgreement) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputPassportElementRentalAgreement)
+}
+
+func (inputPassportElementRentalAgreement *InputPassportElementRentalAgreement) printText(p *printer) {
+	if inputPassportElementRentalAgreement == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputPassportElementRentalAgreement")
+	p.object("rental_agreement", inputPassportElementRentalAgreement.RentalAgreement)
+	p.endObject(state)
+}
+
 // GetInputPassportElementEnum return the enum type of this object
 func (inputPassportElementRentalAgreement *InputPassportElementRentalAgreement) GetInputPassportElementEnum() InputPassportElementEnum {
 	return InputPassportElementRentalAgreementType
 }
 
+// Accept calls the method of the visitor for InputPassportElementRentalAgreement
+func (inputPassportElementRentalAgreement *InputPassportElementRentalAgreement) Accept(visitor InputPassportElementVisitor) {
+	visitor.VisitInputPassportElementRentalAgreement(inputPassportElementRentalAgreement)
+}
+
 // InputPassportElementPassportRegistration A Telegram Passport element to be saved containing the user's passport registration
 type InputPassportElementPassportRegistration struct {
 	tdCommon
@@ -387,11 +1467,126 @@ func NewInputPassportElementPassportRegistration(passportRegistration *InputPers
 	return &inputPassportElementPassportRegistrationTemp
 }
 
+// MarshalJSON marshals to json
+func (inputPassportElementPassportRegistration *InputPassportElementPassportRegistration) MarshalJSON() ([]byte, error) {
+	return inputPassportElementPassportRegistration.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputPassportElementPassportRegistration *InputPassportElementPassportRegistration) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputPassportElementPassportRegistration)
+}
+
+func (inputPassportElementPassportRegistration *InputPassportElementPassportRegistration) appendJSON(b []byte) ([]byte, error) {
+	if inputPassportElementPassportRegistration == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputPassportElementPassportRegistration.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputPassportElementPassportRegistration.tdCommon.Extra)
+	b = append(b, `,"passport_registration":`...)
+	b, err = inputPassportElementPassportRegistration.PassportRegistration.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inputPassportElementPassportRegistration *InputPassportElementPassportRegistration) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputPassportElementPassportRegistration.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputPassportElementPassportRegistration.tdCommon.Extra, err = r.readString()
+		case "passport_registration":
+			if r.readNull() {
+				inputPassportElementPassportRegistration.PassportRegistration = nil
+			} else {
+				inputPassportElementPassportRegistration.PassportRegistration = new(InputPersonalDocument)
+				err = inputPassportElementPassportRegistration.PassportRegistration.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputPassportElementPassportRegistration *InputPassportElementPassportRegistration) Clone() *InputPassportElementPassportRegistration {
+	if inputPassportElementPassportRegistration == nil {
+		return nil
+	}
+	clone := *inputPassportElementPassportRegistration
+	clone.PassportRegistration = inputPassportElementPassportRegistration.PassportRegistration.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputPassportElementPassportRegistration *InputPassportElementPassportRegistration) Equal(other *InputPassportElementPassportRegistration) bool {
+	if inputPassportElementPassportRegistration == nil || other == nil {
+		return inputPassportElementPassportRegistration == other
+	}
+	if !inputPassportElementPassportRegistration.PassportRegistration.Equal(other.PassportRegistration) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputPassportElementPassportRegistration *InputPassportElementPassportRegistration) Diff(other *InputPassportElementPassportRegistration) []Change {
+	return inputPassportElementPassportRegistration.diff("", other, nil)
+}
+
+func (inputPassportElementPassportRegistration *InputPassportElementPassportRegistration) diff(path string, other *InputPassportElementPassportRegistration, changes []Change) []Change {
+	if inputPassportElementPassportRegistration == nil || other == nil {
+		if inputPassportElementPassportRegistration != other {
+			changes = append(changes, Change{Path: path, Old: inputPassportElementPassportRegistration, New: other})
+		}
+		return changes
+	}
+	changes = inputPassportElementPassportRegistration.PassportRegistration.diff(fieldPath(path, "passport_registration"), other.PassportRegistration, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputPassportElementPassportRegistration *InputPassportElementPassportRegistration) String() string {
+	return objectString(inputPassportElementPassportRegistration)
+}
+
+// LogValue logs the object as its summary
+func (inputPassportElementPassportRegistration *InputPassportElementPassportRegistration) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputPassportElementPassportRegistration))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputPassportElementPassportRegistration *InputPassportElementPassportRegistration) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputPassportElementPassportRegistration)
+}
+
+func (inputPassportElementPassportRegistration *InputPassportElementPassportRegistration) printText(p *printer) {
+	if inputPassportElementPassportRegistration == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputPassportElementPassportRegistration")
+	p.object("passport_registration", inputPassportElementPassportRegistration.PassportRegistration)
+	p.endObject(state)
+}
+
 // GetInputPassportElementEnum return the enum type of this object
 func (inputPassportElementPassportRegistration *InputPassportElementPassportRegistration) GetInputPassportElementEnum() InputPassportElementEnum {
 	return InputPassportElementPassportRegistrationType
 }
 
+// Accept calls the method of the visitor for InputPassportElementPassportRegistration
+func (inputPassportElementPassportRegistration *InputPassportElementPassportRegistration) Accept(visitor InputPassportElementVisitor) {
+	visitor.VisitInputPassportElementPassportRegistration(inputPassportElementPassportRegistration)
+}
+
 // InputPassportElementTemporaryRegistration A Telegram Passport element to be saved containing the user's temporary registration
 type InputPassportElementTemporaryRegistration struct {
 	tdCommon
@@ -415,11 +1610,126 @@ func NewInputPassportElementTemporaryRegistration(temporaryRegistration *InputPe
 	return &inputPassportElementTemporaryRegistrationTemp
 }
 
+// MarshalJSON marshals to json
+func (inputPassportElementTemporaryRegistration *InputPassportElementTemporaryRegistration) MarshalJSON() ([]byte, error) {
+	return inputPassportElementTemporaryRegistration.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputPassportElementTemporaryRegistration *InputPassportElementTemporaryRegistration) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputPassportElementTemporaryRegistration)
+}
+
+func (inputPassportElementTemporaryRegistration *InputPassportElementTemporaryRegistration) appendJSON(b []byte) ([]byte, error) {
+	if inputPassportElementTemporaryRegistration == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputPassportElementTemporaryRegistration.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputPassportElementTemporaryRegistration.tdCommon.Extra)
+	b = append(b, `,"temporary_registration":`...)
+	b, err = inputPassportElementTemporaryRegistration.TemporaryRegistration.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inputPassportElementTemporaryRegistration *InputPassportElementTemporaryRegistration) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputPassportElementTemporaryRegistration.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputPassportElementTemporaryRegistration.tdCommon.Extra, err = r.readString()
+		case "temporary_registration":
+			if r.readNull() {
+				inputPassportElementTemporaryRegistration.TemporaryRegistration = nil
+			} else {
+				inputPassportElementTemporaryRegistration.TemporaryRegistration = new(InputPersonalDocument)
+				err = inputPassportElementTemporaryRegistration.TemporaryRegistration.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputPassportElementTemporaryRegistration *InputPassportElementTemporaryRegistration) Clone() *InputPassportElementTemporaryRegistration {
+	if inputPassportElementTemporaryRegistration == nil {
+		return nil
+	}
+	clone := *inputPassportElementTemporaryRegistration
+	clone.TemporaryRegistration = inputPassportElementTemporaryRegistration.TemporaryRegistration.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputPassportElementTemporaryRegistration *InputPassportElementTemporaryRegistration) Equal(other *InputPassportElementTemporaryRegistration) bool {
+	if inputPassportElementTemporaryRegistration == nil || other == nil {
+		return inputPassportElementTemporaryRegistration == other
+	}
+	if !inputPassportElementTemporaryRegistration.TemporaryRegistration.Equal(other.TemporaryRegistration) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputPassportElementTemporaryRegistration *InputPassportElementTemporaryRegistration) Diff(other *InputPassportElementTemporaryRegistration) []Change {
+	return inputPassportElementTemporaryRegistration.diff("", other, nil)
+}
+
+func (inputPassportElementTemporaryRegistration *InputPassportElementTemporaryRegistration) diff(path string, other *InputPassportElementTemporaryRegistration, changes []Change) []Change {
+	if inputPassportElementTemporaryRegistration == nil || other == nil {
+		if inputPassportElementTemporaryRegistration != other {
+			changes = append(changes, Change{Path: path, Old: inputPassportElementTemporaryRegistration, New: other})
+		}
+		return changes
+	}
+	changes = inputPassportElementTemporaryRegistration.TemporaryRegistration.diff(fieldPath(path, "temporary_registration"), other.TemporaryRegistration, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputPassportElementTemporaryRegistration *InputPassportElementTemporaryRegistration) String() string {
+	return objectString(inputPassportElementTemporaryRegistration)
+}
+
+// LogValue logs the object as its summary
+func (inputPassportElementTemporaryRegistration *InputPassportElementTemporaryRegistration) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputPassportElementTemporaryRegistration))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputPassportElementTemporaryRegistration *InputPassportElementTemporaryRegistration) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputPassportElementTemporaryRegistration)
+}
+
+func (inputPassportElementTemporaryRegistration *InputPassportElementTemporaryRegistration) printText(p *printer) {
+	if inputPassportElementTemporaryRegistration == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputPassportElementTemporaryRegistration")
+	p.object("temporary_registration", inputPassportElementTemporaryRegistration.TemporaryRegistration)
+	p.endObject(state)
+}
+
 // GetInputPassportElementEnum return the enum type of this object
 func (inputPassportElementTemporaryRegistration *InputPassportElementTemporaryRegistration) GetInputPassportElementEnum() InputPassportElementEnum {
 	return InputPassportElementTemporaryRegistrationType
 }
 
+// Accept calls the method of the visitor for InputPassportElementTemporaryRegistration
+func (inputPassportElementTemporaryRegistration *InputPassportElementTemporaryRegistration) Accept(visitor InputPassportElementVisitor) {
+	visitor.VisitInputPassportElementTemporaryRegistration(inputPassportElementTemporaryRegistration)
+}
+
 // InputPassportElementPhoneNumber A Telegram Passport element to be saved containing the user's phone number
 type InputPassportElementPhoneNumber struct {
 	tdCommon
@@ -443,11 +1753,118 @@ func NewInputPassportElementPhoneNumber(phoneNumber string) *InputPassportElemen
 	return &inputPassportElementPhoneNumberTemp
 }
 
+// MarshalJSON marshals to json
+func (inputPassportElementPhoneNumber *InputPassportElementPhoneNumber) MarshalJSON() ([]byte, error) {
+	return inputPassportElementPhoneNumber.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputPassportElementPhoneNumber *InputPassportElementPhoneNumber) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputPassportElementPhoneNumber)
+}
+
+func (inputPassportElementPhoneNumber *InputPassportElementPhoneNumber) appendJSON(b []byte) ([]byte, error) {
+	if inputPassportElementPhoneNumber == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputPassportElementPhoneNumber.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputPassportElementPhoneNumber.tdCommon.Extra)
+	b = append(b, `,"phone_number":`...)
+	b = appendJSONString(b, inputPassportElementPhoneNumber.PhoneNumber)
+	return append(b, '}'), nil
+}
+
+func (inputPassportElementPhoneNumber *InputPassportElementPhoneNumber) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputPassportElementPhoneNumber.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputPassportElementPhoneNumber.tdCommon.Extra, err = r.readString()
+		case "phone_number":
+			inputPassportElementPhoneNumber.PhoneNumber, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputPassportElementPhoneNumber *InputPassportElementPhoneNumber) Clone() *InputPassportElementPhoneNumber {
+	if inputPassportElementPhoneNumber == nil {
+		return nil
+	}
+	clone := *inputPassportElementPhoneNumber
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputPassportElementPhoneNumber *InputPassportElementPhoneNumber) Equal(other *InputPassportElementPhoneNumber) bool {
+	if inputPassportElementPhoneNumber == nil || other == nil {
+		return inputPassportElementPhoneNumber == other
+	}
+	if inputPassportElementPhoneNumber.PhoneNumber != other.PhoneNumber {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputPassportElementPhoneNumber *InputPassportElementPhoneNumber) Diff(other *InputPassportElementPhoneNumber) []Change {
+	return inputPassportElementPhoneNumber.diff("", other, nil)
+}
+
+func (inputPassportElementPhoneNumber *InputPassportElementPhoneNumber) diff(path string, other *InputPassportElementPhoneNumber, changes []Change) []Change {
+	if inputPassportElementPhoneNumber == nil || other == nil {
+		if inputPassportElementPhoneNumber != other {
+			changes = append(changes, Change{Path: path, Old: inputPassportElementPhoneNumber, New: other})
+		}
+		return changes
+	}
+	if inputPassportElementPhoneNumber.PhoneNumber != other.PhoneNumber {
+		changes = append(changes, Change{Path: fieldPath(path, "phone_number"), Old: inputPassportElementPhoneNumber.PhoneNumber, New: other.PhoneNumber})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputPassportElementPhoneNumber *InputPassportElementPhoneNumber) String() string {
+	return objectString(inputPassportElementPhoneNumber)
+}
+
+// LogValue logs the object as its summary
+func (inputPassportElementPhoneNumber *InputPassportElementPhoneNumber) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputPassportElementPhoneNumber))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputPassportElementPhoneNumber *InputPassportElementPhoneNumber) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputPassportElementPhoneNumber)
+}
+
+func (inputPassportElementPhoneNumber *InputPassportElementPhoneNumber) printText(p *printer) {
+	if inputPassportElementPhoneNumber == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputPassportElementPhoneNumber")
+	p.string("phone_number", inputPassportElementPhoneNumber.PhoneNumber)
+	p.endObject(state)
+}
+
 // GetInputPassportElementEnum return the enum type of this object
 func (inputPassportElementPhoneNumber *InputPassportElementPhoneNumber) GetInputPassportElementEnum() InputPassportElementEnum {
 	return InputPassportElementPhoneNumberType
 }
 
+// Accept calls the method of the visitor for InputPassportElementPhoneNumber
+func (inputPassportElementPhoneNumber *InputPassportElementPhoneNumber) Accept(visitor InputPassportElementVisitor) {
+	visitor.VisitInputPassportElementPhoneNumber(inputPassportElementPhoneNumber)
+}
+
 // InputPassportElementEmailAddress A Telegram Passport element to be saved containing the user's email address
 type InputPassportElementEmailAddress struct {
 	tdCommon
@@ -471,7 +1888,374 @@ func NewInputPassportElementEmailAddress(emailAddress string) *InputPassportElem
 	return &inputPassportElementEmailAddressTemp
 }
 
+// MarshalJSON marshals to json
+func (inputPassportElementEmailAddress *InputPassportElementEmailAddress) MarshalJSON() ([]byte, error) {
+	return inputPassportElementEmailAddress.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputPassportElementEmailAddress *InputPassportElementEmailAddress) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputPassportElementEmailAddress)
+}
+
+func (inputPassportElementEmailAddress *InputPassportElementEmailAddress) appendJSON(b []byte) ([]byte, error) {
+	if inputPassportElementEmailAddress == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputPassportElementEmailAddress.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputPassportElementEmailAddress.tdCommon.Extra)
+	b = append(b, `,"email_address":`...)
+	b = appendJSONString(b, inputPassportElementEmailAddress.EmailAddress)
+	return append(b, '}'), nil
+}
+
+func (inputPassportElementEmailAddress *InputPassportElementEmailAddress) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputPassportElementEmailAddress.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputPassportElementEmailAddress.tdCommon.Extra, err = r.readString()
+		case "email_address":
+			inputPassportElementEmailAddress.EmailAddress, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputPassportElementEmailAddress *InputPassportElementEmailAddress) Clone() *InputPassportElementEmailAddress {
+	if inputPassportElementEmailAddress == nil {
+		return nil
+	}
+	clone := *inputPassportElementEmailAddress
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputPassportElementEmailAddress *InputPassportElementEmailAddress) Equal(other *InputPassportElementEmailAddress) bool {
+	if inputPassportElementEmailAddress == nil || other == nil {
+		return inputPassportElementEmailAddress == other
+	}
+	if inputPassportElementEmailAddress.EmailAddress != other.EmailAddress {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputPassportElementEmailAddress *InputPassportElementEmailAddress) Diff(other *InputPassportElementEmailAddress) []Change {
+	return inputPassportElementEmailAddress.diff("", other, nil)
+}
+
+func (inputPassportElementEmailAddress *InputPassportElementEmailAddress) diff(path string, other *InputPassportElementEmailAddress, changes []Change) []Change {
+	if inputPassportElementEmailAddress == nil || other == nil {
+		if inputPassportElementEmailAddress != other {
+			changes = append(changes, Change{Path: path, Old: inputPassportElementEmailAddress, New: other})
+		}
+		return changes
+	}
+	if inputPassportElementEmailAddress.EmailAddress != other.EmailAddress {
+		changes = append(changes, Change{Path: fieldPath(path, "email_address"), Old: inputPassportElementEmailAddress.EmailAddress, New: other.EmailAddress})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputPassportElementEmailAddress *InputPassportElementEmailAddress) String() string {
+	return objectString(inputPassportElementEmailAddress)
+}
+
+// LogValue logs the object as its summary
+func (inputPassportElementEmailAddress *InputPassportElementEmailAddress) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputPassportElementEmailAddress))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputPassportElementEmailAddress *InputPassportElementEmailAddress) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputPassportElementEmailAddress)
+}
+
+func (inputPassportElementEmailAddress *InputPassportElementEmailAddress) printText(p *printer) {
+	if inputPassportElementEmailAddress == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputPassportElementEmailAddress")
+	p.string("email_address", inputPassportElementEmailAddress.EmailAddress)
+	p.endObject(state)
+}
+
 // GetInputPassportElementEnum return the enum type of this object
 func (inputPassportElementEmailAddress *InputPassportElementEmailAddress) GetInputPassportElementEnum() InputPassportElementEnum {
 	return InputPassportElementEmailAddressType
 }
+
+// Accept calls the method of the visitor for InputPassportElementEmailAddress
+func (inputPassportElementEmailAddress *InputPassportElementEmailAddress) Accept(visitor InputPassportElementVisitor) {
+	visitor.VisitInputPassportElementEmailAddress(inputPassportElementEmailAddress)
+}
+
+// InputPassportElementVisitor visits every type of InputPassportElement, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to InputPassportElement, until they handle it too.
+type InputPassportElementVisitor interface {
+	VisitInputPassportElementPersonalDetails(inputPassportElementPersonalDetails *InputPassportElementPersonalDetails)
+	VisitInputPassportElementPassport(inputPassportElementPassport *InputPassportElementPassport)
+	VisitInputPassportElementDriverLicense(inputPassportElementDriverLicense *InputPassportElementDriverLicense)
+	VisitInputPassportElementIdentityCard(inputPassportElementIdentityCard *InputPassportElementIdentityCard)
+	VisitInputPassportElementInternalPassport(inputPassportElementInternalPassport *InputPassportElementInternalPassport)
+	VisitInputPassportElementAddress(inputPassportElementAddress *InputPassportElementAddress)
+	VisitInputPassportElementUtilityBill(inputPassportElementUtilityBill *InputPassportElementUtilityBill)
+	VisitInputPassportElementBankStatement(inputPassportElementBankStatement *InputPassportElementBankStatement)
+	VisitInputPassportElementRentalAgreement(inputPassportElementRentalAgreement *InputPassportElementRentalAgreement)
+	VisitInputPassportElementPassportRegistration(inputPassportElementPassportRegistration *InputPassportElementPassportRegistration)
+	VisitInputPassportElementTemporaryRegistration(inputPassportElementTemporaryRegistration *InputPassportElementTemporaryRegistration)
+	VisitInputPassportElementPhoneNumber(inputPassportElementPhoneNumber *InputPassportElementPhoneNumber)
+	VisitInputPassportElementEmailAddress(inputPassportElementEmailAddress *InputPassportElementEmailAddress)
+	VisitUnknownInputPassportElement(unknownInputPassportElement *UnknownInputPassportElement)
+}
+
+// InputPassportElementCases holds the function MatchInputPassportElement calls for each type of InputPassportElement;
+// types without a function are passed to the default case
+type InputPassportElementCases[R any] struct {
+	InputPassportElementPersonalDetails       func(inputPassportElementPersonalDetails *InputPassportElementPersonalDetails) R
+	InputPassportElementPassport              func(inputPassportElementPassport *InputPassportElementPassport) R
+	InputPassportElementDriverLicense         func(inputPassportElementDriverLicense *InputPassportElementDriverLicense) R
+	InputPassportElementIdentityCard          func(inputPassportElementIdentityCard *InputPassportElementIdentityCard) R
+	InputPassportElementInternalPassport      func(inputPassportElementInternalPassport *InputPassportElementInternalPassport) R
+	InputPassportElementAddress               func(inputPassportElementAddress *InputPassportElementAddress) R
+	InputPassportElementUtilityBill           func(inputPassportElementUtilityBill *InputPassportElementUtilityBill) R
+	InputPassportElementBankStatement         func(inputPassportElementBankStatement *InputPassportElementBankStatement) R
+	InputPassportElementRentalAgreement       func(inputPassportElementRentalAgreement *InputPassportElementRentalAgreement) R
+	InputPassportElementPassportRegistration  func(inputPassportElementPassportRegistration *InputPassportElementPassportRegistration) R
+	InputPassportElementTemporaryRegistration func(inputPassportElementTemporaryRegistration *InputPassportElementTemporaryRegistration) R
+	InputPassportElementPhoneNumber           func(inputPassportElementPhoneNumber *InputPassportElementPhoneNumber) R
+	InputPassportElementEmailAddress          func(inputPassportElementEmailAddress *InputPassportElementEmailAddress) R
+}
+
+// MatchInputPassportElement calls the case of the type of inputPassportElement, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement InputPassportElementVisitor
+// to have the compiler check every type is handled.
+func MatchInputPassportElement[R any](inputPassportElement InputPassportElement, cases InputPassportElementCases[R], defaultCase func(inputPassportElement InputPassportElement) R) R {
+	switch value := inputPassportElement.(type) {
+	case *InputPassportElementPersonalDetails:
+		if cases.InputPassportElementPersonalDetails != nil {
+			return cases.InputPassportElementPersonalDetails(value)
+		}
+	case *InputPassportElementPassport:
+		if cases.InputPassportElementPassport != nil {
+			return cases.InputPassportElementPassport(value)
+		}
+	case *InputPassportElementDriverLicense:
+		if cases.InputPassportElementDriverLicense != nil {
+			return cases.InputPassportElementDriverLicense(value)
+		}
+	case *InputPassportElementIdentityCard:
+		if cases.InputPassportElementIdentityCard != nil {
+			return cases.InputPassportElementIdentityCard(value)
+		}
+	case *InputPassportElementInternalPassport:
+		if cases.InputPassportElementInternalPassport != nil {
+			return cases.InputPassportElementInternalPassport(value)
+		}
+	case *InputPassportElementAddress:
+		if cases.InputPassportElementAddress != nil {
+			return cases.InputPassportElementAddress(value)
+		}
+	case *InputPassportElementUtilityBill:
+		if cases.InputPassportElementUtilityBill != nil {
+			return cases.InputPassportElementUtilityBill(value)
+		}
+	case *InputPassportElementBankStatement:
+		if cases.InputPassportElementBankStatement != nil {
+			return cases.InputPassportElementBankStatement(value)
+		}
+	case *InputPassportElementRentalAgreement:
+		if cases.InputPassportElementRentalAgreement != nil {
+			return cases.InputPassportElementRentalAgreement(value)
+		}
+	case *InputPassportElementPassportRegistration:
+		if cases.InputPassportElementPassportRegistration != nil {
+			return cases.InputPassportElementPassportRegistration(value)
+		}
+	case *InputPassportElementTemporaryRegistration:
+		if cases.InputPassportElementTemporaryRegistration != nil {
+			return cases.InputPassportElementTemporaryRegistration(value)
+		}
+	case *InputPassportElementPhoneNumber:
+		if cases.InputPassportElementPhoneNumber != nil {
+			return cases.InputPassportElementPhoneNumber(value)
+		}
+	case *InputPassportElementEmailAddress:
+		if cases.InputPassportElementEmailAddress != nil {
+			return cases.InputPassportElementEmailAddress(value)
+		}
+	}
+	return defaultCase(inputPassportElement)
+}
+
+// Clone returns a deep copy of the object
+func (unknownInputPassportElement *UnknownInputPassportElement) Clone() *UnknownInputPassportElement {
+	if unknownInputPassportElement == nil {
+		return nil
+	}
+	return &UnknownInputPassportElement{Unknown: unknownInputPassportElement.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownInputPassportElement *UnknownInputPassportElement) Equal(other *UnknownInputPassportElement) bool {
+	if unknownInputPassportElement == nil || other == nil {
+		return unknownInputPassportElement == other
+	}
+	return unknownInputPassportElement.Unknown.equal(other.Unknown)
+}
+
+func cloneInputPassportElement(inputPassportElement InputPassportElement) InputPassportElement {
+	switch value := inputPassportElement.(type) {
+	case *InputPassportElementPersonalDetails:
+		return value.Clone()
+	case *InputPassportElementPassport:
+		return value.Clone()
+	case *InputPassportElementDriverLicense:
+		return value.Clone()
+	case *InputPassportElementIdentityCard:
+		return value.Clone()
+	case *InputPassportElementInternalPassport:
+		return value.Clone()
+	case *InputPassportElementAddress:
+		return value.Clone()
+	case *InputPassportElementUtilityBill:
+		return value.Clone()
+	case *InputPassportElementBankStatement:
+		return value.Clone()
+	case *InputPassportElementRentalAgreement:
+		return value.Clone()
+	case *InputPassportElementPassportRegistration:
+		return value.Clone()
+	case *InputPassportElementTemporaryRegistration:
+		return value.Clone()
+	case *InputPassportElementPhoneNumber:
+		return value.Clone()
+	case *InputPassportElementEmailAddress:
+		return value.Clone()
+	case *UnknownInputPassportElement:
+		return value.Clone()
+	}
+	return inputPassportElement
+}
+
+func equalInputPassportElement(a InputPassportElement, b InputPassportElement) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *InputPassportElementPersonalDetails:
+		b, ok := b.(*InputPassportElementPersonalDetails)
+		return ok && a.Equal(b)
+	case *InputPassportElementPassport:
+		b, ok := b.(*InputPassportElementPassport)
+		return ok && a.Equal(b)
+	case *InputPassportElementDriverLicense:
+		b, ok := b.(*InputPassportElementDriverLicense)
+		return ok && a.Equal(b)
+	case *InputPassportElementIdentityCard:
+		b, ok := b.(*InputPassportElementIdentityCard)
+		return ok && a.Equal(b)
+	case *InputPassportElementInternalPassport:
+		b, ok := b.(*InputPassportElementInternalPassport)
+		return ok && a.Equal(b)
+	case *InputPassportElementAddress:
+		b, ok := b.(*InputPassportElementAddress)
+		return ok && a.Equal(b)
+	case *InputPassportElementUtilityBill:
+		b, ok := b.(*InputPassportElementUtilityBill)
+		return ok && a.Equal(b)
+	case *InputPassportElementBankStatement:
+		b, ok := b.(*InputPassportElementBankStatement)
+		return ok && a.Equal(b)
+	case *InputPassportElementRentalAgreement:
+		b, ok := b.(*InputPassportElementRentalAgreement)
+		return ok && a.Equal(b)
+	case *InputPassportElementPassportRegistration:
+		b, ok := b.(*InputPassportElementPassportRegistration)
+		return ok && a.Equal(b)
+	case *InputPassportElementTemporaryRegistration:
+		b, ok := b.(*InputPassportElementTemporaryRegistration)
+		return ok && a.Equal(b)
+	case *InputPassportElementPhoneNumber:
+		b, ok := b.(*InputPassportElementPhoneNumber)
+		return ok && a.Equal(b)
+	case *InputPassportElementEmailAddress:
+		b, ok := b.(*InputPassportElementEmailAddress)
+		return ok && a.Equal(b)
+	case *UnknownInputPassportElement:
+		b, ok := b.(*UnknownInputPassportElement)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffInputPassportElement(path string, a InputPassportElement, b InputPassportElement, changes []Change) []Change {
+	switch a := a.(type) {
+	case *InputPassportElementPersonalDetails:
+		if b, ok := b.(*InputPassportElementPersonalDetails); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputPassportElementPassport:
+		if b, ok := b.(*InputPassportElementPassport); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputPassportElementDriverLicense:
+		if b, ok := b.(*InputPassportElementDriverLicense); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputPassportElementIdentityCard:
+		if b, ok := b.(*InputPassportElementIdentityCard); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputPassportElementInternalPassport:
+		if b, ok := b.(*InputPassportElementInternalPassport); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputPassportElementAddress:
+		if b, ok := b.(*InputPassportElementAddress); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputPassportElementUtilityBill:
+		if b, ok := b.(*InputPassportElementUtilityBill); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputPassportElementBankStatement:
+		if b, ok := b.(*InputPassportElementBankStatement); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputPassportElementRentalAgreement:
+		if b, ok := b.(*InputPassportElementRentalAgreement); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputPassportElementPassportRegistration:
+		if b, ok := b.(*InputPassportElementPassportRegistration); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputPassportElementTemporaryRegistration:
+		if b, ok := b.(*InputPassportElementTemporaryRegistration); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputPassportElementPhoneNumber:
+		if b, ok := b.(*InputPassportElementPhoneNumber); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputPassportElementEmailAddress:
+		if b, ok := b.(*InputPassportElementEmailAddress); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalInputPassportElement(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // InputPassportElementError Contains the description of an error in a Telegram Passport element; for bots only
@@ -33,31 +34,131 @@ func NewInputPassportElementError(typeParam PassportElementType, message string,
 	return &inputPassportElementErrorTemp
 }
 
+// MarshalJSON marshals to json
+func (inputPassportElementError *InputPassportElementError) MarshalJSON() ([]byte, error) {
+	return inputPassportElementError.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputPassportElementError *InputPassportElementError) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, inputPassportElementError)
+}
+
+func (inputPassportElementError *InputPassportElementError) appendJSON(b []byte) ([]byte, error) {
+	if inputPassportElementError == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputPassportElementError.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputPassportElementError.tdCommon.Extra)
+	b = append(b, `,"type":`...)
+	b, err = appendJSONValue(b, inputPassportElementError.Type)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-		Message string `json:"message"` // Error message
-
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	b = append(b, `,"message":`...)
+	b = appendJSONString(b, inputPassportElementError.Message)
+	b = append(b, `,"source":`...)
+	b, err = appendJSONValue(b, inputPassportElementError.Source)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (inputPassportElementError *InputPassportElementError) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputPassportElementError.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputPassportElementError.tdCommon.Extra, err = r.readString()
+		case "type":
+			inputPassportElementError.Type, err = decodePassportElementType(r)
+		case "message":
+			inputPassportElementError.Message, err = r.readString()
+		case "source":
+			inputPassportElementError.Source, err = decodeInputPassportElementErrorSource(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputPassportElementError *InputPassportElementError) Clone() *InputPassportElementError {
+	if inputPassportElementError == nil {
+		return nil
+	}
+	clone := *inputPassportElementError
+	clone.Type = clonePassportElementType(inputPassportElementError.Type)
+	clone.Source = cloneInputPassportElementErrorSource(inputPassportElementError.Source)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputPassportElementError *InputPassportElementError) Equal(other *InputPassportElementError) bool {
+	if inputPassportElementError == nil || other == nil {
+		return inputPassportElementError == other
 	}
+	if !equalPassportElementType(inputPassportElementError.Type, other.Type) {
+		return false
+	}
+	if inputPassportElementError.Message != other.Message {
+		return false
+	}
+	if !equalInputPassportElementErrorSource(inputPassportElementError.Source, other.Source) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputPassportElementError *InputPassportElementError) Diff(other *InputPassportElementError) []Change {
+	return inputPassportElementError.diff("", other, nil)
+}
+
+func (inputPassportElementError *InputPassportElementError) diff(path string, other *InputPassportElementError, changes []Change) []Change {
+	if inputPassportElementError == nil || other == nil {
+		if inputPassportElementError != other {
+			changes = append(changes, Change{Path: path, Old: inputPassportElementError, New: other})
+		}
+		return changes
+	}
+	changes = diffPassportElementType(fieldPath(path, "type"), inputPassportElementError.Type, other.Type, changes)
+	if inputPassportElementError.Message != other.Message {
+		changes = append(changes, Change{Path: fieldPath(path, "message"), Old: inputPassportElementError.Message, New: other.Message})
+	}
+	changes = diffInputPassportElementErrorSource(fieldPath(path, "source"), inputPassportElementError.Source, other.Source, changes)
+	return changes
+}
 
-	inputPassportElementError.tdCommon = tempObj.tdCommon
-	inputPassportElementError.Message = tempObj.Message
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputPassportElementError *InputPassportElementError) String() string {
+	return objectString(inputPassportElementError)
+}
 
-	fieldType, _ := unmarshalPassportElementType(objMap["type"])
-	inputPassportElementError.Type = fieldType
+// LogValue logs the object as its summary
+func (inputPassportElementError *InputPassportElementError) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputPassportElementError))
+}
 
-	fieldSource, _ := unmarshalInputPassportElementErrorSource(objMap["source"])
-	inputPassportElementError.Source = fieldSource
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputPassportElementError *InputPassportElementError) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputPassportElementError)
+}
 
-	return nil
+func (inputPassportElementError *InputPassportElementError) printText(p *printer) {
+	if inputPassportElementError == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputPassportElementError")
+	p.object("type", inputPassportElementError.Type)
+	p.string("message", inputPassportElementError.Message)
+	p.object("source", inputPassportElementError.Source)
+	p.endObject(state)
 }
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // InputPassportElementErrorSource Contains the description of an error in a Telegram Passport element; for bots only
 type InputPassportElementErrorSource interface {
 	GetInputPassportElementErrorSourceEnum() InputPassportElementErrorSourceEnum
+	Accept(visitor InputPassportElementErrorSourceVisitor)
 }
 
 // InputPassportElementErrorSourceEnum Alias for abstract InputPassportElementErrorSource 'Sub-Classes', used as constant-enum here
@@ -26,68 +28,119 @@ const (
 	InputPassportElementErrorSourceFilesType            InputPassportElementErrorSourceEnum = "inputPassportElementErrorSourceFiles"
 )
 
+// UnknownInputPassportElementErrorSource is an InputPassportElementErrorSource of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownInputPassportElementErrorSource struct {
+	Unknown
+}
+
+// GetInputPassportElementErrorSourceEnum return the enum type of this object
+func (unknownInputPassportElementErrorSource *UnknownInputPassportElementErrorSource) GetInputPassportElementErrorSourceEnum() InputPassportElementErrorSourceEnum {
+	return InputPassportElementErrorSourceEnum(unknownInputPassportElementErrorSource.Type)
+}
+
+// Accept calls the method of the visitor for UnknownInputPassportElementErrorSource
+func (unknownInputPassportElementErrorSource *UnknownInputPassportElementErrorSource) Accept(visitor InputPassportElementErrorSourceVisitor) {
+	visitor.VisitUnknownInputPassportElementErrorSource(unknownInputPassportElementErrorSource)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownInputPassportElementErrorSource *UnknownInputPassportElementErrorSource) String() string {
+	return objectString(unknownInputPassportElementErrorSource)
+}
+
+// LogValue logs the object as its summary
+func (unknownInputPassportElementErrorSource *UnknownInputPassportElementErrorSource) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownInputPassportElementErrorSource))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownInputPassportElementErrorSource *UnknownInputPassportElementErrorSource) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownInputPassportElementErrorSource)
+}
+
+func (unknownInputPassportElementErrorSource *UnknownInputPassportElementErrorSource) printText(p *printer) {
+	if unknownInputPassportElementErrorSource == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownInputPassportElementErrorSource")
+	p.string("@type", unknownInputPassportElementErrorSource.Type)
+	p.endObject(state)
+}
+
 func unmarshalInputPassportElementErrorSource(rawMsg *json.RawMessage) (InputPassportElementErrorSource, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch InputPassportElementErrorSourceEnum(objMap["@type"].(string)) {
+	switch InputPassportElementErrorSourceEnum(typeName) {
 	case InputPassportElementErrorSourceUnspecifiedType:
 		var inputPassportElementErrorSourceUnspecified InputPassportElementErrorSourceUnspecified
-		err := json.Unmarshal(*rawMsg, &inputPassportElementErrorSourceUnspecified)
+		err := jsonUnmarshal(*rawMsg, &inputPassportElementErrorSourceUnspecified)
 		return &inputPassportElementErrorSourceUnspecified, err
 
 	case InputPassportElementErrorSourceDataFieldType:
 		var inputPassportElementErrorSourceDataField InputPassportElementErrorSourceDataField
-		err := json.Unmarshal(*rawMsg, &inputPassportElementErrorSourceDataField)
+		err := jsonUnmarshal(*rawMsg, &inputPassportElementErrorSourceDataField)
 		return &inputPassportElementErrorSourceDataField, err
 
 	case InputPassportElementErrorSourceFrontSideType:
 		var inputPassportElementErrorSourceFrontSide InputPassportElementErrorSourceFrontSide
-		err := json.Unmarshal(*rawMsg, &inputPassportElementErrorSourceFrontSide)
+		err := jsonUnmarshal(*rawMsg, &inputPassportElementErrorSourceFrontSide)
 		return &inputPassportElementErrorSourceFrontSide, err
 
 	case InputPassportElementErrorSourceReverseSideType:
 		var inputPassportElementErrorSourceReverseSide InputPassportElementErrorSourceReverseSide
-		err := json.Unmarshal(*rawMsg, &inputPassportElementErrorSourceReverseSide)
+		err := jsonUnmarshal(*rawMsg, &inputPassportElementErrorSourceReverseSide)
 		return &inputPassportElementErrorSourceReverseSide, err
 
 	case InputPassportElementErrorSourceSelfieType:
 		var inputPassportElementErrorSourceSelfie InputPassportElementErrorSourceSelfie
-		err := json.Unmarshal(*rawMsg, &inputPassportElementErrorSourceSelfie)
+		err := jsonUnmarshal(*rawMsg, &inputPassportElementErrorSourceSelfie)
 		return &inputPassportElementErrorSourceSelfie, err
 
 	case InputPassportElementErrorSourceTranslationFileType:
 		var inputPassportElementErrorSourceTranslationFile InputPassportElementErrorSourceTranslationFile
-		err := json.Unmarshal(*rawMsg, &inputPassportElementErrorSourceTranslationFile)
+		err := jsonUnmarshal(*rawMsg, &inputPassportElementErrorSourceTranslationFile)
 		return &inputPassportElementErrorSourceTranslationFile, err
 
 	case InputPassportElementErrorSourceTranslationFilesType:
 		var inputPassportElementErrorSourceTranslationFiles InputPassportElementErrorSourceTranslationFiles
-		err := json.Unmarshal(*rawMsg, &inputPassportElementErrorSourceTranslationFiles)
+		err := jsonUnmarshal(*rawMsg, &inputPassportElementErrorSourceTranslationFiles)
 		return &inputPassportElementErrorSourceTranslationFiles, err
 
 	case InputPassportElementErrorSourceFileType:
 		var inputPassportElementErrorSourceFile InputPassportElementErrorSourceFile
-		err := json.Unmarshal(*rawMsg, &inputPassportElementErrorSourceFile)
+		err := jsonUnmarshal(*rawMsg, &inputPassportElementErrorSourceFile)
 		return &inputPassportElementErrorSourceFile, err
 
 	case InputPassportElementErrorSourceFilesType:
 		var inputPassportElementErrorSourceFiles InputPassportElementErrorSourceFiles
-		err := json.Unmarshal(*rawMsg, &inputPassportElementErrorSourceFiles)
+		err := jsonUnmarshal(*rawMsg, &inputPassportElementErrorSourceFiles)
 		return &inputPassportElementErrorSourceFiles, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownInputPassportElementErrorSource{Unknown: unknown}, nil
 	}
 }
 
+func decodeInputPassportElementErrorSource(r *jsonReader) (InputPassportElementErrorSource, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalInputPassportElementErrorSource(rawMsg)
+}
+
 // InputPassportElementErrorSourceUnspecified The element contains an error in an unspecified place. The error will be considered resolved when new data is added
 type InputPassportElementErrorSourceUnspecified struct {
 	tdCommon
@@ -111,11 +164,121 @@ func NewInputPassportElementErrorSourceUnspecified(elementHash []byte) *InputPas
 	return &inputPassportElementErrorSourceUnspecifiedTemp
 }
 
+// MarshalJSON marshals to json
+func (inputPassportElementErrorSourceUnspecified *InputPassportElementErrorSourceUnspecified) MarshalJSON() ([]byte, error) {
+	return inputPassportElementErrorSourceUnspecified.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputPassportElementErrorSourceUnspecified *InputPassportElementErrorSourceUnspecified) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputPassportElementErrorSourceUnspecified)
+}
+
+func (inputPassportElementErrorSourceUnspecified *InputPassportElementErrorSourceUnspecified) appendJSON(b []byte) ([]byte, error) {
+	if inputPassportElementErrorSourceUnspecified == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputPassportElementErrorSourceUnspecified.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputPassportElementErrorSourceUnspecified.tdCommon.Extra)
+	b = append(b, `,"element_hash":`...)
+	b = appendJSONBytes(b, inputPassportElementErrorSourceUnspecified.ElementHash)
+	return append(b, '}'), nil
+}
+
+func (inputPassportElementErrorSourceUnspecified *InputPassportElementErrorSourceUnspecified) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputPassportElementErrorSourceUnspecified.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputPassportElementErrorSourceUnspecified.tdCommon.Extra, err = r.readString()
+		case "element_hash":
+			inputPassportElementErrorSourceUnspecified.ElementHash, err = r.readBytes()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputPassportElementErrorSourceUnspecified *InputPassportElementErrorSourceUnspecified) Clone() *InputPassportElementErrorSourceUnspecified {
+	if inputPassportElementErrorSourceUnspecified == nil {
+		return nil
+	}
+	clone := *inputPassportElementErrorSourceUnspecified
+	if inputPassportElementErrorSourceUnspecified.ElementHash != nil {
+		clone.ElementHash = append([]byte{}, inputPassportElementErrorSourceUnspecified.ElementHash...)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputPassportElementErrorSourceUnspecified *InputPassportElementErrorSourceUnspecified) Equal(other *InputPassportElementErrorSourceUnspecified) bool {
+	if inputPassportElementErrorSourceUnspecified == nil || other == nil {
+		return inputPassportElementErrorSourceUnspecified == other
+	}
+	if !equalBytes(inputPassportElementErrorSourceUnspecified.ElementHash, other.ElementHash) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputPassportElementErrorSourceUnspecified *InputPassportElementErrorSourceUnspecified) Diff(other *InputPassportElementErrorSourceUnspecified) []Change {
+	return inputPassportElementErrorSourceUnspecified.diff("", other, nil)
+}
+
+func (inputPassportElementErrorSourceUnspecified *InputPassportElementErrorSourceUnspecified) diff(path string, other *InputPassportElementErrorSourceUnspecified, changes []Change) []Change {
+	if inputPassportElementErrorSourceUnspecified == nil || other == nil {
+		if inputPassportElementErrorSourceUnspecified != other {
+			changes = append(changes, Change{Path: path, Old: inputPassportElementErrorSourceUnspecified, New: other})
+		}
+		return changes
+	}
+	if !equalBytes(inputPassportElementErrorSourceUnspecified.ElementHash, other.ElementHash) {
+		changes = append(changes, Change{Path: fieldPath(path, "element_hash"), Old: inputPassportElementErrorSourceUnspecified.ElementHash, New: other.ElementHash})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputPassportElementErrorSourceUnspecified *InputPassportElementErrorSourceUnspecified) String() string {
+	return objectString(inputPassportElementErrorSourceUnspecified)
+}
+
+// LogValue logs the object as its summary
+func (inputPassportElementErrorSourceUnspecified *InputPassportElementErrorSourceUnspecified) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputPassportElementErrorSourceUnspecified))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputPassportElementErrorSourceUnspecified *InputPassportElementErrorSourceUnspecified) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputPassportElementErrorSourceUnspecified)
+}
+
+func (inputPassportElementErrorSourceUnspecified *InputPassportElementErrorSourceUnspecified) printText(p *printer) {
+	if inputPassportElementErrorSourceUnspecified == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputPassportElementErrorSourceUnspecified")
+	p.bytes("element_hash", inputPassportElementErrorSourceUnspecified.ElementHash)
+	p.endObject(state)
+}
+
 // GetInputPassportElementErrorSourceEnum return the enum type of this object
 func (inputPassportElementErrorSourceUnspecified *InputPassportElementErrorSourceUnspecified) GetInputPassportElementErrorSourceEnum() InputPassportElementErrorSourceEnum {
 	return InputPassportElementErrorSourceUnspecifiedType
 }
 
+// Accept calls the method of the visitor for InputPassportElementErrorSourceUnspecified
+func (inputPassportElementErrorSourceUnspecified *InputPassportElementErrorSourceUnspecified) Accept(visitor InputPassportElementErrorSourceVisitor) {
+	visitor.VisitInputPassportElementErrorSourceUnspecified(inputPassportElementErrorSourceUnspecified)
+}
+
 // InputPassportElementErrorSourceDataField A data field contains an error. The error is considered resolved when the field's value changes
 type InputPassportElementErrorSourceDataField struct {
 	tdCommon
@@ -142,11 +305,132 @@ func NewInputPassportElementErrorSourceDataField(fieldName string, dataHash []by
 	return &inputPassportElementErrorSourceDataFieldTemp
 }
 
+// MarshalJSON marshals to json
+func (inputPassportElementErrorSourceDataField *InputPassportElementErrorSourceDataField) MarshalJSON() ([]byte, error) {
+	return inputPassportElementErrorSourceDataField.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputPassportElementErrorSourceDataField *InputPassportElementErrorSourceDataField) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputPassportElementErrorSourceDataField)
+}
+
+func (inputPassportElementErrorSourceDataField *InputPassportElementErrorSourceDataField) appendJSON(b []byte) ([]byte, error) {
+	if inputPassportElementErrorSourceDataField == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputPassportElementErrorSourceDataField.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputPassportElementErrorSourceDataField.tdCommon.Extra)
+	b = append(b, `,"field_name":`...)
+	b = appendJSONString(b, inputPassportElementErrorSourceDataField.FieldName)
+	b = append(b, `,"data_hash":`...)
+	b = appendJSONBytes(b, inputPassportElementErrorSourceDataField.DataHash)
+	return append(b, '}'), nil
+}
+
+func (inputPassportElementErrorSourceDataField *InputPassportElementErrorSourceDataField) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputPassportElementErrorSourceDataField.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputPassportElementErrorSourceDataField.tdCommon.Extra, err = r.readString()
+		case "field_name":
+			inputPassportElementErrorSourceDataField.FieldName, err = r.readString()
+		case "data_hash":
+			inputPassportElementErrorSourceDataField.DataHash, err = r.readBytes()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputPassportElementErrorSourceDataField *InputPassportElementErrorSourceDataField) Clone() *InputPassportElementErrorSourceDataField {
+	if inputPassportElementErrorSourceDataField == nil {
+		return nil
+	}
+	clone := *inputPassportElementErrorSourceDataField
+	if inputPassportElementErrorSourceDataField.DataHash != nil {
+		clone.DataHash = append([]byte{}, inputPassportElementErrorSourceDataField.DataHash...)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputPassportElementErrorSourceDataField *InputPassportElementErrorSourceDataField) Equal(other *InputPassportElementErrorSourceDataField) bool {
+	if inputPassportElementErrorSourceDataField == nil || other == nil {
+		return inputPassportElementErrorSourceDataField == other
+	}
+	if inputPassportElementErrorSourceDataField.FieldName != other.FieldName {
+		return false
+	}
+	if !equalBytes(inputPassportElementErrorSourceDataField.DataHash, other.DataHash) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputPassportElementErrorSourceDataField *InputPassportElementErrorSourceDataField) Diff(other *InputPassportElementErrorSourceDataField) []Change {
+	return inputPassportElementErrorSourceDataField.diff("", other, nil)
+}
+
+func (inputPassportElementErrorSourceDataField *InputPassportElementErrorSourceDataField) diff(path string, other *InputPassportElementErrorSourceDataField, changes []Change) []Change {
+	if inputPassportElementErrorSourceDataField == nil || other == nil {
+		if inputPassportElementErrorSourceDataField != other {
+			changes = append(changes, Change{Path: path, Old: inputPassportElementErrorSourceDataField, New: other})
+		}
+		return changes
+	}
+	if inputPassportElementErrorSourceDataField.FieldName != other.FieldName {
+		changes = append(changes, Change{Path: fieldPath(path, "field_name"), Old: inputPassportElementErrorSourceDataField.FieldName, New: other.FieldName})
+	}
+	if !equalBytes(inputPassportElementErrorSourceDataField.DataHash, other.DataHash) {
+		changes = append(changes, Change{Path: fieldPath(path, "data_hash"), Old: inputPassportElementErrorSourceDataField.DataHash, New: other.DataHash})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputPassportElementErrorSourceDataField *InputPassportElementErrorSourceDataField) String() string {
+	return objectString(inputPassportElementErrorSourceDataField)
+}
+
+// LogValue logs the object as its summary
+func (inputPassportElementErrorSourceDataField *InputPassportElementErrorSourceDataField) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputPassportElementErrorSourceDataField))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputPassportElementErrorSourceDataField *InputPassportElementErrorSourceDataField) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputPassportElementErrorSourceDataField)
+}
+
+func (inputPassportElementErrorSourceDataField *InputPassportElementErrorSourceDataField) printText(p *printer) {
+	if inputPassportElementErrorSourceDataField == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputPassportElementErrorSourceDataField")
+	p.string("field_name", inputPassportElementErrorSourceDataField.FieldName)
+	p.bytes("data_hash", inputPassportElementErrorSourceDataField.DataHash)
+	p.endObject(state)
+}
+
 // GetInputPassportElementErrorSourceEnum return the enum type of this object
 func (inputPassportElementErrorSourceDataField *InputPassportElementErrorSourceDataField) GetInputPassportElementErrorSourceEnum() InputPassportElementErrorSourceEnum {
 	return InputPassportElementErrorSourceDataFieldType
 }
 
+// Accept calls the method of the visitor for InputPassportElementErrorSourceDataField
+func (inputPassportElementErrorSourceDataField *InputPassportElementErrorSourceDataField) Accept(visitor InputPassportElementErrorSourceVisitor) {
+	visitor.VisitInputPassportElementErrorSourceDataField(inputPassportElementErrorSourceDataField)
+}
+
 // InputPassportElementErrorSourceFrontSide The front side of the document contains an error. The error is considered resolved when the file with the front side of the document changes
 type InputPassportElementErrorSourceFrontSide struct {
 	tdCommon
@@ -170,11 +454,121 @@ func NewInputPassportElementErrorSourceFrontSide(fileHash []byte) *InputPassport
 	return &inputPassportElementErrorSourceFrontSideTemp
 }
 
+// MarshalJSON marshals to json
+func (inputPassportElementErrorSourceFrontSide *InputPassportElementErrorSourceFrontSide) MarshalJSON() ([]byte, error) {
+	return inputPassportElementErrorSourceFrontSide.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputPassportElementErrorSourceFrontSide *InputPassportElementErrorSourceFrontSide) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputPassportElementErrorSourceFrontSide)
+}
+
+func (inputPassportElementErrorSourceFrontSide *InputPassportElementErrorSourceFrontSide) appendJSON(b []byte) ([]byte, error) {
+	if inputPassportElementErrorSourceFrontSide == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputPassportElementErrorSourceFrontSide.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputPassportElementErrorSourceFrontSide.tdCommon.Extra)
+	b = append(b, `,"file_hash":`...)
+	b = appendJSONBytes(b, inputPassportElementErrorSourceFrontSide.FileHash)
+	return append(b, '}'), nil
+}
+
+func (inputPassportElementErrorSourceFrontSide *InputPassportElementErrorSourceFrontSide) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputPassportElementErrorSourceFrontSide.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputPassportElementErrorSourceFrontSide.tdCommon.Extra, err = r.readString()
+		case "file_hash":
+			inputPassportElementErrorSourceFrontSide.FileHash, err = r.readBytes()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputPassportElementErrorSourceFrontSide *InputPassportElementErrorSourceFrontSide) Clone() *InputPassportElementErrorSourceFrontSide {
+	if inputPassportElementErrorSourceFrontSide == nil {
+		return nil
+	}
+	clone := *inputPassportElementErrorSourceFrontSide
+	if inputPassportElementErrorSourceFrontSide.FileHash != nil {
+		clone.FileHash = append([]byte{}, inputPassportElementErrorSourceFrontSide.FileHash...)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputPassportElementErrorSourceFrontSide *InputPassportElementErrorSourceFrontSide) Equal(other *InputPassportElementErrorSourceFrontSide) bool {
+	if inputPassportElementErrorSourceFrontSide == nil || other == nil {
+		return inputPassportElementErrorSourceFrontSide == other
+	}
+	if !equalBytes(inputPassportElementErrorSourceFrontSide.FileHash, other.FileHash) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputPassportElementErrorSourceFrontSide *InputPassportElementErrorSourceFrontSide) Diff(other *InputPassportElementErrorSourceFrontSide) []Change {
+	return inputPassportElementErrorSourceFrontSide.diff("", other, nil)
+}
+
+func (inputPassportElementErrorSourceFrontSide *InputPassportElementErrorSourceFrontSide) diff(path string, other *InputPassportElementErrorSourceFrontSide, changes []Change) []Change {
+	if inputPassportElementErrorSourceFrontSide == nil || other == nil {
+		if inputPassportElementErrorSourceFrontSide != other {
+			changes = append(changes, Change{Path: path, Old: inputPassportElementErrorSourceFrontSide, New: other})
+		}
+		return changes
+	}
+	if !equalBytes(inputPassportElementErrorSourceFrontSide.FileHash, other.FileHash) {
+		changes = append(changes, Change{Path: fieldPath(path, "file_hash"), Old: inputPassportElementErrorSourceFrontSide.FileHash, New: other.FileHash})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputPassportElementErrorSourceFrontSide *InputPassportElementErrorSourceFrontSide) String() string {
+	return objectString(inputPassportElementErrorSourceFrontSide)
+}
+
+// LogValue logs the object as its summary
+func (inputPassportElementErrorSourceFrontSide *InputPassportElementErrorSourceFrontSide) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputPassportElementErrorSourceFrontSide))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputPassportElementErrorSourceFrontSide *InputPassportElementErrorSourceFrontSide) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputPassportElementErrorSourceFrontSide)
+}
+
+func (inputPassportElementErrorSourceFrontSide *InputPassportElementErrorSourceFrontSide) printText(p *printer) {
+	if inputPassportElementErrorSourceFrontSide == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputPassportElementErrorSourceFrontSide")
+	p.bytes("file_hash", inputPassportElementErrorSourceFrontSide.FileHash)
+	p.endObject(state)
+}
+
 // GetInputPassportElementErrorSourceEnum return the enum type of this object
 func (inputPassportElementErrorSourceFrontSide *InputPassportElementErrorSourceFrontSide) GetInputPassportElementErrorSourceEnum() InputPassportElementErrorSourceEnum {
 	return InputPassportElementErrorSourceFrontSideType
 }
 
+// Accept calls the method of the visitor for InputPassportElementErrorSourceFrontSide
+func (inputPassportElementErrorSourceFrontSide *InputPassportElementErrorSourceFrontSide) Accept(visitor InputPassportElementErrorSourceVisitor) {
+	visitor.VisitInputPassportElementErrorSourceFrontSide(inputPassportElementErrorSourceFrontSide)
+}
+
 // InputPassportElementErrorSourceReverseSide The reverse side of the document contains an error. The error is considered resolved when the file with the reverse side of the document changes
 type InputPassportElementErrorSourceReverseSide struct {
 	tdCommon
@@ -198,11 +592,121 @@ func NewInputPassportElementErrorSourceReverseSide(fileHash []byte) *InputPasspo
 	return &inputPassportElementErrorSourceReverseSideTemp
 }
 
+// MarshalJSON marshals to json
+func (inputPassportElementErrorSourceReverseSide *InputPassportElementErrorSourceReverseSide) MarshalJSON() ([]byte, error) {
+	return inputPassportElementErrorSourceReverseSide.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputPassportElementErrorSourceReverseSide *InputPassportElementErrorSourceReverseSide) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputPassportElementErrorSourceReverseSide)
+}
+
+func (inputPassportElementErrorSourceReverseSide *InputPassportElementErrorSourceReverseSide) appendJSON(b []byte) ([]byte, error) {
+	if inputPassportElementErrorSourceReverseSide == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputPassportElementErrorSourceReverseSide.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputPassportElementErrorSourceReverseSide.tdCommon.Extra)
+	b = append(b, `,"file_hash":`...)
+	b = appendJSONBytes(b, inputPassportElementErrorSourceReverseSide.FileHash)
+	return append(b, '}'), nil
+}
+
+func (inputPassportElementErrorSourceReverseSide *InputPassportElementErrorSourceReverseSide) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputPassportElementErrorSourceReverseSide.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputPassportElementErrorSourceReverseSide.tdCommon.Extra, err = r.readString()
+		case "file_hash":
+			inputPassportElementErrorSourceReverseSide.FileHash, err = r.readBytes()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputPassportElementErrorSourceReverseSide *InputPassportElementErrorSourceReverseSide) Clone() *InputPassportElementErrorSourceReverseSide {
+	if inputPassportElementErrorSourceReverseSide == nil {
+		return nil
+	}
+	clone := *inputPassportElementErrorSourceReverseSide
+	if inputPassportElementErrorSourceReverseSide.FileHash != nil {
+		clone.FileHash = append([]byte{}, inputPassportElementErrorSourceReverseSide.FileHash...)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputPassportElementErrorSourceReverseSide *InputPassportElementErrorSourceReverseSide) Equal(other *InputPassportElementErrorSourceReverseSide) bool {
+	if inputPassportElementErrorSourceReverseSide == nil || other == nil {
+		return inputPassportElementErrorSourceReverseSide == other
+	}
+	if !equalBytes(inputPassportElementErrorSourceReverseSide.FileHash, other.FileHash) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputPassportElementErrorSourceReverseSide *InputPassportElementErrorSourceReverseSide) Diff(other *InputPassportElementErrorSourceReverseSide) []Change {
+	return inputPassportElementErrorSourceReverseSide.diff("", other, nil)
+}
+
+func (inputPassportElementErrorSourceReverseSide *InputPassportElementErrorSourceReverseSide) diff(path string, other *InputPassportElementErrorSourceReverseSide, changes []Change) []Change {
+	if inputPassportElementErrorSourceReverseSide == nil || other == nil {
+		if inputPassportElementErrorSourceReverseSide != other {
+			changes = append(changes, Change{Path: path, Old: inputPassportElementErrorSourceReverseSide, New: other})
+		}
+		return changes
+	}
+	if !equalBytes(inputPassportElementErrorSourceReverseSide.FileHash, other.FileHash) {
+		changes = append(changes, Change{Path: fieldPath(path, "file_hash"), Old: inputPassportElementErrorSourceReverseSide.FileHash, New: other.FileHash})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputPassportElementErrorSourceReverseSide *InputPassportElementErrorSourceReverseSide) String() string {
+	return objectString(inputPassportElementErrorSourceReverseSide)
+}
+
+// LogValue logs the object as its summary
+func (inputPassportElementErrorSourceReverseSide *InputPassportElementErrorSourceReverseSide) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputPassportElementErrorSourceReverseSide))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputPassportElementErrorSourceReverseSide *InputPassportElementErrorSourceReverseSide) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputPassportElementErrorSourceReverseSide)
+}
+
+func (inputPassportElementErrorSourceReverseSide *InputPassportElementErrorSourceReverseSide) printText(p *printer) {
+	if inputPassportElementErrorSourceReverseSide == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputPassportElementErrorSourceReverseSide")
+	p.bytes("file_hash", inputPassportElementErrorSourceReverseSide.FileHash)
+	p.endObject(state)
+}
+
 // GetInputPassportElementErrorSourceEnum return the enum type of this object
 func (inputPassportElementErrorSourceReverseSide *InputPassportElementErrorSourceReverseSide) GetInputPassportElementErrorSourceEnum() InputPassportElementErrorSourceEnum {
 	return InputPassportElementErrorSourceReverseSideType
 }
 
+// Accept calls the method of the visitor for InputPassportElementErrorSourceReverseSide
+func (inputPassportElementErrorSourceReverseSide *InputPassportElementErrorSourceReverseSide) Accept(visitor InputPassportElementErrorSourceVisitor) {
+	visitor.VisitInputPassportElementErrorSourceReverseSide(inputPassportElementErrorSourceReverseSide)
+}
+
 // InputPassportElementErrorSourceSelfie The selfie contains an error. The error is considered resolved when the file with the selfie changes
 type InputPassportElementErrorSourceSelfie struct {
 	tdCommon
@@ -226,11 +730,121 @@ func NewInputPassportElementErrorSourceSelfie(fileHash []byte) *InputPassportEle
 	return &inputPassportElementErrorSourceSelfieTemp
 }
 
+// MarshalJSON marshals to json
+func (inputPassportElementErrorSourceSelfie *InputPassportElementErrorSourceSelfie) MarshalJSON() ([]byte, error) {
+	return inputPassportElementErrorSourceSelfie.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputPassportElementErrorSourceSelfie *InputPassportElementErrorSourceSelfie) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputPassportElementErrorSourceSelfie)
+}
+
+func (inputPassportElementErrorSourceSelfie *InputPassportElementErrorSourceSelfie) appendJSON(b []byte) ([]byte, error) {
+	if inputPassportElementErrorSourceSelfie == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputPassportElementErrorSourceSelfie.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputPassportElementErrorSourceSelfie.tdCommon.Extra)
+	b = append(b, `,"file_hash":`...)
+	b = appendJSONBytes(b, inputPassportElementErrorSourceSelfie.FileHash)
+	return append(b, '}'), nil
+}
+
+func (inputPassportElementErrorSourceSelfie *InputPassportElementErrorSourceSelfie) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputPassportElementErrorSourceSelfie.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputPassportElementErrorSourceSelfie.tdCommon.Extra, err = r.readString()
+		case "file_hash":
+			inputPassportElementErrorSourceSelfie.FileHash, err = r.readBytes()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputPassportElementErrorSourceSelfie *InputPassportElementErrorSourceSelfie) Clone() *InputPassportElementErrorSourceSelfie {
+	if inputPassportElementErrorSourceSelfie == nil {
+		return nil
+	}
+	clone := *inputPassportElementErrorSourceSelfie
+	if inputPassportElementErrorSourceSelfie.FileHash != nil {
+		clone.FileHash = append([]byte{}, inputPassportElementErrorSourceSelfie.FileHash...)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputPassportElementErrorSourceSelfie *InputPassportElementErrorSourceSelfie) Equal(other *InputPassportElementErrorSourceSelfie) bool {
+	if inputPassportElementErrorSourceSelfie == nil || other == nil {
+		return inputPassportElementErrorSourceSelfie == other
+	}
+	if !equalBytes(inputPassportElementErrorSourceSelfie.FileHash, other.FileHash) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputPassportElementErrorSourceSelfie *InputPassportElementErrorSourceSelfie) Diff(other *InputPassportElementErrorSourceSelfie) []Change {
+	return inputPassportElementErrorSourceSelfie.diff("", other, nil)
+}
+
+func (inputPassportElementErrorSourceSelfie *InputPassportElementErrorSourceSelfie) diff(path string, other *InputPassportElementErrorSourceSelfie, changes []Change) []Change {
+	if inputPassportElementErrorSourceSelfie == nil || other == nil {
+		if inputPassportElementErrorSourceSelfie != other {
+			changes = append(changes, Change{Path: path, Old: inputPassportElementErrorSourceSelfie, New: other})
+		}
+		return changes
+	}
+	if !equalBytes(inputPassportElementErrorSourceSelfie.FileHash, other.FileHash) {
+		changes = append(changes, Change{Path: fieldPath(path, "file_hash"), Old: inputPassportElementErrorSourceSelfie.FileHash, New: other.FileHash})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputPassportElementErrorSourceSelfie *InputPassportElementErrorSourceSelfie) String() string {
+	return objectString(inputPassportElementErrorSourceSelfie)
+}
+
+// LogValue logs the object as its summary
+func (inputPassportElementErrorSourceSelfie *InputPassportElementErrorSourceSelfie) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputPassportElementErrorSourceSelfie))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputPassportElementErrorSourceSelfie *InputPassportElementErrorSourceSelfie) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputPassportElementErrorSourceSelfie)
+}
+
+func (inputPassportElementErrorSourceSelfie *InputPassportElementErrorSourceSelfie) printText(p *printer) {
+	if inputPassportElementErrorSourceSelfie == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputPassportElementErrorSourceSelfie")
+	p.bytes("file_hash", inputPassportElementErrorSourceSelfie.FileHash)
+	p.endObject(state)
+}
+
 // GetInputPassportElementErrorSourceEnum return the enum type of this object
 func (inputPassportElementErrorSourceSelfie *InputPassportElementErrorSourceSelfie) GetInputPassportElementErrorSourceEnum() InputPassportElementErrorSourceEnum {
 	return InputPassportElementErrorSourceSelfieType
 }
 
+// Accept calls the method of the visitor for InputPassportElementErrorSourceSelfie
+func (inputPassportElementErrorSourceSelfie *InputPassportElementErrorSourceSelfie) Accept(visitor InputPassportElementErrorSourceVisitor) {
+	visitor.VisitInputPassportElementErrorSourceSelfie(inputPassportElementErrorSourceSelfie)
+}
+
 // InputPassportElementErrorSourceTranslationFile One of the files containing the translation of the document contains an error. The error is considered resolved when the file with the translation changes
 type InputPassportElementErrorSourceTranslationFile struct {
 	tdCommon
@@ -254,11 +868,121 @@ func NewInputPassportElementErrorSourceTranslationFile(fileHash []byte) *InputPa
 	return &inputPassportElementErrorSourceTranslationFileTemp
 }
 
+// MarshalJSON marshals to json
+func (inputPassportElementErrorSourceTranslationFile *InputPassportElementErrorSourceTranslationFile) MarshalJSON() ([]byte, error) {
+	return inputPassportElementErrorSourceTranslationFile.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputPassportElementErrorSourceTranslationFile *InputPassportElementErrorSourceTranslationFile) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputPassportElementErrorSourceTranslationFile)
+}
+
+func (inputPassportElementErrorSourceTranslationFile *InputPassportElementErrorSourceTranslationFile) appendJSON(b []byte) ([]byte, error) {
+	if inputPassportElementErrorSourceTranslationFile == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputPassportElementErrorSourceTranslationFile.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputPassportElementErrorSourceTranslationFile.tdCommon.Extra)
+	b = append(b, `,"file_hash":`...)
+	b = appendJSONBytes(b, inputPassportElementErrorSourceTranslationFile.FileHash)
+	return append(b, '}'), nil
+}
+
+func (inputPassportElementErrorSourceTranslationFile *InputPassportElementErrorSourceTranslationFile) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputPassportElementErrorSourceTranslationFile.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputPassportElementErrorSourceTranslationFile.tdCommon.Extra, err = r.readString()
+		case "file_hash":
+			inputPassportElementErrorSourceTranslationFile.FileHash, err = r.readBytes()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputPassportElementErrorSourceTranslationFile *InputPassportElementErrorSourceTranslationFile) Clone() *InputPassportElementErrorSourceTranslationFile {
+	if inputPassportElementErrorSourceTranslationFile == nil {
+		return nil
+	}
+	clone := *inputPassportElementErrorSourceTranslationFile
+	if inputPassportElementErrorSourceTranslationFile.FileHash != nil {
+		clone.FileHash = append([]byte{}, inputPassportElementErrorSourceTranslationFile.FileHash...)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputPassportElementErrorSourceTranslationFile *InputPassportElementErrorSourceTranslationFile) Equal(other *InputPassportElementErrorSourceTranslationFile) bool {
+	if inputPassportElementErrorSourceTranslationFile == nil || other == nil {
+		return inputPassportElementErrorSourceTranslationFile == other
+	}
+	if !equalBytes(inputPassportElementErrorSourceTranslationFile.FileHash, other.FileHash) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputPassportElementErrorSourceTranslationFile *InputPassportElementErrorSourceTranslationFile) Diff(other *InputPassportElementErrorSourceTranslationFile) []Change {
+	return inputPassportElementErrorSourceTranslationFile.diff("", other, nil)
+}
+
+func (inputPassportElementErrorSourceTranslationFile *InputPassportElementErrorSourceTranslationFile) diff(path string, other *InputPassportElementErrorSourceTranslationFile, changes []Change) []Change {
+	if inputPassportElementErrorSourceTranslationFile == nil || other == nil {
+		if inputPassportElementErrorSourceTranslationFile != other {
+			changes = append(changes, Change{Path: path, Old: inputPassportElementErrorSourceTranslationFile, New: other})
+		}
+		return changes
+	}
+	if !equalBytes(inputPassportElementErrorSourceTranslationFile.FileHash, other.FileHash) {
+		changes = append(changes, Change{Path: fieldPath(path, "file_hash"), Old: inputPassportElementErrorSourceTranslationFile.FileHash, New: other.FileHash})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputPassportElementErrorSourceTranslationFile *InputPassportElementErrorSourceTranslationFile) String() string {
+	return objectString(inputPassportElementErrorSourceTranslationFile)
+}
+
+// LogValue logs the object as its summary
+func (inputPassportElementErrorSourceTranslationFile *InputPassportElementErrorSourceTranslationFile) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputPassportElementErrorSourceTranslationFile))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputPassportElementErrorSourceTranslationFile *InputPassportElementErrorSourceTranslationFile) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputPassportElementErrorSourceTranslationFile)
+}
+
+func (inputPassportElementErrorSourceTranslationFile *InputPassportElementErrorSourceTranslationFile) printText(p *printer) {
+	if inputPassportElementErrorSourceTranslationFile == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputPassportElementErrorSourceTranslationFile")
+	p.bytes("file_hash", inputPassportElementErrorSourceTranslationFile.FileHash)
+	p.endObject(state)
+}
+
 // GetInputPassportElementErrorSourceEnum return the enum type of this object
 func (inputPassportElementErrorSourceTranslationFile *InputPassportElementErrorSourceTranslationFile) GetInputPassportElementErrorSourceEnum() InputPassportElementErrorSourceEnum {
 	return InputPassportElementErrorSourceTranslationFileType
 }
 
+// Accept calls the method of the visitor for InputPassportElementErrorSourceTranslationFile
+func (inputPassportElementErrorSourceTranslationFile *InputPassportElementErrorSourceTranslationFile) Accept(visitor InputPassportElementErrorSourceVisitor) {
+	visitor.VisitInputPassportElementErrorSourceTranslationFile(inputPassportElementErrorSourceTranslationFile)
+}
+
 // InputPassportElementErrorSourceTranslationFiles The translation of the document contains an error. The error is considered resolved when the list of files changes
 type InputPassportElementErrorSourceTranslationFiles struct {
 	tdCommon
@@ -282,11 +1006,160 @@ func NewInputPassportElementErrorSourceTranslationFiles(fileHashes [][]byte) *In
 	return &inputPassportElementErrorSourceTranslationFilesTemp
 }
 
+// MarshalJSON marshals to json
+func (inputPassportElementErrorSourceTranslationFiles *InputPassportElementErrorSourceTranslationFiles) MarshalJSON() ([]byte, error) {
+	return inputPassportElementErrorSourceTranslationFiles.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputPassportElementErrorSourceTranslationFiles *InputPassportElementErrorSourceTranslationFiles) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputPassportElementErrorSourceTranslationFiles)
+}
+
+func (inputPassportElementErrorSourceTranslationFiles *InputPassportElementErrorSourceTranslationFiles) appendJSON(b []byte) ([]byte, error) {
+	if inputPassportElementErrorSourceTranslationFiles == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputPassportElementErrorSourceTranslationFiles.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputPassportElementErrorSourceTranslationFiles.tdCommon.Extra)
+	b = append(b, `,"file_hashes":`...)
+	if inputPassportElementErrorSourceTranslationFiles.FileHashes == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range inputPassportElementErrorSourceTranslationFiles.FileHashes {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONBytes(b, inputPassportElementErrorSourceTranslationFiles.FileHashes[i0])
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (inputPassportElementErrorSourceTranslationFiles *InputPassportElementErrorSourceTranslationFiles) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputPassportElementErrorSourceTranslationFiles.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputPassportElementErrorSourceTranslationFiles.tdCommon.Extra, err = r.readString()
+		case "file_hashes":
+			if r.readNull() {
+				inputPassportElementErrorSourceTranslationFiles.FileHashes = nil
+			} else {
+				inputPassportElementErrorSourceTranslationFiles.FileHashes = make([][]byte, 0)
+				err = r.readArray(func() (err error) {
+					var item0 []byte
+					item0, err = r.readBytes()
+					inputPassportElementErrorSourceTranslationFiles.FileHashes = append(inputPassportElementErrorSourceTranslationFiles.FileHashes, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputPassportElementErrorSourceTranslationFiles *InputPassportElementErrorSourceTranslationFiles) Clone() *InputPassportElementErrorSourceTranslationFiles {
+	if inputPassportElementErrorSourceTranslationFiles == nil {
+		return nil
+	}
+	clone := *inputPassportElementErrorSourceTranslationFiles
+	if inputPassportElementErrorSourceTranslationFiles.FileHashes != nil {
+		clone.FileHashes = make([][]byte, len(inputPassportElementErrorSourceTranslationFiles.FileHashes))
+		for i0 := range inputPassportElementErrorSourceTranslationFiles.FileHashes {
+			if inputPassportElementErrorSourceTranslationFiles.FileHashes[i0] != nil {
+				clone.FileHashes[i0] = append([]byte{}, inputPassportElementErrorSourceTranslationFiles.FileHashes[i0]...)
+			}
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputPassportElementErrorSourceTranslationFiles *InputPassportElementErrorSourceTranslationFiles) Equal(other *InputPassportElementErrorSourceTranslationFiles) bool {
+	if inputPassportElementErrorSourceTranslationFiles == nil || other == nil {
+		return inputPassportElementErrorSourceTranslationFiles == other
+	}
+	if len(inputPassportElementErrorSourceTranslationFiles.FileHashes) != len(other.FileHashes) {
+		return false
+	}
+	for i0 := range inputPassportElementErrorSourceTranslationFiles.FileHashes {
+		if !equalBytes(inputPassportElementErrorSourceTranslationFiles.FileHashes[i0], other.FileHashes[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputPassportElementErrorSourceTranslationFiles *InputPassportElementErrorSourceTranslationFiles) Diff(other *InputPassportElementErrorSourceTranslationFiles) []Change {
+	return inputPassportElementErrorSourceTranslationFiles.diff("", other, nil)
+}
+
+func (inputPassportElementErrorSourceTranslationFiles *InputPassportElementErrorSourceTranslationFiles) diff(path string, other *InputPassportElementErrorSourceTranslationFiles, changes []Change) []Change {
+	if inputPassportElementErrorSourceTranslationFiles == nil || other == nil {
+		if inputPassportElementErrorSourceTranslationFiles != other {
+			changes = append(changes, Change{Path: path, Old: inputPassportElementErrorSourceTranslationFiles, New: other})
+		}
+		return changes
+	}
+	if len(inputPassportElementErrorSourceTranslationFiles.FileHashes) != len(other.FileHashes) {
+		changes = append(changes, Change{Path: fieldPath(path, "file_hashes"), Old: inputPassportElementErrorSourceTranslationFiles.FileHashes, New: other.FileHashes})
+	} else {
+		for i0 := range inputPassportElementErrorSourceTranslationFiles.FileHashes {
+			if !equalBytes(inputPassportElementErrorSourceTranslationFiles.FileHashes[i0], other.FileHashes[i0]) {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "file_hashes"), i0), Old: inputPassportElementErrorSourceTranslationFiles.FileHashes[i0], New: other.FileHashes[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputPassportElementErrorSourceTranslationFiles *InputPassportElementErrorSourceTranslationFiles) String() string {
+	return objectString(inputPassportElementErrorSourceTranslationFiles)
+}
+
+// LogValue logs the object as its summary
+func (inputPassportElementErrorSourceTranslationFiles *InputPassportElementErrorSourceTranslationFiles) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputPassportElementErrorSourceTranslationFiles))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputPassportElementErrorSourceTranslationFiles *InputPassportElementErrorSourceTranslationFiles) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputPassportElementErrorSourceTranslationFiles)
+}
+
+func (inputPassportElementErrorSourceTranslationFiles *InputPassportElementErrorSourceTranslationFiles) printText(p *printer) {
+	if inputPassportElementErrorSourceTranslationFiles == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputPassportElementErrorSourceTranslationFiles")
+	p.vector("file_hashes", len(inputPassportElementErrorSourceTranslationFiles.FileHashes), func(i0 int) {
+		p.bytes("file_hashes", inputPassportElementErrorSourceTranslationFiles.FileHashes[i0])
+	})
+	p.endObject(state)
+}
+
 // GetInputPassportElementErrorSourceEnum return the enum type of this object
 func (inputPassportElementErrorSourceTranslationFiles *InputPassportElementErrorSourceTranslationFiles) GetInputPassportElementErrorSourceEnum() InputPassportElementErrorSourceEnum {
 	return InputPassportElementErrorSourceTranslationFilesType
 }
 
+// Accept calls the method of the visitor for InputPassportElementErrorSourceTranslationFiles
+func (inputPassportElementErrorSourceTranslationFiles *InputPassportElementErrorSourceTranslationFiles) Accept(visitor InputPassportElementErrorSourceVisitor) {
+	visitor.VisitInputPassportElementErrorSourceTranslationFiles(inputPassportElementErrorSourceTranslationFiles)
+}
+
 // InputPassportElementErrorSourceFile The file contains an error. The error is considered resolved when the file changes
 type InputPassportElementErrorSourceFile struct {
 	tdCommon
@@ -310,11 +1183,121 @@ func NewInputPassportElementErrorSourceFile(fileHash []byte) *InputPassportEleme
 	return &inputPassportElementErrorSourceFileTemp
 }
 
+// MarshalJSON marshals to json
+func (inputPassportElementErrorSourceFile *InputPassportElementErrorSourceFile) MarshalJSON() ([]byte, error) {
+	return inputPassportElementErrorSourceFile.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputPassportElementErrorSourceFile *InputPassportElementErrorSourceFile) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputPassportElementErrorSourceFile)
+}
+
+func (inputPassportElementErrorSourceFile *InputPassportElementErrorSourceFile) appendJSON(b []byte) ([]byte, error) {
+	if inputPassportElementErrorSourceFile == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputPassportElementErrorSourceFile.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputPassportElementErrorSourceFile.tdCommon.Extra)
+	b = append(b, `,"file_hash":`...)
+	b = appendJSONBytes(b, inputPassportElementErrorSourceFile.FileHash)
+	return append(b, '}'), nil
+}
+
+func (inputPassportElementErrorSourceFile *InputPassportElementErrorSourceFile) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputPassportElementErrorSourceFile.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputPassportElementErrorSourceFile.tdCommon.Extra, err = r.readString()
+		case "file_hash":
+			inputPassportElementErrorSourceFile.FileHash, err = r.readBytes()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputPassportElementErrorSourceFile *InputPassportElementErrorSourceFile) Clone() *InputPassportElementErrorSourceFile {
+	if inputPassportElementErrorSourceFile == nil {
+		return nil
+	}
+	clone := *inputPassportElementErrorSourceFile
+	if inputPassportElementErrorSourceFile.FileHash != nil {
+		clone.FileHash = append([]byte{}, inputPassportElementErrorSourceFile.FileHash...)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputPassportElementErrorSourceFile *InputPassportElementErrorSourceFile) Equal(other *InputPassportElementErrorSourceFile) bool {
+	if inputPassportElementErrorSourceFile == nil || other == nil {
+		return inputPassportElementErrorSourceFile == other
+	}
+	if !equalBytes(inputPassportElementErrorSourceFile.FileHash, other.FileHash) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputPassportElementErrorSourceFile *InputPassportElementErrorSourceFile) Diff(other *InputPassportElementErrorSourceFile) []Change {
+	return inputPassportElementErrorSourceFile.diff("", other, nil)
+}
+
+func (inputPassportElementErrorSourceFile *InputPassportElementErrorSourceFile) diff(path string, other *InputPassportElementErrorSourceFile, changes []Change) []Change {
+	if inputPassportElementErrorSourceFile == nil || other == nil {
+		if inputPassportElementErrorSourceFile != other {
+			changes = append(changes, Change{Path: path, Old: inputPassportElementErrorSourceFile, New: other})
+		}
+		return changes
+	}
+	if !equalBytes(inputPassportElementErrorSourceFile.FileHash, other.FileHash) {
+		changes = append(changes, Change{Path: fieldPath(path, "file_hash"), Old: inputPassportElementErrorSourceFile.FileHash, New: other.FileHash})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputPassportElementErrorSourceFile *InputPassportElementErrorSourceFile) String() string {
+	return objectString(inputPassportElementErrorSourceFile)
+}
+
+// LogValue logs the object as its summary
+func (inputPassportElementErrorSourceFile *InputPassportElementErrorSourceFile) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputPassportElementErrorSourceFile))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputPassportElementErrorSourceFile *InputPassportElementErrorSourceFile) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputPassportElementErrorSourceFile)
+}
+
+func (inputPassportElementErrorSourceFile *InputPassportElementErrorSourceFile) printText(p *printer) {
+	if inputPassportElementErrorSourceFile == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputPassportElementErrorSourceFile")
+	p.bytes("file_hash", inputPassportElementErrorSourceFile.FileHash)
+	p.endObject(state)
+}
+
 // GetInputPassportElementErrorSourceEnum return the enum type of this object
 func (inputPassportElementErrorSourceFile *InputPassportElementErrorSourceFile) GetInputPassportElementErrorSourceEnum() InputPassportElementErrorSourceEnum {
 	return InputPassportElementErrorSourceFileType
 }
 
+// Accept calls the method of the visitor for InputPassportElementErrorSourceFile
+func (inputPassportElementErrorSourceFile *InputPassportElementErrorSourceFile) Accept(visitor InputPassportElementErrorSourceVisitor) {
+	visitor.VisitInputPassportElementErrorSourceFile(inputPassportElementErrorSourceFile)
+}
+
 // InputPassportElementErrorSourceFiles The list of attached files contains an error. The error is considered resolved when the file list changes
 type InputPassportElementErrorSourceFiles struct {
 	tdCommon
@@ -338,7 +1321,356 @@ func NewInputPassportElementErrorSourceFiles(fileHashes [][]byte) *InputPassport
 	return &inputPassportElementErrorSourceFilesTemp
 }
 
+// MarshalJSON marshals to json
+func (inputPassportElementErrorSourceFiles *InputPassportElementErrorSourceFiles) MarshalJSON() ([]byte, error) {
+	return inputPassportElementErrorSourceFiles.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputPassportElementErrorSourceFiles *InputPassportElementErrorSourceFiles) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputPassportElementErrorSourceFiles)
+}
+
+func (inputPassportElementErrorSourceFiles *InputPassportElementErrorSourceFiles) appendJSON(b []byte) ([]byte, error) {
+	if inputPassportElementErrorSourceFiles == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputPassportElementErrorSourceFiles.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputPassportElementErrorSourceFiles.tdCommon.Extra)
+	b = append(b, `,"file_hashes":`...)
+	if inputPassportElementErrorSourceFiles.FileHashes == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range inputPassportElementErrorSourceFiles.FileHashes {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONBytes(b, inputPassportElementErrorSourceFiles.FileHashes[i0])
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (inputPassportElementErrorSourceFiles *InputPassportElementErrorSourceFiles) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputPassportElementErrorSourceFiles.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputPassportElementErrorSourceFiles.tdCommon.Extra, err = r.readString()
+		case "file_hashes":
+			if r.readNull() {
+				inputPassportElementErrorSourceFiles.FileHashes = nil
+			} else {
+				inputPassportElementErrorSourceFiles.FileHashes = make([][]byte, 0)
+				err = r.readArray(func() (err error) {
+					var item0 []byte
+					item0, err = r.readBytes()
+					inputPassportElementErrorSourceFiles.FileHashes = append(inputPassportElementErrorSourceFiles.FileHashes, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputPassportElementErrorSourceFiles *InputPassportElementErrorSourceFiles) Clone() *InputPassportElementErrorSourceFiles {
+	if inputPassportElementErrorSourceFiles == nil {
+		return nil
+	}
+	clone := *inputPassportElementErrorSourceFiles
+	if inputPassportElementErrorSourceFiles.FileHashes != nil {
+		clone.FileHashes = make([][]byte, len(inputPassportElementErrorSourceFiles.FileHashes))
+		for i0 := range inputPassportElementErrorSourceFiles.FileHashes {
+			if inputPassportElementErrorSourceFiles.FileHashes[i0] != nil {
+				clone.FileHashes[i0] = append([]byte{}, inputPassportElementErrorSourceFiles.FileHashes[i0]...)
+			}
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputPassportElementErrorSourceFiles *InputPassportElementErrorSourceFiles) Equal(other *InputPassportElementErrorSourceFiles) bool {
+	if inputPassportElementErrorSourceFiles == nil || other == nil {
+		return inputPassportElementErrorSourceFiles == other
+	}
+	if len(inputPassportElementErrorSourceFiles.FileHashes) != len(other.FileHashes) {
+		return false
+	}
+	for i0 := range inputPassportElementErrorSourceFiles.FileHashes {
+		if !equalBytes(inputPassportElementErrorSourceFiles.FileHashes[i0], other.FileHashes[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputPassportElementErrorSourceFiles *InputPassportElementErrorSourceFiles) Diff(other *InputPassportElementErrorSourceFiles) []Change {
+	return inputPassportElementErrorSourceFiles.diff("", other, nil)
+}
+
+func (inputPassportElementErrorSourceFiles *InputPassportElementErrorSourceFiles) diff(path string, other *InputPassportElementErrorSourceFiles, changes []Change) []Change {
+	if inputPassportElementErrorSourceFiles == nil || other == nil {
+		if inputPassportElementErrorSourceFiles != other {
+			changes = append(changes, Change{Path: path, Old: inputPassportElementErrorSourceFiles, New: other})
+		}
+		return changes
+	}
+	if len(inputPassportElementErrorSourceFiles.FileHashes) != len(other.FileHashes) {
+		changes = append(changes, Change{Path: fieldPath(path, "file_hashes"), Old: inputPassportElementErrorSourceFiles.FileHashes, New: other.FileHashes})
+	} else {
+		for i0 := range inputPassportElementErrorSourceFiles.FileHashes {
+			if !equalBytes(inputPassportElementErrorSourceFiles.FileHashes[i0], other.FileHashes[i0]) {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "file_hashes"), i0), Old: inputPassportElementErrorSourceFiles.FileHashes[i0], New: other.FileHashes[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputPassportElementErrorSourceFiles *InputPassportElementErrorSourceFiles) String() string {
+	return objectString(inputPassportElementErrorSourceFiles)
+}
+
+// LogValue logs the object as its summary
+func (inputPassportElementErrorSourceFiles *InputPassportElementErrorSourceFiles) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputPassportElementErrorSourceFiles))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputPassportElementErrorSourceFiles *InputPassportElementErrorSourceFiles) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputPassportElementErrorSourceFiles)
+}
+
+func (inputPassportElementErrorSourceFiles *InputPassportElementErrorSourceFiles) printText(p *printer) {
+	if inputPassportElementErrorSourceFiles == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputPassportElementErrorSourceFiles")
+	p.vector("file_hashes", len(inputPassportElementErrorSourceFiles.FileHashes), func(i0 int) {
+		p.bytes("file_hashes", inputPassportElementErrorSourceFiles.FileHashes[i0])
+	})
+	p.endObject(state)
+}
+
 // GetInputPassportElementErrorSourceEnum return the enum type of this object
 func (inputPassportElementErrorSourceFiles *InputPassportElementErrorSourceFiles) GetInputPassportElementErrorSourceEnum() InputPassportElementErrorSourceEnum {
 	return InputPassportElementErrorSourceFilesType
 }
+
+// Accept calls the method of the visitor for InputPassportElementErrorSourceFiles
+func (inputPassportElementErrorSourceFiles *InputPassportElementErrorSourceFiles) Accept(visitor InputPassportElementErrorSourceVisitor) {
+	visitor.VisitInputPassportElementErrorSourceFiles(inputPassportElementErrorSourceFiles)
+}
+
+// InputPassportElementErrorSourceVisitor visits every type of InputPassportElementErrorSource, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to InputPassportElementErrorSource, until they handle it too.
+type InputPassportElementErrorSourceVisitor interface {
+	VisitInputPassportElementErrorSourceUnspecified(inputPassportElementErrorSourceUnspecified *InputPassportElementErrorSourceUnspecified)
+	VisitInputPassportElementErrorSourceDataField(inputPassportElementErrorSourceDataField *InputPassportElementErrorSourceDataField)
+	VisitInputPassportElementErrorSourceFrontSide(inputPassportElementErrorSourceFrontSide *InputPassportElementErrorSourceFrontSide)
+	VisitInputPassportElementErrorSourceReverseSide(inputPassportElementErrorSourceReverseSide *InputPassportElementErrorSourceReverseSide)
+	VisitInputPassportElementErrorSourceSelfie(inputPassportElementErrorSourceSelfie *InputPassportElementErrorSourceSelfie)
+	VisitInputPassportElementErrorSourceTranslationFile(inputPassportElementErrorSourceTranslationFile *InputPassportElementErrorSourceTranslationFile)
+	VisitInputPassportElementErrorSourceTranslationFiles(inputPassportElementErrorSourceTranslationFiles *InputPassportElementErrorSourceTranslationFiles)
+	VisitInputPassportElementErrorSourceFile(inputPassportElementErrorSourceFile *InputPassportElementErrorSourceFile)
+	VisitInputPassportElementErrorSourceFiles(inputPassportElementErrorSourceFiles *InputPassportElementErrorSourceFiles)
+	VisitUnknownInputPassportElementErrorSource(unknownInputPassportElementErrorSource *UnknownInputPassportElementErrorSource)
+}
+
+// InputPassportElementErrorSourceCases holds the function MatchInputPassportElementErrorSource calls for each type of InputPassportElementErrorSource;
+// types without a function are passed to the default case
+type InputPassportElementErrorSourceCases[R any] struct {
+	InputPassportElementErrorSourceUnspecified      func(inputPassportElementErrorSourceUnspecified *InputPassportElementErrorSourceUnspecified) R
+	InputPassportElementErrorSourceDataField        func(inputPassportElementErrorSourceDataField *InputPassportElementErrorSourceDataField) R
+	InputPassportElementErrorSourceFrontSide        func(inputPassportElementErrorSourceFrontSide *InputPassportElementErrorSourceFrontSide) R
+	InputPassportElementErrorSourceReverseSide      func(inputPassportElementErrorSourceReverseSide *InputPassportElementErrorSourceReverseSide) R
+	InputPassportElementErrorSourceSelfie           func(inputPassportElementErrorSourceSelfie *InputPassportElementErrorSourceSelfie) R
+	InputPassportElementErrorSourceTranslationFile  func(inputPassportElementErrorSourceTranslationFile *InputPassportElementErrorSourceTranslationFile) R
+	InputPassportElementErrorSourceTranslationFiles func(inputPassportElementErrorSourceTranslationFiles *InputPassportElementErrorSourceTranslationFiles) R
+	InputPassportElementErrorSourceFile             func(inputPassportElementErrorSourceFile *InputPassportElementErrorSourceFile) R
+	InputPassportElementErrorSourceFiles            func(inputPassportElementErrorSourceFiles *InputPassportElementErrorSourceFiles) R
+}
+
+// MatchInputPassportElementErrorSource calls the case of the type of inputPassportElementErrorSource, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement InputPassportElementErrorSourceVisitor
+// to have the compiler check every type is handled.
+func MatchInputPassportElementErrorSource[R any](inputPassportElementErrorSource InputPassportElementErrorSource, cases InputPassportElementErrorSourceCases[R], defaultCase func(inputPassportElementErrorSource InputPassportElementErrorSource) R) R {
+	switch value := inputPassportElementErrorSource.(type) {
+	case *InputPassportElementErrorSourceUnspecified:
+		if cases.InputPassportElementErrorSourceUnspecified != nil {
+			return cases.InputPassportElementErrorSourceUnspecified(value)
+		}
+	case *InputPassportElementErrorSourceDataField:
+		if cases.InputPassportElementErrorSourceDataField != nil {
+			return cases.InputPassportElementErrorSourceDataField(value)
+		}
+	case *InputPassportElementErrorSourceFrontSide:
+		if cases.InputPassportElementErrorSourceFrontSide != nil {
+			return cases.InputPassportElementErrorSourceFrontSide(value)
+		}
+	case *InputPassportElementErrorSourceReverseSide:
+		if cases.InputPassportElementErrorSourceReverseSide != nil {
+			return cases.InputPassportElementErrorSourceReverseSide(value)
+		}
+	case *InputPassportElementErrorSourceSelfie:
+		if cases.InputPassportElementErrorSourceSelfie != nil {
+			return cases.InputPassportElementErrorSourceSelfie(value)
+		}
+	case *InputPassportElementErrorSourceTranslationFile:
+		if cases.InputPassportElementErrorSourceTranslationFile != nil {
+			return cases.InputPassportElementErrorSourceTranslationFile(value)
+		}
+	case *InputPassportElementErrorSourceTranslationFiles:
+		if cases.InputPassportElementErrorSourceTranslationFiles != nil {
+			return cases.InputPassportElementErrorSourceTranslationFiles(value)
+		}
+	case *InputPassportElementErrorSourceFile:
+		if cases.InputPassportElementErrorSourceFile != nil {
+			return cases.InputPassportElementErrorSourceFile(value)
+		}
+	case *InputPassportElementErrorSourceFiles:
+		if cases.InputPassportElementErrorSourceFiles != nil {
+			return cases.InputPassportElementErrorSourceFiles(value)
+		}
+	}
+	return defaultCase(inputPassportElementErrorSource)
+}
+
+// Clone returns a deep copy of the object
+func (unknownInputPassportElementErrorSource *UnknownInputPassportElementErrorSource) Clone() *UnknownInputPassportElementErrorSource {
+	if unknownInputPassportElementErrorSource == nil {
+		return nil
+	}
+	return &UnknownInputPassportElementErrorSource{Unknown: unknownInputPassportElementErrorSource.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownInputPassportElementErrorSource *UnknownInputPassportElementErrorSource) Equal(other *UnknownInputPassportElementErrorSource) bool {
+	if unknownInputPassportElementErrorSource == nil || other == nil {
+		return unknownInputPassportElementErrorSource == other
+	}
+	return unknownInputPassportElementErrorSource.Unknown.equal(other.Unknown)
+}
+
+func cloneInputPassportElementErrorSource(inputPassportElementErrorSource InputPassportElementErrorSource) InputPassportElementErrorSource {
+	switch value := inputPassportElementErrorSource.(type) {
+	case *InputPassportElementErrorSourceUnspecified:
+		return value.Clone()
+	case *InputPassportElementErrorSourceDataField:
+		return value.Clone()
+	case *InputPassportElementErrorSourceFrontSide:
+		return value.Clone()
+	case *InputPassportElementErrorSourceReverseSide:
+		return value.Clone()
+	case *InputPassportElementErrorSourceSelfie:
+		return value.Clone()
+	case *InputPassportElementErrorSourceTranslationFile:
+		return value.Clone()
+	case *InputPassportElementErrorSourceTranslationFiles:
+		return value.Clone()
+	case *InputPassportElementErrorSourceFile:
+		return value.Clone()
+	case *InputPassportElementErrorSourceFiles:
+		return value.Clone()
+	case *UnknownInputPassportElementErrorSource:
+		return value.Clone()
+	}
+	return inputPassportElementErrorSource
+}
+
+func equalInputPassportElementErrorSource(a InputPassportElementErrorSource, b InputPassportElementErrorSource) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *InputPassportElementErrorSourceUnspecified:
+		b, ok := b.(*InputPassportElementErrorSourceUnspecified)
+		return ok && a.Equal(b)
+	case *InputPassportElementErrorSourceDataField:
+		b, ok := b.(*InputPassportElementErrorSourceDataField)
+		return ok && a.Equal(b)
+	case *InputPassportElementErrorSourceFrontSide:
+		b, ok := b.(*InputPassportElementErrorSourceFrontSide)
+		return ok && a.Equal(b)
+	case *InputPassportElementErrorSourceReverseSide:
+		b, ok := b.(*InputPassportElementErrorSourceReverseSide)
+		return ok && a.Equal(b)
+	case *InputPassportElementErrorSourceSelfie:
+		b, ok := b.(*InputPassportElementErrorSourceSelfie)
+		return ok && a.Equal(b)
+	case *InputPassportElementErrorSourceTranslationFile:
+		b, ok := b.(*InputPassportElementErrorSourceTranslationFile)
+		return ok && a.Equal(b)
+	case *InputPassportElementErrorSourceTranslationFiles:
+		b, ok := b.(*InputPassportElementErrorSourceTranslationFiles)
+		return ok && a.Equal(b)
+	case *InputPassportElementErrorSourceFile:
+		b, ok := b.(*InputPassportElementErrorSourceFile)
+		return ok && a.Equal(b)
+	case *InputPassportElementErrorSourceFiles:
+		b, ok := b.(*InputPassportElementErrorSourceFiles)
+		return ok && a.Equal(b)
+	case *UnknownInputPassportElementErrorSource:
+		b, ok := b.(*UnknownInputPassportElementErrorSource)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffInputPassportElementErrorSource(path string, a InputPassportElementErrorSource, b InputPassportElementErrorSource, changes []Change) []Change {
+	switch a := a.(type) {
+	case *InputPassportElementErrorSourceUnspecified:
+		if b, ok := b.(*InputPassportElementErrorSourceUnspecified); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputPassportElementErrorSourceDataField:
+		if b, ok := b.(*InputPassportElementErrorSourceDataField); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputPassportElementErrorSourceFrontSide:
+		if b, ok := b.(*InputPassportElementErrorSourceFrontSide); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputPassportElementErrorSourceReverseSide:
+		if b, ok := b.(*InputPassportElementErrorSourceReverseSide); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputPassportElementErrorSourceSelfie:
+		if b, ok := b.(*InputPassportElementErrorSourceSelfie); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputPassportElementErrorSourceTranslationFile:
+		if b, ok := b.(*InputPassportElementErrorSourceTranslationFile); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputPassportElementErrorSourceTranslationFiles:
+		if b, ok := b.(*InputPassportElementErrorSourceTranslationFiles); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputPassportElementErrorSourceFile:
+		if b, ok := b.(*InputPassportElementErrorSourceFile); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputPassportElementErrorSourceFiles:
+		if b, ok := b.(*InputPassportElementErrorSourceFiles); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalInputPassportElementErrorSource(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
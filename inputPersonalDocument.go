@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // InputPersonalDocument A personal document to be saved to Telegram Passport
 type InputPersonalDocument struct {
 	tdCommon
@@ -25,3 +30,199 @@ func NewInputPersonalDocument(files []InputFile, translation []InputFile) *Input
 
 	return &inputPersonalDocumentTemp
 }
+
+// MarshalJSON marshals to json
+func (inputPersonalDocument *InputPersonalDocument) MarshalJSON() ([]byte, error) {
+	return inputPersonalDocument.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (inputPersonalDocument *InputPersonalDocument) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, inputPersonalDocument)
+}
+
+func (inputPersonalDocument *InputPersonalDocument) appendJSON(b []byte) ([]byte, error) {
+	if inputPersonalDocument == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputPersonalDocument.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputPersonalDocument.tdCommon.Extra)
+	b = append(b, `,"files":`...)
+	if inputPersonalDocument.Files == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range inputPersonalDocument.Files {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = appendJSONValue(b, inputPersonalDocument.Files[i0])
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"translation":`...)
+	if inputPersonalDocument.Translation == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range inputPersonalDocument.Translation {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = appendJSONValue(b, inputPersonalDocument.Translation[i0])
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (inputPersonalDocument *InputPersonalDocument) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputPersonalDocument.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputPersonalDocument.tdCommon.Extra, err = r.readString()
+		case "files":
+			if r.readNull() {
+				inputPersonalDocument.Files = nil
+			} else {
+				inputPersonalDocument.Files = make([]InputFile, 0)
+				err = r.readArray(func() (err error) {
+					var item0 InputFile
+					item0, err = decodeInputFile(r)
+					inputPersonalDocument.Files = append(inputPersonalDocument.Files, item0)
+					return
+				})
+			}
+		case "translation":
+			if r.readNull() {
+				inputPersonalDocument.Translation = nil
+			} else {
+				inputPersonalDocument.Translation = make([]InputFile, 0)
+				err = r.readArray(func() (err error) {
+					var item0 InputFile
+					item0, err = decodeInputFile(r)
+					inputPersonalDocument.Translation = append(inputPersonalDocument.Translation, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputPersonalDocument *InputPersonalDocument) Clone() *InputPersonalDocument {
+	if inputPersonalDocument == nil {
+		return nil
+	}
+	clone := *inputPersonalDocument
+	if inputPersonalDocument.Files != nil {
+		clone.Files = make([]InputFile, len(inputPersonalDocument.Files))
+		for i0 := range inputPersonalDocument.Files {
+			clone.Files[i0] = cloneInputFile(inputPersonalDocument.Files[i0])
+		}
+	}
+	if inputPersonalDocument.Translation != nil {
+		clone.Translation = make([]InputFile, len(inputPersonalDocument.Translation))
+		for i0 := range inputPersonalDocument.Translation {
+			clone.Translation[i0] = cloneInputFile(inputPersonalDocument.Translation[i0])
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputPersonalDocument *InputPersonalDocument) Equal(other *InputPersonalDocument) bool {
+	if inputPersonalDocument == nil || other == nil {
+		return inputPersonalDocument == other
+	}
+	if len(inputPersonalDocument.Files) != len(other.Files) {
+		return false
+	}
+	for i0 := range inputPersonalDocument.Files {
+		if !equalInputFile(inputPersonalDocument.Files[i0], other.Files[i0]) {
+			return false
+		}
+	}
+	if len(inputPersonalDocument.Translation) != len(other.Translation) {
+		return false
+	}
+	for i0 := range inputPersonalDocument.Translation {
+		if !equalInputFile(inputPersonalDocument.Translation[i0], other.Translation[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputPersonalDocument *InputPersonalDocument) Diff(other *InputPersonalDocument) []Change {
+	return inputPersonalDocument.diff("", other, nil)
+}
+
+func (inputPersonalDocument *InputPersonalDocument) diff(path string, other *InputPersonalDocument, changes []Change) []Change {
+	if inputPersonalDocument == nil || other == nil {
+		if inputPersonalDocument != other {
+			changes = append(changes, Change{Path: path, Old: inputPersonalDocument, New: other})
+		}
+		return changes
+	}
+	if len(inputPersonalDocument.Files) != len(other.Files) {
+		changes = append(changes, Change{Path: fieldPath(path, "files"), Old: inputPersonalDocument.Files, New: other.Files})
+	} else {
+		for i0 := range inputPersonalDocument.Files {
+			changes = diffInputFile(indexPath(fieldPath(path, "files"), i0), inputPersonalDocument.Files[i0], other.Files[i0], changes)
+		}
+	}
+	if len(inputPersonalDocument.Translation) != len(other.Translation) {
+		changes = append(changes, Change{Path: fieldPath(path, "translation"), Old: inputPersonalDocument.Translation, New: other.Translation})
+	} else {
+		for i0 := range inputPersonalDocument.Translation {
+			changes = diffInputFile(indexPath(fieldPath(path, "translation"), i0), inputPersonalDocument.Translation[i0], other.Translation[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputPersonalDocument *InputPersonalDocument) String() string {
+	return objectString(inputPersonalDocument)
+}
+
+// LogValue logs the object as its summary
+func (inputPersonalDocument *InputPersonalDocument) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputPersonalDocument))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputPersonalDocument *InputPersonalDocument) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputPersonalDocument)
+}
+
+func (inputPersonalDocument *InputPersonalDocument) printText(p *printer) {
+	if inputPersonalDocument == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputPersonalDocument")
+	p.vector("files", len(inputPersonalDocument.Files), func(i0 int) {
+		p.object("files", inputPersonalDocument.Files[i0])
+	})
+	p.vector("translation", len(inputPersonalDocument.Translation), func(i0 int) {
+		p.object("translation", inputPersonalDocument.Translation[i0])
+	})
+	p.endObject(state)
+}
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // InputSticker Describes a sticker that needs to be added to a sticker set
 type InputSticker interface {
 	GetInputStickerEnum() InputStickerEnum
+	Accept(visitor InputStickerVisitor)
 }
 
 // InputStickerEnum Alias for abstract InputSticker 'Sub-Classes', used as constant-enum here
@@ -19,30 +21,73 @@ const (
 	InputStickerAnimatedType InputStickerEnum = "inputStickerAnimated"
 )
 
+// UnknownInputSticker is an InputSticker of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownInputSticker struct {
+	Unknown
+}
+
+// GetInputStickerEnum return the enum type of this object
+func (unknownInputSticker *UnknownInputSticker) GetInputStickerEnum() InputStickerEnum {
+	return InputStickerEnum(unknownInputSticker.Type)
+}
+
+// Accept calls the method of the visitor for UnknownInputSticker
+func (unknownInputSticker *UnknownInputSticker) Accept(visitor InputStickerVisitor) {
+	visitor.VisitUnknownInputSticker(unknownInputSticker)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownInputSticker *UnknownInputSticker) String() string {
+	return objectString(unknownInputSticker)
+}
+
+// LogValue logs the object as its summary
+func (unknownInputSticker *UnknownInputSticker) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownInputSticker))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownInputSticker *UnknownInputSticker) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownInputSticker)
+}
+
+func (unknownInputSticker *UnknownInputSticker) printText(p *printer) {
+	if unknownInputSticker == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownInputSticker")
+	p.string("@type", unknownInputSticker.Type)
+	p.endObject(state)
+}
+
 func unmarshalInputSticker(rawMsg *json.RawMessage) (InputSticker, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch InputStickerEnum(objMap["@type"].(string)) {
+	switch InputStickerEnum(typeName) {
 	case InputStickerStaticType:
 		var inputStickerStatic InputStickerStatic
-		err := json.Unmarshal(*rawMsg, &inputStickerStatic)
+		err := jsonUnmarshal(*rawMsg, &inputStickerStatic)
 		return &inputStickerStatic, err
 
 	case InputStickerAnimatedType:
 		var inputStickerAnimated InputStickerAnimated
-		err := json.Unmarshal(*rawMsg, &inputStickerAnimated)
+		err := jsonUnmarshal(*rawMsg, &inputStickerAnimated)
 		return &inputStickerAnimated, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownInputSticker{Unknown: unknown}, nil
 	}
 }
 
@@ -75,31 +120,138 @@ func NewInputStickerStatic(sticker InputFile, emojis string, maskPosition *MaskP
 	return &inputStickerStaticTemp
 }
 
+// MarshalJSON marshals to json
+func (inputStickerStatic *InputStickerStatic) MarshalJSON() ([]byte, error) {
+	return inputStickerStatic.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputStickerStatic *InputStickerStatic) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, inputStickerStatic)
+}
+
+func (inputStickerStatic *InputStickerStatic) appendJSON(b []byte) ([]byte, error) {
+	if inputStickerStatic == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputStickerStatic.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputStickerStatic.tdCommon.Extra)
+	b = append(b, `,"sticker":`...)
+	b, err = appendJSONValue(b, inputStickerStatic.Sticker)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Emojis       string        `json:"emojis"`        // Emojis corresponding to the sticker
-		MaskPosition *MaskPosition `json:"mask_position"` // For masks, position where the mask is placed; pass null if unspecified
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"emojis":`...)
+	b = appendJSONString(b, inputStickerStatic.Emojis)
+	b = append(b, `,"mask_position":`...)
+	b, err = inputStickerStatic.MaskPosition.appendJSON(b)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return append(b, '}'), nil
+}
 
-	inputStickerStatic.tdCommon = tempObj.tdCommon
-	inputStickerStatic.Emojis = tempObj.Emojis
-	inputStickerStatic.MaskPosition = tempObj.MaskPosition
+func (inputStickerStatic *InputStickerStatic) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputStickerStatic.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputStickerStatic.tdCommon.Extra, err = r.readString()
+		case "sticker":
+			inputStickerStatic.Sticker, err = decodeInputFile(r)
+		case "emojis":
+			inputStickerStatic.Emojis, err = r.readString()
+		case "mask_position":
+			if r.readNull() {
+				inputStickerStatic.MaskPosition = nil
+			} else {
+				inputStickerStatic.MaskPosition = new(MaskPosition)
+				err = inputStickerStatic.MaskPosition.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	fieldSticker, _ := unmarshalInputFile(objMap["sticker"])
-	inputStickerStatic.Sticker = fieldSticker
+// Clone returns a deep copy of the object
+func (inputStickerStatic *InputStickerStatic) Clone() *InputStickerStatic {
+	if inputStickerStatic == nil {
+		return nil
+	}
+	clone := *inputStickerStatic
+	clone.Sticker = cloneInputFile(inputStickerStatic.Sticker)
+	clone.MaskPosition = inputStickerStatic.MaskPosition.Clone()
+	return &clone
+}
 
-	return nil
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputStickerStatic *InputStickerStatic) Equal(other *InputStickerStatic) bool {
+	if inputStickerStatic == nil || other == nil {
+		return inputStickerStatic == other
+	}
+	if !equalInputFile(inputStickerStatic.Sticker, other.Sticker) {
+		return false
+	}
+	if inputStickerStatic.Emojis != other.Emojis {
+		return false
+	}
+	if !inputStickerStatic.MaskPosition.Equal(other.MaskPosition) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputStickerStatic *InputStickerStatic) Diff(other *InputStickerStatic) []Change {
+	return inputStickerStatic.diff("", other, nil)
+}
+
+func (inputStickerStatic *InputStickerStatic) diff(path string, other *InputStickerStatic, changes []Change) []Change {
+	if inputStickerStatic == nil || other == nil {
+		if inputStickerStatic != other {
+			changes = append(changes, Change{Path: path, Old: inputStickerStatic, New: other})
+		}
+		return changes
+	}
+	changes = diffInputFile(fieldPath(path, "sticker"), inputStickerStatic.Sticker, other.Sticker, changes)
+	if inputStickerStatic.Emojis != other.Emojis {
+		changes = append(changes, Change{Path: fieldPath(path, "emojis"), Old: inputStickerStatic.Emojis, New: other.Emojis})
+	}
+	changes = inputStickerStatic.MaskPosition.diff(fieldPath(path, "mask_position"), other.MaskPosition, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputStickerStatic *InputStickerStatic) String() string {
+	return objectString(inputStickerStatic)
+}
+
+// LogValue logs the object as its summary
+func (inputStickerStatic *InputStickerStatic) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputStickerStatic))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputStickerStatic *InputStickerStatic) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputStickerStatic)
+}
+
+func (inputStickerStatic *InputStickerStatic) printText(p *printer) {
+	if inputStickerStatic == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputStickerStatic")
+	p.object("sticker", inputStickerStatic.Sticker)
+	p.string("emojis", inputStickerStatic.Emojis)
+	p.object("mask_position", inputStickerStatic.MaskPosition)
+	p.endObject(state)
 }
 
 // GetInputStickerEnum return the enum type of this object
@@ -107,6 +259,11 @@ func (inputStickerStatic *InputStickerStatic) GetInputStickerEnum() InputSticker
 	return InputStickerStaticType
 }
 
+// Accept calls the method of the visitor for InputStickerStatic
+func (inputStickerStatic *InputStickerStatic) Accept(visitor InputStickerVisitor) {
+	visitor.VisitInputStickerStatic(inputStickerStatic)
+}
+
 // InputStickerAnimated An animated sticker in TGS format
 type InputStickerAnimated struct {
 	tdCommon
@@ -133,32 +290,223 @@ func NewInputStickerAnimated(sticker InputFile, emojis string) *InputStickerAnim
 	return &inputStickerAnimatedTemp
 }
 
+// MarshalJSON marshals to json
+func (inputStickerAnimated *InputStickerAnimated) MarshalJSON() ([]byte, error) {
+	return inputStickerAnimated.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputStickerAnimated *InputStickerAnimated) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, inputStickerAnimated)
+}
+
+func (inputStickerAnimated *InputStickerAnimated) appendJSON(b []byte) ([]byte, error) {
+	if inputStickerAnimated == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-		Emojis string `json:"emojis"` // Emojis corresponding to the sticker
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputStickerAnimated.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputStickerAnimated.tdCommon.Extra)
+	b = append(b, `,"sticker":`...)
+	b, err = appendJSONValue(b, inputStickerAnimated.Sticker)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"emojis":`...)
+	b = appendJSONString(b, inputStickerAnimated.Emojis)
+	return append(b, '}'), nil
+}
+
+func (inputStickerAnimated *InputStickerAnimated) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputStickerAnimated.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputStickerAnimated.tdCommon.Extra, err = r.readString()
+		case "sticker":
+			inputStickerAnimated.Sticker, err = decodeInputFile(r)
+		case "emojis":
+			inputStickerAnimated.Emojis, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputStickerAnimated *InputStickerAnimated) Clone() *InputStickerAnimated {
+	if inputStickerAnimated == nil {
+		return nil
+	}
+	clone := *inputStickerAnimated
+	clone.Sticker = cloneInputFile(inputStickerAnimated.Sticker)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputStickerAnimated *InputStickerAnimated) Equal(other *InputStickerAnimated) bool {
+	if inputStickerAnimated == nil || other == nil {
+		return inputStickerAnimated == other
+	}
+	if !equalInputFile(inputStickerAnimated.Sticker, other.Sticker) {
+		return false
 	}
+	if inputStickerAnimated.Emojis != other.Emojis {
+		return false
+	}
+	return true
+}
 
-	inputStickerAnimated.tdCommon = tempObj.tdCommon
-	inputStickerAnimated.Emojis = tempObj.Emojis
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputStickerAnimated *InputStickerAnimated) Diff(other *InputStickerAnimated) []Change {
+	return inputStickerAnimated.diff("", other, nil)
+}
 
-	fieldSticker, _ := unmarshalInputFile(objMap["sticker"])
-	inputStickerAnimated.Sticker = fieldSticker
+func (inputStickerAnimated *InputStickerAnimated) diff(path string, other *InputStickerAnimated, changes []Change) []Change {
+	if inputStickerAnimated == nil || other == nil {
+		if inputStickerAnimated != other {
+			changes = append(changes, Change{Path: path, Old: inputStickerAnimated, New: other})
+		}
+		return changes
+	}
+	changes = diffInputFile(fieldPath(path, "sticker"), inputStickerAnimated.Sticker, other.Sticker, changes)
+	if inputStickerAnimated.Emojis != other.Emojis {
+		changes = append(changes, Change{Path: fieldPath(path, "emojis"), Old: inputStickerAnimated.Emojis, New: other.Emojis})
+	}
+	return changes
+}
 
-	return nil
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputStickerAnimated *InputStickerAnimated) String() string {
+	return objectString(inputStickerAnimated)
+}
+
+// LogValue logs the object as its summary
+func (inputStickerAnimated *InputStickerAnimated) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputStickerAnimated))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputStickerAnimated *InputStickerAnimated) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputStickerAnimated)
+}
+
+func (inputStickerAnimated *InputStickerAnimated) printText(p *printer) {
+	if inputStickerAnimated == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputStickerAnimated")
+	p.object("sticker", inputStickerAnimated.Sticker)
+	p.string("emojis", inputStickerAnimated.Emojis)
+	p.endObject(state)
 }
 
 // GetInputStickerEnum return the enum type of this object
 func (inputStickerAnimated *InputStickerAnimated) GetInputStickerEnum() InputStickerEnum {
 	return InputStickerAnimatedType
 }
+
+// Accept calls the method of the visitor for InputStickerAnimated
+func (inputStickerAnimated *InputStickerAnimated) Accept(visitor InputStickerVisitor) {
+	visitor.VisitInputStickerAnimated(inputStickerAnimated)
+}
+
+// InputStickerVisitor visits every type of InputSticker, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to InputSticker, until they handle it too.
+type InputStickerVisitor interface {
+	VisitInputStickerStatic(inputStickerStatic *InputStickerStatic)
+	VisitInputStickerAnimated(inputStickerAnimated *InputStickerAnimated)
+	VisitUnknownInputSticker(unknownInputSticker *UnknownInputSticker)
+}
+
+// InputStickerCases holds the function MatchInputSticker calls for each type of InputSticker;
+// types without a function are passed to the default case
+type InputStickerCases[R any] struct {
+	InputStickerStatic   func(inputStickerStatic *InputStickerStatic) R
+	InputStickerAnimated func(inputStickerAnimated *InputStickerAnimated) R
+}
+
+// MatchInputSticker calls the case of the type of inputSticker, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement InputStickerVisitor
+// to have the compiler check every type is handled.
+func MatchInputSticker[R any](inputSticker InputSticker, cases InputStickerCases[R], defaultCase func(inputSticker InputSticker) R) R {
+	switch value := inputSticker.(type) {
+	case *InputStickerStatic:
+		if cases.InputStickerStatic != nil {
+			return cases.InputStickerStatic(value)
+		}
+	case *InputStickerAnimated:
+		if cases.InputStickerAnimated != nil {
+			return cases.InputStickerAnimated(value)
+		}
+	}
+	return defaultCase(inputSticker)
+}
+
+// Clone returns a deep copy of the object
+func (unknownInputSticker *UnknownInputSticker) Clone() *UnknownInputSticker {
+	if unknownInputSticker == nil {
+		return nil
+	}
+	return &UnknownInputSticker{Unknown: unknownInputSticker.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownInputSticker *UnknownInputSticker) Equal(other *UnknownInputSticker) bool {
+	if unknownInputSticker == nil || other == nil {
+		return unknownInputSticker == other
+	}
+	return unknownInputSticker.Unknown.equal(other.Unknown)
+}
+
+func cloneInputSticker(inputSticker InputSticker) InputSticker {
+	switch value := inputSticker.(type) {
+	case *InputStickerStatic:
+		return value.Clone()
+	case *InputStickerAnimated:
+		return value.Clone()
+	case *UnknownInputSticker:
+		return value.Clone()
+	}
+	return inputSticker
+}
+
+func equalInputSticker(a InputSticker, b InputSticker) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *InputStickerStatic:
+		b, ok := b.(*InputStickerStatic)
+		return ok && a.Equal(b)
+	case *InputStickerAnimated:
+		b, ok := b.(*InputStickerAnimated)
+		return ok && a.Equal(b)
+	case *UnknownInputSticker:
+		b, ok := b.(*UnknownInputSticker)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffInputSticker(path string, a InputSticker, b InputSticker, changes []Change) []Change {
+	switch a := a.(type) {
+	case *InputStickerStatic:
+		if b, ok := b.(*InputStickerStatic); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InputStickerAnimated:
+		if b, ok := b.(*InputStickerAnimated); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalInputSticker(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // InputThumbnail A thumbnail to be sent along with a file; must be in JPEG or WEBP format for stickers, and less than 200 KB in size
@@ -33,29 +34,129 @@ func NewInputThumbnail(thumbnail InputFile, width int32, height int32) *InputThu
 	return &inputThumbnailTemp
 }
 
+// MarshalJSON marshals to json
+func (inputThumbnail *InputThumbnail) MarshalJSON() ([]byte, error) {
+	return inputThumbnail.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (inputThumbnail *InputThumbnail) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Width  int32 `json:"width"`  // Thumbnail width, usually shouldn't exceed 320. Use 0 if unknown
-		Height int32 `json:"height"` // Thumbnail height, usually shouldn't exceed 320. Use 0 if unknown
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	return unmarshalFast(b, inputThumbnail)
+}
+
+func (inputThumbnail *InputThumbnail) appendJSON(b []byte) ([]byte, error) {
+	if inputThumbnail == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, inputThumbnail.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, inputThumbnail.tdCommon.Extra)
+	b = append(b, `,"thumbnail":`...)
+	b, err = appendJSONValue(b, inputThumbnail.Thumbnail)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"width":`...)
+	b = appendJSONInt(b, int64(inputThumbnail.Width))
+	b = append(b, `,"height":`...)
+	b = appendJSONInt(b, int64(inputThumbnail.Height))
+	return append(b, '}'), nil
+}
+
+func (inputThumbnail *InputThumbnail) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			inputThumbnail.tdCommon.Type, err = r.readString()
+		case "@extra":
+			inputThumbnail.tdCommon.Extra, err = r.readString()
+		case "thumbnail":
+			inputThumbnail.Thumbnail, err = decodeInputFile(r)
+		case "width":
+			inputThumbnail.Width, err = r.readInt32()
+		case "height":
+			inputThumbnail.Height, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (inputThumbnail *InputThumbnail) Clone() *InputThumbnail {
+	if inputThumbnail == nil {
+		return nil
+	}
+	clone := *inputThumbnail
+	clone.Thumbnail = cloneInputFile(inputThumbnail.Thumbnail)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (inputThumbnail *InputThumbnail) Equal(other *InputThumbnail) bool {
+	if inputThumbnail == nil || other == nil {
+		return inputThumbnail == other
+	}
+	if !equalInputFile(inputThumbnail.Thumbnail, other.Thumbnail) {
+		return false
 	}
+	if inputThumbnail.Width != other.Width {
+		return false
+	}
+	if inputThumbnail.Height != other.Height {
+		return false
+	}
+	return true
+}
 
-	inputThumbnail.tdCommon = tempObj.tdCommon
-	inputThumbnail.Width = tempObj.Width
-	inputThumbnail.Height = tempObj.Height
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (inputThumbnail *InputThumbnail) Diff(other *InputThumbnail) []Change {
+	return inputThumbnail.diff("", other, nil)
+}
 
-	fieldThumbnail, _ := unmarshalInputFile(objMap["thumbnail"])
-	inputThumbnail.Thumbnail = fieldThumbnail
+func (inputThumbnail *InputThumbnail) diff(path string, other *InputThumbnail, changes []Change) []Change {
+	if inputThumbnail == nil || other == nil {
+		if inputThumbnail != other {
+			changes = append(changes, Change{Path: path, Old: inputThumbnail, New: other})
+		}
+		return changes
+	}
+	changes = diffInputFile(fieldPath(path, "thumbnail"), inputThumbnail.Thumbnail, other.Thumbnail, changes)
+	if inputThumbnail.Width != other.Width {
+		changes = append(changes, Change{Path: fieldPath(path, "width"), Old: inputThumbnail.Width, New: other.Width})
+	}
+	if inputThumbnail.Height != other.Height {
+		changes = append(changes, Change{Path: fieldPath(path, "height"), Old: inputThumbnail.Height, New: other.Height})
+	}
+	return changes
+}
 
-	return nil
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (inputThumbnail *InputThumbnail) String() string {
+	return objectString(inputThumbnail)
+}
+
+// LogValue logs the object as its summary
+func (inputThumbnail *InputThumbnail) LogValue() slog.Value {
+	return slog.StringValue(objectString(inputThumbnail))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (inputThumbnail *InputThumbnail) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, inputThumbnail)
+}
+
+func (inputThumbnail *InputThumbnail) printText(p *printer) {
+	if inputThumbnail == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InputThumbnail")
+	p.object("thumbnail", inputThumbnail.Thumbnail)
+	p.int("width", int64(inputThumbnail.Width))
+	p.int("height", int64(inputThumbnail.Height))
+	p.endObject(state)
 }
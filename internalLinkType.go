@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // InternalLinkType Describes an internal https://t.me or tg: link, which must be processed by the app in a special way
 type InternalLinkType interface {
 	GetInternalLinkTypeEnum() InternalLinkTypeEnum
+	Accept(visitor InternalLinkTypeVisitor)
 }
 
 // InternalLinkTypeEnum Alias for abstract InternalLinkType 'Sub-Classes', used as constant-enum here
@@ -41,143 +43,194 @@ const (
 	InternalLinkTypeVideoChatType               InternalLinkTypeEnum = "internalLinkTypeVideoChat"
 )
 
+// UnknownInternalLinkType is an InternalLinkType of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownInternalLinkType struct {
+	Unknown
+}
+
+// GetInternalLinkTypeEnum return the enum type of this object
+func (unknownInternalLinkType *UnknownInternalLinkType) GetInternalLinkTypeEnum() InternalLinkTypeEnum {
+	return InternalLinkTypeEnum(unknownInternalLinkType.Type)
+}
+
+// Accept calls the method of the visitor for UnknownInternalLinkType
+func (unknownInternalLinkType *UnknownInternalLinkType) Accept(visitor InternalLinkTypeVisitor) {
+	visitor.VisitUnknownInternalLinkType(unknownInternalLinkType)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownInternalLinkType *UnknownInternalLinkType) String() string {
+	return objectString(unknownInternalLinkType)
+}
+
+// LogValue logs the object as its summary
+func (unknownInternalLinkType *UnknownInternalLinkType) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownInternalLinkType))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownInternalLinkType *UnknownInternalLinkType) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownInternalLinkType)
+}
+
+func (unknownInternalLinkType *UnknownInternalLinkType) printText(p *printer) {
+	if unknownInternalLinkType == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownInternalLinkType")
+	p.string("@type", unknownInternalLinkType.Type)
+	p.endObject(state)
+}
+
 func unmarshalInternalLinkType(rawMsg *json.RawMessage) (InternalLinkType, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch InternalLinkTypeEnum(objMap["@type"].(string)) {
+	switch InternalLinkTypeEnum(typeName) {
 	case InternalLinkTypeActiveSessionsType:
 		var internalLinkTypeActiveSessions InternalLinkTypeActiveSessions
-		err := json.Unmarshal(*rawMsg, &internalLinkTypeActiveSessions)
+		err := jsonUnmarshal(*rawMsg, &internalLinkTypeActiveSessions)
 		return &internalLinkTypeActiveSessions, err
 
 	case InternalLinkTypeAuthenticationCodeType:
 		var internalLinkTypeAuthenticationCode InternalLinkTypeAuthenticationCode
-		err := json.Unmarshal(*rawMsg, &internalLinkTypeAuthenticationCode)
+		err := jsonUnmarshal(*rawMsg, &internalLinkTypeAuthenticationCode)
 		return &internalLinkTypeAuthenticationCode, err
 
 	case InternalLinkTypeBackgroundType:
 		var internalLinkTypeBackground InternalLinkTypeBackground
-		err := json.Unmarshal(*rawMsg, &internalLinkTypeBackground)
+		err := jsonUnmarshal(*rawMsg, &internalLinkTypeBackground)
 		return &internalLinkTypeBackground, err
 
 	case InternalLinkTypeBotStartType:
 		var internalLinkTypeBotStart InternalLinkTypeBotStart
-		err := json.Unmarshal(*rawMsg, &internalLinkTypeBotStart)
+		err := jsonUnmarshal(*rawMsg, &internalLinkTypeBotStart)
 		return &internalLinkTypeBotStart, err
 
 	case InternalLinkTypeBotStartInGroupType:
 		var internalLinkTypeBotStartInGroup InternalLinkTypeBotStartInGroup
-		err := json.Unmarshal(*rawMsg, &internalLinkTypeBotStartInGroup)
+		err := jsonUnmarshal(*rawMsg, &internalLinkTypeBotStartInGroup)
 		return &internalLinkTypeBotStartInGroup, err
 
 	case InternalLinkTypeChangePhoneNumberType:
 		var internalLinkTypeChangePhoneNumber InternalLinkTypeChangePhoneNumber
-		err := json.Unmarshal(*rawMsg, &internalLinkTypeChangePhoneNumber)
+		err := jsonUnmarshal(*rawMsg, &internalLinkTypeChangePhoneNumber)
 		return &internalLinkTypeChangePhoneNumber, err
 
 	case InternalLinkTypeChatInviteType:
 		var internalLinkTypeChatInvite InternalLinkTypeChatInvite
-		err := json.Unmarshal(*rawMsg, &internalLinkTypeChatInvite)
+		err := jsonUnmarshal(*rawMsg, &internalLinkTypeChatInvite)
 		return &internalLinkTypeChatInvite, err
 
 	case InternalLinkTypeFilterSettingsType:
 		var internalLinkTypeFilterSettings InternalLinkTypeFilterSettings
-		err := json.Unmarshal(*rawMsg, &internalLinkTypeFilterSettings)
+		err := jsonUnmarshal(*rawMsg, &internalLinkTypeFilterSettings)
 		return &internalLinkTypeFilterSettings, err
 
 	case InternalLinkTypeGameType:
 		var internalLinkTypeGame InternalLinkTypeGame
-		err := json.Unmarshal(*rawMsg, &internalLinkTypeGame)
+		err := jsonUnmarshal(*rawMsg, &internalLinkTypeGame)
 		return &internalLinkTypeGame, err
 
 	case InternalLinkTypeLanguagePackType:
 		var internalLinkTypeLanguagePack InternalLinkTypeLanguagePack
-		err := json.Unmarshal(*rawMsg, &internalLinkTypeLanguagePack)
+		err := jsonUnmarshal(*rawMsg, &internalLinkTypeLanguagePack)
 		return &internalLinkTypeLanguagePack, err
 
 	case InternalLinkTypeMessageType:
 		var internalLinkTypeMessage InternalLinkTypeMessage
-		err := json.Unmarshal(*rawMsg, &internalLinkTypeMessage)
+		err := jsonUnmarshal(*rawMsg, &internalLinkTypeMessage)
 		return &internalLinkTypeMessage, err
 
 	case InternalLinkTypeMessageDraftType:
 		var internalLinkTypeMessageDraft InternalLinkTypeMessageDraft
-		err := json.Unmarshal(*rawMsg, &internalLinkTypeMessageDraft)
+		err := jsonUnmarshal(*rawMsg, &internalLinkTypeMessageDraft)
 		return &internalLinkTypeMessageDraft, err
 
 	case InternalLinkTypePassportDataRequestType:
 		var internalLinkTypePassportDataRequest InternalLinkTypePassportDataRequest
-		err := json.Unmarshal(*rawMsg, &internalLinkTypePassportDataRequest)
+		err := jsonUnmarshal(*rawMsg, &internalLinkTypePassportDataRequest)
 		return &internalLinkTypePassportDataRequest, err
 
 	case InternalLinkTypePhoneNumberConfirmationType:
 		var internalLinkTypePhoneNumberConfirmation InternalLinkTypePhoneNumberConfirmation
-		err := json.Unmarshal(*rawMsg, &internalLinkTypePhoneNumberConfirmation)
+		err := jsonUnmarshal(*rawMsg, &internalLinkTypePhoneNumberConfirmation)
 		return &internalLinkTypePhoneNumberConfirmation, err
 
 	case InternalLinkTypeProxyType:
 		var internalLinkTypeProxy InternalLinkTypeProxy
-		err := json.Unmarshal(*rawMsg, &internalLinkTypeProxy)
+		err := jsonUnmarshal(*rawMsg, &internalLinkTypeProxy)
 		return &internalLinkTypeProxy, err
 
 	case InternalLinkTypePublicChatType:
 		var internalLinkTypePublicChat InternalLinkTypePublicChat
-		err := json.Unmarshal(*rawMsg, &internalLinkTypePublicChat)
+		err := jsonUnmarshal(*rawMsg, &internalLinkTypePublicChat)
 		return &internalLinkTypePublicChat, err
 
 	case InternalLinkTypeQrCodeAuthenticationType:
 		var internalLinkTypeQrCodeAuthentication InternalLinkTypeQrCodeAuthentication
-		err := json.Unmarshal(*rawMsg, &internalLinkTypeQrCodeAuthentication)
+		err := jsonUnmarshal(*rawMsg, &internalLinkTypeQrCodeAuthentication)
 		return &internalLinkTypeQrCodeAuthentication, err
 
 	case InternalLinkTypeSettingsType:
 		var internalLinkTypeSettings InternalLinkTypeSettings
-		err := json.Unmarshal(*rawMsg, &internalLinkTypeSettings)
+		err := jsonUnmarshal(*rawMsg, &internalLinkTypeSettings)
 		return &internalLinkTypeSettings, err
 
 	case InternalLinkTypeStickerSetType:
 		var internalLinkTypeStickerSet InternalLinkTypeStickerSet
-		err := json.Unmarshal(*rawMsg, &internalLinkTypeStickerSet)
+		err := jsonUnmarshal(*rawMsg, &internalLinkTypeStickerSet)
 		return &internalLinkTypeStickerSet, err
 
 	case InternalLinkTypeThemeType:
 		var internalLinkTypeTheme InternalLinkTypeTheme
-		err := json.Unmarshal(*rawMsg, &internalLinkTypeTheme)
+		err := jsonUnmarshal(*rawMsg, &internalLinkTypeTheme)
 		return &internalLinkTypeTheme, err
 
 	case InternalLinkTypeThemeSettingsType:
 		var internalLinkTypeThemeSettings InternalLinkTypeThemeSettings
-		err := json.Unmarshal(*rawMsg, &internalLinkTypeThemeSettings)
+		err := jsonUnmarshal(*rawMsg, &internalLinkTypeThemeSettings)
 		return &internalLinkTypeThemeSettings, err
 
 	case InternalLinkTypeUnknownDeepLinkType:
 		var internalLinkTypeUnknownDeepLink InternalLinkTypeUnknownDeepLink
-		err := json.Unmarshal(*rawMsg, &internalLinkTypeUnknownDeepLink)
+		err := jsonUnmarshal(*rawMsg, &internalLinkTypeUnknownDeepLink)
 		return &internalLinkTypeUnknownDeepLink, err
 
 	case InternalLinkTypeUnsupportedProxyType:
 		var internalLinkTypeUnsupportedProxy InternalLinkTypeUnsupportedProxy
-		err := json.Unmarshal(*rawMsg, &internalLinkTypeUnsupportedProxy)
+		err := jsonUnmarshal(*rawMsg, &internalLinkTypeUnsupportedProxy)
 		return &internalLinkTypeUnsupportedProxy, err
 
 	case InternalLinkTypeVideoChatType:
 		var internalLinkTypeVideoChat InternalLinkTypeVideoChat
-		err := json.Unmarshal(*rawMsg, &internalLinkTypeVideoChat)
+		err := jsonUnmarshal(*rawMsg, &internalLinkTypeVideoChat)
 		return &internalLinkTypeVideoChat, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownInternalLinkType{Unknown: unknown}, nil
 	}
 }
 
+func decodeInternalLinkType(r *jsonReader) (InternalLinkType, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalInternalLinkType(rawMsg)
+}
+
 // InternalLinkTypeActiveSessions The link is a link to the active sessions section of the app. Use getActiveSessions to handle the link
 type InternalLinkTypeActiveSessions struct {
 	tdCommon
@@ -189,7 +242,6 @@ func (internalLinkTypeActiveSessions *InternalLinkTypeActiveSessions) MessageTyp
 }
 
 // NewInternalLinkTypeActiveSessions creates a new InternalLinkTypeActiveSessions
-//
 func NewInternalLinkTypeActiveSessions() *InternalLinkTypeActiveSessions {
 	internalLinkTypeActiveSessionsTemp := InternalLinkTypeActiveSessions{
 		tdCommon: tdCommon{Type: "internalLinkTypeActiveSessions"},
@@ -198,11 +250,107 @@ func NewInternalLinkTypeActiveSessions() *InternalLinkTypeActiveSessions {
 	return &internalLinkTypeActiveSessionsTemp
 }
 
+// MarshalJSON marshals to json
+func (internalLinkTypeActiveSessions *InternalLinkTypeActiveSessions) MarshalJSON() ([]byte, error) {
+	return internalLinkTypeActiveSessions.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (internalLinkTypeActiveSessions *InternalLinkTypeActiveSessions) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, internalLinkTypeActiveSessions)
+}
+
+func (internalLinkTypeActiveSessions *InternalLinkTypeActiveSessions) appendJSON(b []byte) ([]byte, error) {
+	if internalLinkTypeActiveSessions == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, internalLinkTypeActiveSessions.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, internalLinkTypeActiveSessions.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (internalLinkTypeActiveSessions *InternalLinkTypeActiveSessions) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			internalLinkTypeActiveSessions.tdCommon.Type, err = r.readString()
+		case "@extra":
+			internalLinkTypeActiveSessions.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (internalLinkTypeActiveSessions *InternalLinkTypeActiveSessions) Clone() *InternalLinkTypeActiveSessions {
+	if internalLinkTypeActiveSessions == nil {
+		return nil
+	}
+	clone := *internalLinkTypeActiveSessions
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (internalLinkTypeActiveSessions *InternalLinkTypeActiveSessions) Equal(other *InternalLinkTypeActiveSessions) bool {
+	if internalLinkTypeActiveSessions == nil || other == nil {
+		return internalLinkTypeActiveSessions == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (internalLinkTypeActiveSessions *InternalLinkTypeActiveSessions) Diff(other *InternalLinkTypeActiveSessions) []Change {
+	return internalLinkTypeActiveSessions.diff("", other, nil)
+}
+
+func (internalLinkTypeActiveSessions *InternalLinkTypeActiveSessions) diff(path string, other *InternalLinkTypeActiveSessions, changes []Change) []Change {
+	if internalLinkTypeActiveSessions == nil || other == nil {
+		if internalLinkTypeActiveSessions != other {
+			changes = append(changes, Change{Path: path, Old: internalLinkTypeActiveSessions, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (internalLinkTypeActiveSessions *InternalLinkTypeActiveSessions) String() string {
+	return objectString(internalLinkTypeActiveSessions)
+}
+
+// LogValue logs the object as its summary
+func (internalLinkTypeActiveSessions *InternalLinkTypeActiveSessions) LogValue() slog.Value {
+	return slog.StringValue(objectString(internalLinkTypeActiveSessions))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (internalLinkTypeActiveSessions *InternalLinkTypeActiveSessions) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, internalLinkTypeActiveSessions)
+}
+
+func (internalLinkTypeActiveSessions *InternalLinkTypeActiveSessions) printText(p *printer) {
+	if internalLinkTypeActiveSessions == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InternalLinkTypeActiveSessions")
+	p.endObject(state)
+}
+
 // GetInternalLinkTypeEnum return the enum type of this object
 func (internalLinkTypeActiveSessions *InternalLinkTypeActiveSessions) GetInternalLinkTypeEnum() InternalLinkTypeEnum {
 	return InternalLinkTypeActiveSessionsType
 }
 
+// Accept calls the method of the visitor for InternalLinkTypeActiveSessions
+func (internalLinkTypeActiveSessions *InternalLinkTypeActiveSessions) Accept(visitor InternalLinkTypeVisitor) {
+	visitor.VisitInternalLinkTypeActiveSessions(internalLinkTypeActiveSessions)
+}
+
 // InternalLinkTypeAuthenticationCode The link contains an authentication code. Call checkAuthenticationCode with the code if the current authorization state is authorizationStateWaitCode
 type InternalLinkTypeAuthenticationCode struct {
 	tdCommon
@@ -226,11 +374,118 @@ func NewInternalLinkTypeAuthenticationCode(code string) *InternalLinkTypeAuthent
 	return &internalLinkTypeAuthenticationCodeTemp
 }
 
+// MarshalJSON marshals to json
+func (internalLinkTypeAuthenticationCode *InternalLinkTypeAuthenticationCode) MarshalJSON() ([]byte, error) {
+	return internalLinkTypeAuthenticationCode.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (internalLinkTypeAuthenticationCode *InternalLinkTypeAuthenticationCode) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, internalLinkTypeAuthenticationCode)
+}
+
+func (internalLinkTypeAuthenticationCode *InternalLinkTypeAuthenticationCode) appendJSON(b []byte) ([]byte, error) {
+	if internalLinkTypeAuthenticationCode == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, internalLinkTypeAuthenticationCode.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, internalLinkTypeAuthenticationCode.tdCommon.Extra)
+	b = append(b, `,"code":`...)
+	b = appendJSONString(b, internalLinkTypeAuthenticationCode.Code)
+	return append(b, '}'), nil
+}
+
+func (internalLinkTypeAuthenticationCode *InternalLinkTypeAuthenticationCode) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			internalLinkTypeAuthenticationCode.tdCommon.Type, err = r.readString()
+		case "@extra":
+			internalLinkTypeAuthenticationCode.tdCommon.Extra, err = r.readString()
+		case "code":
+			internalLinkTypeAuthenticationCode.Code, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (internalLinkTypeAuthenticationCode *InternalLinkTypeAuthenticationCode) Clone() *InternalLinkTypeAuthenticationCode {
+	if internalLinkTypeAuthenticationCode == nil {
+		return nil
+	}
+	clone := *internalLinkTypeAuthenticationCode
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (internalLinkTypeAuthenticationCode *InternalLinkTypeAuthenticationCode) Equal(other *InternalLinkTypeAuthenticationCode) bool {
+	if internalLinkTypeAuthenticationCode == nil || other == nil {
+		return internalLinkTypeAuthenticationCode == other
+	}
+	if internalLinkTypeAuthenticationCode.Code != other.Code {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (internalLinkTypeAuthenticationCode *InternalLinkTypeAuthenticationCode) Diff(other *InternalLinkTypeAuthenticationCode) []Change {
+	return internalLinkTypeAuthenticationCode.diff("", other, nil)
+}
+
+func (internalLinkTypeAuthenticationCode *InternalLinkTypeAuthenticationCode) diff(path string, other *InternalLinkTypeAuthenticationCode, changes []Change) []Change {
+	if internalLinkTypeAuthenticationCode == nil || other == nil {
+		if internalLinkTypeAuthenticationCode != other {
+			changes = append(changes, Change{Path: path, Old: internalLinkTypeAuthenticationCode, New: other})
+		}
+		return changes
+	}
+	if internalLinkTypeAuthenticationCode.Code != other.Code {
+		changes = append(changes, Change{Path: fieldPath(path, "code"), Old: internalLinkTypeAuthenticationCode.Code, New: other.Code})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (internalLinkTypeAuthenticationCode *InternalLinkTypeAuthenticationCode) String() string {
+	return objectString(internalLinkTypeAuthenticationCode)
+}
+
+// LogValue logs the object as its summary
+func (internalLinkTypeAuthenticationCode *InternalLinkTypeAuthenticationCode) LogValue() slog.Value {
+	return slog.StringValue(objectString(internalLinkTypeAuthenticationCode))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (internalLinkTypeAuthenticationCode *InternalLinkTypeAuthenticationCode) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, internalLinkTypeAuthenticationCode)
+}
+
+func (internalLinkTypeAuthenticationCode *InternalLinkTypeAuthenticationCode) printText(p *printer) {
+	if internalLinkTypeAuthenticationCode == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InternalLinkTypeAuthenticationCode")
+	p.string("code", internalLinkTypeAuthenticationCode.Code)
+	p.endObject(state)
+}
+
 // GetInternalLinkTypeEnum return the enum type of this object
 func (internalLinkTypeAuthenticationCode *InternalLinkTypeAuthenticationCode) GetInternalLinkTypeEnum() InternalLinkTypeEnum {
 	return InternalLinkTypeAuthenticationCodeType
 }
 
+// Accept calls the method of the visitor for InternalLinkTypeAuthenticationCode
+func (internalLinkTypeAuthenticationCode *InternalLinkTypeAuthenticationCode) Accept(visitor InternalLinkTypeVisitor) {
+	visitor.VisitInternalLinkTypeAuthenticationCode(internalLinkTypeAuthenticationCode)
+}
+
 // InternalLinkTypeBackground The link is a link to a background. Call searchBackground with the given background name to process the link
 type InternalLinkTypeBackground struct {
 	tdCommon
@@ -254,11 +509,118 @@ func NewInternalLinkTypeBackground(backgroundName string) *InternalLinkTypeBackg
 	return &internalLinkTypeBackgroundTemp
 }
 
+// MarshalJSON marshals to json
+func (internalLinkTypeBackground *InternalLinkTypeBackground) MarshalJSON() ([]byte, error) {
+	return internalLinkTypeBackground.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (internalLinkTypeBackground *InternalLinkTypeBackground) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, internalLinkTypeBackground)
+}
+
+func (internalLinkTypeBackground *InternalLinkTypeBackground) appendJSON(b []byte) ([]byte, error) {
+	if internalLinkTypeBackground == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, internalLinkTypeBackground.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, internalLinkTypeBackground.tdCommon.Extra)
+	b = append(b, `,"background_name":`...)
+	b = appendJSONString(b, internalLinkTypeBackground.BackgroundName)
+	return append(b, '}'), nil
+}
+
+func (internalLinkTypeBackground *InternalLinkTypeBackground) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			internalLinkTypeBackground.tdCommon.Type, err = r.readString()
+		case "@extra":
+			internalLinkTypeBackground.tdCommon.Extra, err = r.readString()
+		case "background_name":
+			internalLinkTypeBackground.BackgroundName, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (internalLinkTypeBackground *InternalLinkTypeBackground) Clone() *InternalLinkTypeBackground {
+	if internalLinkTypeBackground == nil {
+		return nil
+	}
+	clone := *internalLinkTypeBackground
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (internalLinkTypeBackground *InternalLinkTypeBackground) Equal(other *InternalLinkTypeBackground) bool {
+	if internalLinkTypeBackground == nil || other == nil {
+		return internalLinkTypeBackground == other
+	}
+	if internalLinkTypeBackground.BackgroundName != other.BackgroundName {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (internalLinkTypeBackground *InternalLinkTypeBackground) Diff(other *InternalLinkTypeBackground) []Change {
+	return internalLinkTypeBackground.diff("", other, nil)
+}
+
+func (internalLinkTypeBackground *InternalLinkTypeBackground) diff(path string, other *InternalLinkTypeBackground, changes []Change) []Change {
+	if internalLinkTypeBackground == nil || other == nil {
+		if internalLinkTypeBackground != other {
+			changes = append(changes, Change{Path: path, Old: internalLinkTypeBackground, New: other})
+		}
+		return changes
+	}
+	if internalLinkTypeBackground.BackgroundName != other.BackgroundName {
+		changes = append(changes, Change{Path: fieldPath(path, "background_name"), Old: internalLinkTypeBackground.BackgroundName, New: other.BackgroundName})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (internalLinkTypeBackground *InternalLinkTypeBackground) String() string {
+	return objectString(internalLinkTypeBackground)
+}
+
+// LogValue logs the object as its summary
+func (internalLinkTypeBackground *InternalLinkTypeBackground) LogValue() slog.Value {
+	return slog.StringValue(objectString(internalLinkTypeBackground))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (internalLinkTypeBackground *InternalLinkTypeBackground) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, internalLinkTypeBackground)
+}
+
+func (internalLinkTypeBackground *InternalLinkTypeBackground) printText(p *printer) {
+	if internalLinkTypeBackground == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InternalLinkTypeBackground")
+	p.string("background_name", internalLinkTypeBackground.BackgroundName)
+	p.endObject(state)
+}
+
 // GetInternalLinkTypeEnum return the enum type of this object
 func (internalLinkTypeBackground *InternalLinkTypeBackground) GetInternalLinkTypeEnum() InternalLinkTypeEnum {
 	return InternalLinkTypeBackgroundType
 }
 
+// Accept calls the method of the visitor for InternalLinkTypeBackground
+func (internalLinkTypeBackground *InternalLinkTypeBackground) Accept(visitor InternalLinkTypeVisitor) {
+	visitor.VisitInternalLinkTypeBackground(internalLinkTypeBackground)
+}
+
 // InternalLinkTypeBotStart The link is a link to a chat with a Telegram bot. Call searchPublicChat with the given bot username, check that the user is a bot, show START button in the chat with the bot, and then call sendBotStartMessage with the given start parameter after the button is pressed
 type InternalLinkTypeBotStart struct {
 	tdCommon
@@ -285,11 +647,129 @@ func NewInternalLinkTypeBotStart(botUsername string, startParameter string) *Int
 	return &internalLinkTypeBotStartTemp
 }
 
+// MarshalJSON marshals to json
+func (internalLinkTypeBotStart *InternalLinkTypeBotStart) MarshalJSON() ([]byte, error) {
+	return internalLinkTypeBotStart.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (internalLinkTypeBotStart *InternalLinkTypeBotStart) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, internalLinkTypeBotStart)
+}
+
+func (internalLinkTypeBotStart *InternalLinkTypeBotStart) appendJSON(b []byte) ([]byte, error) {
+	if internalLinkTypeBotStart == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, internalLinkTypeBotStart.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, internalLinkTypeBotStart.tdCommon.Extra)
+	b = append(b, `,"bot_username":`...)
+	b = appendJSONString(b, internalLinkTypeBotStart.BotUsername)
+	b = append(b, `,"start_parameter":`...)
+	b = appendJSONString(b, internalLinkTypeBotStart.StartParameter)
+	return append(b, '}'), nil
+}
+
+func (internalLinkTypeBotStart *InternalLinkTypeBotStart) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			internalLinkTypeBotStart.tdCommon.Type, err = r.readString()
+		case "@extra":
+			internalLinkTypeBotStart.tdCommon.Extra, err = r.readString()
+		case "bot_username":
+			internalLinkTypeBotStart.BotUsername, err = r.readString()
+		case "start_parameter":
+			internalLinkTypeBotStart.StartParameter, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (internalLinkTypeBotStart *InternalLinkTypeBotStart) Clone() *InternalLinkTypeBotStart {
+	if internalLinkTypeBotStart == nil {
+		return nil
+	}
+	clone := *internalLinkTypeBotStart
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (internalLinkTypeBotStart *InternalLinkTypeBotStart) Equal(other *InternalLinkTypeBotStart) bool {
+	if internalLinkTypeBotStart == nil || other == nil {
+		return internalLinkTypeBotStart == other
+	}
+	if internalLinkTypeBotStart.BotUsername != other.BotUsername {
+		return false
+	}
+	if internalLinkTypeBotStart.StartParameter != other.StartParameter {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (internalLinkTypeBotStart *InternalLinkTypeBotStart) Diff(other *InternalLinkTypeBotStart) []Change {
+	return internalLinkTypeBotStart.diff("", other, nil)
+}
+
+func (internalLinkTypeBotStart *InternalLinkTypeBotStart) diff(path string, other *InternalLinkTypeBotStart, changes []Change) []Change {
+	if internalLinkTypeBotStart == nil || other == nil {
+		if internalLinkTypeBotStart != other {
+			changes = append(changes, Change{Path: path, Old: internalLinkTypeBotStart, New: other})
+		}
+		return changes
+	}
+	if internalLinkTypeBotStart.BotUsername != other.BotUsername {
+		changes = append(changes, Change{Path: fieldPath(path, "bot_username"), Old: internalLinkTypeBotStart.BotUsername, New: other.BotUsername})
+	}
+	if internalLinkTypeBotStart.StartParameter != other.StartParameter {
+		changes = append(changes, Change{Path: fieldPath(path, "start_parameter"), Old: internalLinkTypeBotStart.StartParameter, New: other.StartParameter})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (internalLinkTypeBotStart *InternalLinkTypeBotStart) String() string {
+	return objectString(internalLinkTypeBotStart)
+}
+
+// LogValue logs the object as its summary
+func (internalLinkTypeBotStart *InternalLinkTypeBotStart) LogValue() slog.Value {
+	return slog.StringValue(objectString(internalLinkTypeBotStart))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (internalLinkTypeBotStart *InternalLinkTypeBotStart) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, internalLinkTypeBotStart)
+}
+
+func (internalLinkTypeBotStart *InternalLinkTypeBotStart) printText(p *printer) {
+	if internalLinkTypeBotStart == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InternalLinkTypeBotStart")
+	p.string("bot_username", internalLinkTypeBotStart.BotUsername)
+	p.string("start_parameter", internalLinkTypeBotStart.StartParameter)
+	p.endObject(state)
+}
+
 // GetInternalLinkTypeEnum return the enum type of this object
 func (internalLinkTypeBotStart *InternalLinkTypeBotStart) GetInternalLinkTypeEnum() InternalLinkTypeEnum {
 	return InternalLinkTypeBotStartType
 }
 
+// Accept calls the method of the visitor for InternalLinkTypeBotStart
+func (internalLinkTypeBotStart *InternalLinkTypeBotStart) Accept(visitor InternalLinkTypeVisitor) {
+	visitor.VisitInternalLinkTypeBotStart(internalLinkTypeBotStart)
+}
+
 // InternalLinkTypeBotStartInGroup The link is a link to a Telegram bot, which is supposed to be added to a group chat. Call searchPublicChat with the given bot username, check that the user is a bot and can be added to groups, ask the current user to select a group to add the bot to, and then call sendBotStartMessage with the given start parameter and the chosen group chat. Bots can be added to a public group only by administrators of the group
 type InternalLinkTypeBotStartInGroup struct {
 	tdCommon
@@ -316,11 +796,129 @@ func NewInternalLinkTypeBotStartInGroup(botUsername string, startParameter strin
 	return &internalLinkTypeBotStartInGroupTemp
 }
 
+// MarshalJSON marshals to json
+func (internalLinkTypeBotStartInGroup *InternalLinkTypeBotStartInGroup) MarshalJSON() ([]byte, error) {
+	return internalLinkTypeBotStartInGroup.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (internalLinkTypeBotStartInGroup *InternalLinkTypeBotStartInGroup) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, internalLinkTypeBotStartInGroup)
+}
+
+func (internalLinkTypeBotStartInGroup *InternalLinkTypeBotStartInGroup) appendJSON(b []byte) ([]byte, error) {
+	if internalLinkTypeBotStartInGroup == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, internalLinkTypeBotStartInGroup.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, internalLinkTypeBotStartInGroup.tdCommon.Extra)
+	b = append(b, `,"bot_username":`...)
+	b = appendJSONString(b, internalLinkTypeBotStartInGroup.BotUsername)
+	b = append(b, `,"start_parameter":`...)
+	b = appendJSONString(b, internalLinkTypeBotStartInGroup.StartParameter)
+	return append(b, '}'), nil
+}
+
+func (internalLinkTypeBotStartInGroup *InternalLinkTypeBotStartInGroup) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			internalLinkTypeBotStartInGroup.tdCommon.Type, err = r.readString()
+		case "@extra":
+			internalLinkTypeBotStartInGroup.tdCommon.Extra, err = r.readString()
+		case "bot_username":
+			internalLinkTypeBotStartInGroup.BotUsername, err = r.readString()
+		case "start_parameter":
+			internalLinkTypeBotStartInGroup.StartParameter, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (internalLinkTypeBotStartInGroup *InternalLinkTypeBotStartInGroup) Clone() *InternalLinkTypeBotStartInGroup {
+	if internalLinkTypeBotStartInGroup == nil {
+		return nil
+	}
+	clone := *internalLinkTypeBotStartInGroup
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (internalLinkTypeBotStartInGroup *InternalLinkTypeBotStartInGroup) Equal(other *InternalLinkTypeBotStartInGroup) bool {
+	if internalLinkTypeBotStartInGroup == nil || other == nil {
+		return internalLinkTypeBotStartInGroup == other
+	}
+	if internalLinkTypeBotStartInGroup.BotUsername != other.BotUsername {
+		return false
+	}
+	if internalLinkTypeBotStartInGroup.StartParameter != other.StartParameter {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (internalLinkTypeBotStartInGroup *InternalLinkTypeBotStartInGroup) Diff(other *InternalLinkTypeBotStartInGroup) []Change {
+	return internalLinkTypeBotStartInGroup.diff("", other, nil)
+}
+
+func (internalLinkTypeBotStartInGroup *InternalLinkTypeBotStartInGroup) diff(path string, other *InternalLinkTypeBotStartInGroup, changes []Change) []Change {
+	if internalLinkTypeBotStartInGroup == nil || other == nil {
+		if internalLinkTypeBotStartInGroup != other {
+			changes = append(changes, Change{Path: path, Old: internalLinkTypeBotStartInGroup, New: other})
+		}
+		return changes
+	}
+	if internalLinkTypeBotStartInGroup.BotUsername != other.BotUsername {
+		changes = append(changes, Change{Path: fieldPath(path, "bot_username"), Old: internalLinkTypeBotStartInGroup.BotUsername, New: other.BotUsername})
+	}
+	if internalLinkTypeBotStartInGroup.StartParameter != other.StartParameter {
+		changes = append(changes, Change{Path: fieldPath(path, "start_parameter"), Old: internalLinkTypeBotStartInGroup.StartParameter, New: other.StartParameter})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (internalLinkTypeBotStartInGroup *InternalLinkTypeBotStartInGroup) String() string {
+	return objectString(internalLinkTypeBotStartInGroup)
+}
+
+// LogValue logs the object as its summary
+func (internalLinkTypeBotStartInGroup *InternalLinkTypeBotStartInGroup) LogValue() slog.Value {
+	return slog.StringValue(objectString(internalLinkTypeBotStartInGroup))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (internalLinkTypeBotStartInGroup *InternalLinkTypeBotStartInGroup) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, internalLinkTypeBotStartInGroup)
+}
+
+func (internalLinkTypeBotStartInGroup *InternalLinkTypeBotStartInGroup) printText(p *printer) {
+	if internalLinkTypeBotStartInGroup == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InternalLinkTypeBotStartInGroup")
+	p.string("bot_username", internalLinkTypeBotStartInGroup.BotUsername)
+	p.string("start_parameter", internalLinkTypeBotStartInGroup.StartParameter)
+	p.endObject(state)
+}
+
 // GetInternalLinkTypeEnum return the enum type of this object
 func (internalLinkTypeBotStartInGroup *InternalLinkTypeBotStartInGroup) GetInternalLinkTypeEnum() InternalLinkTypeEnum {
 	return InternalLinkTypeBotStartInGroupType
 }
 
+// Accept calls the method of the visitor for InternalLinkTypeBotStartInGroup
+func (internalLinkTypeBotStartInGroup *InternalLinkTypeBotStartInGroup) Accept(visitor InternalLinkTypeVisitor) {
+	visitor.VisitInternalLinkTypeBotStartInGroup(internalLinkTypeBotStartInGroup)
+}
+
 // InternalLinkTypeChangePhoneNumber The link is a link to the change phone number section of the app
 type InternalLinkTypeChangePhoneNumber struct {
 	tdCommon
@@ -332,7 +930,6 @@ func (internalLinkTypeChangePhoneNumber *InternalLinkTypeChangePhoneNumber) Mess
 }
 
 // NewInternalLinkTypeChangePhoneNumber creates a new InternalLinkTypeChangePhoneNumber
-//
 func NewInternalLinkTypeChangePhoneNumber() *InternalLinkTypeChangePhoneNumber {
 	internalLinkTypeChangePhoneNumberTemp := InternalLinkTypeChangePhoneNumber{
 		tdCommon: tdCommon{Type: "internalLinkTypeChangePhoneNumber"},
@@ -341,11 +938,107 @@ func NewInternalLinkTypeChangePhoneNumber() *InternalLinkTypeChangePhoneNumber {
 	return &internalLinkTypeChangePhoneNumberTemp
 }
 
+// MarshalJSON marshals to json
+func (internalLinkTypeChangePhoneNumber *InternalLinkTypeChangePhoneNumber) MarshalJSON() ([]byte, error) {
+	return internalLinkTypeChangePhoneNumber.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (internalLinkTypeChangePhoneNumber *InternalLinkTypeChangePhoneNumber) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, internalLinkTypeChangePhoneNumber)
+}
+
+func (internalLinkTypeChangePhoneNumber *InternalLinkTypeChangePhoneNumber) appendJSON(b []byte) ([]byte, error) {
+	if internalLinkTypeChangePhoneNumber == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, internalLinkTypeChangePhoneNumber.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, internalLinkTypeChangePhoneNumber.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (internalLinkTypeChangePhoneNumber *InternalLinkTypeChangePhoneNumber) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			internalLinkTypeChangePhoneNumber.tdCommon.Type, err = r.readString()
+		case "@extra":
+			internalLinkTypeChangePhoneNumber.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (internalLinkTypeChangePhoneNumber *InternalLinkTypeChangePhoneNumber) Clone() *InternalLinkTypeChangePhoneNumber {
+	if internalLinkTypeChangePhoneNumber == nil {
+		return nil
+	}
+	clone := *internalLinkTypeChangePhoneNumber
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (internalLinkTypeChangePhoneNumber *InternalLinkTypeChangePhoneNumber) Equal(other *InternalLinkTypeChangePhoneNumber) bool {
+	if internalLinkTypeChangePhoneNumber == nil || other == nil {
+		return internalLinkTypeChangePhoneNumber == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (internalLinkTypeChangePhoneNumber *InternalLinkTypeChangePhoneNumber) Diff(other *InternalLinkTypeChangePhoneNumber) []Change {
+	return internalLinkTypeChangePhoneNumber.diff("", other, nil)
+}
+
+func (internalLinkTypeChangePhoneNumber *InternalLinkTypeChangePhoneNumber) diff(path string, other *InternalLinkTypeChangePhoneNumber, changes []Change) []Change {
+	if internalLinkTypeChangePhoneNumber == nil || other == nil {
+		if internalLinkTypeChangePhoneNumber != other {
+			changes = append(changes, Change{Path: path, Old: internalLinkTypeChangePhoneNumber, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (internalLinkTypeChangePhoneNumber *InternalLinkTypeChangePhoneNumber) String() string {
+	return objectString(internalLinkTypeChangePhoneNumber)
+}
+
+// LogValue logs the object as its summary
+func (internalLinkTypeChangePhoneNumber *InternalLinkTypeChangePhoneNumber) LogValue() slog.Value {
+	return slog.StringValue(objectString(internalLinkTypeChangePhoneNumber))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (internalLinkTypeChangePhoneNumber *InternalLinkTypeChangePhoneNumber) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, internalLinkTypeChangePhoneNumber)
+}
+
+func (internalLinkTypeChangePhoneNumber *InternalLinkTypeChangePhoneNumber) printText(p *printer) {
+	if internalLinkTypeChangePhoneNumber == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InternalLinkTypeChangePhoneNumber")
+	p.endObject(state)
+}
+
 // GetInternalLinkTypeEnum return the enum type of this object
 func (internalLinkTypeChangePhoneNumber *InternalLinkTypeChangePhoneNumber) GetInternalLinkTypeEnum() InternalLinkTypeEnum {
 	return InternalLinkTypeChangePhoneNumberType
 }
 
+// Accept calls the method of the visitor for InternalLinkTypeChangePhoneNumber
+func (internalLinkTypeChangePhoneNumber *InternalLinkTypeChangePhoneNumber) Accept(visitor InternalLinkTypeVisitor) {
+	visitor.VisitInternalLinkTypeChangePhoneNumber(internalLinkTypeChangePhoneNumber)
+}
+
 // InternalLinkTypeChatInvite The link is a chat invite link. Call checkChatInviteLink with the given invite link to process the link
 type InternalLinkTypeChatInvite struct {
 	tdCommon
@@ -369,29 +1062,226 @@ func NewInternalLinkTypeChatInvite(inviteLink string) *InternalLinkTypeChatInvit
 	return &internalLinkTypeChatInviteTemp
 }
 
-// GetInternalLinkTypeEnum return the enum type of this object
-func (internalLinkTypeChatInvite *InternalLinkTypeChatInvite) GetInternalLinkTypeEnum() InternalLinkTypeEnum {
-	return InternalLinkTypeChatInviteType
+// MarshalJSON marshals to json
+func (internalLinkTypeChatInvite *InternalLinkTypeChatInvite) MarshalJSON() ([]byte, error) {
+	return internalLinkTypeChatInvite.appendJSON(nil)
 }
 
-// InternalLinkTypeFilterSettings The link is a link to the filter settings section of the app
-type InternalLinkTypeFilterSettings struct {
-	tdCommon
+// UnmarshalJSON unmarshal to json
+func (internalLinkTypeChatInvite *InternalLinkTypeChatInvite) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, internalLinkTypeChatInvite)
 }
 
-// MessageType return the string telegram-type of InternalLinkTypeFilterSettings
-func (internalLinkTypeFilterSettings *InternalLinkTypeFilterSettings) MessageType() string {
-	return "internalLinkTypeFilterSettings"
+func (internalLinkTypeChatInvite *InternalLinkTypeChatInvite) appendJSON(b []byte) ([]byte, error) {
+	if internalLinkTypeChatInvite == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, internalLinkTypeChatInvite.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, internalLinkTypeChatInvite.tdCommon.Extra)
+	b = append(b, `,"invite_link":`...)
+	b = appendJSONString(b, internalLinkTypeChatInvite.InviteLink)
+	return append(b, '}'), nil
 }
 
-// NewInternalLinkTypeFilterSettings creates a new InternalLinkTypeFilterSettings
-//
-func NewInternalLinkTypeFilterSettings() *InternalLinkTypeFilterSettings {
-	internalLinkTypeFilterSettingsTemp := InternalLinkTypeFilterSettings{
-		tdCommon: tdCommon{Type: "internalLinkTypeFilterSettings"},
+func (internalLinkTypeChatInvite *InternalLinkTypeChatInvite) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			internalLinkTypeChatInvite.tdCommon.Type, err = r.readString()
+		case "@extra":
+			internalLinkTypeChatInvite.tdCommon.Extra, err = r.readString()
+		case "invite_link":
+			internalLinkTypeChatInvite.InviteLink, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (internalLinkTypeChatInvite *InternalLinkTypeChatInvite) Clone() *InternalLinkTypeChatInvite {
+	if internalLinkTypeChatInvite == nil {
+		return nil
 	}
+	clone := *internalLinkTypeChatInvite
+	return &clone
+}
 
-	return &internalLinkTypeFilterSettingsTemp
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (internalLinkTypeChatInvite *InternalLinkTypeChatInvite) Equal(other *InternalLinkTypeChatInvite) bool {
+	if internalLinkTypeChatInvite == nil || other == nil {
+		return internalLinkTypeChatInvite == other
+	}
+	if internalLinkTypeChatInvite.InviteLink != other.InviteLink {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (internalLinkTypeChatInvite *InternalLinkTypeChatInvite) Diff(other *InternalLinkTypeChatInvite) []Change {
+	return internalLinkTypeChatInvite.diff("", other, nil)
+}
+
+func (internalLinkTypeChatInvite *InternalLinkTypeChatInvite) diff(path string, other *InternalLinkTypeChatInvite, changes []Change) []Change {
+	if internalLinkTypeChatInvite == nil || other == nil {
+		if internalLinkTypeChatInvite != other {
+			changes = append(changes, Change{Path: path, Old: internalLinkTypeChatInvite, New: other})
+		}
+		return changes
+	}
+	if internalLinkTypeChatInvite.InviteLink != other.InviteLink {
+		changes = append(changes, Change{Path: fieldPath(path, "invite_link"), Old: internalLinkTypeChatInvite.InviteLink, New: other.InviteLink})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (internalLinkTypeChatInvite *InternalLinkTypeChatInvite) String() string {
+	return objectString(internalLinkTypeChatInvite)
+}
+
+// LogValue logs the object as its summary
+func (internalLinkTypeChatInvite *InternalLinkTypeChatInvite) LogValue() slog.Value {
+	return slog.StringValue(objectString(internalLinkTypeChatInvite))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (internalLinkTypeChatInvite *InternalLinkTypeChatInvite) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, internalLinkTypeChatInvite)
+}
+
+func (internalLinkTypeChatInvite *InternalLinkTypeChatInvite) printText(p *printer) {
+	if internalLinkTypeChatInvite == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InternalLinkTypeChatInvite")
+	p.string("invite_link", internalLinkTypeChatInvite.InviteLink)
+	p.endObject(state)
+}
+
+// GetInternalLinkTypeEnum return the enum type of this object
+func (internalLinkTypeChatInvite *InternalLinkTypeChatInvite) GetInternalLinkTypeEnum() InternalLinkTypeEnum {
+	return InternalLinkTypeChatInviteType
+}
+
+// Accept calls the method of the visitor for InternalLinkTypeChatInvite
+func (internalLinkTypeChatInvite *InternalLinkTypeChatInvite) Accept(visitor InternalLinkTypeVisitor) {
+	visitor.VisitInternalLinkTypeChatInvite(internalLinkTypeChatInvite)
+}
+
+// InternalLinkTypeFilterSettings The link is a link to the filter settings section of the app
+type InternalLinkTypeFilterSettings struct {
+	tdCommon
+}
+
+// MessageType return the string telegram-type of InternalLinkTypeFilterSettings
+func (internalLinkTypeFilterSettings *InternalLinkTypeFilterSettings) MessageType() string {
+	return "internalLinkTypeFilterSettings"
+}
+
+// NewInternalLinkTypeFilterSettings creates a new InternalLinkTypeFilterSettings
+func NewInternalLinkTypeFilterSettings() *InternalLinkTypeFilterSettings {
+	internalLinkTypeFilterSettingsTemp := InternalLinkTypeFilterSettings{
+		tdCommon: tdCommon{Type: "internalLinkTypeFilterSettings"},
+	}
+
+	return &internalLinkTypeFilterSettingsTemp
+}
+
+// MarshalJSON marshals to json
+func (internalLinkTypeFilterSettings *InternalLinkTypeFilterSettings) MarshalJSON() ([]byte, error) {
+	return internalLinkTypeFilterSettings.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (internalLinkTypeFilterSettings *InternalLinkTypeFilterSettings) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, internalLinkTypeFilterSettings)
+}
+
+func (internalLinkTypeFilterSettings *InternalLinkTypeFilterSettings) appendJSON(b []byte) ([]byte, error) {
+	if internalLinkTypeFilterSettings == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, internalLinkTypeFilterSettings.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, internalLinkTypeFilterSettings.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (internalLinkTypeFilterSettings *InternalLinkTypeFilterSettings) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			internalLinkTypeFilterSettings.tdCommon.Type, err = r.readString()
+		case "@extra":
+			internalLinkTypeFilterSettings.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (internalLinkTypeFilterSettings *InternalLinkTypeFilterSettings) Clone() *InternalLinkTypeFilterSettings {
+	if internalLinkTypeFilterSettings == nil {
+		return nil
+	}
+	clone := *internalLinkTypeFilterSettings
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (internalLinkTypeFilterSettings *InternalLinkTypeFilterSettings) Equal(other *InternalLinkTypeFilterSettings) bool {
+	if internalLinkTypeFilterSettings == nil || other == nil {
+		return internalLinkTypeFilterSettings == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (internalLinkTypeFilterSettings *InternalLinkTypeFilterSettings) Diff(other *InternalLinkTypeFilterSettings) []Change {
+	return internalLinkTypeFilterSettings.diff("", other, nil)
+}
+
+func (internalLinkTypeFilterSettings *InternalLinkTypeFilterSettings) diff(path string, other *InternalLinkTypeFilterSettings, changes []Change) []Change {
+	if internalLinkTypeFilterSettings == nil || other == nil {
+		if internalLinkTypeFilterSettings != other {
+			changes = append(changes, Change{Path: path, Old: internalLinkTypeFilterSettings, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (internalLinkTypeFilterSettings *InternalLinkTypeFilterSettings) String() string {
+	return objectString(internalLinkTypeFilterSettings)
+}
+
+// LogValue logs the object as its summary
+func (internalLinkTypeFilterSettings *InternalLinkTypeFilterSettings) LogValue() slog.Value {
+	return slog.StringValue(objectString(internalLinkTypeFilterSettings))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (internalLinkTypeFilterSettings *InternalLinkTypeFilterSettings) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, internalLinkTypeFilterSettings)
+}
+
+func (internalLinkTypeFilterSettings *InternalLinkTypeFilterSettings) printText(p *printer) {
+	if internalLinkTypeFilterSettings == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InternalLinkTypeFilterSettings")
+	p.endObject(state)
 }
 
 // GetInternalLinkTypeEnum return the enum type of this object
@@ -399,6 +1289,11 @@ func (internalLinkTypeFilterSettings *InternalLinkTypeFilterSettings) GetInterna
 	return InternalLinkTypeFilterSettingsType
 }
 
+// Accept calls the method of the visitor for InternalLinkTypeFilterSettings
+func (internalLinkTypeFilterSettings *InternalLinkTypeFilterSettings) Accept(visitor InternalLinkTypeVisitor) {
+	visitor.VisitInternalLinkTypeFilterSettings(internalLinkTypeFilterSettings)
+}
+
 // InternalLinkTypeGame The link is a link to a game. Call searchPublicChat with the given bot username, check that the user is a bot, ask the current user to select a chat to send the game, and then call sendMessage with inputMessageGame
 type InternalLinkTypeGame struct {
 	tdCommon
@@ -425,11 +1320,129 @@ func NewInternalLinkTypeGame(botUsername string, gameShortName string) *Internal
 	return &internalLinkTypeGameTemp
 }
 
+// MarshalJSON marshals to json
+func (internalLinkTypeGame *InternalLinkTypeGame) MarshalJSON() ([]byte, error) {
+	return internalLinkTypeGame.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (internalLinkTypeGame *InternalLinkTypeGame) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, internalLinkTypeGame)
+}
+
+func (internalLinkTypeGame *InternalLinkTypeGame) appendJSON(b []byte) ([]byte, error) {
+	if internalLinkTypeGame == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, internalLinkTypeGame.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, internalLinkTypeGame.tdCommon.Extra)
+	b = append(b, `,"bot_username":`...)
+	b = appendJSONString(b, internalLinkTypeGame.BotUsername)
+	b = append(b, `,"game_short_name":`...)
+	b = appendJSONString(b, internalLinkTypeGame.GameShortName)
+	return append(b, '}'), nil
+}
+
+func (internalLinkTypeGame *InternalLinkTypeGame) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			internalLinkTypeGame.tdCommon.Type, err = r.readString()
+		case "@extra":
+			internalLinkTypeGame.tdCommon.Extra, err = r.readString()
+		case "bot_username":
+			internalLinkTypeGame.BotUsername, err = r.readString()
+		case "game_short_name":
+			internalLinkTypeGame.GameShortName, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (internalLinkTypeGame *InternalLinkTypeGame) Clone() *InternalLinkTypeGame {
+	if internalLinkTypeGame == nil {
+		return nil
+	}
+	clone := *internalLinkTypeGame
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (internalLinkTypeGame *InternalLinkTypeGame) Equal(other *InternalLinkTypeGame) bool {
+	if internalLinkTypeGame == nil || other == nil {
+		return internalLinkTypeGame == other
+	}
+	if internalLinkTypeGame.BotUsername != other.BotUsername {
+		return false
+	}
+	if internalLinkTypeGame.GameShortName != other.GameShortName {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (internalLinkTypeGame *InternalLinkTypeGame) Diff(other *InternalLinkTypeGame) []Change {
+	return internalLinkTypeGame.diff("", other, nil)
+}
+
+func (internalLinkTypeGame *InternalLinkTypeGame) diff(path string, other *InternalLinkTypeGame, changes []Change) []Change {
+	if internalLinkTypeGame == nil || other == nil {
+		if internalLinkTypeGame != other {
+			changes = append(changes, Change{Path: path, Old: internalLinkTypeGame, New: other})
+		}
+		return changes
+	}
+	if internalLinkTypeGame.BotUsername != other.BotUsername {
+		changes = append(changes, Change{Path: fieldPath(path, "bot_username"), Old: internalLinkTypeGame.BotUsername, New: other.BotUsername})
+	}
+	if internalLinkTypeGame.GameShortName != other.GameShortName {
+		changes = append(changes, Change{Path: fieldPath(path, "game_short_name"), Old: internalLinkTypeGame.GameShortName, New: other.GameShortName})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (internalLinkTypeGame *InternalLinkTypeGame) String() string {
+	return objectString(internalLinkTypeGame)
+}
+
+// LogValue logs the object as its summary
+func (internalLinkTypeGame *InternalLinkTypeGame) LogValue() slog.Value {
+	return slog.StringValue(objectString(internalLinkTypeGame))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (internalLinkTypeGame *InternalLinkTypeGame) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, internalLinkTypeGame)
+}
+
+func (internalLinkTypeGame *InternalLinkTypeGame) printText(p *printer) {
+	if internalLinkTypeGame == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InternalLinkTypeGame")
+	p.string("bot_username", internalLinkTypeGame.BotUsername)
+	p.string("game_short_name", internalLinkTypeGame.GameShortName)
+	p.endObject(state)
+}
+
 // GetInternalLinkTypeEnum return the enum type of this object
 func (internalLinkTypeGame *InternalLinkTypeGame) GetInternalLinkTypeEnum() InternalLinkTypeEnum {
 	return InternalLinkTypeGameType
 }
 
+// Accept calls the method of the visitor for InternalLinkTypeGame
+func (internalLinkTypeGame *InternalLinkTypeGame) Accept(visitor InternalLinkTypeVisitor) {
+	visitor.VisitInternalLinkTypeGame(internalLinkTypeGame)
+}
+
 // InternalLinkTypeLanguagePack The link is a link to a language pack. Call getLanguagePackInfo with the given language pack identifier to process the link
 type InternalLinkTypeLanguagePack struct {
 	tdCommon
@@ -453,11 +1466,118 @@ func NewInternalLinkTypeLanguagePack(languagePackId string) *InternalLinkTypeLan
 	return &internalLinkTypeLanguagePackTemp
 }
 
+// MarshalJSON marshals to json
+func (internalLinkTypeLanguagePack *InternalLinkTypeLanguagePack) MarshalJSON() ([]byte, error) {
+	return internalLinkTypeLanguagePack.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (internalLinkTypeLanguagePack *InternalLinkTypeLanguagePack) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, internalLinkTypeLanguagePack)
+}
+
+func (internalLinkTypeLanguagePack *InternalLinkTypeLanguagePack) appendJSON(b []byte) ([]byte, error) {
+	if internalLinkTypeLanguagePack == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, internalLinkTypeLanguagePack.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, internalLinkTypeLanguagePack.tdCommon.Extra)
+	b = append(b, `,"language_pack_id":`...)
+	b = appendJSONString(b, internalLinkTypeLanguagePack.LanguagePackId)
+	return append(b, '}'), nil
+}
+
+func (internalLinkTypeLanguagePack *InternalLinkTypeLanguagePack) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			internalLinkTypeLanguagePack.tdCommon.Type, err = r.readString()
+		case "@extra":
+			internalLinkTypeLanguagePack.tdCommon.Extra, err = r.readString()
+		case "language_pack_id":
+			internalLinkTypeLanguagePack.LanguagePackId, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (internalLinkTypeLanguagePack *InternalLinkTypeLanguagePack) Clone() *InternalLinkTypeLanguagePack {
+	if internalLinkTypeLanguagePack == nil {
+		return nil
+	}
+	clone := *internalLinkTypeLanguagePack
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (internalLinkTypeLanguagePack *InternalLinkTypeLanguagePack) Equal(other *InternalLinkTypeLanguagePack) bool {
+	if internalLinkTypeLanguagePack == nil || other == nil {
+		return internalLinkTypeLanguagePack == other
+	}
+	if internalLinkTypeLanguagePack.LanguagePackId != other.LanguagePackId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (internalLinkTypeLanguagePack *InternalLinkTypeLanguagePack) Diff(other *InternalLinkTypeLanguagePack) []Change {
+	return internalLinkTypeLanguagePack.diff("", other, nil)
+}
+
+func (internalLinkTypeLanguagePack *InternalLinkTypeLanguagePack) diff(path string, other *InternalLinkTypeLanguagePack, changes []Change) []Change {
+	if internalLinkTypeLanguagePack == nil || other == nil {
+		if internalLinkTypeLanguagePack != other {
+			changes = append(changes, Change{Path: path, Old: internalLinkTypeLanguagePack, New: other})
+		}
+		return changes
+	}
+	if internalLinkTypeLanguagePack.LanguagePackId != other.LanguagePackId {
+		changes = append(changes, Change{Path: fieldPath(path, "language_pack_id"), Old: internalLinkTypeLanguagePack.LanguagePackId, New: other.LanguagePackId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (internalLinkTypeLanguagePack *InternalLinkTypeLanguagePack) String() string {
+	return objectString(internalLinkTypeLanguagePack)
+}
+
+// LogValue logs the object as its summary
+func (internalLinkTypeLanguagePack *InternalLinkTypeLanguagePack) LogValue() slog.Value {
+	return slog.StringValue(objectString(internalLinkTypeLanguagePack))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (internalLinkTypeLanguagePack *InternalLinkTypeLanguagePack) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, internalLinkTypeLanguagePack)
+}
+
+func (internalLinkTypeLanguagePack *InternalLinkTypeLanguagePack) printText(p *printer) {
+	if internalLinkTypeLanguagePack == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InternalLinkTypeLanguagePack")
+	p.string("language_pack_id", internalLinkTypeLanguagePack.LanguagePackId)
+	p.endObject(state)
+}
+
 // GetInternalLinkTypeEnum return the enum type of this object
 func (internalLinkTypeLanguagePack *InternalLinkTypeLanguagePack) GetInternalLinkTypeEnum() InternalLinkTypeEnum {
 	return InternalLinkTypeLanguagePackType
 }
 
+// Accept calls the method of the visitor for InternalLinkTypeLanguagePack
+func (internalLinkTypeLanguagePack *InternalLinkTypeLanguagePack) Accept(visitor InternalLinkTypeVisitor) {
+	visitor.VisitInternalLinkTypeLanguagePack(internalLinkTypeLanguagePack)
+}
+
 // InternalLinkTypeMessage The link is a link to a Telegram message. Call getMessageLinkInfo with the given URL to process the link
 type InternalLinkTypeMessage struct {
 	tdCommon
@@ -481,11 +1601,118 @@ func NewInternalLinkTypeMessage(url string) *InternalLinkTypeMessage {
 	return &internalLinkTypeMessageTemp
 }
 
+// MarshalJSON marshals to json
+func (internalLinkTypeMessage *InternalLinkTypeMessage) MarshalJSON() ([]byte, error) {
+	return internalLinkTypeMessage.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (internalLinkTypeMessage *InternalLinkTypeMessage) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, internalLinkTypeMessage)
+}
+
+func (internalLinkTypeMessage *InternalLinkTypeMessage) appendJSON(b []byte) ([]byte, error) {
+	if internalLinkTypeMessage == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, internalLinkTypeMessage.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, internalLinkTypeMessage.tdCommon.Extra)
+	b = append(b, `,"url":`...)
+	b = appendJSONString(b, internalLinkTypeMessage.Url)
+	return append(b, '}'), nil
+}
+
+func (internalLinkTypeMessage *InternalLinkTypeMessage) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			internalLinkTypeMessage.tdCommon.Type, err = r.readString()
+		case "@extra":
+			internalLinkTypeMessage.tdCommon.Extra, err = r.readString()
+		case "url":
+			internalLinkTypeMessage.Url, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (internalLinkTypeMessage *InternalLinkTypeMessage) Clone() *InternalLinkTypeMessage {
+	if internalLinkTypeMessage == nil {
+		return nil
+	}
+	clone := *internalLinkTypeMessage
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (internalLinkTypeMessage *InternalLinkTypeMessage) Equal(other *InternalLinkTypeMessage) bool {
+	if internalLinkTypeMessage == nil || other == nil {
+		return internalLinkTypeMessage == other
+	}
+	if internalLinkTypeMessage.Url != other.Url {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (internalLinkTypeMessage *InternalLinkTypeMessage) Diff(other *InternalLinkTypeMessage) []Change {
+	return internalLinkTypeMessage.diff("", other, nil)
+}
+
+func (internalLinkTypeMessage *InternalLinkTypeMessage) diff(path string, other *InternalLinkTypeMessage, changes []Change) []Change {
+	if internalLinkTypeMessage == nil || other == nil {
+		if internalLinkTypeMessage != other {
+			changes = append(changes, Change{Path: path, Old: internalLinkTypeMessage, New: other})
+		}
+		return changes
+	}
+	if internalLinkTypeMessage.Url != other.Url {
+		changes = append(changes, Change{Path: fieldPath(path, "url"), Old: internalLinkTypeMessage.Url, New: other.Url})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (internalLinkTypeMessage *InternalLinkTypeMessage) String() string {
+	return objectString(internalLinkTypeMessage)
+}
+
+// LogValue logs the object as its summary
+func (internalLinkTypeMessage *InternalLinkTypeMessage) LogValue() slog.Value {
+	return slog.StringValue(objectString(internalLinkTypeMessage))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (internalLinkTypeMessage *InternalLinkTypeMessage) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, internalLinkTypeMessage)
+}
+
+func (internalLinkTypeMessage *InternalLinkTypeMessage) printText(p *printer) {
+	if internalLinkTypeMessage == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InternalLinkTypeMessage")
+	p.string("url", internalLinkTypeMessage.Url)
+	p.endObject(state)
+}
+
 // GetInternalLinkTypeEnum return the enum type of this object
 func (internalLinkTypeMessage *InternalLinkTypeMessage) GetInternalLinkTypeEnum() InternalLinkTypeEnum {
 	return InternalLinkTypeMessageType
 }
 
+// Accept calls the method of the visitor for InternalLinkTypeMessage
+func (internalLinkTypeMessage *InternalLinkTypeMessage) Accept(visitor InternalLinkTypeVisitor) {
+	visitor.VisitInternalLinkTypeMessage(internalLinkTypeMessage)
+}
+
 // InternalLinkTypeMessageDraft The link contains a message draft text. A share screen needs to be shown to the user, then the chosen chat must be opened and the text is added to the input field
 type InternalLinkTypeMessageDraft struct {
 	tdCommon
@@ -512,11 +1739,137 @@ func NewInternalLinkTypeMessageDraft(text *FormattedText, containsLink bool) *In
 	return &internalLinkTypeMessageDraftTemp
 }
 
+// MarshalJSON marshals to json
+func (internalLinkTypeMessageDraft *InternalLinkTypeMessageDraft) MarshalJSON() ([]byte, error) {
+	return internalLinkTypeMessageDraft.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (internalLinkTypeMessageDraft *InternalLinkTypeMessageDraft) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, internalLinkTypeMessageDraft)
+}
+
+func (internalLinkTypeMessageDraft *InternalLinkTypeMessageDraft) appendJSON(b []byte) ([]byte, error) {
+	if internalLinkTypeMessageDraft == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, internalLinkTypeMessageDraft.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, internalLinkTypeMessageDraft.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b, err = internalLinkTypeMessageDraft.Text.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"contains_link":`...)
+	b = appendJSONBool(b, internalLinkTypeMessageDraft.ContainsLink)
+	return append(b, '}'), nil
+}
+
+func (internalLinkTypeMessageDraft *InternalLinkTypeMessageDraft) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			internalLinkTypeMessageDraft.tdCommon.Type, err = r.readString()
+		case "@extra":
+			internalLinkTypeMessageDraft.tdCommon.Extra, err = r.readString()
+		case "text":
+			if r.readNull() {
+				internalLinkTypeMessageDraft.Text = nil
+			} else {
+				internalLinkTypeMessageDraft.Text = new(FormattedText)
+				err = internalLinkTypeMessageDraft.Text.decodeJSON(r)
+			}
+		case "contains_link":
+			internalLinkTypeMessageDraft.ContainsLink, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (internalLinkTypeMessageDraft *InternalLinkTypeMessageDraft) Clone() *InternalLinkTypeMessageDraft {
+	if internalLinkTypeMessageDraft == nil {
+		return nil
+	}
+	clone := *internalLinkTypeMessageDraft
+	clone.Text = internalLinkTypeMessageDraft.Text.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (internalLinkTypeMessageDraft *InternalLinkTypeMessageDraft) Equal(other *InternalLinkTypeMessageDraft) bool {
+	if internalLinkTypeMessageDraft == nil || other == nil {
+		return internalLinkTypeMessageDraft == other
+	}
+	if !internalLinkTypeMessageDraft.Text.Equal(other.Text) {
+		return false
+	}
+	if internalLinkTypeMessageDraft.ContainsLink != other.ContainsLink {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (internalLinkTypeMessageDraft *InternalLinkTypeMessageDraft) Diff(other *InternalLinkTypeMessageDraft) []Change {
+	return internalLinkTypeMessageDraft.diff("", other, nil)
+}
+
+func (internalLinkTypeMessageDraft *InternalLinkTypeMessageDraft) diff(path string, other *InternalLinkTypeMessageDraft, changes []Change) []Change {
+	if internalLinkTypeMessageDraft == nil || other == nil {
+		if internalLinkTypeMessageDraft != other {
+			changes = append(changes, Change{Path: path, Old: internalLinkTypeMessageDraft, New: other})
+		}
+		return changes
+	}
+	changes = internalLinkTypeMessageDraft.Text.diff(fieldPath(path, "text"), other.Text, changes)
+	if internalLinkTypeMessageDraft.ContainsLink != other.ContainsLink {
+		changes = append(changes, Change{Path: fieldPath(path, "contains_link"), Old: internalLinkTypeMessageDraft.ContainsLink, New: other.ContainsLink})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (internalLinkTypeMessageDraft *InternalLinkTypeMessageDraft) String() string {
+	return objectString(internalLinkTypeMessageDraft)
+}
+
+// LogValue logs the object as its summary
+func (internalLinkTypeMessageDraft *InternalLinkTypeMessageDraft) LogValue() slog.Value {
+	return slog.StringValue(objectString(internalLinkTypeMessageDraft))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (internalLinkTypeMessageDraft *InternalLinkTypeMessageDraft) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, internalLinkTypeMessageDraft)
+}
+
+func (internalLinkTypeMessageDraft *InternalLinkTypeMessageDraft) printText(p *printer) {
+	if internalLinkTypeMessageDraft == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InternalLinkTypeMessageDraft")
+	p.object("text", internalLinkTypeMessageDraft.Text)
+	p.bool("contains_link", internalLinkTypeMessageDraft.ContainsLink)
+	p.endObject(state)
+}
+
 // GetInternalLinkTypeEnum return the enum type of this object
 func (internalLinkTypeMessageDraft *InternalLinkTypeMessageDraft) GetInternalLinkTypeEnum() InternalLinkTypeEnum {
 	return InternalLinkTypeMessageDraftType
 }
 
+// Accept calls the method of the visitor for InternalLinkTypeMessageDraft
+func (internalLinkTypeMessageDraft *InternalLinkTypeMessageDraft) Accept(visitor InternalLinkTypeVisitor) {
+	visitor.VisitInternalLinkTypeMessageDraft(internalLinkTypeMessageDraft)
+}
+
 // InternalLinkTypePassportDataRequest The link contains a request of Telegram passport data. Call getPassportAuthorizationForm with the given parameters to process the link if the link was received from outside of the app, otherwise ignore it
 type InternalLinkTypePassportDataRequest struct {
 	tdCommon
@@ -552,11 +1905,162 @@ func NewInternalLinkTypePassportDataRequest(botUserId int64, scope string, publi
 	return &internalLinkTypePassportDataRequestTemp
 }
 
+// MarshalJSON marshals to json
+func (internalLinkTypePassportDataRequest *InternalLinkTypePassportDataRequest) MarshalJSON() ([]byte, error) {
+	return internalLinkTypePassportDataRequest.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (internalLinkTypePassportDataRequest *InternalLinkTypePassportDataRequest) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, internalLinkTypePassportDataRequest)
+}
+
+func (internalLinkTypePassportDataRequest *InternalLinkTypePassportDataRequest) appendJSON(b []byte) ([]byte, error) {
+	if internalLinkTypePassportDataRequest == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, internalLinkTypePassportDataRequest.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, internalLinkTypePassportDataRequest.tdCommon.Extra)
+	b = append(b, `,"bot_user_id":`...)
+	b = appendJSONInt(b, int64(internalLinkTypePassportDataRequest.BotUserId))
+	b = append(b, `,"scope":`...)
+	b = appendJSONString(b, internalLinkTypePassportDataRequest.Scope)
+	b = append(b, `,"public_key":`...)
+	b = appendJSONString(b, internalLinkTypePassportDataRequest.PublicKey)
+	b = append(b, `,"nonce":`...)
+	b = appendJSONString(b, internalLinkTypePassportDataRequest.Nonce)
+	b = append(b, `,"callback_url":`...)
+	b = appendJSONString(b, internalLinkTypePassportDataRequest.CallbackUrl)
+	return append(b, '}'), nil
+}
+
+func (internalLinkTypePassportDataRequest *InternalLinkTypePassportDataRequest) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			internalLinkTypePassportDataRequest.tdCommon.Type, err = r.readString()
+		case "@extra":
+			internalLinkTypePassportDataRequest.tdCommon.Extra, err = r.readString()
+		case "bot_user_id":
+			internalLinkTypePassportDataRequest.BotUserId, err = r.readInt64()
+		case "scope":
+			internalLinkTypePassportDataRequest.Scope, err = r.readString()
+		case "public_key":
+			internalLinkTypePassportDataRequest.PublicKey, err = r.readString()
+		case "nonce":
+			internalLinkTypePassportDataRequest.Nonce, err = r.readString()
+		case "callback_url":
+			internalLinkTypePassportDataRequest.CallbackUrl, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (internalLinkTypePassportDataRequest *InternalLinkTypePassportDataRequest) Clone() *InternalLinkTypePassportDataRequest {
+	if internalLinkTypePassportDataRequest == nil {
+		return nil
+	}
+	clone := *internalLinkTypePassportDataRequest
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (internalLinkTypePassportDataRequest *InternalLinkTypePassportDataRequest) Equal(other *InternalLinkTypePassportDataRequest) bool {
+	if internalLinkTypePassportDataRequest == nil || other == nil {
+		return internalLinkTypePassportDataRequest == other
+	}
+	if internalLinkTypePassportDataRequest.BotUserId != other.BotUserId {
+		return false
+	}
+	if internalLinkTypePassportDataRequest.Scope != other.Scope {
+		return false
+	}
+	if internalLinkTypePassportDataRequest.PublicKey != other.PublicKey {
+		return false
+	}
+	if internalLinkTypePassportDataRequest.Nonce != other.Nonce {
+		return false
+	}
+	if internalLinkTypePassportDataRequest.CallbackUrl != other.CallbackUrl {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (internalLinkTypePassportDataRequest *InternalLinkTypePassportDataRequest) Diff(other *InternalLinkTypePassportDataRequest) []Change {
+	return internalLinkTypePassportDataRequest.diff("", other, nil)
+}
+
+func (internalLinkTypePassportDataRequest *InternalLinkTypePassportDataRequest) diff(path string, other *InternalLinkTypePassportDataRequest, changes []Change) []Change {
+	if internalLinkTypePassportDataRequest == nil || other == nil {
+		if internalLinkTypePassportDataRequest != other {
+			changes = append(changes, Change{Path: path, Old: internalLinkTypePassportDataRequest, New: other})
+		}
+		return changes
+	}
+	if internalLinkTypePassportDataRequest.BotUserId != other.BotUserId {
+		changes = append(changes, Change{Path: fieldPath(path, "bot_user_id"), Old: internalLinkTypePassportDataRequest.BotUserId, New: other.BotUserId})
+	}
+	if internalLinkTypePassportDataRequest.Scope != other.Scope {
+		changes = append(changes, Change{Path: fieldPath(path, "scope"), Old: internalLinkTypePassportDataRequest.Scope, New: other.Scope})
+	}
+	if internalLinkTypePassportDataRequest.PublicKey != other.PublicKey {
+		changes = append(changes, Change{Path: fieldPath(path, "public_key"), Old: internalLinkTypePassportDataRequest.PublicKey, New: other.PublicKey})
+	}
+	if internalLinkTypePassportDataRequest.Nonce != other.Nonce {
+		changes = append(changes, Change{Path: fieldPath(path, "nonce"), Old: internalLinkTypePassportDataRequest.Nonce, New: other.Nonce})
+	}
+	if internalLinkTypePassportDataRequest.CallbackUrl != other.CallbackUrl {
+		changes = append(changes, Change{Path: fieldPath(path, "callback_url"), Old: internalLinkTypePassportDataRequest.CallbackUrl, New: other.CallbackUrl})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (internalLinkTypePassportDataRequest *InternalLinkTypePassportDataRequest) String() string {
+	return objectString(internalLinkTypePassportDataRequest)
+}
+
+// LogValue logs the object as its summary
+func (internalLinkTypePassportDataRequest *InternalLinkTypePassportDataRequest) LogValue() slog.Value {
+	return slog.StringValue(objectString(internalLinkTypePassportDataRequest))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (internalLinkTypePassportDataRequest *InternalLinkTypePassportDataRequest) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, internalLinkTypePassportDataRequest)
+}
+
+func (internalLinkTypePassportDataRequest *InternalLinkTypePassportDataRequest) printText(p *printer) {
+	if internalLinkTypePassportDataRequest == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InternalLinkTypePassportDataRequest")
+	p.int("bot_user_id", int64(internalLinkTypePassportDataRequest.BotUserId))
+	p.string("scope", internalLinkTypePassportDataRequest.Scope)
+	p.string("public_key", internalLinkTypePassportDataRequest.PublicKey)
+	p.string("nonce", internalLinkTypePassportDataRequest.Nonce)
+	p.string("callback_url", internalLinkTypePassportDataRequest.CallbackUrl)
+	p.endObject(state)
+}
+
 // GetInternalLinkTypeEnum return the enum type of this object
 func (internalLinkTypePassportDataRequest *InternalLinkTypePassportDataRequest) GetInternalLinkTypeEnum() InternalLinkTypeEnum {
 	return InternalLinkTypePassportDataRequestType
 }
 
+// Accept calls the method of the visitor for InternalLinkTypePassportDataRequest
+func (internalLinkTypePassportDataRequest *InternalLinkTypePassportDataRequest) Accept(visitor InternalLinkTypeVisitor) {
+	visitor.VisitInternalLinkTypePassportDataRequest(internalLinkTypePassportDataRequest)
+}
+
 // InternalLinkTypePhoneNumberConfirmation The link can be used to confirm ownership of a phone number to prevent account deletion. Call sendPhoneNumberConfirmationCode with the given hash and phone number to process the link
 type InternalLinkTypePhoneNumberConfirmation struct {
 	tdCommon
@@ -583,11 +2087,129 @@ func NewInternalLinkTypePhoneNumberConfirmation(hash string, phoneNumber string)
 	return &internalLinkTypePhoneNumberConfirmationTemp
 }
 
+// MarshalJSON marshals to json
+func (internalLinkTypePhoneNumberConfirmation *InternalLinkTypePhoneNumberConfirmation) MarshalJSON() ([]byte, error) {
+	return internalLinkTypePhoneNumberConfirmation.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (internalLinkTypePhoneNumberConfirmation *InternalLinkTypePhoneNumberConfirmation) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, internalLinkTypePhoneNumberConfirmation)
+}
+
+func (internalLinkTypePhoneNumberConfirmation *InternalLinkTypePhoneNumberConfirmation) appendJSON(b []byte) ([]byte, error) {
+	if internalLinkTypePhoneNumberConfirmation == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, internalLinkTypePhoneNumberConfirmation.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, internalLinkTypePhoneNumberConfirmation.tdCommon.Extra)
+	b = append(b, `,"hash":`...)
+	b = appendJSONString(b, internalLinkTypePhoneNumberConfirmation.Hash)
+	b = append(b, `,"phone_number":`...)
+	b = appendJSONString(b, internalLinkTypePhoneNumberConfirmation.PhoneNumber)
+	return append(b, '}'), nil
+}
+
+func (internalLinkTypePhoneNumberConfirmation *InternalLinkTypePhoneNumberConfirmation) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			internalLinkTypePhoneNumberConfirmation.tdCommon.Type, err = r.readString()
+		case "@extra":
+			internalLinkTypePhoneNumberConfirmation.tdCommon.Extra, err = r.readString()
+		case "hash":
+			internalLinkTypePhoneNumberConfirmation.Hash, err = r.readString()
+		case "phone_number":
+			internalLinkTypePhoneNumberConfirmation.PhoneNumber, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (internalLinkTypePhoneNumberConfirmation *InternalLinkTypePhoneNumberConfirmation) Clone() *InternalLinkTypePhoneNumberConfirmation {
+	if internalLinkTypePhoneNumberConfirmation == nil {
+		return nil
+	}
+	clone := *internalLinkTypePhoneNumberConfirmation
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (internalLinkTypePhoneNumberConfirmation *InternalLinkTypePhoneNumberConfirmation) Equal(other *InternalLinkTypePhoneNumberConfirmation) bool {
+	if internalLinkTypePhoneNumberConfirmation == nil || other == nil {
+		return internalLinkTypePhoneNumberConfirmation == other
+	}
+	if internalLinkTypePhoneNumberConfirmation.Hash != other.Hash {
+		return false
+	}
+	if internalLinkTypePhoneNumberConfirmation.PhoneNumber != other.PhoneNumber {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (internalLinkTypePhoneNumberConfirmation *InternalLinkTypePhoneNumberConfirmation) Diff(other *InternalLinkTypePhoneNumberConfirmation) []Change {
+	return internalLinkTypePhoneNumberConfirmation.diff("", other, nil)
+}
+
+func (internalLinkTypePhoneNumberConfirmation *InternalLinkTypePhoneNumberConfirmation) diff(path string, other *InternalLinkTypePhoneNumberConfirmation, changes []Change) []Change {
+	if internalLinkTypePhoneNumberConfirmation == nil || other == nil {
+		if internalLinkTypePhoneNumberConfirmation != other {
+			changes = append(changes, Change{Path: path, Old: internalLinkTypePhoneNumberConfirmation, New: other})
+		}
+		return changes
+	}
+	if internalLinkTypePhoneNumberConfirmation.Hash != other.Hash {
+		changes = append(changes, Change{Path: fieldPath(path, "hash"), Old: internalLinkTypePhoneNumberConfirmation.Hash, New: other.Hash})
+	}
+	if internalLinkTypePhoneNumberConfirmation.PhoneNumber != other.PhoneNumber {
+		changes = append(changes, Change{Path: fieldPath(path, "phone_number"), Old: internalLinkTypePhoneNumberConfirmation.PhoneNumber, New: other.PhoneNumber})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (internalLinkTypePhoneNumberConfirmation *InternalLinkTypePhoneNumberConfirmation) String() string {
+	return objectString(internalLinkTypePhoneNumberConfirmation)
+}
+
+// LogValue logs the object as its summary
+func (internalLinkTypePhoneNumberConfirmation *InternalLinkTypePhoneNumberConfirmation) LogValue() slog.Value {
+	return slog.StringValue(objectString(internalLinkTypePhoneNumberConfirmation))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (internalLinkTypePhoneNumberConfirmation *InternalLinkTypePhoneNumberConfirmation) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, internalLinkTypePhoneNumberConfirmation)
+}
+
+func (internalLinkTypePhoneNumberConfirmation *InternalLinkTypePhoneNumberConfirmation) printText(p *printer) {
+	if internalLinkTypePhoneNumberConfirmation == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InternalLinkTypePhoneNumberConfirmation")
+	p.string("hash", internalLinkTypePhoneNumberConfirmation.Hash)
+	p.string("phone_number", internalLinkTypePhoneNumberConfirmation.PhoneNumber)
+	p.endObject(state)
+}
+
 // GetInternalLinkTypeEnum return the enum type of this object
 func (internalLinkTypePhoneNumberConfirmation *InternalLinkTypePhoneNumberConfirmation) GetInternalLinkTypeEnum() InternalLinkTypeEnum {
 	return InternalLinkTypePhoneNumberConfirmationType
 }
 
+// Accept calls the method of the visitor for InternalLinkTypePhoneNumberConfirmation
+func (internalLinkTypePhoneNumberConfirmation *InternalLinkTypePhoneNumberConfirmation) Accept(visitor InternalLinkTypeVisitor) {
+	visitor.VisitInternalLinkTypePhoneNumberConfirmation(internalLinkTypePhoneNumberConfirmation)
+}
+
 // InternalLinkTypeProxy The link is a link to a proxy. Call addProxy with the given parameters to process the link and add the proxy
 type InternalLinkTypeProxy struct {
 	tdCommon
@@ -617,32 +2239,131 @@ func NewInternalLinkTypeProxy(server string, port int32, typeParam ProxyType) *I
 	return &internalLinkTypeProxyTemp
 }
 
+// MarshalJSON marshals to json
+func (internalLinkTypeProxy *InternalLinkTypeProxy) MarshalJSON() ([]byte, error) {
+	return internalLinkTypeProxy.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (internalLinkTypeProxy *InternalLinkTypeProxy) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, internalLinkTypeProxy)
+}
+
+func (internalLinkTypeProxy *InternalLinkTypeProxy) appendJSON(b []byte) ([]byte, error) {
+	if internalLinkTypeProxy == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, internalLinkTypeProxy.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, internalLinkTypeProxy.tdCommon.Extra)
+	b = append(b, `,"server":`...)
+	b = appendJSONString(b, internalLinkTypeProxy.Server)
+	b = append(b, `,"port":`...)
+	b = appendJSONInt(b, int64(internalLinkTypeProxy.Port))
+	b = append(b, `,"type":`...)
+	b, err = appendJSONValue(b, internalLinkTypeProxy.Type)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (internalLinkTypeProxy *InternalLinkTypeProxy) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			internalLinkTypeProxy.tdCommon.Type, err = r.readString()
+		case "@extra":
+			internalLinkTypeProxy.tdCommon.Extra, err = r.readString()
+		case "server":
+			internalLinkTypeProxy.Server, err = r.readString()
+		case "port":
+			internalLinkTypeProxy.Port, err = r.readInt32()
+		case "type":
+			internalLinkTypeProxy.Type, err = decodeProxyType(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (internalLinkTypeProxy *InternalLinkTypeProxy) Clone() *InternalLinkTypeProxy {
+	if internalLinkTypeProxy == nil {
+		return nil
 	}
-	tempObj := struct {
-		tdCommon
-		Server string `json:"server"` // Proxy server IP address
-		Port   int32  `json:"port"`   // Proxy server port
+	clone := *internalLinkTypeProxy
+	clone.Type = cloneProxyType(internalLinkTypeProxy.Type)
+	return &clone
+}
 
-	}{}
-	err = json.Unmarshal(b, &tempObj)
-	if err != nil {
-		return err
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (internalLinkTypeProxy *InternalLinkTypeProxy) Equal(other *InternalLinkTypeProxy) bool {
+	if internalLinkTypeProxy == nil || other == nil {
+		return internalLinkTypeProxy == other
+	}
+	if internalLinkTypeProxy.Server != other.Server {
+		return false
+	}
+	if internalLinkTypeProxy.Port != other.Port {
+		return false
+	}
+	if !equalProxyType(internalLinkTypeProxy.Type, other.Type) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (internalLinkTypeProxy *InternalLinkTypeProxy) Diff(other *InternalLinkTypeProxy) []Change {
+	return internalLinkTypeProxy.diff("", other, nil)
+}
+
+func (internalLinkTypeProxy *InternalLinkTypeProxy) diff(path string, other *InternalLinkTypeProxy, changes []Change) []Change {
+	if internalLinkTypeProxy == nil || other == nil {
+		if internalLinkTypeProxy != other {
+			changes = append(changes, Change{Path: path, Old: internalLinkTypeProxy, New: other})
+		}
+		return changes
+	}
+	if internalLinkTypeProxy.Server != other.Server {
+		changes = append(changes, Change{Path: fieldPath(path, "server"), Old: internalLinkTypeProxy.Server, New: other.Server})
 	}
+	if internalLinkTypeProxy.Port != other.Port {
+		changes = append(changes, Change{Path: fieldPath(path, "port"), Old: internalLinkTypeProxy.Port, New: other.Port})
+	}
+	changes = diffProxyType(fieldPath(path, "type"), internalLinkTypeProxy.Type, other.Type, changes)
+	return changes
+}
 
-	internalLinkTypeProxy.tdCommon = tempObj.tdCommon
-	internalLinkTypeProxy.Server = tempObj.Server
-	internalLinkTypeProxy.Port = tempObj.Port
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (internalLinkTypeProxy *InternalLinkTypeProxy) String() string {
+	return objectString(internalLinkTypeProxy)
+}
+
+// LogValue logs the object as its summary
+func (internalLinkTypeProxy *InternalLinkTypeProxy) LogValue() slog.Value {
+	return slog.StringValue(objectString(internalLinkTypeProxy))
+}
 
-	fieldType, _ := unmarshalProxyType(objMap["type"])
-	internalLinkTypeProxy.Type = fieldType
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (internalLinkTypeProxy *InternalLinkTypeProxy) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, internalLinkTypeProxy)
+}
 
-	return nil
+func (internalLinkTypeProxy *InternalLinkTypeProxy) printText(p *printer) {
+	if internalLinkTypeProxy == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InternalLinkTypeProxy")
+	p.string("server", internalLinkTypeProxy.Server)
+	p.int("port", int64(internalLinkTypeProxy.Port))
+	p.object("type", internalLinkTypeProxy.Type)
+	p.endObject(state)
 }
 
 // GetInternalLinkTypeEnum return the enum type of this object
@@ -650,6 +2371,11 @@ func (internalLinkTypeProxy *InternalLinkTypeProxy) GetInternalLinkTypeEnum() In
 	return InternalLinkTypeProxyType
 }
 
+// Accept calls the method of the visitor for InternalLinkTypeProxy
+func (internalLinkTypeProxy *InternalLinkTypeProxy) Accept(visitor InternalLinkTypeVisitor) {
+	visitor.VisitInternalLinkTypeProxy(internalLinkTypeProxy)
+}
+
 // InternalLinkTypePublicChat The link is a link to a chat by its username. Call searchPublicChat with the given chat username to process the link
 type InternalLinkTypePublicChat struct {
 	tdCommon
@@ -673,11 +2399,118 @@ func NewInternalLinkTypePublicChat(chatUsername string) *InternalLinkTypePublicC
 	return &internalLinkTypePublicChatTemp
 }
 
+// MarshalJSON marshals to json
+func (internalLinkTypePublicChat *InternalLinkTypePublicChat) MarshalJSON() ([]byte, error) {
+	return internalLinkTypePublicChat.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (internalLinkTypePublicChat *InternalLinkTypePublicChat) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, internalLinkTypePublicChat)
+}
+
+func (internalLinkTypePublicChat *InternalLinkTypePublicChat) appendJSON(b []byte) ([]byte, error) {
+	if internalLinkTypePublicChat == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, internalLinkTypePublicChat.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, internalLinkTypePublicChat.tdCommon.Extra)
+	b = append(b, `,"chat_username":`...)
+	b = appendJSONString(b, internalLinkTypePublicChat.ChatUsername)
+	return append(b, '}'), nil
+}
+
+func (internalLinkTypePublicChat *InternalLinkTypePublicChat) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			internalLinkTypePublicChat.tdCommon.Type, err = r.readString()
+		case "@extra":
+			internalLinkTypePublicChat.tdCommon.Extra, err = r.readString()
+		case "chat_username":
+			internalLinkTypePublicChat.ChatUsername, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (internalLinkTypePublicChat *InternalLinkTypePublicChat) Clone() *InternalLinkTypePublicChat {
+	if internalLinkTypePublicChat == nil {
+		return nil
+	}
+	clone := *internalLinkTypePublicChat
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (internalLinkTypePublicChat *InternalLinkTypePublicChat) Equal(other *InternalLinkTypePublicChat) bool {
+	if internalLinkTypePublicChat == nil || other == nil {
+		return internalLinkTypePublicChat == other
+	}
+	if internalLinkTypePublicChat.ChatUsername != other.ChatUsername {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (internalLinkTypePublicChat *InternalLinkTypePublicChat) Diff(other *InternalLinkTypePublicChat) []Change {
+	return internalLinkTypePublicChat.diff("", other, nil)
+}
+
+func (internalLinkTypePublicChat *InternalLinkTypePublicChat) diff(path string, other *InternalLinkTypePublicChat, changes []Change) []Change {
+	if internalLinkTypePublicChat == nil || other == nil {
+		if internalLinkTypePublicChat != other {
+			changes = append(changes, Change{Path: path, Old: internalLinkTypePublicChat, New: other})
+		}
+		return changes
+	}
+	if internalLinkTypePublicChat.ChatUsername != other.ChatUsername {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_username"), Old: internalLinkTypePublicChat.ChatUsername, New: other.ChatUsername})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (internalLinkTypePublicChat *InternalLinkTypePublicChat) String() string {
+	return objectString(internalLinkTypePublicChat)
+}
+
+// LogValue logs the object as its summary
+func (internalLinkTypePublicChat *InternalLinkTypePublicChat) LogValue() slog.Value {
+	return slog.StringValue(objectString(internalLinkTypePublicChat))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (internalLinkTypePublicChat *InternalLinkTypePublicChat) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, internalLinkTypePublicChat)
+}
+
+func (internalLinkTypePublicChat *InternalLinkTypePublicChat) printText(p *printer) {
+	if internalLinkTypePublicChat == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InternalLinkTypePublicChat")
+	p.string("chat_username", internalLinkTypePublicChat.ChatUsername)
+	p.endObject(state)
+}
+
 // GetInternalLinkTypeEnum return the enum type of this object
 func (internalLinkTypePublicChat *InternalLinkTypePublicChat) GetInternalLinkTypeEnum() InternalLinkTypeEnum {
 	return InternalLinkTypePublicChatType
 }
 
+// Accept calls the method of the visitor for InternalLinkTypePublicChat
+func (internalLinkTypePublicChat *InternalLinkTypePublicChat) Accept(visitor InternalLinkTypeVisitor) {
+	visitor.VisitInternalLinkTypePublicChat(internalLinkTypePublicChat)
+}
+
 // InternalLinkTypeQrCodeAuthentication The link can be used to login the current user on another device, but it must be scanned from QR-code using in-app camera. An alert similar to "This code can be used to allow someone to log in to your Telegram account. To confirm Telegram login, please go to Settings > Devices > Scan QR and scan the code" needs to be shown
 type InternalLinkTypeQrCodeAuthentication struct {
 	tdCommon
@@ -689,38 +2522,223 @@ func (internalLinkTypeQrCodeAuthentication *InternalLinkTypeQrCodeAuthentication
 }
 
 // NewInternalLinkTypeQrCodeAuthentication creates a new InternalLinkTypeQrCodeAuthentication
-//
 func NewInternalLinkTypeQrCodeAuthentication() *InternalLinkTypeQrCodeAuthentication {
 	internalLinkTypeQrCodeAuthenticationTemp := InternalLinkTypeQrCodeAuthentication{
 		tdCommon: tdCommon{Type: "internalLinkTypeQrCodeAuthentication"},
 	}
 
-	return &internalLinkTypeQrCodeAuthenticationTemp
+	return &internalLinkTypeQrCodeAuthenticationTemp
+}
+
+// MarshalJSON marshals to json
+func (internalLinkTypeQrCodeAuthentication *InternalLinkTypeQrCodeAuthentication) MarshalJSON() ([]byte, error) {
+	return internalLinkTypeQrCodeAuthentication.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (internalLinkTypeQrCodeAuthentication *InternalLinkTypeQrCodeAuthentication) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, internalLinkTypeQrCodeAuthentication)
+}
+
+func (internalLinkTypeQrCodeAuthentication *InternalLinkTypeQrCodeAuthentication) appendJSON(b []byte) ([]byte, error) {
+	if internalLinkTypeQrCodeAuthentication == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, internalLinkTypeQrCodeAuthentication.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, internalLinkTypeQrCodeAuthentication.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (internalLinkTypeQrCodeAuthentication *InternalLinkTypeQrCodeAuthentication) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			internalLinkTypeQrCodeAuthentication.tdCommon.Type, err = r.readString()
+		case "@extra":
+			internalLinkTypeQrCodeAuthentication.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (internalLinkTypeQrCodeAuthentication *InternalLinkTypeQrCodeAuthentication) Clone() *InternalLinkTypeQrCodeAuthentication {
+	if internalLinkTypeQrCodeAuthentication == nil {
+		return nil
+	}
+	clone := *internalLinkTypeQrCodeAuthentication
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (internalLinkTypeQrCodeAuthentication *InternalLinkTypeQrCodeAuthentication) Equal(other *InternalLinkTypeQrCodeAuthentication) bool {
+	if internalLinkTypeQrCodeAuthentication == nil || other == nil {
+		return internalLinkTypeQrCodeAuthentication == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (internalLinkTypeQrCodeAuthentication *InternalLinkTypeQrCodeAuthentication) Diff(other *InternalLinkTypeQrCodeAuthentication) []Change {
+	return internalLinkTypeQrCodeAuthentication.diff("", other, nil)
+}
+
+func (internalLinkTypeQrCodeAuthentication *InternalLinkTypeQrCodeAuthentication) diff(path string, other *InternalLinkTypeQrCodeAuthentication, changes []Change) []Change {
+	if internalLinkTypeQrCodeAuthentication == nil || other == nil {
+		if internalLinkTypeQrCodeAuthentication != other {
+			changes = append(changes, Change{Path: path, Old: internalLinkTypeQrCodeAuthentication, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (internalLinkTypeQrCodeAuthentication *InternalLinkTypeQrCodeAuthentication) String() string {
+	return objectString(internalLinkTypeQrCodeAuthentication)
+}
+
+// LogValue logs the object as its summary
+func (internalLinkTypeQrCodeAuthentication *InternalLinkTypeQrCodeAuthentication) LogValue() slog.Value {
+	return slog.StringValue(objectString(internalLinkTypeQrCodeAuthentication))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (internalLinkTypeQrCodeAuthentication *InternalLinkTypeQrCodeAuthentication) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, internalLinkTypeQrCodeAuthentication)
+}
+
+func (internalLinkTypeQrCodeAuthentication *InternalLinkTypeQrCodeAuthentication) printText(p *printer) {
+	if internalLinkTypeQrCodeAuthentication == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InternalLinkTypeQrCodeAuthentication")
+	p.endObject(state)
+}
+
+// GetInternalLinkTypeEnum return the enum type of this object
+func (internalLinkTypeQrCodeAuthentication *InternalLinkTypeQrCodeAuthentication) GetInternalLinkTypeEnum() InternalLinkTypeEnum {
+	return InternalLinkTypeQrCodeAuthenticationType
+}
+
+// Accept calls the method of the visitor for InternalLinkTypeQrCodeAuthentication
+func (internalLinkTypeQrCodeAuthentication *InternalLinkTypeQrCodeAuthentication) Accept(visitor InternalLinkTypeVisitor) {
+	visitor.VisitInternalLinkTypeQrCodeAuthentication(internalLinkTypeQrCodeAuthentication)
+}
+
+// InternalLinkTypeSettings The link is a link to app settings
+type InternalLinkTypeSettings struct {
+	tdCommon
+}
+
+// MessageType return the string telegram-type of InternalLinkTypeSettings
+func (internalLinkTypeSettings *InternalLinkTypeSettings) MessageType() string {
+	return "internalLinkTypeSettings"
+}
+
+// NewInternalLinkTypeSettings creates a new InternalLinkTypeSettings
+func NewInternalLinkTypeSettings() *InternalLinkTypeSettings {
+	internalLinkTypeSettingsTemp := InternalLinkTypeSettings{
+		tdCommon: tdCommon{Type: "internalLinkTypeSettings"},
+	}
+
+	return &internalLinkTypeSettingsTemp
+}
+
+// MarshalJSON marshals to json
+func (internalLinkTypeSettings *InternalLinkTypeSettings) MarshalJSON() ([]byte, error) {
+	return internalLinkTypeSettings.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (internalLinkTypeSettings *InternalLinkTypeSettings) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, internalLinkTypeSettings)
+}
+
+func (internalLinkTypeSettings *InternalLinkTypeSettings) appendJSON(b []byte) ([]byte, error) {
+	if internalLinkTypeSettings == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, internalLinkTypeSettings.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, internalLinkTypeSettings.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (internalLinkTypeSettings *InternalLinkTypeSettings) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			internalLinkTypeSettings.tdCommon.Type, err = r.readString()
+		case "@extra":
+			internalLinkTypeSettings.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (internalLinkTypeSettings *InternalLinkTypeSettings) Clone() *InternalLinkTypeSettings {
+	if internalLinkTypeSettings == nil {
+		return nil
+	}
+	clone := *internalLinkTypeSettings
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (internalLinkTypeSettings *InternalLinkTypeSettings) Equal(other *InternalLinkTypeSettings) bool {
+	if internalLinkTypeSettings == nil || other == nil {
+		return internalLinkTypeSettings == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (internalLinkTypeSettings *InternalLinkTypeSettings) Diff(other *InternalLinkTypeSettings) []Change {
+	return internalLinkTypeSettings.diff("", other, nil)
+}
+
+func (internalLinkTypeSettings *InternalLinkTypeSettings) diff(path string, other *InternalLinkTypeSettings, changes []Change) []Change {
+	if internalLinkTypeSettings == nil || other == nil {
+		if internalLinkTypeSettings != other {
+			changes = append(changes, Change{Path: path, Old: internalLinkTypeSettings, New: other})
+		}
+		return changes
+	}
+	return changes
 }
 
-// GetInternalLinkTypeEnum return the enum type of this object
-func (internalLinkTypeQrCodeAuthentication *InternalLinkTypeQrCodeAuthentication) GetInternalLinkTypeEnum() InternalLinkTypeEnum {
-	return InternalLinkTypeQrCodeAuthenticationType
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (internalLinkTypeSettings *InternalLinkTypeSettings) String() string {
+	return objectString(internalLinkTypeSettings)
 }
 
-// InternalLinkTypeSettings The link is a link to app settings
-type InternalLinkTypeSettings struct {
-	tdCommon
+// LogValue logs the object as its summary
+func (internalLinkTypeSettings *InternalLinkTypeSettings) LogValue() slog.Value {
+	return slog.StringValue(objectString(internalLinkTypeSettings))
 }
 
-// MessageType return the string telegram-type of InternalLinkTypeSettings
-func (internalLinkTypeSettings *InternalLinkTypeSettings) MessageType() string {
-	return "internalLinkTypeSettings"
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (internalLinkTypeSettings *InternalLinkTypeSettings) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, internalLinkTypeSettings)
 }
 
-// NewInternalLinkTypeSettings creates a new InternalLinkTypeSettings
-//
-func NewInternalLinkTypeSettings() *InternalLinkTypeSettings {
-	internalLinkTypeSettingsTemp := InternalLinkTypeSettings{
-		tdCommon: tdCommon{Type: "internalLinkTypeSettings"},
+func (internalLinkTypeSettings *InternalLinkTypeSettings) printText(p *printer) {
+	if internalLinkTypeSettings == nil {
+		p.null()
+		return
 	}
-
-	return &internalLinkTypeSettingsTemp
+	state := p.beginObject("InternalLinkTypeSettings")
+	p.endObject(state)
 }
 
 // GetInternalLinkTypeEnum return the enum type of this object
@@ -728,6 +2746,11 @@ func (internalLinkTypeSettings *InternalLinkTypeSettings) GetInternalLinkTypeEnu
 	return InternalLinkTypeSettingsType
 }
 
+// Accept calls the method of the visitor for InternalLinkTypeSettings
+func (internalLinkTypeSettings *InternalLinkTypeSettings) Accept(visitor InternalLinkTypeVisitor) {
+	visitor.VisitInternalLinkTypeSettings(internalLinkTypeSettings)
+}
+
 // InternalLinkTypeStickerSet The link is a link to a sticker set. Call searchStickerSet with the given sticker set name to process the link and show the sticker set
 type InternalLinkTypeStickerSet struct {
 	tdCommon
@@ -751,11 +2774,118 @@ func NewInternalLinkTypeStickerSet(stickerSetName string) *InternalLinkTypeStick
 	return &internalLinkTypeStickerSetTemp
 }
 
+// MarshalJSON marshals to json
+func (internalLinkTypeStickerSet *InternalLinkTypeStickerSet) MarshalJSON() ([]byte, error) {
+	return internalLinkTypeStickerSet.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (internalLinkTypeStickerSet *InternalLinkTypeStickerSet) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, internalLinkTypeStickerSet)
+}
+
+func (internalLinkTypeStickerSet *InternalLinkTypeStickerSet) appendJSON(b []byte) ([]byte, error) {
+	if internalLinkTypeStickerSet == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, internalLinkTypeStickerSet.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, internalLinkTypeStickerSet.tdCommon.Extra)
+	b = append(b, `,"sticker_set_name":`...)
+	b = appendJSONString(b, internalLinkTypeStickerSet.StickerSetName)
+	return append(b, '}'), nil
+}
+
+func (internalLinkTypeStickerSet *InternalLinkTypeStickerSet) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			internalLinkTypeStickerSet.tdCommon.Type, err = r.readString()
+		case "@extra":
+			internalLinkTypeStickerSet.tdCommon.Extra, err = r.readString()
+		case "sticker_set_name":
+			internalLinkTypeStickerSet.StickerSetName, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (internalLinkTypeStickerSet *InternalLinkTypeStickerSet) Clone() *InternalLinkTypeStickerSet {
+	if internalLinkTypeStickerSet == nil {
+		return nil
+	}
+	clone := *internalLinkTypeStickerSet
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (internalLinkTypeStickerSet *InternalLinkTypeStickerSet) Equal(other *InternalLinkTypeStickerSet) bool {
+	if internalLinkTypeStickerSet == nil || other == nil {
+		return internalLinkTypeStickerSet == other
+	}
+	if internalLinkTypeStickerSet.StickerSetName != other.StickerSetName {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (internalLinkTypeStickerSet *InternalLinkTypeStickerSet) Diff(other *InternalLinkTypeStickerSet) []Change {
+	return internalLinkTypeStickerSet.diff("", other, nil)
+}
+
+func (internalLinkTypeStickerSet *InternalLinkTypeStickerSet) diff(path string, other *InternalLinkTypeStickerSet, changes []Change) []Change {
+	if internalLinkTypeStickerSet == nil || other == nil {
+		if internalLinkTypeStickerSet != other {
+			changes = append(changes, Change{Path: path, Old: internalLinkTypeStickerSet, New: other})
+		}
+		return changes
+	}
+	if internalLinkTypeStickerSet.StickerSetName != other.StickerSetName {
+		changes = append(changes, Change{Path: fieldPath(path, "sticker_set_name"), Old: internalLinkTypeStickerSet.StickerSetName, New: other.StickerSetName})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (internalLinkTypeStickerSet *InternalLinkTypeStickerSet) String() string {
+	return objectString(internalLinkTypeStickerSet)
+}
+
+// LogValue logs the object as its summary
+func (internalLinkTypeStickerSet *InternalLinkTypeStickerSet) LogValue() slog.Value {
+	return slog.StringValue(objectString(internalLinkTypeStickerSet))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (internalLinkTypeStickerSet *InternalLinkTypeStickerSet) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, internalLinkTypeStickerSet)
+}
+
+func (internalLinkTypeStickerSet *InternalLinkTypeStickerSet) printText(p *printer) {
+	if internalLinkTypeStickerSet == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InternalLinkTypeStickerSet")
+	p.string("sticker_set_name", internalLinkTypeStickerSet.StickerSetName)
+	p.endObject(state)
+}
+
 // GetInternalLinkTypeEnum return the enum type of this object
 func (internalLinkTypeStickerSet *InternalLinkTypeStickerSet) GetInternalLinkTypeEnum() InternalLinkTypeEnum {
 	return InternalLinkTypeStickerSetType
 }
 
+// Accept calls the method of the visitor for InternalLinkTypeStickerSet
+func (internalLinkTypeStickerSet *InternalLinkTypeStickerSet) Accept(visitor InternalLinkTypeVisitor) {
+	visitor.VisitInternalLinkTypeStickerSet(internalLinkTypeStickerSet)
+}
+
 // InternalLinkTypeTheme The link is a link to a theme. TDLib has no theme support yet
 type InternalLinkTypeTheme struct {
 	tdCommon
@@ -779,11 +2909,118 @@ func NewInternalLinkTypeTheme(themeName string) *InternalLinkTypeTheme {
 	return &internalLinkTypeThemeTemp
 }
 
+// MarshalJSON marshals to json
+func (internalLinkTypeTheme *InternalLinkTypeTheme) MarshalJSON() ([]byte, error) {
+	return internalLinkTypeTheme.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (internalLinkTypeTheme *InternalLinkTypeTheme) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, internalLinkTypeTheme)
+}
+
+func (internalLinkTypeTheme *InternalLinkTypeTheme) appendJSON(b []byte) ([]byte, error) {
+	if internalLinkTypeTheme == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, internalLinkTypeTheme.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, internalLinkTypeTheme.tdCommon.Extra)
+	b = append(b, `,"theme_name":`...)
+	b = appendJSONString(b, internalLinkTypeTheme.ThemeName)
+	return append(b, '}'), nil
+}
+
+func (internalLinkTypeTheme *InternalLinkTypeTheme) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			internalLinkTypeTheme.tdCommon.Type, err = r.readString()
+		case "@extra":
+			internalLinkTypeTheme.tdCommon.Extra, err = r.readString()
+		case "theme_name":
+			internalLinkTypeTheme.ThemeName, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (internalLinkTypeTheme *InternalLinkTypeTheme) Clone() *InternalLinkTypeTheme {
+	if internalLinkTypeTheme == nil {
+		return nil
+	}
+	clone := *internalLinkTypeTheme
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (internalLinkTypeTheme *InternalLinkTypeTheme) Equal(other *InternalLinkTypeTheme) bool {
+	if internalLinkTypeTheme == nil || other == nil {
+		return internalLinkTypeTheme == other
+	}
+	if internalLinkTypeTheme.ThemeName != other.ThemeName {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (internalLinkTypeTheme *InternalLinkTypeTheme) Diff(other *InternalLinkTypeTheme) []Change {
+	return internalLinkTypeTheme.diff("", other, nil)
+}
+
+func (internalLinkTypeTheme *InternalLinkTypeTheme) diff(path string, other *InternalLinkTypeTheme, changes []Change) []Change {
+	if internalLinkTypeTheme == nil || other == nil {
+		if internalLinkTypeTheme != other {
+			changes = append(changes, Change{Path: path, Old: internalLinkTypeTheme, New: other})
+		}
+		return changes
+	}
+	if internalLinkTypeTheme.ThemeName != other.ThemeName {
+		changes = append(changes, Change{Path: fieldPath(path, "theme_name"), Old: internalLinkTypeTheme.ThemeName, New: other.ThemeName})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (internalLinkTypeTheme *InternalLinkTypeTheme) String() string {
+	return objectString(internalLinkTypeTheme)
+}
+
+// LogValue logs the object as its summary
+func (internalLinkTypeTheme *InternalLinkTypeTheme) LogValue() slog.Value {
+	return slog.StringValue(objectString(internalLinkTypeTheme))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (internalLinkTypeTheme *InternalLinkTypeTheme) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, internalLinkTypeTheme)
+}
+
+func (internalLinkTypeTheme *InternalLinkTypeTheme) printText(p *printer) {
+	if internalLinkTypeTheme == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InternalLinkTypeTheme")
+	p.string("theme_name", internalLinkTypeTheme.ThemeName)
+	p.endObject(state)
+}
+
 // GetInternalLinkTypeEnum return the enum type of this object
 func (internalLinkTypeTheme *InternalLinkTypeTheme) GetInternalLinkTypeEnum() InternalLinkTypeEnum {
 	return InternalLinkTypeThemeType
 }
 
+// Accept calls the method of the visitor for InternalLinkTypeTheme
+func (internalLinkTypeTheme *InternalLinkTypeTheme) Accept(visitor InternalLinkTypeVisitor) {
+	visitor.VisitInternalLinkTypeTheme(internalLinkTypeTheme)
+}
+
 // InternalLinkTypeThemeSettings The link is a link to the theme settings section of the app
 type InternalLinkTypeThemeSettings struct {
 	tdCommon
@@ -795,7 +3032,6 @@ func (internalLinkTypeThemeSettings *InternalLinkTypeThemeSettings) MessageType(
 }
 
 // NewInternalLinkTypeThemeSettings creates a new InternalLinkTypeThemeSettings
-//
 func NewInternalLinkTypeThemeSettings() *InternalLinkTypeThemeSettings {
 	internalLinkTypeThemeSettingsTemp := InternalLinkTypeThemeSettings{
 		tdCommon: tdCommon{Type: "internalLinkTypeThemeSettings"},
@@ -804,11 +3040,107 @@ func NewInternalLinkTypeThemeSettings() *InternalLinkTypeThemeSettings {
 	return &internalLinkTypeThemeSettingsTemp
 }
 
+// MarshalJSON marshals to json
+func (internalLinkTypeThemeSettings *InternalLinkTypeThemeSettings) MarshalJSON() ([]byte, error) {
+	return internalLinkTypeThemeSettings.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (internalLinkTypeThemeSettings *InternalLinkTypeThemeSettings) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, internalLinkTypeThemeSettings)
+}
+
+func (internalLinkTypeThemeSettings *InternalLinkTypeThemeSettings) appendJSON(b []byte) ([]byte, error) {
+	if internalLinkTypeThemeSettings == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, internalLinkTypeThemeSettings.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, internalLinkTypeThemeSettings.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (internalLinkTypeThemeSettings *InternalLinkTypeThemeSettings) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			internalLinkTypeThemeSettings.tdCommon.Type, err = r.readString()
+		case "@extra":
+			internalLinkTypeThemeSettings.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (internalLinkTypeThemeSettings *InternalLinkTypeThemeSettings) Clone() *InternalLinkTypeThemeSettings {
+	if internalLinkTypeThemeSettings == nil {
+		return nil
+	}
+	clone := *internalLinkTypeThemeSettings
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (internalLinkTypeThemeSettings *InternalLinkTypeThemeSettings) Equal(other *InternalLinkTypeThemeSettings) bool {
+	if internalLinkTypeThemeSettings == nil || other == nil {
+		return internalLinkTypeThemeSettings == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (internalLinkTypeThemeSettings *InternalLinkTypeThemeSettings) Diff(other *InternalLinkTypeThemeSettings) []Change {
+	return internalLinkTypeThemeSettings.diff("", other, nil)
+}
+
+func (internalLinkTypeThemeSettings *InternalLinkTypeThemeSettings) diff(path string, other *InternalLinkTypeThemeSettings, changes []Change) []Change {
+	if internalLinkTypeThemeSettings == nil || other == nil {
+		if internalLinkTypeThemeSettings != other {
+			changes = append(changes, Change{Path: path, Old: internalLinkTypeThemeSettings, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (internalLinkTypeThemeSettings *InternalLinkTypeThemeSettings) String() string {
+	return objectString(internalLinkTypeThemeSettings)
+}
+
+// LogValue logs the object as its summary
+func (internalLinkTypeThemeSettings *InternalLinkTypeThemeSettings) LogValue() slog.Value {
+	return slog.StringValue(objectString(internalLinkTypeThemeSettings))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (internalLinkTypeThemeSettings *InternalLinkTypeThemeSettings) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, internalLinkTypeThemeSettings)
+}
+
+func (internalLinkTypeThemeSettings *InternalLinkTypeThemeSettings) printText(p *printer) {
+	if internalLinkTypeThemeSettings == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InternalLinkTypeThemeSettings")
+	p.endObject(state)
+}
+
 // GetInternalLinkTypeEnum return the enum type of this object
 func (internalLinkTypeThemeSettings *InternalLinkTypeThemeSettings) GetInternalLinkTypeEnum() InternalLinkTypeEnum {
 	return InternalLinkTypeThemeSettingsType
 }
 
+// Accept calls the method of the visitor for InternalLinkTypeThemeSettings
+func (internalLinkTypeThemeSettings *InternalLinkTypeThemeSettings) Accept(visitor InternalLinkTypeVisitor) {
+	visitor.VisitInternalLinkTypeThemeSettings(internalLinkTypeThemeSettings)
+}
+
 // InternalLinkTypeUnknownDeepLink The link is an unknown tg: link. Call getDeepLinkInfo to process the link
 type InternalLinkTypeUnknownDeepLink struct {
 	tdCommon
@@ -832,11 +3164,118 @@ func NewInternalLinkTypeUnknownDeepLink(link string) *InternalLinkTypeUnknownDee
 	return &internalLinkTypeUnknownDeepLinkTemp
 }
 
+// MarshalJSON marshals to json
+func (internalLinkTypeUnknownDeepLink *InternalLinkTypeUnknownDeepLink) MarshalJSON() ([]byte, error) {
+	return internalLinkTypeUnknownDeepLink.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (internalLinkTypeUnknownDeepLink *InternalLinkTypeUnknownDeepLink) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, internalLinkTypeUnknownDeepLink)
+}
+
+func (internalLinkTypeUnknownDeepLink *InternalLinkTypeUnknownDeepLink) appendJSON(b []byte) ([]byte, error) {
+	if internalLinkTypeUnknownDeepLink == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, internalLinkTypeUnknownDeepLink.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, internalLinkTypeUnknownDeepLink.tdCommon.Extra)
+	b = append(b, `,"link":`...)
+	b = appendJSONString(b, internalLinkTypeUnknownDeepLink.Link)
+	return append(b, '}'), nil
+}
+
+func (internalLinkTypeUnknownDeepLink *InternalLinkTypeUnknownDeepLink) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			internalLinkTypeUnknownDeepLink.tdCommon.Type, err = r.readString()
+		case "@extra":
+			internalLinkTypeUnknownDeepLink.tdCommon.Extra, err = r.readString()
+		case "link":
+			internalLinkTypeUnknownDeepLink.Link, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (internalLinkTypeUnknownDeepLink *InternalLinkTypeUnknownDeepLink) Clone() *InternalLinkTypeUnknownDeepLink {
+	if internalLinkTypeUnknownDeepLink == nil {
+		return nil
+	}
+	clone := *internalLinkTypeUnknownDeepLink
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (internalLinkTypeUnknownDeepLink *InternalLinkTypeUnknownDeepLink) Equal(other *InternalLinkTypeUnknownDeepLink) bool {
+	if internalLinkTypeUnknownDeepLink == nil || other == nil {
+		return internalLinkTypeUnknownDeepLink == other
+	}
+	if internalLinkTypeUnknownDeepLink.Link != other.Link {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (internalLinkTypeUnknownDeepLink *InternalLinkTypeUnknownDeepLink) Diff(other *InternalLinkTypeUnknownDeepLink) []Change {
+	return internalLinkTypeUnknownDeepLink.diff("", other, nil)
+}
+
+func (internalLinkTypeUnknownDeepLink *InternalLinkTypeUnknownDeepLink) diff(path string, other *InternalLinkTypeUnknownDeepLink, changes []Change) []Change {
+	if internalLinkTypeUnknownDeepLink == nil || other == nil {
+		if internalLinkTypeUnknownDeepLink != other {
+			changes = append(changes, Change{Path: path, Old: internalLinkTypeUnknownDeepLink, New: other})
+		}
+		return changes
+	}
+	if internalLinkTypeUnknownDeepLink.Link != other.Link {
+		changes = append(changes, Change{Path: fieldPath(path, "link"), Old: internalLinkTypeUnknownDeepLink.Link, New: other.Link})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (internalLinkTypeUnknownDeepLink *InternalLinkTypeUnknownDeepLink) String() string {
+	return objectString(internalLinkTypeUnknownDeepLink)
+}
+
+// LogValue logs the object as its summary
+func (internalLinkTypeUnknownDeepLink *InternalLinkTypeUnknownDeepLink) LogValue() slog.Value {
+	return slog.StringValue(objectString(internalLinkTypeUnknownDeepLink))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (internalLinkTypeUnknownDeepLink *InternalLinkTypeUnknownDeepLink) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, internalLinkTypeUnknownDeepLink)
+}
+
+func (internalLinkTypeUnknownDeepLink *InternalLinkTypeUnknownDeepLink) printText(p *printer) {
+	if internalLinkTypeUnknownDeepLink == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InternalLinkTypeUnknownDeepLink")
+	p.string("link", internalLinkTypeUnknownDeepLink.Link)
+	p.endObject(state)
+}
+
 // GetInternalLinkTypeEnum return the enum type of this object
 func (internalLinkTypeUnknownDeepLink *InternalLinkTypeUnknownDeepLink) GetInternalLinkTypeEnum() InternalLinkTypeEnum {
 	return InternalLinkTypeUnknownDeepLinkType
 }
 
+// Accept calls the method of the visitor for InternalLinkTypeUnknownDeepLink
+func (internalLinkTypeUnknownDeepLink *InternalLinkTypeUnknownDeepLink) Accept(visitor InternalLinkTypeVisitor) {
+	visitor.VisitInternalLinkTypeUnknownDeepLink(internalLinkTypeUnknownDeepLink)
+}
+
 // InternalLinkTypeUnsupportedProxy The link is a link to an unsupported proxy. An alert can be shown to the user
 type InternalLinkTypeUnsupportedProxy struct {
 	tdCommon
@@ -848,7 +3287,6 @@ func (internalLinkTypeUnsupportedProxy *InternalLinkTypeUnsupportedProxy) Messag
 }
 
 // NewInternalLinkTypeUnsupportedProxy creates a new InternalLinkTypeUnsupportedProxy
-//
 func NewInternalLinkTypeUnsupportedProxy() *InternalLinkTypeUnsupportedProxy {
 	internalLinkTypeUnsupportedProxyTemp := InternalLinkTypeUnsupportedProxy{
 		tdCommon: tdCommon{Type: "internalLinkTypeUnsupportedProxy"},
@@ -857,11 +3295,107 @@ func NewInternalLinkTypeUnsupportedProxy() *InternalLinkTypeUnsupportedProxy {
 	return &internalLinkTypeUnsupportedProxyTemp
 }
 
+// MarshalJSON marshals to json
+func (internalLinkTypeUnsupportedProxy *InternalLinkTypeUnsupportedProxy) MarshalJSON() ([]byte, error) {
+	return internalLinkTypeUnsupportedProxy.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (internalLinkTypeUnsupportedProxy *InternalLinkTypeUnsupportedProxy) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, internalLinkTypeUnsupportedProxy)
+}
+
+func (internalLinkTypeUnsupportedProxy *InternalLinkTypeUnsupportedProxy) appendJSON(b []byte) ([]byte, error) {
+	if internalLinkTypeUnsupportedProxy == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, internalLinkTypeUnsupportedProxy.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, internalLinkTypeUnsupportedProxy.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (internalLinkTypeUnsupportedProxy *InternalLinkTypeUnsupportedProxy) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			internalLinkTypeUnsupportedProxy.tdCommon.Type, err = r.readString()
+		case "@extra":
+			internalLinkTypeUnsupportedProxy.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (internalLinkTypeUnsupportedProxy *InternalLinkTypeUnsupportedProxy) Clone() *InternalLinkTypeUnsupportedProxy {
+	if internalLinkTypeUnsupportedProxy == nil {
+		return nil
+	}
+	clone := *internalLinkTypeUnsupportedProxy
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (internalLinkTypeUnsupportedProxy *InternalLinkTypeUnsupportedProxy) Equal(other *InternalLinkTypeUnsupportedProxy) bool {
+	if internalLinkTypeUnsupportedProxy == nil || other == nil {
+		return internalLinkTypeUnsupportedProxy == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (internalLinkTypeUnsupportedProxy *InternalLinkTypeUnsupportedProxy) Diff(other *InternalLinkTypeUnsupportedProxy) []Change {
+	return internalLinkTypeUnsupportedProxy.diff("", other, nil)
+}
+
+func (internalLinkTypeUnsupportedProxy *InternalLinkTypeUnsupportedProxy) diff(path string, other *InternalLinkTypeUnsupportedProxy, changes []Change) []Change {
+	if internalLinkTypeUnsupportedProxy == nil || other == nil {
+		if internalLinkTypeUnsupportedProxy != other {
+			changes = append(changes, Change{Path: path, Old: internalLinkTypeUnsupportedProxy, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (internalLinkTypeUnsupportedProxy *InternalLinkTypeUnsupportedProxy) String() string {
+	return objectString(internalLinkTypeUnsupportedProxy)
+}
+
+// LogValue logs the object as its summary
+func (internalLinkTypeUnsupportedProxy *InternalLinkTypeUnsupportedProxy) LogValue() slog.Value {
+	return slog.StringValue(objectString(internalLinkTypeUnsupportedProxy))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (internalLinkTypeUnsupportedProxy *InternalLinkTypeUnsupportedProxy) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, internalLinkTypeUnsupportedProxy)
+}
+
+func (internalLinkTypeUnsupportedProxy *InternalLinkTypeUnsupportedProxy) printText(p *printer) {
+	if internalLinkTypeUnsupportedProxy == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InternalLinkTypeUnsupportedProxy")
+	p.endObject(state)
+}
+
 // GetInternalLinkTypeEnum return the enum type of this object
 func (internalLinkTypeUnsupportedProxy *InternalLinkTypeUnsupportedProxy) GetInternalLinkTypeEnum() InternalLinkTypeEnum {
 	return InternalLinkTypeUnsupportedProxyType
 }
 
+// Accept calls the method of the visitor for InternalLinkTypeUnsupportedProxy
+func (internalLinkTypeUnsupportedProxy *InternalLinkTypeUnsupportedProxy) Accept(visitor InternalLinkTypeVisitor) {
+	visitor.VisitInternalLinkTypeUnsupportedProxy(internalLinkTypeUnsupportedProxy)
+}
+
 // InternalLinkTypeVideoChat The link is a link to a video chat. Call searchPublicChat with the given chat username, and then joinGoupCall with the given invite hash to process the link
 type InternalLinkTypeVideoChat struct {
 	tdCommon
@@ -891,11 +3425,565 @@ func NewInternalLinkTypeVideoChat(chatUsername string, inviteHash string, isLive
 	return &internalLinkTypeVideoChatTemp
 }
 
+// MarshalJSON marshals to json
+func (internalLinkTypeVideoChat *InternalLinkTypeVideoChat) MarshalJSON() ([]byte, error) {
+	return internalLinkTypeVideoChat.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (internalLinkTypeVideoChat *InternalLinkTypeVideoChat) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, internalLinkTypeVideoChat)
+}
+
+func (internalLinkTypeVideoChat *InternalLinkTypeVideoChat) appendJSON(b []byte) ([]byte, error) {
+	if internalLinkTypeVideoChat == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, internalLinkTypeVideoChat.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, internalLinkTypeVideoChat.tdCommon.Extra)
+	b = append(b, `,"chat_username":`...)
+	b = appendJSONString(b, internalLinkTypeVideoChat.ChatUsername)
+	b = append(b, `,"invite_hash":`...)
+	b = appendJSONString(b, internalLinkTypeVideoChat.InviteHash)
+	b = append(b, `,"is_live_stream":`...)
+	b = appendJSONBool(b, internalLinkTypeVideoChat.IsLiveStream)
+	return append(b, '}'), nil
+}
+
+func (internalLinkTypeVideoChat *InternalLinkTypeVideoChat) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			internalLinkTypeVideoChat.tdCommon.Type, err = r.readString()
+		case "@extra":
+			internalLinkTypeVideoChat.tdCommon.Extra, err = r.readString()
+		case "chat_username":
+			internalLinkTypeVideoChat.ChatUsername, err = r.readString()
+		case "invite_hash":
+			internalLinkTypeVideoChat.InviteHash, err = r.readString()
+		case "is_live_stream":
+			internalLinkTypeVideoChat.IsLiveStream, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (internalLinkTypeVideoChat *InternalLinkTypeVideoChat) Clone() *InternalLinkTypeVideoChat {
+	if internalLinkTypeVideoChat == nil {
+		return nil
+	}
+	clone := *internalLinkTypeVideoChat
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (internalLinkTypeVideoChat *InternalLinkTypeVideoChat) Equal(other *InternalLinkTypeVideoChat) bool {
+	if internalLinkTypeVideoChat == nil || other == nil {
+		return internalLinkTypeVideoChat == other
+	}
+	if internalLinkTypeVideoChat.ChatUsername != other.ChatUsername {
+		return false
+	}
+	if internalLinkTypeVideoChat.InviteHash != other.InviteHash {
+		return false
+	}
+	if internalLinkTypeVideoChat.IsLiveStream != other.IsLiveStream {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (internalLinkTypeVideoChat *InternalLinkTypeVideoChat) Diff(other *InternalLinkTypeVideoChat) []Change {
+	return internalLinkTypeVideoChat.diff("", other, nil)
+}
+
+func (internalLinkTypeVideoChat *InternalLinkTypeVideoChat) diff(path string, other *InternalLinkTypeVideoChat, changes []Change) []Change {
+	if internalLinkTypeVideoChat == nil || other == nil {
+		if internalLinkTypeVideoChat != other {
+			changes = append(changes, Change{Path: path, Old: internalLinkTypeVideoChat, New: other})
+		}
+		return changes
+	}
+	if internalLinkTypeVideoChat.ChatUsername != other.ChatUsername {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_username"), Old: internalLinkTypeVideoChat.ChatUsername, New: other.ChatUsername})
+	}
+	if internalLinkTypeVideoChat.InviteHash != other.InviteHash {
+		changes = append(changes, Change{Path: fieldPath(path, "invite_hash"), Old: internalLinkTypeVideoChat.InviteHash, New: other.InviteHash})
+	}
+	if internalLinkTypeVideoChat.IsLiveStream != other.IsLiveStream {
+		changes = append(changes, Change{Path: fieldPath(path, "is_live_stream"), Old: internalLinkTypeVideoChat.IsLiveStream, New: other.IsLiveStream})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (internalLinkTypeVideoChat *InternalLinkTypeVideoChat) String() string {
+	return objectString(internalLinkTypeVideoChat)
+}
+
+// LogValue logs the object as its summary
+func (internalLinkTypeVideoChat *InternalLinkTypeVideoChat) LogValue() slog.Value {
+	return slog.StringValue(objectString(internalLinkTypeVideoChat))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (internalLinkTypeVideoChat *InternalLinkTypeVideoChat) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, internalLinkTypeVideoChat)
+}
+
+func (internalLinkTypeVideoChat *InternalLinkTypeVideoChat) printText(p *printer) {
+	if internalLinkTypeVideoChat == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("InternalLinkTypeVideoChat")
+	p.string("chat_username", internalLinkTypeVideoChat.ChatUsername)
+	p.string("invite_hash", internalLinkTypeVideoChat.InviteHash)
+	p.bool("is_live_stream", internalLinkTypeVideoChat.IsLiveStream)
+	p.endObject(state)
+}
+
 // GetInternalLinkTypeEnum return the enum type of this object
 func (internalLinkTypeVideoChat *InternalLinkTypeVideoChat) GetInternalLinkTypeEnum() InternalLinkTypeEnum {
 	return InternalLinkTypeVideoChatType
 }
 
+// Accept calls the method of the visitor for InternalLinkTypeVideoChat
+func (internalLinkTypeVideoChat *InternalLinkTypeVideoChat) Accept(visitor InternalLinkTypeVisitor) {
+	visitor.VisitInternalLinkTypeVideoChat(internalLinkTypeVideoChat)
+}
+
+// InternalLinkTypeVisitor visits every type of InternalLinkType, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to InternalLinkType, until they handle it too.
+type InternalLinkTypeVisitor interface {
+	VisitInternalLinkTypeActiveSessions(internalLinkTypeActiveSessions *InternalLinkTypeActiveSessions)
+	VisitInternalLinkTypeAuthenticationCode(internalLinkTypeAuthenticationCode *InternalLinkTypeAuthenticationCode)
+	VisitInternalLinkTypeBackground(internalLinkTypeBackground *InternalLinkTypeBackground)
+	VisitInternalLinkTypeBotStart(internalLinkTypeBotStart *InternalLinkTypeBotStart)
+	VisitInternalLinkTypeBotStartInGroup(internalLinkTypeBotStartInGroup *InternalLinkTypeBotStartInGroup)
+	VisitInternalLinkTypeChangePhoneNumber(internalLinkTypeChangePhoneNumber *InternalLinkTypeChangePhoneNumber)
+	VisitInternalLinkTypeChatInvite(internalLinkTypeChatInvite *InternalLinkTypeChatInvite)
+	VisitInternalLinkTypeFilterSettings(internalLinkTypeFilterSettings *InternalLinkTypeFilterSettings)
+	VisitInternalLinkTypeGame(internalLinkTypeGame *InternalLinkTypeGame)
+	VisitInternalLinkTypeLanguagePack(internalLinkTypeLanguagePack *InternalLinkTypeLanguagePack)
+	VisitInternalLinkTypeMessage(internalLinkTypeMessage *InternalLinkTypeMessage)
+	VisitInternalLinkTypeMessageDraft(internalLinkTypeMessageDraft *InternalLinkTypeMessageDraft)
+	VisitInternalLinkTypePassportDataRequest(internalLinkTypePassportDataRequest *InternalLinkTypePassportDataRequest)
+	VisitInternalLinkTypePhoneNumberConfirmation(internalLinkTypePhoneNumberConfirmation *InternalLinkTypePhoneNumberConfirmation)
+	VisitInternalLinkTypeProxy(internalLinkTypeProxy *InternalLinkTypeProxy)
+	VisitInternalLinkTypePublicChat(internalLinkTypePublicChat *InternalLinkTypePublicChat)
+	VisitInternalLinkTypeQrCodeAuthentication(internalLinkTypeQrCodeAuthentication *InternalLinkTypeQrCodeAuthentication)
+	VisitInternalLinkTypeSettings(internalLinkTypeSettings *InternalLinkTypeSettings)
+	VisitInternalLinkTypeStickerSet(internalLinkTypeStickerSet *InternalLinkTypeStickerSet)
+	VisitInternalLinkTypeTheme(internalLinkTypeTheme *InternalLinkTypeTheme)
+	VisitInternalLinkTypeThemeSettings(internalLinkTypeThemeSettings *InternalLinkTypeThemeSettings)
+	VisitInternalLinkTypeUnknownDeepLink(internalLinkTypeUnknownDeepLink *InternalLinkTypeUnknownDeepLink)
+	VisitInternalLinkTypeUnsupportedProxy(internalLinkTypeUnsupportedProxy *InternalLinkTypeUnsupportedProxy)
+	VisitInternalLinkTypeVideoChat(internalLinkTypeVideoChat *InternalLinkTypeVideoChat)
+	VisitUnknownInternalLinkType(unknownInternalLinkType *UnknownInternalLinkType)
+}
+
+// InternalLinkTypeCases holds the function MatchInternalLinkType calls for each type of InternalLinkType;
+// types without a function are passed to the default case
+type InternalLinkTypeCases[R any] struct {
+	InternalLinkTypeActiveSessions          func(internalLinkTypeActiveSessions *InternalLinkTypeActiveSessions) R
+	InternalLinkTypeAuthenticationCode      func(internalLinkTypeAuthenticationCode *InternalLinkTypeAuthenticationCode) R
+	InternalLinkTypeBackground              func(internalLinkTypeBackground *InternalLinkTypeBackground) R
+	InternalLinkTypeBotStart                func(internalLinkTypeBotStart *InternalLinkTypeBotStart) R
+	InternalLinkTypeBotStartInGroup         func(internalLinkTypeBotStartInGroup *InternalLinkTypeBotStartInGroup) R
+	InternalLinkTypeChangePhoneNumber       func(internalLinkTypeChangePhoneNumber *InternalLinkTypeChangePhoneNumber) R
+	InternalLinkTypeChatInvite              func(internalLinkTypeChatInvite *InternalLinkTypeChatInvite) R
+	InternalLinkTypeFilterSettings          func(internalLinkTypeFilterSettings *InternalLinkTypeFilterSettings) R
+	InternalLinkTypeGame                    func(internalLinkTypeGame *InternalLinkTypeGame) R
+	InternalLinkTypeLanguagePack            func(internalLinkTypeLanguagePack *InternalLinkTypeLanguagePack) R
+	InternalLinkTypeMessage                 func(internalLinkTypeMessage *InternalLinkTypeMessage) R
+	InternalLinkTypeMessageDraft            func(internalLinkTypeMessageDraft *InternalLinkTypeMessageDraft) R
+	InternalLinkTypePassportDataRequest     func(internalLinkTypePassportDataRequest *InternalLinkTypePassportDataRequest) R
+	InternalLinkTypePhoneNumberConfirmation func(internalLinkTypePhoneNumberConfirmation *InternalLinkTypePhoneNumberConfirmation) R
+	InternalLinkTypeProxy                   func(internalLinkTypeProxy *InternalLinkTypeProxy) R
+	InternalLinkTypePublicChat              func(internalLinkTypePublicChat *InternalLinkTypePublicChat) R
+	InternalLinkTypeQrCodeAuthentication    func(internalLinkTypeQrCodeAuthentication *InternalLinkTypeQrCodeAuthentication) R
+	InternalLinkTypeSettings                func(internalLinkTypeSettings *InternalLinkTypeSettings) R
+	InternalLinkTypeStickerSet              func(internalLinkTypeStickerSet *InternalLinkTypeStickerSet) R
+	InternalLinkTypeTheme                   func(internalLinkTypeTheme *InternalLinkTypeTheme) R
+	InternalLinkTypeThemeSettings           func(internalLinkTypeThemeSettings *InternalLinkTypeThemeSettings) R
+	InternalLinkTypeUnknownDeepLink         func(internalLinkTypeUnknownDeepLink *InternalLinkTypeUnknownDeepLink) R
+	InternalLinkTypeUnsupportedProxy        func(internalLinkTypeUnsupportedProxy *InternalLinkTypeUnsupportedProxy) R
+	InternalLinkTypeVideoChat               func(internalLinkTypeVideoChat *InternalLinkTypeVideoChat) R
+}
+
+// MatchInternalLinkType calls the case of the type of internalLinkType, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement InternalLinkTypeVisitor
+// to have the compiler check every type is handled.
+func MatchInternalLinkType[R any](internalLinkType InternalLinkType, cases InternalLinkTypeCases[R], defaultCase func(internalLinkType InternalLinkType) R) R {
+	switch value := internalLinkType.(type) {
+	case *InternalLinkTypeActiveSessions:
+		if cases.InternalLinkTypeActiveSessions != nil {
+			return cases.InternalLinkTypeActiveSessions(value)
+		}
+	case *InternalLinkTypeAuthenticationCode:
+		if cases.InternalLinkTypeAuthenticationCode != nil {
+			return cases.InternalLinkTypeAuthenticationCode(value)
+		}
+	case *InternalLinkTypeBackground:
+		if cases.InternalLinkTypeBackground != nil {
+			return cases.InternalLinkTypeBackground(value)
+		}
+	case *InternalLinkTypeBotStart:
+		if cases.InternalLinkTypeBotStart != nil {
+			return cases.InternalLinkTypeBotStart(value)
+		}
+	case *InternalLinkTypeBotStartInGroup:
+		if cases.InternalLinkTypeBotStartInGroup != nil {
+			return cases.InternalLinkTypeBotStartInGroup(value)
+		}
+	case *InternalLinkTypeChangePhoneNumber:
+		if cases.InternalLinkTypeChangePhoneNumber != nil {
+			return cases.InternalLinkTypeChangePhoneNumber(value)
+		}
+	case *InternalLinkTypeChatInvite:
+		if cases.InternalLinkTypeChatInvite != nil {
+			return cases.InternalLinkTypeChatInvite(value)
+		}
+	case *InternalLinkTypeFilterSettings:
+		if cases.InternalLinkTypeFilterSettings != nil {
+			return cases.InternalLinkTypeFilterSettings(value)
+		}
+	case *InternalLinkTypeGame:
+		if cases.InternalLinkTypeGame != nil {
+			return cases.InternalLinkTypeGame(value)
+		}
+	case *InternalLinkTypeLanguagePack:
+		if cases.InternalLinkTypeLanguagePack != nil {
+			return cases.InternalLinkTypeLanguagePack(value)
+		}
+	case *InternalLinkTypeMessage:
+		if cases.InternalLinkTypeMessage != nil {
+			return cases.InternalLinkTypeMessage(value)
+		}
+	case *InternalLinkTypeMessageDraft:
+		if cases.InternalLinkTypeMessageDraft != nil {
+			return cases.InternalLinkTypeMessageDraft(value)
+		}
+	case *InternalLinkTypePassportDataRequest:
+		if cases.InternalLinkTypePassportDataRequest != nil {
+			return cases.InternalLinkTypePassportDataRequest(value)
+		}
+	case *InternalLinkTypePhoneNumberConfirmation:
+		if cases.InternalLinkTypePhoneNumberConfirmation != nil {
+			return cases.InternalLinkTypePhoneNumberConfirmation(value)
+		}
+	case *InternalLinkTypeProxy:
+		if cases.InternalLinkTypeProxy != nil {
+			return cases.InternalLinkTypeProxy(value)
+		}
+	case *InternalLinkTypePublicChat:
+		if cases.InternalLinkTypePublicChat != nil {
+			return cases.InternalLinkTypePublicChat(value)
+		}
+	case *InternalLinkTypeQrCodeAuthentication:
+		if cases.InternalLinkTypeQrCodeAuthentication != nil {
+			return cases.InternalLinkTypeQrCodeAuthentication(value)
+		}
+	case *InternalLinkTypeSettings:
+		if cases.InternalLinkTypeSettings != nil {
+			return cases.InternalLinkTypeSettings(value)
+		}
+	case *InternalLinkTypeStickerSet:
+		if cases.InternalLinkTypeStickerSet != nil {
+			return cases.InternalLinkTypeStickerSet(value)
+		}
+	case *InternalLinkTypeTheme:
+		if cases.InternalLinkTypeTheme != nil {
+			return cases.InternalLinkTypeTheme(value)
+		}
+	case *InternalLinkTypeThemeSettings:
+		if cases.InternalLinkTypeThemeSettings != nil {
+			return cases.InternalLinkTypeThemeSettings(value)
+		}
+	case *InternalLinkTypeUnknownDeepLink:
+		if cases.InternalLinkTypeUnknownDeepLink != nil {
+			return cases.InternalLinkTypeUnknownDeepLink(value)
+		}
+	case *InternalLinkTypeUnsupportedProxy:
+		if cases.InternalLinkTypeUnsupportedProxy != nil {
+			return cases.InternalLinkTypeUnsupportedProxy(value)
+		}
+	case *InternalLinkTypeVideoChat:
+		if cases.InternalLinkTypeVideoChat != nil {
+			return cases.InternalLinkTypeVideoChat(value)
+		}
+	}
+	return defaultCase(internalLinkType)
+}
+
+// Clone returns a deep copy of the object
+func (unknownInternalLinkType *UnknownInternalLinkType) Clone() *UnknownInternalLinkType {
+	if unknownInternalLinkType == nil {
+		return nil
+	}
+	return &UnknownInternalLinkType{Unknown: unknownInternalLinkType.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownInternalLinkType *UnknownInternalLinkType) Equal(other *UnknownInternalLinkType) bool {
+	if unknownInternalLinkType == nil || other == nil {
+		return unknownInternalLinkType == other
+	}
+	return unknownInternalLinkType.Unknown.equal(other.Unknown)
+}
+
+func cloneInternalLinkType(internalLinkType InternalLinkType) InternalLinkType {
+	switch value := internalLinkType.(type) {
+	case *InternalLinkTypeActiveSessions:
+		return value.Clone()
+	case *InternalLinkTypeAuthenticationCode:
+		return value.Clone()
+	case *InternalLinkTypeBackground:
+		return value.Clone()
+	case *InternalLinkTypeBotStart:
+		return value.Clone()
+	case *InternalLinkTypeBotStartInGroup:
+		return value.Clone()
+	case *InternalLinkTypeChangePhoneNumber:
+		return value.Clone()
+	case *InternalLinkTypeChatInvite:
+		return value.Clone()
+	case *InternalLinkTypeFilterSettings:
+		return value.Clone()
+	case *InternalLinkTypeGame:
+		return value.Clone()
+	case *InternalLinkTypeLanguagePack:
+		return value.Clone()
+	case *InternalLinkTypeMessage:
+		return value.Clone()
+	case *InternalLinkTypeMessageDraft:
+		return value.Clone()
+	case *InternalLinkTypePassportDataRequest:
+		return value.Clone()
+	case *InternalLinkTypePhoneNumberConfirmation:
+		return value.Clone()
+	case *InternalLinkTypeProxy:
+		return value.Clone()
+	case *InternalLinkTypePublicChat:
+		return value.Clone()
+	case *InternalLinkTypeQrCodeAuthentication:
+		return value.Clone()
+	case *InternalLinkTypeSettings:
+		return value.Clone()
+	case *InternalLinkTypeStickerSet:
+		return value.Clone()
+	case *InternalLinkTypeTheme:
+		return value.Clone()
+	case *InternalLinkTypeThemeSettings:
+		return value.Clone()
+	case *InternalLinkTypeUnknownDeepLink:
+		return value.Clone()
+	case *InternalLinkTypeUnsupportedProxy:
+		return value.Clone()
+	case *InternalLinkTypeVideoChat:
+		return value.Clone()
+	case *UnknownInternalLinkType:
+		return value.Clone()
+	}
+	return internalLinkType
+}
+
+func equalInternalLinkType(a InternalLinkType, b InternalLinkType) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *InternalLinkTypeActiveSessions:
+		b, ok := b.(*InternalLinkTypeActiveSessions)
+		return ok && a.Equal(b)
+	case *InternalLinkTypeAuthenticationCode:
+		b, ok := b.(*InternalLinkTypeAuthenticationCode)
+		return ok && a.Equal(b)
+	case *InternalLinkTypeBackground:
+		b, ok := b.(*InternalLinkTypeBackground)
+		return ok && a.Equal(b)
+	case *InternalLinkTypeBotStart:
+		b, ok := b.(*InternalLinkTypeBotStart)
+		return ok && a.Equal(b)
+	case *InternalLinkTypeBotStartInGroup:
+		b, ok := b.(*InternalLinkTypeBotStartInGroup)
+		return ok && a.Equal(b)
+	case *InternalLinkTypeChangePhoneNumber:
+		b, ok := b.(*InternalLinkTypeChangePhoneNumber)
+		return ok && a.Equal(b)
+	case *InternalLinkTypeChatInvite:
+		b, ok := b.(*InternalLinkTypeChatInvite)
+		return ok && a.Equal(b)
+	case *InternalLinkTypeFilterSettings:
+		b, ok := b.(*InternalLinkTypeFilterSettings)
+		return ok && a.Equal(b)
+	case *InternalLinkTypeGame:
+		b, ok := b.(*InternalLinkTypeGame)
+		return ok && a.Equal(b)
+	case *InternalLinkTypeLanguagePack:
+		b, ok := b.(*InternalLinkTypeLanguagePack)
+		return ok && a.Equal(b)
+	case *InternalLinkTypeMessage:
+		b, ok := b.(*InternalLinkTypeMessage)
+		return ok && a.Equal(b)
+	case *InternalLinkTypeMessageDraft:
+		b, ok := b.(*InternalLinkTypeMessageDraft)
+		return ok && a.Equal(b)
+	case *InternalLinkTypePassportDataRequest:
+		b, ok := b.(*InternalLinkTypePassportDataRequest)
+		return ok && a.Equal(b)
+	case *InternalLinkTypePhoneNumberConfirmation:
+		b, ok := b.(*InternalLinkTypePhoneNumberConfirmation)
+		return ok && a.Equal(b)
+	case *InternalLinkTypeProxy:
+		b, ok := b.(*InternalLinkTypeProxy)
+		return ok && a.Equal(b)
+	case *InternalLinkTypePublicChat:
+		b, ok := b.(*InternalLinkTypePublicChat)
+		return ok && a.Equal(b)
+	case *InternalLinkTypeQrCodeAuthentication:
+		b, ok := b.(*InternalLinkTypeQrCodeAuthentication)
+		return ok && a.Equal(b)
+	case *InternalLinkTypeSettings:
+		b, ok := b.(*InternalLinkTypeSettings)
+		return ok && a.Equal(b)
+	case *InternalLinkTypeStickerSet:
+		b, ok := b.(*InternalLinkTypeStickerSet)
+		return ok && a.Equal(b)
+	case *InternalLinkTypeTheme:
+		b, ok := b.(*InternalLinkTypeTheme)
+		return ok && a.Equal(b)
+	case *InternalLinkTypeThemeSettings:
+		b, ok := b.(*InternalLinkTypeThemeSettings)
+		return ok && a.Equal(b)
+	case *InternalLinkTypeUnknownDeepLink:
+		b, ok := b.(*InternalLinkTypeUnknownDeepLink)
+		return ok && a.Equal(b)
+	case *InternalLinkTypeUnsupportedProxy:
+		b, ok := b.(*InternalLinkTypeUnsupportedProxy)
+		return ok && a.Equal(b)
+	case *InternalLinkTypeVideoChat:
+		b, ok := b.(*InternalLinkTypeVideoChat)
+		return ok && a.Equal(b)
+	case *UnknownInternalLinkType:
+		b, ok := b.(*UnknownInternalLinkType)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffInternalLinkType(path string, a InternalLinkType, b InternalLinkType, changes []Change) []Change {
+	switch a := a.(type) {
+	case *InternalLinkTypeActiveSessions:
+		if b, ok := b.(*InternalLinkTypeActiveSessions); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InternalLinkTypeAuthenticationCode:
+		if b, ok := b.(*InternalLinkTypeAuthenticationCode); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InternalLinkTypeBackground:
+		if b, ok := b.(*InternalLinkTypeBackground); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InternalLinkTypeBotStart:
+		if b, ok := b.(*InternalLinkTypeBotStart); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InternalLinkTypeBotStartInGroup:
+		if b, ok := b.(*InternalLinkTypeBotStartInGroup); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InternalLinkTypeChangePhoneNumber:
+		if b, ok := b.(*InternalLinkTypeChangePhoneNumber); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InternalLinkTypeChatInvite:
+		if b, ok := b.(*InternalLinkTypeChatInvite); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InternalLinkTypeFilterSettings:
+		if b, ok := b.(*InternalLinkTypeFilterSettings); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InternalLinkTypeGame:
+		if b, ok := b.(*InternalLinkTypeGame); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InternalLinkTypeLanguagePack:
+		if b, ok := b.(*InternalLinkTypeLanguagePack); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InternalLinkTypeMessage:
+		if b, ok := b.(*InternalLinkTypeMessage); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InternalLinkTypeMessageDraft:
+		if b, ok := b.(*InternalLinkTypeMessageDraft); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InternalLinkTypePassportDataRequest:
+		if b, ok := b.(*InternalLinkTypePassportDataRequest); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InternalLinkTypePhoneNumberConfirmation:
+		if b, ok := b.(*InternalLinkTypePhoneNumberConfirmation); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InternalLinkTypeProxy:
+		if b, ok := b.(*InternalLinkTypeProxy); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InternalLinkTypePublicChat:
+		if b, ok := b.(*InternalLinkTypePublicChat); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InternalLinkTypeQrCodeAuthentication:
+		if b, ok := b.(*InternalLinkTypeQrCodeAuthentication); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InternalLinkTypeSettings:
+		if b, ok := b.(*InternalLinkTypeSettings); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InternalLinkTypeStickerSet:
+		if b, ok := b.(*InternalLinkTypeStickerSet); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InternalLinkTypeTheme:
+		if b, ok := b.(*InternalLinkTypeTheme); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InternalLinkTypeThemeSettings:
+		if b, ok := b.(*InternalLinkTypeThemeSettings); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InternalLinkTypeUnknownDeepLink:
+		if b, ok := b.(*InternalLinkTypeUnknownDeepLink); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InternalLinkTypeUnsupportedProxy:
+		if b, ok := b.(*InternalLinkTypeUnsupportedProxy); ok {
+			return a.diff(path, b, changes)
+		}
+	case *InternalLinkTypeVideoChat:
+		if b, ok := b.(*InternalLinkTypeVideoChat); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalInternalLinkType(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
+
 // GetInternalLinkType Returns information about the type of an internal link. Returns a 404 error if the link is not internal. Can be called before authorization
 // @param link The link
 func (client *Client) GetInternalLinkType(link string) (InternalLinkType, error) {
@@ -908,130 +3996,131 @@ func (client *Client) GetInternalLinkType(link string) (InternalLinkType, error)
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
-	switch InternalLinkTypeEnum(result.Data["@type"].(string)) {
+	resultType, _ := result.Data["@type"].(string)
+	switch InternalLinkTypeEnum(resultType) {
 
 	case InternalLinkTypeActiveSessionsType:
 		var internalLinkType InternalLinkTypeActiveSessions
-		err = json.Unmarshal(result.Raw, &internalLinkType)
+		err = jsonUnmarshal(result.Raw, &internalLinkType)
 		return &internalLinkType, err
 
 	case InternalLinkTypeAuthenticationCodeType:
 		var internalLinkType InternalLinkTypeAuthenticationCode
-		err = json.Unmarshal(result.Raw, &internalLinkType)
+		err = jsonUnmarshal(result.Raw, &internalLinkType)
 		return &internalLinkType, err
 
 	case InternalLinkTypeBackgroundType:
 		var internalLinkType InternalLinkTypeBackground
-		err = json.Unmarshal(result.Raw, &internalLinkType)
+		err = jsonUnmarshal(result.Raw, &internalLinkType)
 		return &internalLinkType, err
 
 	case InternalLinkTypeBotStartType:
 		var internalLinkType InternalLinkTypeBotStart
-		err = json.Unmarshal(result.Raw, &internalLinkType)
+		err = jsonUnmarshal(result.Raw, &internalLinkType)
 		return &internalLinkType, err
 
 	case InternalLinkTypeBotStartInGroupType:
 		var internalLinkType InternalLinkTypeBotStartInGroup
-		err = json.Unmarshal(result.Raw, &internalLinkType)
+		err = jsonUnmarshal(result.Raw, &internalLinkType)
 		return &internalLinkType, err
 
 	case InternalLinkTypeChangePhoneNumberType:
 		var internalLinkType InternalLinkTypeChangePhoneNumber
-		err = json.Unmarshal(result.Raw, &internalLinkType)
+		err = jsonUnmarshal(result.Raw, &internalLinkType)
 		return &internalLinkType, err
 
 	case InternalLinkTypeChatInviteType:
 		var internalLinkType InternalLinkTypeChatInvite
-		err = json.Unmarshal(result.Raw, &internalLinkType)
+		err = jsonUnmarshal(result.Raw, &internalLinkType)
 		return &internalLinkType, err
 
 	case InternalLinkTypeFilterSettingsType:
 		var internalLinkType InternalLinkTypeFilterSettings
-		err = json.Unmarshal(result.Raw, &internalLinkType)
+		err = jsonUnmarshal(result.Raw, &internalLinkType)
 		return &internalLinkType, err
 
 	case InternalLinkTypeGameType:
 		var internalLinkType InternalLinkTypeGame
-		err = json.Unmarshal(result.Raw, &internalLinkType)
+		err = jsonUnmarshal(result.Raw, &internalLinkType)
 		return &internalLinkType, err
 
 	case InternalLinkTypeLanguagePackType:
 		var internalLinkType InternalLinkTypeLanguagePack
-		err = json.Unmarshal(result.Raw, &internalLinkType)
+		err = jsonUnmarshal(result.Raw, &internalLinkType)
 		return &internalLinkType, err
 
 	case InternalLinkTypeMessageType:
 		var internalLinkType InternalLinkTypeMessage
-		err = json.Unmarshal(result.Raw, &internalLinkType)
+		err = jsonUnmarshal(result.Raw, &internalLinkType)
 		return &internalLinkType, err
 
 	case InternalLinkTypeMessageDraftType:
 		var internalLinkType InternalLinkTypeMessageDraft
-		err = json.Unmarshal(result.Raw, &internalLinkType)
+		err = jsonUnmarshal(result.Raw, &internalLinkType)
 		return &internalLinkType, err
 
 	case InternalLinkTypePassportDataRequestType:
 		var internalLinkType InternalLinkTypePassportDataRequest
-		err = json.Unmarshal(result.Raw, &internalLinkType)
+		err = jsonUnmarshal(result.Raw, &internalLinkType)
 		return &internalLinkType, err
 
 	case InternalLinkTypePhoneNumberConfirmationType:
 		var internalLinkType InternalLinkTypePhoneNumberConfirmation
-		err = json.Unmarshal(result.Raw, &internalLinkType)
+		err = jsonUnmarshal(result.Raw, &internalLinkType)
 		return &internalLinkType, err
 
 	case InternalLinkTypeProxyType:
 		var internalLinkType InternalLinkTypeProxy
-		err = json.Unmarshal(result.Raw, &internalLinkType)
+		err = jsonUnmarshal(result.Raw, &internalLinkType)
 		return &internalLinkType, err
 
 	case InternalLinkTypePublicChatType:
 		var internalLinkType InternalLinkTypePublicChat
-		err = json.Unmarshal(result.Raw, &internalLinkType)
+		err = jsonUnmarshal(result.Raw, &internalLinkType)
 		return &internalLinkType, err
 
 	case InternalLinkTypeQrCodeAuthenticationType:
 		var internalLinkType InternalLinkTypeQrCodeAuthentication
-		err = json.Unmarshal(result.Raw, &internalLinkType)
+		err = jsonUnmarshal(result.Raw, &internalLinkType)
 		return &internalLinkType, err
 
 	case InternalLinkTypeSettingsType:
 		var internalLinkType InternalLinkTypeSettings
-		err = json.Unmarshal(result.Raw, &internalLinkType)
+		err = jsonUnmarshal(result.Raw, &internalLinkType)
 		return &internalLinkType, err
 
 	case InternalLinkTypeStickerSetType:
 		var internalLinkType InternalLinkTypeStickerSet
-		err = json.Unmarshal(result.Raw, &internalLinkType)
+		err = jsonUnmarshal(result.Raw, &internalLinkType)
 		return &internalLinkType, err
 
 	case InternalLinkTypeThemeType:
 		var internalLinkType InternalLinkTypeTheme
-		err = json.Unmarshal(result.Raw, &internalLinkType)
+		err = jsonUnmarshal(result.Raw, &internalLinkType)
 		return &internalLinkType, err
 
 	case InternalLinkTypeThemeSettingsType:
 		var internalLinkType InternalLinkTypeThemeSettings
-		err = json.Unmarshal(result.Raw, &internalLinkType)
+		err = jsonUnmarshal(result.Raw, &internalLinkType)
 		return &internalLinkType, err
 
 	case InternalLinkTypeUnknownDeepLinkType:
 		var internalLinkType InternalLinkTypeUnknownDeepLink
-		err = json.Unmarshal(result.Raw, &internalLinkType)
+		err = jsonUnmarshal(result.Raw, &internalLinkType)
 		return &internalLinkType, err
 
 	case InternalLinkTypeUnsupportedProxyType:
 		var internalLinkType InternalLinkTypeUnsupportedProxy
-		err = json.Unmarshal(result.Raw, &internalLinkType)
+		err = jsonUnmarshal(result.Raw, &internalLinkType)
 		return &internalLinkType, err
 
 	case InternalLinkTypeVideoChatType:
 		var internalLinkType InternalLinkTypeVideoChat
-		err = json.Unmarshal(result.Raw, &internalLinkType)
+		err = jsonUnmarshal(result.Raw, &internalLinkType)
 		return &internalLinkType, err
 
 	default:
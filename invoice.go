@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // Invoice Product invoice
 type Invoice struct {
 	tdCommon
@@ -55,3 +60,306 @@ func NewInvoice(currency string, priceParts []LabeledPricePart, maxTipAmount int
 
 	return &invoiceTemp
 }
+
+// MarshalJSON marshals to json
+func (invoice *Invoice) MarshalJSON() ([]byte, error) {
+	return invoice.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (invoice *Invoice) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, invoice)
+}
+
+func (invoice *Invoice) appendJSON(b []byte) ([]byte, error) {
+	if invoice == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, invoice.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, invoice.tdCommon.Extra)
+	b = append(b, `,"currency":`...)
+	b = appendJSONString(b, invoice.Currency)
+	b = append(b, `,"price_parts":`...)
+	if invoice.PriceParts == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range invoice.PriceParts {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = invoice.PriceParts[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"max_tip_amount":`...)
+	b = appendJSONInt(b, int64(invoice.MaxTipAmount))
+	b = append(b, `,"suggested_tip_amounts":`...)
+	if invoice.SuggestedTipAmounts == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range invoice.SuggestedTipAmounts {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(invoice.SuggestedTipAmounts[i0]))
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"is_test":`...)
+	b = appendJSONBool(b, invoice.IsTest)
+	b = append(b, `,"need_name":`...)
+	b = appendJSONBool(b, invoice.NeedName)
+	b = append(b, `,"need_phone_number":`...)
+	b = appendJSONBool(b, invoice.NeedPhoneNumber)
+	b = append(b, `,"need_email_address":`...)
+	b = appendJSONBool(b, invoice.NeedEmailAddress)
+	b = append(b, `,"need_shipping_address":`...)
+	b = appendJSONBool(b, invoice.NeedShippingAddress)
+	b = append(b, `,"send_phone_number_to_provider":`...)
+	b = appendJSONBool(b, invoice.SendPhoneNumberToProvider)
+	b = append(b, `,"send_email_address_to_provider":`...)
+	b = appendJSONBool(b, invoice.SendEmailAddressToProvider)
+	b = append(b, `,"is_flexible":`...)
+	b = appendJSONBool(b, invoice.IsFlexible)
+	return append(b, '}'), nil
+}
+
+func (invoice *Invoice) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			invoice.tdCommon.Type, err = r.readString()
+		case "@extra":
+			invoice.tdCommon.Extra, err = r.readString()
+		case "currency":
+			invoice.Currency, err = r.readString()
+		case "price_parts":
+			if r.readNull() {
+				invoice.PriceParts = nil
+			} else {
+				invoice.PriceParts = make([]LabeledPricePart, 0)
+				err = r.readArray(func() (err error) {
+					var item0 LabeledPricePart
+					err = item0.decodeJSON(r)
+					invoice.PriceParts = append(invoice.PriceParts, item0)
+					return
+				})
+			}
+		case "max_tip_amount":
+			invoice.MaxTipAmount, err = r.readInt64()
+		case "suggested_tip_amounts":
+			if r.readNull() {
+				invoice.SuggestedTipAmounts = nil
+			} else {
+				invoice.SuggestedTipAmounts = make([]int64, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int64
+					item0, err = r.readInt64()
+					invoice.SuggestedTipAmounts = append(invoice.SuggestedTipAmounts, item0)
+					return
+				})
+			}
+		case "is_test":
+			invoice.IsTest, err = r.readBool()
+		case "need_name":
+			invoice.NeedName, err = r.readBool()
+		case "need_phone_number":
+			invoice.NeedPhoneNumber, err = r.readBool()
+		case "need_email_address":
+			invoice.NeedEmailAddress, err = r.readBool()
+		case "need_shipping_address":
+			invoice.NeedShippingAddress, err = r.readBool()
+		case "send_phone_number_to_provider":
+			invoice.SendPhoneNumberToProvider, err = r.readBool()
+		case "send_email_address_to_provider":
+			invoice.SendEmailAddressToProvider, err = r.readBool()
+		case "is_flexible":
+			invoice.IsFlexible, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (invoice *Invoice) Clone() *Invoice {
+	if invoice == nil {
+		return nil
+	}
+	clone := *invoice
+	if invoice.PriceParts != nil {
+		clone.PriceParts = make([]LabeledPricePart, len(invoice.PriceParts))
+		for i0 := range invoice.PriceParts {
+			clone.PriceParts[i0] = *invoice.PriceParts[i0].Clone()
+		}
+	}
+	if invoice.SuggestedTipAmounts != nil {
+		clone.SuggestedTipAmounts = make([]int64, len(invoice.SuggestedTipAmounts))
+		copy(clone.SuggestedTipAmounts, invoice.SuggestedTipAmounts)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (invoice *Invoice) Equal(other *Invoice) bool {
+	if invoice == nil || other == nil {
+		return invoice == other
+	}
+	if invoice.Currency != other.Currency {
+		return false
+	}
+	if len(invoice.PriceParts) != len(other.PriceParts) {
+		return false
+	}
+	for i0 := range invoice.PriceParts {
+		if !invoice.PriceParts[i0].Equal(&other.PriceParts[i0]) {
+			return false
+		}
+	}
+	if invoice.MaxTipAmount != other.MaxTipAmount {
+		return false
+	}
+	if len(invoice.SuggestedTipAmounts) != len(other.SuggestedTipAmounts) {
+		return false
+	}
+	for i0 := range invoice.SuggestedTipAmounts {
+		if invoice.SuggestedTipAmounts[i0] != other.SuggestedTipAmounts[i0] {
+			return false
+		}
+	}
+	if invoice.IsTest != other.IsTest {
+		return false
+	}
+	if invoice.NeedName != other.NeedName {
+		return false
+	}
+	if invoice.NeedPhoneNumber != other.NeedPhoneNumber {
+		return false
+	}
+	if invoice.NeedEmailAddress != other.NeedEmailAddress {
+		return false
+	}
+	if invoice.NeedShippingAddress != other.NeedShippingAddress {
+		return false
+	}
+	if invoice.SendPhoneNumberToProvider != other.SendPhoneNumberToProvider {
+		return false
+	}
+	if invoice.SendEmailAddressToProvider != other.SendEmailAddressToProvider {
+		return false
+	}
+	if invoice.IsFlexible != other.IsFlexible {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (invoice *Invoice) Diff(other *Invoice) []Change {
+	return invoice.diff("", other, nil)
+}
+
+func (invoice *Invoice) diff(path string, other *Invoice, changes []Change) []Change {
+	if invoice == nil || other == nil {
+		if invoice != other {
+			changes = append(changes, Change{Path: path, Old: invoice, New: other})
+		}
+		return changes
+	}
+	if invoice.Currency != other.Currency {
+		changes = append(changes, Change{Path: fieldPath(path, "currency"), Old: invoice.Currency, New: other.Currency})
+	}
+	if len(invoice.PriceParts) != len(other.PriceParts) {
+		changes = append(changes, Change{Path: fieldPath(path, "price_parts"), Old: invoice.PriceParts, New: other.PriceParts})
+	} else {
+		for i0 := range invoice.PriceParts {
+			changes = invoice.PriceParts[i0].diff(indexPath(fieldPath(path, "price_parts"), i0), &other.PriceParts[i0], changes)
+		}
+	}
+	if invoice.MaxTipAmount != other.MaxTipAmount {
+		changes = append(changes, Change{Path: fieldPath(path, "max_tip_amount"), Old: invoice.MaxTipAmount, New: other.MaxTipAmount})
+	}
+	if len(invoice.SuggestedTipAmounts) != len(other.SuggestedTipAmounts) {
+		changes = append(changes, Change{Path: fieldPath(path, "suggested_tip_amounts"), Old: invoice.SuggestedTipAmounts, New: other.SuggestedTipAmounts})
+	} else {
+		for i0 := range invoice.SuggestedTipAmounts {
+			if invoice.SuggestedTipAmounts[i0] != other.SuggestedTipAmounts[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "suggested_tip_amounts"), i0), Old: invoice.SuggestedTipAmounts[i0], New: other.SuggestedTipAmounts[i0]})
+			}
+		}
+	}
+	if invoice.IsTest != other.IsTest {
+		changes = append(changes, Change{Path: fieldPath(path, "is_test"), Old: invoice.IsTest, New: other.IsTest})
+	}
+	if invoice.NeedName != other.NeedName {
+		changes = append(changes, Change{Path: fieldPath(path, "need_name"), Old: invoice.NeedName, New: other.NeedName})
+	}
+	if invoice.NeedPhoneNumber != other.NeedPhoneNumber {
+		changes = append(changes, Change{Path: fieldPath(path, "need_phone_number"), Old: invoice.NeedPhoneNumber, New: other.NeedPhoneNumber})
+	}
+	if invoice.NeedEmailAddress != other.NeedEmailAddress {
+		changes = append(changes, Change{Path: fieldPath(path, "need_email_address"), Old: invoice.NeedEmailAddress, New: other.NeedEmailAddress})
+	}
+	if invoice.NeedShippingAddress != other.NeedShippingAddress {
+		changes = append(changes, Change{Path: fieldPath(path, "need_shipping_address"), Old: invoice.NeedShippingAddress, New: other.NeedShippingAddress})
+	}
+	if invoice.SendPhoneNumberToProvider != other.SendPhoneNumberToProvider {
+		changes = append(changes, Change{Path: fieldPath(path, "send_phone_number_to_provider"), Old: invoice.SendPhoneNumberToProvider, New: other.SendPhoneNumberToProvider})
+	}
+	if invoice.SendEmailAddressToProvider != other.SendEmailAddressToProvider {
+		changes = append(changes, Change{Path: fieldPath(path, "send_email_address_to_provider"), Old: invoice.SendEmailAddressToProvider, New: other.SendEmailAddressToProvider})
+	}
+	if invoice.IsFlexible != other.IsFlexible {
+		changes = append(changes, Change{Path: fieldPath(path, "is_flexible"), Old: invoice.IsFlexible, New: other.IsFlexible})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (invoice *Invoice) String() string {
+	return objectString(invoice)
+}
+
+// LogValue logs the object as its summary
+func (invoice *Invoice) LogValue() slog.Value {
+	return slog.StringValue(objectString(invoice))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (invoice *Invoice) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, invoice)
+}
+
+func (invoice *Invoice) printText(p *printer) {
+	if invoice == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Invoice")
+	p.string("currency", invoice.Currency)
+	p.vector("price_parts", len(invoice.PriceParts), func(i0 int) {
+		invoice.PriceParts[i0].printText(p)
+	})
+	p.int("max_tip_amount", int64(invoice.MaxTipAmount))
+	p.vector("suggested_tip_amounts", len(invoice.SuggestedTipAmounts), func(i0 int) {
+		p.int("suggested_tip_amounts", int64(invoice.SuggestedTipAmounts[i0]))
+	})
+	p.bool("is_test", invoice.IsTest)
+	p.bool("need_name", invoice.NeedName)
+	p.bool("need_phone_number", invoice.NeedPhoneNumber)
+	p.bool("need_email_address", invoice.NeedEmailAddress)
+	p.bool("need_shipping_address", invoice.NeedShippingAddress)
+	p.bool("send_phone_number_to_provider", invoice.SendPhoneNumberToProvider)
+	p.bool("send_email_address_to_provider", invoice.SendEmailAddressToProvider)
+	p.bool("is_flexible", invoice.IsFlexible)
+	p.endObject(state)
+}
@@ -0,0 +1,150 @@
+package tdlib
+
+import (
+	"errors"
+	"fmt"
+)
+
+// InvoiceBuilder builds the content of an invoice message. Mistakes, e.g. a title which is too long, are
+// returned by Build.
+//
+//	invoice, err := tdlib.NewInvoiceBuilder("USD", "Coffee", "A cup of coffee", []byte("order-42"), providerToken).
+//		Price("Coffee", 350).Price("Delivery", 150).Tips(500, 50, 100, 200).NeedShippingAddress().Flexible().Build()
+//	...
+//	message, err := client.Message(chatID).Content(invoice).Send(ctx)
+type InvoiceBuilder struct {
+	invoice *Invoice
+	content *InputMessageInvoice
+}
+
+// NewInvoiceBuilder creates an invoice builder for a product. The payload identifies the invoice in the shipping
+// and pre-checkout queries and the successful payment; it isn't shown to the user.
+func NewInvoiceBuilder(currency string, title string, description string, payload []byte, providerToken string) *InvoiceBuilder {
+	invoice := NewInvoice(currency, nil, 0, nil, false, false, false, false, false, false, false, false)
+	return &InvoiceBuilder{
+		invoice: invoice,
+		content: NewInputMessageInvoice(invoice, title, description, "", 0, 0, 0, payload, providerToken, "", ""),
+	}
+}
+
+// Price adds a part of the price, in the smallest units of the currency, e.g. cents
+func (builder *InvoiceBuilder) Price(label string, amount int64) *InvoiceBuilder {
+	builder.invoice.PriceParts = append(builder.invoice.PriceParts, *NewLabeledPricePart(label, amount))
+	return builder
+}
+
+// Tips lets users add a tip of up to maxAmount, suggesting up to 4 amounts
+func (builder *InvoiceBuilder) Tips(maxAmount int64, suggestedAmounts ...int64) *InvoiceBuilder {
+	builder.invoice.MaxTipAmount = maxAmount
+	builder.invoice.SuggestedTipAmounts = suggestedAmounts
+	return builder
+}
+
+// Test makes the invoice a test invoice, paid with the test cards of the provider
+func (builder *InvoiceBuilder) Test() *InvoiceBuilder {
+	builder.invoice.IsTest = true
+	return builder
+}
+
+// NeedName asks the user for their name
+func (builder *InvoiceBuilder) NeedName() *InvoiceBuilder {
+	builder.invoice.NeedName = true
+	return builder
+}
+
+// NeedPhoneNumber asks the user for their phone number, which is sent to the provider if sendToProvider is set
+func (builder *InvoiceBuilder) NeedPhoneNumber(sendToProvider bool) *InvoiceBuilder {
+	builder.invoice.NeedPhoneNumber = true
+	builder.invoice.SendPhoneNumberToProvider = sendToProvider
+	return builder
+}
+
+// NeedEmailAddress asks the user for their email address, which is sent to the provider if sendToProvider is set
+func (builder *InvoiceBuilder) NeedEmailAddress(sendToProvider bool) *InvoiceBuilder {
+	builder.invoice.NeedEmailAddress = true
+	builder.invoice.SendEmailAddressToProvider = sendToProvider
+	return builder
+}
+
+// NeedShippingAddress asks the user for their shipping address
+func (builder *InvoiceBuilder) NeedShippingAddress() *InvoiceBuilder {
+	builder.invoice.NeedShippingAddress = true
+	return builder
+}
+
+// Flexible makes the price depend on the shipping address, which sends shipping queries to the bot,
+// see PaymentRouter.HandleShipping
+func (builder *InvoiceBuilder) Flexible() *InvoiceBuilder {
+	builder.invoice.IsFlexible = true
+	return builder
+}
+
+// Photo sets the photo of the product, by URL; the size and the dimensions are optional
+func (builder *InvoiceBuilder) Photo(url string, size int32, width int32, height int32) *InvoiceBuilder {
+	builder.content.PhotoUrl = url
+	builder.content.PhotoSize, builder.content.PhotoWidth, builder.content.PhotoHeight = size, width, height
+	return builder
+}
+
+// ProviderData sets JSON-encoded data about the invoice shared with the payment provider
+func (builder *InvoiceBuilder) ProviderData(data string) *InvoiceBuilder {
+	builder.content.ProviderData = data
+	return builder
+}
+
+// StartParameter sets the start parameter of the deep link paying the invoice from forwards of the message.
+// Forwards can be paid directly if it's empty.
+func (builder *InvoiceBuilder) StartParameter(parameter string) *InvoiceBuilder {
+	builder.content.StartParameter = parameter
+	return builder
+}
+
+// Build returns the content of the invoice message, or the first mistake made building it
+func (builder *InvoiceBuilder) Build() (*InputMessageInvoice, error) {
+	content, invoice := builder.content, builder.invoice
+	if len(invoice.Currency) != 3 {
+		return nil, fmt.Errorf("currency %q isn't an ISO 4217 currency code", invoice.Currency)
+	}
+	if content.Title == "" {
+		return nil, errors.New("invoice has no title")
+	}
+	if err := checkLength("invoice title", content.Title, 32); err != nil {
+		return nil, err
+	}
+	if err := checkLength("invoice description", content.Description, 255); err != nil {
+		return nil, err
+	}
+	if err := checkRange("invoice payload length", int64(len(content.Payload)), 1, 128); err != nil {
+		return nil, err
+	}
+	if content.ProviderToken == "" {
+		return nil, errors.New("invoice has no provider token")
+	}
+
+	if len(invoice.PriceParts) == 0 {
+		return nil, errors.New("invoice has no price")
+	}
+	var total int64
+	for _, part := range invoice.PriceParts {
+		total += part.Amount
+	}
+	if total <= 0 {
+		return nil, fmt.Errorf("invoice total is %d, it must be positive", total)
+	}
+
+	if len(invoice.SuggestedTipAmounts) > 4 {
+		return nil, fmt.Errorf("invoice has %d suggested tips, at most 4 are allowed", len(invoice.SuggestedTipAmounts))
+	}
+	for i, amount := range invoice.SuggestedTipAmounts {
+		if amount <= 0 || amount > invoice.MaxTipAmount {
+			return nil, fmt.Errorf("suggested tip %d must be positive and at most the maximum tip %d", amount, invoice.MaxTipAmount)
+		}
+		if i > 0 && amount <= invoice.SuggestedTipAmounts[i-1] {
+			return nil, errors.New("suggested tips must be increasing")
+		}
+	}
+	if invoice.IsFlexible && !invoice.NeedShippingAddress {
+		return nil, errors.New("flexible invoice must ask for the shipping address")
+	}
+	return content, nil
+}
@@ -0,0 +1,52 @@
+package tdlib
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestInvoiceBuilderBuild(t *testing.T) {
+	// invoice returns a valid invoice builder
+	invoice := func() *InvoiceBuilder {
+		return NewInvoiceBuilder("USD", "Coffee", "A cup of coffee", []byte("order-42"), "token").Price("Coffee", 350)
+	}
+	tests := []struct {
+		name    string
+		builder *InvoiceBuilder
+		err     string
+	}{
+		{"valid", invoice().Tips(500, 50, 100, 200, 500).NeedShippingAddress().Flexible(), ""},
+		{"no currency", NewInvoiceBuilder("", "Coffee", "", []byte("order"), "token").Price("Coffee", 350), "currency"},
+		{"long currency", NewInvoiceBuilder("USDT", "Coffee", "", []byte("order"), "token").Price("Coffee", 350), "currency"},
+		{"no title", NewInvoiceBuilder("USD", "", "", []byte("order"), "token").Price("Coffee", 350), "no title"},
+		{"long title", NewInvoiceBuilder("USD", strings.Repeat("a", 33), "", []byte("order"), "token").Price("Coffee", 350), "invoice title"},
+		{"longest title", NewInvoiceBuilder("USD", strings.Repeat("é", 32), "", []byte("order"), "token").Price("Coffee", 350), ""},
+		{"long description", NewInvoiceBuilder("USD", "Coffee", strings.Repeat("a", 256), []byte("order"), "token").Price("Coffee", 350), "invoice description"},
+		{"no payload", NewInvoiceBuilder("USD", "Coffee", "", nil, "token").Price("Coffee", 350), "payload"},
+		{"long payload", NewInvoiceBuilder("USD", "Coffee", "", make([]byte, 129), "token").Price("Coffee", 350), "payload"},
+		{"longest payload", NewInvoiceBuilder("USD", "Coffee", "", make([]byte, 128), "token").Price("Coffee", 350), ""},
+		{"no provider token", NewInvoiceBuilder("USD", "Coffee", "", []byte("order"), "").Price("Coffee", 350), "provider token"},
+		{"no price", NewInvoiceBuilder("USD", "Coffee", "", []byte("order"), "token"), "no price"},
+		{"negative total", invoice().Price("Discount", -350), "total"},
+		{"too many tips", invoice().Tips(500, 10, 20, 30, 40, 50), "suggested tips"},
+		{"decreasing tips", invoice().Tips(500, 100, 50), "increasing"},
+		{"equal tips", invoice().Tips(500, 100, 100), "increasing"},
+		{"tip above the maximum", invoice().Tips(500, 100, 600), "maximum tip"},
+		{"zero tip", invoice().Tips(500, 0), "positive"},
+		{"flexible without shipping address", invoice().Flexible(), "shipping address"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			content, err := test.builder.Build()
+			if test.err == "" {
+				if err != nil || content == nil {
+					t.Errorf("Build returns %v, want the invoice", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("Build returns error %v, want %q", err, test.err)
+			}
+		})
+	}
+}
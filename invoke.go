@@ -0,0 +1,69 @@
+package tdlib
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Invoke Sends the TDLib function with the given @type, e.g. getChat, and returns its result decoded into
+// its generated type, e.g. *Chat. The parameters are validated against the schema of the function first,
+// see LookupMethod. They can be nil, UpdateData, a map, a struct or a JSON object as string, []byte or
+// json.RawMessage.
+//
+//	result, err := client.Invoke(ctx, "getChat", `{"chat_id": -1001234567890}`)
+func (client *Client) Invoke(ctx context.Context, name string, params interface{}) (TdMessage, error) {
+	method, ok := LookupMethod(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown method %s", name)
+	}
+
+	query, err := invokeParams(params)
+	if err != nil {
+		return nil, fmt.Errorf("%s: invalid parameters: %v", name, err)
+	}
+	if err := method.Validate(query); err != nil {
+		return nil, err
+	}
+	query["@type"] = name
+
+	result, err := client.SendAndCatchContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Data["@type"] == "error" {
+		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
+	}
+
+	return method.DecodeResult(result.Raw)
+}
+
+// invokeParams converts the parameters given to Invoke to JSON values
+func invokeParams(params interface{}) (UpdateData, error) {
+	var rawParams []byte
+	switch params := params.(type) {
+	case nil:
+		return UpdateData{}, nil
+	case string:
+		rawParams = []byte(params)
+	case []byte:
+		rawParams = params
+	case json.RawMessage:
+		rawParams = params
+	default:
+		var err error
+		if rawParams, err = jsonMarshal(params); err != nil {
+			return nil, err
+		}
+	}
+
+	var query UpdateData
+	if err := jsonUnmarshal(rawParams, &query); err != nil {
+		return nil, err
+	}
+	if query == nil {
+		query = UpdateData{}
+	}
+	return query, nil
+}
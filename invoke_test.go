@@ -0,0 +1,154 @@
+package tdlib
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+// chatTransport answers getChat with a chat, or with response if it isn't nil
+func chatTransport(response map[string]interface{}) *fakeTransport {
+	return newFakeTransport(func(request map[string]interface{}) []map[string]interface{} {
+		answer := map[string]interface{}{"@type": "chat", "id": request["chat_id"], "title": "Chat"}
+		if response != nil {
+			answer = map[string]interface{}{}
+			for key, value := range response {
+				answer[key] = value
+			}
+		}
+		answer["@extra"] = request["@extra"]
+		return []map[string]interface{}{answer}
+	})
+}
+
+func TestInvoke(t *testing.T) {
+	transport := chatTransport(nil)
+	client := NewClientWithTransport(Config{}, transport)
+
+	result, err := client.Invoke(context.Background(), "getChat", `{"chat_id":-1001234567890}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if chat, ok := result.(*Chat); !ok || chat.Id != -1001234567890 || chat.Title != "Chat" {
+		t.Errorf("result is %#v, want the chat", result)
+	}
+
+	// missing parameters are left to TDLib
+	if _, err := client.Invoke(context.Background(), "getChat", nil); err != nil {
+		t.Errorf("Invoke without parameters returns %v", err)
+	}
+	requests := transport.sent("getChat")
+	if len(requests) != 2 || len(requests[1]) != 2 {
+		t.Errorf("requests are %v, want the request without parameters", requests)
+	}
+}
+
+func TestInvokeInvalidRequest(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		params interface{}
+		err    string
+	}{
+		{"unknown method", "getChatt", nil, "unknown method getChatt"},
+		{"invalid JSON", "getChat", `{"chat_id":`, "getChat: invalid parameters"},
+		{"not an object", "getChat", `[1]`, "getChat: invalid parameters"},
+		{"unknown parameter", "getChat", UpdateData{"chat_id": 1, "chat": 1}, "getChat: unknown parameter chat"},
+		{"other @type", "getChat", UpdateData{"@type": "getUser"}, "getChat: @type is getUser, expected getChat"},
+		{"string for int53", "getChat", `{"chat_id":"x"}`, "getChat: chat_id: expected int53, got x"},
+		{"boolean for int53", "getChat", `{"chat_id":true}`, "getChat: chat_id: expected int53, got boolean"},
+		{"fraction for int53", "getChat", `{"chat_id":1.5}`, "getChat: chat_id: expected int53, got 1.5"},
+		{"string for int32", "getChatHistory", `{"limit":"10"}`, "getChatHistory: limit: expected int32, got string"},
+		{"int32 overflow", "getChatHistory", `{"limit":2147483648}`, "getChatHistory: limit: 2147483648 overflows int32"},
+		{"number for Bool", "getChatHistory", `{"only_local":1}`, "getChatHistory: only_local: expected Bool, got number"},
+		{"number for string", "searchPublicChats", `{"query":1}`, "searchPublicChats: query: expected string, got number"},
+		{"invalid bytes", "checkDatabaseEncryptionKey", `{"encryption_key":"!"}`, "checkDatabaseEncryptionKey: encryption_key: expected base64 encoded bytes"},
+		{"array for object", "setLocation", `{"location":[]}`, "setLocation: location: expected location, got array"},
+		{"mistyped field", "setLocation", `{"location":{"latitude":"north"}}`, "setLocation: location: location: latitude: expected double, got string"},
+		{"missing class @type", "sendMessage", `{"input_message_content":{"text":null}}`, "sendMessage: input_message_content: missing @type of InputMessageContent"},
+		{"other class", "sendMessage", `{"input_message_content":{"@type":"messageText"}}`, "sendMessage: input_message_content: messageText is not an InputMessageContent"},
+		{"mistyped vector item", "sendMessage", `{"input_message_content":{"@type":"inputMessageText","text":{"@type":"formattedText","text":"a","entities":[1]}}}`,
+			"sendMessage: input_message_content: inputMessageText: text: formattedText: entities: [0]: expected textEntity, got number"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			transport := chatTransport(nil)
+			client := NewClientWithTransport(Config{}, transport)
+
+			result, err := client.Invoke(context.Background(), test.method, test.params)
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("Invoke returns %v and error %v, want %q", result, err, test.err)
+			}
+			if requests := transport.sent(test.method); len(requests) != 0 {
+				t.Errorf("invalid request was sent: %v", requests)
+			}
+		})
+	}
+}
+
+func TestInvokeResult(t *testing.T) {
+	tests := []struct {
+		name     string
+		response map[string]interface{}
+		err      string
+	}{
+		{"error", map[string]interface{}{"@type": "error", "code": 400, "message": "CHAT_NOT_FOUND"}, "error! code: 400 msg: CHAT_NOT_FOUND"},
+		{"result of another class", map[string]interface{}{"@type": "user", "id": 1}, "getChat: unexpected result of type user, expected Chat"},
+		{"ok instead of the result", map[string]interface{}{"@type": "ok"}, "getChat: unexpected result of type ok, expected Chat"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := NewClientWithTransport(Config{}, chatTransport(test.response))
+
+			result, err := client.Invoke(context.Background(), "getChat", UpdateData{"chat_id": 1})
+			if err == nil || !strings.Contains(err.Error(), test.err) || result != nil {
+				t.Errorf("Invoke returns %v and error %v, want %q", result, err, test.err)
+			}
+		})
+	}
+}
+
+func TestMethodSchemaDecodeResult(t *testing.T) {
+	method, ok := LookupMethod("getChatHistory")
+	if !ok {
+		t.Fatal("getChatHistory isn't known")
+	}
+
+	result, err := method.DecodeResult([]byte(`{"@type":"messages","total_count":1,"messages":[{"@type":"message","id":5}]}`))
+	if messages, ok := result.(*Messages); err != nil || !ok || messages.TotalCount != 1 || len(messages.Messages) != 1 {
+		t.Errorf("decoded %#v with error %v, want the messages", result, err)
+	}
+
+	// types of a newer TDLib are returned as they are
+	result, err = method.DecodeResult([]byte(`{"@type":"messagesFromTheFuture"}`))
+	if _, ok := result.(*Unknown); err != nil || !ok {
+		t.Errorf("decoded %#v with error %v, want an unknown type", result, err)
+	}
+
+	for _, rawMsg := range []string{`{"@type":"message","id":5}`, `{}`, `[`} {
+		if result, err := method.DecodeResult([]byte(rawMsg)); err == nil {
+			t.Errorf("%s: decoded %#v, want an error", rawMsg, result)
+		}
+	}
+}
+
+func TestMethodSchemaValidate(t *testing.T) {
+	method, ok := LookupMethod("sendMessage")
+	if !ok {
+		t.Fatal("sendMessage isn't known")
+	}
+	params := UpdateData{}
+	if err := jsonUnmarshal([]byte(`{
+		"@type": "sendMessage",
+		"@extra": "extra",
+		"chat_id": "-1001234567890",
+		"reply_markup": null,
+		"options": {"@type": "messageSendOptions", "disable_notification": true},
+		"input_message_content": {"@type": "inputMessageText", "text": {"@type": "formattedText", "text": "hi", "entities": []}}
+	}`), &params); err != nil {
+		t.Fatal(err)
+	}
+	if err := method.Validate(params); err != nil {
+		t.Errorf("Validate returns %v for valid parameters", err)
+	}
+}
@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // JsonObjectMember Represents one member of a JSON object
@@ -30,28 +31,118 @@ func NewJsonObjectMember(key string, value JsonValue) *JsonObjectMember {
 	return &jsonObjectMemberTemp
 }
 
+// MarshalJSON marshals to json
+func (jsonObjectMember *JsonObjectMember) MarshalJSON() ([]byte, error) {
+	return jsonObjectMember.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (jsonObjectMember *JsonObjectMember) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, jsonObjectMember)
+}
+
+func (jsonObjectMember *JsonObjectMember) appendJSON(b []byte) ([]byte, error) {
+	if jsonObjectMember == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, jsonObjectMember.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, jsonObjectMember.tdCommon.Extra)
+	b = append(b, `,"key":`...)
+	b = appendJSONString(b, jsonObjectMember.Key)
+	b = append(b, `,"value":`...)
+	b, err = appendJSONValue(b, jsonObjectMember.Value)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-		Key string `json:"key"` // Member's key
+	return append(b, '}'), nil
+}
 
-	}{}
-	err = json.Unmarshal(b, &tempObj)
-	if err != nil {
-		return err
+func (jsonObjectMember *JsonObjectMember) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			jsonObjectMember.tdCommon.Type, err = r.readString()
+		case "@extra":
+			jsonObjectMember.tdCommon.Extra, err = r.readString()
+		case "key":
+			jsonObjectMember.Key, err = r.readString()
+		case "value":
+			jsonObjectMember.Value, err = decodeJsonValue(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (jsonObjectMember *JsonObjectMember) Clone() *JsonObjectMember {
+	if jsonObjectMember == nil {
+		return nil
+	}
+	clone := *jsonObjectMember
+	clone.Value = cloneJsonValue(jsonObjectMember.Value)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (jsonObjectMember *JsonObjectMember) Equal(other *JsonObjectMember) bool {
+	if jsonObjectMember == nil || other == nil {
+		return jsonObjectMember == other
+	}
+	if jsonObjectMember.Key != other.Key {
+		return false
 	}
+	if !equalJsonValue(jsonObjectMember.Value, other.Value) {
+		return false
+	}
+	return true
+}
 
-	jsonObjectMember.tdCommon = tempObj.tdCommon
-	jsonObjectMember.Key = tempObj.Key
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (jsonObjectMember *JsonObjectMember) Diff(other *JsonObjectMember) []Change {
+	return jsonObjectMember.diff("", other, nil)
+}
 
-	fieldValue, _ := unmarshalJsonValue(objMap["value"])
-	jsonObjectMember.Value = fieldValue
+func (jsonObjectMember *JsonObjectMember) diff(path string, other *JsonObjectMember, changes []Change) []Change {
+	if jsonObjectMember == nil || other == nil {
+		if jsonObjectMember != other {
+			changes = append(changes, Change{Path: path, Old: jsonObjectMember, New: other})
+		}
+		return changes
+	}
+	if jsonObjectMember.Key != other.Key {
+		changes = append(changes, Change{Path: fieldPath(path, "key"), Old: jsonObjectMember.Key, New: other.Key})
+	}
+	changes = diffJsonValue(fieldPath(path, "value"), jsonObjectMember.Value, other.Value, changes)
+	return changes
+}
 
-	return nil
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (jsonObjectMember *JsonObjectMember) String() string {
+	return objectString(jsonObjectMember)
+}
+
+// LogValue logs the object as its summary
+func (jsonObjectMember *JsonObjectMember) LogValue() slog.Value {
+	return slog.StringValue(objectString(jsonObjectMember))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (jsonObjectMember *JsonObjectMember) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, jsonObjectMember)
+}
+
+func (jsonObjectMember *JsonObjectMember) printText(p *printer) {
+	if jsonObjectMember == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("JsonObjectMember")
+	p.string("key", jsonObjectMember.Key)
+	p.object("value", jsonObjectMember.Value)
+	p.endObject(state)
 }
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // JsonValue Represents a JSON value
 type JsonValue interface {
 	GetJsonValueEnum() JsonValueEnum
+	Accept(visitor JsonValueVisitor)
 }
 
 // JsonValueEnum Alias for abstract JsonValue 'Sub-Classes', used as constant-enum here
@@ -23,53 +25,127 @@ const (
 	JsonValueObjectType  JsonValueEnum = "jsonValueObject"
 )
 
+// UnknownJsonValue is a JsonValue of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownJsonValue struct {
+	Unknown
+}
+
+// GetJsonValueEnum return the enum type of this object
+func (unknownJsonValue *UnknownJsonValue) GetJsonValueEnum() JsonValueEnum {
+	return JsonValueEnum(unknownJsonValue.Type)
+}
+
+// Accept calls the method of the visitor for UnknownJsonValue
+func (unknownJsonValue *UnknownJsonValue) Accept(visitor JsonValueVisitor) {
+	visitor.VisitUnknownJsonValue(unknownJsonValue)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownJsonValue *UnknownJsonValue) String() string {
+	return objectString(unknownJsonValue)
+}
+
+// LogValue logs the object as its summary
+func (unknownJsonValue *UnknownJsonValue) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownJsonValue))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownJsonValue *UnknownJsonValue) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownJsonValue)
+}
+
+func (unknownJsonValue *UnknownJsonValue) printText(p *printer) {
+	if unknownJsonValue == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownJsonValue")
+	p.string("@type", unknownJsonValue.Type)
+	p.endObject(state)
+}
+
 func unmarshalJsonValue(rawMsg *json.RawMessage) (JsonValue, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch JsonValueEnum(objMap["@type"].(string)) {
+	switch JsonValueEnum(typeName) {
 	case JsonValueNullType:
 		var jsonValueNull JsonValueNull
-		err := json.Unmarshal(*rawMsg, &jsonValueNull)
+		err := jsonUnmarshal(*rawMsg, &jsonValueNull)
 		return &jsonValueNull, err
 
 	case JsonValueBooleanType:
 		var jsonValueBoolean JsonValueBoolean
-		err := json.Unmarshal(*rawMsg, &jsonValueBoolean)
+		err := jsonUnmarshal(*rawMsg, &jsonValueBoolean)
 		return &jsonValueBoolean, err
 
 	case JsonValueNumberType:
 		var jsonValueNumber JsonValueNumber
-		err := json.Unmarshal(*rawMsg, &jsonValueNumber)
+		err := jsonUnmarshal(*rawMsg, &jsonValueNumber)
 		return &jsonValueNumber, err
 
 	case JsonValueStringType:
 		var jsonValueString JsonValueString
-		err := json.Unmarshal(*rawMsg, &jsonValueString)
+		err := jsonUnmarshal(*rawMsg, &jsonValueString)
 		return &jsonValueString, err
 
 	case JsonValueArrayType:
 		var jsonValueArray JsonValueArray
-		err := json.Unmarshal(*rawMsg, &jsonValueArray)
+		err := jsonUnmarshal(*rawMsg, &jsonValueArray)
 		return &jsonValueArray, err
 
 	case JsonValueObjectType:
 		var jsonValueObject JsonValueObject
-		err := json.Unmarshal(*rawMsg, &jsonValueObject)
+		err := jsonUnmarshal(*rawMsg, &jsonValueObject)
 		return &jsonValueObject, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownJsonValue{Unknown: unknown}, nil
 	}
 }
 
+func unmarshalJsonValueSlice(rawMsg *json.RawMessage) ([]JsonValue, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var rawItems []*json.RawMessage
+	err := jsonUnmarshal(*rawMsg, &rawItems)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]JsonValue, 0, len(rawItems))
+	for _, rawItem := range rawItems {
+		item, err := unmarshalJsonValue(rawItem)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func decodeJsonValue(r *jsonReader) (JsonValue, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalJsonValue(rawMsg)
+}
+
 // JsonValueNull Represents a null JSON value
 type JsonValueNull struct {
 	tdCommon
@@ -81,7 +157,6 @@ func (jsonValueNull *JsonValueNull) MessageType() string {
 }
 
 // NewJsonValueNull creates a new JsonValueNull
-//
 func NewJsonValueNull() *JsonValueNull {
 	jsonValueNullTemp := JsonValueNull{
 		tdCommon: tdCommon{Type: "jsonValueNull"},
@@ -90,11 +165,107 @@ func NewJsonValueNull() *JsonValueNull {
 	return &jsonValueNullTemp
 }
 
+// MarshalJSON marshals to json
+func (jsonValueNull *JsonValueNull) MarshalJSON() ([]byte, error) {
+	return jsonValueNull.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (jsonValueNull *JsonValueNull) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, jsonValueNull)
+}
+
+func (jsonValueNull *JsonValueNull) appendJSON(b []byte) ([]byte, error) {
+	if jsonValueNull == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, jsonValueNull.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, jsonValueNull.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (jsonValueNull *JsonValueNull) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			jsonValueNull.tdCommon.Type, err = r.readString()
+		case "@extra":
+			jsonValueNull.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (jsonValueNull *JsonValueNull) Clone() *JsonValueNull {
+	if jsonValueNull == nil {
+		return nil
+	}
+	clone := *jsonValueNull
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (jsonValueNull *JsonValueNull) Equal(other *JsonValueNull) bool {
+	if jsonValueNull == nil || other == nil {
+		return jsonValueNull == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (jsonValueNull *JsonValueNull) Diff(other *JsonValueNull) []Change {
+	return jsonValueNull.diff("", other, nil)
+}
+
+func (jsonValueNull *JsonValueNull) diff(path string, other *JsonValueNull, changes []Change) []Change {
+	if jsonValueNull == nil || other == nil {
+		if jsonValueNull != other {
+			changes = append(changes, Change{Path: path, Old: jsonValueNull, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (jsonValueNull *JsonValueNull) String() string {
+	return objectString(jsonValueNull)
+}
+
+// LogValue logs the object as its summary
+func (jsonValueNull *JsonValueNull) LogValue() slog.Value {
+	return slog.StringValue(objectString(jsonValueNull))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (jsonValueNull *JsonValueNull) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, jsonValueNull)
+}
+
+func (jsonValueNull *JsonValueNull) printText(p *printer) {
+	if jsonValueNull == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("JsonValueNull")
+	p.endObject(state)
+}
+
 // GetJsonValueEnum return the enum type of this object
 func (jsonValueNull *JsonValueNull) GetJsonValueEnum() JsonValueEnum {
 	return JsonValueNullType
 }
 
+// Accept calls the method of the visitor for JsonValueNull
+func (jsonValueNull *JsonValueNull) Accept(visitor JsonValueVisitor) {
+	visitor.VisitJsonValueNull(jsonValueNull)
+}
+
 // JsonValueBoolean Represents a boolean JSON value
 type JsonValueBoolean struct {
 	tdCommon
@@ -118,11 +289,118 @@ func NewJsonValueBoolean(value bool) *JsonValueBoolean {
 	return &jsonValueBooleanTemp
 }
 
+// MarshalJSON marshals to json
+func (jsonValueBoolean *JsonValueBoolean) MarshalJSON() ([]byte, error) {
+	return jsonValueBoolean.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (jsonValueBoolean *JsonValueBoolean) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, jsonValueBoolean)
+}
+
+func (jsonValueBoolean *JsonValueBoolean) appendJSON(b []byte) ([]byte, error) {
+	if jsonValueBoolean == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, jsonValueBoolean.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, jsonValueBoolean.tdCommon.Extra)
+	b = append(b, `,"value":`...)
+	b = appendJSONBool(b, jsonValueBoolean.Value)
+	return append(b, '}'), nil
+}
+
+func (jsonValueBoolean *JsonValueBoolean) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			jsonValueBoolean.tdCommon.Type, err = r.readString()
+		case "@extra":
+			jsonValueBoolean.tdCommon.Extra, err = r.readString()
+		case "value":
+			jsonValueBoolean.Value, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (jsonValueBoolean *JsonValueBoolean) Clone() *JsonValueBoolean {
+	if jsonValueBoolean == nil {
+		return nil
+	}
+	clone := *jsonValueBoolean
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (jsonValueBoolean *JsonValueBoolean) Equal(other *JsonValueBoolean) bool {
+	if jsonValueBoolean == nil || other == nil {
+		return jsonValueBoolean == other
+	}
+	if jsonValueBoolean.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (jsonValueBoolean *JsonValueBoolean) Diff(other *JsonValueBoolean) []Change {
+	return jsonValueBoolean.diff("", other, nil)
+}
+
+func (jsonValueBoolean *JsonValueBoolean) diff(path string, other *JsonValueBoolean, changes []Change) []Change {
+	if jsonValueBoolean == nil || other == nil {
+		if jsonValueBoolean != other {
+			changes = append(changes, Change{Path: path, Old: jsonValueBoolean, New: other})
+		}
+		return changes
+	}
+	if jsonValueBoolean.Value != other.Value {
+		changes = append(changes, Change{Path: fieldPath(path, "value"), Old: jsonValueBoolean.Value, New: other.Value})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (jsonValueBoolean *JsonValueBoolean) String() string {
+	return objectString(jsonValueBoolean)
+}
+
+// LogValue logs the object as its summary
+func (jsonValueBoolean *JsonValueBoolean) LogValue() slog.Value {
+	return slog.StringValue(objectString(jsonValueBoolean))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (jsonValueBoolean *JsonValueBoolean) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, jsonValueBoolean)
+}
+
+func (jsonValueBoolean *JsonValueBoolean) printText(p *printer) {
+	if jsonValueBoolean == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("JsonValueBoolean")
+	p.bool("value", jsonValueBoolean.Value)
+	p.endObject(state)
+}
+
 // GetJsonValueEnum return the enum type of this object
 func (jsonValueBoolean *JsonValueBoolean) GetJsonValueEnum() JsonValueEnum {
 	return JsonValueBooleanType
 }
 
+// Accept calls the method of the visitor for JsonValueBoolean
+func (jsonValueBoolean *JsonValueBoolean) Accept(visitor JsonValueVisitor) {
+	visitor.VisitJsonValueBoolean(jsonValueBoolean)
+}
+
 // JsonValueNumber Represents a numeric JSON value
 type JsonValueNumber struct {
 	tdCommon
@@ -146,11 +424,122 @@ func NewJsonValueNumber(value float64) *JsonValueNumber {
 	return &jsonValueNumberTemp
 }
 
+// MarshalJSON marshals to json
+func (jsonValueNumber *JsonValueNumber) MarshalJSON() ([]byte, error) {
+	return jsonValueNumber.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (jsonValueNumber *JsonValueNumber) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, jsonValueNumber)
+}
+
+func (jsonValueNumber *JsonValueNumber) appendJSON(b []byte) ([]byte, error) {
+	if jsonValueNumber == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, jsonValueNumber.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, jsonValueNumber.tdCommon.Extra)
+	b = append(b, `,"value":`...)
+	b, err = appendJSONFloat(b, jsonValueNumber.Value)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (jsonValueNumber *JsonValueNumber) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			jsonValueNumber.tdCommon.Type, err = r.readString()
+		case "@extra":
+			jsonValueNumber.tdCommon.Extra, err = r.readString()
+		case "value":
+			jsonValueNumber.Value, err = r.readFloat64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (jsonValueNumber *JsonValueNumber) Clone() *JsonValueNumber {
+	if jsonValueNumber == nil {
+		return nil
+	}
+	clone := *jsonValueNumber
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (jsonValueNumber *JsonValueNumber) Equal(other *JsonValueNumber) bool {
+	if jsonValueNumber == nil || other == nil {
+		return jsonValueNumber == other
+	}
+	if jsonValueNumber.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (jsonValueNumber *JsonValueNumber) Diff(other *JsonValueNumber) []Change {
+	return jsonValueNumber.diff("", other, nil)
+}
+
+func (jsonValueNumber *JsonValueNumber) diff(path string, other *JsonValueNumber, changes []Change) []Change {
+	if jsonValueNumber == nil || other == nil {
+		if jsonValueNumber != other {
+			changes = append(changes, Change{Path: path, Old: jsonValueNumber, New: other})
+		}
+		return changes
+	}
+	if jsonValueNumber.Value != other.Value {
+		changes = append(changes, Change{Path: fieldPath(path, "value"), Old: jsonValueNumber.Value, New: other.Value})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (jsonValueNumber *JsonValueNumber) String() string {
+	return objectString(jsonValueNumber)
+}
+
+// LogValue logs the object as its summary
+func (jsonValueNumber *JsonValueNumber) LogValue() slog.Value {
+	return slog.StringValue(objectString(jsonValueNumber))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (jsonValueNumber *JsonValueNumber) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, jsonValueNumber)
+}
+
+func (jsonValueNumber *JsonValueNumber) printText(p *printer) {
+	if jsonValueNumber == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("JsonValueNumber")
+	p.float("value", jsonValueNumber.Value)
+	p.endObject(state)
+}
+
 // GetJsonValueEnum return the enum type of this object
 func (jsonValueNumber *JsonValueNumber) GetJsonValueEnum() JsonValueEnum {
 	return JsonValueNumberType
 }
 
+// Accept calls the method of the visitor for JsonValueNumber
+func (jsonValueNumber *JsonValueNumber) Accept(visitor JsonValueVisitor) {
+	visitor.VisitJsonValueNumber(jsonValueNumber)
+}
+
 // JsonValueString Represents a string JSON value
 type JsonValueString struct {
 	tdCommon
@@ -174,11 +563,118 @@ func NewJsonValueString(value string) *JsonValueString {
 	return &jsonValueStringTemp
 }
 
+// MarshalJSON marshals to json
+func (jsonValueString *JsonValueString) MarshalJSON() ([]byte, error) {
+	return jsonValueString.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (jsonValueString *JsonValueString) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, jsonValueString)
+}
+
+func (jsonValueString *JsonValueString) appendJSON(b []byte) ([]byte, error) {
+	if jsonValueString == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, jsonValueString.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, jsonValueString.tdCommon.Extra)
+	b = append(b, `,"value":`...)
+	b = appendJSONString(b, jsonValueString.Value)
+	return append(b, '}'), nil
+}
+
+func (jsonValueString *JsonValueString) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			jsonValueString.tdCommon.Type, err = r.readString()
+		case "@extra":
+			jsonValueString.tdCommon.Extra, err = r.readString()
+		case "value":
+			jsonValueString.Value, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (jsonValueString *JsonValueString) Clone() *JsonValueString {
+	if jsonValueString == nil {
+		return nil
+	}
+	clone := *jsonValueString
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (jsonValueString *JsonValueString) Equal(other *JsonValueString) bool {
+	if jsonValueString == nil || other == nil {
+		return jsonValueString == other
+	}
+	if jsonValueString.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (jsonValueString *JsonValueString) Diff(other *JsonValueString) []Change {
+	return jsonValueString.diff("", other, nil)
+}
+
+func (jsonValueString *JsonValueString) diff(path string, other *JsonValueString, changes []Change) []Change {
+	if jsonValueString == nil || other == nil {
+		if jsonValueString != other {
+			changes = append(changes, Change{Path: path, Old: jsonValueString, New: other})
+		}
+		return changes
+	}
+	if jsonValueString.Value != other.Value {
+		changes = append(changes, Change{Path: fieldPath(path, "value"), Old: jsonValueString.Value, New: other.Value})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (jsonValueString *JsonValueString) String() string {
+	return objectString(jsonValueString)
+}
+
+// LogValue logs the object as its summary
+func (jsonValueString *JsonValueString) LogValue() slog.Value {
+	return slog.StringValue(objectString(jsonValueString))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (jsonValueString *JsonValueString) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, jsonValueString)
+}
+
+func (jsonValueString *JsonValueString) printText(p *printer) {
+	if jsonValueString == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("JsonValueString")
+	p.string("value", jsonValueString.Value)
+	p.endObject(state)
+}
+
 // GetJsonValueEnum return the enum type of this object
 func (jsonValueString *JsonValueString) GetJsonValueEnum() JsonValueEnum {
 	return JsonValueStringType
 }
 
+// Accept calls the method of the visitor for JsonValueString
+func (jsonValueString *JsonValueString) Accept(visitor JsonValueVisitor) {
+	visitor.VisitJsonValueString(jsonValueString)
+}
+
 // JsonValueArray Represents a JSON array
 type JsonValueArray struct {
 	tdCommon
@@ -202,11 +698,160 @@ func NewJsonValueArray(values []JsonValue) *JsonValueArray {
 	return &jsonValueArrayTemp
 }
 
+// MarshalJSON marshals to json
+func (jsonValueArray *JsonValueArray) MarshalJSON() ([]byte, error) {
+	return jsonValueArray.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (jsonValueArray *JsonValueArray) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, jsonValueArray)
+}
+
+func (jsonValueArray *JsonValueArray) appendJSON(b []byte) ([]byte, error) {
+	if jsonValueArray == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, jsonValueArray.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, jsonValueArray.tdCommon.Extra)
+	b = append(b, `,"values":`...)
+	if jsonValueArray.Values == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range jsonValueArray.Values {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = appendJSONValue(b, jsonValueArray.Values[i0])
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (jsonValueArray *JsonValueArray) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			jsonValueArray.tdCommon.Type, err = r.readString()
+		case "@extra":
+			jsonValueArray.tdCommon.Extra, err = r.readString()
+		case "values":
+			if r.readNull() {
+				jsonValueArray.Values = nil
+			} else {
+				jsonValueArray.Values = make([]JsonValue, 0)
+				err = r.readArray(func() (err error) {
+					var item0 JsonValue
+					item0, err = decodeJsonValue(r)
+					jsonValueArray.Values = append(jsonValueArray.Values, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (jsonValueArray *JsonValueArray) Clone() *JsonValueArray {
+	if jsonValueArray == nil {
+		return nil
+	}
+	clone := *jsonValueArray
+	if jsonValueArray.Values != nil {
+		clone.Values = make([]JsonValue, len(jsonValueArray.Values))
+		for i0 := range jsonValueArray.Values {
+			clone.Values[i0] = cloneJsonValue(jsonValueArray.Values[i0])
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (jsonValueArray *JsonValueArray) Equal(other *JsonValueArray) bool {
+	if jsonValueArray == nil || other == nil {
+		return jsonValueArray == other
+	}
+	if len(jsonValueArray.Values) != len(other.Values) {
+		return false
+	}
+	for i0 := range jsonValueArray.Values {
+		if !equalJsonValue(jsonValueArray.Values[i0], other.Values[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (jsonValueArray *JsonValueArray) Diff(other *JsonValueArray) []Change {
+	return jsonValueArray.diff("", other, nil)
+}
+
+func (jsonValueArray *JsonValueArray) diff(path string, other *JsonValueArray, changes []Change) []Change {
+	if jsonValueArray == nil || other == nil {
+		if jsonValueArray != other {
+			changes = append(changes, Change{Path: path, Old: jsonValueArray, New: other})
+		}
+		return changes
+	}
+	if len(jsonValueArray.Values) != len(other.Values) {
+		changes = append(changes, Change{Path: fieldPath(path, "values"), Old: jsonValueArray.Values, New: other.Values})
+	} else {
+		for i0 := range jsonValueArray.Values {
+			changes = diffJsonValue(indexPath(fieldPath(path, "values"), i0), jsonValueArray.Values[i0], other.Values[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (jsonValueArray *JsonValueArray) String() string {
+	return objectString(jsonValueArray)
+}
+
+// LogValue logs the object as its summary
+func (jsonValueArray *JsonValueArray) LogValue() slog.Value {
+	return slog.StringValue(objectString(jsonValueArray))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (jsonValueArray *JsonValueArray) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, jsonValueArray)
+}
+
+func (jsonValueArray *JsonValueArray) printText(p *printer) {
+	if jsonValueArray == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("JsonValueArray")
+	p.vector("values", len(jsonValueArray.Values), func(i0 int) {
+		p.object("values", jsonValueArray.Values[i0])
+	})
+	p.endObject(state)
+}
+
 // GetJsonValueEnum return the enum type of this object
 func (jsonValueArray *JsonValueArray) GetJsonValueEnum() JsonValueEnum {
 	return JsonValueArrayType
 }
 
+// Accept calls the method of the visitor for JsonValueArray
+func (jsonValueArray *JsonValueArray) Accept(visitor JsonValueVisitor) {
+	visitor.VisitJsonValueArray(jsonValueArray)
+}
+
 // JsonValueObject Represents a JSON object
 type JsonValueObject struct {
 	tdCommon
@@ -230,11 +875,315 @@ func NewJsonValueObject(members []JsonObjectMember) *JsonValueObject {
 	return &jsonValueObjectTemp
 }
 
+// MarshalJSON marshals to json
+func (jsonValueObject *JsonValueObject) MarshalJSON() ([]byte, error) {
+	return jsonValueObject.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (jsonValueObject *JsonValueObject) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, jsonValueObject)
+}
+
+func (jsonValueObject *JsonValueObject) appendJSON(b []byte) ([]byte, error) {
+	if jsonValueObject == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, jsonValueObject.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, jsonValueObject.tdCommon.Extra)
+	b = append(b, `,"members":`...)
+	if jsonValueObject.Members == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range jsonValueObject.Members {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = jsonValueObject.Members[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (jsonValueObject *JsonValueObject) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			jsonValueObject.tdCommon.Type, err = r.readString()
+		case "@extra":
+			jsonValueObject.tdCommon.Extra, err = r.readString()
+		case "members":
+			if r.readNull() {
+				jsonValueObject.Members = nil
+			} else {
+				jsonValueObject.Members = make([]JsonObjectMember, 0)
+				err = r.readArray(func() (err error) {
+					var item0 JsonObjectMember
+					err = item0.decodeJSON(r)
+					jsonValueObject.Members = append(jsonValueObject.Members, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (jsonValueObject *JsonValueObject) Clone() *JsonValueObject {
+	if jsonValueObject == nil {
+		return nil
+	}
+	clone := *jsonValueObject
+	if jsonValueObject.Members != nil {
+		clone.Members = make([]JsonObjectMember, len(jsonValueObject.Members))
+		for i0 := range jsonValueObject.Members {
+			clone.Members[i0] = *jsonValueObject.Members[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (jsonValueObject *JsonValueObject) Equal(other *JsonValueObject) bool {
+	if jsonValueObject == nil || other == nil {
+		return jsonValueObject == other
+	}
+	if len(jsonValueObject.Members) != len(other.Members) {
+		return false
+	}
+	for i0 := range jsonValueObject.Members {
+		if !jsonValueObject.Members[i0].Equal(&other.Members[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (jsonValueObject *JsonValueObject) Diff(other *JsonValueObject) []Change {
+	return jsonValueObject.diff("", other, nil)
+}
+
+func (jsonValueObject *JsonValueObject) diff(path string, other *JsonValueObject, changes []Change) []Change {
+	if jsonValueObject == nil || other == nil {
+		if jsonValueObject != other {
+			changes = append(changes, Change{Path: path, Old: jsonValueObject, New: other})
+		}
+		return changes
+	}
+	if len(jsonValueObject.Members) != len(other.Members) {
+		changes = append(changes, Change{Path: fieldPath(path, "members"), Old: jsonValueObject.Members, New: other.Members})
+	} else {
+		for i0 := range jsonValueObject.Members {
+			changes = jsonValueObject.Members[i0].diff(indexPath(fieldPath(path, "members"), i0), &other.Members[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (jsonValueObject *JsonValueObject) String() string {
+	return objectString(jsonValueObject)
+}
+
+// LogValue logs the object as its summary
+func (jsonValueObject *JsonValueObject) LogValue() slog.Value {
+	return slog.StringValue(objectString(jsonValueObject))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (jsonValueObject *JsonValueObject) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, jsonValueObject)
+}
+
+func (jsonValueObject *JsonValueObject) printText(p *printer) {
+	if jsonValueObject == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("JsonValueObject")
+	p.vector("members", len(jsonValueObject.Members), func(i0 int) {
+		jsonValueObject.Members[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetJsonValueEnum return the enum type of this object
 func (jsonValueObject *JsonValueObject) GetJsonValueEnum() JsonValueEnum {
 	return JsonValueObjectType
 }
 
+// Accept calls the method of the visitor for JsonValueObject
+func (jsonValueObject *JsonValueObject) Accept(visitor JsonValueVisitor) {
+	visitor.VisitJsonValueObject(jsonValueObject)
+}
+
+// JsonValueVisitor visits every type of JsonValue, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to JsonValue, until they handle it too.
+type JsonValueVisitor interface {
+	VisitJsonValueNull(jsonValueNull *JsonValueNull)
+	VisitJsonValueBoolean(jsonValueBoolean *JsonValueBoolean)
+	VisitJsonValueNumber(jsonValueNumber *JsonValueNumber)
+	VisitJsonValueString(jsonValueString *JsonValueString)
+	VisitJsonValueArray(jsonValueArray *JsonValueArray)
+	VisitJsonValueObject(jsonValueObject *JsonValueObject)
+	VisitUnknownJsonValue(unknownJsonValue *UnknownJsonValue)
+}
+
+// JsonValueCases holds the function MatchJsonValue calls for each type of JsonValue;
+// types without a function are passed to the default case
+type JsonValueCases[R any] struct {
+	JsonValueNull    func(jsonValueNull *JsonValueNull) R
+	JsonValueBoolean func(jsonValueBoolean *JsonValueBoolean) R
+	JsonValueNumber  func(jsonValueNumber *JsonValueNumber) R
+	JsonValueString  func(jsonValueString *JsonValueString) R
+	JsonValueArray   func(jsonValueArray *JsonValueArray) R
+	JsonValueObject  func(jsonValueObject *JsonValueObject) R
+}
+
+// MatchJsonValue calls the case of the type of jsonValue, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement JsonValueVisitor
+// to have the compiler check every type is handled.
+func MatchJsonValue[R any](jsonValue JsonValue, cases JsonValueCases[R], defaultCase func(jsonValue JsonValue) R) R {
+	switch value := jsonValue.(type) {
+	case *JsonValueNull:
+		if cases.JsonValueNull != nil {
+			return cases.JsonValueNull(value)
+		}
+	case *JsonValueBoolean:
+		if cases.JsonValueBoolean != nil {
+			return cases.JsonValueBoolean(value)
+		}
+	case *JsonValueNumber:
+		if cases.JsonValueNumber != nil {
+			return cases.JsonValueNumber(value)
+		}
+	case *JsonValueString:
+		if cases.JsonValueString != nil {
+			return cases.JsonValueString(value)
+		}
+	case *JsonValueArray:
+		if cases.JsonValueArray != nil {
+			return cases.JsonValueArray(value)
+		}
+	case *JsonValueObject:
+		if cases.JsonValueObject != nil {
+			return cases.JsonValueObject(value)
+		}
+	}
+	return defaultCase(jsonValue)
+}
+
+// Clone returns a deep copy of the object
+func (unknownJsonValue *UnknownJsonValue) Clone() *UnknownJsonValue {
+	if unknownJsonValue == nil {
+		return nil
+	}
+	return &UnknownJsonValue{Unknown: unknownJsonValue.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownJsonValue *UnknownJsonValue) Equal(other *UnknownJsonValue) bool {
+	if unknownJsonValue == nil || other == nil {
+		return unknownJsonValue == other
+	}
+	return unknownJsonValue.Unknown.equal(other.Unknown)
+}
+
+func cloneJsonValue(jsonValue JsonValue) JsonValue {
+	switch value := jsonValue.(type) {
+	case *JsonValueNull:
+		return value.Clone()
+	case *JsonValueBoolean:
+		return value.Clone()
+	case *JsonValueNumber:
+		return value.Clone()
+	case *JsonValueString:
+		return value.Clone()
+	case *JsonValueArray:
+		return value.Clone()
+	case *JsonValueObject:
+		return value.Clone()
+	case *UnknownJsonValue:
+		return value.Clone()
+	}
+	return jsonValue
+}
+
+func equalJsonValue(a JsonValue, b JsonValue) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *JsonValueNull:
+		b, ok := b.(*JsonValueNull)
+		return ok && a.Equal(b)
+	case *JsonValueBoolean:
+		b, ok := b.(*JsonValueBoolean)
+		return ok && a.Equal(b)
+	case *JsonValueNumber:
+		b, ok := b.(*JsonValueNumber)
+		return ok && a.Equal(b)
+	case *JsonValueString:
+		b, ok := b.(*JsonValueString)
+		return ok && a.Equal(b)
+	case *JsonValueArray:
+		b, ok := b.(*JsonValueArray)
+		return ok && a.Equal(b)
+	case *JsonValueObject:
+		b, ok := b.(*JsonValueObject)
+		return ok && a.Equal(b)
+	case *UnknownJsonValue:
+		b, ok := b.(*UnknownJsonValue)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffJsonValue(path string, a JsonValue, b JsonValue, changes []Change) []Change {
+	switch a := a.(type) {
+	case *JsonValueNull:
+		if b, ok := b.(*JsonValueNull); ok {
+			return a.diff(path, b, changes)
+		}
+	case *JsonValueBoolean:
+		if b, ok := b.(*JsonValueBoolean); ok {
+			return a.diff(path, b, changes)
+		}
+	case *JsonValueNumber:
+		if b, ok := b.(*JsonValueNumber); ok {
+			return a.diff(path, b, changes)
+		}
+	case *JsonValueString:
+		if b, ok := b.(*JsonValueString); ok {
+			return a.diff(path, b, changes)
+		}
+	case *JsonValueArray:
+		if b, ok := b.(*JsonValueArray); ok {
+			return a.diff(path, b, changes)
+		}
+	case *JsonValueObject:
+		if b, ok := b.(*JsonValueObject); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalJsonValue(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
+
 // GetJsonValue Converts a JSON-serialized string to corresponding JsonValue object. Can be called synchronously
 // @param jsonString The JSON-serialized string
 func (client *Client) GetJsonValue(jsonString string) (JsonValue, error) {
@@ -247,40 +1196,95 @@ func (client *Client) GetJsonValue(jsonString string) (JsonValue, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
+		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
+	}
+
+	resultType, _ := result.Data["@type"].(string)
+	switch JsonValueEnum(resultType) {
+
+	case JsonValueNullType:
+		var jsonValue JsonValueNull
+		err = jsonUnmarshal(result.Raw, &jsonValue)
+		return &jsonValue, err
+
+	case JsonValueBooleanType:
+		var jsonValue JsonValueBoolean
+		err = jsonUnmarshal(result.Raw, &jsonValue)
+		return &jsonValue, err
+
+	case JsonValueNumberType:
+		var jsonValue JsonValueNumber
+		err = jsonUnmarshal(result.Raw, &jsonValue)
+		return &jsonValue, err
+
+	case JsonValueStringType:
+		var jsonValue JsonValueString
+		err = jsonUnmarshal(result.Raw, &jsonValue)
+		return &jsonValue, err
+
+	case JsonValueArrayType:
+		var jsonValue JsonValueArray
+		err = jsonUnmarshal(result.Raw, &jsonValue)
+		return &jsonValue, err
+
+	case JsonValueObjectType:
+		var jsonValue JsonValueObject
+		err = jsonUnmarshal(result.Raw, &jsonValue)
+		return &jsonValue, err
+
+	default:
+		return nil, fmt.Errorf("Invalid type")
+	}
+}
+
+// ExecuteGetJsonValue Synchronously executes GetJsonValue, without a client. Converts a JSON-serialized string to corresponding JsonValue object. Can be called synchronously
+// @param jsonString The JSON-serialized string
+func ExecuteGetJsonValue(jsonString string) (JsonValue, error) {
+	result, err := execute(UpdateData{
+		"@type": "getJsonValue",
+		"json":  jsonString,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
-	switch JsonValueEnum(result.Data["@type"].(string)) {
+	resultType, _ := result.Data["@type"].(string)
+	switch JsonValueEnum(resultType) {
 
 	case JsonValueNullType:
 		var jsonValue JsonValueNull
-		err = json.Unmarshal(result.Raw, &jsonValue)
+		err = jsonUnmarshal(result.Raw, &jsonValue)
 		return &jsonValue, err
 
 	case JsonValueBooleanType:
 		var jsonValue JsonValueBoolean
-		err = json.Unmarshal(result.Raw, &jsonValue)
+		err = jsonUnmarshal(result.Raw, &jsonValue)
 		return &jsonValue, err
 
 	case JsonValueNumberType:
 		var jsonValue JsonValueNumber
-		err = json.Unmarshal(result.Raw, &jsonValue)
+		err = jsonUnmarshal(result.Raw, &jsonValue)
 		return &jsonValue, err
 
 	case JsonValueStringType:
 		var jsonValue JsonValueString
-		err = json.Unmarshal(result.Raw, &jsonValue)
+		err = jsonUnmarshal(result.Raw, &jsonValue)
 		return &jsonValue, err
 
 	case JsonValueArrayType:
 		var jsonValue JsonValueArray
-		err = json.Unmarshal(result.Raw, &jsonValue)
+		err = jsonUnmarshal(result.Raw, &jsonValue)
 		return &jsonValue, err
 
 	case JsonValueObjectType:
 		var jsonValue JsonValueObject
-		err = json.Unmarshal(result.Raw, &jsonValue)
+		err = jsonUnmarshal(result.Raw, &jsonValue)
 		return &jsonValue, err
 
 	default:
@@ -298,40 +1302,41 @@ func (client *Client) GetApplicationConfig() (JsonValue, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
-	switch JsonValueEnum(result.Data["@type"].(string)) {
+	resultType, _ := result.Data["@type"].(string)
+	switch JsonValueEnum(resultType) {
 
 	case JsonValueNullType:
 		var jsonValue JsonValueNull
-		err = json.Unmarshal(result.Raw, &jsonValue)
+		err = jsonUnmarshal(result.Raw, &jsonValue)
 		return &jsonValue, err
 
 	case JsonValueBooleanType:
 		var jsonValue JsonValueBoolean
-		err = json.Unmarshal(result.Raw, &jsonValue)
+		err = jsonUnmarshal(result.Raw, &jsonValue)
 		return &jsonValue, err
 
 	case JsonValueNumberType:
 		var jsonValue JsonValueNumber
-		err = json.Unmarshal(result.Raw, &jsonValue)
+		err = jsonUnmarshal(result.Raw, &jsonValue)
 		return &jsonValue, err
 
 	case JsonValueStringType:
 		var jsonValue JsonValueString
-		err = json.Unmarshal(result.Raw, &jsonValue)
+		err = jsonUnmarshal(result.Raw, &jsonValue)
 		return &jsonValue, err
 
 	case JsonValueArrayType:
 		var jsonValue JsonValueArray
-		err = json.Unmarshal(result.Raw, &jsonValue)
+		err = jsonUnmarshal(result.Raw, &jsonValue)
 		return &jsonValue, err
 
 	case JsonValueObjectType:
 		var jsonValue JsonValueObject
-		err = json.Unmarshal(result.Raw, &jsonValue)
+		err = jsonUnmarshal(result.Raw, &jsonValue)
 		return &jsonValue, err
 
 	default:
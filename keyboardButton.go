@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // KeyboardButton Represents a single button in a bot keyboard
@@ -30,28 +31,118 @@ func NewKeyboardButton(text string, typeParam KeyboardButtonType) *KeyboardButto
 	return &keyboardButtonTemp
 }
 
+// MarshalJSON marshals to json
+func (keyboardButton *KeyboardButton) MarshalJSON() ([]byte, error) {
+	return keyboardButton.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (keyboardButton *KeyboardButton) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, keyboardButton)
+}
+
+func (keyboardButton *KeyboardButton) appendJSON(b []byte) ([]byte, error) {
+	if keyboardButton == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, keyboardButton.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, keyboardButton.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b = appendJSONString(b, keyboardButton.Text)
+	b = append(b, `,"type":`...)
+	b, err = appendJSONValue(b, keyboardButton.Type)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-		Text string `json:"text"` // Text of the button
+	return append(b, '}'), nil
+}
 
-	}{}
-	err = json.Unmarshal(b, &tempObj)
-	if err != nil {
-		return err
+func (keyboardButton *KeyboardButton) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			keyboardButton.tdCommon.Type, err = r.readString()
+		case "@extra":
+			keyboardButton.tdCommon.Extra, err = r.readString()
+		case "text":
+			keyboardButton.Text, err = r.readString()
+		case "type":
+			keyboardButton.Type, err = decodeKeyboardButtonType(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (keyboardButton *KeyboardButton) Clone() *KeyboardButton {
+	if keyboardButton == nil {
+		return nil
+	}
+	clone := *keyboardButton
+	clone.Type = cloneKeyboardButtonType(keyboardButton.Type)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (keyboardButton *KeyboardButton) Equal(other *KeyboardButton) bool {
+	if keyboardButton == nil || other == nil {
+		return keyboardButton == other
+	}
+	if keyboardButton.Text != other.Text {
+		return false
 	}
+	if !equalKeyboardButtonType(keyboardButton.Type, other.Type) {
+		return false
+	}
+	return true
+}
 
-	keyboardButton.tdCommon = tempObj.tdCommon
-	keyboardButton.Text = tempObj.Text
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (keyboardButton *KeyboardButton) Diff(other *KeyboardButton) []Change {
+	return keyboardButton.diff("", other, nil)
+}
 
-	fieldType, _ := unmarshalKeyboardButtonType(objMap["type"])
-	keyboardButton.Type = fieldType
+func (keyboardButton *KeyboardButton) diff(path string, other *KeyboardButton, changes []Change) []Change {
+	if keyboardButton == nil || other == nil {
+		if keyboardButton != other {
+			changes = append(changes, Change{Path: path, Old: keyboardButton, New: other})
+		}
+		return changes
+	}
+	if keyboardButton.Text != other.Text {
+		changes = append(changes, Change{Path: fieldPath(path, "text"), Old: keyboardButton.Text, New: other.Text})
+	}
+	changes = diffKeyboardButtonType(fieldPath(path, "type"), keyboardButton.Type, other.Type, changes)
+	return changes
+}
 
-	return nil
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (keyboardButton *KeyboardButton) String() string {
+	return objectString(keyboardButton)
+}
+
+// LogValue logs the object as its summary
+func (keyboardButton *KeyboardButton) LogValue() slog.Value {
+	return slog.StringValue(objectString(keyboardButton))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (keyboardButton *KeyboardButton) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, keyboardButton)
+}
+
+func (keyboardButton *KeyboardButton) printText(p *printer) {
+	if keyboardButton == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("KeyboardButton")
+	p.string("text", keyboardButton.Text)
+	p.object("type", keyboardButton.Type)
+	p.endObject(state)
 }
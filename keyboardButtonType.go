@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // KeyboardButtonType Describes a keyboard button type
 type KeyboardButtonType interface {
 	GetKeyboardButtonTypeEnum() KeyboardButtonTypeEnum
+	Accept(visitor KeyboardButtonTypeVisitor)
 }
 
 // KeyboardButtonTypeEnum Alias for abstract KeyboardButtonType 'Sub-Classes', used as constant-enum here
@@ -21,43 +23,94 @@ const (
 	KeyboardButtonTypeRequestPollType        KeyboardButtonTypeEnum = "keyboardButtonTypeRequestPoll"
 )
 
+// UnknownKeyboardButtonType is a KeyboardButtonType of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownKeyboardButtonType struct {
+	Unknown
+}
+
+// GetKeyboardButtonTypeEnum return the enum type of this object
+func (unknownKeyboardButtonType *UnknownKeyboardButtonType) GetKeyboardButtonTypeEnum() KeyboardButtonTypeEnum {
+	return KeyboardButtonTypeEnum(unknownKeyboardButtonType.Type)
+}
+
+// Accept calls the method of the visitor for UnknownKeyboardButtonType
+func (unknownKeyboardButtonType *UnknownKeyboardButtonType) Accept(visitor KeyboardButtonTypeVisitor) {
+	visitor.VisitUnknownKeyboardButtonType(unknownKeyboardButtonType)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownKeyboardButtonType *UnknownKeyboardButtonType) String() string {
+	return objectString(unknownKeyboardButtonType)
+}
+
+// LogValue logs the object as its summary
+func (unknownKeyboardButtonType *UnknownKeyboardButtonType) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownKeyboardButtonType))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownKeyboardButtonType *UnknownKeyboardButtonType) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownKeyboardButtonType)
+}
+
+func (unknownKeyboardButtonType *UnknownKeyboardButtonType) printText(p *printer) {
+	if unknownKeyboardButtonType == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownKeyboardButtonType")
+	p.string("@type", unknownKeyboardButtonType.Type)
+	p.endObject(state)
+}
+
 func unmarshalKeyboardButtonType(rawMsg *json.RawMessage) (KeyboardButtonType, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch KeyboardButtonTypeEnum(objMap["@type"].(string)) {
+	switch KeyboardButtonTypeEnum(typeName) {
 	case KeyboardButtonTypeTextType:
 		var keyboardButtonTypeText KeyboardButtonTypeText
-		err := json.Unmarshal(*rawMsg, &keyboardButtonTypeText)
+		err := jsonUnmarshal(*rawMsg, &keyboardButtonTypeText)
 		return &keyboardButtonTypeText, err
 
 	case KeyboardButtonTypeRequestPhoneNumberType:
 		var keyboardButtonTypeRequestPhoneNumber KeyboardButtonTypeRequestPhoneNumber
-		err := json.Unmarshal(*rawMsg, &keyboardButtonTypeRequestPhoneNumber)
+		err := jsonUnmarshal(*rawMsg, &keyboardButtonTypeRequestPhoneNumber)
 		return &keyboardButtonTypeRequestPhoneNumber, err
 
 	case KeyboardButtonTypeRequestLocationType:
 		var keyboardButtonTypeRequestLocation KeyboardButtonTypeRequestLocation
-		err := json.Unmarshal(*rawMsg, &keyboardButtonTypeRequestLocation)
+		err := jsonUnmarshal(*rawMsg, &keyboardButtonTypeRequestLocation)
 		return &keyboardButtonTypeRequestLocation, err
 
 	case KeyboardButtonTypeRequestPollType:
 		var keyboardButtonTypeRequestPoll KeyboardButtonTypeRequestPoll
-		err := json.Unmarshal(*rawMsg, &keyboardButtonTypeRequestPoll)
+		err := jsonUnmarshal(*rawMsg, &keyboardButtonTypeRequestPoll)
 		return &keyboardButtonTypeRequestPoll, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownKeyboardButtonType{Unknown: unknown}, nil
 	}
 }
 
+func decodeKeyboardButtonType(r *jsonReader) (KeyboardButtonType, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalKeyboardButtonType(rawMsg)
+}
+
 // KeyboardButtonTypeText A simple button, with text that must be sent when the button is pressed
 type KeyboardButtonTypeText struct {
 	tdCommon
@@ -69,7 +122,6 @@ func (keyboardButtonTypeText *KeyboardButtonTypeText) MessageType() string {
 }
 
 // NewKeyboardButtonTypeText creates a new KeyboardButtonTypeText
-//
 func NewKeyboardButtonTypeText() *KeyboardButtonTypeText {
 	keyboardButtonTypeTextTemp := KeyboardButtonTypeText{
 		tdCommon: tdCommon{Type: "keyboardButtonTypeText"},
@@ -78,11 +130,107 @@ func NewKeyboardButtonTypeText() *KeyboardButtonTypeText {
 	return &keyboardButtonTypeTextTemp
 }
 
+// MarshalJSON marshals to json
+func (keyboardButtonTypeText *KeyboardButtonTypeText) MarshalJSON() ([]byte, error) {
+	return keyboardButtonTypeText.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (keyboardButtonTypeText *KeyboardButtonTypeText) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, keyboardButtonTypeText)
+}
+
+func (keyboardButtonTypeText *KeyboardButtonTypeText) appendJSON(b []byte) ([]byte, error) {
+	if keyboardButtonTypeText == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, keyboardButtonTypeText.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, keyboardButtonTypeText.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (keyboardButtonTypeText *KeyboardButtonTypeText) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			keyboardButtonTypeText.tdCommon.Type, err = r.readString()
+		case "@extra":
+			keyboardButtonTypeText.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (keyboardButtonTypeText *KeyboardButtonTypeText) Clone() *KeyboardButtonTypeText {
+	if keyboardButtonTypeText == nil {
+		return nil
+	}
+	clone := *keyboardButtonTypeText
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (keyboardButtonTypeText *KeyboardButtonTypeText) Equal(other *KeyboardButtonTypeText) bool {
+	if keyboardButtonTypeText == nil || other == nil {
+		return keyboardButtonTypeText == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (keyboardButtonTypeText *KeyboardButtonTypeText) Diff(other *KeyboardButtonTypeText) []Change {
+	return keyboardButtonTypeText.diff("", other, nil)
+}
+
+func (keyboardButtonTypeText *KeyboardButtonTypeText) diff(path string, other *KeyboardButtonTypeText, changes []Change) []Change {
+	if keyboardButtonTypeText == nil || other == nil {
+		if keyboardButtonTypeText != other {
+			changes = append(changes, Change{Path: path, Old: keyboardButtonTypeText, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (keyboardButtonTypeText *KeyboardButtonTypeText) String() string {
+	return objectString(keyboardButtonTypeText)
+}
+
+// LogValue logs the object as its summary
+func (keyboardButtonTypeText *KeyboardButtonTypeText) LogValue() slog.Value {
+	return slog.StringValue(objectString(keyboardButtonTypeText))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (keyboardButtonTypeText *KeyboardButtonTypeText) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, keyboardButtonTypeText)
+}
+
+func (keyboardButtonTypeText *KeyboardButtonTypeText) printText(p *printer) {
+	if keyboardButtonTypeText == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("KeyboardButtonTypeText")
+	p.endObject(state)
+}
+
 // GetKeyboardButtonTypeEnum return the enum type of this object
 func (keyboardButtonTypeText *KeyboardButtonTypeText) GetKeyboardButtonTypeEnum() KeyboardButtonTypeEnum {
 	return KeyboardButtonTypeTextType
 }
 
+// Accept calls the method of the visitor for KeyboardButtonTypeText
+func (keyboardButtonTypeText *KeyboardButtonTypeText) Accept(visitor KeyboardButtonTypeVisitor) {
+	visitor.VisitKeyboardButtonTypeText(keyboardButtonTypeText)
+}
+
 // KeyboardButtonTypeRequestPhoneNumber A button that sends the user's phone number when pressed; available only in private chats
 type KeyboardButtonTypeRequestPhoneNumber struct {
 	tdCommon
@@ -94,7 +242,6 @@ func (keyboardButtonTypeRequestPhoneNumber *KeyboardButtonTypeRequestPhoneNumber
 }
 
 // NewKeyboardButtonTypeRequestPhoneNumber creates a new KeyboardButtonTypeRequestPhoneNumber
-//
 func NewKeyboardButtonTypeRequestPhoneNumber() *KeyboardButtonTypeRequestPhoneNumber {
 	keyboardButtonTypeRequestPhoneNumberTemp := KeyboardButtonTypeRequestPhoneNumber{
 		tdCommon: tdCommon{Type: "keyboardButtonTypeRequestPhoneNumber"},
@@ -103,11 +250,107 @@ func NewKeyboardButtonTypeRequestPhoneNumber() *KeyboardButtonTypeRequestPhoneNu
 	return &keyboardButtonTypeRequestPhoneNumberTemp
 }
 
+// MarshalJSON marshals to json
+func (keyboardButtonTypeRequestPhoneNumber *KeyboardButtonTypeRequestPhoneNumber) MarshalJSON() ([]byte, error) {
+	return keyboardButtonTypeRequestPhoneNumber.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (keyboardButtonTypeRequestPhoneNumber *KeyboardButtonTypeRequestPhoneNumber) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, keyboardButtonTypeRequestPhoneNumber)
+}
+
+func (keyboardButtonTypeRequestPhoneNumber *KeyboardButtonTypeRequestPhoneNumber) appendJSON(b []byte) ([]byte, error) {
+	if keyboardButtonTypeRequestPhoneNumber == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, keyboardButtonTypeRequestPhoneNumber.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, keyboardButtonTypeRequestPhoneNumber.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (keyboardButtonTypeRequestPhoneNumber *KeyboardButtonTypeRequestPhoneNumber) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			keyboardButtonTypeRequestPhoneNumber.tdCommon.Type, err = r.readString()
+		case "@extra":
+			keyboardButtonTypeRequestPhoneNumber.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (keyboardButtonTypeRequestPhoneNumber *KeyboardButtonTypeRequestPhoneNumber) Clone() *KeyboardButtonTypeRequestPhoneNumber {
+	if keyboardButtonTypeRequestPhoneNumber == nil {
+		return nil
+	}
+	clone := *keyboardButtonTypeRequestPhoneNumber
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (keyboardButtonTypeRequestPhoneNumber *KeyboardButtonTypeRequestPhoneNumber) Equal(other *KeyboardButtonTypeRequestPhoneNumber) bool {
+	if keyboardButtonTypeRequestPhoneNumber == nil || other == nil {
+		return keyboardButtonTypeRequestPhoneNumber == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (keyboardButtonTypeRequestPhoneNumber *KeyboardButtonTypeRequestPhoneNumber) Diff(other *KeyboardButtonTypeRequestPhoneNumber) []Change {
+	return keyboardButtonTypeRequestPhoneNumber.diff("", other, nil)
+}
+
+func (keyboardButtonTypeRequestPhoneNumber *KeyboardButtonTypeRequestPhoneNumber) diff(path string, other *KeyboardButtonTypeRequestPhoneNumber, changes []Change) []Change {
+	if keyboardButtonTypeRequestPhoneNumber == nil || other == nil {
+		if keyboardButtonTypeRequestPhoneNumber != other {
+			changes = append(changes, Change{Path: path, Old: keyboardButtonTypeRequestPhoneNumber, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (keyboardButtonTypeRequestPhoneNumber *KeyboardButtonTypeRequestPhoneNumber) String() string {
+	return objectString(keyboardButtonTypeRequestPhoneNumber)
+}
+
+// LogValue logs the object as its summary
+func (keyboardButtonTypeRequestPhoneNumber *KeyboardButtonTypeRequestPhoneNumber) LogValue() slog.Value {
+	return slog.StringValue(objectString(keyboardButtonTypeRequestPhoneNumber))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (keyboardButtonTypeRequestPhoneNumber *KeyboardButtonTypeRequestPhoneNumber) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, keyboardButtonTypeRequestPhoneNumber)
+}
+
+func (keyboardButtonTypeRequestPhoneNumber *KeyboardButtonTypeRequestPhoneNumber) printText(p *printer) {
+	if keyboardButtonTypeRequestPhoneNumber == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("KeyboardButtonTypeRequestPhoneNumber")
+	p.endObject(state)
+}
+
 // GetKeyboardButtonTypeEnum return the enum type of this object
 func (keyboardButtonTypeRequestPhoneNumber *KeyboardButtonTypeRequestPhoneNumber) GetKeyboardButtonTypeEnum() KeyboardButtonTypeEnum {
 	return KeyboardButtonTypeRequestPhoneNumberType
 }
 
+// Accept calls the method of the visitor for KeyboardButtonTypeRequestPhoneNumber
+func (keyboardButtonTypeRequestPhoneNumber *KeyboardButtonTypeRequestPhoneNumber) Accept(visitor KeyboardButtonTypeVisitor) {
+	visitor.VisitKeyboardButtonTypeRequestPhoneNumber(keyboardButtonTypeRequestPhoneNumber)
+}
+
 // KeyboardButtonTypeRequestLocation A button that sends the user's location when pressed; available only in private chats
 type KeyboardButtonTypeRequestLocation struct {
 	tdCommon
@@ -119,7 +362,6 @@ func (keyboardButtonTypeRequestLocation *KeyboardButtonTypeRequestLocation) Mess
 }
 
 // NewKeyboardButtonTypeRequestLocation creates a new KeyboardButtonTypeRequestLocation
-//
 func NewKeyboardButtonTypeRequestLocation() *KeyboardButtonTypeRequestLocation {
 	keyboardButtonTypeRequestLocationTemp := KeyboardButtonTypeRequestLocation{
 		tdCommon: tdCommon{Type: "keyboardButtonTypeRequestLocation"},
@@ -128,11 +370,107 @@ func NewKeyboardButtonTypeRequestLocation() *KeyboardButtonTypeRequestLocation {
 	return &keyboardButtonTypeRequestLocationTemp
 }
 
+// MarshalJSON marshals to json
+func (keyboardButtonTypeRequestLocation *KeyboardButtonTypeRequestLocation) MarshalJSON() ([]byte, error) {
+	return keyboardButtonTypeRequestLocation.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (keyboardButtonTypeRequestLocation *KeyboardButtonTypeRequestLocation) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, keyboardButtonTypeRequestLocation)
+}
+
+func (keyboardButtonTypeRequestLocation *KeyboardButtonTypeRequestLocation) appendJSON(b []byte) ([]byte, error) {
+	if keyboardButtonTypeRequestLocation == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, keyboardButtonTypeRequestLocation.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, keyboardButtonTypeRequestLocation.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (keyboardButtonTypeRequestLocation *KeyboardButtonTypeRequestLocation) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			keyboardButtonTypeRequestLocation.tdCommon.Type, err = r.readString()
+		case "@extra":
+			keyboardButtonTypeRequestLocation.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (keyboardButtonTypeRequestLocation *KeyboardButtonTypeRequestLocation) Clone() *KeyboardButtonTypeRequestLocation {
+	if keyboardButtonTypeRequestLocation == nil {
+		return nil
+	}
+	clone := *keyboardButtonTypeRequestLocation
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (keyboardButtonTypeRequestLocation *KeyboardButtonTypeRequestLocation) Equal(other *KeyboardButtonTypeRequestLocation) bool {
+	if keyboardButtonTypeRequestLocation == nil || other == nil {
+		return keyboardButtonTypeRequestLocation == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (keyboardButtonTypeRequestLocation *KeyboardButtonTypeRequestLocation) Diff(other *KeyboardButtonTypeRequestLocation) []Change {
+	return keyboardButtonTypeRequestLocation.diff("", other, nil)
+}
+
+func (keyboardButtonTypeRequestLocation *KeyboardButtonTypeRequestLocation) diff(path string, other *KeyboardButtonTypeRequestLocation, changes []Change) []Change {
+	if keyboardButtonTypeRequestLocation == nil || other == nil {
+		if keyboardButtonTypeRequestLocation != other {
+			changes = append(changes, Change{Path: path, Old: keyboardButtonTypeRequestLocation, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (keyboardButtonTypeRequestLocation *KeyboardButtonTypeRequestLocation) String() string {
+	return objectString(keyboardButtonTypeRequestLocation)
+}
+
+// LogValue logs the object as its summary
+func (keyboardButtonTypeRequestLocation *KeyboardButtonTypeRequestLocation) LogValue() slog.Value {
+	return slog.StringValue(objectString(keyboardButtonTypeRequestLocation))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (keyboardButtonTypeRequestLocation *KeyboardButtonTypeRequestLocation) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, keyboardButtonTypeRequestLocation)
+}
+
+func (keyboardButtonTypeRequestLocation *KeyboardButtonTypeRequestLocation) printText(p *printer) {
+	if keyboardButtonTypeRequestLocation == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("KeyboardButtonTypeRequestLocation")
+	p.endObject(state)
+}
+
 // GetKeyboardButtonTypeEnum return the enum type of this object
 func (keyboardButtonTypeRequestLocation *KeyboardButtonTypeRequestLocation) GetKeyboardButtonTypeEnum() KeyboardButtonTypeEnum {
 	return KeyboardButtonTypeRequestLocationType
 }
 
+// Accept calls the method of the visitor for KeyboardButtonTypeRequestLocation
+func (keyboardButtonTypeRequestLocation *KeyboardButtonTypeRequestLocation) Accept(visitor KeyboardButtonTypeVisitor) {
+	visitor.VisitKeyboardButtonTypeRequestLocation(keyboardButtonTypeRequestLocation)
+}
+
 // KeyboardButtonTypeRequestPoll A button that allows the user to create and send a poll when pressed; available only in private chats
 type KeyboardButtonTypeRequestPoll struct {
 	tdCommon
@@ -159,7 +497,250 @@ func NewKeyboardButtonTypeRequestPoll(forceRegular bool, forceQuiz bool) *Keyboa
 	return &keyboardButtonTypeRequestPollTemp
 }
 
+// MarshalJSON marshals to json
+func (keyboardButtonTypeRequestPoll *KeyboardButtonTypeRequestPoll) MarshalJSON() ([]byte, error) {
+	return keyboardButtonTypeRequestPoll.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (keyboardButtonTypeRequestPoll *KeyboardButtonTypeRequestPoll) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, keyboardButtonTypeRequestPoll)
+}
+
+func (keyboardButtonTypeRequestPoll *KeyboardButtonTypeRequestPoll) appendJSON(b []byte) ([]byte, error) {
+	if keyboardButtonTypeRequestPoll == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, keyboardButtonTypeRequestPoll.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, keyboardButtonTypeRequestPoll.tdCommon.Extra)
+	b = append(b, `,"force_regular":`...)
+	b = appendJSONBool(b, keyboardButtonTypeRequestPoll.ForceRegular)
+	b = append(b, `,"force_quiz":`...)
+	b = appendJSONBool(b, keyboardButtonTypeRequestPoll.ForceQuiz)
+	return append(b, '}'), nil
+}
+
+func (keyboardButtonTypeRequestPoll *KeyboardButtonTypeRequestPoll) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			keyboardButtonTypeRequestPoll.tdCommon.Type, err = r.readString()
+		case "@extra":
+			keyboardButtonTypeRequestPoll.tdCommon.Extra, err = r.readString()
+		case "force_regular":
+			keyboardButtonTypeRequestPoll.ForceRegular, err = r.readBool()
+		case "force_quiz":
+			keyboardButtonTypeRequestPoll.ForceQuiz, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (keyboardButtonTypeRequestPoll *KeyboardButtonTypeRequestPoll) Clone() *KeyboardButtonTypeRequestPoll {
+	if keyboardButtonTypeRequestPoll == nil {
+		return nil
+	}
+	clone := *keyboardButtonTypeRequestPoll
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (keyboardButtonTypeRequestPoll *KeyboardButtonTypeRequestPoll) Equal(other *KeyboardButtonTypeRequestPoll) bool {
+	if keyboardButtonTypeRequestPoll == nil || other == nil {
+		return keyboardButtonTypeRequestPoll == other
+	}
+	if keyboardButtonTypeRequestPoll.ForceRegular != other.ForceRegular {
+		return false
+	}
+	if keyboardButtonTypeRequestPoll.ForceQuiz != other.ForceQuiz {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (keyboardButtonTypeRequestPoll *KeyboardButtonTypeRequestPoll) Diff(other *KeyboardButtonTypeRequestPoll) []Change {
+	return keyboardButtonTypeRequestPoll.diff("", other, nil)
+}
+
+func (keyboardButtonTypeRequestPoll *KeyboardButtonTypeRequestPoll) diff(path string, other *KeyboardButtonTypeRequestPoll, changes []Change) []Change {
+	if keyboardButtonTypeRequestPoll == nil || other == nil {
+		if keyboardButtonTypeRequestPoll != other {
+			changes = append(changes, Change{Path: path, Old: keyboardButtonTypeRequestPoll, New: other})
+		}
+		return changes
+	}
+	if keyboardButtonTypeRequestPoll.ForceRegular != other.ForceRegular {
+		changes = append(changes, Change{Path: fieldPath(path, "force_regular"), Old: keyboardButtonTypeRequestPoll.ForceRegular, New: other.ForceRegular})
+	}
+	if keyboardButtonTypeRequestPoll.ForceQuiz != other.ForceQuiz {
+		changes = append(changes, Change{Path: fieldPath(path, "force_quiz"), Old: keyboardButtonTypeRequestPoll.ForceQuiz, New: other.ForceQuiz})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (keyboardButtonTypeRequestPoll *KeyboardButtonTypeRequestPoll) String() string {
+	return objectString(keyboardButtonTypeRequestPoll)
+}
+
+// LogValue logs the object as its summary
+func (keyboardButtonTypeRequestPoll *KeyboardButtonTypeRequestPoll) LogValue() slog.Value {
+	return slog.StringValue(objectString(keyboardButtonTypeRequestPoll))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (keyboardButtonTypeRequestPoll *KeyboardButtonTypeRequestPoll) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, keyboardButtonTypeRequestPoll)
+}
+
+func (keyboardButtonTypeRequestPoll *KeyboardButtonTypeRequestPoll) printText(p *printer) {
+	if keyboardButtonTypeRequestPoll == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("KeyboardButtonTypeRequestPoll")
+	p.bool("force_regular", keyboardButtonTypeRequestPoll.ForceRegular)
+	p.bool("force_quiz", keyboardButtonTypeRequestPoll.ForceQuiz)
+	p.endObject(state)
+}
+
 // GetKeyboardButtonTypeEnum return the enum type of this object
 func (keyboardButtonTypeRequestPoll *KeyboardButtonTypeRequestPoll) GetKeyboardButtonTypeEnum() KeyboardButtonTypeEnum {
 	return KeyboardButtonTypeRequestPollType
 }
+
+// Accept calls the method of the visitor for KeyboardButtonTypeRequestPoll
+func (keyboardButtonTypeRequestPoll *KeyboardButtonTypeRequestPoll) Accept(visitor KeyboardButtonTypeVisitor) {
+	visitor.VisitKeyboardButtonTypeRequestPoll(keyboardButtonTypeRequestPoll)
+}
+
+// KeyboardButtonTypeVisitor visits every type of KeyboardButtonType, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to KeyboardButtonType, until they handle it too.
+type KeyboardButtonTypeVisitor interface {
+	VisitKeyboardButtonTypeText(keyboardButtonTypeText *KeyboardButtonTypeText)
+	VisitKeyboardButtonTypeRequestPhoneNumber(keyboardButtonTypeRequestPhoneNumber *KeyboardButtonTypeRequestPhoneNumber)
+	VisitKeyboardButtonTypeRequestLocation(keyboardButtonTypeRequestLocation *KeyboardButtonTypeRequestLocation)
+	VisitKeyboardButtonTypeRequestPoll(keyboardButtonTypeRequestPoll *KeyboardButtonTypeRequestPoll)
+	VisitUnknownKeyboardButtonType(unknownKeyboardButtonType *UnknownKeyboardButtonType)
+}
+
+// KeyboardButtonTypeCases holds the function MatchKeyboardButtonType calls for each type of KeyboardButtonType;
+// types without a function are passed to the default case
+type KeyboardButtonTypeCases[R any] struct {
+	KeyboardButtonTypeText               func(keyboardButtonTypeText *KeyboardButtonTypeText) R
+	KeyboardButtonTypeRequestPhoneNumber func(keyboardButtonTypeRequestPhoneNumber *KeyboardButtonTypeRequestPhoneNumber) R
+	KeyboardButtonTypeRequestLocation    func(keyboardButtonTypeRequestLocation *KeyboardButtonTypeRequestLocation) R
+	KeyboardButtonTypeRequestPoll        func(keyboardButtonTypeRequestPoll *KeyboardButtonTypeRequestPoll) R
+}
+
+// MatchKeyboardButtonType calls the case of the type of keyboardButtonType, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement KeyboardButtonTypeVisitor
+// to have the compiler check every type is handled.
+func MatchKeyboardButtonType[R any](keyboardButtonType KeyboardButtonType, cases KeyboardButtonTypeCases[R], defaultCase func(keyboardButtonType KeyboardButtonType) R) R {
+	switch value := keyboardButtonType.(type) {
+	case *KeyboardButtonTypeText:
+		if cases.KeyboardButtonTypeText != nil {
+			return cases.KeyboardButtonTypeText(value)
+		}
+	case *KeyboardButtonTypeRequestPhoneNumber:
+		if cases.KeyboardButtonTypeRequestPhoneNumber != nil {
+			return cases.KeyboardButtonTypeRequestPhoneNumber(value)
+		}
+	case *KeyboardButtonTypeRequestLocation:
+		if cases.KeyboardButtonTypeRequestLocation != nil {
+			return cases.KeyboardButtonTypeRequestLocation(value)
+		}
+	case *KeyboardButtonTypeRequestPoll:
+		if cases.KeyboardButtonTypeRequestPoll != nil {
+			return cases.KeyboardButtonTypeRequestPoll(value)
+		}
+	}
+	return defaultCase(keyboardButtonType)
+}
+
+// Clone returns a deep copy of the object
+func (unknownKeyboardButtonType *UnknownKeyboardButtonType) Clone() *UnknownKeyboardButtonType {
+	if unknownKeyboardButtonType == nil {
+		return nil
+	}
+	return &UnknownKeyboardButtonType{Unknown: unknownKeyboardButtonType.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownKeyboardButtonType *UnknownKeyboardButtonType) Equal(other *UnknownKeyboardButtonType) bool {
+	if unknownKeyboardButtonType == nil || other == nil {
+		return unknownKeyboardButtonType == other
+	}
+	return unknownKeyboardButtonType.Unknown.equal(other.Unknown)
+}
+
+func cloneKeyboardButtonType(keyboardButtonType KeyboardButtonType) KeyboardButtonType {
+	switch value := keyboardButtonType.(type) {
+	case *KeyboardButtonTypeText:
+		return value.Clone()
+	case *KeyboardButtonTypeRequestPhoneNumber:
+		return value.Clone()
+	case *KeyboardButtonTypeRequestLocation:
+		return value.Clone()
+	case *KeyboardButtonTypeRequestPoll:
+		return value.Clone()
+	case *UnknownKeyboardButtonType:
+		return value.Clone()
+	}
+	return keyboardButtonType
+}
+
+func equalKeyboardButtonType(a KeyboardButtonType, b KeyboardButtonType) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *KeyboardButtonTypeText:
+		b, ok := b.(*KeyboardButtonTypeText)
+		return ok && a.Equal(b)
+	case *KeyboardButtonTypeRequestPhoneNumber:
+		b, ok := b.(*KeyboardButtonTypeRequestPhoneNumber)
+		return ok && a.Equal(b)
+	case *KeyboardButtonTypeRequestLocation:
+		b, ok := b.(*KeyboardButtonTypeRequestLocation)
+		return ok && a.Equal(b)
+	case *KeyboardButtonTypeRequestPoll:
+		b, ok := b.(*KeyboardButtonTypeRequestPoll)
+		return ok && a.Equal(b)
+	case *UnknownKeyboardButtonType:
+		b, ok := b.(*UnknownKeyboardButtonType)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffKeyboardButtonType(path string, a KeyboardButtonType, b KeyboardButtonType, changes []Change) []Change {
+	switch a := a.(type) {
+	case *KeyboardButtonTypeText:
+		if b, ok := b.(*KeyboardButtonTypeText); ok {
+			return a.diff(path, b, changes)
+		}
+	case *KeyboardButtonTypeRequestPhoneNumber:
+		if b, ok := b.(*KeyboardButtonTypeRequestPhoneNumber); ok {
+			return a.diff(path, b, changes)
+		}
+	case *KeyboardButtonTypeRequestLocation:
+		if b, ok := b.(*KeyboardButtonTypeRequestLocation); ok {
+			return a.diff(path, b, changes)
+		}
+	case *KeyboardButtonTypeRequestPoll:
+		if b, ok := b.(*KeyboardButtonTypeRequestPoll); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalKeyboardButtonType(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // LabeledPricePart Portion of the price of a product (e.g., "delivery cost", "tax amount")
 type LabeledPricePart struct {
 	tdCommon
@@ -25,3 +30,116 @@ func NewLabeledPricePart(label string, amount int64) *LabeledPricePart {
 
 	return &labeledPricePartTemp
 }
+
+// MarshalJSON marshals to json
+func (labeledPricePart *LabeledPricePart) MarshalJSON() ([]byte, error) {
+	return labeledPricePart.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (labeledPricePart *LabeledPricePart) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, labeledPricePart)
+}
+
+func (labeledPricePart *LabeledPricePart) appendJSON(b []byte) ([]byte, error) {
+	if labeledPricePart == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, labeledPricePart.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, labeledPricePart.tdCommon.Extra)
+	b = append(b, `,"label":`...)
+	b = appendJSONString(b, labeledPricePart.Label)
+	b = append(b, `,"amount":`...)
+	b = appendJSONInt(b, int64(labeledPricePart.Amount))
+	return append(b, '}'), nil
+}
+
+func (labeledPricePart *LabeledPricePart) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			labeledPricePart.tdCommon.Type, err = r.readString()
+		case "@extra":
+			labeledPricePart.tdCommon.Extra, err = r.readString()
+		case "label":
+			labeledPricePart.Label, err = r.readString()
+		case "amount":
+			labeledPricePart.Amount, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (labeledPricePart *LabeledPricePart) Clone() *LabeledPricePart {
+	if labeledPricePart == nil {
+		return nil
+	}
+	clone := *labeledPricePart
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (labeledPricePart *LabeledPricePart) Equal(other *LabeledPricePart) bool {
+	if labeledPricePart == nil || other == nil {
+		return labeledPricePart == other
+	}
+	if labeledPricePart.Label != other.Label {
+		return false
+	}
+	if labeledPricePart.Amount != other.Amount {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (labeledPricePart *LabeledPricePart) Diff(other *LabeledPricePart) []Change {
+	return labeledPricePart.diff("", other, nil)
+}
+
+func (labeledPricePart *LabeledPricePart) diff(path string, other *LabeledPricePart, changes []Change) []Change {
+	if labeledPricePart == nil || other == nil {
+		if labeledPricePart != other {
+			changes = append(changes, Change{Path: path, Old: labeledPricePart, New: other})
+		}
+		return changes
+	}
+	if labeledPricePart.Label != other.Label {
+		changes = append(changes, Change{Path: fieldPath(path, "label"), Old: labeledPricePart.Label, New: other.Label})
+	}
+	if labeledPricePart.Amount != other.Amount {
+		changes = append(changes, Change{Path: fieldPath(path, "amount"), Old: labeledPricePart.Amount, New: other.Amount})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (labeledPricePart *LabeledPricePart) String() string {
+	return objectString(labeledPricePart)
+}
+
+// LogValue logs the object as its summary
+func (labeledPricePart *LabeledPricePart) LogValue() slog.Value {
+	return slog.StringValue(objectString(labeledPricePart))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (labeledPricePart *LabeledPricePart) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, labeledPricePart)
+}
+
+func (labeledPricePart *LabeledPricePart) printText(p *printer) {
+	if labeledPricePart == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("LabeledPricePart")
+	p.string("label", labeledPricePart.Label)
+	p.int("amount", int64(labeledPricePart.Amount))
+	p.endObject(state)
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // LanguagePackInfo Contains information about a language pack
@@ -64,6 +64,240 @@ func NewLanguagePackInfo(id string, baseLanguagePackId string, name string, nati
 	return &languagePackInfoTemp
 }
 
+// MarshalJSON marshals to json
+func (languagePackInfo *LanguagePackInfo) MarshalJSON() ([]byte, error) {
+	return languagePackInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (languagePackInfo *LanguagePackInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, languagePackInfo)
+}
+
+func (languagePackInfo *LanguagePackInfo) appendJSON(b []byte) ([]byte, error) {
+	if languagePackInfo == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, languagePackInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, languagePackInfo.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, languagePackInfo.Id)
+	b = append(b, `,"base_language_pack_id":`...)
+	b = appendJSONString(b, languagePackInfo.BaseLanguagePackId)
+	b = append(b, `,"name":`...)
+	b = appendJSONString(b, languagePackInfo.Name)
+	b = append(b, `,"native_name":`...)
+	b = appendJSONString(b, languagePackInfo.NativeName)
+	b = append(b, `,"plural_code":`...)
+	b = appendJSONString(b, languagePackInfo.PluralCode)
+	b = append(b, `,"is_official":`...)
+	b = appendJSONBool(b, languagePackInfo.IsOfficial)
+	b = append(b, `,"is_rtl":`...)
+	b = appendJSONBool(b, languagePackInfo.IsRtl)
+	b = append(b, `,"is_beta":`...)
+	b = appendJSONBool(b, languagePackInfo.IsBeta)
+	b = append(b, `,"is_installed":`...)
+	b = appendJSONBool(b, languagePackInfo.IsInstalled)
+	b = append(b, `,"total_string_count":`...)
+	b = appendJSONInt(b, int64(languagePackInfo.TotalStringCount))
+	b = append(b, `,"translated_string_count":`...)
+	b = appendJSONInt(b, int64(languagePackInfo.TranslatedStringCount))
+	b = append(b, `,"local_string_count":`...)
+	b = appendJSONInt(b, int64(languagePackInfo.LocalStringCount))
+	b = append(b, `,"translation_url":`...)
+	b = appendJSONString(b, languagePackInfo.TranslationUrl)
+	return append(b, '}'), nil
+}
+
+func (languagePackInfo *LanguagePackInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			languagePackInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			languagePackInfo.tdCommon.Extra, err = r.readString()
+		case "id":
+			languagePackInfo.Id, err = r.readString()
+		case "base_language_pack_id":
+			languagePackInfo.BaseLanguagePackId, err = r.readString()
+		case "name":
+			languagePackInfo.Name, err = r.readString()
+		case "native_name":
+			languagePackInfo.NativeName, err = r.readString()
+		case "plural_code":
+			languagePackInfo.PluralCode, err = r.readString()
+		case "is_official":
+			languagePackInfo.IsOfficial, err = r.readBool()
+		case "is_rtl":
+			languagePackInfo.IsRtl, err = r.readBool()
+		case "is_beta":
+			languagePackInfo.IsBeta, err = r.readBool()
+		case "is_installed":
+			languagePackInfo.IsInstalled, err = r.readBool()
+		case "total_string_count":
+			languagePackInfo.TotalStringCount, err = r.readInt32()
+		case "translated_string_count":
+			languagePackInfo.TranslatedStringCount, err = r.readInt32()
+		case "local_string_count":
+			languagePackInfo.LocalStringCount, err = r.readInt32()
+		case "translation_url":
+			languagePackInfo.TranslationUrl, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (languagePackInfo *LanguagePackInfo) Clone() *LanguagePackInfo {
+	if languagePackInfo == nil {
+		return nil
+	}
+	clone := *languagePackInfo
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (languagePackInfo *LanguagePackInfo) Equal(other *LanguagePackInfo) bool {
+	if languagePackInfo == nil || other == nil {
+		return languagePackInfo == other
+	}
+	if languagePackInfo.Id != other.Id {
+		return false
+	}
+	if languagePackInfo.BaseLanguagePackId != other.BaseLanguagePackId {
+		return false
+	}
+	if languagePackInfo.Name != other.Name {
+		return false
+	}
+	if languagePackInfo.NativeName != other.NativeName {
+		return false
+	}
+	if languagePackInfo.PluralCode != other.PluralCode {
+		return false
+	}
+	if languagePackInfo.IsOfficial != other.IsOfficial {
+		return false
+	}
+	if languagePackInfo.IsRtl != other.IsRtl {
+		return false
+	}
+	if languagePackInfo.IsBeta != other.IsBeta {
+		return false
+	}
+	if languagePackInfo.IsInstalled != other.IsInstalled {
+		return false
+	}
+	if languagePackInfo.TotalStringCount != other.TotalStringCount {
+		return false
+	}
+	if languagePackInfo.TranslatedStringCount != other.TranslatedStringCount {
+		return false
+	}
+	if languagePackInfo.LocalStringCount != other.LocalStringCount {
+		return false
+	}
+	if languagePackInfo.TranslationUrl != other.TranslationUrl {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (languagePackInfo *LanguagePackInfo) Diff(other *LanguagePackInfo) []Change {
+	return languagePackInfo.diff("", other, nil)
+}
+
+func (languagePackInfo *LanguagePackInfo) diff(path string, other *LanguagePackInfo, changes []Change) []Change {
+	if languagePackInfo == nil || other == nil {
+		if languagePackInfo != other {
+			changes = append(changes, Change{Path: path, Old: languagePackInfo, New: other})
+		}
+		return changes
+	}
+	if languagePackInfo.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: languagePackInfo.Id, New: other.Id})
+	}
+	if languagePackInfo.BaseLanguagePackId != other.BaseLanguagePackId {
+		changes = append(changes, Change{Path: fieldPath(path, "base_language_pack_id"), Old: languagePackInfo.BaseLanguagePackId, New: other.BaseLanguagePackId})
+	}
+	if languagePackInfo.Name != other.Name {
+		changes = append(changes, Change{Path: fieldPath(path, "name"), Old: languagePackInfo.Name, New: other.Name})
+	}
+	if languagePackInfo.NativeName != other.NativeName {
+		changes = append(changes, Change{Path: fieldPath(path, "native_name"), Old: languagePackInfo.NativeName, New: other.NativeName})
+	}
+	if languagePackInfo.PluralCode != other.PluralCode {
+		changes = append(changes, Change{Path: fieldPath(path, "plural_code"), Old: languagePackInfo.PluralCode, New: other.PluralCode})
+	}
+	if languagePackInfo.IsOfficial != other.IsOfficial {
+		changes = append(changes, Change{Path: fieldPath(path, "is_official"), Old: languagePackInfo.IsOfficial, New: other.IsOfficial})
+	}
+	if languagePackInfo.IsRtl != other.IsRtl {
+		changes = append(changes, Change{Path: fieldPath(path, "is_rtl"), Old: languagePackInfo.IsRtl, New: other.IsRtl})
+	}
+	if languagePackInfo.IsBeta != other.IsBeta {
+		changes = append(changes, Change{Path: fieldPath(path, "is_beta"), Old: languagePackInfo.IsBeta, New: other.IsBeta})
+	}
+	if languagePackInfo.IsInstalled != other.IsInstalled {
+		changes = append(changes, Change{Path: fieldPath(path, "is_installed"), Old: languagePackInfo.IsInstalled, New: other.IsInstalled})
+	}
+	if languagePackInfo.TotalStringCount != other.TotalStringCount {
+		changes = append(changes, Change{Path: fieldPath(path, "total_string_count"), Old: languagePackInfo.TotalStringCount, New: other.TotalStringCount})
+	}
+	if languagePackInfo.TranslatedStringCount != other.TranslatedStringCount {
+		changes = append(changes, Change{Path: fieldPath(path, "translated_string_count"), Old: languagePackInfo.TranslatedStringCount, New: other.TranslatedStringCount})
+	}
+	if languagePackInfo.LocalStringCount != other.LocalStringCount {
+		changes = append(changes, Change{Path: fieldPath(path, "local_string_count"), Old: languagePackInfo.LocalStringCount, New: other.LocalStringCount})
+	}
+	if languagePackInfo.TranslationUrl != other.TranslationUrl {
+		changes = append(changes, Change{Path: fieldPath(path, "translation_url"), Old: languagePackInfo.TranslationUrl, New: other.TranslationUrl})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (languagePackInfo *LanguagePackInfo) String() string {
+	return objectString(languagePackInfo)
+}
+
+// LogValue logs the object as its summary
+func (languagePackInfo *LanguagePackInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(languagePackInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (languagePackInfo *LanguagePackInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, languagePackInfo)
+}
+
+func (languagePackInfo *LanguagePackInfo) printText(p *printer) {
+	if languagePackInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("LanguagePackInfo")
+	p.string("id", languagePackInfo.Id)
+	p.string("base_language_pack_id", languagePackInfo.BaseLanguagePackId)
+	p.string("name", languagePackInfo.Name)
+	p.string("native_name", languagePackInfo.NativeName)
+	p.string("plural_code", languagePackInfo.PluralCode)
+	p.bool("is_official", languagePackInfo.IsOfficial)
+	p.bool("is_rtl", languagePackInfo.IsRtl)
+	p.bool("is_beta", languagePackInfo.IsBeta)
+	p.bool("is_installed", languagePackInfo.IsInstalled)
+	p.int("total_string_count", int64(languagePackInfo.TotalStringCount))
+	p.int("translated_string_count", int64(languagePackInfo.TranslatedStringCount))
+	p.int("local_string_count", int64(languagePackInfo.LocalStringCount))
+	p.string("translation_url", languagePackInfo.TranslationUrl)
+	p.endObject(state)
+}
+
 // GetLanguagePackInfo Returns information about a language pack. Returned language pack identifier may be different from a provided one. Can be called before authorization
 // @param languagePackId Language pack identifier
 func (client *Client) GetLanguagePackInfo(languagePackId string) (*LanguagePackInfo, error) {
@@ -76,11 +310,11 @@ func (client *Client) GetLanguagePackInfo(languagePackId string) (*LanguagePackI
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var languagePackInfo LanguagePackInfo
-	err = json.Unmarshal(result.Raw, &languagePackInfo)
+	err = jsonUnmarshal(result.Raw, &languagePackInfo)
 	return &languagePackInfo, err
 }
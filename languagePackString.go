@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // LanguagePackString Represents one language pack string
@@ -30,28 +31,118 @@ func NewLanguagePackString(key string, value LanguagePackStringValue) *LanguageP
 	return &languagePackStringTemp
 }
 
+// MarshalJSON marshals to json
+func (languagePackString *LanguagePackString) MarshalJSON() ([]byte, error) {
+	return languagePackString.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (languagePackString *LanguagePackString) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, languagePackString)
+}
+
+func (languagePackString *LanguagePackString) appendJSON(b []byte) ([]byte, error) {
+	if languagePackString == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, languagePackString.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, languagePackString.tdCommon.Extra)
+	b = append(b, `,"key":`...)
+	b = appendJSONString(b, languagePackString.Key)
+	b = append(b, `,"value":`...)
+	b, err = appendJSONValue(b, languagePackString.Value)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-		Key string `json:"key"` // String key
+	return append(b, '}'), nil
+}
 
-	}{}
-	err = json.Unmarshal(b, &tempObj)
-	if err != nil {
-		return err
+func (languagePackString *LanguagePackString) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			languagePackString.tdCommon.Type, err = r.readString()
+		case "@extra":
+			languagePackString.tdCommon.Extra, err = r.readString()
+		case "key":
+			languagePackString.Key, err = r.readString()
+		case "value":
+			languagePackString.Value, err = decodeLanguagePackStringValue(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (languagePackString *LanguagePackString) Clone() *LanguagePackString {
+	if languagePackString == nil {
+		return nil
+	}
+	clone := *languagePackString
+	clone.Value = cloneLanguagePackStringValue(languagePackString.Value)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (languagePackString *LanguagePackString) Equal(other *LanguagePackString) bool {
+	if languagePackString == nil || other == nil {
+		return languagePackString == other
+	}
+	if languagePackString.Key != other.Key {
+		return false
 	}
+	if !equalLanguagePackStringValue(languagePackString.Value, other.Value) {
+		return false
+	}
+	return true
+}
 
-	languagePackString.tdCommon = tempObj.tdCommon
-	languagePackString.Key = tempObj.Key
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (languagePackString *LanguagePackString) Diff(other *LanguagePackString) []Change {
+	return languagePackString.diff("", other, nil)
+}
 
-	fieldValue, _ := unmarshalLanguagePackStringValue(objMap["value"])
-	languagePackString.Value = fieldValue
+func (languagePackString *LanguagePackString) diff(path string, other *LanguagePackString, changes []Change) []Change {
+	if languagePackString == nil || other == nil {
+		if languagePackString != other {
+			changes = append(changes, Change{Path: path, Old: languagePackString, New: other})
+		}
+		return changes
+	}
+	if languagePackString.Key != other.Key {
+		changes = append(changes, Change{Path: fieldPath(path, "key"), Old: languagePackString.Key, New: other.Key})
+	}
+	changes = diffLanguagePackStringValue(fieldPath(path, "value"), languagePackString.Value, other.Value, changes)
+	return changes
+}
 
-	return nil
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (languagePackString *LanguagePackString) String() string {
+	return objectString(languagePackString)
+}
+
+// LogValue logs the object as its summary
+func (languagePackString *LanguagePackString) LogValue() slog.Value {
+	return slog.StringValue(objectString(languagePackString))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (languagePackString *LanguagePackString) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, languagePackString)
+}
+
+func (languagePackString *LanguagePackString) printText(p *printer) {
+	if languagePackString == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("LanguagePackString")
+	p.string("key", languagePackString.Key)
+	p.object("value", languagePackString.Value)
+	p.endObject(state)
 }
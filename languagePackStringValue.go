@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // LanguagePackStringValue Represents the value of a string in a language pack
 type LanguagePackStringValue interface {
 	GetLanguagePackStringValueEnum() LanguagePackStringValueEnum
+	Accept(visitor LanguagePackStringValueVisitor)
 }
 
 // LanguagePackStringValueEnum Alias for abstract LanguagePackStringValue 'Sub-Classes', used as constant-enum here
@@ -20,36 +22,87 @@ const (
 	LanguagePackStringValueDeletedType    LanguagePackStringValueEnum = "languagePackStringValueDeleted"
 )
 
+// UnknownLanguagePackStringValue is a LanguagePackStringValue of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownLanguagePackStringValue struct {
+	Unknown
+}
+
+// GetLanguagePackStringValueEnum return the enum type of this object
+func (unknownLanguagePackStringValue *UnknownLanguagePackStringValue) GetLanguagePackStringValueEnum() LanguagePackStringValueEnum {
+	return LanguagePackStringValueEnum(unknownLanguagePackStringValue.Type)
+}
+
+// Accept calls the method of the visitor for UnknownLanguagePackStringValue
+func (unknownLanguagePackStringValue *UnknownLanguagePackStringValue) Accept(visitor LanguagePackStringValueVisitor) {
+	visitor.VisitUnknownLanguagePackStringValue(unknownLanguagePackStringValue)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownLanguagePackStringValue *UnknownLanguagePackStringValue) String() string {
+	return objectString(unknownLanguagePackStringValue)
+}
+
+// LogValue logs the object as its summary
+func (unknownLanguagePackStringValue *UnknownLanguagePackStringValue) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownLanguagePackStringValue))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownLanguagePackStringValue *UnknownLanguagePackStringValue) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownLanguagePackStringValue)
+}
+
+func (unknownLanguagePackStringValue *UnknownLanguagePackStringValue) printText(p *printer) {
+	if unknownLanguagePackStringValue == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownLanguagePackStringValue")
+	p.string("@type", unknownLanguagePackStringValue.Type)
+	p.endObject(state)
+}
+
 func unmarshalLanguagePackStringValue(rawMsg *json.RawMessage) (LanguagePackStringValue, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch LanguagePackStringValueEnum(objMap["@type"].(string)) {
+	switch LanguagePackStringValueEnum(typeName) {
 	case LanguagePackStringValueOrdinaryType:
 		var languagePackStringValueOrdinary LanguagePackStringValueOrdinary
-		err := json.Unmarshal(*rawMsg, &languagePackStringValueOrdinary)
+		err := jsonUnmarshal(*rawMsg, &languagePackStringValueOrdinary)
 		return &languagePackStringValueOrdinary, err
 
 	case LanguagePackStringValuePluralizedType:
 		var languagePackStringValuePluralized LanguagePackStringValuePluralized
-		err := json.Unmarshal(*rawMsg, &languagePackStringValuePluralized)
+		err := jsonUnmarshal(*rawMsg, &languagePackStringValuePluralized)
 		return &languagePackStringValuePluralized, err
 
 	case LanguagePackStringValueDeletedType:
 		var languagePackStringValueDeleted LanguagePackStringValueDeleted
-		err := json.Unmarshal(*rawMsg, &languagePackStringValueDeleted)
+		err := jsonUnmarshal(*rawMsg, &languagePackStringValueDeleted)
 		return &languagePackStringValueDeleted, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownLanguagePackStringValue{Unknown: unknown}, nil
+	}
+}
+
+func decodeLanguagePackStringValue(r *jsonReader) (LanguagePackStringValue, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
 	}
+	return unmarshalLanguagePackStringValue(rawMsg)
 }
 
 // LanguagePackStringValueOrdinary An ordinary language pack string
@@ -75,11 +128,118 @@ func NewLanguagePackStringValueOrdinary(value string) *LanguagePackStringValueOr
 	return &languagePackStringValueOrdinaryTemp
 }
 
+// MarshalJSON marshals to json
+func (languagePackStringValueOrdinary *LanguagePackStringValueOrdinary) MarshalJSON() ([]byte, error) {
+	return languagePackStringValueOrdinary.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (languagePackStringValueOrdinary *LanguagePackStringValueOrdinary) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, languagePackStringValueOrdinary)
+}
+
+func (languagePackStringValueOrdinary *LanguagePackStringValueOrdinary) appendJSON(b []byte) ([]byte, error) {
+	if languagePackStringValueOrdinary == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, languagePackStringValueOrdinary.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, languagePackStringValueOrdinary.tdCommon.Extra)
+	b = append(b, `,"value":`...)
+	b = appendJSONString(b, languagePackStringValueOrdinary.Value)
+	return append(b, '}'), nil
+}
+
+func (languagePackStringValueOrdinary *LanguagePackStringValueOrdinary) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			languagePackStringValueOrdinary.tdCommon.Type, err = r.readString()
+		case "@extra":
+			languagePackStringValueOrdinary.tdCommon.Extra, err = r.readString()
+		case "value":
+			languagePackStringValueOrdinary.Value, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (languagePackStringValueOrdinary *LanguagePackStringValueOrdinary) Clone() *LanguagePackStringValueOrdinary {
+	if languagePackStringValueOrdinary == nil {
+		return nil
+	}
+	clone := *languagePackStringValueOrdinary
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (languagePackStringValueOrdinary *LanguagePackStringValueOrdinary) Equal(other *LanguagePackStringValueOrdinary) bool {
+	if languagePackStringValueOrdinary == nil || other == nil {
+		return languagePackStringValueOrdinary == other
+	}
+	if languagePackStringValueOrdinary.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (languagePackStringValueOrdinary *LanguagePackStringValueOrdinary) Diff(other *LanguagePackStringValueOrdinary) []Change {
+	return languagePackStringValueOrdinary.diff("", other, nil)
+}
+
+func (languagePackStringValueOrdinary *LanguagePackStringValueOrdinary) diff(path string, other *LanguagePackStringValueOrdinary, changes []Change) []Change {
+	if languagePackStringValueOrdinary == nil || other == nil {
+		if languagePackStringValueOrdinary != other {
+			changes = append(changes, Change{Path: path, Old: languagePackStringValueOrdinary, New: other})
+		}
+		return changes
+	}
+	if languagePackStringValueOrdinary.Value != other.Value {
+		changes = append(changes, Change{Path: fieldPath(path, "value"), Old: languagePackStringValueOrdinary.Value, New: other.Value})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (languagePackStringValueOrdinary *LanguagePackStringValueOrdinary) String() string {
+	return objectString(languagePackStringValueOrdinary)
+}
+
+// LogValue logs the object as its summary
+func (languagePackStringValueOrdinary *LanguagePackStringValueOrdinary) LogValue() slog.Value {
+	return slog.StringValue(objectString(languagePackStringValueOrdinary))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (languagePackStringValueOrdinary *LanguagePackStringValueOrdinary) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, languagePackStringValueOrdinary)
+}
+
+func (languagePackStringValueOrdinary *LanguagePackStringValueOrdinary) printText(p *printer) {
+	if languagePackStringValueOrdinary == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("LanguagePackStringValueOrdinary")
+	p.string("value", languagePackStringValueOrdinary.Value)
+	p.endObject(state)
+}
+
 // GetLanguagePackStringValueEnum return the enum type of this object
 func (languagePackStringValueOrdinary *LanguagePackStringValueOrdinary) GetLanguagePackStringValueEnum() LanguagePackStringValueEnum {
 	return LanguagePackStringValueOrdinaryType
 }
 
+// Accept calls the method of the visitor for LanguagePackStringValueOrdinary
+func (languagePackStringValueOrdinary *LanguagePackStringValueOrdinary) Accept(visitor LanguagePackStringValueVisitor) {
+	visitor.VisitLanguagePackStringValueOrdinary(languagePackStringValueOrdinary)
+}
+
 // LanguagePackStringValuePluralized A language pack string which has different forms based on the number of some object it mentions. See https://www.unicode.org/cldr/charts/latest/supplemental/language_plural_rules.html for more info
 type LanguagePackStringValuePluralized struct {
 	tdCommon
@@ -118,11 +278,173 @@ func NewLanguagePackStringValuePluralized(zeroValue string, oneValue string, two
 	return &languagePackStringValuePluralizedTemp
 }
 
+// MarshalJSON marshals to json
+func (languagePackStringValuePluralized *LanguagePackStringValuePluralized) MarshalJSON() ([]byte, error) {
+	return languagePackStringValuePluralized.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (languagePackStringValuePluralized *LanguagePackStringValuePluralized) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, languagePackStringValuePluralized)
+}
+
+func (languagePackStringValuePluralized *LanguagePackStringValuePluralized) appendJSON(b []byte) ([]byte, error) {
+	if languagePackStringValuePluralized == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, languagePackStringValuePluralized.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, languagePackStringValuePluralized.tdCommon.Extra)
+	b = append(b, `,"zero_value":`...)
+	b = appendJSONString(b, languagePackStringValuePluralized.ZeroValue)
+	b = append(b, `,"one_value":`...)
+	b = appendJSONString(b, languagePackStringValuePluralized.OneValue)
+	b = append(b, `,"two_value":`...)
+	b = appendJSONString(b, languagePackStringValuePluralized.TwoValue)
+	b = append(b, `,"few_value":`...)
+	b = appendJSONString(b, languagePackStringValuePluralized.FewValue)
+	b = append(b, `,"many_value":`...)
+	b = appendJSONString(b, languagePackStringValuePluralized.ManyValue)
+	b = append(b, `,"other_value":`...)
+	b = appendJSONString(b, languagePackStringValuePluralized.OtherValue)
+	return append(b, '}'), nil
+}
+
+func (languagePackStringValuePluralized *LanguagePackStringValuePluralized) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			languagePackStringValuePluralized.tdCommon.Type, err = r.readString()
+		case "@extra":
+			languagePackStringValuePluralized.tdCommon.Extra, err = r.readString()
+		case "zero_value":
+			languagePackStringValuePluralized.ZeroValue, err = r.readString()
+		case "one_value":
+			languagePackStringValuePluralized.OneValue, err = r.readString()
+		case "two_value":
+			languagePackStringValuePluralized.TwoValue, err = r.readString()
+		case "few_value":
+			languagePackStringValuePluralized.FewValue, err = r.readString()
+		case "many_value":
+			languagePackStringValuePluralized.ManyValue, err = r.readString()
+		case "other_value":
+			languagePackStringValuePluralized.OtherValue, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (languagePackStringValuePluralized *LanguagePackStringValuePluralized) Clone() *LanguagePackStringValuePluralized {
+	if languagePackStringValuePluralized == nil {
+		return nil
+	}
+	clone := *languagePackStringValuePluralized
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (languagePackStringValuePluralized *LanguagePackStringValuePluralized) Equal(other *LanguagePackStringValuePluralized) bool {
+	if languagePackStringValuePluralized == nil || other == nil {
+		return languagePackStringValuePluralized == other
+	}
+	if languagePackStringValuePluralized.ZeroValue != other.ZeroValue {
+		return false
+	}
+	if languagePackStringValuePluralized.OneValue != other.OneValue {
+		return false
+	}
+	if languagePackStringValuePluralized.TwoValue != other.TwoValue {
+		return false
+	}
+	if languagePackStringValuePluralized.FewValue != other.FewValue {
+		return false
+	}
+	if languagePackStringValuePluralized.ManyValue != other.ManyValue {
+		return false
+	}
+	if languagePackStringValuePluralized.OtherValue != other.OtherValue {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (languagePackStringValuePluralized *LanguagePackStringValuePluralized) Diff(other *LanguagePackStringValuePluralized) []Change {
+	return languagePackStringValuePluralized.diff("", other, nil)
+}
+
+func (languagePackStringValuePluralized *LanguagePackStringValuePluralized) diff(path string, other *LanguagePackStringValuePluralized, changes []Change) []Change {
+	if languagePackStringValuePluralized == nil || other == nil {
+		if languagePackStringValuePluralized != other {
+			changes = append(changes, Change{Path: path, Old: languagePackStringValuePluralized, New: other})
+		}
+		return changes
+	}
+	if languagePackStringValuePluralized.ZeroValue != other.ZeroValue {
+		changes = append(changes, Change{Path: fieldPath(path, "zero_value"), Old: languagePackStringValuePluralized.ZeroValue, New: other.ZeroValue})
+	}
+	if languagePackStringValuePluralized.OneValue != other.OneValue {
+		changes = append(changes, Change{Path: fieldPath(path, "one_value"), Old: languagePackStringValuePluralized.OneValue, New: other.OneValue})
+	}
+	if languagePackStringValuePluralized.TwoValue != other.TwoValue {
+		changes = append(changes, Change{Path: fieldPath(path, "two_value"), Old: languagePackStringValuePluralized.TwoValue, New: other.TwoValue})
+	}
+	if languagePackStringValuePluralized.FewValue != other.FewValue {
+		changes = append(changes, Change{Path: fieldPath(path, "few_value"), Old: languagePackStringValuePluralized.FewValue, New: other.FewValue})
+	}
+	if languagePackStringValuePluralized.ManyValue != other.ManyValue {
+		changes = append(changes, Change{Path: fieldPath(path, "many_value"), Old: languagePackStringValuePluralized.ManyValue, New: other.ManyValue})
+	}
+	if languagePackStringValuePluralized.OtherValue != other.OtherValue {
+		changes = append(changes, Change{Path: fieldPath(path, "other_value"), Old: languagePackStringValuePluralized.OtherValue, New: other.OtherValue})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (languagePackStringValuePluralized *LanguagePackStringValuePluralized) String() string {
+	return objectString(languagePackStringValuePluralized)
+}
+
+// LogValue logs the object as its summary
+func (languagePackStringValuePluralized *LanguagePackStringValuePluralized) LogValue() slog.Value {
+	return slog.StringValue(objectString(languagePackStringValuePluralized))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (languagePackStringValuePluralized *LanguagePackStringValuePluralized) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, languagePackStringValuePluralized)
+}
+
+func (languagePackStringValuePluralized *LanguagePackStringValuePluralized) printText(p *printer) {
+	if languagePackStringValuePluralized == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("LanguagePackStringValuePluralized")
+	p.string("zero_value", languagePackStringValuePluralized.ZeroValue)
+	p.string("one_value", languagePackStringValuePluralized.OneValue)
+	p.string("two_value", languagePackStringValuePluralized.TwoValue)
+	p.string("few_value", languagePackStringValuePluralized.FewValue)
+	p.string("many_value", languagePackStringValuePluralized.ManyValue)
+	p.string("other_value", languagePackStringValuePluralized.OtherValue)
+	p.endObject(state)
+}
+
 // GetLanguagePackStringValueEnum return the enum type of this object
 func (languagePackStringValuePluralized *LanguagePackStringValuePluralized) GetLanguagePackStringValueEnum() LanguagePackStringValueEnum {
 	return LanguagePackStringValuePluralizedType
 }
 
+// Accept calls the method of the visitor for LanguagePackStringValuePluralized
+func (languagePackStringValuePluralized *LanguagePackStringValuePluralized) Accept(visitor LanguagePackStringValueVisitor) {
+	visitor.VisitLanguagePackStringValuePluralized(languagePackStringValuePluralized)
+}
+
 // LanguagePackStringValueDeleted A deleted language pack string, the value must be taken from the built-in English language pack
 type LanguagePackStringValueDeleted struct {
 	tdCommon
@@ -134,7 +456,6 @@ func (languagePackStringValueDeleted *LanguagePackStringValueDeleted) MessageTyp
 }
 
 // NewLanguagePackStringValueDeleted creates a new LanguagePackStringValueDeleted
-//
 func NewLanguagePackStringValueDeleted() *LanguagePackStringValueDeleted {
 	languagePackStringValueDeletedTemp := LanguagePackStringValueDeleted{
 		tdCommon: tdCommon{Type: "languagePackStringValueDeleted"},
@@ -143,11 +464,217 @@ func NewLanguagePackStringValueDeleted() *LanguagePackStringValueDeleted {
 	return &languagePackStringValueDeletedTemp
 }
 
+// MarshalJSON marshals to json
+func (languagePackStringValueDeleted *LanguagePackStringValueDeleted) MarshalJSON() ([]byte, error) {
+	return languagePackStringValueDeleted.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (languagePackStringValueDeleted *LanguagePackStringValueDeleted) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, languagePackStringValueDeleted)
+}
+
+func (languagePackStringValueDeleted *LanguagePackStringValueDeleted) appendJSON(b []byte) ([]byte, error) {
+	if languagePackStringValueDeleted == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, languagePackStringValueDeleted.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, languagePackStringValueDeleted.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (languagePackStringValueDeleted *LanguagePackStringValueDeleted) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			languagePackStringValueDeleted.tdCommon.Type, err = r.readString()
+		case "@extra":
+			languagePackStringValueDeleted.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (languagePackStringValueDeleted *LanguagePackStringValueDeleted) Clone() *LanguagePackStringValueDeleted {
+	if languagePackStringValueDeleted == nil {
+		return nil
+	}
+	clone := *languagePackStringValueDeleted
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (languagePackStringValueDeleted *LanguagePackStringValueDeleted) Equal(other *LanguagePackStringValueDeleted) bool {
+	if languagePackStringValueDeleted == nil || other == nil {
+		return languagePackStringValueDeleted == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (languagePackStringValueDeleted *LanguagePackStringValueDeleted) Diff(other *LanguagePackStringValueDeleted) []Change {
+	return languagePackStringValueDeleted.diff("", other, nil)
+}
+
+func (languagePackStringValueDeleted *LanguagePackStringValueDeleted) diff(path string, other *LanguagePackStringValueDeleted, changes []Change) []Change {
+	if languagePackStringValueDeleted == nil || other == nil {
+		if languagePackStringValueDeleted != other {
+			changes = append(changes, Change{Path: path, Old: languagePackStringValueDeleted, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (languagePackStringValueDeleted *LanguagePackStringValueDeleted) String() string {
+	return objectString(languagePackStringValueDeleted)
+}
+
+// LogValue logs the object as its summary
+func (languagePackStringValueDeleted *LanguagePackStringValueDeleted) LogValue() slog.Value {
+	return slog.StringValue(objectString(languagePackStringValueDeleted))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (languagePackStringValueDeleted *LanguagePackStringValueDeleted) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, languagePackStringValueDeleted)
+}
+
+func (languagePackStringValueDeleted *LanguagePackStringValueDeleted) printText(p *printer) {
+	if languagePackStringValueDeleted == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("LanguagePackStringValueDeleted")
+	p.endObject(state)
+}
+
 // GetLanguagePackStringValueEnum return the enum type of this object
 func (languagePackStringValueDeleted *LanguagePackStringValueDeleted) GetLanguagePackStringValueEnum() LanguagePackStringValueEnum {
 	return LanguagePackStringValueDeletedType
 }
 
+// Accept calls the method of the visitor for LanguagePackStringValueDeleted
+func (languagePackStringValueDeleted *LanguagePackStringValueDeleted) Accept(visitor LanguagePackStringValueVisitor) {
+	visitor.VisitLanguagePackStringValueDeleted(languagePackStringValueDeleted)
+}
+
+// LanguagePackStringValueVisitor visits every type of LanguagePackStringValue, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to LanguagePackStringValue, until they handle it too.
+type LanguagePackStringValueVisitor interface {
+	VisitLanguagePackStringValueOrdinary(languagePackStringValueOrdinary *LanguagePackStringValueOrdinary)
+	VisitLanguagePackStringValuePluralized(languagePackStringValuePluralized *LanguagePackStringValuePluralized)
+	VisitLanguagePackStringValueDeleted(languagePackStringValueDeleted *LanguagePackStringValueDeleted)
+	VisitUnknownLanguagePackStringValue(unknownLanguagePackStringValue *UnknownLanguagePackStringValue)
+}
+
+// LanguagePackStringValueCases holds the function MatchLanguagePackStringValue calls for each type of LanguagePackStringValue;
+// types without a function are passed to the default case
+type LanguagePackStringValueCases[R any] struct {
+	LanguagePackStringValueOrdinary   func(languagePackStringValueOrdinary *LanguagePackStringValueOrdinary) R
+	LanguagePackStringValuePluralized func(languagePackStringValuePluralized *LanguagePackStringValuePluralized) R
+	LanguagePackStringValueDeleted    func(languagePackStringValueDeleted *LanguagePackStringValueDeleted) R
+}
+
+// MatchLanguagePackStringValue calls the case of the type of languagePackStringValue, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement LanguagePackStringValueVisitor
+// to have the compiler check every type is handled.
+func MatchLanguagePackStringValue[R any](languagePackStringValue LanguagePackStringValue, cases LanguagePackStringValueCases[R], defaultCase func(languagePackStringValue LanguagePackStringValue) R) R {
+	switch value := languagePackStringValue.(type) {
+	case *LanguagePackStringValueOrdinary:
+		if cases.LanguagePackStringValueOrdinary != nil {
+			return cases.LanguagePackStringValueOrdinary(value)
+		}
+	case *LanguagePackStringValuePluralized:
+		if cases.LanguagePackStringValuePluralized != nil {
+			return cases.LanguagePackStringValuePluralized(value)
+		}
+	case *LanguagePackStringValueDeleted:
+		if cases.LanguagePackStringValueDeleted != nil {
+			return cases.LanguagePackStringValueDeleted(value)
+		}
+	}
+	return defaultCase(languagePackStringValue)
+}
+
+// Clone returns a deep copy of the object
+func (unknownLanguagePackStringValue *UnknownLanguagePackStringValue) Clone() *UnknownLanguagePackStringValue {
+	if unknownLanguagePackStringValue == nil {
+		return nil
+	}
+	return &UnknownLanguagePackStringValue{Unknown: unknownLanguagePackStringValue.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownLanguagePackStringValue *UnknownLanguagePackStringValue) Equal(other *UnknownLanguagePackStringValue) bool {
+	if unknownLanguagePackStringValue == nil || other == nil {
+		return unknownLanguagePackStringValue == other
+	}
+	return unknownLanguagePackStringValue.Unknown.equal(other.Unknown)
+}
+
+func cloneLanguagePackStringValue(languagePackStringValue LanguagePackStringValue) LanguagePackStringValue {
+	switch value := languagePackStringValue.(type) {
+	case *LanguagePackStringValueOrdinary:
+		return value.Clone()
+	case *LanguagePackStringValuePluralized:
+		return value.Clone()
+	case *LanguagePackStringValueDeleted:
+		return value.Clone()
+	case *UnknownLanguagePackStringValue:
+		return value.Clone()
+	}
+	return languagePackStringValue
+}
+
+func equalLanguagePackStringValue(a LanguagePackStringValue, b LanguagePackStringValue) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *LanguagePackStringValueOrdinary:
+		b, ok := b.(*LanguagePackStringValueOrdinary)
+		return ok && a.Equal(b)
+	case *LanguagePackStringValuePluralized:
+		b, ok := b.(*LanguagePackStringValuePluralized)
+		return ok && a.Equal(b)
+	case *LanguagePackStringValueDeleted:
+		b, ok := b.(*LanguagePackStringValueDeleted)
+		return ok && a.Equal(b)
+	case *UnknownLanguagePackStringValue:
+		b, ok := b.(*UnknownLanguagePackStringValue)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffLanguagePackStringValue(path string, a LanguagePackStringValue, b LanguagePackStringValue, changes []Change) []Change {
+	switch a := a.(type) {
+	case *LanguagePackStringValueOrdinary:
+		if b, ok := b.(*LanguagePackStringValueOrdinary); ok {
+			return a.diff(path, b, changes)
+		}
+	case *LanguagePackStringValuePluralized:
+		if b, ok := b.(*LanguagePackStringValuePluralized); ok {
+			return a.diff(path, b, changes)
+		}
+	case *LanguagePackStringValueDeleted:
+		if b, ok := b.(*LanguagePackStringValueDeleted); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalLanguagePackStringValue(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
+
 // GetLanguagePackString Returns a string stored in the local database from the specified localization target and language pack by its key. Returns a 404 error if the string is not found. Can be called synchronously
 // @param languagePackDatabasePath Path to the language pack database in which strings are stored
 // @param localizationTarget Localization target to which the language pack belongs
@@ -166,25 +693,71 @@ func (client *Client) GetLanguagePackString(languagePackDatabasePath string, loc
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
+		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
+	}
+
+	resultType, _ := result.Data["@type"].(string)
+	switch LanguagePackStringValueEnum(resultType) {
+
+	case LanguagePackStringValueOrdinaryType:
+		var languagePackStringValue LanguagePackStringValueOrdinary
+		err = jsonUnmarshal(result.Raw, &languagePackStringValue)
+		return &languagePackStringValue, err
+
+	case LanguagePackStringValuePluralizedType:
+		var languagePackStringValue LanguagePackStringValuePluralized
+		err = jsonUnmarshal(result.Raw, &languagePackStringValue)
+		return &languagePackStringValue, err
+
+	case LanguagePackStringValueDeletedType:
+		var languagePackStringValue LanguagePackStringValueDeleted
+		err = jsonUnmarshal(result.Raw, &languagePackStringValue)
+		return &languagePackStringValue, err
+
+	default:
+		return nil, fmt.Errorf("Invalid type")
+	}
+}
+
+// ExecuteGetLanguagePackString Synchronously executes GetLanguagePackString, without a client. Returns a string stored in the local database from the specified localization target and language pack by its key. Returns a 404 error if the string is not found. Can be called synchronously
+// @param languagePackDatabasePath Path to the language pack database in which strings are stored
+// @param localizationTarget Localization target to which the language pack belongs
+// @param languagePackId Language pack identifier
+// @param key Language pack key of the string to be returned
+func ExecuteGetLanguagePackString(languagePackDatabasePath string, localizationTarget string, languagePackId string, key string) (LanguagePackStringValue, error) {
+	result, err := execute(UpdateData{
+		"@type":                       "getLanguagePackString",
+		"language_pack_database_path": languagePackDatabasePath,
+		"localization_target":         localizationTarget,
+		"language_pack_id":            languagePackId,
+		"key":                         key,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
-	switch LanguagePackStringValueEnum(result.Data["@type"].(string)) {
+	resultType, _ := result.Data["@type"].(string)
+	switch LanguagePackStringValueEnum(resultType) {
 
 	case LanguagePackStringValueOrdinaryType:
 		var languagePackStringValue LanguagePackStringValueOrdinary
-		err = json.Unmarshal(result.Raw, &languagePackStringValue)
+		err = jsonUnmarshal(result.Raw, &languagePackStringValue)
 		return &languagePackStringValue, err
 
 	case LanguagePackStringValuePluralizedType:
 		var languagePackStringValue LanguagePackStringValuePluralized
-		err = json.Unmarshal(result.Raw, &languagePackStringValue)
+		err = jsonUnmarshal(result.Raw, &languagePackStringValue)
 		return &languagePackStringValue, err
 
 	case LanguagePackStringValueDeletedType:
 		var languagePackStringValue LanguagePackStringValueDeleted
-		err = json.Unmarshal(result.Raw, &languagePackStringValue)
+		err = jsonUnmarshal(result.Raw, &languagePackStringValue)
 		return &languagePackStringValue, err
 
 	default:
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // LanguagePackStrings Contains a list of language pack strings
@@ -28,6 +28,150 @@ func NewLanguagePackStrings(strings []LanguagePackString) *LanguagePackStrings {
 	return &languagePackStringsTemp
 }
 
+// MarshalJSON marshals to json
+func (languagePackStrings *LanguagePackStrings) MarshalJSON() ([]byte, error) {
+	return languagePackStrings.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (languagePackStrings *LanguagePackStrings) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, languagePackStrings)
+}
+
+func (languagePackStrings *LanguagePackStrings) appendJSON(b []byte) ([]byte, error) {
+	if languagePackStrings == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, languagePackStrings.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, languagePackStrings.tdCommon.Extra)
+	b = append(b, `,"strings":`...)
+	if languagePackStrings.Strings == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range languagePackStrings.Strings {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = languagePackStrings.Strings[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (languagePackStrings *LanguagePackStrings) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			languagePackStrings.tdCommon.Type, err = r.readString()
+		case "@extra":
+			languagePackStrings.tdCommon.Extra, err = r.readString()
+		case "strings":
+			if r.readNull() {
+				languagePackStrings.Strings = nil
+			} else {
+				languagePackStrings.Strings = make([]LanguagePackString, 0)
+				err = r.readArray(func() (err error) {
+					var item0 LanguagePackString
+					err = item0.decodeJSON(r)
+					languagePackStrings.Strings = append(languagePackStrings.Strings, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (languagePackStrings *LanguagePackStrings) Clone() *LanguagePackStrings {
+	if languagePackStrings == nil {
+		return nil
+	}
+	clone := *languagePackStrings
+	if languagePackStrings.Strings != nil {
+		clone.Strings = make([]LanguagePackString, len(languagePackStrings.Strings))
+		for i0 := range languagePackStrings.Strings {
+			clone.Strings[i0] = *languagePackStrings.Strings[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (languagePackStrings *LanguagePackStrings) Equal(other *LanguagePackStrings) bool {
+	if languagePackStrings == nil || other == nil {
+		return languagePackStrings == other
+	}
+	if len(languagePackStrings.Strings) != len(other.Strings) {
+		return false
+	}
+	for i0 := range languagePackStrings.Strings {
+		if !languagePackStrings.Strings[i0].Equal(&other.Strings[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (languagePackStrings *LanguagePackStrings) Diff(other *LanguagePackStrings) []Change {
+	return languagePackStrings.diff("", other, nil)
+}
+
+func (languagePackStrings *LanguagePackStrings) diff(path string, other *LanguagePackStrings, changes []Change) []Change {
+	if languagePackStrings == nil || other == nil {
+		if languagePackStrings != other {
+			changes = append(changes, Change{Path: path, Old: languagePackStrings, New: other})
+		}
+		return changes
+	}
+	if len(languagePackStrings.Strings) != len(other.Strings) {
+		changes = append(changes, Change{Path: fieldPath(path, "strings"), Old: languagePackStrings.Strings, New: other.Strings})
+	} else {
+		for i0 := range languagePackStrings.Strings {
+			changes = languagePackStrings.Strings[i0].diff(indexPath(fieldPath(path, "strings"), i0), &other.Strings[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (languagePackStrings *LanguagePackStrings) String() string {
+	return objectString(languagePackStrings)
+}
+
+// LogValue logs the object as its summary
+func (languagePackStrings *LanguagePackStrings) LogValue() slog.Value {
+	return slog.StringValue(objectString(languagePackStrings))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (languagePackStrings *LanguagePackStrings) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, languagePackStrings)
+}
+
+func (languagePackStrings *LanguagePackStrings) printText(p *printer) {
+	if languagePackStrings == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("LanguagePackStrings")
+	p.vector("strings", len(languagePackStrings.Strings), func(i0 int) {
+		languagePackStrings.Strings[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetLanguagePackStrings Returns strings from a language pack in the current localization target by their keys. Can be called before authorization
 // @param languagePackId Language pack identifier of the strings to be returned
 // @param keys Language pack keys of the strings to be returned; leave empty to request all available strings
@@ -42,11 +186,11 @@ func (client *Client) GetLanguagePackStrings(languagePackId string, keys []strin
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var languagePackStrings LanguagePackStrings
-	err = json.Unmarshal(result.Raw, &languagePackStrings)
+	err = jsonUnmarshal(result.Raw, &languagePackStrings)
 	return &languagePackStrings, err
 }
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // LocalFile Represents a local file
 type LocalFile struct {
 	tdCommon
@@ -43,3 +48,182 @@ func NewLocalFile(path string, canBeDownloaded bool, canBeDeleted bool, isDownlo
 
 	return &localFileTemp
 }
+
+// MarshalJSON marshals to json
+func (localFile *LocalFile) MarshalJSON() ([]byte, error) {
+	return localFile.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (localFile *LocalFile) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, localFile)
+}
+
+func (localFile *LocalFile) appendJSON(b []byte) ([]byte, error) {
+	if localFile == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, localFile.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, localFile.tdCommon.Extra)
+	b = append(b, `,"path":`...)
+	b = appendJSONString(b, localFile.Path)
+	b = append(b, `,"can_be_downloaded":`...)
+	b = appendJSONBool(b, localFile.CanBeDownloaded)
+	b = append(b, `,"can_be_deleted":`...)
+	b = appendJSONBool(b, localFile.CanBeDeleted)
+	b = append(b, `,"is_downloading_active":`...)
+	b = appendJSONBool(b, localFile.IsDownloadingActive)
+	b = append(b, `,"is_downloading_completed":`...)
+	b = appendJSONBool(b, localFile.IsDownloadingCompleted)
+	b = append(b, `,"download_offset":`...)
+	b = appendJSONInt(b, int64(localFile.DownloadOffset))
+	b = append(b, `,"downloaded_prefix_size":`...)
+	b = appendJSONInt(b, int64(localFile.DownloadedPrefixSize))
+	b = append(b, `,"downloaded_size":`...)
+	b = appendJSONInt(b, int64(localFile.DownloadedSize))
+	return append(b, '}'), nil
+}
+
+func (localFile *LocalFile) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			localFile.tdCommon.Type, err = r.readString()
+		case "@extra":
+			localFile.tdCommon.Extra, err = r.readString()
+		case "path":
+			localFile.Path, err = r.readString()
+		case "can_be_downloaded":
+			localFile.CanBeDownloaded, err = r.readBool()
+		case "can_be_deleted":
+			localFile.CanBeDeleted, err = r.readBool()
+		case "is_downloading_active":
+			localFile.IsDownloadingActive, err = r.readBool()
+		case "is_downloading_completed":
+			localFile.IsDownloadingCompleted, err = r.readBool()
+		case "download_offset":
+			localFile.DownloadOffset, err = r.readInt32()
+		case "downloaded_prefix_size":
+			localFile.DownloadedPrefixSize, err = r.readInt32()
+		case "downloaded_size":
+			localFile.DownloadedSize, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (localFile *LocalFile) Clone() *LocalFile {
+	if localFile == nil {
+		return nil
+	}
+	clone := *localFile
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (localFile *LocalFile) Equal(other *LocalFile) bool {
+	if localFile == nil || other == nil {
+		return localFile == other
+	}
+	if localFile.Path != other.Path {
+		return false
+	}
+	if localFile.CanBeDownloaded != other.CanBeDownloaded {
+		return false
+	}
+	if localFile.CanBeDeleted != other.CanBeDeleted {
+		return false
+	}
+	if localFile.IsDownloadingActive != other.IsDownloadingActive {
+		return false
+	}
+	if localFile.IsDownloadingCompleted != other.IsDownloadingCompleted {
+		return false
+	}
+	if localFile.DownloadOffset != other.DownloadOffset {
+		return false
+	}
+	if localFile.DownloadedPrefixSize != other.DownloadedPrefixSize {
+		return false
+	}
+	if localFile.DownloadedSize != other.DownloadedSize {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (localFile *LocalFile) Diff(other *LocalFile) []Change {
+	return localFile.diff("", other, nil)
+}
+
+func (localFile *LocalFile) diff(path string, other *LocalFile, changes []Change) []Change {
+	if localFile == nil || other == nil {
+		if localFile != other {
+			changes = append(changes, Change{Path: path, Old: localFile, New: other})
+		}
+		return changes
+	}
+	if localFile.Path != other.Path {
+		changes = append(changes, Change{Path: fieldPath(path, "path"), Old: localFile.Path, New: other.Path})
+	}
+	if localFile.CanBeDownloaded != other.CanBeDownloaded {
+		changes = append(changes, Change{Path: fieldPath(path, "can_be_downloaded"), Old: localFile.CanBeDownloaded, New: other.CanBeDownloaded})
+	}
+	if localFile.CanBeDeleted != other.CanBeDeleted {
+		changes = append(changes, Change{Path: fieldPath(path, "can_be_deleted"), Old: localFile.CanBeDeleted, New: other.CanBeDeleted})
+	}
+	if localFile.IsDownloadingActive != other.IsDownloadingActive {
+		changes = append(changes, Change{Path: fieldPath(path, "is_downloading_active"), Old: localFile.IsDownloadingActive, New: other.IsDownloadingActive})
+	}
+	if localFile.IsDownloadingCompleted != other.IsDownloadingCompleted {
+		changes = append(changes, Change{Path: fieldPath(path, "is_downloading_completed"), Old: localFile.IsDownloadingCompleted, New: other.IsDownloadingCompleted})
+	}
+	if localFile.DownloadOffset != other.DownloadOffset {
+		changes = append(changes, Change{Path: fieldPath(path, "download_offset"), Old: localFile.DownloadOffset, New: other.DownloadOffset})
+	}
+	if localFile.DownloadedPrefixSize != other.DownloadedPrefixSize {
+		changes = append(changes, Change{Path: fieldPath(path, "downloaded_prefix_size"), Old: localFile.DownloadedPrefixSize, New: other.DownloadedPrefixSize})
+	}
+	if localFile.DownloadedSize != other.DownloadedSize {
+		changes = append(changes, Change{Path: fieldPath(path, "downloaded_size"), Old: localFile.DownloadedSize, New: other.DownloadedSize})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (localFile *LocalFile) String() string {
+	return objectString(localFile)
+}
+
+// LogValue logs the object as its summary
+func (localFile *LocalFile) LogValue() slog.Value {
+	return slog.StringValue(objectString(localFile))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (localFile *LocalFile) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, localFile)
+}
+
+func (localFile *LocalFile) printText(p *printer) {
+	if localFile == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("LocalFile")
+	p.string("path", localFile.Path)
+	p.bool("can_be_downloaded", localFile.CanBeDownloaded)
+	p.bool("can_be_deleted", localFile.CanBeDeleted)
+	p.bool("is_downloading_active", localFile.IsDownloadingActive)
+	p.bool("is_downloading_completed", localFile.IsDownloadingCompleted)
+	p.int("download_offset", int64(localFile.DownloadOffset))
+	p.int("downloaded_prefix_size", int64(localFile.DownloadedPrefixSize))
+	p.int("downloaded_size", int64(localFile.DownloadedSize))
+	p.endObject(state)
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // LocalizationTargetInfo Contains information about the current localization target
@@ -28,6 +28,150 @@ func NewLocalizationTargetInfo(languagePacks []LanguagePackInfo) *LocalizationTa
 	return &localizationTargetInfoTemp
 }
 
+// MarshalJSON marshals to json
+func (localizationTargetInfo *LocalizationTargetInfo) MarshalJSON() ([]byte, error) {
+	return localizationTargetInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (localizationTargetInfo *LocalizationTargetInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, localizationTargetInfo)
+}
+
+func (localizationTargetInfo *LocalizationTargetInfo) appendJSON(b []byte) ([]byte, error) {
+	if localizationTargetInfo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, localizationTargetInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, localizationTargetInfo.tdCommon.Extra)
+	b = append(b, `,"language_packs":`...)
+	if localizationTargetInfo.LanguagePacks == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range localizationTargetInfo.LanguagePacks {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = localizationTargetInfo.LanguagePacks[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (localizationTargetInfo *LocalizationTargetInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			localizationTargetInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			localizationTargetInfo.tdCommon.Extra, err = r.readString()
+		case "language_packs":
+			if r.readNull() {
+				localizationTargetInfo.LanguagePacks = nil
+			} else {
+				localizationTargetInfo.LanguagePacks = make([]LanguagePackInfo, 0)
+				err = r.readArray(func() (err error) {
+					var item0 LanguagePackInfo
+					err = item0.decodeJSON(r)
+					localizationTargetInfo.LanguagePacks = append(localizationTargetInfo.LanguagePacks, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (localizationTargetInfo *LocalizationTargetInfo) Clone() *LocalizationTargetInfo {
+	if localizationTargetInfo == nil {
+		return nil
+	}
+	clone := *localizationTargetInfo
+	if localizationTargetInfo.LanguagePacks != nil {
+		clone.LanguagePacks = make([]LanguagePackInfo, len(localizationTargetInfo.LanguagePacks))
+		for i0 := range localizationTargetInfo.LanguagePacks {
+			clone.LanguagePacks[i0] = *localizationTargetInfo.LanguagePacks[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (localizationTargetInfo *LocalizationTargetInfo) Equal(other *LocalizationTargetInfo) bool {
+	if localizationTargetInfo == nil || other == nil {
+		return localizationTargetInfo == other
+	}
+	if len(localizationTargetInfo.LanguagePacks) != len(other.LanguagePacks) {
+		return false
+	}
+	for i0 := range localizationTargetInfo.LanguagePacks {
+		if !localizationTargetInfo.LanguagePacks[i0].Equal(&other.LanguagePacks[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (localizationTargetInfo *LocalizationTargetInfo) Diff(other *LocalizationTargetInfo) []Change {
+	return localizationTargetInfo.diff("", other, nil)
+}
+
+func (localizationTargetInfo *LocalizationTargetInfo) diff(path string, other *LocalizationTargetInfo, changes []Change) []Change {
+	if localizationTargetInfo == nil || other == nil {
+		if localizationTargetInfo != other {
+			changes = append(changes, Change{Path: path, Old: localizationTargetInfo, New: other})
+		}
+		return changes
+	}
+	if len(localizationTargetInfo.LanguagePacks) != len(other.LanguagePacks) {
+		changes = append(changes, Change{Path: fieldPath(path, "language_packs"), Old: localizationTargetInfo.LanguagePacks, New: other.LanguagePacks})
+	} else {
+		for i0 := range localizationTargetInfo.LanguagePacks {
+			changes = localizationTargetInfo.LanguagePacks[i0].diff(indexPath(fieldPath(path, "language_packs"), i0), &other.LanguagePacks[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (localizationTargetInfo *LocalizationTargetInfo) String() string {
+	return objectString(localizationTargetInfo)
+}
+
+// LogValue logs the object as its summary
+func (localizationTargetInfo *LocalizationTargetInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(localizationTargetInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (localizationTargetInfo *LocalizationTargetInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, localizationTargetInfo)
+}
+
+func (localizationTargetInfo *LocalizationTargetInfo) printText(p *printer) {
+	if localizationTargetInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("LocalizationTargetInfo")
+	p.vector("language_packs", len(localizationTargetInfo.LanguagePacks), func(i0 int) {
+		localizationTargetInfo.LanguagePacks[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetLocalizationTargetInfo Returns information about the current localization target. This is an offline request if only_local is true. Can be called before authorization
 // @param onlyLocal If true, returns only locally available information without sending network requests
 func (client *Client) GetLocalizationTargetInfo(onlyLocal bool) (*LocalizationTargetInfo, error) {
@@ -40,11 +184,11 @@ func (client *Client) GetLocalizationTargetInfo(onlyLocal bool) (*LocalizationTa
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var localizationTargetInfo LocalizationTargetInfo
-	err = json.Unmarshal(result.Raw, &localizationTargetInfo)
+	err = jsonUnmarshal(result.Raw, &localizationTargetInfo)
 	return &localizationTargetInfo, err
 }
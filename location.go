@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // Location Describes a location on planet Earth
 type Location struct {
 	tdCommon
@@ -28,3 +33,137 @@ func NewLocation(latitude float64, longitude float64, horizontalAccuracy float64
 
 	return &locationTemp
 }
+
+// MarshalJSON marshals to json
+func (location *Location) MarshalJSON() ([]byte, error) {
+	return location.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (location *Location) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, location)
+}
+
+func (location *Location) appendJSON(b []byte) ([]byte, error) {
+	if location == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, location.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, location.tdCommon.Extra)
+	b = append(b, `,"latitude":`...)
+	b, err = appendJSONFloat(b, location.Latitude)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"longitude":`...)
+	b, err = appendJSONFloat(b, location.Longitude)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"horizontal_accuracy":`...)
+	b, err = appendJSONFloat(b, location.HorizontalAccuracy)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (location *Location) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			location.tdCommon.Type, err = r.readString()
+		case "@extra":
+			location.tdCommon.Extra, err = r.readString()
+		case "latitude":
+			location.Latitude, err = r.readFloat64()
+		case "longitude":
+			location.Longitude, err = r.readFloat64()
+		case "horizontal_accuracy":
+			location.HorizontalAccuracy, err = r.readFloat64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (location *Location) Clone() *Location {
+	if location == nil {
+		return nil
+	}
+	clone := *location
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (location *Location) Equal(other *Location) bool {
+	if location == nil || other == nil {
+		return location == other
+	}
+	if location.Latitude != other.Latitude {
+		return false
+	}
+	if location.Longitude != other.Longitude {
+		return false
+	}
+	if location.HorizontalAccuracy != other.HorizontalAccuracy {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (location *Location) Diff(other *Location) []Change {
+	return location.diff("", other, nil)
+}
+
+func (location *Location) diff(path string, other *Location, changes []Change) []Change {
+	if location == nil || other == nil {
+		if location != other {
+			changes = append(changes, Change{Path: path, Old: location, New: other})
+		}
+		return changes
+	}
+	if location.Latitude != other.Latitude {
+		changes = append(changes, Change{Path: fieldPath(path, "latitude"), Old: location.Latitude, New: other.Latitude})
+	}
+	if location.Longitude != other.Longitude {
+		changes = append(changes, Change{Path: fieldPath(path, "longitude"), Old: location.Longitude, New: other.Longitude})
+	}
+	if location.HorizontalAccuracy != other.HorizontalAccuracy {
+		changes = append(changes, Change{Path: fieldPath(path, "horizontal_accuracy"), Old: location.HorizontalAccuracy, New: other.HorizontalAccuracy})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (location *Location) String() string {
+	return objectString(location)
+}
+
+// LogValue logs the object as its summary
+func (location *Location) LogValue() slog.Value {
+	return slog.StringValue(objectString(location))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (location *Location) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, location)
+}
+
+func (location *Location) printText(p *printer) {
+	if location == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Location")
+	p.float("latitude", location.Latitude)
+	p.float("longitude", location.Longitude)
+	p.float("horizontal_accuracy", location.HorizontalAccuracy)
+	p.endObject(state)
+}
@@ -0,0 +1,142 @@
+package tdlib
+
+//#include <td/telegram/td_json_client.h>
+//
+//extern void goLogMessageCallback(int verbosity_level, char *message);
+import "C"
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LogLevelFatal is the slog level of TDLib fatal errors, logged with verbosity level 0
+const LogLevelFatal = slog.LevelError + 4
+
+// LogMessage is a message added to the internal TDLib log
+type LogMessage struct {
+	VerbosityLevel int       // Log verbosity level of the message; 0 is a fatal error, 1 an error, 2 a warning, 3 an informational message, 4 a debug message and above that verbose debug
+	Time           time.Time // Time the message was logged at
+	Thread         int       // Identifier of the TDLib thread which logged the message
+	Source         string    // TDLib source file and line which logged the message, e.g. "Td.cpp:4020"
+	Tag            string    // Context tag of the message, e.g. the client identifier, if any
+	Actor          string    // Name of the TDLib actor which logged the message, if any
+	Text           string    // Text of the message without the header
+	Raw            string    // The message as logged by TDLib
+}
+
+var (
+	logCallback     func(msg LogMessage)
+	logCallbackLock sync.RWMutex
+)
+
+// TDLib log header: [ 3][t 1][1642434111.102342486][Td.cpp:4020][#1][!Td][&condition]<tab>text
+var logHeaderRegexp = regexp.MustCompile(`(?s)^\[\s*(\d+)\]\[t\s*(\d+)\]\[(\d+(?:\.\d+)?)\]\[([^\]]*)\](?:\[#([^\]]*)\])?(?:\[!([^\]]*)\])?(?:\[&[^\]]*\])?\t(.*)$`)
+
+// SetLogMessageCallback Sets the callback that will be called when a message is added to the internal TDLib log.
+// The callback is called from TDLib threads and must not call any TDLib methods.
+// After a message with verbosity level 0 TDLib terminates the process as soon as the callback returns.
+// Pass nil callback to remove the callback.
+//
+// TDLib doesn't report which log tag (see GetLogTags) produced a message, so the
+// Tag and Actor of a message are taken from its header when TDLib writes them.
+func SetLogMessageCallback(maxVerbosityLevel int, callback func(msg LogMessage)) {
+	logCallbackLock.Lock()
+	logCallback = callback
+	logCallbackLock.Unlock()
+
+	if callback == nil {
+		C.td_set_log_message_callback(C.int(maxVerbosityLevel), nil)
+		return
+	}
+	C.td_set_log_message_callback(C.int(maxVerbosityLevel), C.td_log_message_callback_ptr(C.goLogMessageCallback))
+}
+
+// SetLogHandler Routes the internal TDLib log into a log/slog handler.
+// Verbosity levels are mapped to slog levels: 0 to LogLevelFatal, 1 to Error, 2 to Warn, 3 to Info
+// and 4 to Debug, every following verbosity level being one slog level below Debug.
+// onFatalError, if not nil, is called with fatal errors before TDLib terminates the process,
+// so they can be reported.
+func SetLogHandler(maxVerbosityLevel int, handler slog.Handler, onFatalError func(msg LogMessage)) {
+	SetLogMessageCallback(maxVerbosityLevel, func(msg LogMessage) {
+		level := LogVerbosityToLevel(msg.VerbosityLevel)
+		if handler.Enabled(context.Background(), level) {
+			record := slog.NewRecord(msg.Time, level, msg.Text, 0)
+			record.AddAttrs(slog.Int("verbosity_level", msg.VerbosityLevel))
+			if msg.Source != "" {
+				record.AddAttrs(slog.String("source", msg.Source))
+			}
+			if msg.Tag != "" {
+				record.AddAttrs(slog.String("tag", msg.Tag))
+			}
+			if msg.Actor != "" {
+				record.AddAttrs(slog.String("actor", msg.Actor))
+			}
+			handler.Handle(context.Background(), record)
+		}
+
+		if msg.VerbosityLevel == 0 && onFatalError != nil {
+			onFatalError(msg)
+		}
+	})
+}
+
+// LogVerbosityToLevel converts a TDLib log verbosity level to a slog level
+func LogVerbosityToLevel(verbosityLevel int) slog.Level {
+	switch verbosityLevel {
+	case 0:
+		return LogLevelFatal
+	case 1:
+		return slog.LevelError
+	case 2:
+		return slog.LevelWarn
+	case 3:
+		return slog.LevelInfo
+	default:
+		return slog.LevelDebug - slog.Level(verbosityLevel-4)
+	}
+}
+
+// ParseLogMessage splits a message of the internal TDLib log into its header fields and text
+func ParseLogMessage(verbosityLevel int, message string) LogMessage {
+	msg := LogMessage{
+		VerbosityLevel: verbosityLevel,
+		Time:           time.Now(),
+		Text:           strings.TrimRight(message, "\n"),
+		Raw:            message,
+	}
+
+	match := logHeaderRegexp.FindStringSubmatch(msg.Text)
+	if match == nil {
+		return msg
+	}
+
+	msg.Thread, _ = strconv.Atoi(match[2])
+	if seconds, err := strconv.ParseFloat(match[3], 64); err == nil {
+		whole, frac := math.Modf(seconds)
+		msg.Time = time.Unix(int64(whole), int64(frac*1e9))
+	}
+	msg.Source = match[4]
+	msg.Tag = match[5]
+	msg.Actor = match[6]
+	msg.Text = match[7]
+
+	return msg
+}
+
+//export goLogMessageCallback
+func goLogMessageCallback(verbosityLevel C.int, message *C.char) {
+	logCallbackLock.RLock()
+	callback := logCallback
+	logCallbackLock.RUnlock()
+
+	if callback != nil {
+		callback(ParseLogMessage(int(verbosityLevel), C.GoString(message)))
+	}
+}
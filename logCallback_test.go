@@ -0,0 +1,161 @@
+package tdlib
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestParseLogMessage(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    LogMessage
+	}{
+		{
+			"well-formed",
+			"[ 3][t 1][1642434111.102342486][Td.cpp:4020][#1][!Td]\tSend request\n",
+			LogMessage{Thread: 1, Time: time.Unix(1642434111, 102342486), Source: "Td.cpp:4020", Tag: "1", Actor: "Td", Text: "Send request"},
+		},
+		{
+			"without tag and actor",
+			"[ 2][t 4][1642434111][NetQueryDispatcher.cpp:155]\tToo many requests",
+			LogMessage{Thread: 4, Time: time.Unix(1642434111, 0), Source: "NetQueryDispatcher.cpp:155", Text: "Too many requests"},
+		},
+		{
+			"with condition",
+			"[ 1][t 0][1642434111.5][Session.cpp:10][!Session][&ok]\tLost connection",
+			LogMessage{Thread: 0, Time: time.Unix(1642434111, 500000000), Source: "Session.cpp:10", Actor: "Session", Text: "Lost connection"},
+		},
+		{
+			"multi-line",
+			"[ 0][t 2][1642434111.25][Td.cpp:1][#2]\tCheck failed\nat frame 1\nat frame 2\n",
+			LogMessage{Thread: 2, Time: time.Unix(1642434111, 250000000), Source: "Td.cpp:1", Tag: "2", Text: "Check failed\nat frame 1\nat frame 2"},
+		},
+		{"no header", "plain text\n", LogMessage{Text: "plain text"}},
+		{"truncated header", "[ 3][t 1][1642434111.1]", LogMessage{Text: "[ 3][t 1][1642434111.1]"}},
+		{"no tab after the header", "[ 3][t 1][1642434111.1][Td.cpp:1] text", LogMessage{Text: "[ 3][t 1][1642434111.1][Td.cpp:1] text"}},
+		{"invalid thread", "[ 3][t x][1642434111.1][Td.cpp:1]\ttext", LogMessage{Text: "[ 3][t x][1642434111.1][Td.cpp:1]\ttext"}},
+		{"empty", "", LogMessage{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			before := time.Now()
+			msg := ParseLogMessage(3, test.message)
+
+			if msg.VerbosityLevel != 3 || msg.Raw != test.message {
+				t.Errorf("message has verbosity level %d and raw %q, want 3 and %q", msg.VerbosityLevel, msg.Raw, test.message)
+			}
+			if msg.Thread != test.want.Thread || msg.Source != test.want.Source || msg.Tag != test.want.Tag ||
+				msg.Actor != test.want.Actor || msg.Text != test.want.Text {
+				t.Errorf("parsed %+v, want %+v", msg, test.want)
+			}
+			// the time is the time of the header, to the microsecond as it's parsed as a float, or now without one
+			if test.want.Time.IsZero() {
+				if msg.Time.Before(before) || msg.Time.After(time.Now()) {
+					t.Errorf("time is %v, want now", msg.Time)
+				}
+			} else if diff := msg.Time.Sub(test.want.Time); diff < -time.Microsecond || diff > time.Microsecond {
+				t.Errorf("time is %v, want %v", msg.Time, test.want.Time)
+			}
+		})
+	}
+}
+
+func TestLogVerbosityToLevel(t *testing.T) {
+	tests := []struct {
+		verbosityLevel int
+		level          slog.Level
+	}{
+		{0, LogLevelFatal},
+		{1, slog.LevelError},
+		{2, slog.LevelWarn},
+		{3, slog.LevelInfo},
+		{4, slog.LevelDebug},
+		{5, slog.LevelDebug - 1},
+		{1023, slog.LevelDebug - 1019},
+	}
+	for _, test := range tests {
+		if level := LogVerbosityToLevel(test.verbosityLevel); level != test.level {
+			t.Errorf("verbosity level %d is slog level %v, want %v", test.verbosityLevel, level, test.level)
+		}
+	}
+}
+
+// recordingHandler is a slog.Handler recording the records of enabled levels
+type recordingHandler struct {
+	level   slog.Level
+	lock    sync.Mutex
+	records []slog.Record
+}
+
+func (handler *recordingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= handler.level
+}
+
+func (handler *recordingHandler) Handle(ctx context.Context, record slog.Record) error {
+	handler.lock.Lock()
+	defer handler.lock.Unlock()
+	handler.records = append(handler.records, record)
+	return nil
+}
+
+func (handler *recordingHandler) WithAttrs(attrs []slog.Attr) slog.Handler { return handler }
+
+func (handler *recordingHandler) WithGroup(name string) slog.Handler { return handler }
+
+func TestSetLogHandler(t *testing.T) {
+	handler := &recordingHandler{level: slog.LevelDebug}
+	var fatal []LogMessage
+	SetLogHandler(5, handler, func(msg LogMessage) {
+		fatal = append(fatal, msg)
+	})
+	defer SetLogMessageCallback(0, nil)
+
+	logCallbackLock.RLock()
+	callback := logCallback
+	logCallbackLock.RUnlock()
+	for verbosityLevel, message := range []string{
+		"[ 0][t 1][1642434111][Td.cpp:1]\tfatal",
+		"[ 1][t 1][1642434111][Td.cpp:2][#1][!Td]\terror",
+		"[ 2][t 1][1642434111][Td.cpp:3]\twarning",
+		"[ 3][t 1][1642434111][Td.cpp:4]\tinfo",
+		"[ 4][t 1][1642434111][Td.cpp:5]\tdebug",
+		"[ 5][t 1][1642434111][Td.cpp:6]\tverbose",
+	} {
+		callback(ParseLogMessage(verbosityLevel, message))
+	}
+
+	// the verbose message is below the level of the handler
+	want := []struct {
+		level   slog.Level
+		message string
+	}{{LogLevelFatal, "fatal"}, {slog.LevelError, "error"}, {slog.LevelWarn, "warning"}, {slog.LevelInfo, "info"}, {slog.LevelDebug, "debug"}}
+	if len(handler.records) != len(want) {
+		t.Fatalf("%d records were handled, want %d", len(handler.records), len(want))
+	}
+	for i, record := range handler.records {
+		if record.Level != want[i].level || record.Message != want[i].message || !record.Time.Equal(time.Unix(1642434111, 0)) {
+			t.Errorf("record %d is %v %q at %v, want %v %q", i, record.Level, record.Message, record.Time, want[i].level, want[i].message)
+		}
+	}
+
+	attrs := map[string]string{}
+	handler.records[1].Attrs(func(attr slog.Attr) bool {
+		attrs[attr.Key] = attr.Value.String()
+		return true
+	})
+	wantAttrs := map[string]string{"verbosity_level": "1", "source": "Td.cpp:2", "tag": "1", "actor": "Td"}
+	for key, value := range wantAttrs {
+		if attrs[key] != value {
+			t.Errorf("error record has the attributes %v, want %v", attrs, wantAttrs)
+			break
+		}
+	}
+
+	if len(fatal) != 1 || fatal[0].Text != "fatal" {
+		t.Errorf("fatal errors are %v, want the fatal message", fatal)
+	}
+}
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // LogStream Describes a stream to which TDLib internal log is written
 type LogStream interface {
 	GetLogStreamEnum() LogStreamEnum
+	Accept(visitor LogStreamVisitor)
 }
 
 // LogStreamEnum Alias for abstract LogStream 'Sub-Classes', used as constant-enum here
@@ -20,35 +22,78 @@ const (
 	LogStreamEmptyType   LogStreamEnum = "logStreamEmpty"
 )
 
+// UnknownLogStream is a LogStream of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownLogStream struct {
+	Unknown
+}
+
+// GetLogStreamEnum return the enum type of this object
+func (unknownLogStream *UnknownLogStream) GetLogStreamEnum() LogStreamEnum {
+	return LogStreamEnum(unknownLogStream.Type)
+}
+
+// Accept calls the method of the visitor for UnknownLogStream
+func (unknownLogStream *UnknownLogStream) Accept(visitor LogStreamVisitor) {
+	visitor.VisitUnknownLogStream(unknownLogStream)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownLogStream *UnknownLogStream) String() string {
+	return objectString(unknownLogStream)
+}
+
+// LogValue logs the object as its summary
+func (unknownLogStream *UnknownLogStream) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownLogStream))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownLogStream *UnknownLogStream) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownLogStream)
+}
+
+func (unknownLogStream *UnknownLogStream) printText(p *printer) {
+	if unknownLogStream == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownLogStream")
+	p.string("@type", unknownLogStream.Type)
+	p.endObject(state)
+}
+
 func unmarshalLogStream(rawMsg *json.RawMessage) (LogStream, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch LogStreamEnum(objMap["@type"].(string)) {
+	switch LogStreamEnum(typeName) {
 	case LogStreamDefaultType:
 		var logStreamDefault LogStreamDefault
-		err := json.Unmarshal(*rawMsg, &logStreamDefault)
+		err := jsonUnmarshal(*rawMsg, &logStreamDefault)
 		return &logStreamDefault, err
 
 	case LogStreamFileType:
 		var logStreamFile LogStreamFile
-		err := json.Unmarshal(*rawMsg, &logStreamFile)
+		err := jsonUnmarshal(*rawMsg, &logStreamFile)
 		return &logStreamFile, err
 
 	case LogStreamEmptyType:
 		var logStreamEmpty LogStreamEmpty
-		err := json.Unmarshal(*rawMsg, &logStreamEmpty)
+		err := jsonUnmarshal(*rawMsg, &logStreamEmpty)
 		return &logStreamEmpty, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownLogStream{Unknown: unknown}, nil
 	}
 }
 
@@ -63,7 +108,6 @@ func (logStreamDefault *LogStreamDefault) MessageType() string {
 }
 
 // NewLogStreamDefault creates a new LogStreamDefault
-//
 func NewLogStreamDefault() *LogStreamDefault {
 	logStreamDefaultTemp := LogStreamDefault{
 		tdCommon: tdCommon{Type: "logStreamDefault"},
@@ -72,11 +116,107 @@ func NewLogStreamDefault() *LogStreamDefault {
 	return &logStreamDefaultTemp
 }
 
+// MarshalJSON marshals to json
+func (logStreamDefault *LogStreamDefault) MarshalJSON() ([]byte, error) {
+	return logStreamDefault.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (logStreamDefault *LogStreamDefault) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, logStreamDefault)
+}
+
+func (logStreamDefault *LogStreamDefault) appendJSON(b []byte) ([]byte, error) {
+	if logStreamDefault == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, logStreamDefault.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, logStreamDefault.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (logStreamDefault *LogStreamDefault) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			logStreamDefault.tdCommon.Type, err = r.readString()
+		case "@extra":
+			logStreamDefault.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (logStreamDefault *LogStreamDefault) Clone() *LogStreamDefault {
+	if logStreamDefault == nil {
+		return nil
+	}
+	clone := *logStreamDefault
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (logStreamDefault *LogStreamDefault) Equal(other *LogStreamDefault) bool {
+	if logStreamDefault == nil || other == nil {
+		return logStreamDefault == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (logStreamDefault *LogStreamDefault) Diff(other *LogStreamDefault) []Change {
+	return logStreamDefault.diff("", other, nil)
+}
+
+func (logStreamDefault *LogStreamDefault) diff(path string, other *LogStreamDefault, changes []Change) []Change {
+	if logStreamDefault == nil || other == nil {
+		if logStreamDefault != other {
+			changes = append(changes, Change{Path: path, Old: logStreamDefault, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (logStreamDefault *LogStreamDefault) String() string {
+	return objectString(logStreamDefault)
+}
+
+// LogValue logs the object as its summary
+func (logStreamDefault *LogStreamDefault) LogValue() slog.Value {
+	return slog.StringValue(objectString(logStreamDefault))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (logStreamDefault *LogStreamDefault) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, logStreamDefault)
+}
+
+func (logStreamDefault *LogStreamDefault) printText(p *printer) {
+	if logStreamDefault == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("LogStreamDefault")
+	p.endObject(state)
+}
+
 // GetLogStreamEnum return the enum type of this object
 func (logStreamDefault *LogStreamDefault) GetLogStreamEnum() LogStreamEnum {
 	return LogStreamDefaultType
 }
 
+// Accept calls the method of the visitor for LogStreamDefault
+func (logStreamDefault *LogStreamDefault) Accept(visitor LogStreamVisitor) {
+	visitor.VisitLogStreamDefault(logStreamDefault)
+}
+
 // LogStreamFile The log is written to a file
 type LogStreamFile struct {
 	tdCommon
@@ -106,11 +246,140 @@ func NewLogStreamFile(path string, maxFileSize int64, redirectStderr bool) *LogS
 	return &logStreamFileTemp
 }
 
+// MarshalJSON marshals to json
+func (logStreamFile *LogStreamFile) MarshalJSON() ([]byte, error) {
+	return logStreamFile.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (logStreamFile *LogStreamFile) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, logStreamFile)
+}
+
+func (logStreamFile *LogStreamFile) appendJSON(b []byte) ([]byte, error) {
+	if logStreamFile == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, logStreamFile.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, logStreamFile.tdCommon.Extra)
+	b = append(b, `,"path":`...)
+	b = appendJSONString(b, logStreamFile.Path)
+	b = append(b, `,"max_file_size":`...)
+	b = appendJSONInt(b, int64(logStreamFile.MaxFileSize))
+	b = append(b, `,"redirect_stderr":`...)
+	b = appendJSONBool(b, logStreamFile.RedirectStderr)
+	return append(b, '}'), nil
+}
+
+func (logStreamFile *LogStreamFile) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			logStreamFile.tdCommon.Type, err = r.readString()
+		case "@extra":
+			logStreamFile.tdCommon.Extra, err = r.readString()
+		case "path":
+			logStreamFile.Path, err = r.readString()
+		case "max_file_size":
+			logStreamFile.MaxFileSize, err = r.readInt64()
+		case "redirect_stderr":
+			logStreamFile.RedirectStderr, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (logStreamFile *LogStreamFile) Clone() *LogStreamFile {
+	if logStreamFile == nil {
+		return nil
+	}
+	clone := *logStreamFile
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (logStreamFile *LogStreamFile) Equal(other *LogStreamFile) bool {
+	if logStreamFile == nil || other == nil {
+		return logStreamFile == other
+	}
+	if logStreamFile.Path != other.Path {
+		return false
+	}
+	if logStreamFile.MaxFileSize != other.MaxFileSize {
+		return false
+	}
+	if logStreamFile.RedirectStderr != other.RedirectStderr {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (logStreamFile *LogStreamFile) Diff(other *LogStreamFile) []Change {
+	return logStreamFile.diff("", other, nil)
+}
+
+func (logStreamFile *LogStreamFile) diff(path string, other *LogStreamFile, changes []Change) []Change {
+	if logStreamFile == nil || other == nil {
+		if logStreamFile != other {
+			changes = append(changes, Change{Path: path, Old: logStreamFile, New: other})
+		}
+		return changes
+	}
+	if logStreamFile.Path != other.Path {
+		changes = append(changes, Change{Path: fieldPath(path, "path"), Old: logStreamFile.Path, New: other.Path})
+	}
+	if logStreamFile.MaxFileSize != other.MaxFileSize {
+		changes = append(changes, Change{Path: fieldPath(path, "max_file_size"), Old: logStreamFile.MaxFileSize, New: other.MaxFileSize})
+	}
+	if logStreamFile.RedirectStderr != other.RedirectStderr {
+		changes = append(changes, Change{Path: fieldPath(path, "redirect_stderr"), Old: logStreamFile.RedirectStderr, New: other.RedirectStderr})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (logStreamFile *LogStreamFile) String() string {
+	return objectString(logStreamFile)
+}
+
+// LogValue logs the object as its summary
+func (logStreamFile *LogStreamFile) LogValue() slog.Value {
+	return slog.StringValue(objectString(logStreamFile))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (logStreamFile *LogStreamFile) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, logStreamFile)
+}
+
+func (logStreamFile *LogStreamFile) printText(p *printer) {
+	if logStreamFile == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("LogStreamFile")
+	p.string("path", logStreamFile.Path)
+	p.int("max_file_size", int64(logStreamFile.MaxFileSize))
+	p.bool("redirect_stderr", logStreamFile.RedirectStderr)
+	p.endObject(state)
+}
+
 // GetLogStreamEnum return the enum type of this object
 func (logStreamFile *LogStreamFile) GetLogStreamEnum() LogStreamEnum {
 	return LogStreamFileType
 }
 
+// Accept calls the method of the visitor for LogStreamFile
+func (logStreamFile *LogStreamFile) Accept(visitor LogStreamVisitor) {
+	visitor.VisitLogStreamFile(logStreamFile)
+}
+
 // LogStreamEmpty The log is written nowhere
 type LogStreamEmpty struct {
 	tdCommon
@@ -122,7 +391,6 @@ func (logStreamEmpty *LogStreamEmpty) MessageType() string {
 }
 
 // NewLogStreamEmpty creates a new LogStreamEmpty
-//
 func NewLogStreamEmpty() *LogStreamEmpty {
 	logStreamEmptyTemp := LogStreamEmpty{
 		tdCommon: tdCommon{Type: "logStreamEmpty"},
@@ -131,11 +399,217 @@ func NewLogStreamEmpty() *LogStreamEmpty {
 	return &logStreamEmptyTemp
 }
 
+// MarshalJSON marshals to json
+func (logStreamEmpty *LogStreamEmpty) MarshalJSON() ([]byte, error) {
+	return logStreamEmpty.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (logStreamEmpty *LogStreamEmpty) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, logStreamEmpty)
+}
+
+func (logStreamEmpty *LogStreamEmpty) appendJSON(b []byte) ([]byte, error) {
+	if logStreamEmpty == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, logStreamEmpty.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, logStreamEmpty.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (logStreamEmpty *LogStreamEmpty) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			logStreamEmpty.tdCommon.Type, err = r.readString()
+		case "@extra":
+			logStreamEmpty.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (logStreamEmpty *LogStreamEmpty) Clone() *LogStreamEmpty {
+	if logStreamEmpty == nil {
+		return nil
+	}
+	clone := *logStreamEmpty
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (logStreamEmpty *LogStreamEmpty) Equal(other *LogStreamEmpty) bool {
+	if logStreamEmpty == nil || other == nil {
+		return logStreamEmpty == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (logStreamEmpty *LogStreamEmpty) Diff(other *LogStreamEmpty) []Change {
+	return logStreamEmpty.diff("", other, nil)
+}
+
+func (logStreamEmpty *LogStreamEmpty) diff(path string, other *LogStreamEmpty, changes []Change) []Change {
+	if logStreamEmpty == nil || other == nil {
+		if logStreamEmpty != other {
+			changes = append(changes, Change{Path: path, Old: logStreamEmpty, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (logStreamEmpty *LogStreamEmpty) String() string {
+	return objectString(logStreamEmpty)
+}
+
+// LogValue logs the object as its summary
+func (logStreamEmpty *LogStreamEmpty) LogValue() slog.Value {
+	return slog.StringValue(objectString(logStreamEmpty))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (logStreamEmpty *LogStreamEmpty) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, logStreamEmpty)
+}
+
+func (logStreamEmpty *LogStreamEmpty) printText(p *printer) {
+	if logStreamEmpty == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("LogStreamEmpty")
+	p.endObject(state)
+}
+
 // GetLogStreamEnum return the enum type of this object
 func (logStreamEmpty *LogStreamEmpty) GetLogStreamEnum() LogStreamEnum {
 	return LogStreamEmptyType
 }
 
+// Accept calls the method of the visitor for LogStreamEmpty
+func (logStreamEmpty *LogStreamEmpty) Accept(visitor LogStreamVisitor) {
+	visitor.VisitLogStreamEmpty(logStreamEmpty)
+}
+
+// LogStreamVisitor visits every type of LogStream, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to LogStream, until they handle it too.
+type LogStreamVisitor interface {
+	VisitLogStreamDefault(logStreamDefault *LogStreamDefault)
+	VisitLogStreamFile(logStreamFile *LogStreamFile)
+	VisitLogStreamEmpty(logStreamEmpty *LogStreamEmpty)
+	VisitUnknownLogStream(unknownLogStream *UnknownLogStream)
+}
+
+// LogStreamCases holds the function MatchLogStream calls for each type of LogStream;
+// types without a function are passed to the default case
+type LogStreamCases[R any] struct {
+	LogStreamDefault func(logStreamDefault *LogStreamDefault) R
+	LogStreamFile    func(logStreamFile *LogStreamFile) R
+	LogStreamEmpty   func(logStreamEmpty *LogStreamEmpty) R
+}
+
+// MatchLogStream calls the case of the type of logStream, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement LogStreamVisitor
+// to have the compiler check every type is handled.
+func MatchLogStream[R any](logStream LogStream, cases LogStreamCases[R], defaultCase func(logStream LogStream) R) R {
+	switch value := logStream.(type) {
+	case *LogStreamDefault:
+		if cases.LogStreamDefault != nil {
+			return cases.LogStreamDefault(value)
+		}
+	case *LogStreamFile:
+		if cases.LogStreamFile != nil {
+			return cases.LogStreamFile(value)
+		}
+	case *LogStreamEmpty:
+		if cases.LogStreamEmpty != nil {
+			return cases.LogStreamEmpty(value)
+		}
+	}
+	return defaultCase(logStream)
+}
+
+// Clone returns a deep copy of the object
+func (unknownLogStream *UnknownLogStream) Clone() *UnknownLogStream {
+	if unknownLogStream == nil {
+		return nil
+	}
+	return &UnknownLogStream{Unknown: unknownLogStream.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownLogStream *UnknownLogStream) Equal(other *UnknownLogStream) bool {
+	if unknownLogStream == nil || other == nil {
+		return unknownLogStream == other
+	}
+	return unknownLogStream.Unknown.equal(other.Unknown)
+}
+
+func cloneLogStream(logStream LogStream) LogStream {
+	switch value := logStream.(type) {
+	case *LogStreamDefault:
+		return value.Clone()
+	case *LogStreamFile:
+		return value.Clone()
+	case *LogStreamEmpty:
+		return value.Clone()
+	case *UnknownLogStream:
+		return value.Clone()
+	}
+	return logStream
+}
+
+func equalLogStream(a LogStream, b LogStream) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *LogStreamDefault:
+		b, ok := b.(*LogStreamDefault)
+		return ok && a.Equal(b)
+	case *LogStreamFile:
+		b, ok := b.(*LogStreamFile)
+		return ok && a.Equal(b)
+	case *LogStreamEmpty:
+		b, ok := b.(*LogStreamEmpty)
+		return ok && a.Equal(b)
+	case *UnknownLogStream:
+		b, ok := b.(*UnknownLogStream)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffLogStream(path string, a LogStream, b LogStream, changes []Change) []Change {
+	switch a := a.(type) {
+	case *LogStreamDefault:
+		if b, ok := b.(*LogStreamDefault); ok {
+			return a.diff(path, b, changes)
+		}
+	case *LogStreamFile:
+		if b, ok := b.(*LogStreamFile); ok {
+			return a.diff(path, b, changes)
+		}
+	case *LogStreamEmpty:
+		if b, ok := b.(*LogStreamEmpty); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalLogStream(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
+
 // GetLogStream Returns information about currently used log stream for internal logging of TDLib. Can be called synchronously
 func (client *Client) GetLogStream() (LogStream, error) {
 	result, err := client.SendAndCatch(UpdateData{
@@ -146,25 +620,63 @@ func (client *Client) GetLogStream() (LogStream, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
+		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
+	}
+
+	resultType, _ := result.Data["@type"].(string)
+	switch LogStreamEnum(resultType) {
+
+	case LogStreamDefaultType:
+		var logStream LogStreamDefault
+		err = jsonUnmarshal(result.Raw, &logStream)
+		return &logStream, err
+
+	case LogStreamFileType:
+		var logStream LogStreamFile
+		err = jsonUnmarshal(result.Raw, &logStream)
+		return &logStream, err
+
+	case LogStreamEmptyType:
+		var logStream LogStreamEmpty
+		err = jsonUnmarshal(result.Raw, &logStream)
+		return &logStream, err
+
+	default:
+		return nil, fmt.Errorf("Invalid type")
+	}
+}
+
+// ExecuteGetLogStream Synchronously executes GetLogStream, without a client. Returns information about currently used log stream for internal logging of TDLib. Can be called synchronously
+func ExecuteGetLogStream() (LogStream, error) {
+	result, err := execute(UpdateData{
+		"@type": "getLogStream",
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
-	switch LogStreamEnum(result.Data["@type"].(string)) {
+	resultType, _ := result.Data["@type"].(string)
+	switch LogStreamEnum(resultType) {
 
 	case LogStreamDefaultType:
 		var logStream LogStreamDefault
-		err = json.Unmarshal(result.Raw, &logStream)
+		err = jsonUnmarshal(result.Raw, &logStream)
 		return &logStream, err
 
 	case LogStreamFileType:
 		var logStream LogStreamFile
-		err = json.Unmarshal(result.Raw, &logStream)
+		err = jsonUnmarshal(result.Raw, &logStream)
 		return &logStream, err
 
 	case LogStreamEmptyType:
 		var logStream LogStreamEmpty
-		err = json.Unmarshal(result.Raw, &logStream)
+		err = jsonUnmarshal(result.Raw, &logStream)
 		return &logStream, err
 
 	default:
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // LogTags Contains a list of available TDLib internal log tags
@@ -28,6 +28,146 @@ func NewLogTags(tags []string) *LogTags {
 	return &logTagsTemp
 }
 
+// MarshalJSON marshals to json
+func (logTags *LogTags) MarshalJSON() ([]byte, error) {
+	return logTags.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (logTags *LogTags) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, logTags)
+}
+
+func (logTags *LogTags) appendJSON(b []byte) ([]byte, error) {
+	if logTags == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, logTags.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, logTags.tdCommon.Extra)
+	b = append(b, `,"tags":`...)
+	if logTags.Tags == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range logTags.Tags {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONString(b, logTags.Tags[i0])
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (logTags *LogTags) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			logTags.tdCommon.Type, err = r.readString()
+		case "@extra":
+			logTags.tdCommon.Extra, err = r.readString()
+		case "tags":
+			if r.readNull() {
+				logTags.Tags = nil
+			} else {
+				logTags.Tags = make([]string, 0)
+				err = r.readArray(func() (err error) {
+					var item0 string
+					item0, err = r.readString()
+					logTags.Tags = append(logTags.Tags, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (logTags *LogTags) Clone() *LogTags {
+	if logTags == nil {
+		return nil
+	}
+	clone := *logTags
+	if logTags.Tags != nil {
+		clone.Tags = make([]string, len(logTags.Tags))
+		copy(clone.Tags, logTags.Tags)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (logTags *LogTags) Equal(other *LogTags) bool {
+	if logTags == nil || other == nil {
+		return logTags == other
+	}
+	if len(logTags.Tags) != len(other.Tags) {
+		return false
+	}
+	for i0 := range logTags.Tags {
+		if logTags.Tags[i0] != other.Tags[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (logTags *LogTags) Diff(other *LogTags) []Change {
+	return logTags.diff("", other, nil)
+}
+
+func (logTags *LogTags) diff(path string, other *LogTags, changes []Change) []Change {
+	if logTags == nil || other == nil {
+		if logTags != other {
+			changes = append(changes, Change{Path: path, Old: logTags, New: other})
+		}
+		return changes
+	}
+	if len(logTags.Tags) != len(other.Tags) {
+		changes = append(changes, Change{Path: fieldPath(path, "tags"), Old: logTags.Tags, New: other.Tags})
+	} else {
+		for i0 := range logTags.Tags {
+			if logTags.Tags[i0] != other.Tags[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "tags"), i0), Old: logTags.Tags[i0], New: other.Tags[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (logTags *LogTags) String() string {
+	return objectString(logTags)
+}
+
+// LogValue logs the object as its summary
+func (logTags *LogTags) LogValue() slog.Value {
+	return slog.StringValue(objectString(logTags))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (logTags *LogTags) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, logTags)
+}
+
+func (logTags *LogTags) printText(p *printer) {
+	if logTags == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("LogTags")
+	p.vector("tags", len(logTags.Tags), func(i0 int) {
+		p.string("tags", logTags.Tags[i0])
+	})
+	p.endObject(state)
+}
+
 // GetLogTags Returns list of available TDLib internal log tags, for example, ["actor", "binlog", "connections", "notifications", "proxy"]. Can be called synchronously
 func (client *Client) GetLogTags() (*LogTags, error) {
 	result, err := client.SendAndCatch(UpdateData{
@@ -38,11 +178,30 @@ func (client *Client) GetLogTags() (*LogTags, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
+		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
+	}
+
+	var logTags LogTags
+	err = jsonUnmarshal(result.Raw, &logTags)
+	return &logTags, err
+}
+
+// ExecuteGetLogTags Synchronously executes GetLogTags, without a client. Returns list of available TDLib internal log tags, for example, ["actor", "binlog", "connections", "notifications", "proxy"]. Can be called synchronously
+func ExecuteGetLogTags() (*LogTags, error) {
+	result, err := execute(UpdateData{
+		"@type": "getLogTags",
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var logTags LogTags
-	err = json.Unmarshal(result.Raw, &logTags)
+	err = jsonUnmarshal(result.Raw, &logTags)
 	return &logTags, err
 }
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // LogVerbosityLevel Contains a TDLib internal log verbosity level
@@ -28,6 +28,108 @@ func NewLogVerbosityLevel(verbosityLevel int32) *LogVerbosityLevel {
 	return &logVerbosityLevelTemp
 }
 
+// MarshalJSON marshals to json
+func (logVerbosityLevel *LogVerbosityLevel) MarshalJSON() ([]byte, error) {
+	return logVerbosityLevel.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (logVerbosityLevel *LogVerbosityLevel) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, logVerbosityLevel)
+}
+
+func (logVerbosityLevel *LogVerbosityLevel) appendJSON(b []byte) ([]byte, error) {
+	if logVerbosityLevel == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, logVerbosityLevel.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, logVerbosityLevel.tdCommon.Extra)
+	b = append(b, `,"verbosity_level":`...)
+	b = appendJSONInt(b, int64(logVerbosityLevel.VerbosityLevel))
+	return append(b, '}'), nil
+}
+
+func (logVerbosityLevel *LogVerbosityLevel) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			logVerbosityLevel.tdCommon.Type, err = r.readString()
+		case "@extra":
+			logVerbosityLevel.tdCommon.Extra, err = r.readString()
+		case "verbosity_level":
+			logVerbosityLevel.VerbosityLevel, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (logVerbosityLevel *LogVerbosityLevel) Clone() *LogVerbosityLevel {
+	if logVerbosityLevel == nil {
+		return nil
+	}
+	clone := *logVerbosityLevel
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (logVerbosityLevel *LogVerbosityLevel) Equal(other *LogVerbosityLevel) bool {
+	if logVerbosityLevel == nil || other == nil {
+		return logVerbosityLevel == other
+	}
+	if logVerbosityLevel.VerbosityLevel != other.VerbosityLevel {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (logVerbosityLevel *LogVerbosityLevel) Diff(other *LogVerbosityLevel) []Change {
+	return logVerbosityLevel.diff("", other, nil)
+}
+
+func (logVerbosityLevel *LogVerbosityLevel) diff(path string, other *LogVerbosityLevel, changes []Change) []Change {
+	if logVerbosityLevel == nil || other == nil {
+		if logVerbosityLevel != other {
+			changes = append(changes, Change{Path: path, Old: logVerbosityLevel, New: other})
+		}
+		return changes
+	}
+	if logVerbosityLevel.VerbosityLevel != other.VerbosityLevel {
+		changes = append(changes, Change{Path: fieldPath(path, "verbosity_level"), Old: logVerbosityLevel.VerbosityLevel, New: other.VerbosityLevel})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (logVerbosityLevel *LogVerbosityLevel) String() string {
+	return objectString(logVerbosityLevel)
+}
+
+// LogValue logs the object as its summary
+func (logVerbosityLevel *LogVerbosityLevel) LogValue() slog.Value {
+	return slog.StringValue(objectString(logVerbosityLevel))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (logVerbosityLevel *LogVerbosityLevel) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, logVerbosityLevel)
+}
+
+func (logVerbosityLevel *LogVerbosityLevel) printText(p *printer) {
+	if logVerbosityLevel == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("LogVerbosityLevel")
+	p.int("verbosity_level", int64(logVerbosityLevel.VerbosityLevel))
+	p.endObject(state)
+}
+
 // GetLogVerbosityLevel Returns current verbosity level of the internal logging of TDLib. Can be called synchronously
 func (client *Client) GetLogVerbosityLevel() (*LogVerbosityLevel, error) {
 	result, err := client.SendAndCatch(UpdateData{
@@ -38,12 +140,31 @@ func (client *Client) GetLogVerbosityLevel() (*LogVerbosityLevel, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
+		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
+	}
+
+	var logVerbosityLevel LogVerbosityLevel
+	err = jsonUnmarshal(result.Raw, &logVerbosityLevel)
+	return &logVerbosityLevel, err
+}
+
+// ExecuteGetLogVerbosityLevel Synchronously executes GetLogVerbosityLevel, without a client. Returns current verbosity level of the internal logging of TDLib. Can be called synchronously
+func ExecuteGetLogVerbosityLevel() (*LogVerbosityLevel, error) {
+	result, err := execute(UpdateData{
+		"@type": "getLogVerbosityLevel",
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var logVerbosityLevel LogVerbosityLevel
-	err = json.Unmarshal(result.Raw, &logVerbosityLevel)
+	err = jsonUnmarshal(result.Raw, &logVerbosityLevel)
 	return &logVerbosityLevel, err
 }
 
@@ -59,11 +180,32 @@ func (client *Client) GetLogTagVerbosityLevel(tag string) (*LogVerbosityLevel, e
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
+		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
+	}
+
+	var logVerbosityLevel LogVerbosityLevel
+	err = jsonUnmarshal(result.Raw, &logVerbosityLevel)
+	return &logVerbosityLevel, err
+}
+
+// ExecuteGetLogTagVerbosityLevel Synchronously executes GetLogTagVerbosityLevel, without a client. Returns current verbosity level for a specified TDLib internal log tag. Can be called synchronously
+// @param tag Logging tag to change verbosity level
+func ExecuteGetLogTagVerbosityLevel(tag string) (*LogVerbosityLevel, error) {
+	result, err := execute(UpdateData{
+		"@type": "getLogTagVerbosityLevel",
+		"tag":   tag,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var logVerbosityLevel LogVerbosityLevel
-	err = json.Unmarshal(result.Raw, &logVerbosityLevel)
+	err = jsonUnmarshal(result.Raw, &logVerbosityLevel)
 	return &logVerbosityLevel, err
 }
@@ -0,0 +1,88 @@
+package tdlib
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+)
+
+// redactedFields are request fields which hold secrets and must not be logged
+var redactedFields = map[string]bool{
+	"api_hash":           true,
+	"code":               true,
+	"encryption_key":     true,
+	"new_encryption_key": true,
+	"new_password":       true,
+	"old_password":       true,
+	"password":           true,
+	"recovery_code":      true,
+	"secret":             true,
+	"token":              true,
+}
+
+// discardHandler is the slog.Handler of clients without a logger
+type discardHandler struct{}
+
+func (discardHandler) Enabled(context.Context, slog.Level) bool  { return false }
+func (discardHandler) Handle(context.Context, slog.Record) error { return nil }
+func (h discardHandler) WithAttrs([]slog.Attr) slog.Handler      { return h }
+func (h discardHandler) WithGroup(string) slog.Handler           { return h }
+
+var discardLogger = slog.New(discardHandler{})
+
+// SetLogger sets the logger used for the client internals: requests and responses are
+// logged at debug level, decode failures, dropped updates and request timeouts as warnings
+// and errors, and authorization state changes at info level.
+// Secrets such as authentication codes, passwords and bot tokens are redacted.
+// Pass nil to disable logging, which is the default.
+func (client *Client) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		logger = discardLogger
+	}
+	client.logger.Store(logger)
+}
+
+// Logger returns the logger set with SetLogger
+func (client *Client) Logger() *slog.Logger {
+	if logger := client.logger.Load(); logger != nil {
+		return logger
+	}
+	return discardLogger
+}
+
+// redactRequest returns a copy of a request which is safe to be logged
+func redactRequest(request UpdateData) interface{} {
+	jsonBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil
+	}
+
+	// keep numbers as they are, big integers would lose precision as float64
+	decoder := json.NewDecoder(bytes.NewReader(jsonBytes))
+	decoder.UseNumber()
+
+	var copied interface{}
+	if err := decoder.Decode(&copied); err != nil {
+		return nil
+	}
+	return redactValue(copied)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if redactedFields[key] {
+				v[key] = "[REDACTED]"
+			} else {
+				v[key] = redactValue(field)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+	return value
+}
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // LoginUrlInfo Contains information about an inline button of type inlineKeyboardButtonTypeLoginUrl
 type LoginUrlInfo interface {
 	GetLoginUrlInfoEnum() LoginUrlInfoEnum
+	Accept(visitor LoginUrlInfoVisitor)
 }
 
 // LoginUrlInfoEnum Alias for abstract LoginUrlInfo 'Sub-Classes', used as constant-enum here
@@ -19,30 +21,73 @@ const (
 	LoginUrlInfoRequestConfirmationType LoginUrlInfoEnum = "loginUrlInfoRequestConfirmation"
 )
 
+// UnknownLoginUrlInfo is a LoginUrlInfo of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownLoginUrlInfo struct {
+	Unknown
+}
+
+// GetLoginUrlInfoEnum return the enum type of this object
+func (unknownLoginUrlInfo *UnknownLoginUrlInfo) GetLoginUrlInfoEnum() LoginUrlInfoEnum {
+	return LoginUrlInfoEnum(unknownLoginUrlInfo.Type)
+}
+
+// Accept calls the method of the visitor for UnknownLoginUrlInfo
+func (unknownLoginUrlInfo *UnknownLoginUrlInfo) Accept(visitor LoginUrlInfoVisitor) {
+	visitor.VisitUnknownLoginUrlInfo(unknownLoginUrlInfo)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownLoginUrlInfo *UnknownLoginUrlInfo) String() string {
+	return objectString(unknownLoginUrlInfo)
+}
+
+// LogValue logs the object as its summary
+func (unknownLoginUrlInfo *UnknownLoginUrlInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownLoginUrlInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownLoginUrlInfo *UnknownLoginUrlInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownLoginUrlInfo)
+}
+
+func (unknownLoginUrlInfo *UnknownLoginUrlInfo) printText(p *printer) {
+	if unknownLoginUrlInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownLoginUrlInfo")
+	p.string("@type", unknownLoginUrlInfo.Type)
+	p.endObject(state)
+}
+
 func unmarshalLoginUrlInfo(rawMsg *json.RawMessage) (LoginUrlInfo, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch LoginUrlInfoEnum(objMap["@type"].(string)) {
+	switch LoginUrlInfoEnum(typeName) {
 	case LoginUrlInfoOpenType:
 		var loginUrlInfoOpen LoginUrlInfoOpen
-		err := json.Unmarshal(*rawMsg, &loginUrlInfoOpen)
+		err := jsonUnmarshal(*rawMsg, &loginUrlInfoOpen)
 		return &loginUrlInfoOpen, err
 
 	case LoginUrlInfoRequestConfirmationType:
 		var loginUrlInfoRequestConfirmation LoginUrlInfoRequestConfirmation
-		err := json.Unmarshal(*rawMsg, &loginUrlInfoRequestConfirmation)
+		err := jsonUnmarshal(*rawMsg, &loginUrlInfoRequestConfirmation)
 		return &loginUrlInfoRequestConfirmation, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownLoginUrlInfo{Unknown: unknown}, nil
 	}
 }
 
@@ -72,11 +117,129 @@ func NewLoginUrlInfoOpen(url string, skipConfirm bool) *LoginUrlInfoOpen {
 	return &loginUrlInfoOpenTemp
 }
 
+// MarshalJSON marshals to json
+func (loginUrlInfoOpen *LoginUrlInfoOpen) MarshalJSON() ([]byte, error) {
+	return loginUrlInfoOpen.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (loginUrlInfoOpen *LoginUrlInfoOpen) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, loginUrlInfoOpen)
+}
+
+func (loginUrlInfoOpen *LoginUrlInfoOpen) appendJSON(b []byte) ([]byte, error) {
+	if loginUrlInfoOpen == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, loginUrlInfoOpen.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, loginUrlInfoOpen.tdCommon.Extra)
+	b = append(b, `,"url":`...)
+	b = appendJSONString(b, loginUrlInfoOpen.Url)
+	b = append(b, `,"skip_confirm":`...)
+	b = appendJSONBool(b, loginUrlInfoOpen.SkipConfirm)
+	return append(b, '}'), nil
+}
+
+func (loginUrlInfoOpen *LoginUrlInfoOpen) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			loginUrlInfoOpen.tdCommon.Type, err = r.readString()
+		case "@extra":
+			loginUrlInfoOpen.tdCommon.Extra, err = r.readString()
+		case "url":
+			loginUrlInfoOpen.Url, err = r.readString()
+		case "skip_confirm":
+			loginUrlInfoOpen.SkipConfirm, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (loginUrlInfoOpen *LoginUrlInfoOpen) Clone() *LoginUrlInfoOpen {
+	if loginUrlInfoOpen == nil {
+		return nil
+	}
+	clone := *loginUrlInfoOpen
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (loginUrlInfoOpen *LoginUrlInfoOpen) Equal(other *LoginUrlInfoOpen) bool {
+	if loginUrlInfoOpen == nil || other == nil {
+		return loginUrlInfoOpen == other
+	}
+	if loginUrlInfoOpen.Url != other.Url {
+		return false
+	}
+	if loginUrlInfoOpen.SkipConfirm != other.SkipConfirm {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (loginUrlInfoOpen *LoginUrlInfoOpen) Diff(other *LoginUrlInfoOpen) []Change {
+	return loginUrlInfoOpen.diff("", other, nil)
+}
+
+func (loginUrlInfoOpen *LoginUrlInfoOpen) diff(path string, other *LoginUrlInfoOpen, changes []Change) []Change {
+	if loginUrlInfoOpen == nil || other == nil {
+		if loginUrlInfoOpen != other {
+			changes = append(changes, Change{Path: path, Old: loginUrlInfoOpen, New: other})
+		}
+		return changes
+	}
+	if loginUrlInfoOpen.Url != other.Url {
+		changes = append(changes, Change{Path: fieldPath(path, "url"), Old: loginUrlInfoOpen.Url, New: other.Url})
+	}
+	if loginUrlInfoOpen.SkipConfirm != other.SkipConfirm {
+		changes = append(changes, Change{Path: fieldPath(path, "skip_confirm"), Old: loginUrlInfoOpen.SkipConfirm, New: other.SkipConfirm})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (loginUrlInfoOpen *LoginUrlInfoOpen) String() string {
+	return objectString(loginUrlInfoOpen)
+}
+
+// LogValue logs the object as its summary
+func (loginUrlInfoOpen *LoginUrlInfoOpen) LogValue() slog.Value {
+	return slog.StringValue(objectString(loginUrlInfoOpen))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (loginUrlInfoOpen *LoginUrlInfoOpen) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, loginUrlInfoOpen)
+}
+
+func (loginUrlInfoOpen *LoginUrlInfoOpen) printText(p *printer) {
+	if loginUrlInfoOpen == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("LoginUrlInfoOpen")
+	p.string("url", loginUrlInfoOpen.Url)
+	p.bool("skip_confirm", loginUrlInfoOpen.SkipConfirm)
+	p.endObject(state)
+}
+
 // GetLoginUrlInfoEnum return the enum type of this object
 func (loginUrlInfoOpen *LoginUrlInfoOpen) GetLoginUrlInfoEnum() LoginUrlInfoEnum {
 	return LoginUrlInfoOpenType
 }
 
+// Accept calls the method of the visitor for LoginUrlInfoOpen
+func (loginUrlInfoOpen *LoginUrlInfoOpen) Accept(visitor LoginUrlInfoVisitor) {
+	visitor.VisitLoginUrlInfoOpen(loginUrlInfoOpen)
+}
+
 // LoginUrlInfoRequestConfirmation An authorization confirmation dialog needs to be shown to the user
 type LoginUrlInfoRequestConfirmation struct {
 	tdCommon
@@ -109,11 +272,246 @@ func NewLoginUrlInfoRequestConfirmation(url string, domain string, botUserId int
 	return &loginUrlInfoRequestConfirmationTemp
 }
 
+// MarshalJSON marshals to json
+func (loginUrlInfoRequestConfirmation *LoginUrlInfoRequestConfirmation) MarshalJSON() ([]byte, error) {
+	return loginUrlInfoRequestConfirmation.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (loginUrlInfoRequestConfirmation *LoginUrlInfoRequestConfirmation) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, loginUrlInfoRequestConfirmation)
+}
+
+func (loginUrlInfoRequestConfirmation *LoginUrlInfoRequestConfirmation) appendJSON(b []byte) ([]byte, error) {
+	if loginUrlInfoRequestConfirmation == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, loginUrlInfoRequestConfirmation.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, loginUrlInfoRequestConfirmation.tdCommon.Extra)
+	b = append(b, `,"url":`...)
+	b = appendJSONString(b, loginUrlInfoRequestConfirmation.Url)
+	b = append(b, `,"domain":`...)
+	b = appendJSONString(b, loginUrlInfoRequestConfirmation.Domain)
+	b = append(b, `,"bot_user_id":`...)
+	b = appendJSONInt(b, int64(loginUrlInfoRequestConfirmation.BotUserId))
+	b = append(b, `,"request_write_access":`...)
+	b = appendJSONBool(b, loginUrlInfoRequestConfirmation.RequestWriteAccess)
+	return append(b, '}'), nil
+}
+
+func (loginUrlInfoRequestConfirmation *LoginUrlInfoRequestConfirmation) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			loginUrlInfoRequestConfirmation.tdCommon.Type, err = r.readString()
+		case "@extra":
+			loginUrlInfoRequestConfirmation.tdCommon.Extra, err = r.readString()
+		case "url":
+			loginUrlInfoRequestConfirmation.Url, err = r.readString()
+		case "domain":
+			loginUrlInfoRequestConfirmation.Domain, err = r.readString()
+		case "bot_user_id":
+			loginUrlInfoRequestConfirmation.BotUserId, err = r.readInt64()
+		case "request_write_access":
+			loginUrlInfoRequestConfirmation.RequestWriteAccess, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (loginUrlInfoRequestConfirmation *LoginUrlInfoRequestConfirmation) Clone() *LoginUrlInfoRequestConfirmation {
+	if loginUrlInfoRequestConfirmation == nil {
+		return nil
+	}
+	clone := *loginUrlInfoRequestConfirmation
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (loginUrlInfoRequestConfirmation *LoginUrlInfoRequestConfirmation) Equal(other *LoginUrlInfoRequestConfirmation) bool {
+	if loginUrlInfoRequestConfirmation == nil || other == nil {
+		return loginUrlInfoRequestConfirmation == other
+	}
+	if loginUrlInfoRequestConfirmation.Url != other.Url {
+		return false
+	}
+	if loginUrlInfoRequestConfirmation.Domain != other.Domain {
+		return false
+	}
+	if loginUrlInfoRequestConfirmation.BotUserId != other.BotUserId {
+		return false
+	}
+	if loginUrlInfoRequestConfirmation.RequestWriteAccess != other.RequestWriteAccess {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (loginUrlInfoRequestConfirmation *LoginUrlInfoRequestConfirmation) Diff(other *LoginUrlInfoRequestConfirmation) []Change {
+	return loginUrlInfoRequestConfirmation.diff("", other, nil)
+}
+
+func (loginUrlInfoRequestConfirmation *LoginUrlInfoRequestConfirmation) diff(path string, other *LoginUrlInfoRequestConfirmation, changes []Change) []Change {
+	if loginUrlInfoRequestConfirmation == nil || other == nil {
+		if loginUrlInfoRequestConfirmation != other {
+			changes = append(changes, Change{Path: path, Old: loginUrlInfoRequestConfirmation, New: other})
+		}
+		return changes
+	}
+	if loginUrlInfoRequestConfirmation.Url != other.Url {
+		changes = append(changes, Change{Path: fieldPath(path, "url"), Old: loginUrlInfoRequestConfirmation.Url, New: other.Url})
+	}
+	if loginUrlInfoRequestConfirmation.Domain != other.Domain {
+		changes = append(changes, Change{Path: fieldPath(path, "domain"), Old: loginUrlInfoRequestConfirmation.Domain, New: other.Domain})
+	}
+	if loginUrlInfoRequestConfirmation.BotUserId != other.BotUserId {
+		changes = append(changes, Change{Path: fieldPath(path, "bot_user_id"), Old: loginUrlInfoRequestConfirmation.BotUserId, New: other.BotUserId})
+	}
+	if loginUrlInfoRequestConfirmation.RequestWriteAccess != other.RequestWriteAccess {
+		changes = append(changes, Change{Path: fieldPath(path, "request_write_access"), Old: loginUrlInfoRequestConfirmation.RequestWriteAccess, New: other.RequestWriteAccess})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (loginUrlInfoRequestConfirmation *LoginUrlInfoRequestConfirmation) String() string {
+	return objectString(loginUrlInfoRequestConfirmation)
+}
+
+// LogValue logs the object as its summary
+func (loginUrlInfoRequestConfirmation *LoginUrlInfoRequestConfirmation) LogValue() slog.Value {
+	return slog.StringValue(objectString(loginUrlInfoRequestConfirmation))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (loginUrlInfoRequestConfirmation *LoginUrlInfoRequestConfirmation) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, loginUrlInfoRequestConfirmation)
+}
+
+func (loginUrlInfoRequestConfirmation *LoginUrlInfoRequestConfirmation) printText(p *printer) {
+	if loginUrlInfoRequestConfirmation == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("LoginUrlInfoRequestConfirmation")
+	p.string("url", loginUrlInfoRequestConfirmation.Url)
+	p.string("domain", loginUrlInfoRequestConfirmation.Domain)
+	p.int("bot_user_id", int64(loginUrlInfoRequestConfirmation.BotUserId))
+	p.bool("request_write_access", loginUrlInfoRequestConfirmation.RequestWriteAccess)
+	p.endObject(state)
+}
+
 // GetLoginUrlInfoEnum return the enum type of this object
 func (loginUrlInfoRequestConfirmation *LoginUrlInfoRequestConfirmation) GetLoginUrlInfoEnum() LoginUrlInfoEnum {
 	return LoginUrlInfoRequestConfirmationType
 }
 
+// Accept calls the method of the visitor for LoginUrlInfoRequestConfirmation
+func (loginUrlInfoRequestConfirmation *LoginUrlInfoRequestConfirmation) Accept(visitor LoginUrlInfoVisitor) {
+	visitor.VisitLoginUrlInfoRequestConfirmation(loginUrlInfoRequestConfirmation)
+}
+
+// LoginUrlInfoVisitor visits every type of LoginUrlInfo, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to LoginUrlInfo, until they handle it too.
+type LoginUrlInfoVisitor interface {
+	VisitLoginUrlInfoOpen(loginUrlInfoOpen *LoginUrlInfoOpen)
+	VisitLoginUrlInfoRequestConfirmation(loginUrlInfoRequestConfirmation *LoginUrlInfoRequestConfirmation)
+	VisitUnknownLoginUrlInfo(unknownLoginUrlInfo *UnknownLoginUrlInfo)
+}
+
+// LoginUrlInfoCases holds the function MatchLoginUrlInfo calls for each type of LoginUrlInfo;
+// types without a function are passed to the default case
+type LoginUrlInfoCases[R any] struct {
+	LoginUrlInfoOpen                func(loginUrlInfoOpen *LoginUrlInfoOpen) R
+	LoginUrlInfoRequestConfirmation func(loginUrlInfoRequestConfirmation *LoginUrlInfoRequestConfirmation) R
+}
+
+// MatchLoginUrlInfo calls the case of the type of loginUrlInfo, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement LoginUrlInfoVisitor
+// to have the compiler check every type is handled.
+func MatchLoginUrlInfo[R any](loginUrlInfo LoginUrlInfo, cases LoginUrlInfoCases[R], defaultCase func(loginUrlInfo LoginUrlInfo) R) R {
+	switch value := loginUrlInfo.(type) {
+	case *LoginUrlInfoOpen:
+		if cases.LoginUrlInfoOpen != nil {
+			return cases.LoginUrlInfoOpen(value)
+		}
+	case *LoginUrlInfoRequestConfirmation:
+		if cases.LoginUrlInfoRequestConfirmation != nil {
+			return cases.LoginUrlInfoRequestConfirmation(value)
+		}
+	}
+	return defaultCase(loginUrlInfo)
+}
+
+// Clone returns a deep copy of the object
+func (unknownLoginUrlInfo *UnknownLoginUrlInfo) Clone() *UnknownLoginUrlInfo {
+	if unknownLoginUrlInfo == nil {
+		return nil
+	}
+	return &UnknownLoginUrlInfo{Unknown: unknownLoginUrlInfo.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownLoginUrlInfo *UnknownLoginUrlInfo) Equal(other *UnknownLoginUrlInfo) bool {
+	if unknownLoginUrlInfo == nil || other == nil {
+		return unknownLoginUrlInfo == other
+	}
+	return unknownLoginUrlInfo.Unknown.equal(other.Unknown)
+}
+
+func cloneLoginUrlInfo(loginUrlInfo LoginUrlInfo) LoginUrlInfo {
+	switch value := loginUrlInfo.(type) {
+	case *LoginUrlInfoOpen:
+		return value.Clone()
+	case *LoginUrlInfoRequestConfirmation:
+		return value.Clone()
+	case *UnknownLoginUrlInfo:
+		return value.Clone()
+	}
+	return loginUrlInfo
+}
+
+func equalLoginUrlInfo(a LoginUrlInfo, b LoginUrlInfo) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *LoginUrlInfoOpen:
+		b, ok := b.(*LoginUrlInfoOpen)
+		return ok && a.Equal(b)
+	case *LoginUrlInfoRequestConfirmation:
+		b, ok := b.(*LoginUrlInfoRequestConfirmation)
+		return ok && a.Equal(b)
+	case *UnknownLoginUrlInfo:
+		b, ok := b.(*UnknownLoginUrlInfo)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffLoginUrlInfo(path string, a LoginUrlInfo, b LoginUrlInfo, changes []Change) []Change {
+	switch a := a.(type) {
+	case *LoginUrlInfoOpen:
+		if b, ok := b.(*LoginUrlInfoOpen); ok {
+			return a.diff(path, b, changes)
+		}
+	case *LoginUrlInfoRequestConfirmation:
+		if b, ok := b.(*LoginUrlInfoRequestConfirmation); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalLoginUrlInfo(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
+
 // GetLoginUrlInfo Returns information about a button of type inlineKeyboardButtonTypeLoginUrl. The method needs to be called when the user presses the button
 // @param chatId Chat identifier of the message with the button
 // @param messageId Message identifier of the message with the button
@@ -130,20 +528,21 @@ func (client *Client) GetLoginUrlInfo(chatId int64, messageId int64, buttonId in
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
-	switch LoginUrlInfoEnum(result.Data["@type"].(string)) {
+	resultType, _ := result.Data["@type"].(string)
+	switch LoginUrlInfoEnum(resultType) {
 
 	case LoginUrlInfoOpenType:
 		var loginUrlInfo LoginUrlInfoOpen
-		err = json.Unmarshal(result.Raw, &loginUrlInfo)
+		err = jsonUnmarshal(result.Raw, &loginUrlInfo)
 		return &loginUrlInfo, err
 
 	case LoginUrlInfoRequestConfirmationType:
 		var loginUrlInfo LoginUrlInfoRequestConfirmation
-		err = json.Unmarshal(result.Raw, &loginUrlInfo)
+		err = jsonUnmarshal(result.Raw, &loginUrlInfo)
 		return &loginUrlInfo, err
 
 	default:
@@ -163,20 +562,21 @@ func (client *Client) GetExternalLinkInfo(link string) (LoginUrlInfo, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
-	switch LoginUrlInfoEnum(result.Data["@type"].(string)) {
+	resultType, _ := result.Data["@type"].(string)
+	switch LoginUrlInfoEnum(resultType) {
 
 	case LoginUrlInfoOpenType:
 		var loginUrlInfo LoginUrlInfoOpen
-		err = json.Unmarshal(result.Raw, &loginUrlInfo)
+		err = jsonUnmarshal(result.Raw, &loginUrlInfo)
 		return &loginUrlInfo, err
 
 	case LoginUrlInfoRequestConfirmationType:
 		var loginUrlInfo LoginUrlInfoRequestConfirmation
-		err = json.Unmarshal(result.Raw, &loginUrlInfo)
+		err = jsonUnmarshal(result.Raw, &loginUrlInfo)
 		return &loginUrlInfo, err
 
 	default:
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // MaskPoint Part of the face, relative to which a mask is placed
 type MaskPoint interface {
 	GetMaskPointEnum() MaskPointEnum
+	Accept(visitor MaskPointVisitor)
 }
 
 // MaskPointEnum Alias for abstract MaskPoint 'Sub-Classes', used as constant-enum here
@@ -21,41 +23,92 @@ const (
 	MaskPointChinType     MaskPointEnum = "maskPointChin"
 )
 
+// UnknownMaskPoint is a MaskPoint of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownMaskPoint struct {
+	Unknown
+}
+
+// GetMaskPointEnum return the enum type of this object
+func (unknownMaskPoint *UnknownMaskPoint) GetMaskPointEnum() MaskPointEnum {
+	return MaskPointEnum(unknownMaskPoint.Type)
+}
+
+// Accept calls the method of the visitor for UnknownMaskPoint
+func (unknownMaskPoint *UnknownMaskPoint) Accept(visitor MaskPointVisitor) {
+	visitor.VisitUnknownMaskPoint(unknownMaskPoint)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownMaskPoint *UnknownMaskPoint) String() string {
+	return objectString(unknownMaskPoint)
+}
+
+// LogValue logs the object as its summary
+func (unknownMaskPoint *UnknownMaskPoint) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownMaskPoint))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownMaskPoint *UnknownMaskPoint) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownMaskPoint)
+}
+
+func (unknownMaskPoint *UnknownMaskPoint) printText(p *printer) {
+	if unknownMaskPoint == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownMaskPoint")
+	p.string("@type", unknownMaskPoint.Type)
+	p.endObject(state)
+}
+
 func unmarshalMaskPoint(rawMsg *json.RawMessage) (MaskPoint, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch MaskPointEnum(objMap["@type"].(string)) {
+	switch MaskPointEnum(typeName) {
 	case MaskPointForeheadType:
 		var maskPointForehead MaskPointForehead
-		err := json.Unmarshal(*rawMsg, &maskPointForehead)
+		err := jsonUnmarshal(*rawMsg, &maskPointForehead)
 		return &maskPointForehead, err
 
 	case MaskPointEyesType:
 		var maskPointEyes MaskPointEyes
-		err := json.Unmarshal(*rawMsg, &maskPointEyes)
+		err := jsonUnmarshal(*rawMsg, &maskPointEyes)
 		return &maskPointEyes, err
 
 	case MaskPointMouthType:
 		var maskPointMouth MaskPointMouth
-		err := json.Unmarshal(*rawMsg, &maskPointMouth)
+		err := jsonUnmarshal(*rawMsg, &maskPointMouth)
 		return &maskPointMouth, err
 
 	case MaskPointChinType:
 		var maskPointChin MaskPointChin
-		err := json.Unmarshal(*rawMsg, &maskPointChin)
+		err := jsonUnmarshal(*rawMsg, &maskPointChin)
 		return &maskPointChin, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownMaskPoint{Unknown: unknown}, nil
+	}
+}
+
+func decodeMaskPoint(r *jsonReader) (MaskPoint, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
 	}
+	return unmarshalMaskPoint(rawMsg)
 }
 
 // MaskPointForehead The mask is placed relatively to the forehead
@@ -69,7 +122,6 @@ func (maskPointForehead *MaskPointForehead) MessageType() string {
 }
 
 // NewMaskPointForehead creates a new MaskPointForehead
-//
 func NewMaskPointForehead() *MaskPointForehead {
 	maskPointForeheadTemp := MaskPointForehead{
 		tdCommon: tdCommon{Type: "maskPointForehead"},
@@ -78,11 +130,107 @@ func NewMaskPointForehead() *MaskPointForehead {
 	return &maskPointForeheadTemp
 }
 
+// MarshalJSON marshals to json
+func (maskPointForehead *MaskPointForehead) MarshalJSON() ([]byte, error) {
+	return maskPointForehead.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (maskPointForehead *MaskPointForehead) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, maskPointForehead)
+}
+
+func (maskPointForehead *MaskPointForehead) appendJSON(b []byte) ([]byte, error) {
+	if maskPointForehead == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, maskPointForehead.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, maskPointForehead.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (maskPointForehead *MaskPointForehead) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			maskPointForehead.tdCommon.Type, err = r.readString()
+		case "@extra":
+			maskPointForehead.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (maskPointForehead *MaskPointForehead) Clone() *MaskPointForehead {
+	if maskPointForehead == nil {
+		return nil
+	}
+	clone := *maskPointForehead
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (maskPointForehead *MaskPointForehead) Equal(other *MaskPointForehead) bool {
+	if maskPointForehead == nil || other == nil {
+		return maskPointForehead == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (maskPointForehead *MaskPointForehead) Diff(other *MaskPointForehead) []Change {
+	return maskPointForehead.diff("", other, nil)
+}
+
+func (maskPointForehead *MaskPointForehead) diff(path string, other *MaskPointForehead, changes []Change) []Change {
+	if maskPointForehead == nil || other == nil {
+		if maskPointForehead != other {
+			changes = append(changes, Change{Path: path, Old: maskPointForehead, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (maskPointForehead *MaskPointForehead) String() string {
+	return objectString(maskPointForehead)
+}
+
+// LogValue logs the object as its summary
+func (maskPointForehead *MaskPointForehead) LogValue() slog.Value {
+	return slog.StringValue(objectString(maskPointForehead))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (maskPointForehead *MaskPointForehead) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, maskPointForehead)
+}
+
+func (maskPointForehead *MaskPointForehead) printText(p *printer) {
+	if maskPointForehead == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MaskPointForehead")
+	p.endObject(state)
+}
+
 // GetMaskPointEnum return the enum type of this object
 func (maskPointForehead *MaskPointForehead) GetMaskPointEnum() MaskPointEnum {
 	return MaskPointForeheadType
 }
 
+// Accept calls the method of the visitor for MaskPointForehead
+func (maskPointForehead *MaskPointForehead) Accept(visitor MaskPointVisitor) {
+	visitor.VisitMaskPointForehead(maskPointForehead)
+}
+
 // MaskPointEyes The mask is placed relatively to the eyes
 type MaskPointEyes struct {
 	tdCommon
@@ -94,7 +242,6 @@ func (maskPointEyes *MaskPointEyes) MessageType() string {
 }
 
 // NewMaskPointEyes creates a new MaskPointEyes
-//
 func NewMaskPointEyes() *MaskPointEyes {
 	maskPointEyesTemp := MaskPointEyes{
 		tdCommon: tdCommon{Type: "maskPointEyes"},
@@ -103,11 +250,107 @@ func NewMaskPointEyes() *MaskPointEyes {
 	return &maskPointEyesTemp
 }
 
+// MarshalJSON marshals to json
+func (maskPointEyes *MaskPointEyes) MarshalJSON() ([]byte, error) {
+	return maskPointEyes.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (maskPointEyes *MaskPointEyes) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, maskPointEyes)
+}
+
+func (maskPointEyes *MaskPointEyes) appendJSON(b []byte) ([]byte, error) {
+	if maskPointEyes == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, maskPointEyes.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, maskPointEyes.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (maskPointEyes *MaskPointEyes) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			maskPointEyes.tdCommon.Type, err = r.readString()
+		case "@extra":
+			maskPointEyes.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (maskPointEyes *MaskPointEyes) Clone() *MaskPointEyes {
+	if maskPointEyes == nil {
+		return nil
+	}
+	clone := *maskPointEyes
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (maskPointEyes *MaskPointEyes) Equal(other *MaskPointEyes) bool {
+	if maskPointEyes == nil || other == nil {
+		return maskPointEyes == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (maskPointEyes *MaskPointEyes) Diff(other *MaskPointEyes) []Change {
+	return maskPointEyes.diff("", other, nil)
+}
+
+func (maskPointEyes *MaskPointEyes) diff(path string, other *MaskPointEyes, changes []Change) []Change {
+	if maskPointEyes == nil || other == nil {
+		if maskPointEyes != other {
+			changes = append(changes, Change{Path: path, Old: maskPointEyes, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (maskPointEyes *MaskPointEyes) String() string {
+	return objectString(maskPointEyes)
+}
+
+// LogValue logs the object as its summary
+func (maskPointEyes *MaskPointEyes) LogValue() slog.Value {
+	return slog.StringValue(objectString(maskPointEyes))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (maskPointEyes *MaskPointEyes) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, maskPointEyes)
+}
+
+func (maskPointEyes *MaskPointEyes) printText(p *printer) {
+	if maskPointEyes == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MaskPointEyes")
+	p.endObject(state)
+}
+
 // GetMaskPointEnum return the enum type of this object
 func (maskPointEyes *MaskPointEyes) GetMaskPointEnum() MaskPointEnum {
 	return MaskPointEyesType
 }
 
+// Accept calls the method of the visitor for MaskPointEyes
+func (maskPointEyes *MaskPointEyes) Accept(visitor MaskPointVisitor) {
+	visitor.VisitMaskPointEyes(maskPointEyes)
+}
+
 // MaskPointMouth The mask is placed relatively to the mouth
 type MaskPointMouth struct {
 	tdCommon
@@ -119,7 +362,6 @@ func (maskPointMouth *MaskPointMouth) MessageType() string {
 }
 
 // NewMaskPointMouth creates a new MaskPointMouth
-//
 func NewMaskPointMouth() *MaskPointMouth {
 	maskPointMouthTemp := MaskPointMouth{
 		tdCommon: tdCommon{Type: "maskPointMouth"},
@@ -128,11 +370,107 @@ func NewMaskPointMouth() *MaskPointMouth {
 	return &maskPointMouthTemp
 }
 
+// MarshalJSON marshals to json
+func (maskPointMouth *MaskPointMouth) MarshalJSON() ([]byte, error) {
+	return maskPointMouth.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (maskPointMouth *MaskPointMouth) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, maskPointMouth)
+}
+
+func (maskPointMouth *MaskPointMouth) appendJSON(b []byte) ([]byte, error) {
+	if maskPointMouth == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, maskPointMouth.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, maskPointMouth.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (maskPointMouth *MaskPointMouth) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			maskPointMouth.tdCommon.Type, err = r.readString()
+		case "@extra":
+			maskPointMouth.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (maskPointMouth *MaskPointMouth) Clone() *MaskPointMouth {
+	if maskPointMouth == nil {
+		return nil
+	}
+	clone := *maskPointMouth
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (maskPointMouth *MaskPointMouth) Equal(other *MaskPointMouth) bool {
+	if maskPointMouth == nil || other == nil {
+		return maskPointMouth == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (maskPointMouth *MaskPointMouth) Diff(other *MaskPointMouth) []Change {
+	return maskPointMouth.diff("", other, nil)
+}
+
+func (maskPointMouth *MaskPointMouth) diff(path string, other *MaskPointMouth, changes []Change) []Change {
+	if maskPointMouth == nil || other == nil {
+		if maskPointMouth != other {
+			changes = append(changes, Change{Path: path, Old: maskPointMouth, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (maskPointMouth *MaskPointMouth) String() string {
+	return objectString(maskPointMouth)
+}
+
+// LogValue logs the object as its summary
+func (maskPointMouth *MaskPointMouth) LogValue() slog.Value {
+	return slog.StringValue(objectString(maskPointMouth))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (maskPointMouth *MaskPointMouth) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, maskPointMouth)
+}
+
+func (maskPointMouth *MaskPointMouth) printText(p *printer) {
+	if maskPointMouth == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MaskPointMouth")
+	p.endObject(state)
+}
+
 // GetMaskPointEnum return the enum type of this object
 func (maskPointMouth *MaskPointMouth) GetMaskPointEnum() MaskPointEnum {
 	return MaskPointMouthType
 }
 
+// Accept calls the method of the visitor for MaskPointMouth
+func (maskPointMouth *MaskPointMouth) Accept(visitor MaskPointVisitor) {
+	visitor.VisitMaskPointMouth(maskPointMouth)
+}
+
 // MaskPointChin The mask is placed relatively to the chin
 type MaskPointChin struct {
 	tdCommon
@@ -144,7 +482,6 @@ func (maskPointChin *MaskPointChin) MessageType() string {
 }
 
 // NewMaskPointChin creates a new MaskPointChin
-//
 func NewMaskPointChin() *MaskPointChin {
 	maskPointChinTemp := MaskPointChin{
 		tdCommon: tdCommon{Type: "maskPointChin"},
@@ -153,7 +490,228 @@ func NewMaskPointChin() *MaskPointChin {
 	return &maskPointChinTemp
 }
 
+// MarshalJSON marshals to json
+func (maskPointChin *MaskPointChin) MarshalJSON() ([]byte, error) {
+	return maskPointChin.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (maskPointChin *MaskPointChin) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, maskPointChin)
+}
+
+func (maskPointChin *MaskPointChin) appendJSON(b []byte) ([]byte, error) {
+	if maskPointChin == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, maskPointChin.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, maskPointChin.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (maskPointChin *MaskPointChin) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			maskPointChin.tdCommon.Type, err = r.readString()
+		case "@extra":
+			maskPointChin.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (maskPointChin *MaskPointChin) Clone() *MaskPointChin {
+	if maskPointChin == nil {
+		return nil
+	}
+	clone := *maskPointChin
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (maskPointChin *MaskPointChin) Equal(other *MaskPointChin) bool {
+	if maskPointChin == nil || other == nil {
+		return maskPointChin == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (maskPointChin *MaskPointChin) Diff(other *MaskPointChin) []Change {
+	return maskPointChin.diff("", other, nil)
+}
+
+func (maskPointChin *MaskPointChin) diff(path string, other *MaskPointChin, changes []Change) []Change {
+	if maskPointChin == nil || other == nil {
+		if maskPointChin != other {
+			changes = append(changes, Change{Path: path, Old: maskPointChin, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (maskPointChin *MaskPointChin) String() string {
+	return objectString(maskPointChin)
+}
+
+// LogValue logs the object as its summary
+func (maskPointChin *MaskPointChin) LogValue() slog.Value {
+	return slog.StringValue(objectString(maskPointChin))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (maskPointChin *MaskPointChin) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, maskPointChin)
+}
+
+func (maskPointChin *MaskPointChin) printText(p *printer) {
+	if maskPointChin == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MaskPointChin")
+	p.endObject(state)
+}
+
 // GetMaskPointEnum return the enum type of this object
 func (maskPointChin *MaskPointChin) GetMaskPointEnum() MaskPointEnum {
 	return MaskPointChinType
 }
+
+// Accept calls the method of the visitor for MaskPointChin
+func (maskPointChin *MaskPointChin) Accept(visitor MaskPointVisitor) {
+	visitor.VisitMaskPointChin(maskPointChin)
+}
+
+// MaskPointVisitor visits every type of MaskPoint, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to MaskPoint, until they handle it too.
+type MaskPointVisitor interface {
+	VisitMaskPointForehead(maskPointForehead *MaskPointForehead)
+	VisitMaskPointEyes(maskPointEyes *MaskPointEyes)
+	VisitMaskPointMouth(maskPointMouth *MaskPointMouth)
+	VisitMaskPointChin(maskPointChin *MaskPointChin)
+	VisitUnknownMaskPoint(unknownMaskPoint *UnknownMaskPoint)
+}
+
+// MaskPointCases holds the function MatchMaskPoint calls for each type of MaskPoint;
+// types without a function are passed to the default case
+type MaskPointCases[R any] struct {
+	MaskPointForehead func(maskPointForehead *MaskPointForehead) R
+	MaskPointEyes     func(maskPointEyes *MaskPointEyes) R
+	MaskPointMouth    func(maskPointMouth *MaskPointMouth) R
+	MaskPointChin     func(maskPointChin *MaskPointChin) R
+}
+
+// MatchMaskPoint calls the case of the type of maskPoint, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement MaskPointVisitor
+// to have the compiler check every type is handled.
+func MatchMaskPoint[R any](maskPoint MaskPoint, cases MaskPointCases[R], defaultCase func(maskPoint MaskPoint) R) R {
+	switch value := maskPoint.(type) {
+	case *MaskPointForehead:
+		if cases.MaskPointForehead != nil {
+			return cases.MaskPointForehead(value)
+		}
+	case *MaskPointEyes:
+		if cases.MaskPointEyes != nil {
+			return cases.MaskPointEyes(value)
+		}
+	case *MaskPointMouth:
+		if cases.MaskPointMouth != nil {
+			return cases.MaskPointMouth(value)
+		}
+	case *MaskPointChin:
+		if cases.MaskPointChin != nil {
+			return cases.MaskPointChin(value)
+		}
+	}
+	return defaultCase(maskPoint)
+}
+
+// Clone returns a deep copy of the object
+func (unknownMaskPoint *UnknownMaskPoint) Clone() *UnknownMaskPoint {
+	if unknownMaskPoint == nil {
+		return nil
+	}
+	return &UnknownMaskPoint{Unknown: unknownMaskPoint.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownMaskPoint *UnknownMaskPoint) Equal(other *UnknownMaskPoint) bool {
+	if unknownMaskPoint == nil || other == nil {
+		return unknownMaskPoint == other
+	}
+	return unknownMaskPoint.Unknown.equal(other.Unknown)
+}
+
+func cloneMaskPoint(maskPoint MaskPoint) MaskPoint {
+	switch value := maskPoint.(type) {
+	case *MaskPointForehead:
+		return value.Clone()
+	case *MaskPointEyes:
+		return value.Clone()
+	case *MaskPointMouth:
+		return value.Clone()
+	case *MaskPointChin:
+		return value.Clone()
+	case *UnknownMaskPoint:
+		return value.Clone()
+	}
+	return maskPoint
+}
+
+func equalMaskPoint(a MaskPoint, b MaskPoint) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *MaskPointForehead:
+		b, ok := b.(*MaskPointForehead)
+		return ok && a.Equal(b)
+	case *MaskPointEyes:
+		b, ok := b.(*MaskPointEyes)
+		return ok && a.Equal(b)
+	case *MaskPointMouth:
+		b, ok := b.(*MaskPointMouth)
+		return ok && a.Equal(b)
+	case *MaskPointChin:
+		b, ok := b.(*MaskPointChin)
+		return ok && a.Equal(b)
+	case *UnknownMaskPoint:
+		b, ok := b.(*UnknownMaskPoint)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffMaskPoint(path string, a MaskPoint, b MaskPoint, changes []Change) []Change {
+	switch a := a.(type) {
+	case *MaskPointForehead:
+		if b, ok := b.(*MaskPointForehead); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MaskPointEyes:
+		if b, ok := b.(*MaskPointEyes); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MaskPointMouth:
+		if b, ok := b.(*MaskPointMouth); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MaskPointChin:
+		if b, ok := b.(*MaskPointChin); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalMaskPoint(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
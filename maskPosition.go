@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // MaskPosition Position on a photo where a mask is placed
@@ -36,31 +37,149 @@ func NewMaskPosition(point MaskPoint, xShift float64, yShift float64, scale floa
 	return &maskPositionTemp
 }
 
+// MarshalJSON marshals to json
+func (maskPosition *MaskPosition) MarshalJSON() ([]byte, error) {
+	return maskPosition.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (maskPosition *MaskPosition) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, maskPosition)
+}
+
+func (maskPosition *MaskPosition) appendJSON(b []byte) ([]byte, error) {
+	if maskPosition == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, maskPosition.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, maskPosition.tdCommon.Extra)
+	b = append(b, `,"point":`...)
+	b, err = appendJSONValue(b, maskPosition.Point)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"x_shift":`...)
+	b, err = appendJSONFloat(b, maskPosition.XShift)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"y_shift":`...)
+	b, err = appendJSONFloat(b, maskPosition.YShift)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		XShift float64 `json:"x_shift"` // Shift by X-axis measured in widths of the mask scaled to the face size, from left to right. (For example, -1.0 will place the mask just to the left of the default mask position)
-		YShift float64 `json:"y_shift"` // Shift by Y-axis measured in heights of the mask scaled to the face size, from top to bottom. (For example, 1.0 will place the mask just below the default mask position)
-		Scale  float64 `json:"scale"`   // Mask scaling coefficient. (For example, 2.0 means a doubled size)
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"scale":`...)
+	b, err = appendJSONFloat(b, maskPosition.Scale)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (maskPosition *MaskPosition) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			maskPosition.tdCommon.Type, err = r.readString()
+		case "@extra":
+			maskPosition.tdCommon.Extra, err = r.readString()
+		case "point":
+			maskPosition.Point, err = decodeMaskPoint(r)
+		case "x_shift":
+			maskPosition.XShift, err = r.readFloat64()
+		case "y_shift":
+			maskPosition.YShift, err = r.readFloat64()
+		case "scale":
+			maskPosition.Scale, err = r.readFloat64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (maskPosition *MaskPosition) Clone() *MaskPosition {
+	if maskPosition == nil {
+		return nil
+	}
+	clone := *maskPosition
+	clone.Point = cloneMaskPoint(maskPosition.Point)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (maskPosition *MaskPosition) Equal(other *MaskPosition) bool {
+	if maskPosition == nil || other == nil {
+		return maskPosition == other
 	}
+	if !equalMaskPoint(maskPosition.Point, other.Point) {
+		return false
+	}
+	if maskPosition.XShift != other.XShift {
+		return false
+	}
+	if maskPosition.YShift != other.YShift {
+		return false
+	}
+	if maskPosition.Scale != other.Scale {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (maskPosition *MaskPosition) Diff(other *MaskPosition) []Change {
+	return maskPosition.diff("", other, nil)
+}
+
+func (maskPosition *MaskPosition) diff(path string, other *MaskPosition, changes []Change) []Change {
+	if maskPosition == nil || other == nil {
+		if maskPosition != other {
+			changes = append(changes, Change{Path: path, Old: maskPosition, New: other})
+		}
+		return changes
+	}
+	changes = diffMaskPoint(fieldPath(path, "point"), maskPosition.Point, other.Point, changes)
+	if maskPosition.XShift != other.XShift {
+		changes = append(changes, Change{Path: fieldPath(path, "x_shift"), Old: maskPosition.XShift, New: other.XShift})
+	}
+	if maskPosition.YShift != other.YShift {
+		changes = append(changes, Change{Path: fieldPath(path, "y_shift"), Old: maskPosition.YShift, New: other.YShift})
+	}
+	if maskPosition.Scale != other.Scale {
+		changes = append(changes, Change{Path: fieldPath(path, "scale"), Old: maskPosition.Scale, New: other.Scale})
+	}
+	return changes
+}
 
-	maskPosition.tdCommon = tempObj.tdCommon
-	maskPosition.XShift = tempObj.XShift
-	maskPosition.YShift = tempObj.YShift
-	maskPosition.Scale = tempObj.Scale
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (maskPosition *MaskPosition) String() string {
+	return objectString(maskPosition)
+}
 
-	fieldPoint, _ := unmarshalMaskPoint(objMap["point"])
-	maskPosition.Point = fieldPoint
+// LogValue logs the object as its summary
+func (maskPosition *MaskPosition) LogValue() slog.Value {
+	return slog.StringValue(objectString(maskPosition))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (maskPosition *MaskPosition) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, maskPosition)
+}
 
-	return nil
+func (maskPosition *MaskPosition) printText(p *printer) {
+	if maskPosition == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MaskPosition")
+	p.object("point", maskPosition.Point)
+	p.float("x_shift", maskPosition.XShift)
+	p.float("y_shift", maskPosition.YShift)
+	p.float("scale", maskPosition.Scale)
+	p.endObject(state)
 }
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // Message Describes a message
@@ -127,143 +127,497 @@ func NewMessage(id int64, senderId MessageSender, chatId int64, sendingState Mes
 	return &messageTemp
 }
 
+// MarshalJSON marshals to json
+func (message *Message) MarshalJSON() ([]byte, error) {
+	return message.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (message *Message) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, message)
+}
+
+func (message *Message) appendJSON(b []byte) ([]byte, error) {
+	if message == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, message.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, message.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt(b, int64(message.Id))
+	b = append(b, `,"sender_id":`...)
+	b, err = appendJSONValue(b, message.SenderId)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(message.ChatId))
+	b = append(b, `,"sending_state":`...)
+	b, err = appendJSONValue(b, message.SendingState)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Id                        int64               `json:"id"`                            // Message identifier; unique for the chat to which the message belongs
-		ChatId                    int64               `json:"chat_id"`                       // Chat identifier
-		IsOutgoing                bool                `json:"is_outgoing"`                   // True, if the message is outgoing
-		IsPinned                  bool                `json:"is_pinned"`                     // True, if the message is pinned
-		CanBeEdited               bool                `json:"can_be_edited"`                 // True, if the message can be edited. For live location and poll messages this fields shows whether editMessageLiveLocation or stopPoll can be used with this message by the application
-		CanBeForwarded            bool                `json:"can_be_forwarded"`              // True, if the message can be forwarded
-		CanBeSaved                bool                `json:"can_be_saved"`                  // True, if content of the message can be saved locally or copied
-		CanBeDeletedOnlyForSelf   bool                `json:"can_be_deleted_only_for_self"`  // True, if the message can be deleted only for the current user while other users will continue to see it
-		CanBeDeletedForAllUsers   bool                `json:"can_be_deleted_for_all_users"`  // True, if the message can be deleted for all users
-		CanGetStatistics          bool                `json:"can_get_statistics"`            // True, if the message statistics are available
-		CanGetMessageThread       bool                `json:"can_get_message_thread"`        // True, if the message thread info is available
-		CanGetViewers             bool                `json:"can_get_viewers"`               // True, if chat members already viewed the message can be received through getMessageViewers
-		CanGetMediaTimestampLinks bool                `json:"can_get_media_timestamp_links"` // True, if media timestamp links can be generated for media timestamp entities in the message text, caption or web page description
-		HasTimestampedMedia       bool                `json:"has_timestamped_media"`         // True, if media timestamp entities refers to a media in this message as opposed to a media in the replied message
-		IsChannelPost             bool                `json:"is_channel_post"`               // True, if the message is a channel post. All messages to channels are channel posts, all other messages are not channel posts
-		ContainsUnreadMention     bool                `json:"contains_unread_mention"`       // True, if the message contains an unread mention for the current user
-		Date                      int32               `json:"date"`                          // Point in time (Unix timestamp) when the message was sent
-		EditDate                  int32               `json:"edit_date"`                     // Point in time (Unix timestamp) when the message was last edited
-		ForwardInfo               *MessageForwardInfo `json:"forward_info"`                  // Information about the initial message sender; may be null
-		ReplyInChatId             int64               `json:"reply_in_chat_id"`              // If non-zero, the identifier of the chat to which the replied message belongs; Currently, only messages in the Replies chat can have different reply_in_chat_id and chat_id
-		ReplyToMessageId          int64               `json:"reply_to_message_id"`           // If non-zero, the identifier of the message this message is replying to; can be the identifier of a deleted message
-		MessageThreadId           int64               `json:"message_thread_id"`             // If non-zero, the identifier of the message thread the message belongs to; unique within the chat to which the message belongs
-		Ttl                       int32               `json:"ttl"`                           // For self-destructing messages, the message's TTL (Time To Live), in seconds; 0 if none. TDLib will send updateDeleteMessages or updateMessageContent once the TTL expires
-		TtlExpiresIn              float64             `json:"ttl_expires_in"`                // Time left before the message expires, in seconds. If the TTL timer isn't started yet, equals to the value of the ttl field
-		ViaBotUserId              int64               `json:"via_bot_user_id"`               // If non-zero, the user identifier of the bot through which this message was sent
-		AuthorSignature           string              `json:"author_signature"`              // For channel posts and anonymous group messages, optional author signature
-		MediaAlbumId              JSONInt64           `json:"media_album_id"`                // Unique identifier of an album this message belongs to. Only audios, documents, photos and videos can be grouped together in albums
-		RestrictionReason         string              `json:"restriction_reason"`            // If non-empty, contains a human-readable description of the reason why access to this message must be restricted
-
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"scheduling_state":`...)
+	b, err = appendJSONValue(b, message.SchedulingState)
 	if err != nil {
-		return err
-	}
-
-	message.tdCommon = tempObj.tdCommon
-	message.Id = tempObj.Id
-	message.ChatId = tempObj.ChatId
-	message.IsOutgoing = tempObj.IsOutgoing
-	message.IsPinned = tempObj.IsPinned
-	message.CanBeEdited = tempObj.CanBeEdited
-	message.CanBeForwarded = tempObj.CanBeForwarded
-	message.CanBeSaved = tempObj.CanBeSaved
-	message.CanBeDeletedOnlyForSelf = tempObj.CanBeDeletedOnlyForSelf
-	message.CanBeDeletedForAllUsers = tempObj.CanBeDeletedForAllUsers
-	message.CanGetStatistics = tempObj.CanGetStatistics
-	message.CanGetMessageThread = tempObj.CanGetMessageThread
-	message.CanGetViewers = tempObj.CanGetViewers
-	message.CanGetMediaTimestampLinks = tempObj.CanGetMediaTimestampLinks
-	message.HasTimestampedMedia = tempObj.HasTimestampedMedia
-	message.IsChannelPost = tempObj.IsChannelPost
-	message.ContainsUnreadMention = tempObj.ContainsUnreadMention
-	message.Date = tempObj.Date
-	message.EditDate = tempObj.EditDate
-	message.ForwardInfo = tempObj.ForwardInfo
-	message.ReplyInChatId = tempObj.ReplyInChatId
-	message.ReplyToMessageId = tempObj.ReplyToMessageId
-	message.MessageThreadId = tempObj.MessageThreadId
-	message.Ttl = tempObj.Ttl
-	message.TtlExpiresIn = tempObj.TtlExpiresIn
-	message.ViaBotUserId = tempObj.ViaBotUserId
-	message.AuthorSignature = tempObj.AuthorSignature
-	message.MediaAlbumId = tempObj.MediaAlbumId
-	message.RestrictionReason = tempObj.RestrictionReason
-
-	fieldSenderId, _ := unmarshalMessageSender(objMap["sender_id"])
-	message.SenderId = fieldSenderId
-
-	// FYI: Arman92/go-tl-parser can't parse TDLib 1.7 new type `interaction_info`
-	// and that go-tl-parser is HARD TO MAINTENANCE!!!
-	// So we need convert `interaction_info` to Go struct without codegen.
-	if objMap["interaction_info"] != nil {
-		info, _ := objMap["interaction_info"].MarshalJSON()
-
-		infoObj := struct {
-			Type         string `json:"@type"`
-			ViewCount    int32  `json:"view_count"`
-			ForwardCount int32  `json:"forward_count"`
-			ReplyInfo    struct {
-				Type             string `json:"@type"`
-				ReplyCount       int32  `json:"reply_count"`
-				RecentReplierIds []struct {
-					Type   string `json:"@type"`
-					ChatID int64  `json:"chat_id"`
-					UserID int64  `json:"user_id"`
-				} `json:"recent_repliers_ids"`
-				LastReadInboxMessageID  int64 `json:"last_read_inbox_message_id"`
-				LastReadOutboxMessageID int64 `json:"last_read_outbox_message_id"`
-				LastMessageID           int64 `json:"last_message_id"`
-			} `json:"reply_info"`
-		}{}
-
-		err = json.Unmarshal(info, &infoObj)
-		if err != nil {
-			return err
-		}
+		return nil, err
+	}
+	b = append(b, `,"is_outgoing":`...)
+	b = appendJSONBool(b, message.IsOutgoing)
+	b = append(b, `,"is_pinned":`...)
+	b = appendJSONBool(b, message.IsPinned)
+	b = append(b, `,"can_be_edited":`...)
+	b = appendJSONBool(b, message.CanBeEdited)
+	b = append(b, `,"can_be_forwarded":`...)
+	b = appendJSONBool(b, message.CanBeForwarded)
+	b = append(b, `,"can_be_saved":`...)
+	b = appendJSONBool(b, message.CanBeSaved)
+	b = append(b, `,"can_be_deleted_only_for_self":`...)
+	b = appendJSONBool(b, message.CanBeDeletedOnlyForSelf)
+	b = append(b, `,"can_be_deleted_for_all_users":`...)
+	b = appendJSONBool(b, message.CanBeDeletedForAllUsers)
+	b = append(b, `,"can_get_statistics":`...)
+	b = appendJSONBool(b, message.CanGetStatistics)
+	b = append(b, `,"can_get_message_thread":`...)
+	b = appendJSONBool(b, message.CanGetMessageThread)
+	b = append(b, `,"can_get_viewers":`...)
+	b = appendJSONBool(b, message.CanGetViewers)
+	b = append(b, `,"can_get_media_timestamp_links":`...)
+	b = appendJSONBool(b, message.CanGetMediaTimestampLinks)
+	b = append(b, `,"has_timestamped_media":`...)
+	b = appendJSONBool(b, message.HasTimestampedMedia)
+	b = append(b, `,"is_channel_post":`...)
+	b = appendJSONBool(b, message.IsChannelPost)
+	b = append(b, `,"contains_unread_mention":`...)
+	b = appendJSONBool(b, message.ContainsUnreadMention)
+	b = append(b, `,"date":`...)
+	b = appendJSONInt(b, int64(message.Date))
+	b = append(b, `,"edit_date":`...)
+	b = appendJSONInt(b, int64(message.EditDate))
+	b = append(b, `,"forward_info":`...)
+	b, err = message.ForwardInfo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"interaction_info":`...)
+	b, err = message.InteractionInfo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"reply_in_chat_id":`...)
+	b = appendJSONInt(b, int64(message.ReplyInChatId))
+	b = append(b, `,"reply_to_message_id":`...)
+	b = appendJSONInt(b, int64(message.ReplyToMessageId))
+	b = append(b, `,"message_thread_id":`...)
+	b = appendJSONInt(b, int64(message.MessageThreadId))
+	b = append(b, `,"ttl":`...)
+	b = appendJSONInt(b, int64(message.Ttl))
+	b = append(b, `,"ttl_expires_in":`...)
+	b, err = appendJSONFloat(b, message.TtlExpiresIn)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"via_bot_user_id":`...)
+	b = appendJSONInt(b, int64(message.ViaBotUserId))
+	b = append(b, `,"author_signature":`...)
+	b = appendJSONString(b, message.AuthorSignature)
+	b = append(b, `,"media_album_id":`...)
+	b = appendJSONInt64String(b, int64(message.MediaAlbumId))
+	b = append(b, `,"restriction_reason":`...)
+	b = appendJSONString(b, message.RestrictionReason)
+	b = append(b, `,"content":`...)
+	b, err = appendJSONValue(b, message.Content)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"reply_markup":`...)
+	b, err = appendJSONValue(b, message.ReplyMarkup)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
 
-		message.InteractionInfo = NewMessageInteractionInfo(infoObj.ViewCount, infoObj.ForwardCount, nil)
-		message.InteractionInfo.ReplyInfo = NewMessageReplyInfo(infoObj.ReplyInfo.ReplyCount, nil, infoObj.ReplyInfo.LastReadInboxMessageID, infoObj.ReplyInfo.LastReadOutboxMessageID, infoObj.ReplyInfo.LastMessageID)
-		for _, reply := range infoObj.ReplyInfo.RecentReplierIds {
-			switch reply.Type {
-			case "messageSenderUser":
-				{
-					message.InteractionInfo.ReplyInfo.RecentReplierIds = append(message.InteractionInfo.ReplyInfo.RecentReplierIds, NewMessageSenderUser(reply.UserID))
-				}
-			case "messageSenderChat":
-				{
-					message.InteractionInfo.ReplyInfo.RecentReplierIds = append(message.InteractionInfo.ReplyInfo.RecentReplierIds, NewMessageSenderChat(reply.ChatID))
-				}
-			default:
-				return fmt.Errorf("Error unmarshaling, unknown messageSender type:" + reply.Type)
+func (message *Message) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			message.tdCommon.Type, err = r.readString()
+		case "@extra":
+			message.tdCommon.Extra, err = r.readString()
+		case "id":
+			message.Id, err = r.readInt64()
+		case "sender_id":
+			message.SenderId, err = decodeMessageSender(r)
+		case "chat_id":
+			message.ChatId, err = r.readInt64()
+		case "sending_state":
+			message.SendingState, err = decodeMessageSendingState(r)
+		case "scheduling_state":
+			message.SchedulingState, err = decodeMessageSchedulingState(r)
+		case "is_outgoing":
+			message.IsOutgoing, err = r.readBool()
+		case "is_pinned":
+			message.IsPinned, err = r.readBool()
+		case "can_be_edited":
+			message.CanBeEdited, err = r.readBool()
+		case "can_be_forwarded":
+			message.CanBeForwarded, err = r.readBool()
+		case "can_be_saved":
+			message.CanBeSaved, err = r.readBool()
+		case "can_be_deleted_only_for_self":
+			message.CanBeDeletedOnlyForSelf, err = r.readBool()
+		case "can_be_deleted_for_all_users":
+			message.CanBeDeletedForAllUsers, err = r.readBool()
+		case "can_get_statistics":
+			message.CanGetStatistics, err = r.readBool()
+		case "can_get_message_thread":
+			message.CanGetMessageThread, err = r.readBool()
+		case "can_get_viewers":
+			message.CanGetViewers, err = r.readBool()
+		case "can_get_media_timestamp_links":
+			message.CanGetMediaTimestampLinks, err = r.readBool()
+		case "has_timestamped_media":
+			message.HasTimestampedMedia, err = r.readBool()
+		case "is_channel_post":
+			message.IsChannelPost, err = r.readBool()
+		case "contains_unread_mention":
+			message.ContainsUnreadMention, err = r.readBool()
+		case "date":
+			message.Date, err = r.readInt32()
+		case "edit_date":
+			message.EditDate, err = r.readInt32()
+		case "forward_info":
+			if r.readNull() {
+				message.ForwardInfo = nil
+			} else {
+				message.ForwardInfo = new(MessageForwardInfo)
+				err = message.ForwardInfo.decodeJSON(r)
 			}
+		case "interaction_info":
+			if r.readNull() {
+				message.InteractionInfo = nil
+			} else {
+				message.InteractionInfo = new(MessageInteractionInfo)
+				err = message.InteractionInfo.decodeJSON(r)
+			}
+		case "reply_in_chat_id":
+			message.ReplyInChatId, err = r.readInt64()
+		case "reply_to_message_id":
+			message.ReplyToMessageId, err = r.readInt64()
+		case "message_thread_id":
+			message.MessageThreadId, err = r.readInt64()
+		case "ttl":
+			message.Ttl, err = r.readInt32()
+		case "ttl_expires_in":
+			message.TtlExpiresIn, err = r.readFloat64()
+		case "via_bot_user_id":
+			message.ViaBotUserId, err = r.readInt64()
+		case "author_signature":
+			message.AuthorSignature, err = r.readString()
+		case "media_album_id":
+			message.MediaAlbumId, err = r.readJSONInt64()
+		case "restriction_reason":
+			message.RestrictionReason, err = r.readString()
+		case "content":
+			message.Content, err = decodeMessageContent(r)
+		case "reply_markup":
+			message.ReplyMarkup, err = decodeReplyMarkup(r)
+		default:
+			err = r.skip()
 		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (message *Message) Clone() *Message {
+	if message == nil {
+		return nil
 	}
+	clone := *message
+	clone.SenderId = cloneMessageSender(message.SenderId)
+	clone.SendingState = cloneMessageSendingState(message.SendingState)
+	clone.SchedulingState = cloneMessageSchedulingState(message.SchedulingState)
+	clone.ForwardInfo = message.ForwardInfo.Clone()
+	clone.InteractionInfo = message.InteractionInfo.Clone()
+	clone.Content = cloneMessageContent(message.Content)
+	clone.ReplyMarkup = cloneReplyMarkup(message.ReplyMarkup)
+	return &clone
+}
 
-	fieldSendingState, _ := unmarshalMessageSendingState(objMap["sending_state"])
-	message.SendingState = fieldSendingState
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (message *Message) Equal(other *Message) bool {
+	if message == nil || other == nil {
+		return message == other
+	}
+	if message.Id != other.Id {
+		return false
+	}
+	if !equalMessageSender(message.SenderId, other.SenderId) {
+		return false
+	}
+	if message.ChatId != other.ChatId {
+		return false
+	}
+	if !equalMessageSendingState(message.SendingState, other.SendingState) {
+		return false
+	}
+	if !equalMessageSchedulingState(message.SchedulingState, other.SchedulingState) {
+		return false
+	}
+	if message.IsOutgoing != other.IsOutgoing {
+		return false
+	}
+	if message.IsPinned != other.IsPinned {
+		return false
+	}
+	if message.CanBeEdited != other.CanBeEdited {
+		return false
+	}
+	if message.CanBeForwarded != other.CanBeForwarded {
+		return false
+	}
+	if message.CanBeSaved != other.CanBeSaved {
+		return false
+	}
+	if message.CanBeDeletedOnlyForSelf != other.CanBeDeletedOnlyForSelf {
+		return false
+	}
+	if message.CanBeDeletedForAllUsers != other.CanBeDeletedForAllUsers {
+		return false
+	}
+	if message.CanGetStatistics != other.CanGetStatistics {
+		return false
+	}
+	if message.CanGetMessageThread != other.CanGetMessageThread {
+		return false
+	}
+	if message.CanGetViewers != other.CanGetViewers {
+		return false
+	}
+	if message.CanGetMediaTimestampLinks != other.CanGetMediaTimestampLinks {
+		return false
+	}
+	if message.HasTimestampedMedia != other.HasTimestampedMedia {
+		return false
+	}
+	if message.IsChannelPost != other.IsChannelPost {
+		return false
+	}
+	if message.ContainsUnreadMention != other.ContainsUnreadMention {
+		return false
+	}
+	if message.Date != other.Date {
+		return false
+	}
+	if message.EditDate != other.EditDate {
+		return false
+	}
+	if !message.ForwardInfo.Equal(other.ForwardInfo) {
+		return false
+	}
+	if !message.InteractionInfo.Equal(other.InteractionInfo) {
+		return false
+	}
+	if message.ReplyInChatId != other.ReplyInChatId {
+		return false
+	}
+	if message.ReplyToMessageId != other.ReplyToMessageId {
+		return false
+	}
+	if message.MessageThreadId != other.MessageThreadId {
+		return false
+	}
+	if message.Ttl != other.Ttl {
+		return false
+	}
+	if message.TtlExpiresIn != other.TtlExpiresIn {
+		return false
+	}
+	if message.ViaBotUserId != other.ViaBotUserId {
+		return false
+	}
+	if message.AuthorSignature != other.AuthorSignature {
+		return false
+	}
+	if message.MediaAlbumId != other.MediaAlbumId {
+		return false
+	}
+	if message.RestrictionReason != other.RestrictionReason {
+		return false
+	}
+	if !equalMessageContent(message.Content, other.Content) {
+		return false
+	}
+	if !equalReplyMarkup(message.ReplyMarkup, other.ReplyMarkup) {
+		return false
+	}
+	return true
+}
 
-	fieldSchedulingState, _ := unmarshalMessageSchedulingState(objMap["scheduling_state"])
-	message.SchedulingState = fieldSchedulingState
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (message *Message) Diff(other *Message) []Change {
+	return message.diff("", other, nil)
+}
 
-	fieldContent, _ := unmarshalMessageContent(objMap["content"])
-	message.Content = fieldContent
+func (message *Message) diff(path string, other *Message, changes []Change) []Change {
+	if message == nil || other == nil {
+		if message != other {
+			changes = append(changes, Change{Path: path, Old: message, New: other})
+		}
+		return changes
+	}
+	if message.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: message.Id, New: other.Id})
+	}
+	changes = diffMessageSender(fieldPath(path, "sender_id"), message.SenderId, other.SenderId, changes)
+	if message.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: message.ChatId, New: other.ChatId})
+	}
+	changes = diffMessageSendingState(fieldPath(path, "sending_state"), message.SendingState, other.SendingState, changes)
+	changes = diffMessageSchedulingState(fieldPath(path, "scheduling_state"), message.SchedulingState, other.SchedulingState, changes)
+	if message.IsOutgoing != other.IsOutgoing {
+		changes = append(changes, Change{Path: fieldPath(path, "is_outgoing"), Old: message.IsOutgoing, New: other.IsOutgoing})
+	}
+	if message.IsPinned != other.IsPinned {
+		changes = append(changes, Change{Path: fieldPath(path, "is_pinned"), Old: message.IsPinned, New: other.IsPinned})
+	}
+	if message.CanBeEdited != other.CanBeEdited {
+		changes = append(changes, Change{Path: fieldPath(path, "can_be_edited"), Old: message.CanBeEdited, New: other.CanBeEdited})
+	}
+	if message.CanBeForwarded != other.CanBeForwarded {
+		changes = append(changes, Change{Path: fieldPath(path, "can_be_forwarded"), Old: message.CanBeForwarded, New: other.CanBeForwarded})
+	}
+	if message.CanBeSaved != other.CanBeSaved {
+		changes = append(changes, Change{Path: fieldPath(path, "can_be_saved"), Old: message.CanBeSaved, New: other.CanBeSaved})
+	}
+	if message.CanBeDeletedOnlyForSelf != other.CanBeDeletedOnlyForSelf {
+		changes = append(changes, Change{Path: fieldPath(path, "can_be_deleted_only_for_self"), Old: message.CanBeDeletedOnlyForSelf, New: other.CanBeDeletedOnlyForSelf})
+	}
+	if message.CanBeDeletedForAllUsers != other.CanBeDeletedForAllUsers {
+		changes = append(changes, Change{Path: fieldPath(path, "can_be_deleted_for_all_users"), Old: message.CanBeDeletedForAllUsers, New: other.CanBeDeletedForAllUsers})
+	}
+	if message.CanGetStatistics != other.CanGetStatistics {
+		changes = append(changes, Change{Path: fieldPath(path, "can_get_statistics"), Old: message.CanGetStatistics, New: other.CanGetStatistics})
+	}
+	if message.CanGetMessageThread != other.CanGetMessageThread {
+		changes = append(changes, Change{Path: fieldPath(path, "can_get_message_thread"), Old: message.CanGetMessageThread, New: other.CanGetMessageThread})
+	}
+	if message.CanGetViewers != other.CanGetViewers {
+		changes = append(changes, Change{Path: fieldPath(path, "can_get_viewers"), Old: message.CanGetViewers, New: other.CanGetViewers})
+	}
+	if message.CanGetMediaTimestampLinks != other.CanGetMediaTimestampLinks {
+		changes = append(changes, Change{Path: fieldPath(path, "can_get_media_timestamp_links"), Old: message.CanGetMediaTimestampLinks, New: other.CanGetMediaTimestampLinks})
+	}
+	if message.HasTimestampedMedia != other.HasTimestampedMedia {
+		changes = append(changes, Change{Path: fieldPath(path, "has_timestamped_media"), Old: message.HasTimestampedMedia, New: other.HasTimestampedMedia})
+	}
+	if message.IsChannelPost != other.IsChannelPost {
+		changes = append(changes, Change{Path: fieldPath(path, "is_channel_post"), Old: message.IsChannelPost, New: other.IsChannelPost})
+	}
+	if message.ContainsUnreadMention != other.ContainsUnreadMention {
+		changes = append(changes, Change{Path: fieldPath(path, "contains_unread_mention"), Old: message.ContainsUnreadMention, New: other.ContainsUnreadMention})
+	}
+	if message.Date != other.Date {
+		changes = append(changes, Change{Path: fieldPath(path, "date"), Old: message.Date, New: other.Date})
+	}
+	if message.EditDate != other.EditDate {
+		changes = append(changes, Change{Path: fieldPath(path, "edit_date"), Old: message.EditDate, New: other.EditDate})
+	}
+	changes = message.ForwardInfo.diff(fieldPath(path, "forward_info"), other.ForwardInfo, changes)
+	changes = message.InteractionInfo.diff(fieldPath(path, "interaction_info"), other.InteractionInfo, changes)
+	if message.ReplyInChatId != other.ReplyInChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "reply_in_chat_id"), Old: message.ReplyInChatId, New: other.ReplyInChatId})
+	}
+	if message.ReplyToMessageId != other.ReplyToMessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "reply_to_message_id"), Old: message.ReplyToMessageId, New: other.ReplyToMessageId})
+	}
+	if message.MessageThreadId != other.MessageThreadId {
+		changes = append(changes, Change{Path: fieldPath(path, "message_thread_id"), Old: message.MessageThreadId, New: other.MessageThreadId})
+	}
+	if message.Ttl != other.Ttl {
+		changes = append(changes, Change{Path: fieldPath(path, "ttl"), Old: message.Ttl, New: other.Ttl})
+	}
+	if message.TtlExpiresIn != other.TtlExpiresIn {
+		changes = append(changes, Change{Path: fieldPath(path, "ttl_expires_in"), Old: message.TtlExpiresIn, New: other.TtlExpiresIn})
+	}
+	if message.ViaBotUserId != other.ViaBotUserId {
+		changes = append(changes, Change{Path: fieldPath(path, "via_bot_user_id"), Old: message.ViaBotUserId, New: other.ViaBotUserId})
+	}
+	if message.AuthorSignature != other.AuthorSignature {
+		changes = append(changes, Change{Path: fieldPath(path, "author_signature"), Old: message.AuthorSignature, New: other.AuthorSignature})
+	}
+	if message.MediaAlbumId != other.MediaAlbumId {
+		changes = append(changes, Change{Path: fieldPath(path, "media_album_id"), Old: message.MediaAlbumId, New: other.MediaAlbumId})
+	}
+	if message.RestrictionReason != other.RestrictionReason {
+		changes = append(changes, Change{Path: fieldPath(path, "restriction_reason"), Old: message.RestrictionReason, New: other.RestrictionReason})
+	}
+	changes = diffMessageContent(fieldPath(path, "content"), message.Content, other.Content, changes)
+	changes = diffReplyMarkup(fieldPath(path, "reply_markup"), message.ReplyMarkup, other.ReplyMarkup, changes)
+	return changes
+}
 
-	fieldReplyMarkup, _ := unmarshalReplyMarkup(objMap["reply_markup"])
-	message.ReplyMarkup = fieldReplyMarkup
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (message *Message) String() string {
+	return objectString(message)
+}
+
+// LogValue logs the object as its summary
+func (message *Message) LogValue() slog.Value {
+	return slog.StringValue(objectString(message))
+}
 
-	return nil
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (message *Message) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, message)
+}
+
+func (message *Message) printText(p *printer) {
+	if message == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Message")
+	p.int("id", int64(message.Id))
+	p.object("sender_id", message.SenderId)
+	p.int("chat_id", int64(message.ChatId))
+	p.object("sending_state", message.SendingState)
+	p.object("scheduling_state", message.SchedulingState)
+	p.bool("is_outgoing", message.IsOutgoing)
+	p.bool("is_pinned", message.IsPinned)
+	p.bool("can_be_edited", message.CanBeEdited)
+	p.bool("can_be_forwarded", message.CanBeForwarded)
+	p.bool("can_be_saved", message.CanBeSaved)
+	p.bool("can_be_deleted_only_for_self", message.CanBeDeletedOnlyForSelf)
+	p.bool("can_be_deleted_for_all_users", message.CanBeDeletedForAllUsers)
+	p.bool("can_get_statistics", message.CanGetStatistics)
+	p.bool("can_get_message_thread", message.CanGetMessageThread)
+	p.bool("can_get_viewers", message.CanGetViewers)
+	p.bool("can_get_media_timestamp_links", message.CanGetMediaTimestampLinks)
+	p.bool("has_timestamped_media", message.HasTimestampedMedia)
+	p.bool("is_channel_post", message.IsChannelPost)
+	p.bool("contains_unread_mention", message.ContainsUnreadMention)
+	p.int("date", int64(message.Date))
+	p.int("edit_date", int64(message.EditDate))
+	p.object("forward_info", message.ForwardInfo)
+	p.object("interaction_info", message.InteractionInfo)
+	p.int("reply_in_chat_id", int64(message.ReplyInChatId))
+	p.int("reply_to_message_id", int64(message.ReplyToMessageId))
+	p.int("message_thread_id", int64(message.MessageThreadId))
+	p.int("ttl", int64(message.Ttl))
+	p.float("ttl_expires_in", message.TtlExpiresIn)
+	p.int("via_bot_user_id", int64(message.ViaBotUserId))
+	p.string("author_signature", message.AuthorSignature)
+	p.int("media_album_id", int64(message.MediaAlbumId))
+	p.string("restriction_reason", message.RestrictionReason)
+	p.object("content", message.Content)
+	p.object("reply_markup", message.ReplyMarkup)
+	p.endObject(state)
 }
 
 // GetMessage Returns information about a message
@@ -280,12 +634,12 @@ func (client *Client) GetMessage(chatId int64, messageId int64) (*Message, error
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messageDummy Message
-	err = json.Unmarshal(result.Raw, &messageDummy)
+	err = jsonUnmarshal(result.Raw, &messageDummy)
 	return &messageDummy, err
 }
 
@@ -303,12 +657,12 @@ func (client *Client) GetMessageLocally(chatId int64, messageId int64) (*Message
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messageDummy Message
-	err = json.Unmarshal(result.Raw, &messageDummy)
+	err = jsonUnmarshal(result.Raw, &messageDummy)
 	return &messageDummy, err
 }
 
@@ -326,12 +680,12 @@ func (client *Client) GetRepliedMessage(chatId int64, messageId int64) (*Message
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messageDummy Message
-	err = json.Unmarshal(result.Raw, &messageDummy)
+	err = jsonUnmarshal(result.Raw, &messageDummy)
 	return &messageDummy, err
 }
 
@@ -347,12 +701,12 @@ func (client *Client) GetChatPinnedMessage(chatId int64) (*Message, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var message Message
-	err = json.Unmarshal(result.Raw, &message)
+	err = jsonUnmarshal(result.Raw, &message)
 	return &message, err
 }
 
@@ -372,12 +726,12 @@ func (client *Client) GetCallbackQueryMessage(chatId int64, messageId int64, cal
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messageDummy Message
-	err = json.Unmarshal(result.Raw, &messageDummy)
+	err = jsonUnmarshal(result.Raw, &messageDummy)
 	return &messageDummy, err
 }
 
@@ -395,12 +749,12 @@ func (client *Client) GetChatMessageByDate(chatId int64, date int32) (*Message,
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var message Message
-	err = json.Unmarshal(result.Raw, &message)
+	err = jsonUnmarshal(result.Raw, &message)
 	return &message, err
 }
 
@@ -426,12 +780,12 @@ func (client *Client) SendMessage(chatId int64, messageThreadId int64, replyToMe
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messageDummy Message
-	err = json.Unmarshal(result.Raw, &messageDummy)
+	err = jsonUnmarshal(result.Raw, &messageDummy)
 	return &messageDummy, err
 }
 
@@ -451,12 +805,12 @@ func (client *Client) SendBotStartMessage(botUserId int64, chatId int64, paramet
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var message Message
-	err = json.Unmarshal(result.Raw, &message)
+	err = jsonUnmarshal(result.Raw, &message)
 	return &message, err
 }
 
@@ -484,12 +838,12 @@ func (client *Client) SendInlineQueryResultMessage(chatId int64, messageThreadId
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messageDummy Message
-	err = json.Unmarshal(result.Raw, &messageDummy)
+	err = jsonUnmarshal(result.Raw, &messageDummy)
 	return &messageDummy, err
 }
 
@@ -513,12 +867,12 @@ func (client *Client) AddLocalMessage(chatId int64, senderId MessageSender, repl
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messageDummy Message
-	err = json.Unmarshal(result.Raw, &messageDummy)
+	err = jsonUnmarshal(result.Raw, &messageDummy)
 	return &messageDummy, err
 }
 
@@ -540,12 +894,12 @@ func (client *Client) EditMessageText(chatId int64, messageId int64, replyMarkup
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messageDummy Message
-	err = json.Unmarshal(result.Raw, &messageDummy)
+	err = jsonUnmarshal(result.Raw, &messageDummy)
 	return &messageDummy, err
 }
 
@@ -571,12 +925,12 @@ func (client *Client) EditMessageLiveLocation(chatId int64, messageId int64, rep
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messageDummy Message
-	err = json.Unmarshal(result.Raw, &messageDummy)
+	err = jsonUnmarshal(result.Raw, &messageDummy)
 	return &messageDummy, err
 }
 
@@ -598,12 +952,12 @@ func (client *Client) EditMessageMedia(chatId int64, messageId int64, replyMarku
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messageDummy Message
-	err = json.Unmarshal(result.Raw, &messageDummy)
+	err = jsonUnmarshal(result.Raw, &messageDummy)
 	return &messageDummy, err
 }
 
@@ -625,12 +979,12 @@ func (client *Client) EditMessageCaption(chatId int64, messageId int64, replyMar
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messageDummy Message
-	err = json.Unmarshal(result.Raw, &messageDummy)
+	err = jsonUnmarshal(result.Raw, &messageDummy)
 	return &messageDummy, err
 }
 
@@ -650,12 +1004,12 @@ func (client *Client) EditMessageReplyMarkup(chatId int64, messageId int64, repl
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messageDummy Message
-	err = json.Unmarshal(result.Raw, &messageDummy)
+	err = jsonUnmarshal(result.Raw, &messageDummy)
 	return &messageDummy, err
 }
 
@@ -681,11 +1035,11 @@ func (client *Client) SetGameScore(chatId int64, messageId int64, editMessage bo
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messageDummy Message
-	err = json.Unmarshal(result.Raw, &messageDummy)
+	err = jsonUnmarshal(result.Raw, &messageDummy)
 	return &messageDummy, err
 }
@@ -0,0 +1,574 @@
+package tdlib
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// MessageBuilder builds a message, or an album of messages, and sends it to a chat.
+// Content methods such as Text or Photo add a content, and a second content turns the message into an album;
+// methods such as Caption or Size change the content added last. Mistakes, e.g. a caption on a sticker,
+// are returned by Send or SendAlbum.
+//
+//	message, err := client.Message(chatID).Photo("./bunny.jpg").CaptionMarkdown("*Bunny*").Reply(messageID).Silent().Keyboard(keyboard).Send(ctx)
+type MessageBuilder struct {
+	client          *Client
+	chatID          int64
+	messageThreadID int64
+	replyToID       int64
+	options         *MessageSendOptions
+	replyMarkup     ReplyMarkup
+	contents        []InputMessageContent
+	err             error
+	// sendResultTimeout is how long Send waits for the final message, see sendAndCatch
+	sendResultTimeout time.Duration
+}
+
+// Message starts building a message to the chat
+func (client *Client) Message(chatID int64) *MessageBuilder {
+	return &MessageBuilder{
+		client:            client,
+		chatID:            chatID,
+		options:           NewMessageSendOptions(false, false, false, nil),
+		sendResultTimeout: time.Second,
+	}
+}
+
+// fail remembers the first mistake, which is returned when sending
+func (builder *MessageBuilder) fail(format string, args ...interface{}) *MessageBuilder {
+	if builder.err == nil {
+		builder.err = fmt.Errorf(format, args...)
+	}
+	return builder
+}
+
+// add adds a content to the message
+func (builder *MessageBuilder) add(content InputMessageContent) *MessageBuilder {
+	builder.contents = append(builder.contents, content)
+	return builder
+}
+
+// last returns the content added last
+func (builder *MessageBuilder) last() InputMessageContent {
+	if len(builder.contents) == 0 {
+		return nil
+	}
+	return builder.contents[len(builder.contents)-1]
+}
+
+// parse parses text with entities in a parse mode, remembering parsing errors
+func (builder *MessageBuilder) parse(text string, parseMode TextParseMode) *FormattedText {
+	formattedText, err := ExecuteParseTextEntities(text, parseMode)
+	if err != nil {
+		builder.fail("can't parse %q: %v", text, err)
+		return NewFormattedText(text, nil)
+	}
+	return formattedText
+}
+
+// Content adds a content built by hand, e.g. an InputMessagePhoto of a file already uploaded
+func (builder *MessageBuilder) Content(content InputMessageContent) *MessageBuilder {
+	return builder.add(content)
+}
+
+// Text adds a plain text
+func (builder *MessageBuilder) Text(text string) *MessageBuilder {
+	return builder.FormattedText(NewFormattedText(text, nil))
+}
+
+// Markdown adds a text in Telegram Bot API MarkdownV2
+func (builder *MessageBuilder) Markdown(text string) *MessageBuilder {
+	return builder.FormattedText(builder.parse(text, NewTextParseModeMarkdown(2)))
+}
+
+// HTML adds a text in Telegram Bot API HTML
+func (builder *MessageBuilder) HTML(text string) *MessageBuilder {
+	return builder.FormattedText(builder.parse(text, NewTextParseModeHTML()))
+}
+
+// FormattedText adds a text with entities
+func (builder *MessageBuilder) FormattedText(text *FormattedText) *MessageBuilder {
+	return builder.add(NewInputMessageText(text, false, false))
+}
+
+// Animation adds an animation from a local file
+func (builder *MessageBuilder) Animation(path string) *MessageBuilder {
+	return builder.add(NewInputMessageAnimation(NewInputFileLocal(path), nil, nil, 0, 0, 0, nil))
+}
+
+// Audio adds an audio from a local file
+func (builder *MessageBuilder) Audio(path string) *MessageBuilder {
+	return builder.add(NewInputMessageAudio(NewInputFileLocal(path), nil, 0, "", "", nil))
+}
+
+// Document adds a document from a local file
+func (builder *MessageBuilder) Document(path string) *MessageBuilder {
+	return builder.add(NewInputMessageDocument(NewInputFileLocal(path), nil, false, nil))
+}
+
+// Photo adds a photo from a local file
+func (builder *MessageBuilder) Photo(path string) *MessageBuilder {
+	return builder.add(NewInputMessagePhoto(NewInputFileLocal(path), nil, nil, 0, 0, nil, 0))
+}
+
+// Sticker adds a sticker from a local file
+func (builder *MessageBuilder) Sticker(path string) *MessageBuilder {
+	return builder.add(NewInputMessageSticker(NewInputFileLocal(path), nil, 0, 0, ""))
+}
+
+// Video adds a video from a local file
+func (builder *MessageBuilder) Video(path string) *MessageBuilder {
+	return builder.add(NewInputMessageVideo(NewInputFileLocal(path), nil, nil, 0, 0, 0, false, nil, 0))
+}
+
+// VideoNote adds a video note from a local file
+func (builder *MessageBuilder) VideoNote(path string) *MessageBuilder {
+	return builder.add(NewInputMessageVideoNote(NewInputFileLocal(path), nil, 0, 0))
+}
+
+// VoiceNote adds a voice note from a local file
+func (builder *MessageBuilder) VoiceNote(path string) *MessageBuilder {
+	return builder.add(NewInputMessageVoiceNote(NewInputFileLocal(path), 0, nil, nil))
+}
+
+// Location adds a location
+func (builder *MessageBuilder) Location(latitude float64, longitude float64) *MessageBuilder {
+	return builder.add(NewInputMessageLocation(NewLocation(latitude, longitude, 0), 0, 0, 0))
+}
+
+// Venue adds a venue
+func (builder *MessageBuilder) Venue(venue *Venue) *MessageBuilder {
+	return builder.add(NewInputMessageVenue(venue))
+}
+
+// Contact adds a contact
+func (builder *MessageBuilder) Contact(contact *Contact) *MessageBuilder {
+	return builder.add(NewInputMessageContact(contact))
+}
+
+// Dice adds a dice with a random value, e.g. for the emoji 🎲
+func (builder *MessageBuilder) Dice(emoji string) *MessageBuilder {
+	return builder.add(NewInputMessageDice(emoji, false))
+}
+
+// Game adds a game; for bots only
+func (builder *MessageBuilder) Game(botUserID int64, gameShortName string) *MessageBuilder {
+	return builder.add(NewInputMessageGame(botUserID, gameShortName))
+}
+
+// Invoice adds an invoice; for bots only
+func (builder *MessageBuilder) Invoice(invoice *Invoice, title string, description string, payload []byte, providerToken string) *MessageBuilder {
+	return builder.add(NewInputMessageInvoice(invoice, title, description, "", 0, 0, 0, payload, providerToken, "", ""))
+}
+
+// Poll adds a regular anonymous poll, see Quiz, MultipleAnswers, Public, OpenPeriod and CloseDate
+func (builder *MessageBuilder) Poll(question string, options ...string) *MessageBuilder {
+	return builder.add(NewInputMessagePoll(question, options, true, NewPollTypeRegular(false), 0, 0, false))
+}
+
+// Forward adds a forwarded message, see Copy
+func (builder *MessageBuilder) Forward(fromChatID int64, messageID int64) *MessageBuilder {
+	return builder.add(NewInputMessageForwarded(fromChatID, messageID, false, nil))
+}
+
+// Caption sets a plain text caption of the last animation, audio, document, photo, video or voice note
+func (builder *MessageBuilder) Caption(text string) *MessageBuilder {
+	return builder.CaptionFormatted(NewFormattedText(text, nil))
+}
+
+// CaptionMarkdown sets a caption in Telegram Bot API MarkdownV2, see Caption
+func (builder *MessageBuilder) CaptionMarkdown(text string) *MessageBuilder {
+	return builder.CaptionFormatted(builder.parse(text, NewTextParseModeMarkdown(2)))
+}
+
+// CaptionHTML sets a caption in Telegram Bot API HTML, see Caption
+func (builder *MessageBuilder) CaptionHTML(text string) *MessageBuilder {
+	return builder.CaptionFormatted(builder.parse(text, NewTextParseModeHTML()))
+}
+
+// CaptionFormatted sets a caption with entities, see Caption
+func (builder *MessageBuilder) CaptionFormatted(caption *FormattedText) *MessageBuilder {
+	switch content := builder.last().(type) {
+	case *InputMessageAnimation:
+		content.Caption = caption
+	case *InputMessageAudio:
+		content.Caption = caption
+	case *InputMessageDocument:
+		content.Caption = caption
+	case *InputMessagePhoto:
+		content.Caption = caption
+	case *InputMessageVideo:
+		content.Caption = caption
+	case *InputMessageVoiceNote:
+		content.Caption = caption
+	case *InputMessageForwarded:
+		content.CopyOptions = NewMessageCopyOptions(true, true, caption)
+	default:
+		return builder.fail("caption can't be set on %s", contentName(content))
+	}
+	return builder
+}
+
+// Thumbnail sets the thumbnail of the last animation, audio, document, photo, sticker, video or video note
+// from a local file
+func (builder *MessageBuilder) Thumbnail(path string, width int32, height int32) *MessageBuilder {
+	thumbnail := NewInputThumbnail(NewInputFileLocal(path), width, height)
+	switch content := builder.last().(type) {
+	case *InputMessageAnimation:
+		content.Thumbnail = thumbnail
+	case *InputMessageAudio:
+		content.AlbumCoverThumbnail = thumbnail
+	case *InputMessageDocument:
+		content.Thumbnail = thumbnail
+	case *InputMessagePhoto:
+		content.Thumbnail = thumbnail
+	case *InputMessageSticker:
+		content.Thumbnail = thumbnail
+	case *InputMessageVideo:
+		content.Thumbnail = thumbnail
+	case *InputMessageVideoNote:
+		content.Thumbnail = thumbnail
+	default:
+		return builder.fail("thumbnail can't be set on %s", contentName(content))
+	}
+	return builder
+}
+
+// Size sets the width and height of the last animation, photo, sticker or video
+func (builder *MessageBuilder) Size(width int32, height int32) *MessageBuilder {
+	switch content := builder.last().(type) {
+	case *InputMessageAnimation:
+		content.Width, content.Height = width, height
+	case *InputMessagePhoto:
+		content.Width, content.Height = width, height
+	case *InputMessageSticker:
+		content.Width, content.Height = width, height
+	case *InputMessageVideo:
+		content.Width, content.Height = width, height
+	default:
+		return builder.fail("size can't be set on %s", contentName(content))
+	}
+	return builder
+}
+
+// Duration sets the duration of the last animation, audio, video, video note or voice note, in seconds
+func (builder *MessageBuilder) Duration(duration int32) *MessageBuilder {
+	switch content := builder.last().(type) {
+	case *InputMessageAnimation:
+		content.Duration = duration
+	case *InputMessageAudio:
+		content.Duration = duration
+	case *InputMessageVideo:
+		content.Duration = duration
+	case *InputMessageVideoNote:
+		content.Duration = duration
+	case *InputMessageVoiceNote:
+		content.Duration = duration
+	default:
+		return builder.fail("duration can't be set on %s", contentName(content))
+	}
+	return builder
+}
+
+// TTL makes the last photo or video self-destruct the given number of seconds after it's opened; in private chats only
+func (builder *MessageBuilder) TTL(seconds int32) *MessageBuilder {
+	switch content := builder.last().(type) {
+	case *InputMessagePhoto:
+		content.Ttl = seconds
+	case *InputMessageVideo:
+		content.Ttl = seconds
+	default:
+		return builder.fail("TTL can't be set on %s", contentName(content))
+	}
+	return builder
+}
+
+// Streaming marks the last video as suitable for streaming
+func (builder *MessageBuilder) Streaming() *MessageBuilder {
+	content, ok := builder.last().(*InputMessageVideo)
+	if !ok {
+		return builder.fail("streaming can't be set on %s", contentName(builder.last()))
+	}
+	content.SupportsStreaming = true
+	return builder
+}
+
+// AudioInfo sets the title and performer of the last audio
+func (builder *MessageBuilder) AudioInfo(title string, performer string) *MessageBuilder {
+	content, ok := builder.last().(*InputMessageAudio)
+	if !ok {
+		return builder.fail("audio info can't be set on %s", contentName(builder.last()))
+	}
+	content.Title, content.Performer = title, performer
+	return builder
+}
+
+// Emoji sets the emoji of the last sticker
+func (builder *MessageBuilder) Emoji(emoji string) *MessageBuilder {
+	content, ok := builder.last().(*InputMessageSticker)
+	if !ok {
+		return builder.fail("emoji can't be set on %s", contentName(builder.last()))
+	}
+	content.Emoji = emoji
+	return builder
+}
+
+// Length sets the diameter of the last video note
+func (builder *MessageBuilder) Length(length int32) *MessageBuilder {
+	content, ok := builder.last().(*InputMessageVideoNote)
+	if !ok {
+		return builder.fail("length can't be set on %s", contentName(builder.last()))
+	}
+	content.Length = length
+	return builder
+}
+
+// Waveform sets the waveform of the last voice note
+func (builder *MessageBuilder) Waveform(waveform []byte) *MessageBuilder {
+	content, ok := builder.last().(*InputMessageVoiceNote)
+	if !ok {
+		return builder.fail("waveform can't be set on %s", contentName(builder.last()))
+	}
+	content.Waveform = waveform
+	return builder
+}
+
+// DisableContentTypeDetection sends the last document as a file, even if it's e.g. a video
+func (builder *MessageBuilder) DisableContentTypeDetection() *MessageBuilder {
+	content, ok := builder.last().(*InputMessageDocument)
+	if !ok {
+		return builder.fail("content type detection can't be disabled on %s", contentName(builder.last()))
+	}
+	content.DisableContentTypeDetection = true
+	return builder
+}
+
+// DisableWebPagePreview disables the web page preview of the last text
+func (builder *MessageBuilder) DisableWebPagePreview() *MessageBuilder {
+	content, ok := builder.last().(*InputMessageText)
+	if !ok {
+		return builder.fail("web page preview can't be disabled on %s", contentName(builder.last()))
+	}
+	content.DisableWebPagePreview = true
+	return builder
+}
+
+// ClearDraft deletes the draft of the chat when the last text or dice is sent
+func (builder *MessageBuilder) ClearDraft() *MessageBuilder {
+	switch content := builder.last().(type) {
+	case *InputMessageText:
+		content.ClearDraft = true
+	case *InputMessageDice:
+		content.ClearDraft = true
+	default:
+		return builder.fail("draft can't be cleared by %s", contentName(content))
+	}
+	return builder
+}
+
+// LivePeriod makes the last location a live location, updated for the given number of seconds
+func (builder *MessageBuilder) LivePeriod(seconds int32) *MessageBuilder {
+	content, ok := builder.last().(*InputMessageLocation)
+	if !ok {
+		return builder.fail("live period can't be set on %s", contentName(builder.last()))
+	}
+	content.LivePeriod = seconds
+	return builder
+}
+
+// lastPoll returns the poll added last, remembering a mistake if it isn't a poll
+func (builder *MessageBuilder) lastPoll(option string) *InputMessagePoll {
+	content, ok := builder.last().(*InputMessagePoll)
+	if !ok {
+		builder.fail("%s can't be set on %s", option, contentName(builder.last()))
+		return &InputMessagePoll{}
+	}
+	return content
+}
+
+// Quiz makes the last poll a quiz with a correct option, shown with the explanation when a wrong one is chosen
+func (builder *MessageBuilder) Quiz(correctOptionID int32, explanation string) *MessageBuilder {
+	var formattedExplanation *FormattedText
+	if explanation != "" {
+		formattedExplanation = NewFormattedText(explanation, nil)
+	}
+	builder.lastPoll("quiz").Type = NewPollTypeQuiz(correctOptionID, formattedExplanation)
+	return builder
+}
+
+// MultipleAnswers allows choosing multiple options of the last poll
+func (builder *MessageBuilder) MultipleAnswers() *MessageBuilder {
+	builder.lastPoll("multiple answers").Type = NewPollTypeRegular(true)
+	return builder
+}
+
+// Public shows the voters of the last poll
+func (builder *MessageBuilder) Public() *MessageBuilder {
+	builder.lastPoll("public").IsAnonymous = false
+	return builder
+}
+
+// OpenPeriod closes the last poll the given number of seconds after it's sent; for bots only
+func (builder *MessageBuilder) OpenPeriod(seconds int32) *MessageBuilder {
+	builder.lastPoll("open period").OpenPeriod = seconds
+	return builder
+}
+
+// CloseDate closes the last poll at the given time; for bots only
+func (builder *MessageBuilder) CloseDate(date time.Time) *MessageBuilder {
+	builder.lastPoll("close date").CloseDate = int32(date.Unix())
+	return builder
+}
+
+// Copy sends a copy of the last forwarded message, without a link to the original one
+func (builder *MessageBuilder) Copy() *MessageBuilder {
+	content, ok := builder.last().(*InputMessageForwarded)
+	if !ok {
+		return builder.fail("copy can't be set on %s", contentName(builder.last()))
+	}
+	if content.CopyOptions == nil {
+		content.CopyOptions = NewMessageCopyOptions(true, false, nil)
+	}
+	return builder
+}
+
+// Thread sends the message to a message thread, e.g. a forum topic or the comments of a channel post
+func (builder *MessageBuilder) Thread(messageThreadID int64) *MessageBuilder {
+	builder.messageThreadID = messageThreadID
+	return builder
+}
+
+// Reply sends the message as a reply to a message
+func (builder *MessageBuilder) Reply(messageID int64) *MessageBuilder {
+	builder.replyToID = messageID
+	return builder
+}
+
+// Silent sends the message without a notification
+func (builder *MessageBuilder) Silent() *MessageBuilder {
+	builder.options.DisableNotification = true
+	return builder
+}
+
+// Background marks the message as sent from the background
+func (builder *MessageBuilder) Background() *MessageBuilder {
+	builder.options.FromBackground = true
+	return builder
+}
+
+// Protect prevents forwarding and saving the content of the message
+func (builder *MessageBuilder) Protect() *MessageBuilder {
+	builder.options.ProtectContent = true
+	return builder
+}
+
+// ScheduleAt schedules the message to be sent at the given time, within 367 days
+func (builder *MessageBuilder) ScheduleAt(date time.Time) *MessageBuilder {
+	builder.options.SchedulingState = NewMessageSchedulingStateSendAtDate(int32(date.Unix()))
+	return builder
+}
+
+// ScheduleWhenOnline schedules the message to be sent when the other user is online; in private chats only
+func (builder *MessageBuilder) ScheduleWhenOnline() *MessageBuilder {
+	builder.options.SchedulingState = NewMessageSchedulingStateSendWhenOnline()
+	return builder
+}
+
+// Keyboard sets the reply markup of the message, e.g. an inline keyboard; albums can't have one
+func (builder *MessageBuilder) Keyboard(replyMarkup ReplyMarkup) *MessageBuilder {
+	builder.replyMarkup = replyMarkup
+	return builder
+}
+
+// InlineKeyboard sets an inline keyboard as the reply markup of the message, see Keyboard
+func (builder *MessageBuilder) InlineKeyboard(keyboard *InlineKeyboardBuilder) *MessageBuilder {
+	replyMarkup, err := keyboard.Build()
+	if err != nil {
+		return builder.fail("%v", err)
+	}
+	return builder.Keyboard(replyMarkup)
+}
+
+// Build returns the contents of the message, or the first mistake made building it
+func (builder *MessageBuilder) Build() ([]InputMessageContent, error) {
+	if builder.err != nil {
+		return nil, builder.err
+	}
+	if len(builder.contents) == 0 {
+		return nil, errors.New("message has no content")
+	}
+	return builder.contents, nil
+}
+
+// Send sends the message, which must have a single content
+func (builder *MessageBuilder) Send(ctx context.Context) (*Message, error) {
+	contents, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	if len(contents) > 1 {
+		return nil, fmt.Errorf("message has %d contents, use SendAlbum to send them as an album", len(contents))
+	}
+
+	result, err := builder.client.sendAndCatch(ctx, UpdateData{
+		"@type":                 "sendMessage",
+		"chat_id":               builder.chatID,
+		"message_thread_id":     builder.messageThreadID,
+		"reply_to_message_id":   builder.replyToID,
+		"options":               builder.options,
+		"reply_markup":          builder.replyMarkup,
+		"input_message_content": contents[0],
+	}, builder.sendResultTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Data["@type"] == "error" {
+		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
+	}
+
+	var message Message
+	err = jsonUnmarshal(result.Raw, &message)
+	return &message, err
+}
+
+// SendAlbum sends the contents as an album of 2-10 photos and videos, documents or audios
+func (builder *MessageBuilder) SendAlbum(ctx context.Context) (*Messages, error) {
+	contents, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	if builder.replyMarkup != nil {
+		return nil, errors.New("album can't have a keyboard")
+	}
+
+	result, err := builder.client.SendAndCatchContext(ctx, UpdateData{
+		"@type":                  "sendMessageAlbum",
+		"chat_id":                builder.chatID,
+		"message_thread_id":      builder.messageThreadID,
+		"reply_to_message_id":    builder.replyToID,
+		"options":                builder.options,
+		"input_message_contents": contents,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Data["@type"] == "error" {
+		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
+	}
+
+	var messages Messages
+	err = jsonUnmarshal(result.Raw, &messages)
+	return &messages, err
+}
+
+// contentName names a content for errors, e.g. inputMessageSticker
+func contentName(content InputMessageContent) string {
+	if content == nil {
+		return "a message without content"
+	}
+	return string(content.GetInputMessageContentEnum())
+}
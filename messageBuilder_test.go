@@ -0,0 +1,224 @@
+package tdlib
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMessageBuilderContents(t *testing.T) {
+	caption := NewFormattedText("caption", nil)
+	thumbnail := NewInputThumbnail(NewInputFileLocal("thumb.jpg"), 90, 60)
+	venue := NewVenue(NewLocation(1, 2, 0), "Cafe", "Street 1", "foursquare", "id", "food")
+	contact := NewContact("+100", "First", "Last", "", 42)
+	invoice := NewInvoice("USD", []LabeledPricePart{*NewLabeledPricePart("Coffee", 350)}, 0, nil, false, false, false, false, false, false, false, false)
+	closeDate := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name  string
+		build func(builder *MessageBuilder) *MessageBuilder
+		want  InputMessageContent
+	}{
+		{
+			"text",
+			func(builder *MessageBuilder) *MessageBuilder {
+				return builder.Text("hi").DisableWebPagePreview().ClearDraft()
+			},
+			NewInputMessageText(NewFormattedText("hi", nil), true, true),
+		},
+		{
+			"animation",
+			func(builder *MessageBuilder) *MessageBuilder {
+				return builder.Animation("a.gif").Caption("caption").Thumbnail("thumb.jpg", 90, 60).Size(320, 240).Duration(3)
+			},
+			NewInputMessageAnimation(NewInputFileLocal("a.gif"), thumbnail, nil, 3, 320, 240, caption),
+		},
+		{
+			"audio",
+			func(builder *MessageBuilder) *MessageBuilder {
+				return builder.Audio("a.mp3").Caption("caption").Thumbnail("thumb.jpg", 90, 60).Duration(200).AudioInfo("Song", "Band")
+			},
+			NewInputMessageAudio(NewInputFileLocal("a.mp3"), thumbnail, 200, "Song", "Band", caption),
+		},
+		{
+			"document",
+			func(builder *MessageBuilder) *MessageBuilder {
+				return builder.Document("a.pdf").Caption("caption").Thumbnail("thumb.jpg", 90, 60).DisableContentTypeDetection()
+			},
+			NewInputMessageDocument(NewInputFileLocal("a.pdf"), thumbnail, true, caption),
+		},
+		{
+			"photo",
+			func(builder *MessageBuilder) *MessageBuilder {
+				return builder.Photo("a.jpg").Caption("caption").Thumbnail("thumb.jpg", 90, 60).Size(800, 600).TTL(10)
+			},
+			NewInputMessagePhoto(NewInputFileLocal("a.jpg"), thumbnail, nil, 800, 600, caption, 10),
+		},
+		{
+			"sticker",
+			func(builder *MessageBuilder) *MessageBuilder {
+				return builder.Sticker("a.webp").Thumbnail("thumb.jpg", 90, 60).Size(512, 512).Emoji("😀")
+			},
+			NewInputMessageSticker(NewInputFileLocal("a.webp"), thumbnail, 512, 512, "😀"),
+		},
+		{
+			"video",
+			func(builder *MessageBuilder) *MessageBuilder {
+				return builder.Video("a.mp4").Caption("caption").Thumbnail("thumb.jpg", 90, 60).Size(1280, 720).Duration(60).TTL(20).Streaming()
+			},
+			NewInputMessageVideo(NewInputFileLocal("a.mp4"), thumbnail, nil, 60, 1280, 720, true, caption, 20),
+		},
+		{
+			"video note",
+			func(builder *MessageBuilder) *MessageBuilder {
+				return builder.VideoNote("a.mp4").Thumbnail("thumb.jpg", 90, 60).Duration(10).Length(240)
+			},
+			NewInputMessageVideoNote(NewInputFileLocal("a.mp4"), thumbnail, 10, 240),
+		},
+		{
+			"voice note",
+			func(builder *MessageBuilder) *MessageBuilder {
+				return builder.VoiceNote("a.ogg").Caption("caption").Duration(5).Waveform([]byte{1, 2})
+			},
+			NewInputMessageVoiceNote(NewInputFileLocal("a.ogg"), 5, []byte{1, 2}, caption),
+		},
+		{
+			"location",
+			func(builder *MessageBuilder) *MessageBuilder {
+				return builder.Location(1.5, 2.5).LivePeriod(600)
+			},
+			NewInputMessageLocation(NewLocation(1.5, 2.5, 0), 600, 0, 0),
+		},
+		{"venue", func(builder *MessageBuilder) *MessageBuilder { return builder.Venue(venue) }, NewInputMessageVenue(venue)},
+		{"contact", func(builder *MessageBuilder) *MessageBuilder { return builder.Contact(contact) }, NewInputMessageContact(contact)},
+		{"dice", func(builder *MessageBuilder) *MessageBuilder { return builder.Dice("🎲").ClearDraft() }, NewInputMessageDice("🎲", true)},
+		{"game", func(builder *MessageBuilder) *MessageBuilder { return builder.Game(42, "game") }, NewInputMessageGame(42, "game")},
+		{
+			"invoice",
+			func(builder *MessageBuilder) *MessageBuilder {
+				return builder.Invoice(invoice, "Coffee", "A cup", []byte("order"), "token")
+			},
+			NewInputMessageInvoice(invoice, "Coffee", "A cup", "", 0, 0, 0, []byte("order"), "token", "", ""),
+		},
+		{
+			"poll",
+			func(builder *MessageBuilder) *MessageBuilder {
+				return builder.Poll("Question?", "A", "B").Quiz(1, "Because").Public().OpenPeriod(60).CloseDate(closeDate)
+			},
+			NewInputMessagePoll("Question?", []string{"A", "B"}, false, NewPollTypeQuiz(1, NewFormattedText("Because", nil)), 60, int32(closeDate.Unix()), false),
+		},
+		{
+			"forward",
+			func(builder *MessageBuilder) *MessageBuilder {
+				return builder.Forward(-100, 5).Copy()
+			},
+			NewInputMessageForwarded(-100, 5, false, NewMessageCopyOptions(true, false, nil)),
+		},
+		{
+			"forward with a new caption",
+			func(builder *MessageBuilder) *MessageBuilder {
+				return builder.Forward(-100, 5).Caption("caption")
+			},
+			NewInputMessageForwarded(-100, 5, false, NewMessageCopyOptions(true, true, caption)),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			contents, err := test.build(NewClientWithTransport(Config{}, newFakeTransport(nil)).Message(5)).Build()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(contents) != 1 {
+				t.Fatalf("built %d contents, want 1", len(contents))
+			}
+			if !reflect.DeepEqual(contents[0], test.want) {
+				t.Errorf("built %+v, want %+v", contents[0], test.want)
+			}
+		})
+	}
+}
+
+func TestMessageBuilderMistakes(t *testing.T) {
+	tests := []struct {
+		name  string
+		build func(builder *MessageBuilder) *MessageBuilder
+		err   string
+	}{
+		{"no content", func(builder *MessageBuilder) *MessageBuilder { return builder }, "message has no content"},
+		{"caption on a sticker", func(builder *MessageBuilder) *MessageBuilder { return builder.Sticker("a.webp").Caption("a") }, "caption can't be set on inputMessageSticker"},
+		{"thumbnail on a text", func(builder *MessageBuilder) *MessageBuilder { return builder.Text("a").Thumbnail("a.jpg", 1, 1) }, "thumbnail can't be set on inputMessageText"},
+		{"size without content", func(builder *MessageBuilder) *MessageBuilder { return builder.Size(1, 1).Text("a") }, "size can't be set on a message without content"},
+		{"quiz on a dice", func(builder *MessageBuilder) *MessageBuilder { return builder.Dice("🎲").Quiz(0, "") }, "quiz can't be set on inputMessageDice"},
+		// the first mistake is returned
+		{"two mistakes", func(builder *MessageBuilder) *MessageBuilder { return builder.Text("a").TTL(1).Streaming() }, "TTL can't be set on inputMessageText"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			contents, err := test.build(NewClientWithTransport(Config{}, newFakeTransport(nil)).Message(5)).Build()
+			if err == nil || err.Error() != test.err {
+				t.Errorf("Build returns %v and error %v, want %q", contents, err, test.err)
+			}
+		})
+	}
+}
+
+func TestMessageBuilderSend(t *testing.T) {
+	transport := sendMessageTransport(0, sendSucceeded)
+	client := NewClientWithTransport(Config{}, transport)
+
+	message, err := client.Message(5).Text("hi").Reply(3).Thread(2).Silent().Send(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if message.Id != 1<<20 || message.ChatId != 5 {
+		t.Errorf("sent message %d in chat %d, want the sent message %d in chat 5", message.Id, message.ChatId, 1<<20)
+	}
+	requests := transport.sent("sendMessage")
+	if len(requests) != 1 {
+		t.Fatalf("%d requests were sent, want 1", len(requests))
+	}
+	options, _ := requests[0]["options"].(map[string]interface{})
+	if replyTo, _ := int64Value(requests[0]["reply_to_message_id"]); replyTo != 3 || options["disable_notification"] != true {
+		t.Errorf("request is %v, want a silent reply to 3", requests[0])
+	}
+}
+
+func TestMessageBuilderSendError(t *testing.T) {
+	transport := newFakeTransport(func(request map[string]interface{}) []map[string]interface{} {
+		return []map[string]interface{}{{"@type": "error", "@extra": request["@extra"], "code": 400, "message": "CHAT_WRITE_FORBIDDEN"}}
+	})
+	client := NewClientWithTransport(Config{}, transport)
+
+	tests := []struct {
+		name string
+		send func() (interface{}, error)
+		err  string
+		sent int
+	}{
+		{"error response", func() (interface{}, error) { return client.Message(5).Text("hi").Send(context.Background()) }, "error! code: 400 msg: CHAT_WRITE_FORBIDDEN", 1},
+		{"mistake", func() (interface{}, error) { return client.Message(5).Text("hi").TTL(1).Send(context.Background()) }, "TTL can't be set on inputMessageText", 0},
+		{"album", func() (interface{}, error) {
+			return client.Message(5).Photo("a.jpg").Photo("b.jpg").Send(context.Background())
+		}, "use SendAlbum", 0},
+		{"album error response", func() (interface{}, error) {
+			return client.Message(5).Photo("a.jpg").Photo("b.jpg").SendAlbum(context.Background())
+		}, "error! code: 400", 1},
+		{"album with a keyboard", func() (interface{}, error) {
+			return client.Message(5).Photo("a.jpg").Photo("b.jpg").Keyboard(NewReplyMarkupRemoveKeyboard(false)).SendAlbum(context.Background())
+		}, "album can't have a keyboard", 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			before := len(transport.sent("sendMessage")) + len(transport.sent("sendMessageAlbum"))
+			result, err := test.send()
+			if err == nil || !strings.Contains(err.Error(), test.err) || !reflect.ValueOf(result).IsNil() {
+				t.Errorf("send returns %v and error %v, want %q", result, err, test.err)
+			}
+			if sent := len(transport.sent("sendMessage")) + len(transport.sent("sendMessageAlbum")) - before; sent != test.sent {
+				t.Errorf("%d requests were sent, want %d", sent, test.sent)
+			}
+		})
+	}
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // MessageCalendar Contains information about found messages, split by days according to the option "utc_time_offset"
@@ -31,6 +31,161 @@ func NewMessageCalendar(totalCount int32, days []MessageCalendarDay) *MessageCal
 	return &messageCalendarTemp
 }
 
+// MarshalJSON marshals to json
+func (messageCalendar *MessageCalendar) MarshalJSON() ([]byte, error) {
+	return messageCalendar.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageCalendar *MessageCalendar) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageCalendar)
+}
+
+func (messageCalendar *MessageCalendar) appendJSON(b []byte) ([]byte, error) {
+	if messageCalendar == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageCalendar.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageCalendar.tdCommon.Extra)
+	b = append(b, `,"total_count":`...)
+	b = appendJSONInt(b, int64(messageCalendar.TotalCount))
+	b = append(b, `,"days":`...)
+	if messageCalendar.Days == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range messageCalendar.Days {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = messageCalendar.Days[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (messageCalendar *MessageCalendar) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageCalendar.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageCalendar.tdCommon.Extra, err = r.readString()
+		case "total_count":
+			messageCalendar.TotalCount, err = r.readInt32()
+		case "days":
+			if r.readNull() {
+				messageCalendar.Days = nil
+			} else {
+				messageCalendar.Days = make([]MessageCalendarDay, 0)
+				err = r.readArray(func() (err error) {
+					var item0 MessageCalendarDay
+					err = item0.decodeJSON(r)
+					messageCalendar.Days = append(messageCalendar.Days, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageCalendar *MessageCalendar) Clone() *MessageCalendar {
+	if messageCalendar == nil {
+		return nil
+	}
+	clone := *messageCalendar
+	if messageCalendar.Days != nil {
+		clone.Days = make([]MessageCalendarDay, len(messageCalendar.Days))
+		for i0 := range messageCalendar.Days {
+			clone.Days[i0] = *messageCalendar.Days[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageCalendar *MessageCalendar) Equal(other *MessageCalendar) bool {
+	if messageCalendar == nil || other == nil {
+		return messageCalendar == other
+	}
+	if messageCalendar.TotalCount != other.TotalCount {
+		return false
+	}
+	if len(messageCalendar.Days) != len(other.Days) {
+		return false
+	}
+	for i0 := range messageCalendar.Days {
+		if !messageCalendar.Days[i0].Equal(&other.Days[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageCalendar *MessageCalendar) Diff(other *MessageCalendar) []Change {
+	return messageCalendar.diff("", other, nil)
+}
+
+func (messageCalendar *MessageCalendar) diff(path string, other *MessageCalendar, changes []Change) []Change {
+	if messageCalendar == nil || other == nil {
+		if messageCalendar != other {
+			changes = append(changes, Change{Path: path, Old: messageCalendar, New: other})
+		}
+		return changes
+	}
+	if messageCalendar.TotalCount != other.TotalCount {
+		changes = append(changes, Change{Path: fieldPath(path, "total_count"), Old: messageCalendar.TotalCount, New: other.TotalCount})
+	}
+	if len(messageCalendar.Days) != len(other.Days) {
+		changes = append(changes, Change{Path: fieldPath(path, "days"), Old: messageCalendar.Days, New: other.Days})
+	} else {
+		for i0 := range messageCalendar.Days {
+			changes = messageCalendar.Days[i0].diff(indexPath(fieldPath(path, "days"), i0), &other.Days[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageCalendar *MessageCalendar) String() string {
+	return objectString(messageCalendar)
+}
+
+// LogValue logs the object as its summary
+func (messageCalendar *MessageCalendar) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageCalendar))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageCalendar *MessageCalendar) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageCalendar)
+}
+
+func (messageCalendar *MessageCalendar) printText(p *printer) {
+	if messageCalendar == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageCalendar")
+	p.int("total_count", int64(messageCalendar.TotalCount))
+	p.vector("days", len(messageCalendar.Days), func(i0 int) {
+		messageCalendar.Days[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetChatMessageCalendar Returns information about the next messages of the specified type in the chat split by days. Returns the results in reverse chronological order. Can return partial result for the last returned day. Behavior of this method depends on the value of the option "utc_time_offset"
 // @param chatId Identifier of the chat in which to return information about messages
 // @param filter Filter for message content. Filters searchMessagesFilterEmpty, searchMessagesFilterMention and searchMessagesFilterUnreadMention are unsupported in this function
@@ -47,11 +202,11 @@ func (client *Client) GetChatMessageCalendar(chatId int64, filter SearchMessages
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messageCalendar MessageCalendar
-	err = json.Unmarshal(result.Raw, &messageCalendar)
+	err = jsonUnmarshal(result.Raw, &messageCalendar)
 	return &messageCalendar, err
 }
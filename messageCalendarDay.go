@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // MessageCalendarDay Contains information about found messages sent on a specific day
 type MessageCalendarDay struct {
 	tdCommon
@@ -25,3 +30,124 @@ func NewMessageCalendarDay(totalCount int32, message *Message) *MessageCalendarD
 
 	return &messageCalendarDayTemp
 }
+
+// MarshalJSON marshals to json
+func (messageCalendarDay *MessageCalendarDay) MarshalJSON() ([]byte, error) {
+	return messageCalendarDay.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageCalendarDay *MessageCalendarDay) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageCalendarDay)
+}
+
+func (messageCalendarDay *MessageCalendarDay) appendJSON(b []byte) ([]byte, error) {
+	if messageCalendarDay == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageCalendarDay.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageCalendarDay.tdCommon.Extra)
+	b = append(b, `,"total_count":`...)
+	b = appendJSONInt(b, int64(messageCalendarDay.TotalCount))
+	b = append(b, `,"message":`...)
+	b, err = messageCalendarDay.Message.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (messageCalendarDay *MessageCalendarDay) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageCalendarDay.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageCalendarDay.tdCommon.Extra, err = r.readString()
+		case "total_count":
+			messageCalendarDay.TotalCount, err = r.readInt32()
+		case "message":
+			if r.readNull() {
+				messageCalendarDay.Message = nil
+			} else {
+				messageCalendarDay.Message = new(Message)
+				err = messageCalendarDay.Message.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageCalendarDay *MessageCalendarDay) Clone() *MessageCalendarDay {
+	if messageCalendarDay == nil {
+		return nil
+	}
+	clone := *messageCalendarDay
+	clone.Message = messageCalendarDay.Message.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageCalendarDay *MessageCalendarDay) Equal(other *MessageCalendarDay) bool {
+	if messageCalendarDay == nil || other == nil {
+		return messageCalendarDay == other
+	}
+	if messageCalendarDay.TotalCount != other.TotalCount {
+		return false
+	}
+	if !messageCalendarDay.Message.Equal(other.Message) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageCalendarDay *MessageCalendarDay) Diff(other *MessageCalendarDay) []Change {
+	return messageCalendarDay.diff("", other, nil)
+}
+
+func (messageCalendarDay *MessageCalendarDay) diff(path string, other *MessageCalendarDay, changes []Change) []Change {
+	if messageCalendarDay == nil || other == nil {
+		if messageCalendarDay != other {
+			changes = append(changes, Change{Path: path, Old: messageCalendarDay, New: other})
+		}
+		return changes
+	}
+	if messageCalendarDay.TotalCount != other.TotalCount {
+		changes = append(changes, Change{Path: fieldPath(path, "total_count"), Old: messageCalendarDay.TotalCount, New: other.TotalCount})
+	}
+	changes = messageCalendarDay.Message.diff(fieldPath(path, "message"), other.Message, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageCalendarDay *MessageCalendarDay) String() string {
+	return objectString(messageCalendarDay)
+}
+
+// LogValue logs the object as its summary
+func (messageCalendarDay *MessageCalendarDay) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageCalendarDay))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageCalendarDay *MessageCalendarDay) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageCalendarDay)
+}
+
+func (messageCalendarDay *MessageCalendarDay) printText(p *printer) {
+	if messageCalendarDay == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageCalendarDay")
+	p.int("total_count", int64(messageCalendarDay.TotalCount))
+	p.object("message", messageCalendarDay.Message)
+	p.endObject(state)
+}
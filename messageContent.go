@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // MessageContent Contains the content of a message
 type MessageContent interface {
 	GetMessageContentEnum() MessageContentEnum
+	Accept(visitor MessageContentVisitor)
 }
 
 // MessageContentEnum Alias for abstract MessageContent 'Sub-Classes', used as constant-enum here
@@ -66,268 +68,319 @@ const (
 	MessageUnsupportedType                 MessageContentEnum = "messageUnsupported"
 )
 
+// UnknownMessageContent is a MessageContent of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownMessageContent struct {
+	Unknown
+}
+
+// GetMessageContentEnum return the enum type of this object
+func (unknownMessageContent *UnknownMessageContent) GetMessageContentEnum() MessageContentEnum {
+	return MessageContentEnum(unknownMessageContent.Type)
+}
+
+// Accept calls the method of the visitor for UnknownMessageContent
+func (unknownMessageContent *UnknownMessageContent) Accept(visitor MessageContentVisitor) {
+	visitor.VisitUnknownMessageContent(unknownMessageContent)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownMessageContent *UnknownMessageContent) String() string {
+	return objectString(unknownMessageContent)
+}
+
+// LogValue logs the object as its summary
+func (unknownMessageContent *UnknownMessageContent) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownMessageContent))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownMessageContent *UnknownMessageContent) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownMessageContent)
+}
+
+func (unknownMessageContent *UnknownMessageContent) printText(p *printer) {
+	if unknownMessageContent == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownMessageContent")
+	p.string("@type", unknownMessageContent.Type)
+	p.endObject(state)
+}
+
 func unmarshalMessageContent(rawMsg *json.RawMessage) (MessageContent, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch MessageContentEnum(objMap["@type"].(string)) {
+	switch MessageContentEnum(typeName) {
 	case MessageTextType:
 		var messageText MessageText
-		err := json.Unmarshal(*rawMsg, &messageText)
+		err := jsonUnmarshal(*rawMsg, &messageText)
 		return &messageText, err
 
 	case MessageAnimationType:
 		var messageAnimation MessageAnimation
-		err := json.Unmarshal(*rawMsg, &messageAnimation)
+		err := jsonUnmarshal(*rawMsg, &messageAnimation)
 		return &messageAnimation, err
 
 	case MessageAudioType:
 		var messageAudio MessageAudio
-		err := json.Unmarshal(*rawMsg, &messageAudio)
+		err := jsonUnmarshal(*rawMsg, &messageAudio)
 		return &messageAudio, err
 
 	case MessageDocumentType:
 		var messageDocument MessageDocument
-		err := json.Unmarshal(*rawMsg, &messageDocument)
+		err := jsonUnmarshal(*rawMsg, &messageDocument)
 		return &messageDocument, err
 
 	case MessagePhotoType:
 		var messagePhoto MessagePhoto
-		err := json.Unmarshal(*rawMsg, &messagePhoto)
+		err := jsonUnmarshal(*rawMsg, &messagePhoto)
 		return &messagePhoto, err
 
 	case MessageExpiredPhotoType:
 		var messageExpiredPhoto MessageExpiredPhoto
-		err := json.Unmarshal(*rawMsg, &messageExpiredPhoto)
+		err := jsonUnmarshal(*rawMsg, &messageExpiredPhoto)
 		return &messageExpiredPhoto, err
 
 	case MessageStickerType:
 		var messageSticker MessageSticker
-		err := json.Unmarshal(*rawMsg, &messageSticker)
+		err := jsonUnmarshal(*rawMsg, &messageSticker)
 		return &messageSticker, err
 
 	case MessageVideoType:
 		var messageVideo MessageVideo
-		err := json.Unmarshal(*rawMsg, &messageVideo)
+		err := jsonUnmarshal(*rawMsg, &messageVideo)
 		return &messageVideo, err
 
 	case MessageExpiredVideoType:
 		var messageExpiredVideo MessageExpiredVideo
-		err := json.Unmarshal(*rawMsg, &messageExpiredVideo)
+		err := jsonUnmarshal(*rawMsg, &messageExpiredVideo)
 		return &messageExpiredVideo, err
 
 	case MessageVideoNoteType:
 		var messageVideoNote MessageVideoNote
-		err := json.Unmarshal(*rawMsg, &messageVideoNote)
+		err := jsonUnmarshal(*rawMsg, &messageVideoNote)
 		return &messageVideoNote, err
 
 	case MessageVoiceNoteType:
 		var messageVoiceNote MessageVoiceNote
-		err := json.Unmarshal(*rawMsg, &messageVoiceNote)
+		err := jsonUnmarshal(*rawMsg, &messageVoiceNote)
 		return &messageVoiceNote, err
 
 	case MessageLocationType:
 		var messageLocation MessageLocation
-		err := json.Unmarshal(*rawMsg, &messageLocation)
+		err := jsonUnmarshal(*rawMsg, &messageLocation)
 		return &messageLocation, err
 
 	case MessageVenueType:
 		var messageVenue MessageVenue
-		err := json.Unmarshal(*rawMsg, &messageVenue)
+		err := jsonUnmarshal(*rawMsg, &messageVenue)
 		return &messageVenue, err
 
 	case MessageContactType:
 		var messageContact MessageContact
-		err := json.Unmarshal(*rawMsg, &messageContact)
+		err := jsonUnmarshal(*rawMsg, &messageContact)
 		return &messageContact, err
 
 	case MessageAnimatedEmojiType:
 		var messageAnimatedEmoji MessageAnimatedEmoji
-		err := json.Unmarshal(*rawMsg, &messageAnimatedEmoji)
+		err := jsonUnmarshal(*rawMsg, &messageAnimatedEmoji)
 		return &messageAnimatedEmoji, err
 
 	case MessageDiceType:
 		var messageDice MessageDice
-		err := json.Unmarshal(*rawMsg, &messageDice)
+		err := jsonUnmarshal(*rawMsg, &messageDice)
 		return &messageDice, err
 
 	case MessageGameType:
 		var messageGame MessageGame
-		err := json.Unmarshal(*rawMsg, &messageGame)
+		err := jsonUnmarshal(*rawMsg, &messageGame)
 		return &messageGame, err
 
 	case MessagePollType:
 		var messagePoll MessagePoll
-		err := json.Unmarshal(*rawMsg, &messagePoll)
+		err := jsonUnmarshal(*rawMsg, &messagePoll)
 		return &messagePoll, err
 
 	case MessageInvoiceType:
 		var messageInvoice MessageInvoice
-		err := json.Unmarshal(*rawMsg, &messageInvoice)
+		err := jsonUnmarshal(*rawMsg, &messageInvoice)
 		return &messageInvoice, err
 
 	case MessageCallType:
 		var messageCall MessageCall
-		err := json.Unmarshal(*rawMsg, &messageCall)
+		err := jsonUnmarshal(*rawMsg, &messageCall)
 		return &messageCall, err
 
 	case MessageVideoChatScheduledType:
 		var messageVideoChatScheduled MessageVideoChatScheduled
-		err := json.Unmarshal(*rawMsg, &messageVideoChatScheduled)
+		err := jsonUnmarshal(*rawMsg, &messageVideoChatScheduled)
 		return &messageVideoChatScheduled, err
 
 	case MessageVideoChatStartedType:
 		var messageVideoChatStarted MessageVideoChatStarted
-		err := json.Unmarshal(*rawMsg, &messageVideoChatStarted)
+		err := jsonUnmarshal(*rawMsg, &messageVideoChatStarted)
 		return &messageVideoChatStarted, err
 
 	case MessageVideoChatEndedType:
 		var messageVideoChatEnded MessageVideoChatEnded
-		err := json.Unmarshal(*rawMsg, &messageVideoChatEnded)
+		err := jsonUnmarshal(*rawMsg, &messageVideoChatEnded)
 		return &messageVideoChatEnded, err
 
 	case MessageInviteVideoChatParticipantsType:
 		var messageInviteVideoChatParticipants MessageInviteVideoChatParticipants
-		err := json.Unmarshal(*rawMsg, &messageInviteVideoChatParticipants)
+		err := jsonUnmarshal(*rawMsg, &messageInviteVideoChatParticipants)
 		return &messageInviteVideoChatParticipants, err
 
 	case MessageBasicGroupChatCreateType:
 		var messageBasicGroupChatCreate MessageBasicGroupChatCreate
-		err := json.Unmarshal(*rawMsg, &messageBasicGroupChatCreate)
+		err := jsonUnmarshal(*rawMsg, &messageBasicGroupChatCreate)
 		return &messageBasicGroupChatCreate, err
 
 	case MessageSupergroupChatCreateType:
 		var messageSupergroupChatCreate MessageSupergroupChatCreate
-		err := json.Unmarshal(*rawMsg, &messageSupergroupChatCreate)
+		err := jsonUnmarshal(*rawMsg, &messageSupergroupChatCreate)
 		return &messageSupergroupChatCreate, err
 
 	case MessageChatChangeTitleType:
 		var messageChatChangeTitle MessageChatChangeTitle
-		err := json.Unmarshal(*rawMsg, &messageChatChangeTitle)
+		err := jsonUnmarshal(*rawMsg, &messageChatChangeTitle)
 		return &messageChatChangeTitle, err
 
 	case MessageChatChangePhotoType:
 		var messageChatChangePhoto MessageChatChangePhoto
-		err := json.Unmarshal(*rawMsg, &messageChatChangePhoto)
+		err := jsonUnmarshal(*rawMsg, &messageChatChangePhoto)
 		return &messageChatChangePhoto, err
 
 	case MessageChatDeletePhotoType:
 		var messageChatDeletePhoto MessageChatDeletePhoto
-		err := json.Unmarshal(*rawMsg, &messageChatDeletePhoto)
+		err := jsonUnmarshal(*rawMsg, &messageChatDeletePhoto)
 		return &messageChatDeletePhoto, err
 
 	case MessageChatAddMembersType:
 		var messageChatAddMembers MessageChatAddMembers
-		err := json.Unmarshal(*rawMsg, &messageChatAddMembers)
+		err := jsonUnmarshal(*rawMsg, &messageChatAddMembers)
 		return &messageChatAddMembers, err
 
 	case MessageChatJoinByLinkType:
 		var messageChatJoinByLink MessageChatJoinByLink
-		err := json.Unmarshal(*rawMsg, &messageChatJoinByLink)
+		err := jsonUnmarshal(*rawMsg, &messageChatJoinByLink)
 		return &messageChatJoinByLink, err
 
 	case MessageChatJoinByRequestType:
 		var messageChatJoinByRequest MessageChatJoinByRequest
-		err := json.Unmarshal(*rawMsg, &messageChatJoinByRequest)
+		err := jsonUnmarshal(*rawMsg, &messageChatJoinByRequest)
 		return &messageChatJoinByRequest, err
 
 	case MessageChatDeleteMemberType:
 		var messageChatDeleteMember MessageChatDeleteMember
-		err := json.Unmarshal(*rawMsg, &messageChatDeleteMember)
+		err := jsonUnmarshal(*rawMsg, &messageChatDeleteMember)
 		return &messageChatDeleteMember, err
 
 	case MessageChatUpgradeToType:
 		var messageChatUpgradeTo MessageChatUpgradeTo
-		err := json.Unmarshal(*rawMsg, &messageChatUpgradeTo)
+		err := jsonUnmarshal(*rawMsg, &messageChatUpgradeTo)
 		return &messageChatUpgradeTo, err
 
 	case MessageChatUpgradeFromType:
 		var messageChatUpgradeFrom MessageChatUpgradeFrom
-		err := json.Unmarshal(*rawMsg, &messageChatUpgradeFrom)
+		err := jsonUnmarshal(*rawMsg, &messageChatUpgradeFrom)
 		return &messageChatUpgradeFrom, err
 
 	case MessagePinMessageType:
 		var messagePinMessage MessagePinMessage
-		err := json.Unmarshal(*rawMsg, &messagePinMessage)
+		err := jsonUnmarshal(*rawMsg, &messagePinMessage)
 		return &messagePinMessage, err
 
 	case MessageScreenshotTakenType:
 		var messageScreenshotTaken MessageScreenshotTaken
-		err := json.Unmarshal(*rawMsg, &messageScreenshotTaken)
+		err := jsonUnmarshal(*rawMsg, &messageScreenshotTaken)
 		return &messageScreenshotTaken, err
 
 	case MessageChatSetThemeType:
 		var messageChatSetTheme MessageChatSetTheme
-		err := json.Unmarshal(*rawMsg, &messageChatSetTheme)
+		err := jsonUnmarshal(*rawMsg, &messageChatSetTheme)
 		return &messageChatSetTheme, err
 
 	case MessageChatSetTtlType:
 		var messageChatSetTtl MessageChatSetTtl
-		err := json.Unmarshal(*rawMsg, &messageChatSetTtl)
+		err := jsonUnmarshal(*rawMsg, &messageChatSetTtl)
 		return &messageChatSetTtl, err
 
 	case MessageCustomServiceActionType:
 		var messageCustomServiceAction MessageCustomServiceAction
-		err := json.Unmarshal(*rawMsg, &messageCustomServiceAction)
+		err := jsonUnmarshal(*rawMsg, &messageCustomServiceAction)
 		return &messageCustomServiceAction, err
 
 	case MessageGameScoreType:
 		var messageGameScore MessageGameScore
-		err := json.Unmarshal(*rawMsg, &messageGameScore)
+		err := jsonUnmarshal(*rawMsg, &messageGameScore)
 		return &messageGameScore, err
 
 	case MessagePaymentSuccessfulType:
 		var messagePaymentSuccessful MessagePaymentSuccessful
-		err := json.Unmarshal(*rawMsg, &messagePaymentSuccessful)
+		err := jsonUnmarshal(*rawMsg, &messagePaymentSuccessful)
 		return &messagePaymentSuccessful, err
 
 	case MessagePaymentSuccessfulBotType:
 		var messagePaymentSuccessfulBot MessagePaymentSuccessfulBot
-		err := json.Unmarshal(*rawMsg, &messagePaymentSuccessfulBot)
+		err := jsonUnmarshal(*rawMsg, &messagePaymentSuccessfulBot)
 		return &messagePaymentSuccessfulBot, err
 
 	case MessageContactRegisteredType:
 		var messageContactRegistered MessageContactRegistered
-		err := json.Unmarshal(*rawMsg, &messageContactRegistered)
+		err := jsonUnmarshal(*rawMsg, &messageContactRegistered)
 		return &messageContactRegistered, err
 
 	case MessageWebsiteConnectedType:
 		var messageWebsiteConnected MessageWebsiteConnected
-		err := json.Unmarshal(*rawMsg, &messageWebsiteConnected)
+		err := jsonUnmarshal(*rawMsg, &messageWebsiteConnected)
 		return &messageWebsiteConnected, err
 
 	case MessagePassportDataSentType:
 		var messagePassportDataSent MessagePassportDataSent
-		err := json.Unmarshal(*rawMsg, &messagePassportDataSent)
+		err := jsonUnmarshal(*rawMsg, &messagePassportDataSent)
 		return &messagePassportDataSent, err
 
 	case MessagePassportDataReceivedType:
 		var messagePassportDataReceived MessagePassportDataReceived
-		err := json.Unmarshal(*rawMsg, &messagePassportDataReceived)
+		err := jsonUnmarshal(*rawMsg, &messagePassportDataReceived)
 		return &messagePassportDataReceived, err
 
 	case MessageProximityAlertTriggeredType:
 		var messageProximityAlertTriggered MessageProximityAlertTriggered
-		err := json.Unmarshal(*rawMsg, &messageProximityAlertTriggered)
+		err := jsonUnmarshal(*rawMsg, &messageProximityAlertTriggered)
 		return &messageProximityAlertTriggered, err
 
 	case MessageUnsupportedType:
 		var messageUnsupported MessageUnsupported
-		err := json.Unmarshal(*rawMsg, &messageUnsupported)
+		err := jsonUnmarshal(*rawMsg, &messageUnsupported)
 		return &messageUnsupported, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownMessageContent{Unknown: unknown}, nil
 	}
 }
 
+func decodeMessageContent(r *jsonReader) (MessageContent, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalMessageContent(rawMsg)
+}
+
 // MessageText A text message
 type MessageText struct {
 	tdCommon
@@ -354,11 +407,144 @@ func NewMessageText(text *FormattedText, webPage *WebPage) *MessageText {
 	return &messageTextTemp
 }
 
+// MarshalJSON marshals to json
+func (messageText *MessageText) MarshalJSON() ([]byte, error) {
+	return messageText.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageText *MessageText) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageText)
+}
+
+func (messageText *MessageText) appendJSON(b []byte) ([]byte, error) {
+	if messageText == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageText.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageText.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b, err = messageText.Text.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"web_page":`...)
+	b, err = messageText.WebPage.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (messageText *MessageText) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageText.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageText.tdCommon.Extra, err = r.readString()
+		case "text":
+			if r.readNull() {
+				messageText.Text = nil
+			} else {
+				messageText.Text = new(FormattedText)
+				err = messageText.Text.decodeJSON(r)
+			}
+		case "web_page":
+			if r.readNull() {
+				messageText.WebPage = nil
+			} else {
+				messageText.WebPage = new(WebPage)
+				err = messageText.WebPage.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageText *MessageText) Clone() *MessageText {
+	if messageText == nil {
+		return nil
+	}
+	clone := *messageText
+	clone.Text = messageText.Text.Clone()
+	clone.WebPage = messageText.WebPage.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageText *MessageText) Equal(other *MessageText) bool {
+	if messageText == nil || other == nil {
+		return messageText == other
+	}
+	if !messageText.Text.Equal(other.Text) {
+		return false
+	}
+	if !messageText.WebPage.Equal(other.WebPage) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageText *MessageText) Diff(other *MessageText) []Change {
+	return messageText.diff("", other, nil)
+}
+
+func (messageText *MessageText) diff(path string, other *MessageText, changes []Change) []Change {
+	if messageText == nil || other == nil {
+		if messageText != other {
+			changes = append(changes, Change{Path: path, Old: messageText, New: other})
+		}
+		return changes
+	}
+	changes = messageText.Text.diff(fieldPath(path, "text"), other.Text, changes)
+	changes = messageText.WebPage.diff(fieldPath(path, "web_page"), other.WebPage, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageText *MessageText) String() string {
+	return objectString(messageText)
+}
+
+// LogValue logs the object as its summary
+func (messageText *MessageText) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageText))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageText *MessageText) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageText)
+}
+
+func (messageText *MessageText) printText(p *printer) {
+	if messageText == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageText")
+	p.object("text", messageText.Text)
+	p.object("web_page", messageText.WebPage)
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageText *MessageText) GetMessageContentEnum() MessageContentEnum {
 	return MessageTextType
 }
 
+// Accept calls the method of the visitor for MessageText
+func (messageText *MessageText) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageText(messageText)
+}
+
 // MessageAnimation An animation message (GIF-style).
 type MessageAnimation struct {
 	tdCommon
@@ -388,11 +574,155 @@ func NewMessageAnimation(animation *Animation, caption *FormattedText, isSecret
 	return &messageAnimationTemp
 }
 
+// MarshalJSON marshals to json
+func (messageAnimation *MessageAnimation) MarshalJSON() ([]byte, error) {
+	return messageAnimation.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageAnimation *MessageAnimation) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageAnimation)
+}
+
+func (messageAnimation *MessageAnimation) appendJSON(b []byte) ([]byte, error) {
+	if messageAnimation == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageAnimation.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageAnimation.tdCommon.Extra)
+	b = append(b, `,"animation":`...)
+	b, err = messageAnimation.Animation.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"caption":`...)
+	b, err = messageAnimation.Caption.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"is_secret":`...)
+	b = appendJSONBool(b, messageAnimation.IsSecret)
+	return append(b, '}'), nil
+}
+
+func (messageAnimation *MessageAnimation) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageAnimation.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageAnimation.tdCommon.Extra, err = r.readString()
+		case "animation":
+			if r.readNull() {
+				messageAnimation.Animation = nil
+			} else {
+				messageAnimation.Animation = new(Animation)
+				err = messageAnimation.Animation.decodeJSON(r)
+			}
+		case "caption":
+			if r.readNull() {
+				messageAnimation.Caption = nil
+			} else {
+				messageAnimation.Caption = new(FormattedText)
+				err = messageAnimation.Caption.decodeJSON(r)
+			}
+		case "is_secret":
+			messageAnimation.IsSecret, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageAnimation *MessageAnimation) Clone() *MessageAnimation {
+	if messageAnimation == nil {
+		return nil
+	}
+	clone := *messageAnimation
+	clone.Animation = messageAnimation.Animation.Clone()
+	clone.Caption = messageAnimation.Caption.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageAnimation *MessageAnimation) Equal(other *MessageAnimation) bool {
+	if messageAnimation == nil || other == nil {
+		return messageAnimation == other
+	}
+	if !messageAnimation.Animation.Equal(other.Animation) {
+		return false
+	}
+	if !messageAnimation.Caption.Equal(other.Caption) {
+		return false
+	}
+	if messageAnimation.IsSecret != other.IsSecret {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageAnimation *MessageAnimation) Diff(other *MessageAnimation) []Change {
+	return messageAnimation.diff("", other, nil)
+}
+
+func (messageAnimation *MessageAnimation) diff(path string, other *MessageAnimation, changes []Change) []Change {
+	if messageAnimation == nil || other == nil {
+		if messageAnimation != other {
+			changes = append(changes, Change{Path: path, Old: messageAnimation, New: other})
+		}
+		return changes
+	}
+	changes = messageAnimation.Animation.diff(fieldPath(path, "animation"), other.Animation, changes)
+	changes = messageAnimation.Caption.diff(fieldPath(path, "caption"), other.Caption, changes)
+	if messageAnimation.IsSecret != other.IsSecret {
+		changes = append(changes, Change{Path: fieldPath(path, "is_secret"), Old: messageAnimation.IsSecret, New: other.IsSecret})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageAnimation *MessageAnimation) String() string {
+	return objectString(messageAnimation)
+}
+
+// LogValue logs the object as its summary
+func (messageAnimation *MessageAnimation) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageAnimation))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageAnimation *MessageAnimation) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageAnimation)
+}
+
+func (messageAnimation *MessageAnimation) printText(p *printer) {
+	if messageAnimation == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageAnimation")
+	p.object("animation", messageAnimation.Animation)
+	p.object("caption", messageAnimation.Caption)
+	p.bool("is_secret", messageAnimation.IsSecret)
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageAnimation *MessageAnimation) GetMessageContentEnum() MessageContentEnum {
 	return MessageAnimationType
 }
 
+// Accept calls the method of the visitor for MessageAnimation
+func (messageAnimation *MessageAnimation) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageAnimation(messageAnimation)
+}
+
 // MessageAudio An audio message
 type MessageAudio struct {
 	tdCommon
@@ -419,11 +749,144 @@ func NewMessageAudio(audio *Audio, caption *FormattedText) *MessageAudio {
 	return &messageAudioTemp
 }
 
+// MarshalJSON marshals to json
+func (messageAudio *MessageAudio) MarshalJSON() ([]byte, error) {
+	return messageAudio.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageAudio *MessageAudio) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageAudio)
+}
+
+func (messageAudio *MessageAudio) appendJSON(b []byte) ([]byte, error) {
+	if messageAudio == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageAudio.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageAudio.tdCommon.Extra)
+	b = append(b, `,"audio":`...)
+	b, err = messageAudio.Audio.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"caption":`...)
+	b, err = messageAudio.Caption.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (messageAudio *MessageAudio) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageAudio.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageAudio.tdCommon.Extra, err = r.readString()
+		case "audio":
+			if r.readNull() {
+				messageAudio.Audio = nil
+			} else {
+				messageAudio.Audio = new(Audio)
+				err = messageAudio.Audio.decodeJSON(r)
+			}
+		case "caption":
+			if r.readNull() {
+				messageAudio.Caption = nil
+			} else {
+				messageAudio.Caption = new(FormattedText)
+				err = messageAudio.Caption.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageAudio *MessageAudio) Clone() *MessageAudio {
+	if messageAudio == nil {
+		return nil
+	}
+	clone := *messageAudio
+	clone.Audio = messageAudio.Audio.Clone()
+	clone.Caption = messageAudio.Caption.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageAudio *MessageAudio) Equal(other *MessageAudio) bool {
+	if messageAudio == nil || other == nil {
+		return messageAudio == other
+	}
+	if !messageAudio.Audio.Equal(other.Audio) {
+		return false
+	}
+	if !messageAudio.Caption.Equal(other.Caption) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageAudio *MessageAudio) Diff(other *MessageAudio) []Change {
+	return messageAudio.diff("", other, nil)
+}
+
+func (messageAudio *MessageAudio) diff(path string, other *MessageAudio, changes []Change) []Change {
+	if messageAudio == nil || other == nil {
+		if messageAudio != other {
+			changes = append(changes, Change{Path: path, Old: messageAudio, New: other})
+		}
+		return changes
+	}
+	changes = messageAudio.Audio.diff(fieldPath(path, "audio"), other.Audio, changes)
+	changes = messageAudio.Caption.diff(fieldPath(path, "caption"), other.Caption, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageAudio *MessageAudio) String() string {
+	return objectString(messageAudio)
+}
+
+// LogValue logs the object as its summary
+func (messageAudio *MessageAudio) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageAudio))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageAudio *MessageAudio) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageAudio)
+}
+
+func (messageAudio *MessageAudio) printText(p *printer) {
+	if messageAudio == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageAudio")
+	p.object("audio", messageAudio.Audio)
+	p.object("caption", messageAudio.Caption)
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageAudio *MessageAudio) GetMessageContentEnum() MessageContentEnum {
 	return MessageAudioType
 }
 
+// Accept calls the method of the visitor for MessageAudio
+func (messageAudio *MessageAudio) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageAudio(messageAudio)
+}
+
 // MessageDocument A document message (general file)
 type MessageDocument struct {
 	tdCommon
@@ -450,11 +913,144 @@ func NewMessageDocument(document *Document, caption *FormattedText) *MessageDocu
 	return &messageDocumentTemp
 }
 
+// MarshalJSON marshals to json
+func (messageDocument *MessageDocument) MarshalJSON() ([]byte, error) {
+	return messageDocument.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageDocument *MessageDocument) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageDocument)
+}
+
+func (messageDocument *MessageDocument) appendJSON(b []byte) ([]byte, error) {
+	if messageDocument == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageDocument.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageDocument.tdCommon.Extra)
+	b = append(b, `,"document":`...)
+	b, err = messageDocument.Document.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"caption":`...)
+	b, err = messageDocument.Caption.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (messageDocument *MessageDocument) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageDocument.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageDocument.tdCommon.Extra, err = r.readString()
+		case "document":
+			if r.readNull() {
+				messageDocument.Document = nil
+			} else {
+				messageDocument.Document = new(Document)
+				err = messageDocument.Document.decodeJSON(r)
+			}
+		case "caption":
+			if r.readNull() {
+				messageDocument.Caption = nil
+			} else {
+				messageDocument.Caption = new(FormattedText)
+				err = messageDocument.Caption.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageDocument *MessageDocument) Clone() *MessageDocument {
+	if messageDocument == nil {
+		return nil
+	}
+	clone := *messageDocument
+	clone.Document = messageDocument.Document.Clone()
+	clone.Caption = messageDocument.Caption.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageDocument *MessageDocument) Equal(other *MessageDocument) bool {
+	if messageDocument == nil || other == nil {
+		return messageDocument == other
+	}
+	if !messageDocument.Document.Equal(other.Document) {
+		return false
+	}
+	if !messageDocument.Caption.Equal(other.Caption) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageDocument *MessageDocument) Diff(other *MessageDocument) []Change {
+	return messageDocument.diff("", other, nil)
+}
+
+func (messageDocument *MessageDocument) diff(path string, other *MessageDocument, changes []Change) []Change {
+	if messageDocument == nil || other == nil {
+		if messageDocument != other {
+			changes = append(changes, Change{Path: path, Old: messageDocument, New: other})
+		}
+		return changes
+	}
+	changes = messageDocument.Document.diff(fieldPath(path, "document"), other.Document, changes)
+	changes = messageDocument.Caption.diff(fieldPath(path, "caption"), other.Caption, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageDocument *MessageDocument) String() string {
+	return objectString(messageDocument)
+}
+
+// LogValue logs the object as its summary
+func (messageDocument *MessageDocument) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageDocument))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageDocument *MessageDocument) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageDocument)
+}
+
+func (messageDocument *MessageDocument) printText(p *printer) {
+	if messageDocument == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageDocument")
+	p.object("document", messageDocument.Document)
+	p.object("caption", messageDocument.Caption)
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageDocument *MessageDocument) GetMessageContentEnum() MessageContentEnum {
 	return MessageDocumentType
 }
 
+// Accept calls the method of the visitor for MessageDocument
+func (messageDocument *MessageDocument) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageDocument(messageDocument)
+}
+
 // MessagePhoto A photo message
 type MessagePhoto struct {
 	tdCommon
@@ -484,11 +1080,155 @@ func NewMessagePhoto(photo *Photo, caption *FormattedText, isSecret bool) *Messa
 	return &messagePhotoTemp
 }
 
+// MarshalJSON marshals to json
+func (messagePhoto *MessagePhoto) MarshalJSON() ([]byte, error) {
+	return messagePhoto.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messagePhoto *MessagePhoto) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messagePhoto)
+}
+
+func (messagePhoto *MessagePhoto) appendJSON(b []byte) ([]byte, error) {
+	if messagePhoto == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messagePhoto.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messagePhoto.tdCommon.Extra)
+	b = append(b, `,"photo":`...)
+	b, err = messagePhoto.Photo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"caption":`...)
+	b, err = messagePhoto.Caption.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"is_secret":`...)
+	b = appendJSONBool(b, messagePhoto.IsSecret)
+	return append(b, '}'), nil
+}
+
+func (messagePhoto *MessagePhoto) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messagePhoto.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messagePhoto.tdCommon.Extra, err = r.readString()
+		case "photo":
+			if r.readNull() {
+				messagePhoto.Photo = nil
+			} else {
+				messagePhoto.Photo = new(Photo)
+				err = messagePhoto.Photo.decodeJSON(r)
+			}
+		case "caption":
+			if r.readNull() {
+				messagePhoto.Caption = nil
+			} else {
+				messagePhoto.Caption = new(FormattedText)
+				err = messagePhoto.Caption.decodeJSON(r)
+			}
+		case "is_secret":
+			messagePhoto.IsSecret, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messagePhoto *MessagePhoto) Clone() *MessagePhoto {
+	if messagePhoto == nil {
+		return nil
+	}
+	clone := *messagePhoto
+	clone.Photo = messagePhoto.Photo.Clone()
+	clone.Caption = messagePhoto.Caption.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messagePhoto *MessagePhoto) Equal(other *MessagePhoto) bool {
+	if messagePhoto == nil || other == nil {
+		return messagePhoto == other
+	}
+	if !messagePhoto.Photo.Equal(other.Photo) {
+		return false
+	}
+	if !messagePhoto.Caption.Equal(other.Caption) {
+		return false
+	}
+	if messagePhoto.IsSecret != other.IsSecret {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messagePhoto *MessagePhoto) Diff(other *MessagePhoto) []Change {
+	return messagePhoto.diff("", other, nil)
+}
+
+func (messagePhoto *MessagePhoto) diff(path string, other *MessagePhoto, changes []Change) []Change {
+	if messagePhoto == nil || other == nil {
+		if messagePhoto != other {
+			changes = append(changes, Change{Path: path, Old: messagePhoto, New: other})
+		}
+		return changes
+	}
+	changes = messagePhoto.Photo.diff(fieldPath(path, "photo"), other.Photo, changes)
+	changes = messagePhoto.Caption.diff(fieldPath(path, "caption"), other.Caption, changes)
+	if messagePhoto.IsSecret != other.IsSecret {
+		changes = append(changes, Change{Path: fieldPath(path, "is_secret"), Old: messagePhoto.IsSecret, New: other.IsSecret})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messagePhoto *MessagePhoto) String() string {
+	return objectString(messagePhoto)
+}
+
+// LogValue logs the object as its summary
+func (messagePhoto *MessagePhoto) LogValue() slog.Value {
+	return slog.StringValue(objectString(messagePhoto))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messagePhoto *MessagePhoto) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messagePhoto)
+}
+
+func (messagePhoto *MessagePhoto) printText(p *printer) {
+	if messagePhoto == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessagePhoto")
+	p.object("photo", messagePhoto.Photo)
+	p.object("caption", messagePhoto.Caption)
+	p.bool("is_secret", messagePhoto.IsSecret)
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messagePhoto *MessagePhoto) GetMessageContentEnum() MessageContentEnum {
 	return MessagePhotoType
 }
 
+// Accept calls the method of the visitor for MessagePhoto
+func (messagePhoto *MessagePhoto) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessagePhoto(messagePhoto)
+}
+
 // MessageExpiredPhoto An expired photo message (self-destructed after TTL has elapsed)
 type MessageExpiredPhoto struct {
 	tdCommon
@@ -500,7 +1240,6 @@ func (messageExpiredPhoto *MessageExpiredPhoto) MessageType() string {
 }
 
 // NewMessageExpiredPhoto creates a new MessageExpiredPhoto
-//
 func NewMessageExpiredPhoto() *MessageExpiredPhoto {
 	messageExpiredPhotoTemp := MessageExpiredPhoto{
 		tdCommon: tdCommon{Type: "messageExpiredPhoto"},
@@ -509,11 +1248,107 @@ func NewMessageExpiredPhoto() *MessageExpiredPhoto {
 	return &messageExpiredPhotoTemp
 }
 
+// MarshalJSON marshals to json
+func (messageExpiredPhoto *MessageExpiredPhoto) MarshalJSON() ([]byte, error) {
+	return messageExpiredPhoto.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageExpiredPhoto *MessageExpiredPhoto) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageExpiredPhoto)
+}
+
+func (messageExpiredPhoto *MessageExpiredPhoto) appendJSON(b []byte) ([]byte, error) {
+	if messageExpiredPhoto == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageExpiredPhoto.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageExpiredPhoto.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (messageExpiredPhoto *MessageExpiredPhoto) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageExpiredPhoto.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageExpiredPhoto.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageExpiredPhoto *MessageExpiredPhoto) Clone() *MessageExpiredPhoto {
+	if messageExpiredPhoto == nil {
+		return nil
+	}
+	clone := *messageExpiredPhoto
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageExpiredPhoto *MessageExpiredPhoto) Equal(other *MessageExpiredPhoto) bool {
+	if messageExpiredPhoto == nil || other == nil {
+		return messageExpiredPhoto == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageExpiredPhoto *MessageExpiredPhoto) Diff(other *MessageExpiredPhoto) []Change {
+	return messageExpiredPhoto.diff("", other, nil)
+}
+
+func (messageExpiredPhoto *MessageExpiredPhoto) diff(path string, other *MessageExpiredPhoto, changes []Change) []Change {
+	if messageExpiredPhoto == nil || other == nil {
+		if messageExpiredPhoto != other {
+			changes = append(changes, Change{Path: path, Old: messageExpiredPhoto, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageExpiredPhoto *MessageExpiredPhoto) String() string {
+	return objectString(messageExpiredPhoto)
+}
+
+// LogValue logs the object as its summary
+func (messageExpiredPhoto *MessageExpiredPhoto) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageExpiredPhoto))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageExpiredPhoto *MessageExpiredPhoto) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageExpiredPhoto)
+}
+
+func (messageExpiredPhoto *MessageExpiredPhoto) printText(p *printer) {
+	if messageExpiredPhoto == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageExpiredPhoto")
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageExpiredPhoto *MessageExpiredPhoto) GetMessageContentEnum() MessageContentEnum {
 	return MessageExpiredPhotoType
 }
 
+// Accept calls the method of the visitor for MessageExpiredPhoto
+func (messageExpiredPhoto *MessageExpiredPhoto) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageExpiredPhoto(messageExpiredPhoto)
+}
+
 // MessageSticker A sticker message
 type MessageSticker struct {
 	tdCommon
@@ -537,28 +1372,143 @@ func NewMessageSticker(sticker *Sticker) *MessageSticker {
 	return &messageStickerTemp
 }
 
-// GetMessageContentEnum return the enum type of this object
-func (messageSticker *MessageSticker) GetMessageContentEnum() MessageContentEnum {
-	return MessageStickerType
+// MarshalJSON marshals to json
+func (messageSticker *MessageSticker) MarshalJSON() ([]byte, error) {
+	return messageSticker.appendJSON(nil)
 }
 
-// MessageVideo A video message
-type MessageVideo struct {
-	tdCommon
-	Video    *Video         `json:"video"`     // The video description
-	Caption  *FormattedText `json:"caption"`   // Video caption
-	IsSecret bool           `json:"is_secret"` // True, if the video thumbnail must be blurred and the video must be shown only while tapped
+// UnmarshalJSON unmarshal to json
+func (messageSticker *MessageSticker) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageSticker)
 }
 
-// MessageType return the string telegram-type of MessageVideo
-func (messageVideo *MessageVideo) MessageType() string {
-	return "messageVideo"
+func (messageSticker *MessageSticker) appendJSON(b []byte) ([]byte, error) {
+	if messageSticker == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageSticker.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageSticker.tdCommon.Extra)
+	b = append(b, `,"sticker":`...)
+	b, err = messageSticker.Sticker.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (messageSticker *MessageSticker) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageSticker.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageSticker.tdCommon.Extra, err = r.readString()
+		case "sticker":
+			if r.readNull() {
+				messageSticker.Sticker = nil
+			} else {
+				messageSticker.Sticker = new(Sticker)
+				err = messageSticker.Sticker.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageSticker *MessageSticker) Clone() *MessageSticker {
+	if messageSticker == nil {
+		return nil
+	}
+	clone := *messageSticker
+	clone.Sticker = messageSticker.Sticker.Clone()
+	return &clone
 }
 
-// NewMessageVideo creates a new MessageVideo
-//
-// @param video The video description
-// @param caption Video caption
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageSticker *MessageSticker) Equal(other *MessageSticker) bool {
+	if messageSticker == nil || other == nil {
+		return messageSticker == other
+	}
+	if !messageSticker.Sticker.Equal(other.Sticker) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageSticker *MessageSticker) Diff(other *MessageSticker) []Change {
+	return messageSticker.diff("", other, nil)
+}
+
+func (messageSticker *MessageSticker) diff(path string, other *MessageSticker, changes []Change) []Change {
+	if messageSticker == nil || other == nil {
+		if messageSticker != other {
+			changes = append(changes, Change{Path: path, Old: messageSticker, New: other})
+		}
+		return changes
+	}
+	changes = messageSticker.Sticker.diff(fieldPath(path, "sticker"), other.Sticker, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageSticker *MessageSticker) String() string {
+	return objectString(messageSticker)
+}
+
+// LogValue logs the object as its summary
+func (messageSticker *MessageSticker) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageSticker))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageSticker *MessageSticker) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageSticker)
+}
+
+func (messageSticker *MessageSticker) printText(p *printer) {
+	if messageSticker == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageSticker")
+	p.object("sticker", messageSticker.Sticker)
+	p.endObject(state)
+}
+
+// GetMessageContentEnum return the enum type of this object
+func (messageSticker *MessageSticker) GetMessageContentEnum() MessageContentEnum {
+	return MessageStickerType
+}
+
+// Accept calls the method of the visitor for MessageSticker
+func (messageSticker *MessageSticker) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageSticker(messageSticker)
+}
+
+// MessageVideo A video message
+type MessageVideo struct {
+	tdCommon
+	Video    *Video         `json:"video"`     // The video description
+	Caption  *FormattedText `json:"caption"`   // Video caption
+	IsSecret bool           `json:"is_secret"` // True, if the video thumbnail must be blurred and the video must be shown only while tapped
+}
+
+// MessageType return the string telegram-type of MessageVideo
+func (messageVideo *MessageVideo) MessageType() string {
+	return "messageVideo"
+}
+
+// NewMessageVideo creates a new MessageVideo
+//
+// @param video The video description
+// @param caption Video caption
 // @param isSecret True, if the video thumbnail must be blurred and the video must be shown only while tapped
 func NewMessageVideo(video *Video, caption *FormattedText, isSecret bool) *MessageVideo {
 	messageVideoTemp := MessageVideo{
@@ -571,11 +1521,155 @@ func NewMessageVideo(video *Video, caption *FormattedText, isSecret bool) *Messa
 	return &messageVideoTemp
 }
 
+// MarshalJSON marshals to json
+func (messageVideo *MessageVideo) MarshalJSON() ([]byte, error) {
+	return messageVideo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageVideo *MessageVideo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageVideo)
+}
+
+func (messageVideo *MessageVideo) appendJSON(b []byte) ([]byte, error) {
+	if messageVideo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageVideo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageVideo.tdCommon.Extra)
+	b = append(b, `,"video":`...)
+	b, err = messageVideo.Video.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"caption":`...)
+	b, err = messageVideo.Caption.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"is_secret":`...)
+	b = appendJSONBool(b, messageVideo.IsSecret)
+	return append(b, '}'), nil
+}
+
+func (messageVideo *MessageVideo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageVideo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageVideo.tdCommon.Extra, err = r.readString()
+		case "video":
+			if r.readNull() {
+				messageVideo.Video = nil
+			} else {
+				messageVideo.Video = new(Video)
+				err = messageVideo.Video.decodeJSON(r)
+			}
+		case "caption":
+			if r.readNull() {
+				messageVideo.Caption = nil
+			} else {
+				messageVideo.Caption = new(FormattedText)
+				err = messageVideo.Caption.decodeJSON(r)
+			}
+		case "is_secret":
+			messageVideo.IsSecret, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageVideo *MessageVideo) Clone() *MessageVideo {
+	if messageVideo == nil {
+		return nil
+	}
+	clone := *messageVideo
+	clone.Video = messageVideo.Video.Clone()
+	clone.Caption = messageVideo.Caption.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageVideo *MessageVideo) Equal(other *MessageVideo) bool {
+	if messageVideo == nil || other == nil {
+		return messageVideo == other
+	}
+	if !messageVideo.Video.Equal(other.Video) {
+		return false
+	}
+	if !messageVideo.Caption.Equal(other.Caption) {
+		return false
+	}
+	if messageVideo.IsSecret != other.IsSecret {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageVideo *MessageVideo) Diff(other *MessageVideo) []Change {
+	return messageVideo.diff("", other, nil)
+}
+
+func (messageVideo *MessageVideo) diff(path string, other *MessageVideo, changes []Change) []Change {
+	if messageVideo == nil || other == nil {
+		if messageVideo != other {
+			changes = append(changes, Change{Path: path, Old: messageVideo, New: other})
+		}
+		return changes
+	}
+	changes = messageVideo.Video.diff(fieldPath(path, "video"), other.Video, changes)
+	changes = messageVideo.Caption.diff(fieldPath(path, "caption"), other.Caption, changes)
+	if messageVideo.IsSecret != other.IsSecret {
+		changes = append(changes, Change{Path: fieldPath(path, "is_secret"), Old: messageVideo.IsSecret, New: other.IsSecret})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageVideo *MessageVideo) String() string {
+	return objectString(messageVideo)
+}
+
+// LogValue logs the object as its summary
+func (messageVideo *MessageVideo) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageVideo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageVideo *MessageVideo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageVideo)
+}
+
+func (messageVideo *MessageVideo) printText(p *printer) {
+	if messageVideo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageVideo")
+	p.object("video", messageVideo.Video)
+	p.object("caption", messageVideo.Caption)
+	p.bool("is_secret", messageVideo.IsSecret)
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageVideo *MessageVideo) GetMessageContentEnum() MessageContentEnum {
 	return MessageVideoType
 }
 
+// Accept calls the method of the visitor for MessageVideo
+func (messageVideo *MessageVideo) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageVideo(messageVideo)
+}
+
 // MessageExpiredVideo An expired video message (self-destructed after TTL has elapsed)
 type MessageExpiredVideo struct {
 	tdCommon
@@ -587,7 +1681,6 @@ func (messageExpiredVideo *MessageExpiredVideo) MessageType() string {
 }
 
 // NewMessageExpiredVideo creates a new MessageExpiredVideo
-//
 func NewMessageExpiredVideo() *MessageExpiredVideo {
 	messageExpiredVideoTemp := MessageExpiredVideo{
 		tdCommon: tdCommon{Type: "messageExpiredVideo"},
@@ -596,11 +1689,107 @@ func NewMessageExpiredVideo() *MessageExpiredVideo {
 	return &messageExpiredVideoTemp
 }
 
+// MarshalJSON marshals to json
+func (messageExpiredVideo *MessageExpiredVideo) MarshalJSON() ([]byte, error) {
+	return messageExpiredVideo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageExpiredVideo *MessageExpiredVideo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageExpiredVideo)
+}
+
+func (messageExpiredVideo *MessageExpiredVideo) appendJSON(b []byte) ([]byte, error) {
+	if messageExpiredVideo == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageExpiredVideo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageExpiredVideo.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (messageExpiredVideo *MessageExpiredVideo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageExpiredVideo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageExpiredVideo.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageExpiredVideo *MessageExpiredVideo) Clone() *MessageExpiredVideo {
+	if messageExpiredVideo == nil {
+		return nil
+	}
+	clone := *messageExpiredVideo
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageExpiredVideo *MessageExpiredVideo) Equal(other *MessageExpiredVideo) bool {
+	if messageExpiredVideo == nil || other == nil {
+		return messageExpiredVideo == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageExpiredVideo *MessageExpiredVideo) Diff(other *MessageExpiredVideo) []Change {
+	return messageExpiredVideo.diff("", other, nil)
+}
+
+func (messageExpiredVideo *MessageExpiredVideo) diff(path string, other *MessageExpiredVideo, changes []Change) []Change {
+	if messageExpiredVideo == nil || other == nil {
+		if messageExpiredVideo != other {
+			changes = append(changes, Change{Path: path, Old: messageExpiredVideo, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageExpiredVideo *MessageExpiredVideo) String() string {
+	return objectString(messageExpiredVideo)
+}
+
+// LogValue logs the object as its summary
+func (messageExpiredVideo *MessageExpiredVideo) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageExpiredVideo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageExpiredVideo *MessageExpiredVideo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageExpiredVideo)
+}
+
+func (messageExpiredVideo *MessageExpiredVideo) printText(p *printer) {
+	if messageExpiredVideo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageExpiredVideo")
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageExpiredVideo *MessageExpiredVideo) GetMessageContentEnum() MessageContentEnum {
 	return MessageExpiredVideoType
 }
 
+// Accept calls the method of the visitor for MessageExpiredVideo
+func (messageExpiredVideo *MessageExpiredVideo) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageExpiredVideo(messageExpiredVideo)
+}
+
 // MessageVideoNote A video note message
 type MessageVideoNote struct {
 	tdCommon
@@ -630,11 +1819,148 @@ func NewMessageVideoNote(videoNote *VideoNote, isViewed bool, isSecret bool) *Me
 	return &messageVideoNoteTemp
 }
 
+// MarshalJSON marshals to json
+func (messageVideoNote *MessageVideoNote) MarshalJSON() ([]byte, error) {
+	return messageVideoNote.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageVideoNote *MessageVideoNote) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageVideoNote)
+}
+
+func (messageVideoNote *MessageVideoNote) appendJSON(b []byte) ([]byte, error) {
+	if messageVideoNote == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageVideoNote.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageVideoNote.tdCommon.Extra)
+	b = append(b, `,"video_note":`...)
+	b, err = messageVideoNote.VideoNote.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"is_viewed":`...)
+	b = appendJSONBool(b, messageVideoNote.IsViewed)
+	b = append(b, `,"is_secret":`...)
+	b = appendJSONBool(b, messageVideoNote.IsSecret)
+	return append(b, '}'), nil
+}
+
+func (messageVideoNote *MessageVideoNote) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageVideoNote.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageVideoNote.tdCommon.Extra, err = r.readString()
+		case "video_note":
+			if r.readNull() {
+				messageVideoNote.VideoNote = nil
+			} else {
+				messageVideoNote.VideoNote = new(VideoNote)
+				err = messageVideoNote.VideoNote.decodeJSON(r)
+			}
+		case "is_viewed":
+			messageVideoNote.IsViewed, err = r.readBool()
+		case "is_secret":
+			messageVideoNote.IsSecret, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageVideoNote *MessageVideoNote) Clone() *MessageVideoNote {
+	if messageVideoNote == nil {
+		return nil
+	}
+	clone := *messageVideoNote
+	clone.VideoNote = messageVideoNote.VideoNote.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageVideoNote *MessageVideoNote) Equal(other *MessageVideoNote) bool {
+	if messageVideoNote == nil || other == nil {
+		return messageVideoNote == other
+	}
+	if !messageVideoNote.VideoNote.Equal(other.VideoNote) {
+		return false
+	}
+	if messageVideoNote.IsViewed != other.IsViewed {
+		return false
+	}
+	if messageVideoNote.IsSecret != other.IsSecret {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageVideoNote *MessageVideoNote) Diff(other *MessageVideoNote) []Change {
+	return messageVideoNote.diff("", other, nil)
+}
+
+func (messageVideoNote *MessageVideoNote) diff(path string, other *MessageVideoNote, changes []Change) []Change {
+	if messageVideoNote == nil || other == nil {
+		if messageVideoNote != other {
+			changes = append(changes, Change{Path: path, Old: messageVideoNote, New: other})
+		}
+		return changes
+	}
+	changes = messageVideoNote.VideoNote.diff(fieldPath(path, "video_note"), other.VideoNote, changes)
+	if messageVideoNote.IsViewed != other.IsViewed {
+		changes = append(changes, Change{Path: fieldPath(path, "is_viewed"), Old: messageVideoNote.IsViewed, New: other.IsViewed})
+	}
+	if messageVideoNote.IsSecret != other.IsSecret {
+		changes = append(changes, Change{Path: fieldPath(path, "is_secret"), Old: messageVideoNote.IsSecret, New: other.IsSecret})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageVideoNote *MessageVideoNote) String() string {
+	return objectString(messageVideoNote)
+}
+
+// LogValue logs the object as its summary
+func (messageVideoNote *MessageVideoNote) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageVideoNote))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageVideoNote *MessageVideoNote) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageVideoNote)
+}
+
+func (messageVideoNote *MessageVideoNote) printText(p *printer) {
+	if messageVideoNote == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageVideoNote")
+	p.object("video_note", messageVideoNote.VideoNote)
+	p.bool("is_viewed", messageVideoNote.IsViewed)
+	p.bool("is_secret", messageVideoNote.IsSecret)
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageVideoNote *MessageVideoNote) GetMessageContentEnum() MessageContentEnum {
 	return MessageVideoNoteType
 }
 
+// Accept calls the method of the visitor for MessageVideoNote
+func (messageVideoNote *MessageVideoNote) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageVideoNote(messageVideoNote)
+}
+
 // MessageVoiceNote A voice note message
 type MessageVoiceNote struct {
 	tdCommon
@@ -664,11 +1990,155 @@ func NewMessageVoiceNote(voiceNote *VoiceNote, caption *FormattedText, isListene
 	return &messageVoiceNoteTemp
 }
 
+// MarshalJSON marshals to json
+func (messageVoiceNote *MessageVoiceNote) MarshalJSON() ([]byte, error) {
+	return messageVoiceNote.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageVoiceNote *MessageVoiceNote) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageVoiceNote)
+}
+
+func (messageVoiceNote *MessageVoiceNote) appendJSON(b []byte) ([]byte, error) {
+	if messageVoiceNote == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageVoiceNote.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageVoiceNote.tdCommon.Extra)
+	b = append(b, `,"voice_note":`...)
+	b, err = messageVoiceNote.VoiceNote.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"caption":`...)
+	b, err = messageVoiceNote.Caption.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"is_listened":`...)
+	b = appendJSONBool(b, messageVoiceNote.IsListened)
+	return append(b, '}'), nil
+}
+
+func (messageVoiceNote *MessageVoiceNote) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageVoiceNote.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageVoiceNote.tdCommon.Extra, err = r.readString()
+		case "voice_note":
+			if r.readNull() {
+				messageVoiceNote.VoiceNote = nil
+			} else {
+				messageVoiceNote.VoiceNote = new(VoiceNote)
+				err = messageVoiceNote.VoiceNote.decodeJSON(r)
+			}
+		case "caption":
+			if r.readNull() {
+				messageVoiceNote.Caption = nil
+			} else {
+				messageVoiceNote.Caption = new(FormattedText)
+				err = messageVoiceNote.Caption.decodeJSON(r)
+			}
+		case "is_listened":
+			messageVoiceNote.IsListened, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageVoiceNote *MessageVoiceNote) Clone() *MessageVoiceNote {
+	if messageVoiceNote == nil {
+		return nil
+	}
+	clone := *messageVoiceNote
+	clone.VoiceNote = messageVoiceNote.VoiceNote.Clone()
+	clone.Caption = messageVoiceNote.Caption.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageVoiceNote *MessageVoiceNote) Equal(other *MessageVoiceNote) bool {
+	if messageVoiceNote == nil || other == nil {
+		return messageVoiceNote == other
+	}
+	if !messageVoiceNote.VoiceNote.Equal(other.VoiceNote) {
+		return false
+	}
+	if !messageVoiceNote.Caption.Equal(other.Caption) {
+		return false
+	}
+	if messageVoiceNote.IsListened != other.IsListened {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageVoiceNote *MessageVoiceNote) Diff(other *MessageVoiceNote) []Change {
+	return messageVoiceNote.diff("", other, nil)
+}
+
+func (messageVoiceNote *MessageVoiceNote) diff(path string, other *MessageVoiceNote, changes []Change) []Change {
+	if messageVoiceNote == nil || other == nil {
+		if messageVoiceNote != other {
+			changes = append(changes, Change{Path: path, Old: messageVoiceNote, New: other})
+		}
+		return changes
+	}
+	changes = messageVoiceNote.VoiceNote.diff(fieldPath(path, "voice_note"), other.VoiceNote, changes)
+	changes = messageVoiceNote.Caption.diff(fieldPath(path, "caption"), other.Caption, changes)
+	if messageVoiceNote.IsListened != other.IsListened {
+		changes = append(changes, Change{Path: fieldPath(path, "is_listened"), Old: messageVoiceNote.IsListened, New: other.IsListened})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageVoiceNote *MessageVoiceNote) String() string {
+	return objectString(messageVoiceNote)
+}
+
+// LogValue logs the object as its summary
+func (messageVoiceNote *MessageVoiceNote) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageVoiceNote))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageVoiceNote *MessageVoiceNote) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageVoiceNote)
+}
+
+func (messageVoiceNote *MessageVoiceNote) printText(p *printer) {
+	if messageVoiceNote == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageVoiceNote")
+	p.object("voice_note", messageVoiceNote.VoiceNote)
+	p.object("caption", messageVoiceNote.Caption)
+	p.bool("is_listened", messageVoiceNote.IsListened)
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageVoiceNote *MessageVoiceNote) GetMessageContentEnum() MessageContentEnum {
 	return MessageVoiceNoteType
 }
 
+// Accept calls the method of the visitor for MessageVoiceNote
+func (messageVoiceNote *MessageVoiceNote) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageVoiceNote(messageVoiceNote)
+}
+
 // MessageLocation A message with a location
 type MessageLocation struct {
 	tdCommon
@@ -704,11 +2174,170 @@ func NewMessageLocation(location *Location, livePeriod int32, expiresIn int32, h
 	return &messageLocationTemp
 }
 
+// MarshalJSON marshals to json
+func (messageLocation *MessageLocation) MarshalJSON() ([]byte, error) {
+	return messageLocation.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageLocation *MessageLocation) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageLocation)
+}
+
+func (messageLocation *MessageLocation) appendJSON(b []byte) ([]byte, error) {
+	if messageLocation == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageLocation.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageLocation.tdCommon.Extra)
+	b = append(b, `,"location":`...)
+	b, err = messageLocation.Location.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"live_period":`...)
+	b = appendJSONInt(b, int64(messageLocation.LivePeriod))
+	b = append(b, `,"expires_in":`...)
+	b = appendJSONInt(b, int64(messageLocation.ExpiresIn))
+	b = append(b, `,"heading":`...)
+	b = appendJSONInt(b, int64(messageLocation.Heading))
+	b = append(b, `,"proximity_alert_radius":`...)
+	b = appendJSONInt(b, int64(messageLocation.ProximityAlertRadius))
+	return append(b, '}'), nil
+}
+
+func (messageLocation *MessageLocation) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageLocation.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageLocation.tdCommon.Extra, err = r.readString()
+		case "location":
+			if r.readNull() {
+				messageLocation.Location = nil
+			} else {
+				messageLocation.Location = new(Location)
+				err = messageLocation.Location.decodeJSON(r)
+			}
+		case "live_period":
+			messageLocation.LivePeriod, err = r.readInt32()
+		case "expires_in":
+			messageLocation.ExpiresIn, err = r.readInt32()
+		case "heading":
+			messageLocation.Heading, err = r.readInt32()
+		case "proximity_alert_radius":
+			messageLocation.ProximityAlertRadius, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageLocation *MessageLocation) Clone() *MessageLocation {
+	if messageLocation == nil {
+		return nil
+	}
+	clone := *messageLocation
+	clone.Location = messageLocation.Location.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageLocation *MessageLocation) Equal(other *MessageLocation) bool {
+	if messageLocation == nil || other == nil {
+		return messageLocation == other
+	}
+	if !messageLocation.Location.Equal(other.Location) {
+		return false
+	}
+	if messageLocation.LivePeriod != other.LivePeriod {
+		return false
+	}
+	if messageLocation.ExpiresIn != other.ExpiresIn {
+		return false
+	}
+	if messageLocation.Heading != other.Heading {
+		return false
+	}
+	if messageLocation.ProximityAlertRadius != other.ProximityAlertRadius {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageLocation *MessageLocation) Diff(other *MessageLocation) []Change {
+	return messageLocation.diff("", other, nil)
+}
+
+func (messageLocation *MessageLocation) diff(path string, other *MessageLocation, changes []Change) []Change {
+	if messageLocation == nil || other == nil {
+		if messageLocation != other {
+			changes = append(changes, Change{Path: path, Old: messageLocation, New: other})
+		}
+		return changes
+	}
+	changes = messageLocation.Location.diff(fieldPath(path, "location"), other.Location, changes)
+	if messageLocation.LivePeriod != other.LivePeriod {
+		changes = append(changes, Change{Path: fieldPath(path, "live_period"), Old: messageLocation.LivePeriod, New: other.LivePeriod})
+	}
+	if messageLocation.ExpiresIn != other.ExpiresIn {
+		changes = append(changes, Change{Path: fieldPath(path, "expires_in"), Old: messageLocation.ExpiresIn, New: other.ExpiresIn})
+	}
+	if messageLocation.Heading != other.Heading {
+		changes = append(changes, Change{Path: fieldPath(path, "heading"), Old: messageLocation.Heading, New: other.Heading})
+	}
+	if messageLocation.ProximityAlertRadius != other.ProximityAlertRadius {
+		changes = append(changes, Change{Path: fieldPath(path, "proximity_alert_radius"), Old: messageLocation.ProximityAlertRadius, New: other.ProximityAlertRadius})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageLocation *MessageLocation) String() string {
+	return objectString(messageLocation)
+}
+
+// LogValue logs the object as its summary
+func (messageLocation *MessageLocation) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageLocation))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageLocation *MessageLocation) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageLocation)
+}
+
+func (messageLocation *MessageLocation) printText(p *printer) {
+	if messageLocation == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageLocation")
+	p.object("location", messageLocation.Location)
+	p.int("live_period", int64(messageLocation.LivePeriod))
+	p.int("expires_in", int64(messageLocation.ExpiresIn))
+	p.int("heading", int64(messageLocation.Heading))
+	p.int("proximity_alert_radius", int64(messageLocation.ProximityAlertRadius))
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageLocation *MessageLocation) GetMessageContentEnum() MessageContentEnum {
 	return MessageLocationType
 }
 
+// Accept calls the method of the visitor for MessageLocation
+func (messageLocation *MessageLocation) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageLocation(messageLocation)
+}
+
 // MessageVenue A message with information about a venue
 type MessageVenue struct {
 	tdCommon
@@ -732,11 +2361,126 @@ func NewMessageVenue(venue *Venue) *MessageVenue {
 	return &messageVenueTemp
 }
 
+// MarshalJSON marshals to json
+func (messageVenue *MessageVenue) MarshalJSON() ([]byte, error) {
+	return messageVenue.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageVenue *MessageVenue) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageVenue)
+}
+
+func (messageVenue *MessageVenue) appendJSON(b []byte) ([]byte, error) {
+	if messageVenue == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageVenue.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageVenue.tdCommon.Extra)
+	b = append(b, `,"venue":`...)
+	b, err = messageVenue.Venue.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (messageVenue *MessageVenue) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageVenue.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageVenue.tdCommon.Extra, err = r.readString()
+		case "venue":
+			if r.readNull() {
+				messageVenue.Venue = nil
+			} else {
+				messageVenue.Venue = new(Venue)
+				err = messageVenue.Venue.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageVenue *MessageVenue) Clone() *MessageVenue {
+	if messageVenue == nil {
+		return nil
+	}
+	clone := *messageVenue
+	clone.Venue = messageVenue.Venue.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageVenue *MessageVenue) Equal(other *MessageVenue) bool {
+	if messageVenue == nil || other == nil {
+		return messageVenue == other
+	}
+	if !messageVenue.Venue.Equal(other.Venue) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageVenue *MessageVenue) Diff(other *MessageVenue) []Change {
+	return messageVenue.diff("", other, nil)
+}
+
+func (messageVenue *MessageVenue) diff(path string, other *MessageVenue, changes []Change) []Change {
+	if messageVenue == nil || other == nil {
+		if messageVenue != other {
+			changes = append(changes, Change{Path: path, Old: messageVenue, New: other})
+		}
+		return changes
+	}
+	changes = messageVenue.Venue.diff(fieldPath(path, "venue"), other.Venue, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageVenue *MessageVenue) String() string {
+	return objectString(messageVenue)
+}
+
+// LogValue logs the object as its summary
+func (messageVenue *MessageVenue) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageVenue))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageVenue *MessageVenue) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageVenue)
+}
+
+func (messageVenue *MessageVenue) printText(p *printer) {
+	if messageVenue == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageVenue")
+	p.object("venue", messageVenue.Venue)
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageVenue *MessageVenue) GetMessageContentEnum() MessageContentEnum {
 	return MessageVenueType
 }
 
+// Accept calls the method of the visitor for MessageVenue
+func (messageVenue *MessageVenue) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageVenue(messageVenue)
+}
+
 // MessageContact A message with a user contact
 type MessageContact struct {
 	tdCommon
@@ -760,11 +2504,126 @@ func NewMessageContact(contact *Contact) *MessageContact {
 	return &messageContactTemp
 }
 
-// GetMessageContentEnum return the enum type of this object
+// MarshalJSON marshals to json
+func (messageContact *MessageContact) MarshalJSON() ([]byte, error) {
+	return messageContact.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageContact *MessageContact) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageContact)
+}
+
+func (messageContact *MessageContact) appendJSON(b []byte) ([]byte, error) {
+	if messageContact == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageContact.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageContact.tdCommon.Extra)
+	b = append(b, `,"contact":`...)
+	b, err = messageContact.Contact.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (messageContact *MessageContact) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageContact.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageContact.tdCommon.Extra, err = r.readString()
+		case "contact":
+			if r.readNull() {
+				messageContact.Contact = nil
+			} else {
+				messageContact.Contact = new(Contact)
+				err = messageContact.Contact.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageContact *MessageContact) Clone() *MessageContact {
+	if messageContact == nil {
+		return nil
+	}
+	clone := *messageContact
+	clone.Contact = messageContact.Contact.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageContact *MessageContact) Equal(other *MessageContact) bool {
+	if messageContact == nil || other == nil {
+		return messageContact == other
+	}
+	if !messageContact.Contact.Equal(other.Contact) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageContact *MessageContact) Diff(other *MessageContact) []Change {
+	return messageContact.diff("", other, nil)
+}
+
+func (messageContact *MessageContact) diff(path string, other *MessageContact, changes []Change) []Change {
+	if messageContact == nil || other == nil {
+		if messageContact != other {
+			changes = append(changes, Change{Path: path, Old: messageContact, New: other})
+		}
+		return changes
+	}
+	changes = messageContact.Contact.diff(fieldPath(path, "contact"), other.Contact, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageContact *MessageContact) String() string {
+	return objectString(messageContact)
+}
+
+// LogValue logs the object as its summary
+func (messageContact *MessageContact) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageContact))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageContact *MessageContact) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageContact)
+}
+
+func (messageContact *MessageContact) printText(p *printer) {
+	if messageContact == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageContact")
+	p.object("contact", messageContact.Contact)
+	p.endObject(state)
+}
+
+// GetMessageContentEnum return the enum type of this object
 func (messageContact *MessageContact) GetMessageContentEnum() MessageContentEnum {
 	return MessageContactType
 }
 
+// Accept calls the method of the visitor for MessageContact
+func (messageContact *MessageContact) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageContact(messageContact)
+}
+
 // MessageAnimatedEmoji A message with an animated emoji
 type MessageAnimatedEmoji struct {
 	tdCommon
@@ -791,11 +2650,137 @@ func NewMessageAnimatedEmoji(animatedEmoji *AnimatedEmoji, emoji string) *Messag
 	return &messageAnimatedEmojiTemp
 }
 
+// MarshalJSON marshals to json
+func (messageAnimatedEmoji *MessageAnimatedEmoji) MarshalJSON() ([]byte, error) {
+	return messageAnimatedEmoji.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageAnimatedEmoji *MessageAnimatedEmoji) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageAnimatedEmoji)
+}
+
+func (messageAnimatedEmoji *MessageAnimatedEmoji) appendJSON(b []byte) ([]byte, error) {
+	if messageAnimatedEmoji == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageAnimatedEmoji.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageAnimatedEmoji.tdCommon.Extra)
+	b = append(b, `,"animated_emoji":`...)
+	b, err = messageAnimatedEmoji.AnimatedEmoji.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"emoji":`...)
+	b = appendJSONString(b, messageAnimatedEmoji.Emoji)
+	return append(b, '}'), nil
+}
+
+func (messageAnimatedEmoji *MessageAnimatedEmoji) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageAnimatedEmoji.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageAnimatedEmoji.tdCommon.Extra, err = r.readString()
+		case "animated_emoji":
+			if r.readNull() {
+				messageAnimatedEmoji.AnimatedEmoji = nil
+			} else {
+				messageAnimatedEmoji.AnimatedEmoji = new(AnimatedEmoji)
+				err = messageAnimatedEmoji.AnimatedEmoji.decodeJSON(r)
+			}
+		case "emoji":
+			messageAnimatedEmoji.Emoji, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageAnimatedEmoji *MessageAnimatedEmoji) Clone() *MessageAnimatedEmoji {
+	if messageAnimatedEmoji == nil {
+		return nil
+	}
+	clone := *messageAnimatedEmoji
+	clone.AnimatedEmoji = messageAnimatedEmoji.AnimatedEmoji.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageAnimatedEmoji *MessageAnimatedEmoji) Equal(other *MessageAnimatedEmoji) bool {
+	if messageAnimatedEmoji == nil || other == nil {
+		return messageAnimatedEmoji == other
+	}
+	if !messageAnimatedEmoji.AnimatedEmoji.Equal(other.AnimatedEmoji) {
+		return false
+	}
+	if messageAnimatedEmoji.Emoji != other.Emoji {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageAnimatedEmoji *MessageAnimatedEmoji) Diff(other *MessageAnimatedEmoji) []Change {
+	return messageAnimatedEmoji.diff("", other, nil)
+}
+
+func (messageAnimatedEmoji *MessageAnimatedEmoji) diff(path string, other *MessageAnimatedEmoji, changes []Change) []Change {
+	if messageAnimatedEmoji == nil || other == nil {
+		if messageAnimatedEmoji != other {
+			changes = append(changes, Change{Path: path, Old: messageAnimatedEmoji, New: other})
+		}
+		return changes
+	}
+	changes = messageAnimatedEmoji.AnimatedEmoji.diff(fieldPath(path, "animated_emoji"), other.AnimatedEmoji, changes)
+	if messageAnimatedEmoji.Emoji != other.Emoji {
+		changes = append(changes, Change{Path: fieldPath(path, "emoji"), Old: messageAnimatedEmoji.Emoji, New: other.Emoji})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageAnimatedEmoji *MessageAnimatedEmoji) String() string {
+	return objectString(messageAnimatedEmoji)
+}
+
+// LogValue logs the object as its summary
+func (messageAnimatedEmoji *MessageAnimatedEmoji) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageAnimatedEmoji))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageAnimatedEmoji *MessageAnimatedEmoji) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageAnimatedEmoji)
+}
+
+func (messageAnimatedEmoji *MessageAnimatedEmoji) printText(p *printer) {
+	if messageAnimatedEmoji == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageAnimatedEmoji")
+	p.object("animated_emoji", messageAnimatedEmoji.AnimatedEmoji)
+	p.string("emoji", messageAnimatedEmoji.Emoji)
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageAnimatedEmoji *MessageAnimatedEmoji) GetMessageContentEnum() MessageContentEnum {
 	return MessageAnimatedEmojiType
 }
 
+// Accept calls the method of the visitor for MessageAnimatedEmoji
+func (messageAnimatedEmoji *MessageAnimatedEmoji) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageAnimatedEmoji(messageAnimatedEmoji)
+}
+
 // MessageDice A dice message. The dice value is randomly generated by the server
 type MessageDice struct {
 	tdCommon
@@ -831,36 +2816,155 @@ func NewMessageDice(initialState DiceStickers, finalState DiceStickers, emoji st
 	return &messageDiceTemp
 }
 
+// MarshalJSON marshals to json
+func (messageDice *MessageDice) MarshalJSON() ([]byte, error) {
+	return messageDice.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (messageDice *MessageDice) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, messageDice)
+}
+
+func (messageDice *MessageDice) appendJSON(b []byte) ([]byte, error) {
+	if messageDice == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageDice.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageDice.tdCommon.Extra)
+	b = append(b, `,"initial_state":`...)
+	b, err = appendJSONValue(b, messageDice.InitialState)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Emoji                       string `json:"emoji"`                          // Emoji on which the dice throw animation is based
-		Value                       int32  `json:"value"`                          // The dice value. If the value is 0, the dice don't have final state yet
-		SuccessAnimationFrameNumber int32  `json:"success_animation_frame_number"` // Number of frame after which a success animation like a shower of confetti needs to be shown on updateMessageSendSucceeded
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"final_state":`...)
+	b, err = appendJSONValue(b, messageDice.FinalState)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"emoji":`...)
+	b = appendJSONString(b, messageDice.Emoji)
+	b = append(b, `,"value":`...)
+	b = appendJSONInt(b, int64(messageDice.Value))
+	b = append(b, `,"success_animation_frame_number":`...)
+	b = appendJSONInt(b, int64(messageDice.SuccessAnimationFrameNumber))
+	return append(b, '}'), nil
+}
+
+func (messageDice *MessageDice) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageDice.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageDice.tdCommon.Extra, err = r.readString()
+		case "initial_state":
+			messageDice.InitialState, err = decodeDiceStickers(r)
+		case "final_state":
+			messageDice.FinalState, err = decodeDiceStickers(r)
+		case "emoji":
+			messageDice.Emoji, err = r.readString()
+		case "value":
+			messageDice.Value, err = r.readInt32()
+		case "success_animation_frame_number":
+			messageDice.SuccessAnimationFrameNumber, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageDice *MessageDice) Clone() *MessageDice {
+	if messageDice == nil {
+		return nil
+	}
+	clone := *messageDice
+	clone.InitialState = cloneDiceStickers(messageDice.InitialState)
+	clone.FinalState = cloneDiceStickers(messageDice.FinalState)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageDice *MessageDice) Equal(other *MessageDice) bool {
+	if messageDice == nil || other == nil {
+		return messageDice == other
+	}
+	if !equalDiceStickers(messageDice.InitialState, other.InitialState) {
+		return false
 	}
+	if !equalDiceStickers(messageDice.FinalState, other.FinalState) {
+		return false
+	}
+	if messageDice.Emoji != other.Emoji {
+		return false
+	}
+	if messageDice.Value != other.Value {
+		return false
+	}
+	if messageDice.SuccessAnimationFrameNumber != other.SuccessAnimationFrameNumber {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageDice *MessageDice) Diff(other *MessageDice) []Change {
+	return messageDice.diff("", other, nil)
+}
+
+func (messageDice *MessageDice) diff(path string, other *MessageDice, changes []Change) []Change {
+	if messageDice == nil || other == nil {
+		if messageDice != other {
+			changes = append(changes, Change{Path: path, Old: messageDice, New: other})
+		}
+		return changes
+	}
+	changes = diffDiceStickers(fieldPath(path, "initial_state"), messageDice.InitialState, other.InitialState, changes)
+	changes = diffDiceStickers(fieldPath(path, "final_state"), messageDice.FinalState, other.FinalState, changes)
+	if messageDice.Emoji != other.Emoji {
+		changes = append(changes, Change{Path: fieldPath(path, "emoji"), Old: messageDice.Emoji, New: other.Emoji})
+	}
+	if messageDice.Value != other.Value {
+		changes = append(changes, Change{Path: fieldPath(path, "value"), Old: messageDice.Value, New: other.Value})
+	}
+	if messageDice.SuccessAnimationFrameNumber != other.SuccessAnimationFrameNumber {
+		changes = append(changes, Change{Path: fieldPath(path, "success_animation_frame_number"), Old: messageDice.SuccessAnimationFrameNumber, New: other.SuccessAnimationFrameNumber})
+	}
+	return changes
+}
 
-	messageDice.tdCommon = tempObj.tdCommon
-	messageDice.Emoji = tempObj.Emoji
-	messageDice.Value = tempObj.Value
-	messageDice.SuccessAnimationFrameNumber = tempObj.SuccessAnimationFrameNumber
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageDice *MessageDice) String() string {
+	return objectString(messageDice)
+}
 
-	fieldInitialState, _ := unmarshalDiceStickers(objMap["initial_state"])
-	messageDice.InitialState = fieldInitialState
+// LogValue logs the object as its summary
+func (messageDice *MessageDice) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageDice))
+}
 
-	fieldFinalState, _ := unmarshalDiceStickers(objMap["final_state"])
-	messageDice.FinalState = fieldFinalState
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageDice *MessageDice) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageDice)
+}
 
-	return nil
+func (messageDice *MessageDice) printText(p *printer) {
+	if messageDice == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageDice")
+	p.object("initial_state", messageDice.InitialState)
+	p.object("final_state", messageDice.FinalState)
+	p.string("emoji", messageDice.Emoji)
+	p.int("value", int64(messageDice.Value))
+	p.int("success_animation_frame_number", int64(messageDice.SuccessAnimationFrameNumber))
+	p.endObject(state)
 }
 
 // GetMessageContentEnum return the enum type of this object
@@ -868,6 +2972,11 @@ func (messageDice *MessageDice) GetMessageContentEnum() MessageContentEnum {
 	return MessageDiceType
 }
 
+// Accept calls the method of the visitor for MessageDice
+func (messageDice *MessageDice) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageDice(messageDice)
+}
+
 // MessageGame A message with a game
 type MessageGame struct {
 	tdCommon
@@ -891,11 +3000,126 @@ func NewMessageGame(game *Game) *MessageGame {
 	return &messageGameTemp
 }
 
+// MarshalJSON marshals to json
+func (messageGame *MessageGame) MarshalJSON() ([]byte, error) {
+	return messageGame.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageGame *MessageGame) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageGame)
+}
+
+func (messageGame *MessageGame) appendJSON(b []byte) ([]byte, error) {
+	if messageGame == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageGame.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageGame.tdCommon.Extra)
+	b = append(b, `,"game":`...)
+	b, err = messageGame.Game.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (messageGame *MessageGame) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageGame.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageGame.tdCommon.Extra, err = r.readString()
+		case "game":
+			if r.readNull() {
+				messageGame.Game = nil
+			} else {
+				messageGame.Game = new(Game)
+				err = messageGame.Game.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageGame *MessageGame) Clone() *MessageGame {
+	if messageGame == nil {
+		return nil
+	}
+	clone := *messageGame
+	clone.Game = messageGame.Game.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageGame *MessageGame) Equal(other *MessageGame) bool {
+	if messageGame == nil || other == nil {
+		return messageGame == other
+	}
+	if !messageGame.Game.Equal(other.Game) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageGame *MessageGame) Diff(other *MessageGame) []Change {
+	return messageGame.diff("", other, nil)
+}
+
+func (messageGame *MessageGame) diff(path string, other *MessageGame, changes []Change) []Change {
+	if messageGame == nil || other == nil {
+		if messageGame != other {
+			changes = append(changes, Change{Path: path, Old: messageGame, New: other})
+		}
+		return changes
+	}
+	changes = messageGame.Game.diff(fieldPath(path, "game"), other.Game, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageGame *MessageGame) String() string {
+	return objectString(messageGame)
+}
+
+// LogValue logs the object as its summary
+func (messageGame *MessageGame) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageGame))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageGame *MessageGame) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageGame)
+}
+
+func (messageGame *MessageGame) printText(p *printer) {
+	if messageGame == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageGame")
+	p.object("game", messageGame.Game)
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageGame *MessageGame) GetMessageContentEnum() MessageContentEnum {
 	return MessageGameType
 }
 
+// Accept calls the method of the visitor for MessageGame
+func (messageGame *MessageGame) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageGame(messageGame)
+}
+
 // MessagePoll A message with a poll
 type MessagePoll struct {
 	tdCommon
@@ -919,11 +3143,126 @@ func NewMessagePoll(poll *Poll) *MessagePoll {
 	return &messagePollTemp
 }
 
+// MarshalJSON marshals to json
+func (messagePoll *MessagePoll) MarshalJSON() ([]byte, error) {
+	return messagePoll.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messagePoll *MessagePoll) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messagePoll)
+}
+
+func (messagePoll *MessagePoll) appendJSON(b []byte) ([]byte, error) {
+	if messagePoll == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messagePoll.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messagePoll.tdCommon.Extra)
+	b = append(b, `,"poll":`...)
+	b, err = messagePoll.Poll.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (messagePoll *MessagePoll) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messagePoll.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messagePoll.tdCommon.Extra, err = r.readString()
+		case "poll":
+			if r.readNull() {
+				messagePoll.Poll = nil
+			} else {
+				messagePoll.Poll = new(Poll)
+				err = messagePoll.Poll.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messagePoll *MessagePoll) Clone() *MessagePoll {
+	if messagePoll == nil {
+		return nil
+	}
+	clone := *messagePoll
+	clone.Poll = messagePoll.Poll.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messagePoll *MessagePoll) Equal(other *MessagePoll) bool {
+	if messagePoll == nil || other == nil {
+		return messagePoll == other
+	}
+	if !messagePoll.Poll.Equal(other.Poll) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messagePoll *MessagePoll) Diff(other *MessagePoll) []Change {
+	return messagePoll.diff("", other, nil)
+}
+
+func (messagePoll *MessagePoll) diff(path string, other *MessagePoll, changes []Change) []Change {
+	if messagePoll == nil || other == nil {
+		if messagePoll != other {
+			changes = append(changes, Change{Path: path, Old: messagePoll, New: other})
+		}
+		return changes
+	}
+	changes = messagePoll.Poll.diff(fieldPath(path, "poll"), other.Poll, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messagePoll *MessagePoll) String() string {
+	return objectString(messagePoll)
+}
+
+// LogValue logs the object as its summary
+func (messagePoll *MessagePoll) LogValue() slog.Value {
+	return slog.StringValue(objectString(messagePoll))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messagePoll *MessagePoll) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messagePoll)
+}
+
+func (messagePoll *MessagePoll) printText(p *printer) {
+	if messagePoll == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessagePoll")
+	p.object("poll", messagePoll.Poll)
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messagePoll *MessagePoll) GetMessageContentEnum() MessageContentEnum {
 	return MessagePollType
 }
 
+// Accept calls the method of the visitor for MessagePoll
+func (messagePoll *MessagePoll) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessagePoll(messagePoll)
+}
+
 // MessageInvoice A message with an invoice from a bot
 type MessageInvoice struct {
 	tdCommon
@@ -971,11 +3310,214 @@ func NewMessageInvoice(title string, description string, photo *Photo, currency
 	return &messageInvoiceTemp
 }
 
+// MarshalJSON marshals to json
+func (messageInvoice *MessageInvoice) MarshalJSON() ([]byte, error) {
+	return messageInvoice.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageInvoice *MessageInvoice) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageInvoice)
+}
+
+func (messageInvoice *MessageInvoice) appendJSON(b []byte) ([]byte, error) {
+	if messageInvoice == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageInvoice.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageInvoice.tdCommon.Extra)
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, messageInvoice.Title)
+	b = append(b, `,"description":`...)
+	b = appendJSONString(b, messageInvoice.Description)
+	b = append(b, `,"photo":`...)
+	b, err = messageInvoice.Photo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"currency":`...)
+	b = appendJSONString(b, messageInvoice.Currency)
+	b = append(b, `,"total_amount":`...)
+	b = appendJSONInt(b, int64(messageInvoice.TotalAmount))
+	b = append(b, `,"start_parameter":`...)
+	b = appendJSONString(b, messageInvoice.StartParameter)
+	b = append(b, `,"is_test":`...)
+	b = appendJSONBool(b, messageInvoice.IsTest)
+	b = append(b, `,"need_shipping_address":`...)
+	b = appendJSONBool(b, messageInvoice.NeedShippingAddress)
+	b = append(b, `,"receipt_message_id":`...)
+	b = appendJSONInt(b, int64(messageInvoice.ReceiptMessageId))
+	return append(b, '}'), nil
+}
+
+func (messageInvoice *MessageInvoice) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageInvoice.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageInvoice.tdCommon.Extra, err = r.readString()
+		case "title":
+			messageInvoice.Title, err = r.readString()
+		case "description":
+			messageInvoice.Description, err = r.readString()
+		case "photo":
+			if r.readNull() {
+				messageInvoice.Photo = nil
+			} else {
+				messageInvoice.Photo = new(Photo)
+				err = messageInvoice.Photo.decodeJSON(r)
+			}
+		case "currency":
+			messageInvoice.Currency, err = r.readString()
+		case "total_amount":
+			messageInvoice.TotalAmount, err = r.readInt64()
+		case "start_parameter":
+			messageInvoice.StartParameter, err = r.readString()
+		case "is_test":
+			messageInvoice.IsTest, err = r.readBool()
+		case "need_shipping_address":
+			messageInvoice.NeedShippingAddress, err = r.readBool()
+		case "receipt_message_id":
+			messageInvoice.ReceiptMessageId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageInvoice *MessageInvoice) Clone() *MessageInvoice {
+	if messageInvoice == nil {
+		return nil
+	}
+	clone := *messageInvoice
+	clone.Photo = messageInvoice.Photo.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageInvoice *MessageInvoice) Equal(other *MessageInvoice) bool {
+	if messageInvoice == nil || other == nil {
+		return messageInvoice == other
+	}
+	if messageInvoice.Title != other.Title {
+		return false
+	}
+	if messageInvoice.Description != other.Description {
+		return false
+	}
+	if !messageInvoice.Photo.Equal(other.Photo) {
+		return false
+	}
+	if messageInvoice.Currency != other.Currency {
+		return false
+	}
+	if messageInvoice.TotalAmount != other.TotalAmount {
+		return false
+	}
+	if messageInvoice.StartParameter != other.StartParameter {
+		return false
+	}
+	if messageInvoice.IsTest != other.IsTest {
+		return false
+	}
+	if messageInvoice.NeedShippingAddress != other.NeedShippingAddress {
+		return false
+	}
+	if messageInvoice.ReceiptMessageId != other.ReceiptMessageId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageInvoice *MessageInvoice) Diff(other *MessageInvoice) []Change {
+	return messageInvoice.diff("", other, nil)
+}
+
+func (messageInvoice *MessageInvoice) diff(path string, other *MessageInvoice, changes []Change) []Change {
+	if messageInvoice == nil || other == nil {
+		if messageInvoice != other {
+			changes = append(changes, Change{Path: path, Old: messageInvoice, New: other})
+		}
+		return changes
+	}
+	if messageInvoice.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: messageInvoice.Title, New: other.Title})
+	}
+	if messageInvoice.Description != other.Description {
+		changes = append(changes, Change{Path: fieldPath(path, "description"), Old: messageInvoice.Description, New: other.Description})
+	}
+	changes = messageInvoice.Photo.diff(fieldPath(path, "photo"), other.Photo, changes)
+	if messageInvoice.Currency != other.Currency {
+		changes = append(changes, Change{Path: fieldPath(path, "currency"), Old: messageInvoice.Currency, New: other.Currency})
+	}
+	if messageInvoice.TotalAmount != other.TotalAmount {
+		changes = append(changes, Change{Path: fieldPath(path, "total_amount"), Old: messageInvoice.TotalAmount, New: other.TotalAmount})
+	}
+	if messageInvoice.StartParameter != other.StartParameter {
+		changes = append(changes, Change{Path: fieldPath(path, "start_parameter"), Old: messageInvoice.StartParameter, New: other.StartParameter})
+	}
+	if messageInvoice.IsTest != other.IsTest {
+		changes = append(changes, Change{Path: fieldPath(path, "is_test"), Old: messageInvoice.IsTest, New: other.IsTest})
+	}
+	if messageInvoice.NeedShippingAddress != other.NeedShippingAddress {
+		changes = append(changes, Change{Path: fieldPath(path, "need_shipping_address"), Old: messageInvoice.NeedShippingAddress, New: other.NeedShippingAddress})
+	}
+	if messageInvoice.ReceiptMessageId != other.ReceiptMessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "receipt_message_id"), Old: messageInvoice.ReceiptMessageId, New: other.ReceiptMessageId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageInvoice *MessageInvoice) String() string {
+	return objectString(messageInvoice)
+}
+
+// LogValue logs the object as its summary
+func (messageInvoice *MessageInvoice) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageInvoice))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageInvoice *MessageInvoice) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageInvoice)
+}
+
+func (messageInvoice *MessageInvoice) printText(p *printer) {
+	if messageInvoice == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageInvoice")
+	p.string("title", messageInvoice.Title)
+	p.string("description", messageInvoice.Description)
+	p.object("photo", messageInvoice.Photo)
+	p.string("currency", messageInvoice.Currency)
+	p.int("total_amount", int64(messageInvoice.TotalAmount))
+	p.string("start_parameter", messageInvoice.StartParameter)
+	p.bool("is_test", messageInvoice.IsTest)
+	p.bool("need_shipping_address", messageInvoice.NeedShippingAddress)
+	p.int("receipt_message_id", int64(messageInvoice.ReceiptMessageId))
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageInvoice *MessageInvoice) GetMessageContentEnum() MessageContentEnum {
 	return MessageInvoiceType
 }
 
+// Accept calls the method of the visitor for MessageInvoice
+func (messageInvoice *MessageInvoice) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageInvoice(messageInvoice)
+}
+
 // MessageCall A message with information about an ended call
 type MessageCall struct {
 	tdCommon
@@ -1005,31 +3547,131 @@ func NewMessageCall(isVideo bool, discardReason CallDiscardReason, duration int3
 	return &messageCallTemp
 }
 
+// MarshalJSON marshals to json
+func (messageCall *MessageCall) MarshalJSON() ([]byte, error) {
+	return messageCall.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (messageCall *MessageCall) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		IsVideo  bool  `json:"is_video"` // True, if the call was a video call
-		Duration int32 `json:"duration"` // Call duration, in seconds
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	return unmarshalFast(b, messageCall)
+}
+
+func (messageCall *MessageCall) appendJSON(b []byte) ([]byte, error) {
+	if messageCall == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageCall.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageCall.tdCommon.Extra)
+	b = append(b, `,"is_video":`...)
+	b = appendJSONBool(b, messageCall.IsVideo)
+	b = append(b, `,"discard_reason":`...)
+	b, err = appendJSONValue(b, messageCall.DiscardReason)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"duration":`...)
+	b = appendJSONInt(b, int64(messageCall.Duration))
+	return append(b, '}'), nil
+}
+
+func (messageCall *MessageCall) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageCall.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageCall.tdCommon.Extra, err = r.readString()
+		case "is_video":
+			messageCall.IsVideo, err = r.readBool()
+		case "discard_reason":
+			messageCall.DiscardReason, err = decodeCallDiscardReason(r)
+		case "duration":
+			messageCall.Duration, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageCall *MessageCall) Clone() *MessageCall {
+	if messageCall == nil {
+		return nil
+	}
+	clone := *messageCall
+	clone.DiscardReason = cloneCallDiscardReason(messageCall.DiscardReason)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageCall *MessageCall) Equal(other *MessageCall) bool {
+	if messageCall == nil || other == nil {
+		return messageCall == other
+	}
+	if messageCall.IsVideo != other.IsVideo {
+		return false
+	}
+	if !equalCallDiscardReason(messageCall.DiscardReason, other.DiscardReason) {
+		return false
 	}
+	if messageCall.Duration != other.Duration {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageCall *MessageCall) Diff(other *MessageCall) []Change {
+	return messageCall.diff("", other, nil)
+}
+
+func (messageCall *MessageCall) diff(path string, other *MessageCall, changes []Change) []Change {
+	if messageCall == nil || other == nil {
+		if messageCall != other {
+			changes = append(changes, Change{Path: path, Old: messageCall, New: other})
+		}
+		return changes
+	}
+	if messageCall.IsVideo != other.IsVideo {
+		changes = append(changes, Change{Path: fieldPath(path, "is_video"), Old: messageCall.IsVideo, New: other.IsVideo})
+	}
+	changes = diffCallDiscardReason(fieldPath(path, "discard_reason"), messageCall.DiscardReason, other.DiscardReason, changes)
+	if messageCall.Duration != other.Duration {
+		changes = append(changes, Change{Path: fieldPath(path, "duration"), Old: messageCall.Duration, New: other.Duration})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageCall *MessageCall) String() string {
+	return objectString(messageCall)
+}
 
-	messageCall.tdCommon = tempObj.tdCommon
-	messageCall.IsVideo = tempObj.IsVideo
-	messageCall.Duration = tempObj.Duration
+// LogValue logs the object as its summary
+func (messageCall *MessageCall) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageCall))
+}
 
-	fieldDiscardReason, _ := unmarshalCallDiscardReason(objMap["discard_reason"])
-	messageCall.DiscardReason = fieldDiscardReason
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageCall *MessageCall) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageCall)
+}
 
-	return nil
+func (messageCall *MessageCall) printText(p *printer) {
+	if messageCall == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageCall")
+	p.bool("is_video", messageCall.IsVideo)
+	p.object("discard_reason", messageCall.DiscardReason)
+	p.int("duration", int64(messageCall.Duration))
+	p.endObject(state)
 }
 
 // GetMessageContentEnum return the enum type of this object
@@ -1037,6 +3679,11 @@ func (messageCall *MessageCall) GetMessageContentEnum() MessageContentEnum {
 	return MessageCallType
 }
 
+// Accept calls the method of the visitor for MessageCall
+func (messageCall *MessageCall) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageCall(messageCall)
+}
+
 // MessageVideoChatScheduled A new video chat was scheduled
 type MessageVideoChatScheduled struct {
 	tdCommon
@@ -1063,25 +3710,143 @@ func NewMessageVideoChatScheduled(groupCallId int32, startDate int32) *MessageVi
 	return &messageVideoChatScheduledTemp
 }
 
-// GetMessageContentEnum return the enum type of this object
-func (messageVideoChatScheduled *MessageVideoChatScheduled) GetMessageContentEnum() MessageContentEnum {
-	return MessageVideoChatScheduledType
+// MarshalJSON marshals to json
+func (messageVideoChatScheduled *MessageVideoChatScheduled) MarshalJSON() ([]byte, error) {
+	return messageVideoChatScheduled.appendJSON(nil)
 }
 
-// MessageVideoChatStarted A newly created video chat
-type MessageVideoChatStarted struct {
-	tdCommon
-	GroupCallId int32 `json:"group_call_id"` // Identifier of the video chat. The video chat can be received through the method getGroupCall
+// UnmarshalJSON unmarshal to json
+func (messageVideoChatScheduled *MessageVideoChatScheduled) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageVideoChatScheduled)
 }
 
-// MessageType return the string telegram-type of MessageVideoChatStarted
-func (messageVideoChatStarted *MessageVideoChatStarted) MessageType() string {
-	return "messageVideoChatStarted"
+func (messageVideoChatScheduled *MessageVideoChatScheduled) appendJSON(b []byte) ([]byte, error) {
+	if messageVideoChatScheduled == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageVideoChatScheduled.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageVideoChatScheduled.tdCommon.Extra)
+	b = append(b, `,"group_call_id":`...)
+	b = appendJSONInt(b, int64(messageVideoChatScheduled.GroupCallId))
+	b = append(b, `,"start_date":`...)
+	b = appendJSONInt(b, int64(messageVideoChatScheduled.StartDate))
+	return append(b, '}'), nil
+}
+
+func (messageVideoChatScheduled *MessageVideoChatScheduled) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageVideoChatScheduled.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageVideoChatScheduled.tdCommon.Extra, err = r.readString()
+		case "group_call_id":
+			messageVideoChatScheduled.GroupCallId, err = r.readInt32()
+		case "start_date":
+			messageVideoChatScheduled.StartDate, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageVideoChatScheduled *MessageVideoChatScheduled) Clone() *MessageVideoChatScheduled {
+	if messageVideoChatScheduled == nil {
+		return nil
+	}
+	clone := *messageVideoChatScheduled
+	return &clone
 }
 
-// NewMessageVideoChatStarted creates a new MessageVideoChatStarted
-//
-// @param groupCallId Identifier of the video chat. The video chat can be received through the method getGroupCall
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageVideoChatScheduled *MessageVideoChatScheduled) Equal(other *MessageVideoChatScheduled) bool {
+	if messageVideoChatScheduled == nil || other == nil {
+		return messageVideoChatScheduled == other
+	}
+	if messageVideoChatScheduled.GroupCallId != other.GroupCallId {
+		return false
+	}
+	if messageVideoChatScheduled.StartDate != other.StartDate {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageVideoChatScheduled *MessageVideoChatScheduled) Diff(other *MessageVideoChatScheduled) []Change {
+	return messageVideoChatScheduled.diff("", other, nil)
+}
+
+func (messageVideoChatScheduled *MessageVideoChatScheduled) diff(path string, other *MessageVideoChatScheduled, changes []Change) []Change {
+	if messageVideoChatScheduled == nil || other == nil {
+		if messageVideoChatScheduled != other {
+			changes = append(changes, Change{Path: path, Old: messageVideoChatScheduled, New: other})
+		}
+		return changes
+	}
+	if messageVideoChatScheduled.GroupCallId != other.GroupCallId {
+		changes = append(changes, Change{Path: fieldPath(path, "group_call_id"), Old: messageVideoChatScheduled.GroupCallId, New: other.GroupCallId})
+	}
+	if messageVideoChatScheduled.StartDate != other.StartDate {
+		changes = append(changes, Change{Path: fieldPath(path, "start_date"), Old: messageVideoChatScheduled.StartDate, New: other.StartDate})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageVideoChatScheduled *MessageVideoChatScheduled) String() string {
+	return objectString(messageVideoChatScheduled)
+}
+
+// LogValue logs the object as its summary
+func (messageVideoChatScheduled *MessageVideoChatScheduled) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageVideoChatScheduled))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageVideoChatScheduled *MessageVideoChatScheduled) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageVideoChatScheduled)
+}
+
+func (messageVideoChatScheduled *MessageVideoChatScheduled) printText(p *printer) {
+	if messageVideoChatScheduled == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageVideoChatScheduled")
+	p.int("group_call_id", int64(messageVideoChatScheduled.GroupCallId))
+	p.int("start_date", int64(messageVideoChatScheduled.StartDate))
+	p.endObject(state)
+}
+
+// GetMessageContentEnum return the enum type of this object
+func (messageVideoChatScheduled *MessageVideoChatScheduled) GetMessageContentEnum() MessageContentEnum {
+	return MessageVideoChatScheduledType
+}
+
+// Accept calls the method of the visitor for MessageVideoChatScheduled
+func (messageVideoChatScheduled *MessageVideoChatScheduled) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageVideoChatScheduled(messageVideoChatScheduled)
+}
+
+// MessageVideoChatStarted A newly created video chat
+type MessageVideoChatStarted struct {
+	tdCommon
+	GroupCallId int32 `json:"group_call_id"` // Identifier of the video chat. The video chat can be received through the method getGroupCall
+}
+
+// MessageType return the string telegram-type of MessageVideoChatStarted
+func (messageVideoChatStarted *MessageVideoChatStarted) MessageType() string {
+	return "messageVideoChatStarted"
+}
+
+// NewMessageVideoChatStarted creates a new MessageVideoChatStarted
+//
+// @param groupCallId Identifier of the video chat. The video chat can be received through the method getGroupCall
 func NewMessageVideoChatStarted(groupCallId int32) *MessageVideoChatStarted {
 	messageVideoChatStartedTemp := MessageVideoChatStarted{
 		tdCommon:    tdCommon{Type: "messageVideoChatStarted"},
@@ -1091,11 +3856,118 @@ func NewMessageVideoChatStarted(groupCallId int32) *MessageVideoChatStarted {
 	return &messageVideoChatStartedTemp
 }
 
+// MarshalJSON marshals to json
+func (messageVideoChatStarted *MessageVideoChatStarted) MarshalJSON() ([]byte, error) {
+	return messageVideoChatStarted.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageVideoChatStarted *MessageVideoChatStarted) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageVideoChatStarted)
+}
+
+func (messageVideoChatStarted *MessageVideoChatStarted) appendJSON(b []byte) ([]byte, error) {
+	if messageVideoChatStarted == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageVideoChatStarted.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageVideoChatStarted.tdCommon.Extra)
+	b = append(b, `,"group_call_id":`...)
+	b = appendJSONInt(b, int64(messageVideoChatStarted.GroupCallId))
+	return append(b, '}'), nil
+}
+
+func (messageVideoChatStarted *MessageVideoChatStarted) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageVideoChatStarted.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageVideoChatStarted.tdCommon.Extra, err = r.readString()
+		case "group_call_id":
+			messageVideoChatStarted.GroupCallId, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageVideoChatStarted *MessageVideoChatStarted) Clone() *MessageVideoChatStarted {
+	if messageVideoChatStarted == nil {
+		return nil
+	}
+	clone := *messageVideoChatStarted
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageVideoChatStarted *MessageVideoChatStarted) Equal(other *MessageVideoChatStarted) bool {
+	if messageVideoChatStarted == nil || other == nil {
+		return messageVideoChatStarted == other
+	}
+	if messageVideoChatStarted.GroupCallId != other.GroupCallId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageVideoChatStarted *MessageVideoChatStarted) Diff(other *MessageVideoChatStarted) []Change {
+	return messageVideoChatStarted.diff("", other, nil)
+}
+
+func (messageVideoChatStarted *MessageVideoChatStarted) diff(path string, other *MessageVideoChatStarted, changes []Change) []Change {
+	if messageVideoChatStarted == nil || other == nil {
+		if messageVideoChatStarted != other {
+			changes = append(changes, Change{Path: path, Old: messageVideoChatStarted, New: other})
+		}
+		return changes
+	}
+	if messageVideoChatStarted.GroupCallId != other.GroupCallId {
+		changes = append(changes, Change{Path: fieldPath(path, "group_call_id"), Old: messageVideoChatStarted.GroupCallId, New: other.GroupCallId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageVideoChatStarted *MessageVideoChatStarted) String() string {
+	return objectString(messageVideoChatStarted)
+}
+
+// LogValue logs the object as its summary
+func (messageVideoChatStarted *MessageVideoChatStarted) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageVideoChatStarted))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageVideoChatStarted *MessageVideoChatStarted) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageVideoChatStarted)
+}
+
+func (messageVideoChatStarted *MessageVideoChatStarted) printText(p *printer) {
+	if messageVideoChatStarted == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageVideoChatStarted")
+	p.int("group_call_id", int64(messageVideoChatStarted.GroupCallId))
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageVideoChatStarted *MessageVideoChatStarted) GetMessageContentEnum() MessageContentEnum {
 	return MessageVideoChatStartedType
 }
 
+// Accept calls the method of the visitor for MessageVideoChatStarted
+func (messageVideoChatStarted *MessageVideoChatStarted) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageVideoChatStarted(messageVideoChatStarted)
+}
+
 // MessageVideoChatEnded A message with information about an ended video chat
 type MessageVideoChatEnded struct {
 	tdCommon
@@ -1119,11 +3991,118 @@ func NewMessageVideoChatEnded(duration int32) *MessageVideoChatEnded {
 	return &messageVideoChatEndedTemp
 }
 
+// MarshalJSON marshals to json
+func (messageVideoChatEnded *MessageVideoChatEnded) MarshalJSON() ([]byte, error) {
+	return messageVideoChatEnded.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageVideoChatEnded *MessageVideoChatEnded) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageVideoChatEnded)
+}
+
+func (messageVideoChatEnded *MessageVideoChatEnded) appendJSON(b []byte) ([]byte, error) {
+	if messageVideoChatEnded == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageVideoChatEnded.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageVideoChatEnded.tdCommon.Extra)
+	b = append(b, `,"duration":`...)
+	b = appendJSONInt(b, int64(messageVideoChatEnded.Duration))
+	return append(b, '}'), nil
+}
+
+func (messageVideoChatEnded *MessageVideoChatEnded) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageVideoChatEnded.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageVideoChatEnded.tdCommon.Extra, err = r.readString()
+		case "duration":
+			messageVideoChatEnded.Duration, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageVideoChatEnded *MessageVideoChatEnded) Clone() *MessageVideoChatEnded {
+	if messageVideoChatEnded == nil {
+		return nil
+	}
+	clone := *messageVideoChatEnded
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageVideoChatEnded *MessageVideoChatEnded) Equal(other *MessageVideoChatEnded) bool {
+	if messageVideoChatEnded == nil || other == nil {
+		return messageVideoChatEnded == other
+	}
+	if messageVideoChatEnded.Duration != other.Duration {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageVideoChatEnded *MessageVideoChatEnded) Diff(other *MessageVideoChatEnded) []Change {
+	return messageVideoChatEnded.diff("", other, nil)
+}
+
+func (messageVideoChatEnded *MessageVideoChatEnded) diff(path string, other *MessageVideoChatEnded, changes []Change) []Change {
+	if messageVideoChatEnded == nil || other == nil {
+		if messageVideoChatEnded != other {
+			changes = append(changes, Change{Path: path, Old: messageVideoChatEnded, New: other})
+		}
+		return changes
+	}
+	if messageVideoChatEnded.Duration != other.Duration {
+		changes = append(changes, Change{Path: fieldPath(path, "duration"), Old: messageVideoChatEnded.Duration, New: other.Duration})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageVideoChatEnded *MessageVideoChatEnded) String() string {
+	return objectString(messageVideoChatEnded)
+}
+
+// LogValue logs the object as its summary
+func (messageVideoChatEnded *MessageVideoChatEnded) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageVideoChatEnded))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageVideoChatEnded *MessageVideoChatEnded) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageVideoChatEnded)
+}
+
+func (messageVideoChatEnded *MessageVideoChatEnded) printText(p *printer) {
+	if messageVideoChatEnded == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageVideoChatEnded")
+	p.int("duration", int64(messageVideoChatEnded.Duration))
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageVideoChatEnded *MessageVideoChatEnded) GetMessageContentEnum() MessageContentEnum {
 	return MessageVideoChatEndedType
 }
 
+// Accept calls the method of the visitor for MessageVideoChatEnded
+func (messageVideoChatEnded *MessageVideoChatEnded) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageVideoChatEnded(messageVideoChatEnded)
+}
+
 // MessageInviteVideoChatParticipants A message with information about an invite to a video chat
 type MessageInviteVideoChatParticipants struct {
 	tdCommon
@@ -1150,11 +4129,167 @@ func NewMessageInviteVideoChatParticipants(groupCallId int32, userIds []int64) *
 	return &messageInviteVideoChatParticipantsTemp
 }
 
+// MarshalJSON marshals to json
+func (messageInviteVideoChatParticipants *MessageInviteVideoChatParticipants) MarshalJSON() ([]byte, error) {
+	return messageInviteVideoChatParticipants.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageInviteVideoChatParticipants *MessageInviteVideoChatParticipants) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageInviteVideoChatParticipants)
+}
+
+func (messageInviteVideoChatParticipants *MessageInviteVideoChatParticipants) appendJSON(b []byte) ([]byte, error) {
+	if messageInviteVideoChatParticipants == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageInviteVideoChatParticipants.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageInviteVideoChatParticipants.tdCommon.Extra)
+	b = append(b, `,"group_call_id":`...)
+	b = appendJSONInt(b, int64(messageInviteVideoChatParticipants.GroupCallId))
+	b = append(b, `,"user_ids":`...)
+	if messageInviteVideoChatParticipants.UserIds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range messageInviteVideoChatParticipants.UserIds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(messageInviteVideoChatParticipants.UserIds[i0]))
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (messageInviteVideoChatParticipants *MessageInviteVideoChatParticipants) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageInviteVideoChatParticipants.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageInviteVideoChatParticipants.tdCommon.Extra, err = r.readString()
+		case "group_call_id":
+			messageInviteVideoChatParticipants.GroupCallId, err = r.readInt32()
+		case "user_ids":
+			if r.readNull() {
+				messageInviteVideoChatParticipants.UserIds = nil
+			} else {
+				messageInviteVideoChatParticipants.UserIds = make([]int64, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int64
+					item0, err = r.readInt64()
+					messageInviteVideoChatParticipants.UserIds = append(messageInviteVideoChatParticipants.UserIds, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageInviteVideoChatParticipants *MessageInviteVideoChatParticipants) Clone() *MessageInviteVideoChatParticipants {
+	if messageInviteVideoChatParticipants == nil {
+		return nil
+	}
+	clone := *messageInviteVideoChatParticipants
+	if messageInviteVideoChatParticipants.UserIds != nil {
+		clone.UserIds = make([]int64, len(messageInviteVideoChatParticipants.UserIds))
+		copy(clone.UserIds, messageInviteVideoChatParticipants.UserIds)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageInviteVideoChatParticipants *MessageInviteVideoChatParticipants) Equal(other *MessageInviteVideoChatParticipants) bool {
+	if messageInviteVideoChatParticipants == nil || other == nil {
+		return messageInviteVideoChatParticipants == other
+	}
+	if messageInviteVideoChatParticipants.GroupCallId != other.GroupCallId {
+		return false
+	}
+	if len(messageInviteVideoChatParticipants.UserIds) != len(other.UserIds) {
+		return false
+	}
+	for i0 := range messageInviteVideoChatParticipants.UserIds {
+		if messageInviteVideoChatParticipants.UserIds[i0] != other.UserIds[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageInviteVideoChatParticipants *MessageInviteVideoChatParticipants) Diff(other *MessageInviteVideoChatParticipants) []Change {
+	return messageInviteVideoChatParticipants.diff("", other, nil)
+}
+
+func (messageInviteVideoChatParticipants *MessageInviteVideoChatParticipants) diff(path string, other *MessageInviteVideoChatParticipants, changes []Change) []Change {
+	if messageInviteVideoChatParticipants == nil || other == nil {
+		if messageInviteVideoChatParticipants != other {
+			changes = append(changes, Change{Path: path, Old: messageInviteVideoChatParticipants, New: other})
+		}
+		return changes
+	}
+	if messageInviteVideoChatParticipants.GroupCallId != other.GroupCallId {
+		changes = append(changes, Change{Path: fieldPath(path, "group_call_id"), Old: messageInviteVideoChatParticipants.GroupCallId, New: other.GroupCallId})
+	}
+	if len(messageInviteVideoChatParticipants.UserIds) != len(other.UserIds) {
+		changes = append(changes, Change{Path: fieldPath(path, "user_ids"), Old: messageInviteVideoChatParticipants.UserIds, New: other.UserIds})
+	} else {
+		for i0 := range messageInviteVideoChatParticipants.UserIds {
+			if messageInviteVideoChatParticipants.UserIds[i0] != other.UserIds[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "user_ids"), i0), Old: messageInviteVideoChatParticipants.UserIds[i0], New: other.UserIds[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageInviteVideoChatParticipants *MessageInviteVideoChatParticipants) String() string {
+	return objectString(messageInviteVideoChatParticipants)
+}
+
+// LogValue logs the object as its summary
+func (messageInviteVideoChatParticipants *MessageInviteVideoChatParticipants) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageInviteVideoChatParticipants))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageInviteVideoChatParticipants *MessageInviteVideoChatParticipants) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageInviteVideoChatParticipants)
+}
+
+func (messageInviteVideoChatParticipants *MessageInviteVideoChatParticipants) printText(p *printer) {
+	if messageInviteVideoChatParticipants == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageInviteVideoChatParticipants")
+	p.int("group_call_id", int64(messageInviteVideoChatParticipants.GroupCallId))
+	p.vector("user_ids", len(messageInviteVideoChatParticipants.UserIds), func(i0 int) {
+		p.int("user_ids", int64(messageInviteVideoChatParticipants.UserIds[i0]))
+	})
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageInviteVideoChatParticipants *MessageInviteVideoChatParticipants) GetMessageContentEnum() MessageContentEnum {
 	return MessageInviteVideoChatParticipantsType
 }
 
+// Accept calls the method of the visitor for MessageInviteVideoChatParticipants
+func (messageInviteVideoChatParticipants *MessageInviteVideoChatParticipants) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageInviteVideoChatParticipants(messageInviteVideoChatParticipants)
+}
+
 // MessageBasicGroupChatCreate A newly created basic group
 type MessageBasicGroupChatCreate struct {
 	tdCommon
@@ -1181,11 +4316,167 @@ func NewMessageBasicGroupChatCreate(title string, memberUserIds []int64) *Messag
 	return &messageBasicGroupChatCreateTemp
 }
 
+// MarshalJSON marshals to json
+func (messageBasicGroupChatCreate *MessageBasicGroupChatCreate) MarshalJSON() ([]byte, error) {
+	return messageBasicGroupChatCreate.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageBasicGroupChatCreate *MessageBasicGroupChatCreate) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageBasicGroupChatCreate)
+}
+
+func (messageBasicGroupChatCreate *MessageBasicGroupChatCreate) appendJSON(b []byte) ([]byte, error) {
+	if messageBasicGroupChatCreate == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageBasicGroupChatCreate.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageBasicGroupChatCreate.tdCommon.Extra)
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, messageBasicGroupChatCreate.Title)
+	b = append(b, `,"member_user_ids":`...)
+	if messageBasicGroupChatCreate.MemberUserIds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range messageBasicGroupChatCreate.MemberUserIds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(messageBasicGroupChatCreate.MemberUserIds[i0]))
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (messageBasicGroupChatCreate *MessageBasicGroupChatCreate) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageBasicGroupChatCreate.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageBasicGroupChatCreate.tdCommon.Extra, err = r.readString()
+		case "title":
+			messageBasicGroupChatCreate.Title, err = r.readString()
+		case "member_user_ids":
+			if r.readNull() {
+				messageBasicGroupChatCreate.MemberUserIds = nil
+			} else {
+				messageBasicGroupChatCreate.MemberUserIds = make([]int64, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int64
+					item0, err = r.readInt64()
+					messageBasicGroupChatCreate.MemberUserIds = append(messageBasicGroupChatCreate.MemberUserIds, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageBasicGroupChatCreate *MessageBasicGroupChatCreate) Clone() *MessageBasicGroupChatCreate {
+	if messageBasicGroupChatCreate == nil {
+		return nil
+	}
+	clone := *messageBasicGroupChatCreate
+	if messageBasicGroupChatCreate.MemberUserIds != nil {
+		clone.MemberUserIds = make([]int64, len(messageBasicGroupChatCreate.MemberUserIds))
+		copy(clone.MemberUserIds, messageBasicGroupChatCreate.MemberUserIds)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageBasicGroupChatCreate *MessageBasicGroupChatCreate) Equal(other *MessageBasicGroupChatCreate) bool {
+	if messageBasicGroupChatCreate == nil || other == nil {
+		return messageBasicGroupChatCreate == other
+	}
+	if messageBasicGroupChatCreate.Title != other.Title {
+		return false
+	}
+	if len(messageBasicGroupChatCreate.MemberUserIds) != len(other.MemberUserIds) {
+		return false
+	}
+	for i0 := range messageBasicGroupChatCreate.MemberUserIds {
+		if messageBasicGroupChatCreate.MemberUserIds[i0] != other.MemberUserIds[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageBasicGroupChatCreate *MessageBasicGroupChatCreate) Diff(other *MessageBasicGroupChatCreate) []Change {
+	return messageBasicGroupChatCreate.diff("", other, nil)
+}
+
+func (messageBasicGroupChatCreate *MessageBasicGroupChatCreate) diff(path string, other *MessageBasicGroupChatCreate, changes []Change) []Change {
+	if messageBasicGroupChatCreate == nil || other == nil {
+		if messageBasicGroupChatCreate != other {
+			changes = append(changes, Change{Path: path, Old: messageBasicGroupChatCreate, New: other})
+		}
+		return changes
+	}
+	if messageBasicGroupChatCreate.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: messageBasicGroupChatCreate.Title, New: other.Title})
+	}
+	if len(messageBasicGroupChatCreate.MemberUserIds) != len(other.MemberUserIds) {
+		changes = append(changes, Change{Path: fieldPath(path, "member_user_ids"), Old: messageBasicGroupChatCreate.MemberUserIds, New: other.MemberUserIds})
+	} else {
+		for i0 := range messageBasicGroupChatCreate.MemberUserIds {
+			if messageBasicGroupChatCreate.MemberUserIds[i0] != other.MemberUserIds[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "member_user_ids"), i0), Old: messageBasicGroupChatCreate.MemberUserIds[i0], New: other.MemberUserIds[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageBasicGroupChatCreate *MessageBasicGroupChatCreate) String() string {
+	return objectString(messageBasicGroupChatCreate)
+}
+
+// LogValue logs the object as its summary
+func (messageBasicGroupChatCreate *MessageBasicGroupChatCreate) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageBasicGroupChatCreate))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageBasicGroupChatCreate *MessageBasicGroupChatCreate) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageBasicGroupChatCreate)
+}
+
+func (messageBasicGroupChatCreate *MessageBasicGroupChatCreate) printText(p *printer) {
+	if messageBasicGroupChatCreate == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageBasicGroupChatCreate")
+	p.string("title", messageBasicGroupChatCreate.Title)
+	p.vector("member_user_ids", len(messageBasicGroupChatCreate.MemberUserIds), func(i0 int) {
+		p.int("member_user_ids", int64(messageBasicGroupChatCreate.MemberUserIds[i0]))
+	})
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageBasicGroupChatCreate *MessageBasicGroupChatCreate) GetMessageContentEnum() MessageContentEnum {
 	return MessageBasicGroupChatCreateType
 }
 
+// Accept calls the method of the visitor for MessageBasicGroupChatCreate
+func (messageBasicGroupChatCreate *MessageBasicGroupChatCreate) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageBasicGroupChatCreate(messageBasicGroupChatCreate)
+}
+
 // MessageSupergroupChatCreate A newly created supergroup or channel
 type MessageSupergroupChatCreate struct {
 	tdCommon
@@ -1209,11 +4500,118 @@ func NewMessageSupergroupChatCreate(title string) *MessageSupergroupChatCreate {
 	return &messageSupergroupChatCreateTemp
 }
 
+// MarshalJSON marshals to json
+func (messageSupergroupChatCreate *MessageSupergroupChatCreate) MarshalJSON() ([]byte, error) {
+	return messageSupergroupChatCreate.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageSupergroupChatCreate *MessageSupergroupChatCreate) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageSupergroupChatCreate)
+}
+
+func (messageSupergroupChatCreate *MessageSupergroupChatCreate) appendJSON(b []byte) ([]byte, error) {
+	if messageSupergroupChatCreate == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageSupergroupChatCreate.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageSupergroupChatCreate.tdCommon.Extra)
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, messageSupergroupChatCreate.Title)
+	return append(b, '}'), nil
+}
+
+func (messageSupergroupChatCreate *MessageSupergroupChatCreate) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageSupergroupChatCreate.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageSupergroupChatCreate.tdCommon.Extra, err = r.readString()
+		case "title":
+			messageSupergroupChatCreate.Title, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageSupergroupChatCreate *MessageSupergroupChatCreate) Clone() *MessageSupergroupChatCreate {
+	if messageSupergroupChatCreate == nil {
+		return nil
+	}
+	clone := *messageSupergroupChatCreate
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageSupergroupChatCreate *MessageSupergroupChatCreate) Equal(other *MessageSupergroupChatCreate) bool {
+	if messageSupergroupChatCreate == nil || other == nil {
+		return messageSupergroupChatCreate == other
+	}
+	if messageSupergroupChatCreate.Title != other.Title {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageSupergroupChatCreate *MessageSupergroupChatCreate) Diff(other *MessageSupergroupChatCreate) []Change {
+	return messageSupergroupChatCreate.diff("", other, nil)
+}
+
+func (messageSupergroupChatCreate *MessageSupergroupChatCreate) diff(path string, other *MessageSupergroupChatCreate, changes []Change) []Change {
+	if messageSupergroupChatCreate == nil || other == nil {
+		if messageSupergroupChatCreate != other {
+			changes = append(changes, Change{Path: path, Old: messageSupergroupChatCreate, New: other})
+		}
+		return changes
+	}
+	if messageSupergroupChatCreate.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: messageSupergroupChatCreate.Title, New: other.Title})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageSupergroupChatCreate *MessageSupergroupChatCreate) String() string {
+	return objectString(messageSupergroupChatCreate)
+}
+
+// LogValue logs the object as its summary
+func (messageSupergroupChatCreate *MessageSupergroupChatCreate) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageSupergroupChatCreate))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageSupergroupChatCreate *MessageSupergroupChatCreate) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageSupergroupChatCreate)
+}
+
+func (messageSupergroupChatCreate *MessageSupergroupChatCreate) printText(p *printer) {
+	if messageSupergroupChatCreate == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageSupergroupChatCreate")
+	p.string("title", messageSupergroupChatCreate.Title)
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageSupergroupChatCreate *MessageSupergroupChatCreate) GetMessageContentEnum() MessageContentEnum {
 	return MessageSupergroupChatCreateType
 }
 
+// Accept calls the method of the visitor for MessageSupergroupChatCreate
+func (messageSupergroupChatCreate *MessageSupergroupChatCreate) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageSupergroupChatCreate(messageSupergroupChatCreate)
+}
+
 // MessageChatChangeTitle An updated chat title
 type MessageChatChangeTitle struct {
 	tdCommon
@@ -1237,11 +4635,118 @@ func NewMessageChatChangeTitle(title string) *MessageChatChangeTitle {
 	return &messageChatChangeTitleTemp
 }
 
+// MarshalJSON marshals to json
+func (messageChatChangeTitle *MessageChatChangeTitle) MarshalJSON() ([]byte, error) {
+	return messageChatChangeTitle.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageChatChangeTitle *MessageChatChangeTitle) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageChatChangeTitle)
+}
+
+func (messageChatChangeTitle *MessageChatChangeTitle) appendJSON(b []byte) ([]byte, error) {
+	if messageChatChangeTitle == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageChatChangeTitle.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageChatChangeTitle.tdCommon.Extra)
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, messageChatChangeTitle.Title)
+	return append(b, '}'), nil
+}
+
+func (messageChatChangeTitle *MessageChatChangeTitle) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageChatChangeTitle.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageChatChangeTitle.tdCommon.Extra, err = r.readString()
+		case "title":
+			messageChatChangeTitle.Title, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageChatChangeTitle *MessageChatChangeTitle) Clone() *MessageChatChangeTitle {
+	if messageChatChangeTitle == nil {
+		return nil
+	}
+	clone := *messageChatChangeTitle
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageChatChangeTitle *MessageChatChangeTitle) Equal(other *MessageChatChangeTitle) bool {
+	if messageChatChangeTitle == nil || other == nil {
+		return messageChatChangeTitle == other
+	}
+	if messageChatChangeTitle.Title != other.Title {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageChatChangeTitle *MessageChatChangeTitle) Diff(other *MessageChatChangeTitle) []Change {
+	return messageChatChangeTitle.diff("", other, nil)
+}
+
+func (messageChatChangeTitle *MessageChatChangeTitle) diff(path string, other *MessageChatChangeTitle, changes []Change) []Change {
+	if messageChatChangeTitle == nil || other == nil {
+		if messageChatChangeTitle != other {
+			changes = append(changes, Change{Path: path, Old: messageChatChangeTitle, New: other})
+		}
+		return changes
+	}
+	if messageChatChangeTitle.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: messageChatChangeTitle.Title, New: other.Title})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageChatChangeTitle *MessageChatChangeTitle) String() string {
+	return objectString(messageChatChangeTitle)
+}
+
+// LogValue logs the object as its summary
+func (messageChatChangeTitle *MessageChatChangeTitle) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageChatChangeTitle))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageChatChangeTitle *MessageChatChangeTitle) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageChatChangeTitle)
+}
+
+func (messageChatChangeTitle *MessageChatChangeTitle) printText(p *printer) {
+	if messageChatChangeTitle == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageChatChangeTitle")
+	p.string("title", messageChatChangeTitle.Title)
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageChatChangeTitle *MessageChatChangeTitle) GetMessageContentEnum() MessageContentEnum {
 	return MessageChatChangeTitleType
 }
 
+// Accept calls the method of the visitor for MessageChatChangeTitle
+func (messageChatChangeTitle *MessageChatChangeTitle) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageChatChangeTitle(messageChatChangeTitle)
+}
+
 // MessageChatChangePhoto An updated chat photo
 type MessageChatChangePhoto struct {
 	tdCommon
@@ -1265,11 +4770,126 @@ func NewMessageChatChangePhoto(photo *ChatPhoto) *MessageChatChangePhoto {
 	return &messageChatChangePhotoTemp
 }
 
+// MarshalJSON marshals to json
+func (messageChatChangePhoto *MessageChatChangePhoto) MarshalJSON() ([]byte, error) {
+	return messageChatChangePhoto.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageChatChangePhoto *MessageChatChangePhoto) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageChatChangePhoto)
+}
+
+func (messageChatChangePhoto *MessageChatChangePhoto) appendJSON(b []byte) ([]byte, error) {
+	if messageChatChangePhoto == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageChatChangePhoto.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageChatChangePhoto.tdCommon.Extra)
+	b = append(b, `,"photo":`...)
+	b, err = messageChatChangePhoto.Photo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (messageChatChangePhoto *MessageChatChangePhoto) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageChatChangePhoto.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageChatChangePhoto.tdCommon.Extra, err = r.readString()
+		case "photo":
+			if r.readNull() {
+				messageChatChangePhoto.Photo = nil
+			} else {
+				messageChatChangePhoto.Photo = new(ChatPhoto)
+				err = messageChatChangePhoto.Photo.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageChatChangePhoto *MessageChatChangePhoto) Clone() *MessageChatChangePhoto {
+	if messageChatChangePhoto == nil {
+		return nil
+	}
+	clone := *messageChatChangePhoto
+	clone.Photo = messageChatChangePhoto.Photo.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageChatChangePhoto *MessageChatChangePhoto) Equal(other *MessageChatChangePhoto) bool {
+	if messageChatChangePhoto == nil || other == nil {
+		return messageChatChangePhoto == other
+	}
+	if !messageChatChangePhoto.Photo.Equal(other.Photo) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageChatChangePhoto *MessageChatChangePhoto) Diff(other *MessageChatChangePhoto) []Change {
+	return messageChatChangePhoto.diff("", other, nil)
+}
+
+func (messageChatChangePhoto *MessageChatChangePhoto) diff(path string, other *MessageChatChangePhoto, changes []Change) []Change {
+	if messageChatChangePhoto == nil || other == nil {
+		if messageChatChangePhoto != other {
+			changes = append(changes, Change{Path: path, Old: messageChatChangePhoto, New: other})
+		}
+		return changes
+	}
+	changes = messageChatChangePhoto.Photo.diff(fieldPath(path, "photo"), other.Photo, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageChatChangePhoto *MessageChatChangePhoto) String() string {
+	return objectString(messageChatChangePhoto)
+}
+
+// LogValue logs the object as its summary
+func (messageChatChangePhoto *MessageChatChangePhoto) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageChatChangePhoto))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageChatChangePhoto *MessageChatChangePhoto) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageChatChangePhoto)
+}
+
+func (messageChatChangePhoto *MessageChatChangePhoto) printText(p *printer) {
+	if messageChatChangePhoto == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageChatChangePhoto")
+	p.object("photo", messageChatChangePhoto.Photo)
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageChatChangePhoto *MessageChatChangePhoto) GetMessageContentEnum() MessageContentEnum {
 	return MessageChatChangePhotoType
 }
 
+// Accept calls the method of the visitor for MessageChatChangePhoto
+func (messageChatChangePhoto *MessageChatChangePhoto) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageChatChangePhoto(messageChatChangePhoto)
+}
+
 // MessageChatDeletePhoto A deleted chat photo
 type MessageChatDeletePhoto struct {
 	tdCommon
@@ -1281,7 +4901,6 @@ func (messageChatDeletePhoto *MessageChatDeletePhoto) MessageType() string {
 }
 
 // NewMessageChatDeletePhoto creates a new MessageChatDeletePhoto
-//
 func NewMessageChatDeletePhoto() *MessageChatDeletePhoto {
 	messageChatDeletePhotoTemp := MessageChatDeletePhoto{
 		tdCommon: tdCommon{Type: "messageChatDeletePhoto"},
@@ -1290,11 +4909,107 @@ func NewMessageChatDeletePhoto() *MessageChatDeletePhoto {
 	return &messageChatDeletePhotoTemp
 }
 
+// MarshalJSON marshals to json
+func (messageChatDeletePhoto *MessageChatDeletePhoto) MarshalJSON() ([]byte, error) {
+	return messageChatDeletePhoto.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageChatDeletePhoto *MessageChatDeletePhoto) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageChatDeletePhoto)
+}
+
+func (messageChatDeletePhoto *MessageChatDeletePhoto) appendJSON(b []byte) ([]byte, error) {
+	if messageChatDeletePhoto == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageChatDeletePhoto.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageChatDeletePhoto.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (messageChatDeletePhoto *MessageChatDeletePhoto) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageChatDeletePhoto.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageChatDeletePhoto.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageChatDeletePhoto *MessageChatDeletePhoto) Clone() *MessageChatDeletePhoto {
+	if messageChatDeletePhoto == nil {
+		return nil
+	}
+	clone := *messageChatDeletePhoto
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageChatDeletePhoto *MessageChatDeletePhoto) Equal(other *MessageChatDeletePhoto) bool {
+	if messageChatDeletePhoto == nil || other == nil {
+		return messageChatDeletePhoto == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageChatDeletePhoto *MessageChatDeletePhoto) Diff(other *MessageChatDeletePhoto) []Change {
+	return messageChatDeletePhoto.diff("", other, nil)
+}
+
+func (messageChatDeletePhoto *MessageChatDeletePhoto) diff(path string, other *MessageChatDeletePhoto, changes []Change) []Change {
+	if messageChatDeletePhoto == nil || other == nil {
+		if messageChatDeletePhoto != other {
+			changes = append(changes, Change{Path: path, Old: messageChatDeletePhoto, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageChatDeletePhoto *MessageChatDeletePhoto) String() string {
+	return objectString(messageChatDeletePhoto)
+}
+
+// LogValue logs the object as its summary
+func (messageChatDeletePhoto *MessageChatDeletePhoto) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageChatDeletePhoto))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageChatDeletePhoto *MessageChatDeletePhoto) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageChatDeletePhoto)
+}
+
+func (messageChatDeletePhoto *MessageChatDeletePhoto) printText(p *printer) {
+	if messageChatDeletePhoto == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageChatDeletePhoto")
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageChatDeletePhoto *MessageChatDeletePhoto) GetMessageContentEnum() MessageContentEnum {
 	return MessageChatDeletePhotoType
 }
 
+// Accept calls the method of the visitor for MessageChatDeletePhoto
+func (messageChatDeletePhoto *MessageChatDeletePhoto) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageChatDeletePhoto(messageChatDeletePhoto)
+}
+
 // MessageChatAddMembers New chat members were added
 type MessageChatAddMembers struct {
 	tdCommon
@@ -1318,11 +5033,156 @@ func NewMessageChatAddMembers(memberUserIds []int64) *MessageChatAddMembers {
 	return &messageChatAddMembersTemp
 }
 
+// MarshalJSON marshals to json
+func (messageChatAddMembers *MessageChatAddMembers) MarshalJSON() ([]byte, error) {
+	return messageChatAddMembers.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageChatAddMembers *MessageChatAddMembers) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageChatAddMembers)
+}
+
+func (messageChatAddMembers *MessageChatAddMembers) appendJSON(b []byte) ([]byte, error) {
+	if messageChatAddMembers == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageChatAddMembers.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageChatAddMembers.tdCommon.Extra)
+	b = append(b, `,"member_user_ids":`...)
+	if messageChatAddMembers.MemberUserIds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range messageChatAddMembers.MemberUserIds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(messageChatAddMembers.MemberUserIds[i0]))
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (messageChatAddMembers *MessageChatAddMembers) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageChatAddMembers.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageChatAddMembers.tdCommon.Extra, err = r.readString()
+		case "member_user_ids":
+			if r.readNull() {
+				messageChatAddMembers.MemberUserIds = nil
+			} else {
+				messageChatAddMembers.MemberUserIds = make([]int64, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int64
+					item0, err = r.readInt64()
+					messageChatAddMembers.MemberUserIds = append(messageChatAddMembers.MemberUserIds, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageChatAddMembers *MessageChatAddMembers) Clone() *MessageChatAddMembers {
+	if messageChatAddMembers == nil {
+		return nil
+	}
+	clone := *messageChatAddMembers
+	if messageChatAddMembers.MemberUserIds != nil {
+		clone.MemberUserIds = make([]int64, len(messageChatAddMembers.MemberUserIds))
+		copy(clone.MemberUserIds, messageChatAddMembers.MemberUserIds)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageChatAddMembers *MessageChatAddMembers) Equal(other *MessageChatAddMembers) bool {
+	if messageChatAddMembers == nil || other == nil {
+		return messageChatAddMembers == other
+	}
+	if len(messageChatAddMembers.MemberUserIds) != len(other.MemberUserIds) {
+		return false
+	}
+	for i0 := range messageChatAddMembers.MemberUserIds {
+		if messageChatAddMembers.MemberUserIds[i0] != other.MemberUserIds[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageChatAddMembers *MessageChatAddMembers) Diff(other *MessageChatAddMembers) []Change {
+	return messageChatAddMembers.diff("", other, nil)
+}
+
+func (messageChatAddMembers *MessageChatAddMembers) diff(path string, other *MessageChatAddMembers, changes []Change) []Change {
+	if messageChatAddMembers == nil || other == nil {
+		if messageChatAddMembers != other {
+			changes = append(changes, Change{Path: path, Old: messageChatAddMembers, New: other})
+		}
+		return changes
+	}
+	if len(messageChatAddMembers.MemberUserIds) != len(other.MemberUserIds) {
+		changes = append(changes, Change{Path: fieldPath(path, "member_user_ids"), Old: messageChatAddMembers.MemberUserIds, New: other.MemberUserIds})
+	} else {
+		for i0 := range messageChatAddMembers.MemberUserIds {
+			if messageChatAddMembers.MemberUserIds[i0] != other.MemberUserIds[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "member_user_ids"), i0), Old: messageChatAddMembers.MemberUserIds[i0], New: other.MemberUserIds[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageChatAddMembers *MessageChatAddMembers) String() string {
+	return objectString(messageChatAddMembers)
+}
+
+// LogValue logs the object as its summary
+func (messageChatAddMembers *MessageChatAddMembers) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageChatAddMembers))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageChatAddMembers *MessageChatAddMembers) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageChatAddMembers)
+}
+
+func (messageChatAddMembers *MessageChatAddMembers) printText(p *printer) {
+	if messageChatAddMembers == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageChatAddMembers")
+	p.vector("member_user_ids", len(messageChatAddMembers.MemberUserIds), func(i0 int) {
+		p.int("member_user_ids", int64(messageChatAddMembers.MemberUserIds[i0]))
+	})
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageChatAddMembers *MessageChatAddMembers) GetMessageContentEnum() MessageContentEnum {
 	return MessageChatAddMembersType
 }
 
+// Accept calls the method of the visitor for MessageChatAddMembers
+func (messageChatAddMembers *MessageChatAddMembers) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageChatAddMembers(messageChatAddMembers)
+}
+
 // MessageChatJoinByLink A new member joined the chat via an invite link
 type MessageChatJoinByLink struct {
 	tdCommon
@@ -1334,7 +5194,6 @@ func (messageChatJoinByLink *MessageChatJoinByLink) MessageType() string {
 }
 
 // NewMessageChatJoinByLink creates a new MessageChatJoinByLink
-//
 func NewMessageChatJoinByLink() *MessageChatJoinByLink {
 	messageChatJoinByLinkTemp := MessageChatJoinByLink{
 		tdCommon: tdCommon{Type: "messageChatJoinByLink"},
@@ -1343,11 +5202,107 @@ func NewMessageChatJoinByLink() *MessageChatJoinByLink {
 	return &messageChatJoinByLinkTemp
 }
 
+// MarshalJSON marshals to json
+func (messageChatJoinByLink *MessageChatJoinByLink) MarshalJSON() ([]byte, error) {
+	return messageChatJoinByLink.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageChatJoinByLink *MessageChatJoinByLink) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageChatJoinByLink)
+}
+
+func (messageChatJoinByLink *MessageChatJoinByLink) appendJSON(b []byte) ([]byte, error) {
+	if messageChatJoinByLink == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageChatJoinByLink.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageChatJoinByLink.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (messageChatJoinByLink *MessageChatJoinByLink) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageChatJoinByLink.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageChatJoinByLink.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageChatJoinByLink *MessageChatJoinByLink) Clone() *MessageChatJoinByLink {
+	if messageChatJoinByLink == nil {
+		return nil
+	}
+	clone := *messageChatJoinByLink
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageChatJoinByLink *MessageChatJoinByLink) Equal(other *MessageChatJoinByLink) bool {
+	if messageChatJoinByLink == nil || other == nil {
+		return messageChatJoinByLink == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageChatJoinByLink *MessageChatJoinByLink) Diff(other *MessageChatJoinByLink) []Change {
+	return messageChatJoinByLink.diff("", other, nil)
+}
+
+func (messageChatJoinByLink *MessageChatJoinByLink) diff(path string, other *MessageChatJoinByLink, changes []Change) []Change {
+	if messageChatJoinByLink == nil || other == nil {
+		if messageChatJoinByLink != other {
+			changes = append(changes, Change{Path: path, Old: messageChatJoinByLink, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageChatJoinByLink *MessageChatJoinByLink) String() string {
+	return objectString(messageChatJoinByLink)
+}
+
+// LogValue logs the object as its summary
+func (messageChatJoinByLink *MessageChatJoinByLink) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageChatJoinByLink))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageChatJoinByLink *MessageChatJoinByLink) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageChatJoinByLink)
+}
+
+func (messageChatJoinByLink *MessageChatJoinByLink) printText(p *printer) {
+	if messageChatJoinByLink == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageChatJoinByLink")
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageChatJoinByLink *MessageChatJoinByLink) GetMessageContentEnum() MessageContentEnum {
 	return MessageChatJoinByLinkType
 }
 
+// Accept calls the method of the visitor for MessageChatJoinByLink
+func (messageChatJoinByLink *MessageChatJoinByLink) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageChatJoinByLink(messageChatJoinByLink)
+}
+
 // MessageChatJoinByRequest A new member was accepted to the chat by an administrator
 type MessageChatJoinByRequest struct {
 	tdCommon
@@ -1359,7 +5314,6 @@ func (messageChatJoinByRequest *MessageChatJoinByRequest) MessageType() string {
 }
 
 // NewMessageChatJoinByRequest creates a new MessageChatJoinByRequest
-//
 func NewMessageChatJoinByRequest() *MessageChatJoinByRequest {
 	messageChatJoinByRequestTemp := MessageChatJoinByRequest{
 		tdCommon: tdCommon{Type: "messageChatJoinByRequest"},
@@ -1368,11 +5322,107 @@ func NewMessageChatJoinByRequest() *MessageChatJoinByRequest {
 	return &messageChatJoinByRequestTemp
 }
 
+// MarshalJSON marshals to json
+func (messageChatJoinByRequest *MessageChatJoinByRequest) MarshalJSON() ([]byte, error) {
+	return messageChatJoinByRequest.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageChatJoinByRequest *MessageChatJoinByRequest) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageChatJoinByRequest)
+}
+
+func (messageChatJoinByRequest *MessageChatJoinByRequest) appendJSON(b []byte) ([]byte, error) {
+	if messageChatJoinByRequest == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageChatJoinByRequest.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageChatJoinByRequest.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (messageChatJoinByRequest *MessageChatJoinByRequest) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageChatJoinByRequest.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageChatJoinByRequest.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageChatJoinByRequest *MessageChatJoinByRequest) Clone() *MessageChatJoinByRequest {
+	if messageChatJoinByRequest == nil {
+		return nil
+	}
+	clone := *messageChatJoinByRequest
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageChatJoinByRequest *MessageChatJoinByRequest) Equal(other *MessageChatJoinByRequest) bool {
+	if messageChatJoinByRequest == nil || other == nil {
+		return messageChatJoinByRequest == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageChatJoinByRequest *MessageChatJoinByRequest) Diff(other *MessageChatJoinByRequest) []Change {
+	return messageChatJoinByRequest.diff("", other, nil)
+}
+
+func (messageChatJoinByRequest *MessageChatJoinByRequest) diff(path string, other *MessageChatJoinByRequest, changes []Change) []Change {
+	if messageChatJoinByRequest == nil || other == nil {
+		if messageChatJoinByRequest != other {
+			changes = append(changes, Change{Path: path, Old: messageChatJoinByRequest, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageChatJoinByRequest *MessageChatJoinByRequest) String() string {
+	return objectString(messageChatJoinByRequest)
+}
+
+// LogValue logs the object as its summary
+func (messageChatJoinByRequest *MessageChatJoinByRequest) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageChatJoinByRequest))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageChatJoinByRequest *MessageChatJoinByRequest) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageChatJoinByRequest)
+}
+
+func (messageChatJoinByRequest *MessageChatJoinByRequest) printText(p *printer) {
+	if messageChatJoinByRequest == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageChatJoinByRequest")
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageChatJoinByRequest *MessageChatJoinByRequest) GetMessageContentEnum() MessageContentEnum {
 	return MessageChatJoinByRequestType
 }
 
+// Accept calls the method of the visitor for MessageChatJoinByRequest
+func (messageChatJoinByRequest *MessageChatJoinByRequest) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageChatJoinByRequest(messageChatJoinByRequest)
+}
+
 // MessageChatDeleteMember A chat member was deleted
 type MessageChatDeleteMember struct {
 	tdCommon
@@ -1396,32 +5446,241 @@ func NewMessageChatDeleteMember(userId int64) *MessageChatDeleteMember {
 	return &messageChatDeleteMemberTemp
 }
 
+// MarshalJSON marshals to json
+func (messageChatDeleteMember *MessageChatDeleteMember) MarshalJSON() ([]byte, error) {
+	return messageChatDeleteMember.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageChatDeleteMember *MessageChatDeleteMember) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageChatDeleteMember)
+}
+
+func (messageChatDeleteMember *MessageChatDeleteMember) appendJSON(b []byte) ([]byte, error) {
+	if messageChatDeleteMember == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageChatDeleteMember.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageChatDeleteMember.tdCommon.Extra)
+	b = append(b, `,"user_id":`...)
+	b = appendJSONInt(b, int64(messageChatDeleteMember.UserId))
+	return append(b, '}'), nil
+}
+
+func (messageChatDeleteMember *MessageChatDeleteMember) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageChatDeleteMember.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageChatDeleteMember.tdCommon.Extra, err = r.readString()
+		case "user_id":
+			messageChatDeleteMember.UserId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageChatDeleteMember *MessageChatDeleteMember) Clone() *MessageChatDeleteMember {
+	if messageChatDeleteMember == nil {
+		return nil
+	}
+	clone := *messageChatDeleteMember
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageChatDeleteMember *MessageChatDeleteMember) Equal(other *MessageChatDeleteMember) bool {
+	if messageChatDeleteMember == nil || other == nil {
+		return messageChatDeleteMember == other
+	}
+	if messageChatDeleteMember.UserId != other.UserId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageChatDeleteMember *MessageChatDeleteMember) Diff(other *MessageChatDeleteMember) []Change {
+	return messageChatDeleteMember.diff("", other, nil)
+}
+
+func (messageChatDeleteMember *MessageChatDeleteMember) diff(path string, other *MessageChatDeleteMember, changes []Change) []Change {
+	if messageChatDeleteMember == nil || other == nil {
+		if messageChatDeleteMember != other {
+			changes = append(changes, Change{Path: path, Old: messageChatDeleteMember, New: other})
+		}
+		return changes
+	}
+	if messageChatDeleteMember.UserId != other.UserId {
+		changes = append(changes, Change{Path: fieldPath(path, "user_id"), Old: messageChatDeleteMember.UserId, New: other.UserId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageChatDeleteMember *MessageChatDeleteMember) String() string {
+	return objectString(messageChatDeleteMember)
+}
+
+// LogValue logs the object as its summary
+func (messageChatDeleteMember *MessageChatDeleteMember) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageChatDeleteMember))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageChatDeleteMember *MessageChatDeleteMember) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageChatDeleteMember)
+}
+
+func (messageChatDeleteMember *MessageChatDeleteMember) printText(p *printer) {
+	if messageChatDeleteMember == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageChatDeleteMember")
+	p.int("user_id", int64(messageChatDeleteMember.UserId))
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageChatDeleteMember *MessageChatDeleteMember) GetMessageContentEnum() MessageContentEnum {
 	return MessageChatDeleteMemberType
 }
 
-// MessageChatUpgradeTo A basic group was upgraded to a supergroup and was deactivated as the result
-type MessageChatUpgradeTo struct {
-	tdCommon
-	SupergroupId int64 `json:"supergroup_id"` // Identifier of the supergroup to which the basic group was upgraded
+// Accept calls the method of the visitor for MessageChatDeleteMember
+func (messageChatDeleteMember *MessageChatDeleteMember) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageChatDeleteMember(messageChatDeleteMember)
+}
+
+// MessageChatUpgradeTo A basic group was upgraded to a supergroup and was deactivated as the result
+type MessageChatUpgradeTo struct {
+	tdCommon
+	SupergroupId int64 `json:"supergroup_id"` // Identifier of the supergroup to which the basic group was upgraded
+}
+
+// MessageType return the string telegram-type of MessageChatUpgradeTo
+func (messageChatUpgradeTo *MessageChatUpgradeTo) MessageType() string {
+	return "messageChatUpgradeTo"
+}
+
+// NewMessageChatUpgradeTo creates a new MessageChatUpgradeTo
+//
+// @param supergroupId Identifier of the supergroup to which the basic group was upgraded
+func NewMessageChatUpgradeTo(supergroupId int64) *MessageChatUpgradeTo {
+	messageChatUpgradeToTemp := MessageChatUpgradeTo{
+		tdCommon:     tdCommon{Type: "messageChatUpgradeTo"},
+		SupergroupId: supergroupId,
+	}
+
+	return &messageChatUpgradeToTemp
+}
+
+// MarshalJSON marshals to json
+func (messageChatUpgradeTo *MessageChatUpgradeTo) MarshalJSON() ([]byte, error) {
+	return messageChatUpgradeTo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageChatUpgradeTo *MessageChatUpgradeTo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageChatUpgradeTo)
+}
+
+func (messageChatUpgradeTo *MessageChatUpgradeTo) appendJSON(b []byte) ([]byte, error) {
+	if messageChatUpgradeTo == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageChatUpgradeTo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageChatUpgradeTo.tdCommon.Extra)
+	b = append(b, `,"supergroup_id":`...)
+	b = appendJSONInt(b, int64(messageChatUpgradeTo.SupergroupId))
+	return append(b, '}'), nil
+}
+
+func (messageChatUpgradeTo *MessageChatUpgradeTo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageChatUpgradeTo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageChatUpgradeTo.tdCommon.Extra, err = r.readString()
+		case "supergroup_id":
+			messageChatUpgradeTo.SupergroupId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageChatUpgradeTo *MessageChatUpgradeTo) Clone() *MessageChatUpgradeTo {
+	if messageChatUpgradeTo == nil {
+		return nil
+	}
+	clone := *messageChatUpgradeTo
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageChatUpgradeTo *MessageChatUpgradeTo) Equal(other *MessageChatUpgradeTo) bool {
+	if messageChatUpgradeTo == nil || other == nil {
+		return messageChatUpgradeTo == other
+	}
+	if messageChatUpgradeTo.SupergroupId != other.SupergroupId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageChatUpgradeTo *MessageChatUpgradeTo) Diff(other *MessageChatUpgradeTo) []Change {
+	return messageChatUpgradeTo.diff("", other, nil)
+}
+
+func (messageChatUpgradeTo *MessageChatUpgradeTo) diff(path string, other *MessageChatUpgradeTo, changes []Change) []Change {
+	if messageChatUpgradeTo == nil || other == nil {
+		if messageChatUpgradeTo != other {
+			changes = append(changes, Change{Path: path, Old: messageChatUpgradeTo, New: other})
+		}
+		return changes
+	}
+	if messageChatUpgradeTo.SupergroupId != other.SupergroupId {
+		changes = append(changes, Change{Path: fieldPath(path, "supergroup_id"), Old: messageChatUpgradeTo.SupergroupId, New: other.SupergroupId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageChatUpgradeTo *MessageChatUpgradeTo) String() string {
+	return objectString(messageChatUpgradeTo)
+}
+
+// LogValue logs the object as its summary
+func (messageChatUpgradeTo *MessageChatUpgradeTo) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageChatUpgradeTo))
 }
 
-// MessageType return the string telegram-type of MessageChatUpgradeTo
-func (messageChatUpgradeTo *MessageChatUpgradeTo) MessageType() string {
-	return "messageChatUpgradeTo"
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageChatUpgradeTo *MessageChatUpgradeTo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageChatUpgradeTo)
 }
 
-// NewMessageChatUpgradeTo creates a new MessageChatUpgradeTo
-//
-// @param supergroupId Identifier of the supergroup to which the basic group was upgraded
-func NewMessageChatUpgradeTo(supergroupId int64) *MessageChatUpgradeTo {
-	messageChatUpgradeToTemp := MessageChatUpgradeTo{
-		tdCommon:     tdCommon{Type: "messageChatUpgradeTo"},
-		SupergroupId: supergroupId,
+func (messageChatUpgradeTo *MessageChatUpgradeTo) printText(p *printer) {
+	if messageChatUpgradeTo == nil {
+		p.null()
+		return
 	}
-
-	return &messageChatUpgradeToTemp
+	state := p.beginObject("MessageChatUpgradeTo")
+	p.int("supergroup_id", int64(messageChatUpgradeTo.SupergroupId))
+	p.endObject(state)
 }
 
 // GetMessageContentEnum return the enum type of this object
@@ -1429,6 +5688,11 @@ func (messageChatUpgradeTo *MessageChatUpgradeTo) GetMessageContentEnum() Messag
 	return MessageChatUpgradeToType
 }
 
+// Accept calls the method of the visitor for MessageChatUpgradeTo
+func (messageChatUpgradeTo *MessageChatUpgradeTo) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageChatUpgradeTo(messageChatUpgradeTo)
+}
+
 // MessageChatUpgradeFrom A supergroup has been created from a basic group
 type MessageChatUpgradeFrom struct {
 	tdCommon
@@ -1455,11 +5719,129 @@ func NewMessageChatUpgradeFrom(title string, basicGroupId int64) *MessageChatUpg
 	return &messageChatUpgradeFromTemp
 }
 
+// MarshalJSON marshals to json
+func (messageChatUpgradeFrom *MessageChatUpgradeFrom) MarshalJSON() ([]byte, error) {
+	return messageChatUpgradeFrom.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageChatUpgradeFrom *MessageChatUpgradeFrom) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageChatUpgradeFrom)
+}
+
+func (messageChatUpgradeFrom *MessageChatUpgradeFrom) appendJSON(b []byte) ([]byte, error) {
+	if messageChatUpgradeFrom == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageChatUpgradeFrom.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageChatUpgradeFrom.tdCommon.Extra)
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, messageChatUpgradeFrom.Title)
+	b = append(b, `,"basic_group_id":`...)
+	b = appendJSONInt(b, int64(messageChatUpgradeFrom.BasicGroupId))
+	return append(b, '}'), nil
+}
+
+func (messageChatUpgradeFrom *MessageChatUpgradeFrom) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageChatUpgradeFrom.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageChatUpgradeFrom.tdCommon.Extra, err = r.readString()
+		case "title":
+			messageChatUpgradeFrom.Title, err = r.readString()
+		case "basic_group_id":
+			messageChatUpgradeFrom.BasicGroupId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageChatUpgradeFrom *MessageChatUpgradeFrom) Clone() *MessageChatUpgradeFrom {
+	if messageChatUpgradeFrom == nil {
+		return nil
+	}
+	clone := *messageChatUpgradeFrom
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageChatUpgradeFrom *MessageChatUpgradeFrom) Equal(other *MessageChatUpgradeFrom) bool {
+	if messageChatUpgradeFrom == nil || other == nil {
+		return messageChatUpgradeFrom == other
+	}
+	if messageChatUpgradeFrom.Title != other.Title {
+		return false
+	}
+	if messageChatUpgradeFrom.BasicGroupId != other.BasicGroupId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageChatUpgradeFrom *MessageChatUpgradeFrom) Diff(other *MessageChatUpgradeFrom) []Change {
+	return messageChatUpgradeFrom.diff("", other, nil)
+}
+
+func (messageChatUpgradeFrom *MessageChatUpgradeFrom) diff(path string, other *MessageChatUpgradeFrom, changes []Change) []Change {
+	if messageChatUpgradeFrom == nil || other == nil {
+		if messageChatUpgradeFrom != other {
+			changes = append(changes, Change{Path: path, Old: messageChatUpgradeFrom, New: other})
+		}
+		return changes
+	}
+	if messageChatUpgradeFrom.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: messageChatUpgradeFrom.Title, New: other.Title})
+	}
+	if messageChatUpgradeFrom.BasicGroupId != other.BasicGroupId {
+		changes = append(changes, Change{Path: fieldPath(path, "basic_group_id"), Old: messageChatUpgradeFrom.BasicGroupId, New: other.BasicGroupId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageChatUpgradeFrom *MessageChatUpgradeFrom) String() string {
+	return objectString(messageChatUpgradeFrom)
+}
+
+// LogValue logs the object as its summary
+func (messageChatUpgradeFrom *MessageChatUpgradeFrom) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageChatUpgradeFrom))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageChatUpgradeFrom *MessageChatUpgradeFrom) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageChatUpgradeFrom)
+}
+
+func (messageChatUpgradeFrom *MessageChatUpgradeFrom) printText(p *printer) {
+	if messageChatUpgradeFrom == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageChatUpgradeFrom")
+	p.string("title", messageChatUpgradeFrom.Title)
+	p.int("basic_group_id", int64(messageChatUpgradeFrom.BasicGroupId))
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageChatUpgradeFrom *MessageChatUpgradeFrom) GetMessageContentEnum() MessageContentEnum {
 	return MessageChatUpgradeFromType
 }
 
+// Accept calls the method of the visitor for MessageChatUpgradeFrom
+func (messageChatUpgradeFrom *MessageChatUpgradeFrom) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageChatUpgradeFrom(messageChatUpgradeFrom)
+}
+
 // MessagePinMessage A message has been pinned
 type MessagePinMessage struct {
 	tdCommon
@@ -1483,11 +5865,118 @@ func NewMessagePinMessage(messageId int64) *MessagePinMessage {
 	return &messagePinMessageTemp
 }
 
+// MarshalJSON marshals to json
+func (messagePinMessage *MessagePinMessage) MarshalJSON() ([]byte, error) {
+	return messagePinMessage.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messagePinMessage *MessagePinMessage) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messagePinMessage)
+}
+
+func (messagePinMessage *MessagePinMessage) appendJSON(b []byte) ([]byte, error) {
+	if messagePinMessage == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messagePinMessage.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messagePinMessage.tdCommon.Extra)
+	b = append(b, `,"message_id":`...)
+	b = appendJSONInt(b, int64(messagePinMessage.MessageId))
+	return append(b, '}'), nil
+}
+
+func (messagePinMessage *MessagePinMessage) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messagePinMessage.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messagePinMessage.tdCommon.Extra, err = r.readString()
+		case "message_id":
+			messagePinMessage.MessageId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messagePinMessage *MessagePinMessage) Clone() *MessagePinMessage {
+	if messagePinMessage == nil {
+		return nil
+	}
+	clone := *messagePinMessage
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messagePinMessage *MessagePinMessage) Equal(other *MessagePinMessage) bool {
+	if messagePinMessage == nil || other == nil {
+		return messagePinMessage == other
+	}
+	if messagePinMessage.MessageId != other.MessageId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messagePinMessage *MessagePinMessage) Diff(other *MessagePinMessage) []Change {
+	return messagePinMessage.diff("", other, nil)
+}
+
+func (messagePinMessage *MessagePinMessage) diff(path string, other *MessagePinMessage, changes []Change) []Change {
+	if messagePinMessage == nil || other == nil {
+		if messagePinMessage != other {
+			changes = append(changes, Change{Path: path, Old: messagePinMessage, New: other})
+		}
+		return changes
+	}
+	if messagePinMessage.MessageId != other.MessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "message_id"), Old: messagePinMessage.MessageId, New: other.MessageId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messagePinMessage *MessagePinMessage) String() string {
+	return objectString(messagePinMessage)
+}
+
+// LogValue logs the object as its summary
+func (messagePinMessage *MessagePinMessage) LogValue() slog.Value {
+	return slog.StringValue(objectString(messagePinMessage))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messagePinMessage *MessagePinMessage) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messagePinMessage)
+}
+
+func (messagePinMessage *MessagePinMessage) printText(p *printer) {
+	if messagePinMessage == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessagePinMessage")
+	p.int("message_id", int64(messagePinMessage.MessageId))
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messagePinMessage *MessagePinMessage) GetMessageContentEnum() MessageContentEnum {
 	return MessagePinMessageType
 }
 
+// Accept calls the method of the visitor for MessagePinMessage
+func (messagePinMessage *MessagePinMessage) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessagePinMessage(messagePinMessage)
+}
+
 // MessageScreenshotTaken A screenshot of a message in the chat has been taken
 type MessageScreenshotTaken struct {
 	tdCommon
@@ -1499,7 +5988,6 @@ func (messageScreenshotTaken *MessageScreenshotTaken) MessageType() string {
 }
 
 // NewMessageScreenshotTaken creates a new MessageScreenshotTaken
-//
 func NewMessageScreenshotTaken() *MessageScreenshotTaken {
 	messageScreenshotTakenTemp := MessageScreenshotTaken{
 		tdCommon: tdCommon{Type: "messageScreenshotTaken"},
@@ -1508,11 +5996,107 @@ func NewMessageScreenshotTaken() *MessageScreenshotTaken {
 	return &messageScreenshotTakenTemp
 }
 
+// MarshalJSON marshals to json
+func (messageScreenshotTaken *MessageScreenshotTaken) MarshalJSON() ([]byte, error) {
+	return messageScreenshotTaken.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageScreenshotTaken *MessageScreenshotTaken) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageScreenshotTaken)
+}
+
+func (messageScreenshotTaken *MessageScreenshotTaken) appendJSON(b []byte) ([]byte, error) {
+	if messageScreenshotTaken == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageScreenshotTaken.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageScreenshotTaken.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (messageScreenshotTaken *MessageScreenshotTaken) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageScreenshotTaken.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageScreenshotTaken.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageScreenshotTaken *MessageScreenshotTaken) Clone() *MessageScreenshotTaken {
+	if messageScreenshotTaken == nil {
+		return nil
+	}
+	clone := *messageScreenshotTaken
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageScreenshotTaken *MessageScreenshotTaken) Equal(other *MessageScreenshotTaken) bool {
+	if messageScreenshotTaken == nil || other == nil {
+		return messageScreenshotTaken == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageScreenshotTaken *MessageScreenshotTaken) Diff(other *MessageScreenshotTaken) []Change {
+	return messageScreenshotTaken.diff("", other, nil)
+}
+
+func (messageScreenshotTaken *MessageScreenshotTaken) diff(path string, other *MessageScreenshotTaken, changes []Change) []Change {
+	if messageScreenshotTaken == nil || other == nil {
+		if messageScreenshotTaken != other {
+			changes = append(changes, Change{Path: path, Old: messageScreenshotTaken, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageScreenshotTaken *MessageScreenshotTaken) String() string {
+	return objectString(messageScreenshotTaken)
+}
+
+// LogValue logs the object as its summary
+func (messageScreenshotTaken *MessageScreenshotTaken) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageScreenshotTaken))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageScreenshotTaken *MessageScreenshotTaken) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageScreenshotTaken)
+}
+
+func (messageScreenshotTaken *MessageScreenshotTaken) printText(p *printer) {
+	if messageScreenshotTaken == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageScreenshotTaken")
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageScreenshotTaken *MessageScreenshotTaken) GetMessageContentEnum() MessageContentEnum {
 	return MessageScreenshotTakenType
 }
 
+// Accept calls the method of the visitor for MessageScreenshotTaken
+func (messageScreenshotTaken *MessageScreenshotTaken) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageScreenshotTaken(messageScreenshotTaken)
+}
+
 // MessageChatSetTheme A theme in the chat has been changed
 type MessageChatSetTheme struct {
 	tdCommon
@@ -1536,11 +6120,118 @@ func NewMessageChatSetTheme(themeName string) *MessageChatSetTheme {
 	return &messageChatSetThemeTemp
 }
 
+// MarshalJSON marshals to json
+func (messageChatSetTheme *MessageChatSetTheme) MarshalJSON() ([]byte, error) {
+	return messageChatSetTheme.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageChatSetTheme *MessageChatSetTheme) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageChatSetTheme)
+}
+
+func (messageChatSetTheme *MessageChatSetTheme) appendJSON(b []byte) ([]byte, error) {
+	if messageChatSetTheme == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageChatSetTheme.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageChatSetTheme.tdCommon.Extra)
+	b = append(b, `,"theme_name":`...)
+	b = appendJSONString(b, messageChatSetTheme.ThemeName)
+	return append(b, '}'), nil
+}
+
+func (messageChatSetTheme *MessageChatSetTheme) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageChatSetTheme.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageChatSetTheme.tdCommon.Extra, err = r.readString()
+		case "theme_name":
+			messageChatSetTheme.ThemeName, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageChatSetTheme *MessageChatSetTheme) Clone() *MessageChatSetTheme {
+	if messageChatSetTheme == nil {
+		return nil
+	}
+	clone := *messageChatSetTheme
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageChatSetTheme *MessageChatSetTheme) Equal(other *MessageChatSetTheme) bool {
+	if messageChatSetTheme == nil || other == nil {
+		return messageChatSetTheme == other
+	}
+	if messageChatSetTheme.ThemeName != other.ThemeName {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageChatSetTheme *MessageChatSetTheme) Diff(other *MessageChatSetTheme) []Change {
+	return messageChatSetTheme.diff("", other, nil)
+}
+
+func (messageChatSetTheme *MessageChatSetTheme) diff(path string, other *MessageChatSetTheme, changes []Change) []Change {
+	if messageChatSetTheme == nil || other == nil {
+		if messageChatSetTheme != other {
+			changes = append(changes, Change{Path: path, Old: messageChatSetTheme, New: other})
+		}
+		return changes
+	}
+	if messageChatSetTheme.ThemeName != other.ThemeName {
+		changes = append(changes, Change{Path: fieldPath(path, "theme_name"), Old: messageChatSetTheme.ThemeName, New: other.ThemeName})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageChatSetTheme *MessageChatSetTheme) String() string {
+	return objectString(messageChatSetTheme)
+}
+
+// LogValue logs the object as its summary
+func (messageChatSetTheme *MessageChatSetTheme) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageChatSetTheme))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageChatSetTheme *MessageChatSetTheme) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageChatSetTheme)
+}
+
+func (messageChatSetTheme *MessageChatSetTheme) printText(p *printer) {
+	if messageChatSetTheme == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageChatSetTheme")
+	p.string("theme_name", messageChatSetTheme.ThemeName)
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageChatSetTheme *MessageChatSetTheme) GetMessageContentEnum() MessageContentEnum {
 	return MessageChatSetThemeType
 }
 
+// Accept calls the method of the visitor for MessageChatSetTheme
+func (messageChatSetTheme *MessageChatSetTheme) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageChatSetTheme(messageChatSetTheme)
+}
+
 // MessageChatSetTtl The TTL (Time To Live) setting for messages in the chat has been changed
 type MessageChatSetTtl struct {
 	tdCommon
@@ -1564,11 +6255,118 @@ func NewMessageChatSetTtl(ttl int32) *MessageChatSetTtl {
 	return &messageChatSetTtlTemp
 }
 
+// MarshalJSON marshals to json
+func (messageChatSetTtl *MessageChatSetTtl) MarshalJSON() ([]byte, error) {
+	return messageChatSetTtl.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageChatSetTtl *MessageChatSetTtl) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageChatSetTtl)
+}
+
+func (messageChatSetTtl *MessageChatSetTtl) appendJSON(b []byte) ([]byte, error) {
+	if messageChatSetTtl == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageChatSetTtl.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageChatSetTtl.tdCommon.Extra)
+	b = append(b, `,"ttl":`...)
+	b = appendJSONInt(b, int64(messageChatSetTtl.Ttl))
+	return append(b, '}'), nil
+}
+
+func (messageChatSetTtl *MessageChatSetTtl) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageChatSetTtl.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageChatSetTtl.tdCommon.Extra, err = r.readString()
+		case "ttl":
+			messageChatSetTtl.Ttl, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageChatSetTtl *MessageChatSetTtl) Clone() *MessageChatSetTtl {
+	if messageChatSetTtl == nil {
+		return nil
+	}
+	clone := *messageChatSetTtl
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageChatSetTtl *MessageChatSetTtl) Equal(other *MessageChatSetTtl) bool {
+	if messageChatSetTtl == nil || other == nil {
+		return messageChatSetTtl == other
+	}
+	if messageChatSetTtl.Ttl != other.Ttl {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageChatSetTtl *MessageChatSetTtl) Diff(other *MessageChatSetTtl) []Change {
+	return messageChatSetTtl.diff("", other, nil)
+}
+
+func (messageChatSetTtl *MessageChatSetTtl) diff(path string, other *MessageChatSetTtl, changes []Change) []Change {
+	if messageChatSetTtl == nil || other == nil {
+		if messageChatSetTtl != other {
+			changes = append(changes, Change{Path: path, Old: messageChatSetTtl, New: other})
+		}
+		return changes
+	}
+	if messageChatSetTtl.Ttl != other.Ttl {
+		changes = append(changes, Change{Path: fieldPath(path, "ttl"), Old: messageChatSetTtl.Ttl, New: other.Ttl})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageChatSetTtl *MessageChatSetTtl) String() string {
+	return objectString(messageChatSetTtl)
+}
+
+// LogValue logs the object as its summary
+func (messageChatSetTtl *MessageChatSetTtl) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageChatSetTtl))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageChatSetTtl *MessageChatSetTtl) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageChatSetTtl)
+}
+
+func (messageChatSetTtl *MessageChatSetTtl) printText(p *printer) {
+	if messageChatSetTtl == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageChatSetTtl")
+	p.int("ttl", int64(messageChatSetTtl.Ttl))
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageChatSetTtl *MessageChatSetTtl) GetMessageContentEnum() MessageContentEnum {
 	return MessageChatSetTtlType
 }
 
+// Accept calls the method of the visitor for MessageChatSetTtl
+func (messageChatSetTtl *MessageChatSetTtl) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageChatSetTtl(messageChatSetTtl)
+}
+
 // MessageCustomServiceAction A non-standard action has happened in the chat
 type MessageCustomServiceAction struct {
 	tdCommon
@@ -1592,11 +6390,118 @@ func NewMessageCustomServiceAction(text string) *MessageCustomServiceAction {
 	return &messageCustomServiceActionTemp
 }
 
+// MarshalJSON marshals to json
+func (messageCustomServiceAction *MessageCustomServiceAction) MarshalJSON() ([]byte, error) {
+	return messageCustomServiceAction.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageCustomServiceAction *MessageCustomServiceAction) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageCustomServiceAction)
+}
+
+func (messageCustomServiceAction *MessageCustomServiceAction) appendJSON(b []byte) ([]byte, error) {
+	if messageCustomServiceAction == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageCustomServiceAction.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageCustomServiceAction.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b = appendJSONString(b, messageCustomServiceAction.Text)
+	return append(b, '}'), nil
+}
+
+func (messageCustomServiceAction *MessageCustomServiceAction) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageCustomServiceAction.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageCustomServiceAction.tdCommon.Extra, err = r.readString()
+		case "text":
+			messageCustomServiceAction.Text, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageCustomServiceAction *MessageCustomServiceAction) Clone() *MessageCustomServiceAction {
+	if messageCustomServiceAction == nil {
+		return nil
+	}
+	clone := *messageCustomServiceAction
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageCustomServiceAction *MessageCustomServiceAction) Equal(other *MessageCustomServiceAction) bool {
+	if messageCustomServiceAction == nil || other == nil {
+		return messageCustomServiceAction == other
+	}
+	if messageCustomServiceAction.Text != other.Text {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageCustomServiceAction *MessageCustomServiceAction) Diff(other *MessageCustomServiceAction) []Change {
+	return messageCustomServiceAction.diff("", other, nil)
+}
+
+func (messageCustomServiceAction *MessageCustomServiceAction) diff(path string, other *MessageCustomServiceAction, changes []Change) []Change {
+	if messageCustomServiceAction == nil || other == nil {
+		if messageCustomServiceAction != other {
+			changes = append(changes, Change{Path: path, Old: messageCustomServiceAction, New: other})
+		}
+		return changes
+	}
+	if messageCustomServiceAction.Text != other.Text {
+		changes = append(changes, Change{Path: fieldPath(path, "text"), Old: messageCustomServiceAction.Text, New: other.Text})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageCustomServiceAction *MessageCustomServiceAction) String() string {
+	return objectString(messageCustomServiceAction)
+}
+
+// LogValue logs the object as its summary
+func (messageCustomServiceAction *MessageCustomServiceAction) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageCustomServiceAction))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageCustomServiceAction *MessageCustomServiceAction) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageCustomServiceAction)
+}
+
+func (messageCustomServiceAction *MessageCustomServiceAction) printText(p *printer) {
+	if messageCustomServiceAction == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageCustomServiceAction")
+	p.string("text", messageCustomServiceAction.Text)
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageCustomServiceAction *MessageCustomServiceAction) GetMessageContentEnum() MessageContentEnum {
 	return MessageCustomServiceActionType
 }
 
+// Accept calls the method of the visitor for MessageCustomServiceAction
+func (messageCustomServiceAction *MessageCustomServiceAction) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageCustomServiceAction(messageCustomServiceAction)
+}
+
 // MessageGameScore A new high score was achieved in a game
 type MessageGameScore struct {
 	tdCommon
@@ -1626,11 +6531,140 @@ func NewMessageGameScore(gameMessageId int64, gameId JSONInt64, score int32) *Me
 	return &messageGameScoreTemp
 }
 
+// MarshalJSON marshals to json
+func (messageGameScore *MessageGameScore) MarshalJSON() ([]byte, error) {
+	return messageGameScore.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageGameScore *MessageGameScore) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageGameScore)
+}
+
+func (messageGameScore *MessageGameScore) appendJSON(b []byte) ([]byte, error) {
+	if messageGameScore == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageGameScore.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageGameScore.tdCommon.Extra)
+	b = append(b, `,"game_message_id":`...)
+	b = appendJSONInt(b, int64(messageGameScore.GameMessageId))
+	b = append(b, `,"game_id":`...)
+	b = appendJSONInt64String(b, int64(messageGameScore.GameId))
+	b = append(b, `,"score":`...)
+	b = appendJSONInt(b, int64(messageGameScore.Score))
+	return append(b, '}'), nil
+}
+
+func (messageGameScore *MessageGameScore) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageGameScore.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageGameScore.tdCommon.Extra, err = r.readString()
+		case "game_message_id":
+			messageGameScore.GameMessageId, err = r.readInt64()
+		case "game_id":
+			messageGameScore.GameId, err = r.readJSONInt64()
+		case "score":
+			messageGameScore.Score, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageGameScore *MessageGameScore) Clone() *MessageGameScore {
+	if messageGameScore == nil {
+		return nil
+	}
+	clone := *messageGameScore
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageGameScore *MessageGameScore) Equal(other *MessageGameScore) bool {
+	if messageGameScore == nil || other == nil {
+		return messageGameScore == other
+	}
+	if messageGameScore.GameMessageId != other.GameMessageId {
+		return false
+	}
+	if messageGameScore.GameId != other.GameId {
+		return false
+	}
+	if messageGameScore.Score != other.Score {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageGameScore *MessageGameScore) Diff(other *MessageGameScore) []Change {
+	return messageGameScore.diff("", other, nil)
+}
+
+func (messageGameScore *MessageGameScore) diff(path string, other *MessageGameScore, changes []Change) []Change {
+	if messageGameScore == nil || other == nil {
+		if messageGameScore != other {
+			changes = append(changes, Change{Path: path, Old: messageGameScore, New: other})
+		}
+		return changes
+	}
+	if messageGameScore.GameMessageId != other.GameMessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "game_message_id"), Old: messageGameScore.GameMessageId, New: other.GameMessageId})
+	}
+	if messageGameScore.GameId != other.GameId {
+		changes = append(changes, Change{Path: fieldPath(path, "game_id"), Old: messageGameScore.GameId, New: other.GameId})
+	}
+	if messageGameScore.Score != other.Score {
+		changes = append(changes, Change{Path: fieldPath(path, "score"), Old: messageGameScore.Score, New: other.Score})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageGameScore *MessageGameScore) String() string {
+	return objectString(messageGameScore)
+}
+
+// LogValue logs the object as its summary
+func (messageGameScore *MessageGameScore) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageGameScore))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageGameScore *MessageGameScore) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageGameScore)
+}
+
+func (messageGameScore *MessageGameScore) printText(p *printer) {
+	if messageGameScore == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageGameScore")
+	p.int("game_message_id", int64(messageGameScore.GameMessageId))
+	p.int("game_id", int64(messageGameScore.GameId))
+	p.int("score", int64(messageGameScore.Score))
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageGameScore *MessageGameScore) GetMessageContentEnum() MessageContentEnum {
 	return MessageGameScoreType
 }
 
+// Accept calls the method of the visitor for MessageGameScore
+func (messageGameScore *MessageGameScore) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageGameScore(messageGameScore)
+}
+
 // MessagePaymentSuccessful A payment has been completed
 type MessagePaymentSuccessful struct {
 	tdCommon
@@ -1660,7 +6694,142 @@ func NewMessagePaymentSuccessful(invoiceChatId int64, invoiceMessageId int64, cu
 		TotalAmount:      totalAmount,
 	}
 
-	return &messagePaymentSuccessfulTemp
+	return &messagePaymentSuccessfulTemp
+}
+
+// MarshalJSON marshals to json
+func (messagePaymentSuccessful *MessagePaymentSuccessful) MarshalJSON() ([]byte, error) {
+	return messagePaymentSuccessful.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messagePaymentSuccessful *MessagePaymentSuccessful) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messagePaymentSuccessful)
+}
+
+func (messagePaymentSuccessful *MessagePaymentSuccessful) appendJSON(b []byte) ([]byte, error) {
+	if messagePaymentSuccessful == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messagePaymentSuccessful.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messagePaymentSuccessful.tdCommon.Extra)
+	b = append(b, `,"invoice_chat_id":`...)
+	b = appendJSONInt(b, int64(messagePaymentSuccessful.InvoiceChatId))
+	b = append(b, `,"invoice_message_id":`...)
+	b = appendJSONInt(b, int64(messagePaymentSuccessful.InvoiceMessageId))
+	b = append(b, `,"currency":`...)
+	b = appendJSONString(b, messagePaymentSuccessful.Currency)
+	b = append(b, `,"total_amount":`...)
+	b = appendJSONInt(b, int64(messagePaymentSuccessful.TotalAmount))
+	return append(b, '}'), nil
+}
+
+func (messagePaymentSuccessful *MessagePaymentSuccessful) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messagePaymentSuccessful.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messagePaymentSuccessful.tdCommon.Extra, err = r.readString()
+		case "invoice_chat_id":
+			messagePaymentSuccessful.InvoiceChatId, err = r.readInt64()
+		case "invoice_message_id":
+			messagePaymentSuccessful.InvoiceMessageId, err = r.readInt64()
+		case "currency":
+			messagePaymentSuccessful.Currency, err = r.readString()
+		case "total_amount":
+			messagePaymentSuccessful.TotalAmount, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messagePaymentSuccessful *MessagePaymentSuccessful) Clone() *MessagePaymentSuccessful {
+	if messagePaymentSuccessful == nil {
+		return nil
+	}
+	clone := *messagePaymentSuccessful
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messagePaymentSuccessful *MessagePaymentSuccessful) Equal(other *MessagePaymentSuccessful) bool {
+	if messagePaymentSuccessful == nil || other == nil {
+		return messagePaymentSuccessful == other
+	}
+	if messagePaymentSuccessful.InvoiceChatId != other.InvoiceChatId {
+		return false
+	}
+	if messagePaymentSuccessful.InvoiceMessageId != other.InvoiceMessageId {
+		return false
+	}
+	if messagePaymentSuccessful.Currency != other.Currency {
+		return false
+	}
+	if messagePaymentSuccessful.TotalAmount != other.TotalAmount {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messagePaymentSuccessful *MessagePaymentSuccessful) Diff(other *MessagePaymentSuccessful) []Change {
+	return messagePaymentSuccessful.diff("", other, nil)
+}
+
+func (messagePaymentSuccessful *MessagePaymentSuccessful) diff(path string, other *MessagePaymentSuccessful, changes []Change) []Change {
+	if messagePaymentSuccessful == nil || other == nil {
+		if messagePaymentSuccessful != other {
+			changes = append(changes, Change{Path: path, Old: messagePaymentSuccessful, New: other})
+		}
+		return changes
+	}
+	if messagePaymentSuccessful.InvoiceChatId != other.InvoiceChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "invoice_chat_id"), Old: messagePaymentSuccessful.InvoiceChatId, New: other.InvoiceChatId})
+	}
+	if messagePaymentSuccessful.InvoiceMessageId != other.InvoiceMessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "invoice_message_id"), Old: messagePaymentSuccessful.InvoiceMessageId, New: other.InvoiceMessageId})
+	}
+	if messagePaymentSuccessful.Currency != other.Currency {
+		changes = append(changes, Change{Path: fieldPath(path, "currency"), Old: messagePaymentSuccessful.Currency, New: other.Currency})
+	}
+	if messagePaymentSuccessful.TotalAmount != other.TotalAmount {
+		changes = append(changes, Change{Path: fieldPath(path, "total_amount"), Old: messagePaymentSuccessful.TotalAmount, New: other.TotalAmount})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messagePaymentSuccessful *MessagePaymentSuccessful) String() string {
+	return objectString(messagePaymentSuccessful)
+}
+
+// LogValue logs the object as its summary
+func (messagePaymentSuccessful *MessagePaymentSuccessful) LogValue() slog.Value {
+	return slog.StringValue(objectString(messagePaymentSuccessful))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messagePaymentSuccessful *MessagePaymentSuccessful) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messagePaymentSuccessful)
+}
+
+func (messagePaymentSuccessful *MessagePaymentSuccessful) printText(p *printer) {
+	if messagePaymentSuccessful == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessagePaymentSuccessful")
+	p.int("invoice_chat_id", int64(messagePaymentSuccessful.InvoiceChatId))
+	p.int("invoice_message_id", int64(messagePaymentSuccessful.InvoiceMessageId))
+	p.string("currency", messagePaymentSuccessful.Currency)
+	p.int("total_amount", int64(messagePaymentSuccessful.TotalAmount))
+	p.endObject(state)
 }
 
 // GetMessageContentEnum return the enum type of this object
@@ -1668,6 +6837,11 @@ func (messagePaymentSuccessful *MessagePaymentSuccessful) GetMessageContentEnum(
 	return MessagePaymentSuccessfulType
 }
 
+// Accept calls the method of the visitor for MessagePaymentSuccessful
+func (messagePaymentSuccessful *MessagePaymentSuccessful) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessagePaymentSuccessful(messagePaymentSuccessful)
+}
+
 // MessagePaymentSuccessfulBot A payment has been completed; for bots only
 type MessagePaymentSuccessfulBot struct {
 	tdCommon
@@ -1709,11 +6883,195 @@ func NewMessagePaymentSuccessfulBot(currency string, totalAmount int64, invoiceP
 	return &messagePaymentSuccessfulBotTemp
 }
 
+// MarshalJSON marshals to json
+func (messagePaymentSuccessfulBot *MessagePaymentSuccessfulBot) MarshalJSON() ([]byte, error) {
+	return messagePaymentSuccessfulBot.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messagePaymentSuccessfulBot *MessagePaymentSuccessfulBot) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messagePaymentSuccessfulBot)
+}
+
+func (messagePaymentSuccessfulBot *MessagePaymentSuccessfulBot) appendJSON(b []byte) ([]byte, error) {
+	if messagePaymentSuccessfulBot == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messagePaymentSuccessfulBot.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messagePaymentSuccessfulBot.tdCommon.Extra)
+	b = append(b, `,"currency":`...)
+	b = appendJSONString(b, messagePaymentSuccessfulBot.Currency)
+	b = append(b, `,"total_amount":`...)
+	b = appendJSONInt(b, int64(messagePaymentSuccessfulBot.TotalAmount))
+	b = append(b, `,"invoice_payload":`...)
+	b = appendJSONBytes(b, messagePaymentSuccessfulBot.InvoicePayload)
+	b = append(b, `,"shipping_option_id":`...)
+	b = appendJSONString(b, messagePaymentSuccessfulBot.ShippingOptionId)
+	b = append(b, `,"order_info":`...)
+	b, err = messagePaymentSuccessfulBot.OrderInfo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"telegram_payment_charge_id":`...)
+	b = appendJSONString(b, messagePaymentSuccessfulBot.TelegramPaymentChargeId)
+	b = append(b, `,"provider_payment_charge_id":`...)
+	b = appendJSONString(b, messagePaymentSuccessfulBot.ProviderPaymentChargeId)
+	return append(b, '}'), nil
+}
+
+func (messagePaymentSuccessfulBot *MessagePaymentSuccessfulBot) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messagePaymentSuccessfulBot.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messagePaymentSuccessfulBot.tdCommon.Extra, err = r.readString()
+		case "currency":
+			messagePaymentSuccessfulBot.Currency, err = r.readString()
+		case "total_amount":
+			messagePaymentSuccessfulBot.TotalAmount, err = r.readInt64()
+		case "invoice_payload":
+			messagePaymentSuccessfulBot.InvoicePayload, err = r.readBytes()
+		case "shipping_option_id":
+			messagePaymentSuccessfulBot.ShippingOptionId, err = r.readString()
+		case "order_info":
+			if r.readNull() {
+				messagePaymentSuccessfulBot.OrderInfo = nil
+			} else {
+				messagePaymentSuccessfulBot.OrderInfo = new(OrderInfo)
+				err = messagePaymentSuccessfulBot.OrderInfo.decodeJSON(r)
+			}
+		case "telegram_payment_charge_id":
+			messagePaymentSuccessfulBot.TelegramPaymentChargeId, err = r.readString()
+		case "provider_payment_charge_id":
+			messagePaymentSuccessfulBot.ProviderPaymentChargeId, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messagePaymentSuccessfulBot *MessagePaymentSuccessfulBot) Clone() *MessagePaymentSuccessfulBot {
+	if messagePaymentSuccessfulBot == nil {
+		return nil
+	}
+	clone := *messagePaymentSuccessfulBot
+	if messagePaymentSuccessfulBot.InvoicePayload != nil {
+		clone.InvoicePayload = append([]byte{}, messagePaymentSuccessfulBot.InvoicePayload...)
+	}
+	clone.OrderInfo = messagePaymentSuccessfulBot.OrderInfo.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messagePaymentSuccessfulBot *MessagePaymentSuccessfulBot) Equal(other *MessagePaymentSuccessfulBot) bool {
+	if messagePaymentSuccessfulBot == nil || other == nil {
+		return messagePaymentSuccessfulBot == other
+	}
+	if messagePaymentSuccessfulBot.Currency != other.Currency {
+		return false
+	}
+	if messagePaymentSuccessfulBot.TotalAmount != other.TotalAmount {
+		return false
+	}
+	if !equalBytes(messagePaymentSuccessfulBot.InvoicePayload, other.InvoicePayload) {
+		return false
+	}
+	if messagePaymentSuccessfulBot.ShippingOptionId != other.ShippingOptionId {
+		return false
+	}
+	if !messagePaymentSuccessfulBot.OrderInfo.Equal(other.OrderInfo) {
+		return false
+	}
+	if messagePaymentSuccessfulBot.TelegramPaymentChargeId != other.TelegramPaymentChargeId {
+		return false
+	}
+	if messagePaymentSuccessfulBot.ProviderPaymentChargeId != other.ProviderPaymentChargeId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messagePaymentSuccessfulBot *MessagePaymentSuccessfulBot) Diff(other *MessagePaymentSuccessfulBot) []Change {
+	return messagePaymentSuccessfulBot.diff("", other, nil)
+}
+
+func (messagePaymentSuccessfulBot *MessagePaymentSuccessfulBot) diff(path string, other *MessagePaymentSuccessfulBot, changes []Change) []Change {
+	if messagePaymentSuccessfulBot == nil || other == nil {
+		if messagePaymentSuccessfulBot != other {
+			changes = append(changes, Change{Path: path, Old: messagePaymentSuccessfulBot, New: other})
+		}
+		return changes
+	}
+	if messagePaymentSuccessfulBot.Currency != other.Currency {
+		changes = append(changes, Change{Path: fieldPath(path, "currency"), Old: messagePaymentSuccessfulBot.Currency, New: other.Currency})
+	}
+	if messagePaymentSuccessfulBot.TotalAmount != other.TotalAmount {
+		changes = append(changes, Change{Path: fieldPath(path, "total_amount"), Old: messagePaymentSuccessfulBot.TotalAmount, New: other.TotalAmount})
+	}
+	if !equalBytes(messagePaymentSuccessfulBot.InvoicePayload, other.InvoicePayload) {
+		changes = append(changes, Change{Path: fieldPath(path, "invoice_payload"), Old: messagePaymentSuccessfulBot.InvoicePayload, New: other.InvoicePayload})
+	}
+	if messagePaymentSuccessfulBot.ShippingOptionId != other.ShippingOptionId {
+		changes = append(changes, Change{Path: fieldPath(path, "shipping_option_id"), Old: messagePaymentSuccessfulBot.ShippingOptionId, New: other.ShippingOptionId})
+	}
+	changes = messagePaymentSuccessfulBot.OrderInfo.diff(fieldPath(path, "order_info"), other.OrderInfo, changes)
+	if messagePaymentSuccessfulBot.TelegramPaymentChargeId != other.TelegramPaymentChargeId {
+		changes = append(changes, Change{Path: fieldPath(path, "telegram_payment_charge_id"), Old: messagePaymentSuccessfulBot.TelegramPaymentChargeId, New: other.TelegramPaymentChargeId})
+	}
+	if messagePaymentSuccessfulBot.ProviderPaymentChargeId != other.ProviderPaymentChargeId {
+		changes = append(changes, Change{Path: fieldPath(path, "provider_payment_charge_id"), Old: messagePaymentSuccessfulBot.ProviderPaymentChargeId, New: other.ProviderPaymentChargeId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messagePaymentSuccessfulBot *MessagePaymentSuccessfulBot) String() string {
+	return objectString(messagePaymentSuccessfulBot)
+}
+
+// LogValue logs the object as its summary
+func (messagePaymentSuccessfulBot *MessagePaymentSuccessfulBot) LogValue() slog.Value {
+	return slog.StringValue(objectString(messagePaymentSuccessfulBot))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messagePaymentSuccessfulBot *MessagePaymentSuccessfulBot) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messagePaymentSuccessfulBot)
+}
+
+func (messagePaymentSuccessfulBot *MessagePaymentSuccessfulBot) printText(p *printer) {
+	if messagePaymentSuccessfulBot == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessagePaymentSuccessfulBot")
+	p.string("currency", messagePaymentSuccessfulBot.Currency)
+	p.int("total_amount", int64(messagePaymentSuccessfulBot.TotalAmount))
+	p.bytes("invoice_payload", messagePaymentSuccessfulBot.InvoicePayload)
+	p.string("shipping_option_id", messagePaymentSuccessfulBot.ShippingOptionId)
+	p.object("order_info", messagePaymentSuccessfulBot.OrderInfo)
+	p.string("telegram_payment_charge_id", messagePaymentSuccessfulBot.TelegramPaymentChargeId)
+	p.string("provider_payment_charge_id", messagePaymentSuccessfulBot.ProviderPaymentChargeId)
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messagePaymentSuccessfulBot *MessagePaymentSuccessfulBot) GetMessageContentEnum() MessageContentEnum {
 	return MessagePaymentSuccessfulBotType
 }
 
+// Accept calls the method of the visitor for MessagePaymentSuccessfulBot
+func (messagePaymentSuccessfulBot *MessagePaymentSuccessfulBot) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessagePaymentSuccessfulBot(messagePaymentSuccessfulBot)
+}
+
 // MessageContactRegistered A contact has registered with Telegram
 type MessageContactRegistered struct {
 	tdCommon
@@ -1725,7 +7083,6 @@ func (messageContactRegistered *MessageContactRegistered) MessageType() string {
 }
 
 // NewMessageContactRegistered creates a new MessageContactRegistered
-//
 func NewMessageContactRegistered() *MessageContactRegistered {
 	messageContactRegisteredTemp := MessageContactRegistered{
 		tdCommon: tdCommon{Type: "messageContactRegistered"},
@@ -1734,11 +7091,107 @@ func NewMessageContactRegistered() *MessageContactRegistered {
 	return &messageContactRegisteredTemp
 }
 
+// MarshalJSON marshals to json
+func (messageContactRegistered *MessageContactRegistered) MarshalJSON() ([]byte, error) {
+	return messageContactRegistered.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageContactRegistered *MessageContactRegistered) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageContactRegistered)
+}
+
+func (messageContactRegistered *MessageContactRegistered) appendJSON(b []byte) ([]byte, error) {
+	if messageContactRegistered == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageContactRegistered.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageContactRegistered.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (messageContactRegistered *MessageContactRegistered) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageContactRegistered.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageContactRegistered.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageContactRegistered *MessageContactRegistered) Clone() *MessageContactRegistered {
+	if messageContactRegistered == nil {
+		return nil
+	}
+	clone := *messageContactRegistered
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageContactRegistered *MessageContactRegistered) Equal(other *MessageContactRegistered) bool {
+	if messageContactRegistered == nil || other == nil {
+		return messageContactRegistered == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageContactRegistered *MessageContactRegistered) Diff(other *MessageContactRegistered) []Change {
+	return messageContactRegistered.diff("", other, nil)
+}
+
+func (messageContactRegistered *MessageContactRegistered) diff(path string, other *MessageContactRegistered, changes []Change) []Change {
+	if messageContactRegistered == nil || other == nil {
+		if messageContactRegistered != other {
+			changes = append(changes, Change{Path: path, Old: messageContactRegistered, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageContactRegistered *MessageContactRegistered) String() string {
+	return objectString(messageContactRegistered)
+}
+
+// LogValue logs the object as its summary
+func (messageContactRegistered *MessageContactRegistered) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageContactRegistered))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageContactRegistered *MessageContactRegistered) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageContactRegistered)
+}
+
+func (messageContactRegistered *MessageContactRegistered) printText(p *printer) {
+	if messageContactRegistered == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageContactRegistered")
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageContactRegistered *MessageContactRegistered) GetMessageContentEnum() MessageContentEnum {
 	return MessageContactRegisteredType
 }
 
+// Accept calls the method of the visitor for MessageContactRegistered
+func (messageContactRegistered *MessageContactRegistered) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageContactRegistered(messageContactRegistered)
+}
+
 // MessageWebsiteConnected The current user has connected a website by logging in using Telegram Login Widget on it
 type MessageWebsiteConnected struct {
 	tdCommon
@@ -1762,11 +7215,118 @@ func NewMessageWebsiteConnected(domainName string) *MessageWebsiteConnected {
 	return &messageWebsiteConnectedTemp
 }
 
+// MarshalJSON marshals to json
+func (messageWebsiteConnected *MessageWebsiteConnected) MarshalJSON() ([]byte, error) {
+	return messageWebsiteConnected.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageWebsiteConnected *MessageWebsiteConnected) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageWebsiteConnected)
+}
+
+func (messageWebsiteConnected *MessageWebsiteConnected) appendJSON(b []byte) ([]byte, error) {
+	if messageWebsiteConnected == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageWebsiteConnected.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageWebsiteConnected.tdCommon.Extra)
+	b = append(b, `,"domain_name":`...)
+	b = appendJSONString(b, messageWebsiteConnected.DomainName)
+	return append(b, '}'), nil
+}
+
+func (messageWebsiteConnected *MessageWebsiteConnected) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageWebsiteConnected.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageWebsiteConnected.tdCommon.Extra, err = r.readString()
+		case "domain_name":
+			messageWebsiteConnected.DomainName, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageWebsiteConnected *MessageWebsiteConnected) Clone() *MessageWebsiteConnected {
+	if messageWebsiteConnected == nil {
+		return nil
+	}
+	clone := *messageWebsiteConnected
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageWebsiteConnected *MessageWebsiteConnected) Equal(other *MessageWebsiteConnected) bool {
+	if messageWebsiteConnected == nil || other == nil {
+		return messageWebsiteConnected == other
+	}
+	if messageWebsiteConnected.DomainName != other.DomainName {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageWebsiteConnected *MessageWebsiteConnected) Diff(other *MessageWebsiteConnected) []Change {
+	return messageWebsiteConnected.diff("", other, nil)
+}
+
+func (messageWebsiteConnected *MessageWebsiteConnected) diff(path string, other *MessageWebsiteConnected, changes []Change) []Change {
+	if messageWebsiteConnected == nil || other == nil {
+		if messageWebsiteConnected != other {
+			changes = append(changes, Change{Path: path, Old: messageWebsiteConnected, New: other})
+		}
+		return changes
+	}
+	if messageWebsiteConnected.DomainName != other.DomainName {
+		changes = append(changes, Change{Path: fieldPath(path, "domain_name"), Old: messageWebsiteConnected.DomainName, New: other.DomainName})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageWebsiteConnected *MessageWebsiteConnected) String() string {
+	return objectString(messageWebsiteConnected)
+}
+
+// LogValue logs the object as its summary
+func (messageWebsiteConnected *MessageWebsiteConnected) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageWebsiteConnected))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageWebsiteConnected *MessageWebsiteConnected) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageWebsiteConnected)
+}
+
+func (messageWebsiteConnected *MessageWebsiteConnected) printText(p *printer) {
+	if messageWebsiteConnected == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageWebsiteConnected")
+	p.string("domain_name", messageWebsiteConnected.DomainName)
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageWebsiteConnected *MessageWebsiteConnected) GetMessageContentEnum() MessageContentEnum {
 	return MessageWebsiteConnectedType
 }
 
+// Accept calls the method of the visitor for MessageWebsiteConnected
+func (messageWebsiteConnected *MessageWebsiteConnected) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageWebsiteConnected(messageWebsiteConnected)
+}
+
 // MessagePassportDataSent Telegram Passport data has been sent
 type MessagePassportDataSent struct {
 	tdCommon
@@ -1790,11 +7350,160 @@ func NewMessagePassportDataSent(typeParams []PassportElementType) *MessagePasspo
 	return &messagePassportDataSentTemp
 }
 
+// MarshalJSON marshals to json
+func (messagePassportDataSent *MessagePassportDataSent) MarshalJSON() ([]byte, error) {
+	return messagePassportDataSent.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messagePassportDataSent *MessagePassportDataSent) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messagePassportDataSent)
+}
+
+func (messagePassportDataSent *MessagePassportDataSent) appendJSON(b []byte) ([]byte, error) {
+	if messagePassportDataSent == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messagePassportDataSent.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messagePassportDataSent.tdCommon.Extra)
+	b = append(b, `,"types":`...)
+	if messagePassportDataSent.Types == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range messagePassportDataSent.Types {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = appendJSONValue(b, messagePassportDataSent.Types[i0])
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (messagePassportDataSent *MessagePassportDataSent) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messagePassportDataSent.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messagePassportDataSent.tdCommon.Extra, err = r.readString()
+		case "types":
+			if r.readNull() {
+				messagePassportDataSent.Types = nil
+			} else {
+				messagePassportDataSent.Types = make([]PassportElementType, 0)
+				err = r.readArray(func() (err error) {
+					var item0 PassportElementType
+					item0, err = decodePassportElementType(r)
+					messagePassportDataSent.Types = append(messagePassportDataSent.Types, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messagePassportDataSent *MessagePassportDataSent) Clone() *MessagePassportDataSent {
+	if messagePassportDataSent == nil {
+		return nil
+	}
+	clone := *messagePassportDataSent
+	if messagePassportDataSent.Types != nil {
+		clone.Types = make([]PassportElementType, len(messagePassportDataSent.Types))
+		for i0 := range messagePassportDataSent.Types {
+			clone.Types[i0] = clonePassportElementType(messagePassportDataSent.Types[i0])
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messagePassportDataSent *MessagePassportDataSent) Equal(other *MessagePassportDataSent) bool {
+	if messagePassportDataSent == nil || other == nil {
+		return messagePassportDataSent == other
+	}
+	if len(messagePassportDataSent.Types) != len(other.Types) {
+		return false
+	}
+	for i0 := range messagePassportDataSent.Types {
+		if !equalPassportElementType(messagePassportDataSent.Types[i0], other.Types[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messagePassportDataSent *MessagePassportDataSent) Diff(other *MessagePassportDataSent) []Change {
+	return messagePassportDataSent.diff("", other, nil)
+}
+
+func (messagePassportDataSent *MessagePassportDataSent) diff(path string, other *MessagePassportDataSent, changes []Change) []Change {
+	if messagePassportDataSent == nil || other == nil {
+		if messagePassportDataSent != other {
+			changes = append(changes, Change{Path: path, Old: messagePassportDataSent, New: other})
+		}
+		return changes
+	}
+	if len(messagePassportDataSent.Types) != len(other.Types) {
+		changes = append(changes, Change{Path: fieldPath(path, "types"), Old: messagePassportDataSent.Types, New: other.Types})
+	} else {
+		for i0 := range messagePassportDataSent.Types {
+			changes = diffPassportElementType(indexPath(fieldPath(path, "types"), i0), messagePassportDataSent.Types[i0], other.Types[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messagePassportDataSent *MessagePassportDataSent) String() string {
+	return objectString(messagePassportDataSent)
+}
+
+// LogValue logs the object as its summary
+func (messagePassportDataSent *MessagePassportDataSent) LogValue() slog.Value {
+	return slog.StringValue(objectString(messagePassportDataSent))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messagePassportDataSent *MessagePassportDataSent) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messagePassportDataSent)
+}
+
+func (messagePassportDataSent *MessagePassportDataSent) printText(p *printer) {
+	if messagePassportDataSent == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessagePassportDataSent")
+	p.vector("types", len(messagePassportDataSent.Types), func(i0 int) {
+		p.object("types", messagePassportDataSent.Types[i0])
+	})
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messagePassportDataSent *MessagePassportDataSent) GetMessageContentEnum() MessageContentEnum {
 	return MessagePassportDataSentType
 }
 
+// Accept calls the method of the visitor for MessagePassportDataSent
+func (messagePassportDataSent *MessagePassportDataSent) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessagePassportDataSent(messagePassportDataSent)
+}
+
 // MessagePassportDataReceived Telegram Passport data has been received; for bots only
 type MessagePassportDataReceived struct {
 	tdCommon
@@ -1821,11 +7530,178 @@ func NewMessagePassportDataReceived(elements []EncryptedPassportElement, credent
 	return &messagePassportDataReceivedTemp
 }
 
+// MarshalJSON marshals to json
+func (messagePassportDataReceived *MessagePassportDataReceived) MarshalJSON() ([]byte, error) {
+	return messagePassportDataReceived.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messagePassportDataReceived *MessagePassportDataReceived) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messagePassportDataReceived)
+}
+
+func (messagePassportDataReceived *MessagePassportDataReceived) appendJSON(b []byte) ([]byte, error) {
+	if messagePassportDataReceived == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messagePassportDataReceived.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messagePassportDataReceived.tdCommon.Extra)
+	b = append(b, `,"elements":`...)
+	if messagePassportDataReceived.Elements == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range messagePassportDataReceived.Elements {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = messagePassportDataReceived.Elements[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"credentials":`...)
+	b, err = messagePassportDataReceived.Credentials.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (messagePassportDataReceived *MessagePassportDataReceived) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messagePassportDataReceived.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messagePassportDataReceived.tdCommon.Extra, err = r.readString()
+		case "elements":
+			if r.readNull() {
+				messagePassportDataReceived.Elements = nil
+			} else {
+				messagePassportDataReceived.Elements = make([]EncryptedPassportElement, 0)
+				err = r.readArray(func() (err error) {
+					var item0 EncryptedPassportElement
+					err = item0.decodeJSON(r)
+					messagePassportDataReceived.Elements = append(messagePassportDataReceived.Elements, item0)
+					return
+				})
+			}
+		case "credentials":
+			if r.readNull() {
+				messagePassportDataReceived.Credentials = nil
+			} else {
+				messagePassportDataReceived.Credentials = new(EncryptedCredentials)
+				err = messagePassportDataReceived.Credentials.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messagePassportDataReceived *MessagePassportDataReceived) Clone() *MessagePassportDataReceived {
+	if messagePassportDataReceived == nil {
+		return nil
+	}
+	clone := *messagePassportDataReceived
+	if messagePassportDataReceived.Elements != nil {
+		clone.Elements = make([]EncryptedPassportElement, len(messagePassportDataReceived.Elements))
+		for i0 := range messagePassportDataReceived.Elements {
+			clone.Elements[i0] = *messagePassportDataReceived.Elements[i0].Clone()
+		}
+	}
+	clone.Credentials = messagePassportDataReceived.Credentials.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messagePassportDataReceived *MessagePassportDataReceived) Equal(other *MessagePassportDataReceived) bool {
+	if messagePassportDataReceived == nil || other == nil {
+		return messagePassportDataReceived == other
+	}
+	if len(messagePassportDataReceived.Elements) != len(other.Elements) {
+		return false
+	}
+	for i0 := range messagePassportDataReceived.Elements {
+		if !messagePassportDataReceived.Elements[i0].Equal(&other.Elements[i0]) {
+			return false
+		}
+	}
+	if !messagePassportDataReceived.Credentials.Equal(other.Credentials) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messagePassportDataReceived *MessagePassportDataReceived) Diff(other *MessagePassportDataReceived) []Change {
+	return messagePassportDataReceived.diff("", other, nil)
+}
+
+func (messagePassportDataReceived *MessagePassportDataReceived) diff(path string, other *MessagePassportDataReceived, changes []Change) []Change {
+	if messagePassportDataReceived == nil || other == nil {
+		if messagePassportDataReceived != other {
+			changes = append(changes, Change{Path: path, Old: messagePassportDataReceived, New: other})
+		}
+		return changes
+	}
+	if len(messagePassportDataReceived.Elements) != len(other.Elements) {
+		changes = append(changes, Change{Path: fieldPath(path, "elements"), Old: messagePassportDataReceived.Elements, New: other.Elements})
+	} else {
+		for i0 := range messagePassportDataReceived.Elements {
+			changes = messagePassportDataReceived.Elements[i0].diff(indexPath(fieldPath(path, "elements"), i0), &other.Elements[i0], changes)
+		}
+	}
+	changes = messagePassportDataReceived.Credentials.diff(fieldPath(path, "credentials"), other.Credentials, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messagePassportDataReceived *MessagePassportDataReceived) String() string {
+	return objectString(messagePassportDataReceived)
+}
+
+// LogValue logs the object as its summary
+func (messagePassportDataReceived *MessagePassportDataReceived) LogValue() slog.Value {
+	return slog.StringValue(objectString(messagePassportDataReceived))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messagePassportDataReceived *MessagePassportDataReceived) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messagePassportDataReceived)
+}
+
+func (messagePassportDataReceived *MessagePassportDataReceived) printText(p *printer) {
+	if messagePassportDataReceived == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessagePassportDataReceived")
+	p.vector("elements", len(messagePassportDataReceived.Elements), func(i0 int) {
+		messagePassportDataReceived.Elements[i0].printText(p)
+	})
+	p.object("credentials", messagePassportDataReceived.Credentials)
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messagePassportDataReceived *MessagePassportDataReceived) GetMessageContentEnum() MessageContentEnum {
 	return MessagePassportDataReceivedType
 }
 
+// Accept calls the method of the visitor for MessagePassportDataReceived
+func (messagePassportDataReceived *MessagePassportDataReceived) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessagePassportDataReceived(messagePassportDataReceived)
+}
+
 // MessageProximityAlertTriggered A user in the chat came within proximity alert range
 type MessageProximityAlertTriggered struct {
 	tdCommon
@@ -1855,32 +7731,133 @@ func NewMessageProximityAlertTriggered(travelerId MessageSender, watcherId Messa
 	return &messageProximityAlertTriggeredTemp
 }
 
+// MarshalJSON marshals to json
+func (messageProximityAlertTriggered *MessageProximityAlertTriggered) MarshalJSON() ([]byte, error) {
+	return messageProximityAlertTriggered.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (messageProximityAlertTriggered *MessageProximityAlertTriggered) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, messageProximityAlertTriggered)
+}
+
+func (messageProximityAlertTriggered *MessageProximityAlertTriggered) appendJSON(b []byte) ([]byte, error) {
+	if messageProximityAlertTriggered == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageProximityAlertTriggered.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageProximityAlertTriggered.tdCommon.Extra)
+	b = append(b, `,"traveler_id":`...)
+	b, err = appendJSONValue(b, messageProximityAlertTriggered.TravelerId)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-		Distance int32 `json:"distance"` // The distance between the users
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	b = append(b, `,"watcher_id":`...)
+	b, err = appendJSONValue(b, messageProximityAlertTriggered.WatcherId)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"distance":`...)
+	b = appendJSONInt(b, int64(messageProximityAlertTriggered.Distance))
+	return append(b, '}'), nil
+}
+
+func (messageProximityAlertTriggered *MessageProximityAlertTriggered) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageProximityAlertTriggered.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageProximityAlertTriggered.tdCommon.Extra, err = r.readString()
+		case "traveler_id":
+			messageProximityAlertTriggered.TravelerId, err = decodeMessageSender(r)
+		case "watcher_id":
+			messageProximityAlertTriggered.WatcherId, err = decodeMessageSender(r)
+		case "distance":
+			messageProximityAlertTriggered.Distance, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageProximityAlertTriggered *MessageProximityAlertTriggered) Clone() *MessageProximityAlertTriggered {
+	if messageProximityAlertTriggered == nil {
+		return nil
+	}
+	clone := *messageProximityAlertTriggered
+	clone.TravelerId = cloneMessageSender(messageProximityAlertTriggered.TravelerId)
+	clone.WatcherId = cloneMessageSender(messageProximityAlertTriggered.WatcherId)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageProximityAlertTriggered *MessageProximityAlertTriggered) Equal(other *MessageProximityAlertTriggered) bool {
+	if messageProximityAlertTriggered == nil || other == nil {
+		return messageProximityAlertTriggered == other
+	}
+	if !equalMessageSender(messageProximityAlertTriggered.TravelerId, other.TravelerId) {
+		return false
+	}
+	if !equalMessageSender(messageProximityAlertTriggered.WatcherId, other.WatcherId) {
+		return false
+	}
+	if messageProximityAlertTriggered.Distance != other.Distance {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageProximityAlertTriggered *MessageProximityAlertTriggered) Diff(other *MessageProximityAlertTriggered) []Change {
+	return messageProximityAlertTriggered.diff("", other, nil)
+}
+
+func (messageProximityAlertTriggered *MessageProximityAlertTriggered) diff(path string, other *MessageProximityAlertTriggered, changes []Change) []Change {
+	if messageProximityAlertTriggered == nil || other == nil {
+		if messageProximityAlertTriggered != other {
+			changes = append(changes, Change{Path: path, Old: messageProximityAlertTriggered, New: other})
+		}
+		return changes
 	}
+	changes = diffMessageSender(fieldPath(path, "traveler_id"), messageProximityAlertTriggered.TravelerId, other.TravelerId, changes)
+	changes = diffMessageSender(fieldPath(path, "watcher_id"), messageProximityAlertTriggered.WatcherId, other.WatcherId, changes)
+	if messageProximityAlertTriggered.Distance != other.Distance {
+		changes = append(changes, Change{Path: fieldPath(path, "distance"), Old: messageProximityAlertTriggered.Distance, New: other.Distance})
+	}
+	return changes
+}
 
-	messageProximityAlertTriggered.tdCommon = tempObj.tdCommon
-	messageProximityAlertTriggered.Distance = tempObj.Distance
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageProximityAlertTriggered *MessageProximityAlertTriggered) String() string {
+	return objectString(messageProximityAlertTriggered)
+}
 
-	fieldTravelerId, _ := unmarshalMessageSender(objMap["traveler_id"])
-	messageProximityAlertTriggered.TravelerId = fieldTravelerId
+// LogValue logs the object as its summary
+func (messageProximityAlertTriggered *MessageProximityAlertTriggered) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageProximityAlertTriggered))
+}
 
-	fieldWatcherId, _ := unmarshalMessageSender(objMap["watcher_id"])
-	messageProximityAlertTriggered.WatcherId = fieldWatcherId
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageProximityAlertTriggered *MessageProximityAlertTriggered) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageProximityAlertTriggered)
+}
 
-	return nil
+func (messageProximityAlertTriggered *MessageProximityAlertTriggered) printText(p *printer) {
+	if messageProximityAlertTriggered == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageProximityAlertTriggered")
+	p.object("traveler_id", messageProximityAlertTriggered.TravelerId)
+	p.object("watcher_id", messageProximityAlertTriggered.WatcherId)
+	p.int("distance", int64(messageProximityAlertTriggered.Distance))
+	p.endObject(state)
 }
 
 // GetMessageContentEnum return the enum type of this object
@@ -1888,6 +7865,11 @@ func (messageProximityAlertTriggered *MessageProximityAlertTriggered) GetMessage
 	return MessageProximityAlertTriggeredType
 }
 
+// Accept calls the method of the visitor for MessageProximityAlertTriggered
+func (messageProximityAlertTriggered *MessageProximityAlertTriggered) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageProximityAlertTriggered(messageProximityAlertTriggered)
+}
+
 // MessageUnsupported Message content that is not supported in the current TDLib version
 type MessageUnsupported struct {
 	tdCommon
@@ -1899,7 +7881,6 @@ func (messageUnsupported *MessageUnsupported) MessageType() string {
 }
 
 // NewMessageUnsupported creates a new MessageUnsupported
-//
 func NewMessageUnsupported() *MessageUnsupported {
 	messageUnsupportedTemp := MessageUnsupported{
 		tdCommon: tdCommon{Type: "messageUnsupported"},
@@ -1908,7 +7889,903 @@ func NewMessageUnsupported() *MessageUnsupported {
 	return &messageUnsupportedTemp
 }
 
+// MarshalJSON marshals to json
+func (messageUnsupported *MessageUnsupported) MarshalJSON() ([]byte, error) {
+	return messageUnsupported.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageUnsupported *MessageUnsupported) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageUnsupported)
+}
+
+func (messageUnsupported *MessageUnsupported) appendJSON(b []byte) ([]byte, error) {
+	if messageUnsupported == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageUnsupported.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageUnsupported.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (messageUnsupported *MessageUnsupported) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageUnsupported.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageUnsupported.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageUnsupported *MessageUnsupported) Clone() *MessageUnsupported {
+	if messageUnsupported == nil {
+		return nil
+	}
+	clone := *messageUnsupported
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageUnsupported *MessageUnsupported) Equal(other *MessageUnsupported) bool {
+	if messageUnsupported == nil || other == nil {
+		return messageUnsupported == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageUnsupported *MessageUnsupported) Diff(other *MessageUnsupported) []Change {
+	return messageUnsupported.diff("", other, nil)
+}
+
+func (messageUnsupported *MessageUnsupported) diff(path string, other *MessageUnsupported, changes []Change) []Change {
+	if messageUnsupported == nil || other == nil {
+		if messageUnsupported != other {
+			changes = append(changes, Change{Path: path, Old: messageUnsupported, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageUnsupported *MessageUnsupported) String() string {
+	return objectString(messageUnsupported)
+}
+
+// LogValue logs the object as its summary
+func (messageUnsupported *MessageUnsupported) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageUnsupported))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageUnsupported *MessageUnsupported) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageUnsupported)
+}
+
+func (messageUnsupported *MessageUnsupported) printText(p *printer) {
+	if messageUnsupported == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageUnsupported")
+	p.endObject(state)
+}
+
 // GetMessageContentEnum return the enum type of this object
 func (messageUnsupported *MessageUnsupported) GetMessageContentEnum() MessageContentEnum {
 	return MessageUnsupportedType
 }
+
+// Accept calls the method of the visitor for MessageUnsupported
+func (messageUnsupported *MessageUnsupported) Accept(visitor MessageContentVisitor) {
+	visitor.VisitMessageUnsupported(messageUnsupported)
+}
+
+// MessageContentVisitor visits every type of MessageContent, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to MessageContent, until they handle it too.
+type MessageContentVisitor interface {
+	VisitMessageText(messageText *MessageText)
+	VisitMessageAnimation(messageAnimation *MessageAnimation)
+	VisitMessageAudio(messageAudio *MessageAudio)
+	VisitMessageDocument(messageDocument *MessageDocument)
+	VisitMessagePhoto(messagePhoto *MessagePhoto)
+	VisitMessageExpiredPhoto(messageExpiredPhoto *MessageExpiredPhoto)
+	VisitMessageSticker(messageSticker *MessageSticker)
+	VisitMessageVideo(messageVideo *MessageVideo)
+	VisitMessageExpiredVideo(messageExpiredVideo *MessageExpiredVideo)
+	VisitMessageVideoNote(messageVideoNote *MessageVideoNote)
+	VisitMessageVoiceNote(messageVoiceNote *MessageVoiceNote)
+	VisitMessageLocation(messageLocation *MessageLocation)
+	VisitMessageVenue(messageVenue *MessageVenue)
+	VisitMessageContact(messageContact *MessageContact)
+	VisitMessageAnimatedEmoji(messageAnimatedEmoji *MessageAnimatedEmoji)
+	VisitMessageDice(messageDice *MessageDice)
+	VisitMessageGame(messageGame *MessageGame)
+	VisitMessagePoll(messagePoll *MessagePoll)
+	VisitMessageInvoice(messageInvoice *MessageInvoice)
+	VisitMessageCall(messageCall *MessageCall)
+	VisitMessageVideoChatScheduled(messageVideoChatScheduled *MessageVideoChatScheduled)
+	VisitMessageVideoChatStarted(messageVideoChatStarted *MessageVideoChatStarted)
+	VisitMessageVideoChatEnded(messageVideoChatEnded *MessageVideoChatEnded)
+	VisitMessageInviteVideoChatParticipants(messageInviteVideoChatParticipants *MessageInviteVideoChatParticipants)
+	VisitMessageBasicGroupChatCreate(messageBasicGroupChatCreate *MessageBasicGroupChatCreate)
+	VisitMessageSupergroupChatCreate(messageSupergroupChatCreate *MessageSupergroupChatCreate)
+	VisitMessageChatChangeTitle(messageChatChangeTitle *MessageChatChangeTitle)
+	VisitMessageChatChangePhoto(messageChatChangePhoto *MessageChatChangePhoto)
+	VisitMessageChatDeletePhoto(messageChatDeletePhoto *MessageChatDeletePhoto)
+	VisitMessageChatAddMembers(messageChatAddMembers *MessageChatAddMembers)
+	VisitMessageChatJoinByLink(messageChatJoinByLink *MessageChatJoinByLink)
+	VisitMessageChatJoinByRequest(messageChatJoinByRequest *MessageChatJoinByRequest)
+	VisitMessageChatDeleteMember(messageChatDeleteMember *MessageChatDeleteMember)
+	VisitMessageChatUpgradeTo(messageChatUpgradeTo *MessageChatUpgradeTo)
+	VisitMessageChatUpgradeFrom(messageChatUpgradeFrom *MessageChatUpgradeFrom)
+	VisitMessagePinMessage(messagePinMessage *MessagePinMessage)
+	VisitMessageScreenshotTaken(messageScreenshotTaken *MessageScreenshotTaken)
+	VisitMessageChatSetTheme(messageChatSetTheme *MessageChatSetTheme)
+	VisitMessageChatSetTtl(messageChatSetTtl *MessageChatSetTtl)
+	VisitMessageCustomServiceAction(messageCustomServiceAction *MessageCustomServiceAction)
+	VisitMessageGameScore(messageGameScore *MessageGameScore)
+	VisitMessagePaymentSuccessful(messagePaymentSuccessful *MessagePaymentSuccessful)
+	VisitMessagePaymentSuccessfulBot(messagePaymentSuccessfulBot *MessagePaymentSuccessfulBot)
+	VisitMessageContactRegistered(messageContactRegistered *MessageContactRegistered)
+	VisitMessageWebsiteConnected(messageWebsiteConnected *MessageWebsiteConnected)
+	VisitMessagePassportDataSent(messagePassportDataSent *MessagePassportDataSent)
+	VisitMessagePassportDataReceived(messagePassportDataReceived *MessagePassportDataReceived)
+	VisitMessageProximityAlertTriggered(messageProximityAlertTriggered *MessageProximityAlertTriggered)
+	VisitMessageUnsupported(messageUnsupported *MessageUnsupported)
+	VisitUnknownMessageContent(unknownMessageContent *UnknownMessageContent)
+}
+
+// MessageContentCases holds the function MatchMessageContent calls for each type of MessageContent;
+// types without a function are passed to the default case
+type MessageContentCases[R any] struct {
+	MessageText                        func(messageText *MessageText) R
+	MessageAnimation                   func(messageAnimation *MessageAnimation) R
+	MessageAudio                       func(messageAudio *MessageAudio) R
+	MessageDocument                    func(messageDocument *MessageDocument) R
+	MessagePhoto                       func(messagePhoto *MessagePhoto) R
+	MessageExpiredPhoto                func(messageExpiredPhoto *MessageExpiredPhoto) R
+	MessageSticker                     func(messageSticker *MessageSticker) R
+	MessageVideo                       func(messageVideo *MessageVideo) R
+	MessageExpiredVideo                func(messageExpiredVideo *MessageExpiredVideo) R
+	MessageVideoNote                   func(messageVideoNote *MessageVideoNote) R
+	MessageVoiceNote                   func(messageVoiceNote *MessageVoiceNote) R
+	MessageLocation                    func(messageLocation *MessageLocation) R
+	MessageVenue                       func(messageVenue *MessageVenue) R
+	MessageContact                     func(messageContact *MessageContact) R
+	MessageAnimatedEmoji               func(messageAnimatedEmoji *MessageAnimatedEmoji) R
+	MessageDice                        func(messageDice *MessageDice) R
+	MessageGame                        func(messageGame *MessageGame) R
+	MessagePoll                        func(messagePoll *MessagePoll) R
+	MessageInvoice                     func(messageInvoice *MessageInvoice) R
+	MessageCall                        func(messageCall *MessageCall) R
+	MessageVideoChatScheduled          func(messageVideoChatScheduled *MessageVideoChatScheduled) R
+	MessageVideoChatStarted            func(messageVideoChatStarted *MessageVideoChatStarted) R
+	MessageVideoChatEnded              func(messageVideoChatEnded *MessageVideoChatEnded) R
+	MessageInviteVideoChatParticipants func(messageInviteVideoChatParticipants *MessageInviteVideoChatParticipants) R
+	MessageBasicGroupChatCreate        func(messageBasicGroupChatCreate *MessageBasicGroupChatCreate) R
+	MessageSupergroupChatCreate        func(messageSupergroupChatCreate *MessageSupergroupChatCreate) R
+	MessageChatChangeTitle             func(messageChatChangeTitle *MessageChatChangeTitle) R
+	MessageChatChangePhoto             func(messageChatChangePhoto *MessageChatChangePhoto) R
+	MessageChatDeletePhoto             func(messageChatDeletePhoto *MessageChatDeletePhoto) R
+	MessageChatAddMembers              func(messageChatAddMembers *MessageChatAddMembers) R
+	MessageChatJoinByLink              func(messageChatJoinByLink *MessageChatJoinByLink) R
+	MessageChatJoinByRequest           func(messageChatJoinByRequest *MessageChatJoinByRequest) R
+	MessageChatDeleteMember            func(messageChatDeleteMember *MessageChatDeleteMember) R
+	MessageChatUpgradeTo               func(messageChatUpgradeTo *MessageChatUpgradeTo) R
+	MessageChatUpgradeFrom             func(messageChatUpgradeFrom *MessageChatUpgradeFrom) R
+	MessagePinMessage                  func(messagePinMessage *MessagePinMessage) R
+	MessageScreenshotTaken             func(messageScreenshotTaken *MessageScreenshotTaken) R
+	MessageChatSetTheme                func(messageChatSetTheme *MessageChatSetTheme) R
+	MessageChatSetTtl                  func(messageChatSetTtl *MessageChatSetTtl) R
+	MessageCustomServiceAction         func(messageCustomServiceAction *MessageCustomServiceAction) R
+	MessageGameScore                   func(messageGameScore *MessageGameScore) R
+	MessagePaymentSuccessful           func(messagePaymentSuccessful *MessagePaymentSuccessful) R
+	MessagePaymentSuccessfulBot        func(messagePaymentSuccessfulBot *MessagePaymentSuccessfulBot) R
+	MessageContactRegistered           func(messageContactRegistered *MessageContactRegistered) R
+	MessageWebsiteConnected            func(messageWebsiteConnected *MessageWebsiteConnected) R
+	MessagePassportDataSent            func(messagePassportDataSent *MessagePassportDataSent) R
+	MessagePassportDataReceived        func(messagePassportDataReceived *MessagePassportDataReceived) R
+	MessageProximityAlertTriggered     func(messageProximityAlertTriggered *MessageProximityAlertTriggered) R
+	MessageUnsupported                 func(messageUnsupported *MessageUnsupported) R
+}
+
+// MatchMessageContent calls the case of the type of messageContent, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement MessageContentVisitor
+// to have the compiler check every type is handled.
+func MatchMessageContent[R any](messageContent MessageContent, cases MessageContentCases[R], defaultCase func(messageContent MessageContent) R) R {
+	switch value := messageContent.(type) {
+	case *MessageText:
+		if cases.MessageText != nil {
+			return cases.MessageText(value)
+		}
+	case *MessageAnimation:
+		if cases.MessageAnimation != nil {
+			return cases.MessageAnimation(value)
+		}
+	case *MessageAudio:
+		if cases.MessageAudio != nil {
+			return cases.MessageAudio(value)
+		}
+	case *MessageDocument:
+		if cases.MessageDocument != nil {
+			return cases.MessageDocument(value)
+		}
+	case *MessagePhoto:
+		if cases.MessagePhoto != nil {
+			return cases.MessagePhoto(value)
+		}
+	case *MessageExpiredPhoto:
+		if cases.MessageExpiredPhoto != nil {
+			return cases.MessageExpiredPhoto(value)
+		}
+	case *MessageSticker:
+		if cases.MessageSticker != nil {
+			return cases.MessageSticker(value)
+		}
+	case *MessageVideo:
+		if cases.MessageVideo != nil {
+			return cases.MessageVideo(value)
+		}
+	case *MessageExpiredVideo:
+		if cases.MessageExpiredVideo != nil {
+			return cases.MessageExpiredVideo(value)
+		}
+	case *MessageVideoNote:
+		if cases.MessageVideoNote != nil {
+			return cases.MessageVideoNote(value)
+		}
+	case *MessageVoiceNote:
+		if cases.MessageVoiceNote != nil {
+			return cases.MessageVoiceNote(value)
+		}
+	case *MessageLocation:
+		if cases.MessageLocation != nil {
+			return cases.MessageLocation(value)
+		}
+	case *MessageVenue:
+		if cases.MessageVenue != nil {
+			return cases.MessageVenue(value)
+		}
+	case *MessageContact:
+		if cases.MessageContact != nil {
+			return cases.MessageContact(value)
+		}
+	case *MessageAnimatedEmoji:
+		if cases.MessageAnimatedEmoji != nil {
+			return cases.MessageAnimatedEmoji(value)
+		}
+	case *MessageDice:
+		if cases.MessageDice != nil {
+			return cases.MessageDice(value)
+		}
+	case *MessageGame:
+		if cases.MessageGame != nil {
+			return cases.MessageGame(value)
+		}
+	case *MessagePoll:
+		if cases.MessagePoll != nil {
+			return cases.MessagePoll(value)
+		}
+	case *MessageInvoice:
+		if cases.MessageInvoice != nil {
+			return cases.MessageInvoice(value)
+		}
+	case *MessageCall:
+		if cases.MessageCall != nil {
+			return cases.MessageCall(value)
+		}
+	case *MessageVideoChatScheduled:
+		if cases.MessageVideoChatScheduled != nil {
+			return cases.MessageVideoChatScheduled(value)
+		}
+	case *MessageVideoChatStarted:
+		if cases.MessageVideoChatStarted != nil {
+			return cases.MessageVideoChatStarted(value)
+		}
+	case *MessageVideoChatEnded:
+		if cases.MessageVideoChatEnded != nil {
+			return cases.MessageVideoChatEnded(value)
+		}
+	case *MessageInviteVideoChatParticipants:
+		if cases.MessageInviteVideoChatParticipants != nil {
+			return cases.MessageInviteVideoChatParticipants(value)
+		}
+	case *MessageBasicGroupChatCreate:
+		if cases.MessageBasicGroupChatCreate != nil {
+			return cases.MessageBasicGroupChatCreate(value)
+		}
+	case *MessageSupergroupChatCreate:
+		if cases.MessageSupergroupChatCreate != nil {
+			return cases.MessageSupergroupChatCreate(value)
+		}
+	case *MessageChatChangeTitle:
+		if cases.MessageChatChangeTitle != nil {
+			return cases.MessageChatChangeTitle(value)
+		}
+	case *MessageChatChangePhoto:
+		if cases.MessageChatChangePhoto != nil {
+			return cases.MessageChatChangePhoto(value)
+		}
+	case *MessageChatDeletePhoto:
+		if cases.MessageChatDeletePhoto != nil {
+			return cases.MessageChatDeletePhoto(value)
+		}
+	case *MessageChatAddMembers:
+		if cases.MessageChatAddMembers != nil {
+			return cases.MessageChatAddMembers(value)
+		}
+	case *MessageChatJoinByLink:
+		if cases.MessageChatJoinByLink != nil {
+			return cases.MessageChatJoinByLink(value)
+		}
+	case *MessageChatJoinByRequest:
+		if cases.MessageChatJoinByRequest != nil {
+			return cases.MessageChatJoinByRequest(value)
+		}
+	case *MessageChatDeleteMember:
+		if cases.MessageChatDeleteMember != nil {
+			return cases.MessageChatDeleteMember(value)
+		}
+	case *MessageChatUpgradeTo:
+		if cases.MessageChatUpgradeTo != nil {
+			return cases.MessageChatUpgradeTo(value)
+		}
+	case *MessageChatUpgradeFrom:
+		if cases.MessageChatUpgradeFrom != nil {
+			return cases.MessageChatUpgradeFrom(value)
+		}
+	case *MessagePinMessage:
+		if cases.MessagePinMessage != nil {
+			return cases.MessagePinMessage(value)
+		}
+	case *MessageScreenshotTaken:
+		if cases.MessageScreenshotTaken != nil {
+			return cases.MessageScreenshotTaken(value)
+		}
+	case *MessageChatSetTheme:
+		if cases.MessageChatSetTheme != nil {
+			return cases.MessageChatSetTheme(value)
+		}
+	case *MessageChatSetTtl:
+		if cases.MessageChatSetTtl != nil {
+			return cases.MessageChatSetTtl(value)
+		}
+	case *MessageCustomServiceAction:
+		if cases.MessageCustomServiceAction != nil {
+			return cases.MessageCustomServiceAction(value)
+		}
+	case *MessageGameScore:
+		if cases.MessageGameScore != nil {
+			return cases.MessageGameScore(value)
+		}
+	case *MessagePaymentSuccessful:
+		if cases.MessagePaymentSuccessful != nil {
+			return cases.MessagePaymentSuccessful(value)
+		}
+	case *MessagePaymentSuccessfulBot:
+		if cases.MessagePaymentSuccessfulBot != nil {
+			return cases.MessagePaymentSuccessfulBot(value)
+		}
+	case *MessageContactRegistered:
+		if cases.MessageContactRegistered != nil {
+			return cases.MessageContactRegistered(value)
+		}
+	case *MessageWebsiteConnected:
+		if cases.MessageWebsiteConnected != nil {
+			return cases.MessageWebsiteConnected(value)
+		}
+	case *MessagePassportDataSent:
+		if cases.MessagePassportDataSent != nil {
+			return cases.MessagePassportDataSent(value)
+		}
+	case *MessagePassportDataReceived:
+		if cases.MessagePassportDataReceived != nil {
+			return cases.MessagePassportDataReceived(value)
+		}
+	case *MessageProximityAlertTriggered:
+		if cases.MessageProximityAlertTriggered != nil {
+			return cases.MessageProximityAlertTriggered(value)
+		}
+	case *MessageUnsupported:
+		if cases.MessageUnsupported != nil {
+			return cases.MessageUnsupported(value)
+		}
+	}
+	return defaultCase(messageContent)
+}
+
+// Clone returns a deep copy of the object
+func (unknownMessageContent *UnknownMessageContent) Clone() *UnknownMessageContent {
+	if unknownMessageContent == nil {
+		return nil
+	}
+	return &UnknownMessageContent{Unknown: unknownMessageContent.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownMessageContent *UnknownMessageContent) Equal(other *UnknownMessageContent) bool {
+	if unknownMessageContent == nil || other == nil {
+		return unknownMessageContent == other
+	}
+	return unknownMessageContent.Unknown.equal(other.Unknown)
+}
+
+func cloneMessageContent(messageContent MessageContent) MessageContent {
+	switch value := messageContent.(type) {
+	case *MessageText:
+		return value.Clone()
+	case *MessageAnimation:
+		return value.Clone()
+	case *MessageAudio:
+		return value.Clone()
+	case *MessageDocument:
+		return value.Clone()
+	case *MessagePhoto:
+		return value.Clone()
+	case *MessageExpiredPhoto:
+		return value.Clone()
+	case *MessageSticker:
+		return value.Clone()
+	case *MessageVideo:
+		return value.Clone()
+	case *MessageExpiredVideo:
+		return value.Clone()
+	case *MessageVideoNote:
+		return value.Clone()
+	case *MessageVoiceNote:
+		return value.Clone()
+	case *MessageLocation:
+		return value.Clone()
+	case *MessageVenue:
+		return value.Clone()
+	case *MessageContact:
+		return value.Clone()
+	case *MessageAnimatedEmoji:
+		return value.Clone()
+	case *MessageDice:
+		return value.Clone()
+	case *MessageGame:
+		return value.Clone()
+	case *MessagePoll:
+		return value.Clone()
+	case *MessageInvoice:
+		return value.Clone()
+	case *MessageCall:
+		return value.Clone()
+	case *MessageVideoChatScheduled:
+		return value.Clone()
+	case *MessageVideoChatStarted:
+		return value.Clone()
+	case *MessageVideoChatEnded:
+		return value.Clone()
+	case *MessageInviteVideoChatParticipants:
+		return value.Clone()
+	case *MessageBasicGroupChatCreate:
+		return value.Clone()
+	case *MessageSupergroupChatCreate:
+		return value.Clone()
+	case *MessageChatChangeTitle:
+		return value.Clone()
+	case *MessageChatChangePhoto:
+		return value.Clone()
+	case *MessageChatDeletePhoto:
+		return value.Clone()
+	case *MessageChatAddMembers:
+		return value.Clone()
+	case *MessageChatJoinByLink:
+		return value.Clone()
+	case *MessageChatJoinByRequest:
+		return value.Clone()
+	case *MessageChatDeleteMember:
+		return value.Clone()
+	case *MessageChatUpgradeTo:
+		return value.Clone()
+	case *MessageChatUpgradeFrom:
+		return value.Clone()
+	case *MessagePinMessage:
+		return value.Clone()
+	case *MessageScreenshotTaken:
+		return value.Clone()
+	case *MessageChatSetTheme:
+		return value.Clone()
+	case *MessageChatSetTtl:
+		return value.Clone()
+	case *MessageCustomServiceAction:
+		return value.Clone()
+	case *MessageGameScore:
+		return value.Clone()
+	case *MessagePaymentSuccessful:
+		return value.Clone()
+	case *MessagePaymentSuccessfulBot:
+		return value.Clone()
+	case *MessageContactRegistered:
+		return value.Clone()
+	case *MessageWebsiteConnected:
+		return value.Clone()
+	case *MessagePassportDataSent:
+		return value.Clone()
+	case *MessagePassportDataReceived:
+		return value.Clone()
+	case *MessageProximityAlertTriggered:
+		return value.Clone()
+	case *MessageUnsupported:
+		return value.Clone()
+	case *UnknownMessageContent:
+		return value.Clone()
+	}
+	return messageContent
+}
+
+func equalMessageContent(a MessageContent, b MessageContent) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *MessageText:
+		b, ok := b.(*MessageText)
+		return ok && a.Equal(b)
+	case *MessageAnimation:
+		b, ok := b.(*MessageAnimation)
+		return ok && a.Equal(b)
+	case *MessageAudio:
+		b, ok := b.(*MessageAudio)
+		return ok && a.Equal(b)
+	case *MessageDocument:
+		b, ok := b.(*MessageDocument)
+		return ok && a.Equal(b)
+	case *MessagePhoto:
+		b, ok := b.(*MessagePhoto)
+		return ok && a.Equal(b)
+	case *MessageExpiredPhoto:
+		b, ok := b.(*MessageExpiredPhoto)
+		return ok && a.Equal(b)
+	case *MessageSticker:
+		b, ok := b.(*MessageSticker)
+		return ok && a.Equal(b)
+	case *MessageVideo:
+		b, ok := b.(*MessageVideo)
+		return ok && a.Equal(b)
+	case *MessageExpiredVideo:
+		b, ok := b.(*MessageExpiredVideo)
+		return ok && a.Equal(b)
+	case *MessageVideoNote:
+		b, ok := b.(*MessageVideoNote)
+		return ok && a.Equal(b)
+	case *MessageVoiceNote:
+		b, ok := b.(*MessageVoiceNote)
+		return ok && a.Equal(b)
+	case *MessageLocation:
+		b, ok := b.(*MessageLocation)
+		return ok && a.Equal(b)
+	case *MessageVenue:
+		b, ok := b.(*MessageVenue)
+		return ok && a.Equal(b)
+	case *MessageContact:
+		b, ok := b.(*MessageContact)
+		return ok && a.Equal(b)
+	case *MessageAnimatedEmoji:
+		b, ok := b.(*MessageAnimatedEmoji)
+		return ok && a.Equal(b)
+	case *MessageDice:
+		b, ok := b.(*MessageDice)
+		return ok && a.Equal(b)
+	case *MessageGame:
+		b, ok := b.(*MessageGame)
+		return ok && a.Equal(b)
+	case *MessagePoll:
+		b, ok := b.(*MessagePoll)
+		return ok && a.Equal(b)
+	case *MessageInvoice:
+		b, ok := b.(*MessageInvoice)
+		return ok && a.Equal(b)
+	case *MessageCall:
+		b, ok := b.(*MessageCall)
+		return ok && a.Equal(b)
+	case *MessageVideoChatScheduled:
+		b, ok := b.(*MessageVideoChatScheduled)
+		return ok && a.Equal(b)
+	case *MessageVideoChatStarted:
+		b, ok := b.(*MessageVideoChatStarted)
+		return ok && a.Equal(b)
+	case *MessageVideoChatEnded:
+		b, ok := b.(*MessageVideoChatEnded)
+		return ok && a.Equal(b)
+	case *MessageInviteVideoChatParticipants:
+		b, ok := b.(*MessageInviteVideoChatParticipants)
+		return ok && a.Equal(b)
+	case *MessageBasicGroupChatCreate:
+		b, ok := b.(*MessageBasicGroupChatCreate)
+		return ok && a.Equal(b)
+	case *MessageSupergroupChatCreate:
+		b, ok := b.(*MessageSupergroupChatCreate)
+		return ok && a.Equal(b)
+	case *MessageChatChangeTitle:
+		b, ok := b.(*MessageChatChangeTitle)
+		return ok && a.Equal(b)
+	case *MessageChatChangePhoto:
+		b, ok := b.(*MessageChatChangePhoto)
+		return ok && a.Equal(b)
+	case *MessageChatDeletePhoto:
+		b, ok := b.(*MessageChatDeletePhoto)
+		return ok && a.Equal(b)
+	case *MessageChatAddMembers:
+		b, ok := b.(*MessageChatAddMembers)
+		return ok && a.Equal(b)
+	case *MessageChatJoinByLink:
+		b, ok := b.(*MessageChatJoinByLink)
+		return ok && a.Equal(b)
+	case *MessageChatJoinByRequest:
+		b, ok := b.(*MessageChatJoinByRequest)
+		return ok && a.Equal(b)
+	case *MessageChatDeleteMember:
+		b, ok := b.(*MessageChatDeleteMember)
+		return ok && a.Equal(b)
+	case *MessageChatUpgradeTo:
+		b, ok := b.(*MessageChatUpgradeTo)
+		return ok && a.Equal(b)
+	case *MessageChatUpgradeFrom:
+		b, ok := b.(*MessageChatUpgradeFrom)
+		return ok && a.Equal(b)
+	case *MessagePinMessage:
+		b, ok := b.(*MessagePinMessage)
+		return ok && a.Equal(b)
+	case *MessageScreenshotTaken:
+		b, ok := b.(*MessageScreenshotTaken)
+		return ok && a.Equal(b)
+	case *MessageChatSetTheme:
+		b, ok := b.(*MessageChatSetTheme)
+		return ok && a.Equal(b)
+	case *MessageChatSetTtl:
+		b, ok := b.(*MessageChatSetTtl)
+		return ok && a.Equal(b)
+	case *MessageCustomServiceAction:
+		b, ok := b.(*MessageCustomServiceAction)
+		return ok && a.Equal(b)
+	case *MessageGameScore:
+		b, ok := b.(*MessageGameScore)
+		return ok && a.Equal(b)
+	case *MessagePaymentSuccessful:
+		b, ok := b.(*MessagePaymentSuccessful)
+		return ok && a.Equal(b)
+	case *MessagePaymentSuccessfulBot:
+		b, ok := b.(*MessagePaymentSuccessfulBot)
+		return ok && a.Equal(b)
+	case *MessageContactRegistered:
+		b, ok := b.(*MessageContactRegistered)
+		return ok && a.Equal(b)
+	case *MessageWebsiteConnected:
+		b, ok := b.(*MessageWebsiteConnected)
+		return ok && a.Equal(b)
+	case *MessagePassportDataSent:
+		b, ok := b.(*MessagePassportDataSent)
+		return ok && a.Equal(b)
+	case *MessagePassportDataReceived:
+		b, ok := b.(*MessagePassportDataReceived)
+		return ok && a.Equal(b)
+	case *MessageProximityAlertTriggered:
+		b, ok := b.(*MessageProximityAlertTriggered)
+		return ok && a.Equal(b)
+	case *MessageUnsupported:
+		b, ok := b.(*MessageUnsupported)
+		return ok && a.Equal(b)
+	case *UnknownMessageContent:
+		b, ok := b.(*UnknownMessageContent)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffMessageContent(path string, a MessageContent, b MessageContent, changes []Change) []Change {
+	switch a := a.(type) {
+	case *MessageText:
+		if b, ok := b.(*MessageText); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageAnimation:
+		if b, ok := b.(*MessageAnimation); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageAudio:
+		if b, ok := b.(*MessageAudio); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageDocument:
+		if b, ok := b.(*MessageDocument); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessagePhoto:
+		if b, ok := b.(*MessagePhoto); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageExpiredPhoto:
+		if b, ok := b.(*MessageExpiredPhoto); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageSticker:
+		if b, ok := b.(*MessageSticker); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageVideo:
+		if b, ok := b.(*MessageVideo); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageExpiredVideo:
+		if b, ok := b.(*MessageExpiredVideo); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageVideoNote:
+		if b, ok := b.(*MessageVideoNote); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageVoiceNote:
+		if b, ok := b.(*MessageVoiceNote); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageLocation:
+		if b, ok := b.(*MessageLocation); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageVenue:
+		if b, ok := b.(*MessageVenue); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageContact:
+		if b, ok := b.(*MessageContact); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageAnimatedEmoji:
+		if b, ok := b.(*MessageAnimatedEmoji); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageDice:
+		if b, ok := b.(*MessageDice); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageGame:
+		if b, ok := b.(*MessageGame); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessagePoll:
+		if b, ok := b.(*MessagePoll); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageInvoice:
+		if b, ok := b.(*MessageInvoice); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageCall:
+		if b, ok := b.(*MessageCall); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageVideoChatScheduled:
+		if b, ok := b.(*MessageVideoChatScheduled); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageVideoChatStarted:
+		if b, ok := b.(*MessageVideoChatStarted); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageVideoChatEnded:
+		if b, ok := b.(*MessageVideoChatEnded); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageInviteVideoChatParticipants:
+		if b, ok := b.(*MessageInviteVideoChatParticipants); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageBasicGroupChatCreate:
+		if b, ok := b.(*MessageBasicGroupChatCreate); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageSupergroupChatCreate:
+		if b, ok := b.(*MessageSupergroupChatCreate); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageChatChangeTitle:
+		if b, ok := b.(*MessageChatChangeTitle); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageChatChangePhoto:
+		if b, ok := b.(*MessageChatChangePhoto); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageChatDeletePhoto:
+		if b, ok := b.(*MessageChatDeletePhoto); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageChatAddMembers:
+		if b, ok := b.(*MessageChatAddMembers); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageChatJoinByLink:
+		if b, ok := b.(*MessageChatJoinByLink); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageChatJoinByRequest:
+		if b, ok := b.(*MessageChatJoinByRequest); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageChatDeleteMember:
+		if b, ok := b.(*MessageChatDeleteMember); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageChatUpgradeTo:
+		if b, ok := b.(*MessageChatUpgradeTo); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageChatUpgradeFrom:
+		if b, ok := b.(*MessageChatUpgradeFrom); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessagePinMessage:
+		if b, ok := b.(*MessagePinMessage); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageScreenshotTaken:
+		if b, ok := b.(*MessageScreenshotTaken); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageChatSetTheme:
+		if b, ok := b.(*MessageChatSetTheme); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageChatSetTtl:
+		if b, ok := b.(*MessageChatSetTtl); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageCustomServiceAction:
+		if b, ok := b.(*MessageCustomServiceAction); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageGameScore:
+		if b, ok := b.(*MessageGameScore); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessagePaymentSuccessful:
+		if b, ok := b.(*MessagePaymentSuccessful); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessagePaymentSuccessfulBot:
+		if b, ok := b.(*MessagePaymentSuccessfulBot); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageContactRegistered:
+		if b, ok := b.(*MessageContactRegistered); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageWebsiteConnected:
+		if b, ok := b.(*MessageWebsiteConnected); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessagePassportDataSent:
+		if b, ok := b.(*MessagePassportDataSent); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessagePassportDataReceived:
+		if b, ok := b.(*MessagePassportDataReceived); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageProximityAlertTriggered:
+		if b, ok := b.(*MessageProximityAlertTriggered); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageUnsupported:
+		if b, ok := b.(*MessageUnsupported); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalMessageContent(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // MessageCopyOptions Options to be used when a message content is copied without reference to the original sender. Service messages and messageInvoice can't be copied
 type MessageCopyOptions struct {
 	tdCommon
@@ -28,3 +33,135 @@ func NewMessageCopyOptions(sendCopy bool, replaceCaption bool, newCaption *Forma
 
 	return &messageCopyOptionsTemp
 }
+
+// MarshalJSON marshals to json
+func (messageCopyOptions *MessageCopyOptions) MarshalJSON() ([]byte, error) {
+	return messageCopyOptions.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageCopyOptions *MessageCopyOptions) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageCopyOptions)
+}
+
+func (messageCopyOptions *MessageCopyOptions) appendJSON(b []byte) ([]byte, error) {
+	if messageCopyOptions == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageCopyOptions.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageCopyOptions.tdCommon.Extra)
+	b = append(b, `,"send_copy":`...)
+	b = appendJSONBool(b, messageCopyOptions.SendCopy)
+	b = append(b, `,"replace_caption":`...)
+	b = appendJSONBool(b, messageCopyOptions.ReplaceCaption)
+	b = append(b, `,"new_caption":`...)
+	b, err = messageCopyOptions.NewCaption.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (messageCopyOptions *MessageCopyOptions) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageCopyOptions.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageCopyOptions.tdCommon.Extra, err = r.readString()
+		case "send_copy":
+			messageCopyOptions.SendCopy, err = r.readBool()
+		case "replace_caption":
+			messageCopyOptions.ReplaceCaption, err = r.readBool()
+		case "new_caption":
+			if r.readNull() {
+				messageCopyOptions.NewCaption = nil
+			} else {
+				messageCopyOptions.NewCaption = new(FormattedText)
+				err = messageCopyOptions.NewCaption.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageCopyOptions *MessageCopyOptions) Clone() *MessageCopyOptions {
+	if messageCopyOptions == nil {
+		return nil
+	}
+	clone := *messageCopyOptions
+	clone.NewCaption = messageCopyOptions.NewCaption.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageCopyOptions *MessageCopyOptions) Equal(other *MessageCopyOptions) bool {
+	if messageCopyOptions == nil || other == nil {
+		return messageCopyOptions == other
+	}
+	if messageCopyOptions.SendCopy != other.SendCopy {
+		return false
+	}
+	if messageCopyOptions.ReplaceCaption != other.ReplaceCaption {
+		return false
+	}
+	if !messageCopyOptions.NewCaption.Equal(other.NewCaption) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageCopyOptions *MessageCopyOptions) Diff(other *MessageCopyOptions) []Change {
+	return messageCopyOptions.diff("", other, nil)
+}
+
+func (messageCopyOptions *MessageCopyOptions) diff(path string, other *MessageCopyOptions, changes []Change) []Change {
+	if messageCopyOptions == nil || other == nil {
+		if messageCopyOptions != other {
+			changes = append(changes, Change{Path: path, Old: messageCopyOptions, New: other})
+		}
+		return changes
+	}
+	if messageCopyOptions.SendCopy != other.SendCopy {
+		changes = append(changes, Change{Path: fieldPath(path, "send_copy"), Old: messageCopyOptions.SendCopy, New: other.SendCopy})
+	}
+	if messageCopyOptions.ReplaceCaption != other.ReplaceCaption {
+		changes = append(changes, Change{Path: fieldPath(path, "replace_caption"), Old: messageCopyOptions.ReplaceCaption, New: other.ReplaceCaption})
+	}
+	changes = messageCopyOptions.NewCaption.diff(fieldPath(path, "new_caption"), other.NewCaption, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageCopyOptions *MessageCopyOptions) String() string {
+	return objectString(messageCopyOptions)
+}
+
+// LogValue logs the object as its summary
+func (messageCopyOptions *MessageCopyOptions) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageCopyOptions))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageCopyOptions *MessageCopyOptions) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageCopyOptions)
+}
+
+func (messageCopyOptions *MessageCopyOptions) printText(p *printer) {
+	if messageCopyOptions == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageCopyOptions")
+	p.bool("send_copy", messageCopyOptions.SendCopy)
+	p.bool("replace_caption", messageCopyOptions.ReplaceCaption)
+	p.object("new_caption", messageCopyOptions.NewCaption)
+	p.endObject(state)
+}
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // MessageFileType Contains information about a file with messages exported from another app
 type MessageFileType interface {
 	GetMessageFileTypeEnum() MessageFileTypeEnum
+	Accept(visitor MessageFileTypeVisitor)
 }
 
 // MessageFileTypeEnum Alias for abstract MessageFileType 'Sub-Classes', used as constant-enum here
@@ -20,35 +22,78 @@ const (
 	MessageFileTypeUnknownType MessageFileTypeEnum = "messageFileTypeUnknown"
 )
 
+// UnknownMessageFileType is a MessageFileType of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownMessageFileType struct {
+	Unknown
+}
+
+// GetMessageFileTypeEnum return the enum type of this object
+func (unknownMessageFileType *UnknownMessageFileType) GetMessageFileTypeEnum() MessageFileTypeEnum {
+	return MessageFileTypeEnum(unknownMessageFileType.Type)
+}
+
+// Accept calls the method of the visitor for UnknownMessageFileType
+func (unknownMessageFileType *UnknownMessageFileType) Accept(visitor MessageFileTypeVisitor) {
+	visitor.VisitUnknownMessageFileType(unknownMessageFileType)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownMessageFileType *UnknownMessageFileType) String() string {
+	return objectString(unknownMessageFileType)
+}
+
+// LogValue logs the object as its summary
+func (unknownMessageFileType *UnknownMessageFileType) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownMessageFileType))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownMessageFileType *UnknownMessageFileType) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownMessageFileType)
+}
+
+func (unknownMessageFileType *UnknownMessageFileType) printText(p *printer) {
+	if unknownMessageFileType == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownMessageFileType")
+	p.string("@type", unknownMessageFileType.Type)
+	p.endObject(state)
+}
+
 func unmarshalMessageFileType(rawMsg *json.RawMessage) (MessageFileType, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch MessageFileTypeEnum(objMap["@type"].(string)) {
+	switch MessageFileTypeEnum(typeName) {
 	case MessageFileTypePrivateType:
 		var messageFileTypePrivate MessageFileTypePrivate
-		err := json.Unmarshal(*rawMsg, &messageFileTypePrivate)
+		err := jsonUnmarshal(*rawMsg, &messageFileTypePrivate)
 		return &messageFileTypePrivate, err
 
 	case MessageFileTypeGroupType:
 		var messageFileTypeGroup MessageFileTypeGroup
-		err := json.Unmarshal(*rawMsg, &messageFileTypeGroup)
+		err := jsonUnmarshal(*rawMsg, &messageFileTypeGroup)
 		return &messageFileTypeGroup, err
 
 	case MessageFileTypeUnknownType:
 		var messageFileTypeUnknown MessageFileTypeUnknown
-		err := json.Unmarshal(*rawMsg, &messageFileTypeUnknown)
+		err := jsonUnmarshal(*rawMsg, &messageFileTypeUnknown)
 		return &messageFileTypeUnknown, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownMessageFileType{Unknown: unknown}, nil
 	}
 }
 
@@ -75,11 +120,118 @@ func NewMessageFileTypePrivate(name string) *MessageFileTypePrivate {
 	return &messageFileTypePrivateTemp
 }
 
+// MarshalJSON marshals to json
+func (messageFileTypePrivate *MessageFileTypePrivate) MarshalJSON() ([]byte, error) {
+	return messageFileTypePrivate.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageFileTypePrivate *MessageFileTypePrivate) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageFileTypePrivate)
+}
+
+func (messageFileTypePrivate *MessageFileTypePrivate) appendJSON(b []byte) ([]byte, error) {
+	if messageFileTypePrivate == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageFileTypePrivate.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageFileTypePrivate.tdCommon.Extra)
+	b = append(b, `,"name":`...)
+	b = appendJSONString(b, messageFileTypePrivate.Name)
+	return append(b, '}'), nil
+}
+
+func (messageFileTypePrivate *MessageFileTypePrivate) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageFileTypePrivate.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageFileTypePrivate.tdCommon.Extra, err = r.readString()
+		case "name":
+			messageFileTypePrivate.Name, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageFileTypePrivate *MessageFileTypePrivate) Clone() *MessageFileTypePrivate {
+	if messageFileTypePrivate == nil {
+		return nil
+	}
+	clone := *messageFileTypePrivate
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageFileTypePrivate *MessageFileTypePrivate) Equal(other *MessageFileTypePrivate) bool {
+	if messageFileTypePrivate == nil || other == nil {
+		return messageFileTypePrivate == other
+	}
+	if messageFileTypePrivate.Name != other.Name {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageFileTypePrivate *MessageFileTypePrivate) Diff(other *MessageFileTypePrivate) []Change {
+	return messageFileTypePrivate.diff("", other, nil)
+}
+
+func (messageFileTypePrivate *MessageFileTypePrivate) diff(path string, other *MessageFileTypePrivate, changes []Change) []Change {
+	if messageFileTypePrivate == nil || other == nil {
+		if messageFileTypePrivate != other {
+			changes = append(changes, Change{Path: path, Old: messageFileTypePrivate, New: other})
+		}
+		return changes
+	}
+	if messageFileTypePrivate.Name != other.Name {
+		changes = append(changes, Change{Path: fieldPath(path, "name"), Old: messageFileTypePrivate.Name, New: other.Name})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageFileTypePrivate *MessageFileTypePrivate) String() string {
+	return objectString(messageFileTypePrivate)
+}
+
+// LogValue logs the object as its summary
+func (messageFileTypePrivate *MessageFileTypePrivate) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageFileTypePrivate))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageFileTypePrivate *MessageFileTypePrivate) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageFileTypePrivate)
+}
+
+func (messageFileTypePrivate *MessageFileTypePrivate) printText(p *printer) {
+	if messageFileTypePrivate == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageFileTypePrivate")
+	p.string("name", messageFileTypePrivate.Name)
+	p.endObject(state)
+}
+
 // GetMessageFileTypeEnum return the enum type of this object
 func (messageFileTypePrivate *MessageFileTypePrivate) GetMessageFileTypeEnum() MessageFileTypeEnum {
 	return MessageFileTypePrivateType
 }
 
+// Accept calls the method of the visitor for MessageFileTypePrivate
+func (messageFileTypePrivate *MessageFileTypePrivate) Accept(visitor MessageFileTypeVisitor) {
+	visitor.VisitMessageFileTypePrivate(messageFileTypePrivate)
+}
+
 // MessageFileTypeGroup The messages was exported from a group chat
 type MessageFileTypeGroup struct {
 	tdCommon
@@ -103,11 +255,118 @@ func NewMessageFileTypeGroup(title string) *MessageFileTypeGroup {
 	return &messageFileTypeGroupTemp
 }
 
+// MarshalJSON marshals to json
+func (messageFileTypeGroup *MessageFileTypeGroup) MarshalJSON() ([]byte, error) {
+	return messageFileTypeGroup.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageFileTypeGroup *MessageFileTypeGroup) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageFileTypeGroup)
+}
+
+func (messageFileTypeGroup *MessageFileTypeGroup) appendJSON(b []byte) ([]byte, error) {
+	if messageFileTypeGroup == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageFileTypeGroup.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageFileTypeGroup.tdCommon.Extra)
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, messageFileTypeGroup.Title)
+	return append(b, '}'), nil
+}
+
+func (messageFileTypeGroup *MessageFileTypeGroup) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageFileTypeGroup.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageFileTypeGroup.tdCommon.Extra, err = r.readString()
+		case "title":
+			messageFileTypeGroup.Title, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageFileTypeGroup *MessageFileTypeGroup) Clone() *MessageFileTypeGroup {
+	if messageFileTypeGroup == nil {
+		return nil
+	}
+	clone := *messageFileTypeGroup
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageFileTypeGroup *MessageFileTypeGroup) Equal(other *MessageFileTypeGroup) bool {
+	if messageFileTypeGroup == nil || other == nil {
+		return messageFileTypeGroup == other
+	}
+	if messageFileTypeGroup.Title != other.Title {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageFileTypeGroup *MessageFileTypeGroup) Diff(other *MessageFileTypeGroup) []Change {
+	return messageFileTypeGroup.diff("", other, nil)
+}
+
+func (messageFileTypeGroup *MessageFileTypeGroup) diff(path string, other *MessageFileTypeGroup, changes []Change) []Change {
+	if messageFileTypeGroup == nil || other == nil {
+		if messageFileTypeGroup != other {
+			changes = append(changes, Change{Path: path, Old: messageFileTypeGroup, New: other})
+		}
+		return changes
+	}
+	if messageFileTypeGroup.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: messageFileTypeGroup.Title, New: other.Title})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageFileTypeGroup *MessageFileTypeGroup) String() string {
+	return objectString(messageFileTypeGroup)
+}
+
+// LogValue logs the object as its summary
+func (messageFileTypeGroup *MessageFileTypeGroup) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageFileTypeGroup))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageFileTypeGroup *MessageFileTypeGroup) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageFileTypeGroup)
+}
+
+func (messageFileTypeGroup *MessageFileTypeGroup) printText(p *printer) {
+	if messageFileTypeGroup == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageFileTypeGroup")
+	p.string("title", messageFileTypeGroup.Title)
+	p.endObject(state)
+}
+
 // GetMessageFileTypeEnum return the enum type of this object
 func (messageFileTypeGroup *MessageFileTypeGroup) GetMessageFileTypeEnum() MessageFileTypeEnum {
 	return MessageFileTypeGroupType
 }
 
+// Accept calls the method of the visitor for MessageFileTypeGroup
+func (messageFileTypeGroup *MessageFileTypeGroup) Accept(visitor MessageFileTypeVisitor) {
+	visitor.VisitMessageFileTypeGroup(messageFileTypeGroup)
+}
+
 // MessageFileTypeUnknown The messages was exported from a chat of unknown type
 type MessageFileTypeUnknown struct {
 	tdCommon
@@ -119,7 +378,6 @@ func (messageFileTypeUnknown *MessageFileTypeUnknown) MessageType() string {
 }
 
 // NewMessageFileTypeUnknown creates a new MessageFileTypeUnknown
-//
 func NewMessageFileTypeUnknown() *MessageFileTypeUnknown {
 	messageFileTypeUnknownTemp := MessageFileTypeUnknown{
 		tdCommon: tdCommon{Type: "messageFileTypeUnknown"},
@@ -128,11 +386,217 @@ func NewMessageFileTypeUnknown() *MessageFileTypeUnknown {
 	return &messageFileTypeUnknownTemp
 }
 
+// MarshalJSON marshals to json
+func (messageFileTypeUnknown *MessageFileTypeUnknown) MarshalJSON() ([]byte, error) {
+	return messageFileTypeUnknown.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageFileTypeUnknown *MessageFileTypeUnknown) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageFileTypeUnknown)
+}
+
+func (messageFileTypeUnknown *MessageFileTypeUnknown) appendJSON(b []byte) ([]byte, error) {
+	if messageFileTypeUnknown == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageFileTypeUnknown.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageFileTypeUnknown.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (messageFileTypeUnknown *MessageFileTypeUnknown) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageFileTypeUnknown.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageFileTypeUnknown.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageFileTypeUnknown *MessageFileTypeUnknown) Clone() *MessageFileTypeUnknown {
+	if messageFileTypeUnknown == nil {
+		return nil
+	}
+	clone := *messageFileTypeUnknown
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageFileTypeUnknown *MessageFileTypeUnknown) Equal(other *MessageFileTypeUnknown) bool {
+	if messageFileTypeUnknown == nil || other == nil {
+		return messageFileTypeUnknown == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageFileTypeUnknown *MessageFileTypeUnknown) Diff(other *MessageFileTypeUnknown) []Change {
+	return messageFileTypeUnknown.diff("", other, nil)
+}
+
+func (messageFileTypeUnknown *MessageFileTypeUnknown) diff(path string, other *MessageFileTypeUnknown, changes []Change) []Change {
+	if messageFileTypeUnknown == nil || other == nil {
+		if messageFileTypeUnknown != other {
+			changes = append(changes, Change{Path: path, Old: messageFileTypeUnknown, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageFileTypeUnknown *MessageFileTypeUnknown) String() string {
+	return objectString(messageFileTypeUnknown)
+}
+
+// LogValue logs the object as its summary
+func (messageFileTypeUnknown *MessageFileTypeUnknown) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageFileTypeUnknown))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageFileTypeUnknown *MessageFileTypeUnknown) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageFileTypeUnknown)
+}
+
+func (messageFileTypeUnknown *MessageFileTypeUnknown) printText(p *printer) {
+	if messageFileTypeUnknown == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageFileTypeUnknown")
+	p.endObject(state)
+}
+
 // GetMessageFileTypeEnum return the enum type of this object
 func (messageFileTypeUnknown *MessageFileTypeUnknown) GetMessageFileTypeEnum() MessageFileTypeEnum {
 	return MessageFileTypeUnknownType
 }
 
+// Accept calls the method of the visitor for MessageFileTypeUnknown
+func (messageFileTypeUnknown *MessageFileTypeUnknown) Accept(visitor MessageFileTypeVisitor) {
+	visitor.VisitMessageFileTypeUnknown(messageFileTypeUnknown)
+}
+
+// MessageFileTypeVisitor visits every type of MessageFileType, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to MessageFileType, until they handle it too.
+type MessageFileTypeVisitor interface {
+	VisitMessageFileTypePrivate(messageFileTypePrivate *MessageFileTypePrivate)
+	VisitMessageFileTypeGroup(messageFileTypeGroup *MessageFileTypeGroup)
+	VisitMessageFileTypeUnknown(messageFileTypeUnknown *MessageFileTypeUnknown)
+	VisitUnknownMessageFileType(unknownMessageFileType *UnknownMessageFileType)
+}
+
+// MessageFileTypeCases holds the function MatchMessageFileType calls for each type of MessageFileType;
+// types without a function are passed to the default case
+type MessageFileTypeCases[R any] struct {
+	MessageFileTypePrivate func(messageFileTypePrivate *MessageFileTypePrivate) R
+	MessageFileTypeGroup   func(messageFileTypeGroup *MessageFileTypeGroup) R
+	MessageFileTypeUnknown func(messageFileTypeUnknown *MessageFileTypeUnknown) R
+}
+
+// MatchMessageFileType calls the case of the type of messageFileType, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement MessageFileTypeVisitor
+// to have the compiler check every type is handled.
+func MatchMessageFileType[R any](messageFileType MessageFileType, cases MessageFileTypeCases[R], defaultCase func(messageFileType MessageFileType) R) R {
+	switch value := messageFileType.(type) {
+	case *MessageFileTypePrivate:
+		if cases.MessageFileTypePrivate != nil {
+			return cases.MessageFileTypePrivate(value)
+		}
+	case *MessageFileTypeGroup:
+		if cases.MessageFileTypeGroup != nil {
+			return cases.MessageFileTypeGroup(value)
+		}
+	case *MessageFileTypeUnknown:
+		if cases.MessageFileTypeUnknown != nil {
+			return cases.MessageFileTypeUnknown(value)
+		}
+	}
+	return defaultCase(messageFileType)
+}
+
+// Clone returns a deep copy of the object
+func (unknownMessageFileType *UnknownMessageFileType) Clone() *UnknownMessageFileType {
+	if unknownMessageFileType == nil {
+		return nil
+	}
+	return &UnknownMessageFileType{Unknown: unknownMessageFileType.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownMessageFileType *UnknownMessageFileType) Equal(other *UnknownMessageFileType) bool {
+	if unknownMessageFileType == nil || other == nil {
+		return unknownMessageFileType == other
+	}
+	return unknownMessageFileType.Unknown.equal(other.Unknown)
+}
+
+func cloneMessageFileType(messageFileType MessageFileType) MessageFileType {
+	switch value := messageFileType.(type) {
+	case *MessageFileTypePrivate:
+		return value.Clone()
+	case *MessageFileTypeGroup:
+		return value.Clone()
+	case *MessageFileTypeUnknown:
+		return value.Clone()
+	case *UnknownMessageFileType:
+		return value.Clone()
+	}
+	return messageFileType
+}
+
+func equalMessageFileType(a MessageFileType, b MessageFileType) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *MessageFileTypePrivate:
+		b, ok := b.(*MessageFileTypePrivate)
+		return ok && a.Equal(b)
+	case *MessageFileTypeGroup:
+		b, ok := b.(*MessageFileTypeGroup)
+		return ok && a.Equal(b)
+	case *MessageFileTypeUnknown:
+		b, ok := b.(*MessageFileTypeUnknown)
+		return ok && a.Equal(b)
+	case *UnknownMessageFileType:
+		b, ok := b.(*UnknownMessageFileType)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffMessageFileType(path string, a MessageFileType, b MessageFileType, changes []Change) []Change {
+	switch a := a.(type) {
+	case *MessageFileTypePrivate:
+		if b, ok := b.(*MessageFileTypePrivate); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageFileTypeGroup:
+		if b, ok := b.(*MessageFileTypeGroup); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageFileTypeUnknown:
+		if b, ok := b.(*MessageFileTypeUnknown); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalMessageFileType(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
+
 // GetMessageFileType Returns information about a file with messages exported from another app
 // @param messageFileHead Beginning of the message file; up to 100 first lines
 func (client *Client) GetMessageFileType(messageFileHead string) (MessageFileType, error) {
@@ -145,25 +609,26 @@ func (client *Client) GetMessageFileType(messageFileHead string) (MessageFileTyp
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
-	switch MessageFileTypeEnum(result.Data["@type"].(string)) {
+	resultType, _ := result.Data["@type"].(string)
+	switch MessageFileTypeEnum(resultType) {
 
 	case MessageFileTypePrivateType:
 		var messageFileType MessageFileTypePrivate
-		err = json.Unmarshal(result.Raw, &messageFileType)
+		err = jsonUnmarshal(result.Raw, &messageFileType)
 		return &messageFileType, err
 
 	case MessageFileTypeGroupType:
 		var messageFileType MessageFileTypeGroup
-		err = json.Unmarshal(result.Raw, &messageFileType)
+		err = jsonUnmarshal(result.Raw, &messageFileType)
 		return &messageFileType, err
 
 	case MessageFileTypeUnknownType:
 		var messageFileType MessageFileTypeUnknown
-		err = json.Unmarshal(result.Raw, &messageFileType)
+		err = jsonUnmarshal(result.Raw, &messageFileType)
 		return &messageFileType, err
 
 	default:
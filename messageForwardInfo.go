@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // MessageForwardInfo Contains information about a forwarded message
@@ -39,33 +40,151 @@ func NewMessageForwardInfo(origin MessageForwardOrigin, date int32, publicServic
 	return &messageForwardInfoTemp
 }
 
+// MarshalJSON marshals to json
+func (messageForwardInfo *MessageForwardInfo) MarshalJSON() ([]byte, error) {
+	return messageForwardInfo.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (messageForwardInfo *MessageForwardInfo) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Date                          int32  `json:"date"`                             // Point in time (Unix timestamp) when the message was originally sent
-		PublicServiceAnnouncementType string `json:"public_service_announcement_type"` // The type of a public service announcement for the forwarded message
-		FromChatId                    int64  `json:"from_chat_id"`                     // For messages forwarded to the chat with the current user (Saved Messages), to the Replies bot chat, or to the channel's discussion group, the identifier of the chat from which the message was forwarded last time; 0 if unknown
-		FromMessageId                 int64  `json:"from_message_id"`                  // For messages forwarded to the chat with the current user (Saved Messages), to the Replies bot chat, or to the channel's discussion group, the identifier of the original message from which the new message was forwarded last time; 0 if unknown
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	return unmarshalFast(b, messageForwardInfo)
+}
+
+func (messageForwardInfo *MessageForwardInfo) appendJSON(b []byte) ([]byte, error) {
+	if messageForwardInfo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageForwardInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageForwardInfo.tdCommon.Extra)
+	b = append(b, `,"origin":`...)
+	b, err = appendJSONValue(b, messageForwardInfo.Origin)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"date":`...)
+	b = appendJSONInt(b, int64(messageForwardInfo.Date))
+	b = append(b, `,"public_service_announcement_type":`...)
+	b = appendJSONString(b, messageForwardInfo.PublicServiceAnnouncementType)
+	b = append(b, `,"from_chat_id":`...)
+	b = appendJSONInt(b, int64(messageForwardInfo.FromChatId))
+	b = append(b, `,"from_message_id":`...)
+	b = appendJSONInt(b, int64(messageForwardInfo.FromMessageId))
+	return append(b, '}'), nil
+}
+
+func (messageForwardInfo *MessageForwardInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageForwardInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageForwardInfo.tdCommon.Extra, err = r.readString()
+		case "origin":
+			messageForwardInfo.Origin, err = decodeMessageForwardOrigin(r)
+		case "date":
+			messageForwardInfo.Date, err = r.readInt32()
+		case "public_service_announcement_type":
+			messageForwardInfo.PublicServiceAnnouncementType, err = r.readString()
+		case "from_chat_id":
+			messageForwardInfo.FromChatId, err = r.readInt64()
+		case "from_message_id":
+			messageForwardInfo.FromMessageId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageForwardInfo *MessageForwardInfo) Clone() *MessageForwardInfo {
+	if messageForwardInfo == nil {
+		return nil
+	}
+	clone := *messageForwardInfo
+	clone.Origin = cloneMessageForwardOrigin(messageForwardInfo.Origin)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageForwardInfo *MessageForwardInfo) Equal(other *MessageForwardInfo) bool {
+	if messageForwardInfo == nil || other == nil {
+		return messageForwardInfo == other
+	}
+	if !equalMessageForwardOrigin(messageForwardInfo.Origin, other.Origin) {
+		return false
+	}
+	if messageForwardInfo.Date != other.Date {
+		return false
+	}
+	if messageForwardInfo.PublicServiceAnnouncementType != other.PublicServiceAnnouncementType {
+		return false
 	}
+	if messageForwardInfo.FromChatId != other.FromChatId {
+		return false
+	}
+	if messageForwardInfo.FromMessageId != other.FromMessageId {
+		return false
+	}
+	return true
+}
 
-	messageForwardInfo.tdCommon = tempObj.tdCommon
-	messageForwardInfo.Date = tempObj.Date
-	messageForwardInfo.PublicServiceAnnouncementType = tempObj.PublicServiceAnnouncementType
-	messageForwardInfo.FromChatId = tempObj.FromChatId
-	messageForwardInfo.FromMessageId = tempObj.FromMessageId
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageForwardInfo *MessageForwardInfo) Diff(other *MessageForwardInfo) []Change {
+	return messageForwardInfo.diff("", other, nil)
+}
 
-	fieldOrigin, _ := unmarshalMessageForwardOrigin(objMap["origin"])
-	messageForwardInfo.Origin = fieldOrigin
+func (messageForwardInfo *MessageForwardInfo) diff(path string, other *MessageForwardInfo, changes []Change) []Change {
+	if messageForwardInfo == nil || other == nil {
+		if messageForwardInfo != other {
+			changes = append(changes, Change{Path: path, Old: messageForwardInfo, New: other})
+		}
+		return changes
+	}
+	changes = diffMessageForwardOrigin(fieldPath(path, "origin"), messageForwardInfo.Origin, other.Origin, changes)
+	if messageForwardInfo.Date != other.Date {
+		changes = append(changes, Change{Path: fieldPath(path, "date"), Old: messageForwardInfo.Date, New: other.Date})
+	}
+	if messageForwardInfo.PublicServiceAnnouncementType != other.PublicServiceAnnouncementType {
+		changes = append(changes, Change{Path: fieldPath(path, "public_service_announcement_type"), Old: messageForwardInfo.PublicServiceAnnouncementType, New: other.PublicServiceAnnouncementType})
+	}
+	if messageForwardInfo.FromChatId != other.FromChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "from_chat_id"), Old: messageForwardInfo.FromChatId, New: other.FromChatId})
+	}
+	if messageForwardInfo.FromMessageId != other.FromMessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "from_message_id"), Old: messageForwardInfo.FromMessageId, New: other.FromMessageId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageForwardInfo *MessageForwardInfo) String() string {
+	return objectString(messageForwardInfo)
+}
+
+// LogValue logs the object as its summary
+func (messageForwardInfo *MessageForwardInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageForwardInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageForwardInfo *MessageForwardInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageForwardInfo)
+}
 
-	return nil
+func (messageForwardInfo *MessageForwardInfo) printText(p *printer) {
+	if messageForwardInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageForwardInfo")
+	p.object("origin", messageForwardInfo.Origin)
+	p.int("date", int64(messageForwardInfo.Date))
+	p.string("public_service_announcement_type", messageForwardInfo.PublicServiceAnnouncementType)
+	p.int("from_chat_id", int64(messageForwardInfo.FromChatId))
+	p.int("from_message_id", int64(messageForwardInfo.FromMessageId))
+	p.endObject(state)
 }
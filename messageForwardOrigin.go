@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // MessageForwardOrigin Contains information about the origin of a forwarded message
 type MessageForwardOrigin interface {
 	GetMessageForwardOriginEnum() MessageForwardOriginEnum
+	Accept(visitor MessageForwardOriginVisitor)
 }
 
 // MessageForwardOriginEnum Alias for abstract MessageForwardOrigin 'Sub-Classes', used as constant-enum here
@@ -22,46 +24,97 @@ const (
 	MessageForwardOriginMessageImportType MessageForwardOriginEnum = "messageForwardOriginMessageImport"
 )
 
+// UnknownMessageForwardOrigin is a MessageForwardOrigin of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownMessageForwardOrigin struct {
+	Unknown
+}
+
+// GetMessageForwardOriginEnum return the enum type of this object
+func (unknownMessageForwardOrigin *UnknownMessageForwardOrigin) GetMessageForwardOriginEnum() MessageForwardOriginEnum {
+	return MessageForwardOriginEnum(unknownMessageForwardOrigin.Type)
+}
+
+// Accept calls the method of the visitor for UnknownMessageForwardOrigin
+func (unknownMessageForwardOrigin *UnknownMessageForwardOrigin) Accept(visitor MessageForwardOriginVisitor) {
+	visitor.VisitUnknownMessageForwardOrigin(unknownMessageForwardOrigin)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownMessageForwardOrigin *UnknownMessageForwardOrigin) String() string {
+	return objectString(unknownMessageForwardOrigin)
+}
+
+// LogValue logs the object as its summary
+func (unknownMessageForwardOrigin *UnknownMessageForwardOrigin) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownMessageForwardOrigin))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownMessageForwardOrigin *UnknownMessageForwardOrigin) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownMessageForwardOrigin)
+}
+
+func (unknownMessageForwardOrigin *UnknownMessageForwardOrigin) printText(p *printer) {
+	if unknownMessageForwardOrigin == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownMessageForwardOrigin")
+	p.string("@type", unknownMessageForwardOrigin.Type)
+	p.endObject(state)
+}
+
 func unmarshalMessageForwardOrigin(rawMsg *json.RawMessage) (MessageForwardOrigin, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch MessageForwardOriginEnum(objMap["@type"].(string)) {
+	switch MessageForwardOriginEnum(typeName) {
 	case MessageForwardOriginUserType:
 		var messageForwardOriginUser MessageForwardOriginUser
-		err := json.Unmarshal(*rawMsg, &messageForwardOriginUser)
+		err := jsonUnmarshal(*rawMsg, &messageForwardOriginUser)
 		return &messageForwardOriginUser, err
 
 	case MessageForwardOriginChatType:
 		var messageForwardOriginChat MessageForwardOriginChat
-		err := json.Unmarshal(*rawMsg, &messageForwardOriginChat)
+		err := jsonUnmarshal(*rawMsg, &messageForwardOriginChat)
 		return &messageForwardOriginChat, err
 
 	case MessageForwardOriginHiddenUserType:
 		var messageForwardOriginHiddenUser MessageForwardOriginHiddenUser
-		err := json.Unmarshal(*rawMsg, &messageForwardOriginHiddenUser)
+		err := jsonUnmarshal(*rawMsg, &messageForwardOriginHiddenUser)
 		return &messageForwardOriginHiddenUser, err
 
 	case MessageForwardOriginChannelType:
 		var messageForwardOriginChannel MessageForwardOriginChannel
-		err := json.Unmarshal(*rawMsg, &messageForwardOriginChannel)
+		err := jsonUnmarshal(*rawMsg, &messageForwardOriginChannel)
 		return &messageForwardOriginChannel, err
 
 	case MessageForwardOriginMessageImportType:
 		var messageForwardOriginMessageImport MessageForwardOriginMessageImport
-		err := json.Unmarshal(*rawMsg, &messageForwardOriginMessageImport)
+		err := jsonUnmarshal(*rawMsg, &messageForwardOriginMessageImport)
 		return &messageForwardOriginMessageImport, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownMessageForwardOrigin{Unknown: unknown}, nil
+	}
+}
+
+func decodeMessageForwardOrigin(r *jsonReader) (MessageForwardOrigin, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
 	}
+	return unmarshalMessageForwardOrigin(rawMsg)
 }
 
 // MessageForwardOriginUser The message was originally sent by a known user
@@ -87,11 +140,118 @@ func NewMessageForwardOriginUser(senderUserId int64) *MessageForwardOriginUser {
 	return &messageForwardOriginUserTemp
 }
 
+// MarshalJSON marshals to json
+func (messageForwardOriginUser *MessageForwardOriginUser) MarshalJSON() ([]byte, error) {
+	return messageForwardOriginUser.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageForwardOriginUser *MessageForwardOriginUser) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageForwardOriginUser)
+}
+
+func (messageForwardOriginUser *MessageForwardOriginUser) appendJSON(b []byte) ([]byte, error) {
+	if messageForwardOriginUser == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageForwardOriginUser.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageForwardOriginUser.tdCommon.Extra)
+	b = append(b, `,"sender_user_id":`...)
+	b = appendJSONInt(b, int64(messageForwardOriginUser.SenderUserId))
+	return append(b, '}'), nil
+}
+
+func (messageForwardOriginUser *MessageForwardOriginUser) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageForwardOriginUser.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageForwardOriginUser.tdCommon.Extra, err = r.readString()
+		case "sender_user_id":
+			messageForwardOriginUser.SenderUserId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageForwardOriginUser *MessageForwardOriginUser) Clone() *MessageForwardOriginUser {
+	if messageForwardOriginUser == nil {
+		return nil
+	}
+	clone := *messageForwardOriginUser
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageForwardOriginUser *MessageForwardOriginUser) Equal(other *MessageForwardOriginUser) bool {
+	if messageForwardOriginUser == nil || other == nil {
+		return messageForwardOriginUser == other
+	}
+	if messageForwardOriginUser.SenderUserId != other.SenderUserId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageForwardOriginUser *MessageForwardOriginUser) Diff(other *MessageForwardOriginUser) []Change {
+	return messageForwardOriginUser.diff("", other, nil)
+}
+
+func (messageForwardOriginUser *MessageForwardOriginUser) diff(path string, other *MessageForwardOriginUser, changes []Change) []Change {
+	if messageForwardOriginUser == nil || other == nil {
+		if messageForwardOriginUser != other {
+			changes = append(changes, Change{Path: path, Old: messageForwardOriginUser, New: other})
+		}
+		return changes
+	}
+	if messageForwardOriginUser.SenderUserId != other.SenderUserId {
+		changes = append(changes, Change{Path: fieldPath(path, "sender_user_id"), Old: messageForwardOriginUser.SenderUserId, New: other.SenderUserId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageForwardOriginUser *MessageForwardOriginUser) String() string {
+	return objectString(messageForwardOriginUser)
+}
+
+// LogValue logs the object as its summary
+func (messageForwardOriginUser *MessageForwardOriginUser) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageForwardOriginUser))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageForwardOriginUser *MessageForwardOriginUser) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageForwardOriginUser)
+}
+
+func (messageForwardOriginUser *MessageForwardOriginUser) printText(p *printer) {
+	if messageForwardOriginUser == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageForwardOriginUser")
+	p.int("sender_user_id", int64(messageForwardOriginUser.SenderUserId))
+	p.endObject(state)
+}
+
 // GetMessageForwardOriginEnum return the enum type of this object
 func (messageForwardOriginUser *MessageForwardOriginUser) GetMessageForwardOriginEnum() MessageForwardOriginEnum {
 	return MessageForwardOriginUserType
 }
 
+// Accept calls the method of the visitor for MessageForwardOriginUser
+func (messageForwardOriginUser *MessageForwardOriginUser) Accept(visitor MessageForwardOriginVisitor) {
+	visitor.VisitMessageForwardOriginUser(messageForwardOriginUser)
+}
+
 // MessageForwardOriginChat The message was originally sent on behalf of a chat
 type MessageForwardOriginChat struct {
 	tdCommon
@@ -118,11 +278,129 @@ func NewMessageForwardOriginChat(senderChatId int64, authorSignature string) *Me
 	return &messageForwardOriginChatTemp
 }
 
+// MarshalJSON marshals to json
+func (messageForwardOriginChat *MessageForwardOriginChat) MarshalJSON() ([]byte, error) {
+	return messageForwardOriginChat.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageForwardOriginChat *MessageForwardOriginChat) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageForwardOriginChat)
+}
+
+func (messageForwardOriginChat *MessageForwardOriginChat) appendJSON(b []byte) ([]byte, error) {
+	if messageForwardOriginChat == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageForwardOriginChat.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageForwardOriginChat.tdCommon.Extra)
+	b = append(b, `,"sender_chat_id":`...)
+	b = appendJSONInt(b, int64(messageForwardOriginChat.SenderChatId))
+	b = append(b, `,"author_signature":`...)
+	b = appendJSONString(b, messageForwardOriginChat.AuthorSignature)
+	return append(b, '}'), nil
+}
+
+func (messageForwardOriginChat *MessageForwardOriginChat) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageForwardOriginChat.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageForwardOriginChat.tdCommon.Extra, err = r.readString()
+		case "sender_chat_id":
+			messageForwardOriginChat.SenderChatId, err = r.readInt64()
+		case "author_signature":
+			messageForwardOriginChat.AuthorSignature, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageForwardOriginChat *MessageForwardOriginChat) Clone() *MessageForwardOriginChat {
+	if messageForwardOriginChat == nil {
+		return nil
+	}
+	clone := *messageForwardOriginChat
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageForwardOriginChat *MessageForwardOriginChat) Equal(other *MessageForwardOriginChat) bool {
+	if messageForwardOriginChat == nil || other == nil {
+		return messageForwardOriginChat == other
+	}
+	if messageForwardOriginChat.SenderChatId != other.SenderChatId {
+		return false
+	}
+	if messageForwardOriginChat.AuthorSignature != other.AuthorSignature {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageForwardOriginChat *MessageForwardOriginChat) Diff(other *MessageForwardOriginChat) []Change {
+	return messageForwardOriginChat.diff("", other, nil)
+}
+
+func (messageForwardOriginChat *MessageForwardOriginChat) diff(path string, other *MessageForwardOriginChat, changes []Change) []Change {
+	if messageForwardOriginChat == nil || other == nil {
+		if messageForwardOriginChat != other {
+			changes = append(changes, Change{Path: path, Old: messageForwardOriginChat, New: other})
+		}
+		return changes
+	}
+	if messageForwardOriginChat.SenderChatId != other.SenderChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "sender_chat_id"), Old: messageForwardOriginChat.SenderChatId, New: other.SenderChatId})
+	}
+	if messageForwardOriginChat.AuthorSignature != other.AuthorSignature {
+		changes = append(changes, Change{Path: fieldPath(path, "author_signature"), Old: messageForwardOriginChat.AuthorSignature, New: other.AuthorSignature})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageForwardOriginChat *MessageForwardOriginChat) String() string {
+	return objectString(messageForwardOriginChat)
+}
+
+// LogValue logs the object as its summary
+func (messageForwardOriginChat *MessageForwardOriginChat) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageForwardOriginChat))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageForwardOriginChat *MessageForwardOriginChat) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageForwardOriginChat)
+}
+
+func (messageForwardOriginChat *MessageForwardOriginChat) printText(p *printer) {
+	if messageForwardOriginChat == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageForwardOriginChat")
+	p.int("sender_chat_id", int64(messageForwardOriginChat.SenderChatId))
+	p.string("author_signature", messageForwardOriginChat.AuthorSignature)
+	p.endObject(state)
+}
+
 // GetMessageForwardOriginEnum return the enum type of this object
 func (messageForwardOriginChat *MessageForwardOriginChat) GetMessageForwardOriginEnum() MessageForwardOriginEnum {
 	return MessageForwardOriginChatType
 }
 
+// Accept calls the method of the visitor for MessageForwardOriginChat
+func (messageForwardOriginChat *MessageForwardOriginChat) Accept(visitor MessageForwardOriginVisitor) {
+	visitor.VisitMessageForwardOriginChat(messageForwardOriginChat)
+}
+
 // MessageForwardOriginHiddenUser The message was originally sent by a user, which is hidden by their privacy settings
 type MessageForwardOriginHiddenUser struct {
 	tdCommon
@@ -146,11 +424,118 @@ func NewMessageForwardOriginHiddenUser(senderName string) *MessageForwardOriginH
 	return &messageForwardOriginHiddenUserTemp
 }
 
+// MarshalJSON marshals to json
+func (messageForwardOriginHiddenUser *MessageForwardOriginHiddenUser) MarshalJSON() ([]byte, error) {
+	return messageForwardOriginHiddenUser.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageForwardOriginHiddenUser *MessageForwardOriginHiddenUser) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageForwardOriginHiddenUser)
+}
+
+func (messageForwardOriginHiddenUser *MessageForwardOriginHiddenUser) appendJSON(b []byte) ([]byte, error) {
+	if messageForwardOriginHiddenUser == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageForwardOriginHiddenUser.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageForwardOriginHiddenUser.tdCommon.Extra)
+	b = append(b, `,"sender_name":`...)
+	b = appendJSONString(b, messageForwardOriginHiddenUser.SenderName)
+	return append(b, '}'), nil
+}
+
+func (messageForwardOriginHiddenUser *MessageForwardOriginHiddenUser) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageForwardOriginHiddenUser.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageForwardOriginHiddenUser.tdCommon.Extra, err = r.readString()
+		case "sender_name":
+			messageForwardOriginHiddenUser.SenderName, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageForwardOriginHiddenUser *MessageForwardOriginHiddenUser) Clone() *MessageForwardOriginHiddenUser {
+	if messageForwardOriginHiddenUser == nil {
+		return nil
+	}
+	clone := *messageForwardOriginHiddenUser
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageForwardOriginHiddenUser *MessageForwardOriginHiddenUser) Equal(other *MessageForwardOriginHiddenUser) bool {
+	if messageForwardOriginHiddenUser == nil || other == nil {
+		return messageForwardOriginHiddenUser == other
+	}
+	if messageForwardOriginHiddenUser.SenderName != other.SenderName {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageForwardOriginHiddenUser *MessageForwardOriginHiddenUser) Diff(other *MessageForwardOriginHiddenUser) []Change {
+	return messageForwardOriginHiddenUser.diff("", other, nil)
+}
+
+func (messageForwardOriginHiddenUser *MessageForwardOriginHiddenUser) diff(path string, other *MessageForwardOriginHiddenUser, changes []Change) []Change {
+	if messageForwardOriginHiddenUser == nil || other == nil {
+		if messageForwardOriginHiddenUser != other {
+			changes = append(changes, Change{Path: path, Old: messageForwardOriginHiddenUser, New: other})
+		}
+		return changes
+	}
+	if messageForwardOriginHiddenUser.SenderName != other.SenderName {
+		changes = append(changes, Change{Path: fieldPath(path, "sender_name"), Old: messageForwardOriginHiddenUser.SenderName, New: other.SenderName})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageForwardOriginHiddenUser *MessageForwardOriginHiddenUser) String() string {
+	return objectString(messageForwardOriginHiddenUser)
+}
+
+// LogValue logs the object as its summary
+func (messageForwardOriginHiddenUser *MessageForwardOriginHiddenUser) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageForwardOriginHiddenUser))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageForwardOriginHiddenUser *MessageForwardOriginHiddenUser) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageForwardOriginHiddenUser)
+}
+
+func (messageForwardOriginHiddenUser *MessageForwardOriginHiddenUser) printText(p *printer) {
+	if messageForwardOriginHiddenUser == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageForwardOriginHiddenUser")
+	p.string("sender_name", messageForwardOriginHiddenUser.SenderName)
+	p.endObject(state)
+}
+
 // GetMessageForwardOriginEnum return the enum type of this object
 func (messageForwardOriginHiddenUser *MessageForwardOriginHiddenUser) GetMessageForwardOriginEnum() MessageForwardOriginEnum {
 	return MessageForwardOriginHiddenUserType
 }
 
+// Accept calls the method of the visitor for MessageForwardOriginHiddenUser
+func (messageForwardOriginHiddenUser *MessageForwardOriginHiddenUser) Accept(visitor MessageForwardOriginVisitor) {
+	visitor.VisitMessageForwardOriginHiddenUser(messageForwardOriginHiddenUser)
+}
+
 // MessageForwardOriginChannel The message was originally a post in a channel
 type MessageForwardOriginChannel struct {
 	tdCommon
@@ -180,11 +565,140 @@ func NewMessageForwardOriginChannel(chatId int64, messageId int64, authorSignatu
 	return &messageForwardOriginChannelTemp
 }
 
+// MarshalJSON marshals to json
+func (messageForwardOriginChannel *MessageForwardOriginChannel) MarshalJSON() ([]byte, error) {
+	return messageForwardOriginChannel.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageForwardOriginChannel *MessageForwardOriginChannel) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageForwardOriginChannel)
+}
+
+func (messageForwardOriginChannel *MessageForwardOriginChannel) appendJSON(b []byte) ([]byte, error) {
+	if messageForwardOriginChannel == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageForwardOriginChannel.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageForwardOriginChannel.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(messageForwardOriginChannel.ChatId))
+	b = append(b, `,"message_id":`...)
+	b = appendJSONInt(b, int64(messageForwardOriginChannel.MessageId))
+	b = append(b, `,"author_signature":`...)
+	b = appendJSONString(b, messageForwardOriginChannel.AuthorSignature)
+	return append(b, '}'), nil
+}
+
+func (messageForwardOriginChannel *MessageForwardOriginChannel) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageForwardOriginChannel.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageForwardOriginChannel.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			messageForwardOriginChannel.ChatId, err = r.readInt64()
+		case "message_id":
+			messageForwardOriginChannel.MessageId, err = r.readInt64()
+		case "author_signature":
+			messageForwardOriginChannel.AuthorSignature, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageForwardOriginChannel *MessageForwardOriginChannel) Clone() *MessageForwardOriginChannel {
+	if messageForwardOriginChannel == nil {
+		return nil
+	}
+	clone := *messageForwardOriginChannel
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageForwardOriginChannel *MessageForwardOriginChannel) Equal(other *MessageForwardOriginChannel) bool {
+	if messageForwardOriginChannel == nil || other == nil {
+		return messageForwardOriginChannel == other
+	}
+	if messageForwardOriginChannel.ChatId != other.ChatId {
+		return false
+	}
+	if messageForwardOriginChannel.MessageId != other.MessageId {
+		return false
+	}
+	if messageForwardOriginChannel.AuthorSignature != other.AuthorSignature {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageForwardOriginChannel *MessageForwardOriginChannel) Diff(other *MessageForwardOriginChannel) []Change {
+	return messageForwardOriginChannel.diff("", other, nil)
+}
+
+func (messageForwardOriginChannel *MessageForwardOriginChannel) diff(path string, other *MessageForwardOriginChannel, changes []Change) []Change {
+	if messageForwardOriginChannel == nil || other == nil {
+		if messageForwardOriginChannel != other {
+			changes = append(changes, Change{Path: path, Old: messageForwardOriginChannel, New: other})
+		}
+		return changes
+	}
+	if messageForwardOriginChannel.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: messageForwardOriginChannel.ChatId, New: other.ChatId})
+	}
+	if messageForwardOriginChannel.MessageId != other.MessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "message_id"), Old: messageForwardOriginChannel.MessageId, New: other.MessageId})
+	}
+	if messageForwardOriginChannel.AuthorSignature != other.AuthorSignature {
+		changes = append(changes, Change{Path: fieldPath(path, "author_signature"), Old: messageForwardOriginChannel.AuthorSignature, New: other.AuthorSignature})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageForwardOriginChannel *MessageForwardOriginChannel) String() string {
+	return objectString(messageForwardOriginChannel)
+}
+
+// LogValue logs the object as its summary
+func (messageForwardOriginChannel *MessageForwardOriginChannel) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageForwardOriginChannel))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageForwardOriginChannel *MessageForwardOriginChannel) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageForwardOriginChannel)
+}
+
+func (messageForwardOriginChannel *MessageForwardOriginChannel) printText(p *printer) {
+	if messageForwardOriginChannel == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageForwardOriginChannel")
+	p.int("chat_id", int64(messageForwardOriginChannel.ChatId))
+	p.int("message_id", int64(messageForwardOriginChannel.MessageId))
+	p.string("author_signature", messageForwardOriginChannel.AuthorSignature)
+	p.endObject(state)
+}
+
 // GetMessageForwardOriginEnum return the enum type of this object
 func (messageForwardOriginChannel *MessageForwardOriginChannel) GetMessageForwardOriginEnum() MessageForwardOriginEnum {
 	return MessageForwardOriginChannelType
 }
 
+// Accept calls the method of the visitor for MessageForwardOriginChannel
+func (messageForwardOriginChannel *MessageForwardOriginChannel) Accept(visitor MessageForwardOriginVisitor) {
+	visitor.VisitMessageForwardOriginChannel(messageForwardOriginChannel)
+}
+
 // MessageForwardOriginMessageImport The message was imported from an exported message history
 type MessageForwardOriginMessageImport struct {
 	tdCommon
@@ -208,7 +722,254 @@ func NewMessageForwardOriginMessageImport(senderName string) *MessageForwardOrig
 	return &messageForwardOriginMessageImportTemp
 }
 
+// MarshalJSON marshals to json
+func (messageForwardOriginMessageImport *MessageForwardOriginMessageImport) MarshalJSON() ([]byte, error) {
+	return messageForwardOriginMessageImport.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageForwardOriginMessageImport *MessageForwardOriginMessageImport) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageForwardOriginMessageImport)
+}
+
+func (messageForwardOriginMessageImport *MessageForwardOriginMessageImport) appendJSON(b []byte) ([]byte, error) {
+	if messageForwardOriginMessageImport == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageForwardOriginMessageImport.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageForwardOriginMessageImport.tdCommon.Extra)
+	b = append(b, `,"sender_name":`...)
+	b = appendJSONString(b, messageForwardOriginMessageImport.SenderName)
+	return append(b, '}'), nil
+}
+
+func (messageForwardOriginMessageImport *MessageForwardOriginMessageImport) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageForwardOriginMessageImport.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageForwardOriginMessageImport.tdCommon.Extra, err = r.readString()
+		case "sender_name":
+			messageForwardOriginMessageImport.SenderName, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageForwardOriginMessageImport *MessageForwardOriginMessageImport) Clone() *MessageForwardOriginMessageImport {
+	if messageForwardOriginMessageImport == nil {
+		return nil
+	}
+	clone := *messageForwardOriginMessageImport
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageForwardOriginMessageImport *MessageForwardOriginMessageImport) Equal(other *MessageForwardOriginMessageImport) bool {
+	if messageForwardOriginMessageImport == nil || other == nil {
+		return messageForwardOriginMessageImport == other
+	}
+	if messageForwardOriginMessageImport.SenderName != other.SenderName {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageForwardOriginMessageImport *MessageForwardOriginMessageImport) Diff(other *MessageForwardOriginMessageImport) []Change {
+	return messageForwardOriginMessageImport.diff("", other, nil)
+}
+
+func (messageForwardOriginMessageImport *MessageForwardOriginMessageImport) diff(path string, other *MessageForwardOriginMessageImport, changes []Change) []Change {
+	if messageForwardOriginMessageImport == nil || other == nil {
+		if messageForwardOriginMessageImport != other {
+			changes = append(changes, Change{Path: path, Old: messageForwardOriginMessageImport, New: other})
+		}
+		return changes
+	}
+	if messageForwardOriginMessageImport.SenderName != other.SenderName {
+		changes = append(changes, Change{Path: fieldPath(path, "sender_name"), Old: messageForwardOriginMessageImport.SenderName, New: other.SenderName})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageForwardOriginMessageImport *MessageForwardOriginMessageImport) String() string {
+	return objectString(messageForwardOriginMessageImport)
+}
+
+// LogValue logs the object as its summary
+func (messageForwardOriginMessageImport *MessageForwardOriginMessageImport) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageForwardOriginMessageImport))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageForwardOriginMessageImport *MessageForwardOriginMessageImport) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageForwardOriginMessageImport)
+}
+
+func (messageForwardOriginMessageImport *MessageForwardOriginMessageImport) printText(p *printer) {
+	if messageForwardOriginMessageImport == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageForwardOriginMessageImport")
+	p.string("sender_name", messageForwardOriginMessageImport.SenderName)
+	p.endObject(state)
+}
+
 // GetMessageForwardOriginEnum return the enum type of this object
 func (messageForwardOriginMessageImport *MessageForwardOriginMessageImport) GetMessageForwardOriginEnum() MessageForwardOriginEnum {
 	return MessageForwardOriginMessageImportType
 }
+
+// Accept calls the method of the visitor for MessageForwardOriginMessageImport
+func (messageForwardOriginMessageImport *MessageForwardOriginMessageImport) Accept(visitor MessageForwardOriginVisitor) {
+	visitor.VisitMessageForwardOriginMessageImport(messageForwardOriginMessageImport)
+}
+
+// MessageForwardOriginVisitor visits every type of MessageForwardOrigin, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to MessageForwardOrigin, until they handle it too.
+type MessageForwardOriginVisitor interface {
+	VisitMessageForwardOriginUser(messageForwardOriginUser *MessageForwardOriginUser)
+	VisitMessageForwardOriginChat(messageForwardOriginChat *MessageForwardOriginChat)
+	VisitMessageForwardOriginHiddenUser(messageForwardOriginHiddenUser *MessageForwardOriginHiddenUser)
+	VisitMessageForwardOriginChannel(messageForwardOriginChannel *MessageForwardOriginChannel)
+	VisitMessageForwardOriginMessageImport(messageForwardOriginMessageImport *MessageForwardOriginMessageImport)
+	VisitUnknownMessageForwardOrigin(unknownMessageForwardOrigin *UnknownMessageForwardOrigin)
+}
+
+// MessageForwardOriginCases holds the function MatchMessageForwardOrigin calls for each type of MessageForwardOrigin;
+// types without a function are passed to the default case
+type MessageForwardOriginCases[R any] struct {
+	MessageForwardOriginUser          func(messageForwardOriginUser *MessageForwardOriginUser) R
+	MessageForwardOriginChat          func(messageForwardOriginChat *MessageForwardOriginChat) R
+	MessageForwardOriginHiddenUser    func(messageForwardOriginHiddenUser *MessageForwardOriginHiddenUser) R
+	MessageForwardOriginChannel       func(messageForwardOriginChannel *MessageForwardOriginChannel) R
+	MessageForwardOriginMessageImport func(messageForwardOriginMessageImport *MessageForwardOriginMessageImport) R
+}
+
+// MatchMessageForwardOrigin calls the case of the type of messageForwardOrigin, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement MessageForwardOriginVisitor
+// to have the compiler check every type is handled.
+func MatchMessageForwardOrigin[R any](messageForwardOrigin MessageForwardOrigin, cases MessageForwardOriginCases[R], defaultCase func(messageForwardOrigin MessageForwardOrigin) R) R {
+	switch value := messageForwardOrigin.(type) {
+	case *MessageForwardOriginUser:
+		if cases.MessageForwardOriginUser != nil {
+			return cases.MessageForwardOriginUser(value)
+		}
+	case *MessageForwardOriginChat:
+		if cases.MessageForwardOriginChat != nil {
+			return cases.MessageForwardOriginChat(value)
+		}
+	case *MessageForwardOriginHiddenUser:
+		if cases.MessageForwardOriginHiddenUser != nil {
+			return cases.MessageForwardOriginHiddenUser(value)
+		}
+	case *MessageForwardOriginChannel:
+		if cases.MessageForwardOriginChannel != nil {
+			return cases.MessageForwardOriginChannel(value)
+		}
+	case *MessageForwardOriginMessageImport:
+		if cases.MessageForwardOriginMessageImport != nil {
+			return cases.MessageForwardOriginMessageImport(value)
+		}
+	}
+	return defaultCase(messageForwardOrigin)
+}
+
+// Clone returns a deep copy of the object
+func (unknownMessageForwardOrigin *UnknownMessageForwardOrigin) Clone() *UnknownMessageForwardOrigin {
+	if unknownMessageForwardOrigin == nil {
+		return nil
+	}
+	return &UnknownMessageForwardOrigin{Unknown: unknownMessageForwardOrigin.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownMessageForwardOrigin *UnknownMessageForwardOrigin) Equal(other *UnknownMessageForwardOrigin) bool {
+	if unknownMessageForwardOrigin == nil || other == nil {
+		return unknownMessageForwardOrigin == other
+	}
+	return unknownMessageForwardOrigin.Unknown.equal(other.Unknown)
+}
+
+func cloneMessageForwardOrigin(messageForwardOrigin MessageForwardOrigin) MessageForwardOrigin {
+	switch value := messageForwardOrigin.(type) {
+	case *MessageForwardOriginUser:
+		return value.Clone()
+	case *MessageForwardOriginChat:
+		return value.Clone()
+	case *MessageForwardOriginHiddenUser:
+		return value.Clone()
+	case *MessageForwardOriginChannel:
+		return value.Clone()
+	case *MessageForwardOriginMessageImport:
+		return value.Clone()
+	case *UnknownMessageForwardOrigin:
+		return value.Clone()
+	}
+	return messageForwardOrigin
+}
+
+func equalMessageForwardOrigin(a MessageForwardOrigin, b MessageForwardOrigin) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *MessageForwardOriginUser:
+		b, ok := b.(*MessageForwardOriginUser)
+		return ok && a.Equal(b)
+	case *MessageForwardOriginChat:
+		b, ok := b.(*MessageForwardOriginChat)
+		return ok && a.Equal(b)
+	case *MessageForwardOriginHiddenUser:
+		b, ok := b.(*MessageForwardOriginHiddenUser)
+		return ok && a.Equal(b)
+	case *MessageForwardOriginChannel:
+		b, ok := b.(*MessageForwardOriginChannel)
+		return ok && a.Equal(b)
+	case *MessageForwardOriginMessageImport:
+		b, ok := b.(*MessageForwardOriginMessageImport)
+		return ok && a.Equal(b)
+	case *UnknownMessageForwardOrigin:
+		b, ok := b.(*UnknownMessageForwardOrigin)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffMessageForwardOrigin(path string, a MessageForwardOrigin, b MessageForwardOrigin, changes []Change) []Change {
+	switch a := a.(type) {
+	case *MessageForwardOriginUser:
+		if b, ok := b.(*MessageForwardOriginUser); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageForwardOriginChat:
+		if b, ok := b.(*MessageForwardOriginChat); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageForwardOriginHiddenUser:
+		if b, ok := b.(*MessageForwardOriginHiddenUser); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageForwardOriginChannel:
+		if b, ok := b.(*MessageForwardOriginChannel); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageForwardOriginMessageImport:
+		if b, ok := b.(*MessageForwardOriginMessageImport); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalMessageForwardOrigin(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // MessageInteractionInfo Contains information about interactions with a message
 type MessageInteractionInfo struct {
 	tdCommon
@@ -28,3 +33,135 @@ func NewMessageInteractionInfo(viewCount int32, forwardCount int32, replyInfo *M
 
 	return &messageInteractionInfoTemp
 }
+
+// MarshalJSON marshals to json
+func (messageInteractionInfo *MessageInteractionInfo) MarshalJSON() ([]byte, error) {
+	return messageInteractionInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageInteractionInfo *MessageInteractionInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageInteractionInfo)
+}
+
+func (messageInteractionInfo *MessageInteractionInfo) appendJSON(b []byte) ([]byte, error) {
+	if messageInteractionInfo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageInteractionInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageInteractionInfo.tdCommon.Extra)
+	b = append(b, `,"view_count":`...)
+	b = appendJSONInt(b, int64(messageInteractionInfo.ViewCount))
+	b = append(b, `,"forward_count":`...)
+	b = appendJSONInt(b, int64(messageInteractionInfo.ForwardCount))
+	b = append(b, `,"reply_info":`...)
+	b, err = messageInteractionInfo.ReplyInfo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (messageInteractionInfo *MessageInteractionInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageInteractionInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageInteractionInfo.tdCommon.Extra, err = r.readString()
+		case "view_count":
+			messageInteractionInfo.ViewCount, err = r.readInt32()
+		case "forward_count":
+			messageInteractionInfo.ForwardCount, err = r.readInt32()
+		case "reply_info":
+			if r.readNull() {
+				messageInteractionInfo.ReplyInfo = nil
+			} else {
+				messageInteractionInfo.ReplyInfo = new(MessageReplyInfo)
+				err = messageInteractionInfo.ReplyInfo.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageInteractionInfo *MessageInteractionInfo) Clone() *MessageInteractionInfo {
+	if messageInteractionInfo == nil {
+		return nil
+	}
+	clone := *messageInteractionInfo
+	clone.ReplyInfo = messageInteractionInfo.ReplyInfo.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageInteractionInfo *MessageInteractionInfo) Equal(other *MessageInteractionInfo) bool {
+	if messageInteractionInfo == nil || other == nil {
+		return messageInteractionInfo == other
+	}
+	if messageInteractionInfo.ViewCount != other.ViewCount {
+		return false
+	}
+	if messageInteractionInfo.ForwardCount != other.ForwardCount {
+		return false
+	}
+	if !messageInteractionInfo.ReplyInfo.Equal(other.ReplyInfo) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageInteractionInfo *MessageInteractionInfo) Diff(other *MessageInteractionInfo) []Change {
+	return messageInteractionInfo.diff("", other, nil)
+}
+
+func (messageInteractionInfo *MessageInteractionInfo) diff(path string, other *MessageInteractionInfo, changes []Change) []Change {
+	if messageInteractionInfo == nil || other == nil {
+		if messageInteractionInfo != other {
+			changes = append(changes, Change{Path: path, Old: messageInteractionInfo, New: other})
+		}
+		return changes
+	}
+	if messageInteractionInfo.ViewCount != other.ViewCount {
+		changes = append(changes, Change{Path: fieldPath(path, "view_count"), Old: messageInteractionInfo.ViewCount, New: other.ViewCount})
+	}
+	if messageInteractionInfo.ForwardCount != other.ForwardCount {
+		changes = append(changes, Change{Path: fieldPath(path, "forward_count"), Old: messageInteractionInfo.ForwardCount, New: other.ForwardCount})
+	}
+	changes = messageInteractionInfo.ReplyInfo.diff(fieldPath(path, "reply_info"), other.ReplyInfo, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageInteractionInfo *MessageInteractionInfo) String() string {
+	return objectString(messageInteractionInfo)
+}
+
+// LogValue logs the object as its summary
+func (messageInteractionInfo *MessageInteractionInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageInteractionInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageInteractionInfo *MessageInteractionInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageInteractionInfo)
+}
+
+func (messageInteractionInfo *MessageInteractionInfo) printText(p *printer) {
+	if messageInteractionInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageInteractionInfo")
+	p.int("view_count", int64(messageInteractionInfo.ViewCount))
+	p.int("forward_count", int64(messageInteractionInfo.ForwardCount))
+	p.object("reply_info", messageInteractionInfo.ReplyInfo)
+	p.endObject(state)
+}
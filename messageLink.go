@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // MessageLink Contains an HTTPS link to a message in a supergroup or channel
@@ -31,6 +31,119 @@ func NewMessageLink(link string, isPublic bool) *MessageLink {
 	return &messageLinkTemp
 }
 
+// MarshalJSON marshals to json
+func (messageLink *MessageLink) MarshalJSON() ([]byte, error) {
+	return messageLink.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageLink *MessageLink) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageLink)
+}
+
+func (messageLink *MessageLink) appendJSON(b []byte) ([]byte, error) {
+	if messageLink == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageLink.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageLink.tdCommon.Extra)
+	b = append(b, `,"link":`...)
+	b = appendJSONString(b, messageLink.Link)
+	b = append(b, `,"is_public":`...)
+	b = appendJSONBool(b, messageLink.IsPublic)
+	return append(b, '}'), nil
+}
+
+func (messageLink *MessageLink) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageLink.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageLink.tdCommon.Extra, err = r.readString()
+		case "link":
+			messageLink.Link, err = r.readString()
+		case "is_public":
+			messageLink.IsPublic, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageLink *MessageLink) Clone() *MessageLink {
+	if messageLink == nil {
+		return nil
+	}
+	clone := *messageLink
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageLink *MessageLink) Equal(other *MessageLink) bool {
+	if messageLink == nil || other == nil {
+		return messageLink == other
+	}
+	if messageLink.Link != other.Link {
+		return false
+	}
+	if messageLink.IsPublic != other.IsPublic {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageLink *MessageLink) Diff(other *MessageLink) []Change {
+	return messageLink.diff("", other, nil)
+}
+
+func (messageLink *MessageLink) diff(path string, other *MessageLink, changes []Change) []Change {
+	if messageLink == nil || other == nil {
+		if messageLink != other {
+			changes = append(changes, Change{Path: path, Old: messageLink, New: other})
+		}
+		return changes
+	}
+	if messageLink.Link != other.Link {
+		changes = append(changes, Change{Path: fieldPath(path, "link"), Old: messageLink.Link, New: other.Link})
+	}
+	if messageLink.IsPublic != other.IsPublic {
+		changes = append(changes, Change{Path: fieldPath(path, "is_public"), Old: messageLink.IsPublic, New: other.IsPublic})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageLink *MessageLink) String() string {
+	return objectString(messageLink)
+}
+
+// LogValue logs the object as its summary
+func (messageLink *MessageLink) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageLink))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageLink *MessageLink) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageLink)
+}
+
+func (messageLink *MessageLink) printText(p *printer) {
+	if messageLink == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageLink")
+	p.string("link", messageLink.Link)
+	p.bool("is_public", messageLink.IsPublic)
+	p.endObject(state)
+}
+
 // GetMessageLink Returns an HTTPS link to a message in a chat. Available only for already sent messages in supergroups and channels, or if message.can_get_media_timestamp_links and a media timestamp link is generated. This is an offline request
 // @param chatId Identifier of the chat to which the message belongs
 // @param messageId Identifier of the message
@@ -51,11 +164,11 @@ func (client *Client) GetMessageLink(chatId int64, messageId int64, mediaTimesta
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messageLink MessageLink
-	err = json.Unmarshal(result.Raw, &messageLink)
+	err = jsonUnmarshal(result.Raw, &messageLink)
 	return &messageLink, err
 }
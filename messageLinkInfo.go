@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // MessageLinkInfo Contains information about a link to a message in a chat
@@ -43,6 +43,171 @@ func NewMessageLinkInfo(isPublic bool, chatId int64, message *Message, mediaTime
 	return &messageLinkInfoTemp
 }
 
+// MarshalJSON marshals to json
+func (messageLinkInfo *MessageLinkInfo) MarshalJSON() ([]byte, error) {
+	return messageLinkInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageLinkInfo *MessageLinkInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageLinkInfo)
+}
+
+func (messageLinkInfo *MessageLinkInfo) appendJSON(b []byte) ([]byte, error) {
+	if messageLinkInfo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageLinkInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageLinkInfo.tdCommon.Extra)
+	b = append(b, `,"is_public":`...)
+	b = appendJSONBool(b, messageLinkInfo.IsPublic)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(messageLinkInfo.ChatId))
+	b = append(b, `,"message":`...)
+	b, err = messageLinkInfo.Message.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"media_timestamp":`...)
+	b = appendJSONInt(b, int64(messageLinkInfo.MediaTimestamp))
+	b = append(b, `,"for_album":`...)
+	b = appendJSONBool(b, messageLinkInfo.ForAlbum)
+	b = append(b, `,"for_comment":`...)
+	b = appendJSONBool(b, messageLinkInfo.ForComment)
+	return append(b, '}'), nil
+}
+
+func (messageLinkInfo *MessageLinkInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageLinkInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageLinkInfo.tdCommon.Extra, err = r.readString()
+		case "is_public":
+			messageLinkInfo.IsPublic, err = r.readBool()
+		case "chat_id":
+			messageLinkInfo.ChatId, err = r.readInt64()
+		case "message":
+			if r.readNull() {
+				messageLinkInfo.Message = nil
+			} else {
+				messageLinkInfo.Message = new(Message)
+				err = messageLinkInfo.Message.decodeJSON(r)
+			}
+		case "media_timestamp":
+			messageLinkInfo.MediaTimestamp, err = r.readInt32()
+		case "for_album":
+			messageLinkInfo.ForAlbum, err = r.readBool()
+		case "for_comment":
+			messageLinkInfo.ForComment, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageLinkInfo *MessageLinkInfo) Clone() *MessageLinkInfo {
+	if messageLinkInfo == nil {
+		return nil
+	}
+	clone := *messageLinkInfo
+	clone.Message = messageLinkInfo.Message.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageLinkInfo *MessageLinkInfo) Equal(other *MessageLinkInfo) bool {
+	if messageLinkInfo == nil || other == nil {
+		return messageLinkInfo == other
+	}
+	if messageLinkInfo.IsPublic != other.IsPublic {
+		return false
+	}
+	if messageLinkInfo.ChatId != other.ChatId {
+		return false
+	}
+	if !messageLinkInfo.Message.Equal(other.Message) {
+		return false
+	}
+	if messageLinkInfo.MediaTimestamp != other.MediaTimestamp {
+		return false
+	}
+	if messageLinkInfo.ForAlbum != other.ForAlbum {
+		return false
+	}
+	if messageLinkInfo.ForComment != other.ForComment {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageLinkInfo *MessageLinkInfo) Diff(other *MessageLinkInfo) []Change {
+	return messageLinkInfo.diff("", other, nil)
+}
+
+func (messageLinkInfo *MessageLinkInfo) diff(path string, other *MessageLinkInfo, changes []Change) []Change {
+	if messageLinkInfo == nil || other == nil {
+		if messageLinkInfo != other {
+			changes = append(changes, Change{Path: path, Old: messageLinkInfo, New: other})
+		}
+		return changes
+	}
+	if messageLinkInfo.IsPublic != other.IsPublic {
+		changes = append(changes, Change{Path: fieldPath(path, "is_public"), Old: messageLinkInfo.IsPublic, New: other.IsPublic})
+	}
+	if messageLinkInfo.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: messageLinkInfo.ChatId, New: other.ChatId})
+	}
+	changes = messageLinkInfo.Message.diff(fieldPath(path, "message"), other.Message, changes)
+	if messageLinkInfo.MediaTimestamp != other.MediaTimestamp {
+		changes = append(changes, Change{Path: fieldPath(path, "media_timestamp"), Old: messageLinkInfo.MediaTimestamp, New: other.MediaTimestamp})
+	}
+	if messageLinkInfo.ForAlbum != other.ForAlbum {
+		changes = append(changes, Change{Path: fieldPath(path, "for_album"), Old: messageLinkInfo.ForAlbum, New: other.ForAlbum})
+	}
+	if messageLinkInfo.ForComment != other.ForComment {
+		changes = append(changes, Change{Path: fieldPath(path, "for_comment"), Old: messageLinkInfo.ForComment, New: other.ForComment})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageLinkInfo *MessageLinkInfo) String() string {
+	return objectString(messageLinkInfo)
+}
+
+// LogValue logs the object as its summary
+func (messageLinkInfo *MessageLinkInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageLinkInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageLinkInfo *MessageLinkInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageLinkInfo)
+}
+
+func (messageLinkInfo *MessageLinkInfo) printText(p *printer) {
+	if messageLinkInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageLinkInfo")
+	p.bool("is_public", messageLinkInfo.IsPublic)
+	p.int("chat_id", int64(messageLinkInfo.ChatId))
+	p.object("message", messageLinkInfo.Message)
+	p.int("media_timestamp", int64(messageLinkInfo.MediaTimestamp))
+	p.bool("for_album", messageLinkInfo.ForAlbum)
+	p.bool("for_comment", messageLinkInfo.ForComment)
+	p.endObject(state)
+}
+
 // GetMessageLinkInfo Returns information about a public or private message link. Can be called for any internal link of the type internalLinkTypeMessage
 // @param url The message link
 func (client *Client) GetMessageLinkInfo(url string) (*MessageLinkInfo, error) {
@@ -55,11 +220,11 @@ func (client *Client) GetMessageLinkInfo(url string) (*MessageLinkInfo, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messageLinkInfo MessageLinkInfo
-	err = json.Unmarshal(result.Raw, &messageLinkInfo)
+	err = jsonUnmarshal(result.Raw, &messageLinkInfo)
 	return &messageLinkInfo, err
 }
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // MessagePosition Contains information about a message in a specific position
 type MessagePosition struct {
 	tdCommon
@@ -28,3 +33,127 @@ func NewMessagePosition(position int32, messageId int64, date int32) *MessagePos
 
 	return &messagePositionTemp
 }
+
+// MarshalJSON marshals to json
+func (messagePosition *MessagePosition) MarshalJSON() ([]byte, error) {
+	return messagePosition.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messagePosition *MessagePosition) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messagePosition)
+}
+
+func (messagePosition *MessagePosition) appendJSON(b []byte) ([]byte, error) {
+	if messagePosition == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messagePosition.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messagePosition.tdCommon.Extra)
+	b = append(b, `,"position":`...)
+	b = appendJSONInt(b, int64(messagePosition.Position))
+	b = append(b, `,"message_id":`...)
+	b = appendJSONInt(b, int64(messagePosition.MessageId))
+	b = append(b, `,"date":`...)
+	b = appendJSONInt(b, int64(messagePosition.Date))
+	return append(b, '}'), nil
+}
+
+func (messagePosition *MessagePosition) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messagePosition.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messagePosition.tdCommon.Extra, err = r.readString()
+		case "position":
+			messagePosition.Position, err = r.readInt32()
+		case "message_id":
+			messagePosition.MessageId, err = r.readInt64()
+		case "date":
+			messagePosition.Date, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messagePosition *MessagePosition) Clone() *MessagePosition {
+	if messagePosition == nil {
+		return nil
+	}
+	clone := *messagePosition
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messagePosition *MessagePosition) Equal(other *MessagePosition) bool {
+	if messagePosition == nil || other == nil {
+		return messagePosition == other
+	}
+	if messagePosition.Position != other.Position {
+		return false
+	}
+	if messagePosition.MessageId != other.MessageId {
+		return false
+	}
+	if messagePosition.Date != other.Date {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messagePosition *MessagePosition) Diff(other *MessagePosition) []Change {
+	return messagePosition.diff("", other, nil)
+}
+
+func (messagePosition *MessagePosition) diff(path string, other *MessagePosition, changes []Change) []Change {
+	if messagePosition == nil || other == nil {
+		if messagePosition != other {
+			changes = append(changes, Change{Path: path, Old: messagePosition, New: other})
+		}
+		return changes
+	}
+	if messagePosition.Position != other.Position {
+		changes = append(changes, Change{Path: fieldPath(path, "position"), Old: messagePosition.Position, New: other.Position})
+	}
+	if messagePosition.MessageId != other.MessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "message_id"), Old: messagePosition.MessageId, New: other.MessageId})
+	}
+	if messagePosition.Date != other.Date {
+		changes = append(changes, Change{Path: fieldPath(path, "date"), Old: messagePosition.Date, New: other.Date})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messagePosition *MessagePosition) String() string {
+	return objectString(messagePosition)
+}
+
+// LogValue logs the object as its summary
+func (messagePosition *MessagePosition) LogValue() slog.Value {
+	return slog.StringValue(objectString(messagePosition))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messagePosition *MessagePosition) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messagePosition)
+}
+
+func (messagePosition *MessagePosition) printText(p *printer) {
+	if messagePosition == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessagePosition")
+	p.int("position", int64(messagePosition.Position))
+	p.int("message_id", int64(messagePosition.MessageId))
+	p.int("date", int64(messagePosition.Date))
+	p.endObject(state)
+}
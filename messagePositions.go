@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // MessagePositions Contains a list of message positions
@@ -31,6 +31,161 @@ func NewMessagePositions(totalCount int32, positions []MessagePosition) *Message
 	return &messagePositionsTemp
 }
 
+// MarshalJSON marshals to json
+func (messagePositions *MessagePositions) MarshalJSON() ([]byte, error) {
+	return messagePositions.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messagePositions *MessagePositions) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messagePositions)
+}
+
+func (messagePositions *MessagePositions) appendJSON(b []byte) ([]byte, error) {
+	if messagePositions == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messagePositions.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messagePositions.tdCommon.Extra)
+	b = append(b, `,"total_count":`...)
+	b = appendJSONInt(b, int64(messagePositions.TotalCount))
+	b = append(b, `,"positions":`...)
+	if messagePositions.Positions == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range messagePositions.Positions {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = messagePositions.Positions[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (messagePositions *MessagePositions) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messagePositions.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messagePositions.tdCommon.Extra, err = r.readString()
+		case "total_count":
+			messagePositions.TotalCount, err = r.readInt32()
+		case "positions":
+			if r.readNull() {
+				messagePositions.Positions = nil
+			} else {
+				messagePositions.Positions = make([]MessagePosition, 0)
+				err = r.readArray(func() (err error) {
+					var item0 MessagePosition
+					err = item0.decodeJSON(r)
+					messagePositions.Positions = append(messagePositions.Positions, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messagePositions *MessagePositions) Clone() *MessagePositions {
+	if messagePositions == nil {
+		return nil
+	}
+	clone := *messagePositions
+	if messagePositions.Positions != nil {
+		clone.Positions = make([]MessagePosition, len(messagePositions.Positions))
+		for i0 := range messagePositions.Positions {
+			clone.Positions[i0] = *messagePositions.Positions[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messagePositions *MessagePositions) Equal(other *MessagePositions) bool {
+	if messagePositions == nil || other == nil {
+		return messagePositions == other
+	}
+	if messagePositions.TotalCount != other.TotalCount {
+		return false
+	}
+	if len(messagePositions.Positions) != len(other.Positions) {
+		return false
+	}
+	for i0 := range messagePositions.Positions {
+		if !messagePositions.Positions[i0].Equal(&other.Positions[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messagePositions *MessagePositions) Diff(other *MessagePositions) []Change {
+	return messagePositions.diff("", other, nil)
+}
+
+func (messagePositions *MessagePositions) diff(path string, other *MessagePositions, changes []Change) []Change {
+	if messagePositions == nil || other == nil {
+		if messagePositions != other {
+			changes = append(changes, Change{Path: path, Old: messagePositions, New: other})
+		}
+		return changes
+	}
+	if messagePositions.TotalCount != other.TotalCount {
+		changes = append(changes, Change{Path: fieldPath(path, "total_count"), Old: messagePositions.TotalCount, New: other.TotalCount})
+	}
+	if len(messagePositions.Positions) != len(other.Positions) {
+		changes = append(changes, Change{Path: fieldPath(path, "positions"), Old: messagePositions.Positions, New: other.Positions})
+	} else {
+		for i0 := range messagePositions.Positions {
+			changes = messagePositions.Positions[i0].diff(indexPath(fieldPath(path, "positions"), i0), &other.Positions[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messagePositions *MessagePositions) String() string {
+	return objectString(messagePositions)
+}
+
+// LogValue logs the object as its summary
+func (messagePositions *MessagePositions) LogValue() slog.Value {
+	return slog.StringValue(objectString(messagePositions))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messagePositions *MessagePositions) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messagePositions)
+}
+
+func (messagePositions *MessagePositions) printText(p *printer) {
+	if messagePositions == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessagePositions")
+	p.int("total_count", int64(messagePositions.TotalCount))
+	p.vector("positions", len(messagePositions.Positions), func(i0 int) {
+		messagePositions.Positions[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetChatSparseMessagePositions Returns sparse positions of messages of the specified type in the chat to be used for shared media scroll implementation. Returns the results in reverse chronological order (i.e., in order of decreasing message_id). Cannot be used in secret chats or with searchMessagesFilterFailedToSend filter without an enabled message database
 // @param chatId Identifier of the chat in which to return information about message positions
 // @param filter Filter for message content. Filters searchMessagesFilterEmpty, searchMessagesFilterMention and searchMessagesFilterUnreadMention are unsupported in this function
@@ -49,11 +204,11 @@ func (client *Client) GetChatSparseMessagePositions(chatId int64, filter SearchM
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messagePositions MessagePositions
-	err = json.Unmarshal(result.Raw, &messagePositions)
+	err = jsonUnmarshal(result.Raw, &messagePositions)
 	return &messagePositions, err
 }
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // MessageReplyInfo Contains information about replies to a message
 type MessageReplyInfo struct {
 	tdCommon
@@ -34,3 +39,191 @@ func NewMessageReplyInfo(replyCount int32, recentReplierIds []MessageSender, las
 
 	return &messageReplyInfoTemp
 }
+
+// MarshalJSON marshals to json
+func (messageReplyInfo *MessageReplyInfo) MarshalJSON() ([]byte, error) {
+	return messageReplyInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageReplyInfo *MessageReplyInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageReplyInfo)
+}
+
+func (messageReplyInfo *MessageReplyInfo) appendJSON(b []byte) ([]byte, error) {
+	if messageReplyInfo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageReplyInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageReplyInfo.tdCommon.Extra)
+	b = append(b, `,"reply_count":`...)
+	b = appendJSONInt(b, int64(messageReplyInfo.ReplyCount))
+	b = append(b, `,"recent_replier_ids":`...)
+	if messageReplyInfo.RecentReplierIds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range messageReplyInfo.RecentReplierIds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = appendJSONValue(b, messageReplyInfo.RecentReplierIds[i0])
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"last_read_inbox_message_id":`...)
+	b = appendJSONInt(b, int64(messageReplyInfo.LastReadInboxMessageId))
+	b = append(b, `,"last_read_outbox_message_id":`...)
+	b = appendJSONInt(b, int64(messageReplyInfo.LastReadOutboxMessageId))
+	b = append(b, `,"last_message_id":`...)
+	b = appendJSONInt(b, int64(messageReplyInfo.LastMessageId))
+	return append(b, '}'), nil
+}
+
+func (messageReplyInfo *MessageReplyInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageReplyInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageReplyInfo.tdCommon.Extra, err = r.readString()
+		case "reply_count":
+			messageReplyInfo.ReplyCount, err = r.readInt32()
+		case "recent_replier_ids":
+			if r.readNull() {
+				messageReplyInfo.RecentReplierIds = nil
+			} else {
+				messageReplyInfo.RecentReplierIds = make([]MessageSender, 0)
+				err = r.readArray(func() (err error) {
+					var item0 MessageSender
+					item0, err = decodeMessageSender(r)
+					messageReplyInfo.RecentReplierIds = append(messageReplyInfo.RecentReplierIds, item0)
+					return
+				})
+			}
+		case "last_read_inbox_message_id":
+			messageReplyInfo.LastReadInboxMessageId, err = r.readInt64()
+		case "last_read_outbox_message_id":
+			messageReplyInfo.LastReadOutboxMessageId, err = r.readInt64()
+		case "last_message_id":
+			messageReplyInfo.LastMessageId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageReplyInfo *MessageReplyInfo) Clone() *MessageReplyInfo {
+	if messageReplyInfo == nil {
+		return nil
+	}
+	clone := *messageReplyInfo
+	if messageReplyInfo.RecentReplierIds != nil {
+		clone.RecentReplierIds = make([]MessageSender, len(messageReplyInfo.RecentReplierIds))
+		for i0 := range messageReplyInfo.RecentReplierIds {
+			clone.RecentReplierIds[i0] = cloneMessageSender(messageReplyInfo.RecentReplierIds[i0])
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageReplyInfo *MessageReplyInfo) Equal(other *MessageReplyInfo) bool {
+	if messageReplyInfo == nil || other == nil {
+		return messageReplyInfo == other
+	}
+	if messageReplyInfo.ReplyCount != other.ReplyCount {
+		return false
+	}
+	if len(messageReplyInfo.RecentReplierIds) != len(other.RecentReplierIds) {
+		return false
+	}
+	for i0 := range messageReplyInfo.RecentReplierIds {
+		if !equalMessageSender(messageReplyInfo.RecentReplierIds[i0], other.RecentReplierIds[i0]) {
+			return false
+		}
+	}
+	if messageReplyInfo.LastReadInboxMessageId != other.LastReadInboxMessageId {
+		return false
+	}
+	if messageReplyInfo.LastReadOutboxMessageId != other.LastReadOutboxMessageId {
+		return false
+	}
+	if messageReplyInfo.LastMessageId != other.LastMessageId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageReplyInfo *MessageReplyInfo) Diff(other *MessageReplyInfo) []Change {
+	return messageReplyInfo.diff("", other, nil)
+}
+
+func (messageReplyInfo *MessageReplyInfo) diff(path string, other *MessageReplyInfo, changes []Change) []Change {
+	if messageReplyInfo == nil || other == nil {
+		if messageReplyInfo != other {
+			changes = append(changes, Change{Path: path, Old: messageReplyInfo, New: other})
+		}
+		return changes
+	}
+	if messageReplyInfo.ReplyCount != other.ReplyCount {
+		changes = append(changes, Change{Path: fieldPath(path, "reply_count"), Old: messageReplyInfo.ReplyCount, New: other.ReplyCount})
+	}
+	if len(messageReplyInfo.RecentReplierIds) != len(other.RecentReplierIds) {
+		changes = append(changes, Change{Path: fieldPath(path, "recent_replier_ids"), Old: messageReplyInfo.RecentReplierIds, New: other.RecentReplierIds})
+	} else {
+		for i0 := range messageReplyInfo.RecentReplierIds {
+			changes = diffMessageSender(indexPath(fieldPath(path, "recent_replier_ids"), i0), messageReplyInfo.RecentReplierIds[i0], other.RecentReplierIds[i0], changes)
+		}
+	}
+	if messageReplyInfo.LastReadInboxMessageId != other.LastReadInboxMessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "last_read_inbox_message_id"), Old: messageReplyInfo.LastReadInboxMessageId, New: other.LastReadInboxMessageId})
+	}
+	if messageReplyInfo.LastReadOutboxMessageId != other.LastReadOutboxMessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "last_read_outbox_message_id"), Old: messageReplyInfo.LastReadOutboxMessageId, New: other.LastReadOutboxMessageId})
+	}
+	if messageReplyInfo.LastMessageId != other.LastMessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "last_message_id"), Old: messageReplyInfo.LastMessageId, New: other.LastMessageId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageReplyInfo *MessageReplyInfo) String() string {
+	return objectString(messageReplyInfo)
+}
+
+// LogValue logs the object as its summary
+func (messageReplyInfo *MessageReplyInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageReplyInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageReplyInfo *MessageReplyInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageReplyInfo)
+}
+
+func (messageReplyInfo *MessageReplyInfo) printText(p *printer) {
+	if messageReplyInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageReplyInfo")
+	p.int("reply_count", int64(messageReplyInfo.ReplyCount))
+	p.vector("recent_replier_ids", len(messageReplyInfo.RecentReplierIds), func(i0 int) {
+		p.object("recent_replier_ids", messageReplyInfo.RecentReplierIds[i0])
+	})
+	p.int("last_read_inbox_message_id", int64(messageReplyInfo.LastReadInboxMessageId))
+	p.int("last_read_outbox_message_id", int64(messageReplyInfo.LastReadOutboxMessageId))
+	p.int("last_message_id", int64(messageReplyInfo.LastMessageId))
+	p.endObject(state)
+}
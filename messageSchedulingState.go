@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // MessageSchedulingState Contains information about the time when a scheduled message will be sent
 type MessageSchedulingState interface {
 	GetMessageSchedulingStateEnum() MessageSchedulingStateEnum
+	Accept(visitor MessageSchedulingStateVisitor)
 }
 
 // MessageSchedulingStateEnum Alias for abstract MessageSchedulingState 'Sub-Classes', used as constant-enum here
@@ -19,31 +21,82 @@ const (
 	MessageSchedulingStateSendWhenOnlineType MessageSchedulingStateEnum = "messageSchedulingStateSendWhenOnline"
 )
 
+// UnknownMessageSchedulingState is a MessageSchedulingState of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownMessageSchedulingState struct {
+	Unknown
+}
+
+// GetMessageSchedulingStateEnum return the enum type of this object
+func (unknownMessageSchedulingState *UnknownMessageSchedulingState) GetMessageSchedulingStateEnum() MessageSchedulingStateEnum {
+	return MessageSchedulingStateEnum(unknownMessageSchedulingState.Type)
+}
+
+// Accept calls the method of the visitor for UnknownMessageSchedulingState
+func (unknownMessageSchedulingState *UnknownMessageSchedulingState) Accept(visitor MessageSchedulingStateVisitor) {
+	visitor.VisitUnknownMessageSchedulingState(unknownMessageSchedulingState)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownMessageSchedulingState *UnknownMessageSchedulingState) String() string {
+	return objectString(unknownMessageSchedulingState)
+}
+
+// LogValue logs the object as its summary
+func (unknownMessageSchedulingState *UnknownMessageSchedulingState) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownMessageSchedulingState))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownMessageSchedulingState *UnknownMessageSchedulingState) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownMessageSchedulingState)
+}
+
+func (unknownMessageSchedulingState *UnknownMessageSchedulingState) printText(p *printer) {
+	if unknownMessageSchedulingState == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownMessageSchedulingState")
+	p.string("@type", unknownMessageSchedulingState.Type)
+	p.endObject(state)
+}
+
 func unmarshalMessageSchedulingState(rawMsg *json.RawMessage) (MessageSchedulingState, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch MessageSchedulingStateEnum(objMap["@type"].(string)) {
+	switch MessageSchedulingStateEnum(typeName) {
 	case MessageSchedulingStateSendAtDateType:
 		var messageSchedulingStateSendAtDate MessageSchedulingStateSendAtDate
-		err := json.Unmarshal(*rawMsg, &messageSchedulingStateSendAtDate)
+		err := jsonUnmarshal(*rawMsg, &messageSchedulingStateSendAtDate)
 		return &messageSchedulingStateSendAtDate, err
 
 	case MessageSchedulingStateSendWhenOnlineType:
 		var messageSchedulingStateSendWhenOnline MessageSchedulingStateSendWhenOnline
-		err := json.Unmarshal(*rawMsg, &messageSchedulingStateSendWhenOnline)
+		err := jsonUnmarshal(*rawMsg, &messageSchedulingStateSendWhenOnline)
 		return &messageSchedulingStateSendWhenOnline, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownMessageSchedulingState{Unknown: unknown}, nil
+	}
+}
+
+func decodeMessageSchedulingState(r *jsonReader) (MessageSchedulingState, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
 	}
+	return unmarshalMessageSchedulingState(rawMsg)
 }
 
 // MessageSchedulingStateSendAtDate The message will be sent at the specified date
@@ -69,11 +122,118 @@ func NewMessageSchedulingStateSendAtDate(sendDate int32) *MessageSchedulingState
 	return &messageSchedulingStateSendAtDateTemp
 }
 
+// MarshalJSON marshals to json
+func (messageSchedulingStateSendAtDate *MessageSchedulingStateSendAtDate) MarshalJSON() ([]byte, error) {
+	return messageSchedulingStateSendAtDate.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageSchedulingStateSendAtDate *MessageSchedulingStateSendAtDate) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageSchedulingStateSendAtDate)
+}
+
+func (messageSchedulingStateSendAtDate *MessageSchedulingStateSendAtDate) appendJSON(b []byte) ([]byte, error) {
+	if messageSchedulingStateSendAtDate == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageSchedulingStateSendAtDate.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageSchedulingStateSendAtDate.tdCommon.Extra)
+	b = append(b, `,"send_date":`...)
+	b = appendJSONInt(b, int64(messageSchedulingStateSendAtDate.SendDate))
+	return append(b, '}'), nil
+}
+
+func (messageSchedulingStateSendAtDate *MessageSchedulingStateSendAtDate) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageSchedulingStateSendAtDate.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageSchedulingStateSendAtDate.tdCommon.Extra, err = r.readString()
+		case "send_date":
+			messageSchedulingStateSendAtDate.SendDate, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageSchedulingStateSendAtDate *MessageSchedulingStateSendAtDate) Clone() *MessageSchedulingStateSendAtDate {
+	if messageSchedulingStateSendAtDate == nil {
+		return nil
+	}
+	clone := *messageSchedulingStateSendAtDate
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageSchedulingStateSendAtDate *MessageSchedulingStateSendAtDate) Equal(other *MessageSchedulingStateSendAtDate) bool {
+	if messageSchedulingStateSendAtDate == nil || other == nil {
+		return messageSchedulingStateSendAtDate == other
+	}
+	if messageSchedulingStateSendAtDate.SendDate != other.SendDate {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageSchedulingStateSendAtDate *MessageSchedulingStateSendAtDate) Diff(other *MessageSchedulingStateSendAtDate) []Change {
+	return messageSchedulingStateSendAtDate.diff("", other, nil)
+}
+
+func (messageSchedulingStateSendAtDate *MessageSchedulingStateSendAtDate) diff(path string, other *MessageSchedulingStateSendAtDate, changes []Change) []Change {
+	if messageSchedulingStateSendAtDate == nil || other == nil {
+		if messageSchedulingStateSendAtDate != other {
+			changes = append(changes, Change{Path: path, Old: messageSchedulingStateSendAtDate, New: other})
+		}
+		return changes
+	}
+	if messageSchedulingStateSendAtDate.SendDate != other.SendDate {
+		changes = append(changes, Change{Path: fieldPath(path, "send_date"), Old: messageSchedulingStateSendAtDate.SendDate, New: other.SendDate})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageSchedulingStateSendAtDate *MessageSchedulingStateSendAtDate) String() string {
+	return objectString(messageSchedulingStateSendAtDate)
+}
+
+// LogValue logs the object as its summary
+func (messageSchedulingStateSendAtDate *MessageSchedulingStateSendAtDate) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageSchedulingStateSendAtDate))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageSchedulingStateSendAtDate *MessageSchedulingStateSendAtDate) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageSchedulingStateSendAtDate)
+}
+
+func (messageSchedulingStateSendAtDate *MessageSchedulingStateSendAtDate) printText(p *printer) {
+	if messageSchedulingStateSendAtDate == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageSchedulingStateSendAtDate")
+	p.int("send_date", int64(messageSchedulingStateSendAtDate.SendDate))
+	p.endObject(state)
+}
+
 // GetMessageSchedulingStateEnum return the enum type of this object
 func (messageSchedulingStateSendAtDate *MessageSchedulingStateSendAtDate) GetMessageSchedulingStateEnum() MessageSchedulingStateEnum {
 	return MessageSchedulingStateSendAtDateType
 }
 
+// Accept calls the method of the visitor for MessageSchedulingStateSendAtDate
+func (messageSchedulingStateSendAtDate *MessageSchedulingStateSendAtDate) Accept(visitor MessageSchedulingStateVisitor) {
+	visitor.VisitMessageSchedulingStateSendAtDate(messageSchedulingStateSendAtDate)
+}
+
 // MessageSchedulingStateSendWhenOnline The message will be sent when the peer will be online. Applicable to private chats only and when the exact online status of the peer is known
 type MessageSchedulingStateSendWhenOnline struct {
 	tdCommon
@@ -85,7 +245,6 @@ func (messageSchedulingStateSendWhenOnline *MessageSchedulingStateSendWhenOnline
 }
 
 // NewMessageSchedulingStateSendWhenOnline creates a new MessageSchedulingStateSendWhenOnline
-//
 func NewMessageSchedulingStateSendWhenOnline() *MessageSchedulingStateSendWhenOnline {
 	messageSchedulingStateSendWhenOnlineTemp := MessageSchedulingStateSendWhenOnline{
 		tdCommon: tdCommon{Type: "messageSchedulingStateSendWhenOnline"},
@@ -94,7 +253,198 @@ func NewMessageSchedulingStateSendWhenOnline() *MessageSchedulingStateSendWhenOn
 	return &messageSchedulingStateSendWhenOnlineTemp
 }
 
+// MarshalJSON marshals to json
+func (messageSchedulingStateSendWhenOnline *MessageSchedulingStateSendWhenOnline) MarshalJSON() ([]byte, error) {
+	return messageSchedulingStateSendWhenOnline.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageSchedulingStateSendWhenOnline *MessageSchedulingStateSendWhenOnline) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageSchedulingStateSendWhenOnline)
+}
+
+func (messageSchedulingStateSendWhenOnline *MessageSchedulingStateSendWhenOnline) appendJSON(b []byte) ([]byte, error) {
+	if messageSchedulingStateSendWhenOnline == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageSchedulingStateSendWhenOnline.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageSchedulingStateSendWhenOnline.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (messageSchedulingStateSendWhenOnline *MessageSchedulingStateSendWhenOnline) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageSchedulingStateSendWhenOnline.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageSchedulingStateSendWhenOnline.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageSchedulingStateSendWhenOnline *MessageSchedulingStateSendWhenOnline) Clone() *MessageSchedulingStateSendWhenOnline {
+	if messageSchedulingStateSendWhenOnline == nil {
+		return nil
+	}
+	clone := *messageSchedulingStateSendWhenOnline
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageSchedulingStateSendWhenOnline *MessageSchedulingStateSendWhenOnline) Equal(other *MessageSchedulingStateSendWhenOnline) bool {
+	if messageSchedulingStateSendWhenOnline == nil || other == nil {
+		return messageSchedulingStateSendWhenOnline == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageSchedulingStateSendWhenOnline *MessageSchedulingStateSendWhenOnline) Diff(other *MessageSchedulingStateSendWhenOnline) []Change {
+	return messageSchedulingStateSendWhenOnline.diff("", other, nil)
+}
+
+func (messageSchedulingStateSendWhenOnline *MessageSchedulingStateSendWhenOnline) diff(path string, other *MessageSchedulingStateSendWhenOnline, changes []Change) []Change {
+	if messageSchedulingStateSendWhenOnline == nil || other == nil {
+		if messageSchedulingStateSendWhenOnline != other {
+			changes = append(changes, Change{Path: path, Old: messageSchedulingStateSendWhenOnline, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageSchedulingStateSendWhenOnline *MessageSchedulingStateSendWhenOnline) String() string {
+	return objectString(messageSchedulingStateSendWhenOnline)
+}
+
+// LogValue logs the object as its summary
+func (messageSchedulingStateSendWhenOnline *MessageSchedulingStateSendWhenOnline) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageSchedulingStateSendWhenOnline))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageSchedulingStateSendWhenOnline *MessageSchedulingStateSendWhenOnline) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageSchedulingStateSendWhenOnline)
+}
+
+func (messageSchedulingStateSendWhenOnline *MessageSchedulingStateSendWhenOnline) printText(p *printer) {
+	if messageSchedulingStateSendWhenOnline == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageSchedulingStateSendWhenOnline")
+	p.endObject(state)
+}
+
 // GetMessageSchedulingStateEnum return the enum type of this object
 func (messageSchedulingStateSendWhenOnline *MessageSchedulingStateSendWhenOnline) GetMessageSchedulingStateEnum() MessageSchedulingStateEnum {
 	return MessageSchedulingStateSendWhenOnlineType
 }
+
+// Accept calls the method of the visitor for MessageSchedulingStateSendWhenOnline
+func (messageSchedulingStateSendWhenOnline *MessageSchedulingStateSendWhenOnline) Accept(visitor MessageSchedulingStateVisitor) {
+	visitor.VisitMessageSchedulingStateSendWhenOnline(messageSchedulingStateSendWhenOnline)
+}
+
+// MessageSchedulingStateVisitor visits every type of MessageSchedulingState, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to MessageSchedulingState, until they handle it too.
+type MessageSchedulingStateVisitor interface {
+	VisitMessageSchedulingStateSendAtDate(messageSchedulingStateSendAtDate *MessageSchedulingStateSendAtDate)
+	VisitMessageSchedulingStateSendWhenOnline(messageSchedulingStateSendWhenOnline *MessageSchedulingStateSendWhenOnline)
+	VisitUnknownMessageSchedulingState(unknownMessageSchedulingState *UnknownMessageSchedulingState)
+}
+
+// MessageSchedulingStateCases holds the function MatchMessageSchedulingState calls for each type of MessageSchedulingState;
+// types without a function are passed to the default case
+type MessageSchedulingStateCases[R any] struct {
+	MessageSchedulingStateSendAtDate     func(messageSchedulingStateSendAtDate *MessageSchedulingStateSendAtDate) R
+	MessageSchedulingStateSendWhenOnline func(messageSchedulingStateSendWhenOnline *MessageSchedulingStateSendWhenOnline) R
+}
+
+// MatchMessageSchedulingState calls the case of the type of messageSchedulingState, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement MessageSchedulingStateVisitor
+// to have the compiler check every type is handled.
+func MatchMessageSchedulingState[R any](messageSchedulingState MessageSchedulingState, cases MessageSchedulingStateCases[R], defaultCase func(messageSchedulingState MessageSchedulingState) R) R {
+	switch value := messageSchedulingState.(type) {
+	case *MessageSchedulingStateSendAtDate:
+		if cases.MessageSchedulingStateSendAtDate != nil {
+			return cases.MessageSchedulingStateSendAtDate(value)
+		}
+	case *MessageSchedulingStateSendWhenOnline:
+		if cases.MessageSchedulingStateSendWhenOnline != nil {
+			return cases.MessageSchedulingStateSendWhenOnline(value)
+		}
+	}
+	return defaultCase(messageSchedulingState)
+}
+
+// Clone returns a deep copy of the object
+func (unknownMessageSchedulingState *UnknownMessageSchedulingState) Clone() *UnknownMessageSchedulingState {
+	if unknownMessageSchedulingState == nil {
+		return nil
+	}
+	return &UnknownMessageSchedulingState{Unknown: unknownMessageSchedulingState.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownMessageSchedulingState *UnknownMessageSchedulingState) Equal(other *UnknownMessageSchedulingState) bool {
+	if unknownMessageSchedulingState == nil || other == nil {
+		return unknownMessageSchedulingState == other
+	}
+	return unknownMessageSchedulingState.Unknown.equal(other.Unknown)
+}
+
+func cloneMessageSchedulingState(messageSchedulingState MessageSchedulingState) MessageSchedulingState {
+	switch value := messageSchedulingState.(type) {
+	case *MessageSchedulingStateSendAtDate:
+		return value.Clone()
+	case *MessageSchedulingStateSendWhenOnline:
+		return value.Clone()
+	case *UnknownMessageSchedulingState:
+		return value.Clone()
+	}
+	return messageSchedulingState
+}
+
+func equalMessageSchedulingState(a MessageSchedulingState, b MessageSchedulingState) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *MessageSchedulingStateSendAtDate:
+		b, ok := b.(*MessageSchedulingStateSendAtDate)
+		return ok && a.Equal(b)
+	case *MessageSchedulingStateSendWhenOnline:
+		b, ok := b.(*MessageSchedulingStateSendWhenOnline)
+		return ok && a.Equal(b)
+	case *UnknownMessageSchedulingState:
+		b, ok := b.(*UnknownMessageSchedulingState)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffMessageSchedulingState(path string, a MessageSchedulingState, b MessageSchedulingState, changes []Change) []Change {
+	switch a := a.(type) {
+	case *MessageSchedulingStateSendAtDate:
+		if b, ok := b.(*MessageSchedulingStateSendAtDate); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageSchedulingStateSendWhenOnline:
+		if b, ok := b.(*MessageSchedulingStateSendWhenOnline); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalMessageSchedulingState(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
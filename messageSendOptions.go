@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // MessageSendOptions Options to be used when a message is sent
@@ -36,32 +37,140 @@ func NewMessageSendOptions(disableNotification bool, fromBackground bool, protec
 	return &messageSendOptionsTemp
 }
 
+// MarshalJSON marshals to json
+func (messageSendOptions *MessageSendOptions) MarshalJSON() ([]byte, error) {
+	return messageSendOptions.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (messageSendOptions *MessageSendOptions) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, messageSendOptions)
+}
+
+func (messageSendOptions *MessageSendOptions) appendJSON(b []byte) ([]byte, error) {
+	if messageSendOptions == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-		DisableNotification bool `json:"disable_notification"` // Pass true to disable notification for the message
-		FromBackground      bool `json:"from_background"`      // Pass true if the message is sent from the background
-		ProtectContent      bool `json:"protect_content"`      // Pass true if the content of the message must be protected from forwarding and saving; for bots only
-
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageSendOptions.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageSendOptions.tdCommon.Extra)
+	b = append(b, `,"disable_notification":`...)
+	b = appendJSONBool(b, messageSendOptions.DisableNotification)
+	b = append(b, `,"from_background":`...)
+	b = appendJSONBool(b, messageSendOptions.FromBackground)
+	b = append(b, `,"protect_content":`...)
+	b = appendJSONBool(b, messageSendOptions.ProtectContent)
+	b = append(b, `,"scheduling_state":`...)
+	b, err = appendJSONValue(b, messageSendOptions.SchedulingState)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return append(b, '}'), nil
+}
+
+func (messageSendOptions *MessageSendOptions) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageSendOptions.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageSendOptions.tdCommon.Extra, err = r.readString()
+		case "disable_notification":
+			messageSendOptions.DisableNotification, err = r.readBool()
+		case "from_background":
+			messageSendOptions.FromBackground, err = r.readBool()
+		case "protect_content":
+			messageSendOptions.ProtectContent, err = r.readBool()
+		case "scheduling_state":
+			messageSendOptions.SchedulingState, err = decodeMessageSchedulingState(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	messageSendOptions.tdCommon = tempObj.tdCommon
-	messageSendOptions.DisableNotification = tempObj.DisableNotification
-	messageSendOptions.FromBackground = tempObj.FromBackground
-	messageSendOptions.ProtectContent = tempObj.ProtectContent
+// Clone returns a deep copy of the object
+func (messageSendOptions *MessageSendOptions) Clone() *MessageSendOptions {
+	if messageSendOptions == nil {
+		return nil
+	}
+	clone := *messageSendOptions
+	clone.SchedulingState = cloneMessageSchedulingState(messageSendOptions.SchedulingState)
+	return &clone
+}
 
-	fieldSchedulingState, _ := unmarshalMessageSchedulingState(objMap["scheduling_state"])
-	messageSendOptions.SchedulingState = fieldSchedulingState
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageSendOptions *MessageSendOptions) Equal(other *MessageSendOptions) bool {
+	if messageSendOptions == nil || other == nil {
+		return messageSendOptions == other
+	}
+	if messageSendOptions.DisableNotification != other.DisableNotification {
+		return false
+	}
+	if messageSendOptions.FromBackground != other.FromBackground {
+		return false
+	}
+	if messageSendOptions.ProtectContent != other.ProtectContent {
+		return false
+	}
+	if !equalMessageSchedulingState(messageSendOptions.SchedulingState, other.SchedulingState) {
+		return false
+	}
+	return true
+}
 
-	return nil
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageSendOptions *MessageSendOptions) Diff(other *MessageSendOptions) []Change {
+	return messageSendOptions.diff("", other, nil)
+}
+
+func (messageSendOptions *MessageSendOptions) diff(path string, other *MessageSendOptions, changes []Change) []Change {
+	if messageSendOptions == nil || other == nil {
+		if messageSendOptions != other {
+			changes = append(changes, Change{Path: path, Old: messageSendOptions, New: other})
+		}
+		return changes
+	}
+	if messageSendOptions.DisableNotification != other.DisableNotification {
+		changes = append(changes, Change{Path: fieldPath(path, "disable_notification"), Old: messageSendOptions.DisableNotification, New: other.DisableNotification})
+	}
+	if messageSendOptions.FromBackground != other.FromBackground {
+		changes = append(changes, Change{Path: fieldPath(path, "from_background"), Old: messageSendOptions.FromBackground, New: other.FromBackground})
+	}
+	if messageSendOptions.ProtectContent != other.ProtectContent {
+		changes = append(changes, Change{Path: fieldPath(path, "protect_content"), Old: messageSendOptions.ProtectContent, New: other.ProtectContent})
+	}
+	changes = diffMessageSchedulingState(fieldPath(path, "scheduling_state"), messageSendOptions.SchedulingState, other.SchedulingState, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageSendOptions *MessageSendOptions) String() string {
+	return objectString(messageSendOptions)
+}
+
+// LogValue logs the object as its summary
+func (messageSendOptions *MessageSendOptions) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageSendOptions))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageSendOptions *MessageSendOptions) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageSendOptions)
+}
+
+func (messageSendOptions *MessageSendOptions) printText(p *printer) {
+	if messageSendOptions == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageSendOptions")
+	p.bool("disable_notification", messageSendOptions.DisableNotification)
+	p.bool("from_background", messageSendOptions.FromBackground)
+	p.bool("protect_content", messageSendOptions.ProtectContent)
+	p.object("scheduling_state", messageSendOptions.SchedulingState)
+	p.endObject(state)
 }
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // MessageSender Contains information about the sender of a message
 type MessageSender interface {
 	GetMessageSenderEnum() MessageSenderEnum
+	Accept(visitor MessageSenderVisitor)
 }
 
 // MessageSenderEnum Alias for abstract MessageSender 'Sub-Classes', used as constant-enum here
@@ -19,33 +21,107 @@ const (
 	MessageSenderChatType MessageSenderEnum = "messageSenderChat"
 )
 
+// UnknownMessageSender is a MessageSender of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownMessageSender struct {
+	Unknown
+}
+
+// GetMessageSenderEnum return the enum type of this object
+func (unknownMessageSender *UnknownMessageSender) GetMessageSenderEnum() MessageSenderEnum {
+	return MessageSenderEnum(unknownMessageSender.Type)
+}
+
+// Accept calls the method of the visitor for UnknownMessageSender
+func (unknownMessageSender *UnknownMessageSender) Accept(visitor MessageSenderVisitor) {
+	visitor.VisitUnknownMessageSender(unknownMessageSender)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownMessageSender *UnknownMessageSender) String() string {
+	return objectString(unknownMessageSender)
+}
+
+// LogValue logs the object as its summary
+func (unknownMessageSender *UnknownMessageSender) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownMessageSender))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownMessageSender *UnknownMessageSender) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownMessageSender)
+}
+
+func (unknownMessageSender *UnknownMessageSender) printText(p *printer) {
+	if unknownMessageSender == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownMessageSender")
+	p.string("@type", unknownMessageSender.Type)
+	p.endObject(state)
+}
+
 func unmarshalMessageSender(rawMsg *json.RawMessage) (MessageSender, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch MessageSenderEnum(objMap["@type"].(string)) {
+	switch MessageSenderEnum(typeName) {
 	case MessageSenderUserType:
 		var messageSenderUser MessageSenderUser
-		err := json.Unmarshal(*rawMsg, &messageSenderUser)
+		err := jsonUnmarshal(*rawMsg, &messageSenderUser)
 		return &messageSenderUser, err
 
 	case MessageSenderChatType:
 		var messageSenderChat MessageSenderChat
-		err := json.Unmarshal(*rawMsg, &messageSenderChat)
+		err := jsonUnmarshal(*rawMsg, &messageSenderChat)
 		return &messageSenderChat, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownMessageSender{Unknown: unknown}, nil
 	}
 }
 
+func unmarshalMessageSenderSlice(rawMsg *json.RawMessage) ([]MessageSender, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var rawItems []*json.RawMessage
+	err := jsonUnmarshal(*rawMsg, &rawItems)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]MessageSender, 0, len(rawItems))
+	for _, rawItem := range rawItems {
+		item, err := unmarshalMessageSender(rawItem)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func decodeMessageSender(r *jsonReader) (MessageSender, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalMessageSender(rawMsg)
+}
+
 // MessageSenderUser The message was sent by a known user
 type MessageSenderUser struct {
 	tdCommon
@@ -69,11 +145,118 @@ func NewMessageSenderUser(userId int64) *MessageSenderUser {
 	return &messageSenderUserTemp
 }
 
+// MarshalJSON marshals to json
+func (messageSenderUser *MessageSenderUser) MarshalJSON() ([]byte, error) {
+	return messageSenderUser.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageSenderUser *MessageSenderUser) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageSenderUser)
+}
+
+func (messageSenderUser *MessageSenderUser) appendJSON(b []byte) ([]byte, error) {
+	if messageSenderUser == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageSenderUser.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageSenderUser.tdCommon.Extra)
+	b = append(b, `,"user_id":`...)
+	b = appendJSONInt(b, int64(messageSenderUser.UserId))
+	return append(b, '}'), nil
+}
+
+func (messageSenderUser *MessageSenderUser) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageSenderUser.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageSenderUser.tdCommon.Extra, err = r.readString()
+		case "user_id":
+			messageSenderUser.UserId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageSenderUser *MessageSenderUser) Clone() *MessageSenderUser {
+	if messageSenderUser == nil {
+		return nil
+	}
+	clone := *messageSenderUser
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageSenderUser *MessageSenderUser) Equal(other *MessageSenderUser) bool {
+	if messageSenderUser == nil || other == nil {
+		return messageSenderUser == other
+	}
+	if messageSenderUser.UserId != other.UserId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageSenderUser *MessageSenderUser) Diff(other *MessageSenderUser) []Change {
+	return messageSenderUser.diff("", other, nil)
+}
+
+func (messageSenderUser *MessageSenderUser) diff(path string, other *MessageSenderUser, changes []Change) []Change {
+	if messageSenderUser == nil || other == nil {
+		if messageSenderUser != other {
+			changes = append(changes, Change{Path: path, Old: messageSenderUser, New: other})
+		}
+		return changes
+	}
+	if messageSenderUser.UserId != other.UserId {
+		changes = append(changes, Change{Path: fieldPath(path, "user_id"), Old: messageSenderUser.UserId, New: other.UserId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageSenderUser *MessageSenderUser) String() string {
+	return objectString(messageSenderUser)
+}
+
+// LogValue logs the object as its summary
+func (messageSenderUser *MessageSenderUser) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageSenderUser))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageSenderUser *MessageSenderUser) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageSenderUser)
+}
+
+func (messageSenderUser *MessageSenderUser) printText(p *printer) {
+	if messageSenderUser == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageSenderUser")
+	p.int("user_id", int64(messageSenderUser.UserId))
+	p.endObject(state)
+}
+
 // GetMessageSenderEnum return the enum type of this object
 func (messageSenderUser *MessageSenderUser) GetMessageSenderEnum() MessageSenderEnum {
 	return MessageSenderUserType
 }
 
+// Accept calls the method of the visitor for MessageSenderUser
+func (messageSenderUser *MessageSenderUser) Accept(visitor MessageSenderVisitor) {
+	visitor.VisitMessageSenderUser(messageSenderUser)
+}
+
 // MessageSenderChat The message was sent on behalf of a chat
 type MessageSenderChat struct {
 	tdCommon
@@ -97,7 +280,209 @@ func NewMessageSenderChat(chatId int64) *MessageSenderChat {
 	return &messageSenderChatTemp
 }
 
+// MarshalJSON marshals to json
+func (messageSenderChat *MessageSenderChat) MarshalJSON() ([]byte, error) {
+	return messageSenderChat.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageSenderChat *MessageSenderChat) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageSenderChat)
+}
+
+func (messageSenderChat *MessageSenderChat) appendJSON(b []byte) ([]byte, error) {
+	if messageSenderChat == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageSenderChat.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageSenderChat.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(messageSenderChat.ChatId))
+	return append(b, '}'), nil
+}
+
+func (messageSenderChat *MessageSenderChat) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageSenderChat.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageSenderChat.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			messageSenderChat.ChatId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageSenderChat *MessageSenderChat) Clone() *MessageSenderChat {
+	if messageSenderChat == nil {
+		return nil
+	}
+	clone := *messageSenderChat
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageSenderChat *MessageSenderChat) Equal(other *MessageSenderChat) bool {
+	if messageSenderChat == nil || other == nil {
+		return messageSenderChat == other
+	}
+	if messageSenderChat.ChatId != other.ChatId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageSenderChat *MessageSenderChat) Diff(other *MessageSenderChat) []Change {
+	return messageSenderChat.diff("", other, nil)
+}
+
+func (messageSenderChat *MessageSenderChat) diff(path string, other *MessageSenderChat, changes []Change) []Change {
+	if messageSenderChat == nil || other == nil {
+		if messageSenderChat != other {
+			changes = append(changes, Change{Path: path, Old: messageSenderChat, New: other})
+		}
+		return changes
+	}
+	if messageSenderChat.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: messageSenderChat.ChatId, New: other.ChatId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageSenderChat *MessageSenderChat) String() string {
+	return objectString(messageSenderChat)
+}
+
+// LogValue logs the object as its summary
+func (messageSenderChat *MessageSenderChat) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageSenderChat))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageSenderChat *MessageSenderChat) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageSenderChat)
+}
+
+func (messageSenderChat *MessageSenderChat) printText(p *printer) {
+	if messageSenderChat == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageSenderChat")
+	p.int("chat_id", int64(messageSenderChat.ChatId))
+	p.endObject(state)
+}
+
 // GetMessageSenderEnum return the enum type of this object
 func (messageSenderChat *MessageSenderChat) GetMessageSenderEnum() MessageSenderEnum {
 	return MessageSenderChatType
 }
+
+// Accept calls the method of the visitor for MessageSenderChat
+func (messageSenderChat *MessageSenderChat) Accept(visitor MessageSenderVisitor) {
+	visitor.VisitMessageSenderChat(messageSenderChat)
+}
+
+// MessageSenderVisitor visits every type of MessageSender, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to MessageSender, until they handle it too.
+type MessageSenderVisitor interface {
+	VisitMessageSenderUser(messageSenderUser *MessageSenderUser)
+	VisitMessageSenderChat(messageSenderChat *MessageSenderChat)
+	VisitUnknownMessageSender(unknownMessageSender *UnknownMessageSender)
+}
+
+// MessageSenderCases holds the function MatchMessageSender calls for each type of MessageSender;
+// types without a function are passed to the default case
+type MessageSenderCases[R any] struct {
+	MessageSenderUser func(messageSenderUser *MessageSenderUser) R
+	MessageSenderChat func(messageSenderChat *MessageSenderChat) R
+}
+
+// MatchMessageSender calls the case of the type of messageSender, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement MessageSenderVisitor
+// to have the compiler check every type is handled.
+func MatchMessageSender[R any](messageSender MessageSender, cases MessageSenderCases[R], defaultCase func(messageSender MessageSender) R) R {
+	switch value := messageSender.(type) {
+	case *MessageSenderUser:
+		if cases.MessageSenderUser != nil {
+			return cases.MessageSenderUser(value)
+		}
+	case *MessageSenderChat:
+		if cases.MessageSenderChat != nil {
+			return cases.MessageSenderChat(value)
+		}
+	}
+	return defaultCase(messageSender)
+}
+
+// Clone returns a deep copy of the object
+func (unknownMessageSender *UnknownMessageSender) Clone() *UnknownMessageSender {
+	if unknownMessageSender == nil {
+		return nil
+	}
+	return &UnknownMessageSender{Unknown: unknownMessageSender.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownMessageSender *UnknownMessageSender) Equal(other *UnknownMessageSender) bool {
+	if unknownMessageSender == nil || other == nil {
+		return unknownMessageSender == other
+	}
+	return unknownMessageSender.Unknown.equal(other.Unknown)
+}
+
+func cloneMessageSender(messageSender MessageSender) MessageSender {
+	switch value := messageSender.(type) {
+	case *MessageSenderUser:
+		return value.Clone()
+	case *MessageSenderChat:
+		return value.Clone()
+	case *UnknownMessageSender:
+		return value.Clone()
+	}
+	return messageSender
+}
+
+func equalMessageSender(a MessageSender, b MessageSender) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *MessageSenderUser:
+		b, ok := b.(*MessageSenderUser)
+		return ok && a.Equal(b)
+	case *MessageSenderChat:
+		b, ok := b.(*MessageSenderChat)
+		return ok && a.Equal(b)
+	case *UnknownMessageSender:
+		b, ok := b.(*UnknownMessageSender)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffMessageSender(path string, a MessageSender, b MessageSender, changes []Change) []Change {
+	switch a := a.(type) {
+	case *MessageSenderUser:
+		if b, ok := b.(*MessageSenderUser); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageSenderChat:
+		if b, ok := b.(*MessageSenderChat); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalMessageSender(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // MessageSenders Represents a list of message senders
@@ -31,6 +31,161 @@ func NewMessageSenders(totalCount int32, senders []MessageSender) *MessageSender
 	return &messageSendersTemp
 }
 
+// MarshalJSON marshals to json
+func (messageSenders *MessageSenders) MarshalJSON() ([]byte, error) {
+	return messageSenders.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageSenders *MessageSenders) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageSenders)
+}
+
+func (messageSenders *MessageSenders) appendJSON(b []byte) ([]byte, error) {
+	if messageSenders == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageSenders.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageSenders.tdCommon.Extra)
+	b = append(b, `,"total_count":`...)
+	b = appendJSONInt(b, int64(messageSenders.TotalCount))
+	b = append(b, `,"senders":`...)
+	if messageSenders.Senders == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range messageSenders.Senders {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = appendJSONValue(b, messageSenders.Senders[i0])
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (messageSenders *MessageSenders) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageSenders.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageSenders.tdCommon.Extra, err = r.readString()
+		case "total_count":
+			messageSenders.TotalCount, err = r.readInt32()
+		case "senders":
+			if r.readNull() {
+				messageSenders.Senders = nil
+			} else {
+				messageSenders.Senders = make([]MessageSender, 0)
+				err = r.readArray(func() (err error) {
+					var item0 MessageSender
+					item0, err = decodeMessageSender(r)
+					messageSenders.Senders = append(messageSenders.Senders, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageSenders *MessageSenders) Clone() *MessageSenders {
+	if messageSenders == nil {
+		return nil
+	}
+	clone := *messageSenders
+	if messageSenders.Senders != nil {
+		clone.Senders = make([]MessageSender, len(messageSenders.Senders))
+		for i0 := range messageSenders.Senders {
+			clone.Senders[i0] = cloneMessageSender(messageSenders.Senders[i0])
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageSenders *MessageSenders) Equal(other *MessageSenders) bool {
+	if messageSenders == nil || other == nil {
+		return messageSenders == other
+	}
+	if messageSenders.TotalCount != other.TotalCount {
+		return false
+	}
+	if len(messageSenders.Senders) != len(other.Senders) {
+		return false
+	}
+	for i0 := range messageSenders.Senders {
+		if !equalMessageSender(messageSenders.Senders[i0], other.Senders[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageSenders *MessageSenders) Diff(other *MessageSenders) []Change {
+	return messageSenders.diff("", other, nil)
+}
+
+func (messageSenders *MessageSenders) diff(path string, other *MessageSenders, changes []Change) []Change {
+	if messageSenders == nil || other == nil {
+		if messageSenders != other {
+			changes = append(changes, Change{Path: path, Old: messageSenders, New: other})
+		}
+		return changes
+	}
+	if messageSenders.TotalCount != other.TotalCount {
+		changes = append(changes, Change{Path: fieldPath(path, "total_count"), Old: messageSenders.TotalCount, New: other.TotalCount})
+	}
+	if len(messageSenders.Senders) != len(other.Senders) {
+		changes = append(changes, Change{Path: fieldPath(path, "senders"), Old: messageSenders.Senders, New: other.Senders})
+	} else {
+		for i0 := range messageSenders.Senders {
+			changes = diffMessageSender(indexPath(fieldPath(path, "senders"), i0), messageSenders.Senders[i0], other.Senders[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageSenders *MessageSenders) String() string {
+	return objectString(messageSenders)
+}
+
+// LogValue logs the object as its summary
+func (messageSenders *MessageSenders) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageSenders))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageSenders *MessageSenders) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageSenders)
+}
+
+func (messageSenders *MessageSenders) printText(p *printer) {
+	if messageSenders == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageSenders")
+	p.int("total_count", int64(messageSenders.TotalCount))
+	p.vector("senders", len(messageSenders.Senders), func(i0 int) {
+		p.object("senders", messageSenders.Senders[i0])
+	})
+	p.endObject(state)
+}
+
 // GetChatAvailableMessageSenders Returns list of message sender identifiers, which can be used to send messages in a chat
 // @param chatId Chat identifier
 func (client *Client) GetChatAvailableMessageSenders(chatId int64) (*MessageSenders, error) {
@@ -43,12 +198,12 @@ func (client *Client) GetChatAvailableMessageSenders(chatId int64) (*MessageSend
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messageSenders MessageSenders
-	err = json.Unmarshal(result.Raw, &messageSenders)
+	err = jsonUnmarshal(result.Raw, &messageSenders)
 	return &messageSenders, err
 }
 
@@ -64,12 +219,12 @@ func (client *Client) GetVideoChatAvailableParticipants(chatId int64) (*MessageS
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messageSenders MessageSenders
-	err = json.Unmarshal(result.Raw, &messageSenders)
+	err = jsonUnmarshal(result.Raw, &messageSenders)
 	return &messageSenders, err
 }
 
@@ -87,11 +242,11 @@ func (client *Client) GetBlockedMessageSenders(offset int32, limit int32) (*Mess
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messageSenders MessageSenders
-	err = json.Unmarshal(result.Raw, &messageSenders)
+	err = jsonUnmarshal(result.Raw, &messageSenders)
 	return &messageSenders, err
 }
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // MessageSendingState Contains information about the sending state of the message
 type MessageSendingState interface {
 	GetMessageSendingStateEnum() MessageSendingStateEnum
+	Accept(visitor MessageSendingStateVisitor)
 }
 
 // MessageSendingStateEnum Alias for abstract MessageSendingState 'Sub-Classes', used as constant-enum here
@@ -19,31 +21,82 @@ const (
 	MessageSendingStateFailedType  MessageSendingStateEnum = "messageSendingStateFailed"
 )
 
+// UnknownMessageSendingState is a MessageSendingState of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownMessageSendingState struct {
+	Unknown
+}
+
+// GetMessageSendingStateEnum return the enum type of this object
+func (unknownMessageSendingState *UnknownMessageSendingState) GetMessageSendingStateEnum() MessageSendingStateEnum {
+	return MessageSendingStateEnum(unknownMessageSendingState.Type)
+}
+
+// Accept calls the method of the visitor for UnknownMessageSendingState
+func (unknownMessageSendingState *UnknownMessageSendingState) Accept(visitor MessageSendingStateVisitor) {
+	visitor.VisitUnknownMessageSendingState(unknownMessageSendingState)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownMessageSendingState *UnknownMessageSendingState) String() string {
+	return objectString(unknownMessageSendingState)
+}
+
+// LogValue logs the object as its summary
+func (unknownMessageSendingState *UnknownMessageSendingState) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownMessageSendingState))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownMessageSendingState *UnknownMessageSendingState) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownMessageSendingState)
+}
+
+func (unknownMessageSendingState *UnknownMessageSendingState) printText(p *printer) {
+	if unknownMessageSendingState == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownMessageSendingState")
+	p.string("@type", unknownMessageSendingState.Type)
+	p.endObject(state)
+}
+
 func unmarshalMessageSendingState(rawMsg *json.RawMessage) (MessageSendingState, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch MessageSendingStateEnum(objMap["@type"].(string)) {
+	switch MessageSendingStateEnum(typeName) {
 	case MessageSendingStatePendingType:
 		var messageSendingStatePending MessageSendingStatePending
-		err := json.Unmarshal(*rawMsg, &messageSendingStatePending)
+		err := jsonUnmarshal(*rawMsg, &messageSendingStatePending)
 		return &messageSendingStatePending, err
 
 	case MessageSendingStateFailedType:
 		var messageSendingStateFailed MessageSendingStateFailed
-		err := json.Unmarshal(*rawMsg, &messageSendingStateFailed)
+		err := jsonUnmarshal(*rawMsg, &messageSendingStateFailed)
 		return &messageSendingStateFailed, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownMessageSendingState{Unknown: unknown}, nil
+	}
+}
+
+func decodeMessageSendingState(r *jsonReader) (MessageSendingState, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
 	}
+	return unmarshalMessageSendingState(rawMsg)
 }
 
 // MessageSendingStatePending The message is being sent now, but has not yet been delivered to the server
@@ -57,7 +110,6 @@ func (messageSendingStatePending *MessageSendingStatePending) MessageType() stri
 }
 
 // NewMessageSendingStatePending creates a new MessageSendingStatePending
-//
 func NewMessageSendingStatePending() *MessageSendingStatePending {
 	messageSendingStatePendingTemp := MessageSendingStatePending{
 		tdCommon: tdCommon{Type: "messageSendingStatePending"},
@@ -66,11 +118,107 @@ func NewMessageSendingStatePending() *MessageSendingStatePending {
 	return &messageSendingStatePendingTemp
 }
 
+// MarshalJSON marshals to json
+func (messageSendingStatePending *MessageSendingStatePending) MarshalJSON() ([]byte, error) {
+	return messageSendingStatePending.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageSendingStatePending *MessageSendingStatePending) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageSendingStatePending)
+}
+
+func (messageSendingStatePending *MessageSendingStatePending) appendJSON(b []byte) ([]byte, error) {
+	if messageSendingStatePending == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageSendingStatePending.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageSendingStatePending.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (messageSendingStatePending *MessageSendingStatePending) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageSendingStatePending.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageSendingStatePending.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageSendingStatePending *MessageSendingStatePending) Clone() *MessageSendingStatePending {
+	if messageSendingStatePending == nil {
+		return nil
+	}
+	clone := *messageSendingStatePending
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageSendingStatePending *MessageSendingStatePending) Equal(other *MessageSendingStatePending) bool {
+	if messageSendingStatePending == nil || other == nil {
+		return messageSendingStatePending == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageSendingStatePending *MessageSendingStatePending) Diff(other *MessageSendingStatePending) []Change {
+	return messageSendingStatePending.diff("", other, nil)
+}
+
+func (messageSendingStatePending *MessageSendingStatePending) diff(path string, other *MessageSendingStatePending, changes []Change) []Change {
+	if messageSendingStatePending == nil || other == nil {
+		if messageSendingStatePending != other {
+			changes = append(changes, Change{Path: path, Old: messageSendingStatePending, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageSendingStatePending *MessageSendingStatePending) String() string {
+	return objectString(messageSendingStatePending)
+}
+
+// LogValue logs the object as its summary
+func (messageSendingStatePending *MessageSendingStatePending) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageSendingStatePending))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageSendingStatePending *MessageSendingStatePending) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageSendingStatePending)
+}
+
+func (messageSendingStatePending *MessageSendingStatePending) printText(p *printer) {
+	if messageSendingStatePending == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageSendingStatePending")
+	p.endObject(state)
+}
+
 // GetMessageSendingStateEnum return the enum type of this object
 func (messageSendingStatePending *MessageSendingStatePending) GetMessageSendingStateEnum() MessageSendingStateEnum {
 	return MessageSendingStatePendingType
 }
 
+// Accept calls the method of the visitor for MessageSendingStatePending
+func (messageSendingStatePending *MessageSendingStatePending) Accept(visitor MessageSendingStateVisitor) {
+	visitor.VisitMessageSendingStatePending(messageSendingStatePending)
+}
+
 // MessageSendingStateFailed The message failed to be sent
 type MessageSendingStateFailed struct {
 	tdCommon
@@ -106,7 +254,257 @@ func NewMessageSendingStateFailed(errorCode int32, errorMessage string, canRetry
 	return &messageSendingStateFailedTemp
 }
 
+// MarshalJSON marshals to json
+func (messageSendingStateFailed *MessageSendingStateFailed) MarshalJSON() ([]byte, error) {
+	return messageSendingStateFailed.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageSendingStateFailed *MessageSendingStateFailed) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageSendingStateFailed)
+}
+
+func (messageSendingStateFailed *MessageSendingStateFailed) appendJSON(b []byte) ([]byte, error) {
+	if messageSendingStateFailed == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageSendingStateFailed.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageSendingStateFailed.tdCommon.Extra)
+	b = append(b, `,"error_code":`...)
+	b = appendJSONInt(b, int64(messageSendingStateFailed.ErrorCode))
+	b = append(b, `,"error_message":`...)
+	b = appendJSONString(b, messageSendingStateFailed.ErrorMessage)
+	b = append(b, `,"can_retry":`...)
+	b = appendJSONBool(b, messageSendingStateFailed.CanRetry)
+	b = append(b, `,"need_another_sender":`...)
+	b = appendJSONBool(b, messageSendingStateFailed.NeedAnotherSender)
+	b = append(b, `,"retry_after":`...)
+	b, err = appendJSONFloat(b, messageSendingStateFailed.RetryAfter)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (messageSendingStateFailed *MessageSendingStateFailed) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageSendingStateFailed.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageSendingStateFailed.tdCommon.Extra, err = r.readString()
+		case "error_code":
+			messageSendingStateFailed.ErrorCode, err = r.readInt32()
+		case "error_message":
+			messageSendingStateFailed.ErrorMessage, err = r.readString()
+		case "can_retry":
+			messageSendingStateFailed.CanRetry, err = r.readBool()
+		case "need_another_sender":
+			messageSendingStateFailed.NeedAnotherSender, err = r.readBool()
+		case "retry_after":
+			messageSendingStateFailed.RetryAfter, err = r.readFloat64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageSendingStateFailed *MessageSendingStateFailed) Clone() *MessageSendingStateFailed {
+	if messageSendingStateFailed == nil {
+		return nil
+	}
+	clone := *messageSendingStateFailed
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageSendingStateFailed *MessageSendingStateFailed) Equal(other *MessageSendingStateFailed) bool {
+	if messageSendingStateFailed == nil || other == nil {
+		return messageSendingStateFailed == other
+	}
+	if messageSendingStateFailed.ErrorCode != other.ErrorCode {
+		return false
+	}
+	if messageSendingStateFailed.ErrorMessage != other.ErrorMessage {
+		return false
+	}
+	if messageSendingStateFailed.CanRetry != other.CanRetry {
+		return false
+	}
+	if messageSendingStateFailed.NeedAnotherSender != other.NeedAnotherSender {
+		return false
+	}
+	if messageSendingStateFailed.RetryAfter != other.RetryAfter {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageSendingStateFailed *MessageSendingStateFailed) Diff(other *MessageSendingStateFailed) []Change {
+	return messageSendingStateFailed.diff("", other, nil)
+}
+
+func (messageSendingStateFailed *MessageSendingStateFailed) diff(path string, other *MessageSendingStateFailed, changes []Change) []Change {
+	if messageSendingStateFailed == nil || other == nil {
+		if messageSendingStateFailed != other {
+			changes = append(changes, Change{Path: path, Old: messageSendingStateFailed, New: other})
+		}
+		return changes
+	}
+	if messageSendingStateFailed.ErrorCode != other.ErrorCode {
+		changes = append(changes, Change{Path: fieldPath(path, "error_code"), Old: messageSendingStateFailed.ErrorCode, New: other.ErrorCode})
+	}
+	if messageSendingStateFailed.ErrorMessage != other.ErrorMessage {
+		changes = append(changes, Change{Path: fieldPath(path, "error_message"), Old: messageSendingStateFailed.ErrorMessage, New: other.ErrorMessage})
+	}
+	if messageSendingStateFailed.CanRetry != other.CanRetry {
+		changes = append(changes, Change{Path: fieldPath(path, "can_retry"), Old: messageSendingStateFailed.CanRetry, New: other.CanRetry})
+	}
+	if messageSendingStateFailed.NeedAnotherSender != other.NeedAnotherSender {
+		changes = append(changes, Change{Path: fieldPath(path, "need_another_sender"), Old: messageSendingStateFailed.NeedAnotherSender, New: other.NeedAnotherSender})
+	}
+	if messageSendingStateFailed.RetryAfter != other.RetryAfter {
+		changes = append(changes, Change{Path: fieldPath(path, "retry_after"), Old: messageSendingStateFailed.RetryAfter, New: other.RetryAfter})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageSendingStateFailed *MessageSendingStateFailed) String() string {
+	return objectString(messageSendingStateFailed)
+}
+
+// LogValue logs the object as its summary
+func (messageSendingStateFailed *MessageSendingStateFailed) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageSendingStateFailed))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageSendingStateFailed *MessageSendingStateFailed) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageSendingStateFailed)
+}
+
+func (messageSendingStateFailed *MessageSendingStateFailed) printText(p *printer) {
+	if messageSendingStateFailed == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageSendingStateFailed")
+	p.int("error_code", int64(messageSendingStateFailed.ErrorCode))
+	p.string("error_message", messageSendingStateFailed.ErrorMessage)
+	p.bool("can_retry", messageSendingStateFailed.CanRetry)
+	p.bool("need_another_sender", messageSendingStateFailed.NeedAnotherSender)
+	p.float("retry_after", messageSendingStateFailed.RetryAfter)
+	p.endObject(state)
+}
+
 // GetMessageSendingStateEnum return the enum type of this object
 func (messageSendingStateFailed *MessageSendingStateFailed) GetMessageSendingStateEnum() MessageSendingStateEnum {
 	return MessageSendingStateFailedType
 }
+
+// Accept calls the method of the visitor for MessageSendingStateFailed
+func (messageSendingStateFailed *MessageSendingStateFailed) Accept(visitor MessageSendingStateVisitor) {
+	visitor.VisitMessageSendingStateFailed(messageSendingStateFailed)
+}
+
+// MessageSendingStateVisitor visits every type of MessageSendingState, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to MessageSendingState, until they handle it too.
+type MessageSendingStateVisitor interface {
+	VisitMessageSendingStatePending(messageSendingStatePending *MessageSendingStatePending)
+	VisitMessageSendingStateFailed(messageSendingStateFailed *MessageSendingStateFailed)
+	VisitUnknownMessageSendingState(unknownMessageSendingState *UnknownMessageSendingState)
+}
+
+// MessageSendingStateCases holds the function MatchMessageSendingState calls for each type of MessageSendingState;
+// types without a function are passed to the default case
+type MessageSendingStateCases[R any] struct {
+	MessageSendingStatePending func(messageSendingStatePending *MessageSendingStatePending) R
+	MessageSendingStateFailed  func(messageSendingStateFailed *MessageSendingStateFailed) R
+}
+
+// MatchMessageSendingState calls the case of the type of messageSendingState, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement MessageSendingStateVisitor
+// to have the compiler check every type is handled.
+func MatchMessageSendingState[R any](messageSendingState MessageSendingState, cases MessageSendingStateCases[R], defaultCase func(messageSendingState MessageSendingState) R) R {
+	switch value := messageSendingState.(type) {
+	case *MessageSendingStatePending:
+		if cases.MessageSendingStatePending != nil {
+			return cases.MessageSendingStatePending(value)
+		}
+	case *MessageSendingStateFailed:
+		if cases.MessageSendingStateFailed != nil {
+			return cases.MessageSendingStateFailed(value)
+		}
+	}
+	return defaultCase(messageSendingState)
+}
+
+// Clone returns a deep copy of the object
+func (unknownMessageSendingState *UnknownMessageSendingState) Clone() *UnknownMessageSendingState {
+	if unknownMessageSendingState == nil {
+		return nil
+	}
+	return &UnknownMessageSendingState{Unknown: unknownMessageSendingState.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownMessageSendingState *UnknownMessageSendingState) Equal(other *UnknownMessageSendingState) bool {
+	if unknownMessageSendingState == nil || other == nil {
+		return unknownMessageSendingState == other
+	}
+	return unknownMessageSendingState.Unknown.equal(other.Unknown)
+}
+
+func cloneMessageSendingState(messageSendingState MessageSendingState) MessageSendingState {
+	switch value := messageSendingState.(type) {
+	case *MessageSendingStatePending:
+		return value.Clone()
+	case *MessageSendingStateFailed:
+		return value.Clone()
+	case *UnknownMessageSendingState:
+		return value.Clone()
+	}
+	return messageSendingState
+}
+
+func equalMessageSendingState(a MessageSendingState, b MessageSendingState) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *MessageSendingStatePending:
+		b, ok := b.(*MessageSendingStatePending)
+		return ok && a.Equal(b)
+	case *MessageSendingStateFailed:
+		b, ok := b.(*MessageSendingStateFailed)
+		return ok && a.Equal(b)
+	case *UnknownMessageSendingState:
+		b, ok := b.(*UnknownMessageSendingState)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffMessageSendingState(path string, a MessageSendingState, b MessageSendingState, changes []Change) []Change {
+	switch a := a.(type) {
+	case *MessageSendingStatePending:
+		if b, ok := b.(*MessageSendingStatePending); ok {
+			return a.diff(path, b, changes)
+		}
+	case *MessageSendingStateFailed:
+		if b, ok := b.(*MessageSendingStateFailed); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalMessageSendingState(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // MessageStatistics A detailed statistics about a message
@@ -28,27 +28,109 @@ func NewMessageStatistics(messageInteractionGraph StatisticalGraph) *MessageStat
 	return &messageStatisticsTemp
 }
 
+// MarshalJSON marshals to json
+func (messageStatistics *MessageStatistics) MarshalJSON() ([]byte, error) {
+	return messageStatistics.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (messageStatistics *MessageStatistics) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, messageStatistics)
+}
+
+func (messageStatistics *MessageStatistics) appendJSON(b []byte) ([]byte, error) {
+	if messageStatistics == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageStatistics.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageStatistics.tdCommon.Extra)
+	b = append(b, `,"message_interaction_graph":`...)
+	b, err = appendJSONValue(b, messageStatistics.MessageInteractionGraph)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return append(b, '}'), nil
+}
 
-	messageStatistics.tdCommon = tempObj.tdCommon
+func (messageStatistics *MessageStatistics) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageStatistics.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageStatistics.tdCommon.Extra, err = r.readString()
+		case "message_interaction_graph":
+			messageStatistics.MessageInteractionGraph, err = decodeStatisticalGraph(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	fieldMessageInteractionGraph, _ := unmarshalStatisticalGraph(objMap["message_interaction_graph"])
-	messageStatistics.MessageInteractionGraph = fieldMessageInteractionGraph
+// Clone returns a deep copy of the object
+func (messageStatistics *MessageStatistics) Clone() *MessageStatistics {
+	if messageStatistics == nil {
+		return nil
+	}
+	clone := *messageStatistics
+	clone.MessageInteractionGraph = cloneStatisticalGraph(messageStatistics.MessageInteractionGraph)
+	return &clone
+}
 
-	return nil
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageStatistics *MessageStatistics) Equal(other *MessageStatistics) bool {
+	if messageStatistics == nil || other == nil {
+		return messageStatistics == other
+	}
+	if !equalStatisticalGraph(messageStatistics.MessageInteractionGraph, other.MessageInteractionGraph) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageStatistics *MessageStatistics) Diff(other *MessageStatistics) []Change {
+	return messageStatistics.diff("", other, nil)
+}
+
+func (messageStatistics *MessageStatistics) diff(path string, other *MessageStatistics, changes []Change) []Change {
+	if messageStatistics == nil || other == nil {
+		if messageStatistics != other {
+			changes = append(changes, Change{Path: path, Old: messageStatistics, New: other})
+		}
+		return changes
+	}
+	changes = diffStatisticalGraph(fieldPath(path, "message_interaction_graph"), messageStatistics.MessageInteractionGraph, other.MessageInteractionGraph, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageStatistics *MessageStatistics) String() string {
+	return objectString(messageStatistics)
+}
+
+// LogValue logs the object as its summary
+func (messageStatistics *MessageStatistics) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageStatistics))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageStatistics *MessageStatistics) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageStatistics)
+}
+
+func (messageStatistics *MessageStatistics) printText(p *printer) {
+	if messageStatistics == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageStatistics")
+	p.object("message_interaction_graph", messageStatistics.MessageInteractionGraph)
+	p.endObject(state)
 }
 
 // GetMessageStatistics Returns detailed statistics about a message. Can be used only if message.can_get_statistics == true
@@ -67,11 +149,11 @@ func (client *Client) GetMessageStatistics(chatId int64, messageId int64, isDark
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messageStatistics MessageStatistics
-	err = json.Unmarshal(result.Raw, &messageStatistics)
+	err = jsonUnmarshal(result.Raw, &messageStatistics)
 	return &messageStatistics, err
 }
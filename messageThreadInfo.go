@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // MessageThreadInfo Contains information about a message thread
@@ -43,6 +43,219 @@ func NewMessageThreadInfo(chatId int64, messageThreadId int64, replyInfo *Messag
 	return &messageThreadInfoTemp
 }
 
+// MarshalJSON marshals to json
+func (messageThreadInfo *MessageThreadInfo) MarshalJSON() ([]byte, error) {
+	return messageThreadInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messageThreadInfo *MessageThreadInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messageThreadInfo)
+}
+
+func (messageThreadInfo *MessageThreadInfo) appendJSON(b []byte) ([]byte, error) {
+	if messageThreadInfo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messageThreadInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messageThreadInfo.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(messageThreadInfo.ChatId))
+	b = append(b, `,"message_thread_id":`...)
+	b = appendJSONInt(b, int64(messageThreadInfo.MessageThreadId))
+	b = append(b, `,"reply_info":`...)
+	b, err = messageThreadInfo.ReplyInfo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"unread_message_count":`...)
+	b = appendJSONInt(b, int64(messageThreadInfo.UnreadMessageCount))
+	b = append(b, `,"messages":`...)
+	if messageThreadInfo.Messages == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range messageThreadInfo.Messages {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = messageThreadInfo.Messages[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"draft_message":`...)
+	b, err = messageThreadInfo.DraftMessage.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (messageThreadInfo *MessageThreadInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messageThreadInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messageThreadInfo.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			messageThreadInfo.ChatId, err = r.readInt64()
+		case "message_thread_id":
+			messageThreadInfo.MessageThreadId, err = r.readInt64()
+		case "reply_info":
+			if r.readNull() {
+				messageThreadInfo.ReplyInfo = nil
+			} else {
+				messageThreadInfo.ReplyInfo = new(MessageReplyInfo)
+				err = messageThreadInfo.ReplyInfo.decodeJSON(r)
+			}
+		case "unread_message_count":
+			messageThreadInfo.UnreadMessageCount, err = r.readInt32()
+		case "messages":
+			if r.readNull() {
+				messageThreadInfo.Messages = nil
+			} else {
+				messageThreadInfo.Messages = make([]Message, 0)
+				err = r.readArray(func() (err error) {
+					var item0 Message
+					err = item0.decodeJSON(r)
+					messageThreadInfo.Messages = append(messageThreadInfo.Messages, item0)
+					return
+				})
+			}
+		case "draft_message":
+			if r.readNull() {
+				messageThreadInfo.DraftMessage = nil
+			} else {
+				messageThreadInfo.DraftMessage = new(DraftMessage)
+				err = messageThreadInfo.DraftMessage.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messageThreadInfo *MessageThreadInfo) Clone() *MessageThreadInfo {
+	if messageThreadInfo == nil {
+		return nil
+	}
+	clone := *messageThreadInfo
+	clone.ReplyInfo = messageThreadInfo.ReplyInfo.Clone()
+	if messageThreadInfo.Messages != nil {
+		clone.Messages = make([]Message, len(messageThreadInfo.Messages))
+		for i0 := range messageThreadInfo.Messages {
+			clone.Messages[i0] = *messageThreadInfo.Messages[i0].Clone()
+		}
+	}
+	clone.DraftMessage = messageThreadInfo.DraftMessage.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messageThreadInfo *MessageThreadInfo) Equal(other *MessageThreadInfo) bool {
+	if messageThreadInfo == nil || other == nil {
+		return messageThreadInfo == other
+	}
+	if messageThreadInfo.ChatId != other.ChatId {
+		return false
+	}
+	if messageThreadInfo.MessageThreadId != other.MessageThreadId {
+		return false
+	}
+	if !messageThreadInfo.ReplyInfo.Equal(other.ReplyInfo) {
+		return false
+	}
+	if messageThreadInfo.UnreadMessageCount != other.UnreadMessageCount {
+		return false
+	}
+	if len(messageThreadInfo.Messages) != len(other.Messages) {
+		return false
+	}
+	for i0 := range messageThreadInfo.Messages {
+		if !messageThreadInfo.Messages[i0].Equal(&other.Messages[i0]) {
+			return false
+		}
+	}
+	if !messageThreadInfo.DraftMessage.Equal(other.DraftMessage) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messageThreadInfo *MessageThreadInfo) Diff(other *MessageThreadInfo) []Change {
+	return messageThreadInfo.diff("", other, nil)
+}
+
+func (messageThreadInfo *MessageThreadInfo) diff(path string, other *MessageThreadInfo, changes []Change) []Change {
+	if messageThreadInfo == nil || other == nil {
+		if messageThreadInfo != other {
+			changes = append(changes, Change{Path: path, Old: messageThreadInfo, New: other})
+		}
+		return changes
+	}
+	if messageThreadInfo.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: messageThreadInfo.ChatId, New: other.ChatId})
+	}
+	if messageThreadInfo.MessageThreadId != other.MessageThreadId {
+		changes = append(changes, Change{Path: fieldPath(path, "message_thread_id"), Old: messageThreadInfo.MessageThreadId, New: other.MessageThreadId})
+	}
+	changes = messageThreadInfo.ReplyInfo.diff(fieldPath(path, "reply_info"), other.ReplyInfo, changes)
+	if messageThreadInfo.UnreadMessageCount != other.UnreadMessageCount {
+		changes = append(changes, Change{Path: fieldPath(path, "unread_message_count"), Old: messageThreadInfo.UnreadMessageCount, New: other.UnreadMessageCount})
+	}
+	if len(messageThreadInfo.Messages) != len(other.Messages) {
+		changes = append(changes, Change{Path: fieldPath(path, "messages"), Old: messageThreadInfo.Messages, New: other.Messages})
+	} else {
+		for i0 := range messageThreadInfo.Messages {
+			changes = messageThreadInfo.Messages[i0].diff(indexPath(fieldPath(path, "messages"), i0), &other.Messages[i0], changes)
+		}
+	}
+	changes = messageThreadInfo.DraftMessage.diff(fieldPath(path, "draft_message"), other.DraftMessage, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messageThreadInfo *MessageThreadInfo) String() string {
+	return objectString(messageThreadInfo)
+}
+
+// LogValue logs the object as its summary
+func (messageThreadInfo *MessageThreadInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(messageThreadInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messageThreadInfo *MessageThreadInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messageThreadInfo)
+}
+
+func (messageThreadInfo *MessageThreadInfo) printText(p *printer) {
+	if messageThreadInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("MessageThreadInfo")
+	p.int("chat_id", int64(messageThreadInfo.ChatId))
+	p.int("message_thread_id", int64(messageThreadInfo.MessageThreadId))
+	p.object("reply_info", messageThreadInfo.ReplyInfo)
+	p.int("unread_message_count", int64(messageThreadInfo.UnreadMessageCount))
+	p.vector("messages", len(messageThreadInfo.Messages), func(i0 int) {
+		messageThreadInfo.Messages[i0].printText(p)
+	})
+	p.object("draft_message", messageThreadInfo.DraftMessage)
+	p.endObject(state)
+}
+
 // GetMessageThread Returns information about a message thread. Can be used only if message.can_get_message_thread == true
 // @param chatId Chat identifier
 // @param messageId Identifier of the message
@@ -57,11 +270,11 @@ func (client *Client) GetMessageThread(chatId int64, messageId int64) (*MessageT
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messageThreadInfo MessageThreadInfo
-	err = json.Unmarshal(result.Raw, &messageThreadInfo)
+	err = jsonUnmarshal(result.Raw, &messageThreadInfo)
 	return &messageThreadInfo, err
 }
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // Messages Contains a list of messages
@@ -31,6 +31,161 @@ func NewMessages(totalCount int32, messages []Message) *Messages {
 	return &messagesTemp
 }
 
+// MarshalJSON marshals to json
+func (messages *Messages) MarshalJSON() ([]byte, error) {
+	return messages.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (messages *Messages) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, messages)
+}
+
+func (messages *Messages) appendJSON(b []byte) ([]byte, error) {
+	if messages == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, messages.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, messages.tdCommon.Extra)
+	b = append(b, `,"total_count":`...)
+	b = appendJSONInt(b, int64(messages.TotalCount))
+	b = append(b, `,"messages":`...)
+	if messages.Messages == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range messages.Messages {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = messages.Messages[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (messages *Messages) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			messages.tdCommon.Type, err = r.readString()
+		case "@extra":
+			messages.tdCommon.Extra, err = r.readString()
+		case "total_count":
+			messages.TotalCount, err = r.readInt32()
+		case "messages":
+			if r.readNull() {
+				messages.Messages = nil
+			} else {
+				messages.Messages = make([]Message, 0)
+				err = r.readArray(func() (err error) {
+					var item0 Message
+					err = item0.decodeJSON(r)
+					messages.Messages = append(messages.Messages, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (messages *Messages) Clone() *Messages {
+	if messages == nil {
+		return nil
+	}
+	clone := *messages
+	if messages.Messages != nil {
+		clone.Messages = make([]Message, len(messages.Messages))
+		for i0 := range messages.Messages {
+			clone.Messages[i0] = *messages.Messages[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (messages *Messages) Equal(other *Messages) bool {
+	if messages == nil || other == nil {
+		return messages == other
+	}
+	if messages.TotalCount != other.TotalCount {
+		return false
+	}
+	if len(messages.Messages) != len(other.Messages) {
+		return false
+	}
+	for i0 := range messages.Messages {
+		if !messages.Messages[i0].Equal(&other.Messages[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (messages *Messages) Diff(other *Messages) []Change {
+	return messages.diff("", other, nil)
+}
+
+func (messages *Messages) diff(path string, other *Messages, changes []Change) []Change {
+	if messages == nil || other == nil {
+		if messages != other {
+			changes = append(changes, Change{Path: path, Old: messages, New: other})
+		}
+		return changes
+	}
+	if messages.TotalCount != other.TotalCount {
+		changes = append(changes, Change{Path: fieldPath(path, "total_count"), Old: messages.TotalCount, New: other.TotalCount})
+	}
+	if len(messages.Messages) != len(other.Messages) {
+		changes = append(changes, Change{Path: fieldPath(path, "messages"), Old: messages.Messages, New: other.Messages})
+	} else {
+		for i0 := range messages.Messages {
+			changes = messages.Messages[i0].diff(indexPath(fieldPath(path, "messages"), i0), &other.Messages[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (messages *Messages) String() string {
+	return objectString(messages)
+}
+
+// LogValue logs the object as its summary
+func (messages *Messages) LogValue() slog.Value {
+	return slog.StringValue(objectString(messages))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (messages *Messages) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, messages)
+}
+
+func (messages *Messages) printText(p *printer) {
+	if messages == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Messages")
+	p.int("total_count", int64(messages.TotalCount))
+	p.vector("messages", len(messages.Messages), func(i0 int) {
+		messages.Messages[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetMessages Returns information about messages. If a message is not found, returns null on the corresponding position of the result
 // @param chatId Identifier of the chat the messages belong to
 // @param messageIds Identifiers of the messages to get
@@ -45,12 +200,12 @@ func (client *Client) GetMessages(chatId int64, messageIds []int64) (*Messages,
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messages Messages
-	err = json.Unmarshal(result.Raw, &messages)
+	err = jsonUnmarshal(result.Raw, &messages)
 	return &messages, err
 }
 
@@ -74,12 +229,12 @@ func (client *Client) GetChatHistory(chatId int64, fromMessageId int64, offset i
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messages Messages
-	err = json.Unmarshal(result.Raw, &messages)
+	err = jsonUnmarshal(result.Raw, &messages)
 	return &messages, err
 }
 
@@ -103,12 +258,12 @@ func (client *Client) GetMessageThreadHistory(chatId int64, messageId int64, fro
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messages Messages
-	err = json.Unmarshal(result.Raw, &messages)
+	err = jsonUnmarshal(result.Raw, &messages)
 	return &messages, err
 }
 
@@ -138,12 +293,12 @@ func (client *Client) SearchChatMessages(chatId int64, query string, senderId Me
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messages Messages
-	err = json.Unmarshal(result.Raw, &messages)
+	err = jsonUnmarshal(result.Raw, &messages)
 	return &messages, err
 }
 
@@ -175,12 +330,12 @@ func (client *Client) SearchMessages(chatList ChatList, query string, offsetDate
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messages Messages
-	err = json.Unmarshal(result.Raw, &messages)
+	err = jsonUnmarshal(result.Raw, &messages)
 	return &messages, err
 }
 
@@ -200,12 +355,12 @@ func (client *Client) SearchCallMessages(fromMessageId int64, limit int32, onlyM
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messages Messages
-	err = json.Unmarshal(result.Raw, &messages)
+	err = jsonUnmarshal(result.Raw, &messages)
 	return &messages, err
 }
 
@@ -223,12 +378,12 @@ func (client *Client) SearchChatRecentLocationMessages(chatId int64, limit int32
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messages Messages
-	err = json.Unmarshal(result.Raw, &messages)
+	err = jsonUnmarshal(result.Raw, &messages)
 	return &messages, err
 }
 
@@ -242,12 +397,12 @@ func (client *Client) GetActiveLiveLocationMessages() (*Messages, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messages Messages
-	err = json.Unmarshal(result.Raw, &messages)
+	err = jsonUnmarshal(result.Raw, &messages)
 	return &messages, err
 }
 
@@ -263,12 +418,12 @@ func (client *Client) GetChatScheduledMessages(chatId int64) (*Messages, error)
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messages Messages
-	err = json.Unmarshal(result.Raw, &messages)
+	err = jsonUnmarshal(result.Raw, &messages)
 	return &messages, err
 }
 
@@ -292,12 +447,12 @@ func (client *Client) SendMessageAlbum(chatId int64, messageThreadId int64, repl
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messages Messages
-	err = json.Unmarshal(result.Raw, &messages)
+	err = jsonUnmarshal(result.Raw, &messages)
 	return &messages, err
 }
 
@@ -325,12 +480,12 @@ func (client *Client) ForwardMessages(chatId int64, fromChatId int64, messageIds
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messages Messages
-	err = json.Unmarshal(result.Raw, &messages)
+	err = jsonUnmarshal(result.Raw, &messages)
 	return &messages, err
 }
 
@@ -348,11 +503,11 @@ func (client *Client) ResendMessages(chatId int64, messageIds []int64) (*Message
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var messages Messages
-	err = json.Unmarshal(result.Raw, &messages)
+	err = jsonUnmarshal(result.Raw, &messages)
 	return &messages, err
 }
@@ -0,0 +1,107 @@
+package tdlib
+
+import (
+	"time"
+)
+
+// ErrorCodeTimeout is the error code reported to Metrics for requests which timed out
+const ErrorCodeTimeout = -1
+
+// ErrorCodeCanceled is the error code reported to tracing spans for requests whose caller stopped waiting for the
+// response, as the context was done
+const ErrorCodeCanceled = -2
+
+// Metrics receives measurements of a client, see SetMetrics.
+// Implementations are called from the receive loop and from every goroutine sending requests,
+// so they must be safe for concurrent use and should not block.
+//
+// Gauges, such as the number of pending requests and the fill levels of receiver channels,
+// are read with Client.Stats when metrics are collected.
+type Metrics interface {
+	// RequestFinished is called when a request sent with SendAndCatch gets its response or times out.
+	// errorCode is 0 on success, the TDLib error code on error and ErrorCodeTimeout on timeout.
+	RequestFinished(method string, latency time.Duration, errorCode int)
+
+	// UpdateReceived is called for every update received from TDLib.
+	UpdateReceived(updateType UpdateEnum)
+
+	// ConnectionStateChanged is called when TDLib sends updateConnectionState.
+	ConnectionStateChanged(state ConnectionStateEnum)
+}
+
+// ReceiverStats holds the fill level of an event receiver channel
+type ReceiverStats struct {
+	Type     string // Type of the messages the receiver subscribed to
+	Len      int    // Number of messages waiting in the receiver channel
+	Capacity int    // Capacity of the receiver channel
+}
+
+// ClientStats is a snapshot of the client state
+type ClientStats struct {
+	PendingRequests int                 // Number of requests sent with SendAndCatch which wait for their response
+	PendingMessages int                 // Number of sent messages which wait for updateMessageSendSucceeded
+	RawUpdatesLen   int                 // Number of updates waiting in the raw updates channel
+	RawUpdatesCap   int                 // Capacity of the raw updates channel
+	Receivers       []ReceiverStats     // Fill levels of the event receiver channels
+	ConnectionState ConnectionStateEnum // Last connection state sent by TDLib, empty if none was received yet
+}
+
+// metricsHolder wraps Metrics so they can be stored in an atomic.Value
+type metricsHolder struct {
+	metrics Metrics
+}
+
+// SetMetrics sets the Metrics which receive the client measurements. Pass nil to disable them.
+func (client *Client) SetMetrics(metrics Metrics) {
+	client.metrics.Store(metricsHolder{metrics: metrics})
+}
+
+// getMetrics returns the Metrics set with SetMetrics, or nil
+func (client *Client) getMetrics() Metrics {
+	holder, _ := client.metrics.Load().(metricsHolder)
+	return holder.metrics
+}
+
+// Stats returns a snapshot of the client state, to be exported as gauges
+func (client *Client) Stats() ClientStats {
+	var stats ClientStats
+
+	client.waitersLock.RLock()
+	stats.PendingRequests = len(client.waiters)
+	client.waitersLock.RUnlock()
+
+	client.msgWaitersLock.RLock()
+	stats.PendingMessages = len(client.msgWaiters)
+	client.msgWaitersLock.RUnlock()
+
+	if client.rawUpdates != nil {
+		stats.RawUpdatesLen = len(client.rawUpdates)
+		stats.RawUpdatesCap = cap(client.rawUpdates)
+	}
+
+	// the receivers are read without receiverLock, which is held while an update waits for a full receiver channel
+	receivers := *client.receivers.Load()
+	stats.Receivers = make([]ReceiverStats, 0, len(receivers))
+	for _, receiver := range receivers {
+		stats.Receivers = append(stats.Receivers, ReceiverStats{
+			Type:     receiver.Instance.MessageType(),
+			Len:      len(receiver.Chan),
+			Capacity: cap(receiver.Chan),
+		})
+	}
+
+	if state, ok := client.connectionState.Load().(ConnectionStateEnum); ok {
+		stats.ConnectionState = state
+	}
+
+	return stats
+}
+
+// responseErrorCode returns the TDLib error code of a response, or 0 if it isn't an error
+func responseErrorCode(response UpdateMsg) int {
+	if response.Data["@type"] != "error" {
+		return 0
+	}
+	code, _ := int64Value(response.Data["code"])
+	return int(code)
+}
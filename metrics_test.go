@@ -0,0 +1,40 @@
+package tdlib
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStatsWithFullReceiver(t *testing.T) {
+	transport := newFakeTransport(nil)
+	client := NewClientWithTransport(Config{}, transport)
+	receiver := client.AddEventReceiver(&UpdateNewMessage{}, func(msg *TdMessage) bool { return true }, 1)
+
+	// the second update waits for the full receiver channel
+	transport.push(map[string]interface{}{"@type": "updateNewMessage", "message": nil})
+	transport.push(map[string]interface{}{"@type": "updateNewMessage", "message": nil})
+	for len(receiver.Chan) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(10 * time.Millisecond)
+
+	returned := make(chan ClientStats)
+	go func() {
+		returned <- client.Stats()
+	}()
+	select {
+	case stats := <-returned:
+		if len(stats.Receivers) != 1 || stats.Receivers[0].Len != 1 || stats.Receivers[0].Capacity != 1 {
+			t.Errorf("receivers are %+v, want the full receiver", stats.Receivers)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Stats blocks while an update waits for a full receiver channel")
+	}
+
+	<-receiver.Chan
+	<-receiver.Chan
+	client.RemoveEventReceiver(receiver)
+	if stats := client.Stats(); len(stats.Receivers) != 0 {
+		t.Errorf("receivers are %+v after the receiver was removed", stats.Receivers)
+	}
+}
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // Minithumbnail Thumbnail image of a very poor quality and low resolution
 type Minithumbnail struct {
 	tdCommon
@@ -28,3 +33,130 @@ func NewMinithumbnail(width int32, height int32, data []byte) *Minithumbnail {
 
 	return &minithumbnailTemp
 }
+
+// MarshalJSON marshals to json
+func (minithumbnail *Minithumbnail) MarshalJSON() ([]byte, error) {
+	return minithumbnail.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (minithumbnail *Minithumbnail) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, minithumbnail)
+}
+
+func (minithumbnail *Minithumbnail) appendJSON(b []byte) ([]byte, error) {
+	if minithumbnail == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, minithumbnail.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, minithumbnail.tdCommon.Extra)
+	b = append(b, `,"width":`...)
+	b = appendJSONInt(b, int64(minithumbnail.Width))
+	b = append(b, `,"height":`...)
+	b = appendJSONInt(b, int64(minithumbnail.Height))
+	b = append(b, `,"data":`...)
+	b = appendJSONBytes(b, minithumbnail.Data)
+	return append(b, '}'), nil
+}
+
+func (minithumbnail *Minithumbnail) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			minithumbnail.tdCommon.Type, err = r.readString()
+		case "@extra":
+			minithumbnail.tdCommon.Extra, err = r.readString()
+		case "width":
+			minithumbnail.Width, err = r.readInt32()
+		case "height":
+			minithumbnail.Height, err = r.readInt32()
+		case "data":
+			minithumbnail.Data, err = r.readBytes()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (minithumbnail *Minithumbnail) Clone() *Minithumbnail {
+	if minithumbnail == nil {
+		return nil
+	}
+	clone := *minithumbnail
+	if minithumbnail.Data != nil {
+		clone.Data = append([]byte{}, minithumbnail.Data...)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (minithumbnail *Minithumbnail) Equal(other *Minithumbnail) bool {
+	if minithumbnail == nil || other == nil {
+		return minithumbnail == other
+	}
+	if minithumbnail.Width != other.Width {
+		return false
+	}
+	if minithumbnail.Height != other.Height {
+		return false
+	}
+	if !equalBytes(minithumbnail.Data, other.Data) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (minithumbnail *Minithumbnail) Diff(other *Minithumbnail) []Change {
+	return minithumbnail.diff("", other, nil)
+}
+
+func (minithumbnail *Minithumbnail) diff(path string, other *Minithumbnail, changes []Change) []Change {
+	if minithumbnail == nil || other == nil {
+		if minithumbnail != other {
+			changes = append(changes, Change{Path: path, Old: minithumbnail, New: other})
+		}
+		return changes
+	}
+	if minithumbnail.Width != other.Width {
+		changes = append(changes, Change{Path: fieldPath(path, "width"), Old: minithumbnail.Width, New: other.Width})
+	}
+	if minithumbnail.Height != other.Height {
+		changes = append(changes, Change{Path: fieldPath(path, "height"), Old: minithumbnail.Height, New: other.Height})
+	}
+	if !equalBytes(minithumbnail.Data, other.Data) {
+		changes = append(changes, Change{Path: fieldPath(path, "data"), Old: minithumbnail.Data, New: other.Data})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (minithumbnail *Minithumbnail) String() string {
+	return objectString(minithumbnail)
+}
+
+// LogValue logs the object as its summary
+func (minithumbnail *Minithumbnail) LogValue() slog.Value {
+	return slog.StringValue(objectString(minithumbnail))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (minithumbnail *Minithumbnail) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, minithumbnail)
+}
+
+func (minithumbnail *Minithumbnail) printText(p *printer) {
+	if minithumbnail == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Minithumbnail")
+	p.int("width", int64(minithumbnail.Width))
+	p.int("height", int64(minithumbnail.Height))
+	p.bytes("data", minithumbnail.Data)
+	p.endObject(state)
+}
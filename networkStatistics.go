@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // NetworkStatistics A full list of available network statistic entries
@@ -31,6 +31,161 @@ func NewNetworkStatistics(sinceDate int32, entries []NetworkStatisticsEntry) *Ne
 	return &networkStatisticsTemp
 }
 
+// MarshalJSON marshals to json
+func (networkStatistics *NetworkStatistics) MarshalJSON() ([]byte, error) {
+	return networkStatistics.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (networkStatistics *NetworkStatistics) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, networkStatistics)
+}
+
+func (networkStatistics *NetworkStatistics) appendJSON(b []byte) ([]byte, error) {
+	if networkStatistics == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, networkStatistics.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, networkStatistics.tdCommon.Extra)
+	b = append(b, `,"since_date":`...)
+	b = appendJSONInt(b, int64(networkStatistics.SinceDate))
+	b = append(b, `,"entries":`...)
+	if networkStatistics.Entries == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range networkStatistics.Entries {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = appendJSONValue(b, networkStatistics.Entries[i0])
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (networkStatistics *NetworkStatistics) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			networkStatistics.tdCommon.Type, err = r.readString()
+		case "@extra":
+			networkStatistics.tdCommon.Extra, err = r.readString()
+		case "since_date":
+			networkStatistics.SinceDate, err = r.readInt32()
+		case "entries":
+			if r.readNull() {
+				networkStatistics.Entries = nil
+			} else {
+				networkStatistics.Entries = make([]NetworkStatisticsEntry, 0)
+				err = r.readArray(func() (err error) {
+					var item0 NetworkStatisticsEntry
+					item0, err = decodeNetworkStatisticsEntry(r)
+					networkStatistics.Entries = append(networkStatistics.Entries, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (networkStatistics *NetworkStatistics) Clone() *NetworkStatistics {
+	if networkStatistics == nil {
+		return nil
+	}
+	clone := *networkStatistics
+	if networkStatistics.Entries != nil {
+		clone.Entries = make([]NetworkStatisticsEntry, len(networkStatistics.Entries))
+		for i0 := range networkStatistics.Entries {
+			clone.Entries[i0] = cloneNetworkStatisticsEntry(networkStatistics.Entries[i0])
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (networkStatistics *NetworkStatistics) Equal(other *NetworkStatistics) bool {
+	if networkStatistics == nil || other == nil {
+		return networkStatistics == other
+	}
+	if networkStatistics.SinceDate != other.SinceDate {
+		return false
+	}
+	if len(networkStatistics.Entries) != len(other.Entries) {
+		return false
+	}
+	for i0 := range networkStatistics.Entries {
+		if !equalNetworkStatisticsEntry(networkStatistics.Entries[i0], other.Entries[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (networkStatistics *NetworkStatistics) Diff(other *NetworkStatistics) []Change {
+	return networkStatistics.diff("", other, nil)
+}
+
+func (networkStatistics *NetworkStatistics) diff(path string, other *NetworkStatistics, changes []Change) []Change {
+	if networkStatistics == nil || other == nil {
+		if networkStatistics != other {
+			changes = append(changes, Change{Path: path, Old: networkStatistics, New: other})
+		}
+		return changes
+	}
+	if networkStatistics.SinceDate != other.SinceDate {
+		changes = append(changes, Change{Path: fieldPath(path, "since_date"), Old: networkStatistics.SinceDate, New: other.SinceDate})
+	}
+	if len(networkStatistics.Entries) != len(other.Entries) {
+		changes = append(changes, Change{Path: fieldPath(path, "entries"), Old: networkStatistics.Entries, New: other.Entries})
+	} else {
+		for i0 := range networkStatistics.Entries {
+			changes = diffNetworkStatisticsEntry(indexPath(fieldPath(path, "entries"), i0), networkStatistics.Entries[i0], other.Entries[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (networkStatistics *NetworkStatistics) String() string {
+	return objectString(networkStatistics)
+}
+
+// LogValue logs the object as its summary
+func (networkStatistics *NetworkStatistics) LogValue() slog.Value {
+	return slog.StringValue(objectString(networkStatistics))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (networkStatistics *NetworkStatistics) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, networkStatistics)
+}
+
+func (networkStatistics *NetworkStatistics) printText(p *printer) {
+	if networkStatistics == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("NetworkStatistics")
+	p.int("since_date", int64(networkStatistics.SinceDate))
+	p.vector("entries", len(networkStatistics.Entries), func(i0 int) {
+		p.object("entries", networkStatistics.Entries[i0])
+	})
+	p.endObject(state)
+}
+
 // GetNetworkStatistics Returns network data usage statistics. Can be called before authorization
 // @param onlyCurrent If true, returns only data for the current library launch
 func (client *Client) GetNetworkStatistics(onlyCurrent bool) (*NetworkStatistics, error) {
@@ -43,11 +198,11 @@ func (client *Client) GetNetworkStatistics(onlyCurrent bool) (*NetworkStatistics
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var networkStatistics NetworkStatistics
-	err = json.Unmarshal(result.Raw, &networkStatistics)
+	err = jsonUnmarshal(result.Raw, &networkStatistics)
 	return &networkStatistics, err
 }
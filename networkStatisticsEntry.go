@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // NetworkStatisticsEntry Contains statistics about network usage
 type NetworkStatisticsEntry interface {
 	GetNetworkStatisticsEntryEnum() NetworkStatisticsEntryEnum
+	Accept(visitor NetworkStatisticsEntryVisitor)
 }
 
 // NetworkStatisticsEntryEnum Alias for abstract NetworkStatisticsEntry 'Sub-Classes', used as constant-enum here
@@ -19,33 +21,107 @@ const (
 	NetworkStatisticsEntryCallType NetworkStatisticsEntryEnum = "networkStatisticsEntryCall"
 )
 
+// UnknownNetworkStatisticsEntry is a NetworkStatisticsEntry of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownNetworkStatisticsEntry struct {
+	Unknown
+}
+
+// GetNetworkStatisticsEntryEnum return the enum type of this object
+func (unknownNetworkStatisticsEntry *UnknownNetworkStatisticsEntry) GetNetworkStatisticsEntryEnum() NetworkStatisticsEntryEnum {
+	return NetworkStatisticsEntryEnum(unknownNetworkStatisticsEntry.Type)
+}
+
+// Accept calls the method of the visitor for UnknownNetworkStatisticsEntry
+func (unknownNetworkStatisticsEntry *UnknownNetworkStatisticsEntry) Accept(visitor NetworkStatisticsEntryVisitor) {
+	visitor.VisitUnknownNetworkStatisticsEntry(unknownNetworkStatisticsEntry)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownNetworkStatisticsEntry *UnknownNetworkStatisticsEntry) String() string {
+	return objectString(unknownNetworkStatisticsEntry)
+}
+
+// LogValue logs the object as its summary
+func (unknownNetworkStatisticsEntry *UnknownNetworkStatisticsEntry) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownNetworkStatisticsEntry))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownNetworkStatisticsEntry *UnknownNetworkStatisticsEntry) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownNetworkStatisticsEntry)
+}
+
+func (unknownNetworkStatisticsEntry *UnknownNetworkStatisticsEntry) printText(p *printer) {
+	if unknownNetworkStatisticsEntry == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownNetworkStatisticsEntry")
+	p.string("@type", unknownNetworkStatisticsEntry.Type)
+	p.endObject(state)
+}
+
 func unmarshalNetworkStatisticsEntry(rawMsg *json.RawMessage) (NetworkStatisticsEntry, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch NetworkStatisticsEntryEnum(objMap["@type"].(string)) {
+	switch NetworkStatisticsEntryEnum(typeName) {
 	case NetworkStatisticsEntryFileType:
 		var networkStatisticsEntryFile NetworkStatisticsEntryFile
-		err := json.Unmarshal(*rawMsg, &networkStatisticsEntryFile)
+		err := jsonUnmarshal(*rawMsg, &networkStatisticsEntryFile)
 		return &networkStatisticsEntryFile, err
 
 	case NetworkStatisticsEntryCallType:
 		var networkStatisticsEntryCall NetworkStatisticsEntryCall
-		err := json.Unmarshal(*rawMsg, &networkStatisticsEntryCall)
+		err := jsonUnmarshal(*rawMsg, &networkStatisticsEntryCall)
 		return &networkStatisticsEntryCall, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownNetworkStatisticsEntry{Unknown: unknown}, nil
 	}
 }
 
+func unmarshalNetworkStatisticsEntrySlice(rawMsg *json.RawMessage) ([]NetworkStatisticsEntry, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var rawItems []*json.RawMessage
+	err := jsonUnmarshal(*rawMsg, &rawItems)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]NetworkStatisticsEntry, 0, len(rawItems))
+	for _, rawItem := range rawItems {
+		item, err := unmarshalNetworkStatisticsEntry(rawItem)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func decodeNetworkStatisticsEntry(r *jsonReader) (NetworkStatisticsEntry, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalNetworkStatisticsEntry(rawMsg)
+}
+
 // NetworkStatisticsEntryFile Contains information about the total amount of data that was used to send and receive files
 type NetworkStatisticsEntryFile struct {
 	tdCommon
@@ -78,34 +154,144 @@ func NewNetworkStatisticsEntryFile(fileType FileType, networkType NetworkType, s
 	return &networkStatisticsEntryFileTemp
 }
 
+// MarshalJSON marshals to json
+func (networkStatisticsEntryFile *NetworkStatisticsEntryFile) MarshalJSON() ([]byte, error) {
+	return networkStatisticsEntryFile.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (networkStatisticsEntryFile *NetworkStatisticsEntryFile) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, networkStatisticsEntryFile)
+}
+
+func (networkStatisticsEntryFile *NetworkStatisticsEntryFile) appendJSON(b []byte) ([]byte, error) {
+	if networkStatisticsEntryFile == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, networkStatisticsEntryFile.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, networkStatisticsEntryFile.tdCommon.Extra)
+	b = append(b, `,"file_type":`...)
+	b, err = appendJSONValue(b, networkStatisticsEntryFile.FileType)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		SentBytes     int64 `json:"sent_bytes"`     // Total number of bytes sent
-		ReceivedBytes int64 `json:"received_bytes"` // Total number of bytes received
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"network_type":`...)
+	b, err = appendJSONValue(b, networkStatisticsEntryFile.NetworkType)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	b = append(b, `,"sent_bytes":`...)
+	b = appendJSONInt(b, int64(networkStatisticsEntryFile.SentBytes))
+	b = append(b, `,"received_bytes":`...)
+	b = appendJSONInt(b, int64(networkStatisticsEntryFile.ReceivedBytes))
+	return append(b, '}'), nil
+}
+
+func (networkStatisticsEntryFile *NetworkStatisticsEntryFile) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			networkStatisticsEntryFile.tdCommon.Type, err = r.readString()
+		case "@extra":
+			networkStatisticsEntryFile.tdCommon.Extra, err = r.readString()
+		case "file_type":
+			networkStatisticsEntryFile.FileType, err = decodeFileType(r)
+		case "network_type":
+			networkStatisticsEntryFile.NetworkType, err = decodeNetworkType(r)
+		case "sent_bytes":
+			networkStatisticsEntryFile.SentBytes, err = r.readInt64()
+		case "received_bytes":
+			networkStatisticsEntryFile.ReceivedBytes, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	networkStatisticsEntryFile.tdCommon = tempObj.tdCommon
-	networkStatisticsEntryFile.SentBytes = tempObj.SentBytes
-	networkStatisticsEntryFile.ReceivedBytes = tempObj.ReceivedBytes
+// Clone returns a deep copy of the object
+func (networkStatisticsEntryFile *NetworkStatisticsEntryFile) Clone() *NetworkStatisticsEntryFile {
+	if networkStatisticsEntryFile == nil {
+		return nil
+	}
+	clone := *networkStatisticsEntryFile
+	clone.FileType = cloneFileType(networkStatisticsEntryFile.FileType)
+	clone.NetworkType = cloneNetworkType(networkStatisticsEntryFile.NetworkType)
+	return &clone
+}
 
-	fieldFileType, _ := unmarshalFileType(objMap["file_type"])
-	networkStatisticsEntryFile.FileType = fieldFileType
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (networkStatisticsEntryFile *NetworkStatisticsEntryFile) Equal(other *NetworkStatisticsEntryFile) bool {
+	if networkStatisticsEntryFile == nil || other == nil {
+		return networkStatisticsEntryFile == other
+	}
+	if !equalFileType(networkStatisticsEntryFile.FileType, other.FileType) {
+		return false
+	}
+	if !equalNetworkType(networkStatisticsEntryFile.NetworkType, other.NetworkType) {
+		return false
+	}
+	if networkStatisticsEntryFile.SentBytes != other.SentBytes {
+		return false
+	}
+	if networkStatisticsEntryFile.ReceivedBytes != other.ReceivedBytes {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (networkStatisticsEntryFile *NetworkStatisticsEntryFile) Diff(other *NetworkStatisticsEntryFile) []Change {
+	return networkStatisticsEntryFile.diff("", other, nil)
+}
+
+func (networkStatisticsEntryFile *NetworkStatisticsEntryFile) diff(path string, other *NetworkStatisticsEntryFile, changes []Change) []Change {
+	if networkStatisticsEntryFile == nil || other == nil {
+		if networkStatisticsEntryFile != other {
+			changes = append(changes, Change{Path: path, Old: networkStatisticsEntryFile, New: other})
+		}
+		return changes
+	}
+	changes = diffFileType(fieldPath(path, "file_type"), networkStatisticsEntryFile.FileType, other.FileType, changes)
+	changes = diffNetworkType(fieldPath(path, "network_type"), networkStatisticsEntryFile.NetworkType, other.NetworkType, changes)
+	if networkStatisticsEntryFile.SentBytes != other.SentBytes {
+		changes = append(changes, Change{Path: fieldPath(path, "sent_bytes"), Old: networkStatisticsEntryFile.SentBytes, New: other.SentBytes})
+	}
+	if networkStatisticsEntryFile.ReceivedBytes != other.ReceivedBytes {
+		changes = append(changes, Change{Path: fieldPath(path, "received_bytes"), Old: networkStatisticsEntryFile.ReceivedBytes, New: other.ReceivedBytes})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (networkStatisticsEntryFile *NetworkStatisticsEntryFile) String() string {
+	return objectString(networkStatisticsEntryFile)
+}
+
+// LogValue logs the object as its summary
+func (networkStatisticsEntryFile *NetworkStatisticsEntryFile) LogValue() slog.Value {
+	return slog.StringValue(objectString(networkStatisticsEntryFile))
+}
 
-	fieldNetworkType, _ := unmarshalNetworkType(objMap["network_type"])
-	networkStatisticsEntryFile.NetworkType = fieldNetworkType
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (networkStatisticsEntryFile *NetworkStatisticsEntryFile) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, networkStatisticsEntryFile)
+}
 
-	return nil
+func (networkStatisticsEntryFile *NetworkStatisticsEntryFile) printText(p *printer) {
+	if networkStatisticsEntryFile == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("NetworkStatisticsEntryFile")
+	p.object("file_type", networkStatisticsEntryFile.FileType)
+	p.object("network_type", networkStatisticsEntryFile.NetworkType)
+	p.int("sent_bytes", int64(networkStatisticsEntryFile.SentBytes))
+	p.int("received_bytes", int64(networkStatisticsEntryFile.ReceivedBytes))
+	p.endObject(state)
 }
 
 // GetNetworkStatisticsEntryEnum return the enum type of this object
@@ -113,6 +299,11 @@ func (networkStatisticsEntryFile *NetworkStatisticsEntryFile) GetNetworkStatisti
 	return NetworkStatisticsEntryFileType
 }
 
+// Accept calls the method of the visitor for NetworkStatisticsEntryFile
+func (networkStatisticsEntryFile *NetworkStatisticsEntryFile) Accept(visitor NetworkStatisticsEntryVisitor) {
+	visitor.VisitNetworkStatisticsEntryFile(networkStatisticsEntryFile)
+}
+
 // NetworkStatisticsEntryCall Contains information about the total amount of data that was used for calls
 type NetworkStatisticsEntryCall struct {
 	tdCommon
@@ -145,36 +336,248 @@ func NewNetworkStatisticsEntryCall(networkType NetworkType, sentBytes int64, rec
 	return &networkStatisticsEntryCallTemp
 }
 
+// MarshalJSON marshals to json
+func (networkStatisticsEntryCall *NetworkStatisticsEntryCall) MarshalJSON() ([]byte, error) {
+	return networkStatisticsEntryCall.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (networkStatisticsEntryCall *NetworkStatisticsEntryCall) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, networkStatisticsEntryCall)
+}
+
+func (networkStatisticsEntryCall *NetworkStatisticsEntryCall) appendJSON(b []byte) ([]byte, error) {
+	if networkStatisticsEntryCall == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, networkStatisticsEntryCall.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, networkStatisticsEntryCall.tdCommon.Extra)
+	b = append(b, `,"network_type":`...)
+	b, err = appendJSONValue(b, networkStatisticsEntryCall.NetworkType)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		SentBytes     int64   `json:"sent_bytes"`     // Total number of bytes sent
-		ReceivedBytes int64   `json:"received_bytes"` // Total number of bytes received
-		Duration      float64 `json:"duration"`       // Total call duration, in seconds
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"sent_bytes":`...)
+	b = appendJSONInt(b, int64(networkStatisticsEntryCall.SentBytes))
+	b = append(b, `,"received_bytes":`...)
+	b = appendJSONInt(b, int64(networkStatisticsEntryCall.ReceivedBytes))
+	b = append(b, `,"duration":`...)
+	b, err = appendJSONFloat(b, networkStatisticsEntryCall.Duration)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (networkStatisticsEntryCall *NetworkStatisticsEntryCall) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			networkStatisticsEntryCall.tdCommon.Type, err = r.readString()
+		case "@extra":
+			networkStatisticsEntryCall.tdCommon.Extra, err = r.readString()
+		case "network_type":
+			networkStatisticsEntryCall.NetworkType, err = decodeNetworkType(r)
+		case "sent_bytes":
+			networkStatisticsEntryCall.SentBytes, err = r.readInt64()
+		case "received_bytes":
+			networkStatisticsEntryCall.ReceivedBytes, err = r.readInt64()
+		case "duration":
+			networkStatisticsEntryCall.Duration, err = r.readFloat64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (networkStatisticsEntryCall *NetworkStatisticsEntryCall) Clone() *NetworkStatisticsEntryCall {
+	if networkStatisticsEntryCall == nil {
+		return nil
+	}
+	clone := *networkStatisticsEntryCall
+	clone.NetworkType = cloneNetworkType(networkStatisticsEntryCall.NetworkType)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (networkStatisticsEntryCall *NetworkStatisticsEntryCall) Equal(other *NetworkStatisticsEntryCall) bool {
+	if networkStatisticsEntryCall == nil || other == nil {
+		return networkStatisticsEntryCall == other
+	}
+	if !equalNetworkType(networkStatisticsEntryCall.NetworkType, other.NetworkType) {
+		return false
+	}
+	if networkStatisticsEntryCall.SentBytes != other.SentBytes {
+		return false
+	}
+	if networkStatisticsEntryCall.ReceivedBytes != other.ReceivedBytes {
+		return false
+	}
+	if networkStatisticsEntryCall.Duration != other.Duration {
+		return false
 	}
+	return true
+}
 
-	networkStatisticsEntryCall.tdCommon = tempObj.tdCommon
-	networkStatisticsEntryCall.SentBytes = tempObj.SentBytes
-	networkStatisticsEntryCall.ReceivedBytes = tempObj.ReceivedBytes
-	networkStatisticsEntryCall.Duration = tempObj.Duration
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (networkStatisticsEntryCall *NetworkStatisticsEntryCall) Diff(other *NetworkStatisticsEntryCall) []Change {
+	return networkStatisticsEntryCall.diff("", other, nil)
+}
 
-	fieldNetworkType, _ := unmarshalNetworkType(objMap["network_type"])
-	networkStatisticsEntryCall.NetworkType = fieldNetworkType
+func (networkStatisticsEntryCall *NetworkStatisticsEntryCall) diff(path string, other *NetworkStatisticsEntryCall, changes []Change) []Change {
+	if networkStatisticsEntryCall == nil || other == nil {
+		if networkStatisticsEntryCall != other {
+			changes = append(changes, Change{Path: path, Old: networkStatisticsEntryCall, New: other})
+		}
+		return changes
+	}
+	changes = diffNetworkType(fieldPath(path, "network_type"), networkStatisticsEntryCall.NetworkType, other.NetworkType, changes)
+	if networkStatisticsEntryCall.SentBytes != other.SentBytes {
+		changes = append(changes, Change{Path: fieldPath(path, "sent_bytes"), Old: networkStatisticsEntryCall.SentBytes, New: other.SentBytes})
+	}
+	if networkStatisticsEntryCall.ReceivedBytes != other.ReceivedBytes {
+		changes = append(changes, Change{Path: fieldPath(path, "received_bytes"), Old: networkStatisticsEntryCall.ReceivedBytes, New: other.ReceivedBytes})
+	}
+	if networkStatisticsEntryCall.Duration != other.Duration {
+		changes = append(changes, Change{Path: fieldPath(path, "duration"), Old: networkStatisticsEntryCall.Duration, New: other.Duration})
+	}
+	return changes
+}
 
-	return nil
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (networkStatisticsEntryCall *NetworkStatisticsEntryCall) String() string {
+	return objectString(networkStatisticsEntryCall)
+}
+
+// LogValue logs the object as its summary
+func (networkStatisticsEntryCall *NetworkStatisticsEntryCall) LogValue() slog.Value {
+	return slog.StringValue(objectString(networkStatisticsEntryCall))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (networkStatisticsEntryCall *NetworkStatisticsEntryCall) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, networkStatisticsEntryCall)
+}
+
+func (networkStatisticsEntryCall *NetworkStatisticsEntryCall) printText(p *printer) {
+	if networkStatisticsEntryCall == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("NetworkStatisticsEntryCall")
+	p.object("network_type", networkStatisticsEntryCall.NetworkType)
+	p.int("sent_bytes", int64(networkStatisticsEntryCall.SentBytes))
+	p.int("received_bytes", int64(networkStatisticsEntryCall.ReceivedBytes))
+	p.float("duration", networkStatisticsEntryCall.Duration)
+	p.endObject(state)
 }
 
 // GetNetworkStatisticsEntryEnum return the enum type of this object
 func (networkStatisticsEntryCall *NetworkStatisticsEntryCall) GetNetworkStatisticsEntryEnum() NetworkStatisticsEntryEnum {
 	return NetworkStatisticsEntryCallType
 }
+
+// Accept calls the method of the visitor for NetworkStatisticsEntryCall
+func (networkStatisticsEntryCall *NetworkStatisticsEntryCall) Accept(visitor NetworkStatisticsEntryVisitor) {
+	visitor.VisitNetworkStatisticsEntryCall(networkStatisticsEntryCall)
+}
+
+// NetworkStatisticsEntryVisitor visits every type of NetworkStatisticsEntry, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to NetworkStatisticsEntry, until they handle it too.
+type NetworkStatisticsEntryVisitor interface {
+	VisitNetworkStatisticsEntryFile(networkStatisticsEntryFile *NetworkStatisticsEntryFile)
+	VisitNetworkStatisticsEntryCall(networkStatisticsEntryCall *NetworkStatisticsEntryCall)
+	VisitUnknownNetworkStatisticsEntry(unknownNetworkStatisticsEntry *UnknownNetworkStatisticsEntry)
+}
+
+// NetworkStatisticsEntryCases holds the function MatchNetworkStatisticsEntry calls for each type of NetworkStatisticsEntry;
+// types without a function are passed to the default case
+type NetworkStatisticsEntryCases[R any] struct {
+	NetworkStatisticsEntryFile func(networkStatisticsEntryFile *NetworkStatisticsEntryFile) R
+	NetworkStatisticsEntryCall func(networkStatisticsEntryCall *NetworkStatisticsEntryCall) R
+}
+
+// MatchNetworkStatisticsEntry calls the case of the type of networkStatisticsEntry, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement NetworkStatisticsEntryVisitor
+// to have the compiler check every type is handled.
+func MatchNetworkStatisticsEntry[R any](networkStatisticsEntry NetworkStatisticsEntry, cases NetworkStatisticsEntryCases[R], defaultCase func(networkStatisticsEntry NetworkStatisticsEntry) R) R {
+	switch value := networkStatisticsEntry.(type) {
+	case *NetworkStatisticsEntryFile:
+		if cases.NetworkStatisticsEntryFile != nil {
+			return cases.NetworkStatisticsEntryFile(value)
+		}
+	case *NetworkStatisticsEntryCall:
+		if cases.NetworkStatisticsEntryCall != nil {
+			return cases.NetworkStatisticsEntryCall(value)
+		}
+	}
+	return defaultCase(networkStatisticsEntry)
+}
+
+// Clone returns a deep copy of the object
+func (unknownNetworkStatisticsEntry *UnknownNetworkStatisticsEntry) Clone() *UnknownNetworkStatisticsEntry {
+	if unknownNetworkStatisticsEntry == nil {
+		return nil
+	}
+	return &UnknownNetworkStatisticsEntry{Unknown: unknownNetworkStatisticsEntry.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownNetworkStatisticsEntry *UnknownNetworkStatisticsEntry) Equal(other *UnknownNetworkStatisticsEntry) bool {
+	if unknownNetworkStatisticsEntry == nil || other == nil {
+		return unknownNetworkStatisticsEntry == other
+	}
+	return unknownNetworkStatisticsEntry.Unknown.equal(other.Unknown)
+}
+
+func cloneNetworkStatisticsEntry(networkStatisticsEntry NetworkStatisticsEntry) NetworkStatisticsEntry {
+	switch value := networkStatisticsEntry.(type) {
+	case *NetworkStatisticsEntryFile:
+		return value.Clone()
+	case *NetworkStatisticsEntryCall:
+		return value.Clone()
+	case *UnknownNetworkStatisticsEntry:
+		return value.Clone()
+	}
+	return networkStatisticsEntry
+}
+
+func equalNetworkStatisticsEntry(a NetworkStatisticsEntry, b NetworkStatisticsEntry) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *NetworkStatisticsEntryFile:
+		b, ok := b.(*NetworkStatisticsEntryFile)
+		return ok && a.Equal(b)
+	case *NetworkStatisticsEntryCall:
+		b, ok := b.(*NetworkStatisticsEntryCall)
+		return ok && a.Equal(b)
+	case *UnknownNetworkStatisticsEntry:
+		b, ok := b.(*UnknownNetworkStatisticsEntry)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffNetworkStatisticsEntry(path string, a NetworkStatisticsEntry, b NetworkStatisticsEntry, changes []Change) []Change {
+	switch a := a.(type) {
+	case *NetworkStatisticsEntryFile:
+		if b, ok := b.(*NetworkStatisticsEntryFile); ok {
+			return a.diff(path, b, changes)
+		}
+	case *NetworkStatisticsEntryCall:
+		if b, ok := b.(*NetworkStatisticsEntryCall); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalNetworkStatisticsEntry(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // NetworkType Represents the type of a network
 type NetworkType interface {
 	GetNetworkTypeEnum() NetworkTypeEnum
+	Accept(visitor NetworkTypeVisitor)
 }
 
 // NetworkTypeEnum Alias for abstract NetworkType 'Sub-Classes', used as constant-enum here
@@ -22,46 +24,97 @@ const (
 	NetworkTypeOtherType         NetworkTypeEnum = "networkTypeOther"
 )
 
+// UnknownNetworkType is a NetworkType of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownNetworkType struct {
+	Unknown
+}
+
+// GetNetworkTypeEnum return the enum type of this object
+func (unknownNetworkType *UnknownNetworkType) GetNetworkTypeEnum() NetworkTypeEnum {
+	return NetworkTypeEnum(unknownNetworkType.Type)
+}
+
+// Accept calls the method of the visitor for UnknownNetworkType
+func (unknownNetworkType *UnknownNetworkType) Accept(visitor NetworkTypeVisitor) {
+	visitor.VisitUnknownNetworkType(unknownNetworkType)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownNetworkType *UnknownNetworkType) String() string {
+	return objectString(unknownNetworkType)
+}
+
+// LogValue logs the object as its summary
+func (unknownNetworkType *UnknownNetworkType) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownNetworkType))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownNetworkType *UnknownNetworkType) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownNetworkType)
+}
+
+func (unknownNetworkType *UnknownNetworkType) printText(p *printer) {
+	if unknownNetworkType == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownNetworkType")
+	p.string("@type", unknownNetworkType.Type)
+	p.endObject(state)
+}
+
 func unmarshalNetworkType(rawMsg *json.RawMessage) (NetworkType, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch NetworkTypeEnum(objMap["@type"].(string)) {
+	switch NetworkTypeEnum(typeName) {
 	case NetworkTypeNoneType:
 		var networkTypeNone NetworkTypeNone
-		err := json.Unmarshal(*rawMsg, &networkTypeNone)
+		err := jsonUnmarshal(*rawMsg, &networkTypeNone)
 		return &networkTypeNone, err
 
 	case NetworkTypeMobileType:
 		var networkTypeMobile NetworkTypeMobile
-		err := json.Unmarshal(*rawMsg, &networkTypeMobile)
+		err := jsonUnmarshal(*rawMsg, &networkTypeMobile)
 		return &networkTypeMobile, err
 
 	case NetworkTypeMobileRoamingType:
 		var networkTypeMobileRoaming NetworkTypeMobileRoaming
-		err := json.Unmarshal(*rawMsg, &networkTypeMobileRoaming)
+		err := jsonUnmarshal(*rawMsg, &networkTypeMobileRoaming)
 		return &networkTypeMobileRoaming, err
 
 	case NetworkTypeWiFiType:
 		var networkTypeWiFi NetworkTypeWiFi
-		err := json.Unmarshal(*rawMsg, &networkTypeWiFi)
+		err := jsonUnmarshal(*rawMsg, &networkTypeWiFi)
 		return &networkTypeWiFi, err
 
 	case NetworkTypeOtherType:
 		var networkTypeOther NetworkTypeOther
-		err := json.Unmarshal(*rawMsg, &networkTypeOther)
+		err := jsonUnmarshal(*rawMsg, &networkTypeOther)
 		return &networkTypeOther, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownNetworkType{Unknown: unknown}, nil
+	}
+}
+
+func decodeNetworkType(r *jsonReader) (NetworkType, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
 	}
+	return unmarshalNetworkType(rawMsg)
 }
 
 // NetworkTypeNone The network is not available
@@ -75,7 +128,6 @@ func (networkTypeNone *NetworkTypeNone) MessageType() string {
 }
 
 // NewNetworkTypeNone creates a new NetworkTypeNone
-//
 func NewNetworkTypeNone() *NetworkTypeNone {
 	networkTypeNoneTemp := NetworkTypeNone{
 		tdCommon: tdCommon{Type: "networkTypeNone"},
@@ -84,11 +136,107 @@ func NewNetworkTypeNone() *NetworkTypeNone {
 	return &networkTypeNoneTemp
 }
 
+// MarshalJSON marshals to json
+func (networkTypeNone *NetworkTypeNone) MarshalJSON() ([]byte, error) {
+	return networkTypeNone.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (networkTypeNone *NetworkTypeNone) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, networkTypeNone)
+}
+
+func (networkTypeNone *NetworkTypeNone) appendJSON(b []byte) ([]byte, error) {
+	if networkTypeNone == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, networkTypeNone.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, networkTypeNone.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (networkTypeNone *NetworkTypeNone) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			networkTypeNone.tdCommon.Type, err = r.readString()
+		case "@extra":
+			networkTypeNone.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (networkTypeNone *NetworkTypeNone) Clone() *NetworkTypeNone {
+	if networkTypeNone == nil {
+		return nil
+	}
+	clone := *networkTypeNone
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (networkTypeNone *NetworkTypeNone) Equal(other *NetworkTypeNone) bool {
+	if networkTypeNone == nil || other == nil {
+		return networkTypeNone == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (networkTypeNone *NetworkTypeNone) Diff(other *NetworkTypeNone) []Change {
+	return networkTypeNone.diff("", other, nil)
+}
+
+func (networkTypeNone *NetworkTypeNone) diff(path string, other *NetworkTypeNone, changes []Change) []Change {
+	if networkTypeNone == nil || other == nil {
+		if networkTypeNone != other {
+			changes = append(changes, Change{Path: path, Old: networkTypeNone, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (networkTypeNone *NetworkTypeNone) String() string {
+	return objectString(networkTypeNone)
+}
+
+// LogValue logs the object as its summary
+func (networkTypeNone *NetworkTypeNone) LogValue() slog.Value {
+	return slog.StringValue(objectString(networkTypeNone))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (networkTypeNone *NetworkTypeNone) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, networkTypeNone)
+}
+
+func (networkTypeNone *NetworkTypeNone) printText(p *printer) {
+	if networkTypeNone == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("NetworkTypeNone")
+	p.endObject(state)
+}
+
 // GetNetworkTypeEnum return the enum type of this object
 func (networkTypeNone *NetworkTypeNone) GetNetworkTypeEnum() NetworkTypeEnum {
 	return NetworkTypeNoneType
 }
 
+// Accept calls the method of the visitor for NetworkTypeNone
+func (networkTypeNone *NetworkTypeNone) Accept(visitor NetworkTypeVisitor) {
+	visitor.VisitNetworkTypeNone(networkTypeNone)
+}
+
 // NetworkTypeMobile A mobile network
 type NetworkTypeMobile struct {
 	tdCommon
@@ -100,7 +248,6 @@ func (networkTypeMobile *NetworkTypeMobile) MessageType() string {
 }
 
 // NewNetworkTypeMobile creates a new NetworkTypeMobile
-//
 func NewNetworkTypeMobile() *NetworkTypeMobile {
 	networkTypeMobileTemp := NetworkTypeMobile{
 		tdCommon: tdCommon{Type: "networkTypeMobile"},
@@ -109,11 +256,107 @@ func NewNetworkTypeMobile() *NetworkTypeMobile {
 	return &networkTypeMobileTemp
 }
 
+// MarshalJSON marshals to json
+func (networkTypeMobile *NetworkTypeMobile) MarshalJSON() ([]byte, error) {
+	return networkTypeMobile.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (networkTypeMobile *NetworkTypeMobile) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, networkTypeMobile)
+}
+
+func (networkTypeMobile *NetworkTypeMobile) appendJSON(b []byte) ([]byte, error) {
+	if networkTypeMobile == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, networkTypeMobile.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, networkTypeMobile.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (networkTypeMobile *NetworkTypeMobile) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			networkTypeMobile.tdCommon.Type, err = r.readString()
+		case "@extra":
+			networkTypeMobile.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (networkTypeMobile *NetworkTypeMobile) Clone() *NetworkTypeMobile {
+	if networkTypeMobile == nil {
+		return nil
+	}
+	clone := *networkTypeMobile
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (networkTypeMobile *NetworkTypeMobile) Equal(other *NetworkTypeMobile) bool {
+	if networkTypeMobile == nil || other == nil {
+		return networkTypeMobile == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (networkTypeMobile *NetworkTypeMobile) Diff(other *NetworkTypeMobile) []Change {
+	return networkTypeMobile.diff("", other, nil)
+}
+
+func (networkTypeMobile *NetworkTypeMobile) diff(path string, other *NetworkTypeMobile, changes []Change) []Change {
+	if networkTypeMobile == nil || other == nil {
+		if networkTypeMobile != other {
+			changes = append(changes, Change{Path: path, Old: networkTypeMobile, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (networkTypeMobile *NetworkTypeMobile) String() string {
+	return objectString(networkTypeMobile)
+}
+
+// LogValue logs the object as its summary
+func (networkTypeMobile *NetworkTypeMobile) LogValue() slog.Value {
+	return slog.StringValue(objectString(networkTypeMobile))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (networkTypeMobile *NetworkTypeMobile) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, networkTypeMobile)
+}
+
+func (networkTypeMobile *NetworkTypeMobile) printText(p *printer) {
+	if networkTypeMobile == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("NetworkTypeMobile")
+	p.endObject(state)
+}
+
 // GetNetworkTypeEnum return the enum type of this object
 func (networkTypeMobile *NetworkTypeMobile) GetNetworkTypeEnum() NetworkTypeEnum {
 	return NetworkTypeMobileType
 }
 
+// Accept calls the method of the visitor for NetworkTypeMobile
+func (networkTypeMobile *NetworkTypeMobile) Accept(visitor NetworkTypeVisitor) {
+	visitor.VisitNetworkTypeMobile(networkTypeMobile)
+}
+
 // NetworkTypeMobileRoaming A mobile roaming network
 type NetworkTypeMobileRoaming struct {
 	tdCommon
@@ -125,7 +368,6 @@ func (networkTypeMobileRoaming *NetworkTypeMobileRoaming) MessageType() string {
 }
 
 // NewNetworkTypeMobileRoaming creates a new NetworkTypeMobileRoaming
-//
 func NewNetworkTypeMobileRoaming() *NetworkTypeMobileRoaming {
 	networkTypeMobileRoamingTemp := NetworkTypeMobileRoaming{
 		tdCommon: tdCommon{Type: "networkTypeMobileRoaming"},
@@ -134,11 +376,107 @@ func NewNetworkTypeMobileRoaming() *NetworkTypeMobileRoaming {
 	return &networkTypeMobileRoamingTemp
 }
 
+// MarshalJSON marshals to json
+func (networkTypeMobileRoaming *NetworkTypeMobileRoaming) MarshalJSON() ([]byte, error) {
+	return networkTypeMobileRoaming.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (networkTypeMobileRoaming *NetworkTypeMobileRoaming) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, networkTypeMobileRoaming)
+}
+
+func (networkTypeMobileRoaming *NetworkTypeMobileRoaming) appendJSON(b []byte) ([]byte, error) {
+	if networkTypeMobileRoaming == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, networkTypeMobileRoaming.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, networkTypeMobileRoaming.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (networkTypeMobileRoaming *NetworkTypeMobileRoaming) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			networkTypeMobileRoaming.tdCommon.Type, err = r.readString()
+		case "@extra":
+			networkTypeMobileRoaming.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (networkTypeMobileRoaming *NetworkTypeMobileRoaming) Clone() *NetworkTypeMobileRoaming {
+	if networkTypeMobileRoaming == nil {
+		return nil
+	}
+	clone := *networkTypeMobileRoaming
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (networkTypeMobileRoaming *NetworkTypeMobileRoaming) Equal(other *NetworkTypeMobileRoaming) bool {
+	if networkTypeMobileRoaming == nil || other == nil {
+		return networkTypeMobileRoaming == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (networkTypeMobileRoaming *NetworkTypeMobileRoaming) Diff(other *NetworkTypeMobileRoaming) []Change {
+	return networkTypeMobileRoaming.diff("", other, nil)
+}
+
+func (networkTypeMobileRoaming *NetworkTypeMobileRoaming) diff(path string, other *NetworkTypeMobileRoaming, changes []Change) []Change {
+	if networkTypeMobileRoaming == nil || other == nil {
+		if networkTypeMobileRoaming != other {
+			changes = append(changes, Change{Path: path, Old: networkTypeMobileRoaming, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (networkTypeMobileRoaming *NetworkTypeMobileRoaming) String() string {
+	return objectString(networkTypeMobileRoaming)
+}
+
+// LogValue logs the object as its summary
+func (networkTypeMobileRoaming *NetworkTypeMobileRoaming) LogValue() slog.Value {
+	return slog.StringValue(objectString(networkTypeMobileRoaming))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (networkTypeMobileRoaming *NetworkTypeMobileRoaming) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, networkTypeMobileRoaming)
+}
+
+func (networkTypeMobileRoaming *NetworkTypeMobileRoaming) printText(p *printer) {
+	if networkTypeMobileRoaming == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("NetworkTypeMobileRoaming")
+	p.endObject(state)
+}
+
 // GetNetworkTypeEnum return the enum type of this object
 func (networkTypeMobileRoaming *NetworkTypeMobileRoaming) GetNetworkTypeEnum() NetworkTypeEnum {
 	return NetworkTypeMobileRoamingType
 }
 
+// Accept calls the method of the visitor for NetworkTypeMobileRoaming
+func (networkTypeMobileRoaming *NetworkTypeMobileRoaming) Accept(visitor NetworkTypeVisitor) {
+	visitor.VisitNetworkTypeMobileRoaming(networkTypeMobileRoaming)
+}
+
 // NetworkTypeWiFi A Wi-Fi network
 type NetworkTypeWiFi struct {
 	tdCommon
@@ -150,7 +488,6 @@ func (networkTypeWiFi *NetworkTypeWiFi) MessageType() string {
 }
 
 // NewNetworkTypeWiFi creates a new NetworkTypeWiFi
-//
 func NewNetworkTypeWiFi() *NetworkTypeWiFi {
 	networkTypeWiFiTemp := NetworkTypeWiFi{
 		tdCommon: tdCommon{Type: "networkTypeWiFi"},
@@ -159,11 +496,107 @@ func NewNetworkTypeWiFi() *NetworkTypeWiFi {
 	return &networkTypeWiFiTemp
 }
 
+// MarshalJSON marshals to json
+func (networkTypeWiFi *NetworkTypeWiFi) MarshalJSON() ([]byte, error) {
+	return networkTypeWiFi.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (networkTypeWiFi *NetworkTypeWiFi) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, networkTypeWiFi)
+}
+
+func (networkTypeWiFi *NetworkTypeWiFi) appendJSON(b []byte) ([]byte, error) {
+	if networkTypeWiFi == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, networkTypeWiFi.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, networkTypeWiFi.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (networkTypeWiFi *NetworkTypeWiFi) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			networkTypeWiFi.tdCommon.Type, err = r.readString()
+		case "@extra":
+			networkTypeWiFi.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (networkTypeWiFi *NetworkTypeWiFi) Clone() *NetworkTypeWiFi {
+	if networkTypeWiFi == nil {
+		return nil
+	}
+	clone := *networkTypeWiFi
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (networkTypeWiFi *NetworkTypeWiFi) Equal(other *NetworkTypeWiFi) bool {
+	if networkTypeWiFi == nil || other == nil {
+		return networkTypeWiFi == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (networkTypeWiFi *NetworkTypeWiFi) Diff(other *NetworkTypeWiFi) []Change {
+	return networkTypeWiFi.diff("", other, nil)
+}
+
+func (networkTypeWiFi *NetworkTypeWiFi) diff(path string, other *NetworkTypeWiFi, changes []Change) []Change {
+	if networkTypeWiFi == nil || other == nil {
+		if networkTypeWiFi != other {
+			changes = append(changes, Change{Path: path, Old: networkTypeWiFi, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (networkTypeWiFi *NetworkTypeWiFi) String() string {
+	return objectString(networkTypeWiFi)
+}
+
+// LogValue logs the object as its summary
+func (networkTypeWiFi *NetworkTypeWiFi) LogValue() slog.Value {
+	return slog.StringValue(objectString(networkTypeWiFi))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (networkTypeWiFi *NetworkTypeWiFi) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, networkTypeWiFi)
+}
+
+func (networkTypeWiFi *NetworkTypeWiFi) printText(p *printer) {
+	if networkTypeWiFi == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("NetworkTypeWiFi")
+	p.endObject(state)
+}
+
 // GetNetworkTypeEnum return the enum type of this object
 func (networkTypeWiFi *NetworkTypeWiFi) GetNetworkTypeEnum() NetworkTypeEnum {
 	return NetworkTypeWiFiType
 }
 
+// Accept calls the method of the visitor for NetworkTypeWiFi
+func (networkTypeWiFi *NetworkTypeWiFi) Accept(visitor NetworkTypeVisitor) {
+	visitor.VisitNetworkTypeWiFi(networkTypeWiFi)
+}
+
 // NetworkTypeOther A different network type (e.g., Ethernet network)
 type NetworkTypeOther struct {
 	tdCommon
@@ -175,7 +608,6 @@ func (networkTypeOther *NetworkTypeOther) MessageType() string {
 }
 
 // NewNetworkTypeOther creates a new NetworkTypeOther
-//
 func NewNetworkTypeOther() *NetworkTypeOther {
 	networkTypeOtherTemp := NetworkTypeOther{
 		tdCommon: tdCommon{Type: "networkTypeOther"},
@@ -184,7 +616,243 @@ func NewNetworkTypeOther() *NetworkTypeOther {
 	return &networkTypeOtherTemp
 }
 
+// MarshalJSON marshals to json
+func (networkTypeOther *NetworkTypeOther) MarshalJSON() ([]byte, error) {
+	return networkTypeOther.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (networkTypeOther *NetworkTypeOther) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, networkTypeOther)
+}
+
+func (networkTypeOther *NetworkTypeOther) appendJSON(b []byte) ([]byte, error) {
+	if networkTypeOther == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, networkTypeOther.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, networkTypeOther.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (networkTypeOther *NetworkTypeOther) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			networkTypeOther.tdCommon.Type, err = r.readString()
+		case "@extra":
+			networkTypeOther.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (networkTypeOther *NetworkTypeOther) Clone() *NetworkTypeOther {
+	if networkTypeOther == nil {
+		return nil
+	}
+	clone := *networkTypeOther
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (networkTypeOther *NetworkTypeOther) Equal(other *NetworkTypeOther) bool {
+	if networkTypeOther == nil || other == nil {
+		return networkTypeOther == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (networkTypeOther *NetworkTypeOther) Diff(other *NetworkTypeOther) []Change {
+	return networkTypeOther.diff("", other, nil)
+}
+
+func (networkTypeOther *NetworkTypeOther) diff(path string, other *NetworkTypeOther, changes []Change) []Change {
+	if networkTypeOther == nil || other == nil {
+		if networkTypeOther != other {
+			changes = append(changes, Change{Path: path, Old: networkTypeOther, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (networkTypeOther *NetworkTypeOther) String() string {
+	return objectString(networkTypeOther)
+}
+
+// LogValue logs the object as its summary
+func (networkTypeOther *NetworkTypeOther) LogValue() slog.Value {
+	return slog.StringValue(objectString(networkTypeOther))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (networkTypeOther *NetworkTypeOther) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, networkTypeOther)
+}
+
+func (networkTypeOther *NetworkTypeOther) printText(p *printer) {
+	if networkTypeOther == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("NetworkTypeOther")
+	p.endObject(state)
+}
+
 // GetNetworkTypeEnum return the enum type of this object
 func (networkTypeOther *NetworkTypeOther) GetNetworkTypeEnum() NetworkTypeEnum {
 	return NetworkTypeOtherType
 }
+
+// Accept calls the method of the visitor for NetworkTypeOther
+func (networkTypeOther *NetworkTypeOther) Accept(visitor NetworkTypeVisitor) {
+	visitor.VisitNetworkTypeOther(networkTypeOther)
+}
+
+// NetworkTypeVisitor visits every type of NetworkType, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to NetworkType, until they handle it too.
+type NetworkTypeVisitor interface {
+	VisitNetworkTypeNone(networkTypeNone *NetworkTypeNone)
+	VisitNetworkTypeMobile(networkTypeMobile *NetworkTypeMobile)
+	VisitNetworkTypeMobileRoaming(networkTypeMobileRoaming *NetworkTypeMobileRoaming)
+	VisitNetworkTypeWiFi(networkTypeWiFi *NetworkTypeWiFi)
+	VisitNetworkTypeOther(networkTypeOther *NetworkTypeOther)
+	VisitUnknownNetworkType(unknownNetworkType *UnknownNetworkType)
+}
+
+// NetworkTypeCases holds the function MatchNetworkType calls for each type of NetworkType;
+// types without a function are passed to the default case
+type NetworkTypeCases[R any] struct {
+	NetworkTypeNone          func(networkTypeNone *NetworkTypeNone) R
+	NetworkTypeMobile        func(networkTypeMobile *NetworkTypeMobile) R
+	NetworkTypeMobileRoaming func(networkTypeMobileRoaming *NetworkTypeMobileRoaming) R
+	NetworkTypeWiFi          func(networkTypeWiFi *NetworkTypeWiFi) R
+	NetworkTypeOther         func(networkTypeOther *NetworkTypeOther) R
+}
+
+// MatchNetworkType calls the case of the type of networkType, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement NetworkTypeVisitor
+// to have the compiler check every type is handled.
+func MatchNetworkType[R any](networkType NetworkType, cases NetworkTypeCases[R], defaultCase func(networkType NetworkType) R) R {
+	switch value := networkType.(type) {
+	case *NetworkTypeNone:
+		if cases.NetworkTypeNone != nil {
+			return cases.NetworkTypeNone(value)
+		}
+	case *NetworkTypeMobile:
+		if cases.NetworkTypeMobile != nil {
+			return cases.NetworkTypeMobile(value)
+		}
+	case *NetworkTypeMobileRoaming:
+		if cases.NetworkTypeMobileRoaming != nil {
+			return cases.NetworkTypeMobileRoaming(value)
+		}
+	case *NetworkTypeWiFi:
+		if cases.NetworkTypeWiFi != nil {
+			return cases.NetworkTypeWiFi(value)
+		}
+	case *NetworkTypeOther:
+		if cases.NetworkTypeOther != nil {
+			return cases.NetworkTypeOther(value)
+		}
+	}
+	return defaultCase(networkType)
+}
+
+// Clone returns a deep copy of the object
+func (unknownNetworkType *UnknownNetworkType) Clone() *UnknownNetworkType {
+	if unknownNetworkType == nil {
+		return nil
+	}
+	return &UnknownNetworkType{Unknown: unknownNetworkType.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownNetworkType *UnknownNetworkType) Equal(other *UnknownNetworkType) bool {
+	if unknownNetworkType == nil || other == nil {
+		return unknownNetworkType == other
+	}
+	return unknownNetworkType.Unknown.equal(other.Unknown)
+}
+
+func cloneNetworkType(networkType NetworkType) NetworkType {
+	switch value := networkType.(type) {
+	case *NetworkTypeNone:
+		return value.Clone()
+	case *NetworkTypeMobile:
+		return value.Clone()
+	case *NetworkTypeMobileRoaming:
+		return value.Clone()
+	case *NetworkTypeWiFi:
+		return value.Clone()
+	case *NetworkTypeOther:
+		return value.Clone()
+	case *UnknownNetworkType:
+		return value.Clone()
+	}
+	return networkType
+}
+
+func equalNetworkType(a NetworkType, b NetworkType) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *NetworkTypeNone:
+		b, ok := b.(*NetworkTypeNone)
+		return ok && a.Equal(b)
+	case *NetworkTypeMobile:
+		b, ok := b.(*NetworkTypeMobile)
+		return ok && a.Equal(b)
+	case *NetworkTypeMobileRoaming:
+		b, ok := b.(*NetworkTypeMobileRoaming)
+		return ok && a.Equal(b)
+	case *NetworkTypeWiFi:
+		b, ok := b.(*NetworkTypeWiFi)
+		return ok && a.Equal(b)
+	case *NetworkTypeOther:
+		b, ok := b.(*NetworkTypeOther)
+		return ok && a.Equal(b)
+	case *UnknownNetworkType:
+		b, ok := b.(*UnknownNetworkType)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffNetworkType(path string, a NetworkType, b NetworkType, changes []Change) []Change {
+	switch a := a.(type) {
+	case *NetworkTypeNone:
+		if b, ok := b.(*NetworkTypeNone); ok {
+			return a.diff(path, b, changes)
+		}
+	case *NetworkTypeMobile:
+		if b, ok := b.(*NetworkTypeMobile); ok {
+			return a.diff(path, b, changes)
+		}
+	case *NetworkTypeMobileRoaming:
+		if b, ok := b.(*NetworkTypeMobileRoaming); ok {
+			return a.diff(path, b, changes)
+		}
+	case *NetworkTypeWiFi:
+		if b, ok := b.(*NetworkTypeWiFi); ok {
+			return a.diff(path, b, changes)
+		}
+	case *NetworkTypeOther:
+		if b, ok := b.(*NetworkTypeOther); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalNetworkType(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // Notification Contains information about a notification
@@ -36,32 +37,140 @@ func NewNotification(id int32, date int32, isSilent bool, typeParam Notification
 	return &notificationTemp
 }
 
+// MarshalJSON marshals to json
+func (notification *Notification) MarshalJSON() ([]byte, error) {
+	return notification.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (notification *Notification) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, notification)
+}
+
+func (notification *Notification) appendJSON(b []byte) ([]byte, error) {
+	if notification == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-		Id       int32 `json:"id"`        // Unique persistent identifier of this notification
-		Date     int32 `json:"date"`      // Notification date
-		IsSilent bool  `json:"is_silent"` // True, if the notification was initially silent
-
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, notification.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, notification.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt(b, int64(notification.Id))
+	b = append(b, `,"date":`...)
+	b = appendJSONInt(b, int64(notification.Date))
+	b = append(b, `,"is_silent":`...)
+	b = appendJSONBool(b, notification.IsSilent)
+	b = append(b, `,"type":`...)
+	b, err = appendJSONValue(b, notification.Type)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return append(b, '}'), nil
+}
+
+func (notification *Notification) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			notification.tdCommon.Type, err = r.readString()
+		case "@extra":
+			notification.tdCommon.Extra, err = r.readString()
+		case "id":
+			notification.Id, err = r.readInt32()
+		case "date":
+			notification.Date, err = r.readInt32()
+		case "is_silent":
+			notification.IsSilent, err = r.readBool()
+		case "type":
+			notification.Type, err = decodeNotificationType(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	notification.tdCommon = tempObj.tdCommon
-	notification.Id = tempObj.Id
-	notification.Date = tempObj.Date
-	notification.IsSilent = tempObj.IsSilent
+// Clone returns a deep copy of the object
+func (notification *Notification) Clone() *Notification {
+	if notification == nil {
+		return nil
+	}
+	clone := *notification
+	clone.Type = cloneNotificationType(notification.Type)
+	return &clone
+}
 
-	fieldType, _ := unmarshalNotificationType(objMap["type"])
-	notification.Type = fieldType
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (notification *Notification) Equal(other *Notification) bool {
+	if notification == nil || other == nil {
+		return notification == other
+	}
+	if notification.Id != other.Id {
+		return false
+	}
+	if notification.Date != other.Date {
+		return false
+	}
+	if notification.IsSilent != other.IsSilent {
+		return false
+	}
+	if !equalNotificationType(notification.Type, other.Type) {
+		return false
+	}
+	return true
+}
 
-	return nil
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (notification *Notification) Diff(other *Notification) []Change {
+	return notification.diff("", other, nil)
+}
+
+func (notification *Notification) diff(path string, other *Notification, changes []Change) []Change {
+	if notification == nil || other == nil {
+		if notification != other {
+			changes = append(changes, Change{Path: path, Old: notification, New: other})
+		}
+		return changes
+	}
+	if notification.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: notification.Id, New: other.Id})
+	}
+	if notification.Date != other.Date {
+		changes = append(changes, Change{Path: fieldPath(path, "date"), Old: notification.Date, New: other.Date})
+	}
+	if notification.IsSilent != other.IsSilent {
+		changes = append(changes, Change{Path: fieldPath(path, "is_silent"), Old: notification.IsSilent, New: other.IsSilent})
+	}
+	changes = diffNotificationType(fieldPath(path, "type"), notification.Type, other.Type, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (notification *Notification) String() string {
+	return objectString(notification)
+}
+
+// LogValue logs the object as its summary
+func (notification *Notification) LogValue() slog.Value {
+	return slog.StringValue(objectString(notification))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (notification *Notification) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, notification)
+}
+
+func (notification *Notification) printText(p *printer) {
+	if notification == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Notification")
+	p.int("id", int64(notification.Id))
+	p.int("date", int64(notification.Date))
+	p.bool("is_silent", notification.IsSilent)
+	p.object("type", notification.Type)
+	p.endObject(state)
 }
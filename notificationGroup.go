@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // NotificationGroup Describes a group of notifications
@@ -39,33 +40,192 @@ func NewNotificationGroup(id int32, typeParam NotificationGroupType, chatId int6
 	return &notificationGroupTemp
 }
 
+// MarshalJSON marshals to json
+func (notificationGroup *NotificationGroup) MarshalJSON() ([]byte, error) {
+	return notificationGroup.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (notificationGroup *NotificationGroup) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Id            int32          `json:"id"`            // Unique persistent auto-incremented from 1 identifier of the notification group
-		ChatId        int64          `json:"chat_id"`       // Identifier of a chat to which all notifications in the group belong
-		TotalCount    int32          `json:"total_count"`   // Total number of active notifications in the group
-		Notifications []Notification `json:"notifications"` // The list of active notifications
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	return unmarshalFast(b, notificationGroup)
+}
+
+func (notificationGroup *NotificationGroup) appendJSON(b []byte) ([]byte, error) {
+	if notificationGroup == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, notificationGroup.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, notificationGroup.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt(b, int64(notificationGroup.Id))
+	b = append(b, `,"type":`...)
+	b, err = appendJSONValue(b, notificationGroup.Type)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(notificationGroup.ChatId))
+	b = append(b, `,"total_count":`...)
+	b = appendJSONInt(b, int64(notificationGroup.TotalCount))
+	b = append(b, `,"notifications":`...)
+	if notificationGroup.Notifications == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range notificationGroup.Notifications {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = notificationGroup.Notifications[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
 	}
+	return append(b, '}'), nil
+}
 
-	notificationGroup.tdCommon = tempObj.tdCommon
-	notificationGroup.Id = tempObj.Id
-	notificationGroup.ChatId = tempObj.ChatId
-	notificationGroup.TotalCount = tempObj.TotalCount
-	notificationGroup.Notifications = tempObj.Notifications
+func (notificationGroup *NotificationGroup) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			notificationGroup.tdCommon.Type, err = r.readString()
+		case "@extra":
+			notificationGroup.tdCommon.Extra, err = r.readString()
+		case "id":
+			notificationGroup.Id, err = r.readInt32()
+		case "type":
+			notificationGroup.Type, err = decodeNotificationGroupType(r)
+		case "chat_id":
+			notificationGroup.ChatId, err = r.readInt64()
+		case "total_count":
+			notificationGroup.TotalCount, err = r.readInt32()
+		case "notifications":
+			if r.readNull() {
+				notificationGroup.Notifications = nil
+			} else {
+				notificationGroup.Notifications = make([]Notification, 0)
+				err = r.readArray(func() (err error) {
+					var item0 Notification
+					err = item0.decodeJSON(r)
+					notificationGroup.Notifications = append(notificationGroup.Notifications, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	fieldType, _ := unmarshalNotificationGroupType(objMap["type"])
-	notificationGroup.Type = fieldType
+// Clone returns a deep copy of the object
+func (notificationGroup *NotificationGroup) Clone() *NotificationGroup {
+	if notificationGroup == nil {
+		return nil
+	}
+	clone := *notificationGroup
+	clone.Type = cloneNotificationGroupType(notificationGroup.Type)
+	if notificationGroup.Notifications != nil {
+		clone.Notifications = make([]Notification, len(notificationGroup.Notifications))
+		for i0 := range notificationGroup.Notifications {
+			clone.Notifications[i0] = *notificationGroup.Notifications[i0].Clone()
+		}
+	}
+	return &clone
+}
 
-	return nil
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (notificationGroup *NotificationGroup) Equal(other *NotificationGroup) bool {
+	if notificationGroup == nil || other == nil {
+		return notificationGroup == other
+	}
+	if notificationGroup.Id != other.Id {
+		return false
+	}
+	if !equalNotificationGroupType(notificationGroup.Type, other.Type) {
+		return false
+	}
+	if notificationGroup.ChatId != other.ChatId {
+		return false
+	}
+	if notificationGroup.TotalCount != other.TotalCount {
+		return false
+	}
+	if len(notificationGroup.Notifications) != len(other.Notifications) {
+		return false
+	}
+	for i0 := range notificationGroup.Notifications {
+		if !notificationGroup.Notifications[i0].Equal(&other.Notifications[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (notificationGroup *NotificationGroup) Diff(other *NotificationGroup) []Change {
+	return notificationGroup.diff("", other, nil)
+}
+
+func (notificationGroup *NotificationGroup) diff(path string, other *NotificationGroup, changes []Change) []Change {
+	if notificationGroup == nil || other == nil {
+		if notificationGroup != other {
+			changes = append(changes, Change{Path: path, Old: notificationGroup, New: other})
+		}
+		return changes
+	}
+	if notificationGroup.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: notificationGroup.Id, New: other.Id})
+	}
+	changes = diffNotificationGroupType(fieldPath(path, "type"), notificationGroup.Type, other.Type, changes)
+	if notificationGroup.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: notificationGroup.ChatId, New: other.ChatId})
+	}
+	if notificationGroup.TotalCount != other.TotalCount {
+		changes = append(changes, Change{Path: fieldPath(path, "total_count"), Old: notificationGroup.TotalCount, New: other.TotalCount})
+	}
+	if len(notificationGroup.Notifications) != len(other.Notifications) {
+		changes = append(changes, Change{Path: fieldPath(path, "notifications"), Old: notificationGroup.Notifications, New: other.Notifications})
+	} else {
+		for i0 := range notificationGroup.Notifications {
+			changes = notificationGroup.Notifications[i0].diff(indexPath(fieldPath(path, "notifications"), i0), &other.Notifications[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (notificationGroup *NotificationGroup) String() string {
+	return objectString(notificationGroup)
+}
+
+// LogValue logs the object as its summary
+func (notificationGroup *NotificationGroup) LogValue() slog.Value {
+	return slog.StringValue(objectString(notificationGroup))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (notificationGroup *NotificationGroup) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, notificationGroup)
+}
+
+func (notificationGroup *NotificationGroup) printText(p *printer) {
+	if notificationGroup == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("NotificationGroup")
+	p.int("id", int64(notificationGroup.Id))
+	p.object("type", notificationGroup.Type)
+	p.int("chat_id", int64(notificationGroup.ChatId))
+	p.int("total_count", int64(notificationGroup.TotalCount))
+	p.vector("notifications", len(notificationGroup.Notifications), func(i0 int) {
+		notificationGroup.Notifications[i0].printText(p)
+	})
+	p.endObject(state)
 }
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // NotificationGroupType Describes the type of notifications in a notification group
 type NotificationGroupType interface {
 	GetNotificationGroupTypeEnum() NotificationGroupTypeEnum
+	Accept(visitor NotificationGroupTypeVisitor)
 }
 
 // NotificationGroupTypeEnum Alias for abstract NotificationGroupType 'Sub-Classes', used as constant-enum here
@@ -21,41 +23,92 @@ const (
 	NotificationGroupTypeCallsType      NotificationGroupTypeEnum = "notificationGroupTypeCalls"
 )
 
+// UnknownNotificationGroupType is a NotificationGroupType of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownNotificationGroupType struct {
+	Unknown
+}
+
+// GetNotificationGroupTypeEnum return the enum type of this object
+func (unknownNotificationGroupType *UnknownNotificationGroupType) GetNotificationGroupTypeEnum() NotificationGroupTypeEnum {
+	return NotificationGroupTypeEnum(unknownNotificationGroupType.Type)
+}
+
+// Accept calls the method of the visitor for UnknownNotificationGroupType
+func (unknownNotificationGroupType *UnknownNotificationGroupType) Accept(visitor NotificationGroupTypeVisitor) {
+	visitor.VisitUnknownNotificationGroupType(unknownNotificationGroupType)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownNotificationGroupType *UnknownNotificationGroupType) String() string {
+	return objectString(unknownNotificationGroupType)
+}
+
+// LogValue logs the object as its summary
+func (unknownNotificationGroupType *UnknownNotificationGroupType) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownNotificationGroupType))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownNotificationGroupType *UnknownNotificationGroupType) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownNotificationGroupType)
+}
+
+func (unknownNotificationGroupType *UnknownNotificationGroupType) printText(p *printer) {
+	if unknownNotificationGroupType == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownNotificationGroupType")
+	p.string("@type", unknownNotificationGroupType.Type)
+	p.endObject(state)
+}
+
 func unmarshalNotificationGroupType(rawMsg *json.RawMessage) (NotificationGroupType, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch NotificationGroupTypeEnum(objMap["@type"].(string)) {
+	switch NotificationGroupTypeEnum(typeName) {
 	case NotificationGroupTypeMessagesType:
 		var notificationGroupTypeMessages NotificationGroupTypeMessages
-		err := json.Unmarshal(*rawMsg, &notificationGroupTypeMessages)
+		err := jsonUnmarshal(*rawMsg, &notificationGroupTypeMessages)
 		return &notificationGroupTypeMessages, err
 
 	case NotificationGroupTypeMentionsType:
 		var notificationGroupTypeMentions NotificationGroupTypeMentions
-		err := json.Unmarshal(*rawMsg, &notificationGroupTypeMentions)
+		err := jsonUnmarshal(*rawMsg, &notificationGroupTypeMentions)
 		return &notificationGroupTypeMentions, err
 
 	case NotificationGroupTypeSecretChatType:
 		var notificationGroupTypeSecretChat NotificationGroupTypeSecretChat
-		err := json.Unmarshal(*rawMsg, &notificationGroupTypeSecretChat)
+		err := jsonUnmarshal(*rawMsg, &notificationGroupTypeSecretChat)
 		return &notificationGroupTypeSecretChat, err
 
 	case NotificationGroupTypeCallsType:
 		var notificationGroupTypeCalls NotificationGroupTypeCalls
-		err := json.Unmarshal(*rawMsg, &notificationGroupTypeCalls)
+		err := jsonUnmarshal(*rawMsg, &notificationGroupTypeCalls)
 		return &notificationGroupTypeCalls, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownNotificationGroupType{Unknown: unknown}, nil
+	}
+}
+
+func decodeNotificationGroupType(r *jsonReader) (NotificationGroupType, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
 	}
+	return unmarshalNotificationGroupType(rawMsg)
 }
 
 // NotificationGroupTypeMessages A group containing notifications of type notificationTypeNewMessage and notificationTypeNewPushMessage with ordinary unread messages
@@ -69,7 +122,6 @@ func (notificationGroupTypeMessages *NotificationGroupTypeMessages) MessageType(
 }
 
 // NewNotificationGroupTypeMessages creates a new NotificationGroupTypeMessages
-//
 func NewNotificationGroupTypeMessages() *NotificationGroupTypeMessages {
 	notificationGroupTypeMessagesTemp := NotificationGroupTypeMessages{
 		tdCommon: tdCommon{Type: "notificationGroupTypeMessages"},
@@ -78,11 +130,107 @@ func NewNotificationGroupTypeMessages() *NotificationGroupTypeMessages {
 	return &notificationGroupTypeMessagesTemp
 }
 
+// MarshalJSON marshals to json
+func (notificationGroupTypeMessages *NotificationGroupTypeMessages) MarshalJSON() ([]byte, error) {
+	return notificationGroupTypeMessages.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (notificationGroupTypeMessages *NotificationGroupTypeMessages) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, notificationGroupTypeMessages)
+}
+
+func (notificationGroupTypeMessages *NotificationGroupTypeMessages) appendJSON(b []byte) ([]byte, error) {
+	if notificationGroupTypeMessages == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, notificationGroupTypeMessages.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, notificationGroupTypeMessages.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (notificationGroupTypeMessages *NotificationGroupTypeMessages) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			notificationGroupTypeMessages.tdCommon.Type, err = r.readString()
+		case "@extra":
+			notificationGroupTypeMessages.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (notificationGroupTypeMessages *NotificationGroupTypeMessages) Clone() *NotificationGroupTypeMessages {
+	if notificationGroupTypeMessages == nil {
+		return nil
+	}
+	clone := *notificationGroupTypeMessages
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (notificationGroupTypeMessages *NotificationGroupTypeMessages) Equal(other *NotificationGroupTypeMessages) bool {
+	if notificationGroupTypeMessages == nil || other == nil {
+		return notificationGroupTypeMessages == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (notificationGroupTypeMessages *NotificationGroupTypeMessages) Diff(other *NotificationGroupTypeMessages) []Change {
+	return notificationGroupTypeMessages.diff("", other, nil)
+}
+
+func (notificationGroupTypeMessages *NotificationGroupTypeMessages) diff(path string, other *NotificationGroupTypeMessages, changes []Change) []Change {
+	if notificationGroupTypeMessages == nil || other == nil {
+		if notificationGroupTypeMessages != other {
+			changes = append(changes, Change{Path: path, Old: notificationGroupTypeMessages, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (notificationGroupTypeMessages *NotificationGroupTypeMessages) String() string {
+	return objectString(notificationGroupTypeMessages)
+}
+
+// LogValue logs the object as its summary
+func (notificationGroupTypeMessages *NotificationGroupTypeMessages) LogValue() slog.Value {
+	return slog.StringValue(objectString(notificationGroupTypeMessages))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (notificationGroupTypeMessages *NotificationGroupTypeMessages) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, notificationGroupTypeMessages)
+}
+
+func (notificationGroupTypeMessages *NotificationGroupTypeMessages) printText(p *printer) {
+	if notificationGroupTypeMessages == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("NotificationGroupTypeMessages")
+	p.endObject(state)
+}
+
 // GetNotificationGroupTypeEnum return the enum type of this object
 func (notificationGroupTypeMessages *NotificationGroupTypeMessages) GetNotificationGroupTypeEnum() NotificationGroupTypeEnum {
 	return NotificationGroupTypeMessagesType
 }
 
+// Accept calls the method of the visitor for NotificationGroupTypeMessages
+func (notificationGroupTypeMessages *NotificationGroupTypeMessages) Accept(visitor NotificationGroupTypeVisitor) {
+	visitor.VisitNotificationGroupTypeMessages(notificationGroupTypeMessages)
+}
+
 // NotificationGroupTypeMentions A group containing notifications of type notificationTypeNewMessage and notificationTypeNewPushMessage with unread mentions of the current user, replies to their messages, or a pinned message
 type NotificationGroupTypeMentions struct {
 	tdCommon
@@ -94,7 +242,6 @@ func (notificationGroupTypeMentions *NotificationGroupTypeMentions) MessageType(
 }
 
 // NewNotificationGroupTypeMentions creates a new NotificationGroupTypeMentions
-//
 func NewNotificationGroupTypeMentions() *NotificationGroupTypeMentions {
 	notificationGroupTypeMentionsTemp := NotificationGroupTypeMentions{
 		tdCommon: tdCommon{Type: "notificationGroupTypeMentions"},
@@ -103,11 +250,107 @@ func NewNotificationGroupTypeMentions() *NotificationGroupTypeMentions {
 	return &notificationGroupTypeMentionsTemp
 }
 
+// MarshalJSON marshals to json
+func (notificationGroupTypeMentions *NotificationGroupTypeMentions) MarshalJSON() ([]byte, error) {
+	return notificationGroupTypeMentions.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (notificationGroupTypeMentions *NotificationGroupTypeMentions) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, notificationGroupTypeMentions)
+}
+
+func (notificationGroupTypeMentions *NotificationGroupTypeMentions) appendJSON(b []byte) ([]byte, error) {
+	if notificationGroupTypeMentions == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, notificationGroupTypeMentions.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, notificationGroupTypeMentions.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (notificationGroupTypeMentions *NotificationGroupTypeMentions) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			notificationGroupTypeMentions.tdCommon.Type, err = r.readString()
+		case "@extra":
+			notificationGroupTypeMentions.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (notificationGroupTypeMentions *NotificationGroupTypeMentions) Clone() *NotificationGroupTypeMentions {
+	if notificationGroupTypeMentions == nil {
+		return nil
+	}
+	clone := *notificationGroupTypeMentions
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (notificationGroupTypeMentions *NotificationGroupTypeMentions) Equal(other *NotificationGroupTypeMentions) bool {
+	if notificationGroupTypeMentions == nil || other == nil {
+		return notificationGroupTypeMentions == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (notificationGroupTypeMentions *NotificationGroupTypeMentions) Diff(other *NotificationGroupTypeMentions) []Change {
+	return notificationGroupTypeMentions.diff("", other, nil)
+}
+
+func (notificationGroupTypeMentions *NotificationGroupTypeMentions) diff(path string, other *NotificationGroupTypeMentions, changes []Change) []Change {
+	if notificationGroupTypeMentions == nil || other == nil {
+		if notificationGroupTypeMentions != other {
+			changes = append(changes, Change{Path: path, Old: notificationGroupTypeMentions, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (notificationGroupTypeMentions *NotificationGroupTypeMentions) String() string {
+	return objectString(notificationGroupTypeMentions)
+}
+
+// LogValue logs the object as its summary
+func (notificationGroupTypeMentions *NotificationGroupTypeMentions) LogValue() slog.Value {
+	return slog.StringValue(objectString(notificationGroupTypeMentions))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (notificationGroupTypeMentions *NotificationGroupTypeMentions) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, notificationGroupTypeMentions)
+}
+
+func (notificationGroupTypeMentions *NotificationGroupTypeMentions) printText(p *printer) {
+	if notificationGroupTypeMentions == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("NotificationGroupTypeMentions")
+	p.endObject(state)
+}
+
 // GetNotificationGroupTypeEnum return the enum type of this object
 func (notificationGroupTypeMentions *NotificationGroupTypeMentions) GetNotificationGroupTypeEnum() NotificationGroupTypeEnum {
 	return NotificationGroupTypeMentionsType
 }
 
+// Accept calls the method of the visitor for NotificationGroupTypeMentions
+func (notificationGroupTypeMentions *NotificationGroupTypeMentions) Accept(visitor NotificationGroupTypeVisitor) {
+	visitor.VisitNotificationGroupTypeMentions(notificationGroupTypeMentions)
+}
+
 // NotificationGroupTypeSecretChat A group containing a notification of type notificationTypeNewSecretChat
 type NotificationGroupTypeSecretChat struct {
 	tdCommon
@@ -119,7 +362,6 @@ func (notificationGroupTypeSecretChat *NotificationGroupTypeSecretChat) MessageT
 }
 
 // NewNotificationGroupTypeSecretChat creates a new NotificationGroupTypeSecretChat
-//
 func NewNotificationGroupTypeSecretChat() *NotificationGroupTypeSecretChat {
 	notificationGroupTypeSecretChatTemp := NotificationGroupTypeSecretChat{
 		tdCommon: tdCommon{Type: "notificationGroupTypeSecretChat"},
@@ -128,11 +370,107 @@ func NewNotificationGroupTypeSecretChat() *NotificationGroupTypeSecretChat {
 	return &notificationGroupTypeSecretChatTemp
 }
 
+// MarshalJSON marshals to json
+func (notificationGroupTypeSecretChat *NotificationGroupTypeSecretChat) MarshalJSON() ([]byte, error) {
+	return notificationGroupTypeSecretChat.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (notificationGroupTypeSecretChat *NotificationGroupTypeSecretChat) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, notificationGroupTypeSecretChat)
+}
+
+func (notificationGroupTypeSecretChat *NotificationGroupTypeSecretChat) appendJSON(b []byte) ([]byte, error) {
+	if notificationGroupTypeSecretChat == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, notificationGroupTypeSecretChat.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, notificationGroupTypeSecretChat.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (notificationGroupTypeSecretChat *NotificationGroupTypeSecretChat) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			notificationGroupTypeSecretChat.tdCommon.Type, err = r.readString()
+		case "@extra":
+			notificationGroupTypeSecretChat.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (notificationGroupTypeSecretChat *NotificationGroupTypeSecretChat) Clone() *NotificationGroupTypeSecretChat {
+	if notificationGroupTypeSecretChat == nil {
+		return nil
+	}
+	clone := *notificationGroupTypeSecretChat
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (notificationGroupTypeSecretChat *NotificationGroupTypeSecretChat) Equal(other *NotificationGroupTypeSecretChat) bool {
+	if notificationGroupTypeSecretChat == nil || other == nil {
+		return notificationGroupTypeSecretChat == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (notificationGroupTypeSecretChat *NotificationGroupTypeSecretChat) Diff(other *NotificationGroupTypeSecretChat) []Change {
+	return notificationGroupTypeSecretChat.diff("", other, nil)
+}
+
+func (notificationGroupTypeSecretChat *NotificationGroupTypeSecretChat) diff(path string, other *NotificationGroupTypeSecretChat, changes []Change) []Change {
+	if notificationGroupTypeSecretChat == nil || other == nil {
+		if notificationGroupTypeSecretChat != other {
+			changes = append(changes, Change{Path: path, Old: notificationGroupTypeSecretChat, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (notificationGroupTypeSecretChat *NotificationGroupTypeSecretChat) String() string {
+	return objectString(notificationGroupTypeSecretChat)
+}
+
+// LogValue logs the object as its summary
+func (notificationGroupTypeSecretChat *NotificationGroupTypeSecretChat) LogValue() slog.Value {
+	return slog.StringValue(objectString(notificationGroupTypeSecretChat))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (notificationGroupTypeSecretChat *NotificationGroupTypeSecretChat) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, notificationGroupTypeSecretChat)
+}
+
+func (notificationGroupTypeSecretChat *NotificationGroupTypeSecretChat) printText(p *printer) {
+	if notificationGroupTypeSecretChat == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("NotificationGroupTypeSecretChat")
+	p.endObject(state)
+}
+
 // GetNotificationGroupTypeEnum return the enum type of this object
 func (notificationGroupTypeSecretChat *NotificationGroupTypeSecretChat) GetNotificationGroupTypeEnum() NotificationGroupTypeEnum {
 	return NotificationGroupTypeSecretChatType
 }
 
+// Accept calls the method of the visitor for NotificationGroupTypeSecretChat
+func (notificationGroupTypeSecretChat *NotificationGroupTypeSecretChat) Accept(visitor NotificationGroupTypeVisitor) {
+	visitor.VisitNotificationGroupTypeSecretChat(notificationGroupTypeSecretChat)
+}
+
 // NotificationGroupTypeCalls A group containing notifications of type notificationTypeNewCall
 type NotificationGroupTypeCalls struct {
 	tdCommon
@@ -144,7 +482,6 @@ func (notificationGroupTypeCalls *NotificationGroupTypeCalls) MessageType() stri
 }
 
 // NewNotificationGroupTypeCalls creates a new NotificationGroupTypeCalls
-//
 func NewNotificationGroupTypeCalls() *NotificationGroupTypeCalls {
 	notificationGroupTypeCallsTemp := NotificationGroupTypeCalls{
 		tdCommon: tdCommon{Type: "notificationGroupTypeCalls"},
@@ -153,7 +490,228 @@ func NewNotificationGroupTypeCalls() *NotificationGroupTypeCalls {
 	return &notificationGroupTypeCallsTemp
 }
 
+// MarshalJSON marshals to json
+func (notificationGroupTypeCalls *NotificationGroupTypeCalls) MarshalJSON() ([]byte, error) {
+	return notificationGroupTypeCalls.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (notificationGroupTypeCalls *NotificationGroupTypeCalls) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, notificationGroupTypeCalls)
+}
+
+func (notificationGroupTypeCalls *NotificationGroupTypeCalls) appendJSON(b []byte) ([]byte, error) {
+	if notificationGroupTypeCalls == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, notificationGroupTypeCalls.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, notificationGroupTypeCalls.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (notificationGroupTypeCalls *NotificationGroupTypeCalls) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			notificationGroupTypeCalls.tdCommon.Type, err = r.readString()
+		case "@extra":
+			notificationGroupTypeCalls.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (notificationGroupTypeCalls *NotificationGroupTypeCalls) Clone() *NotificationGroupTypeCalls {
+	if notificationGroupTypeCalls == nil {
+		return nil
+	}
+	clone := *notificationGroupTypeCalls
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (notificationGroupTypeCalls *NotificationGroupTypeCalls) Equal(other *NotificationGroupTypeCalls) bool {
+	if notificationGroupTypeCalls == nil || other == nil {
+		return notificationGroupTypeCalls == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (notificationGroupTypeCalls *NotificationGroupTypeCalls) Diff(other *NotificationGroupTypeCalls) []Change {
+	return notificationGroupTypeCalls.diff("", other, nil)
+}
+
+func (notificationGroupTypeCalls *NotificationGroupTypeCalls) diff(path string, other *NotificationGroupTypeCalls, changes []Change) []Change {
+	if notificationGroupTypeCalls == nil || other == nil {
+		if notificationGroupTypeCalls != other {
+			changes = append(changes, Change{Path: path, Old: notificationGroupTypeCalls, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (notificationGroupTypeCalls *NotificationGroupTypeCalls) String() string {
+	return objectString(notificationGroupTypeCalls)
+}
+
+// LogValue logs the object as its summary
+func (notificationGroupTypeCalls *NotificationGroupTypeCalls) LogValue() slog.Value {
+	return slog.StringValue(objectString(notificationGroupTypeCalls))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (notificationGroupTypeCalls *NotificationGroupTypeCalls) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, notificationGroupTypeCalls)
+}
+
+func (notificationGroupTypeCalls *NotificationGroupTypeCalls) printText(p *printer) {
+	if notificationGroupTypeCalls == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("NotificationGroupTypeCalls")
+	p.endObject(state)
+}
+
 // GetNotificationGroupTypeEnum return the enum type of this object
 func (notificationGroupTypeCalls *NotificationGroupTypeCalls) GetNotificationGroupTypeEnum() NotificationGroupTypeEnum {
 	return NotificationGroupTypeCallsType
 }
+
+// Accept calls the method of the visitor for NotificationGroupTypeCalls
+func (notificationGroupTypeCalls *NotificationGroupTypeCalls) Accept(visitor NotificationGroupTypeVisitor) {
+	visitor.VisitNotificationGroupTypeCalls(notificationGroupTypeCalls)
+}
+
+// NotificationGroupTypeVisitor visits every type of NotificationGroupType, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to NotificationGroupType, until they handle it too.
+type NotificationGroupTypeVisitor interface {
+	VisitNotificationGroupTypeMessages(notificationGroupTypeMessages *NotificationGroupTypeMessages)
+	VisitNotificationGroupTypeMentions(notificationGroupTypeMentions *NotificationGroupTypeMentions)
+	VisitNotificationGroupTypeSecretChat(notificationGroupTypeSecretChat *NotificationGroupTypeSecretChat)
+	VisitNotificationGroupTypeCalls(notificationGroupTypeCalls *NotificationGroupTypeCalls)
+	VisitUnknownNotificationGroupType(unknownNotificationGroupType *UnknownNotificationGroupType)
+}
+
+// NotificationGroupTypeCases holds the function MatchNotificationGroupType calls for each type of NotificationGroupType;
+// types without a function are passed to the default case
+type NotificationGroupTypeCases[R any] struct {
+	NotificationGroupTypeMessages   func(notificationGroupTypeMessages *NotificationGroupTypeMessages) R
+	NotificationGroupTypeMentions   func(notificationGroupTypeMentions *NotificationGroupTypeMentions) R
+	NotificationGroupTypeSecretChat func(notificationGroupTypeSecretChat *NotificationGroupTypeSecretChat) R
+	NotificationGroupTypeCalls      func(notificationGroupTypeCalls *NotificationGroupTypeCalls) R
+}
+
+// MatchNotificationGroupType calls the case of the type of notificationGroupType, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement NotificationGroupTypeVisitor
+// to have the compiler check every type is handled.
+func MatchNotificationGroupType[R any](notificationGroupType NotificationGroupType, cases NotificationGroupTypeCases[R], defaultCase func(notificationGroupType NotificationGroupType) R) R {
+	switch value := notificationGroupType.(type) {
+	case *NotificationGroupTypeMessages:
+		if cases.NotificationGroupTypeMessages != nil {
+			return cases.NotificationGroupTypeMessages(value)
+		}
+	case *NotificationGroupTypeMentions:
+		if cases.NotificationGroupTypeMentions != nil {
+			return cases.NotificationGroupTypeMentions(value)
+		}
+	case *NotificationGroupTypeSecretChat:
+		if cases.NotificationGroupTypeSecretChat != nil {
+			return cases.NotificationGroupTypeSecretChat(value)
+		}
+	case *NotificationGroupTypeCalls:
+		if cases.NotificationGroupTypeCalls != nil {
+			return cases.NotificationGroupTypeCalls(value)
+		}
+	}
+	return defaultCase(notificationGroupType)
+}
+
+// Clone returns a deep copy of the object
+func (unknownNotificationGroupType *UnknownNotificationGroupType) Clone() *UnknownNotificationGroupType {
+	if unknownNotificationGroupType == nil {
+		return nil
+	}
+	return &UnknownNotificationGroupType{Unknown: unknownNotificationGroupType.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownNotificationGroupType *UnknownNotificationGroupType) Equal(other *UnknownNotificationGroupType) bool {
+	if unknownNotificationGroupType == nil || other == nil {
+		return unknownNotificationGroupType == other
+	}
+	return unknownNotificationGroupType.Unknown.equal(other.Unknown)
+}
+
+func cloneNotificationGroupType(notificationGroupType NotificationGroupType) NotificationGroupType {
+	switch value := notificationGroupType.(type) {
+	case *NotificationGroupTypeMessages:
+		return value.Clone()
+	case *NotificationGroupTypeMentions:
+		return value.Clone()
+	case *NotificationGroupTypeSecretChat:
+		return value.Clone()
+	case *NotificationGroupTypeCalls:
+		return value.Clone()
+	case *UnknownNotificationGroupType:
+		return value.Clone()
+	}
+	return notificationGroupType
+}
+
+func equalNotificationGroupType(a NotificationGroupType, b NotificationGroupType) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *NotificationGroupTypeMessages:
+		b, ok := b.(*NotificationGroupTypeMessages)
+		return ok && a.Equal(b)
+	case *NotificationGroupTypeMentions:
+		b, ok := b.(*NotificationGroupTypeMentions)
+		return ok && a.Equal(b)
+	case *NotificationGroupTypeSecretChat:
+		b, ok := b.(*NotificationGroupTypeSecretChat)
+		return ok && a.Equal(b)
+	case *NotificationGroupTypeCalls:
+		b, ok := b.(*NotificationGroupTypeCalls)
+		return ok && a.Equal(b)
+	case *UnknownNotificationGroupType:
+		b, ok := b.(*UnknownNotificationGroupType)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffNotificationGroupType(path string, a NotificationGroupType, b NotificationGroupType, changes []Change) []Change {
+	switch a := a.(type) {
+	case *NotificationGroupTypeMessages:
+		if b, ok := b.(*NotificationGroupTypeMessages); ok {
+			return a.diff(path, b, changes)
+		}
+	case *NotificationGroupTypeMentions:
+		if b, ok := b.(*NotificationGroupTypeMentions); ok {
+			return a.diff(path, b, changes)
+		}
+	case *NotificationGroupTypeSecretChat:
+		if b, ok := b.(*NotificationGroupTypeSecretChat); ok {
+			return a.diff(path, b, changes)
+		}
+	case *NotificationGroupTypeCalls:
+		if b, ok := b.(*NotificationGroupTypeCalls); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalNotificationGroupType(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
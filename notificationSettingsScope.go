@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // NotificationSettingsScope Describes the types of chats to which notification settings are relevant
 type NotificationSettingsScope interface {
 	GetNotificationSettingsScopeEnum() NotificationSettingsScopeEnum
+	Accept(visitor NotificationSettingsScopeVisitor)
 }
 
 // NotificationSettingsScopeEnum Alias for abstract NotificationSettingsScope 'Sub-Classes', used as constant-enum here
@@ -20,38 +22,89 @@ const (
 	NotificationSettingsScopeChannelChatsType NotificationSettingsScopeEnum = "notificationSettingsScopeChannelChats"
 )
 
+// UnknownNotificationSettingsScope is a NotificationSettingsScope of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownNotificationSettingsScope struct {
+	Unknown
+}
+
+// GetNotificationSettingsScopeEnum return the enum type of this object
+func (unknownNotificationSettingsScope *UnknownNotificationSettingsScope) GetNotificationSettingsScopeEnum() NotificationSettingsScopeEnum {
+	return NotificationSettingsScopeEnum(unknownNotificationSettingsScope.Type)
+}
+
+// Accept calls the method of the visitor for UnknownNotificationSettingsScope
+func (unknownNotificationSettingsScope *UnknownNotificationSettingsScope) Accept(visitor NotificationSettingsScopeVisitor) {
+	visitor.VisitUnknownNotificationSettingsScope(unknownNotificationSettingsScope)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownNotificationSettingsScope *UnknownNotificationSettingsScope) String() string {
+	return objectString(unknownNotificationSettingsScope)
+}
+
+// LogValue logs the object as its summary
+func (unknownNotificationSettingsScope *UnknownNotificationSettingsScope) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownNotificationSettingsScope))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownNotificationSettingsScope *UnknownNotificationSettingsScope) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownNotificationSettingsScope)
+}
+
+func (unknownNotificationSettingsScope *UnknownNotificationSettingsScope) printText(p *printer) {
+	if unknownNotificationSettingsScope == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownNotificationSettingsScope")
+	p.string("@type", unknownNotificationSettingsScope.Type)
+	p.endObject(state)
+}
+
 func unmarshalNotificationSettingsScope(rawMsg *json.RawMessage) (NotificationSettingsScope, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch NotificationSettingsScopeEnum(objMap["@type"].(string)) {
+	switch NotificationSettingsScopeEnum(typeName) {
 	case NotificationSettingsScopePrivateChatsType:
 		var notificationSettingsScopePrivateChats NotificationSettingsScopePrivateChats
-		err := json.Unmarshal(*rawMsg, &notificationSettingsScopePrivateChats)
+		err := jsonUnmarshal(*rawMsg, &notificationSettingsScopePrivateChats)
 		return &notificationSettingsScopePrivateChats, err
 
 	case NotificationSettingsScopeGroupChatsType:
 		var notificationSettingsScopeGroupChats NotificationSettingsScopeGroupChats
-		err := json.Unmarshal(*rawMsg, &notificationSettingsScopeGroupChats)
+		err := jsonUnmarshal(*rawMsg, &notificationSettingsScopeGroupChats)
 		return &notificationSettingsScopeGroupChats, err
 
 	case NotificationSettingsScopeChannelChatsType:
 		var notificationSettingsScopeChannelChats NotificationSettingsScopeChannelChats
-		err := json.Unmarshal(*rawMsg, &notificationSettingsScopeChannelChats)
+		err := jsonUnmarshal(*rawMsg, &notificationSettingsScopeChannelChats)
 		return &notificationSettingsScopeChannelChats, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownNotificationSettingsScope{Unknown: unknown}, nil
 	}
 }
 
+func decodeNotificationSettingsScope(r *jsonReader) (NotificationSettingsScope, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalNotificationSettingsScope(rawMsg)
+}
+
 // NotificationSettingsScopePrivateChats Notification settings applied to all private and secret chats when the corresponding chat setting has a default value
 type NotificationSettingsScopePrivateChats struct {
 	tdCommon
@@ -63,7 +116,6 @@ func (notificationSettingsScopePrivateChats *NotificationSettingsScopePrivateCha
 }
 
 // NewNotificationSettingsScopePrivateChats creates a new NotificationSettingsScopePrivateChats
-//
 func NewNotificationSettingsScopePrivateChats() *NotificationSettingsScopePrivateChats {
 	notificationSettingsScopePrivateChatsTemp := NotificationSettingsScopePrivateChats{
 		tdCommon: tdCommon{Type: "notificationSettingsScopePrivateChats"},
@@ -72,11 +124,107 @@ func NewNotificationSettingsScopePrivateChats() *NotificationSettingsScopePrivat
 	return &notificationSettingsScopePrivateChatsTemp
 }
 
+// MarshalJSON marshals to json
+func (notificationSettingsScopePrivateChats *NotificationSettingsScopePrivateChats) MarshalJSON() ([]byte, error) {
+	return notificationSettingsScopePrivateChats.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (notificationSettingsScopePrivateChats *NotificationSettingsScopePrivateChats) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, notificationSettingsScopePrivateChats)
+}
+
+func (notificationSettingsScopePrivateChats *NotificationSettingsScopePrivateChats) appendJSON(b []byte) ([]byte, error) {
+	if notificationSettingsScopePrivateChats == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, notificationSettingsScopePrivateChats.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, notificationSettingsScopePrivateChats.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (notificationSettingsScopePrivateChats *NotificationSettingsScopePrivateChats) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			notificationSettingsScopePrivateChats.tdCommon.Type, err = r.readString()
+		case "@extra":
+			notificationSettingsScopePrivateChats.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (notificationSettingsScopePrivateChats *NotificationSettingsScopePrivateChats) Clone() *NotificationSettingsScopePrivateChats {
+	if notificationSettingsScopePrivateChats == nil {
+		return nil
+	}
+	clone := *notificationSettingsScopePrivateChats
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (notificationSettingsScopePrivateChats *NotificationSettingsScopePrivateChats) Equal(other *NotificationSettingsScopePrivateChats) bool {
+	if notificationSettingsScopePrivateChats == nil || other == nil {
+		return notificationSettingsScopePrivateChats == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (notificationSettingsScopePrivateChats *NotificationSettingsScopePrivateChats) Diff(other *NotificationSettingsScopePrivateChats) []Change {
+	return notificationSettingsScopePrivateChats.diff("", other, nil)
+}
+
+func (notificationSettingsScopePrivateChats *NotificationSettingsScopePrivateChats) diff(path string, other *NotificationSettingsScopePrivateChats, changes []Change) []Change {
+	if notificationSettingsScopePrivateChats == nil || other == nil {
+		if notificationSettingsScopePrivateChats != other {
+			changes = append(changes, Change{Path: path, Old: notificationSettingsScopePrivateChats, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (notificationSettingsScopePrivateChats *NotificationSettingsScopePrivateChats) String() string {
+	return objectString(notificationSettingsScopePrivateChats)
+}
+
+// LogValue logs the object as its summary
+func (notificationSettingsScopePrivateChats *NotificationSettingsScopePrivateChats) LogValue() slog.Value {
+	return slog.StringValue(objectString(notificationSettingsScopePrivateChats))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (notificationSettingsScopePrivateChats *NotificationSettingsScopePrivateChats) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, notificationSettingsScopePrivateChats)
+}
+
+func (notificationSettingsScopePrivateChats *NotificationSettingsScopePrivateChats) printText(p *printer) {
+	if notificationSettingsScopePrivateChats == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("NotificationSettingsScopePrivateChats")
+	p.endObject(state)
+}
+
 // GetNotificationSettingsScopeEnum return the enum type of this object
 func (notificationSettingsScopePrivateChats *NotificationSettingsScopePrivateChats) GetNotificationSettingsScopeEnum() NotificationSettingsScopeEnum {
 	return NotificationSettingsScopePrivateChatsType
 }
 
+// Accept calls the method of the visitor for NotificationSettingsScopePrivateChats
+func (notificationSettingsScopePrivateChats *NotificationSettingsScopePrivateChats) Accept(visitor NotificationSettingsScopeVisitor) {
+	visitor.VisitNotificationSettingsScopePrivateChats(notificationSettingsScopePrivateChats)
+}
+
 // NotificationSettingsScopeGroupChats Notification settings applied to all basic groups and supergroups when the corresponding chat setting has a default value
 type NotificationSettingsScopeGroupChats struct {
 	tdCommon
@@ -88,7 +236,6 @@ func (notificationSettingsScopeGroupChats *NotificationSettingsScopeGroupChats)
 }
 
 // NewNotificationSettingsScopeGroupChats creates a new NotificationSettingsScopeGroupChats
-//
 func NewNotificationSettingsScopeGroupChats() *NotificationSettingsScopeGroupChats {
 	notificationSettingsScopeGroupChatsTemp := NotificationSettingsScopeGroupChats{
 		tdCommon: tdCommon{Type: "notificationSettingsScopeGroupChats"},
@@ -97,11 +244,107 @@ func NewNotificationSettingsScopeGroupChats() *NotificationSettingsScopeGroupCha
 	return &notificationSettingsScopeGroupChatsTemp
 }
 
+// MarshalJSON marshals to json
+func (notificationSettingsScopeGroupChats *NotificationSettingsScopeGroupChats) MarshalJSON() ([]byte, error) {
+	return notificationSettingsScopeGroupChats.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (notificationSettingsScopeGroupChats *NotificationSettingsScopeGroupChats) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, notificationSettingsScopeGroupChats)
+}
+
+func (notificationSettingsScopeGroupChats *NotificationSettingsScopeGroupChats) appendJSON(b []byte) ([]byte, error) {
+	if notificationSettingsScopeGroupChats == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, notificationSettingsScopeGroupChats.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, notificationSettingsScopeGroupChats.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (notificationSettingsScopeGroupChats *NotificationSettingsScopeGroupChats) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			notificationSettingsScopeGroupChats.tdCommon.Type, err = r.readString()
+		case "@extra":
+			notificationSettingsScopeGroupChats.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (notificationSettingsScopeGroupChats *NotificationSettingsScopeGroupChats) Clone() *NotificationSettingsScopeGroupChats {
+	if notificationSettingsScopeGroupChats == nil {
+		return nil
+	}
+	clone := *notificationSettingsScopeGroupChats
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (notificationSettingsScopeGroupChats *NotificationSettingsScopeGroupChats) Equal(other *NotificationSettingsScopeGroupChats) bool {
+	if notificationSettingsScopeGroupChats == nil || other == nil {
+		return notificationSettingsScopeGroupChats == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (notificationSettingsScopeGroupChats *NotificationSettingsScopeGroupChats) Diff(other *NotificationSettingsScopeGroupChats) []Change {
+	return notificationSettingsScopeGroupChats.diff("", other, nil)
+}
+
+func (notificationSettingsScopeGroupChats *NotificationSettingsScopeGroupChats) diff(path string, other *NotificationSettingsScopeGroupChats, changes []Change) []Change {
+	if notificationSettingsScopeGroupChats == nil || other == nil {
+		if notificationSettingsScopeGroupChats != other {
+			changes = append(changes, Change{Path: path, Old: notificationSettingsScopeGroupChats, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (notificationSettingsScopeGroupChats *NotificationSettingsScopeGroupChats) String() string {
+	return objectString(notificationSettingsScopeGroupChats)
+}
+
+// LogValue logs the object as its summary
+func (notificationSettingsScopeGroupChats *NotificationSettingsScopeGroupChats) LogValue() slog.Value {
+	return slog.StringValue(objectString(notificationSettingsScopeGroupChats))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (notificationSettingsScopeGroupChats *NotificationSettingsScopeGroupChats) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, notificationSettingsScopeGroupChats)
+}
+
+func (notificationSettingsScopeGroupChats *NotificationSettingsScopeGroupChats) printText(p *printer) {
+	if notificationSettingsScopeGroupChats == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("NotificationSettingsScopeGroupChats")
+	p.endObject(state)
+}
+
 // GetNotificationSettingsScopeEnum return the enum type of this object
 func (notificationSettingsScopeGroupChats *NotificationSettingsScopeGroupChats) GetNotificationSettingsScopeEnum() NotificationSettingsScopeEnum {
 	return NotificationSettingsScopeGroupChatsType
 }
 
+// Accept calls the method of the visitor for NotificationSettingsScopeGroupChats
+func (notificationSettingsScopeGroupChats *NotificationSettingsScopeGroupChats) Accept(visitor NotificationSettingsScopeVisitor) {
+	visitor.VisitNotificationSettingsScopeGroupChats(notificationSettingsScopeGroupChats)
+}
+
 // NotificationSettingsScopeChannelChats Notification settings applied to all channels when the corresponding chat setting has a default value
 type NotificationSettingsScopeChannelChats struct {
 	tdCommon
@@ -113,7 +356,6 @@ func (notificationSettingsScopeChannelChats *NotificationSettingsScopeChannelCha
 }
 
 // NewNotificationSettingsScopeChannelChats creates a new NotificationSettingsScopeChannelChats
-//
 func NewNotificationSettingsScopeChannelChats() *NotificationSettingsScopeChannelChats {
 	notificationSettingsScopeChannelChatsTemp := NotificationSettingsScopeChannelChats{
 		tdCommon: tdCommon{Type: "notificationSettingsScopeChannelChats"},
@@ -122,7 +364,213 @@ func NewNotificationSettingsScopeChannelChats() *NotificationSettingsScopeChanne
 	return &notificationSettingsScopeChannelChatsTemp
 }
 
+// MarshalJSON marshals to json
+func (notificationSettingsScopeChannelChats *NotificationSettingsScopeChannelChats) MarshalJSON() ([]byte, error) {
+	return notificationSettingsScopeChannelChats.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (notificationSettingsScopeChannelChats *NotificationSettingsScopeChannelChats) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, notificationSettingsScopeChannelChats)
+}
+
+func (notificationSettingsScopeChannelChats *NotificationSettingsScopeChannelChats) appendJSON(b []byte) ([]byte, error) {
+	if notificationSettingsScopeChannelChats == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, notificationSettingsScopeChannelChats.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, notificationSettingsScopeChannelChats.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (notificationSettingsScopeChannelChats *NotificationSettingsScopeChannelChats) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			notificationSettingsScopeChannelChats.tdCommon.Type, err = r.readString()
+		case "@extra":
+			notificationSettingsScopeChannelChats.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (notificationSettingsScopeChannelChats *NotificationSettingsScopeChannelChats) Clone() *NotificationSettingsScopeChannelChats {
+	if notificationSettingsScopeChannelChats == nil {
+		return nil
+	}
+	clone := *notificationSettingsScopeChannelChats
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (notificationSettingsScopeChannelChats *NotificationSettingsScopeChannelChats) Equal(other *NotificationSettingsScopeChannelChats) bool {
+	if notificationSettingsScopeChannelChats == nil || other == nil {
+		return notificationSettingsScopeChannelChats == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (notificationSettingsScopeChannelChats *NotificationSettingsScopeChannelChats) Diff(other *NotificationSettingsScopeChannelChats) []Change {
+	return notificationSettingsScopeChannelChats.diff("", other, nil)
+}
+
+func (notificationSettingsScopeChannelChats *NotificationSettingsScopeChannelChats) diff(path string, other *NotificationSettingsScopeChannelChats, changes []Change) []Change {
+	if notificationSettingsScopeChannelChats == nil || other == nil {
+		if notificationSettingsScopeChannelChats != other {
+			changes = append(changes, Change{Path: path, Old: notificationSettingsScopeChannelChats, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (notificationSettingsScopeChannelChats *NotificationSettingsScopeChannelChats) String() string {
+	return objectString(notificationSettingsScopeChannelChats)
+}
+
+// LogValue logs the object as its summary
+func (notificationSettingsScopeChannelChats *NotificationSettingsScopeChannelChats) LogValue() slog.Value {
+	return slog.StringValue(objectString(notificationSettingsScopeChannelChats))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (notificationSettingsScopeChannelChats *NotificationSettingsScopeChannelChats) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, notificationSettingsScopeChannelChats)
+}
+
+func (notificationSettingsScopeChannelChats *NotificationSettingsScopeChannelChats) printText(p *printer) {
+	if notificationSettingsScopeChannelChats == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("NotificationSettingsScopeChannelChats")
+	p.endObject(state)
+}
+
 // GetNotificationSettingsScopeEnum return the enum type of this object
 func (notificationSettingsScopeChannelChats *NotificationSettingsScopeChannelChats) GetNotificationSettingsScopeEnum() NotificationSettingsScopeEnum {
 	return NotificationSettingsScopeChannelChatsType
 }
+
+// Accept calls the method of the visitor for NotificationSettingsScopeChannelChats
+func (notificationSettingsScopeChannelChats *NotificationSettingsScopeChannelChats) Accept(visitor NotificationSettingsScopeVisitor) {
+	visitor.VisitNotificationSettingsScopeChannelChats(notificationSettingsScopeChannelChats)
+}
+
+// NotificationSettingsScopeVisitor visits every type of NotificationSettingsScope, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to NotificationSettingsScope, until they handle it too.
+type NotificationSettingsScopeVisitor interface {
+	VisitNotificationSettingsScopePrivateChats(notificationSettingsScopePrivateChats *NotificationSettingsScopePrivateChats)
+	VisitNotificationSettingsScopeGroupChats(notificationSettingsScopeGroupChats *NotificationSettingsScopeGroupChats)
+	VisitNotificationSettingsScopeChannelChats(notificationSettingsScopeChannelChats *NotificationSettingsScopeChannelChats)
+	VisitUnknownNotificationSettingsScope(unknownNotificationSettingsScope *UnknownNotificationSettingsScope)
+}
+
+// NotificationSettingsScopeCases holds the function MatchNotificationSettingsScope calls for each type of NotificationSettingsScope;
+// types without a function are passed to the default case
+type NotificationSettingsScopeCases[R any] struct {
+	NotificationSettingsScopePrivateChats func(notificationSettingsScopePrivateChats *NotificationSettingsScopePrivateChats) R
+	NotificationSettingsScopeGroupChats   func(notificationSettingsScopeGroupChats *NotificationSettingsScopeGroupChats) R
+	NotificationSettingsScopeChannelChats func(notificationSettingsScopeChannelChats *NotificationSettingsScopeChannelChats) R
+}
+
+// MatchNotificationSettingsScope calls the case of the type of notificationSettingsScope, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement NotificationSettingsScopeVisitor
+// to have the compiler check every type is handled.
+func MatchNotificationSettingsScope[R any](notificationSettingsScope NotificationSettingsScope, cases NotificationSettingsScopeCases[R], defaultCase func(notificationSettingsScope NotificationSettingsScope) R) R {
+	switch value := notificationSettingsScope.(type) {
+	case *NotificationSettingsScopePrivateChats:
+		if cases.NotificationSettingsScopePrivateChats != nil {
+			return cases.NotificationSettingsScopePrivateChats(value)
+		}
+	case *NotificationSettingsScopeGroupChats:
+		if cases.NotificationSettingsScopeGroupChats != nil {
+			return cases.NotificationSettingsScopeGroupChats(value)
+		}
+	case *NotificationSettingsScopeChannelChats:
+		if cases.NotificationSettingsScopeChannelChats != nil {
+			return cases.NotificationSettingsScopeChannelChats(value)
+		}
+	}
+	return defaultCase(notificationSettingsScope)
+}
+
+// Clone returns a deep copy of the object
+func (unknownNotificationSettingsScope *UnknownNotificationSettingsScope) Clone() *UnknownNotificationSettingsScope {
+	if unknownNotificationSettingsScope == nil {
+		return nil
+	}
+	return &UnknownNotificationSettingsScope{Unknown: unknownNotificationSettingsScope.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownNotificationSettingsScope *UnknownNotificationSettingsScope) Equal(other *UnknownNotificationSettingsScope) bool {
+	if unknownNotificationSettingsScope == nil || other == nil {
+		return unknownNotificationSettingsScope == other
+	}
+	return unknownNotificationSettingsScope.Unknown.equal(other.Unknown)
+}
+
+func cloneNotificationSettingsScope(notificationSettingsScope NotificationSettingsScope) NotificationSettingsScope {
+	switch value := notificationSettingsScope.(type) {
+	case *NotificationSettingsScopePrivateChats:
+		return value.Clone()
+	case *NotificationSettingsScopeGroupChats:
+		return value.Clone()
+	case *NotificationSettingsScopeChannelChats:
+		return value.Clone()
+	case *UnknownNotificationSettingsScope:
+		return value.Clone()
+	}
+	return notificationSettingsScope
+}
+
+func equalNotificationSettingsScope(a NotificationSettingsScope, b NotificationSettingsScope) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *NotificationSettingsScopePrivateChats:
+		b, ok := b.(*NotificationSettingsScopePrivateChats)
+		return ok && a.Equal(b)
+	case *NotificationSettingsScopeGroupChats:
+		b, ok := b.(*NotificationSettingsScopeGroupChats)
+		return ok && a.Equal(b)
+	case *NotificationSettingsScopeChannelChats:
+		b, ok := b.(*NotificationSettingsScopeChannelChats)
+		return ok && a.Equal(b)
+	case *UnknownNotificationSettingsScope:
+		b, ok := b.(*UnknownNotificationSettingsScope)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffNotificationSettingsScope(path string, a NotificationSettingsScope, b NotificationSettingsScope, changes []Change) []Change {
+	switch a := a.(type) {
+	case *NotificationSettingsScopePrivateChats:
+		if b, ok := b.(*NotificationSettingsScopePrivateChats); ok {
+			return a.diff(path, b, changes)
+		}
+	case *NotificationSettingsScopeGroupChats:
+		if b, ok := b.(*NotificationSettingsScopeGroupChats); ok {
+			return a.diff(path, b, changes)
+		}
+	case *NotificationSettingsScopeChannelChats:
+		if b, ok := b.(*NotificationSettingsScopeChannelChats); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalNotificationSettingsScope(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // NotificationType Contains detailed information about a notification
 type NotificationType interface {
 	GetNotificationTypeEnum() NotificationTypeEnum
+	Accept(visitor NotificationTypeVisitor)
 }
 
 // NotificationTypeEnum Alias for abstract NotificationType 'Sub-Classes', used as constant-enum here
@@ -21,43 +23,94 @@ const (
 	NotificationTypeNewPushMessageType NotificationTypeEnum = "notificationTypeNewPushMessage"
 )
 
+// UnknownNotificationType is a NotificationType of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownNotificationType struct {
+	Unknown
+}
+
+// GetNotificationTypeEnum return the enum type of this object
+func (unknownNotificationType *UnknownNotificationType) GetNotificationTypeEnum() NotificationTypeEnum {
+	return NotificationTypeEnum(unknownNotificationType.Type)
+}
+
+// Accept calls the method of the visitor for UnknownNotificationType
+func (unknownNotificationType *UnknownNotificationType) Accept(visitor NotificationTypeVisitor) {
+	visitor.VisitUnknownNotificationType(unknownNotificationType)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownNotificationType *UnknownNotificationType) String() string {
+	return objectString(unknownNotificationType)
+}
+
+// LogValue logs the object as its summary
+func (unknownNotificationType *UnknownNotificationType) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownNotificationType))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownNotificationType *UnknownNotificationType) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownNotificationType)
+}
+
+func (unknownNotificationType *UnknownNotificationType) printText(p *printer) {
+	if unknownNotificationType == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownNotificationType")
+	p.string("@type", unknownNotificationType.Type)
+	p.endObject(state)
+}
+
 func unmarshalNotificationType(rawMsg *json.RawMessage) (NotificationType, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch NotificationTypeEnum(objMap["@type"].(string)) {
+	switch NotificationTypeEnum(typeName) {
 	case NotificationTypeNewMessageType:
 		var notificationTypeNewMessage NotificationTypeNewMessage
-		err := json.Unmarshal(*rawMsg, &notificationTypeNewMessage)
+		err := jsonUnmarshal(*rawMsg, &notificationTypeNewMessage)
 		return &notificationTypeNewMessage, err
 
 	case NotificationTypeNewSecretChatType:
 		var notificationTypeNewSecretChat NotificationTypeNewSecretChat
-		err := json.Unmarshal(*rawMsg, &notificationTypeNewSecretChat)
+		err := jsonUnmarshal(*rawMsg, &notificationTypeNewSecretChat)
 		return &notificationTypeNewSecretChat, err
 
 	case NotificationTypeNewCallType:
 		var notificationTypeNewCall NotificationTypeNewCall
-		err := json.Unmarshal(*rawMsg, &notificationTypeNewCall)
+		err := jsonUnmarshal(*rawMsg, &notificationTypeNewCall)
 		return &notificationTypeNewCall, err
 
 	case NotificationTypeNewPushMessageType:
 		var notificationTypeNewPushMessage NotificationTypeNewPushMessage
-		err := json.Unmarshal(*rawMsg, &notificationTypeNewPushMessage)
+		err := jsonUnmarshal(*rawMsg, &notificationTypeNewPushMessage)
 		return &notificationTypeNewPushMessage, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownNotificationType{Unknown: unknown}, nil
 	}
 }
 
+func decodeNotificationType(r *jsonReader) (NotificationType, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalNotificationType(rawMsg)
+}
+
 // NotificationTypeNewMessage New message was received
 type NotificationTypeNewMessage struct {
 	tdCommon
@@ -81,11 +134,126 @@ func NewNotificationTypeNewMessage(message *Message) *NotificationTypeNewMessage
 	return &notificationTypeNewMessageTemp
 }
 
+// MarshalJSON marshals to json
+func (notificationTypeNewMessage *NotificationTypeNewMessage) MarshalJSON() ([]byte, error) {
+	return notificationTypeNewMessage.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (notificationTypeNewMessage *NotificationTypeNewMessage) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, notificationTypeNewMessage)
+}
+
+func (notificationTypeNewMessage *NotificationTypeNewMessage) appendJSON(b []byte) ([]byte, error) {
+	if notificationTypeNewMessage == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, notificationTypeNewMessage.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, notificationTypeNewMessage.tdCommon.Extra)
+	b = append(b, `,"message":`...)
+	b, err = notificationTypeNewMessage.Message.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (notificationTypeNewMessage *NotificationTypeNewMessage) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			notificationTypeNewMessage.tdCommon.Type, err = r.readString()
+		case "@extra":
+			notificationTypeNewMessage.tdCommon.Extra, err = r.readString()
+		case "message":
+			if r.readNull() {
+				notificationTypeNewMessage.Message = nil
+			} else {
+				notificationTypeNewMessage.Message = new(Message)
+				err = notificationTypeNewMessage.Message.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (notificationTypeNewMessage *NotificationTypeNewMessage) Clone() *NotificationTypeNewMessage {
+	if notificationTypeNewMessage == nil {
+		return nil
+	}
+	clone := *notificationTypeNewMessage
+	clone.Message = notificationTypeNewMessage.Message.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (notificationTypeNewMessage *NotificationTypeNewMessage) Equal(other *NotificationTypeNewMessage) bool {
+	if notificationTypeNewMessage == nil || other == nil {
+		return notificationTypeNewMessage == other
+	}
+	if !notificationTypeNewMessage.Message.Equal(other.Message) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (notificationTypeNewMessage *NotificationTypeNewMessage) Diff(other *NotificationTypeNewMessage) []Change {
+	return notificationTypeNewMessage.diff("", other, nil)
+}
+
+func (notificationTypeNewMessage *NotificationTypeNewMessage) diff(path string, other *NotificationTypeNewMessage, changes []Change) []Change {
+	if notificationTypeNewMessage == nil || other == nil {
+		if notificationTypeNewMessage != other {
+			changes = append(changes, Change{Path: path, Old: notificationTypeNewMessage, New: other})
+		}
+		return changes
+	}
+	changes = notificationTypeNewMessage.Message.diff(fieldPath(path, "message"), other.Message, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (notificationTypeNewMessage *NotificationTypeNewMessage) String() string {
+	return objectString(notificationTypeNewMessage)
+}
+
+// LogValue logs the object as its summary
+func (notificationTypeNewMessage *NotificationTypeNewMessage) LogValue() slog.Value {
+	return slog.StringValue(objectString(notificationTypeNewMessage))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (notificationTypeNewMessage *NotificationTypeNewMessage) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, notificationTypeNewMessage)
+}
+
+func (notificationTypeNewMessage *NotificationTypeNewMessage) printText(p *printer) {
+	if notificationTypeNewMessage == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("NotificationTypeNewMessage")
+	p.object("message", notificationTypeNewMessage.Message)
+	p.endObject(state)
+}
+
 // GetNotificationTypeEnum return the enum type of this object
 func (notificationTypeNewMessage *NotificationTypeNewMessage) GetNotificationTypeEnum() NotificationTypeEnum {
 	return NotificationTypeNewMessageType
 }
 
+// Accept calls the method of the visitor for NotificationTypeNewMessage
+func (notificationTypeNewMessage *NotificationTypeNewMessage) Accept(visitor NotificationTypeVisitor) {
+	visitor.VisitNotificationTypeNewMessage(notificationTypeNewMessage)
+}
+
 // NotificationTypeNewSecretChat New secret chat was created
 type NotificationTypeNewSecretChat struct {
 	tdCommon
@@ -97,7 +265,6 @@ func (notificationTypeNewSecretChat *NotificationTypeNewSecretChat) MessageType(
 }
 
 // NewNotificationTypeNewSecretChat creates a new NotificationTypeNewSecretChat
-//
 func NewNotificationTypeNewSecretChat() *NotificationTypeNewSecretChat {
 	notificationTypeNewSecretChatTemp := NotificationTypeNewSecretChat{
 		tdCommon: tdCommon{Type: "notificationTypeNewSecretChat"},
@@ -106,11 +273,107 @@ func NewNotificationTypeNewSecretChat() *NotificationTypeNewSecretChat {
 	return &notificationTypeNewSecretChatTemp
 }
 
+// MarshalJSON marshals to json
+func (notificationTypeNewSecretChat *NotificationTypeNewSecretChat) MarshalJSON() ([]byte, error) {
+	return notificationTypeNewSecretChat.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (notificationTypeNewSecretChat *NotificationTypeNewSecretChat) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, notificationTypeNewSecretChat)
+}
+
+func (notificationTypeNewSecretChat *NotificationTypeNewSecretChat) appendJSON(b []byte) ([]byte, error) {
+	if notificationTypeNewSecretChat == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, notificationTypeNewSecretChat.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, notificationTypeNewSecretChat.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (notificationTypeNewSecretChat *NotificationTypeNewSecretChat) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			notificationTypeNewSecretChat.tdCommon.Type, err = r.readString()
+		case "@extra":
+			notificationTypeNewSecretChat.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (notificationTypeNewSecretChat *NotificationTypeNewSecretChat) Clone() *NotificationTypeNewSecretChat {
+	if notificationTypeNewSecretChat == nil {
+		return nil
+	}
+	clone := *notificationTypeNewSecretChat
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (notificationTypeNewSecretChat *NotificationTypeNewSecretChat) Equal(other *NotificationTypeNewSecretChat) bool {
+	if notificationTypeNewSecretChat == nil || other == nil {
+		return notificationTypeNewSecretChat == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (notificationTypeNewSecretChat *NotificationTypeNewSecretChat) Diff(other *NotificationTypeNewSecretChat) []Change {
+	return notificationTypeNewSecretChat.diff("", other, nil)
+}
+
+func (notificationTypeNewSecretChat *NotificationTypeNewSecretChat) diff(path string, other *NotificationTypeNewSecretChat, changes []Change) []Change {
+	if notificationTypeNewSecretChat == nil || other == nil {
+		if notificationTypeNewSecretChat != other {
+			changes = append(changes, Change{Path: path, Old: notificationTypeNewSecretChat, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (notificationTypeNewSecretChat *NotificationTypeNewSecretChat) String() string {
+	return objectString(notificationTypeNewSecretChat)
+}
+
+// LogValue logs the object as its summary
+func (notificationTypeNewSecretChat *NotificationTypeNewSecretChat) LogValue() slog.Value {
+	return slog.StringValue(objectString(notificationTypeNewSecretChat))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (notificationTypeNewSecretChat *NotificationTypeNewSecretChat) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, notificationTypeNewSecretChat)
+}
+
+func (notificationTypeNewSecretChat *NotificationTypeNewSecretChat) printText(p *printer) {
+	if notificationTypeNewSecretChat == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("NotificationTypeNewSecretChat")
+	p.endObject(state)
+}
+
 // GetNotificationTypeEnum return the enum type of this object
 func (notificationTypeNewSecretChat *NotificationTypeNewSecretChat) GetNotificationTypeEnum() NotificationTypeEnum {
 	return NotificationTypeNewSecretChatType
 }
 
+// Accept calls the method of the visitor for NotificationTypeNewSecretChat
+func (notificationTypeNewSecretChat *NotificationTypeNewSecretChat) Accept(visitor NotificationTypeVisitor) {
+	visitor.VisitNotificationTypeNewSecretChat(notificationTypeNewSecretChat)
+}
+
 // NotificationTypeNewCall New call was received
 type NotificationTypeNewCall struct {
 	tdCommon
@@ -134,11 +397,118 @@ func NewNotificationTypeNewCall(callId int32) *NotificationTypeNewCall {
 	return &notificationTypeNewCallTemp
 }
 
+// MarshalJSON marshals to json
+func (notificationTypeNewCall *NotificationTypeNewCall) MarshalJSON() ([]byte, error) {
+	return notificationTypeNewCall.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (notificationTypeNewCall *NotificationTypeNewCall) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, notificationTypeNewCall)
+}
+
+func (notificationTypeNewCall *NotificationTypeNewCall) appendJSON(b []byte) ([]byte, error) {
+	if notificationTypeNewCall == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, notificationTypeNewCall.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, notificationTypeNewCall.tdCommon.Extra)
+	b = append(b, `,"call_id":`...)
+	b = appendJSONInt(b, int64(notificationTypeNewCall.CallId))
+	return append(b, '}'), nil
+}
+
+func (notificationTypeNewCall *NotificationTypeNewCall) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			notificationTypeNewCall.tdCommon.Type, err = r.readString()
+		case "@extra":
+			notificationTypeNewCall.tdCommon.Extra, err = r.readString()
+		case "call_id":
+			notificationTypeNewCall.CallId, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (notificationTypeNewCall *NotificationTypeNewCall) Clone() *NotificationTypeNewCall {
+	if notificationTypeNewCall == nil {
+		return nil
+	}
+	clone := *notificationTypeNewCall
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (notificationTypeNewCall *NotificationTypeNewCall) Equal(other *NotificationTypeNewCall) bool {
+	if notificationTypeNewCall == nil || other == nil {
+		return notificationTypeNewCall == other
+	}
+	if notificationTypeNewCall.CallId != other.CallId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (notificationTypeNewCall *NotificationTypeNewCall) Diff(other *NotificationTypeNewCall) []Change {
+	return notificationTypeNewCall.diff("", other, nil)
+}
+
+func (notificationTypeNewCall *NotificationTypeNewCall) diff(path string, other *NotificationTypeNewCall, changes []Change) []Change {
+	if notificationTypeNewCall == nil || other == nil {
+		if notificationTypeNewCall != other {
+			changes = append(changes, Change{Path: path, Old: notificationTypeNewCall, New: other})
+		}
+		return changes
+	}
+	if notificationTypeNewCall.CallId != other.CallId {
+		changes = append(changes, Change{Path: fieldPath(path, "call_id"), Old: notificationTypeNewCall.CallId, New: other.CallId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (notificationTypeNewCall *NotificationTypeNewCall) String() string {
+	return objectString(notificationTypeNewCall)
+}
+
+// LogValue logs the object as its summary
+func (notificationTypeNewCall *NotificationTypeNewCall) LogValue() slog.Value {
+	return slog.StringValue(objectString(notificationTypeNewCall))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (notificationTypeNewCall *NotificationTypeNewCall) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, notificationTypeNewCall)
+}
+
+func (notificationTypeNewCall *NotificationTypeNewCall) printText(p *printer) {
+	if notificationTypeNewCall == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("NotificationTypeNewCall")
+	p.int("call_id", int64(notificationTypeNewCall.CallId))
+	p.endObject(state)
+}
+
 // GetNotificationTypeEnum return the enum type of this object
 func (notificationTypeNewCall *NotificationTypeNewCall) GetNotificationTypeEnum() NotificationTypeEnum {
 	return NotificationTypeNewCallType
 }
 
+// Accept calls the method of the visitor for NotificationTypeNewCall
+func (notificationTypeNewCall *NotificationTypeNewCall) Accept(visitor NotificationTypeVisitor) {
+	visitor.VisitNotificationTypeNewCall(notificationTypeNewCall)
+}
+
 // NotificationTypeNewPushMessage New message was received through a push notification
 type NotificationTypeNewPushMessage struct {
 	tdCommon
@@ -174,40 +544,288 @@ func NewNotificationTypeNewPushMessage(messageId int64, senderId MessageSender,
 	return &notificationTypeNewPushMessageTemp
 }
 
+// MarshalJSON marshals to json
+func (notificationTypeNewPushMessage *NotificationTypeNewPushMessage) MarshalJSON() ([]byte, error) {
+	return notificationTypeNewPushMessage.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (notificationTypeNewPushMessage *NotificationTypeNewPushMessage) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, notificationTypeNewPushMessage)
+}
+
+func (notificationTypeNewPushMessage *NotificationTypeNewPushMessage) appendJSON(b []byte) ([]byte, error) {
+	if notificationTypeNewPushMessage == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, notificationTypeNewPushMessage.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, notificationTypeNewPushMessage.tdCommon.Extra)
+	b = append(b, `,"message_id":`...)
+	b = appendJSONInt(b, int64(notificationTypeNewPushMessage.MessageId))
+	b = append(b, `,"sender_id":`...)
+	b, err = appendJSONValue(b, notificationTypeNewPushMessage.SenderId)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-		MessageId  int64  `json:"message_id"`  // The message identifier. The message will not be available in the chat history, but the ID can be used in viewMessages, or as reply_to_message_id
-		SenderName string `json:"sender_name"` // Name of the sender
-		IsOutgoing bool   `json:"is_outgoing"` // True, if the message is outgoing
-
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	b = append(b, `,"sender_name":`...)
+	b = appendJSONString(b, notificationTypeNewPushMessage.SenderName)
+	b = append(b, `,"is_outgoing":`...)
+	b = appendJSONBool(b, notificationTypeNewPushMessage.IsOutgoing)
+	b = append(b, `,"content":`...)
+	b, err = appendJSONValue(b, notificationTypeNewPushMessage.Content)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return append(b, '}'), nil
+}
 
-	notificationTypeNewPushMessage.tdCommon = tempObj.tdCommon
-	notificationTypeNewPushMessage.MessageId = tempObj.MessageId
-	notificationTypeNewPushMessage.SenderName = tempObj.SenderName
-	notificationTypeNewPushMessage.IsOutgoing = tempObj.IsOutgoing
+func (notificationTypeNewPushMessage *NotificationTypeNewPushMessage) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			notificationTypeNewPushMessage.tdCommon.Type, err = r.readString()
+		case "@extra":
+			notificationTypeNewPushMessage.tdCommon.Extra, err = r.readString()
+		case "message_id":
+			notificationTypeNewPushMessage.MessageId, err = r.readInt64()
+		case "sender_id":
+			notificationTypeNewPushMessage.SenderId, err = decodeMessageSender(r)
+		case "sender_name":
+			notificationTypeNewPushMessage.SenderName, err = r.readString()
+		case "is_outgoing":
+			notificationTypeNewPushMessage.IsOutgoing, err = r.readBool()
+		case "content":
+			notificationTypeNewPushMessage.Content, err = decodePushMessageContent(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	fieldSenderId, _ := unmarshalMessageSender(objMap["sender_id"])
-	notificationTypeNewPushMessage.SenderId = fieldSenderId
+// Clone returns a deep copy of the object
+func (notificationTypeNewPushMessage *NotificationTypeNewPushMessage) Clone() *NotificationTypeNewPushMessage {
+	if notificationTypeNewPushMessage == nil {
+		return nil
+	}
+	clone := *notificationTypeNewPushMessage
+	clone.SenderId = cloneMessageSender(notificationTypeNewPushMessage.SenderId)
+	clone.Content = clonePushMessageContent(notificationTypeNewPushMessage.Content)
+	return &clone
+}
 
-	fieldContent, _ := unmarshalPushMessageContent(objMap["content"])
-	notificationTypeNewPushMessage.Content = fieldContent
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (notificationTypeNewPushMessage *NotificationTypeNewPushMessage) Equal(other *NotificationTypeNewPushMessage) bool {
+	if notificationTypeNewPushMessage == nil || other == nil {
+		return notificationTypeNewPushMessage == other
+	}
+	if notificationTypeNewPushMessage.MessageId != other.MessageId {
+		return false
+	}
+	if !equalMessageSender(notificationTypeNewPushMessage.SenderId, other.SenderId) {
+		return false
+	}
+	if notificationTypeNewPushMessage.SenderName != other.SenderName {
+		return false
+	}
+	if notificationTypeNewPushMessage.IsOutgoing != other.IsOutgoing {
+		return false
+	}
+	if !equalPushMessageContent(notificationTypeNewPushMessage.Content, other.Content) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (notificationTypeNewPushMessage *NotificationTypeNewPushMessage) Diff(other *NotificationTypeNewPushMessage) []Change {
+	return notificationTypeNewPushMessage.diff("", other, nil)
+}
 
-	return nil
+func (notificationTypeNewPushMessage *NotificationTypeNewPushMessage) diff(path string, other *NotificationTypeNewPushMessage, changes []Change) []Change {
+	if notificationTypeNewPushMessage == nil || other == nil {
+		if notificationTypeNewPushMessage != other {
+			changes = append(changes, Change{Path: path, Old: notificationTypeNewPushMessage, New: other})
+		}
+		return changes
+	}
+	if notificationTypeNewPushMessage.MessageId != other.MessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "message_id"), Old: notificationTypeNewPushMessage.MessageId, New: other.MessageId})
+	}
+	changes = diffMessageSender(fieldPath(path, "sender_id"), notificationTypeNewPushMessage.SenderId, other.SenderId, changes)
+	if notificationTypeNewPushMessage.SenderName != other.SenderName {
+		changes = append(changes, Change{Path: fieldPath(path, "sender_name"), Old: notificationTypeNewPushMessage.SenderName, New: other.SenderName})
+	}
+	if notificationTypeNewPushMessage.IsOutgoing != other.IsOutgoing {
+		changes = append(changes, Change{Path: fieldPath(path, "is_outgoing"), Old: notificationTypeNewPushMessage.IsOutgoing, New: other.IsOutgoing})
+	}
+	changes = diffPushMessageContent(fieldPath(path, "content"), notificationTypeNewPushMessage.Content, other.Content, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (notificationTypeNewPushMessage *NotificationTypeNewPushMessage) String() string {
+	return objectString(notificationTypeNewPushMessage)
+}
+
+// LogValue logs the object as its summary
+func (notificationTypeNewPushMessage *NotificationTypeNewPushMessage) LogValue() slog.Value {
+	return slog.StringValue(objectString(notificationTypeNewPushMessage))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (notificationTypeNewPushMessage *NotificationTypeNewPushMessage) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, notificationTypeNewPushMessage)
+}
+
+func (notificationTypeNewPushMessage *NotificationTypeNewPushMessage) printText(p *printer) {
+	if notificationTypeNewPushMessage == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("NotificationTypeNewPushMessage")
+	p.int("message_id", int64(notificationTypeNewPushMessage.MessageId))
+	p.object("sender_id", notificationTypeNewPushMessage.SenderId)
+	p.string("sender_name", notificationTypeNewPushMessage.SenderName)
+	p.bool("is_outgoing", notificationTypeNewPushMessage.IsOutgoing)
+	p.object("content", notificationTypeNewPushMessage.Content)
+	p.endObject(state)
 }
 
 // GetNotificationTypeEnum return the enum type of this object
 func (notificationTypeNewPushMessage *NotificationTypeNewPushMessage) GetNotificationTypeEnum() NotificationTypeEnum {
 	return NotificationTypeNewPushMessageType
 }
+
+// Accept calls the method of the visitor for NotificationTypeNewPushMessage
+func (notificationTypeNewPushMessage *NotificationTypeNewPushMessage) Accept(visitor NotificationTypeVisitor) {
+	visitor.VisitNotificationTypeNewPushMessage(notificationTypeNewPushMessage)
+}
+
+// NotificationTypeVisitor visits every type of NotificationType, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to NotificationType, until they handle it too.
+type NotificationTypeVisitor interface {
+	VisitNotificationTypeNewMessage(notificationTypeNewMessage *NotificationTypeNewMessage)
+	VisitNotificationTypeNewSecretChat(notificationTypeNewSecretChat *NotificationTypeNewSecretChat)
+	VisitNotificationTypeNewCall(notificationTypeNewCall *NotificationTypeNewCall)
+	VisitNotificationTypeNewPushMessage(notificationTypeNewPushMessage *NotificationTypeNewPushMessage)
+	VisitUnknownNotificationType(unknownNotificationType *UnknownNotificationType)
+}
+
+// NotificationTypeCases holds the function MatchNotificationType calls for each type of NotificationType;
+// types without a function are passed to the default case
+type NotificationTypeCases[R any] struct {
+	NotificationTypeNewMessage     func(notificationTypeNewMessage *NotificationTypeNewMessage) R
+	NotificationTypeNewSecretChat  func(notificationTypeNewSecretChat *NotificationTypeNewSecretChat) R
+	NotificationTypeNewCall        func(notificationTypeNewCall *NotificationTypeNewCall) R
+	NotificationTypeNewPushMessage func(notificationTypeNewPushMessage *NotificationTypeNewPushMessage) R
+}
+
+// MatchNotificationType calls the case of the type of notificationType, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement NotificationTypeVisitor
+// to have the compiler check every type is handled.
+func MatchNotificationType[R any](notificationType NotificationType, cases NotificationTypeCases[R], defaultCase func(notificationType NotificationType) R) R {
+	switch value := notificationType.(type) {
+	case *NotificationTypeNewMessage:
+		if cases.NotificationTypeNewMessage != nil {
+			return cases.NotificationTypeNewMessage(value)
+		}
+	case *NotificationTypeNewSecretChat:
+		if cases.NotificationTypeNewSecretChat != nil {
+			return cases.NotificationTypeNewSecretChat(value)
+		}
+	case *NotificationTypeNewCall:
+		if cases.NotificationTypeNewCall != nil {
+			return cases.NotificationTypeNewCall(value)
+		}
+	case *NotificationTypeNewPushMessage:
+		if cases.NotificationTypeNewPushMessage != nil {
+			return cases.NotificationTypeNewPushMessage(value)
+		}
+	}
+	return defaultCase(notificationType)
+}
+
+// Clone returns a deep copy of the object
+func (unknownNotificationType *UnknownNotificationType) Clone() *UnknownNotificationType {
+	if unknownNotificationType == nil {
+		return nil
+	}
+	return &UnknownNotificationType{Unknown: unknownNotificationType.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownNotificationType *UnknownNotificationType) Equal(other *UnknownNotificationType) bool {
+	if unknownNotificationType == nil || other == nil {
+		return unknownNotificationType == other
+	}
+	return unknownNotificationType.Unknown.equal(other.Unknown)
+}
+
+func cloneNotificationType(notificationType NotificationType) NotificationType {
+	switch value := notificationType.(type) {
+	case *NotificationTypeNewMessage:
+		return value.Clone()
+	case *NotificationTypeNewSecretChat:
+		return value.Clone()
+	case *NotificationTypeNewCall:
+		return value.Clone()
+	case *NotificationTypeNewPushMessage:
+		return value.Clone()
+	case *UnknownNotificationType:
+		return value.Clone()
+	}
+	return notificationType
+}
+
+func equalNotificationType(a NotificationType, b NotificationType) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *NotificationTypeNewMessage:
+		b, ok := b.(*NotificationTypeNewMessage)
+		return ok && a.Equal(b)
+	case *NotificationTypeNewSecretChat:
+		b, ok := b.(*NotificationTypeNewSecretChat)
+		return ok && a.Equal(b)
+	case *NotificationTypeNewCall:
+		b, ok := b.(*NotificationTypeNewCall)
+		return ok && a.Equal(b)
+	case *NotificationTypeNewPushMessage:
+		b, ok := b.(*NotificationTypeNewPushMessage)
+		return ok && a.Equal(b)
+	case *UnknownNotificationType:
+		b, ok := b.(*UnknownNotificationType)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffNotificationType(path string, a NotificationType, b NotificationType, changes []Change) []Change {
+	switch a := a.(type) {
+	case *NotificationTypeNewMessage:
+		if b, ok := b.(*NotificationTypeNewMessage); ok {
+			return a.diff(path, b, changes)
+		}
+	case *NotificationTypeNewSecretChat:
+		if b, ok := b.(*NotificationTypeNewSecretChat); ok {
+			return a.diff(path, b, changes)
+		}
+	case *NotificationTypeNewCall:
+		if b, ok := b.(*NotificationTypeNewCall); ok {
+			return a.diff(path, b, changes)
+		}
+	case *NotificationTypeNewPushMessage:
+		if b, ok := b.(*NotificationTypeNewPushMessage); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalNotificationType(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // Ok An object of this type is returned on a successful function call for certain functions
@@ -16,7 +16,6 @@ func (ok *Ok) MessageType() string {
 }
 
 // NewOk creates a new Ok
-//
 func NewOk() *Ok {
 	okTemp := Ok{
 		tdCommon: tdCommon{Type: "ok"},
@@ -25,6 +24,97 @@ func NewOk() *Ok {
 	return &okTemp
 }
 
+// MarshalJSON marshals to json
+func (ok *Ok) MarshalJSON() ([]byte, error) {
+	return ok.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (ok *Ok) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, ok)
+}
+
+func (ok *Ok) appendJSON(b []byte) ([]byte, error) {
+	if ok == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, ok.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, ok.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (ok *Ok) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			ok.tdCommon.Type, err = r.readString()
+		case "@extra":
+			ok.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (ok *Ok) Clone() *Ok {
+	if ok == nil {
+		return nil
+	}
+	clone := *ok
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (ok *Ok) Equal(other *Ok) bool {
+	if ok == nil || other == nil {
+		return ok == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (ok *Ok) Diff(other *Ok) []Change {
+	return ok.diff("", other, nil)
+}
+
+func (ok *Ok) diff(path string, other *Ok, changes []Change) []Change {
+	if ok == nil || other == nil {
+		if ok != other {
+			changes = append(changes, Change{Path: path, Old: ok, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (ok *Ok) String() string {
+	return objectString(ok)
+}
+
+// LogValue logs the object as its summary
+func (ok *Ok) LogValue() slog.Value {
+	return slog.StringValue(objectString(ok))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (ok *Ok) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, ok)
+}
+
+func (ok *Ok) printText(p *printer) {
+	if ok == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Ok")
+	p.endObject(state)
+}
+
 // SetTdlibParameters Sets the parameters for TDLib initialization. Works only when the current authorization state is authorizationStateWaitTdlibParameters
 // @param parameters Parameters for TDLib initialization
 func (client *Client) SetTdlibParameters(parameters *TdlibParameters) (*Ok, error) {
@@ -37,12 +127,12 @@ func (client *Client) SetTdlibParameters(parameters *TdlibParameters) (*Ok, erro
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -58,12 +148,12 @@ func (client *Client) CheckDatabaseEncryptionKey(encryptionKey []byte) (*Ok, err
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -81,12 +171,12 @@ func (client *Client) SetAuthenticationPhoneNumber(phoneNumber string, settings
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -100,12 +190,12 @@ func (client *Client) ResendAuthenticationCode() (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -121,12 +211,12 @@ func (client *Client) CheckAuthenticationCode(code string) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -142,12 +232,12 @@ func (client *Client) RequestQrCodeAuthentication(otherUserIds []int64) (*Ok, er
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -165,12 +255,12 @@ func (client *Client) RegisterUser(firstName string, lastName string) (*Ok, erro
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -186,12 +276,12 @@ func (client *Client) CheckAuthenticationPassword(password string) (*Ok, error)
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -205,12 +295,12 @@ func (client *Client) RequestAuthenticationPasswordRecovery() (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -226,12 +316,12 @@ func (client *Client) CheckAuthenticationPasswordRecoveryCode(recoveryCode strin
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -251,12 +341,12 @@ func (client *Client) RecoverAuthenticationPassword(recoveryCode string, newPass
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -272,12 +362,12 @@ func (client *Client) CheckAuthenticationBotToken(token string) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var okDummy Ok
-	err = json.Unmarshal(result.Raw, &okDummy)
+	err = jsonUnmarshal(result.Raw, &okDummy)
 	return &okDummy, err
 }
 
@@ -291,12 +381,12 @@ func (client *Client) LogOut() (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -310,12 +400,12 @@ func (client *Client) Close() (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -329,12 +419,12 @@ func (client *Client) Destroy() (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -350,12 +440,12 @@ func (client *Client) SetDatabaseEncryptionKey(newEncryptionKey []byte) (*Ok, er
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -371,12 +461,12 @@ func (client *Client) CheckPasswordRecoveryCode(recoveryCode string) (*Ok, error
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -390,12 +480,12 @@ func (client *Client) CancelPasswordReset() (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -413,12 +503,12 @@ func (client *Client) LoadChats(chatList ChatList, limit int32) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -436,12 +526,12 @@ func (client *Client) RemoveTopChat(category TopChatCategory, chatId int64) (*Ok
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -457,12 +547,12 @@ func (client *Client) AddRecentlyFoundChat(chatId int64) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -478,12 +568,12 @@ func (client *Client) RemoveRecentlyFoundChat(chatId int64) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -497,12 +587,12 @@ func (client *Client) ClearRecentlyFoundChats() (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -518,12 +608,12 @@ func (client *Client) CheckCreatedPublicChatsLimit(typeParam PublicChatType) (*O
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -543,12 +633,12 @@ func (client *Client) DeleteChatHistory(chatId int64, removeFromChatList bool, r
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var okDummy Ok
-	err = json.Unmarshal(result.Raw, &okDummy)
+	err = jsonUnmarshal(result.Raw, &okDummy)
 	return &okDummy, err
 }
 
@@ -564,12 +654,12 @@ func (client *Client) DeleteChat(chatId int64) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -585,12 +675,12 @@ func (client *Client) DeleteAllCallMessages(revoke bool) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var okDummy Ok
-	err = json.Unmarshal(result.Raw, &okDummy)
+	err = jsonUnmarshal(result.Raw, &okDummy)
 	return &okDummy, err
 }
 
@@ -608,12 +698,12 @@ func (client *Client) RemoveNotification(notificationGroupId int32, notification
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -631,12 +721,12 @@ func (client *Client) RemoveNotificationGroup(notificationGroupId int32, maxNoti
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -654,12 +744,12 @@ func (client *Client) SetChatMessageSender(chatId int64, messageSenderId Message
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -675,12 +765,12 @@ func (client *Client) SendChatScreenshotTakenNotification(chatId int64) (*Ok, er
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -700,12 +790,12 @@ func (client *Client) DeleteMessages(chatId int64, messageIds []int64, revoke bo
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var okDummy Ok
-	err = json.Unmarshal(result.Raw, &okDummy)
+	err = jsonUnmarshal(result.Raw, &okDummy)
 	return &okDummy, err
 }
 
@@ -723,12 +813,12 @@ func (client *Client) DeleteChatMessagesBySender(chatId int64, senderId MessageS
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -750,12 +840,12 @@ func (client *Client) DeleteChatMessagesByDate(chatId int64, minDate int32, maxD
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var okDummy Ok
-	err = json.Unmarshal(result.Raw, &okDummy)
+	err = jsonUnmarshal(result.Raw, &okDummy)
 	return &okDummy, err
 }
 
@@ -775,12 +865,12 @@ func (client *Client) EditInlineMessageText(inlineMessageId string, replyMarkup
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -804,12 +894,12 @@ func (client *Client) EditInlineMessageLiveLocation(inlineMessageId string, repl
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -829,12 +919,12 @@ func (client *Client) EditInlineMessageMedia(inlineMessageId string, replyMarkup
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -854,12 +944,12 @@ func (client *Client) EditInlineMessageCaption(inlineMessageId string, replyMark
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -877,12 +967,12 @@ func (client *Client) EditInlineMessageReplyMarkup(inlineMessageId string, reply
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -902,12 +992,12 @@ func (client *Client) EditMessageSchedulingState(chatId int64, messageId int64,
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -927,12 +1017,12 @@ func (client *Client) SetPollAnswer(chatId int64, messageId int64, optionIds []i
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -952,12 +1042,12 @@ func (client *Client) StopPoll(chatId int64, messageId int64, replyMarkup ReplyM
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -973,12 +1063,12 @@ func (client *Client) HideSuggestedAction(action SuggestedAction) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1006,12 +1096,12 @@ func (client *Client) AnswerInlineQuery(inlineQueryId JSONInt64, isPersonal bool
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1035,12 +1125,12 @@ func (client *Client) AnswerCallbackQuery(callbackQueryId JSONInt64, text string
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1060,12 +1150,12 @@ func (client *Client) AnswerShippingQuery(shippingQueryId JSONInt64, shippingOpt
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1083,12 +1173,12 @@ func (client *Client) AnswerPreCheckoutQuery(preCheckoutQueryId JSONInt64, error
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1112,12 +1202,12 @@ func (client *Client) SetInlineGameScore(inlineMessageId string, editMessage boo
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1135,12 +1225,12 @@ func (client *Client) DeleteChatReplyMarkup(chatId int64, messageId int64) (*Ok,
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1160,12 +1250,12 @@ func (client *Client) SendChatAction(chatId int64, messageThreadId int64, action
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1181,12 +1271,12 @@ func (client *Client) OpenChat(chatId int64) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1202,12 +1292,12 @@ func (client *Client) CloseChat(chatId int64) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1229,12 +1319,12 @@ func (client *Client) ViewMessages(chatId int64, messageThreadId int64, messageI
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1252,12 +1342,12 @@ func (client *Client) OpenMessageContent(chatId int64, messageId int64) (*Ok, er
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1273,12 +1363,12 @@ func (client *Client) ReadAllChatMentions(chatId int64) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1296,12 +1386,12 @@ func (client *Client) AddChatToList(chatId int64, chatList ChatList) (*Ok, error
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1317,12 +1407,12 @@ func (client *Client) DeleteChatFilter(chatFilterId int32) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1338,12 +1428,12 @@ func (client *Client) ReorderChatFilters(chatFilterIds []int32) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1361,12 +1451,12 @@ func (client *Client) SetChatTitle(chatId int64, title string) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1384,12 +1474,12 @@ func (client *Client) SetChatPhoto(chatId int64, photo InputChatPhoto) (*Ok, err
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1407,12 +1497,12 @@ func (client *Client) SetChatMessageTtl(chatId int64, ttl int32) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1430,12 +1520,12 @@ func (client *Client) SetChatPermissions(chatId int64, permissions *ChatPermissi
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1453,12 +1543,12 @@ func (client *Client) SetChatTheme(chatId int64, themeName string) (*Ok, error)
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1478,12 +1568,12 @@ func (client *Client) SetChatDraftMessage(chatId int64, messageThreadId int64, d
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1501,12 +1591,12 @@ func (client *Client) SetChatNotificationSettings(chatId int64, notificationSett
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1524,12 +1614,12 @@ func (client *Client) ToggleChatHasProtectedContent(chatId int64, hasProtectedCo
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1547,12 +1637,12 @@ func (client *Client) ToggleChatIsMarkedAsUnread(chatId int64, isMarkedAsUnread
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1570,12 +1660,12 @@ func (client *Client) ToggleChatDefaultDisableNotification(chatId int64, default
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1593,12 +1683,12 @@ func (client *Client) SetChatClientData(chatId int64, clientData string) (*Ok, e
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1616,12 +1706,12 @@ func (client *Client) SetChatDescription(chatId int64, description string) (*Ok,
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1639,12 +1729,12 @@ func (client *Client) SetChatDiscussionGroup(chatId int64, discussionChatId int6
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1662,12 +1752,12 @@ func (client *Client) SetChatLocation(chatId int64, location *ChatLocation) (*Ok
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1685,12 +1775,12 @@ func (client *Client) SetChatSlowModeDelay(chatId int64, slowModeDelay int32) (*
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1712,12 +1802,12 @@ func (client *Client) PinChatMessage(chatId int64, messageId int64, disableNotif
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1735,12 +1825,12 @@ func (client *Client) UnpinChatMessage(chatId int64, messageId int64) (*Ok, erro
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1756,12 +1846,12 @@ func (client *Client) UnpinAllChatMessages(chatId int64) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1777,12 +1867,12 @@ func (client *Client) JoinChat(chatId int64) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1798,12 +1888,12 @@ func (client *Client) LeaveChat(chatId int64) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1823,12 +1913,12 @@ func (client *Client) AddChatMember(chatId int64, userId int64, forwardLimit int
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1846,12 +1936,12 @@ func (client *Client) AddChatMembers(chatId int64, userIds []int64) (*Ok, error)
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1871,12 +1961,12 @@ func (client *Client) SetChatMemberStatus(chatId int64, memberId MessageSender,
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1898,12 +1988,12 @@ func (client *Client) BanChatMember(chatId int64, memberId MessageSender, banned
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var okDummy Ok
-	err = json.Unmarshal(result.Raw, &okDummy)
+	err = jsonUnmarshal(result.Raw, &okDummy)
 	return &okDummy, err
 }
 
@@ -1923,12 +2013,12 @@ func (client *Client) TransferChatOwnership(chatId int64, userId int64, password
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1944,12 +2034,12 @@ func (client *Client) ClearAllDraftMessages(excludeSecretChats bool) (*Ok, error
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1967,12 +2057,12 @@ func (client *Client) SetScopeNotificationSettings(scope NotificationSettingsSco
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -1986,12 +2076,12 @@ func (client *Client) ResetAllNotificationSettings() (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2011,12 +2101,12 @@ func (client *Client) ToggleChatIsPinned(chatList ChatList, chatId int64, isPinn
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2034,12 +2124,12 @@ func (client *Client) SetPinnedChats(chatList ChatList, chatIds []int64) (*Ok, e
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2057,12 +2147,12 @@ func (client *Client) CancelDownloadFile(fileId int32, onlyIfPending bool) (*Ok,
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2078,12 +2168,12 @@ func (client *Client) CancelUploadFile(fileId int32) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2103,12 +2193,12 @@ func (client *Client) WriteGeneratedFilePart(generationId JSONInt64, offset int3
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2128,12 +2218,12 @@ func (client *Client) SetFileGenerationProgress(generationId JSONInt64, expected
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2151,12 +2241,12 @@ func (client *Client) FinishFileGeneration(generationId JSONInt64, error *Error)
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2172,12 +2262,12 @@ func (client *Client) DeleteFile(fileId int32) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2197,12 +2287,12 @@ func (client *Client) ImportMessages(chatId int64, messageFile InputFile, attach
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2220,12 +2310,12 @@ func (client *Client) DeleteRevokedChatInviteLink(chatId int64, inviteLink strin
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2243,12 +2333,12 @@ func (client *Client) DeleteAllRevokedChatInviteLinks(chatId int64, creatorUserI
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2268,12 +2358,12 @@ func (client *Client) ProcessChatJoinRequest(chatId int64, userId int64, approve
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2293,12 +2383,12 @@ func (client *Client) ProcessChatJoinRequests(chatId int64, inviteLink string, a
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2316,12 +2406,12 @@ func (client *Client) AcceptCall(callId int32, protocol *CallProtocol) (*Ok, err
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2339,12 +2429,12 @@ func (client *Client) SendCallSignalingData(callId int32, data []byte) (*Ok, err
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2368,12 +2458,12 @@ func (client *Client) DiscardCall(callId int32, isDisconnected bool, duration in
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2395,12 +2485,12 @@ func (client *Client) SendCallRating(callId int32, rating int32, comment string,
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2418,12 +2508,12 @@ func (client *Client) SendCallDebugInformation(callId int32, debugInformation st
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2441,12 +2531,12 @@ func (client *Client) SetVideoChatDefaultParticipant(chatId int64, defaultPartic
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2462,12 +2552,12 @@ func (client *Client) StartScheduledGroupCall(groupCallId int32) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2485,12 +2575,12 @@ func (client *Client) ToggleGroupCallEnabledStartNotification(groupCallId int32,
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2508,12 +2598,12 @@ func (client *Client) ToggleGroupCallScreenSharingIsPaused(groupCallId int32, is
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2529,12 +2619,12 @@ func (client *Client) EndGroupCallScreenSharing(groupCallId int32) (*Ok, error)
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2552,12 +2642,12 @@ func (client *Client) SetGroupCallTitle(groupCallId int32, title string) (*Ok, e
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2575,12 +2665,12 @@ func (client *Client) ToggleGroupCallMuteNewParticipants(groupCallId int32, mute
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2598,12 +2688,12 @@ func (client *Client) InviteGroupCallParticipants(groupCallId int32, userIds []i
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2619,12 +2709,12 @@ func (client *Client) RevokeGroupCallInviteLink(groupCallId int32) (*Ok, error)
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2646,12 +2736,12 @@ func (client *Client) StartGroupCallRecording(groupCallId int32, title string, r
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2667,12 +2757,12 @@ func (client *Client) EndGroupCallRecording(groupCallId int32) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2690,12 +2780,12 @@ func (client *Client) ToggleGroupCallIsMyVideoPaused(groupCallId int32, isMyVide
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2713,12 +2803,12 @@ func (client *Client) ToggleGroupCallIsMyVideoEnabled(groupCallId int32, isMyVid
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2738,12 +2828,12 @@ func (client *Client) SetGroupCallParticipantIsSpeaking(groupCallId int32, audio
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2763,12 +2853,12 @@ func (client *Client) ToggleGroupCallParticipantIsMuted(groupCallId int32, parti
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2788,12 +2878,12 @@ func (client *Client) SetGroupCallParticipantVolumeLevel(groupCallId int32, part
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2813,12 +2903,12 @@ func (client *Client) ToggleGroupCallParticipantIsHandRaised(groupCallId int32,
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2836,12 +2926,12 @@ func (client *Client) LoadGroupCallParticipants(groupCallId int32, limit int32)
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2857,12 +2947,12 @@ func (client *Client) LeaveGroupCall(groupCallId int32) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2878,12 +2968,12 @@ func (client *Client) EndGroupCall(groupCallId int32) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2901,12 +2991,12 @@ func (client *Client) ToggleMessageSenderIsBlocked(senderId MessageSender, isBlo
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2928,12 +3018,12 @@ func (client *Client) BlockMessageSenderFromReplies(messageId int64, deleteMessa
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2951,12 +3041,12 @@ func (client *Client) AddContact(contact *Contact, sharePhoneNumber bool) (*Ok,
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2972,12 +3062,12 @@ func (client *Client) RemoveContacts(userIds []int64) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -2991,12 +3081,12 @@ func (client *Client) ClearImportedContacts() (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3012,12 +3102,12 @@ func (client *Client) SharePhoneNumber(userId int64) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3037,12 +3127,12 @@ func (client *Client) ChangeStickerSet(setId JSONInt64, isInstalled bool, isArch
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3058,12 +3148,12 @@ func (client *Client) ViewTrendingStickerSets(stickerSetIds []JSONInt64) (*Ok, e
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3081,12 +3171,12 @@ func (client *Client) ReorderInstalledStickerSets(isMasks bool, stickerSetIds []
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3104,12 +3194,12 @@ func (client *Client) RemoveRecentSticker(isAttached bool, sticker InputFile) (*
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3125,12 +3215,12 @@ func (client *Client) ClearRecentStickers(isAttached bool) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3146,12 +3236,12 @@ func (client *Client) AddFavoriteSticker(sticker InputFile) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3167,12 +3257,12 @@ func (client *Client) RemoveFavoriteSticker(sticker InputFile) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3188,12 +3278,12 @@ func (client *Client) AddSavedAnimation(animation InputFile) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3209,12 +3299,12 @@ func (client *Client) RemoveSavedAnimation(animation InputFile) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3230,12 +3320,12 @@ func (client *Client) RemoveRecentHashtag(hashtag string) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3251,12 +3341,12 @@ func (client *Client) SetProfilePhoto(photo InputChatPhoto) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3272,12 +3362,12 @@ func (client *Client) DeleteProfilePhoto(profilePhotoId JSONInt64) (*Ok, error)
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3295,12 +3385,12 @@ func (client *Client) SetName(firstName string, lastName string) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3316,12 +3406,12 @@ func (client *Client) SetBio(bio string) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3337,12 +3427,12 @@ func (client *Client) SetUsername(username string) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3358,12 +3448,12 @@ func (client *Client) SetLocation(location *Location) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3379,12 +3469,12 @@ func (client *Client) CheckChangePhoneNumberCode(code string) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3404,12 +3494,12 @@ func (client *Client) SetCommands(scope BotCommandScope, languageCode string, co
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3427,12 +3517,12 @@ func (client *Client) DeleteCommands(scope BotCommandScope, languageCode string)
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3448,12 +3538,12 @@ func (client *Client) TerminateSession(sessionId JSONInt64) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3467,12 +3557,12 @@ func (client *Client) TerminateAllOtherSessions() (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3490,12 +3580,12 @@ func (client *Client) ToggleSessionCanAcceptCalls(sessionId JSONInt64, canAccept
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3513,12 +3603,12 @@ func (client *Client) ToggleSessionCanAcceptSecretChats(sessionId JSONInt64, can
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3534,12 +3624,12 @@ func (client *Client) SetInactiveSessionTtl(inactiveSessionTtlDays int32) (*Ok,
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3555,12 +3645,12 @@ func (client *Client) DisconnectWebsite(websiteId JSONInt64) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3574,12 +3664,12 @@ func (client *Client) DisconnectAllWebsites() (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3597,12 +3687,12 @@ func (client *Client) SetSupergroupUsername(supergroupId int64, username string)
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3620,12 +3710,12 @@ func (client *Client) SetSupergroupStickerSet(supergroupId int64, stickerSetId J
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3643,12 +3733,12 @@ func (client *Client) ToggleSupergroupSignMessages(supergroupId int64, signMessa
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3666,12 +3756,12 @@ func (client *Client) ToggleSupergroupIsAllHistoryAvailable(supergroupId int64,
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3687,12 +3777,12 @@ func (client *Client) ToggleSupergroupIsBroadcastGroup(supergroupId int64) (*Ok,
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3710,12 +3800,12 @@ func (client *Client) ReportSupergroupSpam(supergroupId int64, messageIds []int6
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3731,12 +3821,12 @@ func (client *Client) CloseSecretChat(secretChatId int32) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3750,12 +3840,12 @@ func (client *Client) DeleteSavedOrderInfo() (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3769,12 +3859,12 @@ func (client *Client) DeleteSavedCredentials() (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3790,12 +3880,12 @@ func (client *Client) RemoveBackground(backgroundId JSONInt64) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3809,12 +3899,12 @@ func (client *Client) ResetBackgrounds() (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3830,12 +3920,12 @@ func (client *Client) SynchronizeLanguagePack(languagePackId string) (*Ok, error
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3851,12 +3941,12 @@ func (client *Client) AddCustomServerLanguagePack(languagePackId string) (*Ok, e
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3874,12 +3964,12 @@ func (client *Client) SetCustomLanguagePack(info *LanguagePackInfo, strings []La
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3895,12 +3985,12 @@ func (client *Client) EditCustomLanguagePackInfo(info *LanguagePackInfo) (*Ok, e
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3918,12 +4008,12 @@ func (client *Client) SetCustomLanguagePackString(languagePackId string, newStri
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3939,12 +4029,12 @@ func (client *Client) DeleteLanguagePack(languagePackId string) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3960,12 +4050,12 @@ func (client *Client) ProcessPushNotification(payload string) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -3983,12 +4073,12 @@ func (client *Client) SetUserPrivacySettingRules(setting UserPrivacySetting, rul
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4006,12 +4096,12 @@ func (client *Client) SetOption(name string, value OptionValue) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4027,12 +4117,12 @@ func (client *Client) SetAccountTtl(ttl *AccountTtl) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4048,12 +4138,12 @@ func (client *Client) DeleteAccount(reason string) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4069,12 +4159,12 @@ func (client *Client) RemoveChatActionBar(chatId int64) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4096,12 +4186,12 @@ func (client *Client) ReportChat(chatId int64, messageIds []int64, reason ChatRe
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4123,12 +4213,12 @@ func (client *Client) ReportChatPhoto(chatId int64, fileId int32, reason ChatRep
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4144,12 +4234,12 @@ func (client *Client) SetNetworkType(typeParam NetworkType) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4165,12 +4255,12 @@ func (client *Client) AddNetworkStatistics(entry NetworkStatisticsEntry) (*Ok, e
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4184,12 +4274,12 @@ func (client *Client) ResetNetworkStatistics() (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4207,12 +4297,12 @@ func (client *Client) SetAutoDownloadSettings(settings *AutoDownloadSettings, ty
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4228,12 +4318,12 @@ func (client *Client) DeletePassportElement(typeParam PassportElementType) (*Ok,
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4251,12 +4341,12 @@ func (client *Client) SetPassportElementErrors(userId int64, errors []InputPassp
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4272,12 +4362,12 @@ func (client *Client) CheckPhoneNumberVerificationCode(code string) (*Ok, error)
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4293,12 +4383,12 @@ func (client *Client) CheckEmailAddressVerificationCode(code string) (*Ok, error
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4316,12 +4406,12 @@ func (client *Client) SendPassportAuthorizationForm(autorizationFormId int32, ty
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4337,12 +4427,12 @@ func (client *Client) CheckPhoneNumberConfirmationCode(code string) (*Ok, error)
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4360,12 +4450,12 @@ func (client *Client) SetBotUpdatesStatus(pendingUpdateCount int32, errorMessage
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4383,12 +4473,12 @@ func (client *Client) SetStickerPositionInSet(sticker InputFile, position int32)
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4404,12 +4494,12 @@ func (client *Client) RemoveStickerFromSet(sticker InputFile) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4425,12 +4515,12 @@ func (client *Client) AcceptTermsOfService(termsOfServiceId string) (*Ok, error)
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4448,12 +4538,12 @@ func (client *Client) AnswerCustomQuery(customQueryId JSONInt64, data string) (*
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4469,12 +4559,12 @@ func (client *Client) SetAlarm(seconds float64) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4494,12 +4584,12 @@ func (client *Client) SaveApplicationLogEvent(typeParam string, chatId int64, da
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4515,12 +4605,12 @@ func (client *Client) EnableProxy(proxyId int32) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4534,12 +4624,12 @@ func (client *Client) DisableProxy() (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4555,12 +4645,12 @@ func (client *Client) RemoveProxy(proxyId int32) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4576,12 +4666,33 @@ func (client *Client) SetLogStream(logStream LogStream) (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
+		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
+	}
+
+	var ok Ok
+	err = jsonUnmarshal(result.Raw, &ok)
+	return &ok, err
+}
+
+// ExecuteSetLogStream Synchronously executes SetLogStream, without a client. Sets new log stream for internal logging of TDLib. Can be called synchronously
+// @param logStream New log stream
+func ExecuteSetLogStream(logStream LogStream) (*Ok, error) {
+	result, err := execute(UpdateData{
+		"@type":      "setLogStream",
+		"log_stream": logStream,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4597,12 +4708,33 @@ func (client *Client) SetLogVerbosityLevel(newVerbosityLevel int32) (*Ok, error)
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
+		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
+	}
+
+	var ok Ok
+	err = jsonUnmarshal(result.Raw, &ok)
+	return &ok, err
+}
+
+// ExecuteSetLogVerbosityLevel Synchronously executes SetLogVerbosityLevel, without a client. Sets the verbosity level of the internal logging of TDLib. Can be called synchronously
+// @param newVerbosityLevel New value of the verbosity level for logging. Value 0 corresponds to fatal errors, value 1 corresponds to errors, value 2 corresponds to warnings and debug warnings, value 3 corresponds to informational, value 4 corresponds to debug, value 5 corresponds to verbose debug, value greater than 5 and up to 1023 can be used to enable even more logging
+func ExecuteSetLogVerbosityLevel(newVerbosityLevel int32) (*Ok, error) {
+	result, err := execute(UpdateData{
+		"@type":               "setLogVerbosityLevel",
+		"new_verbosity_level": newVerbosityLevel,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4620,12 +4752,35 @@ func (client *Client) SetLogTagVerbosityLevel(tag string, newVerbosityLevel int3
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
+	return &ok, err
+}
+
+// ExecuteSetLogTagVerbosityLevel Synchronously executes SetLogTagVerbosityLevel, without a client. Sets the verbosity level for a specified TDLib internal log tag. Can be called synchronously
+// @param tag Logging tag to change verbosity level
+// @param newVerbosityLevel New verbosity level; 1-1024
+func ExecuteSetLogTagVerbosityLevel(tag string, newVerbosityLevel int32) (*Ok, error) {
+	result, err := execute(UpdateData{
+		"@type":               "setLogTagVerbosityLevel",
+		"tag":                 tag,
+		"new_verbosity_level": newVerbosityLevel,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Data["@type"] == "error" {
+		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
+	}
+
+	var ok Ok
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4643,12 +4798,35 @@ func (client *Client) AddLogMessage(verbosityLevel int32, text string) (*Ok, err
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
+		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
+	}
+
+	var ok Ok
+	err = jsonUnmarshal(result.Raw, &ok)
+	return &ok, err
+}
+
+// ExecuteAddLogMessage Synchronously executes AddLogMessage, without a client. Adds a message to TDLib internal log. Can be called synchronously
+// @param verbosityLevel The minimum verbosity level needed for the message to be logged; 0-1023
+// @param text Text of a message to log
+func ExecuteAddLogMessage(verbosityLevel int32, text string) (*Ok, error) {
+	result, err := execute(UpdateData{
+		"@type":           "addLogMessage",
+		"verbosity_level": verbosityLevel,
+		"text":            text,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4662,12 +4840,12 @@ func (client *Client) TestCallEmpty() (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4681,12 +4859,12 @@ func (client *Client) TestNetwork() (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4710,12 +4888,12 @@ func (client *Client) TestProxy(server string, port int32, typeParam ProxyType,
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
 
@@ -4729,11 +4907,11 @@ func (client *Client) TestGetDifference() (*Ok, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
+	err = jsonUnmarshal(result.Raw, &ok)
 	return &ok, err
 }
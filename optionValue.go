@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // OptionValue Represents the value of an option
 type OptionValue interface {
 	GetOptionValueEnum() OptionValueEnum
+	Accept(visitor OptionValueVisitor)
 }
 
 // OptionValueEnum Alias for abstract OptionValue 'Sub-Classes', used as constant-enum here
@@ -21,43 +23,94 @@ const (
 	OptionValueStringType  OptionValueEnum = "optionValueString"
 )
 
+// UnknownOptionValue is an OptionValue of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownOptionValue struct {
+	Unknown
+}
+
+// GetOptionValueEnum return the enum type of this object
+func (unknownOptionValue *UnknownOptionValue) GetOptionValueEnum() OptionValueEnum {
+	return OptionValueEnum(unknownOptionValue.Type)
+}
+
+// Accept calls the method of the visitor for UnknownOptionValue
+func (unknownOptionValue *UnknownOptionValue) Accept(visitor OptionValueVisitor) {
+	visitor.VisitUnknownOptionValue(unknownOptionValue)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownOptionValue *UnknownOptionValue) String() string {
+	return objectString(unknownOptionValue)
+}
+
+// LogValue logs the object as its summary
+func (unknownOptionValue *UnknownOptionValue) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownOptionValue))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownOptionValue *UnknownOptionValue) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownOptionValue)
+}
+
+func (unknownOptionValue *UnknownOptionValue) printText(p *printer) {
+	if unknownOptionValue == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownOptionValue")
+	p.string("@type", unknownOptionValue.Type)
+	p.endObject(state)
+}
+
 func unmarshalOptionValue(rawMsg *json.RawMessage) (OptionValue, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch OptionValueEnum(objMap["@type"].(string)) {
+	switch OptionValueEnum(typeName) {
 	case OptionValueBooleanType:
 		var optionValueBoolean OptionValueBoolean
-		err := json.Unmarshal(*rawMsg, &optionValueBoolean)
+		err := jsonUnmarshal(*rawMsg, &optionValueBoolean)
 		return &optionValueBoolean, err
 
 	case OptionValueEmptyType:
 		var optionValueEmpty OptionValueEmpty
-		err := json.Unmarshal(*rawMsg, &optionValueEmpty)
+		err := jsonUnmarshal(*rawMsg, &optionValueEmpty)
 		return &optionValueEmpty, err
 
 	case OptionValueIntegerType:
 		var optionValueInteger OptionValueInteger
-		err := json.Unmarshal(*rawMsg, &optionValueInteger)
+		err := jsonUnmarshal(*rawMsg, &optionValueInteger)
 		return &optionValueInteger, err
 
 	case OptionValueStringType:
 		var optionValueString OptionValueString
-		err := json.Unmarshal(*rawMsg, &optionValueString)
+		err := jsonUnmarshal(*rawMsg, &optionValueString)
 		return &optionValueString, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownOptionValue{Unknown: unknown}, nil
 	}
 }
 
+func decodeOptionValue(r *jsonReader) (OptionValue, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalOptionValue(rawMsg)
+}
+
 // OptionValueBoolean Represents a boolean option
 type OptionValueBoolean struct {
 	tdCommon
@@ -81,11 +134,118 @@ func NewOptionValueBoolean(value bool) *OptionValueBoolean {
 	return &optionValueBooleanTemp
 }
 
+// MarshalJSON marshals to json
+func (optionValueBoolean *OptionValueBoolean) MarshalJSON() ([]byte, error) {
+	return optionValueBoolean.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (optionValueBoolean *OptionValueBoolean) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, optionValueBoolean)
+}
+
+func (optionValueBoolean *OptionValueBoolean) appendJSON(b []byte) ([]byte, error) {
+	if optionValueBoolean == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, optionValueBoolean.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, optionValueBoolean.tdCommon.Extra)
+	b = append(b, `,"value":`...)
+	b = appendJSONBool(b, optionValueBoolean.Value)
+	return append(b, '}'), nil
+}
+
+func (optionValueBoolean *OptionValueBoolean) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			optionValueBoolean.tdCommon.Type, err = r.readString()
+		case "@extra":
+			optionValueBoolean.tdCommon.Extra, err = r.readString()
+		case "value":
+			optionValueBoolean.Value, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (optionValueBoolean *OptionValueBoolean) Clone() *OptionValueBoolean {
+	if optionValueBoolean == nil {
+		return nil
+	}
+	clone := *optionValueBoolean
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (optionValueBoolean *OptionValueBoolean) Equal(other *OptionValueBoolean) bool {
+	if optionValueBoolean == nil || other == nil {
+		return optionValueBoolean == other
+	}
+	if optionValueBoolean.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (optionValueBoolean *OptionValueBoolean) Diff(other *OptionValueBoolean) []Change {
+	return optionValueBoolean.diff("", other, nil)
+}
+
+func (optionValueBoolean *OptionValueBoolean) diff(path string, other *OptionValueBoolean, changes []Change) []Change {
+	if optionValueBoolean == nil || other == nil {
+		if optionValueBoolean != other {
+			changes = append(changes, Change{Path: path, Old: optionValueBoolean, New: other})
+		}
+		return changes
+	}
+	if optionValueBoolean.Value != other.Value {
+		changes = append(changes, Change{Path: fieldPath(path, "value"), Old: optionValueBoolean.Value, New: other.Value})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (optionValueBoolean *OptionValueBoolean) String() string {
+	return objectString(optionValueBoolean)
+}
+
+// LogValue logs the object as its summary
+func (optionValueBoolean *OptionValueBoolean) LogValue() slog.Value {
+	return slog.StringValue(objectString(optionValueBoolean))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (optionValueBoolean *OptionValueBoolean) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, optionValueBoolean)
+}
+
+func (optionValueBoolean *OptionValueBoolean) printText(p *printer) {
+	if optionValueBoolean == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("OptionValueBoolean")
+	p.bool("value", optionValueBoolean.Value)
+	p.endObject(state)
+}
+
 // GetOptionValueEnum return the enum type of this object
 func (optionValueBoolean *OptionValueBoolean) GetOptionValueEnum() OptionValueEnum {
 	return OptionValueBooleanType
 }
 
+// Accept calls the method of the visitor for OptionValueBoolean
+func (optionValueBoolean *OptionValueBoolean) Accept(visitor OptionValueVisitor) {
+	visitor.VisitOptionValueBoolean(optionValueBoolean)
+}
+
 // OptionValueEmpty Represents an unknown option or an option which has a default value
 type OptionValueEmpty struct {
 	tdCommon
@@ -97,7 +257,6 @@ func (optionValueEmpty *OptionValueEmpty) MessageType() string {
 }
 
 // NewOptionValueEmpty creates a new OptionValueEmpty
-//
 func NewOptionValueEmpty() *OptionValueEmpty {
 	optionValueEmptyTemp := OptionValueEmpty{
 		tdCommon: tdCommon{Type: "optionValueEmpty"},
@@ -106,11 +265,107 @@ func NewOptionValueEmpty() *OptionValueEmpty {
 	return &optionValueEmptyTemp
 }
 
+// MarshalJSON marshals to json
+func (optionValueEmpty *OptionValueEmpty) MarshalJSON() ([]byte, error) {
+	return optionValueEmpty.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (optionValueEmpty *OptionValueEmpty) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, optionValueEmpty)
+}
+
+func (optionValueEmpty *OptionValueEmpty) appendJSON(b []byte) ([]byte, error) {
+	if optionValueEmpty == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, optionValueEmpty.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, optionValueEmpty.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (optionValueEmpty *OptionValueEmpty) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			optionValueEmpty.tdCommon.Type, err = r.readString()
+		case "@extra":
+			optionValueEmpty.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (optionValueEmpty *OptionValueEmpty) Clone() *OptionValueEmpty {
+	if optionValueEmpty == nil {
+		return nil
+	}
+	clone := *optionValueEmpty
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (optionValueEmpty *OptionValueEmpty) Equal(other *OptionValueEmpty) bool {
+	if optionValueEmpty == nil || other == nil {
+		return optionValueEmpty == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (optionValueEmpty *OptionValueEmpty) Diff(other *OptionValueEmpty) []Change {
+	return optionValueEmpty.diff("", other, nil)
+}
+
+func (optionValueEmpty *OptionValueEmpty) diff(path string, other *OptionValueEmpty, changes []Change) []Change {
+	if optionValueEmpty == nil || other == nil {
+		if optionValueEmpty != other {
+			changes = append(changes, Change{Path: path, Old: optionValueEmpty, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (optionValueEmpty *OptionValueEmpty) String() string {
+	return objectString(optionValueEmpty)
+}
+
+// LogValue logs the object as its summary
+func (optionValueEmpty *OptionValueEmpty) LogValue() slog.Value {
+	return slog.StringValue(objectString(optionValueEmpty))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (optionValueEmpty *OptionValueEmpty) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, optionValueEmpty)
+}
+
+func (optionValueEmpty *OptionValueEmpty) printText(p *printer) {
+	if optionValueEmpty == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("OptionValueEmpty")
+	p.endObject(state)
+}
+
 // GetOptionValueEnum return the enum type of this object
 func (optionValueEmpty *OptionValueEmpty) GetOptionValueEnum() OptionValueEnum {
 	return OptionValueEmptyType
 }
 
+// Accept calls the method of the visitor for OptionValueEmpty
+func (optionValueEmpty *OptionValueEmpty) Accept(visitor OptionValueVisitor) {
+	visitor.VisitOptionValueEmpty(optionValueEmpty)
+}
+
 // OptionValueInteger Represents an integer option
 type OptionValueInteger struct {
 	tdCommon
@@ -134,11 +389,118 @@ func NewOptionValueInteger(value JSONInt64) *OptionValueInteger {
 	return &optionValueIntegerTemp
 }
 
+// MarshalJSON marshals to json
+func (optionValueInteger *OptionValueInteger) MarshalJSON() ([]byte, error) {
+	return optionValueInteger.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (optionValueInteger *OptionValueInteger) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, optionValueInteger)
+}
+
+func (optionValueInteger *OptionValueInteger) appendJSON(b []byte) ([]byte, error) {
+	if optionValueInteger == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, optionValueInteger.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, optionValueInteger.tdCommon.Extra)
+	b = append(b, `,"value":`...)
+	b = appendJSONInt64String(b, int64(optionValueInteger.Value))
+	return append(b, '}'), nil
+}
+
+func (optionValueInteger *OptionValueInteger) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			optionValueInteger.tdCommon.Type, err = r.readString()
+		case "@extra":
+			optionValueInteger.tdCommon.Extra, err = r.readString()
+		case "value":
+			optionValueInteger.Value, err = r.readJSONInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (optionValueInteger *OptionValueInteger) Clone() *OptionValueInteger {
+	if optionValueInteger == nil {
+		return nil
+	}
+	clone := *optionValueInteger
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (optionValueInteger *OptionValueInteger) Equal(other *OptionValueInteger) bool {
+	if optionValueInteger == nil || other == nil {
+		return optionValueInteger == other
+	}
+	if optionValueInteger.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (optionValueInteger *OptionValueInteger) Diff(other *OptionValueInteger) []Change {
+	return optionValueInteger.diff("", other, nil)
+}
+
+func (optionValueInteger *OptionValueInteger) diff(path string, other *OptionValueInteger, changes []Change) []Change {
+	if optionValueInteger == nil || other == nil {
+		if optionValueInteger != other {
+			changes = append(changes, Change{Path: path, Old: optionValueInteger, New: other})
+		}
+		return changes
+	}
+	if optionValueInteger.Value != other.Value {
+		changes = append(changes, Change{Path: fieldPath(path, "value"), Old: optionValueInteger.Value, New: other.Value})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (optionValueInteger *OptionValueInteger) String() string {
+	return objectString(optionValueInteger)
+}
+
+// LogValue logs the object as its summary
+func (optionValueInteger *OptionValueInteger) LogValue() slog.Value {
+	return slog.StringValue(objectString(optionValueInteger))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (optionValueInteger *OptionValueInteger) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, optionValueInteger)
+}
+
+func (optionValueInteger *OptionValueInteger) printText(p *printer) {
+	if optionValueInteger == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("OptionValueInteger")
+	p.int("value", int64(optionValueInteger.Value))
+	p.endObject(state)
+}
+
 // GetOptionValueEnum return the enum type of this object
 func (optionValueInteger *OptionValueInteger) GetOptionValueEnum() OptionValueEnum {
 	return OptionValueIntegerType
 }
 
+// Accept calls the method of the visitor for OptionValueInteger
+func (optionValueInteger *OptionValueInteger) Accept(visitor OptionValueVisitor) {
+	visitor.VisitOptionValueInteger(optionValueInteger)
+}
+
 // OptionValueString Represents a string option
 type OptionValueString struct {
 	tdCommon
@@ -162,11 +524,243 @@ func NewOptionValueString(value string) *OptionValueString {
 	return &optionValueStringTemp
 }
 
+// MarshalJSON marshals to json
+func (optionValueString *OptionValueString) MarshalJSON() ([]byte, error) {
+	return optionValueString.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (optionValueString *OptionValueString) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, optionValueString)
+}
+
+func (optionValueString *OptionValueString) appendJSON(b []byte) ([]byte, error) {
+	if optionValueString == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, optionValueString.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, optionValueString.tdCommon.Extra)
+	b = append(b, `,"value":`...)
+	b = appendJSONString(b, optionValueString.Value)
+	return append(b, '}'), nil
+}
+
+func (optionValueString *OptionValueString) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			optionValueString.tdCommon.Type, err = r.readString()
+		case "@extra":
+			optionValueString.tdCommon.Extra, err = r.readString()
+		case "value":
+			optionValueString.Value, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (optionValueString *OptionValueString) Clone() *OptionValueString {
+	if optionValueString == nil {
+		return nil
+	}
+	clone := *optionValueString
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (optionValueString *OptionValueString) Equal(other *OptionValueString) bool {
+	if optionValueString == nil || other == nil {
+		return optionValueString == other
+	}
+	if optionValueString.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (optionValueString *OptionValueString) Diff(other *OptionValueString) []Change {
+	return optionValueString.diff("", other, nil)
+}
+
+func (optionValueString *OptionValueString) diff(path string, other *OptionValueString, changes []Change) []Change {
+	if optionValueString == nil || other == nil {
+		if optionValueString != other {
+			changes = append(changes, Change{Path: path, Old: optionValueString, New: other})
+		}
+		return changes
+	}
+	if optionValueString.Value != other.Value {
+		changes = append(changes, Change{Path: fieldPath(path, "value"), Old: optionValueString.Value, New: other.Value})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (optionValueString *OptionValueString) String() string {
+	return objectString(optionValueString)
+}
+
+// LogValue logs the object as its summary
+func (optionValueString *OptionValueString) LogValue() slog.Value {
+	return slog.StringValue(objectString(optionValueString))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (optionValueString *OptionValueString) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, optionValueString)
+}
+
+func (optionValueString *OptionValueString) printText(p *printer) {
+	if optionValueString == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("OptionValueString")
+	p.string("value", optionValueString.Value)
+	p.endObject(state)
+}
+
 // GetOptionValueEnum return the enum type of this object
 func (optionValueString *OptionValueString) GetOptionValueEnum() OptionValueEnum {
 	return OptionValueStringType
 }
 
+// Accept calls the method of the visitor for OptionValueString
+func (optionValueString *OptionValueString) Accept(visitor OptionValueVisitor) {
+	visitor.VisitOptionValueString(optionValueString)
+}
+
+// OptionValueVisitor visits every type of OptionValue, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to OptionValue, until they handle it too.
+type OptionValueVisitor interface {
+	VisitOptionValueBoolean(optionValueBoolean *OptionValueBoolean)
+	VisitOptionValueEmpty(optionValueEmpty *OptionValueEmpty)
+	VisitOptionValueInteger(optionValueInteger *OptionValueInteger)
+	VisitOptionValueString(optionValueString *OptionValueString)
+	VisitUnknownOptionValue(unknownOptionValue *UnknownOptionValue)
+}
+
+// OptionValueCases holds the function MatchOptionValue calls for each type of OptionValue;
+// types without a function are passed to the default case
+type OptionValueCases[R any] struct {
+	OptionValueBoolean func(optionValueBoolean *OptionValueBoolean) R
+	OptionValueEmpty   func(optionValueEmpty *OptionValueEmpty) R
+	OptionValueInteger func(optionValueInteger *OptionValueInteger) R
+	OptionValueString  func(optionValueString *OptionValueString) R
+}
+
+// MatchOptionValue calls the case of the type of optionValue, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement OptionValueVisitor
+// to have the compiler check every type is handled.
+func MatchOptionValue[R any](optionValue OptionValue, cases OptionValueCases[R], defaultCase func(optionValue OptionValue) R) R {
+	switch value := optionValue.(type) {
+	case *OptionValueBoolean:
+		if cases.OptionValueBoolean != nil {
+			return cases.OptionValueBoolean(value)
+		}
+	case *OptionValueEmpty:
+		if cases.OptionValueEmpty != nil {
+			return cases.OptionValueEmpty(value)
+		}
+	case *OptionValueInteger:
+		if cases.OptionValueInteger != nil {
+			return cases.OptionValueInteger(value)
+		}
+	case *OptionValueString:
+		if cases.OptionValueString != nil {
+			return cases.OptionValueString(value)
+		}
+	}
+	return defaultCase(optionValue)
+}
+
+// Clone returns a deep copy of the object
+func (unknownOptionValue *UnknownOptionValue) Clone() *UnknownOptionValue {
+	if unknownOptionValue == nil {
+		return nil
+	}
+	return &UnknownOptionValue{Unknown: unknownOptionValue.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownOptionValue *UnknownOptionValue) Equal(other *UnknownOptionValue) bool {
+	if unknownOptionValue == nil || other == nil {
+		return unknownOptionValue == other
+	}
+	return unknownOptionValue.Unknown.equal(other.Unknown)
+}
+
+func cloneOptionValue(optionValue OptionValue) OptionValue {
+	switch value := optionValue.(type) {
+	case *OptionValueBoolean:
+		return value.Clone()
+	case *OptionValueEmpty:
+		return value.Clone()
+	case *OptionValueInteger:
+		return value.Clone()
+	case *OptionValueString:
+		return value.Clone()
+	case *UnknownOptionValue:
+		return value.Clone()
+	}
+	return optionValue
+}
+
+func equalOptionValue(a OptionValue, b OptionValue) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *OptionValueBoolean:
+		b, ok := b.(*OptionValueBoolean)
+		return ok && a.Equal(b)
+	case *OptionValueEmpty:
+		b, ok := b.(*OptionValueEmpty)
+		return ok && a.Equal(b)
+	case *OptionValueInteger:
+		b, ok := b.(*OptionValueInteger)
+		return ok && a.Equal(b)
+	case *OptionValueString:
+		b, ok := b.(*OptionValueString)
+		return ok && a.Equal(b)
+	case *UnknownOptionValue:
+		b, ok := b.(*UnknownOptionValue)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffOptionValue(path string, a OptionValue, b OptionValue, changes []Change) []Change {
+	switch a := a.(type) {
+	case *OptionValueBoolean:
+		if b, ok := b.(*OptionValueBoolean); ok {
+			return a.diff(path, b, changes)
+		}
+	case *OptionValueEmpty:
+		if b, ok := b.(*OptionValueEmpty); ok {
+			return a.diff(path, b, changes)
+		}
+	case *OptionValueInteger:
+		if b, ok := b.(*OptionValueInteger); ok {
+			return a.diff(path, b, changes)
+		}
+	case *OptionValueString:
+		if b, ok := b.(*OptionValueString); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalOptionValue(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
+
 // GetOption Returns the value of an option by its name. (Check the list of available options on https://core.telegram.org/tdlib/options.) Can be called before authorization
 // @param name The name of the option
 func (client *Client) GetOption(name string) (OptionValue, error) {
@@ -179,30 +773,31 @@ func (client *Client) GetOption(name string) (OptionValue, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
-	switch OptionValueEnum(result.Data["@type"].(string)) {
+	resultType, _ := result.Data["@type"].(string)
+	switch OptionValueEnum(resultType) {
 
 	case OptionValueBooleanType:
 		var optionValue OptionValueBoolean
-		err = json.Unmarshal(result.Raw, &optionValue)
+		err = jsonUnmarshal(result.Raw, &optionValue)
 		return &optionValue, err
 
 	case OptionValueEmptyType:
 		var optionValue OptionValueEmpty
-		err = json.Unmarshal(result.Raw, &optionValue)
+		err = jsonUnmarshal(result.Raw, &optionValue)
 		return &optionValue, err
 
 	case OptionValueIntegerType:
 		var optionValue OptionValueInteger
-		err = json.Unmarshal(result.Raw, &optionValue)
+		err = jsonUnmarshal(result.Raw, &optionValue)
 		return &optionValue, err
 
 	case OptionValueStringType:
 		var optionValue OptionValueString
-		err = json.Unmarshal(result.Raw, &optionValue)
+		err = jsonUnmarshal(result.Raw, &optionValue)
 		return &optionValue, err
 
 	default:
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // OrderInfo Order information
@@ -37,6 +37,149 @@ func NewOrderInfo(name string, phoneNumber string, emailAddress string, shipping
 	return &orderInfoTemp
 }
 
+// MarshalJSON marshals to json
+func (orderInfo *OrderInfo) MarshalJSON() ([]byte, error) {
+	return orderInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (orderInfo *OrderInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, orderInfo)
+}
+
+func (orderInfo *OrderInfo) appendJSON(b []byte) ([]byte, error) {
+	if orderInfo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, orderInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, orderInfo.tdCommon.Extra)
+	b = append(b, `,"name":`...)
+	b = appendJSONString(b, orderInfo.Name)
+	b = append(b, `,"phone_number":`...)
+	b = appendJSONString(b, orderInfo.PhoneNumber)
+	b = append(b, `,"email_address":`...)
+	b = appendJSONString(b, orderInfo.EmailAddress)
+	b = append(b, `,"shipping_address":`...)
+	b, err = orderInfo.ShippingAddress.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (orderInfo *OrderInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			orderInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			orderInfo.tdCommon.Extra, err = r.readString()
+		case "name":
+			orderInfo.Name, err = r.readString()
+		case "phone_number":
+			orderInfo.PhoneNumber, err = r.readString()
+		case "email_address":
+			orderInfo.EmailAddress, err = r.readString()
+		case "shipping_address":
+			if r.readNull() {
+				orderInfo.ShippingAddress = nil
+			} else {
+				orderInfo.ShippingAddress = new(Address)
+				err = orderInfo.ShippingAddress.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (orderInfo *OrderInfo) Clone() *OrderInfo {
+	if orderInfo == nil {
+		return nil
+	}
+	clone := *orderInfo
+	clone.ShippingAddress = orderInfo.ShippingAddress.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (orderInfo *OrderInfo) Equal(other *OrderInfo) bool {
+	if orderInfo == nil || other == nil {
+		return orderInfo == other
+	}
+	if orderInfo.Name != other.Name {
+		return false
+	}
+	if orderInfo.PhoneNumber != other.PhoneNumber {
+		return false
+	}
+	if orderInfo.EmailAddress != other.EmailAddress {
+		return false
+	}
+	if !orderInfo.ShippingAddress.Equal(other.ShippingAddress) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (orderInfo *OrderInfo) Diff(other *OrderInfo) []Change {
+	return orderInfo.diff("", other, nil)
+}
+
+func (orderInfo *OrderInfo) diff(path string, other *OrderInfo, changes []Change) []Change {
+	if orderInfo == nil || other == nil {
+		if orderInfo != other {
+			changes = append(changes, Change{Path: path, Old: orderInfo, New: other})
+		}
+		return changes
+	}
+	if orderInfo.Name != other.Name {
+		changes = append(changes, Change{Path: fieldPath(path, "name"), Old: orderInfo.Name, New: other.Name})
+	}
+	if orderInfo.PhoneNumber != other.PhoneNumber {
+		changes = append(changes, Change{Path: fieldPath(path, "phone_number"), Old: orderInfo.PhoneNumber, New: other.PhoneNumber})
+	}
+	if orderInfo.EmailAddress != other.EmailAddress {
+		changes = append(changes, Change{Path: fieldPath(path, "email_address"), Old: orderInfo.EmailAddress, New: other.EmailAddress})
+	}
+	changes = orderInfo.ShippingAddress.diff(fieldPath(path, "shipping_address"), other.ShippingAddress, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (orderInfo *OrderInfo) String() string {
+	return objectString(orderInfo)
+}
+
+// LogValue logs the object as its summary
+func (orderInfo *OrderInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(orderInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (orderInfo *OrderInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, orderInfo)
+}
+
+func (orderInfo *OrderInfo) printText(p *printer) {
+	if orderInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("OrderInfo")
+	p.string("name", orderInfo.Name)
+	p.string("phone_number", orderInfo.PhoneNumber)
+	p.string("email_address", orderInfo.EmailAddress)
+	p.object("shipping_address", orderInfo.ShippingAddress)
+	p.endObject(state)
+}
+
 // GetSavedOrderInfo Returns saved order info, if any
 func (client *Client) GetSavedOrderInfo() (*OrderInfo, error) {
 	result, err := client.SendAndCatch(UpdateData{
@@ -47,11 +190,11 @@ func (client *Client) GetSavedOrderInfo() (*OrderInfo, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var orderInfo OrderInfo
-	err = json.Unmarshal(result.Raw, &orderInfo)
+	err = jsonUnmarshal(result.Raw, &orderInfo)
 	return &orderInfo, err
 }
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // PageBlock Describes a block of an instant view web page
 type PageBlock interface {
 	GetPageBlockEnum() PageBlockEnum
+	Accept(visitor PageBlockVisitor)
 }
 
 // PageBlockEnum Alias for abstract PageBlock 'Sub-Classes', used as constant-enum here
@@ -46,166 +48,240 @@ const (
 	PageBlockMapType             PageBlockEnum = "pageBlockMap"
 )
 
+// UnknownPageBlock is a PageBlock of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownPageBlock struct {
+	Unknown
+}
+
+// GetPageBlockEnum return the enum type of this object
+func (unknownPageBlock *UnknownPageBlock) GetPageBlockEnum() PageBlockEnum {
+	return PageBlockEnum(unknownPageBlock.Type)
+}
+
+// Accept calls the method of the visitor for UnknownPageBlock
+func (unknownPageBlock *UnknownPageBlock) Accept(visitor PageBlockVisitor) {
+	visitor.VisitUnknownPageBlock(unknownPageBlock)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownPageBlock *UnknownPageBlock) String() string {
+	return objectString(unknownPageBlock)
+}
+
+// LogValue logs the object as its summary
+func (unknownPageBlock *UnknownPageBlock) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownPageBlock))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownPageBlock *UnknownPageBlock) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownPageBlock)
+}
+
+func (unknownPageBlock *UnknownPageBlock) printText(p *printer) {
+	if unknownPageBlock == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownPageBlock")
+	p.string("@type", unknownPageBlock.Type)
+	p.endObject(state)
+}
+
 func unmarshalPageBlock(rawMsg *json.RawMessage) (PageBlock, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch PageBlockEnum(objMap["@type"].(string)) {
+	switch PageBlockEnum(typeName) {
 	case PageBlockTitleType:
 		var pageBlockTitle PageBlockTitle
-		err := json.Unmarshal(*rawMsg, &pageBlockTitle)
+		err := jsonUnmarshal(*rawMsg, &pageBlockTitle)
 		return &pageBlockTitle, err
 
 	case PageBlockSubtitleType:
 		var pageBlockSubtitle PageBlockSubtitle
-		err := json.Unmarshal(*rawMsg, &pageBlockSubtitle)
+		err := jsonUnmarshal(*rawMsg, &pageBlockSubtitle)
 		return &pageBlockSubtitle, err
 
 	case PageBlockAuthorDateType:
 		var pageBlockAuthorDate PageBlockAuthorDate
-		err := json.Unmarshal(*rawMsg, &pageBlockAuthorDate)
+		err := jsonUnmarshal(*rawMsg, &pageBlockAuthorDate)
 		return &pageBlockAuthorDate, err
 
 	case PageBlockHeaderType:
 		var pageBlockHeader PageBlockHeader
-		err := json.Unmarshal(*rawMsg, &pageBlockHeader)
+		err := jsonUnmarshal(*rawMsg, &pageBlockHeader)
 		return &pageBlockHeader, err
 
 	case PageBlockSubheaderType:
 		var pageBlockSubheader PageBlockSubheader
-		err := json.Unmarshal(*rawMsg, &pageBlockSubheader)
+		err := jsonUnmarshal(*rawMsg, &pageBlockSubheader)
 		return &pageBlockSubheader, err
 
 	case PageBlockKickerType:
 		var pageBlockKicker PageBlockKicker
-		err := json.Unmarshal(*rawMsg, &pageBlockKicker)
+		err := jsonUnmarshal(*rawMsg, &pageBlockKicker)
 		return &pageBlockKicker, err
 
 	case PageBlockParagraphType:
 		var pageBlockParagraph PageBlockParagraph
-		err := json.Unmarshal(*rawMsg, &pageBlockParagraph)
+		err := jsonUnmarshal(*rawMsg, &pageBlockParagraph)
 		return &pageBlockParagraph, err
 
 	case PageBlockPreformattedType:
 		var pageBlockPreformatted PageBlockPreformatted
-		err := json.Unmarshal(*rawMsg, &pageBlockPreformatted)
+		err := jsonUnmarshal(*rawMsg, &pageBlockPreformatted)
 		return &pageBlockPreformatted, err
 
 	case PageBlockFooterType:
 		var pageBlockFooter PageBlockFooter
-		err := json.Unmarshal(*rawMsg, &pageBlockFooter)
+		err := jsonUnmarshal(*rawMsg, &pageBlockFooter)
 		return &pageBlockFooter, err
 
 	case PageBlockDividerType:
 		var pageBlockDivider PageBlockDivider
-		err := json.Unmarshal(*rawMsg, &pageBlockDivider)
+		err := jsonUnmarshal(*rawMsg, &pageBlockDivider)
 		return &pageBlockDivider, err
 
 	case PageBlockAnchorType:
 		var pageBlockAnchor PageBlockAnchor
-		err := json.Unmarshal(*rawMsg, &pageBlockAnchor)
+		err := jsonUnmarshal(*rawMsg, &pageBlockAnchor)
 		return &pageBlockAnchor, err
 
 	case PageBlockListType:
 		var pageBlockList PageBlockList
-		err := json.Unmarshal(*rawMsg, &pageBlockList)
+		err := jsonUnmarshal(*rawMsg, &pageBlockList)
 		return &pageBlockList, err
 
 	case PageBlockBlockQuoteType:
 		var pageBlockBlockQuote PageBlockBlockQuote
-		err := json.Unmarshal(*rawMsg, &pageBlockBlockQuote)
+		err := jsonUnmarshal(*rawMsg, &pageBlockBlockQuote)
 		return &pageBlockBlockQuote, err
 
 	case PageBlockPullQuoteType:
 		var pageBlockPullQuote PageBlockPullQuote
-		err := json.Unmarshal(*rawMsg, &pageBlockPullQuote)
+		err := jsonUnmarshal(*rawMsg, &pageBlockPullQuote)
 		return &pageBlockPullQuote, err
 
 	case PageBlockAnimationType:
 		var pageBlockAnimation PageBlockAnimation
-		err := json.Unmarshal(*rawMsg, &pageBlockAnimation)
+		err := jsonUnmarshal(*rawMsg, &pageBlockAnimation)
 		return &pageBlockAnimation, err
 
 	case PageBlockAudioType:
 		var pageBlockAudio PageBlockAudio
-		err := json.Unmarshal(*rawMsg, &pageBlockAudio)
+		err := jsonUnmarshal(*rawMsg, &pageBlockAudio)
 		return &pageBlockAudio, err
 
 	case PageBlockPhotoType:
 		var pageBlockPhoto PageBlockPhoto
-		err := json.Unmarshal(*rawMsg, &pageBlockPhoto)
+		err := jsonUnmarshal(*rawMsg, &pageBlockPhoto)
 		return &pageBlockPhoto, err
 
 	case PageBlockVideoType:
 		var pageBlockVideo PageBlockVideo
-		err := json.Unmarshal(*rawMsg, &pageBlockVideo)
+		err := jsonUnmarshal(*rawMsg, &pageBlockVideo)
 		return &pageBlockVideo, err
 
 	case PageBlockVoiceNoteType:
 		var pageBlockVoiceNote PageBlockVoiceNote
-		err := json.Unmarshal(*rawMsg, &pageBlockVoiceNote)
+		err := jsonUnmarshal(*rawMsg, &pageBlockVoiceNote)
 		return &pageBlockVoiceNote, err
 
 	case PageBlockCoverType:
 		var pageBlockCover PageBlockCover
-		err := json.Unmarshal(*rawMsg, &pageBlockCover)
+		err := jsonUnmarshal(*rawMsg, &pageBlockCover)
 		return &pageBlockCover, err
 
 	case PageBlockEmbeddedType:
 		var pageBlockEmbedded PageBlockEmbedded
-		err := json.Unmarshal(*rawMsg, &pageBlockEmbedded)
+		err := jsonUnmarshal(*rawMsg, &pageBlockEmbedded)
 		return &pageBlockEmbedded, err
 
 	case PageBlockEmbeddedPostType:
 		var pageBlockEmbeddedPost PageBlockEmbeddedPost
-		err := json.Unmarshal(*rawMsg, &pageBlockEmbeddedPost)
+		err := jsonUnmarshal(*rawMsg, &pageBlockEmbeddedPost)
 		return &pageBlockEmbeddedPost, err
 
 	case PageBlockCollageType:
 		var pageBlockCollage PageBlockCollage
-		err := json.Unmarshal(*rawMsg, &pageBlockCollage)
+		err := jsonUnmarshal(*rawMsg, &pageBlockCollage)
 		return &pageBlockCollage, err
 
 	case PageBlockSlideshowType:
 		var pageBlockSlideshow PageBlockSlideshow
-		err := json.Unmarshal(*rawMsg, &pageBlockSlideshow)
+		err := jsonUnmarshal(*rawMsg, &pageBlockSlideshow)
 		return &pageBlockSlideshow, err
 
 	case PageBlockChatLinkType:
 		var pageBlockChatLink PageBlockChatLink
-		err := json.Unmarshal(*rawMsg, &pageBlockChatLink)
+		err := jsonUnmarshal(*rawMsg, &pageBlockChatLink)
 		return &pageBlockChatLink, err
 
 	case PageBlockTableType:
 		var pageBlockTable PageBlockTable
-		err := json.Unmarshal(*rawMsg, &pageBlockTable)
+		err := jsonUnmarshal(*rawMsg, &pageBlockTable)
 		return &pageBlockTable, err
 
 	case PageBlockDetailsType:
 		var pageBlockDetails PageBlockDetails
-		err := json.Unmarshal(*rawMsg, &pageBlockDetails)
+		err := jsonUnmarshal(*rawMsg, &pageBlockDetails)
 		return &pageBlockDetails, err
 
 	case PageBlockRelatedArticlesType:
 		var pageBlockRelatedArticles PageBlockRelatedArticles
-		err := json.Unmarshal(*rawMsg, &pageBlockRelatedArticles)
+		err := jsonUnmarshal(*rawMsg, &pageBlockRelatedArticles)
 		return &pageBlockRelatedArticles, err
 
 	case PageBlockMapType:
 		var pageBlockMap PageBlockMap
-		err := json.Unmarshal(*rawMsg, &pageBlockMap)
+		err := jsonUnmarshal(*rawMsg, &pageBlockMap)
 		return &pageBlockMap, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownPageBlock{Unknown: unknown}, nil
+	}
+}
+
+func unmarshalPageBlockSlice(rawMsg *json.RawMessage) ([]PageBlock, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var rawItems []*json.RawMessage
+	err := jsonUnmarshal(*rawMsg, &rawItems)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]PageBlock, 0, len(rawItems))
+	for _, rawItem := range rawItems {
+		item, err := unmarshalPageBlock(rawItem)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func decodePageBlock(r *jsonReader) (PageBlock, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
 	}
+	return unmarshalPageBlock(rawMsg)
 }
 
 // PageBlockTitle The title of a page
@@ -231,27 +307,109 @@ func NewPageBlockTitle(title RichText) *PageBlockTitle {
 	return &pageBlockTitleTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockTitle *PageBlockTitle) MarshalJSON() ([]byte, error) {
+	return pageBlockTitle.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (pageBlockTitle *PageBlockTitle) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, pageBlockTitle)
+}
+
+func (pageBlockTitle *PageBlockTitle) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockTitle == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockTitle.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockTitle.tdCommon.Extra)
+	b = append(b, `,"title":`...)
+	b, err = appendJSONValue(b, pageBlockTitle.Title)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (pageBlockTitle *PageBlockTitle) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockTitle.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockTitle.tdCommon.Extra, err = r.readString()
+		case "title":
+			pageBlockTitle.Title, err = decodeRichText(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockTitle *PageBlockTitle) Clone() *PageBlockTitle {
+	if pageBlockTitle == nil {
+		return nil
+	}
+	clone := *pageBlockTitle
+	clone.Title = cloneRichText(pageBlockTitle.Title)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockTitle *PageBlockTitle) Equal(other *PageBlockTitle) bool {
+	if pageBlockTitle == nil || other == nil {
+		return pageBlockTitle == other
+	}
+	if !equalRichText(pageBlockTitle.Title, other.Title) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockTitle *PageBlockTitle) Diff(other *PageBlockTitle) []Change {
+	return pageBlockTitle.diff("", other, nil)
+}
+
+func (pageBlockTitle *PageBlockTitle) diff(path string, other *PageBlockTitle, changes []Change) []Change {
+	if pageBlockTitle == nil || other == nil {
+		if pageBlockTitle != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockTitle, New: other})
+		}
+		return changes
 	}
+	changes = diffRichText(fieldPath(path, "title"), pageBlockTitle.Title, other.Title, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockTitle *PageBlockTitle) String() string {
+	return objectString(pageBlockTitle)
+}
 
-	pageBlockTitle.tdCommon = tempObj.tdCommon
+// LogValue logs the object as its summary
+func (pageBlockTitle *PageBlockTitle) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockTitle))
+}
 
-	fieldTitle, _ := unmarshalRichText(objMap["title"])
-	pageBlockTitle.Title = fieldTitle
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockTitle *PageBlockTitle) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockTitle)
+}
 
-	return nil
+func (pageBlockTitle *PageBlockTitle) printText(p *printer) {
+	if pageBlockTitle == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockTitle")
+	p.object("title", pageBlockTitle.Title)
+	p.endObject(state)
 }
 
 // GetPageBlockEnum return the enum type of this object
@@ -259,6 +417,11 @@ func (pageBlockTitle *PageBlockTitle) GetPageBlockEnum() PageBlockEnum {
 	return PageBlockTitleType
 }
 
+// Accept calls the method of the visitor for PageBlockTitle
+func (pageBlockTitle *PageBlockTitle) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockTitle(pageBlockTitle)
+}
+
 // PageBlockSubtitle The subtitle of a page
 type PageBlockSubtitle struct {
 	tdCommon
@@ -282,27 +445,109 @@ func NewPageBlockSubtitle(subtitle RichText) *PageBlockSubtitle {
 	return &pageBlockSubtitleTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockSubtitle *PageBlockSubtitle) MarshalJSON() ([]byte, error) {
+	return pageBlockSubtitle.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (pageBlockSubtitle *PageBlockSubtitle) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, pageBlockSubtitle)
+}
+
+func (pageBlockSubtitle *PageBlockSubtitle) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockSubtitle == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockSubtitle.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockSubtitle.tdCommon.Extra)
+	b = append(b, `,"subtitle":`...)
+	b, err = appendJSONValue(b, pageBlockSubtitle.Subtitle)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (pageBlockSubtitle *PageBlockSubtitle) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockSubtitle.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockSubtitle.tdCommon.Extra, err = r.readString()
+		case "subtitle":
+			pageBlockSubtitle.Subtitle, err = decodeRichText(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockSubtitle *PageBlockSubtitle) Clone() *PageBlockSubtitle {
+	if pageBlockSubtitle == nil {
+		return nil
 	}
+	clone := *pageBlockSubtitle
+	clone.Subtitle = cloneRichText(pageBlockSubtitle.Subtitle)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockSubtitle *PageBlockSubtitle) Equal(other *PageBlockSubtitle) bool {
+	if pageBlockSubtitle == nil || other == nil {
+		return pageBlockSubtitle == other
+	}
+	if !equalRichText(pageBlockSubtitle.Subtitle, other.Subtitle) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockSubtitle *PageBlockSubtitle) Diff(other *PageBlockSubtitle) []Change {
+	return pageBlockSubtitle.diff("", other, nil)
+}
+
+func (pageBlockSubtitle *PageBlockSubtitle) diff(path string, other *PageBlockSubtitle, changes []Change) []Change {
+	if pageBlockSubtitle == nil || other == nil {
+		if pageBlockSubtitle != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockSubtitle, New: other})
+		}
+		return changes
+	}
+	changes = diffRichText(fieldPath(path, "subtitle"), pageBlockSubtitle.Subtitle, other.Subtitle, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockSubtitle *PageBlockSubtitle) String() string {
+	return objectString(pageBlockSubtitle)
+}
 
-	pageBlockSubtitle.tdCommon = tempObj.tdCommon
+// LogValue logs the object as its summary
+func (pageBlockSubtitle *PageBlockSubtitle) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockSubtitle))
+}
 
-	fieldSubtitle, _ := unmarshalRichText(objMap["subtitle"])
-	pageBlockSubtitle.Subtitle = fieldSubtitle
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockSubtitle *PageBlockSubtitle) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockSubtitle)
+}
 
-	return nil
+func (pageBlockSubtitle *PageBlockSubtitle) printText(p *printer) {
+	if pageBlockSubtitle == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockSubtitle")
+	p.object("subtitle", pageBlockSubtitle.Subtitle)
+	p.endObject(state)
 }
 
 // GetPageBlockEnum return the enum type of this object
@@ -310,6 +555,11 @@ func (pageBlockSubtitle *PageBlockSubtitle) GetPageBlockEnum() PageBlockEnum {
 	return PageBlockSubtitleType
 }
 
+// Accept calls the method of the visitor for PageBlockSubtitle
+func (pageBlockSubtitle *PageBlockSubtitle) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockSubtitle(pageBlockSubtitle)
+}
+
 // PageBlockAuthorDate The author and publishing date of a page
 type PageBlockAuthorDate struct {
 	tdCommon
@@ -336,29 +586,120 @@ func NewPageBlockAuthorDate(author RichText, publishDate int32) *PageBlockAuthor
 	return &pageBlockAuthorDateTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockAuthorDate *PageBlockAuthorDate) MarshalJSON() ([]byte, error) {
+	return pageBlockAuthorDate.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (pageBlockAuthorDate *PageBlockAuthorDate) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, pageBlockAuthorDate)
+}
+
+func (pageBlockAuthorDate *PageBlockAuthorDate) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockAuthorDate == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-		PublishDate int32 `json:"publish_date"` // Point in time (Unix timestamp) when the article was published; 0 if unknown
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockAuthorDate.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockAuthorDate.tdCommon.Extra)
+	b = append(b, `,"author":`...)
+	b, err = appendJSONValue(b, pageBlockAuthorDate.Author)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"publish_date":`...)
+	b = appendJSONInt(b, int64(pageBlockAuthorDate.PublishDate))
+	return append(b, '}'), nil
+}
+
+func (pageBlockAuthorDate *PageBlockAuthorDate) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockAuthorDate.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockAuthorDate.tdCommon.Extra, err = r.readString()
+		case "author":
+			pageBlockAuthorDate.Author, err = decodeRichText(r)
+		case "publish_date":
+			pageBlockAuthorDate.PublishDate, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockAuthorDate *PageBlockAuthorDate) Clone() *PageBlockAuthorDate {
+	if pageBlockAuthorDate == nil {
+		return nil
+	}
+	clone := *pageBlockAuthorDate
+	clone.Author = cloneRichText(pageBlockAuthorDate.Author)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockAuthorDate *PageBlockAuthorDate) Equal(other *PageBlockAuthorDate) bool {
+	if pageBlockAuthorDate == nil || other == nil {
+		return pageBlockAuthorDate == other
+	}
+	if !equalRichText(pageBlockAuthorDate.Author, other.Author) {
+		return false
+	}
+	if pageBlockAuthorDate.PublishDate != other.PublishDate {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockAuthorDate *PageBlockAuthorDate) Diff(other *PageBlockAuthorDate) []Change {
+	return pageBlockAuthorDate.diff("", other, nil)
+}
+
+func (pageBlockAuthorDate *PageBlockAuthorDate) diff(path string, other *PageBlockAuthorDate, changes []Change) []Change {
+	if pageBlockAuthorDate == nil || other == nil {
+		if pageBlockAuthorDate != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockAuthorDate, New: other})
+		}
+		return changes
 	}
+	changes = diffRichText(fieldPath(path, "author"), pageBlockAuthorDate.Author, other.Author, changes)
+	if pageBlockAuthorDate.PublishDate != other.PublishDate {
+		changes = append(changes, Change{Path: fieldPath(path, "publish_date"), Old: pageBlockAuthorDate.PublishDate, New: other.PublishDate})
+	}
+	return changes
+}
 
-	pageBlockAuthorDate.tdCommon = tempObj.tdCommon
-	pageBlockAuthorDate.PublishDate = tempObj.PublishDate
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockAuthorDate *PageBlockAuthorDate) String() string {
+	return objectString(pageBlockAuthorDate)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockAuthorDate *PageBlockAuthorDate) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockAuthorDate))
+}
 
-	fieldAuthor, _ := unmarshalRichText(objMap["author"])
-	pageBlockAuthorDate.Author = fieldAuthor
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockAuthorDate *PageBlockAuthorDate) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockAuthorDate)
+}
 
-	return nil
+func (pageBlockAuthorDate *PageBlockAuthorDate) printText(p *printer) {
+	if pageBlockAuthorDate == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockAuthorDate")
+	p.object("author", pageBlockAuthorDate.Author)
+	p.int("publish_date", int64(pageBlockAuthorDate.PublishDate))
+	p.endObject(state)
 }
 
 // GetPageBlockEnum return the enum type of this object
@@ -366,6 +707,11 @@ func (pageBlockAuthorDate *PageBlockAuthorDate) GetPageBlockEnum() PageBlockEnum
 	return PageBlockAuthorDateType
 }
 
+// Accept calls the method of the visitor for PageBlockAuthorDate
+func (pageBlockAuthorDate *PageBlockAuthorDate) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockAuthorDate(pageBlockAuthorDate)
+}
+
 // PageBlockHeader A header
 type PageBlockHeader struct {
 	tdCommon
@@ -389,27 +735,109 @@ func NewPageBlockHeader(header RichText) *PageBlockHeader {
 	return &pageBlockHeaderTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockHeader *PageBlockHeader) MarshalJSON() ([]byte, error) {
+	return pageBlockHeader.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (pageBlockHeader *PageBlockHeader) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, pageBlockHeader)
+}
+
+func (pageBlockHeader *PageBlockHeader) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockHeader == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockHeader.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockHeader.tdCommon.Extra)
+	b = append(b, `,"header":`...)
+	b, err = appendJSONValue(b, pageBlockHeader.Header)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (pageBlockHeader *PageBlockHeader) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockHeader.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockHeader.tdCommon.Extra, err = r.readString()
+		case "header":
+			pageBlockHeader.Header, err = decodeRichText(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockHeader *PageBlockHeader) Clone() *PageBlockHeader {
+	if pageBlockHeader == nil {
+		return nil
+	}
+	clone := *pageBlockHeader
+	clone.Header = cloneRichText(pageBlockHeader.Header)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockHeader *PageBlockHeader) Equal(other *PageBlockHeader) bool {
+	if pageBlockHeader == nil || other == nil {
+		return pageBlockHeader == other
+	}
+	if !equalRichText(pageBlockHeader.Header, other.Header) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockHeader *PageBlockHeader) Diff(other *PageBlockHeader) []Change {
+	return pageBlockHeader.diff("", other, nil)
+}
+
+func (pageBlockHeader *PageBlockHeader) diff(path string, other *PageBlockHeader, changes []Change) []Change {
+	if pageBlockHeader == nil || other == nil {
+		if pageBlockHeader != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockHeader, New: other})
+		}
+		return changes
 	}
+	changes = diffRichText(fieldPath(path, "header"), pageBlockHeader.Header, other.Header, changes)
+	return changes
+}
 
-	pageBlockHeader.tdCommon = tempObj.tdCommon
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockHeader *PageBlockHeader) String() string {
+	return objectString(pageBlockHeader)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockHeader *PageBlockHeader) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockHeader))
+}
 
-	fieldHeader, _ := unmarshalRichText(objMap["header"])
-	pageBlockHeader.Header = fieldHeader
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockHeader *PageBlockHeader) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockHeader)
+}
 
-	return nil
+func (pageBlockHeader *PageBlockHeader) printText(p *printer) {
+	if pageBlockHeader == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockHeader")
+	p.object("header", pageBlockHeader.Header)
+	p.endObject(state)
 }
 
 // GetPageBlockEnum return the enum type of this object
@@ -417,6 +845,11 @@ func (pageBlockHeader *PageBlockHeader) GetPageBlockEnum() PageBlockEnum {
 	return PageBlockHeaderType
 }
 
+// Accept calls the method of the visitor for PageBlockHeader
+func (pageBlockHeader *PageBlockHeader) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockHeader(pageBlockHeader)
+}
+
 // PageBlockSubheader A subheader
 type PageBlockSubheader struct {
 	tdCommon
@@ -440,27 +873,109 @@ func NewPageBlockSubheader(subheader RichText) *PageBlockSubheader {
 	return &pageBlockSubheaderTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockSubheader *PageBlockSubheader) MarshalJSON() ([]byte, error) {
+	return pageBlockSubheader.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (pageBlockSubheader *PageBlockSubheader) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, pageBlockSubheader)
+}
+
+func (pageBlockSubheader *PageBlockSubheader) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockSubheader == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockSubheader.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockSubheader.tdCommon.Extra)
+	b = append(b, `,"subheader":`...)
+	b, err = appendJSONValue(b, pageBlockSubheader.Subheader)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (pageBlockSubheader *PageBlockSubheader) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockSubheader.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockSubheader.tdCommon.Extra, err = r.readString()
+		case "subheader":
+			pageBlockSubheader.Subheader, err = decodeRichText(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockSubheader *PageBlockSubheader) Clone() *PageBlockSubheader {
+	if pageBlockSubheader == nil {
+		return nil
+	}
+	clone := *pageBlockSubheader
+	clone.Subheader = cloneRichText(pageBlockSubheader.Subheader)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockSubheader *PageBlockSubheader) Equal(other *PageBlockSubheader) bool {
+	if pageBlockSubheader == nil || other == nil {
+		return pageBlockSubheader == other
+	}
+	if !equalRichText(pageBlockSubheader.Subheader, other.Subheader) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockSubheader *PageBlockSubheader) Diff(other *PageBlockSubheader) []Change {
+	return pageBlockSubheader.diff("", other, nil)
+}
+
+func (pageBlockSubheader *PageBlockSubheader) diff(path string, other *PageBlockSubheader, changes []Change) []Change {
+	if pageBlockSubheader == nil || other == nil {
+		if pageBlockSubheader != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockSubheader, New: other})
+		}
+		return changes
 	}
+	changes = diffRichText(fieldPath(path, "subheader"), pageBlockSubheader.Subheader, other.Subheader, changes)
+	return changes
+}
 
-	pageBlockSubheader.tdCommon = tempObj.tdCommon
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockSubheader *PageBlockSubheader) String() string {
+	return objectString(pageBlockSubheader)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockSubheader *PageBlockSubheader) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockSubheader))
+}
 
-	fieldSubheader, _ := unmarshalRichText(objMap["subheader"])
-	pageBlockSubheader.Subheader = fieldSubheader
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockSubheader *PageBlockSubheader) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockSubheader)
+}
 
-	return nil
+func (pageBlockSubheader *PageBlockSubheader) printText(p *printer) {
+	if pageBlockSubheader == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockSubheader")
+	p.object("subheader", pageBlockSubheader.Subheader)
+	p.endObject(state)
 }
 
 // GetPageBlockEnum return the enum type of this object
@@ -468,6 +983,11 @@ func (pageBlockSubheader *PageBlockSubheader) GetPageBlockEnum() PageBlockEnum {
 	return PageBlockSubheaderType
 }
 
+// Accept calls the method of the visitor for PageBlockSubheader
+func (pageBlockSubheader *PageBlockSubheader) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockSubheader(pageBlockSubheader)
+}
+
 // PageBlockKicker A kicker
 type PageBlockKicker struct {
 	tdCommon
@@ -491,27 +1011,109 @@ func NewPageBlockKicker(kicker RichText) *PageBlockKicker {
 	return &pageBlockKickerTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockKicker *PageBlockKicker) MarshalJSON() ([]byte, error) {
+	return pageBlockKicker.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (pageBlockKicker *PageBlockKicker) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, pageBlockKicker)
+}
+
+func (pageBlockKicker *PageBlockKicker) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockKicker == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockKicker.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockKicker.tdCommon.Extra)
+	b = append(b, `,"kicker":`...)
+	b, err = appendJSONValue(b, pageBlockKicker.Kicker)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (pageBlockKicker *PageBlockKicker) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockKicker.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockKicker.tdCommon.Extra, err = r.readString()
+		case "kicker":
+			pageBlockKicker.Kicker, err = decodeRichText(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockKicker *PageBlockKicker) Clone() *PageBlockKicker {
+	if pageBlockKicker == nil {
+		return nil
+	}
+	clone := *pageBlockKicker
+	clone.Kicker = cloneRichText(pageBlockKicker.Kicker)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockKicker *PageBlockKicker) Equal(other *PageBlockKicker) bool {
+	if pageBlockKicker == nil || other == nil {
+		return pageBlockKicker == other
+	}
+	if !equalRichText(pageBlockKicker.Kicker, other.Kicker) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockKicker *PageBlockKicker) Diff(other *PageBlockKicker) []Change {
+	return pageBlockKicker.diff("", other, nil)
+}
+
+func (pageBlockKicker *PageBlockKicker) diff(path string, other *PageBlockKicker, changes []Change) []Change {
+	if pageBlockKicker == nil || other == nil {
+		if pageBlockKicker != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockKicker, New: other})
+		}
+		return changes
 	}
+	changes = diffRichText(fieldPath(path, "kicker"), pageBlockKicker.Kicker, other.Kicker, changes)
+	return changes
+}
 
-	pageBlockKicker.tdCommon = tempObj.tdCommon
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockKicker *PageBlockKicker) String() string {
+	return objectString(pageBlockKicker)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockKicker *PageBlockKicker) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockKicker))
+}
 
-	fieldKicker, _ := unmarshalRichText(objMap["kicker"])
-	pageBlockKicker.Kicker = fieldKicker
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockKicker *PageBlockKicker) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockKicker)
+}
 
-	return nil
+func (pageBlockKicker *PageBlockKicker) printText(p *printer) {
+	if pageBlockKicker == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockKicker")
+	p.object("kicker", pageBlockKicker.Kicker)
+	p.endObject(state)
 }
 
 // GetPageBlockEnum return the enum type of this object
@@ -519,6 +1121,11 @@ func (pageBlockKicker *PageBlockKicker) GetPageBlockEnum() PageBlockEnum {
 	return PageBlockKickerType
 }
 
+// Accept calls the method of the visitor for PageBlockKicker
+func (pageBlockKicker *PageBlockKicker) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockKicker(pageBlockKicker)
+}
+
 // PageBlockParagraph A text paragraph
 type PageBlockParagraph struct {
 	tdCommon
@@ -542,27 +1149,109 @@ func NewPageBlockParagraph(text RichText) *PageBlockParagraph {
 	return &pageBlockParagraphTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockParagraph *PageBlockParagraph) MarshalJSON() ([]byte, error) {
+	return pageBlockParagraph.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (pageBlockParagraph *PageBlockParagraph) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, pageBlockParagraph)
+}
+
+func (pageBlockParagraph *PageBlockParagraph) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockParagraph == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockParagraph.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockParagraph.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b, err = appendJSONValue(b, pageBlockParagraph.Text)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (pageBlockParagraph *PageBlockParagraph) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockParagraph.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockParagraph.tdCommon.Extra, err = r.readString()
+		case "text":
+			pageBlockParagraph.Text, err = decodeRichText(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockParagraph *PageBlockParagraph) Clone() *PageBlockParagraph {
+	if pageBlockParagraph == nil {
+		return nil
+	}
+	clone := *pageBlockParagraph
+	clone.Text = cloneRichText(pageBlockParagraph.Text)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockParagraph *PageBlockParagraph) Equal(other *PageBlockParagraph) bool {
+	if pageBlockParagraph == nil || other == nil {
+		return pageBlockParagraph == other
+	}
+	if !equalRichText(pageBlockParagraph.Text, other.Text) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockParagraph *PageBlockParagraph) Diff(other *PageBlockParagraph) []Change {
+	return pageBlockParagraph.diff("", other, nil)
+}
+
+func (pageBlockParagraph *PageBlockParagraph) diff(path string, other *PageBlockParagraph, changes []Change) []Change {
+	if pageBlockParagraph == nil || other == nil {
+		if pageBlockParagraph != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockParagraph, New: other})
+		}
+		return changes
 	}
+	changes = diffRichText(fieldPath(path, "text"), pageBlockParagraph.Text, other.Text, changes)
+	return changes
+}
 
-	pageBlockParagraph.tdCommon = tempObj.tdCommon
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockParagraph *PageBlockParagraph) String() string {
+	return objectString(pageBlockParagraph)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockParagraph *PageBlockParagraph) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockParagraph))
+}
 
-	fieldText, _ := unmarshalRichText(objMap["text"])
-	pageBlockParagraph.Text = fieldText
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockParagraph *PageBlockParagraph) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockParagraph)
+}
 
-	return nil
+func (pageBlockParagraph *PageBlockParagraph) printText(p *printer) {
+	if pageBlockParagraph == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockParagraph")
+	p.object("text", pageBlockParagraph.Text)
+	p.endObject(state)
 }
 
 // GetPageBlockEnum return the enum type of this object
@@ -570,6 +1259,11 @@ func (pageBlockParagraph *PageBlockParagraph) GetPageBlockEnum() PageBlockEnum {
 	return PageBlockParagraphType
 }
 
+// Accept calls the method of the visitor for PageBlockParagraph
+func (pageBlockParagraph *PageBlockParagraph) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockParagraph(pageBlockParagraph)
+}
+
 // PageBlockPreformatted A preformatted text paragraph
 type PageBlockPreformatted struct {
 	tdCommon
@@ -596,29 +1290,120 @@ func NewPageBlockPreformatted(text RichText, language string) *PageBlockPreforma
 	return &pageBlockPreformattedTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockPreformatted *PageBlockPreformatted) MarshalJSON() ([]byte, error) {
+	return pageBlockPreformatted.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (pageBlockPreformatted *PageBlockPreformatted) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, pageBlockPreformatted)
+}
+
+func (pageBlockPreformatted *PageBlockPreformatted) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockPreformatted == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-		Language string `json:"language"` // Programming language for which the text needs to be formatted
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockPreformatted.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockPreformatted.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b, err = appendJSONValue(b, pageBlockPreformatted.Text)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"language":`...)
+	b = appendJSONString(b, pageBlockPreformatted.Language)
+	return append(b, '}'), nil
+}
+
+func (pageBlockPreformatted *PageBlockPreformatted) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockPreformatted.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockPreformatted.tdCommon.Extra, err = r.readString()
+		case "text":
+			pageBlockPreformatted.Text, err = decodeRichText(r)
+		case "language":
+			pageBlockPreformatted.Language, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockPreformatted *PageBlockPreformatted) Clone() *PageBlockPreformatted {
+	if pageBlockPreformatted == nil {
+		return nil
+	}
+	clone := *pageBlockPreformatted
+	clone.Text = cloneRichText(pageBlockPreformatted.Text)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockPreformatted *PageBlockPreformatted) Equal(other *PageBlockPreformatted) bool {
+	if pageBlockPreformatted == nil || other == nil {
+		return pageBlockPreformatted == other
+	}
+	if !equalRichText(pageBlockPreformatted.Text, other.Text) {
+		return false
+	}
+	if pageBlockPreformatted.Language != other.Language {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockPreformatted *PageBlockPreformatted) Diff(other *PageBlockPreformatted) []Change {
+	return pageBlockPreformatted.diff("", other, nil)
+}
+
+func (pageBlockPreformatted *PageBlockPreformatted) diff(path string, other *PageBlockPreformatted, changes []Change) []Change {
+	if pageBlockPreformatted == nil || other == nil {
+		if pageBlockPreformatted != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockPreformatted, New: other})
+		}
+		return changes
 	}
+	changes = diffRichText(fieldPath(path, "text"), pageBlockPreformatted.Text, other.Text, changes)
+	if pageBlockPreformatted.Language != other.Language {
+		changes = append(changes, Change{Path: fieldPath(path, "language"), Old: pageBlockPreformatted.Language, New: other.Language})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockPreformatted *PageBlockPreformatted) String() string {
+	return objectString(pageBlockPreformatted)
+}
 
-	pageBlockPreformatted.tdCommon = tempObj.tdCommon
-	pageBlockPreformatted.Language = tempObj.Language
+// LogValue logs the object as its summary
+func (pageBlockPreformatted *PageBlockPreformatted) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockPreformatted))
+}
 
-	fieldText, _ := unmarshalRichText(objMap["text"])
-	pageBlockPreformatted.Text = fieldText
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockPreformatted *PageBlockPreformatted) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockPreformatted)
+}
 
-	return nil
+func (pageBlockPreformatted *PageBlockPreformatted) printText(p *printer) {
+	if pageBlockPreformatted == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockPreformatted")
+	p.object("text", pageBlockPreformatted.Text)
+	p.string("language", pageBlockPreformatted.Language)
+	p.endObject(state)
 }
 
 // GetPageBlockEnum return the enum type of this object
@@ -626,6 +1411,11 @@ func (pageBlockPreformatted *PageBlockPreformatted) GetPageBlockEnum() PageBlock
 	return PageBlockPreformattedType
 }
 
+// Accept calls the method of the visitor for PageBlockPreformatted
+func (pageBlockPreformatted *PageBlockPreformatted) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockPreformatted(pageBlockPreformatted)
+}
+
 // PageBlockFooter The footer of a page
 type PageBlockFooter struct {
 	tdCommon
@@ -649,27 +1439,109 @@ func NewPageBlockFooter(footer RichText) *PageBlockFooter {
 	return &pageBlockFooterTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockFooter *PageBlockFooter) MarshalJSON() ([]byte, error) {
+	return pageBlockFooter.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (pageBlockFooter *PageBlockFooter) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, pageBlockFooter)
+}
+
+func (pageBlockFooter *PageBlockFooter) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockFooter == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockFooter.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockFooter.tdCommon.Extra)
+	b = append(b, `,"footer":`...)
+	b, err = appendJSONValue(b, pageBlockFooter.Footer)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (pageBlockFooter *PageBlockFooter) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockFooter.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockFooter.tdCommon.Extra, err = r.readString()
+		case "footer":
+			pageBlockFooter.Footer, err = decodeRichText(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockFooter *PageBlockFooter) Clone() *PageBlockFooter {
+	if pageBlockFooter == nil {
+		return nil
+	}
+	clone := *pageBlockFooter
+	clone.Footer = cloneRichText(pageBlockFooter.Footer)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockFooter *PageBlockFooter) Equal(other *PageBlockFooter) bool {
+	if pageBlockFooter == nil || other == nil {
+		return pageBlockFooter == other
+	}
+	if !equalRichText(pageBlockFooter.Footer, other.Footer) {
+		return false
 	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockFooter *PageBlockFooter) Diff(other *PageBlockFooter) []Change {
+	return pageBlockFooter.diff("", other, nil)
+}
+
+func (pageBlockFooter *PageBlockFooter) diff(path string, other *PageBlockFooter, changes []Change) []Change {
+	if pageBlockFooter == nil || other == nil {
+		if pageBlockFooter != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockFooter, New: other})
+		}
+		return changes
+	}
+	changes = diffRichText(fieldPath(path, "footer"), pageBlockFooter.Footer, other.Footer, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockFooter *PageBlockFooter) String() string {
+	return objectString(pageBlockFooter)
+}
 
-	pageBlockFooter.tdCommon = tempObj.tdCommon
+// LogValue logs the object as its summary
+func (pageBlockFooter *PageBlockFooter) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockFooter))
+}
 
-	fieldFooter, _ := unmarshalRichText(objMap["footer"])
-	pageBlockFooter.Footer = fieldFooter
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockFooter *PageBlockFooter) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockFooter)
+}
 
-	return nil
+func (pageBlockFooter *PageBlockFooter) printText(p *printer) {
+	if pageBlockFooter == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockFooter")
+	p.object("footer", pageBlockFooter.Footer)
+	p.endObject(state)
 }
 
 // GetPageBlockEnum return the enum type of this object
@@ -677,6 +1549,11 @@ func (pageBlockFooter *PageBlockFooter) GetPageBlockEnum() PageBlockEnum {
 	return PageBlockFooterType
 }
 
+// Accept calls the method of the visitor for PageBlockFooter
+func (pageBlockFooter *PageBlockFooter) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockFooter(pageBlockFooter)
+}
+
 // PageBlockDivider An empty block separating a page
 type PageBlockDivider struct {
 	tdCommon
@@ -688,7 +1565,6 @@ func (pageBlockDivider *PageBlockDivider) MessageType() string {
 }
 
 // NewPageBlockDivider creates a new PageBlockDivider
-//
 func NewPageBlockDivider() *PageBlockDivider {
 	pageBlockDividerTemp := PageBlockDivider{
 		tdCommon: tdCommon{Type: "pageBlockDivider"},
@@ -697,11 +1573,107 @@ func NewPageBlockDivider() *PageBlockDivider {
 	return &pageBlockDividerTemp
 }
 
-// GetPageBlockEnum return the enum type of this object
-func (pageBlockDivider *PageBlockDivider) GetPageBlockEnum() PageBlockEnum {
+// MarshalJSON marshals to json
+func (pageBlockDivider *PageBlockDivider) MarshalJSON() ([]byte, error) {
+	return pageBlockDivider.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pageBlockDivider *PageBlockDivider) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pageBlockDivider)
+}
+
+func (pageBlockDivider *PageBlockDivider) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockDivider == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockDivider.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockDivider.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (pageBlockDivider *PageBlockDivider) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockDivider.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockDivider.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockDivider *PageBlockDivider) Clone() *PageBlockDivider {
+	if pageBlockDivider == nil {
+		return nil
+	}
+	clone := *pageBlockDivider
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockDivider *PageBlockDivider) Equal(other *PageBlockDivider) bool {
+	if pageBlockDivider == nil || other == nil {
+		return pageBlockDivider == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockDivider *PageBlockDivider) Diff(other *PageBlockDivider) []Change {
+	return pageBlockDivider.diff("", other, nil)
+}
+
+func (pageBlockDivider *PageBlockDivider) diff(path string, other *PageBlockDivider, changes []Change) []Change {
+	if pageBlockDivider == nil || other == nil {
+		if pageBlockDivider != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockDivider, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockDivider *PageBlockDivider) String() string {
+	return objectString(pageBlockDivider)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockDivider *PageBlockDivider) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockDivider))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockDivider *PageBlockDivider) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockDivider)
+}
+
+func (pageBlockDivider *PageBlockDivider) printText(p *printer) {
+	if pageBlockDivider == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockDivider")
+	p.endObject(state)
+}
+
+// GetPageBlockEnum return the enum type of this object
+func (pageBlockDivider *PageBlockDivider) GetPageBlockEnum() PageBlockEnum {
 	return PageBlockDividerType
 }
 
+// Accept calls the method of the visitor for PageBlockDivider
+func (pageBlockDivider *PageBlockDivider) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockDivider(pageBlockDivider)
+}
+
 // PageBlockAnchor An invisible anchor on a page, which can be used in a URL to open the page from the specified anchor
 type PageBlockAnchor struct {
 	tdCommon
@@ -725,11 +1697,118 @@ func NewPageBlockAnchor(name string) *PageBlockAnchor {
 	return &pageBlockAnchorTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockAnchor *PageBlockAnchor) MarshalJSON() ([]byte, error) {
+	return pageBlockAnchor.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pageBlockAnchor *PageBlockAnchor) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pageBlockAnchor)
+}
+
+func (pageBlockAnchor *PageBlockAnchor) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockAnchor == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockAnchor.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockAnchor.tdCommon.Extra)
+	b = append(b, `,"name":`...)
+	b = appendJSONString(b, pageBlockAnchor.Name)
+	return append(b, '}'), nil
+}
+
+func (pageBlockAnchor *PageBlockAnchor) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockAnchor.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockAnchor.tdCommon.Extra, err = r.readString()
+		case "name":
+			pageBlockAnchor.Name, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockAnchor *PageBlockAnchor) Clone() *PageBlockAnchor {
+	if pageBlockAnchor == nil {
+		return nil
+	}
+	clone := *pageBlockAnchor
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockAnchor *PageBlockAnchor) Equal(other *PageBlockAnchor) bool {
+	if pageBlockAnchor == nil || other == nil {
+		return pageBlockAnchor == other
+	}
+	if pageBlockAnchor.Name != other.Name {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockAnchor *PageBlockAnchor) Diff(other *PageBlockAnchor) []Change {
+	return pageBlockAnchor.diff("", other, nil)
+}
+
+func (pageBlockAnchor *PageBlockAnchor) diff(path string, other *PageBlockAnchor, changes []Change) []Change {
+	if pageBlockAnchor == nil || other == nil {
+		if pageBlockAnchor != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockAnchor, New: other})
+		}
+		return changes
+	}
+	if pageBlockAnchor.Name != other.Name {
+		changes = append(changes, Change{Path: fieldPath(path, "name"), Old: pageBlockAnchor.Name, New: other.Name})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockAnchor *PageBlockAnchor) String() string {
+	return objectString(pageBlockAnchor)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockAnchor *PageBlockAnchor) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockAnchor))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockAnchor *PageBlockAnchor) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockAnchor)
+}
+
+func (pageBlockAnchor *PageBlockAnchor) printText(p *printer) {
+	if pageBlockAnchor == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockAnchor")
+	p.string("name", pageBlockAnchor.Name)
+	p.endObject(state)
+}
+
 // GetPageBlockEnum return the enum type of this object
 func (pageBlockAnchor *PageBlockAnchor) GetPageBlockEnum() PageBlockEnum {
 	return PageBlockAnchorType
 }
 
+// Accept calls the method of the visitor for PageBlockAnchor
+func (pageBlockAnchor *PageBlockAnchor) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockAnchor(pageBlockAnchor)
+}
+
 // PageBlockList A list of data blocks
 type PageBlockList struct {
 	tdCommon
@@ -753,11 +1832,160 @@ func NewPageBlockList(items []PageBlockListItem) *PageBlockList {
 	return &pageBlockListTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockList *PageBlockList) MarshalJSON() ([]byte, error) {
+	return pageBlockList.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pageBlockList *PageBlockList) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pageBlockList)
+}
+
+func (pageBlockList *PageBlockList) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockList == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockList.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockList.tdCommon.Extra)
+	b = append(b, `,"items":`...)
+	if pageBlockList.Items == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range pageBlockList.Items {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = pageBlockList.Items[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (pageBlockList *PageBlockList) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockList.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockList.tdCommon.Extra, err = r.readString()
+		case "items":
+			if r.readNull() {
+				pageBlockList.Items = nil
+			} else {
+				pageBlockList.Items = make([]PageBlockListItem, 0)
+				err = r.readArray(func() (err error) {
+					var item0 PageBlockListItem
+					err = item0.decodeJSON(r)
+					pageBlockList.Items = append(pageBlockList.Items, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockList *PageBlockList) Clone() *PageBlockList {
+	if pageBlockList == nil {
+		return nil
+	}
+	clone := *pageBlockList
+	if pageBlockList.Items != nil {
+		clone.Items = make([]PageBlockListItem, len(pageBlockList.Items))
+		for i0 := range pageBlockList.Items {
+			clone.Items[i0] = *pageBlockList.Items[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockList *PageBlockList) Equal(other *PageBlockList) bool {
+	if pageBlockList == nil || other == nil {
+		return pageBlockList == other
+	}
+	if len(pageBlockList.Items) != len(other.Items) {
+		return false
+	}
+	for i0 := range pageBlockList.Items {
+		if !pageBlockList.Items[i0].Equal(&other.Items[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockList *PageBlockList) Diff(other *PageBlockList) []Change {
+	return pageBlockList.diff("", other, nil)
+}
+
+func (pageBlockList *PageBlockList) diff(path string, other *PageBlockList, changes []Change) []Change {
+	if pageBlockList == nil || other == nil {
+		if pageBlockList != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockList, New: other})
+		}
+		return changes
+	}
+	if len(pageBlockList.Items) != len(other.Items) {
+		changes = append(changes, Change{Path: fieldPath(path, "items"), Old: pageBlockList.Items, New: other.Items})
+	} else {
+		for i0 := range pageBlockList.Items {
+			changes = pageBlockList.Items[i0].diff(indexPath(fieldPath(path, "items"), i0), &other.Items[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockList *PageBlockList) String() string {
+	return objectString(pageBlockList)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockList *PageBlockList) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockList))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockList *PageBlockList) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockList)
+}
+
+func (pageBlockList *PageBlockList) printText(p *printer) {
+	if pageBlockList == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockList")
+	p.vector("items", len(pageBlockList.Items), func(i0 int) {
+		pageBlockList.Items[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetPageBlockEnum return the enum type of this object
 func (pageBlockList *PageBlockList) GetPageBlockEnum() PageBlockEnum {
 	return PageBlockListType
 }
 
+// Accept calls the method of the visitor for PageBlockList
+func (pageBlockList *PageBlockList) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockList(pageBlockList)
+}
+
 // PageBlockBlockQuote A block quote
 type PageBlockBlockQuote struct {
 	tdCommon
@@ -784,30 +2012,122 @@ func NewPageBlockBlockQuote(text RichText, credit RichText) *PageBlockBlockQuote
 	return &pageBlockBlockQuoteTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockBlockQuote *PageBlockBlockQuote) MarshalJSON() ([]byte, error) {
+	return pageBlockBlockQuote.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (pageBlockBlockQuote *PageBlockBlockQuote) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, pageBlockBlockQuote)
+}
+
+func (pageBlockBlockQuote *PageBlockBlockQuote) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockBlockQuote == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockBlockQuote.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockBlockQuote.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b, err = appendJSONValue(b, pageBlockBlockQuote.Text)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	b = append(b, `,"credit":`...)
+	b, err = appendJSONValue(b, pageBlockBlockQuote.Credit)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (pageBlockBlockQuote *PageBlockBlockQuote) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockBlockQuote.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockBlockQuote.tdCommon.Extra, err = r.readString()
+		case "text":
+			pageBlockBlockQuote.Text, err = decodeRichText(r)
+		case "credit":
+			pageBlockBlockQuote.Credit, err = decodeRichText(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockBlockQuote *PageBlockBlockQuote) Clone() *PageBlockBlockQuote {
+	if pageBlockBlockQuote == nil {
+		return nil
+	}
+	clone := *pageBlockBlockQuote
+	clone.Text = cloneRichText(pageBlockBlockQuote.Text)
+	clone.Credit = cloneRichText(pageBlockBlockQuote.Credit)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockBlockQuote *PageBlockBlockQuote) Equal(other *PageBlockBlockQuote) bool {
+	if pageBlockBlockQuote == nil || other == nil {
+		return pageBlockBlockQuote == other
 	}
+	if !equalRichText(pageBlockBlockQuote.Text, other.Text) {
+		return false
+	}
+	if !equalRichText(pageBlockBlockQuote.Credit, other.Credit) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockBlockQuote *PageBlockBlockQuote) Diff(other *PageBlockBlockQuote) []Change {
+	return pageBlockBlockQuote.diff("", other, nil)
+}
+
+func (pageBlockBlockQuote *PageBlockBlockQuote) diff(path string, other *PageBlockBlockQuote, changes []Change) []Change {
+	if pageBlockBlockQuote == nil || other == nil {
+		if pageBlockBlockQuote != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockBlockQuote, New: other})
+		}
+		return changes
+	}
+	changes = diffRichText(fieldPath(path, "text"), pageBlockBlockQuote.Text, other.Text, changes)
+	changes = diffRichText(fieldPath(path, "credit"), pageBlockBlockQuote.Credit, other.Credit, changes)
+	return changes
+}
 
-	pageBlockBlockQuote.tdCommon = tempObj.tdCommon
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockBlockQuote *PageBlockBlockQuote) String() string {
+	return objectString(pageBlockBlockQuote)
+}
 
-	fieldText, _ := unmarshalRichText(objMap["text"])
-	pageBlockBlockQuote.Text = fieldText
+// LogValue logs the object as its summary
+func (pageBlockBlockQuote *PageBlockBlockQuote) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockBlockQuote))
+}
 
-	fieldCredit, _ := unmarshalRichText(objMap["credit"])
-	pageBlockBlockQuote.Credit = fieldCredit
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockBlockQuote *PageBlockBlockQuote) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockBlockQuote)
+}
 
-	return nil
+func (pageBlockBlockQuote *PageBlockBlockQuote) printText(p *printer) {
+	if pageBlockBlockQuote == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockBlockQuote")
+	p.object("text", pageBlockBlockQuote.Text)
+	p.object("credit", pageBlockBlockQuote.Credit)
+	p.endObject(state)
 }
 
 // GetPageBlockEnum return the enum type of this object
@@ -815,6 +2135,11 @@ func (pageBlockBlockQuote *PageBlockBlockQuote) GetPageBlockEnum() PageBlockEnum
 	return PageBlockBlockQuoteType
 }
 
+// Accept calls the method of the visitor for PageBlockBlockQuote
+func (pageBlockBlockQuote *PageBlockBlockQuote) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockBlockQuote(pageBlockBlockQuote)
+}
+
 // PageBlockPullQuote A pull quote
 type PageBlockPullQuote struct {
 	tdCommon
@@ -841,30 +2166,122 @@ func NewPageBlockPullQuote(text RichText, credit RichText) *PageBlockPullQuote {
 	return &pageBlockPullQuoteTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockPullQuote *PageBlockPullQuote) MarshalJSON() ([]byte, error) {
+	return pageBlockPullQuote.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (pageBlockPullQuote *PageBlockPullQuote) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, pageBlockPullQuote)
+}
+
+func (pageBlockPullQuote *PageBlockPullQuote) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockPullQuote == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockPullQuote.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockPullQuote.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b, err = appendJSONValue(b, pageBlockPullQuote.Text)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	b = append(b, `,"credit":`...)
+	b, err = appendJSONValue(b, pageBlockPullQuote.Credit)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (pageBlockPullQuote *PageBlockPullQuote) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockPullQuote.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockPullQuote.tdCommon.Extra, err = r.readString()
+		case "text":
+			pageBlockPullQuote.Text, err = decodeRichText(r)
+		case "credit":
+			pageBlockPullQuote.Credit, err = decodeRichText(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockPullQuote *PageBlockPullQuote) Clone() *PageBlockPullQuote {
+	if pageBlockPullQuote == nil {
+		return nil
+	}
+	clone := *pageBlockPullQuote
+	clone.Text = cloneRichText(pageBlockPullQuote.Text)
+	clone.Credit = cloneRichText(pageBlockPullQuote.Credit)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockPullQuote *PageBlockPullQuote) Equal(other *PageBlockPullQuote) bool {
+	if pageBlockPullQuote == nil || other == nil {
+		return pageBlockPullQuote == other
+	}
+	if !equalRichText(pageBlockPullQuote.Text, other.Text) {
+		return false
+	}
+	if !equalRichText(pageBlockPullQuote.Credit, other.Credit) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockPullQuote *PageBlockPullQuote) Diff(other *PageBlockPullQuote) []Change {
+	return pageBlockPullQuote.diff("", other, nil)
+}
+
+func (pageBlockPullQuote *PageBlockPullQuote) diff(path string, other *PageBlockPullQuote, changes []Change) []Change {
+	if pageBlockPullQuote == nil || other == nil {
+		if pageBlockPullQuote != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockPullQuote, New: other})
+		}
+		return changes
 	}
+	changes = diffRichText(fieldPath(path, "text"), pageBlockPullQuote.Text, other.Text, changes)
+	changes = diffRichText(fieldPath(path, "credit"), pageBlockPullQuote.Credit, other.Credit, changes)
+	return changes
+}
 
-	pageBlockPullQuote.tdCommon = tempObj.tdCommon
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockPullQuote *PageBlockPullQuote) String() string {
+	return objectString(pageBlockPullQuote)
+}
 
-	fieldText, _ := unmarshalRichText(objMap["text"])
-	pageBlockPullQuote.Text = fieldText
+// LogValue logs the object as its summary
+func (pageBlockPullQuote *PageBlockPullQuote) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockPullQuote))
+}
 
-	fieldCredit, _ := unmarshalRichText(objMap["credit"])
-	pageBlockPullQuote.Credit = fieldCredit
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockPullQuote *PageBlockPullQuote) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockPullQuote)
+}
 
-	return nil
+func (pageBlockPullQuote *PageBlockPullQuote) printText(p *printer) {
+	if pageBlockPullQuote == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockPullQuote")
+	p.object("text", pageBlockPullQuote.Text)
+	p.object("credit", pageBlockPullQuote.Credit)
+	p.endObject(state)
 }
 
 // GetPageBlockEnum return the enum type of this object
@@ -872,6 +2289,11 @@ func (pageBlockPullQuote *PageBlockPullQuote) GetPageBlockEnum() PageBlockEnum {
 	return PageBlockPullQuoteType
 }
 
+// Accept calls the method of the visitor for PageBlockPullQuote
+func (pageBlockPullQuote *PageBlockPullQuote) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockPullQuote(pageBlockPullQuote)
+}
+
 // PageBlockAnimation An animation
 type PageBlockAnimation struct {
 	tdCommon
@@ -901,11 +2323,155 @@ func NewPageBlockAnimation(animation *Animation, caption *PageBlockCaption, need
 	return &pageBlockAnimationTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockAnimation *PageBlockAnimation) MarshalJSON() ([]byte, error) {
+	return pageBlockAnimation.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pageBlockAnimation *PageBlockAnimation) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pageBlockAnimation)
+}
+
+func (pageBlockAnimation *PageBlockAnimation) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockAnimation == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockAnimation.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockAnimation.tdCommon.Extra)
+	b = append(b, `,"animation":`...)
+	b, err = pageBlockAnimation.Animation.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"caption":`...)
+	b, err = pageBlockAnimation.Caption.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"need_autoplay":`...)
+	b = appendJSONBool(b, pageBlockAnimation.NeedAutoplay)
+	return append(b, '}'), nil
+}
+
+func (pageBlockAnimation *PageBlockAnimation) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockAnimation.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockAnimation.tdCommon.Extra, err = r.readString()
+		case "animation":
+			if r.readNull() {
+				pageBlockAnimation.Animation = nil
+			} else {
+				pageBlockAnimation.Animation = new(Animation)
+				err = pageBlockAnimation.Animation.decodeJSON(r)
+			}
+		case "caption":
+			if r.readNull() {
+				pageBlockAnimation.Caption = nil
+			} else {
+				pageBlockAnimation.Caption = new(PageBlockCaption)
+				err = pageBlockAnimation.Caption.decodeJSON(r)
+			}
+		case "need_autoplay":
+			pageBlockAnimation.NeedAutoplay, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockAnimation *PageBlockAnimation) Clone() *PageBlockAnimation {
+	if pageBlockAnimation == nil {
+		return nil
+	}
+	clone := *pageBlockAnimation
+	clone.Animation = pageBlockAnimation.Animation.Clone()
+	clone.Caption = pageBlockAnimation.Caption.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockAnimation *PageBlockAnimation) Equal(other *PageBlockAnimation) bool {
+	if pageBlockAnimation == nil || other == nil {
+		return pageBlockAnimation == other
+	}
+	if !pageBlockAnimation.Animation.Equal(other.Animation) {
+		return false
+	}
+	if !pageBlockAnimation.Caption.Equal(other.Caption) {
+		return false
+	}
+	if pageBlockAnimation.NeedAutoplay != other.NeedAutoplay {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockAnimation *PageBlockAnimation) Diff(other *PageBlockAnimation) []Change {
+	return pageBlockAnimation.diff("", other, nil)
+}
+
+func (pageBlockAnimation *PageBlockAnimation) diff(path string, other *PageBlockAnimation, changes []Change) []Change {
+	if pageBlockAnimation == nil || other == nil {
+		if pageBlockAnimation != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockAnimation, New: other})
+		}
+		return changes
+	}
+	changes = pageBlockAnimation.Animation.diff(fieldPath(path, "animation"), other.Animation, changes)
+	changes = pageBlockAnimation.Caption.diff(fieldPath(path, "caption"), other.Caption, changes)
+	if pageBlockAnimation.NeedAutoplay != other.NeedAutoplay {
+		changes = append(changes, Change{Path: fieldPath(path, "need_autoplay"), Old: pageBlockAnimation.NeedAutoplay, New: other.NeedAutoplay})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockAnimation *PageBlockAnimation) String() string {
+	return objectString(pageBlockAnimation)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockAnimation *PageBlockAnimation) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockAnimation))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockAnimation *PageBlockAnimation) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockAnimation)
+}
+
+func (pageBlockAnimation *PageBlockAnimation) printText(p *printer) {
+	if pageBlockAnimation == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockAnimation")
+	p.object("animation", pageBlockAnimation.Animation)
+	p.object("caption", pageBlockAnimation.Caption)
+	p.bool("need_autoplay", pageBlockAnimation.NeedAutoplay)
+	p.endObject(state)
+}
+
 // GetPageBlockEnum return the enum type of this object
 func (pageBlockAnimation *PageBlockAnimation) GetPageBlockEnum() PageBlockEnum {
 	return PageBlockAnimationType
 }
 
+// Accept calls the method of the visitor for PageBlockAnimation
+func (pageBlockAnimation *PageBlockAnimation) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockAnimation(pageBlockAnimation)
+}
+
 // PageBlockAudio An audio file
 type PageBlockAudio struct {
 	tdCommon
@@ -932,11 +2498,144 @@ func NewPageBlockAudio(audio *Audio, caption *PageBlockCaption) *PageBlockAudio
 	return &pageBlockAudioTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockAudio *PageBlockAudio) MarshalJSON() ([]byte, error) {
+	return pageBlockAudio.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pageBlockAudio *PageBlockAudio) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pageBlockAudio)
+}
+
+func (pageBlockAudio *PageBlockAudio) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockAudio == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockAudio.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockAudio.tdCommon.Extra)
+	b = append(b, `,"audio":`...)
+	b, err = pageBlockAudio.Audio.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"caption":`...)
+	b, err = pageBlockAudio.Caption.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (pageBlockAudio *PageBlockAudio) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockAudio.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockAudio.tdCommon.Extra, err = r.readString()
+		case "audio":
+			if r.readNull() {
+				pageBlockAudio.Audio = nil
+			} else {
+				pageBlockAudio.Audio = new(Audio)
+				err = pageBlockAudio.Audio.decodeJSON(r)
+			}
+		case "caption":
+			if r.readNull() {
+				pageBlockAudio.Caption = nil
+			} else {
+				pageBlockAudio.Caption = new(PageBlockCaption)
+				err = pageBlockAudio.Caption.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockAudio *PageBlockAudio) Clone() *PageBlockAudio {
+	if pageBlockAudio == nil {
+		return nil
+	}
+	clone := *pageBlockAudio
+	clone.Audio = pageBlockAudio.Audio.Clone()
+	clone.Caption = pageBlockAudio.Caption.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockAudio *PageBlockAudio) Equal(other *PageBlockAudio) bool {
+	if pageBlockAudio == nil || other == nil {
+		return pageBlockAudio == other
+	}
+	if !pageBlockAudio.Audio.Equal(other.Audio) {
+		return false
+	}
+	if !pageBlockAudio.Caption.Equal(other.Caption) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockAudio *PageBlockAudio) Diff(other *PageBlockAudio) []Change {
+	return pageBlockAudio.diff("", other, nil)
+}
+
+func (pageBlockAudio *PageBlockAudio) diff(path string, other *PageBlockAudio, changes []Change) []Change {
+	if pageBlockAudio == nil || other == nil {
+		if pageBlockAudio != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockAudio, New: other})
+		}
+		return changes
+	}
+	changes = pageBlockAudio.Audio.diff(fieldPath(path, "audio"), other.Audio, changes)
+	changes = pageBlockAudio.Caption.diff(fieldPath(path, "caption"), other.Caption, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockAudio *PageBlockAudio) String() string {
+	return objectString(pageBlockAudio)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockAudio *PageBlockAudio) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockAudio))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockAudio *PageBlockAudio) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockAudio)
+}
+
+func (pageBlockAudio *PageBlockAudio) printText(p *printer) {
+	if pageBlockAudio == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockAudio")
+	p.object("audio", pageBlockAudio.Audio)
+	p.object("caption", pageBlockAudio.Caption)
+	p.endObject(state)
+}
+
 // GetPageBlockEnum return the enum type of this object
 func (pageBlockAudio *PageBlockAudio) GetPageBlockEnum() PageBlockEnum {
 	return PageBlockAudioType
 }
 
+// Accept calls the method of the visitor for PageBlockAudio
+func (pageBlockAudio *PageBlockAudio) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockAudio(pageBlockAudio)
+}
+
 // PageBlockPhoto A photo
 type PageBlockPhoto struct {
 	tdCommon
@@ -966,11 +2665,155 @@ func NewPageBlockPhoto(photo *Photo, caption *PageBlockCaption, url string) *Pag
 	return &pageBlockPhotoTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockPhoto *PageBlockPhoto) MarshalJSON() ([]byte, error) {
+	return pageBlockPhoto.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pageBlockPhoto *PageBlockPhoto) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pageBlockPhoto)
+}
+
+func (pageBlockPhoto *PageBlockPhoto) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockPhoto == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockPhoto.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockPhoto.tdCommon.Extra)
+	b = append(b, `,"photo":`...)
+	b, err = pageBlockPhoto.Photo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"caption":`...)
+	b, err = pageBlockPhoto.Caption.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"url":`...)
+	b = appendJSONString(b, pageBlockPhoto.Url)
+	return append(b, '}'), nil
+}
+
+func (pageBlockPhoto *PageBlockPhoto) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockPhoto.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockPhoto.tdCommon.Extra, err = r.readString()
+		case "photo":
+			if r.readNull() {
+				pageBlockPhoto.Photo = nil
+			} else {
+				pageBlockPhoto.Photo = new(Photo)
+				err = pageBlockPhoto.Photo.decodeJSON(r)
+			}
+		case "caption":
+			if r.readNull() {
+				pageBlockPhoto.Caption = nil
+			} else {
+				pageBlockPhoto.Caption = new(PageBlockCaption)
+				err = pageBlockPhoto.Caption.decodeJSON(r)
+			}
+		case "url":
+			pageBlockPhoto.Url, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockPhoto *PageBlockPhoto) Clone() *PageBlockPhoto {
+	if pageBlockPhoto == nil {
+		return nil
+	}
+	clone := *pageBlockPhoto
+	clone.Photo = pageBlockPhoto.Photo.Clone()
+	clone.Caption = pageBlockPhoto.Caption.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockPhoto *PageBlockPhoto) Equal(other *PageBlockPhoto) bool {
+	if pageBlockPhoto == nil || other == nil {
+		return pageBlockPhoto == other
+	}
+	if !pageBlockPhoto.Photo.Equal(other.Photo) {
+		return false
+	}
+	if !pageBlockPhoto.Caption.Equal(other.Caption) {
+		return false
+	}
+	if pageBlockPhoto.Url != other.Url {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockPhoto *PageBlockPhoto) Diff(other *PageBlockPhoto) []Change {
+	return pageBlockPhoto.diff("", other, nil)
+}
+
+func (pageBlockPhoto *PageBlockPhoto) diff(path string, other *PageBlockPhoto, changes []Change) []Change {
+	if pageBlockPhoto == nil || other == nil {
+		if pageBlockPhoto != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockPhoto, New: other})
+		}
+		return changes
+	}
+	changes = pageBlockPhoto.Photo.diff(fieldPath(path, "photo"), other.Photo, changes)
+	changes = pageBlockPhoto.Caption.diff(fieldPath(path, "caption"), other.Caption, changes)
+	if pageBlockPhoto.Url != other.Url {
+		changes = append(changes, Change{Path: fieldPath(path, "url"), Old: pageBlockPhoto.Url, New: other.Url})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockPhoto *PageBlockPhoto) String() string {
+	return objectString(pageBlockPhoto)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockPhoto *PageBlockPhoto) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockPhoto))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockPhoto *PageBlockPhoto) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockPhoto)
+}
+
+func (pageBlockPhoto *PageBlockPhoto) printText(p *printer) {
+	if pageBlockPhoto == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockPhoto")
+	p.object("photo", pageBlockPhoto.Photo)
+	p.object("caption", pageBlockPhoto.Caption)
+	p.string("url", pageBlockPhoto.Url)
+	p.endObject(state)
+}
+
 // GetPageBlockEnum return the enum type of this object
 func (pageBlockPhoto *PageBlockPhoto) GetPageBlockEnum() PageBlockEnum {
 	return PageBlockPhotoType
 }
 
+// Accept calls the method of the visitor for PageBlockPhoto
+func (pageBlockPhoto *PageBlockPhoto) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockPhoto(pageBlockPhoto)
+}
+
 // PageBlockVideo A video
 type PageBlockVideo struct {
 	tdCommon
@@ -1003,11 +2846,166 @@ func NewPageBlockVideo(video *Video, caption *PageBlockCaption, needAutoplay boo
 	return &pageBlockVideoTemp
 }
 
-// GetPageBlockEnum return the enum type of this object
+// MarshalJSON marshals to json
+func (pageBlockVideo *PageBlockVideo) MarshalJSON() ([]byte, error) {
+	return pageBlockVideo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pageBlockVideo *PageBlockVideo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pageBlockVideo)
+}
+
+func (pageBlockVideo *PageBlockVideo) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockVideo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockVideo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockVideo.tdCommon.Extra)
+	b = append(b, `,"video":`...)
+	b, err = pageBlockVideo.Video.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"caption":`...)
+	b, err = pageBlockVideo.Caption.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"need_autoplay":`...)
+	b = appendJSONBool(b, pageBlockVideo.NeedAutoplay)
+	b = append(b, `,"is_looped":`...)
+	b = appendJSONBool(b, pageBlockVideo.IsLooped)
+	return append(b, '}'), nil
+}
+
+func (pageBlockVideo *PageBlockVideo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockVideo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockVideo.tdCommon.Extra, err = r.readString()
+		case "video":
+			if r.readNull() {
+				pageBlockVideo.Video = nil
+			} else {
+				pageBlockVideo.Video = new(Video)
+				err = pageBlockVideo.Video.decodeJSON(r)
+			}
+		case "caption":
+			if r.readNull() {
+				pageBlockVideo.Caption = nil
+			} else {
+				pageBlockVideo.Caption = new(PageBlockCaption)
+				err = pageBlockVideo.Caption.decodeJSON(r)
+			}
+		case "need_autoplay":
+			pageBlockVideo.NeedAutoplay, err = r.readBool()
+		case "is_looped":
+			pageBlockVideo.IsLooped, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockVideo *PageBlockVideo) Clone() *PageBlockVideo {
+	if pageBlockVideo == nil {
+		return nil
+	}
+	clone := *pageBlockVideo
+	clone.Video = pageBlockVideo.Video.Clone()
+	clone.Caption = pageBlockVideo.Caption.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockVideo *PageBlockVideo) Equal(other *PageBlockVideo) bool {
+	if pageBlockVideo == nil || other == nil {
+		return pageBlockVideo == other
+	}
+	if !pageBlockVideo.Video.Equal(other.Video) {
+		return false
+	}
+	if !pageBlockVideo.Caption.Equal(other.Caption) {
+		return false
+	}
+	if pageBlockVideo.NeedAutoplay != other.NeedAutoplay {
+		return false
+	}
+	if pageBlockVideo.IsLooped != other.IsLooped {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockVideo *PageBlockVideo) Diff(other *PageBlockVideo) []Change {
+	return pageBlockVideo.diff("", other, nil)
+}
+
+func (pageBlockVideo *PageBlockVideo) diff(path string, other *PageBlockVideo, changes []Change) []Change {
+	if pageBlockVideo == nil || other == nil {
+		if pageBlockVideo != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockVideo, New: other})
+		}
+		return changes
+	}
+	changes = pageBlockVideo.Video.diff(fieldPath(path, "video"), other.Video, changes)
+	changes = pageBlockVideo.Caption.diff(fieldPath(path, "caption"), other.Caption, changes)
+	if pageBlockVideo.NeedAutoplay != other.NeedAutoplay {
+		changes = append(changes, Change{Path: fieldPath(path, "need_autoplay"), Old: pageBlockVideo.NeedAutoplay, New: other.NeedAutoplay})
+	}
+	if pageBlockVideo.IsLooped != other.IsLooped {
+		changes = append(changes, Change{Path: fieldPath(path, "is_looped"), Old: pageBlockVideo.IsLooped, New: other.IsLooped})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockVideo *PageBlockVideo) String() string {
+	return objectString(pageBlockVideo)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockVideo *PageBlockVideo) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockVideo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockVideo *PageBlockVideo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockVideo)
+}
+
+func (pageBlockVideo *PageBlockVideo) printText(p *printer) {
+	if pageBlockVideo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockVideo")
+	p.object("video", pageBlockVideo.Video)
+	p.object("caption", pageBlockVideo.Caption)
+	p.bool("need_autoplay", pageBlockVideo.NeedAutoplay)
+	p.bool("is_looped", pageBlockVideo.IsLooped)
+	p.endObject(state)
+}
+
+// GetPageBlockEnum return the enum type of this object
 func (pageBlockVideo *PageBlockVideo) GetPageBlockEnum() PageBlockEnum {
 	return PageBlockVideoType
 }
 
+// Accept calls the method of the visitor for PageBlockVideo
+func (pageBlockVideo *PageBlockVideo) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockVideo(pageBlockVideo)
+}
+
 // PageBlockVoiceNote A voice note
 type PageBlockVoiceNote struct {
 	tdCommon
@@ -1034,11 +3032,144 @@ func NewPageBlockVoiceNote(voiceNote *VoiceNote, caption *PageBlockCaption) *Pag
 	return &pageBlockVoiceNoteTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockVoiceNote *PageBlockVoiceNote) MarshalJSON() ([]byte, error) {
+	return pageBlockVoiceNote.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pageBlockVoiceNote *PageBlockVoiceNote) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pageBlockVoiceNote)
+}
+
+func (pageBlockVoiceNote *PageBlockVoiceNote) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockVoiceNote == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockVoiceNote.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockVoiceNote.tdCommon.Extra)
+	b = append(b, `,"voice_note":`...)
+	b, err = pageBlockVoiceNote.VoiceNote.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"caption":`...)
+	b, err = pageBlockVoiceNote.Caption.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (pageBlockVoiceNote *PageBlockVoiceNote) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockVoiceNote.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockVoiceNote.tdCommon.Extra, err = r.readString()
+		case "voice_note":
+			if r.readNull() {
+				pageBlockVoiceNote.VoiceNote = nil
+			} else {
+				pageBlockVoiceNote.VoiceNote = new(VoiceNote)
+				err = pageBlockVoiceNote.VoiceNote.decodeJSON(r)
+			}
+		case "caption":
+			if r.readNull() {
+				pageBlockVoiceNote.Caption = nil
+			} else {
+				pageBlockVoiceNote.Caption = new(PageBlockCaption)
+				err = pageBlockVoiceNote.Caption.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockVoiceNote *PageBlockVoiceNote) Clone() *PageBlockVoiceNote {
+	if pageBlockVoiceNote == nil {
+		return nil
+	}
+	clone := *pageBlockVoiceNote
+	clone.VoiceNote = pageBlockVoiceNote.VoiceNote.Clone()
+	clone.Caption = pageBlockVoiceNote.Caption.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockVoiceNote *PageBlockVoiceNote) Equal(other *PageBlockVoiceNote) bool {
+	if pageBlockVoiceNote == nil || other == nil {
+		return pageBlockVoiceNote == other
+	}
+	if !pageBlockVoiceNote.VoiceNote.Equal(other.VoiceNote) {
+		return false
+	}
+	if !pageBlockVoiceNote.Caption.Equal(other.Caption) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockVoiceNote *PageBlockVoiceNote) Diff(other *PageBlockVoiceNote) []Change {
+	return pageBlockVoiceNote.diff("", other, nil)
+}
+
+func (pageBlockVoiceNote *PageBlockVoiceNote) diff(path string, other *PageBlockVoiceNote, changes []Change) []Change {
+	if pageBlockVoiceNote == nil || other == nil {
+		if pageBlockVoiceNote != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockVoiceNote, New: other})
+		}
+		return changes
+	}
+	changes = pageBlockVoiceNote.VoiceNote.diff(fieldPath(path, "voice_note"), other.VoiceNote, changes)
+	changes = pageBlockVoiceNote.Caption.diff(fieldPath(path, "caption"), other.Caption, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockVoiceNote *PageBlockVoiceNote) String() string {
+	return objectString(pageBlockVoiceNote)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockVoiceNote *PageBlockVoiceNote) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockVoiceNote))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockVoiceNote *PageBlockVoiceNote) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockVoiceNote)
+}
+
+func (pageBlockVoiceNote *PageBlockVoiceNote) printText(p *printer) {
+	if pageBlockVoiceNote == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockVoiceNote")
+	p.object("voice_note", pageBlockVoiceNote.VoiceNote)
+	p.object("caption", pageBlockVoiceNote.Caption)
+	p.endObject(state)
+}
+
 // GetPageBlockEnum return the enum type of this object
 func (pageBlockVoiceNote *PageBlockVoiceNote) GetPageBlockEnum() PageBlockEnum {
 	return PageBlockVoiceNoteType
 }
 
+// Accept calls the method of the visitor for PageBlockVoiceNote
+func (pageBlockVoiceNote *PageBlockVoiceNote) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockVoiceNote(pageBlockVoiceNote)
+}
+
 // PageBlockCover A page cover
 type PageBlockCover struct {
 	tdCommon
@@ -1062,27 +3193,109 @@ func NewPageBlockCover(cover PageBlock) *PageBlockCover {
 	return &pageBlockCoverTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockCover *PageBlockCover) MarshalJSON() ([]byte, error) {
+	return pageBlockCover.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (pageBlockCover *PageBlockCover) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, pageBlockCover)
+}
+
+func (pageBlockCover *PageBlockCover) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockCover == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockCover.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockCover.tdCommon.Extra)
+	b = append(b, `,"cover":`...)
+	b, err = appendJSONValue(b, pageBlockCover.Cover)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return append(b, '}'), nil
+}
+
+func (pageBlockCover *PageBlockCover) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockCover.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockCover.tdCommon.Extra, err = r.readString()
+		case "cover":
+			pageBlockCover.Cover, err = decodePageBlock(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockCover *PageBlockCover) Clone() *PageBlockCover {
+	if pageBlockCover == nil {
+		return nil
+	}
+	clone := *pageBlockCover
+	clone.Cover = clonePageBlock(pageBlockCover.Cover)
+	return &clone
+}
 
-	pageBlockCover.tdCommon = tempObj.tdCommon
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockCover *PageBlockCover) Equal(other *PageBlockCover) bool {
+	if pageBlockCover == nil || other == nil {
+		return pageBlockCover == other
+	}
+	if !equalPageBlock(pageBlockCover.Cover, other.Cover) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockCover *PageBlockCover) Diff(other *PageBlockCover) []Change {
+	return pageBlockCover.diff("", other, nil)
+}
+
+func (pageBlockCover *PageBlockCover) diff(path string, other *PageBlockCover, changes []Change) []Change {
+	if pageBlockCover == nil || other == nil {
+		if pageBlockCover != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockCover, New: other})
+		}
+		return changes
+	}
+	changes = diffPageBlock(fieldPath(path, "cover"), pageBlockCover.Cover, other.Cover, changes)
+	return changes
+}
 
-	fieldCover, _ := unmarshalPageBlock(objMap["cover"])
-	pageBlockCover.Cover = fieldCover
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockCover *PageBlockCover) String() string {
+	return objectString(pageBlockCover)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockCover *PageBlockCover) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockCover))
+}
 
-	return nil
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockCover *PageBlockCover) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockCover)
+}
+
+func (pageBlockCover *PageBlockCover) printText(p *printer) {
+	if pageBlockCover == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockCover")
+	p.object("cover", pageBlockCover.Cover)
+	p.endObject(state)
 }
 
 // GetPageBlockEnum return the enum type of this object
@@ -1090,6 +3303,11 @@ func (pageBlockCover *PageBlockCover) GetPageBlockEnum() PageBlockEnum {
 	return PageBlockCoverType
 }
 
+// Accept calls the method of the visitor for PageBlockCover
+func (pageBlockCover *PageBlockCover) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockCover(pageBlockCover)
+}
+
 // PageBlockEmbedded An embedded web page
 type PageBlockEmbedded struct {
 	tdCommon
@@ -1134,11 +3352,210 @@ func NewPageBlockEmbedded(url string, html string, posterPhoto *Photo, width int
 	return &pageBlockEmbeddedTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockEmbedded *PageBlockEmbedded) MarshalJSON() ([]byte, error) {
+	return pageBlockEmbedded.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pageBlockEmbedded *PageBlockEmbedded) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pageBlockEmbedded)
+}
+
+func (pageBlockEmbedded *PageBlockEmbedded) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockEmbedded == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockEmbedded.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockEmbedded.tdCommon.Extra)
+	b = append(b, `,"url":`...)
+	b = appendJSONString(b, pageBlockEmbedded.Url)
+	b = append(b, `,"html":`...)
+	b = appendJSONString(b, pageBlockEmbedded.Html)
+	b = append(b, `,"poster_photo":`...)
+	b, err = pageBlockEmbedded.PosterPhoto.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"width":`...)
+	b = appendJSONInt(b, int64(pageBlockEmbedded.Width))
+	b = append(b, `,"height":`...)
+	b = appendJSONInt(b, int64(pageBlockEmbedded.Height))
+	b = append(b, `,"caption":`...)
+	b, err = pageBlockEmbedded.Caption.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"is_full_width":`...)
+	b = appendJSONBool(b, pageBlockEmbedded.IsFullWidth)
+	b = append(b, `,"allow_scrolling":`...)
+	b = appendJSONBool(b, pageBlockEmbedded.AllowScrolling)
+	return append(b, '}'), nil
+}
+
+func (pageBlockEmbedded *PageBlockEmbedded) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockEmbedded.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockEmbedded.tdCommon.Extra, err = r.readString()
+		case "url":
+			pageBlockEmbedded.Url, err = r.readString()
+		case "html":
+			pageBlockEmbedded.Html, err = r.readString()
+		case "poster_photo":
+			if r.readNull() {
+				pageBlockEmbedded.PosterPhoto = nil
+			} else {
+				pageBlockEmbedded.PosterPhoto = new(Photo)
+				err = pageBlockEmbedded.PosterPhoto.decodeJSON(r)
+			}
+		case "width":
+			pageBlockEmbedded.Width, err = r.readInt32()
+		case "height":
+			pageBlockEmbedded.Height, err = r.readInt32()
+		case "caption":
+			if r.readNull() {
+				pageBlockEmbedded.Caption = nil
+			} else {
+				pageBlockEmbedded.Caption = new(PageBlockCaption)
+				err = pageBlockEmbedded.Caption.decodeJSON(r)
+			}
+		case "is_full_width":
+			pageBlockEmbedded.IsFullWidth, err = r.readBool()
+		case "allow_scrolling":
+			pageBlockEmbedded.AllowScrolling, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockEmbedded *PageBlockEmbedded) Clone() *PageBlockEmbedded {
+	if pageBlockEmbedded == nil {
+		return nil
+	}
+	clone := *pageBlockEmbedded
+	clone.PosterPhoto = pageBlockEmbedded.PosterPhoto.Clone()
+	clone.Caption = pageBlockEmbedded.Caption.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockEmbedded *PageBlockEmbedded) Equal(other *PageBlockEmbedded) bool {
+	if pageBlockEmbedded == nil || other == nil {
+		return pageBlockEmbedded == other
+	}
+	if pageBlockEmbedded.Url != other.Url {
+		return false
+	}
+	if pageBlockEmbedded.Html != other.Html {
+		return false
+	}
+	if !pageBlockEmbedded.PosterPhoto.Equal(other.PosterPhoto) {
+		return false
+	}
+	if pageBlockEmbedded.Width != other.Width {
+		return false
+	}
+	if pageBlockEmbedded.Height != other.Height {
+		return false
+	}
+	if !pageBlockEmbedded.Caption.Equal(other.Caption) {
+		return false
+	}
+	if pageBlockEmbedded.IsFullWidth != other.IsFullWidth {
+		return false
+	}
+	if pageBlockEmbedded.AllowScrolling != other.AllowScrolling {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockEmbedded *PageBlockEmbedded) Diff(other *PageBlockEmbedded) []Change {
+	return pageBlockEmbedded.diff("", other, nil)
+}
+
+func (pageBlockEmbedded *PageBlockEmbedded) diff(path string, other *PageBlockEmbedded, changes []Change) []Change {
+	if pageBlockEmbedded == nil || other == nil {
+		if pageBlockEmbedded != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockEmbedded, New: other})
+		}
+		return changes
+	}
+	if pageBlockEmbedded.Url != other.Url {
+		changes = append(changes, Change{Path: fieldPath(path, "url"), Old: pageBlockEmbedded.Url, New: other.Url})
+	}
+	if pageBlockEmbedded.Html != other.Html {
+		changes = append(changes, Change{Path: fieldPath(path, "html"), Old: pageBlockEmbedded.Html, New: other.Html})
+	}
+	changes = pageBlockEmbedded.PosterPhoto.diff(fieldPath(path, "poster_photo"), other.PosterPhoto, changes)
+	if pageBlockEmbedded.Width != other.Width {
+		changes = append(changes, Change{Path: fieldPath(path, "width"), Old: pageBlockEmbedded.Width, New: other.Width})
+	}
+	if pageBlockEmbedded.Height != other.Height {
+		changes = append(changes, Change{Path: fieldPath(path, "height"), Old: pageBlockEmbedded.Height, New: other.Height})
+	}
+	changes = pageBlockEmbedded.Caption.diff(fieldPath(path, "caption"), other.Caption, changes)
+	if pageBlockEmbedded.IsFullWidth != other.IsFullWidth {
+		changes = append(changes, Change{Path: fieldPath(path, "is_full_width"), Old: pageBlockEmbedded.IsFullWidth, New: other.IsFullWidth})
+	}
+	if pageBlockEmbedded.AllowScrolling != other.AllowScrolling {
+		changes = append(changes, Change{Path: fieldPath(path, "allow_scrolling"), Old: pageBlockEmbedded.AllowScrolling, New: other.AllowScrolling})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockEmbedded *PageBlockEmbedded) String() string {
+	return objectString(pageBlockEmbedded)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockEmbedded *PageBlockEmbedded) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockEmbedded))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockEmbedded *PageBlockEmbedded) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockEmbedded)
+}
+
+func (pageBlockEmbedded *PageBlockEmbedded) printText(p *printer) {
+	if pageBlockEmbedded == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockEmbedded")
+	p.string("url", pageBlockEmbedded.Url)
+	p.string("html", pageBlockEmbedded.Html)
+	p.object("poster_photo", pageBlockEmbedded.PosterPhoto)
+	p.int("width", int64(pageBlockEmbedded.Width))
+	p.int("height", int64(pageBlockEmbedded.Height))
+	p.object("caption", pageBlockEmbedded.Caption)
+	p.bool("is_full_width", pageBlockEmbedded.IsFullWidth)
+	p.bool("allow_scrolling", pageBlockEmbedded.AllowScrolling)
+	p.endObject(state)
+}
+
 // GetPageBlockEnum return the enum type of this object
 func (pageBlockEmbedded *PageBlockEmbedded) GetPageBlockEnum() PageBlockEnum {
 	return PageBlockEmbeddedType
 }
 
+// Accept calls the method of the visitor for PageBlockEmbedded
+func (pageBlockEmbedded *PageBlockEmbedded) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockEmbedded(pageBlockEmbedded)
+}
+
 // PageBlockEmbeddedPost An embedded post
 type PageBlockEmbeddedPost struct {
 	tdCommon
@@ -1177,11 +3594,229 @@ func NewPageBlockEmbeddedPost(url string, author string, authorPhoto *Photo, dat
 	return &pageBlockEmbeddedPostTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockEmbeddedPost *PageBlockEmbeddedPost) MarshalJSON() ([]byte, error) {
+	return pageBlockEmbeddedPost.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pageBlockEmbeddedPost *PageBlockEmbeddedPost) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pageBlockEmbeddedPost)
+}
+
+func (pageBlockEmbeddedPost *PageBlockEmbeddedPost) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockEmbeddedPost == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockEmbeddedPost.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockEmbeddedPost.tdCommon.Extra)
+	b = append(b, `,"url":`...)
+	b = appendJSONString(b, pageBlockEmbeddedPost.Url)
+	b = append(b, `,"author":`...)
+	b = appendJSONString(b, pageBlockEmbeddedPost.Author)
+	b = append(b, `,"author_photo":`...)
+	b, err = pageBlockEmbeddedPost.AuthorPhoto.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"date":`...)
+	b = appendJSONInt(b, int64(pageBlockEmbeddedPost.Date))
+	b = append(b, `,"page_blocks":`...)
+	if pageBlockEmbeddedPost.PageBlocks == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range pageBlockEmbeddedPost.PageBlocks {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = appendJSONValue(b, pageBlockEmbeddedPost.PageBlocks[i0])
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"caption":`...)
+	b, err = pageBlockEmbeddedPost.Caption.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (pageBlockEmbeddedPost *PageBlockEmbeddedPost) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockEmbeddedPost.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockEmbeddedPost.tdCommon.Extra, err = r.readString()
+		case "url":
+			pageBlockEmbeddedPost.Url, err = r.readString()
+		case "author":
+			pageBlockEmbeddedPost.Author, err = r.readString()
+		case "author_photo":
+			if r.readNull() {
+				pageBlockEmbeddedPost.AuthorPhoto = nil
+			} else {
+				pageBlockEmbeddedPost.AuthorPhoto = new(Photo)
+				err = pageBlockEmbeddedPost.AuthorPhoto.decodeJSON(r)
+			}
+		case "date":
+			pageBlockEmbeddedPost.Date, err = r.readInt32()
+		case "page_blocks":
+			if r.readNull() {
+				pageBlockEmbeddedPost.PageBlocks = nil
+			} else {
+				pageBlockEmbeddedPost.PageBlocks = make([]PageBlock, 0)
+				err = r.readArray(func() (err error) {
+					var item0 PageBlock
+					item0, err = decodePageBlock(r)
+					pageBlockEmbeddedPost.PageBlocks = append(pageBlockEmbeddedPost.PageBlocks, item0)
+					return
+				})
+			}
+		case "caption":
+			if r.readNull() {
+				pageBlockEmbeddedPost.Caption = nil
+			} else {
+				pageBlockEmbeddedPost.Caption = new(PageBlockCaption)
+				err = pageBlockEmbeddedPost.Caption.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockEmbeddedPost *PageBlockEmbeddedPost) Clone() *PageBlockEmbeddedPost {
+	if pageBlockEmbeddedPost == nil {
+		return nil
+	}
+	clone := *pageBlockEmbeddedPost
+	clone.AuthorPhoto = pageBlockEmbeddedPost.AuthorPhoto.Clone()
+	if pageBlockEmbeddedPost.PageBlocks != nil {
+		clone.PageBlocks = make([]PageBlock, len(pageBlockEmbeddedPost.PageBlocks))
+		for i0 := range pageBlockEmbeddedPost.PageBlocks {
+			clone.PageBlocks[i0] = clonePageBlock(pageBlockEmbeddedPost.PageBlocks[i0])
+		}
+	}
+	clone.Caption = pageBlockEmbeddedPost.Caption.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockEmbeddedPost *PageBlockEmbeddedPost) Equal(other *PageBlockEmbeddedPost) bool {
+	if pageBlockEmbeddedPost == nil || other == nil {
+		return pageBlockEmbeddedPost == other
+	}
+	if pageBlockEmbeddedPost.Url != other.Url {
+		return false
+	}
+	if pageBlockEmbeddedPost.Author != other.Author {
+		return false
+	}
+	if !pageBlockEmbeddedPost.AuthorPhoto.Equal(other.AuthorPhoto) {
+		return false
+	}
+	if pageBlockEmbeddedPost.Date != other.Date {
+		return false
+	}
+	if len(pageBlockEmbeddedPost.PageBlocks) != len(other.PageBlocks) {
+		return false
+	}
+	for i0 := range pageBlockEmbeddedPost.PageBlocks {
+		if !equalPageBlock(pageBlockEmbeddedPost.PageBlocks[i0], other.PageBlocks[i0]) {
+			return false
+		}
+	}
+	if !pageBlockEmbeddedPost.Caption.Equal(other.Caption) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockEmbeddedPost *PageBlockEmbeddedPost) Diff(other *PageBlockEmbeddedPost) []Change {
+	return pageBlockEmbeddedPost.diff("", other, nil)
+}
+
+func (pageBlockEmbeddedPost *PageBlockEmbeddedPost) diff(path string, other *PageBlockEmbeddedPost, changes []Change) []Change {
+	if pageBlockEmbeddedPost == nil || other == nil {
+		if pageBlockEmbeddedPost != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockEmbeddedPost, New: other})
+		}
+		return changes
+	}
+	if pageBlockEmbeddedPost.Url != other.Url {
+		changes = append(changes, Change{Path: fieldPath(path, "url"), Old: pageBlockEmbeddedPost.Url, New: other.Url})
+	}
+	if pageBlockEmbeddedPost.Author != other.Author {
+		changes = append(changes, Change{Path: fieldPath(path, "author"), Old: pageBlockEmbeddedPost.Author, New: other.Author})
+	}
+	changes = pageBlockEmbeddedPost.AuthorPhoto.diff(fieldPath(path, "author_photo"), other.AuthorPhoto, changes)
+	if pageBlockEmbeddedPost.Date != other.Date {
+		changes = append(changes, Change{Path: fieldPath(path, "date"), Old: pageBlockEmbeddedPost.Date, New: other.Date})
+	}
+	if len(pageBlockEmbeddedPost.PageBlocks) != len(other.PageBlocks) {
+		changes = append(changes, Change{Path: fieldPath(path, "page_blocks"), Old: pageBlockEmbeddedPost.PageBlocks, New: other.PageBlocks})
+	} else {
+		for i0 := range pageBlockEmbeddedPost.PageBlocks {
+			changes = diffPageBlock(indexPath(fieldPath(path, "page_blocks"), i0), pageBlockEmbeddedPost.PageBlocks[i0], other.PageBlocks[i0], changes)
+		}
+	}
+	changes = pageBlockEmbeddedPost.Caption.diff(fieldPath(path, "caption"), other.Caption, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockEmbeddedPost *PageBlockEmbeddedPost) String() string {
+	return objectString(pageBlockEmbeddedPost)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockEmbeddedPost *PageBlockEmbeddedPost) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockEmbeddedPost))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockEmbeddedPost *PageBlockEmbeddedPost) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockEmbeddedPost)
+}
+
+func (pageBlockEmbeddedPost *PageBlockEmbeddedPost) printText(p *printer) {
+	if pageBlockEmbeddedPost == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockEmbeddedPost")
+	p.string("url", pageBlockEmbeddedPost.Url)
+	p.string("author", pageBlockEmbeddedPost.Author)
+	p.object("author_photo", pageBlockEmbeddedPost.AuthorPhoto)
+	p.int("date", int64(pageBlockEmbeddedPost.Date))
+	p.vector("page_blocks", len(pageBlockEmbeddedPost.PageBlocks), func(i0 int) {
+		p.object("page_blocks", pageBlockEmbeddedPost.PageBlocks[i0])
+	})
+	p.object("caption", pageBlockEmbeddedPost.Caption)
+	p.endObject(state)
+}
+
 // GetPageBlockEnum return the enum type of this object
 func (pageBlockEmbeddedPost *PageBlockEmbeddedPost) GetPageBlockEnum() PageBlockEnum {
 	return PageBlockEmbeddedPostType
 }
 
+// Accept calls the method of the visitor for PageBlockEmbeddedPost
+func (pageBlockEmbeddedPost *PageBlockEmbeddedPost) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockEmbeddedPost(pageBlockEmbeddedPost)
+}
+
 // PageBlockCollage A collage
 type PageBlockCollage struct {
 	tdCommon
@@ -1208,11 +3843,178 @@ func NewPageBlockCollage(pageBlocks []PageBlock, caption *PageBlockCaption) *Pag
 	return &pageBlockCollageTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockCollage *PageBlockCollage) MarshalJSON() ([]byte, error) {
+	return pageBlockCollage.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pageBlockCollage *PageBlockCollage) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pageBlockCollage)
+}
+
+func (pageBlockCollage *PageBlockCollage) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockCollage == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockCollage.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockCollage.tdCommon.Extra)
+	b = append(b, `,"page_blocks":`...)
+	if pageBlockCollage.PageBlocks == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range pageBlockCollage.PageBlocks {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = appendJSONValue(b, pageBlockCollage.PageBlocks[i0])
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"caption":`...)
+	b, err = pageBlockCollage.Caption.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (pageBlockCollage *PageBlockCollage) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockCollage.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockCollage.tdCommon.Extra, err = r.readString()
+		case "page_blocks":
+			if r.readNull() {
+				pageBlockCollage.PageBlocks = nil
+			} else {
+				pageBlockCollage.PageBlocks = make([]PageBlock, 0)
+				err = r.readArray(func() (err error) {
+					var item0 PageBlock
+					item0, err = decodePageBlock(r)
+					pageBlockCollage.PageBlocks = append(pageBlockCollage.PageBlocks, item0)
+					return
+				})
+			}
+		case "caption":
+			if r.readNull() {
+				pageBlockCollage.Caption = nil
+			} else {
+				pageBlockCollage.Caption = new(PageBlockCaption)
+				err = pageBlockCollage.Caption.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockCollage *PageBlockCollage) Clone() *PageBlockCollage {
+	if pageBlockCollage == nil {
+		return nil
+	}
+	clone := *pageBlockCollage
+	if pageBlockCollage.PageBlocks != nil {
+		clone.PageBlocks = make([]PageBlock, len(pageBlockCollage.PageBlocks))
+		for i0 := range pageBlockCollage.PageBlocks {
+			clone.PageBlocks[i0] = clonePageBlock(pageBlockCollage.PageBlocks[i0])
+		}
+	}
+	clone.Caption = pageBlockCollage.Caption.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockCollage *PageBlockCollage) Equal(other *PageBlockCollage) bool {
+	if pageBlockCollage == nil || other == nil {
+		return pageBlockCollage == other
+	}
+	if len(pageBlockCollage.PageBlocks) != len(other.PageBlocks) {
+		return false
+	}
+	for i0 := range pageBlockCollage.PageBlocks {
+		if !equalPageBlock(pageBlockCollage.PageBlocks[i0], other.PageBlocks[i0]) {
+			return false
+		}
+	}
+	if !pageBlockCollage.Caption.Equal(other.Caption) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockCollage *PageBlockCollage) Diff(other *PageBlockCollage) []Change {
+	return pageBlockCollage.diff("", other, nil)
+}
+
+func (pageBlockCollage *PageBlockCollage) diff(path string, other *PageBlockCollage, changes []Change) []Change {
+	if pageBlockCollage == nil || other == nil {
+		if pageBlockCollage != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockCollage, New: other})
+		}
+		return changes
+	}
+	if len(pageBlockCollage.PageBlocks) != len(other.PageBlocks) {
+		changes = append(changes, Change{Path: fieldPath(path, "page_blocks"), Old: pageBlockCollage.PageBlocks, New: other.PageBlocks})
+	} else {
+		for i0 := range pageBlockCollage.PageBlocks {
+			changes = diffPageBlock(indexPath(fieldPath(path, "page_blocks"), i0), pageBlockCollage.PageBlocks[i0], other.PageBlocks[i0], changes)
+		}
+	}
+	changes = pageBlockCollage.Caption.diff(fieldPath(path, "caption"), other.Caption, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockCollage *PageBlockCollage) String() string {
+	return objectString(pageBlockCollage)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockCollage *PageBlockCollage) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockCollage))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockCollage *PageBlockCollage) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockCollage)
+}
+
+func (pageBlockCollage *PageBlockCollage) printText(p *printer) {
+	if pageBlockCollage == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockCollage")
+	p.vector("page_blocks", len(pageBlockCollage.PageBlocks), func(i0 int) {
+		p.object("page_blocks", pageBlockCollage.PageBlocks[i0])
+	})
+	p.object("caption", pageBlockCollage.Caption)
+	p.endObject(state)
+}
+
 // GetPageBlockEnum return the enum type of this object
 func (pageBlockCollage *PageBlockCollage) GetPageBlockEnum() PageBlockEnum {
 	return PageBlockCollageType
 }
 
+// Accept calls the method of the visitor for PageBlockCollage
+func (pageBlockCollage *PageBlockCollage) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockCollage(pageBlockCollage)
+}
+
 // PageBlockSlideshow A slideshow
 type PageBlockSlideshow struct {
 	tdCommon
@@ -1239,11 +4041,178 @@ func NewPageBlockSlideshow(pageBlocks []PageBlock, caption *PageBlockCaption) *P
 	return &pageBlockSlideshowTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockSlideshow *PageBlockSlideshow) MarshalJSON() ([]byte, error) {
+	return pageBlockSlideshow.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pageBlockSlideshow *PageBlockSlideshow) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pageBlockSlideshow)
+}
+
+func (pageBlockSlideshow *PageBlockSlideshow) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockSlideshow == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockSlideshow.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockSlideshow.tdCommon.Extra)
+	b = append(b, `,"page_blocks":`...)
+	if pageBlockSlideshow.PageBlocks == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range pageBlockSlideshow.PageBlocks {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = appendJSONValue(b, pageBlockSlideshow.PageBlocks[i0])
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"caption":`...)
+	b, err = pageBlockSlideshow.Caption.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (pageBlockSlideshow *PageBlockSlideshow) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockSlideshow.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockSlideshow.tdCommon.Extra, err = r.readString()
+		case "page_blocks":
+			if r.readNull() {
+				pageBlockSlideshow.PageBlocks = nil
+			} else {
+				pageBlockSlideshow.PageBlocks = make([]PageBlock, 0)
+				err = r.readArray(func() (err error) {
+					var item0 PageBlock
+					item0, err = decodePageBlock(r)
+					pageBlockSlideshow.PageBlocks = append(pageBlockSlideshow.PageBlocks, item0)
+					return
+				})
+			}
+		case "caption":
+			if r.readNull() {
+				pageBlockSlideshow.Caption = nil
+			} else {
+				pageBlockSlideshow.Caption = new(PageBlockCaption)
+				err = pageBlockSlideshow.Caption.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockSlideshow *PageBlockSlideshow) Clone() *PageBlockSlideshow {
+	if pageBlockSlideshow == nil {
+		return nil
+	}
+	clone := *pageBlockSlideshow
+	if pageBlockSlideshow.PageBlocks != nil {
+		clone.PageBlocks = make([]PageBlock, len(pageBlockSlideshow.PageBlocks))
+		for i0 := range pageBlockSlideshow.PageBlocks {
+			clone.PageBlocks[i0] = clonePageBlock(pageBlockSlideshow.PageBlocks[i0])
+		}
+	}
+	clone.Caption = pageBlockSlideshow.Caption.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockSlideshow *PageBlockSlideshow) Equal(other *PageBlockSlideshow) bool {
+	if pageBlockSlideshow == nil || other == nil {
+		return pageBlockSlideshow == other
+	}
+	if len(pageBlockSlideshow.PageBlocks) != len(other.PageBlocks) {
+		return false
+	}
+	for i0 := range pageBlockSlideshow.PageBlocks {
+		if !equalPageBlock(pageBlockSlideshow.PageBlocks[i0], other.PageBlocks[i0]) {
+			return false
+		}
+	}
+	if !pageBlockSlideshow.Caption.Equal(other.Caption) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockSlideshow *PageBlockSlideshow) Diff(other *PageBlockSlideshow) []Change {
+	return pageBlockSlideshow.diff("", other, nil)
+}
+
+func (pageBlockSlideshow *PageBlockSlideshow) diff(path string, other *PageBlockSlideshow, changes []Change) []Change {
+	if pageBlockSlideshow == nil || other == nil {
+		if pageBlockSlideshow != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockSlideshow, New: other})
+		}
+		return changes
+	}
+	if len(pageBlockSlideshow.PageBlocks) != len(other.PageBlocks) {
+		changes = append(changes, Change{Path: fieldPath(path, "page_blocks"), Old: pageBlockSlideshow.PageBlocks, New: other.PageBlocks})
+	} else {
+		for i0 := range pageBlockSlideshow.PageBlocks {
+			changes = diffPageBlock(indexPath(fieldPath(path, "page_blocks"), i0), pageBlockSlideshow.PageBlocks[i0], other.PageBlocks[i0], changes)
+		}
+	}
+	changes = pageBlockSlideshow.Caption.diff(fieldPath(path, "caption"), other.Caption, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockSlideshow *PageBlockSlideshow) String() string {
+	return objectString(pageBlockSlideshow)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockSlideshow *PageBlockSlideshow) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockSlideshow))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockSlideshow *PageBlockSlideshow) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockSlideshow)
+}
+
+func (pageBlockSlideshow *PageBlockSlideshow) printText(p *printer) {
+	if pageBlockSlideshow == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockSlideshow")
+	p.vector("page_blocks", len(pageBlockSlideshow.PageBlocks), func(i0 int) {
+		p.object("page_blocks", pageBlockSlideshow.PageBlocks[i0])
+	})
+	p.object("caption", pageBlockSlideshow.Caption)
+	p.endObject(state)
+}
+
 // GetPageBlockEnum return the enum type of this object
 func (pageBlockSlideshow *PageBlockSlideshow) GetPageBlockEnum() PageBlockEnum {
 	return PageBlockSlideshowType
 }
 
+// Accept calls the method of the visitor for PageBlockSlideshow
+func (pageBlockSlideshow *PageBlockSlideshow) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockSlideshow(pageBlockSlideshow)
+}
+
 // PageBlockChatLink A link to a chat
 type PageBlockChatLink struct {
 	tdCommon
@@ -1273,11 +4242,148 @@ func NewPageBlockChatLink(title string, photo *ChatPhotoInfo, username string) *
 	return &pageBlockChatLinkTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockChatLink *PageBlockChatLink) MarshalJSON() ([]byte, error) {
+	return pageBlockChatLink.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pageBlockChatLink *PageBlockChatLink) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pageBlockChatLink)
+}
+
+func (pageBlockChatLink *PageBlockChatLink) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockChatLink == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockChatLink.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockChatLink.tdCommon.Extra)
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, pageBlockChatLink.Title)
+	b = append(b, `,"photo":`...)
+	b, err = pageBlockChatLink.Photo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"username":`...)
+	b = appendJSONString(b, pageBlockChatLink.Username)
+	return append(b, '}'), nil
+}
+
+func (pageBlockChatLink *PageBlockChatLink) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockChatLink.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockChatLink.tdCommon.Extra, err = r.readString()
+		case "title":
+			pageBlockChatLink.Title, err = r.readString()
+		case "photo":
+			if r.readNull() {
+				pageBlockChatLink.Photo = nil
+			} else {
+				pageBlockChatLink.Photo = new(ChatPhotoInfo)
+				err = pageBlockChatLink.Photo.decodeJSON(r)
+			}
+		case "username":
+			pageBlockChatLink.Username, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockChatLink *PageBlockChatLink) Clone() *PageBlockChatLink {
+	if pageBlockChatLink == nil {
+		return nil
+	}
+	clone := *pageBlockChatLink
+	clone.Photo = pageBlockChatLink.Photo.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockChatLink *PageBlockChatLink) Equal(other *PageBlockChatLink) bool {
+	if pageBlockChatLink == nil || other == nil {
+		return pageBlockChatLink == other
+	}
+	if pageBlockChatLink.Title != other.Title {
+		return false
+	}
+	if !pageBlockChatLink.Photo.Equal(other.Photo) {
+		return false
+	}
+	if pageBlockChatLink.Username != other.Username {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockChatLink *PageBlockChatLink) Diff(other *PageBlockChatLink) []Change {
+	return pageBlockChatLink.diff("", other, nil)
+}
+
+func (pageBlockChatLink *PageBlockChatLink) diff(path string, other *PageBlockChatLink, changes []Change) []Change {
+	if pageBlockChatLink == nil || other == nil {
+		if pageBlockChatLink != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockChatLink, New: other})
+		}
+		return changes
+	}
+	if pageBlockChatLink.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: pageBlockChatLink.Title, New: other.Title})
+	}
+	changes = pageBlockChatLink.Photo.diff(fieldPath(path, "photo"), other.Photo, changes)
+	if pageBlockChatLink.Username != other.Username {
+		changes = append(changes, Change{Path: fieldPath(path, "username"), Old: pageBlockChatLink.Username, New: other.Username})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockChatLink *PageBlockChatLink) String() string {
+	return objectString(pageBlockChatLink)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockChatLink *PageBlockChatLink) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockChatLink))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockChatLink *PageBlockChatLink) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockChatLink)
+}
+
+func (pageBlockChatLink *PageBlockChatLink) printText(p *printer) {
+	if pageBlockChatLink == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockChatLink")
+	p.string("title", pageBlockChatLink.Title)
+	p.object("photo", pageBlockChatLink.Photo)
+	p.string("username", pageBlockChatLink.Username)
+	p.endObject(state)
+}
+
 // GetPageBlockEnum return the enum type of this object
 func (pageBlockChatLink *PageBlockChatLink) GetPageBlockEnum() PageBlockEnum {
 	return PageBlockChatLinkType
 }
 
+// Accept calls the method of the visitor for PageBlockChatLink
+func (pageBlockChatLink *PageBlockChatLink) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockChatLink(pageBlockChatLink)
+}
+
 // PageBlockTable A table
 type PageBlockTable struct {
 	tdCommon
@@ -1310,33 +4416,222 @@ func NewPageBlockTable(caption RichText, cells [][]PageBlockTableCell, isBordere
 	return &pageBlockTableTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockTable *PageBlockTable) MarshalJSON() ([]byte, error) {
+	return pageBlockTable.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (pageBlockTable *PageBlockTable) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Cells      [][]PageBlockTableCell `json:"cells"`       // Table cells
-		IsBordered bool                   `json:"is_bordered"` // True, if the table is bordered
-		IsStriped  bool                   `json:"is_striped"`  // True, if the table is striped
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	return unmarshalFast(b, pageBlockTable)
+}
+
+func (pageBlockTable *PageBlockTable) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockTable == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockTable.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockTable.tdCommon.Extra)
+	b = append(b, `,"caption":`...)
+	b, err = appendJSONValue(b, pageBlockTable.Caption)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	b = append(b, `,"cells":`...)
+	if pageBlockTable.Cells == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range pageBlockTable.Cells {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			if pageBlockTable.Cells[i0] == nil {
+				b = append(b, "null"...)
+			} else {
+				b = append(b, '[')
+				for i1 := range pageBlockTable.Cells[i0] {
+					if i1 > 0 {
+						b = append(b, ',')
+					}
+					b, err = pageBlockTable.Cells[i0][i1].appendJSON(b)
+					if err != nil {
+						return nil, err
+					}
+				}
+				b = append(b, ']')
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"is_bordered":`...)
+	b = appendJSONBool(b, pageBlockTable.IsBordered)
+	b = append(b, `,"is_striped":`...)
+	b = appendJSONBool(b, pageBlockTable.IsStriped)
+	return append(b, '}'), nil
+}
+
+func (pageBlockTable *PageBlockTable) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockTable.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockTable.tdCommon.Extra, err = r.readString()
+		case "caption":
+			pageBlockTable.Caption, err = decodeRichText(r)
+		case "cells":
+			if r.readNull() {
+				pageBlockTable.Cells = nil
+			} else {
+				pageBlockTable.Cells = make([][]PageBlockTableCell, 0)
+				err = r.readArray(func() (err error) {
+					var item0 []PageBlockTableCell
+					if r.readNull() {
+						item0 = nil
+					} else {
+						item0 = make([]PageBlockTableCell, 0)
+						err = r.readArray(func() (err error) {
+							var item1 PageBlockTableCell
+							err = item1.decodeJSON(r)
+							item0 = append(item0, item1)
+							return
+						})
+					}
+					pageBlockTable.Cells = append(pageBlockTable.Cells, item0)
+					return
+				})
+			}
+		case "is_bordered":
+			pageBlockTable.IsBordered, err = r.readBool()
+		case "is_striped":
+			pageBlockTable.IsStriped, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockTable *PageBlockTable) Clone() *PageBlockTable {
+	if pageBlockTable == nil {
+		return nil
+	}
+	clone := *pageBlockTable
+	clone.Caption = cloneRichText(pageBlockTable.Caption)
+	if pageBlockTable.Cells != nil {
+		clone.Cells = make([][]PageBlockTableCell, len(pageBlockTable.Cells))
+		for i0 := range pageBlockTable.Cells {
+			if pageBlockTable.Cells[i0] != nil {
+				clone.Cells[i0] = make([]PageBlockTableCell, len(pageBlockTable.Cells[i0]))
+				for i1 := range pageBlockTable.Cells[i0] {
+					clone.Cells[i0][i1] = *pageBlockTable.Cells[i0][i1].Clone()
+				}
+			}
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockTable *PageBlockTable) Equal(other *PageBlockTable) bool {
+	if pageBlockTable == nil || other == nil {
+		return pageBlockTable == other
+	}
+	if !equalRichText(pageBlockTable.Caption, other.Caption) {
+		return false
+	}
+	if len(pageBlockTable.Cells) != len(other.Cells) {
+		return false
+	}
+	for i0 := range pageBlockTable.Cells {
+		if len(pageBlockTable.Cells[i0]) != len(other.Cells[i0]) {
+			return false
+		}
+		for i1 := range pageBlockTable.Cells[i0] {
+			if !pageBlockTable.Cells[i0][i1].Equal(&other.Cells[i0][i1]) {
+				return false
+			}
+		}
+	}
+	if pageBlockTable.IsBordered != other.IsBordered {
+		return false
+	}
+	if pageBlockTable.IsStriped != other.IsStriped {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockTable *PageBlockTable) Diff(other *PageBlockTable) []Change {
+	return pageBlockTable.diff("", other, nil)
+}
 
-	pageBlockTable.tdCommon = tempObj.tdCommon
-	pageBlockTable.Cells = tempObj.Cells
-	pageBlockTable.IsBordered = tempObj.IsBordered
-	pageBlockTable.IsStriped = tempObj.IsStriped
+func (pageBlockTable *PageBlockTable) diff(path string, other *PageBlockTable, changes []Change) []Change {
+	if pageBlockTable == nil || other == nil {
+		if pageBlockTable != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockTable, New: other})
+		}
+		return changes
+	}
+	changes = diffRichText(fieldPath(path, "caption"), pageBlockTable.Caption, other.Caption, changes)
+	if len(pageBlockTable.Cells) != len(other.Cells) {
+		changes = append(changes, Change{Path: fieldPath(path, "cells"), Old: pageBlockTable.Cells, New: other.Cells})
+	} else {
+		for i0 := range pageBlockTable.Cells {
+			if len(pageBlockTable.Cells[i0]) != len(other.Cells[i0]) {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "cells"), i0), Old: pageBlockTable.Cells[i0], New: other.Cells[i0]})
+			} else {
+				for i1 := range pageBlockTable.Cells[i0] {
+					changes = pageBlockTable.Cells[i0][i1].diff(indexPath(indexPath(fieldPath(path, "cells"), i0), i1), &other.Cells[i0][i1], changes)
+				}
+			}
+		}
+	}
+	if pageBlockTable.IsBordered != other.IsBordered {
+		changes = append(changes, Change{Path: fieldPath(path, "is_bordered"), Old: pageBlockTable.IsBordered, New: other.IsBordered})
+	}
+	if pageBlockTable.IsStriped != other.IsStriped {
+		changes = append(changes, Change{Path: fieldPath(path, "is_striped"), Old: pageBlockTable.IsStriped, New: other.IsStriped})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockTable *PageBlockTable) String() string {
+	return objectString(pageBlockTable)
+}
 
-	fieldCaption, _ := unmarshalRichText(objMap["caption"])
-	pageBlockTable.Caption = fieldCaption
+// LogValue logs the object as its summary
+func (pageBlockTable *PageBlockTable) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockTable))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockTable *PageBlockTable) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockTable)
+}
 
-	return nil
+func (pageBlockTable *PageBlockTable) printText(p *printer) {
+	if pageBlockTable == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockTable")
+	p.object("caption", pageBlockTable.Caption)
+	p.vector("cells", len(pageBlockTable.Cells), func(i0 int) {
+		p.vector("cells", len(pageBlockTable.Cells[i0]), func(i1 int) {
+			pageBlockTable.Cells[i0][i1].printText(p)
+		})
+	})
+	p.bool("is_bordered", pageBlockTable.IsBordered)
+	p.bool("is_striped", pageBlockTable.IsStriped)
+	p.endObject(state)
 }
 
 // GetPageBlockEnum return the enum type of this object
@@ -1344,6 +4639,11 @@ func (pageBlockTable *PageBlockTable) GetPageBlockEnum() PageBlockEnum {
 	return PageBlockTableType
 }
 
+// Accept calls the method of the visitor for PageBlockTable
+func (pageBlockTable *PageBlockTable) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockTable(pageBlockTable)
+}
+
 // PageBlockDetails A collapsible block
 type PageBlockDetails struct {
 	tdCommon
@@ -1373,31 +4673,172 @@ func NewPageBlockDetails(header RichText, pageBlocks []PageBlock, isOpen bool) *
 	return &pageBlockDetailsTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockDetails *PageBlockDetails) MarshalJSON() ([]byte, error) {
+	return pageBlockDetails.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (pageBlockDetails *PageBlockDetails) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		PageBlocks []PageBlock `json:"page_blocks"` // Block contents
-		IsOpen     bool        `json:"is_open"`     // True, if the block is open by default
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	return unmarshalFast(b, pageBlockDetails)
+}
+
+func (pageBlockDetails *PageBlockDetails) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockDetails == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockDetails.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockDetails.tdCommon.Extra)
+	b = append(b, `,"header":`...)
+	b, err = appendJSONValue(b, pageBlockDetails.Header)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"page_blocks":`...)
+	if pageBlockDetails.PageBlocks == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range pageBlockDetails.PageBlocks {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = appendJSONValue(b, pageBlockDetails.PageBlocks[i0])
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"is_open":`...)
+	b = appendJSONBool(b, pageBlockDetails.IsOpen)
+	return append(b, '}'), nil
+}
+
+func (pageBlockDetails *PageBlockDetails) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockDetails.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockDetails.tdCommon.Extra, err = r.readString()
+		case "header":
+			pageBlockDetails.Header, err = decodeRichText(r)
+		case "page_blocks":
+			if r.readNull() {
+				pageBlockDetails.PageBlocks = nil
+			} else {
+				pageBlockDetails.PageBlocks = make([]PageBlock, 0)
+				err = r.readArray(func() (err error) {
+					var item0 PageBlock
+					item0, err = decodePageBlock(r)
+					pageBlockDetails.PageBlocks = append(pageBlockDetails.PageBlocks, item0)
+					return
+				})
+			}
+		case "is_open":
+			pageBlockDetails.IsOpen, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockDetails *PageBlockDetails) Clone() *PageBlockDetails {
+	if pageBlockDetails == nil {
+		return nil
 	}
+	clone := *pageBlockDetails
+	clone.Header = cloneRichText(pageBlockDetails.Header)
+	if pageBlockDetails.PageBlocks != nil {
+		clone.PageBlocks = make([]PageBlock, len(pageBlockDetails.PageBlocks))
+		for i0 := range pageBlockDetails.PageBlocks {
+			clone.PageBlocks[i0] = clonePageBlock(pageBlockDetails.PageBlocks[i0])
+		}
+	}
+	return &clone
+}
 
-	pageBlockDetails.tdCommon = tempObj.tdCommon
-	pageBlockDetails.PageBlocks = tempObj.PageBlocks
-	pageBlockDetails.IsOpen = tempObj.IsOpen
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockDetails *PageBlockDetails) Equal(other *PageBlockDetails) bool {
+	if pageBlockDetails == nil || other == nil {
+		return pageBlockDetails == other
+	}
+	if !equalRichText(pageBlockDetails.Header, other.Header) {
+		return false
+	}
+	if len(pageBlockDetails.PageBlocks) != len(other.PageBlocks) {
+		return false
+	}
+	for i0 := range pageBlockDetails.PageBlocks {
+		if !equalPageBlock(pageBlockDetails.PageBlocks[i0], other.PageBlocks[i0]) {
+			return false
+		}
+	}
+	if pageBlockDetails.IsOpen != other.IsOpen {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockDetails *PageBlockDetails) Diff(other *PageBlockDetails) []Change {
+	return pageBlockDetails.diff("", other, nil)
+}
+
+func (pageBlockDetails *PageBlockDetails) diff(path string, other *PageBlockDetails, changes []Change) []Change {
+	if pageBlockDetails == nil || other == nil {
+		if pageBlockDetails != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockDetails, New: other})
+		}
+		return changes
+	}
+	changes = diffRichText(fieldPath(path, "header"), pageBlockDetails.Header, other.Header, changes)
+	if len(pageBlockDetails.PageBlocks) != len(other.PageBlocks) {
+		changes = append(changes, Change{Path: fieldPath(path, "page_blocks"), Old: pageBlockDetails.PageBlocks, New: other.PageBlocks})
+	} else {
+		for i0 := range pageBlockDetails.PageBlocks {
+			changes = diffPageBlock(indexPath(fieldPath(path, "page_blocks"), i0), pageBlockDetails.PageBlocks[i0], other.PageBlocks[i0], changes)
+		}
+	}
+	if pageBlockDetails.IsOpen != other.IsOpen {
+		changes = append(changes, Change{Path: fieldPath(path, "is_open"), Old: pageBlockDetails.IsOpen, New: other.IsOpen})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockDetails *PageBlockDetails) String() string {
+	return objectString(pageBlockDetails)
+}
 
-	fieldHeader, _ := unmarshalRichText(objMap["header"])
-	pageBlockDetails.Header = fieldHeader
+// LogValue logs the object as its summary
+func (pageBlockDetails *PageBlockDetails) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockDetails))
+}
 
-	return nil
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockDetails *PageBlockDetails) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockDetails)
+}
+
+func (pageBlockDetails *PageBlockDetails) printText(p *printer) {
+	if pageBlockDetails == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockDetails")
+	p.object("header", pageBlockDetails.Header)
+	p.vector("page_blocks", len(pageBlockDetails.PageBlocks), func(i0 int) {
+		p.object("page_blocks", pageBlockDetails.PageBlocks[i0])
+	})
+	p.bool("is_open", pageBlockDetails.IsOpen)
+	p.endObject(state)
 }
 
 // GetPageBlockEnum return the enum type of this object
@@ -1405,6 +4846,11 @@ func (pageBlockDetails *PageBlockDetails) GetPageBlockEnum() PageBlockEnum {
 	return PageBlockDetailsType
 }
 
+// Accept calls the method of the visitor for PageBlockDetails
+func (pageBlockDetails *PageBlockDetails) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockDetails(pageBlockDetails)
+}
+
 // PageBlockRelatedArticles Related articles
 type PageBlockRelatedArticles struct {
 	tdCommon
@@ -1431,29 +4877,161 @@ func NewPageBlockRelatedArticles(header RichText, articles []PageBlockRelatedArt
 	return &pageBlockRelatedArticlesTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockRelatedArticles *PageBlockRelatedArticles) MarshalJSON() ([]byte, error) {
+	return pageBlockRelatedArticles.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (pageBlockRelatedArticles *PageBlockRelatedArticles) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, pageBlockRelatedArticles)
+}
+
+func (pageBlockRelatedArticles *PageBlockRelatedArticles) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockRelatedArticles == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-		Articles []PageBlockRelatedArticle `json:"articles"` // List of related articles
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockRelatedArticles.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockRelatedArticles.tdCommon.Extra)
+	b = append(b, `,"header":`...)
+	b, err = appendJSONValue(b, pageBlockRelatedArticles.Header)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"articles":`...)
+	if pageBlockRelatedArticles.Articles == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range pageBlockRelatedArticles.Articles {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = pageBlockRelatedArticles.Articles[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (pageBlockRelatedArticles *PageBlockRelatedArticles) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockRelatedArticles.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockRelatedArticles.tdCommon.Extra, err = r.readString()
+		case "header":
+			pageBlockRelatedArticles.Header, err = decodeRichText(r)
+		case "articles":
+			if r.readNull() {
+				pageBlockRelatedArticles.Articles = nil
+			} else {
+				pageBlockRelatedArticles.Articles = make([]PageBlockRelatedArticle, 0)
+				err = r.readArray(func() (err error) {
+					var item0 PageBlockRelatedArticle
+					err = item0.decodeJSON(r)
+					pageBlockRelatedArticles.Articles = append(pageBlockRelatedArticles.Articles, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockRelatedArticles *PageBlockRelatedArticles) Clone() *PageBlockRelatedArticles {
+	if pageBlockRelatedArticles == nil {
+		return nil
+	}
+	clone := *pageBlockRelatedArticles
+	clone.Header = cloneRichText(pageBlockRelatedArticles.Header)
+	if pageBlockRelatedArticles.Articles != nil {
+		clone.Articles = make([]PageBlockRelatedArticle, len(pageBlockRelatedArticles.Articles))
+		for i0 := range pageBlockRelatedArticles.Articles {
+			clone.Articles[i0] = *pageBlockRelatedArticles.Articles[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockRelatedArticles *PageBlockRelatedArticles) Equal(other *PageBlockRelatedArticles) bool {
+	if pageBlockRelatedArticles == nil || other == nil {
+		return pageBlockRelatedArticles == other
 	}
+	if !equalRichText(pageBlockRelatedArticles.Header, other.Header) {
+		return false
+	}
+	if len(pageBlockRelatedArticles.Articles) != len(other.Articles) {
+		return false
+	}
+	for i0 := range pageBlockRelatedArticles.Articles {
+		if !pageBlockRelatedArticles.Articles[i0].Equal(&other.Articles[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockRelatedArticles *PageBlockRelatedArticles) Diff(other *PageBlockRelatedArticles) []Change {
+	return pageBlockRelatedArticles.diff("", other, nil)
+}
+
+func (pageBlockRelatedArticles *PageBlockRelatedArticles) diff(path string, other *PageBlockRelatedArticles, changes []Change) []Change {
+	if pageBlockRelatedArticles == nil || other == nil {
+		if pageBlockRelatedArticles != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockRelatedArticles, New: other})
+		}
+		return changes
+	}
+	changes = diffRichText(fieldPath(path, "header"), pageBlockRelatedArticles.Header, other.Header, changes)
+	if len(pageBlockRelatedArticles.Articles) != len(other.Articles) {
+		changes = append(changes, Change{Path: fieldPath(path, "articles"), Old: pageBlockRelatedArticles.Articles, New: other.Articles})
+	} else {
+		for i0 := range pageBlockRelatedArticles.Articles {
+			changes = pageBlockRelatedArticles.Articles[i0].diff(indexPath(fieldPath(path, "articles"), i0), &other.Articles[i0], changes)
+		}
+	}
+	return changes
+}
 
-	pageBlockRelatedArticles.tdCommon = tempObj.tdCommon
-	pageBlockRelatedArticles.Articles = tempObj.Articles
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockRelatedArticles *PageBlockRelatedArticles) String() string {
+	return objectString(pageBlockRelatedArticles)
+}
 
-	fieldHeader, _ := unmarshalRichText(objMap["header"])
-	pageBlockRelatedArticles.Header = fieldHeader
+// LogValue logs the object as its summary
+func (pageBlockRelatedArticles *PageBlockRelatedArticles) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockRelatedArticles))
+}
 
-	return nil
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockRelatedArticles *PageBlockRelatedArticles) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockRelatedArticles)
+}
+
+func (pageBlockRelatedArticles *PageBlockRelatedArticles) printText(p *printer) {
+	if pageBlockRelatedArticles == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockRelatedArticles")
+	p.object("header", pageBlockRelatedArticles.Header)
+	p.vector("articles", len(pageBlockRelatedArticles.Articles), func(i0 int) {
+		pageBlockRelatedArticles.Articles[i0].printText(p)
+	})
+	p.endObject(state)
 }
 
 // GetPageBlockEnum return the enum type of this object
@@ -1461,6 +5039,11 @@ func (pageBlockRelatedArticles *PageBlockRelatedArticles) GetPageBlockEnum() Pag
 	return PageBlockRelatedArticlesType
 }
 
+// Accept calls the method of the visitor for PageBlockRelatedArticles
+func (pageBlockRelatedArticles *PageBlockRelatedArticles) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockRelatedArticles(pageBlockRelatedArticles)
+}
+
 // PageBlockMap A map
 type PageBlockMap struct {
 	tdCommon
@@ -1496,7 +5079,673 @@ func NewPageBlockMap(location *Location, zoom int32, width int32, height int32,
 	return &pageBlockMapTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockMap *PageBlockMap) MarshalJSON() ([]byte, error) {
+	return pageBlockMap.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pageBlockMap *PageBlockMap) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pageBlockMap)
+}
+
+func (pageBlockMap *PageBlockMap) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockMap == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockMap.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockMap.tdCommon.Extra)
+	b = append(b, `,"location":`...)
+	b, err = pageBlockMap.Location.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"zoom":`...)
+	b = appendJSONInt(b, int64(pageBlockMap.Zoom))
+	b = append(b, `,"width":`...)
+	b = appendJSONInt(b, int64(pageBlockMap.Width))
+	b = append(b, `,"height":`...)
+	b = appendJSONInt(b, int64(pageBlockMap.Height))
+	b = append(b, `,"caption":`...)
+	b, err = pageBlockMap.Caption.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (pageBlockMap *PageBlockMap) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockMap.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockMap.tdCommon.Extra, err = r.readString()
+		case "location":
+			if r.readNull() {
+				pageBlockMap.Location = nil
+			} else {
+				pageBlockMap.Location = new(Location)
+				err = pageBlockMap.Location.decodeJSON(r)
+			}
+		case "zoom":
+			pageBlockMap.Zoom, err = r.readInt32()
+		case "width":
+			pageBlockMap.Width, err = r.readInt32()
+		case "height":
+			pageBlockMap.Height, err = r.readInt32()
+		case "caption":
+			if r.readNull() {
+				pageBlockMap.Caption = nil
+			} else {
+				pageBlockMap.Caption = new(PageBlockCaption)
+				err = pageBlockMap.Caption.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockMap *PageBlockMap) Clone() *PageBlockMap {
+	if pageBlockMap == nil {
+		return nil
+	}
+	clone := *pageBlockMap
+	clone.Location = pageBlockMap.Location.Clone()
+	clone.Caption = pageBlockMap.Caption.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockMap *PageBlockMap) Equal(other *PageBlockMap) bool {
+	if pageBlockMap == nil || other == nil {
+		return pageBlockMap == other
+	}
+	if !pageBlockMap.Location.Equal(other.Location) {
+		return false
+	}
+	if pageBlockMap.Zoom != other.Zoom {
+		return false
+	}
+	if pageBlockMap.Width != other.Width {
+		return false
+	}
+	if pageBlockMap.Height != other.Height {
+		return false
+	}
+	if !pageBlockMap.Caption.Equal(other.Caption) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockMap *PageBlockMap) Diff(other *PageBlockMap) []Change {
+	return pageBlockMap.diff("", other, nil)
+}
+
+func (pageBlockMap *PageBlockMap) diff(path string, other *PageBlockMap, changes []Change) []Change {
+	if pageBlockMap == nil || other == nil {
+		if pageBlockMap != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockMap, New: other})
+		}
+		return changes
+	}
+	changes = pageBlockMap.Location.diff(fieldPath(path, "location"), other.Location, changes)
+	if pageBlockMap.Zoom != other.Zoom {
+		changes = append(changes, Change{Path: fieldPath(path, "zoom"), Old: pageBlockMap.Zoom, New: other.Zoom})
+	}
+	if pageBlockMap.Width != other.Width {
+		changes = append(changes, Change{Path: fieldPath(path, "width"), Old: pageBlockMap.Width, New: other.Width})
+	}
+	if pageBlockMap.Height != other.Height {
+		changes = append(changes, Change{Path: fieldPath(path, "height"), Old: pageBlockMap.Height, New: other.Height})
+	}
+	changes = pageBlockMap.Caption.diff(fieldPath(path, "caption"), other.Caption, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockMap *PageBlockMap) String() string {
+	return objectString(pageBlockMap)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockMap *PageBlockMap) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockMap))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockMap *PageBlockMap) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockMap)
+}
+
+func (pageBlockMap *PageBlockMap) printText(p *printer) {
+	if pageBlockMap == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockMap")
+	p.object("location", pageBlockMap.Location)
+	p.int("zoom", int64(pageBlockMap.Zoom))
+	p.int("width", int64(pageBlockMap.Width))
+	p.int("height", int64(pageBlockMap.Height))
+	p.object("caption", pageBlockMap.Caption)
+	p.endObject(state)
+}
+
 // GetPageBlockEnum return the enum type of this object
 func (pageBlockMap *PageBlockMap) GetPageBlockEnum() PageBlockEnum {
 	return PageBlockMapType
 }
+
+// Accept calls the method of the visitor for PageBlockMap
+func (pageBlockMap *PageBlockMap) Accept(visitor PageBlockVisitor) {
+	visitor.VisitPageBlockMap(pageBlockMap)
+}
+
+// PageBlockVisitor visits every type of PageBlock, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to PageBlock, until they handle it too.
+type PageBlockVisitor interface {
+	VisitPageBlockTitle(pageBlockTitle *PageBlockTitle)
+	VisitPageBlockSubtitle(pageBlockSubtitle *PageBlockSubtitle)
+	VisitPageBlockAuthorDate(pageBlockAuthorDate *PageBlockAuthorDate)
+	VisitPageBlockHeader(pageBlockHeader *PageBlockHeader)
+	VisitPageBlockSubheader(pageBlockSubheader *PageBlockSubheader)
+	VisitPageBlockKicker(pageBlockKicker *PageBlockKicker)
+	VisitPageBlockParagraph(pageBlockParagraph *PageBlockParagraph)
+	VisitPageBlockPreformatted(pageBlockPreformatted *PageBlockPreformatted)
+	VisitPageBlockFooter(pageBlockFooter *PageBlockFooter)
+	VisitPageBlockDivider(pageBlockDivider *PageBlockDivider)
+	VisitPageBlockAnchor(pageBlockAnchor *PageBlockAnchor)
+	VisitPageBlockList(pageBlockList *PageBlockList)
+	VisitPageBlockBlockQuote(pageBlockBlockQuote *PageBlockBlockQuote)
+	VisitPageBlockPullQuote(pageBlockPullQuote *PageBlockPullQuote)
+	VisitPageBlockAnimation(pageBlockAnimation *PageBlockAnimation)
+	VisitPageBlockAudio(pageBlockAudio *PageBlockAudio)
+	VisitPageBlockPhoto(pageBlockPhoto *PageBlockPhoto)
+	VisitPageBlockVideo(pageBlockVideo *PageBlockVideo)
+	VisitPageBlockVoiceNote(pageBlockVoiceNote *PageBlockVoiceNote)
+	VisitPageBlockCover(pageBlockCover *PageBlockCover)
+	VisitPageBlockEmbedded(pageBlockEmbedded *PageBlockEmbedded)
+	VisitPageBlockEmbeddedPost(pageBlockEmbeddedPost *PageBlockEmbeddedPost)
+	VisitPageBlockCollage(pageBlockCollage *PageBlockCollage)
+	VisitPageBlockSlideshow(pageBlockSlideshow *PageBlockSlideshow)
+	VisitPageBlockChatLink(pageBlockChatLink *PageBlockChatLink)
+	VisitPageBlockTable(pageBlockTable *PageBlockTable)
+	VisitPageBlockDetails(pageBlockDetails *PageBlockDetails)
+	VisitPageBlockRelatedArticles(pageBlockRelatedArticles *PageBlockRelatedArticles)
+	VisitPageBlockMap(pageBlockMap *PageBlockMap)
+	VisitUnknownPageBlock(unknownPageBlock *UnknownPageBlock)
+}
+
+// PageBlockCases holds the function MatchPageBlock calls for each type of PageBlock;
+// types without a function are passed to the default case
+type PageBlockCases[R any] struct {
+	PageBlockTitle           func(pageBlockTitle *PageBlockTitle) R
+	PageBlockSubtitle        func(pageBlockSubtitle *PageBlockSubtitle) R
+	PageBlockAuthorDate      func(pageBlockAuthorDate *PageBlockAuthorDate) R
+	PageBlockHeader          func(pageBlockHeader *PageBlockHeader) R
+	PageBlockSubheader       func(pageBlockSubheader *PageBlockSubheader) R
+	PageBlockKicker          func(pageBlockKicker *PageBlockKicker) R
+	PageBlockParagraph       func(pageBlockParagraph *PageBlockParagraph) R
+	PageBlockPreformatted    func(pageBlockPreformatted *PageBlockPreformatted) R
+	PageBlockFooter          func(pageBlockFooter *PageBlockFooter) R
+	PageBlockDivider         func(pageBlockDivider *PageBlockDivider) R
+	PageBlockAnchor          func(pageBlockAnchor *PageBlockAnchor) R
+	PageBlockList            func(pageBlockList *PageBlockList) R
+	PageBlockBlockQuote      func(pageBlockBlockQuote *PageBlockBlockQuote) R
+	PageBlockPullQuote       func(pageBlockPullQuote *PageBlockPullQuote) R
+	PageBlockAnimation       func(pageBlockAnimation *PageBlockAnimation) R
+	PageBlockAudio           func(pageBlockAudio *PageBlockAudio) R
+	PageBlockPhoto           func(pageBlockPhoto *PageBlockPhoto) R
+	PageBlockVideo           func(pageBlockVideo *PageBlockVideo) R
+	PageBlockVoiceNote       func(pageBlockVoiceNote *PageBlockVoiceNote) R
+	PageBlockCover           func(pageBlockCover *PageBlockCover) R
+	PageBlockEmbedded        func(pageBlockEmbedded *PageBlockEmbedded) R
+	PageBlockEmbeddedPost    func(pageBlockEmbeddedPost *PageBlockEmbeddedPost) R
+	PageBlockCollage         func(pageBlockCollage *PageBlockCollage) R
+	PageBlockSlideshow       func(pageBlockSlideshow *PageBlockSlideshow) R
+	PageBlockChatLink        func(pageBlockChatLink *PageBlockChatLink) R
+	PageBlockTable           func(pageBlockTable *PageBlockTable) R
+	PageBlockDetails         func(pageBlockDetails *PageBlockDetails) R
+	PageBlockRelatedArticles func(pageBlockRelatedArticles *PageBlockRelatedArticles) R
+	PageBlockMap             func(pageBlockMap *PageBlockMap) R
+}
+
+// MatchPageBlock calls the case of the type of pageBlock, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement PageBlockVisitor
+// to have the compiler check every type is handled.
+func MatchPageBlock[R any](pageBlock PageBlock, cases PageBlockCases[R], defaultCase func(pageBlock PageBlock) R) R {
+	switch value := pageBlock.(type) {
+	case *PageBlockTitle:
+		if cases.PageBlockTitle != nil {
+			return cases.PageBlockTitle(value)
+		}
+	case *PageBlockSubtitle:
+		if cases.PageBlockSubtitle != nil {
+			return cases.PageBlockSubtitle(value)
+		}
+	case *PageBlockAuthorDate:
+		if cases.PageBlockAuthorDate != nil {
+			return cases.PageBlockAuthorDate(value)
+		}
+	case *PageBlockHeader:
+		if cases.PageBlockHeader != nil {
+			return cases.PageBlockHeader(value)
+		}
+	case *PageBlockSubheader:
+		if cases.PageBlockSubheader != nil {
+			return cases.PageBlockSubheader(value)
+		}
+	case *PageBlockKicker:
+		if cases.PageBlockKicker != nil {
+			return cases.PageBlockKicker(value)
+		}
+	case *PageBlockParagraph:
+		if cases.PageBlockParagraph != nil {
+			return cases.PageBlockParagraph(value)
+		}
+	case *PageBlockPreformatted:
+		if cases.PageBlockPreformatted != nil {
+			return cases.PageBlockPreformatted(value)
+		}
+	case *PageBlockFooter:
+		if cases.PageBlockFooter != nil {
+			return cases.PageBlockFooter(value)
+		}
+	case *PageBlockDivider:
+		if cases.PageBlockDivider != nil {
+			return cases.PageBlockDivider(value)
+		}
+	case *PageBlockAnchor:
+		if cases.PageBlockAnchor != nil {
+			return cases.PageBlockAnchor(value)
+		}
+	case *PageBlockList:
+		if cases.PageBlockList != nil {
+			return cases.PageBlockList(value)
+		}
+	case *PageBlockBlockQuote:
+		if cases.PageBlockBlockQuote != nil {
+			return cases.PageBlockBlockQuote(value)
+		}
+	case *PageBlockPullQuote:
+		if cases.PageBlockPullQuote != nil {
+			return cases.PageBlockPullQuote(value)
+		}
+	case *PageBlockAnimation:
+		if cases.PageBlockAnimation != nil {
+			return cases.PageBlockAnimation(value)
+		}
+	case *PageBlockAudio:
+		if cases.PageBlockAudio != nil {
+			return cases.PageBlockAudio(value)
+		}
+	case *PageBlockPhoto:
+		if cases.PageBlockPhoto != nil {
+			return cases.PageBlockPhoto(value)
+		}
+	case *PageBlockVideo:
+		if cases.PageBlockVideo != nil {
+			return cases.PageBlockVideo(value)
+		}
+	case *PageBlockVoiceNote:
+		if cases.PageBlockVoiceNote != nil {
+			return cases.PageBlockVoiceNote(value)
+		}
+	case *PageBlockCover:
+		if cases.PageBlockCover != nil {
+			return cases.PageBlockCover(value)
+		}
+	case *PageBlockEmbedded:
+		if cases.PageBlockEmbedded != nil {
+			return cases.PageBlockEmbedded(value)
+		}
+	case *PageBlockEmbeddedPost:
+		if cases.PageBlockEmbeddedPost != nil {
+			return cases.PageBlockEmbeddedPost(value)
+		}
+	case *PageBlockCollage:
+		if cases.PageBlockCollage != nil {
+			return cases.PageBlockCollage(value)
+		}
+	case *PageBlockSlideshow:
+		if cases.PageBlockSlideshow != nil {
+			return cases.PageBlockSlideshow(value)
+		}
+	case *PageBlockChatLink:
+		if cases.PageBlockChatLink != nil {
+			return cases.PageBlockChatLink(value)
+		}
+	case *PageBlockTable:
+		if cases.PageBlockTable != nil {
+			return cases.PageBlockTable(value)
+		}
+	case *PageBlockDetails:
+		if cases.PageBlockDetails != nil {
+			return cases.PageBlockDetails(value)
+		}
+	case *PageBlockRelatedArticles:
+		if cases.PageBlockRelatedArticles != nil {
+			return cases.PageBlockRelatedArticles(value)
+		}
+	case *PageBlockMap:
+		if cases.PageBlockMap != nil {
+			return cases.PageBlockMap(value)
+		}
+	}
+	return defaultCase(pageBlock)
+}
+
+// Clone returns a deep copy of the object
+func (unknownPageBlock *UnknownPageBlock) Clone() *UnknownPageBlock {
+	if unknownPageBlock == nil {
+		return nil
+	}
+	return &UnknownPageBlock{Unknown: unknownPageBlock.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownPageBlock *UnknownPageBlock) Equal(other *UnknownPageBlock) bool {
+	if unknownPageBlock == nil || other == nil {
+		return unknownPageBlock == other
+	}
+	return unknownPageBlock.Unknown.equal(other.Unknown)
+}
+
+func clonePageBlock(pageBlock PageBlock) PageBlock {
+	switch value := pageBlock.(type) {
+	case *PageBlockTitle:
+		return value.Clone()
+	case *PageBlockSubtitle:
+		return value.Clone()
+	case *PageBlockAuthorDate:
+		return value.Clone()
+	case *PageBlockHeader:
+		return value.Clone()
+	case *PageBlockSubheader:
+		return value.Clone()
+	case *PageBlockKicker:
+		return value.Clone()
+	case *PageBlockParagraph:
+		return value.Clone()
+	case *PageBlockPreformatted:
+		return value.Clone()
+	case *PageBlockFooter:
+		return value.Clone()
+	case *PageBlockDivider:
+		return value.Clone()
+	case *PageBlockAnchor:
+		return value.Clone()
+	case *PageBlockList:
+		return value.Clone()
+	case *PageBlockBlockQuote:
+		return value.Clone()
+	case *PageBlockPullQuote:
+		return value.Clone()
+	case *PageBlockAnimation:
+		return value.Clone()
+	case *PageBlockAudio:
+		return value.Clone()
+	case *PageBlockPhoto:
+		return value.Clone()
+	case *PageBlockVideo:
+		return value.Clone()
+	case *PageBlockVoiceNote:
+		return value.Clone()
+	case *PageBlockCover:
+		return value.Clone()
+	case *PageBlockEmbedded:
+		return value.Clone()
+	case *PageBlockEmbeddedPost:
+		return value.Clone()
+	case *PageBlockCollage:
+		return value.Clone()
+	case *PageBlockSlideshow:
+		return value.Clone()
+	case *PageBlockChatLink:
+		return value.Clone()
+	case *PageBlockTable:
+		return value.Clone()
+	case *PageBlockDetails:
+		return value.Clone()
+	case *PageBlockRelatedArticles:
+		return value.Clone()
+	case *PageBlockMap:
+		return value.Clone()
+	case *UnknownPageBlock:
+		return value.Clone()
+	}
+	return pageBlock
+}
+
+func equalPageBlock(a PageBlock, b PageBlock) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *PageBlockTitle:
+		b, ok := b.(*PageBlockTitle)
+		return ok && a.Equal(b)
+	case *PageBlockSubtitle:
+		b, ok := b.(*PageBlockSubtitle)
+		return ok && a.Equal(b)
+	case *PageBlockAuthorDate:
+		b, ok := b.(*PageBlockAuthorDate)
+		return ok && a.Equal(b)
+	case *PageBlockHeader:
+		b, ok := b.(*PageBlockHeader)
+		return ok && a.Equal(b)
+	case *PageBlockSubheader:
+		b, ok := b.(*PageBlockSubheader)
+		return ok && a.Equal(b)
+	case *PageBlockKicker:
+		b, ok := b.(*PageBlockKicker)
+		return ok && a.Equal(b)
+	case *PageBlockParagraph:
+		b, ok := b.(*PageBlockParagraph)
+		return ok && a.Equal(b)
+	case *PageBlockPreformatted:
+		b, ok := b.(*PageBlockPreformatted)
+		return ok && a.Equal(b)
+	case *PageBlockFooter:
+		b, ok := b.(*PageBlockFooter)
+		return ok && a.Equal(b)
+	case *PageBlockDivider:
+		b, ok := b.(*PageBlockDivider)
+		return ok && a.Equal(b)
+	case *PageBlockAnchor:
+		b, ok := b.(*PageBlockAnchor)
+		return ok && a.Equal(b)
+	case *PageBlockList:
+		b, ok := b.(*PageBlockList)
+		return ok && a.Equal(b)
+	case *PageBlockBlockQuote:
+		b, ok := b.(*PageBlockBlockQuote)
+		return ok && a.Equal(b)
+	case *PageBlockPullQuote:
+		b, ok := b.(*PageBlockPullQuote)
+		return ok && a.Equal(b)
+	case *PageBlockAnimation:
+		b, ok := b.(*PageBlockAnimation)
+		return ok && a.Equal(b)
+	case *PageBlockAudio:
+		b, ok := b.(*PageBlockAudio)
+		return ok && a.Equal(b)
+	case *PageBlockPhoto:
+		b, ok := b.(*PageBlockPhoto)
+		return ok && a.Equal(b)
+	case *PageBlockVideo:
+		b, ok := b.(*PageBlockVideo)
+		return ok && a.Equal(b)
+	case *PageBlockVoiceNote:
+		b, ok := b.(*PageBlockVoiceNote)
+		return ok && a.Equal(b)
+	case *PageBlockCover:
+		b, ok := b.(*PageBlockCover)
+		return ok && a.Equal(b)
+	case *PageBlockEmbedded:
+		b, ok := b.(*PageBlockEmbedded)
+		return ok && a.Equal(b)
+	case *PageBlockEmbeddedPost:
+		b, ok := b.(*PageBlockEmbeddedPost)
+		return ok && a.Equal(b)
+	case *PageBlockCollage:
+		b, ok := b.(*PageBlockCollage)
+		return ok && a.Equal(b)
+	case *PageBlockSlideshow:
+		b, ok := b.(*PageBlockSlideshow)
+		return ok && a.Equal(b)
+	case *PageBlockChatLink:
+		b, ok := b.(*PageBlockChatLink)
+		return ok && a.Equal(b)
+	case *PageBlockTable:
+		b, ok := b.(*PageBlockTable)
+		return ok && a.Equal(b)
+	case *PageBlockDetails:
+		b, ok := b.(*PageBlockDetails)
+		return ok && a.Equal(b)
+	case *PageBlockRelatedArticles:
+		b, ok := b.(*PageBlockRelatedArticles)
+		return ok && a.Equal(b)
+	case *PageBlockMap:
+		b, ok := b.(*PageBlockMap)
+		return ok && a.Equal(b)
+	case *UnknownPageBlock:
+		b, ok := b.(*UnknownPageBlock)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffPageBlock(path string, a PageBlock, b PageBlock, changes []Change) []Change {
+	switch a := a.(type) {
+	case *PageBlockTitle:
+		if b, ok := b.(*PageBlockTitle); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockSubtitle:
+		if b, ok := b.(*PageBlockSubtitle); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockAuthorDate:
+		if b, ok := b.(*PageBlockAuthorDate); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockHeader:
+		if b, ok := b.(*PageBlockHeader); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockSubheader:
+		if b, ok := b.(*PageBlockSubheader); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockKicker:
+		if b, ok := b.(*PageBlockKicker); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockParagraph:
+		if b, ok := b.(*PageBlockParagraph); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockPreformatted:
+		if b, ok := b.(*PageBlockPreformatted); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockFooter:
+		if b, ok := b.(*PageBlockFooter); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockDivider:
+		if b, ok := b.(*PageBlockDivider); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockAnchor:
+		if b, ok := b.(*PageBlockAnchor); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockList:
+		if b, ok := b.(*PageBlockList); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockBlockQuote:
+		if b, ok := b.(*PageBlockBlockQuote); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockPullQuote:
+		if b, ok := b.(*PageBlockPullQuote); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockAnimation:
+		if b, ok := b.(*PageBlockAnimation); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockAudio:
+		if b, ok := b.(*PageBlockAudio); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockPhoto:
+		if b, ok := b.(*PageBlockPhoto); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockVideo:
+		if b, ok := b.(*PageBlockVideo); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockVoiceNote:
+		if b, ok := b.(*PageBlockVoiceNote); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockCover:
+		if b, ok := b.(*PageBlockCover); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockEmbedded:
+		if b, ok := b.(*PageBlockEmbedded); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockEmbeddedPost:
+		if b, ok := b.(*PageBlockEmbeddedPost); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockCollage:
+		if b, ok := b.(*PageBlockCollage); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockSlideshow:
+		if b, ok := b.(*PageBlockSlideshow); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockChatLink:
+		if b, ok := b.(*PageBlockChatLink); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockTable:
+		if b, ok := b.(*PageBlockTable); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockDetails:
+		if b, ok := b.(*PageBlockDetails); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockRelatedArticles:
+		if b, ok := b.(*PageBlockRelatedArticles); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockMap:
+		if b, ok := b.(*PageBlockMap); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalPageBlock(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // PageBlockCaption Contains a caption of an instant view web page block, consisting of a text and a trailing credit
@@ -30,28 +31,120 @@ func NewPageBlockCaption(text RichText, credit RichText) *PageBlockCaption {
 	return &pageBlockCaptionTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockCaption *PageBlockCaption) MarshalJSON() ([]byte, error) {
+	return pageBlockCaption.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (pageBlockCaption *PageBlockCaption) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, pageBlockCaption)
+}
+
+func (pageBlockCaption *PageBlockCaption) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockCaption == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockCaption.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockCaption.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b, err = appendJSONValue(b, pageBlockCaption.Text)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	b = append(b, `,"credit":`...)
+	b, err = appendJSONValue(b, pageBlockCaption.Credit)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return append(b, '}'), nil
+}
 
-	pageBlockCaption.tdCommon = tempObj.tdCommon
+func (pageBlockCaption *PageBlockCaption) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockCaption.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockCaption.tdCommon.Extra, err = r.readString()
+		case "text":
+			pageBlockCaption.Text, err = decodeRichText(r)
+		case "credit":
+			pageBlockCaption.Credit, err = decodeRichText(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	fieldText, _ := unmarshalRichText(objMap["text"])
-	pageBlockCaption.Text = fieldText
+// Clone returns a deep copy of the object
+func (pageBlockCaption *PageBlockCaption) Clone() *PageBlockCaption {
+	if pageBlockCaption == nil {
+		return nil
+	}
+	clone := *pageBlockCaption
+	clone.Text = cloneRichText(pageBlockCaption.Text)
+	clone.Credit = cloneRichText(pageBlockCaption.Credit)
+	return &clone
+}
 
-	fieldCredit, _ := unmarshalRichText(objMap["credit"])
-	pageBlockCaption.Credit = fieldCredit
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockCaption *PageBlockCaption) Equal(other *PageBlockCaption) bool {
+	if pageBlockCaption == nil || other == nil {
+		return pageBlockCaption == other
+	}
+	if !equalRichText(pageBlockCaption.Text, other.Text) {
+		return false
+	}
+	if !equalRichText(pageBlockCaption.Credit, other.Credit) {
+		return false
+	}
+	return true
+}
 
-	return nil
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockCaption *PageBlockCaption) Diff(other *PageBlockCaption) []Change {
+	return pageBlockCaption.diff("", other, nil)
+}
+
+func (pageBlockCaption *PageBlockCaption) diff(path string, other *PageBlockCaption, changes []Change) []Change {
+	if pageBlockCaption == nil || other == nil {
+		if pageBlockCaption != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockCaption, New: other})
+		}
+		return changes
+	}
+	changes = diffRichText(fieldPath(path, "text"), pageBlockCaption.Text, other.Text, changes)
+	changes = diffRichText(fieldPath(path, "credit"), pageBlockCaption.Credit, other.Credit, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockCaption *PageBlockCaption) String() string {
+	return objectString(pageBlockCaption)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockCaption *PageBlockCaption) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockCaption))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockCaption *PageBlockCaption) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockCaption)
+}
+
+func (pageBlockCaption *PageBlockCaption) printText(p *printer) {
+	if pageBlockCaption == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockCaption")
+	p.object("text", pageBlockCaption.Text)
+	p.object("credit", pageBlockCaption.Credit)
+	p.endObject(state)
 }
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // PageBlockHorizontalAlignment Describes a horizontal alignment of a table cell content
 type PageBlockHorizontalAlignment interface {
 	GetPageBlockHorizontalAlignmentEnum() PageBlockHorizontalAlignmentEnum
+	Accept(visitor PageBlockHorizontalAlignmentVisitor)
 }
 
 // PageBlockHorizontalAlignmentEnum Alias for abstract PageBlockHorizontalAlignment 'Sub-Classes', used as constant-enum here
@@ -20,38 +22,89 @@ const (
 	PageBlockHorizontalAlignmentRightType  PageBlockHorizontalAlignmentEnum = "pageBlockHorizontalAlignmentRight"
 )
 
+// UnknownPageBlockHorizontalAlignment is a PageBlockHorizontalAlignment of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownPageBlockHorizontalAlignment struct {
+	Unknown
+}
+
+// GetPageBlockHorizontalAlignmentEnum return the enum type of this object
+func (unknownPageBlockHorizontalAlignment *UnknownPageBlockHorizontalAlignment) GetPageBlockHorizontalAlignmentEnum() PageBlockHorizontalAlignmentEnum {
+	return PageBlockHorizontalAlignmentEnum(unknownPageBlockHorizontalAlignment.Type)
+}
+
+// Accept calls the method of the visitor for UnknownPageBlockHorizontalAlignment
+func (unknownPageBlockHorizontalAlignment *UnknownPageBlockHorizontalAlignment) Accept(visitor PageBlockHorizontalAlignmentVisitor) {
+	visitor.VisitUnknownPageBlockHorizontalAlignment(unknownPageBlockHorizontalAlignment)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownPageBlockHorizontalAlignment *UnknownPageBlockHorizontalAlignment) String() string {
+	return objectString(unknownPageBlockHorizontalAlignment)
+}
+
+// LogValue logs the object as its summary
+func (unknownPageBlockHorizontalAlignment *UnknownPageBlockHorizontalAlignment) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownPageBlockHorizontalAlignment))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownPageBlockHorizontalAlignment *UnknownPageBlockHorizontalAlignment) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownPageBlockHorizontalAlignment)
+}
+
+func (unknownPageBlockHorizontalAlignment *UnknownPageBlockHorizontalAlignment) printText(p *printer) {
+	if unknownPageBlockHorizontalAlignment == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownPageBlockHorizontalAlignment")
+	p.string("@type", unknownPageBlockHorizontalAlignment.Type)
+	p.endObject(state)
+}
+
 func unmarshalPageBlockHorizontalAlignment(rawMsg *json.RawMessage) (PageBlockHorizontalAlignment, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch PageBlockHorizontalAlignmentEnum(objMap["@type"].(string)) {
+	switch PageBlockHorizontalAlignmentEnum(typeName) {
 	case PageBlockHorizontalAlignmentLeftType:
 		var pageBlockHorizontalAlignmentLeft PageBlockHorizontalAlignmentLeft
-		err := json.Unmarshal(*rawMsg, &pageBlockHorizontalAlignmentLeft)
+		err := jsonUnmarshal(*rawMsg, &pageBlockHorizontalAlignmentLeft)
 		return &pageBlockHorizontalAlignmentLeft, err
 
 	case PageBlockHorizontalAlignmentCenterType:
 		var pageBlockHorizontalAlignmentCenter PageBlockHorizontalAlignmentCenter
-		err := json.Unmarshal(*rawMsg, &pageBlockHorizontalAlignmentCenter)
+		err := jsonUnmarshal(*rawMsg, &pageBlockHorizontalAlignmentCenter)
 		return &pageBlockHorizontalAlignmentCenter, err
 
 	case PageBlockHorizontalAlignmentRightType:
 		var pageBlockHorizontalAlignmentRight PageBlockHorizontalAlignmentRight
-		err := json.Unmarshal(*rawMsg, &pageBlockHorizontalAlignmentRight)
+		err := jsonUnmarshal(*rawMsg, &pageBlockHorizontalAlignmentRight)
 		return &pageBlockHorizontalAlignmentRight, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownPageBlockHorizontalAlignment{Unknown: unknown}, nil
 	}
 }
 
+func decodePageBlockHorizontalAlignment(r *jsonReader) (PageBlockHorizontalAlignment, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalPageBlockHorizontalAlignment(rawMsg)
+}
+
 // PageBlockHorizontalAlignmentLeft The content must be left-aligned
 type PageBlockHorizontalAlignmentLeft struct {
 	tdCommon
@@ -63,7 +116,6 @@ func (pageBlockHorizontalAlignmentLeft *PageBlockHorizontalAlignmentLeft) Messag
 }
 
 // NewPageBlockHorizontalAlignmentLeft creates a new PageBlockHorizontalAlignmentLeft
-//
 func NewPageBlockHorizontalAlignmentLeft() *PageBlockHorizontalAlignmentLeft {
 	pageBlockHorizontalAlignmentLeftTemp := PageBlockHorizontalAlignmentLeft{
 		tdCommon: tdCommon{Type: "pageBlockHorizontalAlignmentLeft"},
@@ -72,11 +124,107 @@ func NewPageBlockHorizontalAlignmentLeft() *PageBlockHorizontalAlignmentLeft {
 	return &pageBlockHorizontalAlignmentLeftTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockHorizontalAlignmentLeft *PageBlockHorizontalAlignmentLeft) MarshalJSON() ([]byte, error) {
+	return pageBlockHorizontalAlignmentLeft.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pageBlockHorizontalAlignmentLeft *PageBlockHorizontalAlignmentLeft) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pageBlockHorizontalAlignmentLeft)
+}
+
+func (pageBlockHorizontalAlignmentLeft *PageBlockHorizontalAlignmentLeft) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockHorizontalAlignmentLeft == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockHorizontalAlignmentLeft.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockHorizontalAlignmentLeft.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (pageBlockHorizontalAlignmentLeft *PageBlockHorizontalAlignmentLeft) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockHorizontalAlignmentLeft.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockHorizontalAlignmentLeft.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockHorizontalAlignmentLeft *PageBlockHorizontalAlignmentLeft) Clone() *PageBlockHorizontalAlignmentLeft {
+	if pageBlockHorizontalAlignmentLeft == nil {
+		return nil
+	}
+	clone := *pageBlockHorizontalAlignmentLeft
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockHorizontalAlignmentLeft *PageBlockHorizontalAlignmentLeft) Equal(other *PageBlockHorizontalAlignmentLeft) bool {
+	if pageBlockHorizontalAlignmentLeft == nil || other == nil {
+		return pageBlockHorizontalAlignmentLeft == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockHorizontalAlignmentLeft *PageBlockHorizontalAlignmentLeft) Diff(other *PageBlockHorizontalAlignmentLeft) []Change {
+	return pageBlockHorizontalAlignmentLeft.diff("", other, nil)
+}
+
+func (pageBlockHorizontalAlignmentLeft *PageBlockHorizontalAlignmentLeft) diff(path string, other *PageBlockHorizontalAlignmentLeft, changes []Change) []Change {
+	if pageBlockHorizontalAlignmentLeft == nil || other == nil {
+		if pageBlockHorizontalAlignmentLeft != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockHorizontalAlignmentLeft, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockHorizontalAlignmentLeft *PageBlockHorizontalAlignmentLeft) String() string {
+	return objectString(pageBlockHorizontalAlignmentLeft)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockHorizontalAlignmentLeft *PageBlockHorizontalAlignmentLeft) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockHorizontalAlignmentLeft))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockHorizontalAlignmentLeft *PageBlockHorizontalAlignmentLeft) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockHorizontalAlignmentLeft)
+}
+
+func (pageBlockHorizontalAlignmentLeft *PageBlockHorizontalAlignmentLeft) printText(p *printer) {
+	if pageBlockHorizontalAlignmentLeft == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockHorizontalAlignmentLeft")
+	p.endObject(state)
+}
+
 // GetPageBlockHorizontalAlignmentEnum return the enum type of this object
 func (pageBlockHorizontalAlignmentLeft *PageBlockHorizontalAlignmentLeft) GetPageBlockHorizontalAlignmentEnum() PageBlockHorizontalAlignmentEnum {
 	return PageBlockHorizontalAlignmentLeftType
 }
 
+// Accept calls the method of the visitor for PageBlockHorizontalAlignmentLeft
+func (pageBlockHorizontalAlignmentLeft *PageBlockHorizontalAlignmentLeft) Accept(visitor PageBlockHorizontalAlignmentVisitor) {
+	visitor.VisitPageBlockHorizontalAlignmentLeft(pageBlockHorizontalAlignmentLeft)
+}
+
 // PageBlockHorizontalAlignmentCenter The content must be center-aligned
 type PageBlockHorizontalAlignmentCenter struct {
 	tdCommon
@@ -88,7 +236,6 @@ func (pageBlockHorizontalAlignmentCenter *PageBlockHorizontalAlignmentCenter) Me
 }
 
 // NewPageBlockHorizontalAlignmentCenter creates a new PageBlockHorizontalAlignmentCenter
-//
 func NewPageBlockHorizontalAlignmentCenter() *PageBlockHorizontalAlignmentCenter {
 	pageBlockHorizontalAlignmentCenterTemp := PageBlockHorizontalAlignmentCenter{
 		tdCommon: tdCommon{Type: "pageBlockHorizontalAlignmentCenter"},
@@ -97,11 +244,107 @@ func NewPageBlockHorizontalAlignmentCenter() *PageBlockHorizontalAlignmentCenter
 	return &pageBlockHorizontalAlignmentCenterTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockHorizontalAlignmentCenter *PageBlockHorizontalAlignmentCenter) MarshalJSON() ([]byte, error) {
+	return pageBlockHorizontalAlignmentCenter.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pageBlockHorizontalAlignmentCenter *PageBlockHorizontalAlignmentCenter) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pageBlockHorizontalAlignmentCenter)
+}
+
+func (pageBlockHorizontalAlignmentCenter *PageBlockHorizontalAlignmentCenter) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockHorizontalAlignmentCenter == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockHorizontalAlignmentCenter.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockHorizontalAlignmentCenter.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (pageBlockHorizontalAlignmentCenter *PageBlockHorizontalAlignmentCenter) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockHorizontalAlignmentCenter.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockHorizontalAlignmentCenter.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockHorizontalAlignmentCenter *PageBlockHorizontalAlignmentCenter) Clone() *PageBlockHorizontalAlignmentCenter {
+	if pageBlockHorizontalAlignmentCenter == nil {
+		return nil
+	}
+	clone := *pageBlockHorizontalAlignmentCenter
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockHorizontalAlignmentCenter *PageBlockHorizontalAlignmentCenter) Equal(other *PageBlockHorizontalAlignmentCenter) bool {
+	if pageBlockHorizontalAlignmentCenter == nil || other == nil {
+		return pageBlockHorizontalAlignmentCenter == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockHorizontalAlignmentCenter *PageBlockHorizontalAlignmentCenter) Diff(other *PageBlockHorizontalAlignmentCenter) []Change {
+	return pageBlockHorizontalAlignmentCenter.diff("", other, nil)
+}
+
+func (pageBlockHorizontalAlignmentCenter *PageBlockHorizontalAlignmentCenter) diff(path string, other *PageBlockHorizontalAlignmentCenter, changes []Change) []Change {
+	if pageBlockHorizontalAlignmentCenter == nil || other == nil {
+		if pageBlockHorizontalAlignmentCenter != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockHorizontalAlignmentCenter, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockHorizontalAlignmentCenter *PageBlockHorizontalAlignmentCenter) String() string {
+	return objectString(pageBlockHorizontalAlignmentCenter)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockHorizontalAlignmentCenter *PageBlockHorizontalAlignmentCenter) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockHorizontalAlignmentCenter))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockHorizontalAlignmentCenter *PageBlockHorizontalAlignmentCenter) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockHorizontalAlignmentCenter)
+}
+
+func (pageBlockHorizontalAlignmentCenter *PageBlockHorizontalAlignmentCenter) printText(p *printer) {
+	if pageBlockHorizontalAlignmentCenter == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockHorizontalAlignmentCenter")
+	p.endObject(state)
+}
+
 // GetPageBlockHorizontalAlignmentEnum return the enum type of this object
 func (pageBlockHorizontalAlignmentCenter *PageBlockHorizontalAlignmentCenter) GetPageBlockHorizontalAlignmentEnum() PageBlockHorizontalAlignmentEnum {
 	return PageBlockHorizontalAlignmentCenterType
 }
 
+// Accept calls the method of the visitor for PageBlockHorizontalAlignmentCenter
+func (pageBlockHorizontalAlignmentCenter *PageBlockHorizontalAlignmentCenter) Accept(visitor PageBlockHorizontalAlignmentVisitor) {
+	visitor.VisitPageBlockHorizontalAlignmentCenter(pageBlockHorizontalAlignmentCenter)
+}
+
 // PageBlockHorizontalAlignmentRight The content must be right-aligned
 type PageBlockHorizontalAlignmentRight struct {
 	tdCommon
@@ -113,7 +356,6 @@ func (pageBlockHorizontalAlignmentRight *PageBlockHorizontalAlignmentRight) Mess
 }
 
 // NewPageBlockHorizontalAlignmentRight creates a new PageBlockHorizontalAlignmentRight
-//
 func NewPageBlockHorizontalAlignmentRight() *PageBlockHorizontalAlignmentRight {
 	pageBlockHorizontalAlignmentRightTemp := PageBlockHorizontalAlignmentRight{
 		tdCommon: tdCommon{Type: "pageBlockHorizontalAlignmentRight"},
@@ -122,7 +364,213 @@ func NewPageBlockHorizontalAlignmentRight() *PageBlockHorizontalAlignmentRight {
 	return &pageBlockHorizontalAlignmentRightTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockHorizontalAlignmentRight *PageBlockHorizontalAlignmentRight) MarshalJSON() ([]byte, error) {
+	return pageBlockHorizontalAlignmentRight.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pageBlockHorizontalAlignmentRight *PageBlockHorizontalAlignmentRight) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pageBlockHorizontalAlignmentRight)
+}
+
+func (pageBlockHorizontalAlignmentRight *PageBlockHorizontalAlignmentRight) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockHorizontalAlignmentRight == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockHorizontalAlignmentRight.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockHorizontalAlignmentRight.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (pageBlockHorizontalAlignmentRight *PageBlockHorizontalAlignmentRight) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockHorizontalAlignmentRight.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockHorizontalAlignmentRight.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockHorizontalAlignmentRight *PageBlockHorizontalAlignmentRight) Clone() *PageBlockHorizontalAlignmentRight {
+	if pageBlockHorizontalAlignmentRight == nil {
+		return nil
+	}
+	clone := *pageBlockHorizontalAlignmentRight
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockHorizontalAlignmentRight *PageBlockHorizontalAlignmentRight) Equal(other *PageBlockHorizontalAlignmentRight) bool {
+	if pageBlockHorizontalAlignmentRight == nil || other == nil {
+		return pageBlockHorizontalAlignmentRight == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockHorizontalAlignmentRight *PageBlockHorizontalAlignmentRight) Diff(other *PageBlockHorizontalAlignmentRight) []Change {
+	return pageBlockHorizontalAlignmentRight.diff("", other, nil)
+}
+
+func (pageBlockHorizontalAlignmentRight *PageBlockHorizontalAlignmentRight) diff(path string, other *PageBlockHorizontalAlignmentRight, changes []Change) []Change {
+	if pageBlockHorizontalAlignmentRight == nil || other == nil {
+		if pageBlockHorizontalAlignmentRight != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockHorizontalAlignmentRight, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockHorizontalAlignmentRight *PageBlockHorizontalAlignmentRight) String() string {
+	return objectString(pageBlockHorizontalAlignmentRight)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockHorizontalAlignmentRight *PageBlockHorizontalAlignmentRight) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockHorizontalAlignmentRight))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockHorizontalAlignmentRight *PageBlockHorizontalAlignmentRight) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockHorizontalAlignmentRight)
+}
+
+func (pageBlockHorizontalAlignmentRight *PageBlockHorizontalAlignmentRight) printText(p *printer) {
+	if pageBlockHorizontalAlignmentRight == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockHorizontalAlignmentRight")
+	p.endObject(state)
+}
+
 // GetPageBlockHorizontalAlignmentEnum return the enum type of this object
 func (pageBlockHorizontalAlignmentRight *PageBlockHorizontalAlignmentRight) GetPageBlockHorizontalAlignmentEnum() PageBlockHorizontalAlignmentEnum {
 	return PageBlockHorizontalAlignmentRightType
 }
+
+// Accept calls the method of the visitor for PageBlockHorizontalAlignmentRight
+func (pageBlockHorizontalAlignmentRight *PageBlockHorizontalAlignmentRight) Accept(visitor PageBlockHorizontalAlignmentVisitor) {
+	visitor.VisitPageBlockHorizontalAlignmentRight(pageBlockHorizontalAlignmentRight)
+}
+
+// PageBlockHorizontalAlignmentVisitor visits every type of PageBlockHorizontalAlignment, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to PageBlockHorizontalAlignment, until they handle it too.
+type PageBlockHorizontalAlignmentVisitor interface {
+	VisitPageBlockHorizontalAlignmentLeft(pageBlockHorizontalAlignmentLeft *PageBlockHorizontalAlignmentLeft)
+	VisitPageBlockHorizontalAlignmentCenter(pageBlockHorizontalAlignmentCenter *PageBlockHorizontalAlignmentCenter)
+	VisitPageBlockHorizontalAlignmentRight(pageBlockHorizontalAlignmentRight *PageBlockHorizontalAlignmentRight)
+	VisitUnknownPageBlockHorizontalAlignment(unknownPageBlockHorizontalAlignment *UnknownPageBlockHorizontalAlignment)
+}
+
+// PageBlockHorizontalAlignmentCases holds the function MatchPageBlockHorizontalAlignment calls for each type of PageBlockHorizontalAlignment;
+// types without a function are passed to the default case
+type PageBlockHorizontalAlignmentCases[R any] struct {
+	PageBlockHorizontalAlignmentLeft   func(pageBlockHorizontalAlignmentLeft *PageBlockHorizontalAlignmentLeft) R
+	PageBlockHorizontalAlignmentCenter func(pageBlockHorizontalAlignmentCenter *PageBlockHorizontalAlignmentCenter) R
+	PageBlockHorizontalAlignmentRight  func(pageBlockHorizontalAlignmentRight *PageBlockHorizontalAlignmentRight) R
+}
+
+// MatchPageBlockHorizontalAlignment calls the case of the type of pageBlockHorizontalAlignment, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement PageBlockHorizontalAlignmentVisitor
+// to have the compiler check every type is handled.
+func MatchPageBlockHorizontalAlignment[R any](pageBlockHorizontalAlignment PageBlockHorizontalAlignment, cases PageBlockHorizontalAlignmentCases[R], defaultCase func(pageBlockHorizontalAlignment PageBlockHorizontalAlignment) R) R {
+	switch value := pageBlockHorizontalAlignment.(type) {
+	case *PageBlockHorizontalAlignmentLeft:
+		if cases.PageBlockHorizontalAlignmentLeft != nil {
+			return cases.PageBlockHorizontalAlignmentLeft(value)
+		}
+	case *PageBlockHorizontalAlignmentCenter:
+		if cases.PageBlockHorizontalAlignmentCenter != nil {
+			return cases.PageBlockHorizontalAlignmentCenter(value)
+		}
+	case *PageBlockHorizontalAlignmentRight:
+		if cases.PageBlockHorizontalAlignmentRight != nil {
+			return cases.PageBlockHorizontalAlignmentRight(value)
+		}
+	}
+	return defaultCase(pageBlockHorizontalAlignment)
+}
+
+// Clone returns a deep copy of the object
+func (unknownPageBlockHorizontalAlignment *UnknownPageBlockHorizontalAlignment) Clone() *UnknownPageBlockHorizontalAlignment {
+	if unknownPageBlockHorizontalAlignment == nil {
+		return nil
+	}
+	return &UnknownPageBlockHorizontalAlignment{Unknown: unknownPageBlockHorizontalAlignment.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownPageBlockHorizontalAlignment *UnknownPageBlockHorizontalAlignment) Equal(other *UnknownPageBlockHorizontalAlignment) bool {
+	if unknownPageBlockHorizontalAlignment == nil || other == nil {
+		return unknownPageBlockHorizontalAlignment == other
+	}
+	return unknownPageBlockHorizontalAlignment.Unknown.equal(other.Unknown)
+}
+
+func clonePageBlockHorizontalAlignment(pageBlockHorizontalAlignment PageBlockHorizontalAlignment) PageBlockHorizontalAlignment {
+	switch value := pageBlockHorizontalAlignment.(type) {
+	case *PageBlockHorizontalAlignmentLeft:
+		return value.Clone()
+	case *PageBlockHorizontalAlignmentCenter:
+		return value.Clone()
+	case *PageBlockHorizontalAlignmentRight:
+		return value.Clone()
+	case *UnknownPageBlockHorizontalAlignment:
+		return value.Clone()
+	}
+	return pageBlockHorizontalAlignment
+}
+
+func equalPageBlockHorizontalAlignment(a PageBlockHorizontalAlignment, b PageBlockHorizontalAlignment) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *PageBlockHorizontalAlignmentLeft:
+		b, ok := b.(*PageBlockHorizontalAlignmentLeft)
+		return ok && a.Equal(b)
+	case *PageBlockHorizontalAlignmentCenter:
+		b, ok := b.(*PageBlockHorizontalAlignmentCenter)
+		return ok && a.Equal(b)
+	case *PageBlockHorizontalAlignmentRight:
+		b, ok := b.(*PageBlockHorizontalAlignmentRight)
+		return ok && a.Equal(b)
+	case *UnknownPageBlockHorizontalAlignment:
+		b, ok := b.(*UnknownPageBlockHorizontalAlignment)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffPageBlockHorizontalAlignment(path string, a PageBlockHorizontalAlignment, b PageBlockHorizontalAlignment, changes []Change) []Change {
+	switch a := a.(type) {
+	case *PageBlockHorizontalAlignmentLeft:
+		if b, ok := b.(*PageBlockHorizontalAlignmentLeft); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockHorizontalAlignmentCenter:
+		if b, ok := b.(*PageBlockHorizontalAlignmentCenter); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockHorizontalAlignmentRight:
+		if b, ok := b.(*PageBlockHorizontalAlignmentRight); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalPageBlockHorizontalAlignment(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // PageBlockListItem Describes an item of a list page block
 type PageBlockListItem struct {
 	tdCommon
@@ -25,3 +30,158 @@ func NewPageBlockListItem(label string, pageBlocks []PageBlock) *PageBlockListIt
 
 	return &pageBlockListItemTemp
 }
+
+// MarshalJSON marshals to json
+func (pageBlockListItem *PageBlockListItem) MarshalJSON() ([]byte, error) {
+	return pageBlockListItem.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pageBlockListItem *PageBlockListItem) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pageBlockListItem)
+}
+
+func (pageBlockListItem *PageBlockListItem) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockListItem == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockListItem.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockListItem.tdCommon.Extra)
+	b = append(b, `,"label":`...)
+	b = appendJSONString(b, pageBlockListItem.Label)
+	b = append(b, `,"page_blocks":`...)
+	if pageBlockListItem.PageBlocks == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range pageBlockListItem.PageBlocks {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = appendJSONValue(b, pageBlockListItem.PageBlocks[i0])
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (pageBlockListItem *PageBlockListItem) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockListItem.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockListItem.tdCommon.Extra, err = r.readString()
+		case "label":
+			pageBlockListItem.Label, err = r.readString()
+		case "page_blocks":
+			if r.readNull() {
+				pageBlockListItem.PageBlocks = nil
+			} else {
+				pageBlockListItem.PageBlocks = make([]PageBlock, 0)
+				err = r.readArray(func() (err error) {
+					var item0 PageBlock
+					item0, err = decodePageBlock(r)
+					pageBlockListItem.PageBlocks = append(pageBlockListItem.PageBlocks, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockListItem *PageBlockListItem) Clone() *PageBlockListItem {
+	if pageBlockListItem == nil {
+		return nil
+	}
+	clone := *pageBlockListItem
+	if pageBlockListItem.PageBlocks != nil {
+		clone.PageBlocks = make([]PageBlock, len(pageBlockListItem.PageBlocks))
+		for i0 := range pageBlockListItem.PageBlocks {
+			clone.PageBlocks[i0] = clonePageBlock(pageBlockListItem.PageBlocks[i0])
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockListItem *PageBlockListItem) Equal(other *PageBlockListItem) bool {
+	if pageBlockListItem == nil || other == nil {
+		return pageBlockListItem == other
+	}
+	if pageBlockListItem.Label != other.Label {
+		return false
+	}
+	if len(pageBlockListItem.PageBlocks) != len(other.PageBlocks) {
+		return false
+	}
+	for i0 := range pageBlockListItem.PageBlocks {
+		if !equalPageBlock(pageBlockListItem.PageBlocks[i0], other.PageBlocks[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockListItem *PageBlockListItem) Diff(other *PageBlockListItem) []Change {
+	return pageBlockListItem.diff("", other, nil)
+}
+
+func (pageBlockListItem *PageBlockListItem) diff(path string, other *PageBlockListItem, changes []Change) []Change {
+	if pageBlockListItem == nil || other == nil {
+		if pageBlockListItem != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockListItem, New: other})
+		}
+		return changes
+	}
+	if pageBlockListItem.Label != other.Label {
+		changes = append(changes, Change{Path: fieldPath(path, "label"), Old: pageBlockListItem.Label, New: other.Label})
+	}
+	if len(pageBlockListItem.PageBlocks) != len(other.PageBlocks) {
+		changes = append(changes, Change{Path: fieldPath(path, "page_blocks"), Old: pageBlockListItem.PageBlocks, New: other.PageBlocks})
+	} else {
+		for i0 := range pageBlockListItem.PageBlocks {
+			changes = diffPageBlock(indexPath(fieldPath(path, "page_blocks"), i0), pageBlockListItem.PageBlocks[i0], other.PageBlocks[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockListItem *PageBlockListItem) String() string {
+	return objectString(pageBlockListItem)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockListItem *PageBlockListItem) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockListItem))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockListItem *PageBlockListItem) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockListItem)
+}
+
+func (pageBlockListItem *PageBlockListItem) printText(p *printer) {
+	if pageBlockListItem == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockListItem")
+	p.string("label", pageBlockListItem.Label)
+	p.vector("page_blocks", len(pageBlockListItem.PageBlocks), func(i0 int) {
+		p.object("page_blocks", pageBlockListItem.PageBlocks[i0])
+	})
+	p.endObject(state)
+}
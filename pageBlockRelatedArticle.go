@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // PageBlockRelatedArticle Contains information about a related article
 type PageBlockRelatedArticle struct {
 	tdCommon
@@ -37,3 +42,168 @@ func NewPageBlockRelatedArticle(url string, title string, description string, ph
 
 	return &pageBlockRelatedArticleTemp
 }
+
+// MarshalJSON marshals to json
+func (pageBlockRelatedArticle *PageBlockRelatedArticle) MarshalJSON() ([]byte, error) {
+	return pageBlockRelatedArticle.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pageBlockRelatedArticle *PageBlockRelatedArticle) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pageBlockRelatedArticle)
+}
+
+func (pageBlockRelatedArticle *PageBlockRelatedArticle) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockRelatedArticle == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockRelatedArticle.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockRelatedArticle.tdCommon.Extra)
+	b = append(b, `,"url":`...)
+	b = appendJSONString(b, pageBlockRelatedArticle.Url)
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, pageBlockRelatedArticle.Title)
+	b = append(b, `,"description":`...)
+	b = appendJSONString(b, pageBlockRelatedArticle.Description)
+	b = append(b, `,"photo":`...)
+	b, err = pageBlockRelatedArticle.Photo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"author":`...)
+	b = appendJSONString(b, pageBlockRelatedArticle.Author)
+	b = append(b, `,"publish_date":`...)
+	b = appendJSONInt(b, int64(pageBlockRelatedArticle.PublishDate))
+	return append(b, '}'), nil
+}
+
+func (pageBlockRelatedArticle *PageBlockRelatedArticle) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockRelatedArticle.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockRelatedArticle.tdCommon.Extra, err = r.readString()
+		case "url":
+			pageBlockRelatedArticle.Url, err = r.readString()
+		case "title":
+			pageBlockRelatedArticle.Title, err = r.readString()
+		case "description":
+			pageBlockRelatedArticle.Description, err = r.readString()
+		case "photo":
+			if r.readNull() {
+				pageBlockRelatedArticle.Photo = nil
+			} else {
+				pageBlockRelatedArticle.Photo = new(Photo)
+				err = pageBlockRelatedArticle.Photo.decodeJSON(r)
+			}
+		case "author":
+			pageBlockRelatedArticle.Author, err = r.readString()
+		case "publish_date":
+			pageBlockRelatedArticle.PublishDate, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockRelatedArticle *PageBlockRelatedArticle) Clone() *PageBlockRelatedArticle {
+	if pageBlockRelatedArticle == nil {
+		return nil
+	}
+	clone := *pageBlockRelatedArticle
+	clone.Photo = pageBlockRelatedArticle.Photo.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockRelatedArticle *PageBlockRelatedArticle) Equal(other *PageBlockRelatedArticle) bool {
+	if pageBlockRelatedArticle == nil || other == nil {
+		return pageBlockRelatedArticle == other
+	}
+	if pageBlockRelatedArticle.Url != other.Url {
+		return false
+	}
+	if pageBlockRelatedArticle.Title != other.Title {
+		return false
+	}
+	if pageBlockRelatedArticle.Description != other.Description {
+		return false
+	}
+	if !pageBlockRelatedArticle.Photo.Equal(other.Photo) {
+		return false
+	}
+	if pageBlockRelatedArticle.Author != other.Author {
+		return false
+	}
+	if pageBlockRelatedArticle.PublishDate != other.PublishDate {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockRelatedArticle *PageBlockRelatedArticle) Diff(other *PageBlockRelatedArticle) []Change {
+	return pageBlockRelatedArticle.diff("", other, nil)
+}
+
+func (pageBlockRelatedArticle *PageBlockRelatedArticle) diff(path string, other *PageBlockRelatedArticle, changes []Change) []Change {
+	if pageBlockRelatedArticle == nil || other == nil {
+		if pageBlockRelatedArticle != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockRelatedArticle, New: other})
+		}
+		return changes
+	}
+	if pageBlockRelatedArticle.Url != other.Url {
+		changes = append(changes, Change{Path: fieldPath(path, "url"), Old: pageBlockRelatedArticle.Url, New: other.Url})
+	}
+	if pageBlockRelatedArticle.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: pageBlockRelatedArticle.Title, New: other.Title})
+	}
+	if pageBlockRelatedArticle.Description != other.Description {
+		changes = append(changes, Change{Path: fieldPath(path, "description"), Old: pageBlockRelatedArticle.Description, New: other.Description})
+	}
+	changes = pageBlockRelatedArticle.Photo.diff(fieldPath(path, "photo"), other.Photo, changes)
+	if pageBlockRelatedArticle.Author != other.Author {
+		changes = append(changes, Change{Path: fieldPath(path, "author"), Old: pageBlockRelatedArticle.Author, New: other.Author})
+	}
+	if pageBlockRelatedArticle.PublishDate != other.PublishDate {
+		changes = append(changes, Change{Path: fieldPath(path, "publish_date"), Old: pageBlockRelatedArticle.PublishDate, New: other.PublishDate})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockRelatedArticle *PageBlockRelatedArticle) String() string {
+	return objectString(pageBlockRelatedArticle)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockRelatedArticle *PageBlockRelatedArticle) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockRelatedArticle))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockRelatedArticle *PageBlockRelatedArticle) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockRelatedArticle)
+}
+
+func (pageBlockRelatedArticle *PageBlockRelatedArticle) printText(p *printer) {
+	if pageBlockRelatedArticle == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockRelatedArticle")
+	p.string("url", pageBlockRelatedArticle.Url)
+	p.string("title", pageBlockRelatedArticle.Title)
+	p.string("description", pageBlockRelatedArticle.Description)
+	p.object("photo", pageBlockRelatedArticle.Photo)
+	p.string("author", pageBlockRelatedArticle.Author)
+	p.int("publish_date", int64(pageBlockRelatedArticle.PublishDate))
+	p.endObject(state)
+}
@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // PageBlockTableCell Represents a cell of a table
@@ -42,38 +43,166 @@ func NewPageBlockTableCell(text RichText, isHeader bool, colspan int32, rowspan
 	return &pageBlockTableCellTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockTableCell *PageBlockTableCell) MarshalJSON() ([]byte, error) {
+	return pageBlockTableCell.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (pageBlockTableCell *PageBlockTableCell) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, pageBlockTableCell)
+}
+
+func (pageBlockTableCell *PageBlockTableCell) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockTableCell == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockTableCell.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockTableCell.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b, err = appendJSONValue(b, pageBlockTableCell.Text)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-		IsHeader bool  `json:"is_header"` // True, if it is a header cell
-		Colspan  int32 `json:"colspan"`   // The number of columns the cell spans
-		Rowspan  int32 `json:"rowspan"`   // The number of rows the cell spans
-
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	b = append(b, `,"is_header":`...)
+	b = appendJSONBool(b, pageBlockTableCell.IsHeader)
+	b = append(b, `,"colspan":`...)
+	b = appendJSONInt(b, int64(pageBlockTableCell.Colspan))
+	b = append(b, `,"rowspan":`...)
+	b = appendJSONInt(b, int64(pageBlockTableCell.Rowspan))
+	b = append(b, `,"align":`...)
+	b, err = appendJSONValue(b, pageBlockTableCell.Align)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"valign":`...)
+	b, err = appendJSONValue(b, pageBlockTableCell.Valign)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (pageBlockTableCell *PageBlockTableCell) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockTableCell.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockTableCell.tdCommon.Extra, err = r.readString()
+		case "text":
+			pageBlockTableCell.Text, err = decodeRichText(r)
+		case "is_header":
+			pageBlockTableCell.IsHeader, err = r.readBool()
+		case "colspan":
+			pageBlockTableCell.Colspan, err = r.readInt32()
+		case "rowspan":
+			pageBlockTableCell.Rowspan, err = r.readInt32()
+		case "align":
+			pageBlockTableCell.Align, err = decodePageBlockHorizontalAlignment(r)
+		case "valign":
+			pageBlockTableCell.Valign, err = decodePageBlockVerticalAlignment(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockTableCell *PageBlockTableCell) Clone() *PageBlockTableCell {
+	if pageBlockTableCell == nil {
+		return nil
+	}
+	clone := *pageBlockTableCell
+	clone.Text = cloneRichText(pageBlockTableCell.Text)
+	clone.Align = clonePageBlockHorizontalAlignment(pageBlockTableCell.Align)
+	clone.Valign = clonePageBlockVerticalAlignment(pageBlockTableCell.Valign)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockTableCell *PageBlockTableCell) Equal(other *PageBlockTableCell) bool {
+	if pageBlockTableCell == nil || other == nil {
+		return pageBlockTableCell == other
+	}
+	if !equalRichText(pageBlockTableCell.Text, other.Text) {
+		return false
 	}
+	if pageBlockTableCell.IsHeader != other.IsHeader {
+		return false
+	}
+	if pageBlockTableCell.Colspan != other.Colspan {
+		return false
+	}
+	if pageBlockTableCell.Rowspan != other.Rowspan {
+		return false
+	}
+	if !equalPageBlockHorizontalAlignment(pageBlockTableCell.Align, other.Align) {
+		return false
+	}
+	if !equalPageBlockVerticalAlignment(pageBlockTableCell.Valign, other.Valign) {
+		return false
+	}
+	return true
+}
 
-	pageBlockTableCell.tdCommon = tempObj.tdCommon
-	pageBlockTableCell.IsHeader = tempObj.IsHeader
-	pageBlockTableCell.Colspan = tempObj.Colspan
-	pageBlockTableCell.Rowspan = tempObj.Rowspan
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockTableCell *PageBlockTableCell) Diff(other *PageBlockTableCell) []Change {
+	return pageBlockTableCell.diff("", other, nil)
+}
 
-	fieldText, _ := unmarshalRichText(objMap["text"])
-	pageBlockTableCell.Text = fieldText
+func (pageBlockTableCell *PageBlockTableCell) diff(path string, other *PageBlockTableCell, changes []Change) []Change {
+	if pageBlockTableCell == nil || other == nil {
+		if pageBlockTableCell != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockTableCell, New: other})
+		}
+		return changes
+	}
+	changes = diffRichText(fieldPath(path, "text"), pageBlockTableCell.Text, other.Text, changes)
+	if pageBlockTableCell.IsHeader != other.IsHeader {
+		changes = append(changes, Change{Path: fieldPath(path, "is_header"), Old: pageBlockTableCell.IsHeader, New: other.IsHeader})
+	}
+	if pageBlockTableCell.Colspan != other.Colspan {
+		changes = append(changes, Change{Path: fieldPath(path, "colspan"), Old: pageBlockTableCell.Colspan, New: other.Colspan})
+	}
+	if pageBlockTableCell.Rowspan != other.Rowspan {
+		changes = append(changes, Change{Path: fieldPath(path, "rowspan"), Old: pageBlockTableCell.Rowspan, New: other.Rowspan})
+	}
+	changes = diffPageBlockHorizontalAlignment(fieldPath(path, "align"), pageBlockTableCell.Align, other.Align, changes)
+	changes = diffPageBlockVerticalAlignment(fieldPath(path, "valign"), pageBlockTableCell.Valign, other.Valign, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockTableCell *PageBlockTableCell) String() string {
+	return objectString(pageBlockTableCell)
+}
 
-	fieldAlign, _ := unmarshalPageBlockHorizontalAlignment(objMap["align"])
-	pageBlockTableCell.Align = fieldAlign
+// LogValue logs the object as its summary
+func (pageBlockTableCell *PageBlockTableCell) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockTableCell))
+}
 
-	fieldValign, _ := unmarshalPageBlockVerticalAlignment(objMap["valign"])
-	pageBlockTableCell.Valign = fieldValign
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockTableCell *PageBlockTableCell) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockTableCell)
+}
 
-	return nil
+func (pageBlockTableCell *PageBlockTableCell) printText(p *printer) {
+	if pageBlockTableCell == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockTableCell")
+	p.object("text", pageBlockTableCell.Text)
+	p.bool("is_header", pageBlockTableCell.IsHeader)
+	p.int("colspan", int64(pageBlockTableCell.Colspan))
+	p.int("rowspan", int64(pageBlockTableCell.Rowspan))
+	p.object("align", pageBlockTableCell.Align)
+	p.object("valign", pageBlockTableCell.Valign)
+	p.endObject(state)
 }
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // PageBlockVerticalAlignment Describes a Vertical alignment of a table cell content
 type PageBlockVerticalAlignment interface {
 	GetPageBlockVerticalAlignmentEnum() PageBlockVerticalAlignmentEnum
+	Accept(visitor PageBlockVerticalAlignmentVisitor)
 }
 
 // PageBlockVerticalAlignmentEnum Alias for abstract PageBlockVerticalAlignment 'Sub-Classes', used as constant-enum here
@@ -20,38 +22,89 @@ const (
 	PageBlockVerticalAlignmentBottomType PageBlockVerticalAlignmentEnum = "pageBlockVerticalAlignmentBottom"
 )
 
+// UnknownPageBlockVerticalAlignment is a PageBlockVerticalAlignment of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownPageBlockVerticalAlignment struct {
+	Unknown
+}
+
+// GetPageBlockVerticalAlignmentEnum return the enum type of this object
+func (unknownPageBlockVerticalAlignment *UnknownPageBlockVerticalAlignment) GetPageBlockVerticalAlignmentEnum() PageBlockVerticalAlignmentEnum {
+	return PageBlockVerticalAlignmentEnum(unknownPageBlockVerticalAlignment.Type)
+}
+
+// Accept calls the method of the visitor for UnknownPageBlockVerticalAlignment
+func (unknownPageBlockVerticalAlignment *UnknownPageBlockVerticalAlignment) Accept(visitor PageBlockVerticalAlignmentVisitor) {
+	visitor.VisitUnknownPageBlockVerticalAlignment(unknownPageBlockVerticalAlignment)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownPageBlockVerticalAlignment *UnknownPageBlockVerticalAlignment) String() string {
+	return objectString(unknownPageBlockVerticalAlignment)
+}
+
+// LogValue logs the object as its summary
+func (unknownPageBlockVerticalAlignment *UnknownPageBlockVerticalAlignment) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownPageBlockVerticalAlignment))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownPageBlockVerticalAlignment *UnknownPageBlockVerticalAlignment) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownPageBlockVerticalAlignment)
+}
+
+func (unknownPageBlockVerticalAlignment *UnknownPageBlockVerticalAlignment) printText(p *printer) {
+	if unknownPageBlockVerticalAlignment == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownPageBlockVerticalAlignment")
+	p.string("@type", unknownPageBlockVerticalAlignment.Type)
+	p.endObject(state)
+}
+
 func unmarshalPageBlockVerticalAlignment(rawMsg *json.RawMessage) (PageBlockVerticalAlignment, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch PageBlockVerticalAlignmentEnum(objMap["@type"].(string)) {
+	switch PageBlockVerticalAlignmentEnum(typeName) {
 	case PageBlockVerticalAlignmentTopType:
 		var pageBlockVerticalAlignmentTop PageBlockVerticalAlignmentTop
-		err := json.Unmarshal(*rawMsg, &pageBlockVerticalAlignmentTop)
+		err := jsonUnmarshal(*rawMsg, &pageBlockVerticalAlignmentTop)
 		return &pageBlockVerticalAlignmentTop, err
 
 	case PageBlockVerticalAlignmentMiddleType:
 		var pageBlockVerticalAlignmentMiddle PageBlockVerticalAlignmentMiddle
-		err := json.Unmarshal(*rawMsg, &pageBlockVerticalAlignmentMiddle)
+		err := jsonUnmarshal(*rawMsg, &pageBlockVerticalAlignmentMiddle)
 		return &pageBlockVerticalAlignmentMiddle, err
 
 	case PageBlockVerticalAlignmentBottomType:
 		var pageBlockVerticalAlignmentBottom PageBlockVerticalAlignmentBottom
-		err := json.Unmarshal(*rawMsg, &pageBlockVerticalAlignmentBottom)
+		err := jsonUnmarshal(*rawMsg, &pageBlockVerticalAlignmentBottom)
 		return &pageBlockVerticalAlignmentBottom, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownPageBlockVerticalAlignment{Unknown: unknown}, nil
 	}
 }
 
+func decodePageBlockVerticalAlignment(r *jsonReader) (PageBlockVerticalAlignment, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalPageBlockVerticalAlignment(rawMsg)
+}
+
 // PageBlockVerticalAlignmentTop The content must be top-aligned
 type PageBlockVerticalAlignmentTop struct {
 	tdCommon
@@ -63,7 +116,6 @@ func (pageBlockVerticalAlignmentTop *PageBlockVerticalAlignmentTop) MessageType(
 }
 
 // NewPageBlockVerticalAlignmentTop creates a new PageBlockVerticalAlignmentTop
-//
 func NewPageBlockVerticalAlignmentTop() *PageBlockVerticalAlignmentTop {
 	pageBlockVerticalAlignmentTopTemp := PageBlockVerticalAlignmentTop{
 		tdCommon: tdCommon{Type: "pageBlockVerticalAlignmentTop"},
@@ -72,11 +124,107 @@ func NewPageBlockVerticalAlignmentTop() *PageBlockVerticalAlignmentTop {
 	return &pageBlockVerticalAlignmentTopTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockVerticalAlignmentTop *PageBlockVerticalAlignmentTop) MarshalJSON() ([]byte, error) {
+	return pageBlockVerticalAlignmentTop.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pageBlockVerticalAlignmentTop *PageBlockVerticalAlignmentTop) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pageBlockVerticalAlignmentTop)
+}
+
+func (pageBlockVerticalAlignmentTop *PageBlockVerticalAlignmentTop) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockVerticalAlignmentTop == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockVerticalAlignmentTop.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockVerticalAlignmentTop.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (pageBlockVerticalAlignmentTop *PageBlockVerticalAlignmentTop) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockVerticalAlignmentTop.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockVerticalAlignmentTop.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockVerticalAlignmentTop *PageBlockVerticalAlignmentTop) Clone() *PageBlockVerticalAlignmentTop {
+	if pageBlockVerticalAlignmentTop == nil {
+		return nil
+	}
+	clone := *pageBlockVerticalAlignmentTop
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockVerticalAlignmentTop *PageBlockVerticalAlignmentTop) Equal(other *PageBlockVerticalAlignmentTop) bool {
+	if pageBlockVerticalAlignmentTop == nil || other == nil {
+		return pageBlockVerticalAlignmentTop == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockVerticalAlignmentTop *PageBlockVerticalAlignmentTop) Diff(other *PageBlockVerticalAlignmentTop) []Change {
+	return pageBlockVerticalAlignmentTop.diff("", other, nil)
+}
+
+func (pageBlockVerticalAlignmentTop *PageBlockVerticalAlignmentTop) diff(path string, other *PageBlockVerticalAlignmentTop, changes []Change) []Change {
+	if pageBlockVerticalAlignmentTop == nil || other == nil {
+		if pageBlockVerticalAlignmentTop != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockVerticalAlignmentTop, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockVerticalAlignmentTop *PageBlockVerticalAlignmentTop) String() string {
+	return objectString(pageBlockVerticalAlignmentTop)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockVerticalAlignmentTop *PageBlockVerticalAlignmentTop) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockVerticalAlignmentTop))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockVerticalAlignmentTop *PageBlockVerticalAlignmentTop) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockVerticalAlignmentTop)
+}
+
+func (pageBlockVerticalAlignmentTop *PageBlockVerticalAlignmentTop) printText(p *printer) {
+	if pageBlockVerticalAlignmentTop == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockVerticalAlignmentTop")
+	p.endObject(state)
+}
+
 // GetPageBlockVerticalAlignmentEnum return the enum type of this object
 func (pageBlockVerticalAlignmentTop *PageBlockVerticalAlignmentTop) GetPageBlockVerticalAlignmentEnum() PageBlockVerticalAlignmentEnum {
 	return PageBlockVerticalAlignmentTopType
 }
 
+// Accept calls the method of the visitor for PageBlockVerticalAlignmentTop
+func (pageBlockVerticalAlignmentTop *PageBlockVerticalAlignmentTop) Accept(visitor PageBlockVerticalAlignmentVisitor) {
+	visitor.VisitPageBlockVerticalAlignmentTop(pageBlockVerticalAlignmentTop)
+}
+
 // PageBlockVerticalAlignmentMiddle The content must be middle-aligned
 type PageBlockVerticalAlignmentMiddle struct {
 	tdCommon
@@ -88,7 +236,6 @@ func (pageBlockVerticalAlignmentMiddle *PageBlockVerticalAlignmentMiddle) Messag
 }
 
 // NewPageBlockVerticalAlignmentMiddle creates a new PageBlockVerticalAlignmentMiddle
-//
 func NewPageBlockVerticalAlignmentMiddle() *PageBlockVerticalAlignmentMiddle {
 	pageBlockVerticalAlignmentMiddleTemp := PageBlockVerticalAlignmentMiddle{
 		tdCommon: tdCommon{Type: "pageBlockVerticalAlignmentMiddle"},
@@ -97,11 +244,107 @@ func NewPageBlockVerticalAlignmentMiddle() *PageBlockVerticalAlignmentMiddle {
 	return &pageBlockVerticalAlignmentMiddleTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockVerticalAlignmentMiddle *PageBlockVerticalAlignmentMiddle) MarshalJSON() ([]byte, error) {
+	return pageBlockVerticalAlignmentMiddle.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pageBlockVerticalAlignmentMiddle *PageBlockVerticalAlignmentMiddle) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pageBlockVerticalAlignmentMiddle)
+}
+
+func (pageBlockVerticalAlignmentMiddle *PageBlockVerticalAlignmentMiddle) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockVerticalAlignmentMiddle == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockVerticalAlignmentMiddle.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockVerticalAlignmentMiddle.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (pageBlockVerticalAlignmentMiddle *PageBlockVerticalAlignmentMiddle) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockVerticalAlignmentMiddle.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockVerticalAlignmentMiddle.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockVerticalAlignmentMiddle *PageBlockVerticalAlignmentMiddle) Clone() *PageBlockVerticalAlignmentMiddle {
+	if pageBlockVerticalAlignmentMiddle == nil {
+		return nil
+	}
+	clone := *pageBlockVerticalAlignmentMiddle
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockVerticalAlignmentMiddle *PageBlockVerticalAlignmentMiddle) Equal(other *PageBlockVerticalAlignmentMiddle) bool {
+	if pageBlockVerticalAlignmentMiddle == nil || other == nil {
+		return pageBlockVerticalAlignmentMiddle == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockVerticalAlignmentMiddle *PageBlockVerticalAlignmentMiddle) Diff(other *PageBlockVerticalAlignmentMiddle) []Change {
+	return pageBlockVerticalAlignmentMiddle.diff("", other, nil)
+}
+
+func (pageBlockVerticalAlignmentMiddle *PageBlockVerticalAlignmentMiddle) diff(path string, other *PageBlockVerticalAlignmentMiddle, changes []Change) []Change {
+	if pageBlockVerticalAlignmentMiddle == nil || other == nil {
+		if pageBlockVerticalAlignmentMiddle != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockVerticalAlignmentMiddle, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockVerticalAlignmentMiddle *PageBlockVerticalAlignmentMiddle) String() string {
+	return objectString(pageBlockVerticalAlignmentMiddle)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockVerticalAlignmentMiddle *PageBlockVerticalAlignmentMiddle) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockVerticalAlignmentMiddle))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockVerticalAlignmentMiddle *PageBlockVerticalAlignmentMiddle) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockVerticalAlignmentMiddle)
+}
+
+func (pageBlockVerticalAlignmentMiddle *PageBlockVerticalAlignmentMiddle) printText(p *printer) {
+	if pageBlockVerticalAlignmentMiddle == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockVerticalAlignmentMiddle")
+	p.endObject(state)
+}
+
 // GetPageBlockVerticalAlignmentEnum return the enum type of this object
 func (pageBlockVerticalAlignmentMiddle *PageBlockVerticalAlignmentMiddle) GetPageBlockVerticalAlignmentEnum() PageBlockVerticalAlignmentEnum {
 	return PageBlockVerticalAlignmentMiddleType
 }
 
+// Accept calls the method of the visitor for PageBlockVerticalAlignmentMiddle
+func (pageBlockVerticalAlignmentMiddle *PageBlockVerticalAlignmentMiddle) Accept(visitor PageBlockVerticalAlignmentVisitor) {
+	visitor.VisitPageBlockVerticalAlignmentMiddle(pageBlockVerticalAlignmentMiddle)
+}
+
 // PageBlockVerticalAlignmentBottom The content must be bottom-aligned
 type PageBlockVerticalAlignmentBottom struct {
 	tdCommon
@@ -113,7 +356,6 @@ func (pageBlockVerticalAlignmentBottom *PageBlockVerticalAlignmentBottom) Messag
 }
 
 // NewPageBlockVerticalAlignmentBottom creates a new PageBlockVerticalAlignmentBottom
-//
 func NewPageBlockVerticalAlignmentBottom() *PageBlockVerticalAlignmentBottom {
 	pageBlockVerticalAlignmentBottomTemp := PageBlockVerticalAlignmentBottom{
 		tdCommon: tdCommon{Type: "pageBlockVerticalAlignmentBottom"},
@@ -122,7 +364,213 @@ func NewPageBlockVerticalAlignmentBottom() *PageBlockVerticalAlignmentBottom {
 	return &pageBlockVerticalAlignmentBottomTemp
 }
 
+// MarshalJSON marshals to json
+func (pageBlockVerticalAlignmentBottom *PageBlockVerticalAlignmentBottom) MarshalJSON() ([]byte, error) {
+	return pageBlockVerticalAlignmentBottom.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pageBlockVerticalAlignmentBottom *PageBlockVerticalAlignmentBottom) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pageBlockVerticalAlignmentBottom)
+}
+
+func (pageBlockVerticalAlignmentBottom *PageBlockVerticalAlignmentBottom) appendJSON(b []byte) ([]byte, error) {
+	if pageBlockVerticalAlignmentBottom == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pageBlockVerticalAlignmentBottom.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pageBlockVerticalAlignmentBottom.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (pageBlockVerticalAlignmentBottom *PageBlockVerticalAlignmentBottom) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pageBlockVerticalAlignmentBottom.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pageBlockVerticalAlignmentBottom.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pageBlockVerticalAlignmentBottom *PageBlockVerticalAlignmentBottom) Clone() *PageBlockVerticalAlignmentBottom {
+	if pageBlockVerticalAlignmentBottom == nil {
+		return nil
+	}
+	clone := *pageBlockVerticalAlignmentBottom
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pageBlockVerticalAlignmentBottom *PageBlockVerticalAlignmentBottom) Equal(other *PageBlockVerticalAlignmentBottom) bool {
+	if pageBlockVerticalAlignmentBottom == nil || other == nil {
+		return pageBlockVerticalAlignmentBottom == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pageBlockVerticalAlignmentBottom *PageBlockVerticalAlignmentBottom) Diff(other *PageBlockVerticalAlignmentBottom) []Change {
+	return pageBlockVerticalAlignmentBottom.diff("", other, nil)
+}
+
+func (pageBlockVerticalAlignmentBottom *PageBlockVerticalAlignmentBottom) diff(path string, other *PageBlockVerticalAlignmentBottom, changes []Change) []Change {
+	if pageBlockVerticalAlignmentBottom == nil || other == nil {
+		if pageBlockVerticalAlignmentBottom != other {
+			changes = append(changes, Change{Path: path, Old: pageBlockVerticalAlignmentBottom, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pageBlockVerticalAlignmentBottom *PageBlockVerticalAlignmentBottom) String() string {
+	return objectString(pageBlockVerticalAlignmentBottom)
+}
+
+// LogValue logs the object as its summary
+func (pageBlockVerticalAlignmentBottom *PageBlockVerticalAlignmentBottom) LogValue() slog.Value {
+	return slog.StringValue(objectString(pageBlockVerticalAlignmentBottom))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pageBlockVerticalAlignmentBottom *PageBlockVerticalAlignmentBottom) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pageBlockVerticalAlignmentBottom)
+}
+
+func (pageBlockVerticalAlignmentBottom *PageBlockVerticalAlignmentBottom) printText(p *printer) {
+	if pageBlockVerticalAlignmentBottom == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PageBlockVerticalAlignmentBottom")
+	p.endObject(state)
+}
+
 // GetPageBlockVerticalAlignmentEnum return the enum type of this object
 func (pageBlockVerticalAlignmentBottom *PageBlockVerticalAlignmentBottom) GetPageBlockVerticalAlignmentEnum() PageBlockVerticalAlignmentEnum {
 	return PageBlockVerticalAlignmentBottomType
 }
+
+// Accept calls the method of the visitor for PageBlockVerticalAlignmentBottom
+func (pageBlockVerticalAlignmentBottom *PageBlockVerticalAlignmentBottom) Accept(visitor PageBlockVerticalAlignmentVisitor) {
+	visitor.VisitPageBlockVerticalAlignmentBottom(pageBlockVerticalAlignmentBottom)
+}
+
+// PageBlockVerticalAlignmentVisitor visits every type of PageBlockVerticalAlignment, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to PageBlockVerticalAlignment, until they handle it too.
+type PageBlockVerticalAlignmentVisitor interface {
+	VisitPageBlockVerticalAlignmentTop(pageBlockVerticalAlignmentTop *PageBlockVerticalAlignmentTop)
+	VisitPageBlockVerticalAlignmentMiddle(pageBlockVerticalAlignmentMiddle *PageBlockVerticalAlignmentMiddle)
+	VisitPageBlockVerticalAlignmentBottom(pageBlockVerticalAlignmentBottom *PageBlockVerticalAlignmentBottom)
+	VisitUnknownPageBlockVerticalAlignment(unknownPageBlockVerticalAlignment *UnknownPageBlockVerticalAlignment)
+}
+
+// PageBlockVerticalAlignmentCases holds the function MatchPageBlockVerticalAlignment calls for each type of PageBlockVerticalAlignment;
+// types without a function are passed to the default case
+type PageBlockVerticalAlignmentCases[R any] struct {
+	PageBlockVerticalAlignmentTop    func(pageBlockVerticalAlignmentTop *PageBlockVerticalAlignmentTop) R
+	PageBlockVerticalAlignmentMiddle func(pageBlockVerticalAlignmentMiddle *PageBlockVerticalAlignmentMiddle) R
+	PageBlockVerticalAlignmentBottom func(pageBlockVerticalAlignmentBottom *PageBlockVerticalAlignmentBottom) R
+}
+
+// MatchPageBlockVerticalAlignment calls the case of the type of pageBlockVerticalAlignment, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement PageBlockVerticalAlignmentVisitor
+// to have the compiler check every type is handled.
+func MatchPageBlockVerticalAlignment[R any](pageBlockVerticalAlignment PageBlockVerticalAlignment, cases PageBlockVerticalAlignmentCases[R], defaultCase func(pageBlockVerticalAlignment PageBlockVerticalAlignment) R) R {
+	switch value := pageBlockVerticalAlignment.(type) {
+	case *PageBlockVerticalAlignmentTop:
+		if cases.PageBlockVerticalAlignmentTop != nil {
+			return cases.PageBlockVerticalAlignmentTop(value)
+		}
+	case *PageBlockVerticalAlignmentMiddle:
+		if cases.PageBlockVerticalAlignmentMiddle != nil {
+			return cases.PageBlockVerticalAlignmentMiddle(value)
+		}
+	case *PageBlockVerticalAlignmentBottom:
+		if cases.PageBlockVerticalAlignmentBottom != nil {
+			return cases.PageBlockVerticalAlignmentBottom(value)
+		}
+	}
+	return defaultCase(pageBlockVerticalAlignment)
+}
+
+// Clone returns a deep copy of the object
+func (unknownPageBlockVerticalAlignment *UnknownPageBlockVerticalAlignment) Clone() *UnknownPageBlockVerticalAlignment {
+	if unknownPageBlockVerticalAlignment == nil {
+		return nil
+	}
+	return &UnknownPageBlockVerticalAlignment{Unknown: unknownPageBlockVerticalAlignment.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownPageBlockVerticalAlignment *UnknownPageBlockVerticalAlignment) Equal(other *UnknownPageBlockVerticalAlignment) bool {
+	if unknownPageBlockVerticalAlignment == nil || other == nil {
+		return unknownPageBlockVerticalAlignment == other
+	}
+	return unknownPageBlockVerticalAlignment.Unknown.equal(other.Unknown)
+}
+
+func clonePageBlockVerticalAlignment(pageBlockVerticalAlignment PageBlockVerticalAlignment) PageBlockVerticalAlignment {
+	switch value := pageBlockVerticalAlignment.(type) {
+	case *PageBlockVerticalAlignmentTop:
+		return value.Clone()
+	case *PageBlockVerticalAlignmentMiddle:
+		return value.Clone()
+	case *PageBlockVerticalAlignmentBottom:
+		return value.Clone()
+	case *UnknownPageBlockVerticalAlignment:
+		return value.Clone()
+	}
+	return pageBlockVerticalAlignment
+}
+
+func equalPageBlockVerticalAlignment(a PageBlockVerticalAlignment, b PageBlockVerticalAlignment) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *PageBlockVerticalAlignmentTop:
+		b, ok := b.(*PageBlockVerticalAlignmentTop)
+		return ok && a.Equal(b)
+	case *PageBlockVerticalAlignmentMiddle:
+		b, ok := b.(*PageBlockVerticalAlignmentMiddle)
+		return ok && a.Equal(b)
+	case *PageBlockVerticalAlignmentBottom:
+		b, ok := b.(*PageBlockVerticalAlignmentBottom)
+		return ok && a.Equal(b)
+	case *UnknownPageBlockVerticalAlignment:
+		b, ok := b.(*UnknownPageBlockVerticalAlignment)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffPageBlockVerticalAlignment(path string, a PageBlockVerticalAlignment, b PageBlockVerticalAlignment, changes []Change) []Change {
+	switch a := a.(type) {
+	case *PageBlockVerticalAlignmentTop:
+		if b, ok := b.(*PageBlockVerticalAlignmentTop); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockVerticalAlignmentMiddle:
+		if b, ok := b.(*PageBlockVerticalAlignmentMiddle); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PageBlockVerticalAlignmentBottom:
+		if b, ok := b.(*PageBlockVerticalAlignmentBottom); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalPageBlockVerticalAlignment(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
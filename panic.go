@@ -0,0 +1,39 @@
+package tdlib
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// PanicError is a panic recovered while dispatching an update, e.g. in an EventFilterFunc
+type PanicError struct {
+	Type  string      // @type of the update being dispatched, if known
+	Value interface{} // The value passed to panic
+	Stack []byte      // Stack trace of the panicking goroutine
+}
+
+func (err *PanicError) Error() string {
+	return fmt.Sprintf("panic while dispatching %s: %v", err.Type, err.Value)
+}
+
+// panicHandlerHolder wraps a panic handler so it can be stored in an atomic.Value
+type panicHandlerHolder struct {
+	handler func(err *PanicError)
+}
+
+// SetPanicHandler sets the function called with panics recovered in the receive loop,
+// e.g. in an EventFilterFunc, in addition to logging them as errors.
+// The receiver whose filter panicked doesn't get the update, and the receive loop goes on.
+func (client *Client) SetPanicHandler(handler func(err *PanicError)) {
+	client.panicHandler.Store(panicHandlerHolder{handler: handler})
+}
+
+// reportPanic reports a recovered panic to the logger and the panic handler
+func (client *Client) reportPanic(msgType string, value interface{}) {
+	err := &PanicError{Type: msgType, Value: value, Stack: debug.Stack()}
+	client.Logger().Error("recovered panic in the receive loop", "@type", msgType, "panic", value, "stack", string(err.Stack))
+
+	if holder, ok := client.panicHandler.Load().(panicHandlerHolder); ok && holder.handler != nil {
+		holder.handler(err)
+	}
+}
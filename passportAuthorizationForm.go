@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // PassportAuthorizationForm Contains information about a Telegram Passport authorization form that was requested
@@ -34,6 +34,172 @@ func NewPassportAuthorizationForm(id int32, requiredElements []PassportRequiredE
 	return &passportAuthorizationFormTemp
 }
 
+// MarshalJSON marshals to json
+func (passportAuthorizationForm *PassportAuthorizationForm) MarshalJSON() ([]byte, error) {
+	return passportAuthorizationForm.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportAuthorizationForm *PassportAuthorizationForm) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportAuthorizationForm)
+}
+
+func (passportAuthorizationForm *PassportAuthorizationForm) appendJSON(b []byte) ([]byte, error) {
+	if passportAuthorizationForm == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportAuthorizationForm.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportAuthorizationForm.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt(b, int64(passportAuthorizationForm.Id))
+	b = append(b, `,"required_elements":`...)
+	if passportAuthorizationForm.RequiredElements == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range passportAuthorizationForm.RequiredElements {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = passportAuthorizationForm.RequiredElements[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"privacy_policy_url":`...)
+	b = appendJSONString(b, passportAuthorizationForm.PrivacyPolicyUrl)
+	return append(b, '}'), nil
+}
+
+func (passportAuthorizationForm *PassportAuthorizationForm) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportAuthorizationForm.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportAuthorizationForm.tdCommon.Extra, err = r.readString()
+		case "id":
+			passportAuthorizationForm.Id, err = r.readInt32()
+		case "required_elements":
+			if r.readNull() {
+				passportAuthorizationForm.RequiredElements = nil
+			} else {
+				passportAuthorizationForm.RequiredElements = make([]PassportRequiredElement, 0)
+				err = r.readArray(func() (err error) {
+					var item0 PassportRequiredElement
+					err = item0.decodeJSON(r)
+					passportAuthorizationForm.RequiredElements = append(passportAuthorizationForm.RequiredElements, item0)
+					return
+				})
+			}
+		case "privacy_policy_url":
+			passportAuthorizationForm.PrivacyPolicyUrl, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportAuthorizationForm *PassportAuthorizationForm) Clone() *PassportAuthorizationForm {
+	if passportAuthorizationForm == nil {
+		return nil
+	}
+	clone := *passportAuthorizationForm
+	if passportAuthorizationForm.RequiredElements != nil {
+		clone.RequiredElements = make([]PassportRequiredElement, len(passportAuthorizationForm.RequiredElements))
+		for i0 := range passportAuthorizationForm.RequiredElements {
+			clone.RequiredElements[i0] = *passportAuthorizationForm.RequiredElements[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportAuthorizationForm *PassportAuthorizationForm) Equal(other *PassportAuthorizationForm) bool {
+	if passportAuthorizationForm == nil || other == nil {
+		return passportAuthorizationForm == other
+	}
+	if passportAuthorizationForm.Id != other.Id {
+		return false
+	}
+	if len(passportAuthorizationForm.RequiredElements) != len(other.RequiredElements) {
+		return false
+	}
+	for i0 := range passportAuthorizationForm.RequiredElements {
+		if !passportAuthorizationForm.RequiredElements[i0].Equal(&other.RequiredElements[i0]) {
+			return false
+		}
+	}
+	if passportAuthorizationForm.PrivacyPolicyUrl != other.PrivacyPolicyUrl {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportAuthorizationForm *PassportAuthorizationForm) Diff(other *PassportAuthorizationForm) []Change {
+	return passportAuthorizationForm.diff("", other, nil)
+}
+
+func (passportAuthorizationForm *PassportAuthorizationForm) diff(path string, other *PassportAuthorizationForm, changes []Change) []Change {
+	if passportAuthorizationForm == nil || other == nil {
+		if passportAuthorizationForm != other {
+			changes = append(changes, Change{Path: path, Old: passportAuthorizationForm, New: other})
+		}
+		return changes
+	}
+	if passportAuthorizationForm.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: passportAuthorizationForm.Id, New: other.Id})
+	}
+	if len(passportAuthorizationForm.RequiredElements) != len(other.RequiredElements) {
+		changes = append(changes, Change{Path: fieldPath(path, "required_elements"), Old: passportAuthorizationForm.RequiredElements, New: other.RequiredElements})
+	} else {
+		for i0 := range passportAuthorizationForm.RequiredElements {
+			changes = passportAuthorizationForm.RequiredElements[i0].diff(indexPath(fieldPath(path, "required_elements"), i0), &other.RequiredElements[i0], changes)
+		}
+	}
+	if passportAuthorizationForm.PrivacyPolicyUrl != other.PrivacyPolicyUrl {
+		changes = append(changes, Change{Path: fieldPath(path, "privacy_policy_url"), Old: passportAuthorizationForm.PrivacyPolicyUrl, New: other.PrivacyPolicyUrl})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportAuthorizationForm *PassportAuthorizationForm) String() string {
+	return objectString(passportAuthorizationForm)
+}
+
+// LogValue logs the object as its summary
+func (passportAuthorizationForm *PassportAuthorizationForm) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportAuthorizationForm))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportAuthorizationForm *PassportAuthorizationForm) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportAuthorizationForm)
+}
+
+func (passportAuthorizationForm *PassportAuthorizationForm) printText(p *printer) {
+	if passportAuthorizationForm == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportAuthorizationForm")
+	p.int("id", int64(passportAuthorizationForm.Id))
+	p.vector("required_elements", len(passportAuthorizationForm.RequiredElements), func(i0 int) {
+		passportAuthorizationForm.RequiredElements[i0].printText(p)
+	})
+	p.string("privacy_policy_url", passportAuthorizationForm.PrivacyPolicyUrl)
+	p.endObject(state)
+}
+
 // GetPassportAuthorizationForm Returns a Telegram Passport authorization form for sharing data with a service
 // @param botUserId User identifier of the service's bot
 // @param scope Telegram Passport element types requested by the service
@@ -52,11 +218,11 @@ func (client *Client) GetPassportAuthorizationForm(botUserId int64, scope string
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var passportAuthorizationForm PassportAuthorizationForm
-	err = json.Unmarshal(result.Raw, &passportAuthorizationForm)
+	err = jsonUnmarshal(result.Raw, &passportAuthorizationForm)
 	return &passportAuthorizationForm, err
 }
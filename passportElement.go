@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // PassportElement Contains information about a Telegram Passport element
 type PassportElement interface {
 	GetPassportElementEnum() PassportElementEnum
+	Accept(visitor PassportElementVisitor)
 }
 
 // PassportElementEnum Alias for abstract PassportElement 'Sub-Classes', used as constant-enum here
@@ -30,86 +32,160 @@ const (
 	PassportElementEmailAddressType          PassportElementEnum = "passportElementEmailAddress"
 )
 
+// UnknownPassportElement is a PassportElement of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownPassportElement struct {
+	Unknown
+}
+
+// GetPassportElementEnum return the enum type of this object
+func (unknownPassportElement *UnknownPassportElement) GetPassportElementEnum() PassportElementEnum {
+	return PassportElementEnum(unknownPassportElement.Type)
+}
+
+// Accept calls the method of the visitor for UnknownPassportElement
+func (unknownPassportElement *UnknownPassportElement) Accept(visitor PassportElementVisitor) {
+	visitor.VisitUnknownPassportElement(unknownPassportElement)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownPassportElement *UnknownPassportElement) String() string {
+	return objectString(unknownPassportElement)
+}
+
+// LogValue logs the object as its summary
+func (unknownPassportElement *UnknownPassportElement) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownPassportElement))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownPassportElement *UnknownPassportElement) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownPassportElement)
+}
+
+func (unknownPassportElement *UnknownPassportElement) printText(p *printer) {
+	if unknownPassportElement == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownPassportElement")
+	p.string("@type", unknownPassportElement.Type)
+	p.endObject(state)
+}
+
 func unmarshalPassportElement(rawMsg *json.RawMessage) (PassportElement, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch PassportElementEnum(objMap["@type"].(string)) {
+	switch PassportElementEnum(typeName) {
 	case PassportElementPersonalDetailsType:
 		var passportElementPersonalDetails PassportElementPersonalDetails
-		err := json.Unmarshal(*rawMsg, &passportElementPersonalDetails)
+		err := jsonUnmarshal(*rawMsg, &passportElementPersonalDetails)
 		return &passportElementPersonalDetails, err
 
 	case PassportElementPassportType:
 		var passportElementPassport PassportElementPassport
-		err := json.Unmarshal(*rawMsg, &passportElementPassport)
+		err := jsonUnmarshal(*rawMsg, &passportElementPassport)
 		return &passportElementPassport, err
 
 	case PassportElementDriverLicenseType:
 		var passportElementDriverLicense PassportElementDriverLicense
-		err := json.Unmarshal(*rawMsg, &passportElementDriverLicense)
+		err := jsonUnmarshal(*rawMsg, &passportElementDriverLicense)
 		return &passportElementDriverLicense, err
 
 	case PassportElementIdentityCardType:
 		var passportElementIdentityCard PassportElementIdentityCard
-		err := json.Unmarshal(*rawMsg, &passportElementIdentityCard)
+		err := jsonUnmarshal(*rawMsg, &passportElementIdentityCard)
 		return &passportElementIdentityCard, err
 
 	case PassportElementInternalPassportType:
 		var passportElementInternalPassport PassportElementInternalPassport
-		err := json.Unmarshal(*rawMsg, &passportElementInternalPassport)
+		err := jsonUnmarshal(*rawMsg, &passportElementInternalPassport)
 		return &passportElementInternalPassport, err
 
 	case PassportElementAddressType:
 		var passportElementAddress PassportElementAddress
-		err := json.Unmarshal(*rawMsg, &passportElementAddress)
+		err := jsonUnmarshal(*rawMsg, &passportElementAddress)
 		return &passportElementAddress, err
 
 	case PassportElementUtilityBillType:
 		var passportElementUtilityBill PassportElementUtilityBill
-		err := json.Unmarshal(*rawMsg, &passportElementUtilityBill)
+		err := jsonUnmarshal(*rawMsg, &passportElementUtilityBill)
 		return &passportElementUtilityBill, err
 
 	case PassportElementBankStatementType:
 		var passportElementBankStatement PassportElementBankStatement
-		err := json.Unmarshal(*rawMsg, &passportElementBankStatement)
+		err := jsonUnmarshal(*rawMsg, &passportElementBankStatement)
 		return &passportElementBankStatement, err
 
 	case PassportElementRentalAgreementType:
 		var passportElementRentalAgreement PassportElementRentalAgreement
-		err := json.Unmarshal(*rawMsg, &passportElementRentalAgreement)
+		err := jsonUnmarshal(*rawMsg, &passportElementRentalAgreement)
 		return &passportElementRentalAgreement, err
 
 	case PassportElementPassportRegistrationType:
 		var passportElementPassportRegistration PassportElementPassportRegistration
-		err := json.Unmarshal(*rawMsg, &passportElementPassportRegistration)
+		err := jsonUnmarshal(*rawMsg, &passportElementPassportRegistration)
 		return &passportElementPassportRegistration, err
 
 	case PassportElementTemporaryRegistrationType:
 		var passportElementTemporaryRegistration PassportElementTemporaryRegistration
-		err := json.Unmarshal(*rawMsg, &passportElementTemporaryRegistration)
+		err := jsonUnmarshal(*rawMsg, &passportElementTemporaryRegistration)
 		return &passportElementTemporaryRegistration, err
 
 	case PassportElementPhoneNumberType:
 		var passportElementPhoneNumber PassportElementPhoneNumber
-		err := json.Unmarshal(*rawMsg, &passportElementPhoneNumber)
+		err := jsonUnmarshal(*rawMsg, &passportElementPhoneNumber)
 		return &passportElementPhoneNumber, err
 
 	case PassportElementEmailAddressType:
 		var passportElementEmailAddress PassportElementEmailAddress
-		err := json.Unmarshal(*rawMsg, &passportElementEmailAddress)
+		err := jsonUnmarshal(*rawMsg, &passportElementEmailAddress)
 		return &passportElementEmailAddress, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownPassportElement{Unknown: unknown}, nil
+	}
+}
+
+func unmarshalPassportElementSlice(rawMsg *json.RawMessage) ([]PassportElement, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var rawItems []*json.RawMessage
+	err := jsonUnmarshal(*rawMsg, &rawItems)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]PassportElement, 0, len(rawItems))
+	for _, rawItem := range rawItems {
+		item, err := unmarshalPassportElement(rawItem)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func decodePassportElement(r *jsonReader) (PassportElement, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
 	}
+	return unmarshalPassportElement(rawMsg)
 }
 
 // PassportElementPersonalDetails A Telegram Passport element containing the user's personal details
@@ -135,11 +211,126 @@ func NewPassportElementPersonalDetails(personalDetails *PersonalDetails) *Passpo
 	return &passportElementPersonalDetailsTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementPersonalDetails *PassportElementPersonalDetails) MarshalJSON() ([]byte, error) {
+	return passportElementPersonalDetails.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementPersonalDetails *PassportElementPersonalDetails) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementPersonalDetails)
+}
+
+func (passportElementPersonalDetails *PassportElementPersonalDetails) appendJSON(b []byte) ([]byte, error) {
+	if passportElementPersonalDetails == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementPersonalDetails.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementPersonalDetails.tdCommon.Extra)
+	b = append(b, `,"personal_details":`...)
+	b, err = passportElementPersonalDetails.PersonalDetails.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (passportElementPersonalDetails *PassportElementPersonalDetails) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementPersonalDetails.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementPersonalDetails.tdCommon.Extra, err = r.readString()
+		case "personal_details":
+			if r.readNull() {
+				passportElementPersonalDetails.PersonalDetails = nil
+			} else {
+				passportElementPersonalDetails.PersonalDetails = new(PersonalDetails)
+				err = passportElementPersonalDetails.PersonalDetails.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementPersonalDetails *PassportElementPersonalDetails) Clone() *PassportElementPersonalDetails {
+	if passportElementPersonalDetails == nil {
+		return nil
+	}
+	clone := *passportElementPersonalDetails
+	clone.PersonalDetails = passportElementPersonalDetails.PersonalDetails.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementPersonalDetails *PassportElementPersonalDetails) Equal(other *PassportElementPersonalDetails) bool {
+	if passportElementPersonalDetails == nil || other == nil {
+		return passportElementPersonalDetails == other
+	}
+	if !passportElementPersonalDetails.PersonalDetails.Equal(other.PersonalDetails) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementPersonalDetails *PassportElementPersonalDetails) Diff(other *PassportElementPersonalDetails) []Change {
+	return passportElementPersonalDetails.diff("", other, nil)
+}
+
+func (passportElementPersonalDetails *PassportElementPersonalDetails) diff(path string, other *PassportElementPersonalDetails, changes []Change) []Change {
+	if passportElementPersonalDetails == nil || other == nil {
+		if passportElementPersonalDetails != other {
+			changes = append(changes, Change{Path: path, Old: passportElementPersonalDetails, New: other})
+		}
+		return changes
+	}
+	changes = passportElementPersonalDetails.PersonalDetails.diff(fieldPath(path, "personal_details"), other.PersonalDetails, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementPersonalDetails *PassportElementPersonalDetails) String() string {
+	return objectString(passportElementPersonalDetails)
+}
+
+// LogValue logs the object as its summary
+func (passportElementPersonalDetails *PassportElementPersonalDetails) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementPersonalDetails))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementPersonalDetails *PassportElementPersonalDetails) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementPersonalDetails)
+}
+
+func (passportElementPersonalDetails *PassportElementPersonalDetails) printText(p *printer) {
+	if passportElementPersonalDetails == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementPersonalDetails")
+	p.object("personal_details", passportElementPersonalDetails.PersonalDetails)
+	p.endObject(state)
+}
+
 // GetPassportElementEnum return the enum type of this object
 func (passportElementPersonalDetails *PassportElementPersonalDetails) GetPassportElementEnum() PassportElementEnum {
 	return PassportElementPersonalDetailsType
 }
 
+// Accept calls the method of the visitor for PassportElementPersonalDetails
+func (passportElementPersonalDetails *PassportElementPersonalDetails) Accept(visitor PassportElementVisitor) {
+	visitor.VisitPassportElementPersonalDetails(passportElementPersonalDetails)
+}
+
 // PassportElementPassport A Telegram Passport element containing the user's passport
 type PassportElementPassport struct {
 	tdCommon
@@ -163,11 +354,126 @@ func NewPassportElementPassport(passport *IdentityDocument) *PassportElementPass
 	return &passportElementPassportTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementPassport *PassportElementPassport) MarshalJSON() ([]byte, error) {
+	return passportElementPassport.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementPassport *PassportElementPassport) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementPassport)
+}
+
+func (passportElementPassport *PassportElementPassport) appendJSON(b []byte) ([]byte, error) {
+	if passportElementPassport == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementPassport.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementPassport.tdCommon.Extra)
+	b = append(b, `,"passport":`...)
+	b, err = passportElementPassport.Passport.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (passportElementPassport *PassportElementPassport) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementPassport.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementPassport.tdCommon.Extra, err = r.readString()
+		case "passport":
+			if r.readNull() {
+				passportElementPassport.Passport = nil
+			} else {
+				passportElementPassport.Passport = new(IdentityDocument)
+				err = passportElementPassport.Passport.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementPassport *PassportElementPassport) Clone() *PassportElementPassport {
+	if passportElementPassport == nil {
+		return nil
+	}
+	clone := *passportElementPassport
+	clone.Passport = passportElementPassport.Passport.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementPassport *PassportElementPassport) Equal(other *PassportElementPassport) bool {
+	if passportElementPassport == nil || other == nil {
+		return passportElementPassport == other
+	}
+	if !passportElementPassport.Passport.Equal(other.Passport) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementPassport *PassportElementPassport) Diff(other *PassportElementPassport) []Change {
+	return passportElementPassport.diff("", other, nil)
+}
+
+func (passportElementPassport *PassportElementPassport) diff(path string, other *PassportElementPassport, changes []Change) []Change {
+	if passportElementPassport == nil || other == nil {
+		if passportElementPassport != other {
+			changes = append(changes, Change{Path: path, Old: passportElementPassport, New: other})
+		}
+		return changes
+	}
+	changes = passportElementPassport.Passport.diff(fieldPath(path, "passport"), other.Passport, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementPassport *PassportElementPassport) String() string {
+	return objectString(passportElementPassport)
+}
+
+// LogValue logs the object as its summary
+func (passportElementPassport *PassportElementPassport) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementPassport))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementPassport *PassportElementPassport) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementPassport)
+}
+
+func (passportElementPassport *PassportElementPassport) printText(p *printer) {
+	if passportElementPassport == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementPassport")
+	p.object("passport", passportElementPassport.Passport)
+	p.endObject(state)
+}
+
 // GetPassportElementEnum return the enum type of this object
 func (passportElementPassport *PassportElementPassport) GetPassportElementEnum() PassportElementEnum {
 	return PassportElementPassportType
 }
 
+// Accept calls the method of the visitor for PassportElementPassport
+func (passportElementPassport *PassportElementPassport) Accept(visitor PassportElementVisitor) {
+	visitor.VisitPassportElementPassport(passportElementPassport)
+}
+
 // PassportElementDriverLicense A Telegram Passport element containing the user's driver license
 type PassportElementDriverLicense struct {
 	tdCommon
@@ -191,11 +497,126 @@ func NewPassportElementDriverLicense(driverLicense *IdentityDocument) *PassportE
 	return &passportElementDriverLicenseTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementDriverLicense *PassportElementDriverLicense) MarshalJSON() ([]byte, error) {
+	return passportElementDriverLicense.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementDriverLicense *PassportElementDriverLicense) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementDriverLicense)
+}
+
+func (passportElementDriverLicense *PassportElementDriverLicense) appendJSON(b []byte) ([]byte, error) {
+	if passportElementDriverLicense == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementDriverLicense.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementDriverLicense.tdCommon.Extra)
+	b = append(b, `,"driver_license":`...)
+	b, err = passportElementDriverLicense.DriverLicense.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (passportElementDriverLicense *PassportElementDriverLicense) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementDriverLicense.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementDriverLicense.tdCommon.Extra, err = r.readString()
+		case "driver_license":
+			if r.readNull() {
+				passportElementDriverLicense.DriverLicense = nil
+			} else {
+				passportElementDriverLicense.DriverLicense = new(IdentityDocument)
+				err = passportElementDriverLicense.DriverLicense.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementDriverLicense *PassportElementDriverLicense) Clone() *PassportElementDriverLicense {
+	if passportElementDriverLicense == nil {
+		return nil
+	}
+	clone := *passportElementDriverLicense
+	clone.DriverLicense = passportElementDriverLicense.DriverLicense.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementDriverLicense *PassportElementDriverLicense) Equal(other *PassportElementDriverLicense) bool {
+	if passportElementDriverLicense == nil || other == nil {
+		return passportElementDriverLicense == other
+	}
+	if !passportElementDriverLicense.DriverLicense.Equal(other.DriverLicense) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementDriverLicense *PassportElementDriverLicense) Diff(other *PassportElementDriverLicense) []Change {
+	return passportElementDriverLicense.diff("", other, nil)
+}
+
+func (passportElementDriverLicense *PassportElementDriverLicense) diff(path string, other *PassportElementDriverLicense, changes []Change) []Change {
+	if passportElementDriverLicense == nil || other == nil {
+		if passportElementDriverLicense != other {
+			changes = append(changes, Change{Path: path, Old: passportElementDriverLicense, New: other})
+		}
+		return changes
+	}
+	changes = passportElementDriverLicense.DriverLicense.diff(fieldPath(path, "driver_license"), other.DriverLicense, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementDriverLicense *PassportElementDriverLicense) String() string {
+	return objectString(passportElementDriverLicense)
+}
+
+// LogValue logs the object as its summary
+func (passportElementDriverLicense *PassportElementDriverLicense) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementDriverLicense))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementDriverLicense *PassportElementDriverLicense) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementDriverLicense)
+}
+
+func (passportElementDriverLicense *PassportElementDriverLicense) printText(p *printer) {
+	if passportElementDriverLicense == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementDriverLicense")
+	p.object("driver_license", passportElementDriverLicense.DriverLicense)
+	p.endObject(state)
+}
+
 // GetPassportElementEnum return the enum type of this object
 func (passportElementDriverLicense *PassportElementDriverLicense) GetPassportElementEnum() PassportElementEnum {
 	return PassportElementDriverLicenseType
 }
 
+// Accept calls the method of the visitor for PassportElementDriverLicense
+func (passportElementDriverLicense *PassportElementDriverLicense) Accept(visitor PassportElementVisitor) {
+	visitor.VisitPassportElementDriverLicense(passportElementDriverLicense)
+}
+
 // PassportElementIdentityCard A Telegram Passport element containing the user's identity card
 type PassportElementIdentityCard struct {
 	tdCommon
@@ -219,11 +640,126 @@ func NewPassportElementIdentityCard(identityCard *IdentityDocument) *PassportEle
 	return &passportElementIdentityCardTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementIdentityCard *PassportElementIdentityCard) MarshalJSON() ([]byte, error) {
+	return passportElementIdentityCard.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementIdentityCard *PassportElementIdentityCard) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementIdentityCard)
+}
+
+func (passportElementIdentityCard *PassportElementIdentityCard) appendJSON(b []byte) ([]byte, error) {
+	if passportElementIdentityCard == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementIdentityCard.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementIdentityCard.tdCommon.Extra)
+	b = append(b, `,"identity_card":`...)
+	b, err = passportElementIdentityCard.IdentityCard.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (passportElementIdentityCard *PassportElementIdentityCard) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementIdentityCard.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementIdentityCard.tdCommon.Extra, err = r.readString()
+		case "identity_card":
+			if r.readNull() {
+				passportElementIdentityCard.IdentityCard = nil
+			} else {
+				passportElementIdentityCard.IdentityCard = new(IdentityDocument)
+				err = passportElementIdentityCard.IdentityCard.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementIdentityCard *PassportElementIdentityCard) Clone() *PassportElementIdentityCard {
+	if passportElementIdentityCard == nil {
+		return nil
+	}
+	clone := *passportElementIdentityCard
+	clone.IdentityCard = passportElementIdentityCard.IdentityCard.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementIdentityCard *PassportElementIdentityCard) Equal(other *PassportElementIdentityCard) bool {
+	if passportElementIdentityCard == nil || other == nil {
+		return passportElementIdentityCard == other
+	}
+	if !passportElementIdentityCard.IdentityCard.Equal(other.IdentityCard) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementIdentityCard *PassportElementIdentityCard) Diff(other *PassportElementIdentityCard) []Change {
+	return passportElementIdentityCard.diff("", other, nil)
+}
+
+func (passportElementIdentityCard *PassportElementIdentityCard) diff(path string, other *PassportElementIdentityCard, changes []Change) []Change {
+	if passportElementIdentityCard == nil || other == nil {
+		if passportElementIdentityCard != other {
+			changes = append(changes, Change{Path: path, Old: passportElementIdentityCard, New: other})
+		}
+		return changes
+	}
+	changes = passportElementIdentityCard.IdentityCard.diff(fieldPath(path, "identity_card"), other.IdentityCard, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementIdentityCard *PassportElementIdentityCard) String() string {
+	return objectString(passportElementIdentityCard)
+}
+
+// LogValue logs the object as its summary
+func (passportElementIdentityCard *PassportElementIdentityCard) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementIdentityCard))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementIdentityCard *PassportElementIdentityCard) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementIdentityCard)
+}
+
+func (passportElementIdentityCard *PassportElementIdentityCard) printText(p *printer) {
+	if passportElementIdentityCard == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementIdentityCard")
+	p.object("identity_card", passportElementIdentityCard.IdentityCard)
+	p.endObject(state)
+}
+
 // GetPassportElementEnum return the enum type of this object
 func (passportElementIdentityCard *PassportElementIdentityCard) GetPassportElementEnum() PassportElementEnum {
 	return PassportElementIdentityCardType
 }
 
+// Accept calls the method of the visitor for PassportElementIdentityCard
+func (passportElementIdentityCard *PassportElementIdentityCard) Accept(visitor PassportElementVisitor) {
+	visitor.VisitPassportElementIdentityCard(passportElementIdentityCard)
+}
+
 // PassportElementInternalPassport A Telegram Passport element containing the user's internal passport
 type PassportElementInternalPassport struct {
 	tdCommon
@@ -247,11 +783,126 @@ func NewPassportElementInternalPassport(internalPassport *IdentityDocument) *Pas
 	return &passportElementInternalPassportTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementInternalPassport *PassportElementInternalPassport) MarshalJSON() ([]byte, error) {
+	return passportElementInternalPassport.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementInternalPassport *PassportElementInternalPassport) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementInternalPassport)
+}
+
+func (passportElementInternalPassport *PassportElementInternalPassport) appendJSON(b []byte) ([]byte, error) {
+	if passportElementInternalPassport == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementInternalPassport.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementInternalPassport.tdCommon.Extra)
+	b = append(b, `,"internal_passport":`...)
+	b, err = passportElementInternalPassport.InternalPassport.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (passportElementInternalPassport *PassportElementInternalPassport) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementInternalPassport.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementInternalPassport.tdCommon.Extra, err = r.readString()
+		case "internal_passport":
+			if r.readNull() {
+				passportElementInternalPassport.InternalPassport = nil
+			} else {
+				passportElementInternalPassport.InternalPassport = new(IdentityDocument)
+				err = passportElementInternalPassport.InternalPassport.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementInternalPassport *PassportElementInternalPassport) Clone() *PassportElementInternalPassport {
+	if passportElementInternalPassport == nil {
+		return nil
+	}
+	clone := *passportElementInternalPassport
+	clone.InternalPassport = passportElementInternalPassport.InternalPassport.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementInternalPassport *PassportElementInternalPassport) Equal(other *PassportElementInternalPassport) bool {
+	if passportElementInternalPassport == nil || other == nil {
+		return passportElementInternalPassport == other
+	}
+	if !passportElementInternalPassport.InternalPassport.Equal(other.InternalPassport) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementInternalPassport *PassportElementInternalPassport) Diff(other *PassportElementInternalPassport) []Change {
+	return passportElementInternalPassport.diff("", other, nil)
+}
+
+func (passportElementInternalPassport *PassportElementInternalPassport) diff(path string, other *PassportElementInternalPassport, changes []Change) []Change {
+	if passportElementInternalPassport == nil || other == nil {
+		if passportElementInternalPassport != other {
+			changes = append(changes, Change{Path: path, Old: passportElementInternalPassport, New: other})
+		}
+		return changes
+	}
+	changes = passportElementInternalPassport.InternalPassport.diff(fieldPath(path, "internal_passport"), other.InternalPassport, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementInternalPassport *PassportElementInternalPassport) String() string {
+	return objectString(passportElementInternalPassport)
+}
+
+// LogValue logs the object as its summary
+func (passportElementInternalPassport *PassportElementInternalPassport) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementInternalPassport))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementInternalPassport *PassportElementInternalPassport) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementInternalPassport)
+}
+
+func (passportElementInternalPassport *PassportElementInternalPassport) printText(p *printer) {
+	if passportElementInternalPassport == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementInternalPassport")
+	p.object("internal_passport", passportElementInternalPassport.InternalPassport)
+	p.endObject(state)
+}
+
 // GetPassportElementEnum return the enum type of this object
 func (passportElementInternalPassport *PassportElementInternalPassport) GetPassportElementEnum() PassportElementEnum {
 	return PassportElementInternalPassportType
 }
 
+// Accept calls the method of the visitor for PassportElementInternalPassport
+func (passportElementInternalPassport *PassportElementInternalPassport) Accept(visitor PassportElementVisitor) {
+	visitor.VisitPassportElementInternalPassport(passportElementInternalPassport)
+}
+
 // PassportElementAddress A Telegram Passport element containing the user's address
 type PassportElementAddress struct {
 	tdCommon
@@ -275,11 +926,126 @@ func NewPassportElementAddress(address *Address) *PassportElementAddress {
 	return &passportElementAddressTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementAddress *PassportElementAddress) MarshalJSON() ([]byte, error) {
+	return passportElementAddress.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementAddress *PassportElementAddress) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementAddress)
+}
+
+func (passportElementAddress *PassportElementAddress) appendJSON(b []byte) ([]byte, error) {
+	if passportElementAddress == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementAddress.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementAddress.tdCommon.Extra)
+	b = append(b, `,"address":`...)
+	b, err = passportElementAddress.Address.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (passportElementAddress *PassportElementAddress) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementAddress.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementAddress.tdCommon.Extra, err = r.readString()
+		case "address":
+			if r.readNull() {
+				passportElementAddress.Address = nil
+			} else {
+				passportElementAddress.Address = new(Address)
+				err = passportElementAddress.Address.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementAddress *PassportElementAddress) Clone() *PassportElementAddress {
+	if passportElementAddress == nil {
+		return nil
+	}
+	clone := *passportElementAddress
+	clone.Address = passportElementAddress.Address.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementAddress *PassportElementAddress) Equal(other *PassportElementAddress) bool {
+	if passportElementAddress == nil || other == nil {
+		return passportElementAddress == other
+	}
+	if !passportElementAddress.Address.Equal(other.Address) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementAddress *PassportElementAddress) Diff(other *PassportElementAddress) []Change {
+	return passportElementAddress.diff("", other, nil)
+}
+
+func (passportElementAddress *PassportElementAddress) diff(path string, other *PassportElementAddress, changes []Change) []Change {
+	if passportElementAddress == nil || other == nil {
+		if passportElementAddress != other {
+			changes = append(changes, Change{Path: path, Old: passportElementAddress, New: other})
+		}
+		return changes
+	}
+	changes = passportElementAddress.Address.diff(fieldPath(path, "address"), other.Address, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementAddress *PassportElementAddress) String() string {
+	return objectString(passportElementAddress)
+}
+
+// LogValue logs the object as its summary
+func (passportElementAddress *PassportElementAddress) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementAddress))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementAddress *PassportElementAddress) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementAddress)
+}
+
+func (passportElementAddress *PassportElementAddress) printText(p *printer) {
+	if passportElementAddress == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementAddress")
+	p.object("address", passportElementAddress.Address)
+	p.endObject(state)
+}
+
 // GetPassportElementEnum return the enum type of this object
 func (passportElementAddress *PassportElementAddress) GetPassportElementEnum() PassportElementEnum {
 	return PassportElementAddressType
 }
 
+// Accept calls the method of the visitor for PassportElementAddress
+func (passportElementAddress *PassportElementAddress) Accept(visitor PassportElementVisitor) {
+	visitor.VisitPassportElementAddress(passportElementAddress)
+}
+
 // PassportElementUtilityBill A Telegram Passport element containing the user's utility bill
 type PassportElementUtilityBill struct {
 	tdCommon
@@ -303,15 +1069,130 @@ func NewPassportElementUtilityBill(utilityBill *PersonalDocument) *PassportEleme
 	return &passportElementUtilityBillTemp
 }
 
-// GetPassportElementEnum return the enum type of this object
-func (passportElementUtilityBill *PassportElementUtilityBill) GetPassportElementEnum() PassportElementEnum {
-	return PassportElementUtilityBillType
+// MarshalJSON marshals to json
+func (passportElementUtilityBill *PassportElementUtilityBill) MarshalJSON() ([]byte, error) {
+	return passportElementUtilityBill.appendJSON(nil)
 }
 
-// PassportElementBankStatement A Telegram Passport element containing the user's bank statement
-type PassportElementBankStatement struct {
-	tdCommon
-	BankStatement *PersonalDocument `json:"bank_statement"` // Bank statement
+// UnmarshalJSON unmarshal to json
+func (passportElementUtilityBill *PassportElementUtilityBill) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementUtilityBill)
+}
+
+func (passportElementUtilityBill *PassportElementUtilityBill) appendJSON(b []byte) ([]byte, error) {
+	if passportElementUtilityBill == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementUtilityBill.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementUtilityBill.tdCommon.Extra)
+	b = append(b, `,"utility_bill":`...)
+	b, err = passportElementUtilityBill.UtilityBill.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (passportElementUtilityBill *PassportElementUtilityBill) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementUtilityBill.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementUtilityBill.tdCommon.Extra, err = r.readString()
+		case "utility_bill":
+			if r.readNull() {
+				passportElementUtilityBill.UtilityBill = nil
+			} else {
+				passportElementUtilityBill.UtilityBill = new(PersonalDocument)
+				err = passportElementUtilityBill.UtilityBill.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementUtilityBill *PassportElementUtilityBill) Clone() *PassportElementUtilityBill {
+	if passportElementUtilityBill == nil {
+		return nil
+	}
+	clone := *passportElementUtilityBill
+	clone.UtilityBill = passportElementUtilityBill.UtilityBill.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementUtilityBill *PassportElementUtilityBill) Equal(other *PassportElementUtilityBill) bool {
+	if passportElementUtilityBill == nil || other == nil {
+		return passportElementUtilityBill == other
+	}
+	if !passportElementUtilityBill.UtilityBill.Equal(other.UtilityBill) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementUtilityBill *PassportElementUtilityBill) Diff(other *PassportElementUtilityBill) []Change {
+	return passportElementUtilityBill.diff("", other, nil)
+}
+
+func (passportElementUtilityBill *PassportElementUtilityBill) diff(path string, other *PassportElementUtilityBill, changes []Change) []Change {
+	if passportElementUtilityBill == nil || other == nil {
+		if passportElementUtilityBill != other {
+			changes = append(changes, Change{Path: path, Old: passportElementUtilityBill, New: other})
+		}
+		return changes
+	}
+	changes = passportElementUtilityBill.UtilityBill.diff(fieldPath(path, "utility_bill"), other.UtilityBill, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementUtilityBill *PassportElementUtilityBill) String() string {
+	return objectString(passportElementUtilityBill)
+}
+
+// LogValue logs the object as its summary
+func (passportElementUtilityBill *PassportElementUtilityBill) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementUtilityBill))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementUtilityBill *PassportElementUtilityBill) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementUtilityBill)
+}
+
+func (passportElementUtilityBill *PassportElementUtilityBill) printText(p *printer) {
+	if passportElementUtilityBill == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementUtilityBill")
+	p.object("utility_bill", passportElementUtilityBill.UtilityBill)
+	p.endObject(state)
+}
+
+// GetPassportElementEnum return the enum type of this object
+func (passportElementUtilityBill *PassportElementUtilityBill) GetPassportElementEnum() PassportElementEnum {
+	return PassportElementUtilityBillType
+}
+
+// Accept calls the method of the visitor for PassportElementUtilityBill
+func (passportElementUtilityBill *PassportElementUtilityBill) Accept(visitor PassportElementVisitor) {
+	visitor.VisitPassportElementUtilityBill(passportElementUtilityBill)
+}
+
+// PassportElementBankStatement A Telegram Passport element containing the user's bank statement
+type PassportElementBankStatement struct {
+	tdCommon
+	BankStatement *PersonalDocument `json:"bank_statement"` // Bank statement
 }
 
 // MessageType return the string telegram-type of PassportElementBankStatement
@@ -331,11 +1212,126 @@ func NewPassportElementBankStatement(bankStatement *PersonalDocument) *PassportE
 	return &passportElementBankStatementTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementBankStatement *PassportElementBankStatement) MarshalJSON() ([]byte, error) {
+	return passportElementBankStatement.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementBankStatement *PassportElementBankStatement) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementBankStatement)
+}
+
+func (passportElementBankStatement *PassportElementBankStatement) appendJSON(b []byte) ([]byte, error) {
+	if passportElementBankStatement == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementBankStatement.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementBankStatement.tdCommon.Extra)
+	b = append(b, `,"bank_statement":`...)
+	b, err = passportElementBankStatement.BankStatement.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (passportElementBankStatement *PassportElementBankStatement) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementBankStatement.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementBankStatement.tdCommon.Extra, err = r.readString()
+		case "bank_statement":
+			if r.readNull() {
+				passportElementBankStatement.BankStatement = nil
+			} else {
+				passportElementBankStatement.BankStatement = new(PersonalDocument)
+				err = passportElementBankStatement.BankStatement.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementBankStatement *PassportElementBankStatement) Clone() *PassportElementBankStatement {
+	if passportElementBankStatement == nil {
+		return nil
+	}
+	clone := *passportElementBankStatement
+	clone.BankStatement = passportElementBankStatement.BankStatement.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementBankStatement *PassportElementBankStatement) Equal(other *PassportElementBankStatement) bool {
+	if passportElementBankStatement == nil || other == nil {
+		return passportElementBankStatement == other
+	}
+	if !passportElementBankStatement.BankStatement.Equal(other.BankStatement) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementBankStatement *PassportElementBankStatement) Diff(other *PassportElementBankStatement) []Change {
+	return passportElementBankStatement.diff("", other, nil)
+}
+
+func (passportElementBankStatement *PassportElementBankStatement) diff(path string, other *PassportElementBankStatement, changes []Change) []Change {
+	if passportElementBankStatement == nil || other == nil {
+		if passportElementBankStatement != other {
+			changes = append(changes, Change{Path: path, Old: passportElementBankStatement, New: other})
+		}
+		return changes
+	}
+	changes = passportElementBankStatement.BankStatement.diff(fieldPath(path, "bank_statement"), other.BankStatement, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementBankStatement *PassportElementBankStatement) String() string {
+	return objectString(passportElementBankStatement)
+}
+
+// LogValue logs the object as its summary
+func (passportElementBankStatement *PassportElementBankStatement) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementBankStatement))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementBankStatement *PassportElementBankStatement) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementBankStatement)
+}
+
+func (passportElementBankStatement *PassportElementBankStatement) printText(p *printer) {
+	if passportElementBankStatement == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementBankStatement")
+	p.object("bank_statement", passportElementBankStatement.BankStatement)
+	p.endObject(state)
+}
+
 // GetPassportElementEnum return the enum type of this object
 func (passportElementBankStatement *PassportElementBankStatement) GetPassportElementEnum() PassportElementEnum {
 	return PassportElementBankStatementType
 }
 
+// Accept calls the method of the visitor for PassportElementBankStatement
+func (passportElementBankStatement *PassportElementBankStatement) Accept(visitor PassportElementVisitor) {
+	visitor.VisitPassportElementBankStatement(passportElementBankStatement)
+}
+
 // PassportElementRentalAgreement A Telegram Passport element containing the user's rental agreement
 type PassportElementRentalAgreement struct {
 	tdCommon
@@ -359,11 +1355,126 @@ func NewPassportElementRentalAgreement(rentalAgreement *PersonalDocument) *Passp
 	return &passportElementRentalAgreementTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementRentalAgreement *PassportElementRentalAgreement) MarshalJSON() ([]byte, error) {
+	return passportElementRentalAgreement.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementRentalAgreement *PassportElementRentalAgreement) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementRentalAgreement)
+}
+
+func (passportElementRentalAgreement *PassportElementRentalAgreement) appendJSON(b []byte) ([]byte, error) {
+	if passportElementRentalAgreement == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementRentalAgreement.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementRentalAgreement.tdCommon.Extra)
+	b = append(b, `,"rental_agreement":`...)
+	b, err = passportElementRentalAgreement.RentalAgreement.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (passportElementRentalAgreement *PassportElementRentalAgreement) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementRentalAgreement.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementRentalAgreement.tdCommon.Extra, err = r.readString()
+		case "rental_agreement":
+			if r.readNull() {
+				passportElementRentalAgreement.RentalAgreement = nil
+			} else {
+				passportElementRentalAgreement.RentalAgreement = new(PersonalDocument)
+				err = passportElementRentalAgreement.RentalAgreement.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementRentalAgreement *PassportElementRentalAgreement) Clone() *PassportElementRentalAgreement {
+	if passportElementRentalAgreement == nil {
+		return nil
+	}
+	clone := *passportElementRentalAgreement
+	clone.RentalAgreement = passportElementRentalAgreement.RentalAgreement.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementRentalAgreement *PassportElementRentalAgreement) Equal(other *PassportElementRentalAgreement) bool {
+	if passportElementRentalAgreement == nil || other == nil {
+		return passportElementRentalAgreement == other
+	}
+	if !passportElementRentalAgreement.RentalAgreement.Equal(other.RentalAgreement) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementRentalAgreement *PassportElementRentalAgreement) Diff(other *PassportElementRentalAgreement) []Change {
+	return passportElementRentalAgreement.diff("", other, nil)
+}
+
+func (passportElementRentalAgreement *PassportElementRentalAgreement) diff(path string, other *PassportElementRentalAgreement, changes []Change) []Change {
+	if passportElementRentalAgreement == nil || other == nil {
+		if passportElementRentalAgreement != other {
+			changes = append(changes, Change{Path: path, Old: passportElementRentalAgreement, New: other})
+		}
+		return changes
+	}
+	changes = passportElementRentalAgreement.RentalAgreement.diff(fieldPath(path, "rental_agreement"), other.RentalAgreement, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementRentalAgreement *PassportElementRentalAgreement) String() string {
+	return objectString(passportElementRentalAgreement)
+}
+
+// LogValue logs the object as its summary
+func (passportElementRentalAgreement *PassportElementRentalAgreement) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementRentalAgreement))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementRentalAgreement *PassportElementRentalAgreement) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementRentalAgreement)
+}
+
+func (passportElementRentalAgreement *PassportElementRentalAgreement) printText(p *printer) {
+	if passportElementRentalAgreement == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementRentalAgreement")
+	p.object("rental_agreement", passportElementRentalAgreement.RentalAgreement)
+	p.endObject(state)
+}
+
 // GetPassportElementEnum return the enum type of this object
 func (passportElementRentalAgreement *PassportElementRentalAgreement) GetPassportElementEnum() PassportElementEnum {
 	return PassportElementRentalAgreementType
 }
 
+// Accept calls the method of the visitor for PassportElementRentalAgreement
+func (passportElementRentalAgreement *PassportElementRentalAgreement) Accept(visitor PassportElementVisitor) {
+	visitor.VisitPassportElementRentalAgreement(passportElementRentalAgreement)
+}
+
 // PassportElementPassportRegistration A Telegram Passport element containing the user's passport registration pages
 type PassportElementPassportRegistration struct {
 	tdCommon
@@ -387,11 +1498,126 @@ func NewPassportElementPassportRegistration(passportRegistration *PersonalDocume
 	return &passportElementPassportRegistrationTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementPassportRegistration *PassportElementPassportRegistration) MarshalJSON() ([]byte, error) {
+	return passportElementPassportRegistration.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementPassportRegistration *PassportElementPassportRegistration) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementPassportRegistration)
+}
+
+func (passportElementPassportRegistration *PassportElementPassportRegistration) appendJSON(b []byte) ([]byte, error) {
+	if passportElementPassportRegistration == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementPassportRegistration.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementPassportRegistration.tdCommon.Extra)
+	b = append(b, `,"passport_registration":`...)
+	b, err = passportElementPassportRegistration.PassportRegistration.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (passportElementPassportRegistration *PassportElementPassportRegistration) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementPassportRegistration.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementPassportRegistration.tdCommon.Extra, err = r.readString()
+		case "passport_registration":
+			if r.readNull() {
+				passportElementPassportRegistration.PassportRegistration = nil
+			} else {
+				passportElementPassportRegistration.PassportRegistration = new(PersonalDocument)
+				err = passportElementPassportRegistration.PassportRegistration.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementPassportRegistration *PassportElementPassportRegistration) Clone() *PassportElementPassportRegistration {
+	if passportElementPassportRegistration == nil {
+		return nil
+	}
+	clone := *passportElementPassportRegistration
+	clone.PassportRegistration = passportElementPassportRegistration.PassportRegistration.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementPassportRegistration *PassportElementPassportRegistration) Equal(other *PassportElementPassportRegistration) bool {
+	if passportElementPassportRegistration == nil || other == nil {
+		return passportElementPassportRegistration == other
+	}
+	if !passportElementPassportRegistration.PassportRegistration.Equal(other.PassportRegistration) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementPassportRegistration *PassportElementPassportRegistration) Diff(other *PassportElementPassportRegistration) []Change {
+	return passportElementPassportRegistration.diff("", other, nil)
+}
+
+func (passportElementPassportRegistration *PassportElementPassportRegistration) diff(path string, other *PassportElementPassportRegistration, changes []Change) []Change {
+	if passportElementPassportRegistration == nil || other == nil {
+		if passportElementPassportRegistration != other {
+			changes = append(changes, Change{Path: path, Old: passportElementPassportRegistration, New: other})
+		}
+		return changes
+	}
+	changes = passportElementPassportRegistration.PassportRegistration.diff(fieldPath(path, "passport_registration"), other.PassportRegistration, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementPassportRegistration *PassportElementPassportRegistration) String() string {
+	return objectString(passportElementPassportRegistration)
+}
+
+// LogValue logs the object as its summary
+func (passportElementPassportRegistration *PassportElementPassportRegistration) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementPassportRegistration))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementPassportRegistration *PassportElementPassportRegistration) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementPassportRegistration)
+}
+
+func (passportElementPassportRegistration *PassportElementPassportRegistration) printText(p *printer) {
+	if passportElementPassportRegistration == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementPassportRegistration")
+	p.object("passport_registration", passportElementPassportRegistration.PassportRegistration)
+	p.endObject(state)
+}
+
 // GetPassportElementEnum return the enum type of this object
 func (passportElementPassportRegistration *PassportElementPassportRegistration) GetPassportElementEnum() PassportElementEnum {
 	return PassportElementPassportRegistrationType
 }
 
+// Accept calls the method of the visitor for PassportElementPassportRegistration
+func (passportElementPassportRegistration *PassportElementPassportRegistration) Accept(visitor PassportElementVisitor) {
+	visitor.VisitPassportElementPassportRegistration(passportElementPassportRegistration)
+}
+
 // PassportElementTemporaryRegistration A Telegram Passport element containing the user's temporary registration
 type PassportElementTemporaryRegistration struct {
 	tdCommon
@@ -415,11 +1641,126 @@ func NewPassportElementTemporaryRegistration(temporaryRegistration *PersonalDocu
 	return &passportElementTemporaryRegistrationTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementTemporaryRegistration *PassportElementTemporaryRegistration) MarshalJSON() ([]byte, error) {
+	return passportElementTemporaryRegistration.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementTemporaryRegistration *PassportElementTemporaryRegistration) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementTemporaryRegistration)
+}
+
+func (passportElementTemporaryRegistration *PassportElementTemporaryRegistration) appendJSON(b []byte) ([]byte, error) {
+	if passportElementTemporaryRegistration == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementTemporaryRegistration.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementTemporaryRegistration.tdCommon.Extra)
+	b = append(b, `,"temporary_registration":`...)
+	b, err = passportElementTemporaryRegistration.TemporaryRegistration.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (passportElementTemporaryRegistration *PassportElementTemporaryRegistration) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementTemporaryRegistration.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementTemporaryRegistration.tdCommon.Extra, err = r.readString()
+		case "temporary_registration":
+			if r.readNull() {
+				passportElementTemporaryRegistration.TemporaryRegistration = nil
+			} else {
+				passportElementTemporaryRegistration.TemporaryRegistration = new(PersonalDocument)
+				err = passportElementTemporaryRegistration.TemporaryRegistration.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementTemporaryRegistration *PassportElementTemporaryRegistration) Clone() *PassportElementTemporaryRegistration {
+	if passportElementTemporaryRegistration == nil {
+		return nil
+	}
+	clone := *passportElementTemporaryRegistration
+	clone.TemporaryRegistration = passportElementTemporaryRegistration.TemporaryRegistration.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementTemporaryRegistration *PassportElementTemporaryRegistration) Equal(other *PassportElementTemporaryRegistration) bool {
+	if passportElementTemporaryRegistration == nil || other == nil {
+		return passportElementTemporaryRegistration == other
+	}
+	if !passportElementTemporaryRegistration.TemporaryRegistration.Equal(other.TemporaryRegistration) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementTemporaryRegistration *PassportElementTemporaryRegistration) Diff(other *PassportElementTemporaryRegistration) []Change {
+	return passportElementTemporaryRegistration.diff("", other, nil)
+}
+
+func (passportElementTemporaryRegistration *PassportElementTemporaryRegistration) diff(path string, other *PassportElementTemporaryRegistration, changes []Change) []Change {
+	if passportElementTemporaryRegistration == nil || other == nil {
+		if passportElementTemporaryRegistration != other {
+			changes = append(changes, Change{Path: path, Old: passportElementTemporaryRegistration, New: other})
+		}
+		return changes
+	}
+	changes = passportElementTemporaryRegistration.TemporaryRegistration.diff(fieldPath(path, "temporary_registration"), other.TemporaryRegistration, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementTemporaryRegistration *PassportElementTemporaryRegistration) String() string {
+	return objectString(passportElementTemporaryRegistration)
+}
+
+// LogValue logs the object as its summary
+func (passportElementTemporaryRegistration *PassportElementTemporaryRegistration) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementTemporaryRegistration))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementTemporaryRegistration *PassportElementTemporaryRegistration) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementTemporaryRegistration)
+}
+
+func (passportElementTemporaryRegistration *PassportElementTemporaryRegistration) printText(p *printer) {
+	if passportElementTemporaryRegistration == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementTemporaryRegistration")
+	p.object("temporary_registration", passportElementTemporaryRegistration.TemporaryRegistration)
+	p.endObject(state)
+}
+
 // GetPassportElementEnum return the enum type of this object
 func (passportElementTemporaryRegistration *PassportElementTemporaryRegistration) GetPassportElementEnum() PassportElementEnum {
 	return PassportElementTemporaryRegistrationType
 }
 
+// Accept calls the method of the visitor for PassportElementTemporaryRegistration
+func (passportElementTemporaryRegistration *PassportElementTemporaryRegistration) Accept(visitor PassportElementVisitor) {
+	visitor.VisitPassportElementTemporaryRegistration(passportElementTemporaryRegistration)
+}
+
 // PassportElementPhoneNumber A Telegram Passport element containing the user's phone number
 type PassportElementPhoneNumber struct {
 	tdCommon
@@ -443,11 +1784,118 @@ func NewPassportElementPhoneNumber(phoneNumber string) *PassportElementPhoneNumb
 	return &passportElementPhoneNumberTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementPhoneNumber *PassportElementPhoneNumber) MarshalJSON() ([]byte, error) {
+	return passportElementPhoneNumber.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementPhoneNumber *PassportElementPhoneNumber) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementPhoneNumber)
+}
+
+func (passportElementPhoneNumber *PassportElementPhoneNumber) appendJSON(b []byte) ([]byte, error) {
+	if passportElementPhoneNumber == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementPhoneNumber.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementPhoneNumber.tdCommon.Extra)
+	b = append(b, `,"phone_number":`...)
+	b = appendJSONString(b, passportElementPhoneNumber.PhoneNumber)
+	return append(b, '}'), nil
+}
+
+func (passportElementPhoneNumber *PassportElementPhoneNumber) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementPhoneNumber.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementPhoneNumber.tdCommon.Extra, err = r.readString()
+		case "phone_number":
+			passportElementPhoneNumber.PhoneNumber, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementPhoneNumber *PassportElementPhoneNumber) Clone() *PassportElementPhoneNumber {
+	if passportElementPhoneNumber == nil {
+		return nil
+	}
+	clone := *passportElementPhoneNumber
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementPhoneNumber *PassportElementPhoneNumber) Equal(other *PassportElementPhoneNumber) bool {
+	if passportElementPhoneNumber == nil || other == nil {
+		return passportElementPhoneNumber == other
+	}
+	if passportElementPhoneNumber.PhoneNumber != other.PhoneNumber {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementPhoneNumber *PassportElementPhoneNumber) Diff(other *PassportElementPhoneNumber) []Change {
+	return passportElementPhoneNumber.diff("", other, nil)
+}
+
+func (passportElementPhoneNumber *PassportElementPhoneNumber) diff(path string, other *PassportElementPhoneNumber, changes []Change) []Change {
+	if passportElementPhoneNumber == nil || other == nil {
+		if passportElementPhoneNumber != other {
+			changes = append(changes, Change{Path: path, Old: passportElementPhoneNumber, New: other})
+		}
+		return changes
+	}
+	if passportElementPhoneNumber.PhoneNumber != other.PhoneNumber {
+		changes = append(changes, Change{Path: fieldPath(path, "phone_number"), Old: passportElementPhoneNumber.PhoneNumber, New: other.PhoneNumber})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementPhoneNumber *PassportElementPhoneNumber) String() string {
+	return objectString(passportElementPhoneNumber)
+}
+
+// LogValue logs the object as its summary
+func (passportElementPhoneNumber *PassportElementPhoneNumber) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementPhoneNumber))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementPhoneNumber *PassportElementPhoneNumber) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementPhoneNumber)
+}
+
+func (passportElementPhoneNumber *PassportElementPhoneNumber) printText(p *printer) {
+	if passportElementPhoneNumber == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementPhoneNumber")
+	p.string("phone_number", passportElementPhoneNumber.PhoneNumber)
+	p.endObject(state)
+}
+
 // GetPassportElementEnum return the enum type of this object
 func (passportElementPhoneNumber *PassportElementPhoneNumber) GetPassportElementEnum() PassportElementEnum {
 	return PassportElementPhoneNumberType
 }
 
+// Accept calls the method of the visitor for PassportElementPhoneNumber
+func (passportElementPhoneNumber *PassportElementPhoneNumber) Accept(visitor PassportElementVisitor) {
+	visitor.VisitPassportElementPhoneNumber(passportElementPhoneNumber)
+}
+
 // PassportElementEmailAddress A Telegram Passport element containing the user's email address
 type PassportElementEmailAddress struct {
 	tdCommon
@@ -471,11 +1919,378 @@ func NewPassportElementEmailAddress(emailAddress string) *PassportElementEmailAd
 	return &passportElementEmailAddressTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementEmailAddress *PassportElementEmailAddress) MarshalJSON() ([]byte, error) {
+	return passportElementEmailAddress.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementEmailAddress *PassportElementEmailAddress) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementEmailAddress)
+}
+
+func (passportElementEmailAddress *PassportElementEmailAddress) appendJSON(b []byte) ([]byte, error) {
+	if passportElementEmailAddress == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementEmailAddress.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementEmailAddress.tdCommon.Extra)
+	b = append(b, `,"email_address":`...)
+	b = appendJSONString(b, passportElementEmailAddress.EmailAddress)
+	return append(b, '}'), nil
+}
+
+func (passportElementEmailAddress *PassportElementEmailAddress) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementEmailAddress.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementEmailAddress.tdCommon.Extra, err = r.readString()
+		case "email_address":
+			passportElementEmailAddress.EmailAddress, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementEmailAddress *PassportElementEmailAddress) Clone() *PassportElementEmailAddress {
+	if passportElementEmailAddress == nil {
+		return nil
+	}
+	clone := *passportElementEmailAddress
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementEmailAddress *PassportElementEmailAddress) Equal(other *PassportElementEmailAddress) bool {
+	if passportElementEmailAddress == nil || other == nil {
+		return passportElementEmailAddress == other
+	}
+	if passportElementEmailAddress.EmailAddress != other.EmailAddress {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementEmailAddress *PassportElementEmailAddress) Diff(other *PassportElementEmailAddress) []Change {
+	return passportElementEmailAddress.diff("", other, nil)
+}
+
+func (passportElementEmailAddress *PassportElementEmailAddress) diff(path string, other *PassportElementEmailAddress, changes []Change) []Change {
+	if passportElementEmailAddress == nil || other == nil {
+		if passportElementEmailAddress != other {
+			changes = append(changes, Change{Path: path, Old: passportElementEmailAddress, New: other})
+		}
+		return changes
+	}
+	if passportElementEmailAddress.EmailAddress != other.EmailAddress {
+		changes = append(changes, Change{Path: fieldPath(path, "email_address"), Old: passportElementEmailAddress.EmailAddress, New: other.EmailAddress})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementEmailAddress *PassportElementEmailAddress) String() string {
+	return objectString(passportElementEmailAddress)
+}
+
+// LogValue logs the object as its summary
+func (passportElementEmailAddress *PassportElementEmailAddress) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementEmailAddress))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementEmailAddress *PassportElementEmailAddress) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementEmailAddress)
+}
+
+func (passportElementEmailAddress *PassportElementEmailAddress) printText(p *printer) {
+	if passportElementEmailAddress == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementEmailAddress")
+	p.string("email_address", passportElementEmailAddress.EmailAddress)
+	p.endObject(state)
+}
+
 // GetPassportElementEnum return the enum type of this object
 func (passportElementEmailAddress *PassportElementEmailAddress) GetPassportElementEnum() PassportElementEnum {
 	return PassportElementEmailAddressType
 }
 
+// Accept calls the method of the visitor for PassportElementEmailAddress
+func (passportElementEmailAddress *PassportElementEmailAddress) Accept(visitor PassportElementVisitor) {
+	visitor.VisitPassportElementEmailAddress(passportElementEmailAddress)
+}
+
+// PassportElementVisitor visits every type of PassportElement, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to PassportElement, until they handle it too.
+type PassportElementVisitor interface {
+	VisitPassportElementPersonalDetails(passportElementPersonalDetails *PassportElementPersonalDetails)
+	VisitPassportElementPassport(passportElementPassport *PassportElementPassport)
+	VisitPassportElementDriverLicense(passportElementDriverLicense *PassportElementDriverLicense)
+	VisitPassportElementIdentityCard(passportElementIdentityCard *PassportElementIdentityCard)
+	VisitPassportElementInternalPassport(passportElementInternalPassport *PassportElementInternalPassport)
+	VisitPassportElementAddress(passportElementAddress *PassportElementAddress)
+	VisitPassportElementUtilityBill(passportElementUtilityBill *PassportElementUtilityBill)
+	VisitPassportElementBankStatement(passportElementBankStatement *PassportElementBankStatement)
+	VisitPassportElementRentalAgreement(passportElementRentalAgreement *PassportElementRentalAgreement)
+	VisitPassportElementPassportRegistration(passportElementPassportRegistration *PassportElementPassportRegistration)
+	VisitPassportElementTemporaryRegistration(passportElementTemporaryRegistration *PassportElementTemporaryRegistration)
+	VisitPassportElementPhoneNumber(passportElementPhoneNumber *PassportElementPhoneNumber)
+	VisitPassportElementEmailAddress(passportElementEmailAddress *PassportElementEmailAddress)
+	VisitUnknownPassportElement(unknownPassportElement *UnknownPassportElement)
+}
+
+// PassportElementCases holds the function MatchPassportElement calls for each type of PassportElement;
+// types without a function are passed to the default case
+type PassportElementCases[R any] struct {
+	PassportElementPersonalDetails       func(passportElementPersonalDetails *PassportElementPersonalDetails) R
+	PassportElementPassport              func(passportElementPassport *PassportElementPassport) R
+	PassportElementDriverLicense         func(passportElementDriverLicense *PassportElementDriverLicense) R
+	PassportElementIdentityCard          func(passportElementIdentityCard *PassportElementIdentityCard) R
+	PassportElementInternalPassport      func(passportElementInternalPassport *PassportElementInternalPassport) R
+	PassportElementAddress               func(passportElementAddress *PassportElementAddress) R
+	PassportElementUtilityBill           func(passportElementUtilityBill *PassportElementUtilityBill) R
+	PassportElementBankStatement         func(passportElementBankStatement *PassportElementBankStatement) R
+	PassportElementRentalAgreement       func(passportElementRentalAgreement *PassportElementRentalAgreement) R
+	PassportElementPassportRegistration  func(passportElementPassportRegistration *PassportElementPassportRegistration) R
+	PassportElementTemporaryRegistration func(passportElementTemporaryRegistration *PassportElementTemporaryRegistration) R
+	PassportElementPhoneNumber           func(passportElementPhoneNumber *PassportElementPhoneNumber) R
+	PassportElementEmailAddress          func(passportElementEmailAddress *PassportElementEmailAddress) R
+}
+
+// MatchPassportElement calls the case of the type of passportElement, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement PassportElementVisitor
+// to have the compiler check every type is handled.
+func MatchPassportElement[R any](passportElement PassportElement, cases PassportElementCases[R], defaultCase func(passportElement PassportElement) R) R {
+	switch value := passportElement.(type) {
+	case *PassportElementPersonalDetails:
+		if cases.PassportElementPersonalDetails != nil {
+			return cases.PassportElementPersonalDetails(value)
+		}
+	case *PassportElementPassport:
+		if cases.PassportElementPassport != nil {
+			return cases.PassportElementPassport(value)
+		}
+	case *PassportElementDriverLicense:
+		if cases.PassportElementDriverLicense != nil {
+			return cases.PassportElementDriverLicense(value)
+		}
+	case *PassportElementIdentityCard:
+		if cases.PassportElementIdentityCard != nil {
+			return cases.PassportElementIdentityCard(value)
+		}
+	case *PassportElementInternalPassport:
+		if cases.PassportElementInternalPassport != nil {
+			return cases.PassportElementInternalPassport(value)
+		}
+	case *PassportElementAddress:
+		if cases.PassportElementAddress != nil {
+			return cases.PassportElementAddress(value)
+		}
+	case *PassportElementUtilityBill:
+		if cases.PassportElementUtilityBill != nil {
+			return cases.PassportElementUtilityBill(value)
+		}
+	case *PassportElementBankStatement:
+		if cases.PassportElementBankStatement != nil {
+			return cases.PassportElementBankStatement(value)
+		}
+	case *PassportElementRentalAgreement:
+		if cases.PassportElementRentalAgreement != nil {
+			return cases.PassportElementRentalAgreement(value)
+		}
+	case *PassportElementPassportRegistration:
+		if cases.PassportElementPassportRegistration != nil {
+			return cases.PassportElementPassportRegistration(value)
+		}
+	case *PassportElementTemporaryRegistration:
+		if cases.PassportElementTemporaryRegistration != nil {
+			return cases.PassportElementTemporaryRegistration(value)
+		}
+	case *PassportElementPhoneNumber:
+		if cases.PassportElementPhoneNumber != nil {
+			return cases.PassportElementPhoneNumber(value)
+		}
+	case *PassportElementEmailAddress:
+		if cases.PassportElementEmailAddress != nil {
+			return cases.PassportElementEmailAddress(value)
+		}
+	}
+	return defaultCase(passportElement)
+}
+
+// Clone returns a deep copy of the object
+func (unknownPassportElement *UnknownPassportElement) Clone() *UnknownPassportElement {
+	if unknownPassportElement == nil {
+		return nil
+	}
+	return &UnknownPassportElement{Unknown: unknownPassportElement.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownPassportElement *UnknownPassportElement) Equal(other *UnknownPassportElement) bool {
+	if unknownPassportElement == nil || other == nil {
+		return unknownPassportElement == other
+	}
+	return unknownPassportElement.Unknown.equal(other.Unknown)
+}
+
+func clonePassportElement(passportElement PassportElement) PassportElement {
+	switch value := passportElement.(type) {
+	case *PassportElementPersonalDetails:
+		return value.Clone()
+	case *PassportElementPassport:
+		return value.Clone()
+	case *PassportElementDriverLicense:
+		return value.Clone()
+	case *PassportElementIdentityCard:
+		return value.Clone()
+	case *PassportElementInternalPassport:
+		return value.Clone()
+	case *PassportElementAddress:
+		return value.Clone()
+	case *PassportElementUtilityBill:
+		return value.Clone()
+	case *PassportElementBankStatement:
+		return value.Clone()
+	case *PassportElementRentalAgreement:
+		return value.Clone()
+	case *PassportElementPassportRegistration:
+		return value.Clone()
+	case *PassportElementTemporaryRegistration:
+		return value.Clone()
+	case *PassportElementPhoneNumber:
+		return value.Clone()
+	case *PassportElementEmailAddress:
+		return value.Clone()
+	case *UnknownPassportElement:
+		return value.Clone()
+	}
+	return passportElement
+}
+
+func equalPassportElement(a PassportElement, b PassportElement) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *PassportElementPersonalDetails:
+		b, ok := b.(*PassportElementPersonalDetails)
+		return ok && a.Equal(b)
+	case *PassportElementPassport:
+		b, ok := b.(*PassportElementPassport)
+		return ok && a.Equal(b)
+	case *PassportElementDriverLicense:
+		b, ok := b.(*PassportElementDriverLicense)
+		return ok && a.Equal(b)
+	case *PassportElementIdentityCard:
+		b, ok := b.(*PassportElementIdentityCard)
+		return ok && a.Equal(b)
+	case *PassportElementInternalPassport:
+		b, ok := b.(*PassportElementInternalPassport)
+		return ok && a.Equal(b)
+	case *PassportElementAddress:
+		b, ok := b.(*PassportElementAddress)
+		return ok && a.Equal(b)
+	case *PassportElementUtilityBill:
+		b, ok := b.(*PassportElementUtilityBill)
+		return ok && a.Equal(b)
+	case *PassportElementBankStatement:
+		b, ok := b.(*PassportElementBankStatement)
+		return ok && a.Equal(b)
+	case *PassportElementRentalAgreement:
+		b, ok := b.(*PassportElementRentalAgreement)
+		return ok && a.Equal(b)
+	case *PassportElementPassportRegistration:
+		b, ok := b.(*PassportElementPassportRegistration)
+		return ok && a.Equal(b)
+	case *PassportElementTemporaryRegistration:
+		b, ok := b.(*PassportElementTemporaryRegistration)
+		return ok && a.Equal(b)
+	case *PassportElementPhoneNumber:
+		b, ok := b.(*PassportElementPhoneNumber)
+		return ok && a.Equal(b)
+	case *PassportElementEmailAddress:
+		b, ok := b.(*PassportElementEmailAddress)
+		return ok && a.Equal(b)
+	case *UnknownPassportElement:
+		b, ok := b.(*UnknownPassportElement)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffPassportElement(path string, a PassportElement, b PassportElement, changes []Change) []Change {
+	switch a := a.(type) {
+	case *PassportElementPersonalDetails:
+		if b, ok := b.(*PassportElementPersonalDetails); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementPassport:
+		if b, ok := b.(*PassportElementPassport); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementDriverLicense:
+		if b, ok := b.(*PassportElementDriverLicense); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementIdentityCard:
+		if b, ok := b.(*PassportElementIdentityCard); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementInternalPassport:
+		if b, ok := b.(*PassportElementInternalPassport); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementAddress:
+		if b, ok := b.(*PassportElementAddress); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementUtilityBill:
+		if b, ok := b.(*PassportElementUtilityBill); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementBankStatement:
+		if b, ok := b.(*PassportElementBankStatement); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementRentalAgreement:
+		if b, ok := b.(*PassportElementRentalAgreement); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementPassportRegistration:
+		if b, ok := b.(*PassportElementPassportRegistration); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementTemporaryRegistration:
+		if b, ok := b.(*PassportElementTemporaryRegistration); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementPhoneNumber:
+		if b, ok := b.(*PassportElementPhoneNumber); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementEmailAddress:
+		if b, ok := b.(*PassportElementEmailAddress); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalPassportElement(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
+
 // GetPassportElement Returns one of the available Telegram Passport elements
 // @param typeParam Telegram Passport element type
 // @param password Password of the current user
@@ -490,75 +2305,76 @@ func (client *Client) GetPassportElement(typeParam PassportElementType, password
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
-	switch PassportElementEnum(result.Data["@type"].(string)) {
+	resultType, _ := result.Data["@type"].(string)
+	switch PassportElementEnum(resultType) {
 
 	case PassportElementPersonalDetailsType:
 		var passportElement PassportElementPersonalDetails
-		err = json.Unmarshal(result.Raw, &passportElement)
+		err = jsonUnmarshal(result.Raw, &passportElement)
 		return &passportElement, err
 
 	case PassportElementPassportType:
 		var passportElement PassportElementPassport
-		err = json.Unmarshal(result.Raw, &passportElement)
+		err = jsonUnmarshal(result.Raw, &passportElement)
 		return &passportElement, err
 
 	case PassportElementDriverLicenseType:
 		var passportElement PassportElementDriverLicense
-		err = json.Unmarshal(result.Raw, &passportElement)
+		err = jsonUnmarshal(result.Raw, &passportElement)
 		return &passportElement, err
 
 	case PassportElementIdentityCardType:
 		var passportElement PassportElementIdentityCard
-		err = json.Unmarshal(result.Raw, &passportElement)
+		err = jsonUnmarshal(result.Raw, &passportElement)
 		return &passportElement, err
 
 	case PassportElementInternalPassportType:
 		var passportElement PassportElementInternalPassport
-		err = json.Unmarshal(result.Raw, &passportElement)
+		err = jsonUnmarshal(result.Raw, &passportElement)
 		return &passportElement, err
 
 	case PassportElementAddressType:
 		var passportElement PassportElementAddress
-		err = json.Unmarshal(result.Raw, &passportElement)
+		err = jsonUnmarshal(result.Raw, &passportElement)
 		return &passportElement, err
 
 	case PassportElementUtilityBillType:
 		var passportElement PassportElementUtilityBill
-		err = json.Unmarshal(result.Raw, &passportElement)
+		err = jsonUnmarshal(result.Raw, &passportElement)
 		return &passportElement, err
 
 	case PassportElementBankStatementType:
 		var passportElement PassportElementBankStatement
-		err = json.Unmarshal(result.Raw, &passportElement)
+		err = jsonUnmarshal(result.Raw, &passportElement)
 		return &passportElement, err
 
 	case PassportElementRentalAgreementType:
 		var passportElement PassportElementRentalAgreement
-		err = json.Unmarshal(result.Raw, &passportElement)
+		err = jsonUnmarshal(result.Raw, &passportElement)
 		return &passportElement, err
 
 	case PassportElementPassportRegistrationType:
 		var passportElement PassportElementPassportRegistration
-		err = json.Unmarshal(result.Raw, &passportElement)
+		err = jsonUnmarshal(result.Raw, &passportElement)
 		return &passportElement, err
 
 	case PassportElementTemporaryRegistrationType:
 		var passportElement PassportElementTemporaryRegistration
-		err = json.Unmarshal(result.Raw, &passportElement)
+		err = jsonUnmarshal(result.Raw, &passportElement)
 		return &passportElement, err
 
 	case PassportElementPhoneNumberType:
 		var passportElement PassportElementPhoneNumber
-		err = json.Unmarshal(result.Raw, &passportElement)
+		err = jsonUnmarshal(result.Raw, &passportElement)
 		return &passportElement, err
 
 	case PassportElementEmailAddressType:
 		var passportElement PassportElementEmailAddress
-		err = json.Unmarshal(result.Raw, &passportElement)
+		err = jsonUnmarshal(result.Raw, &passportElement)
 		return &passportElement, err
 
 	default:
@@ -580,75 +2396,76 @@ func (client *Client) SetPassportElement(element InputPassportElement, password
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
-	switch PassportElementEnum(result.Data["@type"].(string)) {
+	resultType, _ := result.Data["@type"].(string)
+	switch PassportElementEnum(resultType) {
 
 	case PassportElementPersonalDetailsType:
 		var passportElement PassportElementPersonalDetails
-		err = json.Unmarshal(result.Raw, &passportElement)
+		err = jsonUnmarshal(result.Raw, &passportElement)
 		return &passportElement, err
 
 	case PassportElementPassportType:
 		var passportElement PassportElementPassport
-		err = json.Unmarshal(result.Raw, &passportElement)
+		err = jsonUnmarshal(result.Raw, &passportElement)
 		return &passportElement, err
 
 	case PassportElementDriverLicenseType:
 		var passportElement PassportElementDriverLicense
-		err = json.Unmarshal(result.Raw, &passportElement)
+		err = jsonUnmarshal(result.Raw, &passportElement)
 		return &passportElement, err
 
 	case PassportElementIdentityCardType:
 		var passportElement PassportElementIdentityCard
-		err = json.Unmarshal(result.Raw, &passportElement)
+		err = jsonUnmarshal(result.Raw, &passportElement)
 		return &passportElement, err
 
 	case PassportElementInternalPassportType:
 		var passportElement PassportElementInternalPassport
-		err = json.Unmarshal(result.Raw, &passportElement)
+		err = jsonUnmarshal(result.Raw, &passportElement)
 		return &passportElement, err
 
 	case PassportElementAddressType:
 		var passportElement PassportElementAddress
-		err = json.Unmarshal(result.Raw, &passportElement)
+		err = jsonUnmarshal(result.Raw, &passportElement)
 		return &passportElement, err
 
 	case PassportElementUtilityBillType:
 		var passportElement PassportElementUtilityBill
-		err = json.Unmarshal(result.Raw, &passportElement)
+		err = jsonUnmarshal(result.Raw, &passportElement)
 		return &passportElement, err
 
 	case PassportElementBankStatementType:
 		var passportElement PassportElementBankStatement
-		err = json.Unmarshal(result.Raw, &passportElement)
+		err = jsonUnmarshal(result.Raw, &passportElement)
 		return &passportElement, err
 
 	case PassportElementRentalAgreementType:
 		var passportElement PassportElementRentalAgreement
-		err = json.Unmarshal(result.Raw, &passportElement)
+		err = jsonUnmarshal(result.Raw, &passportElement)
 		return &passportElement, err
 
 	case PassportElementPassportRegistrationType:
 		var passportElement PassportElementPassportRegistration
-		err = json.Unmarshal(result.Raw, &passportElement)
+		err = jsonUnmarshal(result.Raw, &passportElement)
 		return &passportElement, err
 
 	case PassportElementTemporaryRegistrationType:
 		var passportElement PassportElementTemporaryRegistration
-		err = json.Unmarshal(result.Raw, &passportElement)
+		err = jsonUnmarshal(result.Raw, &passportElement)
 		return &passportElement, err
 
 	case PassportElementPhoneNumberType:
 		var passportElement PassportElementPhoneNumber
-		err = json.Unmarshal(result.Raw, &passportElement)
+		err = jsonUnmarshal(result.Raw, &passportElement)
 		return &passportElement, err
 
 	case PassportElementEmailAddressType:
 		var passportElement PassportElementEmailAddress
-		err = json.Unmarshal(result.Raw, &passportElement)
+		err = jsonUnmarshal(result.Raw, &passportElement)
 		return &passportElement, err
 
 	default:
@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // PassportElementError Contains the description of an error in a Telegram Passport element
@@ -33,31 +34,131 @@ func NewPassportElementError(typeParam PassportElementType, message string, sour
 	return &passportElementErrorTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementError *PassportElementError) MarshalJSON() ([]byte, error) {
+	return passportElementError.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (passportElementError *PassportElementError) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, passportElementError)
+}
+
+func (passportElementError *PassportElementError) appendJSON(b []byte) ([]byte, error) {
+	if passportElementError == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementError.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementError.tdCommon.Extra)
+	b = append(b, `,"type":`...)
+	b, err = appendJSONValue(b, passportElementError.Type)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-		Message string `json:"message"` // Error message
-
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	b = append(b, `,"message":`...)
+	b = appendJSONString(b, passportElementError.Message)
+	b = append(b, `,"source":`...)
+	b, err = appendJSONValue(b, passportElementError.Source)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (passportElementError *PassportElementError) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementError.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementError.tdCommon.Extra, err = r.readString()
+		case "type":
+			passportElementError.Type, err = decodePassportElementType(r)
+		case "message":
+			passportElementError.Message, err = r.readString()
+		case "source":
+			passportElementError.Source, err = decodePassportElementErrorSource(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementError *PassportElementError) Clone() *PassportElementError {
+	if passportElementError == nil {
+		return nil
+	}
+	clone := *passportElementError
+	clone.Type = clonePassportElementType(passportElementError.Type)
+	clone.Source = clonePassportElementErrorSource(passportElementError.Source)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementError *PassportElementError) Equal(other *PassportElementError) bool {
+	if passportElementError == nil || other == nil {
+		return passportElementError == other
 	}
+	if !equalPassportElementType(passportElementError.Type, other.Type) {
+		return false
+	}
+	if passportElementError.Message != other.Message {
+		return false
+	}
+	if !equalPassportElementErrorSource(passportElementError.Source, other.Source) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementError *PassportElementError) Diff(other *PassportElementError) []Change {
+	return passportElementError.diff("", other, nil)
+}
+
+func (passportElementError *PassportElementError) diff(path string, other *PassportElementError, changes []Change) []Change {
+	if passportElementError == nil || other == nil {
+		if passportElementError != other {
+			changes = append(changes, Change{Path: path, Old: passportElementError, New: other})
+		}
+		return changes
+	}
+	changes = diffPassportElementType(fieldPath(path, "type"), passportElementError.Type, other.Type, changes)
+	if passportElementError.Message != other.Message {
+		changes = append(changes, Change{Path: fieldPath(path, "message"), Old: passportElementError.Message, New: other.Message})
+	}
+	changes = diffPassportElementErrorSource(fieldPath(path, "source"), passportElementError.Source, other.Source, changes)
+	return changes
+}
 
-	passportElementError.tdCommon = tempObj.tdCommon
-	passportElementError.Message = tempObj.Message
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementError *PassportElementError) String() string {
+	return objectString(passportElementError)
+}
 
-	fieldType, _ := unmarshalPassportElementType(objMap["type"])
-	passportElementError.Type = fieldType
+// LogValue logs the object as its summary
+func (passportElementError *PassportElementError) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementError))
+}
 
-	fieldSource, _ := unmarshalPassportElementErrorSource(objMap["source"])
-	passportElementError.Source = fieldSource
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementError *PassportElementError) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementError)
+}
 
-	return nil
+func (passportElementError *PassportElementError) printText(p *printer) {
+	if passportElementError == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementError")
+	p.object("type", passportElementError.Type)
+	p.string("message", passportElementError.Message)
+	p.object("source", passportElementError.Source)
+	p.endObject(state)
 }
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // PassportElementErrorSource Contains the description of an error in a Telegram Passport element
 type PassportElementErrorSource interface {
 	GetPassportElementErrorSourceEnum() PassportElementErrorSourceEnum
+	Accept(visitor PassportElementErrorSourceVisitor)
 }
 
 // PassportElementErrorSourceEnum Alias for abstract PassportElementErrorSource 'Sub-Classes', used as constant-enum here
@@ -26,66 +28,117 @@ const (
 	PassportElementErrorSourceFilesType            PassportElementErrorSourceEnum = "passportElementErrorSourceFiles"
 )
 
+// UnknownPassportElementErrorSource is a PassportElementErrorSource of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownPassportElementErrorSource struct {
+	Unknown
+}
+
+// GetPassportElementErrorSourceEnum return the enum type of this object
+func (unknownPassportElementErrorSource *UnknownPassportElementErrorSource) GetPassportElementErrorSourceEnum() PassportElementErrorSourceEnum {
+	return PassportElementErrorSourceEnum(unknownPassportElementErrorSource.Type)
+}
+
+// Accept calls the method of the visitor for UnknownPassportElementErrorSource
+func (unknownPassportElementErrorSource *UnknownPassportElementErrorSource) Accept(visitor PassportElementErrorSourceVisitor) {
+	visitor.VisitUnknownPassportElementErrorSource(unknownPassportElementErrorSource)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownPassportElementErrorSource *UnknownPassportElementErrorSource) String() string {
+	return objectString(unknownPassportElementErrorSource)
+}
+
+// LogValue logs the object as its summary
+func (unknownPassportElementErrorSource *UnknownPassportElementErrorSource) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownPassportElementErrorSource))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownPassportElementErrorSource *UnknownPassportElementErrorSource) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownPassportElementErrorSource)
+}
+
+func (unknownPassportElementErrorSource *UnknownPassportElementErrorSource) printText(p *printer) {
+	if unknownPassportElementErrorSource == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownPassportElementErrorSource")
+	p.string("@type", unknownPassportElementErrorSource.Type)
+	p.endObject(state)
+}
+
 func unmarshalPassportElementErrorSource(rawMsg *json.RawMessage) (PassportElementErrorSource, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch PassportElementErrorSourceEnum(objMap["@type"].(string)) {
+	switch PassportElementErrorSourceEnum(typeName) {
 	case PassportElementErrorSourceUnspecifiedType:
 		var passportElementErrorSourceUnspecified PassportElementErrorSourceUnspecified
-		err := json.Unmarshal(*rawMsg, &passportElementErrorSourceUnspecified)
+		err := jsonUnmarshal(*rawMsg, &passportElementErrorSourceUnspecified)
 		return &passportElementErrorSourceUnspecified, err
 
 	case PassportElementErrorSourceDataFieldType:
 		var passportElementErrorSourceDataField PassportElementErrorSourceDataField
-		err := json.Unmarshal(*rawMsg, &passportElementErrorSourceDataField)
+		err := jsonUnmarshal(*rawMsg, &passportElementErrorSourceDataField)
 		return &passportElementErrorSourceDataField, err
 
 	case PassportElementErrorSourceFrontSideType:
 		var passportElementErrorSourceFrontSide PassportElementErrorSourceFrontSide
-		err := json.Unmarshal(*rawMsg, &passportElementErrorSourceFrontSide)
+		err := jsonUnmarshal(*rawMsg, &passportElementErrorSourceFrontSide)
 		return &passportElementErrorSourceFrontSide, err
 
 	case PassportElementErrorSourceReverseSideType:
 		var passportElementErrorSourceReverseSide PassportElementErrorSourceReverseSide
-		err := json.Unmarshal(*rawMsg, &passportElementErrorSourceReverseSide)
+		err := jsonUnmarshal(*rawMsg, &passportElementErrorSourceReverseSide)
 		return &passportElementErrorSourceReverseSide, err
 
 	case PassportElementErrorSourceSelfieType:
 		var passportElementErrorSourceSelfie PassportElementErrorSourceSelfie
-		err := json.Unmarshal(*rawMsg, &passportElementErrorSourceSelfie)
+		err := jsonUnmarshal(*rawMsg, &passportElementErrorSourceSelfie)
 		return &passportElementErrorSourceSelfie, err
 
 	case PassportElementErrorSourceTranslationFileType:
 		var passportElementErrorSourceTranslationFile PassportElementErrorSourceTranslationFile
-		err := json.Unmarshal(*rawMsg, &passportElementErrorSourceTranslationFile)
+		err := jsonUnmarshal(*rawMsg, &passportElementErrorSourceTranslationFile)
 		return &passportElementErrorSourceTranslationFile, err
 
 	case PassportElementErrorSourceTranslationFilesType:
 		var passportElementErrorSourceTranslationFiles PassportElementErrorSourceTranslationFiles
-		err := json.Unmarshal(*rawMsg, &passportElementErrorSourceTranslationFiles)
+		err := jsonUnmarshal(*rawMsg, &passportElementErrorSourceTranslationFiles)
 		return &passportElementErrorSourceTranslationFiles, err
 
 	case PassportElementErrorSourceFileType:
 		var passportElementErrorSourceFile PassportElementErrorSourceFile
-		err := json.Unmarshal(*rawMsg, &passportElementErrorSourceFile)
+		err := jsonUnmarshal(*rawMsg, &passportElementErrorSourceFile)
 		return &passportElementErrorSourceFile, err
 
 	case PassportElementErrorSourceFilesType:
 		var passportElementErrorSourceFiles PassportElementErrorSourceFiles
-		err := json.Unmarshal(*rawMsg, &passportElementErrorSourceFiles)
+		err := jsonUnmarshal(*rawMsg, &passportElementErrorSourceFiles)
 		return &passportElementErrorSourceFiles, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownPassportElementErrorSource{Unknown: unknown}, nil
+	}
+}
+
+func decodePassportElementErrorSource(r *jsonReader) (PassportElementErrorSource, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
 	}
+	return unmarshalPassportElementErrorSource(rawMsg)
 }
 
 // PassportElementErrorSourceUnspecified The element contains an error in an unspecified place. The error will be considered resolved when new data is added
@@ -99,7 +152,6 @@ func (passportElementErrorSourceUnspecified *PassportElementErrorSourceUnspecifi
 }
 
 // NewPassportElementErrorSourceUnspecified creates a new PassportElementErrorSourceUnspecified
-//
 func NewPassportElementErrorSourceUnspecified() *PassportElementErrorSourceUnspecified {
 	passportElementErrorSourceUnspecifiedTemp := PassportElementErrorSourceUnspecified{
 		tdCommon: tdCommon{Type: "passportElementErrorSourceUnspecified"},
@@ -108,11 +160,107 @@ func NewPassportElementErrorSourceUnspecified() *PassportElementErrorSourceUnspe
 	return &passportElementErrorSourceUnspecifiedTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementErrorSourceUnspecified *PassportElementErrorSourceUnspecified) MarshalJSON() ([]byte, error) {
+	return passportElementErrorSourceUnspecified.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementErrorSourceUnspecified *PassportElementErrorSourceUnspecified) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementErrorSourceUnspecified)
+}
+
+func (passportElementErrorSourceUnspecified *PassportElementErrorSourceUnspecified) appendJSON(b []byte) ([]byte, error) {
+	if passportElementErrorSourceUnspecified == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementErrorSourceUnspecified.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementErrorSourceUnspecified.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (passportElementErrorSourceUnspecified *PassportElementErrorSourceUnspecified) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementErrorSourceUnspecified.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementErrorSourceUnspecified.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementErrorSourceUnspecified *PassportElementErrorSourceUnspecified) Clone() *PassportElementErrorSourceUnspecified {
+	if passportElementErrorSourceUnspecified == nil {
+		return nil
+	}
+	clone := *passportElementErrorSourceUnspecified
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementErrorSourceUnspecified *PassportElementErrorSourceUnspecified) Equal(other *PassportElementErrorSourceUnspecified) bool {
+	if passportElementErrorSourceUnspecified == nil || other == nil {
+		return passportElementErrorSourceUnspecified == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementErrorSourceUnspecified *PassportElementErrorSourceUnspecified) Diff(other *PassportElementErrorSourceUnspecified) []Change {
+	return passportElementErrorSourceUnspecified.diff("", other, nil)
+}
+
+func (passportElementErrorSourceUnspecified *PassportElementErrorSourceUnspecified) diff(path string, other *PassportElementErrorSourceUnspecified, changes []Change) []Change {
+	if passportElementErrorSourceUnspecified == nil || other == nil {
+		if passportElementErrorSourceUnspecified != other {
+			changes = append(changes, Change{Path: path, Old: passportElementErrorSourceUnspecified, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementErrorSourceUnspecified *PassportElementErrorSourceUnspecified) String() string {
+	return objectString(passportElementErrorSourceUnspecified)
+}
+
+// LogValue logs the object as its summary
+func (passportElementErrorSourceUnspecified *PassportElementErrorSourceUnspecified) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementErrorSourceUnspecified))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementErrorSourceUnspecified *PassportElementErrorSourceUnspecified) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementErrorSourceUnspecified)
+}
+
+func (passportElementErrorSourceUnspecified *PassportElementErrorSourceUnspecified) printText(p *printer) {
+	if passportElementErrorSourceUnspecified == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementErrorSourceUnspecified")
+	p.endObject(state)
+}
+
 // GetPassportElementErrorSourceEnum return the enum type of this object
 func (passportElementErrorSourceUnspecified *PassportElementErrorSourceUnspecified) GetPassportElementErrorSourceEnum() PassportElementErrorSourceEnum {
 	return PassportElementErrorSourceUnspecifiedType
 }
 
+// Accept calls the method of the visitor for PassportElementErrorSourceUnspecified
+func (passportElementErrorSourceUnspecified *PassportElementErrorSourceUnspecified) Accept(visitor PassportElementErrorSourceVisitor) {
+	visitor.VisitPassportElementErrorSourceUnspecified(passportElementErrorSourceUnspecified)
+}
+
 // PassportElementErrorSourceDataField One of the data fields contains an error. The error will be considered resolved when the value of the field changes
 type PassportElementErrorSourceDataField struct {
 	tdCommon
@@ -136,11 +284,118 @@ func NewPassportElementErrorSourceDataField(fieldName string) *PassportElementEr
 	return &passportElementErrorSourceDataFieldTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementErrorSourceDataField *PassportElementErrorSourceDataField) MarshalJSON() ([]byte, error) {
+	return passportElementErrorSourceDataField.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementErrorSourceDataField *PassportElementErrorSourceDataField) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementErrorSourceDataField)
+}
+
+func (passportElementErrorSourceDataField *PassportElementErrorSourceDataField) appendJSON(b []byte) ([]byte, error) {
+	if passportElementErrorSourceDataField == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementErrorSourceDataField.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementErrorSourceDataField.tdCommon.Extra)
+	b = append(b, `,"field_name":`...)
+	b = appendJSONString(b, passportElementErrorSourceDataField.FieldName)
+	return append(b, '}'), nil
+}
+
+func (passportElementErrorSourceDataField *PassportElementErrorSourceDataField) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementErrorSourceDataField.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementErrorSourceDataField.tdCommon.Extra, err = r.readString()
+		case "field_name":
+			passportElementErrorSourceDataField.FieldName, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementErrorSourceDataField *PassportElementErrorSourceDataField) Clone() *PassportElementErrorSourceDataField {
+	if passportElementErrorSourceDataField == nil {
+		return nil
+	}
+	clone := *passportElementErrorSourceDataField
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementErrorSourceDataField *PassportElementErrorSourceDataField) Equal(other *PassportElementErrorSourceDataField) bool {
+	if passportElementErrorSourceDataField == nil || other == nil {
+		return passportElementErrorSourceDataField == other
+	}
+	if passportElementErrorSourceDataField.FieldName != other.FieldName {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementErrorSourceDataField *PassportElementErrorSourceDataField) Diff(other *PassportElementErrorSourceDataField) []Change {
+	return passportElementErrorSourceDataField.diff("", other, nil)
+}
+
+func (passportElementErrorSourceDataField *PassportElementErrorSourceDataField) diff(path string, other *PassportElementErrorSourceDataField, changes []Change) []Change {
+	if passportElementErrorSourceDataField == nil || other == nil {
+		if passportElementErrorSourceDataField != other {
+			changes = append(changes, Change{Path: path, Old: passportElementErrorSourceDataField, New: other})
+		}
+		return changes
+	}
+	if passportElementErrorSourceDataField.FieldName != other.FieldName {
+		changes = append(changes, Change{Path: fieldPath(path, "field_name"), Old: passportElementErrorSourceDataField.FieldName, New: other.FieldName})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementErrorSourceDataField *PassportElementErrorSourceDataField) String() string {
+	return objectString(passportElementErrorSourceDataField)
+}
+
+// LogValue logs the object as its summary
+func (passportElementErrorSourceDataField *PassportElementErrorSourceDataField) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementErrorSourceDataField))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementErrorSourceDataField *PassportElementErrorSourceDataField) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementErrorSourceDataField)
+}
+
+func (passportElementErrorSourceDataField *PassportElementErrorSourceDataField) printText(p *printer) {
+	if passportElementErrorSourceDataField == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementErrorSourceDataField")
+	p.string("field_name", passportElementErrorSourceDataField.FieldName)
+	p.endObject(state)
+}
+
 // GetPassportElementErrorSourceEnum return the enum type of this object
 func (passportElementErrorSourceDataField *PassportElementErrorSourceDataField) GetPassportElementErrorSourceEnum() PassportElementErrorSourceEnum {
 	return PassportElementErrorSourceDataFieldType
 }
 
+// Accept calls the method of the visitor for PassportElementErrorSourceDataField
+func (passportElementErrorSourceDataField *PassportElementErrorSourceDataField) Accept(visitor PassportElementErrorSourceVisitor) {
+	visitor.VisitPassportElementErrorSourceDataField(passportElementErrorSourceDataField)
+}
+
 // PassportElementErrorSourceFrontSide The front side of the document contains an error. The error will be considered resolved when the file with the front side changes
 type PassportElementErrorSourceFrontSide struct {
 	tdCommon
@@ -152,7 +407,6 @@ func (passportElementErrorSourceFrontSide *PassportElementErrorSourceFrontSide)
 }
 
 // NewPassportElementErrorSourceFrontSide creates a new PassportElementErrorSourceFrontSide
-//
 func NewPassportElementErrorSourceFrontSide() *PassportElementErrorSourceFrontSide {
 	passportElementErrorSourceFrontSideTemp := PassportElementErrorSourceFrontSide{
 		tdCommon: tdCommon{Type: "passportElementErrorSourceFrontSide"},
@@ -161,11 +415,107 @@ func NewPassportElementErrorSourceFrontSide() *PassportElementErrorSourceFrontSi
 	return &passportElementErrorSourceFrontSideTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementErrorSourceFrontSide *PassportElementErrorSourceFrontSide) MarshalJSON() ([]byte, error) {
+	return passportElementErrorSourceFrontSide.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementErrorSourceFrontSide *PassportElementErrorSourceFrontSide) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementErrorSourceFrontSide)
+}
+
+func (passportElementErrorSourceFrontSide *PassportElementErrorSourceFrontSide) appendJSON(b []byte) ([]byte, error) {
+	if passportElementErrorSourceFrontSide == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementErrorSourceFrontSide.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementErrorSourceFrontSide.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (passportElementErrorSourceFrontSide *PassportElementErrorSourceFrontSide) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementErrorSourceFrontSide.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementErrorSourceFrontSide.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementErrorSourceFrontSide *PassportElementErrorSourceFrontSide) Clone() *PassportElementErrorSourceFrontSide {
+	if passportElementErrorSourceFrontSide == nil {
+		return nil
+	}
+	clone := *passportElementErrorSourceFrontSide
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementErrorSourceFrontSide *PassportElementErrorSourceFrontSide) Equal(other *PassportElementErrorSourceFrontSide) bool {
+	if passportElementErrorSourceFrontSide == nil || other == nil {
+		return passportElementErrorSourceFrontSide == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementErrorSourceFrontSide *PassportElementErrorSourceFrontSide) Diff(other *PassportElementErrorSourceFrontSide) []Change {
+	return passportElementErrorSourceFrontSide.diff("", other, nil)
+}
+
+func (passportElementErrorSourceFrontSide *PassportElementErrorSourceFrontSide) diff(path string, other *PassportElementErrorSourceFrontSide, changes []Change) []Change {
+	if passportElementErrorSourceFrontSide == nil || other == nil {
+		if passportElementErrorSourceFrontSide != other {
+			changes = append(changes, Change{Path: path, Old: passportElementErrorSourceFrontSide, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementErrorSourceFrontSide *PassportElementErrorSourceFrontSide) String() string {
+	return objectString(passportElementErrorSourceFrontSide)
+}
+
+// LogValue logs the object as its summary
+func (passportElementErrorSourceFrontSide *PassportElementErrorSourceFrontSide) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementErrorSourceFrontSide))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementErrorSourceFrontSide *PassportElementErrorSourceFrontSide) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementErrorSourceFrontSide)
+}
+
+func (passportElementErrorSourceFrontSide *PassportElementErrorSourceFrontSide) printText(p *printer) {
+	if passportElementErrorSourceFrontSide == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementErrorSourceFrontSide")
+	p.endObject(state)
+}
+
 // GetPassportElementErrorSourceEnum return the enum type of this object
 func (passportElementErrorSourceFrontSide *PassportElementErrorSourceFrontSide) GetPassportElementErrorSourceEnum() PassportElementErrorSourceEnum {
 	return PassportElementErrorSourceFrontSideType
 }
 
+// Accept calls the method of the visitor for PassportElementErrorSourceFrontSide
+func (passportElementErrorSourceFrontSide *PassportElementErrorSourceFrontSide) Accept(visitor PassportElementErrorSourceVisitor) {
+	visitor.VisitPassportElementErrorSourceFrontSide(passportElementErrorSourceFrontSide)
+}
+
 // PassportElementErrorSourceReverseSide The reverse side of the document contains an error. The error will be considered resolved when the file with the reverse side changes
 type PassportElementErrorSourceReverseSide struct {
 	tdCommon
@@ -177,7 +527,6 @@ func (passportElementErrorSourceReverseSide *PassportElementErrorSourceReverseSi
 }
 
 // NewPassportElementErrorSourceReverseSide creates a new PassportElementErrorSourceReverseSide
-//
 func NewPassportElementErrorSourceReverseSide() *PassportElementErrorSourceReverseSide {
 	passportElementErrorSourceReverseSideTemp := PassportElementErrorSourceReverseSide{
 		tdCommon: tdCommon{Type: "passportElementErrorSourceReverseSide"},
@@ -186,11 +535,107 @@ func NewPassportElementErrorSourceReverseSide() *PassportElementErrorSourceRever
 	return &passportElementErrorSourceReverseSideTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementErrorSourceReverseSide *PassportElementErrorSourceReverseSide) MarshalJSON() ([]byte, error) {
+	return passportElementErrorSourceReverseSide.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementErrorSourceReverseSide *PassportElementErrorSourceReverseSide) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementErrorSourceReverseSide)
+}
+
+func (passportElementErrorSourceReverseSide *PassportElementErrorSourceReverseSide) appendJSON(b []byte) ([]byte, error) {
+	if passportElementErrorSourceReverseSide == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementErrorSourceReverseSide.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementErrorSourceReverseSide.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (passportElementErrorSourceReverseSide *PassportElementErrorSourceReverseSide) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementErrorSourceReverseSide.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementErrorSourceReverseSide.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementErrorSourceReverseSide *PassportElementErrorSourceReverseSide) Clone() *PassportElementErrorSourceReverseSide {
+	if passportElementErrorSourceReverseSide == nil {
+		return nil
+	}
+	clone := *passportElementErrorSourceReverseSide
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementErrorSourceReverseSide *PassportElementErrorSourceReverseSide) Equal(other *PassportElementErrorSourceReverseSide) bool {
+	if passportElementErrorSourceReverseSide == nil || other == nil {
+		return passportElementErrorSourceReverseSide == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementErrorSourceReverseSide *PassportElementErrorSourceReverseSide) Diff(other *PassportElementErrorSourceReverseSide) []Change {
+	return passportElementErrorSourceReverseSide.diff("", other, nil)
+}
+
+func (passportElementErrorSourceReverseSide *PassportElementErrorSourceReverseSide) diff(path string, other *PassportElementErrorSourceReverseSide, changes []Change) []Change {
+	if passportElementErrorSourceReverseSide == nil || other == nil {
+		if passportElementErrorSourceReverseSide != other {
+			changes = append(changes, Change{Path: path, Old: passportElementErrorSourceReverseSide, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementErrorSourceReverseSide *PassportElementErrorSourceReverseSide) String() string {
+	return objectString(passportElementErrorSourceReverseSide)
+}
+
+// LogValue logs the object as its summary
+func (passportElementErrorSourceReverseSide *PassportElementErrorSourceReverseSide) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementErrorSourceReverseSide))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementErrorSourceReverseSide *PassportElementErrorSourceReverseSide) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementErrorSourceReverseSide)
+}
+
+func (passportElementErrorSourceReverseSide *PassportElementErrorSourceReverseSide) printText(p *printer) {
+	if passportElementErrorSourceReverseSide == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementErrorSourceReverseSide")
+	p.endObject(state)
+}
+
 // GetPassportElementErrorSourceEnum return the enum type of this object
 func (passportElementErrorSourceReverseSide *PassportElementErrorSourceReverseSide) GetPassportElementErrorSourceEnum() PassportElementErrorSourceEnum {
 	return PassportElementErrorSourceReverseSideType
 }
 
+// Accept calls the method of the visitor for PassportElementErrorSourceReverseSide
+func (passportElementErrorSourceReverseSide *PassportElementErrorSourceReverseSide) Accept(visitor PassportElementErrorSourceVisitor) {
+	visitor.VisitPassportElementErrorSourceReverseSide(passportElementErrorSourceReverseSide)
+}
+
 // PassportElementErrorSourceSelfie The selfie with the document contains an error. The error will be considered resolved when the file with the selfie changes
 type PassportElementErrorSourceSelfie struct {
 	tdCommon
@@ -202,7 +647,6 @@ func (passportElementErrorSourceSelfie *PassportElementErrorSourceSelfie) Messag
 }
 
 // NewPassportElementErrorSourceSelfie creates a new PassportElementErrorSourceSelfie
-//
 func NewPassportElementErrorSourceSelfie() *PassportElementErrorSourceSelfie {
 	passportElementErrorSourceSelfieTemp := PassportElementErrorSourceSelfie{
 		tdCommon: tdCommon{Type: "passportElementErrorSourceSelfie"},
@@ -211,11 +655,107 @@ func NewPassportElementErrorSourceSelfie() *PassportElementErrorSourceSelfie {
 	return &passportElementErrorSourceSelfieTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementErrorSourceSelfie *PassportElementErrorSourceSelfie) MarshalJSON() ([]byte, error) {
+	return passportElementErrorSourceSelfie.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementErrorSourceSelfie *PassportElementErrorSourceSelfie) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementErrorSourceSelfie)
+}
+
+func (passportElementErrorSourceSelfie *PassportElementErrorSourceSelfie) appendJSON(b []byte) ([]byte, error) {
+	if passportElementErrorSourceSelfie == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementErrorSourceSelfie.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementErrorSourceSelfie.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (passportElementErrorSourceSelfie *PassportElementErrorSourceSelfie) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementErrorSourceSelfie.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementErrorSourceSelfie.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementErrorSourceSelfie *PassportElementErrorSourceSelfie) Clone() *PassportElementErrorSourceSelfie {
+	if passportElementErrorSourceSelfie == nil {
+		return nil
+	}
+	clone := *passportElementErrorSourceSelfie
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementErrorSourceSelfie *PassportElementErrorSourceSelfie) Equal(other *PassportElementErrorSourceSelfie) bool {
+	if passportElementErrorSourceSelfie == nil || other == nil {
+		return passportElementErrorSourceSelfie == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementErrorSourceSelfie *PassportElementErrorSourceSelfie) Diff(other *PassportElementErrorSourceSelfie) []Change {
+	return passportElementErrorSourceSelfie.diff("", other, nil)
+}
+
+func (passportElementErrorSourceSelfie *PassportElementErrorSourceSelfie) diff(path string, other *PassportElementErrorSourceSelfie, changes []Change) []Change {
+	if passportElementErrorSourceSelfie == nil || other == nil {
+		if passportElementErrorSourceSelfie != other {
+			changes = append(changes, Change{Path: path, Old: passportElementErrorSourceSelfie, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementErrorSourceSelfie *PassportElementErrorSourceSelfie) String() string {
+	return objectString(passportElementErrorSourceSelfie)
+}
+
+// LogValue logs the object as its summary
+func (passportElementErrorSourceSelfie *PassportElementErrorSourceSelfie) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementErrorSourceSelfie))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementErrorSourceSelfie *PassportElementErrorSourceSelfie) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementErrorSourceSelfie)
+}
+
+func (passportElementErrorSourceSelfie *PassportElementErrorSourceSelfie) printText(p *printer) {
+	if passportElementErrorSourceSelfie == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementErrorSourceSelfie")
+	p.endObject(state)
+}
+
 // GetPassportElementErrorSourceEnum return the enum type of this object
 func (passportElementErrorSourceSelfie *PassportElementErrorSourceSelfie) GetPassportElementErrorSourceEnum() PassportElementErrorSourceEnum {
 	return PassportElementErrorSourceSelfieType
 }
 
+// Accept calls the method of the visitor for PassportElementErrorSourceSelfie
+func (passportElementErrorSourceSelfie *PassportElementErrorSourceSelfie) Accept(visitor PassportElementErrorSourceVisitor) {
+	visitor.VisitPassportElementErrorSourceSelfie(passportElementErrorSourceSelfie)
+}
+
 // PassportElementErrorSourceTranslationFile One of files with the translation of the document contains an error. The error will be considered resolved when the file changes
 type PassportElementErrorSourceTranslationFile struct {
 	tdCommon
@@ -239,11 +779,118 @@ func NewPassportElementErrorSourceTranslationFile(fileIndex int32) *PassportElem
 	return &passportElementErrorSourceTranslationFileTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementErrorSourceTranslationFile *PassportElementErrorSourceTranslationFile) MarshalJSON() ([]byte, error) {
+	return passportElementErrorSourceTranslationFile.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementErrorSourceTranslationFile *PassportElementErrorSourceTranslationFile) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementErrorSourceTranslationFile)
+}
+
+func (passportElementErrorSourceTranslationFile *PassportElementErrorSourceTranslationFile) appendJSON(b []byte) ([]byte, error) {
+	if passportElementErrorSourceTranslationFile == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementErrorSourceTranslationFile.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementErrorSourceTranslationFile.tdCommon.Extra)
+	b = append(b, `,"file_index":`...)
+	b = appendJSONInt(b, int64(passportElementErrorSourceTranslationFile.FileIndex))
+	return append(b, '}'), nil
+}
+
+func (passportElementErrorSourceTranslationFile *PassportElementErrorSourceTranslationFile) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementErrorSourceTranslationFile.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementErrorSourceTranslationFile.tdCommon.Extra, err = r.readString()
+		case "file_index":
+			passportElementErrorSourceTranslationFile.FileIndex, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementErrorSourceTranslationFile *PassportElementErrorSourceTranslationFile) Clone() *PassportElementErrorSourceTranslationFile {
+	if passportElementErrorSourceTranslationFile == nil {
+		return nil
+	}
+	clone := *passportElementErrorSourceTranslationFile
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementErrorSourceTranslationFile *PassportElementErrorSourceTranslationFile) Equal(other *PassportElementErrorSourceTranslationFile) bool {
+	if passportElementErrorSourceTranslationFile == nil || other == nil {
+		return passportElementErrorSourceTranslationFile == other
+	}
+	if passportElementErrorSourceTranslationFile.FileIndex != other.FileIndex {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementErrorSourceTranslationFile *PassportElementErrorSourceTranslationFile) Diff(other *PassportElementErrorSourceTranslationFile) []Change {
+	return passportElementErrorSourceTranslationFile.diff("", other, nil)
+}
+
+func (passportElementErrorSourceTranslationFile *PassportElementErrorSourceTranslationFile) diff(path string, other *PassportElementErrorSourceTranslationFile, changes []Change) []Change {
+	if passportElementErrorSourceTranslationFile == nil || other == nil {
+		if passportElementErrorSourceTranslationFile != other {
+			changes = append(changes, Change{Path: path, Old: passportElementErrorSourceTranslationFile, New: other})
+		}
+		return changes
+	}
+	if passportElementErrorSourceTranslationFile.FileIndex != other.FileIndex {
+		changes = append(changes, Change{Path: fieldPath(path, "file_index"), Old: passportElementErrorSourceTranslationFile.FileIndex, New: other.FileIndex})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementErrorSourceTranslationFile *PassportElementErrorSourceTranslationFile) String() string {
+	return objectString(passportElementErrorSourceTranslationFile)
+}
+
+// LogValue logs the object as its summary
+func (passportElementErrorSourceTranslationFile *PassportElementErrorSourceTranslationFile) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementErrorSourceTranslationFile))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementErrorSourceTranslationFile *PassportElementErrorSourceTranslationFile) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementErrorSourceTranslationFile)
+}
+
+func (passportElementErrorSourceTranslationFile *PassportElementErrorSourceTranslationFile) printText(p *printer) {
+	if passportElementErrorSourceTranslationFile == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementErrorSourceTranslationFile")
+	p.int("file_index", int64(passportElementErrorSourceTranslationFile.FileIndex))
+	p.endObject(state)
+}
+
 // GetPassportElementErrorSourceEnum return the enum type of this object
 func (passportElementErrorSourceTranslationFile *PassportElementErrorSourceTranslationFile) GetPassportElementErrorSourceEnum() PassportElementErrorSourceEnum {
 	return PassportElementErrorSourceTranslationFileType
 }
 
+// Accept calls the method of the visitor for PassportElementErrorSourceTranslationFile
+func (passportElementErrorSourceTranslationFile *PassportElementErrorSourceTranslationFile) Accept(visitor PassportElementErrorSourceVisitor) {
+	visitor.VisitPassportElementErrorSourceTranslationFile(passportElementErrorSourceTranslationFile)
+}
+
 // PassportElementErrorSourceTranslationFiles The translation of the document contains an error. The error will be considered resolved when the list of translation files changes
 type PassportElementErrorSourceTranslationFiles struct {
 	tdCommon
@@ -255,7 +902,6 @@ func (passportElementErrorSourceTranslationFiles *PassportElementErrorSourceTran
 }
 
 // NewPassportElementErrorSourceTranslationFiles creates a new PassportElementErrorSourceTranslationFiles
-//
 func NewPassportElementErrorSourceTranslationFiles() *PassportElementErrorSourceTranslationFiles {
 	passportElementErrorSourceTranslationFilesTemp := PassportElementErrorSourceTranslationFiles{
 		tdCommon: tdCommon{Type: "passportElementErrorSourceTranslationFiles"},
@@ -264,11 +910,107 @@ func NewPassportElementErrorSourceTranslationFiles() *PassportElementErrorSource
 	return &passportElementErrorSourceTranslationFilesTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementErrorSourceTranslationFiles *PassportElementErrorSourceTranslationFiles) MarshalJSON() ([]byte, error) {
+	return passportElementErrorSourceTranslationFiles.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementErrorSourceTranslationFiles *PassportElementErrorSourceTranslationFiles) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementErrorSourceTranslationFiles)
+}
+
+func (passportElementErrorSourceTranslationFiles *PassportElementErrorSourceTranslationFiles) appendJSON(b []byte) ([]byte, error) {
+	if passportElementErrorSourceTranslationFiles == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementErrorSourceTranslationFiles.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementErrorSourceTranslationFiles.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (passportElementErrorSourceTranslationFiles *PassportElementErrorSourceTranslationFiles) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementErrorSourceTranslationFiles.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementErrorSourceTranslationFiles.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementErrorSourceTranslationFiles *PassportElementErrorSourceTranslationFiles) Clone() *PassportElementErrorSourceTranslationFiles {
+	if passportElementErrorSourceTranslationFiles == nil {
+		return nil
+	}
+	clone := *passportElementErrorSourceTranslationFiles
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementErrorSourceTranslationFiles *PassportElementErrorSourceTranslationFiles) Equal(other *PassportElementErrorSourceTranslationFiles) bool {
+	if passportElementErrorSourceTranslationFiles == nil || other == nil {
+		return passportElementErrorSourceTranslationFiles == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementErrorSourceTranslationFiles *PassportElementErrorSourceTranslationFiles) Diff(other *PassportElementErrorSourceTranslationFiles) []Change {
+	return passportElementErrorSourceTranslationFiles.diff("", other, nil)
+}
+
+func (passportElementErrorSourceTranslationFiles *PassportElementErrorSourceTranslationFiles) diff(path string, other *PassportElementErrorSourceTranslationFiles, changes []Change) []Change {
+	if passportElementErrorSourceTranslationFiles == nil || other == nil {
+		if passportElementErrorSourceTranslationFiles != other {
+			changes = append(changes, Change{Path: path, Old: passportElementErrorSourceTranslationFiles, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementErrorSourceTranslationFiles *PassportElementErrorSourceTranslationFiles) String() string {
+	return objectString(passportElementErrorSourceTranslationFiles)
+}
+
+// LogValue logs the object as its summary
+func (passportElementErrorSourceTranslationFiles *PassportElementErrorSourceTranslationFiles) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementErrorSourceTranslationFiles))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementErrorSourceTranslationFiles *PassportElementErrorSourceTranslationFiles) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementErrorSourceTranslationFiles)
+}
+
+func (passportElementErrorSourceTranslationFiles *PassportElementErrorSourceTranslationFiles) printText(p *printer) {
+	if passportElementErrorSourceTranslationFiles == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementErrorSourceTranslationFiles")
+	p.endObject(state)
+}
+
 // GetPassportElementErrorSourceEnum return the enum type of this object
 func (passportElementErrorSourceTranslationFiles *PassportElementErrorSourceTranslationFiles) GetPassportElementErrorSourceEnum() PassportElementErrorSourceEnum {
 	return PassportElementErrorSourceTranslationFilesType
 }
 
+// Accept calls the method of the visitor for PassportElementErrorSourceTranslationFiles
+func (passportElementErrorSourceTranslationFiles *PassportElementErrorSourceTranslationFiles) Accept(visitor PassportElementErrorSourceVisitor) {
+	visitor.VisitPassportElementErrorSourceTranslationFiles(passportElementErrorSourceTranslationFiles)
+}
+
 // PassportElementErrorSourceFile The file contains an error. The error will be considered resolved when the file changes
 type PassportElementErrorSourceFile struct {
 	tdCommon
@@ -292,11 +1034,118 @@ func NewPassportElementErrorSourceFile(fileIndex int32) *PassportElementErrorSou
 	return &passportElementErrorSourceFileTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementErrorSourceFile *PassportElementErrorSourceFile) MarshalJSON() ([]byte, error) {
+	return passportElementErrorSourceFile.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementErrorSourceFile *PassportElementErrorSourceFile) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementErrorSourceFile)
+}
+
+func (passportElementErrorSourceFile *PassportElementErrorSourceFile) appendJSON(b []byte) ([]byte, error) {
+	if passportElementErrorSourceFile == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementErrorSourceFile.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementErrorSourceFile.tdCommon.Extra)
+	b = append(b, `,"file_index":`...)
+	b = appendJSONInt(b, int64(passportElementErrorSourceFile.FileIndex))
+	return append(b, '}'), nil
+}
+
+func (passportElementErrorSourceFile *PassportElementErrorSourceFile) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementErrorSourceFile.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementErrorSourceFile.tdCommon.Extra, err = r.readString()
+		case "file_index":
+			passportElementErrorSourceFile.FileIndex, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementErrorSourceFile *PassportElementErrorSourceFile) Clone() *PassportElementErrorSourceFile {
+	if passportElementErrorSourceFile == nil {
+		return nil
+	}
+	clone := *passportElementErrorSourceFile
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementErrorSourceFile *PassportElementErrorSourceFile) Equal(other *PassportElementErrorSourceFile) bool {
+	if passportElementErrorSourceFile == nil || other == nil {
+		return passportElementErrorSourceFile == other
+	}
+	if passportElementErrorSourceFile.FileIndex != other.FileIndex {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementErrorSourceFile *PassportElementErrorSourceFile) Diff(other *PassportElementErrorSourceFile) []Change {
+	return passportElementErrorSourceFile.diff("", other, nil)
+}
+
+func (passportElementErrorSourceFile *PassportElementErrorSourceFile) diff(path string, other *PassportElementErrorSourceFile, changes []Change) []Change {
+	if passportElementErrorSourceFile == nil || other == nil {
+		if passportElementErrorSourceFile != other {
+			changes = append(changes, Change{Path: path, Old: passportElementErrorSourceFile, New: other})
+		}
+		return changes
+	}
+	if passportElementErrorSourceFile.FileIndex != other.FileIndex {
+		changes = append(changes, Change{Path: fieldPath(path, "file_index"), Old: passportElementErrorSourceFile.FileIndex, New: other.FileIndex})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementErrorSourceFile *PassportElementErrorSourceFile) String() string {
+	return objectString(passportElementErrorSourceFile)
+}
+
+// LogValue logs the object as its summary
+func (passportElementErrorSourceFile *PassportElementErrorSourceFile) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementErrorSourceFile))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementErrorSourceFile *PassportElementErrorSourceFile) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementErrorSourceFile)
+}
+
+func (passportElementErrorSourceFile *PassportElementErrorSourceFile) printText(p *printer) {
+	if passportElementErrorSourceFile == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementErrorSourceFile")
+	p.int("file_index", int64(passportElementErrorSourceFile.FileIndex))
+	p.endObject(state)
+}
+
 // GetPassportElementErrorSourceEnum return the enum type of this object
 func (passportElementErrorSourceFile *PassportElementErrorSourceFile) GetPassportElementErrorSourceEnum() PassportElementErrorSourceEnum {
 	return PassportElementErrorSourceFileType
 }
 
+// Accept calls the method of the visitor for PassportElementErrorSourceFile
+func (passportElementErrorSourceFile *PassportElementErrorSourceFile) Accept(visitor PassportElementErrorSourceVisitor) {
+	visitor.VisitPassportElementErrorSourceFile(passportElementErrorSourceFile)
+}
+
 // PassportElementErrorSourceFiles The list of attached files contains an error. The error will be considered resolved when the list of files changes
 type PassportElementErrorSourceFiles struct {
 	tdCommon
@@ -308,7 +1157,6 @@ func (passportElementErrorSourceFiles *PassportElementErrorSourceFiles) MessageT
 }
 
 // NewPassportElementErrorSourceFiles creates a new PassportElementErrorSourceFiles
-//
 func NewPassportElementErrorSourceFiles() *PassportElementErrorSourceFiles {
 	passportElementErrorSourceFilesTemp := PassportElementErrorSourceFiles{
 		tdCommon: tdCommon{Type: "passportElementErrorSourceFiles"},
@@ -317,7 +1165,303 @@ func NewPassportElementErrorSourceFiles() *PassportElementErrorSourceFiles {
 	return &passportElementErrorSourceFilesTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementErrorSourceFiles *PassportElementErrorSourceFiles) MarshalJSON() ([]byte, error) {
+	return passportElementErrorSourceFiles.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementErrorSourceFiles *PassportElementErrorSourceFiles) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementErrorSourceFiles)
+}
+
+func (passportElementErrorSourceFiles *PassportElementErrorSourceFiles) appendJSON(b []byte) ([]byte, error) {
+	if passportElementErrorSourceFiles == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementErrorSourceFiles.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementErrorSourceFiles.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (passportElementErrorSourceFiles *PassportElementErrorSourceFiles) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementErrorSourceFiles.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementErrorSourceFiles.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementErrorSourceFiles *PassportElementErrorSourceFiles) Clone() *PassportElementErrorSourceFiles {
+	if passportElementErrorSourceFiles == nil {
+		return nil
+	}
+	clone := *passportElementErrorSourceFiles
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementErrorSourceFiles *PassportElementErrorSourceFiles) Equal(other *PassportElementErrorSourceFiles) bool {
+	if passportElementErrorSourceFiles == nil || other == nil {
+		return passportElementErrorSourceFiles == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementErrorSourceFiles *PassportElementErrorSourceFiles) Diff(other *PassportElementErrorSourceFiles) []Change {
+	return passportElementErrorSourceFiles.diff("", other, nil)
+}
+
+func (passportElementErrorSourceFiles *PassportElementErrorSourceFiles) diff(path string, other *PassportElementErrorSourceFiles, changes []Change) []Change {
+	if passportElementErrorSourceFiles == nil || other == nil {
+		if passportElementErrorSourceFiles != other {
+			changes = append(changes, Change{Path: path, Old: passportElementErrorSourceFiles, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementErrorSourceFiles *PassportElementErrorSourceFiles) String() string {
+	return objectString(passportElementErrorSourceFiles)
+}
+
+// LogValue logs the object as its summary
+func (passportElementErrorSourceFiles *PassportElementErrorSourceFiles) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementErrorSourceFiles))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementErrorSourceFiles *PassportElementErrorSourceFiles) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementErrorSourceFiles)
+}
+
+func (passportElementErrorSourceFiles *PassportElementErrorSourceFiles) printText(p *printer) {
+	if passportElementErrorSourceFiles == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementErrorSourceFiles")
+	p.endObject(state)
+}
+
 // GetPassportElementErrorSourceEnum return the enum type of this object
 func (passportElementErrorSourceFiles *PassportElementErrorSourceFiles) GetPassportElementErrorSourceEnum() PassportElementErrorSourceEnum {
 	return PassportElementErrorSourceFilesType
 }
+
+// Accept calls the method of the visitor for PassportElementErrorSourceFiles
+func (passportElementErrorSourceFiles *PassportElementErrorSourceFiles) Accept(visitor PassportElementErrorSourceVisitor) {
+	visitor.VisitPassportElementErrorSourceFiles(passportElementErrorSourceFiles)
+}
+
+// PassportElementErrorSourceVisitor visits every type of PassportElementErrorSource, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to PassportElementErrorSource, until they handle it too.
+type PassportElementErrorSourceVisitor interface {
+	VisitPassportElementErrorSourceUnspecified(passportElementErrorSourceUnspecified *PassportElementErrorSourceUnspecified)
+	VisitPassportElementErrorSourceDataField(passportElementErrorSourceDataField *PassportElementErrorSourceDataField)
+	VisitPassportElementErrorSourceFrontSide(passportElementErrorSourceFrontSide *PassportElementErrorSourceFrontSide)
+	VisitPassportElementErrorSourceReverseSide(passportElementErrorSourceReverseSide *PassportElementErrorSourceReverseSide)
+	VisitPassportElementErrorSourceSelfie(passportElementErrorSourceSelfie *PassportElementErrorSourceSelfie)
+	VisitPassportElementErrorSourceTranslationFile(passportElementErrorSourceTranslationFile *PassportElementErrorSourceTranslationFile)
+	VisitPassportElementErrorSourceTranslationFiles(passportElementErrorSourceTranslationFiles *PassportElementErrorSourceTranslationFiles)
+	VisitPassportElementErrorSourceFile(passportElementErrorSourceFile *PassportElementErrorSourceFile)
+	VisitPassportElementErrorSourceFiles(passportElementErrorSourceFiles *PassportElementErrorSourceFiles)
+	VisitUnknownPassportElementErrorSource(unknownPassportElementErrorSource *UnknownPassportElementErrorSource)
+}
+
+// PassportElementErrorSourceCases holds the function MatchPassportElementErrorSource calls for each type of PassportElementErrorSource;
+// types without a function are passed to the default case
+type PassportElementErrorSourceCases[R any] struct {
+	PassportElementErrorSourceUnspecified      func(passportElementErrorSourceUnspecified *PassportElementErrorSourceUnspecified) R
+	PassportElementErrorSourceDataField        func(passportElementErrorSourceDataField *PassportElementErrorSourceDataField) R
+	PassportElementErrorSourceFrontSide        func(passportElementErrorSourceFrontSide *PassportElementErrorSourceFrontSide) R
+	PassportElementErrorSourceReverseSide      func(passportElementErrorSourceReverseSide *PassportElementErrorSourceReverseSide) R
+	PassportElementErrorSourceSelfie           func(passportElementErrorSourceSelfie *PassportElementErrorSourceSelfie) R
+	PassportElementErrorSourceTranslationFile  func(passportElementErrorSourceTranslationFile *PassportElementErrorSourceTranslationFile) R
+	PassportElementErrorSourceTranslationFiles func(passportElementErrorSourceTranslationFiles *PassportElementErrorSourceTranslationFiles) R
+	PassportElementErrorSourceFile             func(passportElementErrorSourceFile *PassportElementErrorSourceFile) R
+	PassportElementErrorSourceFiles            func(passportElementErrorSourceFiles *PassportElementErrorSourceFiles) R
+}
+
+// MatchPassportElementErrorSource calls the case of the type of passportElementErrorSource, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement PassportElementErrorSourceVisitor
+// to have the compiler check every type is handled.
+func MatchPassportElementErrorSource[R any](passportElementErrorSource PassportElementErrorSource, cases PassportElementErrorSourceCases[R], defaultCase func(passportElementErrorSource PassportElementErrorSource) R) R {
+	switch value := passportElementErrorSource.(type) {
+	case *PassportElementErrorSourceUnspecified:
+		if cases.PassportElementErrorSourceUnspecified != nil {
+			return cases.PassportElementErrorSourceUnspecified(value)
+		}
+	case *PassportElementErrorSourceDataField:
+		if cases.PassportElementErrorSourceDataField != nil {
+			return cases.PassportElementErrorSourceDataField(value)
+		}
+	case *PassportElementErrorSourceFrontSide:
+		if cases.PassportElementErrorSourceFrontSide != nil {
+			return cases.PassportElementErrorSourceFrontSide(value)
+		}
+	case *PassportElementErrorSourceReverseSide:
+		if cases.PassportElementErrorSourceReverseSide != nil {
+			return cases.PassportElementErrorSourceReverseSide(value)
+		}
+	case *PassportElementErrorSourceSelfie:
+		if cases.PassportElementErrorSourceSelfie != nil {
+			return cases.PassportElementErrorSourceSelfie(value)
+		}
+	case *PassportElementErrorSourceTranslationFile:
+		if cases.PassportElementErrorSourceTranslationFile != nil {
+			return cases.PassportElementErrorSourceTranslationFile(value)
+		}
+	case *PassportElementErrorSourceTranslationFiles:
+		if cases.PassportElementErrorSourceTranslationFiles != nil {
+			return cases.PassportElementErrorSourceTranslationFiles(value)
+		}
+	case *PassportElementErrorSourceFile:
+		if cases.PassportElementErrorSourceFile != nil {
+			return cases.PassportElementErrorSourceFile(value)
+		}
+	case *PassportElementErrorSourceFiles:
+		if cases.PassportElementErrorSourceFiles != nil {
+			return cases.PassportElementErrorSourceFiles(value)
+		}
+	}
+	return defaultCase(passportElementErrorSource)
+}
+
+// Clone returns a deep copy of the object
+func (unknownPassportElementErrorSource *UnknownPassportElementErrorSource) Clone() *UnknownPassportElementErrorSource {
+	if unknownPassportElementErrorSource == nil {
+		return nil
+	}
+	return &UnknownPassportElementErrorSource{Unknown: unknownPassportElementErrorSource.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownPassportElementErrorSource *UnknownPassportElementErrorSource) Equal(other *UnknownPassportElementErrorSource) bool {
+	if unknownPassportElementErrorSource == nil || other == nil {
+		return unknownPassportElementErrorSource == other
+	}
+	return unknownPassportElementErrorSource.Unknown.equal(other.Unknown)
+}
+
+func clonePassportElementErrorSource(passportElementErrorSource PassportElementErrorSource) PassportElementErrorSource {
+	switch value := passportElementErrorSource.(type) {
+	case *PassportElementErrorSourceUnspecified:
+		return value.Clone()
+	case *PassportElementErrorSourceDataField:
+		return value.Clone()
+	case *PassportElementErrorSourceFrontSide:
+		return value.Clone()
+	case *PassportElementErrorSourceReverseSide:
+		return value.Clone()
+	case *PassportElementErrorSourceSelfie:
+		return value.Clone()
+	case *PassportElementErrorSourceTranslationFile:
+		return value.Clone()
+	case *PassportElementErrorSourceTranslationFiles:
+		return value.Clone()
+	case *PassportElementErrorSourceFile:
+		return value.Clone()
+	case *PassportElementErrorSourceFiles:
+		return value.Clone()
+	case *UnknownPassportElementErrorSource:
+		return value.Clone()
+	}
+	return passportElementErrorSource
+}
+
+func equalPassportElementErrorSource(a PassportElementErrorSource, b PassportElementErrorSource) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *PassportElementErrorSourceUnspecified:
+		b, ok := b.(*PassportElementErrorSourceUnspecified)
+		return ok && a.Equal(b)
+	case *PassportElementErrorSourceDataField:
+		b, ok := b.(*PassportElementErrorSourceDataField)
+		return ok && a.Equal(b)
+	case *PassportElementErrorSourceFrontSide:
+		b, ok := b.(*PassportElementErrorSourceFrontSide)
+		return ok && a.Equal(b)
+	case *PassportElementErrorSourceReverseSide:
+		b, ok := b.(*PassportElementErrorSourceReverseSide)
+		return ok && a.Equal(b)
+	case *PassportElementErrorSourceSelfie:
+		b, ok := b.(*PassportElementErrorSourceSelfie)
+		return ok && a.Equal(b)
+	case *PassportElementErrorSourceTranslationFile:
+		b, ok := b.(*PassportElementErrorSourceTranslationFile)
+		return ok && a.Equal(b)
+	case *PassportElementErrorSourceTranslationFiles:
+		b, ok := b.(*PassportElementErrorSourceTranslationFiles)
+		return ok && a.Equal(b)
+	case *PassportElementErrorSourceFile:
+		b, ok := b.(*PassportElementErrorSourceFile)
+		return ok && a.Equal(b)
+	case *PassportElementErrorSourceFiles:
+		b, ok := b.(*PassportElementErrorSourceFiles)
+		return ok && a.Equal(b)
+	case *UnknownPassportElementErrorSource:
+		b, ok := b.(*UnknownPassportElementErrorSource)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffPassportElementErrorSource(path string, a PassportElementErrorSource, b PassportElementErrorSource, changes []Change) []Change {
+	switch a := a.(type) {
+	case *PassportElementErrorSourceUnspecified:
+		if b, ok := b.(*PassportElementErrorSourceUnspecified); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementErrorSourceDataField:
+		if b, ok := b.(*PassportElementErrorSourceDataField); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementErrorSourceFrontSide:
+		if b, ok := b.(*PassportElementErrorSourceFrontSide); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementErrorSourceReverseSide:
+		if b, ok := b.(*PassportElementErrorSourceReverseSide); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementErrorSourceSelfie:
+		if b, ok := b.(*PassportElementErrorSourceSelfie); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementErrorSourceTranslationFile:
+		if b, ok := b.(*PassportElementErrorSourceTranslationFile); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementErrorSourceTranslationFiles:
+		if b, ok := b.(*PassportElementErrorSourceTranslationFiles); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementErrorSourceFile:
+		if b, ok := b.(*PassportElementErrorSourceFile); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementErrorSourceFiles:
+		if b, ok := b.(*PassportElementErrorSourceFiles); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalPassportElementErrorSource(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
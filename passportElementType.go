@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // PassportElementType Contains the type of a Telegram Passport element
 type PassportElementType interface {
 	GetPassportElementTypeEnum() PassportElementTypeEnum
+	Accept(visitor PassportElementTypeVisitor)
 }
 
 // PassportElementTypeEnum Alias for abstract PassportElementType 'Sub-Classes', used as constant-enum here
@@ -30,86 +32,160 @@ const (
 	PassportElementTypeEmailAddressType          PassportElementTypeEnum = "passportElementTypeEmailAddress"
 )
 
+// UnknownPassportElementType is a PassportElementType of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownPassportElementType struct {
+	Unknown
+}
+
+// GetPassportElementTypeEnum return the enum type of this object
+func (unknownPassportElementType *UnknownPassportElementType) GetPassportElementTypeEnum() PassportElementTypeEnum {
+	return PassportElementTypeEnum(unknownPassportElementType.Type)
+}
+
+// Accept calls the method of the visitor for UnknownPassportElementType
+func (unknownPassportElementType *UnknownPassportElementType) Accept(visitor PassportElementTypeVisitor) {
+	visitor.VisitUnknownPassportElementType(unknownPassportElementType)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownPassportElementType *UnknownPassportElementType) String() string {
+	return objectString(unknownPassportElementType)
+}
+
+// LogValue logs the object as its summary
+func (unknownPassportElementType *UnknownPassportElementType) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownPassportElementType))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownPassportElementType *UnknownPassportElementType) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownPassportElementType)
+}
+
+func (unknownPassportElementType *UnknownPassportElementType) printText(p *printer) {
+	if unknownPassportElementType == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownPassportElementType")
+	p.string("@type", unknownPassportElementType.Type)
+	p.endObject(state)
+}
+
 func unmarshalPassportElementType(rawMsg *json.RawMessage) (PassportElementType, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch PassportElementTypeEnum(objMap["@type"].(string)) {
+	switch PassportElementTypeEnum(typeName) {
 	case PassportElementTypePersonalDetailsType:
 		var passportElementTypePersonalDetails PassportElementTypePersonalDetails
-		err := json.Unmarshal(*rawMsg, &passportElementTypePersonalDetails)
+		err := jsonUnmarshal(*rawMsg, &passportElementTypePersonalDetails)
 		return &passportElementTypePersonalDetails, err
 
 	case PassportElementTypePassportType:
 		var passportElementTypePassport PassportElementTypePassport
-		err := json.Unmarshal(*rawMsg, &passportElementTypePassport)
+		err := jsonUnmarshal(*rawMsg, &passportElementTypePassport)
 		return &passportElementTypePassport, err
 
 	case PassportElementTypeDriverLicenseType:
 		var passportElementTypeDriverLicense PassportElementTypeDriverLicense
-		err := json.Unmarshal(*rawMsg, &passportElementTypeDriverLicense)
+		err := jsonUnmarshal(*rawMsg, &passportElementTypeDriverLicense)
 		return &passportElementTypeDriverLicense, err
 
 	case PassportElementTypeIdentityCardType:
 		var passportElementTypeIdentityCard PassportElementTypeIdentityCard
-		err := json.Unmarshal(*rawMsg, &passportElementTypeIdentityCard)
+		err := jsonUnmarshal(*rawMsg, &passportElementTypeIdentityCard)
 		return &passportElementTypeIdentityCard, err
 
 	case PassportElementTypeInternalPassportType:
 		var passportElementTypeInternalPassport PassportElementTypeInternalPassport
-		err := json.Unmarshal(*rawMsg, &passportElementTypeInternalPassport)
+		err := jsonUnmarshal(*rawMsg, &passportElementTypeInternalPassport)
 		return &passportElementTypeInternalPassport, err
 
 	case PassportElementTypeAddressType:
 		var passportElementTypeAddress PassportElementTypeAddress
-		err := json.Unmarshal(*rawMsg, &passportElementTypeAddress)
+		err := jsonUnmarshal(*rawMsg, &passportElementTypeAddress)
 		return &passportElementTypeAddress, err
 
 	case PassportElementTypeUtilityBillType:
 		var passportElementTypeUtilityBill PassportElementTypeUtilityBill
-		err := json.Unmarshal(*rawMsg, &passportElementTypeUtilityBill)
+		err := jsonUnmarshal(*rawMsg, &passportElementTypeUtilityBill)
 		return &passportElementTypeUtilityBill, err
 
 	case PassportElementTypeBankStatementType:
 		var passportElementTypeBankStatement PassportElementTypeBankStatement
-		err := json.Unmarshal(*rawMsg, &passportElementTypeBankStatement)
+		err := jsonUnmarshal(*rawMsg, &passportElementTypeBankStatement)
 		return &passportElementTypeBankStatement, err
 
 	case PassportElementTypeRentalAgreementType:
 		var passportElementTypeRentalAgreement PassportElementTypeRentalAgreement
-		err := json.Unmarshal(*rawMsg, &passportElementTypeRentalAgreement)
+		err := jsonUnmarshal(*rawMsg, &passportElementTypeRentalAgreement)
 		return &passportElementTypeRentalAgreement, err
 
 	case PassportElementTypePassportRegistrationType:
 		var passportElementTypePassportRegistration PassportElementTypePassportRegistration
-		err := json.Unmarshal(*rawMsg, &passportElementTypePassportRegistration)
+		err := jsonUnmarshal(*rawMsg, &passportElementTypePassportRegistration)
 		return &passportElementTypePassportRegistration, err
 
 	case PassportElementTypeTemporaryRegistrationType:
 		var passportElementTypeTemporaryRegistration PassportElementTypeTemporaryRegistration
-		err := json.Unmarshal(*rawMsg, &passportElementTypeTemporaryRegistration)
+		err := jsonUnmarshal(*rawMsg, &passportElementTypeTemporaryRegistration)
 		return &passportElementTypeTemporaryRegistration, err
 
 	case PassportElementTypePhoneNumberType:
 		var passportElementTypePhoneNumber PassportElementTypePhoneNumber
-		err := json.Unmarshal(*rawMsg, &passportElementTypePhoneNumber)
+		err := jsonUnmarshal(*rawMsg, &passportElementTypePhoneNumber)
 		return &passportElementTypePhoneNumber, err
 
 	case PassportElementTypeEmailAddressType:
 		var passportElementTypeEmailAddress PassportElementTypeEmailAddress
-		err := json.Unmarshal(*rawMsg, &passportElementTypeEmailAddress)
+		err := jsonUnmarshal(*rawMsg, &passportElementTypeEmailAddress)
 		return &passportElementTypeEmailAddress, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownPassportElementType{Unknown: unknown}, nil
+	}
+}
+
+func unmarshalPassportElementTypeSlice(rawMsg *json.RawMessage) ([]PassportElementType, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var rawItems []*json.RawMessage
+	err := jsonUnmarshal(*rawMsg, &rawItems)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]PassportElementType, 0, len(rawItems))
+	for _, rawItem := range rawItems {
+		item, err := unmarshalPassportElementType(rawItem)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func decodePassportElementType(r *jsonReader) (PassportElementType, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
 	}
+	return unmarshalPassportElementType(rawMsg)
 }
 
 // PassportElementTypePersonalDetails A Telegram Passport element containing the user's personal details
@@ -123,7 +199,6 @@ func (passportElementTypePersonalDetails *PassportElementTypePersonalDetails) Me
 }
 
 // NewPassportElementTypePersonalDetails creates a new PassportElementTypePersonalDetails
-//
 func NewPassportElementTypePersonalDetails() *PassportElementTypePersonalDetails {
 	passportElementTypePersonalDetailsTemp := PassportElementTypePersonalDetails{
 		tdCommon: tdCommon{Type: "passportElementTypePersonalDetails"},
@@ -132,11 +207,107 @@ func NewPassportElementTypePersonalDetails() *PassportElementTypePersonalDetails
 	return &passportElementTypePersonalDetailsTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementTypePersonalDetails *PassportElementTypePersonalDetails) MarshalJSON() ([]byte, error) {
+	return passportElementTypePersonalDetails.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementTypePersonalDetails *PassportElementTypePersonalDetails) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementTypePersonalDetails)
+}
+
+func (passportElementTypePersonalDetails *PassportElementTypePersonalDetails) appendJSON(b []byte) ([]byte, error) {
+	if passportElementTypePersonalDetails == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementTypePersonalDetails.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementTypePersonalDetails.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (passportElementTypePersonalDetails *PassportElementTypePersonalDetails) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementTypePersonalDetails.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementTypePersonalDetails.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementTypePersonalDetails *PassportElementTypePersonalDetails) Clone() *PassportElementTypePersonalDetails {
+	if passportElementTypePersonalDetails == nil {
+		return nil
+	}
+	clone := *passportElementTypePersonalDetails
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementTypePersonalDetails *PassportElementTypePersonalDetails) Equal(other *PassportElementTypePersonalDetails) bool {
+	if passportElementTypePersonalDetails == nil || other == nil {
+		return passportElementTypePersonalDetails == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementTypePersonalDetails *PassportElementTypePersonalDetails) Diff(other *PassportElementTypePersonalDetails) []Change {
+	return passportElementTypePersonalDetails.diff("", other, nil)
+}
+
+func (passportElementTypePersonalDetails *PassportElementTypePersonalDetails) diff(path string, other *PassportElementTypePersonalDetails, changes []Change) []Change {
+	if passportElementTypePersonalDetails == nil || other == nil {
+		if passportElementTypePersonalDetails != other {
+			changes = append(changes, Change{Path: path, Old: passportElementTypePersonalDetails, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementTypePersonalDetails *PassportElementTypePersonalDetails) String() string {
+	return objectString(passportElementTypePersonalDetails)
+}
+
+// LogValue logs the object as its summary
+func (passportElementTypePersonalDetails *PassportElementTypePersonalDetails) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementTypePersonalDetails))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementTypePersonalDetails *PassportElementTypePersonalDetails) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementTypePersonalDetails)
+}
+
+func (passportElementTypePersonalDetails *PassportElementTypePersonalDetails) printText(p *printer) {
+	if passportElementTypePersonalDetails == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementTypePersonalDetails")
+	p.endObject(state)
+}
+
 // GetPassportElementTypeEnum return the enum type of this object
 func (passportElementTypePersonalDetails *PassportElementTypePersonalDetails) GetPassportElementTypeEnum() PassportElementTypeEnum {
 	return PassportElementTypePersonalDetailsType
 }
 
+// Accept calls the method of the visitor for PassportElementTypePersonalDetails
+func (passportElementTypePersonalDetails *PassportElementTypePersonalDetails) Accept(visitor PassportElementTypeVisitor) {
+	visitor.VisitPassportElementTypePersonalDetails(passportElementTypePersonalDetails)
+}
+
 // PassportElementTypePassport A Telegram Passport element containing the user's passport
 type PassportElementTypePassport struct {
 	tdCommon
@@ -148,7 +319,6 @@ func (passportElementTypePassport *PassportElementTypePassport) MessageType() st
 }
 
 // NewPassportElementTypePassport creates a new PassportElementTypePassport
-//
 func NewPassportElementTypePassport() *PassportElementTypePassport {
 	passportElementTypePassportTemp := PassportElementTypePassport{
 		tdCommon: tdCommon{Type: "passportElementTypePassport"},
@@ -157,11 +327,107 @@ func NewPassportElementTypePassport() *PassportElementTypePassport {
 	return &passportElementTypePassportTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementTypePassport *PassportElementTypePassport) MarshalJSON() ([]byte, error) {
+	return passportElementTypePassport.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementTypePassport *PassportElementTypePassport) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementTypePassport)
+}
+
+func (passportElementTypePassport *PassportElementTypePassport) appendJSON(b []byte) ([]byte, error) {
+	if passportElementTypePassport == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementTypePassport.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementTypePassport.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (passportElementTypePassport *PassportElementTypePassport) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementTypePassport.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementTypePassport.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementTypePassport *PassportElementTypePassport) Clone() *PassportElementTypePassport {
+	if passportElementTypePassport == nil {
+		return nil
+	}
+	clone := *passportElementTypePassport
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementTypePassport *PassportElementTypePassport) Equal(other *PassportElementTypePassport) bool {
+	if passportElementTypePassport == nil || other == nil {
+		return passportElementTypePassport == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementTypePassport *PassportElementTypePassport) Diff(other *PassportElementTypePassport) []Change {
+	return passportElementTypePassport.diff("", other, nil)
+}
+
+func (passportElementTypePassport *PassportElementTypePassport) diff(path string, other *PassportElementTypePassport, changes []Change) []Change {
+	if passportElementTypePassport == nil || other == nil {
+		if passportElementTypePassport != other {
+			changes = append(changes, Change{Path: path, Old: passportElementTypePassport, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementTypePassport *PassportElementTypePassport) String() string {
+	return objectString(passportElementTypePassport)
+}
+
+// LogValue logs the object as its summary
+func (passportElementTypePassport *PassportElementTypePassport) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementTypePassport))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementTypePassport *PassportElementTypePassport) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementTypePassport)
+}
+
+func (passportElementTypePassport *PassportElementTypePassport) printText(p *printer) {
+	if passportElementTypePassport == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementTypePassport")
+	p.endObject(state)
+}
+
 // GetPassportElementTypeEnum return the enum type of this object
 func (passportElementTypePassport *PassportElementTypePassport) GetPassportElementTypeEnum() PassportElementTypeEnum {
 	return PassportElementTypePassportType
 }
 
+// Accept calls the method of the visitor for PassportElementTypePassport
+func (passportElementTypePassport *PassportElementTypePassport) Accept(visitor PassportElementTypeVisitor) {
+	visitor.VisitPassportElementTypePassport(passportElementTypePassport)
+}
+
 // PassportElementTypeDriverLicense A Telegram Passport element containing the user's driver license
 type PassportElementTypeDriverLicense struct {
 	tdCommon
@@ -173,7 +439,6 @@ func (passportElementTypeDriverLicense *PassportElementTypeDriverLicense) Messag
 }
 
 // NewPassportElementTypeDriverLicense creates a new PassportElementTypeDriverLicense
-//
 func NewPassportElementTypeDriverLicense() *PassportElementTypeDriverLicense {
 	passportElementTypeDriverLicenseTemp := PassportElementTypeDriverLicense{
 		tdCommon: tdCommon{Type: "passportElementTypeDriverLicense"},
@@ -182,11 +447,107 @@ func NewPassportElementTypeDriverLicense() *PassportElementTypeDriverLicense {
 	return &passportElementTypeDriverLicenseTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementTypeDriverLicense *PassportElementTypeDriverLicense) MarshalJSON() ([]byte, error) {
+	return passportElementTypeDriverLicense.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementTypeDriverLicense *PassportElementTypeDriverLicense) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementTypeDriverLicense)
+}
+
+func (passportElementTypeDriverLicense *PassportElementTypeDriverLicense) appendJSON(b []byte) ([]byte, error) {
+	if passportElementTypeDriverLicense == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementTypeDriverLicense.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementTypeDriverLicense.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (passportElementTypeDriverLicense *PassportElementTypeDriverLicense) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementTypeDriverLicense.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementTypeDriverLicense.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementTypeDriverLicense *PassportElementTypeDriverLicense) Clone() *PassportElementTypeDriverLicense {
+	if passportElementTypeDriverLicense == nil {
+		return nil
+	}
+	clone := *passportElementTypeDriverLicense
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementTypeDriverLicense *PassportElementTypeDriverLicense) Equal(other *PassportElementTypeDriverLicense) bool {
+	if passportElementTypeDriverLicense == nil || other == nil {
+		return passportElementTypeDriverLicense == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementTypeDriverLicense *PassportElementTypeDriverLicense) Diff(other *PassportElementTypeDriverLicense) []Change {
+	return passportElementTypeDriverLicense.diff("", other, nil)
+}
+
+func (passportElementTypeDriverLicense *PassportElementTypeDriverLicense) diff(path string, other *PassportElementTypeDriverLicense, changes []Change) []Change {
+	if passportElementTypeDriverLicense == nil || other == nil {
+		if passportElementTypeDriverLicense != other {
+			changes = append(changes, Change{Path: path, Old: passportElementTypeDriverLicense, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementTypeDriverLicense *PassportElementTypeDriverLicense) String() string {
+	return objectString(passportElementTypeDriverLicense)
+}
+
+// LogValue logs the object as its summary
+func (passportElementTypeDriverLicense *PassportElementTypeDriverLicense) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementTypeDriverLicense))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementTypeDriverLicense *PassportElementTypeDriverLicense) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementTypeDriverLicense)
+}
+
+func (passportElementTypeDriverLicense *PassportElementTypeDriverLicense) printText(p *printer) {
+	if passportElementTypeDriverLicense == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementTypeDriverLicense")
+	p.endObject(state)
+}
+
 // GetPassportElementTypeEnum return the enum type of this object
 func (passportElementTypeDriverLicense *PassportElementTypeDriverLicense) GetPassportElementTypeEnum() PassportElementTypeEnum {
 	return PassportElementTypeDriverLicenseType
 }
 
+// Accept calls the method of the visitor for PassportElementTypeDriverLicense
+func (passportElementTypeDriverLicense *PassportElementTypeDriverLicense) Accept(visitor PassportElementTypeVisitor) {
+	visitor.VisitPassportElementTypeDriverLicense(passportElementTypeDriverLicense)
+}
+
 // PassportElementTypeIdentityCard A Telegram Passport element containing the user's identity card
 type PassportElementTypeIdentityCard struct {
 	tdCommon
@@ -198,7 +559,6 @@ func (passportElementTypeIdentityCard *PassportElementTypeIdentityCard) MessageT
 }
 
 // NewPassportElementTypeIdentityCard creates a new PassportElementTypeIdentityCard
-//
 func NewPassportElementTypeIdentityCard() *PassportElementTypeIdentityCard {
 	passportElementTypeIdentityCardTemp := PassportElementTypeIdentityCard{
 		tdCommon: tdCommon{Type: "passportElementTypeIdentityCard"},
@@ -207,11 +567,107 @@ func NewPassportElementTypeIdentityCard() *PassportElementTypeIdentityCard {
 	return &passportElementTypeIdentityCardTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementTypeIdentityCard *PassportElementTypeIdentityCard) MarshalJSON() ([]byte, error) {
+	return passportElementTypeIdentityCard.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementTypeIdentityCard *PassportElementTypeIdentityCard) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementTypeIdentityCard)
+}
+
+func (passportElementTypeIdentityCard *PassportElementTypeIdentityCard) appendJSON(b []byte) ([]byte, error) {
+	if passportElementTypeIdentityCard == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementTypeIdentityCard.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementTypeIdentityCard.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (passportElementTypeIdentityCard *PassportElementTypeIdentityCard) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementTypeIdentityCard.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementTypeIdentityCard.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementTypeIdentityCard *PassportElementTypeIdentityCard) Clone() *PassportElementTypeIdentityCard {
+	if passportElementTypeIdentityCard == nil {
+		return nil
+	}
+	clone := *passportElementTypeIdentityCard
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementTypeIdentityCard *PassportElementTypeIdentityCard) Equal(other *PassportElementTypeIdentityCard) bool {
+	if passportElementTypeIdentityCard == nil || other == nil {
+		return passportElementTypeIdentityCard == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementTypeIdentityCard *PassportElementTypeIdentityCard) Diff(other *PassportElementTypeIdentityCard) []Change {
+	return passportElementTypeIdentityCard.diff("", other, nil)
+}
+
+func (passportElementTypeIdentityCard *PassportElementTypeIdentityCard) diff(path string, other *PassportElementTypeIdentityCard, changes []Change) []Change {
+	if passportElementTypeIdentityCard == nil || other == nil {
+		if passportElementTypeIdentityCard != other {
+			changes = append(changes, Change{Path: path, Old: passportElementTypeIdentityCard, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementTypeIdentityCard *PassportElementTypeIdentityCard) String() string {
+	return objectString(passportElementTypeIdentityCard)
+}
+
+// LogValue logs the object as its summary
+func (passportElementTypeIdentityCard *PassportElementTypeIdentityCard) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementTypeIdentityCard))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementTypeIdentityCard *PassportElementTypeIdentityCard) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementTypeIdentityCard)
+}
+
+func (passportElementTypeIdentityCard *PassportElementTypeIdentityCard) printText(p *printer) {
+	if passportElementTypeIdentityCard == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementTypeIdentityCard")
+	p.endObject(state)
+}
+
 // GetPassportElementTypeEnum return the enum type of this object
 func (passportElementTypeIdentityCard *PassportElementTypeIdentityCard) GetPassportElementTypeEnum() PassportElementTypeEnum {
 	return PassportElementTypeIdentityCardType
 }
 
+// Accept calls the method of the visitor for PassportElementTypeIdentityCard
+func (passportElementTypeIdentityCard *PassportElementTypeIdentityCard) Accept(visitor PassportElementTypeVisitor) {
+	visitor.VisitPassportElementTypeIdentityCard(passportElementTypeIdentityCard)
+}
+
 // PassportElementTypeInternalPassport A Telegram Passport element containing the user's internal passport
 type PassportElementTypeInternalPassport struct {
 	tdCommon
@@ -223,7 +679,6 @@ func (passportElementTypeInternalPassport *PassportElementTypeInternalPassport)
 }
 
 // NewPassportElementTypeInternalPassport creates a new PassportElementTypeInternalPassport
-//
 func NewPassportElementTypeInternalPassport() *PassportElementTypeInternalPassport {
 	passportElementTypeInternalPassportTemp := PassportElementTypeInternalPassport{
 		tdCommon: tdCommon{Type: "passportElementTypeInternalPassport"},
@@ -232,11 +687,107 @@ func NewPassportElementTypeInternalPassport() *PassportElementTypeInternalPasspo
 	return &passportElementTypeInternalPassportTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementTypeInternalPassport *PassportElementTypeInternalPassport) MarshalJSON() ([]byte, error) {
+	return passportElementTypeInternalPassport.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementTypeInternalPassport *PassportElementTypeInternalPassport) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementTypeInternalPassport)
+}
+
+func (passportElementTypeInternalPassport *PassportElementTypeInternalPassport) appendJSON(b []byte) ([]byte, error) {
+	if passportElementTypeInternalPassport == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementTypeInternalPassport.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementTypeInternalPassport.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (passportElementTypeInternalPassport *PassportElementTypeInternalPassport) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementTypeInternalPassport.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementTypeInternalPassport.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementTypeInternalPassport *PassportElementTypeInternalPassport) Clone() *PassportElementTypeInternalPassport {
+	if passportElementTypeInternalPassport == nil {
+		return nil
+	}
+	clone := *passportElementTypeInternalPassport
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementTypeInternalPassport *PassportElementTypeInternalPassport) Equal(other *PassportElementTypeInternalPassport) bool {
+	if passportElementTypeInternalPassport == nil || other == nil {
+		return passportElementTypeInternalPassport == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementTypeInternalPassport *PassportElementTypeInternalPassport) Diff(other *PassportElementTypeInternalPassport) []Change {
+	return passportElementTypeInternalPassport.diff("", other, nil)
+}
+
+func (passportElementTypeInternalPassport *PassportElementTypeInternalPassport) diff(path string, other *PassportElementTypeInternalPassport, changes []Change) []Change {
+	if passportElementTypeInternalPassport == nil || other == nil {
+		if passportElementTypeInternalPassport != other {
+			changes = append(changes, Change{Path: path, Old: passportElementTypeInternalPassport, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementTypeInternalPassport *PassportElementTypeInternalPassport) String() string {
+	return objectString(passportElementTypeInternalPassport)
+}
+
+// LogValue logs the object as its summary
+func (passportElementTypeInternalPassport *PassportElementTypeInternalPassport) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementTypeInternalPassport))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementTypeInternalPassport *PassportElementTypeInternalPassport) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementTypeInternalPassport)
+}
+
+func (passportElementTypeInternalPassport *PassportElementTypeInternalPassport) printText(p *printer) {
+	if passportElementTypeInternalPassport == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementTypeInternalPassport")
+	p.endObject(state)
+}
+
 // GetPassportElementTypeEnum return the enum type of this object
 func (passportElementTypeInternalPassport *PassportElementTypeInternalPassport) GetPassportElementTypeEnum() PassportElementTypeEnum {
 	return PassportElementTypeInternalPassportType
 }
 
+// Accept calls the method of the visitor for PassportElementTypeInternalPassport
+func (passportElementTypeInternalPassport *PassportElementTypeInternalPassport) Accept(visitor PassportElementTypeVisitor) {
+	visitor.VisitPassportElementTypeInternalPassport(passportElementTypeInternalPassport)
+}
+
 // PassportElementTypeAddress A Telegram Passport element containing the user's address
 type PassportElementTypeAddress struct {
 	tdCommon
@@ -248,7 +799,6 @@ func (passportElementTypeAddress *PassportElementTypeAddress) MessageType() stri
 }
 
 // NewPassportElementTypeAddress creates a new PassportElementTypeAddress
-//
 func NewPassportElementTypeAddress() *PassportElementTypeAddress {
 	passportElementTypeAddressTemp := PassportElementTypeAddress{
 		tdCommon: tdCommon{Type: "passportElementTypeAddress"},
@@ -257,11 +807,107 @@ func NewPassportElementTypeAddress() *PassportElementTypeAddress {
 	return &passportElementTypeAddressTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementTypeAddress *PassportElementTypeAddress) MarshalJSON() ([]byte, error) {
+	return passportElementTypeAddress.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementTypeAddress *PassportElementTypeAddress) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementTypeAddress)
+}
+
+func (passportElementTypeAddress *PassportElementTypeAddress) appendJSON(b []byte) ([]byte, error) {
+	if passportElementTypeAddress == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementTypeAddress.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementTypeAddress.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (passportElementTypeAddress *PassportElementTypeAddress) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementTypeAddress.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementTypeAddress.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementTypeAddress *PassportElementTypeAddress) Clone() *PassportElementTypeAddress {
+	if passportElementTypeAddress == nil {
+		return nil
+	}
+	clone := *passportElementTypeAddress
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementTypeAddress *PassportElementTypeAddress) Equal(other *PassportElementTypeAddress) bool {
+	if passportElementTypeAddress == nil || other == nil {
+		return passportElementTypeAddress == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementTypeAddress *PassportElementTypeAddress) Diff(other *PassportElementTypeAddress) []Change {
+	return passportElementTypeAddress.diff("", other, nil)
+}
+
+func (passportElementTypeAddress *PassportElementTypeAddress) diff(path string, other *PassportElementTypeAddress, changes []Change) []Change {
+	if passportElementTypeAddress == nil || other == nil {
+		if passportElementTypeAddress != other {
+			changes = append(changes, Change{Path: path, Old: passportElementTypeAddress, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementTypeAddress *PassportElementTypeAddress) String() string {
+	return objectString(passportElementTypeAddress)
+}
+
+// LogValue logs the object as its summary
+func (passportElementTypeAddress *PassportElementTypeAddress) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementTypeAddress))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementTypeAddress *PassportElementTypeAddress) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementTypeAddress)
+}
+
+func (passportElementTypeAddress *PassportElementTypeAddress) printText(p *printer) {
+	if passportElementTypeAddress == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementTypeAddress")
+	p.endObject(state)
+}
+
 // GetPassportElementTypeEnum return the enum type of this object
 func (passportElementTypeAddress *PassportElementTypeAddress) GetPassportElementTypeEnum() PassportElementTypeEnum {
 	return PassportElementTypeAddressType
 }
 
+// Accept calls the method of the visitor for PassportElementTypeAddress
+func (passportElementTypeAddress *PassportElementTypeAddress) Accept(visitor PassportElementTypeVisitor) {
+	visitor.VisitPassportElementTypeAddress(passportElementTypeAddress)
+}
+
 // PassportElementTypeUtilityBill A Telegram Passport element containing the user's utility bill
 type PassportElementTypeUtilityBill struct {
 	tdCommon
@@ -273,7 +919,6 @@ func (passportElementTypeUtilityBill *PassportElementTypeUtilityBill) MessageTyp
 }
 
 // NewPassportElementTypeUtilityBill creates a new PassportElementTypeUtilityBill
-//
 func NewPassportElementTypeUtilityBill() *PassportElementTypeUtilityBill {
 	passportElementTypeUtilityBillTemp := PassportElementTypeUtilityBill{
 		tdCommon: tdCommon{Type: "passportElementTypeUtilityBill"},
@@ -282,11 +927,107 @@ func NewPassportElementTypeUtilityBill() *PassportElementTypeUtilityBill {
 	return &passportElementTypeUtilityBillTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementTypeUtilityBill *PassportElementTypeUtilityBill) MarshalJSON() ([]byte, error) {
+	return passportElementTypeUtilityBill.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementTypeUtilityBill *PassportElementTypeUtilityBill) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementTypeUtilityBill)
+}
+
+func (passportElementTypeUtilityBill *PassportElementTypeUtilityBill) appendJSON(b []byte) ([]byte, error) {
+	if passportElementTypeUtilityBill == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementTypeUtilityBill.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementTypeUtilityBill.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (passportElementTypeUtilityBill *PassportElementTypeUtilityBill) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementTypeUtilityBill.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementTypeUtilityBill.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementTypeUtilityBill *PassportElementTypeUtilityBill) Clone() *PassportElementTypeUtilityBill {
+	if passportElementTypeUtilityBill == nil {
+		return nil
+	}
+	clone := *passportElementTypeUtilityBill
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementTypeUtilityBill *PassportElementTypeUtilityBill) Equal(other *PassportElementTypeUtilityBill) bool {
+	if passportElementTypeUtilityBill == nil || other == nil {
+		return passportElementTypeUtilityBill == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementTypeUtilityBill *PassportElementTypeUtilityBill) Diff(other *PassportElementTypeUtilityBill) []Change {
+	return passportElementTypeUtilityBill.diff("", other, nil)
+}
+
+func (passportElementTypeUtilityBill *PassportElementTypeUtilityBill) diff(path string, other *PassportElementTypeUtilityBill, changes []Change) []Change {
+	if passportElementTypeUtilityBill == nil || other == nil {
+		if passportElementTypeUtilityBill != other {
+			changes = append(changes, Change{Path: path, Old: passportElementTypeUtilityBill, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementTypeUtilityBill *PassportElementTypeUtilityBill) String() string {
+	return objectString(passportElementTypeUtilityBill)
+}
+
+// LogValue logs the object as its summary
+func (passportElementTypeUtilityBill *PassportElementTypeUtilityBill) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementTypeUtilityBill))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementTypeUtilityBill *PassportElementTypeUtilityBill) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementTypeUtilityBill)
+}
+
+func (passportElementTypeUtilityBill *PassportElementTypeUtilityBill) printText(p *printer) {
+	if passportElementTypeUtilityBill == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementTypeUtilityBill")
+	p.endObject(state)
+}
+
 // GetPassportElementTypeEnum return the enum type of this object
 func (passportElementTypeUtilityBill *PassportElementTypeUtilityBill) GetPassportElementTypeEnum() PassportElementTypeEnum {
 	return PassportElementTypeUtilityBillType
 }
 
+// Accept calls the method of the visitor for PassportElementTypeUtilityBill
+func (passportElementTypeUtilityBill *PassportElementTypeUtilityBill) Accept(visitor PassportElementTypeVisitor) {
+	visitor.VisitPassportElementTypeUtilityBill(passportElementTypeUtilityBill)
+}
+
 // PassportElementTypeBankStatement A Telegram Passport element containing the user's bank statement
 type PassportElementTypeBankStatement struct {
 	tdCommon
@@ -298,7 +1039,6 @@ func (passportElementTypeBankStatement *PassportElementTypeBankStatement) Messag
 }
 
 // NewPassportElementTypeBankStatement creates a new PassportElementTypeBankStatement
-//
 func NewPassportElementTypeBankStatement() *PassportElementTypeBankStatement {
 	passportElementTypeBankStatementTemp := PassportElementTypeBankStatement{
 		tdCommon: tdCommon{Type: "passportElementTypeBankStatement"},
@@ -307,11 +1047,107 @@ func NewPassportElementTypeBankStatement() *PassportElementTypeBankStatement {
 	return &passportElementTypeBankStatementTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementTypeBankStatement *PassportElementTypeBankStatement) MarshalJSON() ([]byte, error) {
+	return passportElementTypeBankStatement.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementTypeBankStatement *PassportElementTypeBankStatement) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementTypeBankStatement)
+}
+
+func (passportElementTypeBankStatement *PassportElementTypeBankStatement) appendJSON(b []byte) ([]byte, error) {
+	if passportElementTypeBankStatement == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementTypeBankStatement.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementTypeBankStatement.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (passportElementTypeBankStatement *PassportElementTypeBankStatement) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementTypeBankStatement.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementTypeBankStatement.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementTypeBankStatement *PassportElementTypeBankStatement) Clone() *PassportElementTypeBankStatement {
+	if passportElementTypeBankStatement == nil {
+		return nil
+	}
+	clone := *passportElementTypeBankStatement
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementTypeBankStatement *PassportElementTypeBankStatement) Equal(other *PassportElementTypeBankStatement) bool {
+	if passportElementTypeBankStatement == nil || other == nil {
+		return passportElementTypeBankStatement == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementTypeBankStatement *PassportElementTypeBankStatement) Diff(other *PassportElementTypeBankStatement) []Change {
+	return passportElementTypeBankStatement.diff("", other, nil)
+}
+
+func (passportElementTypeBankStatement *PassportElementTypeBankStatement) diff(path string, other *PassportElementTypeBankStatement, changes []Change) []Change {
+	if passportElementTypeBankStatement == nil || other == nil {
+		if passportElementTypeBankStatement != other {
+			changes = append(changes, Change{Path: path, Old: passportElementTypeBankStatement, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementTypeBankStatement *PassportElementTypeBankStatement) String() string {
+	return objectString(passportElementTypeBankStatement)
+}
+
+// LogValue logs the object as its summary
+func (passportElementTypeBankStatement *PassportElementTypeBankStatement) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementTypeBankStatement))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementTypeBankStatement *PassportElementTypeBankStatement) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementTypeBankStatement)
+}
+
+func (passportElementTypeBankStatement *PassportElementTypeBankStatement) printText(p *printer) {
+	if passportElementTypeBankStatement == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementTypeBankStatement")
+	p.endObject(state)
+}
+
 // GetPassportElementTypeEnum return the enum type of this object
 func (passportElementTypeBankStatement *PassportElementTypeBankStatement) GetPassportElementTypeEnum() PassportElementTypeEnum {
 	return PassportElementTypeBankStatementType
 }
 
+// Accept calls the method of the visitor for PassportElementTypeBankStatement
+func (passportElementTypeBankStatement *PassportElementTypeBankStatement) Accept(visitor PassportElementTypeVisitor) {
+	visitor.VisitPassportElementTypeBankStatement(passportElementTypeBankStatement)
+}
+
 // PassportElementTypeRentalAgreement A Telegram Passport element containing the user's rental agreement
 type PassportElementTypeRentalAgreement struct {
 	tdCommon
@@ -323,7 +1159,6 @@ func (passportElementTypeRentalAgreement *PassportElementTypeRentalAgreement) Me
 }
 
 // NewPassportElementTypeRentalAgreement creates a new PassportElementTypeRentalAgreement
-//
 func NewPassportElementTypeRentalAgreement() *PassportElementTypeRentalAgreement {
 	passportElementTypeRentalAgreementTemp := PassportElementTypeRentalAgreement{
 		tdCommon: tdCommon{Type: "passportElementTypeRentalAgreement"},
@@ -332,11 +1167,107 @@ func NewPassportElementTypeRentalAgreement() *PassportElementTypeRentalAgreement
 	return &passportElementTypeRentalAgreementTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementTypeRentalAgreement *PassportElementTypeRentalAgreement) MarshalJSON() ([]byte, error) {
+	return passportElementTypeRentalAgreement.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementTypeRentalAgreement *PassportElementTypeRentalAgreement) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementTypeRentalAgreement)
+}
+
+func (passportElementTypeRentalAgreement *PassportElementTypeRentalAgreement) appendJSON(b []byte) ([]byte, error) {
+	if passportElementTypeRentalAgreement == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementTypeRentalAgreement.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementTypeRentalAgreement.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (passportElementTypeRentalAgreement *PassportElementTypeRentalAgreement) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementTypeRentalAgreement.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementTypeRentalAgreement.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementTypeRentalAgreement *PassportElementTypeRentalAgreement) Clone() *PassportElementTypeRentalAgreement {
+	if passportElementTypeRentalAgreement == nil {
+		return nil
+	}
+	clone := *passportElementTypeRentalAgreement
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementTypeRentalAgreement *PassportElementTypeRentalAgreement) Equal(other *PassportElementTypeRentalAgreement) bool {
+	if passportElementTypeRentalAgreement == nil || other == nil {
+		return passportElementTypeRentalAgreement == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementTypeRentalAgreement *PassportElementTypeRentalAgreement) Diff(other *PassportElementTypeRentalAgreement) []Change {
+	return passportElementTypeRentalAgreement.diff("", other, nil)
+}
+
+func (passportElementTypeRentalAgreement *PassportElementTypeRentalAgreement) diff(path string, other *PassportElementTypeRentalAgreement, changes []Change) []Change {
+	if passportElementTypeRentalAgreement == nil || other == nil {
+		if passportElementTypeRentalAgreement != other {
+			changes = append(changes, Change{Path: path, Old: passportElementTypeRentalAgreement, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementTypeRentalAgreement *PassportElementTypeRentalAgreement) String() string {
+	return objectString(passportElementTypeRentalAgreement)
+}
+
+// LogValue logs the object as its summary
+func (passportElementTypeRentalAgreement *PassportElementTypeRentalAgreement) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementTypeRentalAgreement))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementTypeRentalAgreement *PassportElementTypeRentalAgreement) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementTypeRentalAgreement)
+}
+
+func (passportElementTypeRentalAgreement *PassportElementTypeRentalAgreement) printText(p *printer) {
+	if passportElementTypeRentalAgreement == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementTypeRentalAgreement")
+	p.endObject(state)
+}
+
 // GetPassportElementTypeEnum return the enum type of this object
 func (passportElementTypeRentalAgreement *PassportElementTypeRentalAgreement) GetPassportElementTypeEnum() PassportElementTypeEnum {
 	return PassportElementTypeRentalAgreementType
 }
 
+// Accept calls the method of the visitor for PassportElementTypeRentalAgreement
+func (passportElementTypeRentalAgreement *PassportElementTypeRentalAgreement) Accept(visitor PassportElementTypeVisitor) {
+	visitor.VisitPassportElementTypeRentalAgreement(passportElementTypeRentalAgreement)
+}
+
 // PassportElementTypePassportRegistration A Telegram Passport element containing the registration page of the user's passport
 type PassportElementTypePassportRegistration struct {
 	tdCommon
@@ -348,7 +1279,6 @@ func (passportElementTypePassportRegistration *PassportElementTypePassportRegist
 }
 
 // NewPassportElementTypePassportRegistration creates a new PassportElementTypePassportRegistration
-//
 func NewPassportElementTypePassportRegistration() *PassportElementTypePassportRegistration {
 	passportElementTypePassportRegistrationTemp := PassportElementTypePassportRegistration{
 		tdCommon: tdCommon{Type: "passportElementTypePassportRegistration"},
@@ -357,11 +1287,107 @@ func NewPassportElementTypePassportRegistration() *PassportElementTypePassportRe
 	return &passportElementTypePassportRegistrationTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementTypePassportRegistration *PassportElementTypePassportRegistration) MarshalJSON() ([]byte, error) {
+	return passportElementTypePassportRegistration.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementTypePassportRegistration *PassportElementTypePassportRegistration) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementTypePassportRegistration)
+}
+
+func (passportElementTypePassportRegistration *PassportElementTypePassportRegistration) appendJSON(b []byte) ([]byte, error) {
+	if passportElementTypePassportRegistration == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementTypePassportRegistration.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementTypePassportRegistration.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (passportElementTypePassportRegistration *PassportElementTypePassportRegistration) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementTypePassportRegistration.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementTypePassportRegistration.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementTypePassportRegistration *PassportElementTypePassportRegistration) Clone() *PassportElementTypePassportRegistration {
+	if passportElementTypePassportRegistration == nil {
+		return nil
+	}
+	clone := *passportElementTypePassportRegistration
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementTypePassportRegistration *PassportElementTypePassportRegistration) Equal(other *PassportElementTypePassportRegistration) bool {
+	if passportElementTypePassportRegistration == nil || other == nil {
+		return passportElementTypePassportRegistration == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementTypePassportRegistration *PassportElementTypePassportRegistration) Diff(other *PassportElementTypePassportRegistration) []Change {
+	return passportElementTypePassportRegistration.diff("", other, nil)
+}
+
+func (passportElementTypePassportRegistration *PassportElementTypePassportRegistration) diff(path string, other *PassportElementTypePassportRegistration, changes []Change) []Change {
+	if passportElementTypePassportRegistration == nil || other == nil {
+		if passportElementTypePassportRegistration != other {
+			changes = append(changes, Change{Path: path, Old: passportElementTypePassportRegistration, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementTypePassportRegistration *PassportElementTypePassportRegistration) String() string {
+	return objectString(passportElementTypePassportRegistration)
+}
+
+// LogValue logs the object as its summary
+func (passportElementTypePassportRegistration *PassportElementTypePassportRegistration) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementTypePassportRegistration))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementTypePassportRegistration *PassportElementTypePassportRegistration) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementTypePassportRegistration)
+}
+
+func (passportElementTypePassportRegistration *PassportElementTypePassportRegistration) printText(p *printer) {
+	if passportElementTypePassportRegistration == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementTypePassportRegistration")
+	p.endObject(state)
+}
+
 // GetPassportElementTypeEnum return the enum type of this object
 func (passportElementTypePassportRegistration *PassportElementTypePassportRegistration) GetPassportElementTypeEnum() PassportElementTypeEnum {
 	return PassportElementTypePassportRegistrationType
 }
 
+// Accept calls the method of the visitor for PassportElementTypePassportRegistration
+func (passportElementTypePassportRegistration *PassportElementTypePassportRegistration) Accept(visitor PassportElementTypeVisitor) {
+	visitor.VisitPassportElementTypePassportRegistration(passportElementTypePassportRegistration)
+}
+
 // PassportElementTypeTemporaryRegistration A Telegram Passport element containing the user's temporary registration
 type PassportElementTypeTemporaryRegistration struct {
 	tdCommon
@@ -373,7 +1399,6 @@ func (passportElementTypeTemporaryRegistration *PassportElementTypeTemporaryRegi
 }
 
 // NewPassportElementTypeTemporaryRegistration creates a new PassportElementTypeTemporaryRegistration
-//
 func NewPassportElementTypeTemporaryRegistration() *PassportElementTypeTemporaryRegistration {
 	passportElementTypeTemporaryRegistrationTemp := PassportElementTypeTemporaryRegistration{
 		tdCommon: tdCommon{Type: "passportElementTypeTemporaryRegistration"},
@@ -382,11 +1407,107 @@ func NewPassportElementTypeTemporaryRegistration() *PassportElementTypeTemporary
 	return &passportElementTypeTemporaryRegistrationTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementTypeTemporaryRegistration *PassportElementTypeTemporaryRegistration) MarshalJSON() ([]byte, error) {
+	return passportElementTypeTemporaryRegistration.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementTypeTemporaryRegistration *PassportElementTypeTemporaryRegistration) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementTypeTemporaryRegistration)
+}
+
+func (passportElementTypeTemporaryRegistration *PassportElementTypeTemporaryRegistration) appendJSON(b []byte) ([]byte, error) {
+	if passportElementTypeTemporaryRegistration == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementTypeTemporaryRegistration.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementTypeTemporaryRegistration.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (passportElementTypeTemporaryRegistration *PassportElementTypeTemporaryRegistration) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementTypeTemporaryRegistration.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementTypeTemporaryRegistration.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementTypeTemporaryRegistration *PassportElementTypeTemporaryRegistration) Clone() *PassportElementTypeTemporaryRegistration {
+	if passportElementTypeTemporaryRegistration == nil {
+		return nil
+	}
+	clone := *passportElementTypeTemporaryRegistration
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementTypeTemporaryRegistration *PassportElementTypeTemporaryRegistration) Equal(other *PassportElementTypeTemporaryRegistration) bool {
+	if passportElementTypeTemporaryRegistration == nil || other == nil {
+		return passportElementTypeTemporaryRegistration == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementTypeTemporaryRegistration *PassportElementTypeTemporaryRegistration) Diff(other *PassportElementTypeTemporaryRegistration) []Change {
+	return passportElementTypeTemporaryRegistration.diff("", other, nil)
+}
+
+func (passportElementTypeTemporaryRegistration *PassportElementTypeTemporaryRegistration) diff(path string, other *PassportElementTypeTemporaryRegistration, changes []Change) []Change {
+	if passportElementTypeTemporaryRegistration == nil || other == nil {
+		if passportElementTypeTemporaryRegistration != other {
+			changes = append(changes, Change{Path: path, Old: passportElementTypeTemporaryRegistration, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementTypeTemporaryRegistration *PassportElementTypeTemporaryRegistration) String() string {
+	return objectString(passportElementTypeTemporaryRegistration)
+}
+
+// LogValue logs the object as its summary
+func (passportElementTypeTemporaryRegistration *PassportElementTypeTemporaryRegistration) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementTypeTemporaryRegistration))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementTypeTemporaryRegistration *PassportElementTypeTemporaryRegistration) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementTypeTemporaryRegistration)
+}
+
+func (passportElementTypeTemporaryRegistration *PassportElementTypeTemporaryRegistration) printText(p *printer) {
+	if passportElementTypeTemporaryRegistration == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementTypeTemporaryRegistration")
+	p.endObject(state)
+}
+
 // GetPassportElementTypeEnum return the enum type of this object
 func (passportElementTypeTemporaryRegistration *PassportElementTypeTemporaryRegistration) GetPassportElementTypeEnum() PassportElementTypeEnum {
 	return PassportElementTypeTemporaryRegistrationType
 }
 
+// Accept calls the method of the visitor for PassportElementTypeTemporaryRegistration
+func (passportElementTypeTemporaryRegistration *PassportElementTypeTemporaryRegistration) Accept(visitor PassportElementTypeVisitor) {
+	visitor.VisitPassportElementTypeTemporaryRegistration(passportElementTypeTemporaryRegistration)
+}
+
 // PassportElementTypePhoneNumber A Telegram Passport element containing the user's phone number
 type PassportElementTypePhoneNumber struct {
 	tdCommon
@@ -398,7 +1519,6 @@ func (passportElementTypePhoneNumber *PassportElementTypePhoneNumber) MessageTyp
 }
 
 // NewPassportElementTypePhoneNumber creates a new PassportElementTypePhoneNumber
-//
 func NewPassportElementTypePhoneNumber() *PassportElementTypePhoneNumber {
 	passportElementTypePhoneNumberTemp := PassportElementTypePhoneNumber{
 		tdCommon: tdCommon{Type: "passportElementTypePhoneNumber"},
@@ -407,11 +1527,107 @@ func NewPassportElementTypePhoneNumber() *PassportElementTypePhoneNumber {
 	return &passportElementTypePhoneNumberTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementTypePhoneNumber *PassportElementTypePhoneNumber) MarshalJSON() ([]byte, error) {
+	return passportElementTypePhoneNumber.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementTypePhoneNumber *PassportElementTypePhoneNumber) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementTypePhoneNumber)
+}
+
+func (passportElementTypePhoneNumber *PassportElementTypePhoneNumber) appendJSON(b []byte) ([]byte, error) {
+	if passportElementTypePhoneNumber == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementTypePhoneNumber.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementTypePhoneNumber.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (passportElementTypePhoneNumber *PassportElementTypePhoneNumber) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementTypePhoneNumber.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementTypePhoneNumber.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementTypePhoneNumber *PassportElementTypePhoneNumber) Clone() *PassportElementTypePhoneNumber {
+	if passportElementTypePhoneNumber == nil {
+		return nil
+	}
+	clone := *passportElementTypePhoneNumber
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementTypePhoneNumber *PassportElementTypePhoneNumber) Equal(other *PassportElementTypePhoneNumber) bool {
+	if passportElementTypePhoneNumber == nil || other == nil {
+		return passportElementTypePhoneNumber == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementTypePhoneNumber *PassportElementTypePhoneNumber) Diff(other *PassportElementTypePhoneNumber) []Change {
+	return passportElementTypePhoneNumber.diff("", other, nil)
+}
+
+func (passportElementTypePhoneNumber *PassportElementTypePhoneNumber) diff(path string, other *PassportElementTypePhoneNumber, changes []Change) []Change {
+	if passportElementTypePhoneNumber == nil || other == nil {
+		if passportElementTypePhoneNumber != other {
+			changes = append(changes, Change{Path: path, Old: passportElementTypePhoneNumber, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementTypePhoneNumber *PassportElementTypePhoneNumber) String() string {
+	return objectString(passportElementTypePhoneNumber)
+}
+
+// LogValue logs the object as its summary
+func (passportElementTypePhoneNumber *PassportElementTypePhoneNumber) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementTypePhoneNumber))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementTypePhoneNumber *PassportElementTypePhoneNumber) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementTypePhoneNumber)
+}
+
+func (passportElementTypePhoneNumber *PassportElementTypePhoneNumber) printText(p *printer) {
+	if passportElementTypePhoneNumber == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementTypePhoneNumber")
+	p.endObject(state)
+}
+
 // GetPassportElementTypeEnum return the enum type of this object
 func (passportElementTypePhoneNumber *PassportElementTypePhoneNumber) GetPassportElementTypeEnum() PassportElementTypeEnum {
 	return PassportElementTypePhoneNumberType
 }
 
+// Accept calls the method of the visitor for PassportElementTypePhoneNumber
+func (passportElementTypePhoneNumber *PassportElementTypePhoneNumber) Accept(visitor PassportElementTypeVisitor) {
+	visitor.VisitPassportElementTypePhoneNumber(passportElementTypePhoneNumber)
+}
+
 // PassportElementTypeEmailAddress A Telegram Passport element containing the user's email address
 type PassportElementTypeEmailAddress struct {
 	tdCommon
@@ -423,7 +1639,6 @@ func (passportElementTypeEmailAddress *PassportElementTypeEmailAddress) MessageT
 }
 
 // NewPassportElementTypeEmailAddress creates a new PassportElementTypeEmailAddress
-//
 func NewPassportElementTypeEmailAddress() *PassportElementTypeEmailAddress {
 	passportElementTypeEmailAddressTemp := PassportElementTypeEmailAddress{
 		tdCommon: tdCommon{Type: "passportElementTypeEmailAddress"},
@@ -432,7 +1647,363 @@ func NewPassportElementTypeEmailAddress() *PassportElementTypeEmailAddress {
 	return &passportElementTypeEmailAddressTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementTypeEmailAddress *PassportElementTypeEmailAddress) MarshalJSON() ([]byte, error) {
+	return passportElementTypeEmailAddress.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementTypeEmailAddress *PassportElementTypeEmailAddress) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementTypeEmailAddress)
+}
+
+func (passportElementTypeEmailAddress *PassportElementTypeEmailAddress) appendJSON(b []byte) ([]byte, error) {
+	if passportElementTypeEmailAddress == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementTypeEmailAddress.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementTypeEmailAddress.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (passportElementTypeEmailAddress *PassportElementTypeEmailAddress) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementTypeEmailAddress.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementTypeEmailAddress.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementTypeEmailAddress *PassportElementTypeEmailAddress) Clone() *PassportElementTypeEmailAddress {
+	if passportElementTypeEmailAddress == nil {
+		return nil
+	}
+	clone := *passportElementTypeEmailAddress
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementTypeEmailAddress *PassportElementTypeEmailAddress) Equal(other *PassportElementTypeEmailAddress) bool {
+	if passportElementTypeEmailAddress == nil || other == nil {
+		return passportElementTypeEmailAddress == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementTypeEmailAddress *PassportElementTypeEmailAddress) Diff(other *PassportElementTypeEmailAddress) []Change {
+	return passportElementTypeEmailAddress.diff("", other, nil)
+}
+
+func (passportElementTypeEmailAddress *PassportElementTypeEmailAddress) diff(path string, other *PassportElementTypeEmailAddress, changes []Change) []Change {
+	if passportElementTypeEmailAddress == nil || other == nil {
+		if passportElementTypeEmailAddress != other {
+			changes = append(changes, Change{Path: path, Old: passportElementTypeEmailAddress, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementTypeEmailAddress *PassportElementTypeEmailAddress) String() string {
+	return objectString(passportElementTypeEmailAddress)
+}
+
+// LogValue logs the object as its summary
+func (passportElementTypeEmailAddress *PassportElementTypeEmailAddress) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementTypeEmailAddress))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementTypeEmailAddress *PassportElementTypeEmailAddress) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementTypeEmailAddress)
+}
+
+func (passportElementTypeEmailAddress *PassportElementTypeEmailAddress) printText(p *printer) {
+	if passportElementTypeEmailAddress == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementTypeEmailAddress")
+	p.endObject(state)
+}
+
 // GetPassportElementTypeEnum return the enum type of this object
 func (passportElementTypeEmailAddress *PassportElementTypeEmailAddress) GetPassportElementTypeEnum() PassportElementTypeEnum {
 	return PassportElementTypeEmailAddressType
 }
+
+// Accept calls the method of the visitor for PassportElementTypeEmailAddress
+func (passportElementTypeEmailAddress *PassportElementTypeEmailAddress) Accept(visitor PassportElementTypeVisitor) {
+	visitor.VisitPassportElementTypeEmailAddress(passportElementTypeEmailAddress)
+}
+
+// PassportElementTypeVisitor visits every type of PassportElementType, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to PassportElementType, until they handle it too.
+type PassportElementTypeVisitor interface {
+	VisitPassportElementTypePersonalDetails(passportElementTypePersonalDetails *PassportElementTypePersonalDetails)
+	VisitPassportElementTypePassport(passportElementTypePassport *PassportElementTypePassport)
+	VisitPassportElementTypeDriverLicense(passportElementTypeDriverLicense *PassportElementTypeDriverLicense)
+	VisitPassportElementTypeIdentityCard(passportElementTypeIdentityCard *PassportElementTypeIdentityCard)
+	VisitPassportElementTypeInternalPassport(passportElementTypeInternalPassport *PassportElementTypeInternalPassport)
+	VisitPassportElementTypeAddress(passportElementTypeAddress *PassportElementTypeAddress)
+	VisitPassportElementTypeUtilityBill(passportElementTypeUtilityBill *PassportElementTypeUtilityBill)
+	VisitPassportElementTypeBankStatement(passportElementTypeBankStatement *PassportElementTypeBankStatement)
+	VisitPassportElementTypeRentalAgreement(passportElementTypeRentalAgreement *PassportElementTypeRentalAgreement)
+	VisitPassportElementTypePassportRegistration(passportElementTypePassportRegistration *PassportElementTypePassportRegistration)
+	VisitPassportElementTypeTemporaryRegistration(passportElementTypeTemporaryRegistration *PassportElementTypeTemporaryRegistration)
+	VisitPassportElementTypePhoneNumber(passportElementTypePhoneNumber *PassportElementTypePhoneNumber)
+	VisitPassportElementTypeEmailAddress(passportElementTypeEmailAddress *PassportElementTypeEmailAddress)
+	VisitUnknownPassportElementType(unknownPassportElementType *UnknownPassportElementType)
+}
+
+// PassportElementTypeCases holds the function MatchPassportElementType calls for each type of PassportElementType;
+// types without a function are passed to the default case
+type PassportElementTypeCases[R any] struct {
+	PassportElementTypePersonalDetails       func(passportElementTypePersonalDetails *PassportElementTypePersonalDetails) R
+	PassportElementTypePassport              func(passportElementTypePassport *PassportElementTypePassport) R
+	PassportElementTypeDriverLicense         func(passportElementTypeDriverLicense *PassportElementTypeDriverLicense) R
+	PassportElementTypeIdentityCard          func(passportElementTypeIdentityCard *PassportElementTypeIdentityCard) R
+	PassportElementTypeInternalPassport      func(passportElementTypeInternalPassport *PassportElementTypeInternalPassport) R
+	PassportElementTypeAddress               func(passportElementTypeAddress *PassportElementTypeAddress) R
+	PassportElementTypeUtilityBill           func(passportElementTypeUtilityBill *PassportElementTypeUtilityBill) R
+	PassportElementTypeBankStatement         func(passportElementTypeBankStatement *PassportElementTypeBankStatement) R
+	PassportElementTypeRentalAgreement       func(passportElementTypeRentalAgreement *PassportElementTypeRentalAgreement) R
+	PassportElementTypePassportRegistration  func(passportElementTypePassportRegistration *PassportElementTypePassportRegistration) R
+	PassportElementTypeTemporaryRegistration func(passportElementTypeTemporaryRegistration *PassportElementTypeTemporaryRegistration) R
+	PassportElementTypePhoneNumber           func(passportElementTypePhoneNumber *PassportElementTypePhoneNumber) R
+	PassportElementTypeEmailAddress          func(passportElementTypeEmailAddress *PassportElementTypeEmailAddress) R
+}
+
+// MatchPassportElementType calls the case of the type of passportElementType, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement PassportElementTypeVisitor
+// to have the compiler check every type is handled.
+func MatchPassportElementType[R any](passportElementType PassportElementType, cases PassportElementTypeCases[R], defaultCase func(passportElementType PassportElementType) R) R {
+	switch value := passportElementType.(type) {
+	case *PassportElementTypePersonalDetails:
+		if cases.PassportElementTypePersonalDetails != nil {
+			return cases.PassportElementTypePersonalDetails(value)
+		}
+	case *PassportElementTypePassport:
+		if cases.PassportElementTypePassport != nil {
+			return cases.PassportElementTypePassport(value)
+		}
+	case *PassportElementTypeDriverLicense:
+		if cases.PassportElementTypeDriverLicense != nil {
+			return cases.PassportElementTypeDriverLicense(value)
+		}
+	case *PassportElementTypeIdentityCard:
+		if cases.PassportElementTypeIdentityCard != nil {
+			return cases.PassportElementTypeIdentityCard(value)
+		}
+	case *PassportElementTypeInternalPassport:
+		if cases.PassportElementTypeInternalPassport != nil {
+			return cases.PassportElementTypeInternalPassport(value)
+		}
+	case *PassportElementTypeAddress:
+		if cases.PassportElementTypeAddress != nil {
+			return cases.PassportElementTypeAddress(value)
+		}
+	case *PassportElementTypeUtilityBill:
+		if cases.PassportElementTypeUtilityBill != nil {
+			return cases.PassportElementTypeUtilityBill(value)
+		}
+	case *PassportElementTypeBankStatement:
+		if cases.PassportElementTypeBankStatement != nil {
+			return cases.PassportElementTypeBankStatement(value)
+		}
+	case *PassportElementTypeRentalAgreement:
+		if cases.PassportElementTypeRentalAgreement != nil {
+			return cases.PassportElementTypeRentalAgreement(value)
+		}
+	case *PassportElementTypePassportRegistration:
+		if cases.PassportElementTypePassportRegistration != nil {
+			return cases.PassportElementTypePassportRegistration(value)
+		}
+	case *PassportElementTypeTemporaryRegistration:
+		if cases.PassportElementTypeTemporaryRegistration != nil {
+			return cases.PassportElementTypeTemporaryRegistration(value)
+		}
+	case *PassportElementTypePhoneNumber:
+		if cases.PassportElementTypePhoneNumber != nil {
+			return cases.PassportElementTypePhoneNumber(value)
+		}
+	case *PassportElementTypeEmailAddress:
+		if cases.PassportElementTypeEmailAddress != nil {
+			return cases.PassportElementTypeEmailAddress(value)
+		}
+	}
+	return defaultCase(passportElementType)
+}
+
+// Clone returns a deep copy of the object
+func (unknownPassportElementType *UnknownPassportElementType) Clone() *UnknownPassportElementType {
+	if unknownPassportElementType == nil {
+		return nil
+	}
+	return &UnknownPassportElementType{Unknown: unknownPassportElementType.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownPassportElementType *UnknownPassportElementType) Equal(other *UnknownPassportElementType) bool {
+	if unknownPassportElementType == nil || other == nil {
+		return unknownPassportElementType == other
+	}
+	return unknownPassportElementType.Unknown.equal(other.Unknown)
+}
+
+func clonePassportElementType(passportElementType PassportElementType) PassportElementType {
+	switch value := passportElementType.(type) {
+	case *PassportElementTypePersonalDetails:
+		return value.Clone()
+	case *PassportElementTypePassport:
+		return value.Clone()
+	case *PassportElementTypeDriverLicense:
+		return value.Clone()
+	case *PassportElementTypeIdentityCard:
+		return value.Clone()
+	case *PassportElementTypeInternalPassport:
+		return value.Clone()
+	case *PassportElementTypeAddress:
+		return value.Clone()
+	case *PassportElementTypeUtilityBill:
+		return value.Clone()
+	case *PassportElementTypeBankStatement:
+		return value.Clone()
+	case *PassportElementTypeRentalAgreement:
+		return value.Clone()
+	case *PassportElementTypePassportRegistration:
+		return value.Clone()
+	case *PassportElementTypeTemporaryRegistration:
+		return value.Clone()
+	case *PassportElementTypePhoneNumber:
+		return value.Clone()
+	case *PassportElementTypeEmailAddress:
+		return value.Clone()
+	case *UnknownPassportElementType:
+		return value.Clone()
+	}
+	return passportElementType
+}
+
+func equalPassportElementType(a PassportElementType, b PassportElementType) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *PassportElementTypePersonalDetails:
+		b, ok := b.(*PassportElementTypePersonalDetails)
+		return ok && a.Equal(b)
+	case *PassportElementTypePassport:
+		b, ok := b.(*PassportElementTypePassport)
+		return ok && a.Equal(b)
+	case *PassportElementTypeDriverLicense:
+		b, ok := b.(*PassportElementTypeDriverLicense)
+		return ok && a.Equal(b)
+	case *PassportElementTypeIdentityCard:
+		b, ok := b.(*PassportElementTypeIdentityCard)
+		return ok && a.Equal(b)
+	case *PassportElementTypeInternalPassport:
+		b, ok := b.(*PassportElementTypeInternalPassport)
+		return ok && a.Equal(b)
+	case *PassportElementTypeAddress:
+		b, ok := b.(*PassportElementTypeAddress)
+		return ok && a.Equal(b)
+	case *PassportElementTypeUtilityBill:
+		b, ok := b.(*PassportElementTypeUtilityBill)
+		return ok && a.Equal(b)
+	case *PassportElementTypeBankStatement:
+		b, ok := b.(*PassportElementTypeBankStatement)
+		return ok && a.Equal(b)
+	case *PassportElementTypeRentalAgreement:
+		b, ok := b.(*PassportElementTypeRentalAgreement)
+		return ok && a.Equal(b)
+	case *PassportElementTypePassportRegistration:
+		b, ok := b.(*PassportElementTypePassportRegistration)
+		return ok && a.Equal(b)
+	case *PassportElementTypeTemporaryRegistration:
+		b, ok := b.(*PassportElementTypeTemporaryRegistration)
+		return ok && a.Equal(b)
+	case *PassportElementTypePhoneNumber:
+		b, ok := b.(*PassportElementTypePhoneNumber)
+		return ok && a.Equal(b)
+	case *PassportElementTypeEmailAddress:
+		b, ok := b.(*PassportElementTypeEmailAddress)
+		return ok && a.Equal(b)
+	case *UnknownPassportElementType:
+		b, ok := b.(*UnknownPassportElementType)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffPassportElementType(path string, a PassportElementType, b PassportElementType, changes []Change) []Change {
+	switch a := a.(type) {
+	case *PassportElementTypePersonalDetails:
+		if b, ok := b.(*PassportElementTypePersonalDetails); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementTypePassport:
+		if b, ok := b.(*PassportElementTypePassport); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementTypeDriverLicense:
+		if b, ok := b.(*PassportElementTypeDriverLicense); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementTypeIdentityCard:
+		if b, ok := b.(*PassportElementTypeIdentityCard); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementTypeInternalPassport:
+		if b, ok := b.(*PassportElementTypeInternalPassport); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementTypeAddress:
+		if b, ok := b.(*PassportElementTypeAddress); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementTypeUtilityBill:
+		if b, ok := b.(*PassportElementTypeUtilityBill); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementTypeBankStatement:
+		if b, ok := b.(*PassportElementTypeBankStatement); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementTypeRentalAgreement:
+		if b, ok := b.(*PassportElementTypeRentalAgreement); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementTypePassportRegistration:
+		if b, ok := b.(*PassportElementTypePassportRegistration); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementTypeTemporaryRegistration:
+		if b, ok := b.(*PassportElementTypeTemporaryRegistration); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementTypePhoneNumber:
+		if b, ok := b.(*PassportElementTypePhoneNumber); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PassportElementTypeEmailAddress:
+		if b, ok := b.(*PassportElementTypeEmailAddress); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalPassportElementType(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
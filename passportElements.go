@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // PassportElements Contains information about saved Telegram Passport elements
@@ -28,6 +28,150 @@ func NewPassportElements(elements []PassportElement) *PassportElements {
 	return &passportElementsTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElements *PassportElements) MarshalJSON() ([]byte, error) {
+	return passportElements.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElements *PassportElements) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElements)
+}
+
+func (passportElements *PassportElements) appendJSON(b []byte) ([]byte, error) {
+	if passportElements == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElements.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElements.tdCommon.Extra)
+	b = append(b, `,"elements":`...)
+	if passportElements.Elements == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range passportElements.Elements {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = appendJSONValue(b, passportElements.Elements[i0])
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (passportElements *PassportElements) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElements.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElements.tdCommon.Extra, err = r.readString()
+		case "elements":
+			if r.readNull() {
+				passportElements.Elements = nil
+			} else {
+				passportElements.Elements = make([]PassportElement, 0)
+				err = r.readArray(func() (err error) {
+					var item0 PassportElement
+					item0, err = decodePassportElement(r)
+					passportElements.Elements = append(passportElements.Elements, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElements *PassportElements) Clone() *PassportElements {
+	if passportElements == nil {
+		return nil
+	}
+	clone := *passportElements
+	if passportElements.Elements != nil {
+		clone.Elements = make([]PassportElement, len(passportElements.Elements))
+		for i0 := range passportElements.Elements {
+			clone.Elements[i0] = clonePassportElement(passportElements.Elements[i0])
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElements *PassportElements) Equal(other *PassportElements) bool {
+	if passportElements == nil || other == nil {
+		return passportElements == other
+	}
+	if len(passportElements.Elements) != len(other.Elements) {
+		return false
+	}
+	for i0 := range passportElements.Elements {
+		if !equalPassportElement(passportElements.Elements[i0], other.Elements[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElements *PassportElements) Diff(other *PassportElements) []Change {
+	return passportElements.diff("", other, nil)
+}
+
+func (passportElements *PassportElements) diff(path string, other *PassportElements, changes []Change) []Change {
+	if passportElements == nil || other == nil {
+		if passportElements != other {
+			changes = append(changes, Change{Path: path, Old: passportElements, New: other})
+		}
+		return changes
+	}
+	if len(passportElements.Elements) != len(other.Elements) {
+		changes = append(changes, Change{Path: fieldPath(path, "elements"), Old: passportElements.Elements, New: other.Elements})
+	} else {
+		for i0 := range passportElements.Elements {
+			changes = diffPassportElement(indexPath(fieldPath(path, "elements"), i0), passportElements.Elements[i0], other.Elements[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElements *PassportElements) String() string {
+	return objectString(passportElements)
+}
+
+// LogValue logs the object as its summary
+func (passportElements *PassportElements) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElements))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElements *PassportElements) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElements)
+}
+
+func (passportElements *PassportElements) printText(p *printer) {
+	if passportElements == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElements")
+	p.vector("elements", len(passportElements.Elements), func(i0 int) {
+		p.object("elements", passportElements.Elements[i0])
+	})
+	p.endObject(state)
+}
+
 // GetAllPassportElements Returns all available Telegram Passport elements
 // @param password Password of the current user
 func (client *Client) GetAllPassportElements(password string) (*PassportElements, error) {
@@ -40,11 +184,11 @@ func (client *Client) GetAllPassportElements(password string) (*PassportElements
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var passportElements PassportElements
-	err = json.Unmarshal(result.Raw, &passportElements)
+	err = jsonUnmarshal(result.Raw, &passportElements)
 	return &passportElements, err
 }
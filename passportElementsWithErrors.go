@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // PassportElementsWithErrors Contains information about a Telegram Passport elements and corresponding errors
@@ -31,6 +31,202 @@ func NewPassportElementsWithErrors(elements []PassportElement, errors []Passport
 	return &passportElementsWithErrorsTemp
 }
 
+// MarshalJSON marshals to json
+func (passportElementsWithErrors *PassportElementsWithErrors) MarshalJSON() ([]byte, error) {
+	return passportElementsWithErrors.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportElementsWithErrors *PassportElementsWithErrors) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportElementsWithErrors)
+}
+
+func (passportElementsWithErrors *PassportElementsWithErrors) appendJSON(b []byte) ([]byte, error) {
+	if passportElementsWithErrors == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportElementsWithErrors.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportElementsWithErrors.tdCommon.Extra)
+	b = append(b, `,"elements":`...)
+	if passportElementsWithErrors.Elements == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range passportElementsWithErrors.Elements {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = appendJSONValue(b, passportElementsWithErrors.Elements[i0])
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"errors":`...)
+	if passportElementsWithErrors.Errors == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range passportElementsWithErrors.Errors {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = passportElementsWithErrors.Errors[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (passportElementsWithErrors *PassportElementsWithErrors) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportElementsWithErrors.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportElementsWithErrors.tdCommon.Extra, err = r.readString()
+		case "elements":
+			if r.readNull() {
+				passportElementsWithErrors.Elements = nil
+			} else {
+				passportElementsWithErrors.Elements = make([]PassportElement, 0)
+				err = r.readArray(func() (err error) {
+					var item0 PassportElement
+					item0, err = decodePassportElement(r)
+					passportElementsWithErrors.Elements = append(passportElementsWithErrors.Elements, item0)
+					return
+				})
+			}
+		case "errors":
+			if r.readNull() {
+				passportElementsWithErrors.Errors = nil
+			} else {
+				passportElementsWithErrors.Errors = make([]PassportElementError, 0)
+				err = r.readArray(func() (err error) {
+					var item0 PassportElementError
+					err = item0.decodeJSON(r)
+					passportElementsWithErrors.Errors = append(passportElementsWithErrors.Errors, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportElementsWithErrors *PassportElementsWithErrors) Clone() *PassportElementsWithErrors {
+	if passportElementsWithErrors == nil {
+		return nil
+	}
+	clone := *passportElementsWithErrors
+	if passportElementsWithErrors.Elements != nil {
+		clone.Elements = make([]PassportElement, len(passportElementsWithErrors.Elements))
+		for i0 := range passportElementsWithErrors.Elements {
+			clone.Elements[i0] = clonePassportElement(passportElementsWithErrors.Elements[i0])
+		}
+	}
+	if passportElementsWithErrors.Errors != nil {
+		clone.Errors = make([]PassportElementError, len(passportElementsWithErrors.Errors))
+		for i0 := range passportElementsWithErrors.Errors {
+			clone.Errors[i0] = *passportElementsWithErrors.Errors[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportElementsWithErrors *PassportElementsWithErrors) Equal(other *PassportElementsWithErrors) bool {
+	if passportElementsWithErrors == nil || other == nil {
+		return passportElementsWithErrors == other
+	}
+	if len(passportElementsWithErrors.Elements) != len(other.Elements) {
+		return false
+	}
+	for i0 := range passportElementsWithErrors.Elements {
+		if !equalPassportElement(passportElementsWithErrors.Elements[i0], other.Elements[i0]) {
+			return false
+		}
+	}
+	if len(passportElementsWithErrors.Errors) != len(other.Errors) {
+		return false
+	}
+	for i0 := range passportElementsWithErrors.Errors {
+		if !passportElementsWithErrors.Errors[i0].Equal(&other.Errors[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportElementsWithErrors *PassportElementsWithErrors) Diff(other *PassportElementsWithErrors) []Change {
+	return passportElementsWithErrors.diff("", other, nil)
+}
+
+func (passportElementsWithErrors *PassportElementsWithErrors) diff(path string, other *PassportElementsWithErrors, changes []Change) []Change {
+	if passportElementsWithErrors == nil || other == nil {
+		if passportElementsWithErrors != other {
+			changes = append(changes, Change{Path: path, Old: passportElementsWithErrors, New: other})
+		}
+		return changes
+	}
+	if len(passportElementsWithErrors.Elements) != len(other.Elements) {
+		changes = append(changes, Change{Path: fieldPath(path, "elements"), Old: passportElementsWithErrors.Elements, New: other.Elements})
+	} else {
+		for i0 := range passportElementsWithErrors.Elements {
+			changes = diffPassportElement(indexPath(fieldPath(path, "elements"), i0), passportElementsWithErrors.Elements[i0], other.Elements[i0], changes)
+		}
+	}
+	if len(passportElementsWithErrors.Errors) != len(other.Errors) {
+		changes = append(changes, Change{Path: fieldPath(path, "errors"), Old: passportElementsWithErrors.Errors, New: other.Errors})
+	} else {
+		for i0 := range passportElementsWithErrors.Errors {
+			changes = passportElementsWithErrors.Errors[i0].diff(indexPath(fieldPath(path, "errors"), i0), &other.Errors[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportElementsWithErrors *PassportElementsWithErrors) String() string {
+	return objectString(passportElementsWithErrors)
+}
+
+// LogValue logs the object as its summary
+func (passportElementsWithErrors *PassportElementsWithErrors) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportElementsWithErrors))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportElementsWithErrors *PassportElementsWithErrors) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportElementsWithErrors)
+}
+
+func (passportElementsWithErrors *PassportElementsWithErrors) printText(p *printer) {
+	if passportElementsWithErrors == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportElementsWithErrors")
+	p.vector("elements", len(passportElementsWithErrors.Elements), func(i0 int) {
+		p.object("elements", passportElementsWithErrors.Elements[i0])
+	})
+	p.vector("errors", len(passportElementsWithErrors.Errors), func(i0 int) {
+		passportElementsWithErrors.Errors[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetPassportAuthorizationFormAvailableElements Returns already available Telegram Passport elements suitable for completing a Telegram Passport authorization form. Result can be received only once for each authorization form
 // @param autorizationFormId Authorization form identifier
 // @param password Password of the current user
@@ -45,11 +241,11 @@ func (client *Client) GetPassportAuthorizationFormAvailableElements(autorization
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var passportElementsWithErrors PassportElementsWithErrors
-	err = json.Unmarshal(result.Raw, &passportElementsWithErrors)
+	err = jsonUnmarshal(result.Raw, &passportElementsWithErrors)
 	return &passportElementsWithErrors, err
 }
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // PassportRequiredElement Contains a description of the required Telegram Passport element that was requested by a service
 type PassportRequiredElement struct {
 	tdCommon
@@ -22,3 +27,147 @@ func NewPassportRequiredElement(suitableElements []PassportSuitableElement) *Pas
 
 	return &passportRequiredElementTemp
 }
+
+// MarshalJSON marshals to json
+func (passportRequiredElement *PassportRequiredElement) MarshalJSON() ([]byte, error) {
+	return passportRequiredElement.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passportRequiredElement *PassportRequiredElement) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passportRequiredElement)
+}
+
+func (passportRequiredElement *PassportRequiredElement) appendJSON(b []byte) ([]byte, error) {
+	if passportRequiredElement == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportRequiredElement.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportRequiredElement.tdCommon.Extra)
+	b = append(b, `,"suitable_elements":`...)
+	if passportRequiredElement.SuitableElements == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range passportRequiredElement.SuitableElements {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = passportRequiredElement.SuitableElements[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (passportRequiredElement *PassportRequiredElement) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportRequiredElement.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportRequiredElement.tdCommon.Extra, err = r.readString()
+		case "suitable_elements":
+			if r.readNull() {
+				passportRequiredElement.SuitableElements = nil
+			} else {
+				passportRequiredElement.SuitableElements = make([]PassportSuitableElement, 0)
+				err = r.readArray(func() (err error) {
+					var item0 PassportSuitableElement
+					err = item0.decodeJSON(r)
+					passportRequiredElement.SuitableElements = append(passportRequiredElement.SuitableElements, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passportRequiredElement *PassportRequiredElement) Clone() *PassportRequiredElement {
+	if passportRequiredElement == nil {
+		return nil
+	}
+	clone := *passportRequiredElement
+	if passportRequiredElement.SuitableElements != nil {
+		clone.SuitableElements = make([]PassportSuitableElement, len(passportRequiredElement.SuitableElements))
+		for i0 := range passportRequiredElement.SuitableElements {
+			clone.SuitableElements[i0] = *passportRequiredElement.SuitableElements[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportRequiredElement *PassportRequiredElement) Equal(other *PassportRequiredElement) bool {
+	if passportRequiredElement == nil || other == nil {
+		return passportRequiredElement == other
+	}
+	if len(passportRequiredElement.SuitableElements) != len(other.SuitableElements) {
+		return false
+	}
+	for i0 := range passportRequiredElement.SuitableElements {
+		if !passportRequiredElement.SuitableElements[i0].Equal(&other.SuitableElements[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportRequiredElement *PassportRequiredElement) Diff(other *PassportRequiredElement) []Change {
+	return passportRequiredElement.diff("", other, nil)
+}
+
+func (passportRequiredElement *PassportRequiredElement) diff(path string, other *PassportRequiredElement, changes []Change) []Change {
+	if passportRequiredElement == nil || other == nil {
+		if passportRequiredElement != other {
+			changes = append(changes, Change{Path: path, Old: passportRequiredElement, New: other})
+		}
+		return changes
+	}
+	if len(passportRequiredElement.SuitableElements) != len(other.SuitableElements) {
+		changes = append(changes, Change{Path: fieldPath(path, "suitable_elements"), Old: passportRequiredElement.SuitableElements, New: other.SuitableElements})
+	} else {
+		for i0 := range passportRequiredElement.SuitableElements {
+			changes = passportRequiredElement.SuitableElements[i0].diff(indexPath(fieldPath(path, "suitable_elements"), i0), &other.SuitableElements[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportRequiredElement *PassportRequiredElement) String() string {
+	return objectString(passportRequiredElement)
+}
+
+// LogValue logs the object as its summary
+func (passportRequiredElement *PassportRequiredElement) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportRequiredElement))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportRequiredElement *PassportRequiredElement) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportRequiredElement)
+}
+
+func (passportRequiredElement *PassportRequiredElement) printText(p *printer) {
+	if passportRequiredElement == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportRequiredElement")
+	p.vector("suitable_elements", len(passportRequiredElement.SuitableElements), func(i0 int) {
+		passportRequiredElement.SuitableElements[i0].printText(p)
+	})
+	p.endObject(state)
+}
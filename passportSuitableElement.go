@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // PassportSuitableElement Contains information about a Telegram Passport element that was requested by a service
@@ -36,31 +37,140 @@ func NewPassportSuitableElement(typeParam PassportElementType, isSelfieRequired
 	return &passportSuitableElementTemp
 }
 
+// MarshalJSON marshals to json
+func (passportSuitableElement *PassportSuitableElement) MarshalJSON() ([]byte, error) {
+	return passportSuitableElement.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (passportSuitableElement *PassportSuitableElement) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, passportSuitableElement)
+}
+
+func (passportSuitableElement *PassportSuitableElement) appendJSON(b []byte) ([]byte, error) {
+	if passportSuitableElement == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-		IsSelfieRequired      bool `json:"is_selfie_required"`      // True, if a selfie is required with the identity document
-		IsTranslationRequired bool `json:"is_translation_required"` // True, if a certified English translation is required with the document
-		IsNativeNameRequired  bool `json:"is_native_name_required"` // True, if personal details must include the user's name in the language of their country of residence
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passportSuitableElement.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passportSuitableElement.tdCommon.Extra)
+	b = append(b, `,"type":`...)
+	b, err = appendJSONValue(b, passportSuitableElement.Type)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	b = append(b, `,"is_selfie_required":`...)
+	b = appendJSONBool(b, passportSuitableElement.IsSelfieRequired)
+	b = append(b, `,"is_translation_required":`...)
+	b = appendJSONBool(b, passportSuitableElement.IsTranslationRequired)
+	b = append(b, `,"is_native_name_required":`...)
+	b = appendJSONBool(b, passportSuitableElement.IsNativeNameRequired)
+	return append(b, '}'), nil
+}
+
+func (passportSuitableElement *PassportSuitableElement) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passportSuitableElement.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passportSuitableElement.tdCommon.Extra, err = r.readString()
+		case "type":
+			passportSuitableElement.Type, err = decodePassportElementType(r)
+		case "is_selfie_required":
+			passportSuitableElement.IsSelfieRequired, err = r.readBool()
+		case "is_translation_required":
+			passportSuitableElement.IsTranslationRequired, err = r.readBool()
+		case "is_native_name_required":
+			passportSuitableElement.IsNativeNameRequired, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	passportSuitableElement.tdCommon = tempObj.tdCommon
-	passportSuitableElement.IsSelfieRequired = tempObj.IsSelfieRequired
-	passportSuitableElement.IsTranslationRequired = tempObj.IsTranslationRequired
-	passportSuitableElement.IsNativeNameRequired = tempObj.IsNativeNameRequired
+// Clone returns a deep copy of the object
+func (passportSuitableElement *PassportSuitableElement) Clone() *PassportSuitableElement {
+	if passportSuitableElement == nil {
+		return nil
+	}
+	clone := *passportSuitableElement
+	clone.Type = clonePassportElementType(passportSuitableElement.Type)
+	return &clone
+}
 
-	fieldType, _ := unmarshalPassportElementType(objMap["type"])
-	passportSuitableElement.Type = fieldType
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passportSuitableElement *PassportSuitableElement) Equal(other *PassportSuitableElement) bool {
+	if passportSuitableElement == nil || other == nil {
+		return passportSuitableElement == other
+	}
+	if !equalPassportElementType(passportSuitableElement.Type, other.Type) {
+		return false
+	}
+	if passportSuitableElement.IsSelfieRequired != other.IsSelfieRequired {
+		return false
+	}
+	if passportSuitableElement.IsTranslationRequired != other.IsTranslationRequired {
+		return false
+	}
+	if passportSuitableElement.IsNativeNameRequired != other.IsNativeNameRequired {
+		return false
+	}
+	return true
+}
 
-	return nil
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passportSuitableElement *PassportSuitableElement) Diff(other *PassportSuitableElement) []Change {
+	return passportSuitableElement.diff("", other, nil)
+}
+
+func (passportSuitableElement *PassportSuitableElement) diff(path string, other *PassportSuitableElement, changes []Change) []Change {
+	if passportSuitableElement == nil || other == nil {
+		if passportSuitableElement != other {
+			changes = append(changes, Change{Path: path, Old: passportSuitableElement, New: other})
+		}
+		return changes
+	}
+	changes = diffPassportElementType(fieldPath(path, "type"), passportSuitableElement.Type, other.Type, changes)
+	if passportSuitableElement.IsSelfieRequired != other.IsSelfieRequired {
+		changes = append(changes, Change{Path: fieldPath(path, "is_selfie_required"), Old: passportSuitableElement.IsSelfieRequired, New: other.IsSelfieRequired})
+	}
+	if passportSuitableElement.IsTranslationRequired != other.IsTranslationRequired {
+		changes = append(changes, Change{Path: fieldPath(path, "is_translation_required"), Old: passportSuitableElement.IsTranslationRequired, New: other.IsTranslationRequired})
+	}
+	if passportSuitableElement.IsNativeNameRequired != other.IsNativeNameRequired {
+		changes = append(changes, Change{Path: fieldPath(path, "is_native_name_required"), Old: passportSuitableElement.IsNativeNameRequired, New: other.IsNativeNameRequired})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passportSuitableElement *PassportSuitableElement) String() string {
+	return objectString(passportSuitableElement)
+}
+
+// LogValue logs the object as its summary
+func (passportSuitableElement *PassportSuitableElement) LogValue() slog.Value {
+	return slog.StringValue(objectString(passportSuitableElement))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passportSuitableElement *PassportSuitableElement) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passportSuitableElement)
+}
+
+func (passportSuitableElement *PassportSuitableElement) printText(p *printer) {
+	if passportSuitableElement == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PassportSuitableElement")
+	p.object("type", passportSuitableElement.Type)
+	p.bool("is_selfie_required", passportSuitableElement.IsSelfieRequired)
+	p.bool("is_translation_required", passportSuitableElement.IsTranslationRequired)
+	p.bool("is_native_name_required", passportSuitableElement.IsNativeNameRequired)
+	p.endObject(state)
 }
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // PasswordState Represents the current state of 2-step verification
@@ -43,6 +43,171 @@ func NewPasswordState(hasPassword bool, passwordHint string, hasRecoveryEmailAdd
 	return &passwordStateTemp
 }
 
+// MarshalJSON marshals to json
+func (passwordState *PasswordState) MarshalJSON() ([]byte, error) {
+	return passwordState.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (passwordState *PasswordState) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, passwordState)
+}
+
+func (passwordState *PasswordState) appendJSON(b []byte) ([]byte, error) {
+	if passwordState == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, passwordState.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, passwordState.tdCommon.Extra)
+	b = append(b, `,"has_password":`...)
+	b = appendJSONBool(b, passwordState.HasPassword)
+	b = append(b, `,"password_hint":`...)
+	b = appendJSONString(b, passwordState.PasswordHint)
+	b = append(b, `,"has_recovery_email_address":`...)
+	b = appendJSONBool(b, passwordState.HasRecoveryEmailAddress)
+	b = append(b, `,"has_passport_data":`...)
+	b = appendJSONBool(b, passwordState.HasPassportData)
+	b = append(b, `,"recovery_email_address_code_info":`...)
+	b, err = passwordState.RecoveryEmailAddressCodeInfo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"pending_reset_date":`...)
+	b = appendJSONInt(b, int64(passwordState.PendingResetDate))
+	return append(b, '}'), nil
+}
+
+func (passwordState *PasswordState) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			passwordState.tdCommon.Type, err = r.readString()
+		case "@extra":
+			passwordState.tdCommon.Extra, err = r.readString()
+		case "has_password":
+			passwordState.HasPassword, err = r.readBool()
+		case "password_hint":
+			passwordState.PasswordHint, err = r.readString()
+		case "has_recovery_email_address":
+			passwordState.HasRecoveryEmailAddress, err = r.readBool()
+		case "has_passport_data":
+			passwordState.HasPassportData, err = r.readBool()
+		case "recovery_email_address_code_info":
+			if r.readNull() {
+				passwordState.RecoveryEmailAddressCodeInfo = nil
+			} else {
+				passwordState.RecoveryEmailAddressCodeInfo = new(EmailAddressAuthenticationCodeInfo)
+				err = passwordState.RecoveryEmailAddressCodeInfo.decodeJSON(r)
+			}
+		case "pending_reset_date":
+			passwordState.PendingResetDate, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (passwordState *PasswordState) Clone() *PasswordState {
+	if passwordState == nil {
+		return nil
+	}
+	clone := *passwordState
+	clone.RecoveryEmailAddressCodeInfo = passwordState.RecoveryEmailAddressCodeInfo.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (passwordState *PasswordState) Equal(other *PasswordState) bool {
+	if passwordState == nil || other == nil {
+		return passwordState == other
+	}
+	if passwordState.HasPassword != other.HasPassword {
+		return false
+	}
+	if passwordState.PasswordHint != other.PasswordHint {
+		return false
+	}
+	if passwordState.HasRecoveryEmailAddress != other.HasRecoveryEmailAddress {
+		return false
+	}
+	if passwordState.HasPassportData != other.HasPassportData {
+		return false
+	}
+	if !passwordState.RecoveryEmailAddressCodeInfo.Equal(other.RecoveryEmailAddressCodeInfo) {
+		return false
+	}
+	if passwordState.PendingResetDate != other.PendingResetDate {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (passwordState *PasswordState) Diff(other *PasswordState) []Change {
+	return passwordState.diff("", other, nil)
+}
+
+func (passwordState *PasswordState) diff(path string, other *PasswordState, changes []Change) []Change {
+	if passwordState == nil || other == nil {
+		if passwordState != other {
+			changes = append(changes, Change{Path: path, Old: passwordState, New: other})
+		}
+		return changes
+	}
+	if passwordState.HasPassword != other.HasPassword {
+		changes = append(changes, Change{Path: fieldPath(path, "has_password"), Old: passwordState.HasPassword, New: other.HasPassword})
+	}
+	if passwordState.PasswordHint != other.PasswordHint {
+		changes = append(changes, Change{Path: fieldPath(path, "password_hint"), Old: passwordState.PasswordHint, New: other.PasswordHint})
+	}
+	if passwordState.HasRecoveryEmailAddress != other.HasRecoveryEmailAddress {
+		changes = append(changes, Change{Path: fieldPath(path, "has_recovery_email_address"), Old: passwordState.HasRecoveryEmailAddress, New: other.HasRecoveryEmailAddress})
+	}
+	if passwordState.HasPassportData != other.HasPassportData {
+		changes = append(changes, Change{Path: fieldPath(path, "has_passport_data"), Old: passwordState.HasPassportData, New: other.HasPassportData})
+	}
+	changes = passwordState.RecoveryEmailAddressCodeInfo.diff(fieldPath(path, "recovery_email_address_code_info"), other.RecoveryEmailAddressCodeInfo, changes)
+	if passwordState.PendingResetDate != other.PendingResetDate {
+		changes = append(changes, Change{Path: fieldPath(path, "pending_reset_date"), Old: passwordState.PendingResetDate, New: other.PendingResetDate})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (passwordState *PasswordState) String() string {
+	return objectString(passwordState)
+}
+
+// LogValue logs the object as its summary
+func (passwordState *PasswordState) LogValue() slog.Value {
+	return slog.StringValue(objectString(passwordState))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (passwordState *PasswordState) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, passwordState)
+}
+
+func (passwordState *PasswordState) printText(p *printer) {
+	if passwordState == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PasswordState")
+	p.bool("has_password", passwordState.HasPassword)
+	p.string("password_hint", passwordState.PasswordHint)
+	p.bool("has_recovery_email_address", passwordState.HasRecoveryEmailAddress)
+	p.bool("has_passport_data", passwordState.HasPassportData)
+	p.object("recovery_email_address_code_info", passwordState.RecoveryEmailAddressCodeInfo)
+	p.int("pending_reset_date", int64(passwordState.PendingResetDate))
+	p.endObject(state)
+}
+
 // GetPasswordState Returns the current state of 2-step verification
 func (client *Client) GetPasswordState() (*PasswordState, error) {
 	result, err := client.SendAndCatch(UpdateData{
@@ -53,12 +218,12 @@ func (client *Client) GetPasswordState() (*PasswordState, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var passwordState PasswordState
-	err = json.Unmarshal(result.Raw, &passwordState)
+	err = jsonUnmarshal(result.Raw, &passwordState)
 	return &passwordState, err
 }
 
@@ -82,12 +247,12 @@ func (client *Client) SetPassword(oldPassword string, newPassword string, newHin
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var passwordState PasswordState
-	err = json.Unmarshal(result.Raw, &passwordState)
+	err = jsonUnmarshal(result.Raw, &passwordState)
 	return &passwordState, err
 }
 
@@ -105,12 +270,12 @@ func (client *Client) SetRecoveryEmailAddress(password string, newRecoveryEmailA
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var passwordState PasswordState
-	err = json.Unmarshal(result.Raw, &passwordState)
+	err = jsonUnmarshal(result.Raw, &passwordState)
 	return &passwordState, err
 }
 
@@ -126,12 +291,12 @@ func (client *Client) CheckRecoveryEmailAddressCode(code string) (*PasswordState
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var passwordState PasswordState
-	err = json.Unmarshal(result.Raw, &passwordState)
+	err = jsonUnmarshal(result.Raw, &passwordState)
 	return &passwordState, err
 }
 
@@ -145,12 +310,12 @@ func (client *Client) ResendRecoveryEmailAddressCode() (*PasswordState, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var passwordState PasswordState
-	err = json.Unmarshal(result.Raw, &passwordState)
+	err = jsonUnmarshal(result.Raw, &passwordState)
 	return &passwordState, err
 }
 
@@ -170,11 +335,11 @@ func (client *Client) RecoverPassword(recoveryCode string, newPassword string, n
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var passwordState PasswordState
-	err = json.Unmarshal(result.Raw, &passwordState)
+	err = jsonUnmarshal(result.Raw, &passwordState)
 	return &passwordState, err
 }
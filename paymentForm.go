@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // PaymentForm Contains information about an invoice payment form
@@ -55,6 +55,236 @@ func NewPaymentForm(id JSONInt64, invoice *Invoice, url string, sellerBotUserId
 	return &paymentFormTemp
 }
 
+// MarshalJSON marshals to json
+func (paymentForm *PaymentForm) MarshalJSON() ([]byte, error) {
+	return paymentForm.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (paymentForm *PaymentForm) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, paymentForm)
+}
+
+func (paymentForm *PaymentForm) appendJSON(b []byte) ([]byte, error) {
+	if paymentForm == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, paymentForm.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, paymentForm.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt64String(b, int64(paymentForm.Id))
+	b = append(b, `,"invoice":`...)
+	b, err = paymentForm.Invoice.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"url":`...)
+	b = appendJSONString(b, paymentForm.Url)
+	b = append(b, `,"seller_bot_user_id":`...)
+	b = appendJSONInt(b, int64(paymentForm.SellerBotUserId))
+	b = append(b, `,"payments_provider_user_id":`...)
+	b = appendJSONInt(b, int64(paymentForm.PaymentsProviderUserId))
+	b = append(b, `,"payments_provider":`...)
+	b, err = paymentForm.PaymentsProvider.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"saved_order_info":`...)
+	b, err = paymentForm.SavedOrderInfo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"saved_credentials":`...)
+	b, err = paymentForm.SavedCredentials.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"can_save_credentials":`...)
+	b = appendJSONBool(b, paymentForm.CanSaveCredentials)
+	b = append(b, `,"need_password":`...)
+	b = appendJSONBool(b, paymentForm.NeedPassword)
+	return append(b, '}'), nil
+}
+
+func (paymentForm *PaymentForm) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			paymentForm.tdCommon.Type, err = r.readString()
+		case "@extra":
+			paymentForm.tdCommon.Extra, err = r.readString()
+		case "id":
+			paymentForm.Id, err = r.readJSONInt64()
+		case "invoice":
+			if r.readNull() {
+				paymentForm.Invoice = nil
+			} else {
+				paymentForm.Invoice = new(Invoice)
+				err = paymentForm.Invoice.decodeJSON(r)
+			}
+		case "url":
+			paymentForm.Url, err = r.readString()
+		case "seller_bot_user_id":
+			paymentForm.SellerBotUserId, err = r.readInt64()
+		case "payments_provider_user_id":
+			paymentForm.PaymentsProviderUserId, err = r.readInt64()
+		case "payments_provider":
+			if r.readNull() {
+				paymentForm.PaymentsProvider = nil
+			} else {
+				paymentForm.PaymentsProvider = new(PaymentsProviderStripe)
+				err = paymentForm.PaymentsProvider.decodeJSON(r)
+			}
+		case "saved_order_info":
+			if r.readNull() {
+				paymentForm.SavedOrderInfo = nil
+			} else {
+				paymentForm.SavedOrderInfo = new(OrderInfo)
+				err = paymentForm.SavedOrderInfo.decodeJSON(r)
+			}
+		case "saved_credentials":
+			if r.readNull() {
+				paymentForm.SavedCredentials = nil
+			} else {
+				paymentForm.SavedCredentials = new(SavedCredentials)
+				err = paymentForm.SavedCredentials.decodeJSON(r)
+			}
+		case "can_save_credentials":
+			paymentForm.CanSaveCredentials, err = r.readBool()
+		case "need_password":
+			paymentForm.NeedPassword, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (paymentForm *PaymentForm) Clone() *PaymentForm {
+	if paymentForm == nil {
+		return nil
+	}
+	clone := *paymentForm
+	clone.Invoice = paymentForm.Invoice.Clone()
+	clone.PaymentsProvider = paymentForm.PaymentsProvider.Clone()
+	clone.SavedOrderInfo = paymentForm.SavedOrderInfo.Clone()
+	clone.SavedCredentials = paymentForm.SavedCredentials.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (paymentForm *PaymentForm) Equal(other *PaymentForm) bool {
+	if paymentForm == nil || other == nil {
+		return paymentForm == other
+	}
+	if paymentForm.Id != other.Id {
+		return false
+	}
+	if !paymentForm.Invoice.Equal(other.Invoice) {
+		return false
+	}
+	if paymentForm.Url != other.Url {
+		return false
+	}
+	if paymentForm.SellerBotUserId != other.SellerBotUserId {
+		return false
+	}
+	if paymentForm.PaymentsProviderUserId != other.PaymentsProviderUserId {
+		return false
+	}
+	if !paymentForm.PaymentsProvider.Equal(other.PaymentsProvider) {
+		return false
+	}
+	if !paymentForm.SavedOrderInfo.Equal(other.SavedOrderInfo) {
+		return false
+	}
+	if !paymentForm.SavedCredentials.Equal(other.SavedCredentials) {
+		return false
+	}
+	if paymentForm.CanSaveCredentials != other.CanSaveCredentials {
+		return false
+	}
+	if paymentForm.NeedPassword != other.NeedPassword {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (paymentForm *PaymentForm) Diff(other *PaymentForm) []Change {
+	return paymentForm.diff("", other, nil)
+}
+
+func (paymentForm *PaymentForm) diff(path string, other *PaymentForm, changes []Change) []Change {
+	if paymentForm == nil || other == nil {
+		if paymentForm != other {
+			changes = append(changes, Change{Path: path, Old: paymentForm, New: other})
+		}
+		return changes
+	}
+	if paymentForm.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: paymentForm.Id, New: other.Id})
+	}
+	changes = paymentForm.Invoice.diff(fieldPath(path, "invoice"), other.Invoice, changes)
+	if paymentForm.Url != other.Url {
+		changes = append(changes, Change{Path: fieldPath(path, "url"), Old: paymentForm.Url, New: other.Url})
+	}
+	if paymentForm.SellerBotUserId != other.SellerBotUserId {
+		changes = append(changes, Change{Path: fieldPath(path, "seller_bot_user_id"), Old: paymentForm.SellerBotUserId, New: other.SellerBotUserId})
+	}
+	if paymentForm.PaymentsProviderUserId != other.PaymentsProviderUserId {
+		changes = append(changes, Change{Path: fieldPath(path, "payments_provider_user_id"), Old: paymentForm.PaymentsProviderUserId, New: other.PaymentsProviderUserId})
+	}
+	changes = paymentForm.PaymentsProvider.diff(fieldPath(path, "payments_provider"), other.PaymentsProvider, changes)
+	changes = paymentForm.SavedOrderInfo.diff(fieldPath(path, "saved_order_info"), other.SavedOrderInfo, changes)
+	changes = paymentForm.SavedCredentials.diff(fieldPath(path, "saved_credentials"), other.SavedCredentials, changes)
+	if paymentForm.CanSaveCredentials != other.CanSaveCredentials {
+		changes = append(changes, Change{Path: fieldPath(path, "can_save_credentials"), Old: paymentForm.CanSaveCredentials, New: other.CanSaveCredentials})
+	}
+	if paymentForm.NeedPassword != other.NeedPassword {
+		changes = append(changes, Change{Path: fieldPath(path, "need_password"), Old: paymentForm.NeedPassword, New: other.NeedPassword})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (paymentForm *PaymentForm) String() string {
+	return objectString(paymentForm)
+}
+
+// LogValue logs the object as its summary
+func (paymentForm *PaymentForm) LogValue() slog.Value {
+	return slog.StringValue(objectString(paymentForm))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (paymentForm *PaymentForm) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, paymentForm)
+}
+
+func (paymentForm *PaymentForm) printText(p *printer) {
+	if paymentForm == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PaymentForm")
+	p.int("id", int64(paymentForm.Id))
+	p.object("invoice", paymentForm.Invoice)
+	p.string("url", paymentForm.Url)
+	p.int("seller_bot_user_id", int64(paymentForm.SellerBotUserId))
+	p.int("payments_provider_user_id", int64(paymentForm.PaymentsProviderUserId))
+	p.object("payments_provider", paymentForm.PaymentsProvider)
+	p.object("saved_order_info", paymentForm.SavedOrderInfo)
+	p.object("saved_credentials", paymentForm.SavedCredentials)
+	p.bool("can_save_credentials", paymentForm.CanSaveCredentials)
+	p.bool("need_password", paymentForm.NeedPassword)
+	p.endObject(state)
+}
+
 // GetPaymentForm Returns an invoice payment form. This method must be called when the user presses inlineKeyboardButtonBuy
 // @param chatId Chat identifier of the Invoice message
 // @param messageId Message identifier
@@ -71,11 +301,11 @@ func (client *Client) GetPaymentForm(chatId int64, messageId int64, theme *Payme
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var paymentForm PaymentForm
-	err = json.Unmarshal(result.Raw, &paymentForm)
+	err = jsonUnmarshal(result.Raw, &paymentForm)
 	return &paymentForm, err
 }
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // PaymentFormTheme Theme colors for a payment form
 type PaymentFormTheme struct {
 	tdCommon
@@ -37,3 +42,160 @@ func NewPaymentFormTheme(backgroundColor int32, textColor int32, hintColor int32
 
 	return &paymentFormThemeTemp
 }
+
+// MarshalJSON marshals to json
+func (paymentFormTheme *PaymentFormTheme) MarshalJSON() ([]byte, error) {
+	return paymentFormTheme.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (paymentFormTheme *PaymentFormTheme) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, paymentFormTheme)
+}
+
+func (paymentFormTheme *PaymentFormTheme) appendJSON(b []byte) ([]byte, error) {
+	if paymentFormTheme == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, paymentFormTheme.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, paymentFormTheme.tdCommon.Extra)
+	b = append(b, `,"background_color":`...)
+	b = appendJSONInt(b, int64(paymentFormTheme.BackgroundColor))
+	b = append(b, `,"text_color":`...)
+	b = appendJSONInt(b, int64(paymentFormTheme.TextColor))
+	b = append(b, `,"hint_color":`...)
+	b = appendJSONInt(b, int64(paymentFormTheme.HintColor))
+	b = append(b, `,"link_color":`...)
+	b = appendJSONInt(b, int64(paymentFormTheme.LinkColor))
+	b = append(b, `,"button_color":`...)
+	b = appendJSONInt(b, int64(paymentFormTheme.ButtonColor))
+	b = append(b, `,"button_text_color":`...)
+	b = appendJSONInt(b, int64(paymentFormTheme.ButtonTextColor))
+	return append(b, '}'), nil
+}
+
+func (paymentFormTheme *PaymentFormTheme) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			paymentFormTheme.tdCommon.Type, err = r.readString()
+		case "@extra":
+			paymentFormTheme.tdCommon.Extra, err = r.readString()
+		case "background_color":
+			paymentFormTheme.BackgroundColor, err = r.readInt32()
+		case "text_color":
+			paymentFormTheme.TextColor, err = r.readInt32()
+		case "hint_color":
+			paymentFormTheme.HintColor, err = r.readInt32()
+		case "link_color":
+			paymentFormTheme.LinkColor, err = r.readInt32()
+		case "button_color":
+			paymentFormTheme.ButtonColor, err = r.readInt32()
+		case "button_text_color":
+			paymentFormTheme.ButtonTextColor, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (paymentFormTheme *PaymentFormTheme) Clone() *PaymentFormTheme {
+	if paymentFormTheme == nil {
+		return nil
+	}
+	clone := *paymentFormTheme
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (paymentFormTheme *PaymentFormTheme) Equal(other *PaymentFormTheme) bool {
+	if paymentFormTheme == nil || other == nil {
+		return paymentFormTheme == other
+	}
+	if paymentFormTheme.BackgroundColor != other.BackgroundColor {
+		return false
+	}
+	if paymentFormTheme.TextColor != other.TextColor {
+		return false
+	}
+	if paymentFormTheme.HintColor != other.HintColor {
+		return false
+	}
+	if paymentFormTheme.LinkColor != other.LinkColor {
+		return false
+	}
+	if paymentFormTheme.ButtonColor != other.ButtonColor {
+		return false
+	}
+	if paymentFormTheme.ButtonTextColor != other.ButtonTextColor {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (paymentFormTheme *PaymentFormTheme) Diff(other *PaymentFormTheme) []Change {
+	return paymentFormTheme.diff("", other, nil)
+}
+
+func (paymentFormTheme *PaymentFormTheme) diff(path string, other *PaymentFormTheme, changes []Change) []Change {
+	if paymentFormTheme == nil || other == nil {
+		if paymentFormTheme != other {
+			changes = append(changes, Change{Path: path, Old: paymentFormTheme, New: other})
+		}
+		return changes
+	}
+	if paymentFormTheme.BackgroundColor != other.BackgroundColor {
+		changes = append(changes, Change{Path: fieldPath(path, "background_color"), Old: paymentFormTheme.BackgroundColor, New: other.BackgroundColor})
+	}
+	if paymentFormTheme.TextColor != other.TextColor {
+		changes = append(changes, Change{Path: fieldPath(path, "text_color"), Old: paymentFormTheme.TextColor, New: other.TextColor})
+	}
+	if paymentFormTheme.HintColor != other.HintColor {
+		changes = append(changes, Change{Path: fieldPath(path, "hint_color"), Old: paymentFormTheme.HintColor, New: other.HintColor})
+	}
+	if paymentFormTheme.LinkColor != other.LinkColor {
+		changes = append(changes, Change{Path: fieldPath(path, "link_color"), Old: paymentFormTheme.LinkColor, New: other.LinkColor})
+	}
+	if paymentFormTheme.ButtonColor != other.ButtonColor {
+		changes = append(changes, Change{Path: fieldPath(path, "button_color"), Old: paymentFormTheme.ButtonColor, New: other.ButtonColor})
+	}
+	if paymentFormTheme.ButtonTextColor != other.ButtonTextColor {
+		changes = append(changes, Change{Path: fieldPath(path, "button_text_color"), Old: paymentFormTheme.ButtonTextColor, New: other.ButtonTextColor})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (paymentFormTheme *PaymentFormTheme) String() string {
+	return objectString(paymentFormTheme)
+}
+
+// LogValue logs the object as its summary
+func (paymentFormTheme *PaymentFormTheme) LogValue() slog.Value {
+	return slog.StringValue(objectString(paymentFormTheme))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (paymentFormTheme *PaymentFormTheme) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, paymentFormTheme)
+}
+
+func (paymentFormTheme *PaymentFormTheme) printText(p *printer) {
+	if paymentFormTheme == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PaymentFormTheme")
+	p.int("background_color", int64(paymentFormTheme.BackgroundColor))
+	p.int("text_color", int64(paymentFormTheme.TextColor))
+	p.int("hint_color", int64(paymentFormTheme.HintColor))
+	p.int("link_color", int64(paymentFormTheme.LinkColor))
+	p.int("button_color", int64(paymentFormTheme.ButtonColor))
+	p.int("button_text_color", int64(paymentFormTheme.ButtonTextColor))
+	p.endObject(state)
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // PaymentReceipt Contains information about a successful payment
@@ -58,6 +58,247 @@ func NewPaymentReceipt(title string, description string, photo *Photo, date int3
 	return &paymentReceiptTemp
 }
 
+// MarshalJSON marshals to json
+func (paymentReceipt *PaymentReceipt) MarshalJSON() ([]byte, error) {
+	return paymentReceipt.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (paymentReceipt *PaymentReceipt) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, paymentReceipt)
+}
+
+func (paymentReceipt *PaymentReceipt) appendJSON(b []byte) ([]byte, error) {
+	if paymentReceipt == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, paymentReceipt.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, paymentReceipt.tdCommon.Extra)
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, paymentReceipt.Title)
+	b = append(b, `,"description":`...)
+	b = appendJSONString(b, paymentReceipt.Description)
+	b = append(b, `,"photo":`...)
+	b, err = paymentReceipt.Photo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"date":`...)
+	b = appendJSONInt(b, int64(paymentReceipt.Date))
+	b = append(b, `,"seller_bot_user_id":`...)
+	b = appendJSONInt(b, int64(paymentReceipt.SellerBotUserId))
+	b = append(b, `,"payments_provider_user_id":`...)
+	b = appendJSONInt(b, int64(paymentReceipt.PaymentsProviderUserId))
+	b = append(b, `,"invoice":`...)
+	b, err = paymentReceipt.Invoice.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"order_info":`...)
+	b, err = paymentReceipt.OrderInfo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"shipping_option":`...)
+	b, err = paymentReceipt.ShippingOption.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"credentials_title":`...)
+	b = appendJSONString(b, paymentReceipt.CredentialsTitle)
+	b = append(b, `,"tip_amount":`...)
+	b = appendJSONInt(b, int64(paymentReceipt.TipAmount))
+	return append(b, '}'), nil
+}
+
+func (paymentReceipt *PaymentReceipt) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			paymentReceipt.tdCommon.Type, err = r.readString()
+		case "@extra":
+			paymentReceipt.tdCommon.Extra, err = r.readString()
+		case "title":
+			paymentReceipt.Title, err = r.readString()
+		case "description":
+			paymentReceipt.Description, err = r.readString()
+		case "photo":
+			if r.readNull() {
+				paymentReceipt.Photo = nil
+			} else {
+				paymentReceipt.Photo = new(Photo)
+				err = paymentReceipt.Photo.decodeJSON(r)
+			}
+		case "date":
+			paymentReceipt.Date, err = r.readInt32()
+		case "seller_bot_user_id":
+			paymentReceipt.SellerBotUserId, err = r.readInt64()
+		case "payments_provider_user_id":
+			paymentReceipt.PaymentsProviderUserId, err = r.readInt64()
+		case "invoice":
+			if r.readNull() {
+				paymentReceipt.Invoice = nil
+			} else {
+				paymentReceipt.Invoice = new(Invoice)
+				err = paymentReceipt.Invoice.decodeJSON(r)
+			}
+		case "order_info":
+			if r.readNull() {
+				paymentReceipt.OrderInfo = nil
+			} else {
+				paymentReceipt.OrderInfo = new(OrderInfo)
+				err = paymentReceipt.OrderInfo.decodeJSON(r)
+			}
+		case "shipping_option":
+			if r.readNull() {
+				paymentReceipt.ShippingOption = nil
+			} else {
+				paymentReceipt.ShippingOption = new(ShippingOption)
+				err = paymentReceipt.ShippingOption.decodeJSON(r)
+			}
+		case "credentials_title":
+			paymentReceipt.CredentialsTitle, err = r.readString()
+		case "tip_amount":
+			paymentReceipt.TipAmount, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (paymentReceipt *PaymentReceipt) Clone() *PaymentReceipt {
+	if paymentReceipt == nil {
+		return nil
+	}
+	clone := *paymentReceipt
+	clone.Photo = paymentReceipt.Photo.Clone()
+	clone.Invoice = paymentReceipt.Invoice.Clone()
+	clone.OrderInfo = paymentReceipt.OrderInfo.Clone()
+	clone.ShippingOption = paymentReceipt.ShippingOption.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (paymentReceipt *PaymentReceipt) Equal(other *PaymentReceipt) bool {
+	if paymentReceipt == nil || other == nil {
+		return paymentReceipt == other
+	}
+	if paymentReceipt.Title != other.Title {
+		return false
+	}
+	if paymentReceipt.Description != other.Description {
+		return false
+	}
+	if !paymentReceipt.Photo.Equal(other.Photo) {
+		return false
+	}
+	if paymentReceipt.Date != other.Date {
+		return false
+	}
+	if paymentReceipt.SellerBotUserId != other.SellerBotUserId {
+		return false
+	}
+	if paymentReceipt.PaymentsProviderUserId != other.PaymentsProviderUserId {
+		return false
+	}
+	if !paymentReceipt.Invoice.Equal(other.Invoice) {
+		return false
+	}
+	if !paymentReceipt.OrderInfo.Equal(other.OrderInfo) {
+		return false
+	}
+	if !paymentReceipt.ShippingOption.Equal(other.ShippingOption) {
+		return false
+	}
+	if paymentReceipt.CredentialsTitle != other.CredentialsTitle {
+		return false
+	}
+	if paymentReceipt.TipAmount != other.TipAmount {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (paymentReceipt *PaymentReceipt) Diff(other *PaymentReceipt) []Change {
+	return paymentReceipt.diff("", other, nil)
+}
+
+func (paymentReceipt *PaymentReceipt) diff(path string, other *PaymentReceipt, changes []Change) []Change {
+	if paymentReceipt == nil || other == nil {
+		if paymentReceipt != other {
+			changes = append(changes, Change{Path: path, Old: paymentReceipt, New: other})
+		}
+		return changes
+	}
+	if paymentReceipt.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: paymentReceipt.Title, New: other.Title})
+	}
+	if paymentReceipt.Description != other.Description {
+		changes = append(changes, Change{Path: fieldPath(path, "description"), Old: paymentReceipt.Description, New: other.Description})
+	}
+	changes = paymentReceipt.Photo.diff(fieldPath(path, "photo"), other.Photo, changes)
+	if paymentReceipt.Date != other.Date {
+		changes = append(changes, Change{Path: fieldPath(path, "date"), Old: paymentReceipt.Date, New: other.Date})
+	}
+	if paymentReceipt.SellerBotUserId != other.SellerBotUserId {
+		changes = append(changes, Change{Path: fieldPath(path, "seller_bot_user_id"), Old: paymentReceipt.SellerBotUserId, New: other.SellerBotUserId})
+	}
+	if paymentReceipt.PaymentsProviderUserId != other.PaymentsProviderUserId {
+		changes = append(changes, Change{Path: fieldPath(path, "payments_provider_user_id"), Old: paymentReceipt.PaymentsProviderUserId, New: other.PaymentsProviderUserId})
+	}
+	changes = paymentReceipt.Invoice.diff(fieldPath(path, "invoice"), other.Invoice, changes)
+	changes = paymentReceipt.OrderInfo.diff(fieldPath(path, "order_info"), other.OrderInfo, changes)
+	changes = paymentReceipt.ShippingOption.diff(fieldPath(path, "shipping_option"), other.ShippingOption, changes)
+	if paymentReceipt.CredentialsTitle != other.CredentialsTitle {
+		changes = append(changes, Change{Path: fieldPath(path, "credentials_title"), Old: paymentReceipt.CredentialsTitle, New: other.CredentialsTitle})
+	}
+	if paymentReceipt.TipAmount != other.TipAmount {
+		changes = append(changes, Change{Path: fieldPath(path, "tip_amount"), Old: paymentReceipt.TipAmount, New: other.TipAmount})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (paymentReceipt *PaymentReceipt) String() string {
+	return objectString(paymentReceipt)
+}
+
+// LogValue logs the object as its summary
+func (paymentReceipt *PaymentReceipt) LogValue() slog.Value {
+	return slog.StringValue(objectString(paymentReceipt))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (paymentReceipt *PaymentReceipt) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, paymentReceipt)
+}
+
+func (paymentReceipt *PaymentReceipt) printText(p *printer) {
+	if paymentReceipt == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PaymentReceipt")
+	p.string("title", paymentReceipt.Title)
+	p.string("description", paymentReceipt.Description)
+	p.object("photo", paymentReceipt.Photo)
+	p.int("date", int64(paymentReceipt.Date))
+	p.int("seller_bot_user_id", int64(paymentReceipt.SellerBotUserId))
+	p.int("payments_provider_user_id", int64(paymentReceipt.PaymentsProviderUserId))
+	p.object("invoice", paymentReceipt.Invoice)
+	p.object("order_info", paymentReceipt.OrderInfo)
+	p.object("shipping_option", paymentReceipt.ShippingOption)
+	p.string("credentials_title", paymentReceipt.CredentialsTitle)
+	p.int("tip_amount", int64(paymentReceipt.TipAmount))
+	p.endObject(state)
+}
+
 // GetPaymentReceipt Returns information about a successful payment
 // @param chatId Chat identifier of the PaymentSuccessful message
 // @param messageId Message identifier
@@ -72,11 +313,11 @@ func (client *Client) GetPaymentReceipt(chatId int64, messageId int64) (*Payment
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var paymentReceipt PaymentReceipt
-	err = json.Unmarshal(result.Raw, &paymentReceipt)
+	err = jsonUnmarshal(result.Raw, &paymentReceipt)
 	return &paymentReceipt, err
 }
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // PaymentResult Contains the result of a payment request
@@ -31,6 +31,119 @@ func NewPaymentResult(success bool, verificationUrl string) *PaymentResult {
 	return &paymentResultTemp
 }
 
+// MarshalJSON marshals to json
+func (paymentResult *PaymentResult) MarshalJSON() ([]byte, error) {
+	return paymentResult.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (paymentResult *PaymentResult) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, paymentResult)
+}
+
+func (paymentResult *PaymentResult) appendJSON(b []byte) ([]byte, error) {
+	if paymentResult == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, paymentResult.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, paymentResult.tdCommon.Extra)
+	b = append(b, `,"success":`...)
+	b = appendJSONBool(b, paymentResult.Success)
+	b = append(b, `,"verification_url":`...)
+	b = appendJSONString(b, paymentResult.VerificationUrl)
+	return append(b, '}'), nil
+}
+
+func (paymentResult *PaymentResult) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			paymentResult.tdCommon.Type, err = r.readString()
+		case "@extra":
+			paymentResult.tdCommon.Extra, err = r.readString()
+		case "success":
+			paymentResult.Success, err = r.readBool()
+		case "verification_url":
+			paymentResult.VerificationUrl, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (paymentResult *PaymentResult) Clone() *PaymentResult {
+	if paymentResult == nil {
+		return nil
+	}
+	clone := *paymentResult
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (paymentResult *PaymentResult) Equal(other *PaymentResult) bool {
+	if paymentResult == nil || other == nil {
+		return paymentResult == other
+	}
+	if paymentResult.Success != other.Success {
+		return false
+	}
+	if paymentResult.VerificationUrl != other.VerificationUrl {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (paymentResult *PaymentResult) Diff(other *PaymentResult) []Change {
+	return paymentResult.diff("", other, nil)
+}
+
+func (paymentResult *PaymentResult) diff(path string, other *PaymentResult, changes []Change) []Change {
+	if paymentResult == nil || other == nil {
+		if paymentResult != other {
+			changes = append(changes, Change{Path: path, Old: paymentResult, New: other})
+		}
+		return changes
+	}
+	if paymentResult.Success != other.Success {
+		changes = append(changes, Change{Path: fieldPath(path, "success"), Old: paymentResult.Success, New: other.Success})
+	}
+	if paymentResult.VerificationUrl != other.VerificationUrl {
+		changes = append(changes, Change{Path: fieldPath(path, "verification_url"), Old: paymentResult.VerificationUrl, New: other.VerificationUrl})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (paymentResult *PaymentResult) String() string {
+	return objectString(paymentResult)
+}
+
+// LogValue logs the object as its summary
+func (paymentResult *PaymentResult) LogValue() slog.Value {
+	return slog.StringValue(objectString(paymentResult))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (paymentResult *PaymentResult) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, paymentResult)
+}
+
+func (paymentResult *PaymentResult) printText(p *printer) {
+	if paymentResult == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PaymentResult")
+	p.bool("success", paymentResult.Success)
+	p.string("verification_url", paymentResult.VerificationUrl)
+	p.endObject(state)
+}
+
 // SendPaymentForm Sends a filled-out payment form to the bot for final verification
 // @param chatId Chat identifier of the Invoice message
 // @param messageId Message identifier
@@ -55,11 +168,11 @@ func (client *Client) SendPaymentForm(chatId int64, messageId int64, paymentForm
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var paymentResult PaymentResult
-	err = json.Unmarshal(result.Raw, &paymentResult)
+	err = jsonUnmarshal(result.Raw, &paymentResult)
 	return &paymentResult, err
 }
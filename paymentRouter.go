@@ -0,0 +1,337 @@
+package tdlib
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// DefaultPaymentTimeout is the time shipping and pre-checkout handlers have to answer their query before the
+// router rejects it, which leaves time to answer within the 10 seconds Telegram waits, see PaymentRouter.SetTimeout
+const DefaultPaymentTimeout = 8 * time.Second
+
+// PaymentRejection is an error of a shipping or pre-checkout handler whose message is shown to the user
+type PaymentRejection struct {
+	Message string
+}
+
+// Error returns the message shown to the user
+func (rejection *PaymentRejection) Error() string {
+	return rejection.Message
+}
+
+// RejectPayment returns an error of a shipping or pre-checkout handler rejecting the query with a message
+// for the user, e.g. "We don't ship to Antarctica"
+func RejectPayment(message string) error {
+	return &PaymentRejection{Message: message}
+}
+
+// ShippingQuery is a query for the shipping options of an address from updateNewShippingQuery,
+// passed to a ShippingHandler
+type ShippingQuery struct {
+	ID              JSONInt64               // Unique query identifier
+	SenderUserID    int64                   // Identifier of the user who sent the query
+	InvoicePayload  string                  // Payload of the invoice
+	ShippingAddress *Address                // Shipping address of the user
+	Update          *UpdateNewShippingQuery // The update of the query
+
+	ctx context.Context
+}
+
+// Context returns the context of the query, which is done when the handler times out
+func (query *ShippingQuery) Context() context.Context {
+	return query.ctx
+}
+
+// ShippingHandler returns the shipping options of the address of a query, or rejects it with RejectPayment
+type ShippingHandler func(query *ShippingQuery) ([]ShippingOption, error)
+
+// PreCheckoutQuery is a query to confirm an order before it's paid from updateNewPreCheckoutQuery,
+// passed to a PreCheckoutHandler
+type PreCheckoutQuery struct {
+	ID               JSONInt64                  // Unique query identifier
+	SenderUserID     int64                      // Identifier of the user who sent the query
+	Currency         string                     // Currency of the price
+	TotalAmount      int64                      // Total price, with the tip and the shipping, in the smallest units of the currency
+	InvoicePayload   []byte                     // Payload of the invoice
+	ShippingOptionID string                     // Identifier of the shipping option chosen by the user; may be empty
+	OrderInfo        *OrderInfo                 // Information about the order; may be nil
+	Update           *UpdateNewPreCheckoutQuery // The update of the query
+
+	ctx context.Context
+}
+
+// Context returns the context of the query, which is done when the handler times out
+func (query *PreCheckoutQuery) Context() context.Context {
+	return query.ctx
+}
+
+// PreCheckoutHandler confirms an order, e.g. after checking the products are in stock, by returning nil,
+// or rejects it with RejectPayment
+type PreCheckoutHandler func(query *PreCheckoutQuery) error
+
+// SuccessfulPaymentHandler records a successful payment, received as a message with the
+// *MessagePaymentSuccessfulBot content in the chat with the user
+type SuccessfulPaymentHandler func(ctx context.Context, message *Message, payment *MessagePaymentSuccessfulBot) error
+
+// PaymentRouter handles the payment flow of invoices sent by the bot: it answers shipping queries with the
+// shipping options of the address, answers pre-checkout queries confirming or rejecting orders, and passes
+// successful payments on to be recorded. Queries handlers don't answer in time are rejected, so users aren't
+// left waiting for Telegram to give up.
+//
+//	router := tdlib.NewPaymentRouter(client)
+//	router.HandlePreCheckout(func(query *tdlib.PreCheckoutQuery) error {
+//		if !inStock(query.InvoicePayload) {
+//			return tdlib.RejectPayment("Sold out, sorry")
+//		}
+//		return nil
+//	})
+//	router.HandleSuccessfulPayment(func(ctx context.Context, message *tdlib.Message, payment *tdlib.MessagePaymentSuccessfulBot) error {
+//		return orders.Paid(ctx, payment.InvoicePayload, payment.TelegramPaymentChargeId, payment.OrderInfo)
+//	})
+//	go router.Run(ctx)
+type PaymentRouter struct {
+	client      *Client
+	lock        sync.RWMutex
+	shipping    ShippingHandler
+	preCheckout PreCheckoutHandler
+	successful  SuccessfulPaymentHandler
+	timeout     time.Duration
+	failureText string
+}
+
+// NewPaymentRouter creates a payment router for the queries and payments the client receives
+func NewPaymentRouter(client *Client) *PaymentRouter {
+	return &PaymentRouter{
+		client:      client,
+		timeout:     DefaultPaymentTimeout,
+		failureText: "The payment can't be processed right now, please try again later",
+	}
+}
+
+// SetTimeout sets the time handlers have to answer their query before the router rejects it
+func (router *PaymentRouter) SetTimeout(timeout time.Duration) {
+	router.lock.Lock()
+	defer router.lock.Unlock()
+	router.timeout = timeout
+}
+
+// SetFailureText sets the message rejecting queries whose handler failed or timed out
+func (router *PaymentRouter) SetFailureText(text string) {
+	router.lock.Lock()
+	defer router.lock.Unlock()
+	router.failureText = text
+}
+
+// HandleShipping handles the shipping queries of flexible invoices, which are rejected otherwise
+func (router *PaymentRouter) HandleShipping(handler ShippingHandler) {
+	router.lock.Lock()
+	defer router.lock.Unlock()
+	router.shipping = handler
+}
+
+// HandlePreCheckout handles pre-checkout queries, which are rejected otherwise
+func (router *PaymentRouter) HandlePreCheckout(handler PreCheckoutHandler) {
+	router.lock.Lock()
+	defer router.lock.Unlock()
+	router.preCheckout = handler
+}
+
+// HandleSuccessfulPayment handles successful payments
+func (router *PaymentRouter) HandleSuccessfulPayment(handler SuccessfulPaymentHandler) {
+	router.lock.Lock()
+	defer router.lock.Unlock()
+	router.successful = handler
+}
+
+// isSuccessfulPayment is the EventFilterFunc of the receiver of messages about successful payments
+func isSuccessfulPayment(msg *TdMessage) bool {
+	update, ok := (*msg).(*UpdateNewMessage)
+	if !ok || update.Message == nil {
+		return false
+	}
+	_, ok = update.Message.Content.(*MessagePaymentSuccessfulBot)
+	return ok
+}
+
+// Run handles the shipping and pre-checkout queries and the successful payments the client receives until ctx is done
+func (router *PaymentRouter) Run(ctx context.Context) error {
+	shippingQueries := router.client.AddEventReceiver(&UpdateNewShippingQuery{}, acceptUpdate, 100)
+	preCheckoutQueries := router.client.AddEventReceiver(&UpdateNewPreCheckoutQuery{}, acceptUpdate, 100)
+	payments := router.client.AddEventReceiver(&UpdateNewMessage{}, isSuccessfulPayment, 100)
+	defer func() {
+		removed := make(chan struct{})
+		go func() {
+			router.client.RemoveEventReceiver(shippingQueries)
+			router.client.RemoveEventReceiver(preCheckoutQueries)
+			router.client.RemoveEventReceiver(payments)
+			close(removed)
+		}()
+		for {
+			select {
+			case <-shippingQueries.Chan:
+			case <-preCheckoutQueries.Chan:
+			case <-payments.Chan:
+			case <-removed:
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case update := <-shippingQueries.Chan:
+			go router.HandleUpdate(ctx, update)
+		case update := <-preCheckoutQueries.Chan:
+			go router.HandleUpdate(ctx, update)
+		case update := <-payments.Chan:
+			go router.HandleUpdate(ctx, update)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// HandleUpdate handles an updateNewShippingQuery, an updateNewPreCheckoutQuery or an updateNewMessage about
+// a successful payment, e.g. received by an own receiver instead of Run, and returns when the query is answered.
+// Other updates are ignored.
+func (router *PaymentRouter) HandleUpdate(ctx context.Context, update TdMessage) {
+	router.lock.RLock()
+	shipping, preCheckout, successful := router.shipping, router.preCheckout, router.successful
+	timeout, failureText := router.timeout, router.failureText
+	router.lock.RUnlock()
+
+	switch update := update.(type) {
+	case *UpdateNewShippingQuery:
+		query := &ShippingQuery{
+			ID:              update.Id,
+			SenderUserID:    update.SenderUserId,
+			InvoicePayload:  update.InvoicePayload,
+			ShippingAddress: update.ShippingAddress,
+			Update:          update,
+		}
+		var cancel context.CancelFunc
+		query.ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		var options []ShippingOption
+		err := errors.New("no shipping handler")
+		if shipping != nil {
+			options, err = callPaymentHandler(router, query.ctx, update, func() ([]ShippingOption, error) {
+				return shipping(query)
+			})
+		}
+		errorMessage := router.errorMessage(update, update.SenderUserId, err, failureText)
+		if errorMessage != "" || options == nil {
+			options = []ShippingOption{}
+		}
+		_, err = router.client.Invoke(context.WithoutCancel(ctx), "answerShippingQuery", UpdateData{
+			"shipping_query_id": update.Id,
+			"shipping_options":  options,
+			"error_message":     errorMessage,
+		})
+		if err != nil {
+			router.client.Logger().Warn("failed to answer shipping query", "sender_user_id", update.SenderUserId, "error", err)
+		}
+
+	case *UpdateNewPreCheckoutQuery:
+		query := &PreCheckoutQuery{
+			ID:               update.Id,
+			SenderUserID:     update.SenderUserId,
+			Currency:         update.Currency,
+			TotalAmount:      update.TotalAmount,
+			InvoicePayload:   update.InvoicePayload,
+			ShippingOptionID: update.ShippingOptionId,
+			OrderInfo:        update.OrderInfo,
+			Update:           update,
+		}
+		var cancel context.CancelFunc
+		query.ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		err := errors.New("no pre-checkout handler")
+		if preCheckout != nil {
+			err = router.call(query.ctx, update, func() error {
+				return preCheckout(query)
+			})
+		}
+		_, err = router.client.Invoke(context.WithoutCancel(ctx), "answerPreCheckoutQuery", UpdateData{
+			"pre_checkout_query_id": update.Id,
+			"error_message":         router.errorMessage(update, update.SenderUserId, err, failureText),
+		})
+		if err != nil {
+			router.client.Logger().Warn("failed to answer pre-checkout query", "sender_user_id", update.SenderUserId, "error", err)
+		}
+
+	case *UpdateNewMessage:
+		if update.Message == nil {
+			return
+		}
+		payment, ok := update.Message.Content.(*MessagePaymentSuccessfulBot)
+		if !ok || successful == nil {
+			return
+		}
+		err := router.call(ctx, update, func() error {
+			return successful(ctx, update.Message, payment)
+		})
+		if err != nil {
+			router.client.Logger().Error("successful payment handler failed", "chat_id", update.Message.ChatId,
+				"telegram_payment_charge_id", payment.TelegramPaymentChargeId, "error", err)
+		}
+	}
+}
+
+// call calls a handler with panic recovery, and returns its error, or ctx's error if ctx is done first
+func (router *PaymentRouter) call(ctx context.Context, update TdMessage, handler func() error) error {
+	_, err := callPaymentHandler(router, ctx, update, func() (struct{}, error) {
+		return struct{}{}, handler()
+	})
+	return err
+}
+
+// callPaymentHandler calls a handler with panic recovery, and returns its result, or ctx's error if ctx is done
+// first. The result is passed back through a channel, so a handler returning after ctx is done doesn't write
+// anything the router reads.
+func callPaymentHandler[T any](router *PaymentRouter, ctx context.Context, update TdMessage, handler func() (T, error)) (T, error) {
+	type result struct {
+		value T
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				router.client.reportPanic(update.MessageType(), r)
+				done <- result{err: errors.New("handler panicked")}
+			}
+		}()
+		value, err := handler()
+		done <- result{value: value, err: err}
+	}()
+
+	select {
+	case result := <-done:
+		return result.value, result.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// errorMessage returns the message answering a query whose handler returned err: none if it's nil, the message
+// of a PaymentRejection, or failureText otherwise
+func (router *PaymentRouter) errorMessage(update TdMessage, senderUserID int64, err error, failureText string) string {
+	var rejection *PaymentRejection
+	switch {
+	case err == nil:
+		return ""
+	case errors.As(err, &rejection):
+		return rejection.Message
+	case errors.Is(err, context.DeadlineExceeded):
+		router.client.Logger().Warn("payment handler timed out", "query", update.MessageType(), "sender_user_id", senderUserID)
+	default:
+		router.client.Logger().Error("payment handler failed", "query", update.MessageType(), "sender_user_id", senderUserID, "error", err)
+	}
+	return failureText
+}
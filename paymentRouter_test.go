@@ -0,0 +1,94 @@
+package tdlib
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestPaymentRouterShipping(t *testing.T) {
+	option := *NewShippingOption("post", "Post", []LabeledPricePart{*NewLabeledPricePart("Post", 500)})
+	tests := []struct {
+		name         string
+		delay        time.Duration
+		err          error
+		options      int
+		errorMessage string
+	}{
+		{"options", 0, nil, 1, ""},
+		{"rejected", 0, RejectPayment("We don't ship there"), 0, "We don't ship there"},
+		{"timed out", 50 * time.Millisecond, nil, 0, "failed"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			transport := newFakeTransport(nil)
+			router := NewPaymentRouter(NewClientWithTransport(Config{}, transport))
+			router.SetTimeout(10 * time.Millisecond)
+			router.SetFailureText("failed")
+			returned := make(chan struct{})
+			router.HandleShipping(func(query *ShippingQuery) ([]ShippingOption, error) {
+				defer close(returned)
+				time.Sleep(test.delay)
+				return []ShippingOption{option}, test.err
+			})
+
+			router.HandleUpdate(context.Background(), NewUpdateNewShippingQuery(1, 2, "order", nil))
+			// a handler returning after the timeout must not change the answer
+			<-returned
+
+			answers := transport.sent("answerShippingQuery")
+			if len(answers) != 1 {
+				t.Fatalf("%d answers were sent, want 1", len(answers))
+			}
+			options, _ := answers[0]["shipping_options"].([]interface{})
+			if len(options) != test.options || answers[0]["error_message"] != test.errorMessage {
+				t.Errorf("answered %v, want %d options and the error message %q", answers[0], test.options, test.errorMessage)
+			}
+		})
+	}
+}
+
+func TestPaymentRouterPreCheckout(t *testing.T) {
+	tests := []struct {
+		name         string
+		handler      bool
+		delay        time.Duration
+		err          error
+		errorMessage string
+	}{
+		{"confirmed", true, 0, nil, ""},
+		{"rejected", true, 0, RejectPayment("Sold out, sorry"), "Sold out, sorry"},
+		{"timed out", true, 50 * time.Millisecond, nil, "failed"},
+		{"no handler", false, 0, nil, "failed"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			transport := newFakeTransport(nil)
+			router := NewPaymentRouter(NewClientWithTransport(Config{}, transport))
+			router.SetTimeout(10 * time.Millisecond)
+			router.SetFailureText("failed")
+			returned := make(chan struct{})
+			if test.handler {
+				router.HandlePreCheckout(func(query *PreCheckoutQuery) error {
+					defer close(returned)
+					time.Sleep(test.delay)
+					return test.err
+				})
+			} else {
+				close(returned)
+			}
+
+			router.HandleUpdate(context.Background(), NewUpdateNewPreCheckoutQuery(1, 2, "USD", 500, []byte("order"), "", nil))
+			// a handler returning after the timeout must not change the answer
+			<-returned
+
+			answers := transport.sent("answerPreCheckoutQuery")
+			if len(answers) != 1 {
+				t.Fatalf("%d answers were sent, want 1", len(answers))
+			}
+			if answers[0]["error_message"] != test.errorMessage {
+				t.Errorf("answered %v, want the error message %q", answers[0], test.errorMessage)
+			}
+		})
+	}
+}
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // PaymentsProviderStripe Stripe payment provider
 type PaymentsProviderStripe struct {
 	tdCommon
@@ -31,3 +36,138 @@ func NewPaymentsProviderStripe(publishableKey string, needCountry bool, needPost
 
 	return &paymentsProviderStripeTemp
 }
+
+// MarshalJSON marshals to json
+func (paymentsProviderStripe *PaymentsProviderStripe) MarshalJSON() ([]byte, error) {
+	return paymentsProviderStripe.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (paymentsProviderStripe *PaymentsProviderStripe) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, paymentsProviderStripe)
+}
+
+func (paymentsProviderStripe *PaymentsProviderStripe) appendJSON(b []byte) ([]byte, error) {
+	if paymentsProviderStripe == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, paymentsProviderStripe.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, paymentsProviderStripe.tdCommon.Extra)
+	b = append(b, `,"publishable_key":`...)
+	b = appendJSONString(b, paymentsProviderStripe.PublishableKey)
+	b = append(b, `,"need_country":`...)
+	b = appendJSONBool(b, paymentsProviderStripe.NeedCountry)
+	b = append(b, `,"need_postal_code":`...)
+	b = appendJSONBool(b, paymentsProviderStripe.NeedPostalCode)
+	b = append(b, `,"need_cardholder_name":`...)
+	b = appendJSONBool(b, paymentsProviderStripe.NeedCardholderName)
+	return append(b, '}'), nil
+}
+
+func (paymentsProviderStripe *PaymentsProviderStripe) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			paymentsProviderStripe.tdCommon.Type, err = r.readString()
+		case "@extra":
+			paymentsProviderStripe.tdCommon.Extra, err = r.readString()
+		case "publishable_key":
+			paymentsProviderStripe.PublishableKey, err = r.readString()
+		case "need_country":
+			paymentsProviderStripe.NeedCountry, err = r.readBool()
+		case "need_postal_code":
+			paymentsProviderStripe.NeedPostalCode, err = r.readBool()
+		case "need_cardholder_name":
+			paymentsProviderStripe.NeedCardholderName, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (paymentsProviderStripe *PaymentsProviderStripe) Clone() *PaymentsProviderStripe {
+	if paymentsProviderStripe == nil {
+		return nil
+	}
+	clone := *paymentsProviderStripe
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (paymentsProviderStripe *PaymentsProviderStripe) Equal(other *PaymentsProviderStripe) bool {
+	if paymentsProviderStripe == nil || other == nil {
+		return paymentsProviderStripe == other
+	}
+	if paymentsProviderStripe.PublishableKey != other.PublishableKey {
+		return false
+	}
+	if paymentsProviderStripe.NeedCountry != other.NeedCountry {
+		return false
+	}
+	if paymentsProviderStripe.NeedPostalCode != other.NeedPostalCode {
+		return false
+	}
+	if paymentsProviderStripe.NeedCardholderName != other.NeedCardholderName {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (paymentsProviderStripe *PaymentsProviderStripe) Diff(other *PaymentsProviderStripe) []Change {
+	return paymentsProviderStripe.diff("", other, nil)
+}
+
+func (paymentsProviderStripe *PaymentsProviderStripe) diff(path string, other *PaymentsProviderStripe, changes []Change) []Change {
+	if paymentsProviderStripe == nil || other == nil {
+		if paymentsProviderStripe != other {
+			changes = append(changes, Change{Path: path, Old: paymentsProviderStripe, New: other})
+		}
+		return changes
+	}
+	if paymentsProviderStripe.PublishableKey != other.PublishableKey {
+		changes = append(changes, Change{Path: fieldPath(path, "publishable_key"), Old: paymentsProviderStripe.PublishableKey, New: other.PublishableKey})
+	}
+	if paymentsProviderStripe.NeedCountry != other.NeedCountry {
+		changes = append(changes, Change{Path: fieldPath(path, "need_country"), Old: paymentsProviderStripe.NeedCountry, New: other.NeedCountry})
+	}
+	if paymentsProviderStripe.NeedPostalCode != other.NeedPostalCode {
+		changes = append(changes, Change{Path: fieldPath(path, "need_postal_code"), Old: paymentsProviderStripe.NeedPostalCode, New: other.NeedPostalCode})
+	}
+	if paymentsProviderStripe.NeedCardholderName != other.NeedCardholderName {
+		changes = append(changes, Change{Path: fieldPath(path, "need_cardholder_name"), Old: paymentsProviderStripe.NeedCardholderName, New: other.NeedCardholderName})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (paymentsProviderStripe *PaymentsProviderStripe) String() string {
+	return objectString(paymentsProviderStripe)
+}
+
+// LogValue logs the object as its summary
+func (paymentsProviderStripe *PaymentsProviderStripe) LogValue() slog.Value {
+	return slog.StringValue(objectString(paymentsProviderStripe))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (paymentsProviderStripe *PaymentsProviderStripe) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, paymentsProviderStripe)
+}
+
+func (paymentsProviderStripe *PaymentsProviderStripe) printText(p *printer) {
+	if paymentsProviderStripe == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PaymentsProviderStripe")
+	p.string("publishable_key", paymentsProviderStripe.PublishableKey)
+	p.bool("need_country", paymentsProviderStripe.NeedCountry)
+	p.bool("need_postal_code", paymentsProviderStripe.NeedPostalCode)
+	p.bool("need_cardholder_name", paymentsProviderStripe.NeedCardholderName)
+	p.endObject(state)
+}
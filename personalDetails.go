@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // PersonalDetails Contains the user's personal details
 type PersonalDetails struct {
 	tdCommon
@@ -49,3 +54,212 @@ func NewPersonalDetails(firstName string, middleName string, lastName string, na
 
 	return &personalDetailsTemp
 }
+
+// MarshalJSON marshals to json
+func (personalDetails *PersonalDetails) MarshalJSON() ([]byte, error) {
+	return personalDetails.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (personalDetails *PersonalDetails) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, personalDetails)
+}
+
+func (personalDetails *PersonalDetails) appendJSON(b []byte) ([]byte, error) {
+	if personalDetails == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, personalDetails.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, personalDetails.tdCommon.Extra)
+	b = append(b, `,"first_name":`...)
+	b = appendJSONString(b, personalDetails.FirstName)
+	b = append(b, `,"middle_name":`...)
+	b = appendJSONString(b, personalDetails.MiddleName)
+	b = append(b, `,"last_name":`...)
+	b = appendJSONString(b, personalDetails.LastName)
+	b = append(b, `,"native_first_name":`...)
+	b = appendJSONString(b, personalDetails.NativeFirstName)
+	b = append(b, `,"native_middle_name":`...)
+	b = appendJSONString(b, personalDetails.NativeMiddleName)
+	b = append(b, `,"native_last_name":`...)
+	b = appendJSONString(b, personalDetails.NativeLastName)
+	b = append(b, `,"birthdate":`...)
+	b, err = personalDetails.Birthdate.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"gender":`...)
+	b = appendJSONString(b, personalDetails.Gender)
+	b = append(b, `,"country_code":`...)
+	b = appendJSONString(b, personalDetails.CountryCode)
+	b = append(b, `,"residence_country_code":`...)
+	b = appendJSONString(b, personalDetails.ResidenceCountryCode)
+	return append(b, '}'), nil
+}
+
+func (personalDetails *PersonalDetails) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			personalDetails.tdCommon.Type, err = r.readString()
+		case "@extra":
+			personalDetails.tdCommon.Extra, err = r.readString()
+		case "first_name":
+			personalDetails.FirstName, err = r.readString()
+		case "middle_name":
+			personalDetails.MiddleName, err = r.readString()
+		case "last_name":
+			personalDetails.LastName, err = r.readString()
+		case "native_first_name":
+			personalDetails.NativeFirstName, err = r.readString()
+		case "native_middle_name":
+			personalDetails.NativeMiddleName, err = r.readString()
+		case "native_last_name":
+			personalDetails.NativeLastName, err = r.readString()
+		case "birthdate":
+			if r.readNull() {
+				personalDetails.Birthdate = nil
+			} else {
+				personalDetails.Birthdate = new(Date)
+				err = personalDetails.Birthdate.decodeJSON(r)
+			}
+		case "gender":
+			personalDetails.Gender, err = r.readString()
+		case "country_code":
+			personalDetails.CountryCode, err = r.readString()
+		case "residence_country_code":
+			personalDetails.ResidenceCountryCode, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (personalDetails *PersonalDetails) Clone() *PersonalDetails {
+	if personalDetails == nil {
+		return nil
+	}
+	clone := *personalDetails
+	clone.Birthdate = personalDetails.Birthdate.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (personalDetails *PersonalDetails) Equal(other *PersonalDetails) bool {
+	if personalDetails == nil || other == nil {
+		return personalDetails == other
+	}
+	if personalDetails.FirstName != other.FirstName {
+		return false
+	}
+	if personalDetails.MiddleName != other.MiddleName {
+		return false
+	}
+	if personalDetails.LastName != other.LastName {
+		return false
+	}
+	if personalDetails.NativeFirstName != other.NativeFirstName {
+		return false
+	}
+	if personalDetails.NativeMiddleName != other.NativeMiddleName {
+		return false
+	}
+	if personalDetails.NativeLastName != other.NativeLastName {
+		return false
+	}
+	if !personalDetails.Birthdate.Equal(other.Birthdate) {
+		return false
+	}
+	if personalDetails.Gender != other.Gender {
+		return false
+	}
+	if personalDetails.CountryCode != other.CountryCode {
+		return false
+	}
+	if personalDetails.ResidenceCountryCode != other.ResidenceCountryCode {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (personalDetails *PersonalDetails) Diff(other *PersonalDetails) []Change {
+	return personalDetails.diff("", other, nil)
+}
+
+func (personalDetails *PersonalDetails) diff(path string, other *PersonalDetails, changes []Change) []Change {
+	if personalDetails == nil || other == nil {
+		if personalDetails != other {
+			changes = append(changes, Change{Path: path, Old: personalDetails, New: other})
+		}
+		return changes
+	}
+	if personalDetails.FirstName != other.FirstName {
+		changes = append(changes, Change{Path: fieldPath(path, "first_name"), Old: personalDetails.FirstName, New: other.FirstName})
+	}
+	if personalDetails.MiddleName != other.MiddleName {
+		changes = append(changes, Change{Path: fieldPath(path, "middle_name"), Old: personalDetails.MiddleName, New: other.MiddleName})
+	}
+	if personalDetails.LastName != other.LastName {
+		changes = append(changes, Change{Path: fieldPath(path, "last_name"), Old: personalDetails.LastName, New: other.LastName})
+	}
+	if personalDetails.NativeFirstName != other.NativeFirstName {
+		changes = append(changes, Change{Path: fieldPath(path, "native_first_name"), Old: personalDetails.NativeFirstName, New: other.NativeFirstName})
+	}
+	if personalDetails.NativeMiddleName != other.NativeMiddleName {
+		changes = append(changes, Change{Path: fieldPath(path, "native_middle_name"), Old: personalDetails.NativeMiddleName, New: other.NativeMiddleName})
+	}
+	if personalDetails.NativeLastName != other.NativeLastName {
+		changes = append(changes, Change{Path: fieldPath(path, "native_last_name"), Old: personalDetails.NativeLastName, New: other.NativeLastName})
+	}
+	changes = personalDetails.Birthdate.diff(fieldPath(path, "birthdate"), other.Birthdate, changes)
+	if personalDetails.Gender != other.Gender {
+		changes = append(changes, Change{Path: fieldPath(path, "gender"), Old: personalDetails.Gender, New: other.Gender})
+	}
+	if personalDetails.CountryCode != other.CountryCode {
+		changes = append(changes, Change{Path: fieldPath(path, "country_code"), Old: personalDetails.CountryCode, New: other.CountryCode})
+	}
+	if personalDetails.ResidenceCountryCode != other.ResidenceCountryCode {
+		changes = append(changes, Change{Path: fieldPath(path, "residence_country_code"), Old: personalDetails.ResidenceCountryCode, New: other.ResidenceCountryCode})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (personalDetails *PersonalDetails) String() string {
+	return objectString(personalDetails)
+}
+
+// LogValue logs the object as its summary
+func (personalDetails *PersonalDetails) LogValue() slog.Value {
+	return slog.StringValue(objectString(personalDetails))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (personalDetails *PersonalDetails) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, personalDetails)
+}
+
+func (personalDetails *PersonalDetails) printText(p *printer) {
+	if personalDetails == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PersonalDetails")
+	p.string("first_name", personalDetails.FirstName)
+	p.string("middle_name", personalDetails.MiddleName)
+	p.string("last_name", personalDetails.LastName)
+	p.string("native_first_name", personalDetails.NativeFirstName)
+	p.string("native_middle_name", personalDetails.NativeMiddleName)
+	p.string("native_last_name", personalDetails.NativeLastName)
+	p.object("birthdate", personalDetails.Birthdate)
+	p.string("gender", personalDetails.Gender)
+	p.string("country_code", personalDetails.CountryCode)
+	p.string("residence_country_code", personalDetails.ResidenceCountryCode)
+	p.endObject(state)
+}
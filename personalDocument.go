@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // PersonalDocument A personal document, containing some information about a user
 type PersonalDocument struct {
 	tdCommon
@@ -25,3 +30,199 @@ func NewPersonalDocument(files []DatedFile, translation []DatedFile) *PersonalDo
 
 	return &personalDocumentTemp
 }
+
+// MarshalJSON marshals to json
+func (personalDocument *PersonalDocument) MarshalJSON() ([]byte, error) {
+	return personalDocument.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (personalDocument *PersonalDocument) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, personalDocument)
+}
+
+func (personalDocument *PersonalDocument) appendJSON(b []byte) ([]byte, error) {
+	if personalDocument == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, personalDocument.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, personalDocument.tdCommon.Extra)
+	b = append(b, `,"files":`...)
+	if personalDocument.Files == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range personalDocument.Files {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = personalDocument.Files[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"translation":`...)
+	if personalDocument.Translation == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range personalDocument.Translation {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = personalDocument.Translation[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (personalDocument *PersonalDocument) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			personalDocument.tdCommon.Type, err = r.readString()
+		case "@extra":
+			personalDocument.tdCommon.Extra, err = r.readString()
+		case "files":
+			if r.readNull() {
+				personalDocument.Files = nil
+			} else {
+				personalDocument.Files = make([]DatedFile, 0)
+				err = r.readArray(func() (err error) {
+					var item0 DatedFile
+					err = item0.decodeJSON(r)
+					personalDocument.Files = append(personalDocument.Files, item0)
+					return
+				})
+			}
+		case "translation":
+			if r.readNull() {
+				personalDocument.Translation = nil
+			} else {
+				personalDocument.Translation = make([]DatedFile, 0)
+				err = r.readArray(func() (err error) {
+					var item0 DatedFile
+					err = item0.decodeJSON(r)
+					personalDocument.Translation = append(personalDocument.Translation, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (personalDocument *PersonalDocument) Clone() *PersonalDocument {
+	if personalDocument == nil {
+		return nil
+	}
+	clone := *personalDocument
+	if personalDocument.Files != nil {
+		clone.Files = make([]DatedFile, len(personalDocument.Files))
+		for i0 := range personalDocument.Files {
+			clone.Files[i0] = *personalDocument.Files[i0].Clone()
+		}
+	}
+	if personalDocument.Translation != nil {
+		clone.Translation = make([]DatedFile, len(personalDocument.Translation))
+		for i0 := range personalDocument.Translation {
+			clone.Translation[i0] = *personalDocument.Translation[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (personalDocument *PersonalDocument) Equal(other *PersonalDocument) bool {
+	if personalDocument == nil || other == nil {
+		return personalDocument == other
+	}
+	if len(personalDocument.Files) != len(other.Files) {
+		return false
+	}
+	for i0 := range personalDocument.Files {
+		if !personalDocument.Files[i0].Equal(&other.Files[i0]) {
+			return false
+		}
+	}
+	if len(personalDocument.Translation) != len(other.Translation) {
+		return false
+	}
+	for i0 := range personalDocument.Translation {
+		if !personalDocument.Translation[i0].Equal(&other.Translation[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (personalDocument *PersonalDocument) Diff(other *PersonalDocument) []Change {
+	return personalDocument.diff("", other, nil)
+}
+
+func (personalDocument *PersonalDocument) diff(path string, other *PersonalDocument, changes []Change) []Change {
+	if personalDocument == nil || other == nil {
+		if personalDocument != other {
+			changes = append(changes, Change{Path: path, Old: personalDocument, New: other})
+		}
+		return changes
+	}
+	if len(personalDocument.Files) != len(other.Files) {
+		changes = append(changes, Change{Path: fieldPath(path, "files"), Old: personalDocument.Files, New: other.Files})
+	} else {
+		for i0 := range personalDocument.Files {
+			changes = personalDocument.Files[i0].diff(indexPath(fieldPath(path, "files"), i0), &other.Files[i0], changes)
+		}
+	}
+	if len(personalDocument.Translation) != len(other.Translation) {
+		changes = append(changes, Change{Path: fieldPath(path, "translation"), Old: personalDocument.Translation, New: other.Translation})
+	} else {
+		for i0 := range personalDocument.Translation {
+			changes = personalDocument.Translation[i0].diff(indexPath(fieldPath(path, "translation"), i0), &other.Translation[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (personalDocument *PersonalDocument) String() string {
+	return objectString(personalDocument)
+}
+
+// LogValue logs the object as its summary
+func (personalDocument *PersonalDocument) LogValue() slog.Value {
+	return slog.StringValue(objectString(personalDocument))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (personalDocument *PersonalDocument) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, personalDocument)
+}
+
+func (personalDocument *PersonalDocument) printText(p *printer) {
+	if personalDocument == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PersonalDocument")
+	p.vector("files", len(personalDocument.Files), func(i0 int) {
+		personalDocument.Files[i0].printText(p)
+	})
+	p.vector("translation", len(personalDocument.Translation), func(i0 int) {
+		personalDocument.Translation[i0].printText(p)
+	})
+	p.endObject(state)
+}
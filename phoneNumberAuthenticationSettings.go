@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // PhoneNumberAuthenticationSettings Contains settings for the authentication of the user's phone number
 type PhoneNumberAuthenticationSettings struct {
 	tdCommon
@@ -34,3 +39,187 @@ func NewPhoneNumberAuthenticationSettings(allowFlashCall bool, allowMissedCall b
 
 	return &phoneNumberAuthenticationSettingsTemp
 }
+
+// MarshalJSON marshals to json
+func (phoneNumberAuthenticationSettings *PhoneNumberAuthenticationSettings) MarshalJSON() ([]byte, error) {
+	return phoneNumberAuthenticationSettings.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (phoneNumberAuthenticationSettings *PhoneNumberAuthenticationSettings) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, phoneNumberAuthenticationSettings)
+}
+
+func (phoneNumberAuthenticationSettings *PhoneNumberAuthenticationSettings) appendJSON(b []byte) ([]byte, error) {
+	if phoneNumberAuthenticationSettings == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, phoneNumberAuthenticationSettings.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, phoneNumberAuthenticationSettings.tdCommon.Extra)
+	b = append(b, `,"allow_flash_call":`...)
+	b = appendJSONBool(b, phoneNumberAuthenticationSettings.AllowFlashCall)
+	b = append(b, `,"allow_missed_call":`...)
+	b = appendJSONBool(b, phoneNumberAuthenticationSettings.AllowMissedCall)
+	b = append(b, `,"is_current_phone_number":`...)
+	b = appendJSONBool(b, phoneNumberAuthenticationSettings.IsCurrentPhoneNumber)
+	b = append(b, `,"allow_sms_retriever_api":`...)
+	b = appendJSONBool(b, phoneNumberAuthenticationSettings.AllowSmsRetrieverApi)
+	b = append(b, `,"authentication_tokens":`...)
+	if phoneNumberAuthenticationSettings.AuthenticationTokens == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range phoneNumberAuthenticationSettings.AuthenticationTokens {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONString(b, phoneNumberAuthenticationSettings.AuthenticationTokens[i0])
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (phoneNumberAuthenticationSettings *PhoneNumberAuthenticationSettings) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			phoneNumberAuthenticationSettings.tdCommon.Type, err = r.readString()
+		case "@extra":
+			phoneNumberAuthenticationSettings.tdCommon.Extra, err = r.readString()
+		case "allow_flash_call":
+			phoneNumberAuthenticationSettings.AllowFlashCall, err = r.readBool()
+		case "allow_missed_call":
+			phoneNumberAuthenticationSettings.AllowMissedCall, err = r.readBool()
+		case "is_current_phone_number":
+			phoneNumberAuthenticationSettings.IsCurrentPhoneNumber, err = r.readBool()
+		case "allow_sms_retriever_api":
+			phoneNumberAuthenticationSettings.AllowSmsRetrieverApi, err = r.readBool()
+		case "authentication_tokens":
+			if r.readNull() {
+				phoneNumberAuthenticationSettings.AuthenticationTokens = nil
+			} else {
+				phoneNumberAuthenticationSettings.AuthenticationTokens = make([]string, 0)
+				err = r.readArray(func() (err error) {
+					var item0 string
+					item0, err = r.readString()
+					phoneNumberAuthenticationSettings.AuthenticationTokens = append(phoneNumberAuthenticationSettings.AuthenticationTokens, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (phoneNumberAuthenticationSettings *PhoneNumberAuthenticationSettings) Clone() *PhoneNumberAuthenticationSettings {
+	if phoneNumberAuthenticationSettings == nil {
+		return nil
+	}
+	clone := *phoneNumberAuthenticationSettings
+	if phoneNumberAuthenticationSettings.AuthenticationTokens != nil {
+		clone.AuthenticationTokens = make([]string, len(phoneNumberAuthenticationSettings.AuthenticationTokens))
+		copy(clone.AuthenticationTokens, phoneNumberAuthenticationSettings.AuthenticationTokens)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (phoneNumberAuthenticationSettings *PhoneNumberAuthenticationSettings) Equal(other *PhoneNumberAuthenticationSettings) bool {
+	if phoneNumberAuthenticationSettings == nil || other == nil {
+		return phoneNumberAuthenticationSettings == other
+	}
+	if phoneNumberAuthenticationSettings.AllowFlashCall != other.AllowFlashCall {
+		return false
+	}
+	if phoneNumberAuthenticationSettings.AllowMissedCall != other.AllowMissedCall {
+		return false
+	}
+	if phoneNumberAuthenticationSettings.IsCurrentPhoneNumber != other.IsCurrentPhoneNumber {
+		return false
+	}
+	if phoneNumberAuthenticationSettings.AllowSmsRetrieverApi != other.AllowSmsRetrieverApi {
+		return false
+	}
+	if len(phoneNumberAuthenticationSettings.AuthenticationTokens) != len(other.AuthenticationTokens) {
+		return false
+	}
+	for i0 := range phoneNumberAuthenticationSettings.AuthenticationTokens {
+		if phoneNumberAuthenticationSettings.AuthenticationTokens[i0] != other.AuthenticationTokens[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (phoneNumberAuthenticationSettings *PhoneNumberAuthenticationSettings) Diff(other *PhoneNumberAuthenticationSettings) []Change {
+	return phoneNumberAuthenticationSettings.diff("", other, nil)
+}
+
+func (phoneNumberAuthenticationSettings *PhoneNumberAuthenticationSettings) diff(path string, other *PhoneNumberAuthenticationSettings, changes []Change) []Change {
+	if phoneNumberAuthenticationSettings == nil || other == nil {
+		if phoneNumberAuthenticationSettings != other {
+			changes = append(changes, Change{Path: path, Old: phoneNumberAuthenticationSettings, New: other})
+		}
+		return changes
+	}
+	if phoneNumberAuthenticationSettings.AllowFlashCall != other.AllowFlashCall {
+		changes = append(changes, Change{Path: fieldPath(path, "allow_flash_call"), Old: phoneNumberAuthenticationSettings.AllowFlashCall, New: other.AllowFlashCall})
+	}
+	if phoneNumberAuthenticationSettings.AllowMissedCall != other.AllowMissedCall {
+		changes = append(changes, Change{Path: fieldPath(path, "allow_missed_call"), Old: phoneNumberAuthenticationSettings.AllowMissedCall, New: other.AllowMissedCall})
+	}
+	if phoneNumberAuthenticationSettings.IsCurrentPhoneNumber != other.IsCurrentPhoneNumber {
+		changes = append(changes, Change{Path: fieldPath(path, "is_current_phone_number"), Old: phoneNumberAuthenticationSettings.IsCurrentPhoneNumber, New: other.IsCurrentPhoneNumber})
+	}
+	if phoneNumberAuthenticationSettings.AllowSmsRetrieverApi != other.AllowSmsRetrieverApi {
+		changes = append(changes, Change{Path: fieldPath(path, "allow_sms_retriever_api"), Old: phoneNumberAuthenticationSettings.AllowSmsRetrieverApi, New: other.AllowSmsRetrieverApi})
+	}
+	if len(phoneNumberAuthenticationSettings.AuthenticationTokens) != len(other.AuthenticationTokens) {
+		changes = append(changes, Change{Path: fieldPath(path, "authentication_tokens"), Old: phoneNumberAuthenticationSettings.AuthenticationTokens, New: other.AuthenticationTokens})
+	} else {
+		for i0 := range phoneNumberAuthenticationSettings.AuthenticationTokens {
+			if phoneNumberAuthenticationSettings.AuthenticationTokens[i0] != other.AuthenticationTokens[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "authentication_tokens"), i0), Old: phoneNumberAuthenticationSettings.AuthenticationTokens[i0], New: other.AuthenticationTokens[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (phoneNumberAuthenticationSettings *PhoneNumberAuthenticationSettings) String() string {
+	return objectString(phoneNumberAuthenticationSettings)
+}
+
+// LogValue logs the object as its summary
+func (phoneNumberAuthenticationSettings *PhoneNumberAuthenticationSettings) LogValue() slog.Value {
+	return slog.StringValue(objectString(phoneNumberAuthenticationSettings))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (phoneNumberAuthenticationSettings *PhoneNumberAuthenticationSettings) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, phoneNumberAuthenticationSettings)
+}
+
+func (phoneNumberAuthenticationSettings *PhoneNumberAuthenticationSettings) printText(p *printer) {
+	if phoneNumberAuthenticationSettings == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PhoneNumberAuthenticationSettings")
+	p.bool("allow_flash_call", phoneNumberAuthenticationSettings.AllowFlashCall)
+	p.bool("allow_missed_call", phoneNumberAuthenticationSettings.AllowMissedCall)
+	p.bool("is_current_phone_number", phoneNumberAuthenticationSettings.IsCurrentPhoneNumber)
+	p.bool("allow_sms_retriever_api", phoneNumberAuthenticationSettings.AllowSmsRetrieverApi)
+	p.vector("authentication_tokens", len(phoneNumberAuthenticationSettings.AuthenticationTokens), func(i0 int) {
+		p.string("authentication_tokens", phoneNumberAuthenticationSettings.AuthenticationTokens[i0])
+	})
+	p.endObject(state)
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // PhoneNumberInfo Contains information about a phone number
@@ -34,6 +34,138 @@ func NewPhoneNumberInfo(country *CountryInfo, countryCallingCode string, formatt
 	return &phoneNumberInfoTemp
 }
 
+// MarshalJSON marshals to json
+func (phoneNumberInfo *PhoneNumberInfo) MarshalJSON() ([]byte, error) {
+	return phoneNumberInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (phoneNumberInfo *PhoneNumberInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, phoneNumberInfo)
+}
+
+func (phoneNumberInfo *PhoneNumberInfo) appendJSON(b []byte) ([]byte, error) {
+	if phoneNumberInfo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, phoneNumberInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, phoneNumberInfo.tdCommon.Extra)
+	b = append(b, `,"country":`...)
+	b, err = phoneNumberInfo.Country.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"country_calling_code":`...)
+	b = appendJSONString(b, phoneNumberInfo.CountryCallingCode)
+	b = append(b, `,"formatted_phone_number":`...)
+	b = appendJSONString(b, phoneNumberInfo.FormattedPhoneNumber)
+	return append(b, '}'), nil
+}
+
+func (phoneNumberInfo *PhoneNumberInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			phoneNumberInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			phoneNumberInfo.tdCommon.Extra, err = r.readString()
+		case "country":
+			if r.readNull() {
+				phoneNumberInfo.Country = nil
+			} else {
+				phoneNumberInfo.Country = new(CountryInfo)
+				err = phoneNumberInfo.Country.decodeJSON(r)
+			}
+		case "country_calling_code":
+			phoneNumberInfo.CountryCallingCode, err = r.readString()
+		case "formatted_phone_number":
+			phoneNumberInfo.FormattedPhoneNumber, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (phoneNumberInfo *PhoneNumberInfo) Clone() *PhoneNumberInfo {
+	if phoneNumberInfo == nil {
+		return nil
+	}
+	clone := *phoneNumberInfo
+	clone.Country = phoneNumberInfo.Country.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (phoneNumberInfo *PhoneNumberInfo) Equal(other *PhoneNumberInfo) bool {
+	if phoneNumberInfo == nil || other == nil {
+		return phoneNumberInfo == other
+	}
+	if !phoneNumberInfo.Country.Equal(other.Country) {
+		return false
+	}
+	if phoneNumberInfo.CountryCallingCode != other.CountryCallingCode {
+		return false
+	}
+	if phoneNumberInfo.FormattedPhoneNumber != other.FormattedPhoneNumber {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (phoneNumberInfo *PhoneNumberInfo) Diff(other *PhoneNumberInfo) []Change {
+	return phoneNumberInfo.diff("", other, nil)
+}
+
+func (phoneNumberInfo *PhoneNumberInfo) diff(path string, other *PhoneNumberInfo, changes []Change) []Change {
+	if phoneNumberInfo == nil || other == nil {
+		if phoneNumberInfo != other {
+			changes = append(changes, Change{Path: path, Old: phoneNumberInfo, New: other})
+		}
+		return changes
+	}
+	changes = phoneNumberInfo.Country.diff(fieldPath(path, "country"), other.Country, changes)
+	if phoneNumberInfo.CountryCallingCode != other.CountryCallingCode {
+		changes = append(changes, Change{Path: fieldPath(path, "country_calling_code"), Old: phoneNumberInfo.CountryCallingCode, New: other.CountryCallingCode})
+	}
+	if phoneNumberInfo.FormattedPhoneNumber != other.FormattedPhoneNumber {
+		changes = append(changes, Change{Path: fieldPath(path, "formatted_phone_number"), Old: phoneNumberInfo.FormattedPhoneNumber, New: other.FormattedPhoneNumber})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (phoneNumberInfo *PhoneNumberInfo) String() string {
+	return objectString(phoneNumberInfo)
+}
+
+// LogValue logs the object as its summary
+func (phoneNumberInfo *PhoneNumberInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(phoneNumberInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (phoneNumberInfo *PhoneNumberInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, phoneNumberInfo)
+}
+
+func (phoneNumberInfo *PhoneNumberInfo) printText(p *printer) {
+	if phoneNumberInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PhoneNumberInfo")
+	p.object("country", phoneNumberInfo.Country)
+	p.string("country_calling_code", phoneNumberInfo.CountryCallingCode)
+	p.string("formatted_phone_number", phoneNumberInfo.FormattedPhoneNumber)
+	p.endObject(state)
+}
+
 // GetPhoneNumberInfo Returns information about a phone number by its prefix. Can be called before authorization
 // @param phoneNumberPrefix The phone number prefix
 func (client *Client) GetPhoneNumberInfo(phoneNumberPrefix string) (*PhoneNumberInfo, error) {
@@ -46,12 +178,12 @@ func (client *Client) GetPhoneNumberInfo(phoneNumberPrefix string) (*PhoneNumber
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var phoneNumberInfo PhoneNumberInfo
-	err = json.Unmarshal(result.Raw, &phoneNumberInfo)
+	err = jsonUnmarshal(result.Raw, &phoneNumberInfo)
 	return &phoneNumberInfo, err
 }
 
@@ -69,11 +201,34 @@ func (client *Client) GetPhoneNumberInfoSync(languageCode string, phoneNumberPre
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
+		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
+	}
+
+	var phoneNumberInfo PhoneNumberInfo
+	err = jsonUnmarshal(result.Raw, &phoneNumberInfo)
+	return &phoneNumberInfo, err
+}
+
+// ExecuteGetPhoneNumberInfoSync Synchronously executes GetPhoneNumberInfoSync, without a client. Returns information about a phone number by its prefix synchronously. getCountries must be called at least once after changing localization to the specified language if properly localized country information is expected. Can be called synchronously
+// @param languageCode A two-letter ISO 639-1 country code for country information localization
+// @param phoneNumberPrefix The phone number prefix
+func ExecuteGetPhoneNumberInfoSync(languageCode string, phoneNumberPrefix string) (*PhoneNumberInfo, error) {
+	result, err := execute(UpdateData{
+		"@type":               "getPhoneNumberInfoSync",
+		"language_code":       languageCode,
+		"phone_number_prefix": phoneNumberPrefix,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var phoneNumberInfo PhoneNumberInfo
-	err = json.Unmarshal(result.Raw, &phoneNumberInfo)
+	err = jsonUnmarshal(result.Raw, &phoneNumberInfo)
 	return &phoneNumberInfo, err
 }
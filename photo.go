@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // Photo Describes a photo
 type Photo struct {
 	tdCommon
@@ -28,3 +33,176 @@ func NewPhoto(hasStickers bool, minithumbnail *Minithumbnail, sizes []PhotoSize)
 
 	return &photoTemp
 }
+
+// MarshalJSON marshals to json
+func (photo *Photo) MarshalJSON() ([]byte, error) {
+	return photo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (photo *Photo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, photo)
+}
+
+func (photo *Photo) appendJSON(b []byte) ([]byte, error) {
+	if photo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, photo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, photo.tdCommon.Extra)
+	b = append(b, `,"has_stickers":`...)
+	b = appendJSONBool(b, photo.HasStickers)
+	b = append(b, `,"minithumbnail":`...)
+	b, err = photo.Minithumbnail.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"sizes":`...)
+	if photo.Sizes == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range photo.Sizes {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = photo.Sizes[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (photo *Photo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			photo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			photo.tdCommon.Extra, err = r.readString()
+		case "has_stickers":
+			photo.HasStickers, err = r.readBool()
+		case "minithumbnail":
+			if r.readNull() {
+				photo.Minithumbnail = nil
+			} else {
+				photo.Minithumbnail = new(Minithumbnail)
+				err = photo.Minithumbnail.decodeJSON(r)
+			}
+		case "sizes":
+			if r.readNull() {
+				photo.Sizes = nil
+			} else {
+				photo.Sizes = make([]PhotoSize, 0)
+				err = r.readArray(func() (err error) {
+					var item0 PhotoSize
+					err = item0.decodeJSON(r)
+					photo.Sizes = append(photo.Sizes, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (photo *Photo) Clone() *Photo {
+	if photo == nil {
+		return nil
+	}
+	clone := *photo
+	clone.Minithumbnail = photo.Minithumbnail.Clone()
+	if photo.Sizes != nil {
+		clone.Sizes = make([]PhotoSize, len(photo.Sizes))
+		for i0 := range photo.Sizes {
+			clone.Sizes[i0] = *photo.Sizes[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (photo *Photo) Equal(other *Photo) bool {
+	if photo == nil || other == nil {
+		return photo == other
+	}
+	if photo.HasStickers != other.HasStickers {
+		return false
+	}
+	if !photo.Minithumbnail.Equal(other.Minithumbnail) {
+		return false
+	}
+	if len(photo.Sizes) != len(other.Sizes) {
+		return false
+	}
+	for i0 := range photo.Sizes {
+		if !photo.Sizes[i0].Equal(&other.Sizes[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (photo *Photo) Diff(other *Photo) []Change {
+	return photo.diff("", other, nil)
+}
+
+func (photo *Photo) diff(path string, other *Photo, changes []Change) []Change {
+	if photo == nil || other == nil {
+		if photo != other {
+			changes = append(changes, Change{Path: path, Old: photo, New: other})
+		}
+		return changes
+	}
+	if photo.HasStickers != other.HasStickers {
+		changes = append(changes, Change{Path: fieldPath(path, "has_stickers"), Old: photo.HasStickers, New: other.HasStickers})
+	}
+	changes = photo.Minithumbnail.diff(fieldPath(path, "minithumbnail"), other.Minithumbnail, changes)
+	if len(photo.Sizes) != len(other.Sizes) {
+		changes = append(changes, Change{Path: fieldPath(path, "sizes"), Old: photo.Sizes, New: other.Sizes})
+	} else {
+		for i0 := range photo.Sizes {
+			changes = photo.Sizes[i0].diff(indexPath(fieldPath(path, "sizes"), i0), &other.Sizes[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (photo *Photo) String() string {
+	return objectString(photo)
+}
+
+// LogValue logs the object as its summary
+func (photo *Photo) LogValue() slog.Value {
+	return slog.StringValue(objectString(photo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (photo *Photo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, photo)
+}
+
+func (photo *Photo) printText(p *printer) {
+	if photo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Photo")
+	p.bool("has_stickers", photo.HasStickers)
+	p.object("minithumbnail", photo.Minithumbnail)
+	p.vector("sizes", len(photo.Sizes), func(i0 int) {
+		photo.Sizes[i0].printText(p)
+	})
+	p.endObject(state)
+}
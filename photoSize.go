@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // PhotoSize Describes an image in JPEG format
 type PhotoSize struct {
 	tdCommon
@@ -34,3 +39,195 @@ func NewPhotoSize(typeParam string, photo *File, width int32, height int32, prog
 
 	return &photoSizeTemp
 }
+
+// MarshalJSON marshals to json
+func (photoSize *PhotoSize) MarshalJSON() ([]byte, error) {
+	return photoSize.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (photoSize *PhotoSize) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, photoSize)
+}
+
+func (photoSize *PhotoSize) appendJSON(b []byte) ([]byte, error) {
+	if photoSize == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, photoSize.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, photoSize.tdCommon.Extra)
+	b = append(b, `,"type":`...)
+	b = appendJSONString(b, photoSize.Type)
+	b = append(b, `,"photo":`...)
+	b, err = photoSize.Photo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"width":`...)
+	b = appendJSONInt(b, int64(photoSize.Width))
+	b = append(b, `,"height":`...)
+	b = appendJSONInt(b, int64(photoSize.Height))
+	b = append(b, `,"progressive_sizes":`...)
+	if photoSize.ProgressiveSizes == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range photoSize.ProgressiveSizes {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(photoSize.ProgressiveSizes[i0]))
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (photoSize *PhotoSize) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			photoSize.tdCommon.Type, err = r.readString()
+		case "@extra":
+			photoSize.tdCommon.Extra, err = r.readString()
+		case "type":
+			photoSize.Type, err = r.readString()
+		case "photo":
+			if r.readNull() {
+				photoSize.Photo = nil
+			} else {
+				photoSize.Photo = new(File)
+				err = photoSize.Photo.decodeJSON(r)
+			}
+		case "width":
+			photoSize.Width, err = r.readInt32()
+		case "height":
+			photoSize.Height, err = r.readInt32()
+		case "progressive_sizes":
+			if r.readNull() {
+				photoSize.ProgressiveSizes = nil
+			} else {
+				photoSize.ProgressiveSizes = make([]int32, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int32
+					item0, err = r.readInt32()
+					photoSize.ProgressiveSizes = append(photoSize.ProgressiveSizes, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (photoSize *PhotoSize) Clone() *PhotoSize {
+	if photoSize == nil {
+		return nil
+	}
+	clone := *photoSize
+	clone.Photo = photoSize.Photo.Clone()
+	if photoSize.ProgressiveSizes != nil {
+		clone.ProgressiveSizes = make([]int32, len(photoSize.ProgressiveSizes))
+		copy(clone.ProgressiveSizes, photoSize.ProgressiveSizes)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (photoSize *PhotoSize) Equal(other *PhotoSize) bool {
+	if photoSize == nil || other == nil {
+		return photoSize == other
+	}
+	if photoSize.Type != other.Type {
+		return false
+	}
+	if !photoSize.Photo.Equal(other.Photo) {
+		return false
+	}
+	if photoSize.Width != other.Width {
+		return false
+	}
+	if photoSize.Height != other.Height {
+		return false
+	}
+	if len(photoSize.ProgressiveSizes) != len(other.ProgressiveSizes) {
+		return false
+	}
+	for i0 := range photoSize.ProgressiveSizes {
+		if photoSize.ProgressiveSizes[i0] != other.ProgressiveSizes[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (photoSize *PhotoSize) Diff(other *PhotoSize) []Change {
+	return photoSize.diff("", other, nil)
+}
+
+func (photoSize *PhotoSize) diff(path string, other *PhotoSize, changes []Change) []Change {
+	if photoSize == nil || other == nil {
+		if photoSize != other {
+			changes = append(changes, Change{Path: path, Old: photoSize, New: other})
+		}
+		return changes
+	}
+	if photoSize.Type != other.Type {
+		changes = append(changes, Change{Path: fieldPath(path, "type"), Old: photoSize.Type, New: other.Type})
+	}
+	changes = photoSize.Photo.diff(fieldPath(path, "photo"), other.Photo, changes)
+	if photoSize.Width != other.Width {
+		changes = append(changes, Change{Path: fieldPath(path, "width"), Old: photoSize.Width, New: other.Width})
+	}
+	if photoSize.Height != other.Height {
+		changes = append(changes, Change{Path: fieldPath(path, "height"), Old: photoSize.Height, New: other.Height})
+	}
+	if len(photoSize.ProgressiveSizes) != len(other.ProgressiveSizes) {
+		changes = append(changes, Change{Path: fieldPath(path, "progressive_sizes"), Old: photoSize.ProgressiveSizes, New: other.ProgressiveSizes})
+	} else {
+		for i0 := range photoSize.ProgressiveSizes {
+			if photoSize.ProgressiveSizes[i0] != other.ProgressiveSizes[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "progressive_sizes"), i0), Old: photoSize.ProgressiveSizes[i0], New: other.ProgressiveSizes[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (photoSize *PhotoSize) String() string {
+	return objectString(photoSize)
+}
+
+// LogValue logs the object as its summary
+func (photoSize *PhotoSize) LogValue() slog.Value {
+	return slog.StringValue(objectString(photoSize))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (photoSize *PhotoSize) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, photoSize)
+}
+
+func (photoSize *PhotoSize) printText(p *printer) {
+	if photoSize == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PhotoSize")
+	p.string("type", photoSize.Type)
+	p.object("photo", photoSize.Photo)
+	p.int("width", int64(photoSize.Width))
+	p.int("height", int64(photoSize.Height))
+	p.vector("progressive_sizes", len(photoSize.ProgressiveSizes), func(i0 int) {
+		p.int("progressive_sizes", int64(photoSize.ProgressiveSizes[i0]))
+	})
+	p.endObject(state)
+}
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // Point A point on a Cartesian plane
 type Point struct {
 	tdCommon
@@ -25,3 +30,123 @@ func NewPoint(x float64, y float64) *Point {
 
 	return &pointTemp
 }
+
+// MarshalJSON marshals to json
+func (point *Point) MarshalJSON() ([]byte, error) {
+	return point.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (point *Point) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, point)
+}
+
+func (point *Point) appendJSON(b []byte) ([]byte, error) {
+	if point == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, point.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, point.tdCommon.Extra)
+	b = append(b, `,"x":`...)
+	b, err = appendJSONFloat(b, point.X)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"y":`...)
+	b, err = appendJSONFloat(b, point.Y)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (point *Point) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			point.tdCommon.Type, err = r.readString()
+		case "@extra":
+			point.tdCommon.Extra, err = r.readString()
+		case "x":
+			point.X, err = r.readFloat64()
+		case "y":
+			point.Y, err = r.readFloat64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (point *Point) Clone() *Point {
+	if point == nil {
+		return nil
+	}
+	clone := *point
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (point *Point) Equal(other *Point) bool {
+	if point == nil || other == nil {
+		return point == other
+	}
+	if point.X != other.X {
+		return false
+	}
+	if point.Y != other.Y {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (point *Point) Diff(other *Point) []Change {
+	return point.diff("", other, nil)
+}
+
+func (point *Point) diff(path string, other *Point, changes []Change) []Change {
+	if point == nil || other == nil {
+		if point != other {
+			changes = append(changes, Change{Path: path, Old: point, New: other})
+		}
+		return changes
+	}
+	if point.X != other.X {
+		changes = append(changes, Change{Path: fieldPath(path, "x"), Old: point.X, New: other.X})
+	}
+	if point.Y != other.Y {
+		changes = append(changes, Change{Path: fieldPath(path, "y"), Old: point.Y, New: other.Y})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (point *Point) String() string {
+	return objectString(point)
+}
+
+// LogValue logs the object as its summary
+func (point *Point) LogValue() slog.Value {
+	return slog.StringValue(objectString(point))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (point *Point) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, point)
+}
+
+func (point *Point) printText(p *printer) {
+	if point == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Point")
+	p.float("x", point.X)
+	p.float("y", point.Y)
+	p.endObject(state)
+}
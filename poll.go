@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // Poll Describes a poll
@@ -54,43 +55,285 @@ func NewPoll(id JSONInt64, question string, options []PollOption, totalVoterCoun
 	return &pollTemp
 }
 
+// MarshalJSON marshals to json
+func (poll *Poll) MarshalJSON() ([]byte, error) {
+	return poll.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (poll *Poll) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Id                 JSONInt64    `json:"id"`                    // Unique poll identifier
-		Question           string       `json:"question"`              // Poll question; 1-300 characters
-		Options            []PollOption `json:"options"`               // List of poll answer options
-		TotalVoterCount    int32        `json:"total_voter_count"`     // Total number of voters, participating in the poll
-		RecentVoterUserIds []int64      `json:"recent_voter_user_ids"` // User identifiers of recent voters, if the poll is non-anonymous
-		IsAnonymous        bool         `json:"is_anonymous"`          // True, if the poll is anonymous
-		OpenPeriod         int32        `json:"open_period"`           // Amount of time the poll will be active after creation, in seconds
-		CloseDate          int32        `json:"close_date"`            // Point in time (Unix timestamp) when the poll will automatically be closed
-		IsClosed           bool         `json:"is_closed"`             // True, if the poll is closed
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	return unmarshalFast(b, poll)
+}
+
+func (poll *Poll) appendJSON(b []byte) ([]byte, error) {
+	if poll == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, poll.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, poll.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt64String(b, int64(poll.Id))
+	b = append(b, `,"question":`...)
+	b = appendJSONString(b, poll.Question)
+	b = append(b, `,"options":`...)
+	if poll.Options == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range poll.Options {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = poll.Options[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"total_voter_count":`...)
+	b = appendJSONInt(b, int64(poll.TotalVoterCount))
+	b = append(b, `,"recent_voter_user_ids":`...)
+	if poll.RecentVoterUserIds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range poll.RecentVoterUserIds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(poll.RecentVoterUserIds[i0]))
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"is_anonymous":`...)
+	b = appendJSONBool(b, poll.IsAnonymous)
+	b = append(b, `,"type":`...)
+	b, err = appendJSONValue(b, poll.Type)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"open_period":`...)
+	b = appendJSONInt(b, int64(poll.OpenPeriod))
+	b = append(b, `,"close_date":`...)
+	b = appendJSONInt(b, int64(poll.CloseDate))
+	b = append(b, `,"is_closed":`...)
+	b = appendJSONBool(b, poll.IsClosed)
+	return append(b, '}'), nil
+}
+
+func (poll *Poll) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			poll.tdCommon.Type, err = r.readString()
+		case "@extra":
+			poll.tdCommon.Extra, err = r.readString()
+		case "id":
+			poll.Id, err = r.readJSONInt64()
+		case "question":
+			poll.Question, err = r.readString()
+		case "options":
+			if r.readNull() {
+				poll.Options = nil
+			} else {
+				poll.Options = make([]PollOption, 0)
+				err = r.readArray(func() (err error) {
+					var item0 PollOption
+					err = item0.decodeJSON(r)
+					poll.Options = append(poll.Options, item0)
+					return
+				})
+			}
+		case "total_voter_count":
+			poll.TotalVoterCount, err = r.readInt32()
+		case "recent_voter_user_ids":
+			if r.readNull() {
+				poll.RecentVoterUserIds = nil
+			} else {
+				poll.RecentVoterUserIds = make([]int64, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int64
+					item0, err = r.readInt64()
+					poll.RecentVoterUserIds = append(poll.RecentVoterUserIds, item0)
+					return
+				})
+			}
+		case "is_anonymous":
+			poll.IsAnonymous, err = r.readBool()
+		case "type":
+			poll.Type, err = decodePollType(r)
+		case "open_period":
+			poll.OpenPeriod, err = r.readInt32()
+		case "close_date":
+			poll.CloseDate, err = r.readInt32()
+		case "is_closed":
+			poll.IsClosed, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (poll *Poll) Clone() *Poll {
+	if poll == nil {
+		return nil
+	}
+	clone := *poll
+	if poll.Options != nil {
+		clone.Options = make([]PollOption, len(poll.Options))
+		for i0 := range poll.Options {
+			clone.Options[i0] = *poll.Options[i0].Clone()
+		}
+	}
+	if poll.RecentVoterUserIds != nil {
+		clone.RecentVoterUserIds = make([]int64, len(poll.RecentVoterUserIds))
+		copy(clone.RecentVoterUserIds, poll.RecentVoterUserIds)
+	}
+	clone.Type = clonePollType(poll.Type)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (poll *Poll) Equal(other *Poll) bool {
+	if poll == nil || other == nil {
+		return poll == other
+	}
+	if poll.Id != other.Id {
+		return false
+	}
+	if poll.Question != other.Question {
+		return false
+	}
+	if len(poll.Options) != len(other.Options) {
+		return false
+	}
+	for i0 := range poll.Options {
+		if !poll.Options[i0].Equal(&other.Options[i0]) {
+			return false
+		}
+	}
+	if poll.TotalVoterCount != other.TotalVoterCount {
+		return false
+	}
+	if len(poll.RecentVoterUserIds) != len(other.RecentVoterUserIds) {
+		return false
+	}
+	for i0 := range poll.RecentVoterUserIds {
+		if poll.RecentVoterUserIds[i0] != other.RecentVoterUserIds[i0] {
+			return false
+		}
 	}
+	if poll.IsAnonymous != other.IsAnonymous {
+		return false
+	}
+	if !equalPollType(poll.Type, other.Type) {
+		return false
+	}
+	if poll.OpenPeriod != other.OpenPeriod {
+		return false
+	}
+	if poll.CloseDate != other.CloseDate {
+		return false
+	}
+	if poll.IsClosed != other.IsClosed {
+		return false
+	}
+	return true
+}
 
-	poll.tdCommon = tempObj.tdCommon
-	poll.Id = tempObj.Id
-	poll.Question = tempObj.Question
-	poll.Options = tempObj.Options
-	poll.TotalVoterCount = tempObj.TotalVoterCount
-	poll.RecentVoterUserIds = tempObj.RecentVoterUserIds
-	poll.IsAnonymous = tempObj.IsAnonymous
-	poll.OpenPeriod = tempObj.OpenPeriod
-	poll.CloseDate = tempObj.CloseDate
-	poll.IsClosed = tempObj.IsClosed
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (poll *Poll) Diff(other *Poll) []Change {
+	return poll.diff("", other, nil)
+}
 
-	fieldType, _ := unmarshalPollType(objMap["type"])
-	poll.Type = fieldType
+func (poll *Poll) diff(path string, other *Poll, changes []Change) []Change {
+	if poll == nil || other == nil {
+		if poll != other {
+			changes = append(changes, Change{Path: path, Old: poll, New: other})
+		}
+		return changes
+	}
+	if poll.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: poll.Id, New: other.Id})
+	}
+	if poll.Question != other.Question {
+		changes = append(changes, Change{Path: fieldPath(path, "question"), Old: poll.Question, New: other.Question})
+	}
+	if len(poll.Options) != len(other.Options) {
+		changes = append(changes, Change{Path: fieldPath(path, "options"), Old: poll.Options, New: other.Options})
+	} else {
+		for i0 := range poll.Options {
+			changes = poll.Options[i0].diff(indexPath(fieldPath(path, "options"), i0), &other.Options[i0], changes)
+		}
+	}
+	if poll.TotalVoterCount != other.TotalVoterCount {
+		changes = append(changes, Change{Path: fieldPath(path, "total_voter_count"), Old: poll.TotalVoterCount, New: other.TotalVoterCount})
+	}
+	if len(poll.RecentVoterUserIds) != len(other.RecentVoterUserIds) {
+		changes = append(changes, Change{Path: fieldPath(path, "recent_voter_user_ids"), Old: poll.RecentVoterUserIds, New: other.RecentVoterUserIds})
+	} else {
+		for i0 := range poll.RecentVoterUserIds {
+			if poll.RecentVoterUserIds[i0] != other.RecentVoterUserIds[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "recent_voter_user_ids"), i0), Old: poll.RecentVoterUserIds[i0], New: other.RecentVoterUserIds[i0]})
+			}
+		}
+	}
+	if poll.IsAnonymous != other.IsAnonymous {
+		changes = append(changes, Change{Path: fieldPath(path, "is_anonymous"), Old: poll.IsAnonymous, New: other.IsAnonymous})
+	}
+	changes = diffPollType(fieldPath(path, "type"), poll.Type, other.Type, changes)
+	if poll.OpenPeriod != other.OpenPeriod {
+		changes = append(changes, Change{Path: fieldPath(path, "open_period"), Old: poll.OpenPeriod, New: other.OpenPeriod})
+	}
+	if poll.CloseDate != other.CloseDate {
+		changes = append(changes, Change{Path: fieldPath(path, "close_date"), Old: poll.CloseDate, New: other.CloseDate})
+	}
+	if poll.IsClosed != other.IsClosed {
+		changes = append(changes, Change{Path: fieldPath(path, "is_closed"), Old: poll.IsClosed, New: other.IsClosed})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (poll *Poll) String() string {
+	return objectString(poll)
+}
+
+// LogValue logs the object as its summary
+func (poll *Poll) LogValue() slog.Value {
+	return slog.StringValue(objectString(poll))
+}
 
-	return nil
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (poll *Poll) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, poll)
+}
+
+func (poll *Poll) printText(p *printer) {
+	if poll == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Poll")
+	p.int("id", int64(poll.Id))
+	p.string("question", poll.Question)
+	p.vector("options", len(poll.Options), func(i0 int) {
+		poll.Options[i0].printText(p)
+	})
+	p.int("total_voter_count", int64(poll.TotalVoterCount))
+	p.vector("recent_voter_user_ids", len(poll.RecentVoterUserIds), func(i0 int) {
+		p.int("recent_voter_user_ids", int64(poll.RecentVoterUserIds[i0]))
+	})
+	p.bool("is_anonymous", poll.IsAnonymous)
+	p.object("type", poll.Type)
+	p.int("open_period", int64(poll.OpenPeriod))
+	p.int("close_date", int64(poll.CloseDate))
+	p.bool("is_closed", poll.IsClosed)
+	p.endObject(state)
 }
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // PollOption Describes one answer option of a poll
 type PollOption struct {
 	tdCommon
@@ -34,3 +39,149 @@ func NewPollOption(text string, voterCount int32, votePercentage int32, isChosen
 
 	return &pollOptionTemp
 }
+
+// MarshalJSON marshals to json
+func (pollOption *PollOption) MarshalJSON() ([]byte, error) {
+	return pollOption.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pollOption *PollOption) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pollOption)
+}
+
+func (pollOption *PollOption) appendJSON(b []byte) ([]byte, error) {
+	if pollOption == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pollOption.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pollOption.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b = appendJSONString(b, pollOption.Text)
+	b = append(b, `,"voter_count":`...)
+	b = appendJSONInt(b, int64(pollOption.VoterCount))
+	b = append(b, `,"vote_percentage":`...)
+	b = appendJSONInt(b, int64(pollOption.VotePercentage))
+	b = append(b, `,"is_chosen":`...)
+	b = appendJSONBool(b, pollOption.IsChosen)
+	b = append(b, `,"is_being_chosen":`...)
+	b = appendJSONBool(b, pollOption.IsBeingChosen)
+	return append(b, '}'), nil
+}
+
+func (pollOption *PollOption) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pollOption.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pollOption.tdCommon.Extra, err = r.readString()
+		case "text":
+			pollOption.Text, err = r.readString()
+		case "voter_count":
+			pollOption.VoterCount, err = r.readInt32()
+		case "vote_percentage":
+			pollOption.VotePercentage, err = r.readInt32()
+		case "is_chosen":
+			pollOption.IsChosen, err = r.readBool()
+		case "is_being_chosen":
+			pollOption.IsBeingChosen, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pollOption *PollOption) Clone() *PollOption {
+	if pollOption == nil {
+		return nil
+	}
+	clone := *pollOption
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pollOption *PollOption) Equal(other *PollOption) bool {
+	if pollOption == nil || other == nil {
+		return pollOption == other
+	}
+	if pollOption.Text != other.Text {
+		return false
+	}
+	if pollOption.VoterCount != other.VoterCount {
+		return false
+	}
+	if pollOption.VotePercentage != other.VotePercentage {
+		return false
+	}
+	if pollOption.IsChosen != other.IsChosen {
+		return false
+	}
+	if pollOption.IsBeingChosen != other.IsBeingChosen {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pollOption *PollOption) Diff(other *PollOption) []Change {
+	return pollOption.diff("", other, nil)
+}
+
+func (pollOption *PollOption) diff(path string, other *PollOption, changes []Change) []Change {
+	if pollOption == nil || other == nil {
+		if pollOption != other {
+			changes = append(changes, Change{Path: path, Old: pollOption, New: other})
+		}
+		return changes
+	}
+	if pollOption.Text != other.Text {
+		changes = append(changes, Change{Path: fieldPath(path, "text"), Old: pollOption.Text, New: other.Text})
+	}
+	if pollOption.VoterCount != other.VoterCount {
+		changes = append(changes, Change{Path: fieldPath(path, "voter_count"), Old: pollOption.VoterCount, New: other.VoterCount})
+	}
+	if pollOption.VotePercentage != other.VotePercentage {
+		changes = append(changes, Change{Path: fieldPath(path, "vote_percentage"), Old: pollOption.VotePercentage, New: other.VotePercentage})
+	}
+	if pollOption.IsChosen != other.IsChosen {
+		changes = append(changes, Change{Path: fieldPath(path, "is_chosen"), Old: pollOption.IsChosen, New: other.IsChosen})
+	}
+	if pollOption.IsBeingChosen != other.IsBeingChosen {
+		changes = append(changes, Change{Path: fieldPath(path, "is_being_chosen"), Old: pollOption.IsBeingChosen, New: other.IsBeingChosen})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pollOption *PollOption) String() string {
+	return objectString(pollOption)
+}
+
+// LogValue logs the object as its summary
+func (pollOption *PollOption) LogValue() slog.Value {
+	return slog.StringValue(objectString(pollOption))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pollOption *PollOption) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pollOption)
+}
+
+func (pollOption *PollOption) printText(p *printer) {
+	if pollOption == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PollOption")
+	p.string("text", pollOption.Text)
+	p.int("voter_count", int64(pollOption.VoterCount))
+	p.int("vote_percentage", int64(pollOption.VotePercentage))
+	p.bool("is_chosen", pollOption.IsChosen)
+	p.bool("is_being_chosen", pollOption.IsBeingChosen)
+	p.endObject(state)
+}
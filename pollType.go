@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // PollType Describes the type of a poll
 type PollType interface {
 	GetPollTypeEnum() PollTypeEnum
+	Accept(visitor PollTypeVisitor)
 }
 
 // PollTypeEnum Alias for abstract PollType 'Sub-Classes', used as constant-enum here
@@ -19,33 +21,84 @@ const (
 	PollTypeQuizType    PollTypeEnum = "pollTypeQuiz"
 )
 
+// UnknownPollType is a PollType of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownPollType struct {
+	Unknown
+}
+
+// GetPollTypeEnum return the enum type of this object
+func (unknownPollType *UnknownPollType) GetPollTypeEnum() PollTypeEnum {
+	return PollTypeEnum(unknownPollType.Type)
+}
+
+// Accept calls the method of the visitor for UnknownPollType
+func (unknownPollType *UnknownPollType) Accept(visitor PollTypeVisitor) {
+	visitor.VisitUnknownPollType(unknownPollType)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownPollType *UnknownPollType) String() string {
+	return objectString(unknownPollType)
+}
+
+// LogValue logs the object as its summary
+func (unknownPollType *UnknownPollType) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownPollType))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownPollType *UnknownPollType) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownPollType)
+}
+
+func (unknownPollType *UnknownPollType) printText(p *printer) {
+	if unknownPollType == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownPollType")
+	p.string("@type", unknownPollType.Type)
+	p.endObject(state)
+}
+
 func unmarshalPollType(rawMsg *json.RawMessage) (PollType, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch PollTypeEnum(objMap["@type"].(string)) {
+	switch PollTypeEnum(typeName) {
 	case PollTypeRegularType:
 		var pollTypeRegular PollTypeRegular
-		err := json.Unmarshal(*rawMsg, &pollTypeRegular)
+		err := jsonUnmarshal(*rawMsg, &pollTypeRegular)
 		return &pollTypeRegular, err
 
 	case PollTypeQuizType:
 		var pollTypeQuiz PollTypeQuiz
-		err := json.Unmarshal(*rawMsg, &pollTypeQuiz)
+		err := jsonUnmarshal(*rawMsg, &pollTypeQuiz)
 		return &pollTypeQuiz, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownPollType{Unknown: unknown}, nil
 	}
 }
 
+func decodePollType(r *jsonReader) (PollType, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalPollType(rawMsg)
+}
+
 // PollTypeRegular A regular poll
 type PollTypeRegular struct {
 	tdCommon
@@ -69,11 +122,118 @@ func NewPollTypeRegular(allowMultipleAnswers bool) *PollTypeRegular {
 	return &pollTypeRegularTemp
 }
 
+// MarshalJSON marshals to json
+func (pollTypeRegular *PollTypeRegular) MarshalJSON() ([]byte, error) {
+	return pollTypeRegular.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pollTypeRegular *PollTypeRegular) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pollTypeRegular)
+}
+
+func (pollTypeRegular *PollTypeRegular) appendJSON(b []byte) ([]byte, error) {
+	if pollTypeRegular == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pollTypeRegular.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pollTypeRegular.tdCommon.Extra)
+	b = append(b, `,"allow_multiple_answers":`...)
+	b = appendJSONBool(b, pollTypeRegular.AllowMultipleAnswers)
+	return append(b, '}'), nil
+}
+
+func (pollTypeRegular *PollTypeRegular) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pollTypeRegular.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pollTypeRegular.tdCommon.Extra, err = r.readString()
+		case "allow_multiple_answers":
+			pollTypeRegular.AllowMultipleAnswers, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pollTypeRegular *PollTypeRegular) Clone() *PollTypeRegular {
+	if pollTypeRegular == nil {
+		return nil
+	}
+	clone := *pollTypeRegular
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pollTypeRegular *PollTypeRegular) Equal(other *PollTypeRegular) bool {
+	if pollTypeRegular == nil || other == nil {
+		return pollTypeRegular == other
+	}
+	if pollTypeRegular.AllowMultipleAnswers != other.AllowMultipleAnswers {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pollTypeRegular *PollTypeRegular) Diff(other *PollTypeRegular) []Change {
+	return pollTypeRegular.diff("", other, nil)
+}
+
+func (pollTypeRegular *PollTypeRegular) diff(path string, other *PollTypeRegular, changes []Change) []Change {
+	if pollTypeRegular == nil || other == nil {
+		if pollTypeRegular != other {
+			changes = append(changes, Change{Path: path, Old: pollTypeRegular, New: other})
+		}
+		return changes
+	}
+	if pollTypeRegular.AllowMultipleAnswers != other.AllowMultipleAnswers {
+		changes = append(changes, Change{Path: fieldPath(path, "allow_multiple_answers"), Old: pollTypeRegular.AllowMultipleAnswers, New: other.AllowMultipleAnswers})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pollTypeRegular *PollTypeRegular) String() string {
+	return objectString(pollTypeRegular)
+}
+
+// LogValue logs the object as its summary
+func (pollTypeRegular *PollTypeRegular) LogValue() slog.Value {
+	return slog.StringValue(objectString(pollTypeRegular))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pollTypeRegular *PollTypeRegular) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pollTypeRegular)
+}
+
+func (pollTypeRegular *PollTypeRegular) printText(p *printer) {
+	if pollTypeRegular == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PollTypeRegular")
+	p.bool("allow_multiple_answers", pollTypeRegular.AllowMultipleAnswers)
+	p.endObject(state)
+}
+
 // GetPollTypeEnum return the enum type of this object
 func (pollTypeRegular *PollTypeRegular) GetPollTypeEnum() PollTypeEnum {
 	return PollTypeRegularType
 }
 
+// Accept calls the method of the visitor for PollTypeRegular
+func (pollTypeRegular *PollTypeRegular) Accept(visitor PollTypeVisitor) {
+	visitor.VisitPollTypeRegular(pollTypeRegular)
+}
+
 // PollTypeQuiz A poll in quiz mode, which has exactly one correct answer option and can be answered only once
 type PollTypeQuiz struct {
 	tdCommon
@@ -100,7 +260,228 @@ func NewPollTypeQuiz(correctOptionId int32, explanation *FormattedText) *PollTyp
 	return &pollTypeQuizTemp
 }
 
+// MarshalJSON marshals to json
+func (pollTypeQuiz *PollTypeQuiz) MarshalJSON() ([]byte, error) {
+	return pollTypeQuiz.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pollTypeQuiz *PollTypeQuiz) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pollTypeQuiz)
+}
+
+func (pollTypeQuiz *PollTypeQuiz) appendJSON(b []byte) ([]byte, error) {
+	if pollTypeQuiz == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pollTypeQuiz.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pollTypeQuiz.tdCommon.Extra)
+	b = append(b, `,"correct_option_id":`...)
+	b = appendJSONInt(b, int64(pollTypeQuiz.CorrectOptionId))
+	b = append(b, `,"explanation":`...)
+	b, err = pollTypeQuiz.Explanation.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (pollTypeQuiz *PollTypeQuiz) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pollTypeQuiz.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pollTypeQuiz.tdCommon.Extra, err = r.readString()
+		case "correct_option_id":
+			pollTypeQuiz.CorrectOptionId, err = r.readInt32()
+		case "explanation":
+			if r.readNull() {
+				pollTypeQuiz.Explanation = nil
+			} else {
+				pollTypeQuiz.Explanation = new(FormattedText)
+				err = pollTypeQuiz.Explanation.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pollTypeQuiz *PollTypeQuiz) Clone() *PollTypeQuiz {
+	if pollTypeQuiz == nil {
+		return nil
+	}
+	clone := *pollTypeQuiz
+	clone.Explanation = pollTypeQuiz.Explanation.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pollTypeQuiz *PollTypeQuiz) Equal(other *PollTypeQuiz) bool {
+	if pollTypeQuiz == nil || other == nil {
+		return pollTypeQuiz == other
+	}
+	if pollTypeQuiz.CorrectOptionId != other.CorrectOptionId {
+		return false
+	}
+	if !pollTypeQuiz.Explanation.Equal(other.Explanation) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pollTypeQuiz *PollTypeQuiz) Diff(other *PollTypeQuiz) []Change {
+	return pollTypeQuiz.diff("", other, nil)
+}
+
+func (pollTypeQuiz *PollTypeQuiz) diff(path string, other *PollTypeQuiz, changes []Change) []Change {
+	if pollTypeQuiz == nil || other == nil {
+		if pollTypeQuiz != other {
+			changes = append(changes, Change{Path: path, Old: pollTypeQuiz, New: other})
+		}
+		return changes
+	}
+	if pollTypeQuiz.CorrectOptionId != other.CorrectOptionId {
+		changes = append(changes, Change{Path: fieldPath(path, "correct_option_id"), Old: pollTypeQuiz.CorrectOptionId, New: other.CorrectOptionId})
+	}
+	changes = pollTypeQuiz.Explanation.diff(fieldPath(path, "explanation"), other.Explanation, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pollTypeQuiz *PollTypeQuiz) String() string {
+	return objectString(pollTypeQuiz)
+}
+
+// LogValue logs the object as its summary
+func (pollTypeQuiz *PollTypeQuiz) LogValue() slog.Value {
+	return slog.StringValue(objectString(pollTypeQuiz))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pollTypeQuiz *PollTypeQuiz) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pollTypeQuiz)
+}
+
+func (pollTypeQuiz *PollTypeQuiz) printText(p *printer) {
+	if pollTypeQuiz == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PollTypeQuiz")
+	p.int("correct_option_id", int64(pollTypeQuiz.CorrectOptionId))
+	p.object("explanation", pollTypeQuiz.Explanation)
+	p.endObject(state)
+}
+
 // GetPollTypeEnum return the enum type of this object
 func (pollTypeQuiz *PollTypeQuiz) GetPollTypeEnum() PollTypeEnum {
 	return PollTypeQuizType
 }
+
+// Accept calls the method of the visitor for PollTypeQuiz
+func (pollTypeQuiz *PollTypeQuiz) Accept(visitor PollTypeVisitor) {
+	visitor.VisitPollTypeQuiz(pollTypeQuiz)
+}
+
+// PollTypeVisitor visits every type of PollType, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to PollType, until they handle it too.
+type PollTypeVisitor interface {
+	VisitPollTypeRegular(pollTypeRegular *PollTypeRegular)
+	VisitPollTypeQuiz(pollTypeQuiz *PollTypeQuiz)
+	VisitUnknownPollType(unknownPollType *UnknownPollType)
+}
+
+// PollTypeCases holds the function MatchPollType calls for each type of PollType;
+// types without a function are passed to the default case
+type PollTypeCases[R any] struct {
+	PollTypeRegular func(pollTypeRegular *PollTypeRegular) R
+	PollTypeQuiz    func(pollTypeQuiz *PollTypeQuiz) R
+}
+
+// MatchPollType calls the case of the type of pollType, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement PollTypeVisitor
+// to have the compiler check every type is handled.
+func MatchPollType[R any](pollType PollType, cases PollTypeCases[R], defaultCase func(pollType PollType) R) R {
+	switch value := pollType.(type) {
+	case *PollTypeRegular:
+		if cases.PollTypeRegular != nil {
+			return cases.PollTypeRegular(value)
+		}
+	case *PollTypeQuiz:
+		if cases.PollTypeQuiz != nil {
+			return cases.PollTypeQuiz(value)
+		}
+	}
+	return defaultCase(pollType)
+}
+
+// Clone returns a deep copy of the object
+func (unknownPollType *UnknownPollType) Clone() *UnknownPollType {
+	if unknownPollType == nil {
+		return nil
+	}
+	return &UnknownPollType{Unknown: unknownPollType.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownPollType *UnknownPollType) Equal(other *UnknownPollType) bool {
+	if unknownPollType == nil || other == nil {
+		return unknownPollType == other
+	}
+	return unknownPollType.Unknown.equal(other.Unknown)
+}
+
+func clonePollType(pollType PollType) PollType {
+	switch value := pollType.(type) {
+	case *PollTypeRegular:
+		return value.Clone()
+	case *PollTypeQuiz:
+		return value.Clone()
+	case *UnknownPollType:
+		return value.Clone()
+	}
+	return pollType
+}
+
+func equalPollType(a PollType, b PollType) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *PollTypeRegular:
+		b, ok := b.(*PollTypeRegular)
+		return ok && a.Equal(b)
+	case *PollTypeQuiz:
+		b, ok := b.(*PollTypeQuiz)
+		return ok && a.Equal(b)
+	case *UnknownPollType:
+		b, ok := b.(*UnknownPollType)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffPollType(path string, a PollType, b PollType, changes []Change) []Change {
+	switch a := a.(type) {
+	case *PollTypeRegular:
+		if b, ok := b.(*PollTypeRegular); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PollTypeQuiz:
+		if b, ok := b.(*PollTypeQuiz); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalPollType(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
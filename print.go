@@ -0,0 +1,357 @@
+package tdlib
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// maxSummaryString is the number of runes of a string shown in a summary
+	maxSummaryString = 64
+	// maxSummaryItems is the number of items of a vector shown in a summary
+	maxSummaryItems = 3
+)
+
+// Summarize returns the one-line summary of an object, which omits empty fields and shortens long strings
+// and vectors, e.g. Message{id=123 chat_id=-100 sender_id=MessageSenderUser{user_id=42} ...}.
+// Secrets such as passwords are redacted unless level is below slog.LevelDebug, and file paths
+// unless level is below slog.LevelInfo. String and LogValue of the generated types use slog.LevelInfo,
+// as a slog.LogValuer doesn't know the level of the record; see LevelSummaryHandler.
+func Summarize(value TdMessage, level slog.Level) string {
+	return printObject(value, level, false)
+}
+
+// Pretty returns all fields of an object on multiple lines, redacted like Summarize.
+// Printing the generated types with %+v uses slog.LevelInfo.
+func Pretty(value TdMessage, level slog.Level) string {
+	return printObject(value, level, true)
+}
+
+func printObject(value interface{}, level slog.Level, pretty bool) string {
+	if value == nil {
+		return "nil"
+	}
+	object, ok := value.(textPrinter)
+	if !ok {
+		return fmt.Sprint(value)
+	}
+
+	p := printer{level: level, pretty: pretty, first: true}
+	object.printText(&p)
+	return string(p.b)
+}
+
+// objectString returns the summary of an object for its String method
+func objectString(object textPrinter) string {
+	return printObject(object, slog.LevelInfo, false)
+}
+
+// formatObject formats an object for its Format method: %v and %s print its summary, %+v all its fields
+func formatObject(f fmt.State, verb rune, object textPrinter) {
+	switch verb {
+	case 'v', 's':
+		io.WriteString(f, printObject(object, slog.LevelInfo, verb == 'v' && f.Flag('+')))
+	case 'q':
+		io.WriteString(f, strconv.Quote(objectString(object)))
+	default:
+		fmt.Fprintf(f, "%%!%c(%T)", verb, object)
+	}
+}
+
+// LevelSummaryHandler wraps a slog.Handler so the objects in the attributes of a record are summarized at the
+// level of the record, e.g. with file paths in debug records, instead of at slog.LevelInfo by their LogValue.
+// Attributes added with WithAttrs have no record level yet and are summarized at slog.LevelInfo.
+//
+//	logger := slog.New(tdlib.LevelSummaryHandler(slog.NewTextHandler(os.Stderr, nil)))
+func LevelSummaryHandler(handler slog.Handler) slog.Handler {
+	return levelSummaryHandler{handler: handler}
+}
+
+// levelSummaryHandler is the slog.Handler returned by LevelSummaryHandler
+type levelSummaryHandler struct {
+	handler slog.Handler
+}
+
+func (h levelSummaryHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.handler.Enabled(ctx, level)
+}
+
+func (h levelSummaryHandler) Handle(ctx context.Context, record slog.Record) error {
+	summarized := slog.NewRecord(record.Time, record.Level, record.Message, record.PC)
+	record.Attrs(func(attr slog.Attr) bool {
+		summarized.AddAttrs(summarizeAttr(attr, record.Level))
+		return true
+	})
+	return h.handler.Handle(ctx, summarized)
+}
+
+func (h levelSummaryHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return levelSummaryHandler{handler: h.handler.WithAttrs(attrs)}
+}
+
+func (h levelSummaryHandler) WithGroup(name string) slog.Handler {
+	return levelSummaryHandler{handler: h.handler.WithGroup(name)}
+}
+
+// summarizeAttr replaces the objects in an attribute, or in the attributes of a group, with their summary at level
+func summarizeAttr(attr slog.Attr, level slog.Level) slog.Attr {
+	switch attr.Value.Kind() {
+	case slog.KindLogValuer:
+		if object, ok := attr.Value.LogValuer().(textPrinter); ok {
+			return slog.String(attr.Key, printObject(object, level, false))
+		}
+	case slog.KindGroup:
+		group := attr.Value.Group()
+		attrs := make([]slog.Attr, len(group))
+		for i, groupAttr := range group {
+			attrs[i] = summarizeAttr(groupAttr, level)
+		}
+		return slog.Attr{Key: attr.Key, Value: slog.GroupValue(attrs...)}
+	}
+	return attr
+}
+
+// textPrinter is implemented by the generated types, which print their fields with a printer
+type textPrinter interface {
+	printText(p *printer)
+}
+
+// printer prints objects either as a one-line summary or on multiple lines
+type printer struct {
+	b        []byte
+	level    slog.Level
+	pretty   bool
+	indent   int
+	first    bool   // no field or item printed yet in the current object or vector
+	inVector bool   // values are items of a vector instead of fields
+	pending  string // name of the field of the object printed next
+}
+
+// printState is the state of the enclosing object or vector, restored at its end
+type printState struct {
+	first    bool
+	inVector bool
+}
+
+// omit reports whether an empty value is left out, which it is from the fields of a summary
+func (p *printer) omit() bool {
+	return !p.pretty && !p.inVector
+}
+
+// writeKey writes the separator in front of a field or an item, and the name of a field
+func (p *printer) writeKey(name string) {
+	switch {
+	case p.inVector:
+		if p.pretty {
+			p.newline()
+		} else if !p.first {
+			p.b = append(p.b, ' ')
+		}
+	case name == "":
+		// the printed object itself
+	case p.pretty:
+		p.newline()
+		p.b = append(p.b, name...)
+		p.b = append(p.b, ": "...)
+	default:
+		if !p.first {
+			p.b = append(p.b, ' ')
+		}
+		p.b = append(p.b, name...)
+		p.b = append(p.b, '=')
+	}
+	p.first = false
+}
+
+func (p *printer) newline() {
+	p.b = append(p.b, '\n')
+	for i := 0; i < p.indent; i++ {
+		p.b = append(p.b, "  "...)
+	}
+}
+
+// beginObject writes the start of an object of a type, as the pending field
+func (p *printer) beginObject(typeName string) printState {
+	name := p.pending
+	p.pending = ""
+	p.writeKey(name)
+
+	state := printState{first: p.first, inVector: p.inVector}
+	p.b = append(p.b, typeName...)
+	p.b = append(p.b, '{')
+	p.first = true
+	p.inVector = false
+	p.indent++
+	return state
+}
+
+// endObject writes the end of an object
+func (p *printer) endObject(state printState) {
+	p.indent--
+	if p.pretty && !p.first {
+		p.newline()
+	}
+	p.b = append(p.b, '}')
+	p.first = state.first
+	p.inVector = state.inVector
+}
+
+// null writes a nil object as the pending field
+func (p *printer) null() {
+	name := p.pending
+	p.pending = ""
+	if name != "" && p.omit() {
+		return
+	}
+	p.writeKey(name)
+	p.b = append(p.b, "nil"...)
+}
+
+// object writes the field of an object or an interface
+func (p *printer) object(name string, value interface{}) {
+	p.pending = name
+	if object, ok := value.(textPrinter); ok {
+		object.printText(p)
+		return
+	}
+	if value == nil {
+		p.null()
+		return
+	}
+
+	p.pending = ""
+	p.writeKey(name)
+	p.b = fmt.Append(p.b, value)
+}
+
+// vector writes a vector field, calling item to write each of its items
+func (p *printer) vector(name string, n int, item func(i int)) {
+	if n == 0 && p.omit() {
+		return
+	}
+	p.writeKey(name)
+
+	state := printState{first: p.first, inVector: p.inVector}
+	p.b = append(p.b, '[')
+	p.first = true
+	p.inVector = true
+	p.indent++
+
+	shown := n
+	if !p.pretty && shown > maxSummaryItems {
+		shown = maxSummaryItems
+	}
+	for i := 0; i < shown; i++ {
+		item(i)
+	}
+	if shown < n {
+		p.b = append(p.b, " ...+"...)
+		p.b = strconv.AppendInt(p.b, int64(n-shown), 10)
+	}
+
+	p.indent--
+	if p.pretty && n > 0 {
+		p.newline()
+	}
+	p.b = append(p.b, ']')
+	p.first = state.first
+	p.inVector = state.inVector
+}
+
+func (p *printer) int(name string, v int64) {
+	if v == 0 && p.omit() {
+		return
+	}
+	p.writeKey(name)
+	p.b = strconv.AppendInt(p.b, v, 10)
+}
+
+func (p *printer) float(name string, v float64) {
+	if v == 0 && p.omit() {
+		return
+	}
+	p.writeKey(name)
+	p.b = strconv.AppendFloat(p.b, v, 'g', -1, 64)
+}
+
+func (p *printer) bool(name string, v bool) {
+	if !v && p.omit() {
+		return
+	}
+	p.writeKey(name)
+	p.b = strconv.AppendBool(p.b, v)
+}
+
+func (p *printer) string(name string, v string) {
+	if v == "" && p.omit() {
+		return
+	}
+	p.writeKey(name)
+
+	if p.redacted(name) {
+		p.b = append(p.b, "[REDACTED]"...)
+		return
+	}
+	if !p.pretty && utf8.RuneCountInString(v) > maxSummaryString {
+		runes := []rune(v)
+		v = string(runes[:maxSummaryString]) + "..."
+	}
+	p.b = strconv.AppendQuote(p.b, v)
+}
+
+func (p *printer) bytes(name string, v []byte) {
+	if len(v) == 0 && p.omit() {
+		return
+	}
+	p.writeKey(name)
+
+	if p.redacted(name) {
+		p.b = append(p.b, "[REDACTED]"...)
+		return
+	}
+	p.b = append(p.b, '<')
+	p.b = strconv.AppendInt(p.b, int64(len(v)), 10)
+	p.b = append(p.b, " bytes>"...)
+}
+
+// redacted reports whether the value of a field is hidden at the level of the printer:
+// secrets below slog.LevelDebug only, file paths below slog.LevelInfo only
+func (p *printer) redacted(name string) bool {
+	if redactedFields[name] {
+		return p.level >= slog.LevelDebug
+	}
+	if isFilePathField(name) {
+		return p.level >= slog.LevelInfo
+	}
+	return false
+}
+
+// isFilePathField reports whether a field holds a local file or directory path
+func isFilePathField(name string) bool {
+	return name == "path" || strings.HasSuffix(name, "_path") || strings.HasSuffix(name, "_directory")
+}
+
+func (unknown *Unknown) printText(p *printer) {
+	if unknown == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Unknown")
+	p.string("@type", unknown.Type)
+	p.endObject(state)
+}
+
+// String returns a one-line summary of the object
+func (unknown *Unknown) String() string {
+	return objectString(unknown)
+}
+
+// LogValue logs the object as its summary
+func (unknown *Unknown) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknown))
+}
@@ -0,0 +1,82 @@
+package tdlib
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+// secretObjects returns objects with the secrets api_hash, password and token, and the file path
+// of the database directory
+func secretObjects() []TdMessage {
+	return []TdMessage{
+		NewTdlibParameters(false, "/var/lib/bot", "", false, false, false, false, 1, "hash-S3CR3T", "en", "server", "1", "1", false, false),
+		NewProxyTypeSocks5("user", "password-S3CR3T"),
+		NewDeviceTokenFirebaseCloudMessaging("token-S3CR3T", false),
+	}
+}
+
+// printed returns an object printed by String, fmt verbs and a slog handler at the given level, by name
+func printed(object TdMessage, level slog.Level) map[string]string {
+	outputs := map[string]string{
+		"String": object.(fmt.Stringer).String(),
+		"%v":     fmt.Sprintf("%v", object),
+		"%+v":    fmt.Sprintf("%+v", object),
+		"%s":     fmt.Sprintf("%s", object),
+		"%q":     fmt.Sprintf("%q", object),
+	}
+
+	var text, json, summarized bytes.Buffer
+	options := &slog.HandlerOptions{Level: slog.LevelDebug - 4}
+	slog.New(slog.NewTextHandler(&text, options)).Log(context.Background(), level, "object", "object", object)
+	slog.New(slog.NewJSONHandler(&json, options)).Log(context.Background(), level, "object", "object", object)
+	slog.New(LevelSummaryHandler(slog.NewTextHandler(&summarized, options))).Log(context.Background(), level, "object", slog.Group("group", "object", object))
+	outputs["slog text"], outputs["slog JSON"], outputs["LevelSummaryHandler"] = text.String(), json.String(), summarized.String()
+	return outputs
+}
+
+func TestPrintRedactsSecrets(t *testing.T) {
+	for _, object := range secretObjects() {
+		for _, level := range []slog.Level{slog.LevelDebug, slog.LevelInfo, slog.LevelError} {
+			for name, output := range printed(object, level) {
+				if strings.Contains(output, "S3CR3T") {
+					t.Errorf("%s at %v has a secret: %s", name, level, output)
+				}
+				if !strings.Contains(output, "[REDACTED]") {
+					t.Errorf("%s at %v has no redacted value: %s", name, level, output)
+				}
+			}
+		}
+	}
+}
+
+func TestLevelSummaryHandler(t *testing.T) {
+	parameters := secretObjects()[0]
+	tests := []struct {
+		level   slog.Level
+		path    bool
+		secrets bool
+	}{
+		{slog.LevelInfo, false, false},
+		{slog.LevelDebug, true, false},
+		{slog.LevelDebug - 1, true, true},
+	}
+	for _, test := range tests {
+		outputs := printed(parameters, test.level)
+
+		// only the handler passes the level of the record on
+		summary := outputs["LevelSummaryHandler"]
+		if strings.Contains(summary, "/var/lib/bot") != test.path || strings.Contains(summary, "hash-S3CR3T") != test.secrets {
+			t.Errorf("summary at %v is %s, want the path %v and the secrets %v", test.level, summary, test.path, test.secrets)
+		}
+		if !strings.Contains(summary, "group.object=") {
+			t.Errorf("summary at %v is %s, want it in the group", test.level, summary)
+		}
+		if text := outputs["slog text"]; strings.Contains(text, "/var/lib/bot") {
+			t.Errorf("text at %v is %s, want the path redacted like at info level", test.level, text)
+		}
+	}
+}
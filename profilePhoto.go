@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // ProfilePhoto Describes a user profile photo
 type ProfilePhoto struct {
 	tdCommon
@@ -34,3 +39,171 @@ func NewProfilePhoto(id JSONInt64, small *File, big *File, minithumbnail *Minith
 
 	return &profilePhotoTemp
 }
+
+// MarshalJSON marshals to json
+func (profilePhoto *ProfilePhoto) MarshalJSON() ([]byte, error) {
+	return profilePhoto.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (profilePhoto *ProfilePhoto) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, profilePhoto)
+}
+
+func (profilePhoto *ProfilePhoto) appendJSON(b []byte) ([]byte, error) {
+	if profilePhoto == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, profilePhoto.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, profilePhoto.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt64String(b, int64(profilePhoto.Id))
+	b = append(b, `,"small":`...)
+	b, err = profilePhoto.Small.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"big":`...)
+	b, err = profilePhoto.Big.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"minithumbnail":`...)
+	b, err = profilePhoto.Minithumbnail.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"has_animation":`...)
+	b = appendJSONBool(b, profilePhoto.HasAnimation)
+	return append(b, '}'), nil
+}
+
+func (profilePhoto *ProfilePhoto) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			profilePhoto.tdCommon.Type, err = r.readString()
+		case "@extra":
+			profilePhoto.tdCommon.Extra, err = r.readString()
+		case "id":
+			profilePhoto.Id, err = r.readJSONInt64()
+		case "small":
+			if r.readNull() {
+				profilePhoto.Small = nil
+			} else {
+				profilePhoto.Small = new(File)
+				err = profilePhoto.Small.decodeJSON(r)
+			}
+		case "big":
+			if r.readNull() {
+				profilePhoto.Big = nil
+			} else {
+				profilePhoto.Big = new(File)
+				err = profilePhoto.Big.decodeJSON(r)
+			}
+		case "minithumbnail":
+			if r.readNull() {
+				profilePhoto.Minithumbnail = nil
+			} else {
+				profilePhoto.Minithumbnail = new(Minithumbnail)
+				err = profilePhoto.Minithumbnail.decodeJSON(r)
+			}
+		case "has_animation":
+			profilePhoto.HasAnimation, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (profilePhoto *ProfilePhoto) Clone() *ProfilePhoto {
+	if profilePhoto == nil {
+		return nil
+	}
+	clone := *profilePhoto
+	clone.Small = profilePhoto.Small.Clone()
+	clone.Big = profilePhoto.Big.Clone()
+	clone.Minithumbnail = profilePhoto.Minithumbnail.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (profilePhoto *ProfilePhoto) Equal(other *ProfilePhoto) bool {
+	if profilePhoto == nil || other == nil {
+		return profilePhoto == other
+	}
+	if profilePhoto.Id != other.Id {
+		return false
+	}
+	if !profilePhoto.Small.Equal(other.Small) {
+		return false
+	}
+	if !profilePhoto.Big.Equal(other.Big) {
+		return false
+	}
+	if !profilePhoto.Minithumbnail.Equal(other.Minithumbnail) {
+		return false
+	}
+	if profilePhoto.HasAnimation != other.HasAnimation {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (profilePhoto *ProfilePhoto) Diff(other *ProfilePhoto) []Change {
+	return profilePhoto.diff("", other, nil)
+}
+
+func (profilePhoto *ProfilePhoto) diff(path string, other *ProfilePhoto, changes []Change) []Change {
+	if profilePhoto == nil || other == nil {
+		if profilePhoto != other {
+			changes = append(changes, Change{Path: path, Old: profilePhoto, New: other})
+		}
+		return changes
+	}
+	if profilePhoto.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: profilePhoto.Id, New: other.Id})
+	}
+	changes = profilePhoto.Small.diff(fieldPath(path, "small"), other.Small, changes)
+	changes = profilePhoto.Big.diff(fieldPath(path, "big"), other.Big, changes)
+	changes = profilePhoto.Minithumbnail.diff(fieldPath(path, "minithumbnail"), other.Minithumbnail, changes)
+	if profilePhoto.HasAnimation != other.HasAnimation {
+		changes = append(changes, Change{Path: fieldPath(path, "has_animation"), Old: profilePhoto.HasAnimation, New: other.HasAnimation})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (profilePhoto *ProfilePhoto) String() string {
+	return objectString(profilePhoto)
+}
+
+// LogValue logs the object as its summary
+func (profilePhoto *ProfilePhoto) LogValue() slog.Value {
+	return slog.StringValue(objectString(profilePhoto))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (profilePhoto *ProfilePhoto) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, profilePhoto)
+}
+
+func (profilePhoto *ProfilePhoto) printText(p *printer) {
+	if profilePhoto == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ProfilePhoto")
+	p.int("id", int64(profilePhoto.Id))
+	p.object("small", profilePhoto.Small)
+	p.object("big", profilePhoto.Big)
+	p.object("minithumbnail", profilePhoto.Minithumbnail)
+	p.bool("has_animation", profilePhoto.HasAnimation)
+	p.endObject(state)
+}
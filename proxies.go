@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // Proxies Represents a list of proxy servers
@@ -28,6 +28,150 @@ func NewProxies(proxies []Proxy) *Proxies {
 	return &proxiesTemp
 }
 
+// MarshalJSON marshals to json
+func (proxies *Proxies) MarshalJSON() ([]byte, error) {
+	return proxies.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (proxies *Proxies) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, proxies)
+}
+
+func (proxies *Proxies) appendJSON(b []byte) ([]byte, error) {
+	if proxies == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, proxies.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, proxies.tdCommon.Extra)
+	b = append(b, `,"proxies":`...)
+	if proxies.Proxies == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range proxies.Proxies {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = proxies.Proxies[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (proxies *Proxies) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			proxies.tdCommon.Type, err = r.readString()
+		case "@extra":
+			proxies.tdCommon.Extra, err = r.readString()
+		case "proxies":
+			if r.readNull() {
+				proxies.Proxies = nil
+			} else {
+				proxies.Proxies = make([]Proxy, 0)
+				err = r.readArray(func() (err error) {
+					var item0 Proxy
+					err = item0.decodeJSON(r)
+					proxies.Proxies = append(proxies.Proxies, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (proxies *Proxies) Clone() *Proxies {
+	if proxies == nil {
+		return nil
+	}
+	clone := *proxies
+	if proxies.Proxies != nil {
+		clone.Proxies = make([]Proxy, len(proxies.Proxies))
+		for i0 := range proxies.Proxies {
+			clone.Proxies[i0] = *proxies.Proxies[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (proxies *Proxies) Equal(other *Proxies) bool {
+	if proxies == nil || other == nil {
+		return proxies == other
+	}
+	if len(proxies.Proxies) != len(other.Proxies) {
+		return false
+	}
+	for i0 := range proxies.Proxies {
+		if !proxies.Proxies[i0].Equal(&other.Proxies[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (proxies *Proxies) Diff(other *Proxies) []Change {
+	return proxies.diff("", other, nil)
+}
+
+func (proxies *Proxies) diff(path string, other *Proxies, changes []Change) []Change {
+	if proxies == nil || other == nil {
+		if proxies != other {
+			changes = append(changes, Change{Path: path, Old: proxies, New: other})
+		}
+		return changes
+	}
+	if len(proxies.Proxies) != len(other.Proxies) {
+		changes = append(changes, Change{Path: fieldPath(path, "proxies"), Old: proxies.Proxies, New: other.Proxies})
+	} else {
+		for i0 := range proxies.Proxies {
+			changes = proxies.Proxies[i0].diff(indexPath(fieldPath(path, "proxies"), i0), &other.Proxies[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (proxies *Proxies) String() string {
+	return objectString(proxies)
+}
+
+// LogValue logs the object as its summary
+func (proxies *Proxies) LogValue() slog.Value {
+	return slog.StringValue(objectString(proxies))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (proxies *Proxies) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, proxies)
+}
+
+func (proxies *Proxies) printText(p *printer) {
+	if proxies == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Proxies")
+	p.vector("proxies", len(proxies.Proxies), func(i0 int) {
+		proxies.Proxies[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetProxies Returns list of proxies that are currently set up. Can be called before authorization
 func (client *Client) GetProxies() (*Proxies, error) {
 	result, err := client.SendAndCatch(UpdateData{
@@ -38,11 +182,11 @@ func (client *Client) GetProxies() (*Proxies, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var proxies Proxies
-	err = json.Unmarshal(result.Raw, &proxies)
+	err = jsonUnmarshal(result.Raw, &proxies)
 	return &proxies, err
 }
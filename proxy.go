@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // Proxy Contains information about a proxy server
@@ -43,38 +43,164 @@ func NewProxy(id int32, server string, port int32, lastUsedDate int32, isEnabled
 	return &proxyTemp
 }
 
+// MarshalJSON marshals to json
+func (proxy *Proxy) MarshalJSON() ([]byte, error) {
+	return proxy.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (proxy *Proxy) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Id           int32  `json:"id"`             // Unique identifier of the proxy
-		Server       string `json:"server"`         // Proxy server IP address
-		Port         int32  `json:"port"`           // Proxy server port
-		LastUsedDate int32  `json:"last_used_date"` // Point in time (Unix timestamp) when the proxy was last used; 0 if never
-		IsEnabled    bool   `json:"is_enabled"`     // True, if the proxy is enabled now
-
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	return unmarshalFast(b, proxy)
+}
+
+func (proxy *Proxy) appendJSON(b []byte) ([]byte, error) {
+	if proxy == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, proxy.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, proxy.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt(b, int64(proxy.Id))
+	b = append(b, `,"server":`...)
+	b = appendJSONString(b, proxy.Server)
+	b = append(b, `,"port":`...)
+	b = appendJSONInt(b, int64(proxy.Port))
+	b = append(b, `,"last_used_date":`...)
+	b = appendJSONInt(b, int64(proxy.LastUsedDate))
+	b = append(b, `,"is_enabled":`...)
+	b = appendJSONBool(b, proxy.IsEnabled)
+	b = append(b, `,"type":`...)
+	b, err = appendJSONValue(b, proxy.Type)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (proxy *Proxy) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			proxy.tdCommon.Type, err = r.readString()
+		case "@extra":
+			proxy.tdCommon.Extra, err = r.readString()
+		case "id":
+			proxy.Id, err = r.readInt32()
+		case "server":
+			proxy.Server, err = r.readString()
+		case "port":
+			proxy.Port, err = r.readInt32()
+		case "last_used_date":
+			proxy.LastUsedDate, err = r.readInt32()
+		case "is_enabled":
+			proxy.IsEnabled, err = r.readBool()
+		case "type":
+			proxy.Type, err = decodeProxyType(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (proxy *Proxy) Clone() *Proxy {
+	if proxy == nil {
+		return nil
+	}
+	clone := *proxy
+	clone.Type = cloneProxyType(proxy.Type)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (proxy *Proxy) Equal(other *Proxy) bool {
+	if proxy == nil || other == nil {
+		return proxy == other
+	}
+	if proxy.Id != other.Id {
+		return false
+	}
+	if proxy.Server != other.Server {
+		return false
+	}
+	if proxy.Port != other.Port {
+		return false
 	}
+	if proxy.LastUsedDate != other.LastUsedDate {
+		return false
+	}
+	if proxy.IsEnabled != other.IsEnabled {
+		return false
+	}
+	if !equalProxyType(proxy.Type, other.Type) {
+		return false
+	}
+	return true
+}
 
-	proxy.tdCommon = tempObj.tdCommon
-	proxy.Id = tempObj.Id
-	proxy.Server = tempObj.Server
-	proxy.Port = tempObj.Port
-	proxy.LastUsedDate = tempObj.LastUsedDate
-	proxy.IsEnabled = tempObj.IsEnabled
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (proxy *Proxy) Diff(other *Proxy) []Change {
+	return proxy.diff("", other, nil)
+}
 
-	fieldType, _ := unmarshalProxyType(objMap["type"])
-	proxy.Type = fieldType
+func (proxy *Proxy) diff(path string, other *Proxy, changes []Change) []Change {
+	if proxy == nil || other == nil {
+		if proxy != other {
+			changes = append(changes, Change{Path: path, Old: proxy, New: other})
+		}
+		return changes
+	}
+	if proxy.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: proxy.Id, New: other.Id})
+	}
+	if proxy.Server != other.Server {
+		changes = append(changes, Change{Path: fieldPath(path, "server"), Old: proxy.Server, New: other.Server})
+	}
+	if proxy.Port != other.Port {
+		changes = append(changes, Change{Path: fieldPath(path, "port"), Old: proxy.Port, New: other.Port})
+	}
+	if proxy.LastUsedDate != other.LastUsedDate {
+		changes = append(changes, Change{Path: fieldPath(path, "last_used_date"), Old: proxy.LastUsedDate, New: other.LastUsedDate})
+	}
+	if proxy.IsEnabled != other.IsEnabled {
+		changes = append(changes, Change{Path: fieldPath(path, "is_enabled"), Old: proxy.IsEnabled, New: other.IsEnabled})
+	}
+	changes = diffProxyType(fieldPath(path, "type"), proxy.Type, other.Type, changes)
+	return changes
+}
 
-	return nil
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (proxy *Proxy) String() string {
+	return objectString(proxy)
+}
+
+// LogValue logs the object as its summary
+func (proxy *Proxy) LogValue() slog.Value {
+	return slog.StringValue(objectString(proxy))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (proxy *Proxy) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, proxy)
+}
+
+func (proxy *Proxy) printText(p *printer) {
+	if proxy == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Proxy")
+	p.int("id", int64(proxy.Id))
+	p.string("server", proxy.Server)
+	p.int("port", int64(proxy.Port))
+	p.int("last_used_date", int64(proxy.LastUsedDate))
+	p.bool("is_enabled", proxy.IsEnabled)
+	p.object("type", proxy.Type)
+	p.endObject(state)
 }
 
 // AddProxy Adds a proxy server for network requests. Can be called before authorization
@@ -95,12 +221,12 @@ func (client *Client) AddProxy(server string, port int32, enable bool, typeParam
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var proxy Proxy
-	err = json.Unmarshal(result.Raw, &proxy)
+	err = jsonUnmarshal(result.Raw, &proxy)
 	return &proxy, err
 }
 
@@ -124,11 +250,11 @@ func (client *Client) EditProxy(proxyId int32, server string, port int32, enable
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var proxyDummy Proxy
-	err = json.Unmarshal(result.Raw, &proxyDummy)
+	err = jsonUnmarshal(result.Raw, &proxyDummy)
 	return &proxyDummy, err
 }
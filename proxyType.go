@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ProxyType Describes the type of a proxy server
 type ProxyType interface {
 	GetProxyTypeEnum() ProxyTypeEnum
+	Accept(visitor ProxyTypeVisitor)
 }
 
 // ProxyTypeEnum Alias for abstract ProxyType 'Sub-Classes', used as constant-enum here
@@ -20,36 +22,87 @@ const (
 	ProxyTypeMtprotoType ProxyTypeEnum = "proxyTypeMtproto"
 )
 
+// UnknownProxyType is a ProxyType of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownProxyType struct {
+	Unknown
+}
+
+// GetProxyTypeEnum return the enum type of this object
+func (unknownProxyType *UnknownProxyType) GetProxyTypeEnum() ProxyTypeEnum {
+	return ProxyTypeEnum(unknownProxyType.Type)
+}
+
+// Accept calls the method of the visitor for UnknownProxyType
+func (unknownProxyType *UnknownProxyType) Accept(visitor ProxyTypeVisitor) {
+	visitor.VisitUnknownProxyType(unknownProxyType)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownProxyType *UnknownProxyType) String() string {
+	return objectString(unknownProxyType)
+}
+
+// LogValue logs the object as its summary
+func (unknownProxyType *UnknownProxyType) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownProxyType))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownProxyType *UnknownProxyType) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownProxyType)
+}
+
+func (unknownProxyType *UnknownProxyType) printText(p *printer) {
+	if unknownProxyType == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownProxyType")
+	p.string("@type", unknownProxyType.Type)
+	p.endObject(state)
+}
+
 func unmarshalProxyType(rawMsg *json.RawMessage) (ProxyType, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch ProxyTypeEnum(objMap["@type"].(string)) {
+	switch ProxyTypeEnum(typeName) {
 	case ProxyTypeSocks5Type:
 		var proxyTypeSocks5 ProxyTypeSocks5
-		err := json.Unmarshal(*rawMsg, &proxyTypeSocks5)
+		err := jsonUnmarshal(*rawMsg, &proxyTypeSocks5)
 		return &proxyTypeSocks5, err
 
 	case ProxyTypeHttpType:
 		var proxyTypeHttp ProxyTypeHttp
-		err := json.Unmarshal(*rawMsg, &proxyTypeHttp)
+		err := jsonUnmarshal(*rawMsg, &proxyTypeHttp)
 		return &proxyTypeHttp, err
 
 	case ProxyTypeMtprotoType:
 		var proxyTypeMtproto ProxyTypeMtproto
-		err := json.Unmarshal(*rawMsg, &proxyTypeMtproto)
+		err := jsonUnmarshal(*rawMsg, &proxyTypeMtproto)
 		return &proxyTypeMtproto, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownProxyType{Unknown: unknown}, nil
+	}
+}
+
+func decodeProxyType(r *jsonReader) (ProxyType, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
 	}
+	return unmarshalProxyType(rawMsg)
 }
 
 // ProxyTypeSocks5 A SOCKS5 proxy server
@@ -78,11 +131,129 @@ func NewProxyTypeSocks5(username string, password string) *ProxyTypeSocks5 {
 	return &proxyTypeSocks5Temp
 }
 
+// MarshalJSON marshals to json
+func (proxyTypeSocks5 *ProxyTypeSocks5) MarshalJSON() ([]byte, error) {
+	return proxyTypeSocks5.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (proxyTypeSocks5 *ProxyTypeSocks5) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, proxyTypeSocks5)
+}
+
+func (proxyTypeSocks5 *ProxyTypeSocks5) appendJSON(b []byte) ([]byte, error) {
+	if proxyTypeSocks5 == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, proxyTypeSocks5.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, proxyTypeSocks5.tdCommon.Extra)
+	b = append(b, `,"username":`...)
+	b = appendJSONString(b, proxyTypeSocks5.Username)
+	b = append(b, `,"password":`...)
+	b = appendJSONString(b, proxyTypeSocks5.Password)
+	return append(b, '}'), nil
+}
+
+func (proxyTypeSocks5 *ProxyTypeSocks5) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			proxyTypeSocks5.tdCommon.Type, err = r.readString()
+		case "@extra":
+			proxyTypeSocks5.tdCommon.Extra, err = r.readString()
+		case "username":
+			proxyTypeSocks5.Username, err = r.readString()
+		case "password":
+			proxyTypeSocks5.Password, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (proxyTypeSocks5 *ProxyTypeSocks5) Clone() *ProxyTypeSocks5 {
+	if proxyTypeSocks5 == nil {
+		return nil
+	}
+	clone := *proxyTypeSocks5
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (proxyTypeSocks5 *ProxyTypeSocks5) Equal(other *ProxyTypeSocks5) bool {
+	if proxyTypeSocks5 == nil || other == nil {
+		return proxyTypeSocks5 == other
+	}
+	if proxyTypeSocks5.Username != other.Username {
+		return false
+	}
+	if proxyTypeSocks5.Password != other.Password {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (proxyTypeSocks5 *ProxyTypeSocks5) Diff(other *ProxyTypeSocks5) []Change {
+	return proxyTypeSocks5.diff("", other, nil)
+}
+
+func (proxyTypeSocks5 *ProxyTypeSocks5) diff(path string, other *ProxyTypeSocks5, changes []Change) []Change {
+	if proxyTypeSocks5 == nil || other == nil {
+		if proxyTypeSocks5 != other {
+			changes = append(changes, Change{Path: path, Old: proxyTypeSocks5, New: other})
+		}
+		return changes
+	}
+	if proxyTypeSocks5.Username != other.Username {
+		changes = append(changes, Change{Path: fieldPath(path, "username"), Old: proxyTypeSocks5.Username, New: other.Username})
+	}
+	if proxyTypeSocks5.Password != other.Password {
+		changes = append(changes, Change{Path: fieldPath(path, "password"), Old: proxyTypeSocks5.Password, New: other.Password})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (proxyTypeSocks5 *ProxyTypeSocks5) String() string {
+	return objectString(proxyTypeSocks5)
+}
+
+// LogValue logs the object as its summary
+func (proxyTypeSocks5 *ProxyTypeSocks5) LogValue() slog.Value {
+	return slog.StringValue(objectString(proxyTypeSocks5))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (proxyTypeSocks5 *ProxyTypeSocks5) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, proxyTypeSocks5)
+}
+
+func (proxyTypeSocks5 *ProxyTypeSocks5) printText(p *printer) {
+	if proxyTypeSocks5 == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ProxyTypeSocks5")
+	p.string("username", proxyTypeSocks5.Username)
+	p.string("password", proxyTypeSocks5.Password)
+	p.endObject(state)
+}
+
 // GetProxyTypeEnum return the enum type of this object
 func (proxyTypeSocks5 *ProxyTypeSocks5) GetProxyTypeEnum() ProxyTypeEnum {
 	return ProxyTypeSocks5Type
 }
 
+// Accept calls the method of the visitor for ProxyTypeSocks5
+func (proxyTypeSocks5 *ProxyTypeSocks5) Accept(visitor ProxyTypeVisitor) {
+	visitor.VisitProxyTypeSocks5(proxyTypeSocks5)
+}
+
 // ProxyTypeHttp A HTTP transparent proxy server
 type ProxyTypeHttp struct {
 	tdCommon
@@ -112,11 +283,140 @@ func NewProxyTypeHttp(username string, password string, httpOnly bool) *ProxyTyp
 	return &proxyTypeHttpTemp
 }
 
+// MarshalJSON marshals to json
+func (proxyTypeHttp *ProxyTypeHttp) MarshalJSON() ([]byte, error) {
+	return proxyTypeHttp.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (proxyTypeHttp *ProxyTypeHttp) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, proxyTypeHttp)
+}
+
+func (proxyTypeHttp *ProxyTypeHttp) appendJSON(b []byte) ([]byte, error) {
+	if proxyTypeHttp == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, proxyTypeHttp.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, proxyTypeHttp.tdCommon.Extra)
+	b = append(b, `,"username":`...)
+	b = appendJSONString(b, proxyTypeHttp.Username)
+	b = append(b, `,"password":`...)
+	b = appendJSONString(b, proxyTypeHttp.Password)
+	b = append(b, `,"http_only":`...)
+	b = appendJSONBool(b, proxyTypeHttp.HttpOnly)
+	return append(b, '}'), nil
+}
+
+func (proxyTypeHttp *ProxyTypeHttp) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			proxyTypeHttp.tdCommon.Type, err = r.readString()
+		case "@extra":
+			proxyTypeHttp.tdCommon.Extra, err = r.readString()
+		case "username":
+			proxyTypeHttp.Username, err = r.readString()
+		case "password":
+			proxyTypeHttp.Password, err = r.readString()
+		case "http_only":
+			proxyTypeHttp.HttpOnly, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (proxyTypeHttp *ProxyTypeHttp) Clone() *ProxyTypeHttp {
+	if proxyTypeHttp == nil {
+		return nil
+	}
+	clone := *proxyTypeHttp
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (proxyTypeHttp *ProxyTypeHttp) Equal(other *ProxyTypeHttp) bool {
+	if proxyTypeHttp == nil || other == nil {
+		return proxyTypeHttp == other
+	}
+	if proxyTypeHttp.Username != other.Username {
+		return false
+	}
+	if proxyTypeHttp.Password != other.Password {
+		return false
+	}
+	if proxyTypeHttp.HttpOnly != other.HttpOnly {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (proxyTypeHttp *ProxyTypeHttp) Diff(other *ProxyTypeHttp) []Change {
+	return proxyTypeHttp.diff("", other, nil)
+}
+
+func (proxyTypeHttp *ProxyTypeHttp) diff(path string, other *ProxyTypeHttp, changes []Change) []Change {
+	if proxyTypeHttp == nil || other == nil {
+		if proxyTypeHttp != other {
+			changes = append(changes, Change{Path: path, Old: proxyTypeHttp, New: other})
+		}
+		return changes
+	}
+	if proxyTypeHttp.Username != other.Username {
+		changes = append(changes, Change{Path: fieldPath(path, "username"), Old: proxyTypeHttp.Username, New: other.Username})
+	}
+	if proxyTypeHttp.Password != other.Password {
+		changes = append(changes, Change{Path: fieldPath(path, "password"), Old: proxyTypeHttp.Password, New: other.Password})
+	}
+	if proxyTypeHttp.HttpOnly != other.HttpOnly {
+		changes = append(changes, Change{Path: fieldPath(path, "http_only"), Old: proxyTypeHttp.HttpOnly, New: other.HttpOnly})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (proxyTypeHttp *ProxyTypeHttp) String() string {
+	return objectString(proxyTypeHttp)
+}
+
+// LogValue logs the object as its summary
+func (proxyTypeHttp *ProxyTypeHttp) LogValue() slog.Value {
+	return slog.StringValue(objectString(proxyTypeHttp))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (proxyTypeHttp *ProxyTypeHttp) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, proxyTypeHttp)
+}
+
+func (proxyTypeHttp *ProxyTypeHttp) printText(p *printer) {
+	if proxyTypeHttp == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ProxyTypeHttp")
+	p.string("username", proxyTypeHttp.Username)
+	p.string("password", proxyTypeHttp.Password)
+	p.bool("http_only", proxyTypeHttp.HttpOnly)
+	p.endObject(state)
+}
+
 // GetProxyTypeEnum return the enum type of this object
 func (proxyTypeHttp *ProxyTypeHttp) GetProxyTypeEnum() ProxyTypeEnum {
 	return ProxyTypeHttpType
 }
 
+// Accept calls the method of the visitor for ProxyTypeHttp
+func (proxyTypeHttp *ProxyTypeHttp) Accept(visitor ProxyTypeVisitor) {
+	visitor.VisitProxyTypeHttp(proxyTypeHttp)
+}
+
 // ProxyTypeMtproto An MTProto proxy server
 type ProxyTypeMtproto struct {
 	tdCommon
@@ -140,7 +440,224 @@ func NewProxyTypeMtproto(secret string) *ProxyTypeMtproto {
 	return &proxyTypeMtprotoTemp
 }
 
+// MarshalJSON marshals to json
+func (proxyTypeMtproto *ProxyTypeMtproto) MarshalJSON() ([]byte, error) {
+	return proxyTypeMtproto.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (proxyTypeMtproto *ProxyTypeMtproto) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, proxyTypeMtproto)
+}
+
+func (proxyTypeMtproto *ProxyTypeMtproto) appendJSON(b []byte) ([]byte, error) {
+	if proxyTypeMtproto == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, proxyTypeMtproto.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, proxyTypeMtproto.tdCommon.Extra)
+	b = append(b, `,"secret":`...)
+	b = appendJSONString(b, proxyTypeMtproto.Secret)
+	return append(b, '}'), nil
+}
+
+func (proxyTypeMtproto *ProxyTypeMtproto) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			proxyTypeMtproto.tdCommon.Type, err = r.readString()
+		case "@extra":
+			proxyTypeMtproto.tdCommon.Extra, err = r.readString()
+		case "secret":
+			proxyTypeMtproto.Secret, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (proxyTypeMtproto *ProxyTypeMtproto) Clone() *ProxyTypeMtproto {
+	if proxyTypeMtproto == nil {
+		return nil
+	}
+	clone := *proxyTypeMtproto
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (proxyTypeMtproto *ProxyTypeMtproto) Equal(other *ProxyTypeMtproto) bool {
+	if proxyTypeMtproto == nil || other == nil {
+		return proxyTypeMtproto == other
+	}
+	if proxyTypeMtproto.Secret != other.Secret {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (proxyTypeMtproto *ProxyTypeMtproto) Diff(other *ProxyTypeMtproto) []Change {
+	return proxyTypeMtproto.diff("", other, nil)
+}
+
+func (proxyTypeMtproto *ProxyTypeMtproto) diff(path string, other *ProxyTypeMtproto, changes []Change) []Change {
+	if proxyTypeMtproto == nil || other == nil {
+		if proxyTypeMtproto != other {
+			changes = append(changes, Change{Path: path, Old: proxyTypeMtproto, New: other})
+		}
+		return changes
+	}
+	if proxyTypeMtproto.Secret != other.Secret {
+		changes = append(changes, Change{Path: fieldPath(path, "secret"), Old: proxyTypeMtproto.Secret, New: other.Secret})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (proxyTypeMtproto *ProxyTypeMtproto) String() string {
+	return objectString(proxyTypeMtproto)
+}
+
+// LogValue logs the object as its summary
+func (proxyTypeMtproto *ProxyTypeMtproto) LogValue() slog.Value {
+	return slog.StringValue(objectString(proxyTypeMtproto))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (proxyTypeMtproto *ProxyTypeMtproto) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, proxyTypeMtproto)
+}
+
+func (proxyTypeMtproto *ProxyTypeMtproto) printText(p *printer) {
+	if proxyTypeMtproto == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ProxyTypeMtproto")
+	p.string("secret", proxyTypeMtproto.Secret)
+	p.endObject(state)
+}
+
 // GetProxyTypeEnum return the enum type of this object
 func (proxyTypeMtproto *ProxyTypeMtproto) GetProxyTypeEnum() ProxyTypeEnum {
 	return ProxyTypeMtprotoType
 }
+
+// Accept calls the method of the visitor for ProxyTypeMtproto
+func (proxyTypeMtproto *ProxyTypeMtproto) Accept(visitor ProxyTypeVisitor) {
+	visitor.VisitProxyTypeMtproto(proxyTypeMtproto)
+}
+
+// ProxyTypeVisitor visits every type of ProxyType, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to ProxyType, until they handle it too.
+type ProxyTypeVisitor interface {
+	VisitProxyTypeSocks5(proxyTypeSocks5 *ProxyTypeSocks5)
+	VisitProxyTypeHttp(proxyTypeHttp *ProxyTypeHttp)
+	VisitProxyTypeMtproto(proxyTypeMtproto *ProxyTypeMtproto)
+	VisitUnknownProxyType(unknownProxyType *UnknownProxyType)
+}
+
+// ProxyTypeCases holds the function MatchProxyType calls for each type of ProxyType;
+// types without a function are passed to the default case
+type ProxyTypeCases[R any] struct {
+	ProxyTypeSocks5  func(proxyTypeSocks5 *ProxyTypeSocks5) R
+	ProxyTypeHttp    func(proxyTypeHttp *ProxyTypeHttp) R
+	ProxyTypeMtproto func(proxyTypeMtproto *ProxyTypeMtproto) R
+}
+
+// MatchProxyType calls the case of the type of proxyType, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement ProxyTypeVisitor
+// to have the compiler check every type is handled.
+func MatchProxyType[R any](proxyType ProxyType, cases ProxyTypeCases[R], defaultCase func(proxyType ProxyType) R) R {
+	switch value := proxyType.(type) {
+	case *ProxyTypeSocks5:
+		if cases.ProxyTypeSocks5 != nil {
+			return cases.ProxyTypeSocks5(value)
+		}
+	case *ProxyTypeHttp:
+		if cases.ProxyTypeHttp != nil {
+			return cases.ProxyTypeHttp(value)
+		}
+	case *ProxyTypeMtproto:
+		if cases.ProxyTypeMtproto != nil {
+			return cases.ProxyTypeMtproto(value)
+		}
+	}
+	return defaultCase(proxyType)
+}
+
+// Clone returns a deep copy of the object
+func (unknownProxyType *UnknownProxyType) Clone() *UnknownProxyType {
+	if unknownProxyType == nil {
+		return nil
+	}
+	return &UnknownProxyType{Unknown: unknownProxyType.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownProxyType *UnknownProxyType) Equal(other *UnknownProxyType) bool {
+	if unknownProxyType == nil || other == nil {
+		return unknownProxyType == other
+	}
+	return unknownProxyType.Unknown.equal(other.Unknown)
+}
+
+func cloneProxyType(proxyType ProxyType) ProxyType {
+	switch value := proxyType.(type) {
+	case *ProxyTypeSocks5:
+		return value.Clone()
+	case *ProxyTypeHttp:
+		return value.Clone()
+	case *ProxyTypeMtproto:
+		return value.Clone()
+	case *UnknownProxyType:
+		return value.Clone()
+	}
+	return proxyType
+}
+
+func equalProxyType(a ProxyType, b ProxyType) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *ProxyTypeSocks5:
+		b, ok := b.(*ProxyTypeSocks5)
+		return ok && a.Equal(b)
+	case *ProxyTypeHttp:
+		b, ok := b.(*ProxyTypeHttp)
+		return ok && a.Equal(b)
+	case *ProxyTypeMtproto:
+		b, ok := b.(*ProxyTypeMtproto)
+		return ok && a.Equal(b)
+	case *UnknownProxyType:
+		b, ok := b.(*UnknownProxyType)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffProxyType(path string, a ProxyType, b ProxyType, changes []Change) []Change {
+	switch a := a.(type) {
+	case *ProxyTypeSocks5:
+		if b, ok := b.(*ProxyTypeSocks5); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ProxyTypeHttp:
+		if b, ok := b.(*ProxyTypeHttp); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ProxyTypeMtproto:
+		if b, ok := b.(*ProxyTypeMtproto); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalProxyType(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
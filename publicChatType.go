@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // PublicChatType Describes a type of public chats
 type PublicChatType interface {
 	GetPublicChatTypeEnum() PublicChatTypeEnum
+	Accept(visitor PublicChatTypeVisitor)
 }
 
 // PublicChatTypeEnum Alias for abstract PublicChatType 'Sub-Classes', used as constant-enum here
@@ -19,30 +21,73 @@ const (
 	PublicChatTypeIsLocationBasedType PublicChatTypeEnum = "publicChatTypeIsLocationBased"
 )
 
+// UnknownPublicChatType is a PublicChatType of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownPublicChatType struct {
+	Unknown
+}
+
+// GetPublicChatTypeEnum return the enum type of this object
+func (unknownPublicChatType *UnknownPublicChatType) GetPublicChatTypeEnum() PublicChatTypeEnum {
+	return PublicChatTypeEnum(unknownPublicChatType.Type)
+}
+
+// Accept calls the method of the visitor for UnknownPublicChatType
+func (unknownPublicChatType *UnknownPublicChatType) Accept(visitor PublicChatTypeVisitor) {
+	visitor.VisitUnknownPublicChatType(unknownPublicChatType)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownPublicChatType *UnknownPublicChatType) String() string {
+	return objectString(unknownPublicChatType)
+}
+
+// LogValue logs the object as its summary
+func (unknownPublicChatType *UnknownPublicChatType) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownPublicChatType))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownPublicChatType *UnknownPublicChatType) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownPublicChatType)
+}
+
+func (unknownPublicChatType *UnknownPublicChatType) printText(p *printer) {
+	if unknownPublicChatType == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownPublicChatType")
+	p.string("@type", unknownPublicChatType.Type)
+	p.endObject(state)
+}
+
 func unmarshalPublicChatType(rawMsg *json.RawMessage) (PublicChatType, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch PublicChatTypeEnum(objMap["@type"].(string)) {
+	switch PublicChatTypeEnum(typeName) {
 	case PublicChatTypeHasUsernameType:
 		var publicChatTypeHasUsername PublicChatTypeHasUsername
-		err := json.Unmarshal(*rawMsg, &publicChatTypeHasUsername)
+		err := jsonUnmarshal(*rawMsg, &publicChatTypeHasUsername)
 		return &publicChatTypeHasUsername, err
 
 	case PublicChatTypeIsLocationBasedType:
 		var publicChatTypeIsLocationBased PublicChatTypeIsLocationBased
-		err := json.Unmarshal(*rawMsg, &publicChatTypeIsLocationBased)
+		err := jsonUnmarshal(*rawMsg, &publicChatTypeIsLocationBased)
 		return &publicChatTypeIsLocationBased, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownPublicChatType{Unknown: unknown}, nil
 	}
 }
 
@@ -57,7 +102,6 @@ func (publicChatTypeHasUsername *PublicChatTypeHasUsername) MessageType() string
 }
 
 // NewPublicChatTypeHasUsername creates a new PublicChatTypeHasUsername
-//
 func NewPublicChatTypeHasUsername() *PublicChatTypeHasUsername {
 	publicChatTypeHasUsernameTemp := PublicChatTypeHasUsername{
 		tdCommon: tdCommon{Type: "publicChatTypeHasUsername"},
@@ -66,11 +110,107 @@ func NewPublicChatTypeHasUsername() *PublicChatTypeHasUsername {
 	return &publicChatTypeHasUsernameTemp
 }
 
+// MarshalJSON marshals to json
+func (publicChatTypeHasUsername *PublicChatTypeHasUsername) MarshalJSON() ([]byte, error) {
+	return publicChatTypeHasUsername.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (publicChatTypeHasUsername *PublicChatTypeHasUsername) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, publicChatTypeHasUsername)
+}
+
+func (publicChatTypeHasUsername *PublicChatTypeHasUsername) appendJSON(b []byte) ([]byte, error) {
+	if publicChatTypeHasUsername == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, publicChatTypeHasUsername.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, publicChatTypeHasUsername.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (publicChatTypeHasUsername *PublicChatTypeHasUsername) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			publicChatTypeHasUsername.tdCommon.Type, err = r.readString()
+		case "@extra":
+			publicChatTypeHasUsername.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (publicChatTypeHasUsername *PublicChatTypeHasUsername) Clone() *PublicChatTypeHasUsername {
+	if publicChatTypeHasUsername == nil {
+		return nil
+	}
+	clone := *publicChatTypeHasUsername
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (publicChatTypeHasUsername *PublicChatTypeHasUsername) Equal(other *PublicChatTypeHasUsername) bool {
+	if publicChatTypeHasUsername == nil || other == nil {
+		return publicChatTypeHasUsername == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (publicChatTypeHasUsername *PublicChatTypeHasUsername) Diff(other *PublicChatTypeHasUsername) []Change {
+	return publicChatTypeHasUsername.diff("", other, nil)
+}
+
+func (publicChatTypeHasUsername *PublicChatTypeHasUsername) diff(path string, other *PublicChatTypeHasUsername, changes []Change) []Change {
+	if publicChatTypeHasUsername == nil || other == nil {
+		if publicChatTypeHasUsername != other {
+			changes = append(changes, Change{Path: path, Old: publicChatTypeHasUsername, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (publicChatTypeHasUsername *PublicChatTypeHasUsername) String() string {
+	return objectString(publicChatTypeHasUsername)
+}
+
+// LogValue logs the object as its summary
+func (publicChatTypeHasUsername *PublicChatTypeHasUsername) LogValue() slog.Value {
+	return slog.StringValue(objectString(publicChatTypeHasUsername))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (publicChatTypeHasUsername *PublicChatTypeHasUsername) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, publicChatTypeHasUsername)
+}
+
+func (publicChatTypeHasUsername *PublicChatTypeHasUsername) printText(p *printer) {
+	if publicChatTypeHasUsername == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PublicChatTypeHasUsername")
+	p.endObject(state)
+}
+
 // GetPublicChatTypeEnum return the enum type of this object
 func (publicChatTypeHasUsername *PublicChatTypeHasUsername) GetPublicChatTypeEnum() PublicChatTypeEnum {
 	return PublicChatTypeHasUsernameType
 }
 
+// Accept calls the method of the visitor for PublicChatTypeHasUsername
+func (publicChatTypeHasUsername *PublicChatTypeHasUsername) Accept(visitor PublicChatTypeVisitor) {
+	visitor.VisitPublicChatTypeHasUsername(publicChatTypeHasUsername)
+}
+
 // PublicChatTypeIsLocationBased The chat is public, because it is a location-based supergroup
 type PublicChatTypeIsLocationBased struct {
 	tdCommon
@@ -82,7 +222,6 @@ func (publicChatTypeIsLocationBased *PublicChatTypeIsLocationBased) MessageType(
 }
 
 // NewPublicChatTypeIsLocationBased creates a new PublicChatTypeIsLocationBased
-//
 func NewPublicChatTypeIsLocationBased() *PublicChatTypeIsLocationBased {
 	publicChatTypeIsLocationBasedTemp := PublicChatTypeIsLocationBased{
 		tdCommon: tdCommon{Type: "publicChatTypeIsLocationBased"},
@@ -91,7 +230,198 @@ func NewPublicChatTypeIsLocationBased() *PublicChatTypeIsLocationBased {
 	return &publicChatTypeIsLocationBasedTemp
 }
 
+// MarshalJSON marshals to json
+func (publicChatTypeIsLocationBased *PublicChatTypeIsLocationBased) MarshalJSON() ([]byte, error) {
+	return publicChatTypeIsLocationBased.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (publicChatTypeIsLocationBased *PublicChatTypeIsLocationBased) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, publicChatTypeIsLocationBased)
+}
+
+func (publicChatTypeIsLocationBased *PublicChatTypeIsLocationBased) appendJSON(b []byte) ([]byte, error) {
+	if publicChatTypeIsLocationBased == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, publicChatTypeIsLocationBased.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, publicChatTypeIsLocationBased.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (publicChatTypeIsLocationBased *PublicChatTypeIsLocationBased) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			publicChatTypeIsLocationBased.tdCommon.Type, err = r.readString()
+		case "@extra":
+			publicChatTypeIsLocationBased.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (publicChatTypeIsLocationBased *PublicChatTypeIsLocationBased) Clone() *PublicChatTypeIsLocationBased {
+	if publicChatTypeIsLocationBased == nil {
+		return nil
+	}
+	clone := *publicChatTypeIsLocationBased
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (publicChatTypeIsLocationBased *PublicChatTypeIsLocationBased) Equal(other *PublicChatTypeIsLocationBased) bool {
+	if publicChatTypeIsLocationBased == nil || other == nil {
+		return publicChatTypeIsLocationBased == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (publicChatTypeIsLocationBased *PublicChatTypeIsLocationBased) Diff(other *PublicChatTypeIsLocationBased) []Change {
+	return publicChatTypeIsLocationBased.diff("", other, nil)
+}
+
+func (publicChatTypeIsLocationBased *PublicChatTypeIsLocationBased) diff(path string, other *PublicChatTypeIsLocationBased, changes []Change) []Change {
+	if publicChatTypeIsLocationBased == nil || other == nil {
+		if publicChatTypeIsLocationBased != other {
+			changes = append(changes, Change{Path: path, Old: publicChatTypeIsLocationBased, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (publicChatTypeIsLocationBased *PublicChatTypeIsLocationBased) String() string {
+	return objectString(publicChatTypeIsLocationBased)
+}
+
+// LogValue logs the object as its summary
+func (publicChatTypeIsLocationBased *PublicChatTypeIsLocationBased) LogValue() slog.Value {
+	return slog.StringValue(objectString(publicChatTypeIsLocationBased))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (publicChatTypeIsLocationBased *PublicChatTypeIsLocationBased) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, publicChatTypeIsLocationBased)
+}
+
+func (publicChatTypeIsLocationBased *PublicChatTypeIsLocationBased) printText(p *printer) {
+	if publicChatTypeIsLocationBased == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PublicChatTypeIsLocationBased")
+	p.endObject(state)
+}
+
 // GetPublicChatTypeEnum return the enum type of this object
 func (publicChatTypeIsLocationBased *PublicChatTypeIsLocationBased) GetPublicChatTypeEnum() PublicChatTypeEnum {
 	return PublicChatTypeIsLocationBasedType
 }
+
+// Accept calls the method of the visitor for PublicChatTypeIsLocationBased
+func (publicChatTypeIsLocationBased *PublicChatTypeIsLocationBased) Accept(visitor PublicChatTypeVisitor) {
+	visitor.VisitPublicChatTypeIsLocationBased(publicChatTypeIsLocationBased)
+}
+
+// PublicChatTypeVisitor visits every type of PublicChatType, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to PublicChatType, until they handle it too.
+type PublicChatTypeVisitor interface {
+	VisitPublicChatTypeHasUsername(publicChatTypeHasUsername *PublicChatTypeHasUsername)
+	VisitPublicChatTypeIsLocationBased(publicChatTypeIsLocationBased *PublicChatTypeIsLocationBased)
+	VisitUnknownPublicChatType(unknownPublicChatType *UnknownPublicChatType)
+}
+
+// PublicChatTypeCases holds the function MatchPublicChatType calls for each type of PublicChatType;
+// types without a function are passed to the default case
+type PublicChatTypeCases[R any] struct {
+	PublicChatTypeHasUsername     func(publicChatTypeHasUsername *PublicChatTypeHasUsername) R
+	PublicChatTypeIsLocationBased func(publicChatTypeIsLocationBased *PublicChatTypeIsLocationBased) R
+}
+
+// MatchPublicChatType calls the case of the type of publicChatType, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement PublicChatTypeVisitor
+// to have the compiler check every type is handled.
+func MatchPublicChatType[R any](publicChatType PublicChatType, cases PublicChatTypeCases[R], defaultCase func(publicChatType PublicChatType) R) R {
+	switch value := publicChatType.(type) {
+	case *PublicChatTypeHasUsername:
+		if cases.PublicChatTypeHasUsername != nil {
+			return cases.PublicChatTypeHasUsername(value)
+		}
+	case *PublicChatTypeIsLocationBased:
+		if cases.PublicChatTypeIsLocationBased != nil {
+			return cases.PublicChatTypeIsLocationBased(value)
+		}
+	}
+	return defaultCase(publicChatType)
+}
+
+// Clone returns a deep copy of the object
+func (unknownPublicChatType *UnknownPublicChatType) Clone() *UnknownPublicChatType {
+	if unknownPublicChatType == nil {
+		return nil
+	}
+	return &UnknownPublicChatType{Unknown: unknownPublicChatType.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownPublicChatType *UnknownPublicChatType) Equal(other *UnknownPublicChatType) bool {
+	if unknownPublicChatType == nil || other == nil {
+		return unknownPublicChatType == other
+	}
+	return unknownPublicChatType.Unknown.equal(other.Unknown)
+}
+
+func clonePublicChatType(publicChatType PublicChatType) PublicChatType {
+	switch value := publicChatType.(type) {
+	case *PublicChatTypeHasUsername:
+		return value.Clone()
+	case *PublicChatTypeIsLocationBased:
+		return value.Clone()
+	case *UnknownPublicChatType:
+		return value.Clone()
+	}
+	return publicChatType
+}
+
+func equalPublicChatType(a PublicChatType, b PublicChatType) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *PublicChatTypeHasUsername:
+		b, ok := b.(*PublicChatTypeHasUsername)
+		return ok && a.Equal(b)
+	case *PublicChatTypeIsLocationBased:
+		b, ok := b.(*PublicChatTypeIsLocationBased)
+		return ok && a.Equal(b)
+	case *UnknownPublicChatType:
+		b, ok := b.(*UnknownPublicChatType)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffPublicChatType(path string, a PublicChatType, b PublicChatType, changes []Change) []Change {
+	switch a := a.(type) {
+	case *PublicChatTypeHasUsername:
+		if b, ok := b.(*PublicChatTypeHasUsername); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PublicChatTypeIsLocationBased:
+		if b, ok := b.(*PublicChatTypeIsLocationBased); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalPublicChatType(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
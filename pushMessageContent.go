@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // PushMessageContent Contains content of a push message notification
 type PushMessageContent interface {
 	GetPushMessageContentEnum() PushMessageContentEnum
+	Accept(visitor PushMessageContentVisitor)
 }
 
 // PushMessageContentEnum Alias for abstract PushMessageContent 'Sub-Classes', used as constant-enum here
@@ -45,163 +47,214 @@ const (
 	PushMessageContentMediaAlbumType           PushMessageContentEnum = "pushMessageContentMediaAlbum"
 )
 
+// UnknownPushMessageContent is a PushMessageContent of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownPushMessageContent struct {
+	Unknown
+}
+
+// GetPushMessageContentEnum return the enum type of this object
+func (unknownPushMessageContent *UnknownPushMessageContent) GetPushMessageContentEnum() PushMessageContentEnum {
+	return PushMessageContentEnum(unknownPushMessageContent.Type)
+}
+
+// Accept calls the method of the visitor for UnknownPushMessageContent
+func (unknownPushMessageContent *UnknownPushMessageContent) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitUnknownPushMessageContent(unknownPushMessageContent)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownPushMessageContent *UnknownPushMessageContent) String() string {
+	return objectString(unknownPushMessageContent)
+}
+
+// LogValue logs the object as its summary
+func (unknownPushMessageContent *UnknownPushMessageContent) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownPushMessageContent))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownPushMessageContent *UnknownPushMessageContent) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownPushMessageContent)
+}
+
+func (unknownPushMessageContent *UnknownPushMessageContent) printText(p *printer) {
+	if unknownPushMessageContent == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownPushMessageContent")
+	p.string("@type", unknownPushMessageContent.Type)
+	p.endObject(state)
+}
+
 func unmarshalPushMessageContent(rawMsg *json.RawMessage) (PushMessageContent, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch PushMessageContentEnum(objMap["@type"].(string)) {
+	switch PushMessageContentEnum(typeName) {
 	case PushMessageContentHiddenType:
 		var pushMessageContentHidden PushMessageContentHidden
-		err := json.Unmarshal(*rawMsg, &pushMessageContentHidden)
+		err := jsonUnmarshal(*rawMsg, &pushMessageContentHidden)
 		return &pushMessageContentHidden, err
 
 	case PushMessageContentAnimationType:
 		var pushMessageContentAnimation PushMessageContentAnimation
-		err := json.Unmarshal(*rawMsg, &pushMessageContentAnimation)
+		err := jsonUnmarshal(*rawMsg, &pushMessageContentAnimation)
 		return &pushMessageContentAnimation, err
 
 	case PushMessageContentAudioType:
 		var pushMessageContentAudio PushMessageContentAudio
-		err := json.Unmarshal(*rawMsg, &pushMessageContentAudio)
+		err := jsonUnmarshal(*rawMsg, &pushMessageContentAudio)
 		return &pushMessageContentAudio, err
 
 	case PushMessageContentContactType:
 		var pushMessageContentContact PushMessageContentContact
-		err := json.Unmarshal(*rawMsg, &pushMessageContentContact)
+		err := jsonUnmarshal(*rawMsg, &pushMessageContentContact)
 		return &pushMessageContentContact, err
 
 	case PushMessageContentContactRegisteredType:
 		var pushMessageContentContactRegistered PushMessageContentContactRegistered
-		err := json.Unmarshal(*rawMsg, &pushMessageContentContactRegistered)
+		err := jsonUnmarshal(*rawMsg, &pushMessageContentContactRegistered)
 		return &pushMessageContentContactRegistered, err
 
 	case PushMessageContentDocumentType:
 		var pushMessageContentDocument PushMessageContentDocument
-		err := json.Unmarshal(*rawMsg, &pushMessageContentDocument)
+		err := jsonUnmarshal(*rawMsg, &pushMessageContentDocument)
 		return &pushMessageContentDocument, err
 
 	case PushMessageContentGameType:
 		var pushMessageContentGame PushMessageContentGame
-		err := json.Unmarshal(*rawMsg, &pushMessageContentGame)
+		err := jsonUnmarshal(*rawMsg, &pushMessageContentGame)
 		return &pushMessageContentGame, err
 
 	case PushMessageContentGameScoreType:
 		var pushMessageContentGameScore PushMessageContentGameScore
-		err := json.Unmarshal(*rawMsg, &pushMessageContentGameScore)
+		err := jsonUnmarshal(*rawMsg, &pushMessageContentGameScore)
 		return &pushMessageContentGameScore, err
 
 	case PushMessageContentInvoiceType:
 		var pushMessageContentInvoice PushMessageContentInvoice
-		err := json.Unmarshal(*rawMsg, &pushMessageContentInvoice)
+		err := jsonUnmarshal(*rawMsg, &pushMessageContentInvoice)
 		return &pushMessageContentInvoice, err
 
 	case PushMessageContentLocationType:
 		var pushMessageContentLocation PushMessageContentLocation
-		err := json.Unmarshal(*rawMsg, &pushMessageContentLocation)
+		err := jsonUnmarshal(*rawMsg, &pushMessageContentLocation)
 		return &pushMessageContentLocation, err
 
 	case PushMessageContentPhotoType:
 		var pushMessageContentPhoto PushMessageContentPhoto
-		err := json.Unmarshal(*rawMsg, &pushMessageContentPhoto)
+		err := jsonUnmarshal(*rawMsg, &pushMessageContentPhoto)
 		return &pushMessageContentPhoto, err
 
 	case PushMessageContentPollType:
 		var pushMessageContentPoll PushMessageContentPoll
-		err := json.Unmarshal(*rawMsg, &pushMessageContentPoll)
+		err := jsonUnmarshal(*rawMsg, &pushMessageContentPoll)
 		return &pushMessageContentPoll, err
 
 	case PushMessageContentScreenshotTakenType:
 		var pushMessageContentScreenshotTaken PushMessageContentScreenshotTaken
-		err := json.Unmarshal(*rawMsg, &pushMessageContentScreenshotTaken)
+		err := jsonUnmarshal(*rawMsg, &pushMessageContentScreenshotTaken)
 		return &pushMessageContentScreenshotTaken, err
 
 	case PushMessageContentStickerType:
 		var pushMessageContentSticker PushMessageContentSticker
-		err := json.Unmarshal(*rawMsg, &pushMessageContentSticker)
+		err := jsonUnmarshal(*rawMsg, &pushMessageContentSticker)
 		return &pushMessageContentSticker, err
 
 	case PushMessageContentTextType:
 		var pushMessageContentText PushMessageContentText
-		err := json.Unmarshal(*rawMsg, &pushMessageContentText)
+		err := jsonUnmarshal(*rawMsg, &pushMessageContentText)
 		return &pushMessageContentText, err
 
 	case PushMessageContentVideoType:
 		var pushMessageContentVideo PushMessageContentVideo
-		err := json.Unmarshal(*rawMsg, &pushMessageContentVideo)
+		err := jsonUnmarshal(*rawMsg, &pushMessageContentVideo)
 		return &pushMessageContentVideo, err
 
 	case PushMessageContentVideoNoteType:
 		var pushMessageContentVideoNote PushMessageContentVideoNote
-		err := json.Unmarshal(*rawMsg, &pushMessageContentVideoNote)
+		err := jsonUnmarshal(*rawMsg, &pushMessageContentVideoNote)
 		return &pushMessageContentVideoNote, err
 
 	case PushMessageContentVoiceNoteType:
 		var pushMessageContentVoiceNote PushMessageContentVoiceNote
-		err := json.Unmarshal(*rawMsg, &pushMessageContentVoiceNote)
+		err := jsonUnmarshal(*rawMsg, &pushMessageContentVoiceNote)
 		return &pushMessageContentVoiceNote, err
 
 	case PushMessageContentBasicGroupChatCreateType:
 		var pushMessageContentBasicGroupChatCreate PushMessageContentBasicGroupChatCreate
-		err := json.Unmarshal(*rawMsg, &pushMessageContentBasicGroupChatCreate)
+		err := jsonUnmarshal(*rawMsg, &pushMessageContentBasicGroupChatCreate)
 		return &pushMessageContentBasicGroupChatCreate, err
 
 	case PushMessageContentChatAddMembersType:
 		var pushMessageContentChatAddMembers PushMessageContentChatAddMembers
-		err := json.Unmarshal(*rawMsg, &pushMessageContentChatAddMembers)
+		err := jsonUnmarshal(*rawMsg, &pushMessageContentChatAddMembers)
 		return &pushMessageContentChatAddMembers, err
 
 	case PushMessageContentChatChangePhotoType:
 		var pushMessageContentChatChangePhoto PushMessageContentChatChangePhoto
-		err := json.Unmarshal(*rawMsg, &pushMessageContentChatChangePhoto)
+		err := jsonUnmarshal(*rawMsg, &pushMessageContentChatChangePhoto)
 		return &pushMessageContentChatChangePhoto, err
 
 	case PushMessageContentChatChangeTitleType:
 		var pushMessageContentChatChangeTitle PushMessageContentChatChangeTitle
-		err := json.Unmarshal(*rawMsg, &pushMessageContentChatChangeTitle)
+		err := jsonUnmarshal(*rawMsg, &pushMessageContentChatChangeTitle)
 		return &pushMessageContentChatChangeTitle, err
 
 	case PushMessageContentChatSetThemeType:
 		var pushMessageContentChatSetTheme PushMessageContentChatSetTheme
-		err := json.Unmarshal(*rawMsg, &pushMessageContentChatSetTheme)
+		err := jsonUnmarshal(*rawMsg, &pushMessageContentChatSetTheme)
 		return &pushMessageContentChatSetTheme, err
 
 	case PushMessageContentChatDeleteMemberType:
 		var pushMessageContentChatDeleteMember PushMessageContentChatDeleteMember
-		err := json.Unmarshal(*rawMsg, &pushMessageContentChatDeleteMember)
+		err := jsonUnmarshal(*rawMsg, &pushMessageContentChatDeleteMember)
 		return &pushMessageContentChatDeleteMember, err
 
 	case PushMessageContentChatJoinByLinkType:
 		var pushMessageContentChatJoinByLink PushMessageContentChatJoinByLink
-		err := json.Unmarshal(*rawMsg, &pushMessageContentChatJoinByLink)
+		err := jsonUnmarshal(*rawMsg, &pushMessageContentChatJoinByLink)
 		return &pushMessageContentChatJoinByLink, err
 
 	case PushMessageContentChatJoinByRequestType:
 		var pushMessageContentChatJoinByRequest PushMessageContentChatJoinByRequest
-		err := json.Unmarshal(*rawMsg, &pushMessageContentChatJoinByRequest)
+		err := jsonUnmarshal(*rawMsg, &pushMessageContentChatJoinByRequest)
 		return &pushMessageContentChatJoinByRequest, err
 
 	case PushMessageContentMessageForwardsType:
 		var pushMessageContentMessageForwards PushMessageContentMessageForwards
-		err := json.Unmarshal(*rawMsg, &pushMessageContentMessageForwards)
+		err := jsonUnmarshal(*rawMsg, &pushMessageContentMessageForwards)
 		return &pushMessageContentMessageForwards, err
 
 	case PushMessageContentMediaAlbumType:
 		var pushMessageContentMediaAlbum PushMessageContentMediaAlbum
-		err := json.Unmarshal(*rawMsg, &pushMessageContentMediaAlbum)
+		err := jsonUnmarshal(*rawMsg, &pushMessageContentMediaAlbum)
 		return &pushMessageContentMediaAlbum, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownPushMessageContent{Unknown: unknown}, nil
 	}
 }
 
+func decodePushMessageContent(r *jsonReader) (PushMessageContent, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalPushMessageContent(rawMsg)
+}
+
 // PushMessageContentHidden A general message with hidden content
 type PushMessageContentHidden struct {
 	tdCommon
@@ -225,11 +278,118 @@ func NewPushMessageContentHidden(isPinned bool) *PushMessageContentHidden {
 	return &pushMessageContentHiddenTemp
 }
 
+// MarshalJSON marshals to json
+func (pushMessageContentHidden *PushMessageContentHidden) MarshalJSON() ([]byte, error) {
+	return pushMessageContentHidden.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pushMessageContentHidden *PushMessageContentHidden) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushMessageContentHidden)
+}
+
+func (pushMessageContentHidden *PushMessageContentHidden) appendJSON(b []byte) ([]byte, error) {
+	if pushMessageContentHidden == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushMessageContentHidden.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushMessageContentHidden.tdCommon.Extra)
+	b = append(b, `,"is_pinned":`...)
+	b = appendJSONBool(b, pushMessageContentHidden.IsPinned)
+	return append(b, '}'), nil
+}
+
+func (pushMessageContentHidden *PushMessageContentHidden) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushMessageContentHidden.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushMessageContentHidden.tdCommon.Extra, err = r.readString()
+		case "is_pinned":
+			pushMessageContentHidden.IsPinned, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushMessageContentHidden *PushMessageContentHidden) Clone() *PushMessageContentHidden {
+	if pushMessageContentHidden == nil {
+		return nil
+	}
+	clone := *pushMessageContentHidden
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushMessageContentHidden *PushMessageContentHidden) Equal(other *PushMessageContentHidden) bool {
+	if pushMessageContentHidden == nil || other == nil {
+		return pushMessageContentHidden == other
+	}
+	if pushMessageContentHidden.IsPinned != other.IsPinned {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushMessageContentHidden *PushMessageContentHidden) Diff(other *PushMessageContentHidden) []Change {
+	return pushMessageContentHidden.diff("", other, nil)
+}
+
+func (pushMessageContentHidden *PushMessageContentHidden) diff(path string, other *PushMessageContentHidden, changes []Change) []Change {
+	if pushMessageContentHidden == nil || other == nil {
+		if pushMessageContentHidden != other {
+			changes = append(changes, Change{Path: path, Old: pushMessageContentHidden, New: other})
+		}
+		return changes
+	}
+	if pushMessageContentHidden.IsPinned != other.IsPinned {
+		changes = append(changes, Change{Path: fieldPath(path, "is_pinned"), Old: pushMessageContentHidden.IsPinned, New: other.IsPinned})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushMessageContentHidden *PushMessageContentHidden) String() string {
+	return objectString(pushMessageContentHidden)
+}
+
+// LogValue logs the object as its summary
+func (pushMessageContentHidden *PushMessageContentHidden) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushMessageContentHidden))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushMessageContentHidden *PushMessageContentHidden) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushMessageContentHidden)
+}
+
+func (pushMessageContentHidden *PushMessageContentHidden) printText(p *printer) {
+	if pushMessageContentHidden == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PushMessageContentHidden")
+	p.bool("is_pinned", pushMessageContentHidden.IsPinned)
+	p.endObject(state)
+}
+
 // GetPushMessageContentEnum return the enum type of this object
 func (pushMessageContentHidden *PushMessageContentHidden) GetPushMessageContentEnum() PushMessageContentEnum {
 	return PushMessageContentHiddenType
 }
 
+// Accept calls the method of the visitor for PushMessageContentHidden
+func (pushMessageContentHidden *PushMessageContentHidden) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitPushMessageContentHidden(pushMessageContentHidden)
+}
+
 // PushMessageContentAnimation An animation message (GIF-style).
 type PushMessageContentAnimation struct {
 	tdCommon
@@ -259,11 +419,148 @@ func NewPushMessageContentAnimation(animation *Animation, caption string, isPinn
 	return &pushMessageContentAnimationTemp
 }
 
+// MarshalJSON marshals to json
+func (pushMessageContentAnimation *PushMessageContentAnimation) MarshalJSON() ([]byte, error) {
+	return pushMessageContentAnimation.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pushMessageContentAnimation *PushMessageContentAnimation) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushMessageContentAnimation)
+}
+
+func (pushMessageContentAnimation *PushMessageContentAnimation) appendJSON(b []byte) ([]byte, error) {
+	if pushMessageContentAnimation == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushMessageContentAnimation.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushMessageContentAnimation.tdCommon.Extra)
+	b = append(b, `,"animation":`...)
+	b, err = pushMessageContentAnimation.Animation.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"caption":`...)
+	b = appendJSONString(b, pushMessageContentAnimation.Caption)
+	b = append(b, `,"is_pinned":`...)
+	b = appendJSONBool(b, pushMessageContentAnimation.IsPinned)
+	return append(b, '}'), nil
+}
+
+func (pushMessageContentAnimation *PushMessageContentAnimation) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushMessageContentAnimation.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushMessageContentAnimation.tdCommon.Extra, err = r.readString()
+		case "animation":
+			if r.readNull() {
+				pushMessageContentAnimation.Animation = nil
+			} else {
+				pushMessageContentAnimation.Animation = new(Animation)
+				err = pushMessageContentAnimation.Animation.decodeJSON(r)
+			}
+		case "caption":
+			pushMessageContentAnimation.Caption, err = r.readString()
+		case "is_pinned":
+			pushMessageContentAnimation.IsPinned, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushMessageContentAnimation *PushMessageContentAnimation) Clone() *PushMessageContentAnimation {
+	if pushMessageContentAnimation == nil {
+		return nil
+	}
+	clone := *pushMessageContentAnimation
+	clone.Animation = pushMessageContentAnimation.Animation.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushMessageContentAnimation *PushMessageContentAnimation) Equal(other *PushMessageContentAnimation) bool {
+	if pushMessageContentAnimation == nil || other == nil {
+		return pushMessageContentAnimation == other
+	}
+	if !pushMessageContentAnimation.Animation.Equal(other.Animation) {
+		return false
+	}
+	if pushMessageContentAnimation.Caption != other.Caption {
+		return false
+	}
+	if pushMessageContentAnimation.IsPinned != other.IsPinned {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushMessageContentAnimation *PushMessageContentAnimation) Diff(other *PushMessageContentAnimation) []Change {
+	return pushMessageContentAnimation.diff("", other, nil)
+}
+
+func (pushMessageContentAnimation *PushMessageContentAnimation) diff(path string, other *PushMessageContentAnimation, changes []Change) []Change {
+	if pushMessageContentAnimation == nil || other == nil {
+		if pushMessageContentAnimation != other {
+			changes = append(changes, Change{Path: path, Old: pushMessageContentAnimation, New: other})
+		}
+		return changes
+	}
+	changes = pushMessageContentAnimation.Animation.diff(fieldPath(path, "animation"), other.Animation, changes)
+	if pushMessageContentAnimation.Caption != other.Caption {
+		changes = append(changes, Change{Path: fieldPath(path, "caption"), Old: pushMessageContentAnimation.Caption, New: other.Caption})
+	}
+	if pushMessageContentAnimation.IsPinned != other.IsPinned {
+		changes = append(changes, Change{Path: fieldPath(path, "is_pinned"), Old: pushMessageContentAnimation.IsPinned, New: other.IsPinned})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushMessageContentAnimation *PushMessageContentAnimation) String() string {
+	return objectString(pushMessageContentAnimation)
+}
+
+// LogValue logs the object as its summary
+func (pushMessageContentAnimation *PushMessageContentAnimation) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushMessageContentAnimation))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushMessageContentAnimation *PushMessageContentAnimation) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushMessageContentAnimation)
+}
+
+func (pushMessageContentAnimation *PushMessageContentAnimation) printText(p *printer) {
+	if pushMessageContentAnimation == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PushMessageContentAnimation")
+	p.object("animation", pushMessageContentAnimation.Animation)
+	p.string("caption", pushMessageContentAnimation.Caption)
+	p.bool("is_pinned", pushMessageContentAnimation.IsPinned)
+	p.endObject(state)
+}
+
 // GetPushMessageContentEnum return the enum type of this object
 func (pushMessageContentAnimation *PushMessageContentAnimation) GetPushMessageContentEnum() PushMessageContentEnum {
 	return PushMessageContentAnimationType
 }
 
+// Accept calls the method of the visitor for PushMessageContentAnimation
+func (pushMessageContentAnimation *PushMessageContentAnimation) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitPushMessageContentAnimation(pushMessageContentAnimation)
+}
+
 // PushMessageContentAudio An audio message
 type PushMessageContentAudio struct {
 	tdCommon
@@ -290,11 +587,137 @@ func NewPushMessageContentAudio(audio *Audio, isPinned bool) *PushMessageContent
 	return &pushMessageContentAudioTemp
 }
 
+// MarshalJSON marshals to json
+func (pushMessageContentAudio *PushMessageContentAudio) MarshalJSON() ([]byte, error) {
+	return pushMessageContentAudio.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pushMessageContentAudio *PushMessageContentAudio) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushMessageContentAudio)
+}
+
+func (pushMessageContentAudio *PushMessageContentAudio) appendJSON(b []byte) ([]byte, error) {
+	if pushMessageContentAudio == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushMessageContentAudio.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushMessageContentAudio.tdCommon.Extra)
+	b = append(b, `,"audio":`...)
+	b, err = pushMessageContentAudio.Audio.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"is_pinned":`...)
+	b = appendJSONBool(b, pushMessageContentAudio.IsPinned)
+	return append(b, '}'), nil
+}
+
+func (pushMessageContentAudio *PushMessageContentAudio) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushMessageContentAudio.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushMessageContentAudio.tdCommon.Extra, err = r.readString()
+		case "audio":
+			if r.readNull() {
+				pushMessageContentAudio.Audio = nil
+			} else {
+				pushMessageContentAudio.Audio = new(Audio)
+				err = pushMessageContentAudio.Audio.decodeJSON(r)
+			}
+		case "is_pinned":
+			pushMessageContentAudio.IsPinned, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushMessageContentAudio *PushMessageContentAudio) Clone() *PushMessageContentAudio {
+	if pushMessageContentAudio == nil {
+		return nil
+	}
+	clone := *pushMessageContentAudio
+	clone.Audio = pushMessageContentAudio.Audio.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushMessageContentAudio *PushMessageContentAudio) Equal(other *PushMessageContentAudio) bool {
+	if pushMessageContentAudio == nil || other == nil {
+		return pushMessageContentAudio == other
+	}
+	if !pushMessageContentAudio.Audio.Equal(other.Audio) {
+		return false
+	}
+	if pushMessageContentAudio.IsPinned != other.IsPinned {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushMessageContentAudio *PushMessageContentAudio) Diff(other *PushMessageContentAudio) []Change {
+	return pushMessageContentAudio.diff("", other, nil)
+}
+
+func (pushMessageContentAudio *PushMessageContentAudio) diff(path string, other *PushMessageContentAudio, changes []Change) []Change {
+	if pushMessageContentAudio == nil || other == nil {
+		if pushMessageContentAudio != other {
+			changes = append(changes, Change{Path: path, Old: pushMessageContentAudio, New: other})
+		}
+		return changes
+	}
+	changes = pushMessageContentAudio.Audio.diff(fieldPath(path, "audio"), other.Audio, changes)
+	if pushMessageContentAudio.IsPinned != other.IsPinned {
+		changes = append(changes, Change{Path: fieldPath(path, "is_pinned"), Old: pushMessageContentAudio.IsPinned, New: other.IsPinned})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushMessageContentAudio *PushMessageContentAudio) String() string {
+	return objectString(pushMessageContentAudio)
+}
+
+// LogValue logs the object as its summary
+func (pushMessageContentAudio *PushMessageContentAudio) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushMessageContentAudio))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushMessageContentAudio *PushMessageContentAudio) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushMessageContentAudio)
+}
+
+func (pushMessageContentAudio *PushMessageContentAudio) printText(p *printer) {
+	if pushMessageContentAudio == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PushMessageContentAudio")
+	p.object("audio", pushMessageContentAudio.Audio)
+	p.bool("is_pinned", pushMessageContentAudio.IsPinned)
+	p.endObject(state)
+}
+
 // GetPushMessageContentEnum return the enum type of this object
 func (pushMessageContentAudio *PushMessageContentAudio) GetPushMessageContentEnum() PushMessageContentEnum {
 	return PushMessageContentAudioType
 }
 
+// Accept calls the method of the visitor for PushMessageContentAudio
+func (pushMessageContentAudio *PushMessageContentAudio) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitPushMessageContentAudio(pushMessageContentAudio)
+}
+
 // PushMessageContentContact A message with a user contact
 type PushMessageContentContact struct {
 	tdCommon
@@ -321,11 +744,129 @@ func NewPushMessageContentContact(name string, isPinned bool) *PushMessageConten
 	return &pushMessageContentContactTemp
 }
 
+// MarshalJSON marshals to json
+func (pushMessageContentContact *PushMessageContentContact) MarshalJSON() ([]byte, error) {
+	return pushMessageContentContact.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pushMessageContentContact *PushMessageContentContact) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushMessageContentContact)
+}
+
+func (pushMessageContentContact *PushMessageContentContact) appendJSON(b []byte) ([]byte, error) {
+	if pushMessageContentContact == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushMessageContentContact.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushMessageContentContact.tdCommon.Extra)
+	b = append(b, `,"name":`...)
+	b = appendJSONString(b, pushMessageContentContact.Name)
+	b = append(b, `,"is_pinned":`...)
+	b = appendJSONBool(b, pushMessageContentContact.IsPinned)
+	return append(b, '}'), nil
+}
+
+func (pushMessageContentContact *PushMessageContentContact) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushMessageContentContact.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushMessageContentContact.tdCommon.Extra, err = r.readString()
+		case "name":
+			pushMessageContentContact.Name, err = r.readString()
+		case "is_pinned":
+			pushMessageContentContact.IsPinned, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushMessageContentContact *PushMessageContentContact) Clone() *PushMessageContentContact {
+	if pushMessageContentContact == nil {
+		return nil
+	}
+	clone := *pushMessageContentContact
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushMessageContentContact *PushMessageContentContact) Equal(other *PushMessageContentContact) bool {
+	if pushMessageContentContact == nil || other == nil {
+		return pushMessageContentContact == other
+	}
+	if pushMessageContentContact.Name != other.Name {
+		return false
+	}
+	if pushMessageContentContact.IsPinned != other.IsPinned {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushMessageContentContact *PushMessageContentContact) Diff(other *PushMessageContentContact) []Change {
+	return pushMessageContentContact.diff("", other, nil)
+}
+
+func (pushMessageContentContact *PushMessageContentContact) diff(path string, other *PushMessageContentContact, changes []Change) []Change {
+	if pushMessageContentContact == nil || other == nil {
+		if pushMessageContentContact != other {
+			changes = append(changes, Change{Path: path, Old: pushMessageContentContact, New: other})
+		}
+		return changes
+	}
+	if pushMessageContentContact.Name != other.Name {
+		changes = append(changes, Change{Path: fieldPath(path, "name"), Old: pushMessageContentContact.Name, New: other.Name})
+	}
+	if pushMessageContentContact.IsPinned != other.IsPinned {
+		changes = append(changes, Change{Path: fieldPath(path, "is_pinned"), Old: pushMessageContentContact.IsPinned, New: other.IsPinned})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushMessageContentContact *PushMessageContentContact) String() string {
+	return objectString(pushMessageContentContact)
+}
+
+// LogValue logs the object as its summary
+func (pushMessageContentContact *PushMessageContentContact) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushMessageContentContact))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushMessageContentContact *PushMessageContentContact) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushMessageContentContact)
+}
+
+func (pushMessageContentContact *PushMessageContentContact) printText(p *printer) {
+	if pushMessageContentContact == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PushMessageContentContact")
+	p.string("name", pushMessageContentContact.Name)
+	p.bool("is_pinned", pushMessageContentContact.IsPinned)
+	p.endObject(state)
+}
+
 // GetPushMessageContentEnum return the enum type of this object
 func (pushMessageContentContact *PushMessageContentContact) GetPushMessageContentEnum() PushMessageContentEnum {
 	return PushMessageContentContactType
 }
 
+// Accept calls the method of the visitor for PushMessageContentContact
+func (pushMessageContentContact *PushMessageContentContact) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitPushMessageContentContact(pushMessageContentContact)
+}
+
 // PushMessageContentContactRegistered A contact has registered with Telegram
 type PushMessageContentContactRegistered struct {
 	tdCommon
@@ -337,7 +878,6 @@ func (pushMessageContentContactRegistered *PushMessageContentContactRegistered)
 }
 
 // NewPushMessageContentContactRegistered creates a new PushMessageContentContactRegistered
-//
 func NewPushMessageContentContactRegistered() *PushMessageContentContactRegistered {
 	pushMessageContentContactRegisteredTemp := PushMessageContentContactRegistered{
 		tdCommon: tdCommon{Type: "pushMessageContentContactRegistered"},
@@ -346,11 +886,107 @@ func NewPushMessageContentContactRegistered() *PushMessageContentContactRegister
 	return &pushMessageContentContactRegisteredTemp
 }
 
+// MarshalJSON marshals to json
+func (pushMessageContentContactRegistered *PushMessageContentContactRegistered) MarshalJSON() ([]byte, error) {
+	return pushMessageContentContactRegistered.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pushMessageContentContactRegistered *PushMessageContentContactRegistered) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushMessageContentContactRegistered)
+}
+
+func (pushMessageContentContactRegistered *PushMessageContentContactRegistered) appendJSON(b []byte) ([]byte, error) {
+	if pushMessageContentContactRegistered == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushMessageContentContactRegistered.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushMessageContentContactRegistered.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (pushMessageContentContactRegistered *PushMessageContentContactRegistered) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushMessageContentContactRegistered.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushMessageContentContactRegistered.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushMessageContentContactRegistered *PushMessageContentContactRegistered) Clone() *PushMessageContentContactRegistered {
+	if pushMessageContentContactRegistered == nil {
+		return nil
+	}
+	clone := *pushMessageContentContactRegistered
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushMessageContentContactRegistered *PushMessageContentContactRegistered) Equal(other *PushMessageContentContactRegistered) bool {
+	if pushMessageContentContactRegistered == nil || other == nil {
+		return pushMessageContentContactRegistered == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushMessageContentContactRegistered *PushMessageContentContactRegistered) Diff(other *PushMessageContentContactRegistered) []Change {
+	return pushMessageContentContactRegistered.diff("", other, nil)
+}
+
+func (pushMessageContentContactRegistered *PushMessageContentContactRegistered) diff(path string, other *PushMessageContentContactRegistered, changes []Change) []Change {
+	if pushMessageContentContactRegistered == nil || other == nil {
+		if pushMessageContentContactRegistered != other {
+			changes = append(changes, Change{Path: path, Old: pushMessageContentContactRegistered, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushMessageContentContactRegistered *PushMessageContentContactRegistered) String() string {
+	return objectString(pushMessageContentContactRegistered)
+}
+
+// LogValue logs the object as its summary
+func (pushMessageContentContactRegistered *PushMessageContentContactRegistered) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushMessageContentContactRegistered))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushMessageContentContactRegistered *PushMessageContentContactRegistered) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushMessageContentContactRegistered)
+}
+
+func (pushMessageContentContactRegistered *PushMessageContentContactRegistered) printText(p *printer) {
+	if pushMessageContentContactRegistered == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PushMessageContentContactRegistered")
+	p.endObject(state)
+}
+
 // GetPushMessageContentEnum return the enum type of this object
 func (pushMessageContentContactRegistered *PushMessageContentContactRegistered) GetPushMessageContentEnum() PushMessageContentEnum {
 	return PushMessageContentContactRegisteredType
 }
 
+// Accept calls the method of the visitor for PushMessageContentContactRegistered
+func (pushMessageContentContactRegistered *PushMessageContentContactRegistered) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitPushMessageContentContactRegistered(pushMessageContentContactRegistered)
+}
+
 // PushMessageContentDocument A document message (a general file)
 type PushMessageContentDocument struct {
 	tdCommon
@@ -377,11 +1013,137 @@ func NewPushMessageContentDocument(document *Document, isPinned bool) *PushMessa
 	return &pushMessageContentDocumentTemp
 }
 
+// MarshalJSON marshals to json
+func (pushMessageContentDocument *PushMessageContentDocument) MarshalJSON() ([]byte, error) {
+	return pushMessageContentDocument.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pushMessageContentDocument *PushMessageContentDocument) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushMessageContentDocument)
+}
+
+func (pushMessageContentDocument *PushMessageContentDocument) appendJSON(b []byte) ([]byte, error) {
+	if pushMessageContentDocument == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushMessageContentDocument.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushMessageContentDocument.tdCommon.Extra)
+	b = append(b, `,"document":`...)
+	b, err = pushMessageContentDocument.Document.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"is_pinned":`...)
+	b = appendJSONBool(b, pushMessageContentDocument.IsPinned)
+	return append(b, '}'), nil
+}
+
+func (pushMessageContentDocument *PushMessageContentDocument) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushMessageContentDocument.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushMessageContentDocument.tdCommon.Extra, err = r.readString()
+		case "document":
+			if r.readNull() {
+				pushMessageContentDocument.Document = nil
+			} else {
+				pushMessageContentDocument.Document = new(Document)
+				err = pushMessageContentDocument.Document.decodeJSON(r)
+			}
+		case "is_pinned":
+			pushMessageContentDocument.IsPinned, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushMessageContentDocument *PushMessageContentDocument) Clone() *PushMessageContentDocument {
+	if pushMessageContentDocument == nil {
+		return nil
+	}
+	clone := *pushMessageContentDocument
+	clone.Document = pushMessageContentDocument.Document.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushMessageContentDocument *PushMessageContentDocument) Equal(other *PushMessageContentDocument) bool {
+	if pushMessageContentDocument == nil || other == nil {
+		return pushMessageContentDocument == other
+	}
+	if !pushMessageContentDocument.Document.Equal(other.Document) {
+		return false
+	}
+	if pushMessageContentDocument.IsPinned != other.IsPinned {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushMessageContentDocument *PushMessageContentDocument) Diff(other *PushMessageContentDocument) []Change {
+	return pushMessageContentDocument.diff("", other, nil)
+}
+
+func (pushMessageContentDocument *PushMessageContentDocument) diff(path string, other *PushMessageContentDocument, changes []Change) []Change {
+	if pushMessageContentDocument == nil || other == nil {
+		if pushMessageContentDocument != other {
+			changes = append(changes, Change{Path: path, Old: pushMessageContentDocument, New: other})
+		}
+		return changes
+	}
+	changes = pushMessageContentDocument.Document.diff(fieldPath(path, "document"), other.Document, changes)
+	if pushMessageContentDocument.IsPinned != other.IsPinned {
+		changes = append(changes, Change{Path: fieldPath(path, "is_pinned"), Old: pushMessageContentDocument.IsPinned, New: other.IsPinned})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushMessageContentDocument *PushMessageContentDocument) String() string {
+	return objectString(pushMessageContentDocument)
+}
+
+// LogValue logs the object as its summary
+func (pushMessageContentDocument *PushMessageContentDocument) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushMessageContentDocument))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushMessageContentDocument *PushMessageContentDocument) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushMessageContentDocument)
+}
+
+func (pushMessageContentDocument *PushMessageContentDocument) printText(p *printer) {
+	if pushMessageContentDocument == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PushMessageContentDocument")
+	p.object("document", pushMessageContentDocument.Document)
+	p.bool("is_pinned", pushMessageContentDocument.IsPinned)
+	p.endObject(state)
+}
+
 // GetPushMessageContentEnum return the enum type of this object
 func (pushMessageContentDocument *PushMessageContentDocument) GetPushMessageContentEnum() PushMessageContentEnum {
 	return PushMessageContentDocumentType
 }
 
+// Accept calls the method of the visitor for PushMessageContentDocument
+func (pushMessageContentDocument *PushMessageContentDocument) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitPushMessageContentDocument(pushMessageContentDocument)
+}
+
 // PushMessageContentGame A message with a game
 type PushMessageContentGame struct {
 	tdCommon
@@ -408,33 +1170,151 @@ func NewPushMessageContentGame(title string, isPinned bool) *PushMessageContentG
 	return &pushMessageContentGameTemp
 }
 
-// GetPushMessageContentEnum return the enum type of this object
-func (pushMessageContentGame *PushMessageContentGame) GetPushMessageContentEnum() PushMessageContentEnum {
-	return PushMessageContentGameType
+// MarshalJSON marshals to json
+func (pushMessageContentGame *PushMessageContentGame) MarshalJSON() ([]byte, error) {
+	return pushMessageContentGame.appendJSON(nil)
 }
 
-// PushMessageContentGameScore A new high score was achieved in a game
-type PushMessageContentGameScore struct {
-	tdCommon
-	Title    string `json:"title"`     // Game title, empty for pinned message
-	Score    int32  `json:"score"`     // New score, 0 for pinned message
-	IsPinned bool   `json:"is_pinned"` // True, if the message is a pinned message with the specified content
+// UnmarshalJSON unmarshal to json
+func (pushMessageContentGame *PushMessageContentGame) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushMessageContentGame)
 }
 
-// MessageType return the string telegram-type of PushMessageContentGameScore
-func (pushMessageContentGameScore *PushMessageContentGameScore) MessageType() string {
-	return "pushMessageContentGameScore"
+func (pushMessageContentGame *PushMessageContentGame) appendJSON(b []byte) ([]byte, error) {
+	if pushMessageContentGame == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushMessageContentGame.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushMessageContentGame.tdCommon.Extra)
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, pushMessageContentGame.Title)
+	b = append(b, `,"is_pinned":`...)
+	b = appendJSONBool(b, pushMessageContentGame.IsPinned)
+	return append(b, '}'), nil
 }
 
-// NewPushMessageContentGameScore creates a new PushMessageContentGameScore
-//
-// @param title Game title, empty for pinned message
-// @param score New score, 0 for pinned message
-// @param isPinned True, if the message is a pinned message with the specified content
-func NewPushMessageContentGameScore(title string, score int32, isPinned bool) *PushMessageContentGameScore {
-	pushMessageContentGameScoreTemp := PushMessageContentGameScore{
-		tdCommon: tdCommon{Type: "pushMessageContentGameScore"},
-		Title:    title,
+func (pushMessageContentGame *PushMessageContentGame) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushMessageContentGame.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushMessageContentGame.tdCommon.Extra, err = r.readString()
+		case "title":
+			pushMessageContentGame.Title, err = r.readString()
+		case "is_pinned":
+			pushMessageContentGame.IsPinned, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushMessageContentGame *PushMessageContentGame) Clone() *PushMessageContentGame {
+	if pushMessageContentGame == nil {
+		return nil
+	}
+	clone := *pushMessageContentGame
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushMessageContentGame *PushMessageContentGame) Equal(other *PushMessageContentGame) bool {
+	if pushMessageContentGame == nil || other == nil {
+		return pushMessageContentGame == other
+	}
+	if pushMessageContentGame.Title != other.Title {
+		return false
+	}
+	if pushMessageContentGame.IsPinned != other.IsPinned {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushMessageContentGame *PushMessageContentGame) Diff(other *PushMessageContentGame) []Change {
+	return pushMessageContentGame.diff("", other, nil)
+}
+
+func (pushMessageContentGame *PushMessageContentGame) diff(path string, other *PushMessageContentGame, changes []Change) []Change {
+	if pushMessageContentGame == nil || other == nil {
+		if pushMessageContentGame != other {
+			changes = append(changes, Change{Path: path, Old: pushMessageContentGame, New: other})
+		}
+		return changes
+	}
+	if pushMessageContentGame.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: pushMessageContentGame.Title, New: other.Title})
+	}
+	if pushMessageContentGame.IsPinned != other.IsPinned {
+		changes = append(changes, Change{Path: fieldPath(path, "is_pinned"), Old: pushMessageContentGame.IsPinned, New: other.IsPinned})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushMessageContentGame *PushMessageContentGame) String() string {
+	return objectString(pushMessageContentGame)
+}
+
+// LogValue logs the object as its summary
+func (pushMessageContentGame *PushMessageContentGame) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushMessageContentGame))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushMessageContentGame *PushMessageContentGame) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushMessageContentGame)
+}
+
+func (pushMessageContentGame *PushMessageContentGame) printText(p *printer) {
+	if pushMessageContentGame == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PushMessageContentGame")
+	p.string("title", pushMessageContentGame.Title)
+	p.bool("is_pinned", pushMessageContentGame.IsPinned)
+	p.endObject(state)
+}
+
+// GetPushMessageContentEnum return the enum type of this object
+func (pushMessageContentGame *PushMessageContentGame) GetPushMessageContentEnum() PushMessageContentEnum {
+	return PushMessageContentGameType
+}
+
+// Accept calls the method of the visitor for PushMessageContentGame
+func (pushMessageContentGame *PushMessageContentGame) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitPushMessageContentGame(pushMessageContentGame)
+}
+
+// PushMessageContentGameScore A new high score was achieved in a game
+type PushMessageContentGameScore struct {
+	tdCommon
+	Title    string `json:"title"`     // Game title, empty for pinned message
+	Score    int32  `json:"score"`     // New score, 0 for pinned message
+	IsPinned bool   `json:"is_pinned"` // True, if the message is a pinned message with the specified content
+}
+
+// MessageType return the string telegram-type of PushMessageContentGameScore
+func (pushMessageContentGameScore *PushMessageContentGameScore) MessageType() string {
+	return "pushMessageContentGameScore"
+}
+
+// NewPushMessageContentGameScore creates a new PushMessageContentGameScore
+//
+// @param title Game title, empty for pinned message
+// @param score New score, 0 for pinned message
+// @param isPinned True, if the message is a pinned message with the specified content
+func NewPushMessageContentGameScore(title string, score int32, isPinned bool) *PushMessageContentGameScore {
+	pushMessageContentGameScoreTemp := PushMessageContentGameScore{
+		tdCommon: tdCommon{Type: "pushMessageContentGameScore"},
+		Title:    title,
 		Score:    score,
 		IsPinned: isPinned,
 	}
@@ -442,11 +1322,140 @@ func NewPushMessageContentGameScore(title string, score int32, isPinned bool) *P
 	return &pushMessageContentGameScoreTemp
 }
 
+// MarshalJSON marshals to json
+func (pushMessageContentGameScore *PushMessageContentGameScore) MarshalJSON() ([]byte, error) {
+	return pushMessageContentGameScore.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pushMessageContentGameScore *PushMessageContentGameScore) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushMessageContentGameScore)
+}
+
+func (pushMessageContentGameScore *PushMessageContentGameScore) appendJSON(b []byte) ([]byte, error) {
+	if pushMessageContentGameScore == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushMessageContentGameScore.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushMessageContentGameScore.tdCommon.Extra)
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, pushMessageContentGameScore.Title)
+	b = append(b, `,"score":`...)
+	b = appendJSONInt(b, int64(pushMessageContentGameScore.Score))
+	b = append(b, `,"is_pinned":`...)
+	b = appendJSONBool(b, pushMessageContentGameScore.IsPinned)
+	return append(b, '}'), nil
+}
+
+func (pushMessageContentGameScore *PushMessageContentGameScore) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushMessageContentGameScore.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushMessageContentGameScore.tdCommon.Extra, err = r.readString()
+		case "title":
+			pushMessageContentGameScore.Title, err = r.readString()
+		case "score":
+			pushMessageContentGameScore.Score, err = r.readInt32()
+		case "is_pinned":
+			pushMessageContentGameScore.IsPinned, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushMessageContentGameScore *PushMessageContentGameScore) Clone() *PushMessageContentGameScore {
+	if pushMessageContentGameScore == nil {
+		return nil
+	}
+	clone := *pushMessageContentGameScore
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushMessageContentGameScore *PushMessageContentGameScore) Equal(other *PushMessageContentGameScore) bool {
+	if pushMessageContentGameScore == nil || other == nil {
+		return pushMessageContentGameScore == other
+	}
+	if pushMessageContentGameScore.Title != other.Title {
+		return false
+	}
+	if pushMessageContentGameScore.Score != other.Score {
+		return false
+	}
+	if pushMessageContentGameScore.IsPinned != other.IsPinned {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushMessageContentGameScore *PushMessageContentGameScore) Diff(other *PushMessageContentGameScore) []Change {
+	return pushMessageContentGameScore.diff("", other, nil)
+}
+
+func (pushMessageContentGameScore *PushMessageContentGameScore) diff(path string, other *PushMessageContentGameScore, changes []Change) []Change {
+	if pushMessageContentGameScore == nil || other == nil {
+		if pushMessageContentGameScore != other {
+			changes = append(changes, Change{Path: path, Old: pushMessageContentGameScore, New: other})
+		}
+		return changes
+	}
+	if pushMessageContentGameScore.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: pushMessageContentGameScore.Title, New: other.Title})
+	}
+	if pushMessageContentGameScore.Score != other.Score {
+		changes = append(changes, Change{Path: fieldPath(path, "score"), Old: pushMessageContentGameScore.Score, New: other.Score})
+	}
+	if pushMessageContentGameScore.IsPinned != other.IsPinned {
+		changes = append(changes, Change{Path: fieldPath(path, "is_pinned"), Old: pushMessageContentGameScore.IsPinned, New: other.IsPinned})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushMessageContentGameScore *PushMessageContentGameScore) String() string {
+	return objectString(pushMessageContentGameScore)
+}
+
+// LogValue logs the object as its summary
+func (pushMessageContentGameScore *PushMessageContentGameScore) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushMessageContentGameScore))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushMessageContentGameScore *PushMessageContentGameScore) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushMessageContentGameScore)
+}
+
+func (pushMessageContentGameScore *PushMessageContentGameScore) printText(p *printer) {
+	if pushMessageContentGameScore == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PushMessageContentGameScore")
+	p.string("title", pushMessageContentGameScore.Title)
+	p.int("score", int64(pushMessageContentGameScore.Score))
+	p.bool("is_pinned", pushMessageContentGameScore.IsPinned)
+	p.endObject(state)
+}
+
 // GetPushMessageContentEnum return the enum type of this object
 func (pushMessageContentGameScore *PushMessageContentGameScore) GetPushMessageContentEnum() PushMessageContentEnum {
 	return PushMessageContentGameScoreType
 }
 
+// Accept calls the method of the visitor for PushMessageContentGameScore
+func (pushMessageContentGameScore *PushMessageContentGameScore) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitPushMessageContentGameScore(pushMessageContentGameScore)
+}
+
 // PushMessageContentInvoice A message with an invoice from a bot
 type PushMessageContentInvoice struct {
 	tdCommon
@@ -473,11 +1482,129 @@ func NewPushMessageContentInvoice(price string, isPinned bool) *PushMessageConte
 	return &pushMessageContentInvoiceTemp
 }
 
+// MarshalJSON marshals to json
+func (pushMessageContentInvoice *PushMessageContentInvoice) MarshalJSON() ([]byte, error) {
+	return pushMessageContentInvoice.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pushMessageContentInvoice *PushMessageContentInvoice) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushMessageContentInvoice)
+}
+
+func (pushMessageContentInvoice *PushMessageContentInvoice) appendJSON(b []byte) ([]byte, error) {
+	if pushMessageContentInvoice == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushMessageContentInvoice.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushMessageContentInvoice.tdCommon.Extra)
+	b = append(b, `,"price":`...)
+	b = appendJSONString(b, pushMessageContentInvoice.Price)
+	b = append(b, `,"is_pinned":`...)
+	b = appendJSONBool(b, pushMessageContentInvoice.IsPinned)
+	return append(b, '}'), nil
+}
+
+func (pushMessageContentInvoice *PushMessageContentInvoice) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushMessageContentInvoice.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushMessageContentInvoice.tdCommon.Extra, err = r.readString()
+		case "price":
+			pushMessageContentInvoice.Price, err = r.readString()
+		case "is_pinned":
+			pushMessageContentInvoice.IsPinned, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushMessageContentInvoice *PushMessageContentInvoice) Clone() *PushMessageContentInvoice {
+	if pushMessageContentInvoice == nil {
+		return nil
+	}
+	clone := *pushMessageContentInvoice
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushMessageContentInvoice *PushMessageContentInvoice) Equal(other *PushMessageContentInvoice) bool {
+	if pushMessageContentInvoice == nil || other == nil {
+		return pushMessageContentInvoice == other
+	}
+	if pushMessageContentInvoice.Price != other.Price {
+		return false
+	}
+	if pushMessageContentInvoice.IsPinned != other.IsPinned {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushMessageContentInvoice *PushMessageContentInvoice) Diff(other *PushMessageContentInvoice) []Change {
+	return pushMessageContentInvoice.diff("", other, nil)
+}
+
+func (pushMessageContentInvoice *PushMessageContentInvoice) diff(path string, other *PushMessageContentInvoice, changes []Change) []Change {
+	if pushMessageContentInvoice == nil || other == nil {
+		if pushMessageContentInvoice != other {
+			changes = append(changes, Change{Path: path, Old: pushMessageContentInvoice, New: other})
+		}
+		return changes
+	}
+	if pushMessageContentInvoice.Price != other.Price {
+		changes = append(changes, Change{Path: fieldPath(path, "price"), Old: pushMessageContentInvoice.Price, New: other.Price})
+	}
+	if pushMessageContentInvoice.IsPinned != other.IsPinned {
+		changes = append(changes, Change{Path: fieldPath(path, "is_pinned"), Old: pushMessageContentInvoice.IsPinned, New: other.IsPinned})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushMessageContentInvoice *PushMessageContentInvoice) String() string {
+	return objectString(pushMessageContentInvoice)
+}
+
+// LogValue logs the object as its summary
+func (pushMessageContentInvoice *PushMessageContentInvoice) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushMessageContentInvoice))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushMessageContentInvoice *PushMessageContentInvoice) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushMessageContentInvoice)
+}
+
+func (pushMessageContentInvoice *PushMessageContentInvoice) printText(p *printer) {
+	if pushMessageContentInvoice == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PushMessageContentInvoice")
+	p.string("price", pushMessageContentInvoice.Price)
+	p.bool("is_pinned", pushMessageContentInvoice.IsPinned)
+	p.endObject(state)
+}
+
 // GetPushMessageContentEnum return the enum type of this object
 func (pushMessageContentInvoice *PushMessageContentInvoice) GetPushMessageContentEnum() PushMessageContentEnum {
 	return PushMessageContentInvoiceType
 }
 
+// Accept calls the method of the visitor for PushMessageContentInvoice
+func (pushMessageContentInvoice *PushMessageContentInvoice) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitPushMessageContentInvoice(pushMessageContentInvoice)
+}
+
 // PushMessageContentLocation A message with a location
 type PushMessageContentLocation struct {
 	tdCommon
@@ -504,11 +1631,129 @@ func NewPushMessageContentLocation(isLive bool, isPinned bool) *PushMessageConte
 	return &pushMessageContentLocationTemp
 }
 
+// MarshalJSON marshals to json
+func (pushMessageContentLocation *PushMessageContentLocation) MarshalJSON() ([]byte, error) {
+	return pushMessageContentLocation.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pushMessageContentLocation *PushMessageContentLocation) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushMessageContentLocation)
+}
+
+func (pushMessageContentLocation *PushMessageContentLocation) appendJSON(b []byte) ([]byte, error) {
+	if pushMessageContentLocation == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushMessageContentLocation.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushMessageContentLocation.tdCommon.Extra)
+	b = append(b, `,"is_live":`...)
+	b = appendJSONBool(b, pushMessageContentLocation.IsLive)
+	b = append(b, `,"is_pinned":`...)
+	b = appendJSONBool(b, pushMessageContentLocation.IsPinned)
+	return append(b, '}'), nil
+}
+
+func (pushMessageContentLocation *PushMessageContentLocation) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushMessageContentLocation.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushMessageContentLocation.tdCommon.Extra, err = r.readString()
+		case "is_live":
+			pushMessageContentLocation.IsLive, err = r.readBool()
+		case "is_pinned":
+			pushMessageContentLocation.IsPinned, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushMessageContentLocation *PushMessageContentLocation) Clone() *PushMessageContentLocation {
+	if pushMessageContentLocation == nil {
+		return nil
+	}
+	clone := *pushMessageContentLocation
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushMessageContentLocation *PushMessageContentLocation) Equal(other *PushMessageContentLocation) bool {
+	if pushMessageContentLocation == nil || other == nil {
+		return pushMessageContentLocation == other
+	}
+	if pushMessageContentLocation.IsLive != other.IsLive {
+		return false
+	}
+	if pushMessageContentLocation.IsPinned != other.IsPinned {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushMessageContentLocation *PushMessageContentLocation) Diff(other *PushMessageContentLocation) []Change {
+	return pushMessageContentLocation.diff("", other, nil)
+}
+
+func (pushMessageContentLocation *PushMessageContentLocation) diff(path string, other *PushMessageContentLocation, changes []Change) []Change {
+	if pushMessageContentLocation == nil || other == nil {
+		if pushMessageContentLocation != other {
+			changes = append(changes, Change{Path: path, Old: pushMessageContentLocation, New: other})
+		}
+		return changes
+	}
+	if pushMessageContentLocation.IsLive != other.IsLive {
+		changes = append(changes, Change{Path: fieldPath(path, "is_live"), Old: pushMessageContentLocation.IsLive, New: other.IsLive})
+	}
+	if pushMessageContentLocation.IsPinned != other.IsPinned {
+		changes = append(changes, Change{Path: fieldPath(path, "is_pinned"), Old: pushMessageContentLocation.IsPinned, New: other.IsPinned})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushMessageContentLocation *PushMessageContentLocation) String() string {
+	return objectString(pushMessageContentLocation)
+}
+
+// LogValue logs the object as its summary
+func (pushMessageContentLocation *PushMessageContentLocation) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushMessageContentLocation))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushMessageContentLocation *PushMessageContentLocation) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushMessageContentLocation)
+}
+
+func (pushMessageContentLocation *PushMessageContentLocation) printText(p *printer) {
+	if pushMessageContentLocation == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PushMessageContentLocation")
+	p.bool("is_live", pushMessageContentLocation.IsLive)
+	p.bool("is_pinned", pushMessageContentLocation.IsPinned)
+	p.endObject(state)
+}
+
 // GetPushMessageContentEnum return the enum type of this object
 func (pushMessageContentLocation *PushMessageContentLocation) GetPushMessageContentEnum() PushMessageContentEnum {
 	return PushMessageContentLocationType
 }
 
+// Accept calls the method of the visitor for PushMessageContentLocation
+func (pushMessageContentLocation *PushMessageContentLocation) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitPushMessageContentLocation(pushMessageContentLocation)
+}
+
 // PushMessageContentPhoto A photo message
 type PushMessageContentPhoto struct {
 	tdCommon
@@ -541,11 +1786,159 @@ func NewPushMessageContentPhoto(photo *Photo, caption string, isSecret bool, isP
 	return &pushMessageContentPhotoTemp
 }
 
+// MarshalJSON marshals to json
+func (pushMessageContentPhoto *PushMessageContentPhoto) MarshalJSON() ([]byte, error) {
+	return pushMessageContentPhoto.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pushMessageContentPhoto *PushMessageContentPhoto) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushMessageContentPhoto)
+}
+
+func (pushMessageContentPhoto *PushMessageContentPhoto) appendJSON(b []byte) ([]byte, error) {
+	if pushMessageContentPhoto == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushMessageContentPhoto.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushMessageContentPhoto.tdCommon.Extra)
+	b = append(b, `,"photo":`...)
+	b, err = pushMessageContentPhoto.Photo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"caption":`...)
+	b = appendJSONString(b, pushMessageContentPhoto.Caption)
+	b = append(b, `,"is_secret":`...)
+	b = appendJSONBool(b, pushMessageContentPhoto.IsSecret)
+	b = append(b, `,"is_pinned":`...)
+	b = appendJSONBool(b, pushMessageContentPhoto.IsPinned)
+	return append(b, '}'), nil
+}
+
+func (pushMessageContentPhoto *PushMessageContentPhoto) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushMessageContentPhoto.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushMessageContentPhoto.tdCommon.Extra, err = r.readString()
+		case "photo":
+			if r.readNull() {
+				pushMessageContentPhoto.Photo = nil
+			} else {
+				pushMessageContentPhoto.Photo = new(Photo)
+				err = pushMessageContentPhoto.Photo.decodeJSON(r)
+			}
+		case "caption":
+			pushMessageContentPhoto.Caption, err = r.readString()
+		case "is_secret":
+			pushMessageContentPhoto.IsSecret, err = r.readBool()
+		case "is_pinned":
+			pushMessageContentPhoto.IsPinned, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushMessageContentPhoto *PushMessageContentPhoto) Clone() *PushMessageContentPhoto {
+	if pushMessageContentPhoto == nil {
+		return nil
+	}
+	clone := *pushMessageContentPhoto
+	clone.Photo = pushMessageContentPhoto.Photo.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushMessageContentPhoto *PushMessageContentPhoto) Equal(other *PushMessageContentPhoto) bool {
+	if pushMessageContentPhoto == nil || other == nil {
+		return pushMessageContentPhoto == other
+	}
+	if !pushMessageContentPhoto.Photo.Equal(other.Photo) {
+		return false
+	}
+	if pushMessageContentPhoto.Caption != other.Caption {
+		return false
+	}
+	if pushMessageContentPhoto.IsSecret != other.IsSecret {
+		return false
+	}
+	if pushMessageContentPhoto.IsPinned != other.IsPinned {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushMessageContentPhoto *PushMessageContentPhoto) Diff(other *PushMessageContentPhoto) []Change {
+	return pushMessageContentPhoto.diff("", other, nil)
+}
+
+func (pushMessageContentPhoto *PushMessageContentPhoto) diff(path string, other *PushMessageContentPhoto, changes []Change) []Change {
+	if pushMessageContentPhoto == nil || other == nil {
+		if pushMessageContentPhoto != other {
+			changes = append(changes, Change{Path: path, Old: pushMessageContentPhoto, New: other})
+		}
+		return changes
+	}
+	changes = pushMessageContentPhoto.Photo.diff(fieldPath(path, "photo"), other.Photo, changes)
+	if pushMessageContentPhoto.Caption != other.Caption {
+		changes = append(changes, Change{Path: fieldPath(path, "caption"), Old: pushMessageContentPhoto.Caption, New: other.Caption})
+	}
+	if pushMessageContentPhoto.IsSecret != other.IsSecret {
+		changes = append(changes, Change{Path: fieldPath(path, "is_secret"), Old: pushMessageContentPhoto.IsSecret, New: other.IsSecret})
+	}
+	if pushMessageContentPhoto.IsPinned != other.IsPinned {
+		changes = append(changes, Change{Path: fieldPath(path, "is_pinned"), Old: pushMessageContentPhoto.IsPinned, New: other.IsPinned})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushMessageContentPhoto *PushMessageContentPhoto) String() string {
+	return objectString(pushMessageContentPhoto)
+}
+
+// LogValue logs the object as its summary
+func (pushMessageContentPhoto *PushMessageContentPhoto) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushMessageContentPhoto))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushMessageContentPhoto *PushMessageContentPhoto) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushMessageContentPhoto)
+}
+
+func (pushMessageContentPhoto *PushMessageContentPhoto) printText(p *printer) {
+	if pushMessageContentPhoto == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PushMessageContentPhoto")
+	p.object("photo", pushMessageContentPhoto.Photo)
+	p.string("caption", pushMessageContentPhoto.Caption)
+	p.bool("is_secret", pushMessageContentPhoto.IsSecret)
+	p.bool("is_pinned", pushMessageContentPhoto.IsPinned)
+	p.endObject(state)
+}
+
 // GetPushMessageContentEnum return the enum type of this object
 func (pushMessageContentPhoto *PushMessageContentPhoto) GetPushMessageContentEnum() PushMessageContentEnum {
 	return PushMessageContentPhotoType
 }
 
+// Accept calls the method of the visitor for PushMessageContentPhoto
+func (pushMessageContentPhoto *PushMessageContentPhoto) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitPushMessageContentPhoto(pushMessageContentPhoto)
+}
+
 // PushMessageContentPoll A message with a poll
 type PushMessageContentPoll struct {
 	tdCommon
@@ -575,11 +1968,140 @@ func NewPushMessageContentPoll(question string, isRegular bool, isPinned bool) *
 	return &pushMessageContentPollTemp
 }
 
+// MarshalJSON marshals to json
+func (pushMessageContentPoll *PushMessageContentPoll) MarshalJSON() ([]byte, error) {
+	return pushMessageContentPoll.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pushMessageContentPoll *PushMessageContentPoll) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushMessageContentPoll)
+}
+
+func (pushMessageContentPoll *PushMessageContentPoll) appendJSON(b []byte) ([]byte, error) {
+	if pushMessageContentPoll == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushMessageContentPoll.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushMessageContentPoll.tdCommon.Extra)
+	b = append(b, `,"question":`...)
+	b = appendJSONString(b, pushMessageContentPoll.Question)
+	b = append(b, `,"is_regular":`...)
+	b = appendJSONBool(b, pushMessageContentPoll.IsRegular)
+	b = append(b, `,"is_pinned":`...)
+	b = appendJSONBool(b, pushMessageContentPoll.IsPinned)
+	return append(b, '}'), nil
+}
+
+func (pushMessageContentPoll *PushMessageContentPoll) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushMessageContentPoll.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushMessageContentPoll.tdCommon.Extra, err = r.readString()
+		case "question":
+			pushMessageContentPoll.Question, err = r.readString()
+		case "is_regular":
+			pushMessageContentPoll.IsRegular, err = r.readBool()
+		case "is_pinned":
+			pushMessageContentPoll.IsPinned, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushMessageContentPoll *PushMessageContentPoll) Clone() *PushMessageContentPoll {
+	if pushMessageContentPoll == nil {
+		return nil
+	}
+	clone := *pushMessageContentPoll
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushMessageContentPoll *PushMessageContentPoll) Equal(other *PushMessageContentPoll) bool {
+	if pushMessageContentPoll == nil || other == nil {
+		return pushMessageContentPoll == other
+	}
+	if pushMessageContentPoll.Question != other.Question {
+		return false
+	}
+	if pushMessageContentPoll.IsRegular != other.IsRegular {
+		return false
+	}
+	if pushMessageContentPoll.IsPinned != other.IsPinned {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushMessageContentPoll *PushMessageContentPoll) Diff(other *PushMessageContentPoll) []Change {
+	return pushMessageContentPoll.diff("", other, nil)
+}
+
+func (pushMessageContentPoll *PushMessageContentPoll) diff(path string, other *PushMessageContentPoll, changes []Change) []Change {
+	if pushMessageContentPoll == nil || other == nil {
+		if pushMessageContentPoll != other {
+			changes = append(changes, Change{Path: path, Old: pushMessageContentPoll, New: other})
+		}
+		return changes
+	}
+	if pushMessageContentPoll.Question != other.Question {
+		changes = append(changes, Change{Path: fieldPath(path, "question"), Old: pushMessageContentPoll.Question, New: other.Question})
+	}
+	if pushMessageContentPoll.IsRegular != other.IsRegular {
+		changes = append(changes, Change{Path: fieldPath(path, "is_regular"), Old: pushMessageContentPoll.IsRegular, New: other.IsRegular})
+	}
+	if pushMessageContentPoll.IsPinned != other.IsPinned {
+		changes = append(changes, Change{Path: fieldPath(path, "is_pinned"), Old: pushMessageContentPoll.IsPinned, New: other.IsPinned})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushMessageContentPoll *PushMessageContentPoll) String() string {
+	return objectString(pushMessageContentPoll)
+}
+
+// LogValue logs the object as its summary
+func (pushMessageContentPoll *PushMessageContentPoll) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushMessageContentPoll))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushMessageContentPoll *PushMessageContentPoll) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushMessageContentPoll)
+}
+
+func (pushMessageContentPoll *PushMessageContentPoll) printText(p *printer) {
+	if pushMessageContentPoll == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PushMessageContentPoll")
+	p.string("question", pushMessageContentPoll.Question)
+	p.bool("is_regular", pushMessageContentPoll.IsRegular)
+	p.bool("is_pinned", pushMessageContentPoll.IsPinned)
+	p.endObject(state)
+}
+
 // GetPushMessageContentEnum return the enum type of this object
 func (pushMessageContentPoll *PushMessageContentPoll) GetPushMessageContentEnum() PushMessageContentEnum {
 	return PushMessageContentPollType
 }
 
+// Accept calls the method of the visitor for PushMessageContentPoll
+func (pushMessageContentPoll *PushMessageContentPoll) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitPushMessageContentPoll(pushMessageContentPoll)
+}
+
 // PushMessageContentScreenshotTaken A screenshot of a message in the chat has been taken
 type PushMessageContentScreenshotTaken struct {
 	tdCommon
@@ -591,7 +2113,6 @@ func (pushMessageContentScreenshotTaken *PushMessageContentScreenshotTaken) Mess
 }
 
 // NewPushMessageContentScreenshotTaken creates a new PushMessageContentScreenshotTaken
-//
 func NewPushMessageContentScreenshotTaken() *PushMessageContentScreenshotTaken {
 	pushMessageContentScreenshotTakenTemp := PushMessageContentScreenshotTaken{
 		tdCommon: tdCommon{Type: "pushMessageContentScreenshotTaken"},
@@ -600,26 +2121,122 @@ func NewPushMessageContentScreenshotTaken() *PushMessageContentScreenshotTaken {
 	return &pushMessageContentScreenshotTakenTemp
 }
 
-// GetPushMessageContentEnum return the enum type of this object
-func (pushMessageContentScreenshotTaken *PushMessageContentScreenshotTaken) GetPushMessageContentEnum() PushMessageContentEnum {
-	return PushMessageContentScreenshotTakenType
+// MarshalJSON marshals to json
+func (pushMessageContentScreenshotTaken *PushMessageContentScreenshotTaken) MarshalJSON() ([]byte, error) {
+	return pushMessageContentScreenshotTaken.appendJSON(nil)
 }
 
-// PushMessageContentSticker A message with a sticker
-type PushMessageContentSticker struct {
-	tdCommon
-	Sticker  *Sticker `json:"sticker"`   // Message content; may be null
-	Emoji    string   `json:"emoji"`     // Emoji corresponding to the sticker; may be empty
-	IsPinned bool     `json:"is_pinned"` // True, if the message is a pinned message with the specified content
+// UnmarshalJSON unmarshal to json
+func (pushMessageContentScreenshotTaken *PushMessageContentScreenshotTaken) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushMessageContentScreenshotTaken)
 }
 
-// MessageType return the string telegram-type of PushMessageContentSticker
-func (pushMessageContentSticker *PushMessageContentSticker) MessageType() string {
-	return "pushMessageContentSticker"
+func (pushMessageContentScreenshotTaken *PushMessageContentScreenshotTaken) appendJSON(b []byte) ([]byte, error) {
+	if pushMessageContentScreenshotTaken == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushMessageContentScreenshotTaken.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushMessageContentScreenshotTaken.tdCommon.Extra)
+	return append(b, '}'), nil
 }
 
-// NewPushMessageContentSticker creates a new PushMessageContentSticker
-//
+func (pushMessageContentScreenshotTaken *PushMessageContentScreenshotTaken) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushMessageContentScreenshotTaken.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushMessageContentScreenshotTaken.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushMessageContentScreenshotTaken *PushMessageContentScreenshotTaken) Clone() *PushMessageContentScreenshotTaken {
+	if pushMessageContentScreenshotTaken == nil {
+		return nil
+	}
+	clone := *pushMessageContentScreenshotTaken
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushMessageContentScreenshotTaken *PushMessageContentScreenshotTaken) Equal(other *PushMessageContentScreenshotTaken) bool {
+	if pushMessageContentScreenshotTaken == nil || other == nil {
+		return pushMessageContentScreenshotTaken == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushMessageContentScreenshotTaken *PushMessageContentScreenshotTaken) Diff(other *PushMessageContentScreenshotTaken) []Change {
+	return pushMessageContentScreenshotTaken.diff("", other, nil)
+}
+
+func (pushMessageContentScreenshotTaken *PushMessageContentScreenshotTaken) diff(path string, other *PushMessageContentScreenshotTaken, changes []Change) []Change {
+	if pushMessageContentScreenshotTaken == nil || other == nil {
+		if pushMessageContentScreenshotTaken != other {
+			changes = append(changes, Change{Path: path, Old: pushMessageContentScreenshotTaken, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushMessageContentScreenshotTaken *PushMessageContentScreenshotTaken) String() string {
+	return objectString(pushMessageContentScreenshotTaken)
+}
+
+// LogValue logs the object as its summary
+func (pushMessageContentScreenshotTaken *PushMessageContentScreenshotTaken) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushMessageContentScreenshotTaken))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushMessageContentScreenshotTaken *PushMessageContentScreenshotTaken) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushMessageContentScreenshotTaken)
+}
+
+func (pushMessageContentScreenshotTaken *PushMessageContentScreenshotTaken) printText(p *printer) {
+	if pushMessageContentScreenshotTaken == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PushMessageContentScreenshotTaken")
+	p.endObject(state)
+}
+
+// GetPushMessageContentEnum return the enum type of this object
+func (pushMessageContentScreenshotTaken *PushMessageContentScreenshotTaken) GetPushMessageContentEnum() PushMessageContentEnum {
+	return PushMessageContentScreenshotTakenType
+}
+
+// Accept calls the method of the visitor for PushMessageContentScreenshotTaken
+func (pushMessageContentScreenshotTaken *PushMessageContentScreenshotTaken) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitPushMessageContentScreenshotTaken(pushMessageContentScreenshotTaken)
+}
+
+// PushMessageContentSticker A message with a sticker
+type PushMessageContentSticker struct {
+	tdCommon
+	Sticker  *Sticker `json:"sticker"`   // Message content; may be null
+	Emoji    string   `json:"emoji"`     // Emoji corresponding to the sticker; may be empty
+	IsPinned bool     `json:"is_pinned"` // True, if the message is a pinned message with the specified content
+}
+
+// MessageType return the string telegram-type of PushMessageContentSticker
+func (pushMessageContentSticker *PushMessageContentSticker) MessageType() string {
+	return "pushMessageContentSticker"
+}
+
+// NewPushMessageContentSticker creates a new PushMessageContentSticker
+//
 // @param sticker Message content; may be null
 // @param emoji Emoji corresponding to the sticker; may be empty
 // @param isPinned True, if the message is a pinned message with the specified content
@@ -634,11 +2251,148 @@ func NewPushMessageContentSticker(sticker *Sticker, emoji string, isPinned bool)
 	return &pushMessageContentStickerTemp
 }
 
+// MarshalJSON marshals to json
+func (pushMessageContentSticker *PushMessageContentSticker) MarshalJSON() ([]byte, error) {
+	return pushMessageContentSticker.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pushMessageContentSticker *PushMessageContentSticker) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushMessageContentSticker)
+}
+
+func (pushMessageContentSticker *PushMessageContentSticker) appendJSON(b []byte) ([]byte, error) {
+	if pushMessageContentSticker == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushMessageContentSticker.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushMessageContentSticker.tdCommon.Extra)
+	b = append(b, `,"sticker":`...)
+	b, err = pushMessageContentSticker.Sticker.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"emoji":`...)
+	b = appendJSONString(b, pushMessageContentSticker.Emoji)
+	b = append(b, `,"is_pinned":`...)
+	b = appendJSONBool(b, pushMessageContentSticker.IsPinned)
+	return append(b, '}'), nil
+}
+
+func (pushMessageContentSticker *PushMessageContentSticker) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushMessageContentSticker.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushMessageContentSticker.tdCommon.Extra, err = r.readString()
+		case "sticker":
+			if r.readNull() {
+				pushMessageContentSticker.Sticker = nil
+			} else {
+				pushMessageContentSticker.Sticker = new(Sticker)
+				err = pushMessageContentSticker.Sticker.decodeJSON(r)
+			}
+		case "emoji":
+			pushMessageContentSticker.Emoji, err = r.readString()
+		case "is_pinned":
+			pushMessageContentSticker.IsPinned, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushMessageContentSticker *PushMessageContentSticker) Clone() *PushMessageContentSticker {
+	if pushMessageContentSticker == nil {
+		return nil
+	}
+	clone := *pushMessageContentSticker
+	clone.Sticker = pushMessageContentSticker.Sticker.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushMessageContentSticker *PushMessageContentSticker) Equal(other *PushMessageContentSticker) bool {
+	if pushMessageContentSticker == nil || other == nil {
+		return pushMessageContentSticker == other
+	}
+	if !pushMessageContentSticker.Sticker.Equal(other.Sticker) {
+		return false
+	}
+	if pushMessageContentSticker.Emoji != other.Emoji {
+		return false
+	}
+	if pushMessageContentSticker.IsPinned != other.IsPinned {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushMessageContentSticker *PushMessageContentSticker) Diff(other *PushMessageContentSticker) []Change {
+	return pushMessageContentSticker.diff("", other, nil)
+}
+
+func (pushMessageContentSticker *PushMessageContentSticker) diff(path string, other *PushMessageContentSticker, changes []Change) []Change {
+	if pushMessageContentSticker == nil || other == nil {
+		if pushMessageContentSticker != other {
+			changes = append(changes, Change{Path: path, Old: pushMessageContentSticker, New: other})
+		}
+		return changes
+	}
+	changes = pushMessageContentSticker.Sticker.diff(fieldPath(path, "sticker"), other.Sticker, changes)
+	if pushMessageContentSticker.Emoji != other.Emoji {
+		changes = append(changes, Change{Path: fieldPath(path, "emoji"), Old: pushMessageContentSticker.Emoji, New: other.Emoji})
+	}
+	if pushMessageContentSticker.IsPinned != other.IsPinned {
+		changes = append(changes, Change{Path: fieldPath(path, "is_pinned"), Old: pushMessageContentSticker.IsPinned, New: other.IsPinned})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushMessageContentSticker *PushMessageContentSticker) String() string {
+	return objectString(pushMessageContentSticker)
+}
+
+// LogValue logs the object as its summary
+func (pushMessageContentSticker *PushMessageContentSticker) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushMessageContentSticker))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushMessageContentSticker *PushMessageContentSticker) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushMessageContentSticker)
+}
+
+func (pushMessageContentSticker *PushMessageContentSticker) printText(p *printer) {
+	if pushMessageContentSticker == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PushMessageContentSticker")
+	p.object("sticker", pushMessageContentSticker.Sticker)
+	p.string("emoji", pushMessageContentSticker.Emoji)
+	p.bool("is_pinned", pushMessageContentSticker.IsPinned)
+	p.endObject(state)
+}
+
 // GetPushMessageContentEnum return the enum type of this object
 func (pushMessageContentSticker *PushMessageContentSticker) GetPushMessageContentEnum() PushMessageContentEnum {
 	return PushMessageContentStickerType
 }
 
+// Accept calls the method of the visitor for PushMessageContentSticker
+func (pushMessageContentSticker *PushMessageContentSticker) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitPushMessageContentSticker(pushMessageContentSticker)
+}
+
 // PushMessageContentText A text message
 type PushMessageContentText struct {
 	tdCommon
@@ -665,11 +2419,129 @@ func NewPushMessageContentText(text string, isPinned bool) *PushMessageContentTe
 	return &pushMessageContentTextTemp
 }
 
+// MarshalJSON marshals to json
+func (pushMessageContentText *PushMessageContentText) MarshalJSON() ([]byte, error) {
+	return pushMessageContentText.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pushMessageContentText *PushMessageContentText) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushMessageContentText)
+}
+
+func (pushMessageContentText *PushMessageContentText) appendJSON(b []byte) ([]byte, error) {
+	if pushMessageContentText == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushMessageContentText.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushMessageContentText.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b = appendJSONString(b, pushMessageContentText.Text)
+	b = append(b, `,"is_pinned":`...)
+	b = appendJSONBool(b, pushMessageContentText.IsPinned)
+	return append(b, '}'), nil
+}
+
+func (pushMessageContentText *PushMessageContentText) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushMessageContentText.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushMessageContentText.tdCommon.Extra, err = r.readString()
+		case "text":
+			pushMessageContentText.Text, err = r.readString()
+		case "is_pinned":
+			pushMessageContentText.IsPinned, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushMessageContentText *PushMessageContentText) Clone() *PushMessageContentText {
+	if pushMessageContentText == nil {
+		return nil
+	}
+	clone := *pushMessageContentText
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushMessageContentText *PushMessageContentText) Equal(other *PushMessageContentText) bool {
+	if pushMessageContentText == nil || other == nil {
+		return pushMessageContentText == other
+	}
+	if pushMessageContentText.Text != other.Text {
+		return false
+	}
+	if pushMessageContentText.IsPinned != other.IsPinned {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushMessageContentText *PushMessageContentText) Diff(other *PushMessageContentText) []Change {
+	return pushMessageContentText.diff("", other, nil)
+}
+
+func (pushMessageContentText *PushMessageContentText) diff(path string, other *PushMessageContentText, changes []Change) []Change {
+	if pushMessageContentText == nil || other == nil {
+		if pushMessageContentText != other {
+			changes = append(changes, Change{Path: path, Old: pushMessageContentText, New: other})
+		}
+		return changes
+	}
+	if pushMessageContentText.Text != other.Text {
+		changes = append(changes, Change{Path: fieldPath(path, "text"), Old: pushMessageContentText.Text, New: other.Text})
+	}
+	if pushMessageContentText.IsPinned != other.IsPinned {
+		changes = append(changes, Change{Path: fieldPath(path, "is_pinned"), Old: pushMessageContentText.IsPinned, New: other.IsPinned})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushMessageContentText *PushMessageContentText) String() string {
+	return objectString(pushMessageContentText)
+}
+
+// LogValue logs the object as its summary
+func (pushMessageContentText *PushMessageContentText) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushMessageContentText))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushMessageContentText *PushMessageContentText) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushMessageContentText)
+}
+
+func (pushMessageContentText *PushMessageContentText) printText(p *printer) {
+	if pushMessageContentText == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PushMessageContentText")
+	p.string("text", pushMessageContentText.Text)
+	p.bool("is_pinned", pushMessageContentText.IsPinned)
+	p.endObject(state)
+}
+
 // GetPushMessageContentEnum return the enum type of this object
 func (pushMessageContentText *PushMessageContentText) GetPushMessageContentEnum() PushMessageContentEnum {
 	return PushMessageContentTextType
 }
 
+// Accept calls the method of the visitor for PushMessageContentText
+func (pushMessageContentText *PushMessageContentText) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitPushMessageContentText(pushMessageContentText)
+}
+
 // PushMessageContentVideo A video message
 type PushMessageContentVideo struct {
 	tdCommon
@@ -702,11 +2574,159 @@ func NewPushMessageContentVideo(video *Video, caption string, isSecret bool, isP
 	return &pushMessageContentVideoTemp
 }
 
+// MarshalJSON marshals to json
+func (pushMessageContentVideo *PushMessageContentVideo) MarshalJSON() ([]byte, error) {
+	return pushMessageContentVideo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pushMessageContentVideo *PushMessageContentVideo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushMessageContentVideo)
+}
+
+func (pushMessageContentVideo *PushMessageContentVideo) appendJSON(b []byte) ([]byte, error) {
+	if pushMessageContentVideo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushMessageContentVideo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushMessageContentVideo.tdCommon.Extra)
+	b = append(b, `,"video":`...)
+	b, err = pushMessageContentVideo.Video.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"caption":`...)
+	b = appendJSONString(b, pushMessageContentVideo.Caption)
+	b = append(b, `,"is_secret":`...)
+	b = appendJSONBool(b, pushMessageContentVideo.IsSecret)
+	b = append(b, `,"is_pinned":`...)
+	b = appendJSONBool(b, pushMessageContentVideo.IsPinned)
+	return append(b, '}'), nil
+}
+
+func (pushMessageContentVideo *PushMessageContentVideo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushMessageContentVideo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushMessageContentVideo.tdCommon.Extra, err = r.readString()
+		case "video":
+			if r.readNull() {
+				pushMessageContentVideo.Video = nil
+			} else {
+				pushMessageContentVideo.Video = new(Video)
+				err = pushMessageContentVideo.Video.decodeJSON(r)
+			}
+		case "caption":
+			pushMessageContentVideo.Caption, err = r.readString()
+		case "is_secret":
+			pushMessageContentVideo.IsSecret, err = r.readBool()
+		case "is_pinned":
+			pushMessageContentVideo.IsPinned, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushMessageContentVideo *PushMessageContentVideo) Clone() *PushMessageContentVideo {
+	if pushMessageContentVideo == nil {
+		return nil
+	}
+	clone := *pushMessageContentVideo
+	clone.Video = pushMessageContentVideo.Video.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushMessageContentVideo *PushMessageContentVideo) Equal(other *PushMessageContentVideo) bool {
+	if pushMessageContentVideo == nil || other == nil {
+		return pushMessageContentVideo == other
+	}
+	if !pushMessageContentVideo.Video.Equal(other.Video) {
+		return false
+	}
+	if pushMessageContentVideo.Caption != other.Caption {
+		return false
+	}
+	if pushMessageContentVideo.IsSecret != other.IsSecret {
+		return false
+	}
+	if pushMessageContentVideo.IsPinned != other.IsPinned {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushMessageContentVideo *PushMessageContentVideo) Diff(other *PushMessageContentVideo) []Change {
+	return pushMessageContentVideo.diff("", other, nil)
+}
+
+func (pushMessageContentVideo *PushMessageContentVideo) diff(path string, other *PushMessageContentVideo, changes []Change) []Change {
+	if pushMessageContentVideo == nil || other == nil {
+		if pushMessageContentVideo != other {
+			changes = append(changes, Change{Path: path, Old: pushMessageContentVideo, New: other})
+		}
+		return changes
+	}
+	changes = pushMessageContentVideo.Video.diff(fieldPath(path, "video"), other.Video, changes)
+	if pushMessageContentVideo.Caption != other.Caption {
+		changes = append(changes, Change{Path: fieldPath(path, "caption"), Old: pushMessageContentVideo.Caption, New: other.Caption})
+	}
+	if pushMessageContentVideo.IsSecret != other.IsSecret {
+		changes = append(changes, Change{Path: fieldPath(path, "is_secret"), Old: pushMessageContentVideo.IsSecret, New: other.IsSecret})
+	}
+	if pushMessageContentVideo.IsPinned != other.IsPinned {
+		changes = append(changes, Change{Path: fieldPath(path, "is_pinned"), Old: pushMessageContentVideo.IsPinned, New: other.IsPinned})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushMessageContentVideo *PushMessageContentVideo) String() string {
+	return objectString(pushMessageContentVideo)
+}
+
+// LogValue logs the object as its summary
+func (pushMessageContentVideo *PushMessageContentVideo) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushMessageContentVideo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushMessageContentVideo *PushMessageContentVideo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushMessageContentVideo)
+}
+
+func (pushMessageContentVideo *PushMessageContentVideo) printText(p *printer) {
+	if pushMessageContentVideo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PushMessageContentVideo")
+	p.object("video", pushMessageContentVideo.Video)
+	p.string("caption", pushMessageContentVideo.Caption)
+	p.bool("is_secret", pushMessageContentVideo.IsSecret)
+	p.bool("is_pinned", pushMessageContentVideo.IsPinned)
+	p.endObject(state)
+}
+
 // GetPushMessageContentEnum return the enum type of this object
 func (pushMessageContentVideo *PushMessageContentVideo) GetPushMessageContentEnum() PushMessageContentEnum {
 	return PushMessageContentVideoType
 }
 
+// Accept calls the method of the visitor for PushMessageContentVideo
+func (pushMessageContentVideo *PushMessageContentVideo) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitPushMessageContentVideo(pushMessageContentVideo)
+}
+
 // PushMessageContentVideoNote A video note message
 type PushMessageContentVideoNote struct {
 	tdCommon
@@ -733,11 +2753,137 @@ func NewPushMessageContentVideoNote(videoNote *VideoNote, isPinned bool) *PushMe
 	return &pushMessageContentVideoNoteTemp
 }
 
+// MarshalJSON marshals to json
+func (pushMessageContentVideoNote *PushMessageContentVideoNote) MarshalJSON() ([]byte, error) {
+	return pushMessageContentVideoNote.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pushMessageContentVideoNote *PushMessageContentVideoNote) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushMessageContentVideoNote)
+}
+
+func (pushMessageContentVideoNote *PushMessageContentVideoNote) appendJSON(b []byte) ([]byte, error) {
+	if pushMessageContentVideoNote == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushMessageContentVideoNote.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushMessageContentVideoNote.tdCommon.Extra)
+	b = append(b, `,"video_note":`...)
+	b, err = pushMessageContentVideoNote.VideoNote.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"is_pinned":`...)
+	b = appendJSONBool(b, pushMessageContentVideoNote.IsPinned)
+	return append(b, '}'), nil
+}
+
+func (pushMessageContentVideoNote *PushMessageContentVideoNote) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushMessageContentVideoNote.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushMessageContentVideoNote.tdCommon.Extra, err = r.readString()
+		case "video_note":
+			if r.readNull() {
+				pushMessageContentVideoNote.VideoNote = nil
+			} else {
+				pushMessageContentVideoNote.VideoNote = new(VideoNote)
+				err = pushMessageContentVideoNote.VideoNote.decodeJSON(r)
+			}
+		case "is_pinned":
+			pushMessageContentVideoNote.IsPinned, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushMessageContentVideoNote *PushMessageContentVideoNote) Clone() *PushMessageContentVideoNote {
+	if pushMessageContentVideoNote == nil {
+		return nil
+	}
+	clone := *pushMessageContentVideoNote
+	clone.VideoNote = pushMessageContentVideoNote.VideoNote.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushMessageContentVideoNote *PushMessageContentVideoNote) Equal(other *PushMessageContentVideoNote) bool {
+	if pushMessageContentVideoNote == nil || other == nil {
+		return pushMessageContentVideoNote == other
+	}
+	if !pushMessageContentVideoNote.VideoNote.Equal(other.VideoNote) {
+		return false
+	}
+	if pushMessageContentVideoNote.IsPinned != other.IsPinned {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushMessageContentVideoNote *PushMessageContentVideoNote) Diff(other *PushMessageContentVideoNote) []Change {
+	return pushMessageContentVideoNote.diff("", other, nil)
+}
+
+func (pushMessageContentVideoNote *PushMessageContentVideoNote) diff(path string, other *PushMessageContentVideoNote, changes []Change) []Change {
+	if pushMessageContentVideoNote == nil || other == nil {
+		if pushMessageContentVideoNote != other {
+			changes = append(changes, Change{Path: path, Old: pushMessageContentVideoNote, New: other})
+		}
+		return changes
+	}
+	changes = pushMessageContentVideoNote.VideoNote.diff(fieldPath(path, "video_note"), other.VideoNote, changes)
+	if pushMessageContentVideoNote.IsPinned != other.IsPinned {
+		changes = append(changes, Change{Path: fieldPath(path, "is_pinned"), Old: pushMessageContentVideoNote.IsPinned, New: other.IsPinned})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushMessageContentVideoNote *PushMessageContentVideoNote) String() string {
+	return objectString(pushMessageContentVideoNote)
+}
+
+// LogValue logs the object as its summary
+func (pushMessageContentVideoNote *PushMessageContentVideoNote) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushMessageContentVideoNote))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushMessageContentVideoNote *PushMessageContentVideoNote) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushMessageContentVideoNote)
+}
+
+func (pushMessageContentVideoNote *PushMessageContentVideoNote) printText(p *printer) {
+	if pushMessageContentVideoNote == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PushMessageContentVideoNote")
+	p.object("video_note", pushMessageContentVideoNote.VideoNote)
+	p.bool("is_pinned", pushMessageContentVideoNote.IsPinned)
+	p.endObject(state)
+}
+
 // GetPushMessageContentEnum return the enum type of this object
 func (pushMessageContentVideoNote *PushMessageContentVideoNote) GetPushMessageContentEnum() PushMessageContentEnum {
 	return PushMessageContentVideoNoteType
 }
 
+// Accept calls the method of the visitor for PushMessageContentVideoNote
+func (pushMessageContentVideoNote *PushMessageContentVideoNote) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitPushMessageContentVideoNote(pushMessageContentVideoNote)
+}
+
 // PushMessageContentVoiceNote A voice note message
 type PushMessageContentVoiceNote struct {
 	tdCommon
@@ -764,11 +2910,137 @@ func NewPushMessageContentVoiceNote(voiceNote *VoiceNote, isPinned bool) *PushMe
 	return &pushMessageContentVoiceNoteTemp
 }
 
+// MarshalJSON marshals to json
+func (pushMessageContentVoiceNote *PushMessageContentVoiceNote) MarshalJSON() ([]byte, error) {
+	return pushMessageContentVoiceNote.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pushMessageContentVoiceNote *PushMessageContentVoiceNote) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushMessageContentVoiceNote)
+}
+
+func (pushMessageContentVoiceNote *PushMessageContentVoiceNote) appendJSON(b []byte) ([]byte, error) {
+	if pushMessageContentVoiceNote == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushMessageContentVoiceNote.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushMessageContentVoiceNote.tdCommon.Extra)
+	b = append(b, `,"voice_note":`...)
+	b, err = pushMessageContentVoiceNote.VoiceNote.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"is_pinned":`...)
+	b = appendJSONBool(b, pushMessageContentVoiceNote.IsPinned)
+	return append(b, '}'), nil
+}
+
+func (pushMessageContentVoiceNote *PushMessageContentVoiceNote) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushMessageContentVoiceNote.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushMessageContentVoiceNote.tdCommon.Extra, err = r.readString()
+		case "voice_note":
+			if r.readNull() {
+				pushMessageContentVoiceNote.VoiceNote = nil
+			} else {
+				pushMessageContentVoiceNote.VoiceNote = new(VoiceNote)
+				err = pushMessageContentVoiceNote.VoiceNote.decodeJSON(r)
+			}
+		case "is_pinned":
+			pushMessageContentVoiceNote.IsPinned, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushMessageContentVoiceNote *PushMessageContentVoiceNote) Clone() *PushMessageContentVoiceNote {
+	if pushMessageContentVoiceNote == nil {
+		return nil
+	}
+	clone := *pushMessageContentVoiceNote
+	clone.VoiceNote = pushMessageContentVoiceNote.VoiceNote.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushMessageContentVoiceNote *PushMessageContentVoiceNote) Equal(other *PushMessageContentVoiceNote) bool {
+	if pushMessageContentVoiceNote == nil || other == nil {
+		return pushMessageContentVoiceNote == other
+	}
+	if !pushMessageContentVoiceNote.VoiceNote.Equal(other.VoiceNote) {
+		return false
+	}
+	if pushMessageContentVoiceNote.IsPinned != other.IsPinned {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushMessageContentVoiceNote *PushMessageContentVoiceNote) Diff(other *PushMessageContentVoiceNote) []Change {
+	return pushMessageContentVoiceNote.diff("", other, nil)
+}
+
+func (pushMessageContentVoiceNote *PushMessageContentVoiceNote) diff(path string, other *PushMessageContentVoiceNote, changes []Change) []Change {
+	if pushMessageContentVoiceNote == nil || other == nil {
+		if pushMessageContentVoiceNote != other {
+			changes = append(changes, Change{Path: path, Old: pushMessageContentVoiceNote, New: other})
+		}
+		return changes
+	}
+	changes = pushMessageContentVoiceNote.VoiceNote.diff(fieldPath(path, "voice_note"), other.VoiceNote, changes)
+	if pushMessageContentVoiceNote.IsPinned != other.IsPinned {
+		changes = append(changes, Change{Path: fieldPath(path, "is_pinned"), Old: pushMessageContentVoiceNote.IsPinned, New: other.IsPinned})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushMessageContentVoiceNote *PushMessageContentVoiceNote) String() string {
+	return objectString(pushMessageContentVoiceNote)
+}
+
+// LogValue logs the object as its summary
+func (pushMessageContentVoiceNote *PushMessageContentVoiceNote) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushMessageContentVoiceNote))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushMessageContentVoiceNote *PushMessageContentVoiceNote) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushMessageContentVoiceNote)
+}
+
+func (pushMessageContentVoiceNote *PushMessageContentVoiceNote) printText(p *printer) {
+	if pushMessageContentVoiceNote == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PushMessageContentVoiceNote")
+	p.object("voice_note", pushMessageContentVoiceNote.VoiceNote)
+	p.bool("is_pinned", pushMessageContentVoiceNote.IsPinned)
+	p.endObject(state)
+}
+
 // GetPushMessageContentEnum return the enum type of this object
 func (pushMessageContentVoiceNote *PushMessageContentVoiceNote) GetPushMessageContentEnum() PushMessageContentEnum {
 	return PushMessageContentVoiceNoteType
 }
 
+// Accept calls the method of the visitor for PushMessageContentVoiceNote
+func (pushMessageContentVoiceNote *PushMessageContentVoiceNote) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitPushMessageContentVoiceNote(pushMessageContentVoiceNote)
+}
+
 // PushMessageContentBasicGroupChatCreate A newly created basic group
 type PushMessageContentBasicGroupChatCreate struct {
 	tdCommon
@@ -780,7 +3052,6 @@ func (pushMessageContentBasicGroupChatCreate *PushMessageContentBasicGroupChatCr
 }
 
 // NewPushMessageContentBasicGroupChatCreate creates a new PushMessageContentBasicGroupChatCreate
-//
 func NewPushMessageContentBasicGroupChatCreate() *PushMessageContentBasicGroupChatCreate {
 	pushMessageContentBasicGroupChatCreateTemp := PushMessageContentBasicGroupChatCreate{
 		tdCommon: tdCommon{Type: "pushMessageContentBasicGroupChatCreate"},
@@ -789,11 +3060,107 @@ func NewPushMessageContentBasicGroupChatCreate() *PushMessageContentBasicGroupCh
 	return &pushMessageContentBasicGroupChatCreateTemp
 }
 
+// MarshalJSON marshals to json
+func (pushMessageContentBasicGroupChatCreate *PushMessageContentBasicGroupChatCreate) MarshalJSON() ([]byte, error) {
+	return pushMessageContentBasicGroupChatCreate.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pushMessageContentBasicGroupChatCreate *PushMessageContentBasicGroupChatCreate) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushMessageContentBasicGroupChatCreate)
+}
+
+func (pushMessageContentBasicGroupChatCreate *PushMessageContentBasicGroupChatCreate) appendJSON(b []byte) ([]byte, error) {
+	if pushMessageContentBasicGroupChatCreate == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushMessageContentBasicGroupChatCreate.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushMessageContentBasicGroupChatCreate.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (pushMessageContentBasicGroupChatCreate *PushMessageContentBasicGroupChatCreate) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushMessageContentBasicGroupChatCreate.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushMessageContentBasicGroupChatCreate.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushMessageContentBasicGroupChatCreate *PushMessageContentBasicGroupChatCreate) Clone() *PushMessageContentBasicGroupChatCreate {
+	if pushMessageContentBasicGroupChatCreate == nil {
+		return nil
+	}
+	clone := *pushMessageContentBasicGroupChatCreate
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushMessageContentBasicGroupChatCreate *PushMessageContentBasicGroupChatCreate) Equal(other *PushMessageContentBasicGroupChatCreate) bool {
+	if pushMessageContentBasicGroupChatCreate == nil || other == nil {
+		return pushMessageContentBasicGroupChatCreate == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushMessageContentBasicGroupChatCreate *PushMessageContentBasicGroupChatCreate) Diff(other *PushMessageContentBasicGroupChatCreate) []Change {
+	return pushMessageContentBasicGroupChatCreate.diff("", other, nil)
+}
+
+func (pushMessageContentBasicGroupChatCreate *PushMessageContentBasicGroupChatCreate) diff(path string, other *PushMessageContentBasicGroupChatCreate, changes []Change) []Change {
+	if pushMessageContentBasicGroupChatCreate == nil || other == nil {
+		if pushMessageContentBasicGroupChatCreate != other {
+			changes = append(changes, Change{Path: path, Old: pushMessageContentBasicGroupChatCreate, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushMessageContentBasicGroupChatCreate *PushMessageContentBasicGroupChatCreate) String() string {
+	return objectString(pushMessageContentBasicGroupChatCreate)
+}
+
+// LogValue logs the object as its summary
+func (pushMessageContentBasicGroupChatCreate *PushMessageContentBasicGroupChatCreate) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushMessageContentBasicGroupChatCreate))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushMessageContentBasicGroupChatCreate *PushMessageContentBasicGroupChatCreate) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushMessageContentBasicGroupChatCreate)
+}
+
+func (pushMessageContentBasicGroupChatCreate *PushMessageContentBasicGroupChatCreate) printText(p *printer) {
+	if pushMessageContentBasicGroupChatCreate == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PushMessageContentBasicGroupChatCreate")
+	p.endObject(state)
+}
+
 // GetPushMessageContentEnum return the enum type of this object
 func (pushMessageContentBasicGroupChatCreate *PushMessageContentBasicGroupChatCreate) GetPushMessageContentEnum() PushMessageContentEnum {
 	return PushMessageContentBasicGroupChatCreateType
 }
 
+// Accept calls the method of the visitor for PushMessageContentBasicGroupChatCreate
+func (pushMessageContentBasicGroupChatCreate *PushMessageContentBasicGroupChatCreate) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitPushMessageContentBasicGroupChatCreate(pushMessageContentBasicGroupChatCreate)
+}
+
 // PushMessageContentChatAddMembers New chat members were invited to a group
 type PushMessageContentChatAddMembers struct {
 	tdCommon
@@ -823,11 +3190,140 @@ func NewPushMessageContentChatAddMembers(memberName string, isCurrentUser bool,
 	return &pushMessageContentChatAddMembersTemp
 }
 
+// MarshalJSON marshals to json
+func (pushMessageContentChatAddMembers *PushMessageContentChatAddMembers) MarshalJSON() ([]byte, error) {
+	return pushMessageContentChatAddMembers.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pushMessageContentChatAddMembers *PushMessageContentChatAddMembers) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushMessageContentChatAddMembers)
+}
+
+func (pushMessageContentChatAddMembers *PushMessageContentChatAddMembers) appendJSON(b []byte) ([]byte, error) {
+	if pushMessageContentChatAddMembers == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushMessageContentChatAddMembers.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushMessageContentChatAddMembers.tdCommon.Extra)
+	b = append(b, `,"member_name":`...)
+	b = appendJSONString(b, pushMessageContentChatAddMembers.MemberName)
+	b = append(b, `,"is_current_user":`...)
+	b = appendJSONBool(b, pushMessageContentChatAddMembers.IsCurrentUser)
+	b = append(b, `,"is_returned":`...)
+	b = appendJSONBool(b, pushMessageContentChatAddMembers.IsReturned)
+	return append(b, '}'), nil
+}
+
+func (pushMessageContentChatAddMembers *PushMessageContentChatAddMembers) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushMessageContentChatAddMembers.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushMessageContentChatAddMembers.tdCommon.Extra, err = r.readString()
+		case "member_name":
+			pushMessageContentChatAddMembers.MemberName, err = r.readString()
+		case "is_current_user":
+			pushMessageContentChatAddMembers.IsCurrentUser, err = r.readBool()
+		case "is_returned":
+			pushMessageContentChatAddMembers.IsReturned, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushMessageContentChatAddMembers *PushMessageContentChatAddMembers) Clone() *PushMessageContentChatAddMembers {
+	if pushMessageContentChatAddMembers == nil {
+		return nil
+	}
+	clone := *pushMessageContentChatAddMembers
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushMessageContentChatAddMembers *PushMessageContentChatAddMembers) Equal(other *PushMessageContentChatAddMembers) bool {
+	if pushMessageContentChatAddMembers == nil || other == nil {
+		return pushMessageContentChatAddMembers == other
+	}
+	if pushMessageContentChatAddMembers.MemberName != other.MemberName {
+		return false
+	}
+	if pushMessageContentChatAddMembers.IsCurrentUser != other.IsCurrentUser {
+		return false
+	}
+	if pushMessageContentChatAddMembers.IsReturned != other.IsReturned {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushMessageContentChatAddMembers *PushMessageContentChatAddMembers) Diff(other *PushMessageContentChatAddMembers) []Change {
+	return pushMessageContentChatAddMembers.diff("", other, nil)
+}
+
+func (pushMessageContentChatAddMembers *PushMessageContentChatAddMembers) diff(path string, other *PushMessageContentChatAddMembers, changes []Change) []Change {
+	if pushMessageContentChatAddMembers == nil || other == nil {
+		if pushMessageContentChatAddMembers != other {
+			changes = append(changes, Change{Path: path, Old: pushMessageContentChatAddMembers, New: other})
+		}
+		return changes
+	}
+	if pushMessageContentChatAddMembers.MemberName != other.MemberName {
+		changes = append(changes, Change{Path: fieldPath(path, "member_name"), Old: pushMessageContentChatAddMembers.MemberName, New: other.MemberName})
+	}
+	if pushMessageContentChatAddMembers.IsCurrentUser != other.IsCurrentUser {
+		changes = append(changes, Change{Path: fieldPath(path, "is_current_user"), Old: pushMessageContentChatAddMembers.IsCurrentUser, New: other.IsCurrentUser})
+	}
+	if pushMessageContentChatAddMembers.IsReturned != other.IsReturned {
+		changes = append(changes, Change{Path: fieldPath(path, "is_returned"), Old: pushMessageContentChatAddMembers.IsReturned, New: other.IsReturned})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushMessageContentChatAddMembers *PushMessageContentChatAddMembers) String() string {
+	return objectString(pushMessageContentChatAddMembers)
+}
+
+// LogValue logs the object as its summary
+func (pushMessageContentChatAddMembers *PushMessageContentChatAddMembers) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushMessageContentChatAddMembers))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushMessageContentChatAddMembers *PushMessageContentChatAddMembers) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushMessageContentChatAddMembers)
+}
+
+func (pushMessageContentChatAddMembers *PushMessageContentChatAddMembers) printText(p *printer) {
+	if pushMessageContentChatAddMembers == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PushMessageContentChatAddMembers")
+	p.string("member_name", pushMessageContentChatAddMembers.MemberName)
+	p.bool("is_current_user", pushMessageContentChatAddMembers.IsCurrentUser)
+	p.bool("is_returned", pushMessageContentChatAddMembers.IsReturned)
+	p.endObject(state)
+}
+
 // GetPushMessageContentEnum return the enum type of this object
 func (pushMessageContentChatAddMembers *PushMessageContentChatAddMembers) GetPushMessageContentEnum() PushMessageContentEnum {
 	return PushMessageContentChatAddMembersType
 }
 
+// Accept calls the method of the visitor for PushMessageContentChatAddMembers
+func (pushMessageContentChatAddMembers *PushMessageContentChatAddMembers) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitPushMessageContentChatAddMembers(pushMessageContentChatAddMembers)
+}
+
 // PushMessageContentChatChangePhoto A chat photo was edited
 type PushMessageContentChatChangePhoto struct {
 	tdCommon
@@ -839,41 +3335,238 @@ func (pushMessageContentChatChangePhoto *PushMessageContentChatChangePhoto) Mess
 }
 
 // NewPushMessageContentChatChangePhoto creates a new PushMessageContentChatChangePhoto
-//
 func NewPushMessageContentChatChangePhoto() *PushMessageContentChatChangePhoto {
 	pushMessageContentChatChangePhotoTemp := PushMessageContentChatChangePhoto{
 		tdCommon: tdCommon{Type: "pushMessageContentChatChangePhoto"},
 	}
 
-	return &pushMessageContentChatChangePhotoTemp
+	return &pushMessageContentChatChangePhotoTemp
+}
+
+// MarshalJSON marshals to json
+func (pushMessageContentChatChangePhoto *PushMessageContentChatChangePhoto) MarshalJSON() ([]byte, error) {
+	return pushMessageContentChatChangePhoto.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pushMessageContentChatChangePhoto *PushMessageContentChatChangePhoto) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushMessageContentChatChangePhoto)
+}
+
+func (pushMessageContentChatChangePhoto *PushMessageContentChatChangePhoto) appendJSON(b []byte) ([]byte, error) {
+	if pushMessageContentChatChangePhoto == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushMessageContentChatChangePhoto.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushMessageContentChatChangePhoto.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (pushMessageContentChatChangePhoto *PushMessageContentChatChangePhoto) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushMessageContentChatChangePhoto.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushMessageContentChatChangePhoto.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushMessageContentChatChangePhoto *PushMessageContentChatChangePhoto) Clone() *PushMessageContentChatChangePhoto {
+	if pushMessageContentChatChangePhoto == nil {
+		return nil
+	}
+	clone := *pushMessageContentChatChangePhoto
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushMessageContentChatChangePhoto *PushMessageContentChatChangePhoto) Equal(other *PushMessageContentChatChangePhoto) bool {
+	if pushMessageContentChatChangePhoto == nil || other == nil {
+		return pushMessageContentChatChangePhoto == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushMessageContentChatChangePhoto *PushMessageContentChatChangePhoto) Diff(other *PushMessageContentChatChangePhoto) []Change {
+	return pushMessageContentChatChangePhoto.diff("", other, nil)
+}
+
+func (pushMessageContentChatChangePhoto *PushMessageContentChatChangePhoto) diff(path string, other *PushMessageContentChatChangePhoto, changes []Change) []Change {
+	if pushMessageContentChatChangePhoto == nil || other == nil {
+		if pushMessageContentChatChangePhoto != other {
+			changes = append(changes, Change{Path: path, Old: pushMessageContentChatChangePhoto, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushMessageContentChatChangePhoto *PushMessageContentChatChangePhoto) String() string {
+	return objectString(pushMessageContentChatChangePhoto)
+}
+
+// LogValue logs the object as its summary
+func (pushMessageContentChatChangePhoto *PushMessageContentChatChangePhoto) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushMessageContentChatChangePhoto))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushMessageContentChatChangePhoto *PushMessageContentChatChangePhoto) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushMessageContentChatChangePhoto)
+}
+
+func (pushMessageContentChatChangePhoto *PushMessageContentChatChangePhoto) printText(p *printer) {
+	if pushMessageContentChatChangePhoto == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PushMessageContentChatChangePhoto")
+	p.endObject(state)
+}
+
+// GetPushMessageContentEnum return the enum type of this object
+func (pushMessageContentChatChangePhoto *PushMessageContentChatChangePhoto) GetPushMessageContentEnum() PushMessageContentEnum {
+	return PushMessageContentChatChangePhotoType
+}
+
+// Accept calls the method of the visitor for PushMessageContentChatChangePhoto
+func (pushMessageContentChatChangePhoto *PushMessageContentChatChangePhoto) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitPushMessageContentChatChangePhoto(pushMessageContentChatChangePhoto)
+}
+
+// PushMessageContentChatChangeTitle A chat title was edited
+type PushMessageContentChatChangeTitle struct {
+	tdCommon
+	Title string `json:"title"` // New chat title
+}
+
+// MessageType return the string telegram-type of PushMessageContentChatChangeTitle
+func (pushMessageContentChatChangeTitle *PushMessageContentChatChangeTitle) MessageType() string {
+	return "pushMessageContentChatChangeTitle"
+}
+
+// NewPushMessageContentChatChangeTitle creates a new PushMessageContentChatChangeTitle
+//
+// @param title New chat title
+func NewPushMessageContentChatChangeTitle(title string) *PushMessageContentChatChangeTitle {
+	pushMessageContentChatChangeTitleTemp := PushMessageContentChatChangeTitle{
+		tdCommon: tdCommon{Type: "pushMessageContentChatChangeTitle"},
+		Title:    title,
+	}
+
+	return &pushMessageContentChatChangeTitleTemp
+}
+
+// MarshalJSON marshals to json
+func (pushMessageContentChatChangeTitle *PushMessageContentChatChangeTitle) MarshalJSON() ([]byte, error) {
+	return pushMessageContentChatChangeTitle.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pushMessageContentChatChangeTitle *PushMessageContentChatChangeTitle) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushMessageContentChatChangeTitle)
+}
+
+func (pushMessageContentChatChangeTitle *PushMessageContentChatChangeTitle) appendJSON(b []byte) ([]byte, error) {
+	if pushMessageContentChatChangeTitle == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushMessageContentChatChangeTitle.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushMessageContentChatChangeTitle.tdCommon.Extra)
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, pushMessageContentChatChangeTitle.Title)
+	return append(b, '}'), nil
+}
+
+func (pushMessageContentChatChangeTitle *PushMessageContentChatChangeTitle) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushMessageContentChatChangeTitle.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushMessageContentChatChangeTitle.tdCommon.Extra, err = r.readString()
+		case "title":
+			pushMessageContentChatChangeTitle.Title, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushMessageContentChatChangeTitle *PushMessageContentChatChangeTitle) Clone() *PushMessageContentChatChangeTitle {
+	if pushMessageContentChatChangeTitle == nil {
+		return nil
+	}
+	clone := *pushMessageContentChatChangeTitle
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushMessageContentChatChangeTitle *PushMessageContentChatChangeTitle) Equal(other *PushMessageContentChatChangeTitle) bool {
+	if pushMessageContentChatChangeTitle == nil || other == nil {
+		return pushMessageContentChatChangeTitle == other
+	}
+	if pushMessageContentChatChangeTitle.Title != other.Title {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushMessageContentChatChangeTitle *PushMessageContentChatChangeTitle) Diff(other *PushMessageContentChatChangeTitle) []Change {
+	return pushMessageContentChatChangeTitle.diff("", other, nil)
+}
+
+func (pushMessageContentChatChangeTitle *PushMessageContentChatChangeTitle) diff(path string, other *PushMessageContentChatChangeTitle, changes []Change) []Change {
+	if pushMessageContentChatChangeTitle == nil || other == nil {
+		if pushMessageContentChatChangeTitle != other {
+			changes = append(changes, Change{Path: path, Old: pushMessageContentChatChangeTitle, New: other})
+		}
+		return changes
+	}
+	if pushMessageContentChatChangeTitle.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: pushMessageContentChatChangeTitle.Title, New: other.Title})
+	}
+	return changes
 }
 
-// GetPushMessageContentEnum return the enum type of this object
-func (pushMessageContentChatChangePhoto *PushMessageContentChatChangePhoto) GetPushMessageContentEnum() PushMessageContentEnum {
-	return PushMessageContentChatChangePhotoType
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushMessageContentChatChangeTitle *PushMessageContentChatChangeTitle) String() string {
+	return objectString(pushMessageContentChatChangeTitle)
 }
 
-// PushMessageContentChatChangeTitle A chat title was edited
-type PushMessageContentChatChangeTitle struct {
-	tdCommon
-	Title string `json:"title"` // New chat title
+// LogValue logs the object as its summary
+func (pushMessageContentChatChangeTitle *PushMessageContentChatChangeTitle) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushMessageContentChatChangeTitle))
 }
 
-// MessageType return the string telegram-type of PushMessageContentChatChangeTitle
-func (pushMessageContentChatChangeTitle *PushMessageContentChatChangeTitle) MessageType() string {
-	return "pushMessageContentChatChangeTitle"
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushMessageContentChatChangeTitle *PushMessageContentChatChangeTitle) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushMessageContentChatChangeTitle)
 }
 
-// NewPushMessageContentChatChangeTitle creates a new PushMessageContentChatChangeTitle
-//
-// @param title New chat title
-func NewPushMessageContentChatChangeTitle(title string) *PushMessageContentChatChangeTitle {
-	pushMessageContentChatChangeTitleTemp := PushMessageContentChatChangeTitle{
-		tdCommon: tdCommon{Type: "pushMessageContentChatChangeTitle"},
-		Title:    title,
+func (pushMessageContentChatChangeTitle *PushMessageContentChatChangeTitle) printText(p *printer) {
+	if pushMessageContentChatChangeTitle == nil {
+		p.null()
+		return
 	}
-
-	return &pushMessageContentChatChangeTitleTemp
+	state := p.beginObject("PushMessageContentChatChangeTitle")
+	p.string("title", pushMessageContentChatChangeTitle.Title)
+	p.endObject(state)
 }
 
 // GetPushMessageContentEnum return the enum type of this object
@@ -881,6 +3574,11 @@ func (pushMessageContentChatChangeTitle *PushMessageContentChatChangeTitle) GetP
 	return PushMessageContentChatChangeTitleType
 }
 
+// Accept calls the method of the visitor for PushMessageContentChatChangeTitle
+func (pushMessageContentChatChangeTitle *PushMessageContentChatChangeTitle) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitPushMessageContentChatChangeTitle(pushMessageContentChatChangeTitle)
+}
+
 // PushMessageContentChatSetTheme A chat theme was edited
 type PushMessageContentChatSetTheme struct {
 	tdCommon
@@ -904,11 +3602,118 @@ func NewPushMessageContentChatSetTheme(themeName string) *PushMessageContentChat
 	return &pushMessageContentChatSetThemeTemp
 }
 
+// MarshalJSON marshals to json
+func (pushMessageContentChatSetTheme *PushMessageContentChatSetTheme) MarshalJSON() ([]byte, error) {
+	return pushMessageContentChatSetTheme.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pushMessageContentChatSetTheme *PushMessageContentChatSetTheme) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushMessageContentChatSetTheme)
+}
+
+func (pushMessageContentChatSetTheme *PushMessageContentChatSetTheme) appendJSON(b []byte) ([]byte, error) {
+	if pushMessageContentChatSetTheme == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushMessageContentChatSetTheme.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushMessageContentChatSetTheme.tdCommon.Extra)
+	b = append(b, `,"theme_name":`...)
+	b = appendJSONString(b, pushMessageContentChatSetTheme.ThemeName)
+	return append(b, '}'), nil
+}
+
+func (pushMessageContentChatSetTheme *PushMessageContentChatSetTheme) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushMessageContentChatSetTheme.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushMessageContentChatSetTheme.tdCommon.Extra, err = r.readString()
+		case "theme_name":
+			pushMessageContentChatSetTheme.ThemeName, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushMessageContentChatSetTheme *PushMessageContentChatSetTheme) Clone() *PushMessageContentChatSetTheme {
+	if pushMessageContentChatSetTheme == nil {
+		return nil
+	}
+	clone := *pushMessageContentChatSetTheme
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushMessageContentChatSetTheme *PushMessageContentChatSetTheme) Equal(other *PushMessageContentChatSetTheme) bool {
+	if pushMessageContentChatSetTheme == nil || other == nil {
+		return pushMessageContentChatSetTheme == other
+	}
+	if pushMessageContentChatSetTheme.ThemeName != other.ThemeName {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushMessageContentChatSetTheme *PushMessageContentChatSetTheme) Diff(other *PushMessageContentChatSetTheme) []Change {
+	return pushMessageContentChatSetTheme.diff("", other, nil)
+}
+
+func (pushMessageContentChatSetTheme *PushMessageContentChatSetTheme) diff(path string, other *PushMessageContentChatSetTheme, changes []Change) []Change {
+	if pushMessageContentChatSetTheme == nil || other == nil {
+		if pushMessageContentChatSetTheme != other {
+			changes = append(changes, Change{Path: path, Old: pushMessageContentChatSetTheme, New: other})
+		}
+		return changes
+	}
+	if pushMessageContentChatSetTheme.ThemeName != other.ThemeName {
+		changes = append(changes, Change{Path: fieldPath(path, "theme_name"), Old: pushMessageContentChatSetTheme.ThemeName, New: other.ThemeName})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushMessageContentChatSetTheme *PushMessageContentChatSetTheme) String() string {
+	return objectString(pushMessageContentChatSetTheme)
+}
+
+// LogValue logs the object as its summary
+func (pushMessageContentChatSetTheme *PushMessageContentChatSetTheme) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushMessageContentChatSetTheme))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushMessageContentChatSetTheme *PushMessageContentChatSetTheme) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushMessageContentChatSetTheme)
+}
+
+func (pushMessageContentChatSetTheme *PushMessageContentChatSetTheme) printText(p *printer) {
+	if pushMessageContentChatSetTheme == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PushMessageContentChatSetTheme")
+	p.string("theme_name", pushMessageContentChatSetTheme.ThemeName)
+	p.endObject(state)
+}
+
 // GetPushMessageContentEnum return the enum type of this object
 func (pushMessageContentChatSetTheme *PushMessageContentChatSetTheme) GetPushMessageContentEnum() PushMessageContentEnum {
 	return PushMessageContentChatSetThemeType
 }
 
+// Accept calls the method of the visitor for PushMessageContentChatSetTheme
+func (pushMessageContentChatSetTheme *PushMessageContentChatSetTheme) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitPushMessageContentChatSetTheme(pushMessageContentChatSetTheme)
+}
+
 // PushMessageContentChatDeleteMember A chat member was deleted
 type PushMessageContentChatDeleteMember struct {
 	tdCommon
@@ -938,11 +3743,140 @@ func NewPushMessageContentChatDeleteMember(memberName string, isCurrentUser bool
 	return &pushMessageContentChatDeleteMemberTemp
 }
 
+// MarshalJSON marshals to json
+func (pushMessageContentChatDeleteMember *PushMessageContentChatDeleteMember) MarshalJSON() ([]byte, error) {
+	return pushMessageContentChatDeleteMember.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pushMessageContentChatDeleteMember *PushMessageContentChatDeleteMember) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushMessageContentChatDeleteMember)
+}
+
+func (pushMessageContentChatDeleteMember *PushMessageContentChatDeleteMember) appendJSON(b []byte) ([]byte, error) {
+	if pushMessageContentChatDeleteMember == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushMessageContentChatDeleteMember.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushMessageContentChatDeleteMember.tdCommon.Extra)
+	b = append(b, `,"member_name":`...)
+	b = appendJSONString(b, pushMessageContentChatDeleteMember.MemberName)
+	b = append(b, `,"is_current_user":`...)
+	b = appendJSONBool(b, pushMessageContentChatDeleteMember.IsCurrentUser)
+	b = append(b, `,"is_left":`...)
+	b = appendJSONBool(b, pushMessageContentChatDeleteMember.IsLeft)
+	return append(b, '}'), nil
+}
+
+func (pushMessageContentChatDeleteMember *PushMessageContentChatDeleteMember) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushMessageContentChatDeleteMember.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushMessageContentChatDeleteMember.tdCommon.Extra, err = r.readString()
+		case "member_name":
+			pushMessageContentChatDeleteMember.MemberName, err = r.readString()
+		case "is_current_user":
+			pushMessageContentChatDeleteMember.IsCurrentUser, err = r.readBool()
+		case "is_left":
+			pushMessageContentChatDeleteMember.IsLeft, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushMessageContentChatDeleteMember *PushMessageContentChatDeleteMember) Clone() *PushMessageContentChatDeleteMember {
+	if pushMessageContentChatDeleteMember == nil {
+		return nil
+	}
+	clone := *pushMessageContentChatDeleteMember
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushMessageContentChatDeleteMember *PushMessageContentChatDeleteMember) Equal(other *PushMessageContentChatDeleteMember) bool {
+	if pushMessageContentChatDeleteMember == nil || other == nil {
+		return pushMessageContentChatDeleteMember == other
+	}
+	if pushMessageContentChatDeleteMember.MemberName != other.MemberName {
+		return false
+	}
+	if pushMessageContentChatDeleteMember.IsCurrentUser != other.IsCurrentUser {
+		return false
+	}
+	if pushMessageContentChatDeleteMember.IsLeft != other.IsLeft {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushMessageContentChatDeleteMember *PushMessageContentChatDeleteMember) Diff(other *PushMessageContentChatDeleteMember) []Change {
+	return pushMessageContentChatDeleteMember.diff("", other, nil)
+}
+
+func (pushMessageContentChatDeleteMember *PushMessageContentChatDeleteMember) diff(path string, other *PushMessageContentChatDeleteMember, changes []Change) []Change {
+	if pushMessageContentChatDeleteMember == nil || other == nil {
+		if pushMessageContentChatDeleteMember != other {
+			changes = append(changes, Change{Path: path, Old: pushMessageContentChatDeleteMember, New: other})
+		}
+		return changes
+	}
+	if pushMessageContentChatDeleteMember.MemberName != other.MemberName {
+		changes = append(changes, Change{Path: fieldPath(path, "member_name"), Old: pushMessageContentChatDeleteMember.MemberName, New: other.MemberName})
+	}
+	if pushMessageContentChatDeleteMember.IsCurrentUser != other.IsCurrentUser {
+		changes = append(changes, Change{Path: fieldPath(path, "is_current_user"), Old: pushMessageContentChatDeleteMember.IsCurrentUser, New: other.IsCurrentUser})
+	}
+	if pushMessageContentChatDeleteMember.IsLeft != other.IsLeft {
+		changes = append(changes, Change{Path: fieldPath(path, "is_left"), Old: pushMessageContentChatDeleteMember.IsLeft, New: other.IsLeft})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushMessageContentChatDeleteMember *PushMessageContentChatDeleteMember) String() string {
+	return objectString(pushMessageContentChatDeleteMember)
+}
+
+// LogValue logs the object as its summary
+func (pushMessageContentChatDeleteMember *PushMessageContentChatDeleteMember) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushMessageContentChatDeleteMember))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushMessageContentChatDeleteMember *PushMessageContentChatDeleteMember) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushMessageContentChatDeleteMember)
+}
+
+func (pushMessageContentChatDeleteMember *PushMessageContentChatDeleteMember) printText(p *printer) {
+	if pushMessageContentChatDeleteMember == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PushMessageContentChatDeleteMember")
+	p.string("member_name", pushMessageContentChatDeleteMember.MemberName)
+	p.bool("is_current_user", pushMessageContentChatDeleteMember.IsCurrentUser)
+	p.bool("is_left", pushMessageContentChatDeleteMember.IsLeft)
+	p.endObject(state)
+}
+
 // GetPushMessageContentEnum return the enum type of this object
 func (pushMessageContentChatDeleteMember *PushMessageContentChatDeleteMember) GetPushMessageContentEnum() PushMessageContentEnum {
 	return PushMessageContentChatDeleteMemberType
 }
 
+// Accept calls the method of the visitor for PushMessageContentChatDeleteMember
+func (pushMessageContentChatDeleteMember *PushMessageContentChatDeleteMember) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitPushMessageContentChatDeleteMember(pushMessageContentChatDeleteMember)
+}
+
 // PushMessageContentChatJoinByLink A new member joined the chat via an invite link
 type PushMessageContentChatJoinByLink struct {
 	tdCommon
@@ -954,7 +3888,6 @@ func (pushMessageContentChatJoinByLink *PushMessageContentChatJoinByLink) Messag
 }
 
 // NewPushMessageContentChatJoinByLink creates a new PushMessageContentChatJoinByLink
-//
 func NewPushMessageContentChatJoinByLink() *PushMessageContentChatJoinByLink {
 	pushMessageContentChatJoinByLinkTemp := PushMessageContentChatJoinByLink{
 		tdCommon: tdCommon{Type: "pushMessageContentChatJoinByLink"},
@@ -963,11 +3896,107 @@ func NewPushMessageContentChatJoinByLink() *PushMessageContentChatJoinByLink {
 	return &pushMessageContentChatJoinByLinkTemp
 }
 
+// MarshalJSON marshals to json
+func (pushMessageContentChatJoinByLink *PushMessageContentChatJoinByLink) MarshalJSON() ([]byte, error) {
+	return pushMessageContentChatJoinByLink.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pushMessageContentChatJoinByLink *PushMessageContentChatJoinByLink) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushMessageContentChatJoinByLink)
+}
+
+func (pushMessageContentChatJoinByLink *PushMessageContentChatJoinByLink) appendJSON(b []byte) ([]byte, error) {
+	if pushMessageContentChatJoinByLink == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushMessageContentChatJoinByLink.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushMessageContentChatJoinByLink.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (pushMessageContentChatJoinByLink *PushMessageContentChatJoinByLink) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushMessageContentChatJoinByLink.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushMessageContentChatJoinByLink.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushMessageContentChatJoinByLink *PushMessageContentChatJoinByLink) Clone() *PushMessageContentChatJoinByLink {
+	if pushMessageContentChatJoinByLink == nil {
+		return nil
+	}
+	clone := *pushMessageContentChatJoinByLink
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushMessageContentChatJoinByLink *PushMessageContentChatJoinByLink) Equal(other *PushMessageContentChatJoinByLink) bool {
+	if pushMessageContentChatJoinByLink == nil || other == nil {
+		return pushMessageContentChatJoinByLink == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushMessageContentChatJoinByLink *PushMessageContentChatJoinByLink) Diff(other *PushMessageContentChatJoinByLink) []Change {
+	return pushMessageContentChatJoinByLink.diff("", other, nil)
+}
+
+func (pushMessageContentChatJoinByLink *PushMessageContentChatJoinByLink) diff(path string, other *PushMessageContentChatJoinByLink, changes []Change) []Change {
+	if pushMessageContentChatJoinByLink == nil || other == nil {
+		if pushMessageContentChatJoinByLink != other {
+			changes = append(changes, Change{Path: path, Old: pushMessageContentChatJoinByLink, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushMessageContentChatJoinByLink *PushMessageContentChatJoinByLink) String() string {
+	return objectString(pushMessageContentChatJoinByLink)
+}
+
+// LogValue logs the object as its summary
+func (pushMessageContentChatJoinByLink *PushMessageContentChatJoinByLink) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushMessageContentChatJoinByLink))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushMessageContentChatJoinByLink *PushMessageContentChatJoinByLink) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushMessageContentChatJoinByLink)
+}
+
+func (pushMessageContentChatJoinByLink *PushMessageContentChatJoinByLink) printText(p *printer) {
+	if pushMessageContentChatJoinByLink == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PushMessageContentChatJoinByLink")
+	p.endObject(state)
+}
+
 // GetPushMessageContentEnum return the enum type of this object
 func (pushMessageContentChatJoinByLink *PushMessageContentChatJoinByLink) GetPushMessageContentEnum() PushMessageContentEnum {
 	return PushMessageContentChatJoinByLinkType
 }
 
+// Accept calls the method of the visitor for PushMessageContentChatJoinByLink
+func (pushMessageContentChatJoinByLink *PushMessageContentChatJoinByLink) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitPushMessageContentChatJoinByLink(pushMessageContentChatJoinByLink)
+}
+
 // PushMessageContentChatJoinByRequest A new member was accepted to the chat by an administrator
 type PushMessageContentChatJoinByRequest struct {
 	tdCommon
@@ -979,7 +4008,6 @@ func (pushMessageContentChatJoinByRequest *PushMessageContentChatJoinByRequest)
 }
 
 // NewPushMessageContentChatJoinByRequest creates a new PushMessageContentChatJoinByRequest
-//
 func NewPushMessageContentChatJoinByRequest() *PushMessageContentChatJoinByRequest {
 	pushMessageContentChatJoinByRequestTemp := PushMessageContentChatJoinByRequest{
 		tdCommon: tdCommon{Type: "pushMessageContentChatJoinByRequest"},
@@ -988,11 +4016,107 @@ func NewPushMessageContentChatJoinByRequest() *PushMessageContentChatJoinByReque
 	return &pushMessageContentChatJoinByRequestTemp
 }
 
+// MarshalJSON marshals to json
+func (pushMessageContentChatJoinByRequest *PushMessageContentChatJoinByRequest) MarshalJSON() ([]byte, error) {
+	return pushMessageContentChatJoinByRequest.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pushMessageContentChatJoinByRequest *PushMessageContentChatJoinByRequest) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushMessageContentChatJoinByRequest)
+}
+
+func (pushMessageContentChatJoinByRequest *PushMessageContentChatJoinByRequest) appendJSON(b []byte) ([]byte, error) {
+	if pushMessageContentChatJoinByRequest == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushMessageContentChatJoinByRequest.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushMessageContentChatJoinByRequest.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (pushMessageContentChatJoinByRequest *PushMessageContentChatJoinByRequest) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushMessageContentChatJoinByRequest.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushMessageContentChatJoinByRequest.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushMessageContentChatJoinByRequest *PushMessageContentChatJoinByRequest) Clone() *PushMessageContentChatJoinByRequest {
+	if pushMessageContentChatJoinByRequest == nil {
+		return nil
+	}
+	clone := *pushMessageContentChatJoinByRequest
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushMessageContentChatJoinByRequest *PushMessageContentChatJoinByRequest) Equal(other *PushMessageContentChatJoinByRequest) bool {
+	if pushMessageContentChatJoinByRequest == nil || other == nil {
+		return pushMessageContentChatJoinByRequest == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushMessageContentChatJoinByRequest *PushMessageContentChatJoinByRequest) Diff(other *PushMessageContentChatJoinByRequest) []Change {
+	return pushMessageContentChatJoinByRequest.diff("", other, nil)
+}
+
+func (pushMessageContentChatJoinByRequest *PushMessageContentChatJoinByRequest) diff(path string, other *PushMessageContentChatJoinByRequest, changes []Change) []Change {
+	if pushMessageContentChatJoinByRequest == nil || other == nil {
+		if pushMessageContentChatJoinByRequest != other {
+			changes = append(changes, Change{Path: path, Old: pushMessageContentChatJoinByRequest, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushMessageContentChatJoinByRequest *PushMessageContentChatJoinByRequest) String() string {
+	return objectString(pushMessageContentChatJoinByRequest)
+}
+
+// LogValue logs the object as its summary
+func (pushMessageContentChatJoinByRequest *PushMessageContentChatJoinByRequest) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushMessageContentChatJoinByRequest))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushMessageContentChatJoinByRequest *PushMessageContentChatJoinByRequest) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushMessageContentChatJoinByRequest)
+}
+
+func (pushMessageContentChatJoinByRequest *PushMessageContentChatJoinByRequest) printText(p *printer) {
+	if pushMessageContentChatJoinByRequest == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PushMessageContentChatJoinByRequest")
+	p.endObject(state)
+}
+
 // GetPushMessageContentEnum return the enum type of this object
 func (pushMessageContentChatJoinByRequest *PushMessageContentChatJoinByRequest) GetPushMessageContentEnum() PushMessageContentEnum {
 	return PushMessageContentChatJoinByRequestType
 }
 
+// Accept calls the method of the visitor for PushMessageContentChatJoinByRequest
+func (pushMessageContentChatJoinByRequest *PushMessageContentChatJoinByRequest) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitPushMessageContentChatJoinByRequest(pushMessageContentChatJoinByRequest)
+}
+
 // PushMessageContentMessageForwards A forwarded messages
 type PushMessageContentMessageForwards struct {
 	tdCommon
@@ -1016,11 +4140,118 @@ func NewPushMessageContentMessageForwards(totalCount int32) *PushMessageContentM
 	return &pushMessageContentMessageForwardsTemp
 }
 
+// MarshalJSON marshals to json
+func (pushMessageContentMessageForwards *PushMessageContentMessageForwards) MarshalJSON() ([]byte, error) {
+	return pushMessageContentMessageForwards.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pushMessageContentMessageForwards *PushMessageContentMessageForwards) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushMessageContentMessageForwards)
+}
+
+func (pushMessageContentMessageForwards *PushMessageContentMessageForwards) appendJSON(b []byte) ([]byte, error) {
+	if pushMessageContentMessageForwards == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushMessageContentMessageForwards.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushMessageContentMessageForwards.tdCommon.Extra)
+	b = append(b, `,"total_count":`...)
+	b = appendJSONInt(b, int64(pushMessageContentMessageForwards.TotalCount))
+	return append(b, '}'), nil
+}
+
+func (pushMessageContentMessageForwards *PushMessageContentMessageForwards) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushMessageContentMessageForwards.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushMessageContentMessageForwards.tdCommon.Extra, err = r.readString()
+		case "total_count":
+			pushMessageContentMessageForwards.TotalCount, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushMessageContentMessageForwards *PushMessageContentMessageForwards) Clone() *PushMessageContentMessageForwards {
+	if pushMessageContentMessageForwards == nil {
+		return nil
+	}
+	clone := *pushMessageContentMessageForwards
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushMessageContentMessageForwards *PushMessageContentMessageForwards) Equal(other *PushMessageContentMessageForwards) bool {
+	if pushMessageContentMessageForwards == nil || other == nil {
+		return pushMessageContentMessageForwards == other
+	}
+	if pushMessageContentMessageForwards.TotalCount != other.TotalCount {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushMessageContentMessageForwards *PushMessageContentMessageForwards) Diff(other *PushMessageContentMessageForwards) []Change {
+	return pushMessageContentMessageForwards.diff("", other, nil)
+}
+
+func (pushMessageContentMessageForwards *PushMessageContentMessageForwards) diff(path string, other *PushMessageContentMessageForwards, changes []Change) []Change {
+	if pushMessageContentMessageForwards == nil || other == nil {
+		if pushMessageContentMessageForwards != other {
+			changes = append(changes, Change{Path: path, Old: pushMessageContentMessageForwards, New: other})
+		}
+		return changes
+	}
+	if pushMessageContentMessageForwards.TotalCount != other.TotalCount {
+		changes = append(changes, Change{Path: fieldPath(path, "total_count"), Old: pushMessageContentMessageForwards.TotalCount, New: other.TotalCount})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushMessageContentMessageForwards *PushMessageContentMessageForwards) String() string {
+	return objectString(pushMessageContentMessageForwards)
+}
+
+// LogValue logs the object as its summary
+func (pushMessageContentMessageForwards *PushMessageContentMessageForwards) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushMessageContentMessageForwards))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushMessageContentMessageForwards *PushMessageContentMessageForwards) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushMessageContentMessageForwards)
+}
+
+func (pushMessageContentMessageForwards *PushMessageContentMessageForwards) printText(p *printer) {
+	if pushMessageContentMessageForwards == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PushMessageContentMessageForwards")
+	p.int("total_count", int64(pushMessageContentMessageForwards.TotalCount))
+	p.endObject(state)
+}
+
 // GetPushMessageContentEnum return the enum type of this object
 func (pushMessageContentMessageForwards *PushMessageContentMessageForwards) GetPushMessageContentEnum() PushMessageContentEnum {
 	return PushMessageContentMessageForwardsType
 }
 
+// Accept calls the method of the visitor for PushMessageContentMessageForwards
+func (pushMessageContentMessageForwards *PushMessageContentMessageForwards) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitPushMessageContentMessageForwards(pushMessageContentMessageForwards)
+}
+
 // PushMessageContentMediaAlbum A media album
 type PushMessageContentMediaAlbum struct {
 	tdCommon
@@ -1056,7 +4287,643 @@ func NewPushMessageContentMediaAlbum(totalCount int32, hasPhotos bool, hasVideos
 	return &pushMessageContentMediaAlbumTemp
 }
 
+// MarshalJSON marshals to json
+func (pushMessageContentMediaAlbum *PushMessageContentMediaAlbum) MarshalJSON() ([]byte, error) {
+	return pushMessageContentMediaAlbum.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pushMessageContentMediaAlbum *PushMessageContentMediaAlbum) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushMessageContentMediaAlbum)
+}
+
+func (pushMessageContentMediaAlbum *PushMessageContentMediaAlbum) appendJSON(b []byte) ([]byte, error) {
+	if pushMessageContentMediaAlbum == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushMessageContentMediaAlbum.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushMessageContentMediaAlbum.tdCommon.Extra)
+	b = append(b, `,"total_count":`...)
+	b = appendJSONInt(b, int64(pushMessageContentMediaAlbum.TotalCount))
+	b = append(b, `,"has_photos":`...)
+	b = appendJSONBool(b, pushMessageContentMediaAlbum.HasPhotos)
+	b = append(b, `,"has_videos":`...)
+	b = appendJSONBool(b, pushMessageContentMediaAlbum.HasVideos)
+	b = append(b, `,"has_audios":`...)
+	b = appendJSONBool(b, pushMessageContentMediaAlbum.HasAudios)
+	b = append(b, `,"has_documents":`...)
+	b = appendJSONBool(b, pushMessageContentMediaAlbum.HasDocuments)
+	return append(b, '}'), nil
+}
+
+func (pushMessageContentMediaAlbum *PushMessageContentMediaAlbum) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushMessageContentMediaAlbum.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushMessageContentMediaAlbum.tdCommon.Extra, err = r.readString()
+		case "total_count":
+			pushMessageContentMediaAlbum.TotalCount, err = r.readInt32()
+		case "has_photos":
+			pushMessageContentMediaAlbum.HasPhotos, err = r.readBool()
+		case "has_videos":
+			pushMessageContentMediaAlbum.HasVideos, err = r.readBool()
+		case "has_audios":
+			pushMessageContentMediaAlbum.HasAudios, err = r.readBool()
+		case "has_documents":
+			pushMessageContentMediaAlbum.HasDocuments, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushMessageContentMediaAlbum *PushMessageContentMediaAlbum) Clone() *PushMessageContentMediaAlbum {
+	if pushMessageContentMediaAlbum == nil {
+		return nil
+	}
+	clone := *pushMessageContentMediaAlbum
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushMessageContentMediaAlbum *PushMessageContentMediaAlbum) Equal(other *PushMessageContentMediaAlbum) bool {
+	if pushMessageContentMediaAlbum == nil || other == nil {
+		return pushMessageContentMediaAlbum == other
+	}
+	if pushMessageContentMediaAlbum.TotalCount != other.TotalCount {
+		return false
+	}
+	if pushMessageContentMediaAlbum.HasPhotos != other.HasPhotos {
+		return false
+	}
+	if pushMessageContentMediaAlbum.HasVideos != other.HasVideos {
+		return false
+	}
+	if pushMessageContentMediaAlbum.HasAudios != other.HasAudios {
+		return false
+	}
+	if pushMessageContentMediaAlbum.HasDocuments != other.HasDocuments {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushMessageContentMediaAlbum *PushMessageContentMediaAlbum) Diff(other *PushMessageContentMediaAlbum) []Change {
+	return pushMessageContentMediaAlbum.diff("", other, nil)
+}
+
+func (pushMessageContentMediaAlbum *PushMessageContentMediaAlbum) diff(path string, other *PushMessageContentMediaAlbum, changes []Change) []Change {
+	if pushMessageContentMediaAlbum == nil || other == nil {
+		if pushMessageContentMediaAlbum != other {
+			changes = append(changes, Change{Path: path, Old: pushMessageContentMediaAlbum, New: other})
+		}
+		return changes
+	}
+	if pushMessageContentMediaAlbum.TotalCount != other.TotalCount {
+		changes = append(changes, Change{Path: fieldPath(path, "total_count"), Old: pushMessageContentMediaAlbum.TotalCount, New: other.TotalCount})
+	}
+	if pushMessageContentMediaAlbum.HasPhotos != other.HasPhotos {
+		changes = append(changes, Change{Path: fieldPath(path, "has_photos"), Old: pushMessageContentMediaAlbum.HasPhotos, New: other.HasPhotos})
+	}
+	if pushMessageContentMediaAlbum.HasVideos != other.HasVideos {
+		changes = append(changes, Change{Path: fieldPath(path, "has_videos"), Old: pushMessageContentMediaAlbum.HasVideos, New: other.HasVideos})
+	}
+	if pushMessageContentMediaAlbum.HasAudios != other.HasAudios {
+		changes = append(changes, Change{Path: fieldPath(path, "has_audios"), Old: pushMessageContentMediaAlbum.HasAudios, New: other.HasAudios})
+	}
+	if pushMessageContentMediaAlbum.HasDocuments != other.HasDocuments {
+		changes = append(changes, Change{Path: fieldPath(path, "has_documents"), Old: pushMessageContentMediaAlbum.HasDocuments, New: other.HasDocuments})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushMessageContentMediaAlbum *PushMessageContentMediaAlbum) String() string {
+	return objectString(pushMessageContentMediaAlbum)
+}
+
+// LogValue logs the object as its summary
+func (pushMessageContentMediaAlbum *PushMessageContentMediaAlbum) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushMessageContentMediaAlbum))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushMessageContentMediaAlbum *PushMessageContentMediaAlbum) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushMessageContentMediaAlbum)
+}
+
+func (pushMessageContentMediaAlbum *PushMessageContentMediaAlbum) printText(p *printer) {
+	if pushMessageContentMediaAlbum == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PushMessageContentMediaAlbum")
+	p.int("total_count", int64(pushMessageContentMediaAlbum.TotalCount))
+	p.bool("has_photos", pushMessageContentMediaAlbum.HasPhotos)
+	p.bool("has_videos", pushMessageContentMediaAlbum.HasVideos)
+	p.bool("has_audios", pushMessageContentMediaAlbum.HasAudios)
+	p.bool("has_documents", pushMessageContentMediaAlbum.HasDocuments)
+	p.endObject(state)
+}
+
 // GetPushMessageContentEnum return the enum type of this object
 func (pushMessageContentMediaAlbum *PushMessageContentMediaAlbum) GetPushMessageContentEnum() PushMessageContentEnum {
 	return PushMessageContentMediaAlbumType
 }
+
+// Accept calls the method of the visitor for PushMessageContentMediaAlbum
+func (pushMessageContentMediaAlbum *PushMessageContentMediaAlbum) Accept(visitor PushMessageContentVisitor) {
+	visitor.VisitPushMessageContentMediaAlbum(pushMessageContentMediaAlbum)
+}
+
+// PushMessageContentVisitor visits every type of PushMessageContent, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to PushMessageContent, until they handle it too.
+type PushMessageContentVisitor interface {
+	VisitPushMessageContentHidden(pushMessageContentHidden *PushMessageContentHidden)
+	VisitPushMessageContentAnimation(pushMessageContentAnimation *PushMessageContentAnimation)
+	VisitPushMessageContentAudio(pushMessageContentAudio *PushMessageContentAudio)
+	VisitPushMessageContentContact(pushMessageContentContact *PushMessageContentContact)
+	VisitPushMessageContentContactRegistered(pushMessageContentContactRegistered *PushMessageContentContactRegistered)
+	VisitPushMessageContentDocument(pushMessageContentDocument *PushMessageContentDocument)
+	VisitPushMessageContentGame(pushMessageContentGame *PushMessageContentGame)
+	VisitPushMessageContentGameScore(pushMessageContentGameScore *PushMessageContentGameScore)
+	VisitPushMessageContentInvoice(pushMessageContentInvoice *PushMessageContentInvoice)
+	VisitPushMessageContentLocation(pushMessageContentLocation *PushMessageContentLocation)
+	VisitPushMessageContentPhoto(pushMessageContentPhoto *PushMessageContentPhoto)
+	VisitPushMessageContentPoll(pushMessageContentPoll *PushMessageContentPoll)
+	VisitPushMessageContentScreenshotTaken(pushMessageContentScreenshotTaken *PushMessageContentScreenshotTaken)
+	VisitPushMessageContentSticker(pushMessageContentSticker *PushMessageContentSticker)
+	VisitPushMessageContentText(pushMessageContentText *PushMessageContentText)
+	VisitPushMessageContentVideo(pushMessageContentVideo *PushMessageContentVideo)
+	VisitPushMessageContentVideoNote(pushMessageContentVideoNote *PushMessageContentVideoNote)
+	VisitPushMessageContentVoiceNote(pushMessageContentVoiceNote *PushMessageContentVoiceNote)
+	VisitPushMessageContentBasicGroupChatCreate(pushMessageContentBasicGroupChatCreate *PushMessageContentBasicGroupChatCreate)
+	VisitPushMessageContentChatAddMembers(pushMessageContentChatAddMembers *PushMessageContentChatAddMembers)
+	VisitPushMessageContentChatChangePhoto(pushMessageContentChatChangePhoto *PushMessageContentChatChangePhoto)
+	VisitPushMessageContentChatChangeTitle(pushMessageContentChatChangeTitle *PushMessageContentChatChangeTitle)
+	VisitPushMessageContentChatSetTheme(pushMessageContentChatSetTheme *PushMessageContentChatSetTheme)
+	VisitPushMessageContentChatDeleteMember(pushMessageContentChatDeleteMember *PushMessageContentChatDeleteMember)
+	VisitPushMessageContentChatJoinByLink(pushMessageContentChatJoinByLink *PushMessageContentChatJoinByLink)
+	VisitPushMessageContentChatJoinByRequest(pushMessageContentChatJoinByRequest *PushMessageContentChatJoinByRequest)
+	VisitPushMessageContentMessageForwards(pushMessageContentMessageForwards *PushMessageContentMessageForwards)
+	VisitPushMessageContentMediaAlbum(pushMessageContentMediaAlbum *PushMessageContentMediaAlbum)
+	VisitUnknownPushMessageContent(unknownPushMessageContent *UnknownPushMessageContent)
+}
+
+// PushMessageContentCases holds the function MatchPushMessageContent calls for each type of PushMessageContent;
+// types without a function are passed to the default case
+type PushMessageContentCases[R any] struct {
+	PushMessageContentHidden               func(pushMessageContentHidden *PushMessageContentHidden) R
+	PushMessageContentAnimation            func(pushMessageContentAnimation *PushMessageContentAnimation) R
+	PushMessageContentAudio                func(pushMessageContentAudio *PushMessageContentAudio) R
+	PushMessageContentContact              func(pushMessageContentContact *PushMessageContentContact) R
+	PushMessageContentContactRegistered    func(pushMessageContentContactRegistered *PushMessageContentContactRegistered) R
+	PushMessageContentDocument             func(pushMessageContentDocument *PushMessageContentDocument) R
+	PushMessageContentGame                 func(pushMessageContentGame *PushMessageContentGame) R
+	PushMessageContentGameScore            func(pushMessageContentGameScore *PushMessageContentGameScore) R
+	PushMessageContentInvoice              func(pushMessageContentInvoice *PushMessageContentInvoice) R
+	PushMessageContentLocation             func(pushMessageContentLocation *PushMessageContentLocation) R
+	PushMessageContentPhoto                func(pushMessageContentPhoto *PushMessageContentPhoto) R
+	PushMessageContentPoll                 func(pushMessageContentPoll *PushMessageContentPoll) R
+	PushMessageContentScreenshotTaken      func(pushMessageContentScreenshotTaken *PushMessageContentScreenshotTaken) R
+	PushMessageContentSticker              func(pushMessageContentSticker *PushMessageContentSticker) R
+	PushMessageContentText                 func(pushMessageContentText *PushMessageContentText) R
+	PushMessageContentVideo                func(pushMessageContentVideo *PushMessageContentVideo) R
+	PushMessageContentVideoNote            func(pushMessageContentVideoNote *PushMessageContentVideoNote) R
+	PushMessageContentVoiceNote            func(pushMessageContentVoiceNote *PushMessageContentVoiceNote) R
+	PushMessageContentBasicGroupChatCreate func(pushMessageContentBasicGroupChatCreate *PushMessageContentBasicGroupChatCreate) R
+	PushMessageContentChatAddMembers       func(pushMessageContentChatAddMembers *PushMessageContentChatAddMembers) R
+	PushMessageContentChatChangePhoto      func(pushMessageContentChatChangePhoto *PushMessageContentChatChangePhoto) R
+	PushMessageContentChatChangeTitle      func(pushMessageContentChatChangeTitle *PushMessageContentChatChangeTitle) R
+	PushMessageContentChatSetTheme         func(pushMessageContentChatSetTheme *PushMessageContentChatSetTheme) R
+	PushMessageContentChatDeleteMember     func(pushMessageContentChatDeleteMember *PushMessageContentChatDeleteMember) R
+	PushMessageContentChatJoinByLink       func(pushMessageContentChatJoinByLink *PushMessageContentChatJoinByLink) R
+	PushMessageContentChatJoinByRequest    func(pushMessageContentChatJoinByRequest *PushMessageContentChatJoinByRequest) R
+	PushMessageContentMessageForwards      func(pushMessageContentMessageForwards *PushMessageContentMessageForwards) R
+	PushMessageContentMediaAlbum           func(pushMessageContentMediaAlbum *PushMessageContentMediaAlbum) R
+}
+
+// MatchPushMessageContent calls the case of the type of pushMessageContent, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement PushMessageContentVisitor
+// to have the compiler check every type is handled.
+func MatchPushMessageContent[R any](pushMessageContent PushMessageContent, cases PushMessageContentCases[R], defaultCase func(pushMessageContent PushMessageContent) R) R {
+	switch value := pushMessageContent.(type) {
+	case *PushMessageContentHidden:
+		if cases.PushMessageContentHidden != nil {
+			return cases.PushMessageContentHidden(value)
+		}
+	case *PushMessageContentAnimation:
+		if cases.PushMessageContentAnimation != nil {
+			return cases.PushMessageContentAnimation(value)
+		}
+	case *PushMessageContentAudio:
+		if cases.PushMessageContentAudio != nil {
+			return cases.PushMessageContentAudio(value)
+		}
+	case *PushMessageContentContact:
+		if cases.PushMessageContentContact != nil {
+			return cases.PushMessageContentContact(value)
+		}
+	case *PushMessageContentContactRegistered:
+		if cases.PushMessageContentContactRegistered != nil {
+			return cases.PushMessageContentContactRegistered(value)
+		}
+	case *PushMessageContentDocument:
+		if cases.PushMessageContentDocument != nil {
+			return cases.PushMessageContentDocument(value)
+		}
+	case *PushMessageContentGame:
+		if cases.PushMessageContentGame != nil {
+			return cases.PushMessageContentGame(value)
+		}
+	case *PushMessageContentGameScore:
+		if cases.PushMessageContentGameScore != nil {
+			return cases.PushMessageContentGameScore(value)
+		}
+	case *PushMessageContentInvoice:
+		if cases.PushMessageContentInvoice != nil {
+			return cases.PushMessageContentInvoice(value)
+		}
+	case *PushMessageContentLocation:
+		if cases.PushMessageContentLocation != nil {
+			return cases.PushMessageContentLocation(value)
+		}
+	case *PushMessageContentPhoto:
+		if cases.PushMessageContentPhoto != nil {
+			return cases.PushMessageContentPhoto(value)
+		}
+	case *PushMessageContentPoll:
+		if cases.PushMessageContentPoll != nil {
+			return cases.PushMessageContentPoll(value)
+		}
+	case *PushMessageContentScreenshotTaken:
+		if cases.PushMessageContentScreenshotTaken != nil {
+			return cases.PushMessageContentScreenshotTaken(value)
+		}
+	case *PushMessageContentSticker:
+		if cases.PushMessageContentSticker != nil {
+			return cases.PushMessageContentSticker(value)
+		}
+	case *PushMessageContentText:
+		if cases.PushMessageContentText != nil {
+			return cases.PushMessageContentText(value)
+		}
+	case *PushMessageContentVideo:
+		if cases.PushMessageContentVideo != nil {
+			return cases.PushMessageContentVideo(value)
+		}
+	case *PushMessageContentVideoNote:
+		if cases.PushMessageContentVideoNote != nil {
+			return cases.PushMessageContentVideoNote(value)
+		}
+	case *PushMessageContentVoiceNote:
+		if cases.PushMessageContentVoiceNote != nil {
+			return cases.PushMessageContentVoiceNote(value)
+		}
+	case *PushMessageContentBasicGroupChatCreate:
+		if cases.PushMessageContentBasicGroupChatCreate != nil {
+			return cases.PushMessageContentBasicGroupChatCreate(value)
+		}
+	case *PushMessageContentChatAddMembers:
+		if cases.PushMessageContentChatAddMembers != nil {
+			return cases.PushMessageContentChatAddMembers(value)
+		}
+	case *PushMessageContentChatChangePhoto:
+		if cases.PushMessageContentChatChangePhoto != nil {
+			return cases.PushMessageContentChatChangePhoto(value)
+		}
+	case *PushMessageContentChatChangeTitle:
+		if cases.PushMessageContentChatChangeTitle != nil {
+			return cases.PushMessageContentChatChangeTitle(value)
+		}
+	case *PushMessageContentChatSetTheme:
+		if cases.PushMessageContentChatSetTheme != nil {
+			return cases.PushMessageContentChatSetTheme(value)
+		}
+	case *PushMessageContentChatDeleteMember:
+		if cases.PushMessageContentChatDeleteMember != nil {
+			return cases.PushMessageContentChatDeleteMember(value)
+		}
+	case *PushMessageContentChatJoinByLink:
+		if cases.PushMessageContentChatJoinByLink != nil {
+			return cases.PushMessageContentChatJoinByLink(value)
+		}
+	case *PushMessageContentChatJoinByRequest:
+		if cases.PushMessageContentChatJoinByRequest != nil {
+			return cases.PushMessageContentChatJoinByRequest(value)
+		}
+	case *PushMessageContentMessageForwards:
+		if cases.PushMessageContentMessageForwards != nil {
+			return cases.PushMessageContentMessageForwards(value)
+		}
+	case *PushMessageContentMediaAlbum:
+		if cases.PushMessageContentMediaAlbum != nil {
+			return cases.PushMessageContentMediaAlbum(value)
+		}
+	}
+	return defaultCase(pushMessageContent)
+}
+
+// Clone returns a deep copy of the object
+func (unknownPushMessageContent *UnknownPushMessageContent) Clone() *UnknownPushMessageContent {
+	if unknownPushMessageContent == nil {
+		return nil
+	}
+	return &UnknownPushMessageContent{Unknown: unknownPushMessageContent.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownPushMessageContent *UnknownPushMessageContent) Equal(other *UnknownPushMessageContent) bool {
+	if unknownPushMessageContent == nil || other == nil {
+		return unknownPushMessageContent == other
+	}
+	return unknownPushMessageContent.Unknown.equal(other.Unknown)
+}
+
+func clonePushMessageContent(pushMessageContent PushMessageContent) PushMessageContent {
+	switch value := pushMessageContent.(type) {
+	case *PushMessageContentHidden:
+		return value.Clone()
+	case *PushMessageContentAnimation:
+		return value.Clone()
+	case *PushMessageContentAudio:
+		return value.Clone()
+	case *PushMessageContentContact:
+		return value.Clone()
+	case *PushMessageContentContactRegistered:
+		return value.Clone()
+	case *PushMessageContentDocument:
+		return value.Clone()
+	case *PushMessageContentGame:
+		return value.Clone()
+	case *PushMessageContentGameScore:
+		return value.Clone()
+	case *PushMessageContentInvoice:
+		return value.Clone()
+	case *PushMessageContentLocation:
+		return value.Clone()
+	case *PushMessageContentPhoto:
+		return value.Clone()
+	case *PushMessageContentPoll:
+		return value.Clone()
+	case *PushMessageContentScreenshotTaken:
+		return value.Clone()
+	case *PushMessageContentSticker:
+		return value.Clone()
+	case *PushMessageContentText:
+		return value.Clone()
+	case *PushMessageContentVideo:
+		return value.Clone()
+	case *PushMessageContentVideoNote:
+		return value.Clone()
+	case *PushMessageContentVoiceNote:
+		return value.Clone()
+	case *PushMessageContentBasicGroupChatCreate:
+		return value.Clone()
+	case *PushMessageContentChatAddMembers:
+		return value.Clone()
+	case *PushMessageContentChatChangePhoto:
+		return value.Clone()
+	case *PushMessageContentChatChangeTitle:
+		return value.Clone()
+	case *PushMessageContentChatSetTheme:
+		return value.Clone()
+	case *PushMessageContentChatDeleteMember:
+		return value.Clone()
+	case *PushMessageContentChatJoinByLink:
+		return value.Clone()
+	case *PushMessageContentChatJoinByRequest:
+		return value.Clone()
+	case *PushMessageContentMessageForwards:
+		return value.Clone()
+	case *PushMessageContentMediaAlbum:
+		return value.Clone()
+	case *UnknownPushMessageContent:
+		return value.Clone()
+	}
+	return pushMessageContent
+}
+
+func equalPushMessageContent(a PushMessageContent, b PushMessageContent) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *PushMessageContentHidden:
+		b, ok := b.(*PushMessageContentHidden)
+		return ok && a.Equal(b)
+	case *PushMessageContentAnimation:
+		b, ok := b.(*PushMessageContentAnimation)
+		return ok && a.Equal(b)
+	case *PushMessageContentAudio:
+		b, ok := b.(*PushMessageContentAudio)
+		return ok && a.Equal(b)
+	case *PushMessageContentContact:
+		b, ok := b.(*PushMessageContentContact)
+		return ok && a.Equal(b)
+	case *PushMessageContentContactRegistered:
+		b, ok := b.(*PushMessageContentContactRegistered)
+		return ok && a.Equal(b)
+	case *PushMessageContentDocument:
+		b, ok := b.(*PushMessageContentDocument)
+		return ok && a.Equal(b)
+	case *PushMessageContentGame:
+		b, ok := b.(*PushMessageContentGame)
+		return ok && a.Equal(b)
+	case *PushMessageContentGameScore:
+		b, ok := b.(*PushMessageContentGameScore)
+		return ok && a.Equal(b)
+	case *PushMessageContentInvoice:
+		b, ok := b.(*PushMessageContentInvoice)
+		return ok && a.Equal(b)
+	case *PushMessageContentLocation:
+		b, ok := b.(*PushMessageContentLocation)
+		return ok && a.Equal(b)
+	case *PushMessageContentPhoto:
+		b, ok := b.(*PushMessageContentPhoto)
+		return ok && a.Equal(b)
+	case *PushMessageContentPoll:
+		b, ok := b.(*PushMessageContentPoll)
+		return ok && a.Equal(b)
+	case *PushMessageContentScreenshotTaken:
+		b, ok := b.(*PushMessageContentScreenshotTaken)
+		return ok && a.Equal(b)
+	case *PushMessageContentSticker:
+		b, ok := b.(*PushMessageContentSticker)
+		return ok && a.Equal(b)
+	case *PushMessageContentText:
+		b, ok := b.(*PushMessageContentText)
+		return ok && a.Equal(b)
+	case *PushMessageContentVideo:
+		b, ok := b.(*PushMessageContentVideo)
+		return ok && a.Equal(b)
+	case *PushMessageContentVideoNote:
+		b, ok := b.(*PushMessageContentVideoNote)
+		return ok && a.Equal(b)
+	case *PushMessageContentVoiceNote:
+		b, ok := b.(*PushMessageContentVoiceNote)
+		return ok && a.Equal(b)
+	case *PushMessageContentBasicGroupChatCreate:
+		b, ok := b.(*PushMessageContentBasicGroupChatCreate)
+		return ok && a.Equal(b)
+	case *PushMessageContentChatAddMembers:
+		b, ok := b.(*PushMessageContentChatAddMembers)
+		return ok && a.Equal(b)
+	case *PushMessageContentChatChangePhoto:
+		b, ok := b.(*PushMessageContentChatChangePhoto)
+		return ok && a.Equal(b)
+	case *PushMessageContentChatChangeTitle:
+		b, ok := b.(*PushMessageContentChatChangeTitle)
+		return ok && a.Equal(b)
+	case *PushMessageContentChatSetTheme:
+		b, ok := b.(*PushMessageContentChatSetTheme)
+		return ok && a.Equal(b)
+	case *PushMessageContentChatDeleteMember:
+		b, ok := b.(*PushMessageContentChatDeleteMember)
+		return ok && a.Equal(b)
+	case *PushMessageContentChatJoinByLink:
+		b, ok := b.(*PushMessageContentChatJoinByLink)
+		return ok && a.Equal(b)
+	case *PushMessageContentChatJoinByRequest:
+		b, ok := b.(*PushMessageContentChatJoinByRequest)
+		return ok && a.Equal(b)
+	case *PushMessageContentMessageForwards:
+		b, ok := b.(*PushMessageContentMessageForwards)
+		return ok && a.Equal(b)
+	case *PushMessageContentMediaAlbum:
+		b, ok := b.(*PushMessageContentMediaAlbum)
+		return ok && a.Equal(b)
+	case *UnknownPushMessageContent:
+		b, ok := b.(*UnknownPushMessageContent)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffPushMessageContent(path string, a PushMessageContent, b PushMessageContent, changes []Change) []Change {
+	switch a := a.(type) {
+	case *PushMessageContentHidden:
+		if b, ok := b.(*PushMessageContentHidden); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PushMessageContentAnimation:
+		if b, ok := b.(*PushMessageContentAnimation); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PushMessageContentAudio:
+		if b, ok := b.(*PushMessageContentAudio); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PushMessageContentContact:
+		if b, ok := b.(*PushMessageContentContact); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PushMessageContentContactRegistered:
+		if b, ok := b.(*PushMessageContentContactRegistered); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PushMessageContentDocument:
+		if b, ok := b.(*PushMessageContentDocument); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PushMessageContentGame:
+		if b, ok := b.(*PushMessageContentGame); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PushMessageContentGameScore:
+		if b, ok := b.(*PushMessageContentGameScore); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PushMessageContentInvoice:
+		if b, ok := b.(*PushMessageContentInvoice); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PushMessageContentLocation:
+		if b, ok := b.(*PushMessageContentLocation); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PushMessageContentPhoto:
+		if b, ok := b.(*PushMessageContentPhoto); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PushMessageContentPoll:
+		if b, ok := b.(*PushMessageContentPoll); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PushMessageContentScreenshotTaken:
+		if b, ok := b.(*PushMessageContentScreenshotTaken); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PushMessageContentSticker:
+		if b, ok := b.(*PushMessageContentSticker); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PushMessageContentText:
+		if b, ok := b.(*PushMessageContentText); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PushMessageContentVideo:
+		if b, ok := b.(*PushMessageContentVideo); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PushMessageContentVideoNote:
+		if b, ok := b.(*PushMessageContentVideoNote); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PushMessageContentVoiceNote:
+		if b, ok := b.(*PushMessageContentVoiceNote); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PushMessageContentBasicGroupChatCreate:
+		if b, ok := b.(*PushMessageContentBasicGroupChatCreate); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PushMessageContentChatAddMembers:
+		if b, ok := b.(*PushMessageContentChatAddMembers); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PushMessageContentChatChangePhoto:
+		if b, ok := b.(*PushMessageContentChatChangePhoto); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PushMessageContentChatChangeTitle:
+		if b, ok := b.(*PushMessageContentChatChangeTitle); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PushMessageContentChatSetTheme:
+		if b, ok := b.(*PushMessageContentChatSetTheme); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PushMessageContentChatDeleteMember:
+		if b, ok := b.(*PushMessageContentChatDeleteMember); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PushMessageContentChatJoinByLink:
+		if b, ok := b.(*PushMessageContentChatJoinByLink); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PushMessageContentChatJoinByRequest:
+		if b, ok := b.(*PushMessageContentChatJoinByRequest); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PushMessageContentMessageForwards:
+		if b, ok := b.(*PushMessageContentMessageForwards); ok {
+			return a.diff(path, b, changes)
+		}
+	case *PushMessageContentMediaAlbum:
+		if b, ok := b.(*PushMessageContentMediaAlbum); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalPushMessageContent(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // PushReceiverId Contains a globally unique push receiver identifier, which can be used to identify which account has received a push notification
@@ -28,6 +28,108 @@ func NewPushReceiverId(id JSONInt64) *PushReceiverId {
 	return &pushReceiverIdTemp
 }
 
+// MarshalJSON marshals to json
+func (pushReceiverId *PushReceiverId) MarshalJSON() ([]byte, error) {
+	return pushReceiverId.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (pushReceiverId *PushReceiverId) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, pushReceiverId)
+}
+
+func (pushReceiverId *PushReceiverId) appendJSON(b []byte) ([]byte, error) {
+	if pushReceiverId == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, pushReceiverId.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, pushReceiverId.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt64String(b, int64(pushReceiverId.Id))
+	return append(b, '}'), nil
+}
+
+func (pushReceiverId *PushReceiverId) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			pushReceiverId.tdCommon.Type, err = r.readString()
+		case "@extra":
+			pushReceiverId.tdCommon.Extra, err = r.readString()
+		case "id":
+			pushReceiverId.Id, err = r.readJSONInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (pushReceiverId *PushReceiverId) Clone() *PushReceiverId {
+	if pushReceiverId == nil {
+		return nil
+	}
+	clone := *pushReceiverId
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (pushReceiverId *PushReceiverId) Equal(other *PushReceiverId) bool {
+	if pushReceiverId == nil || other == nil {
+		return pushReceiverId == other
+	}
+	if pushReceiverId.Id != other.Id {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (pushReceiverId *PushReceiverId) Diff(other *PushReceiverId) []Change {
+	return pushReceiverId.diff("", other, nil)
+}
+
+func (pushReceiverId *PushReceiverId) diff(path string, other *PushReceiverId, changes []Change) []Change {
+	if pushReceiverId == nil || other == nil {
+		if pushReceiverId != other {
+			changes = append(changes, Change{Path: path, Old: pushReceiverId, New: other})
+		}
+		return changes
+	}
+	if pushReceiverId.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: pushReceiverId.Id, New: other.Id})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (pushReceiverId *PushReceiverId) String() string {
+	return objectString(pushReceiverId)
+}
+
+// LogValue logs the object as its summary
+func (pushReceiverId *PushReceiverId) LogValue() slog.Value {
+	return slog.StringValue(objectString(pushReceiverId))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (pushReceiverId *PushReceiverId) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, pushReceiverId)
+}
+
+func (pushReceiverId *PushReceiverId) printText(p *printer) {
+	if pushReceiverId == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("PushReceiverId")
+	p.int("id", int64(pushReceiverId.Id))
+	p.endObject(state)
+}
+
 // RegisterDevice Registers the currently used device for receiving push notifications. Returns a globally unique identifier of the push notification subscription
 // @param deviceToken Device token
 // @param otherUserIds List of user identifiers of other users currently using the application
@@ -42,12 +144,12 @@ func (client *Client) RegisterDevice(deviceToken DeviceToken, otherUserIds []int
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var pushReceiverId PushReceiverId
-	err = json.Unmarshal(result.Raw, &pushReceiverId)
+	err = jsonUnmarshal(result.Raw, &pushReceiverId)
 	return &pushReceiverId, err
 }
 
@@ -63,11 +165,32 @@ func (client *Client) GetPushReceiverId(payload string) (*PushReceiverId, error)
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
+		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
+	}
+
+	var pushReceiverId PushReceiverId
+	err = jsonUnmarshal(result.Raw, &pushReceiverId)
+	return &pushReceiverId, err
+}
+
+// ExecuteGetPushReceiverId Synchronously executes GetPushReceiverId, without a client. Returns a globally unique push notification subscription identifier for identification of an account, which has received a push notification. Can be called synchronously
+// @param payload JSON-encoded push notification payload
+func ExecuteGetPushReceiverId(payload string) (*PushReceiverId, error) {
+	result, err := execute(UpdateData{
+		"@type":   "getPushReceiverId",
+		"payload": payload,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var pushReceiverId PushReceiverId
-	err = json.Unmarshal(result.Raw, &pushReceiverId)
+	err = jsonUnmarshal(result.Raw, &pushReceiverId)
 	return &pushReceiverId, err
 }
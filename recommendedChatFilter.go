@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // RecommendedChatFilter Describes a recommended chat filter
 type RecommendedChatFilter struct {
 	tdCommon
@@ -25,3 +30,124 @@ func NewRecommendedChatFilter(filter *ChatFilter, description string) *Recommend
 
 	return &recommendedChatFilterTemp
 }
+
+// MarshalJSON marshals to json
+func (recommendedChatFilter *RecommendedChatFilter) MarshalJSON() ([]byte, error) {
+	return recommendedChatFilter.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (recommendedChatFilter *RecommendedChatFilter) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, recommendedChatFilter)
+}
+
+func (recommendedChatFilter *RecommendedChatFilter) appendJSON(b []byte) ([]byte, error) {
+	if recommendedChatFilter == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, recommendedChatFilter.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, recommendedChatFilter.tdCommon.Extra)
+	b = append(b, `,"filter":`...)
+	b, err = recommendedChatFilter.Filter.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"description":`...)
+	b = appendJSONString(b, recommendedChatFilter.Description)
+	return append(b, '}'), nil
+}
+
+func (recommendedChatFilter *RecommendedChatFilter) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			recommendedChatFilter.tdCommon.Type, err = r.readString()
+		case "@extra":
+			recommendedChatFilter.tdCommon.Extra, err = r.readString()
+		case "filter":
+			if r.readNull() {
+				recommendedChatFilter.Filter = nil
+			} else {
+				recommendedChatFilter.Filter = new(ChatFilter)
+				err = recommendedChatFilter.Filter.decodeJSON(r)
+			}
+		case "description":
+			recommendedChatFilter.Description, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (recommendedChatFilter *RecommendedChatFilter) Clone() *RecommendedChatFilter {
+	if recommendedChatFilter == nil {
+		return nil
+	}
+	clone := *recommendedChatFilter
+	clone.Filter = recommendedChatFilter.Filter.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (recommendedChatFilter *RecommendedChatFilter) Equal(other *RecommendedChatFilter) bool {
+	if recommendedChatFilter == nil || other == nil {
+		return recommendedChatFilter == other
+	}
+	if !recommendedChatFilter.Filter.Equal(other.Filter) {
+		return false
+	}
+	if recommendedChatFilter.Description != other.Description {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (recommendedChatFilter *RecommendedChatFilter) Diff(other *RecommendedChatFilter) []Change {
+	return recommendedChatFilter.diff("", other, nil)
+}
+
+func (recommendedChatFilter *RecommendedChatFilter) diff(path string, other *RecommendedChatFilter, changes []Change) []Change {
+	if recommendedChatFilter == nil || other == nil {
+		if recommendedChatFilter != other {
+			changes = append(changes, Change{Path: path, Old: recommendedChatFilter, New: other})
+		}
+		return changes
+	}
+	changes = recommendedChatFilter.Filter.diff(fieldPath(path, "filter"), other.Filter, changes)
+	if recommendedChatFilter.Description != other.Description {
+		changes = append(changes, Change{Path: fieldPath(path, "description"), Old: recommendedChatFilter.Description, New: other.Description})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (recommendedChatFilter *RecommendedChatFilter) String() string {
+	return objectString(recommendedChatFilter)
+}
+
+// LogValue logs the object as its summary
+func (recommendedChatFilter *RecommendedChatFilter) LogValue() slog.Value {
+	return slog.StringValue(objectString(recommendedChatFilter))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (recommendedChatFilter *RecommendedChatFilter) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, recommendedChatFilter)
+}
+
+func (recommendedChatFilter *RecommendedChatFilter) printText(p *printer) {
+	if recommendedChatFilter == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("RecommendedChatFilter")
+	p.object("filter", recommendedChatFilter.Filter)
+	p.string("description", recommendedChatFilter.Description)
+	p.endObject(state)
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // RecommendedChatFilters Contains a list of recommended chat filters
@@ -28,6 +28,150 @@ func NewRecommendedChatFilters(chatFilters []RecommendedChatFilter) *Recommended
 	return &recommendedChatFiltersTemp
 }
 
+// MarshalJSON marshals to json
+func (recommendedChatFilters *RecommendedChatFilters) MarshalJSON() ([]byte, error) {
+	return recommendedChatFilters.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (recommendedChatFilters *RecommendedChatFilters) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, recommendedChatFilters)
+}
+
+func (recommendedChatFilters *RecommendedChatFilters) appendJSON(b []byte) ([]byte, error) {
+	if recommendedChatFilters == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, recommendedChatFilters.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, recommendedChatFilters.tdCommon.Extra)
+	b = append(b, `,"chat_filters":`...)
+	if recommendedChatFilters.ChatFilters == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range recommendedChatFilters.ChatFilters {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = recommendedChatFilters.ChatFilters[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (recommendedChatFilters *RecommendedChatFilters) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			recommendedChatFilters.tdCommon.Type, err = r.readString()
+		case "@extra":
+			recommendedChatFilters.tdCommon.Extra, err = r.readString()
+		case "chat_filters":
+			if r.readNull() {
+				recommendedChatFilters.ChatFilters = nil
+			} else {
+				recommendedChatFilters.ChatFilters = make([]RecommendedChatFilter, 0)
+				err = r.readArray(func() (err error) {
+					var item0 RecommendedChatFilter
+					err = item0.decodeJSON(r)
+					recommendedChatFilters.ChatFilters = append(recommendedChatFilters.ChatFilters, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (recommendedChatFilters *RecommendedChatFilters) Clone() *RecommendedChatFilters {
+	if recommendedChatFilters == nil {
+		return nil
+	}
+	clone := *recommendedChatFilters
+	if recommendedChatFilters.ChatFilters != nil {
+		clone.ChatFilters = make([]RecommendedChatFilter, len(recommendedChatFilters.ChatFilters))
+		for i0 := range recommendedChatFilters.ChatFilters {
+			clone.ChatFilters[i0] = *recommendedChatFilters.ChatFilters[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (recommendedChatFilters *RecommendedChatFilters) Equal(other *RecommendedChatFilters) bool {
+	if recommendedChatFilters == nil || other == nil {
+		return recommendedChatFilters == other
+	}
+	if len(recommendedChatFilters.ChatFilters) != len(other.ChatFilters) {
+		return false
+	}
+	for i0 := range recommendedChatFilters.ChatFilters {
+		if !recommendedChatFilters.ChatFilters[i0].Equal(&other.ChatFilters[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (recommendedChatFilters *RecommendedChatFilters) Diff(other *RecommendedChatFilters) []Change {
+	return recommendedChatFilters.diff("", other, nil)
+}
+
+func (recommendedChatFilters *RecommendedChatFilters) diff(path string, other *RecommendedChatFilters, changes []Change) []Change {
+	if recommendedChatFilters == nil || other == nil {
+		if recommendedChatFilters != other {
+			changes = append(changes, Change{Path: path, Old: recommendedChatFilters, New: other})
+		}
+		return changes
+	}
+	if len(recommendedChatFilters.ChatFilters) != len(other.ChatFilters) {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_filters"), Old: recommendedChatFilters.ChatFilters, New: other.ChatFilters})
+	} else {
+		for i0 := range recommendedChatFilters.ChatFilters {
+			changes = recommendedChatFilters.ChatFilters[i0].diff(indexPath(fieldPath(path, "chat_filters"), i0), &other.ChatFilters[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (recommendedChatFilters *RecommendedChatFilters) String() string {
+	return objectString(recommendedChatFilters)
+}
+
+// LogValue logs the object as its summary
+func (recommendedChatFilters *RecommendedChatFilters) LogValue() slog.Value {
+	return slog.StringValue(objectString(recommendedChatFilters))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (recommendedChatFilters *RecommendedChatFilters) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, recommendedChatFilters)
+}
+
+func (recommendedChatFilters *RecommendedChatFilters) printText(p *printer) {
+	if recommendedChatFilters == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("RecommendedChatFilters")
+	p.vector("chat_filters", len(recommendedChatFilters.ChatFilters), func(i0 int) {
+		recommendedChatFilters.ChatFilters[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetRecommendedChatFilters Returns recommended chat filters for the current user
 func (client *Client) GetRecommendedChatFilters() (*RecommendedChatFilters, error) {
 	result, err := client.SendAndCatch(UpdateData{
@@ -38,11 +182,11 @@ func (client *Client) GetRecommendedChatFilters() (*RecommendedChatFilters, erro
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var recommendedChatFilters RecommendedChatFilters
-	err = json.Unmarshal(result.Raw, &recommendedChatFilters)
+	err = jsonUnmarshal(result.Raw, &recommendedChatFilters)
 	return &recommendedChatFilters, err
 }
@@ -0,0 +1,190 @@
+package tdlib
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Directions of recorded JSON lines
+const (
+	RecordSend    = "send"    // Request passed to Client.Send
+	RecordReceive = "receive" // Update or response returned by Client.Receive
+)
+
+// DefaultRecordMaxSize is the default size of the uncompressed JSON lines written to a record file before it's rotated
+const DefaultRecordMaxSize = 64 * 1024 * 1024
+
+// RecordEntry is a JSON line of a record file
+type RecordEntry struct {
+	Time      time.Time       `json:"time"`      // Time the JSON was sent or received
+	Direction string          `json:"direction"` // RecordSend or RecordReceive
+	Data      json.RawMessage `json:"data"`      // The JSON as passed to TDLib or received from it; secrets of sent requests are redacted
+}
+
+// RecordWriter writes record entries to gzip compressed files,
+// starting a new file when MaxSize bytes were written to the current one.
+// Files are named <prefix>-<timestamp>.jsonl.gz, so they sort chronologically.
+type RecordWriter struct {
+	Prefix  string // Path and name prefix of the record files
+	MaxSize int64  // Size of the uncompressed entries after which a file is rotated; DefaultRecordMaxSize if 0
+
+	lock    sync.Mutex
+	file    *os.File
+	gzip    *gzip.Writer
+	written int64
+}
+
+// NewRecordWriter creates a new RecordWriter writing files named <prefix>-<timestamp>.jsonl.gz
+func NewRecordWriter(prefix string, maxSize int64) *RecordWriter {
+	return &RecordWriter{Prefix: prefix, MaxSize: maxSize}
+}
+
+// Write writes an entry, rotating the current file if needed
+func (writer *RecordWriter) Write(entry RecordEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	writer.lock.Lock()
+	defer writer.lock.Unlock()
+
+	maxSize := writer.MaxSize
+	if maxSize == 0 {
+		maxSize = DefaultRecordMaxSize
+	}
+	if writer.file != nil && writer.written+int64(len(line)) > maxSize {
+		if err := writer.closeFile(); err != nil {
+			return err
+		}
+	}
+	if writer.file == nil {
+		if err := writer.openFile(entry.Time); err != nil {
+			return err
+		}
+	}
+
+	n, err := writer.gzip.Write(line)
+	writer.written += int64(n)
+	return err
+}
+
+// Close flushes and closes the current file
+func (writer *RecordWriter) Close() error {
+	writer.lock.Lock()
+	defer writer.lock.Unlock()
+
+	if writer.file == nil {
+		return nil
+	}
+	return writer.closeFile()
+}
+
+func (writer *RecordWriter) openFile(t time.Time) error {
+	if dir := filepath.Dir(writer.Prefix); dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+	}
+
+	name := fmt.Sprintf("%s-%s.jsonl.gz", writer.Prefix, t.UTC().Format("20060102T150405.000000000"))
+	file, err := os.OpenFile(name, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	writer.file = file
+	writer.gzip = gzip.NewWriter(file)
+	writer.written = 0
+	return nil
+}
+
+func (writer *RecordWriter) closeFile() error {
+	err := writer.gzip.Close()
+	if closeErr := writer.file.Close(); err == nil {
+		err = closeErr
+	}
+
+	writer.file = nil
+	writer.gzip = nil
+	return err
+}
+
+// RecordingTransport is a Transport recording every JSON line sent and received through another Transport,
+// to be replayed later with a ReplayTransport.
+// Secrets of sent requests, such as authentication codes and passwords, are redacted.
+type RecordingTransport struct {
+	Transport Transport     // The recorded transport
+	Writer    *RecordWriter // Writer of the record files
+	OnError   func(error)   // Called when an entry can't be written, if not nil
+}
+
+// NewRecordingTransport creates a new RecordingTransport recording the transport into the writer
+func NewRecordingTransport(transport Transport, writer *RecordWriter) *RecordingTransport {
+	return &RecordingTransport{Transport: transport, Writer: writer}
+}
+
+// NewRecordingClient creates a new TDLib client instance recording its JSON stream into the writer
+func NewRecordingClient(config Config, writer *RecordWriter) *Client {
+	// the client starts receiving as soon as it's created, so the transport must record from the start
+	transport := newTdjsonTransport()
+	client := NewClientWithTransport(config, NewRecordingTransport(transport, writer))
+	client.Client = transport.client
+	return client
+}
+
+// Send records and sends a request
+func (transport *RecordingTransport) Send(query []byte) {
+	transport.record(RecordSend, redactQuery(query))
+	transport.Transport.Send(query)
+}
+
+// Receive receives and records an update or a response
+func (transport *RecordingTransport) Receive(timeout float64) []byte {
+	result := transport.Transport.Receive(timeout)
+	if len(result) != 0 {
+		transport.record(RecordReceive, result)
+	}
+	return result
+}
+
+// Destroy destroys the recorded transport and closes the record file
+func (transport *RecordingTransport) Destroy() {
+	transport.Transport.Destroy()
+	if err := transport.Writer.Close(); err != nil && transport.OnError != nil {
+		transport.OnError(err)
+	}
+}
+
+func (transport *RecordingTransport) record(direction string, data []byte) {
+	if !json.Valid(data) {
+		// keep the record file readable
+		data, _ = json.Marshal(string(data))
+	}
+
+	err := transport.Writer.Write(RecordEntry{Time: time.Now(), Direction: direction, Data: data})
+	if err != nil && transport.OnError != nil {
+		transport.OnError(err)
+	}
+}
+
+// redactQuery returns the query with its secrets redacted
+func redactQuery(query []byte) []byte {
+	// numbers are decoded as json.Number, big integers would lose precision as float64
+	var request UpdateData
+	if err := jsonUnmarshal(query, &request); err != nil {
+		return query
+	}
+
+	redacted, err := json.Marshal(redactRequest(request))
+	if err != nil {
+		return query
+	}
+	return redacted
+}
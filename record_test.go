@@ -0,0 +1,21 @@
+package tdlib
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRedactQuery(t *testing.T) {
+	query := []byte(`{"@type":"checkAuthenticationPassword","password":"secret","chat_id":9007199254740993,"@extra":"x"}`)
+	defer SetCodec(nil)
+	for _, codec := range []Codec{StdCodec{}, FastCodec{}} {
+		SetCodec(codec)
+		redacted := string(redactQuery(query))
+		if strings.Contains(redacted, "secret") {
+			t.Errorf("%T: redacted query %s has the password", codec, redacted)
+		}
+		if !strings.Contains(redacted, `"chat_id":9007199254740993`) {
+			t.Errorf("%T: redacted query %s changes the chat identifier", codec, redacted)
+		}
+	}
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // RecoveryEmailAddress Contains information about the current recovery email address
@@ -28,6 +28,108 @@ func NewRecoveryEmailAddress(recoveryEmailAddress string) *RecoveryEmailAddress
 	return &recoveryEmailAddressTemp
 }
 
+// MarshalJSON marshals to json
+func (recoveryEmailAddress *RecoveryEmailAddress) MarshalJSON() ([]byte, error) {
+	return recoveryEmailAddress.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (recoveryEmailAddress *RecoveryEmailAddress) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, recoveryEmailAddress)
+}
+
+func (recoveryEmailAddress *RecoveryEmailAddress) appendJSON(b []byte) ([]byte, error) {
+	if recoveryEmailAddress == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, recoveryEmailAddress.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, recoveryEmailAddress.tdCommon.Extra)
+	b = append(b, `,"recovery_email_address":`...)
+	b = appendJSONString(b, recoveryEmailAddress.RecoveryEmailAddress)
+	return append(b, '}'), nil
+}
+
+func (recoveryEmailAddress *RecoveryEmailAddress) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			recoveryEmailAddress.tdCommon.Type, err = r.readString()
+		case "@extra":
+			recoveryEmailAddress.tdCommon.Extra, err = r.readString()
+		case "recovery_email_address":
+			recoveryEmailAddress.RecoveryEmailAddress, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (recoveryEmailAddress *RecoveryEmailAddress) Clone() *RecoveryEmailAddress {
+	if recoveryEmailAddress == nil {
+		return nil
+	}
+	clone := *recoveryEmailAddress
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (recoveryEmailAddress *RecoveryEmailAddress) Equal(other *RecoveryEmailAddress) bool {
+	if recoveryEmailAddress == nil || other == nil {
+		return recoveryEmailAddress == other
+	}
+	if recoveryEmailAddress.RecoveryEmailAddress != other.RecoveryEmailAddress {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (recoveryEmailAddress *RecoveryEmailAddress) Diff(other *RecoveryEmailAddress) []Change {
+	return recoveryEmailAddress.diff("", other, nil)
+}
+
+func (recoveryEmailAddress *RecoveryEmailAddress) diff(path string, other *RecoveryEmailAddress, changes []Change) []Change {
+	if recoveryEmailAddress == nil || other == nil {
+		if recoveryEmailAddress != other {
+			changes = append(changes, Change{Path: path, Old: recoveryEmailAddress, New: other})
+		}
+		return changes
+	}
+	if recoveryEmailAddress.RecoveryEmailAddress != other.RecoveryEmailAddress {
+		changes = append(changes, Change{Path: fieldPath(path, "recovery_email_address"), Old: recoveryEmailAddress.RecoveryEmailAddress, New: other.RecoveryEmailAddress})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (recoveryEmailAddress *RecoveryEmailAddress) String() string {
+	return objectString(recoveryEmailAddress)
+}
+
+// LogValue logs the object as its summary
+func (recoveryEmailAddress *RecoveryEmailAddress) LogValue() slog.Value {
+	return slog.StringValue(objectString(recoveryEmailAddress))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (recoveryEmailAddress *RecoveryEmailAddress) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, recoveryEmailAddress)
+}
+
+func (recoveryEmailAddress *RecoveryEmailAddress) printText(p *printer) {
+	if recoveryEmailAddress == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("RecoveryEmailAddress")
+	p.string("recovery_email_address", recoveryEmailAddress.RecoveryEmailAddress)
+	p.endObject(state)
+}
+
 // GetRecoveryEmailAddress Returns a 2-step verification recovery email address that was previously set up. This method can be used to verify a password provided by the user
 // @param password The password for the current user
 func (client *Client) GetRecoveryEmailAddress(password string) (*RecoveryEmailAddress, error) {
@@ -40,11 +142,11 @@ func (client *Client) GetRecoveryEmailAddress(password string) (*RecoveryEmailAd
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var recoveryEmailAddress RecoveryEmailAddress
-	err = json.Unmarshal(result.Raw, &recoveryEmailAddress)
+	err = jsonUnmarshal(result.Raw, &recoveryEmailAddress)
 	return &recoveryEmailAddress, err
 }
@@ -0,0 +1,12724 @@
+package tdlib
+
+// classSchemas describes every abstract class, in declaration order
+var classSchemas = []*ClassSchema{
+	{
+		Name:        "AuthenticationCodeType",
+		Description: "Provides information about the method by which an authentication code is delivered to the user",
+		Types:       []string{"authenticationCodeTypeTelegramMessage", "authenticationCodeTypeSms", "authenticationCodeTypeCall", "authenticationCodeTypeFlashCall", "authenticationCodeTypeMissedCall"},
+	},
+	{
+		Name:        "AuthorizationState",
+		Description: "Represents the current authorization state of the TDLib client",
+		Types:       []string{"authorizationStateWaitTdlibParameters", "authorizationStateWaitEncryptionKey", "authorizationStateWaitPhoneNumber", "authorizationStateWaitCode", "authorizationStateWaitOtherDeviceConfirmation", "authorizationStateWaitRegistration", "authorizationStateWaitPassword", "authorizationStateReady", "authorizationStateLoggingOut", "authorizationStateClosing", "authorizationStateClosed"},
+	},
+	{
+		Name:        "BackgroundFill",
+		Description: "Describes a fill of a background",
+		Types:       []string{"backgroundFillSolid", "backgroundFillGradient", "backgroundFillFreeformGradient"},
+	},
+	{
+		Name:        "BackgroundType",
+		Description: "Describes the type of a background",
+		Types:       []string{"backgroundTypeWallpaper", "backgroundTypePattern", "backgroundTypeFill"},
+	},
+	{
+		Name:        "BotCommandScope",
+		Description: "Represents the scope to which bot commands are relevant",
+		Types:       []string{"botCommandScopeDefault", "botCommandScopeAllPrivateChats", "botCommandScopeAllGroupChats", "botCommandScopeAllChatAdministrators", "botCommandScopeChat", "botCommandScopeChatAdministrators", "botCommandScopeChatMember"},
+	},
+	{
+		Name:        "CallDiscardReason",
+		Description: "Describes the reason why a call was discarded",
+		Types:       []string{"callDiscardReasonEmpty", "callDiscardReasonMissed", "callDiscardReasonDeclined", "callDiscardReasonDisconnected", "callDiscardReasonHungUp"},
+	},
+	{
+		Name:        "CallProblem",
+		Description: "Describes the exact type of a problem with a call",
+		Types:       []string{"callProblemEcho", "callProblemNoise", "callProblemInterruptions", "callProblemDistortedSpeech", "callProblemSilentLocal", "callProblemSilentRemote", "callProblemDropped", "callProblemDistortedVideo", "callProblemPixelatedVideo"},
+	},
+	{
+		Name:        "CallServerType",
+		Description: "Describes the type of a call server",
+		Types:       []string{"callServerTypeTelegramReflector", "callServerTypeWebrtc"},
+	},
+	{
+		Name:        "CallState",
+		Description: "Describes the current call state",
+		Types:       []string{"callStatePending", "callStateExchangingKeys", "callStateReady", "callStateHangingUp", "callStateDiscarded", "callStateError"},
+	},
+	{
+		Name:        "CallbackQueryPayload",
+		Description: "Represents a payload of a callback query",
+		Types:       []string{"callbackQueryPayloadData", "callbackQueryPayloadDataWithPassword", "callbackQueryPayloadGame"},
+	},
+	{
+		Name:        "CanTransferOwnershipResult",
+		Description: "Represents result of checking whether the current session can be used to transfer a chat ownership to another user",
+		Types:       []string{"canTransferOwnershipResultOk", "canTransferOwnershipResultPasswordNeeded", "canTransferOwnershipResultPasswordTooFresh", "canTransferOwnershipResultSessionTooFresh"},
+	},
+	{
+		Name:        "ChatAction",
+		Description: "Describes the different types of activity in a chat",
+		Types:       []string{"chatActionTyping", "chatActionRecordingVideo", "chatActionUploadingVideo", "chatActionRecordingVoiceNote", "chatActionUploadingVoiceNote", "chatActionUploadingPhoto", "chatActionUploadingDocument", "chatActionChoosingSticker", "chatActionChoosingLocation", "chatActionChoosingContact", "chatActionStartPlayingGame", "chatActionRecordingVideoNote", "chatActionUploadingVideoNote", "chatActionWatchingAnimations", "chatActionCancel"},
+	},
+	{
+		Name:        "ChatActionBar",
+		Description: "Describes actions which must be possible to do through a chat action bar",
+		Types:       []string{"chatActionBarReportSpam", "chatActionBarReportUnrelatedLocation", "chatActionBarInviteMembers", "chatActionBarReportAddBlock", "chatActionBarAddContact", "chatActionBarSharePhoneNumber", "chatActionBarJoinRequest"},
+	},
+	{
+		Name:        "ChatEventAction",
+		Description: "Represents a chat event",
+		Types:       []string{"chatEventMessageEdited", "chatEventMessageDeleted", "chatEventPollStopped", "chatEventMessagePinned", "chatEventMessageUnpinned", "chatEventMemberJoined", "chatEventMemberJoinedByInviteLink", "chatEventMemberJoinedByRequest", "chatEventMemberLeft", "chatEventMemberInvited", "chatEventMemberPromoted", "chatEventMemberRestricted", "chatEventTitleChanged", "chatEventPermissionsChanged", "chatEventDescriptionChanged", "chatEventUsernameChanged", "chatEventPhotoChanged", "chatEventInvitesToggled", "chatEventLinkedChatChanged", "chatEventSlowModeDelayChanged", "chatEventMessageTtlChanged", "chatEventSignMessagesToggled", "chatEventHasProtectedContentToggled", "chatEventStickerSetChanged", "chatEventLocationChanged", "chatEventIsAllHistoryAvailableToggled", "chatEventInviteLinkEdited", "chatEventInviteLinkRevoked", "chatEventInviteLinkDeleted", "chatEventVideoChatCreated", "chatEventVideoChatEnded", "chatEventVideoChatParticipantIsMutedToggled", "chatEventVideoChatParticipantVolumeLevelChanged", "chatEventVideoChatMuteNewParticipantsToggled"},
+	},
+	{
+		Name:        "ChatList",
+		Description: "Describes a list of chats",
+		Types:       []string{"chatListMain", "chatListArchive", "chatListFilter"},
+	},
+	{
+		Name:        "ChatMemberStatus",
+		Description: "Provides information about the status of a member in a chat",
+		Types:       []string{"chatMemberStatusCreator", "chatMemberStatusAdministrator", "chatMemberStatusMember", "chatMemberStatusRestricted", "chatMemberStatusLeft", "chatMemberStatusBanned"},
+	},
+	{
+		Name:        "ChatMembersFilter",
+		Description: "Specifies the kind of chat members to return in searchChatMembers",
+		Types:       []string{"chatMembersFilterContacts", "chatMembersFilterAdministrators", "chatMembersFilterMembers", "chatMembersFilterMention", "chatMembersFilterRestricted", "chatMembersFilterBanned", "chatMembersFilterBots"},
+	},
+	{
+		Name:        "ChatReportReason",
+		Description: "Describes the reason why a chat is reported",
+		Types:       []string{"chatReportReasonSpam", "chatReportReasonViolence", "chatReportReasonPornography", "chatReportReasonChildAbuse", "chatReportReasonCopyright", "chatReportReasonUnrelatedLocation", "chatReportReasonFake", "chatReportReasonCustom"},
+	},
+	{
+		Name:        "ChatSource",
+		Description: "Describes a reason why an external chat is shown in a chat list",
+		Types:       []string{"chatSourceMtprotoProxy", "chatSourcePublicServiceAnnouncement"},
+	},
+	{
+		Name:        "ChatStatistics",
+		Description: "Contains a detailed statistics about a chat",
+		Types:       []string{"chatStatisticsSupergroup", "chatStatisticsChannel"},
+	},
+	{
+		Name:        "ChatType",
+		Description: "Describes the type of a chat",
+		Types:       []string{"chatTypePrivate", "chatTypeBasicGroup", "chatTypeSupergroup", "chatTypeSecret"},
+	},
+	{
+		Name:        "CheckChatUsernameResult",
+		Description: "Represents result of checking whether a username can be set for a chat",
+		Types:       []string{"checkChatUsernameResultOk", "checkChatUsernameResultUsernameInvalid", "checkChatUsernameResultUsernameOccupied", "checkChatUsernameResultPublicChatsTooMuch", "checkChatUsernameResultPublicGroupsUnavailable"},
+	},
+	{
+		Name:        "CheckStickerSetNameResult",
+		Description: "Represents result of checking whether a name can be used for a new sticker set",
+		Types:       []string{"checkStickerSetNameResultOk", "checkStickerSetNameResultNameInvalid", "checkStickerSetNameResultNameOccupied"},
+	},
+	{
+		Name:        "ConnectionState",
+		Description: "Describes the current state of the connection to Telegram servers",
+		Types:       []string{"connectionStateWaitingForNetwork", "connectionStateConnectingToProxy", "connectionStateConnecting", "connectionStateUpdating", "connectionStateReady"},
+	},
+	{
+		Name:        "DeviceToken",
+		Description: "Represents a data needed to subscribe for push notifications through registerDevice method. To use specific push notification service, the correct application platform must be specified and a valid server authentication data must be uploaded at https://my.telegram.org",
+		Types:       []string{"deviceTokenFirebaseCloudMessaging", "deviceTokenApplePush", "deviceTokenApplePushVoIP", "deviceTokenWindowsPush", "deviceTokenMicrosoftPush", "deviceTokenMicrosoftPushVoIP", "deviceTokenWebPush", "deviceTokenSimplePush", "deviceTokenUbuntuPush", "deviceTokenBlackBerryPush", "deviceTokenTizenPush"},
+	},
+	{
+		Name:        "DiceStickers",
+		Description: "Contains animated stickers which must be used for dice animation rendering",
+		Types:       []string{"diceStickersRegular", "diceStickersSlotMachine"},
+	},
+	{
+		Name:        "FileType",
+		Description: "Represents the type of a file",
+		Types:       []string{"fileTypeNone", "fileTypeAnimation", "fileTypeAudio", "fileTypeDocument", "fileTypePhoto", "fileTypeProfilePhoto", "fileTypeSecret", "fileTypeSecretThumbnail", "fileTypeSecure", "fileTypeSticker", "fileTypeThumbnail", "fileTypeUnknown", "fileTypeVideo", "fileTypeVideoNote", "fileTypeVoiceNote", "fileTypeWallpaper"},
+	},
+	{
+		Name:        "GroupCallVideoQuality",
+		Description: "Describes the quality of a group call video",
+		Types:       []string{"groupCallVideoQualityThumbnail", "groupCallVideoQualityMedium", "groupCallVideoQualityFull"},
+	},
+	{
+		Name:        "InlineKeyboardButtonType",
+		Description: "Describes the type of an inline keyboard button",
+		Types:       []string{"inlineKeyboardButtonTypeUrl", "inlineKeyboardButtonTypeLoginUrl", "inlineKeyboardButtonTypeCallback", "inlineKeyboardButtonTypeCallbackWithPassword", "inlineKeyboardButtonTypeCallbackGame", "inlineKeyboardButtonTypeSwitchInline", "inlineKeyboardButtonTypeBuy", "inlineKeyboardButtonTypeUser"},
+	},
+	{
+		Name:        "InlineQueryResult",
+		Description: "Represents a single result of an inline query",
+		Types:       []string{"inlineQueryResultArticle", "inlineQueryResultContact", "inlineQueryResultLocation", "inlineQueryResultVenue", "inlineQueryResultGame", "inlineQueryResultAnimation", "inlineQueryResultAudio", "inlineQueryResultDocument", "inlineQueryResultPhoto", "inlineQueryResultSticker", "inlineQueryResultVideo", "inlineQueryResultVoiceNote"},
+	},
+	{
+		Name:        "InputBackground",
+		Description: "Contains information about background to set",
+		Types:       []string{"inputBackgroundLocal", "inputBackgroundRemote"},
+	},
+	{
+		Name:        "InputChatPhoto",
+		Description: "Describes a photo to be set as a user profile or chat photo",
+		Types:       []string{"inputChatPhotoPrevious", "inputChatPhotoStatic", "inputChatPhotoAnimation"},
+	},
+	{
+		Name:        "InputCredentials",
+		Description: "Contains information about the payment method chosen by the user",
+		Types:       []string{"inputCredentialsSaved", "inputCredentialsNew", "inputCredentialsApplePay", "inputCredentialsGooglePay"},
+	},
+	{
+		Name:        "InputFile",
+		Description: "Points to a file",
+		Types:       []string{"inputFileId", "inputFileRemote", "inputFileLocal", "inputFileGenerated"},
+	},
+	{
+		Name:        "InputInlineQueryResult",
+		Description: "Represents a single result of an inline query; for bots only",
+		Types:       []string{"inputInlineQueryResultAnimation", "inputInlineQueryResultArticle", "inputInlineQueryResultAudio", "inputInlineQueryResultContact", "inputInlineQueryResultDocument", "inputInlineQueryResultGame", "inputInlineQueryResultLocation", "inputInlineQueryResultPhoto", "inputInlineQueryResultSticker", "inputInlineQueryResultVenue", "inputInlineQueryResultVideo", "inputInlineQueryResultVoiceNote"},
+	},
+	{
+		Name:        "InputMessageContent",
+		Description: "The content of a message to send",
+		Types:       []string{"inputMessageText", "inputMessageAnimation", "inputMessageAudio", "inputMessageDocument", "inputMessagePhoto", "inputMessageSticker", "inputMessageVideo", "inputMessageVideoNote", "inputMessageVoiceNote", "inputMessageLocation", "inputMessageVenue", "inputMessageContact", "inputMessageDice", "inputMessageGame", "inputMessageInvoice", "inputMessagePoll", "inputMessageForwarded"},
+	},
+	{
+		Name:        "InputPassportElement",
+		Description: "Contains information about a Telegram Passport element to be saved",
+		Types:       []string{"inputPassportElementPersonalDetails", "inputPassportElementPassport", "inputPassportElementDriverLicense", "inputPassportElementIdentityCard", "inputPassportElementInternalPassport", "inputPassportElementAddress", "inputPassportElementUtilityBill", "inputPassportElementBankStatement", "inputPassportElementRentalAgreement", "inputPassportElementPassportRegistration", "inputPassportElementTemporaryRegistration", "inputPassportElementPhoneNumber", "inputPassportElementEmailAddress"},
+	},
+	{
+		Name:        "InputPassportElementErrorSource",
+		Description: "Contains the description of an error in a Telegram Passport element; for bots only",
+		Types:       []string{"inputPassportElementErrorSourceUnspecified", "inputPassportElementErrorSourceDataField", "inputPassportElementErrorSourceFrontSide", "inputPassportElementErrorSourceReverseSide", "inputPassportElementErrorSourceSelfie", "inputPassportElementErrorSourceTranslationFile", "inputPassportElementErrorSourceTranslationFiles", "inputPassportElementErrorSourceFile", "inputPassportElementErrorSourceFiles"},
+	},
+	{
+		Name:        "InputSticker",
+		Description: "Describes a sticker that needs to be added to a sticker set",
+		Types:       []string{"inputStickerStatic", "inputStickerAnimated"},
+	},
+	{
+		Name:        "InternalLinkType",
+		Description: "Describes an internal https://t.me or tg: link, which must be processed by the app in a special way",
+		Types:       []string{"internalLinkTypeActiveSessions", "internalLinkTypeAuthenticationCode", "internalLinkTypeBackground", "internalLinkTypeBotStart", "internalLinkTypeBotStartInGroup", "internalLinkTypeChangePhoneNumber", "internalLinkTypeChatInvite", "internalLinkTypeFilterSettings", "internalLinkTypeGame", "internalLinkTypeLanguagePack", "internalLinkTypeMessage", "internalLinkTypeMessageDraft", "internalLinkTypePassportDataRequest", "internalLinkTypePhoneNumberConfirmation", "internalLinkTypeProxy", "internalLinkTypePublicChat", "internalLinkTypeQrCodeAuthentication", "internalLinkTypeSettings", "internalLinkTypeStickerSet", "internalLinkTypeTheme", "internalLinkTypeThemeSettings", "internalLinkTypeUnknownDeepLink", "internalLinkTypeUnsupportedProxy", "internalLinkTypeVideoChat"},
+	},
+	{
+		Name:        "JsonValue",
+		Description: "Represents a JSON value",
+		Types:       []string{"jsonValueNull", "jsonValueBoolean", "jsonValueNumber", "jsonValueString", "jsonValueArray", "jsonValueObject"},
+	},
+	{
+		Name:        "KeyboardButtonType",
+		Description: "Describes a keyboard button type",
+		Types:       []string{"keyboardButtonTypeText", "keyboardButtonTypeRequestPhoneNumber", "keyboardButtonTypeRequestLocation", "keyboardButtonTypeRequestPoll"},
+	},
+	{
+		Name:        "LanguagePackStringValue",
+		Description: "Represents the value of a string in a language pack",
+		Types:       []string{"languagePackStringValueOrdinary", "languagePackStringValuePluralized", "languagePackStringValueDeleted"},
+	},
+	{
+		Name:        "LogStream",
+		Description: "Describes a stream to which TDLib internal log is written",
+		Types:       []string{"logStreamDefault", "logStreamFile", "logStreamEmpty"},
+	},
+	{
+		Name:        "LoginUrlInfo",
+		Description: "Contains information about an inline button of type inlineKeyboardButtonTypeLoginUrl",
+		Types:       []string{"loginUrlInfoOpen", "loginUrlInfoRequestConfirmation"},
+	},
+	{
+		Name:        "MaskPoint",
+		Description: "Part of the face, relative to which a mask is placed",
+		Types:       []string{"maskPointForehead", "maskPointEyes", "maskPointMouth", "maskPointChin"},
+	},
+	{
+		Name:        "MessageContent",
+		Description: "Contains the content of a message",
+		Types:       []string{"messageText", "messageAnimation", "messageAudio", "messageDocument", "messagePhoto", "messageExpiredPhoto", "messageSticker", "messageVideo", "messageExpiredVideo", "messageVideoNote", "messageVoiceNote", "messageLocation", "messageVenue", "messageContact", "messageAnimatedEmoji", "messageDice", "messageGame", "messagePoll", "messageInvoice", "messageCall", "messageVideoChatScheduled", "messageVideoChatStarted", "messageVideoChatEnded", "messageInviteVideoChatParticipants", "messageBasicGroupChatCreate", "messageSupergroupChatCreate", "messageChatChangeTitle", "messageChatChangePhoto", "messageChatDeletePhoto", "messageChatAddMembers", "messageChatJoinByLink", "messageChatJoinByRequest", "messageChatDeleteMember", "messageChatUpgradeTo", "messageChatUpgradeFrom", "messagePinMessage", "messageScreenshotTaken", "messageChatSetTheme", "messageChatSetTtl", "messageCustomServiceAction", "messageGameScore", "messagePaymentSuccessful", "messagePaymentSuccessfulBot", "messageContactRegistered", "messageWebsiteConnected", "messagePassportDataSent", "messagePassportDataReceived", "messageProximityAlertTriggered", "messageUnsupported"},
+	},
+	{
+		Name:        "MessageFileType",
+		Description: "Contains information about a file with messages exported from another app",
+		Types:       []string{"messageFileTypePrivate", "messageFileTypeGroup", "messageFileTypeUnknown"},
+	},
+	{
+		Name:        "MessageForwardOrigin",
+		Description: "Contains information about the origin of a forwarded message",
+		Types:       []string{"messageForwardOriginUser", "messageForwardOriginChat", "messageForwardOriginHiddenUser", "messageForwardOriginChannel", "messageForwardOriginMessageImport"},
+	},
+	{
+		Name:        "MessageSchedulingState",
+		Description: "Contains information about the time when a scheduled message will be sent",
+		Types:       []string{"messageSchedulingStateSendAtDate", "messageSchedulingStateSendWhenOnline"},
+	},
+	{
+		Name:        "MessageSender",
+		Description: "Contains information about the sender of a message",
+		Types:       []string{"messageSenderUser", "messageSenderChat"},
+	},
+	{
+		Name:        "MessageSendingState",
+		Description: "Contains information about the sending state of the message",
+		Types:       []string{"messageSendingStatePending", "messageSendingStateFailed"},
+	},
+	{
+		Name:        "NetworkStatisticsEntry",
+		Description: "Contains statistics about network usage",
+		Types:       []string{"networkStatisticsEntryFile", "networkStatisticsEntryCall"},
+	},
+	{
+		Name:        "NetworkType",
+		Description: "Represents the type of a network",
+		Types:       []string{"networkTypeNone", "networkTypeMobile", "networkTypeMobileRoaming", "networkTypeWiFi", "networkTypeOther"},
+	},
+	{
+		Name:        "NotificationGroupType",
+		Description: "Describes the type of notifications in a notification group",
+		Types:       []string{"notificationGroupTypeMessages", "notificationGroupTypeMentions", "notificationGroupTypeSecretChat", "notificationGroupTypeCalls"},
+	},
+	{
+		Name:        "NotificationSettingsScope",
+		Description: "Describes the types of chats to which notification settings are relevant",
+		Types:       []string{"notificationSettingsScopePrivateChats", "notificationSettingsScopeGroupChats", "notificationSettingsScopeChannelChats"},
+	},
+	{
+		Name:        "NotificationType",
+		Description: "Contains detailed information about a notification",
+		Types:       []string{"notificationTypeNewMessage", "notificationTypeNewSecretChat", "notificationTypeNewCall", "notificationTypeNewPushMessage"},
+	},
+	{
+		Name:        "OptionValue",
+		Description: "Represents the value of an option",
+		Types:       []string{"optionValueBoolean", "optionValueEmpty", "optionValueInteger", "optionValueString"},
+	},
+	{
+		Name:        "PageBlock",
+		Description: "Describes a block of an instant view web page",
+		Types:       []string{"pageBlockTitle", "pageBlockSubtitle", "pageBlockAuthorDate", "pageBlockHeader", "pageBlockSubheader", "pageBlockKicker", "pageBlockParagraph", "pageBlockPreformatted", "pageBlockFooter", "pageBlockDivider", "pageBlockAnchor", "pageBlockList", "pageBlockBlockQuote", "pageBlockPullQuote", "pageBlockAnimation", "pageBlockAudio", "pageBlockPhoto", "pageBlockVideo", "pageBlockVoiceNote", "pageBlockCover", "pageBlockEmbedded", "pageBlockEmbeddedPost", "pageBlockCollage", "pageBlockSlideshow", "pageBlockChatLink", "pageBlockTable", "pageBlockDetails", "pageBlockRelatedArticles", "pageBlockMap"},
+	},
+	{
+		Name:        "PageBlockHorizontalAlignment",
+		Description: "Describes a horizontal alignment of a table cell content",
+		Types:       []string{"pageBlockHorizontalAlignmentLeft", "pageBlockHorizontalAlignmentCenter", "pageBlockHorizontalAlignmentRight"},
+	},
+	{
+		Name:        "PageBlockVerticalAlignment",
+		Description: "Describes a Vertical alignment of a table cell content",
+		Types:       []string{"pageBlockVerticalAlignmentTop", "pageBlockVerticalAlignmentMiddle", "pageBlockVerticalAlignmentBottom"},
+	},
+	{
+		Name:        "PassportElement",
+		Description: "Contains information about a Telegram Passport element",
+		Types:       []string{"passportElementPersonalDetails", "passportElementPassport", "passportElementDriverLicense", "passportElementIdentityCard", "passportElementInternalPassport", "passportElementAddress", "passportElementUtilityBill", "passportElementBankStatement", "passportElementRentalAgreement", "passportElementPassportRegistration", "passportElementTemporaryRegistration", "passportElementPhoneNumber", "passportElementEmailAddress"},
+	},
+	{
+		Name:        "PassportElementErrorSource",
+		Description: "Contains the description of an error in a Telegram Passport element",
+		Types:       []string{"passportElementErrorSourceUnspecified", "passportElementErrorSourceDataField", "passportElementErrorSourceFrontSide", "passportElementErrorSourceReverseSide", "passportElementErrorSourceSelfie", "passportElementErrorSourceTranslationFile", "passportElementErrorSourceTranslationFiles", "passportElementErrorSourceFile", "passportElementErrorSourceFiles"},
+	},
+	{
+		Name:        "PassportElementType",
+		Description: "Contains the type of a Telegram Passport element",
+		Types:       []string{"passportElementTypePersonalDetails", "passportElementTypePassport", "passportElementTypeDriverLicense", "passportElementTypeIdentityCard", "passportElementTypeInternalPassport", "passportElementTypeAddress", "passportElementTypeUtilityBill", "passportElementTypeBankStatement", "passportElementTypeRentalAgreement", "passportElementTypePassportRegistration", "passportElementTypeTemporaryRegistration", "passportElementTypePhoneNumber", "passportElementTypeEmailAddress"},
+	},
+	{
+		Name:        "PollType",
+		Description: "Describes the type of a poll",
+		Types:       []string{"pollTypeRegular", "pollTypeQuiz"},
+	},
+	{
+		Name:        "ProxyType",
+		Description: "Describes the type of a proxy server",
+		Types:       []string{"proxyTypeSocks5", "proxyTypeHttp", "proxyTypeMtproto"},
+	},
+	{
+		Name:        "PublicChatType",
+		Description: "Describes a type of public chats",
+		Types:       []string{"publicChatTypeHasUsername", "publicChatTypeIsLocationBased"},
+	},
+	{
+		Name:        "PushMessageContent",
+		Description: "Contains content of a push message notification",
+		Types:       []string{"pushMessageContentHidden", "pushMessageContentAnimation", "pushMessageContentAudio", "pushMessageContentContact", "pushMessageContentContactRegistered", "pushMessageContentDocument", "pushMessageContentGame", "pushMessageContentGameScore", "pushMessageContentInvoice", "pushMessageContentLocation", "pushMessageContentPhoto", "pushMessageContentPoll", "pushMessageContentScreenshotTaken", "pushMessageContentSticker", "pushMessageContentText", "pushMessageContentVideo", "pushMessageContentVideoNote", "pushMessageContentVoiceNote", "pushMessageContentBasicGroupChatCreate", "pushMessageContentChatAddMembers", "pushMessageContentChatChangePhoto", "pushMessageContentChatChangeTitle", "pushMessageContentChatSetTheme", "pushMessageContentChatDeleteMember", "pushMessageContentChatJoinByLink", "pushMessageContentChatJoinByRequest", "pushMessageContentMessageForwards", "pushMessageContentMediaAlbum"},
+	},
+	{
+		Name:        "ReplyMarkup",
+		Description: "Contains a description of a custom keyboard and actions that can be done with it to quickly reply to bots",
+		Types:       []string{"replyMarkupRemoveKeyboard", "replyMarkupForceReply", "replyMarkupShowKeyboard", "replyMarkupInlineKeyboard"},
+	},
+	{
+		Name:        "ResetPasswordResult",
+		Description: "Represents result of 2-step verification password reset",
+		Types:       []string{"resetPasswordResultOk", "resetPasswordResultPending", "resetPasswordResultDeclined"},
+	},
+	{
+		Name:        "RichText",
+		Description: "Describes a text object inside an instant-view web page",
+		Types:       []string{"richTextPlain", "richTextBold", "richTextItalic", "richTextUnderline", "richTextStrikethrough", "richTextFixed", "richTextUrl", "richTextEmailAddress", "richTextSubscript", "richTextSuperscript", "richTextMarked", "richTextPhoneNumber", "richTextIcon", "richTextReference", "richTextAnchor", "richTextAnchorLink", "richTexts"},
+	},
+	{
+		Name:        "SearchMessagesFilter",
+		Description: "Represents a filter for message search results",
+		Types:       []string{"searchMessagesFilterEmpty", "searchMessagesFilterAnimation", "searchMessagesFilterAudio", "searchMessagesFilterDocument", "searchMessagesFilterPhoto", "searchMessagesFilterVideo", "searchMessagesFilterVoiceNote", "searchMessagesFilterPhotoAndVideo", "searchMessagesFilterUrl", "searchMessagesFilterChatPhoto", "searchMessagesFilterVideoNote", "searchMessagesFilterVoiceAndVideoNote", "searchMessagesFilterMention", "searchMessagesFilterUnreadMention", "searchMessagesFilterFailedToSend", "searchMessagesFilterPinned"},
+	},
+	{
+		Name:        "SecretChatState",
+		Description: "Describes the current secret chat state",
+		Types:       []string{"secretChatStatePending", "secretChatStateReady", "secretChatStateClosed"},
+	},
+	{
+		Name:        "StatisticalGraph",
+		Description: "Describes a statistical graph",
+		Types:       []string{"statisticalGraphData", "statisticalGraphAsync", "statisticalGraphError"},
+	},
+	{
+		Name:        "SuggestedAction",
+		Description: "Describes an action suggested to the current user",
+		Types:       []string{"suggestedActionEnableArchiveAndMuteNewChats", "suggestedActionCheckPassword", "suggestedActionCheckPhoneNumber", "suggestedActionViewChecksHint", "suggestedActionConvertToBroadcastGroup", "suggestedActionSetPassword"},
+	},
+	{
+		Name:        "SupergroupMembersFilter",
+		Description: "Specifies the kind of chat members to return in getSupergroupMembers",
+		Types:       []string{"supergroupMembersFilterRecent", "supergroupMembersFilterContacts", "supergroupMembersFilterAdministrators", "supergroupMembersFilterSearch", "supergroupMembersFilterRestricted", "supergroupMembersFilterBanned", "supergroupMembersFilterMention", "supergroupMembersFilterBots"},
+	},
+	{
+		Name:        "TMeUrlType",
+		Description: "Describes the type of a URL linking to an internal Telegram entity",
+		Types:       []string{"tMeUrlTypeUser", "tMeUrlTypeSupergroup", "tMeUrlTypeChatInvite", "tMeUrlTypeStickerSet"},
+	},
+	{
+		Name:        "TextEntityType",
+		Description: "Represents a part of the text which must be formatted differently",
+		Types:       []string{"textEntityTypeMention", "textEntityTypeHashtag", "textEntityTypeCashtag", "textEntityTypeBotCommand", "textEntityTypeUrl", "textEntityTypeEmailAddress", "textEntityTypePhoneNumber", "textEntityTypeBankCardNumber", "textEntityTypeBold", "textEntityTypeItalic", "textEntityTypeUnderline", "textEntityTypeStrikethrough", "textEntityTypeSpoiler", "textEntityTypeCode", "textEntityTypePre", "textEntityTypePreCode", "textEntityTypeTextUrl", "textEntityTypeMentionName", "textEntityTypeMediaTimestamp"},
+	},
+	{
+		Name:        "TextParseMode",
+		Description: "Describes the way the text needs to be parsed for TextEntities",
+		Types:       []string{"textParseModeMarkdown", "textParseModeHTML"},
+	},
+	{
+		Name:        "ThumbnailFormat",
+		Description: "Describes format of the thumbnail",
+		Types:       []string{"thumbnailFormatJpeg", "thumbnailFormatPng", "thumbnailFormatWebp", "thumbnailFormatGif", "thumbnailFormatTgs", "thumbnailFormatMpeg4"},
+	},
+	{
+		Name:        "TopChatCategory",
+		Description: "Represents the categories of chats for which a list of frequently used chats can be retrieved",
+		Types:       []string{"topChatCategoryUsers", "topChatCategoryBots", "topChatCategoryGroups", "topChatCategoryChannels", "topChatCategoryInlineBots", "topChatCategoryCalls", "topChatCategoryForwardChats"},
+	},
+	{
+		Name:        "Update",
+		Description: "Contains notifications about data changes",
+		Types:       []string{"updateAuthorizationState", "updateNewMessage", "updateMessageSendAcknowledged", "updateMessageSendSucceeded", "updateMessageSendFailed", "updateMessageContent", "updateMessageEdited", "updateMessageIsPinned", "updateMessageInteractionInfo", "updateMessageContentOpened", "updateMessageMentionRead", "updateMessageLiveLocationViewed", "updateNewChat", "updateChatTitle", "updateChatPhoto", "updateChatPermissions", "updateChatLastMessage", "updateChatPosition", "updateChatReadInbox", "updateChatReadOutbox", "updateChatActionBar", "updateChatDraftMessage", "updateChatMessageSender", "updateChatMessageTtl", "updateChatNotificationSettings", "updateChatPendingJoinRequests", "updateChatReplyMarkup", "updateChatTheme", "updateChatUnreadMentionCount", "updateChatVideoChat", "updateChatDefaultDisableNotification", "updateChatHasProtectedContent", "updateChatHasScheduledMessages", "updateChatIsBlocked", "updateChatIsMarkedAsUnread", "updateChatFilters", "updateChatOnlineMemberCount", "updateScopeNotificationSettings", "updateNotification", "updateNotificationGroup", "updateActiveNotifications", "updateHavePendingNotifications", "updateDeleteMessages", "updateChatAction", "updateUserStatus", "updateUser", "updateBasicGroup", "updateSupergroup", "updateSecretChat", "updateUserFullInfo", "updateBasicGroupFullInfo", "updateSupergroupFullInfo", "updateServiceNotification", "updateFile", "updateFileGenerationStart", "updateFileGenerationStop", "updateCall", "updateGroupCall", "updateGroupCallParticipant", "updateNewCallSignalingData", "updateUserPrivacySettingRules", "updateUnreadMessageCount", "updateUnreadChatCount", "updateOption", "updateStickerSet", "updateInstalledStickerSets", "updateTrendingStickerSets", "updateRecentStickers", "updateFavoriteStickers", "updateSavedAnimations", "updateSelectedBackground", "updateChatThemes", "updateLanguagePackStrings", "updateConnectionState", "updateTermsOfService", "updateUsersNearby", "updateDiceEmojis", "updateAnimatedEmojiMessageClicked", "updateAnimationSearchParameters", "updateSuggestedActions", "updateNewInlineQuery", "updateNewChosenInlineResult", "updateNewCallbackQuery", "updateNewInlineCallbackQuery", "updateNewShippingQuery", "updateNewPreCheckoutQuery", "updateNewCustomEvent", "updateNewCustomQuery", "updatePoll", "updatePollAnswer", "updateChatMember", "updateNewChatJoinRequest"},
+	},
+	{
+		Name:        "UserPrivacySetting",
+		Description: "Describes available user privacy settings",
+		Types:       []string{"userPrivacySettingShowStatus", "userPrivacySettingShowProfilePhoto", "userPrivacySettingShowLinkInForwardedMessages", "userPrivacySettingShowPhoneNumber", "userPrivacySettingAllowChatInvites", "userPrivacySettingAllowCalls", "userPrivacySettingAllowPeerToPeerCalls", "userPrivacySettingAllowFindingByPhoneNumber"},
+	},
+	{
+		Name:        "UserPrivacySettingRule",
+		Description: "Represents a single rule for managing privacy settings",
+		Types:       []string{"userPrivacySettingRuleAllowAll", "userPrivacySettingRuleAllowContacts", "userPrivacySettingRuleAllowUsers", "userPrivacySettingRuleAllowChatMembers", "userPrivacySettingRuleRestrictAll", "userPrivacySettingRuleRestrictContacts", "userPrivacySettingRuleRestrictUsers", "userPrivacySettingRuleRestrictChatMembers"},
+	},
+	{
+		Name:        "UserStatus",
+		Description: "Describes the last time the user was online",
+		Types:       []string{"userStatusEmpty", "userStatusOnline", "userStatusOffline", "userStatusRecently", "userStatusLastWeek", "userStatusLastMonth"},
+	},
+	{
+		Name:        "UserType",
+		Description: "Represents the type of a user. The following types are possible: regular users, deleted users and bots",
+		Types:       []string{"userTypeRegular", "userTypeDeleted", "userTypeBot", "userTypeUnknown"},
+	},
+	{
+		Name:        "VectorPathCommand",
+		Description: "Represents a vector path command",
+		Types:       []string{"vectorPathCommandLine", "vectorPathCommandCubicBezierCurve"},
+	},
+}
+
+// typeSchemas describes every type, in declaration order
+var typeSchemas = []*TypeSchema{
+	{
+		Name:        "accountTtl",
+		Class:       "AccountTtl",
+		Description: "Contains information about the period of inactivity after which the current user's account will automatically be deleted",
+		Fields: []ParamSchema{
+			{Name: "days", Type: "int32", Description: "Number of days of inactivity before the account will be flagged for deletion; 30-366 days"},
+		},
+	},
+	{
+		Name:        "address",
+		Class:       "Address",
+		Description: "Describes an address",
+		Fields: []ParamSchema{
+			{Name: "country_code", Type: "string", Description: "A two-letter ISO 3166-1 alpha-2 country code"},
+			{Name: "state", Type: "string", Description: "State, if applicable"},
+			{Name: "city", Type: "string", Description: "City"},
+			{Name: "street_line1", Type: "string", Description: "First line of the address"},
+			{Name: "street_line2", Type: "string", Description: "Second line of the address"},
+			{Name: "postal_code", Type: "string", Description: "Address postal code"},
+		},
+	},
+	{
+		Name:        "animatedChatPhoto",
+		Class:       "AnimatedChatPhoto",
+		Description: "Animated variant of a chat photo in MPEG4 format",
+		Fields: []ParamSchema{
+			{Name: "length", Type: "int32", Description: "Animation width and height"},
+			{Name: "file", Type: "file", Description: "Information about the animation file"},
+			{Name: "main_frame_timestamp", Type: "double", Description: "Timestamp of the frame, used as a static chat photo"},
+		},
+	},
+	{
+		Name:        "animatedEmoji",
+		Class:       "AnimatedEmoji",
+		Description: "Describes an animated representation of an emoji",
+		Fields: []ParamSchema{
+			{Name: "sticker", Type: "sticker", Description: "Animated sticker for the emoji"},
+			{Name: "fitzpatrick_type", Type: "int32", Description: "Emoji modifier fitzpatrick type; 0-6; 0 if none"},
+			{Name: "sound", Type: "file", Description: "File containing the sound to be played when the animated emoji is clicked if any; may be null. The sound is encoded with the Opus codec, and stored inside an OGG container"},
+		},
+	},
+	{
+		Name:        "animation",
+		Class:       "Animation",
+		Description: "Describes an animation file. The animation must be encoded in GIF or MPEG4 format",
+		Fields: []ParamSchema{
+			{Name: "duration", Type: "int32", Description: "Duration of the animation, in seconds; as defined by the sender"},
+			{Name: "width", Type: "int32", Description: "Width of the animation"},
+			{Name: "height", Type: "int32", Description: "Height of the animation"},
+			{Name: "file_name", Type: "string", Description: "Original name of the file; as defined by the sender"},
+			{Name: "mime_type", Type: "string", Description: "MIME type of the file, usually \"image/gif\" or \"video/mp4\""},
+			{Name: "has_stickers", Type: "Bool", Description: "True, if stickers were added to the animation. The list of corresponding sticker set can be received using getAttachedStickerSets"},
+			{Name: "minithumbnail", Type: "minithumbnail", Description: "Animation minithumbnail; may be null"},
+			{Name: "thumbnail", Type: "thumbnail", Description: "Animation thumbnail in JPEG or MPEG4 format; may be null"},
+			{Name: "animation", Type: "file", Description: "File containing the animation"},
+		},
+	},
+	{
+		Name:        "animations",
+		Class:       "Animations",
+		Description: "Represents a list of animations",
+		Fields: []ParamSchema{
+			{Name: "animations", Type: "vector<animation>", Description: "List of animations"},
+		},
+	},
+	{
+		Name:        "audio",
+		Class:       "Audio",
+		Description: "Describes an audio file. Audio is usually in MP3 or M4A format",
+		Fields: []ParamSchema{
+			{Name: "duration", Type: "int32", Description: "Duration of the audio, in seconds; as defined by the sender"},
+			{Name: "title", Type: "string", Description: "Title of the audio; as defined by the sender"},
+			{Name: "performer", Type: "string", Description: "Performer of the audio; as defined by the sender"},
+			{Name: "file_name", Type: "string", Description: "Original name of the file; as defined by the sender"},
+			{Name: "mime_type", Type: "string", Description: "The MIME type of the file; as defined by the sender"},
+			{Name: "album_cover_minithumbnail", Type: "minithumbnail", Description: "The minithumbnail of the album cover; may be null"},
+			{Name: "album_cover_thumbnail", Type: "thumbnail", Description: "The thumbnail of the album cover in JPEG format; as defined by the sender. The full size thumbnail is supposed to be extracted from the downloaded file; may be null"},
+			{Name: "audio", Type: "file", Description: "File containing the audio"},
+		},
+	},
+	{
+		Name:        "authenticationCodeInfo",
+		Class:       "AuthenticationCodeInfo",
+		Description: "Information about the authentication code that was sent",
+		Fields: []ParamSchema{
+			{Name: "phone_number", Type: "string", Description: "A phone number that is being authenticated"},
+			{Name: "type", Type: "AuthenticationCodeType", Description: "The way the code was sent to the user"},
+			{Name: "next_type", Type: "AuthenticationCodeType", Description: "The way the next code will be sent to the user; may be null"},
+			{Name: "timeout", Type: "int32", Description: "Timeout before the code can be re-sent, in seconds"},
+		},
+	},
+	{
+		Name:        "authenticationCodeTypeTelegramMessage",
+		Class:       "AuthenticationCodeType",
+		Description: "An authentication code is delivered via a private Telegram message, which can be viewed from another active session",
+		Fields: []ParamSchema{
+			{Name: "length", Type: "int32", Description: "Length of the code"},
+		},
+	},
+	{
+		Name:        "authenticationCodeTypeSms",
+		Class:       "AuthenticationCodeType",
+		Description: "An authentication code is delivered via an SMS message to the specified phone number",
+		Fields: []ParamSchema{
+			{Name: "length", Type: "int32", Description: "Length of the code"},
+		},
+	},
+	{
+		Name:        "authenticationCodeTypeCall",
+		Class:       "AuthenticationCodeType",
+		Description: "An authentication code is delivered via a phone call to the specified phone number",
+		Fields: []ParamSchema{
+			{Name: "length", Type: "int32", Description: "Length of the code"},
+		},
+	},
+	{
+		Name:        "authenticationCodeTypeFlashCall",
+		Class:       "AuthenticationCodeType",
+		Description: "An authentication code is delivered by an immediately canceled call to the specified phone number. The phone number that calls is the code that must be entered automatically",
+		Fields: []ParamSchema{
+			{Name: "pattern", Type: "string", Description: "Pattern of the phone number from which the call will be made"},
+		},
+	},
+	{
+		Name:        "authenticationCodeTypeMissedCall",
+		Class:       "AuthenticationCodeType",
+		Description: "An authentication code is delivered by an immediately canceled call to the specified phone number. The last digits of the phone number that calls are the code that must be entered manually by the user",
+		Fields: []ParamSchema{
+			{Name: "phone_number_prefix", Type: "string", Description: "Prefix of the phone number from which the call will be made"},
+			{Name: "length", Type: "int32", Description: "Number of digits in the code, excluding the prefix"},
+		},
+	},
+	{
+		Name:        "authorizationStateWaitTdlibParameters",
+		Class:       "AuthorizationState",
+		Description: "TDLib needs TdlibParameters for initialization",
+	},
+	{
+		Name:        "authorizationStateWaitEncryptionKey",
+		Class:       "AuthorizationState",
+		Description: "TDLib needs an encryption key to decrypt the local database",
+		Fields: []ParamSchema{
+			{Name: "is_encrypted", Type: "Bool", Description: "True, if the database is currently encrypted"},
+		},
+	},
+	{
+		Name:        "authorizationStateWaitPhoneNumber",
+		Class:       "AuthorizationState",
+		Description: "TDLib needs the user's phone number to authorize. Call `setAuthenticationPhoneNumber` to provide the phone number, or use `requestQrCodeAuthentication`, or `checkAuthenticationBotToken` for other authentication options",
+	},
+	{
+		Name:        "authorizationStateWaitCode",
+		Class:       "AuthorizationState",
+		Description: "TDLib needs the user's authentication code to authorize",
+		Fields: []ParamSchema{
+			{Name: "code_info", Type: "authenticationCodeInfo", Description: "Information about the authorization code that was sent"},
+		},
+	},
+	{
+		Name:        "authorizationStateWaitOtherDeviceConfirmation",
+		Class:       "AuthorizationState",
+		Description: "The user needs to confirm authorization on another logged in device by scanning a QR code with the provided link",
+		Fields: []ParamSchema{
+			{Name: "link", Type: "string", Description: "A tg:// URL for the QR code. The link will be updated frequently"},
+		},
+	},
+	{
+		Name:        "authorizationStateWaitRegistration",
+		Class:       "AuthorizationState",
+		Description: "The user is unregistered and need to accept terms of service and enter their first name and last name to finish registration",
+		Fields: []ParamSchema{
+			{Name: "terms_of_service", Type: "termsOfService", Description: "Telegram terms of service"},
+		},
+	},
+	{
+		Name:        "authorizationStateWaitPassword",
+		Class:       "AuthorizationState",
+		Description: "The user has been authorized, but needs to enter a password to start using the application",
+		Fields: []ParamSchema{
+			{Name: "password_hint", Type: "string", Description: "Hint for the password; may be empty"},
+			{Name: "has_recovery_email_address", Type: "Bool", Description: "True, if a recovery email address has been set up"},
+			{Name: "recovery_email_address_pattern", Type: "string", Description: "Pattern of the email address to which the recovery email was sent; empty until a recovery email has been sent"},
+		},
+	},
+	{
+		Name:        "authorizationStateReady",
+		Class:       "AuthorizationState",
+		Description: "The user has been successfully authorized. TDLib is now ready to answer queries",
+	},
+	{
+		Name:        "authorizationStateLoggingOut",
+		Class:       "AuthorizationState",
+		Description: "The user is currently logging out",
+	},
+	{
+		Name:        "authorizationStateClosing",
+		Class:       "AuthorizationState",
+		Description: "TDLib is closing, all subsequent queries will be answered with the error 500. Note that closing TDLib can take a while. All resources will be freed only after authorizationStateClosed has been received",
+	},
+	{
+		Name:        "authorizationStateClosed",
+		Class:       "AuthorizationState",
+		Description: "TDLib client is in its final state. All databases are closed and all resources are released. No other updates will be received after this. All queries will be responded to with error code 500. To continue working, one must create a new instance of the TDLib client",
+	},
+	{
+		Name:        "autoDownloadSettings",
+		Class:       "AutoDownloadSettings",
+		Description: "Contains auto-download settings",
+		Fields: []ParamSchema{
+			{Name: "is_auto_download_enabled", Type: "Bool", Description: "True, if the auto-download is enabled"},
+			{Name: "max_photo_file_size", Type: "int32", Description: "The maximum size of a photo file to be auto-downloaded, in bytes"},
+			{Name: "max_video_file_size", Type: "int32", Description: "The maximum size of a video file to be auto-downloaded, in bytes"},
+			{Name: "max_other_file_size", Type: "int32", Description: "The maximum size of other file types to be auto-downloaded, in bytes"},
+			{Name: "video_upload_bitrate", Type: "int32", Description: "The maximum suggested bitrate for uploaded videos, in kbit/s"},
+			{Name: "preload_large_videos", Type: "Bool", Description: "True, if the beginning of video files needs to be preloaded for instant playback"},
+			{Name: "preload_next_audio", Type: "Bool", Description: "True, if the next audio track needs to be preloaded while the user is listening to an audio file"},
+			{Name: "use_less_data_for_calls", Type: "Bool", Description: "True, if \"use less data for calls\" option needs to be enabled"},
+		},
+	},
+	{
+		Name:        "autoDownloadSettingsPresets",
+		Class:       "AutoDownloadSettingsPresets",
+		Description: "Contains auto-download settings presets for the current user",
+		Fields: []ParamSchema{
+			{Name: "low", Type: "autoDownloadSettings", Description: "Preset with lowest settings; supposed to be used by default when roaming"},
+			{Name: "medium", Type: "autoDownloadSettings", Description: "Preset with medium settings; supposed to be used by default when using mobile data"},
+			{Name: "high", Type: "autoDownloadSettings", Description: "Preset with highest settings; supposed to be used by default when connected on Wi-Fi"},
+		},
+	},
+	{
+		Name:        "background",
+		Class:       "Background",
+		Description: "Describes a chat background",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int64", Description: "Unique background identifier"},
+			{Name: "is_default", Type: "Bool", Description: "True, if this is one of default backgrounds"},
+			{Name: "is_dark", Type: "Bool", Description: "True, if the background is dark and is recommended to be used with dark theme"},
+			{Name: "name", Type: "string", Description: "Unique background name"},
+			{Name: "document", Type: "document", Description: "Document with the background; may be null. Null only for filled backgrounds"},
+			{Name: "type", Type: "BackgroundType", Description: "Type of the background"},
+		},
+	},
+	{
+		Name:        "backgroundFillSolid",
+		Class:       "BackgroundFill",
+		Description: "Describes a solid fill of a background",
+		Fields: []ParamSchema{
+			{Name: "color", Type: "int32", Description: "A color of the background in the RGB24 format"},
+		},
+	},
+	{
+		Name:        "backgroundFillGradient",
+		Class:       "BackgroundFill",
+		Description: "Describes a gradient fill of a background",
+		Fields: []ParamSchema{
+			{Name: "top_color", Type: "int32", Description: "A top color of the background in the RGB24 format"},
+			{Name: "bottom_color", Type: "int32", Description: "A bottom color of the background in the RGB24 format"},
+			{Name: "rotation_angle", Type: "int32", Description: "Clockwise rotation angle of the gradient, in degrees; 0-359. Must be always divisible by 45"},
+		},
+	},
+	{
+		Name:        "backgroundFillFreeformGradient",
+		Class:       "BackgroundFill",
+		Description: "Describes a freeform gradient fill of a background",
+		Fields: []ParamSchema{
+			{Name: "colors", Type: "vector<int32>", Description: "A list of 3 or 4 colors of the freeform gradients in the RGB24 format"},
+		},
+	},
+	{
+		Name:        "backgroundTypeWallpaper",
+		Class:       "BackgroundType",
+		Description: "A wallpaper in JPEG format",
+		Fields: []ParamSchema{
+			{Name: "is_blurred", Type: "Bool", Description: "True, if the wallpaper must be downscaled to fit in 450x450 square and then box-blurred with radius 12"},
+			{Name: "is_moving", Type: "Bool", Description: "True, if the background needs to be slightly moved when device is tilted"},
+		},
+	},
+	{
+		Name:        "backgroundTypePattern",
+		Class:       "BackgroundType",
+		Description: "A PNG or TGV (gzipped subset of SVG with MIME type \"application/x-tgwallpattern\") pattern to be combined with the background fill chosen by the user",
+		Fields: []ParamSchema{
+			{Name: "fill", Type: "BackgroundFill", Description: "Fill of the background"},
+			{Name: "intensity", Type: "int32", Description: "Intensity of the pattern when it is shown above the filled background; 0-100."},
+			{Name: "is_inverted", Type: "Bool", Description: "True, if the background fill must be applied only to the pattern itself. All other pixels are black in this case. For dark themes only"},
+			{Name: "is_moving", Type: "Bool", Description: "True, if the background needs to be slightly moved when device is tilted"},
+		},
+	},
+	{
+		Name:        "backgroundTypeFill",
+		Class:       "BackgroundType",
+		Description: "A filled background",
+		Fields: []ParamSchema{
+			{Name: "fill", Type: "BackgroundFill", Description: "The background fill"},
+		},
+	},
+	{
+		Name:        "backgrounds",
+		Class:       "Backgrounds",
+		Description: "Contains a list of backgrounds",
+		Fields: []ParamSchema{
+			{Name: "backgrounds", Type: "vector<background>", Description: "A list of backgrounds"},
+		},
+	},
+	{
+		Name:        "bankCardActionOpenUrl",
+		Class:       "BankCardActionOpenUrl",
+		Description: "Describes an action associated with a bank card number",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "string", Description: "Action text"},
+			{Name: "url", Type: "string", Description: "The URL to be opened"},
+		},
+	},
+	{
+		Name:        "bankCardInfo",
+		Class:       "BankCardInfo",
+		Description: "Information about a bank card",
+		Fields: []ParamSchema{
+			{Name: "title", Type: "string", Description: "Title of the bank card description"},
+			{Name: "actions", Type: "vector<bankCardActionOpenUrl>", Description: "Actions that can be done with the bank card number"},
+		},
+	},
+	{
+		Name:        "basicGroup",
+		Class:       "BasicGroup",
+		Description: "Represents a basic group of 0-200 users (must be upgraded to a supergroup to accommodate more than 200 users)",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int53", Description: "Group identifier"},
+			{Name: "access_hash", Type: "int64", Description: "Group access hash"},
+			{Name: "member_count", Type: "int32", Description: "Number of members in the group"},
+			{Name: "status", Type: "ChatMemberStatus", Description: "Status of the current user in the group"},
+			{Name: "is_active", Type: "Bool", Description: "True, if the group is active"},
+			{Name: "upgraded_to_supergroup_id", Type: "int32", Description: "Identifier of the supergroup to which this group was upgraded; 0 if none"},
+		},
+	},
+	{
+		Name:        "basicGroupFullInfo",
+		Class:       "BasicGroupFullInfo",
+		Description: "Contains full information about a basic group",
+		Fields: []ParamSchema{
+			{Name: "photo", Type: "chatPhoto", Description: "Chat photo; may be null"},
+			{Name: "description", Type: "string", Description: "Group description. Updated only after the basic group is opened"},
+			{Name: "creator_user_id", Type: "int53", Description: "User identifier of the creator of the group; 0 if unknown"},
+			{Name: "members", Type: "vector<chatMember>", Description: "Group members"},
+			{Name: "invite_link", Type: "chatInviteLink", Description: "Primary invite link for this group; may be null. For chat administrators with can_invite_users right only. Updated only after the basic group is opened"},
+			{Name: "bot_commands", Type: "vector<botCommands>", Description: "List of commands of bots in the group"},
+		},
+	},
+	{
+		Name:        "botCommand",
+		Class:       "BotCommand",
+		Description: "Represents a command supported by a bot",
+		Fields: []ParamSchema{
+			{Name: "command", Type: "string", Description: "Text of the bot command"},
+			{Name: "description", Type: "string", Description: "Description of the bot command"},
+		},
+	},
+	{
+		Name:        "botCommandScopeDefault",
+		Class:       "BotCommandScope",
+		Description: "A scope covering all users",
+	},
+	{
+		Name:        "botCommandScopeAllPrivateChats",
+		Class:       "BotCommandScope",
+		Description: "A scope covering all private chats",
+	},
+	{
+		Name:        "botCommandScopeAllGroupChats",
+		Class:       "BotCommandScope",
+		Description: "A scope covering all group and supergroup chats",
+	},
+	{
+		Name:        "botCommandScopeAllChatAdministrators",
+		Class:       "BotCommandScope",
+		Description: "A scope covering all group and supergroup chat administrators",
+	},
+	{
+		Name:        "botCommandScopeChat",
+		Class:       "BotCommandScope",
+		Description: "A scope covering all members of a chat",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+		},
+	},
+	{
+		Name:        "botCommandScopeChatAdministrators",
+		Class:       "BotCommandScope",
+		Description: "A scope covering all administrators of a chat",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+		},
+	},
+	{
+		Name:        "botCommandScopeChatMember",
+		Class:       "BotCommandScope",
+		Description: "A scope covering a member of a chat",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "user_id", Type: "int53", Description: "User identifier"},
+		},
+	},
+	{
+		Name:        "botCommands",
+		Class:       "BotCommands",
+		Description: "Contains a list of bot commands",
+		Fields: []ParamSchema{
+			{Name: "bot_user_id", Type: "int53", Description: "Bot's user identifier"},
+			{Name: "commands", Type: "vector<botCommand>", Description: "List of bot commands"},
+		},
+	},
+	{
+		Name:        "call",
+		Class:       "Call",
+		Description: "Describes a call",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int32", Description: "Call identifier, not persistent"},
+			{Name: "user_id", Type: "int53", Description: "Peer user identifier"},
+			{Name: "is_outgoing", Type: "Bool", Description: "True, if the call is outgoing"},
+			{Name: "is_video", Type: "Bool", Description: "True, if the call is a video call"},
+			{Name: "state", Type: "CallState", Description: "Call state"},
+		},
+	},
+	{
+		Name:        "callDiscardReasonEmpty",
+		Class:       "CallDiscardReason",
+		Description: "The call wasn't discarded, or the reason is unknown",
+	},
+	{
+		Name:        "callDiscardReasonMissed",
+		Class:       "CallDiscardReason",
+		Description: "The call was ended before the conversation started. It was canceled by the caller or missed by the other party",
+	},
+	{
+		Name:        "callDiscardReasonDeclined",
+		Class:       "CallDiscardReason",
+		Description: "The call was ended before the conversation started. It was declined by the other party",
+	},
+	{
+		Name:        "callDiscardReasonDisconnected",
+		Class:       "CallDiscardReason",
+		Description: "The call was ended during the conversation because the users were disconnected",
+	},
+	{
+		Name:        "callDiscardReasonHungUp",
+		Class:       "CallDiscardReason",
+		Description: "The call was ended because one of the parties hung up",
+	},
+	{
+		Name:        "callId",
+		Class:       "CallId",
+		Description: "Contains the call identifier",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int32", Description: "Call identifier"},
+		},
+	},
+	{
+		Name:        "callProblemEcho",
+		Class:       "CallProblem",
+		Description: "The user heard their own voice",
+	},
+	{
+		Name:        "callProblemNoise",
+		Class:       "CallProblem",
+		Description: "The user heard background noise",
+	},
+	{
+		Name:        "callProblemInterruptions",
+		Class:       "CallProblem",
+		Description: "The other side kept disappearing",
+	},
+	{
+		Name:        "callProblemDistortedSpeech",
+		Class:       "CallProblem",
+		Description: "The speech was distorted",
+	},
+	{
+		Name:        "callProblemSilentLocal",
+		Class:       "CallProblem",
+		Description: "The user couldn't hear the other side",
+	},
+	{
+		Name:        "callProblemSilentRemote",
+		Class:       "CallProblem",
+		Description: "The other side couldn't hear the user",
+	},
+	{
+		Name:        "callProblemDropped",
+		Class:       "CallProblem",
+		Description: "The call ended unexpectedly",
+	},
+	{
+		Name:        "callProblemDistortedVideo",
+		Class:       "CallProblem",
+		Description: "The video was distorted",
+	},
+	{
+		Name:        "callProblemPixelatedVideo",
+		Class:       "CallProblem",
+		Description: "The video was pixelated",
+	},
+	{
+		Name:        "callProtocol",
+		Class:       "CallProtocol",
+		Description: "Specifies the supported call protocols",
+		Fields: []ParamSchema{
+			{Name: "udp_p2p", Type: "Bool", Description: "True, if UDP peer-to-peer connections are supported"},
+			{Name: "udp_reflector", Type: "Bool", Description: "True, if connection through UDP reflectors is supported"},
+			{Name: "min_layer", Type: "int32", Description: "The minimum supported API layer; use 65"},
+			{Name: "max_layer", Type: "int32", Description: "The maximum supported API layer; use 65"},
+			{Name: "library_versions", Type: "vector<string>", Description: "List of supported tgcalls versions"},
+		},
+	},
+	{
+		Name:        "callServer",
+		Class:       "CallServer",
+		Description: "Describes a server for relaying call data",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int64", Description: "Server identifier"},
+			{Name: "ip_address", Type: "string", Description: "Server IPv4 address"},
+			{Name: "ipv6_address", Type: "string", Description: "Server IPv6 address"},
+			{Name: "port", Type: "int32", Description: "Server port number"},
+			{Name: "type", Type: "CallServerType", Description: "Server type"},
+		},
+	},
+	{
+		Name:        "callServerTypeTelegramReflector",
+		Class:       "CallServerType",
+		Description: "A Telegram call reflector",
+		Fields: []ParamSchema{
+			{Name: "peer_tag", Type: "bytes", Description: "A peer tag to be used with the reflector"},
+		},
+	},
+	{
+		Name:        "callServerTypeWebrtc",
+		Class:       "CallServerType",
+		Description: "A WebRTC server",
+		Fields: []ParamSchema{
+			{Name: "username", Type: "string", Description: "Username to be used for authentication"},
+			{Name: "password", Type: "string", Description: "Authentication password"},
+			{Name: "supports_turn", Type: "Bool", Description: "True, if the server supports TURN"},
+			{Name: "supports_stun", Type: "Bool", Description: "True, if the server supports STUN"},
+		},
+	},
+	{
+		Name:        "callStatePending",
+		Class:       "CallState",
+		Description: "The call is pending, waiting to be accepted by a user",
+		Fields: []ParamSchema{
+			{Name: "is_created", Type: "Bool", Description: "True, if the call has already been created by the server"},
+			{Name: "is_received", Type: "Bool", Description: "True, if the call has already been received by the other party"},
+		},
+	},
+	{
+		Name:        "callStateExchangingKeys",
+		Class:       "CallState",
+		Description: "The call has been answered and encryption keys are being exchanged",
+	},
+	{
+		Name:        "callStateReady",
+		Class:       "CallState",
+		Description: "The call is ready to use",
+		Fields: []ParamSchema{
+			{Name: "protocol", Type: "callProtocol", Description: "Call protocols supported by the peer"},
+			{Name: "servers", Type: "vector<callServer>", Description: "List of available call servers"},
+			{Name: "config", Type: "string", Description: "A JSON-encoded call config"},
+			{Name: "encryption_key", Type: "bytes", Description: "Call encryption key"},
+			{Name: "emojis", Type: "vector<string>", Description: "Encryption key emojis fingerprint"},
+			{Name: "allow_p2p", Type: "Bool", Description: "True, if peer-to-peer connection is allowed by users privacy settings"},
+		},
+	},
+	{
+		Name:        "callStateHangingUp",
+		Class:       "CallState",
+		Description: "The call is hanging up after discardCall has been called",
+	},
+	{
+		Name:        "callStateDiscarded",
+		Class:       "CallState",
+		Description: "The call has ended successfully",
+		Fields: []ParamSchema{
+			{Name: "reason", Type: "CallDiscardReason", Description: "The reason, why the call has ended"},
+			{Name: "need_rating", Type: "Bool", Description: "True, if the call rating must be sent to the server"},
+			{Name: "need_debug_information", Type: "Bool", Description: "True, if the call debug information must be sent to the server"},
+		},
+	},
+	{
+		Name:        "callStateError",
+		Class:       "CallState",
+		Description: "The call has ended with an error",
+		Fields: []ParamSchema{
+			{Name: "error", Type: "error", Description: "Error. An error with the code 4005000 will be returned if an outgoing call is missed because of an expired timeout"},
+		},
+	},
+	{
+		Name:        "callbackQueryAnswer",
+		Class:       "CallbackQueryAnswer",
+		Description: "Contains a bot's answer to a callback query",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "string", Description: "Text of the answer"},
+			{Name: "show_alert", Type: "Bool", Description: "True, if an alert must be shown to the user instead of a toast notification"},
+			{Name: "url", Type: "string", Description: "URL to be opened"},
+		},
+	},
+	{
+		Name:        "callbackQueryPayloadData",
+		Class:       "CallbackQueryPayload",
+		Description: "The payload for a general callback button",
+		Fields: []ParamSchema{
+			{Name: "data", Type: "bytes", Description: "Data that was attached to the callback button"},
+		},
+	},
+	{
+		Name:        "callbackQueryPayloadDataWithPassword",
+		Class:       "CallbackQueryPayload",
+		Description: "The payload for a callback button requiring password",
+		Fields: []ParamSchema{
+			{Name: "password", Type: "string", Description: "The password for the current user"},
+			{Name: "data", Type: "bytes", Description: "Data that was attached to the callback button"},
+		},
+	},
+	{
+		Name:        "callbackQueryPayloadGame",
+		Class:       "CallbackQueryPayload",
+		Description: "The payload for a game callback button",
+		Fields: []ParamSchema{
+			{Name: "game_short_name", Type: "string", Description: "A short name of the game that was attached to the callback button"},
+		},
+	},
+	{
+		Name:        "canTransferOwnershipResultOk",
+		Class:       "CanTransferOwnershipResult",
+		Description: "The session can be used",
+	},
+	{
+		Name:        "canTransferOwnershipResultPasswordNeeded",
+		Class:       "CanTransferOwnershipResult",
+		Description: "The 2-step verification needs to be enabled first",
+	},
+	{
+		Name:        "canTransferOwnershipResultPasswordTooFresh",
+		Class:       "CanTransferOwnershipResult",
+		Description: "The 2-step verification was enabled recently, user needs to wait",
+		Fields: []ParamSchema{
+			{Name: "retry_after", Type: "int32", Description: "Time left before the session can be used to transfer ownership of a chat, in seconds"},
+		},
+	},
+	{
+		Name:        "canTransferOwnershipResultSessionTooFresh",
+		Class:       "CanTransferOwnershipResult",
+		Description: "The session was created recently, user needs to wait",
+		Fields: []ParamSchema{
+			{Name: "retry_after", Type: "int32", Description: "Time left before the session can be used to transfer ownership of a chat, in seconds"},
+		},
+	},
+	{
+		Name:        "chat",
+		Class:       "Chat",
+		Description: "A chat. (Can be a private chat, basic group, supergroup, or secret chat)",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int53", Description: "Chat unique identifier"},
+			{Name: "type", Type: "ChatType", Description: "Type of the chat"},
+			{Name: "title", Type: "string", Description: "Chat title"},
+			{Name: "photo", Type: "chatPhotoInfo", Description: "Chat photo; may be null"},
+			{Name: "permissions", Type: "chatPermissions", Description: "Actions that non-administrator chat members are allowed to take in the chat"},
+			{Name: "last_message", Type: "message", Description: "Last message in the chat; may be null"},
+			{Name: "positions", Type: "vector<chatPosition>", Description: "Positions of the chat in chat lists"},
+			{Name: "message_sender_id", Type: "MessageSender", Description: "Identifier of a user or chat that is selected to send messages in the chat; may be null if the user can't change message sender"},
+			{Name: "has_protected_content", Type: "Bool", Description: "True, if chat content can't be saved locally, forwarded, or copied"},
+			{Name: "is_marked_as_unread", Type: "Bool", Description: "True, if the chat is marked as unread"},
+			{Name: "is_blocked", Type: "Bool", Description: "True, if the chat is blocked by the current user and private messages from the chat can't be received"},
+			{Name: "has_scheduled_messages", Type: "Bool", Description: "True, if the chat has scheduled messages"},
+			{Name: "can_be_deleted_only_for_self", Type: "Bool", Description: "True, if the chat messages can be deleted only for the current user while other users will continue to see the messages"},
+			{Name: "can_be_deleted_for_all_users", Type: "Bool", Description: "True, if the chat messages can be deleted for all users"},
+			{Name: "can_be_reported", Type: "Bool", Description: "True, if the chat can be reported to Telegram moderators through reportChat or reportChatPhoto"},
+			{Name: "default_disable_notification", Type: "Bool", Description: "Default value of the disable_notification parameter, used when a message is sent to the chat"},
+			{Name: "unread_count", Type: "int32", Description: "Number of unread messages in the chat"},
+			{Name: "last_read_inbox_message_id", Type: "int53", Description: "Identifier of the last read incoming message"},
+			{Name: "last_read_outbox_message_id", Type: "int53", Description: "Identifier of the last read outgoing message"},
+			{Name: "unread_mention_count", Type: "int32", Description: "Number of unread messages with a mention/reply in the chat"},
+			{Name: "notification_settings", Type: "chatNotificationSettings", Description: "Notification settings for this chat"},
+			{Name: "message_ttl", Type: "int32", Description: "Current message Time To Live setting (self-destruct timer) for the chat; 0 if not defined. TTL is counted from the time message or its content is viewed in secret chats and from the send date in other chats"},
+			{Name: "theme_name", Type: "string", Description: "If non-empty, name of a theme, set for the chat"},
+			{Name: "action_bar", Type: "ChatActionBar", Description: "Information about actions which must be possible to do through the chat action bar; may be null"},
+			{Name: "video_chat", Type: "videoChat", Description: "Information about video chat of the chat"},
+			{Name: "pending_join_requests", Type: "chatJoinRequestsInfo", Description: "Information about pending join requests; may be null"},
+			{Name: "reply_markup_message_id", Type: "int53", Description: "Identifier of the message from which reply markup needs to be used; 0 if there is no default custom reply markup in the chat"},
+			{Name: "draft_message", Type: "draftMessage", Description: "A draft of a message in the chat; may be null"},
+			{Name: "client_data", Type: "string", Description: "Application-specific data associated with the chat. (For example, the chat scroll position or local chat notification settings can be stored here.) Persistent if the message database is used"},
+		},
+	},
+	{
+		Name:        "chatActionTyping",
+		Class:       "ChatAction",
+		Description: "The user is typing a message",
+	},
+	{
+		Name:        "chatActionRecordingVideo",
+		Class:       "ChatAction",
+		Description: "The user is recording a video",
+	},
+	{
+		Name:        "chatActionUploadingVideo",
+		Class:       "ChatAction",
+		Description: "The user is uploading a video",
+		Fields: []ParamSchema{
+			{Name: "progress", Type: "int32", Description: "Upload progress, as a percentage"},
+		},
+	},
+	{
+		Name:        "chatActionRecordingVoiceNote",
+		Class:       "ChatAction",
+		Description: "The user is recording a voice note",
+	},
+	{
+		Name:        "chatActionUploadingVoiceNote",
+		Class:       "ChatAction",
+		Description: "The user is uploading a voice note",
+		Fields: []ParamSchema{
+			{Name: "progress", Type: "int32", Description: "Upload progress, as a percentage"},
+		},
+	},
+	{
+		Name:        "chatActionUploadingPhoto",
+		Class:       "ChatAction",
+		Description: "The user is uploading a photo",
+		Fields: []ParamSchema{
+			{Name: "progress", Type: "int32", Description: "Upload progress, as a percentage"},
+		},
+	},
+	{
+		Name:        "chatActionUploadingDocument",
+		Class:       "ChatAction",
+		Description: "The user is uploading a document",
+		Fields: []ParamSchema{
+			{Name: "progress", Type: "int32", Description: "Upload progress, as a percentage"},
+		},
+	},
+	{
+		Name:        "chatActionChoosingSticker",
+		Class:       "ChatAction",
+		Description: "The user is picking a sticker to send",
+	},
+	{
+		Name:        "chatActionChoosingLocation",
+		Class:       "ChatAction",
+		Description: "The user is picking a location or venue to send",
+	},
+	{
+		Name:        "chatActionChoosingContact",
+		Class:       "ChatAction",
+		Description: "The user is picking a contact to send",
+	},
+	{
+		Name:        "chatActionStartPlayingGame",
+		Class:       "ChatAction",
+		Description: "The user has started to play a game",
+	},
+	{
+		Name:        "chatActionRecordingVideoNote",
+		Class:       "ChatAction",
+		Description: "The user is recording a video note",
+	},
+	{
+		Name:        "chatActionUploadingVideoNote",
+		Class:       "ChatAction",
+		Description: "The user is uploading a video note",
+		Fields: []ParamSchema{
+			{Name: "progress", Type: "int32", Description: "Upload progress, as a percentage"},
+		},
+	},
+	{
+		Name:        "chatActionWatchingAnimations",
+		Class:       "ChatAction",
+		Description: "The user is watching animations sent by the other party by clicking on an animated emoji",
+		Fields: []ParamSchema{
+			{Name: "emoji", Type: "string", Description: "The animated emoji"},
+		},
+	},
+	{
+		Name:        "chatActionCancel",
+		Class:       "ChatAction",
+		Description: "The user has canceled the previous action",
+	},
+	{
+		Name:        "chatActionBarReportSpam",
+		Class:       "ChatActionBar",
+		Description: "The chat can be reported as spam using the method reportChat with the reason chatReportReasonSpam",
+		Fields: []ParamSchema{
+			{Name: "can_unarchive", Type: "Bool", Description: "If true, the chat was automatically archived and can be moved back to the main chat list using addChatToList simultaneously with setting chat notification settings to default using setChatNotificationSettings"},
+		},
+	},
+	{
+		Name:        "chatActionBarReportUnrelatedLocation",
+		Class:       "ChatActionBar",
+		Description: "The chat is a location-based supergroup, which can be reported as having unrelated location using the method reportChat with the reason chatReportReasonUnrelatedLocation",
+	},
+	{
+		Name:        "chatActionBarInviteMembers",
+		Class:       "ChatActionBar",
+		Description: "The chat is a recently created group chat to which new members can be invited",
+	},
+	{
+		Name:        "chatActionBarReportAddBlock",
+		Class:       "ChatActionBar",
+		Description: "The chat is a private or secret chat, which can be reported using the method reportChat, or the other user can be blocked using the method toggleMessageSenderIsBlocked, or the other user can be added to the contact list using the method addContact",
+		Fields: []ParamSchema{
+			{Name: "can_unarchive", Type: "Bool", Description: "If true, the chat was automatically archived and can be moved back to the main chat list using addChatToList simultaneously with setting chat notification settings to default using setChatNotificationSettings"},
+			{Name: "distance", Type: "int32", Description: "If non-negative, the current user was found by the peer through searchChatsNearby and this is the distance between the users"},
+		},
+	},
+	{
+		Name:        "chatActionBarAddContact",
+		Class:       "ChatActionBar",
+		Description: "The chat is a private or secret chat and the other user can be added to the contact list using the method addContact",
+	},
+	{
+		Name:        "chatActionBarSharePhoneNumber",
+		Class:       "ChatActionBar",
+		Description: "The chat is a private or secret chat with a mutual contact and the user's phone number can be shared with the other user using the method sharePhoneNumber",
+	},
+	{
+		Name:        "chatActionBarJoinRequest",
+		Class:       "ChatActionBar",
+		Description: "The chat is a private chat with an administrator of a chat to which the user sent join request",
+		Fields: []ParamSchema{
+			{Name: "title", Type: "string", Description: "Title of the chat to which the join request was sent"},
+			{Name: "is_channel", Type: "Bool", Description: "True, if the join request was sent to a channel chat"},
+			{Name: "request_date", Type: "int32", Description: "Point in time (Unix timestamp) when the join request was sent"},
+		},
+	},
+	{
+		Name:        "chatAdministrator",
+		Class:       "ChatAdministrator",
+		Description: "Contains information about a chat administrator",
+		Fields: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "User identifier of the administrator"},
+			{Name: "custom_title", Type: "string", Description: "Custom title of the administrator"},
+			{Name: "is_owner", Type: "Bool", Description: "True, if the user is the owner of the chat"},
+		},
+	},
+	{
+		Name:        "chatAdministrators",
+		Class:       "ChatAdministrators",
+		Description: "Represents a list of chat administrators",
+		Fields: []ParamSchema{
+			{Name: "administrators", Type: "vector<chatAdministrator>", Description: "A list of chat administrators"},
+		},
+	},
+	{
+		Name:        "chatEvent",
+		Class:       "ChatEvent",
+		Description: "Represents a chat event",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int64", Description: "Chat event identifier"},
+			{Name: "date", Type: "int32", Description: "Point in time (Unix timestamp) when the event happened"},
+			{Name: "member_id", Type: "MessageSender", Description: "Identifier of the user or chat who performed the action"},
+			{Name: "action", Type: "ChatEventAction", Description: "The action"},
+		},
+	},
+	{
+		Name:        "chatEventMessageEdited",
+		Class:       "ChatEventAction",
+		Description: "A message was edited",
+		Fields: []ParamSchema{
+			{Name: "old_message", Type: "message", Description: "The original message before the edit"},
+			{Name: "new_message", Type: "message", Description: "The message after it was edited"},
+		},
+	},
+	{
+		Name:        "chatEventMessageDeleted",
+		Class:       "ChatEventAction",
+		Description: "A message was deleted",
+		Fields: []ParamSchema{
+			{Name: "message", Type: "message", Description: "Deleted message"},
+		},
+	},
+	{
+		Name:        "chatEventPollStopped",
+		Class:       "ChatEventAction",
+		Description: "A poll in a message was stopped",
+		Fields: []ParamSchema{
+			{Name: "message", Type: "message", Description: "The message with the poll"},
+		},
+	},
+	{
+		Name:        "chatEventMessagePinned",
+		Class:       "ChatEventAction",
+		Description: "A message was pinned",
+		Fields: []ParamSchema{
+			{Name: "message", Type: "message", Description: "Pinned message"},
+		},
+	},
+	{
+		Name:        "chatEventMessageUnpinned",
+		Class:       "ChatEventAction",
+		Description: "A message was unpinned",
+		Fields: []ParamSchema{
+			{Name: "message", Type: "message", Description: "Unpinned message"},
+		},
+	},
+	{
+		Name:        "chatEventMemberJoined",
+		Class:       "ChatEventAction",
+		Description: "A new member joined the chat",
+	},
+	{
+		Name:        "chatEventMemberJoinedByInviteLink",
+		Class:       "ChatEventAction",
+		Description: "A new member joined the chat via an invite link",
+		Fields: []ParamSchema{
+			{Name: "invite_link", Type: "chatInviteLink", Description: "Invite link used to join the chat"},
+		},
+	},
+	{
+		Name:        "chatEventMemberJoinedByRequest",
+		Class:       "ChatEventAction",
+		Description: "A new member was accepted to the chat by an administrator",
+		Fields: []ParamSchema{
+			{Name: "approver_user_id", Type: "int53", Description: "User identifier of the chat administrator, approved user join request"},
+			{Name: "invite_link", Type: "chatInviteLink", Description: "Invite link used to join the chat; may be null"},
+		},
+	},
+	{
+		Name:        "chatEventMemberLeft",
+		Class:       "ChatEventAction",
+		Description: "A member left the chat",
+	},
+	{
+		Name:        "chatEventMemberInvited",
+		Class:       "ChatEventAction",
+		Description: "A new chat member was invited",
+		Fields: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "New member user identifier"},
+			{Name: "status", Type: "ChatMemberStatus", Description: "New member status"},
+		},
+	},
+	{
+		Name:        "chatEventMemberPromoted",
+		Class:       "ChatEventAction",
+		Description: "A chat member has gained/lost administrator status, or the list of their administrator privileges has changed",
+		Fields: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "Affected chat member user identifier"},
+			{Name: "old_status", Type: "ChatMemberStatus", Description: "Previous status of the chat member"},
+			{Name: "new_status", Type: "ChatMemberStatus", Description: "New status of the chat member"},
+		},
+	},
+	{
+		Name:        "chatEventMemberRestricted",
+		Class:       "ChatEventAction",
+		Description: "A chat member was restricted/unrestricted or banned/unbanned, or the list of their restrictions has changed",
+		Fields: []ParamSchema{
+			{Name: "member_id", Type: "MessageSender", Description: "Affected chat member identifier"},
+			{Name: "old_status", Type: "ChatMemberStatus", Description: "Previous status of the chat member"},
+			{Name: "new_status", Type: "ChatMemberStatus", Description: "New status of the chat member"},
+		},
+	},
+	{
+		Name:        "chatEventTitleChanged",
+		Class:       "ChatEventAction",
+		Description: "The chat title was changed",
+		Fields: []ParamSchema{
+			{Name: "old_title", Type: "string", Description: "Previous chat title"},
+			{Name: "new_title", Type: "string", Description: "New chat title"},
+		},
+	},
+	{
+		Name:        "chatEventPermissionsChanged",
+		Class:       "ChatEventAction",
+		Description: "The chat permissions was changed",
+		Fields: []ParamSchema{
+			{Name: "old_permissions", Type: "chatPermissions", Description: "Previous chat permissions"},
+			{Name: "new_permissions", Type: "chatPermissions", Description: "New chat permissions"},
+		},
+	},
+	{
+		Name:        "chatEventDescriptionChanged",
+		Class:       "ChatEventAction",
+		Description: "The chat description was changed",
+		Fields: []ParamSchema{
+			{Name: "old_description", Type: "string", Description: "Previous chat description"},
+			{Name: "new_description", Type: "string", Description: "New chat description"},
+		},
+	},
+	{
+		Name:        "chatEventUsernameChanged",
+		Class:       "ChatEventAction",
+		Description: "The chat username was changed",
+		Fields: []ParamSchema{
+			{Name: "old_username", Type: "string", Description: "Previous chat username"},
+			{Name: "new_username", Type: "string", Description: "New chat username"},
+		},
+	},
+	{
+		Name:        "chatEventPhotoChanged",
+		Class:       "ChatEventAction",
+		Description: "The chat photo was changed",
+		Fields: []ParamSchema{
+			{Name: "old_photo", Type: "chatPhoto", Description: "Previous chat photo value; may be null"},
+			{Name: "new_photo", Type: "chatPhoto", Description: "New chat photo value; may be null"},
+		},
+	},
+	{
+		Name:        "chatEventInvitesToggled",
+		Class:       "ChatEventAction",
+		Description: "The can_invite_users permission of a supergroup chat was toggled",
+		Fields: []ParamSchema{
+			{Name: "can_invite_users", Type: "Bool", Description: "New value of can_invite_users permission"},
+		},
+	},
+	{
+		Name:        "chatEventLinkedChatChanged",
+		Class:       "ChatEventAction",
+		Description: "The linked chat of a supergroup was changed",
+		Fields: []ParamSchema{
+			{Name: "old_linked_chat_id", Type: "int53", Description: "Previous supergroup linked chat identifier"},
+			{Name: "new_linked_chat_id", Type: "int53", Description: "New supergroup linked chat identifier"},
+		},
+	},
+	{
+		Name:        "chatEventSlowModeDelayChanged",
+		Class:       "ChatEventAction",
+		Description: "The slow_mode_delay setting of a supergroup was changed",
+		Fields: []ParamSchema{
+			{Name: "old_slow_mode_delay", Type: "int32", Description: "Previous value of slow_mode_delay, in seconds"},
+			{Name: "new_slow_mode_delay", Type: "int32", Description: "New value of slow_mode_delay, in seconds"},
+		},
+	},
+	{
+		Name:        "chatEventMessageTtlChanged",
+		Class:       "ChatEventAction",
+		Description: "The message TTL was changed",
+		Fields: []ParamSchema{
+			{Name: "old_message_ttl", Type: "int32", Description: "Previous value of message_ttl"},
+			{Name: "new_message_ttl", Type: "int32", Description: "New value of message_ttl"},
+		},
+	},
+	{
+		Name:        "chatEventSignMessagesToggled",
+		Class:       "ChatEventAction",
+		Description: "The sign_messages setting of a channel was toggled",
+		Fields: []ParamSchema{
+			{Name: "sign_messages", Type: "Bool", Description: "New value of sign_messages"},
+		},
+	},
+	{
+		Name:        "chatEventHasProtectedContentToggled",
+		Class:       "ChatEventAction",
+		Description: "The has_protected_content setting of a channel was toggled",
+		Fields: []ParamSchema{
+			{Name: "has_protected_content", Type: "Bool", Description: "New value of has_protected_content"},
+		},
+	},
+	{
+		Name:        "chatEventStickerSetChanged",
+		Class:       "ChatEventAction",
+		Description: "The supergroup sticker set was changed",
+		Fields: []ParamSchema{
+			{Name: "old_sticker_set_id", Type: "int64", Description: "Previous identifier of the chat sticker set; 0 if none"},
+			{Name: "new_sticker_set_id", Type: "int64", Description: "New identifier of the chat sticker set; 0 if none"},
+		},
+	},
+	{
+		Name:        "chatEventLocationChanged",
+		Class:       "ChatEventAction",
+		Description: "The supergroup location was changed",
+		Fields: []ParamSchema{
+			{Name: "old_location", Type: "chatLocation", Description: "Previous location; may be null"},
+			{Name: "new_location", Type: "chatLocation", Description: "New location; may be null"},
+		},
+	},
+	{
+		Name:        "chatEventIsAllHistoryAvailableToggled",
+		Class:       "ChatEventAction",
+		Description: "The is_all_history_available setting of a supergroup was toggled",
+		Fields: []ParamSchema{
+			{Name: "is_all_history_available", Type: "Bool", Description: "New value of is_all_history_available"},
+		},
+	},
+	{
+		Name:        "chatEventInviteLinkEdited",
+		Class:       "ChatEventAction",
+		Description: "A chat invite link was edited",
+		Fields: []ParamSchema{
+			{Name: "old_invite_link", Type: "chatInviteLink", Description: "Previous information about the invite link"},
+			{Name: "new_invite_link", Type: "chatInviteLink", Description: "New information about the invite link"},
+		},
+	},
+	{
+		Name:        "chatEventInviteLinkRevoked",
+		Class:       "ChatEventAction",
+		Description: "A chat invite link was revoked",
+		Fields: []ParamSchema{
+			{Name: "invite_link", Type: "chatInviteLink", Description: "The invite link"},
+		},
+	},
+	{
+		Name:        "chatEventInviteLinkDeleted",
+		Class:       "ChatEventAction",
+		Description: "A revoked chat invite link was deleted",
+		Fields: []ParamSchema{
+			{Name: "invite_link", Type: "chatInviteLink", Description: "The invite link"},
+		},
+	},
+	{
+		Name:        "chatEventVideoChatCreated",
+		Class:       "ChatEventAction",
+		Description: "A video chat was created",
+		Fields: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Identifier of the video chat. The video chat can be received through the method getGroupCall"},
+		},
+	},
+	{
+		Name:        "chatEventVideoChatEnded",
+		Class:       "ChatEventAction",
+		Description: "A video chat was ended",
+		Fields: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Identifier of the video chat. The video chat can be received through the method getGroupCall"},
+		},
+	},
+	{
+		Name:        "chatEventVideoChatParticipantIsMutedToggled",
+		Class:       "ChatEventAction",
+		Description: "A video chat participant was muted or unmuted",
+		Fields: []ParamSchema{
+			{Name: "participant_id", Type: "MessageSender", Description: "Identifier of the affected group call participant"},
+			{Name: "is_muted", Type: "Bool", Description: "New value of is_muted"},
+		},
+	},
+	{
+		Name:        "chatEventVideoChatParticipantVolumeLevelChanged",
+		Class:       "ChatEventAction",
+		Description: "A video chat participant volume level was changed",
+		Fields: []ParamSchema{
+			{Name: "participant_id", Type: "MessageSender", Description: "Identifier of the affected group call participant"},
+			{Name: "volume_level", Type: "int32", Description: "New value of volume_level; 1-20000 in hundreds of percents"},
+		},
+	},
+	{
+		Name:        "chatEventVideoChatMuteNewParticipantsToggled",
+		Class:       "ChatEventAction",
+		Description: "The mute_new_participants setting of a video chat was toggled",
+		Fields: []ParamSchema{
+			{Name: "mute_new_participants", Type: "Bool", Description: "New value of the mute_new_participants setting"},
+		},
+	},
+	{
+		Name:        "chatEventLogFilters",
+		Class:       "ChatEventLogFilters",
+		Description: "Represents a set of filters used to obtain a chat event log",
+		Fields: []ParamSchema{
+			{Name: "message_edits", Type: "Bool", Description: "True, if message edits need to be returned"},
+			{Name: "message_deletions", Type: "Bool", Description: "True, if message deletions need to be returned"},
+			{Name: "message_pins", Type: "Bool", Description: "True, if pin/unpin events need to be returned"},
+			{Name: "member_joins", Type: "Bool", Description: "True, if members joining events need to be returned"},
+			{Name: "member_leaves", Type: "Bool", Description: "True, if members leaving events need to be returned"},
+			{Name: "member_invites", Type: "Bool", Description: "True, if invited member events need to be returned"},
+			{Name: "member_promotions", Type: "Bool", Description: "True, if member promotion/demotion events need to be returned"},
+			{Name: "member_restrictions", Type: "Bool", Description: "True, if member restricted/unrestricted/banned/unbanned events need to be returned"},
+			{Name: "info_changes", Type: "Bool", Description: "True, if changes in chat information need to be returned"},
+			{Name: "setting_changes", Type: "Bool", Description: "True, if changes in chat settings need to be returned"},
+			{Name: "invite_link_changes", Type: "Bool", Description: "True, if changes to invite links need to be returned"},
+			{Name: "video_chat_changes", Type: "Bool", Description: "True, if video chat actions need to be returned"},
+		},
+	},
+	{
+		Name:        "chatEvents",
+		Class:       "ChatEvents",
+		Description: "Contains a list of chat events",
+		Fields: []ParamSchema{
+			{Name: "events", Type: "vector<chatEvent>", Description: "List of events"},
+		},
+	},
+	{
+		Name:        "chatFilter",
+		Class:       "ChatFilter",
+		Description: "Represents a filter of user chats",
+		Fields: []ParamSchema{
+			{Name: "title", Type: "string", Description: "The title of the filter; 1-12 characters without line feeds"},
+			{Name: "icon_name", Type: "string", Description: "The chosen icon name for short filter representation. If non-empty, must be one of \"All\", \"Unread\", \"Unmuted\", \"Bots\", \"Channels\", \"Groups\", \"Private\", \"Custom\", \"Setup\", \"Cat\", \"Crown\", \"Favorite\", \"Flower\", \"Game\", \"Home\", \"Love\", \"Mask\", \"Party\", \"Sport\", \"Study\", \"Trade\", \"Travel\", \"Work\". If empty, use getChatFilterDefaultIconName to get default icon name for the filter"},
+			{Name: "pinned_chat_ids", Type: "vector<int53>", Description: "The chat identifiers of pinned chats in the filtered chat list"},
+			{Name: "included_chat_ids", Type: "vector<int53>", Description: "The chat identifiers of always included chats in the filtered chat list"},
+			{Name: "excluded_chat_ids", Type: "vector<int53>", Description: "The chat identifiers of always excluded chats in the filtered chat list"},
+			{Name: "exclude_muted", Type: "Bool", Description: "True, if muted chats need to be excluded"},
+			{Name: "exclude_read", Type: "Bool", Description: "True, if read chats need to be excluded"},
+			{Name: "exclude_archived", Type: "Bool", Description: "True, if archived chats need to be excluded"},
+			{Name: "include_contacts", Type: "Bool", Description: "True, if contacts need to be included"},
+			{Name: "include_non_contacts", Type: "Bool", Description: "True, if non-contact users need to be included"},
+			{Name: "include_bots", Type: "Bool", Description: "True, if bots need to be included"},
+			{Name: "include_groups", Type: "Bool", Description: "True, if basic groups and supergroups need to be included"},
+			{Name: "include_channels", Type: "Bool", Description: "True, if channels need to be included"},
+		},
+	},
+	{
+		Name:        "chatFilterInfo",
+		Class:       "ChatFilterInfo",
+		Description: "Contains basic information about a chat filter",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int32", Description: "Unique chat filter identifier"},
+			{Name: "title", Type: "string", Description: "The title of the filter; 1-12 characters without line feeds"},
+			{Name: "icon_name", Type: "string", Description: "The chosen or default icon name for short filter representation. One of \"All\", \"Unread\", \"Unmuted\", \"Bots\", \"Channels\", \"Groups\", \"Private\", \"Custom\", \"Setup\", \"Cat\", \"Crown\", \"Favorite\", \"Flower\", \"Game\", \"Home\", \"Love\", \"Mask\", \"Party\", \"Sport\", \"Study\", \"Trade\", \"Travel\", \"Work\""},
+		},
+	},
+	{
+		Name:        "chatInviteLink",
+		Class:       "ChatInviteLink",
+		Description: "Contains a chat invite link",
+		Fields: []ParamSchema{
+			{Name: "invite_link", Type: "string", Description: "Chat invite link"},
+			{Name: "name", Type: "string", Description: "Name of the link"},
+			{Name: "creator_user_id", Type: "int53", Description: "User identifier of an administrator created the link"},
+			{Name: "date", Type: "int32", Description: "Point in time (Unix timestamp) when the link was created"},
+			{Name: "edit_date", Type: "int32", Description: "Point in time (Unix timestamp) when the link was last edited; 0 if never or unknown"},
+			{Name: "expiration_date", Type: "int32", Description: "Point in time (Unix timestamp) when the link will expire; 0 if never"},
+			{Name: "member_limit", Type: "int32", Description: "The maximum number of members, which can join the chat using the link simultaneously; 0 if not limited. Always 0 if the link requires approval"},
+			{Name: "member_count", Type: "int32", Description: "Number of chat members, which joined the chat using the link"},
+			{Name: "pending_join_request_count", Type: "int32", Description: "Number of pending join requests created using this link"},
+			{Name: "creates_join_request", Type: "Bool", Description: "True, if the link only creates join request. If true, total number of joining members will be unlimited"},
+			{Name: "is_primary", Type: "Bool", Description: "True, if the link is primary. Primary invite link can't have name, expiration date, or usage limit. There is exactly one primary invite link for each administrator with can_invite_users right at a given time"},
+			{Name: "is_revoked", Type: "Bool", Description: "True, if the link was revoked"},
+		},
+	},
+	{
+		Name:        "chatInviteLinkCount",
+		Class:       "ChatInviteLinkCount",
+		Description: "Describes a chat administrator with a number of active and revoked chat invite links",
+		Fields: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "Administrator's user identifier"},
+			{Name: "invite_link_count", Type: "int32", Description: "Number of active invite links"},
+			{Name: "revoked_invite_link_count", Type: "int32", Description: "Number of revoked invite links"},
+		},
+	},
+	{
+		Name:        "chatInviteLinkCounts",
+		Class:       "ChatInviteLinkCounts",
+		Description: "Contains a list of chat invite link counts",
+		Fields: []ParamSchema{
+			{Name: "invite_link_counts", Type: "vector<chatInviteLinkCount>", Description: "List of invite link counts"},
+		},
+	},
+	{
+		Name:        "chatInviteLinkInfo",
+		Class:       "ChatInviteLinkInfo",
+		Description: "Contains information about a chat invite link",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier of the invite link; 0 if the user has no access to the chat before joining"},
+			{Name: "accessible_for", Type: "int32", Description: "If non-zero, the amount of time for which read access to the chat will remain available, in seconds"},
+			{Name: "type", Type: "ChatType", Description: "Type of the chat"},
+			{Name: "title", Type: "string", Description: "Title of the chat"},
+			{Name: "photo", Type: "chatPhotoInfo", Description: "Chat photo; may be null"},
+			{Name: "description", Type: "string", Description: "Chat description"},
+			{Name: "member_count", Type: "int32", Description: "Number of members in the chat"},
+			{Name: "member_user_ids", Type: "vector<int53>", Description: "User identifiers of some chat members that may be known to the current user"},
+			{Name: "creates_join_request", Type: "Bool", Description: "True, if the link only creates join request"},
+			{Name: "is_public", Type: "Bool", Description: "True, if the chat is a public supergroup or channel, i.e. it has a username or it is a location-based supergroup"},
+		},
+	},
+	{
+		Name:        "chatInviteLinkMember",
+		Class:       "ChatInviteLinkMember",
+		Description: "Describes a chat member joined a chat via an invite link",
+		Fields: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "User identifier"},
+			{Name: "joined_chat_date", Type: "int32", Description: "Point in time (Unix timestamp) when the user joined the chat"},
+			{Name: "approver_user_id", Type: "int53", Description: "User identifier of the chat administrator, approved user join request"},
+		},
+	},
+	{
+		Name:        "chatInviteLinkMembers",
+		Class:       "ChatInviteLinkMembers",
+		Description: "Contains a list of chat members joined a chat via an invite link",
+		Fields: []ParamSchema{
+			{Name: "total_count", Type: "int32", Description: "Approximate total count of chat members found"},
+			{Name: "members", Type: "vector<chatInviteLinkMember>", Description: "List of chat members, joined a chat via an invite link"},
+		},
+	},
+	{
+		Name:        "chatInviteLinks",
+		Class:       "ChatInviteLinks",
+		Description: "Contains a list of chat invite links",
+		Fields: []ParamSchema{
+			{Name: "total_count", Type: "int32", Description: "Approximate total count of chat invite links found"},
+			{Name: "invite_links", Type: "vector<chatInviteLink>", Description: "List of invite links"},
+		},
+	},
+	{
+		Name:        "chatJoinRequest",
+		Class:       "ChatJoinRequest",
+		Description: "Describes a user that sent a join request and waits for administrator approval",
+		Fields: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "User identifier"},
+			{Name: "date", Type: "int32", Description: "Point in time (Unix timestamp) when the user sent the join request"},
+			{Name: "bio", Type: "string", Description: "A short bio of the user"},
+		},
+	},
+	{
+		Name:        "chatJoinRequests",
+		Class:       "ChatJoinRequests",
+		Description: "Contains a list of requests to join a chat",
+		Fields: []ParamSchema{
+			{Name: "total_count", Type: "int32", Description: "Approximate total count of requests found"},
+			{Name: "requests", Type: "vector<chatJoinRequest>", Description: "List of the requests"},
+		},
+	},
+	{
+		Name:        "chatJoinRequestsInfo",
+		Class:       "ChatJoinRequestsInfo",
+		Description: "Contains information about pending join requests for a chat",
+		Fields: []ParamSchema{
+			{Name: "total_count", Type: "int32", Description: "Total number of pending join requests"},
+			{Name: "user_ids", Type: "vector<int53>", Description: "Identifiers of at most 3 users sent the newest pending join requests"},
+		},
+	},
+	{
+		Name:        "chatListMain",
+		Class:       "ChatList",
+		Description: "A main list of chats",
+	},
+	{
+		Name:        "chatListArchive",
+		Class:       "ChatList",
+		Description: "A list of chats usually located at the top of the main chat list. Unmuted chats are automatically moved from the Archive to the Main chat list when a new message arrives",
+	},
+	{
+		Name:        "chatListFilter",
+		Class:       "ChatList",
+		Description: "A list of chats belonging to a chat filter",
+		Fields: []ParamSchema{
+			{Name: "chat_filter_id", Type: "int32", Description: "Chat filter identifier"},
+		},
+	},
+	{
+		Name:        "chatLists",
+		Class:       "ChatLists",
+		Description: "Contains a list of chat lists",
+		Fields: []ParamSchema{
+			{Name: "chat_lists", Type: "vector<ChatList>", Description: "List of chat lists"},
+		},
+	},
+	{
+		Name:        "chatLocation",
+		Class:       "ChatLocation",
+		Description: "Represents a location to which a chat is connected",
+		Fields: []ParamSchema{
+			{Name: "location", Type: "location", Description: "The location"},
+			{Name: "address", Type: "string", Description: "Location address; 1-64 characters, as defined by the chat owner"},
+		},
+	},
+	{
+		Name:        "chatMember",
+		Class:       "ChatMember",
+		Description: "Describes a user or a chat as a member of another chat",
+		Fields: []ParamSchema{
+			{Name: "member_id", Type: "MessageSender", Description: "Identifier of the chat member. Currently, other chats can be only Left or Banned. Only supergroups and channels can have other chats as Left or Banned members and these chats must be supergroups or channels"},
+			{Name: "inviter_user_id", Type: "int53", Description: "Identifier of a user that invited/promoted/banned this member in the chat; 0 if unknown"},
+			{Name: "joined_chat_date", Type: "int32", Description: "Point in time (Unix timestamp) when the user joined the chat"},
+			{Name: "status", Type: "ChatMemberStatus", Description: "Status of the member in the chat"},
+		},
+	},
+	{
+		Name:        "chatMemberStatusCreator",
+		Class:       "ChatMemberStatus",
+		Description: "The user is the owner of the chat and has all the administrator privileges",
+		Fields: []ParamSchema{
+			{Name: "custom_title", Type: "string", Description: "A custom title of the owner; 0-16 characters without emojis; applicable to supergroups only"},
+			{Name: "is_anonymous", Type: "Bool", Description: "True, if the creator isn't shown in the chat member list and sends messages anonymously; applicable to supergroups only"},
+			{Name: "is_member", Type: "Bool", Description: "True, if the user is a member of the chat"},
+		},
+	},
+	{
+		Name:        "chatMemberStatusAdministrator",
+		Class:       "ChatMemberStatus",
+		Description: "The user is a member of the chat and has some additional privileges. In basic groups, administrators can edit and delete messages sent by others, add new members, ban unprivileged members, and manage video chats. In supergroups and channels, there are more detailed options for administrator privileges",
+		Fields: []ParamSchema{
+			{Name: "custom_title", Type: "string", Description: "A custom title of the administrator; 0-16 characters without emojis; applicable to supergroups only"},
+			{Name: "can_be_edited", Type: "Bool", Description: "True, if the current user can edit the administrator privileges for the called user"},
+			{Name: "can_manage_chat", Type: "Bool", Description: "True, if the administrator can get chat event log, get chat statistics, get message statistics in channels, get channel members, see anonymous administrators in supergroups and ignore slow mode. Implied by any other privilege; applicable to supergroups and channels only"},
+			{Name: "can_change_info", Type: "Bool", Description: "True, if the administrator can change the chat title, photo, and other settings"},
+			{Name: "can_post_messages", Type: "Bool", Description: "True, if the administrator can create channel posts; applicable to channels only"},
+			{Name: "can_edit_messages", Type: "Bool", Description: "True, if the administrator can edit messages of other users and pin messages; applicable to channels only"},
+			{Name: "can_delete_messages", Type: "Bool", Description: "True, if the administrator can delete messages of other users"},
+			{Name: "can_invite_users", Type: "Bool", Description: "True, if the administrator can invite new users to the chat"},
+			{Name: "can_restrict_members", Type: "Bool", Description: "True, if the administrator can restrict, ban, or unban chat members; always true for channels"},
+			{Name: "can_pin_messages", Type: "Bool", Description: "True, if the administrator can pin messages; applicable to basic groups and supergroups only"},
+			{Name: "can_promote_members", Type: "Bool", Description: "True, if the administrator can add new administrators with a subset of their own privileges or demote administrators that were directly or indirectly promoted by them"},
+			{Name: "can_manage_video_chats", Type: "Bool", Description: "True, if the administrator can manage video chats"},
+			{Name: "is_anonymous", Type: "Bool", Description: "True, if the administrator isn't shown in the chat member list and sends messages anonymously; applicable to supergroups only"},
+		},
+	},
+	{
+		Name:        "chatMemberStatusMember",
+		Class:       "ChatMemberStatus",
+		Description: "The user is a member of the chat, without any additional privileges or restrictions",
+	},
+	{
+		Name:        "chatMemberStatusRestricted",
+		Class:       "ChatMemberStatus",
+		Description: "The user is under certain restrictions in the chat. Not supported in basic groups and channels",
+		Fields: []ParamSchema{
+			{Name: "is_member", Type: "Bool", Description: "True, if the user is a member of the chat"},
+			{Name: "restricted_until_date", Type: "int32", Description: "Point in time (Unix timestamp) when restrictions will be lifted from the user; 0 if never. If the user is restricted for more than 366 days or for less than 30 seconds from the current time, the user is considered to be restricted forever"},
+			{Name: "permissions", Type: "chatPermissions", Description: "User permissions in the chat"},
+		},
+	},
+	{
+		Name:        "chatMemberStatusLeft",
+		Class:       "ChatMemberStatus",
+		Description: "The user or the chat is not a chat member",
+	},
+	{
+		Name:        "chatMemberStatusBanned",
+		Class:       "ChatMemberStatus",
+		Description: "The user or the chat was banned (and hence is not a member of the chat). Implies the user can't return to the chat, view messages, or be used as a participant identifier to join a video chat of the chat",
+		Fields: []ParamSchema{
+			{Name: "banned_until_date", Type: "int32", Description: "Point in time (Unix timestamp) when the user will be unbanned; 0 if never. If the user is banned for more than 366 days or for less than 30 seconds from the current time, the user is considered to be banned forever. Always 0 in basic groups"},
+		},
+	},
+	{
+		Name:        "chatMembers",
+		Class:       "ChatMembers",
+		Description: "Contains a list of chat members",
+		Fields: []ParamSchema{
+			{Name: "total_count", Type: "int32", Description: "Approximate total count of chat members found"},
+			{Name: "members", Type: "vector<chatMember>", Description: "A list of chat members"},
+		},
+	},
+	{
+		Name:        "chatMembersFilterContacts",
+		Class:       "ChatMembersFilter",
+		Description: "Returns contacts of the user",
+	},
+	{
+		Name:        "chatMembersFilterAdministrators",
+		Class:       "ChatMembersFilter",
+		Description: "Returns the owner and administrators",
+	},
+	{
+		Name:        "chatMembersFilterMembers",
+		Class:       "ChatMembersFilter",
+		Description: "Returns all chat members, including restricted chat members",
+	},
+	{
+		Name:        "chatMembersFilterMention",
+		Class:       "ChatMembersFilter",
+		Description: "Returns users which can be mentioned in the chat",
+		Fields: []ParamSchema{
+			{Name: "message_thread_id", Type: "int53", Description: "If non-zero, the identifier of the current message thread"},
+		},
+	},
+	{
+		Name:        "chatMembersFilterRestricted",
+		Class:       "ChatMembersFilter",
+		Description: "Returns users under certain restrictions in the chat; can be used only by administrators in a supergroup",
+	},
+	{
+		Name:        "chatMembersFilterBanned",
+		Class:       "ChatMembersFilter",
+		Description: "Returns users banned from the chat; can be used only by administrators in a supergroup or in a channel",
+	},
+	{
+		Name:        "chatMembersFilterBots",
+		Class:       "ChatMembersFilter",
+		Description: "Returns bot members of the chat",
+	},
+	{
+		Name:        "chatNearby",
+		Class:       "ChatNearby",
+		Description: "Describes a chat located nearby",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "distance", Type: "int32", Description: "Distance to the chat location, in meters"},
+		},
+	},
+	{
+		Name:        "chatNotificationSettings",
+		Class:       "ChatNotificationSettings",
+		Description: "Contains information about notification settings for a chat",
+		Fields: []ParamSchema{
+			{Name: "use_default_mute_for", Type: "Bool", Description: "If true, mute_for is ignored and the value for the relevant type of chat is used instead"},
+			{Name: "mute_for", Type: "int32", Description: "Time left before notifications will be unmuted, in seconds"},
+			{Name: "use_default_sound", Type: "Bool", Description: "If true, sound is ignored and the value for the relevant type of chat is used instead"},
+			{Name: "sound", Type: "string", Description: "The name of an audio file to be used for notification sounds; only applies to iOS applications"},
+			{Name: "use_default_show_preview", Type: "Bool", Description: "If true, show_preview is ignored and the value for the relevant type of chat is used instead"},
+			{Name: "show_preview", Type: "Bool", Description: "True, if message content must be displayed in notifications"},
+			{Name: "use_default_disable_pinned_message_notifications", Type: "Bool", Description: "If true, disable_pinned_message_notifications is ignored and the value for the relevant type of chat is used instead"},
+			{Name: "disable_pinned_message_notifications", Type: "Bool", Description: "If true, notifications for incoming pinned messages will be created as for an ordinary unread message"},
+			{Name: "use_default_disable_mention_notifications", Type: "Bool", Description: "If true, disable_mention_notifications is ignored and the value for the relevant type of chat is used instead"},
+			{Name: "disable_mention_notifications", Type: "Bool", Description: "If true, notifications for messages with mentions will be created as for an ordinary unread message"},
+		},
+	},
+	{
+		Name:        "chatPermissions",
+		Class:       "ChatPermissions",
+		Description: "Describes actions that a user is allowed to take in a chat",
+		Fields: []ParamSchema{
+			{Name: "can_send_messages", Type: "Bool", Description: "True, if the user can send text messages, contacts, locations, and venues"},
+			{Name: "can_send_media_messages", Type: "Bool", Description: "True, if the user can send audio files, documents, photos, videos, video notes, and voice notes. Implies can_send_messages permissions"},
+			{Name: "can_send_polls", Type: "Bool", Description: "True, if the user can send polls. Implies can_send_messages permissions"},
+			{Name: "can_send_stickers", Type: "Bool", Description: "True, if the user can send stickers. Implies can_send_messages permissions"},
+			{Name: "can_send_animations", Type: "Bool", Description: "True, if the user can send animations. Implies can_send_messages permissions"},
+			{Name: "can_send_games", Type: "Bool", Description: "True, if the user can send games. Implies can_send_messages permissions"},
+			{Name: "can_use_inline_bots", Type: "Bool", Description: "True, if the user can use inline bots. Implies can_send_messages permissions"},
+			{Name: "can_add_web_page_previews", Type: "Bool", Description: "True, if the user may add a web page preview to their messages. Implies can_send_messages permissions"},
+			{Name: "can_change_info", Type: "Bool", Description: "True, if the user can change the chat title, photo, and other settings"},
+			{Name: "can_invite_users", Type: "Bool", Description: "True, if the user can invite new users to the chat"},
+			{Name: "can_pin_messages", Type: "Bool", Description: "True, if the user can pin messages"},
+		},
+	},
+	{
+		Name:        "chatPhoto",
+		Class:       "ChatPhoto",
+		Description: "Describes a chat or user profile photo",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int64", Description: "Unique photo identifier"},
+			{Name: "added_date", Type: "int32", Description: "Point in time (Unix timestamp) when the photo has been added"},
+			{Name: "minithumbnail", Type: "minithumbnail", Description: "Photo minithumbnail; may be null"},
+			{Name: "sizes", Type: "vector<photoSize>", Description: "Available variants of the photo in JPEG format, in different size"},
+			{Name: "animation", Type: "animatedChatPhoto", Description: "Animated variant of the photo in MPEG4 format; may be null"},
+		},
+	},
+	{
+		Name:        "chatPhotoInfo",
+		Class:       "ChatPhotoInfo",
+		Description: "Contains basic information about the photo of a chat",
+		Fields: []ParamSchema{
+			{Name: "small", Type: "file", Description: "A small (160x160) chat photo variant in JPEG format. The file can be downloaded only before the photo is changed"},
+			{Name: "big", Type: "file", Description: "A big (640x640) chat photo variant in JPEG format. The file can be downloaded only before the photo is changed"},
+			{Name: "minithumbnail", Type: "minithumbnail", Description: "Chat photo minithumbnail; may be null"},
+			{Name: "has_animation", Type: "Bool", Description: "True, if the photo has animated variant"},
+		},
+	},
+	{
+		Name:        "chatPhotos",
+		Class:       "ChatPhotos",
+		Description: "Contains a list of chat or user profile photos",
+		Fields: []ParamSchema{
+			{Name: "total_count", Type: "int32", Description: "Total number of photos"},
+			{Name: "photos", Type: "vector<chatPhoto>", Description: "List of photos"},
+		},
+	},
+	{
+		Name:        "chatPosition",
+		Class:       "ChatPosition",
+		Description: "Describes a position of a chat in a chat list",
+		Fields: []ParamSchema{
+			{Name: "list", Type: "ChatList", Description: "The chat list"},
+			{Name: "order", Type: "int64", Description: "A parameter used to determine order of the chat in the chat list. Chats must be sorted by the pair (order, chat.id) in descending order"},
+			{Name: "is_pinned", Type: "Bool", Description: "True, if the chat is pinned in the chat list"},
+			{Name: "source", Type: "ChatSource", Description: "Source of the chat in the chat list; may be null"},
+		},
+	},
+	{
+		Name:        "chatReportReasonSpam",
+		Class:       "ChatReportReason",
+		Description: "The chat contains spam messages",
+	},
+	{
+		Name:        "chatReportReasonViolence",
+		Class:       "ChatReportReason",
+		Description: "The chat promotes violence",
+	},
+	{
+		Name:        "chatReportReasonPornography",
+		Class:       "ChatReportReason",
+		Description: "The chat contains pornographic messages",
+	},
+	{
+		Name:        "chatReportReasonChildAbuse",
+		Class:       "ChatReportReason",
+		Description: "The chat has child abuse related content",
+	},
+	{
+		Name:        "chatReportReasonCopyright",
+		Class:       "ChatReportReason",
+		Description: "The chat contains copyrighted content",
+	},
+	{
+		Name:        "chatReportReasonUnrelatedLocation",
+		Class:       "ChatReportReason",
+		Description: "The location-based chat is unrelated to its stated location",
+	},
+	{
+		Name:        "chatReportReasonFake",
+		Class:       "ChatReportReason",
+		Description: "The chat represents a fake account",
+	},
+	{
+		Name:        "chatReportReasonCustom",
+		Class:       "ChatReportReason",
+		Description: "A custom reason provided by the user",
+	},
+	{
+		Name:        "chatSourceMtprotoProxy",
+		Class:       "ChatSource",
+		Description: "The chat is sponsored by the user's MTProxy server",
+	},
+	{
+		Name:        "chatSourcePublicServiceAnnouncement",
+		Class:       "ChatSource",
+		Description: "The chat contains a public service announcement",
+		Fields: []ParamSchema{
+			{Name: "type", Type: "string", Description: "The type of the announcement"},
+			{Name: "text", Type: "string", Description: "The text of the announcement"},
+		},
+	},
+	{
+		Name:        "chatStatisticsSupergroup",
+		Class:       "ChatStatistics",
+		Description: "A detailed statistics about a supergroup chat",
+		Fields: []ParamSchema{
+			{Name: "period", Type: "dateRange", Description: "A period to which the statistics applies"},
+			{Name: "member_count", Type: "statisticalValue", Description: "Number of members in the chat"},
+			{Name: "message_count", Type: "statisticalValue", Description: "Number of messages sent to the chat"},
+			{Name: "viewer_count", Type: "statisticalValue", Description: "Number of users who viewed messages in the chat"},
+			{Name: "sender_count", Type: "statisticalValue", Description: "Number of users who sent messages to the chat"},
+			{Name: "member_count_graph", Type: "StatisticalGraph", Description: "A graph containing number of members in the chat"},
+			{Name: "join_graph", Type: "StatisticalGraph", Description: "A graph containing number of members joined and left the chat"},
+			{Name: "join_by_source_graph", Type: "StatisticalGraph", Description: "A graph containing number of new member joins per source"},
+			{Name: "language_graph", Type: "StatisticalGraph", Description: "A graph containing distribution of active users per language"},
+			{Name: "message_content_graph", Type: "StatisticalGraph", Description: "A graph containing distribution of sent messages by content type"},
+			{Name: "action_graph", Type: "StatisticalGraph", Description: "A graph containing number of different actions in the chat"},
+			{Name: "day_graph", Type: "StatisticalGraph", Description: "A graph containing distribution of message views per hour"},
+			{Name: "week_graph", Type: "StatisticalGraph", Description: "A graph containing distribution of message views per day of week"},
+			{Name: "top_senders", Type: "vector<chatStatisticsMessageSenderInfo>", Description: "List of users sent most messages in the last week"},
+			{Name: "top_administrators", Type: "vector<chatStatisticsAdministratorActionsInfo>", Description: "List of most active administrators in the last week"},
+			{Name: "top_inviters", Type: "vector<chatStatisticsInviterInfo>", Description: "List of most active inviters of new members in the last week"},
+		},
+	},
+	{
+		Name:        "chatStatisticsChannel",
+		Class:       "ChatStatistics",
+		Description: "A detailed statistics about a channel chat",
+		Fields: []ParamSchema{
+			{Name: "period", Type: "dateRange", Description: "A period to which the statistics applies"},
+			{Name: "member_count", Type: "statisticalValue", Description: "Number of members in the chat"},
+			{Name: "mean_view_count", Type: "statisticalValue", Description: "Mean number of times the recently sent messages was viewed"},
+			{Name: "mean_share_count", Type: "statisticalValue", Description: "Mean number of times the recently sent messages was shared"},
+			{Name: "enabled_notifications_percentage", Type: "double", Description: "A percentage of users with enabled notifications for the chat"},
+			{Name: "member_count_graph", Type: "StatisticalGraph", Description: "A graph containing number of members in the chat"},
+			{Name: "join_graph", Type: "StatisticalGraph", Description: "A graph containing number of members joined and left the chat"},
+			{Name: "mute_graph", Type: "StatisticalGraph", Description: "A graph containing number of members muted and unmuted the chat"},
+			{Name: "view_count_by_hour_graph", Type: "StatisticalGraph", Description: "A graph containing number of message views in a given hour in the last two weeks"},
+			{Name: "view_count_by_source_graph", Type: "StatisticalGraph", Description: "A graph containing number of message views per source"},
+			{Name: "join_by_source_graph", Type: "StatisticalGraph", Description: "A graph containing number of new member joins per source"},
+			{Name: "language_graph", Type: "StatisticalGraph", Description: "A graph containing number of users viewed chat messages per language"},
+			{Name: "message_interaction_graph", Type: "StatisticalGraph", Description: "A graph containing number of chat message views and shares"},
+			{Name: "instant_view_interaction_graph", Type: "StatisticalGraph", Description: "A graph containing number of views of associated with the chat instant views"},
+			{Name: "recent_message_interactions", Type: "vector<chatStatisticsMessageInteractionInfo>", Description: "Detailed statistics about number of views and shares of recently sent messages"},
+		},
+	},
+	{
+		Name:        "chatStatisticsAdministratorActionsInfo",
+		Class:       "ChatStatisticsAdministratorActionsInfo",
+		Description: "Contains statistics about administrator actions done by a user",
+		Fields: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "Administrator user identifier"},
+			{Name: "deleted_message_count", Type: "int32", Description: "Number of messages deleted by the administrator"},
+			{Name: "banned_user_count", Type: "int32", Description: "Number of users banned by the administrator"},
+			{Name: "restricted_user_count", Type: "int32", Description: "Number of users restricted by the administrator"},
+		},
+	},
+	{
+		Name:        "chatStatisticsInviterInfo",
+		Class:       "ChatStatisticsInviterInfo",
+		Description: "Contains statistics about number of new members invited by a user",
+		Fields: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "User identifier"},
+			{Name: "added_member_count", Type: "int32", Description: "Number of new members invited by the user"},
+		},
+	},
+	{
+		Name:        "chatStatisticsMessageInteractionInfo",
+		Class:       "ChatStatisticsMessageInteractionInfo",
+		Description: "Contains statistics about interactions with a message",
+		Fields: []ParamSchema{
+			{Name: "message_id", Type: "int53", Description: "Message identifier"},
+			{Name: "view_count", Type: "int32", Description: "Number of times the message was viewed"},
+			{Name: "forward_count", Type: "int32", Description: "Number of times the message was forwarded"},
+		},
+	},
+	{
+		Name:        "chatStatisticsMessageSenderInfo",
+		Class:       "ChatStatisticsMessageSenderInfo",
+		Description: "Contains statistics about messages sent by a user",
+		Fields: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "User identifier"},
+			{Name: "sent_message_count", Type: "int32", Description: "Number of sent messages"},
+			{Name: "average_character_count", Type: "int32", Description: "Average number of characters in sent messages; 0 if unknown"},
+		},
+	},
+	{
+		Name:        "chatTheme",
+		Class:       "ChatTheme",
+		Description: "Describes a chat theme",
+		Fields: []ParamSchema{
+			{Name: "name", Type: "string", Description: "Theme name"},
+			{Name: "light_settings", Type: "themeSettings", Description: "Theme settings for a light chat theme"},
+			{Name: "dark_settings", Type: "themeSettings", Description: "Theme settings for a dark chat theme"},
+		},
+	},
+	{
+		Name:        "chatTypePrivate",
+		Class:       "ChatType",
+		Description: "An ordinary chat with a user",
+		Fields: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "User identifier"},
+		},
+	},
+	{
+		Name:        "chatTypeBasicGroup",
+		Class:       "ChatType",
+		Description: "A basic group (a chat with 0-200 other users)",
+		Fields: []ParamSchema{
+			{Name: "basic_group_id", Type: "int53", Description: "Basic group identifier"},
+		},
+	},
+	{
+		Name:        "chatTypeSupergroup",
+		Class:       "ChatType",
+		Description: "A supergroup or channel (with unlimited members)",
+		Fields: []ParamSchema{
+			{Name: "supergroup_id", Type: "int53", Description: "Supergroup or channel identifier"},
+			{Name: "is_channel", Type: "Bool", Description: "True, if the supergroup is a channel"},
+		},
+	},
+	{
+		Name:        "chatTypeSecret",
+		Class:       "ChatType",
+		Description: "A secret chat with a user",
+		Fields: []ParamSchema{
+			{Name: "secret_chat_id", Type: "int32", Description: "Secret chat identifier"},
+			{Name: "user_id", Type: "int53", Description: "User identifier of the secret chat peer"},
+		},
+	},
+	{
+		Name:        "chats",
+		Class:       "Chats",
+		Description: "Represents a list of chats",
+		Fields: []ParamSchema{
+			{Name: "total_count", Type: "int32", Description: "Approximate total count of chats found"},
+			{Name: "chat_ids", Type: "vector<int53>", Description: "List of chat identifiers"},
+		},
+	},
+	{
+		Name:        "chatsNearby",
+		Class:       "ChatsNearby",
+		Description: "Represents a list of chats located nearby",
+		Fields: []ParamSchema{
+			{Name: "users_nearby", Type: "vector<chatNearby>", Description: "List of users nearby"},
+			{Name: "supergroups_nearby", Type: "vector<chatNearby>", Description: "List of location-based supergroups nearby"},
+		},
+	},
+	{
+		Name:        "checkChatUsernameResultOk",
+		Class:       "CheckChatUsernameResult",
+		Description: "The username can be set",
+	},
+	{
+		Name:        "checkChatUsernameResultUsernameInvalid",
+		Class:       "CheckChatUsernameResult",
+		Description: "The username is invalid",
+	},
+	{
+		Name:        "checkChatUsernameResultUsernameOccupied",
+		Class:       "CheckChatUsernameResult",
+		Description: "The username is occupied",
+	},
+	{
+		Name:        "checkChatUsernameResultPublicChatsTooMuch",
+		Class:       "CheckChatUsernameResult",
+		Description: "The user has too much chats with username, one of them must be made private first",
+	},
+	{
+		Name:        "checkChatUsernameResultPublicGroupsUnavailable",
+		Class:       "CheckChatUsernameResult",
+		Description: "The user can't be a member of a public supergroup",
+	},
+	{
+		Name:        "checkStickerSetNameResultOk",
+		Class:       "CheckStickerSetNameResult",
+		Description: "The name can be set",
+	},
+	{
+		Name:        "checkStickerSetNameResultNameInvalid",
+		Class:       "CheckStickerSetNameResult",
+		Description: "The name is invalid",
+	},
+	{
+		Name:        "checkStickerSetNameResultNameOccupied",
+		Class:       "CheckStickerSetNameResult",
+		Description: "The name is occupied",
+	},
+	{
+		Name:        "closedVectorPath",
+		Class:       "ClosedVectorPath",
+		Description: "Represents a closed vector path. The path begins at the end point of the last command",
+		Fields: []ParamSchema{
+			{Name: "commands", Type: "vector<VectorPathCommand>", Description: "List of vector path commands"},
+		},
+	},
+	{
+		Name:        "colorReplacement",
+		Class:       "ColorReplacement",
+		Description: "Describes a color replacement for animated emoji",
+		Fields: []ParamSchema{
+			{Name: "old_color", Type: "int32", Description: "Original animated emoji color in the RGB24 format"},
+			{Name: "new_color", Type: "int32", Description: "Replacement animated emoji color in the RGB24 format"},
+		},
+	},
+	{
+		Name:        "connectedWebsite",
+		Class:       "ConnectedWebsite",
+		Description: "Contains information about one website the current user is logged in with Telegram",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int64", Description: "Website identifier"},
+			{Name: "domain_name", Type: "string", Description: "The domain name of the website"},
+			{Name: "bot_user_id", Type: "int53", Description: "User identifier of a bot linked with the website"},
+			{Name: "browser", Type: "string", Description: "The version of a browser used to log in"},
+			{Name: "platform", Type: "string", Description: "Operating system the browser is running on"},
+			{Name: "log_in_date", Type: "int32", Description: "Point in time (Unix timestamp) when the user was logged in"},
+			{Name: "last_active_date", Type: "int32", Description: "Point in time (Unix timestamp) when obtained authorization was last used"},
+			{Name: "ip", Type: "string", Description: "IP address from which the user was logged in, in human-readable format"},
+			{Name: "location", Type: "string", Description: "Human-readable description of a country and a region, from which the user was logged in, based on the IP address"},
+		},
+	},
+	{
+		Name:        "connectedWebsites",
+		Class:       "ConnectedWebsites",
+		Description: "Contains a list of websites the current user is logged in with Telegram",
+		Fields: []ParamSchema{
+			{Name: "websites", Type: "vector<connectedWebsite>", Description: "List of connected websites"},
+		},
+	},
+	{
+		Name:        "connectionStateWaitingForNetwork",
+		Class:       "ConnectionState",
+		Description: "Currently waiting for the network to become available. Use setNetworkType to change the available network type",
+	},
+	{
+		Name:        "connectionStateConnectingToProxy",
+		Class:       "ConnectionState",
+		Description: "Currently establishing a connection with a proxy server",
+	},
+	{
+		Name:        "connectionStateConnecting",
+		Class:       "ConnectionState",
+		Description: "Currently establishing a connection to the Telegram servers",
+	},
+	{
+		Name:        "connectionStateUpdating",
+		Class:       "ConnectionState",
+		Description: "Downloading data received while the application was offline",
+	},
+	{
+		Name:        "connectionStateReady",
+		Class:       "ConnectionState",
+		Description: "There is a working connection to the Telegram servers",
+	},
+	{
+		Name:        "contact",
+		Class:       "Contact",
+		Description: "Describes a user contact",
+		Fields: []ParamSchema{
+			{Name: "phone_number", Type: "string", Description: "Phone number of the user"},
+			{Name: "first_name", Type: "string", Description: "First name of the user; 1-255 characters in length"},
+			{Name: "last_name", Type: "string", Description: "Last name of the user"},
+			{Name: "vcard", Type: "string", Description: "Additional data about the user in a form of vCard; 0-2048 bytes in length"},
+			{Name: "user_id", Type: "int53", Description: "Identifier of the user, if known; otherwise 0"},
+		},
+	},
+	{
+		Name:        "count",
+		Class:       "Count",
+		Description: "Contains a counter",
+		Fields: []ParamSchema{
+			{Name: "count", Type: "int32", Description: "Count"},
+		},
+	},
+	{
+		Name:        "countries",
+		Class:       "Countries",
+		Description: "Contains information about countries",
+		Fields: []ParamSchema{
+			{Name: "countries", Type: "vector<countryInfo>", Description: "The list of countries"},
+		},
+	},
+	{
+		Name:        "countryInfo",
+		Class:       "CountryInfo",
+		Description: "Contains information about a country",
+		Fields: []ParamSchema{
+			{Name: "country_code", Type: "string", Description: "A two-letter ISO 3166-1 alpha-2 country code"},
+			{Name: "name", Type: "string", Description: "Native name of the country"},
+			{Name: "english_name", Type: "string", Description: "English name of the country"},
+			{Name: "is_hidden", Type: "Bool", Description: "True, if the country must be hidden from the list of all countries"},
+			{Name: "calling_codes", Type: "vector<string>", Description: "List of country calling codes"},
+		},
+	},
+	{
+		Name:        "customRequestResult",
+		Class:       "CustomRequestResult",
+		Description: "Contains the result of a custom request",
+		Fields: []ParamSchema{
+			{Name: "result", Type: "string", Description: "A JSON-serialized result"},
+		},
+	},
+	{
+		Name:        "databaseStatistics",
+		Class:       "DatabaseStatistics",
+		Description: "Contains database statistics",
+		Fields: []ParamSchema{
+			{Name: "statistics", Type: "string", Description: "Database statistics in an unspecified human-readable format"},
+		},
+	},
+	{
+		Name:        "date",
+		Class:       "Date",
+		Description: "Represents a date according to the Gregorian calendar",
+		Fields: []ParamSchema{
+			{Name: "day", Type: "int32", Description: "Day of the month; 1-31"},
+			{Name: "month", Type: "int32", Description: "Month; 1-12"},
+			{Name: "year", Type: "int32", Description: "Year; 1-9999"},
+		},
+	},
+	{
+		Name:        "dateRange",
+		Class:       "DateRange",
+		Description: "Represents a date range",
+		Fields: []ParamSchema{
+			{Name: "start_date", Type: "int32", Description: "Point in time (Unix timestamp) at which the date range begins"},
+			{Name: "end_date", Type: "int32", Description: "Point in time (Unix timestamp) at which the date range ends"},
+		},
+	},
+	{
+		Name:        "datedFile",
+		Class:       "DatedFile",
+		Description: "File with the date it was uploaded",
+		Fields: []ParamSchema{
+			{Name: "file", Type: "file", Description: "The file"},
+			{Name: "date", Type: "int32", Description: "Point in time (Unix timestamp) when the file was uploaded"},
+		},
+	},
+	{
+		Name:        "deepLinkInfo",
+		Class:       "DeepLinkInfo",
+		Description: "Contains information about a tg: deep link",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "formattedText", Description: "Text to be shown to the user"},
+			{Name: "need_update_application", Type: "Bool", Description: "True, if the user must be asked to update the application"},
+		},
+	},
+	{
+		Name:        "deviceTokenFirebaseCloudMessaging",
+		Class:       "DeviceToken",
+		Description: "A token for Firebase Cloud Messaging",
+		Fields: []ParamSchema{
+			{Name: "token", Type: "string", Description: "Device registration token; may be empty to deregister a device"},
+			{Name: "encrypt", Type: "Bool", Description: "True, if push notifications must be additionally encrypted"},
+		},
+	},
+	{
+		Name:        "deviceTokenApplePush",
+		Class:       "DeviceToken",
+		Description: "A token for Apple Push Notification service",
+		Fields: []ParamSchema{
+			{Name: "device_token", Type: "string", Description: "Device token; may be empty to deregister a device"},
+			{Name: "is_app_sandbox", Type: "Bool", Description: "True, if App Sandbox is enabled"},
+		},
+	},
+	{
+		Name:        "deviceTokenApplePushVoIP",
+		Class:       "DeviceToken",
+		Description: "A token for Apple Push Notification service VoIP notifications",
+		Fields: []ParamSchema{
+			{Name: "device_token", Type: "string", Description: "Device token; may be empty to deregister a device"},
+			{Name: "is_app_sandbox", Type: "Bool", Description: "True, if App Sandbox is enabled"},
+			{Name: "encrypt", Type: "Bool", Description: "True, if push notifications must be additionally encrypted"},
+		},
+	},
+	{
+		Name:        "deviceTokenWindowsPush",
+		Class:       "DeviceToken",
+		Description: "A token for Windows Push Notification Services",
+		Fields: []ParamSchema{
+			{Name: "access_token", Type: "string", Description: "The access token that will be used to send notifications; may be empty to deregister a device"},
+		},
+	},
+	{
+		Name:        "deviceTokenMicrosoftPush",
+		Class:       "DeviceToken",
+		Description: "A token for Microsoft Push Notification Service",
+		Fields: []ParamSchema{
+			{Name: "channel_uri", Type: "string", Description: "Push notification channel URI; may be empty to deregister a device"},
+		},
+	},
+	{
+		Name:        "deviceTokenMicrosoftPushVoIP",
+		Class:       "DeviceToken",
+		Description: "A token for Microsoft Push Notification Service VoIP channel",
+		Fields: []ParamSchema{
+			{Name: "channel_uri", Type: "string", Description: "Push notification channel URI; may be empty to deregister a device"},
+		},
+	},
+	{
+		Name:        "deviceTokenWebPush",
+		Class:       "DeviceToken",
+		Description: "A token for web Push API",
+		Fields: []ParamSchema{
+			{Name: "endpoint", Type: "string", Description: "Absolute URL exposed by the push service where the application server can send push messages; may be empty to deregister a device"},
+			{Name: "p256dh_base64url", Type: "string", Description: "Base64url-encoded P-256 elliptic curve Diffie-Hellman public key"},
+			{Name: "auth_base64url", Type: "string", Description: "Base64url-encoded authentication secret"},
+		},
+	},
+	{
+		Name:        "deviceTokenSimplePush",
+		Class:       "DeviceToken",
+		Description: "A token for Simple Push API for Firefox OS",
+		Fields: []ParamSchema{
+			{Name: "endpoint", Type: "string", Description: "Absolute URL exposed by the push service where the application server can send push messages; may be empty to deregister a device"},
+		},
+	},
+	{
+		Name:        "deviceTokenUbuntuPush",
+		Class:       "DeviceToken",
+		Description: "A token for Ubuntu Push Client service",
+		Fields: []ParamSchema{
+			{Name: "token", Type: "string", Description: "Token; may be empty to deregister a device"},
+		},
+	},
+	{
+		Name:        "deviceTokenBlackBerryPush",
+		Class:       "DeviceToken",
+		Description: "A token for BlackBerry Push Service",
+		Fields: []ParamSchema{
+			{Name: "token", Type: "string", Description: "Token; may be empty to deregister a device"},
+		},
+	},
+	{
+		Name:        "deviceTokenTizenPush",
+		Class:       "DeviceToken",
+		Description: "A token for Tizen Push Service",
+		Fields: []ParamSchema{
+			{Name: "reg_id", Type: "string", Description: "Push service registration identifier; may be empty to deregister a device"},
+		},
+	},
+	{
+		Name:        "diceStickersRegular",
+		Class:       "DiceStickers",
+		Description: "A regular animated sticker",
+		Fields: []ParamSchema{
+			{Name: "sticker", Type: "sticker", Description: "The animated sticker with the dice animation"},
+		},
+	},
+	{
+		Name:        "diceStickersSlotMachine",
+		Class:       "DiceStickers",
+		Description: "Animated stickers to be combined into a slot machine",
+		Fields: []ParamSchema{
+			{Name: "background", Type: "sticker", Description: "The animated sticker with the slot machine background. The background animation must start playing after all reel animations finish"},
+			{Name: "lever", Type: "sticker", Description: "The animated sticker with the lever animation. The lever animation must play once in the initial dice state"},
+			{Name: "left_reel", Type: "sticker", Description: "The animated sticker with the left reel"},
+			{Name: "center_reel", Type: "sticker", Description: "The animated sticker with the center reel"},
+			{Name: "right_reel", Type: "sticker", Description: "The animated sticker with the right reel"},
+		},
+	},
+	{
+		Name:        "document",
+		Class:       "Document",
+		Description: "Describes a document of any type",
+		Fields: []ParamSchema{
+			{Name: "file_name", Type: "string", Description: "Original name of the file; as defined by the sender"},
+			{Name: "mime_type", Type: "string", Description: "MIME type of the file; as defined by the sender"},
+			{Name: "minithumbnail", Type: "minithumbnail", Description: "Document minithumbnail; may be null"},
+			{Name: "thumbnail", Type: "thumbnail", Description: "Document thumbnail in JPEG or PNG format (PNG will be used only for background patterns); as defined by the sender; may be null"},
+			{Name: "document", Type: "file", Description: "File containing the document"},
+		},
+	},
+	{
+		Name:        "draftMessage",
+		Class:       "DraftMessage",
+		Description: "Contains information about a message draft",
+		Fields: []ParamSchema{
+			{Name: "reply_to_message_id", Type: "int53", Description: "Identifier of the message to reply to; 0 if none"},
+			{Name: "date", Type: "int32", Description: "Point in time (Unix timestamp) when the draft was created"},
+			{Name: "input_message_text", Type: "InputMessageContent", Description: "Content of the message draft; must be of the type inputMessageText"},
+		},
+	},
+	{
+		Name:        "emailAddressAuthenticationCodeInfo",
+		Class:       "EmailAddressAuthenticationCodeInfo",
+		Description: "Information about the email address authentication code that was sent",
+		Fields: []ParamSchema{
+			{Name: "email_address_pattern", Type: "string", Description: "Pattern of the email address to which an authentication code was sent"},
+			{Name: "length", Type: "int32", Description: "Length of the code; 0 if unknown"},
+		},
+	},
+	{
+		Name:        "emojis",
+		Class:       "Emojis",
+		Description: "Represents a list of emoji",
+		Fields: []ParamSchema{
+			{Name: "emojis", Type: "vector<string>", Description: "List of emojis"},
+		},
+	},
+	{
+		Name:        "encryptedCredentials",
+		Class:       "EncryptedCredentials",
+		Description: "Contains encrypted Telegram Passport data credentials",
+		Fields: []ParamSchema{
+			{Name: "data", Type: "bytes", Description: "The encrypted credentials"},
+			{Name: "hash", Type: "bytes", Description: "The decrypted data hash"},
+			{Name: "secret", Type: "bytes", Description: "Secret for data decryption, encrypted with the service's public key"},
+		},
+	},
+	{
+		Name:        "encryptedPassportElement",
+		Class:       "EncryptedPassportElement",
+		Description: "Contains information about an encrypted Telegram Passport element; for bots only",
+		Fields: []ParamSchema{
+			{Name: "type", Type: "PassportElementType", Description: "Type of Telegram Passport element"},
+			{Name: "data", Type: "bytes", Description: "Encrypted JSON-encoded data about the user"},
+			{Name: "front_side", Type: "datedFile", Description: "The front side of an identity document"},
+			{Name: "reverse_side", Type: "datedFile", Description: "The reverse side of an identity document; may be null"},
+			{Name: "selfie", Type: "datedFile", Description: "Selfie with the document; may be null"},
+			{Name: "translation", Type: "vector<datedFile>", Description: "List of files containing a certified English translation of the document"},
+			{Name: "files", Type: "vector<datedFile>", Description: "List of attached files"},
+			{Name: "value", Type: "string", Description: "Unencrypted data, phone number or email address"},
+			{Name: "hash", Type: "string", Description: "Hash of the entire element"},
+		},
+	},
+	{
+		Name:        "error",
+		Class:       "Error",
+		Description: "An object of this type can be returned on every function call, in case of an error",
+		Fields: []ParamSchema{
+			{Name: "code", Type: "int32", Description: "Error code; subject to future changes. If the error code is 406, the error message must not be processed in any way and must not be displayed to the user"},
+			{Name: "message", Type: "string", Description: "Error message; subject to future changes"},
+		},
+	},
+	{
+		Name:        "file",
+		Class:       "File",
+		Description: "Represents a file",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int32", Description: "Unique file identifier"},
+			{Name: "dc_id", Type: "int32", Description: "File data center"},
+			{Name: "size", Type: "int32", Description: "File size, in bytes; 0 if unknown"},
+			{Name: "expected_size", Type: "int32", Description: "Approximate file size in bytes in case the exact file size is unknown. Can be used to show download/upload progress"},
+			{Name: "local", Type: "localFile", Description: "Information about the local copy of the file"},
+			{Name: "remote", Type: "remoteFile", Description: "Information about the remote copy of the file"},
+		},
+	},
+	{
+		Name:        "filePart",
+		Class:       "FilePart",
+		Description: "Contains a part of a file",
+		Fields: []ParamSchema{
+			{Name: "data", Type: "bytes", Description: "File bytes"},
+		},
+	},
+	{
+		Name:        "fileTypeNone",
+		Class:       "FileType",
+		Description: "The data is not a file",
+	},
+	{
+		Name:        "fileTypeAnimation",
+		Class:       "FileType",
+		Description: "The file is an animation",
+	},
+	{
+		Name:        "fileTypeAudio",
+		Class:       "FileType",
+		Description: "The file is an audio file",
+	},
+	{
+		Name:        "fileTypeDocument",
+		Class:       "FileType",
+		Description: "The file is a document",
+	},
+	{
+		Name:        "fileTypePhoto",
+		Class:       "FileType",
+		Description: "The file is a photo",
+	},
+	{
+		Name:        "fileTypeProfilePhoto",
+		Class:       "FileType",
+		Description: "The file is a profile photo",
+	},
+	{
+		Name:        "fileTypeSecret",
+		Class:       "FileType",
+		Description: "The file was sent to a secret chat (the file type is not known to the server)",
+	},
+	{
+		Name:        "fileTypeSecretThumbnail",
+		Class:       "FileType",
+		Description: "The file is a thumbnail of a file from a secret chat",
+	},
+	{
+		Name:        "fileTypeSecure",
+		Class:       "FileType",
+		Description: "The file is a file from Secure storage used for storing Telegram Passport files",
+	},
+	{
+		Name:        "fileTypeSticker",
+		Class:       "FileType",
+		Description: "The file is a sticker",
+	},
+	{
+		Name:        "fileTypeThumbnail",
+		Class:       "FileType",
+		Description: "The file is a thumbnail of another file",
+	},
+	{
+		Name:        "fileTypeUnknown",
+		Class:       "FileType",
+		Description: "The file type is not yet known",
+	},
+	{
+		Name:        "fileTypeVideo",
+		Class:       "FileType",
+		Description: "The file is a video",
+	},
+	{
+		Name:        "fileTypeVideoNote",
+		Class:       "FileType",
+		Description: "The file is a video note",
+	},
+	{
+		Name:        "fileTypeVoiceNote",
+		Class:       "FileType",
+		Description: "The file is a voice note",
+	},
+	{
+		Name:        "fileTypeWallpaper",
+		Class:       "FileType",
+		Description: "The file is a wallpaper or a background pattern",
+	},
+	{
+		Name:        "formattedText",
+		Class:       "FormattedText",
+		Description: "A text with some entities",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "string", Description: "The text"},
+			{Name: "entities", Type: "vector<textEntity>", Description: "Entities contained in the text. Entities can be nested, but must not mutually intersect with each other. Pre, Code and PreCode entities can't contain other entities. Bold, Italic, Underline, Strikethrough, and Spoiler entities can contain and to be contained in all other entities. All other entities can't contain each other"},
+		},
+	},
+	{
+		Name:        "foundMessages",
+		Class:       "FoundMessages",
+		Description: "Contains a list of messages found by a search",
+		Fields: []ParamSchema{
+			{Name: "total_count", Type: "int32", Description: "Approximate total count of messages found; -1 if unknown"},
+			{Name: "messages", Type: "vector<message>", Description: "List of messages"},
+			{Name: "next_offset", Type: "string", Description: "The offset for the next request. If empty, there are no more results"},
+		},
+	},
+	{
+		Name:        "game",
+		Class:       "Game",
+		Description: "Describes a game",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int64", Description: "Game ID"},
+			{Name: "short_name", Type: "string", Description: "Game short name. To share a game use the URL https://t.me/{bot_username}?game={game_short_name}"},
+			{Name: "title", Type: "string", Description: "Game title"},
+			{Name: "text", Type: "formattedText", Description: "Game text, usually containing scoreboards for a game"},
+			{Name: "description", Type: "string", Description: "Game description"},
+			{Name: "photo", Type: "photo", Description: "Game photo"},
+			{Name: "animation", Type: "animation", Description: "Game animation; may be null"},
+		},
+	},
+	{
+		Name:        "gameHighScore",
+		Class:       "GameHighScore",
+		Description: "Contains one row of the game high score table",
+		Fields: []ParamSchema{
+			{Name: "position", Type: "int32", Description: "Position in the high score table"},
+			{Name: "user_id", Type: "int53", Description: "User identifier"},
+			{Name: "score", Type: "int32", Description: "User score"},
+		},
+	},
+	{
+		Name:        "gameHighScores",
+		Class:       "GameHighScores",
+		Description: "Contains a list of game high scores",
+		Fields: []ParamSchema{
+			{Name: "scores", Type: "vector<gameHighScore>", Description: "A list of game high scores"},
+		},
+	},
+	{
+		Name:        "groupCall",
+		Class:       "GroupCall",
+		Description: "Describes a group call",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int32", Description: "Group call identifier"},
+			{Name: "title", Type: "string", Description: "Group call title"},
+			{Name: "scheduled_start_date", Type: "int32", Description: "Point in time (Unix timestamp) when the group call is supposed to be started by an administrator; 0 if it is already active or was ended"},
+			{Name: "enabled_start_notification", Type: "Bool", Description: "True, if the group call is scheduled and the current user will receive a notification when the group call will start"},
+			{Name: "is_active", Type: "Bool", Description: "True, if the call is active"},
+			{Name: "is_joined", Type: "Bool", Description: "True, if the call is joined"},
+			{Name: "need_rejoin", Type: "Bool", Description: "True, if user was kicked from the call because of network loss and the call needs to be rejoined"},
+			{Name: "can_be_managed", Type: "Bool", Description: "True, if the current user can manage the group call"},
+			{Name: "participant_count", Type: "int32", Description: "Number of participants in the group call"},
+			{Name: "loaded_all_participants", Type: "Bool", Description: "True, if all group call participants are loaded"},
+			{Name: "recent_speakers", Type: "vector<groupCallRecentSpeaker>", Description: "At most 3 recently speaking users in the group call"},
+			{Name: "is_my_video_enabled", Type: "Bool", Description: "True, if the current user's video is enabled"},
+			{Name: "is_my_video_paused", Type: "Bool", Description: "True, if the current user's video is paused"},
+			{Name: "can_enable_video", Type: "Bool", Description: "True, if the current user can broadcast video or share screen"},
+			{Name: "mute_new_participants", Type: "Bool", Description: "True, if only group call administrators can unmute new participants"},
+			{Name: "can_toggle_mute_new_participants", Type: "Bool", Description: "True, if the current user can enable or disable mute_new_participants setting"},
+			{Name: "record_duration", Type: "int32", Description: "Duration of the ongoing group call recording, in seconds; 0 if none. An updateGroupCall update is not triggered when value of this field changes, but the same recording goes on"},
+			{Name: "is_video_recorded", Type: "Bool", Description: "True, if a video file is being recorded for the call"},
+			{Name: "duration", Type: "int32", Description: "Call duration, in seconds; for ended calls only"},
+		},
+	},
+	{
+		Name:        "groupCallId",
+		Class:       "GroupCallId",
+		Description: "Contains the group call identifier",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int32", Description: "Group call identifier"},
+		},
+	},
+	{
+		Name:        "groupCallParticipant",
+		Class:       "GroupCallParticipant",
+		Description: "Represents a group call participant",
+		Fields: []ParamSchema{
+			{Name: "participant_id", Type: "MessageSender", Description: "Identifier of the group call participant"},
+			{Name: "audio_source_id", Type: "int32", Description: "User's audio channel synchronization source identifier"},
+			{Name: "screen_sharing_audio_source_id", Type: "int32", Description: "User's screen sharing audio channel synchronization source identifier"},
+			{Name: "video_info", Type: "groupCallParticipantVideoInfo", Description: "Information about user's video channel; may be null if there is no active video"},
+			{Name: "screen_sharing_video_info", Type: "groupCallParticipantVideoInfo", Description: "Information about user's screen sharing video channel; may be null if there is no active screen sharing video"},
+			{Name: "bio", Type: "string", Description: "The participant user's bio or the participant chat's description"},
+			{Name: "is_current_user", Type: "Bool", Description: "True, if the participant is the current user"},
+			{Name: "is_speaking", Type: "Bool", Description: "True, if the participant is speaking as set by setGroupCallParticipantIsSpeaking"},
+			{Name: "is_hand_raised", Type: "Bool", Description: "True, if the participant hand is raised"},
+			{Name: "can_be_muted_for_all_users", Type: "Bool", Description: "True, if the current user can mute the participant for all other group call participants"},
+			{Name: "can_be_unmuted_for_all_users", Type: "Bool", Description: "True, if the current user can allow the participant to unmute themselves or unmute the participant (if the participant is the current user)"},
+			{Name: "can_be_muted_for_current_user", Type: "Bool", Description: "True, if the current user can mute the participant only for self"},
+			{Name: "can_be_unmuted_for_current_user", Type: "Bool", Description: "True, if the current user can unmute the participant for self"},
+			{Name: "is_muted_for_all_users", Type: "Bool", Description: "True, if the participant is muted for all users"},
+			{Name: "is_muted_for_current_user", Type: "Bool", Description: "True, if the participant is muted for the current user"},
+			{Name: "can_unmute_self", Type: "Bool", Description: "True, if the participant is muted for all users, but can unmute themselves"},
+			{Name: "volume_level", Type: "int32", Description: "Participant's volume level; 1-20000 in hundreds of percents"},
+			{Name: "order", Type: "string", Description: "User's order in the group call participant list. Orders must be compared lexicographically. The bigger is order, the higher is user in the list. If order is empty, the user must be removed from the participant list"},
+		},
+	},
+	{
+		Name:        "groupCallParticipantVideoInfo",
+		Class:       "GroupCallParticipantVideoInfo",
+		Description: "Contains information about a group call participant's video channel",
+		Fields: []ParamSchema{
+			{Name: "source_groups", Type: "vector<groupCallVideoSourceGroup>", Description: "List of synchronization source groups of the video"},
+			{Name: "endpoint_id", Type: "string", Description: "Video channel endpoint identifier"},
+			{Name: "is_paused", Type: "Bool", Description: "True if the video is paused. This flag needs to be ignored, if new video frames are received"},
+		},
+	},
+	{
+		Name:        "groupCallRecentSpeaker",
+		Class:       "GroupCallRecentSpeaker",
+		Description: "Describes a recently speaking participant in a group call",
+		Fields: []ParamSchema{
+			{Name: "participant_id", Type: "MessageSender", Description: "Group call participant identifier"},
+			{Name: "is_speaking", Type: "Bool", Description: "True, is the user has spoken recently"},
+		},
+	},
+	{
+		Name:        "groupCallVideoQualityThumbnail",
+		Class:       "GroupCallVideoQuality",
+		Description: "The worst available video quality",
+	},
+	{
+		Name:        "groupCallVideoQualityMedium",
+		Class:       "GroupCallVideoQuality",
+		Description: "The medium video quality",
+	},
+	{
+		Name:        "groupCallVideoQualityFull",
+		Class:       "GroupCallVideoQuality",
+		Description: "The best available video quality",
+	},
+	{
+		Name:        "groupCallVideoSourceGroup",
+		Class:       "GroupCallVideoSourceGroup",
+		Description: "Describes a group of video synchronization source identifiers",
+		Fields: []ParamSchema{
+			{Name: "semantics", Type: "string", Description: "The semantics of sources, one of \"SIM\" or \"FID\""},
+			{Name: "source_ids", Type: "vector<int32>", Description: "The list of synchronization source identifiers"},
+		},
+	},
+	{
+		Name:        "hashtags",
+		Class:       "Hashtags",
+		Description: "Contains a list of hashtags",
+		Fields: []ParamSchema{
+			{Name: "hashtags", Type: "vector<string>", Description: "A list of hashtags"},
+		},
+	},
+	{
+		Name:        "httpUrl",
+		Class:       "HttpUrl",
+		Description: "Contains an HTTP URL",
+		Fields: []ParamSchema{
+			{Name: "url", Type: "string", Description: "The URL"},
+		},
+	},
+	{
+		Name:        "identityDocument",
+		Class:       "IdentityDocument",
+		Description: "An identity document",
+		Fields: []ParamSchema{
+			{Name: "number", Type: "string", Description: "Document number; 1-24 characters"},
+			{Name: "expiry_date", Type: "date", Description: "Document expiry date; may be null if not applicable"},
+			{Name: "front_side", Type: "datedFile", Description: "Front side of the document"},
+			{Name: "reverse_side", Type: "datedFile", Description: "Reverse side of the document; only for driver license and identity card; may be null"},
+			{Name: "selfie", Type: "datedFile", Description: "Selfie with the document; may be null"},
+			{Name: "translation", Type: "vector<datedFile>", Description: "List of files containing a certified English translation of the document"},
+		},
+	},
+	{
+		Name:        "importedContacts",
+		Class:       "ImportedContacts",
+		Description: "Represents the result of an ImportContacts request",
+		Fields: []ParamSchema{
+			{Name: "user_ids", Type: "vector<int53>", Description: "User identifiers of the imported contacts in the same order as they were specified in the request; 0 if the contact is not yet a registered user"},
+			{Name: "importer_count", Type: "vector<int32>", Description: "The number of users that imported the corresponding contact; 0 for already registered users or if unavailable"},
+		},
+	},
+	{
+		Name:        "inlineKeyboardButton",
+		Class:       "InlineKeyboardButton",
+		Description: "Represents a single button in an inline keyboard",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "string", Description: "Text of the button"},
+			{Name: "type", Type: "InlineKeyboardButtonType", Description: "Type of the button"},
+		},
+	},
+	{
+		Name:        "inlineKeyboardButtonTypeUrl",
+		Class:       "InlineKeyboardButtonType",
+		Description: "A button that opens a specified URL",
+		Fields: []ParamSchema{
+			{Name: "url", Type: "string", Description: "HTTP or tg:// URL to open"},
+		},
+	},
+	{
+		Name:        "inlineKeyboardButtonTypeLoginUrl",
+		Class:       "InlineKeyboardButtonType",
+		Description: "A button that opens a specified URL and automatically authorize the current user if allowed to do so",
+		Fields: []ParamSchema{
+			{Name: "url", Type: "string", Description: "An HTTP URL to open"},
+			{Name: "id", Type: "int53", Description: "Unique button identifier"},
+			{Name: "forward_text", Type: "string", Description: "If non-empty, new text of the button in forwarded messages"},
+		},
+	},
+	{
+		Name:        "inlineKeyboardButtonTypeCallback",
+		Class:       "InlineKeyboardButtonType",
+		Description: "A button that sends a callback query to a bot",
+		Fields: []ParamSchema{
+			{Name: "data", Type: "bytes", Description: "Data to be sent to the bot via a callback query"},
+		},
+	},
+	{
+		Name:        "inlineKeyboardButtonTypeCallbackWithPassword",
+		Class:       "InlineKeyboardButtonType",
+		Description: "A button that asks for password of the current user and then sends a callback query to a bot",
+		Fields: []ParamSchema{
+			{Name: "data", Type: "bytes", Description: "Data to be sent to the bot via a callback query"},
+		},
+	},
+	{
+		Name:        "inlineKeyboardButtonTypeCallbackGame",
+		Class:       "InlineKeyboardButtonType",
+		Description: "A button with a game that sends a callback query to a bot. This button must be in the first column and row of the keyboard and can be attached only to a message with content of the type messageGame",
+	},
+	{
+		Name:        "inlineKeyboardButtonTypeSwitchInline",
+		Class:       "InlineKeyboardButtonType",
+		Description: "A button that forces an inline query to the bot to be inserted in the input field",
+		Fields: []ParamSchema{
+			{Name: "query", Type: "string", Description: "Inline query to be sent to the bot"},
+			{Name: "in_current_chat", Type: "Bool", Description: "True, if the inline query must be sent from the current chat"},
+		},
+	},
+	{
+		Name:        "inlineKeyboardButtonTypeBuy",
+		Class:       "InlineKeyboardButtonType",
+		Description: "A button to buy something. This button must be in the first column and row of the keyboard and can be attached only to a message with content of the type messageInvoice",
+	},
+	{
+		Name:        "inlineKeyboardButtonTypeUser",
+		Class:       "InlineKeyboardButtonType",
+		Description: "A button with a user reference to be handled in the same way as textEntityTypeMentionName entities",
+		Fields: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "User identifier"},
+		},
+	},
+	{
+		Name:        "inlineQueryResultArticle",
+		Class:       "InlineQueryResult",
+		Description: "Represents a link to an article or web page",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Unique identifier of the query result"},
+			{Name: "url", Type: "string", Description: "URL of the result, if it exists"},
+			{Name: "hide_url", Type: "Bool", Description: "True, if the URL must be not shown"},
+			{Name: "title", Type: "string", Description: "Title of the result"},
+			{Name: "description", Type: "string", Description: "A short description of the result"},
+			{Name: "thumbnail", Type: "thumbnail", Description: "Result thumbnail in JPEG format; may be null"},
+		},
+	},
+	{
+		Name:        "inlineQueryResultContact",
+		Class:       "InlineQueryResult",
+		Description: "Represents a user contact",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Unique identifier of the query result"},
+			{Name: "contact", Type: "contact", Description: "A user contact"},
+			{Name: "thumbnail", Type: "thumbnail", Description: "Result thumbnail in JPEG format; may be null"},
+		},
+	},
+	{
+		Name:        "inlineQueryResultLocation",
+		Class:       "InlineQueryResult",
+		Description: "Represents a point on the map",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Unique identifier of the query result"},
+			{Name: "location", Type: "location", Description: "Location result"},
+			{Name: "title", Type: "string", Description: "Title of the result"},
+			{Name: "thumbnail", Type: "thumbnail", Description: "Result thumbnail in JPEG format; may be null"},
+		},
+	},
+	{
+		Name:        "inlineQueryResultVenue",
+		Class:       "InlineQueryResult",
+		Description: "Represents information about a venue",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Unique identifier of the query result"},
+			{Name: "venue", Type: "venue", Description: "Venue result"},
+			{Name: "thumbnail", Type: "thumbnail", Description: "Result thumbnail in JPEG format; may be null"},
+		},
+	},
+	{
+		Name:        "inlineQueryResultGame",
+		Class:       "InlineQueryResult",
+		Description: "Represents information about a game",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Unique identifier of the query result"},
+			{Name: "game", Type: "game", Description: "Game result"},
+		},
+	},
+	{
+		Name:        "inlineQueryResultAnimation",
+		Class:       "InlineQueryResult",
+		Description: "Represents an animation file",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Unique identifier of the query result"},
+			{Name: "animation", Type: "animation", Description: "Animation file"},
+			{Name: "title", Type: "string", Description: "Animation title"},
+		},
+	},
+	{
+		Name:        "inlineQueryResultAudio",
+		Class:       "InlineQueryResult",
+		Description: "Represents an audio file",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Unique identifier of the query result"},
+			{Name: "audio", Type: "audio", Description: "Audio file"},
+		},
+	},
+	{
+		Name:        "inlineQueryResultDocument",
+		Class:       "InlineQueryResult",
+		Description: "Represents a document",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Unique identifier of the query result"},
+			{Name: "document", Type: "document", Description: "Document"},
+			{Name: "title", Type: "string", Description: "Document title"},
+			{Name: "description", Type: "string", Description: "Document description"},
+		},
+	},
+	{
+		Name:        "inlineQueryResultPhoto",
+		Class:       "InlineQueryResult",
+		Description: "Represents a photo",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Unique identifier of the query result"},
+			{Name: "photo", Type: "photo", Description: "Photo"},
+			{Name: "title", Type: "string", Description: "Title of the result, if known"},
+			{Name: "description", Type: "string", Description: "A short description of the result, if known"},
+		},
+	},
+	{
+		Name:        "inlineQueryResultSticker",
+		Class:       "InlineQueryResult",
+		Description: "Represents a sticker",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Unique identifier of the query result"},
+			{Name: "sticker", Type: "sticker", Description: "Sticker"},
+		},
+	},
+	{
+		Name:        "inlineQueryResultVideo",
+		Class:       "InlineQueryResult",
+		Description: "Represents a video",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Unique identifier of the query result"},
+			{Name: "video", Type: "video", Description: "Video"},
+			{Name: "title", Type: "string", Description: "Title of the video"},
+			{Name: "description", Type: "string", Description: "Description of the video"},
+		},
+	},
+	{
+		Name:        "inlineQueryResultVoiceNote",
+		Class:       "InlineQueryResult",
+		Description: "Represents a voice note",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Unique identifier of the query result"},
+			{Name: "voice_note", Type: "voiceNote", Description: "Voice note"},
+			{Name: "title", Type: "string", Description: "Title of the voice note"},
+		},
+	},
+	{
+		Name:        "inlineQueryResults",
+		Class:       "InlineQueryResults",
+		Description: "Represents the results of the inline query. Use sendInlineQueryResultMessage to send the result of the query",
+		Fields: []ParamSchema{
+			{Name: "inline_query_id", Type: "int64", Description: "Unique identifier of the inline query"},
+			{Name: "next_offset", Type: "string", Description: "The offset for the next request. If empty, there are no more results"},
+			{Name: "results", Type: "vector<InlineQueryResult>", Description: "Results of the query"},
+			{Name: "switch_pm_text", Type: "string", Description: "If non-empty, this text must be shown on the button, which opens a private chat with the bot and sends the bot a start message with the switch_pm_parameter"},
+			{Name: "switch_pm_parameter", Type: "string", Description: "Parameter for the bot start message"},
+		},
+	},
+	{
+		Name:        "inputBackgroundLocal",
+		Class:       "InputBackground",
+		Description: "A background from a local file",
+		Fields: []ParamSchema{
+			{Name: "background", Type: "InputFile", Description: "Background file to use. Only inputFileLocal and inputFileGenerated are supported. The file must be in JPEG format for wallpapers and in PNG format for patterns"},
+		},
+	},
+	{
+		Name:        "inputBackgroundRemote",
+		Class:       "InputBackground",
+		Description: "A background from the server",
+		Fields: []ParamSchema{
+			{Name: "background_id", Type: "int64", Description: "The background identifier"},
+		},
+	},
+	{
+		Name:        "inputChatPhotoPrevious",
+		Class:       "InputChatPhoto",
+		Description: "A previously used profile photo of the current user",
+		Fields: []ParamSchema{
+			{Name: "chat_photo_id", Type: "int64", Description: "Identifier of the current user's profile photo to reuse"},
+		},
+	},
+	{
+		Name:        "inputChatPhotoStatic",
+		Class:       "InputChatPhoto",
+		Description: "A static photo in JPEG format",
+		Fields: []ParamSchema{
+			{Name: "photo", Type: "InputFile", Description: "Photo to be set as profile photo. Only inputFileLocal and inputFileGenerated are allowed"},
+		},
+	},
+	{
+		Name:        "inputChatPhotoAnimation",
+		Class:       "InputChatPhoto",
+		Description: "An animation in MPEG4 format; must be square, at most 10 seconds long, have width between 160 and 800 and be at most 2MB in size",
+		Fields: []ParamSchema{
+			{Name: "animation", Type: "InputFile", Description: "Animation to be set as profile photo. Only inputFileLocal and inputFileGenerated are allowed"},
+			{Name: "main_frame_timestamp", Type: "double", Description: "Timestamp of the frame, which will be used as static chat photo"},
+		},
+	},
+	{
+		Name:        "inputCredentialsSaved",
+		Class:       "InputCredentials",
+		Description: "Applies if a user chooses some previously saved payment credentials. To use their previously saved credentials, the user must have a valid temporary password",
+		Fields: []ParamSchema{
+			{Name: "saved_credentials_id", Type: "string", Description: "Identifier of the saved credentials"},
+		},
+	},
+	{
+		Name:        "inputCredentialsNew",
+		Class:       "InputCredentials",
+		Description: "Applies if a user enters new credentials on a payment provider website",
+		Fields: []ParamSchema{
+			{Name: "data", Type: "string", Description: "JSON-encoded data with the credential identifier from the payment provider"},
+			{Name: "allow_save", Type: "Bool", Description: "True, if the credential identifier can be saved on the server side"},
+		},
+	},
+	{
+		Name:        "inputCredentialsApplePay",
+		Class:       "InputCredentials",
+		Description: "Applies if a user enters new credentials using Apple Pay",
+		Fields: []ParamSchema{
+			{Name: "data", Type: "string", Description: "JSON-encoded data with the credential identifier"},
+		},
+	},
+	{
+		Name:        "inputCredentialsGooglePay",
+		Class:       "InputCredentials",
+		Description: "Applies if a user enters new credentials using Google Pay",
+		Fields: []ParamSchema{
+			{Name: "data", Type: "string", Description: "JSON-encoded data with the credential identifier"},
+		},
+	},
+	{
+		Name:        "inputFileId",
+		Class:       "InputFile",
+		Description: "A file defined by its unique ID",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int32", Description: "Unique file identifier"},
+		},
+	},
+	{
+		Name:        "inputFileRemote",
+		Class:       "InputFile",
+		Description: "A file defined by its remote ID. The remote ID is guaranteed to be usable only if the corresponding file is still accessible to the user and known to TDLib. For example, if the file is from a message, then the message must be not deleted and accessible to the user. If the file database is disabled, then the corresponding object with the file must be preloaded by the application",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Remote file identifier"},
+		},
+	},
+	{
+		Name:        "inputFileLocal",
+		Class:       "InputFile",
+		Description: "A file defined by a local path",
+		Fields: []ParamSchema{
+			{Name: "path", Type: "string", Description: "Local path to the file"},
+		},
+	},
+	{
+		Name:        "inputFileGenerated",
+		Class:       "InputFile",
+		Description: "A file generated by the application",
+		Fields: []ParamSchema{
+			{Name: "original_path", Type: "string", Description: "Local path to a file from which the file is generated; may be empty if there is no such file"},
+			{Name: "conversion", Type: "string", Description: "String specifying the conversion applied to the original file; must be persistent across application restarts. Conversions beginning with '#' are reserved for internal TDLib usage"},
+			{Name: "expected_size", Type: "int32", Description: "Expected size of the generated file, in bytes; 0 if unknown"},
+		},
+	},
+	{
+		Name:        "inputIdentityDocument",
+		Class:       "InputIdentityDocument",
+		Description: "An identity document to be saved to Telegram Passport",
+		Fields: []ParamSchema{
+			{Name: "number", Type: "string", Description: "Document number; 1-24 characters"},
+			{Name: "expiry_date", Type: "date", Description: "Document expiry date; pass null if not applicable"},
+			{Name: "front_side", Type: "InputFile", Description: "Front side of the document"},
+			{Name: "reverse_side", Type: "InputFile", Description: "Reverse side of the document; only for driver license and identity card; pass null otherwise"},
+			{Name: "selfie", Type: "InputFile", Description: "Selfie with the document; pass null if unavailable"},
+			{Name: "translation", Type: "vector<InputFile>", Description: "List of files containing a certified English translation of the document"},
+		},
+	},
+	{
+		Name:        "inputInlineQueryResultAnimation",
+		Class:       "InputInlineQueryResult",
+		Description: "Represents a link to an animated GIF or an animated (i.e., without sound) H.264/MPEG-4 AVC video",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Unique identifier of the query result"},
+			{Name: "title", Type: "string", Description: "Title of the query result"},
+			{Name: "thumbnail_url", Type: "string", Description: "URL of the result thumbnail (JPEG, GIF, or MPEG4), if it exists"},
+			{Name: "thumbnail_mime_type", Type: "string", Description: "MIME type of the video thumbnail. If non-empty, must be one of \"image/jpeg\", \"image/gif\" and \"video/mp4\""},
+			{Name: "video_url", Type: "string", Description: "The URL of the video file (file size must not exceed 1MB)"},
+			{Name: "video_mime_type", Type: "string", Description: "MIME type of the video file. Must be one of \"image/gif\" and \"video/mp4\""},
+			{Name: "video_duration", Type: "int32", Description: "Duration of the video, in seconds"},
+			{Name: "video_width", Type: "int32", Description: "Width of the video"},
+			{Name: "video_height", Type: "int32", Description: "Height of the video"},
+			{Name: "reply_markup", Type: "ReplyMarkup", Description: "The message reply markup; pass null if none. Must be of type replyMarkupInlineKeyboard or null"},
+			{Name: "input_message_content", Type: "InputMessageContent", Description: "The content of the message to be sent. Must be one of the following types: inputMessageText, inputMessageAnimation, inputMessageInvoice, inputMessageLocation, inputMessageVenue or inputMessageContact"},
+		},
+	},
+	{
+		Name:        "inputInlineQueryResultArticle",
+		Class:       "InputInlineQueryResult",
+		Description: "Represents a link to an article or web page",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Unique identifier of the query result"},
+			{Name: "url", Type: "string", Description: "URL of the result, if it exists"},
+			{Name: "hide_url", Type: "Bool", Description: "True, if the URL must be not shown"},
+			{Name: "title", Type: "string", Description: "Title of the result"},
+			{Name: "description", Type: "string", Description: "A short description of the result"},
+			{Name: "thumbnail_url", Type: "string", Description: "URL of the result thumbnail, if it exists"},
+			{Name: "thumbnail_width", Type: "int32", Description: "Thumbnail width, if known"},
+			{Name: "thumbnail_height", Type: "int32", Description: "Thumbnail height, if known"},
+			{Name: "reply_markup", Type: "ReplyMarkup", Description: "The message reply markup; pass null if none. Must be of type replyMarkupInlineKeyboard or null"},
+			{Name: "input_message_content", Type: "InputMessageContent", Description: "The content of the message to be sent. Must be one of the following types: inputMessageText, inputMessageInvoice, inputMessageLocation, inputMessageVenue or inputMessageContact"},
+		},
+	},
+	{
+		Name:        "inputInlineQueryResultAudio",
+		Class:       "InputInlineQueryResult",
+		Description: "Represents a link to an MP3 audio file",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Unique identifier of the query result"},
+			{Name: "title", Type: "string", Description: "Title of the audio file"},
+			{Name: "performer", Type: "string", Description: "Performer of the audio file"},
+			{Name: "audio_url", Type: "string", Description: "The URL of the audio file"},
+			{Name: "audio_duration", Type: "int32", Description: "Audio file duration, in seconds"},
+			{Name: "reply_markup", Type: "ReplyMarkup", Description: "The message reply markup; pass null if none. Must be of type replyMarkupInlineKeyboard or null"},
+			{Name: "input_message_content", Type: "InputMessageContent", Description: "The content of the message to be sent. Must be one of the following types: inputMessageText, inputMessageAudio, inputMessageInvoice, inputMessageLocation, inputMessageVenue or inputMessageContact"},
+		},
+	},
+	{
+		Name:        "inputInlineQueryResultContact",
+		Class:       "InputInlineQueryResult",
+		Description: "Represents a user contact",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Unique identifier of the query result"},
+			{Name: "contact", Type: "contact", Description: "User contact"},
+			{Name: "thumbnail_url", Type: "string", Description: "URL of the result thumbnail, if it exists"},
+			{Name: "thumbnail_width", Type: "int32", Description: "Thumbnail width, if known"},
+			{Name: "thumbnail_height", Type: "int32", Description: "Thumbnail height, if known"},
+			{Name: "reply_markup", Type: "ReplyMarkup", Description: "The message reply markup; pass null if none. Must be of type replyMarkupInlineKeyboard or null"},
+			{Name: "input_message_content", Type: "InputMessageContent", Description: "The content of the message to be sent. Must be one of the following types: inputMessageText, inputMessageInvoice, inputMessageLocation, inputMessageVenue or inputMessageContact"},
+		},
+	},
+	{
+		Name:        "inputInlineQueryResultDocument",
+		Class:       "InputInlineQueryResult",
+		Description: "Represents a link to a file",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Unique identifier of the query result"},
+			{Name: "title", Type: "string", Description: "Title of the resulting file"},
+			{Name: "description", Type: "string", Description: "Short description of the result, if known"},
+			{Name: "document_url", Type: "string", Description: "URL of the file"},
+			{Name: "mime_type", Type: "string", Description: "MIME type of the file content; only \"application/pdf\" and \"application/zip\" are currently allowed"},
+			{Name: "thumbnail_url", Type: "string", Description: "The URL of the file thumbnail, if it exists"},
+			{Name: "thumbnail_width", Type: "int32", Description: "Width of the thumbnail"},
+			{Name: "thumbnail_height", Type: "int32", Description: "Height of the thumbnail"},
+			{Name: "reply_markup", Type: "ReplyMarkup", Description: "The message reply markup; pass null if none. Must be of type replyMarkupInlineKeyboard or null"},
+			{Name: "input_message_content", Type: "InputMessageContent", Description: "The content of the message to be sent. Must be one of the following types: inputMessageText, inputMessageDocument, inputMessageInvoice, inputMessageLocation, inputMessageVenue or inputMessageContact"},
+		},
+	},
+	{
+		Name:        "inputInlineQueryResultGame",
+		Class:       "InputInlineQueryResult",
+		Description: "Represents a game",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Unique identifier of the query result"},
+			{Name: "game_short_name", Type: "string", Description: "Short name of the game"},
+			{Name: "reply_markup", Type: "ReplyMarkup", Description: "The message reply markup; pass null if none. Must be of type replyMarkupInlineKeyboard or null"},
+		},
+	},
+	{
+		Name:        "inputInlineQueryResultLocation",
+		Class:       "InputInlineQueryResult",
+		Description: "Represents a point on the map",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Unique identifier of the query result"},
+			{Name: "location", Type: "location", Description: "Location result"},
+			{Name: "live_period", Type: "int32", Description: "Amount of time relative to the message sent time until the location can be updated, in seconds"},
+			{Name: "title", Type: "string", Description: "Title of the result"},
+			{Name: "thumbnail_url", Type: "string", Description: "URL of the result thumbnail, if it exists"},
+			{Name: "thumbnail_width", Type: "int32", Description: "Thumbnail width, if known"},
+			{Name: "thumbnail_height", Type: "int32", Description: "Thumbnail height, if known"},
+			{Name: "reply_markup", Type: "ReplyMarkup", Description: "The message reply markup; pass null if none. Must be of type replyMarkupInlineKeyboard or null"},
+			{Name: "input_message_content", Type: "InputMessageContent", Description: "The content of the message to be sent. Must be one of the following types: inputMessageText, inputMessageInvoice, inputMessageLocation, inputMessageVenue or inputMessageContact"},
+		},
+	},
+	{
+		Name:        "inputInlineQueryResultPhoto",
+		Class:       "InputInlineQueryResult",
+		Description: "Represents link to a JPEG image",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Unique identifier of the query result"},
+			{Name: "title", Type: "string", Description: "Title of the result, if known"},
+			{Name: "description", Type: "string", Description: "A short description of the result, if known"},
+			{Name: "thumbnail_url", Type: "string", Description: "URL of the photo thumbnail, if it exists"},
+			{Name: "photo_url", Type: "string", Description: "The URL of the JPEG photo (photo size must not exceed 5MB)"},
+			{Name: "photo_width", Type: "int32", Description: "Width of the photo"},
+			{Name: "photo_height", Type: "int32", Description: "Height of the photo"},
+			{Name: "reply_markup", Type: "ReplyMarkup", Description: "The message reply markup; pass null if none. Must be of type replyMarkupInlineKeyboard or null"},
+			{Name: "input_message_content", Type: "InputMessageContent", Description: "The content of the message to be sent. Must be one of the following types: inputMessageText, inputMessagePhoto, inputMessageInvoice, inputMessageLocation, inputMessageVenue or inputMessageContact"},
+		},
+	},
+	{
+		Name:        "inputInlineQueryResultSticker",
+		Class:       "InputInlineQueryResult",
+		Description: "Represents a link to a WEBP or TGS sticker",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Unique identifier of the query result"},
+			{Name: "thumbnail_url", Type: "string", Description: "URL of the sticker thumbnail, if it exists"},
+			{Name: "sticker_url", Type: "string", Description: "The URL of the WEBP or TGS sticker (sticker file size must not exceed 5MB)"},
+			{Name: "sticker_width", Type: "int32", Description: "Width of the sticker"},
+			{Name: "sticker_height", Type: "int32", Description: "Height of the sticker"},
+			{Name: "reply_markup", Type: "ReplyMarkup", Description: "The message reply markup; pass null if none. Must be of type replyMarkupInlineKeyboard or null"},
+			{Name: "input_message_content", Type: "InputMessageContent", Description: "The content of the message to be sent. Must be one of the following types: inputMessageText, inputMessageSticker, inputMessageInvoice, inputMessageLocation, inputMessageVenue or inputMessageContact"},
+		},
+	},
+	{
+		Name:        "inputInlineQueryResultVenue",
+		Class:       "InputInlineQueryResult",
+		Description: "Represents information about a venue",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Unique identifier of the query result"},
+			{Name: "venue", Type: "venue", Description: "Venue result"},
+			{Name: "thumbnail_url", Type: "string", Description: "URL of the result thumbnail, if it exists"},
+			{Name: "thumbnail_width", Type: "int32", Description: "Thumbnail width, if known"},
+			{Name: "thumbnail_height", Type: "int32", Description: "Thumbnail height, if known"},
+			{Name: "reply_markup", Type: "ReplyMarkup", Description: "The message reply markup; pass null if none. Must be of type replyMarkupInlineKeyboard or null"},
+			{Name: "input_message_content", Type: "InputMessageContent", Description: "The content of the message to be sent. Must be one of the following types: inputMessageText, inputMessageInvoice, inputMessageLocation, inputMessageVenue or inputMessageContact"},
+		},
+	},
+	{
+		Name:        "inputInlineQueryResultVideo",
+		Class:       "InputInlineQueryResult",
+		Description: "Represents a link to a page containing an embedded video player or a video file",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Unique identifier of the query result"},
+			{Name: "title", Type: "string", Description: "Title of the result"},
+			{Name: "description", Type: "string", Description: "A short description of the result, if known"},
+			{Name: "thumbnail_url", Type: "string", Description: "The URL of the video thumbnail (JPEG), if it exists"},
+			{Name: "video_url", Type: "string", Description: "URL of the embedded video player or video file"},
+			{Name: "mime_type", Type: "string", Description: "MIME type of the content of the video URL, only \"text/html\" or \"video/mp4\" are currently supported"},
+			{Name: "video_width", Type: "int32", Description: "Width of the video"},
+			{Name: "video_height", Type: "int32", Description: "Height of the video"},
+			{Name: "video_duration", Type: "int32", Description: "Video duration, in seconds"},
+			{Name: "reply_markup", Type: "ReplyMarkup", Description: "The message reply markup; pass null if none. Must be of type replyMarkupInlineKeyboard or null"},
+			{Name: "input_message_content", Type: "InputMessageContent", Description: "The content of the message to be sent. Must be one of the following types: inputMessageText, inputMessageVideo, inputMessageInvoice, inputMessageLocation, inputMessageVenue or inputMessageContact"},
+		},
+	},
+	{
+		Name:        "inputInlineQueryResultVoiceNote",
+		Class:       "InputInlineQueryResult",
+		Description: "Represents a link to an opus-encoded audio file within an OGG container, single channel audio",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Unique identifier of the query result"},
+			{Name: "title", Type: "string", Description: "Title of the voice note"},
+			{Name: "voice_note_url", Type: "string", Description: "The URL of the voice note file"},
+			{Name: "voice_note_duration", Type: "int32", Description: "Duration of the voice note, in seconds"},
+			{Name: "reply_markup", Type: "ReplyMarkup", Description: "The message reply markup; pass null if none. Must be of type replyMarkupInlineKeyboard or null"},
+			{Name: "input_message_content", Type: "InputMessageContent", Description: "The content of the message to be sent. Must be one of the following types: inputMessageText, inputMessageVoiceNote, inputMessageInvoice, inputMessageLocation, inputMessageVenue or inputMessageContact"},
+		},
+	},
+	{
+		Name:        "inputMessageText",
+		Class:       "InputMessageContent",
+		Description: "A text message",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "formattedText", Description: "Formatted text to be sent; 1-GetOption(\"message_text_length_max\") characters. Only Bold, Italic, Underline, Strikethrough, Spoiler, Code, Pre, PreCode, TextUrl and MentionName entities are allowed to be specified manually"},
+			{Name: "disable_web_page_preview", Type: "Bool", Description: "True, if rich web page previews for URLs in the message text must be disabled"},
+			{Name: "clear_draft", Type: "Bool", Description: "True, if a chat message draft must be deleted"},
+		},
+	},
+	{
+		Name:        "inputMessageAnimation",
+		Class:       "InputMessageContent",
+		Description: "An animation message (GIF-style).",
+		Fields: []ParamSchema{
+			{Name: "animation", Type: "InputFile", Description: "Animation file to be sent"},
+			{Name: "thumbnail", Type: "inputThumbnail", Description: "Animation thumbnail; pass null to skip thumbnail uploading"},
+			{Name: "added_sticker_file_ids", Type: "vector<int32>", Description: "File identifiers of the stickers added to the animation, if applicable"},
+			{Name: "duration", Type: "int32", Description: "Duration of the animation, in seconds"},
+			{Name: "width", Type: "int32", Description: "Width of the animation; may be replaced by the server"},
+			{Name: "height", Type: "int32", Description: "Height of the animation; may be replaced by the server"},
+			{Name: "caption", Type: "formattedText", Description: "Animation caption; pass null to use an empty caption; 0-GetOption(\"message_caption_length_max\") characters"},
+		},
+	},
+	{
+		Name:        "inputMessageAudio",
+		Class:       "InputMessageContent",
+		Description: "An audio message",
+		Fields: []ParamSchema{
+			{Name: "audio", Type: "InputFile", Description: "Audio file to be sent"},
+			{Name: "album_cover_thumbnail", Type: "inputThumbnail", Description: "Thumbnail of the cover for the album; pass null to skip thumbnail uploading"},
+			{Name: "duration", Type: "int32", Description: "Duration of the audio, in seconds; may be replaced by the server"},
+			{Name: "title", Type: "string", Description: "Title of the audio; 0-64 characters; may be replaced by the server"},
+			{Name: "performer", Type: "string", Description: "Performer of the audio; 0-64 characters, may be replaced by the server"},
+			{Name: "caption", Type: "formattedText", Description: "Audio caption; pass null to use an empty caption; 0-GetOption(\"message_caption_length_max\") characters"},
+		},
+	},
+	{
+		Name:        "inputMessageDocument",
+		Class:       "InputMessageContent",
+		Description: "A document message (general file)",
+		Fields: []ParamSchema{
+			{Name: "document", Type: "InputFile", Description: "Document to be sent"},
+			{Name: "thumbnail", Type: "inputThumbnail", Description: "Document thumbnail; pass null to skip thumbnail uploading"},
+			{Name: "disable_content_type_detection", Type: "Bool", Description: "If true, automatic file type detection will be disabled and the document will be always sent as file. Always true for files sent to secret chats"},
+			{Name: "caption", Type: "formattedText", Description: "Document caption; pass null to use an empty caption; 0-GetOption(\"message_caption_length_max\") characters"},
+		},
+	},
+	{
+		Name:        "inputMessagePhoto",
+		Class:       "InputMessageContent",
+		Description: "A photo message",
+		Fields: []ParamSchema{
+			{Name: "photo", Type: "InputFile", Description: "Photo to send"},
+			{Name: "thumbnail", Type: "inputThumbnail", Description: "Photo thumbnail to be sent; pass null to skip thumbnail uploading. The thumbnail is sent to the other party only in secret chats"},
+			{Name: "added_sticker_file_ids", Type: "vector<int32>", Description: "File identifiers of the stickers added to the photo, if applicable"},
+			{Name: "width", Type: "int32", Description: "Photo width"},
+			{Name: "height", Type: "int32", Description: "Photo height"},
+			{Name: "caption", Type: "formattedText", Description: "Photo caption; pass null to use an empty caption; 0-GetOption(\"message_caption_length_max\") characters"},
+			{Name: "ttl", Type: "int32", Description: "Photo TTL (Time To Live), in seconds (0-60). A non-zero TTL can be specified only in private chats"},
+		},
+	},
+	{
+		Name:        "inputMessageSticker",
+		Class:       "InputMessageContent",
+		Description: "A sticker message",
+		Fields: []ParamSchema{
+			{Name: "sticker", Type: "InputFile", Description: "Sticker to be sent"},
+			{Name: "thumbnail", Type: "inputThumbnail", Description: "Sticker thumbnail; pass null to skip thumbnail uploading"},
+			{Name: "width", Type: "int32", Description: "Sticker width"},
+			{Name: "height", Type: "int32", Description: "Sticker height"},
+			{Name: "emoji", Type: "string", Description: "Emoji used to choose the sticker"},
+		},
+	},
+	{
+		Name:        "inputMessageVideo",
+		Class:       "InputMessageContent",
+		Description: "A video message",
+		Fields: []ParamSchema{
+			{Name: "video", Type: "InputFile", Description: "Video to be sent"},
+			{Name: "thumbnail", Type: "inputThumbnail", Description: "Video thumbnail; pass null to skip thumbnail uploading"},
+			{Name: "added_sticker_file_ids", Type: "vector<int32>", Description: "File identifiers of the stickers added to the video, if applicable"},
+			{Name: "duration", Type: "int32", Description: "Duration of the video, in seconds"},
+			{Name: "width", Type: "int32", Description: "Video width"},
+			{Name: "height", Type: "int32", Description: "Video height"},
+			{Name: "supports_streaming", Type: "Bool", Description: "True, if the video is supposed to be streamed"},
+			{Name: "caption", Type: "formattedText", Description: "Video caption; pass null to use an empty caption; 0-GetOption(\"message_caption_length_max\") characters"},
+			{Name: "ttl", Type: "int32", Description: "Video TTL (Time To Live), in seconds (0-60). A non-zero TTL can be specified only in private chats"},
+		},
+	},
+	{
+		Name:        "inputMessageVideoNote",
+		Class:       "InputMessageContent",
+		Description: "A video note message",
+		Fields: []ParamSchema{
+			{Name: "video_note", Type: "InputFile", Description: "Video note to be sent"},
+			{Name: "thumbnail", Type: "inputThumbnail", Description: "Video thumbnail; pass null to skip thumbnail uploading"},
+			{Name: "duration", Type: "int32", Description: "Duration of the video, in seconds"},
+			{Name: "length", Type: "int32", Description: "Video width and height; must be positive and not greater than 640"},
+		},
+	},
+	{
+		Name:        "inputMessageVoiceNote",
+		Class:       "InputMessageContent",
+		Description: "A voice note message",
+		Fields: []ParamSchema{
+			{Name: "voice_note", Type: "InputFile", Description: "Voice note to be sent"},
+			{Name: "duration", Type: "int32", Description: "Duration of the voice note, in seconds"},
+			{Name: "waveform", Type: "bytes", Description: "Waveform representation of the voice note, in 5-bit format"},
+			{Name: "caption", Type: "formattedText", Description: "Voice note caption; pass null to use an empty caption; 0-GetOption(\"message_caption_length_max\") characters"},
+		},
+	},
+	{
+		Name:        "inputMessageLocation",
+		Class:       "InputMessageContent",
+		Description: "A message with a location",
+		Fields: []ParamSchema{
+			{Name: "location", Type: "location", Description: "Location to be sent"},
+			{Name: "live_period", Type: "int32", Description: "Period for which the location can be updated, in seconds; must be between 60 and 86400 for a live location and 0 otherwise"},
+			{Name: "heading", Type: "int32", Description: "For live locations, a direction in which the location moves, in degrees; 1-360. Pass 0 if unknown"},
+			{Name: "proximity_alert_radius", Type: "int32", Description: "For live locations, a maximum distance to another chat member for proximity alerts, in meters (0-100000). Pass 0 if the notification is disabled. Can't be enabled in channels and Saved Messages"},
+		},
+	},
+	{
+		Name:        "inputMessageVenue",
+		Class:       "InputMessageContent",
+		Description: "A message with information about a venue",
+		Fields: []ParamSchema{
+			{Name: "venue", Type: "venue", Description: "Venue to send"},
+		},
+	},
+	{
+		Name:        "inputMessageContact",
+		Class:       "InputMessageContent",
+		Description: "A message containing a user contact",
+		Fields: []ParamSchema{
+			{Name: "contact", Type: "contact", Description: "Contact to send"},
+		},
+	},
+	{
+		Name:        "inputMessageDice",
+		Class:       "InputMessageContent",
+		Description: "A dice message",
+		Fields: []ParamSchema{
+			{Name: "emoji", Type: "string", Description: "Emoji on which the dice throw animation is based"},
+			{Name: "clear_draft", Type: "Bool", Description: "True, if the chat message draft must be deleted"},
+		},
+	},
+	{
+		Name:        "inputMessageGame",
+		Class:       "InputMessageContent",
+		Description: "A message with a game; not supported for channels or secret chats",
+		Fields: []ParamSchema{
+			{Name: "bot_user_id", Type: "int53", Description: "User identifier of the bot that owns the game"},
+			{Name: "game_short_name", Type: "string", Description: "Short name of the game"},
+		},
+	},
+	{
+		Name:        "inputMessageInvoice",
+		Class:       "InputMessageContent",
+		Description: "A message with an invoice; can be used only by bots",
+		Fields: []ParamSchema{
+			{Name: "invoice", Type: "invoice", Description: "Invoice"},
+			{Name: "title", Type: "string", Description: "Product title; 1-32 characters"},
+			{Name: "description", Type: "string", Description: "Product description; 0-255 characters"},
+			{Name: "photo_url", Type: "string", Description: "Product photo URL; optional"},
+			{Name: "photo_size", Type: "int32", Description: "Product photo size"},
+			{Name: "photo_width", Type: "int32", Description: "Product photo width"},
+			{Name: "photo_height", Type: "int32", Description: "Product photo height"},
+			{Name: "payload", Type: "bytes", Description: "The invoice payload"},
+			{Name: "provider_token", Type: "string", Description: "Payment provider token"},
+			{Name: "provider_data", Type: "string", Description: "JSON-encoded data about the invoice, which will be shared with the payment provider"},
+			{Name: "start_parameter", Type: "string", Description: "Unique invoice bot deep link parameter for the generation of this invoice. If empty, it would be possible to pay directly from forwards of the invoice message"},
+		},
+	},
+	{
+		Name:        "inputMessagePoll",
+		Class:       "InputMessageContent",
+		Description: "A message with a poll. Polls can't be sent to secret chats. Polls can be sent only to a private chat with a bot",
+		Fields: []ParamSchema{
+			{Name: "question", Type: "string", Description: "Poll question; 1-255 characters (up to 300 characters for bots)"},
+			{Name: "options", Type: "vector<string>", Description: "List of poll answer options, 2-10 strings 1-100 characters each"},
+			{Name: "is_anonymous", Type: "Bool", Description: "True, if the poll voters are anonymous. Non-anonymous polls can't be sent or forwarded to channels"},
+			{Name: "type", Type: "PollType", Description: "Type of the poll"},
+			{Name: "open_period", Type: "int32", Description: "Amount of time the poll will be active after creation, in seconds; for bots only"},
+			{Name: "close_date", Type: "int32", Description: "Point in time (Unix timestamp) when the poll will automatically be closed; for bots only"},
+			{Name: "is_closed", Type: "Bool", Description: "True, if the poll needs to be sent already closed; for bots only"},
+		},
+	},
+	{
+		Name:        "inputMessageForwarded",
+		Class:       "InputMessageContent",
+		Description: "A forwarded message",
+		Fields: []ParamSchema{
+			{Name: "from_chat_id", Type: "int53", Description: "Identifier for the chat this forwarded message came from"},
+			{Name: "message_id", Type: "int53", Description: "Identifier of the message to forward"},
+			{Name: "in_game_share", Type: "Bool", Description: "True, if a game message is being shared from a launched game; applies only to game messages"},
+			{Name: "copy_options", Type: "messageCopyOptions", Description: "Options to be used to copy content of the message without reference to the original sender; pass null to forward the message as usual"},
+		},
+	},
+	{
+		Name:        "inputPassportElementPersonalDetails",
+		Class:       "InputPassportElement",
+		Description: "A Telegram Passport element to be saved containing the user's personal details",
+		Fields: []ParamSchema{
+			{Name: "personal_details", Type: "personalDetails", Description: "Personal details of the user"},
+		},
+	},
+	{
+		Name:        "inputPassportElementPassport",
+		Class:       "InputPassportElement",
+		Description: "A Telegram Passport element to be saved containing the user's passport",
+		Fields: []ParamSchema{
+			{Name: "passport", Type: "inputIdentityDocument", Description: "The passport to be saved"},
+		},
+	},
+	{
+		Name:        "inputPassportElementDriverLicense",
+		Class:       "InputPassportElement",
+		Description: "A Telegram Passport element to be saved containing the user's driver license",
+		Fields: []ParamSchema{
+			{Name: "driver_license", Type: "inputIdentityDocument", Description: "The driver license to be saved"},
+		},
+	},
+	{
+		Name:        "inputPassportElementIdentityCard",
+		Class:       "InputPassportElement",
+		Description: "A Telegram Passport element to be saved containing the user's identity card",
+		Fields: []ParamSchema{
+			{Name: "identity_card", Type: "inputIdentityDocument", Description: "The identity card to be saved"},
+		},
+	},
+	{
+		Name:        "inputPassportElementInternalPassport",
+		Class:       "InputPassportElement",
+		Description: "A Telegram Passport element to be saved containing the user's internal passport",
+		Fields: []ParamSchema{
+			{Name: "internal_passport", Type: "inputIdentityDocument", Description: "The internal passport to be saved"},
+		},
+	},
+	{
+		Name:        "inputPassportElementAddress",
+		Class:       "InputPassportElement",
+		Description: "A Telegram Passport element to be saved containing the user's address",
+		Fields: []ParamSchema{
+			{Name: "address", Type: "address", Description: "The address to be saved"},
+		},
+	},
+	{
+		Name:        "inputPassportElementUtilityBill",
+		Class:       "InputPassportElement",
+		Description: "A Telegram Passport element to be saved containing the user's utility bill",
+		Fields: []ParamSchema{
+			{Name: "utility_bill", Type: "inputPersonalDocument", Description: "The utility bill to be saved"},
+		},
+	},
+	{
+		Name:        "inputPassportElementBankStatement",
+		Class:       "InputPassportElement",
+		Description: "A Telegram Passport element to be saved containing the user's bank statement",
+		Fields: []ParamSchema{
+			{Name: "bank_statement", Type: "inputPersonalDocument", Description: "The bank statement to be saved"},
+		},
+	},
+	{
+		Name:        "inputPassportElementRentalAgreement",
+		Class:       "InputPassportElement",
+		Description: "A Telegram Passport element to be saved containing the user's rental agreement",
+		Fields: []ParamSchema{
+			{Name: "rental_agreement", Type: "inputPersonalDocument", Description: "The rental agreement to be saved"},
+		},
+	},
+	{
+		Name:        "inputPassportElementPassportRegistration",
+		Class:       "InputPassportElement",
+		Description: "A Telegram Passport element to be saved containing the user's passport registration",
+		Fields: []ParamSchema{
+			{Name: "passport_registration", Type: "inputPersonalDocument", Description: "The passport registration page to be saved"},
+		},
+	},
+	{
+		Name:        "inputPassportElementTemporaryRegistration",
+		Class:       "InputPassportElement",
+		Description: "A Telegram Passport element to be saved containing the user's temporary registration",
+		Fields: []ParamSchema{
+			{Name: "temporary_registration", Type: "inputPersonalDocument", Description: "The temporary registration document to be saved"},
+		},
+	},
+	{
+		Name:        "inputPassportElementPhoneNumber",
+		Class:       "InputPassportElement",
+		Description: "A Telegram Passport element to be saved containing the user's phone number",
+		Fields: []ParamSchema{
+			{Name: "phone_number", Type: "string", Description: "The phone number to be saved"},
+		},
+	},
+	{
+		Name:        "inputPassportElementEmailAddress",
+		Class:       "InputPassportElement",
+		Description: "A Telegram Passport element to be saved containing the user's email address",
+		Fields: []ParamSchema{
+			{Name: "email_address", Type: "string", Description: "The email address to be saved"},
+		},
+	},
+	{
+		Name:        "inputPassportElementError",
+		Class:       "InputPassportElementError",
+		Description: "Contains the description of an error in a Telegram Passport element; for bots only",
+		Fields: []ParamSchema{
+			{Name: "type", Type: "PassportElementType", Description: "Type of Telegram Passport element that has the error"},
+			{Name: "message", Type: "string", Description: "Error message"},
+			{Name: "source", Type: "InputPassportElementErrorSource", Description: "Error source"},
+		},
+	},
+	{
+		Name:        "inputPassportElementErrorSourceUnspecified",
+		Class:       "InputPassportElementErrorSource",
+		Description: "The element contains an error in an unspecified place. The error will be considered resolved when new data is added",
+		Fields: []ParamSchema{
+			{Name: "element_hash", Type: "bytes", Description: "Current hash of the entire element"},
+		},
+	},
+	{
+		Name:        "inputPassportElementErrorSourceDataField",
+		Class:       "InputPassportElementErrorSource",
+		Description: "A data field contains an error. The error is considered resolved when the field's value changes",
+		Fields: []ParamSchema{
+			{Name: "field_name", Type: "string", Description: "Field name"},
+			{Name: "data_hash", Type: "bytes", Description: "Current data hash"},
+		},
+	},
+	{
+		Name:        "inputPassportElementErrorSourceFrontSide",
+		Class:       "InputPassportElementErrorSource",
+		Description: "The front side of the document contains an error. The error is considered resolved when the file with the front side of the document changes",
+		Fields: []ParamSchema{
+			{Name: "file_hash", Type: "bytes", Description: "Current hash of the file containing the front side"},
+		},
+	},
+	{
+		Name:        "inputPassportElementErrorSourceReverseSide",
+		Class:       "InputPassportElementErrorSource",
+		Description: "The reverse side of the document contains an error. The error is considered resolved when the file with the reverse side of the document changes",
+		Fields: []ParamSchema{
+			{Name: "file_hash", Type: "bytes", Description: "Current hash of the file containing the reverse side"},
+		},
+	},
+	{
+		Name:        "inputPassportElementErrorSourceSelfie",
+		Class:       "InputPassportElementErrorSource",
+		Description: "The selfie contains an error. The error is considered resolved when the file with the selfie changes",
+		Fields: []ParamSchema{
+			{Name: "file_hash", Type: "bytes", Description: "Current hash of the file containing the selfie"},
+		},
+	},
+	{
+		Name:        "inputPassportElementErrorSourceTranslationFile",
+		Class:       "InputPassportElementErrorSource",
+		Description: "One of the files containing the translation of the document contains an error. The error is considered resolved when the file with the translation changes",
+		Fields: []ParamSchema{
+			{Name: "file_hash", Type: "bytes", Description: "Current hash of the file containing the translation"},
+		},
+	},
+	{
+		Name:        "inputPassportElementErrorSourceTranslationFiles",
+		Class:       "InputPassportElementErrorSource",
+		Description: "The translation of the document contains an error. The error is considered resolved when the list of files changes",
+		Fields: []ParamSchema{
+			{Name: "file_hashes", Type: "vector<bytes>", Description: "Current hashes of all files with the translation"},
+		},
+	},
+	{
+		Name:        "inputPassportElementErrorSourceFile",
+		Class:       "InputPassportElementErrorSource",
+		Description: "The file contains an error. The error is considered resolved when the file changes",
+		Fields: []ParamSchema{
+			{Name: "file_hash", Type: "bytes", Description: "Current hash of the file which has the error"},
+		},
+	},
+	{
+		Name:        "inputPassportElementErrorSourceFiles",
+		Class:       "InputPassportElementErrorSource",
+		Description: "The list of attached files contains an error. The error is considered resolved when the file list changes",
+		Fields: []ParamSchema{
+			{Name: "file_hashes", Type: "vector<bytes>", Description: "Current hashes of all attached files"},
+		},
+	},
+	{
+		Name:        "inputPersonalDocument",
+		Class:       "InputPersonalDocument",
+		Description: "A personal document to be saved to Telegram Passport",
+		Fields: []ParamSchema{
+			{Name: "files", Type: "vector<InputFile>", Description: "List of files containing the pages of the document"},
+			{Name: "translation", Type: "vector<InputFile>", Description: "List of files containing a certified English translation of the document"},
+		},
+	},
+	{
+		Name:        "inputStickerStatic",
+		Class:       "InputSticker",
+		Description: "A static sticker in PNG format, which will be converted to WEBP server-side",
+		Fields: []ParamSchema{
+			{Name: "sticker", Type: "InputFile", Description: "PNG image with the sticker; must be up to 512 KB in size and fit in a 512x512 square"},
+			{Name: "emojis", Type: "string", Description: "Emojis corresponding to the sticker"},
+			{Name: "mask_position", Type: "maskPosition", Description: "For masks, position where the mask is placed; pass null if unspecified"},
+		},
+	},
+	{
+		Name:        "inputStickerAnimated",
+		Class:       "InputSticker",
+		Description: "An animated sticker in TGS format",
+		Fields: []ParamSchema{
+			{Name: "sticker", Type: "InputFile", Description: "File with the animated sticker. Only local or uploaded within a week files are supported. See https://core.telegram.org/animated_stickers#technical-requirements for technical requirements"},
+			{Name: "emojis", Type: "string", Description: "Emojis corresponding to the sticker"},
+		},
+	},
+	{
+		Name:        "inputThumbnail",
+		Class:       "InputThumbnail",
+		Description: "A thumbnail to be sent along with a file; must be in JPEG or WEBP format for stickers, and less than 200 KB in size",
+		Fields: []ParamSchema{
+			{Name: "thumbnail", Type: "InputFile", Description: "Thumbnail file to send. Sending thumbnails by file_id is currently not supported"},
+			{Name: "width", Type: "int32", Description: "Thumbnail width, usually shouldn't exceed 320. Use 0 if unknown"},
+			{Name: "height", Type: "int32", Description: "Thumbnail height, usually shouldn't exceed 320. Use 0 if unknown"},
+		},
+	},
+	{
+		Name:        "internalLinkTypeActiveSessions",
+		Class:       "InternalLinkType",
+		Description: "The link is a link to the active sessions section of the app. Use getActiveSessions to handle the link",
+	},
+	{
+		Name:        "internalLinkTypeAuthenticationCode",
+		Class:       "InternalLinkType",
+		Description: "The link contains an authentication code. Call checkAuthenticationCode with the code if the current authorization state is authorizationStateWaitCode",
+		Fields: []ParamSchema{
+			{Name: "code", Type: "string", Description: "The authentication code"},
+		},
+	},
+	{
+		Name:        "internalLinkTypeBackground",
+		Class:       "InternalLinkType",
+		Description: "The link is a link to a background. Call searchBackground with the given background name to process the link",
+		Fields: []ParamSchema{
+			{Name: "background_name", Type: "string", Description: "Name of the background"},
+		},
+	},
+	{
+		Name:        "internalLinkTypeBotStart",
+		Class:       "InternalLinkType",
+		Description: "The link is a link to a chat with a Telegram bot. Call searchPublicChat with the given bot username, check that the user is a bot, show START button in the chat with the bot, and then call sendBotStartMessage with the given start parameter after the button is pressed",
+		Fields: []ParamSchema{
+			{Name: "bot_username", Type: "string", Description: "Username of the bot"},
+			{Name: "start_parameter", Type: "string", Description: "The parameter to be passed to sendBotStartMessage"},
+		},
+	},
+	{
+		Name:        "internalLinkTypeBotStartInGroup",
+		Class:       "InternalLinkType",
+		Description: "The link is a link to a Telegram bot, which is supposed to be added to a group chat. Call searchPublicChat with the given bot username, check that the user is a bot and can be added to groups, ask the current user to select a group to add the bot to, and then call sendBotStartMessage with the given start parameter and the chosen group chat. Bots can be added to a public group only by administrators of the group",
+		Fields: []ParamSchema{
+			{Name: "bot_username", Type: "string", Description: "Username of the bot"},
+			{Name: "start_parameter", Type: "string", Description: "The parameter to be passed to sendBotStartMessage"},
+		},
+	},
+	{
+		Name:        "internalLinkTypeChangePhoneNumber",
+		Class:       "InternalLinkType",
+		Description: "The link is a link to the change phone number section of the app",
+	},
+	{
+		Name:        "internalLinkTypeChatInvite",
+		Class:       "InternalLinkType",
+		Description: "The link is a chat invite link. Call checkChatInviteLink with the given invite link to process the link",
+		Fields: []ParamSchema{
+			{Name: "invite_link", Type: "string", Description: "Internal representation of the invite link"},
+		},
+	},
+	{
+		Name:        "internalLinkTypeFilterSettings",
+		Class:       "InternalLinkType",
+		Description: "The link is a link to the filter settings section of the app",
+	},
+	{
+		Name:        "internalLinkTypeGame",
+		Class:       "InternalLinkType",
+		Description: "The link is a link to a game. Call searchPublicChat with the given bot username, check that the user is a bot, ask the current user to select a chat to send the game, and then call sendMessage with inputMessageGame",
+		Fields: []ParamSchema{
+			{Name: "bot_username", Type: "string", Description: "Username of the bot that owns the game"},
+			{Name: "game_short_name", Type: "string", Description: "Short name of the game"},
+		},
+	},
+	{
+		Name:        "internalLinkTypeLanguagePack",
+		Class:       "InternalLinkType",
+		Description: "The link is a link to a language pack. Call getLanguagePackInfo with the given language pack identifier to process the link",
+		Fields: []ParamSchema{
+			{Name: "language_pack_id", Type: "string", Description: "Language pack identifier"},
+		},
+	},
+	{
+		Name:        "internalLinkTypeMessage",
+		Class:       "InternalLinkType",
+		Description: "The link is a link to a Telegram message. Call getMessageLinkInfo with the given URL to process the link",
+		Fields: []ParamSchema{
+			{Name: "url", Type: "string", Description: "URL to be passed to getMessageLinkInfo"},
+		},
+	},
+	{
+		Name:        "internalLinkTypeMessageDraft",
+		Class:       "InternalLinkType",
+		Description: "The link contains a message draft text. A share screen needs to be shown to the user, then the chosen chat must be opened and the text is added to the input field",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "formattedText", Description: "Message draft text"},
+			{Name: "contains_link", Type: "Bool", Description: "True, if the first line of the text contains a link. If true, the input field needs to be focused and the text after the link must be selected"},
+		},
+	},
+	{
+		Name:        "internalLinkTypePassportDataRequest",
+		Class:       "InternalLinkType",
+		Description: "The link contains a request of Telegram passport data. Call getPassportAuthorizationForm with the given parameters to process the link if the link was received from outside of the app, otherwise ignore it",
+		Fields: []ParamSchema{
+			{Name: "bot_user_id", Type: "int53", Description: "User identifier of the service's bot"},
+			{Name: "scope", Type: "string", Description: "Telegram Passport element types requested by the service"},
+			{Name: "public_key", Type: "string", Description: "Service's public key"},
+			{Name: "nonce", Type: "string", Description: "Unique request identifier provided by the service"},
+			{Name: "callback_url", Type: "string", Description: "An HTTP URL to open once the request is finished or canceled with the parameter tg_passport=success or tg_passport=cancel respectively. If empty, then the link tgbot{bot_user_id}://passport/success or tgbot{bot_user_id}://passport/cancel needs to be opened instead"},
+		},
+	},
+	{
+		Name:        "internalLinkTypePhoneNumberConfirmation",
+		Class:       "InternalLinkType",
+		Description: "The link can be used to confirm ownership of a phone number to prevent account deletion. Call sendPhoneNumberConfirmationCode with the given hash and phone number to process the link",
+		Fields: []ParamSchema{
+			{Name: "hash", Type: "string", Description: "Hash value from the link"},
+			{Name: "phone_number", Type: "string", Description: "Phone number value from the link"},
+		},
+	},
+	{
+		Name:        "internalLinkTypeProxy",
+		Class:       "InternalLinkType",
+		Description: "The link is a link to a proxy. Call addProxy with the given parameters to process the link and add the proxy",
+		Fields: []ParamSchema{
+			{Name: "server", Type: "string", Description: "Proxy server IP address"},
+			{Name: "port", Type: "int32", Description: "Proxy server port"},
+			{Name: "type", Type: "ProxyType", Description: "Type of the proxy"},
+		},
+	},
+	{
+		Name:        "internalLinkTypePublicChat",
+		Class:       "InternalLinkType",
+		Description: "The link is a link to a chat by its username. Call searchPublicChat with the given chat username to process the link",
+		Fields: []ParamSchema{
+			{Name: "chat_username", Type: "string", Description: "Username of the chat"},
+		},
+	},
+	{
+		Name:        "internalLinkTypeQrCodeAuthentication",
+		Class:       "InternalLinkType",
+		Description: "The link can be used to login the current user on another device, but it must be scanned from QR-code using in-app camera. An alert similar to \"This code can be used to allow someone to log in to your Telegram account. To confirm Telegram login, please go to Settings > Devices > Scan QR and scan the code\" needs to be shown",
+	},
+	{
+		Name:        "internalLinkTypeSettings",
+		Class:       "InternalLinkType",
+		Description: "The link is a link to app settings",
+	},
+	{
+		Name:        "internalLinkTypeStickerSet",
+		Class:       "InternalLinkType",
+		Description: "The link is a link to a sticker set. Call searchStickerSet with the given sticker set name to process the link and show the sticker set",
+		Fields: []ParamSchema{
+			{Name: "sticker_set_name", Type: "string", Description: "Name of the sticker set"},
+		},
+	},
+	{
+		Name:        "internalLinkTypeTheme",
+		Class:       "InternalLinkType",
+		Description: "The link is a link to a theme. TDLib has no theme support yet",
+		Fields: []ParamSchema{
+			{Name: "theme_name", Type: "string", Description: "Name of the theme"},
+		},
+	},
+	{
+		Name:        "internalLinkTypeThemeSettings",
+		Class:       "InternalLinkType",
+		Description: "The link is a link to the theme settings section of the app",
+	},
+	{
+		Name:        "internalLinkTypeUnknownDeepLink",
+		Class:       "InternalLinkType",
+		Description: "The link is an unknown tg: link. Call getDeepLinkInfo to process the link",
+		Fields: []ParamSchema{
+			{Name: "link", Type: "string", Description: "Link to be passed to getDeepLinkInfo"},
+		},
+	},
+	{
+		Name:        "internalLinkTypeUnsupportedProxy",
+		Class:       "InternalLinkType",
+		Description: "The link is a link to an unsupported proxy. An alert can be shown to the user",
+	},
+	{
+		Name:        "internalLinkTypeVideoChat",
+		Class:       "InternalLinkType",
+		Description: "The link is a link to a video chat. Call searchPublicChat with the given chat username, and then joinGoupCall with the given invite hash to process the link",
+		Fields: []ParamSchema{
+			{Name: "chat_username", Type: "string", Description: "Username of the chat with the video chat"},
+			{Name: "invite_hash", Type: "string", Description: "If non-empty, invite hash to be used to join the video chat without being muted by administrators"},
+			{Name: "is_live_stream", Type: "Bool", Description: "True, if the video chat is expected to be a live stream in a channel or a broadcast group"},
+		},
+	},
+	{
+		Name:        "invoice",
+		Class:       "Invoice",
+		Description: "Product invoice",
+		Fields: []ParamSchema{
+			{Name: "currency", Type: "string", Description: "ISO 4217 currency code"},
+			{Name: "price_parts", Type: "vector<labeledPricePart>", Description: "A list of objects used to calculate the total price of the product"},
+			{Name: "max_tip_amount", Type: "int53", Description: "The maximum allowed amount of tip in the smallest units of the currency"},
+			{Name: "suggested_tip_amounts", Type: "vector<int53>", Description: "Suggested amounts of tip in the smallest units of the currency"},
+			{Name: "is_test", Type: "Bool", Description: "True, if the payment is a test payment"},
+			{Name: "need_name", Type: "Bool", Description: "True, if the user's name is needed for payment"},
+			{Name: "need_phone_number", Type: "Bool", Description: "True, if the user's phone number is needed for payment"},
+			{Name: "need_email_address", Type: "Bool", Description: "True, if the user's email address is needed for payment"},
+			{Name: "need_shipping_address", Type: "Bool", Description: "True, if the user's shipping address is needed for payment"},
+			{Name: "send_phone_number_to_provider", Type: "Bool", Description: "True, if the user's phone number will be sent to the provider"},
+			{Name: "send_email_address_to_provider", Type: "Bool", Description: "True, if the user's email address will be sent to the provider"},
+			{Name: "is_flexible", Type: "Bool", Description: "True, if the total price depends on the shipping method"},
+		},
+	},
+	{
+		Name:        "jsonObjectMember",
+		Class:       "JsonObjectMember",
+		Description: "Represents one member of a JSON object",
+		Fields: []ParamSchema{
+			{Name: "key", Type: "string", Description: "Member's key"},
+			{Name: "value", Type: "JsonValue", Description: "Member's value"},
+		},
+	},
+	{
+		Name:        "jsonValueNull",
+		Class:       "JsonValue",
+		Description: "Represents a null JSON value",
+	},
+	{
+		Name:        "jsonValueBoolean",
+		Class:       "JsonValue",
+		Description: "Represents a boolean JSON value",
+		Fields: []ParamSchema{
+			{Name: "value", Type: "Bool", Description: "The value"},
+		},
+	},
+	{
+		Name:        "jsonValueNumber",
+		Class:       "JsonValue",
+		Description: "Represents a numeric JSON value",
+		Fields: []ParamSchema{
+			{Name: "value", Type: "double", Description: "The value"},
+		},
+	},
+	{
+		Name:        "jsonValueString",
+		Class:       "JsonValue",
+		Description: "Represents a string JSON value",
+		Fields: []ParamSchema{
+			{Name: "value", Type: "string", Description: "The value"},
+		},
+	},
+	{
+		Name:        "jsonValueArray",
+		Class:       "JsonValue",
+		Description: "Represents a JSON array",
+		Fields: []ParamSchema{
+			{Name: "values", Type: "vector<JsonValue>", Description: "The list of array elements"},
+		},
+	},
+	{
+		Name:        "jsonValueObject",
+		Class:       "JsonValue",
+		Description: "Represents a JSON object",
+		Fields: []ParamSchema{
+			{Name: "members", Type: "vector<jsonObjectMember>", Description: "The list of object members"},
+		},
+	},
+	{
+		Name:        "keyboardButton",
+		Class:       "KeyboardButton",
+		Description: "Represents a single button in a bot keyboard",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "string", Description: "Text of the button"},
+			{Name: "type", Type: "KeyboardButtonType", Description: "Type of the button"},
+		},
+	},
+	{
+		Name:        "keyboardButtonTypeText",
+		Class:       "KeyboardButtonType",
+		Description: "A simple button, with text that must be sent when the button is pressed",
+	},
+	{
+		Name:        "keyboardButtonTypeRequestPhoneNumber",
+		Class:       "KeyboardButtonType",
+		Description: "A button that sends the user's phone number when pressed; available only in private chats",
+	},
+	{
+		Name:        "keyboardButtonTypeRequestLocation",
+		Class:       "KeyboardButtonType",
+		Description: "A button that sends the user's location when pressed; available only in private chats",
+	},
+	{
+		Name:        "keyboardButtonTypeRequestPoll",
+		Class:       "KeyboardButtonType",
+		Description: "A button that allows the user to create and send a poll when pressed; available only in private chats",
+		Fields: []ParamSchema{
+			{Name: "force_regular", Type: "Bool", Description: "If true, only regular polls must be allowed to create"},
+			{Name: "force_quiz", Type: "Bool", Description: "If true, only polls in quiz mode must be allowed to create"},
+		},
+	},
+	{
+		Name:        "labeledPricePart",
+		Class:       "LabeledPricePart",
+		Description: "Portion of the price of a product (e.g., \"delivery cost\", \"tax amount\")",
+		Fields: []ParamSchema{
+			{Name: "label", Type: "string", Description: "Label for this portion of the product price"},
+			{Name: "amount", Type: "int53", Description: "Currency amount in the smallest units of the currency"},
+		},
+	},
+	{
+		Name:        "languagePackInfo",
+		Class:       "LanguagePackInfo",
+		Description: "Contains information about a language pack",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Unique language pack identifier"},
+			{Name: "base_language_pack_id", Type: "string", Description: "Identifier of a base language pack; may be empty. If a string is missed in the language pack, then it must be fetched from base language pack. Unsupported in custom language packs"},
+			{Name: "name", Type: "string", Description: "Language name"},
+			{Name: "native_name", Type: "string", Description: "Name of the language in that language"},
+			{Name: "plural_code", Type: "string", Description: "A language code to be used to apply plural forms. See https://www.unicode.org/cldr/charts/latest/supplemental/language_plural_rules.html for more info"},
+			{Name: "is_official", Type: "Bool", Description: "True, if the language pack is official"},
+			{Name: "is_rtl", Type: "Bool", Description: "True, if the language pack strings are RTL"},
+			{Name: "is_beta", Type: "Bool", Description: "True, if the language pack is a beta language pack"},
+			{Name: "is_installed", Type: "Bool", Description: "True, if the language pack is installed by the current user"},
+			{Name: "total_string_count", Type: "int32", Description: "Total number of non-deleted strings from the language pack"},
+			{Name: "translated_string_count", Type: "int32", Description: "Total number of translated strings from the language pack"},
+			{Name: "local_string_count", Type: "int32", Description: "Total number of non-deleted strings from the language pack available locally"},
+			{Name: "translation_url", Type: "string", Description: "Link to language translation interface; empty for custom local language packs"},
+		},
+	},
+	{
+		Name:        "languagePackString",
+		Class:       "LanguagePackString",
+		Description: "Represents one language pack string",
+		Fields: []ParamSchema{
+			{Name: "key", Type: "string", Description: "String key"},
+			{Name: "value", Type: "LanguagePackStringValue", Description: "String value; pass null if the string needs to be taken from the built-in English language pack"},
+		},
+	},
+	{
+		Name:        "languagePackStringValueOrdinary",
+		Class:       "LanguagePackStringValue",
+		Description: "An ordinary language pack string",
+		Fields: []ParamSchema{
+			{Name: "value", Type: "string", Description: "String value"},
+		},
+	},
+	{
+		Name:        "languagePackStringValuePluralized",
+		Class:       "LanguagePackStringValue",
+		Description: "A language pack string which has different forms based on the number of some object it mentions. See https://www.unicode.org/cldr/charts/latest/supplemental/language_plural_rules.html for more info",
+		Fields: []ParamSchema{
+			{Name: "zero_value", Type: "string", Description: "Value for zero objects"},
+			{Name: "one_value", Type: "string", Description: "Value for one object"},
+			{Name: "two_value", Type: "string", Description: "Value for two objects"},
+			{Name: "few_value", Type: "string", Description: "Value for few objects"},
+			{Name: "many_value", Type: "string", Description: "Value for many objects"},
+			{Name: "other_value", Type: "string", Description: "Default value"},
+		},
+	},
+	{
+		Name:        "languagePackStringValueDeleted",
+		Class:       "LanguagePackStringValue",
+		Description: "A deleted language pack string, the value must be taken from the built-in English language pack",
+	},
+	{
+		Name:        "languagePackStrings",
+		Class:       "LanguagePackStrings",
+		Description: "Contains a list of language pack strings",
+		Fields: []ParamSchema{
+			{Name: "strings", Type: "vector<languagePackString>", Description: "A list of language pack strings"},
+		},
+	},
+	{
+		Name:        "localFile",
+		Class:       "LocalFile",
+		Description: "Represents a local file",
+		Fields: []ParamSchema{
+			{Name: "path", Type: "string", Description: "Local path to the locally available file part; may be empty"},
+			{Name: "can_be_downloaded", Type: "Bool", Description: "True, if it is possible to download or generate the file"},
+			{Name: "can_be_deleted", Type: "Bool", Description: "True, if the file can be deleted"},
+			{Name: "is_downloading_active", Type: "Bool", Description: "True, if the file is currently being downloaded (or a local copy is being generated by some other means)"},
+			{Name: "is_downloading_completed", Type: "Bool", Description: "True, if the local copy is fully available"},
+			{Name: "download_offset", Type: "int32", Description: "Download will be started from this offset. downloaded_prefix_size is calculated from this offset"},
+			{Name: "downloaded_prefix_size", Type: "int32", Description: "If is_downloading_completed is false, then only some prefix of the file starting from download_offset is ready to be read. downloaded_prefix_size is the size of that prefix in bytes"},
+			{Name: "downloaded_size", Type: "int32", Description: "Total downloaded file size, in bytes. Can be used only for calculating download progress. The actual file size may be bigger, and some parts of it may contain garbage"},
+		},
+	},
+	{
+		Name:        "localizationTargetInfo",
+		Class:       "LocalizationTargetInfo",
+		Description: "Contains information about the current localization target",
+		Fields: []ParamSchema{
+			{Name: "language_packs", Type: "vector<languagePackInfo>", Description: "List of available language packs for this application"},
+		},
+	},
+	{
+		Name:        "location",
+		Class:       "Location",
+		Description: "Describes a location on planet Earth",
+		Fields: []ParamSchema{
+			{Name: "latitude", Type: "double", Description: "Latitude of the location in degrees; as defined by the sender"},
+			{Name: "longitude", Type: "double", Description: "Longitude of the location, in degrees; as defined by the sender"},
+			{Name: "horizontal_accuracy", Type: "double", Description: "The estimated horizontal accuracy of the location, in meters; as defined by the sender. 0 if unknown"},
+		},
+	},
+	{
+		Name:        "logStreamDefault",
+		Class:       "LogStream",
+		Description: "The log is written to stderr or an OS specific log",
+	},
+	{
+		Name:        "logStreamFile",
+		Class:       "LogStream",
+		Description: "The log is written to a file",
+		Fields: []ParamSchema{
+			{Name: "path", Type: "string", Description: "Path to the file to where the internal TDLib log will be written"},
+			{Name: "max_file_size", Type: "int53", Description: "The maximum size of the file to where the internal TDLib log is written before the file will automatically be rotated, in bytes"},
+			{Name: "redirect_stderr", Type: "Bool", Description: "Pass true to additionally redirect stderr to the log file. Ignored on Windows"},
+		},
+	},
+	{
+		Name:        "logStreamEmpty",
+		Class:       "LogStream",
+		Description: "The log is written nowhere",
+	},
+	{
+		Name:        "logTags",
+		Class:       "LogTags",
+		Description: "Contains a list of available TDLib internal log tags",
+		Fields: []ParamSchema{
+			{Name: "tags", Type: "vector<string>", Description: "List of log tags"},
+		},
+	},
+	{
+		Name:        "logVerbosityLevel",
+		Class:       "LogVerbosityLevel",
+		Description: "Contains a TDLib internal log verbosity level",
+		Fields: []ParamSchema{
+			{Name: "verbosity_level", Type: "int32", Description: "Log verbosity level"},
+		},
+	},
+	{
+		Name:        "loginUrlInfoOpen",
+		Class:       "LoginUrlInfo",
+		Description: "An HTTP url needs to be open",
+		Fields: []ParamSchema{
+			{Name: "url", Type: "string", Description: "The URL to open"},
+			{Name: "skip_confirm", Type: "Bool", Description: "True, if there is no need to show an ordinary open URL confirm"},
+		},
+	},
+	{
+		Name:        "loginUrlInfoRequestConfirmation",
+		Class:       "LoginUrlInfo",
+		Description: "An authorization confirmation dialog needs to be shown to the user",
+		Fields: []ParamSchema{
+			{Name: "url", Type: "string", Description: "An HTTP URL to be opened"},
+			{Name: "domain", Type: "string", Description: "A domain of the URL"},
+			{Name: "bot_user_id", Type: "int53", Description: "User identifier of a bot linked with the website"},
+			{Name: "request_write_access", Type: "Bool", Description: "True, if the user needs to be requested to give the permission to the bot to send them messages"},
+		},
+	},
+	{
+		Name:        "maskPointForehead",
+		Class:       "MaskPoint",
+		Description: "The mask is placed relatively to the forehead",
+	},
+	{
+		Name:        "maskPointEyes",
+		Class:       "MaskPoint",
+		Description: "The mask is placed relatively to the eyes",
+	},
+	{
+		Name:        "maskPointMouth",
+		Class:       "MaskPoint",
+		Description: "The mask is placed relatively to the mouth",
+	},
+	{
+		Name:        "maskPointChin",
+		Class:       "MaskPoint",
+		Description: "The mask is placed relatively to the chin",
+	},
+	{
+		Name:        "maskPosition",
+		Class:       "MaskPosition",
+		Description: "Position on a photo where a mask is placed",
+		Fields: []ParamSchema{
+			{Name: "point", Type: "MaskPoint", Description: "Part of the face, relative to which the mask is placed"},
+			{Name: "x_shift", Type: "double", Description: "Shift by X-axis measured in widths of the mask scaled to the face size, from left to right. (For example, -1.0 will place the mask just to the left of the default mask position)"},
+			{Name: "y_shift", Type: "double", Description: "Shift by Y-axis measured in heights of the mask scaled to the face size, from top to bottom. (For example, 1.0 will place the mask just below the default mask position)"},
+			{Name: "scale", Type: "double", Description: "Mask scaling coefficient. (For example, 2.0 means a doubled size)"},
+		},
+	},
+	{
+		Name:        "message",
+		Class:       "Message",
+		Description: "Describes a message",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int53", Description: "Message identifier; unique for the chat to which the message belongs"},
+			{Name: "sender_id", Type: "MessageSender", Description: "Identifier of the sender of the message"},
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "sending_state", Type: "MessageSendingState", Description: "The sending state of the message; may be null"},
+			{Name: "scheduling_state", Type: "MessageSchedulingState", Description: "The scheduling state of the message; may be null"},
+			{Name: "is_outgoing", Type: "Bool", Description: "True, if the message is outgoing"},
+			{Name: "is_pinned", Type: "Bool", Description: "True, if the message is pinned"},
+			{Name: "can_be_edited", Type: "Bool", Description: "True, if the message can be edited. For live location and poll messages this fields shows whether editMessageLiveLocation or stopPoll can be used with this message by the application"},
+			{Name: "can_be_forwarded", Type: "Bool", Description: "True, if the message can be forwarded"},
+			{Name: "can_be_saved", Type: "Bool", Description: "True, if content of the message can be saved locally or copied"},
+			{Name: "can_be_deleted_only_for_self", Type: "Bool", Description: "True, if the message can be deleted only for the current user while other users will continue to see it"},
+			{Name: "can_be_deleted_for_all_users", Type: "Bool", Description: "True, if the message can be deleted for all users"},
+			{Name: "can_get_statistics", Type: "Bool", Description: "True, if the message statistics are available"},
+			{Name: "can_get_message_thread", Type: "Bool", Description: "True, if the message thread info is available"},
+			{Name: "can_get_viewers", Type: "Bool", Description: "True, if chat members already viewed the message can be received through getMessageViewers"},
+			{Name: "can_get_media_timestamp_links", Type: "Bool", Description: "True, if media timestamp links can be generated for media timestamp entities in the message text, caption or web page description"},
+			{Name: "has_timestamped_media", Type: "Bool", Description: "True, if media timestamp entities refers to a media in this message as opposed to a media in the replied message"},
+			{Name: "is_channel_post", Type: "Bool", Description: "True, if the message is a channel post. All messages to channels are channel posts, all other messages are not channel posts"},
+			{Name: "contains_unread_mention", Type: "Bool", Description: "True, if the message contains an unread mention for the current user"},
+			{Name: "date", Type: "int32", Description: "Point in time (Unix timestamp) when the message was sent"},
+			{Name: "edit_date", Type: "int32", Description: "Point in time (Unix timestamp) when the message was last edited"},
+			{Name: "forward_info", Type: "messageForwardInfo", Description: "Information about the initial message sender; may be null"},
+			{Name: "interaction_info", Type: "messageInteractionInfo", Description: "Information about interactions with the message; may be null"},
+			{Name: "reply_in_chat_id", Type: "int53", Description: "If non-zero, the identifier of the chat to which the replied message belongs; Currently, only messages in the Replies chat can have different reply_in_chat_id and chat_id"},
+			{Name: "reply_to_message_id", Type: "int53", Description: "If non-zero, the identifier of the message this message is replying to; can be the identifier of a deleted message"},
+			{Name: "message_thread_id", Type: "int53", Description: "If non-zero, the identifier of the message thread the message belongs to; unique within the chat to which the message belongs"},
+			{Name: "ttl", Type: "int32", Description: "For self-destructing messages, the message's TTL (Time To Live), in seconds; 0 if none. TDLib will send updateDeleteMessages or updateMessageContent once the TTL expires"},
+			{Name: "ttl_expires_in", Type: "double", Description: "Time left before the message expires, in seconds. If the TTL timer isn't started yet, equals to the value of the ttl field"},
+			{Name: "via_bot_user_id", Type: "int53", Description: "If non-zero, the user identifier of the bot through which this message was sent"},
+			{Name: "author_signature", Type: "string", Description: "For channel posts and anonymous group messages, optional author signature"},
+			{Name: "media_album_id", Type: "int64", Description: "Unique identifier of an album this message belongs to. Only audios, documents, photos and videos can be grouped together in albums"},
+			{Name: "restriction_reason", Type: "string", Description: "If non-empty, contains a human-readable description of the reason why access to this message must be restricted"},
+			{Name: "content", Type: "MessageContent", Description: "Content of the message"},
+			{Name: "reply_markup", Type: "ReplyMarkup", Description: "Reply markup for the message; may be null"},
+		},
+	},
+	{
+		Name:        "messageCalendar",
+		Class:       "MessageCalendar",
+		Description: "Contains information about found messages, split by days according to the option \"utc_time_offset\"",
+		Fields: []ParamSchema{
+			{Name: "total_count", Type: "int32", Description: "Total number of found messages"},
+			{Name: "days", Type: "vector<messageCalendarDay>", Description: "Information about messages sent"},
+		},
+	},
+	{
+		Name:        "messageCalendarDay",
+		Class:       "MessageCalendarDay",
+		Description: "Contains information about found messages sent on a specific day",
+		Fields: []ParamSchema{
+			{Name: "total_count", Type: "int32", Description: "Total number of found messages sent on the day"},
+			{Name: "message", Type: "message", Description: "First message sent on the day"},
+		},
+	},
+	{
+		Name:        "messageText",
+		Class:       "MessageContent",
+		Description: "A text message",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "formattedText", Description: "Text of the message"},
+			{Name: "web_page", Type: "webPage", Description: "A preview of the web page that's mentioned in the text; may be null"},
+		},
+	},
+	{
+		Name:        "messageAnimation",
+		Class:       "MessageContent",
+		Description: "An animation message (GIF-style).",
+		Fields: []ParamSchema{
+			{Name: "animation", Type: "animation", Description: "The animation description"},
+			{Name: "caption", Type: "formattedText", Description: "Animation caption"},
+			{Name: "is_secret", Type: "Bool", Description: "True, if the animation thumbnail must be blurred and the animation must be shown only while tapped"},
+		},
+	},
+	{
+		Name:        "messageAudio",
+		Class:       "MessageContent",
+		Description: "An audio message",
+		Fields: []ParamSchema{
+			{Name: "audio", Type: "audio", Description: "The audio description"},
+			{Name: "caption", Type: "formattedText", Description: "Audio caption"},
+		},
+	},
+	{
+		Name:        "messageDocument",
+		Class:       "MessageContent",
+		Description: "A document message (general file)",
+		Fields: []ParamSchema{
+			{Name: "document", Type: "document", Description: "The document description"},
+			{Name: "caption", Type: "formattedText", Description: "Document caption"},
+		},
+	},
+	{
+		Name:        "messagePhoto",
+		Class:       "MessageContent",
+		Description: "A photo message",
+		Fields: []ParamSchema{
+			{Name: "photo", Type: "photo", Description: "The photo description"},
+			{Name: "caption", Type: "formattedText", Description: "Photo caption"},
+			{Name: "is_secret", Type: "Bool", Description: "True, if the photo must be blurred and must be shown only while tapped"},
+		},
+	},
+	{
+		Name:        "messageExpiredPhoto",
+		Class:       "MessageContent",
+		Description: "An expired photo message (self-destructed after TTL has elapsed)",
+	},
+	{
+		Name:        "messageSticker",
+		Class:       "MessageContent",
+		Description: "A sticker message",
+		Fields: []ParamSchema{
+			{Name: "sticker", Type: "sticker", Description: "The sticker description"},
+		},
+	},
+	{
+		Name:        "messageVideo",
+		Class:       "MessageContent",
+		Description: "A video message",
+		Fields: []ParamSchema{
+			{Name: "video", Type: "video", Description: "The video description"},
+			{Name: "caption", Type: "formattedText", Description: "Video caption"},
+			{Name: "is_secret", Type: "Bool", Description: "True, if the video thumbnail must be blurred and the video must be shown only while tapped"},
+		},
+	},
+	{
+		Name:        "messageExpiredVideo",
+		Class:       "MessageContent",
+		Description: "An expired video message (self-destructed after TTL has elapsed)",
+	},
+	{
+		Name:        "messageVideoNote",
+		Class:       "MessageContent",
+		Description: "A video note message",
+		Fields: []ParamSchema{
+			{Name: "video_note", Type: "videoNote", Description: "The video note description"},
+			{Name: "is_viewed", Type: "Bool", Description: "True, if at least one of the recipients has viewed the video note"},
+			{Name: "is_secret", Type: "Bool", Description: "True, if the video note thumbnail must be blurred and the video note must be shown only while tapped"},
+		},
+	},
+	{
+		Name:        "messageVoiceNote",
+		Class:       "MessageContent",
+		Description: "A voice note message",
+		Fields: []ParamSchema{
+			{Name: "voice_note", Type: "voiceNote", Description: "The voice note description"},
+			{Name: "caption", Type: "formattedText", Description: "Voice note caption"},
+			{Name: "is_listened", Type: "Bool", Description: "True, if at least one of the recipients has listened to the voice note"},
+		},
+	},
+	{
+		Name:        "messageLocation",
+		Class:       "MessageContent",
+		Description: "A message with a location",
+		Fields: []ParamSchema{
+			{Name: "location", Type: "location", Description: "The location description"},
+			{Name: "live_period", Type: "int32", Description: "Time relative to the message send date, for which the location can be updated, in seconds"},
+			{Name: "expires_in", Type: "int32", Description: "Left time for which the location can be updated, in seconds. updateMessageContent is not sent when this field changes"},
+			{Name: "heading", Type: "int32", Description: "For live locations, a direction in which the location moves, in degrees; 1-360. If 0 the direction is unknown"},
+			{Name: "proximity_alert_radius", Type: "int32", Description: "For live locations, a maximum distance to another chat member for proximity alerts, in meters (0-100000). 0 if the notification is disabled. Available only for the message sender"},
+		},
+	},
+	{
+		Name:        "messageVenue",
+		Class:       "MessageContent",
+		Description: "A message with information about a venue",
+		Fields: []ParamSchema{
+			{Name: "venue", Type: "venue", Description: "The venue description"},
+		},
+	},
+	{
+		Name:        "messageContact",
+		Class:       "MessageContent",
+		Description: "A message with a user contact",
+		Fields: []ParamSchema{
+			{Name: "contact", Type: "contact", Description: "The contact description"},
+		},
+	},
+	{
+		Name:        "messageAnimatedEmoji",
+		Class:       "MessageContent",
+		Description: "A message with an animated emoji",
+		Fields: []ParamSchema{
+			{Name: "animated_emoji", Type: "animatedEmoji", Description: "The animated emoji"},
+			{Name: "emoji", Type: "string", Description: "The corresponding emoji"},
+		},
+	},
+	{
+		Name:        "messageDice",
+		Class:       "MessageContent",
+		Description: "A dice message. The dice value is randomly generated by the server",
+		Fields: []ParamSchema{
+			{Name: "initial_state", Type: "DiceStickers", Description: "The animated stickers with the initial dice animation; may be null if unknown. updateMessageContent will be sent when the sticker became known"},
+			{Name: "final_state", Type: "DiceStickers", Description: "The animated stickers with the final dice animation; may be null if unknown. updateMessageContent will be sent when the sticker became known"},
+			{Name: "emoji", Type: "string", Description: "Emoji on which the dice throw animation is based"},
+			{Name: "value", Type: "int32", Description: "The dice value. If the value is 0, the dice don't have final state yet"},
+			{Name: "success_animation_frame_number", Type: "int32", Description: "Number of frame after which a success animation like a shower of confetti needs to be shown on updateMessageSendSucceeded"},
+		},
+	},
+	{
+		Name:        "messageGame",
+		Class:       "MessageContent",
+		Description: "A message with a game",
+		Fields: []ParamSchema{
+			{Name: "game", Type: "game", Description: "The game description"},
+		},
+	},
+	{
+		Name:        "messagePoll",
+		Class:       "MessageContent",
+		Description: "A message with a poll",
+		Fields: []ParamSchema{
+			{Name: "poll", Type: "poll", Description: "The poll description"},
+		},
+	},
+	{
+		Name:        "messageInvoice",
+		Class:       "MessageContent",
+		Description: "A message with an invoice from a bot",
+		Fields: []ParamSchema{
+			{Name: "title", Type: "string", Description: "Product title"},
+			{Name: "description", Type: "string", Description: "Product description"},
+			{Name: "photo", Type: "photo", Description: "Product photo; may be null"},
+			{Name: "currency", Type: "string", Description: "Currency for the product price"},
+			{Name: "total_amount", Type: "int53", Description: "Product total price in the smallest units of the currency"},
+			{Name: "start_parameter", Type: "string", Description: "Unique invoice bot start_parameter. To share an invoice use the URL https://t.me/{bot_username}?start={start_parameter}"},
+			{Name: "is_test", Type: "Bool", Description: "True, if the invoice is a test invoice"},
+			{Name: "need_shipping_address", Type: "Bool", Description: "True, if the shipping address must be specified"},
+			{Name: "receipt_message_id", Type: "int53", Description: "The identifier of the message with the receipt, after the product has been purchased"},
+		},
+	},
+	{
+		Name:        "messageCall",
+		Class:       "MessageContent",
+		Description: "A message with information about an ended call",
+		Fields: []ParamSchema{
+			{Name: "is_video", Type: "Bool", Description: "True, if the call was a video call"},
+			{Name: "discard_reason", Type: "CallDiscardReason", Description: "Reason why the call was discarded"},
+			{Name: "duration", Type: "int32", Description: "Call duration, in seconds"},
+		},
+	},
+	{
+		Name:        "messageVideoChatScheduled",
+		Class:       "MessageContent",
+		Description: "A new video chat was scheduled",
+		Fields: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Identifier of the video chat. The video chat can be received through the method getGroupCall"},
+			{Name: "start_date", Type: "int32", Description: "Point in time (Unix timestamp) when the group call is supposed to be started by an administrator"},
+		},
+	},
+	{
+		Name:        "messageVideoChatStarted",
+		Class:       "MessageContent",
+		Description: "A newly created video chat",
+		Fields: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Identifier of the video chat. The video chat can be received through the method getGroupCall"},
+		},
+	},
+	{
+		Name:        "messageVideoChatEnded",
+		Class:       "MessageContent",
+		Description: "A message with information about an ended video chat",
+		Fields: []ParamSchema{
+			{Name: "duration", Type: "int32", Description: "Call duration, in seconds"},
+		},
+	},
+	{
+		Name:        "messageInviteVideoChatParticipants",
+		Class:       "MessageContent",
+		Description: "A message with information about an invite to a video chat",
+		Fields: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Identifier of the video chat. The video chat can be received through the method getGroupCall"},
+			{Name: "user_ids", Type: "vector<int53>", Description: "Invited user identifiers"},
+		},
+	},
+	{
+		Name:        "messageBasicGroupChatCreate",
+		Class:       "MessageContent",
+		Description: "A newly created basic group",
+		Fields: []ParamSchema{
+			{Name: "title", Type: "string", Description: "Title of the basic group"},
+			{Name: "member_user_ids", Type: "vector<int53>", Description: "User identifiers of members in the basic group"},
+		},
+	},
+	{
+		Name:        "messageSupergroupChatCreate",
+		Class:       "MessageContent",
+		Description: "A newly created supergroup or channel",
+		Fields: []ParamSchema{
+			{Name: "title", Type: "string", Description: "Title of the supergroup or channel"},
+		},
+	},
+	{
+		Name:        "messageChatChangeTitle",
+		Class:       "MessageContent",
+		Description: "An updated chat title",
+		Fields: []ParamSchema{
+			{Name: "title", Type: "string", Description: "New chat title"},
+		},
+	},
+	{
+		Name:        "messageChatChangePhoto",
+		Class:       "MessageContent",
+		Description: "An updated chat photo",
+		Fields: []ParamSchema{
+			{Name: "photo", Type: "chatPhoto", Description: "New chat photo"},
+		},
+	},
+	{
+		Name:        "messageChatDeletePhoto",
+		Class:       "MessageContent",
+		Description: "A deleted chat photo",
+	},
+	{
+		Name:        "messageChatAddMembers",
+		Class:       "MessageContent",
+		Description: "New chat members were added",
+		Fields: []ParamSchema{
+			{Name: "member_user_ids", Type: "vector<int53>", Description: "User identifiers of the new members"},
+		},
+	},
+	{
+		Name:        "messageChatJoinByLink",
+		Class:       "MessageContent",
+		Description: "A new member joined the chat via an invite link",
+	},
+	{
+		Name:        "messageChatJoinByRequest",
+		Class:       "MessageContent",
+		Description: "A new member was accepted to the chat by an administrator",
+	},
+	{
+		Name:        "messageChatDeleteMember",
+		Class:       "MessageContent",
+		Description: "A chat member was deleted",
+		Fields: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "User identifier of the deleted chat member"},
+		},
+	},
+	{
+		Name:        "messageChatUpgradeTo",
+		Class:       "MessageContent",
+		Description: "A basic group was upgraded to a supergroup and was deactivated as the result",
+		Fields: []ParamSchema{
+			{Name: "supergroup_id", Type: "int53", Description: "Identifier of the supergroup to which the basic group was upgraded"},
+		},
+	},
+	{
+		Name:        "messageChatUpgradeFrom",
+		Class:       "MessageContent",
+		Description: "A supergroup has been created from a basic group",
+		Fields: []ParamSchema{
+			{Name: "title", Type: "string", Description: "Title of the newly created supergroup"},
+			{Name: "basic_group_id", Type: "int53", Description: "The identifier of the original basic group"},
+		},
+	},
+	{
+		Name:        "messagePinMessage",
+		Class:       "MessageContent",
+		Description: "A message has been pinned",
+		Fields: []ParamSchema{
+			{Name: "message_id", Type: "int53", Description: "Identifier of the pinned message, can be an identifier of a deleted message or 0"},
+		},
+	},
+	{
+		Name:        "messageScreenshotTaken",
+		Class:       "MessageContent",
+		Description: "A screenshot of a message in the chat has been taken",
+	},
+	{
+		Name:        "messageChatSetTheme",
+		Class:       "MessageContent",
+		Description: "A theme in the chat has been changed",
+		Fields: []ParamSchema{
+			{Name: "theme_name", Type: "string", Description: "If non-empty, name of a new theme, set for the chat. Otherwise chat theme was reset to the default one"},
+		},
+	},
+	{
+		Name:        "messageChatSetTtl",
+		Class:       "MessageContent",
+		Description: "The TTL (Time To Live) setting for messages in the chat has been changed",
+		Fields: []ParamSchema{
+			{Name: "ttl", Type: "int32", Description: "New message TTL"},
+		},
+	},
+	{
+		Name:        "messageCustomServiceAction",
+		Class:       "MessageContent",
+		Description: "A non-standard action has happened in the chat",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "string", Description: "Message text to be shown in the chat"},
+		},
+	},
+	{
+		Name:        "messageGameScore",
+		Class:       "MessageContent",
+		Description: "A new high score was achieved in a game",
+		Fields: []ParamSchema{
+			{Name: "game_message_id", Type: "int53", Description: "Identifier of the message with the game, can be an identifier of a deleted message"},
+			{Name: "game_id", Type: "int64", Description: "Identifier of the game; may be different from the games presented in the message with the game"},
+			{Name: "score", Type: "int32", Description: "New score"},
+		},
+	},
+	{
+		Name:        "messagePaymentSuccessful",
+		Class:       "MessageContent",
+		Description: "A payment has been completed",
+		Fields: []ParamSchema{
+			{Name: "invoice_chat_id", Type: "int53", Description: "Identifier of the chat, containing the corresponding invoice message; 0 if unknown"},
+			{Name: "invoice_message_id", Type: "int53", Description: "Identifier of the message with the corresponding invoice; can be an identifier of a deleted message"},
+			{Name: "currency", Type: "string", Description: "Currency for the price of the product"},
+			{Name: "total_amount", Type: "int53", Description: "Total price for the product, in the smallest units of the currency"},
+		},
+	},
+	{
+		Name:        "messagePaymentSuccessfulBot",
+		Class:       "MessageContent",
+		Description: "A payment has been completed; for bots only",
+		Fields: []ParamSchema{
+			{Name: "currency", Type: "string", Description: "Currency for price of the product"},
+			{Name: "total_amount", Type: "int53", Description: "Total price for the product, in the smallest units of the currency"},
+			{Name: "invoice_payload", Type: "bytes", Description: "Invoice payload"},
+			{Name: "shipping_option_id", Type: "string", Description: "Identifier of the shipping option chosen by the user; may be empty if not applicable"},
+			{Name: "order_info", Type: "orderInfo", Description: "Information about the order; may be null"},
+			{Name: "telegram_payment_charge_id", Type: "string", Description: "Telegram payment identifier"},
+			{Name: "provider_payment_charge_id", Type: "string", Description: "Provider payment identifier"},
+		},
+	},
+	{
+		Name:        "messageContactRegistered",
+		Class:       "MessageContent",
+		Description: "A contact has registered with Telegram",
+	},
+	{
+		Name:        "messageWebsiteConnected",
+		Class:       "MessageContent",
+		Description: "The current user has connected a website by logging in using Telegram Login Widget on it",
+		Fields: []ParamSchema{
+			{Name: "domain_name", Type: "string", Description: "Domain name of the connected website"},
+		},
+	},
+	{
+		Name:        "messagePassportDataSent",
+		Class:       "MessageContent",
+		Description: "Telegram Passport data has been sent",
+		Fields: []ParamSchema{
+			{Name: "types", Type: "vector<PassportElementType>", Description: "List of Telegram Passport element types sent"},
+		},
+	},
+	{
+		Name:        "messagePassportDataReceived",
+		Class:       "MessageContent",
+		Description: "Telegram Passport data has been received; for bots only",
+		Fields: []ParamSchema{
+			{Name: "elements", Type: "vector<encryptedPassportElement>", Description: "List of received Telegram Passport elements"},
+			{Name: "credentials", Type: "encryptedCredentials", Description: "Encrypted data credentials"},
+		},
+	},
+	{
+		Name:        "messageProximityAlertTriggered",
+		Class:       "MessageContent",
+		Description: "A user in the chat came within proximity alert range",
+		Fields: []ParamSchema{
+			{Name: "traveler_id", Type: "MessageSender", Description: "The identifier of a user or chat that triggered the proximity alert"},
+			{Name: "watcher_id", Type: "MessageSender", Description: "The identifier of a user or chat that subscribed for the proximity alert"},
+			{Name: "distance", Type: "int32", Description: "The distance between the users"},
+		},
+	},
+	{
+		Name:        "messageUnsupported",
+		Class:       "MessageContent",
+		Description: "Message content that is not supported in the current TDLib version",
+	},
+	{
+		Name:        "messageCopyOptions",
+		Class:       "MessageCopyOptions",
+		Description: "Options to be used when a message content is copied without reference to the original sender. Service messages and messageInvoice can't be copied",
+		Fields: []ParamSchema{
+			{Name: "send_copy", Type: "Bool", Description: "True, if content of the message needs to be copied without reference to the original sender. Always true if the message is forwarded to a secret chat or is local"},
+			{Name: "replace_caption", Type: "Bool", Description: "True, if media caption of the message copy needs to be replaced. Ignored if send_copy is false"},
+			{Name: "new_caption", Type: "formattedText", Description: "New message caption; pass null to copy message without caption. Ignored if replace_caption is false"},
+		},
+	},
+	{
+		Name:        "messageFileTypePrivate",
+		Class:       "MessageFileType",
+		Description: "The messages was exported from a private chat",
+		Fields: []ParamSchema{
+			{Name: "name", Type: "string", Description: "Name of the other party; may be empty if unrecognized"},
+		},
+	},
+	{
+		Name:        "messageFileTypeGroup",
+		Class:       "MessageFileType",
+		Description: "The messages was exported from a group chat",
+		Fields: []ParamSchema{
+			{Name: "title", Type: "string", Description: "Title of the group chat; may be empty if unrecognized"},
+		},
+	},
+	{
+		Name:        "messageFileTypeUnknown",
+		Class:       "MessageFileType",
+		Description: "The messages was exported from a chat of unknown type",
+	},
+	{
+		Name:        "messageForwardInfo",
+		Class:       "MessageForwardInfo",
+		Description: "Contains information about a forwarded message",
+		Fields: []ParamSchema{
+			{Name: "origin", Type: "MessageForwardOrigin", Description: "Origin of a forwarded message"},
+			{Name: "date", Type: "int32", Description: "Point in time (Unix timestamp) when the message was originally sent"},
+			{Name: "public_service_announcement_type", Type: "string", Description: "The type of a public service announcement for the forwarded message"},
+			{Name: "from_chat_id", Type: "int53", Description: "For messages forwarded to the chat with the current user (Saved Messages), to the Replies bot chat, or to the channel's discussion group, the identifier of the chat from which the message was forwarded last time; 0 if unknown"},
+			{Name: "from_message_id", Type: "int53", Description: "For messages forwarded to the chat with the current user (Saved Messages), to the Replies bot chat, or to the channel's discussion group, the identifier of the original message from which the new message was forwarded last time; 0 if unknown"},
+		},
+	},
+	{
+		Name:        "messageForwardOriginUser",
+		Class:       "MessageForwardOrigin",
+		Description: "The message was originally sent by a known user",
+		Fields: []ParamSchema{
+			{Name: "sender_user_id", Type: "int53", Description: "Identifier of the user that originally sent the message"},
+		},
+	},
+	{
+		Name:        "messageForwardOriginChat",
+		Class:       "MessageForwardOrigin",
+		Description: "The message was originally sent on behalf of a chat",
+		Fields: []ParamSchema{
+			{Name: "sender_chat_id", Type: "int53", Description: "Identifier of the chat that originally sent the message"},
+			{Name: "author_signature", Type: "string", Description: "For messages originally sent by an anonymous chat administrator, original message author signature"},
+		},
+	},
+	{
+		Name:        "messageForwardOriginHiddenUser",
+		Class:       "MessageForwardOrigin",
+		Description: "The message was originally sent by a user, which is hidden by their privacy settings",
+		Fields: []ParamSchema{
+			{Name: "sender_name", Type: "string", Description: "Name of the sender"},
+		},
+	},
+	{
+		Name:        "messageForwardOriginChannel",
+		Class:       "MessageForwardOrigin",
+		Description: "The message was originally a post in a channel",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat from which the message was originally forwarded"},
+			{Name: "message_id", Type: "int53", Description: "Message identifier of the original message"},
+			{Name: "author_signature", Type: "string", Description: "Original post author signature"},
+		},
+	},
+	{
+		Name:        "messageForwardOriginMessageImport",
+		Class:       "MessageForwardOrigin",
+		Description: "The message was imported from an exported message history",
+		Fields: []ParamSchema{
+			{Name: "sender_name", Type: "string", Description: "Name of the sender"},
+		},
+	},
+	{
+		Name:        "messageInteractionInfo",
+		Class:       "MessageInteractionInfo",
+		Description: "Contains information about interactions with a message",
+		Fields: []ParamSchema{
+			{Name: "view_count", Type: "int32", Description: "Number of times the message was viewed"},
+			{Name: "forward_count", Type: "int32", Description: "Number of times the message was forwarded"},
+			{Name: "reply_info", Type: "messageReplyInfo", Description: "Information about direct or indirect replies to the message; may be null. Currently, available only in channels with a discussion supergroup and discussion supergroups for messages, which are not replies itself"},
+		},
+	},
+	{
+		Name:        "messageLink",
+		Class:       "MessageLink",
+		Description: "Contains an HTTPS link to a message in a supergroup or channel",
+		Fields: []ParamSchema{
+			{Name: "link", Type: "string", Description: "Message link"},
+			{Name: "is_public", Type: "Bool", Description: "True, if the link will work for non-members of the chat"},
+		},
+	},
+	{
+		Name:        "messageLinkInfo",
+		Class:       "MessageLinkInfo",
+		Description: "Contains information about a link to a message in a chat",
+		Fields: []ParamSchema{
+			{Name: "is_public", Type: "Bool", Description: "True, if the link is a public link for a message in a chat"},
+			{Name: "chat_id", Type: "int53", Description: "If found, identifier of the chat to which the message belongs, 0 otherwise"},
+			{Name: "message", Type: "message", Description: "If found, the linked message; may be null"},
+			{Name: "media_timestamp", Type: "int32", Description: "Timestamp from which the video/audio/video note/voice note playing must start, in seconds; 0 if not specified. The media can be in the message content or in its web page preview"},
+			{Name: "for_album", Type: "Bool", Description: "True, if the whole media album to which the message belongs is linked"},
+			{Name: "for_comment", Type: "Bool", Description: "True, if the message is linked as a channel post comment or from a message thread"},
+		},
+	},
+	{
+		Name:        "messagePosition",
+		Class:       "MessagePosition",
+		Description: "Contains information about a message in a specific position",
+		Fields: []ParamSchema{
+			{Name: "position", Type: "int32", Description: "0-based message position in the full list of suitable messages"},
+			{Name: "message_id", Type: "int53", Description: "Message identifier"},
+			{Name: "date", Type: "int32", Description: "Point in time (Unix timestamp) when the message was sent"},
+		},
+	},
+	{
+		Name:        "messagePositions",
+		Class:       "MessagePositions",
+		Description: "Contains a list of message positions",
+		Fields: []ParamSchema{
+			{Name: "total_count", Type: "int32", Description: "Total count of messages found"},
+			{Name: "positions", Type: "vector<messagePosition>", Description: "List of message positions"},
+		},
+	},
+	{
+		Name:        "messageReplyInfo",
+		Class:       "MessageReplyInfo",
+		Description: "Contains information about replies to a message",
+		Fields: []ParamSchema{
+			{Name: "reply_count", Type: "int32", Description: "Number of times the message was directly or indirectly replied"},
+			{Name: "recent_replier_ids", Type: "vector<MessageSender>", Description: "Identifiers of at most 3 recent repliers to the message; available in channels with a discussion supergroup. The users and chats are expected to be inaccessible: only their photo and name will be available"},
+			{Name: "last_read_inbox_message_id", Type: "int53", Description: "Identifier of the last read incoming reply to the message"},
+			{Name: "last_read_outbox_message_id", Type: "int53", Description: "Identifier of the last read outgoing reply to the message"},
+			{Name: "last_message_id", Type: "int53", Description: "Identifier of the last reply to the message"},
+		},
+	},
+	{
+		Name:        "messageSchedulingStateSendAtDate",
+		Class:       "MessageSchedulingState",
+		Description: "The message will be sent at the specified date",
+		Fields: []ParamSchema{
+			{Name: "send_date", Type: "int32", Description: "Date the message will be sent. The date must be within 367 days in the future"},
+		},
+	},
+	{
+		Name:        "messageSchedulingStateSendWhenOnline",
+		Class:       "MessageSchedulingState",
+		Description: "The message will be sent when the peer will be online. Applicable to private chats only and when the exact online status of the peer is known",
+	},
+	{
+		Name:        "messageSendOptions",
+		Class:       "MessageSendOptions",
+		Description: "Options to be used when a message is sent",
+		Fields: []ParamSchema{
+			{Name: "disable_notification", Type: "Bool", Description: "Pass true to disable notification for the message"},
+			{Name: "from_background", Type: "Bool", Description: "Pass true if the message is sent from the background"},
+			{Name: "protect_content", Type: "Bool", Description: "Pass true if the content of the message must be protected from forwarding and saving; for bots only"},
+			{Name: "scheduling_state", Type: "MessageSchedulingState", Description: "Message scheduling state; pass null to send message immediately. Messages sent to a secret chat, live location messages and self-destructing messages can't be scheduled"},
+		},
+	},
+	{
+		Name:        "messageSenderUser",
+		Class:       "MessageSender",
+		Description: "The message was sent by a known user",
+		Fields: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "Identifier of the user that sent the message"},
+		},
+	},
+	{
+		Name:        "messageSenderChat",
+		Class:       "MessageSender",
+		Description: "The message was sent on behalf of a chat",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat that sent the message"},
+		},
+	},
+	{
+		Name:        "messageSenders",
+		Class:       "MessageSenders",
+		Description: "Represents a list of message senders",
+		Fields: []ParamSchema{
+			{Name: "total_count", Type: "int32", Description: "Approximate total count of messages senders found"},
+			{Name: "senders", Type: "vector<MessageSender>", Description: "List of message senders"},
+		},
+	},
+	{
+		Name:        "messageSendingStatePending",
+		Class:       "MessageSendingState",
+		Description: "The message is being sent now, but has not yet been delivered to the server",
+	},
+	{
+		Name:        "messageSendingStateFailed",
+		Class:       "MessageSendingState",
+		Description: "The message failed to be sent",
+		Fields: []ParamSchema{
+			{Name: "error_code", Type: "int32", Description: "An error code; 0 if unknown"},
+			{Name: "error_message", Type: "string", Description: "Error message"},
+			{Name: "can_retry", Type: "Bool", Description: "True, if the message can be re-sent"},
+			{Name: "need_another_sender", Type: "Bool", Description: "True, if the message can be re-sent only on behalf of a different sender"},
+			{Name: "retry_after", Type: "double", Description: "Time left before the message can be re-sent, in seconds. No update is sent when this field changes"},
+		},
+	},
+	{
+		Name:        "messageStatistics",
+		Class:       "MessageStatistics",
+		Description: "A detailed statistics about a message",
+		Fields: []ParamSchema{
+			{Name: "message_interaction_graph", Type: "StatisticalGraph", Description: "A graph containing number of message views and shares"},
+		},
+	},
+	{
+		Name:        "messageThreadInfo",
+		Class:       "MessageThreadInfo",
+		Description: "Contains information about a message thread",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat to which the message thread belongs"},
+			{Name: "message_thread_id", Type: "int53", Description: "Message thread identifier, unique within the chat"},
+			{Name: "reply_info", Type: "messageReplyInfo", Description: "Information about the message thread"},
+			{Name: "unread_message_count", Type: "int32", Description: "Approximate number of unread messages in the message thread"},
+			{Name: "messages", Type: "vector<message>", Description: "The messages from which the thread starts. The messages are returned in a reverse chronological order (i.e., in order of decreasing message_id)"},
+			{Name: "draft_message", Type: "draftMessage", Description: "A draft of a message in the message thread; may be null"},
+		},
+	},
+	{
+		Name:        "messages",
+		Class:       "Messages",
+		Description: "Contains a list of messages",
+		Fields: []ParamSchema{
+			{Name: "total_count", Type: "int32", Description: "Approximate total count of messages found"},
+			{Name: "messages", Type: "vector<message>", Description: "List of messages; messages may be null"},
+		},
+	},
+	{
+		Name:        "minithumbnail",
+		Class:       "Minithumbnail",
+		Description: "Thumbnail image of a very poor quality and low resolution",
+		Fields: []ParamSchema{
+			{Name: "width", Type: "int32", Description: "Thumbnail width, usually doesn't exceed 40"},
+			{Name: "height", Type: "int32", Description: "Thumbnail height, usually doesn't exceed 40"},
+			{Name: "data", Type: "bytes", Description: "The thumbnail in JPEG format"},
+		},
+	},
+	{
+		Name:        "networkStatistics",
+		Class:       "NetworkStatistics",
+		Description: "A full list of available network statistic entries",
+		Fields: []ParamSchema{
+			{Name: "since_date", Type: "int32", Description: "Point in time (Unix timestamp) from which the statistics are collected"},
+			{Name: "entries", Type: "vector<NetworkStatisticsEntry>", Description: "Network statistics entries"},
+		},
+	},
+	{
+		Name:        "networkStatisticsEntryFile",
+		Class:       "NetworkStatisticsEntry",
+		Description: "Contains information about the total amount of data that was used to send and receive files",
+		Fields: []ParamSchema{
+			{Name: "file_type", Type: "FileType", Description: "Type of the file the data is part of; pass null if the data isn't related to files"},
+			{Name: "network_type", Type: "NetworkType", Description: "Type of the network the data was sent through. Call setNetworkType to maintain the actual network type"},
+			{Name: "sent_bytes", Type: "int53", Description: "Total number of bytes sent"},
+			{Name: "received_bytes", Type: "int53", Description: "Total number of bytes received"},
+		},
+	},
+	{
+		Name:        "networkStatisticsEntryCall",
+		Class:       "NetworkStatisticsEntry",
+		Description: "Contains information about the total amount of data that was used for calls",
+		Fields: []ParamSchema{
+			{Name: "network_type", Type: "NetworkType", Description: "Type of the network the data was sent through. Call setNetworkType to maintain the actual network type"},
+			{Name: "sent_bytes", Type: "int53", Description: "Total number of bytes sent"},
+			{Name: "received_bytes", Type: "int53", Description: "Total number of bytes received"},
+			{Name: "duration", Type: "double", Description: "Total call duration, in seconds"},
+		},
+	},
+	{
+		Name:        "networkTypeNone",
+		Class:       "NetworkType",
+		Description: "The network is not available",
+	},
+	{
+		Name:        "networkTypeMobile",
+		Class:       "NetworkType",
+		Description: "A mobile network",
+	},
+	{
+		Name:        "networkTypeMobileRoaming",
+		Class:       "NetworkType",
+		Description: "A mobile roaming network",
+	},
+	{
+		Name:        "networkTypeWiFi",
+		Class:       "NetworkType",
+		Description: "A Wi-Fi network",
+	},
+	{
+		Name:        "networkTypeOther",
+		Class:       "NetworkType",
+		Description: "A different network type (e.g., Ethernet network)",
+	},
+	{
+		Name:        "notification",
+		Class:       "Notification",
+		Description: "Contains information about a notification",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int32", Description: "Unique persistent identifier of this notification"},
+			{Name: "date", Type: "int32", Description: "Notification date"},
+			{Name: "is_silent", Type: "Bool", Description: "True, if the notification was initially silent"},
+			{Name: "type", Type: "NotificationType", Description: "Notification type"},
+		},
+	},
+	{
+		Name:        "notificationGroup",
+		Class:       "NotificationGroup",
+		Description: "Describes a group of notifications",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int32", Description: "Unique persistent auto-incremented from 1 identifier of the notification group"},
+			{Name: "type", Type: "NotificationGroupType", Description: "Type of the group"},
+			{Name: "chat_id", Type: "int53", Description: "Identifier of a chat to which all notifications in the group belong"},
+			{Name: "total_count", Type: "int32", Description: "Total number of active notifications in the group"},
+			{Name: "notifications", Type: "vector<notification>", Description: "The list of active notifications"},
+		},
+	},
+	{
+		Name:        "notificationGroupTypeMessages",
+		Class:       "NotificationGroupType",
+		Description: "A group containing notifications of type notificationTypeNewMessage and notificationTypeNewPushMessage with ordinary unread messages",
+	},
+	{
+		Name:        "notificationGroupTypeMentions",
+		Class:       "NotificationGroupType",
+		Description: "A group containing notifications of type notificationTypeNewMessage and notificationTypeNewPushMessage with unread mentions of the current user, replies to their messages, or a pinned message",
+	},
+	{
+		Name:        "notificationGroupTypeSecretChat",
+		Class:       "NotificationGroupType",
+		Description: "A group containing a notification of type notificationTypeNewSecretChat",
+	},
+	{
+		Name:        "notificationGroupTypeCalls",
+		Class:       "NotificationGroupType",
+		Description: "A group containing notifications of type notificationTypeNewCall",
+	},
+	{
+		Name:        "notificationSettingsScopePrivateChats",
+		Class:       "NotificationSettingsScope",
+		Description: "Notification settings applied to all private and secret chats when the corresponding chat setting has a default value",
+	},
+	{
+		Name:        "notificationSettingsScopeGroupChats",
+		Class:       "NotificationSettingsScope",
+		Description: "Notification settings applied to all basic groups and supergroups when the corresponding chat setting has a default value",
+	},
+	{
+		Name:        "notificationSettingsScopeChannelChats",
+		Class:       "NotificationSettingsScope",
+		Description: "Notification settings applied to all channels when the corresponding chat setting has a default value",
+	},
+	{
+		Name:        "notificationTypeNewMessage",
+		Class:       "NotificationType",
+		Description: "New message was received",
+		Fields: []ParamSchema{
+			{Name: "message", Type: "message", Description: "The message"},
+		},
+	},
+	{
+		Name:        "notificationTypeNewSecretChat",
+		Class:       "NotificationType",
+		Description: "New secret chat was created",
+	},
+	{
+		Name:        "notificationTypeNewCall",
+		Class:       "NotificationType",
+		Description: "New call was received",
+		Fields: []ParamSchema{
+			{Name: "call_id", Type: "int32", Description: "Call identifier"},
+		},
+	},
+	{
+		Name:        "notificationTypeNewPushMessage",
+		Class:       "NotificationType",
+		Description: "New message was received through a push notification",
+		Fields: []ParamSchema{
+			{Name: "message_id", Type: "int53", Description: "The message identifier. The message will not be available in the chat history, but the ID can be used in viewMessages, or as reply_to_message_id"},
+			{Name: "sender_id", Type: "MessageSender", Description: "Identifier of the sender of the message. Corresponding user or chat may be inaccessible"},
+			{Name: "sender_name", Type: "string", Description: "Name of the sender"},
+			{Name: "is_outgoing", Type: "Bool", Description: "True, if the message is outgoing"},
+			{Name: "content", Type: "PushMessageContent", Description: "Push message content"},
+		},
+	},
+	{
+		Name:        "ok",
+		Class:       "Ok",
+		Description: "An object of this type is returned on a successful function call for certain functions",
+	},
+	{
+		Name:        "optionValueBoolean",
+		Class:       "OptionValue",
+		Description: "Represents a boolean option",
+		Fields: []ParamSchema{
+			{Name: "value", Type: "Bool", Description: "The value of the option"},
+		},
+	},
+	{
+		Name:        "optionValueEmpty",
+		Class:       "OptionValue",
+		Description: "Represents an unknown option or an option which has a default value",
+	},
+	{
+		Name:        "optionValueInteger",
+		Class:       "OptionValue",
+		Description: "Represents an integer option",
+		Fields: []ParamSchema{
+			{Name: "value", Type: "int64", Description: "The value of the option"},
+		},
+	},
+	{
+		Name:        "optionValueString",
+		Class:       "OptionValue",
+		Description: "Represents a string option",
+		Fields: []ParamSchema{
+			{Name: "value", Type: "string", Description: "The value of the option"},
+		},
+	},
+	{
+		Name:        "orderInfo",
+		Class:       "OrderInfo",
+		Description: "Order information",
+		Fields: []ParamSchema{
+			{Name: "name", Type: "string", Description: "Name of the user"},
+			{Name: "phone_number", Type: "string", Description: "Phone number of the user"},
+			{Name: "email_address", Type: "string", Description: "Email address of the user"},
+			{Name: "shipping_address", Type: "address", Description: "Shipping address for this order; may be null"},
+		},
+	},
+	{
+		Name:        "pageBlockTitle",
+		Class:       "PageBlock",
+		Description: "The title of a page",
+		Fields: []ParamSchema{
+			{Name: "title", Type: "RichText", Description: "Title"},
+		},
+	},
+	{
+		Name:        "pageBlockSubtitle",
+		Class:       "PageBlock",
+		Description: "The subtitle of a page",
+		Fields: []ParamSchema{
+			{Name: "subtitle", Type: "RichText", Description: "Subtitle"},
+		},
+	},
+	{
+		Name:        "pageBlockAuthorDate",
+		Class:       "PageBlock",
+		Description: "The author and publishing date of a page",
+		Fields: []ParamSchema{
+			{Name: "author", Type: "RichText", Description: "Author"},
+			{Name: "publish_date", Type: "int32", Description: "Point in time (Unix timestamp) when the article was published; 0 if unknown"},
+		},
+	},
+	{
+		Name:        "pageBlockHeader",
+		Class:       "PageBlock",
+		Description: "A header",
+		Fields: []ParamSchema{
+			{Name: "header", Type: "RichText", Description: "Header"},
+		},
+	},
+	{
+		Name:        "pageBlockSubheader",
+		Class:       "PageBlock",
+		Description: "A subheader",
+		Fields: []ParamSchema{
+			{Name: "subheader", Type: "RichText", Description: "Subheader"},
+		},
+	},
+	{
+		Name:        "pageBlockKicker",
+		Class:       "PageBlock",
+		Description: "A kicker",
+		Fields: []ParamSchema{
+			{Name: "kicker", Type: "RichText", Description: "Kicker"},
+		},
+	},
+	{
+		Name:        "pageBlockParagraph",
+		Class:       "PageBlock",
+		Description: "A text paragraph",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "RichText", Description: "Paragraph text"},
+		},
+	},
+	{
+		Name:        "pageBlockPreformatted",
+		Class:       "PageBlock",
+		Description: "A preformatted text paragraph",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "RichText", Description: "Paragraph text"},
+			{Name: "language", Type: "string", Description: "Programming language for which the text needs to be formatted"},
+		},
+	},
+	{
+		Name:        "pageBlockFooter",
+		Class:       "PageBlock",
+		Description: "The footer of a page",
+		Fields: []ParamSchema{
+			{Name: "footer", Type: "RichText", Description: "Footer"},
+		},
+	},
+	{
+		Name:        "pageBlockDivider",
+		Class:       "PageBlock",
+		Description: "An empty block separating a page",
+	},
+	{
+		Name:        "pageBlockAnchor",
+		Class:       "PageBlock",
+		Description: "An invisible anchor on a page, which can be used in a URL to open the page from the specified anchor",
+		Fields: []ParamSchema{
+			{Name: "name", Type: "string", Description: "Name of the anchor"},
+		},
+	},
+	{
+		Name:        "pageBlockList",
+		Class:       "PageBlock",
+		Description: "A list of data blocks",
+		Fields: []ParamSchema{
+			{Name: "items", Type: "vector<pageBlockListItem>", Description: "The items of the list"},
+		},
+	},
+	{
+		Name:        "pageBlockBlockQuote",
+		Class:       "PageBlock",
+		Description: "A block quote",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "RichText", Description: "Quote text"},
+			{Name: "credit", Type: "RichText", Description: "Quote credit"},
+		},
+	},
+	{
+		Name:        "pageBlockPullQuote",
+		Class:       "PageBlock",
+		Description: "A pull quote",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "RichText", Description: "Quote text"},
+			{Name: "credit", Type: "RichText", Description: "Quote credit"},
+		},
+	},
+	{
+		Name:        "pageBlockAnimation",
+		Class:       "PageBlock",
+		Description: "An animation",
+		Fields: []ParamSchema{
+			{Name: "animation", Type: "animation", Description: "Animation file; may be null"},
+			{Name: "caption", Type: "pageBlockCaption", Description: "Animation caption"},
+			{Name: "need_autoplay", Type: "Bool", Description: "True, if the animation must be played automatically"},
+		},
+	},
+	{
+		Name:        "pageBlockAudio",
+		Class:       "PageBlock",
+		Description: "An audio file",
+		Fields: []ParamSchema{
+			{Name: "audio", Type: "audio", Description: "Audio file; may be null"},
+			{Name: "caption", Type: "pageBlockCaption", Description: "Audio file caption"},
+		},
+	},
+	{
+		Name:        "pageBlockPhoto",
+		Class:       "PageBlock",
+		Description: "A photo",
+		Fields: []ParamSchema{
+			{Name: "photo", Type: "photo", Description: "Photo file; may be null"},
+			{Name: "caption", Type: "pageBlockCaption", Description: "Photo caption"},
+			{Name: "url", Type: "string", Description: "URL that needs to be opened when the photo is clicked"},
+		},
+	},
+	{
+		Name:        "pageBlockVideo",
+		Class:       "PageBlock",
+		Description: "A video",
+		Fields: []ParamSchema{
+			{Name: "video", Type: "video", Description: "Video file; may be null"},
+			{Name: "caption", Type: "pageBlockCaption", Description: "Video caption"},
+			{Name: "need_autoplay", Type: "Bool", Description: "True, if the video must be played automatically"},
+			{Name: "is_looped", Type: "Bool", Description: "True, if the video must be looped"},
+		},
+	},
+	{
+		Name:        "pageBlockVoiceNote",
+		Class:       "PageBlock",
+		Description: "A voice note",
+		Fields: []ParamSchema{
+			{Name: "voice_note", Type: "voiceNote", Description: "Voice note; may be null"},
+			{Name: "caption", Type: "pageBlockCaption", Description: "Voice note caption"},
+		},
+	},
+	{
+		Name:        "pageBlockCover",
+		Class:       "PageBlock",
+		Description: "A page cover",
+		Fields: []ParamSchema{
+			{Name: "cover", Type: "PageBlock", Description: "Cover"},
+		},
+	},
+	{
+		Name:        "pageBlockEmbedded",
+		Class:       "PageBlock",
+		Description: "An embedded web page",
+		Fields: []ParamSchema{
+			{Name: "url", Type: "string", Description: "Web page URL, if available"},
+			{Name: "html", Type: "string", Description: "HTML-markup of the embedded page"},
+			{Name: "poster_photo", Type: "photo", Description: "Poster photo, if available; may be null"},
+			{Name: "width", Type: "int32", Description: "Block width; 0 if unknown"},
+			{Name: "height", Type: "int32", Description: "Block height; 0 if unknown"},
+			{Name: "caption", Type: "pageBlockCaption", Description: "Block caption"},
+			{Name: "is_full_width", Type: "Bool", Description: "True, if the block must be full width"},
+			{Name: "allow_scrolling", Type: "Bool", Description: "True, if scrolling needs to be allowed"},
+		},
+	},
+	{
+		Name:        "pageBlockEmbeddedPost",
+		Class:       "PageBlock",
+		Description: "An embedded post",
+		Fields: []ParamSchema{
+			{Name: "url", Type: "string", Description: "Web page URL"},
+			{Name: "author", Type: "string", Description: "Post author"},
+			{Name: "author_photo", Type: "photo", Description: "Post author photo; may be null"},
+			{Name: "date", Type: "int32", Description: "Point in time (Unix timestamp) when the post was created; 0 if unknown"},
+			{Name: "page_blocks", Type: "vector<PageBlock>", Description: "Post content"},
+			{Name: "caption", Type: "pageBlockCaption", Description: "Post caption"},
+		},
+	},
+	{
+		Name:        "pageBlockCollage",
+		Class:       "PageBlock",
+		Description: "A collage",
+		Fields: []ParamSchema{
+			{Name: "page_blocks", Type: "vector<PageBlock>", Description: "Collage item contents"},
+			{Name: "caption", Type: "pageBlockCaption", Description: "Block caption"},
+		},
+	},
+	{
+		Name:        "pageBlockSlideshow",
+		Class:       "PageBlock",
+		Description: "A slideshow",
+		Fields: []ParamSchema{
+			{Name: "page_blocks", Type: "vector<PageBlock>", Description: "Slideshow item contents"},
+			{Name: "caption", Type: "pageBlockCaption", Description: "Block caption"},
+		},
+	},
+	{
+		Name:        "pageBlockChatLink",
+		Class:       "PageBlock",
+		Description: "A link to a chat",
+		Fields: []ParamSchema{
+			{Name: "title", Type: "string", Description: "Chat title"},
+			{Name: "photo", Type: "chatPhotoInfo", Description: "Chat photo; may be null"},
+			{Name: "username", Type: "string", Description: "Chat username, by which all other information about the chat can be resolved"},
+		},
+	},
+	{
+		Name:        "pageBlockTable",
+		Class:       "PageBlock",
+		Description: "A table",
+		Fields: []ParamSchema{
+			{Name: "caption", Type: "RichText", Description: "Table caption"},
+			{Name: "cells", Type: "vector<vector<pageBlockTableCell>>", Description: "Table cells"},
+			{Name: "is_bordered", Type: "Bool", Description: "True, if the table is bordered"},
+			{Name: "is_striped", Type: "Bool", Description: "True, if the table is striped"},
+		},
+	},
+	{
+		Name:        "pageBlockDetails",
+		Class:       "PageBlock",
+		Description: "A collapsible block",
+		Fields: []ParamSchema{
+			{Name: "header", Type: "RichText", Description: "Always visible heading for the block"},
+			{Name: "page_blocks", Type: "vector<PageBlock>", Description: "Block contents"},
+			{Name: "is_open", Type: "Bool", Description: "True, if the block is open by default"},
+		},
+	},
+	{
+		Name:        "pageBlockRelatedArticles",
+		Class:       "PageBlock",
+		Description: "Related articles",
+		Fields: []ParamSchema{
+			{Name: "header", Type: "RichText", Description: "Block header"},
+			{Name: "articles", Type: "vector<pageBlockRelatedArticle>", Description: "List of related articles"},
+		},
+	},
+	{
+		Name:        "pageBlockMap",
+		Class:       "PageBlock",
+		Description: "A map",
+		Fields: []ParamSchema{
+			{Name: "location", Type: "location", Description: "Location of the map center"},
+			{Name: "zoom", Type: "int32", Description: "Map zoom level"},
+			{Name: "width", Type: "int32", Description: "Map width"},
+			{Name: "height", Type: "int32", Description: "Map height"},
+			{Name: "caption", Type: "pageBlockCaption", Description: "Block caption"},
+		},
+	},
+	{
+		Name:        "pageBlockCaption",
+		Class:       "PageBlockCaption",
+		Description: "Contains a caption of an instant view web page block, consisting of a text and a trailing credit",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "RichText", Description: "Content of the caption"},
+			{Name: "credit", Type: "RichText", Description: "Block credit (like HTML tag <cite>)"},
+		},
+	},
+	{
+		Name:        "pageBlockHorizontalAlignmentLeft",
+		Class:       "PageBlockHorizontalAlignment",
+		Description: "The content must be left-aligned",
+	},
+	{
+		Name:        "pageBlockHorizontalAlignmentCenter",
+		Class:       "PageBlockHorizontalAlignment",
+		Description: "The content must be center-aligned",
+	},
+	{
+		Name:        "pageBlockHorizontalAlignmentRight",
+		Class:       "PageBlockHorizontalAlignment",
+		Description: "The content must be right-aligned",
+	},
+	{
+		Name:        "pageBlockListItem",
+		Class:       "PageBlockListItem",
+		Description: "Describes an item of a list page block",
+		Fields: []ParamSchema{
+			{Name: "label", Type: "string", Description: "Item label"},
+			{Name: "page_blocks", Type: "vector<PageBlock>", Description: "Item blocks"},
+		},
+	},
+	{
+		Name:        "pageBlockRelatedArticle",
+		Class:       "PageBlockRelatedArticle",
+		Description: "Contains information about a related article",
+		Fields: []ParamSchema{
+			{Name: "url", Type: "string", Description: "Related article URL"},
+			{Name: "title", Type: "string", Description: "Article title; may be empty"},
+			{Name: "description", Type: "string", Description: "Article description; may be empty"},
+			{Name: "photo", Type: "photo", Description: "Article photo; may be null"},
+			{Name: "author", Type: "string", Description: "Article author; may be empty"},
+			{Name: "publish_date", Type: "int32", Description: "Point in time (Unix timestamp) when the article was published; 0 if unknown"},
+		},
+	},
+	{
+		Name:        "pageBlockTableCell",
+		Class:       "PageBlockTableCell",
+		Description: "Represents a cell of a table",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "RichText", Description: "Cell text; may be null. If the text is null, then the cell must be invisible"},
+			{Name: "is_header", Type: "Bool", Description: "True, if it is a header cell"},
+			{Name: "colspan", Type: "int32", Description: "The number of columns the cell spans"},
+			{Name: "rowspan", Type: "int32", Description: "The number of rows the cell spans"},
+			{Name: "align", Type: "PageBlockHorizontalAlignment", Description: "Horizontal cell content alignment"},
+			{Name: "valign", Type: "PageBlockVerticalAlignment", Description: "Vertical cell content alignment"},
+		},
+	},
+	{
+		Name:        "pageBlockVerticalAlignmentTop",
+		Class:       "PageBlockVerticalAlignment",
+		Description: "The content must be top-aligned",
+	},
+	{
+		Name:        "pageBlockVerticalAlignmentMiddle",
+		Class:       "PageBlockVerticalAlignment",
+		Description: "The content must be middle-aligned",
+	},
+	{
+		Name:        "pageBlockVerticalAlignmentBottom",
+		Class:       "PageBlockVerticalAlignment",
+		Description: "The content must be bottom-aligned",
+	},
+	{
+		Name:        "passportAuthorizationForm",
+		Class:       "PassportAuthorizationForm",
+		Description: "Contains information about a Telegram Passport authorization form that was requested",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int32", Description: "Unique identifier of the authorization form"},
+			{Name: "required_elements", Type: "vector<passportRequiredElement>", Description: "Telegram Passport elements that must be provided to complete the form"},
+			{Name: "privacy_policy_url", Type: "string", Description: "URL for the privacy policy of the service; may be empty"},
+		},
+	},
+	{
+		Name:        "passportElementPersonalDetails",
+		Class:       "PassportElement",
+		Description: "A Telegram Passport element containing the user's personal details",
+		Fields: []ParamSchema{
+			{Name: "personal_details", Type: "personalDetails", Description: "Personal details of the user"},
+		},
+	},
+	{
+		Name:        "passportElementPassport",
+		Class:       "PassportElement",
+		Description: "A Telegram Passport element containing the user's passport",
+		Fields: []ParamSchema{
+			{Name: "passport", Type: "identityDocument", Description: "Passport"},
+		},
+	},
+	{
+		Name:        "passportElementDriverLicense",
+		Class:       "PassportElement",
+		Description: "A Telegram Passport element containing the user's driver license",
+		Fields: []ParamSchema{
+			{Name: "driver_license", Type: "identityDocument", Description: "Driver license"},
+		},
+	},
+	{
+		Name:        "passportElementIdentityCard",
+		Class:       "PassportElement",
+		Description: "A Telegram Passport element containing the user's identity card",
+		Fields: []ParamSchema{
+			{Name: "identity_card", Type: "identityDocument", Description: "Identity card"},
+		},
+	},
+	{
+		Name:        "passportElementInternalPassport",
+		Class:       "PassportElement",
+		Description: "A Telegram Passport element containing the user's internal passport",
+		Fields: []ParamSchema{
+			{Name: "internal_passport", Type: "identityDocument", Description: "Internal passport"},
+		},
+	},
+	{
+		Name:        "passportElementAddress",
+		Class:       "PassportElement",
+		Description: "A Telegram Passport element containing the user's address",
+		Fields: []ParamSchema{
+			{Name: "address", Type: "address", Description: "Address"},
+		},
+	},
+	{
+		Name:        "passportElementUtilityBill",
+		Class:       "PassportElement",
+		Description: "A Telegram Passport element containing the user's utility bill",
+		Fields: []ParamSchema{
+			{Name: "utility_bill", Type: "personalDocument", Description: "Utility bill"},
+		},
+	},
+	{
+		Name:        "passportElementBankStatement",
+		Class:       "PassportElement",
+		Description: "A Telegram Passport element containing the user's bank statement",
+		Fields: []ParamSchema{
+			{Name: "bank_statement", Type: "personalDocument", Description: "Bank statement"},
+		},
+	},
+	{
+		Name:        "passportElementRentalAgreement",
+		Class:       "PassportElement",
+		Description: "A Telegram Passport element containing the user's rental agreement",
+		Fields: []ParamSchema{
+			{Name: "rental_agreement", Type: "personalDocument", Description: "Rental agreement"},
+		},
+	},
+	{
+		Name:        "passportElementPassportRegistration",
+		Class:       "PassportElement",
+		Description: "A Telegram Passport element containing the user's passport registration pages",
+		Fields: []ParamSchema{
+			{Name: "passport_registration", Type: "personalDocument", Description: "Passport registration pages"},
+		},
+	},
+	{
+		Name:        "passportElementTemporaryRegistration",
+		Class:       "PassportElement",
+		Description: "A Telegram Passport element containing the user's temporary registration",
+		Fields: []ParamSchema{
+			{Name: "temporary_registration", Type: "personalDocument", Description: "Temporary registration"},
+		},
+	},
+	{
+		Name:        "passportElementPhoneNumber",
+		Class:       "PassportElement",
+		Description: "A Telegram Passport element containing the user's phone number",
+		Fields: []ParamSchema{
+			{Name: "phone_number", Type: "string", Description: "Phone number"},
+		},
+	},
+	{
+		Name:        "passportElementEmailAddress",
+		Class:       "PassportElement",
+		Description: "A Telegram Passport element containing the user's email address",
+		Fields: []ParamSchema{
+			{Name: "email_address", Type: "string", Description: "Email address"},
+		},
+	},
+	{
+		Name:        "passportElementError",
+		Class:       "PassportElementError",
+		Description: "Contains the description of an error in a Telegram Passport element",
+		Fields: []ParamSchema{
+			{Name: "type", Type: "PassportElementType", Description: "Type of the Telegram Passport element which has the error"},
+			{Name: "message", Type: "string", Description: "Error message"},
+			{Name: "source", Type: "PassportElementErrorSource", Description: "Error source"},
+		},
+	},
+	{
+		Name:        "passportElementErrorSourceUnspecified",
+		Class:       "PassportElementErrorSource",
+		Description: "The element contains an error in an unspecified place. The error will be considered resolved when new data is added",
+	},
+	{
+		Name:        "passportElementErrorSourceDataField",
+		Class:       "PassportElementErrorSource",
+		Description: "One of the data fields contains an error. The error will be considered resolved when the value of the field changes",
+		Fields: []ParamSchema{
+			{Name: "field_name", Type: "string", Description: "Field name"},
+		},
+	},
+	{
+		Name:        "passportElementErrorSourceFrontSide",
+		Class:       "PassportElementErrorSource",
+		Description: "The front side of the document contains an error. The error will be considered resolved when the file with the front side changes",
+	},
+	{
+		Name:        "passportElementErrorSourceReverseSide",
+		Class:       "PassportElementErrorSource",
+		Description: "The reverse side of the document contains an error. The error will be considered resolved when the file with the reverse side changes",
+	},
+	{
+		Name:        "passportElementErrorSourceSelfie",
+		Class:       "PassportElementErrorSource",
+		Description: "The selfie with the document contains an error. The error will be considered resolved when the file with the selfie changes",
+	},
+	{
+		Name:        "passportElementErrorSourceTranslationFile",
+		Class:       "PassportElementErrorSource",
+		Description: "One of files with the translation of the document contains an error. The error will be considered resolved when the file changes",
+		Fields: []ParamSchema{
+			{Name: "file_index", Type: "int32", Description: "Index of a file with the error"},
+		},
+	},
+	{
+		Name:        "passportElementErrorSourceTranslationFiles",
+		Class:       "PassportElementErrorSource",
+		Description: "The translation of the document contains an error. The error will be considered resolved when the list of translation files changes",
+	},
+	{
+		Name:        "passportElementErrorSourceFile",
+		Class:       "PassportElementErrorSource",
+		Description: "The file contains an error. The error will be considered resolved when the file changes",
+		Fields: []ParamSchema{
+			{Name: "file_index", Type: "int32", Description: "Index of a file with the error"},
+		},
+	},
+	{
+		Name:        "passportElementErrorSourceFiles",
+		Class:       "PassportElementErrorSource",
+		Description: "The list of attached files contains an error. The error will be considered resolved when the list of files changes",
+	},
+	{
+		Name:        "passportElementTypePersonalDetails",
+		Class:       "PassportElementType",
+		Description: "A Telegram Passport element containing the user's personal details",
+	},
+	{
+		Name:        "passportElementTypePassport",
+		Class:       "PassportElementType",
+		Description: "A Telegram Passport element containing the user's passport",
+	},
+	{
+		Name:        "passportElementTypeDriverLicense",
+		Class:       "PassportElementType",
+		Description: "A Telegram Passport element containing the user's driver license",
+	},
+	{
+		Name:        "passportElementTypeIdentityCard",
+		Class:       "PassportElementType",
+		Description: "A Telegram Passport element containing the user's identity card",
+	},
+	{
+		Name:        "passportElementTypeInternalPassport",
+		Class:       "PassportElementType",
+		Description: "A Telegram Passport element containing the user's internal passport",
+	},
+	{
+		Name:        "passportElementTypeAddress",
+		Class:       "PassportElementType",
+		Description: "A Telegram Passport element containing the user's address",
+	},
+	{
+		Name:        "passportElementTypeUtilityBill",
+		Class:       "PassportElementType",
+		Description: "A Telegram Passport element containing the user's utility bill",
+	},
+	{
+		Name:        "passportElementTypeBankStatement",
+		Class:       "PassportElementType",
+		Description: "A Telegram Passport element containing the user's bank statement",
+	},
+	{
+		Name:        "passportElementTypeRentalAgreement",
+		Class:       "PassportElementType",
+		Description: "A Telegram Passport element containing the user's rental agreement",
+	},
+	{
+		Name:        "passportElementTypePassportRegistration",
+		Class:       "PassportElementType",
+		Description: "A Telegram Passport element containing the registration page of the user's passport",
+	},
+	{
+		Name:        "passportElementTypeTemporaryRegistration",
+		Class:       "PassportElementType",
+		Description: "A Telegram Passport element containing the user's temporary registration",
+	},
+	{
+		Name:        "passportElementTypePhoneNumber",
+		Class:       "PassportElementType",
+		Description: "A Telegram Passport element containing the user's phone number",
+	},
+	{
+		Name:        "passportElementTypeEmailAddress",
+		Class:       "PassportElementType",
+		Description: "A Telegram Passport element containing the user's email address",
+	},
+	{
+		Name:        "passportElements",
+		Class:       "PassportElements",
+		Description: "Contains information about saved Telegram Passport elements",
+		Fields: []ParamSchema{
+			{Name: "elements", Type: "vector<PassportElement>", Description: "Telegram Passport elements"},
+		},
+	},
+	{
+		Name:        "passportElementsWithErrors",
+		Class:       "PassportElementsWithErrors",
+		Description: "Contains information about a Telegram Passport elements and corresponding errors",
+		Fields: []ParamSchema{
+			{Name: "elements", Type: "vector<PassportElement>", Description: "Telegram Passport elements"},
+			{Name: "errors", Type: "vector<passportElementError>", Description: "Errors in the elements that are already available"},
+		},
+	},
+	{
+		Name:        "passportRequiredElement",
+		Class:       "PassportRequiredElement",
+		Description: "Contains a description of the required Telegram Passport element that was requested by a service",
+		Fields: []ParamSchema{
+			{Name: "suitable_elements", Type: "vector<passportSuitableElement>", Description: "List of Telegram Passport elements any of which is enough to provide"},
+		},
+	},
+	{
+		Name:        "passportSuitableElement",
+		Class:       "PassportSuitableElement",
+		Description: "Contains information about a Telegram Passport element that was requested by a service",
+		Fields: []ParamSchema{
+			{Name: "type", Type: "PassportElementType", Description: "Type of the element"},
+			{Name: "is_selfie_required", Type: "Bool", Description: "True, if a selfie is required with the identity document"},
+			{Name: "is_translation_required", Type: "Bool", Description: "True, if a certified English translation is required with the document"},
+			{Name: "is_native_name_required", Type: "Bool", Description: "True, if personal details must include the user's name in the language of their country of residence"},
+		},
+	},
+	{
+		Name:        "passwordState",
+		Class:       "PasswordState",
+		Description: "Represents the current state of 2-step verification",
+		Fields: []ParamSchema{
+			{Name: "has_password", Type: "Bool", Description: "True, if a 2-step verification password is set"},
+			{Name: "password_hint", Type: "string", Description: "Hint for the password; may be empty"},
+			{Name: "has_recovery_email_address", Type: "Bool", Description: "True, if a recovery email is set"},
+			{Name: "has_passport_data", Type: "Bool", Description: "True, if some Telegram Passport elements were saved"},
+			{Name: "recovery_email_address_code_info", Type: "emailAddressAuthenticationCodeInfo", Description: "Information about the recovery email address to which the confirmation email was sent; may be null"},
+			{Name: "pending_reset_date", Type: "int32", Description: "If not 0, point in time (Unix timestamp) after which the password can be reset immediately using resetPassword"},
+		},
+	},
+	{
+		Name:        "paymentForm",
+		Class:       "PaymentForm",
+		Description: "Contains information about an invoice payment form",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int64", Description: "The payment form identifier"},
+			{Name: "invoice", Type: "invoice", Description: "Full information of the invoice"},
+			{Name: "url", Type: "string", Description: "Payment form URL"},
+			{Name: "seller_bot_user_id", Type: "int53", Description: "User identifier of the seller bot"},
+			{Name: "payments_provider_user_id", Type: "int53", Description: "User identifier of the payment provider bot"},
+			{Name: "payments_provider", Type: "paymentsProviderStripe", Description: "Information about the payment provider, if available, to support it natively without the need for opening the URL; may be null"},
+			{Name: "saved_order_info", Type: "orderInfo", Description: "Saved server-side order information; may be null"},
+			{Name: "saved_credentials", Type: "savedCredentials", Description: "Information about saved card credentials; may be null"},
+			{Name: "can_save_credentials", Type: "Bool", Description: "True, if the user can choose to save credentials"},
+			{Name: "need_password", Type: "Bool", Description: "True, if the user will be able to save credentials protected by a password they set up"},
+		},
+	},
+	{
+		Name:        "paymentFormTheme",
+		Class:       "PaymentFormTheme",
+		Description: "Theme colors for a payment form",
+		Fields: []ParamSchema{
+			{Name: "background_color", Type: "int32", Description: "A color of the payment form background in the RGB24 format"},
+			{Name: "text_color", Type: "int32", Description: "A color of text in the RGB24 format"},
+			{Name: "hint_color", Type: "int32", Description: "A color of hints in the RGB24 format"},
+			{Name: "link_color", Type: "int32", Description: "A color of links in the RGB24 format"},
+			{Name: "button_color", Type: "int32", Description: "A color of the buttons in the RGB24 format"},
+			{Name: "button_text_color", Type: "int32", Description: "A color of text on the buttons in the RGB24 format"},
+		},
+	},
+	{
+		Name:        "paymentReceipt",
+		Class:       "PaymentReceipt",
+		Description: "Contains information about a successful payment",
+		Fields: []ParamSchema{
+			{Name: "title", Type: "string", Description: "Product title"},
+			{Name: "description", Type: "string", Description: "Product description"},
+			{Name: "photo", Type: "photo", Description: "Product photo; may be null"},
+			{Name: "date", Type: "int32", Description: "Point in time (Unix timestamp) when the payment was made"},
+			{Name: "seller_bot_user_id", Type: "int53", Description: "User identifier of the seller bot"},
+			{Name: "payments_provider_user_id", Type: "int53", Description: "User identifier of the payment provider bot"},
+			{Name: "invoice", Type: "invoice", Description: "Information about the invoice"},
+			{Name: "order_info", Type: "orderInfo", Description: "Order information; may be null"},
+			{Name: "shipping_option", Type: "shippingOption", Description: "Chosen shipping option; may be null"},
+			{Name: "credentials_title", Type: "string", Description: "Title of the saved credentials chosen by the buyer"},
+			{Name: "tip_amount", Type: "int53", Description: "The amount of tip chosen by the buyer in the smallest units of the currency"},
+		},
+	},
+	{
+		Name:        "paymentResult",
+		Class:       "PaymentResult",
+		Description: "Contains the result of a payment request",
+		Fields: []ParamSchema{
+			{Name: "success", Type: "Bool", Description: "True, if the payment request was successful; otherwise the verification_url will be non-empty"},
+			{Name: "verification_url", Type: "string", Description: "URL for additional payment credentials verification"},
+		},
+	},
+	{
+		Name:        "paymentsProviderStripe",
+		Class:       "PaymentsProviderStripe",
+		Description: "Stripe payment provider",
+		Fields: []ParamSchema{
+			{Name: "publishable_key", Type: "string", Description: "Stripe API publishable key"},
+			{Name: "need_country", Type: "Bool", Description: "True, if the user country must be provided"},
+			{Name: "need_postal_code", Type: "Bool", Description: "True, if the user ZIP/postal code must be provided"},
+			{Name: "need_cardholder_name", Type: "Bool", Description: "True, if the cardholder name must be provided"},
+		},
+	},
+	{
+		Name:        "personalDetails",
+		Class:       "PersonalDetails",
+		Description: "Contains the user's personal details",
+		Fields: []ParamSchema{
+			{Name: "first_name", Type: "string", Description: "First name of the user written in English; 1-255 characters"},
+			{Name: "middle_name", Type: "string", Description: "Middle name of the user written in English; 0-255 characters"},
+			{Name: "last_name", Type: "string", Description: "Last name of the user written in English; 1-255 characters"},
+			{Name: "native_first_name", Type: "string", Description: "Native first name of the user; 1-255 characters"},
+			{Name: "native_middle_name", Type: "string", Description: "Native middle name of the user; 0-255 characters"},
+			{Name: "native_last_name", Type: "string", Description: "Native last name of the user; 1-255 characters"},
+			{Name: "birthdate", Type: "date", Description: "Birthdate of the user"},
+			{Name: "gender", Type: "string", Description: "Gender of the user, \"male\" or \"female\""},
+			{Name: "country_code", Type: "string", Description: "A two-letter ISO 3166-1 alpha-2 country code of the user's country"},
+			{Name: "residence_country_code", Type: "string", Description: "A two-letter ISO 3166-1 alpha-2 country code of the user's residence country"},
+		},
+	},
+	{
+		Name:        "personalDocument",
+		Class:       "PersonalDocument",
+		Description: "A personal document, containing some information about a user",
+		Fields: []ParamSchema{
+			{Name: "files", Type: "vector<datedFile>", Description: "List of files containing the pages of the document"},
+			{Name: "translation", Type: "vector<datedFile>", Description: "List of files containing a certified English translation of the document"},
+		},
+	},
+	{
+		Name:        "phoneNumberAuthenticationSettings",
+		Class:       "PhoneNumberAuthenticationSettings",
+		Description: "Contains settings for the authentication of the user's phone number",
+		Fields: []ParamSchema{
+			{Name: "allow_flash_call", Type: "Bool", Description: "Pass true if the authentication code may be sent via a flash call to the specified phone number"},
+			{Name: "allow_missed_call", Type: "Bool", Description: "Pass true if the authentication code may be sent via a missed call to the specified phone number"},
+			{Name: "is_current_phone_number", Type: "Bool", Description: "Pass true if the authenticated phone number is used on the current device"},
+			{Name: "allow_sms_retriever_api", Type: "Bool", Description: "For official applications only. True, if the application can use Android SMS Retriever API (requires Google Play Services >= 10.2) to automatically receive the authentication code from the SMS. See https://developers.google.com/identity/sms-retriever/ for more details"},
+			{Name: "authentication_tokens", Type: "vector<string>", Description: "List of up to 20 authentication tokens, recently received in updateOption(\"authentication_token\") in previously logged out sessions"},
+		},
+	},
+	{
+		Name:        "phoneNumberInfo",
+		Class:       "PhoneNumberInfo",
+		Description: "Contains information about a phone number",
+		Fields: []ParamSchema{
+			{Name: "country", Type: "countryInfo", Description: "Information about the country to which the phone number belongs; may be null"},
+			{Name: "country_calling_code", Type: "string", Description: "The part of the phone number denoting country calling code or its part"},
+			{Name: "formatted_phone_number", Type: "string", Description: "The phone number without country calling code formatted accordingly to local rules. Expected digits are returned as '-', but even more digits might be entered by the user"},
+		},
+	},
+	{
+		Name:        "photo",
+		Class:       "Photo",
+		Description: "Describes a photo",
+		Fields: []ParamSchema{
+			{Name: "has_stickers", Type: "Bool", Description: "True, if stickers were added to the photo. The list of corresponding sticker sets can be received using getAttachedStickerSets"},
+			{Name: "minithumbnail", Type: "minithumbnail", Description: "Photo minithumbnail; may be null"},
+			{Name: "sizes", Type: "vector<photoSize>", Description: "Available variants of the photo, in different sizes"},
+		},
+	},
+	{
+		Name:        "photoSize",
+		Class:       "PhotoSize",
+		Description: "Describes an image in JPEG format",
+		Fields: []ParamSchema{
+			{Name: "type", Type: "string", Description: "Image type (see https://core.telegram.org/constructor/photoSize)"},
+			{Name: "photo", Type: "file", Description: "Information about the image file"},
+			{Name: "width", Type: "int32", Description: "Image width"},
+			{Name: "height", Type: "int32", Description: "Image height"},
+			{Name: "progressive_sizes", Type: "vector<int32>", Description: "Sizes of progressive JPEG file prefixes, which can be used to preliminarily show the image; in bytes"},
+		},
+	},
+	{
+		Name:        "point",
+		Class:       "Point",
+		Description: "A point on a Cartesian plane",
+		Fields: []ParamSchema{
+			{Name: "x", Type: "double", Description: "The point's first coordinate"},
+			{Name: "y", Type: "double", Description: "The point's second coordinate"},
+		},
+	},
+	{
+		Name:        "poll",
+		Class:       "Poll",
+		Description: "Describes a poll",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int64", Description: "Unique poll identifier"},
+			{Name: "question", Type: "string", Description: "Poll question; 1-300 characters"},
+			{Name: "options", Type: "vector<pollOption>", Description: "List of poll answer options"},
+			{Name: "total_voter_count", Type: "int32", Description: "Total number of voters, participating in the poll"},
+			{Name: "recent_voter_user_ids", Type: "vector<int53>", Description: "User identifiers of recent voters, if the poll is non-anonymous"},
+			{Name: "is_anonymous", Type: "Bool", Description: "True, if the poll is anonymous"},
+			{Name: "type", Type: "PollType", Description: "Type of the poll"},
+			{Name: "open_period", Type: "int32", Description: "Amount of time the poll will be active after creation, in seconds"},
+			{Name: "close_date", Type: "int32", Description: "Point in time (Unix timestamp) when the poll will automatically be closed"},
+			{Name: "is_closed", Type: "Bool", Description: "True, if the poll is closed"},
+		},
+	},
+	{
+		Name:        "pollOption",
+		Class:       "PollOption",
+		Description: "Describes one answer option of a poll",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "string", Description: "Option text; 1-100 characters"},
+			{Name: "voter_count", Type: "int32", Description: "Number of voters for this option, available only for closed or voted polls"},
+			{Name: "vote_percentage", Type: "int32", Description: "The percentage of votes for this option; 0-100"},
+			{Name: "is_chosen", Type: "Bool", Description: "True, if the option was chosen by the user"},
+			{Name: "is_being_chosen", Type: "Bool", Description: "True, if the option is being chosen by a pending setPollAnswer request"},
+		},
+	},
+	{
+		Name:        "pollTypeRegular",
+		Class:       "PollType",
+		Description: "A regular poll",
+		Fields: []ParamSchema{
+			{Name: "allow_multiple_answers", Type: "Bool", Description: "True, if multiple answer options can be chosen simultaneously"},
+		},
+	},
+	{
+		Name:        "pollTypeQuiz",
+		Class:       "PollType",
+		Description: "A poll in quiz mode, which has exactly one correct answer option and can be answered only once",
+		Fields: []ParamSchema{
+			{Name: "correct_option_id", Type: "int32", Description: "0-based identifier of the correct answer option; -1 for a yet unanswered poll"},
+			{Name: "explanation", Type: "formattedText", Description: "Text that is shown when the user chooses an incorrect answer or taps on the lamp icon; 0-200 characters with at most 2 line feeds; empty for a yet unanswered poll"},
+		},
+	},
+	{
+		Name:        "profilePhoto",
+		Class:       "ProfilePhoto",
+		Description: "Describes a user profile photo",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int64", Description: "Photo identifier; 0 for an empty photo. Can be used to find a photo in a list of user profile photos"},
+			{Name: "small", Type: "file", Description: "A small (160x160) user profile photo. The file can be downloaded only before the photo is changed"},
+			{Name: "big", Type: "file", Description: "A big (640x640) user profile photo. The file can be downloaded only before the photo is changed"},
+			{Name: "minithumbnail", Type: "minithumbnail", Description: "User profile photo minithumbnail; may be null"},
+			{Name: "has_animation", Type: "Bool", Description: "True, if the photo has animated variant"},
+		},
+	},
+	{
+		Name:        "proxies",
+		Class:       "Proxies",
+		Description: "Represents a list of proxy servers",
+		Fields: []ParamSchema{
+			{Name: "proxies", Type: "vector<proxy>", Description: "List of proxy servers"},
+		},
+	},
+	{
+		Name:        "proxy",
+		Class:       "Proxy",
+		Description: "Contains information about a proxy server",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int32", Description: "Unique identifier of the proxy"},
+			{Name: "server", Type: "string", Description: "Proxy server IP address"},
+			{Name: "port", Type: "int32", Description: "Proxy server port"},
+			{Name: "last_used_date", Type: "int32", Description: "Point in time (Unix timestamp) when the proxy was last used; 0 if never"},
+			{Name: "is_enabled", Type: "Bool", Description: "True, if the proxy is enabled now"},
+			{Name: "type", Type: "ProxyType", Description: "Type of the proxy"},
+		},
+	},
+	{
+		Name:        "proxyTypeSocks5",
+		Class:       "ProxyType",
+		Description: "A SOCKS5 proxy server",
+		Fields: []ParamSchema{
+			{Name: "username", Type: "string", Description: "Username for logging in; may be empty"},
+			{Name: "password", Type: "string", Description: "Password for logging in; may be empty"},
+		},
+	},
+	{
+		Name:        "proxyTypeHttp",
+		Class:       "ProxyType",
+		Description: "A HTTP transparent proxy server",
+		Fields: []ParamSchema{
+			{Name: "username", Type: "string", Description: "Username for logging in; may be empty"},
+			{Name: "password", Type: "string", Description: "Password for logging in; may be empty"},
+			{Name: "http_only", Type: "Bool", Description: "Pass true if the proxy supports only HTTP requests and doesn't support transparent TCP connections via HTTP CONNECT method"},
+		},
+	},
+	{
+		Name:        "proxyTypeMtproto",
+		Class:       "ProxyType",
+		Description: "An MTProto proxy server",
+		Fields: []ParamSchema{
+			{Name: "secret", Type: "string", Description: "The proxy's secret in hexadecimal encoding"},
+		},
+	},
+	{
+		Name:        "publicChatTypeHasUsername",
+		Class:       "PublicChatType",
+		Description: "The chat is public, because it has username",
+	},
+	{
+		Name:        "publicChatTypeIsLocationBased",
+		Class:       "PublicChatType",
+		Description: "The chat is public, because it is a location-based supergroup",
+	},
+	{
+		Name:        "pushMessageContentHidden",
+		Class:       "PushMessageContent",
+		Description: "A general message with hidden content",
+		Fields: []ParamSchema{
+			{Name: "is_pinned", Type: "Bool", Description: "True, if the message is a pinned message with the specified content"},
+		},
+	},
+	{
+		Name:        "pushMessageContentAnimation",
+		Class:       "PushMessageContent",
+		Description: "An animation message (GIF-style).",
+		Fields: []ParamSchema{
+			{Name: "animation", Type: "animation", Description: "Message content; may be null"},
+			{Name: "caption", Type: "string", Description: "Animation caption"},
+			{Name: "is_pinned", Type: "Bool", Description: "True, if the message is a pinned message with the specified content"},
+		},
+	},
+	{
+		Name:        "pushMessageContentAudio",
+		Class:       "PushMessageContent",
+		Description: "An audio message",
+		Fields: []ParamSchema{
+			{Name: "audio", Type: "audio", Description: "Message content; may be null"},
+			{Name: "is_pinned", Type: "Bool", Description: "True, if the message is a pinned message with the specified content"},
+		},
+	},
+	{
+		Name:        "pushMessageContentContact",
+		Class:       "PushMessageContent",
+		Description: "A message with a user contact",
+		Fields: []ParamSchema{
+			{Name: "name", Type: "string", Description: "Contact's name"},
+			{Name: "is_pinned", Type: "Bool", Description: "True, if the message is a pinned message with the specified content"},
+		},
+	},
+	{
+		Name:        "pushMessageContentContactRegistered",
+		Class:       "PushMessageContent",
+		Description: "A contact has registered with Telegram",
+	},
+	{
+		Name:        "pushMessageContentDocument",
+		Class:       "PushMessageContent",
+		Description: "A document message (a general file)",
+		Fields: []ParamSchema{
+			{Name: "document", Type: "document", Description: "Message content; may be null"},
+			{Name: "is_pinned", Type: "Bool", Description: "True, if the message is a pinned message with the specified content"},
+		},
+	},
+	{
+		Name:        "pushMessageContentGame",
+		Class:       "PushMessageContent",
+		Description: "A message with a game",
+		Fields: []ParamSchema{
+			{Name: "title", Type: "string", Description: "Game title, empty for pinned game message"},
+			{Name: "is_pinned", Type: "Bool", Description: "True, if the message is a pinned message with the specified content"},
+		},
+	},
+	{
+		Name:        "pushMessageContentGameScore",
+		Class:       "PushMessageContent",
+		Description: "A new high score was achieved in a game",
+		Fields: []ParamSchema{
+			{Name: "title", Type: "string", Description: "Game title, empty for pinned message"},
+			{Name: "score", Type: "int32", Description: "New score, 0 for pinned message"},
+			{Name: "is_pinned", Type: "Bool", Description: "True, if the message is a pinned message with the specified content"},
+		},
+	},
+	{
+		Name:        "pushMessageContentInvoice",
+		Class:       "PushMessageContent",
+		Description: "A message with an invoice from a bot",
+		Fields: []ParamSchema{
+			{Name: "price", Type: "string", Description: "Product price"},
+			{Name: "is_pinned", Type: "Bool", Description: "True, if the message is a pinned message with the specified content"},
+		},
+	},
+	{
+		Name:        "pushMessageContentLocation",
+		Class:       "PushMessageContent",
+		Description: "A message with a location",
+		Fields: []ParamSchema{
+			{Name: "is_live", Type: "Bool", Description: "True, if the location is live"},
+			{Name: "is_pinned", Type: "Bool", Description: "True, if the message is a pinned message with the specified content"},
+		},
+	},
+	{
+		Name:        "pushMessageContentPhoto",
+		Class:       "PushMessageContent",
+		Description: "A photo message",
+		Fields: []ParamSchema{
+			{Name: "photo", Type: "photo", Description: "Message content; may be null"},
+			{Name: "caption", Type: "string", Description: "Photo caption"},
+			{Name: "is_secret", Type: "Bool", Description: "True, if the photo is secret"},
+			{Name: "is_pinned", Type: "Bool", Description: "True, if the message is a pinned message with the specified content"},
+		},
+	},
+	{
+		Name:        "pushMessageContentPoll",
+		Class:       "PushMessageContent",
+		Description: "A message with a poll",
+		Fields: []ParamSchema{
+			{Name: "question", Type: "string", Description: "Poll question"},
+			{Name: "is_regular", Type: "Bool", Description: "True, if the poll is regular and not in quiz mode"},
+			{Name: "is_pinned", Type: "Bool", Description: "True, if the message is a pinned message with the specified content"},
+		},
+	},
+	{
+		Name:        "pushMessageContentScreenshotTaken",
+		Class:       "PushMessageContent",
+		Description: "A screenshot of a message in the chat has been taken",
+	},
+	{
+		Name:        "pushMessageContentSticker",
+		Class:       "PushMessageContent",
+		Description: "A message with a sticker",
+		Fields: []ParamSchema{
+			{Name: "sticker", Type: "sticker", Description: "Message content; may be null"},
+			{Name: "emoji", Type: "string", Description: "Emoji corresponding to the sticker; may be empty"},
+			{Name: "is_pinned", Type: "Bool", Description: "True, if the message is a pinned message with the specified content"},
+		},
+	},
+	{
+		Name:        "pushMessageContentText",
+		Class:       "PushMessageContent",
+		Description: "A text message",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "string", Description: "Message text"},
+			{Name: "is_pinned", Type: "Bool", Description: "True, if the message is a pinned message with the specified content"},
+		},
+	},
+	{
+		Name:        "pushMessageContentVideo",
+		Class:       "PushMessageContent",
+		Description: "A video message",
+		Fields: []ParamSchema{
+			{Name: "video", Type: "video", Description: "Message content; may be null"},
+			{Name: "caption", Type: "string", Description: "Video caption"},
+			{Name: "is_secret", Type: "Bool", Description: "True, if the video is secret"},
+			{Name: "is_pinned", Type: "Bool", Description: "True, if the message is a pinned message with the specified content"},
+		},
+	},
+	{
+		Name:        "pushMessageContentVideoNote",
+		Class:       "PushMessageContent",
+		Description: "A video note message",
+		Fields: []ParamSchema{
+			{Name: "video_note", Type: "videoNote", Description: "Message content; may be null"},
+			{Name: "is_pinned", Type: "Bool", Description: "True, if the message is a pinned message with the specified content"},
+		},
+	},
+	{
+		Name:        "pushMessageContentVoiceNote",
+		Class:       "PushMessageContent",
+		Description: "A voice note message",
+		Fields: []ParamSchema{
+			{Name: "voice_note", Type: "voiceNote", Description: "Message content; may be null"},
+			{Name: "is_pinned", Type: "Bool", Description: "True, if the message is a pinned message with the specified content"},
+		},
+	},
+	{
+		Name:        "pushMessageContentBasicGroupChatCreate",
+		Class:       "PushMessageContent",
+		Description: "A newly created basic group",
+	},
+	{
+		Name:        "pushMessageContentChatAddMembers",
+		Class:       "PushMessageContent",
+		Description: "New chat members were invited to a group",
+		Fields: []ParamSchema{
+			{Name: "member_name", Type: "string", Description: "Name of the added member"},
+			{Name: "is_current_user", Type: "Bool", Description: "True, if the current user was added to the group"},
+			{Name: "is_returned", Type: "Bool", Description: "True, if the user has returned to the group themselves"},
+		},
+	},
+	{
+		Name:        "pushMessageContentChatChangePhoto",
+		Class:       "PushMessageContent",
+		Description: "A chat photo was edited",
+	},
+	{
+		Name:        "pushMessageContentChatChangeTitle",
+		Class:       "PushMessageContent",
+		Description: "A chat title was edited",
+		Fields: []ParamSchema{
+			{Name: "title", Type: "string", Description: "New chat title"},
+		},
+	},
+	{
+		Name:        "pushMessageContentChatSetTheme",
+		Class:       "PushMessageContent",
+		Description: "A chat theme was edited",
+		Fields: []ParamSchema{
+			{Name: "theme_name", Type: "string", Description: "If non-empty, name of a new theme, set for the chat. Otherwise chat theme was reset to the default one"},
+		},
+	},
+	{
+		Name:        "pushMessageContentChatDeleteMember",
+		Class:       "PushMessageContent",
+		Description: "A chat member was deleted",
+		Fields: []ParamSchema{
+			{Name: "member_name", Type: "string", Description: "Name of the deleted member"},
+			{Name: "is_current_user", Type: "Bool", Description: "True, if the current user was deleted from the group"},
+			{Name: "is_left", Type: "Bool", Description: "True, if the user has left the group themselves"},
+		},
+	},
+	{
+		Name:        "pushMessageContentChatJoinByLink",
+		Class:       "PushMessageContent",
+		Description: "A new member joined the chat via an invite link",
+	},
+	{
+		Name:        "pushMessageContentChatJoinByRequest",
+		Class:       "PushMessageContent",
+		Description: "A new member was accepted to the chat by an administrator",
+	},
+	{
+		Name:        "pushMessageContentMessageForwards",
+		Class:       "PushMessageContent",
+		Description: "A forwarded messages",
+		Fields: []ParamSchema{
+			{Name: "total_count", Type: "int32", Description: "Number of forwarded messages"},
+		},
+	},
+	{
+		Name:        "pushMessageContentMediaAlbum",
+		Class:       "PushMessageContent",
+		Description: "A media album",
+		Fields: []ParamSchema{
+			{Name: "total_count", Type: "int32", Description: "Number of messages in the album"},
+			{Name: "has_photos", Type: "Bool", Description: "True, if the album has at least one photo"},
+			{Name: "has_videos", Type: "Bool", Description: "True, if the album has at least one video"},
+			{Name: "has_audios", Type: "Bool", Description: "True, if the album has at least one audio file"},
+			{Name: "has_documents", Type: "Bool", Description: "True, if the album has at least one document"},
+		},
+	},
+	{
+		Name:        "pushReceiverId",
+		Class:       "PushReceiverId",
+		Description: "Contains a globally unique push receiver identifier, which can be used to identify which account has received a push notification",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int64", Description: "The globally unique identifier of push notification subscription"},
+		},
+	},
+	{
+		Name:        "recommendedChatFilter",
+		Class:       "RecommendedChatFilter",
+		Description: "Describes a recommended chat filter",
+		Fields: []ParamSchema{
+			{Name: "filter", Type: "chatFilter", Description: "The chat filter"},
+			{Name: "description", Type: "string", Description: "Chat filter description"},
+		},
+	},
+	{
+		Name:        "recommendedChatFilters",
+		Class:       "RecommendedChatFilters",
+		Description: "Contains a list of recommended chat filters",
+		Fields: []ParamSchema{
+			{Name: "chat_filters", Type: "vector<recommendedChatFilter>", Description: "List of recommended chat filters"},
+		},
+	},
+	{
+		Name:        "recoveryEmailAddress",
+		Class:       "RecoveryEmailAddress",
+		Description: "Contains information about the current recovery email address",
+		Fields: []ParamSchema{
+			{Name: "recovery_email_address", Type: "string", Description: "Recovery email address"},
+		},
+	},
+	{
+		Name:        "remoteFile",
+		Class:       "RemoteFile",
+		Description: "Represents a remote file",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Remote file identifier; may be empty. Can be used by the current user across application restarts or even from other devices. Uniquely identifies a file, but a file can have a lot of different valid identifiers. If the ID starts with \"http://\" or \"https://\", it represents the HTTP URL of the file. TDLib is currently unable to download files if only their URL is known. If downloadFile is called on such a file or if it is sent to a secret chat, TDLib starts a file generation process by sending updateFileGenerationStart to the application with the HTTP URL in the original_path and \"#url#\" as the conversion string. Application must generate the file by downloading it to the specified location"},
+			{Name: "unique_id", Type: "string", Description: "Unique file identifier; may be empty if unknown. The unique file identifier which is the same for the same file even for different users and is persistent over time"},
+			{Name: "is_uploading_active", Type: "Bool", Description: "True, if the file is currently being uploaded (or a remote copy is being generated by some other means)"},
+			{Name: "is_uploading_completed", Type: "Bool", Description: "True, if a remote copy is fully available"},
+			{Name: "uploaded_size", Type: "int32", Description: "Size of the remote available part of the file, in bytes; 0 if unknown"},
+		},
+	},
+	{
+		Name:        "replyMarkupRemoveKeyboard",
+		Class:       "ReplyMarkup",
+		Description: "Instructs application to remove the keyboard once this message has been received. This kind of keyboard can't be received in an incoming message; instead, UpdateChatReplyMarkup with message_id == 0 will be sent",
+		Fields: []ParamSchema{
+			{Name: "is_personal", Type: "Bool", Description: "True, if the keyboard is removed only for the mentioned users or the target user of a reply"},
+		},
+	},
+	{
+		Name:        "replyMarkupForceReply",
+		Class:       "ReplyMarkup",
+		Description: "Instructs application to force a reply to this message",
+		Fields: []ParamSchema{
+			{Name: "is_personal", Type: "Bool", Description: "True, if a forced reply must automatically be shown to the current user. For outgoing messages, specify true to show the forced reply only for the mentioned users and for the target user of a reply"},
+			{Name: "input_field_placeholder", Type: "string", Description: "If non-empty, the placeholder to be shown in the input field when the reply is active; 0-64 characters"},
+		},
+	},
+	{
+		Name:        "replyMarkupShowKeyboard",
+		Class:       "ReplyMarkup",
+		Description: "Contains a custom keyboard layout to quickly reply to bots",
+		Fields: []ParamSchema{
+			{Name: "rows", Type: "vector<vector<keyboardButton>>", Description: "A list of rows of bot keyboard buttons"},
+			{Name: "resize_keyboard", Type: "Bool", Description: "True, if the application needs to resize the keyboard vertically"},
+			{Name: "one_time", Type: "Bool", Description: "True, if the application needs to hide the keyboard after use"},
+			{Name: "is_personal", Type: "Bool", Description: "True, if the keyboard must automatically be shown to the current user. For outgoing messages, specify true to show the keyboard only for the mentioned users and for the target user of a reply"},
+			{Name: "input_field_placeholder", Type: "string", Description: "If non-empty, the placeholder to be shown in the input field when the keyboard is active; 0-64 characters"},
+		},
+	},
+	{
+		Name:        "replyMarkupInlineKeyboard",
+		Class:       "ReplyMarkup",
+		Description: "Contains an inline keyboard layout",
+		Fields: []ParamSchema{
+			{Name: "rows", Type: "vector<vector<inlineKeyboardButton>>", Description: "A list of rows of inline keyboard buttons"},
+		},
+	},
+	{
+		Name:        "resetPasswordResultOk",
+		Class:       "ResetPasswordResult",
+		Description: "The password was reset",
+	},
+	{
+		Name:        "resetPasswordResultPending",
+		Class:       "ResetPasswordResult",
+		Description: "The password reset request is pending",
+		Fields: []ParamSchema{
+			{Name: "pending_reset_date", Type: "int32", Description: "Point in time (Unix timestamp) after which the password can be reset immediately using resetPassword"},
+		},
+	},
+	{
+		Name:        "resetPasswordResultDeclined",
+		Class:       "ResetPasswordResult",
+		Description: "The password reset request was declined",
+		Fields: []ParamSchema{
+			{Name: "retry_date", Type: "int32", Description: "Point in time (Unix timestamp) when the password reset can be retried"},
+		},
+	},
+	{
+		Name:        "richTextPlain",
+		Class:       "RichText",
+		Description: "A plain text",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "string", Description: "Text"},
+		},
+	},
+	{
+		Name:        "richTextBold",
+		Class:       "RichText",
+		Description: "A bold rich text",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "RichText", Description: "Text"},
+		},
+	},
+	{
+		Name:        "richTextItalic",
+		Class:       "RichText",
+		Description: "An italicized rich text",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "RichText", Description: "Text"},
+		},
+	},
+	{
+		Name:        "richTextUnderline",
+		Class:       "RichText",
+		Description: "An underlined rich text",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "RichText", Description: "Text"},
+		},
+	},
+	{
+		Name:        "richTextStrikethrough",
+		Class:       "RichText",
+		Description: "A strikethrough rich text",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "RichText", Description: "Text"},
+		},
+	},
+	{
+		Name:        "richTextFixed",
+		Class:       "RichText",
+		Description: "A fixed-width rich text",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "RichText", Description: "Text"},
+		},
+	},
+	{
+		Name:        "richTextUrl",
+		Class:       "RichText",
+		Description: "A rich text URL link",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "RichText", Description: "Text"},
+			{Name: "url", Type: "string", Description: "URL"},
+			{Name: "is_cached", Type: "Bool", Description: "True, if the URL has cached instant view server-side"},
+		},
+	},
+	{
+		Name:        "richTextEmailAddress",
+		Class:       "RichText",
+		Description: "A rich text email link",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "RichText", Description: "Text"},
+			{Name: "email_address", Type: "string", Description: "Email address"},
+		},
+	},
+	{
+		Name:        "richTextSubscript",
+		Class:       "RichText",
+		Description: "A subscript rich text",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "RichText", Description: "Text"},
+		},
+	},
+	{
+		Name:        "richTextSuperscript",
+		Class:       "RichText",
+		Description: "A superscript rich text",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "RichText", Description: "Text"},
+		},
+	},
+	{
+		Name:        "richTextMarked",
+		Class:       "RichText",
+		Description: "A marked rich text",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "RichText", Description: "Text"},
+		},
+	},
+	{
+		Name:        "richTextPhoneNumber",
+		Class:       "RichText",
+		Description: "A rich text phone number",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "RichText", Description: "Text"},
+			{Name: "phone_number", Type: "string", Description: "Phone number"},
+		},
+	},
+	{
+		Name:        "richTextIcon",
+		Class:       "RichText",
+		Description: "A small image inside the text",
+		Fields: []ParamSchema{
+			{Name: "document", Type: "document", Description: "The image represented as a document. The image can be in GIF, JPEG or PNG format"},
+			{Name: "width", Type: "int32", Description: "Width of a bounding box in which the image must be shown; 0 if unknown"},
+			{Name: "height", Type: "int32", Description: "Height of a bounding box in which the image must be shown; 0 if unknown"},
+		},
+	},
+	{
+		Name:        "richTextReference",
+		Class:       "RichText",
+		Description: "A reference to a richTexts object on the same web page",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "RichText", Description: "The text"},
+			{Name: "anchor_name", Type: "string", Description: "The name of a richTextAnchor object, which is the first element of the target richTexts object"},
+			{Name: "url", Type: "string", Description: "An HTTP URL, opening the reference"},
+		},
+	},
+	{
+		Name:        "richTextAnchor",
+		Class:       "RichText",
+		Description: "An anchor",
+		Fields: []ParamSchema{
+			{Name: "name", Type: "string", Description: "Anchor name"},
+		},
+	},
+	{
+		Name:        "richTextAnchorLink",
+		Class:       "RichText",
+		Description: "A link to an anchor on the same web page",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "RichText", Description: "The link text"},
+			{Name: "anchor_name", Type: "string", Description: "The anchor name. If the name is empty, the link must bring back to top"},
+			{Name: "url", Type: "string", Description: "An HTTP URL, opening the anchor"},
+		},
+	},
+	{
+		Name:        "richTexts",
+		Class:       "RichText",
+		Description: "A concatenation of rich texts",
+		Fields: []ParamSchema{
+			{Name: "texts", Type: "vector<RichText>", Description: "Texts"},
+		},
+	},
+	{
+		Name:        "savedCredentials",
+		Class:       "SavedCredentials",
+		Description: "Contains information about saved card credentials",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Unique identifier of the saved credentials"},
+			{Name: "title", Type: "string", Description: "Title of the saved credentials"},
+		},
+	},
+	{
+		Name:        "scopeNotificationSettings",
+		Class:       "ScopeNotificationSettings",
+		Description: "Contains information about notification settings for several chats",
+		Fields: []ParamSchema{
+			{Name: "mute_for", Type: "int32", Description: "Time left before notifications will be unmuted, in seconds"},
+			{Name: "sound", Type: "string", Description: "The name of an audio file to be used for notification sounds; only applies to iOS applications"},
+			{Name: "show_preview", Type: "Bool", Description: "True, if message content must be displayed in notifications"},
+			{Name: "disable_pinned_message_notifications", Type: "Bool", Description: "True, if notifications for incoming pinned messages will be created as for an ordinary unread message"},
+			{Name: "disable_mention_notifications", Type: "Bool", Description: "True, if notifications for messages with mentions will be created as for an ordinary unread message"},
+		},
+	},
+	{
+		Name:        "searchMessagesFilterEmpty",
+		Class:       "SearchMessagesFilter",
+		Description: "Returns all found messages, no filter is applied",
+	},
+	{
+		Name:        "searchMessagesFilterAnimation",
+		Class:       "SearchMessagesFilter",
+		Description: "Returns only animation messages",
+	},
+	{
+		Name:        "searchMessagesFilterAudio",
+		Class:       "SearchMessagesFilter",
+		Description: "Returns only audio messages",
+	},
+	{
+		Name:        "searchMessagesFilterDocument",
+		Class:       "SearchMessagesFilter",
+		Description: "Returns only document messages",
+	},
+	{
+		Name:        "searchMessagesFilterPhoto",
+		Class:       "SearchMessagesFilter",
+		Description: "Returns only photo messages",
+	},
+	{
+		Name:        "searchMessagesFilterVideo",
+		Class:       "SearchMessagesFilter",
+		Description: "Returns only video messages",
+	},
+	{
+		Name:        "searchMessagesFilterVoiceNote",
+		Class:       "SearchMessagesFilter",
+		Description: "Returns only voice note messages",
+	},
+	{
+		Name:        "searchMessagesFilterPhotoAndVideo",
+		Class:       "SearchMessagesFilter",
+		Description: "Returns only photo and video messages",
+	},
+	{
+		Name:        "searchMessagesFilterUrl",
+		Class:       "SearchMessagesFilter",
+		Description: "Returns only messages containing URLs",
+	},
+	{
+		Name:        "searchMessagesFilterChatPhoto",
+		Class:       "SearchMessagesFilter",
+		Description: "Returns only messages containing chat photos",
+	},
+	{
+		Name:        "searchMessagesFilterVideoNote",
+		Class:       "SearchMessagesFilter",
+		Description: "Returns only video note messages",
+	},
+	{
+		Name:        "searchMessagesFilterVoiceAndVideoNote",
+		Class:       "SearchMessagesFilter",
+		Description: "Returns only voice and video note messages",
+	},
+	{
+		Name:        "searchMessagesFilterMention",
+		Class:       "SearchMessagesFilter",
+		Description: "Returns only messages with mentions of the current user, or messages that are replies to their messages",
+	},
+	{
+		Name:        "searchMessagesFilterUnreadMention",
+		Class:       "SearchMessagesFilter",
+		Description: "Returns only messages with unread mentions of the current user, or messages that are replies to their messages. When using this filter the results can't be additionally filtered by a query, a message thread or by the sending user",
+	},
+	{
+		Name:        "searchMessagesFilterFailedToSend",
+		Class:       "SearchMessagesFilter",
+		Description: "Returns only failed to send messages. This filter can be used only if the message database is used",
+	},
+	{
+		Name:        "searchMessagesFilterPinned",
+		Class:       "SearchMessagesFilter",
+		Description: "Returns only pinned messages",
+	},
+	{
+		Name:        "seconds",
+		Class:       "Seconds",
+		Description: "Contains a value representing a number of seconds",
+		Fields: []ParamSchema{
+			{Name: "seconds", Type: "double", Description: "Number of seconds"},
+		},
+	},
+	{
+		Name:        "secretChat",
+		Class:       "SecretChat",
+		Description: "Represents a secret chat",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int32", Description: "Secret chat identifier"},
+			{Name: "user_id", Type: "int53", Description: "Identifier of the chat partner"},
+			{Name: "state", Type: "SecretChatState", Description: "State of the secret chat"},
+			{Name: "is_outbound", Type: "Bool", Description: "True, if the chat was created by the current user; otherwise false"},
+			{Name: "key_hash", Type: "bytes", Description: "Hash of the currently used key for comparison with the hash of the chat partner's key. This is a string of 36 little-endian bytes, which must be split into groups of 2 bits, each denoting a pixel of one of 4 colors FFFFFF, D5E6F3, 2D5775, and 2F99C9. The pixels must be used to make a 12x12 square image filled from left to right, top to bottom. Alternatively, the first 32 bytes of the hash can be converted to the hexadecimal format and printed as 32 2-digit hex numbers"},
+			{Name: "layer", Type: "int32", Description: "Secret chat layer; determines features supported by the chat partner's application. Nested text entities and underline and strikethrough entities are supported if the layer >= 101"},
+		},
+	},
+	{
+		Name:        "secretChatStatePending",
+		Class:       "SecretChatState",
+		Description: "The secret chat is not yet created; waiting for the other user to get online",
+	},
+	{
+		Name:        "secretChatStateReady",
+		Class:       "SecretChatState",
+		Description: "The secret chat is ready to use",
+	},
+	{
+		Name:        "secretChatStateClosed",
+		Class:       "SecretChatState",
+		Description: "The secret chat is closed",
+	},
+	{
+		Name:        "session",
+		Class:       "Session",
+		Description: "Contains information about one session in a Telegram application used by the current user. Sessions must be shown to the user in the returned order",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int64", Description: "Session identifier"},
+			{Name: "is_current", Type: "Bool", Description: "True, if this session is the current session"},
+			{Name: "is_password_pending", Type: "Bool", Description: "True, if a password is needed to complete authorization of the session"},
+			{Name: "can_accept_secret_chats", Type: "Bool", Description: "True, if incoming secret chats can be accepted by the session"},
+			{Name: "can_accept_calls", Type: "Bool", Description: "True, if incoming calls can be accepted by the session"},
+			{Name: "api_id", Type: "int32", Description: "Telegram API identifier, as provided by the application"},
+			{Name: "application_name", Type: "string", Description: "Name of the application, as provided by the application"},
+			{Name: "application_version", Type: "string", Description: "The version of the application, as provided by the application"},
+			{Name: "is_official_application", Type: "Bool", Description: "True, if the application is an official application or uses the api_id of an official application"},
+			{Name: "device_model", Type: "string", Description: "Model of the device the application has been run or is running on, as provided by the application"},
+			{Name: "platform", Type: "string", Description: "Operating system the application has been run or is running on, as provided by the application"},
+			{Name: "system_version", Type: "string", Description: "Version of the operating system the application has been run or is running on, as provided by the application"},
+			{Name: "log_in_date", Type: "int32", Description: "Point in time (Unix timestamp) when the user has logged in"},
+			{Name: "last_active_date", Type: "int32", Description: "Point in time (Unix timestamp) when the session was last used"},
+			{Name: "ip", Type: "string", Description: "IP address from which the session was created, in human-readable format"},
+			{Name: "country", Type: "string", Description: "A two-letter country code for the country from which the session was created, based on the IP address"},
+			{Name: "region", Type: "string", Description: "Region code from which the session was created, based on the IP address"},
+		},
+	},
+	{
+		Name:        "sessions",
+		Class:       "Sessions",
+		Description: "Contains a list of sessions",
+		Fields: []ParamSchema{
+			{Name: "sessions", Type: "vector<session>", Description: "List of sessions"},
+			{Name: "inactive_session_ttl_days", Type: "int32", Description: "Number of days of inactivity before sessions will automatically be terminated; 1-366 days"},
+		},
+	},
+	{
+		Name:        "shippingOption",
+		Class:       "ShippingOption",
+		Description: "One shipping option",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "string", Description: "Shipping option identifier"},
+			{Name: "title", Type: "string", Description: "Option title"},
+			{Name: "price_parts", Type: "vector<labeledPricePart>", Description: "A list of objects used to calculate the total shipping costs"},
+		},
+	},
+	{
+		Name:        "sponsoredMessage",
+		Class:       "SponsoredMessage",
+		Description: "Describes a sponsored message",
+		Fields: []ParamSchema{
+			{Name: "message_id", Type: "int53", Description: "Message identifier; unique for the chat to which the sponsored message belongs among both ordinary and sponsored messages"},
+			{Name: "sponsor_chat_id", Type: "int53", Description: "Sponsor chat identifier; 0 if the sponsor chat is accessible through an invite link"},
+			{Name: "sponsor_chat_info", Type: "chatInviteLinkInfo", Description: "Information about the sponsor chat; may be null unless sponsor_chat_id == 0"},
+			{Name: "link", Type: "InternalLinkType", Description: "An internal link to be opened when the sponsored message is clicked; may be null. If null, the sponsor chat needs to be opened instead"},
+			{Name: "content", Type: "MessageContent", Description: "Content of the message. Currently, can be only of the type messageText"},
+		},
+	},
+	{
+		Name:        "statisticalGraphData",
+		Class:       "StatisticalGraph",
+		Description: "A graph data",
+		Fields: []ParamSchema{
+			{Name: "json_data", Type: "string", Description: "Graph data in JSON format"},
+			{Name: "zoom_token", Type: "string", Description: "If non-empty, a token which can be used to receive a zoomed in graph"},
+		},
+	},
+	{
+		Name:        "statisticalGraphAsync",
+		Class:       "StatisticalGraph",
+		Description: "The graph data to be asynchronously loaded through getStatisticalGraph",
+		Fields: []ParamSchema{
+			{Name: "token", Type: "string", Description: "The token to use for data loading"},
+		},
+	},
+	{
+		Name:        "statisticalGraphError",
+		Class:       "StatisticalGraph",
+		Description: "An error message to be shown to the user instead of the graph",
+		Fields: []ParamSchema{
+			{Name: "error_message", Type: "string", Description: "The error message"},
+		},
+	},
+	{
+		Name:        "statisticalValue",
+		Class:       "StatisticalValue",
+		Description: "A value with information about its recent changes",
+		Fields: []ParamSchema{
+			{Name: "value", Type: "double", Description: "The current value"},
+			{Name: "previous_value", Type: "double", Description: "The value for the previous day"},
+			{Name: "growth_rate_percentage", Type: "double", Description: "The growth rate of the value, as a percentage"},
+		},
+	},
+	{
+		Name:        "sticker",
+		Class:       "Sticker",
+		Description: "Describes a sticker",
+		Fields: []ParamSchema{
+			{Name: "set_id", Type: "int64", Description: "The identifier of the sticker set to which the sticker belongs; 0 if none"},
+			{Name: "width", Type: "int32", Description: "Sticker width; as defined by the sender"},
+			{Name: "height", Type: "int32", Description: "Sticker height; as defined by the sender"},
+			{Name: "emoji", Type: "string", Description: "Emoji corresponding to the sticker"},
+			{Name: "is_animated", Type: "Bool", Description: "True, if the sticker is an animated sticker in TGS format"},
+			{Name: "is_mask", Type: "Bool", Description: "True, if the sticker is a mask"},
+			{Name: "mask_position", Type: "maskPosition", Description: "Position where the mask is placed; may be null"},
+			{Name: "outline", Type: "vector<closedVectorPath>", Description: "Sticker's outline represented as a list of closed vector paths; may be empty. The coordinate system origin is in the upper-left corner"},
+			{Name: "thumbnail", Type: "thumbnail", Description: "Sticker thumbnail in WEBP or JPEG format; may be null"},
+			{Name: "sticker", Type: "file", Description: "File containing the sticker"},
+		},
+	},
+	{
+		Name:        "stickerSet",
+		Class:       "StickerSet",
+		Description: "Represents a sticker set",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int64", Description: "Identifier of the sticker set"},
+			{Name: "title", Type: "string", Description: "Title of the sticker set"},
+			{Name: "name", Type: "string", Description: "Name of the sticker set"},
+			{Name: "thumbnail", Type: "thumbnail", Description: "Sticker set thumbnail in WEBP or TGS format with width and height 100; may be null. The file can be downloaded only before the thumbnail is changed"},
+			{Name: "thumbnail_outline", Type: "vector<closedVectorPath>", Description: "Sticker set thumbnail's outline represented as a list of closed vector paths; may be empty. The coordinate system origin is in the upper-left corner"},
+			{Name: "is_installed", Type: "Bool", Description: "True, if the sticker set has been installed by the current user"},
+			{Name: "is_archived", Type: "Bool", Description: "True, if the sticker set has been archived. A sticker set can't be installed and archived simultaneously"},
+			{Name: "is_official", Type: "Bool", Description: "True, if the sticker set is official"},
+			{Name: "is_animated", Type: "Bool", Description: "True, is the stickers in the set are animated"},
+			{Name: "is_masks", Type: "Bool", Description: "True, if the stickers in the set are masks"},
+			{Name: "is_viewed", Type: "Bool", Description: "True for already viewed trending sticker sets"},
+			{Name: "stickers", Type: "vector<sticker>", Description: "List of stickers in this set"},
+			{Name: "emojis", Type: "vector<emojis>", Description: "A list of emoji corresponding to the stickers in the same order. The list is only for informational purposes, because a sticker is always sent with a fixed emoji from the corresponding Sticker object"},
+		},
+	},
+	{
+		Name:        "stickerSetInfo",
+		Class:       "StickerSetInfo",
+		Description: "Represents short information about a sticker set",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int64", Description: "Identifier of the sticker set"},
+			{Name: "title", Type: "string", Description: "Title of the sticker set"},
+			{Name: "name", Type: "string", Description: "Name of the sticker set"},
+			{Name: "thumbnail", Type: "thumbnail", Description: "Sticker set thumbnail in WEBP or TGS format with width and height 100; may be null"},
+			{Name: "thumbnail_outline", Type: "vector<closedVectorPath>", Description: "Sticker set thumbnail's outline represented as a list of closed vector paths; may be empty. The coordinate system origin is in the upper-left corner"},
+			{Name: "is_installed", Type: "Bool", Description: "True, if the sticker set has been installed by the current user"},
+			{Name: "is_archived", Type: "Bool", Description: "True, if the sticker set has been archived. A sticker set can't be installed and archived simultaneously"},
+			{Name: "is_official", Type: "Bool", Description: "True, if the sticker set is official"},
+			{Name: "is_animated", Type: "Bool", Description: "True, is the stickers in the set are animated"},
+			{Name: "is_masks", Type: "Bool", Description: "True, if the stickers in the set are masks"},
+			{Name: "is_viewed", Type: "Bool", Description: "True for already viewed trending sticker sets"},
+			{Name: "size", Type: "int32", Description: "Total number of stickers in the set"},
+			{Name: "covers", Type: "vector<sticker>", Description: "Up to the first 5 stickers from the set, depending on the context. If the application needs more stickers the full sticker set needs to be requested"},
+		},
+	},
+	{
+		Name:        "stickerSets",
+		Class:       "StickerSets",
+		Description: "Represents a list of sticker sets",
+		Fields: []ParamSchema{
+			{Name: "total_count", Type: "int32", Description: "Approximate total number of sticker sets found"},
+			{Name: "sets", Type: "vector<stickerSetInfo>", Description: "List of sticker sets"},
+		},
+	},
+	{
+		Name:        "stickers",
+		Class:       "Stickers",
+		Description: "Represents a list of stickers",
+		Fields: []ParamSchema{
+			{Name: "stickers", Type: "vector<sticker>", Description: "List of stickers"},
+		},
+	},
+	{
+		Name:        "storageStatistics",
+		Class:       "StorageStatistics",
+		Description: "Contains the exact storage usage statistics split by chats and file type",
+		Fields: []ParamSchema{
+			{Name: "size", Type: "int53", Description: "Total size of files, in bytes"},
+			{Name: "count", Type: "int32", Description: "Total number of files"},
+			{Name: "by_chat", Type: "vector<storageStatisticsByChat>", Description: "Statistics split by chats"},
+		},
+	},
+	{
+		Name:        "storageStatisticsByChat",
+		Class:       "StorageStatisticsByChat",
+		Description: "Contains the storage usage statistics for a specific chat",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier; 0 if none"},
+			{Name: "size", Type: "int53", Description: "Total size of the files in the chat, in bytes"},
+			{Name: "count", Type: "int32", Description: "Total number of files in the chat"},
+			{Name: "by_file_type", Type: "vector<storageStatisticsByFileType>", Description: "Statistics split by file types"},
+		},
+	},
+	{
+		Name:        "storageStatisticsByFileType",
+		Class:       "StorageStatisticsByFileType",
+		Description: "Contains the storage usage statistics for a specific file type",
+		Fields: []ParamSchema{
+			{Name: "file_type", Type: "FileType", Description: "File type"},
+			{Name: "size", Type: "int53", Description: "Total size of the files, in bytes"},
+			{Name: "count", Type: "int32", Description: "Total number of files"},
+		},
+	},
+	{
+		Name:        "storageStatisticsFast",
+		Class:       "StorageStatisticsFast",
+		Description: "Contains approximate storage usage statistics, excluding files of unknown file type",
+		Fields: []ParamSchema{
+			{Name: "files_size", Type: "int53", Description: "Approximate total size of files, in bytes"},
+			{Name: "file_count", Type: "int32", Description: "Approximate number of files"},
+			{Name: "database_size", Type: "int53", Description: "Size of the database"},
+			{Name: "language_pack_database_size", Type: "int53", Description: "Size of the language pack database"},
+			{Name: "log_size", Type: "int53", Description: "Size of the TDLib internal log"},
+		},
+	},
+	{
+		Name:        "suggestedActionEnableArchiveAndMuteNewChats",
+		Class:       "SuggestedAction",
+		Description: "Suggests the user to enable \"archive_and_mute_new_chats_from_unknown_users\" option",
+	},
+	{
+		Name:        "suggestedActionCheckPassword",
+		Class:       "SuggestedAction",
+		Description: "Suggests the user to check whether they still remember their 2-step verification password",
+	},
+	{
+		Name:        "suggestedActionCheckPhoneNumber",
+		Class:       "SuggestedAction",
+		Description: "Suggests the user to check whether authorization phone number is correct and change the phone number if it is inaccessible",
+	},
+	{
+		Name:        "suggestedActionViewChecksHint",
+		Class:       "SuggestedAction",
+		Description: "Suggests the user to view a hint about the meaning of one and two check marks on sent messages",
+	},
+	{
+		Name:        "suggestedActionConvertToBroadcastGroup",
+		Class:       "SuggestedAction",
+		Description: "Suggests the user to convert specified supergroup to a broadcast group",
+		Fields: []ParamSchema{
+			{Name: "supergroup_id", Type: "int53", Description: "Supergroup identifier"},
+		},
+	},
+	{
+		Name:        "suggestedActionSetPassword",
+		Class:       "SuggestedAction",
+		Description: "Suggests the user to set a 2-step verification password to be able to log in again",
+		Fields: []ParamSchema{
+			{Name: "authorization_delay", Type: "int32", Description: "The number of days to pass between consecutive authorizations if the user declines to set password"},
+		},
+	},
+	{
+		Name:        "supergroup",
+		Class:       "Supergroup",
+		Description: "Represents a supergroup or channel with zero or more members (subscribers in the case of channels). From the point of view of the system, a channel is a special kind of a supergroup: only administrators can post and see the list of members, and posts from all administrators use the name and photo of the channel instead of individual names and profile photos. Unlike supergroups, channels can have an unlimited number of subscribers",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int53", Description: "Supergroup or channel identifier"},
+			{Name: "access_hash", Type: "int64", Description: "Supergroup or channel access hash"},
+			{Name: "username", Type: "string", Description: "Username of the supergroup or channel; empty for private supergroups or channels"},
+			{Name: "date", Type: "int32", Description: "Point in time (Unix timestamp) when the current user joined, or the point in time when the supergroup or channel was created, in case the user is not a member"},
+			{Name: "status", Type: "ChatMemberStatus", Description: "Status of the current user in the supergroup or channel; custom title will be always empty"},
+			{Name: "member_count", Type: "int32", Description: "Number of members in the supergroup or channel; 0 if unknown. Currently, it is guaranteed to be known only if the supergroup or channel was received through searchPublicChats, searchChatsNearby, getInactiveSupergroupChats, getSuitableDiscussionChats, getGroupsInCommon, or getUserPrivacySettingRules"},
+			{Name: "has_linked_chat", Type: "Bool", Description: "True, if the channel has a discussion group, or the supergroup is the designated discussion group for a channel"},
+			{Name: "has_location", Type: "Bool", Description: "True, if the supergroup is connected to a location, i.e. the supergroup is a location-based supergroup"},
+			{Name: "sign_messages", Type: "Bool", Description: "True, if messages sent to the channel need to contain information about the sender. This field is only applicable to channels"},
+			{Name: "is_slow_mode_enabled", Type: "Bool", Description: "True, if the slow mode is enabled in the supergroup"},
+			{Name: "is_channel", Type: "Bool", Description: "True, if the supergroup is a channel"},
+			{Name: "is_broadcast_group", Type: "Bool", Description: "True, if the supergroup is a broadcast group, i.e. only administrators can send messages and there is no limit on the number of members"},
+			{Name: "is_verified", Type: "Bool", Description: "True, if the supergroup or channel is verified"},
+			{Name: "restriction_reason", Type: "string", Description: "If non-empty, contains a human-readable description of the reason why access to this supergroup or channel must be restricted"},
+			{Name: "is_scam", Type: "Bool", Description: "True, if many users reported this supergroup or channel as a scam"},
+			{Name: "is_fake", Type: "Bool", Description: "True, if many users reported this supergroup or channel as a fake account"},
+		},
+	},
+	{
+		Name:        "supergroupFullInfo",
+		Class:       "SupergroupFullInfo",
+		Description: "Contains full information about a supergroup or channel",
+		Fields: []ParamSchema{
+			{Name: "photo", Type: "chatPhoto", Description: "Chat photo; may be null"},
+			{Name: "description", Type: "string", Description: "Supergroup or channel description"},
+			{Name: "member_count", Type: "int32", Description: "Number of members in the supergroup or channel; 0 if unknown"},
+			{Name: "administrator_count", Type: "int32", Description: "Number of privileged users in the supergroup or channel; 0 if unknown"},
+			{Name: "restricted_count", Type: "int32", Description: "Number of restricted users in the supergroup; 0 if unknown"},
+			{Name: "banned_count", Type: "int32", Description: "Number of users banned from chat; 0 if unknown"},
+			{Name: "linked_chat_id", Type: "int53", Description: "Chat identifier of a discussion group for the channel, or a channel, for which the supergroup is the designated discussion group; 0 if none or unknown"},
+			{Name: "slow_mode_delay", Type: "int32", Description: "Delay between consecutive sent messages for non-administrator supergroup members, in seconds"},
+			{Name: "slow_mode_delay_expires_in", Type: "double", Description: "Time left before next message can be sent in the supergroup, in seconds. An updateSupergroupFullInfo update is not triggered when value of this field changes, but both new and old values are non-zero"},
+			{Name: "can_get_members", Type: "Bool", Description: "True, if members of the chat can be retrieved"},
+			{Name: "can_set_username", Type: "Bool", Description: "True, if the chat username can be changed"},
+			{Name: "can_set_sticker_set", Type: "Bool", Description: "True, if the supergroup sticker set can be changed"},
+			{Name: "can_set_location", Type: "Bool", Description: "True, if the supergroup location can be changed"},
+			{Name: "can_get_statistics", Type: "Bool", Description: "True, if the supergroup or channel statistics are available"},
+			{Name: "is_all_history_available", Type: "Bool", Description: "True, if new chat members will have access to old messages. In public or discussion groups and both public and private channels, old messages are always available, so this option affects only private supergroups without a linked chat. The value of this field is only available for chat administrators"},
+			{Name: "sticker_set_id", Type: "int64", Description: "Identifier of the supergroup sticker set; 0 if none"},
+			{Name: "location", Type: "chatLocation", Description: "Location to which the supergroup is connected; may be null"},
+			{Name: "invite_link", Type: "chatInviteLink", Description: "Primary invite link for this chat; may be null. For chat administrators with can_invite_users right only"},
+			{Name: "bot_commands", Type: "vector<botCommands>", Description: "List of commands of bots in the group"},
+			{Name: "upgraded_from_basic_group_id", Type: "int53", Description: "Identifier of the basic group from which supergroup was upgraded; 0 if none"},
+			{Name: "upgraded_from_max_message_id", Type: "int53", Description: "Identifier of the last message in the basic group from which supergroup was upgraded; 0 if none"},
+		},
+	},
+	{
+		Name:        "supergroupMembersFilterRecent",
+		Class:       "SupergroupMembersFilter",
+		Description: "Returns recently active users in reverse chronological order",
+	},
+	{
+		Name:        "supergroupMembersFilterContacts",
+		Class:       "SupergroupMembersFilter",
+		Description: "Returns contacts of the user, which are members of the supergroup or channel",
+		Fields: []ParamSchema{
+			{Name: "query", Type: "string", Description: "Query to search for"},
+		},
+	},
+	{
+		Name:        "supergroupMembersFilterAdministrators",
+		Class:       "SupergroupMembersFilter",
+		Description: "Returns the owner and administrators",
+	},
+	{
+		Name:        "supergroupMembersFilterSearch",
+		Class:       "SupergroupMembersFilter",
+		Description: "Used to search for supergroup or channel members via a (string) query",
+		Fields: []ParamSchema{
+			{Name: "query", Type: "string", Description: "Query to search for"},
+		},
+	},
+	{
+		Name:        "supergroupMembersFilterRestricted",
+		Class:       "SupergroupMembersFilter",
+		Description: "Returns restricted supergroup members; can be used only by administrators",
+		Fields: []ParamSchema{
+			{Name: "query", Type: "string", Description: "Query to search for"},
+		},
+	},
+	{
+		Name:        "supergroupMembersFilterBanned",
+		Class:       "SupergroupMembersFilter",
+		Description: "Returns users banned from the supergroup or channel; can be used only by administrators",
+		Fields: []ParamSchema{
+			{Name: "query", Type: "string", Description: "Query to search for"},
+		},
+	},
+	{
+		Name:        "supergroupMembersFilterMention",
+		Class:       "SupergroupMembersFilter",
+		Description: "Returns users which can be mentioned in the supergroup",
+		Fields: []ParamSchema{
+			{Name: "query", Type: "string", Description: "Query to search for"},
+			{Name: "message_thread_id", Type: "int53", Description: "If non-zero, the identifier of the current message thread"},
+		},
+	},
+	{
+		Name:        "supergroupMembersFilterBots",
+		Class:       "SupergroupMembersFilter",
+		Description: "Returns bot members of the supergroup or channel",
+	},
+	{
+		Name:        "tMeUrl",
+		Class:       "TMeUrl",
+		Description: "Represents a URL linking to an internal Telegram entity",
+		Fields: []ParamSchema{
+			{Name: "url", Type: "string", Description: "URL"},
+			{Name: "type", Type: "TMeUrlType", Description: "Type of the URL"},
+		},
+	},
+	{
+		Name:        "tMeUrlTypeUser",
+		Class:       "TMeUrlType",
+		Description: "A URL linking to a user",
+		Fields: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "Identifier of the user"},
+		},
+	},
+	{
+		Name:        "tMeUrlTypeSupergroup",
+		Class:       "TMeUrlType",
+		Description: "A URL linking to a public supergroup or channel",
+		Fields: []ParamSchema{
+			{Name: "supergroup_id", Type: "int53", Description: "Identifier of the supergroup or channel"},
+		},
+	},
+	{
+		Name:        "tMeUrlTypeChatInvite",
+		Class:       "TMeUrlType",
+		Description: "A chat invite link",
+		Fields: []ParamSchema{
+			{Name: "info", Type: "chatInviteLinkInfo", Description: "Chat invite link info"},
+		},
+	},
+	{
+		Name:        "tMeUrlTypeStickerSet",
+		Class:       "TMeUrlType",
+		Description: "A URL linking to a sticker set",
+		Fields: []ParamSchema{
+			{Name: "sticker_set_id", Type: "int64", Description: "Identifier of the sticker set"},
+		},
+	},
+	{
+		Name:        "tMeUrls",
+		Class:       "TMeUrls",
+		Description: "Contains a list of t.me URLs",
+		Fields: []ParamSchema{
+			{Name: "urls", Type: "vector<tMeUrl>", Description: "List of URLs"},
+		},
+	},
+	{
+		Name:        "tdlibParameters",
+		Class:       "TdlibParameters",
+		Description: "Contains parameters for TDLib initialization",
+		Fields: []ParamSchema{
+			{Name: "use_test_dc", Type: "Bool", Description: "If set to true, the Telegram test environment will be used instead of the production environment"},
+			{Name: "database_directory", Type: "string", Description: "The path to the directory for the persistent database; if empty, the current working directory will be used"},
+			{Name: "files_directory", Type: "string", Description: "The path to the directory for storing files; if empty, database_directory will be used"},
+			{Name: "use_file_database", Type: "Bool", Description: "If set to true, information about downloaded and uploaded files will be saved between application restarts"},
+			{Name: "use_chat_info_database", Type: "Bool", Description: "If set to true, the library will maintain a cache of users, basic groups, supergroups, channels and secret chats. Implies use_file_database"},
+			{Name: "use_message_database", Type: "Bool", Description: "If set to true, the library will maintain a cache of chats and messages. Implies use_chat_info_database"},
+			{Name: "use_secret_chats", Type: "Bool", Description: "If set to true, support for secret chats will be enabled"},
+			{Name: "api_id", Type: "int32", Description: "Application identifier for Telegram API access, which can be obtained at https://my.telegram.org"},
+			{Name: "api_hash", Type: "string", Description: "Application identifier hash for Telegram API access, which can be obtained at https://my.telegram.org"},
+			{Name: "system_language_code", Type: "string", Description: "IETF language tag of the user's operating system language; must be non-empty"},
+			{Name: "device_model", Type: "string", Description: "Model of the device the application is being run on; must be non-empty"},
+			{Name: "system_version", Type: "string", Description: "Version of the operating system the application is being run on. If empty, the version is automatically detected by TDLib"},
+			{Name: "application_version", Type: "string", Description: "Application version; must be non-empty"},
+			{Name: "enable_storage_optimizer", Type: "Bool", Description: "If set to true, old files will automatically be deleted"},
+			{Name: "ignore_file_names", Type: "Bool", Description: "If set to true, original file names will be ignored. Otherwise, downloaded files will be saved under names as close as possible to the original name"},
+		},
+	},
+	{
+		Name:        "temporaryPasswordState",
+		Class:       "TemporaryPasswordState",
+		Description: "Returns information about the availability of a temporary password, which can be used for payments",
+		Fields: []ParamSchema{
+			{Name: "has_password", Type: "Bool", Description: "True, if a temporary password is available"},
+			{Name: "valid_for", Type: "int32", Description: "Time left before the temporary password expires, in seconds"},
+		},
+	},
+	{
+		Name:        "termsOfService",
+		Class:       "TermsOfService",
+		Description: "Contains Telegram terms of service",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "formattedText", Description: "Text of the terms of service"},
+			{Name: "min_user_age", Type: "int32", Description: "The minimum age of a user to be able to accept the terms; 0 if any"},
+			{Name: "show_popup", Type: "Bool", Description: "True, if a blocking popup with terms of service must be shown to the user"},
+		},
+	},
+	{
+		Name:        "testBytes",
+		Class:       "TestBytes",
+		Description: "A simple object containing a sequence of bytes; for testing only",
+		Fields: []ParamSchema{
+			{Name: "value", Type: "bytes", Description: "Bytes"},
+		},
+	},
+	{
+		Name:        "testInt",
+		Class:       "TestInt",
+		Description: "A simple object containing a number; for testing only",
+		Fields: []ParamSchema{
+			{Name: "value", Type: "int32", Description: "Number"},
+		},
+	},
+	{
+		Name:        "testString",
+		Class:       "TestString",
+		Description: "A simple object containing a string; for testing only",
+		Fields: []ParamSchema{
+			{Name: "value", Type: "string", Description: "String"},
+		},
+	},
+	{
+		Name:        "testVectorInt",
+		Class:       "TestVectorInt",
+		Description: "A simple object containing a vector of numbers; for testing only",
+		Fields: []ParamSchema{
+			{Name: "value", Type: "vector<int32>", Description: "Vector of numbers"},
+		},
+	},
+	{
+		Name:        "testVectorIntObject",
+		Class:       "TestVectorIntObject",
+		Description: "A simple object containing a vector of objects that hold a number; for testing only",
+		Fields: []ParamSchema{
+			{Name: "value", Type: "vector<testInt>", Description: "Vector of objects"},
+		},
+	},
+	{
+		Name:        "testVectorString",
+		Class:       "TestVectorString",
+		Description: "A simple object containing a vector of strings; for testing only",
+		Fields: []ParamSchema{
+			{Name: "value", Type: "vector<string>", Description: "Vector of strings"},
+		},
+	},
+	{
+		Name:        "testVectorStringObject",
+		Class:       "TestVectorStringObject",
+		Description: "A simple object containing a vector of objects that hold a string; for testing only",
+		Fields: []ParamSchema{
+			{Name: "value", Type: "vector<testString>", Description: "Vector of objects"},
+		},
+	},
+	{
+		Name:        "text",
+		Class:       "Text",
+		Description: "Contains some text",
+		Fields: []ParamSchema{
+			{Name: "text", Type: "string", Description: "Text"},
+		},
+	},
+	{
+		Name:        "textEntities",
+		Class:       "TextEntities",
+		Description: "Contains a list of text entities",
+		Fields: []ParamSchema{
+			{Name: "entities", Type: "vector<textEntity>", Description: "List of text entities"},
+		},
+	},
+	{
+		Name:        "textEntity",
+		Class:       "TextEntity",
+		Description: "Represents a part of the text that needs to be formatted in some unusual way",
+		Fields: []ParamSchema{
+			{Name: "offset", Type: "int32", Description: "Offset of the entity, in UTF-16 code units"},
+			{Name: "length", Type: "int32", Description: "Length of the entity, in UTF-16 code units"},
+			{Name: "type", Type: "TextEntityType", Description: "Type of the entity"},
+		},
+	},
+	{
+		Name:        "textEntityTypeMention",
+		Class:       "TextEntityType",
+		Description: "A mention of a user by their username",
+	},
+	{
+		Name:        "textEntityTypeHashtag",
+		Class:       "TextEntityType",
+		Description: "A hashtag text, beginning with \"#\"",
+	},
+	{
+		Name:        "textEntityTypeCashtag",
+		Class:       "TextEntityType",
+		Description: "A cashtag text, beginning with \"$\" and consisting of capital English letters (e.g., \"$USD\")",
+	},
+	{
+		Name:        "textEntityTypeBotCommand",
+		Class:       "TextEntityType",
+		Description: "A bot command, beginning with \"/\"",
+	},
+	{
+		Name:        "textEntityTypeUrl",
+		Class:       "TextEntityType",
+		Description: "An HTTP URL",
+	},
+	{
+		Name:        "textEntityTypeEmailAddress",
+		Class:       "TextEntityType",
+		Description: "An email address",
+	},
+	{
+		Name:        "textEntityTypePhoneNumber",
+		Class:       "TextEntityType",
+		Description: "A phone number",
+	},
+	{
+		Name:        "textEntityTypeBankCardNumber",
+		Class:       "TextEntityType",
+		Description: "A bank card number. The getBankCardInfo method can be used to get information about the bank card",
+	},
+	{
+		Name:        "textEntityTypeBold",
+		Class:       "TextEntityType",
+		Description: "A bold text",
+	},
+	{
+		Name:        "textEntityTypeItalic",
+		Class:       "TextEntityType",
+		Description: "An italic text",
+	},
+	{
+		Name:        "textEntityTypeUnderline",
+		Class:       "TextEntityType",
+		Description: "An underlined text",
+	},
+	{
+		Name:        "textEntityTypeStrikethrough",
+		Class:       "TextEntityType",
+		Description: "A strikethrough text",
+	},
+	{
+		Name:        "textEntityTypeSpoiler",
+		Class:       "TextEntityType",
+		Description: "A spoiler text. Not supported in secret chats",
+	},
+	{
+		Name:        "textEntityTypeCode",
+		Class:       "TextEntityType",
+		Description: "Text that must be formatted as if inside a code HTML tag",
+	},
+	{
+		Name:        "textEntityTypePre",
+		Class:       "TextEntityType",
+		Description: "Text that must be formatted as if inside a pre HTML tag",
+	},
+	{
+		Name:        "textEntityTypePreCode",
+		Class:       "TextEntityType",
+		Description: "Text that must be formatted as if inside pre, and code HTML tags",
+		Fields: []ParamSchema{
+			{Name: "language", Type: "string", Description: "Programming language of the code; as defined by the sender"},
+		},
+	},
+	{
+		Name:        "textEntityTypeTextUrl",
+		Class:       "TextEntityType",
+		Description: "A text description shown instead of a raw URL",
+		Fields: []ParamSchema{
+			{Name: "url", Type: "string", Description: "HTTP or tg:// URL to be opened when the link is clicked"},
+		},
+	},
+	{
+		Name:        "textEntityTypeMentionName",
+		Class:       "TextEntityType",
+		Description: "A text shows instead of a raw mention of the user (e.g., when the user has no username)",
+		Fields: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "Identifier of the mentioned user"},
+		},
+	},
+	{
+		Name:        "textEntityTypeMediaTimestamp",
+		Class:       "TextEntityType",
+		Description: "A media timestamp",
+		Fields: []ParamSchema{
+			{Name: "media_timestamp", Type: "int32", Description: "Timestamp from which a video/audio/video note/voice note playing must start, in seconds. The media can be in the content or the web page preview of the current message, or in the same places in the replied message"},
+		},
+	},
+	{
+		Name:        "textParseModeMarkdown",
+		Class:       "TextParseMode",
+		Description: "The text uses Markdown-style formatting",
+		Fields: []ParamSchema{
+			{Name: "version", Type: "int32", Description: "Version of the parser: 0 or 1 - Telegram Bot API \"Markdown\" parse mode, 2 - Telegram Bot API \"MarkdownV2\" parse mode"},
+		},
+	},
+	{
+		Name:        "textParseModeHTML",
+		Class:       "TextParseMode",
+		Description: "The text uses HTML-style formatting. The same as Telegram Bot API \"HTML\" parse mode",
+	},
+	{
+		Name:        "themeSettings",
+		Class:       "ThemeSettings",
+		Description: "Describes theme settings",
+		Fields: []ParamSchema{
+			{Name: "accent_color", Type: "int32", Description: "Theme accent color in ARGB format"},
+			{Name: "background", Type: "background", Description: "The background to be used in chats; may be null"},
+			{Name: "outgoing_message_fill", Type: "BackgroundFill", Description: "The fill to be used as a background for outgoing messages"},
+			{Name: "animate_outgoing_message_fill", Type: "Bool", Description: "If true, the freeform gradient fill needs to be animated on every sent message"},
+			{Name: "outgoing_message_accent_color", Type: "int32", Description: "Accent color of outgoing messages in ARGB format"},
+		},
+	},
+	{
+		Name:        "thumbnail",
+		Class:       "Thumbnail",
+		Description: "Represents a thumbnail",
+		Fields: []ParamSchema{
+			{Name: "format", Type: "ThumbnailFormat", Description: "Thumbnail format"},
+			{Name: "width", Type: "int32", Description: "Thumbnail width"},
+			{Name: "height", Type: "int32", Description: "Thumbnail height"},
+			{Name: "file", Type: "file", Description: "The thumbnail"},
+		},
+	},
+	{
+		Name:        "thumbnailFormatJpeg",
+		Class:       "ThumbnailFormat",
+		Description: "The thumbnail is in JPEG format",
+	},
+	{
+		Name:        "thumbnailFormatPng",
+		Class:       "ThumbnailFormat",
+		Description: "The thumbnail is in PNG format. It will be used only for background patterns",
+	},
+	{
+		Name:        "thumbnailFormatWebp",
+		Class:       "ThumbnailFormat",
+		Description: "The thumbnail is in WEBP format. It will be used only for some stickers",
+	},
+	{
+		Name:        "thumbnailFormatGif",
+		Class:       "ThumbnailFormat",
+		Description: "The thumbnail is in static GIF format. It will be used only for some bot inline results",
+	},
+	{
+		Name:        "thumbnailFormatTgs",
+		Class:       "ThumbnailFormat",
+		Description: "The thumbnail is in TGS format. It will be used only for animated sticker sets",
+	},
+	{
+		Name:        "thumbnailFormatMpeg4",
+		Class:       "ThumbnailFormat",
+		Description: "The thumbnail is in MPEG4 format. It will be used only for some animations and videos",
+	},
+	{
+		Name:        "topChatCategoryUsers",
+		Class:       "TopChatCategory",
+		Description: "A category containing frequently used private chats with non-bot users",
+	},
+	{
+		Name:        "topChatCategoryBots",
+		Class:       "TopChatCategory",
+		Description: "A category containing frequently used private chats with bot users",
+	},
+	{
+		Name:        "topChatCategoryGroups",
+		Class:       "TopChatCategory",
+		Description: "A category containing frequently used basic groups and supergroups",
+	},
+	{
+		Name:        "topChatCategoryChannels",
+		Class:       "TopChatCategory",
+		Description: "A category containing frequently used channels",
+	},
+	{
+		Name:        "topChatCategoryInlineBots",
+		Class:       "TopChatCategory",
+		Description: "A category containing frequently used chats with inline bots sorted by their usage in inline mode",
+	},
+	{
+		Name:        "topChatCategoryCalls",
+		Class:       "TopChatCategory",
+		Description: "A category containing frequently used chats used for calls",
+	},
+	{
+		Name:        "topChatCategoryForwardChats",
+		Class:       "TopChatCategory",
+		Description: "A category containing frequently used chats used to forward messages",
+	},
+	{
+		Name:        "updateAuthorizationState",
+		Class:       "Update",
+		Description: "The user authorization state has changed",
+		Fields: []ParamSchema{
+			{Name: "authorization_state", Type: "AuthorizationState", Description: "New authorization state"},
+		},
+	},
+	{
+		Name:        "updateNewMessage",
+		Class:       "Update",
+		Description: "A new message was received; can also be an outgoing message",
+		Fields: []ParamSchema{
+			{Name: "message", Type: "message", Description: "The new message"},
+		},
+	},
+	{
+		Name:        "updateMessageSendAcknowledged",
+		Class:       "Update",
+		Description: "A request to send a message has reached the Telegram server. This doesn't mean that the message will be sent successfully or even that the send message request will be processed. This update will be sent only if the option \"use_quick_ack\" is set to true. This update may be sent multiple times for the same message",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "The chat identifier of the sent message"},
+			{Name: "message_id", Type: "int53", Description: "A temporary message identifier"},
+		},
+	},
+	{
+		Name:        "updateMessageSendSucceeded",
+		Class:       "Update",
+		Description: "A message has been successfully sent",
+		Fields: []ParamSchema{
+			{Name: "message", Type: "message", Description: "The sent message. Usually only the message identifier, date, and content are changed, but almost all other fields can also change"},
+			{Name: "old_message_id", Type: "int53", Description: "The previous temporary message identifier"},
+		},
+	},
+	{
+		Name:        "updateMessageSendFailed",
+		Class:       "Update",
+		Description: "A message failed to send. Be aware that some messages being sent can be irrecoverably deleted, in which case updateDeleteMessages will be received instead of this update",
+		Fields: []ParamSchema{
+			{Name: "message", Type: "message", Description: "The failed to send message"},
+			{Name: "old_message_id", Type: "int53", Description: "The previous temporary message identifier"},
+			{Name: "error_code", Type: "int32", Description: "An error code"},
+			{Name: "error_message", Type: "string", Description: "Error message"},
+		},
+	},
+	{
+		Name:        "updateMessageContent",
+		Class:       "Update",
+		Description: "The message content has changed",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "message_id", Type: "int53", Description: "Message identifier"},
+			{Name: "new_content", Type: "MessageContent", Description: "New message content"},
+		},
+	},
+	{
+		Name:        "updateMessageEdited",
+		Class:       "Update",
+		Description: "A message was edited. Changes in the message content will come in a separate updateMessageContent",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "message_id", Type: "int53", Description: "Message identifier"},
+			{Name: "edit_date", Type: "int32", Description: "Point in time (Unix timestamp) when the message was edited"},
+			{Name: "reply_markup", Type: "ReplyMarkup", Description: "New message reply markup; may be null"},
+		},
+	},
+	{
+		Name:        "updateMessageIsPinned",
+		Class:       "Update",
+		Description: "The message pinned state was changed",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "message_id", Type: "int53", Description: "The message identifier"},
+			{Name: "is_pinned", Type: "Bool", Description: "True, if the message is pinned"},
+		},
+	},
+	{
+		Name:        "updateMessageInteractionInfo",
+		Class:       "Update",
+		Description: "The information about interactions with a message has changed",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "message_id", Type: "int53", Description: "Message identifier"},
+			{Name: "interaction_info", Type: "messageInteractionInfo", Description: "New information about interactions with the message; may be null"},
+		},
+	},
+	{
+		Name:        "updateMessageContentOpened",
+		Class:       "Update",
+		Description: "The message content was opened. Updates voice note messages to \"listened\", video note messages to \"viewed\" and starts the TTL timer for self-destructing messages",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "message_id", Type: "int53", Description: "Message identifier"},
+		},
+	},
+	{
+		Name:        "updateMessageMentionRead",
+		Class:       "Update",
+		Description: "A message with an unread mention was read",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "message_id", Type: "int53", Description: "Message identifier"},
+			{Name: "unread_mention_count", Type: "int32", Description: "The new number of unread mention messages left in the chat"},
+		},
+	},
+	{
+		Name:        "updateMessageLiveLocationViewed",
+		Class:       "Update",
+		Description: "A message with a live location was viewed. When the update is received, the application is supposed to update the live location",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat with the live location message"},
+			{Name: "message_id", Type: "int53", Description: "Identifier of the message with live location"},
+		},
+	},
+	{
+		Name:        "updateNewChat",
+		Class:       "Update",
+		Description: "A new chat has been loaded/created. This update is guaranteed to come before the chat identifier is returned to the application. The chat field changes will be reported through separate updates",
+		Fields: []ParamSchema{
+			{Name: "chat", Type: "chat", Description: "The chat"},
+		},
+	},
+	{
+		Name:        "updateChatTitle",
+		Class:       "Update",
+		Description: "The title of a chat was changed",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "title", Type: "string", Description: "The new chat title"},
+		},
+	},
+	{
+		Name:        "updateChatPhoto",
+		Class:       "Update",
+		Description: "A chat photo was changed",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "photo", Type: "chatPhotoInfo", Description: "The new chat photo; may be null"},
+		},
+	},
+	{
+		Name:        "updateChatPermissions",
+		Class:       "Update",
+		Description: "Chat permissions was changed",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "permissions", Type: "chatPermissions", Description: "The new chat permissions"},
+		},
+	},
+	{
+		Name:        "updateChatLastMessage",
+		Class:       "Update",
+		Description: "The last message of a chat was changed. If last_message is null, then the last message in the chat became unknown. Some new unknown messages might be added to the chat in this case",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "last_message", Type: "message", Description: "The new last message in the chat; may be null"},
+			{Name: "positions", Type: "vector<chatPosition>", Description: "The new chat positions in the chat lists"},
+		},
+	},
+	{
+		Name:        "updateChatPosition",
+		Class:       "Update",
+		Description: "The position of a chat in a chat list has changed. Instead of this update updateChatLastMessage or updateChatDraftMessage might be sent",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "position", Type: "chatPosition", Description: "New chat position. If new order is 0, then the chat needs to be removed from the list"},
+		},
+	},
+	{
+		Name:        "updateChatReadInbox",
+		Class:       "Update",
+		Description: "Incoming messages were read or the number of unread messages has been changed",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "last_read_inbox_message_id", Type: "int53", Description: "Identifier of the last read incoming message"},
+			{Name: "unread_count", Type: "int32", Description: "The number of unread messages left in the chat"},
+		},
+	},
+	{
+		Name:        "updateChatReadOutbox",
+		Class:       "Update",
+		Description: "Outgoing messages were read",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "last_read_outbox_message_id", Type: "int53", Description: "Identifier of last read outgoing message"},
+		},
+	},
+	{
+		Name:        "updateChatActionBar",
+		Class:       "Update",
+		Description: "The chat action bar was changed",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "action_bar", Type: "ChatActionBar", Description: "The new value of the action bar; may be null"},
+		},
+	},
+	{
+		Name:        "updateChatDraftMessage",
+		Class:       "Update",
+		Description: "A chat draft has changed. Be aware that the update may come in the currently opened chat but with old content of the draft. If the user has changed the content of the draft, this update mustn't be applied",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "draft_message", Type: "draftMessage", Description: "The new draft message; may be null"},
+			{Name: "positions", Type: "vector<chatPosition>", Description: "The new chat positions in the chat lists"},
+		},
+	},
+	{
+		Name:        "updateChatMessageSender",
+		Class:       "Update",
+		Description: "The message sender that is selected to send messages in a chat has changed",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "message_sender_id", Type: "MessageSender", Description: "New value of message_sender_id; may be null if the user can't change message sender"},
+		},
+	},
+	{
+		Name:        "updateChatMessageTtl",
+		Class:       "Update",
+		Description: "The message Time To Live setting for a chat was changed",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "message_ttl", Type: "int32", Description: "New value of message_ttl"},
+		},
+	},
+	{
+		Name:        "updateChatNotificationSettings",
+		Class:       "Update",
+		Description: "Notification settings for a chat were changed",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "notification_settings", Type: "chatNotificationSettings", Description: "The new notification settings"},
+		},
+	},
+	{
+		Name:        "updateChatPendingJoinRequests",
+		Class:       "Update",
+		Description: "The chat pending join requests were changed",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "pending_join_requests", Type: "chatJoinRequestsInfo", Description: "The new data about pending join requests; may be null"},
+		},
+	},
+	{
+		Name:        "updateChatReplyMarkup",
+		Class:       "Update",
+		Description: "The default chat reply markup was changed. Can occur because new messages with reply markup were received or because an old reply markup was hidden by the user",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "reply_markup_message_id", Type: "int53", Description: "Identifier of the message from which reply markup needs to be used; 0 if there is no default custom reply markup in the chat"},
+		},
+	},
+	{
+		Name:        "updateChatTheme",
+		Class:       "Update",
+		Description: "The chat theme was changed",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "theme_name", Type: "string", Description: "The new name of the chat theme; may be empty if theme was reset to default"},
+		},
+	},
+	{
+		Name:        "updateChatUnreadMentionCount",
+		Class:       "Update",
+		Description: "The chat unread_mention_count has changed",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "unread_mention_count", Type: "int32", Description: "The number of unread mention messages left in the chat"},
+		},
+	},
+	{
+		Name:        "updateChatVideoChat",
+		Class:       "Update",
+		Description: "A chat video chat state has changed",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "video_chat", Type: "videoChat", Description: "New value of video_chat"},
+		},
+	},
+	{
+		Name:        "updateChatDefaultDisableNotification",
+		Class:       "Update",
+		Description: "The value of the default disable_notification parameter, used when a message is sent to the chat, was changed",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "default_disable_notification", Type: "Bool", Description: "The new default_disable_notification value"},
+		},
+	},
+	{
+		Name:        "updateChatHasProtectedContent",
+		Class:       "Update",
+		Description: "A chat content was allowed or restricted for saving",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "has_protected_content", Type: "Bool", Description: "New value of has_protected_content"},
+		},
+	},
+	{
+		Name:        "updateChatHasScheduledMessages",
+		Class:       "Update",
+		Description: "A chat's has_scheduled_messages field has changed",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "has_scheduled_messages", Type: "Bool", Description: "New value of has_scheduled_messages"},
+		},
+	},
+	{
+		Name:        "updateChatIsBlocked",
+		Class:       "Update",
+		Description: "A chat was blocked or unblocked",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "is_blocked", Type: "Bool", Description: "New value of is_blocked"},
+		},
+	},
+	{
+		Name:        "updateChatIsMarkedAsUnread",
+		Class:       "Update",
+		Description: "A chat was marked as unread or was read",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "is_marked_as_unread", Type: "Bool", Description: "New value of is_marked_as_unread"},
+		},
+	},
+	{
+		Name:        "updateChatFilters",
+		Class:       "Update",
+		Description: "The list of chat filters or a chat filter has changed",
+		Fields: []ParamSchema{
+			{Name: "chat_filters", Type: "vector<chatFilterInfo>", Description: "The new list of chat filters"},
+		},
+	},
+	{
+		Name:        "updateChatOnlineMemberCount",
+		Class:       "Update",
+		Description: "The number of online group members has changed. This update with non-zero count is sent only for currently opened chats. There is no guarantee that it will be sent just after the count has changed",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat"},
+			{Name: "online_member_count", Type: "int32", Description: "New number of online members in the chat, or 0 if unknown"},
+		},
+	},
+	{
+		Name:        "updateScopeNotificationSettings",
+		Class:       "Update",
+		Description: "Notification settings for some type of chats were updated",
+		Fields: []ParamSchema{
+			{Name: "scope", Type: "NotificationSettingsScope", Description: "Types of chats for which notification settings were updated"},
+			{Name: "notification_settings", Type: "scopeNotificationSettings", Description: "The new notification settings"},
+		},
+	},
+	{
+		Name:        "updateNotification",
+		Class:       "Update",
+		Description: "A notification was changed",
+		Fields: []ParamSchema{
+			{Name: "notification_group_id", Type: "int32", Description: "Unique notification group identifier"},
+			{Name: "notification", Type: "notification", Description: "Changed notification"},
+		},
+	},
+	{
+		Name:        "updateNotificationGroup",
+		Class:       "Update",
+		Description: "A list of active notifications in a notification group has changed",
+		Fields: []ParamSchema{
+			{Name: "notification_group_id", Type: "int32", Description: "Unique notification group identifier"},
+			{Name: "type", Type: "NotificationGroupType", Description: "New type of the notification group"},
+			{Name: "chat_id", Type: "int53", Description: "Identifier of a chat to which all notifications in the group belong"},
+			{Name: "notification_settings_chat_id", Type: "int53", Description: "Chat identifier, which notification settings must be applied to the added notifications"},
+			{Name: "is_silent", Type: "Bool", Description: "True, if the notifications must be shown without sound"},
+			{Name: "total_count", Type: "int32", Description: "Total number of unread notifications in the group, can be bigger than number of active notifications"},
+			{Name: "added_notifications", Type: "vector<notification>", Description: "List of added group notifications, sorted by notification ID"},
+			{Name: "removed_notification_ids", Type: "vector<int32>", Description: "Identifiers of removed group notifications, sorted by notification ID"},
+		},
+	},
+	{
+		Name:        "updateActiveNotifications",
+		Class:       "Update",
+		Description: "Contains active notifications that was shown on previous application launches. This update is sent only if the message database is used. In that case it comes once before any updateNotification and updateNotificationGroup update",
+		Fields: []ParamSchema{
+			{Name: "groups", Type: "vector<notificationGroup>", Description: "Lists of active notification groups"},
+		},
+	},
+	{
+		Name:        "updateHavePendingNotifications",
+		Class:       "Update",
+		Description: "Describes whether there are some pending notification updates. Can be used to prevent application from killing, while there are some pending notifications",
+		Fields: []ParamSchema{
+			{Name: "have_delayed_notifications", Type: "Bool", Description: "True, if there are some delayed notification updates, which will be sent soon"},
+			{Name: "have_unreceived_notifications", Type: "Bool", Description: "True, if there can be some yet unreceived notifications, which are being fetched from the server"},
+		},
+	},
+	{
+		Name:        "updateDeleteMessages",
+		Class:       "Update",
+		Description: "Some messages were deleted",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "message_ids", Type: "vector<int53>", Description: "Identifiers of the deleted messages"},
+			{Name: "is_permanent", Type: "Bool", Description: "True, if the messages are permanently deleted by a user (as opposed to just becoming inaccessible)"},
+			{Name: "from_cache", Type: "Bool", Description: "True, if the messages are deleted only from the cache and can possibly be retrieved again in the future"},
+		},
+	},
+	{
+		Name:        "updateChatAction",
+		Class:       "Update",
+		Description: "A message sender activity in the chat has changed",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "message_thread_id", Type: "int53", Description: "If not 0, a message thread identifier in which the action was performed"},
+			{Name: "sender_id", Type: "MessageSender", Description: "Identifier of a message sender performing the action"},
+			{Name: "action", Type: "ChatAction", Description: "The action"},
+		},
+	},
+	{
+		Name:        "updateUserStatus",
+		Class:       "Update",
+		Description: "The user went online or offline",
+		Fields: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "User identifier"},
+			{Name: "status", Type: "UserStatus", Description: "New status of the user"},
+		},
+	},
+	{
+		Name:        "updateUser",
+		Class:       "Update",
+		Description: "Some data of a user has changed. This update is guaranteed to come before the user identifier is returned to the application",
+		Fields: []ParamSchema{
+			{Name: "user", Type: "user", Description: "New data about the user"},
+		},
+	},
+	{
+		Name:        "updateBasicGroup",
+		Class:       "Update",
+		Description: "Some data of a basic group has changed. This update is guaranteed to come before the basic group identifier is returned to the application",
+		Fields: []ParamSchema{
+			{Name: "basic_group", Type: "basicGroup", Description: "New data about the group"},
+		},
+	},
+	{
+		Name:        "updateSupergroup",
+		Class:       "Update",
+		Description: "Some data of a supergroup or a channel has changed. This update is guaranteed to come before the supergroup identifier is returned to the application",
+		Fields: []ParamSchema{
+			{Name: "supergroup", Type: "supergroup", Description: "New data about the supergroup"},
+		},
+	},
+	{
+		Name:        "updateSecretChat",
+		Class:       "Update",
+		Description: "Some data of a secret chat has changed. This update is guaranteed to come before the secret chat identifier is returned to the application",
+		Fields: []ParamSchema{
+			{Name: "secret_chat", Type: "secretChat", Description: "New data about the secret chat"},
+		},
+	},
+	{
+		Name:        "updateUserFullInfo",
+		Class:       "Update",
+		Description: "Some data in userFullInfo has been changed",
+		Fields: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "User identifier"},
+			{Name: "user_full_info", Type: "userFullInfo", Description: "New full information about the user"},
+		},
+	},
+	{
+		Name:        "updateBasicGroupFullInfo",
+		Class:       "Update",
+		Description: "Some data in basicGroupFullInfo has been changed",
+		Fields: []ParamSchema{
+			{Name: "basic_group_id", Type: "int53", Description: "Identifier of a basic group"},
+			{Name: "basic_group_full_info", Type: "basicGroupFullInfo", Description: "New full information about the group"},
+		},
+	},
+	{
+		Name:        "updateSupergroupFullInfo",
+		Class:       "Update",
+		Description: "Some data in supergroupFullInfo has been changed",
+		Fields: []ParamSchema{
+			{Name: "supergroup_id", Type: "int53", Description: "Identifier of the supergroup or channel"},
+			{Name: "supergroup_full_info", Type: "supergroupFullInfo", Description: "New full information about the supergroup"},
+		},
+	},
+	{
+		Name:        "updateServiceNotification",
+		Class:       "Update",
+		Description: "A service notification from the server was received. Upon receiving this the application must show a popup with the content of the notification",
+		Fields: []ParamSchema{
+			{Name: "type", Type: "string", Description: "Notification type. If type begins with \"AUTH_KEY_DROP_\", then two buttons \"Cancel\" and \"Log out\" must be shown under notification; if user presses the second, all local data must be destroyed using Destroy method"},
+			{Name: "content", Type: "MessageContent", Description: "Notification content"},
+		},
+	},
+	{
+		Name:        "updateFile",
+		Class:       "Update",
+		Description: "Information about a file was updated",
+		Fields: []ParamSchema{
+			{Name: "file", Type: "file", Description: "New data about the file"},
+		},
+	},
+	{
+		Name:        "updateFileGenerationStart",
+		Class:       "Update",
+		Description: "The file generation process needs to be started by the application",
+		Fields: []ParamSchema{
+			{Name: "generation_id", Type: "int64", Description: "Unique identifier for the generation process"},
+			{Name: "original_path", Type: "string", Description: "The path to a file from which a new file is generated; may be empty"},
+			{Name: "destination_path", Type: "string", Description: "The path to a file that must be created and where the new file is generated"},
+			{Name: "conversion", Type: "string", Description: "String specifying the conversion applied to the original file. If conversion is \"#url#\" than original_path contains an HTTP/HTTPS URL of a file, which must be downloaded by the application"},
+		},
+	},
+	{
+		Name:        "updateFileGenerationStop",
+		Class:       "Update",
+		Description: "File generation is no longer needed",
+		Fields: []ParamSchema{
+			{Name: "generation_id", Type: "int64", Description: "Unique identifier for the generation process"},
+		},
+	},
+	{
+		Name:        "updateCall",
+		Class:       "Update",
+		Description: "New call was created or information about a call was updated",
+		Fields: []ParamSchema{
+			{Name: "call", Type: "call", Description: "New data about a call"},
+		},
+	},
+	{
+		Name:        "updateGroupCall",
+		Class:       "Update",
+		Description: "Information about a group call was updated",
+		Fields: []ParamSchema{
+			{Name: "group_call", Type: "groupCall", Description: "New data about a group call"},
+		},
+	},
+	{
+		Name:        "updateGroupCallParticipant",
+		Class:       "Update",
+		Description: "Information about a group call participant was changed. The updates are sent only after the group call is received through getGroupCall and only if the call is joined or being joined",
+		Fields: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Identifier of group call"},
+			{Name: "participant", Type: "groupCallParticipant", Description: "New data about a participant"},
+		},
+	},
+	{
+		Name:        "updateNewCallSignalingData",
+		Class:       "Update",
+		Description: "New call signaling data arrived",
+		Fields: []ParamSchema{
+			{Name: "call_id", Type: "int32", Description: "The call identifier"},
+			{Name: "data", Type: "bytes", Description: "The data"},
+		},
+	},
+	{
+		Name:        "updateUserPrivacySettingRules",
+		Class:       "Update",
+		Description: "Some privacy setting rules have been changed",
+		Fields: []ParamSchema{
+			{Name: "setting", Type: "UserPrivacySetting", Description: "The privacy setting"},
+			{Name: "rules", Type: "userPrivacySettingRules", Description: "New privacy rules"},
+		},
+	},
+	{
+		Name:        "updateUnreadMessageCount",
+		Class:       "Update",
+		Description: "Number of unread messages in a chat list has changed. This update is sent only if the message database is used",
+		Fields: []ParamSchema{
+			{Name: "chat_list", Type: "ChatList", Description: "The chat list with changed number of unread messages"},
+			{Name: "unread_count", Type: "int32", Description: "Total number of unread messages"},
+			{Name: "unread_unmuted_count", Type: "int32", Description: "Total number of unread messages in unmuted chats"},
+		},
+	},
+	{
+		Name:        "updateUnreadChatCount",
+		Class:       "Update",
+		Description: "Number of unread chats, i.e. with unread messages or marked as unread, has changed. This update is sent only if the message database is used",
+		Fields: []ParamSchema{
+			{Name: "chat_list", Type: "ChatList", Description: "The chat list with changed number of unread messages"},
+			{Name: "total_count", Type: "int32", Description: "Approximate total number of chats in the chat list"},
+			{Name: "unread_count", Type: "int32", Description: "Total number of unread chats"},
+			{Name: "unread_unmuted_count", Type: "int32", Description: "Total number of unread unmuted chats"},
+			{Name: "marked_as_unread_count", Type: "int32", Description: "Total number of chats marked as unread"},
+			{Name: "marked_as_unread_unmuted_count", Type: "int32", Description: "Total number of unmuted chats marked as unread"},
+		},
+	},
+	{
+		Name:        "updateOption",
+		Class:       "Update",
+		Description: "An option changed its value",
+		Fields: []ParamSchema{
+			{Name: "name", Type: "string", Description: "The option name"},
+			{Name: "value", Type: "OptionValue", Description: "The new option value"},
+		},
+	},
+	{
+		Name:        "updateStickerSet",
+		Class:       "Update",
+		Description: "A sticker set has changed",
+		Fields: []ParamSchema{
+			{Name: "sticker_set", Type: "stickerSet", Description: "The sticker set"},
+		},
+	},
+	{
+		Name:        "updateInstalledStickerSets",
+		Class:       "Update",
+		Description: "The list of installed sticker sets was updated",
+		Fields: []ParamSchema{
+			{Name: "is_masks", Type: "Bool", Description: "True, if the list of installed mask sticker sets was updated"},
+			{Name: "sticker_set_ids", Type: "vector<int64>", Description: "The new list of installed ordinary sticker sets"},
+		},
+	},
+	{
+		Name:        "updateTrendingStickerSets",
+		Class:       "Update",
+		Description: "The list of trending sticker sets was updated or some of them were viewed",
+		Fields: []ParamSchema{
+			{Name: "sticker_sets", Type: "stickerSets", Description: "The prefix of the list of trending sticker sets with the newest trending sticker sets"},
+		},
+	},
+	{
+		Name:        "updateRecentStickers",
+		Class:       "Update",
+		Description: "The list of recently used stickers was updated",
+		Fields: []ParamSchema{
+			{Name: "is_attached", Type: "Bool", Description: "True, if the list of stickers attached to photo or video files was updated, otherwise the list of sent stickers is updated"},
+			{Name: "sticker_ids", Type: "vector<int32>", Description: "The new list of file identifiers of recently used stickers"},
+		},
+	},
+	{
+		Name:        "updateFavoriteStickers",
+		Class:       "Update",
+		Description: "The list of favorite stickers was updated",
+		Fields: []ParamSchema{
+			{Name: "sticker_ids", Type: "vector<int32>", Description: "The new list of file identifiers of favorite stickers"},
+		},
+	},
+	{
+		Name:        "updateSavedAnimations",
+		Class:       "Update",
+		Description: "The list of saved animations was updated",
+		Fields: []ParamSchema{
+			{Name: "animation_ids", Type: "vector<int32>", Description: "The new list of file identifiers of saved animations"},
+		},
+	},
+	{
+		Name:        "updateSelectedBackground",
+		Class:       "Update",
+		Description: "The selected background has changed",
+		Fields: []ParamSchema{
+			{Name: "for_dark_theme", Type: "Bool", Description: "True, if background for dark theme has changed"},
+			{Name: "background", Type: "background", Description: "The new selected background; may be null"},
+		},
+	},
+	{
+		Name:        "updateChatThemes",
+		Class:       "Update",
+		Description: "The list of available chat themes has changed",
+		Fields: []ParamSchema{
+			{Name: "chat_themes", Type: "vector<chatTheme>", Description: "The new list of chat themes"},
+		},
+	},
+	{
+		Name:        "updateLanguagePackStrings",
+		Class:       "Update",
+		Description: "Some language pack strings have been updated",
+		Fields: []ParamSchema{
+			{Name: "localization_target", Type: "string", Description: "Localization target to which the language pack belongs"},
+			{Name: "language_pack_id", Type: "string", Description: "Identifier of the updated language pack"},
+			{Name: "strings", Type: "vector<languagePackString>", Description: "List of changed language pack strings"},
+		},
+	},
+	{
+		Name:        "updateConnectionState",
+		Class:       "Update",
+		Description: "The connection state has changed. This update must be used only to show a human-readable description of the connection state",
+		Fields: []ParamSchema{
+			{Name: "state", Type: "ConnectionState", Description: "The new connection state"},
+		},
+	},
+	{
+		Name:        "updateTermsOfService",
+		Class:       "Update",
+		Description: "New terms of service must be accepted by the user. If the terms of service are declined, then the deleteAccount method must be called with the reason \"Decline ToS update\"",
+		Fields: []ParamSchema{
+			{Name: "terms_of_service_id", Type: "string", Description: "Identifier of the terms of service"},
+			{Name: "terms_of_service", Type: "termsOfService", Description: "The new terms of service"},
+		},
+	},
+	{
+		Name:        "updateUsersNearby",
+		Class:       "Update",
+		Description: "The list of users nearby has changed. The update is guaranteed to be sent only 60 seconds after a successful searchChatsNearby request",
+		Fields: []ParamSchema{
+			{Name: "users_nearby", Type: "vector<chatNearby>", Description: "The new list of users nearby"},
+		},
+	},
+	{
+		Name:        "updateDiceEmojis",
+		Class:       "Update",
+		Description: "The list of supported dice emojis has changed",
+		Fields: []ParamSchema{
+			{Name: "emojis", Type: "vector<string>", Description: "The new list of supported dice emojis"},
+		},
+	},
+	{
+		Name:        "updateAnimatedEmojiMessageClicked",
+		Class:       "Update",
+		Description: "Some animated emoji message was clicked and a big animated sticker must be played if the message is visible on the screen. chatActionWatchingAnimations with the text of the message needs to be sent if the sticker is played",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "message_id", Type: "int53", Description: "Message identifier"},
+			{Name: "sticker", Type: "sticker", Description: "The animated sticker to be played"},
+		},
+	},
+	{
+		Name:        "updateAnimationSearchParameters",
+		Class:       "Update",
+		Description: "The parameters of animation search through GetOption(\"animation_search_bot_username\") bot has changed",
+		Fields: []ParamSchema{
+			{Name: "provider", Type: "string", Description: "Name of the animation search provider"},
+			{Name: "emojis", Type: "vector<string>", Description: "The new list of emojis suggested for searching"},
+		},
+	},
+	{
+		Name:        "updateSuggestedActions",
+		Class:       "Update",
+		Description: "The list of suggested to the user actions has changed",
+		Fields: []ParamSchema{
+			{Name: "added_actions", Type: "vector<SuggestedAction>", Description: "Added suggested actions"},
+			{Name: "removed_actions", Type: "vector<SuggestedAction>", Description: "Removed suggested actions"},
+		},
+	},
+	{
+		Name:        "updateNewInlineQuery",
+		Class:       "Update",
+		Description: "A new incoming inline query; for bots only",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int64", Description: "Unique query identifier"},
+			{Name: "sender_user_id", Type: "int53", Description: "Identifier of the user who sent the query"},
+			{Name: "user_location", Type: "location", Description: "User location; may be null"},
+			{Name: "chat_type", Type: "ChatType", Description: "The type of the chat, from which the query originated; may be null if unknown"},
+			{Name: "query", Type: "string", Description: "Text of the query"},
+			{Name: "offset", Type: "string", Description: "Offset of the first entry to return"},
+		},
+	},
+	{
+		Name:        "updateNewChosenInlineResult",
+		Class:       "Update",
+		Description: "The user has chosen a result of an inline query; for bots only",
+		Fields: []ParamSchema{
+			{Name: "sender_user_id", Type: "int53", Description: "Identifier of the user who sent the query"},
+			{Name: "user_location", Type: "location", Description: "User location; may be null"},
+			{Name: "query", Type: "string", Description: "Text of the query"},
+			{Name: "result_id", Type: "string", Description: "Identifier of the chosen result"},
+			{Name: "inline_message_id", Type: "string", Description: "Identifier of the sent inline message, if known"},
+		},
+	},
+	{
+		Name:        "updateNewCallbackQuery",
+		Class:       "Update",
+		Description: "A new incoming callback query; for bots only",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int64", Description: "Unique query identifier"},
+			{Name: "sender_user_id", Type: "int53", Description: "Identifier of the user who sent the query"},
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat where the query was sent"},
+			{Name: "message_id", Type: "int53", Description: "Identifier of the message, from which the query originated"},
+			{Name: "chat_instance", Type: "int64", Description: "Identifier that uniquely corresponds to the chat to which the message was sent"},
+			{Name: "payload", Type: "CallbackQueryPayload", Description: "Query payload"},
+		},
+	},
+	{
+		Name:        "updateNewInlineCallbackQuery",
+		Class:       "Update",
+		Description: "A new incoming callback query from a message sent via a bot; for bots only",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int64", Description: "Unique query identifier"},
+			{Name: "sender_user_id", Type: "int53", Description: "Identifier of the user who sent the query"},
+			{Name: "inline_message_id", Type: "string", Description: "Identifier of the inline message, from which the query originated"},
+			{Name: "chat_instance", Type: "int64", Description: "An identifier uniquely corresponding to the chat a message was sent to"},
+			{Name: "payload", Type: "CallbackQueryPayload", Description: "Query payload"},
+		},
+	},
+	{
+		Name:        "updateNewShippingQuery",
+		Class:       "Update",
+		Description: "A new incoming shipping query; for bots only. Only for invoices with flexible price",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int64", Description: "Unique query identifier"},
+			{Name: "sender_user_id", Type: "int53", Description: "Identifier of the user who sent the query"},
+			{Name: "invoice_payload", Type: "string", Description: "Invoice payload"},
+			{Name: "shipping_address", Type: "address", Description: "User shipping address"},
+		},
+	},
+	{
+		Name:        "updateNewPreCheckoutQuery",
+		Class:       "Update",
+		Description: "A new incoming pre-checkout query; for bots only. Contains full information about a checkout",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int64", Description: "Unique query identifier"},
+			{Name: "sender_user_id", Type: "int53", Description: "Identifier of the user who sent the query"},
+			{Name: "currency", Type: "string", Description: "Currency for the product price"},
+			{Name: "total_amount", Type: "int53", Description: "Total price for the product, in the smallest units of the currency"},
+			{Name: "invoice_payload", Type: "bytes", Description: "Invoice payload"},
+			{Name: "shipping_option_id", Type: "string", Description: "Identifier of a shipping option chosen by the user; may be empty if not applicable"},
+			{Name: "order_info", Type: "orderInfo", Description: "Information about the order; may be null"},
+		},
+	},
+	{
+		Name:        "updateNewCustomEvent",
+		Class:       "Update",
+		Description: "A new incoming event; for bots only",
+		Fields: []ParamSchema{
+			{Name: "event", Type: "string", Description: "A JSON-serialized event"},
+		},
+	},
+	{
+		Name:        "updateNewCustomQuery",
+		Class:       "Update",
+		Description: "A new incoming query; for bots only",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int64", Description: "The query identifier"},
+			{Name: "data", Type: "string", Description: "JSON-serialized query data"},
+			{Name: "timeout", Type: "int32", Description: "Query timeout"},
+		},
+	},
+	{
+		Name:        "updatePoll",
+		Class:       "Update",
+		Description: "A poll was updated; for bots only",
+		Fields: []ParamSchema{
+			{Name: "poll", Type: "poll", Description: "New data about the poll"},
+		},
+	},
+	{
+		Name:        "updatePollAnswer",
+		Class:       "Update",
+		Description: "A user changed the answer to a poll; for bots only",
+		Fields: []ParamSchema{
+			{Name: "poll_id", Type: "int64", Description: "Unique poll identifier"},
+			{Name: "user_id", Type: "int53", Description: "The user, who changed the answer to the poll"},
+			{Name: "option_ids", Type: "vector<int32>", Description: "0-based identifiers of answer options, chosen by the user"},
+		},
+	},
+	{
+		Name:        "updateChatMember",
+		Class:       "Update",
+		Description: "User rights changed in a chat; for bots only",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "actor_user_id", Type: "int53", Description: "Identifier of the user, changing the rights"},
+			{Name: "date", Type: "int32", Description: "Point in time (Unix timestamp) when the user rights was changed"},
+			{Name: "invite_link", Type: "chatInviteLink", Description: "If user has joined the chat using an invite link, the invite link; may be null"},
+			{Name: "old_chat_member", Type: "chatMember", Description: "Previous chat member"},
+			{Name: "new_chat_member", Type: "chatMember", Description: "New chat member"},
+		},
+	},
+	{
+		Name:        "updateNewChatJoinRequest",
+		Class:       "Update",
+		Description: "A user sent a join request to a chat; for bots only",
+		Fields: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "request", Type: "chatJoinRequest", Description: "Join request"},
+			{Name: "invite_link", Type: "chatInviteLink", Description: "The invite link, which was used to send join request; may be null"},
+		},
+	},
+	{
+		Name:        "updates",
+		Class:       "Updates",
+		Description: "Contains a list of updates",
+		Fields: []ParamSchema{
+			{Name: "updates", Type: "vector<Update>", Description: "List of updates"},
+		},
+	},
+	{
+		Name:        "user",
+		Class:       "User",
+		Description: "Represents a user",
+		Fields: []ParamSchema{
+			{Name: "id", Type: "int53", Description: "User identifier"},
+			{Name: "access_hash", Type: "int64", Description: "User access hash"},
+			{Name: "first_name", Type: "string", Description: "First name of the user"},
+			{Name: "last_name", Type: "string", Description: "Last name of the user"},
+			{Name: "username", Type: "string", Description: "Username of the user"},
+			{Name: "phone_number", Type: "string", Description: "Phone number of the user"},
+			{Name: "status", Type: "UserStatus", Description: "Current online status of the user"},
+			{Name: "profile_photo", Type: "profilePhoto", Description: "Profile photo of the user; may be null"},
+			{Name: "is_contact", Type: "Bool", Description: "The user is a contact of the current user"},
+			{Name: "is_mutual_contact", Type: "Bool", Description: "The user is a contact of the current user and the current user is a contact of the user"},
+			{Name: "is_verified", Type: "Bool", Description: "True, if the user is verified"},
+			{Name: "is_support", Type: "Bool", Description: "True, if the user is Telegram support account"},
+			{Name: "restriction_reason", Type: "string", Description: "If non-empty, it contains a human-readable description of the reason why access to this user must be restricted"},
+			{Name: "is_scam", Type: "Bool", Description: "True, if many users reported this user as a scam"},
+			{Name: "is_fake", Type: "Bool", Description: "True, if many users reported this user as a fake account"},
+			{Name: "have_access", Type: "Bool", Description: "If false, the user is inaccessible, and the only information known about the user is inside this class. It can't be passed to any method except GetUser"},
+			{Name: "type", Type: "UserType", Description: "Type of the user"},
+			{Name: "language_code", Type: "string", Description: "IETF language tag of the user's language; only available to bots"},
+		},
+	},
+	{
+		Name:        "userFullInfo",
+		Class:       "UserFullInfo",
+		Description: "Contains full information about a user",
+		Fields: []ParamSchema{
+			{Name: "photo", Type: "chatPhoto", Description: "User profile photo; may be null"},
+			{Name: "is_blocked", Type: "Bool", Description: "True, if the user is blocked by the current user"},
+			{Name: "can_be_called", Type: "Bool", Description: "True, if the user can be called"},
+			{Name: "supports_video_calls", Type: "Bool", Description: "True, if a video call can be created with the user"},
+			{Name: "has_private_calls", Type: "Bool", Description: "True, if the user can't be called due to their privacy settings"},
+			{Name: "has_private_forwards", Type: "Bool", Description: "True, if the user can't be linked in forwarded messages due to their privacy settings"},
+			{Name: "need_phone_number_privacy_exception", Type: "Bool", Description: "True, if the current user needs to explicitly allow to share their phone number with the user when the method addContact is used"},
+			{Name: "bio", Type: "string", Description: "A short user bio"},
+			{Name: "share_text", Type: "string", Description: "For bots, the text that is shown on the bot's profile page and is sent together with the link when users share the bot"},
+			{Name: "description", Type: "string", Description: "For bots, the text shown in the chat with the bot if the chat is empty"},
+			{Name: "group_in_common_count", Type: "int32", Description: "Number of group chats where both the other user and the current user are a member; 0 for the current user"},
+			{Name: "commands", Type: "vector<botCommand>", Description: "For bots, list of the bot commands"},
+		},
+	},
+	{
+		Name:        "userPrivacySettingShowStatus",
+		Class:       "UserPrivacySetting",
+		Description: "A privacy setting for managing whether the user's online status is visible",
+	},
+	{
+		Name:        "userPrivacySettingShowProfilePhoto",
+		Class:       "UserPrivacySetting",
+		Description: "A privacy setting for managing whether the user's profile photo is visible",
+	},
+	{
+		Name:        "userPrivacySettingShowLinkInForwardedMessages",
+		Class:       "UserPrivacySetting",
+		Description: "A privacy setting for managing whether a link to the user's account is included in forwarded messages",
+	},
+	{
+		Name:        "userPrivacySettingShowPhoneNumber",
+		Class:       "UserPrivacySetting",
+		Description: "A privacy setting for managing whether the user's phone number is visible",
+	},
+	{
+		Name:        "userPrivacySettingAllowChatInvites",
+		Class:       "UserPrivacySetting",
+		Description: "A privacy setting for managing whether the user can be invited to chats",
+	},
+	{
+		Name:        "userPrivacySettingAllowCalls",
+		Class:       "UserPrivacySetting",
+		Description: "A privacy setting for managing whether the user can be called",
+	},
+	{
+		Name:        "userPrivacySettingAllowPeerToPeerCalls",
+		Class:       "UserPrivacySetting",
+		Description: "A privacy setting for managing whether peer-to-peer connections can be used for calls",
+	},
+	{
+		Name:        "userPrivacySettingAllowFindingByPhoneNumber",
+		Class:       "UserPrivacySetting",
+		Description: "A privacy setting for managing whether the user can be found by their phone number. Checked only if the phone number is not known to the other user. Can be set only to \"Allow contacts\" or \"Allow all\"",
+	},
+	{
+		Name:        "userPrivacySettingRuleAllowAll",
+		Class:       "UserPrivacySettingRule",
+		Description: "A rule to allow all users to do something",
+	},
+	{
+		Name:        "userPrivacySettingRuleAllowContacts",
+		Class:       "UserPrivacySettingRule",
+		Description: "A rule to allow all of a user's contacts to do something",
+	},
+	{
+		Name:        "userPrivacySettingRuleAllowUsers",
+		Class:       "UserPrivacySettingRule",
+		Description: "A rule to allow certain specified users to do something",
+		Fields: []ParamSchema{
+			{Name: "user_ids", Type: "vector<int53>", Description: "The user identifiers, total number of users in all rules must not exceed 1000"},
+		},
+	},
+	{
+		Name:        "userPrivacySettingRuleAllowChatMembers",
+		Class:       "UserPrivacySettingRule",
+		Description: "A rule to allow all members of certain specified basic groups and supergroups to doing something",
+		Fields: []ParamSchema{
+			{Name: "chat_ids", Type: "vector<int53>", Description: "The chat identifiers, total number of chats in all rules must not exceed 20"},
+		},
+	},
+	{
+		Name:        "userPrivacySettingRuleRestrictAll",
+		Class:       "UserPrivacySettingRule",
+		Description: "A rule to restrict all users from doing something",
+	},
+	{
+		Name:        "userPrivacySettingRuleRestrictContacts",
+		Class:       "UserPrivacySettingRule",
+		Description: "A rule to restrict all contacts of a user from doing something",
+	},
+	{
+		Name:        "userPrivacySettingRuleRestrictUsers",
+		Class:       "UserPrivacySettingRule",
+		Description: "A rule to restrict all specified users from doing something",
+		Fields: []ParamSchema{
+			{Name: "user_ids", Type: "vector<int53>", Description: "The user identifiers, total number of users in all rules must not exceed 1000"},
+		},
+	},
+	{
+		Name:        "userPrivacySettingRuleRestrictChatMembers",
+		Class:       "UserPrivacySettingRule",
+		Description: "A rule to restrict all members of specified basic groups and supergroups from doing something",
+		Fields: []ParamSchema{
+			{Name: "chat_ids", Type: "vector<int53>", Description: "The chat identifiers, total number of chats in all rules must not exceed 20"},
+		},
+	},
+	{
+		Name:        "userPrivacySettingRules",
+		Class:       "UserPrivacySettingRules",
+		Description: "A list of privacy rules. Rules are matched in the specified order. The first matched rule defines the privacy setting for a given user. If no rule matches, the action is not allowed",
+		Fields: []ParamSchema{
+			{Name: "rules", Type: "vector<UserPrivacySettingRule>", Description: "A list of rules"},
+		},
+	},
+	{
+		Name:        "userStatusEmpty",
+		Class:       "UserStatus",
+		Description: "The user status was never changed",
+	},
+	{
+		Name:        "userStatusOnline",
+		Class:       "UserStatus",
+		Description: "The user is online",
+		Fields: []ParamSchema{
+			{Name: "expires", Type: "int32", Description: "Point in time (Unix timestamp) when the user's online status will expire"},
+		},
+	},
+	{
+		Name:        "userStatusOffline",
+		Class:       "UserStatus",
+		Description: "The user is offline",
+		Fields: []ParamSchema{
+			{Name: "was_online", Type: "int32", Description: "Point in time (Unix timestamp) when the user was last online"},
+		},
+	},
+	{
+		Name:        "userStatusRecently",
+		Class:       "UserStatus",
+		Description: "The user was online recently",
+	},
+	{
+		Name:        "userStatusLastWeek",
+		Class:       "UserStatus",
+		Description: "The user is offline, but was online last week",
+	},
+	{
+		Name:        "userStatusLastMonth",
+		Class:       "UserStatus",
+		Description: "The user is offline, but was online last month",
+	},
+	{
+		Name:        "userTypeRegular",
+		Class:       "UserType",
+		Description: "A regular user",
+	},
+	{
+		Name:        "userTypeDeleted",
+		Class:       "UserType",
+		Description: "A deleted user or deleted bot. No information on the user besides the user identifier is available. It is not possible to perform any active actions on this type of user",
+	},
+	{
+		Name:        "userTypeBot",
+		Class:       "UserType",
+		Description: "A bot (see https://core.telegram.org/bots)",
+		Fields: []ParamSchema{
+			{Name: "can_join_groups", Type: "Bool", Description: "True, if the bot can be invited to basic group and supergroup chats"},
+			{Name: "can_read_all_group_messages", Type: "Bool", Description: "True, if the bot can read all messages in basic group or supergroup chats and not just those addressed to the bot. In private and channel chats a bot can always read all messages"},
+			{Name: "is_inline", Type: "Bool", Description: "True, if the bot supports inline queries"},
+			{Name: "inline_query_placeholder", Type: "string", Description: "Placeholder for inline queries (displayed on the application input field)"},
+			{Name: "need_location", Type: "Bool", Description: "True, if the location of the user is expected to be sent with every inline query to this bot"},
+		},
+	},
+	{
+		Name:        "userTypeUnknown",
+		Class:       "UserType",
+		Description: "No information on the user besides the user identifier is available, yet this user has not been deleted. This object is extremely rare and must be handled like a deleted user. It is not possible to perform any actions on users of this type",
+	},
+	{
+		Name:        "users",
+		Class:       "Users",
+		Description: "Represents a list of users",
+		Fields: []ParamSchema{
+			{Name: "total_count", Type: "int32", Description: "Approximate total count of users found"},
+			{Name: "user_ids", Type: "vector<int53>", Description: "A list of user identifiers"},
+		},
+	},
+	{
+		Name:        "validatedOrderInfo",
+		Class:       "ValidatedOrderInfo",
+		Description: "Contains a temporary identifier of validated order information, which is stored for one hour. Also contains the available shipping options",
+		Fields: []ParamSchema{
+			{Name: "order_info_id", Type: "string", Description: "Temporary identifier of the order information"},
+			{Name: "shipping_options", Type: "vector<shippingOption>", Description: "Available shipping options"},
+		},
+	},
+	{
+		Name:        "vectorPathCommandLine",
+		Class:       "VectorPathCommand",
+		Description: "A straight line to a given point",
+		Fields: []ParamSchema{
+			{Name: "end_point", Type: "point", Description: "The end point of the straight line"},
+		},
+	},
+	{
+		Name:        "vectorPathCommandCubicBezierCurve",
+		Class:       "VectorPathCommand",
+		Description: "A cubic Bézier curve to a given point",
+		Fields: []ParamSchema{
+			{Name: "start_control_point", Type: "point", Description: "The start control point of the curve"},
+			{Name: "end_control_point", Type: "point", Description: "The end control point of the curve"},
+			{Name: "end_point", Type: "point", Description: "The end point of the curve"},
+		},
+	},
+	{
+		Name:        "venue",
+		Class:       "Venue",
+		Description: "Describes a venue",
+		Fields: []ParamSchema{
+			{Name: "location", Type: "location", Description: "Venue location; as defined by the sender"},
+			{Name: "title", Type: "string", Description: "Venue name; as defined by the sender"},
+			{Name: "address", Type: "string", Description: "Venue address; as defined by the sender"},
+			{Name: "provider", Type: "string", Description: "Provider of the venue database; as defined by the sender. Currently, only \"foursquare\" and \"gplaces\" (Google Places) need to be supported"},
+			{Name: "id", Type: "string", Description: "Identifier of the venue in the provider database; as defined by the sender"},
+			{Name: "type", Type: "string", Description: "Type of the venue in the provider database; as defined by the sender"},
+		},
+	},
+	{
+		Name:        "video",
+		Class:       "Video",
+		Description: "Describes a video file",
+		Fields: []ParamSchema{
+			{Name: "duration", Type: "int32", Description: "Duration of the video, in seconds; as defined by the sender"},
+			{Name: "width", Type: "int32", Description: "Video width; as defined by the sender"},
+			{Name: "height", Type: "int32", Description: "Video height; as defined by the sender"},
+			{Name: "file_name", Type: "string", Description: "Original name of the file; as defined by the sender"},
+			{Name: "mime_type", Type: "string", Description: "MIME type of the file; as defined by the sender"},
+			{Name: "has_stickers", Type: "Bool", Description: "True, if stickers were added to the video. The list of corresponding sticker sets can be received using getAttachedStickerSets"},
+			{Name: "supports_streaming", Type: "Bool", Description: "True, if the video is supposed to be streamed"},
+			{Name: "minithumbnail", Type: "minithumbnail", Description: "Video minithumbnail; may be null"},
+			{Name: "thumbnail", Type: "thumbnail", Description: "Video thumbnail in JPEG or MPEG4 format; as defined by the sender; may be null"},
+			{Name: "video", Type: "file", Description: "File containing the video"},
+		},
+	},
+	{
+		Name:        "videoChat",
+		Class:       "VideoChat",
+		Description: "Describes a video chat",
+		Fields: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Group call identifier of an active video chat; 0 if none. Full information about the video chat can be received through the method getGroupCall"},
+			{Name: "has_participants", Type: "Bool", Description: "True, if the video chat has participants"},
+			{Name: "default_participant_id", Type: "MessageSender", Description: "Default group call participant identifier to join the video chat; may be null"},
+		},
+	},
+	{
+		Name:        "videoNote",
+		Class:       "VideoNote",
+		Description: "Describes a video note. The video must be equal in width and height, cropped to a circle, and stored in MPEG4 format",
+		Fields: []ParamSchema{
+			{Name: "duration", Type: "int32", Description: "Duration of the video, in seconds; as defined by the sender"},
+			{Name: "length", Type: "int32", Description: "Video width and height; as defined by the sender"},
+			{Name: "minithumbnail", Type: "minithumbnail", Description: "Video minithumbnail; may be null"},
+			{Name: "thumbnail", Type: "thumbnail", Description: "Video thumbnail in JPEG format; as defined by the sender; may be null"},
+			{Name: "video", Type: "file", Description: "File containing the video"},
+		},
+	},
+	{
+		Name:        "voiceNote",
+		Class:       "VoiceNote",
+		Description: "Describes a voice note. The voice note must be encoded with the Opus codec, and stored inside an OGG container. Voice notes can have only a single audio channel",
+		Fields: []ParamSchema{
+			{Name: "duration", Type: "int32", Description: "Duration of the voice note, in seconds; as defined by the sender"},
+			{Name: "waveform", Type: "bytes", Description: "A waveform representation of the voice note in 5-bit format"},
+			{Name: "mime_type", Type: "string", Description: "MIME type of the file; as defined by the sender"},
+			{Name: "voice", Type: "file", Description: "File containing the voice note"},
+		},
+	},
+	{
+		Name:        "webPage",
+		Class:       "WebPage",
+		Description: "Describes a web page preview",
+		Fields: []ParamSchema{
+			{Name: "url", Type: "string", Description: "Original URL of the link"},
+			{Name: "display_url", Type: "string", Description: "URL to display"},
+			{Name: "type", Type: "string", Description: "Type of the web page. Can be: article, photo, audio, video, document, profile, app, or something else"},
+			{Name: "site_name", Type: "string", Description: "Short name of the site (e.g., Google Docs, App Store)"},
+			{Name: "title", Type: "string", Description: "Title of the content"},
+			{Name: "description", Type: "formattedText", Description: "Description of the content"},
+			{Name: "photo", Type: "photo", Description: "Image representing the content; may be null"},
+			{Name: "embed_url", Type: "string", Description: "URL to show in the embedded preview"},
+			{Name: "embed_type", Type: "string", Description: "MIME type of the embedded preview, (e.g., text/html or video/mp4)"},
+			{Name: "embed_width", Type: "int32", Description: "Width of the embedded preview"},
+			{Name: "embed_height", Type: "int32", Description: "Height of the embedded preview"},
+			{Name: "duration", Type: "int32", Description: "Duration of the content, in seconds"},
+			{Name: "author", Type: "string", Description: "Author of the content"},
+			{Name: "animation", Type: "animation", Description: "Preview of the content as an animation, if available; may be null"},
+			{Name: "audio", Type: "audio", Description: "Preview of the content as an audio file, if available; may be null"},
+			{Name: "document", Type: "document", Description: "Preview of the content as a document, if available; may be null"},
+			{Name: "sticker", Type: "sticker", Description: "Preview of the content as a sticker for small WEBP files, if available; may be null"},
+			{Name: "video", Type: "video", Description: "Preview of the content as a video, if available; may be null"},
+			{Name: "video_note", Type: "videoNote", Description: "Preview of the content as a video note, if available; may be null"},
+			{Name: "voice_note", Type: "voiceNote", Description: "Preview of the content as a voice note, if available; may be null"},
+			{Name: "instant_view_version", Type: "int32", Description: "Version of instant view, available for the web page (currently, can be 1 or 2), 0 if none"},
+		},
+	},
+	{
+		Name:        "webPageInstantView",
+		Class:       "WebPageInstantView",
+		Description: "Describes an instant view page for a web page",
+		Fields: []ParamSchema{
+			{Name: "page_blocks", Type: "vector<PageBlock>", Description: "Content of the web page"},
+			{Name: "view_count", Type: "int32", Description: "Number of the instant view views; 0 if unknown"},
+			{Name: "version", Type: "int32", Description: "Version of the instant view; currently, can be 1 or 2"},
+			{Name: "is_rtl", Type: "Bool", Description: "True, if the instant view must be shown from right to left"},
+			{Name: "is_full", Type: "Bool", Description: "True, if the instant view contains the full page. A network request might be needed to get the full web page instant view"},
+			{Name: "feedback_link", Type: "InternalLinkType", Description: "An internal link to be opened to leave feedback about the instant view"},
+		},
+	},
+}
+
+// methodSchemas describes every function, in declaration order
+var methodSchemas = []*MethodSchema{
+	{
+		Name:        "getAccountTtl",
+		Description: "Returns the period of inactivity after which the account of the current user will automatically be deleted",
+		Result:      "AccountTtl",
+	},
+	{
+		Name:        "getAnimatedEmoji",
+		Description: "Returns an animated emoji corresponding to a given emoji. Returns a 404 error if the emoji has no animated emoji",
+		Params: []ParamSchema{
+			{Name: "emoji", Type: "string", Description: "The emoji"},
+		},
+		Result: "AnimatedEmoji",
+	},
+	{
+		Name:        "getSavedAnimations",
+		Description: "Returns saved animations",
+		Result:      "Animations",
+	},
+	{
+		Name:        "changePhoneNumber",
+		Description: "Changes the phone number of the user and sends an authentication code to the user's new phone number. On success, returns information about the sent code",
+		Params: []ParamSchema{
+			{Name: "phone_number", Type: "string", Description: "The new phone number of the user in international format"},
+			{Name: "settings", Type: "phoneNumberAuthenticationSettings", Description: "Settings for the authentication of the user's phone number; pass null to use default settings"},
+		},
+		Result: "AuthenticationCodeInfo",
+	},
+	{
+		Name:        "resendChangePhoneNumberCode",
+		Description: "Re-sends the authentication code sent to confirm a new phone number for the current user. Works only if the previously received authenticationCodeInfo next_code_type was not null and the server-specified timeout has passed",
+		Result:      "AuthenticationCodeInfo",
+	},
+	{
+		Name:        "sendPhoneNumberVerificationCode",
+		Description: "Sends a code to verify a phone number to be added to a user's Telegram Passport",
+		Params: []ParamSchema{
+			{Name: "phone_number", Type: "string", Description: "The phone number of the user, in international format"},
+			{Name: "settings", Type: "phoneNumberAuthenticationSettings", Description: "Settings for the authentication of the user's phone number; pass null to use default settings"},
+		},
+		Result: "AuthenticationCodeInfo",
+	},
+	{
+		Name:        "resendPhoneNumberVerificationCode",
+		Description: "Re-sends the code to verify a phone number to be added to a user's Telegram Passport",
+		Result:      "AuthenticationCodeInfo",
+	},
+	{
+		Name:        "sendPhoneNumberConfirmationCode",
+		Description: "Sends phone number confirmation code to handle links of the type internalLinkTypePhoneNumberConfirmation",
+		Params: []ParamSchema{
+			{Name: "hash", Type: "string", Description: "Hash value from the link"},
+			{Name: "phone_number", Type: "string", Description: "Phone number value from the link"},
+			{Name: "settings", Type: "phoneNumberAuthenticationSettings", Description: "Settings for the authentication of the user's phone number; pass null to use default settings"},
+		},
+		Result: "AuthenticationCodeInfo",
+	},
+	{
+		Name:        "resendPhoneNumberConfirmationCode",
+		Description: "Resends phone number confirmation code",
+		Result:      "AuthenticationCodeInfo",
+	},
+	{
+		Name:        "getAuthorizationState",
+		Description: "Returns the current authorization state; this is an offline request. For informational purposes only. Use updateAuthorizationState instead to maintain the current authorization state. Can be called before initialization",
+		Result:      "AuthorizationState",
+	},
+	{
+		Name:        "getAutoDownloadSettingsPresets",
+		Description: "Returns auto-download settings presets for the current user",
+		Result:      "AutoDownloadSettingsPresets",
+	},
+	{
+		Name:        "searchBackground",
+		Description: "Searches for a background by its name",
+		Params: []ParamSchema{
+			{Name: "name", Type: "string", Description: "The name of the background"},
+		},
+		Result: "Background",
+	},
+	{
+		Name:        "setBackground",
+		Description: "Changes the background selected by the user; adds background to the list of installed backgrounds",
+		Params: []ParamSchema{
+			{Name: "background", Type: "InputBackground", Description: "The input background to use; pass null to create a new filled backgrounds or to remove the current background"},
+			{Name: "type", Type: "BackgroundType", Description: "Background type; pass null to use the default type of the remote background or to remove the current background"},
+			{Name: "for_dark_theme", Type: "Bool", Description: "True, if the background is chosen for dark theme"},
+		},
+		Result: "Background",
+	},
+	{
+		Name:        "getBackgrounds",
+		Description: "Returns backgrounds installed by the user",
+		Params: []ParamSchema{
+			{Name: "for_dark_theme", Type: "Bool", Description: "True, if the backgrounds must be ordered for dark theme"},
+		},
+		Result: "Backgrounds",
+	},
+	{
+		Name:        "getBankCardInfo",
+		Description: "Returns information about a bank card",
+		Params: []ParamSchema{
+			{Name: "bank_card_number", Type: "string", Description: "The bank card number"},
+		},
+		Result: "BankCardInfo",
+	},
+	{
+		Name:        "getBasicGroup",
+		Description: "Returns information about a basic group by its identifier. This is an offline request if the current user is not a bot",
+		Params: []ParamSchema{
+			{Name: "basic_group_id", Type: "int53", Description: "Basic group identifier"},
+		},
+		Result: "BasicGroup",
+	},
+	{
+		Name:        "getBasicGroupFullInfo",
+		Description: "Returns full information about a basic group by its identifier",
+		Params: []ParamSchema{
+			{Name: "basic_group_id", Type: "int53", Description: "Basic group identifier"},
+		},
+		Result: "BasicGroupFullInfo",
+	},
+	{
+		Name:        "getCommands",
+		Description: "Returns the list of commands supported by the bot for the given user scope and language; for bots only",
+		Params: []ParamSchema{
+			{Name: "scope", Type: "BotCommandScope", Description: "The scope to which the commands are relevant; pass null to get commands in the default bot command scope"},
+			{Name: "language_code", Type: "string", Description: "A two-letter ISO 639-1 country code or an empty string"},
+		},
+		Result: "BotCommands",
+	},
+	{
+		Name:        "createCall",
+		Description: "Creates a new call",
+		Params: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "Identifier of the user to be called"},
+			{Name: "protocol", Type: "callProtocol", Description: "The call protocols supported by the application"},
+			{Name: "is_video", Type: "Bool", Description: "True, if a video call needs to be created"},
+		},
+		Result: "CallId",
+	},
+	{
+		Name:        "getCallbackQueryAnswer",
+		Description: "Sends a callback query to a bot and returns an answer. Returns an error with code 502 if the bot fails to answer the query before the query timeout expires",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat with the message"},
+			{Name: "message_id", Type: "int53", Description: "Identifier of the message from which the query originated"},
+			{Name: "payload", Type: "CallbackQueryPayload", Description: "Query payload"},
+		},
+		Result: "CallbackQueryAnswer",
+	},
+	{
+		Name:        "canTransferOwnership",
+		Description: "Checks whether the current session can be used to transfer a chat ownership to another user",
+		Result:      "CanTransferOwnershipResult",
+	},
+	{
+		Name:        "getChat",
+		Description: "Returns information about a chat by its identifier, this is an offline request if the current user is not a bot",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+		},
+		Result: "Chat",
+	},
+	{
+		Name:        "searchPublicChat",
+		Description: "Searches a public chat by its username. Currently, only private chats, supergroups and channels can be public. Returns the chat if found; otherwise an error is returned",
+		Params: []ParamSchema{
+			{Name: "username", Type: "string", Description: "Username to be resolved"},
+		},
+		Result: "Chat",
+	},
+	{
+		Name:        "createPrivateChat",
+		Description: "Returns an existing chat corresponding to a given user",
+		Params: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "User identifier"},
+			{Name: "force", Type: "Bool", Description: "If true, the chat will be created without network request. In this case all information about the chat except its type, title and photo can be incorrect"},
+		},
+		Result: "Chat",
+	},
+	{
+		Name:        "createBasicGroupChat",
+		Description: "Returns an existing chat corresponding to a known basic group",
+		Params: []ParamSchema{
+			{Name: "basic_group_id", Type: "int53", Description: "Basic group identifier"},
+			{Name: "force", Type: "Bool", Description: "If true, the chat will be created without network request. In this case all information about the chat except its type, title and photo can be incorrect"},
+		},
+		Result: "Chat",
+	},
+	{
+		Name:        "createSupergroupChat",
+		Description: "Returns an existing chat corresponding to a known supergroup or channel",
+		Params: []ParamSchema{
+			{Name: "supergroup_id", Type: "int53", Description: "Supergroup or channel identifier"},
+			{Name: "force", Type: "Bool", Description: "If true, the chat will be created without network request. In this case all information about the chat except its type, title and photo can be incorrect"},
+		},
+		Result: "Chat",
+	},
+	{
+		Name:        "createSecretChat",
+		Description: "Returns an existing chat corresponding to a known secret chat",
+		Params: []ParamSchema{
+			{Name: "secret_chat_id", Type: "int32", Description: "Secret chat identifier"},
+		},
+		Result: "Chat",
+	},
+	{
+		Name:        "createNewBasicGroupChat",
+		Description: "Creates a new basic group and sends a corresponding messageBasicGroupChatCreate. Returns the newly created chat",
+		Params: []ParamSchema{
+			{Name: "user_ids", Type: "vector<int53>", Description: "Identifiers of users to be added to the basic group"},
+			{Name: "title", Type: "string", Description: "Title of the new basic group; 1-128 characters"},
+		},
+		Result: "Chat",
+	},
+	{
+		Name:        "createNewSupergroupChat",
+		Description: "Creates a new supergroup or channel and sends a corresponding messageSupergroupChatCreate. Returns the newly created chat",
+		Params: []ParamSchema{
+			{Name: "title", Type: "string", Description: "Title of the new chat; 1-128 characters"},
+			{Name: "is_channel", Type: "Bool", Description: "True, if a channel chat needs to be created"},
+			{Name: "description", Type: "string", Description: "Chat description; 0-255 characters"},
+			{Name: "location", Type: "chatLocation", Description: "Chat location if a location-based supergroup is being created; pass null to create an ordinary supergroup chat"},
+			{Name: "for_import", Type: "Bool", Description: "True, if the supergroup is created for importing messages using importMessage"},
+		},
+		Result: "Chat",
+	},
+	{
+		Name:        "createNewSecretChat",
+		Description: "Creates a new secret chat. Returns the newly created chat",
+		Params: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "Identifier of the target user"},
+		},
+		Result: "Chat",
+	},
+	{
+		Name:        "upgradeBasicGroupChatToSupergroupChat",
+		Description: "Creates a new supergroup from an existing basic group and sends a corresponding messageChatUpgradeTo and messageChatUpgradeFrom; requires creator privileges. Deactivates the original basic group",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat to upgrade"},
+		},
+		Result: "Chat",
+	},
+	{
+		Name:        "joinChatByInviteLink",
+		Description: "Uses an invite link to add the current user to the chat if possible",
+		Params: []ParamSchema{
+			{Name: "invite_link", Type: "string", Description: "Invite link to use"},
+		},
+		Result: "Chat",
+	},
+	{
+		Name:        "getChatAdministrators",
+		Description: "Returns a list of administrators of the chat with their custom titles",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+		},
+		Result: "ChatAdministrators",
+	},
+	{
+		Name:        "getChatEventLog",
+		Description: "Returns a list of service actions taken by chat members and administrators in the last 48 hours. Available only for supergroups and channels. Requires administrator rights. Returns results in reverse chronological order (i. e., in order of decreasing event_id)",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "query", Type: "string", Description: "Search query by which to filter events"},
+			{Name: "from_event_id", Type: "int64", Description: "Identifier of an event from which to return results. Use 0 to get results from the latest events"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of events to return; up to 100"},
+			{Name: "filters", Type: "chatEventLogFilters", Description: "The types of events to return; pass null to get chat events of all types"},
+			{Name: "user_ids", Type: "vector<int53>", Description: "User identifiers by which to filter events. By default, events relating to all users will be returned"},
+		},
+		Result: "ChatEvents",
+	},
+	{
+		Name:        "getChatFilter",
+		Description: "Returns information about a chat filter by its identifier",
+		Params: []ParamSchema{
+			{Name: "chat_filter_id", Type: "int32", Description: "Chat filter identifier"},
+		},
+		Result: "ChatFilter",
+	},
+	{
+		Name:        "createChatFilter",
+		Description: "Creates new chat filter. Returns information about the created chat filter",
+		Params: []ParamSchema{
+			{Name: "filter", Type: "chatFilter", Description: "Chat filter"},
+		},
+		Result: "ChatFilterInfo",
+	},
+	{
+		Name:        "editChatFilter",
+		Description: "Edits existing chat filter. Returns information about the edited chat filter",
+		Params: []ParamSchema{
+			{Name: "chat_filter_id", Type: "int32", Description: "Chat filter identifier"},
+			{Name: "filter", Type: "chatFilter", Description: "The edited chat filter"},
+		},
+		Result: "ChatFilterInfo",
+	},
+	{
+		Name:        "replacePrimaryChatInviteLink",
+		Description: "Replaces current primary invite link for a chat with a new primary invite link. Available for basic groups, supergroups, and channels. Requires administrator privileges and can_invite_users right",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+		},
+		Result: "ChatInviteLink",
+	},
+	{
+		Name:        "createChatInviteLink",
+		Description: "Creates a new invite link for a chat. Available for basic groups, supergroups, and channels. Requires administrator privileges and can_invite_users right in the chat",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "name", Type: "string", Description: "Invite link name; 0-32 characters"},
+			{Name: "expiration_date", Type: "int32", Description: "Point in time (Unix timestamp) when the link will expire; pass 0 if never"},
+			{Name: "member_limit", Type: "int32", Description: "The maximum number of chat members that can join the chat via the link simultaneously; 0-99999; pass 0 if not limited"},
+			{Name: "creates_join_request", Type: "Bool", Description: "True, if the link only creates join request. If true, member_limit must not be specified"},
+		},
+		Result: "ChatInviteLink",
+	},
+	{
+		Name:        "editChatInviteLink",
+		Description: "Edits a non-primary invite link for a chat. Available for basic groups, supergroups, and channels. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "invite_link", Type: "string", Description: "Invite link to be edited"},
+			{Name: "name", Type: "string", Description: "Invite link name; 0-32 characters"},
+			{Name: "expiration_date", Type: "int32", Description: "Point in time (Unix timestamp) when the link will expire; pass 0 if never"},
+			{Name: "member_limit", Type: "int32", Description: "The maximum number of chat members that can join the chat via the link simultaneously; 0-99999; pass 0 if not limited"},
+			{Name: "creates_join_request", Type: "Bool", Description: "True, if the link only creates join request. If true, member_limit must not be specified"},
+		},
+		Result: "ChatInviteLink",
+	},
+	{
+		Name:        "getChatInviteLink",
+		Description: "Returns information about an invite link. Requires administrator privileges and can_invite_users right in the chat to get own links and owner privileges to get other links",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "invite_link", Type: "string", Description: "Invite link to get"},
+		},
+		Result: "ChatInviteLink",
+	},
+	{
+		Name:        "getChatInviteLinkCounts",
+		Description: "Returns list of chat administrators with number of their invite links. Requires owner privileges in the chat",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+		},
+		Result: "ChatInviteLinkCounts",
+	},
+	{
+		Name:        "checkChatInviteLink",
+		Description: "Checks the validity of an invite link for a chat and returns information about the corresponding chat",
+		Params: []ParamSchema{
+			{Name: "invite_link", Type: "string", Description: "Invite link to be checked"},
+		},
+		Result: "ChatInviteLinkInfo",
+	},
+	{
+		Name:        "getChatInviteLinkMembers",
+		Description: "Returns chat members joined a chat via an invite link. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "invite_link", Type: "string", Description: "Invite link for which to return chat members"},
+			{Name: "offset_member", Type: "chatInviteLinkMember", Description: "A chat member from which to return next chat members; pass null to get results from the beginning"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of chat members to return; up to 100"},
+		},
+		Result: "ChatInviteLinkMembers",
+	},
+	{
+		Name:        "getChatInviteLinks",
+		Description: "Returns invite links for a chat created by specified administrator. Requires administrator privileges and can_invite_users right in the chat to get own links and owner privileges to get other links",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "creator_user_id", Type: "int53", Description: "User identifier of a chat administrator. Must be an identifier of the current user for non-owner"},
+			{Name: "is_revoked", Type: "Bool", Description: "Pass true if revoked links needs to be returned instead of active or expired"},
+			{Name: "offset_date", Type: "int32", Description: "Creation date of an invite link starting after which to return invite links; use 0 to get results from the beginning"},
+			{Name: "offset_invite_link", Type: "string", Description: "Invite link starting after which to return invite links; use empty string to get results from the beginning"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of invite links to return; up to 100"},
+		},
+		Result: "ChatInviteLinks",
+	},
+	{
+		Name:        "revokeChatInviteLink",
+		Description: "Revokes invite link for a chat. Available for basic groups, supergroups, and channels. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links. If a primary link is revoked, then additionally to the revoked link returns new primary link",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "invite_link", Type: "string", Description: "Invite link to be revoked"},
+		},
+		Result: "ChatInviteLinks",
+	},
+	{
+		Name:        "getChatJoinRequests",
+		Description: "Returns pending join requests in a chat",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "invite_link", Type: "string", Description: "Invite link for which to return join requests. If empty, all join requests will be returned. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links"},
+			{Name: "query", Type: "string", Description: "A query to search for in the first names, last names and usernames of the users to return"},
+			{Name: "offset_request", Type: "chatJoinRequest", Description: "A chat join request from which to return next requests; pass null to get results from the beginning"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of requests to join the chat to return"},
+		},
+		Result: "ChatJoinRequests",
+	},
+	{
+		Name:        "getChatListsToAddChat",
+		Description: "Returns chat lists to which the chat can be added. This is an offline request",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+		},
+		Result: "ChatLists",
+	},
+	{
+		Name:        "getChatMember",
+		Description: "Returns information about a single member of a chat",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "member_id", Type: "MessageSender", Description: "Member identifier"},
+		},
+		Result: "ChatMember",
+	},
+	{
+		Name:        "searchChatMembers",
+		Description: "Searches for a specified query in the first name, last name and username of the members of a specified chat. Requires administrator rights in channels",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "query", Type: "string", Description: "Query to search for"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of users to be returned; up to 200"},
+			{Name: "filter", Type: "ChatMembersFilter", Description: "The type of users to search for; pass null to search among all chat members"},
+		},
+		Result: "ChatMembers",
+	},
+	{
+		Name:        "getSupergroupMembers",
+		Description: "Returns information about members or banned users in a supergroup or channel. Can be used only if supergroupFullInfo.can_get_members == true; additionally, administrator privileges may be required for some filters",
+		Params: []ParamSchema{
+			{Name: "supergroup_id", Type: "int53", Description: "Identifier of the supergroup or channel"},
+			{Name: "filter", Type: "SupergroupMembersFilter", Description: "The type of users to return; pass null to use supergroupMembersFilterRecent"},
+			{Name: "offset", Type: "int32", Description: "Number of users to skip"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of users be returned; up to 200"},
+		},
+		Result: "ChatMembers",
+	},
+	{
+		Name:        "getUserProfilePhotos",
+		Description: "Returns the profile photos of a user. The result of this query may be outdated: some photos might have been deleted already",
+		Params: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "User identifier"},
+			{Name: "offset", Type: "int32", Description: "The number of photos to skip; must be non-negative"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of photos to be returned; up to 100"},
+		},
+		Result: "ChatPhotos",
+	},
+	{
+		Name:        "getChatStatistics",
+		Description: "Returns detailed statistics about a chat. Currently, this method can be used only for supergroups and channels. Can be used only if supergroupFullInfo.can_get_statistics == true",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "is_dark", Type: "Bool", Description: "Pass true if a dark theme is used by the application"},
+		},
+		Result: "ChatStatistics",
+	},
+	{
+		Name:        "getChats",
+		Description: "Returns an ordered list of chats from the beginning of a chat list. For informational purposes only. Use loadChats and updates processing instead to maintain chat lists in a consistent state",
+		Params: []ParamSchema{
+			{Name: "chat_list", Type: "ChatList", Description: "The chat list in which to return chats; pass null to get chats from the main chat list"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of chats to be returned"},
+		},
+		Result: "Chats",
+	},
+	{
+		Name:        "searchPublicChats",
+		Description: "Searches public chats by looking for specified query in their username and title. Currently, only private chats, supergroups and channels can be public. Returns a meaningful number of results. Excludes private chats with contacts and chats from the chat list from the results",
+		Params: []ParamSchema{
+			{Name: "query", Type: "string", Description: "Query to search for"},
+		},
+		Result: "Chats",
+	},
+	{
+		Name:        "searchChats",
+		Description: "Searches for the specified query in the title and username of already known chats, this is an offline request. Returns chats in the order seen in the main chat list",
+		Params: []ParamSchema{
+			{Name: "query", Type: "string", Description: "Query to search for. If the query is empty, returns up to 50 recently found chats"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of chats to be returned"},
+		},
+		Result: "Chats",
+	},
+	{
+		Name:        "searchChatsOnServer",
+		Description: "Searches for the specified query in the title and username of already known chats via request to the server. Returns chats in the order seen in the main chat list",
+		Params: []ParamSchema{
+			{Name: "query", Type: "string", Description: "Query to search for"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of chats to be returned"},
+		},
+		Result: "Chats",
+	},
+	{
+		Name:        "getTopChats",
+		Description: "Returns a list of frequently used chats. Supported only if the chat info database is enabled",
+		Params: []ParamSchema{
+			{Name: "category", Type: "TopChatCategory", Description: "Category of chats to be returned"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of chats to be returned; up to 30"},
+		},
+		Result: "Chats",
+	},
+	{
+		Name:        "getRecentlyOpenedChats",
+		Description: "Returns recently opened chats, this is an offline request. Returns chats in the order of last opening",
+		Params: []ParamSchema{
+			{Name: "limit", Type: "int32", Description: "The maximum number of chats to be returned"},
+		},
+		Result: "Chats",
+	},
+	{
+		Name:        "getCreatedPublicChats",
+		Description: "Returns a list of public chats of the specified type, owned by the user",
+		Params: []ParamSchema{
+			{Name: "type", Type: "PublicChatType", Description: "Type of the public chats to return"},
+		},
+		Result: "Chats",
+	},
+	{
+		Name:        "getSuitableDiscussionChats",
+		Description: "Returns a list of basic group and supergroup chats, which can be used as a discussion group for a channel. Returned basic group chats must be first upgraded to supergroups before they can be set as a discussion group. To set a returned supergroup as a discussion group, access to its old messages must be enabled using toggleSupergroupIsAllHistoryAvailable first",
+		Result:      "Chats",
+	},
+	{
+		Name:        "getInactiveSupergroupChats",
+		Description: "Returns a list of recently inactive supergroups and channels. Can be used when user reaches limit on the number of joined supergroups and channels and receives CHANNELS_TOO_MUCH error",
+		Result:      "Chats",
+	},
+	{
+		Name:        "getGroupsInCommon",
+		Description: "Returns a list of common group chats with a given user. Chats are sorted by their type and creation date",
+		Params: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "User identifier"},
+			{Name: "offset_chat_id", Type: "int53", Description: "Chat identifier starting from which to return chats; use 0 for the first request"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of chats to be returned; up to 100"},
+		},
+		Result: "Chats",
+	},
+	{
+		Name:        "getChatNotificationSettingsExceptions",
+		Description: "Returns list of chats with non-default notification settings",
+		Params: []ParamSchema{
+			{Name: "scope", Type: "NotificationSettingsScope", Description: "If specified, only chats from the scope will be returned; pass null to return chats from all scopes"},
+			{Name: "compare_sound", Type: "Bool", Description: "If true, also chats with non-default sound will be returned"},
+		},
+		Result: "Chats",
+	},
+	{
+		Name:        "searchChatsNearby",
+		Description: "Returns a list of users and location-based supergroups nearby. The list of users nearby will be updated for 60 seconds after the request by the updates updateUsersNearby. The request must be sent again every 25 seconds with adjusted location to not miss new chats",
+		Params: []ParamSchema{
+			{Name: "location", Type: "location", Description: "Current user location"},
+		},
+		Result: "ChatsNearby",
+	},
+	{
+		Name:        "checkChatUsername",
+		Description: "Checks whether a username can be set for a chat",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier; must be identifier of a supergroup chat, or a channel chat, or a private chat with self, or zero if the chat is being created"},
+			{Name: "username", Type: "string", Description: "Username to be checked"},
+		},
+		Result: "CheckChatUsernameResult",
+	},
+	{
+		Name:        "checkStickerSetName",
+		Description: "Checks whether a name can be used for a new sticker set",
+		Params: []ParamSchema{
+			{Name: "name", Type: "string", Description: "Name to be checked"},
+		},
+		Result: "CheckStickerSetNameResult",
+	},
+	{
+		Name:        "getConnectedWebsites",
+		Description: "Returns all website where the current user used Telegram to log in",
+		Result:      "ConnectedWebsites",
+	},
+	{
+		Name:        "getChatMessageCount",
+		Description: "Returns approximate number of messages of the specified type in the chat",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat in which to count messages"},
+			{Name: "filter", Type: "SearchMessagesFilter", Description: "Filter for message content; searchMessagesFilterEmpty is unsupported in this function"},
+			{Name: "return_local", Type: "Bool", Description: "If true, returns count that is available locally without sending network requests, returning -1 if the number of messages is unknown"},
+		},
+		Result: "Count",
+	},
+	{
+		Name:        "getFileDownloadedPrefixSize",
+		Description: "Returns file downloaded prefix size from a given offset, in bytes",
+		Params: []ParamSchema{
+			{Name: "file_id", Type: "int32", Description: "Identifier of the file"},
+			{Name: "offset", Type: "int32", Description: "Offset from which downloaded prefix size needs to be calculated"},
+		},
+		Result: "Count",
+	},
+	{
+		Name:        "getImportedContactCount",
+		Description: "Returns the total number of imported contacts",
+		Result:      "Count",
+	},
+	{
+		Name:        "getCountries",
+		Description: "Returns information about existing countries. Can be called before authorization",
+		Result:      "Countries",
+	},
+	{
+		Name:        "sendCustomRequest",
+		Description: "Sends a custom request; for bots only",
+		Params: []ParamSchema{
+			{Name: "method", Type: "string", Description: "The method name"},
+			{Name: "parameters", Type: "string", Description: "JSON-serialized method parameters"},
+		},
+		Result: "CustomRequestResult",
+	},
+	{
+		Name:        "getDatabaseStatistics",
+		Description: "Returns database statistics",
+		Result:      "DatabaseStatistics",
+	},
+	{
+		Name:        "getDeepLinkInfo",
+		Description: "Returns information about a tg:// deep link. Use \"tg://need_update_for_some_feature\" or \"tg:some_unsupported_feature\" for testing. Returns a 404 error for unknown links. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "link", Type: "string", Description: "The link"},
+		},
+		Result: "DeepLinkInfo",
+	},
+	{
+		Name:        "requestPasswordRecovery",
+		Description: "Requests to send a 2-step verification password recovery code to an email address that was previously set up",
+		Result:      "EmailAddressAuthenticationCodeInfo",
+	},
+	{
+		Name:        "sendEmailAddressVerificationCode",
+		Description: "Sends a code to verify an email address to be added to a user's Telegram Passport",
+		Params: []ParamSchema{
+			{Name: "email_address", Type: "string", Description: "Email address"},
+		},
+		Result: "EmailAddressAuthenticationCodeInfo",
+	},
+	{
+		Name:        "resendEmailAddressVerificationCode",
+		Description: "Re-sends the code to verify an email address to be added to a user's Telegram Passport",
+		Result:      "EmailAddressAuthenticationCodeInfo",
+	},
+	{
+		Name:        "getStickerEmojis",
+		Description: "Returns emoji corresponding to a sticker. The list is only for informational purposes, because a sticker is always sent with a fixed emoji from the corresponding Sticker object",
+		Params: []ParamSchema{
+			{Name: "sticker", Type: "InputFile", Description: "Sticker file identifier"},
+		},
+		Result: "Emojis",
+	},
+	{
+		Name:        "searchEmojis",
+		Description: "Searches for emojis by keywords. Supported only if the file database is enabled",
+		Params: []ParamSchema{
+			{Name: "text", Type: "string", Description: "Text to search for"},
+			{Name: "exact_match", Type: "Bool", Description: "True, if only emojis, which exactly match text needs to be returned"},
+			{Name: "input_language_codes", Type: "vector<string>", Description: "List of possible IETF language tags of the user's input language; may be empty if unknown"},
+		},
+		Result: "Emojis",
+	},
+	{
+		Name:        "testReturnError",
+		Description: "Returns the specified error and ensures that the Error object is used; for testing only. Can be called synchronously",
+		Params: []ParamSchema{
+			{Name: "error", Type: "error", Description: "The error to be returned"},
+		},
+		Result:      "Error",
+		Synchronous: true,
+	},
+	{
+		Name:        "getFile",
+		Description: "Returns information about a file; this is an offline request",
+		Params: []ParamSchema{
+			{Name: "file_id", Type: "int32", Description: "Identifier of the file to get"},
+		},
+		Result: "File",
+	},
+	{
+		Name:        "getRemoteFile",
+		Description: "Returns information about a file by its remote ID; this is an offline request. Can be used to register a URL as a file for further uploading, or sending as a message. Even the request succeeds, the file can be used only if it is still accessible to the user. For example, if the file is from a message, then the message must be not deleted and accessible to the user. If the file database is disabled, then the corresponding object with the file must be preloaded by the application",
+		Params: []ParamSchema{
+			{Name: "remote_file_id", Type: "string", Description: "Remote identifier of the file to get"},
+			{Name: "file_type", Type: "FileType", Description: "File type; pass null if unknown"},
+		},
+		Result: "File",
+	},
+	{
+		Name:        "downloadFile",
+		Description: "Downloads a file from the cloud. Download progress and completion of the download will be notified through updateFile updates",
+		Params: []ParamSchema{
+			{Name: "file_id", Type: "int32", Description: "Identifier of the file to download"},
+			{Name: "priority", Type: "int32", Description: "Priority of the download (1-32). The higher the priority, the earlier the file will be downloaded. If the priorities of two files are equal, then the last one for which downloadFile was called will be downloaded first"},
+			{Name: "offset", Type: "int32", Description: "The starting position from which the file needs to be downloaded"},
+			{Name: "limit", Type: "int32", Description: "Number of bytes which need to be downloaded starting from the \"offset\" position before the download will automatically be canceled; use 0 to download without a limit"},
+			{Name: "synchronous", Type: "Bool", Description: "If false, this request returns file state just after the download has been started. If true, this request returns file state only after the download has succeeded, has failed, has been canceled or a new downloadFile request with different offset/limit parameters was sent"},
+		},
+		Result: "File",
+	},
+	{
+		Name:        "uploadFile",
+		Description: "Asynchronously uploads a file to the cloud without sending it in a message. updateFile will be used to notify about upload progress and successful completion of the upload. The file will not have a persistent remote identifier until it will be sent in a message",
+		Params: []ParamSchema{
+			{Name: "file", Type: "InputFile", Description: "File to upload"},
+			{Name: "file_type", Type: "FileType", Description: "File type; pass null if unknown"},
+			{Name: "priority", Type: "int32", Description: "Priority of the upload (1-32). The higher the priority, the earlier the file will be uploaded. If the priorities of two files are equal, then the first one for which uploadFile was called will be uploaded first"},
+		},
+		Result: "File",
+	},
+	{
+		Name:        "uploadStickerFile",
+		Description: "Uploads a file with a sticker; returns the uploaded file",
+		Params: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "Sticker file owner; ignored for regular users"},
+			{Name: "sticker", Type: "InputSticker", Description: "Sticker file to upload"},
+		},
+		Result: "File",
+	},
+	{
+		Name:        "getMapThumbnailFile",
+		Description: "Returns information about a file with a map thumbnail in PNG format. Only map thumbnail files with size less than 1MB can be downloaded",
+		Params: []ParamSchema{
+			{Name: "location", Type: "location", Description: "Location of the map center"},
+			{Name: "zoom", Type: "int32", Description: "Map zoom level; 13-20"},
+			{Name: "width", Type: "int32", Description: "Map width in pixels before applying scale; 16-1024"},
+			{Name: "height", Type: "int32", Description: "Map height in pixels before applying scale; 16-1024"},
+			{Name: "scale", Type: "int32", Description: "Map scale; 1-3"},
+			{Name: "chat_id", Type: "int53", Description: "Identifier of a chat, in which the thumbnail will be shown. Use 0 if unknown"},
+		},
+		Result: "File",
+	},
+	{
+		Name:        "readFilePart",
+		Description: "Reads a part of a file from the TDLib file cache and returns read bytes. This method is intended to be used only if the application has no direct access to TDLib's file system, because it is usually slower than a direct read from the file",
+		Params: []ParamSchema{
+			{Name: "file_id", Type: "int32", Description: "Identifier of the file. The file must be located in the TDLib file cache"},
+			{Name: "offset", Type: "int32", Description: "The offset from which to read the file"},
+			{Name: "count", Type: "int32", Description: "Number of bytes to read. An error will be returned if there are not enough bytes available in the file from the specified position. Pass 0 to read all available data from the specified position"},
+		},
+		Result: "FilePart",
+	},
+	{
+		Name:        "getGroupCallStreamSegment",
+		Description: "Returns a file with a segment of a group call stream in a modified OGG format for audio or MPEG-4 format for video",
+		Params: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Group call identifier"},
+			{Name: "time_offset", Type: "int53", Description: "Point in time when the stream segment begins; Unix timestamp in milliseconds"},
+			{Name: "scale", Type: "int32", Description: "Segment duration scale; 0-1. Segment's duration is 1000/(2**scale) milliseconds"},
+			{Name: "channel_id", Type: "int32", Description: "Identifier of an audio/video channel to get as received from tgcalls"},
+			{Name: "video_quality", Type: "GroupCallVideoQuality", Description: "Video quality as received from tgcalls; pass null to get the worst available quality"},
+		},
+		Result: "FilePart",
+	},
+	{
+		Name:        "parseTextEntities",
+		Description: "Parses Bold, Italic, Underline, Strikethrough, Spoiler, Code, Pre, PreCode, TextUrl and MentionName entities contained in the text. Can be called synchronously",
+		Params: []ParamSchema{
+			{Name: "text", Type: "string", Description: "The text to parse"},
+			{Name: "parse_mode", Type: "TextParseMode", Description: "Text parse mode"},
+		},
+		Result:      "FormattedText",
+		Synchronous: true,
+	},
+	{
+		Name:        "parseMarkdown",
+		Description: "Parses Markdown entities in a human-friendly format, ignoring markup errors. Can be called synchronously",
+		Params: []ParamSchema{
+			{Name: "text", Type: "formattedText", Description: "The text to parse. For example, \"__italic__ ~~strikethrough~~ ||spoiler|| **bold** `code` ```pre``` __[italic__ text_url](telegram.org) __italic**bold italic__bold**\""},
+		},
+		Result:      "FormattedText",
+		Synchronous: true,
+	},
+	{
+		Name:        "getMarkdownText",
+		Description: "Replaces text entities with Markdown formatting in a human-friendly format. Entities that can't be represented in Markdown unambiguously are kept as is. Can be called synchronously",
+		Params: []ParamSchema{
+			{Name: "text", Type: "formattedText", Description: "The text"},
+		},
+		Result:      "FormattedText",
+		Synchronous: true,
+	},
+	{
+		Name:        "searchSecretMessages",
+		Description: "Searches for messages in secret chats. Returns the results in reverse chronological order. For optimal performance, the number of returned messages is chosen by TDLib",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat in which to search. Specify 0 to search in all secret chats"},
+			{Name: "query", Type: "string", Description: "Query to search for. If empty, searchChatMessages must be used instead"},
+			{Name: "offset", Type: "string", Description: "Offset of the first entry to return as received from the previous request; use empty string to get first chunk of results"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of messages to be returned; up to 100. For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit"},
+			{Name: "filter", Type: "SearchMessagesFilter", Description: "Additional filter for messages to search; pass null to search for all messages"},
+		},
+		Result: "FoundMessages",
+	},
+	{
+		Name:        "getMessagePublicForwards",
+		Description: "Returns forwarded copies of a channel message to different public channels. For optimal performance, the number of returned messages is chosen by TDLib",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier of the message"},
+			{Name: "message_id", Type: "int53", Description: "Message identifier"},
+			{Name: "offset", Type: "string", Description: "Offset of the first entry to return as received from the previous request; use empty string to get first chunk of results"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of messages to be returned; must be positive and can't be greater than 100. For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit"},
+		},
+		Result: "FoundMessages",
+	},
+	{
+		Name:        "getGameHighScores",
+		Description: "Returns the high scores for a game and some part of the high score table in the range of the specified user; for bots only",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "The chat that contains the message with the game"},
+			{Name: "message_id", Type: "int53", Description: "Identifier of the message"},
+			{Name: "user_id", Type: "int53", Description: "User identifier"},
+		},
+		Result: "GameHighScores",
+	},
+	{
+		Name:        "getInlineGameHighScores",
+		Description: "Returns game high scores and some part of the high score table in the range of the specified user; for bots only",
+		Params: []ParamSchema{
+			{Name: "inline_message_id", Type: "string", Description: "Inline message identifier"},
+			{Name: "user_id", Type: "int53", Description: "User identifier"},
+		},
+		Result: "GameHighScores",
+	},
+	{
+		Name:        "getGroupCall",
+		Description: "Returns information about a group call",
+		Params: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Group call identifier"},
+		},
+		Result: "GroupCall",
+	},
+	{
+		Name:        "createVideoChat",
+		Description: "Creates a video chat (a group call bound to a chat). Available only for basic groups, supergroups and channels; requires can_manage_video_chats rights",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier, in which the video chat will be created"},
+			{Name: "title", Type: "string", Description: "Group call title; if empty, chat title will be used"},
+			{Name: "start_date", Type: "int32", Description: "Point in time (Unix timestamp) when the group call is supposed to be started by an administrator; 0 to start the video chat immediately. The date must be at least 10 seconds and at most 8 days in the future"},
+		},
+		Result: "GroupCallId",
+	},
+	{
+		Name:        "searchHashtags",
+		Description: "Searches for recently used hashtags by their prefix",
+		Params: []ParamSchema{
+			{Name: "prefix", Type: "string", Description: "Hashtag prefix to search for"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of hashtags to be returned"},
+		},
+		Result: "Hashtags",
+	},
+	{
+		Name:        "getLoginUrl",
+		Description: "Returns an HTTP URL which can be used to automatically authorize the user on a website after clicking an inline button of type inlineKeyboardButtonTypeLoginUrl. Use the method getLoginUrlInfo to find whether a prior user confirmation is needed. If an error is returned, then the button must be handled as an ordinary URL button",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier of the message with the button"},
+			{Name: "message_id", Type: "int53", Description: "Message identifier of the message with the button"},
+			{Name: "button_id", Type: "int53", Description: "Button identifier"},
+			{Name: "allow_write_access", Type: "Bool", Description: "True, if the user allowed the bot to send them messages"},
+		},
+		Result: "HttpUrl",
+	},
+	{
+		Name:        "getExternalLink",
+		Description: "Returns an HTTP URL which can be used to automatically authorize the current user on a website after clicking an HTTP link. Use the method getExternalLinkInfo to find whether a prior user confirmation is needed",
+		Params: []ParamSchema{
+			{Name: "link", Type: "string", Description: "The HTTP link"},
+			{Name: "allow_write_access", Type: "Bool", Description: "True, if the current user allowed the bot, returned in getExternalLinkInfo, to send them messages"},
+		},
+		Result: "HttpUrl",
+	},
+	{
+		Name:        "getGroupCallInviteLink",
+		Description: "Returns invite link to a video chat in a public chat",
+		Params: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Group call identifier"},
+			{Name: "can_self_unmute", Type: "Bool", Description: "Pass true if the invite link needs to contain an invite hash, passing which to joinGroupCall would allow the invited user to unmute themselves. Requires groupCall.can_be_managed group call flag"},
+		},
+		Result: "HttpUrl",
+	},
+	{
+		Name:        "getEmojiSuggestionsUrl",
+		Description: "Returns an HTTP URL which can be used to automatically log in to the translation platform and suggest new emoji replacements. The URL will be valid for 30 seconds after generation",
+		Params: []ParamSchema{
+			{Name: "language_code", Type: "string", Description: "Language code for which the emoji replacements will be suggested"},
+		},
+		Result: "HttpUrl",
+	},
+	{
+		Name:        "getBackgroundUrl",
+		Description: "Constructs a persistent HTTP URL for a background",
+		Params: []ParamSchema{
+			{Name: "name", Type: "string", Description: "Background name"},
+			{Name: "type", Type: "BackgroundType", Description: "Background type"},
+		},
+		Result: "HttpUrl",
+	},
+	{
+		Name:        "getApplicationDownloadLink",
+		Description: "Returns the link for downloading official Telegram application to be used when the current user invites friends to Telegram",
+		Result:      "HttpUrl",
+	},
+	{
+		Name:        "getProxyLink",
+		Description: "Returns an HTTPS link, which can be used to add a proxy. Available only for SOCKS5 and MTProto proxies. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "proxy_id", Type: "int32", Description: "Proxy identifier"},
+		},
+		Result: "HttpUrl",
+	},
+	{
+		Name:        "importContacts",
+		Description: "Adds new contacts or edits existing contacts by their phone numbers; contacts' user identifiers are ignored",
+		Params: []ParamSchema{
+			{Name: "contacts", Type: "vector<contact>", Description: "The list of contacts to import or edit; contacts' vCard are ignored and are not imported"},
+		},
+		Result: "ImportedContacts",
+	},
+	{
+		Name:        "changeImportedContacts",
+		Description: "Changes imported contacts using the list of contacts saved on the device. Imports newly added contacts and, if at least the file database is enabled, deletes recently deleted contacts. Query result depends on the result of the previous query, so only one query is possible at the same time",
+		Params: []ParamSchema{
+			{Name: "contacts", Type: "vector<contact>", Description: "The new list of contacts, contact's vCard are ignored and are not imported"},
+		},
+		Result: "ImportedContacts",
+	},
+	{
+		Name:        "getInlineQueryResults",
+		Description: "Sends an inline query to a bot and returns its results. Returns an error with code 502 if the bot fails to answer the query before the query timeout expires",
+		Params: []ParamSchema{
+			{Name: "bot_user_id", Type: "int53", Description: "The identifier of the target bot"},
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat where the query was sent"},
+			{Name: "user_location", Type: "location", Description: "Location of the user; pass null if unknown or the bot doesn't need user's location"},
+			{Name: "query", Type: "string", Description: "Text of the query"},
+			{Name: "offset", Type: "string", Description: "Offset of the first entry to return"},
+		},
+		Result: "InlineQueryResults",
+	},
+	{
+		Name:        "getInternalLinkType",
+		Description: "Returns information about the type of an internal link. Returns a 404 error if the link is not internal. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "link", Type: "string", Description: "The link"},
+		},
+		Result: "InternalLinkType",
+	},
+	{
+		Name:        "getJsonValue",
+		Description: "Converts a JSON-serialized string to corresponding JsonValue object. Can be called synchronously",
+		Params: []ParamSchema{
+			{Name: "json", Type: "string", Description: "The JSON-serialized string"},
+		},
+		Result:      "JsonValue",
+		Synchronous: true,
+	},
+	{
+		Name:        "getApplicationConfig",
+		Description: "Returns application config, provided by the server. Can be called before authorization",
+		Result:      "JsonValue",
+	},
+	{
+		Name:        "getLanguagePackInfo",
+		Description: "Returns information about a language pack. Returned language pack identifier may be different from a provided one. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "language_pack_id", Type: "string", Description: "Language pack identifier"},
+		},
+		Result: "LanguagePackInfo",
+	},
+	{
+		Name:        "getLanguagePackString",
+		Description: "Returns a string stored in the local database from the specified localization target and language pack by its key. Returns a 404 error if the string is not found. Can be called synchronously",
+		Params: []ParamSchema{
+			{Name: "language_pack_database_path", Type: "string", Description: "Path to the language pack database in which strings are stored"},
+			{Name: "localization_target", Type: "string", Description: "Localization target to which the language pack belongs"},
+			{Name: "language_pack_id", Type: "string", Description: "Language pack identifier"},
+			{Name: "key", Type: "string", Description: "Language pack key of the string to be returned"},
+		},
+		Result:      "LanguagePackStringValue",
+		Synchronous: true,
+	},
+	{
+		Name:        "getLanguagePackStrings",
+		Description: "Returns strings from a language pack in the current localization target by their keys. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "language_pack_id", Type: "string", Description: "Language pack identifier of the strings to be returned"},
+			{Name: "keys", Type: "vector<string>", Description: "Language pack keys of the strings to be returned; leave empty to request all available strings"},
+		},
+		Result: "LanguagePackStrings",
+	},
+	{
+		Name:        "getLocalizationTargetInfo",
+		Description: "Returns information about the current localization target. This is an offline request if only_local is true. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "only_local", Type: "Bool", Description: "If true, returns only locally available information without sending network requests"},
+		},
+		Result: "LocalizationTargetInfo",
+	},
+	{
+		Name:        "getLogStream",
+		Description: "Returns information about currently used log stream for internal logging of TDLib. Can be called synchronously",
+		Result:      "LogStream",
+		Synchronous: true,
+	},
+	{
+		Name:        "getLogTags",
+		Description: "Returns list of available TDLib internal log tags, for example, [\"actor\", \"binlog\", \"connections\", \"notifications\", \"proxy\"]. Can be called synchronously",
+		Result:      "LogTags",
+		Synchronous: true,
+	},
+	{
+		Name:        "getLogVerbosityLevel",
+		Description: "Returns current verbosity level of the internal logging of TDLib. Can be called synchronously",
+		Result:      "LogVerbosityLevel",
+		Synchronous: true,
+	},
+	{
+		Name:        "getLogTagVerbosityLevel",
+		Description: "Returns current verbosity level for a specified TDLib internal log tag. Can be called synchronously",
+		Params: []ParamSchema{
+			{Name: "tag", Type: "string", Description: "Logging tag to change verbosity level"},
+		},
+		Result:      "LogVerbosityLevel",
+		Synchronous: true,
+	},
+	{
+		Name:        "getLoginUrlInfo",
+		Description: "Returns information about a button of type inlineKeyboardButtonTypeLoginUrl. The method needs to be called when the user presses the button",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier of the message with the button"},
+			{Name: "message_id", Type: "int53", Description: "Message identifier of the message with the button"},
+			{Name: "button_id", Type: "int53", Description: "Button identifier"},
+		},
+		Result: "LoginUrlInfo",
+	},
+	{
+		Name:        "getExternalLinkInfo",
+		Description: "Returns information about an action to be done when the current user clicks an external link. Don't use this method for links from secret chats if web page preview is disabled in secret chats",
+		Params: []ParamSchema{
+			{Name: "link", Type: "string", Description: "The link"},
+		},
+		Result: "LoginUrlInfo",
+	},
+	{
+		Name:        "getMessage",
+		Description: "Returns information about a message",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat the message belongs to"},
+			{Name: "message_id", Type: "int53", Description: "Identifier of the message to get"},
+		},
+		Result: "Message",
+	},
+	{
+		Name:        "getMessageLocally",
+		Description: "Returns information about a message, if it is available locally without sending network request. This is an offline request",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat the message belongs to"},
+			{Name: "message_id", Type: "int53", Description: "Identifier of the message to get"},
+		},
+		Result: "Message",
+	},
+	{
+		Name:        "getRepliedMessage",
+		Description: "Returns information about a message that is replied by a given message. Also returns the pinned message, the game message, and the invoice message for messages of the types messagePinMessage, messageGameScore, and messagePaymentSuccessful respectively",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat the message belongs to"},
+			{Name: "message_id", Type: "int53", Description: "Identifier of the reply message"},
+		},
+		Result: "Message",
+	},
+	{
+		Name:        "getChatPinnedMessage",
+		Description: "Returns information about a newest pinned message in the chat",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat the message belongs to"},
+		},
+		Result: "Message",
+	},
+	{
+		Name:        "getCallbackQueryMessage",
+		Description: "Returns information about a message with the callback button that originated a callback query; for bots only",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat the message belongs to"},
+			{Name: "message_id", Type: "int53", Description: "Message identifier"},
+			{Name: "callback_query_id", Type: "int64", Description: "Identifier of the callback query"},
+		},
+		Result: "Message",
+	},
+	{
+		Name:        "getChatMessageByDate",
+		Description: "Returns the last message sent in a chat no later than the specified date",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "date", Type: "int32", Description: "Point in time (Unix timestamp) relative to which to search for messages"},
+		},
+		Result: "Message",
+	},
+	{
+		Name:        "sendMessage",
+		Description: "Sends a message. Returns the sent message",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Target chat"},
+			{Name: "message_thread_id", Type: "int53", Description: "If not 0, a message thread identifier in which the message will be sent"},
+			{Name: "reply_to_message_id", Type: "int53", Description: "Identifier of the message to reply to or 0"},
+			{Name: "options", Type: "messageSendOptions", Description: "Options to be used to send the message; pass null to use default options"},
+			{Name: "reply_markup", Type: "ReplyMarkup", Description: "Markup for replying to the message; pass null if none; for bots only"},
+			{Name: "input_message_content", Type: "InputMessageContent", Description: "The content of the message to be sent"},
+		},
+		Result: "Message",
+	},
+	{
+		Name:        "sendBotStartMessage",
+		Description: "Invites a bot to a chat (if it is not yet a member) and sends it the /start command. Bots can't be invited to a private chat other than the chat with the bot. Bots can't be invited to channels (although they can be added as admins) and secret chats. Returns the sent message",
+		Params: []ParamSchema{
+			{Name: "bot_user_id", Type: "int53", Description: "Identifier of the bot"},
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the target chat"},
+			{Name: "parameter", Type: "string", Description: "A hidden parameter sent to the bot for deep linking purposes (https://core.telegram.org/bots#deep-linking)"},
+		},
+		Result: "Message",
+	},
+	{
+		Name:        "sendInlineQueryResultMessage",
+		Description: "Sends the result of an inline query as a message. Returns the sent message. Always clears a chat draft message",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Target chat"},
+			{Name: "message_thread_id", Type: "int53", Description: "If not 0, a message thread identifier in which the message will be sent"},
+			{Name: "reply_to_message_id", Type: "int53", Description: "Identifier of a message to reply to or 0"},
+			{Name: "options", Type: "messageSendOptions", Description: "Options to be used to send the message; pass null to use default options"},
+			{Name: "query_id", Type: "int64", Description: "Identifier of the inline query"},
+			{Name: "result_id", Type: "string", Description: "Identifier of the inline result"},
+			{Name: "hide_via_bot", Type: "Bool", Description: "If true, there will be no mention of a bot, via which the message is sent. Can be used only for bots GetOption(\"animation_search_bot_username\"), GetOption(\"photo_search_bot_username\") and GetOption(\"venue_search_bot_username\")"},
+		},
+		Result: "Message",
+	},
+	{
+		Name:        "addLocalMessage",
+		Description: "Adds a local message to a chat. The message is persistent across application restarts only if the message database is used. Returns the added message",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Target chat"},
+			{Name: "sender_id", Type: "MessageSender", Description: "Identifier of the sender of the message"},
+			{Name: "reply_to_message_id", Type: "int53", Description: "Identifier of the message to reply to or 0"},
+			{Name: "disable_notification", Type: "Bool", Description: "Pass true to disable notification for the message"},
+			{Name: "input_message_content", Type: "InputMessageContent", Description: "The content of the message to be added"},
+		},
+		Result: "Message",
+	},
+	{
+		Name:        "editMessageText",
+		Description: "Edits the text of a message (or a text of a game message). Returns the edited message after the edit is completed on the server side",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "The chat the message belongs to"},
+			{Name: "message_id", Type: "int53", Description: "Identifier of the message"},
+			{Name: "reply_markup", Type: "ReplyMarkup", Description: "The new message reply markup; pass null if none; for bots only"},
+			{Name: "input_message_content", Type: "InputMessageContent", Description: "New text content of the message. Must be of type inputMessageText"},
+		},
+		Result: "Message",
+	},
+	{
+		Name:        "editMessageLiveLocation",
+		Description: "Edits the message content of a live location. Messages can be edited for a limited period of time specified in the live location. Returns the edited message after the edit is completed on the server side",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "The chat the message belongs to"},
+			{Name: "message_id", Type: "int53", Description: "Identifier of the message"},
+			{Name: "reply_markup", Type: "ReplyMarkup", Description: "The new message reply markup; pass null if none; for bots only"},
+			{Name: "location", Type: "location", Description: "New location content of the message; pass null to stop sharing the live location"},
+			{Name: "heading", Type: "int32", Description: "The new direction in which the location moves, in degrees; 1-360. Pass 0 if unknown"},
+			{Name: "proximity_alert_radius", Type: "int32", Description: "The new maximum distance for proximity alerts, in meters (0-100000). Pass 0 if the notification is disabled"},
+		},
+		Result: "Message",
+	},
+	{
+		Name:        "editMessageMedia",
+		Description: "Edits the content of a message with an animation, an audio, a document, a photo or a video, including message caption. If only the caption needs to be edited, use editMessageCaption instead. The media can't be edited if the message was set to self-destruct or to a self-destructing media. The type of message content in an album can't be changed with exception of replacing a photo with a video or vice versa. Returns the edited message after the edit is completed on the server side",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "The chat the message belongs to"},
+			{Name: "message_id", Type: "int53", Description: "Identifier of the message"},
+			{Name: "reply_markup", Type: "ReplyMarkup", Description: "The new message reply markup; pass null if none; for bots only"},
+			{Name: "input_message_content", Type: "InputMessageContent", Description: "New content of the message. Must be one of the following types: inputMessageAnimation, inputMessageAudio, inputMessageDocument, inputMessagePhoto or inputMessageVideo"},
+		},
+		Result: "Message",
+	},
+	{
+		Name:        "editMessageCaption",
+		Description: "Edits the message content caption. Returns the edited message after the edit is completed on the server side",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "The chat the message belongs to"},
+			{Name: "message_id", Type: "int53", Description: "Identifier of the message"},
+			{Name: "reply_markup", Type: "ReplyMarkup", Description: "The new message reply markup; pass null if none; for bots only"},
+			{Name: "caption", Type: "formattedText", Description: "New message content caption; 0-GetOption(\"message_caption_length_max\") characters; pass null to remove caption"},
+		},
+		Result: "Message",
+	},
+	{
+		Name:        "editMessageReplyMarkup",
+		Description: "Edits the message reply markup; for bots only. Returns the edited message after the edit is completed on the server side",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "The chat the message belongs to"},
+			{Name: "message_id", Type: "int53", Description: "Identifier of the message"},
+			{Name: "reply_markup", Type: "ReplyMarkup", Description: "The new message reply markup; pass null if none"},
+		},
+		Result: "Message",
+	},
+	{
+		Name:        "setGameScore",
+		Description: "Updates the game score of the specified user in the game; for bots only",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "The chat to which the message with the game belongs"},
+			{Name: "message_id", Type: "int53", Description: "Identifier of the message"},
+			{Name: "edit_message", Type: "Bool", Description: "True, if the message needs to be edited"},
+			{Name: "user_id", Type: "int53", Description: "User identifier"},
+			{Name: "score", Type: "int32", Description: "The new score"},
+			{Name: "force", Type: "Bool", Description: "Pass true to update the score even if it decreases. If the score is 0, the user will be deleted from the high score table"},
+		},
+		Result: "Message",
+	},
+	{
+		Name:        "getChatMessageCalendar",
+		Description: "Returns information about the next messages of the specified type in the chat split by days. Returns the results in reverse chronological order. Can return partial result for the last returned day. Behavior of this method depends on the value of the option \"utc_time_offset\"",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat in which to return information about messages"},
+			{Name: "filter", Type: "SearchMessagesFilter", Description: "Filter for message content. Filters searchMessagesFilterEmpty, searchMessagesFilterMention and searchMessagesFilterUnreadMention are unsupported in this function"},
+			{Name: "from_message_id", Type: "int53", Description: "The message identifier from which to return information about messages; use 0 to get results from the last message"},
+		},
+		Result: "MessageCalendar",
+	},
+	{
+		Name:        "getMessageFileType",
+		Description: "Returns information about a file with messages exported from another app",
+		Params: []ParamSchema{
+			{Name: "message_file_head", Type: "string", Description: "Beginning of the message file; up to 100 first lines"},
+		},
+		Result: "MessageFileType",
+	},
+	{
+		Name:        "getMessageLink",
+		Description: "Returns an HTTPS link to a message in a chat. Available only for already sent messages in supergroups and channels, or if message.can_get_media_timestamp_links and a media timestamp link is generated. This is an offline request",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat to which the message belongs"},
+			{Name: "message_id", Type: "int53", Description: "Identifier of the message"},
+			{Name: "media_timestamp", Type: "int32", Description: "If not 0, timestamp from which the video/audio/video note/voice note playing must start, in seconds. The media can be in the message content or in its web page preview"},
+			{Name: "for_album", Type: "Bool", Description: "Pass true to create a link for the whole media album"},
+			{Name: "for_comment", Type: "Bool", Description: "Pass true to create a link to the message as a channel post comment, or from a message thread"},
+		},
+		Result: "MessageLink",
+	},
+	{
+		Name:        "getMessageLinkInfo",
+		Description: "Returns information about a public or private message link. Can be called for any internal link of the type internalLinkTypeMessage",
+		Params: []ParamSchema{
+			{Name: "url", Type: "string", Description: "The message link"},
+		},
+		Result: "MessageLinkInfo",
+	},
+	{
+		Name:        "getChatSparseMessagePositions",
+		Description: "Returns sparse positions of messages of the specified type in the chat to be used for shared media scroll implementation. Returns the results in reverse chronological order (i.e., in order of decreasing message_id). Cannot be used in secret chats or with searchMessagesFilterFailedToSend filter without an enabled message database",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat in which to return information about message positions"},
+			{Name: "filter", Type: "SearchMessagesFilter", Description: "Filter for message content. Filters searchMessagesFilterEmpty, searchMessagesFilterMention and searchMessagesFilterUnreadMention are unsupported in this function"},
+			{Name: "from_message_id", Type: "int53", Description: "The message identifier from which to return information about message positions"},
+			{Name: "limit", Type: "int32", Description: "The expected number of message positions to be returned; 50-2000. A smaller number of positions can be returned, if there are not enough appropriate messages"},
+		},
+		Result: "MessagePositions",
+	},
+	{
+		Name:        "getChatAvailableMessageSenders",
+		Description: "Returns list of message sender identifiers, which can be used to send messages in a chat",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+		},
+		Result: "MessageSenders",
+	},
+	{
+		Name:        "getVideoChatAvailableParticipants",
+		Description: "Returns list of participant identifiers, on whose behalf a video chat in the chat can be joined",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+		},
+		Result: "MessageSenders",
+	},
+	{
+		Name:        "getBlockedMessageSenders",
+		Description: "Returns users and chats that were blocked by the current user",
+		Params: []ParamSchema{
+			{Name: "offset", Type: "int32", Description: "Number of users and chats to skip in the result; must be non-negative"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of users and chats to return; up to 100"},
+		},
+		Result: "MessageSenders",
+	},
+	{
+		Name:        "getMessageStatistics",
+		Description: "Returns detailed statistics about a message. Can be used only if message.can_get_statistics == true",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "message_id", Type: "int53", Description: "Message identifier"},
+			{Name: "is_dark", Type: "Bool", Description: "Pass true if a dark theme is used by the application"},
+		},
+		Result: "MessageStatistics",
+	},
+	{
+		Name:        "getMessageThread",
+		Description: "Returns information about a message thread. Can be used only if message.can_get_message_thread == true",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "message_id", Type: "int53", Description: "Identifier of the message"},
+		},
+		Result: "MessageThreadInfo",
+	},
+	{
+		Name:        "getMessages",
+		Description: "Returns information about messages. If a message is not found, returns null on the corresponding position of the result",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat the messages belong to"},
+			{Name: "message_ids", Type: "vector<int53>", Description: "Identifiers of the messages to get"},
+		},
+		Result: "Messages",
+	},
+	{
+		Name:        "getChatHistory",
+		Description: "Returns messages in a chat. The messages are returned in a reverse chronological order (i.e., in order of decreasing message_id). For optimal performance, the number of returned messages is chosen by TDLib. This is an offline request if only_local is true",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "from_message_id", Type: "int53", Description: "Identifier of the message starting from which history must be fetched; use 0 to get results from the last message"},
+			{Name: "offset", Type: "int32", Description: "Specify 0 to get results from exactly the from_message_id or a negative offset up to 99 to get additionally some newer messages"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of messages to be returned; must be positive and can't be greater than 100. If the offset is negative, the limit must be greater than or equal to -offset. For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit"},
+			{Name: "only_local", Type: "Bool", Description: "If true, returns only messages that are available locally without sending network requests"},
+		},
+		Result: "Messages",
+	},
+	{
+		Name:        "getMessageThreadHistory",
+		Description: "Returns messages in a message thread of a message. Can be used only if message.can_get_message_thread == true. Message thread of a channel message is in the channel's linked supergroup. The messages are returned in a reverse chronological order (i.e., in order of decreasing message_id). For optimal performance, the number of returned messages is chosen by TDLib",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "message_id", Type: "int53", Description: "Message identifier, which thread history needs to be returned"},
+			{Name: "from_message_id", Type: "int53", Description: "Identifier of the message starting from which history must be fetched; use 0 to get results from the last message"},
+			{Name: "offset", Type: "int32", Description: "Specify 0 to get results from exactly the from_message_id or a negative offset up to 99 to get additionally some newer messages"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of messages to be returned; must be positive and can't be greater than 100. If the offset is negative, the limit must be greater than or equal to -offset. For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit"},
+		},
+		Result: "Messages",
+	},
+	{
+		Name:        "searchChatMessages",
+		Description: "Searches for messages with given words in the chat. Returns the results in reverse chronological order, i.e. in order of decreasing message_id. Cannot be used in secret chats with a non-empty query (searchSecretMessages must be used instead), or without an enabled message database. For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat in which to search messages"},
+			{Name: "query", Type: "string", Description: "Query to search for"},
+			{Name: "sender_id", Type: "MessageSender", Description: "Identifier of the sender of messages to search for; pass null to search for messages from any sender. Not supported in secret chats"},
+			{Name: "from_message_id", Type: "int53", Description: "Identifier of the message starting from which history must be fetched; use 0 to get results from the last message"},
+			{Name: "offset", Type: "int32", Description: "Specify 0 to get results from exactly the from_message_id or a negative offset to get the specified message and some newer messages"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of messages to be returned; must be positive and can't be greater than 100. If the offset is negative, the limit must be greater than -offset. For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit"},
+			{Name: "filter", Type: "SearchMessagesFilter", Description: "Additional filter for messages to search; pass null to search for all messages"},
+			{Name: "message_thread_id", Type: "int53", Description: "If not 0, only messages in the specified thread will be returned; supergroups only"},
+		},
+		Result: "Messages",
+	},
+	{
+		Name:        "searchMessages",
+		Description: "Searches for messages in all chats except secret chats. Returns the results in reverse chronological order (i.e., in order of decreasing (date, chat_id, message_id)). For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit",
+		Params: []ParamSchema{
+			{Name: "chat_list", Type: "ChatList", Description: "Chat list in which to search messages; pass null to search in all chats regardless of their chat list. Only Main and Archive chat lists are supported"},
+			{Name: "query", Type: "string", Description: "Query to search for"},
+			{Name: "offset_date", Type: "int32", Description: "The date of the message starting from which the results need to be fetched. Use 0 or any date in the future to get results from the last message"},
+			{Name: "offset_chat_id", Type: "int53", Description: "The chat identifier of the last found message, or 0 for the first request"},
+			{Name: "offset_message_id", Type: "int53", Description: "The message identifier of the last found message, or 0 for the first request"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of messages to be returned; up to 100. For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit"},
+			{Name: "filter", Type: "SearchMessagesFilter", Description: "Additional filter for messages to search; pass null to search for all messages. Filters searchMessagesFilterMention, searchMessagesFilterUnreadMention, searchMessagesFilterFailedToSend and searchMessagesFilterPinned are unsupported in this function"},
+			{Name: "min_date", Type: "int32", Description: "If not 0, the minimum date of the messages to return"},
+			{Name: "max_date", Type: "int32", Description: "If not 0, the maximum date of the messages to return"},
+		},
+		Result: "Messages",
+	},
+	{
+		Name:        "searchCallMessages",
+		Description: "Searches for call messages. Returns the results in reverse chronological order (i. e., in order of decreasing message_id). For optimal performance, the number of returned messages is chosen by TDLib",
+		Params: []ParamSchema{
+			{Name: "from_message_id", Type: "int53", Description: "Identifier of the message from which to search; use 0 to get results from the last message"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of messages to be returned; up to 100. For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit"},
+			{Name: "only_missed", Type: "Bool", Description: "If true, returns only messages with missed/declined calls"},
+		},
+		Result: "Messages",
+	},
+	{
+		Name:        "searchChatRecentLocationMessages",
+		Description: "Returns information about the recent locations of chat members that were sent to the chat. Returns up to 1 location message per user",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of messages to be returned"},
+		},
+		Result: "Messages",
+	},
+	{
+		Name:        "getActiveLiveLocationMessages",
+		Description: "Returns all active live locations that need to be updated by the application. The list is persistent across application restarts only if the message database is used",
+		Result:      "Messages",
+	},
+	{
+		Name:        "getChatScheduledMessages",
+		Description: "Returns all scheduled messages in a chat. The messages are returned in a reverse chronological order (i.e., in order of decreasing message_id)",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+		},
+		Result: "Messages",
+	},
+	{
+		Name:        "sendMessageAlbum",
+		Description: "Sends 2-10 messages grouped together into an album. Currently, only audio, document, photo and video messages can be grouped into an album. Documents and audio files can be only grouped in an album with messages of the same type. Returns sent messages",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Target chat"},
+			{Name: "message_thread_id", Type: "int53", Description: "If not 0, a message thread identifier in which the messages will be sent"},
+			{Name: "reply_to_message_id", Type: "int53", Description: "Identifier of a message to reply to or 0"},
+			{Name: "options", Type: "messageSendOptions", Description: "Options to be used to send the messages; pass null to use default options"},
+			{Name: "input_message_contents", Type: "vector<InputMessageContent>", Description: "Contents of messages to be sent. At most 10 messages can be added to an album"},
+		},
+		Result: "Messages",
+	},
+	{
+		Name:        "forwardMessages",
+		Description: "Forwards previously sent messages. Returns the forwarded messages in the same order as the message identifiers passed in message_ids. If a message can't be forwarded, null will be returned instead of the message",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat to which to forward messages"},
+			{Name: "from_chat_id", Type: "int53", Description: "Identifier of the chat from which to forward messages"},
+			{Name: "message_ids", Type: "vector<int53>", Description: "Identifiers of the messages to forward. Message identifiers must be in a strictly increasing order. At most 100 messages can be forwarded simultaneously"},
+			{Name: "options", Type: "messageSendOptions", Description: "Options to be used to send the messages; pass null to use default options"},
+			{Name: "send_copy", Type: "Bool", Description: "If true, content of the messages will be copied without reference to the original sender. Always true if the messages are forwarded to a secret chat or are local"},
+			{Name: "remove_caption", Type: "Bool", Description: "If true, media caption of message copies will be removed. Ignored if send_copy is false"},
+			{Name: "only_preview", Type: "Bool", Description: "If true, messages will not be forwarded and instead fake messages will be returned"},
+		},
+		Result: "Messages",
+	},
+	{
+		Name:        "resendMessages",
+		Description: "Resends messages which failed to send. Can be called only for messages for which messageSendingStateFailed.can_retry is true and after specified in messageSendingStateFailed.retry_after time passed. If a message is re-sent, the corresponding failed to send message is deleted. Returns the sent messages in the same order as the message identifiers passed in message_ids. If a message can't be re-sent, null will be returned instead of the message",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat to send messages"},
+			{Name: "message_ids", Type: "vector<int53>", Description: "Identifiers of the messages to resend. Message identifiers must be in a strictly increasing order"},
+		},
+		Result: "Messages",
+	},
+	{
+		Name:        "getNetworkStatistics",
+		Description: "Returns network data usage statistics. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "only_current", Type: "Bool", Description: "If true, returns only data for the current library launch"},
+		},
+		Result: "NetworkStatistics",
+	},
+	{
+		Name:        "setTdlibParameters",
+		Description: "Sets the parameters for TDLib initialization. Works only when the current authorization state is authorizationStateWaitTdlibParameters",
+		Params: []ParamSchema{
+			{Name: "parameters", Type: "tdlibParameters", Description: "Parameters for TDLib initialization"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "checkDatabaseEncryptionKey",
+		Description: "Checks the database encryption key for correctness. Works only when the current authorization state is authorizationStateWaitEncryptionKey",
+		Params: []ParamSchema{
+			{Name: "encryption_key", Type: "bytes", Description: "Encryption key to check or set up"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setAuthenticationPhoneNumber",
+		Description: "Sets the phone number of the user and sends an authentication code to the user. Works only when the current authorization state is authorizationStateWaitPhoneNumber, or if there is no pending authentication query and the current authorization state is authorizationStateWaitCode, authorizationStateWaitRegistration, or authorizationStateWaitPassword",
+		Params: []ParamSchema{
+			{Name: "phone_number", Type: "string", Description: "The phone number of the user, in international format"},
+			{Name: "settings", Type: "phoneNumberAuthenticationSettings", Description: "Settings for the authentication of the user's phone number; pass null to use default settings"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "resendAuthenticationCode",
+		Description: "Re-sends an authentication code to the user. Works only when the current authorization state is authorizationStateWaitCode, the next_code_type of the result is not null and the server-specified timeout has passed",
+		Result:      "Ok",
+	},
+	{
+		Name:        "checkAuthenticationCode",
+		Description: "Checks the authentication code. Works only when the current authorization state is authorizationStateWaitCode",
+		Params: []ParamSchema{
+			{Name: "code", Type: "string", Description: "Authentication code to check"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "requestQrCodeAuthentication",
+		Description: "Requests QR code authentication by scanning a QR code on another logged in device. Works only when the current authorization state is authorizationStateWaitPhoneNumber, or if there is no pending authentication query and the current authorization state is authorizationStateWaitCode, authorizationStateWaitRegistration, or authorizationStateWaitPassword",
+		Params: []ParamSchema{
+			{Name: "other_user_ids", Type: "vector<int53>", Description: "List of user identifiers of other users currently using the application"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "registerUser",
+		Description: "Finishes user registration. Works only when the current authorization state is authorizationStateWaitRegistration",
+		Params: []ParamSchema{
+			{Name: "first_name", Type: "string", Description: "The first name of the user; 1-64 characters"},
+			{Name: "last_name", Type: "string", Description: "The last name of the user; 0-64 characters"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "checkAuthenticationPassword",
+		Description: "Checks the authentication password for correctness. Works only when the current authorization state is authorizationStateWaitPassword",
+		Params: []ParamSchema{
+			{Name: "password", Type: "string", Description: "The password to check"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "requestAuthenticationPasswordRecovery",
+		Description: "Requests to send a password recovery code to an email address that was previously set up. Works only when the current authorization state is authorizationStateWaitPassword",
+		Result:      "Ok",
+	},
+	{
+		Name:        "checkAuthenticationPasswordRecoveryCode",
+		Description: "Checks whether a password recovery code sent to an email address is valid. Works only when the current authorization state is authorizationStateWaitPassword",
+		Params: []ParamSchema{
+			{Name: "recovery_code", Type: "string", Description: "Recovery code to check"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "recoverAuthenticationPassword",
+		Description: "Recovers the password with a password recovery code sent to an email address that was previously set up. Works only when the current authorization state is authorizationStateWaitPassword",
+		Params: []ParamSchema{
+			{Name: "recovery_code", Type: "string", Description: "Recovery code to check"},
+			{Name: "new_password", Type: "string", Description: "New password of the user; may be empty to remove the password"},
+			{Name: "new_hint", Type: "string", Description: "New password hint; may be empty"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "checkAuthenticationBotToken",
+		Description: "Checks the authentication token of a bot; to log in as a bot. Works only when the current authorization state is authorizationStateWaitPhoneNumber. Can be used instead of setAuthenticationPhoneNumber and checkAuthenticationCode to log in",
+		Params: []ParamSchema{
+			{Name: "token", Type: "string", Description: "The bot token"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "logOut",
+		Description: "Closes the TDLib instance after a proper logout. Requires an available network connection. All local data will be destroyed. After the logout completes, updateAuthorizationState with authorizationStateClosed will be sent",
+		Result:      "Ok",
+	},
+	{
+		Name:        "close",
+		Description: "Closes the TDLib instance. All databases will be flushed to disk and properly closed. After the close completes, updateAuthorizationState with authorizationStateClosed will be sent. Can be called before initialization",
+		Result:      "Ok",
+	},
+	{
+		Name:        "destroy",
+		Description: "Closes the TDLib instance, destroying all local data without a proper logout. The current user session will remain in the list of all active sessions. All local data will be destroyed. After the destruction completes updateAuthorizationState with authorizationStateClosed will be sent. Can be called before authorization",
+		Result:      "Ok",
+	},
+	{
+		Name:        "setDatabaseEncryptionKey",
+		Description: "Changes the database encryption key. Usually the encryption key is never changed and is stored in some OS keychain",
+		Params: []ParamSchema{
+			{Name: "new_encryption_key", Type: "bytes", Description: "New encryption key"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "checkPasswordRecoveryCode",
+		Description: "Checks whether a 2-step verification password recovery code sent to an email address is valid",
+		Params: []ParamSchema{
+			{Name: "recovery_code", Type: "string", Description: "Recovery code to check"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "cancelPasswordReset",
+		Description: "Cancels reset of 2-step verification password. The method can be called if passwordState.pending_reset_date > 0",
+		Result:      "Ok",
+	},
+	{
+		Name:        "loadChats",
+		Description: "Loads more chats from a chat list. The loaded chats and their positions in the chat list will be sent through updates. Chats are sorted by the pair (chat.position.order, chat.id) in descending order. Returns a 404 error if all chats have been loaded",
+		Params: []ParamSchema{
+			{Name: "chat_list", Type: "ChatList", Description: "The chat list in which to load chats; pass null to load chats from the main chat list"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of chats to be loaded. For optimal performance, the number of loaded chats is chosen by TDLib and can be smaller than the specified limit, even if the end of the list is not reached"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "removeTopChat",
+		Description: "Removes a chat from the list of frequently used chats. Supported only if the chat info database is enabled",
+		Params: []ParamSchema{
+			{Name: "category", Type: "TopChatCategory", Description: "Category of frequently used chats"},
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "addRecentlyFoundChat",
+		Description: "Adds a chat to the list of recently found chats. The chat is added to the beginning of the list. If the chat is already in the list, it will be removed from the list first",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat to add"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "removeRecentlyFoundChat",
+		Description: "Removes a chat from the list of recently found chats",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat to be removed"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "clearRecentlyFoundChats",
+		Description: "Clears the list of recently found chats",
+		Result:      "Ok",
+	},
+	{
+		Name:        "checkCreatedPublicChatsLimit",
+		Description: "Checks whether the maximum number of owned public chats has been reached. Returns corresponding error if the limit was reached",
+		Params: []ParamSchema{
+			{Name: "type", Type: "PublicChatType", Description: "Type of the public chats, for which to check the limit"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "deleteChatHistory",
+		Description: "Deletes all messages in the chat. Use chat.can_be_deleted_only_for_self and chat.can_be_deleted_for_all_users fields to find whether and how the method can be applied to the chat",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "remove_from_chat_list", Type: "Bool", Description: "Pass true if the chat needs to be removed from the chat list"},
+			{Name: "revoke", Type: "Bool", Description: "Pass true to delete chat history for all users"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "deleteChat",
+		Description: "Deletes a chat along with all messages in the corresponding chat for all chat members; requires owner privileges. For group chats this will release the username and remove all members. Chats with more than 1000 members can't be deleted using this method",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "deleteAllCallMessages",
+		Description: "Deletes all call messages",
+		Params: []ParamSchema{
+			{Name: "revoke", Type: "Bool", Description: "Pass true to delete the messages for all users"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "removeNotification",
+		Description: "Removes an active notification from notification list. Needs to be called only if the notification is removed by the current user",
+		Params: []ParamSchema{
+			{Name: "notification_group_id", Type: "int32", Description: "Identifier of notification group to which the notification belongs"},
+			{Name: "notification_id", Type: "int32", Description: "Identifier of removed notification"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "removeNotificationGroup",
+		Description: "Removes a group of active notifications. Needs to be called only if the notification group is removed by the current user",
+		Params: []ParamSchema{
+			{Name: "notification_group_id", Type: "int32", Description: "Notification group identifier"},
+			{Name: "max_notification_id", Type: "int32", Description: "The maximum identifier of removed notifications"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setChatMessageSender",
+		Description: "Selects a message sender to send messages in a chat",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "message_sender_id", Type: "MessageSender", Description: "New message sender for the chat"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "sendChatScreenshotTakenNotification",
+		Description: "Sends a notification about a screenshot taken in a chat. Supported only in private and secret chats",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "deleteMessages",
+		Description: "Deletes messages",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "message_ids", Type: "vector<int53>", Description: "Identifiers of the messages to be deleted"},
+			{Name: "revoke", Type: "Bool", Description: "Pass true to delete messages for all chat members. Always true for supergroups, channels and secret chats"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "deleteChatMessagesBySender",
+		Description: "Deletes all messages sent by the specified message sender in a chat. Supported only for supergroups; requires can_delete_messages administrator privileges",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "sender_id", Type: "MessageSender", Description: "Identifier of the sender of messages to delete"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "deleteChatMessagesByDate",
+		Description: "Deletes all messages between the specified dates in a chat. Supported only for private chats and basic groups. Messages sent in the last 30 seconds will not be deleted",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "min_date", Type: "int32", Description: "The minimum date of the messages to delete"},
+			{Name: "max_date", Type: "int32", Description: "The maximum date of the messages to delete"},
+			{Name: "revoke", Type: "Bool", Description: "Pass true to delete chat messages for all users; private chats only"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "editInlineMessageText",
+		Description: "Edits the text of an inline text or game message sent via a bot; for bots only",
+		Params: []ParamSchema{
+			{Name: "inline_message_id", Type: "string", Description: "Inline message identifier"},
+			{Name: "reply_markup", Type: "ReplyMarkup", Description: "The new message reply markup; pass null if none"},
+			{Name: "input_message_content", Type: "InputMessageContent", Description: "New text content of the message. Must be of type inputMessageText"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "editInlineMessageLiveLocation",
+		Description: "Edits the content of a live location in an inline message sent via a bot; for bots only",
+		Params: []ParamSchema{
+			{Name: "inline_message_id", Type: "string", Description: "Inline message identifier"},
+			{Name: "reply_markup", Type: "ReplyMarkup", Description: "The new message reply markup; pass null if none"},
+			{Name: "location", Type: "location", Description: "New location content of the message; pass null to stop sharing the live location"},
+			{Name: "heading", Type: "int32", Description: "The new direction in which the location moves, in degrees; 1-360. Pass 0 if unknown"},
+			{Name: "proximity_alert_radius", Type: "int32", Description: "The new maximum distance for proximity alerts, in meters (0-100000). Pass 0 if the notification is disabled"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "editInlineMessageMedia",
+		Description: "Edits the content of a message with an animation, an audio, a document, a photo or a video in an inline message sent via a bot; for bots only",
+		Params: []ParamSchema{
+			{Name: "inline_message_id", Type: "string", Description: "Inline message identifier"},
+			{Name: "reply_markup", Type: "ReplyMarkup", Description: "The new message reply markup; pass null if none; for bots only"},
+			{Name: "input_message_content", Type: "InputMessageContent", Description: "New content of the message. Must be one of the following types: inputMessageAnimation, inputMessageAudio, inputMessageDocument, inputMessagePhoto or inputMessageVideo"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "editInlineMessageCaption",
+		Description: "Edits the caption of an inline message sent via a bot; for bots only",
+		Params: []ParamSchema{
+			{Name: "inline_message_id", Type: "string", Description: "Inline message identifier"},
+			{Name: "reply_markup", Type: "ReplyMarkup", Description: "The new message reply markup; pass null if none"},
+			{Name: "caption", Type: "formattedText", Description: "New message content caption; pass null to remove caption; 0-GetOption(\"message_caption_length_max\") characters"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "editInlineMessageReplyMarkup",
+		Description: "Edits the reply markup of an inline message sent via a bot; for bots only",
+		Params: []ParamSchema{
+			{Name: "inline_message_id", Type: "string", Description: "Inline message identifier"},
+			{Name: "reply_markup", Type: "ReplyMarkup", Description: "The new message reply markup; pass null if none"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "editMessageSchedulingState",
+		Description: "Edits the time when a scheduled message will be sent. Scheduling state of all messages in the same album or forwarded together with the message will be also changed",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "The chat the message belongs to"},
+			{Name: "message_id", Type: "int53", Description: "Identifier of the message"},
+			{Name: "scheduling_state", Type: "MessageSchedulingState", Description: "The new message scheduling state; pass null to send the message immediately"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setPollAnswer",
+		Description: "Changes the user answer to a poll. A poll in quiz mode can be answered only once",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat to which the poll belongs"},
+			{Name: "message_id", Type: "int53", Description: "Identifier of the message containing the poll"},
+			{Name: "option_ids", Type: "vector<int32>", Description: "0-based identifiers of answer options, chosen by the user. User can choose more than 1 answer option only is the poll allows multiple answers"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "stopPoll",
+		Description: "Stops a poll. A poll in a message can be stopped when the message has can_be_edited flag set",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat to which the poll belongs"},
+			{Name: "message_id", Type: "int53", Description: "Identifier of the message containing the poll"},
+			{Name: "reply_markup", Type: "ReplyMarkup", Description: "The new message reply markup; pass null if none; for bots only"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "hideSuggestedAction",
+		Description: "Hides a suggested action",
+		Params: []ParamSchema{
+			{Name: "action", Type: "SuggestedAction", Description: "Suggested action to hide"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "answerInlineQuery",
+		Description: "Sets the result of an inline query; for bots only",
+		Params: []ParamSchema{
+			{Name: "inline_query_id", Type: "int64", Description: "Identifier of the inline query"},
+			{Name: "is_personal", Type: "Bool", Description: "True, if the result of the query can be cached for the specified user"},
+			{Name: "results", Type: "vector<InputInlineQueryResult>", Description: "The results of the query"},
+			{Name: "cache_time", Type: "int32", Description: "Allowed time to cache the results of the query, in seconds"},
+			{Name: "next_offset", Type: "string", Description: "Offset for the next inline query; pass an empty string if there are no more results"},
+			{Name: "switch_pm_text", Type: "string", Description: "If non-empty, this text must be shown on the button that opens a private chat with the bot and sends a start message to the bot with the parameter switch_pm_parameter"},
+			{Name: "switch_pm_parameter", Type: "string", Description: "The parameter for the bot start message"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "answerCallbackQuery",
+		Description: "Sets the result of a callback query; for bots only",
+		Params: []ParamSchema{
+			{Name: "callback_query_id", Type: "int64", Description: "Identifier of the callback query"},
+			{Name: "text", Type: "string", Description: "Text of the answer"},
+			{Name: "show_alert", Type: "Bool", Description: "If true, an alert must be shown to the user instead of a toast notification"},
+			{Name: "url", Type: "string", Description: "URL to be opened"},
+			{Name: "cache_time", Type: "int32", Description: "Time during which the result of the query can be cached, in seconds"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "answerShippingQuery",
+		Description: "Sets the result of a shipping query; for bots only",
+		Params: []ParamSchema{
+			{Name: "shipping_query_id", Type: "int64", Description: "Identifier of the shipping query"},
+			{Name: "shipping_options", Type: "vector<shippingOption>", Description: "Available shipping options"},
+			{Name: "error_message", Type: "string", Description: "An error message, empty on success"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "answerPreCheckoutQuery",
+		Description: "Sets the result of a pre-checkout query; for bots only",
+		Params: []ParamSchema{
+			{Name: "pre_checkout_query_id", Type: "int64", Description: "Identifier of the pre-checkout query"},
+			{Name: "error_message", Type: "string", Description: "An error message, empty on success"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setInlineGameScore",
+		Description: "Updates the game score of the specified user in a game; for bots only",
+		Params: []ParamSchema{
+			{Name: "inline_message_id", Type: "string", Description: "Inline message identifier"},
+			{Name: "edit_message", Type: "Bool", Description: "True, if the message needs to be edited"},
+			{Name: "user_id", Type: "int53", Description: "User identifier"},
+			{Name: "score", Type: "int32", Description: "The new score"},
+			{Name: "force", Type: "Bool", Description: "Pass true to update the score even if it decreases. If the score is 0, the user will be deleted from the high score table"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "deleteChatReplyMarkup",
+		Description: "Deletes the default reply markup from a chat. Must be called after a one-time keyboard or a ForceReply reply markup has been used. UpdateChatReplyMarkup will be sent if the reply markup is changed",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "message_id", Type: "int53", Description: "The message identifier of the used keyboard"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "sendChatAction",
+		Description: "Sends a notification about user activity in a chat",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "message_thread_id", Type: "int53", Description: "If not 0, a message thread identifier in which the action was performed"},
+			{Name: "action", Type: "ChatAction", Description: "The action description; pass null to cancel the currently active action"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "openChat",
+		Description: "Informs TDLib that the chat is opened by the user. Many useful activities depend on the chat being opened or closed (e.g., in supergroups and channels all updates are received only for opened chats)",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "closeChat",
+		Description: "Informs TDLib that the chat is closed by the user. Many useful activities depend on the chat being opened or closed",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "viewMessages",
+		Description: "Informs TDLib that messages are being viewed by the user. Sponsored messages must be marked as viewed only when the entire text of the message is shown on the screen (excluding the button). Many useful activities depend on whether the messages are currently being viewed or not (e.g., marking messages as read, incrementing a view counter, updating a view counter, removing deleted messages in supergroups and channels)",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "message_thread_id", Type: "int53", Description: "If not 0, a message thread identifier in which the messages are being viewed"},
+			{Name: "message_ids", Type: "vector<int53>", Description: "The identifiers of the messages being viewed"},
+			{Name: "force_read", Type: "Bool", Description: "True, if messages in closed chats must be marked as read by the request"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "openMessageContent",
+		Description: "Informs TDLib that the message content has been opened (e.g., the user has opened a photo, video, document, location or venue, or has listened to an audio file or voice note message). An updateMessageContentOpened update will be generated if something has changed",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier of the message"},
+			{Name: "message_id", Type: "int53", Description: "Identifier of the message with the opened content"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "readAllChatMentions",
+		Description: "Marks all mentions in a chat as read",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "addChatToList",
+		Description: "Adds a chat to a chat list. A chat can't be simultaneously in Main and Archive chat lists, so it is automatically removed from another one if needed",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "chat_list", Type: "ChatList", Description: "The chat list. Use getChatListsToAddChat to get suitable chat lists"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "deleteChatFilter",
+		Description: "Deletes existing chat filter",
+		Params: []ParamSchema{
+			{Name: "chat_filter_id", Type: "int32", Description: "Chat filter identifier"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "reorderChatFilters",
+		Description: "Changes the order of chat filters",
+		Params: []ParamSchema{
+			{Name: "chat_filter_ids", Type: "vector<int32>", Description: "Identifiers of chat filters in the new correct order"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setChatTitle",
+		Description: "Changes the chat title. Supported only for basic groups, supergroups and channels. Requires can_change_info administrator right",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "title", Type: "string", Description: "New title of the chat; 1-128 characters"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setChatPhoto",
+		Description: "Changes the photo of a chat. Supported only for basic groups, supergroups and channels. Requires can_change_info administrator right",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "photo", Type: "InputChatPhoto", Description: "New chat photo; pass null to delete the chat photo"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setChatMessageTtl",
+		Description: "Changes the message TTL in a chat. Requires can_delete_messages administrator right in basic groups, supergroups and channels Message TTL can't be changed in a chat with the current user (Saved Messages) and the chat 777000 (Telegram)",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "ttl", Type: "int32", Description: "New TTL value, in seconds; must be one of 0, 86400, 7 * 86400, or 31 * 86400 unless the chat is secret"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setChatPermissions",
+		Description: "Changes the chat members permissions. Supported only for basic groups and supergroups. Requires can_restrict_members administrator right",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "permissions", Type: "chatPermissions", Description: "New non-administrator members permissions in the chat"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setChatTheme",
+		Description: "Changes the chat theme. Supported only in private and secret chats",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "theme_name", Type: "string", Description: "Name of the new chat theme; pass an empty string to return the default theme"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setChatDraftMessage",
+		Description: "Changes the draft message in a chat",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "message_thread_id", Type: "int53", Description: "If not 0, a message thread identifier in which the draft was changed"},
+			{Name: "draft_message", Type: "draftMessage", Description: "New draft message; pass null to remove the draft"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setChatNotificationSettings",
+		Description: "Changes the notification settings of a chat. Notification settings of a chat with the current user (Saved Messages) can't be changed",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "notification_settings", Type: "chatNotificationSettings", Description: "New notification settings for the chat. If the chat is muted for more than 1 week, it is considered to be muted forever"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "toggleChatHasProtectedContent",
+		Description: "Changes the ability of users to save, forward, or copy chat content. Supported only for basic groups, supergroups and channels. Requires owner privileges",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "has_protected_content", Type: "Bool", Description: "True, if chat content can't be saved locally, forwarded, or copied"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "toggleChatIsMarkedAsUnread",
+		Description: "Changes the marked as unread state of a chat",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "is_marked_as_unread", Type: "Bool", Description: "New value of is_marked_as_unread"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "toggleChatDefaultDisableNotification",
+		Description: "Changes the value of the default disable_notification parameter, used when a message is sent to a chat",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "default_disable_notification", Type: "Bool", Description: "New value of default_disable_notification"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setChatClientData",
+		Description: "Changes application-specific data associated with a chat",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "client_data", Type: "string", Description: "New value of client_data"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setChatDescription",
+		Description: "Changes information about a chat. Available for basic groups, supergroups, and channels. Requires can_change_info administrator right",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat"},
+			{Name: "description", Type: "string", Description: "New chat description; 0-255 characters"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setChatDiscussionGroup",
+		Description: "Changes the discussion group of a channel chat; requires can_change_info administrator right in the channel if it is specified",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the channel chat. Pass 0 to remove a link from the supergroup passed in the second argument to a linked channel chat (requires can_pin_messages rights in the supergroup)"},
+			{Name: "discussion_chat_id", Type: "int53", Description: "Identifier of a new channel's discussion group. Use 0 to remove the discussion group. Use the method getSuitableDiscussionChats to find all suitable groups. Basic group chats must be first upgraded to supergroup chats. If new chat members don't have access to old messages in the supergroup, then toggleSupergroupIsAllHistoryAvailable must be used first to change that"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setChatLocation",
+		Description: "Changes the location of a chat. Available only for some location-based supergroups, use supergroupFullInfo.can_set_location to check whether the method is allowed to use",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "location", Type: "chatLocation", Description: "New location for the chat; must be valid and not null"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setChatSlowModeDelay",
+		Description: "Changes the slow mode delay of a chat. Available only for supergroups; requires can_restrict_members rights",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "slow_mode_delay", Type: "int32", Description: "New slow mode delay for the chat, in seconds; must be one of 0, 10, 30, 60, 300, 900, 3600"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "pinChatMessage",
+		Description: "Pins a message in a chat; requires can_pin_messages rights or can_edit_messages rights in the channel",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat"},
+			{Name: "message_id", Type: "int53", Description: "Identifier of the new pinned message"},
+			{Name: "disable_notification", Type: "Bool", Description: "True, if there must be no notification about the pinned message. Notifications are always disabled in channels and private chats"},
+			{Name: "only_for_self", Type: "Bool", Description: "True, if the message needs to be pinned for one side only; private chats only"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "unpinChatMessage",
+		Description: "Removes a pinned message from a chat; requires can_pin_messages rights in the group or can_edit_messages rights in the channel",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat"},
+			{Name: "message_id", Type: "int53", Description: "Identifier of the removed pinned message"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "unpinAllChatMessages",
+		Description: "Removes all pinned messages from a chat; requires can_pin_messages rights in the group or can_edit_messages rights in the channel",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "joinChat",
+		Description: "Adds the current user as a new member to a chat. Private and secret chats can't be joined using this method",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "leaveChat",
+		Description: "Removes the current user from chat members. Private and secret chats can't be left using this method",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "addChatMember",
+		Description: "Adds a new member to a chat. Members can't be added to private or secret chats",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "user_id", Type: "int53", Description: "Identifier of the user"},
+			{Name: "forward_limit", Type: "int32", Description: "The number of earlier messages from the chat to be forwarded to the new member; up to 100. Ignored for supergroups and channels, or if the added user is a bot"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "addChatMembers",
+		Description: "Adds multiple new members to a chat. Currently, this method is only available for supergroups and channels. This method can't be used to join a chat. Members can't be added to a channel if it has more than 200 members",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "user_ids", Type: "vector<int53>", Description: "Identifiers of the users to be added to the chat. The maximum number of added users is 20 for supergroups and 100 for channels"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setChatMemberStatus",
+		Description: "Changes the status of a chat member, needs appropriate privileges. This function is currently not suitable for transferring chat ownership; use transferChatOwnership instead. Use addChatMember or banChatMember if some additional parameters needs to be passed",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "member_id", Type: "MessageSender", Description: "Member identifier. Chats can be only banned and unbanned in supergroups and channels"},
+			{Name: "status", Type: "ChatMemberStatus", Description: "The new status of the member in the chat"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "banChatMember",
+		Description: "Bans a member in a chat. Members can't be banned in private or secret chats. In supergroups and channels, the user will not be able to return to the group on their own using invite links, etc., unless unbanned first",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "member_id", Type: "MessageSender", Description: "Member identifier"},
+			{Name: "banned_until_date", Type: "int32", Description: "Point in time (Unix timestamp) when the user will be unbanned; 0 if never. If the user is banned for more than 366 days or for less than 30 seconds from the current time, the user is considered to be banned forever. Ignored in basic groups and if a chat is banned"},
+			{Name: "revoke_messages", Type: "Bool", Description: "Pass true to delete all messages in the chat for the user that is being removed. Always true for supergroups and channels"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "transferChatOwnership",
+		Description: "Changes the owner of a chat. The current user must be a current owner of the chat. Use the method canTransferOwnership to check whether the ownership can be transferred from the current session. Available only for supergroups and channel chats",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "user_id", Type: "int53", Description: "Identifier of the user to which transfer the ownership. The ownership can't be transferred to a bot or to a deleted user"},
+			{Name: "password", Type: "string", Description: "The password of the current user"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "clearAllDraftMessages",
+		Description: "Clears draft messages in all chats",
+		Params: []ParamSchema{
+			{Name: "exclude_secret_chats", Type: "Bool", Description: "If true, local draft messages in secret chats will not be cleared"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setScopeNotificationSettings",
+		Description: "Changes notification settings for chats of a given type",
+		Params: []ParamSchema{
+			{Name: "scope", Type: "NotificationSettingsScope", Description: "Types of chats for which to change the notification settings"},
+			{Name: "notification_settings", Type: "scopeNotificationSettings", Description: "The new notification settings for the given scope"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "resetAllNotificationSettings",
+		Description: "Resets all notification settings to their default values. By default, all chats are unmuted, the sound is set to \"default\" and message previews are shown",
+		Result:      "Ok",
+	},
+	{
+		Name:        "toggleChatIsPinned",
+		Description: "Changes the pinned state of a chat. There can be up to GetOption(\"pinned_chat_count_max\")/GetOption(\"pinned_archived_chat_count_max\") pinned non-secret chats and the same number of secret chats in the main/arhive chat list",
+		Params: []ParamSchema{
+			{Name: "chat_list", Type: "ChatList", Description: "Chat list in which to change the pinned state of the chat"},
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "is_pinned", Type: "Bool", Description: "True, if the chat is pinned"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setPinnedChats",
+		Description: "Changes the order of pinned chats",
+		Params: []ParamSchema{
+			{Name: "chat_list", Type: "ChatList", Description: "Chat list in which to change the order of pinned chats"},
+			{Name: "chat_ids", Type: "vector<int53>", Description: "The new list of pinned chats"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "cancelDownloadFile",
+		Description: "Stops the downloading of a file. If a file has already been downloaded, does nothing",
+		Params: []ParamSchema{
+			{Name: "file_id", Type: "int32", Description: "Identifier of a file to stop downloading"},
+			{Name: "only_if_pending", Type: "Bool", Description: "Pass true to stop downloading only if it hasn't been started, i.e. request hasn't been sent to server"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "cancelUploadFile",
+		Description: "Stops the uploading of a file. Supported only for files uploaded by using uploadFile. For other files the behavior is undefined",
+		Params: []ParamSchema{
+			{Name: "file_id", Type: "int32", Description: "Identifier of the file to stop uploading"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "writeGeneratedFilePart",
+		Description: "Writes a part of a generated file. This method is intended to be used only if the application has no direct access to TDLib's file system, because it is usually slower than a direct write to the destination file",
+		Params: []ParamSchema{
+			{Name: "generation_id", Type: "int64", Description: "The identifier of the generation process"},
+			{Name: "offset", Type: "int32", Description: "The offset from which to write the data to the file"},
+			{Name: "data", Type: "bytes", Description: "The data to write"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setFileGenerationProgress",
+		Description: "Informs TDLib on a file generation progress",
+		Params: []ParamSchema{
+			{Name: "generation_id", Type: "int64", Description: "The identifier of the generation process"},
+			{Name: "expected_size", Type: "int32", Description: "Expected size of the generated file, in bytes; 0 if unknown"},
+			{Name: "local_prefix_size", Type: "int32", Description: "The number of bytes already generated"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "finishFileGeneration",
+		Description: "Finishes the file generation",
+		Params: []ParamSchema{
+			{Name: "generation_id", Type: "int64", Description: "The identifier of the generation process"},
+			{Name: "error", Type: "error", Description: "If passed, the file generation has failed and must be terminated; pass null if the file generation succeeded"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "deleteFile",
+		Description: "Deletes a file from the TDLib file cache",
+		Params: []ParamSchema{
+			{Name: "file_id", Type: "int32", Description: "Identifier of the file to delete"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "importMessages",
+		Description: "Imports messages exported from another app",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of a chat to which the messages will be imported. It must be an identifier of a private chat with a mutual contact or an identifier of a supergroup chat with can_change_info administrator right"},
+			{Name: "message_file", Type: "InputFile", Description: "File with messages to import. Only inputFileLocal and inputFileGenerated are supported. The file must not be previously uploaded"},
+			{Name: "attached_files", Type: "vector<InputFile>", Description: "Files used in the imported messages. Only inputFileLocal and inputFileGenerated are supported. The files must not be previously uploaded"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "deleteRevokedChatInviteLink",
+		Description: "Deletes revoked chat invite links. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "invite_link", Type: "string", Description: "Invite link to revoke"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "deleteAllRevokedChatInviteLinks",
+		Description: "Deletes all revoked chat invite links created by a given chat administrator. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "creator_user_id", Type: "int53", Description: "User identifier of a chat administrator, which links will be deleted. Must be an identifier of the current user for non-owner"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "processChatJoinRequest",
+		Description: "Handles a pending join request in a chat",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "user_id", Type: "int53", Description: "Identifier of the user that sent the request"},
+			{Name: "approve", Type: "Bool", Description: "True, if the request is approved. Otherwise the request is declived"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "processChatJoinRequests",
+		Description: "Handles all pending join requests for a given link in a chat",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "invite_link", Type: "string", Description: "Invite link for which to process join requests. If empty, all join requests will be processed. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links"},
+			{Name: "approve", Type: "Bool", Description: "True, if the requests are approved. Otherwise the requests are declived"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "acceptCall",
+		Description: "Accepts an incoming call",
+		Params: []ParamSchema{
+			{Name: "call_id", Type: "int32", Description: "Call identifier"},
+			{Name: "protocol", Type: "callProtocol", Description: "The call protocols supported by the application"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "sendCallSignalingData",
+		Description: "Sends call signaling data",
+		Params: []ParamSchema{
+			{Name: "call_id", Type: "int32", Description: "Call identifier"},
+			{Name: "data", Type: "bytes", Description: "The data"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "discardCall",
+		Description: "Discards a call",
+		Params: []ParamSchema{
+			{Name: "call_id", Type: "int32", Description: "Call identifier"},
+			{Name: "is_disconnected", Type: "Bool", Description: "True, if the user was disconnected"},
+			{Name: "duration", Type: "int32", Description: "The call duration, in seconds"},
+			{Name: "is_video", Type: "Bool", Description: "True, if the call was a video call"},
+			{Name: "connection_id", Type: "int64", Description: "Identifier of the connection used during the call"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "sendCallRating",
+		Description: "Sends a call rating",
+		Params: []ParamSchema{
+			{Name: "call_id", Type: "int32", Description: "Call identifier"},
+			{Name: "rating", Type: "int32", Description: "Call rating; 1-5"},
+			{Name: "comment", Type: "string", Description: "An optional user comment if the rating is less than 5"},
+			{Name: "problems", Type: "vector<CallProblem>", Description: "List of the exact types of problems with the call, specified by the user"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "sendCallDebugInformation",
+		Description: "Sends debug information for a call",
+		Params: []ParamSchema{
+			{Name: "call_id", Type: "int32", Description: "Call identifier"},
+			{Name: "debug_information", Type: "string", Description: "Debug information in application-specific format"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setVideoChatDefaultParticipant",
+		Description: "Changes default participant identifier, on whose behalf a video chat in the chat will be joined",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "default_participant_id", Type: "MessageSender", Description: "Default group call participant identifier to join the video chats"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "startScheduledGroupCall",
+		Description: "Starts a scheduled group call",
+		Params: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Group call identifier"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "toggleGroupCallEnabledStartNotification",
+		Description: "Toggles whether the current user will receive a notification when the group call will start; scheduled group calls only",
+		Params: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Group call identifier"},
+			{Name: "enabled_start_notification", Type: "Bool", Description: "New value of the enabled_start_notification setting"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "toggleGroupCallScreenSharingIsPaused",
+		Description: "Pauses or unpauses screen sharing in a joined group call",
+		Params: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Group call identifier"},
+			{Name: "is_paused", Type: "Bool", Description: "True if screen sharing is paused"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "endGroupCallScreenSharing",
+		Description: "Ends screen sharing in a joined group call",
+		Params: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Group call identifier"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setGroupCallTitle",
+		Description: "Sets group call title. Requires groupCall.can_be_managed group call flag",
+		Params: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Group call identifier"},
+			{Name: "title", Type: "string", Description: "New group call title; 1-64 characters"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "toggleGroupCallMuteNewParticipants",
+		Description: "Toggles whether new participants of a group call can be unmuted only by administrators of the group call. Requires groupCall.can_toggle_mute_new_participants group call flag",
+		Params: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Group call identifier"},
+			{Name: "mute_new_participants", Type: "Bool", Description: "New value of the mute_new_participants setting"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "inviteGroupCallParticipants",
+		Description: "Invites users to an active group call. Sends a service message of type messageInviteToGroupCall for video chats",
+		Params: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Group call identifier"},
+			{Name: "user_ids", Type: "vector<int53>", Description: "User identifiers. At most 10 users can be invited simultaneously"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "revokeGroupCallInviteLink",
+		Description: "Revokes invite link for a group call. Requires groupCall.can_be_managed group call flag",
+		Params: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Group call identifier"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "startGroupCallRecording",
+		Description: "Starts recording of an active group call. Requires groupCall.can_be_managed group call flag",
+		Params: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Group call identifier"},
+			{Name: "title", Type: "string", Description: "Group call recording title; 0-64 characters"},
+			{Name: "record_video", Type: "Bool", Description: "Pass true to record a video file instead of an audio file"},
+			{Name: "use_portrait_orientation", Type: "Bool", Description: "Pass true to use portrait orientation for video instead of landscape one"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "endGroupCallRecording",
+		Description: "Ends recording of an active group call. Requires groupCall.can_be_managed group call flag",
+		Params: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Group call identifier"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "toggleGroupCallIsMyVideoPaused",
+		Description: "Toggles whether current user's video is paused",
+		Params: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Group call identifier"},
+			{Name: "is_my_video_paused", Type: "Bool", Description: "Pass true if the current user's video is paused"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "toggleGroupCallIsMyVideoEnabled",
+		Description: "Toggles whether current user's video is enabled",
+		Params: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Group call identifier"},
+			{Name: "is_my_video_enabled", Type: "Bool", Description: "Pass true if the current user's video is enabled"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setGroupCallParticipantIsSpeaking",
+		Description: "Informs TDLib that speaking state of a participant of an active group has changed",
+		Params: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Group call identifier"},
+			{Name: "audio_source", Type: "int32", Description: "Group call participant's synchronization audio source identifier, or 0 for the current user"},
+			{Name: "is_speaking", Type: "Bool", Description: "True, if the user is speaking"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "toggleGroupCallParticipantIsMuted",
+		Description: "Toggles whether a participant of an active group call is muted, unmuted, or allowed to unmute themselves",
+		Params: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Group call identifier"},
+			{Name: "participant_id", Type: "MessageSender", Description: "Participant identifier"},
+			{Name: "is_muted", Type: "Bool", Description: "Pass true if the user must be muted and false otherwise"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setGroupCallParticipantVolumeLevel",
+		Description: "Changes volume level of a participant of an active group call. If the current user can manage the group call, then the participant's volume level will be changed for all users with the default volume level",
+		Params: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Group call identifier"},
+			{Name: "participant_id", Type: "MessageSender", Description: "Participant identifier"},
+			{Name: "volume_level", Type: "int32", Description: "New participant's volume level; 1-20000 in hundreds of percents"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "toggleGroupCallParticipantIsHandRaised",
+		Description: "Toggles whether a group call participant hand is rased",
+		Params: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Group call identifier"},
+			{Name: "participant_id", Type: "MessageSender", Description: "Participant identifier"},
+			{Name: "is_hand_raised", Type: "Bool", Description: "Pass true if the user's hand needs to be raised. Only self hand can be raised. Requires groupCall.can_be_managed group call flag to lower other's hand"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "loadGroupCallParticipants",
+		Description: "Loads more participants of a group call. The loaded participants will be received through updates. Use the field groupCall.loaded_all_participants to check whether all participants have already been loaded",
+		Params: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Group call identifier. The group call must be previously received through getGroupCall and must be joined or being joined"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of participants to load; up to 100"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "leaveGroupCall",
+		Description: "Leaves a group call",
+		Params: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Group call identifier"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "endGroupCall",
+		Description: "Ends a group call. Requires groupCall.can_be_managed",
+		Params: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Group call identifier"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "toggleMessageSenderIsBlocked",
+		Description: "Changes the block state of a message sender. Currently, only users and supergroup chats can be blocked",
+		Params: []ParamSchema{
+			{Name: "sender_id", Type: "MessageSender", Description: "Identifier of a message sender to block/unblock"},
+			{Name: "is_blocked", Type: "Bool", Description: "New value of is_blocked"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "blockMessageSenderFromReplies",
+		Description: "Blocks an original sender of a message in the Replies chat",
+		Params: []ParamSchema{
+			{Name: "message_id", Type: "int53", Description: "The identifier of an incoming message in the Replies chat"},
+			{Name: "delete_message", Type: "Bool", Description: "Pass true if the message must be deleted"},
+			{Name: "delete_all_messages", Type: "Bool", Description: "Pass true if all messages from the same sender must be deleted"},
+			{Name: "report_spam", Type: "Bool", Description: "Pass true if the sender must be reported to the Telegram moderators"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "addContact",
+		Description: "Adds a user to the contact list or edits an existing contact by their user identifier",
+		Params: []ParamSchema{
+			{Name: "contact", Type: "contact", Description: "The contact to add or edit; phone number can be empty and needs to be specified only if known, vCard is ignored"},
+			{Name: "share_phone_number", Type: "Bool", Description: "True, if the new contact needs to be allowed to see current user's phone number. A corresponding rule to userPrivacySettingShowPhoneNumber will be added if needed. Use the field userFullInfo.need_phone_number_privacy_exception to check whether the current user needs to be asked to share their phone number"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "removeContacts",
+		Description: "Removes users from the contact list",
+		Params: []ParamSchema{
+			{Name: "user_ids", Type: "vector<int53>", Description: "Identifiers of users to be deleted"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "clearImportedContacts",
+		Description: "Clears all imported contacts, contact list remains unchanged",
+		Result:      "Ok",
+	},
+	{
+		Name:        "sharePhoneNumber",
+		Description: "Shares the phone number of the current user with a mutual contact. Supposed to be called when the user clicks on chatActionBarSharePhoneNumber",
+		Params: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "Identifier of the user with whom to share the phone number. The user must be a mutual contact"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "changeStickerSet",
+		Description: "Installs/uninstalls or activates/archives a sticker set",
+		Params: []ParamSchema{
+			{Name: "set_id", Type: "int64", Description: "Identifier of the sticker set"},
+			{Name: "is_installed", Type: "Bool", Description: "The new value of is_installed"},
+			{Name: "is_archived", Type: "Bool", Description: "The new value of is_archived. A sticker set can't be installed and archived simultaneously"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "viewTrendingStickerSets",
+		Description: "Informs the server that some trending sticker sets have been viewed by the user",
+		Params: []ParamSchema{
+			{Name: "sticker_set_ids", Type: "vector<int64>", Description: "Identifiers of viewed trending sticker sets"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "reorderInstalledStickerSets",
+		Description: "Changes the order of installed sticker sets",
+		Params: []ParamSchema{
+			{Name: "is_masks", Type: "Bool", Description: "Pass true to change the order of mask sticker sets; pass false to change the order of ordinary sticker sets"},
+			{Name: "sticker_set_ids", Type: "vector<int64>", Description: "Identifiers of installed sticker sets in the new correct order"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "removeRecentSticker",
+		Description: "Removes a sticker from the list of recently used stickers",
+		Params: []ParamSchema{
+			{Name: "is_attached", Type: "Bool", Description: "Pass true to remove the sticker from the list of stickers recently attached to photo or video files; pass false to remove the sticker from the list of recently sent stickers"},
+			{Name: "sticker", Type: "InputFile", Description: "Sticker file to delete"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "clearRecentStickers",
+		Description: "Clears the list of recently used stickers",
+		Params: []ParamSchema{
+			{Name: "is_attached", Type: "Bool", Description: "Pass true to clear the list of stickers recently attached to photo or video files; pass false to clear the list of recently sent stickers"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "addFavoriteSticker",
+		Description: "Adds a new sticker to the list of favorite stickers. The new sticker is added to the top of the list. If the sticker was already in the list, it is removed from the list first. Only stickers belonging to a sticker set can be added to this list",
+		Params: []ParamSchema{
+			{Name: "sticker", Type: "InputFile", Description: "Sticker file to add"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "removeFavoriteSticker",
+		Description: "Removes a sticker from the list of favorite stickers",
+		Params: []ParamSchema{
+			{Name: "sticker", Type: "InputFile", Description: "Sticker file to delete from the list"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "addSavedAnimation",
+		Description: "Manually adds a new animation to the list of saved animations. The new animation is added to the beginning of the list. If the animation was already in the list, it is removed first. Only non-secret video animations with MIME type \"video/mp4\" can be added to the list",
+		Params: []ParamSchema{
+			{Name: "animation", Type: "InputFile", Description: "The animation file to be added. Only animations known to the server (i.e., successfully sent via a message) can be added to the list"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "removeSavedAnimation",
+		Description: "Removes an animation from the list of saved animations",
+		Params: []ParamSchema{
+			{Name: "animation", Type: "InputFile", Description: "Animation file to be removed"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "removeRecentHashtag",
+		Description: "Removes a hashtag from the list of recently used hashtags",
+		Params: []ParamSchema{
+			{Name: "hashtag", Type: "string", Description: "Hashtag to delete"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setProfilePhoto",
+		Description: "Changes a profile photo for the current user",
+		Params: []ParamSchema{
+			{Name: "photo", Type: "InputChatPhoto", Description: "Profile photo to set"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "deleteProfilePhoto",
+		Description: "Deletes a profile photo",
+		Params: []ParamSchema{
+			{Name: "profile_photo_id", Type: "int64", Description: "Identifier of the profile photo to delete"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setName",
+		Description: "Changes the first and last name of the current user",
+		Params: []ParamSchema{
+			{Name: "first_name", Type: "string", Description: "The new value of the first name for the current user; 1-64 characters"},
+			{Name: "last_name", Type: "string", Description: "The new value of the optional last name for the current user; 0-64 characters"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setBio",
+		Description: "Changes the bio of the current user",
+		Params: []ParamSchema{
+			{Name: "bio", Type: "string", Description: "The new value of the user bio; 0-70 characters without line feeds"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setUsername",
+		Description: "Changes the username of the current user",
+		Params: []ParamSchema{
+			{Name: "username", Type: "string", Description: "The new value of the username. Use an empty string to remove the username"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setLocation",
+		Description: "Changes the location of the current user. Needs to be called if GetOption(\"is_location_visible\") is true and location changes for more than 1 kilometer",
+		Params: []ParamSchema{
+			{Name: "location", Type: "location", Description: "The new location of the user"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "checkChangePhoneNumberCode",
+		Description: "Checks the authentication code sent to confirm a new phone number of the user",
+		Params: []ParamSchema{
+			{Name: "code", Type: "string", Description: "Authentication code to check"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setCommands",
+		Description: "Sets the list of commands supported by the bot for the given user scope and language; for bots only",
+		Params: []ParamSchema{
+			{Name: "scope", Type: "BotCommandScope", Description: "The scope to which the commands are relevant; pass null to change commands in the default bot command scope"},
+			{Name: "language_code", Type: "string", Description: "A two-letter ISO 639-1 country code. If empty, the commands will be applied to all users from the given scope, for which language there are no dedicated commands"},
+			{Name: "commands", Type: "vector<botCommand>", Description: "List of the bot's commands"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "deleteCommands",
+		Description: "Deletes commands supported by the bot for the given user scope and language; for bots only",
+		Params: []ParamSchema{
+			{Name: "scope", Type: "BotCommandScope", Description: "The scope to which the commands are relevant; pass null to delete commands in the default bot command scope"},
+			{Name: "language_code", Type: "string", Description: "A two-letter ISO 639-1 country code or an empty string"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "terminateSession",
+		Description: "Terminates a session of the current user",
+		Params: []ParamSchema{
+			{Name: "session_id", Type: "int64", Description: "Session identifier"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "terminateAllOtherSessions",
+		Description: "Terminates all other sessions of the current user",
+		Result:      "Ok",
+	},
+	{
+		Name:        "toggleSessionCanAcceptCalls",
+		Description: "Toggles whether a session can accept incoming calls",
+		Params: []ParamSchema{
+			{Name: "session_id", Type: "int64", Description: "Session identifier"},
+			{Name: "can_accept_calls", Type: "Bool", Description: "True, if incoming calls can be accepted by the session"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "toggleSessionCanAcceptSecretChats",
+		Description: "Toggles whether a session can accept incoming secret chats",
+		Params: []ParamSchema{
+			{Name: "session_id", Type: "int64", Description: "Session identifier"},
+			{Name: "can_accept_secret_chats", Type: "Bool", Description: "True, if incoming secret chats can be accepted by the session"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setInactiveSessionTtl",
+		Description: "Changes the period of inactivity after which sessions will automatically be terminated",
+		Params: []ParamSchema{
+			{Name: "inactive_session_ttl_days", Type: "int32", Description: "New number of days of inactivity before sessions will be automatically terminated; 1-366 days"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "disconnectWebsite",
+		Description: "Disconnects website from the current user's Telegram account",
+		Params: []ParamSchema{
+			{Name: "website_id", Type: "int64", Description: "Website identifier"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "disconnectAllWebsites",
+		Description: "Disconnects all websites from the current user's Telegram account",
+		Result:      "Ok",
+	},
+	{
+		Name:        "setSupergroupUsername",
+		Description: "Changes the username of a supergroup or channel, requires owner privileges in the supergroup or channel",
+		Params: []ParamSchema{
+			{Name: "supergroup_id", Type: "int53", Description: "Identifier of the supergroup or channel"},
+			{Name: "username", Type: "string", Description: "New value of the username. Use an empty string to remove the username"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setSupergroupStickerSet",
+		Description: "Changes the sticker set of a supergroup; requires can_change_info administrator right",
+		Params: []ParamSchema{
+			{Name: "supergroup_id", Type: "int53", Description: "Identifier of the supergroup"},
+			{Name: "sticker_set_id", Type: "int64", Description: "New value of the supergroup sticker set identifier. Use 0 to remove the supergroup sticker set"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "toggleSupergroupSignMessages",
+		Description: "Toggles whether sender signature is added to sent messages in a channel; requires can_change_info administrator right",
+		Params: []ParamSchema{
+			{Name: "supergroup_id", Type: "int53", Description: "Identifier of the channel"},
+			{Name: "sign_messages", Type: "Bool", Description: "New value of sign_messages"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "toggleSupergroupIsAllHistoryAvailable",
+		Description: "Toggles whether the message history of a supergroup is available to new members; requires can_change_info administrator right",
+		Params: []ParamSchema{
+			{Name: "supergroup_id", Type: "int53", Description: "The identifier of the supergroup"},
+			{Name: "is_all_history_available", Type: "Bool", Description: "The new value of is_all_history_available"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "toggleSupergroupIsBroadcastGroup",
+		Description: "Upgrades supergroup to a broadcast group; requires owner privileges in the supergroup",
+		Params: []ParamSchema{
+			{Name: "supergroup_id", Type: "int53", Description: "Identifier of the supergroup"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "reportSupergroupSpam",
+		Description: "Reports messages in a supergroup as spam; requires administrator rights in the supergroup",
+		Params: []ParamSchema{
+			{Name: "supergroup_id", Type: "int53", Description: "Supergroup identifier"},
+			{Name: "message_ids", Type: "vector<int53>", Description: "Identifiers of messages to report"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "closeSecretChat",
+		Description: "Closes a secret chat, effectively transferring its state to secretChatStateClosed",
+		Params: []ParamSchema{
+			{Name: "secret_chat_id", Type: "int32", Description: "Secret chat identifier"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "deleteSavedOrderInfo",
+		Description: "Deletes saved order info",
+		Result:      "Ok",
+	},
+	{
+		Name:        "deleteSavedCredentials",
+		Description: "Deletes saved credentials for all payment provider bots",
+		Result:      "Ok",
+	},
+	{
+		Name:        "removeBackground",
+		Description: "Removes background from the list of installed backgrounds",
+		Params: []ParamSchema{
+			{Name: "background_id", Type: "int64", Description: "The background identifier"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "resetBackgrounds",
+		Description: "Resets list of installed backgrounds to its default value",
+		Result:      "Ok",
+	},
+	{
+		Name:        "synchronizeLanguagePack",
+		Description: "Fetches the latest versions of all strings from a language pack in the current localization target from the server. This method doesn't need to be called explicitly for the current used/base language packs. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "language_pack_id", Type: "string", Description: "Language pack identifier"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "addCustomServerLanguagePack",
+		Description: "Adds a custom server language pack to the list of installed language packs in current localization target. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "language_pack_id", Type: "string", Description: "Identifier of a language pack to be added; may be different from a name that is used in an \"https://t.me/setlanguage/\" link"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setCustomLanguagePack",
+		Description: "Adds or changes a custom local language pack to the current localization target",
+		Params: []ParamSchema{
+			{Name: "info", Type: "languagePackInfo", Description: "Information about the language pack. Language pack ID must start with 'X', consist only of English letters, digits and hyphens, and must not exceed 64 characters. Can be called before authorization"},
+			{Name: "strings", Type: "vector<languagePackString>", Description: "Strings of the new language pack"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "editCustomLanguagePackInfo",
+		Description: "Edits information about a custom local language pack in the current localization target. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "info", Type: "languagePackInfo", Description: "New information about the custom local language pack"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setCustomLanguagePackString",
+		Description: "Adds, edits or deletes a string in a custom local language pack. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "language_pack_id", Type: "string", Description: "Identifier of a previously added custom local language pack in the current localization target"},
+			{Name: "new_string", Type: "languagePackString", Description: "New language pack string"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "deleteLanguagePack",
+		Description: "Deletes all information about a language pack in the current localization target. The language pack which is currently in use (including base language pack) or is being synchronized can't be deleted. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "language_pack_id", Type: "string", Description: "Identifier of the language pack to delete"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "processPushNotification",
+		Description: "Handles a push notification. Returns error with code 406 if the push notification is not supported and connection to the server is required to fetch new data. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "payload", Type: "string", Description: "JSON-encoded push notification payload with all fields sent by the server, and \"google.sent_time\" and \"google.notification.sound\" fields added"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setUserPrivacySettingRules",
+		Description: "Changes user privacy settings",
+		Params: []ParamSchema{
+			{Name: "setting", Type: "UserPrivacySetting", Description: "The privacy setting"},
+			{Name: "rules", Type: "userPrivacySettingRules", Description: "The new privacy rules"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setOption",
+		Description: "Sets the value of an option. (Check the list of available options on https://core.telegram.org/tdlib/options.) Only writable options can be set. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "name", Type: "string", Description: "The name of the option"},
+			{Name: "value", Type: "OptionValue", Description: "The new value of the option; pass null to reset option value to a default value"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setAccountTtl",
+		Description: "Changes the period of inactivity after which the account of the current user will automatically be deleted",
+		Params: []ParamSchema{
+			{Name: "ttl", Type: "accountTtl", Description: "New account TTL"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "deleteAccount",
+		Description: "Deletes the account of the current user, deleting all information associated with the user from the server. The phone number of the account can be used to create a new account. Can be called before authorization when the current authorization state is authorizationStateWaitPassword",
+		Params: []ParamSchema{
+			{Name: "reason", Type: "string", Description: "The reason why the account was deleted; optional"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "removeChatActionBar",
+		Description: "Removes a chat action bar without any other action",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "reportChat",
+		Description: "Reports a chat to the Telegram moderators. A chat can be reported only from the chat action bar, or if chat.can_be_reported",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "message_ids", Type: "vector<int53>", Description: "Identifiers of reported messages, if any"},
+			{Name: "reason", Type: "ChatReportReason", Description: "The reason for reporting the chat"},
+			{Name: "text", Type: "string", Description: "Additional report details; 0-1024 characters"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "reportChatPhoto",
+		Description: "Reports a chat photo to the Telegram moderators. A chat photo can be reported only if chat.can_be_reported",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "file_id", Type: "int32", Description: "Identifier of the photo to report. Only full photos from chatPhoto can be reported"},
+			{Name: "reason", Type: "ChatReportReason", Description: "The reason for reporting the chat photo"},
+			{Name: "text", Type: "string", Description: "Additional report details; 0-1024 characters"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setNetworkType",
+		Description: "Sets the current network type. Can be called before authorization. Calling this method forces all network connections to reopen, mitigating the delay in switching between different networks, so it must be called whenever the network is changed, even if the network type remains the same. Network type is used to check whether the library can use the network at all and also for collecting detailed network data usage statistics",
+		Params: []ParamSchema{
+			{Name: "type", Type: "NetworkType", Description: "The new network type; pass null to set network type to networkTypeOther"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "addNetworkStatistics",
+		Description: "Adds the specified data to data usage statistics. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "entry", Type: "NetworkStatisticsEntry", Description: "The network statistics entry with the data to be added to statistics"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "resetNetworkStatistics",
+		Description: "Resets all network data usage statistics to zero. Can be called before authorization",
+		Result:      "Ok",
+	},
+	{
+		Name:        "setAutoDownloadSettings",
+		Description: "Sets auto-download settings",
+		Params: []ParamSchema{
+			{Name: "settings", Type: "autoDownloadSettings", Description: "New user auto-download settings"},
+			{Name: "type", Type: "NetworkType", Description: "Type of the network for which the new settings are relevant"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "deletePassportElement",
+		Description: "Deletes a Telegram Passport element",
+		Params: []ParamSchema{
+			{Name: "type", Type: "PassportElementType", Description: "Element type"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setPassportElementErrors",
+		Description: "Informs the user that some of the elements in their Telegram Passport contain errors; for bots only. The user will not be able to resend the elements, until the errors are fixed",
+		Params: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "User identifier"},
+			{Name: "errors", Type: "vector<inputPassportElementError>", Description: "The errors"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "checkPhoneNumberVerificationCode",
+		Description: "Checks the phone number verification code for Telegram Passport",
+		Params: []ParamSchema{
+			{Name: "code", Type: "string", Description: "Verification code to check"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "checkEmailAddressVerificationCode",
+		Description: "Checks the email address verification code for Telegram Passport",
+		Params: []ParamSchema{
+			{Name: "code", Type: "string", Description: "Verification code to check"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "sendPassportAuthorizationForm",
+		Description: "Sends a Telegram Passport authorization form, effectively sharing data with the service. This method must be called after getPassportAuthorizationFormAvailableElements if some previously available elements are going to be reused",
+		Params: []ParamSchema{
+			{Name: "autorization_form_id", Type: "int32", Description: "Authorization form identifier"},
+			{Name: "types", Type: "vector<PassportElementType>", Description: "Types of Telegram Passport elements chosen by user to complete the authorization form"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "checkPhoneNumberConfirmationCode",
+		Description: "Checks phone number confirmation code",
+		Params: []ParamSchema{
+			{Name: "code", Type: "string", Description: "Confirmation code to check"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setBotUpdatesStatus",
+		Description: "Informs the server about the number of pending bot updates if they haven't been processed for a long time; for bots only",
+		Params: []ParamSchema{
+			{Name: "pending_update_count", Type: "int32", Description: "The number of pending updates"},
+			{Name: "error_message", Type: "string", Description: "The last error message"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setStickerPositionInSet",
+		Description: "Changes the position of a sticker in the set to which it belongs; for bots only. The sticker set must have been created by the bot",
+		Params: []ParamSchema{
+			{Name: "sticker", Type: "InputFile", Description: "Sticker"},
+			{Name: "position", Type: "int32", Description: "New position of the sticker in the set, zero-based"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "removeStickerFromSet",
+		Description: "Removes a sticker from the set to which it belongs; for bots only. The sticker set must have been created by the bot",
+		Params: []ParamSchema{
+			{Name: "sticker", Type: "InputFile", Description: "Sticker"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "acceptTermsOfService",
+		Description: "Accepts Telegram terms of services",
+		Params: []ParamSchema{
+			{Name: "terms_of_service_id", Type: "string", Description: "Terms of service identifier"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "answerCustomQuery",
+		Description: "Answers a custom query; for bots only",
+		Params: []ParamSchema{
+			{Name: "custom_query_id", Type: "int64", Description: "Identifier of a custom query"},
+			{Name: "data", Type: "string", Description: "JSON-serialized answer to the query"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setAlarm",
+		Description: "Succeeds after a specified amount of time has passed. Can be called before initialization",
+		Params: []ParamSchema{
+			{Name: "seconds", Type: "double", Description: "Number of seconds before the function returns"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "saveApplicationLogEvent",
+		Description: "Saves application log event on the server. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "type", Type: "string", Description: "Event type"},
+			{Name: "chat_id", Type: "int53", Description: "Optional chat identifier, associated with the event"},
+			{Name: "data", Type: "JsonValue", Description: "The log event data"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "enableProxy",
+		Description: "Enables a proxy. Only one proxy can be enabled at a time. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "proxy_id", Type: "int32", Description: "Proxy identifier"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "disableProxy",
+		Description: "Disables the currently enabled proxy. Can be called before authorization",
+		Result:      "Ok",
+	},
+	{
+		Name:        "removeProxy",
+		Description: "Removes a proxy server. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "proxy_id", Type: "int32", Description: "Proxy identifier"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "setLogStream",
+		Description: "Sets new log stream for internal logging of TDLib. Can be called synchronously",
+		Params: []ParamSchema{
+			{Name: "log_stream", Type: "LogStream", Description: "New log stream"},
+		},
+		Result:      "Ok",
+		Synchronous: true,
+	},
+	{
+		Name:        "setLogVerbosityLevel",
+		Description: "Sets the verbosity level of the internal logging of TDLib. Can be called synchronously",
+		Params: []ParamSchema{
+			{Name: "new_verbosity_level", Type: "int32", Description: "New value of the verbosity level for logging. Value 0 corresponds to fatal errors, value 1 corresponds to errors, value 2 corresponds to warnings and debug warnings, value 3 corresponds to informational, value 4 corresponds to debug, value 5 corresponds to verbose debug, value greater than 5 and up to 1023 can be used to enable even more logging"},
+		},
+		Result:      "Ok",
+		Synchronous: true,
+	},
+	{
+		Name:        "setLogTagVerbosityLevel",
+		Description: "Sets the verbosity level for a specified TDLib internal log tag. Can be called synchronously",
+		Params: []ParamSchema{
+			{Name: "tag", Type: "string", Description: "Logging tag to change verbosity level"},
+			{Name: "new_verbosity_level", Type: "int32", Description: "New verbosity level; 1-1024"},
+		},
+		Result:      "Ok",
+		Synchronous: true,
+	},
+	{
+		Name:        "addLogMessage",
+		Description: "Adds a message to TDLib internal log. Can be called synchronously",
+		Params: []ParamSchema{
+			{Name: "verbosity_level", Type: "int32", Description: "The minimum verbosity level needed for the message to be logged; 0-1023"},
+			{Name: "text", Type: "string", Description: "Text of a message to log"},
+		},
+		Result:      "Ok",
+		Synchronous: true,
+	},
+	{
+		Name:        "testCallEmpty",
+		Description: "Does nothing; for testing only. This is an offline method. Can be called before authorization",
+		Result:      "Ok",
+	},
+	{
+		Name:        "testNetwork",
+		Description: "Sends a simple network request to the Telegram servers; for testing only. Can be called before authorization",
+		Result:      "Ok",
+	},
+	{
+		Name:        "testProxy",
+		Description: "Sends a simple network request to the Telegram servers via proxy; for testing only. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "server", Type: "string", Description: "Proxy server IP address"},
+			{Name: "port", Type: "int32", Description: "Proxy server port"},
+			{Name: "type", Type: "ProxyType", Description: "Proxy type"},
+			{Name: "dc_id", Type: "int32", Description: "Identifier of a datacenter, with which to test connection"},
+			{Name: "timeout", Type: "double", Description: "The maximum overall timeout for the request"},
+		},
+		Result: "Ok",
+	},
+	{
+		Name:        "testGetDifference",
+		Description: "Forces an updates.getDifference call to the Telegram servers; for testing only",
+		Result:      "Ok",
+	},
+	{
+		Name:        "getOption",
+		Description: "Returns the value of an option by its name. (Check the list of available options on https://core.telegram.org/tdlib/options.) Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "name", Type: "string", Description: "The name of the option"},
+		},
+		Result: "OptionValue",
+	},
+	{
+		Name:        "getSavedOrderInfo",
+		Description: "Returns saved order info, if any",
+		Result:      "OrderInfo",
+	},
+	{
+		Name:        "getPassportAuthorizationForm",
+		Description: "Returns a Telegram Passport authorization form for sharing data with a service",
+		Params: []ParamSchema{
+			{Name: "bot_user_id", Type: "int53", Description: "User identifier of the service's bot"},
+			{Name: "scope", Type: "string", Description: "Telegram Passport element types requested by the service"},
+			{Name: "public_key", Type: "string", Description: "Service's public key"},
+			{Name: "nonce", Type: "string", Description: "Unique request identifier provided by the service"},
+		},
+		Result: "PassportAuthorizationForm",
+	},
+	{
+		Name:        "getPassportElement",
+		Description: "Returns one of the available Telegram Passport elements",
+		Params: []ParamSchema{
+			{Name: "type", Type: "PassportElementType", Description: "Telegram Passport element type"},
+			{Name: "password", Type: "string", Description: "Password of the current user"},
+		},
+		Result: "PassportElement",
+	},
+	{
+		Name:        "setPassportElement",
+		Description: "Adds an element to the user's Telegram Passport. May return an error with a message \"PHONE_VERIFICATION_NEEDED\" or \"EMAIL_VERIFICATION_NEEDED\" if the chosen phone number or the chosen email address must be verified first",
+		Params: []ParamSchema{
+			{Name: "element", Type: "InputPassportElement", Description: "Input Telegram Passport element"},
+			{Name: "password", Type: "string", Description: "Password of the current user"},
+		},
+		Result: "PassportElement",
+	},
+	{
+		Name:        "getAllPassportElements",
+		Description: "Returns all available Telegram Passport elements",
+		Params: []ParamSchema{
+			{Name: "password", Type: "string", Description: "Password of the current user"},
+		},
+		Result: "PassportElements",
+	},
+	{
+		Name:        "getPassportAuthorizationFormAvailableElements",
+		Description: "Returns already available Telegram Passport elements suitable for completing a Telegram Passport authorization form. Result can be received only once for each authorization form",
+		Params: []ParamSchema{
+			{Name: "autorization_form_id", Type: "int32", Description: "Authorization form identifier"},
+			{Name: "password", Type: "string", Description: "Password of the current user"},
+		},
+		Result: "PassportElementsWithErrors",
+	},
+	{
+		Name:        "getPasswordState",
+		Description: "Returns the current state of 2-step verification",
+		Result:      "PasswordState",
+	},
+	{
+		Name:        "setPassword",
+		Description: "Changes the password for the current user. If a new recovery email address is specified, then the change will not be applied until the new recovery email address is confirmed",
+		Params: []ParamSchema{
+			{Name: "old_password", Type: "string", Description: "Previous password of the user"},
+			{Name: "new_password", Type: "string", Description: "New password of the user; may be empty to remove the password"},
+			{Name: "new_hint", Type: "string", Description: "New password hint; may be empty"},
+			{Name: "set_recovery_email_address", Type: "Bool", Description: "Pass true if the recovery email address must be changed"},
+			{Name: "new_recovery_email_address", Type: "string", Description: "New recovery email address; may be empty"},
+		},
+		Result: "PasswordState",
+	},
+	{
+		Name:        "setRecoveryEmailAddress",
+		Description: "Changes the 2-step verification recovery email address of the user. If a new recovery email address is specified, then the change will not be applied until the new recovery email address is confirmed. If new_recovery_email_address is the same as the email address that is currently set up, this call succeeds immediately and aborts all other requests waiting for an email confirmation",
+		Params: []ParamSchema{
+			{Name: "password", Type: "string", Description: "Password of the current user"},
+			{Name: "new_recovery_email_address", Type: "string", Description: "New recovery email address"},
+		},
+		Result: "PasswordState",
+	},
+	{
+		Name:        "checkRecoveryEmailAddressCode",
+		Description: "Checks the 2-step verification recovery email address verification code",
+		Params: []ParamSchema{
+			{Name: "code", Type: "string", Description: "Verification code to check"},
+		},
+		Result: "PasswordState",
+	},
+	{
+		Name:        "resendRecoveryEmailAddressCode",
+		Description: "Resends the 2-step verification recovery email address verification code",
+		Result:      "PasswordState",
+	},
+	{
+		Name:        "recoverPassword",
+		Description: "Recovers the 2-step verification password using a recovery code sent to an email address that was previously set up",
+		Params: []ParamSchema{
+			{Name: "recovery_code", Type: "string", Description: "Recovery code to check"},
+			{Name: "new_password", Type: "string", Description: "New password of the user; may be empty to remove the password"},
+			{Name: "new_hint", Type: "string", Description: "New password hint; may be empty"},
+		},
+		Result: "PasswordState",
+	},
+	{
+		Name:        "getPaymentForm",
+		Description: "Returns an invoice payment form. This method must be called when the user presses inlineKeyboardButtonBuy",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier of the Invoice message"},
+			{Name: "message_id", Type: "int53", Description: "Message identifier"},
+			{Name: "theme", Type: "paymentFormTheme", Description: "Preferred payment form theme; pass null to use the default theme"},
+		},
+		Result: "PaymentForm",
+	},
+	{
+		Name:        "getPaymentReceipt",
+		Description: "Returns information about a successful payment",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier of the PaymentSuccessful message"},
+			{Name: "message_id", Type: "int53", Description: "Message identifier"},
+		},
+		Result: "PaymentReceipt",
+	},
+	{
+		Name:        "sendPaymentForm",
+		Description: "Sends a filled-out payment form to the bot for final verification",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier of the Invoice message"},
+			{Name: "message_id", Type: "int53", Description: "Message identifier"},
+			{Name: "payment_form_id", Type: "int64", Description: "Payment form identifier returned by getPaymentForm"},
+			{Name: "order_info_id", Type: "string", Description: "Identifier returned by validateOrderInfo, or an empty string"},
+			{Name: "shipping_option_id", Type: "string", Description: "Identifier of a chosen shipping option, if applicable"},
+			{Name: "credentials", Type: "InputCredentials", Description: "The credentials chosen by user for payment"},
+			{Name: "tip_amount", Type: "int53", Description: "Chosen by the user amount of tip in the smallest units of the currency"},
+		},
+		Result: "PaymentResult",
+	},
+	{
+		Name:        "getPhoneNumberInfo",
+		Description: "Returns information about a phone number by its prefix. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "phone_number_prefix", Type: "string", Description: "The phone number prefix"},
+		},
+		Result: "PhoneNumberInfo",
+	},
+	{
+		Name:        "getPhoneNumberInfoSync",
+		Description: "Returns information about a phone number by its prefix synchronously. getCountries must be called at least once after changing localization to the specified language if properly localized country information is expected. Can be called synchronously",
+		Params: []ParamSchema{
+			{Name: "language_code", Type: "string", Description: "A two-letter ISO 639-1 country code for country information localization"},
+			{Name: "phone_number_prefix", Type: "string", Description: "The phone number prefix"},
+		},
+		Result:      "PhoneNumberInfo",
+		Synchronous: true,
+	},
+	{
+		Name:        "getProxies",
+		Description: "Returns list of proxies that are currently set up. Can be called before authorization",
+		Result:      "Proxies",
+	},
+	{
+		Name:        "addProxy",
+		Description: "Adds a proxy server for network requests. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "server", Type: "string", Description: "Proxy server IP address"},
+			{Name: "port", Type: "int32", Description: "Proxy server port"},
+			{Name: "enable", Type: "Bool", Description: "True, if the proxy needs to be enabled"},
+			{Name: "type", Type: "ProxyType", Description: "Proxy type"},
+		},
+		Result: "Proxy",
+	},
+	{
+		Name:        "editProxy",
+		Description: "Edits an existing proxy server for network requests. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "proxy_id", Type: "int32", Description: "Proxy identifier"},
+			{Name: "server", Type: "string", Description: "Proxy server IP address"},
+			{Name: "port", Type: "int32", Description: "Proxy server port"},
+			{Name: "enable", Type: "Bool", Description: "True, if the proxy needs to be enabled"},
+			{Name: "type", Type: "ProxyType", Description: "Proxy type"},
+		},
+		Result: "Proxy",
+	},
+	{
+		Name:        "registerDevice",
+		Description: "Registers the currently used device for receiving push notifications. Returns a globally unique identifier of the push notification subscription",
+		Params: []ParamSchema{
+			{Name: "device_token", Type: "DeviceToken", Description: "Device token"},
+			{Name: "other_user_ids", Type: "vector<int53>", Description: "List of user identifiers of other users currently using the application"},
+		},
+		Result: "PushReceiverId",
+	},
+	{
+		Name:        "getPushReceiverId",
+		Description: "Returns a globally unique push notification subscription identifier for identification of an account, which has received a push notification. Can be called synchronously",
+		Params: []ParamSchema{
+			{Name: "payload", Type: "string", Description: "JSON-encoded push notification payload"},
+		},
+		Result:      "PushReceiverId",
+		Synchronous: true,
+	},
+	{
+		Name:        "getRecommendedChatFilters",
+		Description: "Returns recommended chat filters for the current user",
+		Result:      "RecommendedChatFilters",
+	},
+	{
+		Name:        "getRecoveryEmailAddress",
+		Description: "Returns a 2-step verification recovery email address that was previously set up. This method can be used to verify a password provided by the user",
+		Params: []ParamSchema{
+			{Name: "password", Type: "string", Description: "The password for the current user"},
+		},
+		Result: "RecoveryEmailAddress",
+	},
+	{
+		Name:        "resetPassword",
+		Description: "Removes 2-step verification password without previous password and access to recovery email address. The password can't be reset immediately and the request needs to be repeated after the specified time",
+		Result:      "ResetPasswordResult",
+	},
+	{
+		Name:        "getScopeNotificationSettings",
+		Description: "Returns the notification settings for chats of a given type",
+		Params: []ParamSchema{
+			{Name: "scope", Type: "NotificationSettingsScope", Description: "Types of chats for which to return the notification settings information"},
+		},
+		Result: "ScopeNotificationSettings",
+	},
+	{
+		Name:        "pingProxy",
+		Description: "Computes time needed to receive a response from a Telegram server through a proxy. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "proxy_id", Type: "int32", Description: "Proxy identifier. Use 0 to ping a Telegram server without a proxy"},
+		},
+		Result: "Seconds",
+	},
+	{
+		Name:        "getSecretChat",
+		Description: "Returns information about a secret chat by its identifier. This is an offline request",
+		Params: []ParamSchema{
+			{Name: "secret_chat_id", Type: "int32", Description: "Secret chat identifier"},
+		},
+		Result: "SecretChat",
+	},
+	{
+		Name:        "confirmQrCodeAuthentication",
+		Description: "Confirms QR code authentication on another device. Returns created session on success",
+		Params: []ParamSchema{
+			{Name: "link", Type: "string", Description: "A link from a QR code. The link must be scanned by the in-app camera"},
+		},
+		Result: "Session",
+	},
+	{
+		Name:        "getActiveSessions",
+		Description: "Returns all active sessions of the current user",
+		Result:      "Sessions",
+	},
+	{
+		Name:        "getChatSponsoredMessage",
+		Description: "Returns sponsored message to be shown in a chat; for channel chats only. Returns a 404 error if there is no sponsored message in the chat",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat"},
+		},
+		Result: "SponsoredMessage",
+	},
+	{
+		Name:        "getStatisticalGraph",
+		Description: "Loads an asynchronous or a zoomed in statistical graph",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "token", Type: "string", Description: "The token for graph loading"},
+			{Name: "x", Type: "int53", Description: "X-value for zoomed in graph or 0 otherwise"},
+		},
+		Result: "StatisticalGraph",
+	},
+	{
+		Name:        "clickAnimatedEmojiMessage",
+		Description: "Informs TDLib that a message with an animated emoji was clicked by the user. Returns a big animated sticker to be played or a 404 error if usual animation needs to be played",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier of the message"},
+			{Name: "message_id", Type: "int53", Description: "Identifier of the clicked message"},
+		},
+		Result: "Sticker",
+	},
+	{
+		Name:        "getStickerSet",
+		Description: "Returns information about a sticker set by its identifier",
+		Params: []ParamSchema{
+			{Name: "set_id", Type: "int64", Description: "Identifier of the sticker set"},
+		},
+		Result: "StickerSet",
+	},
+	{
+		Name:        "searchStickerSet",
+		Description: "Searches for a sticker set by its name",
+		Params: []ParamSchema{
+			{Name: "name", Type: "string", Description: "Name of the sticker set"},
+		},
+		Result: "StickerSet",
+	},
+	{
+		Name:        "createNewStickerSet",
+		Description: "Creates a new sticker set. Returns the newly created sticker set",
+		Params: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "Sticker set owner; ignored for regular users"},
+			{Name: "title", Type: "string", Description: "Sticker set title; 1-64 characters"},
+			{Name: "name", Type: "string", Description: "Sticker set name. Can contain only English letters, digits and underscores. Must end with *\"_by_<bot username>\"* (*<bot_username>* is case insensitive) for bots; 1-64 characters"},
+			{Name: "is_masks", Type: "Bool", Description: "True, if stickers are masks. Animated stickers can't be masks"},
+			{Name: "stickers", Type: "vector<InputSticker>", Description: "List of stickers to be added to the set; must be non-empty. All stickers must be of the same type. For animated stickers, uploadStickerFile must be used before the sticker is shown"},
+			{Name: "source", Type: "string", Description: "Source of the sticker set; may be empty if unknown"},
+		},
+		Result: "StickerSet",
+	},
+	{
+		Name:        "addStickerToSet",
+		Description: "Adds a new sticker to a set; for bots only. Returns the sticker set",
+		Params: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "Sticker set owner"},
+			{Name: "name", Type: "string", Description: "Sticker set name"},
+			{Name: "sticker", Type: "InputSticker", Description: "Sticker to add to the set"},
+		},
+		Result: "StickerSet",
+	},
+	{
+		Name:        "setStickerSetThumbnail",
+		Description: "Sets a sticker set thumbnail; for bots only. Returns the sticker set",
+		Params: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "Sticker set owner"},
+			{Name: "name", Type: "string", Description: "Sticker set name"},
+			{Name: "thumbnail", Type: "InputFile", Description: "Thumbnail to set in PNG or TGS format; pass null to remove the sticker set thumbnail. Animated thumbnail must be set for animated sticker sets and only for them"},
+		},
+		Result: "StickerSet",
+	},
+	{
+		Name:        "getInstalledStickerSets",
+		Description: "Returns a list of installed sticker sets",
+		Params: []ParamSchema{
+			{Name: "is_masks", Type: "Bool", Description: "Pass true to return mask sticker sets; pass false to return ordinary sticker sets"},
+		},
+		Result: "StickerSets",
+	},
+	{
+		Name:        "getArchivedStickerSets",
+		Description: "Returns a list of archived sticker sets",
+		Params: []ParamSchema{
+			{Name: "is_masks", Type: "Bool", Description: "Pass true to return mask stickers sets; pass false to return ordinary sticker sets"},
+			{Name: "offset_sticker_set_id", Type: "int64", Description: "Identifier of the sticker set from which to return the result"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of sticker sets to return; up to 100"},
+		},
+		Result: "StickerSets",
+	},
+	{
+		Name:        "getTrendingStickerSets",
+		Description: "Returns a list of trending sticker sets. For optimal performance, the number of returned sticker sets is chosen by TDLib",
+		Params: []ParamSchema{
+			{Name: "offset", Type: "int32", Description: "The offset from which to return the sticker sets; must be non-negative"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of sticker sets to be returned; up to 100. For optimal performance, the number of returned sticker sets is chosen by TDLib and can be smaller than the specified limit, even if the end of the list has not been reached"},
+		},
+		Result: "StickerSets",
+	},
+	{
+		Name:        "getAttachedStickerSets",
+		Description: "Returns a list of sticker sets attached to a file. Currently, only photos and videos can have attached sticker sets",
+		Params: []ParamSchema{
+			{Name: "file_id", Type: "int32", Description: "File identifier"},
+		},
+		Result: "StickerSets",
+	},
+	{
+		Name:        "searchInstalledStickerSets",
+		Description: "Searches for installed sticker sets by looking for specified query in their title and name",
+		Params: []ParamSchema{
+			{Name: "is_masks", Type: "Bool", Description: "Pass true to return mask sticker sets; pass false to return ordinary sticker sets"},
+			{Name: "query", Type: "string", Description: "Query to search for"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of sticker sets to return"},
+		},
+		Result: "StickerSets",
+	},
+	{
+		Name:        "searchStickerSets",
+		Description: "Searches for ordinary sticker sets by looking for specified query in their title and name. Excludes installed sticker sets from the results",
+		Params: []ParamSchema{
+			{Name: "query", Type: "string", Description: "Query to search for"},
+		},
+		Result: "StickerSets",
+	},
+	{
+		Name:        "getStickers",
+		Description: "Returns stickers from the installed sticker sets that correspond to a given emoji. If the emoji is non-empty, favorite and recently used stickers may also be returned",
+		Params: []ParamSchema{
+			{Name: "emoji", Type: "string", Description: "String representation of emoji. If empty, returns all known installed stickers"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of stickers to be returned"},
+		},
+		Result: "Stickers",
+	},
+	{
+		Name:        "searchStickers",
+		Description: "Searches for stickers from public sticker sets that correspond to a given emoji",
+		Params: []ParamSchema{
+			{Name: "emoji", Type: "string", Description: "String representation of emoji; must be non-empty"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of stickers to be returned"},
+		},
+		Result: "Stickers",
+	},
+	{
+		Name:        "getRecentStickers",
+		Description: "Returns a list of recently used stickers",
+		Params: []ParamSchema{
+			{Name: "is_attached", Type: "Bool", Description: "Pass true to return stickers and masks that were recently attached to photos or video files; pass false to return recently sent stickers"},
+		},
+		Result: "Stickers",
+	},
+	{
+		Name:        "addRecentSticker",
+		Description: "Manually adds a new sticker to the list of recently used stickers. The new sticker is added to the top of the list. If the sticker was already in the list, it is removed from the list first. Only stickers belonging to a sticker set can be added to this list",
+		Params: []ParamSchema{
+			{Name: "is_attached", Type: "Bool", Description: "Pass true to add the sticker to the list of stickers recently attached to photo or video files; pass false to add the sticker to the list of recently sent stickers"},
+			{Name: "sticker", Type: "InputFile", Description: "Sticker file to add"},
+		},
+		Result: "Stickers",
+	},
+	{
+		Name:        "getFavoriteStickers",
+		Description: "Returns favorite stickers",
+		Result:      "Stickers",
+	},
+	{
+		Name:        "getStorageStatistics",
+		Description: "Returns storage usage statistics. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "chat_limit", Type: "int32", Description: "The maximum number of chats with the largest storage usage for which separate statistics need to be returned. All other chats will be grouped in entries with chat_id == 0. If the chat info database is not used, the chat_limit is ignored and is always set to 0"},
+		},
+		Result: "StorageStatistics",
+	},
+	{
+		Name:        "optimizeStorage",
+		Description: "Optimizes storage usage, i.e. deletes some files and returns new storage usage statistics. Secret thumbnails can't be deleted",
+		Params: []ParamSchema{
+			{Name: "size", Type: "int53", Description: "Limit on the total size of files after deletion, in bytes. Pass -1 to use the default limit"},
+			{Name: "ttl", Type: "int32", Description: "Limit on the time that has passed since the last time a file was accessed (or creation time for some filesystems). Pass -1 to use the default limit"},
+			{Name: "count", Type: "int32", Description: "Limit on the total count of files after deletion. Pass -1 to use the default limit"},
+			{Name: "immunity_delay", Type: "int32", Description: "The amount of time after the creation of a file during which it can't be deleted, in seconds. Pass -1 to use the default value"},
+			{Name: "file_types", Type: "vector<FileType>", Description: "If non-empty, only files with the given types are considered. By default, all types except thumbnails, profile photos, stickers and wallpapers are deleted"},
+			{Name: "chat_ids", Type: "vector<int53>", Description: "If non-empty, only files from the given chats are considered. Use 0 as chat identifier to delete files not belonging to any chat (e.g., profile photos)"},
+			{Name: "exclude_chat_ids", Type: "vector<int53>", Description: "If non-empty, files from the given chats are excluded. Use 0 as chat identifier to exclude all files not belonging to any chat (e.g., profile photos)"},
+			{Name: "return_deleted_file_statistics", Type: "Bool", Description: "Pass true if statistics about the files that were deleted must be returned instead of the whole storage usage statistics. Affects only returned statistics"},
+			{Name: "chat_limit", Type: "int32", Description: "Same as in getStorageStatistics. Affects only returned statistics"},
+		},
+		Result: "StorageStatistics",
+	},
+	{
+		Name:        "getStorageStatisticsFast",
+		Description: "Quickly returns approximate storage usage statistics. Can be called before authorization",
+		Result:      "StorageStatisticsFast",
+	},
+	{
+		Name:        "getSupergroup",
+		Description: "Returns information about a supergroup or a channel by its identifier. This is an offline request if the current user is not a bot",
+		Params: []ParamSchema{
+			{Name: "supergroup_id", Type: "int53", Description: "Supergroup or channel identifier"},
+		},
+		Result: "Supergroup",
+	},
+	{
+		Name:        "getSupergroupFullInfo",
+		Description: "Returns full information about a supergroup or a channel by its identifier, cached for up to 1 minute",
+		Params: []ParamSchema{
+			{Name: "supergroup_id", Type: "int53", Description: "Supergroup or channel identifier"},
+		},
+		Result: "SupergroupFullInfo",
+	},
+	{
+		Name:        "getRecentlyVisitedTMeUrls",
+		Description: "Returns t.me URLs recently visited by a newly registered user",
+		Params: []ParamSchema{
+			{Name: "referrer", Type: "string", Description: "Google Play referrer to identify the user"},
+		},
+		Result: "TMeUrls",
+	},
+	{
+		Name:        "createTemporaryPassword",
+		Description: "Creates a new temporary password for processing payments",
+		Params: []ParamSchema{
+			{Name: "password", Type: "string", Description: "Persistent user password"},
+			{Name: "valid_for", Type: "int32", Description: "Time during which the temporary password will be valid, in seconds; must be between 60 and 86400"},
+		},
+		Result: "TemporaryPasswordState",
+	},
+	{
+		Name:        "getTemporaryPasswordState",
+		Description: "Returns information about the current temporary password",
+		Result:      "TemporaryPasswordState",
+	},
+	{
+		Name:        "testCallBytes",
+		Description: "Returns the received bytes; for testing only. This is an offline method. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "x", Type: "bytes", Description: "Bytes to return"},
+		},
+		Result: "TestBytes",
+	},
+	{
+		Name:        "testSquareInt",
+		Description: "Returns the squared received number; for testing only. This is an offline method. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "x", Type: "int32", Description: "Number to square"},
+		},
+		Result: "TestInt",
+	},
+	{
+		Name:        "testCallString",
+		Description: "Returns the received string; for testing only. This is an offline method. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "x", Type: "string", Description: "String to return"},
+		},
+		Result: "TestString",
+	},
+	{
+		Name:        "testCallVectorInt",
+		Description: "Returns the received vector of numbers; for testing only. This is an offline method. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "x", Type: "vector<int32>", Description: "Vector of numbers to return"},
+		},
+		Result: "TestVectorInt",
+	},
+	{
+		Name:        "testCallVectorIntObject",
+		Description: "Returns the received vector of objects containing a number; for testing only. This is an offline method. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "x", Type: "vector<testInt>", Description: "Vector of objects to return"},
+		},
+		Result: "TestVectorIntObject",
+	},
+	{
+		Name:        "testCallVectorString",
+		Description: "Returns the received vector of strings; for testing only. This is an offline method. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "x", Type: "vector<string>", Description: "Vector of strings to return"},
+		},
+		Result: "TestVectorString",
+	},
+	{
+		Name:        "testCallVectorStringObject",
+		Description: "Returns the received vector of objects containing a string; for testing only. This is an offline method. Can be called before authorization",
+		Params: []ParamSchema{
+			{Name: "x", Type: "vector<testString>", Description: "Vector of objects to return"},
+		},
+		Result: "TestVectorStringObject",
+	},
+	{
+		Name:        "getMessageEmbeddingCode",
+		Description: "Returns an HTML code for embedding the message. Available only for messages in supergroups and channels with a username",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat to which the message belongs"},
+			{Name: "message_id", Type: "int53", Description: "Identifier of the message"},
+			{Name: "for_album", Type: "Bool", Description: "Pass true to return an HTML code for embedding of the whole media album"},
+		},
+		Result: "Text",
+	},
+	{
+		Name:        "getFileMimeType",
+		Description: "Returns the MIME type of a file, guessed by its extension. Returns an empty string on failure. Can be called synchronously",
+		Params: []ParamSchema{
+			{Name: "file_name", Type: "string", Description: "The name of the file or path to the file"},
+		},
+		Result:      "Text",
+		Synchronous: true,
+	},
+	{
+		Name:        "getFileExtension",
+		Description: "Returns the extension of a file, guessed by its MIME type. Returns an empty string on failure. Can be called synchronously",
+		Params: []ParamSchema{
+			{Name: "mime_type", Type: "string", Description: "The MIME type of the file"},
+		},
+		Result:      "Text",
+		Synchronous: true,
+	},
+	{
+		Name:        "cleanFileName",
+		Description: "Removes potentially dangerous characters from the name of a file. The encoding of the file name is supposed to be UTF-8. Returns an empty string on failure. Can be called synchronously",
+		Params: []ParamSchema{
+			{Name: "file_name", Type: "string", Description: "File name or path to the file"},
+		},
+		Result:      "Text",
+		Synchronous: true,
+	},
+	{
+		Name:        "getJsonString",
+		Description: "Converts a JsonValue object to corresponding JSON-serialized string. Can be called synchronously",
+		Params: []ParamSchema{
+			{Name: "json_value", Type: "JsonValue", Description: "The JsonValue object"},
+		},
+		Result:      "Text",
+		Synchronous: true,
+	},
+	{
+		Name:        "getChatFilterDefaultIconName",
+		Description: "Returns default icon name for a filter. Can be called synchronously",
+		Params: []ParamSchema{
+			{Name: "filter", Type: "chatFilter", Description: "Chat filter"},
+		},
+		Result:      "Text",
+		Synchronous: true,
+	},
+	{
+		Name:        "getSuggestedFileName",
+		Description: "Returns suggested name for saving a file in a given directory",
+		Params: []ParamSchema{
+			{Name: "file_id", Type: "int32", Description: "Identifier of the file"},
+			{Name: "directory", Type: "string", Description: "Directory in which the file is supposed to be saved"},
+		},
+		Result: "Text",
+	},
+	{
+		Name:        "getMessageImportConfirmationText",
+		Description: "Returns a confirmation text to be shown to the user before starting message import",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of a chat to which the messages will be imported. It must be an identifier of a private chat with a mutual contact or an identifier of a supergroup chat with can_change_info administrator right"},
+		},
+		Result: "Text",
+	},
+	{
+		Name:        "joinGroupCall",
+		Description: "Joins an active group call. Returns join response payload for tgcalls",
+		Params: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Group call identifier"},
+			{Name: "participant_id", Type: "MessageSender", Description: "Identifier of a group call participant, which will be used to join the call; pass null to join as self; video chats only"},
+			{Name: "audio_source_id", Type: "int32", Description: "Caller audio channel synchronization source identifier; received from tgcalls"},
+			{Name: "payload", Type: "string", Description: "Group call join payload; received from tgcalls"},
+			{Name: "is_muted", Type: "Bool", Description: "True, if the user's microphone is muted"},
+			{Name: "is_my_video_enabled", Type: "Bool", Description: "True, if the user's video is enabled"},
+			{Name: "invite_hash", Type: "string", Description: "If non-empty, invite hash to be used to join the group call without being muted by administrators"},
+		},
+		Result: "Text",
+	},
+	{
+		Name:        "startGroupCallScreenSharing",
+		Description: "Starts screen sharing in a joined group call. Returns join response payload for tgcalls",
+		Params: []ParamSchema{
+			{Name: "group_call_id", Type: "int32", Description: "Group call identifier"},
+			{Name: "audio_source_id", Type: "int32", Description: "Screen sharing audio channel synchronization source identifier; received from tgcalls"},
+			{Name: "payload", Type: "string", Description: "Group call join payload; received from tgcalls"},
+		},
+		Result: "Text",
+	},
+	{
+		Name:        "getPreferredCountryLanguage",
+		Description: "Returns an IETF language tag of the language preferred in the country, which must be used to fill native fields in Telegram Passport personal details. Returns a 404 error if unknown",
+		Params: []ParamSchema{
+			{Name: "country_code", Type: "string", Description: "A two-letter ISO 3166-1 alpha-2 country code"},
+		},
+		Result: "Text",
+	},
+	{
+		Name:        "getSuggestedStickerSetName",
+		Description: "Returns a suggested name for a new sticker set with a given title",
+		Params: []ParamSchema{
+			{Name: "title", Type: "string", Description: "Sticker set title; 1-64 characters"},
+		},
+		Result: "Text",
+	},
+	{
+		Name:        "getCountryCode",
+		Description: "Uses the current IP address to find the current country. Returns two-letter ISO 3166-1 alpha-2 country code. Can be called before authorization",
+		Result:      "Text",
+	},
+	{
+		Name:        "getTextEntities",
+		Description: "Returns all entities (mentions, hashtags, cashtags, bot commands, bank card numbers, URLs, and email addresses) contained in the text. Can be called synchronously",
+		Params: []ParamSchema{
+			{Name: "text", Type: "string", Description: "The text in which to look for entites"},
+		},
+		Result:      "TextEntities",
+		Synchronous: true,
+	},
+	{
+		Name:        "testUseUpdate",
+		Description: "Does nothing and ensures that the Update object is used; for testing only. This is an offline method. Can be called before authorization",
+		Result:      "Update",
+	},
+	{
+		Name:        "getCurrentState",
+		Description: "Returns all updates needed to restore current TDLib state, i.e. all actual UpdateAuthorizationState/UpdateUser/UpdateNewChat and others. This is especially useful if TDLib is run in a separate process. Can be called before initialization",
+		Result:      "Updates",
+	},
+	{
+		Name:        "getMe",
+		Description: "Returns the current user",
+		Result:      "User",
+	},
+	{
+		Name:        "getUser",
+		Description: "Returns information about a user by their identifier. This is an offline request if the current user is not a bot",
+		Params: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "User identifier"},
+		},
+		Result: "User",
+	},
+	{
+		Name:        "getSupportUser",
+		Description: "Returns a user that can be contacted to get support",
+		Result:      "User",
+	},
+	{
+		Name:        "getUserFullInfo",
+		Description: "Returns full information about a user by their identifier",
+		Params: []ParamSchema{
+			{Name: "user_id", Type: "int53", Description: "User identifier"},
+		},
+		Result: "UserFullInfo",
+	},
+	{
+		Name:        "getUserPrivacySettingRules",
+		Description: "Returns the current privacy settings",
+		Params: []ParamSchema{
+			{Name: "setting", Type: "UserPrivacySetting", Description: "The privacy setting"},
+		},
+		Result: "UserPrivacySettingRules",
+	},
+	{
+		Name:        "getMessageViewers",
+		Description: "Returns viewers of a recent outgoing message in a basic group or a supergroup chat. For video notes and voice notes only users, opened content of the message, are returned. The method can be called if message.can_get_viewers == true",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier"},
+			{Name: "message_id", Type: "int53", Description: "Identifier of the message"},
+		},
+		Result: "Users",
+	},
+	{
+		Name:        "getPollVoters",
+		Description: "Returns users voted for the specified option in a non-anonymous polls. For optimal performance, the number of returned users is chosen by TDLib",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Identifier of the chat to which the poll belongs"},
+			{Name: "message_id", Type: "int53", Description: "Identifier of the message containing the poll"},
+			{Name: "option_id", Type: "int32", Description: "0-based identifier of the answer option"},
+			{Name: "offset", Type: "int32", Description: "Number of users to skip in the result; must be non-negative"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of users to be returned; must be positive and can't be greater than 50. For optimal performance, the number of returned users is chosen by TDLib and can be smaller than the specified limit, even if the end of the voter list has not been reached"},
+		},
+		Result: "Users",
+	},
+	{
+		Name:        "getContacts",
+		Description: "Returns all user contacts",
+		Result:      "Users",
+	},
+	{
+		Name:        "searchContacts",
+		Description: "Searches for the specified query in the first names, last names and usernames of the known user contacts",
+		Params: []ParamSchema{
+			{Name: "query", Type: "string", Description: "Query to search for; may be empty to return all contacts"},
+			{Name: "limit", Type: "int32", Description: "The maximum number of users to be returned"},
+		},
+		Result: "Users",
+	},
+	{
+		Name:        "getRecentInlineBots",
+		Description: "Returns up to 20 recently used inline bots in the order of their last usage",
+		Result:      "Users",
+	},
+	{
+		Name:        "validateOrderInfo",
+		Description: "Validates the order information provided by a user and returns the available shipping options for a flexible invoice",
+		Params: []ParamSchema{
+			{Name: "chat_id", Type: "int53", Description: "Chat identifier of the Invoice message"},
+			{Name: "message_id", Type: "int53", Description: "Message identifier"},
+			{Name: "order_info", Type: "orderInfo", Description: "The order information, provided by the user; pass null if empty"},
+			{Name: "allow_save", Type: "Bool", Description: "True, if the order information can be saved"},
+		},
+		Result: "ValidatedOrderInfo",
+	},
+	{
+		Name:        "getWebPagePreview",
+		Description: "Returns a web page preview by the text of the message. Do not call this function too often. Returns a 404 error if the web page has no preview",
+		Params: []ParamSchema{
+			{Name: "text", Type: "formattedText", Description: "Message text with formatting"},
+		},
+		Result: "WebPage",
+	},
+	{
+		Name:        "getWebPageInstantView",
+		Description: "Returns an instant view version of a web page if available. Returns a 404 error if the web page has no instant view page",
+		Params: []ParamSchema{
+			{Name: "url", Type: "string", Description: "The web page URL"},
+			{Name: "force_full", Type: "Bool", Description: "If true, the full instant view for the web page will be returned"},
+		},
+		Result: "WebPageInstantView",
+	},
+}
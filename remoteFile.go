@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // RemoteFile Represents a remote file
 type RemoteFile struct {
 	tdCommon
@@ -34,3 +39,149 @@ func NewRemoteFile(id string, uniqueId string, isUploadingActive bool, isUploadi
 
 	return &remoteFileTemp
 }
+
+// MarshalJSON marshals to json
+func (remoteFile *RemoteFile) MarshalJSON() ([]byte, error) {
+	return remoteFile.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (remoteFile *RemoteFile) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, remoteFile)
+}
+
+func (remoteFile *RemoteFile) appendJSON(b []byte) ([]byte, error) {
+	if remoteFile == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, remoteFile.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, remoteFile.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, remoteFile.Id)
+	b = append(b, `,"unique_id":`...)
+	b = appendJSONString(b, remoteFile.UniqueId)
+	b = append(b, `,"is_uploading_active":`...)
+	b = appendJSONBool(b, remoteFile.IsUploadingActive)
+	b = append(b, `,"is_uploading_completed":`...)
+	b = appendJSONBool(b, remoteFile.IsUploadingCompleted)
+	b = append(b, `,"uploaded_size":`...)
+	b = appendJSONInt(b, int64(remoteFile.UploadedSize))
+	return append(b, '}'), nil
+}
+
+func (remoteFile *RemoteFile) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			remoteFile.tdCommon.Type, err = r.readString()
+		case "@extra":
+			remoteFile.tdCommon.Extra, err = r.readString()
+		case "id":
+			remoteFile.Id, err = r.readString()
+		case "unique_id":
+			remoteFile.UniqueId, err = r.readString()
+		case "is_uploading_active":
+			remoteFile.IsUploadingActive, err = r.readBool()
+		case "is_uploading_completed":
+			remoteFile.IsUploadingCompleted, err = r.readBool()
+		case "uploaded_size":
+			remoteFile.UploadedSize, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (remoteFile *RemoteFile) Clone() *RemoteFile {
+	if remoteFile == nil {
+		return nil
+	}
+	clone := *remoteFile
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (remoteFile *RemoteFile) Equal(other *RemoteFile) bool {
+	if remoteFile == nil || other == nil {
+		return remoteFile == other
+	}
+	if remoteFile.Id != other.Id {
+		return false
+	}
+	if remoteFile.UniqueId != other.UniqueId {
+		return false
+	}
+	if remoteFile.IsUploadingActive != other.IsUploadingActive {
+		return false
+	}
+	if remoteFile.IsUploadingCompleted != other.IsUploadingCompleted {
+		return false
+	}
+	if remoteFile.UploadedSize != other.UploadedSize {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (remoteFile *RemoteFile) Diff(other *RemoteFile) []Change {
+	return remoteFile.diff("", other, nil)
+}
+
+func (remoteFile *RemoteFile) diff(path string, other *RemoteFile, changes []Change) []Change {
+	if remoteFile == nil || other == nil {
+		if remoteFile != other {
+			changes = append(changes, Change{Path: path, Old: remoteFile, New: other})
+		}
+		return changes
+	}
+	if remoteFile.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: remoteFile.Id, New: other.Id})
+	}
+	if remoteFile.UniqueId != other.UniqueId {
+		changes = append(changes, Change{Path: fieldPath(path, "unique_id"), Old: remoteFile.UniqueId, New: other.UniqueId})
+	}
+	if remoteFile.IsUploadingActive != other.IsUploadingActive {
+		changes = append(changes, Change{Path: fieldPath(path, "is_uploading_active"), Old: remoteFile.IsUploadingActive, New: other.IsUploadingActive})
+	}
+	if remoteFile.IsUploadingCompleted != other.IsUploadingCompleted {
+		changes = append(changes, Change{Path: fieldPath(path, "is_uploading_completed"), Old: remoteFile.IsUploadingCompleted, New: other.IsUploadingCompleted})
+	}
+	if remoteFile.UploadedSize != other.UploadedSize {
+		changes = append(changes, Change{Path: fieldPath(path, "uploaded_size"), Old: remoteFile.UploadedSize, New: other.UploadedSize})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (remoteFile *RemoteFile) String() string {
+	return objectString(remoteFile)
+}
+
+// LogValue logs the object as its summary
+func (remoteFile *RemoteFile) LogValue() slog.Value {
+	return slog.StringValue(objectString(remoteFile))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (remoteFile *RemoteFile) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, remoteFile)
+}
+
+func (remoteFile *RemoteFile) printText(p *printer) {
+	if remoteFile == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("RemoteFile")
+	p.string("id", remoteFile.Id)
+	p.string("unique_id", remoteFile.UniqueId)
+	p.bool("is_uploading_active", remoteFile.IsUploadingActive)
+	p.bool("is_uploading_completed", remoteFile.IsUploadingCompleted)
+	p.int("uploaded_size", int64(remoteFile.UploadedSize))
+	p.endObject(state)
+}
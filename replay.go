@@ -0,0 +1,181 @@
+package tdlib
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ReplayTransport is a Transport replaying the updates and responses of record files
+// written by a RecordingTransport, so the event receivers see the recorded update sequence offline.
+//
+// Requests sent to it aren't passed to TDLib. Instead, each request is matched with the next
+// recorded request of the same @type, and the recorded response to that request is replayed
+// with the @extra of the new request, so SendAndCatch gets it. Responses replayed before
+// their request is sent are dropped by the client like responses to unknown requests.
+type ReplayTransport struct {
+	// Realtime makes Receive wait between updates as long as TDLib did during the recording,
+	// otherwise they are replayed as fast as they are received.
+	Realtime bool
+
+	lock     sync.Mutex
+	received []RecordEntry
+	next     int
+	sent     map[string][]string // recorded @extra of not yet matched requests, by @type
+	extras   map[string]string   // @extra of matched requests, by recorded @extra
+	done     chan struct{}
+	last     time.Time
+}
+
+// NewReplayTransport creates a new ReplayTransport replaying the given record files in the order of their names
+func NewReplayTransport(paths ...string) (*ReplayTransport, error) {
+	sorted := append([]string(nil), paths...)
+	sort.Strings(sorted)
+
+	transport := ReplayTransport{
+		sent:   make(map[string][]string),
+		extras: make(map[string]string),
+		done:   make(chan struct{}),
+	}
+
+	for _, path := range sorted {
+		if err := transport.load(path); err != nil {
+			return nil, err
+		}
+	}
+	if len(transport.received) == 0 {
+		close(transport.done)
+	}
+
+	return &transport, nil
+}
+
+// NewReplayClient creates a new client replaying the given record files
+func NewReplayClient(config Config, paths ...string) (*Client, error) {
+	transport, err := NewReplayTransport(paths...)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClientWithTransport(config, transport), nil
+}
+
+// Done returns a channel which is closed when every recorded update was replayed
+func (transport *ReplayTransport) Done() <-chan struct{} {
+	return transport.done
+}
+
+// Send matches the request with a recorded one; it isn't sent anywhere
+func (transport *ReplayTransport) Send(query []byte) {
+	var request struct {
+		Type  string `json:"@type"`
+		Extra string `json:"@extra"`
+	}
+	if err := json.Unmarshal(query, &request); err != nil || request.Extra == "" {
+		return
+	}
+
+	transport.lock.Lock()
+	defer transport.lock.Unlock()
+
+	if recorded := transport.sent[request.Type]; len(recorded) > 0 {
+		transport.extras[recorded[0]] = request.Extra
+		transport.sent[request.Type] = recorded[1:]
+	}
+}
+
+// Receive returns the next recorded update or response,
+// or an empty slice after the timeout once every update was replayed
+func (transport *ReplayTransport) Receive(timeout float64) []byte {
+	transport.lock.Lock()
+
+	if transport.next >= len(transport.received) {
+		transport.lock.Unlock()
+		time.Sleep(time.Duration(timeout * float64(time.Second)))
+		return nil
+	}
+
+	entry := transport.received[transport.next]
+	transport.next++
+	if transport.next == len(transport.received) {
+		defer close(transport.done)
+	}
+
+	var wait time.Duration
+	if transport.Realtime && !transport.last.IsZero() {
+		wait = entry.Time.Sub(transport.last)
+	}
+	transport.last = entry.Time
+	transport.lock.Unlock()
+
+	if wait > 0 {
+		time.Sleep(wait)
+	}
+
+	var response struct {
+		Extra string `json:"@extra"`
+	}
+	data := []byte(entry.Data)
+	if json.Unmarshal(data, &response) == nil && response.Extra != "" {
+		transport.lock.Lock()
+		newExtra, found := transport.extras[response.Extra]
+		delete(transport.extras, response.Extra)
+		transport.lock.Unlock()
+
+		if found {
+			oldExtra, _ := json.Marshal(response.Extra)
+			quotedExtra, _ := json.Marshal(newExtra)
+			data = bytes.Replace(data, oldExtra, quotedExtra, 1)
+		}
+	}
+
+	return data
+}
+
+// Destroy does nothing, there is no TDLib client instance to destroy
+func (transport *ReplayTransport) Destroy() {
+}
+
+// load reads the entries of a record file
+func (transport *ReplayTransport) load(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader, err := gzip.NewReader(file)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		var entry RecordEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return err
+		}
+
+		switch entry.Direction {
+		case RecordReceive:
+			transport.received = append(transport.received, entry)
+		case RecordSend:
+			var request struct {
+				Type  string `json:"@type"`
+				Extra string `json:"@extra"`
+			}
+			if json.Unmarshal(entry.Data, &request) == nil && request.Extra != "" {
+				transport.sent[request.Type] = append(transport.sent[request.Type], request.Extra)
+			}
+		}
+	}
+
+	return scanner.Err()
+}
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ReplyMarkup Contains a description of a custom keyboard and actions that can be done with it to quickly reply to bots
 type ReplyMarkup interface {
 	GetReplyMarkupEnum() ReplyMarkupEnum
+	Accept(visitor ReplyMarkupVisitor)
 }
 
 // ReplyMarkupEnum Alias for abstract ReplyMarkup 'Sub-Classes', used as constant-enum here
@@ -21,41 +23,92 @@ const (
 	ReplyMarkupInlineKeyboardType ReplyMarkupEnum = "replyMarkupInlineKeyboard"
 )
 
+// UnknownReplyMarkup is a ReplyMarkup of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownReplyMarkup struct {
+	Unknown
+}
+
+// GetReplyMarkupEnum return the enum type of this object
+func (unknownReplyMarkup *UnknownReplyMarkup) GetReplyMarkupEnum() ReplyMarkupEnum {
+	return ReplyMarkupEnum(unknownReplyMarkup.Type)
+}
+
+// Accept calls the method of the visitor for UnknownReplyMarkup
+func (unknownReplyMarkup *UnknownReplyMarkup) Accept(visitor ReplyMarkupVisitor) {
+	visitor.VisitUnknownReplyMarkup(unknownReplyMarkup)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownReplyMarkup *UnknownReplyMarkup) String() string {
+	return objectString(unknownReplyMarkup)
+}
+
+// LogValue logs the object as its summary
+func (unknownReplyMarkup *UnknownReplyMarkup) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownReplyMarkup))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownReplyMarkup *UnknownReplyMarkup) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownReplyMarkup)
+}
+
+func (unknownReplyMarkup *UnknownReplyMarkup) printText(p *printer) {
+	if unknownReplyMarkup == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownReplyMarkup")
+	p.string("@type", unknownReplyMarkup.Type)
+	p.endObject(state)
+}
+
 func unmarshalReplyMarkup(rawMsg *json.RawMessage) (ReplyMarkup, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch ReplyMarkupEnum(objMap["@type"].(string)) {
+	switch ReplyMarkupEnum(typeName) {
 	case ReplyMarkupRemoveKeyboardType:
 		var replyMarkupRemoveKeyboard ReplyMarkupRemoveKeyboard
-		err := json.Unmarshal(*rawMsg, &replyMarkupRemoveKeyboard)
+		err := jsonUnmarshal(*rawMsg, &replyMarkupRemoveKeyboard)
 		return &replyMarkupRemoveKeyboard, err
 
 	case ReplyMarkupForceReplyType:
 		var replyMarkupForceReply ReplyMarkupForceReply
-		err := json.Unmarshal(*rawMsg, &replyMarkupForceReply)
+		err := jsonUnmarshal(*rawMsg, &replyMarkupForceReply)
 		return &replyMarkupForceReply, err
 
 	case ReplyMarkupShowKeyboardType:
 		var replyMarkupShowKeyboard ReplyMarkupShowKeyboard
-		err := json.Unmarshal(*rawMsg, &replyMarkupShowKeyboard)
+		err := jsonUnmarshal(*rawMsg, &replyMarkupShowKeyboard)
 		return &replyMarkupShowKeyboard, err
 
 	case ReplyMarkupInlineKeyboardType:
 		var replyMarkupInlineKeyboard ReplyMarkupInlineKeyboard
-		err := json.Unmarshal(*rawMsg, &replyMarkupInlineKeyboard)
+		err := jsonUnmarshal(*rawMsg, &replyMarkupInlineKeyboard)
 		return &replyMarkupInlineKeyboard, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownReplyMarkup{Unknown: unknown}, nil
+	}
+}
+
+func decodeReplyMarkup(r *jsonReader) (ReplyMarkup, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
 	}
+	return unmarshalReplyMarkup(rawMsg)
 }
 
 // ReplyMarkupRemoveKeyboard Instructs application to remove the keyboard once this message has been received. This kind of keyboard can't be received in an incoming message; instead, UpdateChatReplyMarkup with message_id == 0 will be sent
@@ -81,11 +134,118 @@ func NewReplyMarkupRemoveKeyboard(isPersonal bool) *ReplyMarkupRemoveKeyboard {
 	return &replyMarkupRemoveKeyboardTemp
 }
 
+// MarshalJSON marshals to json
+func (replyMarkupRemoveKeyboard *ReplyMarkupRemoveKeyboard) MarshalJSON() ([]byte, error) {
+	return replyMarkupRemoveKeyboard.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (replyMarkupRemoveKeyboard *ReplyMarkupRemoveKeyboard) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, replyMarkupRemoveKeyboard)
+}
+
+func (replyMarkupRemoveKeyboard *ReplyMarkupRemoveKeyboard) appendJSON(b []byte) ([]byte, error) {
+	if replyMarkupRemoveKeyboard == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, replyMarkupRemoveKeyboard.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, replyMarkupRemoveKeyboard.tdCommon.Extra)
+	b = append(b, `,"is_personal":`...)
+	b = appendJSONBool(b, replyMarkupRemoveKeyboard.IsPersonal)
+	return append(b, '}'), nil
+}
+
+func (replyMarkupRemoveKeyboard *ReplyMarkupRemoveKeyboard) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			replyMarkupRemoveKeyboard.tdCommon.Type, err = r.readString()
+		case "@extra":
+			replyMarkupRemoveKeyboard.tdCommon.Extra, err = r.readString()
+		case "is_personal":
+			replyMarkupRemoveKeyboard.IsPersonal, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (replyMarkupRemoveKeyboard *ReplyMarkupRemoveKeyboard) Clone() *ReplyMarkupRemoveKeyboard {
+	if replyMarkupRemoveKeyboard == nil {
+		return nil
+	}
+	clone := *replyMarkupRemoveKeyboard
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (replyMarkupRemoveKeyboard *ReplyMarkupRemoveKeyboard) Equal(other *ReplyMarkupRemoveKeyboard) bool {
+	if replyMarkupRemoveKeyboard == nil || other == nil {
+		return replyMarkupRemoveKeyboard == other
+	}
+	if replyMarkupRemoveKeyboard.IsPersonal != other.IsPersonal {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (replyMarkupRemoveKeyboard *ReplyMarkupRemoveKeyboard) Diff(other *ReplyMarkupRemoveKeyboard) []Change {
+	return replyMarkupRemoveKeyboard.diff("", other, nil)
+}
+
+func (replyMarkupRemoveKeyboard *ReplyMarkupRemoveKeyboard) diff(path string, other *ReplyMarkupRemoveKeyboard, changes []Change) []Change {
+	if replyMarkupRemoveKeyboard == nil || other == nil {
+		if replyMarkupRemoveKeyboard != other {
+			changes = append(changes, Change{Path: path, Old: replyMarkupRemoveKeyboard, New: other})
+		}
+		return changes
+	}
+	if replyMarkupRemoveKeyboard.IsPersonal != other.IsPersonal {
+		changes = append(changes, Change{Path: fieldPath(path, "is_personal"), Old: replyMarkupRemoveKeyboard.IsPersonal, New: other.IsPersonal})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (replyMarkupRemoveKeyboard *ReplyMarkupRemoveKeyboard) String() string {
+	return objectString(replyMarkupRemoveKeyboard)
+}
+
+// LogValue logs the object as its summary
+func (replyMarkupRemoveKeyboard *ReplyMarkupRemoveKeyboard) LogValue() slog.Value {
+	return slog.StringValue(objectString(replyMarkupRemoveKeyboard))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (replyMarkupRemoveKeyboard *ReplyMarkupRemoveKeyboard) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, replyMarkupRemoveKeyboard)
+}
+
+func (replyMarkupRemoveKeyboard *ReplyMarkupRemoveKeyboard) printText(p *printer) {
+	if replyMarkupRemoveKeyboard == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ReplyMarkupRemoveKeyboard")
+	p.bool("is_personal", replyMarkupRemoveKeyboard.IsPersonal)
+	p.endObject(state)
+}
+
 // GetReplyMarkupEnum return the enum type of this object
 func (replyMarkupRemoveKeyboard *ReplyMarkupRemoveKeyboard) GetReplyMarkupEnum() ReplyMarkupEnum {
 	return ReplyMarkupRemoveKeyboardType
 }
 
+// Accept calls the method of the visitor for ReplyMarkupRemoveKeyboard
+func (replyMarkupRemoveKeyboard *ReplyMarkupRemoveKeyboard) Accept(visitor ReplyMarkupVisitor) {
+	visitor.VisitReplyMarkupRemoveKeyboard(replyMarkupRemoveKeyboard)
+}
+
 // ReplyMarkupForceReply Instructs application to force a reply to this message
 type ReplyMarkupForceReply struct {
 	tdCommon
@@ -112,11 +272,129 @@ func NewReplyMarkupForceReply(isPersonal bool, inputFieldPlaceholder string) *Re
 	return &replyMarkupForceReplyTemp
 }
 
+// MarshalJSON marshals to json
+func (replyMarkupForceReply *ReplyMarkupForceReply) MarshalJSON() ([]byte, error) {
+	return replyMarkupForceReply.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (replyMarkupForceReply *ReplyMarkupForceReply) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, replyMarkupForceReply)
+}
+
+func (replyMarkupForceReply *ReplyMarkupForceReply) appendJSON(b []byte) ([]byte, error) {
+	if replyMarkupForceReply == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, replyMarkupForceReply.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, replyMarkupForceReply.tdCommon.Extra)
+	b = append(b, `,"is_personal":`...)
+	b = appendJSONBool(b, replyMarkupForceReply.IsPersonal)
+	b = append(b, `,"input_field_placeholder":`...)
+	b = appendJSONString(b, replyMarkupForceReply.InputFieldPlaceholder)
+	return append(b, '}'), nil
+}
+
+func (replyMarkupForceReply *ReplyMarkupForceReply) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			replyMarkupForceReply.tdCommon.Type, err = r.readString()
+		case "@extra":
+			replyMarkupForceReply.tdCommon.Extra, err = r.readString()
+		case "is_personal":
+			replyMarkupForceReply.IsPersonal, err = r.readBool()
+		case "input_field_placeholder":
+			replyMarkupForceReply.InputFieldPlaceholder, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (replyMarkupForceReply *ReplyMarkupForceReply) Clone() *ReplyMarkupForceReply {
+	if replyMarkupForceReply == nil {
+		return nil
+	}
+	clone := *replyMarkupForceReply
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (replyMarkupForceReply *ReplyMarkupForceReply) Equal(other *ReplyMarkupForceReply) bool {
+	if replyMarkupForceReply == nil || other == nil {
+		return replyMarkupForceReply == other
+	}
+	if replyMarkupForceReply.IsPersonal != other.IsPersonal {
+		return false
+	}
+	if replyMarkupForceReply.InputFieldPlaceholder != other.InputFieldPlaceholder {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (replyMarkupForceReply *ReplyMarkupForceReply) Diff(other *ReplyMarkupForceReply) []Change {
+	return replyMarkupForceReply.diff("", other, nil)
+}
+
+func (replyMarkupForceReply *ReplyMarkupForceReply) diff(path string, other *ReplyMarkupForceReply, changes []Change) []Change {
+	if replyMarkupForceReply == nil || other == nil {
+		if replyMarkupForceReply != other {
+			changes = append(changes, Change{Path: path, Old: replyMarkupForceReply, New: other})
+		}
+		return changes
+	}
+	if replyMarkupForceReply.IsPersonal != other.IsPersonal {
+		changes = append(changes, Change{Path: fieldPath(path, "is_personal"), Old: replyMarkupForceReply.IsPersonal, New: other.IsPersonal})
+	}
+	if replyMarkupForceReply.InputFieldPlaceholder != other.InputFieldPlaceholder {
+		changes = append(changes, Change{Path: fieldPath(path, "input_field_placeholder"), Old: replyMarkupForceReply.InputFieldPlaceholder, New: other.InputFieldPlaceholder})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (replyMarkupForceReply *ReplyMarkupForceReply) String() string {
+	return objectString(replyMarkupForceReply)
+}
+
+// LogValue logs the object as its summary
+func (replyMarkupForceReply *ReplyMarkupForceReply) LogValue() slog.Value {
+	return slog.StringValue(objectString(replyMarkupForceReply))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (replyMarkupForceReply *ReplyMarkupForceReply) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, replyMarkupForceReply)
+}
+
+func (replyMarkupForceReply *ReplyMarkupForceReply) printText(p *printer) {
+	if replyMarkupForceReply == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ReplyMarkupForceReply")
+	p.bool("is_personal", replyMarkupForceReply.IsPersonal)
+	p.string("input_field_placeholder", replyMarkupForceReply.InputFieldPlaceholder)
+	p.endObject(state)
+}
+
 // GetReplyMarkupEnum return the enum type of this object
 func (replyMarkupForceReply *ReplyMarkupForceReply) GetReplyMarkupEnum() ReplyMarkupEnum {
 	return ReplyMarkupForceReplyType
 }
 
+// Accept calls the method of the visitor for ReplyMarkupForceReply
+func (replyMarkupForceReply *ReplyMarkupForceReply) Accept(visitor ReplyMarkupVisitor) {
+	visitor.VisitReplyMarkupForceReply(replyMarkupForceReply)
+}
+
 // ReplyMarkupShowKeyboard Contains a custom keyboard layout to quickly reply to bots
 type ReplyMarkupShowKeyboard struct {
 	tdCommon
@@ -152,11 +430,243 @@ func NewReplyMarkupShowKeyboard(rows [][]KeyboardButton, resizeKeyboard bool, on
 	return &replyMarkupShowKeyboardTemp
 }
 
+// MarshalJSON marshals to json
+func (replyMarkupShowKeyboard *ReplyMarkupShowKeyboard) MarshalJSON() ([]byte, error) {
+	return replyMarkupShowKeyboard.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (replyMarkupShowKeyboard *ReplyMarkupShowKeyboard) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, replyMarkupShowKeyboard)
+}
+
+func (replyMarkupShowKeyboard *ReplyMarkupShowKeyboard) appendJSON(b []byte) ([]byte, error) {
+	if replyMarkupShowKeyboard == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, replyMarkupShowKeyboard.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, replyMarkupShowKeyboard.tdCommon.Extra)
+	b = append(b, `,"rows":`...)
+	if replyMarkupShowKeyboard.Rows == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range replyMarkupShowKeyboard.Rows {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			if replyMarkupShowKeyboard.Rows[i0] == nil {
+				b = append(b, "null"...)
+			} else {
+				b = append(b, '[')
+				for i1 := range replyMarkupShowKeyboard.Rows[i0] {
+					if i1 > 0 {
+						b = append(b, ',')
+					}
+					b, err = replyMarkupShowKeyboard.Rows[i0][i1].appendJSON(b)
+					if err != nil {
+						return nil, err
+					}
+				}
+				b = append(b, ']')
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"resize_keyboard":`...)
+	b = appendJSONBool(b, replyMarkupShowKeyboard.ResizeKeyboard)
+	b = append(b, `,"one_time":`...)
+	b = appendJSONBool(b, replyMarkupShowKeyboard.OneTime)
+	b = append(b, `,"is_personal":`...)
+	b = appendJSONBool(b, replyMarkupShowKeyboard.IsPersonal)
+	b = append(b, `,"input_field_placeholder":`...)
+	b = appendJSONString(b, replyMarkupShowKeyboard.InputFieldPlaceholder)
+	return append(b, '}'), nil
+}
+
+func (replyMarkupShowKeyboard *ReplyMarkupShowKeyboard) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			replyMarkupShowKeyboard.tdCommon.Type, err = r.readString()
+		case "@extra":
+			replyMarkupShowKeyboard.tdCommon.Extra, err = r.readString()
+		case "rows":
+			if r.readNull() {
+				replyMarkupShowKeyboard.Rows = nil
+			} else {
+				replyMarkupShowKeyboard.Rows = make([][]KeyboardButton, 0)
+				err = r.readArray(func() (err error) {
+					var item0 []KeyboardButton
+					if r.readNull() {
+						item0 = nil
+					} else {
+						item0 = make([]KeyboardButton, 0)
+						err = r.readArray(func() (err error) {
+							var item1 KeyboardButton
+							err = item1.decodeJSON(r)
+							item0 = append(item0, item1)
+							return
+						})
+					}
+					replyMarkupShowKeyboard.Rows = append(replyMarkupShowKeyboard.Rows, item0)
+					return
+				})
+			}
+		case "resize_keyboard":
+			replyMarkupShowKeyboard.ResizeKeyboard, err = r.readBool()
+		case "one_time":
+			replyMarkupShowKeyboard.OneTime, err = r.readBool()
+		case "is_personal":
+			replyMarkupShowKeyboard.IsPersonal, err = r.readBool()
+		case "input_field_placeholder":
+			replyMarkupShowKeyboard.InputFieldPlaceholder, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (replyMarkupShowKeyboard *ReplyMarkupShowKeyboard) Clone() *ReplyMarkupShowKeyboard {
+	if replyMarkupShowKeyboard == nil {
+		return nil
+	}
+	clone := *replyMarkupShowKeyboard
+	if replyMarkupShowKeyboard.Rows != nil {
+		clone.Rows = make([][]KeyboardButton, len(replyMarkupShowKeyboard.Rows))
+		for i0 := range replyMarkupShowKeyboard.Rows {
+			if replyMarkupShowKeyboard.Rows[i0] != nil {
+				clone.Rows[i0] = make([]KeyboardButton, len(replyMarkupShowKeyboard.Rows[i0]))
+				for i1 := range replyMarkupShowKeyboard.Rows[i0] {
+					clone.Rows[i0][i1] = *replyMarkupShowKeyboard.Rows[i0][i1].Clone()
+				}
+			}
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (replyMarkupShowKeyboard *ReplyMarkupShowKeyboard) Equal(other *ReplyMarkupShowKeyboard) bool {
+	if replyMarkupShowKeyboard == nil || other == nil {
+		return replyMarkupShowKeyboard == other
+	}
+	if len(replyMarkupShowKeyboard.Rows) != len(other.Rows) {
+		return false
+	}
+	for i0 := range replyMarkupShowKeyboard.Rows {
+		if len(replyMarkupShowKeyboard.Rows[i0]) != len(other.Rows[i0]) {
+			return false
+		}
+		for i1 := range replyMarkupShowKeyboard.Rows[i0] {
+			if !replyMarkupShowKeyboard.Rows[i0][i1].Equal(&other.Rows[i0][i1]) {
+				return false
+			}
+		}
+	}
+	if replyMarkupShowKeyboard.ResizeKeyboard != other.ResizeKeyboard {
+		return false
+	}
+	if replyMarkupShowKeyboard.OneTime != other.OneTime {
+		return false
+	}
+	if replyMarkupShowKeyboard.IsPersonal != other.IsPersonal {
+		return false
+	}
+	if replyMarkupShowKeyboard.InputFieldPlaceholder != other.InputFieldPlaceholder {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (replyMarkupShowKeyboard *ReplyMarkupShowKeyboard) Diff(other *ReplyMarkupShowKeyboard) []Change {
+	return replyMarkupShowKeyboard.diff("", other, nil)
+}
+
+func (replyMarkupShowKeyboard *ReplyMarkupShowKeyboard) diff(path string, other *ReplyMarkupShowKeyboard, changes []Change) []Change {
+	if replyMarkupShowKeyboard == nil || other == nil {
+		if replyMarkupShowKeyboard != other {
+			changes = append(changes, Change{Path: path, Old: replyMarkupShowKeyboard, New: other})
+		}
+		return changes
+	}
+	if len(replyMarkupShowKeyboard.Rows) != len(other.Rows) {
+		changes = append(changes, Change{Path: fieldPath(path, "rows"), Old: replyMarkupShowKeyboard.Rows, New: other.Rows})
+	} else {
+		for i0 := range replyMarkupShowKeyboard.Rows {
+			if len(replyMarkupShowKeyboard.Rows[i0]) != len(other.Rows[i0]) {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "rows"), i0), Old: replyMarkupShowKeyboard.Rows[i0], New: other.Rows[i0]})
+			} else {
+				for i1 := range replyMarkupShowKeyboard.Rows[i0] {
+					changes = replyMarkupShowKeyboard.Rows[i0][i1].diff(indexPath(indexPath(fieldPath(path, "rows"), i0), i1), &other.Rows[i0][i1], changes)
+				}
+			}
+		}
+	}
+	if replyMarkupShowKeyboard.ResizeKeyboard != other.ResizeKeyboard {
+		changes = append(changes, Change{Path: fieldPath(path, "resize_keyboard"), Old: replyMarkupShowKeyboard.ResizeKeyboard, New: other.ResizeKeyboard})
+	}
+	if replyMarkupShowKeyboard.OneTime != other.OneTime {
+		changes = append(changes, Change{Path: fieldPath(path, "one_time"), Old: replyMarkupShowKeyboard.OneTime, New: other.OneTime})
+	}
+	if replyMarkupShowKeyboard.IsPersonal != other.IsPersonal {
+		changes = append(changes, Change{Path: fieldPath(path, "is_personal"), Old: replyMarkupShowKeyboard.IsPersonal, New: other.IsPersonal})
+	}
+	if replyMarkupShowKeyboard.InputFieldPlaceholder != other.InputFieldPlaceholder {
+		changes = append(changes, Change{Path: fieldPath(path, "input_field_placeholder"), Old: replyMarkupShowKeyboard.InputFieldPlaceholder, New: other.InputFieldPlaceholder})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (replyMarkupShowKeyboard *ReplyMarkupShowKeyboard) String() string {
+	return objectString(replyMarkupShowKeyboard)
+}
+
+// LogValue logs the object as its summary
+func (replyMarkupShowKeyboard *ReplyMarkupShowKeyboard) LogValue() slog.Value {
+	return slog.StringValue(objectString(replyMarkupShowKeyboard))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (replyMarkupShowKeyboard *ReplyMarkupShowKeyboard) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, replyMarkupShowKeyboard)
+}
+
+func (replyMarkupShowKeyboard *ReplyMarkupShowKeyboard) printText(p *printer) {
+	if replyMarkupShowKeyboard == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ReplyMarkupShowKeyboard")
+	p.vector("rows", len(replyMarkupShowKeyboard.Rows), func(i0 int) {
+		p.vector("rows", len(replyMarkupShowKeyboard.Rows[i0]), func(i1 int) {
+			replyMarkupShowKeyboard.Rows[i0][i1].printText(p)
+		})
+	})
+	p.bool("resize_keyboard", replyMarkupShowKeyboard.ResizeKeyboard)
+	p.bool("one_time", replyMarkupShowKeyboard.OneTime)
+	p.bool("is_personal", replyMarkupShowKeyboard.IsPersonal)
+	p.string("input_field_placeholder", replyMarkupShowKeyboard.InputFieldPlaceholder)
+	p.endObject(state)
+}
+
 // GetReplyMarkupEnum return the enum type of this object
 func (replyMarkupShowKeyboard *ReplyMarkupShowKeyboard) GetReplyMarkupEnum() ReplyMarkupEnum {
 	return ReplyMarkupShowKeyboardType
 }
 
+// Accept calls the method of the visitor for ReplyMarkupShowKeyboard
+func (replyMarkupShowKeyboard *ReplyMarkupShowKeyboard) Accept(visitor ReplyMarkupVisitor) {
+	visitor.VisitReplyMarkupShowKeyboard(replyMarkupShowKeyboard)
+}
+
 // ReplyMarkupInlineKeyboard Contains an inline keyboard layout
 type ReplyMarkupInlineKeyboard struct {
 	tdCommon
@@ -180,7 +690,320 @@ func NewReplyMarkupInlineKeyboard(rows [][]InlineKeyboardButton) *ReplyMarkupInl
 	return &replyMarkupInlineKeyboardTemp
 }
 
+// MarshalJSON marshals to json
+func (replyMarkupInlineKeyboard *ReplyMarkupInlineKeyboard) MarshalJSON() ([]byte, error) {
+	return replyMarkupInlineKeyboard.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (replyMarkupInlineKeyboard *ReplyMarkupInlineKeyboard) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, replyMarkupInlineKeyboard)
+}
+
+func (replyMarkupInlineKeyboard *ReplyMarkupInlineKeyboard) appendJSON(b []byte) ([]byte, error) {
+	if replyMarkupInlineKeyboard == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, replyMarkupInlineKeyboard.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, replyMarkupInlineKeyboard.tdCommon.Extra)
+	b = append(b, `,"rows":`...)
+	if replyMarkupInlineKeyboard.Rows == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range replyMarkupInlineKeyboard.Rows {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			if replyMarkupInlineKeyboard.Rows[i0] == nil {
+				b = append(b, "null"...)
+			} else {
+				b = append(b, '[')
+				for i1 := range replyMarkupInlineKeyboard.Rows[i0] {
+					if i1 > 0 {
+						b = append(b, ',')
+					}
+					b, err = replyMarkupInlineKeyboard.Rows[i0][i1].appendJSON(b)
+					if err != nil {
+						return nil, err
+					}
+				}
+				b = append(b, ']')
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (replyMarkupInlineKeyboard *ReplyMarkupInlineKeyboard) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			replyMarkupInlineKeyboard.tdCommon.Type, err = r.readString()
+		case "@extra":
+			replyMarkupInlineKeyboard.tdCommon.Extra, err = r.readString()
+		case "rows":
+			if r.readNull() {
+				replyMarkupInlineKeyboard.Rows = nil
+			} else {
+				replyMarkupInlineKeyboard.Rows = make([][]InlineKeyboardButton, 0)
+				err = r.readArray(func() (err error) {
+					var item0 []InlineKeyboardButton
+					if r.readNull() {
+						item0 = nil
+					} else {
+						item0 = make([]InlineKeyboardButton, 0)
+						err = r.readArray(func() (err error) {
+							var item1 InlineKeyboardButton
+							err = item1.decodeJSON(r)
+							item0 = append(item0, item1)
+							return
+						})
+					}
+					replyMarkupInlineKeyboard.Rows = append(replyMarkupInlineKeyboard.Rows, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (replyMarkupInlineKeyboard *ReplyMarkupInlineKeyboard) Clone() *ReplyMarkupInlineKeyboard {
+	if replyMarkupInlineKeyboard == nil {
+		return nil
+	}
+	clone := *replyMarkupInlineKeyboard
+	if replyMarkupInlineKeyboard.Rows != nil {
+		clone.Rows = make([][]InlineKeyboardButton, len(replyMarkupInlineKeyboard.Rows))
+		for i0 := range replyMarkupInlineKeyboard.Rows {
+			if replyMarkupInlineKeyboard.Rows[i0] != nil {
+				clone.Rows[i0] = make([]InlineKeyboardButton, len(replyMarkupInlineKeyboard.Rows[i0]))
+				for i1 := range replyMarkupInlineKeyboard.Rows[i0] {
+					clone.Rows[i0][i1] = *replyMarkupInlineKeyboard.Rows[i0][i1].Clone()
+				}
+			}
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (replyMarkupInlineKeyboard *ReplyMarkupInlineKeyboard) Equal(other *ReplyMarkupInlineKeyboard) bool {
+	if replyMarkupInlineKeyboard == nil || other == nil {
+		return replyMarkupInlineKeyboard == other
+	}
+	if len(replyMarkupInlineKeyboard.Rows) != len(other.Rows) {
+		return false
+	}
+	for i0 := range replyMarkupInlineKeyboard.Rows {
+		if len(replyMarkupInlineKeyboard.Rows[i0]) != len(other.Rows[i0]) {
+			return false
+		}
+		for i1 := range replyMarkupInlineKeyboard.Rows[i0] {
+			if !replyMarkupInlineKeyboard.Rows[i0][i1].Equal(&other.Rows[i0][i1]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (replyMarkupInlineKeyboard *ReplyMarkupInlineKeyboard) Diff(other *ReplyMarkupInlineKeyboard) []Change {
+	return replyMarkupInlineKeyboard.diff("", other, nil)
+}
+
+func (replyMarkupInlineKeyboard *ReplyMarkupInlineKeyboard) diff(path string, other *ReplyMarkupInlineKeyboard, changes []Change) []Change {
+	if replyMarkupInlineKeyboard == nil || other == nil {
+		if replyMarkupInlineKeyboard != other {
+			changes = append(changes, Change{Path: path, Old: replyMarkupInlineKeyboard, New: other})
+		}
+		return changes
+	}
+	if len(replyMarkupInlineKeyboard.Rows) != len(other.Rows) {
+		changes = append(changes, Change{Path: fieldPath(path, "rows"), Old: replyMarkupInlineKeyboard.Rows, New: other.Rows})
+	} else {
+		for i0 := range replyMarkupInlineKeyboard.Rows {
+			if len(replyMarkupInlineKeyboard.Rows[i0]) != len(other.Rows[i0]) {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "rows"), i0), Old: replyMarkupInlineKeyboard.Rows[i0], New: other.Rows[i0]})
+			} else {
+				for i1 := range replyMarkupInlineKeyboard.Rows[i0] {
+					changes = replyMarkupInlineKeyboard.Rows[i0][i1].diff(indexPath(indexPath(fieldPath(path, "rows"), i0), i1), &other.Rows[i0][i1], changes)
+				}
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (replyMarkupInlineKeyboard *ReplyMarkupInlineKeyboard) String() string {
+	return objectString(replyMarkupInlineKeyboard)
+}
+
+// LogValue logs the object as its summary
+func (replyMarkupInlineKeyboard *ReplyMarkupInlineKeyboard) LogValue() slog.Value {
+	return slog.StringValue(objectString(replyMarkupInlineKeyboard))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (replyMarkupInlineKeyboard *ReplyMarkupInlineKeyboard) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, replyMarkupInlineKeyboard)
+}
+
+func (replyMarkupInlineKeyboard *ReplyMarkupInlineKeyboard) printText(p *printer) {
+	if replyMarkupInlineKeyboard == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ReplyMarkupInlineKeyboard")
+	p.vector("rows", len(replyMarkupInlineKeyboard.Rows), func(i0 int) {
+		p.vector("rows", len(replyMarkupInlineKeyboard.Rows[i0]), func(i1 int) {
+			replyMarkupInlineKeyboard.Rows[i0][i1].printText(p)
+		})
+	})
+	p.endObject(state)
+}
+
 // GetReplyMarkupEnum return the enum type of this object
 func (replyMarkupInlineKeyboard *ReplyMarkupInlineKeyboard) GetReplyMarkupEnum() ReplyMarkupEnum {
 	return ReplyMarkupInlineKeyboardType
 }
+
+// Accept calls the method of the visitor for ReplyMarkupInlineKeyboard
+func (replyMarkupInlineKeyboard *ReplyMarkupInlineKeyboard) Accept(visitor ReplyMarkupVisitor) {
+	visitor.VisitReplyMarkupInlineKeyboard(replyMarkupInlineKeyboard)
+}
+
+// ReplyMarkupVisitor visits every type of ReplyMarkup, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to ReplyMarkup, until they handle it too.
+type ReplyMarkupVisitor interface {
+	VisitReplyMarkupRemoveKeyboard(replyMarkupRemoveKeyboard *ReplyMarkupRemoveKeyboard)
+	VisitReplyMarkupForceReply(replyMarkupForceReply *ReplyMarkupForceReply)
+	VisitReplyMarkupShowKeyboard(replyMarkupShowKeyboard *ReplyMarkupShowKeyboard)
+	VisitReplyMarkupInlineKeyboard(replyMarkupInlineKeyboard *ReplyMarkupInlineKeyboard)
+	VisitUnknownReplyMarkup(unknownReplyMarkup *UnknownReplyMarkup)
+}
+
+// ReplyMarkupCases holds the function MatchReplyMarkup calls for each type of ReplyMarkup;
+// types without a function are passed to the default case
+type ReplyMarkupCases[R any] struct {
+	ReplyMarkupRemoveKeyboard func(replyMarkupRemoveKeyboard *ReplyMarkupRemoveKeyboard) R
+	ReplyMarkupForceReply     func(replyMarkupForceReply *ReplyMarkupForceReply) R
+	ReplyMarkupShowKeyboard   func(replyMarkupShowKeyboard *ReplyMarkupShowKeyboard) R
+	ReplyMarkupInlineKeyboard func(replyMarkupInlineKeyboard *ReplyMarkupInlineKeyboard) R
+}
+
+// MatchReplyMarkup calls the case of the type of replyMarkup, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement ReplyMarkupVisitor
+// to have the compiler check every type is handled.
+func MatchReplyMarkup[R any](replyMarkup ReplyMarkup, cases ReplyMarkupCases[R], defaultCase func(replyMarkup ReplyMarkup) R) R {
+	switch value := replyMarkup.(type) {
+	case *ReplyMarkupRemoveKeyboard:
+		if cases.ReplyMarkupRemoveKeyboard != nil {
+			return cases.ReplyMarkupRemoveKeyboard(value)
+		}
+	case *ReplyMarkupForceReply:
+		if cases.ReplyMarkupForceReply != nil {
+			return cases.ReplyMarkupForceReply(value)
+		}
+	case *ReplyMarkupShowKeyboard:
+		if cases.ReplyMarkupShowKeyboard != nil {
+			return cases.ReplyMarkupShowKeyboard(value)
+		}
+	case *ReplyMarkupInlineKeyboard:
+		if cases.ReplyMarkupInlineKeyboard != nil {
+			return cases.ReplyMarkupInlineKeyboard(value)
+		}
+	}
+	return defaultCase(replyMarkup)
+}
+
+// Clone returns a deep copy of the object
+func (unknownReplyMarkup *UnknownReplyMarkup) Clone() *UnknownReplyMarkup {
+	if unknownReplyMarkup == nil {
+		return nil
+	}
+	return &UnknownReplyMarkup{Unknown: unknownReplyMarkup.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownReplyMarkup *UnknownReplyMarkup) Equal(other *UnknownReplyMarkup) bool {
+	if unknownReplyMarkup == nil || other == nil {
+		return unknownReplyMarkup == other
+	}
+	return unknownReplyMarkup.Unknown.equal(other.Unknown)
+}
+
+func cloneReplyMarkup(replyMarkup ReplyMarkup) ReplyMarkup {
+	switch value := replyMarkup.(type) {
+	case *ReplyMarkupRemoveKeyboard:
+		return value.Clone()
+	case *ReplyMarkupForceReply:
+		return value.Clone()
+	case *ReplyMarkupShowKeyboard:
+		return value.Clone()
+	case *ReplyMarkupInlineKeyboard:
+		return value.Clone()
+	case *UnknownReplyMarkup:
+		return value.Clone()
+	}
+	return replyMarkup
+}
+
+func equalReplyMarkup(a ReplyMarkup, b ReplyMarkup) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *ReplyMarkupRemoveKeyboard:
+		b, ok := b.(*ReplyMarkupRemoveKeyboard)
+		return ok && a.Equal(b)
+	case *ReplyMarkupForceReply:
+		b, ok := b.(*ReplyMarkupForceReply)
+		return ok && a.Equal(b)
+	case *ReplyMarkupShowKeyboard:
+		b, ok := b.(*ReplyMarkupShowKeyboard)
+		return ok && a.Equal(b)
+	case *ReplyMarkupInlineKeyboard:
+		b, ok := b.(*ReplyMarkupInlineKeyboard)
+		return ok && a.Equal(b)
+	case *UnknownReplyMarkup:
+		b, ok := b.(*UnknownReplyMarkup)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffReplyMarkup(path string, a ReplyMarkup, b ReplyMarkup, changes []Change) []Change {
+	switch a := a.(type) {
+	case *ReplyMarkupRemoveKeyboard:
+		if b, ok := b.(*ReplyMarkupRemoveKeyboard); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ReplyMarkupForceReply:
+		if b, ok := b.(*ReplyMarkupForceReply); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ReplyMarkupShowKeyboard:
+		if b, ok := b.(*ReplyMarkupShowKeyboard); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ReplyMarkupInlineKeyboard:
+		if b, ok := b.(*ReplyMarkupInlineKeyboard); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalReplyMarkup(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
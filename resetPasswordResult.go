@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ResetPasswordResult Represents result of 2-step verification password reset
 type ResetPasswordResult interface {
 	GetResetPasswordResultEnum() ResetPasswordResultEnum
+	Accept(visitor ResetPasswordResultVisitor)
 }
 
 // ResetPasswordResultEnum Alias for abstract ResetPasswordResult 'Sub-Classes', used as constant-enum here
@@ -20,35 +22,78 @@ const (
 	ResetPasswordResultDeclinedType ResetPasswordResultEnum = "resetPasswordResultDeclined"
 )
 
+// UnknownResetPasswordResult is a ResetPasswordResult of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownResetPasswordResult struct {
+	Unknown
+}
+
+// GetResetPasswordResultEnum return the enum type of this object
+func (unknownResetPasswordResult *UnknownResetPasswordResult) GetResetPasswordResultEnum() ResetPasswordResultEnum {
+	return ResetPasswordResultEnum(unknownResetPasswordResult.Type)
+}
+
+// Accept calls the method of the visitor for UnknownResetPasswordResult
+func (unknownResetPasswordResult *UnknownResetPasswordResult) Accept(visitor ResetPasswordResultVisitor) {
+	visitor.VisitUnknownResetPasswordResult(unknownResetPasswordResult)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownResetPasswordResult *UnknownResetPasswordResult) String() string {
+	return objectString(unknownResetPasswordResult)
+}
+
+// LogValue logs the object as its summary
+func (unknownResetPasswordResult *UnknownResetPasswordResult) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownResetPasswordResult))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownResetPasswordResult *UnknownResetPasswordResult) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownResetPasswordResult)
+}
+
+func (unknownResetPasswordResult *UnknownResetPasswordResult) printText(p *printer) {
+	if unknownResetPasswordResult == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownResetPasswordResult")
+	p.string("@type", unknownResetPasswordResult.Type)
+	p.endObject(state)
+}
+
 func unmarshalResetPasswordResult(rawMsg *json.RawMessage) (ResetPasswordResult, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch ResetPasswordResultEnum(objMap["@type"].(string)) {
+	switch ResetPasswordResultEnum(typeName) {
 	case ResetPasswordResultOkType:
 		var resetPasswordResultOk ResetPasswordResultOk
-		err := json.Unmarshal(*rawMsg, &resetPasswordResultOk)
+		err := jsonUnmarshal(*rawMsg, &resetPasswordResultOk)
 		return &resetPasswordResultOk, err
 
 	case ResetPasswordResultPendingType:
 		var resetPasswordResultPending ResetPasswordResultPending
-		err := json.Unmarshal(*rawMsg, &resetPasswordResultPending)
+		err := jsonUnmarshal(*rawMsg, &resetPasswordResultPending)
 		return &resetPasswordResultPending, err
 
 	case ResetPasswordResultDeclinedType:
 		var resetPasswordResultDeclined ResetPasswordResultDeclined
-		err := json.Unmarshal(*rawMsg, &resetPasswordResultDeclined)
+		err := jsonUnmarshal(*rawMsg, &resetPasswordResultDeclined)
 		return &resetPasswordResultDeclined, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownResetPasswordResult{Unknown: unknown}, nil
 	}
 }
 
@@ -63,7 +108,6 @@ func (resetPasswordResultOk *ResetPasswordResultOk) MessageType() string {
 }
 
 // NewResetPasswordResultOk creates a new ResetPasswordResultOk
-//
 func NewResetPasswordResultOk() *ResetPasswordResultOk {
 	resetPasswordResultOkTemp := ResetPasswordResultOk{
 		tdCommon: tdCommon{Type: "resetPasswordResultOk"},
@@ -72,11 +116,107 @@ func NewResetPasswordResultOk() *ResetPasswordResultOk {
 	return &resetPasswordResultOkTemp
 }
 
+// MarshalJSON marshals to json
+func (resetPasswordResultOk *ResetPasswordResultOk) MarshalJSON() ([]byte, error) {
+	return resetPasswordResultOk.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (resetPasswordResultOk *ResetPasswordResultOk) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, resetPasswordResultOk)
+}
+
+func (resetPasswordResultOk *ResetPasswordResultOk) appendJSON(b []byte) ([]byte, error) {
+	if resetPasswordResultOk == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, resetPasswordResultOk.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, resetPasswordResultOk.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (resetPasswordResultOk *ResetPasswordResultOk) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			resetPasswordResultOk.tdCommon.Type, err = r.readString()
+		case "@extra":
+			resetPasswordResultOk.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (resetPasswordResultOk *ResetPasswordResultOk) Clone() *ResetPasswordResultOk {
+	if resetPasswordResultOk == nil {
+		return nil
+	}
+	clone := *resetPasswordResultOk
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (resetPasswordResultOk *ResetPasswordResultOk) Equal(other *ResetPasswordResultOk) bool {
+	if resetPasswordResultOk == nil || other == nil {
+		return resetPasswordResultOk == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (resetPasswordResultOk *ResetPasswordResultOk) Diff(other *ResetPasswordResultOk) []Change {
+	return resetPasswordResultOk.diff("", other, nil)
+}
+
+func (resetPasswordResultOk *ResetPasswordResultOk) diff(path string, other *ResetPasswordResultOk, changes []Change) []Change {
+	if resetPasswordResultOk == nil || other == nil {
+		if resetPasswordResultOk != other {
+			changes = append(changes, Change{Path: path, Old: resetPasswordResultOk, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (resetPasswordResultOk *ResetPasswordResultOk) String() string {
+	return objectString(resetPasswordResultOk)
+}
+
+// LogValue logs the object as its summary
+func (resetPasswordResultOk *ResetPasswordResultOk) LogValue() slog.Value {
+	return slog.StringValue(objectString(resetPasswordResultOk))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (resetPasswordResultOk *ResetPasswordResultOk) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, resetPasswordResultOk)
+}
+
+func (resetPasswordResultOk *ResetPasswordResultOk) printText(p *printer) {
+	if resetPasswordResultOk == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ResetPasswordResultOk")
+	p.endObject(state)
+}
+
 // GetResetPasswordResultEnum return the enum type of this object
 func (resetPasswordResultOk *ResetPasswordResultOk) GetResetPasswordResultEnum() ResetPasswordResultEnum {
 	return ResetPasswordResultOkType
 }
 
+// Accept calls the method of the visitor for ResetPasswordResultOk
+func (resetPasswordResultOk *ResetPasswordResultOk) Accept(visitor ResetPasswordResultVisitor) {
+	visitor.VisitResetPasswordResultOk(resetPasswordResultOk)
+}
+
 // ResetPasswordResultPending The password reset request is pending
 type ResetPasswordResultPending struct {
 	tdCommon
@@ -100,11 +240,118 @@ func NewResetPasswordResultPending(pendingResetDate int32) *ResetPasswordResultP
 	return &resetPasswordResultPendingTemp
 }
 
+// MarshalJSON marshals to json
+func (resetPasswordResultPending *ResetPasswordResultPending) MarshalJSON() ([]byte, error) {
+	return resetPasswordResultPending.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (resetPasswordResultPending *ResetPasswordResultPending) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, resetPasswordResultPending)
+}
+
+func (resetPasswordResultPending *ResetPasswordResultPending) appendJSON(b []byte) ([]byte, error) {
+	if resetPasswordResultPending == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, resetPasswordResultPending.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, resetPasswordResultPending.tdCommon.Extra)
+	b = append(b, `,"pending_reset_date":`...)
+	b = appendJSONInt(b, int64(resetPasswordResultPending.PendingResetDate))
+	return append(b, '}'), nil
+}
+
+func (resetPasswordResultPending *ResetPasswordResultPending) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			resetPasswordResultPending.tdCommon.Type, err = r.readString()
+		case "@extra":
+			resetPasswordResultPending.tdCommon.Extra, err = r.readString()
+		case "pending_reset_date":
+			resetPasswordResultPending.PendingResetDate, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (resetPasswordResultPending *ResetPasswordResultPending) Clone() *ResetPasswordResultPending {
+	if resetPasswordResultPending == nil {
+		return nil
+	}
+	clone := *resetPasswordResultPending
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (resetPasswordResultPending *ResetPasswordResultPending) Equal(other *ResetPasswordResultPending) bool {
+	if resetPasswordResultPending == nil || other == nil {
+		return resetPasswordResultPending == other
+	}
+	if resetPasswordResultPending.PendingResetDate != other.PendingResetDate {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (resetPasswordResultPending *ResetPasswordResultPending) Diff(other *ResetPasswordResultPending) []Change {
+	return resetPasswordResultPending.diff("", other, nil)
+}
+
+func (resetPasswordResultPending *ResetPasswordResultPending) diff(path string, other *ResetPasswordResultPending, changes []Change) []Change {
+	if resetPasswordResultPending == nil || other == nil {
+		if resetPasswordResultPending != other {
+			changes = append(changes, Change{Path: path, Old: resetPasswordResultPending, New: other})
+		}
+		return changes
+	}
+	if resetPasswordResultPending.PendingResetDate != other.PendingResetDate {
+		changes = append(changes, Change{Path: fieldPath(path, "pending_reset_date"), Old: resetPasswordResultPending.PendingResetDate, New: other.PendingResetDate})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (resetPasswordResultPending *ResetPasswordResultPending) String() string {
+	return objectString(resetPasswordResultPending)
+}
+
+// LogValue logs the object as its summary
+func (resetPasswordResultPending *ResetPasswordResultPending) LogValue() slog.Value {
+	return slog.StringValue(objectString(resetPasswordResultPending))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (resetPasswordResultPending *ResetPasswordResultPending) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, resetPasswordResultPending)
+}
+
+func (resetPasswordResultPending *ResetPasswordResultPending) printText(p *printer) {
+	if resetPasswordResultPending == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ResetPasswordResultPending")
+	p.int("pending_reset_date", int64(resetPasswordResultPending.PendingResetDate))
+	p.endObject(state)
+}
+
 // GetResetPasswordResultEnum return the enum type of this object
 func (resetPasswordResultPending *ResetPasswordResultPending) GetResetPasswordResultEnum() ResetPasswordResultEnum {
 	return ResetPasswordResultPendingType
 }
 
+// Accept calls the method of the visitor for ResetPasswordResultPending
+func (resetPasswordResultPending *ResetPasswordResultPending) Accept(visitor ResetPasswordResultVisitor) {
+	visitor.VisitResetPasswordResultPending(resetPasswordResultPending)
+}
+
 // ResetPasswordResultDeclined The password reset request was declined
 type ResetPasswordResultDeclined struct {
 	tdCommon
@@ -128,11 +375,228 @@ func NewResetPasswordResultDeclined(retryDate int32) *ResetPasswordResultDecline
 	return &resetPasswordResultDeclinedTemp
 }
 
+// MarshalJSON marshals to json
+func (resetPasswordResultDeclined *ResetPasswordResultDeclined) MarshalJSON() ([]byte, error) {
+	return resetPasswordResultDeclined.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (resetPasswordResultDeclined *ResetPasswordResultDeclined) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, resetPasswordResultDeclined)
+}
+
+func (resetPasswordResultDeclined *ResetPasswordResultDeclined) appendJSON(b []byte) ([]byte, error) {
+	if resetPasswordResultDeclined == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, resetPasswordResultDeclined.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, resetPasswordResultDeclined.tdCommon.Extra)
+	b = append(b, `,"retry_date":`...)
+	b = appendJSONInt(b, int64(resetPasswordResultDeclined.RetryDate))
+	return append(b, '}'), nil
+}
+
+func (resetPasswordResultDeclined *ResetPasswordResultDeclined) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			resetPasswordResultDeclined.tdCommon.Type, err = r.readString()
+		case "@extra":
+			resetPasswordResultDeclined.tdCommon.Extra, err = r.readString()
+		case "retry_date":
+			resetPasswordResultDeclined.RetryDate, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (resetPasswordResultDeclined *ResetPasswordResultDeclined) Clone() *ResetPasswordResultDeclined {
+	if resetPasswordResultDeclined == nil {
+		return nil
+	}
+	clone := *resetPasswordResultDeclined
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (resetPasswordResultDeclined *ResetPasswordResultDeclined) Equal(other *ResetPasswordResultDeclined) bool {
+	if resetPasswordResultDeclined == nil || other == nil {
+		return resetPasswordResultDeclined == other
+	}
+	if resetPasswordResultDeclined.RetryDate != other.RetryDate {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (resetPasswordResultDeclined *ResetPasswordResultDeclined) Diff(other *ResetPasswordResultDeclined) []Change {
+	return resetPasswordResultDeclined.diff("", other, nil)
+}
+
+func (resetPasswordResultDeclined *ResetPasswordResultDeclined) diff(path string, other *ResetPasswordResultDeclined, changes []Change) []Change {
+	if resetPasswordResultDeclined == nil || other == nil {
+		if resetPasswordResultDeclined != other {
+			changes = append(changes, Change{Path: path, Old: resetPasswordResultDeclined, New: other})
+		}
+		return changes
+	}
+	if resetPasswordResultDeclined.RetryDate != other.RetryDate {
+		changes = append(changes, Change{Path: fieldPath(path, "retry_date"), Old: resetPasswordResultDeclined.RetryDate, New: other.RetryDate})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (resetPasswordResultDeclined *ResetPasswordResultDeclined) String() string {
+	return objectString(resetPasswordResultDeclined)
+}
+
+// LogValue logs the object as its summary
+func (resetPasswordResultDeclined *ResetPasswordResultDeclined) LogValue() slog.Value {
+	return slog.StringValue(objectString(resetPasswordResultDeclined))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (resetPasswordResultDeclined *ResetPasswordResultDeclined) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, resetPasswordResultDeclined)
+}
+
+func (resetPasswordResultDeclined *ResetPasswordResultDeclined) printText(p *printer) {
+	if resetPasswordResultDeclined == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ResetPasswordResultDeclined")
+	p.int("retry_date", int64(resetPasswordResultDeclined.RetryDate))
+	p.endObject(state)
+}
+
 // GetResetPasswordResultEnum return the enum type of this object
 func (resetPasswordResultDeclined *ResetPasswordResultDeclined) GetResetPasswordResultEnum() ResetPasswordResultEnum {
 	return ResetPasswordResultDeclinedType
 }
 
+// Accept calls the method of the visitor for ResetPasswordResultDeclined
+func (resetPasswordResultDeclined *ResetPasswordResultDeclined) Accept(visitor ResetPasswordResultVisitor) {
+	visitor.VisitResetPasswordResultDeclined(resetPasswordResultDeclined)
+}
+
+// ResetPasswordResultVisitor visits every type of ResetPasswordResult, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to ResetPasswordResult, until they handle it too.
+type ResetPasswordResultVisitor interface {
+	VisitResetPasswordResultOk(resetPasswordResultOk *ResetPasswordResultOk)
+	VisitResetPasswordResultPending(resetPasswordResultPending *ResetPasswordResultPending)
+	VisitResetPasswordResultDeclined(resetPasswordResultDeclined *ResetPasswordResultDeclined)
+	VisitUnknownResetPasswordResult(unknownResetPasswordResult *UnknownResetPasswordResult)
+}
+
+// ResetPasswordResultCases holds the function MatchResetPasswordResult calls for each type of ResetPasswordResult;
+// types without a function are passed to the default case
+type ResetPasswordResultCases[R any] struct {
+	ResetPasswordResultOk       func(resetPasswordResultOk *ResetPasswordResultOk) R
+	ResetPasswordResultPending  func(resetPasswordResultPending *ResetPasswordResultPending) R
+	ResetPasswordResultDeclined func(resetPasswordResultDeclined *ResetPasswordResultDeclined) R
+}
+
+// MatchResetPasswordResult calls the case of the type of resetPasswordResult, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement ResetPasswordResultVisitor
+// to have the compiler check every type is handled.
+func MatchResetPasswordResult[R any](resetPasswordResult ResetPasswordResult, cases ResetPasswordResultCases[R], defaultCase func(resetPasswordResult ResetPasswordResult) R) R {
+	switch value := resetPasswordResult.(type) {
+	case *ResetPasswordResultOk:
+		if cases.ResetPasswordResultOk != nil {
+			return cases.ResetPasswordResultOk(value)
+		}
+	case *ResetPasswordResultPending:
+		if cases.ResetPasswordResultPending != nil {
+			return cases.ResetPasswordResultPending(value)
+		}
+	case *ResetPasswordResultDeclined:
+		if cases.ResetPasswordResultDeclined != nil {
+			return cases.ResetPasswordResultDeclined(value)
+		}
+	}
+	return defaultCase(resetPasswordResult)
+}
+
+// Clone returns a deep copy of the object
+func (unknownResetPasswordResult *UnknownResetPasswordResult) Clone() *UnknownResetPasswordResult {
+	if unknownResetPasswordResult == nil {
+		return nil
+	}
+	return &UnknownResetPasswordResult{Unknown: unknownResetPasswordResult.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownResetPasswordResult *UnknownResetPasswordResult) Equal(other *UnknownResetPasswordResult) bool {
+	if unknownResetPasswordResult == nil || other == nil {
+		return unknownResetPasswordResult == other
+	}
+	return unknownResetPasswordResult.Unknown.equal(other.Unknown)
+}
+
+func cloneResetPasswordResult(resetPasswordResult ResetPasswordResult) ResetPasswordResult {
+	switch value := resetPasswordResult.(type) {
+	case *ResetPasswordResultOk:
+		return value.Clone()
+	case *ResetPasswordResultPending:
+		return value.Clone()
+	case *ResetPasswordResultDeclined:
+		return value.Clone()
+	case *UnknownResetPasswordResult:
+		return value.Clone()
+	}
+	return resetPasswordResult
+}
+
+func equalResetPasswordResult(a ResetPasswordResult, b ResetPasswordResult) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *ResetPasswordResultOk:
+		b, ok := b.(*ResetPasswordResultOk)
+		return ok && a.Equal(b)
+	case *ResetPasswordResultPending:
+		b, ok := b.(*ResetPasswordResultPending)
+		return ok && a.Equal(b)
+	case *ResetPasswordResultDeclined:
+		b, ok := b.(*ResetPasswordResultDeclined)
+		return ok && a.Equal(b)
+	case *UnknownResetPasswordResult:
+		b, ok := b.(*UnknownResetPasswordResult)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffResetPasswordResult(path string, a ResetPasswordResult, b ResetPasswordResult, changes []Change) []Change {
+	switch a := a.(type) {
+	case *ResetPasswordResultOk:
+		if b, ok := b.(*ResetPasswordResultOk); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ResetPasswordResultPending:
+		if b, ok := b.(*ResetPasswordResultPending); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ResetPasswordResultDeclined:
+		if b, ok := b.(*ResetPasswordResultDeclined); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalResetPasswordResult(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
+
 // ResetPassword Removes 2-step verification password without previous password and access to recovery email address. The password can't be reset immediately and the request needs to be repeated after the specified time
 func (client *Client) ResetPassword() (ResetPasswordResult, error) {
 	result, err := client.SendAndCatch(UpdateData{
@@ -143,25 +607,26 @@ func (client *Client) ResetPassword() (ResetPasswordResult, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
-	switch ResetPasswordResultEnum(result.Data["@type"].(string)) {
+	resultType, _ := result.Data["@type"].(string)
+	switch ResetPasswordResultEnum(resultType) {
 
 	case ResetPasswordResultOkType:
 		var resetPasswordResult ResetPasswordResultOk
-		err = json.Unmarshal(result.Raw, &resetPasswordResult)
+		err = jsonUnmarshal(result.Raw, &resetPasswordResult)
 		return &resetPasswordResult, err
 
 	case ResetPasswordResultPendingType:
 		var resetPasswordResult ResetPasswordResultPending
-		err = json.Unmarshal(result.Raw, &resetPasswordResult)
+		err = jsonUnmarshal(result.Raw, &resetPasswordResult)
 		return &resetPasswordResult, err
 
 	case ResetPasswordResultDeclinedType:
 		var resetPasswordResult ResetPasswordResultDeclined
-		err = json.Unmarshal(result.Raw, &resetPasswordResult)
+		err = jsonUnmarshal(result.Raw, &resetPasswordResult)
 		return &resetPasswordResult, err
 
 	default:
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // RichText Describes a text object inside an instant-view web page
 type RichText interface {
 	GetRichTextEnum() RichTextEnum
+	Accept(visitor RichTextVisitor)
 }
 
 // RichTextEnum Alias for abstract RichText 'Sub-Classes', used as constant-enum here
@@ -34,108 +36,182 @@ const (
 	RichTextsType             RichTextEnum = "richTexts"
 )
 
+// UnknownRichText is a RichText of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownRichText struct {
+	Unknown
+}
+
+// GetRichTextEnum return the enum type of this object
+func (unknownRichText *UnknownRichText) GetRichTextEnum() RichTextEnum {
+	return RichTextEnum(unknownRichText.Type)
+}
+
+// Accept calls the method of the visitor for UnknownRichText
+func (unknownRichText *UnknownRichText) Accept(visitor RichTextVisitor) {
+	visitor.VisitUnknownRichText(unknownRichText)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownRichText *UnknownRichText) String() string {
+	return objectString(unknownRichText)
+}
+
+// LogValue logs the object as its summary
+func (unknownRichText *UnknownRichText) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownRichText))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownRichText *UnknownRichText) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownRichText)
+}
+
+func (unknownRichText *UnknownRichText) printText(p *printer) {
+	if unknownRichText == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownRichText")
+	p.string("@type", unknownRichText.Type)
+	p.endObject(state)
+}
+
 func unmarshalRichText(rawMsg *json.RawMessage) (RichText, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch RichTextEnum(objMap["@type"].(string)) {
+	switch RichTextEnum(typeName) {
 	case RichTextPlainType:
 		var richTextPlain RichTextPlain
-		err := json.Unmarshal(*rawMsg, &richTextPlain)
+		err := jsonUnmarshal(*rawMsg, &richTextPlain)
 		return &richTextPlain, err
 
 	case RichTextBoldType:
 		var richTextBold RichTextBold
-		err := json.Unmarshal(*rawMsg, &richTextBold)
+		err := jsonUnmarshal(*rawMsg, &richTextBold)
 		return &richTextBold, err
 
 	case RichTextItalicType:
 		var richTextItalic RichTextItalic
-		err := json.Unmarshal(*rawMsg, &richTextItalic)
+		err := jsonUnmarshal(*rawMsg, &richTextItalic)
 		return &richTextItalic, err
 
 	case RichTextUnderlineType:
 		var richTextUnderline RichTextUnderline
-		err := json.Unmarshal(*rawMsg, &richTextUnderline)
+		err := jsonUnmarshal(*rawMsg, &richTextUnderline)
 		return &richTextUnderline, err
 
 	case RichTextStrikethroughType:
 		var richTextStrikethrough RichTextStrikethrough
-		err := json.Unmarshal(*rawMsg, &richTextStrikethrough)
+		err := jsonUnmarshal(*rawMsg, &richTextStrikethrough)
 		return &richTextStrikethrough, err
 
 	case RichTextFixedType:
 		var richTextFixed RichTextFixed
-		err := json.Unmarshal(*rawMsg, &richTextFixed)
+		err := jsonUnmarshal(*rawMsg, &richTextFixed)
 		return &richTextFixed, err
 
 	case RichTextUrlType:
 		var richTextUrl RichTextUrl
-		err := json.Unmarshal(*rawMsg, &richTextUrl)
+		err := jsonUnmarshal(*rawMsg, &richTextUrl)
 		return &richTextUrl, err
 
 	case RichTextEmailAddressType:
 		var richTextEmailAddress RichTextEmailAddress
-		err := json.Unmarshal(*rawMsg, &richTextEmailAddress)
+		err := jsonUnmarshal(*rawMsg, &richTextEmailAddress)
 		return &richTextEmailAddress, err
 
 	case RichTextSubscriptType:
 		var richTextSubscript RichTextSubscript
-		err := json.Unmarshal(*rawMsg, &richTextSubscript)
+		err := jsonUnmarshal(*rawMsg, &richTextSubscript)
 		return &richTextSubscript, err
 
 	case RichTextSuperscriptType:
 		var richTextSuperscript RichTextSuperscript
-		err := json.Unmarshal(*rawMsg, &richTextSuperscript)
+		err := jsonUnmarshal(*rawMsg, &richTextSuperscript)
 		return &richTextSuperscript, err
 
 	case RichTextMarkedType:
 		var richTextMarked RichTextMarked
-		err := json.Unmarshal(*rawMsg, &richTextMarked)
+		err := jsonUnmarshal(*rawMsg, &richTextMarked)
 		return &richTextMarked, err
 
 	case RichTextPhoneNumberType:
 		var richTextPhoneNumber RichTextPhoneNumber
-		err := json.Unmarshal(*rawMsg, &richTextPhoneNumber)
+		err := jsonUnmarshal(*rawMsg, &richTextPhoneNumber)
 		return &richTextPhoneNumber, err
 
 	case RichTextIconType:
 		var richTextIcon RichTextIcon
-		err := json.Unmarshal(*rawMsg, &richTextIcon)
+		err := jsonUnmarshal(*rawMsg, &richTextIcon)
 		return &richTextIcon, err
 
 	case RichTextReferenceType:
 		var richTextReference RichTextReference
-		err := json.Unmarshal(*rawMsg, &richTextReference)
+		err := jsonUnmarshal(*rawMsg, &richTextReference)
 		return &richTextReference, err
 
 	case RichTextAnchorType:
 		var richTextAnchor RichTextAnchor
-		err := json.Unmarshal(*rawMsg, &richTextAnchor)
+		err := jsonUnmarshal(*rawMsg, &richTextAnchor)
 		return &richTextAnchor, err
 
 	case RichTextAnchorLinkType:
 		var richTextAnchorLink RichTextAnchorLink
-		err := json.Unmarshal(*rawMsg, &richTextAnchorLink)
+		err := jsonUnmarshal(*rawMsg, &richTextAnchorLink)
 		return &richTextAnchorLink, err
 
 	case RichTextsType:
 		var richTexts RichTexts
-		err := json.Unmarshal(*rawMsg, &richTexts)
+		err := jsonUnmarshal(*rawMsg, &richTexts)
 		return &richTexts, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownRichText{Unknown: unknown}, nil
 	}
 }
 
+func unmarshalRichTextSlice(rawMsg *json.RawMessage) ([]RichText, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var rawItems []*json.RawMessage
+	err := jsonUnmarshal(*rawMsg, &rawItems)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]RichText, 0, len(rawItems))
+	for _, rawItem := range rawItems {
+		item, err := unmarshalRichText(rawItem)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func decodeRichText(r *jsonReader) (RichText, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalRichText(rawMsg)
+}
+
 // RichTextPlain A plain text
 type RichTextPlain struct {
 	tdCommon
@@ -159,11 +235,118 @@ func NewRichTextPlain(text string) *RichTextPlain {
 	return &richTextPlainTemp
 }
 
+// MarshalJSON marshals to json
+func (richTextPlain *RichTextPlain) MarshalJSON() ([]byte, error) {
+	return richTextPlain.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (richTextPlain *RichTextPlain) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, richTextPlain)
+}
+
+func (richTextPlain *RichTextPlain) appendJSON(b []byte) ([]byte, error) {
+	if richTextPlain == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, richTextPlain.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, richTextPlain.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b = appendJSONString(b, richTextPlain.Text)
+	return append(b, '}'), nil
+}
+
+func (richTextPlain *RichTextPlain) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			richTextPlain.tdCommon.Type, err = r.readString()
+		case "@extra":
+			richTextPlain.tdCommon.Extra, err = r.readString()
+		case "text":
+			richTextPlain.Text, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (richTextPlain *RichTextPlain) Clone() *RichTextPlain {
+	if richTextPlain == nil {
+		return nil
+	}
+	clone := *richTextPlain
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (richTextPlain *RichTextPlain) Equal(other *RichTextPlain) bool {
+	if richTextPlain == nil || other == nil {
+		return richTextPlain == other
+	}
+	if richTextPlain.Text != other.Text {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (richTextPlain *RichTextPlain) Diff(other *RichTextPlain) []Change {
+	return richTextPlain.diff("", other, nil)
+}
+
+func (richTextPlain *RichTextPlain) diff(path string, other *RichTextPlain, changes []Change) []Change {
+	if richTextPlain == nil || other == nil {
+		if richTextPlain != other {
+			changes = append(changes, Change{Path: path, Old: richTextPlain, New: other})
+		}
+		return changes
+	}
+	if richTextPlain.Text != other.Text {
+		changes = append(changes, Change{Path: fieldPath(path, "text"), Old: richTextPlain.Text, New: other.Text})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (richTextPlain *RichTextPlain) String() string {
+	return objectString(richTextPlain)
+}
+
+// LogValue logs the object as its summary
+func (richTextPlain *RichTextPlain) LogValue() slog.Value {
+	return slog.StringValue(objectString(richTextPlain))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (richTextPlain *RichTextPlain) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, richTextPlain)
+}
+
+func (richTextPlain *RichTextPlain) printText(p *printer) {
+	if richTextPlain == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("RichTextPlain")
+	p.string("text", richTextPlain.Text)
+	p.endObject(state)
+}
+
 // GetRichTextEnum return the enum type of this object
 func (richTextPlain *RichTextPlain) GetRichTextEnum() RichTextEnum {
 	return RichTextPlainType
 }
 
+// Accept calls the method of the visitor for RichTextPlain
+func (richTextPlain *RichTextPlain) Accept(visitor RichTextVisitor) {
+	visitor.VisitRichTextPlain(richTextPlain)
+}
+
 // RichTextBold A bold rich text
 type RichTextBold struct {
 	tdCommon
@@ -187,27 +370,109 @@ func NewRichTextBold(text RichText) *RichTextBold {
 	return &richTextBoldTemp
 }
 
+// MarshalJSON marshals to json
+func (richTextBold *RichTextBold) MarshalJSON() ([]byte, error) {
+	return richTextBold.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (richTextBold *RichTextBold) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, richTextBold)
+}
+
+func (richTextBold *RichTextBold) appendJSON(b []byte) ([]byte, error) {
+	if richTextBold == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, richTextBold.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, richTextBold.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b, err = appendJSONValue(b, richTextBold.Text)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (richTextBold *RichTextBold) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			richTextBold.tdCommon.Type, err = r.readString()
+		case "@extra":
+			richTextBold.tdCommon.Extra, err = r.readString()
+		case "text":
+			richTextBold.Text, err = decodeRichText(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (richTextBold *RichTextBold) Clone() *RichTextBold {
+	if richTextBold == nil {
+		return nil
+	}
+	clone := *richTextBold
+	clone.Text = cloneRichText(richTextBold.Text)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (richTextBold *RichTextBold) Equal(other *RichTextBold) bool {
+	if richTextBold == nil || other == nil {
+		return richTextBold == other
+	}
+	if !equalRichText(richTextBold.Text, other.Text) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (richTextBold *RichTextBold) Diff(other *RichTextBold) []Change {
+	return richTextBold.diff("", other, nil)
+}
+
+func (richTextBold *RichTextBold) diff(path string, other *RichTextBold, changes []Change) []Change {
+	if richTextBold == nil || other == nil {
+		if richTextBold != other {
+			changes = append(changes, Change{Path: path, Old: richTextBold, New: other})
+		}
+		return changes
 	}
+	changes = diffRichText(fieldPath(path, "text"), richTextBold.Text, other.Text, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (richTextBold *RichTextBold) String() string {
+	return objectString(richTextBold)
+}
 
-	richTextBold.tdCommon = tempObj.tdCommon
+// LogValue logs the object as its summary
+func (richTextBold *RichTextBold) LogValue() slog.Value {
+	return slog.StringValue(objectString(richTextBold))
+}
 
-	fieldText, _ := unmarshalRichText(objMap["text"])
-	richTextBold.Text = fieldText
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (richTextBold *RichTextBold) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, richTextBold)
+}
 
-	return nil
+func (richTextBold *RichTextBold) printText(p *printer) {
+	if richTextBold == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("RichTextBold")
+	p.object("text", richTextBold.Text)
+	p.endObject(state)
 }
 
 // GetRichTextEnum return the enum type of this object
@@ -215,6 +480,11 @@ func (richTextBold *RichTextBold) GetRichTextEnum() RichTextEnum {
 	return RichTextBoldType
 }
 
+// Accept calls the method of the visitor for RichTextBold
+func (richTextBold *RichTextBold) Accept(visitor RichTextVisitor) {
+	visitor.VisitRichTextBold(richTextBold)
+}
+
 // RichTextItalic An italicized rich text
 type RichTextItalic struct {
 	tdCommon
@@ -238,27 +508,109 @@ func NewRichTextItalic(text RichText) *RichTextItalic {
 	return &richTextItalicTemp
 }
 
+// MarshalJSON marshals to json
+func (richTextItalic *RichTextItalic) MarshalJSON() ([]byte, error) {
+	return richTextItalic.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (richTextItalic *RichTextItalic) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, richTextItalic)
+}
+
+func (richTextItalic *RichTextItalic) appendJSON(b []byte) ([]byte, error) {
+	if richTextItalic == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, richTextItalic.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, richTextItalic.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b, err = appendJSONValue(b, richTextItalic.Text)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return append(b, '}'), nil
+}
+
+func (richTextItalic *RichTextItalic) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			richTextItalic.tdCommon.Type, err = r.readString()
+		case "@extra":
+			richTextItalic.tdCommon.Extra, err = r.readString()
+		case "text":
+			richTextItalic.Text, err = decodeRichText(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (richTextItalic *RichTextItalic) Clone() *RichTextItalic {
+	if richTextItalic == nil {
+		return nil
+	}
+	clone := *richTextItalic
+	clone.Text = cloneRichText(richTextItalic.Text)
+	return &clone
+}
 
-	richTextItalic.tdCommon = tempObj.tdCommon
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (richTextItalic *RichTextItalic) Equal(other *RichTextItalic) bool {
+	if richTextItalic == nil || other == nil {
+		return richTextItalic == other
+	}
+	if !equalRichText(richTextItalic.Text, other.Text) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (richTextItalic *RichTextItalic) Diff(other *RichTextItalic) []Change {
+	return richTextItalic.diff("", other, nil)
+}
+
+func (richTextItalic *RichTextItalic) diff(path string, other *RichTextItalic, changes []Change) []Change {
+	if richTextItalic == nil || other == nil {
+		if richTextItalic != other {
+			changes = append(changes, Change{Path: path, Old: richTextItalic, New: other})
+		}
+		return changes
+	}
+	changes = diffRichText(fieldPath(path, "text"), richTextItalic.Text, other.Text, changes)
+	return changes
+}
 
-	fieldText, _ := unmarshalRichText(objMap["text"])
-	richTextItalic.Text = fieldText
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (richTextItalic *RichTextItalic) String() string {
+	return objectString(richTextItalic)
+}
+
+// LogValue logs the object as its summary
+func (richTextItalic *RichTextItalic) LogValue() slog.Value {
+	return slog.StringValue(objectString(richTextItalic))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (richTextItalic *RichTextItalic) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, richTextItalic)
+}
 
-	return nil
+func (richTextItalic *RichTextItalic) printText(p *printer) {
+	if richTextItalic == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("RichTextItalic")
+	p.object("text", richTextItalic.Text)
+	p.endObject(state)
 }
 
 // GetRichTextEnum return the enum type of this object
@@ -266,6 +618,11 @@ func (richTextItalic *RichTextItalic) GetRichTextEnum() RichTextEnum {
 	return RichTextItalicType
 }
 
+// Accept calls the method of the visitor for RichTextItalic
+func (richTextItalic *RichTextItalic) Accept(visitor RichTextVisitor) {
+	visitor.VisitRichTextItalic(richTextItalic)
+}
+
 // RichTextUnderline An underlined rich text
 type RichTextUnderline struct {
 	tdCommon
@@ -289,27 +646,109 @@ func NewRichTextUnderline(text RichText) *RichTextUnderline {
 	return &richTextUnderlineTemp
 }
 
+// MarshalJSON marshals to json
+func (richTextUnderline *RichTextUnderline) MarshalJSON() ([]byte, error) {
+	return richTextUnderline.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (richTextUnderline *RichTextUnderline) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, richTextUnderline)
+}
+
+func (richTextUnderline *RichTextUnderline) appendJSON(b []byte) ([]byte, error) {
+	if richTextUnderline == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, richTextUnderline.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, richTextUnderline.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b, err = appendJSONValue(b, richTextUnderline.Text)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (richTextUnderline *RichTextUnderline) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			richTextUnderline.tdCommon.Type, err = r.readString()
+		case "@extra":
+			richTextUnderline.tdCommon.Extra, err = r.readString()
+		case "text":
+			richTextUnderline.Text, err = decodeRichText(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (richTextUnderline *RichTextUnderline) Clone() *RichTextUnderline {
+	if richTextUnderline == nil {
+		return nil
+	}
+	clone := *richTextUnderline
+	clone.Text = cloneRichText(richTextUnderline.Text)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (richTextUnderline *RichTextUnderline) Equal(other *RichTextUnderline) bool {
+	if richTextUnderline == nil || other == nil {
+		return richTextUnderline == other
+	}
+	if !equalRichText(richTextUnderline.Text, other.Text) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (richTextUnderline *RichTextUnderline) Diff(other *RichTextUnderline) []Change {
+	return richTextUnderline.diff("", other, nil)
+}
+
+func (richTextUnderline *RichTextUnderline) diff(path string, other *RichTextUnderline, changes []Change) []Change {
+	if richTextUnderline == nil || other == nil {
+		if richTextUnderline != other {
+			changes = append(changes, Change{Path: path, Old: richTextUnderline, New: other})
+		}
+		return changes
 	}
+	changes = diffRichText(fieldPath(path, "text"), richTextUnderline.Text, other.Text, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (richTextUnderline *RichTextUnderline) String() string {
+	return objectString(richTextUnderline)
+}
 
-	richTextUnderline.tdCommon = tempObj.tdCommon
+// LogValue logs the object as its summary
+func (richTextUnderline *RichTextUnderline) LogValue() slog.Value {
+	return slog.StringValue(objectString(richTextUnderline))
+}
 
-	fieldText, _ := unmarshalRichText(objMap["text"])
-	richTextUnderline.Text = fieldText
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (richTextUnderline *RichTextUnderline) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, richTextUnderline)
+}
 
-	return nil
+func (richTextUnderline *RichTextUnderline) printText(p *printer) {
+	if richTextUnderline == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("RichTextUnderline")
+	p.object("text", richTextUnderline.Text)
+	p.endObject(state)
 }
 
 // GetRichTextEnum return the enum type of this object
@@ -317,6 +756,11 @@ func (richTextUnderline *RichTextUnderline) GetRichTextEnum() RichTextEnum {
 	return RichTextUnderlineType
 }
 
+// Accept calls the method of the visitor for RichTextUnderline
+func (richTextUnderline *RichTextUnderline) Accept(visitor RichTextVisitor) {
+	visitor.VisitRichTextUnderline(richTextUnderline)
+}
+
 // RichTextStrikethrough A strikethrough rich text
 type RichTextStrikethrough struct {
 	tdCommon
@@ -340,27 +784,109 @@ func NewRichTextStrikethrough(text RichText) *RichTextStrikethrough {
 	return &richTextStrikethroughTemp
 }
 
+// MarshalJSON marshals to json
+func (richTextStrikethrough *RichTextStrikethrough) MarshalJSON() ([]byte, error) {
+	return richTextStrikethrough.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (richTextStrikethrough *RichTextStrikethrough) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, richTextStrikethrough)
+}
+
+func (richTextStrikethrough *RichTextStrikethrough) appendJSON(b []byte) ([]byte, error) {
+	if richTextStrikethrough == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, richTextStrikethrough.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, richTextStrikethrough.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b, err = appendJSONValue(b, richTextStrikethrough.Text)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (richTextStrikethrough *RichTextStrikethrough) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			richTextStrikethrough.tdCommon.Type, err = r.readString()
+		case "@extra":
+			richTextStrikethrough.tdCommon.Extra, err = r.readString()
+		case "text":
+			richTextStrikethrough.Text, err = decodeRichText(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (richTextStrikethrough *RichTextStrikethrough) Clone() *RichTextStrikethrough {
+	if richTextStrikethrough == nil {
+		return nil
 	}
+	clone := *richTextStrikethrough
+	clone.Text = cloneRichText(richTextStrikethrough.Text)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (richTextStrikethrough *RichTextStrikethrough) Equal(other *RichTextStrikethrough) bool {
+	if richTextStrikethrough == nil || other == nil {
+		return richTextStrikethrough == other
+	}
+	if !equalRichText(richTextStrikethrough.Text, other.Text) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (richTextStrikethrough *RichTextStrikethrough) Diff(other *RichTextStrikethrough) []Change {
+	return richTextStrikethrough.diff("", other, nil)
+}
+
+func (richTextStrikethrough *RichTextStrikethrough) diff(path string, other *RichTextStrikethrough, changes []Change) []Change {
+	if richTextStrikethrough == nil || other == nil {
+		if richTextStrikethrough != other {
+			changes = append(changes, Change{Path: path, Old: richTextStrikethrough, New: other})
+		}
+		return changes
+	}
+	changes = diffRichText(fieldPath(path, "text"), richTextStrikethrough.Text, other.Text, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (richTextStrikethrough *RichTextStrikethrough) String() string {
+	return objectString(richTextStrikethrough)
+}
 
-	richTextStrikethrough.tdCommon = tempObj.tdCommon
+// LogValue logs the object as its summary
+func (richTextStrikethrough *RichTextStrikethrough) LogValue() slog.Value {
+	return slog.StringValue(objectString(richTextStrikethrough))
+}
 
-	fieldText, _ := unmarshalRichText(objMap["text"])
-	richTextStrikethrough.Text = fieldText
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (richTextStrikethrough *RichTextStrikethrough) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, richTextStrikethrough)
+}
 
-	return nil
+func (richTextStrikethrough *RichTextStrikethrough) printText(p *printer) {
+	if richTextStrikethrough == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("RichTextStrikethrough")
+	p.object("text", richTextStrikethrough.Text)
+	p.endObject(state)
 }
 
 // GetRichTextEnum return the enum type of this object
@@ -368,6 +894,11 @@ func (richTextStrikethrough *RichTextStrikethrough) GetRichTextEnum() RichTextEn
 	return RichTextStrikethroughType
 }
 
+// Accept calls the method of the visitor for RichTextStrikethrough
+func (richTextStrikethrough *RichTextStrikethrough) Accept(visitor RichTextVisitor) {
+	visitor.VisitRichTextStrikethrough(richTextStrikethrough)
+}
+
 // RichTextFixed A fixed-width rich text
 type RichTextFixed struct {
 	tdCommon
@@ -391,27 +922,109 @@ func NewRichTextFixed(text RichText) *RichTextFixed {
 	return &richTextFixedTemp
 }
 
+// MarshalJSON marshals to json
+func (richTextFixed *RichTextFixed) MarshalJSON() ([]byte, error) {
+	return richTextFixed.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (richTextFixed *RichTextFixed) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, richTextFixed)
+}
+
+func (richTextFixed *RichTextFixed) appendJSON(b []byte) ([]byte, error) {
+	if richTextFixed == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, richTextFixed.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, richTextFixed.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b, err = appendJSONValue(b, richTextFixed.Text)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (richTextFixed *RichTextFixed) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			richTextFixed.tdCommon.Type, err = r.readString()
+		case "@extra":
+			richTextFixed.tdCommon.Extra, err = r.readString()
+		case "text":
+			richTextFixed.Text, err = decodeRichText(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (richTextFixed *RichTextFixed) Clone() *RichTextFixed {
+	if richTextFixed == nil {
+		return nil
 	}
+	clone := *richTextFixed
+	clone.Text = cloneRichText(richTextFixed.Text)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (richTextFixed *RichTextFixed) Equal(other *RichTextFixed) bool {
+	if richTextFixed == nil || other == nil {
+		return richTextFixed == other
+	}
+	if !equalRichText(richTextFixed.Text, other.Text) {
+		return false
+	}
+	return true
+}
 
-	richTextFixed.tdCommon = tempObj.tdCommon
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (richTextFixed *RichTextFixed) Diff(other *RichTextFixed) []Change {
+	return richTextFixed.diff("", other, nil)
+}
 
-	fieldText, _ := unmarshalRichText(objMap["text"])
-	richTextFixed.Text = fieldText
+func (richTextFixed *RichTextFixed) diff(path string, other *RichTextFixed, changes []Change) []Change {
+	if richTextFixed == nil || other == nil {
+		if richTextFixed != other {
+			changes = append(changes, Change{Path: path, Old: richTextFixed, New: other})
+		}
+		return changes
+	}
+	changes = diffRichText(fieldPath(path, "text"), richTextFixed.Text, other.Text, changes)
+	return changes
+}
 
-	return nil
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (richTextFixed *RichTextFixed) String() string {
+	return objectString(richTextFixed)
+}
+
+// LogValue logs the object as its summary
+func (richTextFixed *RichTextFixed) LogValue() slog.Value {
+	return slog.StringValue(objectString(richTextFixed))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (richTextFixed *RichTextFixed) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, richTextFixed)
+}
+
+func (richTextFixed *RichTextFixed) printText(p *printer) {
+	if richTextFixed == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("RichTextFixed")
+	p.object("text", richTextFixed.Text)
+	p.endObject(state)
 }
 
 // GetRichTextEnum return the enum type of this object
@@ -419,6 +1032,11 @@ func (richTextFixed *RichTextFixed) GetRichTextEnum() RichTextEnum {
 	return RichTextFixedType
 }
 
+// Accept calls the method of the visitor for RichTextFixed
+func (richTextFixed *RichTextFixed) Accept(visitor RichTextVisitor) {
+	visitor.VisitRichTextFixed(richTextFixed)
+}
+
 // RichTextUrl A rich text URL link
 type RichTextUrl struct {
 	tdCommon
@@ -448,31 +1066,131 @@ func NewRichTextUrl(text RichText, url string, isCached bool) *RichTextUrl {
 	return &richTextUrlTemp
 }
 
+// MarshalJSON marshals to json
+func (richTextUrl *RichTextUrl) MarshalJSON() ([]byte, error) {
+	return richTextUrl.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (richTextUrl *RichTextUrl) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Url      string `json:"url"`       // URL
-		IsCached bool   `json:"is_cached"` // True, if the URL has cached instant view server-side
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	return unmarshalFast(b, richTextUrl)
+}
+
+func (richTextUrl *RichTextUrl) appendJSON(b []byte) ([]byte, error) {
+	if richTextUrl == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, richTextUrl.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, richTextUrl.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b, err = appendJSONValue(b, richTextUrl.Text)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"url":`...)
+	b = appendJSONString(b, richTextUrl.Url)
+	b = append(b, `,"is_cached":`...)
+	b = appendJSONBool(b, richTextUrl.IsCached)
+	return append(b, '}'), nil
+}
+
+func (richTextUrl *RichTextUrl) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			richTextUrl.tdCommon.Type, err = r.readString()
+		case "@extra":
+			richTextUrl.tdCommon.Extra, err = r.readString()
+		case "text":
+			richTextUrl.Text, err = decodeRichText(r)
+		case "url":
+			richTextUrl.Url, err = r.readString()
+		case "is_cached":
+			richTextUrl.IsCached, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (richTextUrl *RichTextUrl) Clone() *RichTextUrl {
+	if richTextUrl == nil {
+		return nil
+	}
+	clone := *richTextUrl
+	clone.Text = cloneRichText(richTextUrl.Text)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (richTextUrl *RichTextUrl) Equal(other *RichTextUrl) bool {
+	if richTextUrl == nil || other == nil {
+		return richTextUrl == other
+	}
+	if !equalRichText(richTextUrl.Text, other.Text) {
+		return false
+	}
+	if richTextUrl.Url != other.Url {
+		return false
+	}
+	if richTextUrl.IsCached != other.IsCached {
+		return false
 	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (richTextUrl *RichTextUrl) Diff(other *RichTextUrl) []Change {
+	return richTextUrl.diff("", other, nil)
+}
+
+func (richTextUrl *RichTextUrl) diff(path string, other *RichTextUrl, changes []Change) []Change {
+	if richTextUrl == nil || other == nil {
+		if richTextUrl != other {
+			changes = append(changes, Change{Path: path, Old: richTextUrl, New: other})
+		}
+		return changes
+	}
+	changes = diffRichText(fieldPath(path, "text"), richTextUrl.Text, other.Text, changes)
+	if richTextUrl.Url != other.Url {
+		changes = append(changes, Change{Path: fieldPath(path, "url"), Old: richTextUrl.Url, New: other.Url})
+	}
+	if richTextUrl.IsCached != other.IsCached {
+		changes = append(changes, Change{Path: fieldPath(path, "is_cached"), Old: richTextUrl.IsCached, New: other.IsCached})
+	}
+	return changes
+}
 
-	richTextUrl.tdCommon = tempObj.tdCommon
-	richTextUrl.Url = tempObj.Url
-	richTextUrl.IsCached = tempObj.IsCached
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (richTextUrl *RichTextUrl) String() string {
+	return objectString(richTextUrl)
+}
+
+// LogValue logs the object as its summary
+func (richTextUrl *RichTextUrl) LogValue() slog.Value {
+	return slog.StringValue(objectString(richTextUrl))
+}
 
-	fieldText, _ := unmarshalRichText(objMap["text"])
-	richTextUrl.Text = fieldText
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (richTextUrl *RichTextUrl) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, richTextUrl)
+}
 
-	return nil
+func (richTextUrl *RichTextUrl) printText(p *printer) {
+	if richTextUrl == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("RichTextUrl")
+	p.object("text", richTextUrl.Text)
+	p.string("url", richTextUrl.Url)
+	p.bool("is_cached", richTextUrl.IsCached)
+	p.endObject(state)
 }
 
 // GetRichTextEnum return the enum type of this object
@@ -480,6 +1198,11 @@ func (richTextUrl *RichTextUrl) GetRichTextEnum() RichTextEnum {
 	return RichTextUrlType
 }
 
+// Accept calls the method of the visitor for RichTextUrl
+func (richTextUrl *RichTextUrl) Accept(visitor RichTextVisitor) {
+	visitor.VisitRichTextUrl(richTextUrl)
+}
+
 // RichTextEmailAddress A rich text email link
 type RichTextEmailAddress struct {
 	tdCommon
@@ -506,29 +1229,120 @@ func NewRichTextEmailAddress(text RichText, emailAddress string) *RichTextEmailA
 	return &richTextEmailAddressTemp
 }
 
+// MarshalJSON marshals to json
+func (richTextEmailAddress *RichTextEmailAddress) MarshalJSON() ([]byte, error) {
+	return richTextEmailAddress.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (richTextEmailAddress *RichTextEmailAddress) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, richTextEmailAddress)
+}
+
+func (richTextEmailAddress *RichTextEmailAddress) appendJSON(b []byte) ([]byte, error) {
+	if richTextEmailAddress == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-		EmailAddress string `json:"email_address"` // Email address
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, richTextEmailAddress.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, richTextEmailAddress.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b, err = appendJSONValue(b, richTextEmailAddress.Text)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"email_address":`...)
+	b = appendJSONString(b, richTextEmailAddress.EmailAddress)
+	return append(b, '}'), nil
+}
+
+func (richTextEmailAddress *RichTextEmailAddress) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			richTextEmailAddress.tdCommon.Type, err = r.readString()
+		case "@extra":
+			richTextEmailAddress.tdCommon.Extra, err = r.readString()
+		case "text":
+			richTextEmailAddress.Text, err = decodeRichText(r)
+		case "email_address":
+			richTextEmailAddress.EmailAddress, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (richTextEmailAddress *RichTextEmailAddress) Clone() *RichTextEmailAddress {
+	if richTextEmailAddress == nil {
+		return nil
 	}
+	clone := *richTextEmailAddress
+	clone.Text = cloneRichText(richTextEmailAddress.Text)
+	return &clone
+}
 
-	richTextEmailAddress.tdCommon = tempObj.tdCommon
-	richTextEmailAddress.EmailAddress = tempObj.EmailAddress
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (richTextEmailAddress *RichTextEmailAddress) Equal(other *RichTextEmailAddress) bool {
+	if richTextEmailAddress == nil || other == nil {
+		return richTextEmailAddress == other
+	}
+	if !equalRichText(richTextEmailAddress.Text, other.Text) {
+		return false
+	}
+	if richTextEmailAddress.EmailAddress != other.EmailAddress {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (richTextEmailAddress *RichTextEmailAddress) Diff(other *RichTextEmailAddress) []Change {
+	return richTextEmailAddress.diff("", other, nil)
+}
+
+func (richTextEmailAddress *RichTextEmailAddress) diff(path string, other *RichTextEmailAddress, changes []Change) []Change {
+	if richTextEmailAddress == nil || other == nil {
+		if richTextEmailAddress != other {
+			changes = append(changes, Change{Path: path, Old: richTextEmailAddress, New: other})
+		}
+		return changes
+	}
+	changes = diffRichText(fieldPath(path, "text"), richTextEmailAddress.Text, other.Text, changes)
+	if richTextEmailAddress.EmailAddress != other.EmailAddress {
+		changes = append(changes, Change{Path: fieldPath(path, "email_address"), Old: richTextEmailAddress.EmailAddress, New: other.EmailAddress})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (richTextEmailAddress *RichTextEmailAddress) String() string {
+	return objectString(richTextEmailAddress)
+}
+
+// LogValue logs the object as its summary
+func (richTextEmailAddress *RichTextEmailAddress) LogValue() slog.Value {
+	return slog.StringValue(objectString(richTextEmailAddress))
+}
 
-	fieldText, _ := unmarshalRichText(objMap["text"])
-	richTextEmailAddress.Text = fieldText
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (richTextEmailAddress *RichTextEmailAddress) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, richTextEmailAddress)
+}
 
-	return nil
+func (richTextEmailAddress *RichTextEmailAddress) printText(p *printer) {
+	if richTextEmailAddress == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("RichTextEmailAddress")
+	p.object("text", richTextEmailAddress.Text)
+	p.string("email_address", richTextEmailAddress.EmailAddress)
+	p.endObject(state)
 }
 
 // GetRichTextEnum return the enum type of this object
@@ -536,6 +1350,11 @@ func (richTextEmailAddress *RichTextEmailAddress) GetRichTextEnum() RichTextEnum
 	return RichTextEmailAddressType
 }
 
+// Accept calls the method of the visitor for RichTextEmailAddress
+func (richTextEmailAddress *RichTextEmailAddress) Accept(visitor RichTextVisitor) {
+	visitor.VisitRichTextEmailAddress(richTextEmailAddress)
+}
+
 // RichTextSubscript A subscript rich text
 type RichTextSubscript struct {
 	tdCommon
@@ -559,27 +1378,109 @@ func NewRichTextSubscript(text RichText) *RichTextSubscript {
 	return &richTextSubscriptTemp
 }
 
+// MarshalJSON marshals to json
+func (richTextSubscript *RichTextSubscript) MarshalJSON() ([]byte, error) {
+	return richTextSubscript.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (richTextSubscript *RichTextSubscript) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, richTextSubscript)
+}
+
+func (richTextSubscript *RichTextSubscript) appendJSON(b []byte) ([]byte, error) {
+	if richTextSubscript == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, richTextSubscript.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, richTextSubscript.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b, err = appendJSONValue(b, richTextSubscript.Text)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (richTextSubscript *RichTextSubscript) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			richTextSubscript.tdCommon.Type, err = r.readString()
+		case "@extra":
+			richTextSubscript.tdCommon.Extra, err = r.readString()
+		case "text":
+			richTextSubscript.Text, err = decodeRichText(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (richTextSubscript *RichTextSubscript) Clone() *RichTextSubscript {
+	if richTextSubscript == nil {
+		return nil
+	}
+	clone := *richTextSubscript
+	clone.Text = cloneRichText(richTextSubscript.Text)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (richTextSubscript *RichTextSubscript) Equal(other *RichTextSubscript) bool {
+	if richTextSubscript == nil || other == nil {
+		return richTextSubscript == other
+	}
+	if !equalRichText(richTextSubscript.Text, other.Text) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (richTextSubscript *RichTextSubscript) Diff(other *RichTextSubscript) []Change {
+	return richTextSubscript.diff("", other, nil)
+}
+
+func (richTextSubscript *RichTextSubscript) diff(path string, other *RichTextSubscript, changes []Change) []Change {
+	if richTextSubscript == nil || other == nil {
+		if richTextSubscript != other {
+			changes = append(changes, Change{Path: path, Old: richTextSubscript, New: other})
+		}
+		return changes
 	}
+	changes = diffRichText(fieldPath(path, "text"), richTextSubscript.Text, other.Text, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (richTextSubscript *RichTextSubscript) String() string {
+	return objectString(richTextSubscript)
+}
 
-	richTextSubscript.tdCommon = tempObj.tdCommon
+// LogValue logs the object as its summary
+func (richTextSubscript *RichTextSubscript) LogValue() slog.Value {
+	return slog.StringValue(objectString(richTextSubscript))
+}
 
-	fieldText, _ := unmarshalRichText(objMap["text"])
-	richTextSubscript.Text = fieldText
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (richTextSubscript *RichTextSubscript) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, richTextSubscript)
+}
 
-	return nil
+func (richTextSubscript *RichTextSubscript) printText(p *printer) {
+	if richTextSubscript == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("RichTextSubscript")
+	p.object("text", richTextSubscript.Text)
+	p.endObject(state)
 }
 
 // GetRichTextEnum return the enum type of this object
@@ -587,6 +1488,11 @@ func (richTextSubscript *RichTextSubscript) GetRichTextEnum() RichTextEnum {
 	return RichTextSubscriptType
 }
 
+// Accept calls the method of the visitor for RichTextSubscript
+func (richTextSubscript *RichTextSubscript) Accept(visitor RichTextVisitor) {
+	visitor.VisitRichTextSubscript(richTextSubscript)
+}
+
 // RichTextSuperscript A superscript rich text
 type RichTextSuperscript struct {
 	tdCommon
@@ -610,27 +1516,109 @@ func NewRichTextSuperscript(text RichText) *RichTextSuperscript {
 	return &richTextSuperscriptTemp
 }
 
+// MarshalJSON marshals to json
+func (richTextSuperscript *RichTextSuperscript) MarshalJSON() ([]byte, error) {
+	return richTextSuperscript.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (richTextSuperscript *RichTextSuperscript) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, richTextSuperscript)
+}
+
+func (richTextSuperscript *RichTextSuperscript) appendJSON(b []byte) ([]byte, error) {
+	if richTextSuperscript == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, richTextSuperscript.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, richTextSuperscript.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b, err = appendJSONValue(b, richTextSuperscript.Text)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return append(b, '}'), nil
+}
+
+func (richTextSuperscript *RichTextSuperscript) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			richTextSuperscript.tdCommon.Type, err = r.readString()
+		case "@extra":
+			richTextSuperscript.tdCommon.Extra, err = r.readString()
+		case "text":
+			richTextSuperscript.Text, err = decodeRichText(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (richTextSuperscript *RichTextSuperscript) Clone() *RichTextSuperscript {
+	if richTextSuperscript == nil {
+		return nil
+	}
+	clone := *richTextSuperscript
+	clone.Text = cloneRichText(richTextSuperscript.Text)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (richTextSuperscript *RichTextSuperscript) Equal(other *RichTextSuperscript) bool {
+	if richTextSuperscript == nil || other == nil {
+		return richTextSuperscript == other
+	}
+	if !equalRichText(richTextSuperscript.Text, other.Text) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (richTextSuperscript *RichTextSuperscript) Diff(other *RichTextSuperscript) []Change {
+	return richTextSuperscript.diff("", other, nil)
+}
 
-	richTextSuperscript.tdCommon = tempObj.tdCommon
+func (richTextSuperscript *RichTextSuperscript) diff(path string, other *RichTextSuperscript, changes []Change) []Change {
+	if richTextSuperscript == nil || other == nil {
+		if richTextSuperscript != other {
+			changes = append(changes, Change{Path: path, Old: richTextSuperscript, New: other})
+		}
+		return changes
+	}
+	changes = diffRichText(fieldPath(path, "text"), richTextSuperscript.Text, other.Text, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (richTextSuperscript *RichTextSuperscript) String() string {
+	return objectString(richTextSuperscript)
+}
 
-	fieldText, _ := unmarshalRichText(objMap["text"])
-	richTextSuperscript.Text = fieldText
+// LogValue logs the object as its summary
+func (richTextSuperscript *RichTextSuperscript) LogValue() slog.Value {
+	return slog.StringValue(objectString(richTextSuperscript))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (richTextSuperscript *RichTextSuperscript) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, richTextSuperscript)
+}
 
-	return nil
+func (richTextSuperscript *RichTextSuperscript) printText(p *printer) {
+	if richTextSuperscript == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("RichTextSuperscript")
+	p.object("text", richTextSuperscript.Text)
+	p.endObject(state)
 }
 
 // GetRichTextEnum return the enum type of this object
@@ -638,6 +1626,11 @@ func (richTextSuperscript *RichTextSuperscript) GetRichTextEnum() RichTextEnum {
 	return RichTextSuperscriptType
 }
 
+// Accept calls the method of the visitor for RichTextSuperscript
+func (richTextSuperscript *RichTextSuperscript) Accept(visitor RichTextVisitor) {
+	visitor.VisitRichTextSuperscript(richTextSuperscript)
+}
+
 // RichTextMarked A marked rich text
 type RichTextMarked struct {
 	tdCommon
@@ -661,27 +1654,109 @@ func NewRichTextMarked(text RichText) *RichTextMarked {
 	return &richTextMarkedTemp
 }
 
+// MarshalJSON marshals to json
+func (richTextMarked *RichTextMarked) MarshalJSON() ([]byte, error) {
+	return richTextMarked.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (richTextMarked *RichTextMarked) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, richTextMarked)
+}
+
+func (richTextMarked *RichTextMarked) appendJSON(b []byte) ([]byte, error) {
+	if richTextMarked == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, richTextMarked.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, richTextMarked.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b, err = appendJSONValue(b, richTextMarked.Text)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return append(b, '}'), nil
+}
+
+func (richTextMarked *RichTextMarked) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			richTextMarked.tdCommon.Type, err = r.readString()
+		case "@extra":
+			richTextMarked.tdCommon.Extra, err = r.readString()
+		case "text":
+			richTextMarked.Text, err = decodeRichText(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (richTextMarked *RichTextMarked) Clone() *RichTextMarked {
+	if richTextMarked == nil {
+		return nil
+	}
+	clone := *richTextMarked
+	clone.Text = cloneRichText(richTextMarked.Text)
+	return &clone
+}
 
-	richTextMarked.tdCommon = tempObj.tdCommon
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (richTextMarked *RichTextMarked) Equal(other *RichTextMarked) bool {
+	if richTextMarked == nil || other == nil {
+		return richTextMarked == other
+	}
+	if !equalRichText(richTextMarked.Text, other.Text) {
+		return false
+	}
+	return true
+}
 
-	fieldText, _ := unmarshalRichText(objMap["text"])
-	richTextMarked.Text = fieldText
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (richTextMarked *RichTextMarked) Diff(other *RichTextMarked) []Change {
+	return richTextMarked.diff("", other, nil)
+}
 
-	return nil
+func (richTextMarked *RichTextMarked) diff(path string, other *RichTextMarked, changes []Change) []Change {
+	if richTextMarked == nil || other == nil {
+		if richTextMarked != other {
+			changes = append(changes, Change{Path: path, Old: richTextMarked, New: other})
+		}
+		return changes
+	}
+	changes = diffRichText(fieldPath(path, "text"), richTextMarked.Text, other.Text, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (richTextMarked *RichTextMarked) String() string {
+	return objectString(richTextMarked)
+}
+
+// LogValue logs the object as its summary
+func (richTextMarked *RichTextMarked) LogValue() slog.Value {
+	return slog.StringValue(objectString(richTextMarked))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (richTextMarked *RichTextMarked) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, richTextMarked)
+}
+
+func (richTextMarked *RichTextMarked) printText(p *printer) {
+	if richTextMarked == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("RichTextMarked")
+	p.object("text", richTextMarked.Text)
+	p.endObject(state)
 }
 
 // GetRichTextEnum return the enum type of this object
@@ -689,6 +1764,11 @@ func (richTextMarked *RichTextMarked) GetRichTextEnum() RichTextEnum {
 	return RichTextMarkedType
 }
 
+// Accept calls the method of the visitor for RichTextMarked
+func (richTextMarked *RichTextMarked) Accept(visitor RichTextVisitor) {
+	visitor.VisitRichTextMarked(richTextMarked)
+}
+
 // RichTextPhoneNumber A rich text phone number
 type RichTextPhoneNumber struct {
 	tdCommon
@@ -715,29 +1795,120 @@ func NewRichTextPhoneNumber(text RichText, phoneNumber string) *RichTextPhoneNum
 	return &richTextPhoneNumberTemp
 }
 
+// MarshalJSON marshals to json
+func (richTextPhoneNumber *RichTextPhoneNumber) MarshalJSON() ([]byte, error) {
+	return richTextPhoneNumber.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (richTextPhoneNumber *RichTextPhoneNumber) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, richTextPhoneNumber)
+}
+
+func (richTextPhoneNumber *RichTextPhoneNumber) appendJSON(b []byte) ([]byte, error) {
+	if richTextPhoneNumber == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-		PhoneNumber string `json:"phone_number"` // Phone number
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, richTextPhoneNumber.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, richTextPhoneNumber.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b, err = appendJSONValue(b, richTextPhoneNumber.Text)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"phone_number":`...)
+	b = appendJSONString(b, richTextPhoneNumber.PhoneNumber)
+	return append(b, '}'), nil
+}
+
+func (richTextPhoneNumber *RichTextPhoneNumber) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			richTextPhoneNumber.tdCommon.Type, err = r.readString()
+		case "@extra":
+			richTextPhoneNumber.tdCommon.Extra, err = r.readString()
+		case "text":
+			richTextPhoneNumber.Text, err = decodeRichText(r)
+		case "phone_number":
+			richTextPhoneNumber.PhoneNumber, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (richTextPhoneNumber *RichTextPhoneNumber) Clone() *RichTextPhoneNumber {
+	if richTextPhoneNumber == nil {
+		return nil
+	}
+	clone := *richTextPhoneNumber
+	clone.Text = cloneRichText(richTextPhoneNumber.Text)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (richTextPhoneNumber *RichTextPhoneNumber) Equal(other *RichTextPhoneNumber) bool {
+	if richTextPhoneNumber == nil || other == nil {
+		return richTextPhoneNumber == other
+	}
+	if !equalRichText(richTextPhoneNumber.Text, other.Text) {
+		return false
+	}
+	if richTextPhoneNumber.PhoneNumber != other.PhoneNumber {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (richTextPhoneNumber *RichTextPhoneNumber) Diff(other *RichTextPhoneNumber) []Change {
+	return richTextPhoneNumber.diff("", other, nil)
+}
+
+func (richTextPhoneNumber *RichTextPhoneNumber) diff(path string, other *RichTextPhoneNumber, changes []Change) []Change {
+	if richTextPhoneNumber == nil || other == nil {
+		if richTextPhoneNumber != other {
+			changes = append(changes, Change{Path: path, Old: richTextPhoneNumber, New: other})
+		}
+		return changes
 	}
+	changes = diffRichText(fieldPath(path, "text"), richTextPhoneNumber.Text, other.Text, changes)
+	if richTextPhoneNumber.PhoneNumber != other.PhoneNumber {
+		changes = append(changes, Change{Path: fieldPath(path, "phone_number"), Old: richTextPhoneNumber.PhoneNumber, New: other.PhoneNumber})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (richTextPhoneNumber *RichTextPhoneNumber) String() string {
+	return objectString(richTextPhoneNumber)
+}
 
-	richTextPhoneNumber.tdCommon = tempObj.tdCommon
-	richTextPhoneNumber.PhoneNumber = tempObj.PhoneNumber
+// LogValue logs the object as its summary
+func (richTextPhoneNumber *RichTextPhoneNumber) LogValue() slog.Value {
+	return slog.StringValue(objectString(richTextPhoneNumber))
+}
 
-	fieldText, _ := unmarshalRichText(objMap["text"])
-	richTextPhoneNumber.Text = fieldText
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (richTextPhoneNumber *RichTextPhoneNumber) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, richTextPhoneNumber)
+}
 
-	return nil
+func (richTextPhoneNumber *RichTextPhoneNumber) printText(p *printer) {
+	if richTextPhoneNumber == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("RichTextPhoneNumber")
+	p.object("text", richTextPhoneNumber.Text)
+	p.string("phone_number", richTextPhoneNumber.PhoneNumber)
+	p.endObject(state)
 }
 
 // GetRichTextEnum return the enum type of this object
@@ -745,6 +1916,11 @@ func (richTextPhoneNumber *RichTextPhoneNumber) GetRichTextEnum() RichTextEnum {
 	return RichTextPhoneNumberType
 }
 
+// Accept calls the method of the visitor for RichTextPhoneNumber
+func (richTextPhoneNumber *RichTextPhoneNumber) Accept(visitor RichTextVisitor) {
+	visitor.VisitRichTextPhoneNumber(richTextPhoneNumber)
+}
+
 // RichTextIcon A small image inside the text
 type RichTextIcon struct {
 	tdCommon
@@ -774,11 +1950,148 @@ func NewRichTextIcon(document *Document, width int32, height int32) *RichTextIco
 	return &richTextIconTemp
 }
 
+// MarshalJSON marshals to json
+func (richTextIcon *RichTextIcon) MarshalJSON() ([]byte, error) {
+	return richTextIcon.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (richTextIcon *RichTextIcon) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, richTextIcon)
+}
+
+func (richTextIcon *RichTextIcon) appendJSON(b []byte) ([]byte, error) {
+	if richTextIcon == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, richTextIcon.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, richTextIcon.tdCommon.Extra)
+	b = append(b, `,"document":`...)
+	b, err = richTextIcon.Document.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"width":`...)
+	b = appendJSONInt(b, int64(richTextIcon.Width))
+	b = append(b, `,"height":`...)
+	b = appendJSONInt(b, int64(richTextIcon.Height))
+	return append(b, '}'), nil
+}
+
+func (richTextIcon *RichTextIcon) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			richTextIcon.tdCommon.Type, err = r.readString()
+		case "@extra":
+			richTextIcon.tdCommon.Extra, err = r.readString()
+		case "document":
+			if r.readNull() {
+				richTextIcon.Document = nil
+			} else {
+				richTextIcon.Document = new(Document)
+				err = richTextIcon.Document.decodeJSON(r)
+			}
+		case "width":
+			richTextIcon.Width, err = r.readInt32()
+		case "height":
+			richTextIcon.Height, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (richTextIcon *RichTextIcon) Clone() *RichTextIcon {
+	if richTextIcon == nil {
+		return nil
+	}
+	clone := *richTextIcon
+	clone.Document = richTextIcon.Document.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (richTextIcon *RichTextIcon) Equal(other *RichTextIcon) bool {
+	if richTextIcon == nil || other == nil {
+		return richTextIcon == other
+	}
+	if !richTextIcon.Document.Equal(other.Document) {
+		return false
+	}
+	if richTextIcon.Width != other.Width {
+		return false
+	}
+	if richTextIcon.Height != other.Height {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (richTextIcon *RichTextIcon) Diff(other *RichTextIcon) []Change {
+	return richTextIcon.diff("", other, nil)
+}
+
+func (richTextIcon *RichTextIcon) diff(path string, other *RichTextIcon, changes []Change) []Change {
+	if richTextIcon == nil || other == nil {
+		if richTextIcon != other {
+			changes = append(changes, Change{Path: path, Old: richTextIcon, New: other})
+		}
+		return changes
+	}
+	changes = richTextIcon.Document.diff(fieldPath(path, "document"), other.Document, changes)
+	if richTextIcon.Width != other.Width {
+		changes = append(changes, Change{Path: fieldPath(path, "width"), Old: richTextIcon.Width, New: other.Width})
+	}
+	if richTextIcon.Height != other.Height {
+		changes = append(changes, Change{Path: fieldPath(path, "height"), Old: richTextIcon.Height, New: other.Height})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (richTextIcon *RichTextIcon) String() string {
+	return objectString(richTextIcon)
+}
+
+// LogValue logs the object as its summary
+func (richTextIcon *RichTextIcon) LogValue() slog.Value {
+	return slog.StringValue(objectString(richTextIcon))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (richTextIcon *RichTextIcon) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, richTextIcon)
+}
+
+func (richTextIcon *RichTextIcon) printText(p *printer) {
+	if richTextIcon == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("RichTextIcon")
+	p.object("document", richTextIcon.Document)
+	p.int("width", int64(richTextIcon.Width))
+	p.int("height", int64(richTextIcon.Height))
+	p.endObject(state)
+}
+
 // GetRichTextEnum return the enum type of this object
 func (richTextIcon *RichTextIcon) GetRichTextEnum() RichTextEnum {
 	return RichTextIconType
 }
 
+// Accept calls the method of the visitor for RichTextIcon
+func (richTextIcon *RichTextIcon) Accept(visitor RichTextVisitor) {
+	visitor.VisitRichTextIcon(richTextIcon)
+}
+
 // RichTextReference A reference to a richTexts object on the same web page
 type RichTextReference struct {
 	tdCommon
@@ -808,31 +2121,131 @@ func NewRichTextReference(text RichText, anchorName string, url string) *RichTex
 	return &richTextReferenceTemp
 }
 
+// MarshalJSON marshals to json
+func (richTextReference *RichTextReference) MarshalJSON() ([]byte, error) {
+	return richTextReference.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (richTextReference *RichTextReference) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		AnchorName string `json:"anchor_name"` // The name of a richTextAnchor object, which is the first element of the target richTexts object
-		Url        string `json:"url"`         // An HTTP URL, opening the reference
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	return unmarshalFast(b, richTextReference)
+}
+
+func (richTextReference *RichTextReference) appendJSON(b []byte) ([]byte, error) {
+	if richTextReference == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, richTextReference.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, richTextReference.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b, err = appendJSONValue(b, richTextReference.Text)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"anchor_name":`...)
+	b = appendJSONString(b, richTextReference.AnchorName)
+	b = append(b, `,"url":`...)
+	b = appendJSONString(b, richTextReference.Url)
+	return append(b, '}'), nil
+}
+
+func (richTextReference *RichTextReference) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			richTextReference.tdCommon.Type, err = r.readString()
+		case "@extra":
+			richTextReference.tdCommon.Extra, err = r.readString()
+		case "text":
+			richTextReference.Text, err = decodeRichText(r)
+		case "anchor_name":
+			richTextReference.AnchorName, err = r.readString()
+		case "url":
+			richTextReference.Url, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (richTextReference *RichTextReference) Clone() *RichTextReference {
+	if richTextReference == nil {
+		return nil
+	}
+	clone := *richTextReference
+	clone.Text = cloneRichText(richTextReference.Text)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (richTextReference *RichTextReference) Equal(other *RichTextReference) bool {
+	if richTextReference == nil || other == nil {
+		return richTextReference == other
+	}
+	if !equalRichText(richTextReference.Text, other.Text) {
+		return false
+	}
+	if richTextReference.AnchorName != other.AnchorName {
+		return false
+	}
+	if richTextReference.Url != other.Url {
+		return false
 	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (richTextReference *RichTextReference) Diff(other *RichTextReference) []Change {
+	return richTextReference.diff("", other, nil)
+}
+
+func (richTextReference *RichTextReference) diff(path string, other *RichTextReference, changes []Change) []Change {
+	if richTextReference == nil || other == nil {
+		if richTextReference != other {
+			changes = append(changes, Change{Path: path, Old: richTextReference, New: other})
+		}
+		return changes
+	}
+	changes = diffRichText(fieldPath(path, "text"), richTextReference.Text, other.Text, changes)
+	if richTextReference.AnchorName != other.AnchorName {
+		changes = append(changes, Change{Path: fieldPath(path, "anchor_name"), Old: richTextReference.AnchorName, New: other.AnchorName})
+	}
+	if richTextReference.Url != other.Url {
+		changes = append(changes, Change{Path: fieldPath(path, "url"), Old: richTextReference.Url, New: other.Url})
+	}
+	return changes
+}
 
-	richTextReference.tdCommon = tempObj.tdCommon
-	richTextReference.AnchorName = tempObj.AnchorName
-	richTextReference.Url = tempObj.Url
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (richTextReference *RichTextReference) String() string {
+	return objectString(richTextReference)
+}
+
+// LogValue logs the object as its summary
+func (richTextReference *RichTextReference) LogValue() slog.Value {
+	return slog.StringValue(objectString(richTextReference))
+}
 
-	fieldText, _ := unmarshalRichText(objMap["text"])
-	richTextReference.Text = fieldText
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (richTextReference *RichTextReference) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, richTextReference)
+}
 
-	return nil
+func (richTextReference *RichTextReference) printText(p *printer) {
+	if richTextReference == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("RichTextReference")
+	p.object("text", richTextReference.Text)
+	p.string("anchor_name", richTextReference.AnchorName)
+	p.string("url", richTextReference.Url)
+	p.endObject(state)
 }
 
 // GetRichTextEnum return the enum type of this object
@@ -840,6 +2253,11 @@ func (richTextReference *RichTextReference) GetRichTextEnum() RichTextEnum {
 	return RichTextReferenceType
 }
 
+// Accept calls the method of the visitor for RichTextReference
+func (richTextReference *RichTextReference) Accept(visitor RichTextVisitor) {
+	visitor.VisitRichTextReference(richTextReference)
+}
+
 // RichTextAnchor An anchor
 type RichTextAnchor struct {
 	tdCommon
@@ -863,11 +2281,118 @@ func NewRichTextAnchor(name string) *RichTextAnchor {
 	return &richTextAnchorTemp
 }
 
+// MarshalJSON marshals to json
+func (richTextAnchor *RichTextAnchor) MarshalJSON() ([]byte, error) {
+	return richTextAnchor.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (richTextAnchor *RichTextAnchor) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, richTextAnchor)
+}
+
+func (richTextAnchor *RichTextAnchor) appendJSON(b []byte) ([]byte, error) {
+	if richTextAnchor == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, richTextAnchor.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, richTextAnchor.tdCommon.Extra)
+	b = append(b, `,"name":`...)
+	b = appendJSONString(b, richTextAnchor.Name)
+	return append(b, '}'), nil
+}
+
+func (richTextAnchor *RichTextAnchor) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			richTextAnchor.tdCommon.Type, err = r.readString()
+		case "@extra":
+			richTextAnchor.tdCommon.Extra, err = r.readString()
+		case "name":
+			richTextAnchor.Name, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (richTextAnchor *RichTextAnchor) Clone() *RichTextAnchor {
+	if richTextAnchor == nil {
+		return nil
+	}
+	clone := *richTextAnchor
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (richTextAnchor *RichTextAnchor) Equal(other *RichTextAnchor) bool {
+	if richTextAnchor == nil || other == nil {
+		return richTextAnchor == other
+	}
+	if richTextAnchor.Name != other.Name {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (richTextAnchor *RichTextAnchor) Diff(other *RichTextAnchor) []Change {
+	return richTextAnchor.diff("", other, nil)
+}
+
+func (richTextAnchor *RichTextAnchor) diff(path string, other *RichTextAnchor, changes []Change) []Change {
+	if richTextAnchor == nil || other == nil {
+		if richTextAnchor != other {
+			changes = append(changes, Change{Path: path, Old: richTextAnchor, New: other})
+		}
+		return changes
+	}
+	if richTextAnchor.Name != other.Name {
+		changes = append(changes, Change{Path: fieldPath(path, "name"), Old: richTextAnchor.Name, New: other.Name})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (richTextAnchor *RichTextAnchor) String() string {
+	return objectString(richTextAnchor)
+}
+
+// LogValue logs the object as its summary
+func (richTextAnchor *RichTextAnchor) LogValue() slog.Value {
+	return slog.StringValue(objectString(richTextAnchor))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (richTextAnchor *RichTextAnchor) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, richTextAnchor)
+}
+
+func (richTextAnchor *RichTextAnchor) printText(p *printer) {
+	if richTextAnchor == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("RichTextAnchor")
+	p.string("name", richTextAnchor.Name)
+	p.endObject(state)
+}
+
 // GetRichTextEnum return the enum type of this object
 func (richTextAnchor *RichTextAnchor) GetRichTextEnum() RichTextEnum {
 	return RichTextAnchorType
 }
 
+// Accept calls the method of the visitor for RichTextAnchor
+func (richTextAnchor *RichTextAnchor) Accept(visitor RichTextVisitor) {
+	visitor.VisitRichTextAnchor(richTextAnchor)
+}
+
 // RichTextAnchorLink A link to an anchor on the same web page
 type RichTextAnchorLink struct {
 	tdCommon
@@ -897,31 +2422,131 @@ func NewRichTextAnchorLink(text RichText, anchorName string, url string) *RichTe
 	return &richTextAnchorLinkTemp
 }
 
+// MarshalJSON marshals to json
+func (richTextAnchorLink *RichTextAnchorLink) MarshalJSON() ([]byte, error) {
+	return richTextAnchorLink.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (richTextAnchorLink *RichTextAnchorLink) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		AnchorName string `json:"anchor_name"` // The anchor name. If the name is empty, the link must bring back to top
-		Url        string `json:"url"`         // An HTTP URL, opening the anchor
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	return unmarshalFast(b, richTextAnchorLink)
+}
+
+func (richTextAnchorLink *RichTextAnchorLink) appendJSON(b []byte) ([]byte, error) {
+	if richTextAnchorLink == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, richTextAnchorLink.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, richTextAnchorLink.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b, err = appendJSONValue(b, richTextAnchorLink.Text)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"anchor_name":`...)
+	b = appendJSONString(b, richTextAnchorLink.AnchorName)
+	b = append(b, `,"url":`...)
+	b = appendJSONString(b, richTextAnchorLink.Url)
+	return append(b, '}'), nil
+}
+
+func (richTextAnchorLink *RichTextAnchorLink) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			richTextAnchorLink.tdCommon.Type, err = r.readString()
+		case "@extra":
+			richTextAnchorLink.tdCommon.Extra, err = r.readString()
+		case "text":
+			richTextAnchorLink.Text, err = decodeRichText(r)
+		case "anchor_name":
+			richTextAnchorLink.AnchorName, err = r.readString()
+		case "url":
+			richTextAnchorLink.Url, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (richTextAnchorLink *RichTextAnchorLink) Clone() *RichTextAnchorLink {
+	if richTextAnchorLink == nil {
+		return nil
+	}
+	clone := *richTextAnchorLink
+	clone.Text = cloneRichText(richTextAnchorLink.Text)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (richTextAnchorLink *RichTextAnchorLink) Equal(other *RichTextAnchorLink) bool {
+	if richTextAnchorLink == nil || other == nil {
+		return richTextAnchorLink == other
+	}
+	if !equalRichText(richTextAnchorLink.Text, other.Text) {
+		return false
+	}
+	if richTextAnchorLink.AnchorName != other.AnchorName {
+		return false
+	}
+	if richTextAnchorLink.Url != other.Url {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (richTextAnchorLink *RichTextAnchorLink) Diff(other *RichTextAnchorLink) []Change {
+	return richTextAnchorLink.diff("", other, nil)
+}
+
+func (richTextAnchorLink *RichTextAnchorLink) diff(path string, other *RichTextAnchorLink, changes []Change) []Change {
+	if richTextAnchorLink == nil || other == nil {
+		if richTextAnchorLink != other {
+			changes = append(changes, Change{Path: path, Old: richTextAnchorLink, New: other})
+		}
+		return changes
+	}
+	changes = diffRichText(fieldPath(path, "text"), richTextAnchorLink.Text, other.Text, changes)
+	if richTextAnchorLink.AnchorName != other.AnchorName {
+		changes = append(changes, Change{Path: fieldPath(path, "anchor_name"), Old: richTextAnchorLink.AnchorName, New: other.AnchorName})
 	}
+	if richTextAnchorLink.Url != other.Url {
+		changes = append(changes, Change{Path: fieldPath(path, "url"), Old: richTextAnchorLink.Url, New: other.Url})
+	}
+	return changes
+}
 
-	richTextAnchorLink.tdCommon = tempObj.tdCommon
-	richTextAnchorLink.AnchorName = tempObj.AnchorName
-	richTextAnchorLink.Url = tempObj.Url
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (richTextAnchorLink *RichTextAnchorLink) String() string {
+	return objectString(richTextAnchorLink)
+}
+
+// LogValue logs the object as its summary
+func (richTextAnchorLink *RichTextAnchorLink) LogValue() slog.Value {
+	return slog.StringValue(objectString(richTextAnchorLink))
+}
 
-	fieldText, _ := unmarshalRichText(objMap["text"])
-	richTextAnchorLink.Text = fieldText
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (richTextAnchorLink *RichTextAnchorLink) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, richTextAnchorLink)
+}
 
-	return nil
+func (richTextAnchorLink *RichTextAnchorLink) printText(p *printer) {
+	if richTextAnchorLink == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("RichTextAnchorLink")
+	p.object("text", richTextAnchorLink.Text)
+	p.string("anchor_name", richTextAnchorLink.AnchorName)
+	p.string("url", richTextAnchorLink.Url)
+	p.endObject(state)
 }
 
 // GetRichTextEnum return the enum type of this object
@@ -929,6 +2554,11 @@ func (richTextAnchorLink *RichTextAnchorLink) GetRichTextEnum() RichTextEnum {
 	return RichTextAnchorLinkType
 }
 
+// Accept calls the method of the visitor for RichTextAnchorLink
+func (richTextAnchorLink *RichTextAnchorLink) Accept(visitor RichTextVisitor) {
+	visitor.VisitRichTextAnchorLink(richTextAnchorLink)
+}
+
 // RichTexts A concatenation of rich texts
 type RichTexts struct {
 	tdCommon
@@ -952,7 +2582,476 @@ func NewRichTexts(texts []RichText) *RichTexts {
 	return &richTextsTemp
 }
 
+// MarshalJSON marshals to json
+func (richTexts *RichTexts) MarshalJSON() ([]byte, error) {
+	return richTexts.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (richTexts *RichTexts) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, richTexts)
+}
+
+func (richTexts *RichTexts) appendJSON(b []byte) ([]byte, error) {
+	if richTexts == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, richTexts.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, richTexts.tdCommon.Extra)
+	b = append(b, `,"texts":`...)
+	if richTexts.Texts == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range richTexts.Texts {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = appendJSONValue(b, richTexts.Texts[i0])
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (richTexts *RichTexts) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			richTexts.tdCommon.Type, err = r.readString()
+		case "@extra":
+			richTexts.tdCommon.Extra, err = r.readString()
+		case "texts":
+			if r.readNull() {
+				richTexts.Texts = nil
+			} else {
+				richTexts.Texts = make([]RichText, 0)
+				err = r.readArray(func() (err error) {
+					var item0 RichText
+					item0, err = decodeRichText(r)
+					richTexts.Texts = append(richTexts.Texts, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (richTexts *RichTexts) Clone() *RichTexts {
+	if richTexts == nil {
+		return nil
+	}
+	clone := *richTexts
+	if richTexts.Texts != nil {
+		clone.Texts = make([]RichText, len(richTexts.Texts))
+		for i0 := range richTexts.Texts {
+			clone.Texts[i0] = cloneRichText(richTexts.Texts[i0])
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (richTexts *RichTexts) Equal(other *RichTexts) bool {
+	if richTexts == nil || other == nil {
+		return richTexts == other
+	}
+	if len(richTexts.Texts) != len(other.Texts) {
+		return false
+	}
+	for i0 := range richTexts.Texts {
+		if !equalRichText(richTexts.Texts[i0], other.Texts[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (richTexts *RichTexts) Diff(other *RichTexts) []Change {
+	return richTexts.diff("", other, nil)
+}
+
+func (richTexts *RichTexts) diff(path string, other *RichTexts, changes []Change) []Change {
+	if richTexts == nil || other == nil {
+		if richTexts != other {
+			changes = append(changes, Change{Path: path, Old: richTexts, New: other})
+		}
+		return changes
+	}
+	if len(richTexts.Texts) != len(other.Texts) {
+		changes = append(changes, Change{Path: fieldPath(path, "texts"), Old: richTexts.Texts, New: other.Texts})
+	} else {
+		for i0 := range richTexts.Texts {
+			changes = diffRichText(indexPath(fieldPath(path, "texts"), i0), richTexts.Texts[i0], other.Texts[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (richTexts *RichTexts) String() string {
+	return objectString(richTexts)
+}
+
+// LogValue logs the object as its summary
+func (richTexts *RichTexts) LogValue() slog.Value {
+	return slog.StringValue(objectString(richTexts))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (richTexts *RichTexts) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, richTexts)
+}
+
+func (richTexts *RichTexts) printText(p *printer) {
+	if richTexts == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("RichTexts")
+	p.vector("texts", len(richTexts.Texts), func(i0 int) {
+		p.object("texts", richTexts.Texts[i0])
+	})
+	p.endObject(state)
+}
+
 // GetRichTextEnum return the enum type of this object
 func (richTexts *RichTexts) GetRichTextEnum() RichTextEnum {
 	return RichTextsType
 }
+
+// Accept calls the method of the visitor for RichTexts
+func (richTexts *RichTexts) Accept(visitor RichTextVisitor) {
+	visitor.VisitRichTexts(richTexts)
+}
+
+// RichTextVisitor visits every type of RichText, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to RichText, until they handle it too.
+type RichTextVisitor interface {
+	VisitRichTextPlain(richTextPlain *RichTextPlain)
+	VisitRichTextBold(richTextBold *RichTextBold)
+	VisitRichTextItalic(richTextItalic *RichTextItalic)
+	VisitRichTextUnderline(richTextUnderline *RichTextUnderline)
+	VisitRichTextStrikethrough(richTextStrikethrough *RichTextStrikethrough)
+	VisitRichTextFixed(richTextFixed *RichTextFixed)
+	VisitRichTextUrl(richTextUrl *RichTextUrl)
+	VisitRichTextEmailAddress(richTextEmailAddress *RichTextEmailAddress)
+	VisitRichTextSubscript(richTextSubscript *RichTextSubscript)
+	VisitRichTextSuperscript(richTextSuperscript *RichTextSuperscript)
+	VisitRichTextMarked(richTextMarked *RichTextMarked)
+	VisitRichTextPhoneNumber(richTextPhoneNumber *RichTextPhoneNumber)
+	VisitRichTextIcon(richTextIcon *RichTextIcon)
+	VisitRichTextReference(richTextReference *RichTextReference)
+	VisitRichTextAnchor(richTextAnchor *RichTextAnchor)
+	VisitRichTextAnchorLink(richTextAnchorLink *RichTextAnchorLink)
+	VisitRichTexts(richTexts *RichTexts)
+	VisitUnknownRichText(unknownRichText *UnknownRichText)
+}
+
+// RichTextCases holds the function MatchRichText calls for each type of RichText;
+// types without a function are passed to the default case
+type RichTextCases[R any] struct {
+	RichTextPlain         func(richTextPlain *RichTextPlain) R
+	RichTextBold          func(richTextBold *RichTextBold) R
+	RichTextItalic        func(richTextItalic *RichTextItalic) R
+	RichTextUnderline     func(richTextUnderline *RichTextUnderline) R
+	RichTextStrikethrough func(richTextStrikethrough *RichTextStrikethrough) R
+	RichTextFixed         func(richTextFixed *RichTextFixed) R
+	RichTextUrl           func(richTextUrl *RichTextUrl) R
+	RichTextEmailAddress  func(richTextEmailAddress *RichTextEmailAddress) R
+	RichTextSubscript     func(richTextSubscript *RichTextSubscript) R
+	RichTextSuperscript   func(richTextSuperscript *RichTextSuperscript) R
+	RichTextMarked        func(richTextMarked *RichTextMarked) R
+	RichTextPhoneNumber   func(richTextPhoneNumber *RichTextPhoneNumber) R
+	RichTextIcon          func(richTextIcon *RichTextIcon) R
+	RichTextReference     func(richTextReference *RichTextReference) R
+	RichTextAnchor        func(richTextAnchor *RichTextAnchor) R
+	RichTextAnchorLink    func(richTextAnchorLink *RichTextAnchorLink) R
+	RichTexts             func(richTexts *RichTexts) R
+}
+
+// MatchRichText calls the case of the type of richText, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement RichTextVisitor
+// to have the compiler check every type is handled.
+func MatchRichText[R any](richText RichText, cases RichTextCases[R], defaultCase func(richText RichText) R) R {
+	switch value := richText.(type) {
+	case *RichTextPlain:
+		if cases.RichTextPlain != nil {
+			return cases.RichTextPlain(value)
+		}
+	case *RichTextBold:
+		if cases.RichTextBold != nil {
+			return cases.RichTextBold(value)
+		}
+	case *RichTextItalic:
+		if cases.RichTextItalic != nil {
+			return cases.RichTextItalic(value)
+		}
+	case *RichTextUnderline:
+		if cases.RichTextUnderline != nil {
+			return cases.RichTextUnderline(value)
+		}
+	case *RichTextStrikethrough:
+		if cases.RichTextStrikethrough != nil {
+			return cases.RichTextStrikethrough(value)
+		}
+	case *RichTextFixed:
+		if cases.RichTextFixed != nil {
+			return cases.RichTextFixed(value)
+		}
+	case *RichTextUrl:
+		if cases.RichTextUrl != nil {
+			return cases.RichTextUrl(value)
+		}
+	case *RichTextEmailAddress:
+		if cases.RichTextEmailAddress != nil {
+			return cases.RichTextEmailAddress(value)
+		}
+	case *RichTextSubscript:
+		if cases.RichTextSubscript != nil {
+			return cases.RichTextSubscript(value)
+		}
+	case *RichTextSuperscript:
+		if cases.RichTextSuperscript != nil {
+			return cases.RichTextSuperscript(value)
+		}
+	case *RichTextMarked:
+		if cases.RichTextMarked != nil {
+			return cases.RichTextMarked(value)
+		}
+	case *RichTextPhoneNumber:
+		if cases.RichTextPhoneNumber != nil {
+			return cases.RichTextPhoneNumber(value)
+		}
+	case *RichTextIcon:
+		if cases.RichTextIcon != nil {
+			return cases.RichTextIcon(value)
+		}
+	case *RichTextReference:
+		if cases.RichTextReference != nil {
+			return cases.RichTextReference(value)
+		}
+	case *RichTextAnchor:
+		if cases.RichTextAnchor != nil {
+			return cases.RichTextAnchor(value)
+		}
+	case *RichTextAnchorLink:
+		if cases.RichTextAnchorLink != nil {
+			return cases.RichTextAnchorLink(value)
+		}
+	case *RichTexts:
+		if cases.RichTexts != nil {
+			return cases.RichTexts(value)
+		}
+	}
+	return defaultCase(richText)
+}
+
+// Clone returns a deep copy of the object
+func (unknownRichText *UnknownRichText) Clone() *UnknownRichText {
+	if unknownRichText == nil {
+		return nil
+	}
+	return &UnknownRichText{Unknown: unknownRichText.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownRichText *UnknownRichText) Equal(other *UnknownRichText) bool {
+	if unknownRichText == nil || other == nil {
+		return unknownRichText == other
+	}
+	return unknownRichText.Unknown.equal(other.Unknown)
+}
+
+func cloneRichText(richText RichText) RichText {
+	switch value := richText.(type) {
+	case *RichTextPlain:
+		return value.Clone()
+	case *RichTextBold:
+		return value.Clone()
+	case *RichTextItalic:
+		return value.Clone()
+	case *RichTextUnderline:
+		return value.Clone()
+	case *RichTextStrikethrough:
+		return value.Clone()
+	case *RichTextFixed:
+		return value.Clone()
+	case *RichTextUrl:
+		return value.Clone()
+	case *RichTextEmailAddress:
+		return value.Clone()
+	case *RichTextSubscript:
+		return value.Clone()
+	case *RichTextSuperscript:
+		return value.Clone()
+	case *RichTextMarked:
+		return value.Clone()
+	case *RichTextPhoneNumber:
+		return value.Clone()
+	case *RichTextIcon:
+		return value.Clone()
+	case *RichTextReference:
+		return value.Clone()
+	case *RichTextAnchor:
+		return value.Clone()
+	case *RichTextAnchorLink:
+		return value.Clone()
+	case *RichTexts:
+		return value.Clone()
+	case *UnknownRichText:
+		return value.Clone()
+	}
+	return richText
+}
+
+func equalRichText(a RichText, b RichText) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *RichTextPlain:
+		b, ok := b.(*RichTextPlain)
+		return ok && a.Equal(b)
+	case *RichTextBold:
+		b, ok := b.(*RichTextBold)
+		return ok && a.Equal(b)
+	case *RichTextItalic:
+		b, ok := b.(*RichTextItalic)
+		return ok && a.Equal(b)
+	case *RichTextUnderline:
+		b, ok := b.(*RichTextUnderline)
+		return ok && a.Equal(b)
+	case *RichTextStrikethrough:
+		b, ok := b.(*RichTextStrikethrough)
+		return ok && a.Equal(b)
+	case *RichTextFixed:
+		b, ok := b.(*RichTextFixed)
+		return ok && a.Equal(b)
+	case *RichTextUrl:
+		b, ok := b.(*RichTextUrl)
+		return ok && a.Equal(b)
+	case *RichTextEmailAddress:
+		b, ok := b.(*RichTextEmailAddress)
+		return ok && a.Equal(b)
+	case *RichTextSubscript:
+		b, ok := b.(*RichTextSubscript)
+		return ok && a.Equal(b)
+	case *RichTextSuperscript:
+		b, ok := b.(*RichTextSuperscript)
+		return ok && a.Equal(b)
+	case *RichTextMarked:
+		b, ok := b.(*RichTextMarked)
+		return ok && a.Equal(b)
+	case *RichTextPhoneNumber:
+		b, ok := b.(*RichTextPhoneNumber)
+		return ok && a.Equal(b)
+	case *RichTextIcon:
+		b, ok := b.(*RichTextIcon)
+		return ok && a.Equal(b)
+	case *RichTextReference:
+		b, ok := b.(*RichTextReference)
+		return ok && a.Equal(b)
+	case *RichTextAnchor:
+		b, ok := b.(*RichTextAnchor)
+		return ok && a.Equal(b)
+	case *RichTextAnchorLink:
+		b, ok := b.(*RichTextAnchorLink)
+		return ok && a.Equal(b)
+	case *RichTexts:
+		b, ok := b.(*RichTexts)
+		return ok && a.Equal(b)
+	case *UnknownRichText:
+		b, ok := b.(*UnknownRichText)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffRichText(path string, a RichText, b RichText, changes []Change) []Change {
+	switch a := a.(type) {
+	case *RichTextPlain:
+		if b, ok := b.(*RichTextPlain); ok {
+			return a.diff(path, b, changes)
+		}
+	case *RichTextBold:
+		if b, ok := b.(*RichTextBold); ok {
+			return a.diff(path, b, changes)
+		}
+	case *RichTextItalic:
+		if b, ok := b.(*RichTextItalic); ok {
+			return a.diff(path, b, changes)
+		}
+	case *RichTextUnderline:
+		if b, ok := b.(*RichTextUnderline); ok {
+			return a.diff(path, b, changes)
+		}
+	case *RichTextStrikethrough:
+		if b, ok := b.(*RichTextStrikethrough); ok {
+			return a.diff(path, b, changes)
+		}
+	case *RichTextFixed:
+		if b, ok := b.(*RichTextFixed); ok {
+			return a.diff(path, b, changes)
+		}
+	case *RichTextUrl:
+		if b, ok := b.(*RichTextUrl); ok {
+			return a.diff(path, b, changes)
+		}
+	case *RichTextEmailAddress:
+		if b, ok := b.(*RichTextEmailAddress); ok {
+			return a.diff(path, b, changes)
+		}
+	case *RichTextSubscript:
+		if b, ok := b.(*RichTextSubscript); ok {
+			return a.diff(path, b, changes)
+		}
+	case *RichTextSuperscript:
+		if b, ok := b.(*RichTextSuperscript); ok {
+			return a.diff(path, b, changes)
+		}
+	case *RichTextMarked:
+		if b, ok := b.(*RichTextMarked); ok {
+			return a.diff(path, b, changes)
+		}
+	case *RichTextPhoneNumber:
+		if b, ok := b.(*RichTextPhoneNumber); ok {
+			return a.diff(path, b, changes)
+		}
+	case *RichTextIcon:
+		if b, ok := b.(*RichTextIcon); ok {
+			return a.diff(path, b, changes)
+		}
+	case *RichTextReference:
+		if b, ok := b.(*RichTextReference); ok {
+			return a.diff(path, b, changes)
+		}
+	case *RichTextAnchor:
+		if b, ok := b.(*RichTextAnchor); ok {
+			return a.diff(path, b, changes)
+		}
+	case *RichTextAnchorLink:
+		if b, ok := b.(*RichTextAnchorLink); ok {
+			return a.diff(path, b, changes)
+		}
+	case *RichTexts:
+		if b, ok := b.(*RichTexts); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalRichText(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // SavedCredentials Contains information about saved card credentials
 type SavedCredentials struct {
 	tdCommon
@@ -25,3 +30,116 @@ func NewSavedCredentials(id string, title string) *SavedCredentials {
 
 	return &savedCredentialsTemp
 }
+
+// MarshalJSON marshals to json
+func (savedCredentials *SavedCredentials) MarshalJSON() ([]byte, error) {
+	return savedCredentials.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (savedCredentials *SavedCredentials) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, savedCredentials)
+}
+
+func (savedCredentials *SavedCredentials) appendJSON(b []byte) ([]byte, error) {
+	if savedCredentials == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, savedCredentials.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, savedCredentials.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, savedCredentials.Id)
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, savedCredentials.Title)
+	return append(b, '}'), nil
+}
+
+func (savedCredentials *SavedCredentials) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			savedCredentials.tdCommon.Type, err = r.readString()
+		case "@extra":
+			savedCredentials.tdCommon.Extra, err = r.readString()
+		case "id":
+			savedCredentials.Id, err = r.readString()
+		case "title":
+			savedCredentials.Title, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (savedCredentials *SavedCredentials) Clone() *SavedCredentials {
+	if savedCredentials == nil {
+		return nil
+	}
+	clone := *savedCredentials
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (savedCredentials *SavedCredentials) Equal(other *SavedCredentials) bool {
+	if savedCredentials == nil || other == nil {
+		return savedCredentials == other
+	}
+	if savedCredentials.Id != other.Id {
+		return false
+	}
+	if savedCredentials.Title != other.Title {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (savedCredentials *SavedCredentials) Diff(other *SavedCredentials) []Change {
+	return savedCredentials.diff("", other, nil)
+}
+
+func (savedCredentials *SavedCredentials) diff(path string, other *SavedCredentials, changes []Change) []Change {
+	if savedCredentials == nil || other == nil {
+		if savedCredentials != other {
+			changes = append(changes, Change{Path: path, Old: savedCredentials, New: other})
+		}
+		return changes
+	}
+	if savedCredentials.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: savedCredentials.Id, New: other.Id})
+	}
+	if savedCredentials.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: savedCredentials.Title, New: other.Title})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (savedCredentials *SavedCredentials) String() string {
+	return objectString(savedCredentials)
+}
+
+// LogValue logs the object as its summary
+func (savedCredentials *SavedCredentials) LogValue() slog.Value {
+	return slog.StringValue(objectString(savedCredentials))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (savedCredentials *SavedCredentials) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, savedCredentials)
+}
+
+func (savedCredentials *SavedCredentials) printText(p *printer) {
+	if savedCredentials == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SavedCredentials")
+	p.string("id", savedCredentials.Id)
+	p.string("title", savedCredentials.Title)
+	p.endObject(state)
+}
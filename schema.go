@@ -0,0 +1,305 @@
+package tdlib
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// MethodSchema describes a TDLib function, generated from td_api.tl
+type MethodSchema struct {
+	Name        string        // @type of the request, e.g. getChat
+	Description string        // Description of the function
+	Params      []ParamSchema // Parameters of the function
+	Result      string        // TL type of the result, e.g. Chat or AuthorizationState
+	Synchronous bool          // True, if the function can also be executed without a client with its Execute function
+}
+
+// ParamSchema describes a parameter of a function or a field of a type
+type ParamSchema struct {
+	Name        string // Name of the parameter in JSON, e.g. chat_id
+	Type        string // TL type of the parameter, e.g. int53, vector<int53>, formattedText or MessageContent
+	Description string // Description of the parameter
+}
+
+// TypeSchema describes a TDLib type, generated from td_api.tl
+type TypeSchema struct {
+	Name        string        // @type of the object, e.g. chat
+	Class       string        // Abstract class the type belongs to, or its TL result type if it's a standalone object, e.g. Chat
+	Description string        // Description of the type
+	Fields      []ParamSchema // Fields of the type
+}
+
+// ClassSchema describes an abstract TDLib class, generated from td_api.tl
+type ClassSchema struct {
+	Name        string   // Name of the class, e.g. MessageContent
+	Description string   // Description of the class
+	Types       []string // @type of every type of the class
+}
+
+var (
+	schemaIndexOnce sync.Once
+	methodsByName   map[string]*MethodSchema
+	typesByName     map[string]*TypeSchema
+	typesByClass    map[string]*TypeSchema
+	classesByName   map[string]*ClassSchema
+)
+
+// indexSchemas builds the lookup maps of the generated schemas
+func indexSchemas() {
+	schemaIndexOnce.Do(func() {
+		methodsByName = make(map[string]*MethodSchema, len(methodSchemas))
+		for _, method := range methodSchemas {
+			methodsByName[method.Name] = method
+		}
+		classesByName = make(map[string]*ClassSchema, len(classSchemas))
+		for _, class := range classSchemas {
+			classesByName[class.Name] = class
+		}
+		typesByName = make(map[string]*TypeSchema, len(typeSchemas))
+		typesByClass = make(map[string]*TypeSchema)
+		for _, t := range typeSchemas {
+			typesByName[t.Name] = t
+			if _, isAbstract := classesByName[t.Class]; !isAbstract {
+				typesByClass[t.Class] = t
+			}
+		}
+	})
+}
+
+// Methods returns the schema of every TDLib function, in td_api.tl order
+func Methods() []*MethodSchema {
+	return methodSchemas
+}
+
+// LookupMethod returns the schema of a TDLib function by its @type, e.g. getChat
+func LookupMethod(name string) (*MethodSchema, bool) {
+	indexSchemas()
+	method, ok := methodsByName[name]
+	return method, ok
+}
+
+// Types returns the schema of every TDLib type, in td_api.tl order
+func Types() []*TypeSchema {
+	return typeSchemas
+}
+
+// LookupType returns the schema of a TDLib type by its @type, e.g. chat
+func LookupType(name string) (*TypeSchema, bool) {
+	indexSchemas()
+	t, ok := typesByName[name]
+	return t, ok
+}
+
+// Classes returns the schema of every abstract TDLib class, in td_api.tl order
+func Classes() []*ClassSchema {
+	return classSchemas
+}
+
+// LookupClass returns the schema of an abstract TDLib class by its name, e.g. MessageContent
+func LookupClass(name string) (*ClassSchema, bool) {
+	indexSchemas()
+	class, ok := classesByName[name]
+	return class, ok
+}
+
+// Validate checks the parameters of a request against the schema of the function: every parameter
+// must be known and have a value of its type. Missing parameters are left to TDLib, which uses their
+// default values. Params hold JSON values, as decoded into UpdateData.
+func (method *MethodSchema) Validate(params UpdateData) error {
+	if err := validateFields(method.Name, method.Params, params); err != nil {
+		return fmt.Errorf("%s: %v", method.Name, err)
+	}
+	return nil
+}
+
+// DecodeResult decodes the response to a request into the generated type of the result, e.g. *Chat for getChat
+func (method *MethodSchema) DecodeResult(rawMsg []byte) (TdMessage, error) {
+	result, err := UnmarshalTdMessage(rawMsg)
+	if err != nil {
+		return nil, err
+	}
+	if _, isUnknown := result.(*Unknown); isUnknown {
+		return result, nil
+	}
+
+	if t, ok := LookupType(result.MessageType()); !ok || t.Class != method.Result {
+		return nil, fmt.Errorf("%s: unexpected result of type %s, expected %s", method.Name, result.MessageType(), method.Result)
+	}
+	return result, nil
+}
+
+// validateFields checks the fields of an object or the parameters of a request
+func validateFields(typeName string, fields []ParamSchema, values map[string]interface{}) error {
+	for key, value := range values {
+		switch key {
+		case "@type":
+			if value != typeName {
+				return fmt.Errorf("@type is %v, expected %s", value, typeName)
+			}
+			continue
+		case "@extra":
+			continue
+		}
+
+		field, ok := findField(fields, key)
+		if !ok {
+			return fmt.Errorf("unknown parameter %s", key)
+		}
+		if err := validateValue(field.Type, value); err != nil {
+			return fmt.Errorf("%s: %v", key, err)
+		}
+	}
+	return nil
+}
+
+func findField(fields []ParamSchema, name string) (ParamSchema, bool) {
+	for _, field := range fields {
+		if field.Name == name {
+			return field, true
+		}
+	}
+	return ParamSchema{}, false
+}
+
+// validateValue checks that a JSON value has the given TL type
+func validateValue(tlType string, value interface{}) error {
+	if strings.HasPrefix(tlType, "vector<") {
+		if value == nil {
+			return nil
+		}
+		items, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("expected %s, got %s", tlType, jsonKind(value))
+		}
+		elemType := tlType[len("vector<") : len(tlType)-1]
+		for i, item := range items {
+			if err := validateValue(elemType, item); err != nil {
+				return fmt.Errorf("[%d]: %v", i, err)
+			}
+		}
+		return nil
+	}
+
+	switch tlType {
+	case "int32", "int53", "int64":
+		return validateInteger(tlType, value)
+	case "double":
+		if number, ok := value.(json.Number); ok {
+			if _, err := number.Float64(); err == nil {
+				return nil
+			}
+		}
+	case "string":
+		if _, ok := value.(string); ok {
+			return nil
+		}
+	case "bytes":
+		if s, ok := value.(string); ok {
+			if _, err := base64.StdEncoding.DecodeString(s); err != nil {
+				return fmt.Errorf("expected base64 encoded bytes: %v", err)
+			}
+			return nil
+		}
+	case "Bool":
+		if _, ok := value.(bool); ok {
+			return nil
+		}
+	default:
+		return validateObject(tlType, value)
+	}
+
+	return fmt.Errorf("expected %s, got %s", tlType, jsonKind(value))
+}
+
+// validateInteger checks that a JSON value is an integer in the range of the TL type;
+// 64-bit integers may also be strings, like TDLib sends them
+func validateInteger(tlType string, value interface{}) error {
+	var text string
+	switch value := value.(type) {
+	case json.Number:
+		text = string(value)
+	case string:
+		if tlType == "int32" {
+			return fmt.Errorf("expected %s, got string", tlType)
+		}
+		text = value
+	default:
+		return fmt.Errorf("expected %s, got %s", tlType, jsonKind(value))
+	}
+
+	i, err := strconv.ParseInt(text, 10, 64)
+	if err != nil {
+		return fmt.Errorf("expected %s, got %s", tlType, text)
+	}
+	if tlType == "int32" && (i < math.MinInt32 || i > math.MaxInt32) {
+		return fmt.Errorf("%d overflows int32", i)
+	}
+	return nil
+}
+
+// validateObject checks that a JSON value is an object of the given TL type or abstract class
+func validateObject(tlType string, value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("expected %s, got %s", tlType, jsonKind(value))
+	}
+	typeName, _ := object["@type"].(string)
+
+	indexSchemas()
+	t, ok := typesByName[tlType]
+	if !ok {
+		t, ok = typesByClass[tlType]
+	}
+	if !ok {
+		class, isClass := classesByName[tlType]
+		if !isClass {
+			return fmt.Errorf("unknown type %s", tlType)
+		}
+		if typeName == "" {
+			return fmt.Errorf("missing @type of %s", tlType)
+		}
+		if t, ok = typesByName[typeName]; !ok || t.Class != class.Name {
+			return fmt.Errorf("%s is not %s %s", typeName, article(class.Name), class.Name)
+		}
+	}
+
+	if err := validateFields(t.Name, t.Fields, object); err != nil {
+		return fmt.Errorf("%s: %v", t.Name, err)
+	}
+	return nil
+}
+
+// jsonKind names the kind of a JSON value for error messages
+func jsonKind(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case json.Number, float64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// article returns the indefinite article of a class name
+func article(name string) string {
+	if name != "" && strings.ContainsRune("AEIOU", rune(name[0])) {
+		return "an"
+	}
+	return "a"
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ScopeNotificationSettings Contains information about notification settings for several chats
@@ -40,6 +40,152 @@ func NewScopeNotificationSettings(muteFor int32, sound string, showPreview bool,
 	return &scopeNotificationSettingsTemp
 }
 
+// MarshalJSON marshals to json
+func (scopeNotificationSettings *ScopeNotificationSettings) MarshalJSON() ([]byte, error) {
+	return scopeNotificationSettings.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (scopeNotificationSettings *ScopeNotificationSettings) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, scopeNotificationSettings)
+}
+
+func (scopeNotificationSettings *ScopeNotificationSettings) appendJSON(b []byte) ([]byte, error) {
+	if scopeNotificationSettings == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, scopeNotificationSettings.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, scopeNotificationSettings.tdCommon.Extra)
+	b = append(b, `,"mute_for":`...)
+	b = appendJSONInt(b, int64(scopeNotificationSettings.MuteFor))
+	b = append(b, `,"sound":`...)
+	b = appendJSONString(b, scopeNotificationSettings.Sound)
+	b = append(b, `,"show_preview":`...)
+	b = appendJSONBool(b, scopeNotificationSettings.ShowPreview)
+	b = append(b, `,"disable_pinned_message_notifications":`...)
+	b = appendJSONBool(b, scopeNotificationSettings.DisablePinnedMessageNotifications)
+	b = append(b, `,"disable_mention_notifications":`...)
+	b = appendJSONBool(b, scopeNotificationSettings.DisableMentionNotifications)
+	return append(b, '}'), nil
+}
+
+func (scopeNotificationSettings *ScopeNotificationSettings) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			scopeNotificationSettings.tdCommon.Type, err = r.readString()
+		case "@extra":
+			scopeNotificationSettings.tdCommon.Extra, err = r.readString()
+		case "mute_for":
+			scopeNotificationSettings.MuteFor, err = r.readInt32()
+		case "sound":
+			scopeNotificationSettings.Sound, err = r.readString()
+		case "show_preview":
+			scopeNotificationSettings.ShowPreview, err = r.readBool()
+		case "disable_pinned_message_notifications":
+			scopeNotificationSettings.DisablePinnedMessageNotifications, err = r.readBool()
+		case "disable_mention_notifications":
+			scopeNotificationSettings.DisableMentionNotifications, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (scopeNotificationSettings *ScopeNotificationSettings) Clone() *ScopeNotificationSettings {
+	if scopeNotificationSettings == nil {
+		return nil
+	}
+	clone := *scopeNotificationSettings
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (scopeNotificationSettings *ScopeNotificationSettings) Equal(other *ScopeNotificationSettings) bool {
+	if scopeNotificationSettings == nil || other == nil {
+		return scopeNotificationSettings == other
+	}
+	if scopeNotificationSettings.MuteFor != other.MuteFor {
+		return false
+	}
+	if scopeNotificationSettings.Sound != other.Sound {
+		return false
+	}
+	if scopeNotificationSettings.ShowPreview != other.ShowPreview {
+		return false
+	}
+	if scopeNotificationSettings.DisablePinnedMessageNotifications != other.DisablePinnedMessageNotifications {
+		return false
+	}
+	if scopeNotificationSettings.DisableMentionNotifications != other.DisableMentionNotifications {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (scopeNotificationSettings *ScopeNotificationSettings) Diff(other *ScopeNotificationSettings) []Change {
+	return scopeNotificationSettings.diff("", other, nil)
+}
+
+func (scopeNotificationSettings *ScopeNotificationSettings) diff(path string, other *ScopeNotificationSettings, changes []Change) []Change {
+	if scopeNotificationSettings == nil || other == nil {
+		if scopeNotificationSettings != other {
+			changes = append(changes, Change{Path: path, Old: scopeNotificationSettings, New: other})
+		}
+		return changes
+	}
+	if scopeNotificationSettings.MuteFor != other.MuteFor {
+		changes = append(changes, Change{Path: fieldPath(path, "mute_for"), Old: scopeNotificationSettings.MuteFor, New: other.MuteFor})
+	}
+	if scopeNotificationSettings.Sound != other.Sound {
+		changes = append(changes, Change{Path: fieldPath(path, "sound"), Old: scopeNotificationSettings.Sound, New: other.Sound})
+	}
+	if scopeNotificationSettings.ShowPreview != other.ShowPreview {
+		changes = append(changes, Change{Path: fieldPath(path, "show_preview"), Old: scopeNotificationSettings.ShowPreview, New: other.ShowPreview})
+	}
+	if scopeNotificationSettings.DisablePinnedMessageNotifications != other.DisablePinnedMessageNotifications {
+		changes = append(changes, Change{Path: fieldPath(path, "disable_pinned_message_notifications"), Old: scopeNotificationSettings.DisablePinnedMessageNotifications, New: other.DisablePinnedMessageNotifications})
+	}
+	if scopeNotificationSettings.DisableMentionNotifications != other.DisableMentionNotifications {
+		changes = append(changes, Change{Path: fieldPath(path, "disable_mention_notifications"), Old: scopeNotificationSettings.DisableMentionNotifications, New: other.DisableMentionNotifications})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (scopeNotificationSettings *ScopeNotificationSettings) String() string {
+	return objectString(scopeNotificationSettings)
+}
+
+// LogValue logs the object as its summary
+func (scopeNotificationSettings *ScopeNotificationSettings) LogValue() slog.Value {
+	return slog.StringValue(objectString(scopeNotificationSettings))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (scopeNotificationSettings *ScopeNotificationSettings) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, scopeNotificationSettings)
+}
+
+func (scopeNotificationSettings *ScopeNotificationSettings) printText(p *printer) {
+	if scopeNotificationSettings == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ScopeNotificationSettings")
+	p.int("mute_for", int64(scopeNotificationSettings.MuteFor))
+	p.string("sound", scopeNotificationSettings.Sound)
+	p.bool("show_preview", scopeNotificationSettings.ShowPreview)
+	p.bool("disable_pinned_message_notifications", scopeNotificationSettings.DisablePinnedMessageNotifications)
+	p.bool("disable_mention_notifications", scopeNotificationSettings.DisableMentionNotifications)
+	p.endObject(state)
+}
+
 // GetScopeNotificationSettings Returns the notification settings for chats of a given type
 // @param scope Types of chats for which to return the notification settings information
 func (client *Client) GetScopeNotificationSettings(scope NotificationSettingsScope) (*ScopeNotificationSettings, error) {
@@ -52,11 +198,11 @@ func (client *Client) GetScopeNotificationSettings(scope NotificationSettingsSco
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var scopeNotificationSettings ScopeNotificationSettings
-	err = json.Unmarshal(result.Raw, &scopeNotificationSettings)
+	err = jsonUnmarshal(result.Raw, &scopeNotificationSettings)
 	return &scopeNotificationSettings, err
 }
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // SearchMessagesFilter Represents a filter for message search results
 type SearchMessagesFilter interface {
 	GetSearchMessagesFilterEnum() SearchMessagesFilterEnum
+	Accept(visitor SearchMessagesFilterVisitor)
 }
 
 // SearchMessagesFilterEnum Alias for abstract SearchMessagesFilter 'Sub-Classes', used as constant-enum here
@@ -33,100 +35,143 @@ const (
 	SearchMessagesFilterPinnedType            SearchMessagesFilterEnum = "searchMessagesFilterPinned"
 )
 
+// UnknownSearchMessagesFilter is a SearchMessagesFilter of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownSearchMessagesFilter struct {
+	Unknown
+}
+
+// GetSearchMessagesFilterEnum return the enum type of this object
+func (unknownSearchMessagesFilter *UnknownSearchMessagesFilter) GetSearchMessagesFilterEnum() SearchMessagesFilterEnum {
+	return SearchMessagesFilterEnum(unknownSearchMessagesFilter.Type)
+}
+
+// Accept calls the method of the visitor for UnknownSearchMessagesFilter
+func (unknownSearchMessagesFilter *UnknownSearchMessagesFilter) Accept(visitor SearchMessagesFilterVisitor) {
+	visitor.VisitUnknownSearchMessagesFilter(unknownSearchMessagesFilter)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownSearchMessagesFilter *UnknownSearchMessagesFilter) String() string {
+	return objectString(unknownSearchMessagesFilter)
+}
+
+// LogValue logs the object as its summary
+func (unknownSearchMessagesFilter *UnknownSearchMessagesFilter) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownSearchMessagesFilter))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownSearchMessagesFilter *UnknownSearchMessagesFilter) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownSearchMessagesFilter)
+}
+
+func (unknownSearchMessagesFilter *UnknownSearchMessagesFilter) printText(p *printer) {
+	if unknownSearchMessagesFilter == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownSearchMessagesFilter")
+	p.string("@type", unknownSearchMessagesFilter.Type)
+	p.endObject(state)
+}
+
 func unmarshalSearchMessagesFilter(rawMsg *json.RawMessage) (SearchMessagesFilter, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch SearchMessagesFilterEnum(objMap["@type"].(string)) {
+	switch SearchMessagesFilterEnum(typeName) {
 	case SearchMessagesFilterEmptyType:
 		var searchMessagesFilterEmpty SearchMessagesFilterEmpty
-		err := json.Unmarshal(*rawMsg, &searchMessagesFilterEmpty)
+		err := jsonUnmarshal(*rawMsg, &searchMessagesFilterEmpty)
 		return &searchMessagesFilterEmpty, err
 
 	case SearchMessagesFilterAnimationType:
 		var searchMessagesFilterAnimation SearchMessagesFilterAnimation
-		err := json.Unmarshal(*rawMsg, &searchMessagesFilterAnimation)
+		err := jsonUnmarshal(*rawMsg, &searchMessagesFilterAnimation)
 		return &searchMessagesFilterAnimation, err
 
 	case SearchMessagesFilterAudioType:
 		var searchMessagesFilterAudio SearchMessagesFilterAudio
-		err := json.Unmarshal(*rawMsg, &searchMessagesFilterAudio)
+		err := jsonUnmarshal(*rawMsg, &searchMessagesFilterAudio)
 		return &searchMessagesFilterAudio, err
 
 	case SearchMessagesFilterDocumentType:
 		var searchMessagesFilterDocument SearchMessagesFilterDocument
-		err := json.Unmarshal(*rawMsg, &searchMessagesFilterDocument)
+		err := jsonUnmarshal(*rawMsg, &searchMessagesFilterDocument)
 		return &searchMessagesFilterDocument, err
 
 	case SearchMessagesFilterPhotoType:
 		var searchMessagesFilterPhoto SearchMessagesFilterPhoto
-		err := json.Unmarshal(*rawMsg, &searchMessagesFilterPhoto)
+		err := jsonUnmarshal(*rawMsg, &searchMessagesFilterPhoto)
 		return &searchMessagesFilterPhoto, err
 
 	case SearchMessagesFilterVideoType:
 		var searchMessagesFilterVideo SearchMessagesFilterVideo
-		err := json.Unmarshal(*rawMsg, &searchMessagesFilterVideo)
+		err := jsonUnmarshal(*rawMsg, &searchMessagesFilterVideo)
 		return &searchMessagesFilterVideo, err
 
 	case SearchMessagesFilterVoiceNoteType:
 		var searchMessagesFilterVoiceNote SearchMessagesFilterVoiceNote
-		err := json.Unmarshal(*rawMsg, &searchMessagesFilterVoiceNote)
+		err := jsonUnmarshal(*rawMsg, &searchMessagesFilterVoiceNote)
 		return &searchMessagesFilterVoiceNote, err
 
 	case SearchMessagesFilterPhotoAndVideoType:
 		var searchMessagesFilterPhotoAndVideo SearchMessagesFilterPhotoAndVideo
-		err := json.Unmarshal(*rawMsg, &searchMessagesFilterPhotoAndVideo)
+		err := jsonUnmarshal(*rawMsg, &searchMessagesFilterPhotoAndVideo)
 		return &searchMessagesFilterPhotoAndVideo, err
 
 	case SearchMessagesFilterUrlType:
 		var searchMessagesFilterUrl SearchMessagesFilterUrl
-		err := json.Unmarshal(*rawMsg, &searchMessagesFilterUrl)
+		err := jsonUnmarshal(*rawMsg, &searchMessagesFilterUrl)
 		return &searchMessagesFilterUrl, err
 
 	case SearchMessagesFilterChatPhotoType:
 		var searchMessagesFilterChatPhoto SearchMessagesFilterChatPhoto
-		err := json.Unmarshal(*rawMsg, &searchMessagesFilterChatPhoto)
+		err := jsonUnmarshal(*rawMsg, &searchMessagesFilterChatPhoto)
 		return &searchMessagesFilterChatPhoto, err
 
 	case SearchMessagesFilterVideoNoteType:
 		var searchMessagesFilterVideoNote SearchMessagesFilterVideoNote
-		err := json.Unmarshal(*rawMsg, &searchMessagesFilterVideoNote)
+		err := jsonUnmarshal(*rawMsg, &searchMessagesFilterVideoNote)
 		return &searchMessagesFilterVideoNote, err
 
 	case SearchMessagesFilterVoiceAndVideoNoteType:
 		var searchMessagesFilterVoiceAndVideoNote SearchMessagesFilterVoiceAndVideoNote
-		err := json.Unmarshal(*rawMsg, &searchMessagesFilterVoiceAndVideoNote)
+		err := jsonUnmarshal(*rawMsg, &searchMessagesFilterVoiceAndVideoNote)
 		return &searchMessagesFilterVoiceAndVideoNote, err
 
 	case SearchMessagesFilterMentionType:
 		var searchMessagesFilterMention SearchMessagesFilterMention
-		err := json.Unmarshal(*rawMsg, &searchMessagesFilterMention)
+		err := jsonUnmarshal(*rawMsg, &searchMessagesFilterMention)
 		return &searchMessagesFilterMention, err
 
 	case SearchMessagesFilterUnreadMentionType:
 		var searchMessagesFilterUnreadMention SearchMessagesFilterUnreadMention
-		err := json.Unmarshal(*rawMsg, &searchMessagesFilterUnreadMention)
+		err := jsonUnmarshal(*rawMsg, &searchMessagesFilterUnreadMention)
 		return &searchMessagesFilterUnreadMention, err
 
 	case SearchMessagesFilterFailedToSendType:
 		var searchMessagesFilterFailedToSend SearchMessagesFilterFailedToSend
-		err := json.Unmarshal(*rawMsg, &searchMessagesFilterFailedToSend)
+		err := jsonUnmarshal(*rawMsg, &searchMessagesFilterFailedToSend)
 		return &searchMessagesFilterFailedToSend, err
 
 	case SearchMessagesFilterPinnedType:
 		var searchMessagesFilterPinned SearchMessagesFilterPinned
-		err := json.Unmarshal(*rawMsg, &searchMessagesFilterPinned)
+		err := jsonUnmarshal(*rawMsg, &searchMessagesFilterPinned)
 		return &searchMessagesFilterPinned, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownSearchMessagesFilter{Unknown: unknown}, nil
 	}
 }
 
@@ -141,7 +186,6 @@ func (searchMessagesFilterEmpty *SearchMessagesFilterEmpty) MessageType() string
 }
 
 // NewSearchMessagesFilterEmpty creates a new SearchMessagesFilterEmpty
-//
 func NewSearchMessagesFilterEmpty() *SearchMessagesFilterEmpty {
 	searchMessagesFilterEmptyTemp := SearchMessagesFilterEmpty{
 		tdCommon: tdCommon{Type: "searchMessagesFilterEmpty"},
@@ -150,11 +194,107 @@ func NewSearchMessagesFilterEmpty() *SearchMessagesFilterEmpty {
 	return &searchMessagesFilterEmptyTemp
 }
 
+// MarshalJSON marshals to json
+func (searchMessagesFilterEmpty *SearchMessagesFilterEmpty) MarshalJSON() ([]byte, error) {
+	return searchMessagesFilterEmpty.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (searchMessagesFilterEmpty *SearchMessagesFilterEmpty) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, searchMessagesFilterEmpty)
+}
+
+func (searchMessagesFilterEmpty *SearchMessagesFilterEmpty) appendJSON(b []byte) ([]byte, error) {
+	if searchMessagesFilterEmpty == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, searchMessagesFilterEmpty.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, searchMessagesFilterEmpty.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (searchMessagesFilterEmpty *SearchMessagesFilterEmpty) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			searchMessagesFilterEmpty.tdCommon.Type, err = r.readString()
+		case "@extra":
+			searchMessagesFilterEmpty.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (searchMessagesFilterEmpty *SearchMessagesFilterEmpty) Clone() *SearchMessagesFilterEmpty {
+	if searchMessagesFilterEmpty == nil {
+		return nil
+	}
+	clone := *searchMessagesFilterEmpty
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (searchMessagesFilterEmpty *SearchMessagesFilterEmpty) Equal(other *SearchMessagesFilterEmpty) bool {
+	if searchMessagesFilterEmpty == nil || other == nil {
+		return searchMessagesFilterEmpty == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (searchMessagesFilterEmpty *SearchMessagesFilterEmpty) Diff(other *SearchMessagesFilterEmpty) []Change {
+	return searchMessagesFilterEmpty.diff("", other, nil)
+}
+
+func (searchMessagesFilterEmpty *SearchMessagesFilterEmpty) diff(path string, other *SearchMessagesFilterEmpty, changes []Change) []Change {
+	if searchMessagesFilterEmpty == nil || other == nil {
+		if searchMessagesFilterEmpty != other {
+			changes = append(changes, Change{Path: path, Old: searchMessagesFilterEmpty, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (searchMessagesFilterEmpty *SearchMessagesFilterEmpty) String() string {
+	return objectString(searchMessagesFilterEmpty)
+}
+
+// LogValue logs the object as its summary
+func (searchMessagesFilterEmpty *SearchMessagesFilterEmpty) LogValue() slog.Value {
+	return slog.StringValue(objectString(searchMessagesFilterEmpty))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (searchMessagesFilterEmpty *SearchMessagesFilterEmpty) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, searchMessagesFilterEmpty)
+}
+
+func (searchMessagesFilterEmpty *SearchMessagesFilterEmpty) printText(p *printer) {
+	if searchMessagesFilterEmpty == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SearchMessagesFilterEmpty")
+	p.endObject(state)
+}
+
 // GetSearchMessagesFilterEnum return the enum type of this object
 func (searchMessagesFilterEmpty *SearchMessagesFilterEmpty) GetSearchMessagesFilterEnum() SearchMessagesFilterEnum {
 	return SearchMessagesFilterEmptyType
 }
 
+// Accept calls the method of the visitor for SearchMessagesFilterEmpty
+func (searchMessagesFilterEmpty *SearchMessagesFilterEmpty) Accept(visitor SearchMessagesFilterVisitor) {
+	visitor.VisitSearchMessagesFilterEmpty(searchMessagesFilterEmpty)
+}
+
 // SearchMessagesFilterAnimation Returns only animation messages
 type SearchMessagesFilterAnimation struct {
 	tdCommon
@@ -166,7 +306,6 @@ func (searchMessagesFilterAnimation *SearchMessagesFilterAnimation) MessageType(
 }
 
 // NewSearchMessagesFilterAnimation creates a new SearchMessagesFilterAnimation
-//
 func NewSearchMessagesFilterAnimation() *SearchMessagesFilterAnimation {
 	searchMessagesFilterAnimationTemp := SearchMessagesFilterAnimation{
 		tdCommon: tdCommon{Type: "searchMessagesFilterAnimation"},
@@ -175,11 +314,107 @@ func NewSearchMessagesFilterAnimation() *SearchMessagesFilterAnimation {
 	return &searchMessagesFilterAnimationTemp
 }
 
+// MarshalJSON marshals to json
+func (searchMessagesFilterAnimation *SearchMessagesFilterAnimation) MarshalJSON() ([]byte, error) {
+	return searchMessagesFilterAnimation.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (searchMessagesFilterAnimation *SearchMessagesFilterAnimation) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, searchMessagesFilterAnimation)
+}
+
+func (searchMessagesFilterAnimation *SearchMessagesFilterAnimation) appendJSON(b []byte) ([]byte, error) {
+	if searchMessagesFilterAnimation == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, searchMessagesFilterAnimation.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, searchMessagesFilterAnimation.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (searchMessagesFilterAnimation *SearchMessagesFilterAnimation) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			searchMessagesFilterAnimation.tdCommon.Type, err = r.readString()
+		case "@extra":
+			searchMessagesFilterAnimation.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (searchMessagesFilterAnimation *SearchMessagesFilterAnimation) Clone() *SearchMessagesFilterAnimation {
+	if searchMessagesFilterAnimation == nil {
+		return nil
+	}
+	clone := *searchMessagesFilterAnimation
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (searchMessagesFilterAnimation *SearchMessagesFilterAnimation) Equal(other *SearchMessagesFilterAnimation) bool {
+	if searchMessagesFilterAnimation == nil || other == nil {
+		return searchMessagesFilterAnimation == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (searchMessagesFilterAnimation *SearchMessagesFilterAnimation) Diff(other *SearchMessagesFilterAnimation) []Change {
+	return searchMessagesFilterAnimation.diff("", other, nil)
+}
+
+func (searchMessagesFilterAnimation *SearchMessagesFilterAnimation) diff(path string, other *SearchMessagesFilterAnimation, changes []Change) []Change {
+	if searchMessagesFilterAnimation == nil || other == nil {
+		if searchMessagesFilterAnimation != other {
+			changes = append(changes, Change{Path: path, Old: searchMessagesFilterAnimation, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (searchMessagesFilterAnimation *SearchMessagesFilterAnimation) String() string {
+	return objectString(searchMessagesFilterAnimation)
+}
+
+// LogValue logs the object as its summary
+func (searchMessagesFilterAnimation *SearchMessagesFilterAnimation) LogValue() slog.Value {
+	return slog.StringValue(objectString(searchMessagesFilterAnimation))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (searchMessagesFilterAnimation *SearchMessagesFilterAnimation) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, searchMessagesFilterAnimation)
+}
+
+func (searchMessagesFilterAnimation *SearchMessagesFilterAnimation) printText(p *printer) {
+	if searchMessagesFilterAnimation == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SearchMessagesFilterAnimation")
+	p.endObject(state)
+}
+
 // GetSearchMessagesFilterEnum return the enum type of this object
 func (searchMessagesFilterAnimation *SearchMessagesFilterAnimation) GetSearchMessagesFilterEnum() SearchMessagesFilterEnum {
 	return SearchMessagesFilterAnimationType
 }
 
+// Accept calls the method of the visitor for SearchMessagesFilterAnimation
+func (searchMessagesFilterAnimation *SearchMessagesFilterAnimation) Accept(visitor SearchMessagesFilterVisitor) {
+	visitor.VisitSearchMessagesFilterAnimation(searchMessagesFilterAnimation)
+}
+
 // SearchMessagesFilterAudio Returns only audio messages
 type SearchMessagesFilterAudio struct {
 	tdCommon
@@ -191,7 +426,6 @@ func (searchMessagesFilterAudio *SearchMessagesFilterAudio) MessageType() string
 }
 
 // NewSearchMessagesFilterAudio creates a new SearchMessagesFilterAudio
-//
 func NewSearchMessagesFilterAudio() *SearchMessagesFilterAudio {
 	searchMessagesFilterAudioTemp := SearchMessagesFilterAudio{
 		tdCommon: tdCommon{Type: "searchMessagesFilterAudio"},
@@ -200,11 +434,107 @@ func NewSearchMessagesFilterAudio() *SearchMessagesFilterAudio {
 	return &searchMessagesFilterAudioTemp
 }
 
+// MarshalJSON marshals to json
+func (searchMessagesFilterAudio *SearchMessagesFilterAudio) MarshalJSON() ([]byte, error) {
+	return searchMessagesFilterAudio.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (searchMessagesFilterAudio *SearchMessagesFilterAudio) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, searchMessagesFilterAudio)
+}
+
+func (searchMessagesFilterAudio *SearchMessagesFilterAudio) appendJSON(b []byte) ([]byte, error) {
+	if searchMessagesFilterAudio == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, searchMessagesFilterAudio.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, searchMessagesFilterAudio.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (searchMessagesFilterAudio *SearchMessagesFilterAudio) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			searchMessagesFilterAudio.tdCommon.Type, err = r.readString()
+		case "@extra":
+			searchMessagesFilterAudio.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (searchMessagesFilterAudio *SearchMessagesFilterAudio) Clone() *SearchMessagesFilterAudio {
+	if searchMessagesFilterAudio == nil {
+		return nil
+	}
+	clone := *searchMessagesFilterAudio
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (searchMessagesFilterAudio *SearchMessagesFilterAudio) Equal(other *SearchMessagesFilterAudio) bool {
+	if searchMessagesFilterAudio == nil || other == nil {
+		return searchMessagesFilterAudio == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (searchMessagesFilterAudio *SearchMessagesFilterAudio) Diff(other *SearchMessagesFilterAudio) []Change {
+	return searchMessagesFilterAudio.diff("", other, nil)
+}
+
+func (searchMessagesFilterAudio *SearchMessagesFilterAudio) diff(path string, other *SearchMessagesFilterAudio, changes []Change) []Change {
+	if searchMessagesFilterAudio == nil || other == nil {
+		if searchMessagesFilterAudio != other {
+			changes = append(changes, Change{Path: path, Old: searchMessagesFilterAudio, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (searchMessagesFilterAudio *SearchMessagesFilterAudio) String() string {
+	return objectString(searchMessagesFilterAudio)
+}
+
+// LogValue logs the object as its summary
+func (searchMessagesFilterAudio *SearchMessagesFilterAudio) LogValue() slog.Value {
+	return slog.StringValue(objectString(searchMessagesFilterAudio))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (searchMessagesFilterAudio *SearchMessagesFilterAudio) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, searchMessagesFilterAudio)
+}
+
+func (searchMessagesFilterAudio *SearchMessagesFilterAudio) printText(p *printer) {
+	if searchMessagesFilterAudio == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SearchMessagesFilterAudio")
+	p.endObject(state)
+}
+
 // GetSearchMessagesFilterEnum return the enum type of this object
 func (searchMessagesFilterAudio *SearchMessagesFilterAudio) GetSearchMessagesFilterEnum() SearchMessagesFilterEnum {
 	return SearchMessagesFilterAudioType
 }
 
+// Accept calls the method of the visitor for SearchMessagesFilterAudio
+func (searchMessagesFilterAudio *SearchMessagesFilterAudio) Accept(visitor SearchMessagesFilterVisitor) {
+	visitor.VisitSearchMessagesFilterAudio(searchMessagesFilterAudio)
+}
+
 // SearchMessagesFilterDocument Returns only document messages
 type SearchMessagesFilterDocument struct {
 	tdCommon
@@ -216,7 +546,6 @@ func (searchMessagesFilterDocument *SearchMessagesFilterDocument) MessageType()
 }
 
 // NewSearchMessagesFilterDocument creates a new SearchMessagesFilterDocument
-//
 func NewSearchMessagesFilterDocument() *SearchMessagesFilterDocument {
 	searchMessagesFilterDocumentTemp := SearchMessagesFilterDocument{
 		tdCommon: tdCommon{Type: "searchMessagesFilterDocument"},
@@ -225,11 +554,107 @@ func NewSearchMessagesFilterDocument() *SearchMessagesFilterDocument {
 	return &searchMessagesFilterDocumentTemp
 }
 
+// MarshalJSON marshals to json
+func (searchMessagesFilterDocument *SearchMessagesFilterDocument) MarshalJSON() ([]byte, error) {
+	return searchMessagesFilterDocument.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (searchMessagesFilterDocument *SearchMessagesFilterDocument) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, searchMessagesFilterDocument)
+}
+
+func (searchMessagesFilterDocument *SearchMessagesFilterDocument) appendJSON(b []byte) ([]byte, error) {
+	if searchMessagesFilterDocument == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, searchMessagesFilterDocument.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, searchMessagesFilterDocument.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (searchMessagesFilterDocument *SearchMessagesFilterDocument) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			searchMessagesFilterDocument.tdCommon.Type, err = r.readString()
+		case "@extra":
+			searchMessagesFilterDocument.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (searchMessagesFilterDocument *SearchMessagesFilterDocument) Clone() *SearchMessagesFilterDocument {
+	if searchMessagesFilterDocument == nil {
+		return nil
+	}
+	clone := *searchMessagesFilterDocument
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (searchMessagesFilterDocument *SearchMessagesFilterDocument) Equal(other *SearchMessagesFilterDocument) bool {
+	if searchMessagesFilterDocument == nil || other == nil {
+		return searchMessagesFilterDocument == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (searchMessagesFilterDocument *SearchMessagesFilterDocument) Diff(other *SearchMessagesFilterDocument) []Change {
+	return searchMessagesFilterDocument.diff("", other, nil)
+}
+
+func (searchMessagesFilterDocument *SearchMessagesFilterDocument) diff(path string, other *SearchMessagesFilterDocument, changes []Change) []Change {
+	if searchMessagesFilterDocument == nil || other == nil {
+		if searchMessagesFilterDocument != other {
+			changes = append(changes, Change{Path: path, Old: searchMessagesFilterDocument, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (searchMessagesFilterDocument *SearchMessagesFilterDocument) String() string {
+	return objectString(searchMessagesFilterDocument)
+}
+
+// LogValue logs the object as its summary
+func (searchMessagesFilterDocument *SearchMessagesFilterDocument) LogValue() slog.Value {
+	return slog.StringValue(objectString(searchMessagesFilterDocument))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (searchMessagesFilterDocument *SearchMessagesFilterDocument) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, searchMessagesFilterDocument)
+}
+
+func (searchMessagesFilterDocument *SearchMessagesFilterDocument) printText(p *printer) {
+	if searchMessagesFilterDocument == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SearchMessagesFilterDocument")
+	p.endObject(state)
+}
+
 // GetSearchMessagesFilterEnum return the enum type of this object
 func (searchMessagesFilterDocument *SearchMessagesFilterDocument) GetSearchMessagesFilterEnum() SearchMessagesFilterEnum {
 	return SearchMessagesFilterDocumentType
 }
 
+// Accept calls the method of the visitor for SearchMessagesFilterDocument
+func (searchMessagesFilterDocument *SearchMessagesFilterDocument) Accept(visitor SearchMessagesFilterVisitor) {
+	visitor.VisitSearchMessagesFilterDocument(searchMessagesFilterDocument)
+}
+
 // SearchMessagesFilterPhoto Returns only photo messages
 type SearchMessagesFilterPhoto struct {
 	tdCommon
@@ -241,7 +666,6 @@ func (searchMessagesFilterPhoto *SearchMessagesFilterPhoto) MessageType() string
 }
 
 // NewSearchMessagesFilterPhoto creates a new SearchMessagesFilterPhoto
-//
 func NewSearchMessagesFilterPhoto() *SearchMessagesFilterPhoto {
 	searchMessagesFilterPhotoTemp := SearchMessagesFilterPhoto{
 		tdCommon: tdCommon{Type: "searchMessagesFilterPhoto"},
@@ -250,11 +674,107 @@ func NewSearchMessagesFilterPhoto() *SearchMessagesFilterPhoto {
 	return &searchMessagesFilterPhotoTemp
 }
 
+// MarshalJSON marshals to json
+func (searchMessagesFilterPhoto *SearchMessagesFilterPhoto) MarshalJSON() ([]byte, error) {
+	return searchMessagesFilterPhoto.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (searchMessagesFilterPhoto *SearchMessagesFilterPhoto) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, searchMessagesFilterPhoto)
+}
+
+func (searchMessagesFilterPhoto *SearchMessagesFilterPhoto) appendJSON(b []byte) ([]byte, error) {
+	if searchMessagesFilterPhoto == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, searchMessagesFilterPhoto.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, searchMessagesFilterPhoto.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (searchMessagesFilterPhoto *SearchMessagesFilterPhoto) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			searchMessagesFilterPhoto.tdCommon.Type, err = r.readString()
+		case "@extra":
+			searchMessagesFilterPhoto.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (searchMessagesFilterPhoto *SearchMessagesFilterPhoto) Clone() *SearchMessagesFilterPhoto {
+	if searchMessagesFilterPhoto == nil {
+		return nil
+	}
+	clone := *searchMessagesFilterPhoto
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (searchMessagesFilterPhoto *SearchMessagesFilterPhoto) Equal(other *SearchMessagesFilterPhoto) bool {
+	if searchMessagesFilterPhoto == nil || other == nil {
+		return searchMessagesFilterPhoto == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (searchMessagesFilterPhoto *SearchMessagesFilterPhoto) Diff(other *SearchMessagesFilterPhoto) []Change {
+	return searchMessagesFilterPhoto.diff("", other, nil)
+}
+
+func (searchMessagesFilterPhoto *SearchMessagesFilterPhoto) diff(path string, other *SearchMessagesFilterPhoto, changes []Change) []Change {
+	if searchMessagesFilterPhoto == nil || other == nil {
+		if searchMessagesFilterPhoto != other {
+			changes = append(changes, Change{Path: path, Old: searchMessagesFilterPhoto, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (searchMessagesFilterPhoto *SearchMessagesFilterPhoto) String() string {
+	return objectString(searchMessagesFilterPhoto)
+}
+
+// LogValue logs the object as its summary
+func (searchMessagesFilterPhoto *SearchMessagesFilterPhoto) LogValue() slog.Value {
+	return slog.StringValue(objectString(searchMessagesFilterPhoto))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (searchMessagesFilterPhoto *SearchMessagesFilterPhoto) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, searchMessagesFilterPhoto)
+}
+
+func (searchMessagesFilterPhoto *SearchMessagesFilterPhoto) printText(p *printer) {
+	if searchMessagesFilterPhoto == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SearchMessagesFilterPhoto")
+	p.endObject(state)
+}
+
 // GetSearchMessagesFilterEnum return the enum type of this object
 func (searchMessagesFilterPhoto *SearchMessagesFilterPhoto) GetSearchMessagesFilterEnum() SearchMessagesFilterEnum {
 	return SearchMessagesFilterPhotoType
 }
 
+// Accept calls the method of the visitor for SearchMessagesFilterPhoto
+func (searchMessagesFilterPhoto *SearchMessagesFilterPhoto) Accept(visitor SearchMessagesFilterVisitor) {
+	visitor.VisitSearchMessagesFilterPhoto(searchMessagesFilterPhoto)
+}
+
 // SearchMessagesFilterVideo Returns only video messages
 type SearchMessagesFilterVideo struct {
 	tdCommon
@@ -266,7 +786,6 @@ func (searchMessagesFilterVideo *SearchMessagesFilterVideo) MessageType() string
 }
 
 // NewSearchMessagesFilterVideo creates a new SearchMessagesFilterVideo
-//
 func NewSearchMessagesFilterVideo() *SearchMessagesFilterVideo {
 	searchMessagesFilterVideoTemp := SearchMessagesFilterVideo{
 		tdCommon: tdCommon{Type: "searchMessagesFilterVideo"},
@@ -275,11 +794,107 @@ func NewSearchMessagesFilterVideo() *SearchMessagesFilterVideo {
 	return &searchMessagesFilterVideoTemp
 }
 
+// MarshalJSON marshals to json
+func (searchMessagesFilterVideo *SearchMessagesFilterVideo) MarshalJSON() ([]byte, error) {
+	return searchMessagesFilterVideo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (searchMessagesFilterVideo *SearchMessagesFilterVideo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, searchMessagesFilterVideo)
+}
+
+func (searchMessagesFilterVideo *SearchMessagesFilterVideo) appendJSON(b []byte) ([]byte, error) {
+	if searchMessagesFilterVideo == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, searchMessagesFilterVideo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, searchMessagesFilterVideo.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (searchMessagesFilterVideo *SearchMessagesFilterVideo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			searchMessagesFilterVideo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			searchMessagesFilterVideo.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (searchMessagesFilterVideo *SearchMessagesFilterVideo) Clone() *SearchMessagesFilterVideo {
+	if searchMessagesFilterVideo == nil {
+		return nil
+	}
+	clone := *searchMessagesFilterVideo
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (searchMessagesFilterVideo *SearchMessagesFilterVideo) Equal(other *SearchMessagesFilterVideo) bool {
+	if searchMessagesFilterVideo == nil || other == nil {
+		return searchMessagesFilterVideo == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (searchMessagesFilterVideo *SearchMessagesFilterVideo) Diff(other *SearchMessagesFilterVideo) []Change {
+	return searchMessagesFilterVideo.diff("", other, nil)
+}
+
+func (searchMessagesFilterVideo *SearchMessagesFilterVideo) diff(path string, other *SearchMessagesFilterVideo, changes []Change) []Change {
+	if searchMessagesFilterVideo == nil || other == nil {
+		if searchMessagesFilterVideo != other {
+			changes = append(changes, Change{Path: path, Old: searchMessagesFilterVideo, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (searchMessagesFilterVideo *SearchMessagesFilterVideo) String() string {
+	return objectString(searchMessagesFilterVideo)
+}
+
+// LogValue logs the object as its summary
+func (searchMessagesFilterVideo *SearchMessagesFilterVideo) LogValue() slog.Value {
+	return slog.StringValue(objectString(searchMessagesFilterVideo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (searchMessagesFilterVideo *SearchMessagesFilterVideo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, searchMessagesFilterVideo)
+}
+
+func (searchMessagesFilterVideo *SearchMessagesFilterVideo) printText(p *printer) {
+	if searchMessagesFilterVideo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SearchMessagesFilterVideo")
+	p.endObject(state)
+}
+
 // GetSearchMessagesFilterEnum return the enum type of this object
 func (searchMessagesFilterVideo *SearchMessagesFilterVideo) GetSearchMessagesFilterEnum() SearchMessagesFilterEnum {
 	return SearchMessagesFilterVideoType
 }
 
+// Accept calls the method of the visitor for SearchMessagesFilterVideo
+func (searchMessagesFilterVideo *SearchMessagesFilterVideo) Accept(visitor SearchMessagesFilterVisitor) {
+	visitor.VisitSearchMessagesFilterVideo(searchMessagesFilterVideo)
+}
+
 // SearchMessagesFilterVoiceNote Returns only voice note messages
 type SearchMessagesFilterVoiceNote struct {
 	tdCommon
@@ -291,7 +906,6 @@ func (searchMessagesFilterVoiceNote *SearchMessagesFilterVoiceNote) MessageType(
 }
 
 // NewSearchMessagesFilterVoiceNote creates a new SearchMessagesFilterVoiceNote
-//
 func NewSearchMessagesFilterVoiceNote() *SearchMessagesFilterVoiceNote {
 	searchMessagesFilterVoiceNoteTemp := SearchMessagesFilterVoiceNote{
 		tdCommon: tdCommon{Type: "searchMessagesFilterVoiceNote"},
@@ -300,11 +914,107 @@ func NewSearchMessagesFilterVoiceNote() *SearchMessagesFilterVoiceNote {
 	return &searchMessagesFilterVoiceNoteTemp
 }
 
+// MarshalJSON marshals to json
+func (searchMessagesFilterVoiceNote *SearchMessagesFilterVoiceNote) MarshalJSON() ([]byte, error) {
+	return searchMessagesFilterVoiceNote.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (searchMessagesFilterVoiceNote *SearchMessagesFilterVoiceNote) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, searchMessagesFilterVoiceNote)
+}
+
+func (searchMessagesFilterVoiceNote *SearchMessagesFilterVoiceNote) appendJSON(b []byte) ([]byte, error) {
+	if searchMessagesFilterVoiceNote == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, searchMessagesFilterVoiceNote.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, searchMessagesFilterVoiceNote.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (searchMessagesFilterVoiceNote *SearchMessagesFilterVoiceNote) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			searchMessagesFilterVoiceNote.tdCommon.Type, err = r.readString()
+		case "@extra":
+			searchMessagesFilterVoiceNote.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (searchMessagesFilterVoiceNote *SearchMessagesFilterVoiceNote) Clone() *SearchMessagesFilterVoiceNote {
+	if searchMessagesFilterVoiceNote == nil {
+		return nil
+	}
+	clone := *searchMessagesFilterVoiceNote
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (searchMessagesFilterVoiceNote *SearchMessagesFilterVoiceNote) Equal(other *SearchMessagesFilterVoiceNote) bool {
+	if searchMessagesFilterVoiceNote == nil || other == nil {
+		return searchMessagesFilterVoiceNote == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (searchMessagesFilterVoiceNote *SearchMessagesFilterVoiceNote) Diff(other *SearchMessagesFilterVoiceNote) []Change {
+	return searchMessagesFilterVoiceNote.diff("", other, nil)
+}
+
+func (searchMessagesFilterVoiceNote *SearchMessagesFilterVoiceNote) diff(path string, other *SearchMessagesFilterVoiceNote, changes []Change) []Change {
+	if searchMessagesFilterVoiceNote == nil || other == nil {
+		if searchMessagesFilterVoiceNote != other {
+			changes = append(changes, Change{Path: path, Old: searchMessagesFilterVoiceNote, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (searchMessagesFilterVoiceNote *SearchMessagesFilterVoiceNote) String() string {
+	return objectString(searchMessagesFilterVoiceNote)
+}
+
+// LogValue logs the object as its summary
+func (searchMessagesFilterVoiceNote *SearchMessagesFilterVoiceNote) LogValue() slog.Value {
+	return slog.StringValue(objectString(searchMessagesFilterVoiceNote))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (searchMessagesFilterVoiceNote *SearchMessagesFilterVoiceNote) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, searchMessagesFilterVoiceNote)
+}
+
+func (searchMessagesFilterVoiceNote *SearchMessagesFilterVoiceNote) printText(p *printer) {
+	if searchMessagesFilterVoiceNote == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SearchMessagesFilterVoiceNote")
+	p.endObject(state)
+}
+
 // GetSearchMessagesFilterEnum return the enum type of this object
 func (searchMessagesFilterVoiceNote *SearchMessagesFilterVoiceNote) GetSearchMessagesFilterEnum() SearchMessagesFilterEnum {
 	return SearchMessagesFilterVoiceNoteType
 }
 
+// Accept calls the method of the visitor for SearchMessagesFilterVoiceNote
+func (searchMessagesFilterVoiceNote *SearchMessagesFilterVoiceNote) Accept(visitor SearchMessagesFilterVisitor) {
+	visitor.VisitSearchMessagesFilterVoiceNote(searchMessagesFilterVoiceNote)
+}
+
 // SearchMessagesFilterPhotoAndVideo Returns only photo and video messages
 type SearchMessagesFilterPhotoAndVideo struct {
 	tdCommon
@@ -316,7 +1026,6 @@ func (searchMessagesFilterPhotoAndVideo *SearchMessagesFilterPhotoAndVideo) Mess
 }
 
 // NewSearchMessagesFilterPhotoAndVideo creates a new SearchMessagesFilterPhotoAndVideo
-//
 func NewSearchMessagesFilterPhotoAndVideo() *SearchMessagesFilterPhotoAndVideo {
 	searchMessagesFilterPhotoAndVideoTemp := SearchMessagesFilterPhotoAndVideo{
 		tdCommon: tdCommon{Type: "searchMessagesFilterPhotoAndVideo"},
@@ -325,11 +1034,107 @@ func NewSearchMessagesFilterPhotoAndVideo() *SearchMessagesFilterPhotoAndVideo {
 	return &searchMessagesFilterPhotoAndVideoTemp
 }
 
+// MarshalJSON marshals to json
+func (searchMessagesFilterPhotoAndVideo *SearchMessagesFilterPhotoAndVideo) MarshalJSON() ([]byte, error) {
+	return searchMessagesFilterPhotoAndVideo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (searchMessagesFilterPhotoAndVideo *SearchMessagesFilterPhotoAndVideo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, searchMessagesFilterPhotoAndVideo)
+}
+
+func (searchMessagesFilterPhotoAndVideo *SearchMessagesFilterPhotoAndVideo) appendJSON(b []byte) ([]byte, error) {
+	if searchMessagesFilterPhotoAndVideo == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, searchMessagesFilterPhotoAndVideo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, searchMessagesFilterPhotoAndVideo.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (searchMessagesFilterPhotoAndVideo *SearchMessagesFilterPhotoAndVideo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			searchMessagesFilterPhotoAndVideo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			searchMessagesFilterPhotoAndVideo.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (searchMessagesFilterPhotoAndVideo *SearchMessagesFilterPhotoAndVideo) Clone() *SearchMessagesFilterPhotoAndVideo {
+	if searchMessagesFilterPhotoAndVideo == nil {
+		return nil
+	}
+	clone := *searchMessagesFilterPhotoAndVideo
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (searchMessagesFilterPhotoAndVideo *SearchMessagesFilterPhotoAndVideo) Equal(other *SearchMessagesFilterPhotoAndVideo) bool {
+	if searchMessagesFilterPhotoAndVideo == nil || other == nil {
+		return searchMessagesFilterPhotoAndVideo == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (searchMessagesFilterPhotoAndVideo *SearchMessagesFilterPhotoAndVideo) Diff(other *SearchMessagesFilterPhotoAndVideo) []Change {
+	return searchMessagesFilterPhotoAndVideo.diff("", other, nil)
+}
+
+func (searchMessagesFilterPhotoAndVideo *SearchMessagesFilterPhotoAndVideo) diff(path string, other *SearchMessagesFilterPhotoAndVideo, changes []Change) []Change {
+	if searchMessagesFilterPhotoAndVideo == nil || other == nil {
+		if searchMessagesFilterPhotoAndVideo != other {
+			changes = append(changes, Change{Path: path, Old: searchMessagesFilterPhotoAndVideo, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (searchMessagesFilterPhotoAndVideo *SearchMessagesFilterPhotoAndVideo) String() string {
+	return objectString(searchMessagesFilterPhotoAndVideo)
+}
+
+// LogValue logs the object as its summary
+func (searchMessagesFilterPhotoAndVideo *SearchMessagesFilterPhotoAndVideo) LogValue() slog.Value {
+	return slog.StringValue(objectString(searchMessagesFilterPhotoAndVideo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (searchMessagesFilterPhotoAndVideo *SearchMessagesFilterPhotoAndVideo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, searchMessagesFilterPhotoAndVideo)
+}
+
+func (searchMessagesFilterPhotoAndVideo *SearchMessagesFilterPhotoAndVideo) printText(p *printer) {
+	if searchMessagesFilterPhotoAndVideo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SearchMessagesFilterPhotoAndVideo")
+	p.endObject(state)
+}
+
 // GetSearchMessagesFilterEnum return the enum type of this object
 func (searchMessagesFilterPhotoAndVideo *SearchMessagesFilterPhotoAndVideo) GetSearchMessagesFilterEnum() SearchMessagesFilterEnum {
 	return SearchMessagesFilterPhotoAndVideoType
 }
 
+// Accept calls the method of the visitor for SearchMessagesFilterPhotoAndVideo
+func (searchMessagesFilterPhotoAndVideo *SearchMessagesFilterPhotoAndVideo) Accept(visitor SearchMessagesFilterVisitor) {
+	visitor.VisitSearchMessagesFilterPhotoAndVideo(searchMessagesFilterPhotoAndVideo)
+}
+
 // SearchMessagesFilterUrl Returns only messages containing URLs
 type SearchMessagesFilterUrl struct {
 	tdCommon
@@ -341,7 +1146,6 @@ func (searchMessagesFilterUrl *SearchMessagesFilterUrl) MessageType() string {
 }
 
 // NewSearchMessagesFilterUrl creates a new SearchMessagesFilterUrl
-//
 func NewSearchMessagesFilterUrl() *SearchMessagesFilterUrl {
 	searchMessagesFilterUrlTemp := SearchMessagesFilterUrl{
 		tdCommon: tdCommon{Type: "searchMessagesFilterUrl"},
@@ -350,11 +1154,107 @@ func NewSearchMessagesFilterUrl() *SearchMessagesFilterUrl {
 	return &searchMessagesFilterUrlTemp
 }
 
+// MarshalJSON marshals to json
+func (searchMessagesFilterUrl *SearchMessagesFilterUrl) MarshalJSON() ([]byte, error) {
+	return searchMessagesFilterUrl.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (searchMessagesFilterUrl *SearchMessagesFilterUrl) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, searchMessagesFilterUrl)
+}
+
+func (searchMessagesFilterUrl *SearchMessagesFilterUrl) appendJSON(b []byte) ([]byte, error) {
+	if searchMessagesFilterUrl == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, searchMessagesFilterUrl.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, searchMessagesFilterUrl.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (searchMessagesFilterUrl *SearchMessagesFilterUrl) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			searchMessagesFilterUrl.tdCommon.Type, err = r.readString()
+		case "@extra":
+			searchMessagesFilterUrl.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (searchMessagesFilterUrl *SearchMessagesFilterUrl) Clone() *SearchMessagesFilterUrl {
+	if searchMessagesFilterUrl == nil {
+		return nil
+	}
+	clone := *searchMessagesFilterUrl
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (searchMessagesFilterUrl *SearchMessagesFilterUrl) Equal(other *SearchMessagesFilterUrl) bool {
+	if searchMessagesFilterUrl == nil || other == nil {
+		return searchMessagesFilterUrl == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (searchMessagesFilterUrl *SearchMessagesFilterUrl) Diff(other *SearchMessagesFilterUrl) []Change {
+	return searchMessagesFilterUrl.diff("", other, nil)
+}
+
+func (searchMessagesFilterUrl *SearchMessagesFilterUrl) diff(path string, other *SearchMessagesFilterUrl, changes []Change) []Change {
+	if searchMessagesFilterUrl == nil || other == nil {
+		if searchMessagesFilterUrl != other {
+			changes = append(changes, Change{Path: path, Old: searchMessagesFilterUrl, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (searchMessagesFilterUrl *SearchMessagesFilterUrl) String() string {
+	return objectString(searchMessagesFilterUrl)
+}
+
+// LogValue logs the object as its summary
+func (searchMessagesFilterUrl *SearchMessagesFilterUrl) LogValue() slog.Value {
+	return slog.StringValue(objectString(searchMessagesFilterUrl))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (searchMessagesFilterUrl *SearchMessagesFilterUrl) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, searchMessagesFilterUrl)
+}
+
+func (searchMessagesFilterUrl *SearchMessagesFilterUrl) printText(p *printer) {
+	if searchMessagesFilterUrl == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SearchMessagesFilterUrl")
+	p.endObject(state)
+}
+
 // GetSearchMessagesFilterEnum return the enum type of this object
 func (searchMessagesFilterUrl *SearchMessagesFilterUrl) GetSearchMessagesFilterEnum() SearchMessagesFilterEnum {
 	return SearchMessagesFilterUrlType
 }
 
+// Accept calls the method of the visitor for SearchMessagesFilterUrl
+func (searchMessagesFilterUrl *SearchMessagesFilterUrl) Accept(visitor SearchMessagesFilterVisitor) {
+	visitor.VisitSearchMessagesFilterUrl(searchMessagesFilterUrl)
+}
+
 // SearchMessagesFilterChatPhoto Returns only messages containing chat photos
 type SearchMessagesFilterChatPhoto struct {
 	tdCommon
@@ -366,7 +1266,6 @@ func (searchMessagesFilterChatPhoto *SearchMessagesFilterChatPhoto) MessageType(
 }
 
 // NewSearchMessagesFilterChatPhoto creates a new SearchMessagesFilterChatPhoto
-//
 func NewSearchMessagesFilterChatPhoto() *SearchMessagesFilterChatPhoto {
 	searchMessagesFilterChatPhotoTemp := SearchMessagesFilterChatPhoto{
 		tdCommon: tdCommon{Type: "searchMessagesFilterChatPhoto"},
@@ -375,11 +1274,107 @@ func NewSearchMessagesFilterChatPhoto() *SearchMessagesFilterChatPhoto {
 	return &searchMessagesFilterChatPhotoTemp
 }
 
+// MarshalJSON marshals to json
+func (searchMessagesFilterChatPhoto *SearchMessagesFilterChatPhoto) MarshalJSON() ([]byte, error) {
+	return searchMessagesFilterChatPhoto.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (searchMessagesFilterChatPhoto *SearchMessagesFilterChatPhoto) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, searchMessagesFilterChatPhoto)
+}
+
+func (searchMessagesFilterChatPhoto *SearchMessagesFilterChatPhoto) appendJSON(b []byte) ([]byte, error) {
+	if searchMessagesFilterChatPhoto == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, searchMessagesFilterChatPhoto.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, searchMessagesFilterChatPhoto.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (searchMessagesFilterChatPhoto *SearchMessagesFilterChatPhoto) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			searchMessagesFilterChatPhoto.tdCommon.Type, err = r.readString()
+		case "@extra":
+			searchMessagesFilterChatPhoto.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (searchMessagesFilterChatPhoto *SearchMessagesFilterChatPhoto) Clone() *SearchMessagesFilterChatPhoto {
+	if searchMessagesFilterChatPhoto == nil {
+		return nil
+	}
+	clone := *searchMessagesFilterChatPhoto
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (searchMessagesFilterChatPhoto *SearchMessagesFilterChatPhoto) Equal(other *SearchMessagesFilterChatPhoto) bool {
+	if searchMessagesFilterChatPhoto == nil || other == nil {
+		return searchMessagesFilterChatPhoto == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (searchMessagesFilterChatPhoto *SearchMessagesFilterChatPhoto) Diff(other *SearchMessagesFilterChatPhoto) []Change {
+	return searchMessagesFilterChatPhoto.diff("", other, nil)
+}
+
+func (searchMessagesFilterChatPhoto *SearchMessagesFilterChatPhoto) diff(path string, other *SearchMessagesFilterChatPhoto, changes []Change) []Change {
+	if searchMessagesFilterChatPhoto == nil || other == nil {
+		if searchMessagesFilterChatPhoto != other {
+			changes = append(changes, Change{Path: path, Old: searchMessagesFilterChatPhoto, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (searchMessagesFilterChatPhoto *SearchMessagesFilterChatPhoto) String() string {
+	return objectString(searchMessagesFilterChatPhoto)
+}
+
+// LogValue logs the object as its summary
+func (searchMessagesFilterChatPhoto *SearchMessagesFilterChatPhoto) LogValue() slog.Value {
+	return slog.StringValue(objectString(searchMessagesFilterChatPhoto))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (searchMessagesFilterChatPhoto *SearchMessagesFilterChatPhoto) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, searchMessagesFilterChatPhoto)
+}
+
+func (searchMessagesFilterChatPhoto *SearchMessagesFilterChatPhoto) printText(p *printer) {
+	if searchMessagesFilterChatPhoto == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SearchMessagesFilterChatPhoto")
+	p.endObject(state)
+}
+
 // GetSearchMessagesFilterEnum return the enum type of this object
 func (searchMessagesFilterChatPhoto *SearchMessagesFilterChatPhoto) GetSearchMessagesFilterEnum() SearchMessagesFilterEnum {
 	return SearchMessagesFilterChatPhotoType
 }
 
+// Accept calls the method of the visitor for SearchMessagesFilterChatPhoto
+func (searchMessagesFilterChatPhoto *SearchMessagesFilterChatPhoto) Accept(visitor SearchMessagesFilterVisitor) {
+	visitor.VisitSearchMessagesFilterChatPhoto(searchMessagesFilterChatPhoto)
+}
+
 // SearchMessagesFilterVideoNote Returns only video note messages
 type SearchMessagesFilterVideoNote struct {
 	tdCommon
@@ -391,7 +1386,6 @@ func (searchMessagesFilterVideoNote *SearchMessagesFilterVideoNote) MessageType(
 }
 
 // NewSearchMessagesFilterVideoNote creates a new SearchMessagesFilterVideoNote
-//
 func NewSearchMessagesFilterVideoNote() *SearchMessagesFilterVideoNote {
 	searchMessagesFilterVideoNoteTemp := SearchMessagesFilterVideoNote{
 		tdCommon: tdCommon{Type: "searchMessagesFilterVideoNote"},
@@ -400,11 +1394,107 @@ func NewSearchMessagesFilterVideoNote() *SearchMessagesFilterVideoNote {
 	return &searchMessagesFilterVideoNoteTemp
 }
 
+// MarshalJSON marshals to json
+func (searchMessagesFilterVideoNote *SearchMessagesFilterVideoNote) MarshalJSON() ([]byte, error) {
+	return searchMessagesFilterVideoNote.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (searchMessagesFilterVideoNote *SearchMessagesFilterVideoNote) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, searchMessagesFilterVideoNote)
+}
+
+func (searchMessagesFilterVideoNote *SearchMessagesFilterVideoNote) appendJSON(b []byte) ([]byte, error) {
+	if searchMessagesFilterVideoNote == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, searchMessagesFilterVideoNote.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, searchMessagesFilterVideoNote.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (searchMessagesFilterVideoNote *SearchMessagesFilterVideoNote) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			searchMessagesFilterVideoNote.tdCommon.Type, err = r.readString()
+		case "@extra":
+			searchMessagesFilterVideoNote.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (searchMessagesFilterVideoNote *SearchMessagesFilterVideoNote) Clone() *SearchMessagesFilterVideoNote {
+	if searchMessagesFilterVideoNote == nil {
+		return nil
+	}
+	clone := *searchMessagesFilterVideoNote
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (searchMessagesFilterVideoNote *SearchMessagesFilterVideoNote) Equal(other *SearchMessagesFilterVideoNote) bool {
+	if searchMessagesFilterVideoNote == nil || other == nil {
+		return searchMessagesFilterVideoNote == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (searchMessagesFilterVideoNote *SearchMessagesFilterVideoNote) Diff(other *SearchMessagesFilterVideoNote) []Change {
+	return searchMessagesFilterVideoNote.diff("", other, nil)
+}
+
+func (searchMessagesFilterVideoNote *SearchMessagesFilterVideoNote) diff(path string, other *SearchMessagesFilterVideoNote, changes []Change) []Change {
+	if searchMessagesFilterVideoNote == nil || other == nil {
+		if searchMessagesFilterVideoNote != other {
+			changes = append(changes, Change{Path: path, Old: searchMessagesFilterVideoNote, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (searchMessagesFilterVideoNote *SearchMessagesFilterVideoNote) String() string {
+	return objectString(searchMessagesFilterVideoNote)
+}
+
+// LogValue logs the object as its summary
+func (searchMessagesFilterVideoNote *SearchMessagesFilterVideoNote) LogValue() slog.Value {
+	return slog.StringValue(objectString(searchMessagesFilterVideoNote))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (searchMessagesFilterVideoNote *SearchMessagesFilterVideoNote) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, searchMessagesFilterVideoNote)
+}
+
+func (searchMessagesFilterVideoNote *SearchMessagesFilterVideoNote) printText(p *printer) {
+	if searchMessagesFilterVideoNote == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SearchMessagesFilterVideoNote")
+	p.endObject(state)
+}
+
 // GetSearchMessagesFilterEnum return the enum type of this object
 func (searchMessagesFilterVideoNote *SearchMessagesFilterVideoNote) GetSearchMessagesFilterEnum() SearchMessagesFilterEnum {
 	return SearchMessagesFilterVideoNoteType
 }
 
+// Accept calls the method of the visitor for SearchMessagesFilterVideoNote
+func (searchMessagesFilterVideoNote *SearchMessagesFilterVideoNote) Accept(visitor SearchMessagesFilterVisitor) {
+	visitor.VisitSearchMessagesFilterVideoNote(searchMessagesFilterVideoNote)
+}
+
 // SearchMessagesFilterVoiceAndVideoNote Returns only voice and video note messages
 type SearchMessagesFilterVoiceAndVideoNote struct {
 	tdCommon
@@ -416,7 +1506,6 @@ func (searchMessagesFilterVoiceAndVideoNote *SearchMessagesFilterVoiceAndVideoNo
 }
 
 // NewSearchMessagesFilterVoiceAndVideoNote creates a new SearchMessagesFilterVoiceAndVideoNote
-//
 func NewSearchMessagesFilterVoiceAndVideoNote() *SearchMessagesFilterVoiceAndVideoNote {
 	searchMessagesFilterVoiceAndVideoNoteTemp := SearchMessagesFilterVoiceAndVideoNote{
 		tdCommon: tdCommon{Type: "searchMessagesFilterVoiceAndVideoNote"},
@@ -425,11 +1514,107 @@ func NewSearchMessagesFilterVoiceAndVideoNote() *SearchMessagesFilterVoiceAndVid
 	return &searchMessagesFilterVoiceAndVideoNoteTemp
 }
 
+// MarshalJSON marshals to json
+func (searchMessagesFilterVoiceAndVideoNote *SearchMessagesFilterVoiceAndVideoNote) MarshalJSON() ([]byte, error) {
+	return searchMessagesFilterVoiceAndVideoNote.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (searchMessagesFilterVoiceAndVideoNote *SearchMessagesFilterVoiceAndVideoNote) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, searchMessagesFilterVoiceAndVideoNote)
+}
+
+func (searchMessagesFilterVoiceAndVideoNote *SearchMessagesFilterVoiceAndVideoNote) appendJSON(b []byte) ([]byte, error) {
+	if searchMessagesFilterVoiceAndVideoNote == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, searchMessagesFilterVoiceAndVideoNote.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, searchMessagesFilterVoiceAndVideoNote.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (searchMessagesFilterVoiceAndVideoNote *SearchMessagesFilterVoiceAndVideoNote) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			searchMessagesFilterVoiceAndVideoNote.tdCommon.Type, err = r.readString()
+		case "@extra":
+			searchMessagesFilterVoiceAndVideoNote.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (searchMessagesFilterVoiceAndVideoNote *SearchMessagesFilterVoiceAndVideoNote) Clone() *SearchMessagesFilterVoiceAndVideoNote {
+	if searchMessagesFilterVoiceAndVideoNote == nil {
+		return nil
+	}
+	clone := *searchMessagesFilterVoiceAndVideoNote
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (searchMessagesFilterVoiceAndVideoNote *SearchMessagesFilterVoiceAndVideoNote) Equal(other *SearchMessagesFilterVoiceAndVideoNote) bool {
+	if searchMessagesFilterVoiceAndVideoNote == nil || other == nil {
+		return searchMessagesFilterVoiceAndVideoNote == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (searchMessagesFilterVoiceAndVideoNote *SearchMessagesFilterVoiceAndVideoNote) Diff(other *SearchMessagesFilterVoiceAndVideoNote) []Change {
+	return searchMessagesFilterVoiceAndVideoNote.diff("", other, nil)
+}
+
+func (searchMessagesFilterVoiceAndVideoNote *SearchMessagesFilterVoiceAndVideoNote) diff(path string, other *SearchMessagesFilterVoiceAndVideoNote, changes []Change) []Change {
+	if searchMessagesFilterVoiceAndVideoNote == nil || other == nil {
+		if searchMessagesFilterVoiceAndVideoNote != other {
+			changes = append(changes, Change{Path: path, Old: searchMessagesFilterVoiceAndVideoNote, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (searchMessagesFilterVoiceAndVideoNote *SearchMessagesFilterVoiceAndVideoNote) String() string {
+	return objectString(searchMessagesFilterVoiceAndVideoNote)
+}
+
+// LogValue logs the object as its summary
+func (searchMessagesFilterVoiceAndVideoNote *SearchMessagesFilterVoiceAndVideoNote) LogValue() slog.Value {
+	return slog.StringValue(objectString(searchMessagesFilterVoiceAndVideoNote))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (searchMessagesFilterVoiceAndVideoNote *SearchMessagesFilterVoiceAndVideoNote) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, searchMessagesFilterVoiceAndVideoNote)
+}
+
+func (searchMessagesFilterVoiceAndVideoNote *SearchMessagesFilterVoiceAndVideoNote) printText(p *printer) {
+	if searchMessagesFilterVoiceAndVideoNote == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SearchMessagesFilterVoiceAndVideoNote")
+	p.endObject(state)
+}
+
 // GetSearchMessagesFilterEnum return the enum type of this object
 func (searchMessagesFilterVoiceAndVideoNote *SearchMessagesFilterVoiceAndVideoNote) GetSearchMessagesFilterEnum() SearchMessagesFilterEnum {
 	return SearchMessagesFilterVoiceAndVideoNoteType
 }
 
+// Accept calls the method of the visitor for SearchMessagesFilterVoiceAndVideoNote
+func (searchMessagesFilterVoiceAndVideoNote *SearchMessagesFilterVoiceAndVideoNote) Accept(visitor SearchMessagesFilterVisitor) {
+	visitor.VisitSearchMessagesFilterVoiceAndVideoNote(searchMessagesFilterVoiceAndVideoNote)
+}
+
 // SearchMessagesFilterMention Returns only messages with mentions of the current user, or messages that are replies to their messages
 type SearchMessagesFilterMention struct {
 	tdCommon
@@ -441,7 +1626,6 @@ func (searchMessagesFilterMention *SearchMessagesFilterMention) MessageType() st
 }
 
 // NewSearchMessagesFilterMention creates a new SearchMessagesFilterMention
-//
 func NewSearchMessagesFilterMention() *SearchMessagesFilterMention {
 	searchMessagesFilterMentionTemp := SearchMessagesFilterMention{
 		tdCommon: tdCommon{Type: "searchMessagesFilterMention"},
@@ -450,11 +1634,107 @@ func NewSearchMessagesFilterMention() *SearchMessagesFilterMention {
 	return &searchMessagesFilterMentionTemp
 }
 
+// MarshalJSON marshals to json
+func (searchMessagesFilterMention *SearchMessagesFilterMention) MarshalJSON() ([]byte, error) {
+	return searchMessagesFilterMention.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (searchMessagesFilterMention *SearchMessagesFilterMention) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, searchMessagesFilterMention)
+}
+
+func (searchMessagesFilterMention *SearchMessagesFilterMention) appendJSON(b []byte) ([]byte, error) {
+	if searchMessagesFilterMention == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, searchMessagesFilterMention.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, searchMessagesFilterMention.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (searchMessagesFilterMention *SearchMessagesFilterMention) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			searchMessagesFilterMention.tdCommon.Type, err = r.readString()
+		case "@extra":
+			searchMessagesFilterMention.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (searchMessagesFilterMention *SearchMessagesFilterMention) Clone() *SearchMessagesFilterMention {
+	if searchMessagesFilterMention == nil {
+		return nil
+	}
+	clone := *searchMessagesFilterMention
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (searchMessagesFilterMention *SearchMessagesFilterMention) Equal(other *SearchMessagesFilterMention) bool {
+	if searchMessagesFilterMention == nil || other == nil {
+		return searchMessagesFilterMention == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (searchMessagesFilterMention *SearchMessagesFilterMention) Diff(other *SearchMessagesFilterMention) []Change {
+	return searchMessagesFilterMention.diff("", other, nil)
+}
+
+func (searchMessagesFilterMention *SearchMessagesFilterMention) diff(path string, other *SearchMessagesFilterMention, changes []Change) []Change {
+	if searchMessagesFilterMention == nil || other == nil {
+		if searchMessagesFilterMention != other {
+			changes = append(changes, Change{Path: path, Old: searchMessagesFilterMention, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (searchMessagesFilterMention *SearchMessagesFilterMention) String() string {
+	return objectString(searchMessagesFilterMention)
+}
+
+// LogValue logs the object as its summary
+func (searchMessagesFilterMention *SearchMessagesFilterMention) LogValue() slog.Value {
+	return slog.StringValue(objectString(searchMessagesFilterMention))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (searchMessagesFilterMention *SearchMessagesFilterMention) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, searchMessagesFilterMention)
+}
+
+func (searchMessagesFilterMention *SearchMessagesFilterMention) printText(p *printer) {
+	if searchMessagesFilterMention == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SearchMessagesFilterMention")
+	p.endObject(state)
+}
+
 // GetSearchMessagesFilterEnum return the enum type of this object
 func (searchMessagesFilterMention *SearchMessagesFilterMention) GetSearchMessagesFilterEnum() SearchMessagesFilterEnum {
 	return SearchMessagesFilterMentionType
 }
 
+// Accept calls the method of the visitor for SearchMessagesFilterMention
+func (searchMessagesFilterMention *SearchMessagesFilterMention) Accept(visitor SearchMessagesFilterVisitor) {
+	visitor.VisitSearchMessagesFilterMention(searchMessagesFilterMention)
+}
+
 // SearchMessagesFilterUnreadMention Returns only messages with unread mentions of the current user, or messages that are replies to their messages. When using this filter the results can't be additionally filtered by a query, a message thread or by the sending user
 type SearchMessagesFilterUnreadMention struct {
 	tdCommon
@@ -466,7 +1746,6 @@ func (searchMessagesFilterUnreadMention *SearchMessagesFilterUnreadMention) Mess
 }
 
 // NewSearchMessagesFilterUnreadMention creates a new SearchMessagesFilterUnreadMention
-//
 func NewSearchMessagesFilterUnreadMention() *SearchMessagesFilterUnreadMention {
 	searchMessagesFilterUnreadMentionTemp := SearchMessagesFilterUnreadMention{
 		tdCommon: tdCommon{Type: "searchMessagesFilterUnreadMention"},
@@ -475,11 +1754,107 @@ func NewSearchMessagesFilterUnreadMention() *SearchMessagesFilterUnreadMention {
 	return &searchMessagesFilterUnreadMentionTemp
 }
 
+// MarshalJSON marshals to json
+func (searchMessagesFilterUnreadMention *SearchMessagesFilterUnreadMention) MarshalJSON() ([]byte, error) {
+	return searchMessagesFilterUnreadMention.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (searchMessagesFilterUnreadMention *SearchMessagesFilterUnreadMention) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, searchMessagesFilterUnreadMention)
+}
+
+func (searchMessagesFilterUnreadMention *SearchMessagesFilterUnreadMention) appendJSON(b []byte) ([]byte, error) {
+	if searchMessagesFilterUnreadMention == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, searchMessagesFilterUnreadMention.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, searchMessagesFilterUnreadMention.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (searchMessagesFilterUnreadMention *SearchMessagesFilterUnreadMention) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			searchMessagesFilterUnreadMention.tdCommon.Type, err = r.readString()
+		case "@extra":
+			searchMessagesFilterUnreadMention.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (searchMessagesFilterUnreadMention *SearchMessagesFilterUnreadMention) Clone() *SearchMessagesFilterUnreadMention {
+	if searchMessagesFilterUnreadMention == nil {
+		return nil
+	}
+	clone := *searchMessagesFilterUnreadMention
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (searchMessagesFilterUnreadMention *SearchMessagesFilterUnreadMention) Equal(other *SearchMessagesFilterUnreadMention) bool {
+	if searchMessagesFilterUnreadMention == nil || other == nil {
+		return searchMessagesFilterUnreadMention == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (searchMessagesFilterUnreadMention *SearchMessagesFilterUnreadMention) Diff(other *SearchMessagesFilterUnreadMention) []Change {
+	return searchMessagesFilterUnreadMention.diff("", other, nil)
+}
+
+func (searchMessagesFilterUnreadMention *SearchMessagesFilterUnreadMention) diff(path string, other *SearchMessagesFilterUnreadMention, changes []Change) []Change {
+	if searchMessagesFilterUnreadMention == nil || other == nil {
+		if searchMessagesFilterUnreadMention != other {
+			changes = append(changes, Change{Path: path, Old: searchMessagesFilterUnreadMention, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (searchMessagesFilterUnreadMention *SearchMessagesFilterUnreadMention) String() string {
+	return objectString(searchMessagesFilterUnreadMention)
+}
+
+// LogValue logs the object as its summary
+func (searchMessagesFilterUnreadMention *SearchMessagesFilterUnreadMention) LogValue() slog.Value {
+	return slog.StringValue(objectString(searchMessagesFilterUnreadMention))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (searchMessagesFilterUnreadMention *SearchMessagesFilterUnreadMention) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, searchMessagesFilterUnreadMention)
+}
+
+func (searchMessagesFilterUnreadMention *SearchMessagesFilterUnreadMention) printText(p *printer) {
+	if searchMessagesFilterUnreadMention == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SearchMessagesFilterUnreadMention")
+	p.endObject(state)
+}
+
 // GetSearchMessagesFilterEnum return the enum type of this object
 func (searchMessagesFilterUnreadMention *SearchMessagesFilterUnreadMention) GetSearchMessagesFilterEnum() SearchMessagesFilterEnum {
 	return SearchMessagesFilterUnreadMentionType
 }
 
+// Accept calls the method of the visitor for SearchMessagesFilterUnreadMention
+func (searchMessagesFilterUnreadMention *SearchMessagesFilterUnreadMention) Accept(visitor SearchMessagesFilterVisitor) {
+	visitor.VisitSearchMessagesFilterUnreadMention(searchMessagesFilterUnreadMention)
+}
+
 // SearchMessagesFilterFailedToSend Returns only failed to send messages. This filter can be used only if the message database is used
 type SearchMessagesFilterFailedToSend struct {
 	tdCommon
@@ -491,7 +1866,6 @@ func (searchMessagesFilterFailedToSend *SearchMessagesFilterFailedToSend) Messag
 }
 
 // NewSearchMessagesFilterFailedToSend creates a new SearchMessagesFilterFailedToSend
-//
 func NewSearchMessagesFilterFailedToSend() *SearchMessagesFilterFailedToSend {
 	searchMessagesFilterFailedToSendTemp := SearchMessagesFilterFailedToSend{
 		tdCommon: tdCommon{Type: "searchMessagesFilterFailedToSend"},
@@ -500,11 +1874,107 @@ func NewSearchMessagesFilterFailedToSend() *SearchMessagesFilterFailedToSend {
 	return &searchMessagesFilterFailedToSendTemp
 }
 
+// MarshalJSON marshals to json
+func (searchMessagesFilterFailedToSend *SearchMessagesFilterFailedToSend) MarshalJSON() ([]byte, error) {
+	return searchMessagesFilterFailedToSend.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (searchMessagesFilterFailedToSend *SearchMessagesFilterFailedToSend) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, searchMessagesFilterFailedToSend)
+}
+
+func (searchMessagesFilterFailedToSend *SearchMessagesFilterFailedToSend) appendJSON(b []byte) ([]byte, error) {
+	if searchMessagesFilterFailedToSend == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, searchMessagesFilterFailedToSend.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, searchMessagesFilterFailedToSend.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (searchMessagesFilterFailedToSend *SearchMessagesFilterFailedToSend) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			searchMessagesFilterFailedToSend.tdCommon.Type, err = r.readString()
+		case "@extra":
+			searchMessagesFilterFailedToSend.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (searchMessagesFilterFailedToSend *SearchMessagesFilterFailedToSend) Clone() *SearchMessagesFilterFailedToSend {
+	if searchMessagesFilterFailedToSend == nil {
+		return nil
+	}
+	clone := *searchMessagesFilterFailedToSend
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (searchMessagesFilterFailedToSend *SearchMessagesFilterFailedToSend) Equal(other *SearchMessagesFilterFailedToSend) bool {
+	if searchMessagesFilterFailedToSend == nil || other == nil {
+		return searchMessagesFilterFailedToSend == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (searchMessagesFilterFailedToSend *SearchMessagesFilterFailedToSend) Diff(other *SearchMessagesFilterFailedToSend) []Change {
+	return searchMessagesFilterFailedToSend.diff("", other, nil)
+}
+
+func (searchMessagesFilterFailedToSend *SearchMessagesFilterFailedToSend) diff(path string, other *SearchMessagesFilterFailedToSend, changes []Change) []Change {
+	if searchMessagesFilterFailedToSend == nil || other == nil {
+		if searchMessagesFilterFailedToSend != other {
+			changes = append(changes, Change{Path: path, Old: searchMessagesFilterFailedToSend, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (searchMessagesFilterFailedToSend *SearchMessagesFilterFailedToSend) String() string {
+	return objectString(searchMessagesFilterFailedToSend)
+}
+
+// LogValue logs the object as its summary
+func (searchMessagesFilterFailedToSend *SearchMessagesFilterFailedToSend) LogValue() slog.Value {
+	return slog.StringValue(objectString(searchMessagesFilterFailedToSend))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (searchMessagesFilterFailedToSend *SearchMessagesFilterFailedToSend) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, searchMessagesFilterFailedToSend)
+}
+
+func (searchMessagesFilterFailedToSend *SearchMessagesFilterFailedToSend) printText(p *printer) {
+	if searchMessagesFilterFailedToSend == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SearchMessagesFilterFailedToSend")
+	p.endObject(state)
+}
+
 // GetSearchMessagesFilterEnum return the enum type of this object
 func (searchMessagesFilterFailedToSend *SearchMessagesFilterFailedToSend) GetSearchMessagesFilterEnum() SearchMessagesFilterEnum {
 	return SearchMessagesFilterFailedToSendType
 }
 
+// Accept calls the method of the visitor for SearchMessagesFilterFailedToSend
+func (searchMessagesFilterFailedToSend *SearchMessagesFilterFailedToSend) Accept(visitor SearchMessagesFilterVisitor) {
+	visitor.VisitSearchMessagesFilterFailedToSend(searchMessagesFilterFailedToSend)
+}
+
 // SearchMessagesFilterPinned Returns only pinned messages
 type SearchMessagesFilterPinned struct {
 	tdCommon
@@ -516,7 +1986,6 @@ func (searchMessagesFilterPinned *SearchMessagesFilterPinned) MessageType() stri
 }
 
 // NewSearchMessagesFilterPinned creates a new SearchMessagesFilterPinned
-//
 func NewSearchMessagesFilterPinned() *SearchMessagesFilterPinned {
 	searchMessagesFilterPinnedTemp := SearchMessagesFilterPinned{
 		tdCommon: tdCommon{Type: "searchMessagesFilterPinned"},
@@ -525,7 +1994,408 @@ func NewSearchMessagesFilterPinned() *SearchMessagesFilterPinned {
 	return &searchMessagesFilterPinnedTemp
 }
 
+// MarshalJSON marshals to json
+func (searchMessagesFilterPinned *SearchMessagesFilterPinned) MarshalJSON() ([]byte, error) {
+	return searchMessagesFilterPinned.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (searchMessagesFilterPinned *SearchMessagesFilterPinned) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, searchMessagesFilterPinned)
+}
+
+func (searchMessagesFilterPinned *SearchMessagesFilterPinned) appendJSON(b []byte) ([]byte, error) {
+	if searchMessagesFilterPinned == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, searchMessagesFilterPinned.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, searchMessagesFilterPinned.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (searchMessagesFilterPinned *SearchMessagesFilterPinned) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			searchMessagesFilterPinned.tdCommon.Type, err = r.readString()
+		case "@extra":
+			searchMessagesFilterPinned.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (searchMessagesFilterPinned *SearchMessagesFilterPinned) Clone() *SearchMessagesFilterPinned {
+	if searchMessagesFilterPinned == nil {
+		return nil
+	}
+	clone := *searchMessagesFilterPinned
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (searchMessagesFilterPinned *SearchMessagesFilterPinned) Equal(other *SearchMessagesFilterPinned) bool {
+	if searchMessagesFilterPinned == nil || other == nil {
+		return searchMessagesFilterPinned == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (searchMessagesFilterPinned *SearchMessagesFilterPinned) Diff(other *SearchMessagesFilterPinned) []Change {
+	return searchMessagesFilterPinned.diff("", other, nil)
+}
+
+func (searchMessagesFilterPinned *SearchMessagesFilterPinned) diff(path string, other *SearchMessagesFilterPinned, changes []Change) []Change {
+	if searchMessagesFilterPinned == nil || other == nil {
+		if searchMessagesFilterPinned != other {
+			changes = append(changes, Change{Path: path, Old: searchMessagesFilterPinned, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (searchMessagesFilterPinned *SearchMessagesFilterPinned) String() string {
+	return objectString(searchMessagesFilterPinned)
+}
+
+// LogValue logs the object as its summary
+func (searchMessagesFilterPinned *SearchMessagesFilterPinned) LogValue() slog.Value {
+	return slog.StringValue(objectString(searchMessagesFilterPinned))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (searchMessagesFilterPinned *SearchMessagesFilterPinned) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, searchMessagesFilterPinned)
+}
+
+func (searchMessagesFilterPinned *SearchMessagesFilterPinned) printText(p *printer) {
+	if searchMessagesFilterPinned == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SearchMessagesFilterPinned")
+	p.endObject(state)
+}
+
 // GetSearchMessagesFilterEnum return the enum type of this object
 func (searchMessagesFilterPinned *SearchMessagesFilterPinned) GetSearchMessagesFilterEnum() SearchMessagesFilterEnum {
 	return SearchMessagesFilterPinnedType
 }
+
+// Accept calls the method of the visitor for SearchMessagesFilterPinned
+func (searchMessagesFilterPinned *SearchMessagesFilterPinned) Accept(visitor SearchMessagesFilterVisitor) {
+	visitor.VisitSearchMessagesFilterPinned(searchMessagesFilterPinned)
+}
+
+// SearchMessagesFilterVisitor visits every type of SearchMessagesFilter, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to SearchMessagesFilter, until they handle it too.
+type SearchMessagesFilterVisitor interface {
+	VisitSearchMessagesFilterEmpty(searchMessagesFilterEmpty *SearchMessagesFilterEmpty)
+	VisitSearchMessagesFilterAnimation(searchMessagesFilterAnimation *SearchMessagesFilterAnimation)
+	VisitSearchMessagesFilterAudio(searchMessagesFilterAudio *SearchMessagesFilterAudio)
+	VisitSearchMessagesFilterDocument(searchMessagesFilterDocument *SearchMessagesFilterDocument)
+	VisitSearchMessagesFilterPhoto(searchMessagesFilterPhoto *SearchMessagesFilterPhoto)
+	VisitSearchMessagesFilterVideo(searchMessagesFilterVideo *SearchMessagesFilterVideo)
+	VisitSearchMessagesFilterVoiceNote(searchMessagesFilterVoiceNote *SearchMessagesFilterVoiceNote)
+	VisitSearchMessagesFilterPhotoAndVideo(searchMessagesFilterPhotoAndVideo *SearchMessagesFilterPhotoAndVideo)
+	VisitSearchMessagesFilterUrl(searchMessagesFilterUrl *SearchMessagesFilterUrl)
+	VisitSearchMessagesFilterChatPhoto(searchMessagesFilterChatPhoto *SearchMessagesFilterChatPhoto)
+	VisitSearchMessagesFilterVideoNote(searchMessagesFilterVideoNote *SearchMessagesFilterVideoNote)
+	VisitSearchMessagesFilterVoiceAndVideoNote(searchMessagesFilterVoiceAndVideoNote *SearchMessagesFilterVoiceAndVideoNote)
+	VisitSearchMessagesFilterMention(searchMessagesFilterMention *SearchMessagesFilterMention)
+	VisitSearchMessagesFilterUnreadMention(searchMessagesFilterUnreadMention *SearchMessagesFilterUnreadMention)
+	VisitSearchMessagesFilterFailedToSend(searchMessagesFilterFailedToSend *SearchMessagesFilterFailedToSend)
+	VisitSearchMessagesFilterPinned(searchMessagesFilterPinned *SearchMessagesFilterPinned)
+	VisitUnknownSearchMessagesFilter(unknownSearchMessagesFilter *UnknownSearchMessagesFilter)
+}
+
+// SearchMessagesFilterCases holds the function MatchSearchMessagesFilter calls for each type of SearchMessagesFilter;
+// types without a function are passed to the default case
+type SearchMessagesFilterCases[R any] struct {
+	SearchMessagesFilterEmpty             func(searchMessagesFilterEmpty *SearchMessagesFilterEmpty) R
+	SearchMessagesFilterAnimation         func(searchMessagesFilterAnimation *SearchMessagesFilterAnimation) R
+	SearchMessagesFilterAudio             func(searchMessagesFilterAudio *SearchMessagesFilterAudio) R
+	SearchMessagesFilterDocument          func(searchMessagesFilterDocument *SearchMessagesFilterDocument) R
+	SearchMessagesFilterPhoto             func(searchMessagesFilterPhoto *SearchMessagesFilterPhoto) R
+	SearchMessagesFilterVideo             func(searchMessagesFilterVideo *SearchMessagesFilterVideo) R
+	SearchMessagesFilterVoiceNote         func(searchMessagesFilterVoiceNote *SearchMessagesFilterVoiceNote) R
+	SearchMessagesFilterPhotoAndVideo     func(searchMessagesFilterPhotoAndVideo *SearchMessagesFilterPhotoAndVideo) R
+	SearchMessagesFilterUrl               func(searchMessagesFilterUrl *SearchMessagesFilterUrl) R
+	SearchMessagesFilterChatPhoto         func(searchMessagesFilterChatPhoto *SearchMessagesFilterChatPhoto) R
+	SearchMessagesFilterVideoNote         func(searchMessagesFilterVideoNote *SearchMessagesFilterVideoNote) R
+	SearchMessagesFilterVoiceAndVideoNote func(searchMessagesFilterVoiceAndVideoNote *SearchMessagesFilterVoiceAndVideoNote) R
+	SearchMessagesFilterMention           func(searchMessagesFilterMention *SearchMessagesFilterMention) R
+	SearchMessagesFilterUnreadMention     func(searchMessagesFilterUnreadMention *SearchMessagesFilterUnreadMention) R
+	SearchMessagesFilterFailedToSend      func(searchMessagesFilterFailedToSend *SearchMessagesFilterFailedToSend) R
+	SearchMessagesFilterPinned            func(searchMessagesFilterPinned *SearchMessagesFilterPinned) R
+}
+
+// MatchSearchMessagesFilter calls the case of the type of searchMessagesFilter, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement SearchMessagesFilterVisitor
+// to have the compiler check every type is handled.
+func MatchSearchMessagesFilter[R any](searchMessagesFilter SearchMessagesFilter, cases SearchMessagesFilterCases[R], defaultCase func(searchMessagesFilter SearchMessagesFilter) R) R {
+	switch value := searchMessagesFilter.(type) {
+	case *SearchMessagesFilterEmpty:
+		if cases.SearchMessagesFilterEmpty != nil {
+			return cases.SearchMessagesFilterEmpty(value)
+		}
+	case *SearchMessagesFilterAnimation:
+		if cases.SearchMessagesFilterAnimation != nil {
+			return cases.SearchMessagesFilterAnimation(value)
+		}
+	case *SearchMessagesFilterAudio:
+		if cases.SearchMessagesFilterAudio != nil {
+			return cases.SearchMessagesFilterAudio(value)
+		}
+	case *SearchMessagesFilterDocument:
+		if cases.SearchMessagesFilterDocument != nil {
+			return cases.SearchMessagesFilterDocument(value)
+		}
+	case *SearchMessagesFilterPhoto:
+		if cases.SearchMessagesFilterPhoto != nil {
+			return cases.SearchMessagesFilterPhoto(value)
+		}
+	case *SearchMessagesFilterVideo:
+		if cases.SearchMessagesFilterVideo != nil {
+			return cases.SearchMessagesFilterVideo(value)
+		}
+	case *SearchMessagesFilterVoiceNote:
+		if cases.SearchMessagesFilterVoiceNote != nil {
+			return cases.SearchMessagesFilterVoiceNote(value)
+		}
+	case *SearchMessagesFilterPhotoAndVideo:
+		if cases.SearchMessagesFilterPhotoAndVideo != nil {
+			return cases.SearchMessagesFilterPhotoAndVideo(value)
+		}
+	case *SearchMessagesFilterUrl:
+		if cases.SearchMessagesFilterUrl != nil {
+			return cases.SearchMessagesFilterUrl(value)
+		}
+	case *SearchMessagesFilterChatPhoto:
+		if cases.SearchMessagesFilterChatPhoto != nil {
+			return cases.SearchMessagesFilterChatPhoto(value)
+		}
+	case *SearchMessagesFilterVideoNote:
+		if cases.SearchMessagesFilterVideoNote != nil {
+			return cases.SearchMessagesFilterVideoNote(value)
+		}
+	case *SearchMessagesFilterVoiceAndVideoNote:
+		if cases.SearchMessagesFilterVoiceAndVideoNote != nil {
+			return cases.SearchMessagesFilterVoiceAndVideoNote(value)
+		}
+	case *SearchMessagesFilterMention:
+		if cases.SearchMessagesFilterMention != nil {
+			return cases.SearchMessagesFilterMention(value)
+		}
+	case *SearchMessagesFilterUnreadMention:
+		if cases.SearchMessagesFilterUnreadMention != nil {
+			return cases.SearchMessagesFilterUnreadMention(value)
+		}
+	case *SearchMessagesFilterFailedToSend:
+		if cases.SearchMessagesFilterFailedToSend != nil {
+			return cases.SearchMessagesFilterFailedToSend(value)
+		}
+	case *SearchMessagesFilterPinned:
+		if cases.SearchMessagesFilterPinned != nil {
+			return cases.SearchMessagesFilterPinned(value)
+		}
+	}
+	return defaultCase(searchMessagesFilter)
+}
+
+// Clone returns a deep copy of the object
+func (unknownSearchMessagesFilter *UnknownSearchMessagesFilter) Clone() *UnknownSearchMessagesFilter {
+	if unknownSearchMessagesFilter == nil {
+		return nil
+	}
+	return &UnknownSearchMessagesFilter{Unknown: unknownSearchMessagesFilter.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownSearchMessagesFilter *UnknownSearchMessagesFilter) Equal(other *UnknownSearchMessagesFilter) bool {
+	if unknownSearchMessagesFilter == nil || other == nil {
+		return unknownSearchMessagesFilter == other
+	}
+	return unknownSearchMessagesFilter.Unknown.equal(other.Unknown)
+}
+
+func cloneSearchMessagesFilter(searchMessagesFilter SearchMessagesFilter) SearchMessagesFilter {
+	switch value := searchMessagesFilter.(type) {
+	case *SearchMessagesFilterEmpty:
+		return value.Clone()
+	case *SearchMessagesFilterAnimation:
+		return value.Clone()
+	case *SearchMessagesFilterAudio:
+		return value.Clone()
+	case *SearchMessagesFilterDocument:
+		return value.Clone()
+	case *SearchMessagesFilterPhoto:
+		return value.Clone()
+	case *SearchMessagesFilterVideo:
+		return value.Clone()
+	case *SearchMessagesFilterVoiceNote:
+		return value.Clone()
+	case *SearchMessagesFilterPhotoAndVideo:
+		return value.Clone()
+	case *SearchMessagesFilterUrl:
+		return value.Clone()
+	case *SearchMessagesFilterChatPhoto:
+		return value.Clone()
+	case *SearchMessagesFilterVideoNote:
+		return value.Clone()
+	case *SearchMessagesFilterVoiceAndVideoNote:
+		return value.Clone()
+	case *SearchMessagesFilterMention:
+		return value.Clone()
+	case *SearchMessagesFilterUnreadMention:
+		return value.Clone()
+	case *SearchMessagesFilterFailedToSend:
+		return value.Clone()
+	case *SearchMessagesFilterPinned:
+		return value.Clone()
+	case *UnknownSearchMessagesFilter:
+		return value.Clone()
+	}
+	return searchMessagesFilter
+}
+
+func equalSearchMessagesFilter(a SearchMessagesFilter, b SearchMessagesFilter) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *SearchMessagesFilterEmpty:
+		b, ok := b.(*SearchMessagesFilterEmpty)
+		return ok && a.Equal(b)
+	case *SearchMessagesFilterAnimation:
+		b, ok := b.(*SearchMessagesFilterAnimation)
+		return ok && a.Equal(b)
+	case *SearchMessagesFilterAudio:
+		b, ok := b.(*SearchMessagesFilterAudio)
+		return ok && a.Equal(b)
+	case *SearchMessagesFilterDocument:
+		b, ok := b.(*SearchMessagesFilterDocument)
+		return ok && a.Equal(b)
+	case *SearchMessagesFilterPhoto:
+		b, ok := b.(*SearchMessagesFilterPhoto)
+		return ok && a.Equal(b)
+	case *SearchMessagesFilterVideo:
+		b, ok := b.(*SearchMessagesFilterVideo)
+		return ok && a.Equal(b)
+	case *SearchMessagesFilterVoiceNote:
+		b, ok := b.(*SearchMessagesFilterVoiceNote)
+		return ok && a.Equal(b)
+	case *SearchMessagesFilterPhotoAndVideo:
+		b, ok := b.(*SearchMessagesFilterPhotoAndVideo)
+		return ok && a.Equal(b)
+	case *SearchMessagesFilterUrl:
+		b, ok := b.(*SearchMessagesFilterUrl)
+		return ok && a.Equal(b)
+	case *SearchMessagesFilterChatPhoto:
+		b, ok := b.(*SearchMessagesFilterChatPhoto)
+		return ok && a.Equal(b)
+	case *SearchMessagesFilterVideoNote:
+		b, ok := b.(*SearchMessagesFilterVideoNote)
+		return ok && a.Equal(b)
+	case *SearchMessagesFilterVoiceAndVideoNote:
+		b, ok := b.(*SearchMessagesFilterVoiceAndVideoNote)
+		return ok && a.Equal(b)
+	case *SearchMessagesFilterMention:
+		b, ok := b.(*SearchMessagesFilterMention)
+		return ok && a.Equal(b)
+	case *SearchMessagesFilterUnreadMention:
+		b, ok := b.(*SearchMessagesFilterUnreadMention)
+		return ok && a.Equal(b)
+	case *SearchMessagesFilterFailedToSend:
+		b, ok := b.(*SearchMessagesFilterFailedToSend)
+		return ok && a.Equal(b)
+	case *SearchMessagesFilterPinned:
+		b, ok := b.(*SearchMessagesFilterPinned)
+		return ok && a.Equal(b)
+	case *UnknownSearchMessagesFilter:
+		b, ok := b.(*UnknownSearchMessagesFilter)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffSearchMessagesFilter(path string, a SearchMessagesFilter, b SearchMessagesFilter, changes []Change) []Change {
+	switch a := a.(type) {
+	case *SearchMessagesFilterEmpty:
+		if b, ok := b.(*SearchMessagesFilterEmpty); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SearchMessagesFilterAnimation:
+		if b, ok := b.(*SearchMessagesFilterAnimation); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SearchMessagesFilterAudio:
+		if b, ok := b.(*SearchMessagesFilterAudio); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SearchMessagesFilterDocument:
+		if b, ok := b.(*SearchMessagesFilterDocument); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SearchMessagesFilterPhoto:
+		if b, ok := b.(*SearchMessagesFilterPhoto); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SearchMessagesFilterVideo:
+		if b, ok := b.(*SearchMessagesFilterVideo); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SearchMessagesFilterVoiceNote:
+		if b, ok := b.(*SearchMessagesFilterVoiceNote); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SearchMessagesFilterPhotoAndVideo:
+		if b, ok := b.(*SearchMessagesFilterPhotoAndVideo); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SearchMessagesFilterUrl:
+		if b, ok := b.(*SearchMessagesFilterUrl); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SearchMessagesFilterChatPhoto:
+		if b, ok := b.(*SearchMessagesFilterChatPhoto); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SearchMessagesFilterVideoNote:
+		if b, ok := b.(*SearchMessagesFilterVideoNote); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SearchMessagesFilterVoiceAndVideoNote:
+		if b, ok := b.(*SearchMessagesFilterVoiceAndVideoNote); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SearchMessagesFilterMention:
+		if b, ok := b.(*SearchMessagesFilterMention); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SearchMessagesFilterUnreadMention:
+		if b, ok := b.(*SearchMessagesFilterUnreadMention); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SearchMessagesFilterFailedToSend:
+		if b, ok := b.(*SearchMessagesFilterFailedToSend); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SearchMessagesFilterPinned:
+		if b, ok := b.(*SearchMessagesFilterPinned); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalSearchMessagesFilter(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
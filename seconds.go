@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // Seconds Contains a value representing a number of seconds
@@ -28,6 +28,112 @@ func NewSeconds(seconds float64) *Seconds {
 	return &secondsTemp
 }
 
+// MarshalJSON marshals to json
+func (seconds *Seconds) MarshalJSON() ([]byte, error) {
+	return seconds.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (seconds *Seconds) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, seconds)
+}
+
+func (seconds *Seconds) appendJSON(b []byte) ([]byte, error) {
+	if seconds == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, seconds.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, seconds.tdCommon.Extra)
+	b = append(b, `,"seconds":`...)
+	b, err = appendJSONFloat(b, seconds.Seconds)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (seconds *Seconds) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			seconds.tdCommon.Type, err = r.readString()
+		case "@extra":
+			seconds.tdCommon.Extra, err = r.readString()
+		case "seconds":
+			seconds.Seconds, err = r.readFloat64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (seconds *Seconds) Clone() *Seconds {
+	if seconds == nil {
+		return nil
+	}
+	clone := *seconds
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (seconds *Seconds) Equal(other *Seconds) bool {
+	if seconds == nil || other == nil {
+		return seconds == other
+	}
+	if seconds.Seconds != other.Seconds {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (seconds *Seconds) Diff(other *Seconds) []Change {
+	return seconds.diff("", other, nil)
+}
+
+func (seconds *Seconds) diff(path string, other *Seconds, changes []Change) []Change {
+	if seconds == nil || other == nil {
+		if seconds != other {
+			changes = append(changes, Change{Path: path, Old: seconds, New: other})
+		}
+		return changes
+	}
+	if seconds.Seconds != other.Seconds {
+		changes = append(changes, Change{Path: fieldPath(path, "seconds"), Old: seconds.Seconds, New: other.Seconds})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (seconds *Seconds) String() string {
+	return objectString(seconds)
+}
+
+// LogValue logs the object as its summary
+func (seconds *Seconds) LogValue() slog.Value {
+	return slog.StringValue(objectString(seconds))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (seconds *Seconds) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, seconds)
+}
+
+func (seconds *Seconds) printText(p *printer) {
+	if seconds == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Seconds")
+	p.float("seconds", seconds.Seconds)
+	p.endObject(state)
+}
+
 // PingProxy Computes time needed to receive a response from a Telegram server through a proxy. Can be called before authorization
 // @param proxyId Proxy identifier. Use 0 to ping a Telegram server without a proxy
 func (client *Client) PingProxy(proxyId int32) (*Seconds, error) {
@@ -40,11 +146,11 @@ func (client *Client) PingProxy(proxyId int32) (*Seconds, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var seconds Seconds
-	err = json.Unmarshal(result.Raw, &seconds)
+	err = jsonUnmarshal(result.Raw, &seconds)
 	return &seconds, err
 }
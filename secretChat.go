@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // SecretChat Represents a secret chat
@@ -43,37 +43,167 @@ func NewSecretChat(id int32, userId int64, state SecretChatState, isOutbound boo
 	return &secretChatTemp
 }
 
+// MarshalJSON marshals to json
+func (secretChat *SecretChat) MarshalJSON() ([]byte, error) {
+	return secretChat.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (secretChat *SecretChat) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Id         int32  `json:"id"`          // Secret chat identifier
-		UserId     int64  `json:"user_id"`     // Identifier of the chat partner
-		IsOutbound bool   `json:"is_outbound"` // True, if the chat was created by the current user; otherwise false
-		KeyHash    []byte `json:"key_hash"`    // Hash of the currently used key for comparison with the hash of the chat partner's key. This is a string of 36 little-endian bytes, which must be split into groups of 2 bits, each denoting a pixel of one of 4 colors FFFFFF, D5E6F3, 2D5775, and 2F99C9. The pixels must be used to make a 12x12 square image filled from left to right, top to bottom. Alternatively, the first 32 bytes of the hash can be converted to the hexadecimal format and printed as 32 2-digit hex numbers
-		Layer      int32  `json:"layer"`       // Secret chat layer; determines features supported by the chat partner's application. Nested text entities and underline and strikethrough entities are supported if the layer >= 101
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	return unmarshalFast(b, secretChat)
+}
+
+func (secretChat *SecretChat) appendJSON(b []byte) ([]byte, error) {
+	if secretChat == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, secretChat.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, secretChat.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt(b, int64(secretChat.Id))
+	b = append(b, `,"user_id":`...)
+	b = appendJSONInt(b, int64(secretChat.UserId))
+	b = append(b, `,"state":`...)
+	b, err = appendJSONValue(b, secretChat.State)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	b = append(b, `,"is_outbound":`...)
+	b = appendJSONBool(b, secretChat.IsOutbound)
+	b = append(b, `,"key_hash":`...)
+	b = appendJSONBytes(b, secretChat.KeyHash)
+	b = append(b, `,"layer":`...)
+	b = appendJSONInt(b, int64(secretChat.Layer))
+	return append(b, '}'), nil
+}
 
-	secretChat.tdCommon = tempObj.tdCommon
-	secretChat.Id = tempObj.Id
-	secretChat.UserId = tempObj.UserId
-	secretChat.IsOutbound = tempObj.IsOutbound
-	secretChat.KeyHash = tempObj.KeyHash
-	secretChat.Layer = tempObj.Layer
+func (secretChat *SecretChat) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			secretChat.tdCommon.Type, err = r.readString()
+		case "@extra":
+			secretChat.tdCommon.Extra, err = r.readString()
+		case "id":
+			secretChat.Id, err = r.readInt32()
+		case "user_id":
+			secretChat.UserId, err = r.readInt64()
+		case "state":
+			secretChat.State, err = decodeSecretChatState(r)
+		case "is_outbound":
+			secretChat.IsOutbound, err = r.readBool()
+		case "key_hash":
+			secretChat.KeyHash, err = r.readBytes()
+		case "layer":
+			secretChat.Layer, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	fieldState, _ := unmarshalSecretChatState(objMap["state"])
-	secretChat.State = fieldState
+// Clone returns a deep copy of the object
+func (secretChat *SecretChat) Clone() *SecretChat {
+	if secretChat == nil {
+		return nil
+	}
+	clone := *secretChat
+	clone.State = cloneSecretChatState(secretChat.State)
+	if secretChat.KeyHash != nil {
+		clone.KeyHash = append([]byte{}, secretChat.KeyHash...)
+	}
+	return &clone
+}
 
-	return nil
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (secretChat *SecretChat) Equal(other *SecretChat) bool {
+	if secretChat == nil || other == nil {
+		return secretChat == other
+	}
+	if secretChat.Id != other.Id {
+		return false
+	}
+	if secretChat.UserId != other.UserId {
+		return false
+	}
+	if !equalSecretChatState(secretChat.State, other.State) {
+		return false
+	}
+	if secretChat.IsOutbound != other.IsOutbound {
+		return false
+	}
+	if !equalBytes(secretChat.KeyHash, other.KeyHash) {
+		return false
+	}
+	if secretChat.Layer != other.Layer {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (secretChat *SecretChat) Diff(other *SecretChat) []Change {
+	return secretChat.diff("", other, nil)
+}
+
+func (secretChat *SecretChat) diff(path string, other *SecretChat, changes []Change) []Change {
+	if secretChat == nil || other == nil {
+		if secretChat != other {
+			changes = append(changes, Change{Path: path, Old: secretChat, New: other})
+		}
+		return changes
+	}
+	if secretChat.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: secretChat.Id, New: other.Id})
+	}
+	if secretChat.UserId != other.UserId {
+		changes = append(changes, Change{Path: fieldPath(path, "user_id"), Old: secretChat.UserId, New: other.UserId})
+	}
+	changes = diffSecretChatState(fieldPath(path, "state"), secretChat.State, other.State, changes)
+	if secretChat.IsOutbound != other.IsOutbound {
+		changes = append(changes, Change{Path: fieldPath(path, "is_outbound"), Old: secretChat.IsOutbound, New: other.IsOutbound})
+	}
+	if !equalBytes(secretChat.KeyHash, other.KeyHash) {
+		changes = append(changes, Change{Path: fieldPath(path, "key_hash"), Old: secretChat.KeyHash, New: other.KeyHash})
+	}
+	if secretChat.Layer != other.Layer {
+		changes = append(changes, Change{Path: fieldPath(path, "layer"), Old: secretChat.Layer, New: other.Layer})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (secretChat *SecretChat) String() string {
+	return objectString(secretChat)
+}
+
+// LogValue logs the object as its summary
+func (secretChat *SecretChat) LogValue() slog.Value {
+	return slog.StringValue(objectString(secretChat))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (secretChat *SecretChat) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, secretChat)
+}
+
+func (secretChat *SecretChat) printText(p *printer) {
+	if secretChat == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SecretChat")
+	p.int("id", int64(secretChat.Id))
+	p.int("user_id", int64(secretChat.UserId))
+	p.object("state", secretChat.State)
+	p.bool("is_outbound", secretChat.IsOutbound)
+	p.bytes("key_hash", secretChat.KeyHash)
+	p.int("layer", int64(secretChat.Layer))
+	p.endObject(state)
 }
 
 // GetSecretChat Returns information about a secret chat by its identifier. This is an offline request
@@ -88,11 +218,11 @@ func (client *Client) GetSecretChat(secretChatId int32) (*SecretChat, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var secretChatDummy SecretChat
-	err = json.Unmarshal(result.Raw, &secretChatDummy)
+	err = jsonUnmarshal(result.Raw, &secretChatDummy)
 	return &secretChatDummy, err
 }
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // SecretChatState Describes the current secret chat state
 type SecretChatState interface {
 	GetSecretChatStateEnum() SecretChatStateEnum
+	Accept(visitor SecretChatStateVisitor)
 }
 
 // SecretChatStateEnum Alias for abstract SecretChatState 'Sub-Classes', used as constant-enum here
@@ -20,38 +22,89 @@ const (
 	SecretChatStateClosedType  SecretChatStateEnum = "secretChatStateClosed"
 )
 
+// UnknownSecretChatState is a SecretChatState of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownSecretChatState struct {
+	Unknown
+}
+
+// GetSecretChatStateEnum return the enum type of this object
+func (unknownSecretChatState *UnknownSecretChatState) GetSecretChatStateEnum() SecretChatStateEnum {
+	return SecretChatStateEnum(unknownSecretChatState.Type)
+}
+
+// Accept calls the method of the visitor for UnknownSecretChatState
+func (unknownSecretChatState *UnknownSecretChatState) Accept(visitor SecretChatStateVisitor) {
+	visitor.VisitUnknownSecretChatState(unknownSecretChatState)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownSecretChatState *UnknownSecretChatState) String() string {
+	return objectString(unknownSecretChatState)
+}
+
+// LogValue logs the object as its summary
+func (unknownSecretChatState *UnknownSecretChatState) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownSecretChatState))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownSecretChatState *UnknownSecretChatState) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownSecretChatState)
+}
+
+func (unknownSecretChatState *UnknownSecretChatState) printText(p *printer) {
+	if unknownSecretChatState == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownSecretChatState")
+	p.string("@type", unknownSecretChatState.Type)
+	p.endObject(state)
+}
+
 func unmarshalSecretChatState(rawMsg *json.RawMessage) (SecretChatState, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch SecretChatStateEnum(objMap["@type"].(string)) {
+	switch SecretChatStateEnum(typeName) {
 	case SecretChatStatePendingType:
 		var secretChatStatePending SecretChatStatePending
-		err := json.Unmarshal(*rawMsg, &secretChatStatePending)
+		err := jsonUnmarshal(*rawMsg, &secretChatStatePending)
 		return &secretChatStatePending, err
 
 	case SecretChatStateReadyType:
 		var secretChatStateReady SecretChatStateReady
-		err := json.Unmarshal(*rawMsg, &secretChatStateReady)
+		err := jsonUnmarshal(*rawMsg, &secretChatStateReady)
 		return &secretChatStateReady, err
 
 	case SecretChatStateClosedType:
 		var secretChatStateClosed SecretChatStateClosed
-		err := json.Unmarshal(*rawMsg, &secretChatStateClosed)
+		err := jsonUnmarshal(*rawMsg, &secretChatStateClosed)
 		return &secretChatStateClosed, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownSecretChatState{Unknown: unknown}, nil
 	}
 }
 
+func decodeSecretChatState(r *jsonReader) (SecretChatState, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalSecretChatState(rawMsg)
+}
+
 // SecretChatStatePending The secret chat is not yet created; waiting for the other user to get online
 type SecretChatStatePending struct {
 	tdCommon
@@ -63,7 +116,6 @@ func (secretChatStatePending *SecretChatStatePending) MessageType() string {
 }
 
 // NewSecretChatStatePending creates a new SecretChatStatePending
-//
 func NewSecretChatStatePending() *SecretChatStatePending {
 	secretChatStatePendingTemp := SecretChatStatePending{
 		tdCommon: tdCommon{Type: "secretChatStatePending"},
@@ -72,11 +124,107 @@ func NewSecretChatStatePending() *SecretChatStatePending {
 	return &secretChatStatePendingTemp
 }
 
+// MarshalJSON marshals to json
+func (secretChatStatePending *SecretChatStatePending) MarshalJSON() ([]byte, error) {
+	return secretChatStatePending.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (secretChatStatePending *SecretChatStatePending) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, secretChatStatePending)
+}
+
+func (secretChatStatePending *SecretChatStatePending) appendJSON(b []byte) ([]byte, error) {
+	if secretChatStatePending == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, secretChatStatePending.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, secretChatStatePending.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (secretChatStatePending *SecretChatStatePending) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			secretChatStatePending.tdCommon.Type, err = r.readString()
+		case "@extra":
+			secretChatStatePending.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (secretChatStatePending *SecretChatStatePending) Clone() *SecretChatStatePending {
+	if secretChatStatePending == nil {
+		return nil
+	}
+	clone := *secretChatStatePending
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (secretChatStatePending *SecretChatStatePending) Equal(other *SecretChatStatePending) bool {
+	if secretChatStatePending == nil || other == nil {
+		return secretChatStatePending == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (secretChatStatePending *SecretChatStatePending) Diff(other *SecretChatStatePending) []Change {
+	return secretChatStatePending.diff("", other, nil)
+}
+
+func (secretChatStatePending *SecretChatStatePending) diff(path string, other *SecretChatStatePending, changes []Change) []Change {
+	if secretChatStatePending == nil || other == nil {
+		if secretChatStatePending != other {
+			changes = append(changes, Change{Path: path, Old: secretChatStatePending, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (secretChatStatePending *SecretChatStatePending) String() string {
+	return objectString(secretChatStatePending)
+}
+
+// LogValue logs the object as its summary
+func (secretChatStatePending *SecretChatStatePending) LogValue() slog.Value {
+	return slog.StringValue(objectString(secretChatStatePending))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (secretChatStatePending *SecretChatStatePending) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, secretChatStatePending)
+}
+
+func (secretChatStatePending *SecretChatStatePending) printText(p *printer) {
+	if secretChatStatePending == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SecretChatStatePending")
+	p.endObject(state)
+}
+
 // GetSecretChatStateEnum return the enum type of this object
 func (secretChatStatePending *SecretChatStatePending) GetSecretChatStateEnum() SecretChatStateEnum {
 	return SecretChatStatePendingType
 }
 
+// Accept calls the method of the visitor for SecretChatStatePending
+func (secretChatStatePending *SecretChatStatePending) Accept(visitor SecretChatStateVisitor) {
+	visitor.VisitSecretChatStatePending(secretChatStatePending)
+}
+
 // SecretChatStateReady The secret chat is ready to use
 type SecretChatStateReady struct {
 	tdCommon
@@ -88,7 +236,6 @@ func (secretChatStateReady *SecretChatStateReady) MessageType() string {
 }
 
 // NewSecretChatStateReady creates a new SecretChatStateReady
-//
 func NewSecretChatStateReady() *SecretChatStateReady {
 	secretChatStateReadyTemp := SecretChatStateReady{
 		tdCommon: tdCommon{Type: "secretChatStateReady"},
@@ -97,11 +244,107 @@ func NewSecretChatStateReady() *SecretChatStateReady {
 	return &secretChatStateReadyTemp
 }
 
+// MarshalJSON marshals to json
+func (secretChatStateReady *SecretChatStateReady) MarshalJSON() ([]byte, error) {
+	return secretChatStateReady.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (secretChatStateReady *SecretChatStateReady) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, secretChatStateReady)
+}
+
+func (secretChatStateReady *SecretChatStateReady) appendJSON(b []byte) ([]byte, error) {
+	if secretChatStateReady == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, secretChatStateReady.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, secretChatStateReady.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (secretChatStateReady *SecretChatStateReady) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			secretChatStateReady.tdCommon.Type, err = r.readString()
+		case "@extra":
+			secretChatStateReady.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (secretChatStateReady *SecretChatStateReady) Clone() *SecretChatStateReady {
+	if secretChatStateReady == nil {
+		return nil
+	}
+	clone := *secretChatStateReady
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (secretChatStateReady *SecretChatStateReady) Equal(other *SecretChatStateReady) bool {
+	if secretChatStateReady == nil || other == nil {
+		return secretChatStateReady == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (secretChatStateReady *SecretChatStateReady) Diff(other *SecretChatStateReady) []Change {
+	return secretChatStateReady.diff("", other, nil)
+}
+
+func (secretChatStateReady *SecretChatStateReady) diff(path string, other *SecretChatStateReady, changes []Change) []Change {
+	if secretChatStateReady == nil || other == nil {
+		if secretChatStateReady != other {
+			changes = append(changes, Change{Path: path, Old: secretChatStateReady, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (secretChatStateReady *SecretChatStateReady) String() string {
+	return objectString(secretChatStateReady)
+}
+
+// LogValue logs the object as its summary
+func (secretChatStateReady *SecretChatStateReady) LogValue() slog.Value {
+	return slog.StringValue(objectString(secretChatStateReady))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (secretChatStateReady *SecretChatStateReady) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, secretChatStateReady)
+}
+
+func (secretChatStateReady *SecretChatStateReady) printText(p *printer) {
+	if secretChatStateReady == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SecretChatStateReady")
+	p.endObject(state)
+}
+
 // GetSecretChatStateEnum return the enum type of this object
 func (secretChatStateReady *SecretChatStateReady) GetSecretChatStateEnum() SecretChatStateEnum {
 	return SecretChatStateReadyType
 }
 
+// Accept calls the method of the visitor for SecretChatStateReady
+func (secretChatStateReady *SecretChatStateReady) Accept(visitor SecretChatStateVisitor) {
+	visitor.VisitSecretChatStateReady(secretChatStateReady)
+}
+
 // SecretChatStateClosed The secret chat is closed
 type SecretChatStateClosed struct {
 	tdCommon
@@ -113,7 +356,6 @@ func (secretChatStateClosed *SecretChatStateClosed) MessageType() string {
 }
 
 // NewSecretChatStateClosed creates a new SecretChatStateClosed
-//
 func NewSecretChatStateClosed() *SecretChatStateClosed {
 	secretChatStateClosedTemp := SecretChatStateClosed{
 		tdCommon: tdCommon{Type: "secretChatStateClosed"},
@@ -122,7 +364,213 @@ func NewSecretChatStateClosed() *SecretChatStateClosed {
 	return &secretChatStateClosedTemp
 }
 
+// MarshalJSON marshals to json
+func (secretChatStateClosed *SecretChatStateClosed) MarshalJSON() ([]byte, error) {
+	return secretChatStateClosed.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (secretChatStateClosed *SecretChatStateClosed) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, secretChatStateClosed)
+}
+
+func (secretChatStateClosed *SecretChatStateClosed) appendJSON(b []byte) ([]byte, error) {
+	if secretChatStateClosed == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, secretChatStateClosed.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, secretChatStateClosed.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (secretChatStateClosed *SecretChatStateClosed) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			secretChatStateClosed.tdCommon.Type, err = r.readString()
+		case "@extra":
+			secretChatStateClosed.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (secretChatStateClosed *SecretChatStateClosed) Clone() *SecretChatStateClosed {
+	if secretChatStateClosed == nil {
+		return nil
+	}
+	clone := *secretChatStateClosed
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (secretChatStateClosed *SecretChatStateClosed) Equal(other *SecretChatStateClosed) bool {
+	if secretChatStateClosed == nil || other == nil {
+		return secretChatStateClosed == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (secretChatStateClosed *SecretChatStateClosed) Diff(other *SecretChatStateClosed) []Change {
+	return secretChatStateClosed.diff("", other, nil)
+}
+
+func (secretChatStateClosed *SecretChatStateClosed) diff(path string, other *SecretChatStateClosed, changes []Change) []Change {
+	if secretChatStateClosed == nil || other == nil {
+		if secretChatStateClosed != other {
+			changes = append(changes, Change{Path: path, Old: secretChatStateClosed, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (secretChatStateClosed *SecretChatStateClosed) String() string {
+	return objectString(secretChatStateClosed)
+}
+
+// LogValue logs the object as its summary
+func (secretChatStateClosed *SecretChatStateClosed) LogValue() slog.Value {
+	return slog.StringValue(objectString(secretChatStateClosed))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (secretChatStateClosed *SecretChatStateClosed) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, secretChatStateClosed)
+}
+
+func (secretChatStateClosed *SecretChatStateClosed) printText(p *printer) {
+	if secretChatStateClosed == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SecretChatStateClosed")
+	p.endObject(state)
+}
+
 // GetSecretChatStateEnum return the enum type of this object
 func (secretChatStateClosed *SecretChatStateClosed) GetSecretChatStateEnum() SecretChatStateEnum {
 	return SecretChatStateClosedType
 }
+
+// Accept calls the method of the visitor for SecretChatStateClosed
+func (secretChatStateClosed *SecretChatStateClosed) Accept(visitor SecretChatStateVisitor) {
+	visitor.VisitSecretChatStateClosed(secretChatStateClosed)
+}
+
+// SecretChatStateVisitor visits every type of SecretChatState, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to SecretChatState, until they handle it too.
+type SecretChatStateVisitor interface {
+	VisitSecretChatStatePending(secretChatStatePending *SecretChatStatePending)
+	VisitSecretChatStateReady(secretChatStateReady *SecretChatStateReady)
+	VisitSecretChatStateClosed(secretChatStateClosed *SecretChatStateClosed)
+	VisitUnknownSecretChatState(unknownSecretChatState *UnknownSecretChatState)
+}
+
+// SecretChatStateCases holds the function MatchSecretChatState calls for each type of SecretChatState;
+// types without a function are passed to the default case
+type SecretChatStateCases[R any] struct {
+	SecretChatStatePending func(secretChatStatePending *SecretChatStatePending) R
+	SecretChatStateReady   func(secretChatStateReady *SecretChatStateReady) R
+	SecretChatStateClosed  func(secretChatStateClosed *SecretChatStateClosed) R
+}
+
+// MatchSecretChatState calls the case of the type of secretChatState, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement SecretChatStateVisitor
+// to have the compiler check every type is handled.
+func MatchSecretChatState[R any](secretChatState SecretChatState, cases SecretChatStateCases[R], defaultCase func(secretChatState SecretChatState) R) R {
+	switch value := secretChatState.(type) {
+	case *SecretChatStatePending:
+		if cases.SecretChatStatePending != nil {
+			return cases.SecretChatStatePending(value)
+		}
+	case *SecretChatStateReady:
+		if cases.SecretChatStateReady != nil {
+			return cases.SecretChatStateReady(value)
+		}
+	case *SecretChatStateClosed:
+		if cases.SecretChatStateClosed != nil {
+			return cases.SecretChatStateClosed(value)
+		}
+	}
+	return defaultCase(secretChatState)
+}
+
+// Clone returns a deep copy of the object
+func (unknownSecretChatState *UnknownSecretChatState) Clone() *UnknownSecretChatState {
+	if unknownSecretChatState == nil {
+		return nil
+	}
+	return &UnknownSecretChatState{Unknown: unknownSecretChatState.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownSecretChatState *UnknownSecretChatState) Equal(other *UnknownSecretChatState) bool {
+	if unknownSecretChatState == nil || other == nil {
+		return unknownSecretChatState == other
+	}
+	return unknownSecretChatState.Unknown.equal(other.Unknown)
+}
+
+func cloneSecretChatState(secretChatState SecretChatState) SecretChatState {
+	switch value := secretChatState.(type) {
+	case *SecretChatStatePending:
+		return value.Clone()
+	case *SecretChatStateReady:
+		return value.Clone()
+	case *SecretChatStateClosed:
+		return value.Clone()
+	case *UnknownSecretChatState:
+		return value.Clone()
+	}
+	return secretChatState
+}
+
+func equalSecretChatState(a SecretChatState, b SecretChatState) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *SecretChatStatePending:
+		b, ok := b.(*SecretChatStatePending)
+		return ok && a.Equal(b)
+	case *SecretChatStateReady:
+		b, ok := b.(*SecretChatStateReady)
+		return ok && a.Equal(b)
+	case *SecretChatStateClosed:
+		b, ok := b.(*SecretChatStateClosed)
+		return ok && a.Equal(b)
+	case *UnknownSecretChatState:
+		b, ok := b.(*UnknownSecretChatState)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffSecretChatState(path string, a SecretChatState, b SecretChatState, changes []Change) []Change {
+	switch a := a.(type) {
+	case *SecretChatStatePending:
+		if b, ok := b.(*SecretChatStatePending); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SecretChatStateReady:
+		if b, ok := b.(*SecretChatStateReady); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SecretChatStateClosed:
+		if b, ok := b.(*SecretChatStateClosed); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalSecretChatState(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // Session Contains information about one session in a Telegram application used by the current user. Sessions must be shown to the user in the returned order
@@ -76,6 +76,284 @@ func NewSession(id JSONInt64, isCurrent bool, isPasswordPending bool, canAcceptS
 	return &sessionTemp
 }
 
+// MarshalJSON marshals to json
+func (session *Session) MarshalJSON() ([]byte, error) {
+	return session.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (session *Session) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, session)
+}
+
+func (session *Session) appendJSON(b []byte) ([]byte, error) {
+	if session == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, session.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, session.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt64String(b, int64(session.Id))
+	b = append(b, `,"is_current":`...)
+	b = appendJSONBool(b, session.IsCurrent)
+	b = append(b, `,"is_password_pending":`...)
+	b = appendJSONBool(b, session.IsPasswordPending)
+	b = append(b, `,"can_accept_secret_chats":`...)
+	b = appendJSONBool(b, session.CanAcceptSecretChats)
+	b = append(b, `,"can_accept_calls":`...)
+	b = appendJSONBool(b, session.CanAcceptCalls)
+	b = append(b, `,"api_id":`...)
+	b = appendJSONInt(b, int64(session.ApiId))
+	b = append(b, `,"application_name":`...)
+	b = appendJSONString(b, session.ApplicationName)
+	b = append(b, `,"application_version":`...)
+	b = appendJSONString(b, session.ApplicationVersion)
+	b = append(b, `,"is_official_application":`...)
+	b = appendJSONBool(b, session.IsOfficialApplication)
+	b = append(b, `,"device_model":`...)
+	b = appendJSONString(b, session.DeviceModel)
+	b = append(b, `,"platform":`...)
+	b = appendJSONString(b, session.Platform)
+	b = append(b, `,"system_version":`...)
+	b = appendJSONString(b, session.SystemVersion)
+	b = append(b, `,"log_in_date":`...)
+	b = appendJSONInt(b, int64(session.LogInDate))
+	b = append(b, `,"last_active_date":`...)
+	b = appendJSONInt(b, int64(session.LastActiveDate))
+	b = append(b, `,"ip":`...)
+	b = appendJSONString(b, session.Ip)
+	b = append(b, `,"country":`...)
+	b = appendJSONString(b, session.Country)
+	b = append(b, `,"region":`...)
+	b = appendJSONString(b, session.Region)
+	return append(b, '}'), nil
+}
+
+func (session *Session) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			session.tdCommon.Type, err = r.readString()
+		case "@extra":
+			session.tdCommon.Extra, err = r.readString()
+		case "id":
+			session.Id, err = r.readJSONInt64()
+		case "is_current":
+			session.IsCurrent, err = r.readBool()
+		case "is_password_pending":
+			session.IsPasswordPending, err = r.readBool()
+		case "can_accept_secret_chats":
+			session.CanAcceptSecretChats, err = r.readBool()
+		case "can_accept_calls":
+			session.CanAcceptCalls, err = r.readBool()
+		case "api_id":
+			session.ApiId, err = r.readInt32()
+		case "application_name":
+			session.ApplicationName, err = r.readString()
+		case "application_version":
+			session.ApplicationVersion, err = r.readString()
+		case "is_official_application":
+			session.IsOfficialApplication, err = r.readBool()
+		case "device_model":
+			session.DeviceModel, err = r.readString()
+		case "platform":
+			session.Platform, err = r.readString()
+		case "system_version":
+			session.SystemVersion, err = r.readString()
+		case "log_in_date":
+			session.LogInDate, err = r.readInt32()
+		case "last_active_date":
+			session.LastActiveDate, err = r.readInt32()
+		case "ip":
+			session.Ip, err = r.readString()
+		case "country":
+			session.Country, err = r.readString()
+		case "region":
+			session.Region, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (session *Session) Clone() *Session {
+	if session == nil {
+		return nil
+	}
+	clone := *session
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (session *Session) Equal(other *Session) bool {
+	if session == nil || other == nil {
+		return session == other
+	}
+	if session.Id != other.Id {
+		return false
+	}
+	if session.IsCurrent != other.IsCurrent {
+		return false
+	}
+	if session.IsPasswordPending != other.IsPasswordPending {
+		return false
+	}
+	if session.CanAcceptSecretChats != other.CanAcceptSecretChats {
+		return false
+	}
+	if session.CanAcceptCalls != other.CanAcceptCalls {
+		return false
+	}
+	if session.ApiId != other.ApiId {
+		return false
+	}
+	if session.ApplicationName != other.ApplicationName {
+		return false
+	}
+	if session.ApplicationVersion != other.ApplicationVersion {
+		return false
+	}
+	if session.IsOfficialApplication != other.IsOfficialApplication {
+		return false
+	}
+	if session.DeviceModel != other.DeviceModel {
+		return false
+	}
+	if session.Platform != other.Platform {
+		return false
+	}
+	if session.SystemVersion != other.SystemVersion {
+		return false
+	}
+	if session.LogInDate != other.LogInDate {
+		return false
+	}
+	if session.LastActiveDate != other.LastActiveDate {
+		return false
+	}
+	if session.Ip != other.Ip {
+		return false
+	}
+	if session.Country != other.Country {
+		return false
+	}
+	if session.Region != other.Region {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (session *Session) Diff(other *Session) []Change {
+	return session.diff("", other, nil)
+}
+
+func (session *Session) diff(path string, other *Session, changes []Change) []Change {
+	if session == nil || other == nil {
+		if session != other {
+			changes = append(changes, Change{Path: path, Old: session, New: other})
+		}
+		return changes
+	}
+	if session.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: session.Id, New: other.Id})
+	}
+	if session.IsCurrent != other.IsCurrent {
+		changes = append(changes, Change{Path: fieldPath(path, "is_current"), Old: session.IsCurrent, New: other.IsCurrent})
+	}
+	if session.IsPasswordPending != other.IsPasswordPending {
+		changes = append(changes, Change{Path: fieldPath(path, "is_password_pending"), Old: session.IsPasswordPending, New: other.IsPasswordPending})
+	}
+	if session.CanAcceptSecretChats != other.CanAcceptSecretChats {
+		changes = append(changes, Change{Path: fieldPath(path, "can_accept_secret_chats"), Old: session.CanAcceptSecretChats, New: other.CanAcceptSecretChats})
+	}
+	if session.CanAcceptCalls != other.CanAcceptCalls {
+		changes = append(changes, Change{Path: fieldPath(path, "can_accept_calls"), Old: session.CanAcceptCalls, New: other.CanAcceptCalls})
+	}
+	if session.ApiId != other.ApiId {
+		changes = append(changes, Change{Path: fieldPath(path, "api_id"), Old: session.ApiId, New: other.ApiId})
+	}
+	if session.ApplicationName != other.ApplicationName {
+		changes = append(changes, Change{Path: fieldPath(path, "application_name"), Old: session.ApplicationName, New: other.ApplicationName})
+	}
+	if session.ApplicationVersion != other.ApplicationVersion {
+		changes = append(changes, Change{Path: fieldPath(path, "application_version"), Old: session.ApplicationVersion, New: other.ApplicationVersion})
+	}
+	if session.IsOfficialApplication != other.IsOfficialApplication {
+		changes = append(changes, Change{Path: fieldPath(path, "is_official_application"), Old: session.IsOfficialApplication, New: other.IsOfficialApplication})
+	}
+	if session.DeviceModel != other.DeviceModel {
+		changes = append(changes, Change{Path: fieldPath(path, "device_model"), Old: session.DeviceModel, New: other.DeviceModel})
+	}
+	if session.Platform != other.Platform {
+		changes = append(changes, Change{Path: fieldPath(path, "platform"), Old: session.Platform, New: other.Platform})
+	}
+	if session.SystemVersion != other.SystemVersion {
+		changes = append(changes, Change{Path: fieldPath(path, "system_version"), Old: session.SystemVersion, New: other.SystemVersion})
+	}
+	if session.LogInDate != other.LogInDate {
+		changes = append(changes, Change{Path: fieldPath(path, "log_in_date"), Old: session.LogInDate, New: other.LogInDate})
+	}
+	if session.LastActiveDate != other.LastActiveDate {
+		changes = append(changes, Change{Path: fieldPath(path, "last_active_date"), Old: session.LastActiveDate, New: other.LastActiveDate})
+	}
+	if session.Ip != other.Ip {
+		changes = append(changes, Change{Path: fieldPath(path, "ip"), Old: session.Ip, New: other.Ip})
+	}
+	if session.Country != other.Country {
+		changes = append(changes, Change{Path: fieldPath(path, "country"), Old: session.Country, New: other.Country})
+	}
+	if session.Region != other.Region {
+		changes = append(changes, Change{Path: fieldPath(path, "region"), Old: session.Region, New: other.Region})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (session *Session) String() string {
+	return objectString(session)
+}
+
+// LogValue logs the object as its summary
+func (session *Session) LogValue() slog.Value {
+	return slog.StringValue(objectString(session))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (session *Session) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, session)
+}
+
+func (session *Session) printText(p *printer) {
+	if session == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Session")
+	p.int("id", int64(session.Id))
+	p.bool("is_current", session.IsCurrent)
+	p.bool("is_password_pending", session.IsPasswordPending)
+	p.bool("can_accept_secret_chats", session.CanAcceptSecretChats)
+	p.bool("can_accept_calls", session.CanAcceptCalls)
+	p.int("api_id", int64(session.ApiId))
+	p.string("application_name", session.ApplicationName)
+	p.string("application_version", session.ApplicationVersion)
+	p.bool("is_official_application", session.IsOfficialApplication)
+	p.string("device_model", session.DeviceModel)
+	p.string("platform", session.Platform)
+	p.string("system_version", session.SystemVersion)
+	p.int("log_in_date", int64(session.LogInDate))
+	p.int("last_active_date", int64(session.LastActiveDate))
+	p.string("ip", session.Ip)
+	p.string("country", session.Country)
+	p.string("region", session.Region)
+	p.endObject(state)
+}
+
 // ConfirmQrCodeAuthentication Confirms QR code authentication on another device. Returns created session on success
 // @param link A link from a QR code. The link must be scanned by the in-app camera
 func (client *Client) ConfirmQrCodeAuthentication(link string) (*Session, error) {
@@ -88,11 +366,11 @@ func (client *Client) ConfirmQrCodeAuthentication(link string) (*Session, error)
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var session Session
-	err = json.Unmarshal(result.Raw, &session)
+	err = jsonUnmarshal(result.Raw, &session)
 	return &session, err
 }
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // Sessions Contains a list of sessions
@@ -31,6 +31,161 @@ func NewSessions(sessions []Session, inactiveSessionTtlDays int32) *Sessions {
 	return &sessionsTemp
 }
 
+// MarshalJSON marshals to json
+func (sessions *Sessions) MarshalJSON() ([]byte, error) {
+	return sessions.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (sessions *Sessions) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, sessions)
+}
+
+func (sessions *Sessions) appendJSON(b []byte) ([]byte, error) {
+	if sessions == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, sessions.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, sessions.tdCommon.Extra)
+	b = append(b, `,"sessions":`...)
+	if sessions.Sessions == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range sessions.Sessions {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = sessions.Sessions[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"inactive_session_ttl_days":`...)
+	b = appendJSONInt(b, int64(sessions.InactiveSessionTtlDays))
+	return append(b, '}'), nil
+}
+
+func (sessions *Sessions) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			sessions.tdCommon.Type, err = r.readString()
+		case "@extra":
+			sessions.tdCommon.Extra, err = r.readString()
+		case "sessions":
+			if r.readNull() {
+				sessions.Sessions = nil
+			} else {
+				sessions.Sessions = make([]Session, 0)
+				err = r.readArray(func() (err error) {
+					var item0 Session
+					err = item0.decodeJSON(r)
+					sessions.Sessions = append(sessions.Sessions, item0)
+					return
+				})
+			}
+		case "inactive_session_ttl_days":
+			sessions.InactiveSessionTtlDays, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (sessions *Sessions) Clone() *Sessions {
+	if sessions == nil {
+		return nil
+	}
+	clone := *sessions
+	if sessions.Sessions != nil {
+		clone.Sessions = make([]Session, len(sessions.Sessions))
+		for i0 := range sessions.Sessions {
+			clone.Sessions[i0] = *sessions.Sessions[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (sessions *Sessions) Equal(other *Sessions) bool {
+	if sessions == nil || other == nil {
+		return sessions == other
+	}
+	if len(sessions.Sessions) != len(other.Sessions) {
+		return false
+	}
+	for i0 := range sessions.Sessions {
+		if !sessions.Sessions[i0].Equal(&other.Sessions[i0]) {
+			return false
+		}
+	}
+	if sessions.InactiveSessionTtlDays != other.InactiveSessionTtlDays {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (sessions *Sessions) Diff(other *Sessions) []Change {
+	return sessions.diff("", other, nil)
+}
+
+func (sessions *Sessions) diff(path string, other *Sessions, changes []Change) []Change {
+	if sessions == nil || other == nil {
+		if sessions != other {
+			changes = append(changes, Change{Path: path, Old: sessions, New: other})
+		}
+		return changes
+	}
+	if len(sessions.Sessions) != len(other.Sessions) {
+		changes = append(changes, Change{Path: fieldPath(path, "sessions"), Old: sessions.Sessions, New: other.Sessions})
+	} else {
+		for i0 := range sessions.Sessions {
+			changes = sessions.Sessions[i0].diff(indexPath(fieldPath(path, "sessions"), i0), &other.Sessions[i0], changes)
+		}
+	}
+	if sessions.InactiveSessionTtlDays != other.InactiveSessionTtlDays {
+		changes = append(changes, Change{Path: fieldPath(path, "inactive_session_ttl_days"), Old: sessions.InactiveSessionTtlDays, New: other.InactiveSessionTtlDays})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (sessions *Sessions) String() string {
+	return objectString(sessions)
+}
+
+// LogValue logs the object as its summary
+func (sessions *Sessions) LogValue() slog.Value {
+	return slog.StringValue(objectString(sessions))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (sessions *Sessions) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, sessions)
+}
+
+func (sessions *Sessions) printText(p *printer) {
+	if sessions == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Sessions")
+	p.vector("sessions", len(sessions.Sessions), func(i0 int) {
+		sessions.Sessions[i0].printText(p)
+	})
+	p.int("inactive_session_ttl_days", int64(sessions.InactiveSessionTtlDays))
+	p.endObject(state)
+}
+
 // GetActiveSessions Returns all active sessions of the current user
 func (client *Client) GetActiveSessions() (*Sessions, error) {
 	result, err := client.SendAndCatch(UpdateData{
@@ -41,11 +196,11 @@ func (client *Client) GetActiveSessions() (*Sessions, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var sessions Sessions
-	err = json.Unmarshal(result.Raw, &sessions)
+	err = jsonUnmarshal(result.Raw, &sessions)
 	return &sessions, err
 }
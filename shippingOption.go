@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // ShippingOption One shipping option
 type ShippingOption struct {
 	tdCommon
@@ -28,3 +33,169 @@ func NewShippingOption(id string, title string, priceParts []LabeledPricePart) *
 
 	return &shippingOptionTemp
 }
+
+// MarshalJSON marshals to json
+func (shippingOption *ShippingOption) MarshalJSON() ([]byte, error) {
+	return shippingOption.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (shippingOption *ShippingOption) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, shippingOption)
+}
+
+func (shippingOption *ShippingOption) appendJSON(b []byte) ([]byte, error) {
+	if shippingOption == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, shippingOption.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, shippingOption.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, shippingOption.Id)
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, shippingOption.Title)
+	b = append(b, `,"price_parts":`...)
+	if shippingOption.PriceParts == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range shippingOption.PriceParts {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = shippingOption.PriceParts[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (shippingOption *ShippingOption) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			shippingOption.tdCommon.Type, err = r.readString()
+		case "@extra":
+			shippingOption.tdCommon.Extra, err = r.readString()
+		case "id":
+			shippingOption.Id, err = r.readString()
+		case "title":
+			shippingOption.Title, err = r.readString()
+		case "price_parts":
+			if r.readNull() {
+				shippingOption.PriceParts = nil
+			} else {
+				shippingOption.PriceParts = make([]LabeledPricePart, 0)
+				err = r.readArray(func() (err error) {
+					var item0 LabeledPricePart
+					err = item0.decodeJSON(r)
+					shippingOption.PriceParts = append(shippingOption.PriceParts, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (shippingOption *ShippingOption) Clone() *ShippingOption {
+	if shippingOption == nil {
+		return nil
+	}
+	clone := *shippingOption
+	if shippingOption.PriceParts != nil {
+		clone.PriceParts = make([]LabeledPricePart, len(shippingOption.PriceParts))
+		for i0 := range shippingOption.PriceParts {
+			clone.PriceParts[i0] = *shippingOption.PriceParts[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (shippingOption *ShippingOption) Equal(other *ShippingOption) bool {
+	if shippingOption == nil || other == nil {
+		return shippingOption == other
+	}
+	if shippingOption.Id != other.Id {
+		return false
+	}
+	if shippingOption.Title != other.Title {
+		return false
+	}
+	if len(shippingOption.PriceParts) != len(other.PriceParts) {
+		return false
+	}
+	for i0 := range shippingOption.PriceParts {
+		if !shippingOption.PriceParts[i0].Equal(&other.PriceParts[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (shippingOption *ShippingOption) Diff(other *ShippingOption) []Change {
+	return shippingOption.diff("", other, nil)
+}
+
+func (shippingOption *ShippingOption) diff(path string, other *ShippingOption, changes []Change) []Change {
+	if shippingOption == nil || other == nil {
+		if shippingOption != other {
+			changes = append(changes, Change{Path: path, Old: shippingOption, New: other})
+		}
+		return changes
+	}
+	if shippingOption.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: shippingOption.Id, New: other.Id})
+	}
+	if shippingOption.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: shippingOption.Title, New: other.Title})
+	}
+	if len(shippingOption.PriceParts) != len(other.PriceParts) {
+		changes = append(changes, Change{Path: fieldPath(path, "price_parts"), Old: shippingOption.PriceParts, New: other.PriceParts})
+	} else {
+		for i0 := range shippingOption.PriceParts {
+			changes = shippingOption.PriceParts[i0].diff(indexPath(fieldPath(path, "price_parts"), i0), &other.PriceParts[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (shippingOption *ShippingOption) String() string {
+	return objectString(shippingOption)
+}
+
+// LogValue logs the object as its summary
+func (shippingOption *ShippingOption) LogValue() slog.Value {
+	return slog.StringValue(objectString(shippingOption))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (shippingOption *ShippingOption) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, shippingOption)
+}
+
+func (shippingOption *ShippingOption) printText(p *printer) {
+	if shippingOption == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ShippingOption")
+	p.string("id", shippingOption.Id)
+	p.string("title", shippingOption.Title)
+	p.vector("price_parts", len(shippingOption.PriceParts), func(i0 int) {
+		shippingOption.PriceParts[i0].printText(p)
+	})
+	p.endObject(state)
+}
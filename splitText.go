@@ -0,0 +1,176 @@
+package tdlib
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf16"
+)
+
+// SplitFormattedText splits a text longer than maxLength UTF-16 code units into texts which aren't,
+// e.g. to send it as multiple messages. Texts are split at the last paragraph break within the limit,
+// otherwise at the last line break or space, and only mid-word if a word is longer than the limit.
+// URLs, mentions and other entities which break when split aren't split unless they're longer than the limit.
+// Entities are moved into the texts they cover, and ones spanning a split, e.g. bold or pre, are closed at
+// the end of a text and reopened at the start of the next one.
+func SplitFormattedText(text *FormattedText, maxLength int) []*FormattedText {
+	if text == nil {
+		return nil
+	}
+	units := utf16.Encode([]rune(text.Text))
+	if len(units) <= maxLength || maxLength <= 0 {
+		return []*FormattedText{text}
+	}
+
+	var texts []*FormattedText
+	start := 0
+	for start < len(units) {
+		if start > 0 {
+			// drop the line breaks of the paragraph break the text was split at
+			for start < len(units) && units[start] == '\n' {
+				start++
+			}
+			if start == len(units) {
+				break
+			}
+		}
+		if len(units)-start <= maxLength {
+			texts = append(texts, textRange(text, units, start, len(units)))
+			break
+		}
+
+		split := splitPoint(units, text.Entities, start, start+maxLength)
+		end := split
+		for end > start && (units[end-1] == '\n' || units[end-1] == ' ') {
+			end--
+		}
+		if end > start {
+			texts = append(texts, textRange(text, units, start, end))
+		}
+		start = split
+	}
+	return texts
+}
+
+// splitPoint returns where to split the text starting at start so that the first part ends at limit at most
+func splitPoint(units []uint16, entities []TextEntity, start int, limit int) int {
+	for _, isBreak := range []func(i int) bool{
+		func(i int) bool { return i-start >= 2 && units[i-1] == '\n' && units[i-2] == '\n' },
+		func(i int) bool { return units[i-1] == '\n' },
+		func(i int) bool { return units[i-1] == ' ' },
+	} {
+		for i := limit; i > start; i-- {
+			if isBreak(i) && !splitsEntity(entities, i) {
+				return i
+			}
+		}
+	}
+
+	// no break within the limit, split the word but not a surrogate pair or an unsplittable entity
+	split := 0
+	for i := limit; i > start; i-- {
+		if !isHighSurrogate(units[i-1]) {
+			if !splitsEntity(entities, i) {
+				return i
+			}
+			if split == 0 {
+				split = i
+			}
+		}
+	}
+	if split == 0 {
+		return limit
+	}
+	return split
+}
+
+// isHighSurrogate reports whether a code unit is the first one of a surrogate pair
+func isHighSurrogate(unit uint16) bool {
+	return unit >= 0xD800 && unit < 0xDC00
+}
+
+// splitsEntity reports whether splitting at i splits an entity which breaks when split, e.g. a URL
+func splitsEntity(entities []TextEntity, i int) bool {
+	for _, entity := range entities {
+		if int(entity.Offset) < i && i < int(entity.Offset+entity.Length) && !isSplittableEntity(entity) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSplittableEntity reports whether an entity keeps its meaning when split, as formatting does
+func isSplittableEntity(entity TextEntity) bool {
+	if entity.Type == nil {
+		return false
+	}
+	switch entity.Type.GetTextEntityTypeEnum() {
+	case TextEntityTypeBoldType, TextEntityTypeItalicType, TextEntityTypeUnderlineType, TextEntityTypeStrikethroughType,
+		TextEntityTypeSpoilerType, TextEntityTypeCodeType, TextEntityTypePreType, TextEntityTypePreCodeType,
+		TextEntityTypeTextUrlType, TextEntityTypeMentionNameType:
+		return true
+	}
+	return false
+}
+
+// textRange returns the part of a text from start to end, with the entities moved and cut to the part.
+// Unsplittable entities which are cut are dropped.
+func textRange(text *FormattedText, units []uint16, start int, end int) *FormattedText {
+	var entities []TextEntity
+	for _, entity := range text.Entities {
+		entityStart := int(entity.Offset)
+		entityEnd := int(entity.Offset + entity.Length)
+		if entityStart < start {
+			entityStart = start
+		}
+		if entityEnd > end {
+			entityEnd = end
+		}
+		if entityEnd <= entityStart {
+			continue
+		}
+		if entityEnd-entityStart != int(entity.Length) && !isSplittableEntity(entity) {
+			continue
+		}
+		entities = append(entities, *NewTextEntity(int32(entityStart-start), int32(entityEnd-entityStart), cloneTextEntityType(entity.Type)))
+	}
+	return NewFormattedText(string(utf16.Decode(units[start:end])), entities)
+}
+
+// SendLongMessage sends a text which may be longer than the message text limit of the client as messages
+// which reply to the previous one, see SplitFormattedText. The first message replies to replyToMessageId
+// and the last one has the reply markup. Each message is waited for until it's sent, or until ctx is done, as
+// the next one replies to its final identifier. The messages sent before an error are returned with it,
+// including a message which failed to be sent or wasn't sent before ctx was done.
+func (client *Client) SendLongMessage(ctx context.Context, chatId int64, messageThreadId int64, replyToMessageId int64, options *MessageSendOptions, replyMarkup ReplyMarkup, text *FormattedText) ([]*Message, error) {
+	texts := SplitFormattedText(text, client.Limits().MessageTextLength)
+
+	messages := make([]*Message, 0, len(texts))
+	for i, text := range texts {
+		builder := client.Message(chatId).Thread(messageThreadId).Reply(replyToMessageId).FormattedText(text)
+		if options != nil {
+			builder.options = options
+		}
+		if i == len(texts)-1 {
+			builder.Keyboard(replyMarkup)
+		}
+		builder.sendResultTimeout = 0
+
+		message, err := builder.Send(ctx)
+		if err != nil {
+			return messages, err
+		}
+		messages = append(messages, message)
+		switch state := message.SendingState.(type) {
+		case nil:
+		case *MessageSendingStateFailed:
+			return messages, fmt.Errorf("message %d of %d failed to be sent: %d %s", i+1, len(texts), state.ErrorCode, state.ErrorMessage)
+		default:
+			if err := ctx.Err(); err != nil {
+				return messages, fmt.Errorf("message %d of %d wasn't sent: %w", i+1, len(texts), err)
+			}
+			return messages, fmt.Errorf("message %d of %d wasn't sent", i+1, len(texts))
+		}
+		replyToMessageId = message.Id
+	}
+	return messages, nil
+}
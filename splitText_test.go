@@ -0,0 +1,149 @@
+package tdlib
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// entity returns a text entity
+func entity(offset int32, length int32, entityType TextEntityType) TextEntity {
+	return *NewTextEntity(offset, length, entityType)
+}
+
+// describeTexts returns the texts and their entities as strings, e.g. "bold text [0:4 textEntityTypeBold]"
+func describeTexts(texts []*FormattedText) []string {
+	descriptions := []string{}
+	for _, text := range texts {
+		var entities []string
+		for _, entity := range text.Entities {
+			entities = append(entities, fmt.Sprintf("%d:%d %s", entity.Offset, entity.Length, entity.Type.GetTextEntityTypeEnum()))
+		}
+		descriptions = append(descriptions, fmt.Sprintf("%s %v", text.Text, entities))
+	}
+	return descriptions
+}
+
+func TestSplitFormattedText(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		entities  []TextEntity
+		maxLength int
+		want      []string
+	}{
+		{"short", "aaaa bbbb", nil, 9, []string{"aaaa bbbb []"}},
+		{"paragraph", "aa bb\ncc\n\ndd ee", nil, 12, []string{"aa bb\ncc []", "dd ee []"}},
+		{"line", "aaa\nbbb ccc", nil, 8, []string{"aaa []", "bbb ccc []"}},
+		{"space", "aaa bbb ccc", nil, 8, []string{"aaa bbb []", "ccc []"}},
+		{"hard", "abcdefghij", nil, 4, []string{"abcd []", "efgh []", "ij []"}},
+		{"space before the hard split", "ab abcdefgh", nil, 4, []string{"ab []", "abcd []", "efgh []"}},
+		// 😀 is a surrogate pair of 2 UTF-16 code units
+		{"surrogate pair at the limit", "aaa😀b", nil, 4, []string{"aaa []", "😀b []"}},
+		{"surrogate pairs", "😀😀😀", nil, 3, []string{"😀 []", "😀 []", "😀 []"}},
+		{"bold spanning the split", "aaaa bbbb", []TextEntity{entity(0, 9, NewTextEntityTypeBold())}, 5,
+			[]string{"aaaa [0:4 textEntityTypeBold]", "bbbb [0:4 textEntityTypeBold]"}},
+		{"pre spanning the split", "x aaaa\nbbbb", []TextEntity{entity(2, 9, NewTextEntityTypePre())}, 8,
+			[]string{"x aaaa [2:4 textEntityTypePre]", "bbbb [0:4 textEntityTypePre]"}},
+		{"entities moved", "aaaa bbbb", []TextEntity{entity(0, 2, NewTextEntityTypeBold()), entity(6, 2, NewTextEntityTypeBold())}, 5,
+			[]string{"aaaa [0:2 textEntityTypeBold]", "bbbb [1:2 textEntityTypeBold]"}},
+		{"URL kept whole", "aa http://x.io", []TextEntity{entity(3, 11, NewTextEntityTypeUrl())}, 12,
+			[]string{"aa []", "http://x.io [0:11 textEntityTypeUrl]"}},
+		{"space in a URL", "a bc d", []TextEntity{entity(2, 4, NewTextEntityTypeUrl())}, 4,
+			[]string{"a []", "bc d [0:4 textEntityTypeUrl]"}},
+		{"URL longer than the limit dropped", "aa http://x.io", []TextEntity{entity(3, 11, NewTextEntityTypeUrl())}, 6,
+			[]string{"aa []", "http:/ []", "/x.io []"}},
+		{"mention longer than the limit dropped", "@abcdefgh", []TextEntity{entity(0, 9, NewTextEntityTypeMention())}, 5,
+			[]string{"@abcd []", "efgh []"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			texts := SplitFormattedText(NewFormattedText(test.text, test.entities), test.maxLength)
+			if got := describeTexts(texts); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("SplitFormattedText returns %q, want %q", got, test.want)
+			}
+		})
+	}
+
+	if texts := SplitFormattedText(nil, 10); texts != nil {
+		t.Errorf("SplitFormattedText(nil) returns %v, want nil", texts)
+	}
+}
+
+// longMessageClient returns a client sending messages with sendMessageTransport whose message text limit is 5
+func longMessageClient(delay time.Duration, result func(oldID int64, message map[string]interface{}) map[string]interface{}) (*Client, *fakeTransport) {
+	transport := sendMessageTransport(delay, result)
+	client := NewClientWithTransport(Config{}, transport)
+	client.setLimitOption("message_text_length_max", &OptionValueInteger{Value: 5})
+	return client, transport
+}
+
+func TestSendLongMessage(t *testing.T) {
+	// the send results come later than SendAndCatch waits for them
+	client, transport := longMessageClient(1100*time.Millisecond, sendSucceeded)
+	keyboard := NewReplyMarkupInlineKeyboard([][]InlineKeyboardButton{{callbackButton("data")}})
+
+	messages, err := client.SendLongMessage(context.Background(), 5, 0, 7, nil, keyboard, NewFormattedText("aaaa bbbb cccc", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(messages) != 3 {
+		t.Fatalf("sent %d messages, want 3", len(messages))
+	}
+
+	// every message replies to the previous one by its final identifier
+	requests := transport.sent("sendMessage")
+	for i, want := range []int64{7, 1 << 20, 2 << 20} {
+		if replyTo, _ := int64Value(requests[i]["reply_to_message_id"]); replyTo != want {
+			t.Errorf("message %d replies to %v, want %d", i, requests[i]["reply_to_message_id"], want)
+		}
+		if hasKeyboard := requests[i]["reply_markup"] != nil; hasKeyboard != (i == 2) {
+			t.Errorf("message %d has the reply markup %v", i, requests[i]["reply_markup"])
+		}
+	}
+	for i, message := range messages {
+		if message.Id != int64(i+1)<<20 || message.SendingState != nil {
+			t.Errorf("message %d has id %d and sending state %v, want a sent message", i, message.Id, message.SendingState)
+		}
+	}
+}
+
+func TestSendLongMessageNotSent(t *testing.T) {
+	tests := []struct {
+		name   string
+		result func(oldID int64, message map[string]interface{}) map[string]interface{}
+		err    string
+		state  MessageSendingState
+	}{
+		{"failed", sendFailed, "message 1 of 2 failed to be sent: 400 CHAT_WRITE_FORBIDDEN", &MessageSendingStateFailed{}},
+		{"no result", func(int64, map[string]interface{}) map[string]interface{} { return nil }, "message 1 of 2 wasn't sent: context deadline exceeded", &MessageSendingStatePending{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, transport := longMessageClient(0, test.result)
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			messages, err := client.SendLongMessage(ctx, 5, 0, 0, nil, nil, NewFormattedText("aaaa bbbb", nil))
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("SendLongMessage returns error %v, want %q", err, test.err)
+			}
+			// the message which wasn't sent is returned with the error
+			if len(messages) != 1 || reflect.TypeOf(messages[0].SendingState) != reflect.TypeOf(test.state) {
+				t.Errorf("SendLongMessage returns %d messages, want the message with the sending state %T", len(messages), test.state)
+			}
+			if requests := transport.sent("sendMessage"); len(requests) != 1 {
+				t.Errorf("sent %d messages, want the next one not to be sent", len(requests))
+			}
+
+			client.msgWaitersLock.RLock()
+			defer client.msgWaitersLock.RUnlock()
+			if len(client.msgWaiters) != 0 {
+				t.Errorf("%d message waiters are left", len(client.msgWaiters))
+			}
+		})
+	}
+}
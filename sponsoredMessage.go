@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // SponsoredMessage Describes a sponsored message
@@ -40,37 +40,162 @@ func NewSponsoredMessage(messageId int64, sponsorChatId int64, sponsorChatInfo *
 	return &sponsoredMessageTemp
 }
 
+// MarshalJSON marshals to json
+func (sponsoredMessage *SponsoredMessage) MarshalJSON() ([]byte, error) {
+	return sponsoredMessage.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (sponsoredMessage *SponsoredMessage) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, sponsoredMessage)
+}
+
+func (sponsoredMessage *SponsoredMessage) appendJSON(b []byte) ([]byte, error) {
+	if sponsoredMessage == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, sponsoredMessage.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, sponsoredMessage.tdCommon.Extra)
+	b = append(b, `,"message_id":`...)
+	b = appendJSONInt(b, int64(sponsoredMessage.MessageId))
+	b = append(b, `,"sponsor_chat_id":`...)
+	b = appendJSONInt(b, int64(sponsoredMessage.SponsorChatId))
+	b = append(b, `,"sponsor_chat_info":`...)
+	b, err = sponsoredMessage.SponsorChatInfo.appendJSON(b)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-		MessageId       int64               `json:"message_id"`        // Message identifier; unique for the chat to which the sponsored message belongs among both ordinary and sponsored messages
-		SponsorChatId   int64               `json:"sponsor_chat_id"`   // Sponsor chat identifier; 0 if the sponsor chat is accessible through an invite link
-		SponsorChatInfo *ChatInviteLinkInfo `json:"sponsor_chat_info"` // Information about the sponsor chat; may be null unless sponsor_chat_id == 0
-
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	b = append(b, `,"link":`...)
+	b, err = appendJSONValue(b, sponsoredMessage.Link)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	b = append(b, `,"content":`...)
+	b, err = appendJSONValue(b, sponsoredMessage.Content)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
 
-	sponsoredMessage.tdCommon = tempObj.tdCommon
-	sponsoredMessage.MessageId = tempObj.MessageId
-	sponsoredMessage.SponsorChatId = tempObj.SponsorChatId
-	sponsoredMessage.SponsorChatInfo = tempObj.SponsorChatInfo
+func (sponsoredMessage *SponsoredMessage) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			sponsoredMessage.tdCommon.Type, err = r.readString()
+		case "@extra":
+			sponsoredMessage.tdCommon.Extra, err = r.readString()
+		case "message_id":
+			sponsoredMessage.MessageId, err = r.readInt64()
+		case "sponsor_chat_id":
+			sponsoredMessage.SponsorChatId, err = r.readInt64()
+		case "sponsor_chat_info":
+			if r.readNull() {
+				sponsoredMessage.SponsorChatInfo = nil
+			} else {
+				sponsoredMessage.SponsorChatInfo = new(ChatInviteLinkInfo)
+				err = sponsoredMessage.SponsorChatInfo.decodeJSON(r)
+			}
+		case "link":
+			sponsoredMessage.Link, err = decodeInternalLinkType(r)
+		case "content":
+			sponsoredMessage.Content, err = decodeMessageContent(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	fieldLink, _ := unmarshalInternalLinkType(objMap["link"])
-	sponsoredMessage.Link = fieldLink
+// Clone returns a deep copy of the object
+func (sponsoredMessage *SponsoredMessage) Clone() *SponsoredMessage {
+	if sponsoredMessage == nil {
+		return nil
+	}
+	clone := *sponsoredMessage
+	clone.SponsorChatInfo = sponsoredMessage.SponsorChatInfo.Clone()
+	clone.Link = cloneInternalLinkType(sponsoredMessage.Link)
+	clone.Content = cloneMessageContent(sponsoredMessage.Content)
+	return &clone
+}
 
-	fieldContent, _ := unmarshalMessageContent(objMap["content"])
-	sponsoredMessage.Content = fieldContent
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (sponsoredMessage *SponsoredMessage) Equal(other *SponsoredMessage) bool {
+	if sponsoredMessage == nil || other == nil {
+		return sponsoredMessage == other
+	}
+	if sponsoredMessage.MessageId != other.MessageId {
+		return false
+	}
+	if sponsoredMessage.SponsorChatId != other.SponsorChatId {
+		return false
+	}
+	if !sponsoredMessage.SponsorChatInfo.Equal(other.SponsorChatInfo) {
+		return false
+	}
+	if !equalInternalLinkType(sponsoredMessage.Link, other.Link) {
+		return false
+	}
+	if !equalMessageContent(sponsoredMessage.Content, other.Content) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (sponsoredMessage *SponsoredMessage) Diff(other *SponsoredMessage) []Change {
+	return sponsoredMessage.diff("", other, nil)
+}
+
+func (sponsoredMessage *SponsoredMessage) diff(path string, other *SponsoredMessage, changes []Change) []Change {
+	if sponsoredMessage == nil || other == nil {
+		if sponsoredMessage != other {
+			changes = append(changes, Change{Path: path, Old: sponsoredMessage, New: other})
+		}
+		return changes
+	}
+	if sponsoredMessage.MessageId != other.MessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "message_id"), Old: sponsoredMessage.MessageId, New: other.MessageId})
+	}
+	if sponsoredMessage.SponsorChatId != other.SponsorChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "sponsor_chat_id"), Old: sponsoredMessage.SponsorChatId, New: other.SponsorChatId})
+	}
+	changes = sponsoredMessage.SponsorChatInfo.diff(fieldPath(path, "sponsor_chat_info"), other.SponsorChatInfo, changes)
+	changes = diffInternalLinkType(fieldPath(path, "link"), sponsoredMessage.Link, other.Link, changes)
+	changes = diffMessageContent(fieldPath(path, "content"), sponsoredMessage.Content, other.Content, changes)
+	return changes
+}
 
-	return nil
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (sponsoredMessage *SponsoredMessage) String() string {
+	return objectString(sponsoredMessage)
+}
+
+// LogValue logs the object as its summary
+func (sponsoredMessage *SponsoredMessage) LogValue() slog.Value {
+	return slog.StringValue(objectString(sponsoredMessage))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (sponsoredMessage *SponsoredMessage) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, sponsoredMessage)
+}
+
+func (sponsoredMessage *SponsoredMessage) printText(p *printer) {
+	if sponsoredMessage == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SponsoredMessage")
+	p.int("message_id", int64(sponsoredMessage.MessageId))
+	p.int("sponsor_chat_id", int64(sponsoredMessage.SponsorChatId))
+	p.object("sponsor_chat_info", sponsoredMessage.SponsorChatInfo)
+	p.object("link", sponsoredMessage.Link)
+	p.object("content", sponsoredMessage.Content)
+	p.endObject(state)
 }
 
 // GetChatSponsoredMessage Returns sponsored message to be shown in a chat; for channel chats only. Returns a 404 error if there is no sponsored message in the chat
@@ -85,11 +210,11 @@ func (client *Client) GetChatSponsoredMessage(chatId int64) (*SponsoredMessage,
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var sponsoredMessage SponsoredMessage
-	err = json.Unmarshal(result.Raw, &sponsoredMessage)
+	err = jsonUnmarshal(result.Raw, &sponsoredMessage)
 	return &sponsoredMessage, err
 }
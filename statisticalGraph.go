@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // StatisticalGraph Describes a statistical graph
 type StatisticalGraph interface {
 	GetStatisticalGraphEnum() StatisticalGraphEnum
+	Accept(visitor StatisticalGraphVisitor)
 }
 
 // StatisticalGraphEnum Alias for abstract StatisticalGraph 'Sub-Classes', used as constant-enum here
@@ -20,38 +22,89 @@ const (
 	StatisticalGraphErrorType StatisticalGraphEnum = "statisticalGraphError"
 )
 
+// UnknownStatisticalGraph is a StatisticalGraph of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownStatisticalGraph struct {
+	Unknown
+}
+
+// GetStatisticalGraphEnum return the enum type of this object
+func (unknownStatisticalGraph *UnknownStatisticalGraph) GetStatisticalGraphEnum() StatisticalGraphEnum {
+	return StatisticalGraphEnum(unknownStatisticalGraph.Type)
+}
+
+// Accept calls the method of the visitor for UnknownStatisticalGraph
+func (unknownStatisticalGraph *UnknownStatisticalGraph) Accept(visitor StatisticalGraphVisitor) {
+	visitor.VisitUnknownStatisticalGraph(unknownStatisticalGraph)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownStatisticalGraph *UnknownStatisticalGraph) String() string {
+	return objectString(unknownStatisticalGraph)
+}
+
+// LogValue logs the object as its summary
+func (unknownStatisticalGraph *UnknownStatisticalGraph) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownStatisticalGraph))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownStatisticalGraph *UnknownStatisticalGraph) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownStatisticalGraph)
+}
+
+func (unknownStatisticalGraph *UnknownStatisticalGraph) printText(p *printer) {
+	if unknownStatisticalGraph == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownStatisticalGraph")
+	p.string("@type", unknownStatisticalGraph.Type)
+	p.endObject(state)
+}
+
 func unmarshalStatisticalGraph(rawMsg *json.RawMessage) (StatisticalGraph, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch StatisticalGraphEnum(objMap["@type"].(string)) {
+	switch StatisticalGraphEnum(typeName) {
 	case StatisticalGraphDataType:
 		var statisticalGraphData StatisticalGraphData
-		err := json.Unmarshal(*rawMsg, &statisticalGraphData)
+		err := jsonUnmarshal(*rawMsg, &statisticalGraphData)
 		return &statisticalGraphData, err
 
 	case StatisticalGraphAsyncType:
 		var statisticalGraphAsync StatisticalGraphAsync
-		err := json.Unmarshal(*rawMsg, &statisticalGraphAsync)
+		err := jsonUnmarshal(*rawMsg, &statisticalGraphAsync)
 		return &statisticalGraphAsync, err
 
 	case StatisticalGraphErrorType:
 		var statisticalGraphError StatisticalGraphError
-		err := json.Unmarshal(*rawMsg, &statisticalGraphError)
+		err := jsonUnmarshal(*rawMsg, &statisticalGraphError)
 		return &statisticalGraphError, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownStatisticalGraph{Unknown: unknown}, nil
 	}
 }
 
+func decodeStatisticalGraph(r *jsonReader) (StatisticalGraph, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalStatisticalGraph(rawMsg)
+}
+
 // StatisticalGraphData A graph data
 type StatisticalGraphData struct {
 	tdCommon
@@ -78,11 +131,129 @@ func NewStatisticalGraphData(jsonStringData string, zoomToken string) *Statistic
 	return &statisticalGraphDataTemp
 }
 
+// MarshalJSON marshals to json
+func (statisticalGraphData *StatisticalGraphData) MarshalJSON() ([]byte, error) {
+	return statisticalGraphData.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (statisticalGraphData *StatisticalGraphData) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, statisticalGraphData)
+}
+
+func (statisticalGraphData *StatisticalGraphData) appendJSON(b []byte) ([]byte, error) {
+	if statisticalGraphData == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, statisticalGraphData.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, statisticalGraphData.tdCommon.Extra)
+	b = append(b, `,"json_data":`...)
+	b = appendJSONString(b, statisticalGraphData.JsonData)
+	b = append(b, `,"zoom_token":`...)
+	b = appendJSONString(b, statisticalGraphData.ZoomToken)
+	return append(b, '}'), nil
+}
+
+func (statisticalGraphData *StatisticalGraphData) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			statisticalGraphData.tdCommon.Type, err = r.readString()
+		case "@extra":
+			statisticalGraphData.tdCommon.Extra, err = r.readString()
+		case "json_data":
+			statisticalGraphData.JsonData, err = r.readString()
+		case "zoom_token":
+			statisticalGraphData.ZoomToken, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (statisticalGraphData *StatisticalGraphData) Clone() *StatisticalGraphData {
+	if statisticalGraphData == nil {
+		return nil
+	}
+	clone := *statisticalGraphData
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (statisticalGraphData *StatisticalGraphData) Equal(other *StatisticalGraphData) bool {
+	if statisticalGraphData == nil || other == nil {
+		return statisticalGraphData == other
+	}
+	if statisticalGraphData.JsonData != other.JsonData {
+		return false
+	}
+	if statisticalGraphData.ZoomToken != other.ZoomToken {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (statisticalGraphData *StatisticalGraphData) Diff(other *StatisticalGraphData) []Change {
+	return statisticalGraphData.diff("", other, nil)
+}
+
+func (statisticalGraphData *StatisticalGraphData) diff(path string, other *StatisticalGraphData, changes []Change) []Change {
+	if statisticalGraphData == nil || other == nil {
+		if statisticalGraphData != other {
+			changes = append(changes, Change{Path: path, Old: statisticalGraphData, New: other})
+		}
+		return changes
+	}
+	if statisticalGraphData.JsonData != other.JsonData {
+		changes = append(changes, Change{Path: fieldPath(path, "json_data"), Old: statisticalGraphData.JsonData, New: other.JsonData})
+	}
+	if statisticalGraphData.ZoomToken != other.ZoomToken {
+		changes = append(changes, Change{Path: fieldPath(path, "zoom_token"), Old: statisticalGraphData.ZoomToken, New: other.ZoomToken})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (statisticalGraphData *StatisticalGraphData) String() string {
+	return objectString(statisticalGraphData)
+}
+
+// LogValue logs the object as its summary
+func (statisticalGraphData *StatisticalGraphData) LogValue() slog.Value {
+	return slog.StringValue(objectString(statisticalGraphData))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (statisticalGraphData *StatisticalGraphData) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, statisticalGraphData)
+}
+
+func (statisticalGraphData *StatisticalGraphData) printText(p *printer) {
+	if statisticalGraphData == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("StatisticalGraphData")
+	p.string("json_data", statisticalGraphData.JsonData)
+	p.string("zoom_token", statisticalGraphData.ZoomToken)
+	p.endObject(state)
+}
+
 // GetStatisticalGraphEnum return the enum type of this object
 func (statisticalGraphData *StatisticalGraphData) GetStatisticalGraphEnum() StatisticalGraphEnum {
 	return StatisticalGraphDataType
 }
 
+// Accept calls the method of the visitor for StatisticalGraphData
+func (statisticalGraphData *StatisticalGraphData) Accept(visitor StatisticalGraphVisitor) {
+	visitor.VisitStatisticalGraphData(statisticalGraphData)
+}
+
 // StatisticalGraphAsync The graph data to be asynchronously loaded through getStatisticalGraph
 type StatisticalGraphAsync struct {
 	tdCommon
@@ -106,11 +277,118 @@ func NewStatisticalGraphAsync(token string) *StatisticalGraphAsync {
 	return &statisticalGraphAsyncTemp
 }
 
+// MarshalJSON marshals to json
+func (statisticalGraphAsync *StatisticalGraphAsync) MarshalJSON() ([]byte, error) {
+	return statisticalGraphAsync.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (statisticalGraphAsync *StatisticalGraphAsync) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, statisticalGraphAsync)
+}
+
+func (statisticalGraphAsync *StatisticalGraphAsync) appendJSON(b []byte) ([]byte, error) {
+	if statisticalGraphAsync == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, statisticalGraphAsync.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, statisticalGraphAsync.tdCommon.Extra)
+	b = append(b, `,"token":`...)
+	b = appendJSONString(b, statisticalGraphAsync.Token)
+	return append(b, '}'), nil
+}
+
+func (statisticalGraphAsync *StatisticalGraphAsync) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			statisticalGraphAsync.tdCommon.Type, err = r.readString()
+		case "@extra":
+			statisticalGraphAsync.tdCommon.Extra, err = r.readString()
+		case "token":
+			statisticalGraphAsync.Token, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (statisticalGraphAsync *StatisticalGraphAsync) Clone() *StatisticalGraphAsync {
+	if statisticalGraphAsync == nil {
+		return nil
+	}
+	clone := *statisticalGraphAsync
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (statisticalGraphAsync *StatisticalGraphAsync) Equal(other *StatisticalGraphAsync) bool {
+	if statisticalGraphAsync == nil || other == nil {
+		return statisticalGraphAsync == other
+	}
+	if statisticalGraphAsync.Token != other.Token {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (statisticalGraphAsync *StatisticalGraphAsync) Diff(other *StatisticalGraphAsync) []Change {
+	return statisticalGraphAsync.diff("", other, nil)
+}
+
+func (statisticalGraphAsync *StatisticalGraphAsync) diff(path string, other *StatisticalGraphAsync, changes []Change) []Change {
+	if statisticalGraphAsync == nil || other == nil {
+		if statisticalGraphAsync != other {
+			changes = append(changes, Change{Path: path, Old: statisticalGraphAsync, New: other})
+		}
+		return changes
+	}
+	if statisticalGraphAsync.Token != other.Token {
+		changes = append(changes, Change{Path: fieldPath(path, "token"), Old: statisticalGraphAsync.Token, New: other.Token})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (statisticalGraphAsync *StatisticalGraphAsync) String() string {
+	return objectString(statisticalGraphAsync)
+}
+
+// LogValue logs the object as its summary
+func (statisticalGraphAsync *StatisticalGraphAsync) LogValue() slog.Value {
+	return slog.StringValue(objectString(statisticalGraphAsync))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (statisticalGraphAsync *StatisticalGraphAsync) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, statisticalGraphAsync)
+}
+
+func (statisticalGraphAsync *StatisticalGraphAsync) printText(p *printer) {
+	if statisticalGraphAsync == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("StatisticalGraphAsync")
+	p.string("token", statisticalGraphAsync.Token)
+	p.endObject(state)
+}
+
 // GetStatisticalGraphEnum return the enum type of this object
 func (statisticalGraphAsync *StatisticalGraphAsync) GetStatisticalGraphEnum() StatisticalGraphEnum {
 	return StatisticalGraphAsyncType
 }
 
+// Accept calls the method of the visitor for StatisticalGraphAsync
+func (statisticalGraphAsync *StatisticalGraphAsync) Accept(visitor StatisticalGraphVisitor) {
+	visitor.VisitStatisticalGraphAsync(statisticalGraphAsync)
+}
+
 // StatisticalGraphError An error message to be shown to the user instead of the graph
 type StatisticalGraphError struct {
 	tdCommon
@@ -134,11 +412,228 @@ func NewStatisticalGraphError(errorMessage string) *StatisticalGraphError {
 	return &statisticalGraphErrorTemp
 }
 
+// MarshalJSON marshals to json
+func (statisticalGraphError *StatisticalGraphError) MarshalJSON() ([]byte, error) {
+	return statisticalGraphError.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (statisticalGraphError *StatisticalGraphError) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, statisticalGraphError)
+}
+
+func (statisticalGraphError *StatisticalGraphError) appendJSON(b []byte) ([]byte, error) {
+	if statisticalGraphError == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, statisticalGraphError.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, statisticalGraphError.tdCommon.Extra)
+	b = append(b, `,"error_message":`...)
+	b = appendJSONString(b, statisticalGraphError.ErrorMessage)
+	return append(b, '}'), nil
+}
+
+func (statisticalGraphError *StatisticalGraphError) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			statisticalGraphError.tdCommon.Type, err = r.readString()
+		case "@extra":
+			statisticalGraphError.tdCommon.Extra, err = r.readString()
+		case "error_message":
+			statisticalGraphError.ErrorMessage, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (statisticalGraphError *StatisticalGraphError) Clone() *StatisticalGraphError {
+	if statisticalGraphError == nil {
+		return nil
+	}
+	clone := *statisticalGraphError
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (statisticalGraphError *StatisticalGraphError) Equal(other *StatisticalGraphError) bool {
+	if statisticalGraphError == nil || other == nil {
+		return statisticalGraphError == other
+	}
+	if statisticalGraphError.ErrorMessage != other.ErrorMessage {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (statisticalGraphError *StatisticalGraphError) Diff(other *StatisticalGraphError) []Change {
+	return statisticalGraphError.diff("", other, nil)
+}
+
+func (statisticalGraphError *StatisticalGraphError) diff(path string, other *StatisticalGraphError, changes []Change) []Change {
+	if statisticalGraphError == nil || other == nil {
+		if statisticalGraphError != other {
+			changes = append(changes, Change{Path: path, Old: statisticalGraphError, New: other})
+		}
+		return changes
+	}
+	if statisticalGraphError.ErrorMessage != other.ErrorMessage {
+		changes = append(changes, Change{Path: fieldPath(path, "error_message"), Old: statisticalGraphError.ErrorMessage, New: other.ErrorMessage})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (statisticalGraphError *StatisticalGraphError) String() string {
+	return objectString(statisticalGraphError)
+}
+
+// LogValue logs the object as its summary
+func (statisticalGraphError *StatisticalGraphError) LogValue() slog.Value {
+	return slog.StringValue(objectString(statisticalGraphError))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (statisticalGraphError *StatisticalGraphError) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, statisticalGraphError)
+}
+
+func (statisticalGraphError *StatisticalGraphError) printText(p *printer) {
+	if statisticalGraphError == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("StatisticalGraphError")
+	p.string("error_message", statisticalGraphError.ErrorMessage)
+	p.endObject(state)
+}
+
 // GetStatisticalGraphEnum return the enum type of this object
 func (statisticalGraphError *StatisticalGraphError) GetStatisticalGraphEnum() StatisticalGraphEnum {
 	return StatisticalGraphErrorType
 }
 
+// Accept calls the method of the visitor for StatisticalGraphError
+func (statisticalGraphError *StatisticalGraphError) Accept(visitor StatisticalGraphVisitor) {
+	visitor.VisitStatisticalGraphError(statisticalGraphError)
+}
+
+// StatisticalGraphVisitor visits every type of StatisticalGraph, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to StatisticalGraph, until they handle it too.
+type StatisticalGraphVisitor interface {
+	VisitStatisticalGraphData(statisticalGraphData *StatisticalGraphData)
+	VisitStatisticalGraphAsync(statisticalGraphAsync *StatisticalGraphAsync)
+	VisitStatisticalGraphError(statisticalGraphError *StatisticalGraphError)
+	VisitUnknownStatisticalGraph(unknownStatisticalGraph *UnknownStatisticalGraph)
+}
+
+// StatisticalGraphCases holds the function MatchStatisticalGraph calls for each type of StatisticalGraph;
+// types without a function are passed to the default case
+type StatisticalGraphCases[R any] struct {
+	StatisticalGraphData  func(statisticalGraphData *StatisticalGraphData) R
+	StatisticalGraphAsync func(statisticalGraphAsync *StatisticalGraphAsync) R
+	StatisticalGraphError func(statisticalGraphError *StatisticalGraphError) R
+}
+
+// MatchStatisticalGraph calls the case of the type of statisticalGraph, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement StatisticalGraphVisitor
+// to have the compiler check every type is handled.
+func MatchStatisticalGraph[R any](statisticalGraph StatisticalGraph, cases StatisticalGraphCases[R], defaultCase func(statisticalGraph StatisticalGraph) R) R {
+	switch value := statisticalGraph.(type) {
+	case *StatisticalGraphData:
+		if cases.StatisticalGraphData != nil {
+			return cases.StatisticalGraphData(value)
+		}
+	case *StatisticalGraphAsync:
+		if cases.StatisticalGraphAsync != nil {
+			return cases.StatisticalGraphAsync(value)
+		}
+	case *StatisticalGraphError:
+		if cases.StatisticalGraphError != nil {
+			return cases.StatisticalGraphError(value)
+		}
+	}
+	return defaultCase(statisticalGraph)
+}
+
+// Clone returns a deep copy of the object
+func (unknownStatisticalGraph *UnknownStatisticalGraph) Clone() *UnknownStatisticalGraph {
+	if unknownStatisticalGraph == nil {
+		return nil
+	}
+	return &UnknownStatisticalGraph{Unknown: unknownStatisticalGraph.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownStatisticalGraph *UnknownStatisticalGraph) Equal(other *UnknownStatisticalGraph) bool {
+	if unknownStatisticalGraph == nil || other == nil {
+		return unknownStatisticalGraph == other
+	}
+	return unknownStatisticalGraph.Unknown.equal(other.Unknown)
+}
+
+func cloneStatisticalGraph(statisticalGraph StatisticalGraph) StatisticalGraph {
+	switch value := statisticalGraph.(type) {
+	case *StatisticalGraphData:
+		return value.Clone()
+	case *StatisticalGraphAsync:
+		return value.Clone()
+	case *StatisticalGraphError:
+		return value.Clone()
+	case *UnknownStatisticalGraph:
+		return value.Clone()
+	}
+	return statisticalGraph
+}
+
+func equalStatisticalGraph(a StatisticalGraph, b StatisticalGraph) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *StatisticalGraphData:
+		b, ok := b.(*StatisticalGraphData)
+		return ok && a.Equal(b)
+	case *StatisticalGraphAsync:
+		b, ok := b.(*StatisticalGraphAsync)
+		return ok && a.Equal(b)
+	case *StatisticalGraphError:
+		b, ok := b.(*StatisticalGraphError)
+		return ok && a.Equal(b)
+	case *UnknownStatisticalGraph:
+		b, ok := b.(*UnknownStatisticalGraph)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffStatisticalGraph(path string, a StatisticalGraph, b StatisticalGraph, changes []Change) []Change {
+	switch a := a.(type) {
+	case *StatisticalGraphData:
+		if b, ok := b.(*StatisticalGraphData); ok {
+			return a.diff(path, b, changes)
+		}
+	case *StatisticalGraphAsync:
+		if b, ok := b.(*StatisticalGraphAsync); ok {
+			return a.diff(path, b, changes)
+		}
+	case *StatisticalGraphError:
+		if b, ok := b.(*StatisticalGraphError); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalStatisticalGraph(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
+
 // GetStatisticalGraph Loads an asynchronous or a zoomed in statistical graph
 // @param chatId Chat identifier
 // @param token The token for graph loading
@@ -155,25 +650,26 @@ func (client *Client) GetStatisticalGraph(chatId int64, token string, x int64) (
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
-	switch StatisticalGraphEnum(result.Data["@type"].(string)) {
+	resultType, _ := result.Data["@type"].(string)
+	switch StatisticalGraphEnum(resultType) {
 
 	case StatisticalGraphDataType:
 		var statisticalGraph StatisticalGraphData
-		err = json.Unmarshal(result.Raw, &statisticalGraph)
+		err = jsonUnmarshal(result.Raw, &statisticalGraph)
 		return &statisticalGraph, err
 
 	case StatisticalGraphAsyncType:
 		var statisticalGraph StatisticalGraphAsync
-		err = json.Unmarshal(result.Raw, &statisticalGraph)
+		err = jsonUnmarshal(result.Raw, &statisticalGraph)
 		return &statisticalGraph, err
 
 	case StatisticalGraphErrorType:
 		var statisticalGraph StatisticalGraphError
-		err = json.Unmarshal(result.Raw, &statisticalGraph)
+		err = jsonUnmarshal(result.Raw, &statisticalGraph)
 		return &statisticalGraph, err
 
 	default:
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // StatisticalValue A value with information about its recent changes
 type StatisticalValue struct {
 	tdCommon
@@ -28,3 +33,137 @@ func NewStatisticalValue(value float64, previousValue float64, growthRatePercent
 
 	return &statisticalValueTemp
 }
+
+// MarshalJSON marshals to json
+func (statisticalValue *StatisticalValue) MarshalJSON() ([]byte, error) {
+	return statisticalValue.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (statisticalValue *StatisticalValue) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, statisticalValue)
+}
+
+func (statisticalValue *StatisticalValue) appendJSON(b []byte) ([]byte, error) {
+	if statisticalValue == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, statisticalValue.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, statisticalValue.tdCommon.Extra)
+	b = append(b, `,"value":`...)
+	b, err = appendJSONFloat(b, statisticalValue.Value)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"previous_value":`...)
+	b, err = appendJSONFloat(b, statisticalValue.PreviousValue)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"growth_rate_percentage":`...)
+	b, err = appendJSONFloat(b, statisticalValue.GrowthRatePercentage)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (statisticalValue *StatisticalValue) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			statisticalValue.tdCommon.Type, err = r.readString()
+		case "@extra":
+			statisticalValue.tdCommon.Extra, err = r.readString()
+		case "value":
+			statisticalValue.Value, err = r.readFloat64()
+		case "previous_value":
+			statisticalValue.PreviousValue, err = r.readFloat64()
+		case "growth_rate_percentage":
+			statisticalValue.GrowthRatePercentage, err = r.readFloat64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (statisticalValue *StatisticalValue) Clone() *StatisticalValue {
+	if statisticalValue == nil {
+		return nil
+	}
+	clone := *statisticalValue
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (statisticalValue *StatisticalValue) Equal(other *StatisticalValue) bool {
+	if statisticalValue == nil || other == nil {
+		return statisticalValue == other
+	}
+	if statisticalValue.Value != other.Value {
+		return false
+	}
+	if statisticalValue.PreviousValue != other.PreviousValue {
+		return false
+	}
+	if statisticalValue.GrowthRatePercentage != other.GrowthRatePercentage {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (statisticalValue *StatisticalValue) Diff(other *StatisticalValue) []Change {
+	return statisticalValue.diff("", other, nil)
+}
+
+func (statisticalValue *StatisticalValue) diff(path string, other *StatisticalValue, changes []Change) []Change {
+	if statisticalValue == nil || other == nil {
+		if statisticalValue != other {
+			changes = append(changes, Change{Path: path, Old: statisticalValue, New: other})
+		}
+		return changes
+	}
+	if statisticalValue.Value != other.Value {
+		changes = append(changes, Change{Path: fieldPath(path, "value"), Old: statisticalValue.Value, New: other.Value})
+	}
+	if statisticalValue.PreviousValue != other.PreviousValue {
+		changes = append(changes, Change{Path: fieldPath(path, "previous_value"), Old: statisticalValue.PreviousValue, New: other.PreviousValue})
+	}
+	if statisticalValue.GrowthRatePercentage != other.GrowthRatePercentage {
+		changes = append(changes, Change{Path: fieldPath(path, "growth_rate_percentage"), Old: statisticalValue.GrowthRatePercentage, New: other.GrowthRatePercentage})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (statisticalValue *StatisticalValue) String() string {
+	return objectString(statisticalValue)
+}
+
+// LogValue logs the object as its summary
+func (statisticalValue *StatisticalValue) LogValue() slog.Value {
+	return slog.StringValue(objectString(statisticalValue))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (statisticalValue *StatisticalValue) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, statisticalValue)
+}
+
+func (statisticalValue *StatisticalValue) printText(p *printer) {
+	if statisticalValue == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("StatisticalValue")
+	p.float("value", statisticalValue.Value)
+	p.float("previous_value", statisticalValue.PreviousValue)
+	p.float("growth_rate_percentage", statisticalValue.GrowthRatePercentage)
+	p.endObject(state)
+}
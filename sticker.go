@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // Sticker Describes a sticker
@@ -55,6 +55,270 @@ func NewSticker(setId JSONInt64, width int32, height int32, emoji string, isAnim
 	return &stickerTemp
 }
 
+// MarshalJSON marshals to json
+func (sticker *Sticker) MarshalJSON() ([]byte, error) {
+	return sticker.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (sticker *Sticker) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, sticker)
+}
+
+func (sticker *Sticker) appendJSON(b []byte) ([]byte, error) {
+	if sticker == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, sticker.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, sticker.tdCommon.Extra)
+	b = append(b, `,"set_id":`...)
+	b = appendJSONInt64String(b, int64(sticker.SetId))
+	b = append(b, `,"width":`...)
+	b = appendJSONInt(b, int64(sticker.Width))
+	b = append(b, `,"height":`...)
+	b = appendJSONInt(b, int64(sticker.Height))
+	b = append(b, `,"emoji":`...)
+	b = appendJSONString(b, sticker.Emoji)
+	b = append(b, `,"is_animated":`...)
+	b = appendJSONBool(b, sticker.IsAnimated)
+	b = append(b, `,"is_mask":`...)
+	b = appendJSONBool(b, sticker.IsMask)
+	b = append(b, `,"mask_position":`...)
+	b, err = sticker.MaskPosition.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"outline":`...)
+	if sticker.Outline == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range sticker.Outline {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = sticker.Outline[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"thumbnail":`...)
+	b, err = sticker.Thumbnail.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"sticker":`...)
+	b, err = sticker.Sticker.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (sticker *Sticker) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			sticker.tdCommon.Type, err = r.readString()
+		case "@extra":
+			sticker.tdCommon.Extra, err = r.readString()
+		case "set_id":
+			sticker.SetId, err = r.readJSONInt64()
+		case "width":
+			sticker.Width, err = r.readInt32()
+		case "height":
+			sticker.Height, err = r.readInt32()
+		case "emoji":
+			sticker.Emoji, err = r.readString()
+		case "is_animated":
+			sticker.IsAnimated, err = r.readBool()
+		case "is_mask":
+			sticker.IsMask, err = r.readBool()
+		case "mask_position":
+			if r.readNull() {
+				sticker.MaskPosition = nil
+			} else {
+				sticker.MaskPosition = new(MaskPosition)
+				err = sticker.MaskPosition.decodeJSON(r)
+			}
+		case "outline":
+			if r.readNull() {
+				sticker.Outline = nil
+			} else {
+				sticker.Outline = make([]ClosedVectorPath, 0)
+				err = r.readArray(func() (err error) {
+					var item0 ClosedVectorPath
+					err = item0.decodeJSON(r)
+					sticker.Outline = append(sticker.Outline, item0)
+					return
+				})
+			}
+		case "thumbnail":
+			if r.readNull() {
+				sticker.Thumbnail = nil
+			} else {
+				sticker.Thumbnail = new(Thumbnail)
+				err = sticker.Thumbnail.decodeJSON(r)
+			}
+		case "sticker":
+			if r.readNull() {
+				sticker.Sticker = nil
+			} else {
+				sticker.Sticker = new(File)
+				err = sticker.Sticker.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (sticker *Sticker) Clone() *Sticker {
+	if sticker == nil {
+		return nil
+	}
+	clone := *sticker
+	clone.MaskPosition = sticker.MaskPosition.Clone()
+	if sticker.Outline != nil {
+		clone.Outline = make([]ClosedVectorPath, len(sticker.Outline))
+		for i0 := range sticker.Outline {
+			clone.Outline[i0] = *sticker.Outline[i0].Clone()
+		}
+	}
+	clone.Thumbnail = sticker.Thumbnail.Clone()
+	clone.Sticker = sticker.Sticker.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (sticker *Sticker) Equal(other *Sticker) bool {
+	if sticker == nil || other == nil {
+		return sticker == other
+	}
+	if sticker.SetId != other.SetId {
+		return false
+	}
+	if sticker.Width != other.Width {
+		return false
+	}
+	if sticker.Height != other.Height {
+		return false
+	}
+	if sticker.Emoji != other.Emoji {
+		return false
+	}
+	if sticker.IsAnimated != other.IsAnimated {
+		return false
+	}
+	if sticker.IsMask != other.IsMask {
+		return false
+	}
+	if !sticker.MaskPosition.Equal(other.MaskPosition) {
+		return false
+	}
+	if len(sticker.Outline) != len(other.Outline) {
+		return false
+	}
+	for i0 := range sticker.Outline {
+		if !sticker.Outline[i0].Equal(&other.Outline[i0]) {
+			return false
+		}
+	}
+	if !sticker.Thumbnail.Equal(other.Thumbnail) {
+		return false
+	}
+	if !sticker.Sticker.Equal(other.Sticker) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (sticker *Sticker) Diff(other *Sticker) []Change {
+	return sticker.diff("", other, nil)
+}
+
+func (sticker *Sticker) diff(path string, other *Sticker, changes []Change) []Change {
+	if sticker == nil || other == nil {
+		if sticker != other {
+			changes = append(changes, Change{Path: path, Old: sticker, New: other})
+		}
+		return changes
+	}
+	if sticker.SetId != other.SetId {
+		changes = append(changes, Change{Path: fieldPath(path, "set_id"), Old: sticker.SetId, New: other.SetId})
+	}
+	if sticker.Width != other.Width {
+		changes = append(changes, Change{Path: fieldPath(path, "width"), Old: sticker.Width, New: other.Width})
+	}
+	if sticker.Height != other.Height {
+		changes = append(changes, Change{Path: fieldPath(path, "height"), Old: sticker.Height, New: other.Height})
+	}
+	if sticker.Emoji != other.Emoji {
+		changes = append(changes, Change{Path: fieldPath(path, "emoji"), Old: sticker.Emoji, New: other.Emoji})
+	}
+	if sticker.IsAnimated != other.IsAnimated {
+		changes = append(changes, Change{Path: fieldPath(path, "is_animated"), Old: sticker.IsAnimated, New: other.IsAnimated})
+	}
+	if sticker.IsMask != other.IsMask {
+		changes = append(changes, Change{Path: fieldPath(path, "is_mask"), Old: sticker.IsMask, New: other.IsMask})
+	}
+	changes = sticker.MaskPosition.diff(fieldPath(path, "mask_position"), other.MaskPosition, changes)
+	if len(sticker.Outline) != len(other.Outline) {
+		changes = append(changes, Change{Path: fieldPath(path, "outline"), Old: sticker.Outline, New: other.Outline})
+	} else {
+		for i0 := range sticker.Outline {
+			changes = sticker.Outline[i0].diff(indexPath(fieldPath(path, "outline"), i0), &other.Outline[i0], changes)
+		}
+	}
+	changes = sticker.Thumbnail.diff(fieldPath(path, "thumbnail"), other.Thumbnail, changes)
+	changes = sticker.Sticker.diff(fieldPath(path, "sticker"), other.Sticker, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (sticker *Sticker) String() string {
+	return objectString(sticker)
+}
+
+// LogValue logs the object as its summary
+func (sticker *Sticker) LogValue() slog.Value {
+	return slog.StringValue(objectString(sticker))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (sticker *Sticker) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, sticker)
+}
+
+func (sticker *Sticker) printText(p *printer) {
+	if sticker == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Sticker")
+	p.int("set_id", int64(sticker.SetId))
+	p.int("width", int64(sticker.Width))
+	p.int("height", int64(sticker.Height))
+	p.string("emoji", sticker.Emoji)
+	p.bool("is_animated", sticker.IsAnimated)
+	p.bool("is_mask", sticker.IsMask)
+	p.object("mask_position", sticker.MaskPosition)
+	p.vector("outline", len(sticker.Outline), func(i0 int) {
+		sticker.Outline[i0].printText(p)
+	})
+	p.object("thumbnail", sticker.Thumbnail)
+	p.object("sticker", sticker.Sticker)
+	p.endObject(state)
+}
+
 // ClickAnimatedEmojiMessage Informs TDLib that a message with an animated emoji was clicked by the user. Returns a big animated sticker to be played or a 404 error if usual animation needs to be played
 // @param chatId Chat identifier of the message
 // @param messageId Identifier of the clicked message
@@ -69,11 +333,11 @@ func (client *Client) ClickAnimatedEmojiMessage(chatId int64, messageId int64) (
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var sticker Sticker
-	err = json.Unmarshal(result.Raw, &sticker)
+	err = jsonUnmarshal(result.Raw, &sticker)
 	return &sticker, err
 }
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // StickerSet Represents a sticker set
@@ -64,6 +64,371 @@ func NewStickerSet(id JSONInt64, title string, name string, thumbnail *Thumbnail
 	return &stickerSetTemp
 }
 
+// MarshalJSON marshals to json
+func (stickerSet *StickerSet) MarshalJSON() ([]byte, error) {
+	return stickerSet.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (stickerSet *StickerSet) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, stickerSet)
+}
+
+func (stickerSet *StickerSet) appendJSON(b []byte) ([]byte, error) {
+	if stickerSet == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, stickerSet.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, stickerSet.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt64String(b, int64(stickerSet.Id))
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, stickerSet.Title)
+	b = append(b, `,"name":`...)
+	b = appendJSONString(b, stickerSet.Name)
+	b = append(b, `,"thumbnail":`...)
+	b, err = stickerSet.Thumbnail.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"thumbnail_outline":`...)
+	if stickerSet.ThumbnailOutline == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range stickerSet.ThumbnailOutline {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = stickerSet.ThumbnailOutline[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"is_installed":`...)
+	b = appendJSONBool(b, stickerSet.IsInstalled)
+	b = append(b, `,"is_archived":`...)
+	b = appendJSONBool(b, stickerSet.IsArchived)
+	b = append(b, `,"is_official":`...)
+	b = appendJSONBool(b, stickerSet.IsOfficial)
+	b = append(b, `,"is_animated":`...)
+	b = appendJSONBool(b, stickerSet.IsAnimated)
+	b = append(b, `,"is_masks":`...)
+	b = appendJSONBool(b, stickerSet.IsMasks)
+	b = append(b, `,"is_viewed":`...)
+	b = appendJSONBool(b, stickerSet.IsViewed)
+	b = append(b, `,"stickers":`...)
+	if stickerSet.Stickers == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range stickerSet.Stickers {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = stickerSet.Stickers[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"emojis":`...)
+	if stickerSet.Emojis == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range stickerSet.Emojis {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = stickerSet.Emojis[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (stickerSet *StickerSet) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			stickerSet.tdCommon.Type, err = r.readString()
+		case "@extra":
+			stickerSet.tdCommon.Extra, err = r.readString()
+		case "id":
+			stickerSet.Id, err = r.readJSONInt64()
+		case "title":
+			stickerSet.Title, err = r.readString()
+		case "name":
+			stickerSet.Name, err = r.readString()
+		case "thumbnail":
+			if r.readNull() {
+				stickerSet.Thumbnail = nil
+			} else {
+				stickerSet.Thumbnail = new(Thumbnail)
+				err = stickerSet.Thumbnail.decodeJSON(r)
+			}
+		case "thumbnail_outline":
+			if r.readNull() {
+				stickerSet.ThumbnailOutline = nil
+			} else {
+				stickerSet.ThumbnailOutline = make([]ClosedVectorPath, 0)
+				err = r.readArray(func() (err error) {
+					var item0 ClosedVectorPath
+					err = item0.decodeJSON(r)
+					stickerSet.ThumbnailOutline = append(stickerSet.ThumbnailOutline, item0)
+					return
+				})
+			}
+		case "is_installed":
+			stickerSet.IsInstalled, err = r.readBool()
+		case "is_archived":
+			stickerSet.IsArchived, err = r.readBool()
+		case "is_official":
+			stickerSet.IsOfficial, err = r.readBool()
+		case "is_animated":
+			stickerSet.IsAnimated, err = r.readBool()
+		case "is_masks":
+			stickerSet.IsMasks, err = r.readBool()
+		case "is_viewed":
+			stickerSet.IsViewed, err = r.readBool()
+		case "stickers":
+			if r.readNull() {
+				stickerSet.Stickers = nil
+			} else {
+				stickerSet.Stickers = make([]Sticker, 0)
+				err = r.readArray(func() (err error) {
+					var item0 Sticker
+					err = item0.decodeJSON(r)
+					stickerSet.Stickers = append(stickerSet.Stickers, item0)
+					return
+				})
+			}
+		case "emojis":
+			if r.readNull() {
+				stickerSet.Emojis = nil
+			} else {
+				stickerSet.Emojis = make([]Emojis, 0)
+				err = r.readArray(func() (err error) {
+					var item0 Emojis
+					err = item0.decodeJSON(r)
+					stickerSet.Emojis = append(stickerSet.Emojis, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (stickerSet *StickerSet) Clone() *StickerSet {
+	if stickerSet == nil {
+		return nil
+	}
+	clone := *stickerSet
+	clone.Thumbnail = stickerSet.Thumbnail.Clone()
+	if stickerSet.ThumbnailOutline != nil {
+		clone.ThumbnailOutline = make([]ClosedVectorPath, len(stickerSet.ThumbnailOutline))
+		for i0 := range stickerSet.ThumbnailOutline {
+			clone.ThumbnailOutline[i0] = *stickerSet.ThumbnailOutline[i0].Clone()
+		}
+	}
+	if stickerSet.Stickers != nil {
+		clone.Stickers = make([]Sticker, len(stickerSet.Stickers))
+		for i0 := range stickerSet.Stickers {
+			clone.Stickers[i0] = *stickerSet.Stickers[i0].Clone()
+		}
+	}
+	if stickerSet.Emojis != nil {
+		clone.Emojis = make([]Emojis, len(stickerSet.Emojis))
+		for i0 := range stickerSet.Emojis {
+			clone.Emojis[i0] = *stickerSet.Emojis[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (stickerSet *StickerSet) Equal(other *StickerSet) bool {
+	if stickerSet == nil || other == nil {
+		return stickerSet == other
+	}
+	if stickerSet.Id != other.Id {
+		return false
+	}
+	if stickerSet.Title != other.Title {
+		return false
+	}
+	if stickerSet.Name != other.Name {
+		return false
+	}
+	if !stickerSet.Thumbnail.Equal(other.Thumbnail) {
+		return false
+	}
+	if len(stickerSet.ThumbnailOutline) != len(other.ThumbnailOutline) {
+		return false
+	}
+	for i0 := range stickerSet.ThumbnailOutline {
+		if !stickerSet.ThumbnailOutline[i0].Equal(&other.ThumbnailOutline[i0]) {
+			return false
+		}
+	}
+	if stickerSet.IsInstalled != other.IsInstalled {
+		return false
+	}
+	if stickerSet.IsArchived != other.IsArchived {
+		return false
+	}
+	if stickerSet.IsOfficial != other.IsOfficial {
+		return false
+	}
+	if stickerSet.IsAnimated != other.IsAnimated {
+		return false
+	}
+	if stickerSet.IsMasks != other.IsMasks {
+		return false
+	}
+	if stickerSet.IsViewed != other.IsViewed {
+		return false
+	}
+	if len(stickerSet.Stickers) != len(other.Stickers) {
+		return false
+	}
+	for i0 := range stickerSet.Stickers {
+		if !stickerSet.Stickers[i0].Equal(&other.Stickers[i0]) {
+			return false
+		}
+	}
+	if len(stickerSet.Emojis) != len(other.Emojis) {
+		return false
+	}
+	for i0 := range stickerSet.Emojis {
+		if !stickerSet.Emojis[i0].Equal(&other.Emojis[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (stickerSet *StickerSet) Diff(other *StickerSet) []Change {
+	return stickerSet.diff("", other, nil)
+}
+
+func (stickerSet *StickerSet) diff(path string, other *StickerSet, changes []Change) []Change {
+	if stickerSet == nil || other == nil {
+		if stickerSet != other {
+			changes = append(changes, Change{Path: path, Old: stickerSet, New: other})
+		}
+		return changes
+	}
+	if stickerSet.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: stickerSet.Id, New: other.Id})
+	}
+	if stickerSet.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: stickerSet.Title, New: other.Title})
+	}
+	if stickerSet.Name != other.Name {
+		changes = append(changes, Change{Path: fieldPath(path, "name"), Old: stickerSet.Name, New: other.Name})
+	}
+	changes = stickerSet.Thumbnail.diff(fieldPath(path, "thumbnail"), other.Thumbnail, changes)
+	if len(stickerSet.ThumbnailOutline) != len(other.ThumbnailOutline) {
+		changes = append(changes, Change{Path: fieldPath(path, "thumbnail_outline"), Old: stickerSet.ThumbnailOutline, New: other.ThumbnailOutline})
+	} else {
+		for i0 := range stickerSet.ThumbnailOutline {
+			changes = stickerSet.ThumbnailOutline[i0].diff(indexPath(fieldPath(path, "thumbnail_outline"), i0), &other.ThumbnailOutline[i0], changes)
+		}
+	}
+	if stickerSet.IsInstalled != other.IsInstalled {
+		changes = append(changes, Change{Path: fieldPath(path, "is_installed"), Old: stickerSet.IsInstalled, New: other.IsInstalled})
+	}
+	if stickerSet.IsArchived != other.IsArchived {
+		changes = append(changes, Change{Path: fieldPath(path, "is_archived"), Old: stickerSet.IsArchived, New: other.IsArchived})
+	}
+	if stickerSet.IsOfficial != other.IsOfficial {
+		changes = append(changes, Change{Path: fieldPath(path, "is_official"), Old: stickerSet.IsOfficial, New: other.IsOfficial})
+	}
+	if stickerSet.IsAnimated != other.IsAnimated {
+		changes = append(changes, Change{Path: fieldPath(path, "is_animated"), Old: stickerSet.IsAnimated, New: other.IsAnimated})
+	}
+	if stickerSet.IsMasks != other.IsMasks {
+		changes = append(changes, Change{Path: fieldPath(path, "is_masks"), Old: stickerSet.IsMasks, New: other.IsMasks})
+	}
+	if stickerSet.IsViewed != other.IsViewed {
+		changes = append(changes, Change{Path: fieldPath(path, "is_viewed"), Old: stickerSet.IsViewed, New: other.IsViewed})
+	}
+	if len(stickerSet.Stickers) != len(other.Stickers) {
+		changes = append(changes, Change{Path: fieldPath(path, "stickers"), Old: stickerSet.Stickers, New: other.Stickers})
+	} else {
+		for i0 := range stickerSet.Stickers {
+			changes = stickerSet.Stickers[i0].diff(indexPath(fieldPath(path, "stickers"), i0), &other.Stickers[i0], changes)
+		}
+	}
+	if len(stickerSet.Emojis) != len(other.Emojis) {
+		changes = append(changes, Change{Path: fieldPath(path, "emojis"), Old: stickerSet.Emojis, New: other.Emojis})
+	} else {
+		for i0 := range stickerSet.Emojis {
+			changes = stickerSet.Emojis[i0].diff(indexPath(fieldPath(path, "emojis"), i0), &other.Emojis[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (stickerSet *StickerSet) String() string {
+	return objectString(stickerSet)
+}
+
+// LogValue logs the object as its summary
+func (stickerSet *StickerSet) LogValue() slog.Value {
+	return slog.StringValue(objectString(stickerSet))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (stickerSet *StickerSet) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, stickerSet)
+}
+
+func (stickerSet *StickerSet) printText(p *printer) {
+	if stickerSet == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("StickerSet")
+	p.int("id", int64(stickerSet.Id))
+	p.string("title", stickerSet.Title)
+	p.string("name", stickerSet.Name)
+	p.object("thumbnail", stickerSet.Thumbnail)
+	p.vector("thumbnail_outline", len(stickerSet.ThumbnailOutline), func(i0 int) {
+		stickerSet.ThumbnailOutline[i0].printText(p)
+	})
+	p.bool("is_installed", stickerSet.IsInstalled)
+	p.bool("is_archived", stickerSet.IsArchived)
+	p.bool("is_official", stickerSet.IsOfficial)
+	p.bool("is_animated", stickerSet.IsAnimated)
+	p.bool("is_masks", stickerSet.IsMasks)
+	p.bool("is_viewed", stickerSet.IsViewed)
+	p.vector("stickers", len(stickerSet.Stickers), func(i0 int) {
+		stickerSet.Stickers[i0].printText(p)
+	})
+	p.vector("emojis", len(stickerSet.Emojis), func(i0 int) {
+		stickerSet.Emojis[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetStickerSet Returns information about a sticker set by its identifier
 // @param setId Identifier of the sticker set
 func (client *Client) GetStickerSet(setId JSONInt64) (*StickerSet, error) {
@@ -76,12 +441,12 @@ func (client *Client) GetStickerSet(setId JSONInt64) (*StickerSet, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var stickerSet StickerSet
-	err = json.Unmarshal(result.Raw, &stickerSet)
+	err = jsonUnmarshal(result.Raw, &stickerSet)
 	return &stickerSet, err
 }
 
@@ -97,12 +462,12 @@ func (client *Client) SearchStickerSet(name string) (*StickerSet, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var stickerSet StickerSet
-	err = json.Unmarshal(result.Raw, &stickerSet)
+	err = jsonUnmarshal(result.Raw, &stickerSet)
 	return &stickerSet, err
 }
 
@@ -128,12 +493,12 @@ func (client *Client) CreateNewStickerSet(userId int64, title string, name strin
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var stickerSet StickerSet
-	err = json.Unmarshal(result.Raw, &stickerSet)
+	err = jsonUnmarshal(result.Raw, &stickerSet)
 	return &stickerSet, err
 }
 
@@ -153,12 +518,12 @@ func (client *Client) AddStickerToSet(userId int64, name string, sticker InputSt
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var stickerSet StickerSet
-	err = json.Unmarshal(result.Raw, &stickerSet)
+	err = jsonUnmarshal(result.Raw, &stickerSet)
 	return &stickerSet, err
 }
 
@@ -178,11 +543,11 @@ func (client *Client) SetStickerSetThumbnail(userId int64, name string, thumbnai
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var stickerSet StickerSet
-	err = json.Unmarshal(result.Raw, &stickerSet)
+	err = jsonUnmarshal(result.Raw, &stickerSet)
 	return &stickerSet, err
 }
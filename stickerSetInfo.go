@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // StickerSetInfo Represents short information about a sticker set
 type StickerSetInfo struct {
 	tdCommon
@@ -58,3 +63,327 @@ func NewStickerSetInfo(id JSONInt64, title string, name string, thumbnail *Thumb
 
 	return &stickerSetInfoTemp
 }
+
+// MarshalJSON marshals to json
+func (stickerSetInfo *StickerSetInfo) MarshalJSON() ([]byte, error) {
+	return stickerSetInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (stickerSetInfo *StickerSetInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, stickerSetInfo)
+}
+
+func (stickerSetInfo *StickerSetInfo) appendJSON(b []byte) ([]byte, error) {
+	if stickerSetInfo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, stickerSetInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, stickerSetInfo.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt64String(b, int64(stickerSetInfo.Id))
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, stickerSetInfo.Title)
+	b = append(b, `,"name":`...)
+	b = appendJSONString(b, stickerSetInfo.Name)
+	b = append(b, `,"thumbnail":`...)
+	b, err = stickerSetInfo.Thumbnail.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"thumbnail_outline":`...)
+	if stickerSetInfo.ThumbnailOutline == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range stickerSetInfo.ThumbnailOutline {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = stickerSetInfo.ThumbnailOutline[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"is_installed":`...)
+	b = appendJSONBool(b, stickerSetInfo.IsInstalled)
+	b = append(b, `,"is_archived":`...)
+	b = appendJSONBool(b, stickerSetInfo.IsArchived)
+	b = append(b, `,"is_official":`...)
+	b = appendJSONBool(b, stickerSetInfo.IsOfficial)
+	b = append(b, `,"is_animated":`...)
+	b = appendJSONBool(b, stickerSetInfo.IsAnimated)
+	b = append(b, `,"is_masks":`...)
+	b = appendJSONBool(b, stickerSetInfo.IsMasks)
+	b = append(b, `,"is_viewed":`...)
+	b = appendJSONBool(b, stickerSetInfo.IsViewed)
+	b = append(b, `,"size":`...)
+	b = appendJSONInt(b, int64(stickerSetInfo.Size))
+	b = append(b, `,"covers":`...)
+	if stickerSetInfo.Covers == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range stickerSetInfo.Covers {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = stickerSetInfo.Covers[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (stickerSetInfo *StickerSetInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			stickerSetInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			stickerSetInfo.tdCommon.Extra, err = r.readString()
+		case "id":
+			stickerSetInfo.Id, err = r.readJSONInt64()
+		case "title":
+			stickerSetInfo.Title, err = r.readString()
+		case "name":
+			stickerSetInfo.Name, err = r.readString()
+		case "thumbnail":
+			if r.readNull() {
+				stickerSetInfo.Thumbnail = nil
+			} else {
+				stickerSetInfo.Thumbnail = new(Thumbnail)
+				err = stickerSetInfo.Thumbnail.decodeJSON(r)
+			}
+		case "thumbnail_outline":
+			if r.readNull() {
+				stickerSetInfo.ThumbnailOutline = nil
+			} else {
+				stickerSetInfo.ThumbnailOutline = make([]ClosedVectorPath, 0)
+				err = r.readArray(func() (err error) {
+					var item0 ClosedVectorPath
+					err = item0.decodeJSON(r)
+					stickerSetInfo.ThumbnailOutline = append(stickerSetInfo.ThumbnailOutline, item0)
+					return
+				})
+			}
+		case "is_installed":
+			stickerSetInfo.IsInstalled, err = r.readBool()
+		case "is_archived":
+			stickerSetInfo.IsArchived, err = r.readBool()
+		case "is_official":
+			stickerSetInfo.IsOfficial, err = r.readBool()
+		case "is_animated":
+			stickerSetInfo.IsAnimated, err = r.readBool()
+		case "is_masks":
+			stickerSetInfo.IsMasks, err = r.readBool()
+		case "is_viewed":
+			stickerSetInfo.IsViewed, err = r.readBool()
+		case "size":
+			stickerSetInfo.Size, err = r.readInt32()
+		case "covers":
+			if r.readNull() {
+				stickerSetInfo.Covers = nil
+			} else {
+				stickerSetInfo.Covers = make([]Sticker, 0)
+				err = r.readArray(func() (err error) {
+					var item0 Sticker
+					err = item0.decodeJSON(r)
+					stickerSetInfo.Covers = append(stickerSetInfo.Covers, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (stickerSetInfo *StickerSetInfo) Clone() *StickerSetInfo {
+	if stickerSetInfo == nil {
+		return nil
+	}
+	clone := *stickerSetInfo
+	clone.Thumbnail = stickerSetInfo.Thumbnail.Clone()
+	if stickerSetInfo.ThumbnailOutline != nil {
+		clone.ThumbnailOutline = make([]ClosedVectorPath, len(stickerSetInfo.ThumbnailOutline))
+		for i0 := range stickerSetInfo.ThumbnailOutline {
+			clone.ThumbnailOutline[i0] = *stickerSetInfo.ThumbnailOutline[i0].Clone()
+		}
+	}
+	if stickerSetInfo.Covers != nil {
+		clone.Covers = make([]Sticker, len(stickerSetInfo.Covers))
+		for i0 := range stickerSetInfo.Covers {
+			clone.Covers[i0] = *stickerSetInfo.Covers[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (stickerSetInfo *StickerSetInfo) Equal(other *StickerSetInfo) bool {
+	if stickerSetInfo == nil || other == nil {
+		return stickerSetInfo == other
+	}
+	if stickerSetInfo.Id != other.Id {
+		return false
+	}
+	if stickerSetInfo.Title != other.Title {
+		return false
+	}
+	if stickerSetInfo.Name != other.Name {
+		return false
+	}
+	if !stickerSetInfo.Thumbnail.Equal(other.Thumbnail) {
+		return false
+	}
+	if len(stickerSetInfo.ThumbnailOutline) != len(other.ThumbnailOutline) {
+		return false
+	}
+	for i0 := range stickerSetInfo.ThumbnailOutline {
+		if !stickerSetInfo.ThumbnailOutline[i0].Equal(&other.ThumbnailOutline[i0]) {
+			return false
+		}
+	}
+	if stickerSetInfo.IsInstalled != other.IsInstalled {
+		return false
+	}
+	if stickerSetInfo.IsArchived != other.IsArchived {
+		return false
+	}
+	if stickerSetInfo.IsOfficial != other.IsOfficial {
+		return false
+	}
+	if stickerSetInfo.IsAnimated != other.IsAnimated {
+		return false
+	}
+	if stickerSetInfo.IsMasks != other.IsMasks {
+		return false
+	}
+	if stickerSetInfo.IsViewed != other.IsViewed {
+		return false
+	}
+	if stickerSetInfo.Size != other.Size {
+		return false
+	}
+	if len(stickerSetInfo.Covers) != len(other.Covers) {
+		return false
+	}
+	for i0 := range stickerSetInfo.Covers {
+		if !stickerSetInfo.Covers[i0].Equal(&other.Covers[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (stickerSetInfo *StickerSetInfo) Diff(other *StickerSetInfo) []Change {
+	return stickerSetInfo.diff("", other, nil)
+}
+
+func (stickerSetInfo *StickerSetInfo) diff(path string, other *StickerSetInfo, changes []Change) []Change {
+	if stickerSetInfo == nil || other == nil {
+		if stickerSetInfo != other {
+			changes = append(changes, Change{Path: path, Old: stickerSetInfo, New: other})
+		}
+		return changes
+	}
+	if stickerSetInfo.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: stickerSetInfo.Id, New: other.Id})
+	}
+	if stickerSetInfo.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: stickerSetInfo.Title, New: other.Title})
+	}
+	if stickerSetInfo.Name != other.Name {
+		changes = append(changes, Change{Path: fieldPath(path, "name"), Old: stickerSetInfo.Name, New: other.Name})
+	}
+	changes = stickerSetInfo.Thumbnail.diff(fieldPath(path, "thumbnail"), other.Thumbnail, changes)
+	if len(stickerSetInfo.ThumbnailOutline) != len(other.ThumbnailOutline) {
+		changes = append(changes, Change{Path: fieldPath(path, "thumbnail_outline"), Old: stickerSetInfo.ThumbnailOutline, New: other.ThumbnailOutline})
+	} else {
+		for i0 := range stickerSetInfo.ThumbnailOutline {
+			changes = stickerSetInfo.ThumbnailOutline[i0].diff(indexPath(fieldPath(path, "thumbnail_outline"), i0), &other.ThumbnailOutline[i0], changes)
+		}
+	}
+	if stickerSetInfo.IsInstalled != other.IsInstalled {
+		changes = append(changes, Change{Path: fieldPath(path, "is_installed"), Old: stickerSetInfo.IsInstalled, New: other.IsInstalled})
+	}
+	if stickerSetInfo.IsArchived != other.IsArchived {
+		changes = append(changes, Change{Path: fieldPath(path, "is_archived"), Old: stickerSetInfo.IsArchived, New: other.IsArchived})
+	}
+	if stickerSetInfo.IsOfficial != other.IsOfficial {
+		changes = append(changes, Change{Path: fieldPath(path, "is_official"), Old: stickerSetInfo.IsOfficial, New: other.IsOfficial})
+	}
+	if stickerSetInfo.IsAnimated != other.IsAnimated {
+		changes = append(changes, Change{Path: fieldPath(path, "is_animated"), Old: stickerSetInfo.IsAnimated, New: other.IsAnimated})
+	}
+	if stickerSetInfo.IsMasks != other.IsMasks {
+		changes = append(changes, Change{Path: fieldPath(path, "is_masks"), Old: stickerSetInfo.IsMasks, New: other.IsMasks})
+	}
+	if stickerSetInfo.IsViewed != other.IsViewed {
+		changes = append(changes, Change{Path: fieldPath(path, "is_viewed"), Old: stickerSetInfo.IsViewed, New: other.IsViewed})
+	}
+	if stickerSetInfo.Size != other.Size {
+		changes = append(changes, Change{Path: fieldPath(path, "size"), Old: stickerSetInfo.Size, New: other.Size})
+	}
+	if len(stickerSetInfo.Covers) != len(other.Covers) {
+		changes = append(changes, Change{Path: fieldPath(path, "covers"), Old: stickerSetInfo.Covers, New: other.Covers})
+	} else {
+		for i0 := range stickerSetInfo.Covers {
+			changes = stickerSetInfo.Covers[i0].diff(indexPath(fieldPath(path, "covers"), i0), &other.Covers[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (stickerSetInfo *StickerSetInfo) String() string {
+	return objectString(stickerSetInfo)
+}
+
+// LogValue logs the object as its summary
+func (stickerSetInfo *StickerSetInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(stickerSetInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (stickerSetInfo *StickerSetInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, stickerSetInfo)
+}
+
+func (stickerSetInfo *StickerSetInfo) printText(p *printer) {
+	if stickerSetInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("StickerSetInfo")
+	p.int("id", int64(stickerSetInfo.Id))
+	p.string("title", stickerSetInfo.Title)
+	p.string("name", stickerSetInfo.Name)
+	p.object("thumbnail", stickerSetInfo.Thumbnail)
+	p.vector("thumbnail_outline", len(stickerSetInfo.ThumbnailOutline), func(i0 int) {
+		stickerSetInfo.ThumbnailOutline[i0].printText(p)
+	})
+	p.bool("is_installed", stickerSetInfo.IsInstalled)
+	p.bool("is_archived", stickerSetInfo.IsArchived)
+	p.bool("is_official", stickerSetInfo.IsOfficial)
+	p.bool("is_animated", stickerSetInfo.IsAnimated)
+	p.bool("is_masks", stickerSetInfo.IsMasks)
+	p.bool("is_viewed", stickerSetInfo.IsViewed)
+	p.int("size", int64(stickerSetInfo.Size))
+	p.vector("covers", len(stickerSetInfo.Covers), func(i0 int) {
+		stickerSetInfo.Covers[i0].printText(p)
+	})
+	p.endObject(state)
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // StickerSets Represents a list of sticker sets
@@ -31,6 +31,161 @@ func NewStickerSets(totalCount int32, sets []StickerSetInfo) *StickerSets {
 	return &stickerSetsTemp
 }
 
+// MarshalJSON marshals to json
+func (stickerSets *StickerSets) MarshalJSON() ([]byte, error) {
+	return stickerSets.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (stickerSets *StickerSets) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, stickerSets)
+}
+
+func (stickerSets *StickerSets) appendJSON(b []byte) ([]byte, error) {
+	if stickerSets == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, stickerSets.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, stickerSets.tdCommon.Extra)
+	b = append(b, `,"total_count":`...)
+	b = appendJSONInt(b, int64(stickerSets.TotalCount))
+	b = append(b, `,"sets":`...)
+	if stickerSets.Sets == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range stickerSets.Sets {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = stickerSets.Sets[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (stickerSets *StickerSets) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			stickerSets.tdCommon.Type, err = r.readString()
+		case "@extra":
+			stickerSets.tdCommon.Extra, err = r.readString()
+		case "total_count":
+			stickerSets.TotalCount, err = r.readInt32()
+		case "sets":
+			if r.readNull() {
+				stickerSets.Sets = nil
+			} else {
+				stickerSets.Sets = make([]StickerSetInfo, 0)
+				err = r.readArray(func() (err error) {
+					var item0 StickerSetInfo
+					err = item0.decodeJSON(r)
+					stickerSets.Sets = append(stickerSets.Sets, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (stickerSets *StickerSets) Clone() *StickerSets {
+	if stickerSets == nil {
+		return nil
+	}
+	clone := *stickerSets
+	if stickerSets.Sets != nil {
+		clone.Sets = make([]StickerSetInfo, len(stickerSets.Sets))
+		for i0 := range stickerSets.Sets {
+			clone.Sets[i0] = *stickerSets.Sets[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (stickerSets *StickerSets) Equal(other *StickerSets) bool {
+	if stickerSets == nil || other == nil {
+		return stickerSets == other
+	}
+	if stickerSets.TotalCount != other.TotalCount {
+		return false
+	}
+	if len(stickerSets.Sets) != len(other.Sets) {
+		return false
+	}
+	for i0 := range stickerSets.Sets {
+		if !stickerSets.Sets[i0].Equal(&other.Sets[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (stickerSets *StickerSets) Diff(other *StickerSets) []Change {
+	return stickerSets.diff("", other, nil)
+}
+
+func (stickerSets *StickerSets) diff(path string, other *StickerSets, changes []Change) []Change {
+	if stickerSets == nil || other == nil {
+		if stickerSets != other {
+			changes = append(changes, Change{Path: path, Old: stickerSets, New: other})
+		}
+		return changes
+	}
+	if stickerSets.TotalCount != other.TotalCount {
+		changes = append(changes, Change{Path: fieldPath(path, "total_count"), Old: stickerSets.TotalCount, New: other.TotalCount})
+	}
+	if len(stickerSets.Sets) != len(other.Sets) {
+		changes = append(changes, Change{Path: fieldPath(path, "sets"), Old: stickerSets.Sets, New: other.Sets})
+	} else {
+		for i0 := range stickerSets.Sets {
+			changes = stickerSets.Sets[i0].diff(indexPath(fieldPath(path, "sets"), i0), &other.Sets[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (stickerSets *StickerSets) String() string {
+	return objectString(stickerSets)
+}
+
+// LogValue logs the object as its summary
+func (stickerSets *StickerSets) LogValue() slog.Value {
+	return slog.StringValue(objectString(stickerSets))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (stickerSets *StickerSets) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, stickerSets)
+}
+
+func (stickerSets *StickerSets) printText(p *printer) {
+	if stickerSets == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("StickerSets")
+	p.int("total_count", int64(stickerSets.TotalCount))
+	p.vector("sets", len(stickerSets.Sets), func(i0 int) {
+		stickerSets.Sets[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetInstalledStickerSets Returns a list of installed sticker sets
 // @param isMasks Pass true to return mask sticker sets; pass false to return ordinary sticker sets
 func (client *Client) GetInstalledStickerSets(isMasks bool) (*StickerSets, error) {
@@ -43,12 +198,12 @@ func (client *Client) GetInstalledStickerSets(isMasks bool) (*StickerSets, error
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var stickerSets StickerSets
-	err = json.Unmarshal(result.Raw, &stickerSets)
+	err = jsonUnmarshal(result.Raw, &stickerSets)
 	return &stickerSets, err
 }
 
@@ -68,12 +223,12 @@ func (client *Client) GetArchivedStickerSets(isMasks bool, offsetStickerSetId JS
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var stickerSets StickerSets
-	err = json.Unmarshal(result.Raw, &stickerSets)
+	err = jsonUnmarshal(result.Raw, &stickerSets)
 	return &stickerSets, err
 }
 
@@ -91,12 +246,12 @@ func (client *Client) GetTrendingStickerSets(offset int32, limit int32) (*Sticke
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var stickerSets StickerSets
-	err = json.Unmarshal(result.Raw, &stickerSets)
+	err = jsonUnmarshal(result.Raw, &stickerSets)
 	return &stickerSets, err
 }
 
@@ -112,12 +267,12 @@ func (client *Client) GetAttachedStickerSets(fileId int32) (*StickerSets, error)
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var stickerSets StickerSets
-	err = json.Unmarshal(result.Raw, &stickerSets)
+	err = jsonUnmarshal(result.Raw, &stickerSets)
 	return &stickerSets, err
 }
 
@@ -137,12 +292,12 @@ func (client *Client) SearchInstalledStickerSets(isMasks bool, query string, lim
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var stickerSets StickerSets
-	err = json.Unmarshal(result.Raw, &stickerSets)
+	err = jsonUnmarshal(result.Raw, &stickerSets)
 	return &stickerSets, err
 }
 
@@ -158,11 +313,11 @@ func (client *Client) SearchStickerSets(query string) (*StickerSets, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var stickerSets StickerSets
-	err = json.Unmarshal(result.Raw, &stickerSets)
+	err = jsonUnmarshal(result.Raw, &stickerSets)
 	return &stickerSets, err
 }
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // Stickers Represents a list of stickers
@@ -28,6 +28,150 @@ func NewStickers(stickers []Sticker) *Stickers {
 	return &stickersTemp
 }
 
+// MarshalJSON marshals to json
+func (stickers *Stickers) MarshalJSON() ([]byte, error) {
+	return stickers.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (stickers *Stickers) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, stickers)
+}
+
+func (stickers *Stickers) appendJSON(b []byte) ([]byte, error) {
+	if stickers == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, stickers.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, stickers.tdCommon.Extra)
+	b = append(b, `,"stickers":`...)
+	if stickers.Stickers == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range stickers.Stickers {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = stickers.Stickers[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (stickers *Stickers) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			stickers.tdCommon.Type, err = r.readString()
+		case "@extra":
+			stickers.tdCommon.Extra, err = r.readString()
+		case "stickers":
+			if r.readNull() {
+				stickers.Stickers = nil
+			} else {
+				stickers.Stickers = make([]Sticker, 0)
+				err = r.readArray(func() (err error) {
+					var item0 Sticker
+					err = item0.decodeJSON(r)
+					stickers.Stickers = append(stickers.Stickers, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (stickers *Stickers) Clone() *Stickers {
+	if stickers == nil {
+		return nil
+	}
+	clone := *stickers
+	if stickers.Stickers != nil {
+		clone.Stickers = make([]Sticker, len(stickers.Stickers))
+		for i0 := range stickers.Stickers {
+			clone.Stickers[i0] = *stickers.Stickers[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (stickers *Stickers) Equal(other *Stickers) bool {
+	if stickers == nil || other == nil {
+		return stickers == other
+	}
+	if len(stickers.Stickers) != len(other.Stickers) {
+		return false
+	}
+	for i0 := range stickers.Stickers {
+		if !stickers.Stickers[i0].Equal(&other.Stickers[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (stickers *Stickers) Diff(other *Stickers) []Change {
+	return stickers.diff("", other, nil)
+}
+
+func (stickers *Stickers) diff(path string, other *Stickers, changes []Change) []Change {
+	if stickers == nil || other == nil {
+		if stickers != other {
+			changes = append(changes, Change{Path: path, Old: stickers, New: other})
+		}
+		return changes
+	}
+	if len(stickers.Stickers) != len(other.Stickers) {
+		changes = append(changes, Change{Path: fieldPath(path, "stickers"), Old: stickers.Stickers, New: other.Stickers})
+	} else {
+		for i0 := range stickers.Stickers {
+			changes = stickers.Stickers[i0].diff(indexPath(fieldPath(path, "stickers"), i0), &other.Stickers[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (stickers *Stickers) String() string {
+	return objectString(stickers)
+}
+
+// LogValue logs the object as its summary
+func (stickers *Stickers) LogValue() slog.Value {
+	return slog.StringValue(objectString(stickers))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (stickers *Stickers) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, stickers)
+}
+
+func (stickers *Stickers) printText(p *printer) {
+	if stickers == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Stickers")
+	p.vector("stickers", len(stickers.Stickers), func(i0 int) {
+		stickers.Stickers[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetStickers Returns stickers from the installed sticker sets that correspond to a given emoji. If the emoji is non-empty, favorite and recently used stickers may also be returned
 // @param emoji String representation of emoji. If empty, returns all known installed stickers
 // @param limit The maximum number of stickers to be returned
@@ -42,12 +186,12 @@ func (client *Client) GetStickers(emoji string, limit int32) (*Stickers, error)
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var stickers Stickers
-	err = json.Unmarshal(result.Raw, &stickers)
+	err = jsonUnmarshal(result.Raw, &stickers)
 	return &stickers, err
 }
 
@@ -65,12 +209,12 @@ func (client *Client) SearchStickers(emoji string, limit int32) (*Stickers, erro
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var stickers Stickers
-	err = json.Unmarshal(result.Raw, &stickers)
+	err = jsonUnmarshal(result.Raw, &stickers)
 	return &stickers, err
 }
 
@@ -86,12 +230,12 @@ func (client *Client) GetRecentStickers(isAttached bool) (*Stickers, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var stickers Stickers
-	err = json.Unmarshal(result.Raw, &stickers)
+	err = jsonUnmarshal(result.Raw, &stickers)
 	return &stickers, err
 }
 
@@ -109,12 +253,12 @@ func (client *Client) AddRecentSticker(isAttached bool, sticker InputFile) (*Sti
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var stickers Stickers
-	err = json.Unmarshal(result.Raw, &stickers)
+	err = jsonUnmarshal(result.Raw, &stickers)
 	return &stickers, err
 }
 
@@ -128,11 +272,11 @@ func (client *Client) GetFavoriteStickers() (*Stickers, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var stickers Stickers
-	err = json.Unmarshal(result.Raw, &stickers)
+	err = jsonUnmarshal(result.Raw, &stickers)
 	return &stickers, err
 }
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // StorageStatistics Contains the exact storage usage statistics split by chats and file type
@@ -34,6 +34,172 @@ func NewStorageStatistics(size int64, count int32, byChat []StorageStatisticsByC
 	return &storageStatisticsTemp
 }
 
+// MarshalJSON marshals to json
+func (storageStatistics *StorageStatistics) MarshalJSON() ([]byte, error) {
+	return storageStatistics.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (storageStatistics *StorageStatistics) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, storageStatistics)
+}
+
+func (storageStatistics *StorageStatistics) appendJSON(b []byte) ([]byte, error) {
+	if storageStatistics == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, storageStatistics.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, storageStatistics.tdCommon.Extra)
+	b = append(b, `,"size":`...)
+	b = appendJSONInt(b, int64(storageStatistics.Size))
+	b = append(b, `,"count":`...)
+	b = appendJSONInt(b, int64(storageStatistics.Count))
+	b = append(b, `,"by_chat":`...)
+	if storageStatistics.ByChat == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range storageStatistics.ByChat {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = storageStatistics.ByChat[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (storageStatistics *StorageStatistics) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			storageStatistics.tdCommon.Type, err = r.readString()
+		case "@extra":
+			storageStatistics.tdCommon.Extra, err = r.readString()
+		case "size":
+			storageStatistics.Size, err = r.readInt64()
+		case "count":
+			storageStatistics.Count, err = r.readInt32()
+		case "by_chat":
+			if r.readNull() {
+				storageStatistics.ByChat = nil
+			} else {
+				storageStatistics.ByChat = make([]StorageStatisticsByChat, 0)
+				err = r.readArray(func() (err error) {
+					var item0 StorageStatisticsByChat
+					err = item0.decodeJSON(r)
+					storageStatistics.ByChat = append(storageStatistics.ByChat, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (storageStatistics *StorageStatistics) Clone() *StorageStatistics {
+	if storageStatistics == nil {
+		return nil
+	}
+	clone := *storageStatistics
+	if storageStatistics.ByChat != nil {
+		clone.ByChat = make([]StorageStatisticsByChat, len(storageStatistics.ByChat))
+		for i0 := range storageStatistics.ByChat {
+			clone.ByChat[i0] = *storageStatistics.ByChat[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (storageStatistics *StorageStatistics) Equal(other *StorageStatistics) bool {
+	if storageStatistics == nil || other == nil {
+		return storageStatistics == other
+	}
+	if storageStatistics.Size != other.Size {
+		return false
+	}
+	if storageStatistics.Count != other.Count {
+		return false
+	}
+	if len(storageStatistics.ByChat) != len(other.ByChat) {
+		return false
+	}
+	for i0 := range storageStatistics.ByChat {
+		if !storageStatistics.ByChat[i0].Equal(&other.ByChat[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (storageStatistics *StorageStatistics) Diff(other *StorageStatistics) []Change {
+	return storageStatistics.diff("", other, nil)
+}
+
+func (storageStatistics *StorageStatistics) diff(path string, other *StorageStatistics, changes []Change) []Change {
+	if storageStatistics == nil || other == nil {
+		if storageStatistics != other {
+			changes = append(changes, Change{Path: path, Old: storageStatistics, New: other})
+		}
+		return changes
+	}
+	if storageStatistics.Size != other.Size {
+		changes = append(changes, Change{Path: fieldPath(path, "size"), Old: storageStatistics.Size, New: other.Size})
+	}
+	if storageStatistics.Count != other.Count {
+		changes = append(changes, Change{Path: fieldPath(path, "count"), Old: storageStatistics.Count, New: other.Count})
+	}
+	if len(storageStatistics.ByChat) != len(other.ByChat) {
+		changes = append(changes, Change{Path: fieldPath(path, "by_chat"), Old: storageStatistics.ByChat, New: other.ByChat})
+	} else {
+		for i0 := range storageStatistics.ByChat {
+			changes = storageStatistics.ByChat[i0].diff(indexPath(fieldPath(path, "by_chat"), i0), &other.ByChat[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (storageStatistics *StorageStatistics) String() string {
+	return objectString(storageStatistics)
+}
+
+// LogValue logs the object as its summary
+func (storageStatistics *StorageStatistics) LogValue() slog.Value {
+	return slog.StringValue(objectString(storageStatistics))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (storageStatistics *StorageStatistics) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, storageStatistics)
+}
+
+func (storageStatistics *StorageStatistics) printText(p *printer) {
+	if storageStatistics == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("StorageStatistics")
+	p.int("size", int64(storageStatistics.Size))
+	p.int("count", int64(storageStatistics.Count))
+	p.vector("by_chat", len(storageStatistics.ByChat), func(i0 int) {
+		storageStatistics.ByChat[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetStorageStatistics Returns storage usage statistics. Can be called before authorization
 // @param chatLimit The maximum number of chats with the largest storage usage for which separate statistics need to be returned. All other chats will be grouped in entries with chat_id == 0. If the chat info database is not used, the chat_limit is ignored and is always set to 0
 func (client *Client) GetStorageStatistics(chatLimit int32) (*StorageStatistics, error) {
@@ -46,12 +212,12 @@ func (client *Client) GetStorageStatistics(chatLimit int32) (*StorageStatistics,
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var storageStatistics StorageStatistics
-	err = json.Unmarshal(result.Raw, &storageStatistics)
+	err = jsonUnmarshal(result.Raw, &storageStatistics)
 	return &storageStatistics, err
 }
 
@@ -83,11 +249,11 @@ func (client *Client) OptimizeStorage(size int64, ttl int32, count int32, immuni
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var storageStatistics StorageStatistics
-	err = json.Unmarshal(result.Raw, &storageStatistics)
+	err = jsonUnmarshal(result.Raw, &storageStatistics)
 	return &storageStatistics, err
 }
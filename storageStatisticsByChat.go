@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // StorageStatisticsByChat Contains the storage usage statistics for a specific chat
 type StorageStatisticsByChat struct {
 	tdCommon
@@ -31,3 +36,180 @@ func NewStorageStatisticsByChat(chatId int64, size int64, count int32, byFileTyp
 
 	return &storageStatisticsByChatTemp
 }
+
+// MarshalJSON marshals to json
+func (storageStatisticsByChat *StorageStatisticsByChat) MarshalJSON() ([]byte, error) {
+	return storageStatisticsByChat.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (storageStatisticsByChat *StorageStatisticsByChat) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, storageStatisticsByChat)
+}
+
+func (storageStatisticsByChat *StorageStatisticsByChat) appendJSON(b []byte) ([]byte, error) {
+	if storageStatisticsByChat == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, storageStatisticsByChat.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, storageStatisticsByChat.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(storageStatisticsByChat.ChatId))
+	b = append(b, `,"size":`...)
+	b = appendJSONInt(b, int64(storageStatisticsByChat.Size))
+	b = append(b, `,"count":`...)
+	b = appendJSONInt(b, int64(storageStatisticsByChat.Count))
+	b = append(b, `,"by_file_type":`...)
+	if storageStatisticsByChat.ByFileType == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range storageStatisticsByChat.ByFileType {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = storageStatisticsByChat.ByFileType[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (storageStatisticsByChat *StorageStatisticsByChat) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			storageStatisticsByChat.tdCommon.Type, err = r.readString()
+		case "@extra":
+			storageStatisticsByChat.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			storageStatisticsByChat.ChatId, err = r.readInt64()
+		case "size":
+			storageStatisticsByChat.Size, err = r.readInt64()
+		case "count":
+			storageStatisticsByChat.Count, err = r.readInt32()
+		case "by_file_type":
+			if r.readNull() {
+				storageStatisticsByChat.ByFileType = nil
+			} else {
+				storageStatisticsByChat.ByFileType = make([]StorageStatisticsByFileType, 0)
+				err = r.readArray(func() (err error) {
+					var item0 StorageStatisticsByFileType
+					err = item0.decodeJSON(r)
+					storageStatisticsByChat.ByFileType = append(storageStatisticsByChat.ByFileType, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (storageStatisticsByChat *StorageStatisticsByChat) Clone() *StorageStatisticsByChat {
+	if storageStatisticsByChat == nil {
+		return nil
+	}
+	clone := *storageStatisticsByChat
+	if storageStatisticsByChat.ByFileType != nil {
+		clone.ByFileType = make([]StorageStatisticsByFileType, len(storageStatisticsByChat.ByFileType))
+		for i0 := range storageStatisticsByChat.ByFileType {
+			clone.ByFileType[i0] = *storageStatisticsByChat.ByFileType[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (storageStatisticsByChat *StorageStatisticsByChat) Equal(other *StorageStatisticsByChat) bool {
+	if storageStatisticsByChat == nil || other == nil {
+		return storageStatisticsByChat == other
+	}
+	if storageStatisticsByChat.ChatId != other.ChatId {
+		return false
+	}
+	if storageStatisticsByChat.Size != other.Size {
+		return false
+	}
+	if storageStatisticsByChat.Count != other.Count {
+		return false
+	}
+	if len(storageStatisticsByChat.ByFileType) != len(other.ByFileType) {
+		return false
+	}
+	for i0 := range storageStatisticsByChat.ByFileType {
+		if !storageStatisticsByChat.ByFileType[i0].Equal(&other.ByFileType[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (storageStatisticsByChat *StorageStatisticsByChat) Diff(other *StorageStatisticsByChat) []Change {
+	return storageStatisticsByChat.diff("", other, nil)
+}
+
+func (storageStatisticsByChat *StorageStatisticsByChat) diff(path string, other *StorageStatisticsByChat, changes []Change) []Change {
+	if storageStatisticsByChat == nil || other == nil {
+		if storageStatisticsByChat != other {
+			changes = append(changes, Change{Path: path, Old: storageStatisticsByChat, New: other})
+		}
+		return changes
+	}
+	if storageStatisticsByChat.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: storageStatisticsByChat.ChatId, New: other.ChatId})
+	}
+	if storageStatisticsByChat.Size != other.Size {
+		changes = append(changes, Change{Path: fieldPath(path, "size"), Old: storageStatisticsByChat.Size, New: other.Size})
+	}
+	if storageStatisticsByChat.Count != other.Count {
+		changes = append(changes, Change{Path: fieldPath(path, "count"), Old: storageStatisticsByChat.Count, New: other.Count})
+	}
+	if len(storageStatisticsByChat.ByFileType) != len(other.ByFileType) {
+		changes = append(changes, Change{Path: fieldPath(path, "by_file_type"), Old: storageStatisticsByChat.ByFileType, New: other.ByFileType})
+	} else {
+		for i0 := range storageStatisticsByChat.ByFileType {
+			changes = storageStatisticsByChat.ByFileType[i0].diff(indexPath(fieldPath(path, "by_file_type"), i0), &other.ByFileType[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (storageStatisticsByChat *StorageStatisticsByChat) String() string {
+	return objectString(storageStatisticsByChat)
+}
+
+// LogValue logs the object as its summary
+func (storageStatisticsByChat *StorageStatisticsByChat) LogValue() slog.Value {
+	return slog.StringValue(objectString(storageStatisticsByChat))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (storageStatisticsByChat *StorageStatisticsByChat) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, storageStatisticsByChat)
+}
+
+func (storageStatisticsByChat *StorageStatisticsByChat) printText(p *printer) {
+	if storageStatisticsByChat == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("StorageStatisticsByChat")
+	p.int("chat_id", int64(storageStatisticsByChat.ChatId))
+	p.int("size", int64(storageStatisticsByChat.Size))
+	p.int("count", int64(storageStatisticsByChat.Count))
+	p.vector("by_file_type", len(storageStatisticsByChat.ByFileType), func(i0 int) {
+		storageStatisticsByChat.ByFileType[i0].printText(p)
+	})
+	p.endObject(state)
+}
@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // StorageStatisticsByFileType Contains the storage usage statistics for a specific file type
@@ -33,29 +34,129 @@ func NewStorageStatisticsByFileType(fileType FileType, size int64, count int32)
 	return &storageStatisticsByFileTypeTemp
 }
 
+// MarshalJSON marshals to json
+func (storageStatisticsByFileType *StorageStatisticsByFileType) MarshalJSON() ([]byte, error) {
+	return storageStatisticsByFileType.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (storageStatisticsByFileType *StorageStatisticsByFileType) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Size  int64 `json:"size"`  // Total size of the files, in bytes
-		Count int32 `json:"count"` // Total number of files
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	return unmarshalFast(b, storageStatisticsByFileType)
+}
+
+func (storageStatisticsByFileType *StorageStatisticsByFileType) appendJSON(b []byte) ([]byte, error) {
+	if storageStatisticsByFileType == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, storageStatisticsByFileType.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, storageStatisticsByFileType.tdCommon.Extra)
+	b = append(b, `,"file_type":`...)
+	b, err = appendJSONValue(b, storageStatisticsByFileType.FileType)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"size":`...)
+	b = appendJSONInt(b, int64(storageStatisticsByFileType.Size))
+	b = append(b, `,"count":`...)
+	b = appendJSONInt(b, int64(storageStatisticsByFileType.Count))
+	return append(b, '}'), nil
+}
+
+func (storageStatisticsByFileType *StorageStatisticsByFileType) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			storageStatisticsByFileType.tdCommon.Type, err = r.readString()
+		case "@extra":
+			storageStatisticsByFileType.tdCommon.Extra, err = r.readString()
+		case "file_type":
+			storageStatisticsByFileType.FileType, err = decodeFileType(r)
+		case "size":
+			storageStatisticsByFileType.Size, err = r.readInt64()
+		case "count":
+			storageStatisticsByFileType.Count, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (storageStatisticsByFileType *StorageStatisticsByFileType) Clone() *StorageStatisticsByFileType {
+	if storageStatisticsByFileType == nil {
+		return nil
+	}
+	clone := *storageStatisticsByFileType
+	clone.FileType = cloneFileType(storageStatisticsByFileType.FileType)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (storageStatisticsByFileType *StorageStatisticsByFileType) Equal(other *StorageStatisticsByFileType) bool {
+	if storageStatisticsByFileType == nil || other == nil {
+		return storageStatisticsByFileType == other
+	}
+	if !equalFileType(storageStatisticsByFileType.FileType, other.FileType) {
+		return false
 	}
+	if storageStatisticsByFileType.Size != other.Size {
+		return false
+	}
+	if storageStatisticsByFileType.Count != other.Count {
+		return false
+	}
+	return true
+}
 
-	storageStatisticsByFileType.tdCommon = tempObj.tdCommon
-	storageStatisticsByFileType.Size = tempObj.Size
-	storageStatisticsByFileType.Count = tempObj.Count
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (storageStatisticsByFileType *StorageStatisticsByFileType) Diff(other *StorageStatisticsByFileType) []Change {
+	return storageStatisticsByFileType.diff("", other, nil)
+}
 
-	fieldFileType, _ := unmarshalFileType(objMap["file_type"])
-	storageStatisticsByFileType.FileType = fieldFileType
+func (storageStatisticsByFileType *StorageStatisticsByFileType) diff(path string, other *StorageStatisticsByFileType, changes []Change) []Change {
+	if storageStatisticsByFileType == nil || other == nil {
+		if storageStatisticsByFileType != other {
+			changes = append(changes, Change{Path: path, Old: storageStatisticsByFileType, New: other})
+		}
+		return changes
+	}
+	changes = diffFileType(fieldPath(path, "file_type"), storageStatisticsByFileType.FileType, other.FileType, changes)
+	if storageStatisticsByFileType.Size != other.Size {
+		changes = append(changes, Change{Path: fieldPath(path, "size"), Old: storageStatisticsByFileType.Size, New: other.Size})
+	}
+	if storageStatisticsByFileType.Count != other.Count {
+		changes = append(changes, Change{Path: fieldPath(path, "count"), Old: storageStatisticsByFileType.Count, New: other.Count})
+	}
+	return changes
+}
 
-	return nil
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (storageStatisticsByFileType *StorageStatisticsByFileType) String() string {
+	return objectString(storageStatisticsByFileType)
+}
+
+// LogValue logs the object as its summary
+func (storageStatisticsByFileType *StorageStatisticsByFileType) LogValue() slog.Value {
+	return slog.StringValue(objectString(storageStatisticsByFileType))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (storageStatisticsByFileType *StorageStatisticsByFileType) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, storageStatisticsByFileType)
+}
+
+func (storageStatisticsByFileType *StorageStatisticsByFileType) printText(p *printer) {
+	if storageStatisticsByFileType == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("StorageStatisticsByFileType")
+	p.object("file_type", storageStatisticsByFileType.FileType)
+	p.int("size", int64(storageStatisticsByFileType.Size))
+	p.int("count", int64(storageStatisticsByFileType.Count))
+	p.endObject(state)
 }
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // StorageStatisticsFast Contains approximate storage usage statistics, excluding files of unknown file type
@@ -40,6 +40,152 @@ func NewStorageStatisticsFast(filesSize int64, fileCount int32, databaseSize int
 	return &storageStatisticsFastTemp
 }
 
+// MarshalJSON marshals to json
+func (storageStatisticsFast *StorageStatisticsFast) MarshalJSON() ([]byte, error) {
+	return storageStatisticsFast.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (storageStatisticsFast *StorageStatisticsFast) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, storageStatisticsFast)
+}
+
+func (storageStatisticsFast *StorageStatisticsFast) appendJSON(b []byte) ([]byte, error) {
+	if storageStatisticsFast == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, storageStatisticsFast.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, storageStatisticsFast.tdCommon.Extra)
+	b = append(b, `,"files_size":`...)
+	b = appendJSONInt(b, int64(storageStatisticsFast.FilesSize))
+	b = append(b, `,"file_count":`...)
+	b = appendJSONInt(b, int64(storageStatisticsFast.FileCount))
+	b = append(b, `,"database_size":`...)
+	b = appendJSONInt(b, int64(storageStatisticsFast.DatabaseSize))
+	b = append(b, `,"language_pack_database_size":`...)
+	b = appendJSONInt(b, int64(storageStatisticsFast.LanguagePackDatabaseSize))
+	b = append(b, `,"log_size":`...)
+	b = appendJSONInt(b, int64(storageStatisticsFast.LogSize))
+	return append(b, '}'), nil
+}
+
+func (storageStatisticsFast *StorageStatisticsFast) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			storageStatisticsFast.tdCommon.Type, err = r.readString()
+		case "@extra":
+			storageStatisticsFast.tdCommon.Extra, err = r.readString()
+		case "files_size":
+			storageStatisticsFast.FilesSize, err = r.readInt64()
+		case "file_count":
+			storageStatisticsFast.FileCount, err = r.readInt32()
+		case "database_size":
+			storageStatisticsFast.DatabaseSize, err = r.readInt64()
+		case "language_pack_database_size":
+			storageStatisticsFast.LanguagePackDatabaseSize, err = r.readInt64()
+		case "log_size":
+			storageStatisticsFast.LogSize, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (storageStatisticsFast *StorageStatisticsFast) Clone() *StorageStatisticsFast {
+	if storageStatisticsFast == nil {
+		return nil
+	}
+	clone := *storageStatisticsFast
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (storageStatisticsFast *StorageStatisticsFast) Equal(other *StorageStatisticsFast) bool {
+	if storageStatisticsFast == nil || other == nil {
+		return storageStatisticsFast == other
+	}
+	if storageStatisticsFast.FilesSize != other.FilesSize {
+		return false
+	}
+	if storageStatisticsFast.FileCount != other.FileCount {
+		return false
+	}
+	if storageStatisticsFast.DatabaseSize != other.DatabaseSize {
+		return false
+	}
+	if storageStatisticsFast.LanguagePackDatabaseSize != other.LanguagePackDatabaseSize {
+		return false
+	}
+	if storageStatisticsFast.LogSize != other.LogSize {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (storageStatisticsFast *StorageStatisticsFast) Diff(other *StorageStatisticsFast) []Change {
+	return storageStatisticsFast.diff("", other, nil)
+}
+
+func (storageStatisticsFast *StorageStatisticsFast) diff(path string, other *StorageStatisticsFast, changes []Change) []Change {
+	if storageStatisticsFast == nil || other == nil {
+		if storageStatisticsFast != other {
+			changes = append(changes, Change{Path: path, Old: storageStatisticsFast, New: other})
+		}
+		return changes
+	}
+	if storageStatisticsFast.FilesSize != other.FilesSize {
+		changes = append(changes, Change{Path: fieldPath(path, "files_size"), Old: storageStatisticsFast.FilesSize, New: other.FilesSize})
+	}
+	if storageStatisticsFast.FileCount != other.FileCount {
+		changes = append(changes, Change{Path: fieldPath(path, "file_count"), Old: storageStatisticsFast.FileCount, New: other.FileCount})
+	}
+	if storageStatisticsFast.DatabaseSize != other.DatabaseSize {
+		changes = append(changes, Change{Path: fieldPath(path, "database_size"), Old: storageStatisticsFast.DatabaseSize, New: other.DatabaseSize})
+	}
+	if storageStatisticsFast.LanguagePackDatabaseSize != other.LanguagePackDatabaseSize {
+		changes = append(changes, Change{Path: fieldPath(path, "language_pack_database_size"), Old: storageStatisticsFast.LanguagePackDatabaseSize, New: other.LanguagePackDatabaseSize})
+	}
+	if storageStatisticsFast.LogSize != other.LogSize {
+		changes = append(changes, Change{Path: fieldPath(path, "log_size"), Old: storageStatisticsFast.LogSize, New: other.LogSize})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (storageStatisticsFast *StorageStatisticsFast) String() string {
+	return objectString(storageStatisticsFast)
+}
+
+// LogValue logs the object as its summary
+func (storageStatisticsFast *StorageStatisticsFast) LogValue() slog.Value {
+	return slog.StringValue(objectString(storageStatisticsFast))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (storageStatisticsFast *StorageStatisticsFast) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, storageStatisticsFast)
+}
+
+func (storageStatisticsFast *StorageStatisticsFast) printText(p *printer) {
+	if storageStatisticsFast == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("StorageStatisticsFast")
+	p.int("files_size", int64(storageStatisticsFast.FilesSize))
+	p.int("file_count", int64(storageStatisticsFast.FileCount))
+	p.int("database_size", int64(storageStatisticsFast.DatabaseSize))
+	p.int("language_pack_database_size", int64(storageStatisticsFast.LanguagePackDatabaseSize))
+	p.int("log_size", int64(storageStatisticsFast.LogSize))
+	p.endObject(state)
+}
+
 // GetStorageStatisticsFast Quickly returns approximate storage usage statistics. Can be called before authorization
 func (client *Client) GetStorageStatisticsFast() (*StorageStatisticsFast, error) {
 	result, err := client.SendAndCatch(UpdateData{
@@ -50,11 +196,11 @@ func (client *Client) GetStorageStatisticsFast() (*StorageStatisticsFast, error)
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var storageStatisticsFast StorageStatisticsFast
-	err = json.Unmarshal(result.Raw, &storageStatisticsFast)
+	err = jsonUnmarshal(result.Raw, &storageStatisticsFast)
 	return &storageStatisticsFast, err
 }
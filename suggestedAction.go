@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // SuggestedAction Describes an action suggested to the current user
 type SuggestedAction interface {
 	GetSuggestedActionEnum() SuggestedActionEnum
+	Accept(visitor SuggestedActionVisitor)
 }
 
 // SuggestedActionEnum Alias for abstract SuggestedAction 'Sub-Classes', used as constant-enum here
@@ -23,53 +25,127 @@ const (
 	SuggestedActionSetPasswordType                  SuggestedActionEnum = "suggestedActionSetPassword"
 )
 
+// UnknownSuggestedAction is a SuggestedAction of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownSuggestedAction struct {
+	Unknown
+}
+
+// GetSuggestedActionEnum return the enum type of this object
+func (unknownSuggestedAction *UnknownSuggestedAction) GetSuggestedActionEnum() SuggestedActionEnum {
+	return SuggestedActionEnum(unknownSuggestedAction.Type)
+}
+
+// Accept calls the method of the visitor for UnknownSuggestedAction
+func (unknownSuggestedAction *UnknownSuggestedAction) Accept(visitor SuggestedActionVisitor) {
+	visitor.VisitUnknownSuggestedAction(unknownSuggestedAction)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownSuggestedAction *UnknownSuggestedAction) String() string {
+	return objectString(unknownSuggestedAction)
+}
+
+// LogValue logs the object as its summary
+func (unknownSuggestedAction *UnknownSuggestedAction) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownSuggestedAction))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownSuggestedAction *UnknownSuggestedAction) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownSuggestedAction)
+}
+
+func (unknownSuggestedAction *UnknownSuggestedAction) printText(p *printer) {
+	if unknownSuggestedAction == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownSuggestedAction")
+	p.string("@type", unknownSuggestedAction.Type)
+	p.endObject(state)
+}
+
 func unmarshalSuggestedAction(rawMsg *json.RawMessage) (SuggestedAction, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch SuggestedActionEnum(objMap["@type"].(string)) {
+	switch SuggestedActionEnum(typeName) {
 	case SuggestedActionEnableArchiveAndMuteNewChatsType:
 		var suggestedActionEnableArchiveAndMuteNewChats SuggestedActionEnableArchiveAndMuteNewChats
-		err := json.Unmarshal(*rawMsg, &suggestedActionEnableArchiveAndMuteNewChats)
+		err := jsonUnmarshal(*rawMsg, &suggestedActionEnableArchiveAndMuteNewChats)
 		return &suggestedActionEnableArchiveAndMuteNewChats, err
 
 	case SuggestedActionCheckPasswordType:
 		var suggestedActionCheckPassword SuggestedActionCheckPassword
-		err := json.Unmarshal(*rawMsg, &suggestedActionCheckPassword)
+		err := jsonUnmarshal(*rawMsg, &suggestedActionCheckPassword)
 		return &suggestedActionCheckPassword, err
 
 	case SuggestedActionCheckPhoneNumberType:
 		var suggestedActionCheckPhoneNumber SuggestedActionCheckPhoneNumber
-		err := json.Unmarshal(*rawMsg, &suggestedActionCheckPhoneNumber)
+		err := jsonUnmarshal(*rawMsg, &suggestedActionCheckPhoneNumber)
 		return &suggestedActionCheckPhoneNumber, err
 
 	case SuggestedActionViewChecksHintType:
 		var suggestedActionViewChecksHint SuggestedActionViewChecksHint
-		err := json.Unmarshal(*rawMsg, &suggestedActionViewChecksHint)
+		err := jsonUnmarshal(*rawMsg, &suggestedActionViewChecksHint)
 		return &suggestedActionViewChecksHint, err
 
 	case SuggestedActionConvertToBroadcastGroupType:
 		var suggestedActionConvertToBroadcastGroup SuggestedActionConvertToBroadcastGroup
-		err := json.Unmarshal(*rawMsg, &suggestedActionConvertToBroadcastGroup)
+		err := jsonUnmarshal(*rawMsg, &suggestedActionConvertToBroadcastGroup)
 		return &suggestedActionConvertToBroadcastGroup, err
 
 	case SuggestedActionSetPasswordType:
 		var suggestedActionSetPassword SuggestedActionSetPassword
-		err := json.Unmarshal(*rawMsg, &suggestedActionSetPassword)
+		err := jsonUnmarshal(*rawMsg, &suggestedActionSetPassword)
 		return &suggestedActionSetPassword, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownSuggestedAction{Unknown: unknown}, nil
 	}
 }
 
+func unmarshalSuggestedActionSlice(rawMsg *json.RawMessage) ([]SuggestedAction, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var rawItems []*json.RawMessage
+	err := jsonUnmarshal(*rawMsg, &rawItems)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]SuggestedAction, 0, len(rawItems))
+	for _, rawItem := range rawItems {
+		item, err := unmarshalSuggestedAction(rawItem)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func decodeSuggestedAction(r *jsonReader) (SuggestedAction, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalSuggestedAction(rawMsg)
+}
+
 // SuggestedActionEnableArchiveAndMuteNewChats Suggests the user to enable "archive_and_mute_new_chats_from_unknown_users" option
 type SuggestedActionEnableArchiveAndMuteNewChats struct {
 	tdCommon
@@ -81,7 +157,6 @@ func (suggestedActionEnableArchiveAndMuteNewChats *SuggestedActionEnableArchiveA
 }
 
 // NewSuggestedActionEnableArchiveAndMuteNewChats creates a new SuggestedActionEnableArchiveAndMuteNewChats
-//
 func NewSuggestedActionEnableArchiveAndMuteNewChats() *SuggestedActionEnableArchiveAndMuteNewChats {
 	suggestedActionEnableArchiveAndMuteNewChatsTemp := SuggestedActionEnableArchiveAndMuteNewChats{
 		tdCommon: tdCommon{Type: "suggestedActionEnableArchiveAndMuteNewChats"},
@@ -90,11 +165,107 @@ func NewSuggestedActionEnableArchiveAndMuteNewChats() *SuggestedActionEnableArch
 	return &suggestedActionEnableArchiveAndMuteNewChatsTemp
 }
 
+// MarshalJSON marshals to json
+func (suggestedActionEnableArchiveAndMuteNewChats *SuggestedActionEnableArchiveAndMuteNewChats) MarshalJSON() ([]byte, error) {
+	return suggestedActionEnableArchiveAndMuteNewChats.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (suggestedActionEnableArchiveAndMuteNewChats *SuggestedActionEnableArchiveAndMuteNewChats) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, suggestedActionEnableArchiveAndMuteNewChats)
+}
+
+func (suggestedActionEnableArchiveAndMuteNewChats *SuggestedActionEnableArchiveAndMuteNewChats) appendJSON(b []byte) ([]byte, error) {
+	if suggestedActionEnableArchiveAndMuteNewChats == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, suggestedActionEnableArchiveAndMuteNewChats.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, suggestedActionEnableArchiveAndMuteNewChats.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (suggestedActionEnableArchiveAndMuteNewChats *SuggestedActionEnableArchiveAndMuteNewChats) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			suggestedActionEnableArchiveAndMuteNewChats.tdCommon.Type, err = r.readString()
+		case "@extra":
+			suggestedActionEnableArchiveAndMuteNewChats.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (suggestedActionEnableArchiveAndMuteNewChats *SuggestedActionEnableArchiveAndMuteNewChats) Clone() *SuggestedActionEnableArchiveAndMuteNewChats {
+	if suggestedActionEnableArchiveAndMuteNewChats == nil {
+		return nil
+	}
+	clone := *suggestedActionEnableArchiveAndMuteNewChats
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (suggestedActionEnableArchiveAndMuteNewChats *SuggestedActionEnableArchiveAndMuteNewChats) Equal(other *SuggestedActionEnableArchiveAndMuteNewChats) bool {
+	if suggestedActionEnableArchiveAndMuteNewChats == nil || other == nil {
+		return suggestedActionEnableArchiveAndMuteNewChats == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (suggestedActionEnableArchiveAndMuteNewChats *SuggestedActionEnableArchiveAndMuteNewChats) Diff(other *SuggestedActionEnableArchiveAndMuteNewChats) []Change {
+	return suggestedActionEnableArchiveAndMuteNewChats.diff("", other, nil)
+}
+
+func (suggestedActionEnableArchiveAndMuteNewChats *SuggestedActionEnableArchiveAndMuteNewChats) diff(path string, other *SuggestedActionEnableArchiveAndMuteNewChats, changes []Change) []Change {
+	if suggestedActionEnableArchiveAndMuteNewChats == nil || other == nil {
+		if suggestedActionEnableArchiveAndMuteNewChats != other {
+			changes = append(changes, Change{Path: path, Old: suggestedActionEnableArchiveAndMuteNewChats, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (suggestedActionEnableArchiveAndMuteNewChats *SuggestedActionEnableArchiveAndMuteNewChats) String() string {
+	return objectString(suggestedActionEnableArchiveAndMuteNewChats)
+}
+
+// LogValue logs the object as its summary
+func (suggestedActionEnableArchiveAndMuteNewChats *SuggestedActionEnableArchiveAndMuteNewChats) LogValue() slog.Value {
+	return slog.StringValue(objectString(suggestedActionEnableArchiveAndMuteNewChats))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (suggestedActionEnableArchiveAndMuteNewChats *SuggestedActionEnableArchiveAndMuteNewChats) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, suggestedActionEnableArchiveAndMuteNewChats)
+}
+
+func (suggestedActionEnableArchiveAndMuteNewChats *SuggestedActionEnableArchiveAndMuteNewChats) printText(p *printer) {
+	if suggestedActionEnableArchiveAndMuteNewChats == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SuggestedActionEnableArchiveAndMuteNewChats")
+	p.endObject(state)
+}
+
 // GetSuggestedActionEnum return the enum type of this object
 func (suggestedActionEnableArchiveAndMuteNewChats *SuggestedActionEnableArchiveAndMuteNewChats) GetSuggestedActionEnum() SuggestedActionEnum {
 	return SuggestedActionEnableArchiveAndMuteNewChatsType
 }
 
+// Accept calls the method of the visitor for SuggestedActionEnableArchiveAndMuteNewChats
+func (suggestedActionEnableArchiveAndMuteNewChats *SuggestedActionEnableArchiveAndMuteNewChats) Accept(visitor SuggestedActionVisitor) {
+	visitor.VisitSuggestedActionEnableArchiveAndMuteNewChats(suggestedActionEnableArchiveAndMuteNewChats)
+}
+
 // SuggestedActionCheckPassword Suggests the user to check whether they still remember their 2-step verification password
 type SuggestedActionCheckPassword struct {
 	tdCommon
@@ -106,7 +277,6 @@ func (suggestedActionCheckPassword *SuggestedActionCheckPassword) MessageType()
 }
 
 // NewSuggestedActionCheckPassword creates a new SuggestedActionCheckPassword
-//
 func NewSuggestedActionCheckPassword() *SuggestedActionCheckPassword {
 	suggestedActionCheckPasswordTemp := SuggestedActionCheckPassword{
 		tdCommon: tdCommon{Type: "suggestedActionCheckPassword"},
@@ -115,11 +285,107 @@ func NewSuggestedActionCheckPassword() *SuggestedActionCheckPassword {
 	return &suggestedActionCheckPasswordTemp
 }
 
+// MarshalJSON marshals to json
+func (suggestedActionCheckPassword *SuggestedActionCheckPassword) MarshalJSON() ([]byte, error) {
+	return suggestedActionCheckPassword.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (suggestedActionCheckPassword *SuggestedActionCheckPassword) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, suggestedActionCheckPassword)
+}
+
+func (suggestedActionCheckPassword *SuggestedActionCheckPassword) appendJSON(b []byte) ([]byte, error) {
+	if suggestedActionCheckPassword == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, suggestedActionCheckPassword.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, suggestedActionCheckPassword.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (suggestedActionCheckPassword *SuggestedActionCheckPassword) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			suggestedActionCheckPassword.tdCommon.Type, err = r.readString()
+		case "@extra":
+			suggestedActionCheckPassword.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (suggestedActionCheckPassword *SuggestedActionCheckPassword) Clone() *SuggestedActionCheckPassword {
+	if suggestedActionCheckPassword == nil {
+		return nil
+	}
+	clone := *suggestedActionCheckPassword
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (suggestedActionCheckPassword *SuggestedActionCheckPassword) Equal(other *SuggestedActionCheckPassword) bool {
+	if suggestedActionCheckPassword == nil || other == nil {
+		return suggestedActionCheckPassword == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (suggestedActionCheckPassword *SuggestedActionCheckPassword) Diff(other *SuggestedActionCheckPassword) []Change {
+	return suggestedActionCheckPassword.diff("", other, nil)
+}
+
+func (suggestedActionCheckPassword *SuggestedActionCheckPassword) diff(path string, other *SuggestedActionCheckPassword, changes []Change) []Change {
+	if suggestedActionCheckPassword == nil || other == nil {
+		if suggestedActionCheckPassword != other {
+			changes = append(changes, Change{Path: path, Old: suggestedActionCheckPassword, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (suggestedActionCheckPassword *SuggestedActionCheckPassword) String() string {
+	return objectString(suggestedActionCheckPassword)
+}
+
+// LogValue logs the object as its summary
+func (suggestedActionCheckPassword *SuggestedActionCheckPassword) LogValue() slog.Value {
+	return slog.StringValue(objectString(suggestedActionCheckPassword))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (suggestedActionCheckPassword *SuggestedActionCheckPassword) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, suggestedActionCheckPassword)
+}
+
+func (suggestedActionCheckPassword *SuggestedActionCheckPassword) printText(p *printer) {
+	if suggestedActionCheckPassword == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SuggestedActionCheckPassword")
+	p.endObject(state)
+}
+
 // GetSuggestedActionEnum return the enum type of this object
 func (suggestedActionCheckPassword *SuggestedActionCheckPassword) GetSuggestedActionEnum() SuggestedActionEnum {
 	return SuggestedActionCheckPasswordType
 }
 
+// Accept calls the method of the visitor for SuggestedActionCheckPassword
+func (suggestedActionCheckPassword *SuggestedActionCheckPassword) Accept(visitor SuggestedActionVisitor) {
+	visitor.VisitSuggestedActionCheckPassword(suggestedActionCheckPassword)
+}
+
 // SuggestedActionCheckPhoneNumber Suggests the user to check whether authorization phone number is correct and change the phone number if it is inaccessible
 type SuggestedActionCheckPhoneNumber struct {
 	tdCommon
@@ -131,7 +397,6 @@ func (suggestedActionCheckPhoneNumber *SuggestedActionCheckPhoneNumber) MessageT
 }
 
 // NewSuggestedActionCheckPhoneNumber creates a new SuggestedActionCheckPhoneNumber
-//
 func NewSuggestedActionCheckPhoneNumber() *SuggestedActionCheckPhoneNumber {
 	suggestedActionCheckPhoneNumberTemp := SuggestedActionCheckPhoneNumber{
 		tdCommon: tdCommon{Type: "suggestedActionCheckPhoneNumber"},
@@ -140,11 +405,107 @@ func NewSuggestedActionCheckPhoneNumber() *SuggestedActionCheckPhoneNumber {
 	return &suggestedActionCheckPhoneNumberTemp
 }
 
+// MarshalJSON marshals to json
+func (suggestedActionCheckPhoneNumber *SuggestedActionCheckPhoneNumber) MarshalJSON() ([]byte, error) {
+	return suggestedActionCheckPhoneNumber.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (suggestedActionCheckPhoneNumber *SuggestedActionCheckPhoneNumber) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, suggestedActionCheckPhoneNumber)
+}
+
+func (suggestedActionCheckPhoneNumber *SuggestedActionCheckPhoneNumber) appendJSON(b []byte) ([]byte, error) {
+	if suggestedActionCheckPhoneNumber == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, suggestedActionCheckPhoneNumber.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, suggestedActionCheckPhoneNumber.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (suggestedActionCheckPhoneNumber *SuggestedActionCheckPhoneNumber) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			suggestedActionCheckPhoneNumber.tdCommon.Type, err = r.readString()
+		case "@extra":
+			suggestedActionCheckPhoneNumber.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (suggestedActionCheckPhoneNumber *SuggestedActionCheckPhoneNumber) Clone() *SuggestedActionCheckPhoneNumber {
+	if suggestedActionCheckPhoneNumber == nil {
+		return nil
+	}
+	clone := *suggestedActionCheckPhoneNumber
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (suggestedActionCheckPhoneNumber *SuggestedActionCheckPhoneNumber) Equal(other *SuggestedActionCheckPhoneNumber) bool {
+	if suggestedActionCheckPhoneNumber == nil || other == nil {
+		return suggestedActionCheckPhoneNumber == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (suggestedActionCheckPhoneNumber *SuggestedActionCheckPhoneNumber) Diff(other *SuggestedActionCheckPhoneNumber) []Change {
+	return suggestedActionCheckPhoneNumber.diff("", other, nil)
+}
+
+func (suggestedActionCheckPhoneNumber *SuggestedActionCheckPhoneNumber) diff(path string, other *SuggestedActionCheckPhoneNumber, changes []Change) []Change {
+	if suggestedActionCheckPhoneNumber == nil || other == nil {
+		if suggestedActionCheckPhoneNumber != other {
+			changes = append(changes, Change{Path: path, Old: suggestedActionCheckPhoneNumber, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (suggestedActionCheckPhoneNumber *SuggestedActionCheckPhoneNumber) String() string {
+	return objectString(suggestedActionCheckPhoneNumber)
+}
+
+// LogValue logs the object as its summary
+func (suggestedActionCheckPhoneNumber *SuggestedActionCheckPhoneNumber) LogValue() slog.Value {
+	return slog.StringValue(objectString(suggestedActionCheckPhoneNumber))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (suggestedActionCheckPhoneNumber *SuggestedActionCheckPhoneNumber) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, suggestedActionCheckPhoneNumber)
+}
+
+func (suggestedActionCheckPhoneNumber *SuggestedActionCheckPhoneNumber) printText(p *printer) {
+	if suggestedActionCheckPhoneNumber == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SuggestedActionCheckPhoneNumber")
+	p.endObject(state)
+}
+
 // GetSuggestedActionEnum return the enum type of this object
 func (suggestedActionCheckPhoneNumber *SuggestedActionCheckPhoneNumber) GetSuggestedActionEnum() SuggestedActionEnum {
 	return SuggestedActionCheckPhoneNumberType
 }
 
+// Accept calls the method of the visitor for SuggestedActionCheckPhoneNumber
+func (suggestedActionCheckPhoneNumber *SuggestedActionCheckPhoneNumber) Accept(visitor SuggestedActionVisitor) {
+	visitor.VisitSuggestedActionCheckPhoneNumber(suggestedActionCheckPhoneNumber)
+}
+
 // SuggestedActionViewChecksHint Suggests the user to view a hint about the meaning of one and two check marks on sent messages
 type SuggestedActionViewChecksHint struct {
 	tdCommon
@@ -156,7 +517,6 @@ func (suggestedActionViewChecksHint *SuggestedActionViewChecksHint) MessageType(
 }
 
 // NewSuggestedActionViewChecksHint creates a new SuggestedActionViewChecksHint
-//
 func NewSuggestedActionViewChecksHint() *SuggestedActionViewChecksHint {
 	suggestedActionViewChecksHintTemp := SuggestedActionViewChecksHint{
 		tdCommon: tdCommon{Type: "suggestedActionViewChecksHint"},
@@ -165,11 +525,107 @@ func NewSuggestedActionViewChecksHint() *SuggestedActionViewChecksHint {
 	return &suggestedActionViewChecksHintTemp
 }
 
+// MarshalJSON marshals to json
+func (suggestedActionViewChecksHint *SuggestedActionViewChecksHint) MarshalJSON() ([]byte, error) {
+	return suggestedActionViewChecksHint.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (suggestedActionViewChecksHint *SuggestedActionViewChecksHint) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, suggestedActionViewChecksHint)
+}
+
+func (suggestedActionViewChecksHint *SuggestedActionViewChecksHint) appendJSON(b []byte) ([]byte, error) {
+	if suggestedActionViewChecksHint == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, suggestedActionViewChecksHint.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, suggestedActionViewChecksHint.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (suggestedActionViewChecksHint *SuggestedActionViewChecksHint) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			suggestedActionViewChecksHint.tdCommon.Type, err = r.readString()
+		case "@extra":
+			suggestedActionViewChecksHint.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (suggestedActionViewChecksHint *SuggestedActionViewChecksHint) Clone() *SuggestedActionViewChecksHint {
+	if suggestedActionViewChecksHint == nil {
+		return nil
+	}
+	clone := *suggestedActionViewChecksHint
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (suggestedActionViewChecksHint *SuggestedActionViewChecksHint) Equal(other *SuggestedActionViewChecksHint) bool {
+	if suggestedActionViewChecksHint == nil || other == nil {
+		return suggestedActionViewChecksHint == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (suggestedActionViewChecksHint *SuggestedActionViewChecksHint) Diff(other *SuggestedActionViewChecksHint) []Change {
+	return suggestedActionViewChecksHint.diff("", other, nil)
+}
+
+func (suggestedActionViewChecksHint *SuggestedActionViewChecksHint) diff(path string, other *SuggestedActionViewChecksHint, changes []Change) []Change {
+	if suggestedActionViewChecksHint == nil || other == nil {
+		if suggestedActionViewChecksHint != other {
+			changes = append(changes, Change{Path: path, Old: suggestedActionViewChecksHint, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (suggestedActionViewChecksHint *SuggestedActionViewChecksHint) String() string {
+	return objectString(suggestedActionViewChecksHint)
+}
+
+// LogValue logs the object as its summary
+func (suggestedActionViewChecksHint *SuggestedActionViewChecksHint) LogValue() slog.Value {
+	return slog.StringValue(objectString(suggestedActionViewChecksHint))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (suggestedActionViewChecksHint *SuggestedActionViewChecksHint) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, suggestedActionViewChecksHint)
+}
+
+func (suggestedActionViewChecksHint *SuggestedActionViewChecksHint) printText(p *printer) {
+	if suggestedActionViewChecksHint == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SuggestedActionViewChecksHint")
+	p.endObject(state)
+}
+
 // GetSuggestedActionEnum return the enum type of this object
 func (suggestedActionViewChecksHint *SuggestedActionViewChecksHint) GetSuggestedActionEnum() SuggestedActionEnum {
 	return SuggestedActionViewChecksHintType
 }
 
+// Accept calls the method of the visitor for SuggestedActionViewChecksHint
+func (suggestedActionViewChecksHint *SuggestedActionViewChecksHint) Accept(visitor SuggestedActionVisitor) {
+	visitor.VisitSuggestedActionViewChecksHint(suggestedActionViewChecksHint)
+}
+
 // SuggestedActionConvertToBroadcastGroup Suggests the user to convert specified supergroup to a broadcast group
 type SuggestedActionConvertToBroadcastGroup struct {
 	tdCommon
@@ -193,11 +649,118 @@ func NewSuggestedActionConvertToBroadcastGroup(supergroupId int64) *SuggestedAct
 	return &suggestedActionConvertToBroadcastGroupTemp
 }
 
+// MarshalJSON marshals to json
+func (suggestedActionConvertToBroadcastGroup *SuggestedActionConvertToBroadcastGroup) MarshalJSON() ([]byte, error) {
+	return suggestedActionConvertToBroadcastGroup.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (suggestedActionConvertToBroadcastGroup *SuggestedActionConvertToBroadcastGroup) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, suggestedActionConvertToBroadcastGroup)
+}
+
+func (suggestedActionConvertToBroadcastGroup *SuggestedActionConvertToBroadcastGroup) appendJSON(b []byte) ([]byte, error) {
+	if suggestedActionConvertToBroadcastGroup == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, suggestedActionConvertToBroadcastGroup.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, suggestedActionConvertToBroadcastGroup.tdCommon.Extra)
+	b = append(b, `,"supergroup_id":`...)
+	b = appendJSONInt(b, int64(suggestedActionConvertToBroadcastGroup.SupergroupId))
+	return append(b, '}'), nil
+}
+
+func (suggestedActionConvertToBroadcastGroup *SuggestedActionConvertToBroadcastGroup) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			suggestedActionConvertToBroadcastGroup.tdCommon.Type, err = r.readString()
+		case "@extra":
+			suggestedActionConvertToBroadcastGroup.tdCommon.Extra, err = r.readString()
+		case "supergroup_id":
+			suggestedActionConvertToBroadcastGroup.SupergroupId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (suggestedActionConvertToBroadcastGroup *SuggestedActionConvertToBroadcastGroup) Clone() *SuggestedActionConvertToBroadcastGroup {
+	if suggestedActionConvertToBroadcastGroup == nil {
+		return nil
+	}
+	clone := *suggestedActionConvertToBroadcastGroup
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (suggestedActionConvertToBroadcastGroup *SuggestedActionConvertToBroadcastGroup) Equal(other *SuggestedActionConvertToBroadcastGroup) bool {
+	if suggestedActionConvertToBroadcastGroup == nil || other == nil {
+		return suggestedActionConvertToBroadcastGroup == other
+	}
+	if suggestedActionConvertToBroadcastGroup.SupergroupId != other.SupergroupId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (suggestedActionConvertToBroadcastGroup *SuggestedActionConvertToBroadcastGroup) Diff(other *SuggestedActionConvertToBroadcastGroup) []Change {
+	return suggestedActionConvertToBroadcastGroup.diff("", other, nil)
+}
+
+func (suggestedActionConvertToBroadcastGroup *SuggestedActionConvertToBroadcastGroup) diff(path string, other *SuggestedActionConvertToBroadcastGroup, changes []Change) []Change {
+	if suggestedActionConvertToBroadcastGroup == nil || other == nil {
+		if suggestedActionConvertToBroadcastGroup != other {
+			changes = append(changes, Change{Path: path, Old: suggestedActionConvertToBroadcastGroup, New: other})
+		}
+		return changes
+	}
+	if suggestedActionConvertToBroadcastGroup.SupergroupId != other.SupergroupId {
+		changes = append(changes, Change{Path: fieldPath(path, "supergroup_id"), Old: suggestedActionConvertToBroadcastGroup.SupergroupId, New: other.SupergroupId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (suggestedActionConvertToBroadcastGroup *SuggestedActionConvertToBroadcastGroup) String() string {
+	return objectString(suggestedActionConvertToBroadcastGroup)
+}
+
+// LogValue logs the object as its summary
+func (suggestedActionConvertToBroadcastGroup *SuggestedActionConvertToBroadcastGroup) LogValue() slog.Value {
+	return slog.StringValue(objectString(suggestedActionConvertToBroadcastGroup))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (suggestedActionConvertToBroadcastGroup *SuggestedActionConvertToBroadcastGroup) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, suggestedActionConvertToBroadcastGroup)
+}
+
+func (suggestedActionConvertToBroadcastGroup *SuggestedActionConvertToBroadcastGroup) printText(p *printer) {
+	if suggestedActionConvertToBroadcastGroup == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SuggestedActionConvertToBroadcastGroup")
+	p.int("supergroup_id", int64(suggestedActionConvertToBroadcastGroup.SupergroupId))
+	p.endObject(state)
+}
+
 // GetSuggestedActionEnum return the enum type of this object
 func (suggestedActionConvertToBroadcastGroup *SuggestedActionConvertToBroadcastGroup) GetSuggestedActionEnum() SuggestedActionEnum {
 	return SuggestedActionConvertToBroadcastGroupType
 }
 
+// Accept calls the method of the visitor for SuggestedActionConvertToBroadcastGroup
+func (suggestedActionConvertToBroadcastGroup *SuggestedActionConvertToBroadcastGroup) Accept(visitor SuggestedActionVisitor) {
+	visitor.VisitSuggestedActionConvertToBroadcastGroup(suggestedActionConvertToBroadcastGroup)
+}
+
 // SuggestedActionSetPassword Suggests the user to set a 2-step verification password to be able to log in again
 type SuggestedActionSetPassword struct {
 	tdCommon
@@ -221,7 +784,269 @@ func NewSuggestedActionSetPassword(authorizationDelay int32) *SuggestedActionSet
 	return &suggestedActionSetPasswordTemp
 }
 
+// MarshalJSON marshals to json
+func (suggestedActionSetPassword *SuggestedActionSetPassword) MarshalJSON() ([]byte, error) {
+	return suggestedActionSetPassword.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (suggestedActionSetPassword *SuggestedActionSetPassword) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, suggestedActionSetPassword)
+}
+
+func (suggestedActionSetPassword *SuggestedActionSetPassword) appendJSON(b []byte) ([]byte, error) {
+	if suggestedActionSetPassword == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, suggestedActionSetPassword.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, suggestedActionSetPassword.tdCommon.Extra)
+	b = append(b, `,"authorization_delay":`...)
+	b = appendJSONInt(b, int64(suggestedActionSetPassword.AuthorizationDelay))
+	return append(b, '}'), nil
+}
+
+func (suggestedActionSetPassword *SuggestedActionSetPassword) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			suggestedActionSetPassword.tdCommon.Type, err = r.readString()
+		case "@extra":
+			suggestedActionSetPassword.tdCommon.Extra, err = r.readString()
+		case "authorization_delay":
+			suggestedActionSetPassword.AuthorizationDelay, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (suggestedActionSetPassword *SuggestedActionSetPassword) Clone() *SuggestedActionSetPassword {
+	if suggestedActionSetPassword == nil {
+		return nil
+	}
+	clone := *suggestedActionSetPassword
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (suggestedActionSetPassword *SuggestedActionSetPassword) Equal(other *SuggestedActionSetPassword) bool {
+	if suggestedActionSetPassword == nil || other == nil {
+		return suggestedActionSetPassword == other
+	}
+	if suggestedActionSetPassword.AuthorizationDelay != other.AuthorizationDelay {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (suggestedActionSetPassword *SuggestedActionSetPassword) Diff(other *SuggestedActionSetPassword) []Change {
+	return suggestedActionSetPassword.diff("", other, nil)
+}
+
+func (suggestedActionSetPassword *SuggestedActionSetPassword) diff(path string, other *SuggestedActionSetPassword, changes []Change) []Change {
+	if suggestedActionSetPassword == nil || other == nil {
+		if suggestedActionSetPassword != other {
+			changes = append(changes, Change{Path: path, Old: suggestedActionSetPassword, New: other})
+		}
+		return changes
+	}
+	if suggestedActionSetPassword.AuthorizationDelay != other.AuthorizationDelay {
+		changes = append(changes, Change{Path: fieldPath(path, "authorization_delay"), Old: suggestedActionSetPassword.AuthorizationDelay, New: other.AuthorizationDelay})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (suggestedActionSetPassword *SuggestedActionSetPassword) String() string {
+	return objectString(suggestedActionSetPassword)
+}
+
+// LogValue logs the object as its summary
+func (suggestedActionSetPassword *SuggestedActionSetPassword) LogValue() slog.Value {
+	return slog.StringValue(objectString(suggestedActionSetPassword))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (suggestedActionSetPassword *SuggestedActionSetPassword) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, suggestedActionSetPassword)
+}
+
+func (suggestedActionSetPassword *SuggestedActionSetPassword) printText(p *printer) {
+	if suggestedActionSetPassword == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SuggestedActionSetPassword")
+	p.int("authorization_delay", int64(suggestedActionSetPassword.AuthorizationDelay))
+	p.endObject(state)
+}
+
 // GetSuggestedActionEnum return the enum type of this object
 func (suggestedActionSetPassword *SuggestedActionSetPassword) GetSuggestedActionEnum() SuggestedActionEnum {
 	return SuggestedActionSetPasswordType
 }
+
+// Accept calls the method of the visitor for SuggestedActionSetPassword
+func (suggestedActionSetPassword *SuggestedActionSetPassword) Accept(visitor SuggestedActionVisitor) {
+	visitor.VisitSuggestedActionSetPassword(suggestedActionSetPassword)
+}
+
+// SuggestedActionVisitor visits every type of SuggestedAction, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to SuggestedAction, until they handle it too.
+type SuggestedActionVisitor interface {
+	VisitSuggestedActionEnableArchiveAndMuteNewChats(suggestedActionEnableArchiveAndMuteNewChats *SuggestedActionEnableArchiveAndMuteNewChats)
+	VisitSuggestedActionCheckPassword(suggestedActionCheckPassword *SuggestedActionCheckPassword)
+	VisitSuggestedActionCheckPhoneNumber(suggestedActionCheckPhoneNumber *SuggestedActionCheckPhoneNumber)
+	VisitSuggestedActionViewChecksHint(suggestedActionViewChecksHint *SuggestedActionViewChecksHint)
+	VisitSuggestedActionConvertToBroadcastGroup(suggestedActionConvertToBroadcastGroup *SuggestedActionConvertToBroadcastGroup)
+	VisitSuggestedActionSetPassword(suggestedActionSetPassword *SuggestedActionSetPassword)
+	VisitUnknownSuggestedAction(unknownSuggestedAction *UnknownSuggestedAction)
+}
+
+// SuggestedActionCases holds the function MatchSuggestedAction calls for each type of SuggestedAction;
+// types without a function are passed to the default case
+type SuggestedActionCases[R any] struct {
+	SuggestedActionEnableArchiveAndMuteNewChats func(suggestedActionEnableArchiveAndMuteNewChats *SuggestedActionEnableArchiveAndMuteNewChats) R
+	SuggestedActionCheckPassword                func(suggestedActionCheckPassword *SuggestedActionCheckPassword) R
+	SuggestedActionCheckPhoneNumber             func(suggestedActionCheckPhoneNumber *SuggestedActionCheckPhoneNumber) R
+	SuggestedActionViewChecksHint               func(suggestedActionViewChecksHint *SuggestedActionViewChecksHint) R
+	SuggestedActionConvertToBroadcastGroup      func(suggestedActionConvertToBroadcastGroup *SuggestedActionConvertToBroadcastGroup) R
+	SuggestedActionSetPassword                  func(suggestedActionSetPassword *SuggestedActionSetPassword) R
+}
+
+// MatchSuggestedAction calls the case of the type of suggestedAction, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement SuggestedActionVisitor
+// to have the compiler check every type is handled.
+func MatchSuggestedAction[R any](suggestedAction SuggestedAction, cases SuggestedActionCases[R], defaultCase func(suggestedAction SuggestedAction) R) R {
+	switch value := suggestedAction.(type) {
+	case *SuggestedActionEnableArchiveAndMuteNewChats:
+		if cases.SuggestedActionEnableArchiveAndMuteNewChats != nil {
+			return cases.SuggestedActionEnableArchiveAndMuteNewChats(value)
+		}
+	case *SuggestedActionCheckPassword:
+		if cases.SuggestedActionCheckPassword != nil {
+			return cases.SuggestedActionCheckPassword(value)
+		}
+	case *SuggestedActionCheckPhoneNumber:
+		if cases.SuggestedActionCheckPhoneNumber != nil {
+			return cases.SuggestedActionCheckPhoneNumber(value)
+		}
+	case *SuggestedActionViewChecksHint:
+		if cases.SuggestedActionViewChecksHint != nil {
+			return cases.SuggestedActionViewChecksHint(value)
+		}
+	case *SuggestedActionConvertToBroadcastGroup:
+		if cases.SuggestedActionConvertToBroadcastGroup != nil {
+			return cases.SuggestedActionConvertToBroadcastGroup(value)
+		}
+	case *SuggestedActionSetPassword:
+		if cases.SuggestedActionSetPassword != nil {
+			return cases.SuggestedActionSetPassword(value)
+		}
+	}
+	return defaultCase(suggestedAction)
+}
+
+// Clone returns a deep copy of the object
+func (unknownSuggestedAction *UnknownSuggestedAction) Clone() *UnknownSuggestedAction {
+	if unknownSuggestedAction == nil {
+		return nil
+	}
+	return &UnknownSuggestedAction{Unknown: unknownSuggestedAction.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownSuggestedAction *UnknownSuggestedAction) Equal(other *UnknownSuggestedAction) bool {
+	if unknownSuggestedAction == nil || other == nil {
+		return unknownSuggestedAction == other
+	}
+	return unknownSuggestedAction.Unknown.equal(other.Unknown)
+}
+
+func cloneSuggestedAction(suggestedAction SuggestedAction) SuggestedAction {
+	switch value := suggestedAction.(type) {
+	case *SuggestedActionEnableArchiveAndMuteNewChats:
+		return value.Clone()
+	case *SuggestedActionCheckPassword:
+		return value.Clone()
+	case *SuggestedActionCheckPhoneNumber:
+		return value.Clone()
+	case *SuggestedActionViewChecksHint:
+		return value.Clone()
+	case *SuggestedActionConvertToBroadcastGroup:
+		return value.Clone()
+	case *SuggestedActionSetPassword:
+		return value.Clone()
+	case *UnknownSuggestedAction:
+		return value.Clone()
+	}
+	return suggestedAction
+}
+
+func equalSuggestedAction(a SuggestedAction, b SuggestedAction) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *SuggestedActionEnableArchiveAndMuteNewChats:
+		b, ok := b.(*SuggestedActionEnableArchiveAndMuteNewChats)
+		return ok && a.Equal(b)
+	case *SuggestedActionCheckPassword:
+		b, ok := b.(*SuggestedActionCheckPassword)
+		return ok && a.Equal(b)
+	case *SuggestedActionCheckPhoneNumber:
+		b, ok := b.(*SuggestedActionCheckPhoneNumber)
+		return ok && a.Equal(b)
+	case *SuggestedActionViewChecksHint:
+		b, ok := b.(*SuggestedActionViewChecksHint)
+		return ok && a.Equal(b)
+	case *SuggestedActionConvertToBroadcastGroup:
+		b, ok := b.(*SuggestedActionConvertToBroadcastGroup)
+		return ok && a.Equal(b)
+	case *SuggestedActionSetPassword:
+		b, ok := b.(*SuggestedActionSetPassword)
+		return ok && a.Equal(b)
+	case *UnknownSuggestedAction:
+		b, ok := b.(*UnknownSuggestedAction)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffSuggestedAction(path string, a SuggestedAction, b SuggestedAction, changes []Change) []Change {
+	switch a := a.(type) {
+	case *SuggestedActionEnableArchiveAndMuteNewChats:
+		if b, ok := b.(*SuggestedActionEnableArchiveAndMuteNewChats); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SuggestedActionCheckPassword:
+		if b, ok := b.(*SuggestedActionCheckPassword); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SuggestedActionCheckPhoneNumber:
+		if b, ok := b.(*SuggestedActionCheckPhoneNumber); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SuggestedActionViewChecksHint:
+		if b, ok := b.(*SuggestedActionViewChecksHint); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SuggestedActionConvertToBroadcastGroup:
+		if b, ok := b.(*SuggestedActionConvertToBroadcastGroup); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SuggestedActionSetPassword:
+		if b, ok := b.(*SuggestedActionSetPassword); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalSuggestedAction(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
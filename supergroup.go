@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // Supergroup Represents a supergroup or channel with zero or more members (subscribers in the case of channels). From the point of view of the system, a channel is a special kind of a supergroup: only administrators can post and see the list of members, and posts from all administrators use the name and photo of the channel instead of individual names and profile photos. Unlike supergroups, channels can have an unlimited number of subscribers
@@ -73,57 +73,274 @@ func NewSupergroup(id int64, accessHash JSONInt64, username string, date int32,
 	return &supergroupTemp
 }
 
+// MarshalJSON marshals to json
+func (supergroup *Supergroup) MarshalJSON() ([]byte, error) {
+	return supergroup.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (supergroup *Supergroup) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Id                int64     `json:"id"`                   // Supergroup or channel identifier
-		AccessHash        JSONInt64 `json:"access_hash"`          // Supergroup or channel access hash
-		Username          string    `json:"username"`             // Username of the supergroup or channel; empty for private supergroups or channels
-		Date              int32     `json:"date"`                 // Point in time (Unix timestamp) when the current user joined, or the point in time when the supergroup or channel was created, in case the user is not a member
-		MemberCount       int32     `json:"member_count"`         // Number of members in the supergroup or channel; 0 if unknown. Currently, it is guaranteed to be known only if the supergroup or channel was received through searchPublicChats, searchChatsNearby, getInactiveSupergroupChats, getSuitableDiscussionChats, getGroupsInCommon, or getUserPrivacySettingRules
-		HasLinkedChat     bool      `json:"has_linked_chat"`      // True, if the channel has a discussion group, or the supergroup is the designated discussion group for a channel
-		HasLocation       bool      `json:"has_location"`         // True, if the supergroup is connected to a location, i.e. the supergroup is a location-based supergroup
-		SignMessages      bool      `json:"sign_messages"`        // True, if messages sent to the channel need to contain information about the sender. This field is only applicable to channels
-		IsSlowModeEnabled bool      `json:"is_slow_mode_enabled"` // True, if the slow mode is enabled in the supergroup
-		IsChannel         bool      `json:"is_channel"`           // True, if the supergroup is a channel
-		IsBroadcastGroup  bool      `json:"is_broadcast_group"`   // True, if the supergroup is a broadcast group, i.e. only administrators can send messages and there is no limit on the number of members
-		IsVerified        bool      `json:"is_verified"`          // True, if the supergroup or channel is verified
-		RestrictionReason string    `json:"restriction_reason"`   // If non-empty, contains a human-readable description of the reason why access to this supergroup or channel must be restricted
-		IsScam            bool      `json:"is_scam"`              // True, if many users reported this supergroup or channel as a scam
-		IsFake            bool      `json:"is_fake"`              // True, if many users reported this supergroup or channel as a fake account
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	return unmarshalFast(b, supergroup)
+}
+
+func (supergroup *Supergroup) appendJSON(b []byte) ([]byte, error) {
+	if supergroup == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, supergroup.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, supergroup.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt(b, int64(supergroup.Id))
+	b = append(b, `,"access_hash":`...)
+	b = appendJSONInt64String(b, int64(supergroup.AccessHash))
+	b = append(b, `,"username":`...)
+	b = appendJSONString(b, supergroup.Username)
+	b = append(b, `,"date":`...)
+	b = appendJSONInt(b, int64(supergroup.Date))
+	b = append(b, `,"status":`...)
+	b, err = appendJSONValue(b, supergroup.Status)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"member_count":`...)
+	b = appendJSONInt(b, int64(supergroup.MemberCount))
+	b = append(b, `,"has_linked_chat":`...)
+	b = appendJSONBool(b, supergroup.HasLinkedChat)
+	b = append(b, `,"has_location":`...)
+	b = appendJSONBool(b, supergroup.HasLocation)
+	b = append(b, `,"sign_messages":`...)
+	b = appendJSONBool(b, supergroup.SignMessages)
+	b = append(b, `,"is_slow_mode_enabled":`...)
+	b = appendJSONBool(b, supergroup.IsSlowModeEnabled)
+	b = append(b, `,"is_channel":`...)
+	b = appendJSONBool(b, supergroup.IsChannel)
+	b = append(b, `,"is_broadcast_group":`...)
+	b = appendJSONBool(b, supergroup.IsBroadcastGroup)
+	b = append(b, `,"is_verified":`...)
+	b = appendJSONBool(b, supergroup.IsVerified)
+	b = append(b, `,"restriction_reason":`...)
+	b = appendJSONString(b, supergroup.RestrictionReason)
+	b = append(b, `,"is_scam":`...)
+	b = appendJSONBool(b, supergroup.IsScam)
+	b = append(b, `,"is_fake":`...)
+	b = appendJSONBool(b, supergroup.IsFake)
+	return append(b, '}'), nil
+}
+
+func (supergroup *Supergroup) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			supergroup.tdCommon.Type, err = r.readString()
+		case "@extra":
+			supergroup.tdCommon.Extra, err = r.readString()
+		case "id":
+			supergroup.Id, err = r.readInt64()
+		case "access_hash":
+			supergroup.AccessHash, err = r.readJSONInt64()
+		case "username":
+			supergroup.Username, err = r.readString()
+		case "date":
+			supergroup.Date, err = r.readInt32()
+		case "status":
+			supergroup.Status, err = decodeChatMemberStatus(r)
+		case "member_count":
+			supergroup.MemberCount, err = r.readInt32()
+		case "has_linked_chat":
+			supergroup.HasLinkedChat, err = r.readBool()
+		case "has_location":
+			supergroup.HasLocation, err = r.readBool()
+		case "sign_messages":
+			supergroup.SignMessages, err = r.readBool()
+		case "is_slow_mode_enabled":
+			supergroup.IsSlowModeEnabled, err = r.readBool()
+		case "is_channel":
+			supergroup.IsChannel, err = r.readBool()
+		case "is_broadcast_group":
+			supergroup.IsBroadcastGroup, err = r.readBool()
+		case "is_verified":
+			supergroup.IsVerified, err = r.readBool()
+		case "restriction_reason":
+			supergroup.RestrictionReason, err = r.readString()
+		case "is_scam":
+			supergroup.IsScam, err = r.readBool()
+		case "is_fake":
+			supergroup.IsFake, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (supergroup *Supergroup) Clone() *Supergroup {
+	if supergroup == nil {
+		return nil
+	}
+	clone := *supergroup
+	clone.Status = cloneChatMemberStatus(supergroup.Status)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (supergroup *Supergroup) Equal(other *Supergroup) bool {
+	if supergroup == nil || other == nil {
+		return supergroup == other
+	}
+	if supergroup.Id != other.Id {
+		return false
+	}
+	if supergroup.AccessHash != other.AccessHash {
+		return false
+	}
+	if supergroup.Username != other.Username {
+		return false
+	}
+	if supergroup.Date != other.Date {
+		return false
+	}
+	if !equalChatMemberStatus(supergroup.Status, other.Status) {
+		return false
+	}
+	if supergroup.MemberCount != other.MemberCount {
+		return false
+	}
+	if supergroup.HasLinkedChat != other.HasLinkedChat {
+		return false
+	}
+	if supergroup.HasLocation != other.HasLocation {
+		return false
+	}
+	if supergroup.SignMessages != other.SignMessages {
+		return false
+	}
+	if supergroup.IsSlowModeEnabled != other.IsSlowModeEnabled {
+		return false
+	}
+	if supergroup.IsChannel != other.IsChannel {
+		return false
+	}
+	if supergroup.IsBroadcastGroup != other.IsBroadcastGroup {
+		return false
+	}
+	if supergroup.IsVerified != other.IsVerified {
+		return false
 	}
+	if supergroup.RestrictionReason != other.RestrictionReason {
+		return false
+	}
+	if supergroup.IsScam != other.IsScam {
+		return false
+	}
+	if supergroup.IsFake != other.IsFake {
+		return false
+	}
+	return true
+}
 
-	supergroup.tdCommon = tempObj.tdCommon
-	supergroup.Id = tempObj.Id
-	supergroup.AccessHash = tempObj.AccessHash
-	supergroup.Username = tempObj.Username
-	supergroup.Date = tempObj.Date
-	supergroup.MemberCount = tempObj.MemberCount
-	supergroup.HasLinkedChat = tempObj.HasLinkedChat
-	supergroup.HasLocation = tempObj.HasLocation
-	supergroup.SignMessages = tempObj.SignMessages
-	supergroup.IsSlowModeEnabled = tempObj.IsSlowModeEnabled
-	supergroup.IsChannel = tempObj.IsChannel
-	supergroup.IsBroadcastGroup = tempObj.IsBroadcastGroup
-	supergroup.IsVerified = tempObj.IsVerified
-	supergroup.RestrictionReason = tempObj.RestrictionReason
-	supergroup.IsScam = tempObj.IsScam
-	supergroup.IsFake = tempObj.IsFake
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (supergroup *Supergroup) Diff(other *Supergroup) []Change {
+	return supergroup.diff("", other, nil)
+}
 
-	fieldStatus, _ := unmarshalChatMemberStatus(objMap["status"])
-	supergroup.Status = fieldStatus
+func (supergroup *Supergroup) diff(path string, other *Supergroup, changes []Change) []Change {
+	if supergroup == nil || other == nil {
+		if supergroup != other {
+			changes = append(changes, Change{Path: path, Old: supergroup, New: other})
+		}
+		return changes
+	}
+	if supergroup.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: supergroup.Id, New: other.Id})
+	}
+	if supergroup.AccessHash != other.AccessHash {
+		changes = append(changes, Change{Path: fieldPath(path, "access_hash"), Old: supergroup.AccessHash, New: other.AccessHash})
+	}
+	if supergroup.Username != other.Username {
+		changes = append(changes, Change{Path: fieldPath(path, "username"), Old: supergroup.Username, New: other.Username})
+	}
+	if supergroup.Date != other.Date {
+		changes = append(changes, Change{Path: fieldPath(path, "date"), Old: supergroup.Date, New: other.Date})
+	}
+	changes = diffChatMemberStatus(fieldPath(path, "status"), supergroup.Status, other.Status, changes)
+	if supergroup.MemberCount != other.MemberCount {
+		changes = append(changes, Change{Path: fieldPath(path, "member_count"), Old: supergroup.MemberCount, New: other.MemberCount})
+	}
+	if supergroup.HasLinkedChat != other.HasLinkedChat {
+		changes = append(changes, Change{Path: fieldPath(path, "has_linked_chat"), Old: supergroup.HasLinkedChat, New: other.HasLinkedChat})
+	}
+	if supergroup.HasLocation != other.HasLocation {
+		changes = append(changes, Change{Path: fieldPath(path, "has_location"), Old: supergroup.HasLocation, New: other.HasLocation})
+	}
+	if supergroup.SignMessages != other.SignMessages {
+		changes = append(changes, Change{Path: fieldPath(path, "sign_messages"), Old: supergroup.SignMessages, New: other.SignMessages})
+	}
+	if supergroup.IsSlowModeEnabled != other.IsSlowModeEnabled {
+		changes = append(changes, Change{Path: fieldPath(path, "is_slow_mode_enabled"), Old: supergroup.IsSlowModeEnabled, New: other.IsSlowModeEnabled})
+	}
+	if supergroup.IsChannel != other.IsChannel {
+		changes = append(changes, Change{Path: fieldPath(path, "is_channel"), Old: supergroup.IsChannel, New: other.IsChannel})
+	}
+	if supergroup.IsBroadcastGroup != other.IsBroadcastGroup {
+		changes = append(changes, Change{Path: fieldPath(path, "is_broadcast_group"), Old: supergroup.IsBroadcastGroup, New: other.IsBroadcastGroup})
+	}
+	if supergroup.IsVerified != other.IsVerified {
+		changes = append(changes, Change{Path: fieldPath(path, "is_verified"), Old: supergroup.IsVerified, New: other.IsVerified})
+	}
+	if supergroup.RestrictionReason != other.RestrictionReason {
+		changes = append(changes, Change{Path: fieldPath(path, "restriction_reason"), Old: supergroup.RestrictionReason, New: other.RestrictionReason})
+	}
+	if supergroup.IsScam != other.IsScam {
+		changes = append(changes, Change{Path: fieldPath(path, "is_scam"), Old: supergroup.IsScam, New: other.IsScam})
+	}
+	if supergroup.IsFake != other.IsFake {
+		changes = append(changes, Change{Path: fieldPath(path, "is_fake"), Old: supergroup.IsFake, New: other.IsFake})
+	}
+	return changes
+}
 
-	return nil
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (supergroup *Supergroup) String() string {
+	return objectString(supergroup)
+}
+
+// LogValue logs the object as its summary
+func (supergroup *Supergroup) LogValue() slog.Value {
+	return slog.StringValue(objectString(supergroup))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (supergroup *Supergroup) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, supergroup)
+}
+
+func (supergroup *Supergroup) printText(p *printer) {
+	if supergroup == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Supergroup")
+	p.int("id", int64(supergroup.Id))
+	p.int("access_hash", int64(supergroup.AccessHash))
+	p.string("username", supergroup.Username)
+	p.int("date", int64(supergroup.Date))
+	p.object("status", supergroup.Status)
+	p.int("member_count", int64(supergroup.MemberCount))
+	p.bool("has_linked_chat", supergroup.HasLinkedChat)
+	p.bool("has_location", supergroup.HasLocation)
+	p.bool("sign_messages", supergroup.SignMessages)
+	p.bool("is_slow_mode_enabled", supergroup.IsSlowModeEnabled)
+	p.bool("is_channel", supergroup.IsChannel)
+	p.bool("is_broadcast_group", supergroup.IsBroadcastGroup)
+	p.bool("is_verified", supergroup.IsVerified)
+	p.string("restriction_reason", supergroup.RestrictionReason)
+	p.bool("is_scam", supergroup.IsScam)
+	p.bool("is_fake", supergroup.IsFake)
+	p.endObject(state)
 }
 
 // GetSupergroup Returns information about a supergroup or a channel by its identifier. This is an offline request if the current user is not a bot
@@ -138,11 +355,11 @@ func (client *Client) GetSupergroup(supergroupId int64) (*Supergroup, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var supergroupDummy Supergroup
-	err = json.Unmarshal(result.Raw, &supergroupDummy)
+	err = jsonUnmarshal(result.Raw, &supergroupDummy)
 	return &supergroupDummy, err
 }
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // SupergroupFullInfo Contains full information about a supergroup or channel
@@ -88,6 +88,394 @@ func NewSupergroupFullInfo(photo *ChatPhoto, description string, memberCount int
 	return &supergroupFullInfoTemp
 }
 
+// MarshalJSON marshals to json
+func (supergroupFullInfo *SupergroupFullInfo) MarshalJSON() ([]byte, error) {
+	return supergroupFullInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (supergroupFullInfo *SupergroupFullInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, supergroupFullInfo)
+}
+
+func (supergroupFullInfo *SupergroupFullInfo) appendJSON(b []byte) ([]byte, error) {
+	if supergroupFullInfo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, supergroupFullInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, supergroupFullInfo.tdCommon.Extra)
+	b = append(b, `,"photo":`...)
+	b, err = supergroupFullInfo.Photo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"description":`...)
+	b = appendJSONString(b, supergroupFullInfo.Description)
+	b = append(b, `,"member_count":`...)
+	b = appendJSONInt(b, int64(supergroupFullInfo.MemberCount))
+	b = append(b, `,"administrator_count":`...)
+	b = appendJSONInt(b, int64(supergroupFullInfo.AdministratorCount))
+	b = append(b, `,"restricted_count":`...)
+	b = appendJSONInt(b, int64(supergroupFullInfo.RestrictedCount))
+	b = append(b, `,"banned_count":`...)
+	b = appendJSONInt(b, int64(supergroupFullInfo.BannedCount))
+	b = append(b, `,"linked_chat_id":`...)
+	b = appendJSONInt(b, int64(supergroupFullInfo.LinkedChatId))
+	b = append(b, `,"slow_mode_delay":`...)
+	b = appendJSONInt(b, int64(supergroupFullInfo.SlowModeDelay))
+	b = append(b, `,"slow_mode_delay_expires_in":`...)
+	b, err = appendJSONFloat(b, supergroupFullInfo.SlowModeDelayExpiresIn)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"can_get_members":`...)
+	b = appendJSONBool(b, supergroupFullInfo.CanGetMembers)
+	b = append(b, `,"can_set_username":`...)
+	b = appendJSONBool(b, supergroupFullInfo.CanSetUsername)
+	b = append(b, `,"can_set_sticker_set":`...)
+	b = appendJSONBool(b, supergroupFullInfo.CanSetStickerSet)
+	b = append(b, `,"can_set_location":`...)
+	b = appendJSONBool(b, supergroupFullInfo.CanSetLocation)
+	b = append(b, `,"can_get_statistics":`...)
+	b = appendJSONBool(b, supergroupFullInfo.CanGetStatistics)
+	b = append(b, `,"is_all_history_available":`...)
+	b = appendJSONBool(b, supergroupFullInfo.IsAllHistoryAvailable)
+	b = append(b, `,"sticker_set_id":`...)
+	b = appendJSONInt64String(b, int64(supergroupFullInfo.StickerSetId))
+	b = append(b, `,"location":`...)
+	b, err = supergroupFullInfo.Location.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"invite_link":`...)
+	b, err = supergroupFullInfo.InviteLink.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"bot_commands":`...)
+	if supergroupFullInfo.BotCommands == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range supergroupFullInfo.BotCommands {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = supergroupFullInfo.BotCommands[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"upgraded_from_basic_group_id":`...)
+	b = appendJSONInt(b, int64(supergroupFullInfo.UpgradedFromBasicGroupId))
+	b = append(b, `,"upgraded_from_max_message_id":`...)
+	b = appendJSONInt(b, int64(supergroupFullInfo.UpgradedFromMaxMessageId))
+	return append(b, '}'), nil
+}
+
+func (supergroupFullInfo *SupergroupFullInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			supergroupFullInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			supergroupFullInfo.tdCommon.Extra, err = r.readString()
+		case "photo":
+			if r.readNull() {
+				supergroupFullInfo.Photo = nil
+			} else {
+				supergroupFullInfo.Photo = new(ChatPhoto)
+				err = supergroupFullInfo.Photo.decodeJSON(r)
+			}
+		case "description":
+			supergroupFullInfo.Description, err = r.readString()
+		case "member_count":
+			supergroupFullInfo.MemberCount, err = r.readInt32()
+		case "administrator_count":
+			supergroupFullInfo.AdministratorCount, err = r.readInt32()
+		case "restricted_count":
+			supergroupFullInfo.RestrictedCount, err = r.readInt32()
+		case "banned_count":
+			supergroupFullInfo.BannedCount, err = r.readInt32()
+		case "linked_chat_id":
+			supergroupFullInfo.LinkedChatId, err = r.readInt64()
+		case "slow_mode_delay":
+			supergroupFullInfo.SlowModeDelay, err = r.readInt32()
+		case "slow_mode_delay_expires_in":
+			supergroupFullInfo.SlowModeDelayExpiresIn, err = r.readFloat64()
+		case "can_get_members":
+			supergroupFullInfo.CanGetMembers, err = r.readBool()
+		case "can_set_username":
+			supergroupFullInfo.CanSetUsername, err = r.readBool()
+		case "can_set_sticker_set":
+			supergroupFullInfo.CanSetStickerSet, err = r.readBool()
+		case "can_set_location":
+			supergroupFullInfo.CanSetLocation, err = r.readBool()
+		case "can_get_statistics":
+			supergroupFullInfo.CanGetStatistics, err = r.readBool()
+		case "is_all_history_available":
+			supergroupFullInfo.IsAllHistoryAvailable, err = r.readBool()
+		case "sticker_set_id":
+			supergroupFullInfo.StickerSetId, err = r.readJSONInt64()
+		case "location":
+			if r.readNull() {
+				supergroupFullInfo.Location = nil
+			} else {
+				supergroupFullInfo.Location = new(ChatLocation)
+				err = supergroupFullInfo.Location.decodeJSON(r)
+			}
+		case "invite_link":
+			if r.readNull() {
+				supergroupFullInfo.InviteLink = nil
+			} else {
+				supergroupFullInfo.InviteLink = new(ChatInviteLink)
+				err = supergroupFullInfo.InviteLink.decodeJSON(r)
+			}
+		case "bot_commands":
+			if r.readNull() {
+				supergroupFullInfo.BotCommands = nil
+			} else {
+				supergroupFullInfo.BotCommands = make([]BotCommands, 0)
+				err = r.readArray(func() (err error) {
+					var item0 BotCommands
+					err = item0.decodeJSON(r)
+					supergroupFullInfo.BotCommands = append(supergroupFullInfo.BotCommands, item0)
+					return
+				})
+			}
+		case "upgraded_from_basic_group_id":
+			supergroupFullInfo.UpgradedFromBasicGroupId, err = r.readInt64()
+		case "upgraded_from_max_message_id":
+			supergroupFullInfo.UpgradedFromMaxMessageId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (supergroupFullInfo *SupergroupFullInfo) Clone() *SupergroupFullInfo {
+	if supergroupFullInfo == nil {
+		return nil
+	}
+	clone := *supergroupFullInfo
+	clone.Photo = supergroupFullInfo.Photo.Clone()
+	clone.Location = supergroupFullInfo.Location.Clone()
+	clone.InviteLink = supergroupFullInfo.InviteLink.Clone()
+	if supergroupFullInfo.BotCommands != nil {
+		clone.BotCommands = make([]BotCommands, len(supergroupFullInfo.BotCommands))
+		for i0 := range supergroupFullInfo.BotCommands {
+			clone.BotCommands[i0] = *supergroupFullInfo.BotCommands[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (supergroupFullInfo *SupergroupFullInfo) Equal(other *SupergroupFullInfo) bool {
+	if supergroupFullInfo == nil || other == nil {
+		return supergroupFullInfo == other
+	}
+	if !supergroupFullInfo.Photo.Equal(other.Photo) {
+		return false
+	}
+	if supergroupFullInfo.Description != other.Description {
+		return false
+	}
+	if supergroupFullInfo.MemberCount != other.MemberCount {
+		return false
+	}
+	if supergroupFullInfo.AdministratorCount != other.AdministratorCount {
+		return false
+	}
+	if supergroupFullInfo.RestrictedCount != other.RestrictedCount {
+		return false
+	}
+	if supergroupFullInfo.BannedCount != other.BannedCount {
+		return false
+	}
+	if supergroupFullInfo.LinkedChatId != other.LinkedChatId {
+		return false
+	}
+	if supergroupFullInfo.SlowModeDelay != other.SlowModeDelay {
+		return false
+	}
+	if supergroupFullInfo.SlowModeDelayExpiresIn != other.SlowModeDelayExpiresIn {
+		return false
+	}
+	if supergroupFullInfo.CanGetMembers != other.CanGetMembers {
+		return false
+	}
+	if supergroupFullInfo.CanSetUsername != other.CanSetUsername {
+		return false
+	}
+	if supergroupFullInfo.CanSetStickerSet != other.CanSetStickerSet {
+		return false
+	}
+	if supergroupFullInfo.CanSetLocation != other.CanSetLocation {
+		return false
+	}
+	if supergroupFullInfo.CanGetStatistics != other.CanGetStatistics {
+		return false
+	}
+	if supergroupFullInfo.IsAllHistoryAvailable != other.IsAllHistoryAvailable {
+		return false
+	}
+	if supergroupFullInfo.StickerSetId != other.StickerSetId {
+		return false
+	}
+	if !supergroupFullInfo.Location.Equal(other.Location) {
+		return false
+	}
+	if !supergroupFullInfo.InviteLink.Equal(other.InviteLink) {
+		return false
+	}
+	if len(supergroupFullInfo.BotCommands) != len(other.BotCommands) {
+		return false
+	}
+	for i0 := range supergroupFullInfo.BotCommands {
+		if !supergroupFullInfo.BotCommands[i0].Equal(&other.BotCommands[i0]) {
+			return false
+		}
+	}
+	if supergroupFullInfo.UpgradedFromBasicGroupId != other.UpgradedFromBasicGroupId {
+		return false
+	}
+	if supergroupFullInfo.UpgradedFromMaxMessageId != other.UpgradedFromMaxMessageId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (supergroupFullInfo *SupergroupFullInfo) Diff(other *SupergroupFullInfo) []Change {
+	return supergroupFullInfo.diff("", other, nil)
+}
+
+func (supergroupFullInfo *SupergroupFullInfo) diff(path string, other *SupergroupFullInfo, changes []Change) []Change {
+	if supergroupFullInfo == nil || other == nil {
+		if supergroupFullInfo != other {
+			changes = append(changes, Change{Path: path, Old: supergroupFullInfo, New: other})
+		}
+		return changes
+	}
+	changes = supergroupFullInfo.Photo.diff(fieldPath(path, "photo"), other.Photo, changes)
+	if supergroupFullInfo.Description != other.Description {
+		changes = append(changes, Change{Path: fieldPath(path, "description"), Old: supergroupFullInfo.Description, New: other.Description})
+	}
+	if supergroupFullInfo.MemberCount != other.MemberCount {
+		changes = append(changes, Change{Path: fieldPath(path, "member_count"), Old: supergroupFullInfo.MemberCount, New: other.MemberCount})
+	}
+	if supergroupFullInfo.AdministratorCount != other.AdministratorCount {
+		changes = append(changes, Change{Path: fieldPath(path, "administrator_count"), Old: supergroupFullInfo.AdministratorCount, New: other.AdministratorCount})
+	}
+	if supergroupFullInfo.RestrictedCount != other.RestrictedCount {
+		changes = append(changes, Change{Path: fieldPath(path, "restricted_count"), Old: supergroupFullInfo.RestrictedCount, New: other.RestrictedCount})
+	}
+	if supergroupFullInfo.BannedCount != other.BannedCount {
+		changes = append(changes, Change{Path: fieldPath(path, "banned_count"), Old: supergroupFullInfo.BannedCount, New: other.BannedCount})
+	}
+	if supergroupFullInfo.LinkedChatId != other.LinkedChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "linked_chat_id"), Old: supergroupFullInfo.LinkedChatId, New: other.LinkedChatId})
+	}
+	if supergroupFullInfo.SlowModeDelay != other.SlowModeDelay {
+		changes = append(changes, Change{Path: fieldPath(path, "slow_mode_delay"), Old: supergroupFullInfo.SlowModeDelay, New: other.SlowModeDelay})
+	}
+	if supergroupFullInfo.SlowModeDelayExpiresIn != other.SlowModeDelayExpiresIn {
+		changes = append(changes, Change{Path: fieldPath(path, "slow_mode_delay_expires_in"), Old: supergroupFullInfo.SlowModeDelayExpiresIn, New: other.SlowModeDelayExpiresIn})
+	}
+	if supergroupFullInfo.CanGetMembers != other.CanGetMembers {
+		changes = append(changes, Change{Path: fieldPath(path, "can_get_members"), Old: supergroupFullInfo.CanGetMembers, New: other.CanGetMembers})
+	}
+	if supergroupFullInfo.CanSetUsername != other.CanSetUsername {
+		changes = append(changes, Change{Path: fieldPath(path, "can_set_username"), Old: supergroupFullInfo.CanSetUsername, New: other.CanSetUsername})
+	}
+	if supergroupFullInfo.CanSetStickerSet != other.CanSetStickerSet {
+		changes = append(changes, Change{Path: fieldPath(path, "can_set_sticker_set"), Old: supergroupFullInfo.CanSetStickerSet, New: other.CanSetStickerSet})
+	}
+	if supergroupFullInfo.CanSetLocation != other.CanSetLocation {
+		changes = append(changes, Change{Path: fieldPath(path, "can_set_location"), Old: supergroupFullInfo.CanSetLocation, New: other.CanSetLocation})
+	}
+	if supergroupFullInfo.CanGetStatistics != other.CanGetStatistics {
+		changes = append(changes, Change{Path: fieldPath(path, "can_get_statistics"), Old: supergroupFullInfo.CanGetStatistics, New: other.CanGetStatistics})
+	}
+	if supergroupFullInfo.IsAllHistoryAvailable != other.IsAllHistoryAvailable {
+		changes = append(changes, Change{Path: fieldPath(path, "is_all_history_available"), Old: supergroupFullInfo.IsAllHistoryAvailable, New: other.IsAllHistoryAvailable})
+	}
+	if supergroupFullInfo.StickerSetId != other.StickerSetId {
+		changes = append(changes, Change{Path: fieldPath(path, "sticker_set_id"), Old: supergroupFullInfo.StickerSetId, New: other.StickerSetId})
+	}
+	changes = supergroupFullInfo.Location.diff(fieldPath(path, "location"), other.Location, changes)
+	changes = supergroupFullInfo.InviteLink.diff(fieldPath(path, "invite_link"), other.InviteLink, changes)
+	if len(supergroupFullInfo.BotCommands) != len(other.BotCommands) {
+		changes = append(changes, Change{Path: fieldPath(path, "bot_commands"), Old: supergroupFullInfo.BotCommands, New: other.BotCommands})
+	} else {
+		for i0 := range supergroupFullInfo.BotCommands {
+			changes = supergroupFullInfo.BotCommands[i0].diff(indexPath(fieldPath(path, "bot_commands"), i0), &other.BotCommands[i0], changes)
+		}
+	}
+	if supergroupFullInfo.UpgradedFromBasicGroupId != other.UpgradedFromBasicGroupId {
+		changes = append(changes, Change{Path: fieldPath(path, "upgraded_from_basic_group_id"), Old: supergroupFullInfo.UpgradedFromBasicGroupId, New: other.UpgradedFromBasicGroupId})
+	}
+	if supergroupFullInfo.UpgradedFromMaxMessageId != other.UpgradedFromMaxMessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "upgraded_from_max_message_id"), Old: supergroupFullInfo.UpgradedFromMaxMessageId, New: other.UpgradedFromMaxMessageId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (supergroupFullInfo *SupergroupFullInfo) String() string {
+	return objectString(supergroupFullInfo)
+}
+
+// LogValue logs the object as its summary
+func (supergroupFullInfo *SupergroupFullInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(supergroupFullInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (supergroupFullInfo *SupergroupFullInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, supergroupFullInfo)
+}
+
+func (supergroupFullInfo *SupergroupFullInfo) printText(p *printer) {
+	if supergroupFullInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SupergroupFullInfo")
+	p.object("photo", supergroupFullInfo.Photo)
+	p.string("description", supergroupFullInfo.Description)
+	p.int("member_count", int64(supergroupFullInfo.MemberCount))
+	p.int("administrator_count", int64(supergroupFullInfo.AdministratorCount))
+	p.int("restricted_count", int64(supergroupFullInfo.RestrictedCount))
+	p.int("banned_count", int64(supergroupFullInfo.BannedCount))
+	p.int("linked_chat_id", int64(supergroupFullInfo.LinkedChatId))
+	p.int("slow_mode_delay", int64(supergroupFullInfo.SlowModeDelay))
+	p.float("slow_mode_delay_expires_in", supergroupFullInfo.SlowModeDelayExpiresIn)
+	p.bool("can_get_members", supergroupFullInfo.CanGetMembers)
+	p.bool("can_set_username", supergroupFullInfo.CanSetUsername)
+	p.bool("can_set_sticker_set", supergroupFullInfo.CanSetStickerSet)
+	p.bool("can_set_location", supergroupFullInfo.CanSetLocation)
+	p.bool("can_get_statistics", supergroupFullInfo.CanGetStatistics)
+	p.bool("is_all_history_available", supergroupFullInfo.IsAllHistoryAvailable)
+	p.int("sticker_set_id", int64(supergroupFullInfo.StickerSetId))
+	p.object("location", supergroupFullInfo.Location)
+	p.object("invite_link", supergroupFullInfo.InviteLink)
+	p.vector("bot_commands", len(supergroupFullInfo.BotCommands), func(i0 int) {
+		supergroupFullInfo.BotCommands[i0].printText(p)
+	})
+	p.int("upgraded_from_basic_group_id", int64(supergroupFullInfo.UpgradedFromBasicGroupId))
+	p.int("upgraded_from_max_message_id", int64(supergroupFullInfo.UpgradedFromMaxMessageId))
+	p.endObject(state)
+}
+
 // GetSupergroupFullInfo Returns full information about a supergroup or a channel by its identifier, cached for up to 1 minute
 // @param supergroupId Supergroup or channel identifier
 func (client *Client) GetSupergroupFullInfo(supergroupId int64) (*SupergroupFullInfo, error) {
@@ -100,11 +488,11 @@ func (client *Client) GetSupergroupFullInfo(supergroupId int64) (*SupergroupFull
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var supergroupFullInfo SupergroupFullInfo
-	err = json.Unmarshal(result.Raw, &supergroupFullInfo)
+	err = jsonUnmarshal(result.Raw, &supergroupFullInfo)
 	return &supergroupFullInfo, err
 }
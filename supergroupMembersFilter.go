@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // SupergroupMembersFilter Specifies the kind of chat members to return in getSupergroupMembers
 type SupergroupMembersFilter interface {
 	GetSupergroupMembersFilterEnum() SupergroupMembersFilterEnum
+	Accept(visitor SupergroupMembersFilterVisitor)
 }
 
 // SupergroupMembersFilterEnum Alias for abstract SupergroupMembersFilter 'Sub-Classes', used as constant-enum here
@@ -25,60 +27,103 @@ const (
 	SupergroupMembersFilterBotsType           SupergroupMembersFilterEnum = "supergroupMembersFilterBots"
 )
 
+// UnknownSupergroupMembersFilter is a SupergroupMembersFilter of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownSupergroupMembersFilter struct {
+	Unknown
+}
+
+// GetSupergroupMembersFilterEnum return the enum type of this object
+func (unknownSupergroupMembersFilter *UnknownSupergroupMembersFilter) GetSupergroupMembersFilterEnum() SupergroupMembersFilterEnum {
+	return SupergroupMembersFilterEnum(unknownSupergroupMembersFilter.Type)
+}
+
+// Accept calls the method of the visitor for UnknownSupergroupMembersFilter
+func (unknownSupergroupMembersFilter *UnknownSupergroupMembersFilter) Accept(visitor SupergroupMembersFilterVisitor) {
+	visitor.VisitUnknownSupergroupMembersFilter(unknownSupergroupMembersFilter)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownSupergroupMembersFilter *UnknownSupergroupMembersFilter) String() string {
+	return objectString(unknownSupergroupMembersFilter)
+}
+
+// LogValue logs the object as its summary
+func (unknownSupergroupMembersFilter *UnknownSupergroupMembersFilter) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownSupergroupMembersFilter))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownSupergroupMembersFilter *UnknownSupergroupMembersFilter) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownSupergroupMembersFilter)
+}
+
+func (unknownSupergroupMembersFilter *UnknownSupergroupMembersFilter) printText(p *printer) {
+	if unknownSupergroupMembersFilter == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownSupergroupMembersFilter")
+	p.string("@type", unknownSupergroupMembersFilter.Type)
+	p.endObject(state)
+}
+
 func unmarshalSupergroupMembersFilter(rawMsg *json.RawMessage) (SupergroupMembersFilter, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch SupergroupMembersFilterEnum(objMap["@type"].(string)) {
+	switch SupergroupMembersFilterEnum(typeName) {
 	case SupergroupMembersFilterRecentType:
 		var supergroupMembersFilterRecent SupergroupMembersFilterRecent
-		err := json.Unmarshal(*rawMsg, &supergroupMembersFilterRecent)
+		err := jsonUnmarshal(*rawMsg, &supergroupMembersFilterRecent)
 		return &supergroupMembersFilterRecent, err
 
 	case SupergroupMembersFilterContactsType:
 		var supergroupMembersFilterContacts SupergroupMembersFilterContacts
-		err := json.Unmarshal(*rawMsg, &supergroupMembersFilterContacts)
+		err := jsonUnmarshal(*rawMsg, &supergroupMembersFilterContacts)
 		return &supergroupMembersFilterContacts, err
 
 	case SupergroupMembersFilterAdministratorsType:
 		var supergroupMembersFilterAdministrators SupergroupMembersFilterAdministrators
-		err := json.Unmarshal(*rawMsg, &supergroupMembersFilterAdministrators)
+		err := jsonUnmarshal(*rawMsg, &supergroupMembersFilterAdministrators)
 		return &supergroupMembersFilterAdministrators, err
 
 	case SupergroupMembersFilterSearchType:
 		var supergroupMembersFilterSearch SupergroupMembersFilterSearch
-		err := json.Unmarshal(*rawMsg, &supergroupMembersFilterSearch)
+		err := jsonUnmarshal(*rawMsg, &supergroupMembersFilterSearch)
 		return &supergroupMembersFilterSearch, err
 
 	case SupergroupMembersFilterRestrictedType:
 		var supergroupMembersFilterRestricted SupergroupMembersFilterRestricted
-		err := json.Unmarshal(*rawMsg, &supergroupMembersFilterRestricted)
+		err := jsonUnmarshal(*rawMsg, &supergroupMembersFilterRestricted)
 		return &supergroupMembersFilterRestricted, err
 
 	case SupergroupMembersFilterBannedType:
 		var supergroupMembersFilterBanned SupergroupMembersFilterBanned
-		err := json.Unmarshal(*rawMsg, &supergroupMembersFilterBanned)
+		err := jsonUnmarshal(*rawMsg, &supergroupMembersFilterBanned)
 		return &supergroupMembersFilterBanned, err
 
 	case SupergroupMembersFilterMentionType:
 		var supergroupMembersFilterMention SupergroupMembersFilterMention
-		err := json.Unmarshal(*rawMsg, &supergroupMembersFilterMention)
+		err := jsonUnmarshal(*rawMsg, &supergroupMembersFilterMention)
 		return &supergroupMembersFilterMention, err
 
 	case SupergroupMembersFilterBotsType:
 		var supergroupMembersFilterBots SupergroupMembersFilterBots
-		err := json.Unmarshal(*rawMsg, &supergroupMembersFilterBots)
+		err := jsonUnmarshal(*rawMsg, &supergroupMembersFilterBots)
 		return &supergroupMembersFilterBots, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownSupergroupMembersFilter{Unknown: unknown}, nil
 	}
 }
 
@@ -93,7 +138,6 @@ func (supergroupMembersFilterRecent *SupergroupMembersFilterRecent) MessageType(
 }
 
 // NewSupergroupMembersFilterRecent creates a new SupergroupMembersFilterRecent
-//
 func NewSupergroupMembersFilterRecent() *SupergroupMembersFilterRecent {
 	supergroupMembersFilterRecentTemp := SupergroupMembersFilterRecent{
 		tdCommon: tdCommon{Type: "supergroupMembersFilterRecent"},
@@ -102,11 +146,107 @@ func NewSupergroupMembersFilterRecent() *SupergroupMembersFilterRecent {
 	return &supergroupMembersFilterRecentTemp
 }
 
+// MarshalJSON marshals to json
+func (supergroupMembersFilterRecent *SupergroupMembersFilterRecent) MarshalJSON() ([]byte, error) {
+	return supergroupMembersFilterRecent.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (supergroupMembersFilterRecent *SupergroupMembersFilterRecent) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, supergroupMembersFilterRecent)
+}
+
+func (supergroupMembersFilterRecent *SupergroupMembersFilterRecent) appendJSON(b []byte) ([]byte, error) {
+	if supergroupMembersFilterRecent == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, supergroupMembersFilterRecent.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, supergroupMembersFilterRecent.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (supergroupMembersFilterRecent *SupergroupMembersFilterRecent) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			supergroupMembersFilterRecent.tdCommon.Type, err = r.readString()
+		case "@extra":
+			supergroupMembersFilterRecent.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (supergroupMembersFilterRecent *SupergroupMembersFilterRecent) Clone() *SupergroupMembersFilterRecent {
+	if supergroupMembersFilterRecent == nil {
+		return nil
+	}
+	clone := *supergroupMembersFilterRecent
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (supergroupMembersFilterRecent *SupergroupMembersFilterRecent) Equal(other *SupergroupMembersFilterRecent) bool {
+	if supergroupMembersFilterRecent == nil || other == nil {
+		return supergroupMembersFilterRecent == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (supergroupMembersFilterRecent *SupergroupMembersFilterRecent) Diff(other *SupergroupMembersFilterRecent) []Change {
+	return supergroupMembersFilterRecent.diff("", other, nil)
+}
+
+func (supergroupMembersFilterRecent *SupergroupMembersFilterRecent) diff(path string, other *SupergroupMembersFilterRecent, changes []Change) []Change {
+	if supergroupMembersFilterRecent == nil || other == nil {
+		if supergroupMembersFilterRecent != other {
+			changes = append(changes, Change{Path: path, Old: supergroupMembersFilterRecent, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (supergroupMembersFilterRecent *SupergroupMembersFilterRecent) String() string {
+	return objectString(supergroupMembersFilterRecent)
+}
+
+// LogValue logs the object as its summary
+func (supergroupMembersFilterRecent *SupergroupMembersFilterRecent) LogValue() slog.Value {
+	return slog.StringValue(objectString(supergroupMembersFilterRecent))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (supergroupMembersFilterRecent *SupergroupMembersFilterRecent) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, supergroupMembersFilterRecent)
+}
+
+func (supergroupMembersFilterRecent *SupergroupMembersFilterRecent) printText(p *printer) {
+	if supergroupMembersFilterRecent == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SupergroupMembersFilterRecent")
+	p.endObject(state)
+}
+
 // GetSupergroupMembersFilterEnum return the enum type of this object
 func (supergroupMembersFilterRecent *SupergroupMembersFilterRecent) GetSupergroupMembersFilterEnum() SupergroupMembersFilterEnum {
 	return SupergroupMembersFilterRecentType
 }
 
+// Accept calls the method of the visitor for SupergroupMembersFilterRecent
+func (supergroupMembersFilterRecent *SupergroupMembersFilterRecent) Accept(visitor SupergroupMembersFilterVisitor) {
+	visitor.VisitSupergroupMembersFilterRecent(supergroupMembersFilterRecent)
+}
+
 // SupergroupMembersFilterContacts Returns contacts of the user, which are members of the supergroup or channel
 type SupergroupMembersFilterContacts struct {
 	tdCommon
@@ -130,11 +270,118 @@ func NewSupergroupMembersFilterContacts(query string) *SupergroupMembersFilterCo
 	return &supergroupMembersFilterContactsTemp
 }
 
+// MarshalJSON marshals to json
+func (supergroupMembersFilterContacts *SupergroupMembersFilterContacts) MarshalJSON() ([]byte, error) {
+	return supergroupMembersFilterContacts.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (supergroupMembersFilterContacts *SupergroupMembersFilterContacts) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, supergroupMembersFilterContacts)
+}
+
+func (supergroupMembersFilterContacts *SupergroupMembersFilterContacts) appendJSON(b []byte) ([]byte, error) {
+	if supergroupMembersFilterContacts == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, supergroupMembersFilterContacts.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, supergroupMembersFilterContacts.tdCommon.Extra)
+	b = append(b, `,"query":`...)
+	b = appendJSONString(b, supergroupMembersFilterContacts.Query)
+	return append(b, '}'), nil
+}
+
+func (supergroupMembersFilterContacts *SupergroupMembersFilterContacts) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			supergroupMembersFilterContacts.tdCommon.Type, err = r.readString()
+		case "@extra":
+			supergroupMembersFilterContacts.tdCommon.Extra, err = r.readString()
+		case "query":
+			supergroupMembersFilterContacts.Query, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (supergroupMembersFilterContacts *SupergroupMembersFilterContacts) Clone() *SupergroupMembersFilterContacts {
+	if supergroupMembersFilterContacts == nil {
+		return nil
+	}
+	clone := *supergroupMembersFilterContacts
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (supergroupMembersFilterContacts *SupergroupMembersFilterContacts) Equal(other *SupergroupMembersFilterContacts) bool {
+	if supergroupMembersFilterContacts == nil || other == nil {
+		return supergroupMembersFilterContacts == other
+	}
+	if supergroupMembersFilterContacts.Query != other.Query {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (supergroupMembersFilterContacts *SupergroupMembersFilterContacts) Diff(other *SupergroupMembersFilterContacts) []Change {
+	return supergroupMembersFilterContacts.diff("", other, nil)
+}
+
+func (supergroupMembersFilterContacts *SupergroupMembersFilterContacts) diff(path string, other *SupergroupMembersFilterContacts, changes []Change) []Change {
+	if supergroupMembersFilterContacts == nil || other == nil {
+		if supergroupMembersFilterContacts != other {
+			changes = append(changes, Change{Path: path, Old: supergroupMembersFilterContacts, New: other})
+		}
+		return changes
+	}
+	if supergroupMembersFilterContacts.Query != other.Query {
+		changes = append(changes, Change{Path: fieldPath(path, "query"), Old: supergroupMembersFilterContacts.Query, New: other.Query})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (supergroupMembersFilterContacts *SupergroupMembersFilterContacts) String() string {
+	return objectString(supergroupMembersFilterContacts)
+}
+
+// LogValue logs the object as its summary
+func (supergroupMembersFilterContacts *SupergroupMembersFilterContacts) LogValue() slog.Value {
+	return slog.StringValue(objectString(supergroupMembersFilterContacts))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (supergroupMembersFilterContacts *SupergroupMembersFilterContacts) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, supergroupMembersFilterContacts)
+}
+
+func (supergroupMembersFilterContacts *SupergroupMembersFilterContacts) printText(p *printer) {
+	if supergroupMembersFilterContacts == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SupergroupMembersFilterContacts")
+	p.string("query", supergroupMembersFilterContacts.Query)
+	p.endObject(state)
+}
+
 // GetSupergroupMembersFilterEnum return the enum type of this object
 func (supergroupMembersFilterContacts *SupergroupMembersFilterContacts) GetSupergroupMembersFilterEnum() SupergroupMembersFilterEnum {
 	return SupergroupMembersFilterContactsType
 }
 
+// Accept calls the method of the visitor for SupergroupMembersFilterContacts
+func (supergroupMembersFilterContacts *SupergroupMembersFilterContacts) Accept(visitor SupergroupMembersFilterVisitor) {
+	visitor.VisitSupergroupMembersFilterContacts(supergroupMembersFilterContacts)
+}
+
 // SupergroupMembersFilterAdministrators Returns the owner and administrators
 type SupergroupMembersFilterAdministrators struct {
 	tdCommon
@@ -146,7 +393,6 @@ func (supergroupMembersFilterAdministrators *SupergroupMembersFilterAdministrato
 }
 
 // NewSupergroupMembersFilterAdministrators creates a new SupergroupMembersFilterAdministrators
-//
 func NewSupergroupMembersFilterAdministrators() *SupergroupMembersFilterAdministrators {
 	supergroupMembersFilterAdministratorsTemp := SupergroupMembersFilterAdministrators{
 		tdCommon: tdCommon{Type: "supergroupMembersFilterAdministrators"},
@@ -155,11 +401,107 @@ func NewSupergroupMembersFilterAdministrators() *SupergroupMembersFilterAdminist
 	return &supergroupMembersFilterAdministratorsTemp
 }
 
+// MarshalJSON marshals to json
+func (supergroupMembersFilterAdministrators *SupergroupMembersFilterAdministrators) MarshalJSON() ([]byte, error) {
+	return supergroupMembersFilterAdministrators.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (supergroupMembersFilterAdministrators *SupergroupMembersFilterAdministrators) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, supergroupMembersFilterAdministrators)
+}
+
+func (supergroupMembersFilterAdministrators *SupergroupMembersFilterAdministrators) appendJSON(b []byte) ([]byte, error) {
+	if supergroupMembersFilterAdministrators == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, supergroupMembersFilterAdministrators.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, supergroupMembersFilterAdministrators.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (supergroupMembersFilterAdministrators *SupergroupMembersFilterAdministrators) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			supergroupMembersFilterAdministrators.tdCommon.Type, err = r.readString()
+		case "@extra":
+			supergroupMembersFilterAdministrators.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (supergroupMembersFilterAdministrators *SupergroupMembersFilterAdministrators) Clone() *SupergroupMembersFilterAdministrators {
+	if supergroupMembersFilterAdministrators == nil {
+		return nil
+	}
+	clone := *supergroupMembersFilterAdministrators
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (supergroupMembersFilterAdministrators *SupergroupMembersFilterAdministrators) Equal(other *SupergroupMembersFilterAdministrators) bool {
+	if supergroupMembersFilterAdministrators == nil || other == nil {
+		return supergroupMembersFilterAdministrators == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (supergroupMembersFilterAdministrators *SupergroupMembersFilterAdministrators) Diff(other *SupergroupMembersFilterAdministrators) []Change {
+	return supergroupMembersFilterAdministrators.diff("", other, nil)
+}
+
+func (supergroupMembersFilterAdministrators *SupergroupMembersFilterAdministrators) diff(path string, other *SupergroupMembersFilterAdministrators, changes []Change) []Change {
+	if supergroupMembersFilterAdministrators == nil || other == nil {
+		if supergroupMembersFilterAdministrators != other {
+			changes = append(changes, Change{Path: path, Old: supergroupMembersFilterAdministrators, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (supergroupMembersFilterAdministrators *SupergroupMembersFilterAdministrators) String() string {
+	return objectString(supergroupMembersFilterAdministrators)
+}
+
+// LogValue logs the object as its summary
+func (supergroupMembersFilterAdministrators *SupergroupMembersFilterAdministrators) LogValue() slog.Value {
+	return slog.StringValue(objectString(supergroupMembersFilterAdministrators))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (supergroupMembersFilterAdministrators *SupergroupMembersFilterAdministrators) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, supergroupMembersFilterAdministrators)
+}
+
+func (supergroupMembersFilterAdministrators *SupergroupMembersFilterAdministrators) printText(p *printer) {
+	if supergroupMembersFilterAdministrators == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SupergroupMembersFilterAdministrators")
+	p.endObject(state)
+}
+
 // GetSupergroupMembersFilterEnum return the enum type of this object
 func (supergroupMembersFilterAdministrators *SupergroupMembersFilterAdministrators) GetSupergroupMembersFilterEnum() SupergroupMembersFilterEnum {
 	return SupergroupMembersFilterAdministratorsType
 }
 
+// Accept calls the method of the visitor for SupergroupMembersFilterAdministrators
+func (supergroupMembersFilterAdministrators *SupergroupMembersFilterAdministrators) Accept(visitor SupergroupMembersFilterVisitor) {
+	visitor.VisitSupergroupMembersFilterAdministrators(supergroupMembersFilterAdministrators)
+}
+
 // SupergroupMembersFilterSearch Used to search for supergroup or channel members via a (string) query
 type SupergroupMembersFilterSearch struct {
 	tdCommon
@@ -183,11 +525,118 @@ func NewSupergroupMembersFilterSearch(query string) *SupergroupMembersFilterSear
 	return &supergroupMembersFilterSearchTemp
 }
 
+// MarshalJSON marshals to json
+func (supergroupMembersFilterSearch *SupergroupMembersFilterSearch) MarshalJSON() ([]byte, error) {
+	return supergroupMembersFilterSearch.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (supergroupMembersFilterSearch *SupergroupMembersFilterSearch) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, supergroupMembersFilterSearch)
+}
+
+func (supergroupMembersFilterSearch *SupergroupMembersFilterSearch) appendJSON(b []byte) ([]byte, error) {
+	if supergroupMembersFilterSearch == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, supergroupMembersFilterSearch.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, supergroupMembersFilterSearch.tdCommon.Extra)
+	b = append(b, `,"query":`...)
+	b = appendJSONString(b, supergroupMembersFilterSearch.Query)
+	return append(b, '}'), nil
+}
+
+func (supergroupMembersFilterSearch *SupergroupMembersFilterSearch) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			supergroupMembersFilterSearch.tdCommon.Type, err = r.readString()
+		case "@extra":
+			supergroupMembersFilterSearch.tdCommon.Extra, err = r.readString()
+		case "query":
+			supergroupMembersFilterSearch.Query, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (supergroupMembersFilterSearch *SupergroupMembersFilterSearch) Clone() *SupergroupMembersFilterSearch {
+	if supergroupMembersFilterSearch == nil {
+		return nil
+	}
+	clone := *supergroupMembersFilterSearch
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (supergroupMembersFilterSearch *SupergroupMembersFilterSearch) Equal(other *SupergroupMembersFilterSearch) bool {
+	if supergroupMembersFilterSearch == nil || other == nil {
+		return supergroupMembersFilterSearch == other
+	}
+	if supergroupMembersFilterSearch.Query != other.Query {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (supergroupMembersFilterSearch *SupergroupMembersFilterSearch) Diff(other *SupergroupMembersFilterSearch) []Change {
+	return supergroupMembersFilterSearch.diff("", other, nil)
+}
+
+func (supergroupMembersFilterSearch *SupergroupMembersFilterSearch) diff(path string, other *SupergroupMembersFilterSearch, changes []Change) []Change {
+	if supergroupMembersFilterSearch == nil || other == nil {
+		if supergroupMembersFilterSearch != other {
+			changes = append(changes, Change{Path: path, Old: supergroupMembersFilterSearch, New: other})
+		}
+		return changes
+	}
+	if supergroupMembersFilterSearch.Query != other.Query {
+		changes = append(changes, Change{Path: fieldPath(path, "query"), Old: supergroupMembersFilterSearch.Query, New: other.Query})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (supergroupMembersFilterSearch *SupergroupMembersFilterSearch) String() string {
+	return objectString(supergroupMembersFilterSearch)
+}
+
+// LogValue logs the object as its summary
+func (supergroupMembersFilterSearch *SupergroupMembersFilterSearch) LogValue() slog.Value {
+	return slog.StringValue(objectString(supergroupMembersFilterSearch))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (supergroupMembersFilterSearch *SupergroupMembersFilterSearch) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, supergroupMembersFilterSearch)
+}
+
+func (supergroupMembersFilterSearch *SupergroupMembersFilterSearch) printText(p *printer) {
+	if supergroupMembersFilterSearch == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SupergroupMembersFilterSearch")
+	p.string("query", supergroupMembersFilterSearch.Query)
+	p.endObject(state)
+}
+
 // GetSupergroupMembersFilterEnum return the enum type of this object
 func (supergroupMembersFilterSearch *SupergroupMembersFilterSearch) GetSupergroupMembersFilterEnum() SupergroupMembersFilterEnum {
 	return SupergroupMembersFilterSearchType
 }
 
+// Accept calls the method of the visitor for SupergroupMembersFilterSearch
+func (supergroupMembersFilterSearch *SupergroupMembersFilterSearch) Accept(visitor SupergroupMembersFilterVisitor) {
+	visitor.VisitSupergroupMembersFilterSearch(supergroupMembersFilterSearch)
+}
+
 // SupergroupMembersFilterRestricted Returns restricted supergroup members; can be used only by administrators
 type SupergroupMembersFilterRestricted struct {
 	tdCommon
@@ -211,11 +660,118 @@ func NewSupergroupMembersFilterRestricted(query string) *SupergroupMembersFilter
 	return &supergroupMembersFilterRestrictedTemp
 }
 
+// MarshalJSON marshals to json
+func (supergroupMembersFilterRestricted *SupergroupMembersFilterRestricted) MarshalJSON() ([]byte, error) {
+	return supergroupMembersFilterRestricted.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (supergroupMembersFilterRestricted *SupergroupMembersFilterRestricted) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, supergroupMembersFilterRestricted)
+}
+
+func (supergroupMembersFilterRestricted *SupergroupMembersFilterRestricted) appendJSON(b []byte) ([]byte, error) {
+	if supergroupMembersFilterRestricted == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, supergroupMembersFilterRestricted.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, supergroupMembersFilterRestricted.tdCommon.Extra)
+	b = append(b, `,"query":`...)
+	b = appendJSONString(b, supergroupMembersFilterRestricted.Query)
+	return append(b, '}'), nil
+}
+
+func (supergroupMembersFilterRestricted *SupergroupMembersFilterRestricted) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			supergroupMembersFilterRestricted.tdCommon.Type, err = r.readString()
+		case "@extra":
+			supergroupMembersFilterRestricted.tdCommon.Extra, err = r.readString()
+		case "query":
+			supergroupMembersFilterRestricted.Query, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (supergroupMembersFilterRestricted *SupergroupMembersFilterRestricted) Clone() *SupergroupMembersFilterRestricted {
+	if supergroupMembersFilterRestricted == nil {
+		return nil
+	}
+	clone := *supergroupMembersFilterRestricted
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (supergroupMembersFilterRestricted *SupergroupMembersFilterRestricted) Equal(other *SupergroupMembersFilterRestricted) bool {
+	if supergroupMembersFilterRestricted == nil || other == nil {
+		return supergroupMembersFilterRestricted == other
+	}
+	if supergroupMembersFilterRestricted.Query != other.Query {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (supergroupMembersFilterRestricted *SupergroupMembersFilterRestricted) Diff(other *SupergroupMembersFilterRestricted) []Change {
+	return supergroupMembersFilterRestricted.diff("", other, nil)
+}
+
+func (supergroupMembersFilterRestricted *SupergroupMembersFilterRestricted) diff(path string, other *SupergroupMembersFilterRestricted, changes []Change) []Change {
+	if supergroupMembersFilterRestricted == nil || other == nil {
+		if supergroupMembersFilterRestricted != other {
+			changes = append(changes, Change{Path: path, Old: supergroupMembersFilterRestricted, New: other})
+		}
+		return changes
+	}
+	if supergroupMembersFilterRestricted.Query != other.Query {
+		changes = append(changes, Change{Path: fieldPath(path, "query"), Old: supergroupMembersFilterRestricted.Query, New: other.Query})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (supergroupMembersFilterRestricted *SupergroupMembersFilterRestricted) String() string {
+	return objectString(supergroupMembersFilterRestricted)
+}
+
+// LogValue logs the object as its summary
+func (supergroupMembersFilterRestricted *SupergroupMembersFilterRestricted) LogValue() slog.Value {
+	return slog.StringValue(objectString(supergroupMembersFilterRestricted))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (supergroupMembersFilterRestricted *SupergroupMembersFilterRestricted) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, supergroupMembersFilterRestricted)
+}
+
+func (supergroupMembersFilterRestricted *SupergroupMembersFilterRestricted) printText(p *printer) {
+	if supergroupMembersFilterRestricted == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SupergroupMembersFilterRestricted")
+	p.string("query", supergroupMembersFilterRestricted.Query)
+	p.endObject(state)
+}
+
 // GetSupergroupMembersFilterEnum return the enum type of this object
 func (supergroupMembersFilterRestricted *SupergroupMembersFilterRestricted) GetSupergroupMembersFilterEnum() SupergroupMembersFilterEnum {
 	return SupergroupMembersFilterRestrictedType
 }
 
+// Accept calls the method of the visitor for SupergroupMembersFilterRestricted
+func (supergroupMembersFilterRestricted *SupergroupMembersFilterRestricted) Accept(visitor SupergroupMembersFilterVisitor) {
+	visitor.VisitSupergroupMembersFilterRestricted(supergroupMembersFilterRestricted)
+}
+
 // SupergroupMembersFilterBanned Returns users banned from the supergroup or channel; can be used only by administrators
 type SupergroupMembersFilterBanned struct {
 	tdCommon
@@ -239,11 +795,118 @@ func NewSupergroupMembersFilterBanned(query string) *SupergroupMembersFilterBann
 	return &supergroupMembersFilterBannedTemp
 }
 
+// MarshalJSON marshals to json
+func (supergroupMembersFilterBanned *SupergroupMembersFilterBanned) MarshalJSON() ([]byte, error) {
+	return supergroupMembersFilterBanned.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (supergroupMembersFilterBanned *SupergroupMembersFilterBanned) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, supergroupMembersFilterBanned)
+}
+
+func (supergroupMembersFilterBanned *SupergroupMembersFilterBanned) appendJSON(b []byte) ([]byte, error) {
+	if supergroupMembersFilterBanned == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, supergroupMembersFilterBanned.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, supergroupMembersFilterBanned.tdCommon.Extra)
+	b = append(b, `,"query":`...)
+	b = appendJSONString(b, supergroupMembersFilterBanned.Query)
+	return append(b, '}'), nil
+}
+
+func (supergroupMembersFilterBanned *SupergroupMembersFilterBanned) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			supergroupMembersFilterBanned.tdCommon.Type, err = r.readString()
+		case "@extra":
+			supergroupMembersFilterBanned.tdCommon.Extra, err = r.readString()
+		case "query":
+			supergroupMembersFilterBanned.Query, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (supergroupMembersFilterBanned *SupergroupMembersFilterBanned) Clone() *SupergroupMembersFilterBanned {
+	if supergroupMembersFilterBanned == nil {
+		return nil
+	}
+	clone := *supergroupMembersFilterBanned
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (supergroupMembersFilterBanned *SupergroupMembersFilterBanned) Equal(other *SupergroupMembersFilterBanned) bool {
+	if supergroupMembersFilterBanned == nil || other == nil {
+		return supergroupMembersFilterBanned == other
+	}
+	if supergroupMembersFilterBanned.Query != other.Query {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (supergroupMembersFilterBanned *SupergroupMembersFilterBanned) Diff(other *SupergroupMembersFilterBanned) []Change {
+	return supergroupMembersFilterBanned.diff("", other, nil)
+}
+
+func (supergroupMembersFilterBanned *SupergroupMembersFilterBanned) diff(path string, other *SupergroupMembersFilterBanned, changes []Change) []Change {
+	if supergroupMembersFilterBanned == nil || other == nil {
+		if supergroupMembersFilterBanned != other {
+			changes = append(changes, Change{Path: path, Old: supergroupMembersFilterBanned, New: other})
+		}
+		return changes
+	}
+	if supergroupMembersFilterBanned.Query != other.Query {
+		changes = append(changes, Change{Path: fieldPath(path, "query"), Old: supergroupMembersFilterBanned.Query, New: other.Query})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (supergroupMembersFilterBanned *SupergroupMembersFilterBanned) String() string {
+	return objectString(supergroupMembersFilterBanned)
+}
+
+// LogValue logs the object as its summary
+func (supergroupMembersFilterBanned *SupergroupMembersFilterBanned) LogValue() slog.Value {
+	return slog.StringValue(objectString(supergroupMembersFilterBanned))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (supergroupMembersFilterBanned *SupergroupMembersFilterBanned) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, supergroupMembersFilterBanned)
+}
+
+func (supergroupMembersFilterBanned *SupergroupMembersFilterBanned) printText(p *printer) {
+	if supergroupMembersFilterBanned == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SupergroupMembersFilterBanned")
+	p.string("query", supergroupMembersFilterBanned.Query)
+	p.endObject(state)
+}
+
 // GetSupergroupMembersFilterEnum return the enum type of this object
 func (supergroupMembersFilterBanned *SupergroupMembersFilterBanned) GetSupergroupMembersFilterEnum() SupergroupMembersFilterEnum {
 	return SupergroupMembersFilterBannedType
 }
 
+// Accept calls the method of the visitor for SupergroupMembersFilterBanned
+func (supergroupMembersFilterBanned *SupergroupMembersFilterBanned) Accept(visitor SupergroupMembersFilterVisitor) {
+	visitor.VisitSupergroupMembersFilterBanned(supergroupMembersFilterBanned)
+}
+
 // SupergroupMembersFilterMention Returns users which can be mentioned in the supergroup
 type SupergroupMembersFilterMention struct {
 	tdCommon
@@ -270,11 +933,129 @@ func NewSupergroupMembersFilterMention(query string, messageThreadId int64) *Sup
 	return &supergroupMembersFilterMentionTemp
 }
 
+// MarshalJSON marshals to json
+func (supergroupMembersFilterMention *SupergroupMembersFilterMention) MarshalJSON() ([]byte, error) {
+	return supergroupMembersFilterMention.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (supergroupMembersFilterMention *SupergroupMembersFilterMention) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, supergroupMembersFilterMention)
+}
+
+func (supergroupMembersFilterMention *SupergroupMembersFilterMention) appendJSON(b []byte) ([]byte, error) {
+	if supergroupMembersFilterMention == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, supergroupMembersFilterMention.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, supergroupMembersFilterMention.tdCommon.Extra)
+	b = append(b, `,"query":`...)
+	b = appendJSONString(b, supergroupMembersFilterMention.Query)
+	b = append(b, `,"message_thread_id":`...)
+	b = appendJSONInt(b, int64(supergroupMembersFilterMention.MessageThreadId))
+	return append(b, '}'), nil
+}
+
+func (supergroupMembersFilterMention *SupergroupMembersFilterMention) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			supergroupMembersFilterMention.tdCommon.Type, err = r.readString()
+		case "@extra":
+			supergroupMembersFilterMention.tdCommon.Extra, err = r.readString()
+		case "query":
+			supergroupMembersFilterMention.Query, err = r.readString()
+		case "message_thread_id":
+			supergroupMembersFilterMention.MessageThreadId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (supergroupMembersFilterMention *SupergroupMembersFilterMention) Clone() *SupergroupMembersFilterMention {
+	if supergroupMembersFilterMention == nil {
+		return nil
+	}
+	clone := *supergroupMembersFilterMention
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (supergroupMembersFilterMention *SupergroupMembersFilterMention) Equal(other *SupergroupMembersFilterMention) bool {
+	if supergroupMembersFilterMention == nil || other == nil {
+		return supergroupMembersFilterMention == other
+	}
+	if supergroupMembersFilterMention.Query != other.Query {
+		return false
+	}
+	if supergroupMembersFilterMention.MessageThreadId != other.MessageThreadId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (supergroupMembersFilterMention *SupergroupMembersFilterMention) Diff(other *SupergroupMembersFilterMention) []Change {
+	return supergroupMembersFilterMention.diff("", other, nil)
+}
+
+func (supergroupMembersFilterMention *SupergroupMembersFilterMention) diff(path string, other *SupergroupMembersFilterMention, changes []Change) []Change {
+	if supergroupMembersFilterMention == nil || other == nil {
+		if supergroupMembersFilterMention != other {
+			changes = append(changes, Change{Path: path, Old: supergroupMembersFilterMention, New: other})
+		}
+		return changes
+	}
+	if supergroupMembersFilterMention.Query != other.Query {
+		changes = append(changes, Change{Path: fieldPath(path, "query"), Old: supergroupMembersFilterMention.Query, New: other.Query})
+	}
+	if supergroupMembersFilterMention.MessageThreadId != other.MessageThreadId {
+		changes = append(changes, Change{Path: fieldPath(path, "message_thread_id"), Old: supergroupMembersFilterMention.MessageThreadId, New: other.MessageThreadId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (supergroupMembersFilterMention *SupergroupMembersFilterMention) String() string {
+	return objectString(supergroupMembersFilterMention)
+}
+
+// LogValue logs the object as its summary
+func (supergroupMembersFilterMention *SupergroupMembersFilterMention) LogValue() slog.Value {
+	return slog.StringValue(objectString(supergroupMembersFilterMention))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (supergroupMembersFilterMention *SupergroupMembersFilterMention) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, supergroupMembersFilterMention)
+}
+
+func (supergroupMembersFilterMention *SupergroupMembersFilterMention) printText(p *printer) {
+	if supergroupMembersFilterMention == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SupergroupMembersFilterMention")
+	p.string("query", supergroupMembersFilterMention.Query)
+	p.int("message_thread_id", int64(supergroupMembersFilterMention.MessageThreadId))
+	p.endObject(state)
+}
+
 // GetSupergroupMembersFilterEnum return the enum type of this object
 func (supergroupMembersFilterMention *SupergroupMembersFilterMention) GetSupergroupMembersFilterEnum() SupergroupMembersFilterEnum {
 	return SupergroupMembersFilterMentionType
 }
 
+// Accept calls the method of the visitor for SupergroupMembersFilterMention
+func (supergroupMembersFilterMention *SupergroupMembersFilterMention) Accept(visitor SupergroupMembersFilterVisitor) {
+	visitor.VisitSupergroupMembersFilterMention(supergroupMembersFilterMention)
+}
+
 // SupergroupMembersFilterBots Returns bot members of the supergroup or channel
 type SupergroupMembersFilterBots struct {
 	tdCommon
@@ -286,7 +1067,6 @@ func (supergroupMembersFilterBots *SupergroupMembersFilterBots) MessageType() st
 }
 
 // NewSupergroupMembersFilterBots creates a new SupergroupMembersFilterBots
-//
 func NewSupergroupMembersFilterBots() *SupergroupMembersFilterBots {
 	supergroupMembersFilterBotsTemp := SupergroupMembersFilterBots{
 		tdCommon: tdCommon{Type: "supergroupMembersFilterBots"},
@@ -295,7 +1075,288 @@ func NewSupergroupMembersFilterBots() *SupergroupMembersFilterBots {
 	return &supergroupMembersFilterBotsTemp
 }
 
+// MarshalJSON marshals to json
+func (supergroupMembersFilterBots *SupergroupMembersFilterBots) MarshalJSON() ([]byte, error) {
+	return supergroupMembersFilterBots.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (supergroupMembersFilterBots *SupergroupMembersFilterBots) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, supergroupMembersFilterBots)
+}
+
+func (supergroupMembersFilterBots *SupergroupMembersFilterBots) appendJSON(b []byte) ([]byte, error) {
+	if supergroupMembersFilterBots == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, supergroupMembersFilterBots.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, supergroupMembersFilterBots.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (supergroupMembersFilterBots *SupergroupMembersFilterBots) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			supergroupMembersFilterBots.tdCommon.Type, err = r.readString()
+		case "@extra":
+			supergroupMembersFilterBots.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (supergroupMembersFilterBots *SupergroupMembersFilterBots) Clone() *SupergroupMembersFilterBots {
+	if supergroupMembersFilterBots == nil {
+		return nil
+	}
+	clone := *supergroupMembersFilterBots
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (supergroupMembersFilterBots *SupergroupMembersFilterBots) Equal(other *SupergroupMembersFilterBots) bool {
+	if supergroupMembersFilterBots == nil || other == nil {
+		return supergroupMembersFilterBots == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (supergroupMembersFilterBots *SupergroupMembersFilterBots) Diff(other *SupergroupMembersFilterBots) []Change {
+	return supergroupMembersFilterBots.diff("", other, nil)
+}
+
+func (supergroupMembersFilterBots *SupergroupMembersFilterBots) diff(path string, other *SupergroupMembersFilterBots, changes []Change) []Change {
+	if supergroupMembersFilterBots == nil || other == nil {
+		if supergroupMembersFilterBots != other {
+			changes = append(changes, Change{Path: path, Old: supergroupMembersFilterBots, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (supergroupMembersFilterBots *SupergroupMembersFilterBots) String() string {
+	return objectString(supergroupMembersFilterBots)
+}
+
+// LogValue logs the object as its summary
+func (supergroupMembersFilterBots *SupergroupMembersFilterBots) LogValue() slog.Value {
+	return slog.StringValue(objectString(supergroupMembersFilterBots))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (supergroupMembersFilterBots *SupergroupMembersFilterBots) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, supergroupMembersFilterBots)
+}
+
+func (supergroupMembersFilterBots *SupergroupMembersFilterBots) printText(p *printer) {
+	if supergroupMembersFilterBots == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("SupergroupMembersFilterBots")
+	p.endObject(state)
+}
+
 // GetSupergroupMembersFilterEnum return the enum type of this object
 func (supergroupMembersFilterBots *SupergroupMembersFilterBots) GetSupergroupMembersFilterEnum() SupergroupMembersFilterEnum {
 	return SupergroupMembersFilterBotsType
 }
+
+// Accept calls the method of the visitor for SupergroupMembersFilterBots
+func (supergroupMembersFilterBots *SupergroupMembersFilterBots) Accept(visitor SupergroupMembersFilterVisitor) {
+	visitor.VisitSupergroupMembersFilterBots(supergroupMembersFilterBots)
+}
+
+// SupergroupMembersFilterVisitor visits every type of SupergroupMembersFilter, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to SupergroupMembersFilter, until they handle it too.
+type SupergroupMembersFilterVisitor interface {
+	VisitSupergroupMembersFilterRecent(supergroupMembersFilterRecent *SupergroupMembersFilterRecent)
+	VisitSupergroupMembersFilterContacts(supergroupMembersFilterContacts *SupergroupMembersFilterContacts)
+	VisitSupergroupMembersFilterAdministrators(supergroupMembersFilterAdministrators *SupergroupMembersFilterAdministrators)
+	VisitSupergroupMembersFilterSearch(supergroupMembersFilterSearch *SupergroupMembersFilterSearch)
+	VisitSupergroupMembersFilterRestricted(supergroupMembersFilterRestricted *SupergroupMembersFilterRestricted)
+	VisitSupergroupMembersFilterBanned(supergroupMembersFilterBanned *SupergroupMembersFilterBanned)
+	VisitSupergroupMembersFilterMention(supergroupMembersFilterMention *SupergroupMembersFilterMention)
+	VisitSupergroupMembersFilterBots(supergroupMembersFilterBots *SupergroupMembersFilterBots)
+	VisitUnknownSupergroupMembersFilter(unknownSupergroupMembersFilter *UnknownSupergroupMembersFilter)
+}
+
+// SupergroupMembersFilterCases holds the function MatchSupergroupMembersFilter calls for each type of SupergroupMembersFilter;
+// types without a function are passed to the default case
+type SupergroupMembersFilterCases[R any] struct {
+	SupergroupMembersFilterRecent         func(supergroupMembersFilterRecent *SupergroupMembersFilterRecent) R
+	SupergroupMembersFilterContacts       func(supergroupMembersFilterContacts *SupergroupMembersFilterContacts) R
+	SupergroupMembersFilterAdministrators func(supergroupMembersFilterAdministrators *SupergroupMembersFilterAdministrators) R
+	SupergroupMembersFilterSearch         func(supergroupMembersFilterSearch *SupergroupMembersFilterSearch) R
+	SupergroupMembersFilterRestricted     func(supergroupMembersFilterRestricted *SupergroupMembersFilterRestricted) R
+	SupergroupMembersFilterBanned         func(supergroupMembersFilterBanned *SupergroupMembersFilterBanned) R
+	SupergroupMembersFilterMention        func(supergroupMembersFilterMention *SupergroupMembersFilterMention) R
+	SupergroupMembersFilterBots           func(supergroupMembersFilterBots *SupergroupMembersFilterBots) R
+}
+
+// MatchSupergroupMembersFilter calls the case of the type of supergroupMembersFilter, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement SupergroupMembersFilterVisitor
+// to have the compiler check every type is handled.
+func MatchSupergroupMembersFilter[R any](supergroupMembersFilter SupergroupMembersFilter, cases SupergroupMembersFilterCases[R], defaultCase func(supergroupMembersFilter SupergroupMembersFilter) R) R {
+	switch value := supergroupMembersFilter.(type) {
+	case *SupergroupMembersFilterRecent:
+		if cases.SupergroupMembersFilterRecent != nil {
+			return cases.SupergroupMembersFilterRecent(value)
+		}
+	case *SupergroupMembersFilterContacts:
+		if cases.SupergroupMembersFilterContacts != nil {
+			return cases.SupergroupMembersFilterContacts(value)
+		}
+	case *SupergroupMembersFilterAdministrators:
+		if cases.SupergroupMembersFilterAdministrators != nil {
+			return cases.SupergroupMembersFilterAdministrators(value)
+		}
+	case *SupergroupMembersFilterSearch:
+		if cases.SupergroupMembersFilterSearch != nil {
+			return cases.SupergroupMembersFilterSearch(value)
+		}
+	case *SupergroupMembersFilterRestricted:
+		if cases.SupergroupMembersFilterRestricted != nil {
+			return cases.SupergroupMembersFilterRestricted(value)
+		}
+	case *SupergroupMembersFilterBanned:
+		if cases.SupergroupMembersFilterBanned != nil {
+			return cases.SupergroupMembersFilterBanned(value)
+		}
+	case *SupergroupMembersFilterMention:
+		if cases.SupergroupMembersFilterMention != nil {
+			return cases.SupergroupMembersFilterMention(value)
+		}
+	case *SupergroupMembersFilterBots:
+		if cases.SupergroupMembersFilterBots != nil {
+			return cases.SupergroupMembersFilterBots(value)
+		}
+	}
+	return defaultCase(supergroupMembersFilter)
+}
+
+// Clone returns a deep copy of the object
+func (unknownSupergroupMembersFilter *UnknownSupergroupMembersFilter) Clone() *UnknownSupergroupMembersFilter {
+	if unknownSupergroupMembersFilter == nil {
+		return nil
+	}
+	return &UnknownSupergroupMembersFilter{Unknown: unknownSupergroupMembersFilter.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownSupergroupMembersFilter *UnknownSupergroupMembersFilter) Equal(other *UnknownSupergroupMembersFilter) bool {
+	if unknownSupergroupMembersFilter == nil || other == nil {
+		return unknownSupergroupMembersFilter == other
+	}
+	return unknownSupergroupMembersFilter.Unknown.equal(other.Unknown)
+}
+
+func cloneSupergroupMembersFilter(supergroupMembersFilter SupergroupMembersFilter) SupergroupMembersFilter {
+	switch value := supergroupMembersFilter.(type) {
+	case *SupergroupMembersFilterRecent:
+		return value.Clone()
+	case *SupergroupMembersFilterContacts:
+		return value.Clone()
+	case *SupergroupMembersFilterAdministrators:
+		return value.Clone()
+	case *SupergroupMembersFilterSearch:
+		return value.Clone()
+	case *SupergroupMembersFilterRestricted:
+		return value.Clone()
+	case *SupergroupMembersFilterBanned:
+		return value.Clone()
+	case *SupergroupMembersFilterMention:
+		return value.Clone()
+	case *SupergroupMembersFilterBots:
+		return value.Clone()
+	case *UnknownSupergroupMembersFilter:
+		return value.Clone()
+	}
+	return supergroupMembersFilter
+}
+
+func equalSupergroupMembersFilter(a SupergroupMembersFilter, b SupergroupMembersFilter) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *SupergroupMembersFilterRecent:
+		b, ok := b.(*SupergroupMembersFilterRecent)
+		return ok && a.Equal(b)
+	case *SupergroupMembersFilterContacts:
+		b, ok := b.(*SupergroupMembersFilterContacts)
+		return ok && a.Equal(b)
+	case *SupergroupMembersFilterAdministrators:
+		b, ok := b.(*SupergroupMembersFilterAdministrators)
+		return ok && a.Equal(b)
+	case *SupergroupMembersFilterSearch:
+		b, ok := b.(*SupergroupMembersFilterSearch)
+		return ok && a.Equal(b)
+	case *SupergroupMembersFilterRestricted:
+		b, ok := b.(*SupergroupMembersFilterRestricted)
+		return ok && a.Equal(b)
+	case *SupergroupMembersFilterBanned:
+		b, ok := b.(*SupergroupMembersFilterBanned)
+		return ok && a.Equal(b)
+	case *SupergroupMembersFilterMention:
+		b, ok := b.(*SupergroupMembersFilterMention)
+		return ok && a.Equal(b)
+	case *SupergroupMembersFilterBots:
+		b, ok := b.(*SupergroupMembersFilterBots)
+		return ok && a.Equal(b)
+	case *UnknownSupergroupMembersFilter:
+		b, ok := b.(*UnknownSupergroupMembersFilter)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffSupergroupMembersFilter(path string, a SupergroupMembersFilter, b SupergroupMembersFilter, changes []Change) []Change {
+	switch a := a.(type) {
+	case *SupergroupMembersFilterRecent:
+		if b, ok := b.(*SupergroupMembersFilterRecent); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SupergroupMembersFilterContacts:
+		if b, ok := b.(*SupergroupMembersFilterContacts); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SupergroupMembersFilterAdministrators:
+		if b, ok := b.(*SupergroupMembersFilterAdministrators); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SupergroupMembersFilterSearch:
+		if b, ok := b.(*SupergroupMembersFilterSearch); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SupergroupMembersFilterRestricted:
+		if b, ok := b.(*SupergroupMembersFilterRestricted); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SupergroupMembersFilterBanned:
+		if b, ok := b.(*SupergroupMembersFilterBanned); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SupergroupMembersFilterMention:
+		if b, ok := b.(*SupergroupMembersFilterMention); ok {
+			return a.diff(path, b, changes)
+		}
+	case *SupergroupMembersFilterBots:
+		if b, ok := b.(*SupergroupMembersFilterBots); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalSupergroupMembersFilter(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
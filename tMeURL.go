@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // TMeUrl Represents a URL linking to an internal Telegram entity
@@ -30,28 +31,118 @@ func NewTMeUrl(url string, typeParam TMeUrlType) *TMeUrl {
 	return &tMeUrlTemp
 }
 
+// MarshalJSON marshals to json
+func (tMeUrl *TMeUrl) MarshalJSON() ([]byte, error) {
+	return tMeUrl.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (tMeUrl *TMeUrl) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, tMeUrl)
+}
+
+func (tMeUrl *TMeUrl) appendJSON(b []byte) ([]byte, error) {
+	if tMeUrl == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, tMeUrl.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, tMeUrl.tdCommon.Extra)
+	b = append(b, `,"url":`...)
+	b = appendJSONString(b, tMeUrl.Url)
+	b = append(b, `,"type":`...)
+	b, err = appendJSONValue(b, tMeUrl.Type)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-		Url string `json:"url"` // URL
+	return append(b, '}'), nil
+}
 
-	}{}
-	err = json.Unmarshal(b, &tempObj)
-	if err != nil {
-		return err
+func (tMeUrl *TMeUrl) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			tMeUrl.tdCommon.Type, err = r.readString()
+		case "@extra":
+			tMeUrl.tdCommon.Extra, err = r.readString()
+		case "url":
+			tMeUrl.Url, err = r.readString()
+		case "type":
+			tMeUrl.Type, err = decodeTMeUrlType(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (tMeUrl *TMeUrl) Clone() *TMeUrl {
+	if tMeUrl == nil {
+		return nil
+	}
+	clone := *tMeUrl
+	clone.Type = cloneTMeUrlType(tMeUrl.Type)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (tMeUrl *TMeUrl) Equal(other *TMeUrl) bool {
+	if tMeUrl == nil || other == nil {
+		return tMeUrl == other
+	}
+	if tMeUrl.Url != other.Url {
+		return false
 	}
+	if !equalTMeUrlType(tMeUrl.Type, other.Type) {
+		return false
+	}
+	return true
+}
 
-	tMeUrl.tdCommon = tempObj.tdCommon
-	tMeUrl.Url = tempObj.Url
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (tMeUrl *TMeUrl) Diff(other *TMeUrl) []Change {
+	return tMeUrl.diff("", other, nil)
+}
 
-	fieldType, _ := unmarshalTMeUrlType(objMap["type"])
-	tMeUrl.Type = fieldType
+func (tMeUrl *TMeUrl) diff(path string, other *TMeUrl, changes []Change) []Change {
+	if tMeUrl == nil || other == nil {
+		if tMeUrl != other {
+			changes = append(changes, Change{Path: path, Old: tMeUrl, New: other})
+		}
+		return changes
+	}
+	if tMeUrl.Url != other.Url {
+		changes = append(changes, Change{Path: fieldPath(path, "url"), Old: tMeUrl.Url, New: other.Url})
+	}
+	changes = diffTMeUrlType(fieldPath(path, "type"), tMeUrl.Type, other.Type, changes)
+	return changes
+}
 
-	return nil
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (tMeUrl *TMeUrl) String() string {
+	return objectString(tMeUrl)
+}
+
+// LogValue logs the object as its summary
+func (tMeUrl *TMeUrl) LogValue() slog.Value {
+	return slog.StringValue(objectString(tMeUrl))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (tMeUrl *TMeUrl) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, tMeUrl)
+}
+
+func (tMeUrl *TMeUrl) printText(p *printer) {
+	if tMeUrl == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TMeUrl")
+	p.string("url", tMeUrl.Url)
+	p.object("type", tMeUrl.Type)
+	p.endObject(state)
 }
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // TMeUrlType Describes the type of a URL linking to an internal Telegram entity
 type TMeUrlType interface {
 	GetTMeUrlTypeEnum() TMeUrlTypeEnum
+	Accept(visitor TMeUrlTypeVisitor)
 }
 
 // TMeUrlTypeEnum Alias for abstract TMeUrlType 'Sub-Classes', used as constant-enum here
@@ -21,43 +23,94 @@ const (
 	TMeUrlTypeStickerSetType TMeUrlTypeEnum = "tMeUrlTypeStickerSet"
 )
 
+// UnknownTMeUrlType is a TMeUrlType of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownTMeUrlType struct {
+	Unknown
+}
+
+// GetTMeUrlTypeEnum return the enum type of this object
+func (unknownTMeUrlType *UnknownTMeUrlType) GetTMeUrlTypeEnum() TMeUrlTypeEnum {
+	return TMeUrlTypeEnum(unknownTMeUrlType.Type)
+}
+
+// Accept calls the method of the visitor for UnknownTMeUrlType
+func (unknownTMeUrlType *UnknownTMeUrlType) Accept(visitor TMeUrlTypeVisitor) {
+	visitor.VisitUnknownTMeUrlType(unknownTMeUrlType)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownTMeUrlType *UnknownTMeUrlType) String() string {
+	return objectString(unknownTMeUrlType)
+}
+
+// LogValue logs the object as its summary
+func (unknownTMeUrlType *UnknownTMeUrlType) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownTMeUrlType))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownTMeUrlType *UnknownTMeUrlType) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownTMeUrlType)
+}
+
+func (unknownTMeUrlType *UnknownTMeUrlType) printText(p *printer) {
+	if unknownTMeUrlType == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownTMeUrlType")
+	p.string("@type", unknownTMeUrlType.Type)
+	p.endObject(state)
+}
+
 func unmarshalTMeUrlType(rawMsg *json.RawMessage) (TMeUrlType, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch TMeUrlTypeEnum(objMap["@type"].(string)) {
+	switch TMeUrlTypeEnum(typeName) {
 	case TMeUrlTypeUserType:
 		var tMeUrlTypeUser TMeUrlTypeUser
-		err := json.Unmarshal(*rawMsg, &tMeUrlTypeUser)
+		err := jsonUnmarshal(*rawMsg, &tMeUrlTypeUser)
 		return &tMeUrlTypeUser, err
 
 	case TMeUrlTypeSupergroupType:
 		var tMeUrlTypeSupergroup TMeUrlTypeSupergroup
-		err := json.Unmarshal(*rawMsg, &tMeUrlTypeSupergroup)
+		err := jsonUnmarshal(*rawMsg, &tMeUrlTypeSupergroup)
 		return &tMeUrlTypeSupergroup, err
 
 	case TMeUrlTypeChatInviteType:
 		var tMeUrlTypeChatInvite TMeUrlTypeChatInvite
-		err := json.Unmarshal(*rawMsg, &tMeUrlTypeChatInvite)
+		err := jsonUnmarshal(*rawMsg, &tMeUrlTypeChatInvite)
 		return &tMeUrlTypeChatInvite, err
 
 	case TMeUrlTypeStickerSetType:
 		var tMeUrlTypeStickerSet TMeUrlTypeStickerSet
-		err := json.Unmarshal(*rawMsg, &tMeUrlTypeStickerSet)
+		err := jsonUnmarshal(*rawMsg, &tMeUrlTypeStickerSet)
 		return &tMeUrlTypeStickerSet, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownTMeUrlType{Unknown: unknown}, nil
 	}
 }
 
+func decodeTMeUrlType(r *jsonReader) (TMeUrlType, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalTMeUrlType(rawMsg)
+}
+
 // TMeUrlTypeUser A URL linking to a user
 type TMeUrlTypeUser struct {
 	tdCommon
@@ -81,11 +134,118 @@ func NewTMeUrlTypeUser(userId int64) *TMeUrlTypeUser {
 	return &tMeUrlTypeUserTemp
 }
 
+// MarshalJSON marshals to json
+func (tMeUrlTypeUser *TMeUrlTypeUser) MarshalJSON() ([]byte, error) {
+	return tMeUrlTypeUser.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (tMeUrlTypeUser *TMeUrlTypeUser) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, tMeUrlTypeUser)
+}
+
+func (tMeUrlTypeUser *TMeUrlTypeUser) appendJSON(b []byte) ([]byte, error) {
+	if tMeUrlTypeUser == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, tMeUrlTypeUser.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, tMeUrlTypeUser.tdCommon.Extra)
+	b = append(b, `,"user_id":`...)
+	b = appendJSONInt(b, int64(tMeUrlTypeUser.UserId))
+	return append(b, '}'), nil
+}
+
+func (tMeUrlTypeUser *TMeUrlTypeUser) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			tMeUrlTypeUser.tdCommon.Type, err = r.readString()
+		case "@extra":
+			tMeUrlTypeUser.tdCommon.Extra, err = r.readString()
+		case "user_id":
+			tMeUrlTypeUser.UserId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (tMeUrlTypeUser *TMeUrlTypeUser) Clone() *TMeUrlTypeUser {
+	if tMeUrlTypeUser == nil {
+		return nil
+	}
+	clone := *tMeUrlTypeUser
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (tMeUrlTypeUser *TMeUrlTypeUser) Equal(other *TMeUrlTypeUser) bool {
+	if tMeUrlTypeUser == nil || other == nil {
+		return tMeUrlTypeUser == other
+	}
+	if tMeUrlTypeUser.UserId != other.UserId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (tMeUrlTypeUser *TMeUrlTypeUser) Diff(other *TMeUrlTypeUser) []Change {
+	return tMeUrlTypeUser.diff("", other, nil)
+}
+
+func (tMeUrlTypeUser *TMeUrlTypeUser) diff(path string, other *TMeUrlTypeUser, changes []Change) []Change {
+	if tMeUrlTypeUser == nil || other == nil {
+		if tMeUrlTypeUser != other {
+			changes = append(changes, Change{Path: path, Old: tMeUrlTypeUser, New: other})
+		}
+		return changes
+	}
+	if tMeUrlTypeUser.UserId != other.UserId {
+		changes = append(changes, Change{Path: fieldPath(path, "user_id"), Old: tMeUrlTypeUser.UserId, New: other.UserId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (tMeUrlTypeUser *TMeUrlTypeUser) String() string {
+	return objectString(tMeUrlTypeUser)
+}
+
+// LogValue logs the object as its summary
+func (tMeUrlTypeUser *TMeUrlTypeUser) LogValue() slog.Value {
+	return slog.StringValue(objectString(tMeUrlTypeUser))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (tMeUrlTypeUser *TMeUrlTypeUser) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, tMeUrlTypeUser)
+}
+
+func (tMeUrlTypeUser *TMeUrlTypeUser) printText(p *printer) {
+	if tMeUrlTypeUser == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TMeUrlTypeUser")
+	p.int("user_id", int64(tMeUrlTypeUser.UserId))
+	p.endObject(state)
+}
+
 // GetTMeUrlTypeEnum return the enum type of this object
 func (tMeUrlTypeUser *TMeUrlTypeUser) GetTMeUrlTypeEnum() TMeUrlTypeEnum {
 	return TMeUrlTypeUserType
 }
 
+// Accept calls the method of the visitor for TMeUrlTypeUser
+func (tMeUrlTypeUser *TMeUrlTypeUser) Accept(visitor TMeUrlTypeVisitor) {
+	visitor.VisitTMeUrlTypeUser(tMeUrlTypeUser)
+}
+
 // TMeUrlTypeSupergroup A URL linking to a public supergroup or channel
 type TMeUrlTypeSupergroup struct {
 	tdCommon
@@ -109,11 +269,118 @@ func NewTMeUrlTypeSupergroup(supergroupId int64) *TMeUrlTypeSupergroup {
 	return &tMeUrlTypeSupergroupTemp
 }
 
+// MarshalJSON marshals to json
+func (tMeUrlTypeSupergroup *TMeUrlTypeSupergroup) MarshalJSON() ([]byte, error) {
+	return tMeUrlTypeSupergroup.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (tMeUrlTypeSupergroup *TMeUrlTypeSupergroup) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, tMeUrlTypeSupergroup)
+}
+
+func (tMeUrlTypeSupergroup *TMeUrlTypeSupergroup) appendJSON(b []byte) ([]byte, error) {
+	if tMeUrlTypeSupergroup == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, tMeUrlTypeSupergroup.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, tMeUrlTypeSupergroup.tdCommon.Extra)
+	b = append(b, `,"supergroup_id":`...)
+	b = appendJSONInt(b, int64(tMeUrlTypeSupergroup.SupergroupId))
+	return append(b, '}'), nil
+}
+
+func (tMeUrlTypeSupergroup *TMeUrlTypeSupergroup) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			tMeUrlTypeSupergroup.tdCommon.Type, err = r.readString()
+		case "@extra":
+			tMeUrlTypeSupergroup.tdCommon.Extra, err = r.readString()
+		case "supergroup_id":
+			tMeUrlTypeSupergroup.SupergroupId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (tMeUrlTypeSupergroup *TMeUrlTypeSupergroup) Clone() *TMeUrlTypeSupergroup {
+	if tMeUrlTypeSupergroup == nil {
+		return nil
+	}
+	clone := *tMeUrlTypeSupergroup
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (tMeUrlTypeSupergroup *TMeUrlTypeSupergroup) Equal(other *TMeUrlTypeSupergroup) bool {
+	if tMeUrlTypeSupergroup == nil || other == nil {
+		return tMeUrlTypeSupergroup == other
+	}
+	if tMeUrlTypeSupergroup.SupergroupId != other.SupergroupId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (tMeUrlTypeSupergroup *TMeUrlTypeSupergroup) Diff(other *TMeUrlTypeSupergroup) []Change {
+	return tMeUrlTypeSupergroup.diff("", other, nil)
+}
+
+func (tMeUrlTypeSupergroup *TMeUrlTypeSupergroup) diff(path string, other *TMeUrlTypeSupergroup, changes []Change) []Change {
+	if tMeUrlTypeSupergroup == nil || other == nil {
+		if tMeUrlTypeSupergroup != other {
+			changes = append(changes, Change{Path: path, Old: tMeUrlTypeSupergroup, New: other})
+		}
+		return changes
+	}
+	if tMeUrlTypeSupergroup.SupergroupId != other.SupergroupId {
+		changes = append(changes, Change{Path: fieldPath(path, "supergroup_id"), Old: tMeUrlTypeSupergroup.SupergroupId, New: other.SupergroupId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (tMeUrlTypeSupergroup *TMeUrlTypeSupergroup) String() string {
+	return objectString(tMeUrlTypeSupergroup)
+}
+
+// LogValue logs the object as its summary
+func (tMeUrlTypeSupergroup *TMeUrlTypeSupergroup) LogValue() slog.Value {
+	return slog.StringValue(objectString(tMeUrlTypeSupergroup))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (tMeUrlTypeSupergroup *TMeUrlTypeSupergroup) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, tMeUrlTypeSupergroup)
+}
+
+func (tMeUrlTypeSupergroup *TMeUrlTypeSupergroup) printText(p *printer) {
+	if tMeUrlTypeSupergroup == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TMeUrlTypeSupergroup")
+	p.int("supergroup_id", int64(tMeUrlTypeSupergroup.SupergroupId))
+	p.endObject(state)
+}
+
 // GetTMeUrlTypeEnum return the enum type of this object
 func (tMeUrlTypeSupergroup *TMeUrlTypeSupergroup) GetTMeUrlTypeEnum() TMeUrlTypeEnum {
 	return TMeUrlTypeSupergroupType
 }
 
+// Accept calls the method of the visitor for TMeUrlTypeSupergroup
+func (tMeUrlTypeSupergroup *TMeUrlTypeSupergroup) Accept(visitor TMeUrlTypeVisitor) {
+	visitor.VisitTMeUrlTypeSupergroup(tMeUrlTypeSupergroup)
+}
+
 // TMeUrlTypeChatInvite A chat invite link
 type TMeUrlTypeChatInvite struct {
 	tdCommon
@@ -137,11 +404,126 @@ func NewTMeUrlTypeChatInvite(info *ChatInviteLinkInfo) *TMeUrlTypeChatInvite {
 	return &tMeUrlTypeChatInviteTemp
 }
 
+// MarshalJSON marshals to json
+func (tMeUrlTypeChatInvite *TMeUrlTypeChatInvite) MarshalJSON() ([]byte, error) {
+	return tMeUrlTypeChatInvite.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (tMeUrlTypeChatInvite *TMeUrlTypeChatInvite) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, tMeUrlTypeChatInvite)
+}
+
+func (tMeUrlTypeChatInvite *TMeUrlTypeChatInvite) appendJSON(b []byte) ([]byte, error) {
+	if tMeUrlTypeChatInvite == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, tMeUrlTypeChatInvite.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, tMeUrlTypeChatInvite.tdCommon.Extra)
+	b = append(b, `,"info":`...)
+	b, err = tMeUrlTypeChatInvite.Info.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (tMeUrlTypeChatInvite *TMeUrlTypeChatInvite) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			tMeUrlTypeChatInvite.tdCommon.Type, err = r.readString()
+		case "@extra":
+			tMeUrlTypeChatInvite.tdCommon.Extra, err = r.readString()
+		case "info":
+			if r.readNull() {
+				tMeUrlTypeChatInvite.Info = nil
+			} else {
+				tMeUrlTypeChatInvite.Info = new(ChatInviteLinkInfo)
+				err = tMeUrlTypeChatInvite.Info.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (tMeUrlTypeChatInvite *TMeUrlTypeChatInvite) Clone() *TMeUrlTypeChatInvite {
+	if tMeUrlTypeChatInvite == nil {
+		return nil
+	}
+	clone := *tMeUrlTypeChatInvite
+	clone.Info = tMeUrlTypeChatInvite.Info.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (tMeUrlTypeChatInvite *TMeUrlTypeChatInvite) Equal(other *TMeUrlTypeChatInvite) bool {
+	if tMeUrlTypeChatInvite == nil || other == nil {
+		return tMeUrlTypeChatInvite == other
+	}
+	if !tMeUrlTypeChatInvite.Info.Equal(other.Info) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (tMeUrlTypeChatInvite *TMeUrlTypeChatInvite) Diff(other *TMeUrlTypeChatInvite) []Change {
+	return tMeUrlTypeChatInvite.diff("", other, nil)
+}
+
+func (tMeUrlTypeChatInvite *TMeUrlTypeChatInvite) diff(path string, other *TMeUrlTypeChatInvite, changes []Change) []Change {
+	if tMeUrlTypeChatInvite == nil || other == nil {
+		if tMeUrlTypeChatInvite != other {
+			changes = append(changes, Change{Path: path, Old: tMeUrlTypeChatInvite, New: other})
+		}
+		return changes
+	}
+	changes = tMeUrlTypeChatInvite.Info.diff(fieldPath(path, "info"), other.Info, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (tMeUrlTypeChatInvite *TMeUrlTypeChatInvite) String() string {
+	return objectString(tMeUrlTypeChatInvite)
+}
+
+// LogValue logs the object as its summary
+func (tMeUrlTypeChatInvite *TMeUrlTypeChatInvite) LogValue() slog.Value {
+	return slog.StringValue(objectString(tMeUrlTypeChatInvite))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (tMeUrlTypeChatInvite *TMeUrlTypeChatInvite) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, tMeUrlTypeChatInvite)
+}
+
+func (tMeUrlTypeChatInvite *TMeUrlTypeChatInvite) printText(p *printer) {
+	if tMeUrlTypeChatInvite == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TMeUrlTypeChatInvite")
+	p.object("info", tMeUrlTypeChatInvite.Info)
+	p.endObject(state)
+}
+
 // GetTMeUrlTypeEnum return the enum type of this object
 func (tMeUrlTypeChatInvite *TMeUrlTypeChatInvite) GetTMeUrlTypeEnum() TMeUrlTypeEnum {
 	return TMeUrlTypeChatInviteType
 }
 
+// Accept calls the method of the visitor for TMeUrlTypeChatInvite
+func (tMeUrlTypeChatInvite *TMeUrlTypeChatInvite) Accept(visitor TMeUrlTypeVisitor) {
+	visitor.VisitTMeUrlTypeChatInvite(tMeUrlTypeChatInvite)
+}
+
 // TMeUrlTypeStickerSet A URL linking to a sticker set
 type TMeUrlTypeStickerSet struct {
 	tdCommon
@@ -165,7 +547,239 @@ func NewTMeUrlTypeStickerSet(stickerSetId JSONInt64) *TMeUrlTypeStickerSet {
 	return &tMeUrlTypeStickerSetTemp
 }
 
+// MarshalJSON marshals to json
+func (tMeUrlTypeStickerSet *TMeUrlTypeStickerSet) MarshalJSON() ([]byte, error) {
+	return tMeUrlTypeStickerSet.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (tMeUrlTypeStickerSet *TMeUrlTypeStickerSet) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, tMeUrlTypeStickerSet)
+}
+
+func (tMeUrlTypeStickerSet *TMeUrlTypeStickerSet) appendJSON(b []byte) ([]byte, error) {
+	if tMeUrlTypeStickerSet == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, tMeUrlTypeStickerSet.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, tMeUrlTypeStickerSet.tdCommon.Extra)
+	b = append(b, `,"sticker_set_id":`...)
+	b = appendJSONInt64String(b, int64(tMeUrlTypeStickerSet.StickerSetId))
+	return append(b, '}'), nil
+}
+
+func (tMeUrlTypeStickerSet *TMeUrlTypeStickerSet) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			tMeUrlTypeStickerSet.tdCommon.Type, err = r.readString()
+		case "@extra":
+			tMeUrlTypeStickerSet.tdCommon.Extra, err = r.readString()
+		case "sticker_set_id":
+			tMeUrlTypeStickerSet.StickerSetId, err = r.readJSONInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (tMeUrlTypeStickerSet *TMeUrlTypeStickerSet) Clone() *TMeUrlTypeStickerSet {
+	if tMeUrlTypeStickerSet == nil {
+		return nil
+	}
+	clone := *tMeUrlTypeStickerSet
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (tMeUrlTypeStickerSet *TMeUrlTypeStickerSet) Equal(other *TMeUrlTypeStickerSet) bool {
+	if tMeUrlTypeStickerSet == nil || other == nil {
+		return tMeUrlTypeStickerSet == other
+	}
+	if tMeUrlTypeStickerSet.StickerSetId != other.StickerSetId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (tMeUrlTypeStickerSet *TMeUrlTypeStickerSet) Diff(other *TMeUrlTypeStickerSet) []Change {
+	return tMeUrlTypeStickerSet.diff("", other, nil)
+}
+
+func (tMeUrlTypeStickerSet *TMeUrlTypeStickerSet) diff(path string, other *TMeUrlTypeStickerSet, changes []Change) []Change {
+	if tMeUrlTypeStickerSet == nil || other == nil {
+		if tMeUrlTypeStickerSet != other {
+			changes = append(changes, Change{Path: path, Old: tMeUrlTypeStickerSet, New: other})
+		}
+		return changes
+	}
+	if tMeUrlTypeStickerSet.StickerSetId != other.StickerSetId {
+		changes = append(changes, Change{Path: fieldPath(path, "sticker_set_id"), Old: tMeUrlTypeStickerSet.StickerSetId, New: other.StickerSetId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (tMeUrlTypeStickerSet *TMeUrlTypeStickerSet) String() string {
+	return objectString(tMeUrlTypeStickerSet)
+}
+
+// LogValue logs the object as its summary
+func (tMeUrlTypeStickerSet *TMeUrlTypeStickerSet) LogValue() slog.Value {
+	return slog.StringValue(objectString(tMeUrlTypeStickerSet))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (tMeUrlTypeStickerSet *TMeUrlTypeStickerSet) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, tMeUrlTypeStickerSet)
+}
+
+func (tMeUrlTypeStickerSet *TMeUrlTypeStickerSet) printText(p *printer) {
+	if tMeUrlTypeStickerSet == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TMeUrlTypeStickerSet")
+	p.int("sticker_set_id", int64(tMeUrlTypeStickerSet.StickerSetId))
+	p.endObject(state)
+}
+
 // GetTMeUrlTypeEnum return the enum type of this object
 func (tMeUrlTypeStickerSet *TMeUrlTypeStickerSet) GetTMeUrlTypeEnum() TMeUrlTypeEnum {
 	return TMeUrlTypeStickerSetType
 }
+
+// Accept calls the method of the visitor for TMeUrlTypeStickerSet
+func (tMeUrlTypeStickerSet *TMeUrlTypeStickerSet) Accept(visitor TMeUrlTypeVisitor) {
+	visitor.VisitTMeUrlTypeStickerSet(tMeUrlTypeStickerSet)
+}
+
+// TMeUrlTypeVisitor visits every type of TMeUrlType, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to TMeUrlType, until they handle it too.
+type TMeUrlTypeVisitor interface {
+	VisitTMeUrlTypeUser(tMeUrlTypeUser *TMeUrlTypeUser)
+	VisitTMeUrlTypeSupergroup(tMeUrlTypeSupergroup *TMeUrlTypeSupergroup)
+	VisitTMeUrlTypeChatInvite(tMeUrlTypeChatInvite *TMeUrlTypeChatInvite)
+	VisitTMeUrlTypeStickerSet(tMeUrlTypeStickerSet *TMeUrlTypeStickerSet)
+	VisitUnknownTMeUrlType(unknownTMeUrlType *UnknownTMeUrlType)
+}
+
+// TMeUrlTypeCases holds the function MatchTMeUrlType calls for each type of TMeUrlType;
+// types without a function are passed to the default case
+type TMeUrlTypeCases[R any] struct {
+	TMeUrlTypeUser       func(tMeUrlTypeUser *TMeUrlTypeUser) R
+	TMeUrlTypeSupergroup func(tMeUrlTypeSupergroup *TMeUrlTypeSupergroup) R
+	TMeUrlTypeChatInvite func(tMeUrlTypeChatInvite *TMeUrlTypeChatInvite) R
+	TMeUrlTypeStickerSet func(tMeUrlTypeStickerSet *TMeUrlTypeStickerSet) R
+}
+
+// MatchTMeUrlType calls the case of the type of tMeUrlType, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement TMeUrlTypeVisitor
+// to have the compiler check every type is handled.
+func MatchTMeUrlType[R any](tMeUrlType TMeUrlType, cases TMeUrlTypeCases[R], defaultCase func(tMeUrlType TMeUrlType) R) R {
+	switch value := tMeUrlType.(type) {
+	case *TMeUrlTypeUser:
+		if cases.TMeUrlTypeUser != nil {
+			return cases.TMeUrlTypeUser(value)
+		}
+	case *TMeUrlTypeSupergroup:
+		if cases.TMeUrlTypeSupergroup != nil {
+			return cases.TMeUrlTypeSupergroup(value)
+		}
+	case *TMeUrlTypeChatInvite:
+		if cases.TMeUrlTypeChatInvite != nil {
+			return cases.TMeUrlTypeChatInvite(value)
+		}
+	case *TMeUrlTypeStickerSet:
+		if cases.TMeUrlTypeStickerSet != nil {
+			return cases.TMeUrlTypeStickerSet(value)
+		}
+	}
+	return defaultCase(tMeUrlType)
+}
+
+// Clone returns a deep copy of the object
+func (unknownTMeUrlType *UnknownTMeUrlType) Clone() *UnknownTMeUrlType {
+	if unknownTMeUrlType == nil {
+		return nil
+	}
+	return &UnknownTMeUrlType{Unknown: unknownTMeUrlType.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownTMeUrlType *UnknownTMeUrlType) Equal(other *UnknownTMeUrlType) bool {
+	if unknownTMeUrlType == nil || other == nil {
+		return unknownTMeUrlType == other
+	}
+	return unknownTMeUrlType.Unknown.equal(other.Unknown)
+}
+
+func cloneTMeUrlType(tMeUrlType TMeUrlType) TMeUrlType {
+	switch value := tMeUrlType.(type) {
+	case *TMeUrlTypeUser:
+		return value.Clone()
+	case *TMeUrlTypeSupergroup:
+		return value.Clone()
+	case *TMeUrlTypeChatInvite:
+		return value.Clone()
+	case *TMeUrlTypeStickerSet:
+		return value.Clone()
+	case *UnknownTMeUrlType:
+		return value.Clone()
+	}
+	return tMeUrlType
+}
+
+func equalTMeUrlType(a TMeUrlType, b TMeUrlType) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *TMeUrlTypeUser:
+		b, ok := b.(*TMeUrlTypeUser)
+		return ok && a.Equal(b)
+	case *TMeUrlTypeSupergroup:
+		b, ok := b.(*TMeUrlTypeSupergroup)
+		return ok && a.Equal(b)
+	case *TMeUrlTypeChatInvite:
+		b, ok := b.(*TMeUrlTypeChatInvite)
+		return ok && a.Equal(b)
+	case *TMeUrlTypeStickerSet:
+		b, ok := b.(*TMeUrlTypeStickerSet)
+		return ok && a.Equal(b)
+	case *UnknownTMeUrlType:
+		b, ok := b.(*UnknownTMeUrlType)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffTMeUrlType(path string, a TMeUrlType, b TMeUrlType, changes []Change) []Change {
+	switch a := a.(type) {
+	case *TMeUrlTypeUser:
+		if b, ok := b.(*TMeUrlTypeUser); ok {
+			return a.diff(path, b, changes)
+		}
+	case *TMeUrlTypeSupergroup:
+		if b, ok := b.(*TMeUrlTypeSupergroup); ok {
+			return a.diff(path, b, changes)
+		}
+	case *TMeUrlTypeChatInvite:
+		if b, ok := b.(*TMeUrlTypeChatInvite); ok {
+			return a.diff(path, b, changes)
+		}
+	case *TMeUrlTypeStickerSet:
+		if b, ok := b.(*TMeUrlTypeStickerSet); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalTMeUrlType(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // TMeUrls Contains a list of t.me URLs
@@ -28,6 +28,150 @@ func NewTMeUrls(urls []TMeUrl) *TMeUrls {
 	return &tMeUrlsTemp
 }
 
+// MarshalJSON marshals to json
+func (tMeUrls *TMeUrls) MarshalJSON() ([]byte, error) {
+	return tMeUrls.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (tMeUrls *TMeUrls) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, tMeUrls)
+}
+
+func (tMeUrls *TMeUrls) appendJSON(b []byte) ([]byte, error) {
+	if tMeUrls == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, tMeUrls.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, tMeUrls.tdCommon.Extra)
+	b = append(b, `,"urls":`...)
+	if tMeUrls.Urls == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range tMeUrls.Urls {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = tMeUrls.Urls[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (tMeUrls *TMeUrls) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			tMeUrls.tdCommon.Type, err = r.readString()
+		case "@extra":
+			tMeUrls.tdCommon.Extra, err = r.readString()
+		case "urls":
+			if r.readNull() {
+				tMeUrls.Urls = nil
+			} else {
+				tMeUrls.Urls = make([]TMeUrl, 0)
+				err = r.readArray(func() (err error) {
+					var item0 TMeUrl
+					err = item0.decodeJSON(r)
+					tMeUrls.Urls = append(tMeUrls.Urls, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (tMeUrls *TMeUrls) Clone() *TMeUrls {
+	if tMeUrls == nil {
+		return nil
+	}
+	clone := *tMeUrls
+	if tMeUrls.Urls != nil {
+		clone.Urls = make([]TMeUrl, len(tMeUrls.Urls))
+		for i0 := range tMeUrls.Urls {
+			clone.Urls[i0] = *tMeUrls.Urls[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (tMeUrls *TMeUrls) Equal(other *TMeUrls) bool {
+	if tMeUrls == nil || other == nil {
+		return tMeUrls == other
+	}
+	if len(tMeUrls.Urls) != len(other.Urls) {
+		return false
+	}
+	for i0 := range tMeUrls.Urls {
+		if !tMeUrls.Urls[i0].Equal(&other.Urls[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (tMeUrls *TMeUrls) Diff(other *TMeUrls) []Change {
+	return tMeUrls.diff("", other, nil)
+}
+
+func (tMeUrls *TMeUrls) diff(path string, other *TMeUrls, changes []Change) []Change {
+	if tMeUrls == nil || other == nil {
+		if tMeUrls != other {
+			changes = append(changes, Change{Path: path, Old: tMeUrls, New: other})
+		}
+		return changes
+	}
+	if len(tMeUrls.Urls) != len(other.Urls) {
+		changes = append(changes, Change{Path: fieldPath(path, "urls"), Old: tMeUrls.Urls, New: other.Urls})
+	} else {
+		for i0 := range tMeUrls.Urls {
+			changes = tMeUrls.Urls[i0].diff(indexPath(fieldPath(path, "urls"), i0), &other.Urls[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (tMeUrls *TMeUrls) String() string {
+	return objectString(tMeUrls)
+}
+
+// LogValue logs the object as its summary
+func (tMeUrls *TMeUrls) LogValue() slog.Value {
+	return slog.StringValue(objectString(tMeUrls))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (tMeUrls *TMeUrls) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, tMeUrls)
+}
+
+func (tMeUrls *TMeUrls) printText(p *printer) {
+	if tMeUrls == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TMeUrls")
+	p.vector("urls", len(tMeUrls.Urls), func(i0 int) {
+		tMeUrls.Urls[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetRecentlyVisitedTMeUrls Returns t.me URLs recently visited by a newly registered user
 // @param referrer Google Play referrer to identify the user
 func (client *Client) GetRecentlyVisitedTMeUrls(referrer string) (*TMeUrls, error) {
@@ -40,11 +184,11 @@ func (client *Client) GetRecentlyVisitedTMeUrls(referrer string) (*TMeUrls, erro
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var tMeUrls TMeUrls
-	err = json.Unmarshal(result.Raw, &tMeUrls)
+	err = jsonUnmarshal(result.Raw, &tMeUrls)
 	return &tMeUrls, err
 }
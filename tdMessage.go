@@ -0,0 +1,4773 @@
+package tdlib
+
+// unmarshalTdMessage decodes an object of the given @type into its generated type
+func unmarshalTdMessage(typeName string, rawMsg []byte) (TdMessage, error) {
+	switch typeName {
+	case "accountTtl":
+		var accountTtl AccountTtl
+		err := jsonUnmarshal(rawMsg, &accountTtl)
+		return &accountTtl, err
+
+	case "address":
+		var address Address
+		err := jsonUnmarshal(rawMsg, &address)
+		return &address, err
+
+	case "animatedChatPhoto":
+		var animatedChatPhoto AnimatedChatPhoto
+		err := jsonUnmarshal(rawMsg, &animatedChatPhoto)
+		return &animatedChatPhoto, err
+
+	case "animatedEmoji":
+		var animatedEmoji AnimatedEmoji
+		err := jsonUnmarshal(rawMsg, &animatedEmoji)
+		return &animatedEmoji, err
+
+	case "animation":
+		var animation Animation
+		err := jsonUnmarshal(rawMsg, &animation)
+		return &animation, err
+
+	case "animations":
+		var animations Animations
+		err := jsonUnmarshal(rawMsg, &animations)
+		return &animations, err
+
+	case "audio":
+		var audio Audio
+		err := jsonUnmarshal(rawMsg, &audio)
+		return &audio, err
+
+	case "authenticationCodeInfo":
+		var authenticationCodeInfo AuthenticationCodeInfo
+		err := jsonUnmarshal(rawMsg, &authenticationCodeInfo)
+		return &authenticationCodeInfo, err
+
+	case "authenticationCodeTypeTelegramMessage":
+		var authenticationCodeTypeTelegramMessage AuthenticationCodeTypeTelegramMessage
+		err := jsonUnmarshal(rawMsg, &authenticationCodeTypeTelegramMessage)
+		return &authenticationCodeTypeTelegramMessage, err
+
+	case "authenticationCodeTypeSms":
+		var authenticationCodeTypeSms AuthenticationCodeTypeSms
+		err := jsonUnmarshal(rawMsg, &authenticationCodeTypeSms)
+		return &authenticationCodeTypeSms, err
+
+	case "authenticationCodeTypeCall":
+		var authenticationCodeTypeCall AuthenticationCodeTypeCall
+		err := jsonUnmarshal(rawMsg, &authenticationCodeTypeCall)
+		return &authenticationCodeTypeCall, err
+
+	case "authenticationCodeTypeFlashCall":
+		var authenticationCodeTypeFlashCall AuthenticationCodeTypeFlashCall
+		err := jsonUnmarshal(rawMsg, &authenticationCodeTypeFlashCall)
+		return &authenticationCodeTypeFlashCall, err
+
+	case "authenticationCodeTypeMissedCall":
+		var authenticationCodeTypeMissedCall AuthenticationCodeTypeMissedCall
+		err := jsonUnmarshal(rawMsg, &authenticationCodeTypeMissedCall)
+		return &authenticationCodeTypeMissedCall, err
+
+	case "authorizationStateWaitTdlibParameters":
+		var authorizationStateWaitTdlibParameters AuthorizationStateWaitTdlibParameters
+		err := jsonUnmarshal(rawMsg, &authorizationStateWaitTdlibParameters)
+		return &authorizationStateWaitTdlibParameters, err
+
+	case "authorizationStateWaitEncryptionKey":
+		var authorizationStateWaitEncryptionKey AuthorizationStateWaitEncryptionKey
+		err := jsonUnmarshal(rawMsg, &authorizationStateWaitEncryptionKey)
+		return &authorizationStateWaitEncryptionKey, err
+
+	case "authorizationStateWaitPhoneNumber":
+		var authorizationStateWaitPhoneNumber AuthorizationStateWaitPhoneNumber
+		err := jsonUnmarshal(rawMsg, &authorizationStateWaitPhoneNumber)
+		return &authorizationStateWaitPhoneNumber, err
+
+	case "authorizationStateWaitCode":
+		var authorizationStateWaitCode AuthorizationStateWaitCode
+		err := jsonUnmarshal(rawMsg, &authorizationStateWaitCode)
+		return &authorizationStateWaitCode, err
+
+	case "authorizationStateWaitOtherDeviceConfirmation":
+		var authorizationStateWaitOtherDeviceConfirmation AuthorizationStateWaitOtherDeviceConfirmation
+		err := jsonUnmarshal(rawMsg, &authorizationStateWaitOtherDeviceConfirmation)
+		return &authorizationStateWaitOtherDeviceConfirmation, err
+
+	case "authorizationStateWaitRegistration":
+		var authorizationStateWaitRegistration AuthorizationStateWaitRegistration
+		err := jsonUnmarshal(rawMsg, &authorizationStateWaitRegistration)
+		return &authorizationStateWaitRegistration, err
+
+	case "authorizationStateWaitPassword":
+		var authorizationStateWaitPassword AuthorizationStateWaitPassword
+		err := jsonUnmarshal(rawMsg, &authorizationStateWaitPassword)
+		return &authorizationStateWaitPassword, err
+
+	case "authorizationStateReady":
+		var authorizationStateReady AuthorizationStateReady
+		err := jsonUnmarshal(rawMsg, &authorizationStateReady)
+		return &authorizationStateReady, err
+
+	case "authorizationStateLoggingOut":
+		var authorizationStateLoggingOut AuthorizationStateLoggingOut
+		err := jsonUnmarshal(rawMsg, &authorizationStateLoggingOut)
+		return &authorizationStateLoggingOut, err
+
+	case "authorizationStateClosing":
+		var authorizationStateClosing AuthorizationStateClosing
+		err := jsonUnmarshal(rawMsg, &authorizationStateClosing)
+		return &authorizationStateClosing, err
+
+	case "authorizationStateClosed":
+		var authorizationStateClosed AuthorizationStateClosed
+		err := jsonUnmarshal(rawMsg, &authorizationStateClosed)
+		return &authorizationStateClosed, err
+
+	case "autoDownloadSettings":
+		var autoDownloadSettings AutoDownloadSettings
+		err := jsonUnmarshal(rawMsg, &autoDownloadSettings)
+		return &autoDownloadSettings, err
+
+	case "autoDownloadSettingsPresets":
+		var autoDownloadSettingsPresets AutoDownloadSettingsPresets
+		err := jsonUnmarshal(rawMsg, &autoDownloadSettingsPresets)
+		return &autoDownloadSettingsPresets, err
+
+	case "background":
+		var background Background
+		err := jsonUnmarshal(rawMsg, &background)
+		return &background, err
+
+	case "backgroundFillSolid":
+		var backgroundFillSolid BackgroundFillSolid
+		err := jsonUnmarshal(rawMsg, &backgroundFillSolid)
+		return &backgroundFillSolid, err
+
+	case "backgroundFillGradient":
+		var backgroundFillGradient BackgroundFillGradient
+		err := jsonUnmarshal(rawMsg, &backgroundFillGradient)
+		return &backgroundFillGradient, err
+
+	case "backgroundFillFreeformGradient":
+		var backgroundFillFreeformGradient BackgroundFillFreeformGradient
+		err := jsonUnmarshal(rawMsg, &backgroundFillFreeformGradient)
+		return &backgroundFillFreeformGradient, err
+
+	case "backgroundTypeWallpaper":
+		var backgroundTypeWallpaper BackgroundTypeWallpaper
+		err := jsonUnmarshal(rawMsg, &backgroundTypeWallpaper)
+		return &backgroundTypeWallpaper, err
+
+	case "backgroundTypePattern":
+		var backgroundTypePattern BackgroundTypePattern
+		err := jsonUnmarshal(rawMsg, &backgroundTypePattern)
+		return &backgroundTypePattern, err
+
+	case "backgroundTypeFill":
+		var backgroundTypeFill BackgroundTypeFill
+		err := jsonUnmarshal(rawMsg, &backgroundTypeFill)
+		return &backgroundTypeFill, err
+
+	case "backgrounds":
+		var backgrounds Backgrounds
+		err := jsonUnmarshal(rawMsg, &backgrounds)
+		return &backgrounds, err
+
+	case "bankCardActionOpenUrl":
+		var bankCardActionOpenUrl BankCardActionOpenUrl
+		err := jsonUnmarshal(rawMsg, &bankCardActionOpenUrl)
+		return &bankCardActionOpenUrl, err
+
+	case "bankCardInfo":
+		var bankCardInfo BankCardInfo
+		err := jsonUnmarshal(rawMsg, &bankCardInfo)
+		return &bankCardInfo, err
+
+	case "basicGroup":
+		var basicGroup BasicGroup
+		err := jsonUnmarshal(rawMsg, &basicGroup)
+		return &basicGroup, err
+
+	case "basicGroupFullInfo":
+		var basicGroupFullInfo BasicGroupFullInfo
+		err := jsonUnmarshal(rawMsg, &basicGroupFullInfo)
+		return &basicGroupFullInfo, err
+
+	case "botCommand":
+		var botCommand BotCommand
+		err := jsonUnmarshal(rawMsg, &botCommand)
+		return &botCommand, err
+
+	case "botCommandScopeDefault":
+		var botCommandScopeDefault BotCommandScopeDefault
+		err := jsonUnmarshal(rawMsg, &botCommandScopeDefault)
+		return &botCommandScopeDefault, err
+
+	case "botCommandScopeAllPrivateChats":
+		var botCommandScopeAllPrivateChats BotCommandScopeAllPrivateChats
+		err := jsonUnmarshal(rawMsg, &botCommandScopeAllPrivateChats)
+		return &botCommandScopeAllPrivateChats, err
+
+	case "botCommandScopeAllGroupChats":
+		var botCommandScopeAllGroupChats BotCommandScopeAllGroupChats
+		err := jsonUnmarshal(rawMsg, &botCommandScopeAllGroupChats)
+		return &botCommandScopeAllGroupChats, err
+
+	case "botCommandScopeAllChatAdministrators":
+		var botCommandScopeAllChatAdministrators BotCommandScopeAllChatAdministrators
+		err := jsonUnmarshal(rawMsg, &botCommandScopeAllChatAdministrators)
+		return &botCommandScopeAllChatAdministrators, err
+
+	case "botCommandScopeChat":
+		var botCommandScopeChat BotCommandScopeChat
+		err := jsonUnmarshal(rawMsg, &botCommandScopeChat)
+		return &botCommandScopeChat, err
+
+	case "botCommandScopeChatAdministrators":
+		var botCommandScopeChatAdministrators BotCommandScopeChatAdministrators
+		err := jsonUnmarshal(rawMsg, &botCommandScopeChatAdministrators)
+		return &botCommandScopeChatAdministrators, err
+
+	case "botCommandScopeChatMember":
+		var botCommandScopeChatMember BotCommandScopeChatMember
+		err := jsonUnmarshal(rawMsg, &botCommandScopeChatMember)
+		return &botCommandScopeChatMember, err
+
+	case "botCommands":
+		var botCommands BotCommands
+		err := jsonUnmarshal(rawMsg, &botCommands)
+		return &botCommands, err
+
+	case "call":
+		var call Call
+		err := jsonUnmarshal(rawMsg, &call)
+		return &call, err
+
+	case "callDiscardReasonEmpty":
+		var callDiscardReasonEmpty CallDiscardReasonEmpty
+		err := jsonUnmarshal(rawMsg, &callDiscardReasonEmpty)
+		return &callDiscardReasonEmpty, err
+
+	case "callDiscardReasonMissed":
+		var callDiscardReasonMissed CallDiscardReasonMissed
+		err := jsonUnmarshal(rawMsg, &callDiscardReasonMissed)
+		return &callDiscardReasonMissed, err
+
+	case "callDiscardReasonDeclined":
+		var callDiscardReasonDeclined CallDiscardReasonDeclined
+		err := jsonUnmarshal(rawMsg, &callDiscardReasonDeclined)
+		return &callDiscardReasonDeclined, err
+
+	case "callDiscardReasonDisconnected":
+		var callDiscardReasonDisconnected CallDiscardReasonDisconnected
+		err := jsonUnmarshal(rawMsg, &callDiscardReasonDisconnected)
+		return &callDiscardReasonDisconnected, err
+
+	case "callDiscardReasonHungUp":
+		var callDiscardReasonHungUp CallDiscardReasonHungUp
+		err := jsonUnmarshal(rawMsg, &callDiscardReasonHungUp)
+		return &callDiscardReasonHungUp, err
+
+	case "callId":
+		var callId CallId
+		err := jsonUnmarshal(rawMsg, &callId)
+		return &callId, err
+
+	case "callProblemEcho":
+		var callProblemEcho CallProblemEcho
+		err := jsonUnmarshal(rawMsg, &callProblemEcho)
+		return &callProblemEcho, err
+
+	case "callProblemNoise":
+		var callProblemNoise CallProblemNoise
+		err := jsonUnmarshal(rawMsg, &callProblemNoise)
+		return &callProblemNoise, err
+
+	case "callProblemInterruptions":
+		var callProblemInterruptions CallProblemInterruptions
+		err := jsonUnmarshal(rawMsg, &callProblemInterruptions)
+		return &callProblemInterruptions, err
+
+	case "callProblemDistortedSpeech":
+		var callProblemDistortedSpeech CallProblemDistortedSpeech
+		err := jsonUnmarshal(rawMsg, &callProblemDistortedSpeech)
+		return &callProblemDistortedSpeech, err
+
+	case "callProblemSilentLocal":
+		var callProblemSilentLocal CallProblemSilentLocal
+		err := jsonUnmarshal(rawMsg, &callProblemSilentLocal)
+		return &callProblemSilentLocal, err
+
+	case "callProblemSilentRemote":
+		var callProblemSilentRemote CallProblemSilentRemote
+		err := jsonUnmarshal(rawMsg, &callProblemSilentRemote)
+		return &callProblemSilentRemote, err
+
+	case "callProblemDropped":
+		var callProblemDropped CallProblemDropped
+		err := jsonUnmarshal(rawMsg, &callProblemDropped)
+		return &callProblemDropped, err
+
+	case "callProblemDistortedVideo":
+		var callProblemDistortedVideo CallProblemDistortedVideo
+		err := jsonUnmarshal(rawMsg, &callProblemDistortedVideo)
+		return &callProblemDistortedVideo, err
+
+	case "callProblemPixelatedVideo":
+		var callProblemPixelatedVideo CallProblemPixelatedVideo
+		err := jsonUnmarshal(rawMsg, &callProblemPixelatedVideo)
+		return &callProblemPixelatedVideo, err
+
+	case "callProtocol":
+		var callProtocol CallProtocol
+		err := jsonUnmarshal(rawMsg, &callProtocol)
+		return &callProtocol, err
+
+	case "callServer":
+		var callServer CallServer
+		err := jsonUnmarshal(rawMsg, &callServer)
+		return &callServer, err
+
+	case "callServerTypeTelegramReflector":
+		var callServerTypeTelegramReflector CallServerTypeTelegramReflector
+		err := jsonUnmarshal(rawMsg, &callServerTypeTelegramReflector)
+		return &callServerTypeTelegramReflector, err
+
+	case "callServerTypeWebrtc":
+		var callServerTypeWebrtc CallServerTypeWebrtc
+		err := jsonUnmarshal(rawMsg, &callServerTypeWebrtc)
+		return &callServerTypeWebrtc, err
+
+	case "callStatePending":
+		var callStatePending CallStatePending
+		err := jsonUnmarshal(rawMsg, &callStatePending)
+		return &callStatePending, err
+
+	case "callStateExchangingKeys":
+		var callStateExchangingKeys CallStateExchangingKeys
+		err := jsonUnmarshal(rawMsg, &callStateExchangingKeys)
+		return &callStateExchangingKeys, err
+
+	case "callStateReady":
+		var callStateReady CallStateReady
+		err := jsonUnmarshal(rawMsg, &callStateReady)
+		return &callStateReady, err
+
+	case "callStateHangingUp":
+		var callStateHangingUp CallStateHangingUp
+		err := jsonUnmarshal(rawMsg, &callStateHangingUp)
+		return &callStateHangingUp, err
+
+	case "callStateDiscarded":
+		var callStateDiscarded CallStateDiscarded
+		err := jsonUnmarshal(rawMsg, &callStateDiscarded)
+		return &callStateDiscarded, err
+
+	case "callStateError":
+		var callStateError CallStateError
+		err := jsonUnmarshal(rawMsg, &callStateError)
+		return &callStateError, err
+
+	case "callbackQueryAnswer":
+		var callbackQueryAnswer CallbackQueryAnswer
+		err := jsonUnmarshal(rawMsg, &callbackQueryAnswer)
+		return &callbackQueryAnswer, err
+
+	case "callbackQueryPayloadData":
+		var callbackQueryPayloadData CallbackQueryPayloadData
+		err := jsonUnmarshal(rawMsg, &callbackQueryPayloadData)
+		return &callbackQueryPayloadData, err
+
+	case "callbackQueryPayloadDataWithPassword":
+		var callbackQueryPayloadDataWithPassword CallbackQueryPayloadDataWithPassword
+		err := jsonUnmarshal(rawMsg, &callbackQueryPayloadDataWithPassword)
+		return &callbackQueryPayloadDataWithPassword, err
+
+	case "callbackQueryPayloadGame":
+		var callbackQueryPayloadGame CallbackQueryPayloadGame
+		err := jsonUnmarshal(rawMsg, &callbackQueryPayloadGame)
+		return &callbackQueryPayloadGame, err
+
+	case "canTransferOwnershipResultOk":
+		var canTransferOwnershipResultOk CanTransferOwnershipResultOk
+		err := jsonUnmarshal(rawMsg, &canTransferOwnershipResultOk)
+		return &canTransferOwnershipResultOk, err
+
+	case "canTransferOwnershipResultPasswordNeeded":
+		var canTransferOwnershipResultPasswordNeeded CanTransferOwnershipResultPasswordNeeded
+		err := jsonUnmarshal(rawMsg, &canTransferOwnershipResultPasswordNeeded)
+		return &canTransferOwnershipResultPasswordNeeded, err
+
+	case "canTransferOwnershipResultPasswordTooFresh":
+		var canTransferOwnershipResultPasswordTooFresh CanTransferOwnershipResultPasswordTooFresh
+		err := jsonUnmarshal(rawMsg, &canTransferOwnershipResultPasswordTooFresh)
+		return &canTransferOwnershipResultPasswordTooFresh, err
+
+	case "canTransferOwnershipResultSessionTooFresh":
+		var canTransferOwnershipResultSessionTooFresh CanTransferOwnershipResultSessionTooFresh
+		err := jsonUnmarshal(rawMsg, &canTransferOwnershipResultSessionTooFresh)
+		return &canTransferOwnershipResultSessionTooFresh, err
+
+	case "chat":
+		var chat Chat
+		err := jsonUnmarshal(rawMsg, &chat)
+		return &chat, err
+
+	case "chatActionTyping":
+		var chatActionTyping ChatActionTyping
+		err := jsonUnmarshal(rawMsg, &chatActionTyping)
+		return &chatActionTyping, err
+
+	case "chatActionRecordingVideo":
+		var chatActionRecordingVideo ChatActionRecordingVideo
+		err := jsonUnmarshal(rawMsg, &chatActionRecordingVideo)
+		return &chatActionRecordingVideo, err
+
+	case "chatActionUploadingVideo":
+		var chatActionUploadingVideo ChatActionUploadingVideo
+		err := jsonUnmarshal(rawMsg, &chatActionUploadingVideo)
+		return &chatActionUploadingVideo, err
+
+	case "chatActionRecordingVoiceNote":
+		var chatActionRecordingVoiceNote ChatActionRecordingVoiceNote
+		err := jsonUnmarshal(rawMsg, &chatActionRecordingVoiceNote)
+		return &chatActionRecordingVoiceNote, err
+
+	case "chatActionUploadingVoiceNote":
+		var chatActionUploadingVoiceNote ChatActionUploadingVoiceNote
+		err := jsonUnmarshal(rawMsg, &chatActionUploadingVoiceNote)
+		return &chatActionUploadingVoiceNote, err
+
+	case "chatActionUploadingPhoto":
+		var chatActionUploadingPhoto ChatActionUploadingPhoto
+		err := jsonUnmarshal(rawMsg, &chatActionUploadingPhoto)
+		return &chatActionUploadingPhoto, err
+
+	case "chatActionUploadingDocument":
+		var chatActionUploadingDocument ChatActionUploadingDocument
+		err := jsonUnmarshal(rawMsg, &chatActionUploadingDocument)
+		return &chatActionUploadingDocument, err
+
+	case "chatActionChoosingSticker":
+		var chatActionChoosingSticker ChatActionChoosingSticker
+		err := jsonUnmarshal(rawMsg, &chatActionChoosingSticker)
+		return &chatActionChoosingSticker, err
+
+	case "chatActionChoosingLocation":
+		var chatActionChoosingLocation ChatActionChoosingLocation
+		err := jsonUnmarshal(rawMsg, &chatActionChoosingLocation)
+		return &chatActionChoosingLocation, err
+
+	case "chatActionChoosingContact":
+		var chatActionChoosingContact ChatActionChoosingContact
+		err := jsonUnmarshal(rawMsg, &chatActionChoosingContact)
+		return &chatActionChoosingContact, err
+
+	case "chatActionStartPlayingGame":
+		var chatActionStartPlayingGame ChatActionStartPlayingGame
+		err := jsonUnmarshal(rawMsg, &chatActionStartPlayingGame)
+		return &chatActionStartPlayingGame, err
+
+	case "chatActionRecordingVideoNote":
+		var chatActionRecordingVideoNote ChatActionRecordingVideoNote
+		err := jsonUnmarshal(rawMsg, &chatActionRecordingVideoNote)
+		return &chatActionRecordingVideoNote, err
+
+	case "chatActionUploadingVideoNote":
+		var chatActionUploadingVideoNote ChatActionUploadingVideoNote
+		err := jsonUnmarshal(rawMsg, &chatActionUploadingVideoNote)
+		return &chatActionUploadingVideoNote, err
+
+	case "chatActionWatchingAnimations":
+		var chatActionWatchingAnimations ChatActionWatchingAnimations
+		err := jsonUnmarshal(rawMsg, &chatActionWatchingAnimations)
+		return &chatActionWatchingAnimations, err
+
+	case "chatActionCancel":
+		var chatActionCancel ChatActionCancel
+		err := jsonUnmarshal(rawMsg, &chatActionCancel)
+		return &chatActionCancel, err
+
+	case "chatActionBarReportSpam":
+		var chatActionBarReportSpam ChatActionBarReportSpam
+		err := jsonUnmarshal(rawMsg, &chatActionBarReportSpam)
+		return &chatActionBarReportSpam, err
+
+	case "chatActionBarReportUnrelatedLocation":
+		var chatActionBarReportUnrelatedLocation ChatActionBarReportUnrelatedLocation
+		err := jsonUnmarshal(rawMsg, &chatActionBarReportUnrelatedLocation)
+		return &chatActionBarReportUnrelatedLocation, err
+
+	case "chatActionBarInviteMembers":
+		var chatActionBarInviteMembers ChatActionBarInviteMembers
+		err := jsonUnmarshal(rawMsg, &chatActionBarInviteMembers)
+		return &chatActionBarInviteMembers, err
+
+	case "chatActionBarReportAddBlock":
+		var chatActionBarReportAddBlock ChatActionBarReportAddBlock
+		err := jsonUnmarshal(rawMsg, &chatActionBarReportAddBlock)
+		return &chatActionBarReportAddBlock, err
+
+	case "chatActionBarAddContact":
+		var chatActionBarAddContact ChatActionBarAddContact
+		err := jsonUnmarshal(rawMsg, &chatActionBarAddContact)
+		return &chatActionBarAddContact, err
+
+	case "chatActionBarSharePhoneNumber":
+		var chatActionBarSharePhoneNumber ChatActionBarSharePhoneNumber
+		err := jsonUnmarshal(rawMsg, &chatActionBarSharePhoneNumber)
+		return &chatActionBarSharePhoneNumber, err
+
+	case "chatActionBarJoinRequest":
+		var chatActionBarJoinRequest ChatActionBarJoinRequest
+		err := jsonUnmarshal(rawMsg, &chatActionBarJoinRequest)
+		return &chatActionBarJoinRequest, err
+
+	case "chatAdministrator":
+		var chatAdministrator ChatAdministrator
+		err := jsonUnmarshal(rawMsg, &chatAdministrator)
+		return &chatAdministrator, err
+
+	case "chatAdministrators":
+		var chatAdministrators ChatAdministrators
+		err := jsonUnmarshal(rawMsg, &chatAdministrators)
+		return &chatAdministrators, err
+
+	case "chatEvent":
+		var chatEvent ChatEvent
+		err := jsonUnmarshal(rawMsg, &chatEvent)
+		return &chatEvent, err
+
+	case "chatEventMessageEdited":
+		var chatEventMessageEdited ChatEventMessageEdited
+		err := jsonUnmarshal(rawMsg, &chatEventMessageEdited)
+		return &chatEventMessageEdited, err
+
+	case "chatEventMessageDeleted":
+		var chatEventMessageDeleted ChatEventMessageDeleted
+		err := jsonUnmarshal(rawMsg, &chatEventMessageDeleted)
+		return &chatEventMessageDeleted, err
+
+	case "chatEventPollStopped":
+		var chatEventPollStopped ChatEventPollStopped
+		err := jsonUnmarshal(rawMsg, &chatEventPollStopped)
+		return &chatEventPollStopped, err
+
+	case "chatEventMessagePinned":
+		var chatEventMessagePinned ChatEventMessagePinned
+		err := jsonUnmarshal(rawMsg, &chatEventMessagePinned)
+		return &chatEventMessagePinned, err
+
+	case "chatEventMessageUnpinned":
+		var chatEventMessageUnpinned ChatEventMessageUnpinned
+		err := jsonUnmarshal(rawMsg, &chatEventMessageUnpinned)
+		return &chatEventMessageUnpinned, err
+
+	case "chatEventMemberJoined":
+		var chatEventMemberJoined ChatEventMemberJoined
+		err := jsonUnmarshal(rawMsg, &chatEventMemberJoined)
+		return &chatEventMemberJoined, err
+
+	case "chatEventMemberJoinedByInviteLink":
+		var chatEventMemberJoinedByInviteLink ChatEventMemberJoinedByInviteLink
+		err := jsonUnmarshal(rawMsg, &chatEventMemberJoinedByInviteLink)
+		return &chatEventMemberJoinedByInviteLink, err
+
+	case "chatEventMemberJoinedByRequest":
+		var chatEventMemberJoinedByRequest ChatEventMemberJoinedByRequest
+		err := jsonUnmarshal(rawMsg, &chatEventMemberJoinedByRequest)
+		return &chatEventMemberJoinedByRequest, err
+
+	case "chatEventMemberLeft":
+		var chatEventMemberLeft ChatEventMemberLeft
+		err := jsonUnmarshal(rawMsg, &chatEventMemberLeft)
+		return &chatEventMemberLeft, err
+
+	case "chatEventMemberInvited":
+		var chatEventMemberInvited ChatEventMemberInvited
+		err := jsonUnmarshal(rawMsg, &chatEventMemberInvited)
+		return &chatEventMemberInvited, err
+
+	case "chatEventMemberPromoted":
+		var chatEventMemberPromoted ChatEventMemberPromoted
+		err := jsonUnmarshal(rawMsg, &chatEventMemberPromoted)
+		return &chatEventMemberPromoted, err
+
+	case "chatEventMemberRestricted":
+		var chatEventMemberRestricted ChatEventMemberRestricted
+		err := jsonUnmarshal(rawMsg, &chatEventMemberRestricted)
+		return &chatEventMemberRestricted, err
+
+	case "chatEventTitleChanged":
+		var chatEventTitleChanged ChatEventTitleChanged
+		err := jsonUnmarshal(rawMsg, &chatEventTitleChanged)
+		return &chatEventTitleChanged, err
+
+	case "chatEventPermissionsChanged":
+		var chatEventPermissionsChanged ChatEventPermissionsChanged
+		err := jsonUnmarshal(rawMsg, &chatEventPermissionsChanged)
+		return &chatEventPermissionsChanged, err
+
+	case "chatEventDescriptionChanged":
+		var chatEventDescriptionChanged ChatEventDescriptionChanged
+		err := jsonUnmarshal(rawMsg, &chatEventDescriptionChanged)
+		return &chatEventDescriptionChanged, err
+
+	case "chatEventUsernameChanged":
+		var chatEventUsernameChanged ChatEventUsernameChanged
+		err := jsonUnmarshal(rawMsg, &chatEventUsernameChanged)
+		return &chatEventUsernameChanged, err
+
+	case "chatEventPhotoChanged":
+		var chatEventPhotoChanged ChatEventPhotoChanged
+		err := jsonUnmarshal(rawMsg, &chatEventPhotoChanged)
+		return &chatEventPhotoChanged, err
+
+	case "chatEventInvitesToggled":
+		var chatEventInvitesToggled ChatEventInvitesToggled
+		err := jsonUnmarshal(rawMsg, &chatEventInvitesToggled)
+		return &chatEventInvitesToggled, err
+
+	case "chatEventLinkedChatChanged":
+		var chatEventLinkedChatChanged ChatEventLinkedChatChanged
+		err := jsonUnmarshal(rawMsg, &chatEventLinkedChatChanged)
+		return &chatEventLinkedChatChanged, err
+
+	case "chatEventSlowModeDelayChanged":
+		var chatEventSlowModeDelayChanged ChatEventSlowModeDelayChanged
+		err := jsonUnmarshal(rawMsg, &chatEventSlowModeDelayChanged)
+		return &chatEventSlowModeDelayChanged, err
+
+	case "chatEventMessageTtlChanged":
+		var chatEventMessageTtlChanged ChatEventMessageTtlChanged
+		err := jsonUnmarshal(rawMsg, &chatEventMessageTtlChanged)
+		return &chatEventMessageTtlChanged, err
+
+	case "chatEventSignMessagesToggled":
+		var chatEventSignMessagesToggled ChatEventSignMessagesToggled
+		err := jsonUnmarshal(rawMsg, &chatEventSignMessagesToggled)
+		return &chatEventSignMessagesToggled, err
+
+	case "chatEventHasProtectedContentToggled":
+		var chatEventHasProtectedContentToggled ChatEventHasProtectedContentToggled
+		err := jsonUnmarshal(rawMsg, &chatEventHasProtectedContentToggled)
+		return &chatEventHasProtectedContentToggled, err
+
+	case "chatEventStickerSetChanged":
+		var chatEventStickerSetChanged ChatEventStickerSetChanged
+		err := jsonUnmarshal(rawMsg, &chatEventStickerSetChanged)
+		return &chatEventStickerSetChanged, err
+
+	case "chatEventLocationChanged":
+		var chatEventLocationChanged ChatEventLocationChanged
+		err := jsonUnmarshal(rawMsg, &chatEventLocationChanged)
+		return &chatEventLocationChanged, err
+
+	case "chatEventIsAllHistoryAvailableToggled":
+		var chatEventIsAllHistoryAvailableToggled ChatEventIsAllHistoryAvailableToggled
+		err := jsonUnmarshal(rawMsg, &chatEventIsAllHistoryAvailableToggled)
+		return &chatEventIsAllHistoryAvailableToggled, err
+
+	case "chatEventInviteLinkEdited":
+		var chatEventInviteLinkEdited ChatEventInviteLinkEdited
+		err := jsonUnmarshal(rawMsg, &chatEventInviteLinkEdited)
+		return &chatEventInviteLinkEdited, err
+
+	case "chatEventInviteLinkRevoked":
+		var chatEventInviteLinkRevoked ChatEventInviteLinkRevoked
+		err := jsonUnmarshal(rawMsg, &chatEventInviteLinkRevoked)
+		return &chatEventInviteLinkRevoked, err
+
+	case "chatEventInviteLinkDeleted":
+		var chatEventInviteLinkDeleted ChatEventInviteLinkDeleted
+		err := jsonUnmarshal(rawMsg, &chatEventInviteLinkDeleted)
+		return &chatEventInviteLinkDeleted, err
+
+	case "chatEventVideoChatCreated":
+		var chatEventVideoChatCreated ChatEventVideoChatCreated
+		err := jsonUnmarshal(rawMsg, &chatEventVideoChatCreated)
+		return &chatEventVideoChatCreated, err
+
+	case "chatEventVideoChatEnded":
+		var chatEventVideoChatEnded ChatEventVideoChatEnded
+		err := jsonUnmarshal(rawMsg, &chatEventVideoChatEnded)
+		return &chatEventVideoChatEnded, err
+
+	case "chatEventVideoChatParticipantIsMutedToggled":
+		var chatEventVideoChatParticipantIsMutedToggled ChatEventVideoChatParticipantIsMutedToggled
+		err := jsonUnmarshal(rawMsg, &chatEventVideoChatParticipantIsMutedToggled)
+		return &chatEventVideoChatParticipantIsMutedToggled, err
+
+	case "chatEventVideoChatParticipantVolumeLevelChanged":
+		var chatEventVideoChatParticipantVolumeLevelChanged ChatEventVideoChatParticipantVolumeLevelChanged
+		err := jsonUnmarshal(rawMsg, &chatEventVideoChatParticipantVolumeLevelChanged)
+		return &chatEventVideoChatParticipantVolumeLevelChanged, err
+
+	case "chatEventVideoChatMuteNewParticipantsToggled":
+		var chatEventVideoChatMuteNewParticipantsToggled ChatEventVideoChatMuteNewParticipantsToggled
+		err := jsonUnmarshal(rawMsg, &chatEventVideoChatMuteNewParticipantsToggled)
+		return &chatEventVideoChatMuteNewParticipantsToggled, err
+
+	case "chatEventLogFilters":
+		var chatEventLogFilters ChatEventLogFilters
+		err := jsonUnmarshal(rawMsg, &chatEventLogFilters)
+		return &chatEventLogFilters, err
+
+	case "chatEvents":
+		var chatEvents ChatEvents
+		err := jsonUnmarshal(rawMsg, &chatEvents)
+		return &chatEvents, err
+
+	case "chatFilter":
+		var chatFilter ChatFilter
+		err := jsonUnmarshal(rawMsg, &chatFilter)
+		return &chatFilter, err
+
+	case "chatFilterInfo":
+		var chatFilterInfo ChatFilterInfo
+		err := jsonUnmarshal(rawMsg, &chatFilterInfo)
+		return &chatFilterInfo, err
+
+	case "chatInviteLink":
+		var chatInviteLink ChatInviteLink
+		err := jsonUnmarshal(rawMsg, &chatInviteLink)
+		return &chatInviteLink, err
+
+	case "chatInviteLinkCount":
+		var chatInviteLinkCount ChatInviteLinkCount
+		err := jsonUnmarshal(rawMsg, &chatInviteLinkCount)
+		return &chatInviteLinkCount, err
+
+	case "chatInviteLinkCounts":
+		var chatInviteLinkCounts ChatInviteLinkCounts
+		err := jsonUnmarshal(rawMsg, &chatInviteLinkCounts)
+		return &chatInviteLinkCounts, err
+
+	case "chatInviteLinkInfo":
+		var chatInviteLinkInfo ChatInviteLinkInfo
+		err := jsonUnmarshal(rawMsg, &chatInviteLinkInfo)
+		return &chatInviteLinkInfo, err
+
+	case "chatInviteLinkMember":
+		var chatInviteLinkMember ChatInviteLinkMember
+		err := jsonUnmarshal(rawMsg, &chatInviteLinkMember)
+		return &chatInviteLinkMember, err
+
+	case "chatInviteLinkMembers":
+		var chatInviteLinkMembers ChatInviteLinkMembers
+		err := jsonUnmarshal(rawMsg, &chatInviteLinkMembers)
+		return &chatInviteLinkMembers, err
+
+	case "chatInviteLinks":
+		var chatInviteLinks ChatInviteLinks
+		err := jsonUnmarshal(rawMsg, &chatInviteLinks)
+		return &chatInviteLinks, err
+
+	case "chatJoinRequest":
+		var chatJoinRequest ChatJoinRequest
+		err := jsonUnmarshal(rawMsg, &chatJoinRequest)
+		return &chatJoinRequest, err
+
+	case "chatJoinRequests":
+		var chatJoinRequests ChatJoinRequests
+		err := jsonUnmarshal(rawMsg, &chatJoinRequests)
+		return &chatJoinRequests, err
+
+	case "chatJoinRequestsInfo":
+		var chatJoinRequestsInfo ChatJoinRequestsInfo
+		err := jsonUnmarshal(rawMsg, &chatJoinRequestsInfo)
+		return &chatJoinRequestsInfo, err
+
+	case "chatListMain":
+		var chatListMain ChatListMain
+		err := jsonUnmarshal(rawMsg, &chatListMain)
+		return &chatListMain, err
+
+	case "chatListArchive":
+		var chatListArchive ChatListArchive
+		err := jsonUnmarshal(rawMsg, &chatListArchive)
+		return &chatListArchive, err
+
+	case "chatListFilter":
+		var chatListFilter ChatListFilter
+		err := jsonUnmarshal(rawMsg, &chatListFilter)
+		return &chatListFilter, err
+
+	case "chatLists":
+		var chatLists ChatLists
+		err := jsonUnmarshal(rawMsg, &chatLists)
+		return &chatLists, err
+
+	case "chatLocation":
+		var chatLocation ChatLocation
+		err := jsonUnmarshal(rawMsg, &chatLocation)
+		return &chatLocation, err
+
+	case "chatMember":
+		var chatMember ChatMember
+		err := jsonUnmarshal(rawMsg, &chatMember)
+		return &chatMember, err
+
+	case "chatMemberStatusCreator":
+		var chatMemberStatusCreator ChatMemberStatusCreator
+		err := jsonUnmarshal(rawMsg, &chatMemberStatusCreator)
+		return &chatMemberStatusCreator, err
+
+	case "chatMemberStatusAdministrator":
+		var chatMemberStatusAdministrator ChatMemberStatusAdministrator
+		err := jsonUnmarshal(rawMsg, &chatMemberStatusAdministrator)
+		return &chatMemberStatusAdministrator, err
+
+	case "chatMemberStatusMember":
+		var chatMemberStatusMember ChatMemberStatusMember
+		err := jsonUnmarshal(rawMsg, &chatMemberStatusMember)
+		return &chatMemberStatusMember, err
+
+	case "chatMemberStatusRestricted":
+		var chatMemberStatusRestricted ChatMemberStatusRestricted
+		err := jsonUnmarshal(rawMsg, &chatMemberStatusRestricted)
+		return &chatMemberStatusRestricted, err
+
+	case "chatMemberStatusLeft":
+		var chatMemberStatusLeft ChatMemberStatusLeft
+		err := jsonUnmarshal(rawMsg, &chatMemberStatusLeft)
+		return &chatMemberStatusLeft, err
+
+	case "chatMemberStatusBanned":
+		var chatMemberStatusBanned ChatMemberStatusBanned
+		err := jsonUnmarshal(rawMsg, &chatMemberStatusBanned)
+		return &chatMemberStatusBanned, err
+
+	case "chatMembers":
+		var chatMembers ChatMembers
+		err := jsonUnmarshal(rawMsg, &chatMembers)
+		return &chatMembers, err
+
+	case "chatMembersFilterContacts":
+		var chatMembersFilterContacts ChatMembersFilterContacts
+		err := jsonUnmarshal(rawMsg, &chatMembersFilterContacts)
+		return &chatMembersFilterContacts, err
+
+	case "chatMembersFilterAdministrators":
+		var chatMembersFilterAdministrators ChatMembersFilterAdministrators
+		err := jsonUnmarshal(rawMsg, &chatMembersFilterAdministrators)
+		return &chatMembersFilterAdministrators, err
+
+	case "chatMembersFilterMembers":
+		var chatMembersFilterMembers ChatMembersFilterMembers
+		err := jsonUnmarshal(rawMsg, &chatMembersFilterMembers)
+		return &chatMembersFilterMembers, err
+
+	case "chatMembersFilterMention":
+		var chatMembersFilterMention ChatMembersFilterMention
+		err := jsonUnmarshal(rawMsg, &chatMembersFilterMention)
+		return &chatMembersFilterMention, err
+
+	case "chatMembersFilterRestricted":
+		var chatMembersFilterRestricted ChatMembersFilterRestricted
+		err := jsonUnmarshal(rawMsg, &chatMembersFilterRestricted)
+		return &chatMembersFilterRestricted, err
+
+	case "chatMembersFilterBanned":
+		var chatMembersFilterBanned ChatMembersFilterBanned
+		err := jsonUnmarshal(rawMsg, &chatMembersFilterBanned)
+		return &chatMembersFilterBanned, err
+
+	case "chatMembersFilterBots":
+		var chatMembersFilterBots ChatMembersFilterBots
+		err := jsonUnmarshal(rawMsg, &chatMembersFilterBots)
+		return &chatMembersFilterBots, err
+
+	case "chatNearby":
+		var chatNearby ChatNearby
+		err := jsonUnmarshal(rawMsg, &chatNearby)
+		return &chatNearby, err
+
+	case "chatNotificationSettings":
+		var chatNotificationSettings ChatNotificationSettings
+		err := jsonUnmarshal(rawMsg, &chatNotificationSettings)
+		return &chatNotificationSettings, err
+
+	case "chatPermissions":
+		var chatPermissions ChatPermissions
+		err := jsonUnmarshal(rawMsg, &chatPermissions)
+		return &chatPermissions, err
+
+	case "chatPhoto":
+		var chatPhoto ChatPhoto
+		err := jsonUnmarshal(rawMsg, &chatPhoto)
+		return &chatPhoto, err
+
+	case "chatPhotoInfo":
+		var chatPhotoInfo ChatPhotoInfo
+		err := jsonUnmarshal(rawMsg, &chatPhotoInfo)
+		return &chatPhotoInfo, err
+
+	case "chatPhotos":
+		var chatPhotos ChatPhotos
+		err := jsonUnmarshal(rawMsg, &chatPhotos)
+		return &chatPhotos, err
+
+	case "chatPosition":
+		var chatPosition ChatPosition
+		err := jsonUnmarshal(rawMsg, &chatPosition)
+		return &chatPosition, err
+
+	case "chatReportReasonSpam":
+		var chatReportReasonSpam ChatReportReasonSpam
+		err := jsonUnmarshal(rawMsg, &chatReportReasonSpam)
+		return &chatReportReasonSpam, err
+
+	case "chatReportReasonViolence":
+		var chatReportReasonViolence ChatReportReasonViolence
+		err := jsonUnmarshal(rawMsg, &chatReportReasonViolence)
+		return &chatReportReasonViolence, err
+
+	case "chatReportReasonPornography":
+		var chatReportReasonPornography ChatReportReasonPornography
+		err := jsonUnmarshal(rawMsg, &chatReportReasonPornography)
+		return &chatReportReasonPornography, err
+
+	case "chatReportReasonChildAbuse":
+		var chatReportReasonChildAbuse ChatReportReasonChildAbuse
+		err := jsonUnmarshal(rawMsg, &chatReportReasonChildAbuse)
+		return &chatReportReasonChildAbuse, err
+
+	case "chatReportReasonCopyright":
+		var chatReportReasonCopyright ChatReportReasonCopyright
+		err := jsonUnmarshal(rawMsg, &chatReportReasonCopyright)
+		return &chatReportReasonCopyright, err
+
+	case "chatReportReasonUnrelatedLocation":
+		var chatReportReasonUnrelatedLocation ChatReportReasonUnrelatedLocation
+		err := jsonUnmarshal(rawMsg, &chatReportReasonUnrelatedLocation)
+		return &chatReportReasonUnrelatedLocation, err
+
+	case "chatReportReasonFake":
+		var chatReportReasonFake ChatReportReasonFake
+		err := jsonUnmarshal(rawMsg, &chatReportReasonFake)
+		return &chatReportReasonFake, err
+
+	case "chatReportReasonCustom":
+		var chatReportReasonCustom ChatReportReasonCustom
+		err := jsonUnmarshal(rawMsg, &chatReportReasonCustom)
+		return &chatReportReasonCustom, err
+
+	case "chatSourceMtprotoProxy":
+		var chatSourceMtprotoProxy ChatSourceMtprotoProxy
+		err := jsonUnmarshal(rawMsg, &chatSourceMtprotoProxy)
+		return &chatSourceMtprotoProxy, err
+
+	case "chatSourcePublicServiceAnnouncement":
+		var chatSourcePublicServiceAnnouncement ChatSourcePublicServiceAnnouncement
+		err := jsonUnmarshal(rawMsg, &chatSourcePublicServiceAnnouncement)
+		return &chatSourcePublicServiceAnnouncement, err
+
+	case "chatStatisticsSupergroup":
+		var chatStatisticsSupergroup ChatStatisticsSupergroup
+		err := jsonUnmarshal(rawMsg, &chatStatisticsSupergroup)
+		return &chatStatisticsSupergroup, err
+
+	case "chatStatisticsChannel":
+		var chatStatisticsChannel ChatStatisticsChannel
+		err := jsonUnmarshal(rawMsg, &chatStatisticsChannel)
+		return &chatStatisticsChannel, err
+
+	case "chatStatisticsAdministratorActionsInfo":
+		var chatStatisticsAdministratorActionsInfo ChatStatisticsAdministratorActionsInfo
+		err := jsonUnmarshal(rawMsg, &chatStatisticsAdministratorActionsInfo)
+		return &chatStatisticsAdministratorActionsInfo, err
+
+	case "chatStatisticsInviterInfo":
+		var chatStatisticsInviterInfo ChatStatisticsInviterInfo
+		err := jsonUnmarshal(rawMsg, &chatStatisticsInviterInfo)
+		return &chatStatisticsInviterInfo, err
+
+	case "chatStatisticsMessageInteractionInfo":
+		var chatStatisticsMessageInteractionInfo ChatStatisticsMessageInteractionInfo
+		err := jsonUnmarshal(rawMsg, &chatStatisticsMessageInteractionInfo)
+		return &chatStatisticsMessageInteractionInfo, err
+
+	case "chatStatisticsMessageSenderInfo":
+		var chatStatisticsMessageSenderInfo ChatStatisticsMessageSenderInfo
+		err := jsonUnmarshal(rawMsg, &chatStatisticsMessageSenderInfo)
+		return &chatStatisticsMessageSenderInfo, err
+
+	case "chatTheme":
+		var chatTheme ChatTheme
+		err := jsonUnmarshal(rawMsg, &chatTheme)
+		return &chatTheme, err
+
+	case "chatTypePrivate":
+		var chatTypePrivate ChatTypePrivate
+		err := jsonUnmarshal(rawMsg, &chatTypePrivate)
+		return &chatTypePrivate, err
+
+	case "chatTypeBasicGroup":
+		var chatTypeBasicGroup ChatTypeBasicGroup
+		err := jsonUnmarshal(rawMsg, &chatTypeBasicGroup)
+		return &chatTypeBasicGroup, err
+
+	case "chatTypeSupergroup":
+		var chatTypeSupergroup ChatTypeSupergroup
+		err := jsonUnmarshal(rawMsg, &chatTypeSupergroup)
+		return &chatTypeSupergroup, err
+
+	case "chatTypeSecret":
+		var chatTypeSecret ChatTypeSecret
+		err := jsonUnmarshal(rawMsg, &chatTypeSecret)
+		return &chatTypeSecret, err
+
+	case "chats":
+		var chats Chats
+		err := jsonUnmarshal(rawMsg, &chats)
+		return &chats, err
+
+	case "chatsNearby":
+		var chatsNearby ChatsNearby
+		err := jsonUnmarshal(rawMsg, &chatsNearby)
+		return &chatsNearby, err
+
+	case "checkChatUsernameResultOk":
+		var checkChatUsernameResultOk CheckChatUsernameResultOk
+		err := jsonUnmarshal(rawMsg, &checkChatUsernameResultOk)
+		return &checkChatUsernameResultOk, err
+
+	case "checkChatUsernameResultUsernameInvalid":
+		var checkChatUsernameResultUsernameInvalid CheckChatUsernameResultUsernameInvalid
+		err := jsonUnmarshal(rawMsg, &checkChatUsernameResultUsernameInvalid)
+		return &checkChatUsernameResultUsernameInvalid, err
+
+	case "checkChatUsernameResultUsernameOccupied":
+		var checkChatUsernameResultUsernameOccupied CheckChatUsernameResultUsernameOccupied
+		err := jsonUnmarshal(rawMsg, &checkChatUsernameResultUsernameOccupied)
+		return &checkChatUsernameResultUsernameOccupied, err
+
+	case "checkChatUsernameResultPublicChatsTooMuch":
+		var checkChatUsernameResultPublicChatsTooMuch CheckChatUsernameResultPublicChatsTooMuch
+		err := jsonUnmarshal(rawMsg, &checkChatUsernameResultPublicChatsTooMuch)
+		return &checkChatUsernameResultPublicChatsTooMuch, err
+
+	case "checkChatUsernameResultPublicGroupsUnavailable":
+		var checkChatUsernameResultPublicGroupsUnavailable CheckChatUsernameResultPublicGroupsUnavailable
+		err := jsonUnmarshal(rawMsg, &checkChatUsernameResultPublicGroupsUnavailable)
+		return &checkChatUsernameResultPublicGroupsUnavailable, err
+
+	case "checkStickerSetNameResultOk":
+		var checkStickerSetNameResultOk CheckStickerSetNameResultOk
+		err := jsonUnmarshal(rawMsg, &checkStickerSetNameResultOk)
+		return &checkStickerSetNameResultOk, err
+
+	case "checkStickerSetNameResultNameInvalid":
+		var checkStickerSetNameResultNameInvalid CheckStickerSetNameResultNameInvalid
+		err := jsonUnmarshal(rawMsg, &checkStickerSetNameResultNameInvalid)
+		return &checkStickerSetNameResultNameInvalid, err
+
+	case "checkStickerSetNameResultNameOccupied":
+		var checkStickerSetNameResultNameOccupied CheckStickerSetNameResultNameOccupied
+		err := jsonUnmarshal(rawMsg, &checkStickerSetNameResultNameOccupied)
+		return &checkStickerSetNameResultNameOccupied, err
+
+	case "closedVectorPath":
+		var closedVectorPath ClosedVectorPath
+		err := jsonUnmarshal(rawMsg, &closedVectorPath)
+		return &closedVectorPath, err
+
+	case "colorReplacement":
+		var colorReplacement ColorReplacement
+		err := jsonUnmarshal(rawMsg, &colorReplacement)
+		return &colorReplacement, err
+
+	case "connectedWebsite":
+		var connectedWebsite ConnectedWebsite
+		err := jsonUnmarshal(rawMsg, &connectedWebsite)
+		return &connectedWebsite, err
+
+	case "connectedWebsites":
+		var connectedWebsites ConnectedWebsites
+		err := jsonUnmarshal(rawMsg, &connectedWebsites)
+		return &connectedWebsites, err
+
+	case "connectionStateWaitingForNetwork":
+		var connectionStateWaitingForNetwork ConnectionStateWaitingForNetwork
+		err := jsonUnmarshal(rawMsg, &connectionStateWaitingForNetwork)
+		return &connectionStateWaitingForNetwork, err
+
+	case "connectionStateConnectingToProxy":
+		var connectionStateConnectingToProxy ConnectionStateConnectingToProxy
+		err := jsonUnmarshal(rawMsg, &connectionStateConnectingToProxy)
+		return &connectionStateConnectingToProxy, err
+
+	case "connectionStateConnecting":
+		var connectionStateConnecting ConnectionStateConnecting
+		err := jsonUnmarshal(rawMsg, &connectionStateConnecting)
+		return &connectionStateConnecting, err
+
+	case "connectionStateUpdating":
+		var connectionStateUpdating ConnectionStateUpdating
+		err := jsonUnmarshal(rawMsg, &connectionStateUpdating)
+		return &connectionStateUpdating, err
+
+	case "connectionStateReady":
+		var connectionStateReady ConnectionStateReady
+		err := jsonUnmarshal(rawMsg, &connectionStateReady)
+		return &connectionStateReady, err
+
+	case "contact":
+		var contact Contact
+		err := jsonUnmarshal(rawMsg, &contact)
+		return &contact, err
+
+	case "count":
+		var count Count
+		err := jsonUnmarshal(rawMsg, &count)
+		return &count, err
+
+	case "countries":
+		var countries Countries
+		err := jsonUnmarshal(rawMsg, &countries)
+		return &countries, err
+
+	case "countryInfo":
+		var countryInfo CountryInfo
+		err := jsonUnmarshal(rawMsg, &countryInfo)
+		return &countryInfo, err
+
+	case "customRequestResult":
+		var customRequestResult CustomRequestResult
+		err := jsonUnmarshal(rawMsg, &customRequestResult)
+		return &customRequestResult, err
+
+	case "databaseStatistics":
+		var databaseStatistics DatabaseStatistics
+		err := jsonUnmarshal(rawMsg, &databaseStatistics)
+		return &databaseStatistics, err
+
+	case "date":
+		var date Date
+		err := jsonUnmarshal(rawMsg, &date)
+		return &date, err
+
+	case "dateRange":
+		var dateRange DateRange
+		err := jsonUnmarshal(rawMsg, &dateRange)
+		return &dateRange, err
+
+	case "datedFile":
+		var datedFile DatedFile
+		err := jsonUnmarshal(rawMsg, &datedFile)
+		return &datedFile, err
+
+	case "deepLinkInfo":
+		var deepLinkInfo DeepLinkInfo
+		err := jsonUnmarshal(rawMsg, &deepLinkInfo)
+		return &deepLinkInfo, err
+
+	case "deviceTokenFirebaseCloudMessaging":
+		var deviceTokenFirebaseCloudMessaging DeviceTokenFirebaseCloudMessaging
+		err := jsonUnmarshal(rawMsg, &deviceTokenFirebaseCloudMessaging)
+		return &deviceTokenFirebaseCloudMessaging, err
+
+	case "deviceTokenApplePush":
+		var deviceTokenApplePush DeviceTokenApplePush
+		err := jsonUnmarshal(rawMsg, &deviceTokenApplePush)
+		return &deviceTokenApplePush, err
+
+	case "deviceTokenApplePushVoIP":
+		var deviceTokenApplePushVoIP DeviceTokenApplePushVoIP
+		err := jsonUnmarshal(rawMsg, &deviceTokenApplePushVoIP)
+		return &deviceTokenApplePushVoIP, err
+
+	case "deviceTokenWindowsPush":
+		var deviceTokenWindowsPush DeviceTokenWindowsPush
+		err := jsonUnmarshal(rawMsg, &deviceTokenWindowsPush)
+		return &deviceTokenWindowsPush, err
+
+	case "deviceTokenMicrosoftPush":
+		var deviceTokenMicrosoftPush DeviceTokenMicrosoftPush
+		err := jsonUnmarshal(rawMsg, &deviceTokenMicrosoftPush)
+		return &deviceTokenMicrosoftPush, err
+
+	case "deviceTokenMicrosoftPushVoIP":
+		var deviceTokenMicrosoftPushVoIP DeviceTokenMicrosoftPushVoIP
+		err := jsonUnmarshal(rawMsg, &deviceTokenMicrosoftPushVoIP)
+		return &deviceTokenMicrosoftPushVoIP, err
+
+	case "deviceTokenWebPush":
+		var deviceTokenWebPush DeviceTokenWebPush
+		err := jsonUnmarshal(rawMsg, &deviceTokenWebPush)
+		return &deviceTokenWebPush, err
+
+	case "deviceTokenSimplePush":
+		var deviceTokenSimplePush DeviceTokenSimplePush
+		err := jsonUnmarshal(rawMsg, &deviceTokenSimplePush)
+		return &deviceTokenSimplePush, err
+
+	case "deviceTokenUbuntuPush":
+		var deviceTokenUbuntuPush DeviceTokenUbuntuPush
+		err := jsonUnmarshal(rawMsg, &deviceTokenUbuntuPush)
+		return &deviceTokenUbuntuPush, err
+
+	case "deviceTokenBlackBerryPush":
+		var deviceTokenBlackBerryPush DeviceTokenBlackBerryPush
+		err := jsonUnmarshal(rawMsg, &deviceTokenBlackBerryPush)
+		return &deviceTokenBlackBerryPush, err
+
+	case "deviceTokenTizenPush":
+		var deviceTokenTizenPush DeviceTokenTizenPush
+		err := jsonUnmarshal(rawMsg, &deviceTokenTizenPush)
+		return &deviceTokenTizenPush, err
+
+	case "diceStickersRegular":
+		var diceStickersRegular DiceStickersRegular
+		err := jsonUnmarshal(rawMsg, &diceStickersRegular)
+		return &diceStickersRegular, err
+
+	case "diceStickersSlotMachine":
+		var diceStickersSlotMachine DiceStickersSlotMachine
+		err := jsonUnmarshal(rawMsg, &diceStickersSlotMachine)
+		return &diceStickersSlotMachine, err
+
+	case "document":
+		var document Document
+		err := jsonUnmarshal(rawMsg, &document)
+		return &document, err
+
+	case "draftMessage":
+		var draftMessage DraftMessage
+		err := jsonUnmarshal(rawMsg, &draftMessage)
+		return &draftMessage, err
+
+	case "emailAddressAuthenticationCodeInfo":
+		var emailAddressAuthenticationCodeInfo EmailAddressAuthenticationCodeInfo
+		err := jsonUnmarshal(rawMsg, &emailAddressAuthenticationCodeInfo)
+		return &emailAddressAuthenticationCodeInfo, err
+
+	case "emojis":
+		var emojis Emojis
+		err := jsonUnmarshal(rawMsg, &emojis)
+		return &emojis, err
+
+	case "encryptedCredentials":
+		var encryptedCredentials EncryptedCredentials
+		err := jsonUnmarshal(rawMsg, &encryptedCredentials)
+		return &encryptedCredentials, err
+
+	case "encryptedPassportElement":
+		var encryptedPassportElement EncryptedPassportElement
+		err := jsonUnmarshal(rawMsg, &encryptedPassportElement)
+		return &encryptedPassportElement, err
+
+	case "error":
+		var error Error
+		err := jsonUnmarshal(rawMsg, &error)
+		return &error, err
+
+	case "file":
+		var file File
+		err := jsonUnmarshal(rawMsg, &file)
+		return &file, err
+
+	case "filePart":
+		var filePart FilePart
+		err := jsonUnmarshal(rawMsg, &filePart)
+		return &filePart, err
+
+	case "fileTypeNone":
+		var fileTypeNone FileTypeNone
+		err := jsonUnmarshal(rawMsg, &fileTypeNone)
+		return &fileTypeNone, err
+
+	case "fileTypeAnimation":
+		var fileTypeAnimation FileTypeAnimation
+		err := jsonUnmarshal(rawMsg, &fileTypeAnimation)
+		return &fileTypeAnimation, err
+
+	case "fileTypeAudio":
+		var fileTypeAudio FileTypeAudio
+		err := jsonUnmarshal(rawMsg, &fileTypeAudio)
+		return &fileTypeAudio, err
+
+	case "fileTypeDocument":
+		var fileTypeDocument FileTypeDocument
+		err := jsonUnmarshal(rawMsg, &fileTypeDocument)
+		return &fileTypeDocument, err
+
+	case "fileTypePhoto":
+		var fileTypePhoto FileTypePhoto
+		err := jsonUnmarshal(rawMsg, &fileTypePhoto)
+		return &fileTypePhoto, err
+
+	case "fileTypeProfilePhoto":
+		var fileTypeProfilePhoto FileTypeProfilePhoto
+		err := jsonUnmarshal(rawMsg, &fileTypeProfilePhoto)
+		return &fileTypeProfilePhoto, err
+
+	case "fileTypeSecret":
+		var fileTypeSecret FileTypeSecret
+		err := jsonUnmarshal(rawMsg, &fileTypeSecret)
+		return &fileTypeSecret, err
+
+	case "fileTypeSecretThumbnail":
+		var fileTypeSecretThumbnail FileTypeSecretThumbnail
+		err := jsonUnmarshal(rawMsg, &fileTypeSecretThumbnail)
+		return &fileTypeSecretThumbnail, err
+
+	case "fileTypeSecure":
+		var fileTypeSecure FileTypeSecure
+		err := jsonUnmarshal(rawMsg, &fileTypeSecure)
+		return &fileTypeSecure, err
+
+	case "fileTypeSticker":
+		var fileTypeSticker FileTypeSticker
+		err := jsonUnmarshal(rawMsg, &fileTypeSticker)
+		return &fileTypeSticker, err
+
+	case "fileTypeThumbnail":
+		var fileTypeThumbnail FileTypeThumbnail
+		err := jsonUnmarshal(rawMsg, &fileTypeThumbnail)
+		return &fileTypeThumbnail, err
+
+	case "fileTypeUnknown":
+		var fileTypeUnknown FileTypeUnknown
+		err := jsonUnmarshal(rawMsg, &fileTypeUnknown)
+		return &fileTypeUnknown, err
+
+	case "fileTypeVideo":
+		var fileTypeVideo FileTypeVideo
+		err := jsonUnmarshal(rawMsg, &fileTypeVideo)
+		return &fileTypeVideo, err
+
+	case "fileTypeVideoNote":
+		var fileTypeVideoNote FileTypeVideoNote
+		err := jsonUnmarshal(rawMsg, &fileTypeVideoNote)
+		return &fileTypeVideoNote, err
+
+	case "fileTypeVoiceNote":
+		var fileTypeVoiceNote FileTypeVoiceNote
+		err := jsonUnmarshal(rawMsg, &fileTypeVoiceNote)
+		return &fileTypeVoiceNote, err
+
+	case "fileTypeWallpaper":
+		var fileTypeWallpaper FileTypeWallpaper
+		err := jsonUnmarshal(rawMsg, &fileTypeWallpaper)
+		return &fileTypeWallpaper, err
+
+	case "formattedText":
+		var formattedText FormattedText
+		err := jsonUnmarshal(rawMsg, &formattedText)
+		return &formattedText, err
+
+	case "foundMessages":
+		var foundMessages FoundMessages
+		err := jsonUnmarshal(rawMsg, &foundMessages)
+		return &foundMessages, err
+
+	case "game":
+		var game Game
+		err := jsonUnmarshal(rawMsg, &game)
+		return &game, err
+
+	case "gameHighScore":
+		var gameHighScore GameHighScore
+		err := jsonUnmarshal(rawMsg, &gameHighScore)
+		return &gameHighScore, err
+
+	case "gameHighScores":
+		var gameHighScores GameHighScores
+		err := jsonUnmarshal(rawMsg, &gameHighScores)
+		return &gameHighScores, err
+
+	case "groupCall":
+		var groupCall GroupCall
+		err := jsonUnmarshal(rawMsg, &groupCall)
+		return &groupCall, err
+
+	case "groupCallId":
+		var groupCallId GroupCallId
+		err := jsonUnmarshal(rawMsg, &groupCallId)
+		return &groupCallId, err
+
+	case "groupCallParticipant":
+		var groupCallParticipant GroupCallParticipant
+		err := jsonUnmarshal(rawMsg, &groupCallParticipant)
+		return &groupCallParticipant, err
+
+	case "groupCallParticipantVideoInfo":
+		var groupCallParticipantVideoInfo GroupCallParticipantVideoInfo
+		err := jsonUnmarshal(rawMsg, &groupCallParticipantVideoInfo)
+		return &groupCallParticipantVideoInfo, err
+
+	case "groupCallRecentSpeaker":
+		var groupCallRecentSpeaker GroupCallRecentSpeaker
+		err := jsonUnmarshal(rawMsg, &groupCallRecentSpeaker)
+		return &groupCallRecentSpeaker, err
+
+	case "groupCallVideoQualityThumbnail":
+		var groupCallVideoQualityThumbnail GroupCallVideoQualityThumbnail
+		err := jsonUnmarshal(rawMsg, &groupCallVideoQualityThumbnail)
+		return &groupCallVideoQualityThumbnail, err
+
+	case "groupCallVideoQualityMedium":
+		var groupCallVideoQualityMedium GroupCallVideoQualityMedium
+		err := jsonUnmarshal(rawMsg, &groupCallVideoQualityMedium)
+		return &groupCallVideoQualityMedium, err
+
+	case "groupCallVideoQualityFull":
+		var groupCallVideoQualityFull GroupCallVideoQualityFull
+		err := jsonUnmarshal(rawMsg, &groupCallVideoQualityFull)
+		return &groupCallVideoQualityFull, err
+
+	case "groupCallVideoSourceGroup":
+		var groupCallVideoSourceGroup GroupCallVideoSourceGroup
+		err := jsonUnmarshal(rawMsg, &groupCallVideoSourceGroup)
+		return &groupCallVideoSourceGroup, err
+
+	case "hashtags":
+		var hashtags Hashtags
+		err := jsonUnmarshal(rawMsg, &hashtags)
+		return &hashtags, err
+
+	case "httpUrl":
+		var httpUrl HttpUrl
+		err := jsonUnmarshal(rawMsg, &httpUrl)
+		return &httpUrl, err
+
+	case "identityDocument":
+		var identityDocument IdentityDocument
+		err := jsonUnmarshal(rawMsg, &identityDocument)
+		return &identityDocument, err
+
+	case "importedContacts":
+		var importedContacts ImportedContacts
+		err := jsonUnmarshal(rawMsg, &importedContacts)
+		return &importedContacts, err
+
+	case "inlineKeyboardButton":
+		var inlineKeyboardButton InlineKeyboardButton
+		err := jsonUnmarshal(rawMsg, &inlineKeyboardButton)
+		return &inlineKeyboardButton, err
+
+	case "inlineKeyboardButtonTypeUrl":
+		var inlineKeyboardButtonTypeUrl InlineKeyboardButtonTypeUrl
+		err := jsonUnmarshal(rawMsg, &inlineKeyboardButtonTypeUrl)
+		return &inlineKeyboardButtonTypeUrl, err
+
+	case "inlineKeyboardButtonTypeLoginUrl":
+		var inlineKeyboardButtonTypeLoginUrl InlineKeyboardButtonTypeLoginUrl
+		err := jsonUnmarshal(rawMsg, &inlineKeyboardButtonTypeLoginUrl)
+		return &inlineKeyboardButtonTypeLoginUrl, err
+
+	case "inlineKeyboardButtonTypeCallback":
+		var inlineKeyboardButtonTypeCallback InlineKeyboardButtonTypeCallback
+		err := jsonUnmarshal(rawMsg, &inlineKeyboardButtonTypeCallback)
+		return &inlineKeyboardButtonTypeCallback, err
+
+	case "inlineKeyboardButtonTypeCallbackWithPassword":
+		var inlineKeyboardButtonTypeCallbackWithPassword InlineKeyboardButtonTypeCallbackWithPassword
+		err := jsonUnmarshal(rawMsg, &inlineKeyboardButtonTypeCallbackWithPassword)
+		return &inlineKeyboardButtonTypeCallbackWithPassword, err
+
+	case "inlineKeyboardButtonTypeCallbackGame":
+		var inlineKeyboardButtonTypeCallbackGame InlineKeyboardButtonTypeCallbackGame
+		err := jsonUnmarshal(rawMsg, &inlineKeyboardButtonTypeCallbackGame)
+		return &inlineKeyboardButtonTypeCallbackGame, err
+
+	case "inlineKeyboardButtonTypeSwitchInline":
+		var inlineKeyboardButtonTypeSwitchInline InlineKeyboardButtonTypeSwitchInline
+		err := jsonUnmarshal(rawMsg, &inlineKeyboardButtonTypeSwitchInline)
+		return &inlineKeyboardButtonTypeSwitchInline, err
+
+	case "inlineKeyboardButtonTypeBuy":
+		var inlineKeyboardButtonTypeBuy InlineKeyboardButtonTypeBuy
+		err := jsonUnmarshal(rawMsg, &inlineKeyboardButtonTypeBuy)
+		return &inlineKeyboardButtonTypeBuy, err
+
+	case "inlineKeyboardButtonTypeUser":
+		var inlineKeyboardButtonTypeUser InlineKeyboardButtonTypeUser
+		err := jsonUnmarshal(rawMsg, &inlineKeyboardButtonTypeUser)
+		return &inlineKeyboardButtonTypeUser, err
+
+	case "inlineQueryResultArticle":
+		var inlineQueryResultArticle InlineQueryResultArticle
+		err := jsonUnmarshal(rawMsg, &inlineQueryResultArticle)
+		return &inlineQueryResultArticle, err
+
+	case "inlineQueryResultContact":
+		var inlineQueryResultContact InlineQueryResultContact
+		err := jsonUnmarshal(rawMsg, &inlineQueryResultContact)
+		return &inlineQueryResultContact, err
+
+	case "inlineQueryResultLocation":
+		var inlineQueryResultLocation InlineQueryResultLocation
+		err := jsonUnmarshal(rawMsg, &inlineQueryResultLocation)
+		return &inlineQueryResultLocation, err
+
+	case "inlineQueryResultVenue":
+		var inlineQueryResultVenue InlineQueryResultVenue
+		err := jsonUnmarshal(rawMsg, &inlineQueryResultVenue)
+		return &inlineQueryResultVenue, err
+
+	case "inlineQueryResultGame":
+		var inlineQueryResultGame InlineQueryResultGame
+		err := jsonUnmarshal(rawMsg, &inlineQueryResultGame)
+		return &inlineQueryResultGame, err
+
+	case "inlineQueryResultAnimation":
+		var inlineQueryResultAnimation InlineQueryResultAnimation
+		err := jsonUnmarshal(rawMsg, &inlineQueryResultAnimation)
+		return &inlineQueryResultAnimation, err
+
+	case "inlineQueryResultAudio":
+		var inlineQueryResultAudio InlineQueryResultAudio
+		err := jsonUnmarshal(rawMsg, &inlineQueryResultAudio)
+		return &inlineQueryResultAudio, err
+
+	case "inlineQueryResultDocument":
+		var inlineQueryResultDocument InlineQueryResultDocument
+		err := jsonUnmarshal(rawMsg, &inlineQueryResultDocument)
+		return &inlineQueryResultDocument, err
+
+	case "inlineQueryResultPhoto":
+		var inlineQueryResultPhoto InlineQueryResultPhoto
+		err := jsonUnmarshal(rawMsg, &inlineQueryResultPhoto)
+		return &inlineQueryResultPhoto, err
+
+	case "inlineQueryResultSticker":
+		var inlineQueryResultSticker InlineQueryResultSticker
+		err := jsonUnmarshal(rawMsg, &inlineQueryResultSticker)
+		return &inlineQueryResultSticker, err
+
+	case "inlineQueryResultVideo":
+		var inlineQueryResultVideo InlineQueryResultVideo
+		err := jsonUnmarshal(rawMsg, &inlineQueryResultVideo)
+		return &inlineQueryResultVideo, err
+
+	case "inlineQueryResultVoiceNote":
+		var inlineQueryResultVoiceNote InlineQueryResultVoiceNote
+		err := jsonUnmarshal(rawMsg, &inlineQueryResultVoiceNote)
+		return &inlineQueryResultVoiceNote, err
+
+	case "inlineQueryResults":
+		var inlineQueryResults InlineQueryResults
+		err := jsonUnmarshal(rawMsg, &inlineQueryResults)
+		return &inlineQueryResults, err
+
+	case "inputBackgroundLocal":
+		var inputBackgroundLocal InputBackgroundLocal
+		err := jsonUnmarshal(rawMsg, &inputBackgroundLocal)
+		return &inputBackgroundLocal, err
+
+	case "inputBackgroundRemote":
+		var inputBackgroundRemote InputBackgroundRemote
+		err := jsonUnmarshal(rawMsg, &inputBackgroundRemote)
+		return &inputBackgroundRemote, err
+
+	case "inputChatPhotoPrevious":
+		var inputChatPhotoPrevious InputChatPhotoPrevious
+		err := jsonUnmarshal(rawMsg, &inputChatPhotoPrevious)
+		return &inputChatPhotoPrevious, err
+
+	case "inputChatPhotoStatic":
+		var inputChatPhotoStatic InputChatPhotoStatic
+		err := jsonUnmarshal(rawMsg, &inputChatPhotoStatic)
+		return &inputChatPhotoStatic, err
+
+	case "inputChatPhotoAnimation":
+		var inputChatPhotoAnimation InputChatPhotoAnimation
+		err := jsonUnmarshal(rawMsg, &inputChatPhotoAnimation)
+		return &inputChatPhotoAnimation, err
+
+	case "inputCredentialsSaved":
+		var inputCredentialsSaved InputCredentialsSaved
+		err := jsonUnmarshal(rawMsg, &inputCredentialsSaved)
+		return &inputCredentialsSaved, err
+
+	case "inputCredentialsNew":
+		var inputCredentialsNew InputCredentialsNew
+		err := jsonUnmarshal(rawMsg, &inputCredentialsNew)
+		return &inputCredentialsNew, err
+
+	case "inputCredentialsApplePay":
+		var inputCredentialsApplePay InputCredentialsApplePay
+		err := jsonUnmarshal(rawMsg, &inputCredentialsApplePay)
+		return &inputCredentialsApplePay, err
+
+	case "inputCredentialsGooglePay":
+		var inputCredentialsGooglePay InputCredentialsGooglePay
+		err := jsonUnmarshal(rawMsg, &inputCredentialsGooglePay)
+		return &inputCredentialsGooglePay, err
+
+	case "inputFileId":
+		var inputFileId InputFileId
+		err := jsonUnmarshal(rawMsg, &inputFileId)
+		return &inputFileId, err
+
+	case "inputFileRemote":
+		var inputFileRemote InputFileRemote
+		err := jsonUnmarshal(rawMsg, &inputFileRemote)
+		return &inputFileRemote, err
+
+	case "inputFileLocal":
+		var inputFileLocal InputFileLocal
+		err := jsonUnmarshal(rawMsg, &inputFileLocal)
+		return &inputFileLocal, err
+
+	case "inputFileGenerated":
+		var inputFileGenerated InputFileGenerated
+		err := jsonUnmarshal(rawMsg, &inputFileGenerated)
+		return &inputFileGenerated, err
+
+	case "inputIdentityDocument":
+		var inputIdentityDocument InputIdentityDocument
+		err := jsonUnmarshal(rawMsg, &inputIdentityDocument)
+		return &inputIdentityDocument, err
+
+	case "inputInlineQueryResultAnimation":
+		var inputInlineQueryResultAnimation InputInlineQueryResultAnimation
+		err := jsonUnmarshal(rawMsg, &inputInlineQueryResultAnimation)
+		return &inputInlineQueryResultAnimation, err
+
+	case "inputInlineQueryResultArticle":
+		var inputInlineQueryResultArticle InputInlineQueryResultArticle
+		err := jsonUnmarshal(rawMsg, &inputInlineQueryResultArticle)
+		return &inputInlineQueryResultArticle, err
+
+	case "inputInlineQueryResultAudio":
+		var inputInlineQueryResultAudio InputInlineQueryResultAudio
+		err := jsonUnmarshal(rawMsg, &inputInlineQueryResultAudio)
+		return &inputInlineQueryResultAudio, err
+
+	case "inputInlineQueryResultContact":
+		var inputInlineQueryResultContact InputInlineQueryResultContact
+		err := jsonUnmarshal(rawMsg, &inputInlineQueryResultContact)
+		return &inputInlineQueryResultContact, err
+
+	case "inputInlineQueryResultDocument":
+		var inputInlineQueryResultDocument InputInlineQueryResultDocument
+		err := jsonUnmarshal(rawMsg, &inputInlineQueryResultDocument)
+		return &inputInlineQueryResultDocument, err
+
+	case "inputInlineQueryResultGame":
+		var inputInlineQueryResultGame InputInlineQueryResultGame
+		err := jsonUnmarshal(rawMsg, &inputInlineQueryResultGame)
+		return &inputInlineQueryResultGame, err
+
+	case "inputInlineQueryResultLocation":
+		var inputInlineQueryResultLocation InputInlineQueryResultLocation
+		err := jsonUnmarshal(rawMsg, &inputInlineQueryResultLocation)
+		return &inputInlineQueryResultLocation, err
+
+	case "inputInlineQueryResultPhoto":
+		var inputInlineQueryResultPhoto InputInlineQueryResultPhoto
+		err := jsonUnmarshal(rawMsg, &inputInlineQueryResultPhoto)
+		return &inputInlineQueryResultPhoto, err
+
+	case "inputInlineQueryResultSticker":
+		var inputInlineQueryResultSticker InputInlineQueryResultSticker
+		err := jsonUnmarshal(rawMsg, &inputInlineQueryResultSticker)
+		return &inputInlineQueryResultSticker, err
+
+	case "inputInlineQueryResultVenue":
+		var inputInlineQueryResultVenue InputInlineQueryResultVenue
+		err := jsonUnmarshal(rawMsg, &inputInlineQueryResultVenue)
+		return &inputInlineQueryResultVenue, err
+
+	case "inputInlineQueryResultVideo":
+		var inputInlineQueryResultVideo InputInlineQueryResultVideo
+		err := jsonUnmarshal(rawMsg, &inputInlineQueryResultVideo)
+		return &inputInlineQueryResultVideo, err
+
+	case "inputInlineQueryResultVoiceNote":
+		var inputInlineQueryResultVoiceNote InputInlineQueryResultVoiceNote
+		err := jsonUnmarshal(rawMsg, &inputInlineQueryResultVoiceNote)
+		return &inputInlineQueryResultVoiceNote, err
+
+	case "inputMessageText":
+		var inputMessageText InputMessageText
+		err := jsonUnmarshal(rawMsg, &inputMessageText)
+		return &inputMessageText, err
+
+	case "inputMessageAnimation":
+		var inputMessageAnimation InputMessageAnimation
+		err := jsonUnmarshal(rawMsg, &inputMessageAnimation)
+		return &inputMessageAnimation, err
+
+	case "inputMessageAudio":
+		var inputMessageAudio InputMessageAudio
+		err := jsonUnmarshal(rawMsg, &inputMessageAudio)
+		return &inputMessageAudio, err
+
+	case "inputMessageDocument":
+		var inputMessageDocument InputMessageDocument
+		err := jsonUnmarshal(rawMsg, &inputMessageDocument)
+		return &inputMessageDocument, err
+
+	case "inputMessagePhoto":
+		var inputMessagePhoto InputMessagePhoto
+		err := jsonUnmarshal(rawMsg, &inputMessagePhoto)
+		return &inputMessagePhoto, err
+
+	case "inputMessageSticker":
+		var inputMessageSticker InputMessageSticker
+		err := jsonUnmarshal(rawMsg, &inputMessageSticker)
+		return &inputMessageSticker, err
+
+	case "inputMessageVideo":
+		var inputMessageVideo InputMessageVideo
+		err := jsonUnmarshal(rawMsg, &inputMessageVideo)
+		return &inputMessageVideo, err
+
+	case "inputMessageVideoNote":
+		var inputMessageVideoNote InputMessageVideoNote
+		err := jsonUnmarshal(rawMsg, &inputMessageVideoNote)
+		return &inputMessageVideoNote, err
+
+	case "inputMessageVoiceNote":
+		var inputMessageVoiceNote InputMessageVoiceNote
+		err := jsonUnmarshal(rawMsg, &inputMessageVoiceNote)
+		return &inputMessageVoiceNote, err
+
+	case "inputMessageLocation":
+		var inputMessageLocation InputMessageLocation
+		err := jsonUnmarshal(rawMsg, &inputMessageLocation)
+		return &inputMessageLocation, err
+
+	case "inputMessageVenue":
+		var inputMessageVenue InputMessageVenue
+		err := jsonUnmarshal(rawMsg, &inputMessageVenue)
+		return &inputMessageVenue, err
+
+	case "inputMessageContact":
+		var inputMessageContact InputMessageContact
+		err := jsonUnmarshal(rawMsg, &inputMessageContact)
+		return &inputMessageContact, err
+
+	case "inputMessageDice":
+		var inputMessageDice InputMessageDice
+		err := jsonUnmarshal(rawMsg, &inputMessageDice)
+		return &inputMessageDice, err
+
+	case "inputMessageGame":
+		var inputMessageGame InputMessageGame
+		err := jsonUnmarshal(rawMsg, &inputMessageGame)
+		return &inputMessageGame, err
+
+	case "inputMessageInvoice":
+		var inputMessageInvoice InputMessageInvoice
+		err := jsonUnmarshal(rawMsg, &inputMessageInvoice)
+		return &inputMessageInvoice, err
+
+	case "inputMessagePoll":
+		var inputMessagePoll InputMessagePoll
+		err := jsonUnmarshal(rawMsg, &inputMessagePoll)
+		return &inputMessagePoll, err
+
+	case "inputMessageForwarded":
+		var inputMessageForwarded InputMessageForwarded
+		err := jsonUnmarshal(rawMsg, &inputMessageForwarded)
+		return &inputMessageForwarded, err
+
+	case "inputPassportElementPersonalDetails":
+		var inputPassportElementPersonalDetails InputPassportElementPersonalDetails
+		err := jsonUnmarshal(rawMsg, &inputPassportElementPersonalDetails)
+		return &inputPassportElementPersonalDetails, err
+
+	case "inputPassportElementPassport":
+		var inputPassportElementPassport InputPassportElementPassport
+		err := jsonUnmarshal(rawMsg, &inputPassportElementPassport)
+		return &inputPassportElementPassport, err
+
+	case "inputPassportElementDriverLicense":
+		var inputPassportElementDriverLicense InputPassportElementDriverLicense
+		err := jsonUnmarshal(rawMsg, &inputPassportElementDriverLicense)
+		return &inputPassportElementDriverLicense, err
+
+	case "inputPassportElementIdentityCard":
+		var inputPassportElementIdentityCard InputPassportElementIdentityCard
+		err := jsonUnmarshal(rawMsg, &inputPassportElementIdentityCard)
+		return &inputPassportElementIdentityCard, err
+
+	case "inputPassportElementInternalPassport":
+		var inputPassportElementInternalPassport InputPassportElementInternalPassport
+		err := jsonUnmarshal(rawMsg, &inputPassportElementInternalPassport)
+		return &inputPassportElementInternalPassport, err
+
+	case "inputPassportElementAddress":
+		var inputPassportElementAddress InputPassportElementAddress
+		err := jsonUnmarshal(rawMsg, &inputPassportElementAddress)
+		return &inputPassportElementAddress, err
+
+	case "inputPassportElementUtilityBill":
+		var inputPassportElementUtilityBill InputPassportElementUtilityBill
+		err := jsonUnmarshal(rawMsg, &inputPassportElementUtilityBill)
+		return &inputPassportElementUtilityBill, err
+
+	case "inputPassportElementBankStatement":
+		var inputPassportElementBankStatement InputPassportElementBankStatement
+		err := jsonUnmarshal(rawMsg, &inputPassportElementBankStatement)
+		return &inputPassportElementBankStatement, err
+
+	case "inputPassportElementRentalAgreement":
+		var inputPassportElementRentalAgreement InputPassportElementRentalAgreement
+		err := jsonUnmarshal(rawMsg, &inputPassportElementRentalAgreement)
+		return &inputPassportElementRentalAgreement, err
+
+	case "inputPassportElementPassportRegistration":
+		var inputPassportElementPassportRegistration InputPassportElementPassportRegistration
+		err := jsonUnmarshal(rawMsg, &inputPassportElementPassportRegistration)
+		return &inputPassportElementPassportRegistration, err
+
+	case "inputPassportElementTemporaryRegistration":
+		var inputPassportElementTemporaryRegistration InputPassportElementTemporaryRegistration
+		err := jsonUnmarshal(rawMsg, &inputPassportElementTemporaryRegistration)
+		return &inputPassportElementTemporaryRegistration, err
+
+	case "inputPassportElementPhoneNumber":
+		var inputPassportElementPhoneNumber InputPassportElementPhoneNumber
+		err := jsonUnmarshal(rawMsg, &inputPassportElementPhoneNumber)
+		return &inputPassportElementPhoneNumber, err
+
+	case "inputPassportElementEmailAddress":
+		var inputPassportElementEmailAddress InputPassportElementEmailAddress
+		err := jsonUnmarshal(rawMsg, &inputPassportElementEmailAddress)
+		return &inputPassportElementEmailAddress, err
+
+	case "inputPassportElementError":
+		var inputPassportElementError InputPassportElementError
+		err := jsonUnmarshal(rawMsg, &inputPassportElementError)
+		return &inputPassportElementError, err
+
+	case "inputPassportElementErrorSourceUnspecified":
+		var inputPassportElementErrorSourceUnspecified InputPassportElementErrorSourceUnspecified
+		err := jsonUnmarshal(rawMsg, &inputPassportElementErrorSourceUnspecified)
+		return &inputPassportElementErrorSourceUnspecified, err
+
+	case "inputPassportElementErrorSourceDataField":
+		var inputPassportElementErrorSourceDataField InputPassportElementErrorSourceDataField
+		err := jsonUnmarshal(rawMsg, &inputPassportElementErrorSourceDataField)
+		return &inputPassportElementErrorSourceDataField, err
+
+	case "inputPassportElementErrorSourceFrontSide":
+		var inputPassportElementErrorSourceFrontSide InputPassportElementErrorSourceFrontSide
+		err := jsonUnmarshal(rawMsg, &inputPassportElementErrorSourceFrontSide)
+		return &inputPassportElementErrorSourceFrontSide, err
+
+	case "inputPassportElementErrorSourceReverseSide":
+		var inputPassportElementErrorSourceReverseSide InputPassportElementErrorSourceReverseSide
+		err := jsonUnmarshal(rawMsg, &inputPassportElementErrorSourceReverseSide)
+		return &inputPassportElementErrorSourceReverseSide, err
+
+	case "inputPassportElementErrorSourceSelfie":
+		var inputPassportElementErrorSourceSelfie InputPassportElementErrorSourceSelfie
+		err := jsonUnmarshal(rawMsg, &inputPassportElementErrorSourceSelfie)
+		return &inputPassportElementErrorSourceSelfie, err
+
+	case "inputPassportElementErrorSourceTranslationFile":
+		var inputPassportElementErrorSourceTranslationFile InputPassportElementErrorSourceTranslationFile
+		err := jsonUnmarshal(rawMsg, &inputPassportElementErrorSourceTranslationFile)
+		return &inputPassportElementErrorSourceTranslationFile, err
+
+	case "inputPassportElementErrorSourceTranslationFiles":
+		var inputPassportElementErrorSourceTranslationFiles InputPassportElementErrorSourceTranslationFiles
+		err := jsonUnmarshal(rawMsg, &inputPassportElementErrorSourceTranslationFiles)
+		return &inputPassportElementErrorSourceTranslationFiles, err
+
+	case "inputPassportElementErrorSourceFile":
+		var inputPassportElementErrorSourceFile InputPassportElementErrorSourceFile
+		err := jsonUnmarshal(rawMsg, &inputPassportElementErrorSourceFile)
+		return &inputPassportElementErrorSourceFile, err
+
+	case "inputPassportElementErrorSourceFiles":
+		var inputPassportElementErrorSourceFiles InputPassportElementErrorSourceFiles
+		err := jsonUnmarshal(rawMsg, &inputPassportElementErrorSourceFiles)
+		return &inputPassportElementErrorSourceFiles, err
+
+	case "inputPersonalDocument":
+		var inputPersonalDocument InputPersonalDocument
+		err := jsonUnmarshal(rawMsg, &inputPersonalDocument)
+		return &inputPersonalDocument, err
+
+	case "inputStickerStatic":
+		var inputStickerStatic InputStickerStatic
+		err := jsonUnmarshal(rawMsg, &inputStickerStatic)
+		return &inputStickerStatic, err
+
+	case "inputStickerAnimated":
+		var inputStickerAnimated InputStickerAnimated
+		err := jsonUnmarshal(rawMsg, &inputStickerAnimated)
+		return &inputStickerAnimated, err
+
+	case "inputThumbnail":
+		var inputThumbnail InputThumbnail
+		err := jsonUnmarshal(rawMsg, &inputThumbnail)
+		return &inputThumbnail, err
+
+	case "internalLinkTypeActiveSessions":
+		var internalLinkTypeActiveSessions InternalLinkTypeActiveSessions
+		err := jsonUnmarshal(rawMsg, &internalLinkTypeActiveSessions)
+		return &internalLinkTypeActiveSessions, err
+
+	case "internalLinkTypeAuthenticationCode":
+		var internalLinkTypeAuthenticationCode InternalLinkTypeAuthenticationCode
+		err := jsonUnmarshal(rawMsg, &internalLinkTypeAuthenticationCode)
+		return &internalLinkTypeAuthenticationCode, err
+
+	case "internalLinkTypeBackground":
+		var internalLinkTypeBackground InternalLinkTypeBackground
+		err := jsonUnmarshal(rawMsg, &internalLinkTypeBackground)
+		return &internalLinkTypeBackground, err
+
+	case "internalLinkTypeBotStart":
+		var internalLinkTypeBotStart InternalLinkTypeBotStart
+		err := jsonUnmarshal(rawMsg, &internalLinkTypeBotStart)
+		return &internalLinkTypeBotStart, err
+
+	case "internalLinkTypeBotStartInGroup":
+		var internalLinkTypeBotStartInGroup InternalLinkTypeBotStartInGroup
+		err := jsonUnmarshal(rawMsg, &internalLinkTypeBotStartInGroup)
+		return &internalLinkTypeBotStartInGroup, err
+
+	case "internalLinkTypeChangePhoneNumber":
+		var internalLinkTypeChangePhoneNumber InternalLinkTypeChangePhoneNumber
+		err := jsonUnmarshal(rawMsg, &internalLinkTypeChangePhoneNumber)
+		return &internalLinkTypeChangePhoneNumber, err
+
+	case "internalLinkTypeChatInvite":
+		var internalLinkTypeChatInvite InternalLinkTypeChatInvite
+		err := jsonUnmarshal(rawMsg, &internalLinkTypeChatInvite)
+		return &internalLinkTypeChatInvite, err
+
+	case "internalLinkTypeFilterSettings":
+		var internalLinkTypeFilterSettings InternalLinkTypeFilterSettings
+		err := jsonUnmarshal(rawMsg, &internalLinkTypeFilterSettings)
+		return &internalLinkTypeFilterSettings, err
+
+	case "internalLinkTypeGame":
+		var internalLinkTypeGame InternalLinkTypeGame
+		err := jsonUnmarshal(rawMsg, &internalLinkTypeGame)
+		return &internalLinkTypeGame, err
+
+	case "internalLinkTypeLanguagePack":
+		var internalLinkTypeLanguagePack InternalLinkTypeLanguagePack
+		err := jsonUnmarshal(rawMsg, &internalLinkTypeLanguagePack)
+		return &internalLinkTypeLanguagePack, err
+
+	case "internalLinkTypeMessage":
+		var internalLinkTypeMessage InternalLinkTypeMessage
+		err := jsonUnmarshal(rawMsg, &internalLinkTypeMessage)
+		return &internalLinkTypeMessage, err
+
+	case "internalLinkTypeMessageDraft":
+		var internalLinkTypeMessageDraft InternalLinkTypeMessageDraft
+		err := jsonUnmarshal(rawMsg, &internalLinkTypeMessageDraft)
+		return &internalLinkTypeMessageDraft, err
+
+	case "internalLinkTypePassportDataRequest":
+		var internalLinkTypePassportDataRequest InternalLinkTypePassportDataRequest
+		err := jsonUnmarshal(rawMsg, &internalLinkTypePassportDataRequest)
+		return &internalLinkTypePassportDataRequest, err
+
+	case "internalLinkTypePhoneNumberConfirmation":
+		var internalLinkTypePhoneNumberConfirmation InternalLinkTypePhoneNumberConfirmation
+		err := jsonUnmarshal(rawMsg, &internalLinkTypePhoneNumberConfirmation)
+		return &internalLinkTypePhoneNumberConfirmation, err
+
+	case "internalLinkTypeProxy":
+		var internalLinkTypeProxy InternalLinkTypeProxy
+		err := jsonUnmarshal(rawMsg, &internalLinkTypeProxy)
+		return &internalLinkTypeProxy, err
+
+	case "internalLinkTypePublicChat":
+		var internalLinkTypePublicChat InternalLinkTypePublicChat
+		err := jsonUnmarshal(rawMsg, &internalLinkTypePublicChat)
+		return &internalLinkTypePublicChat, err
+
+	case "internalLinkTypeQrCodeAuthentication":
+		var internalLinkTypeQrCodeAuthentication InternalLinkTypeQrCodeAuthentication
+		err := jsonUnmarshal(rawMsg, &internalLinkTypeQrCodeAuthentication)
+		return &internalLinkTypeQrCodeAuthentication, err
+
+	case "internalLinkTypeSettings":
+		var internalLinkTypeSettings InternalLinkTypeSettings
+		err := jsonUnmarshal(rawMsg, &internalLinkTypeSettings)
+		return &internalLinkTypeSettings, err
+
+	case "internalLinkTypeStickerSet":
+		var internalLinkTypeStickerSet InternalLinkTypeStickerSet
+		err := jsonUnmarshal(rawMsg, &internalLinkTypeStickerSet)
+		return &internalLinkTypeStickerSet, err
+
+	case "internalLinkTypeTheme":
+		var internalLinkTypeTheme InternalLinkTypeTheme
+		err := jsonUnmarshal(rawMsg, &internalLinkTypeTheme)
+		return &internalLinkTypeTheme, err
+
+	case "internalLinkTypeThemeSettings":
+		var internalLinkTypeThemeSettings InternalLinkTypeThemeSettings
+		err := jsonUnmarshal(rawMsg, &internalLinkTypeThemeSettings)
+		return &internalLinkTypeThemeSettings, err
+
+	case "internalLinkTypeUnknownDeepLink":
+		var internalLinkTypeUnknownDeepLink InternalLinkTypeUnknownDeepLink
+		err := jsonUnmarshal(rawMsg, &internalLinkTypeUnknownDeepLink)
+		return &internalLinkTypeUnknownDeepLink, err
+
+	case "internalLinkTypeUnsupportedProxy":
+		var internalLinkTypeUnsupportedProxy InternalLinkTypeUnsupportedProxy
+		err := jsonUnmarshal(rawMsg, &internalLinkTypeUnsupportedProxy)
+		return &internalLinkTypeUnsupportedProxy, err
+
+	case "internalLinkTypeVideoChat":
+		var internalLinkTypeVideoChat InternalLinkTypeVideoChat
+		err := jsonUnmarshal(rawMsg, &internalLinkTypeVideoChat)
+		return &internalLinkTypeVideoChat, err
+
+	case "invoice":
+		var invoice Invoice
+		err := jsonUnmarshal(rawMsg, &invoice)
+		return &invoice, err
+
+	case "jsonObjectMember":
+		var jsonObjectMember JsonObjectMember
+		err := jsonUnmarshal(rawMsg, &jsonObjectMember)
+		return &jsonObjectMember, err
+
+	case "jsonValueNull":
+		var jsonValueNull JsonValueNull
+		err := jsonUnmarshal(rawMsg, &jsonValueNull)
+		return &jsonValueNull, err
+
+	case "jsonValueBoolean":
+		var jsonValueBoolean JsonValueBoolean
+		err := jsonUnmarshal(rawMsg, &jsonValueBoolean)
+		return &jsonValueBoolean, err
+
+	case "jsonValueNumber":
+		var jsonValueNumber JsonValueNumber
+		err := jsonUnmarshal(rawMsg, &jsonValueNumber)
+		return &jsonValueNumber, err
+
+	case "jsonValueString":
+		var jsonValueString JsonValueString
+		err := jsonUnmarshal(rawMsg, &jsonValueString)
+		return &jsonValueString, err
+
+	case "jsonValueArray":
+		var jsonValueArray JsonValueArray
+		err := jsonUnmarshal(rawMsg, &jsonValueArray)
+		return &jsonValueArray, err
+
+	case "jsonValueObject":
+		var jsonValueObject JsonValueObject
+		err := jsonUnmarshal(rawMsg, &jsonValueObject)
+		return &jsonValueObject, err
+
+	case "keyboardButton":
+		var keyboardButton KeyboardButton
+		err := jsonUnmarshal(rawMsg, &keyboardButton)
+		return &keyboardButton, err
+
+	case "keyboardButtonTypeText":
+		var keyboardButtonTypeText KeyboardButtonTypeText
+		err := jsonUnmarshal(rawMsg, &keyboardButtonTypeText)
+		return &keyboardButtonTypeText, err
+
+	case "keyboardButtonTypeRequestPhoneNumber":
+		var keyboardButtonTypeRequestPhoneNumber KeyboardButtonTypeRequestPhoneNumber
+		err := jsonUnmarshal(rawMsg, &keyboardButtonTypeRequestPhoneNumber)
+		return &keyboardButtonTypeRequestPhoneNumber, err
+
+	case "keyboardButtonTypeRequestLocation":
+		var keyboardButtonTypeRequestLocation KeyboardButtonTypeRequestLocation
+		err := jsonUnmarshal(rawMsg, &keyboardButtonTypeRequestLocation)
+		return &keyboardButtonTypeRequestLocation, err
+
+	case "keyboardButtonTypeRequestPoll":
+		var keyboardButtonTypeRequestPoll KeyboardButtonTypeRequestPoll
+		err := jsonUnmarshal(rawMsg, &keyboardButtonTypeRequestPoll)
+		return &keyboardButtonTypeRequestPoll, err
+
+	case "labeledPricePart":
+		var labeledPricePart LabeledPricePart
+		err := jsonUnmarshal(rawMsg, &labeledPricePart)
+		return &labeledPricePart, err
+
+	case "languagePackInfo":
+		var languagePackInfo LanguagePackInfo
+		err := jsonUnmarshal(rawMsg, &languagePackInfo)
+		return &languagePackInfo, err
+
+	case "languagePackString":
+		var languagePackString LanguagePackString
+		err := jsonUnmarshal(rawMsg, &languagePackString)
+		return &languagePackString, err
+
+	case "languagePackStringValueOrdinary":
+		var languagePackStringValueOrdinary LanguagePackStringValueOrdinary
+		err := jsonUnmarshal(rawMsg, &languagePackStringValueOrdinary)
+		return &languagePackStringValueOrdinary, err
+
+	case "languagePackStringValuePluralized":
+		var languagePackStringValuePluralized LanguagePackStringValuePluralized
+		err := jsonUnmarshal(rawMsg, &languagePackStringValuePluralized)
+		return &languagePackStringValuePluralized, err
+
+	case "languagePackStringValueDeleted":
+		var languagePackStringValueDeleted LanguagePackStringValueDeleted
+		err := jsonUnmarshal(rawMsg, &languagePackStringValueDeleted)
+		return &languagePackStringValueDeleted, err
+
+	case "languagePackStrings":
+		var languagePackStrings LanguagePackStrings
+		err := jsonUnmarshal(rawMsg, &languagePackStrings)
+		return &languagePackStrings, err
+
+	case "localFile":
+		var localFile LocalFile
+		err := jsonUnmarshal(rawMsg, &localFile)
+		return &localFile, err
+
+	case "localizationTargetInfo":
+		var localizationTargetInfo LocalizationTargetInfo
+		err := jsonUnmarshal(rawMsg, &localizationTargetInfo)
+		return &localizationTargetInfo, err
+
+	case "location":
+		var location Location
+		err := jsonUnmarshal(rawMsg, &location)
+		return &location, err
+
+	case "logStreamDefault":
+		var logStreamDefault LogStreamDefault
+		err := jsonUnmarshal(rawMsg, &logStreamDefault)
+		return &logStreamDefault, err
+
+	case "logStreamFile":
+		var logStreamFile LogStreamFile
+		err := jsonUnmarshal(rawMsg, &logStreamFile)
+		return &logStreamFile, err
+
+	case "logStreamEmpty":
+		var logStreamEmpty LogStreamEmpty
+		err := jsonUnmarshal(rawMsg, &logStreamEmpty)
+		return &logStreamEmpty, err
+
+	case "logTags":
+		var logTags LogTags
+		err := jsonUnmarshal(rawMsg, &logTags)
+		return &logTags, err
+
+	case "logVerbosityLevel":
+		var logVerbosityLevel LogVerbosityLevel
+		err := jsonUnmarshal(rawMsg, &logVerbosityLevel)
+		return &logVerbosityLevel, err
+
+	case "loginUrlInfoOpen":
+		var loginUrlInfoOpen LoginUrlInfoOpen
+		err := jsonUnmarshal(rawMsg, &loginUrlInfoOpen)
+		return &loginUrlInfoOpen, err
+
+	case "loginUrlInfoRequestConfirmation":
+		var loginUrlInfoRequestConfirmation LoginUrlInfoRequestConfirmation
+		err := jsonUnmarshal(rawMsg, &loginUrlInfoRequestConfirmation)
+		return &loginUrlInfoRequestConfirmation, err
+
+	case "maskPointForehead":
+		var maskPointForehead MaskPointForehead
+		err := jsonUnmarshal(rawMsg, &maskPointForehead)
+		return &maskPointForehead, err
+
+	case "maskPointEyes":
+		var maskPointEyes MaskPointEyes
+		err := jsonUnmarshal(rawMsg, &maskPointEyes)
+		return &maskPointEyes, err
+
+	case "maskPointMouth":
+		var maskPointMouth MaskPointMouth
+		err := jsonUnmarshal(rawMsg, &maskPointMouth)
+		return &maskPointMouth, err
+
+	case "maskPointChin":
+		var maskPointChin MaskPointChin
+		err := jsonUnmarshal(rawMsg, &maskPointChin)
+		return &maskPointChin, err
+
+	case "maskPosition":
+		var maskPosition MaskPosition
+		err := jsonUnmarshal(rawMsg, &maskPosition)
+		return &maskPosition, err
+
+	case "message":
+		var message Message
+		err := jsonUnmarshal(rawMsg, &message)
+		return &message, err
+
+	case "messageCalendar":
+		var messageCalendar MessageCalendar
+		err := jsonUnmarshal(rawMsg, &messageCalendar)
+		return &messageCalendar, err
+
+	case "messageCalendarDay":
+		var messageCalendarDay MessageCalendarDay
+		err := jsonUnmarshal(rawMsg, &messageCalendarDay)
+		return &messageCalendarDay, err
+
+	case "messageText":
+		var messageText MessageText
+		err := jsonUnmarshal(rawMsg, &messageText)
+		return &messageText, err
+
+	case "messageAnimation":
+		var messageAnimation MessageAnimation
+		err := jsonUnmarshal(rawMsg, &messageAnimation)
+		return &messageAnimation, err
+
+	case "messageAudio":
+		var messageAudio MessageAudio
+		err := jsonUnmarshal(rawMsg, &messageAudio)
+		return &messageAudio, err
+
+	case "messageDocument":
+		var messageDocument MessageDocument
+		err := jsonUnmarshal(rawMsg, &messageDocument)
+		return &messageDocument, err
+
+	case "messagePhoto":
+		var messagePhoto MessagePhoto
+		err := jsonUnmarshal(rawMsg, &messagePhoto)
+		return &messagePhoto, err
+
+	case "messageExpiredPhoto":
+		var messageExpiredPhoto MessageExpiredPhoto
+		err := jsonUnmarshal(rawMsg, &messageExpiredPhoto)
+		return &messageExpiredPhoto, err
+
+	case "messageSticker":
+		var messageSticker MessageSticker
+		err := jsonUnmarshal(rawMsg, &messageSticker)
+		return &messageSticker, err
+
+	case "messageVideo":
+		var messageVideo MessageVideo
+		err := jsonUnmarshal(rawMsg, &messageVideo)
+		return &messageVideo, err
+
+	case "messageExpiredVideo":
+		var messageExpiredVideo MessageExpiredVideo
+		err := jsonUnmarshal(rawMsg, &messageExpiredVideo)
+		return &messageExpiredVideo, err
+
+	case "messageVideoNote":
+		var messageVideoNote MessageVideoNote
+		err := jsonUnmarshal(rawMsg, &messageVideoNote)
+		return &messageVideoNote, err
+
+	case "messageVoiceNote":
+		var messageVoiceNote MessageVoiceNote
+		err := jsonUnmarshal(rawMsg, &messageVoiceNote)
+		return &messageVoiceNote, err
+
+	case "messageLocation":
+		var messageLocation MessageLocation
+		err := jsonUnmarshal(rawMsg, &messageLocation)
+		return &messageLocation, err
+
+	case "messageVenue":
+		var messageVenue MessageVenue
+		err := jsonUnmarshal(rawMsg, &messageVenue)
+		return &messageVenue, err
+
+	case "messageContact":
+		var messageContact MessageContact
+		err := jsonUnmarshal(rawMsg, &messageContact)
+		return &messageContact, err
+
+	case "messageAnimatedEmoji":
+		var messageAnimatedEmoji MessageAnimatedEmoji
+		err := jsonUnmarshal(rawMsg, &messageAnimatedEmoji)
+		return &messageAnimatedEmoji, err
+
+	case "messageDice":
+		var messageDice MessageDice
+		err := jsonUnmarshal(rawMsg, &messageDice)
+		return &messageDice, err
+
+	case "messageGame":
+		var messageGame MessageGame
+		err := jsonUnmarshal(rawMsg, &messageGame)
+		return &messageGame, err
+
+	case "messagePoll":
+		var messagePoll MessagePoll
+		err := jsonUnmarshal(rawMsg, &messagePoll)
+		return &messagePoll, err
+
+	case "messageInvoice":
+		var messageInvoice MessageInvoice
+		err := jsonUnmarshal(rawMsg, &messageInvoice)
+		return &messageInvoice, err
+
+	case "messageCall":
+		var messageCall MessageCall
+		err := jsonUnmarshal(rawMsg, &messageCall)
+		return &messageCall, err
+
+	case "messageVideoChatScheduled":
+		var messageVideoChatScheduled MessageVideoChatScheduled
+		err := jsonUnmarshal(rawMsg, &messageVideoChatScheduled)
+		return &messageVideoChatScheduled, err
+
+	case "messageVideoChatStarted":
+		var messageVideoChatStarted MessageVideoChatStarted
+		err := jsonUnmarshal(rawMsg, &messageVideoChatStarted)
+		return &messageVideoChatStarted, err
+
+	case "messageVideoChatEnded":
+		var messageVideoChatEnded MessageVideoChatEnded
+		err := jsonUnmarshal(rawMsg, &messageVideoChatEnded)
+		return &messageVideoChatEnded, err
+
+	case "messageInviteVideoChatParticipants":
+		var messageInviteVideoChatParticipants MessageInviteVideoChatParticipants
+		err := jsonUnmarshal(rawMsg, &messageInviteVideoChatParticipants)
+		return &messageInviteVideoChatParticipants, err
+
+	case "messageBasicGroupChatCreate":
+		var messageBasicGroupChatCreate MessageBasicGroupChatCreate
+		err := jsonUnmarshal(rawMsg, &messageBasicGroupChatCreate)
+		return &messageBasicGroupChatCreate, err
+
+	case "messageSupergroupChatCreate":
+		var messageSupergroupChatCreate MessageSupergroupChatCreate
+		err := jsonUnmarshal(rawMsg, &messageSupergroupChatCreate)
+		return &messageSupergroupChatCreate, err
+
+	case "messageChatChangeTitle":
+		var messageChatChangeTitle MessageChatChangeTitle
+		err := jsonUnmarshal(rawMsg, &messageChatChangeTitle)
+		return &messageChatChangeTitle, err
+
+	case "messageChatChangePhoto":
+		var messageChatChangePhoto MessageChatChangePhoto
+		err := jsonUnmarshal(rawMsg, &messageChatChangePhoto)
+		return &messageChatChangePhoto, err
+
+	case "messageChatDeletePhoto":
+		var messageChatDeletePhoto MessageChatDeletePhoto
+		err := jsonUnmarshal(rawMsg, &messageChatDeletePhoto)
+		return &messageChatDeletePhoto, err
+
+	case "messageChatAddMembers":
+		var messageChatAddMembers MessageChatAddMembers
+		err := jsonUnmarshal(rawMsg, &messageChatAddMembers)
+		return &messageChatAddMembers, err
+
+	case "messageChatJoinByLink":
+		var messageChatJoinByLink MessageChatJoinByLink
+		err := jsonUnmarshal(rawMsg, &messageChatJoinByLink)
+		return &messageChatJoinByLink, err
+
+	case "messageChatJoinByRequest":
+		var messageChatJoinByRequest MessageChatJoinByRequest
+		err := jsonUnmarshal(rawMsg, &messageChatJoinByRequest)
+		return &messageChatJoinByRequest, err
+
+	case "messageChatDeleteMember":
+		var messageChatDeleteMember MessageChatDeleteMember
+		err := jsonUnmarshal(rawMsg, &messageChatDeleteMember)
+		return &messageChatDeleteMember, err
+
+	case "messageChatUpgradeTo":
+		var messageChatUpgradeTo MessageChatUpgradeTo
+		err := jsonUnmarshal(rawMsg, &messageChatUpgradeTo)
+		return &messageChatUpgradeTo, err
+
+	case "messageChatUpgradeFrom":
+		var messageChatUpgradeFrom MessageChatUpgradeFrom
+		err := jsonUnmarshal(rawMsg, &messageChatUpgradeFrom)
+		return &messageChatUpgradeFrom, err
+
+	case "messagePinMessage":
+		var messagePinMessage MessagePinMessage
+		err := jsonUnmarshal(rawMsg, &messagePinMessage)
+		return &messagePinMessage, err
+
+	case "messageScreenshotTaken":
+		var messageScreenshotTaken MessageScreenshotTaken
+		err := jsonUnmarshal(rawMsg, &messageScreenshotTaken)
+		return &messageScreenshotTaken, err
+
+	case "messageChatSetTheme":
+		var messageChatSetTheme MessageChatSetTheme
+		err := jsonUnmarshal(rawMsg, &messageChatSetTheme)
+		return &messageChatSetTheme, err
+
+	case "messageChatSetTtl":
+		var messageChatSetTtl MessageChatSetTtl
+		err := jsonUnmarshal(rawMsg, &messageChatSetTtl)
+		return &messageChatSetTtl, err
+
+	case "messageCustomServiceAction":
+		var messageCustomServiceAction MessageCustomServiceAction
+		err := jsonUnmarshal(rawMsg, &messageCustomServiceAction)
+		return &messageCustomServiceAction, err
+
+	case "messageGameScore":
+		var messageGameScore MessageGameScore
+		err := jsonUnmarshal(rawMsg, &messageGameScore)
+		return &messageGameScore, err
+
+	case "messagePaymentSuccessful":
+		var messagePaymentSuccessful MessagePaymentSuccessful
+		err := jsonUnmarshal(rawMsg, &messagePaymentSuccessful)
+		return &messagePaymentSuccessful, err
+
+	case "messagePaymentSuccessfulBot":
+		var messagePaymentSuccessfulBot MessagePaymentSuccessfulBot
+		err := jsonUnmarshal(rawMsg, &messagePaymentSuccessfulBot)
+		return &messagePaymentSuccessfulBot, err
+
+	case "messageContactRegistered":
+		var messageContactRegistered MessageContactRegistered
+		err := jsonUnmarshal(rawMsg, &messageContactRegistered)
+		return &messageContactRegistered, err
+
+	case "messageWebsiteConnected":
+		var messageWebsiteConnected MessageWebsiteConnected
+		err := jsonUnmarshal(rawMsg, &messageWebsiteConnected)
+		return &messageWebsiteConnected, err
+
+	case "messagePassportDataSent":
+		var messagePassportDataSent MessagePassportDataSent
+		err := jsonUnmarshal(rawMsg, &messagePassportDataSent)
+		return &messagePassportDataSent, err
+
+	case "messagePassportDataReceived":
+		var messagePassportDataReceived MessagePassportDataReceived
+		err := jsonUnmarshal(rawMsg, &messagePassportDataReceived)
+		return &messagePassportDataReceived, err
+
+	case "messageProximityAlertTriggered":
+		var messageProximityAlertTriggered MessageProximityAlertTriggered
+		err := jsonUnmarshal(rawMsg, &messageProximityAlertTriggered)
+		return &messageProximityAlertTriggered, err
+
+	case "messageUnsupported":
+		var messageUnsupported MessageUnsupported
+		err := jsonUnmarshal(rawMsg, &messageUnsupported)
+		return &messageUnsupported, err
+
+	case "messageCopyOptions":
+		var messageCopyOptions MessageCopyOptions
+		err := jsonUnmarshal(rawMsg, &messageCopyOptions)
+		return &messageCopyOptions, err
+
+	case "messageFileTypePrivate":
+		var messageFileTypePrivate MessageFileTypePrivate
+		err := jsonUnmarshal(rawMsg, &messageFileTypePrivate)
+		return &messageFileTypePrivate, err
+
+	case "messageFileTypeGroup":
+		var messageFileTypeGroup MessageFileTypeGroup
+		err := jsonUnmarshal(rawMsg, &messageFileTypeGroup)
+		return &messageFileTypeGroup, err
+
+	case "messageFileTypeUnknown":
+		var messageFileTypeUnknown MessageFileTypeUnknown
+		err := jsonUnmarshal(rawMsg, &messageFileTypeUnknown)
+		return &messageFileTypeUnknown, err
+
+	case "messageForwardInfo":
+		var messageForwardInfo MessageForwardInfo
+		err := jsonUnmarshal(rawMsg, &messageForwardInfo)
+		return &messageForwardInfo, err
+
+	case "messageForwardOriginUser":
+		var messageForwardOriginUser MessageForwardOriginUser
+		err := jsonUnmarshal(rawMsg, &messageForwardOriginUser)
+		return &messageForwardOriginUser, err
+
+	case "messageForwardOriginChat":
+		var messageForwardOriginChat MessageForwardOriginChat
+		err := jsonUnmarshal(rawMsg, &messageForwardOriginChat)
+		return &messageForwardOriginChat, err
+
+	case "messageForwardOriginHiddenUser":
+		var messageForwardOriginHiddenUser MessageForwardOriginHiddenUser
+		err := jsonUnmarshal(rawMsg, &messageForwardOriginHiddenUser)
+		return &messageForwardOriginHiddenUser, err
+
+	case "messageForwardOriginChannel":
+		var messageForwardOriginChannel MessageForwardOriginChannel
+		err := jsonUnmarshal(rawMsg, &messageForwardOriginChannel)
+		return &messageForwardOriginChannel, err
+
+	case "messageForwardOriginMessageImport":
+		var messageForwardOriginMessageImport MessageForwardOriginMessageImport
+		err := jsonUnmarshal(rawMsg, &messageForwardOriginMessageImport)
+		return &messageForwardOriginMessageImport, err
+
+	case "messageInteractionInfo":
+		var messageInteractionInfo MessageInteractionInfo
+		err := jsonUnmarshal(rawMsg, &messageInteractionInfo)
+		return &messageInteractionInfo, err
+
+	case "messageLink":
+		var messageLink MessageLink
+		err := jsonUnmarshal(rawMsg, &messageLink)
+		return &messageLink, err
+
+	case "messageLinkInfo":
+		var messageLinkInfo MessageLinkInfo
+		err := jsonUnmarshal(rawMsg, &messageLinkInfo)
+		return &messageLinkInfo, err
+
+	case "messagePosition":
+		var messagePosition MessagePosition
+		err := jsonUnmarshal(rawMsg, &messagePosition)
+		return &messagePosition, err
+
+	case "messagePositions":
+		var messagePositions MessagePositions
+		err := jsonUnmarshal(rawMsg, &messagePositions)
+		return &messagePositions, err
+
+	case "messageReplyInfo":
+		var messageReplyInfo MessageReplyInfo
+		err := jsonUnmarshal(rawMsg, &messageReplyInfo)
+		return &messageReplyInfo, err
+
+	case "messageSchedulingStateSendAtDate":
+		var messageSchedulingStateSendAtDate MessageSchedulingStateSendAtDate
+		err := jsonUnmarshal(rawMsg, &messageSchedulingStateSendAtDate)
+		return &messageSchedulingStateSendAtDate, err
+
+	case "messageSchedulingStateSendWhenOnline":
+		var messageSchedulingStateSendWhenOnline MessageSchedulingStateSendWhenOnline
+		err := jsonUnmarshal(rawMsg, &messageSchedulingStateSendWhenOnline)
+		return &messageSchedulingStateSendWhenOnline, err
+
+	case "messageSendOptions":
+		var messageSendOptions MessageSendOptions
+		err := jsonUnmarshal(rawMsg, &messageSendOptions)
+		return &messageSendOptions, err
+
+	case "messageSenderUser":
+		var messageSenderUser MessageSenderUser
+		err := jsonUnmarshal(rawMsg, &messageSenderUser)
+		return &messageSenderUser, err
+
+	case "messageSenderChat":
+		var messageSenderChat MessageSenderChat
+		err := jsonUnmarshal(rawMsg, &messageSenderChat)
+		return &messageSenderChat, err
+
+	case "messageSenders":
+		var messageSenders MessageSenders
+		err := jsonUnmarshal(rawMsg, &messageSenders)
+		return &messageSenders, err
+
+	case "messageSendingStatePending":
+		var messageSendingStatePending MessageSendingStatePending
+		err := jsonUnmarshal(rawMsg, &messageSendingStatePending)
+		return &messageSendingStatePending, err
+
+	case "messageSendingStateFailed":
+		var messageSendingStateFailed MessageSendingStateFailed
+		err := jsonUnmarshal(rawMsg, &messageSendingStateFailed)
+		return &messageSendingStateFailed, err
+
+	case "messageStatistics":
+		var messageStatistics MessageStatistics
+		err := jsonUnmarshal(rawMsg, &messageStatistics)
+		return &messageStatistics, err
+
+	case "messageThreadInfo":
+		var messageThreadInfo MessageThreadInfo
+		err := jsonUnmarshal(rawMsg, &messageThreadInfo)
+		return &messageThreadInfo, err
+
+	case "messages":
+		var messages Messages
+		err := jsonUnmarshal(rawMsg, &messages)
+		return &messages, err
+
+	case "minithumbnail":
+		var minithumbnail Minithumbnail
+		err := jsonUnmarshal(rawMsg, &minithumbnail)
+		return &minithumbnail, err
+
+	case "networkStatistics":
+		var networkStatistics NetworkStatistics
+		err := jsonUnmarshal(rawMsg, &networkStatistics)
+		return &networkStatistics, err
+
+	case "networkStatisticsEntryFile":
+		var networkStatisticsEntryFile NetworkStatisticsEntryFile
+		err := jsonUnmarshal(rawMsg, &networkStatisticsEntryFile)
+		return &networkStatisticsEntryFile, err
+
+	case "networkStatisticsEntryCall":
+		var networkStatisticsEntryCall NetworkStatisticsEntryCall
+		err := jsonUnmarshal(rawMsg, &networkStatisticsEntryCall)
+		return &networkStatisticsEntryCall, err
+
+	case "networkTypeNone":
+		var networkTypeNone NetworkTypeNone
+		err := jsonUnmarshal(rawMsg, &networkTypeNone)
+		return &networkTypeNone, err
+
+	case "networkTypeMobile":
+		var networkTypeMobile NetworkTypeMobile
+		err := jsonUnmarshal(rawMsg, &networkTypeMobile)
+		return &networkTypeMobile, err
+
+	case "networkTypeMobileRoaming":
+		var networkTypeMobileRoaming NetworkTypeMobileRoaming
+		err := jsonUnmarshal(rawMsg, &networkTypeMobileRoaming)
+		return &networkTypeMobileRoaming, err
+
+	case "networkTypeWiFi":
+		var networkTypeWiFi NetworkTypeWiFi
+		err := jsonUnmarshal(rawMsg, &networkTypeWiFi)
+		return &networkTypeWiFi, err
+
+	case "networkTypeOther":
+		var networkTypeOther NetworkTypeOther
+		err := jsonUnmarshal(rawMsg, &networkTypeOther)
+		return &networkTypeOther, err
+
+	case "notification":
+		var notification Notification
+		err := jsonUnmarshal(rawMsg, &notification)
+		return &notification, err
+
+	case "notificationGroup":
+		var notificationGroup NotificationGroup
+		err := jsonUnmarshal(rawMsg, &notificationGroup)
+		return &notificationGroup, err
+
+	case "notificationGroupTypeMessages":
+		var notificationGroupTypeMessages NotificationGroupTypeMessages
+		err := jsonUnmarshal(rawMsg, &notificationGroupTypeMessages)
+		return &notificationGroupTypeMessages, err
+
+	case "notificationGroupTypeMentions":
+		var notificationGroupTypeMentions NotificationGroupTypeMentions
+		err := jsonUnmarshal(rawMsg, &notificationGroupTypeMentions)
+		return &notificationGroupTypeMentions, err
+
+	case "notificationGroupTypeSecretChat":
+		var notificationGroupTypeSecretChat NotificationGroupTypeSecretChat
+		err := jsonUnmarshal(rawMsg, &notificationGroupTypeSecretChat)
+		return &notificationGroupTypeSecretChat, err
+
+	case "notificationGroupTypeCalls":
+		var notificationGroupTypeCalls NotificationGroupTypeCalls
+		err := jsonUnmarshal(rawMsg, &notificationGroupTypeCalls)
+		return &notificationGroupTypeCalls, err
+
+	case "notificationSettingsScopePrivateChats":
+		var notificationSettingsScopePrivateChats NotificationSettingsScopePrivateChats
+		err := jsonUnmarshal(rawMsg, &notificationSettingsScopePrivateChats)
+		return &notificationSettingsScopePrivateChats, err
+
+	case "notificationSettingsScopeGroupChats":
+		var notificationSettingsScopeGroupChats NotificationSettingsScopeGroupChats
+		err := jsonUnmarshal(rawMsg, &notificationSettingsScopeGroupChats)
+		return &notificationSettingsScopeGroupChats, err
+
+	case "notificationSettingsScopeChannelChats":
+		var notificationSettingsScopeChannelChats NotificationSettingsScopeChannelChats
+		err := jsonUnmarshal(rawMsg, &notificationSettingsScopeChannelChats)
+		return &notificationSettingsScopeChannelChats, err
+
+	case "notificationTypeNewMessage":
+		var notificationTypeNewMessage NotificationTypeNewMessage
+		err := jsonUnmarshal(rawMsg, &notificationTypeNewMessage)
+		return &notificationTypeNewMessage, err
+
+	case "notificationTypeNewSecretChat":
+		var notificationTypeNewSecretChat NotificationTypeNewSecretChat
+		err := jsonUnmarshal(rawMsg, &notificationTypeNewSecretChat)
+		return &notificationTypeNewSecretChat, err
+
+	case "notificationTypeNewCall":
+		var notificationTypeNewCall NotificationTypeNewCall
+		err := jsonUnmarshal(rawMsg, &notificationTypeNewCall)
+		return &notificationTypeNewCall, err
+
+	case "notificationTypeNewPushMessage":
+		var notificationTypeNewPushMessage NotificationTypeNewPushMessage
+		err := jsonUnmarshal(rawMsg, &notificationTypeNewPushMessage)
+		return &notificationTypeNewPushMessage, err
+
+	case "ok":
+		var ok Ok
+		err := jsonUnmarshal(rawMsg, &ok)
+		return &ok, err
+
+	case "optionValueBoolean":
+		var optionValueBoolean OptionValueBoolean
+		err := jsonUnmarshal(rawMsg, &optionValueBoolean)
+		return &optionValueBoolean, err
+
+	case "optionValueEmpty":
+		var optionValueEmpty OptionValueEmpty
+		err := jsonUnmarshal(rawMsg, &optionValueEmpty)
+		return &optionValueEmpty, err
+
+	case "optionValueInteger":
+		var optionValueInteger OptionValueInteger
+		err := jsonUnmarshal(rawMsg, &optionValueInteger)
+		return &optionValueInteger, err
+
+	case "optionValueString":
+		var optionValueString OptionValueString
+		err := jsonUnmarshal(rawMsg, &optionValueString)
+		return &optionValueString, err
+
+	case "orderInfo":
+		var orderInfo OrderInfo
+		err := jsonUnmarshal(rawMsg, &orderInfo)
+		return &orderInfo, err
+
+	case "pageBlockTitle":
+		var pageBlockTitle PageBlockTitle
+		err := jsonUnmarshal(rawMsg, &pageBlockTitle)
+		return &pageBlockTitle, err
+
+	case "pageBlockSubtitle":
+		var pageBlockSubtitle PageBlockSubtitle
+		err := jsonUnmarshal(rawMsg, &pageBlockSubtitle)
+		return &pageBlockSubtitle, err
+
+	case "pageBlockAuthorDate":
+		var pageBlockAuthorDate PageBlockAuthorDate
+		err := jsonUnmarshal(rawMsg, &pageBlockAuthorDate)
+		return &pageBlockAuthorDate, err
+
+	case "pageBlockHeader":
+		var pageBlockHeader PageBlockHeader
+		err := jsonUnmarshal(rawMsg, &pageBlockHeader)
+		return &pageBlockHeader, err
+
+	case "pageBlockSubheader":
+		var pageBlockSubheader PageBlockSubheader
+		err := jsonUnmarshal(rawMsg, &pageBlockSubheader)
+		return &pageBlockSubheader, err
+
+	case "pageBlockKicker":
+		var pageBlockKicker PageBlockKicker
+		err := jsonUnmarshal(rawMsg, &pageBlockKicker)
+		return &pageBlockKicker, err
+
+	case "pageBlockParagraph":
+		var pageBlockParagraph PageBlockParagraph
+		err := jsonUnmarshal(rawMsg, &pageBlockParagraph)
+		return &pageBlockParagraph, err
+
+	case "pageBlockPreformatted":
+		var pageBlockPreformatted PageBlockPreformatted
+		err := jsonUnmarshal(rawMsg, &pageBlockPreformatted)
+		return &pageBlockPreformatted, err
+
+	case "pageBlockFooter":
+		var pageBlockFooter PageBlockFooter
+		err := jsonUnmarshal(rawMsg, &pageBlockFooter)
+		return &pageBlockFooter, err
+
+	case "pageBlockDivider":
+		var pageBlockDivider PageBlockDivider
+		err := jsonUnmarshal(rawMsg, &pageBlockDivider)
+		return &pageBlockDivider, err
+
+	case "pageBlockAnchor":
+		var pageBlockAnchor PageBlockAnchor
+		err := jsonUnmarshal(rawMsg, &pageBlockAnchor)
+		return &pageBlockAnchor, err
+
+	case "pageBlockList":
+		var pageBlockList PageBlockList
+		err := jsonUnmarshal(rawMsg, &pageBlockList)
+		return &pageBlockList, err
+
+	case "pageBlockBlockQuote":
+		var pageBlockBlockQuote PageBlockBlockQuote
+		err := jsonUnmarshal(rawMsg, &pageBlockBlockQuote)
+		return &pageBlockBlockQuote, err
+
+	case "pageBlockPullQuote":
+		var pageBlockPullQuote PageBlockPullQuote
+		err := jsonUnmarshal(rawMsg, &pageBlockPullQuote)
+		return &pageBlockPullQuote, err
+
+	case "pageBlockAnimation":
+		var pageBlockAnimation PageBlockAnimation
+		err := jsonUnmarshal(rawMsg, &pageBlockAnimation)
+		return &pageBlockAnimation, err
+
+	case "pageBlockAudio":
+		var pageBlockAudio PageBlockAudio
+		err := jsonUnmarshal(rawMsg, &pageBlockAudio)
+		return &pageBlockAudio, err
+
+	case "pageBlockPhoto":
+		var pageBlockPhoto PageBlockPhoto
+		err := jsonUnmarshal(rawMsg, &pageBlockPhoto)
+		return &pageBlockPhoto, err
+
+	case "pageBlockVideo":
+		var pageBlockVideo PageBlockVideo
+		err := jsonUnmarshal(rawMsg, &pageBlockVideo)
+		return &pageBlockVideo, err
+
+	case "pageBlockVoiceNote":
+		var pageBlockVoiceNote PageBlockVoiceNote
+		err := jsonUnmarshal(rawMsg, &pageBlockVoiceNote)
+		return &pageBlockVoiceNote, err
+
+	case "pageBlockCover":
+		var pageBlockCover PageBlockCover
+		err := jsonUnmarshal(rawMsg, &pageBlockCover)
+		return &pageBlockCover, err
+
+	case "pageBlockEmbedded":
+		var pageBlockEmbedded PageBlockEmbedded
+		err := jsonUnmarshal(rawMsg, &pageBlockEmbedded)
+		return &pageBlockEmbedded, err
+
+	case "pageBlockEmbeddedPost":
+		var pageBlockEmbeddedPost PageBlockEmbeddedPost
+		err := jsonUnmarshal(rawMsg, &pageBlockEmbeddedPost)
+		return &pageBlockEmbeddedPost, err
+
+	case "pageBlockCollage":
+		var pageBlockCollage PageBlockCollage
+		err := jsonUnmarshal(rawMsg, &pageBlockCollage)
+		return &pageBlockCollage, err
+
+	case "pageBlockSlideshow":
+		var pageBlockSlideshow PageBlockSlideshow
+		err := jsonUnmarshal(rawMsg, &pageBlockSlideshow)
+		return &pageBlockSlideshow, err
+
+	case "pageBlockChatLink":
+		var pageBlockChatLink PageBlockChatLink
+		err := jsonUnmarshal(rawMsg, &pageBlockChatLink)
+		return &pageBlockChatLink, err
+
+	case "pageBlockTable":
+		var pageBlockTable PageBlockTable
+		err := jsonUnmarshal(rawMsg, &pageBlockTable)
+		return &pageBlockTable, err
+
+	case "pageBlockDetails":
+		var pageBlockDetails PageBlockDetails
+		err := jsonUnmarshal(rawMsg, &pageBlockDetails)
+		return &pageBlockDetails, err
+
+	case "pageBlockRelatedArticles":
+		var pageBlockRelatedArticles PageBlockRelatedArticles
+		err := jsonUnmarshal(rawMsg, &pageBlockRelatedArticles)
+		return &pageBlockRelatedArticles, err
+
+	case "pageBlockMap":
+		var pageBlockMap PageBlockMap
+		err := jsonUnmarshal(rawMsg, &pageBlockMap)
+		return &pageBlockMap, err
+
+	case "pageBlockCaption":
+		var pageBlockCaption PageBlockCaption
+		err := jsonUnmarshal(rawMsg, &pageBlockCaption)
+		return &pageBlockCaption, err
+
+	case "pageBlockHorizontalAlignmentLeft":
+		var pageBlockHorizontalAlignmentLeft PageBlockHorizontalAlignmentLeft
+		err := jsonUnmarshal(rawMsg, &pageBlockHorizontalAlignmentLeft)
+		return &pageBlockHorizontalAlignmentLeft, err
+
+	case "pageBlockHorizontalAlignmentCenter":
+		var pageBlockHorizontalAlignmentCenter PageBlockHorizontalAlignmentCenter
+		err := jsonUnmarshal(rawMsg, &pageBlockHorizontalAlignmentCenter)
+		return &pageBlockHorizontalAlignmentCenter, err
+
+	case "pageBlockHorizontalAlignmentRight":
+		var pageBlockHorizontalAlignmentRight PageBlockHorizontalAlignmentRight
+		err := jsonUnmarshal(rawMsg, &pageBlockHorizontalAlignmentRight)
+		return &pageBlockHorizontalAlignmentRight, err
+
+	case "pageBlockListItem":
+		var pageBlockListItem PageBlockListItem
+		err := jsonUnmarshal(rawMsg, &pageBlockListItem)
+		return &pageBlockListItem, err
+
+	case "pageBlockRelatedArticle":
+		var pageBlockRelatedArticle PageBlockRelatedArticle
+		err := jsonUnmarshal(rawMsg, &pageBlockRelatedArticle)
+		return &pageBlockRelatedArticle, err
+
+	case "pageBlockTableCell":
+		var pageBlockTableCell PageBlockTableCell
+		err := jsonUnmarshal(rawMsg, &pageBlockTableCell)
+		return &pageBlockTableCell, err
+
+	case "pageBlockVerticalAlignmentTop":
+		var pageBlockVerticalAlignmentTop PageBlockVerticalAlignmentTop
+		err := jsonUnmarshal(rawMsg, &pageBlockVerticalAlignmentTop)
+		return &pageBlockVerticalAlignmentTop, err
+
+	case "pageBlockVerticalAlignmentMiddle":
+		var pageBlockVerticalAlignmentMiddle PageBlockVerticalAlignmentMiddle
+		err := jsonUnmarshal(rawMsg, &pageBlockVerticalAlignmentMiddle)
+		return &pageBlockVerticalAlignmentMiddle, err
+
+	case "pageBlockVerticalAlignmentBottom":
+		var pageBlockVerticalAlignmentBottom PageBlockVerticalAlignmentBottom
+		err := jsonUnmarshal(rawMsg, &pageBlockVerticalAlignmentBottom)
+		return &pageBlockVerticalAlignmentBottom, err
+
+	case "passportAuthorizationForm":
+		var passportAuthorizationForm PassportAuthorizationForm
+		err := jsonUnmarshal(rawMsg, &passportAuthorizationForm)
+		return &passportAuthorizationForm, err
+
+	case "passportElementPersonalDetails":
+		var passportElementPersonalDetails PassportElementPersonalDetails
+		err := jsonUnmarshal(rawMsg, &passportElementPersonalDetails)
+		return &passportElementPersonalDetails, err
+
+	case "passportElementPassport":
+		var passportElementPassport PassportElementPassport
+		err := jsonUnmarshal(rawMsg, &passportElementPassport)
+		return &passportElementPassport, err
+
+	case "passportElementDriverLicense":
+		var passportElementDriverLicense PassportElementDriverLicense
+		err := jsonUnmarshal(rawMsg, &passportElementDriverLicense)
+		return &passportElementDriverLicense, err
+
+	case "passportElementIdentityCard":
+		var passportElementIdentityCard PassportElementIdentityCard
+		err := jsonUnmarshal(rawMsg, &passportElementIdentityCard)
+		return &passportElementIdentityCard, err
+
+	case "passportElementInternalPassport":
+		var passportElementInternalPassport PassportElementInternalPassport
+		err := jsonUnmarshal(rawMsg, &passportElementInternalPassport)
+		return &passportElementInternalPassport, err
+
+	case "passportElementAddress":
+		var passportElementAddress PassportElementAddress
+		err := jsonUnmarshal(rawMsg, &passportElementAddress)
+		return &passportElementAddress, err
+
+	case "passportElementUtilityBill":
+		var passportElementUtilityBill PassportElementUtilityBill
+		err := jsonUnmarshal(rawMsg, &passportElementUtilityBill)
+		return &passportElementUtilityBill, err
+
+	case "passportElementBankStatement":
+		var passportElementBankStatement PassportElementBankStatement
+		err := jsonUnmarshal(rawMsg, &passportElementBankStatement)
+		return &passportElementBankStatement, err
+
+	case "passportElementRentalAgreement":
+		var passportElementRentalAgreement PassportElementRentalAgreement
+		err := jsonUnmarshal(rawMsg, &passportElementRentalAgreement)
+		return &passportElementRentalAgreement, err
+
+	case "passportElementPassportRegistration":
+		var passportElementPassportRegistration PassportElementPassportRegistration
+		err := jsonUnmarshal(rawMsg, &passportElementPassportRegistration)
+		return &passportElementPassportRegistration, err
+
+	case "passportElementTemporaryRegistration":
+		var passportElementTemporaryRegistration PassportElementTemporaryRegistration
+		err := jsonUnmarshal(rawMsg, &passportElementTemporaryRegistration)
+		return &passportElementTemporaryRegistration, err
+
+	case "passportElementPhoneNumber":
+		var passportElementPhoneNumber PassportElementPhoneNumber
+		err := jsonUnmarshal(rawMsg, &passportElementPhoneNumber)
+		return &passportElementPhoneNumber, err
+
+	case "passportElementEmailAddress":
+		var passportElementEmailAddress PassportElementEmailAddress
+		err := jsonUnmarshal(rawMsg, &passportElementEmailAddress)
+		return &passportElementEmailAddress, err
+
+	case "passportElementError":
+		var passportElementError PassportElementError
+		err := jsonUnmarshal(rawMsg, &passportElementError)
+		return &passportElementError, err
+
+	case "passportElementErrorSourceUnspecified":
+		var passportElementErrorSourceUnspecified PassportElementErrorSourceUnspecified
+		err := jsonUnmarshal(rawMsg, &passportElementErrorSourceUnspecified)
+		return &passportElementErrorSourceUnspecified, err
+
+	case "passportElementErrorSourceDataField":
+		var passportElementErrorSourceDataField PassportElementErrorSourceDataField
+		err := jsonUnmarshal(rawMsg, &passportElementErrorSourceDataField)
+		return &passportElementErrorSourceDataField, err
+
+	case "passportElementErrorSourceFrontSide":
+		var passportElementErrorSourceFrontSide PassportElementErrorSourceFrontSide
+		err := jsonUnmarshal(rawMsg, &passportElementErrorSourceFrontSide)
+		return &passportElementErrorSourceFrontSide, err
+
+	case "passportElementErrorSourceReverseSide":
+		var passportElementErrorSourceReverseSide PassportElementErrorSourceReverseSide
+		err := jsonUnmarshal(rawMsg, &passportElementErrorSourceReverseSide)
+		return &passportElementErrorSourceReverseSide, err
+
+	case "passportElementErrorSourceSelfie":
+		var passportElementErrorSourceSelfie PassportElementErrorSourceSelfie
+		err := jsonUnmarshal(rawMsg, &passportElementErrorSourceSelfie)
+		return &passportElementErrorSourceSelfie, err
+
+	case "passportElementErrorSourceTranslationFile":
+		var passportElementErrorSourceTranslationFile PassportElementErrorSourceTranslationFile
+		err := jsonUnmarshal(rawMsg, &passportElementErrorSourceTranslationFile)
+		return &passportElementErrorSourceTranslationFile, err
+
+	case "passportElementErrorSourceTranslationFiles":
+		var passportElementErrorSourceTranslationFiles PassportElementErrorSourceTranslationFiles
+		err := jsonUnmarshal(rawMsg, &passportElementErrorSourceTranslationFiles)
+		return &passportElementErrorSourceTranslationFiles, err
+
+	case "passportElementErrorSourceFile":
+		var passportElementErrorSourceFile PassportElementErrorSourceFile
+		err := jsonUnmarshal(rawMsg, &passportElementErrorSourceFile)
+		return &passportElementErrorSourceFile, err
+
+	case "passportElementErrorSourceFiles":
+		var passportElementErrorSourceFiles PassportElementErrorSourceFiles
+		err := jsonUnmarshal(rawMsg, &passportElementErrorSourceFiles)
+		return &passportElementErrorSourceFiles, err
+
+	case "passportElementTypePersonalDetails":
+		var passportElementTypePersonalDetails PassportElementTypePersonalDetails
+		err := jsonUnmarshal(rawMsg, &passportElementTypePersonalDetails)
+		return &passportElementTypePersonalDetails, err
+
+	case "passportElementTypePassport":
+		var passportElementTypePassport PassportElementTypePassport
+		err := jsonUnmarshal(rawMsg, &passportElementTypePassport)
+		return &passportElementTypePassport, err
+
+	case "passportElementTypeDriverLicense":
+		var passportElementTypeDriverLicense PassportElementTypeDriverLicense
+		err := jsonUnmarshal(rawMsg, &passportElementTypeDriverLicense)
+		return &passportElementTypeDriverLicense, err
+
+	case "passportElementTypeIdentityCard":
+		var passportElementTypeIdentityCard PassportElementTypeIdentityCard
+		err := jsonUnmarshal(rawMsg, &passportElementTypeIdentityCard)
+		return &passportElementTypeIdentityCard, err
+
+	case "passportElementTypeInternalPassport":
+		var passportElementTypeInternalPassport PassportElementTypeInternalPassport
+		err := jsonUnmarshal(rawMsg, &passportElementTypeInternalPassport)
+		return &passportElementTypeInternalPassport, err
+
+	case "passportElementTypeAddress":
+		var passportElementTypeAddress PassportElementTypeAddress
+		err := jsonUnmarshal(rawMsg, &passportElementTypeAddress)
+		return &passportElementTypeAddress, err
+
+	case "passportElementTypeUtilityBill":
+		var passportElementTypeUtilityBill PassportElementTypeUtilityBill
+		err := jsonUnmarshal(rawMsg, &passportElementTypeUtilityBill)
+		return &passportElementTypeUtilityBill, err
+
+	case "passportElementTypeBankStatement":
+		var passportElementTypeBankStatement PassportElementTypeBankStatement
+		err := jsonUnmarshal(rawMsg, &passportElementTypeBankStatement)
+		return &passportElementTypeBankStatement, err
+
+	case "passportElementTypeRentalAgreement":
+		var passportElementTypeRentalAgreement PassportElementTypeRentalAgreement
+		err := jsonUnmarshal(rawMsg, &passportElementTypeRentalAgreement)
+		return &passportElementTypeRentalAgreement, err
+
+	case "passportElementTypePassportRegistration":
+		var passportElementTypePassportRegistration PassportElementTypePassportRegistration
+		err := jsonUnmarshal(rawMsg, &passportElementTypePassportRegistration)
+		return &passportElementTypePassportRegistration, err
+
+	case "passportElementTypeTemporaryRegistration":
+		var passportElementTypeTemporaryRegistration PassportElementTypeTemporaryRegistration
+		err := jsonUnmarshal(rawMsg, &passportElementTypeTemporaryRegistration)
+		return &passportElementTypeTemporaryRegistration, err
+
+	case "passportElementTypePhoneNumber":
+		var passportElementTypePhoneNumber PassportElementTypePhoneNumber
+		err := jsonUnmarshal(rawMsg, &passportElementTypePhoneNumber)
+		return &passportElementTypePhoneNumber, err
+
+	case "passportElementTypeEmailAddress":
+		var passportElementTypeEmailAddress PassportElementTypeEmailAddress
+		err := jsonUnmarshal(rawMsg, &passportElementTypeEmailAddress)
+		return &passportElementTypeEmailAddress, err
+
+	case "passportElements":
+		var passportElements PassportElements
+		err := jsonUnmarshal(rawMsg, &passportElements)
+		return &passportElements, err
+
+	case "passportElementsWithErrors":
+		var passportElementsWithErrors PassportElementsWithErrors
+		err := jsonUnmarshal(rawMsg, &passportElementsWithErrors)
+		return &passportElementsWithErrors, err
+
+	case "passportRequiredElement":
+		var passportRequiredElement PassportRequiredElement
+		err := jsonUnmarshal(rawMsg, &passportRequiredElement)
+		return &passportRequiredElement, err
+
+	case "passportSuitableElement":
+		var passportSuitableElement PassportSuitableElement
+		err := jsonUnmarshal(rawMsg, &passportSuitableElement)
+		return &passportSuitableElement, err
+
+	case "passwordState":
+		var passwordState PasswordState
+		err := jsonUnmarshal(rawMsg, &passwordState)
+		return &passwordState, err
+
+	case "paymentForm":
+		var paymentForm PaymentForm
+		err := jsonUnmarshal(rawMsg, &paymentForm)
+		return &paymentForm, err
+
+	case "paymentFormTheme":
+		var paymentFormTheme PaymentFormTheme
+		err := jsonUnmarshal(rawMsg, &paymentFormTheme)
+		return &paymentFormTheme, err
+
+	case "paymentReceipt":
+		var paymentReceipt PaymentReceipt
+		err := jsonUnmarshal(rawMsg, &paymentReceipt)
+		return &paymentReceipt, err
+
+	case "paymentResult":
+		var paymentResult PaymentResult
+		err := jsonUnmarshal(rawMsg, &paymentResult)
+		return &paymentResult, err
+
+	case "paymentsProviderStripe":
+		var paymentsProviderStripe PaymentsProviderStripe
+		err := jsonUnmarshal(rawMsg, &paymentsProviderStripe)
+		return &paymentsProviderStripe, err
+
+	case "personalDetails":
+		var personalDetails PersonalDetails
+		err := jsonUnmarshal(rawMsg, &personalDetails)
+		return &personalDetails, err
+
+	case "personalDocument":
+		var personalDocument PersonalDocument
+		err := jsonUnmarshal(rawMsg, &personalDocument)
+		return &personalDocument, err
+
+	case "phoneNumberAuthenticationSettings":
+		var phoneNumberAuthenticationSettings PhoneNumberAuthenticationSettings
+		err := jsonUnmarshal(rawMsg, &phoneNumberAuthenticationSettings)
+		return &phoneNumberAuthenticationSettings, err
+
+	case "phoneNumberInfo":
+		var phoneNumberInfo PhoneNumberInfo
+		err := jsonUnmarshal(rawMsg, &phoneNumberInfo)
+		return &phoneNumberInfo, err
+
+	case "photo":
+		var photo Photo
+		err := jsonUnmarshal(rawMsg, &photo)
+		return &photo, err
+
+	case "photoSize":
+		var photoSize PhotoSize
+		err := jsonUnmarshal(rawMsg, &photoSize)
+		return &photoSize, err
+
+	case "point":
+		var point Point
+		err := jsonUnmarshal(rawMsg, &point)
+		return &point, err
+
+	case "poll":
+		var poll Poll
+		err := jsonUnmarshal(rawMsg, &poll)
+		return &poll, err
+
+	case "pollOption":
+		var pollOption PollOption
+		err := jsonUnmarshal(rawMsg, &pollOption)
+		return &pollOption, err
+
+	case "pollTypeRegular":
+		var pollTypeRegular PollTypeRegular
+		err := jsonUnmarshal(rawMsg, &pollTypeRegular)
+		return &pollTypeRegular, err
+
+	case "pollTypeQuiz":
+		var pollTypeQuiz PollTypeQuiz
+		err := jsonUnmarshal(rawMsg, &pollTypeQuiz)
+		return &pollTypeQuiz, err
+
+	case "profilePhoto":
+		var profilePhoto ProfilePhoto
+		err := jsonUnmarshal(rawMsg, &profilePhoto)
+		return &profilePhoto, err
+
+	case "proxies":
+		var proxies Proxies
+		err := jsonUnmarshal(rawMsg, &proxies)
+		return &proxies, err
+
+	case "proxy":
+		var proxy Proxy
+		err := jsonUnmarshal(rawMsg, &proxy)
+		return &proxy, err
+
+	case "proxyTypeSocks5":
+		var proxyTypeSocks5 ProxyTypeSocks5
+		err := jsonUnmarshal(rawMsg, &proxyTypeSocks5)
+		return &proxyTypeSocks5, err
+
+	case "proxyTypeHttp":
+		var proxyTypeHttp ProxyTypeHttp
+		err := jsonUnmarshal(rawMsg, &proxyTypeHttp)
+		return &proxyTypeHttp, err
+
+	case "proxyTypeMtproto":
+		var proxyTypeMtproto ProxyTypeMtproto
+		err := jsonUnmarshal(rawMsg, &proxyTypeMtproto)
+		return &proxyTypeMtproto, err
+
+	case "publicChatTypeHasUsername":
+		var publicChatTypeHasUsername PublicChatTypeHasUsername
+		err := jsonUnmarshal(rawMsg, &publicChatTypeHasUsername)
+		return &publicChatTypeHasUsername, err
+
+	case "publicChatTypeIsLocationBased":
+		var publicChatTypeIsLocationBased PublicChatTypeIsLocationBased
+		err := jsonUnmarshal(rawMsg, &publicChatTypeIsLocationBased)
+		return &publicChatTypeIsLocationBased, err
+
+	case "pushMessageContentHidden":
+		var pushMessageContentHidden PushMessageContentHidden
+		err := jsonUnmarshal(rawMsg, &pushMessageContentHidden)
+		return &pushMessageContentHidden, err
+
+	case "pushMessageContentAnimation":
+		var pushMessageContentAnimation PushMessageContentAnimation
+		err := jsonUnmarshal(rawMsg, &pushMessageContentAnimation)
+		return &pushMessageContentAnimation, err
+
+	case "pushMessageContentAudio":
+		var pushMessageContentAudio PushMessageContentAudio
+		err := jsonUnmarshal(rawMsg, &pushMessageContentAudio)
+		return &pushMessageContentAudio, err
+
+	case "pushMessageContentContact":
+		var pushMessageContentContact PushMessageContentContact
+		err := jsonUnmarshal(rawMsg, &pushMessageContentContact)
+		return &pushMessageContentContact, err
+
+	case "pushMessageContentContactRegistered":
+		var pushMessageContentContactRegistered PushMessageContentContactRegistered
+		err := jsonUnmarshal(rawMsg, &pushMessageContentContactRegistered)
+		return &pushMessageContentContactRegistered, err
+
+	case "pushMessageContentDocument":
+		var pushMessageContentDocument PushMessageContentDocument
+		err := jsonUnmarshal(rawMsg, &pushMessageContentDocument)
+		return &pushMessageContentDocument, err
+
+	case "pushMessageContentGame":
+		var pushMessageContentGame PushMessageContentGame
+		err := jsonUnmarshal(rawMsg, &pushMessageContentGame)
+		return &pushMessageContentGame, err
+
+	case "pushMessageContentGameScore":
+		var pushMessageContentGameScore PushMessageContentGameScore
+		err := jsonUnmarshal(rawMsg, &pushMessageContentGameScore)
+		return &pushMessageContentGameScore, err
+
+	case "pushMessageContentInvoice":
+		var pushMessageContentInvoice PushMessageContentInvoice
+		err := jsonUnmarshal(rawMsg, &pushMessageContentInvoice)
+		return &pushMessageContentInvoice, err
+
+	case "pushMessageContentLocation":
+		var pushMessageContentLocation PushMessageContentLocation
+		err := jsonUnmarshal(rawMsg, &pushMessageContentLocation)
+		return &pushMessageContentLocation, err
+
+	case "pushMessageContentPhoto":
+		var pushMessageContentPhoto PushMessageContentPhoto
+		err := jsonUnmarshal(rawMsg, &pushMessageContentPhoto)
+		return &pushMessageContentPhoto, err
+
+	case "pushMessageContentPoll":
+		var pushMessageContentPoll PushMessageContentPoll
+		err := jsonUnmarshal(rawMsg, &pushMessageContentPoll)
+		return &pushMessageContentPoll, err
+
+	case "pushMessageContentScreenshotTaken":
+		var pushMessageContentScreenshotTaken PushMessageContentScreenshotTaken
+		err := jsonUnmarshal(rawMsg, &pushMessageContentScreenshotTaken)
+		return &pushMessageContentScreenshotTaken, err
+
+	case "pushMessageContentSticker":
+		var pushMessageContentSticker PushMessageContentSticker
+		err := jsonUnmarshal(rawMsg, &pushMessageContentSticker)
+		return &pushMessageContentSticker, err
+
+	case "pushMessageContentText":
+		var pushMessageContentText PushMessageContentText
+		err := jsonUnmarshal(rawMsg, &pushMessageContentText)
+		return &pushMessageContentText, err
+
+	case "pushMessageContentVideo":
+		var pushMessageContentVideo PushMessageContentVideo
+		err := jsonUnmarshal(rawMsg, &pushMessageContentVideo)
+		return &pushMessageContentVideo, err
+
+	case "pushMessageContentVideoNote":
+		var pushMessageContentVideoNote PushMessageContentVideoNote
+		err := jsonUnmarshal(rawMsg, &pushMessageContentVideoNote)
+		return &pushMessageContentVideoNote, err
+
+	case "pushMessageContentVoiceNote":
+		var pushMessageContentVoiceNote PushMessageContentVoiceNote
+		err := jsonUnmarshal(rawMsg, &pushMessageContentVoiceNote)
+		return &pushMessageContentVoiceNote, err
+
+	case "pushMessageContentBasicGroupChatCreate":
+		var pushMessageContentBasicGroupChatCreate PushMessageContentBasicGroupChatCreate
+		err := jsonUnmarshal(rawMsg, &pushMessageContentBasicGroupChatCreate)
+		return &pushMessageContentBasicGroupChatCreate, err
+
+	case "pushMessageContentChatAddMembers":
+		var pushMessageContentChatAddMembers PushMessageContentChatAddMembers
+		err := jsonUnmarshal(rawMsg, &pushMessageContentChatAddMembers)
+		return &pushMessageContentChatAddMembers, err
+
+	case "pushMessageContentChatChangePhoto":
+		var pushMessageContentChatChangePhoto PushMessageContentChatChangePhoto
+		err := jsonUnmarshal(rawMsg, &pushMessageContentChatChangePhoto)
+		return &pushMessageContentChatChangePhoto, err
+
+	case "pushMessageContentChatChangeTitle":
+		var pushMessageContentChatChangeTitle PushMessageContentChatChangeTitle
+		err := jsonUnmarshal(rawMsg, &pushMessageContentChatChangeTitle)
+		return &pushMessageContentChatChangeTitle, err
+
+	case "pushMessageContentChatSetTheme":
+		var pushMessageContentChatSetTheme PushMessageContentChatSetTheme
+		err := jsonUnmarshal(rawMsg, &pushMessageContentChatSetTheme)
+		return &pushMessageContentChatSetTheme, err
+
+	case "pushMessageContentChatDeleteMember":
+		var pushMessageContentChatDeleteMember PushMessageContentChatDeleteMember
+		err := jsonUnmarshal(rawMsg, &pushMessageContentChatDeleteMember)
+		return &pushMessageContentChatDeleteMember, err
+
+	case "pushMessageContentChatJoinByLink":
+		var pushMessageContentChatJoinByLink PushMessageContentChatJoinByLink
+		err := jsonUnmarshal(rawMsg, &pushMessageContentChatJoinByLink)
+		return &pushMessageContentChatJoinByLink, err
+
+	case "pushMessageContentChatJoinByRequest":
+		var pushMessageContentChatJoinByRequest PushMessageContentChatJoinByRequest
+		err := jsonUnmarshal(rawMsg, &pushMessageContentChatJoinByRequest)
+		return &pushMessageContentChatJoinByRequest, err
+
+	case "pushMessageContentMessageForwards":
+		var pushMessageContentMessageForwards PushMessageContentMessageForwards
+		err := jsonUnmarshal(rawMsg, &pushMessageContentMessageForwards)
+		return &pushMessageContentMessageForwards, err
+
+	case "pushMessageContentMediaAlbum":
+		var pushMessageContentMediaAlbum PushMessageContentMediaAlbum
+		err := jsonUnmarshal(rawMsg, &pushMessageContentMediaAlbum)
+		return &pushMessageContentMediaAlbum, err
+
+	case "pushReceiverId":
+		var pushReceiverId PushReceiverId
+		err := jsonUnmarshal(rawMsg, &pushReceiverId)
+		return &pushReceiverId, err
+
+	case "recommendedChatFilter":
+		var recommendedChatFilter RecommendedChatFilter
+		err := jsonUnmarshal(rawMsg, &recommendedChatFilter)
+		return &recommendedChatFilter, err
+
+	case "recommendedChatFilters":
+		var recommendedChatFilters RecommendedChatFilters
+		err := jsonUnmarshal(rawMsg, &recommendedChatFilters)
+		return &recommendedChatFilters, err
+
+	case "recoveryEmailAddress":
+		var recoveryEmailAddress RecoveryEmailAddress
+		err := jsonUnmarshal(rawMsg, &recoveryEmailAddress)
+		return &recoveryEmailAddress, err
+
+	case "remoteFile":
+		var remoteFile RemoteFile
+		err := jsonUnmarshal(rawMsg, &remoteFile)
+		return &remoteFile, err
+
+	case "replyMarkupRemoveKeyboard":
+		var replyMarkupRemoveKeyboard ReplyMarkupRemoveKeyboard
+		err := jsonUnmarshal(rawMsg, &replyMarkupRemoveKeyboard)
+		return &replyMarkupRemoveKeyboard, err
+
+	case "replyMarkupForceReply":
+		var replyMarkupForceReply ReplyMarkupForceReply
+		err := jsonUnmarshal(rawMsg, &replyMarkupForceReply)
+		return &replyMarkupForceReply, err
+
+	case "replyMarkupShowKeyboard":
+		var replyMarkupShowKeyboard ReplyMarkupShowKeyboard
+		err := jsonUnmarshal(rawMsg, &replyMarkupShowKeyboard)
+		return &replyMarkupShowKeyboard, err
+
+	case "replyMarkupInlineKeyboard":
+		var replyMarkupInlineKeyboard ReplyMarkupInlineKeyboard
+		err := jsonUnmarshal(rawMsg, &replyMarkupInlineKeyboard)
+		return &replyMarkupInlineKeyboard, err
+
+	case "resetPasswordResultOk":
+		var resetPasswordResultOk ResetPasswordResultOk
+		err := jsonUnmarshal(rawMsg, &resetPasswordResultOk)
+		return &resetPasswordResultOk, err
+
+	case "resetPasswordResultPending":
+		var resetPasswordResultPending ResetPasswordResultPending
+		err := jsonUnmarshal(rawMsg, &resetPasswordResultPending)
+		return &resetPasswordResultPending, err
+
+	case "resetPasswordResultDeclined":
+		var resetPasswordResultDeclined ResetPasswordResultDeclined
+		err := jsonUnmarshal(rawMsg, &resetPasswordResultDeclined)
+		return &resetPasswordResultDeclined, err
+
+	case "richTextPlain":
+		var richTextPlain RichTextPlain
+		err := jsonUnmarshal(rawMsg, &richTextPlain)
+		return &richTextPlain, err
+
+	case "richTextBold":
+		var richTextBold RichTextBold
+		err := jsonUnmarshal(rawMsg, &richTextBold)
+		return &richTextBold, err
+
+	case "richTextItalic":
+		var richTextItalic RichTextItalic
+		err := jsonUnmarshal(rawMsg, &richTextItalic)
+		return &richTextItalic, err
+
+	case "richTextUnderline":
+		var richTextUnderline RichTextUnderline
+		err := jsonUnmarshal(rawMsg, &richTextUnderline)
+		return &richTextUnderline, err
+
+	case "richTextStrikethrough":
+		var richTextStrikethrough RichTextStrikethrough
+		err := jsonUnmarshal(rawMsg, &richTextStrikethrough)
+		return &richTextStrikethrough, err
+
+	case "richTextFixed":
+		var richTextFixed RichTextFixed
+		err := jsonUnmarshal(rawMsg, &richTextFixed)
+		return &richTextFixed, err
+
+	case "richTextUrl":
+		var richTextUrl RichTextUrl
+		err := jsonUnmarshal(rawMsg, &richTextUrl)
+		return &richTextUrl, err
+
+	case "richTextEmailAddress":
+		var richTextEmailAddress RichTextEmailAddress
+		err := jsonUnmarshal(rawMsg, &richTextEmailAddress)
+		return &richTextEmailAddress, err
+
+	case "richTextSubscript":
+		var richTextSubscript RichTextSubscript
+		err := jsonUnmarshal(rawMsg, &richTextSubscript)
+		return &richTextSubscript, err
+
+	case "richTextSuperscript":
+		var richTextSuperscript RichTextSuperscript
+		err := jsonUnmarshal(rawMsg, &richTextSuperscript)
+		return &richTextSuperscript, err
+
+	case "richTextMarked":
+		var richTextMarked RichTextMarked
+		err := jsonUnmarshal(rawMsg, &richTextMarked)
+		return &richTextMarked, err
+
+	case "richTextPhoneNumber":
+		var richTextPhoneNumber RichTextPhoneNumber
+		err := jsonUnmarshal(rawMsg, &richTextPhoneNumber)
+		return &richTextPhoneNumber, err
+
+	case "richTextIcon":
+		var richTextIcon RichTextIcon
+		err := jsonUnmarshal(rawMsg, &richTextIcon)
+		return &richTextIcon, err
+
+	case "richTextReference":
+		var richTextReference RichTextReference
+		err := jsonUnmarshal(rawMsg, &richTextReference)
+		return &richTextReference, err
+
+	case "richTextAnchor":
+		var richTextAnchor RichTextAnchor
+		err := jsonUnmarshal(rawMsg, &richTextAnchor)
+		return &richTextAnchor, err
+
+	case "richTextAnchorLink":
+		var richTextAnchorLink RichTextAnchorLink
+		err := jsonUnmarshal(rawMsg, &richTextAnchorLink)
+		return &richTextAnchorLink, err
+
+	case "richTexts":
+		var richTexts RichTexts
+		err := jsonUnmarshal(rawMsg, &richTexts)
+		return &richTexts, err
+
+	case "savedCredentials":
+		var savedCredentials SavedCredentials
+		err := jsonUnmarshal(rawMsg, &savedCredentials)
+		return &savedCredentials, err
+
+	case "scopeNotificationSettings":
+		var scopeNotificationSettings ScopeNotificationSettings
+		err := jsonUnmarshal(rawMsg, &scopeNotificationSettings)
+		return &scopeNotificationSettings, err
+
+	case "searchMessagesFilterEmpty":
+		var searchMessagesFilterEmpty SearchMessagesFilterEmpty
+		err := jsonUnmarshal(rawMsg, &searchMessagesFilterEmpty)
+		return &searchMessagesFilterEmpty, err
+
+	case "searchMessagesFilterAnimation":
+		var searchMessagesFilterAnimation SearchMessagesFilterAnimation
+		err := jsonUnmarshal(rawMsg, &searchMessagesFilterAnimation)
+		return &searchMessagesFilterAnimation, err
+
+	case "searchMessagesFilterAudio":
+		var searchMessagesFilterAudio SearchMessagesFilterAudio
+		err := jsonUnmarshal(rawMsg, &searchMessagesFilterAudio)
+		return &searchMessagesFilterAudio, err
+
+	case "searchMessagesFilterDocument":
+		var searchMessagesFilterDocument SearchMessagesFilterDocument
+		err := jsonUnmarshal(rawMsg, &searchMessagesFilterDocument)
+		return &searchMessagesFilterDocument, err
+
+	case "searchMessagesFilterPhoto":
+		var searchMessagesFilterPhoto SearchMessagesFilterPhoto
+		err := jsonUnmarshal(rawMsg, &searchMessagesFilterPhoto)
+		return &searchMessagesFilterPhoto, err
+
+	case "searchMessagesFilterVideo":
+		var searchMessagesFilterVideo SearchMessagesFilterVideo
+		err := jsonUnmarshal(rawMsg, &searchMessagesFilterVideo)
+		return &searchMessagesFilterVideo, err
+
+	case "searchMessagesFilterVoiceNote":
+		var searchMessagesFilterVoiceNote SearchMessagesFilterVoiceNote
+		err := jsonUnmarshal(rawMsg, &searchMessagesFilterVoiceNote)
+		return &searchMessagesFilterVoiceNote, err
+
+	case "searchMessagesFilterPhotoAndVideo":
+		var searchMessagesFilterPhotoAndVideo SearchMessagesFilterPhotoAndVideo
+		err := jsonUnmarshal(rawMsg, &searchMessagesFilterPhotoAndVideo)
+		return &searchMessagesFilterPhotoAndVideo, err
+
+	case "searchMessagesFilterUrl":
+		var searchMessagesFilterUrl SearchMessagesFilterUrl
+		err := jsonUnmarshal(rawMsg, &searchMessagesFilterUrl)
+		return &searchMessagesFilterUrl, err
+
+	case "searchMessagesFilterChatPhoto":
+		var searchMessagesFilterChatPhoto SearchMessagesFilterChatPhoto
+		err := jsonUnmarshal(rawMsg, &searchMessagesFilterChatPhoto)
+		return &searchMessagesFilterChatPhoto, err
+
+	case "searchMessagesFilterVideoNote":
+		var searchMessagesFilterVideoNote SearchMessagesFilterVideoNote
+		err := jsonUnmarshal(rawMsg, &searchMessagesFilterVideoNote)
+		return &searchMessagesFilterVideoNote, err
+
+	case "searchMessagesFilterVoiceAndVideoNote":
+		var searchMessagesFilterVoiceAndVideoNote SearchMessagesFilterVoiceAndVideoNote
+		err := jsonUnmarshal(rawMsg, &searchMessagesFilterVoiceAndVideoNote)
+		return &searchMessagesFilterVoiceAndVideoNote, err
+
+	case "searchMessagesFilterMention":
+		var searchMessagesFilterMention SearchMessagesFilterMention
+		err := jsonUnmarshal(rawMsg, &searchMessagesFilterMention)
+		return &searchMessagesFilterMention, err
+
+	case "searchMessagesFilterUnreadMention":
+		var searchMessagesFilterUnreadMention SearchMessagesFilterUnreadMention
+		err := jsonUnmarshal(rawMsg, &searchMessagesFilterUnreadMention)
+		return &searchMessagesFilterUnreadMention, err
+
+	case "searchMessagesFilterFailedToSend":
+		var searchMessagesFilterFailedToSend SearchMessagesFilterFailedToSend
+		err := jsonUnmarshal(rawMsg, &searchMessagesFilterFailedToSend)
+		return &searchMessagesFilterFailedToSend, err
+
+	case "searchMessagesFilterPinned":
+		var searchMessagesFilterPinned SearchMessagesFilterPinned
+		err := jsonUnmarshal(rawMsg, &searchMessagesFilterPinned)
+		return &searchMessagesFilterPinned, err
+
+	case "seconds":
+		var seconds Seconds
+		err := jsonUnmarshal(rawMsg, &seconds)
+		return &seconds, err
+
+	case "secretChat":
+		var secretChat SecretChat
+		err := jsonUnmarshal(rawMsg, &secretChat)
+		return &secretChat, err
+
+	case "secretChatStatePending":
+		var secretChatStatePending SecretChatStatePending
+		err := jsonUnmarshal(rawMsg, &secretChatStatePending)
+		return &secretChatStatePending, err
+
+	case "secretChatStateReady":
+		var secretChatStateReady SecretChatStateReady
+		err := jsonUnmarshal(rawMsg, &secretChatStateReady)
+		return &secretChatStateReady, err
+
+	case "secretChatStateClosed":
+		var secretChatStateClosed SecretChatStateClosed
+		err := jsonUnmarshal(rawMsg, &secretChatStateClosed)
+		return &secretChatStateClosed, err
+
+	case "session":
+		var session Session
+		err := jsonUnmarshal(rawMsg, &session)
+		return &session, err
+
+	case "sessions":
+		var sessions Sessions
+		err := jsonUnmarshal(rawMsg, &sessions)
+		return &sessions, err
+
+	case "shippingOption":
+		var shippingOption ShippingOption
+		err := jsonUnmarshal(rawMsg, &shippingOption)
+		return &shippingOption, err
+
+	case "sponsoredMessage":
+		var sponsoredMessage SponsoredMessage
+		err := jsonUnmarshal(rawMsg, &sponsoredMessage)
+		return &sponsoredMessage, err
+
+	case "statisticalGraphData":
+		var statisticalGraphData StatisticalGraphData
+		err := jsonUnmarshal(rawMsg, &statisticalGraphData)
+		return &statisticalGraphData, err
+
+	case "statisticalGraphAsync":
+		var statisticalGraphAsync StatisticalGraphAsync
+		err := jsonUnmarshal(rawMsg, &statisticalGraphAsync)
+		return &statisticalGraphAsync, err
+
+	case "statisticalGraphError":
+		var statisticalGraphError StatisticalGraphError
+		err := jsonUnmarshal(rawMsg, &statisticalGraphError)
+		return &statisticalGraphError, err
+
+	case "statisticalValue":
+		var statisticalValue StatisticalValue
+		err := jsonUnmarshal(rawMsg, &statisticalValue)
+		return &statisticalValue, err
+
+	case "sticker":
+		var sticker Sticker
+		err := jsonUnmarshal(rawMsg, &sticker)
+		return &sticker, err
+
+	case "stickerSet":
+		var stickerSet StickerSet
+		err := jsonUnmarshal(rawMsg, &stickerSet)
+		return &stickerSet, err
+
+	case "stickerSetInfo":
+		var stickerSetInfo StickerSetInfo
+		err := jsonUnmarshal(rawMsg, &stickerSetInfo)
+		return &stickerSetInfo, err
+
+	case "stickerSets":
+		var stickerSets StickerSets
+		err := jsonUnmarshal(rawMsg, &stickerSets)
+		return &stickerSets, err
+
+	case "stickers":
+		var stickers Stickers
+		err := jsonUnmarshal(rawMsg, &stickers)
+		return &stickers, err
+
+	case "storageStatistics":
+		var storageStatistics StorageStatistics
+		err := jsonUnmarshal(rawMsg, &storageStatistics)
+		return &storageStatistics, err
+
+	case "storageStatisticsByChat":
+		var storageStatisticsByChat StorageStatisticsByChat
+		err := jsonUnmarshal(rawMsg, &storageStatisticsByChat)
+		return &storageStatisticsByChat, err
+
+	case "storageStatisticsByFileType":
+		var storageStatisticsByFileType StorageStatisticsByFileType
+		err := jsonUnmarshal(rawMsg, &storageStatisticsByFileType)
+		return &storageStatisticsByFileType, err
+
+	case "storageStatisticsFast":
+		var storageStatisticsFast StorageStatisticsFast
+		err := jsonUnmarshal(rawMsg, &storageStatisticsFast)
+		return &storageStatisticsFast, err
+
+	case "suggestedActionEnableArchiveAndMuteNewChats":
+		var suggestedActionEnableArchiveAndMuteNewChats SuggestedActionEnableArchiveAndMuteNewChats
+		err := jsonUnmarshal(rawMsg, &suggestedActionEnableArchiveAndMuteNewChats)
+		return &suggestedActionEnableArchiveAndMuteNewChats, err
+
+	case "suggestedActionCheckPassword":
+		var suggestedActionCheckPassword SuggestedActionCheckPassword
+		err := jsonUnmarshal(rawMsg, &suggestedActionCheckPassword)
+		return &suggestedActionCheckPassword, err
+
+	case "suggestedActionCheckPhoneNumber":
+		var suggestedActionCheckPhoneNumber SuggestedActionCheckPhoneNumber
+		err := jsonUnmarshal(rawMsg, &suggestedActionCheckPhoneNumber)
+		return &suggestedActionCheckPhoneNumber, err
+
+	case "suggestedActionViewChecksHint":
+		var suggestedActionViewChecksHint SuggestedActionViewChecksHint
+		err := jsonUnmarshal(rawMsg, &suggestedActionViewChecksHint)
+		return &suggestedActionViewChecksHint, err
+
+	case "suggestedActionConvertToBroadcastGroup":
+		var suggestedActionConvertToBroadcastGroup SuggestedActionConvertToBroadcastGroup
+		err := jsonUnmarshal(rawMsg, &suggestedActionConvertToBroadcastGroup)
+		return &suggestedActionConvertToBroadcastGroup, err
+
+	case "suggestedActionSetPassword":
+		var suggestedActionSetPassword SuggestedActionSetPassword
+		err := jsonUnmarshal(rawMsg, &suggestedActionSetPassword)
+		return &suggestedActionSetPassword, err
+
+	case "supergroup":
+		var supergroup Supergroup
+		err := jsonUnmarshal(rawMsg, &supergroup)
+		return &supergroup, err
+
+	case "supergroupFullInfo":
+		var supergroupFullInfo SupergroupFullInfo
+		err := jsonUnmarshal(rawMsg, &supergroupFullInfo)
+		return &supergroupFullInfo, err
+
+	case "supergroupMembersFilterRecent":
+		var supergroupMembersFilterRecent SupergroupMembersFilterRecent
+		err := jsonUnmarshal(rawMsg, &supergroupMembersFilterRecent)
+		return &supergroupMembersFilterRecent, err
+
+	case "supergroupMembersFilterContacts":
+		var supergroupMembersFilterContacts SupergroupMembersFilterContacts
+		err := jsonUnmarshal(rawMsg, &supergroupMembersFilterContacts)
+		return &supergroupMembersFilterContacts, err
+
+	case "supergroupMembersFilterAdministrators":
+		var supergroupMembersFilterAdministrators SupergroupMembersFilterAdministrators
+		err := jsonUnmarshal(rawMsg, &supergroupMembersFilterAdministrators)
+		return &supergroupMembersFilterAdministrators, err
+
+	case "supergroupMembersFilterSearch":
+		var supergroupMembersFilterSearch SupergroupMembersFilterSearch
+		err := jsonUnmarshal(rawMsg, &supergroupMembersFilterSearch)
+		return &supergroupMembersFilterSearch, err
+
+	case "supergroupMembersFilterRestricted":
+		var supergroupMembersFilterRestricted SupergroupMembersFilterRestricted
+		err := jsonUnmarshal(rawMsg, &supergroupMembersFilterRestricted)
+		return &supergroupMembersFilterRestricted, err
+
+	case "supergroupMembersFilterBanned":
+		var supergroupMembersFilterBanned SupergroupMembersFilterBanned
+		err := jsonUnmarshal(rawMsg, &supergroupMembersFilterBanned)
+		return &supergroupMembersFilterBanned, err
+
+	case "supergroupMembersFilterMention":
+		var supergroupMembersFilterMention SupergroupMembersFilterMention
+		err := jsonUnmarshal(rawMsg, &supergroupMembersFilterMention)
+		return &supergroupMembersFilterMention, err
+
+	case "supergroupMembersFilterBots":
+		var supergroupMembersFilterBots SupergroupMembersFilterBots
+		err := jsonUnmarshal(rawMsg, &supergroupMembersFilterBots)
+		return &supergroupMembersFilterBots, err
+
+	case "tMeUrl":
+		var tMeUrl TMeUrl
+		err := jsonUnmarshal(rawMsg, &tMeUrl)
+		return &tMeUrl, err
+
+	case "tMeUrlTypeUser":
+		var tMeUrlTypeUser TMeUrlTypeUser
+		err := jsonUnmarshal(rawMsg, &tMeUrlTypeUser)
+		return &tMeUrlTypeUser, err
+
+	case "tMeUrlTypeSupergroup":
+		var tMeUrlTypeSupergroup TMeUrlTypeSupergroup
+		err := jsonUnmarshal(rawMsg, &tMeUrlTypeSupergroup)
+		return &tMeUrlTypeSupergroup, err
+
+	case "tMeUrlTypeChatInvite":
+		var tMeUrlTypeChatInvite TMeUrlTypeChatInvite
+		err := jsonUnmarshal(rawMsg, &tMeUrlTypeChatInvite)
+		return &tMeUrlTypeChatInvite, err
+
+	case "tMeUrlTypeStickerSet":
+		var tMeUrlTypeStickerSet TMeUrlTypeStickerSet
+		err := jsonUnmarshal(rawMsg, &tMeUrlTypeStickerSet)
+		return &tMeUrlTypeStickerSet, err
+
+	case "tMeUrls":
+		var tMeUrls TMeUrls
+		err := jsonUnmarshal(rawMsg, &tMeUrls)
+		return &tMeUrls, err
+
+	case "tdlibParameters":
+		var tdlibParameters TdlibParameters
+		err := jsonUnmarshal(rawMsg, &tdlibParameters)
+		return &tdlibParameters, err
+
+	case "temporaryPasswordState":
+		var temporaryPasswordState TemporaryPasswordState
+		err := jsonUnmarshal(rawMsg, &temporaryPasswordState)
+		return &temporaryPasswordState, err
+
+	case "termsOfService":
+		var termsOfService TermsOfService
+		err := jsonUnmarshal(rawMsg, &termsOfService)
+		return &termsOfService, err
+
+	case "testBytes":
+		var testBytes TestBytes
+		err := jsonUnmarshal(rawMsg, &testBytes)
+		return &testBytes, err
+
+	case "testInt":
+		var testInt TestInt
+		err := jsonUnmarshal(rawMsg, &testInt)
+		return &testInt, err
+
+	case "testString":
+		var testString TestString
+		err := jsonUnmarshal(rawMsg, &testString)
+		return &testString, err
+
+	case "testVectorInt":
+		var testVectorInt TestVectorInt
+		err := jsonUnmarshal(rawMsg, &testVectorInt)
+		return &testVectorInt, err
+
+	case "testVectorIntObject":
+		var testVectorIntObject TestVectorIntObject
+		err := jsonUnmarshal(rawMsg, &testVectorIntObject)
+		return &testVectorIntObject, err
+
+	case "testVectorString":
+		var testVectorString TestVectorString
+		err := jsonUnmarshal(rawMsg, &testVectorString)
+		return &testVectorString, err
+
+	case "testVectorStringObject":
+		var testVectorStringObject TestVectorStringObject
+		err := jsonUnmarshal(rawMsg, &testVectorStringObject)
+		return &testVectorStringObject, err
+
+	case "text":
+		var text Text
+		err := jsonUnmarshal(rawMsg, &text)
+		return &text, err
+
+	case "textEntities":
+		var textEntities TextEntities
+		err := jsonUnmarshal(rawMsg, &textEntities)
+		return &textEntities, err
+
+	case "textEntity":
+		var textEntity TextEntity
+		err := jsonUnmarshal(rawMsg, &textEntity)
+		return &textEntity, err
+
+	case "textEntityTypeMention":
+		var textEntityTypeMention TextEntityTypeMention
+		err := jsonUnmarshal(rawMsg, &textEntityTypeMention)
+		return &textEntityTypeMention, err
+
+	case "textEntityTypeHashtag":
+		var textEntityTypeHashtag TextEntityTypeHashtag
+		err := jsonUnmarshal(rawMsg, &textEntityTypeHashtag)
+		return &textEntityTypeHashtag, err
+
+	case "textEntityTypeCashtag":
+		var textEntityTypeCashtag TextEntityTypeCashtag
+		err := jsonUnmarshal(rawMsg, &textEntityTypeCashtag)
+		return &textEntityTypeCashtag, err
+
+	case "textEntityTypeBotCommand":
+		var textEntityTypeBotCommand TextEntityTypeBotCommand
+		err := jsonUnmarshal(rawMsg, &textEntityTypeBotCommand)
+		return &textEntityTypeBotCommand, err
+
+	case "textEntityTypeUrl":
+		var textEntityTypeUrl TextEntityTypeUrl
+		err := jsonUnmarshal(rawMsg, &textEntityTypeUrl)
+		return &textEntityTypeUrl, err
+
+	case "textEntityTypeEmailAddress":
+		var textEntityTypeEmailAddress TextEntityTypeEmailAddress
+		err := jsonUnmarshal(rawMsg, &textEntityTypeEmailAddress)
+		return &textEntityTypeEmailAddress, err
+
+	case "textEntityTypePhoneNumber":
+		var textEntityTypePhoneNumber TextEntityTypePhoneNumber
+		err := jsonUnmarshal(rawMsg, &textEntityTypePhoneNumber)
+		return &textEntityTypePhoneNumber, err
+
+	case "textEntityTypeBankCardNumber":
+		var textEntityTypeBankCardNumber TextEntityTypeBankCardNumber
+		err := jsonUnmarshal(rawMsg, &textEntityTypeBankCardNumber)
+		return &textEntityTypeBankCardNumber, err
+
+	case "textEntityTypeBold":
+		var textEntityTypeBold TextEntityTypeBold
+		err := jsonUnmarshal(rawMsg, &textEntityTypeBold)
+		return &textEntityTypeBold, err
+
+	case "textEntityTypeItalic":
+		var textEntityTypeItalic TextEntityTypeItalic
+		err := jsonUnmarshal(rawMsg, &textEntityTypeItalic)
+		return &textEntityTypeItalic, err
+
+	case "textEntityTypeUnderline":
+		var textEntityTypeUnderline TextEntityTypeUnderline
+		err := jsonUnmarshal(rawMsg, &textEntityTypeUnderline)
+		return &textEntityTypeUnderline, err
+
+	case "textEntityTypeStrikethrough":
+		var textEntityTypeStrikethrough TextEntityTypeStrikethrough
+		err := jsonUnmarshal(rawMsg, &textEntityTypeStrikethrough)
+		return &textEntityTypeStrikethrough, err
+
+	case "textEntityTypeSpoiler":
+		var textEntityTypeSpoiler TextEntityTypeSpoiler
+		err := jsonUnmarshal(rawMsg, &textEntityTypeSpoiler)
+		return &textEntityTypeSpoiler, err
+
+	case "textEntityTypeCode":
+		var textEntityTypeCode TextEntityTypeCode
+		err := jsonUnmarshal(rawMsg, &textEntityTypeCode)
+		return &textEntityTypeCode, err
+
+	case "textEntityTypePre":
+		var textEntityTypePre TextEntityTypePre
+		err := jsonUnmarshal(rawMsg, &textEntityTypePre)
+		return &textEntityTypePre, err
+
+	case "textEntityTypePreCode":
+		var textEntityTypePreCode TextEntityTypePreCode
+		err := jsonUnmarshal(rawMsg, &textEntityTypePreCode)
+		return &textEntityTypePreCode, err
+
+	case "textEntityTypeTextUrl":
+		var textEntityTypeTextUrl TextEntityTypeTextUrl
+		err := jsonUnmarshal(rawMsg, &textEntityTypeTextUrl)
+		return &textEntityTypeTextUrl, err
+
+	case "textEntityTypeMentionName":
+		var textEntityTypeMentionName TextEntityTypeMentionName
+		err := jsonUnmarshal(rawMsg, &textEntityTypeMentionName)
+		return &textEntityTypeMentionName, err
+
+	case "textEntityTypeMediaTimestamp":
+		var textEntityTypeMediaTimestamp TextEntityTypeMediaTimestamp
+		err := jsonUnmarshal(rawMsg, &textEntityTypeMediaTimestamp)
+		return &textEntityTypeMediaTimestamp, err
+
+	case "textParseModeMarkdown":
+		var textParseModeMarkdown TextParseModeMarkdown
+		err := jsonUnmarshal(rawMsg, &textParseModeMarkdown)
+		return &textParseModeMarkdown, err
+
+	case "textParseModeHTML":
+		var textParseModeHTML TextParseModeHTML
+		err := jsonUnmarshal(rawMsg, &textParseModeHTML)
+		return &textParseModeHTML, err
+
+	case "themeSettings":
+		var themeSettings ThemeSettings
+		err := jsonUnmarshal(rawMsg, &themeSettings)
+		return &themeSettings, err
+
+	case "thumbnail":
+		var thumbnail Thumbnail
+		err := jsonUnmarshal(rawMsg, &thumbnail)
+		return &thumbnail, err
+
+	case "thumbnailFormatJpeg":
+		var thumbnailFormatJpeg ThumbnailFormatJpeg
+		err := jsonUnmarshal(rawMsg, &thumbnailFormatJpeg)
+		return &thumbnailFormatJpeg, err
+
+	case "thumbnailFormatPng":
+		var thumbnailFormatPng ThumbnailFormatPng
+		err := jsonUnmarshal(rawMsg, &thumbnailFormatPng)
+		return &thumbnailFormatPng, err
+
+	case "thumbnailFormatWebp":
+		var thumbnailFormatWebp ThumbnailFormatWebp
+		err := jsonUnmarshal(rawMsg, &thumbnailFormatWebp)
+		return &thumbnailFormatWebp, err
+
+	case "thumbnailFormatGif":
+		var thumbnailFormatGif ThumbnailFormatGif
+		err := jsonUnmarshal(rawMsg, &thumbnailFormatGif)
+		return &thumbnailFormatGif, err
+
+	case "thumbnailFormatTgs":
+		var thumbnailFormatTgs ThumbnailFormatTgs
+		err := jsonUnmarshal(rawMsg, &thumbnailFormatTgs)
+		return &thumbnailFormatTgs, err
+
+	case "thumbnailFormatMpeg4":
+		var thumbnailFormatMpeg4 ThumbnailFormatMpeg4
+		err := jsonUnmarshal(rawMsg, &thumbnailFormatMpeg4)
+		return &thumbnailFormatMpeg4, err
+
+	case "topChatCategoryUsers":
+		var topChatCategoryUsers TopChatCategoryUsers
+		err := jsonUnmarshal(rawMsg, &topChatCategoryUsers)
+		return &topChatCategoryUsers, err
+
+	case "topChatCategoryBots":
+		var topChatCategoryBots TopChatCategoryBots
+		err := jsonUnmarshal(rawMsg, &topChatCategoryBots)
+		return &topChatCategoryBots, err
+
+	case "topChatCategoryGroups":
+		var topChatCategoryGroups TopChatCategoryGroups
+		err := jsonUnmarshal(rawMsg, &topChatCategoryGroups)
+		return &topChatCategoryGroups, err
+
+	case "topChatCategoryChannels":
+		var topChatCategoryChannels TopChatCategoryChannels
+		err := jsonUnmarshal(rawMsg, &topChatCategoryChannels)
+		return &topChatCategoryChannels, err
+
+	case "topChatCategoryInlineBots":
+		var topChatCategoryInlineBots TopChatCategoryInlineBots
+		err := jsonUnmarshal(rawMsg, &topChatCategoryInlineBots)
+		return &topChatCategoryInlineBots, err
+
+	case "topChatCategoryCalls":
+		var topChatCategoryCalls TopChatCategoryCalls
+		err := jsonUnmarshal(rawMsg, &topChatCategoryCalls)
+		return &topChatCategoryCalls, err
+
+	case "topChatCategoryForwardChats":
+		var topChatCategoryForwardChats TopChatCategoryForwardChats
+		err := jsonUnmarshal(rawMsg, &topChatCategoryForwardChats)
+		return &topChatCategoryForwardChats, err
+
+	case "updateAuthorizationState":
+		var updateAuthorizationState UpdateAuthorizationState
+		err := jsonUnmarshal(rawMsg, &updateAuthorizationState)
+		return &updateAuthorizationState, err
+
+	case "updateNewMessage":
+		var updateNewMessage UpdateNewMessage
+		err := jsonUnmarshal(rawMsg, &updateNewMessage)
+		return &updateNewMessage, err
+
+	case "updateMessageSendAcknowledged":
+		var updateMessageSendAcknowledged UpdateMessageSendAcknowledged
+		err := jsonUnmarshal(rawMsg, &updateMessageSendAcknowledged)
+		return &updateMessageSendAcknowledged, err
+
+	case "updateMessageSendSucceeded":
+		var updateMessageSendSucceeded UpdateMessageSendSucceeded
+		err := jsonUnmarshal(rawMsg, &updateMessageSendSucceeded)
+		return &updateMessageSendSucceeded, err
+
+	case "updateMessageSendFailed":
+		var updateMessageSendFailed UpdateMessageSendFailed
+		err := jsonUnmarshal(rawMsg, &updateMessageSendFailed)
+		return &updateMessageSendFailed, err
+
+	case "updateMessageContent":
+		var updateMessageContent UpdateMessageContent
+		err := jsonUnmarshal(rawMsg, &updateMessageContent)
+		return &updateMessageContent, err
+
+	case "updateMessageEdited":
+		var updateMessageEdited UpdateMessageEdited
+		err := jsonUnmarshal(rawMsg, &updateMessageEdited)
+		return &updateMessageEdited, err
+
+	case "updateMessageIsPinned":
+		var updateMessageIsPinned UpdateMessageIsPinned
+		err := jsonUnmarshal(rawMsg, &updateMessageIsPinned)
+		return &updateMessageIsPinned, err
+
+	case "updateMessageInteractionInfo":
+		var updateMessageInteractionInfo UpdateMessageInteractionInfo
+		err := jsonUnmarshal(rawMsg, &updateMessageInteractionInfo)
+		return &updateMessageInteractionInfo, err
+
+	case "updateMessageContentOpened":
+		var updateMessageContentOpened UpdateMessageContentOpened
+		err := jsonUnmarshal(rawMsg, &updateMessageContentOpened)
+		return &updateMessageContentOpened, err
+
+	case "updateMessageMentionRead":
+		var updateMessageMentionRead UpdateMessageMentionRead
+		err := jsonUnmarshal(rawMsg, &updateMessageMentionRead)
+		return &updateMessageMentionRead, err
+
+	case "updateMessageLiveLocationViewed":
+		var updateMessageLiveLocationViewed UpdateMessageLiveLocationViewed
+		err := jsonUnmarshal(rawMsg, &updateMessageLiveLocationViewed)
+		return &updateMessageLiveLocationViewed, err
+
+	case "updateNewChat":
+		var updateNewChat UpdateNewChat
+		err := jsonUnmarshal(rawMsg, &updateNewChat)
+		return &updateNewChat, err
+
+	case "updateChatTitle":
+		var updateChatTitle UpdateChatTitle
+		err := jsonUnmarshal(rawMsg, &updateChatTitle)
+		return &updateChatTitle, err
+
+	case "updateChatPhoto":
+		var updateChatPhoto UpdateChatPhoto
+		err := jsonUnmarshal(rawMsg, &updateChatPhoto)
+		return &updateChatPhoto, err
+
+	case "updateChatPermissions":
+		var updateChatPermissions UpdateChatPermissions
+		err := jsonUnmarshal(rawMsg, &updateChatPermissions)
+		return &updateChatPermissions, err
+
+	case "updateChatLastMessage":
+		var updateChatLastMessage UpdateChatLastMessage
+		err := jsonUnmarshal(rawMsg, &updateChatLastMessage)
+		return &updateChatLastMessage, err
+
+	case "updateChatPosition":
+		var updateChatPosition UpdateChatPosition
+		err := jsonUnmarshal(rawMsg, &updateChatPosition)
+		return &updateChatPosition, err
+
+	case "updateChatReadInbox":
+		var updateChatReadInbox UpdateChatReadInbox
+		err := jsonUnmarshal(rawMsg, &updateChatReadInbox)
+		return &updateChatReadInbox, err
+
+	case "updateChatReadOutbox":
+		var updateChatReadOutbox UpdateChatReadOutbox
+		err := jsonUnmarshal(rawMsg, &updateChatReadOutbox)
+		return &updateChatReadOutbox, err
+
+	case "updateChatActionBar":
+		var updateChatActionBar UpdateChatActionBar
+		err := jsonUnmarshal(rawMsg, &updateChatActionBar)
+		return &updateChatActionBar, err
+
+	case "updateChatDraftMessage":
+		var updateChatDraftMessage UpdateChatDraftMessage
+		err := jsonUnmarshal(rawMsg, &updateChatDraftMessage)
+		return &updateChatDraftMessage, err
+
+	case "updateChatMessageSender":
+		var updateChatMessageSender UpdateChatMessageSender
+		err := jsonUnmarshal(rawMsg, &updateChatMessageSender)
+		return &updateChatMessageSender, err
+
+	case "updateChatMessageTtl":
+		var updateChatMessageTtl UpdateChatMessageTtl
+		err := jsonUnmarshal(rawMsg, &updateChatMessageTtl)
+		return &updateChatMessageTtl, err
+
+	case "updateChatNotificationSettings":
+		var updateChatNotificationSettings UpdateChatNotificationSettings
+		err := jsonUnmarshal(rawMsg, &updateChatNotificationSettings)
+		return &updateChatNotificationSettings, err
+
+	case "updateChatPendingJoinRequests":
+		var updateChatPendingJoinRequests UpdateChatPendingJoinRequests
+		err := jsonUnmarshal(rawMsg, &updateChatPendingJoinRequests)
+		return &updateChatPendingJoinRequests, err
+
+	case "updateChatReplyMarkup":
+		var updateChatReplyMarkup UpdateChatReplyMarkup
+		err := jsonUnmarshal(rawMsg, &updateChatReplyMarkup)
+		return &updateChatReplyMarkup, err
+
+	case "updateChatTheme":
+		var updateChatTheme UpdateChatTheme
+		err := jsonUnmarshal(rawMsg, &updateChatTheme)
+		return &updateChatTheme, err
+
+	case "updateChatUnreadMentionCount":
+		var updateChatUnreadMentionCount UpdateChatUnreadMentionCount
+		err := jsonUnmarshal(rawMsg, &updateChatUnreadMentionCount)
+		return &updateChatUnreadMentionCount, err
+
+	case "updateChatVideoChat":
+		var updateChatVideoChat UpdateChatVideoChat
+		err := jsonUnmarshal(rawMsg, &updateChatVideoChat)
+		return &updateChatVideoChat, err
+
+	case "updateChatDefaultDisableNotification":
+		var updateChatDefaultDisableNotification UpdateChatDefaultDisableNotification
+		err := jsonUnmarshal(rawMsg, &updateChatDefaultDisableNotification)
+		return &updateChatDefaultDisableNotification, err
+
+	case "updateChatHasProtectedContent":
+		var updateChatHasProtectedContent UpdateChatHasProtectedContent
+		err := jsonUnmarshal(rawMsg, &updateChatHasProtectedContent)
+		return &updateChatHasProtectedContent, err
+
+	case "updateChatHasScheduledMessages":
+		var updateChatHasScheduledMessages UpdateChatHasScheduledMessages
+		err := jsonUnmarshal(rawMsg, &updateChatHasScheduledMessages)
+		return &updateChatHasScheduledMessages, err
+
+	case "updateChatIsBlocked":
+		var updateChatIsBlocked UpdateChatIsBlocked
+		err := jsonUnmarshal(rawMsg, &updateChatIsBlocked)
+		return &updateChatIsBlocked, err
+
+	case "updateChatIsMarkedAsUnread":
+		var updateChatIsMarkedAsUnread UpdateChatIsMarkedAsUnread
+		err := jsonUnmarshal(rawMsg, &updateChatIsMarkedAsUnread)
+		return &updateChatIsMarkedAsUnread, err
+
+	case "updateChatFilters":
+		var updateChatFilters UpdateChatFilters
+		err := jsonUnmarshal(rawMsg, &updateChatFilters)
+		return &updateChatFilters, err
+
+	case "updateChatOnlineMemberCount":
+		var updateChatOnlineMemberCount UpdateChatOnlineMemberCount
+		err := jsonUnmarshal(rawMsg, &updateChatOnlineMemberCount)
+		return &updateChatOnlineMemberCount, err
+
+	case "updateScopeNotificationSettings":
+		var updateScopeNotificationSettings UpdateScopeNotificationSettings
+		err := jsonUnmarshal(rawMsg, &updateScopeNotificationSettings)
+		return &updateScopeNotificationSettings, err
+
+	case "updateNotification":
+		var updateNotification UpdateNotification
+		err := jsonUnmarshal(rawMsg, &updateNotification)
+		return &updateNotification, err
+
+	case "updateNotificationGroup":
+		var updateNotificationGroup UpdateNotificationGroup
+		err := jsonUnmarshal(rawMsg, &updateNotificationGroup)
+		return &updateNotificationGroup, err
+
+	case "updateActiveNotifications":
+		var updateActiveNotifications UpdateActiveNotifications
+		err := jsonUnmarshal(rawMsg, &updateActiveNotifications)
+		return &updateActiveNotifications, err
+
+	case "updateHavePendingNotifications":
+		var updateHavePendingNotifications UpdateHavePendingNotifications
+		err := jsonUnmarshal(rawMsg, &updateHavePendingNotifications)
+		return &updateHavePendingNotifications, err
+
+	case "updateDeleteMessages":
+		var updateDeleteMessages UpdateDeleteMessages
+		err := jsonUnmarshal(rawMsg, &updateDeleteMessages)
+		return &updateDeleteMessages, err
+
+	case "updateChatAction":
+		var updateChatAction UpdateChatAction
+		err := jsonUnmarshal(rawMsg, &updateChatAction)
+		return &updateChatAction, err
+
+	case "updateUserStatus":
+		var updateUserStatus UpdateUserStatus
+		err := jsonUnmarshal(rawMsg, &updateUserStatus)
+		return &updateUserStatus, err
+
+	case "updateUser":
+		var updateUser UpdateUser
+		err := jsonUnmarshal(rawMsg, &updateUser)
+		return &updateUser, err
+
+	case "updateBasicGroup":
+		var updateBasicGroup UpdateBasicGroup
+		err := jsonUnmarshal(rawMsg, &updateBasicGroup)
+		return &updateBasicGroup, err
+
+	case "updateSupergroup":
+		var updateSupergroup UpdateSupergroup
+		err := jsonUnmarshal(rawMsg, &updateSupergroup)
+		return &updateSupergroup, err
+
+	case "updateSecretChat":
+		var updateSecretChat UpdateSecretChat
+		err := jsonUnmarshal(rawMsg, &updateSecretChat)
+		return &updateSecretChat, err
+
+	case "updateUserFullInfo":
+		var updateUserFullInfo UpdateUserFullInfo
+		err := jsonUnmarshal(rawMsg, &updateUserFullInfo)
+		return &updateUserFullInfo, err
+
+	case "updateBasicGroupFullInfo":
+		var updateBasicGroupFullInfo UpdateBasicGroupFullInfo
+		err := jsonUnmarshal(rawMsg, &updateBasicGroupFullInfo)
+		return &updateBasicGroupFullInfo, err
+
+	case "updateSupergroupFullInfo":
+		var updateSupergroupFullInfo UpdateSupergroupFullInfo
+		err := jsonUnmarshal(rawMsg, &updateSupergroupFullInfo)
+		return &updateSupergroupFullInfo, err
+
+	case "updateServiceNotification":
+		var updateServiceNotification UpdateServiceNotification
+		err := jsonUnmarshal(rawMsg, &updateServiceNotification)
+		return &updateServiceNotification, err
+
+	case "updateFile":
+		var updateFile UpdateFile
+		err := jsonUnmarshal(rawMsg, &updateFile)
+		return &updateFile, err
+
+	case "updateFileGenerationStart":
+		var updateFileGenerationStart UpdateFileGenerationStart
+		err := jsonUnmarshal(rawMsg, &updateFileGenerationStart)
+		return &updateFileGenerationStart, err
+
+	case "updateFileGenerationStop":
+		var updateFileGenerationStop UpdateFileGenerationStop
+		err := jsonUnmarshal(rawMsg, &updateFileGenerationStop)
+		return &updateFileGenerationStop, err
+
+	case "updateCall":
+		var updateCall UpdateCall
+		err := jsonUnmarshal(rawMsg, &updateCall)
+		return &updateCall, err
+
+	case "updateGroupCall":
+		var updateGroupCall UpdateGroupCall
+		err := jsonUnmarshal(rawMsg, &updateGroupCall)
+		return &updateGroupCall, err
+
+	case "updateGroupCallParticipant":
+		var updateGroupCallParticipant UpdateGroupCallParticipant
+		err := jsonUnmarshal(rawMsg, &updateGroupCallParticipant)
+		return &updateGroupCallParticipant, err
+
+	case "updateNewCallSignalingData":
+		var updateNewCallSignalingData UpdateNewCallSignalingData
+		err := jsonUnmarshal(rawMsg, &updateNewCallSignalingData)
+		return &updateNewCallSignalingData, err
+
+	case "updateUserPrivacySettingRules":
+		var updateUserPrivacySettingRules UpdateUserPrivacySettingRules
+		err := jsonUnmarshal(rawMsg, &updateUserPrivacySettingRules)
+		return &updateUserPrivacySettingRules, err
+
+	case "updateUnreadMessageCount":
+		var updateUnreadMessageCount UpdateUnreadMessageCount
+		err := jsonUnmarshal(rawMsg, &updateUnreadMessageCount)
+		return &updateUnreadMessageCount, err
+
+	case "updateUnreadChatCount":
+		var updateUnreadChatCount UpdateUnreadChatCount
+		err := jsonUnmarshal(rawMsg, &updateUnreadChatCount)
+		return &updateUnreadChatCount, err
+
+	case "updateOption":
+		var updateOption UpdateOption
+		err := jsonUnmarshal(rawMsg, &updateOption)
+		return &updateOption, err
+
+	case "updateStickerSet":
+		var updateStickerSet UpdateStickerSet
+		err := jsonUnmarshal(rawMsg, &updateStickerSet)
+		return &updateStickerSet, err
+
+	case "updateInstalledStickerSets":
+		var updateInstalledStickerSets UpdateInstalledStickerSets
+		err := jsonUnmarshal(rawMsg, &updateInstalledStickerSets)
+		return &updateInstalledStickerSets, err
+
+	case "updateTrendingStickerSets":
+		var updateTrendingStickerSets UpdateTrendingStickerSets
+		err := jsonUnmarshal(rawMsg, &updateTrendingStickerSets)
+		return &updateTrendingStickerSets, err
+
+	case "updateRecentStickers":
+		var updateRecentStickers UpdateRecentStickers
+		err := jsonUnmarshal(rawMsg, &updateRecentStickers)
+		return &updateRecentStickers, err
+
+	case "updateFavoriteStickers":
+		var updateFavoriteStickers UpdateFavoriteStickers
+		err := jsonUnmarshal(rawMsg, &updateFavoriteStickers)
+		return &updateFavoriteStickers, err
+
+	case "updateSavedAnimations":
+		var updateSavedAnimations UpdateSavedAnimations
+		err := jsonUnmarshal(rawMsg, &updateSavedAnimations)
+		return &updateSavedAnimations, err
+
+	case "updateSelectedBackground":
+		var updateSelectedBackground UpdateSelectedBackground
+		err := jsonUnmarshal(rawMsg, &updateSelectedBackground)
+		return &updateSelectedBackground, err
+
+	case "updateChatThemes":
+		var updateChatThemes UpdateChatThemes
+		err := jsonUnmarshal(rawMsg, &updateChatThemes)
+		return &updateChatThemes, err
+
+	case "updateLanguagePackStrings":
+		var updateLanguagePackStrings UpdateLanguagePackStrings
+		err := jsonUnmarshal(rawMsg, &updateLanguagePackStrings)
+		return &updateLanguagePackStrings, err
+
+	case "updateConnectionState":
+		var updateConnectionState UpdateConnectionState
+		err := jsonUnmarshal(rawMsg, &updateConnectionState)
+		return &updateConnectionState, err
+
+	case "updateTermsOfService":
+		var updateTermsOfService UpdateTermsOfService
+		err := jsonUnmarshal(rawMsg, &updateTermsOfService)
+		return &updateTermsOfService, err
+
+	case "updateUsersNearby":
+		var updateUsersNearby UpdateUsersNearby
+		err := jsonUnmarshal(rawMsg, &updateUsersNearby)
+		return &updateUsersNearby, err
+
+	case "updateDiceEmojis":
+		var updateDiceEmojis UpdateDiceEmojis
+		err := jsonUnmarshal(rawMsg, &updateDiceEmojis)
+		return &updateDiceEmojis, err
+
+	case "updateAnimatedEmojiMessageClicked":
+		var updateAnimatedEmojiMessageClicked UpdateAnimatedEmojiMessageClicked
+		err := jsonUnmarshal(rawMsg, &updateAnimatedEmojiMessageClicked)
+		return &updateAnimatedEmojiMessageClicked, err
+
+	case "updateAnimationSearchParameters":
+		var updateAnimationSearchParameters UpdateAnimationSearchParameters
+		err := jsonUnmarshal(rawMsg, &updateAnimationSearchParameters)
+		return &updateAnimationSearchParameters, err
+
+	case "updateSuggestedActions":
+		var updateSuggestedActions UpdateSuggestedActions
+		err := jsonUnmarshal(rawMsg, &updateSuggestedActions)
+		return &updateSuggestedActions, err
+
+	case "updateNewInlineQuery":
+		var updateNewInlineQuery UpdateNewInlineQuery
+		err := jsonUnmarshal(rawMsg, &updateNewInlineQuery)
+		return &updateNewInlineQuery, err
+
+	case "updateNewChosenInlineResult":
+		var updateNewChosenInlineResult UpdateNewChosenInlineResult
+		err := jsonUnmarshal(rawMsg, &updateNewChosenInlineResult)
+		return &updateNewChosenInlineResult, err
+
+	case "updateNewCallbackQuery":
+		var updateNewCallbackQuery UpdateNewCallbackQuery
+		err := jsonUnmarshal(rawMsg, &updateNewCallbackQuery)
+		return &updateNewCallbackQuery, err
+
+	case "updateNewInlineCallbackQuery":
+		var updateNewInlineCallbackQuery UpdateNewInlineCallbackQuery
+		err := jsonUnmarshal(rawMsg, &updateNewInlineCallbackQuery)
+		return &updateNewInlineCallbackQuery, err
+
+	case "updateNewShippingQuery":
+		var updateNewShippingQuery UpdateNewShippingQuery
+		err := jsonUnmarshal(rawMsg, &updateNewShippingQuery)
+		return &updateNewShippingQuery, err
+
+	case "updateNewPreCheckoutQuery":
+		var updateNewPreCheckoutQuery UpdateNewPreCheckoutQuery
+		err := jsonUnmarshal(rawMsg, &updateNewPreCheckoutQuery)
+		return &updateNewPreCheckoutQuery, err
+
+	case "updateNewCustomEvent":
+		var updateNewCustomEvent UpdateNewCustomEvent
+		err := jsonUnmarshal(rawMsg, &updateNewCustomEvent)
+		return &updateNewCustomEvent, err
+
+	case "updateNewCustomQuery":
+		var updateNewCustomQuery UpdateNewCustomQuery
+		err := jsonUnmarshal(rawMsg, &updateNewCustomQuery)
+		return &updateNewCustomQuery, err
+
+	case "updatePoll":
+		var updatePoll UpdatePoll
+		err := jsonUnmarshal(rawMsg, &updatePoll)
+		return &updatePoll, err
+
+	case "updatePollAnswer":
+		var updatePollAnswer UpdatePollAnswer
+		err := jsonUnmarshal(rawMsg, &updatePollAnswer)
+		return &updatePollAnswer, err
+
+	case "updateChatMember":
+		var updateChatMember UpdateChatMember
+		err := jsonUnmarshal(rawMsg, &updateChatMember)
+		return &updateChatMember, err
+
+	case "updateNewChatJoinRequest":
+		var updateNewChatJoinRequest UpdateNewChatJoinRequest
+		err := jsonUnmarshal(rawMsg, &updateNewChatJoinRequest)
+		return &updateNewChatJoinRequest, err
+
+	case "updates":
+		var updates Updates
+		err := jsonUnmarshal(rawMsg, &updates)
+		return &updates, err
+
+	case "user":
+		var user User
+		err := jsonUnmarshal(rawMsg, &user)
+		return &user, err
+
+	case "userFullInfo":
+		var userFullInfo UserFullInfo
+		err := jsonUnmarshal(rawMsg, &userFullInfo)
+		return &userFullInfo, err
+
+	case "userPrivacySettingShowStatus":
+		var userPrivacySettingShowStatus UserPrivacySettingShowStatus
+		err := jsonUnmarshal(rawMsg, &userPrivacySettingShowStatus)
+		return &userPrivacySettingShowStatus, err
+
+	case "userPrivacySettingShowProfilePhoto":
+		var userPrivacySettingShowProfilePhoto UserPrivacySettingShowProfilePhoto
+		err := jsonUnmarshal(rawMsg, &userPrivacySettingShowProfilePhoto)
+		return &userPrivacySettingShowProfilePhoto, err
+
+	case "userPrivacySettingShowLinkInForwardedMessages":
+		var userPrivacySettingShowLinkInForwardedMessages UserPrivacySettingShowLinkInForwardedMessages
+		err := jsonUnmarshal(rawMsg, &userPrivacySettingShowLinkInForwardedMessages)
+		return &userPrivacySettingShowLinkInForwardedMessages, err
+
+	case "userPrivacySettingShowPhoneNumber":
+		var userPrivacySettingShowPhoneNumber UserPrivacySettingShowPhoneNumber
+		err := jsonUnmarshal(rawMsg, &userPrivacySettingShowPhoneNumber)
+		return &userPrivacySettingShowPhoneNumber, err
+
+	case "userPrivacySettingAllowChatInvites":
+		var userPrivacySettingAllowChatInvites UserPrivacySettingAllowChatInvites
+		err := jsonUnmarshal(rawMsg, &userPrivacySettingAllowChatInvites)
+		return &userPrivacySettingAllowChatInvites, err
+
+	case "userPrivacySettingAllowCalls":
+		var userPrivacySettingAllowCalls UserPrivacySettingAllowCalls
+		err := jsonUnmarshal(rawMsg, &userPrivacySettingAllowCalls)
+		return &userPrivacySettingAllowCalls, err
+
+	case "userPrivacySettingAllowPeerToPeerCalls":
+		var userPrivacySettingAllowPeerToPeerCalls UserPrivacySettingAllowPeerToPeerCalls
+		err := jsonUnmarshal(rawMsg, &userPrivacySettingAllowPeerToPeerCalls)
+		return &userPrivacySettingAllowPeerToPeerCalls, err
+
+	case "userPrivacySettingAllowFindingByPhoneNumber":
+		var userPrivacySettingAllowFindingByPhoneNumber UserPrivacySettingAllowFindingByPhoneNumber
+		err := jsonUnmarshal(rawMsg, &userPrivacySettingAllowFindingByPhoneNumber)
+		return &userPrivacySettingAllowFindingByPhoneNumber, err
+
+	case "userPrivacySettingRuleAllowAll":
+		var userPrivacySettingRuleAllowAll UserPrivacySettingRuleAllowAll
+		err := jsonUnmarshal(rawMsg, &userPrivacySettingRuleAllowAll)
+		return &userPrivacySettingRuleAllowAll, err
+
+	case "userPrivacySettingRuleAllowContacts":
+		var userPrivacySettingRuleAllowContacts UserPrivacySettingRuleAllowContacts
+		err := jsonUnmarshal(rawMsg, &userPrivacySettingRuleAllowContacts)
+		return &userPrivacySettingRuleAllowContacts, err
+
+	case "userPrivacySettingRuleAllowUsers":
+		var userPrivacySettingRuleAllowUsers UserPrivacySettingRuleAllowUsers
+		err := jsonUnmarshal(rawMsg, &userPrivacySettingRuleAllowUsers)
+		return &userPrivacySettingRuleAllowUsers, err
+
+	case "userPrivacySettingRuleAllowChatMembers":
+		var userPrivacySettingRuleAllowChatMembers UserPrivacySettingRuleAllowChatMembers
+		err := jsonUnmarshal(rawMsg, &userPrivacySettingRuleAllowChatMembers)
+		return &userPrivacySettingRuleAllowChatMembers, err
+
+	case "userPrivacySettingRuleRestrictAll":
+		var userPrivacySettingRuleRestrictAll UserPrivacySettingRuleRestrictAll
+		err := jsonUnmarshal(rawMsg, &userPrivacySettingRuleRestrictAll)
+		return &userPrivacySettingRuleRestrictAll, err
+
+	case "userPrivacySettingRuleRestrictContacts":
+		var userPrivacySettingRuleRestrictContacts UserPrivacySettingRuleRestrictContacts
+		err := jsonUnmarshal(rawMsg, &userPrivacySettingRuleRestrictContacts)
+		return &userPrivacySettingRuleRestrictContacts, err
+
+	case "userPrivacySettingRuleRestrictUsers":
+		var userPrivacySettingRuleRestrictUsers UserPrivacySettingRuleRestrictUsers
+		err := jsonUnmarshal(rawMsg, &userPrivacySettingRuleRestrictUsers)
+		return &userPrivacySettingRuleRestrictUsers, err
+
+	case "userPrivacySettingRuleRestrictChatMembers":
+		var userPrivacySettingRuleRestrictChatMembers UserPrivacySettingRuleRestrictChatMembers
+		err := jsonUnmarshal(rawMsg, &userPrivacySettingRuleRestrictChatMembers)
+		return &userPrivacySettingRuleRestrictChatMembers, err
+
+	case "userPrivacySettingRules":
+		var userPrivacySettingRules UserPrivacySettingRules
+		err := jsonUnmarshal(rawMsg, &userPrivacySettingRules)
+		return &userPrivacySettingRules, err
+
+	case "userStatusEmpty":
+		var userStatusEmpty UserStatusEmpty
+		err := jsonUnmarshal(rawMsg, &userStatusEmpty)
+		return &userStatusEmpty, err
+
+	case "userStatusOnline":
+		var userStatusOnline UserStatusOnline
+		err := jsonUnmarshal(rawMsg, &userStatusOnline)
+		return &userStatusOnline, err
+
+	case "userStatusOffline":
+		var userStatusOffline UserStatusOffline
+		err := jsonUnmarshal(rawMsg, &userStatusOffline)
+		return &userStatusOffline, err
+
+	case "userStatusRecently":
+		var userStatusRecently UserStatusRecently
+		err := jsonUnmarshal(rawMsg, &userStatusRecently)
+		return &userStatusRecently, err
+
+	case "userStatusLastWeek":
+		var userStatusLastWeek UserStatusLastWeek
+		err := jsonUnmarshal(rawMsg, &userStatusLastWeek)
+		return &userStatusLastWeek, err
+
+	case "userStatusLastMonth":
+		var userStatusLastMonth UserStatusLastMonth
+		err := jsonUnmarshal(rawMsg, &userStatusLastMonth)
+		return &userStatusLastMonth, err
+
+	case "userTypeRegular":
+		var userTypeRegular UserTypeRegular
+		err := jsonUnmarshal(rawMsg, &userTypeRegular)
+		return &userTypeRegular, err
+
+	case "userTypeDeleted":
+		var userTypeDeleted UserTypeDeleted
+		err := jsonUnmarshal(rawMsg, &userTypeDeleted)
+		return &userTypeDeleted, err
+
+	case "userTypeBot":
+		var userTypeBot UserTypeBot
+		err := jsonUnmarshal(rawMsg, &userTypeBot)
+		return &userTypeBot, err
+
+	case "userTypeUnknown":
+		var userTypeUnknown UserTypeUnknown
+		err := jsonUnmarshal(rawMsg, &userTypeUnknown)
+		return &userTypeUnknown, err
+
+	case "users":
+		var users Users
+		err := jsonUnmarshal(rawMsg, &users)
+		return &users, err
+
+	case "validatedOrderInfo":
+		var validatedOrderInfo ValidatedOrderInfo
+		err := jsonUnmarshal(rawMsg, &validatedOrderInfo)
+		return &validatedOrderInfo, err
+
+	case "vectorPathCommandLine":
+		var vectorPathCommandLine VectorPathCommandLine
+		err := jsonUnmarshal(rawMsg, &vectorPathCommandLine)
+		return &vectorPathCommandLine, err
+
+	case "vectorPathCommandCubicBezierCurve":
+		var vectorPathCommandCubicBezierCurve VectorPathCommandCubicBezierCurve
+		err := jsonUnmarshal(rawMsg, &vectorPathCommandCubicBezierCurve)
+		return &vectorPathCommandCubicBezierCurve, err
+
+	case "venue":
+		var venue Venue
+		err := jsonUnmarshal(rawMsg, &venue)
+		return &venue, err
+
+	case "video":
+		var video Video
+		err := jsonUnmarshal(rawMsg, &video)
+		return &video, err
+
+	case "videoChat":
+		var videoChat VideoChat
+		err := jsonUnmarshal(rawMsg, &videoChat)
+		return &videoChat, err
+
+	case "videoNote":
+		var videoNote VideoNote
+		err := jsonUnmarshal(rawMsg, &videoNote)
+		return &videoNote, err
+
+	case "voiceNote":
+		var voiceNote VoiceNote
+		err := jsonUnmarshal(rawMsg, &voiceNote)
+		return &voiceNote, err
+
+	case "webPage":
+		var webPage WebPage
+		err := jsonUnmarshal(rawMsg, &webPage)
+		return &webPage, err
+
+	case "webPageInstantView":
+		var webPageInstantView WebPageInstantView
+		err := jsonUnmarshal(rawMsg, &webPageInstantView)
+		return &webPageInstantView, err
+
+	default:
+		unknown, err := decodeUnknown(typeName, rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &unknown, nil
+	}
+}
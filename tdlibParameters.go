@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // TdlibParameters Contains parameters for TDLib initialization
 type TdlibParameters struct {
 	tdCommon
@@ -64,3 +69,259 @@ func NewTdlibParameters(useTestDc bool, databaseDirectory string, filesDirectory
 
 	return &tdlibParametersTemp
 }
+
+// MarshalJSON marshals to json
+func (tdlibParameters *TdlibParameters) MarshalJSON() ([]byte, error) {
+	return tdlibParameters.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (tdlibParameters *TdlibParameters) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, tdlibParameters)
+}
+
+func (tdlibParameters *TdlibParameters) appendJSON(b []byte) ([]byte, error) {
+	if tdlibParameters == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, tdlibParameters.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, tdlibParameters.tdCommon.Extra)
+	b = append(b, `,"use_test_dc":`...)
+	b = appendJSONBool(b, tdlibParameters.UseTestDc)
+	b = append(b, `,"database_directory":`...)
+	b = appendJSONString(b, tdlibParameters.DatabaseDirectory)
+	b = append(b, `,"files_directory":`...)
+	b = appendJSONString(b, tdlibParameters.FilesDirectory)
+	b = append(b, `,"use_file_database":`...)
+	b = appendJSONBool(b, tdlibParameters.UseFileDatabase)
+	b = append(b, `,"use_chat_info_database":`...)
+	b = appendJSONBool(b, tdlibParameters.UseChatInfoDatabase)
+	b = append(b, `,"use_message_database":`...)
+	b = appendJSONBool(b, tdlibParameters.UseMessageDatabase)
+	b = append(b, `,"use_secret_chats":`...)
+	b = appendJSONBool(b, tdlibParameters.UseSecretChats)
+	b = append(b, `,"api_id":`...)
+	b = appendJSONInt(b, int64(tdlibParameters.ApiId))
+	b = append(b, `,"api_hash":`...)
+	b = appendJSONString(b, tdlibParameters.ApiHash)
+	b = append(b, `,"system_language_code":`...)
+	b = appendJSONString(b, tdlibParameters.SystemLanguageCode)
+	b = append(b, `,"device_model":`...)
+	b = appendJSONString(b, tdlibParameters.DeviceModel)
+	b = append(b, `,"system_version":`...)
+	b = appendJSONString(b, tdlibParameters.SystemVersion)
+	b = append(b, `,"application_version":`...)
+	b = appendJSONString(b, tdlibParameters.ApplicationVersion)
+	b = append(b, `,"enable_storage_optimizer":`...)
+	b = appendJSONBool(b, tdlibParameters.EnableStorageOptimizer)
+	b = append(b, `,"ignore_file_names":`...)
+	b = appendJSONBool(b, tdlibParameters.IgnoreFileNames)
+	return append(b, '}'), nil
+}
+
+func (tdlibParameters *TdlibParameters) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			tdlibParameters.tdCommon.Type, err = r.readString()
+		case "@extra":
+			tdlibParameters.tdCommon.Extra, err = r.readString()
+		case "use_test_dc":
+			tdlibParameters.UseTestDc, err = r.readBool()
+		case "database_directory":
+			tdlibParameters.DatabaseDirectory, err = r.readString()
+		case "files_directory":
+			tdlibParameters.FilesDirectory, err = r.readString()
+		case "use_file_database":
+			tdlibParameters.UseFileDatabase, err = r.readBool()
+		case "use_chat_info_database":
+			tdlibParameters.UseChatInfoDatabase, err = r.readBool()
+		case "use_message_database":
+			tdlibParameters.UseMessageDatabase, err = r.readBool()
+		case "use_secret_chats":
+			tdlibParameters.UseSecretChats, err = r.readBool()
+		case "api_id":
+			tdlibParameters.ApiId, err = r.readInt32()
+		case "api_hash":
+			tdlibParameters.ApiHash, err = r.readString()
+		case "system_language_code":
+			tdlibParameters.SystemLanguageCode, err = r.readString()
+		case "device_model":
+			tdlibParameters.DeviceModel, err = r.readString()
+		case "system_version":
+			tdlibParameters.SystemVersion, err = r.readString()
+		case "application_version":
+			tdlibParameters.ApplicationVersion, err = r.readString()
+		case "enable_storage_optimizer":
+			tdlibParameters.EnableStorageOptimizer, err = r.readBool()
+		case "ignore_file_names":
+			tdlibParameters.IgnoreFileNames, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (tdlibParameters *TdlibParameters) Clone() *TdlibParameters {
+	if tdlibParameters == nil {
+		return nil
+	}
+	clone := *tdlibParameters
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (tdlibParameters *TdlibParameters) Equal(other *TdlibParameters) bool {
+	if tdlibParameters == nil || other == nil {
+		return tdlibParameters == other
+	}
+	if tdlibParameters.UseTestDc != other.UseTestDc {
+		return false
+	}
+	if tdlibParameters.DatabaseDirectory != other.DatabaseDirectory {
+		return false
+	}
+	if tdlibParameters.FilesDirectory != other.FilesDirectory {
+		return false
+	}
+	if tdlibParameters.UseFileDatabase != other.UseFileDatabase {
+		return false
+	}
+	if tdlibParameters.UseChatInfoDatabase != other.UseChatInfoDatabase {
+		return false
+	}
+	if tdlibParameters.UseMessageDatabase != other.UseMessageDatabase {
+		return false
+	}
+	if tdlibParameters.UseSecretChats != other.UseSecretChats {
+		return false
+	}
+	if tdlibParameters.ApiId != other.ApiId {
+		return false
+	}
+	if tdlibParameters.ApiHash != other.ApiHash {
+		return false
+	}
+	if tdlibParameters.SystemLanguageCode != other.SystemLanguageCode {
+		return false
+	}
+	if tdlibParameters.DeviceModel != other.DeviceModel {
+		return false
+	}
+	if tdlibParameters.SystemVersion != other.SystemVersion {
+		return false
+	}
+	if tdlibParameters.ApplicationVersion != other.ApplicationVersion {
+		return false
+	}
+	if tdlibParameters.EnableStorageOptimizer != other.EnableStorageOptimizer {
+		return false
+	}
+	if tdlibParameters.IgnoreFileNames != other.IgnoreFileNames {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (tdlibParameters *TdlibParameters) Diff(other *TdlibParameters) []Change {
+	return tdlibParameters.diff("", other, nil)
+}
+
+func (tdlibParameters *TdlibParameters) diff(path string, other *TdlibParameters, changes []Change) []Change {
+	if tdlibParameters == nil || other == nil {
+		if tdlibParameters != other {
+			changes = append(changes, Change{Path: path, Old: tdlibParameters, New: other})
+		}
+		return changes
+	}
+	if tdlibParameters.UseTestDc != other.UseTestDc {
+		changes = append(changes, Change{Path: fieldPath(path, "use_test_dc"), Old: tdlibParameters.UseTestDc, New: other.UseTestDc})
+	}
+	if tdlibParameters.DatabaseDirectory != other.DatabaseDirectory {
+		changes = append(changes, Change{Path: fieldPath(path, "database_directory"), Old: tdlibParameters.DatabaseDirectory, New: other.DatabaseDirectory})
+	}
+	if tdlibParameters.FilesDirectory != other.FilesDirectory {
+		changes = append(changes, Change{Path: fieldPath(path, "files_directory"), Old: tdlibParameters.FilesDirectory, New: other.FilesDirectory})
+	}
+	if tdlibParameters.UseFileDatabase != other.UseFileDatabase {
+		changes = append(changes, Change{Path: fieldPath(path, "use_file_database"), Old: tdlibParameters.UseFileDatabase, New: other.UseFileDatabase})
+	}
+	if tdlibParameters.UseChatInfoDatabase != other.UseChatInfoDatabase {
+		changes = append(changes, Change{Path: fieldPath(path, "use_chat_info_database"), Old: tdlibParameters.UseChatInfoDatabase, New: other.UseChatInfoDatabase})
+	}
+	if tdlibParameters.UseMessageDatabase != other.UseMessageDatabase {
+		changes = append(changes, Change{Path: fieldPath(path, "use_message_database"), Old: tdlibParameters.UseMessageDatabase, New: other.UseMessageDatabase})
+	}
+	if tdlibParameters.UseSecretChats != other.UseSecretChats {
+		changes = append(changes, Change{Path: fieldPath(path, "use_secret_chats"), Old: tdlibParameters.UseSecretChats, New: other.UseSecretChats})
+	}
+	if tdlibParameters.ApiId != other.ApiId {
+		changes = append(changes, Change{Path: fieldPath(path, "api_id"), Old: tdlibParameters.ApiId, New: other.ApiId})
+	}
+	if tdlibParameters.ApiHash != other.ApiHash {
+		changes = append(changes, Change{Path: fieldPath(path, "api_hash"), Old: tdlibParameters.ApiHash, New: other.ApiHash})
+	}
+	if tdlibParameters.SystemLanguageCode != other.SystemLanguageCode {
+		changes = append(changes, Change{Path: fieldPath(path, "system_language_code"), Old: tdlibParameters.SystemLanguageCode, New: other.SystemLanguageCode})
+	}
+	if tdlibParameters.DeviceModel != other.DeviceModel {
+		changes = append(changes, Change{Path: fieldPath(path, "device_model"), Old: tdlibParameters.DeviceModel, New: other.DeviceModel})
+	}
+	if tdlibParameters.SystemVersion != other.SystemVersion {
+		changes = append(changes, Change{Path: fieldPath(path, "system_version"), Old: tdlibParameters.SystemVersion, New: other.SystemVersion})
+	}
+	if tdlibParameters.ApplicationVersion != other.ApplicationVersion {
+		changes = append(changes, Change{Path: fieldPath(path, "application_version"), Old: tdlibParameters.ApplicationVersion, New: other.ApplicationVersion})
+	}
+	if tdlibParameters.EnableStorageOptimizer != other.EnableStorageOptimizer {
+		changes = append(changes, Change{Path: fieldPath(path, "enable_storage_optimizer"), Old: tdlibParameters.EnableStorageOptimizer, New: other.EnableStorageOptimizer})
+	}
+	if tdlibParameters.IgnoreFileNames != other.IgnoreFileNames {
+		changes = append(changes, Change{Path: fieldPath(path, "ignore_file_names"), Old: tdlibParameters.IgnoreFileNames, New: other.IgnoreFileNames})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (tdlibParameters *TdlibParameters) String() string {
+	return objectString(tdlibParameters)
+}
+
+// LogValue logs the object as its summary
+func (tdlibParameters *TdlibParameters) LogValue() slog.Value {
+	return slog.StringValue(objectString(tdlibParameters))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (tdlibParameters *TdlibParameters) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, tdlibParameters)
+}
+
+func (tdlibParameters *TdlibParameters) printText(p *printer) {
+	if tdlibParameters == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TdlibParameters")
+	p.bool("use_test_dc", tdlibParameters.UseTestDc)
+	p.string("database_directory", tdlibParameters.DatabaseDirectory)
+	p.string("files_directory", tdlibParameters.FilesDirectory)
+	p.bool("use_file_database", tdlibParameters.UseFileDatabase)
+	p.bool("use_chat_info_database", tdlibParameters.UseChatInfoDatabase)
+	p.bool("use_message_database", tdlibParameters.UseMessageDatabase)
+	p.bool("use_secret_chats", tdlibParameters.UseSecretChats)
+	p.int("api_id", int64(tdlibParameters.ApiId))
+	p.string("api_hash", tdlibParameters.ApiHash)
+	p.string("system_language_code", tdlibParameters.SystemLanguageCode)
+	p.string("device_model", tdlibParameters.DeviceModel)
+	p.string("system_version", tdlibParameters.SystemVersion)
+	p.string("application_version", tdlibParameters.ApplicationVersion)
+	p.bool("enable_storage_optimizer", tdlibParameters.EnableStorageOptimizer)
+	p.bool("ignore_file_names", tdlibParameters.IgnoreFileNames)
+	p.endObject(state)
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // TemporaryPasswordState Returns information about the availability of a temporary password, which can be used for payments
@@ -31,6 +31,119 @@ func NewTemporaryPasswordState(hasPassword bool, validFor int32) *TemporaryPassw
 	return &temporaryPasswordStateTemp
 }
 
+// MarshalJSON marshals to json
+func (temporaryPasswordState *TemporaryPasswordState) MarshalJSON() ([]byte, error) {
+	return temporaryPasswordState.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (temporaryPasswordState *TemporaryPasswordState) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, temporaryPasswordState)
+}
+
+func (temporaryPasswordState *TemporaryPasswordState) appendJSON(b []byte) ([]byte, error) {
+	if temporaryPasswordState == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, temporaryPasswordState.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, temporaryPasswordState.tdCommon.Extra)
+	b = append(b, `,"has_password":`...)
+	b = appendJSONBool(b, temporaryPasswordState.HasPassword)
+	b = append(b, `,"valid_for":`...)
+	b = appendJSONInt(b, int64(temporaryPasswordState.ValidFor))
+	return append(b, '}'), nil
+}
+
+func (temporaryPasswordState *TemporaryPasswordState) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			temporaryPasswordState.tdCommon.Type, err = r.readString()
+		case "@extra":
+			temporaryPasswordState.tdCommon.Extra, err = r.readString()
+		case "has_password":
+			temporaryPasswordState.HasPassword, err = r.readBool()
+		case "valid_for":
+			temporaryPasswordState.ValidFor, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (temporaryPasswordState *TemporaryPasswordState) Clone() *TemporaryPasswordState {
+	if temporaryPasswordState == nil {
+		return nil
+	}
+	clone := *temporaryPasswordState
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (temporaryPasswordState *TemporaryPasswordState) Equal(other *TemporaryPasswordState) bool {
+	if temporaryPasswordState == nil || other == nil {
+		return temporaryPasswordState == other
+	}
+	if temporaryPasswordState.HasPassword != other.HasPassword {
+		return false
+	}
+	if temporaryPasswordState.ValidFor != other.ValidFor {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (temporaryPasswordState *TemporaryPasswordState) Diff(other *TemporaryPasswordState) []Change {
+	return temporaryPasswordState.diff("", other, nil)
+}
+
+func (temporaryPasswordState *TemporaryPasswordState) diff(path string, other *TemporaryPasswordState, changes []Change) []Change {
+	if temporaryPasswordState == nil || other == nil {
+		if temporaryPasswordState != other {
+			changes = append(changes, Change{Path: path, Old: temporaryPasswordState, New: other})
+		}
+		return changes
+	}
+	if temporaryPasswordState.HasPassword != other.HasPassword {
+		changes = append(changes, Change{Path: fieldPath(path, "has_password"), Old: temporaryPasswordState.HasPassword, New: other.HasPassword})
+	}
+	if temporaryPasswordState.ValidFor != other.ValidFor {
+		changes = append(changes, Change{Path: fieldPath(path, "valid_for"), Old: temporaryPasswordState.ValidFor, New: other.ValidFor})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (temporaryPasswordState *TemporaryPasswordState) String() string {
+	return objectString(temporaryPasswordState)
+}
+
+// LogValue logs the object as its summary
+func (temporaryPasswordState *TemporaryPasswordState) LogValue() slog.Value {
+	return slog.StringValue(objectString(temporaryPasswordState))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (temporaryPasswordState *TemporaryPasswordState) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, temporaryPasswordState)
+}
+
+func (temporaryPasswordState *TemporaryPasswordState) printText(p *printer) {
+	if temporaryPasswordState == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TemporaryPasswordState")
+	p.bool("has_password", temporaryPasswordState.HasPassword)
+	p.int("valid_for", int64(temporaryPasswordState.ValidFor))
+	p.endObject(state)
+}
+
 // CreateTemporaryPassword Creates a new temporary password for processing payments
 // @param password Persistent user password
 // @param validFor Time during which the temporary password will be valid, in seconds; must be between 60 and 86400
@@ -45,12 +158,12 @@ func (client *Client) CreateTemporaryPassword(password string, validFor int32) (
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var temporaryPasswordState TemporaryPasswordState
-	err = json.Unmarshal(result.Raw, &temporaryPasswordState)
+	err = jsonUnmarshal(result.Raw, &temporaryPasswordState)
 	return &temporaryPasswordState, err
 }
 
@@ -64,11 +177,11 @@ func (client *Client) GetTemporaryPasswordState() (*TemporaryPasswordState, erro
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var temporaryPasswordState TemporaryPasswordState
-	err = json.Unmarshal(result.Raw, &temporaryPasswordState)
+	err = jsonUnmarshal(result.Raw, &temporaryPasswordState)
 	return &temporaryPasswordState, err
 }
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // TermsOfService Contains Telegram terms of service
 type TermsOfService struct {
 	tdCommon
@@ -28,3 +33,135 @@ func NewTermsOfService(text *FormattedText, minUserAge int32, showPopup bool) *T
 
 	return &termsOfServiceTemp
 }
+
+// MarshalJSON marshals to json
+func (termsOfService *TermsOfService) MarshalJSON() ([]byte, error) {
+	return termsOfService.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (termsOfService *TermsOfService) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, termsOfService)
+}
+
+func (termsOfService *TermsOfService) appendJSON(b []byte) ([]byte, error) {
+	if termsOfService == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, termsOfService.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, termsOfService.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b, err = termsOfService.Text.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"min_user_age":`...)
+	b = appendJSONInt(b, int64(termsOfService.MinUserAge))
+	b = append(b, `,"show_popup":`...)
+	b = appendJSONBool(b, termsOfService.ShowPopup)
+	return append(b, '}'), nil
+}
+
+func (termsOfService *TermsOfService) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			termsOfService.tdCommon.Type, err = r.readString()
+		case "@extra":
+			termsOfService.tdCommon.Extra, err = r.readString()
+		case "text":
+			if r.readNull() {
+				termsOfService.Text = nil
+			} else {
+				termsOfService.Text = new(FormattedText)
+				err = termsOfService.Text.decodeJSON(r)
+			}
+		case "min_user_age":
+			termsOfService.MinUserAge, err = r.readInt32()
+		case "show_popup":
+			termsOfService.ShowPopup, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (termsOfService *TermsOfService) Clone() *TermsOfService {
+	if termsOfService == nil {
+		return nil
+	}
+	clone := *termsOfService
+	clone.Text = termsOfService.Text.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (termsOfService *TermsOfService) Equal(other *TermsOfService) bool {
+	if termsOfService == nil || other == nil {
+		return termsOfService == other
+	}
+	if !termsOfService.Text.Equal(other.Text) {
+		return false
+	}
+	if termsOfService.MinUserAge != other.MinUserAge {
+		return false
+	}
+	if termsOfService.ShowPopup != other.ShowPopup {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (termsOfService *TermsOfService) Diff(other *TermsOfService) []Change {
+	return termsOfService.diff("", other, nil)
+}
+
+func (termsOfService *TermsOfService) diff(path string, other *TermsOfService, changes []Change) []Change {
+	if termsOfService == nil || other == nil {
+		if termsOfService != other {
+			changes = append(changes, Change{Path: path, Old: termsOfService, New: other})
+		}
+		return changes
+	}
+	changes = termsOfService.Text.diff(fieldPath(path, "text"), other.Text, changes)
+	if termsOfService.MinUserAge != other.MinUserAge {
+		changes = append(changes, Change{Path: fieldPath(path, "min_user_age"), Old: termsOfService.MinUserAge, New: other.MinUserAge})
+	}
+	if termsOfService.ShowPopup != other.ShowPopup {
+		changes = append(changes, Change{Path: fieldPath(path, "show_popup"), Old: termsOfService.ShowPopup, New: other.ShowPopup})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (termsOfService *TermsOfService) String() string {
+	return objectString(termsOfService)
+}
+
+// LogValue logs the object as its summary
+func (termsOfService *TermsOfService) LogValue() slog.Value {
+	return slog.StringValue(objectString(termsOfService))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (termsOfService *TermsOfService) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, termsOfService)
+}
+
+func (termsOfService *TermsOfService) printText(p *printer) {
+	if termsOfService == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TermsOfService")
+	p.object("text", termsOfService.Text)
+	p.int("min_user_age", int64(termsOfService.MinUserAge))
+	p.bool("show_popup", termsOfService.ShowPopup)
+	p.endObject(state)
+}
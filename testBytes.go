@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // TestBytes A simple object containing a sequence of bytes; for testing only
@@ -28,6 +28,111 @@ func NewTestBytes(value []byte) *TestBytes {
 	return &testBytesTemp
 }
 
+// MarshalJSON marshals to json
+func (testBytes *TestBytes) MarshalJSON() ([]byte, error) {
+	return testBytes.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (testBytes *TestBytes) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, testBytes)
+}
+
+func (testBytes *TestBytes) appendJSON(b []byte) ([]byte, error) {
+	if testBytes == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, testBytes.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, testBytes.tdCommon.Extra)
+	b = append(b, `,"value":`...)
+	b = appendJSONBytes(b, testBytes.Value)
+	return append(b, '}'), nil
+}
+
+func (testBytes *TestBytes) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			testBytes.tdCommon.Type, err = r.readString()
+		case "@extra":
+			testBytes.tdCommon.Extra, err = r.readString()
+		case "value":
+			testBytes.Value, err = r.readBytes()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (testBytes *TestBytes) Clone() *TestBytes {
+	if testBytes == nil {
+		return nil
+	}
+	clone := *testBytes
+	if testBytes.Value != nil {
+		clone.Value = append([]byte{}, testBytes.Value...)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (testBytes *TestBytes) Equal(other *TestBytes) bool {
+	if testBytes == nil || other == nil {
+		return testBytes == other
+	}
+	if !equalBytes(testBytes.Value, other.Value) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (testBytes *TestBytes) Diff(other *TestBytes) []Change {
+	return testBytes.diff("", other, nil)
+}
+
+func (testBytes *TestBytes) diff(path string, other *TestBytes, changes []Change) []Change {
+	if testBytes == nil || other == nil {
+		if testBytes != other {
+			changes = append(changes, Change{Path: path, Old: testBytes, New: other})
+		}
+		return changes
+	}
+	if !equalBytes(testBytes.Value, other.Value) {
+		changes = append(changes, Change{Path: fieldPath(path, "value"), Old: testBytes.Value, New: other.Value})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (testBytes *TestBytes) String() string {
+	return objectString(testBytes)
+}
+
+// LogValue logs the object as its summary
+func (testBytes *TestBytes) LogValue() slog.Value {
+	return slog.StringValue(objectString(testBytes))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (testBytes *TestBytes) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, testBytes)
+}
+
+func (testBytes *TestBytes) printText(p *printer) {
+	if testBytes == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TestBytes")
+	p.bytes("value", testBytes.Value)
+	p.endObject(state)
+}
+
 // TestCallBytes Returns the received bytes; for testing only. This is an offline method. Can be called before authorization
 // @param x Bytes to return
 func (client *Client) TestCallBytes(x []byte) (*TestBytes, error) {
@@ -40,11 +145,11 @@ func (client *Client) TestCallBytes(x []byte) (*TestBytes, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var testBytes TestBytes
-	err = json.Unmarshal(result.Raw, &testBytes)
+	err = jsonUnmarshal(result.Raw, &testBytes)
 	return &testBytes, err
 }
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // TestInt A simple object containing a number; for testing only
@@ -28,6 +28,108 @@ func NewTestInt(value int32) *TestInt {
 	return &testIntTemp
 }
 
+// MarshalJSON marshals to json
+func (testInt *TestInt) MarshalJSON() ([]byte, error) {
+	return testInt.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (testInt *TestInt) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, testInt)
+}
+
+func (testInt *TestInt) appendJSON(b []byte) ([]byte, error) {
+	if testInt == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, testInt.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, testInt.tdCommon.Extra)
+	b = append(b, `,"value":`...)
+	b = appendJSONInt(b, int64(testInt.Value))
+	return append(b, '}'), nil
+}
+
+func (testInt *TestInt) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			testInt.tdCommon.Type, err = r.readString()
+		case "@extra":
+			testInt.tdCommon.Extra, err = r.readString()
+		case "value":
+			testInt.Value, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (testInt *TestInt) Clone() *TestInt {
+	if testInt == nil {
+		return nil
+	}
+	clone := *testInt
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (testInt *TestInt) Equal(other *TestInt) bool {
+	if testInt == nil || other == nil {
+		return testInt == other
+	}
+	if testInt.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (testInt *TestInt) Diff(other *TestInt) []Change {
+	return testInt.diff("", other, nil)
+}
+
+func (testInt *TestInt) diff(path string, other *TestInt, changes []Change) []Change {
+	if testInt == nil || other == nil {
+		if testInt != other {
+			changes = append(changes, Change{Path: path, Old: testInt, New: other})
+		}
+		return changes
+	}
+	if testInt.Value != other.Value {
+		changes = append(changes, Change{Path: fieldPath(path, "value"), Old: testInt.Value, New: other.Value})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (testInt *TestInt) String() string {
+	return objectString(testInt)
+}
+
+// LogValue logs the object as its summary
+func (testInt *TestInt) LogValue() slog.Value {
+	return slog.StringValue(objectString(testInt))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (testInt *TestInt) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, testInt)
+}
+
+func (testInt *TestInt) printText(p *printer) {
+	if testInt == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TestInt")
+	p.int("value", int64(testInt.Value))
+	p.endObject(state)
+}
+
 // TestSquareInt Returns the squared received number; for testing only. This is an offline method. Can be called before authorization
 // @param x Number to square
 func (client *Client) TestSquareInt(x int32) (*TestInt, error) {
@@ -40,11 +142,11 @@ func (client *Client) TestSquareInt(x int32) (*TestInt, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var testInt TestInt
-	err = json.Unmarshal(result.Raw, &testInt)
+	err = jsonUnmarshal(result.Raw, &testInt)
 	return &testInt, err
 }
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // TestString A simple object containing a string; for testing only
@@ -28,6 +28,108 @@ func NewTestString(value string) *TestString {
 	return &testStringTemp
 }
 
+// MarshalJSON marshals to json
+func (testString *TestString) MarshalJSON() ([]byte, error) {
+	return testString.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (testString *TestString) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, testString)
+}
+
+func (testString *TestString) appendJSON(b []byte) ([]byte, error) {
+	if testString == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, testString.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, testString.tdCommon.Extra)
+	b = append(b, `,"value":`...)
+	b = appendJSONString(b, testString.Value)
+	return append(b, '}'), nil
+}
+
+func (testString *TestString) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			testString.tdCommon.Type, err = r.readString()
+		case "@extra":
+			testString.tdCommon.Extra, err = r.readString()
+		case "value":
+			testString.Value, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (testString *TestString) Clone() *TestString {
+	if testString == nil {
+		return nil
+	}
+	clone := *testString
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (testString *TestString) Equal(other *TestString) bool {
+	if testString == nil || other == nil {
+		return testString == other
+	}
+	if testString.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (testString *TestString) Diff(other *TestString) []Change {
+	return testString.diff("", other, nil)
+}
+
+func (testString *TestString) diff(path string, other *TestString, changes []Change) []Change {
+	if testString == nil || other == nil {
+		if testString != other {
+			changes = append(changes, Change{Path: path, Old: testString, New: other})
+		}
+		return changes
+	}
+	if testString.Value != other.Value {
+		changes = append(changes, Change{Path: fieldPath(path, "value"), Old: testString.Value, New: other.Value})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (testString *TestString) String() string {
+	return objectString(testString)
+}
+
+// LogValue logs the object as its summary
+func (testString *TestString) LogValue() slog.Value {
+	return slog.StringValue(objectString(testString))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (testString *TestString) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, testString)
+}
+
+func (testString *TestString) printText(p *printer) {
+	if testString == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TestString")
+	p.string("value", testString.Value)
+	p.endObject(state)
+}
+
 // TestCallString Returns the received string; for testing only. This is an offline method. Can be called before authorization
 // @param x String to return
 func (client *Client) TestCallString(x string) (*TestString, error) {
@@ -40,11 +142,11 @@ func (client *Client) TestCallString(x string) (*TestString, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var testString TestString
-	err = json.Unmarshal(result.Raw, &testString)
+	err = jsonUnmarshal(result.Raw, &testString)
 	return &testString, err
 }
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // TestVectorInt A simple object containing a vector of numbers; for testing only
@@ -28,6 +28,146 @@ func NewTestVectorInt(value []int32) *TestVectorInt {
 	return &testVectorIntTemp
 }
 
+// MarshalJSON marshals to json
+func (testVectorInt *TestVectorInt) MarshalJSON() ([]byte, error) {
+	return testVectorInt.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (testVectorInt *TestVectorInt) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, testVectorInt)
+}
+
+func (testVectorInt *TestVectorInt) appendJSON(b []byte) ([]byte, error) {
+	if testVectorInt == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, testVectorInt.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, testVectorInt.tdCommon.Extra)
+	b = append(b, `,"value":`...)
+	if testVectorInt.Value == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range testVectorInt.Value {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(testVectorInt.Value[i0]))
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (testVectorInt *TestVectorInt) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			testVectorInt.tdCommon.Type, err = r.readString()
+		case "@extra":
+			testVectorInt.tdCommon.Extra, err = r.readString()
+		case "value":
+			if r.readNull() {
+				testVectorInt.Value = nil
+			} else {
+				testVectorInt.Value = make([]int32, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int32
+					item0, err = r.readInt32()
+					testVectorInt.Value = append(testVectorInt.Value, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (testVectorInt *TestVectorInt) Clone() *TestVectorInt {
+	if testVectorInt == nil {
+		return nil
+	}
+	clone := *testVectorInt
+	if testVectorInt.Value != nil {
+		clone.Value = make([]int32, len(testVectorInt.Value))
+		copy(clone.Value, testVectorInt.Value)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (testVectorInt *TestVectorInt) Equal(other *TestVectorInt) bool {
+	if testVectorInt == nil || other == nil {
+		return testVectorInt == other
+	}
+	if len(testVectorInt.Value) != len(other.Value) {
+		return false
+	}
+	for i0 := range testVectorInt.Value {
+		if testVectorInt.Value[i0] != other.Value[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (testVectorInt *TestVectorInt) Diff(other *TestVectorInt) []Change {
+	return testVectorInt.diff("", other, nil)
+}
+
+func (testVectorInt *TestVectorInt) diff(path string, other *TestVectorInt, changes []Change) []Change {
+	if testVectorInt == nil || other == nil {
+		if testVectorInt != other {
+			changes = append(changes, Change{Path: path, Old: testVectorInt, New: other})
+		}
+		return changes
+	}
+	if len(testVectorInt.Value) != len(other.Value) {
+		changes = append(changes, Change{Path: fieldPath(path, "value"), Old: testVectorInt.Value, New: other.Value})
+	} else {
+		for i0 := range testVectorInt.Value {
+			if testVectorInt.Value[i0] != other.Value[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "value"), i0), Old: testVectorInt.Value[i0], New: other.Value[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (testVectorInt *TestVectorInt) String() string {
+	return objectString(testVectorInt)
+}
+
+// LogValue logs the object as its summary
+func (testVectorInt *TestVectorInt) LogValue() slog.Value {
+	return slog.StringValue(objectString(testVectorInt))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (testVectorInt *TestVectorInt) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, testVectorInt)
+}
+
+func (testVectorInt *TestVectorInt) printText(p *printer) {
+	if testVectorInt == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TestVectorInt")
+	p.vector("value", len(testVectorInt.Value), func(i0 int) {
+		p.int("value", int64(testVectorInt.Value[i0]))
+	})
+	p.endObject(state)
+}
+
 // TestCallVectorInt Returns the received vector of numbers; for testing only. This is an offline method. Can be called before authorization
 // @param x Vector of numbers to return
 func (client *Client) TestCallVectorInt(x []int32) (*TestVectorInt, error) {
@@ -40,11 +180,11 @@ func (client *Client) TestCallVectorInt(x []int32) (*TestVectorInt, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var testVectorInt TestVectorInt
-	err = json.Unmarshal(result.Raw, &testVectorInt)
+	err = jsonUnmarshal(result.Raw, &testVectorInt)
 	return &testVectorInt, err
 }
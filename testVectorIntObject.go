@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // TestVectorIntObject A simple object containing a vector of objects that hold a number; for testing only
@@ -28,6 +28,150 @@ func NewTestVectorIntObject(value []TestInt) *TestVectorIntObject {
 	return &testVectorIntObjectTemp
 }
 
+// MarshalJSON marshals to json
+func (testVectorIntObject *TestVectorIntObject) MarshalJSON() ([]byte, error) {
+	return testVectorIntObject.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (testVectorIntObject *TestVectorIntObject) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, testVectorIntObject)
+}
+
+func (testVectorIntObject *TestVectorIntObject) appendJSON(b []byte) ([]byte, error) {
+	if testVectorIntObject == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, testVectorIntObject.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, testVectorIntObject.tdCommon.Extra)
+	b = append(b, `,"value":`...)
+	if testVectorIntObject.Value == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range testVectorIntObject.Value {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = testVectorIntObject.Value[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (testVectorIntObject *TestVectorIntObject) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			testVectorIntObject.tdCommon.Type, err = r.readString()
+		case "@extra":
+			testVectorIntObject.tdCommon.Extra, err = r.readString()
+		case "value":
+			if r.readNull() {
+				testVectorIntObject.Value = nil
+			} else {
+				testVectorIntObject.Value = make([]TestInt, 0)
+				err = r.readArray(func() (err error) {
+					var item0 TestInt
+					err = item0.decodeJSON(r)
+					testVectorIntObject.Value = append(testVectorIntObject.Value, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (testVectorIntObject *TestVectorIntObject) Clone() *TestVectorIntObject {
+	if testVectorIntObject == nil {
+		return nil
+	}
+	clone := *testVectorIntObject
+	if testVectorIntObject.Value != nil {
+		clone.Value = make([]TestInt, len(testVectorIntObject.Value))
+		for i0 := range testVectorIntObject.Value {
+			clone.Value[i0] = *testVectorIntObject.Value[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (testVectorIntObject *TestVectorIntObject) Equal(other *TestVectorIntObject) bool {
+	if testVectorIntObject == nil || other == nil {
+		return testVectorIntObject == other
+	}
+	if len(testVectorIntObject.Value) != len(other.Value) {
+		return false
+	}
+	for i0 := range testVectorIntObject.Value {
+		if !testVectorIntObject.Value[i0].Equal(&other.Value[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (testVectorIntObject *TestVectorIntObject) Diff(other *TestVectorIntObject) []Change {
+	return testVectorIntObject.diff("", other, nil)
+}
+
+func (testVectorIntObject *TestVectorIntObject) diff(path string, other *TestVectorIntObject, changes []Change) []Change {
+	if testVectorIntObject == nil || other == nil {
+		if testVectorIntObject != other {
+			changes = append(changes, Change{Path: path, Old: testVectorIntObject, New: other})
+		}
+		return changes
+	}
+	if len(testVectorIntObject.Value) != len(other.Value) {
+		changes = append(changes, Change{Path: fieldPath(path, "value"), Old: testVectorIntObject.Value, New: other.Value})
+	} else {
+		for i0 := range testVectorIntObject.Value {
+			changes = testVectorIntObject.Value[i0].diff(indexPath(fieldPath(path, "value"), i0), &other.Value[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (testVectorIntObject *TestVectorIntObject) String() string {
+	return objectString(testVectorIntObject)
+}
+
+// LogValue logs the object as its summary
+func (testVectorIntObject *TestVectorIntObject) LogValue() slog.Value {
+	return slog.StringValue(objectString(testVectorIntObject))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (testVectorIntObject *TestVectorIntObject) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, testVectorIntObject)
+}
+
+func (testVectorIntObject *TestVectorIntObject) printText(p *printer) {
+	if testVectorIntObject == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TestVectorIntObject")
+	p.vector("value", len(testVectorIntObject.Value), func(i0 int) {
+		testVectorIntObject.Value[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // TestCallVectorIntObject Returns the received vector of objects containing a number; for testing only. This is an offline method. Can be called before authorization
 // @param x Vector of objects to return
 func (client *Client) TestCallVectorIntObject(x []TestInt) (*TestVectorIntObject, error) {
@@ -40,11 +184,11 @@ func (client *Client) TestCallVectorIntObject(x []TestInt) (*TestVectorIntObject
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var testVectorIntObject TestVectorIntObject
-	err = json.Unmarshal(result.Raw, &testVectorIntObject)
+	err = jsonUnmarshal(result.Raw, &testVectorIntObject)
 	return &testVectorIntObject, err
 }
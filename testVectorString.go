@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // TestVectorString A simple object containing a vector of strings; for testing only
@@ -28,6 +28,146 @@ func NewTestVectorString(value []string) *TestVectorString {
 	return &testVectorStringTemp
 }
 
+// MarshalJSON marshals to json
+func (testVectorString *TestVectorString) MarshalJSON() ([]byte, error) {
+	return testVectorString.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (testVectorString *TestVectorString) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, testVectorString)
+}
+
+func (testVectorString *TestVectorString) appendJSON(b []byte) ([]byte, error) {
+	if testVectorString == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, testVectorString.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, testVectorString.tdCommon.Extra)
+	b = append(b, `,"value":`...)
+	if testVectorString.Value == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range testVectorString.Value {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONString(b, testVectorString.Value[i0])
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (testVectorString *TestVectorString) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			testVectorString.tdCommon.Type, err = r.readString()
+		case "@extra":
+			testVectorString.tdCommon.Extra, err = r.readString()
+		case "value":
+			if r.readNull() {
+				testVectorString.Value = nil
+			} else {
+				testVectorString.Value = make([]string, 0)
+				err = r.readArray(func() (err error) {
+					var item0 string
+					item0, err = r.readString()
+					testVectorString.Value = append(testVectorString.Value, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (testVectorString *TestVectorString) Clone() *TestVectorString {
+	if testVectorString == nil {
+		return nil
+	}
+	clone := *testVectorString
+	if testVectorString.Value != nil {
+		clone.Value = make([]string, len(testVectorString.Value))
+		copy(clone.Value, testVectorString.Value)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (testVectorString *TestVectorString) Equal(other *TestVectorString) bool {
+	if testVectorString == nil || other == nil {
+		return testVectorString == other
+	}
+	if len(testVectorString.Value) != len(other.Value) {
+		return false
+	}
+	for i0 := range testVectorString.Value {
+		if testVectorString.Value[i0] != other.Value[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (testVectorString *TestVectorString) Diff(other *TestVectorString) []Change {
+	return testVectorString.diff("", other, nil)
+}
+
+func (testVectorString *TestVectorString) diff(path string, other *TestVectorString, changes []Change) []Change {
+	if testVectorString == nil || other == nil {
+		if testVectorString != other {
+			changes = append(changes, Change{Path: path, Old: testVectorString, New: other})
+		}
+		return changes
+	}
+	if len(testVectorString.Value) != len(other.Value) {
+		changes = append(changes, Change{Path: fieldPath(path, "value"), Old: testVectorString.Value, New: other.Value})
+	} else {
+		for i0 := range testVectorString.Value {
+			if testVectorString.Value[i0] != other.Value[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "value"), i0), Old: testVectorString.Value[i0], New: other.Value[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (testVectorString *TestVectorString) String() string {
+	return objectString(testVectorString)
+}
+
+// LogValue logs the object as its summary
+func (testVectorString *TestVectorString) LogValue() slog.Value {
+	return slog.StringValue(objectString(testVectorString))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (testVectorString *TestVectorString) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, testVectorString)
+}
+
+func (testVectorString *TestVectorString) printText(p *printer) {
+	if testVectorString == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TestVectorString")
+	p.vector("value", len(testVectorString.Value), func(i0 int) {
+		p.string("value", testVectorString.Value[i0])
+	})
+	p.endObject(state)
+}
+
 // TestCallVectorString Returns the received vector of strings; for testing only. This is an offline method. Can be called before authorization
 // @param x Vector of strings to return
 func (client *Client) TestCallVectorString(x []string) (*TestVectorString, error) {
@@ -40,11 +180,11 @@ func (client *Client) TestCallVectorString(x []string) (*TestVectorString, error
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var testVectorString TestVectorString
-	err = json.Unmarshal(result.Raw, &testVectorString)
+	err = jsonUnmarshal(result.Raw, &testVectorString)
 	return &testVectorString, err
 }
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // TestVectorStringObject A simple object containing a vector of objects that hold a string; for testing only
@@ -28,6 +28,150 @@ func NewTestVectorStringObject(value []TestString) *TestVectorStringObject {
 	return &testVectorStringObjectTemp
 }
 
+// MarshalJSON marshals to json
+func (testVectorStringObject *TestVectorStringObject) MarshalJSON() ([]byte, error) {
+	return testVectorStringObject.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (testVectorStringObject *TestVectorStringObject) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, testVectorStringObject)
+}
+
+func (testVectorStringObject *TestVectorStringObject) appendJSON(b []byte) ([]byte, error) {
+	if testVectorStringObject == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, testVectorStringObject.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, testVectorStringObject.tdCommon.Extra)
+	b = append(b, `,"value":`...)
+	if testVectorStringObject.Value == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range testVectorStringObject.Value {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = testVectorStringObject.Value[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (testVectorStringObject *TestVectorStringObject) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			testVectorStringObject.tdCommon.Type, err = r.readString()
+		case "@extra":
+			testVectorStringObject.tdCommon.Extra, err = r.readString()
+		case "value":
+			if r.readNull() {
+				testVectorStringObject.Value = nil
+			} else {
+				testVectorStringObject.Value = make([]TestString, 0)
+				err = r.readArray(func() (err error) {
+					var item0 TestString
+					err = item0.decodeJSON(r)
+					testVectorStringObject.Value = append(testVectorStringObject.Value, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (testVectorStringObject *TestVectorStringObject) Clone() *TestVectorStringObject {
+	if testVectorStringObject == nil {
+		return nil
+	}
+	clone := *testVectorStringObject
+	if testVectorStringObject.Value != nil {
+		clone.Value = make([]TestString, len(testVectorStringObject.Value))
+		for i0 := range testVectorStringObject.Value {
+			clone.Value[i0] = *testVectorStringObject.Value[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (testVectorStringObject *TestVectorStringObject) Equal(other *TestVectorStringObject) bool {
+	if testVectorStringObject == nil || other == nil {
+		return testVectorStringObject == other
+	}
+	if len(testVectorStringObject.Value) != len(other.Value) {
+		return false
+	}
+	for i0 := range testVectorStringObject.Value {
+		if !testVectorStringObject.Value[i0].Equal(&other.Value[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (testVectorStringObject *TestVectorStringObject) Diff(other *TestVectorStringObject) []Change {
+	return testVectorStringObject.diff("", other, nil)
+}
+
+func (testVectorStringObject *TestVectorStringObject) diff(path string, other *TestVectorStringObject, changes []Change) []Change {
+	if testVectorStringObject == nil || other == nil {
+		if testVectorStringObject != other {
+			changes = append(changes, Change{Path: path, Old: testVectorStringObject, New: other})
+		}
+		return changes
+	}
+	if len(testVectorStringObject.Value) != len(other.Value) {
+		changes = append(changes, Change{Path: fieldPath(path, "value"), Old: testVectorStringObject.Value, New: other.Value})
+	} else {
+		for i0 := range testVectorStringObject.Value {
+			changes = testVectorStringObject.Value[i0].diff(indexPath(fieldPath(path, "value"), i0), &other.Value[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (testVectorStringObject *TestVectorStringObject) String() string {
+	return objectString(testVectorStringObject)
+}
+
+// LogValue logs the object as its summary
+func (testVectorStringObject *TestVectorStringObject) LogValue() slog.Value {
+	return slog.StringValue(objectString(testVectorStringObject))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (testVectorStringObject *TestVectorStringObject) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, testVectorStringObject)
+}
+
+func (testVectorStringObject *TestVectorStringObject) printText(p *printer) {
+	if testVectorStringObject == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TestVectorStringObject")
+	p.vector("value", len(testVectorStringObject.Value), func(i0 int) {
+		testVectorStringObject.Value[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // TestCallVectorStringObject Returns the received vector of objects containing a string; for testing only. This is an offline method. Can be called before authorization
 // @param x Vector of objects to return
 func (client *Client) TestCallVectorStringObject(x []TestString) (*TestVectorStringObject, error) {
@@ -40,11 +184,11 @@ func (client *Client) TestCallVectorStringObject(x []TestString) (*TestVectorStr
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var testVectorStringObject TestVectorStringObject
-	err = json.Unmarshal(result.Raw, &testVectorStringObject)
+	err = jsonUnmarshal(result.Raw, &testVectorStringObject)
 	return &testVectorStringObject, err
 }
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // Text Contains some text
@@ -28,6 +28,108 @@ func NewText(text string) *Text {
 	return &textTemp
 }
 
+// MarshalJSON marshals to json
+func (text *Text) MarshalJSON() ([]byte, error) {
+	return text.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (text *Text) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, text)
+}
+
+func (text *Text) appendJSON(b []byte) ([]byte, error) {
+	if text == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, text.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, text.tdCommon.Extra)
+	b = append(b, `,"text":`...)
+	b = appendJSONString(b, text.Text)
+	return append(b, '}'), nil
+}
+
+func (text *Text) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			text.tdCommon.Type, err = r.readString()
+		case "@extra":
+			text.tdCommon.Extra, err = r.readString()
+		case "text":
+			text.Text, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (text *Text) Clone() *Text {
+	if text == nil {
+		return nil
+	}
+	clone := *text
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (text *Text) Equal(other *Text) bool {
+	if text == nil || other == nil {
+		return text == other
+	}
+	if text.Text != other.Text {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (text *Text) Diff(other *Text) []Change {
+	return text.diff("", other, nil)
+}
+
+func (text *Text) diff(path string, other *Text, changes []Change) []Change {
+	if text == nil || other == nil {
+		if text != other {
+			changes = append(changes, Change{Path: path, Old: text, New: other})
+		}
+		return changes
+	}
+	if text.Text != other.Text {
+		changes = append(changes, Change{Path: fieldPath(path, "text"), Old: text.Text, New: other.Text})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (text *Text) String() string {
+	return objectString(text)
+}
+
+// LogValue logs the object as its summary
+func (text *Text) LogValue() slog.Value {
+	return slog.StringValue(objectString(text))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (text *Text) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, text)
+}
+
+func (text *Text) printText(p *printer) {
+	if text == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Text")
+	p.string("text", text.Text)
+	p.endObject(state)
+}
+
 // GetMessageEmbeddingCode Returns an HTML code for embedding the message. Available only for messages in supergroups and channels with a username
 // @param chatId Identifier of the chat to which the message belongs
 // @param messageId Identifier of the message
@@ -44,12 +146,12 @@ func (client *Client) GetMessageEmbeddingCode(chatId int64, messageId int64, for
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var text Text
-	err = json.Unmarshal(result.Raw, &text)
+	err = jsonUnmarshal(result.Raw, &text)
 	return &text, err
 }
 
@@ -65,12 +167,33 @@ func (client *Client) GetFileMimeType(fileName string) (*Text, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
+		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
+	}
+
+	var text Text
+	err = jsonUnmarshal(result.Raw, &text)
+	return &text, err
+}
+
+// ExecuteGetFileMimeType Synchronously executes GetFileMimeType, without a client. Returns the MIME type of a file, guessed by its extension. Returns an empty string on failure. Can be called synchronously
+// @param fileName The name of the file or path to the file
+func ExecuteGetFileMimeType(fileName string) (*Text, error) {
+	result, err := execute(UpdateData{
+		"@type":     "getFileMimeType",
+		"file_name": fileName,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var text Text
-	err = json.Unmarshal(result.Raw, &text)
+	err = jsonUnmarshal(result.Raw, &text)
 	return &text, err
 }
 
@@ -86,12 +209,33 @@ func (client *Client) GetFileExtension(mimeType string) (*Text, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
+		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
+	}
+
+	var text Text
+	err = jsonUnmarshal(result.Raw, &text)
+	return &text, err
+}
+
+// ExecuteGetFileExtension Synchronously executes GetFileExtension, without a client. Returns the extension of a file, guessed by its MIME type. Returns an empty string on failure. Can be called synchronously
+// @param mimeType The MIME type of the file
+func ExecuteGetFileExtension(mimeType string) (*Text, error) {
+	result, err := execute(UpdateData{
+		"@type":     "getFileExtension",
+		"mime_type": mimeType,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var text Text
-	err = json.Unmarshal(result.Raw, &text)
+	err = jsonUnmarshal(result.Raw, &text)
 	return &text, err
 }
 
@@ -107,12 +251,33 @@ func (client *Client) CleanFileName(fileName string) (*Text, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
+		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
+	}
+
+	var text Text
+	err = jsonUnmarshal(result.Raw, &text)
+	return &text, err
+}
+
+// ExecuteCleanFileName Synchronously executes CleanFileName, without a client. Removes potentially dangerous characters from the name of a file. The encoding of the file name is supposed to be UTF-8. Returns an empty string on failure. Can be called synchronously
+// @param fileName File name or path to the file
+func ExecuteCleanFileName(fileName string) (*Text, error) {
+	result, err := execute(UpdateData{
+		"@type":     "cleanFileName",
+		"file_name": fileName,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var text Text
-	err = json.Unmarshal(result.Raw, &text)
+	err = jsonUnmarshal(result.Raw, &text)
 	return &text, err
 }
 
@@ -128,12 +293,33 @@ func (client *Client) GetJsonString(jsonStringValue JsonValue) (*Text, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
+		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
+	}
+
+	var text Text
+	err = jsonUnmarshal(result.Raw, &text)
+	return &text, err
+}
+
+// ExecuteGetJsonString Synchronously executes GetJsonString, without a client. Converts a JsonValue object to corresponding JSON-serialized string. Can be called synchronously
+// @param jsonStringValue The JsonValue object
+func ExecuteGetJsonString(jsonStringValue JsonValue) (*Text, error) {
+	result, err := execute(UpdateData{
+		"@type":      "getJsonString",
+		"json_value": jsonStringValue,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var text Text
-	err = json.Unmarshal(result.Raw, &text)
+	err = jsonUnmarshal(result.Raw, &text)
 	return &text, err
 }
 
@@ -149,12 +335,33 @@ func (client *Client) GetChatFilterDefaultIconName(filter *ChatFilter) (*Text, e
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
+		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
+	}
+
+	var text Text
+	err = jsonUnmarshal(result.Raw, &text)
+	return &text, err
+}
+
+// ExecuteGetChatFilterDefaultIconName Synchronously executes GetChatFilterDefaultIconName, without a client. Returns default icon name for a filter. Can be called synchronously
+// @param filter Chat filter
+func ExecuteGetChatFilterDefaultIconName(filter *ChatFilter) (*Text, error) {
+	result, err := execute(UpdateData{
+		"@type":  "getChatFilterDefaultIconName",
+		"filter": filter,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var text Text
-	err = json.Unmarshal(result.Raw, &text)
+	err = jsonUnmarshal(result.Raw, &text)
 	return &text, err
 }
 
@@ -172,12 +379,12 @@ func (client *Client) GetSuggestedFileName(fileId int32, directory string) (*Tex
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var text Text
-	err = json.Unmarshal(result.Raw, &text)
+	err = jsonUnmarshal(result.Raw, &text)
 	return &text, err
 }
 
@@ -193,12 +400,12 @@ func (client *Client) GetMessageImportConfirmationText(chatId int64) (*Text, err
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var text Text
-	err = json.Unmarshal(result.Raw, &text)
+	err = jsonUnmarshal(result.Raw, &text)
 	return &text, err
 }
 
@@ -226,12 +433,12 @@ func (client *Client) JoinGroupCall(groupCallId int32, participantId MessageSend
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var text Text
-	err = json.Unmarshal(result.Raw, &text)
+	err = jsonUnmarshal(result.Raw, &text)
 	return &text, err
 }
 
@@ -251,12 +458,12 @@ func (client *Client) StartGroupCallScreenSharing(groupCallId int32, audioSource
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var text Text
-	err = json.Unmarshal(result.Raw, &text)
+	err = jsonUnmarshal(result.Raw, &text)
 	return &text, err
 }
 
@@ -272,12 +479,12 @@ func (client *Client) GetPreferredCountryLanguage(countryCode string) (*Text, er
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var text Text
-	err = json.Unmarshal(result.Raw, &text)
+	err = jsonUnmarshal(result.Raw, &text)
 	return &text, err
 }
 
@@ -293,12 +500,12 @@ func (client *Client) GetSuggestedStickerSetName(title string) (*Text, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var text Text
-	err = json.Unmarshal(result.Raw, &text)
+	err = jsonUnmarshal(result.Raw, &text)
 	return &text, err
 }
 
@@ -312,11 +519,11 @@ func (client *Client) GetCountryCode() (*Text, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var text Text
-	err = json.Unmarshal(result.Raw, &text)
+	err = jsonUnmarshal(result.Raw, &text)
 	return &text, err
 }
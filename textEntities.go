@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // TextEntities Contains a list of text entities
@@ -28,6 +28,150 @@ func NewTextEntities(entities []TextEntity) *TextEntities {
 	return &textEntitiesTemp
 }
 
+// MarshalJSON marshals to json
+func (textEntities *TextEntities) MarshalJSON() ([]byte, error) {
+	return textEntities.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (textEntities *TextEntities) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, textEntities)
+}
+
+func (textEntities *TextEntities) appendJSON(b []byte) ([]byte, error) {
+	if textEntities == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, textEntities.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, textEntities.tdCommon.Extra)
+	b = append(b, `,"entities":`...)
+	if textEntities.Entities == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range textEntities.Entities {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = textEntities.Entities[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (textEntities *TextEntities) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			textEntities.tdCommon.Type, err = r.readString()
+		case "@extra":
+			textEntities.tdCommon.Extra, err = r.readString()
+		case "entities":
+			if r.readNull() {
+				textEntities.Entities = nil
+			} else {
+				textEntities.Entities = make([]TextEntity, 0)
+				err = r.readArray(func() (err error) {
+					var item0 TextEntity
+					err = item0.decodeJSON(r)
+					textEntities.Entities = append(textEntities.Entities, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (textEntities *TextEntities) Clone() *TextEntities {
+	if textEntities == nil {
+		return nil
+	}
+	clone := *textEntities
+	if textEntities.Entities != nil {
+		clone.Entities = make([]TextEntity, len(textEntities.Entities))
+		for i0 := range textEntities.Entities {
+			clone.Entities[i0] = *textEntities.Entities[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (textEntities *TextEntities) Equal(other *TextEntities) bool {
+	if textEntities == nil || other == nil {
+		return textEntities == other
+	}
+	if len(textEntities.Entities) != len(other.Entities) {
+		return false
+	}
+	for i0 := range textEntities.Entities {
+		if !textEntities.Entities[i0].Equal(&other.Entities[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (textEntities *TextEntities) Diff(other *TextEntities) []Change {
+	return textEntities.diff("", other, nil)
+}
+
+func (textEntities *TextEntities) diff(path string, other *TextEntities, changes []Change) []Change {
+	if textEntities == nil || other == nil {
+		if textEntities != other {
+			changes = append(changes, Change{Path: path, Old: textEntities, New: other})
+		}
+		return changes
+	}
+	if len(textEntities.Entities) != len(other.Entities) {
+		changes = append(changes, Change{Path: fieldPath(path, "entities"), Old: textEntities.Entities, New: other.Entities})
+	} else {
+		for i0 := range textEntities.Entities {
+			changes = textEntities.Entities[i0].diff(indexPath(fieldPath(path, "entities"), i0), &other.Entities[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (textEntities *TextEntities) String() string {
+	return objectString(textEntities)
+}
+
+// LogValue logs the object as its summary
+func (textEntities *TextEntities) LogValue() slog.Value {
+	return slog.StringValue(objectString(textEntities))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (textEntities *TextEntities) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, textEntities)
+}
+
+func (textEntities *TextEntities) printText(p *printer) {
+	if textEntities == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TextEntities")
+	p.vector("entities", len(textEntities.Entities), func(i0 int) {
+		textEntities.Entities[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetTextEntities Returns all entities (mentions, hashtags, cashtags, bot commands, bank card numbers, URLs, and email addresses) contained in the text. Can be called synchronously
 // @param text The text in which to look for entites
 func (client *Client) GetTextEntities(text string) (*TextEntities, error) {
@@ -40,11 +184,32 @@ func (client *Client) GetTextEntities(text string) (*TextEntities, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
+		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
+	}
+
+	var textEntities TextEntities
+	err = jsonUnmarshal(result.Raw, &textEntities)
+	return &textEntities, err
+}
+
+// ExecuteGetTextEntities Synchronously executes GetTextEntities, without a client. Returns all entities (mentions, hashtags, cashtags, bot commands, bank card numbers, URLs, and email addresses) contained in the text. Can be called synchronously
+// @param text The text in which to look for entites
+func ExecuteGetTextEntities(text string) (*TextEntities, error) {
+	result, err := execute(UpdateData{
+		"@type": "getTextEntities",
+		"text":  text,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var textEntities TextEntities
-	err = json.Unmarshal(result.Raw, &textEntities)
+	err = jsonUnmarshal(result.Raw, &textEntities)
 	return &textEntities, err
 }
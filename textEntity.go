@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // TextEntity Represents a part of the text that needs to be formatted in some unusual way
@@ -33,30 +34,129 @@ func NewTextEntity(offset int32, length int32, typeParam TextEntityType) *TextEn
 	return &textEntityTemp
 }
 
+// MarshalJSON marshals to json
+func (textEntity *TextEntity) MarshalJSON() ([]byte, error) {
+	return textEntity.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (textEntity *TextEntity) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, textEntity)
+}
+
+func (textEntity *TextEntity) appendJSON(b []byte) ([]byte, error) {
+	if textEntity == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, textEntity.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, textEntity.tdCommon.Extra)
+	b = append(b, `,"offset":`...)
+	b = appendJSONInt(b, int64(textEntity.Offset))
+	b = append(b, `,"length":`...)
+	b = appendJSONInt(b, int64(textEntity.Length))
+	b = append(b, `,"type":`...)
+	b, err = appendJSONValue(b, textEntity.Type)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-		Offset int32 `json:"offset"` // Offset of the entity, in UTF-16 code units
-		Length int32 `json:"length"` // Length of the entity, in UTF-16 code units
+	return append(b, '}'), nil
+}
 
-	}{}
-	err = json.Unmarshal(b, &tempObj)
-	if err != nil {
-		return err
+func (textEntity *TextEntity) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			textEntity.tdCommon.Type, err = r.readString()
+		case "@extra":
+			textEntity.tdCommon.Extra, err = r.readString()
+		case "offset":
+			textEntity.Offset, err = r.readInt32()
+		case "length":
+			textEntity.Length, err = r.readInt32()
+		case "type":
+			textEntity.Type, err = decodeTextEntityType(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (textEntity *TextEntity) Clone() *TextEntity {
+	if textEntity == nil {
+		return nil
+	}
+	clone := *textEntity
+	clone.Type = cloneTextEntityType(textEntity.Type)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (textEntity *TextEntity) Equal(other *TextEntity) bool {
+	if textEntity == nil || other == nil {
+		return textEntity == other
+	}
+	if textEntity.Offset != other.Offset {
+		return false
+	}
+	if textEntity.Length != other.Length {
+		return false
 	}
+	if !equalTextEntityType(textEntity.Type, other.Type) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (textEntity *TextEntity) Diff(other *TextEntity) []Change {
+	return textEntity.diff("", other, nil)
+}
+
+func (textEntity *TextEntity) diff(path string, other *TextEntity, changes []Change) []Change {
+	if textEntity == nil || other == nil {
+		if textEntity != other {
+			changes = append(changes, Change{Path: path, Old: textEntity, New: other})
+		}
+		return changes
+	}
+	if textEntity.Offset != other.Offset {
+		changes = append(changes, Change{Path: fieldPath(path, "offset"), Old: textEntity.Offset, New: other.Offset})
+	}
+	if textEntity.Length != other.Length {
+		changes = append(changes, Change{Path: fieldPath(path, "length"), Old: textEntity.Length, New: other.Length})
+	}
+	changes = diffTextEntityType(fieldPath(path, "type"), textEntity.Type, other.Type, changes)
+	return changes
+}
 
-	textEntity.tdCommon = tempObj.tdCommon
-	textEntity.Offset = tempObj.Offset
-	textEntity.Length = tempObj.Length
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (textEntity *TextEntity) String() string {
+	return objectString(textEntity)
+}
 
-	fieldType, _ := unmarshalTextEntityType(objMap["type"])
-	textEntity.Type = fieldType
+// LogValue logs the object as its summary
+func (textEntity *TextEntity) LogValue() slog.Value {
+	return slog.StringValue(objectString(textEntity))
+}
 
-	return nil
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (textEntity *TextEntity) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, textEntity)
+}
+
+func (textEntity *TextEntity) printText(p *printer) {
+	if textEntity == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TextEntity")
+	p.int("offset", int64(textEntity.Offset))
+	p.int("length", int64(textEntity.Length))
+	p.object("type", textEntity.Type)
+	p.endObject(state)
 }
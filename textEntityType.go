@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // TextEntityType Represents a part of the text which must be formatted differently
 type TextEntityType interface {
 	GetTextEntityTypeEnum() TextEntityTypeEnum
+	Accept(visitor TextEntityTypeVisitor)
 }
 
 // TextEntityTypeEnum Alias for abstract TextEntityType 'Sub-Classes', used as constant-enum here
@@ -36,118 +38,169 @@ const (
 	TextEntityTypeMediaTimestampType TextEntityTypeEnum = "textEntityTypeMediaTimestamp"
 )
 
+// UnknownTextEntityType is a TextEntityType of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownTextEntityType struct {
+	Unknown
+}
+
+// GetTextEntityTypeEnum return the enum type of this object
+func (unknownTextEntityType *UnknownTextEntityType) GetTextEntityTypeEnum() TextEntityTypeEnum {
+	return TextEntityTypeEnum(unknownTextEntityType.Type)
+}
+
+// Accept calls the method of the visitor for UnknownTextEntityType
+func (unknownTextEntityType *UnknownTextEntityType) Accept(visitor TextEntityTypeVisitor) {
+	visitor.VisitUnknownTextEntityType(unknownTextEntityType)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownTextEntityType *UnknownTextEntityType) String() string {
+	return objectString(unknownTextEntityType)
+}
+
+// LogValue logs the object as its summary
+func (unknownTextEntityType *UnknownTextEntityType) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownTextEntityType))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownTextEntityType *UnknownTextEntityType) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownTextEntityType)
+}
+
+func (unknownTextEntityType *UnknownTextEntityType) printText(p *printer) {
+	if unknownTextEntityType == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownTextEntityType")
+	p.string("@type", unknownTextEntityType.Type)
+	p.endObject(state)
+}
+
 func unmarshalTextEntityType(rawMsg *json.RawMessage) (TextEntityType, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch TextEntityTypeEnum(objMap["@type"].(string)) {
+	switch TextEntityTypeEnum(typeName) {
 	case TextEntityTypeMentionType:
 		var textEntityTypeMention TextEntityTypeMention
-		err := json.Unmarshal(*rawMsg, &textEntityTypeMention)
+		err := jsonUnmarshal(*rawMsg, &textEntityTypeMention)
 		return &textEntityTypeMention, err
 
 	case TextEntityTypeHashtagType:
 		var textEntityTypeHashtag TextEntityTypeHashtag
-		err := json.Unmarshal(*rawMsg, &textEntityTypeHashtag)
+		err := jsonUnmarshal(*rawMsg, &textEntityTypeHashtag)
 		return &textEntityTypeHashtag, err
 
 	case TextEntityTypeCashtagType:
 		var textEntityTypeCashtag TextEntityTypeCashtag
-		err := json.Unmarshal(*rawMsg, &textEntityTypeCashtag)
+		err := jsonUnmarshal(*rawMsg, &textEntityTypeCashtag)
 		return &textEntityTypeCashtag, err
 
 	case TextEntityTypeBotCommandType:
 		var textEntityTypeBotCommand TextEntityTypeBotCommand
-		err := json.Unmarshal(*rawMsg, &textEntityTypeBotCommand)
+		err := jsonUnmarshal(*rawMsg, &textEntityTypeBotCommand)
 		return &textEntityTypeBotCommand, err
 
 	case TextEntityTypeUrlType:
 		var textEntityTypeUrl TextEntityTypeUrl
-		err := json.Unmarshal(*rawMsg, &textEntityTypeUrl)
+		err := jsonUnmarshal(*rawMsg, &textEntityTypeUrl)
 		return &textEntityTypeUrl, err
 
 	case TextEntityTypeEmailAddressType:
 		var textEntityTypeEmailAddress TextEntityTypeEmailAddress
-		err := json.Unmarshal(*rawMsg, &textEntityTypeEmailAddress)
+		err := jsonUnmarshal(*rawMsg, &textEntityTypeEmailAddress)
 		return &textEntityTypeEmailAddress, err
 
 	case TextEntityTypePhoneNumberType:
 		var textEntityTypePhoneNumber TextEntityTypePhoneNumber
-		err := json.Unmarshal(*rawMsg, &textEntityTypePhoneNumber)
+		err := jsonUnmarshal(*rawMsg, &textEntityTypePhoneNumber)
 		return &textEntityTypePhoneNumber, err
 
 	case TextEntityTypeBankCardNumberType:
 		var textEntityTypeBankCardNumber TextEntityTypeBankCardNumber
-		err := json.Unmarshal(*rawMsg, &textEntityTypeBankCardNumber)
+		err := jsonUnmarshal(*rawMsg, &textEntityTypeBankCardNumber)
 		return &textEntityTypeBankCardNumber, err
 
 	case TextEntityTypeBoldType:
 		var textEntityTypeBold TextEntityTypeBold
-		err := json.Unmarshal(*rawMsg, &textEntityTypeBold)
+		err := jsonUnmarshal(*rawMsg, &textEntityTypeBold)
 		return &textEntityTypeBold, err
 
 	case TextEntityTypeItalicType:
 		var textEntityTypeItalic TextEntityTypeItalic
-		err := json.Unmarshal(*rawMsg, &textEntityTypeItalic)
+		err := jsonUnmarshal(*rawMsg, &textEntityTypeItalic)
 		return &textEntityTypeItalic, err
 
 	case TextEntityTypeUnderlineType:
 		var textEntityTypeUnderline TextEntityTypeUnderline
-		err := json.Unmarshal(*rawMsg, &textEntityTypeUnderline)
+		err := jsonUnmarshal(*rawMsg, &textEntityTypeUnderline)
 		return &textEntityTypeUnderline, err
 
 	case TextEntityTypeStrikethroughType:
 		var textEntityTypeStrikethrough TextEntityTypeStrikethrough
-		err := json.Unmarshal(*rawMsg, &textEntityTypeStrikethrough)
+		err := jsonUnmarshal(*rawMsg, &textEntityTypeStrikethrough)
 		return &textEntityTypeStrikethrough, err
 
 	case TextEntityTypeSpoilerType:
 		var textEntityTypeSpoiler TextEntityTypeSpoiler
-		err := json.Unmarshal(*rawMsg, &textEntityTypeSpoiler)
+		err := jsonUnmarshal(*rawMsg, &textEntityTypeSpoiler)
 		return &textEntityTypeSpoiler, err
 
 	case TextEntityTypeCodeType:
 		var textEntityTypeCode TextEntityTypeCode
-		err := json.Unmarshal(*rawMsg, &textEntityTypeCode)
+		err := jsonUnmarshal(*rawMsg, &textEntityTypeCode)
 		return &textEntityTypeCode, err
 
 	case TextEntityTypePreType:
 		var textEntityTypePre TextEntityTypePre
-		err := json.Unmarshal(*rawMsg, &textEntityTypePre)
+		err := jsonUnmarshal(*rawMsg, &textEntityTypePre)
 		return &textEntityTypePre, err
 
 	case TextEntityTypePreCodeType:
 		var textEntityTypePreCode TextEntityTypePreCode
-		err := json.Unmarshal(*rawMsg, &textEntityTypePreCode)
+		err := jsonUnmarshal(*rawMsg, &textEntityTypePreCode)
 		return &textEntityTypePreCode, err
 
 	case TextEntityTypeTextUrlType:
 		var textEntityTypeTextUrl TextEntityTypeTextUrl
-		err := json.Unmarshal(*rawMsg, &textEntityTypeTextUrl)
+		err := jsonUnmarshal(*rawMsg, &textEntityTypeTextUrl)
 		return &textEntityTypeTextUrl, err
 
 	case TextEntityTypeMentionNameType:
 		var textEntityTypeMentionName TextEntityTypeMentionName
-		err := json.Unmarshal(*rawMsg, &textEntityTypeMentionName)
+		err := jsonUnmarshal(*rawMsg, &textEntityTypeMentionName)
 		return &textEntityTypeMentionName, err
 
 	case TextEntityTypeMediaTimestampType:
 		var textEntityTypeMediaTimestamp TextEntityTypeMediaTimestamp
-		err := json.Unmarshal(*rawMsg, &textEntityTypeMediaTimestamp)
+		err := jsonUnmarshal(*rawMsg, &textEntityTypeMediaTimestamp)
 		return &textEntityTypeMediaTimestamp, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownTextEntityType{Unknown: unknown}, nil
 	}
 }
 
+func decodeTextEntityType(r *jsonReader) (TextEntityType, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalTextEntityType(rawMsg)
+}
+
 // TextEntityTypeMention A mention of a user by their username
 type TextEntityTypeMention struct {
 	tdCommon
@@ -159,7 +212,6 @@ func (textEntityTypeMention *TextEntityTypeMention) MessageType() string {
 }
 
 // NewTextEntityTypeMention creates a new TextEntityTypeMention
-//
 func NewTextEntityTypeMention() *TextEntityTypeMention {
 	textEntityTypeMentionTemp := TextEntityTypeMention{
 		tdCommon: tdCommon{Type: "textEntityTypeMention"},
@@ -168,11 +220,107 @@ func NewTextEntityTypeMention() *TextEntityTypeMention {
 	return &textEntityTypeMentionTemp
 }
 
+// MarshalJSON marshals to json
+func (textEntityTypeMention *TextEntityTypeMention) MarshalJSON() ([]byte, error) {
+	return textEntityTypeMention.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (textEntityTypeMention *TextEntityTypeMention) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, textEntityTypeMention)
+}
+
+func (textEntityTypeMention *TextEntityTypeMention) appendJSON(b []byte) ([]byte, error) {
+	if textEntityTypeMention == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, textEntityTypeMention.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, textEntityTypeMention.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (textEntityTypeMention *TextEntityTypeMention) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			textEntityTypeMention.tdCommon.Type, err = r.readString()
+		case "@extra":
+			textEntityTypeMention.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (textEntityTypeMention *TextEntityTypeMention) Clone() *TextEntityTypeMention {
+	if textEntityTypeMention == nil {
+		return nil
+	}
+	clone := *textEntityTypeMention
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (textEntityTypeMention *TextEntityTypeMention) Equal(other *TextEntityTypeMention) bool {
+	if textEntityTypeMention == nil || other == nil {
+		return textEntityTypeMention == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (textEntityTypeMention *TextEntityTypeMention) Diff(other *TextEntityTypeMention) []Change {
+	return textEntityTypeMention.diff("", other, nil)
+}
+
+func (textEntityTypeMention *TextEntityTypeMention) diff(path string, other *TextEntityTypeMention, changes []Change) []Change {
+	if textEntityTypeMention == nil || other == nil {
+		if textEntityTypeMention != other {
+			changes = append(changes, Change{Path: path, Old: textEntityTypeMention, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (textEntityTypeMention *TextEntityTypeMention) String() string {
+	return objectString(textEntityTypeMention)
+}
+
+// LogValue logs the object as its summary
+func (textEntityTypeMention *TextEntityTypeMention) LogValue() slog.Value {
+	return slog.StringValue(objectString(textEntityTypeMention))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (textEntityTypeMention *TextEntityTypeMention) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, textEntityTypeMention)
+}
+
+func (textEntityTypeMention *TextEntityTypeMention) printText(p *printer) {
+	if textEntityTypeMention == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TextEntityTypeMention")
+	p.endObject(state)
+}
+
 // GetTextEntityTypeEnum return the enum type of this object
 func (textEntityTypeMention *TextEntityTypeMention) GetTextEntityTypeEnum() TextEntityTypeEnum {
 	return TextEntityTypeMentionType
 }
 
+// Accept calls the method of the visitor for TextEntityTypeMention
+func (textEntityTypeMention *TextEntityTypeMention) Accept(visitor TextEntityTypeVisitor) {
+	visitor.VisitTextEntityTypeMention(textEntityTypeMention)
+}
+
 // TextEntityTypeHashtag A hashtag text, beginning with "#"
 type TextEntityTypeHashtag struct {
 	tdCommon
@@ -184,7 +332,6 @@ func (textEntityTypeHashtag *TextEntityTypeHashtag) MessageType() string {
 }
 
 // NewTextEntityTypeHashtag creates a new TextEntityTypeHashtag
-//
 func NewTextEntityTypeHashtag() *TextEntityTypeHashtag {
 	textEntityTypeHashtagTemp := TextEntityTypeHashtag{
 		tdCommon: tdCommon{Type: "textEntityTypeHashtag"},
@@ -193,11 +340,107 @@ func NewTextEntityTypeHashtag() *TextEntityTypeHashtag {
 	return &textEntityTypeHashtagTemp
 }
 
+// MarshalJSON marshals to json
+func (textEntityTypeHashtag *TextEntityTypeHashtag) MarshalJSON() ([]byte, error) {
+	return textEntityTypeHashtag.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (textEntityTypeHashtag *TextEntityTypeHashtag) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, textEntityTypeHashtag)
+}
+
+func (textEntityTypeHashtag *TextEntityTypeHashtag) appendJSON(b []byte) ([]byte, error) {
+	if textEntityTypeHashtag == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, textEntityTypeHashtag.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, textEntityTypeHashtag.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (textEntityTypeHashtag *TextEntityTypeHashtag) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			textEntityTypeHashtag.tdCommon.Type, err = r.readString()
+		case "@extra":
+			textEntityTypeHashtag.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (textEntityTypeHashtag *TextEntityTypeHashtag) Clone() *TextEntityTypeHashtag {
+	if textEntityTypeHashtag == nil {
+		return nil
+	}
+	clone := *textEntityTypeHashtag
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (textEntityTypeHashtag *TextEntityTypeHashtag) Equal(other *TextEntityTypeHashtag) bool {
+	if textEntityTypeHashtag == nil || other == nil {
+		return textEntityTypeHashtag == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (textEntityTypeHashtag *TextEntityTypeHashtag) Diff(other *TextEntityTypeHashtag) []Change {
+	return textEntityTypeHashtag.diff("", other, nil)
+}
+
+func (textEntityTypeHashtag *TextEntityTypeHashtag) diff(path string, other *TextEntityTypeHashtag, changes []Change) []Change {
+	if textEntityTypeHashtag == nil || other == nil {
+		if textEntityTypeHashtag != other {
+			changes = append(changes, Change{Path: path, Old: textEntityTypeHashtag, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (textEntityTypeHashtag *TextEntityTypeHashtag) String() string {
+	return objectString(textEntityTypeHashtag)
+}
+
+// LogValue logs the object as its summary
+func (textEntityTypeHashtag *TextEntityTypeHashtag) LogValue() slog.Value {
+	return slog.StringValue(objectString(textEntityTypeHashtag))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (textEntityTypeHashtag *TextEntityTypeHashtag) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, textEntityTypeHashtag)
+}
+
+func (textEntityTypeHashtag *TextEntityTypeHashtag) printText(p *printer) {
+	if textEntityTypeHashtag == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TextEntityTypeHashtag")
+	p.endObject(state)
+}
+
 // GetTextEntityTypeEnum return the enum type of this object
 func (textEntityTypeHashtag *TextEntityTypeHashtag) GetTextEntityTypeEnum() TextEntityTypeEnum {
 	return TextEntityTypeHashtagType
 }
 
+// Accept calls the method of the visitor for TextEntityTypeHashtag
+func (textEntityTypeHashtag *TextEntityTypeHashtag) Accept(visitor TextEntityTypeVisitor) {
+	visitor.VisitTextEntityTypeHashtag(textEntityTypeHashtag)
+}
+
 // TextEntityTypeCashtag A cashtag text, beginning with "$" and consisting of capital English letters (e.g., "$USD")
 type TextEntityTypeCashtag struct {
 	tdCommon
@@ -209,7 +452,6 @@ func (textEntityTypeCashtag *TextEntityTypeCashtag) MessageType() string {
 }
 
 // NewTextEntityTypeCashtag creates a new TextEntityTypeCashtag
-//
 func NewTextEntityTypeCashtag() *TextEntityTypeCashtag {
 	textEntityTypeCashtagTemp := TextEntityTypeCashtag{
 		tdCommon: tdCommon{Type: "textEntityTypeCashtag"},
@@ -218,11 +460,107 @@ func NewTextEntityTypeCashtag() *TextEntityTypeCashtag {
 	return &textEntityTypeCashtagTemp
 }
 
+// MarshalJSON marshals to json
+func (textEntityTypeCashtag *TextEntityTypeCashtag) MarshalJSON() ([]byte, error) {
+	return textEntityTypeCashtag.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (textEntityTypeCashtag *TextEntityTypeCashtag) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, textEntityTypeCashtag)
+}
+
+func (textEntityTypeCashtag *TextEntityTypeCashtag) appendJSON(b []byte) ([]byte, error) {
+	if textEntityTypeCashtag == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, textEntityTypeCashtag.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, textEntityTypeCashtag.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (textEntityTypeCashtag *TextEntityTypeCashtag) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			textEntityTypeCashtag.tdCommon.Type, err = r.readString()
+		case "@extra":
+			textEntityTypeCashtag.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (textEntityTypeCashtag *TextEntityTypeCashtag) Clone() *TextEntityTypeCashtag {
+	if textEntityTypeCashtag == nil {
+		return nil
+	}
+	clone := *textEntityTypeCashtag
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (textEntityTypeCashtag *TextEntityTypeCashtag) Equal(other *TextEntityTypeCashtag) bool {
+	if textEntityTypeCashtag == nil || other == nil {
+		return textEntityTypeCashtag == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (textEntityTypeCashtag *TextEntityTypeCashtag) Diff(other *TextEntityTypeCashtag) []Change {
+	return textEntityTypeCashtag.diff("", other, nil)
+}
+
+func (textEntityTypeCashtag *TextEntityTypeCashtag) diff(path string, other *TextEntityTypeCashtag, changes []Change) []Change {
+	if textEntityTypeCashtag == nil || other == nil {
+		if textEntityTypeCashtag != other {
+			changes = append(changes, Change{Path: path, Old: textEntityTypeCashtag, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (textEntityTypeCashtag *TextEntityTypeCashtag) String() string {
+	return objectString(textEntityTypeCashtag)
+}
+
+// LogValue logs the object as its summary
+func (textEntityTypeCashtag *TextEntityTypeCashtag) LogValue() slog.Value {
+	return slog.StringValue(objectString(textEntityTypeCashtag))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (textEntityTypeCashtag *TextEntityTypeCashtag) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, textEntityTypeCashtag)
+}
+
+func (textEntityTypeCashtag *TextEntityTypeCashtag) printText(p *printer) {
+	if textEntityTypeCashtag == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TextEntityTypeCashtag")
+	p.endObject(state)
+}
+
 // GetTextEntityTypeEnum return the enum type of this object
 func (textEntityTypeCashtag *TextEntityTypeCashtag) GetTextEntityTypeEnum() TextEntityTypeEnum {
 	return TextEntityTypeCashtagType
 }
 
+// Accept calls the method of the visitor for TextEntityTypeCashtag
+func (textEntityTypeCashtag *TextEntityTypeCashtag) Accept(visitor TextEntityTypeVisitor) {
+	visitor.VisitTextEntityTypeCashtag(textEntityTypeCashtag)
+}
+
 // TextEntityTypeBotCommand A bot command, beginning with "/"
 type TextEntityTypeBotCommand struct {
 	tdCommon
@@ -234,7 +572,6 @@ func (textEntityTypeBotCommand *TextEntityTypeBotCommand) MessageType() string {
 }
 
 // NewTextEntityTypeBotCommand creates a new TextEntityTypeBotCommand
-//
 func NewTextEntityTypeBotCommand() *TextEntityTypeBotCommand {
 	textEntityTypeBotCommandTemp := TextEntityTypeBotCommand{
 		tdCommon: tdCommon{Type: "textEntityTypeBotCommand"},
@@ -243,11 +580,107 @@ func NewTextEntityTypeBotCommand() *TextEntityTypeBotCommand {
 	return &textEntityTypeBotCommandTemp
 }
 
+// MarshalJSON marshals to json
+func (textEntityTypeBotCommand *TextEntityTypeBotCommand) MarshalJSON() ([]byte, error) {
+	return textEntityTypeBotCommand.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (textEntityTypeBotCommand *TextEntityTypeBotCommand) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, textEntityTypeBotCommand)
+}
+
+func (textEntityTypeBotCommand *TextEntityTypeBotCommand) appendJSON(b []byte) ([]byte, error) {
+	if textEntityTypeBotCommand == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, textEntityTypeBotCommand.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, textEntityTypeBotCommand.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (textEntityTypeBotCommand *TextEntityTypeBotCommand) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			textEntityTypeBotCommand.tdCommon.Type, err = r.readString()
+		case "@extra":
+			textEntityTypeBotCommand.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (textEntityTypeBotCommand *TextEntityTypeBotCommand) Clone() *TextEntityTypeBotCommand {
+	if textEntityTypeBotCommand == nil {
+		return nil
+	}
+	clone := *textEntityTypeBotCommand
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (textEntityTypeBotCommand *TextEntityTypeBotCommand) Equal(other *TextEntityTypeBotCommand) bool {
+	if textEntityTypeBotCommand == nil || other == nil {
+		return textEntityTypeBotCommand == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (textEntityTypeBotCommand *TextEntityTypeBotCommand) Diff(other *TextEntityTypeBotCommand) []Change {
+	return textEntityTypeBotCommand.diff("", other, nil)
+}
+
+func (textEntityTypeBotCommand *TextEntityTypeBotCommand) diff(path string, other *TextEntityTypeBotCommand, changes []Change) []Change {
+	if textEntityTypeBotCommand == nil || other == nil {
+		if textEntityTypeBotCommand != other {
+			changes = append(changes, Change{Path: path, Old: textEntityTypeBotCommand, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (textEntityTypeBotCommand *TextEntityTypeBotCommand) String() string {
+	return objectString(textEntityTypeBotCommand)
+}
+
+// LogValue logs the object as its summary
+func (textEntityTypeBotCommand *TextEntityTypeBotCommand) LogValue() slog.Value {
+	return slog.StringValue(objectString(textEntityTypeBotCommand))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (textEntityTypeBotCommand *TextEntityTypeBotCommand) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, textEntityTypeBotCommand)
+}
+
+func (textEntityTypeBotCommand *TextEntityTypeBotCommand) printText(p *printer) {
+	if textEntityTypeBotCommand == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TextEntityTypeBotCommand")
+	p.endObject(state)
+}
+
 // GetTextEntityTypeEnum return the enum type of this object
 func (textEntityTypeBotCommand *TextEntityTypeBotCommand) GetTextEntityTypeEnum() TextEntityTypeEnum {
 	return TextEntityTypeBotCommandType
 }
 
+// Accept calls the method of the visitor for TextEntityTypeBotCommand
+func (textEntityTypeBotCommand *TextEntityTypeBotCommand) Accept(visitor TextEntityTypeVisitor) {
+	visitor.VisitTextEntityTypeBotCommand(textEntityTypeBotCommand)
+}
+
 // TextEntityTypeUrl An HTTP URL
 type TextEntityTypeUrl struct {
 	tdCommon
@@ -259,7 +692,6 @@ func (textEntityTypeUrl *TextEntityTypeUrl) MessageType() string {
 }
 
 // NewTextEntityTypeUrl creates a new TextEntityTypeUrl
-//
 func NewTextEntityTypeUrl() *TextEntityTypeUrl {
 	textEntityTypeUrlTemp := TextEntityTypeUrl{
 		tdCommon: tdCommon{Type: "textEntityTypeUrl"},
@@ -268,11 +700,107 @@ func NewTextEntityTypeUrl() *TextEntityTypeUrl {
 	return &textEntityTypeUrlTemp
 }
 
+// MarshalJSON marshals to json
+func (textEntityTypeUrl *TextEntityTypeUrl) MarshalJSON() ([]byte, error) {
+	return textEntityTypeUrl.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (textEntityTypeUrl *TextEntityTypeUrl) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, textEntityTypeUrl)
+}
+
+func (textEntityTypeUrl *TextEntityTypeUrl) appendJSON(b []byte) ([]byte, error) {
+	if textEntityTypeUrl == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, textEntityTypeUrl.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, textEntityTypeUrl.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (textEntityTypeUrl *TextEntityTypeUrl) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			textEntityTypeUrl.tdCommon.Type, err = r.readString()
+		case "@extra":
+			textEntityTypeUrl.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (textEntityTypeUrl *TextEntityTypeUrl) Clone() *TextEntityTypeUrl {
+	if textEntityTypeUrl == nil {
+		return nil
+	}
+	clone := *textEntityTypeUrl
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (textEntityTypeUrl *TextEntityTypeUrl) Equal(other *TextEntityTypeUrl) bool {
+	if textEntityTypeUrl == nil || other == nil {
+		return textEntityTypeUrl == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (textEntityTypeUrl *TextEntityTypeUrl) Diff(other *TextEntityTypeUrl) []Change {
+	return textEntityTypeUrl.diff("", other, nil)
+}
+
+func (textEntityTypeUrl *TextEntityTypeUrl) diff(path string, other *TextEntityTypeUrl, changes []Change) []Change {
+	if textEntityTypeUrl == nil || other == nil {
+		if textEntityTypeUrl != other {
+			changes = append(changes, Change{Path: path, Old: textEntityTypeUrl, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (textEntityTypeUrl *TextEntityTypeUrl) String() string {
+	return objectString(textEntityTypeUrl)
+}
+
+// LogValue logs the object as its summary
+func (textEntityTypeUrl *TextEntityTypeUrl) LogValue() slog.Value {
+	return slog.StringValue(objectString(textEntityTypeUrl))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (textEntityTypeUrl *TextEntityTypeUrl) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, textEntityTypeUrl)
+}
+
+func (textEntityTypeUrl *TextEntityTypeUrl) printText(p *printer) {
+	if textEntityTypeUrl == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TextEntityTypeUrl")
+	p.endObject(state)
+}
+
 // GetTextEntityTypeEnum return the enum type of this object
 func (textEntityTypeUrl *TextEntityTypeUrl) GetTextEntityTypeEnum() TextEntityTypeEnum {
 	return TextEntityTypeUrlType
 }
 
+// Accept calls the method of the visitor for TextEntityTypeUrl
+func (textEntityTypeUrl *TextEntityTypeUrl) Accept(visitor TextEntityTypeVisitor) {
+	visitor.VisitTextEntityTypeUrl(textEntityTypeUrl)
+}
+
 // TextEntityTypeEmailAddress An email address
 type TextEntityTypeEmailAddress struct {
 	tdCommon
@@ -284,7 +812,6 @@ func (textEntityTypeEmailAddress *TextEntityTypeEmailAddress) MessageType() stri
 }
 
 // NewTextEntityTypeEmailAddress creates a new TextEntityTypeEmailAddress
-//
 func NewTextEntityTypeEmailAddress() *TextEntityTypeEmailAddress {
 	textEntityTypeEmailAddressTemp := TextEntityTypeEmailAddress{
 		tdCommon: tdCommon{Type: "textEntityTypeEmailAddress"},
@@ -293,11 +820,107 @@ func NewTextEntityTypeEmailAddress() *TextEntityTypeEmailAddress {
 	return &textEntityTypeEmailAddressTemp
 }
 
+// MarshalJSON marshals to json
+func (textEntityTypeEmailAddress *TextEntityTypeEmailAddress) MarshalJSON() ([]byte, error) {
+	return textEntityTypeEmailAddress.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (textEntityTypeEmailAddress *TextEntityTypeEmailAddress) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, textEntityTypeEmailAddress)
+}
+
+func (textEntityTypeEmailAddress *TextEntityTypeEmailAddress) appendJSON(b []byte) ([]byte, error) {
+	if textEntityTypeEmailAddress == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, textEntityTypeEmailAddress.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, textEntityTypeEmailAddress.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (textEntityTypeEmailAddress *TextEntityTypeEmailAddress) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			textEntityTypeEmailAddress.tdCommon.Type, err = r.readString()
+		case "@extra":
+			textEntityTypeEmailAddress.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (textEntityTypeEmailAddress *TextEntityTypeEmailAddress) Clone() *TextEntityTypeEmailAddress {
+	if textEntityTypeEmailAddress == nil {
+		return nil
+	}
+	clone := *textEntityTypeEmailAddress
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (textEntityTypeEmailAddress *TextEntityTypeEmailAddress) Equal(other *TextEntityTypeEmailAddress) bool {
+	if textEntityTypeEmailAddress == nil || other == nil {
+		return textEntityTypeEmailAddress == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (textEntityTypeEmailAddress *TextEntityTypeEmailAddress) Diff(other *TextEntityTypeEmailAddress) []Change {
+	return textEntityTypeEmailAddress.diff("", other, nil)
+}
+
+func (textEntityTypeEmailAddress *TextEntityTypeEmailAddress) diff(path string, other *TextEntityTypeEmailAddress, changes []Change) []Change {
+	if textEntityTypeEmailAddress == nil || other == nil {
+		if textEntityTypeEmailAddress != other {
+			changes = append(changes, Change{Path: path, Old: textEntityTypeEmailAddress, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (textEntityTypeEmailAddress *TextEntityTypeEmailAddress) String() string {
+	return objectString(textEntityTypeEmailAddress)
+}
+
+// LogValue logs the object as its summary
+func (textEntityTypeEmailAddress *TextEntityTypeEmailAddress) LogValue() slog.Value {
+	return slog.StringValue(objectString(textEntityTypeEmailAddress))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (textEntityTypeEmailAddress *TextEntityTypeEmailAddress) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, textEntityTypeEmailAddress)
+}
+
+func (textEntityTypeEmailAddress *TextEntityTypeEmailAddress) printText(p *printer) {
+	if textEntityTypeEmailAddress == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TextEntityTypeEmailAddress")
+	p.endObject(state)
+}
+
 // GetTextEntityTypeEnum return the enum type of this object
 func (textEntityTypeEmailAddress *TextEntityTypeEmailAddress) GetTextEntityTypeEnum() TextEntityTypeEnum {
 	return TextEntityTypeEmailAddressType
 }
 
+// Accept calls the method of the visitor for TextEntityTypeEmailAddress
+func (textEntityTypeEmailAddress *TextEntityTypeEmailAddress) Accept(visitor TextEntityTypeVisitor) {
+	visitor.VisitTextEntityTypeEmailAddress(textEntityTypeEmailAddress)
+}
+
 // TextEntityTypePhoneNumber A phone number
 type TextEntityTypePhoneNumber struct {
 	tdCommon
@@ -309,7 +932,6 @@ func (textEntityTypePhoneNumber *TextEntityTypePhoneNumber) MessageType() string
 }
 
 // NewTextEntityTypePhoneNumber creates a new TextEntityTypePhoneNumber
-//
 func NewTextEntityTypePhoneNumber() *TextEntityTypePhoneNumber {
 	textEntityTypePhoneNumberTemp := TextEntityTypePhoneNumber{
 		tdCommon: tdCommon{Type: "textEntityTypePhoneNumber"},
@@ -318,11 +940,107 @@ func NewTextEntityTypePhoneNumber() *TextEntityTypePhoneNumber {
 	return &textEntityTypePhoneNumberTemp
 }
 
+// MarshalJSON marshals to json
+func (textEntityTypePhoneNumber *TextEntityTypePhoneNumber) MarshalJSON() ([]byte, error) {
+	return textEntityTypePhoneNumber.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (textEntityTypePhoneNumber *TextEntityTypePhoneNumber) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, textEntityTypePhoneNumber)
+}
+
+func (textEntityTypePhoneNumber *TextEntityTypePhoneNumber) appendJSON(b []byte) ([]byte, error) {
+	if textEntityTypePhoneNumber == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, textEntityTypePhoneNumber.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, textEntityTypePhoneNumber.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (textEntityTypePhoneNumber *TextEntityTypePhoneNumber) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			textEntityTypePhoneNumber.tdCommon.Type, err = r.readString()
+		case "@extra":
+			textEntityTypePhoneNumber.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (textEntityTypePhoneNumber *TextEntityTypePhoneNumber) Clone() *TextEntityTypePhoneNumber {
+	if textEntityTypePhoneNumber == nil {
+		return nil
+	}
+	clone := *textEntityTypePhoneNumber
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (textEntityTypePhoneNumber *TextEntityTypePhoneNumber) Equal(other *TextEntityTypePhoneNumber) bool {
+	if textEntityTypePhoneNumber == nil || other == nil {
+		return textEntityTypePhoneNumber == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (textEntityTypePhoneNumber *TextEntityTypePhoneNumber) Diff(other *TextEntityTypePhoneNumber) []Change {
+	return textEntityTypePhoneNumber.diff("", other, nil)
+}
+
+func (textEntityTypePhoneNumber *TextEntityTypePhoneNumber) diff(path string, other *TextEntityTypePhoneNumber, changes []Change) []Change {
+	if textEntityTypePhoneNumber == nil || other == nil {
+		if textEntityTypePhoneNumber != other {
+			changes = append(changes, Change{Path: path, Old: textEntityTypePhoneNumber, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (textEntityTypePhoneNumber *TextEntityTypePhoneNumber) String() string {
+	return objectString(textEntityTypePhoneNumber)
+}
+
+// LogValue logs the object as its summary
+func (textEntityTypePhoneNumber *TextEntityTypePhoneNumber) LogValue() slog.Value {
+	return slog.StringValue(objectString(textEntityTypePhoneNumber))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (textEntityTypePhoneNumber *TextEntityTypePhoneNumber) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, textEntityTypePhoneNumber)
+}
+
+func (textEntityTypePhoneNumber *TextEntityTypePhoneNumber) printText(p *printer) {
+	if textEntityTypePhoneNumber == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TextEntityTypePhoneNumber")
+	p.endObject(state)
+}
+
 // GetTextEntityTypeEnum return the enum type of this object
 func (textEntityTypePhoneNumber *TextEntityTypePhoneNumber) GetTextEntityTypeEnum() TextEntityTypeEnum {
 	return TextEntityTypePhoneNumberType
 }
 
+// Accept calls the method of the visitor for TextEntityTypePhoneNumber
+func (textEntityTypePhoneNumber *TextEntityTypePhoneNumber) Accept(visitor TextEntityTypeVisitor) {
+	visitor.VisitTextEntityTypePhoneNumber(textEntityTypePhoneNumber)
+}
+
 // TextEntityTypeBankCardNumber A bank card number. The getBankCardInfo method can be used to get information about the bank card
 type TextEntityTypeBankCardNumber struct {
 	tdCommon
@@ -334,7 +1052,6 @@ func (textEntityTypeBankCardNumber *TextEntityTypeBankCardNumber) MessageType()
 }
 
 // NewTextEntityTypeBankCardNumber creates a new TextEntityTypeBankCardNumber
-//
 func NewTextEntityTypeBankCardNumber() *TextEntityTypeBankCardNumber {
 	textEntityTypeBankCardNumberTemp := TextEntityTypeBankCardNumber{
 		tdCommon: tdCommon{Type: "textEntityTypeBankCardNumber"},
@@ -343,11 +1060,107 @@ func NewTextEntityTypeBankCardNumber() *TextEntityTypeBankCardNumber {
 	return &textEntityTypeBankCardNumberTemp
 }
 
+// MarshalJSON marshals to json
+func (textEntityTypeBankCardNumber *TextEntityTypeBankCardNumber) MarshalJSON() ([]byte, error) {
+	return textEntityTypeBankCardNumber.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (textEntityTypeBankCardNumber *TextEntityTypeBankCardNumber) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, textEntityTypeBankCardNumber)
+}
+
+func (textEntityTypeBankCardNumber *TextEntityTypeBankCardNumber) appendJSON(b []byte) ([]byte, error) {
+	if textEntityTypeBankCardNumber == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, textEntityTypeBankCardNumber.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, textEntityTypeBankCardNumber.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (textEntityTypeBankCardNumber *TextEntityTypeBankCardNumber) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			textEntityTypeBankCardNumber.tdCommon.Type, err = r.readString()
+		case "@extra":
+			textEntityTypeBankCardNumber.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (textEntityTypeBankCardNumber *TextEntityTypeBankCardNumber) Clone() *TextEntityTypeBankCardNumber {
+	if textEntityTypeBankCardNumber == nil {
+		return nil
+	}
+	clone := *textEntityTypeBankCardNumber
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (textEntityTypeBankCardNumber *TextEntityTypeBankCardNumber) Equal(other *TextEntityTypeBankCardNumber) bool {
+	if textEntityTypeBankCardNumber == nil || other == nil {
+		return textEntityTypeBankCardNumber == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (textEntityTypeBankCardNumber *TextEntityTypeBankCardNumber) Diff(other *TextEntityTypeBankCardNumber) []Change {
+	return textEntityTypeBankCardNumber.diff("", other, nil)
+}
+
+func (textEntityTypeBankCardNumber *TextEntityTypeBankCardNumber) diff(path string, other *TextEntityTypeBankCardNumber, changes []Change) []Change {
+	if textEntityTypeBankCardNumber == nil || other == nil {
+		if textEntityTypeBankCardNumber != other {
+			changes = append(changes, Change{Path: path, Old: textEntityTypeBankCardNumber, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (textEntityTypeBankCardNumber *TextEntityTypeBankCardNumber) String() string {
+	return objectString(textEntityTypeBankCardNumber)
+}
+
+// LogValue logs the object as its summary
+func (textEntityTypeBankCardNumber *TextEntityTypeBankCardNumber) LogValue() slog.Value {
+	return slog.StringValue(objectString(textEntityTypeBankCardNumber))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (textEntityTypeBankCardNumber *TextEntityTypeBankCardNumber) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, textEntityTypeBankCardNumber)
+}
+
+func (textEntityTypeBankCardNumber *TextEntityTypeBankCardNumber) printText(p *printer) {
+	if textEntityTypeBankCardNumber == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TextEntityTypeBankCardNumber")
+	p.endObject(state)
+}
+
 // GetTextEntityTypeEnum return the enum type of this object
 func (textEntityTypeBankCardNumber *TextEntityTypeBankCardNumber) GetTextEntityTypeEnum() TextEntityTypeEnum {
 	return TextEntityTypeBankCardNumberType
 }
 
+// Accept calls the method of the visitor for TextEntityTypeBankCardNumber
+func (textEntityTypeBankCardNumber *TextEntityTypeBankCardNumber) Accept(visitor TextEntityTypeVisitor) {
+	visitor.VisitTextEntityTypeBankCardNumber(textEntityTypeBankCardNumber)
+}
+
 // TextEntityTypeBold A bold text
 type TextEntityTypeBold struct {
 	tdCommon
@@ -359,7 +1172,6 @@ func (textEntityTypeBold *TextEntityTypeBold) MessageType() string {
 }
 
 // NewTextEntityTypeBold creates a new TextEntityTypeBold
-//
 func NewTextEntityTypeBold() *TextEntityTypeBold {
 	textEntityTypeBoldTemp := TextEntityTypeBold{
 		tdCommon: tdCommon{Type: "textEntityTypeBold"},
@@ -368,23 +1180,118 @@ func NewTextEntityTypeBold() *TextEntityTypeBold {
 	return &textEntityTypeBoldTemp
 }
 
-// GetTextEntityTypeEnum return the enum type of this object
-func (textEntityTypeBold *TextEntityTypeBold) GetTextEntityTypeEnum() TextEntityTypeEnum {
-	return TextEntityTypeBoldType
+// MarshalJSON marshals to json
+func (textEntityTypeBold *TextEntityTypeBold) MarshalJSON() ([]byte, error) {
+	return textEntityTypeBold.appendJSON(nil)
 }
 
-// TextEntityTypeItalic An italic text
-type TextEntityTypeItalic struct {
-	tdCommon
+// UnmarshalJSON unmarshal to json
+func (textEntityTypeBold *TextEntityTypeBold) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, textEntityTypeBold)
 }
 
-// MessageType return the string telegram-type of TextEntityTypeItalic
-func (textEntityTypeItalic *TextEntityTypeItalic) MessageType() string {
-	return "textEntityTypeItalic"
-}
+func (textEntityTypeBold *TextEntityTypeBold) appendJSON(b []byte) ([]byte, error) {
+	if textEntityTypeBold == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, textEntityTypeBold.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, textEntityTypeBold.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (textEntityTypeBold *TextEntityTypeBold) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			textEntityTypeBold.tdCommon.Type, err = r.readString()
+		case "@extra":
+			textEntityTypeBold.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (textEntityTypeBold *TextEntityTypeBold) Clone() *TextEntityTypeBold {
+	if textEntityTypeBold == nil {
+		return nil
+	}
+	clone := *textEntityTypeBold
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (textEntityTypeBold *TextEntityTypeBold) Equal(other *TextEntityTypeBold) bool {
+	if textEntityTypeBold == nil || other == nil {
+		return textEntityTypeBold == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (textEntityTypeBold *TextEntityTypeBold) Diff(other *TextEntityTypeBold) []Change {
+	return textEntityTypeBold.diff("", other, nil)
+}
+
+func (textEntityTypeBold *TextEntityTypeBold) diff(path string, other *TextEntityTypeBold, changes []Change) []Change {
+	if textEntityTypeBold == nil || other == nil {
+		if textEntityTypeBold != other {
+			changes = append(changes, Change{Path: path, Old: textEntityTypeBold, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (textEntityTypeBold *TextEntityTypeBold) String() string {
+	return objectString(textEntityTypeBold)
+}
+
+// LogValue logs the object as its summary
+func (textEntityTypeBold *TextEntityTypeBold) LogValue() slog.Value {
+	return slog.StringValue(objectString(textEntityTypeBold))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (textEntityTypeBold *TextEntityTypeBold) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, textEntityTypeBold)
+}
+
+func (textEntityTypeBold *TextEntityTypeBold) printText(p *printer) {
+	if textEntityTypeBold == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TextEntityTypeBold")
+	p.endObject(state)
+}
+
+// GetTextEntityTypeEnum return the enum type of this object
+func (textEntityTypeBold *TextEntityTypeBold) GetTextEntityTypeEnum() TextEntityTypeEnum {
+	return TextEntityTypeBoldType
+}
+
+// Accept calls the method of the visitor for TextEntityTypeBold
+func (textEntityTypeBold *TextEntityTypeBold) Accept(visitor TextEntityTypeVisitor) {
+	visitor.VisitTextEntityTypeBold(textEntityTypeBold)
+}
+
+// TextEntityTypeItalic An italic text
+type TextEntityTypeItalic struct {
+	tdCommon
+}
+
+// MessageType return the string telegram-type of TextEntityTypeItalic
+func (textEntityTypeItalic *TextEntityTypeItalic) MessageType() string {
+	return "textEntityTypeItalic"
+}
 
 // NewTextEntityTypeItalic creates a new TextEntityTypeItalic
-//
 func NewTextEntityTypeItalic() *TextEntityTypeItalic {
 	textEntityTypeItalicTemp := TextEntityTypeItalic{
 		tdCommon: tdCommon{Type: "textEntityTypeItalic"},
@@ -393,11 +1300,107 @@ func NewTextEntityTypeItalic() *TextEntityTypeItalic {
 	return &textEntityTypeItalicTemp
 }
 
+// MarshalJSON marshals to json
+func (textEntityTypeItalic *TextEntityTypeItalic) MarshalJSON() ([]byte, error) {
+	return textEntityTypeItalic.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (textEntityTypeItalic *TextEntityTypeItalic) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, textEntityTypeItalic)
+}
+
+func (textEntityTypeItalic *TextEntityTypeItalic) appendJSON(b []byte) ([]byte, error) {
+	if textEntityTypeItalic == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, textEntityTypeItalic.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, textEntityTypeItalic.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (textEntityTypeItalic *TextEntityTypeItalic) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			textEntityTypeItalic.tdCommon.Type, err = r.readString()
+		case "@extra":
+			textEntityTypeItalic.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (textEntityTypeItalic *TextEntityTypeItalic) Clone() *TextEntityTypeItalic {
+	if textEntityTypeItalic == nil {
+		return nil
+	}
+	clone := *textEntityTypeItalic
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (textEntityTypeItalic *TextEntityTypeItalic) Equal(other *TextEntityTypeItalic) bool {
+	if textEntityTypeItalic == nil || other == nil {
+		return textEntityTypeItalic == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (textEntityTypeItalic *TextEntityTypeItalic) Diff(other *TextEntityTypeItalic) []Change {
+	return textEntityTypeItalic.diff("", other, nil)
+}
+
+func (textEntityTypeItalic *TextEntityTypeItalic) diff(path string, other *TextEntityTypeItalic, changes []Change) []Change {
+	if textEntityTypeItalic == nil || other == nil {
+		if textEntityTypeItalic != other {
+			changes = append(changes, Change{Path: path, Old: textEntityTypeItalic, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (textEntityTypeItalic *TextEntityTypeItalic) String() string {
+	return objectString(textEntityTypeItalic)
+}
+
+// LogValue logs the object as its summary
+func (textEntityTypeItalic *TextEntityTypeItalic) LogValue() slog.Value {
+	return slog.StringValue(objectString(textEntityTypeItalic))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (textEntityTypeItalic *TextEntityTypeItalic) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, textEntityTypeItalic)
+}
+
+func (textEntityTypeItalic *TextEntityTypeItalic) printText(p *printer) {
+	if textEntityTypeItalic == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TextEntityTypeItalic")
+	p.endObject(state)
+}
+
 // GetTextEntityTypeEnum return the enum type of this object
 func (textEntityTypeItalic *TextEntityTypeItalic) GetTextEntityTypeEnum() TextEntityTypeEnum {
 	return TextEntityTypeItalicType
 }
 
+// Accept calls the method of the visitor for TextEntityTypeItalic
+func (textEntityTypeItalic *TextEntityTypeItalic) Accept(visitor TextEntityTypeVisitor) {
+	visitor.VisitTextEntityTypeItalic(textEntityTypeItalic)
+}
+
 // TextEntityTypeUnderline An underlined text
 type TextEntityTypeUnderline struct {
 	tdCommon
@@ -409,7 +1412,6 @@ func (textEntityTypeUnderline *TextEntityTypeUnderline) MessageType() string {
 }
 
 // NewTextEntityTypeUnderline creates a new TextEntityTypeUnderline
-//
 func NewTextEntityTypeUnderline() *TextEntityTypeUnderline {
 	textEntityTypeUnderlineTemp := TextEntityTypeUnderline{
 		tdCommon: tdCommon{Type: "textEntityTypeUnderline"},
@@ -418,11 +1420,107 @@ func NewTextEntityTypeUnderline() *TextEntityTypeUnderline {
 	return &textEntityTypeUnderlineTemp
 }
 
+// MarshalJSON marshals to json
+func (textEntityTypeUnderline *TextEntityTypeUnderline) MarshalJSON() ([]byte, error) {
+	return textEntityTypeUnderline.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (textEntityTypeUnderline *TextEntityTypeUnderline) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, textEntityTypeUnderline)
+}
+
+func (textEntityTypeUnderline *TextEntityTypeUnderline) appendJSON(b []byte) ([]byte, error) {
+	if textEntityTypeUnderline == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, textEntityTypeUnderline.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, textEntityTypeUnderline.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (textEntityTypeUnderline *TextEntityTypeUnderline) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			textEntityTypeUnderline.tdCommon.Type, err = r.readString()
+		case "@extra":
+			textEntityTypeUnderline.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (textEntityTypeUnderline *TextEntityTypeUnderline) Clone() *TextEntityTypeUnderline {
+	if textEntityTypeUnderline == nil {
+		return nil
+	}
+	clone := *textEntityTypeUnderline
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (textEntityTypeUnderline *TextEntityTypeUnderline) Equal(other *TextEntityTypeUnderline) bool {
+	if textEntityTypeUnderline == nil || other == nil {
+		return textEntityTypeUnderline == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (textEntityTypeUnderline *TextEntityTypeUnderline) Diff(other *TextEntityTypeUnderline) []Change {
+	return textEntityTypeUnderline.diff("", other, nil)
+}
+
+func (textEntityTypeUnderline *TextEntityTypeUnderline) diff(path string, other *TextEntityTypeUnderline, changes []Change) []Change {
+	if textEntityTypeUnderline == nil || other == nil {
+		if textEntityTypeUnderline != other {
+			changes = append(changes, Change{Path: path, Old: textEntityTypeUnderline, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (textEntityTypeUnderline *TextEntityTypeUnderline) String() string {
+	return objectString(textEntityTypeUnderline)
+}
+
+// LogValue logs the object as its summary
+func (textEntityTypeUnderline *TextEntityTypeUnderline) LogValue() slog.Value {
+	return slog.StringValue(objectString(textEntityTypeUnderline))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (textEntityTypeUnderline *TextEntityTypeUnderline) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, textEntityTypeUnderline)
+}
+
+func (textEntityTypeUnderline *TextEntityTypeUnderline) printText(p *printer) {
+	if textEntityTypeUnderline == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TextEntityTypeUnderline")
+	p.endObject(state)
+}
+
 // GetTextEntityTypeEnum return the enum type of this object
 func (textEntityTypeUnderline *TextEntityTypeUnderline) GetTextEntityTypeEnum() TextEntityTypeEnum {
 	return TextEntityTypeUnderlineType
 }
 
+// Accept calls the method of the visitor for TextEntityTypeUnderline
+func (textEntityTypeUnderline *TextEntityTypeUnderline) Accept(visitor TextEntityTypeVisitor) {
+	visitor.VisitTextEntityTypeUnderline(textEntityTypeUnderline)
+}
+
 // TextEntityTypeStrikethrough A strikethrough text
 type TextEntityTypeStrikethrough struct {
 	tdCommon
@@ -434,7 +1532,6 @@ func (textEntityTypeStrikethrough *TextEntityTypeStrikethrough) MessageType() st
 }
 
 // NewTextEntityTypeStrikethrough creates a new TextEntityTypeStrikethrough
-//
 func NewTextEntityTypeStrikethrough() *TextEntityTypeStrikethrough {
 	textEntityTypeStrikethroughTemp := TextEntityTypeStrikethrough{
 		tdCommon: tdCommon{Type: "textEntityTypeStrikethrough"},
@@ -443,11 +1540,107 @@ func NewTextEntityTypeStrikethrough() *TextEntityTypeStrikethrough {
 	return &textEntityTypeStrikethroughTemp
 }
 
+// MarshalJSON marshals to json
+func (textEntityTypeStrikethrough *TextEntityTypeStrikethrough) MarshalJSON() ([]byte, error) {
+	return textEntityTypeStrikethrough.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (textEntityTypeStrikethrough *TextEntityTypeStrikethrough) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, textEntityTypeStrikethrough)
+}
+
+func (textEntityTypeStrikethrough *TextEntityTypeStrikethrough) appendJSON(b []byte) ([]byte, error) {
+	if textEntityTypeStrikethrough == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, textEntityTypeStrikethrough.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, textEntityTypeStrikethrough.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (textEntityTypeStrikethrough *TextEntityTypeStrikethrough) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			textEntityTypeStrikethrough.tdCommon.Type, err = r.readString()
+		case "@extra":
+			textEntityTypeStrikethrough.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (textEntityTypeStrikethrough *TextEntityTypeStrikethrough) Clone() *TextEntityTypeStrikethrough {
+	if textEntityTypeStrikethrough == nil {
+		return nil
+	}
+	clone := *textEntityTypeStrikethrough
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (textEntityTypeStrikethrough *TextEntityTypeStrikethrough) Equal(other *TextEntityTypeStrikethrough) bool {
+	if textEntityTypeStrikethrough == nil || other == nil {
+		return textEntityTypeStrikethrough == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (textEntityTypeStrikethrough *TextEntityTypeStrikethrough) Diff(other *TextEntityTypeStrikethrough) []Change {
+	return textEntityTypeStrikethrough.diff("", other, nil)
+}
+
+func (textEntityTypeStrikethrough *TextEntityTypeStrikethrough) diff(path string, other *TextEntityTypeStrikethrough, changes []Change) []Change {
+	if textEntityTypeStrikethrough == nil || other == nil {
+		if textEntityTypeStrikethrough != other {
+			changes = append(changes, Change{Path: path, Old: textEntityTypeStrikethrough, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (textEntityTypeStrikethrough *TextEntityTypeStrikethrough) String() string {
+	return objectString(textEntityTypeStrikethrough)
+}
+
+// LogValue logs the object as its summary
+func (textEntityTypeStrikethrough *TextEntityTypeStrikethrough) LogValue() slog.Value {
+	return slog.StringValue(objectString(textEntityTypeStrikethrough))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (textEntityTypeStrikethrough *TextEntityTypeStrikethrough) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, textEntityTypeStrikethrough)
+}
+
+func (textEntityTypeStrikethrough *TextEntityTypeStrikethrough) printText(p *printer) {
+	if textEntityTypeStrikethrough == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TextEntityTypeStrikethrough")
+	p.endObject(state)
+}
+
 // GetTextEntityTypeEnum return the enum type of this object
 func (textEntityTypeStrikethrough *TextEntityTypeStrikethrough) GetTextEntityTypeEnum() TextEntityTypeEnum {
 	return TextEntityTypeStrikethroughType
 }
 
+// Accept calls the method of the visitor for TextEntityTypeStrikethrough
+func (textEntityTypeStrikethrough *TextEntityTypeStrikethrough) Accept(visitor TextEntityTypeVisitor) {
+	visitor.VisitTextEntityTypeStrikethrough(textEntityTypeStrikethrough)
+}
+
 // TextEntityTypeSpoiler A spoiler text. Not supported in secret chats
 type TextEntityTypeSpoiler struct {
 	tdCommon
@@ -459,7 +1652,6 @@ func (textEntityTypeSpoiler *TextEntityTypeSpoiler) MessageType() string {
 }
 
 // NewTextEntityTypeSpoiler creates a new TextEntityTypeSpoiler
-//
 func NewTextEntityTypeSpoiler() *TextEntityTypeSpoiler {
 	textEntityTypeSpoilerTemp := TextEntityTypeSpoiler{
 		tdCommon: tdCommon{Type: "textEntityTypeSpoiler"},
@@ -468,11 +1660,107 @@ func NewTextEntityTypeSpoiler() *TextEntityTypeSpoiler {
 	return &textEntityTypeSpoilerTemp
 }
 
+// MarshalJSON marshals to json
+func (textEntityTypeSpoiler *TextEntityTypeSpoiler) MarshalJSON() ([]byte, error) {
+	return textEntityTypeSpoiler.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (textEntityTypeSpoiler *TextEntityTypeSpoiler) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, textEntityTypeSpoiler)
+}
+
+func (textEntityTypeSpoiler *TextEntityTypeSpoiler) appendJSON(b []byte) ([]byte, error) {
+	if textEntityTypeSpoiler == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, textEntityTypeSpoiler.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, textEntityTypeSpoiler.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (textEntityTypeSpoiler *TextEntityTypeSpoiler) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			textEntityTypeSpoiler.tdCommon.Type, err = r.readString()
+		case "@extra":
+			textEntityTypeSpoiler.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (textEntityTypeSpoiler *TextEntityTypeSpoiler) Clone() *TextEntityTypeSpoiler {
+	if textEntityTypeSpoiler == nil {
+		return nil
+	}
+	clone := *textEntityTypeSpoiler
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (textEntityTypeSpoiler *TextEntityTypeSpoiler) Equal(other *TextEntityTypeSpoiler) bool {
+	if textEntityTypeSpoiler == nil || other == nil {
+		return textEntityTypeSpoiler == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (textEntityTypeSpoiler *TextEntityTypeSpoiler) Diff(other *TextEntityTypeSpoiler) []Change {
+	return textEntityTypeSpoiler.diff("", other, nil)
+}
+
+func (textEntityTypeSpoiler *TextEntityTypeSpoiler) diff(path string, other *TextEntityTypeSpoiler, changes []Change) []Change {
+	if textEntityTypeSpoiler == nil || other == nil {
+		if textEntityTypeSpoiler != other {
+			changes = append(changes, Change{Path: path, Old: textEntityTypeSpoiler, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (textEntityTypeSpoiler *TextEntityTypeSpoiler) String() string {
+	return objectString(textEntityTypeSpoiler)
+}
+
+// LogValue logs the object as its summary
+func (textEntityTypeSpoiler *TextEntityTypeSpoiler) LogValue() slog.Value {
+	return slog.StringValue(objectString(textEntityTypeSpoiler))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (textEntityTypeSpoiler *TextEntityTypeSpoiler) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, textEntityTypeSpoiler)
+}
+
+func (textEntityTypeSpoiler *TextEntityTypeSpoiler) printText(p *printer) {
+	if textEntityTypeSpoiler == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TextEntityTypeSpoiler")
+	p.endObject(state)
+}
+
 // GetTextEntityTypeEnum return the enum type of this object
 func (textEntityTypeSpoiler *TextEntityTypeSpoiler) GetTextEntityTypeEnum() TextEntityTypeEnum {
 	return TextEntityTypeSpoilerType
 }
 
+// Accept calls the method of the visitor for TextEntityTypeSpoiler
+func (textEntityTypeSpoiler *TextEntityTypeSpoiler) Accept(visitor TextEntityTypeVisitor) {
+	visitor.VisitTextEntityTypeSpoiler(textEntityTypeSpoiler)
+}
+
 // TextEntityTypeCode Text that must be formatted as if inside a code HTML tag
 type TextEntityTypeCode struct {
 	tdCommon
@@ -484,7 +1772,6 @@ func (textEntityTypeCode *TextEntityTypeCode) MessageType() string {
 }
 
 // NewTextEntityTypeCode creates a new TextEntityTypeCode
-//
 func NewTextEntityTypeCode() *TextEntityTypeCode {
 	textEntityTypeCodeTemp := TextEntityTypeCode{
 		tdCommon: tdCommon{Type: "textEntityTypeCode"},
@@ -493,11 +1780,107 @@ func NewTextEntityTypeCode() *TextEntityTypeCode {
 	return &textEntityTypeCodeTemp
 }
 
+// MarshalJSON marshals to json
+func (textEntityTypeCode *TextEntityTypeCode) MarshalJSON() ([]byte, error) {
+	return textEntityTypeCode.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (textEntityTypeCode *TextEntityTypeCode) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, textEntityTypeCode)
+}
+
+func (textEntityTypeCode *TextEntityTypeCode) appendJSON(b []byte) ([]byte, error) {
+	if textEntityTypeCode == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, textEntityTypeCode.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, textEntityTypeCode.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (textEntityTypeCode *TextEntityTypeCode) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			textEntityTypeCode.tdCommon.Type, err = r.readString()
+		case "@extra":
+			textEntityTypeCode.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (textEntityTypeCode *TextEntityTypeCode) Clone() *TextEntityTypeCode {
+	if textEntityTypeCode == nil {
+		return nil
+	}
+	clone := *textEntityTypeCode
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (textEntityTypeCode *TextEntityTypeCode) Equal(other *TextEntityTypeCode) bool {
+	if textEntityTypeCode == nil || other == nil {
+		return textEntityTypeCode == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (textEntityTypeCode *TextEntityTypeCode) Diff(other *TextEntityTypeCode) []Change {
+	return textEntityTypeCode.diff("", other, nil)
+}
+
+func (textEntityTypeCode *TextEntityTypeCode) diff(path string, other *TextEntityTypeCode, changes []Change) []Change {
+	if textEntityTypeCode == nil || other == nil {
+		if textEntityTypeCode != other {
+			changes = append(changes, Change{Path: path, Old: textEntityTypeCode, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (textEntityTypeCode *TextEntityTypeCode) String() string {
+	return objectString(textEntityTypeCode)
+}
+
+// LogValue logs the object as its summary
+func (textEntityTypeCode *TextEntityTypeCode) LogValue() slog.Value {
+	return slog.StringValue(objectString(textEntityTypeCode))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (textEntityTypeCode *TextEntityTypeCode) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, textEntityTypeCode)
+}
+
+func (textEntityTypeCode *TextEntityTypeCode) printText(p *printer) {
+	if textEntityTypeCode == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TextEntityTypeCode")
+	p.endObject(state)
+}
+
 // GetTextEntityTypeEnum return the enum type of this object
 func (textEntityTypeCode *TextEntityTypeCode) GetTextEntityTypeEnum() TextEntityTypeEnum {
 	return TextEntityTypeCodeType
 }
 
+// Accept calls the method of the visitor for TextEntityTypeCode
+func (textEntityTypeCode *TextEntityTypeCode) Accept(visitor TextEntityTypeVisitor) {
+	visitor.VisitTextEntityTypeCode(textEntityTypeCode)
+}
+
 // TextEntityTypePre Text that must be formatted as if inside a pre HTML tag
 type TextEntityTypePre struct {
 	tdCommon
@@ -509,7 +1892,6 @@ func (textEntityTypePre *TextEntityTypePre) MessageType() string {
 }
 
 // NewTextEntityTypePre creates a new TextEntityTypePre
-//
 func NewTextEntityTypePre() *TextEntityTypePre {
 	textEntityTypePreTemp := TextEntityTypePre{
 		tdCommon: tdCommon{Type: "textEntityTypePre"},
@@ -518,11 +1900,107 @@ func NewTextEntityTypePre() *TextEntityTypePre {
 	return &textEntityTypePreTemp
 }
 
+// MarshalJSON marshals to json
+func (textEntityTypePre *TextEntityTypePre) MarshalJSON() ([]byte, error) {
+	return textEntityTypePre.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (textEntityTypePre *TextEntityTypePre) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, textEntityTypePre)
+}
+
+func (textEntityTypePre *TextEntityTypePre) appendJSON(b []byte) ([]byte, error) {
+	if textEntityTypePre == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, textEntityTypePre.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, textEntityTypePre.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (textEntityTypePre *TextEntityTypePre) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			textEntityTypePre.tdCommon.Type, err = r.readString()
+		case "@extra":
+			textEntityTypePre.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (textEntityTypePre *TextEntityTypePre) Clone() *TextEntityTypePre {
+	if textEntityTypePre == nil {
+		return nil
+	}
+	clone := *textEntityTypePre
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (textEntityTypePre *TextEntityTypePre) Equal(other *TextEntityTypePre) bool {
+	if textEntityTypePre == nil || other == nil {
+		return textEntityTypePre == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (textEntityTypePre *TextEntityTypePre) Diff(other *TextEntityTypePre) []Change {
+	return textEntityTypePre.diff("", other, nil)
+}
+
+func (textEntityTypePre *TextEntityTypePre) diff(path string, other *TextEntityTypePre, changes []Change) []Change {
+	if textEntityTypePre == nil || other == nil {
+		if textEntityTypePre != other {
+			changes = append(changes, Change{Path: path, Old: textEntityTypePre, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (textEntityTypePre *TextEntityTypePre) String() string {
+	return objectString(textEntityTypePre)
+}
+
+// LogValue logs the object as its summary
+func (textEntityTypePre *TextEntityTypePre) LogValue() slog.Value {
+	return slog.StringValue(objectString(textEntityTypePre))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (textEntityTypePre *TextEntityTypePre) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, textEntityTypePre)
+}
+
+func (textEntityTypePre *TextEntityTypePre) printText(p *printer) {
+	if textEntityTypePre == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TextEntityTypePre")
+	p.endObject(state)
+}
+
 // GetTextEntityTypeEnum return the enum type of this object
 func (textEntityTypePre *TextEntityTypePre) GetTextEntityTypeEnum() TextEntityTypeEnum {
 	return TextEntityTypePreType
 }
 
+// Accept calls the method of the visitor for TextEntityTypePre
+func (textEntityTypePre *TextEntityTypePre) Accept(visitor TextEntityTypeVisitor) {
+	visitor.VisitTextEntityTypePre(textEntityTypePre)
+}
+
 // TextEntityTypePreCode Text that must be formatted as if inside pre, and code HTML tags
 type TextEntityTypePreCode struct {
 	tdCommon
@@ -546,11 +2024,118 @@ func NewTextEntityTypePreCode(language string) *TextEntityTypePreCode {
 	return &textEntityTypePreCodeTemp
 }
 
+// MarshalJSON marshals to json
+func (textEntityTypePreCode *TextEntityTypePreCode) MarshalJSON() ([]byte, error) {
+	return textEntityTypePreCode.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (textEntityTypePreCode *TextEntityTypePreCode) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, textEntityTypePreCode)
+}
+
+func (textEntityTypePreCode *TextEntityTypePreCode) appendJSON(b []byte) ([]byte, error) {
+	if textEntityTypePreCode == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, textEntityTypePreCode.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, textEntityTypePreCode.tdCommon.Extra)
+	b = append(b, `,"language":`...)
+	b = appendJSONString(b, textEntityTypePreCode.Language)
+	return append(b, '}'), nil
+}
+
+func (textEntityTypePreCode *TextEntityTypePreCode) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			textEntityTypePreCode.tdCommon.Type, err = r.readString()
+		case "@extra":
+			textEntityTypePreCode.tdCommon.Extra, err = r.readString()
+		case "language":
+			textEntityTypePreCode.Language, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (textEntityTypePreCode *TextEntityTypePreCode) Clone() *TextEntityTypePreCode {
+	if textEntityTypePreCode == nil {
+		return nil
+	}
+	clone := *textEntityTypePreCode
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (textEntityTypePreCode *TextEntityTypePreCode) Equal(other *TextEntityTypePreCode) bool {
+	if textEntityTypePreCode == nil || other == nil {
+		return textEntityTypePreCode == other
+	}
+	if textEntityTypePreCode.Language != other.Language {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (textEntityTypePreCode *TextEntityTypePreCode) Diff(other *TextEntityTypePreCode) []Change {
+	return textEntityTypePreCode.diff("", other, nil)
+}
+
+func (textEntityTypePreCode *TextEntityTypePreCode) diff(path string, other *TextEntityTypePreCode, changes []Change) []Change {
+	if textEntityTypePreCode == nil || other == nil {
+		if textEntityTypePreCode != other {
+			changes = append(changes, Change{Path: path, Old: textEntityTypePreCode, New: other})
+		}
+		return changes
+	}
+	if textEntityTypePreCode.Language != other.Language {
+		changes = append(changes, Change{Path: fieldPath(path, "language"), Old: textEntityTypePreCode.Language, New: other.Language})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (textEntityTypePreCode *TextEntityTypePreCode) String() string {
+	return objectString(textEntityTypePreCode)
+}
+
+// LogValue logs the object as its summary
+func (textEntityTypePreCode *TextEntityTypePreCode) LogValue() slog.Value {
+	return slog.StringValue(objectString(textEntityTypePreCode))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (textEntityTypePreCode *TextEntityTypePreCode) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, textEntityTypePreCode)
+}
+
+func (textEntityTypePreCode *TextEntityTypePreCode) printText(p *printer) {
+	if textEntityTypePreCode == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TextEntityTypePreCode")
+	p.string("language", textEntityTypePreCode.Language)
+	p.endObject(state)
+}
+
 // GetTextEntityTypeEnum return the enum type of this object
 func (textEntityTypePreCode *TextEntityTypePreCode) GetTextEntityTypeEnum() TextEntityTypeEnum {
 	return TextEntityTypePreCodeType
 }
 
+// Accept calls the method of the visitor for TextEntityTypePreCode
+func (textEntityTypePreCode *TextEntityTypePreCode) Accept(visitor TextEntityTypeVisitor) {
+	visitor.VisitTextEntityTypePreCode(textEntityTypePreCode)
+}
+
 // TextEntityTypeTextUrl A text description shown instead of a raw URL
 type TextEntityTypeTextUrl struct {
 	tdCommon
@@ -574,11 +2159,118 @@ func NewTextEntityTypeTextUrl(url string) *TextEntityTypeTextUrl {
 	return &textEntityTypeTextUrlTemp
 }
 
+// MarshalJSON marshals to json
+func (textEntityTypeTextUrl *TextEntityTypeTextUrl) MarshalJSON() ([]byte, error) {
+	return textEntityTypeTextUrl.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (textEntityTypeTextUrl *TextEntityTypeTextUrl) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, textEntityTypeTextUrl)
+}
+
+func (textEntityTypeTextUrl *TextEntityTypeTextUrl) appendJSON(b []byte) ([]byte, error) {
+	if textEntityTypeTextUrl == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, textEntityTypeTextUrl.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, textEntityTypeTextUrl.tdCommon.Extra)
+	b = append(b, `,"url":`...)
+	b = appendJSONString(b, textEntityTypeTextUrl.Url)
+	return append(b, '}'), nil
+}
+
+func (textEntityTypeTextUrl *TextEntityTypeTextUrl) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			textEntityTypeTextUrl.tdCommon.Type, err = r.readString()
+		case "@extra":
+			textEntityTypeTextUrl.tdCommon.Extra, err = r.readString()
+		case "url":
+			textEntityTypeTextUrl.Url, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (textEntityTypeTextUrl *TextEntityTypeTextUrl) Clone() *TextEntityTypeTextUrl {
+	if textEntityTypeTextUrl == nil {
+		return nil
+	}
+	clone := *textEntityTypeTextUrl
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (textEntityTypeTextUrl *TextEntityTypeTextUrl) Equal(other *TextEntityTypeTextUrl) bool {
+	if textEntityTypeTextUrl == nil || other == nil {
+		return textEntityTypeTextUrl == other
+	}
+	if textEntityTypeTextUrl.Url != other.Url {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (textEntityTypeTextUrl *TextEntityTypeTextUrl) Diff(other *TextEntityTypeTextUrl) []Change {
+	return textEntityTypeTextUrl.diff("", other, nil)
+}
+
+func (textEntityTypeTextUrl *TextEntityTypeTextUrl) diff(path string, other *TextEntityTypeTextUrl, changes []Change) []Change {
+	if textEntityTypeTextUrl == nil || other == nil {
+		if textEntityTypeTextUrl != other {
+			changes = append(changes, Change{Path: path, Old: textEntityTypeTextUrl, New: other})
+		}
+		return changes
+	}
+	if textEntityTypeTextUrl.Url != other.Url {
+		changes = append(changes, Change{Path: fieldPath(path, "url"), Old: textEntityTypeTextUrl.Url, New: other.Url})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (textEntityTypeTextUrl *TextEntityTypeTextUrl) String() string {
+	return objectString(textEntityTypeTextUrl)
+}
+
+// LogValue logs the object as its summary
+func (textEntityTypeTextUrl *TextEntityTypeTextUrl) LogValue() slog.Value {
+	return slog.StringValue(objectString(textEntityTypeTextUrl))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (textEntityTypeTextUrl *TextEntityTypeTextUrl) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, textEntityTypeTextUrl)
+}
+
+func (textEntityTypeTextUrl *TextEntityTypeTextUrl) printText(p *printer) {
+	if textEntityTypeTextUrl == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TextEntityTypeTextUrl")
+	p.string("url", textEntityTypeTextUrl.Url)
+	p.endObject(state)
+}
+
 // GetTextEntityTypeEnum return the enum type of this object
 func (textEntityTypeTextUrl *TextEntityTypeTextUrl) GetTextEntityTypeEnum() TextEntityTypeEnum {
 	return TextEntityTypeTextUrlType
 }
 
+// Accept calls the method of the visitor for TextEntityTypeTextUrl
+func (textEntityTypeTextUrl *TextEntityTypeTextUrl) Accept(visitor TextEntityTypeVisitor) {
+	visitor.VisitTextEntityTypeTextUrl(textEntityTypeTextUrl)
+}
+
 // TextEntityTypeMentionName A text shows instead of a raw mention of the user (e.g., when the user has no username)
 type TextEntityTypeMentionName struct {
 	tdCommon
@@ -602,11 +2294,118 @@ func NewTextEntityTypeMentionName(userId int64) *TextEntityTypeMentionName {
 	return &textEntityTypeMentionNameTemp
 }
 
+// MarshalJSON marshals to json
+func (textEntityTypeMentionName *TextEntityTypeMentionName) MarshalJSON() ([]byte, error) {
+	return textEntityTypeMentionName.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (textEntityTypeMentionName *TextEntityTypeMentionName) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, textEntityTypeMentionName)
+}
+
+func (textEntityTypeMentionName *TextEntityTypeMentionName) appendJSON(b []byte) ([]byte, error) {
+	if textEntityTypeMentionName == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, textEntityTypeMentionName.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, textEntityTypeMentionName.tdCommon.Extra)
+	b = append(b, `,"user_id":`...)
+	b = appendJSONInt(b, int64(textEntityTypeMentionName.UserId))
+	return append(b, '}'), nil
+}
+
+func (textEntityTypeMentionName *TextEntityTypeMentionName) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			textEntityTypeMentionName.tdCommon.Type, err = r.readString()
+		case "@extra":
+			textEntityTypeMentionName.tdCommon.Extra, err = r.readString()
+		case "user_id":
+			textEntityTypeMentionName.UserId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (textEntityTypeMentionName *TextEntityTypeMentionName) Clone() *TextEntityTypeMentionName {
+	if textEntityTypeMentionName == nil {
+		return nil
+	}
+	clone := *textEntityTypeMentionName
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (textEntityTypeMentionName *TextEntityTypeMentionName) Equal(other *TextEntityTypeMentionName) bool {
+	if textEntityTypeMentionName == nil || other == nil {
+		return textEntityTypeMentionName == other
+	}
+	if textEntityTypeMentionName.UserId != other.UserId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (textEntityTypeMentionName *TextEntityTypeMentionName) Diff(other *TextEntityTypeMentionName) []Change {
+	return textEntityTypeMentionName.diff("", other, nil)
+}
+
+func (textEntityTypeMentionName *TextEntityTypeMentionName) diff(path string, other *TextEntityTypeMentionName, changes []Change) []Change {
+	if textEntityTypeMentionName == nil || other == nil {
+		if textEntityTypeMentionName != other {
+			changes = append(changes, Change{Path: path, Old: textEntityTypeMentionName, New: other})
+		}
+		return changes
+	}
+	if textEntityTypeMentionName.UserId != other.UserId {
+		changes = append(changes, Change{Path: fieldPath(path, "user_id"), Old: textEntityTypeMentionName.UserId, New: other.UserId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (textEntityTypeMentionName *TextEntityTypeMentionName) String() string {
+	return objectString(textEntityTypeMentionName)
+}
+
+// LogValue logs the object as its summary
+func (textEntityTypeMentionName *TextEntityTypeMentionName) LogValue() slog.Value {
+	return slog.StringValue(objectString(textEntityTypeMentionName))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (textEntityTypeMentionName *TextEntityTypeMentionName) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, textEntityTypeMentionName)
+}
+
+func (textEntityTypeMentionName *TextEntityTypeMentionName) printText(p *printer) {
+	if textEntityTypeMentionName == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TextEntityTypeMentionName")
+	p.int("user_id", int64(textEntityTypeMentionName.UserId))
+	p.endObject(state)
+}
+
 // GetTextEntityTypeEnum return the enum type of this object
 func (textEntityTypeMentionName *TextEntityTypeMentionName) GetTextEntityTypeEnum() TextEntityTypeEnum {
 	return TextEntityTypeMentionNameType
 }
 
+// Accept calls the method of the visitor for TextEntityTypeMentionName
+func (textEntityTypeMentionName *TextEntityTypeMentionName) Accept(visitor TextEntityTypeVisitor) {
+	visitor.VisitTextEntityTypeMentionName(textEntityTypeMentionName)
+}
+
 // TextEntityTypeMediaTimestamp A media timestamp
 type TextEntityTypeMediaTimestamp struct {
 	tdCommon
@@ -630,7 +2429,464 @@ func NewTextEntityTypeMediaTimestamp(mediaTimestamp int32) *TextEntityTypeMediaT
 	return &textEntityTypeMediaTimestampTemp
 }
 
+// MarshalJSON marshals to json
+func (textEntityTypeMediaTimestamp *TextEntityTypeMediaTimestamp) MarshalJSON() ([]byte, error) {
+	return textEntityTypeMediaTimestamp.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (textEntityTypeMediaTimestamp *TextEntityTypeMediaTimestamp) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, textEntityTypeMediaTimestamp)
+}
+
+func (textEntityTypeMediaTimestamp *TextEntityTypeMediaTimestamp) appendJSON(b []byte) ([]byte, error) {
+	if textEntityTypeMediaTimestamp == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, textEntityTypeMediaTimestamp.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, textEntityTypeMediaTimestamp.tdCommon.Extra)
+	b = append(b, `,"media_timestamp":`...)
+	b = appendJSONInt(b, int64(textEntityTypeMediaTimestamp.MediaTimestamp))
+	return append(b, '}'), nil
+}
+
+func (textEntityTypeMediaTimestamp *TextEntityTypeMediaTimestamp) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			textEntityTypeMediaTimestamp.tdCommon.Type, err = r.readString()
+		case "@extra":
+			textEntityTypeMediaTimestamp.tdCommon.Extra, err = r.readString()
+		case "media_timestamp":
+			textEntityTypeMediaTimestamp.MediaTimestamp, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (textEntityTypeMediaTimestamp *TextEntityTypeMediaTimestamp) Clone() *TextEntityTypeMediaTimestamp {
+	if textEntityTypeMediaTimestamp == nil {
+		return nil
+	}
+	clone := *textEntityTypeMediaTimestamp
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (textEntityTypeMediaTimestamp *TextEntityTypeMediaTimestamp) Equal(other *TextEntityTypeMediaTimestamp) bool {
+	if textEntityTypeMediaTimestamp == nil || other == nil {
+		return textEntityTypeMediaTimestamp == other
+	}
+	if textEntityTypeMediaTimestamp.MediaTimestamp != other.MediaTimestamp {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (textEntityTypeMediaTimestamp *TextEntityTypeMediaTimestamp) Diff(other *TextEntityTypeMediaTimestamp) []Change {
+	return textEntityTypeMediaTimestamp.diff("", other, nil)
+}
+
+func (textEntityTypeMediaTimestamp *TextEntityTypeMediaTimestamp) diff(path string, other *TextEntityTypeMediaTimestamp, changes []Change) []Change {
+	if textEntityTypeMediaTimestamp == nil || other == nil {
+		if textEntityTypeMediaTimestamp != other {
+			changes = append(changes, Change{Path: path, Old: textEntityTypeMediaTimestamp, New: other})
+		}
+		return changes
+	}
+	if textEntityTypeMediaTimestamp.MediaTimestamp != other.MediaTimestamp {
+		changes = append(changes, Change{Path: fieldPath(path, "media_timestamp"), Old: textEntityTypeMediaTimestamp.MediaTimestamp, New: other.MediaTimestamp})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (textEntityTypeMediaTimestamp *TextEntityTypeMediaTimestamp) String() string {
+	return objectString(textEntityTypeMediaTimestamp)
+}
+
+// LogValue logs the object as its summary
+func (textEntityTypeMediaTimestamp *TextEntityTypeMediaTimestamp) LogValue() slog.Value {
+	return slog.StringValue(objectString(textEntityTypeMediaTimestamp))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (textEntityTypeMediaTimestamp *TextEntityTypeMediaTimestamp) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, textEntityTypeMediaTimestamp)
+}
+
+func (textEntityTypeMediaTimestamp *TextEntityTypeMediaTimestamp) printText(p *printer) {
+	if textEntityTypeMediaTimestamp == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TextEntityTypeMediaTimestamp")
+	p.int("media_timestamp", int64(textEntityTypeMediaTimestamp.MediaTimestamp))
+	p.endObject(state)
+}
+
 // GetTextEntityTypeEnum return the enum type of this object
 func (textEntityTypeMediaTimestamp *TextEntityTypeMediaTimestamp) GetTextEntityTypeEnum() TextEntityTypeEnum {
 	return TextEntityTypeMediaTimestampType
 }
+
+// Accept calls the method of the visitor for TextEntityTypeMediaTimestamp
+func (textEntityTypeMediaTimestamp *TextEntityTypeMediaTimestamp) Accept(visitor TextEntityTypeVisitor) {
+	visitor.VisitTextEntityTypeMediaTimestamp(textEntityTypeMediaTimestamp)
+}
+
+// TextEntityTypeVisitor visits every type of TextEntityType, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to TextEntityType, until they handle it too.
+type TextEntityTypeVisitor interface {
+	VisitTextEntityTypeMention(textEntityTypeMention *TextEntityTypeMention)
+	VisitTextEntityTypeHashtag(textEntityTypeHashtag *TextEntityTypeHashtag)
+	VisitTextEntityTypeCashtag(textEntityTypeCashtag *TextEntityTypeCashtag)
+	VisitTextEntityTypeBotCommand(textEntityTypeBotCommand *TextEntityTypeBotCommand)
+	VisitTextEntityTypeUrl(textEntityTypeUrl *TextEntityTypeUrl)
+	VisitTextEntityTypeEmailAddress(textEntityTypeEmailAddress *TextEntityTypeEmailAddress)
+	VisitTextEntityTypePhoneNumber(textEntityTypePhoneNumber *TextEntityTypePhoneNumber)
+	VisitTextEntityTypeBankCardNumber(textEntityTypeBankCardNumber *TextEntityTypeBankCardNumber)
+	VisitTextEntityTypeBold(textEntityTypeBold *TextEntityTypeBold)
+	VisitTextEntityTypeItalic(textEntityTypeItalic *TextEntityTypeItalic)
+	VisitTextEntityTypeUnderline(textEntityTypeUnderline *TextEntityTypeUnderline)
+	VisitTextEntityTypeStrikethrough(textEntityTypeStrikethrough *TextEntityTypeStrikethrough)
+	VisitTextEntityTypeSpoiler(textEntityTypeSpoiler *TextEntityTypeSpoiler)
+	VisitTextEntityTypeCode(textEntityTypeCode *TextEntityTypeCode)
+	VisitTextEntityTypePre(textEntityTypePre *TextEntityTypePre)
+	VisitTextEntityTypePreCode(textEntityTypePreCode *TextEntityTypePreCode)
+	VisitTextEntityTypeTextUrl(textEntityTypeTextUrl *TextEntityTypeTextUrl)
+	VisitTextEntityTypeMentionName(textEntityTypeMentionName *TextEntityTypeMentionName)
+	VisitTextEntityTypeMediaTimestamp(textEntityTypeMediaTimestamp *TextEntityTypeMediaTimestamp)
+	VisitUnknownTextEntityType(unknownTextEntityType *UnknownTextEntityType)
+}
+
+// TextEntityTypeCases holds the function MatchTextEntityType calls for each type of TextEntityType;
+// types without a function are passed to the default case
+type TextEntityTypeCases[R any] struct {
+	TextEntityTypeMention        func(textEntityTypeMention *TextEntityTypeMention) R
+	TextEntityTypeHashtag        func(textEntityTypeHashtag *TextEntityTypeHashtag) R
+	TextEntityTypeCashtag        func(textEntityTypeCashtag *TextEntityTypeCashtag) R
+	TextEntityTypeBotCommand     func(textEntityTypeBotCommand *TextEntityTypeBotCommand) R
+	TextEntityTypeUrl            func(textEntityTypeUrl *TextEntityTypeUrl) R
+	TextEntityTypeEmailAddress   func(textEntityTypeEmailAddress *TextEntityTypeEmailAddress) R
+	TextEntityTypePhoneNumber    func(textEntityTypePhoneNumber *TextEntityTypePhoneNumber) R
+	TextEntityTypeBankCardNumber func(textEntityTypeBankCardNumber *TextEntityTypeBankCardNumber) R
+	TextEntityTypeBold           func(textEntityTypeBold *TextEntityTypeBold) R
+	TextEntityTypeItalic         func(textEntityTypeItalic *TextEntityTypeItalic) R
+	TextEntityTypeUnderline      func(textEntityTypeUnderline *TextEntityTypeUnderline) R
+	TextEntityTypeStrikethrough  func(textEntityTypeStrikethrough *TextEntityTypeStrikethrough) R
+	TextEntityTypeSpoiler        func(textEntityTypeSpoiler *TextEntityTypeSpoiler) R
+	TextEntityTypeCode           func(textEntityTypeCode *TextEntityTypeCode) R
+	TextEntityTypePre            func(textEntityTypePre *TextEntityTypePre) R
+	TextEntityTypePreCode        func(textEntityTypePreCode *TextEntityTypePreCode) R
+	TextEntityTypeTextUrl        func(textEntityTypeTextUrl *TextEntityTypeTextUrl) R
+	TextEntityTypeMentionName    func(textEntityTypeMentionName *TextEntityTypeMentionName) R
+	TextEntityTypeMediaTimestamp func(textEntityTypeMediaTimestamp *TextEntityTypeMediaTimestamp) R
+}
+
+// MatchTextEntityType calls the case of the type of textEntityType, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement TextEntityTypeVisitor
+// to have the compiler check every type is handled.
+func MatchTextEntityType[R any](textEntityType TextEntityType, cases TextEntityTypeCases[R], defaultCase func(textEntityType TextEntityType) R) R {
+	switch value := textEntityType.(type) {
+	case *TextEntityTypeMention:
+		if cases.TextEntityTypeMention != nil {
+			return cases.TextEntityTypeMention(value)
+		}
+	case *TextEntityTypeHashtag:
+		if cases.TextEntityTypeHashtag != nil {
+			return cases.TextEntityTypeHashtag(value)
+		}
+	case *TextEntityTypeCashtag:
+		if cases.TextEntityTypeCashtag != nil {
+			return cases.TextEntityTypeCashtag(value)
+		}
+	case *TextEntityTypeBotCommand:
+		if cases.TextEntityTypeBotCommand != nil {
+			return cases.TextEntityTypeBotCommand(value)
+		}
+	case *TextEntityTypeUrl:
+		if cases.TextEntityTypeUrl != nil {
+			return cases.TextEntityTypeUrl(value)
+		}
+	case *TextEntityTypeEmailAddress:
+		if cases.TextEntityTypeEmailAddress != nil {
+			return cases.TextEntityTypeEmailAddress(value)
+		}
+	case *TextEntityTypePhoneNumber:
+		if cases.TextEntityTypePhoneNumber != nil {
+			return cases.TextEntityTypePhoneNumber(value)
+		}
+	case *TextEntityTypeBankCardNumber:
+		if cases.TextEntityTypeBankCardNumber != nil {
+			return cases.TextEntityTypeBankCardNumber(value)
+		}
+	case *TextEntityTypeBold:
+		if cases.TextEntityTypeBold != nil {
+			return cases.TextEntityTypeBold(value)
+		}
+	case *TextEntityTypeItalic:
+		if cases.TextEntityTypeItalic != nil {
+			return cases.TextEntityTypeItalic(value)
+		}
+	case *TextEntityTypeUnderline:
+		if cases.TextEntityTypeUnderline != nil {
+			return cases.TextEntityTypeUnderline(value)
+		}
+	case *TextEntityTypeStrikethrough:
+		if cases.TextEntityTypeStrikethrough != nil {
+			return cases.TextEntityTypeStrikethrough(value)
+		}
+	case *TextEntityTypeSpoiler:
+		if cases.TextEntityTypeSpoiler != nil {
+			return cases.TextEntityTypeSpoiler(value)
+		}
+	case *TextEntityTypeCode:
+		if cases.TextEntityTypeCode != nil {
+			return cases.TextEntityTypeCode(value)
+		}
+	case *TextEntityTypePre:
+		if cases.TextEntityTypePre != nil {
+			return cases.TextEntityTypePre(value)
+		}
+	case *TextEntityTypePreCode:
+		if cases.TextEntityTypePreCode != nil {
+			return cases.TextEntityTypePreCode(value)
+		}
+	case *TextEntityTypeTextUrl:
+		if cases.TextEntityTypeTextUrl != nil {
+			return cases.TextEntityTypeTextUrl(value)
+		}
+	case *TextEntityTypeMentionName:
+		if cases.TextEntityTypeMentionName != nil {
+			return cases.TextEntityTypeMentionName(value)
+		}
+	case *TextEntityTypeMediaTimestamp:
+		if cases.TextEntityTypeMediaTimestamp != nil {
+			return cases.TextEntityTypeMediaTimestamp(value)
+		}
+	}
+	return defaultCase(textEntityType)
+}
+
+// Clone returns a deep copy of the object
+func (unknownTextEntityType *UnknownTextEntityType) Clone() *UnknownTextEntityType {
+	if unknownTextEntityType == nil {
+		return nil
+	}
+	return &UnknownTextEntityType{Unknown: unknownTextEntityType.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownTextEntityType *UnknownTextEntityType) Equal(other *UnknownTextEntityType) bool {
+	if unknownTextEntityType == nil || other == nil {
+		return unknownTextEntityType == other
+	}
+	return unknownTextEntityType.Unknown.equal(other.Unknown)
+}
+
+func cloneTextEntityType(textEntityType TextEntityType) TextEntityType {
+	switch value := textEntityType.(type) {
+	case *TextEntityTypeMention:
+		return value.Clone()
+	case *TextEntityTypeHashtag:
+		return value.Clone()
+	case *TextEntityTypeCashtag:
+		return value.Clone()
+	case *TextEntityTypeBotCommand:
+		return value.Clone()
+	case *TextEntityTypeUrl:
+		return value.Clone()
+	case *TextEntityTypeEmailAddress:
+		return value.Clone()
+	case *TextEntityTypePhoneNumber:
+		return value.Clone()
+	case *TextEntityTypeBankCardNumber:
+		return value.Clone()
+	case *TextEntityTypeBold:
+		return value.Clone()
+	case *TextEntityTypeItalic:
+		return value.Clone()
+	case *TextEntityTypeUnderline:
+		return value.Clone()
+	case *TextEntityTypeStrikethrough:
+		return value.Clone()
+	case *TextEntityTypeSpoiler:
+		return value.Clone()
+	case *TextEntityTypeCode:
+		return value.Clone()
+	case *TextEntityTypePre:
+		return value.Clone()
+	case *TextEntityTypePreCode:
+		return value.Clone()
+	case *TextEntityTypeTextUrl:
+		return value.Clone()
+	case *TextEntityTypeMentionName:
+		return value.Clone()
+	case *TextEntityTypeMediaTimestamp:
+		return value.Clone()
+	case *UnknownTextEntityType:
+		return value.Clone()
+	}
+	return textEntityType
+}
+
+func equalTextEntityType(a TextEntityType, b TextEntityType) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *TextEntityTypeMention:
+		b, ok := b.(*TextEntityTypeMention)
+		return ok && a.Equal(b)
+	case *TextEntityTypeHashtag:
+		b, ok := b.(*TextEntityTypeHashtag)
+		return ok && a.Equal(b)
+	case *TextEntityTypeCashtag:
+		b, ok := b.(*TextEntityTypeCashtag)
+		return ok && a.Equal(b)
+	case *TextEntityTypeBotCommand:
+		b, ok := b.(*TextEntityTypeBotCommand)
+		return ok && a.Equal(b)
+	case *TextEntityTypeUrl:
+		b, ok := b.(*TextEntityTypeUrl)
+		return ok && a.Equal(b)
+	case *TextEntityTypeEmailAddress:
+		b, ok := b.(*TextEntityTypeEmailAddress)
+		return ok && a.Equal(b)
+	case *TextEntityTypePhoneNumber:
+		b, ok := b.(*TextEntityTypePhoneNumber)
+		return ok && a.Equal(b)
+	case *TextEntityTypeBankCardNumber:
+		b, ok := b.(*TextEntityTypeBankCardNumber)
+		return ok && a.Equal(b)
+	case *TextEntityTypeBold:
+		b, ok := b.(*TextEntityTypeBold)
+		return ok && a.Equal(b)
+	case *TextEntityTypeItalic:
+		b, ok := b.(*TextEntityTypeItalic)
+		return ok && a.Equal(b)
+	case *TextEntityTypeUnderline:
+		b, ok := b.(*TextEntityTypeUnderline)
+		return ok && a.Equal(b)
+	case *TextEntityTypeStrikethrough:
+		b, ok := b.(*TextEntityTypeStrikethrough)
+		return ok && a.Equal(b)
+	case *TextEntityTypeSpoiler:
+		b, ok := b.(*TextEntityTypeSpoiler)
+		return ok && a.Equal(b)
+	case *TextEntityTypeCode:
+		b, ok := b.(*TextEntityTypeCode)
+		return ok && a.Equal(b)
+	case *TextEntityTypePre:
+		b, ok := b.(*TextEntityTypePre)
+		return ok && a.Equal(b)
+	case *TextEntityTypePreCode:
+		b, ok := b.(*TextEntityTypePreCode)
+		return ok && a.Equal(b)
+	case *TextEntityTypeTextUrl:
+		b, ok := b.(*TextEntityTypeTextUrl)
+		return ok && a.Equal(b)
+	case *TextEntityTypeMentionName:
+		b, ok := b.(*TextEntityTypeMentionName)
+		return ok && a.Equal(b)
+	case *TextEntityTypeMediaTimestamp:
+		b, ok := b.(*TextEntityTypeMediaTimestamp)
+		return ok && a.Equal(b)
+	case *UnknownTextEntityType:
+		b, ok := b.(*UnknownTextEntityType)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffTextEntityType(path string, a TextEntityType, b TextEntityType, changes []Change) []Change {
+	switch a := a.(type) {
+	case *TextEntityTypeMention:
+		if b, ok := b.(*TextEntityTypeMention); ok {
+			return a.diff(path, b, changes)
+		}
+	case *TextEntityTypeHashtag:
+		if b, ok := b.(*TextEntityTypeHashtag); ok {
+			return a.diff(path, b, changes)
+		}
+	case *TextEntityTypeCashtag:
+		if b, ok := b.(*TextEntityTypeCashtag); ok {
+			return a.diff(path, b, changes)
+		}
+	case *TextEntityTypeBotCommand:
+		if b, ok := b.(*TextEntityTypeBotCommand); ok {
+			return a.diff(path, b, changes)
+		}
+	case *TextEntityTypeUrl:
+		if b, ok := b.(*TextEntityTypeUrl); ok {
+			return a.diff(path, b, changes)
+		}
+	case *TextEntityTypeEmailAddress:
+		if b, ok := b.(*TextEntityTypeEmailAddress); ok {
+			return a.diff(path, b, changes)
+		}
+	case *TextEntityTypePhoneNumber:
+		if b, ok := b.(*TextEntityTypePhoneNumber); ok {
+			return a.diff(path, b, changes)
+		}
+	case *TextEntityTypeBankCardNumber:
+		if b, ok := b.(*TextEntityTypeBankCardNumber); ok {
+			return a.diff(path, b, changes)
+		}
+	case *TextEntityTypeBold:
+		if b, ok := b.(*TextEntityTypeBold); ok {
+			return a.diff(path, b, changes)
+		}
+	case *TextEntityTypeItalic:
+		if b, ok := b.(*TextEntityTypeItalic); ok {
+			return a.diff(path, b, changes)
+		}
+	case *TextEntityTypeUnderline:
+		if b, ok := b.(*TextEntityTypeUnderline); ok {
+			return a.diff(path, b, changes)
+		}
+	case *TextEntityTypeStrikethrough:
+		if b, ok := b.(*TextEntityTypeStrikethrough); ok {
+			return a.diff(path, b, changes)
+		}
+	case *TextEntityTypeSpoiler:
+		if b, ok := b.(*TextEntityTypeSpoiler); ok {
+			return a.diff(path, b, changes)
+		}
+	case *TextEntityTypeCode:
+		if b, ok := b.(*TextEntityTypeCode); ok {
+			return a.diff(path, b, changes)
+		}
+	case *TextEntityTypePre:
+		if b, ok := b.(*TextEntityTypePre); ok {
+			return a.diff(path, b, changes)
+		}
+	case *TextEntityTypePreCode:
+		if b, ok := b.(*TextEntityTypePreCode); ok {
+			return a.diff(path, b, changes)
+		}
+	case *TextEntityTypeTextUrl:
+		if b, ok := b.(*TextEntityTypeTextUrl); ok {
+			return a.diff(path, b, changes)
+		}
+	case *TextEntityTypeMentionName:
+		if b, ok := b.(*TextEntityTypeMentionName); ok {
+			return a.diff(path, b, changes)
+		}
+	case *TextEntityTypeMediaTimestamp:
+		if b, ok := b.(*TextEntityTypeMediaTimestamp); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalTextEntityType(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // TextParseMode Describes the way the text needs to be parsed for TextEntities
 type TextParseMode interface {
 	GetTextParseModeEnum() TextParseModeEnum
+	Accept(visitor TextParseModeVisitor)
 }
 
 // TextParseModeEnum Alias for abstract TextParseMode 'Sub-Classes', used as constant-enum here
@@ -19,30 +21,73 @@ const (
 	TextParseModeHTMLType     TextParseModeEnum = "textParseModeHTML"
 )
 
+// UnknownTextParseMode is a TextParseMode of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownTextParseMode struct {
+	Unknown
+}
+
+// GetTextParseModeEnum return the enum type of this object
+func (unknownTextParseMode *UnknownTextParseMode) GetTextParseModeEnum() TextParseModeEnum {
+	return TextParseModeEnum(unknownTextParseMode.Type)
+}
+
+// Accept calls the method of the visitor for UnknownTextParseMode
+func (unknownTextParseMode *UnknownTextParseMode) Accept(visitor TextParseModeVisitor) {
+	visitor.VisitUnknownTextParseMode(unknownTextParseMode)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownTextParseMode *UnknownTextParseMode) String() string {
+	return objectString(unknownTextParseMode)
+}
+
+// LogValue logs the object as its summary
+func (unknownTextParseMode *UnknownTextParseMode) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownTextParseMode))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownTextParseMode *UnknownTextParseMode) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownTextParseMode)
+}
+
+func (unknownTextParseMode *UnknownTextParseMode) printText(p *printer) {
+	if unknownTextParseMode == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownTextParseMode")
+	p.string("@type", unknownTextParseMode.Type)
+	p.endObject(state)
+}
+
 func unmarshalTextParseMode(rawMsg *json.RawMessage) (TextParseMode, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch TextParseModeEnum(objMap["@type"].(string)) {
+	switch TextParseModeEnum(typeName) {
 	case TextParseModeMarkdownType:
 		var textParseModeMarkdown TextParseModeMarkdown
-		err := json.Unmarshal(*rawMsg, &textParseModeMarkdown)
+		err := jsonUnmarshal(*rawMsg, &textParseModeMarkdown)
 		return &textParseModeMarkdown, err
 
 	case TextParseModeHTMLType:
 		var textParseModeHTML TextParseModeHTML
-		err := json.Unmarshal(*rawMsg, &textParseModeHTML)
+		err := jsonUnmarshal(*rawMsg, &textParseModeHTML)
 		return &textParseModeHTML, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownTextParseMode{Unknown: unknown}, nil
 	}
 }
 
@@ -69,11 +114,118 @@ func NewTextParseModeMarkdown(version int32) *TextParseModeMarkdown {
 	return &textParseModeMarkdownTemp
 }
 
+// MarshalJSON marshals to json
+func (textParseModeMarkdown *TextParseModeMarkdown) MarshalJSON() ([]byte, error) {
+	return textParseModeMarkdown.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (textParseModeMarkdown *TextParseModeMarkdown) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, textParseModeMarkdown)
+}
+
+func (textParseModeMarkdown *TextParseModeMarkdown) appendJSON(b []byte) ([]byte, error) {
+	if textParseModeMarkdown == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, textParseModeMarkdown.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, textParseModeMarkdown.tdCommon.Extra)
+	b = append(b, `,"version":`...)
+	b = appendJSONInt(b, int64(textParseModeMarkdown.Version))
+	return append(b, '}'), nil
+}
+
+func (textParseModeMarkdown *TextParseModeMarkdown) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			textParseModeMarkdown.tdCommon.Type, err = r.readString()
+		case "@extra":
+			textParseModeMarkdown.tdCommon.Extra, err = r.readString()
+		case "version":
+			textParseModeMarkdown.Version, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (textParseModeMarkdown *TextParseModeMarkdown) Clone() *TextParseModeMarkdown {
+	if textParseModeMarkdown == nil {
+		return nil
+	}
+	clone := *textParseModeMarkdown
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (textParseModeMarkdown *TextParseModeMarkdown) Equal(other *TextParseModeMarkdown) bool {
+	if textParseModeMarkdown == nil || other == nil {
+		return textParseModeMarkdown == other
+	}
+	if textParseModeMarkdown.Version != other.Version {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (textParseModeMarkdown *TextParseModeMarkdown) Diff(other *TextParseModeMarkdown) []Change {
+	return textParseModeMarkdown.diff("", other, nil)
+}
+
+func (textParseModeMarkdown *TextParseModeMarkdown) diff(path string, other *TextParseModeMarkdown, changes []Change) []Change {
+	if textParseModeMarkdown == nil || other == nil {
+		if textParseModeMarkdown != other {
+			changes = append(changes, Change{Path: path, Old: textParseModeMarkdown, New: other})
+		}
+		return changes
+	}
+	if textParseModeMarkdown.Version != other.Version {
+		changes = append(changes, Change{Path: fieldPath(path, "version"), Old: textParseModeMarkdown.Version, New: other.Version})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (textParseModeMarkdown *TextParseModeMarkdown) String() string {
+	return objectString(textParseModeMarkdown)
+}
+
+// LogValue logs the object as its summary
+func (textParseModeMarkdown *TextParseModeMarkdown) LogValue() slog.Value {
+	return slog.StringValue(objectString(textParseModeMarkdown))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (textParseModeMarkdown *TextParseModeMarkdown) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, textParseModeMarkdown)
+}
+
+func (textParseModeMarkdown *TextParseModeMarkdown) printText(p *printer) {
+	if textParseModeMarkdown == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TextParseModeMarkdown")
+	p.int("version", int64(textParseModeMarkdown.Version))
+	p.endObject(state)
+}
+
 // GetTextParseModeEnum return the enum type of this object
 func (textParseModeMarkdown *TextParseModeMarkdown) GetTextParseModeEnum() TextParseModeEnum {
 	return TextParseModeMarkdownType
 }
 
+// Accept calls the method of the visitor for TextParseModeMarkdown
+func (textParseModeMarkdown *TextParseModeMarkdown) Accept(visitor TextParseModeVisitor) {
+	visitor.VisitTextParseModeMarkdown(textParseModeMarkdown)
+}
+
 // TextParseModeHTML The text uses HTML-style formatting. The same as Telegram Bot API "HTML" parse mode
 type TextParseModeHTML struct {
 	tdCommon
@@ -85,7 +237,6 @@ func (textParseModeHTML *TextParseModeHTML) MessageType() string {
 }
 
 // NewTextParseModeHTML creates a new TextParseModeHTML
-//
 func NewTextParseModeHTML() *TextParseModeHTML {
 	textParseModeHTMLTemp := TextParseModeHTML{
 		tdCommon: tdCommon{Type: "textParseModeHTML"},
@@ -94,7 +245,198 @@ func NewTextParseModeHTML() *TextParseModeHTML {
 	return &textParseModeHTMLTemp
 }
 
+// MarshalJSON marshals to json
+func (textParseModeHTML *TextParseModeHTML) MarshalJSON() ([]byte, error) {
+	return textParseModeHTML.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (textParseModeHTML *TextParseModeHTML) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, textParseModeHTML)
+}
+
+func (textParseModeHTML *TextParseModeHTML) appendJSON(b []byte) ([]byte, error) {
+	if textParseModeHTML == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, textParseModeHTML.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, textParseModeHTML.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (textParseModeHTML *TextParseModeHTML) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			textParseModeHTML.tdCommon.Type, err = r.readString()
+		case "@extra":
+			textParseModeHTML.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (textParseModeHTML *TextParseModeHTML) Clone() *TextParseModeHTML {
+	if textParseModeHTML == nil {
+		return nil
+	}
+	clone := *textParseModeHTML
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (textParseModeHTML *TextParseModeHTML) Equal(other *TextParseModeHTML) bool {
+	if textParseModeHTML == nil || other == nil {
+		return textParseModeHTML == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (textParseModeHTML *TextParseModeHTML) Diff(other *TextParseModeHTML) []Change {
+	return textParseModeHTML.diff("", other, nil)
+}
+
+func (textParseModeHTML *TextParseModeHTML) diff(path string, other *TextParseModeHTML, changes []Change) []Change {
+	if textParseModeHTML == nil || other == nil {
+		if textParseModeHTML != other {
+			changes = append(changes, Change{Path: path, Old: textParseModeHTML, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (textParseModeHTML *TextParseModeHTML) String() string {
+	return objectString(textParseModeHTML)
+}
+
+// LogValue logs the object as its summary
+func (textParseModeHTML *TextParseModeHTML) LogValue() slog.Value {
+	return slog.StringValue(objectString(textParseModeHTML))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (textParseModeHTML *TextParseModeHTML) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, textParseModeHTML)
+}
+
+func (textParseModeHTML *TextParseModeHTML) printText(p *printer) {
+	if textParseModeHTML == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TextParseModeHTML")
+	p.endObject(state)
+}
+
 // GetTextParseModeEnum return the enum type of this object
 func (textParseModeHTML *TextParseModeHTML) GetTextParseModeEnum() TextParseModeEnum {
 	return TextParseModeHTMLType
 }
+
+// Accept calls the method of the visitor for TextParseModeHTML
+func (textParseModeHTML *TextParseModeHTML) Accept(visitor TextParseModeVisitor) {
+	visitor.VisitTextParseModeHTML(textParseModeHTML)
+}
+
+// TextParseModeVisitor visits every type of TextParseMode, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to TextParseMode, until they handle it too.
+type TextParseModeVisitor interface {
+	VisitTextParseModeMarkdown(textParseModeMarkdown *TextParseModeMarkdown)
+	VisitTextParseModeHTML(textParseModeHTML *TextParseModeHTML)
+	VisitUnknownTextParseMode(unknownTextParseMode *UnknownTextParseMode)
+}
+
+// TextParseModeCases holds the function MatchTextParseMode calls for each type of TextParseMode;
+// types without a function are passed to the default case
+type TextParseModeCases[R any] struct {
+	TextParseModeMarkdown func(textParseModeMarkdown *TextParseModeMarkdown) R
+	TextParseModeHTML     func(textParseModeHTML *TextParseModeHTML) R
+}
+
+// MatchTextParseMode calls the case of the type of textParseMode, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement TextParseModeVisitor
+// to have the compiler check every type is handled.
+func MatchTextParseMode[R any](textParseMode TextParseMode, cases TextParseModeCases[R], defaultCase func(textParseMode TextParseMode) R) R {
+	switch value := textParseMode.(type) {
+	case *TextParseModeMarkdown:
+		if cases.TextParseModeMarkdown != nil {
+			return cases.TextParseModeMarkdown(value)
+		}
+	case *TextParseModeHTML:
+		if cases.TextParseModeHTML != nil {
+			return cases.TextParseModeHTML(value)
+		}
+	}
+	return defaultCase(textParseMode)
+}
+
+// Clone returns a deep copy of the object
+func (unknownTextParseMode *UnknownTextParseMode) Clone() *UnknownTextParseMode {
+	if unknownTextParseMode == nil {
+		return nil
+	}
+	return &UnknownTextParseMode{Unknown: unknownTextParseMode.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownTextParseMode *UnknownTextParseMode) Equal(other *UnknownTextParseMode) bool {
+	if unknownTextParseMode == nil || other == nil {
+		return unknownTextParseMode == other
+	}
+	return unknownTextParseMode.Unknown.equal(other.Unknown)
+}
+
+func cloneTextParseMode(textParseMode TextParseMode) TextParseMode {
+	switch value := textParseMode.(type) {
+	case *TextParseModeMarkdown:
+		return value.Clone()
+	case *TextParseModeHTML:
+		return value.Clone()
+	case *UnknownTextParseMode:
+		return value.Clone()
+	}
+	return textParseMode
+}
+
+func equalTextParseMode(a TextParseMode, b TextParseMode) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *TextParseModeMarkdown:
+		b, ok := b.(*TextParseModeMarkdown)
+		return ok && a.Equal(b)
+	case *TextParseModeHTML:
+		b, ok := b.(*TextParseModeHTML)
+		return ok && a.Equal(b)
+	case *UnknownTextParseMode:
+		b, ok := b.(*UnknownTextParseMode)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffTextParseMode(path string, a TextParseMode, b TextParseMode, changes []Change) []Change {
+	switch a := a.(type) {
+	case *TextParseModeMarkdown:
+		if b, ok := b.(*TextParseModeMarkdown); ok {
+			return a.diff(path, b, changes)
+		}
+	case *TextParseModeHTML:
+		if b, ok := b.(*TextParseModeHTML); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalTextParseMode(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // ThemeSettings Describes theme settings
@@ -39,33 +40,158 @@ func NewThemeSettings(accentColor int32, background *Background, outgoingMessage
 	return &themeSettingsTemp
 }
 
+// MarshalJSON marshals to json
+func (themeSettings *ThemeSettings) MarshalJSON() ([]byte, error) {
+	return themeSettings.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (themeSettings *ThemeSettings) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, themeSettings)
+}
+
+func (themeSettings *ThemeSettings) appendJSON(b []byte) ([]byte, error) {
+	if themeSettings == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, themeSettings.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, themeSettings.tdCommon.Extra)
+	b = append(b, `,"accent_color":`...)
+	b = appendJSONInt(b, int64(themeSettings.AccentColor))
+	b = append(b, `,"background":`...)
+	b, err = themeSettings.Background.appendJSON(b)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		AccentColor                int32       `json:"accent_color"`                  // Theme accent color in ARGB format
-		Background                 *Background `json:"background"`                    // The background to be used in chats; may be null
-		AnimateOutgoingMessageFill bool        `json:"animate_outgoing_message_fill"` // If true, the freeform gradient fill needs to be animated on every sent message
-		OutgoingMessageAccentColor int32       `json:"outgoing_message_accent_color"` // Accent color of outgoing messages in ARGB format
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"outgoing_message_fill":`...)
+	b, err = appendJSONValue(b, themeSettings.OutgoingMessageFill)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	b = append(b, `,"animate_outgoing_message_fill":`...)
+	b = appendJSONBool(b, themeSettings.AnimateOutgoingMessageFill)
+	b = append(b, `,"outgoing_message_accent_color":`...)
+	b = appendJSONInt(b, int64(themeSettings.OutgoingMessageAccentColor))
+	return append(b, '}'), nil
+}
 
-	themeSettings.tdCommon = tempObj.tdCommon
-	themeSettings.AccentColor = tempObj.AccentColor
-	themeSettings.Background = tempObj.Background
-	themeSettings.AnimateOutgoingMessageFill = tempObj.AnimateOutgoingMessageFill
-	themeSettings.OutgoingMessageAccentColor = tempObj.OutgoingMessageAccentColor
+func (themeSettings *ThemeSettings) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			themeSettings.tdCommon.Type, err = r.readString()
+		case "@extra":
+			themeSettings.tdCommon.Extra, err = r.readString()
+		case "accent_color":
+			themeSettings.AccentColor, err = r.readInt32()
+		case "background":
+			if r.readNull() {
+				themeSettings.Background = nil
+			} else {
+				themeSettings.Background = new(Background)
+				err = themeSettings.Background.decodeJSON(r)
+			}
+		case "outgoing_message_fill":
+			themeSettings.OutgoingMessageFill, err = decodeBackgroundFill(r)
+		case "animate_outgoing_message_fill":
+			themeSettings.AnimateOutgoingMessageFill, err = r.readBool()
+		case "outgoing_message_accent_color":
+			themeSettings.OutgoingMessageAccentColor, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	fieldOutgoingMessageFill, _ := unmarshalBackgroundFill(objMap["outgoing_message_fill"])
-	themeSettings.OutgoingMessageFill = fieldOutgoingMessageFill
+// Clone returns a deep copy of the object
+func (themeSettings *ThemeSettings) Clone() *ThemeSettings {
+	if themeSettings == nil {
+		return nil
+	}
+	clone := *themeSettings
+	clone.Background = themeSettings.Background.Clone()
+	clone.OutgoingMessageFill = cloneBackgroundFill(themeSettings.OutgoingMessageFill)
+	return &clone
+}
 
-	return nil
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (themeSettings *ThemeSettings) Equal(other *ThemeSettings) bool {
+	if themeSettings == nil || other == nil {
+		return themeSettings == other
+	}
+	if themeSettings.AccentColor != other.AccentColor {
+		return false
+	}
+	if !themeSettings.Background.Equal(other.Background) {
+		return false
+	}
+	if !equalBackgroundFill(themeSettings.OutgoingMessageFill, other.OutgoingMessageFill) {
+		return false
+	}
+	if themeSettings.AnimateOutgoingMessageFill != other.AnimateOutgoingMessageFill {
+		return false
+	}
+	if themeSettings.OutgoingMessageAccentColor != other.OutgoingMessageAccentColor {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (themeSettings *ThemeSettings) Diff(other *ThemeSettings) []Change {
+	return themeSettings.diff("", other, nil)
+}
+
+func (themeSettings *ThemeSettings) diff(path string, other *ThemeSettings, changes []Change) []Change {
+	if themeSettings == nil || other == nil {
+		if themeSettings != other {
+			changes = append(changes, Change{Path: path, Old: themeSettings, New: other})
+		}
+		return changes
+	}
+	if themeSettings.AccentColor != other.AccentColor {
+		changes = append(changes, Change{Path: fieldPath(path, "accent_color"), Old: themeSettings.AccentColor, New: other.AccentColor})
+	}
+	changes = themeSettings.Background.diff(fieldPath(path, "background"), other.Background, changes)
+	changes = diffBackgroundFill(fieldPath(path, "outgoing_message_fill"), themeSettings.OutgoingMessageFill, other.OutgoingMessageFill, changes)
+	if themeSettings.AnimateOutgoingMessageFill != other.AnimateOutgoingMessageFill {
+		changes = append(changes, Change{Path: fieldPath(path, "animate_outgoing_message_fill"), Old: themeSettings.AnimateOutgoingMessageFill, New: other.AnimateOutgoingMessageFill})
+	}
+	if themeSettings.OutgoingMessageAccentColor != other.OutgoingMessageAccentColor {
+		changes = append(changes, Change{Path: fieldPath(path, "outgoing_message_accent_color"), Old: themeSettings.OutgoingMessageAccentColor, New: other.OutgoingMessageAccentColor})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (themeSettings *ThemeSettings) String() string {
+	return objectString(themeSettings)
+}
+
+// LogValue logs the object as its summary
+func (themeSettings *ThemeSettings) LogValue() slog.Value {
+	return slog.StringValue(objectString(themeSettings))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (themeSettings *ThemeSettings) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, themeSettings)
+}
+
+func (themeSettings *ThemeSettings) printText(p *printer) {
+	if themeSettings == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ThemeSettings")
+	p.int("accent_color", int64(themeSettings.AccentColor))
+	p.object("background", themeSettings.Background)
+	p.object("outgoing_message_fill", themeSettings.OutgoingMessageFill)
+	p.bool("animate_outgoing_message_fill", themeSettings.AnimateOutgoingMessageFill)
+	p.int("outgoing_message_accent_color", int64(themeSettings.OutgoingMessageAccentColor))
+	p.endObject(state)
 }
@@ -1,7 +1,7 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"log/slog"
 )
 
 // Thumbnail Represents a thumbnail
@@ -36,31 +36,142 @@ func NewThumbnail(format ThumbnailFormat, width int32, height int32, file *File)
 	return &thumbnailTemp
 }
 
+// MarshalJSON marshals to json
+func (thumbnail *Thumbnail) MarshalJSON() ([]byte, error) {
+	return thumbnail.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (thumbnail *Thumbnail) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, thumbnail)
+}
+
+func (thumbnail *Thumbnail) appendJSON(b []byte) ([]byte, error) {
+	if thumbnail == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, thumbnail.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, thumbnail.tdCommon.Extra)
+	b = append(b, `,"format":`...)
+	b, err = appendJSONValue(b, thumbnail.Format)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-		Width  int32 `json:"width"`  // Thumbnail width
-		Height int32 `json:"height"` // Thumbnail height
-		File   *File `json:"file"`   // The thumbnail
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	b = append(b, `,"width":`...)
+	b = appendJSONInt(b, int64(thumbnail.Width))
+	b = append(b, `,"height":`...)
+	b = appendJSONInt(b, int64(thumbnail.Height))
+	b = append(b, `,"file":`...)
+	b, err = thumbnail.File.appendJSON(b)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (thumbnail *Thumbnail) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			thumbnail.tdCommon.Type, err = r.readString()
+		case "@extra":
+			thumbnail.tdCommon.Extra, err = r.readString()
+		case "format":
+			thumbnail.Format, err = decodeThumbnailFormat(r)
+		case "width":
+			thumbnail.Width, err = r.readInt32()
+		case "height":
+			thumbnail.Height, err = r.readInt32()
+		case "file":
+			if r.readNull() {
+				thumbnail.File = nil
+			} else {
+				thumbnail.File = new(File)
+				err = thumbnail.File.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (thumbnail *Thumbnail) Clone() *Thumbnail {
+	if thumbnail == nil {
+		return nil
+	}
+	clone := *thumbnail
+	clone.Format = cloneThumbnailFormat(thumbnail.Format)
+	clone.File = thumbnail.File.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (thumbnail *Thumbnail) Equal(other *Thumbnail) bool {
+	if thumbnail == nil || other == nil {
+		return thumbnail == other
+	}
+	if !equalThumbnailFormat(thumbnail.Format, other.Format) {
+		return false
 	}
+	if thumbnail.Width != other.Width {
+		return false
+	}
+	if thumbnail.Height != other.Height {
+		return false
+	}
+	if !thumbnail.File.Equal(other.File) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (thumbnail *Thumbnail) Diff(other *Thumbnail) []Change {
+	return thumbnail.diff("", other, nil)
+}
+
+func (thumbnail *Thumbnail) diff(path string, other *Thumbnail, changes []Change) []Change {
+	if thumbnail == nil || other == nil {
+		if thumbnail != other {
+			changes = append(changes, Change{Path: path, Old: thumbnail, New: other})
+		}
+		return changes
+	}
+	changes = diffThumbnailFormat(fieldPath(path, "format"), thumbnail.Format, other.Format, changes)
+	if thumbnail.Width != other.Width {
+		changes = append(changes, Change{Path: fieldPath(path, "width"), Old: thumbnail.Width, New: other.Width})
+	}
+	if thumbnail.Height != other.Height {
+		changes = append(changes, Change{Path: fieldPath(path, "height"), Old: thumbnail.Height, New: other.Height})
+	}
+	changes = thumbnail.File.diff(fieldPath(path, "file"), other.File, changes)
+	return changes
+}
 
-	thumbnail.tdCommon = tempObj.tdCommon
-	thumbnail.Width = tempObj.Width
-	thumbnail.Height = tempObj.Height
-	thumbnail.File = tempObj.File
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (thumbnail *Thumbnail) String() string {
+	return objectString(thumbnail)
+}
 
-	fieldFormat, _ := unmarshalThumbnailFormat(objMap["format"])
-	thumbnail.Format = fieldFormat
+// LogValue logs the object as its summary
+func (thumbnail *Thumbnail) LogValue() slog.Value {
+	return slog.StringValue(objectString(thumbnail))
+}
 
-	return nil
+func (thumbnail *Thumbnail) printText(p *printer) {
+	if thumbnail == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Thumbnail")
+	p.object("format", thumbnail.Format)
+	p.int("width", int64(thumbnail.Width))
+	p.int("height", int64(thumbnail.Height))
+	p.object("file", thumbnail.File)
+	p.endObject(state)
 }
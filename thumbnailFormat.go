@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ThumbnailFormat Describes format of the thumbnail
 type ThumbnailFormat interface {
 	GetThumbnailFormatEnum() ThumbnailFormatEnum
+	Accept(visitor ThumbnailFormatVisitor)
 }
 
 // ThumbnailFormatEnum Alias for abstract ThumbnailFormat 'Sub-Classes', used as constant-enum here
@@ -23,53 +25,104 @@ const (
 	ThumbnailFormatMpeg4Type ThumbnailFormatEnum = "thumbnailFormatMpeg4"
 )
 
+// UnknownThumbnailFormat is a ThumbnailFormat of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownThumbnailFormat struct {
+	Unknown
+}
+
+// GetThumbnailFormatEnum return the enum type of this object
+func (unknownThumbnailFormat *UnknownThumbnailFormat) GetThumbnailFormatEnum() ThumbnailFormatEnum {
+	return ThumbnailFormatEnum(unknownThumbnailFormat.Type)
+}
+
+// Accept calls the method of the visitor for UnknownThumbnailFormat
+func (unknownThumbnailFormat *UnknownThumbnailFormat) Accept(visitor ThumbnailFormatVisitor) {
+	visitor.VisitUnknownThumbnailFormat(unknownThumbnailFormat)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownThumbnailFormat *UnknownThumbnailFormat) String() string {
+	return objectString(unknownThumbnailFormat)
+}
+
+// LogValue logs the object as its summary
+func (unknownThumbnailFormat *UnknownThumbnailFormat) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownThumbnailFormat))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownThumbnailFormat *UnknownThumbnailFormat) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownThumbnailFormat)
+}
+
+func (unknownThumbnailFormat *UnknownThumbnailFormat) printText(p *printer) {
+	if unknownThumbnailFormat == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownThumbnailFormat")
+	p.string("@type", unknownThumbnailFormat.Type)
+	p.endObject(state)
+}
+
 func unmarshalThumbnailFormat(rawMsg *json.RawMessage) (ThumbnailFormat, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch ThumbnailFormatEnum(objMap["@type"].(string)) {
+	switch ThumbnailFormatEnum(typeName) {
 	case ThumbnailFormatJpegType:
 		var thumbnailFormatJpeg ThumbnailFormatJpeg
-		err := json.Unmarshal(*rawMsg, &thumbnailFormatJpeg)
+		err := jsonUnmarshal(*rawMsg, &thumbnailFormatJpeg)
 		return &thumbnailFormatJpeg, err
 
 	case ThumbnailFormatPngType:
 		var thumbnailFormatPng ThumbnailFormatPng
-		err := json.Unmarshal(*rawMsg, &thumbnailFormatPng)
+		err := jsonUnmarshal(*rawMsg, &thumbnailFormatPng)
 		return &thumbnailFormatPng, err
 
 	case ThumbnailFormatWebpType:
 		var thumbnailFormatWebp ThumbnailFormatWebp
-		err := json.Unmarshal(*rawMsg, &thumbnailFormatWebp)
+		err := jsonUnmarshal(*rawMsg, &thumbnailFormatWebp)
 		return &thumbnailFormatWebp, err
 
 	case ThumbnailFormatGifType:
 		var thumbnailFormatGif ThumbnailFormatGif
-		err := json.Unmarshal(*rawMsg, &thumbnailFormatGif)
+		err := jsonUnmarshal(*rawMsg, &thumbnailFormatGif)
 		return &thumbnailFormatGif, err
 
 	case ThumbnailFormatTgsType:
 		var thumbnailFormatTgs ThumbnailFormatTgs
-		err := json.Unmarshal(*rawMsg, &thumbnailFormatTgs)
+		err := jsonUnmarshal(*rawMsg, &thumbnailFormatTgs)
 		return &thumbnailFormatTgs, err
 
 	case ThumbnailFormatMpeg4Type:
 		var thumbnailFormatMpeg4 ThumbnailFormatMpeg4
-		err := json.Unmarshal(*rawMsg, &thumbnailFormatMpeg4)
+		err := jsonUnmarshal(*rawMsg, &thumbnailFormatMpeg4)
 		return &thumbnailFormatMpeg4, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownThumbnailFormat{Unknown: unknown}, nil
 	}
 }
 
+func decodeThumbnailFormat(r *jsonReader) (ThumbnailFormat, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalThumbnailFormat(rawMsg)
+}
+
 // ThumbnailFormatJpeg The thumbnail is in JPEG format
 type ThumbnailFormatJpeg struct {
 	tdCommon
@@ -81,7 +134,6 @@ func (thumbnailFormatJpeg *ThumbnailFormatJpeg) MessageType() string {
 }
 
 // NewThumbnailFormatJpeg creates a new ThumbnailFormatJpeg
-//
 func NewThumbnailFormatJpeg() *ThumbnailFormatJpeg {
 	thumbnailFormatJpegTemp := ThumbnailFormatJpeg{
 		tdCommon: tdCommon{Type: "thumbnailFormatJpeg"},
@@ -90,11 +142,107 @@ func NewThumbnailFormatJpeg() *ThumbnailFormatJpeg {
 	return &thumbnailFormatJpegTemp
 }
 
+// MarshalJSON marshals to json
+func (thumbnailFormatJpeg *ThumbnailFormatJpeg) MarshalJSON() ([]byte, error) {
+	return thumbnailFormatJpeg.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (thumbnailFormatJpeg *ThumbnailFormatJpeg) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, thumbnailFormatJpeg)
+}
+
+func (thumbnailFormatJpeg *ThumbnailFormatJpeg) appendJSON(b []byte) ([]byte, error) {
+	if thumbnailFormatJpeg == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, thumbnailFormatJpeg.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, thumbnailFormatJpeg.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (thumbnailFormatJpeg *ThumbnailFormatJpeg) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			thumbnailFormatJpeg.tdCommon.Type, err = r.readString()
+		case "@extra":
+			thumbnailFormatJpeg.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (thumbnailFormatJpeg *ThumbnailFormatJpeg) Clone() *ThumbnailFormatJpeg {
+	if thumbnailFormatJpeg == nil {
+		return nil
+	}
+	clone := *thumbnailFormatJpeg
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (thumbnailFormatJpeg *ThumbnailFormatJpeg) Equal(other *ThumbnailFormatJpeg) bool {
+	if thumbnailFormatJpeg == nil || other == nil {
+		return thumbnailFormatJpeg == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (thumbnailFormatJpeg *ThumbnailFormatJpeg) Diff(other *ThumbnailFormatJpeg) []Change {
+	return thumbnailFormatJpeg.diff("", other, nil)
+}
+
+func (thumbnailFormatJpeg *ThumbnailFormatJpeg) diff(path string, other *ThumbnailFormatJpeg, changes []Change) []Change {
+	if thumbnailFormatJpeg == nil || other == nil {
+		if thumbnailFormatJpeg != other {
+			changes = append(changes, Change{Path: path, Old: thumbnailFormatJpeg, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (thumbnailFormatJpeg *ThumbnailFormatJpeg) String() string {
+	return objectString(thumbnailFormatJpeg)
+}
+
+// LogValue logs the object as its summary
+func (thumbnailFormatJpeg *ThumbnailFormatJpeg) LogValue() slog.Value {
+	return slog.StringValue(objectString(thumbnailFormatJpeg))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (thumbnailFormatJpeg *ThumbnailFormatJpeg) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, thumbnailFormatJpeg)
+}
+
+func (thumbnailFormatJpeg *ThumbnailFormatJpeg) printText(p *printer) {
+	if thumbnailFormatJpeg == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ThumbnailFormatJpeg")
+	p.endObject(state)
+}
+
 // GetThumbnailFormatEnum return the enum type of this object
 func (thumbnailFormatJpeg *ThumbnailFormatJpeg) GetThumbnailFormatEnum() ThumbnailFormatEnum {
 	return ThumbnailFormatJpegType
 }
 
+// Accept calls the method of the visitor for ThumbnailFormatJpeg
+func (thumbnailFormatJpeg *ThumbnailFormatJpeg) Accept(visitor ThumbnailFormatVisitor) {
+	visitor.VisitThumbnailFormatJpeg(thumbnailFormatJpeg)
+}
+
 // ThumbnailFormatPng The thumbnail is in PNG format. It will be used only for background patterns
 type ThumbnailFormatPng struct {
 	tdCommon
@@ -106,7 +254,6 @@ func (thumbnailFormatPng *ThumbnailFormatPng) MessageType() string {
 }
 
 // NewThumbnailFormatPng creates a new ThumbnailFormatPng
-//
 func NewThumbnailFormatPng() *ThumbnailFormatPng {
 	thumbnailFormatPngTemp := ThumbnailFormatPng{
 		tdCommon: tdCommon{Type: "thumbnailFormatPng"},
@@ -115,11 +262,107 @@ func NewThumbnailFormatPng() *ThumbnailFormatPng {
 	return &thumbnailFormatPngTemp
 }
 
+// MarshalJSON marshals to json
+func (thumbnailFormatPng *ThumbnailFormatPng) MarshalJSON() ([]byte, error) {
+	return thumbnailFormatPng.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (thumbnailFormatPng *ThumbnailFormatPng) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, thumbnailFormatPng)
+}
+
+func (thumbnailFormatPng *ThumbnailFormatPng) appendJSON(b []byte) ([]byte, error) {
+	if thumbnailFormatPng == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, thumbnailFormatPng.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, thumbnailFormatPng.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (thumbnailFormatPng *ThumbnailFormatPng) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			thumbnailFormatPng.tdCommon.Type, err = r.readString()
+		case "@extra":
+			thumbnailFormatPng.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (thumbnailFormatPng *ThumbnailFormatPng) Clone() *ThumbnailFormatPng {
+	if thumbnailFormatPng == nil {
+		return nil
+	}
+	clone := *thumbnailFormatPng
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (thumbnailFormatPng *ThumbnailFormatPng) Equal(other *ThumbnailFormatPng) bool {
+	if thumbnailFormatPng == nil || other == nil {
+		return thumbnailFormatPng == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (thumbnailFormatPng *ThumbnailFormatPng) Diff(other *ThumbnailFormatPng) []Change {
+	return thumbnailFormatPng.diff("", other, nil)
+}
+
+func (thumbnailFormatPng *ThumbnailFormatPng) diff(path string, other *ThumbnailFormatPng, changes []Change) []Change {
+	if thumbnailFormatPng == nil || other == nil {
+		if thumbnailFormatPng != other {
+			changes = append(changes, Change{Path: path, Old: thumbnailFormatPng, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (thumbnailFormatPng *ThumbnailFormatPng) String() string {
+	return objectString(thumbnailFormatPng)
+}
+
+// LogValue logs the object as its summary
+func (thumbnailFormatPng *ThumbnailFormatPng) LogValue() slog.Value {
+	return slog.StringValue(objectString(thumbnailFormatPng))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (thumbnailFormatPng *ThumbnailFormatPng) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, thumbnailFormatPng)
+}
+
+func (thumbnailFormatPng *ThumbnailFormatPng) printText(p *printer) {
+	if thumbnailFormatPng == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ThumbnailFormatPng")
+	p.endObject(state)
+}
+
 // GetThumbnailFormatEnum return the enum type of this object
 func (thumbnailFormatPng *ThumbnailFormatPng) GetThumbnailFormatEnum() ThumbnailFormatEnum {
 	return ThumbnailFormatPngType
 }
 
+// Accept calls the method of the visitor for ThumbnailFormatPng
+func (thumbnailFormatPng *ThumbnailFormatPng) Accept(visitor ThumbnailFormatVisitor) {
+	visitor.VisitThumbnailFormatPng(thumbnailFormatPng)
+}
+
 // ThumbnailFormatWebp The thumbnail is in WEBP format. It will be used only for some stickers
 type ThumbnailFormatWebp struct {
 	tdCommon
@@ -131,7 +374,6 @@ func (thumbnailFormatWebp *ThumbnailFormatWebp) MessageType() string {
 }
 
 // NewThumbnailFormatWebp creates a new ThumbnailFormatWebp
-//
 func NewThumbnailFormatWebp() *ThumbnailFormatWebp {
 	thumbnailFormatWebpTemp := ThumbnailFormatWebp{
 		tdCommon: tdCommon{Type: "thumbnailFormatWebp"},
@@ -140,11 +382,107 @@ func NewThumbnailFormatWebp() *ThumbnailFormatWebp {
 	return &thumbnailFormatWebpTemp
 }
 
+// MarshalJSON marshals to json
+func (thumbnailFormatWebp *ThumbnailFormatWebp) MarshalJSON() ([]byte, error) {
+	return thumbnailFormatWebp.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (thumbnailFormatWebp *ThumbnailFormatWebp) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, thumbnailFormatWebp)
+}
+
+func (thumbnailFormatWebp *ThumbnailFormatWebp) appendJSON(b []byte) ([]byte, error) {
+	if thumbnailFormatWebp == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, thumbnailFormatWebp.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, thumbnailFormatWebp.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (thumbnailFormatWebp *ThumbnailFormatWebp) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			thumbnailFormatWebp.tdCommon.Type, err = r.readString()
+		case "@extra":
+			thumbnailFormatWebp.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (thumbnailFormatWebp *ThumbnailFormatWebp) Clone() *ThumbnailFormatWebp {
+	if thumbnailFormatWebp == nil {
+		return nil
+	}
+	clone := *thumbnailFormatWebp
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (thumbnailFormatWebp *ThumbnailFormatWebp) Equal(other *ThumbnailFormatWebp) bool {
+	if thumbnailFormatWebp == nil || other == nil {
+		return thumbnailFormatWebp == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (thumbnailFormatWebp *ThumbnailFormatWebp) Diff(other *ThumbnailFormatWebp) []Change {
+	return thumbnailFormatWebp.diff("", other, nil)
+}
+
+func (thumbnailFormatWebp *ThumbnailFormatWebp) diff(path string, other *ThumbnailFormatWebp, changes []Change) []Change {
+	if thumbnailFormatWebp == nil || other == nil {
+		if thumbnailFormatWebp != other {
+			changes = append(changes, Change{Path: path, Old: thumbnailFormatWebp, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (thumbnailFormatWebp *ThumbnailFormatWebp) String() string {
+	return objectString(thumbnailFormatWebp)
+}
+
+// LogValue logs the object as its summary
+func (thumbnailFormatWebp *ThumbnailFormatWebp) LogValue() slog.Value {
+	return slog.StringValue(objectString(thumbnailFormatWebp))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (thumbnailFormatWebp *ThumbnailFormatWebp) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, thumbnailFormatWebp)
+}
+
+func (thumbnailFormatWebp *ThumbnailFormatWebp) printText(p *printer) {
+	if thumbnailFormatWebp == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ThumbnailFormatWebp")
+	p.endObject(state)
+}
+
 // GetThumbnailFormatEnum return the enum type of this object
 func (thumbnailFormatWebp *ThumbnailFormatWebp) GetThumbnailFormatEnum() ThumbnailFormatEnum {
 	return ThumbnailFormatWebpType
 }
 
+// Accept calls the method of the visitor for ThumbnailFormatWebp
+func (thumbnailFormatWebp *ThumbnailFormatWebp) Accept(visitor ThumbnailFormatVisitor) {
+	visitor.VisitThumbnailFormatWebp(thumbnailFormatWebp)
+}
+
 // ThumbnailFormatGif The thumbnail is in static GIF format. It will be used only for some bot inline results
 type ThumbnailFormatGif struct {
 	tdCommon
@@ -156,7 +494,6 @@ func (thumbnailFormatGif *ThumbnailFormatGif) MessageType() string {
 }
 
 // NewThumbnailFormatGif creates a new ThumbnailFormatGif
-//
 func NewThumbnailFormatGif() *ThumbnailFormatGif {
 	thumbnailFormatGifTemp := ThumbnailFormatGif{
 		tdCommon: tdCommon{Type: "thumbnailFormatGif"},
@@ -165,11 +502,107 @@ func NewThumbnailFormatGif() *ThumbnailFormatGif {
 	return &thumbnailFormatGifTemp
 }
 
+// MarshalJSON marshals to json
+func (thumbnailFormatGif *ThumbnailFormatGif) MarshalJSON() ([]byte, error) {
+	return thumbnailFormatGif.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (thumbnailFormatGif *ThumbnailFormatGif) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, thumbnailFormatGif)
+}
+
+func (thumbnailFormatGif *ThumbnailFormatGif) appendJSON(b []byte) ([]byte, error) {
+	if thumbnailFormatGif == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, thumbnailFormatGif.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, thumbnailFormatGif.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (thumbnailFormatGif *ThumbnailFormatGif) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			thumbnailFormatGif.tdCommon.Type, err = r.readString()
+		case "@extra":
+			thumbnailFormatGif.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (thumbnailFormatGif *ThumbnailFormatGif) Clone() *ThumbnailFormatGif {
+	if thumbnailFormatGif == nil {
+		return nil
+	}
+	clone := *thumbnailFormatGif
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (thumbnailFormatGif *ThumbnailFormatGif) Equal(other *ThumbnailFormatGif) bool {
+	if thumbnailFormatGif == nil || other == nil {
+		return thumbnailFormatGif == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (thumbnailFormatGif *ThumbnailFormatGif) Diff(other *ThumbnailFormatGif) []Change {
+	return thumbnailFormatGif.diff("", other, nil)
+}
+
+func (thumbnailFormatGif *ThumbnailFormatGif) diff(path string, other *ThumbnailFormatGif, changes []Change) []Change {
+	if thumbnailFormatGif == nil || other == nil {
+		if thumbnailFormatGif != other {
+			changes = append(changes, Change{Path: path, Old: thumbnailFormatGif, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (thumbnailFormatGif *ThumbnailFormatGif) String() string {
+	return objectString(thumbnailFormatGif)
+}
+
+// LogValue logs the object as its summary
+func (thumbnailFormatGif *ThumbnailFormatGif) LogValue() slog.Value {
+	return slog.StringValue(objectString(thumbnailFormatGif))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (thumbnailFormatGif *ThumbnailFormatGif) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, thumbnailFormatGif)
+}
+
+func (thumbnailFormatGif *ThumbnailFormatGif) printText(p *printer) {
+	if thumbnailFormatGif == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ThumbnailFormatGif")
+	p.endObject(state)
+}
+
 // GetThumbnailFormatEnum return the enum type of this object
 func (thumbnailFormatGif *ThumbnailFormatGif) GetThumbnailFormatEnum() ThumbnailFormatEnum {
 	return ThumbnailFormatGifType
 }
 
+// Accept calls the method of the visitor for ThumbnailFormatGif
+func (thumbnailFormatGif *ThumbnailFormatGif) Accept(visitor ThumbnailFormatVisitor) {
+	visitor.VisitThumbnailFormatGif(thumbnailFormatGif)
+}
+
 // ThumbnailFormatTgs The thumbnail is in TGS format. It will be used only for animated sticker sets
 type ThumbnailFormatTgs struct {
 	tdCommon
@@ -181,7 +614,6 @@ func (thumbnailFormatTgs *ThumbnailFormatTgs) MessageType() string {
 }
 
 // NewThumbnailFormatTgs creates a new ThumbnailFormatTgs
-//
 func NewThumbnailFormatTgs() *ThumbnailFormatTgs {
 	thumbnailFormatTgsTemp := ThumbnailFormatTgs{
 		tdCommon: tdCommon{Type: "thumbnailFormatTgs"},
@@ -190,11 +622,107 @@ func NewThumbnailFormatTgs() *ThumbnailFormatTgs {
 	return &thumbnailFormatTgsTemp
 }
 
+// MarshalJSON marshals to json
+func (thumbnailFormatTgs *ThumbnailFormatTgs) MarshalJSON() ([]byte, error) {
+	return thumbnailFormatTgs.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (thumbnailFormatTgs *ThumbnailFormatTgs) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, thumbnailFormatTgs)
+}
+
+func (thumbnailFormatTgs *ThumbnailFormatTgs) appendJSON(b []byte) ([]byte, error) {
+	if thumbnailFormatTgs == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, thumbnailFormatTgs.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, thumbnailFormatTgs.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (thumbnailFormatTgs *ThumbnailFormatTgs) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			thumbnailFormatTgs.tdCommon.Type, err = r.readString()
+		case "@extra":
+			thumbnailFormatTgs.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (thumbnailFormatTgs *ThumbnailFormatTgs) Clone() *ThumbnailFormatTgs {
+	if thumbnailFormatTgs == nil {
+		return nil
+	}
+	clone := *thumbnailFormatTgs
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (thumbnailFormatTgs *ThumbnailFormatTgs) Equal(other *ThumbnailFormatTgs) bool {
+	if thumbnailFormatTgs == nil || other == nil {
+		return thumbnailFormatTgs == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (thumbnailFormatTgs *ThumbnailFormatTgs) Diff(other *ThumbnailFormatTgs) []Change {
+	return thumbnailFormatTgs.diff("", other, nil)
+}
+
+func (thumbnailFormatTgs *ThumbnailFormatTgs) diff(path string, other *ThumbnailFormatTgs, changes []Change) []Change {
+	if thumbnailFormatTgs == nil || other == nil {
+		if thumbnailFormatTgs != other {
+			changes = append(changes, Change{Path: path, Old: thumbnailFormatTgs, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (thumbnailFormatTgs *ThumbnailFormatTgs) String() string {
+	return objectString(thumbnailFormatTgs)
+}
+
+// LogValue logs the object as its summary
+func (thumbnailFormatTgs *ThumbnailFormatTgs) LogValue() slog.Value {
+	return slog.StringValue(objectString(thumbnailFormatTgs))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (thumbnailFormatTgs *ThumbnailFormatTgs) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, thumbnailFormatTgs)
+}
+
+func (thumbnailFormatTgs *ThumbnailFormatTgs) printText(p *printer) {
+	if thumbnailFormatTgs == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ThumbnailFormatTgs")
+	p.endObject(state)
+}
+
 // GetThumbnailFormatEnum return the enum type of this object
 func (thumbnailFormatTgs *ThumbnailFormatTgs) GetThumbnailFormatEnum() ThumbnailFormatEnum {
 	return ThumbnailFormatTgsType
 }
 
+// Accept calls the method of the visitor for ThumbnailFormatTgs
+func (thumbnailFormatTgs *ThumbnailFormatTgs) Accept(visitor ThumbnailFormatVisitor) {
+	visitor.VisitThumbnailFormatTgs(thumbnailFormatTgs)
+}
+
 // ThumbnailFormatMpeg4 The thumbnail is in MPEG4 format. It will be used only for some animations and videos
 type ThumbnailFormatMpeg4 struct {
 	tdCommon
@@ -206,7 +734,6 @@ func (thumbnailFormatMpeg4 *ThumbnailFormatMpeg4) MessageType() string {
 }
 
 // NewThumbnailFormatMpeg4 creates a new ThumbnailFormatMpeg4
-//
 func NewThumbnailFormatMpeg4() *ThumbnailFormatMpeg4 {
 	thumbnailFormatMpeg4Temp := ThumbnailFormatMpeg4{
 		tdCommon: tdCommon{Type: "thumbnailFormatMpeg4"},
@@ -215,7 +742,258 @@ func NewThumbnailFormatMpeg4() *ThumbnailFormatMpeg4 {
 	return &thumbnailFormatMpeg4Temp
 }
 
+// MarshalJSON marshals to json
+func (thumbnailFormatMpeg4 *ThumbnailFormatMpeg4) MarshalJSON() ([]byte, error) {
+	return thumbnailFormatMpeg4.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (thumbnailFormatMpeg4 *ThumbnailFormatMpeg4) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, thumbnailFormatMpeg4)
+}
+
+func (thumbnailFormatMpeg4 *ThumbnailFormatMpeg4) appendJSON(b []byte) ([]byte, error) {
+	if thumbnailFormatMpeg4 == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, thumbnailFormatMpeg4.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, thumbnailFormatMpeg4.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (thumbnailFormatMpeg4 *ThumbnailFormatMpeg4) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			thumbnailFormatMpeg4.tdCommon.Type, err = r.readString()
+		case "@extra":
+			thumbnailFormatMpeg4.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (thumbnailFormatMpeg4 *ThumbnailFormatMpeg4) Clone() *ThumbnailFormatMpeg4 {
+	if thumbnailFormatMpeg4 == nil {
+		return nil
+	}
+	clone := *thumbnailFormatMpeg4
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (thumbnailFormatMpeg4 *ThumbnailFormatMpeg4) Equal(other *ThumbnailFormatMpeg4) bool {
+	if thumbnailFormatMpeg4 == nil || other == nil {
+		return thumbnailFormatMpeg4 == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (thumbnailFormatMpeg4 *ThumbnailFormatMpeg4) Diff(other *ThumbnailFormatMpeg4) []Change {
+	return thumbnailFormatMpeg4.diff("", other, nil)
+}
+
+func (thumbnailFormatMpeg4 *ThumbnailFormatMpeg4) diff(path string, other *ThumbnailFormatMpeg4, changes []Change) []Change {
+	if thumbnailFormatMpeg4 == nil || other == nil {
+		if thumbnailFormatMpeg4 != other {
+			changes = append(changes, Change{Path: path, Old: thumbnailFormatMpeg4, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (thumbnailFormatMpeg4 *ThumbnailFormatMpeg4) String() string {
+	return objectString(thumbnailFormatMpeg4)
+}
+
+// LogValue logs the object as its summary
+func (thumbnailFormatMpeg4 *ThumbnailFormatMpeg4) LogValue() slog.Value {
+	return slog.StringValue(objectString(thumbnailFormatMpeg4))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (thumbnailFormatMpeg4 *ThumbnailFormatMpeg4) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, thumbnailFormatMpeg4)
+}
+
+func (thumbnailFormatMpeg4 *ThumbnailFormatMpeg4) printText(p *printer) {
+	if thumbnailFormatMpeg4 == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ThumbnailFormatMpeg4")
+	p.endObject(state)
+}
+
 // GetThumbnailFormatEnum return the enum type of this object
 func (thumbnailFormatMpeg4 *ThumbnailFormatMpeg4) GetThumbnailFormatEnum() ThumbnailFormatEnum {
 	return ThumbnailFormatMpeg4Type
 }
+
+// Accept calls the method of the visitor for ThumbnailFormatMpeg4
+func (thumbnailFormatMpeg4 *ThumbnailFormatMpeg4) Accept(visitor ThumbnailFormatVisitor) {
+	visitor.VisitThumbnailFormatMpeg4(thumbnailFormatMpeg4)
+}
+
+// ThumbnailFormatVisitor visits every type of ThumbnailFormat, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to ThumbnailFormat, until they handle it too.
+type ThumbnailFormatVisitor interface {
+	VisitThumbnailFormatJpeg(thumbnailFormatJpeg *ThumbnailFormatJpeg)
+	VisitThumbnailFormatPng(thumbnailFormatPng *ThumbnailFormatPng)
+	VisitThumbnailFormatWebp(thumbnailFormatWebp *ThumbnailFormatWebp)
+	VisitThumbnailFormatGif(thumbnailFormatGif *ThumbnailFormatGif)
+	VisitThumbnailFormatTgs(thumbnailFormatTgs *ThumbnailFormatTgs)
+	VisitThumbnailFormatMpeg4(thumbnailFormatMpeg4 *ThumbnailFormatMpeg4)
+	VisitUnknownThumbnailFormat(unknownThumbnailFormat *UnknownThumbnailFormat)
+}
+
+// ThumbnailFormatCases holds the function MatchThumbnailFormat calls for each type of ThumbnailFormat;
+// types without a function are passed to the default case
+type ThumbnailFormatCases[R any] struct {
+	ThumbnailFormatJpeg  func(thumbnailFormatJpeg *ThumbnailFormatJpeg) R
+	ThumbnailFormatPng   func(thumbnailFormatPng *ThumbnailFormatPng) R
+	ThumbnailFormatWebp  func(thumbnailFormatWebp *ThumbnailFormatWebp) R
+	ThumbnailFormatGif   func(thumbnailFormatGif *ThumbnailFormatGif) R
+	ThumbnailFormatTgs   func(thumbnailFormatTgs *ThumbnailFormatTgs) R
+	ThumbnailFormatMpeg4 func(thumbnailFormatMpeg4 *ThumbnailFormatMpeg4) R
+}
+
+// MatchThumbnailFormat calls the case of the type of thumbnailFormat, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement ThumbnailFormatVisitor
+// to have the compiler check every type is handled.
+func MatchThumbnailFormat[R any](thumbnailFormat ThumbnailFormat, cases ThumbnailFormatCases[R], defaultCase func(thumbnailFormat ThumbnailFormat) R) R {
+	switch value := thumbnailFormat.(type) {
+	case *ThumbnailFormatJpeg:
+		if cases.ThumbnailFormatJpeg != nil {
+			return cases.ThumbnailFormatJpeg(value)
+		}
+	case *ThumbnailFormatPng:
+		if cases.ThumbnailFormatPng != nil {
+			return cases.ThumbnailFormatPng(value)
+		}
+	case *ThumbnailFormatWebp:
+		if cases.ThumbnailFormatWebp != nil {
+			return cases.ThumbnailFormatWebp(value)
+		}
+	case *ThumbnailFormatGif:
+		if cases.ThumbnailFormatGif != nil {
+			return cases.ThumbnailFormatGif(value)
+		}
+	case *ThumbnailFormatTgs:
+		if cases.ThumbnailFormatTgs != nil {
+			return cases.ThumbnailFormatTgs(value)
+		}
+	case *ThumbnailFormatMpeg4:
+		if cases.ThumbnailFormatMpeg4 != nil {
+			return cases.ThumbnailFormatMpeg4(value)
+		}
+	}
+	return defaultCase(thumbnailFormat)
+}
+
+// Clone returns a deep copy of the object
+func (unknownThumbnailFormat *UnknownThumbnailFormat) Clone() *UnknownThumbnailFormat {
+	if unknownThumbnailFormat == nil {
+		return nil
+	}
+	return &UnknownThumbnailFormat{Unknown: unknownThumbnailFormat.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownThumbnailFormat *UnknownThumbnailFormat) Equal(other *UnknownThumbnailFormat) bool {
+	if unknownThumbnailFormat == nil || other == nil {
+		return unknownThumbnailFormat == other
+	}
+	return unknownThumbnailFormat.Unknown.equal(other.Unknown)
+}
+
+func cloneThumbnailFormat(thumbnailFormat ThumbnailFormat) ThumbnailFormat {
+	switch value := thumbnailFormat.(type) {
+	case *ThumbnailFormatJpeg:
+		return value.Clone()
+	case *ThumbnailFormatPng:
+		return value.Clone()
+	case *ThumbnailFormatWebp:
+		return value.Clone()
+	case *ThumbnailFormatGif:
+		return value.Clone()
+	case *ThumbnailFormatTgs:
+		return value.Clone()
+	case *ThumbnailFormatMpeg4:
+		return value.Clone()
+	case *UnknownThumbnailFormat:
+		return value.Clone()
+	}
+	return thumbnailFormat
+}
+
+func equalThumbnailFormat(a ThumbnailFormat, b ThumbnailFormat) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *ThumbnailFormatJpeg:
+		b, ok := b.(*ThumbnailFormatJpeg)
+		return ok && a.Equal(b)
+	case *ThumbnailFormatPng:
+		b, ok := b.(*ThumbnailFormatPng)
+		return ok && a.Equal(b)
+	case *ThumbnailFormatWebp:
+		b, ok := b.(*ThumbnailFormatWebp)
+		return ok && a.Equal(b)
+	case *ThumbnailFormatGif:
+		b, ok := b.(*ThumbnailFormatGif)
+		return ok && a.Equal(b)
+	case *ThumbnailFormatTgs:
+		b, ok := b.(*ThumbnailFormatTgs)
+		return ok && a.Equal(b)
+	case *ThumbnailFormatMpeg4:
+		b, ok := b.(*ThumbnailFormatMpeg4)
+		return ok && a.Equal(b)
+	case *UnknownThumbnailFormat:
+		b, ok := b.(*UnknownThumbnailFormat)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffThumbnailFormat(path string, a ThumbnailFormat, b ThumbnailFormat, changes []Change) []Change {
+	switch a := a.(type) {
+	case *ThumbnailFormatJpeg:
+		if b, ok := b.(*ThumbnailFormatJpeg); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ThumbnailFormatPng:
+		if b, ok := b.(*ThumbnailFormatPng); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ThumbnailFormatWebp:
+		if b, ok := b.(*ThumbnailFormatWebp); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ThumbnailFormatGif:
+		if b, ok := b.(*ThumbnailFormatGif); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ThumbnailFormatTgs:
+		if b, ok := b.(*ThumbnailFormatTgs); ok {
+			return a.diff(path, b, changes)
+		}
+	case *ThumbnailFormatMpeg4:
+		if b, ok := b.(*ThumbnailFormatMpeg4); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalThumbnailFormat(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
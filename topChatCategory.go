@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // TopChatCategory Represents the categories of chats for which a list of frequently used chats can be retrieved
 type TopChatCategory interface {
 	GetTopChatCategoryEnum() TopChatCategoryEnum
+	Accept(visitor TopChatCategoryVisitor)
 }
 
 // TopChatCategoryEnum Alias for abstract TopChatCategory 'Sub-Classes', used as constant-enum here
@@ -24,55 +26,98 @@ const (
 	TopChatCategoryForwardChatsType TopChatCategoryEnum = "topChatCategoryForwardChats"
 )
 
+// UnknownTopChatCategory is a TopChatCategory of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownTopChatCategory struct {
+	Unknown
+}
+
+// GetTopChatCategoryEnum return the enum type of this object
+func (unknownTopChatCategory *UnknownTopChatCategory) GetTopChatCategoryEnum() TopChatCategoryEnum {
+	return TopChatCategoryEnum(unknownTopChatCategory.Type)
+}
+
+// Accept calls the method of the visitor for UnknownTopChatCategory
+func (unknownTopChatCategory *UnknownTopChatCategory) Accept(visitor TopChatCategoryVisitor) {
+	visitor.VisitUnknownTopChatCategory(unknownTopChatCategory)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownTopChatCategory *UnknownTopChatCategory) String() string {
+	return objectString(unknownTopChatCategory)
+}
+
+// LogValue logs the object as its summary
+func (unknownTopChatCategory *UnknownTopChatCategory) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownTopChatCategory))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownTopChatCategory *UnknownTopChatCategory) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownTopChatCategory)
+}
+
+func (unknownTopChatCategory *UnknownTopChatCategory) printText(p *printer) {
+	if unknownTopChatCategory == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownTopChatCategory")
+	p.string("@type", unknownTopChatCategory.Type)
+	p.endObject(state)
+}
+
 func unmarshalTopChatCategory(rawMsg *json.RawMessage) (TopChatCategory, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch TopChatCategoryEnum(objMap["@type"].(string)) {
+	switch TopChatCategoryEnum(typeName) {
 	case TopChatCategoryUsersType:
 		var topChatCategoryUsers TopChatCategoryUsers
-		err := json.Unmarshal(*rawMsg, &topChatCategoryUsers)
+		err := jsonUnmarshal(*rawMsg, &topChatCategoryUsers)
 		return &topChatCategoryUsers, err
 
 	case TopChatCategoryBotsType:
 		var topChatCategoryBots TopChatCategoryBots
-		err := json.Unmarshal(*rawMsg, &topChatCategoryBots)
+		err := jsonUnmarshal(*rawMsg, &topChatCategoryBots)
 		return &topChatCategoryBots, err
 
 	case TopChatCategoryGroupsType:
 		var topChatCategoryGroups TopChatCategoryGroups
-		err := json.Unmarshal(*rawMsg, &topChatCategoryGroups)
+		err := jsonUnmarshal(*rawMsg, &topChatCategoryGroups)
 		return &topChatCategoryGroups, err
 
 	case TopChatCategoryChannelsType:
 		var topChatCategoryChannels TopChatCategoryChannels
-		err := json.Unmarshal(*rawMsg, &topChatCategoryChannels)
+		err := jsonUnmarshal(*rawMsg, &topChatCategoryChannels)
 		return &topChatCategoryChannels, err
 
 	case TopChatCategoryInlineBotsType:
 		var topChatCategoryInlineBots TopChatCategoryInlineBots
-		err := json.Unmarshal(*rawMsg, &topChatCategoryInlineBots)
+		err := jsonUnmarshal(*rawMsg, &topChatCategoryInlineBots)
 		return &topChatCategoryInlineBots, err
 
 	case TopChatCategoryCallsType:
 		var topChatCategoryCalls TopChatCategoryCalls
-		err := json.Unmarshal(*rawMsg, &topChatCategoryCalls)
+		err := jsonUnmarshal(*rawMsg, &topChatCategoryCalls)
 		return &topChatCategoryCalls, err
 
 	case TopChatCategoryForwardChatsType:
 		var topChatCategoryForwardChats TopChatCategoryForwardChats
-		err := json.Unmarshal(*rawMsg, &topChatCategoryForwardChats)
+		err := jsonUnmarshal(*rawMsg, &topChatCategoryForwardChats)
 		return &topChatCategoryForwardChats, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownTopChatCategory{Unknown: unknown}, nil
 	}
 }
 
@@ -87,7 +132,6 @@ func (topChatCategoryUsers *TopChatCategoryUsers) MessageType() string {
 }
 
 // NewTopChatCategoryUsers creates a new TopChatCategoryUsers
-//
 func NewTopChatCategoryUsers() *TopChatCategoryUsers {
 	topChatCategoryUsersTemp := TopChatCategoryUsers{
 		tdCommon: tdCommon{Type: "topChatCategoryUsers"},
@@ -96,11 +140,107 @@ func NewTopChatCategoryUsers() *TopChatCategoryUsers {
 	return &topChatCategoryUsersTemp
 }
 
+// MarshalJSON marshals to json
+func (topChatCategoryUsers *TopChatCategoryUsers) MarshalJSON() ([]byte, error) {
+	return topChatCategoryUsers.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (topChatCategoryUsers *TopChatCategoryUsers) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, topChatCategoryUsers)
+}
+
+func (topChatCategoryUsers *TopChatCategoryUsers) appendJSON(b []byte) ([]byte, error) {
+	if topChatCategoryUsers == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, topChatCategoryUsers.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, topChatCategoryUsers.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (topChatCategoryUsers *TopChatCategoryUsers) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			topChatCategoryUsers.tdCommon.Type, err = r.readString()
+		case "@extra":
+			topChatCategoryUsers.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (topChatCategoryUsers *TopChatCategoryUsers) Clone() *TopChatCategoryUsers {
+	if topChatCategoryUsers == nil {
+		return nil
+	}
+	clone := *topChatCategoryUsers
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (topChatCategoryUsers *TopChatCategoryUsers) Equal(other *TopChatCategoryUsers) bool {
+	if topChatCategoryUsers == nil || other == nil {
+		return topChatCategoryUsers == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (topChatCategoryUsers *TopChatCategoryUsers) Diff(other *TopChatCategoryUsers) []Change {
+	return topChatCategoryUsers.diff("", other, nil)
+}
+
+func (topChatCategoryUsers *TopChatCategoryUsers) diff(path string, other *TopChatCategoryUsers, changes []Change) []Change {
+	if topChatCategoryUsers == nil || other == nil {
+		if topChatCategoryUsers != other {
+			changes = append(changes, Change{Path: path, Old: topChatCategoryUsers, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (topChatCategoryUsers *TopChatCategoryUsers) String() string {
+	return objectString(topChatCategoryUsers)
+}
+
+// LogValue logs the object as its summary
+func (topChatCategoryUsers *TopChatCategoryUsers) LogValue() slog.Value {
+	return slog.StringValue(objectString(topChatCategoryUsers))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (topChatCategoryUsers *TopChatCategoryUsers) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, topChatCategoryUsers)
+}
+
+func (topChatCategoryUsers *TopChatCategoryUsers) printText(p *printer) {
+	if topChatCategoryUsers == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TopChatCategoryUsers")
+	p.endObject(state)
+}
+
 // GetTopChatCategoryEnum return the enum type of this object
 func (topChatCategoryUsers *TopChatCategoryUsers) GetTopChatCategoryEnum() TopChatCategoryEnum {
 	return TopChatCategoryUsersType
 }
 
+// Accept calls the method of the visitor for TopChatCategoryUsers
+func (topChatCategoryUsers *TopChatCategoryUsers) Accept(visitor TopChatCategoryVisitor) {
+	visitor.VisitTopChatCategoryUsers(topChatCategoryUsers)
+}
+
 // TopChatCategoryBots A category containing frequently used private chats with bot users
 type TopChatCategoryBots struct {
 	tdCommon
@@ -112,7 +252,6 @@ func (topChatCategoryBots *TopChatCategoryBots) MessageType() string {
 }
 
 // NewTopChatCategoryBots creates a new TopChatCategoryBots
-//
 func NewTopChatCategoryBots() *TopChatCategoryBots {
 	topChatCategoryBotsTemp := TopChatCategoryBots{
 		tdCommon: tdCommon{Type: "topChatCategoryBots"},
@@ -121,11 +260,107 @@ func NewTopChatCategoryBots() *TopChatCategoryBots {
 	return &topChatCategoryBotsTemp
 }
 
+// MarshalJSON marshals to json
+func (topChatCategoryBots *TopChatCategoryBots) MarshalJSON() ([]byte, error) {
+	return topChatCategoryBots.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (topChatCategoryBots *TopChatCategoryBots) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, topChatCategoryBots)
+}
+
+func (topChatCategoryBots *TopChatCategoryBots) appendJSON(b []byte) ([]byte, error) {
+	if topChatCategoryBots == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, topChatCategoryBots.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, topChatCategoryBots.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (topChatCategoryBots *TopChatCategoryBots) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			topChatCategoryBots.tdCommon.Type, err = r.readString()
+		case "@extra":
+			topChatCategoryBots.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (topChatCategoryBots *TopChatCategoryBots) Clone() *TopChatCategoryBots {
+	if topChatCategoryBots == nil {
+		return nil
+	}
+	clone := *topChatCategoryBots
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (topChatCategoryBots *TopChatCategoryBots) Equal(other *TopChatCategoryBots) bool {
+	if topChatCategoryBots == nil || other == nil {
+		return topChatCategoryBots == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (topChatCategoryBots *TopChatCategoryBots) Diff(other *TopChatCategoryBots) []Change {
+	return topChatCategoryBots.diff("", other, nil)
+}
+
+func (topChatCategoryBots *TopChatCategoryBots) diff(path string, other *TopChatCategoryBots, changes []Change) []Change {
+	if topChatCategoryBots == nil || other == nil {
+		if topChatCategoryBots != other {
+			changes = append(changes, Change{Path: path, Old: topChatCategoryBots, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (topChatCategoryBots *TopChatCategoryBots) String() string {
+	return objectString(topChatCategoryBots)
+}
+
+// LogValue logs the object as its summary
+func (topChatCategoryBots *TopChatCategoryBots) LogValue() slog.Value {
+	return slog.StringValue(objectString(topChatCategoryBots))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (topChatCategoryBots *TopChatCategoryBots) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, topChatCategoryBots)
+}
+
+func (topChatCategoryBots *TopChatCategoryBots) printText(p *printer) {
+	if topChatCategoryBots == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TopChatCategoryBots")
+	p.endObject(state)
+}
+
 // GetTopChatCategoryEnum return the enum type of this object
 func (topChatCategoryBots *TopChatCategoryBots) GetTopChatCategoryEnum() TopChatCategoryEnum {
 	return TopChatCategoryBotsType
 }
 
+// Accept calls the method of the visitor for TopChatCategoryBots
+func (topChatCategoryBots *TopChatCategoryBots) Accept(visitor TopChatCategoryVisitor) {
+	visitor.VisitTopChatCategoryBots(topChatCategoryBots)
+}
+
 // TopChatCategoryGroups A category containing frequently used basic groups and supergroups
 type TopChatCategoryGroups struct {
 	tdCommon
@@ -137,7 +372,6 @@ func (topChatCategoryGroups *TopChatCategoryGroups) MessageType() string {
 }
 
 // NewTopChatCategoryGroups creates a new TopChatCategoryGroups
-//
 func NewTopChatCategoryGroups() *TopChatCategoryGroups {
 	topChatCategoryGroupsTemp := TopChatCategoryGroups{
 		tdCommon: tdCommon{Type: "topChatCategoryGroups"},
@@ -146,11 +380,107 @@ func NewTopChatCategoryGroups() *TopChatCategoryGroups {
 	return &topChatCategoryGroupsTemp
 }
 
+// MarshalJSON marshals to json
+func (topChatCategoryGroups *TopChatCategoryGroups) MarshalJSON() ([]byte, error) {
+	return topChatCategoryGroups.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (topChatCategoryGroups *TopChatCategoryGroups) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, topChatCategoryGroups)
+}
+
+func (topChatCategoryGroups *TopChatCategoryGroups) appendJSON(b []byte) ([]byte, error) {
+	if topChatCategoryGroups == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, topChatCategoryGroups.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, topChatCategoryGroups.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (topChatCategoryGroups *TopChatCategoryGroups) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			topChatCategoryGroups.tdCommon.Type, err = r.readString()
+		case "@extra":
+			topChatCategoryGroups.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (topChatCategoryGroups *TopChatCategoryGroups) Clone() *TopChatCategoryGroups {
+	if topChatCategoryGroups == nil {
+		return nil
+	}
+	clone := *topChatCategoryGroups
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (topChatCategoryGroups *TopChatCategoryGroups) Equal(other *TopChatCategoryGroups) bool {
+	if topChatCategoryGroups == nil || other == nil {
+		return topChatCategoryGroups == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (topChatCategoryGroups *TopChatCategoryGroups) Diff(other *TopChatCategoryGroups) []Change {
+	return topChatCategoryGroups.diff("", other, nil)
+}
+
+func (topChatCategoryGroups *TopChatCategoryGroups) diff(path string, other *TopChatCategoryGroups, changes []Change) []Change {
+	if topChatCategoryGroups == nil || other == nil {
+		if topChatCategoryGroups != other {
+			changes = append(changes, Change{Path: path, Old: topChatCategoryGroups, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (topChatCategoryGroups *TopChatCategoryGroups) String() string {
+	return objectString(topChatCategoryGroups)
+}
+
+// LogValue logs the object as its summary
+func (topChatCategoryGroups *TopChatCategoryGroups) LogValue() slog.Value {
+	return slog.StringValue(objectString(topChatCategoryGroups))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (topChatCategoryGroups *TopChatCategoryGroups) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, topChatCategoryGroups)
+}
+
+func (topChatCategoryGroups *TopChatCategoryGroups) printText(p *printer) {
+	if topChatCategoryGroups == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TopChatCategoryGroups")
+	p.endObject(state)
+}
+
 // GetTopChatCategoryEnum return the enum type of this object
 func (topChatCategoryGroups *TopChatCategoryGroups) GetTopChatCategoryEnum() TopChatCategoryEnum {
 	return TopChatCategoryGroupsType
 }
 
+// Accept calls the method of the visitor for TopChatCategoryGroups
+func (topChatCategoryGroups *TopChatCategoryGroups) Accept(visitor TopChatCategoryVisitor) {
+	visitor.VisitTopChatCategoryGroups(topChatCategoryGroups)
+}
+
 // TopChatCategoryChannels A category containing frequently used channels
 type TopChatCategoryChannels struct {
 	tdCommon
@@ -162,7 +492,6 @@ func (topChatCategoryChannels *TopChatCategoryChannels) MessageType() string {
 }
 
 // NewTopChatCategoryChannels creates a new TopChatCategoryChannels
-//
 func NewTopChatCategoryChannels() *TopChatCategoryChannels {
 	topChatCategoryChannelsTemp := TopChatCategoryChannels{
 		tdCommon: tdCommon{Type: "topChatCategoryChannels"},
@@ -171,11 +500,107 @@ func NewTopChatCategoryChannels() *TopChatCategoryChannels {
 	return &topChatCategoryChannelsTemp
 }
 
+// MarshalJSON marshals to json
+func (topChatCategoryChannels *TopChatCategoryChannels) MarshalJSON() ([]byte, error) {
+	return topChatCategoryChannels.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (topChatCategoryChannels *TopChatCategoryChannels) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, topChatCategoryChannels)
+}
+
+func (topChatCategoryChannels *TopChatCategoryChannels) appendJSON(b []byte) ([]byte, error) {
+	if topChatCategoryChannels == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, topChatCategoryChannels.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, topChatCategoryChannels.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (topChatCategoryChannels *TopChatCategoryChannels) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			topChatCategoryChannels.tdCommon.Type, err = r.readString()
+		case "@extra":
+			topChatCategoryChannels.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (topChatCategoryChannels *TopChatCategoryChannels) Clone() *TopChatCategoryChannels {
+	if topChatCategoryChannels == nil {
+		return nil
+	}
+	clone := *topChatCategoryChannels
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (topChatCategoryChannels *TopChatCategoryChannels) Equal(other *TopChatCategoryChannels) bool {
+	if topChatCategoryChannels == nil || other == nil {
+		return topChatCategoryChannels == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (topChatCategoryChannels *TopChatCategoryChannels) Diff(other *TopChatCategoryChannels) []Change {
+	return topChatCategoryChannels.diff("", other, nil)
+}
+
+func (topChatCategoryChannels *TopChatCategoryChannels) diff(path string, other *TopChatCategoryChannels, changes []Change) []Change {
+	if topChatCategoryChannels == nil || other == nil {
+		if topChatCategoryChannels != other {
+			changes = append(changes, Change{Path: path, Old: topChatCategoryChannels, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (topChatCategoryChannels *TopChatCategoryChannels) String() string {
+	return objectString(topChatCategoryChannels)
+}
+
+// LogValue logs the object as its summary
+func (topChatCategoryChannels *TopChatCategoryChannels) LogValue() slog.Value {
+	return slog.StringValue(objectString(topChatCategoryChannels))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (topChatCategoryChannels *TopChatCategoryChannels) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, topChatCategoryChannels)
+}
+
+func (topChatCategoryChannels *TopChatCategoryChannels) printText(p *printer) {
+	if topChatCategoryChannels == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TopChatCategoryChannels")
+	p.endObject(state)
+}
+
 // GetTopChatCategoryEnum return the enum type of this object
 func (topChatCategoryChannels *TopChatCategoryChannels) GetTopChatCategoryEnum() TopChatCategoryEnum {
 	return TopChatCategoryChannelsType
 }
 
+// Accept calls the method of the visitor for TopChatCategoryChannels
+func (topChatCategoryChannels *TopChatCategoryChannels) Accept(visitor TopChatCategoryVisitor) {
+	visitor.VisitTopChatCategoryChannels(topChatCategoryChannels)
+}
+
 // TopChatCategoryInlineBots A category containing frequently used chats with inline bots sorted by their usage in inline mode
 type TopChatCategoryInlineBots struct {
 	tdCommon
@@ -187,7 +612,6 @@ func (topChatCategoryInlineBots *TopChatCategoryInlineBots) MessageType() string
 }
 
 // NewTopChatCategoryInlineBots creates a new TopChatCategoryInlineBots
-//
 func NewTopChatCategoryInlineBots() *TopChatCategoryInlineBots {
 	topChatCategoryInlineBotsTemp := TopChatCategoryInlineBots{
 		tdCommon: tdCommon{Type: "topChatCategoryInlineBots"},
@@ -196,11 +620,107 @@ func NewTopChatCategoryInlineBots() *TopChatCategoryInlineBots {
 	return &topChatCategoryInlineBotsTemp
 }
 
+// MarshalJSON marshals to json
+func (topChatCategoryInlineBots *TopChatCategoryInlineBots) MarshalJSON() ([]byte, error) {
+	return topChatCategoryInlineBots.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (topChatCategoryInlineBots *TopChatCategoryInlineBots) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, topChatCategoryInlineBots)
+}
+
+func (topChatCategoryInlineBots *TopChatCategoryInlineBots) appendJSON(b []byte) ([]byte, error) {
+	if topChatCategoryInlineBots == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, topChatCategoryInlineBots.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, topChatCategoryInlineBots.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (topChatCategoryInlineBots *TopChatCategoryInlineBots) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			topChatCategoryInlineBots.tdCommon.Type, err = r.readString()
+		case "@extra":
+			topChatCategoryInlineBots.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (topChatCategoryInlineBots *TopChatCategoryInlineBots) Clone() *TopChatCategoryInlineBots {
+	if topChatCategoryInlineBots == nil {
+		return nil
+	}
+	clone := *topChatCategoryInlineBots
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (topChatCategoryInlineBots *TopChatCategoryInlineBots) Equal(other *TopChatCategoryInlineBots) bool {
+	if topChatCategoryInlineBots == nil || other == nil {
+		return topChatCategoryInlineBots == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (topChatCategoryInlineBots *TopChatCategoryInlineBots) Diff(other *TopChatCategoryInlineBots) []Change {
+	return topChatCategoryInlineBots.diff("", other, nil)
+}
+
+func (topChatCategoryInlineBots *TopChatCategoryInlineBots) diff(path string, other *TopChatCategoryInlineBots, changes []Change) []Change {
+	if topChatCategoryInlineBots == nil || other == nil {
+		if topChatCategoryInlineBots != other {
+			changes = append(changes, Change{Path: path, Old: topChatCategoryInlineBots, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (topChatCategoryInlineBots *TopChatCategoryInlineBots) String() string {
+	return objectString(topChatCategoryInlineBots)
+}
+
+// LogValue logs the object as its summary
+func (topChatCategoryInlineBots *TopChatCategoryInlineBots) LogValue() slog.Value {
+	return slog.StringValue(objectString(topChatCategoryInlineBots))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (topChatCategoryInlineBots *TopChatCategoryInlineBots) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, topChatCategoryInlineBots)
+}
+
+func (topChatCategoryInlineBots *TopChatCategoryInlineBots) printText(p *printer) {
+	if topChatCategoryInlineBots == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TopChatCategoryInlineBots")
+	p.endObject(state)
+}
+
 // GetTopChatCategoryEnum return the enum type of this object
 func (topChatCategoryInlineBots *TopChatCategoryInlineBots) GetTopChatCategoryEnum() TopChatCategoryEnum {
 	return TopChatCategoryInlineBotsType
 }
 
+// Accept calls the method of the visitor for TopChatCategoryInlineBots
+func (topChatCategoryInlineBots *TopChatCategoryInlineBots) Accept(visitor TopChatCategoryVisitor) {
+	visitor.VisitTopChatCategoryInlineBots(topChatCategoryInlineBots)
+}
+
 // TopChatCategoryCalls A category containing frequently used chats used for calls
 type TopChatCategoryCalls struct {
 	tdCommon
@@ -212,7 +732,6 @@ func (topChatCategoryCalls *TopChatCategoryCalls) MessageType() string {
 }
 
 // NewTopChatCategoryCalls creates a new TopChatCategoryCalls
-//
 func NewTopChatCategoryCalls() *TopChatCategoryCalls {
 	topChatCategoryCallsTemp := TopChatCategoryCalls{
 		tdCommon: tdCommon{Type: "topChatCategoryCalls"},
@@ -221,11 +740,107 @@ func NewTopChatCategoryCalls() *TopChatCategoryCalls {
 	return &topChatCategoryCallsTemp
 }
 
+// MarshalJSON marshals to json
+func (topChatCategoryCalls *TopChatCategoryCalls) MarshalJSON() ([]byte, error) {
+	return topChatCategoryCalls.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (topChatCategoryCalls *TopChatCategoryCalls) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, topChatCategoryCalls)
+}
+
+func (topChatCategoryCalls *TopChatCategoryCalls) appendJSON(b []byte) ([]byte, error) {
+	if topChatCategoryCalls == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, topChatCategoryCalls.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, topChatCategoryCalls.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (topChatCategoryCalls *TopChatCategoryCalls) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			topChatCategoryCalls.tdCommon.Type, err = r.readString()
+		case "@extra":
+			topChatCategoryCalls.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (topChatCategoryCalls *TopChatCategoryCalls) Clone() *TopChatCategoryCalls {
+	if topChatCategoryCalls == nil {
+		return nil
+	}
+	clone := *topChatCategoryCalls
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (topChatCategoryCalls *TopChatCategoryCalls) Equal(other *TopChatCategoryCalls) bool {
+	if topChatCategoryCalls == nil || other == nil {
+		return topChatCategoryCalls == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (topChatCategoryCalls *TopChatCategoryCalls) Diff(other *TopChatCategoryCalls) []Change {
+	return topChatCategoryCalls.diff("", other, nil)
+}
+
+func (topChatCategoryCalls *TopChatCategoryCalls) diff(path string, other *TopChatCategoryCalls, changes []Change) []Change {
+	if topChatCategoryCalls == nil || other == nil {
+		if topChatCategoryCalls != other {
+			changes = append(changes, Change{Path: path, Old: topChatCategoryCalls, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (topChatCategoryCalls *TopChatCategoryCalls) String() string {
+	return objectString(topChatCategoryCalls)
+}
+
+// LogValue logs the object as its summary
+func (topChatCategoryCalls *TopChatCategoryCalls) LogValue() slog.Value {
+	return slog.StringValue(objectString(topChatCategoryCalls))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (topChatCategoryCalls *TopChatCategoryCalls) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, topChatCategoryCalls)
+}
+
+func (topChatCategoryCalls *TopChatCategoryCalls) printText(p *printer) {
+	if topChatCategoryCalls == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TopChatCategoryCalls")
+	p.endObject(state)
+}
+
 // GetTopChatCategoryEnum return the enum type of this object
 func (topChatCategoryCalls *TopChatCategoryCalls) GetTopChatCategoryEnum() TopChatCategoryEnum {
 	return TopChatCategoryCallsType
 }
 
+// Accept calls the method of the visitor for TopChatCategoryCalls
+func (topChatCategoryCalls *TopChatCategoryCalls) Accept(visitor TopChatCategoryVisitor) {
+	visitor.VisitTopChatCategoryCalls(topChatCategoryCalls)
+}
+
 // TopChatCategoryForwardChats A category containing frequently used chats used to forward messages
 type TopChatCategoryForwardChats struct {
 	tdCommon
@@ -237,7 +852,6 @@ func (topChatCategoryForwardChats *TopChatCategoryForwardChats) MessageType() st
 }
 
 // NewTopChatCategoryForwardChats creates a new TopChatCategoryForwardChats
-//
 func NewTopChatCategoryForwardChats() *TopChatCategoryForwardChats {
 	topChatCategoryForwardChatsTemp := TopChatCategoryForwardChats{
 		tdCommon: tdCommon{Type: "topChatCategoryForwardChats"},
@@ -246,7 +860,273 @@ func NewTopChatCategoryForwardChats() *TopChatCategoryForwardChats {
 	return &topChatCategoryForwardChatsTemp
 }
 
+// MarshalJSON marshals to json
+func (topChatCategoryForwardChats *TopChatCategoryForwardChats) MarshalJSON() ([]byte, error) {
+	return topChatCategoryForwardChats.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (topChatCategoryForwardChats *TopChatCategoryForwardChats) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, topChatCategoryForwardChats)
+}
+
+func (topChatCategoryForwardChats *TopChatCategoryForwardChats) appendJSON(b []byte) ([]byte, error) {
+	if topChatCategoryForwardChats == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, topChatCategoryForwardChats.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, topChatCategoryForwardChats.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (topChatCategoryForwardChats *TopChatCategoryForwardChats) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			topChatCategoryForwardChats.tdCommon.Type, err = r.readString()
+		case "@extra":
+			topChatCategoryForwardChats.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (topChatCategoryForwardChats *TopChatCategoryForwardChats) Clone() *TopChatCategoryForwardChats {
+	if topChatCategoryForwardChats == nil {
+		return nil
+	}
+	clone := *topChatCategoryForwardChats
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (topChatCategoryForwardChats *TopChatCategoryForwardChats) Equal(other *TopChatCategoryForwardChats) bool {
+	if topChatCategoryForwardChats == nil || other == nil {
+		return topChatCategoryForwardChats == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (topChatCategoryForwardChats *TopChatCategoryForwardChats) Diff(other *TopChatCategoryForwardChats) []Change {
+	return topChatCategoryForwardChats.diff("", other, nil)
+}
+
+func (topChatCategoryForwardChats *TopChatCategoryForwardChats) diff(path string, other *TopChatCategoryForwardChats, changes []Change) []Change {
+	if topChatCategoryForwardChats == nil || other == nil {
+		if topChatCategoryForwardChats != other {
+			changes = append(changes, Change{Path: path, Old: topChatCategoryForwardChats, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (topChatCategoryForwardChats *TopChatCategoryForwardChats) String() string {
+	return objectString(topChatCategoryForwardChats)
+}
+
+// LogValue logs the object as its summary
+func (topChatCategoryForwardChats *TopChatCategoryForwardChats) LogValue() slog.Value {
+	return slog.StringValue(objectString(topChatCategoryForwardChats))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (topChatCategoryForwardChats *TopChatCategoryForwardChats) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, topChatCategoryForwardChats)
+}
+
+func (topChatCategoryForwardChats *TopChatCategoryForwardChats) printText(p *printer) {
+	if topChatCategoryForwardChats == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("TopChatCategoryForwardChats")
+	p.endObject(state)
+}
+
 // GetTopChatCategoryEnum return the enum type of this object
 func (topChatCategoryForwardChats *TopChatCategoryForwardChats) GetTopChatCategoryEnum() TopChatCategoryEnum {
 	return TopChatCategoryForwardChatsType
 }
+
+// Accept calls the method of the visitor for TopChatCategoryForwardChats
+func (topChatCategoryForwardChats *TopChatCategoryForwardChats) Accept(visitor TopChatCategoryVisitor) {
+	visitor.VisitTopChatCategoryForwardChats(topChatCategoryForwardChats)
+}
+
+// TopChatCategoryVisitor visits every type of TopChatCategory, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to TopChatCategory, until they handle it too.
+type TopChatCategoryVisitor interface {
+	VisitTopChatCategoryUsers(topChatCategoryUsers *TopChatCategoryUsers)
+	VisitTopChatCategoryBots(topChatCategoryBots *TopChatCategoryBots)
+	VisitTopChatCategoryGroups(topChatCategoryGroups *TopChatCategoryGroups)
+	VisitTopChatCategoryChannels(topChatCategoryChannels *TopChatCategoryChannels)
+	VisitTopChatCategoryInlineBots(topChatCategoryInlineBots *TopChatCategoryInlineBots)
+	VisitTopChatCategoryCalls(topChatCategoryCalls *TopChatCategoryCalls)
+	VisitTopChatCategoryForwardChats(topChatCategoryForwardChats *TopChatCategoryForwardChats)
+	VisitUnknownTopChatCategory(unknownTopChatCategory *UnknownTopChatCategory)
+}
+
+// TopChatCategoryCases holds the function MatchTopChatCategory calls for each type of TopChatCategory;
+// types without a function are passed to the default case
+type TopChatCategoryCases[R any] struct {
+	TopChatCategoryUsers        func(topChatCategoryUsers *TopChatCategoryUsers) R
+	TopChatCategoryBots         func(topChatCategoryBots *TopChatCategoryBots) R
+	TopChatCategoryGroups       func(topChatCategoryGroups *TopChatCategoryGroups) R
+	TopChatCategoryChannels     func(topChatCategoryChannels *TopChatCategoryChannels) R
+	TopChatCategoryInlineBots   func(topChatCategoryInlineBots *TopChatCategoryInlineBots) R
+	TopChatCategoryCalls        func(topChatCategoryCalls *TopChatCategoryCalls) R
+	TopChatCategoryForwardChats func(topChatCategoryForwardChats *TopChatCategoryForwardChats) R
+}
+
+// MatchTopChatCategory calls the case of the type of topChatCategory, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement TopChatCategoryVisitor
+// to have the compiler check every type is handled.
+func MatchTopChatCategory[R any](topChatCategory TopChatCategory, cases TopChatCategoryCases[R], defaultCase func(topChatCategory TopChatCategory) R) R {
+	switch value := topChatCategory.(type) {
+	case *TopChatCategoryUsers:
+		if cases.TopChatCategoryUsers != nil {
+			return cases.TopChatCategoryUsers(value)
+		}
+	case *TopChatCategoryBots:
+		if cases.TopChatCategoryBots != nil {
+			return cases.TopChatCategoryBots(value)
+		}
+	case *TopChatCategoryGroups:
+		if cases.TopChatCategoryGroups != nil {
+			return cases.TopChatCategoryGroups(value)
+		}
+	case *TopChatCategoryChannels:
+		if cases.TopChatCategoryChannels != nil {
+			return cases.TopChatCategoryChannels(value)
+		}
+	case *TopChatCategoryInlineBots:
+		if cases.TopChatCategoryInlineBots != nil {
+			return cases.TopChatCategoryInlineBots(value)
+		}
+	case *TopChatCategoryCalls:
+		if cases.TopChatCategoryCalls != nil {
+			return cases.TopChatCategoryCalls(value)
+		}
+	case *TopChatCategoryForwardChats:
+		if cases.TopChatCategoryForwardChats != nil {
+			return cases.TopChatCategoryForwardChats(value)
+		}
+	}
+	return defaultCase(topChatCategory)
+}
+
+// Clone returns a deep copy of the object
+func (unknownTopChatCategory *UnknownTopChatCategory) Clone() *UnknownTopChatCategory {
+	if unknownTopChatCategory == nil {
+		return nil
+	}
+	return &UnknownTopChatCategory{Unknown: unknownTopChatCategory.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownTopChatCategory *UnknownTopChatCategory) Equal(other *UnknownTopChatCategory) bool {
+	if unknownTopChatCategory == nil || other == nil {
+		return unknownTopChatCategory == other
+	}
+	return unknownTopChatCategory.Unknown.equal(other.Unknown)
+}
+
+func cloneTopChatCategory(topChatCategory TopChatCategory) TopChatCategory {
+	switch value := topChatCategory.(type) {
+	case *TopChatCategoryUsers:
+		return value.Clone()
+	case *TopChatCategoryBots:
+		return value.Clone()
+	case *TopChatCategoryGroups:
+		return value.Clone()
+	case *TopChatCategoryChannels:
+		return value.Clone()
+	case *TopChatCategoryInlineBots:
+		return value.Clone()
+	case *TopChatCategoryCalls:
+		return value.Clone()
+	case *TopChatCategoryForwardChats:
+		return value.Clone()
+	case *UnknownTopChatCategory:
+		return value.Clone()
+	}
+	return topChatCategory
+}
+
+func equalTopChatCategory(a TopChatCategory, b TopChatCategory) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *TopChatCategoryUsers:
+		b, ok := b.(*TopChatCategoryUsers)
+		return ok && a.Equal(b)
+	case *TopChatCategoryBots:
+		b, ok := b.(*TopChatCategoryBots)
+		return ok && a.Equal(b)
+	case *TopChatCategoryGroups:
+		b, ok := b.(*TopChatCategoryGroups)
+		return ok && a.Equal(b)
+	case *TopChatCategoryChannels:
+		b, ok := b.(*TopChatCategoryChannels)
+		return ok && a.Equal(b)
+	case *TopChatCategoryInlineBots:
+		b, ok := b.(*TopChatCategoryInlineBots)
+		return ok && a.Equal(b)
+	case *TopChatCategoryCalls:
+		b, ok := b.(*TopChatCategoryCalls)
+		return ok && a.Equal(b)
+	case *TopChatCategoryForwardChats:
+		b, ok := b.(*TopChatCategoryForwardChats)
+		return ok && a.Equal(b)
+	case *UnknownTopChatCategory:
+		b, ok := b.(*UnknownTopChatCategory)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffTopChatCategory(path string, a TopChatCategory, b TopChatCategory, changes []Change) []Change {
+	switch a := a.(type) {
+	case *TopChatCategoryUsers:
+		if b, ok := b.(*TopChatCategoryUsers); ok {
+			return a.diff(path, b, changes)
+		}
+	case *TopChatCategoryBots:
+		if b, ok := b.(*TopChatCategoryBots); ok {
+			return a.diff(path, b, changes)
+		}
+	case *TopChatCategoryGroups:
+		if b, ok := b.(*TopChatCategoryGroups); ok {
+			return a.diff(path, b, changes)
+		}
+	case *TopChatCategoryChannels:
+		if b, ok := b.(*TopChatCategoryChannels); ok {
+			return a.diff(path, b, changes)
+		}
+	case *TopChatCategoryInlineBots:
+		if b, ok := b.(*TopChatCategoryInlineBots); ok {
+			return a.diff(path, b, changes)
+		}
+	case *TopChatCategoryCalls:
+		if b, ok := b.(*TopChatCategoryCalls); ok {
+			return a.diff(path, b, changes)
+		}
+	case *TopChatCategoryForwardChats:
+		if b, ok := b.(*TopChatCategoryForwardChats); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalTopChatCategory(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -0,0 +1,193 @@
+package tdlib
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Span is a tracing span started by a Tracer
+type Span interface {
+	// SetAttribute sets an attribute of the span, e.g. chat_id or message_id
+	SetAttribute(key string, value int64)
+
+	// End ends the span. errorCode is 0 on success, the TDLib error code on error, ErrorCodeTimeout on timeout and
+	// ErrorCodeCanceled when the context of the request was done; err describes the failure, if any.
+	End(errorCode int, err error)
+}
+
+// Tracer starts tracing spans around TDLib requests and the updates they cause, see SetTracer.
+// It allows to plug OpenTelemetry or any other tracing library into the client.
+type Tracer interface {
+	// StartRequest starts the span of a request sent with SendAndCatchContext, as a child of the span in ctx
+	StartRequest(ctx context.Context, method string) Span
+
+	// StartUpdate starts the span of the dispatch of an update to the event receivers.
+	// cause is the span of the request which caused the update,
+	// e.g. sendMessage for updateMessageSendSucceeded, or nil if it isn't known.
+	StartUpdate(updateType UpdateEnum, cause Span) Span
+}
+
+// tracerHolder wraps a Tracer so it can be stored in an atomic.Value
+type tracerHolder struct {
+	tracer Tracer
+}
+
+// sendingSpanTTL is how long the span of a request is remembered as the cause of the updateMessageSendSucceeded or
+// updateMessageSendFailed of the messages it sent; updates which never come, e.g. because the client was closed,
+// don't keep it forever
+const sendingSpanTTL = 10 * time.Minute
+
+// maxSendingSpans is the number of remembered spans above which expired ones are removed
+const maxSendingSpans = 1000
+
+// sendingSpan is the span of the request which sent a message still being sent, remembered until it expires
+type sendingSpan struct {
+	span    Span
+	expires time.Time
+}
+
+// requestSpanAttributes are the request parameters added as attributes to request spans
+var requestSpanAttributes = []string{"chat_id", "message_id", "user_id"}
+
+// SetTracer sets the Tracer which traces the client requests and updates. Pass nil to disable tracing.
+func (client *Client) SetTracer(tracer Tracer) {
+	client.tracer.Store(tracerHolder{tracer: tracer})
+}
+
+// getTracer returns the Tracer set with SetTracer, or nil
+func (client *Client) getTracer() Tracer {
+	holder, _ := client.tracer.Load().(tracerHolder)
+	return holder.tracer
+}
+
+// startRequestSpan starts the span of a request, or returns nil if tracing is disabled
+func (client *Client) startRequestSpan(ctx context.Context, request UpdateData) Span {
+	tracer := client.getTracer()
+	if tracer == nil {
+		return nil
+	}
+
+	method, _ := request["@type"].(string)
+	span := tracer.StartRequest(ctx, method)
+	for _, key := range requestSpanAttributes {
+		if value, ok := int64Value(request[key]); ok {
+			span.SetAttribute(key, value)
+		}
+	}
+	return span
+}
+
+// endRequestSpan ends the span of a request
+func (client *Client) endRequestSpan(span Span, response UpdateMsg, err error) {
+	if span == nil {
+		return
+	}
+
+	if err != nil {
+		errorCode := ErrorCodeTimeout
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			errorCode = ErrorCodeCanceled
+		}
+		span.End(errorCode, err)
+		return
+	}
+
+	errorCode := responseErrorCode(response)
+	if errorCode != 0 {
+		span.End(errorCode, fmt.Errorf("error! code: %v msg: %s", response.Data["code"], response.Data["message"]))
+		return
+	}
+	span.End(0, nil)
+}
+
+// rememberSendingMessages remembers the span of a request as the cause of the updates of the messages being sent
+// in its response. It's called by the receive loop before the response is passed on, so the span is known when
+// the updates are received.
+func (client *Client) rememberSendingMessages(span Span, response UpdateData) {
+	if span == nil {
+		return
+	}
+
+	switch response["@type"] {
+	case "message":
+		client.rememberSendingMessage(span, response)
+	case "messages":
+		if messages, ok := response["messages"].([]interface{}); ok {
+			for _, message := range messages {
+				if messageData, ok := message.(map[string]interface{}); ok {
+					client.rememberSendingMessage(span, messageData)
+				}
+			}
+		}
+	}
+}
+
+// rememberSendingMessage remembers the span of the request which sent a message still being sent
+func (client *Client) rememberSendingMessage(span Span, message map[string]interface{}) {
+	if message["sending_state"] == nil {
+		return
+	}
+	if id, ok := int64Value(message["id"]); ok {
+		now := time.Now()
+		client.sendingSpansLock.Lock()
+		if len(client.sendingSpans) >= maxSendingSpans {
+			for id, sending := range client.sendingSpans {
+				if now.After(sending.expires) {
+					delete(client.sendingSpans, id)
+				}
+			}
+		}
+		client.sendingSpans[id] = sendingSpan{span: span, expires: now.Add(sendingSpanTTL)}
+		client.sendingSpansLock.Unlock()
+	}
+}
+
+// startUpdateSpan starts the span of an update dispatch, or returns nil if tracing is disabled
+func (client *Client) startUpdateSpan(update TdMessage) Span {
+	tracer := client.getTracer()
+	if tracer == nil || update == nil {
+		return nil
+	}
+
+	var oldMessageID int64
+	switch update := update.(type) {
+	case *UpdateMessageSendSucceeded:
+		oldMessageID = update.OldMessageId
+	case *UpdateMessageSendFailed:
+		oldMessageID = update.OldMessageId
+	}
+
+	var cause Span
+	if oldMessageID != 0 {
+		client.sendingSpansLock.Lock()
+		if sending, ok := client.sendingSpans[oldMessageID]; ok && time.Now().Before(sending.expires) {
+			cause = sending.span
+		}
+		delete(client.sendingSpans, oldMessageID)
+		client.sendingSpansLock.Unlock()
+	}
+	return tracer.StartUpdate(UpdateEnum(update.MessageType()), cause)
+}
+
+// int64Value converts a numeric request or response field to int64
+func int64Value(value interface{}) (int64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return v, true
+	case int32:
+		return int64(v), true
+	case int:
+		return int64(v), true
+	case JSONInt64:
+		return int64(v), true
+	case float64:
+		return int64(v), true
+	case json.Number:
+		i, err := v.Int64()
+		return i, err == nil
+	}
+	return 0, false
+}
@@ -0,0 +1,105 @@
+package tdlib
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeSpan is a Span recording how it ended
+type fakeSpan struct {
+	name      string
+	lock      sync.Mutex
+	cause     *fakeSpan
+	ended     bool
+	errorCode int
+}
+
+func (span *fakeSpan) SetAttribute(key string, value int64) {}
+
+func (span *fakeSpan) End(errorCode int, err error) {
+	span.lock.Lock()
+	defer span.lock.Unlock()
+	span.ended, span.errorCode = true, errorCode
+}
+
+// fakeTracer is a Tracer recording the spans it starts
+type fakeTracer struct {
+	lock  sync.Mutex
+	spans []*fakeSpan
+}
+
+func (tracer *fakeTracer) StartRequest(ctx context.Context, method string) Span {
+	return tracer.start(method, nil)
+}
+
+func (tracer *fakeTracer) StartUpdate(updateType UpdateEnum, cause Span) Span {
+	causeSpan, _ := cause.(*fakeSpan)
+	return tracer.start(string(updateType), causeSpan)
+}
+
+func (tracer *fakeTracer) start(name string, cause *fakeSpan) *fakeSpan {
+	tracer.lock.Lock()
+	defer tracer.lock.Unlock()
+	span := &fakeSpan{name: name, cause: cause}
+	tracer.spans = append(tracer.spans, span)
+	return span
+}
+
+// span returns the first span with the name, or nil
+func (tracer *fakeTracer) span(name string) *fakeSpan {
+	tracer.lock.Lock()
+	defer tracer.lock.Unlock()
+	for _, span := range tracer.spans {
+		if span.name == name {
+			return span
+		}
+	}
+	return nil
+}
+
+func TestTracerSendResultCause(t *testing.T) {
+	// the result is received right after the response, before SendAndCatch gets the response
+	client := NewClientWithTransport(Config{}, sendMessageTransport(0, sendSucceeded))
+	tracer := &fakeTracer{}
+	client.SetTracer(tracer)
+	receiver := client.AddEventReceiver(&UpdateMessageSendSucceeded{}, acceptUpdate, 1)
+
+	_, err := client.SendAndCatchContext(context.Background(), UpdateData{
+		"@type":                 "sendMessage",
+		"chat_id":               5,
+		"input_message_content": NewInputMessageText(NewFormattedText("hi", nil), false, false),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-receiver.Chan
+
+	request := tracer.span("sendMessage")
+	update := tracer.span(string(UpdateMessageSendSucceededType))
+	if request == nil || update == nil {
+		t.Fatalf("spans are %v, want the request and the update", tracer.spans)
+	}
+	if update.cause != request {
+		t.Errorf("update span has the cause %v, want the request span", update.cause)
+	}
+}
+
+func TestTracerCanceledRequest(t *testing.T) {
+	// requests are never answered
+	transport := newFakeTransport(func(request map[string]interface{}) []map[string]interface{} { return nil })
+	client := NewClientWithTransport(Config{}, transport)
+	tracer := &fakeTracer{}
+	client.SetTracer(tracer)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.SendAndCatchContext(ctx, UpdateData{"@type": "getMe"}); err == nil {
+		t.Fatal("SendAndCatchContext returns no error")
+	}
+	span := tracer.span("getMe")
+	if span == nil || !span.ended || span.errorCode != ErrorCodeCanceled {
+		t.Errorf("request span is %+v, want it ended with ErrorCodeCanceled", span)
+	}
+}
@@ -0,0 +1,49 @@
+package tdlib
+
+//#include <stdlib.h>
+//#include <td/telegram/td_json_client.h>
+import "C"
+
+import (
+	"unsafe"
+)
+
+// Transport carries the JSON requests and updates between a Client and TDLib
+type Transport interface {
+	// Send sends a request
+	Send(query []byte)
+
+	// Receive receives an update or a request response, waiting for up to timeout seconds.
+	// Returns an empty slice if nothing was received in time.
+	Receive(timeout float64) []byte
+
+	// Destroy releases the transport, it mustn't be used anymore afterwards.
+	Destroy()
+}
+
+// tdjsonTransport is the Transport of a TDLib JSON client instance
+type tdjsonTransport struct {
+	client unsafe.Pointer
+}
+
+// newTdjsonTransport creates a TDLib JSON client instance and its transport
+func newTdjsonTransport() *tdjsonTransport {
+	return &tdjsonTransport{client: C.td_json_client_create()}
+}
+
+func (transport *tdjsonTransport) Send(query []byte) {
+	cQuery := C.CString(string(query))
+	defer C.free(unsafe.Pointer(cQuery))
+
+	C.td_json_client_send(transport.client, cQuery)
+}
+
+func (transport *tdjsonTransport) Receive(timeout float64) []byte {
+	result := C.td_json_client_receive(transport.client, C.double(timeout))
+
+	return []byte(C.GoString(result))
+}
+
+func (transport *tdjsonTransport) Destroy() {
+	C.td_json_client_destroy(transport.client)
+}
@@ -0,0 +1,78 @@
+package tdlib
+
+import (
+	"bytes"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// fakeTransport is a Transport answering requests without TDLib, for tests
+type fakeTransport struct {
+	// respond returns the responses and updates sent back for a request; responses must copy its @extra
+	respond func(request map[string]interface{}) []map[string]interface{}
+
+	lock     sync.Mutex
+	requests []map[string]interface{}
+	received chan []byte
+}
+
+// newFakeTransport creates a transport answering every request with respond, or with ok if it's nil
+func newFakeTransport(respond func(request map[string]interface{}) []map[string]interface{}) *fakeTransport {
+	if respond == nil {
+		respond = func(request map[string]interface{}) []map[string]interface{} {
+			return []map[string]interface{}{{"@type": "ok", "@extra": request["@extra"]}}
+		}
+	}
+	return &fakeTransport{respond: respond, received: make(chan []byte, 100)}
+}
+
+func (transport *fakeTransport) Send(query []byte) {
+	decoder := json.NewDecoder(bytes.NewReader(query))
+	decoder.UseNumber()
+	var request map[string]interface{}
+	if err := decoder.Decode(&request); err != nil {
+		panic(err)
+	}
+	transport.lock.Lock()
+	transport.requests = append(transport.requests, request)
+	transport.lock.Unlock()
+
+	for _, response := range transport.respond(request) {
+		transport.push(response)
+	}
+}
+
+func (transport *fakeTransport) Receive(timeout float64) []byte {
+	select {
+	case received := <-transport.received:
+		return received
+	case <-time.After(time.Duration(timeout * float64(time.Second))):
+		return nil
+	}
+}
+
+func (transport *fakeTransport) Destroy() {}
+
+// push sends an update to the client
+func (transport *fakeTransport) push(update map[string]interface{}) {
+	data, err := json.Marshal(update)
+	if err != nil {
+		panic(err)
+	}
+	transport.received <- data
+}
+
+// sent returns the requests of a method sent so far
+func (transport *fakeTransport) sent(method string) []map[string]interface{} {
+	transport.lock.Lock()
+	defer transport.lock.Unlock()
+
+	var requests []map[string]interface{}
+	for _, request := range transport.requests {
+		if request["@type"] == method {
+			requests = append(requests, request)
+		}
+	}
+	return requests
+}
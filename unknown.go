@@ -0,0 +1,81 @@
+package tdlib
+
+import (
+	"encoding/json"
+	"sync/atomic"
+)
+
+// strictDecoding makes the decoders fail on unknown @type values, see SetStrictDecoding
+var strictDecoding atomic.Bool
+
+// SetStrictDecoding Sets whether objects of types unknown to the bindings, e.g. sent by a newer TDLib,
+// fail to decode with an UnknownTypeError. By default they are decoded into the Unknown<Interface>
+// type of their interface, e.g. UnknownMessageContent, which keeps their raw JSON.
+func SetStrictDecoding(strict bool) {
+	strictDecoding.Store(strict)
+}
+
+// UnknownTypeError is returned when decoding an object of an unknown type with strict decoding enabled
+type UnknownTypeError struct {
+	Type string // @type of the object
+}
+
+func (err *UnknownTypeError) Error() string {
+	return "Error UnMarshaling, unknown type:" + err.Type
+}
+
+// Unknown holds an object of a type unknown to the bindings.
+// It's embedded in the Unknown<Interface> types, which implement the interfaces.
+type Unknown struct {
+	Type string          // @type of the object
+	Raw  json.RawMessage // The object as received from TDLib
+}
+
+// MessageType return the string telegram-type of the object
+func (unknown *Unknown) MessageType() string {
+	return unknown.Type
+}
+
+// MarshalJSON marshals the object unchanged, as it was received
+func (unknown Unknown) MarshalJSON() ([]byte, error) {
+	if unknown.Raw == nil {
+		return []byte("null"), nil
+	}
+	return unknown.Raw, nil
+}
+
+// UnmarshalJSON keeps the raw JSON of the object
+func (unknown *Unknown) UnmarshalJSON(b []byte) error {
+	var typeOnly struct {
+		Type string `json:"@type"`
+	}
+	if err := json.Unmarshal(b, &typeOnly); err != nil {
+		return err
+	}
+
+	unknown.Type = typeOnly.Type
+	unknown.Raw = append(json.RawMessage(nil), b...)
+	return nil
+}
+
+// decodeUnknown decodes an object of an unknown type, or fails if strict decoding is enabled
+func decodeUnknown(typeName string, rawMsg []byte) (Unknown, error) {
+	if strictDecoding.Load() {
+		return Unknown{}, &UnknownTypeError{Type: typeName}
+	}
+
+	return Unknown{Type: typeName, Raw: append(json.RawMessage(nil), rawMsg...)}, nil
+}
+
+// clone returns a copy of the object with its own raw JSON
+func (unknown Unknown) clone() Unknown {
+	if unknown.Raw != nil {
+		unknown.Raw = append(json.RawMessage{}, unknown.Raw...)
+	}
+	return unknown
+}
+
+// equal reports whether two objects have the same type and raw JSON
+func (unknown Unknown) equal(other Unknown) bool {
+	return unknown.Type == other.Type && equalBytes(unknown.Raw, other.Raw)
+}
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // Update Contains notifications about data changes
 type Update interface {
 	GetUpdateEnum() UpdateEnum
+	Accept(visitor UpdateVisitor)
 }
 
 // UpdateEnum Alias for abstract Update 'Sub-Classes', used as constant-enum here
@@ -109,481 +111,555 @@ const (
 	UpdateNewChatJoinRequestType             UpdateEnum = "updateNewChatJoinRequest"
 )
 
+// UnknownUpdate is an Update of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownUpdate struct {
+	Unknown
+}
+
+// GetUpdateEnum return the enum type of this object
+func (unknownUpdate *UnknownUpdate) GetUpdateEnum() UpdateEnum {
+	return UpdateEnum(unknownUpdate.Type)
+}
+
+// Accept calls the method of the visitor for UnknownUpdate
+func (unknownUpdate *UnknownUpdate) Accept(visitor UpdateVisitor) {
+	visitor.VisitUnknownUpdate(unknownUpdate)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownUpdate *UnknownUpdate) String() string {
+	return objectString(unknownUpdate)
+}
+
+// LogValue logs the object as its summary
+func (unknownUpdate *UnknownUpdate) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownUpdate))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownUpdate *UnknownUpdate) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownUpdate)
+}
+
+func (unknownUpdate *UnknownUpdate) printText(p *printer) {
+	if unknownUpdate == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownUpdate")
+	p.string("@type", unknownUpdate.Type)
+	p.endObject(state)
+}
+
 func unmarshalUpdate(rawMsg *json.RawMessage) (Update, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch UpdateEnum(objMap["@type"].(string)) {
+	switch UpdateEnum(typeName) {
 	case UpdateAuthorizationStateType:
 		var updateAuthorizationState UpdateAuthorizationState
-		err := json.Unmarshal(*rawMsg, &updateAuthorizationState)
+		err := jsonUnmarshal(*rawMsg, &updateAuthorizationState)
 		return &updateAuthorizationState, err
 
 	case UpdateNewMessageType:
 		var updateNewMessage UpdateNewMessage
-		err := json.Unmarshal(*rawMsg, &updateNewMessage)
+		err := jsonUnmarshal(*rawMsg, &updateNewMessage)
 		return &updateNewMessage, err
 
 	case UpdateMessageSendAcknowledgedType:
 		var updateMessageSendAcknowledged UpdateMessageSendAcknowledged
-		err := json.Unmarshal(*rawMsg, &updateMessageSendAcknowledged)
+		err := jsonUnmarshal(*rawMsg, &updateMessageSendAcknowledged)
 		return &updateMessageSendAcknowledged, err
 
 	case UpdateMessageSendSucceededType:
 		var updateMessageSendSucceeded UpdateMessageSendSucceeded
-		err := json.Unmarshal(*rawMsg, &updateMessageSendSucceeded)
+		err := jsonUnmarshal(*rawMsg, &updateMessageSendSucceeded)
 		return &updateMessageSendSucceeded, err
 
 	case UpdateMessageSendFailedType:
 		var updateMessageSendFailed UpdateMessageSendFailed
-		err := json.Unmarshal(*rawMsg, &updateMessageSendFailed)
+		err := jsonUnmarshal(*rawMsg, &updateMessageSendFailed)
 		return &updateMessageSendFailed, err
 
 	case UpdateMessageContentType:
 		var updateMessageContent UpdateMessageContent
-		err := json.Unmarshal(*rawMsg, &updateMessageContent)
+		err := jsonUnmarshal(*rawMsg, &updateMessageContent)
 		return &updateMessageContent, err
 
 	case UpdateMessageEditedType:
 		var updateMessageEdited UpdateMessageEdited
-		err := json.Unmarshal(*rawMsg, &updateMessageEdited)
+		err := jsonUnmarshal(*rawMsg, &updateMessageEdited)
 		return &updateMessageEdited, err
 
 	case UpdateMessageIsPinnedType:
 		var updateMessageIsPinned UpdateMessageIsPinned
-		err := json.Unmarshal(*rawMsg, &updateMessageIsPinned)
+		err := jsonUnmarshal(*rawMsg, &updateMessageIsPinned)
 		return &updateMessageIsPinned, err
 
 	case UpdateMessageInteractionInfoType:
 		var updateMessageInteractionInfo UpdateMessageInteractionInfo
-		err := json.Unmarshal(*rawMsg, &updateMessageInteractionInfo)
+		err := jsonUnmarshal(*rawMsg, &updateMessageInteractionInfo)
 		return &updateMessageInteractionInfo, err
 
 	case UpdateMessageContentOpenedType:
 		var updateMessageContentOpened UpdateMessageContentOpened
-		err := json.Unmarshal(*rawMsg, &updateMessageContentOpened)
+		err := jsonUnmarshal(*rawMsg, &updateMessageContentOpened)
 		return &updateMessageContentOpened, err
 
 	case UpdateMessageMentionReadType:
 		var updateMessageMentionRead UpdateMessageMentionRead
-		err := json.Unmarshal(*rawMsg, &updateMessageMentionRead)
+		err := jsonUnmarshal(*rawMsg, &updateMessageMentionRead)
 		return &updateMessageMentionRead, err
 
 	case UpdateMessageLiveLocationViewedType:
 		var updateMessageLiveLocationViewed UpdateMessageLiveLocationViewed
-		err := json.Unmarshal(*rawMsg, &updateMessageLiveLocationViewed)
+		err := jsonUnmarshal(*rawMsg, &updateMessageLiveLocationViewed)
 		return &updateMessageLiveLocationViewed, err
 
 	case UpdateNewChatType:
 		var updateNewChat UpdateNewChat
-		err := json.Unmarshal(*rawMsg, &updateNewChat)
+		err := jsonUnmarshal(*rawMsg, &updateNewChat)
 		return &updateNewChat, err
 
 	case UpdateChatTitleType:
 		var updateChatTitle UpdateChatTitle
-		err := json.Unmarshal(*rawMsg, &updateChatTitle)
+		err := jsonUnmarshal(*rawMsg, &updateChatTitle)
 		return &updateChatTitle, err
 
 	case UpdateChatPhotoType:
 		var updateChatPhoto UpdateChatPhoto
-		err := json.Unmarshal(*rawMsg, &updateChatPhoto)
+		err := jsonUnmarshal(*rawMsg, &updateChatPhoto)
 		return &updateChatPhoto, err
 
 	case UpdateChatPermissionsType:
 		var updateChatPermissions UpdateChatPermissions
-		err := json.Unmarshal(*rawMsg, &updateChatPermissions)
+		err := jsonUnmarshal(*rawMsg, &updateChatPermissions)
 		return &updateChatPermissions, err
 
 	case UpdateChatLastMessageType:
 		var updateChatLastMessage UpdateChatLastMessage
-		err := json.Unmarshal(*rawMsg, &updateChatLastMessage)
+		err := jsonUnmarshal(*rawMsg, &updateChatLastMessage)
 		return &updateChatLastMessage, err
 
 	case UpdateChatPositionType:
 		var updateChatPosition UpdateChatPosition
-		err := json.Unmarshal(*rawMsg, &updateChatPosition)
+		err := jsonUnmarshal(*rawMsg, &updateChatPosition)
 		return &updateChatPosition, err
 
 	case UpdateChatReadInboxType:
 		var updateChatReadInbox UpdateChatReadInbox
-		err := json.Unmarshal(*rawMsg, &updateChatReadInbox)
+		err := jsonUnmarshal(*rawMsg, &updateChatReadInbox)
 		return &updateChatReadInbox, err
 
 	case UpdateChatReadOutboxType:
 		var updateChatReadOutbox UpdateChatReadOutbox
-		err := json.Unmarshal(*rawMsg, &updateChatReadOutbox)
+		err := jsonUnmarshal(*rawMsg, &updateChatReadOutbox)
 		return &updateChatReadOutbox, err
 
 	case UpdateChatActionBarType:
 		var updateChatActionBar UpdateChatActionBar
-		err := json.Unmarshal(*rawMsg, &updateChatActionBar)
+		err := jsonUnmarshal(*rawMsg, &updateChatActionBar)
 		return &updateChatActionBar, err
 
 	case UpdateChatDraftMessageType:
 		var updateChatDraftMessage UpdateChatDraftMessage
-		err := json.Unmarshal(*rawMsg, &updateChatDraftMessage)
+		err := jsonUnmarshal(*rawMsg, &updateChatDraftMessage)
 		return &updateChatDraftMessage, err
 
 	case UpdateChatMessageSenderType:
 		var updateChatMessageSender UpdateChatMessageSender
-		err := json.Unmarshal(*rawMsg, &updateChatMessageSender)
+		err := jsonUnmarshal(*rawMsg, &updateChatMessageSender)
 		return &updateChatMessageSender, err
 
 	case UpdateChatMessageTtlType:
 		var updateChatMessageTtl UpdateChatMessageTtl
-		err := json.Unmarshal(*rawMsg, &updateChatMessageTtl)
+		err := jsonUnmarshal(*rawMsg, &updateChatMessageTtl)
 		return &updateChatMessageTtl, err
 
 	case UpdateChatNotificationSettingsType:
 		var updateChatNotificationSettings UpdateChatNotificationSettings
-		err := json.Unmarshal(*rawMsg, &updateChatNotificationSettings)
+		err := jsonUnmarshal(*rawMsg, &updateChatNotificationSettings)
 		return &updateChatNotificationSettings, err
 
 	case UpdateChatPendingJoinRequestsType:
 		var updateChatPendingJoinRequests UpdateChatPendingJoinRequests
-		err := json.Unmarshal(*rawMsg, &updateChatPendingJoinRequests)
+		err := jsonUnmarshal(*rawMsg, &updateChatPendingJoinRequests)
 		return &updateChatPendingJoinRequests, err
 
 	case UpdateChatReplyMarkupType:
 		var updateChatReplyMarkup UpdateChatReplyMarkup
-		err := json.Unmarshal(*rawMsg, &updateChatReplyMarkup)
+		err := jsonUnmarshal(*rawMsg, &updateChatReplyMarkup)
 		return &updateChatReplyMarkup, err
 
 	case UpdateChatThemeType:
 		var updateChatTheme UpdateChatTheme
-		err := json.Unmarshal(*rawMsg, &updateChatTheme)
+		err := jsonUnmarshal(*rawMsg, &updateChatTheme)
 		return &updateChatTheme, err
 
 	case UpdateChatUnreadMentionCountType:
 		var updateChatUnreadMentionCount UpdateChatUnreadMentionCount
-		err := json.Unmarshal(*rawMsg, &updateChatUnreadMentionCount)
+		err := jsonUnmarshal(*rawMsg, &updateChatUnreadMentionCount)
 		return &updateChatUnreadMentionCount, err
 
 	case UpdateChatVideoChatType:
 		var updateChatVideoChat UpdateChatVideoChat
-		err := json.Unmarshal(*rawMsg, &updateChatVideoChat)
+		err := jsonUnmarshal(*rawMsg, &updateChatVideoChat)
 		return &updateChatVideoChat, err
 
 	case UpdateChatDefaultDisableNotificationType:
 		var updateChatDefaultDisableNotification UpdateChatDefaultDisableNotification
-		err := json.Unmarshal(*rawMsg, &updateChatDefaultDisableNotification)
+		err := jsonUnmarshal(*rawMsg, &updateChatDefaultDisableNotification)
 		return &updateChatDefaultDisableNotification, err
 
 	case UpdateChatHasProtectedContentType:
 		var updateChatHasProtectedContent UpdateChatHasProtectedContent
-		err := json.Unmarshal(*rawMsg, &updateChatHasProtectedContent)
+		err := jsonUnmarshal(*rawMsg, &updateChatHasProtectedContent)
 		return &updateChatHasProtectedContent, err
 
 	case UpdateChatHasScheduledMessagesType:
 		var updateChatHasScheduledMessages UpdateChatHasScheduledMessages
-		err := json.Unmarshal(*rawMsg, &updateChatHasScheduledMessages)
+		err := jsonUnmarshal(*rawMsg, &updateChatHasScheduledMessages)
 		return &updateChatHasScheduledMessages, err
 
 	case UpdateChatIsBlockedType:
 		var updateChatIsBlocked UpdateChatIsBlocked
-		err := json.Unmarshal(*rawMsg, &updateChatIsBlocked)
+		err := jsonUnmarshal(*rawMsg, &updateChatIsBlocked)
 		return &updateChatIsBlocked, err
 
 	case UpdateChatIsMarkedAsUnreadType:
 		var updateChatIsMarkedAsUnread UpdateChatIsMarkedAsUnread
-		err := json.Unmarshal(*rawMsg, &updateChatIsMarkedAsUnread)
+		err := jsonUnmarshal(*rawMsg, &updateChatIsMarkedAsUnread)
 		return &updateChatIsMarkedAsUnread, err
 
 	case UpdateChatFiltersType:
 		var updateChatFilters UpdateChatFilters
-		err := json.Unmarshal(*rawMsg, &updateChatFilters)
+		err := jsonUnmarshal(*rawMsg, &updateChatFilters)
 		return &updateChatFilters, err
 
 	case UpdateChatOnlineMemberCountType:
 		var updateChatOnlineMemberCount UpdateChatOnlineMemberCount
-		err := json.Unmarshal(*rawMsg, &updateChatOnlineMemberCount)
+		err := jsonUnmarshal(*rawMsg, &updateChatOnlineMemberCount)
 		return &updateChatOnlineMemberCount, err
 
 	case UpdateScopeNotificationSettingsType:
 		var updateScopeNotificationSettings UpdateScopeNotificationSettings
-		err := json.Unmarshal(*rawMsg, &updateScopeNotificationSettings)
+		err := jsonUnmarshal(*rawMsg, &updateScopeNotificationSettings)
 		return &updateScopeNotificationSettings, err
 
 	case UpdateNotificationType:
 		var updateNotification UpdateNotification
-		err := json.Unmarshal(*rawMsg, &updateNotification)
+		err := jsonUnmarshal(*rawMsg, &updateNotification)
 		return &updateNotification, err
 
 	case UpdateNotificationGroupType:
 		var updateNotificationGroup UpdateNotificationGroup
-		err := json.Unmarshal(*rawMsg, &updateNotificationGroup)
+		err := jsonUnmarshal(*rawMsg, &updateNotificationGroup)
 		return &updateNotificationGroup, err
 
 	case UpdateActiveNotificationsType:
 		var updateActiveNotifications UpdateActiveNotifications
-		err := json.Unmarshal(*rawMsg, &updateActiveNotifications)
+		err := jsonUnmarshal(*rawMsg, &updateActiveNotifications)
 		return &updateActiveNotifications, err
 
 	case UpdateHavePendingNotificationsType:
 		var updateHavePendingNotifications UpdateHavePendingNotifications
-		err := json.Unmarshal(*rawMsg, &updateHavePendingNotifications)
+		err := jsonUnmarshal(*rawMsg, &updateHavePendingNotifications)
 		return &updateHavePendingNotifications, err
 
 	case UpdateDeleteMessagesType:
 		var updateDeleteMessages UpdateDeleteMessages
-		err := json.Unmarshal(*rawMsg, &updateDeleteMessages)
+		err := jsonUnmarshal(*rawMsg, &updateDeleteMessages)
 		return &updateDeleteMessages, err
 
 	case UpdateChatActionType:
 		var updateChatAction UpdateChatAction
-		err := json.Unmarshal(*rawMsg, &updateChatAction)
+		err := jsonUnmarshal(*rawMsg, &updateChatAction)
 		return &updateChatAction, err
 
 	case UpdateUserStatusType:
 		var updateUserStatus UpdateUserStatus
-		err := json.Unmarshal(*rawMsg, &updateUserStatus)
+		err := jsonUnmarshal(*rawMsg, &updateUserStatus)
 		return &updateUserStatus, err
 
 	case UpdateUserType:
 		var updateUser UpdateUser
-		err := json.Unmarshal(*rawMsg, &updateUser)
+		err := jsonUnmarshal(*rawMsg, &updateUser)
 		return &updateUser, err
 
 	case UpdateBasicGroupType:
 		var updateBasicGroup UpdateBasicGroup
-		err := json.Unmarshal(*rawMsg, &updateBasicGroup)
+		err := jsonUnmarshal(*rawMsg, &updateBasicGroup)
 		return &updateBasicGroup, err
 
 	case UpdateSupergroupType:
 		var updateSupergroup UpdateSupergroup
-		err := json.Unmarshal(*rawMsg, &updateSupergroup)
+		err := jsonUnmarshal(*rawMsg, &updateSupergroup)
 		return &updateSupergroup, err
 
 	case UpdateSecretChatType:
 		var updateSecretChat UpdateSecretChat
-		err := json.Unmarshal(*rawMsg, &updateSecretChat)
+		err := jsonUnmarshal(*rawMsg, &updateSecretChat)
 		return &updateSecretChat, err
 
 	case UpdateUserFullInfoType:
 		var updateUserFullInfo UpdateUserFullInfo
-		err := json.Unmarshal(*rawMsg, &updateUserFullInfo)
+		err := jsonUnmarshal(*rawMsg, &updateUserFullInfo)
 		return &updateUserFullInfo, err
 
 	case UpdateBasicGroupFullInfoType:
 		var updateBasicGroupFullInfo UpdateBasicGroupFullInfo
-		err := json.Unmarshal(*rawMsg, &updateBasicGroupFullInfo)
+		err := jsonUnmarshal(*rawMsg, &updateBasicGroupFullInfo)
 		return &updateBasicGroupFullInfo, err
 
 	case UpdateSupergroupFullInfoType:
 		var updateSupergroupFullInfo UpdateSupergroupFullInfo
-		err := json.Unmarshal(*rawMsg, &updateSupergroupFullInfo)
+		err := jsonUnmarshal(*rawMsg, &updateSupergroupFullInfo)
 		return &updateSupergroupFullInfo, err
 
 	case UpdateServiceNotificationType:
 		var updateServiceNotification UpdateServiceNotification
-		err := json.Unmarshal(*rawMsg, &updateServiceNotification)
+		err := jsonUnmarshal(*rawMsg, &updateServiceNotification)
 		return &updateServiceNotification, err
 
 	case UpdateFileType:
 		var updateFile UpdateFile
-		err := json.Unmarshal(*rawMsg, &updateFile)
+		err := jsonUnmarshal(*rawMsg, &updateFile)
 		return &updateFile, err
 
 	case UpdateFileGenerationStartType:
 		var updateFileGenerationStart UpdateFileGenerationStart
-		err := json.Unmarshal(*rawMsg, &updateFileGenerationStart)
+		err := jsonUnmarshal(*rawMsg, &updateFileGenerationStart)
 		return &updateFileGenerationStart, err
 
 	case UpdateFileGenerationStopType:
 		var updateFileGenerationStop UpdateFileGenerationStop
-		err := json.Unmarshal(*rawMsg, &updateFileGenerationStop)
+		err := jsonUnmarshal(*rawMsg, &updateFileGenerationStop)
 		return &updateFileGenerationStop, err
 
 	case UpdateCallType:
 		var updateCall UpdateCall
-		err := json.Unmarshal(*rawMsg, &updateCall)
+		err := jsonUnmarshal(*rawMsg, &updateCall)
 		return &updateCall, err
 
 	case UpdateGroupCallType:
 		var updateGroupCall UpdateGroupCall
-		err := json.Unmarshal(*rawMsg, &updateGroupCall)
+		err := jsonUnmarshal(*rawMsg, &updateGroupCall)
 		return &updateGroupCall, err
 
 	case UpdateGroupCallParticipantType:
 		var updateGroupCallParticipant UpdateGroupCallParticipant
-		err := json.Unmarshal(*rawMsg, &updateGroupCallParticipant)
+		err := jsonUnmarshal(*rawMsg, &updateGroupCallParticipant)
 		return &updateGroupCallParticipant, err
 
 	case UpdateNewCallSignalingDataType:
 		var updateNewCallSignalingData UpdateNewCallSignalingData
-		err := json.Unmarshal(*rawMsg, &updateNewCallSignalingData)
+		err := jsonUnmarshal(*rawMsg, &updateNewCallSignalingData)
 		return &updateNewCallSignalingData, err
 
 	case UpdateUserPrivacySettingRulesType:
 		var updateUserPrivacySettingRules UpdateUserPrivacySettingRules
-		err := json.Unmarshal(*rawMsg, &updateUserPrivacySettingRules)
+		err := jsonUnmarshal(*rawMsg, &updateUserPrivacySettingRules)
 		return &updateUserPrivacySettingRules, err
 
 	case UpdateUnreadMessageCountType:
 		var updateUnreadMessageCount UpdateUnreadMessageCount
-		err := json.Unmarshal(*rawMsg, &updateUnreadMessageCount)
+		err := jsonUnmarshal(*rawMsg, &updateUnreadMessageCount)
 		return &updateUnreadMessageCount, err
 
 	case UpdateUnreadChatCountType:
 		var updateUnreadChatCount UpdateUnreadChatCount
-		err := json.Unmarshal(*rawMsg, &updateUnreadChatCount)
+		err := jsonUnmarshal(*rawMsg, &updateUnreadChatCount)
 		return &updateUnreadChatCount, err
 
 	case UpdateOptionType:
 		var updateOption UpdateOption
-		err := json.Unmarshal(*rawMsg, &updateOption)
+		err := jsonUnmarshal(*rawMsg, &updateOption)
 		return &updateOption, err
 
 	case UpdateStickerSetType:
 		var updateStickerSet UpdateStickerSet
-		err := json.Unmarshal(*rawMsg, &updateStickerSet)
+		err := jsonUnmarshal(*rawMsg, &updateStickerSet)
 		return &updateStickerSet, err
 
 	case UpdateInstalledStickerSetsType:
 		var updateInstalledStickerSets UpdateInstalledStickerSets
-		err := json.Unmarshal(*rawMsg, &updateInstalledStickerSets)
+		err := jsonUnmarshal(*rawMsg, &updateInstalledStickerSets)
 		return &updateInstalledStickerSets, err
 
 	case UpdateTrendingStickerSetsType:
 		var updateTrendingStickerSets UpdateTrendingStickerSets
-		err := json.Unmarshal(*rawMsg, &updateTrendingStickerSets)
+		err := jsonUnmarshal(*rawMsg, &updateTrendingStickerSets)
 		return &updateTrendingStickerSets, err
 
 	case UpdateRecentStickersType:
 		var updateRecentStickers UpdateRecentStickers
-		err := json.Unmarshal(*rawMsg, &updateRecentStickers)
+		err := jsonUnmarshal(*rawMsg, &updateRecentStickers)
 		return &updateRecentStickers, err
 
 	case UpdateFavoriteStickersType:
 		var updateFavoriteStickers UpdateFavoriteStickers
-		err := json.Unmarshal(*rawMsg, &updateFavoriteStickers)
+		err := jsonUnmarshal(*rawMsg, &updateFavoriteStickers)
 		return &updateFavoriteStickers, err
 
 	case UpdateSavedAnimationsType:
 		var updateSavedAnimations UpdateSavedAnimations
-		err := json.Unmarshal(*rawMsg, &updateSavedAnimations)
+		err := jsonUnmarshal(*rawMsg, &updateSavedAnimations)
 		return &updateSavedAnimations, err
 
 	case UpdateSelectedBackgroundType:
 		var updateSelectedBackground UpdateSelectedBackground
-		err := json.Unmarshal(*rawMsg, &updateSelectedBackground)
+		err := jsonUnmarshal(*rawMsg, &updateSelectedBackground)
 		return &updateSelectedBackground, err
 
 	case UpdateChatThemesType:
 		var updateChatThemes UpdateChatThemes
-		err := json.Unmarshal(*rawMsg, &updateChatThemes)
+		err := jsonUnmarshal(*rawMsg, &updateChatThemes)
 		return &updateChatThemes, err
 
 	case UpdateLanguagePackStringsType:
 		var updateLanguagePackStrings UpdateLanguagePackStrings
-		err := json.Unmarshal(*rawMsg, &updateLanguagePackStrings)
+		err := jsonUnmarshal(*rawMsg, &updateLanguagePackStrings)
 		return &updateLanguagePackStrings, err
 
 	case UpdateConnectionStateType:
 		var updateConnectionState UpdateConnectionState
-		err := json.Unmarshal(*rawMsg, &updateConnectionState)
+		err := jsonUnmarshal(*rawMsg, &updateConnectionState)
 		return &updateConnectionState, err
 
 	case UpdateTermsOfServiceType:
 		var updateTermsOfService UpdateTermsOfService
-		err := json.Unmarshal(*rawMsg, &updateTermsOfService)
+		err := jsonUnmarshal(*rawMsg, &updateTermsOfService)
 		return &updateTermsOfService, err
 
 	case UpdateUsersNearbyType:
 		var updateUsersNearby UpdateUsersNearby
-		err := json.Unmarshal(*rawMsg, &updateUsersNearby)
+		err := jsonUnmarshal(*rawMsg, &updateUsersNearby)
 		return &updateUsersNearby, err
 
 	case UpdateDiceEmojisType:
 		var updateDiceEmojis UpdateDiceEmojis
-		err := json.Unmarshal(*rawMsg, &updateDiceEmojis)
+		err := jsonUnmarshal(*rawMsg, &updateDiceEmojis)
 		return &updateDiceEmojis, err
 
 	case UpdateAnimatedEmojiMessageClickedType:
 		var updateAnimatedEmojiMessageClicked UpdateAnimatedEmojiMessageClicked
-		err := json.Unmarshal(*rawMsg, &updateAnimatedEmojiMessageClicked)
+		err := jsonUnmarshal(*rawMsg, &updateAnimatedEmojiMessageClicked)
 		return &updateAnimatedEmojiMessageClicked, err
 
 	case UpdateAnimationSearchParametersType:
 		var updateAnimationSearchParameters UpdateAnimationSearchParameters
-		err := json.Unmarshal(*rawMsg, &updateAnimationSearchParameters)
+		err := jsonUnmarshal(*rawMsg, &updateAnimationSearchParameters)
 		return &updateAnimationSearchParameters, err
 
 	case UpdateSuggestedActionsType:
 		var updateSuggestedActions UpdateSuggestedActions
-		err := json.Unmarshal(*rawMsg, &updateSuggestedActions)
+		err := jsonUnmarshal(*rawMsg, &updateSuggestedActions)
 		return &updateSuggestedActions, err
 
 	case UpdateNewInlineQueryType:
 		var updateNewInlineQuery UpdateNewInlineQuery
-		err := json.Unmarshal(*rawMsg, &updateNewInlineQuery)
+		err := jsonUnmarshal(*rawMsg, &updateNewInlineQuery)
 		return &updateNewInlineQuery, err
 
 	case UpdateNewChosenInlineResultType:
 		var updateNewChosenInlineResult UpdateNewChosenInlineResult
-		err := json.Unmarshal(*rawMsg, &updateNewChosenInlineResult)
+		err := jsonUnmarshal(*rawMsg, &updateNewChosenInlineResult)
 		return &updateNewChosenInlineResult, err
 
 	case UpdateNewCallbackQueryType:
 		var updateNewCallbackQuery UpdateNewCallbackQuery
-		err := json.Unmarshal(*rawMsg, &updateNewCallbackQuery)
+		err := jsonUnmarshal(*rawMsg, &updateNewCallbackQuery)
 		return &updateNewCallbackQuery, err
 
 	case UpdateNewInlineCallbackQueryType:
 		var updateNewInlineCallbackQuery UpdateNewInlineCallbackQuery
-		err := json.Unmarshal(*rawMsg, &updateNewInlineCallbackQuery)
+		err := jsonUnmarshal(*rawMsg, &updateNewInlineCallbackQuery)
 		return &updateNewInlineCallbackQuery, err
 
 	case UpdateNewShippingQueryType:
 		var updateNewShippingQuery UpdateNewShippingQuery
-		err := json.Unmarshal(*rawMsg, &updateNewShippingQuery)
+		err := jsonUnmarshal(*rawMsg, &updateNewShippingQuery)
 		return &updateNewShippingQuery, err
 
 	case UpdateNewPreCheckoutQueryType:
 		var updateNewPreCheckoutQuery UpdateNewPreCheckoutQuery
-		err := json.Unmarshal(*rawMsg, &updateNewPreCheckoutQuery)
+		err := jsonUnmarshal(*rawMsg, &updateNewPreCheckoutQuery)
 		return &updateNewPreCheckoutQuery, err
 
 	case UpdateNewCustomEventType:
 		var updateNewCustomEvent UpdateNewCustomEvent
-		err := json.Unmarshal(*rawMsg, &updateNewCustomEvent)
+		err := jsonUnmarshal(*rawMsg, &updateNewCustomEvent)
 		return &updateNewCustomEvent, err
 
 	case UpdateNewCustomQueryType:
 		var updateNewCustomQuery UpdateNewCustomQuery
-		err := json.Unmarshal(*rawMsg, &updateNewCustomQuery)
+		err := jsonUnmarshal(*rawMsg, &updateNewCustomQuery)
 		return &updateNewCustomQuery, err
 
 	case UpdatePollType:
 		var updatePoll UpdatePoll
-		err := json.Unmarshal(*rawMsg, &updatePoll)
+		err := jsonUnmarshal(*rawMsg, &updatePoll)
 		return &updatePoll, err
 
 	case UpdatePollAnswerType:
 		var updatePollAnswer UpdatePollAnswer
-		err := json.Unmarshal(*rawMsg, &updatePollAnswer)
+		err := jsonUnmarshal(*rawMsg, &updatePollAnswer)
 		return &updatePollAnswer, err
 
 	case UpdateChatMemberType:
 		var updateChatMember UpdateChatMember
-		err := json.Unmarshal(*rawMsg, &updateChatMember)
+		err := jsonUnmarshal(*rawMsg, &updateChatMember)
 		return &updateChatMember, err
 
 	case UpdateNewChatJoinRequestType:
 		var updateNewChatJoinRequest UpdateNewChatJoinRequest
-		err := json.Unmarshal(*rawMsg, &updateNewChatJoinRequest)
+		err := jsonUnmarshal(*rawMsg, &updateNewChatJoinRequest)
 		return &updateNewChatJoinRequest, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownUpdate{Unknown: unknown}, nil
+	}
+}
+
+func unmarshalUpdateSlice(rawMsg *json.RawMessage) ([]Update, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var rawItems []*json.RawMessage
+	err := jsonUnmarshal(*rawMsg, &rawItems)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]Update, 0, len(rawItems))
+	for _, rawItem := range rawItems {
+		item, err := unmarshalUpdate(rawItem)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func decodeUpdate(r *jsonReader) (Update, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
 	}
+	return unmarshalUpdate(rawMsg)
 }
 
 // UpdateAuthorizationState The user authorization state has changed
@@ -609,27 +685,109 @@ func NewUpdateAuthorizationState(authorizationState AuthorizationState) *UpdateA
 	return &updateAuthorizationStateTemp
 }
 
+// MarshalJSON marshals to json
+func (updateAuthorizationState *UpdateAuthorizationState) MarshalJSON() ([]byte, error) {
+	return updateAuthorizationState.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (updateAuthorizationState *UpdateAuthorizationState) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, updateAuthorizationState)
+}
+
+func (updateAuthorizationState *UpdateAuthorizationState) appendJSON(b []byte) ([]byte, error) {
+	if updateAuthorizationState == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateAuthorizationState.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateAuthorizationState.tdCommon.Extra)
+	b = append(b, `,"authorization_state":`...)
+	b, err = appendJSONValue(b, updateAuthorizationState.AuthorizationState)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateAuthorizationState *UpdateAuthorizationState) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateAuthorizationState.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateAuthorizationState.tdCommon.Extra, err = r.readString()
+		case "authorization_state":
+			updateAuthorizationState.AuthorizationState, err = decodeAuthorizationState(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateAuthorizationState *UpdateAuthorizationState) Clone() *UpdateAuthorizationState {
+	if updateAuthorizationState == nil {
+		return nil
+	}
+	clone := *updateAuthorizationState
+	clone.AuthorizationState = cloneAuthorizationState(updateAuthorizationState.AuthorizationState)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateAuthorizationState *UpdateAuthorizationState) Equal(other *UpdateAuthorizationState) bool {
+	if updateAuthorizationState == nil || other == nil {
+		return updateAuthorizationState == other
+	}
+	if !equalAuthorizationState(updateAuthorizationState.AuthorizationState, other.AuthorizationState) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateAuthorizationState *UpdateAuthorizationState) Diff(other *UpdateAuthorizationState) []Change {
+	return updateAuthorizationState.diff("", other, nil)
+}
+
+func (updateAuthorizationState *UpdateAuthorizationState) diff(path string, other *UpdateAuthorizationState, changes []Change) []Change {
+	if updateAuthorizationState == nil || other == nil {
+		if updateAuthorizationState != other {
+			changes = append(changes, Change{Path: path, Old: updateAuthorizationState, New: other})
+		}
+		return changes
 	}
+	changes = diffAuthorizationState(fieldPath(path, "authorization_state"), updateAuthorizationState.AuthorizationState, other.AuthorizationState, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateAuthorizationState *UpdateAuthorizationState) String() string {
+	return objectString(updateAuthorizationState)
+}
 
-	updateAuthorizationState.tdCommon = tempObj.tdCommon
+// LogValue logs the object as its summary
+func (updateAuthorizationState *UpdateAuthorizationState) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateAuthorizationState))
+}
 
-	fieldAuthorizationState, _ := unmarshalAuthorizationState(objMap["authorization_state"])
-	updateAuthorizationState.AuthorizationState = fieldAuthorizationState
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateAuthorizationState *UpdateAuthorizationState) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateAuthorizationState)
+}
 
-	return nil
+func (updateAuthorizationState *UpdateAuthorizationState) printText(p *printer) {
+	if updateAuthorizationState == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateAuthorizationState")
+	p.object("authorization_state", updateAuthorizationState.AuthorizationState)
+	p.endObject(state)
 }
 
 // GetUpdateEnum return the enum type of this object
@@ -637,6 +795,11 @@ func (updateAuthorizationState *UpdateAuthorizationState) GetUpdateEnum() Update
 	return UpdateAuthorizationStateType
 }
 
+// Accept calls the method of the visitor for UpdateAuthorizationState
+func (updateAuthorizationState *UpdateAuthorizationState) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateAuthorizationState(updateAuthorizationState)
+}
+
 // UpdateNewMessage A new message was received; can also be an outgoing message
 type UpdateNewMessage struct {
 	tdCommon
@@ -660,11 +823,126 @@ func NewUpdateNewMessage(message *Message) *UpdateNewMessage {
 	return &updateNewMessageTemp
 }
 
+// MarshalJSON marshals to json
+func (updateNewMessage *UpdateNewMessage) MarshalJSON() ([]byte, error) {
+	return updateNewMessage.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateNewMessage *UpdateNewMessage) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateNewMessage)
+}
+
+func (updateNewMessage *UpdateNewMessage) appendJSON(b []byte) ([]byte, error) {
+	if updateNewMessage == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateNewMessage.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateNewMessage.tdCommon.Extra)
+	b = append(b, `,"message":`...)
+	b, err = updateNewMessage.Message.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateNewMessage *UpdateNewMessage) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateNewMessage.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateNewMessage.tdCommon.Extra, err = r.readString()
+		case "message":
+			if r.readNull() {
+				updateNewMessage.Message = nil
+			} else {
+				updateNewMessage.Message = new(Message)
+				err = updateNewMessage.Message.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateNewMessage *UpdateNewMessage) Clone() *UpdateNewMessage {
+	if updateNewMessage == nil {
+		return nil
+	}
+	clone := *updateNewMessage
+	clone.Message = updateNewMessage.Message.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateNewMessage *UpdateNewMessage) Equal(other *UpdateNewMessage) bool {
+	if updateNewMessage == nil || other == nil {
+		return updateNewMessage == other
+	}
+	if !updateNewMessage.Message.Equal(other.Message) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateNewMessage *UpdateNewMessage) Diff(other *UpdateNewMessage) []Change {
+	return updateNewMessage.diff("", other, nil)
+}
+
+func (updateNewMessage *UpdateNewMessage) diff(path string, other *UpdateNewMessage, changes []Change) []Change {
+	if updateNewMessage == nil || other == nil {
+		if updateNewMessage != other {
+			changes = append(changes, Change{Path: path, Old: updateNewMessage, New: other})
+		}
+		return changes
+	}
+	changes = updateNewMessage.Message.diff(fieldPath(path, "message"), other.Message, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateNewMessage *UpdateNewMessage) String() string {
+	return objectString(updateNewMessage)
+}
+
+// LogValue logs the object as its summary
+func (updateNewMessage *UpdateNewMessage) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateNewMessage))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateNewMessage *UpdateNewMessage) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateNewMessage)
+}
+
+func (updateNewMessage *UpdateNewMessage) printText(p *printer) {
+	if updateNewMessage == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateNewMessage")
+	p.object("message", updateNewMessage.Message)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateNewMessage *UpdateNewMessage) GetUpdateEnum() UpdateEnum {
 	return UpdateNewMessageType
 }
 
+// Accept calls the method of the visitor for UpdateNewMessage
+func (updateNewMessage *UpdateNewMessage) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateNewMessage(updateNewMessage)
+}
+
 // UpdateMessageSendAcknowledged A request to send a message has reached the Telegram server. This doesn't mean that the message will be sent successfully or even that the send message request will be processed. This update will be sent only if the option "use_quick_ack" is set to true. This update may be sent multiple times for the same message
 type UpdateMessageSendAcknowledged struct {
 	tdCommon
@@ -691,11 +969,129 @@ func NewUpdateMessageSendAcknowledged(chatId int64, messageId int64) *UpdateMess
 	return &updateMessageSendAcknowledgedTemp
 }
 
+// MarshalJSON marshals to json
+func (updateMessageSendAcknowledged *UpdateMessageSendAcknowledged) MarshalJSON() ([]byte, error) {
+	return updateMessageSendAcknowledged.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateMessageSendAcknowledged *UpdateMessageSendAcknowledged) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateMessageSendAcknowledged)
+}
+
+func (updateMessageSendAcknowledged *UpdateMessageSendAcknowledged) appendJSON(b []byte) ([]byte, error) {
+	if updateMessageSendAcknowledged == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateMessageSendAcknowledged.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateMessageSendAcknowledged.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateMessageSendAcknowledged.ChatId))
+	b = append(b, `,"message_id":`...)
+	b = appendJSONInt(b, int64(updateMessageSendAcknowledged.MessageId))
+	return append(b, '}'), nil
+}
+
+func (updateMessageSendAcknowledged *UpdateMessageSendAcknowledged) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateMessageSendAcknowledged.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateMessageSendAcknowledged.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateMessageSendAcknowledged.ChatId, err = r.readInt64()
+		case "message_id":
+			updateMessageSendAcknowledged.MessageId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateMessageSendAcknowledged *UpdateMessageSendAcknowledged) Clone() *UpdateMessageSendAcknowledged {
+	if updateMessageSendAcknowledged == nil {
+		return nil
+	}
+	clone := *updateMessageSendAcknowledged
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateMessageSendAcknowledged *UpdateMessageSendAcknowledged) Equal(other *UpdateMessageSendAcknowledged) bool {
+	if updateMessageSendAcknowledged == nil || other == nil {
+		return updateMessageSendAcknowledged == other
+	}
+	if updateMessageSendAcknowledged.ChatId != other.ChatId {
+		return false
+	}
+	if updateMessageSendAcknowledged.MessageId != other.MessageId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateMessageSendAcknowledged *UpdateMessageSendAcknowledged) Diff(other *UpdateMessageSendAcknowledged) []Change {
+	return updateMessageSendAcknowledged.diff("", other, nil)
+}
+
+func (updateMessageSendAcknowledged *UpdateMessageSendAcknowledged) diff(path string, other *UpdateMessageSendAcknowledged, changes []Change) []Change {
+	if updateMessageSendAcknowledged == nil || other == nil {
+		if updateMessageSendAcknowledged != other {
+			changes = append(changes, Change{Path: path, Old: updateMessageSendAcknowledged, New: other})
+		}
+		return changes
+	}
+	if updateMessageSendAcknowledged.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateMessageSendAcknowledged.ChatId, New: other.ChatId})
+	}
+	if updateMessageSendAcknowledged.MessageId != other.MessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "message_id"), Old: updateMessageSendAcknowledged.MessageId, New: other.MessageId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateMessageSendAcknowledged *UpdateMessageSendAcknowledged) String() string {
+	return objectString(updateMessageSendAcknowledged)
+}
+
+// LogValue logs the object as its summary
+func (updateMessageSendAcknowledged *UpdateMessageSendAcknowledged) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateMessageSendAcknowledged))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateMessageSendAcknowledged *UpdateMessageSendAcknowledged) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateMessageSendAcknowledged)
+}
+
+func (updateMessageSendAcknowledged *UpdateMessageSendAcknowledged) printText(p *printer) {
+	if updateMessageSendAcknowledged == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateMessageSendAcknowledged")
+	p.int("chat_id", int64(updateMessageSendAcknowledged.ChatId))
+	p.int("message_id", int64(updateMessageSendAcknowledged.MessageId))
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateMessageSendAcknowledged *UpdateMessageSendAcknowledged) GetUpdateEnum() UpdateEnum {
 	return UpdateMessageSendAcknowledgedType
 }
 
+// Accept calls the method of the visitor for UpdateMessageSendAcknowledged
+func (updateMessageSendAcknowledged *UpdateMessageSendAcknowledged) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateMessageSendAcknowledged(updateMessageSendAcknowledged)
+}
+
 // UpdateMessageSendSucceeded A message has been successfully sent
 type UpdateMessageSendSucceeded struct {
 	tdCommon
@@ -722,11 +1118,137 @@ func NewUpdateMessageSendSucceeded(message *Message, oldMessageId int64) *Update
 	return &updateMessageSendSucceededTemp
 }
 
+// MarshalJSON marshals to json
+func (updateMessageSendSucceeded *UpdateMessageSendSucceeded) MarshalJSON() ([]byte, error) {
+	return updateMessageSendSucceeded.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateMessageSendSucceeded *UpdateMessageSendSucceeded) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateMessageSendSucceeded)
+}
+
+func (updateMessageSendSucceeded *UpdateMessageSendSucceeded) appendJSON(b []byte) ([]byte, error) {
+	if updateMessageSendSucceeded == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateMessageSendSucceeded.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateMessageSendSucceeded.tdCommon.Extra)
+	b = append(b, `,"message":`...)
+	b, err = updateMessageSendSucceeded.Message.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"old_message_id":`...)
+	b = appendJSONInt(b, int64(updateMessageSendSucceeded.OldMessageId))
+	return append(b, '}'), nil
+}
+
+func (updateMessageSendSucceeded *UpdateMessageSendSucceeded) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateMessageSendSucceeded.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateMessageSendSucceeded.tdCommon.Extra, err = r.readString()
+		case "message":
+			if r.readNull() {
+				updateMessageSendSucceeded.Message = nil
+			} else {
+				updateMessageSendSucceeded.Message = new(Message)
+				err = updateMessageSendSucceeded.Message.decodeJSON(r)
+			}
+		case "old_message_id":
+			updateMessageSendSucceeded.OldMessageId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateMessageSendSucceeded *UpdateMessageSendSucceeded) Clone() *UpdateMessageSendSucceeded {
+	if updateMessageSendSucceeded == nil {
+		return nil
+	}
+	clone := *updateMessageSendSucceeded
+	clone.Message = updateMessageSendSucceeded.Message.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateMessageSendSucceeded *UpdateMessageSendSucceeded) Equal(other *UpdateMessageSendSucceeded) bool {
+	if updateMessageSendSucceeded == nil || other == nil {
+		return updateMessageSendSucceeded == other
+	}
+	if !updateMessageSendSucceeded.Message.Equal(other.Message) {
+		return false
+	}
+	if updateMessageSendSucceeded.OldMessageId != other.OldMessageId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateMessageSendSucceeded *UpdateMessageSendSucceeded) Diff(other *UpdateMessageSendSucceeded) []Change {
+	return updateMessageSendSucceeded.diff("", other, nil)
+}
+
+func (updateMessageSendSucceeded *UpdateMessageSendSucceeded) diff(path string, other *UpdateMessageSendSucceeded, changes []Change) []Change {
+	if updateMessageSendSucceeded == nil || other == nil {
+		if updateMessageSendSucceeded != other {
+			changes = append(changes, Change{Path: path, Old: updateMessageSendSucceeded, New: other})
+		}
+		return changes
+	}
+	changes = updateMessageSendSucceeded.Message.diff(fieldPath(path, "message"), other.Message, changes)
+	if updateMessageSendSucceeded.OldMessageId != other.OldMessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "old_message_id"), Old: updateMessageSendSucceeded.OldMessageId, New: other.OldMessageId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateMessageSendSucceeded *UpdateMessageSendSucceeded) String() string {
+	return objectString(updateMessageSendSucceeded)
+}
+
+// LogValue logs the object as its summary
+func (updateMessageSendSucceeded *UpdateMessageSendSucceeded) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateMessageSendSucceeded))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateMessageSendSucceeded *UpdateMessageSendSucceeded) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateMessageSendSucceeded)
+}
+
+func (updateMessageSendSucceeded *UpdateMessageSendSucceeded) printText(p *printer) {
+	if updateMessageSendSucceeded == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateMessageSendSucceeded")
+	p.object("message", updateMessageSendSucceeded.Message)
+	p.int("old_message_id", int64(updateMessageSendSucceeded.OldMessageId))
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateMessageSendSucceeded *UpdateMessageSendSucceeded) GetUpdateEnum() UpdateEnum {
 	return UpdateMessageSendSucceededType
 }
 
+// Accept calls the method of the visitor for UpdateMessageSendSucceeded
+func (updateMessageSendSucceeded *UpdateMessageSendSucceeded) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateMessageSendSucceeded(updateMessageSendSucceeded)
+}
+
 // UpdateMessageSendFailed A message failed to send. Be aware that some messages being sent can be irrecoverably deleted, in which case updateDeleteMessages will be received instead of this update
 type UpdateMessageSendFailed struct {
 	tdCommon
@@ -759,11 +1281,159 @@ func NewUpdateMessageSendFailed(message *Message, oldMessageId int64, errorCode
 	return &updateMessageSendFailedTemp
 }
 
+// MarshalJSON marshals to json
+func (updateMessageSendFailed *UpdateMessageSendFailed) MarshalJSON() ([]byte, error) {
+	return updateMessageSendFailed.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateMessageSendFailed *UpdateMessageSendFailed) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateMessageSendFailed)
+}
+
+func (updateMessageSendFailed *UpdateMessageSendFailed) appendJSON(b []byte) ([]byte, error) {
+	if updateMessageSendFailed == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateMessageSendFailed.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateMessageSendFailed.tdCommon.Extra)
+	b = append(b, `,"message":`...)
+	b, err = updateMessageSendFailed.Message.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"old_message_id":`...)
+	b = appendJSONInt(b, int64(updateMessageSendFailed.OldMessageId))
+	b = append(b, `,"error_code":`...)
+	b = appendJSONInt(b, int64(updateMessageSendFailed.ErrorCode))
+	b = append(b, `,"error_message":`...)
+	b = appendJSONString(b, updateMessageSendFailed.ErrorMessage)
+	return append(b, '}'), nil
+}
+
+func (updateMessageSendFailed *UpdateMessageSendFailed) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateMessageSendFailed.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateMessageSendFailed.tdCommon.Extra, err = r.readString()
+		case "message":
+			if r.readNull() {
+				updateMessageSendFailed.Message = nil
+			} else {
+				updateMessageSendFailed.Message = new(Message)
+				err = updateMessageSendFailed.Message.decodeJSON(r)
+			}
+		case "old_message_id":
+			updateMessageSendFailed.OldMessageId, err = r.readInt64()
+		case "error_code":
+			updateMessageSendFailed.ErrorCode, err = r.readInt32()
+		case "error_message":
+			updateMessageSendFailed.ErrorMessage, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateMessageSendFailed *UpdateMessageSendFailed) Clone() *UpdateMessageSendFailed {
+	if updateMessageSendFailed == nil {
+		return nil
+	}
+	clone := *updateMessageSendFailed
+	clone.Message = updateMessageSendFailed.Message.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateMessageSendFailed *UpdateMessageSendFailed) Equal(other *UpdateMessageSendFailed) bool {
+	if updateMessageSendFailed == nil || other == nil {
+		return updateMessageSendFailed == other
+	}
+	if !updateMessageSendFailed.Message.Equal(other.Message) {
+		return false
+	}
+	if updateMessageSendFailed.OldMessageId != other.OldMessageId {
+		return false
+	}
+	if updateMessageSendFailed.ErrorCode != other.ErrorCode {
+		return false
+	}
+	if updateMessageSendFailed.ErrorMessage != other.ErrorMessage {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateMessageSendFailed *UpdateMessageSendFailed) Diff(other *UpdateMessageSendFailed) []Change {
+	return updateMessageSendFailed.diff("", other, nil)
+}
+
+func (updateMessageSendFailed *UpdateMessageSendFailed) diff(path string, other *UpdateMessageSendFailed, changes []Change) []Change {
+	if updateMessageSendFailed == nil || other == nil {
+		if updateMessageSendFailed != other {
+			changes = append(changes, Change{Path: path, Old: updateMessageSendFailed, New: other})
+		}
+		return changes
+	}
+	changes = updateMessageSendFailed.Message.diff(fieldPath(path, "message"), other.Message, changes)
+	if updateMessageSendFailed.OldMessageId != other.OldMessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "old_message_id"), Old: updateMessageSendFailed.OldMessageId, New: other.OldMessageId})
+	}
+	if updateMessageSendFailed.ErrorCode != other.ErrorCode {
+		changes = append(changes, Change{Path: fieldPath(path, "error_code"), Old: updateMessageSendFailed.ErrorCode, New: other.ErrorCode})
+	}
+	if updateMessageSendFailed.ErrorMessage != other.ErrorMessage {
+		changes = append(changes, Change{Path: fieldPath(path, "error_message"), Old: updateMessageSendFailed.ErrorMessage, New: other.ErrorMessage})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateMessageSendFailed *UpdateMessageSendFailed) String() string {
+	return objectString(updateMessageSendFailed)
+}
+
+// LogValue logs the object as its summary
+func (updateMessageSendFailed *UpdateMessageSendFailed) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateMessageSendFailed))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateMessageSendFailed *UpdateMessageSendFailed) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateMessageSendFailed)
+}
+
+func (updateMessageSendFailed *UpdateMessageSendFailed) printText(p *printer) {
+	if updateMessageSendFailed == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateMessageSendFailed")
+	p.object("message", updateMessageSendFailed.Message)
+	p.int("old_message_id", int64(updateMessageSendFailed.OldMessageId))
+	p.int("error_code", int64(updateMessageSendFailed.ErrorCode))
+	p.string("error_message", updateMessageSendFailed.ErrorMessage)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateMessageSendFailed *UpdateMessageSendFailed) GetUpdateEnum() UpdateEnum {
 	return UpdateMessageSendFailedType
 }
 
+// Accept calls the method of the visitor for UpdateMessageSendFailed
+func (updateMessageSendFailed *UpdateMessageSendFailed) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateMessageSendFailed(updateMessageSendFailed)
+}
+
 // UpdateMessageContent The message content has changed
 type UpdateMessageContent struct {
 	tdCommon
@@ -793,32 +1463,131 @@ func NewUpdateMessageContent(chatId int64, messageId int64, newContent MessageCo
 	return &updateMessageContentTemp
 }
 
+// MarshalJSON marshals to json
+func (updateMessageContent *UpdateMessageContent) MarshalJSON() ([]byte, error) {
+	return updateMessageContent.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (updateMessageContent *UpdateMessageContent) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, updateMessageContent)
+}
+
+func (updateMessageContent *UpdateMessageContent) appendJSON(b []byte) ([]byte, error) {
+	if updateMessageContent == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateMessageContent.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateMessageContent.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateMessageContent.ChatId))
+	b = append(b, `,"message_id":`...)
+	b = appendJSONInt(b, int64(updateMessageContent.MessageId))
+	b = append(b, `,"new_content":`...)
+	b, err = appendJSONValue(b, updateMessageContent.NewContent)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateMessageContent *UpdateMessageContent) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateMessageContent.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateMessageContent.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateMessageContent.ChatId, err = r.readInt64()
+		case "message_id":
+			updateMessageContent.MessageId, err = r.readInt64()
+		case "new_content":
+			updateMessageContent.NewContent, err = decodeMessageContent(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateMessageContent *UpdateMessageContent) Clone() *UpdateMessageContent {
+	if updateMessageContent == nil {
+		return nil
 	}
-	tempObj := struct {
-		tdCommon
-		ChatId    int64 `json:"chat_id"`    // Chat identifier
-		MessageId int64 `json:"message_id"` // Message identifier
+	clone := *updateMessageContent
+	clone.NewContent = cloneMessageContent(updateMessageContent.NewContent)
+	return &clone
+}
 
-	}{}
-	err = json.Unmarshal(b, &tempObj)
-	if err != nil {
-		return err
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateMessageContent *UpdateMessageContent) Equal(other *UpdateMessageContent) bool {
+	if updateMessageContent == nil || other == nil {
+		return updateMessageContent == other
+	}
+	if updateMessageContent.ChatId != other.ChatId {
+		return false
+	}
+	if updateMessageContent.MessageId != other.MessageId {
+		return false
+	}
+	if !equalMessageContent(updateMessageContent.NewContent, other.NewContent) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateMessageContent *UpdateMessageContent) Diff(other *UpdateMessageContent) []Change {
+	return updateMessageContent.diff("", other, nil)
+}
+
+func (updateMessageContent *UpdateMessageContent) diff(path string, other *UpdateMessageContent, changes []Change) []Change {
+	if updateMessageContent == nil || other == nil {
+		if updateMessageContent != other {
+			changes = append(changes, Change{Path: path, Old: updateMessageContent, New: other})
+		}
+		return changes
 	}
+	if updateMessageContent.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateMessageContent.ChatId, New: other.ChatId})
+	}
+	if updateMessageContent.MessageId != other.MessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "message_id"), Old: updateMessageContent.MessageId, New: other.MessageId})
+	}
+	changes = diffMessageContent(fieldPath(path, "new_content"), updateMessageContent.NewContent, other.NewContent, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateMessageContent *UpdateMessageContent) String() string {
+	return objectString(updateMessageContent)
+}
 
-	updateMessageContent.tdCommon = tempObj.tdCommon
-	updateMessageContent.ChatId = tempObj.ChatId
-	updateMessageContent.MessageId = tempObj.MessageId
+// LogValue logs the object as its summary
+func (updateMessageContent *UpdateMessageContent) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateMessageContent))
+}
 
-	fieldNewContent, _ := unmarshalMessageContent(objMap["new_content"])
-	updateMessageContent.NewContent = fieldNewContent
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateMessageContent *UpdateMessageContent) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateMessageContent)
+}
 
-	return nil
+func (updateMessageContent *UpdateMessageContent) printText(p *printer) {
+	if updateMessageContent == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateMessageContent")
+	p.int("chat_id", int64(updateMessageContent.ChatId))
+	p.int("message_id", int64(updateMessageContent.MessageId))
+	p.object("new_content", updateMessageContent.NewContent)
+	p.endObject(state)
 }
 
 // GetUpdateEnum return the enum type of this object
@@ -826,6 +1595,11 @@ func (updateMessageContent *UpdateMessageContent) GetUpdateEnum() UpdateEnum {
 	return UpdateMessageContentType
 }
 
+// Accept calls the method of the visitor for UpdateMessageContent
+func (updateMessageContent *UpdateMessageContent) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateMessageContent(updateMessageContent)
+}
+
 // UpdateMessageEdited A message was edited. Changes in the message content will come in a separate updateMessageContent
 type UpdateMessageEdited struct {
 	tdCommon
@@ -858,41 +1632,154 @@ func NewUpdateMessageEdited(chatId int64, messageId int64, editDate int32, reply
 	return &updateMessageEditedTemp
 }
 
+// MarshalJSON marshals to json
+func (updateMessageEdited *UpdateMessageEdited) MarshalJSON() ([]byte, error) {
+	return updateMessageEdited.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (updateMessageEdited *UpdateMessageEdited) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		ChatId    int64 `json:"chat_id"`    // Chat identifier
-		MessageId int64 `json:"message_id"` // Message identifier
-		EditDate  int32 `json:"edit_date"`  // Point in time (Unix timestamp) when the message was edited
+	return unmarshalFast(b, updateMessageEdited)
+}
 
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+func (updateMessageEdited *UpdateMessageEdited) appendJSON(b []byte) ([]byte, error) {
+	if updateMessageEdited == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateMessageEdited.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateMessageEdited.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateMessageEdited.ChatId))
+	b = append(b, `,"message_id":`...)
+	b = appendJSONInt(b, int64(updateMessageEdited.MessageId))
+	b = append(b, `,"edit_date":`...)
+	b = appendJSONInt(b, int64(updateMessageEdited.EditDate))
+	b = append(b, `,"reply_markup":`...)
+	b, err = appendJSONValue(b, updateMessageEdited.ReplyMarkup)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return append(b, '}'), nil
+}
+
+func (updateMessageEdited *UpdateMessageEdited) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateMessageEdited.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateMessageEdited.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateMessageEdited.ChatId, err = r.readInt64()
+		case "message_id":
+			updateMessageEdited.MessageId, err = r.readInt64()
+		case "edit_date":
+			updateMessageEdited.EditDate, err = r.readInt32()
+		case "reply_markup":
+			updateMessageEdited.ReplyMarkup, err = decodeReplyMarkup(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	updateMessageEdited.tdCommon = tempObj.tdCommon
-	updateMessageEdited.ChatId = tempObj.ChatId
-	updateMessageEdited.MessageId = tempObj.MessageId
-	updateMessageEdited.EditDate = tempObj.EditDate
+// Clone returns a deep copy of the object
+func (updateMessageEdited *UpdateMessageEdited) Clone() *UpdateMessageEdited {
+	if updateMessageEdited == nil {
+		return nil
+	}
+	clone := *updateMessageEdited
+	clone.ReplyMarkup = cloneReplyMarkup(updateMessageEdited.ReplyMarkup)
+	return &clone
+}
 
-	fieldReplyMarkup, _ := unmarshalReplyMarkup(objMap["reply_markup"])
-	updateMessageEdited.ReplyMarkup = fieldReplyMarkup
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateMessageEdited *UpdateMessageEdited) Equal(other *UpdateMessageEdited) bool {
+	if updateMessageEdited == nil || other == nil {
+		return updateMessageEdited == other
+	}
+	if updateMessageEdited.ChatId != other.ChatId {
+		return false
+	}
+	if updateMessageEdited.MessageId != other.MessageId {
+		return false
+	}
+	if updateMessageEdited.EditDate != other.EditDate {
+		return false
+	}
+	if !equalReplyMarkup(updateMessageEdited.ReplyMarkup, other.ReplyMarkup) {
+		return false
+	}
+	return true
+}
 
-	return nil
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateMessageEdited *UpdateMessageEdited) Diff(other *UpdateMessageEdited) []Change {
+	return updateMessageEdited.diff("", other, nil)
 }
 
-// GetUpdateEnum return the enum type of this object
+func (updateMessageEdited *UpdateMessageEdited) diff(path string, other *UpdateMessageEdited, changes []Change) []Change {
+	if updateMessageEdited == nil || other == nil {
+		if updateMessageEdited != other {
+			changes = append(changes, Change{Path: path, Old: updateMessageEdited, New: other})
+		}
+		return changes
+	}
+	if updateMessageEdited.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateMessageEdited.ChatId, New: other.ChatId})
+	}
+	if updateMessageEdited.MessageId != other.MessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "message_id"), Old: updateMessageEdited.MessageId, New: other.MessageId})
+	}
+	if updateMessageEdited.EditDate != other.EditDate {
+		changes = append(changes, Change{Path: fieldPath(path, "edit_date"), Old: updateMessageEdited.EditDate, New: other.EditDate})
+	}
+	changes = diffReplyMarkup(fieldPath(path, "reply_markup"), updateMessageEdited.ReplyMarkup, other.ReplyMarkup, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateMessageEdited *UpdateMessageEdited) String() string {
+	return objectString(updateMessageEdited)
+}
+
+// LogValue logs the object as its summary
+func (updateMessageEdited *UpdateMessageEdited) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateMessageEdited))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateMessageEdited *UpdateMessageEdited) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateMessageEdited)
+}
+
+func (updateMessageEdited *UpdateMessageEdited) printText(p *printer) {
+	if updateMessageEdited == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateMessageEdited")
+	p.int("chat_id", int64(updateMessageEdited.ChatId))
+	p.int("message_id", int64(updateMessageEdited.MessageId))
+	p.int("edit_date", int64(updateMessageEdited.EditDate))
+	p.object("reply_markup", updateMessageEdited.ReplyMarkup)
+	p.endObject(state)
+}
+
+// GetUpdateEnum return the enum type of this object
 func (updateMessageEdited *UpdateMessageEdited) GetUpdateEnum() UpdateEnum {
 	return UpdateMessageEditedType
 }
 
+// Accept calls the method of the visitor for UpdateMessageEdited
+func (updateMessageEdited *UpdateMessageEdited) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateMessageEdited(updateMessageEdited)
+}
+
 // UpdateMessageIsPinned The message pinned state was changed
 type UpdateMessageIsPinned struct {
 	tdCommon
@@ -922,11 +1809,140 @@ func NewUpdateMessageIsPinned(chatId int64, messageId int64, isPinned bool) *Upd
 	return &updateMessageIsPinnedTemp
 }
 
+// MarshalJSON marshals to json
+func (updateMessageIsPinned *UpdateMessageIsPinned) MarshalJSON() ([]byte, error) {
+	return updateMessageIsPinned.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateMessageIsPinned *UpdateMessageIsPinned) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateMessageIsPinned)
+}
+
+func (updateMessageIsPinned *UpdateMessageIsPinned) appendJSON(b []byte) ([]byte, error) {
+	if updateMessageIsPinned == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateMessageIsPinned.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateMessageIsPinned.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateMessageIsPinned.ChatId))
+	b = append(b, `,"message_id":`...)
+	b = appendJSONInt(b, int64(updateMessageIsPinned.MessageId))
+	b = append(b, `,"is_pinned":`...)
+	b = appendJSONBool(b, updateMessageIsPinned.IsPinned)
+	return append(b, '}'), nil
+}
+
+func (updateMessageIsPinned *UpdateMessageIsPinned) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateMessageIsPinned.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateMessageIsPinned.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateMessageIsPinned.ChatId, err = r.readInt64()
+		case "message_id":
+			updateMessageIsPinned.MessageId, err = r.readInt64()
+		case "is_pinned":
+			updateMessageIsPinned.IsPinned, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateMessageIsPinned *UpdateMessageIsPinned) Clone() *UpdateMessageIsPinned {
+	if updateMessageIsPinned == nil {
+		return nil
+	}
+	clone := *updateMessageIsPinned
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateMessageIsPinned *UpdateMessageIsPinned) Equal(other *UpdateMessageIsPinned) bool {
+	if updateMessageIsPinned == nil || other == nil {
+		return updateMessageIsPinned == other
+	}
+	if updateMessageIsPinned.ChatId != other.ChatId {
+		return false
+	}
+	if updateMessageIsPinned.MessageId != other.MessageId {
+		return false
+	}
+	if updateMessageIsPinned.IsPinned != other.IsPinned {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateMessageIsPinned *UpdateMessageIsPinned) Diff(other *UpdateMessageIsPinned) []Change {
+	return updateMessageIsPinned.diff("", other, nil)
+}
+
+func (updateMessageIsPinned *UpdateMessageIsPinned) diff(path string, other *UpdateMessageIsPinned, changes []Change) []Change {
+	if updateMessageIsPinned == nil || other == nil {
+		if updateMessageIsPinned != other {
+			changes = append(changes, Change{Path: path, Old: updateMessageIsPinned, New: other})
+		}
+		return changes
+	}
+	if updateMessageIsPinned.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateMessageIsPinned.ChatId, New: other.ChatId})
+	}
+	if updateMessageIsPinned.MessageId != other.MessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "message_id"), Old: updateMessageIsPinned.MessageId, New: other.MessageId})
+	}
+	if updateMessageIsPinned.IsPinned != other.IsPinned {
+		changes = append(changes, Change{Path: fieldPath(path, "is_pinned"), Old: updateMessageIsPinned.IsPinned, New: other.IsPinned})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateMessageIsPinned *UpdateMessageIsPinned) String() string {
+	return objectString(updateMessageIsPinned)
+}
+
+// LogValue logs the object as its summary
+func (updateMessageIsPinned *UpdateMessageIsPinned) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateMessageIsPinned))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateMessageIsPinned *UpdateMessageIsPinned) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateMessageIsPinned)
+}
+
+func (updateMessageIsPinned *UpdateMessageIsPinned) printText(p *printer) {
+	if updateMessageIsPinned == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateMessageIsPinned")
+	p.int("chat_id", int64(updateMessageIsPinned.ChatId))
+	p.int("message_id", int64(updateMessageIsPinned.MessageId))
+	p.bool("is_pinned", updateMessageIsPinned.IsPinned)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateMessageIsPinned *UpdateMessageIsPinned) GetUpdateEnum() UpdateEnum {
 	return UpdateMessageIsPinnedType
 }
 
+// Accept calls the method of the visitor for UpdateMessageIsPinned
+func (updateMessageIsPinned *UpdateMessageIsPinned) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateMessageIsPinned(updateMessageIsPinned)
+}
+
 // UpdateMessageInteractionInfo The information about interactions with a message has changed
 type UpdateMessageInteractionInfo struct {
 	tdCommon
@@ -956,11 +1972,148 @@ func NewUpdateMessageInteractionInfo(chatId int64, messageId int64, interactionI
 	return &updateMessageInteractionInfoTemp
 }
 
+// MarshalJSON marshals to json
+func (updateMessageInteractionInfo *UpdateMessageInteractionInfo) MarshalJSON() ([]byte, error) {
+	return updateMessageInteractionInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateMessageInteractionInfo *UpdateMessageInteractionInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateMessageInteractionInfo)
+}
+
+func (updateMessageInteractionInfo *UpdateMessageInteractionInfo) appendJSON(b []byte) ([]byte, error) {
+	if updateMessageInteractionInfo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateMessageInteractionInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateMessageInteractionInfo.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateMessageInteractionInfo.ChatId))
+	b = append(b, `,"message_id":`...)
+	b = appendJSONInt(b, int64(updateMessageInteractionInfo.MessageId))
+	b = append(b, `,"interaction_info":`...)
+	b, err = updateMessageInteractionInfo.InteractionInfo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateMessageInteractionInfo *UpdateMessageInteractionInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateMessageInteractionInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateMessageInteractionInfo.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateMessageInteractionInfo.ChatId, err = r.readInt64()
+		case "message_id":
+			updateMessageInteractionInfo.MessageId, err = r.readInt64()
+		case "interaction_info":
+			if r.readNull() {
+				updateMessageInteractionInfo.InteractionInfo = nil
+			} else {
+				updateMessageInteractionInfo.InteractionInfo = new(MessageInteractionInfo)
+				err = updateMessageInteractionInfo.InteractionInfo.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateMessageInteractionInfo *UpdateMessageInteractionInfo) Clone() *UpdateMessageInteractionInfo {
+	if updateMessageInteractionInfo == nil {
+		return nil
+	}
+	clone := *updateMessageInteractionInfo
+	clone.InteractionInfo = updateMessageInteractionInfo.InteractionInfo.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateMessageInteractionInfo *UpdateMessageInteractionInfo) Equal(other *UpdateMessageInteractionInfo) bool {
+	if updateMessageInteractionInfo == nil || other == nil {
+		return updateMessageInteractionInfo == other
+	}
+	if updateMessageInteractionInfo.ChatId != other.ChatId {
+		return false
+	}
+	if updateMessageInteractionInfo.MessageId != other.MessageId {
+		return false
+	}
+	if !updateMessageInteractionInfo.InteractionInfo.Equal(other.InteractionInfo) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateMessageInteractionInfo *UpdateMessageInteractionInfo) Diff(other *UpdateMessageInteractionInfo) []Change {
+	return updateMessageInteractionInfo.diff("", other, nil)
+}
+
+func (updateMessageInteractionInfo *UpdateMessageInteractionInfo) diff(path string, other *UpdateMessageInteractionInfo, changes []Change) []Change {
+	if updateMessageInteractionInfo == nil || other == nil {
+		if updateMessageInteractionInfo != other {
+			changes = append(changes, Change{Path: path, Old: updateMessageInteractionInfo, New: other})
+		}
+		return changes
+	}
+	if updateMessageInteractionInfo.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateMessageInteractionInfo.ChatId, New: other.ChatId})
+	}
+	if updateMessageInteractionInfo.MessageId != other.MessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "message_id"), Old: updateMessageInteractionInfo.MessageId, New: other.MessageId})
+	}
+	changes = updateMessageInteractionInfo.InteractionInfo.diff(fieldPath(path, "interaction_info"), other.InteractionInfo, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateMessageInteractionInfo *UpdateMessageInteractionInfo) String() string {
+	return objectString(updateMessageInteractionInfo)
+}
+
+// LogValue logs the object as its summary
+func (updateMessageInteractionInfo *UpdateMessageInteractionInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateMessageInteractionInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateMessageInteractionInfo *UpdateMessageInteractionInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateMessageInteractionInfo)
+}
+
+func (updateMessageInteractionInfo *UpdateMessageInteractionInfo) printText(p *printer) {
+	if updateMessageInteractionInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateMessageInteractionInfo")
+	p.int("chat_id", int64(updateMessageInteractionInfo.ChatId))
+	p.int("message_id", int64(updateMessageInteractionInfo.MessageId))
+	p.object("interaction_info", updateMessageInteractionInfo.InteractionInfo)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateMessageInteractionInfo *UpdateMessageInteractionInfo) GetUpdateEnum() UpdateEnum {
 	return UpdateMessageInteractionInfoType
 }
 
+// Accept calls the method of the visitor for UpdateMessageInteractionInfo
+func (updateMessageInteractionInfo *UpdateMessageInteractionInfo) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateMessageInteractionInfo(updateMessageInteractionInfo)
+}
+
 // UpdateMessageContentOpened The message content was opened. Updates voice note messages to "listened", video note messages to "viewed" and starts the TTL timer for self-destructing messages
 type UpdateMessageContentOpened struct {
 	tdCommon
@@ -987,11 +2140,129 @@ func NewUpdateMessageContentOpened(chatId int64, messageId int64) *UpdateMessage
 	return &updateMessageContentOpenedTemp
 }
 
+// MarshalJSON marshals to json
+func (updateMessageContentOpened *UpdateMessageContentOpened) MarshalJSON() ([]byte, error) {
+	return updateMessageContentOpened.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateMessageContentOpened *UpdateMessageContentOpened) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateMessageContentOpened)
+}
+
+func (updateMessageContentOpened *UpdateMessageContentOpened) appendJSON(b []byte) ([]byte, error) {
+	if updateMessageContentOpened == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateMessageContentOpened.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateMessageContentOpened.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateMessageContentOpened.ChatId))
+	b = append(b, `,"message_id":`...)
+	b = appendJSONInt(b, int64(updateMessageContentOpened.MessageId))
+	return append(b, '}'), nil
+}
+
+func (updateMessageContentOpened *UpdateMessageContentOpened) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateMessageContentOpened.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateMessageContentOpened.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateMessageContentOpened.ChatId, err = r.readInt64()
+		case "message_id":
+			updateMessageContentOpened.MessageId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateMessageContentOpened *UpdateMessageContentOpened) Clone() *UpdateMessageContentOpened {
+	if updateMessageContentOpened == nil {
+		return nil
+	}
+	clone := *updateMessageContentOpened
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateMessageContentOpened *UpdateMessageContentOpened) Equal(other *UpdateMessageContentOpened) bool {
+	if updateMessageContentOpened == nil || other == nil {
+		return updateMessageContentOpened == other
+	}
+	if updateMessageContentOpened.ChatId != other.ChatId {
+		return false
+	}
+	if updateMessageContentOpened.MessageId != other.MessageId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateMessageContentOpened *UpdateMessageContentOpened) Diff(other *UpdateMessageContentOpened) []Change {
+	return updateMessageContentOpened.diff("", other, nil)
+}
+
+func (updateMessageContentOpened *UpdateMessageContentOpened) diff(path string, other *UpdateMessageContentOpened, changes []Change) []Change {
+	if updateMessageContentOpened == nil || other == nil {
+		if updateMessageContentOpened != other {
+			changes = append(changes, Change{Path: path, Old: updateMessageContentOpened, New: other})
+		}
+		return changes
+	}
+	if updateMessageContentOpened.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateMessageContentOpened.ChatId, New: other.ChatId})
+	}
+	if updateMessageContentOpened.MessageId != other.MessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "message_id"), Old: updateMessageContentOpened.MessageId, New: other.MessageId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateMessageContentOpened *UpdateMessageContentOpened) String() string {
+	return objectString(updateMessageContentOpened)
+}
+
+// LogValue logs the object as its summary
+func (updateMessageContentOpened *UpdateMessageContentOpened) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateMessageContentOpened))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateMessageContentOpened *UpdateMessageContentOpened) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateMessageContentOpened)
+}
+
+func (updateMessageContentOpened *UpdateMessageContentOpened) printText(p *printer) {
+	if updateMessageContentOpened == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateMessageContentOpened")
+	p.int("chat_id", int64(updateMessageContentOpened.ChatId))
+	p.int("message_id", int64(updateMessageContentOpened.MessageId))
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateMessageContentOpened *UpdateMessageContentOpened) GetUpdateEnum() UpdateEnum {
 	return UpdateMessageContentOpenedType
 }
 
+// Accept calls the method of the visitor for UpdateMessageContentOpened
+func (updateMessageContentOpened *UpdateMessageContentOpened) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateMessageContentOpened(updateMessageContentOpened)
+}
+
 // UpdateMessageMentionRead A message with an unread mention was read
 type UpdateMessageMentionRead struct {
 	tdCommon
@@ -1021,11 +2292,140 @@ func NewUpdateMessageMentionRead(chatId int64, messageId int64, unreadMentionCou
 	return &updateMessageMentionReadTemp
 }
 
+// MarshalJSON marshals to json
+func (updateMessageMentionRead *UpdateMessageMentionRead) MarshalJSON() ([]byte, error) {
+	return updateMessageMentionRead.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateMessageMentionRead *UpdateMessageMentionRead) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateMessageMentionRead)
+}
+
+func (updateMessageMentionRead *UpdateMessageMentionRead) appendJSON(b []byte) ([]byte, error) {
+	if updateMessageMentionRead == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateMessageMentionRead.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateMessageMentionRead.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateMessageMentionRead.ChatId))
+	b = append(b, `,"message_id":`...)
+	b = appendJSONInt(b, int64(updateMessageMentionRead.MessageId))
+	b = append(b, `,"unread_mention_count":`...)
+	b = appendJSONInt(b, int64(updateMessageMentionRead.UnreadMentionCount))
+	return append(b, '}'), nil
+}
+
+func (updateMessageMentionRead *UpdateMessageMentionRead) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateMessageMentionRead.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateMessageMentionRead.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateMessageMentionRead.ChatId, err = r.readInt64()
+		case "message_id":
+			updateMessageMentionRead.MessageId, err = r.readInt64()
+		case "unread_mention_count":
+			updateMessageMentionRead.UnreadMentionCount, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateMessageMentionRead *UpdateMessageMentionRead) Clone() *UpdateMessageMentionRead {
+	if updateMessageMentionRead == nil {
+		return nil
+	}
+	clone := *updateMessageMentionRead
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateMessageMentionRead *UpdateMessageMentionRead) Equal(other *UpdateMessageMentionRead) bool {
+	if updateMessageMentionRead == nil || other == nil {
+		return updateMessageMentionRead == other
+	}
+	if updateMessageMentionRead.ChatId != other.ChatId {
+		return false
+	}
+	if updateMessageMentionRead.MessageId != other.MessageId {
+		return false
+	}
+	if updateMessageMentionRead.UnreadMentionCount != other.UnreadMentionCount {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateMessageMentionRead *UpdateMessageMentionRead) Diff(other *UpdateMessageMentionRead) []Change {
+	return updateMessageMentionRead.diff("", other, nil)
+}
+
+func (updateMessageMentionRead *UpdateMessageMentionRead) diff(path string, other *UpdateMessageMentionRead, changes []Change) []Change {
+	if updateMessageMentionRead == nil || other == nil {
+		if updateMessageMentionRead != other {
+			changes = append(changes, Change{Path: path, Old: updateMessageMentionRead, New: other})
+		}
+		return changes
+	}
+	if updateMessageMentionRead.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateMessageMentionRead.ChatId, New: other.ChatId})
+	}
+	if updateMessageMentionRead.MessageId != other.MessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "message_id"), Old: updateMessageMentionRead.MessageId, New: other.MessageId})
+	}
+	if updateMessageMentionRead.UnreadMentionCount != other.UnreadMentionCount {
+		changes = append(changes, Change{Path: fieldPath(path, "unread_mention_count"), Old: updateMessageMentionRead.UnreadMentionCount, New: other.UnreadMentionCount})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateMessageMentionRead *UpdateMessageMentionRead) String() string {
+	return objectString(updateMessageMentionRead)
+}
+
+// LogValue logs the object as its summary
+func (updateMessageMentionRead *UpdateMessageMentionRead) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateMessageMentionRead))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateMessageMentionRead *UpdateMessageMentionRead) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateMessageMentionRead)
+}
+
+func (updateMessageMentionRead *UpdateMessageMentionRead) printText(p *printer) {
+	if updateMessageMentionRead == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateMessageMentionRead")
+	p.int("chat_id", int64(updateMessageMentionRead.ChatId))
+	p.int("message_id", int64(updateMessageMentionRead.MessageId))
+	p.int("unread_mention_count", int64(updateMessageMentionRead.UnreadMentionCount))
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateMessageMentionRead *UpdateMessageMentionRead) GetUpdateEnum() UpdateEnum {
 	return UpdateMessageMentionReadType
 }
 
+// Accept calls the method of the visitor for UpdateMessageMentionRead
+func (updateMessageMentionRead *UpdateMessageMentionRead) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateMessageMentionRead(updateMessageMentionRead)
+}
+
 // UpdateMessageLiveLocationViewed A message with a live location was viewed. When the update is received, the application is supposed to update the live location
 type UpdateMessageLiveLocationViewed struct {
 	tdCommon
@@ -1052,11 +2452,129 @@ func NewUpdateMessageLiveLocationViewed(chatId int64, messageId int64) *UpdateMe
 	return &updateMessageLiveLocationViewedTemp
 }
 
+// MarshalJSON marshals to json
+func (updateMessageLiveLocationViewed *UpdateMessageLiveLocationViewed) MarshalJSON() ([]byte, error) {
+	return updateMessageLiveLocationViewed.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateMessageLiveLocationViewed *UpdateMessageLiveLocationViewed) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateMessageLiveLocationViewed)
+}
+
+func (updateMessageLiveLocationViewed *UpdateMessageLiveLocationViewed) appendJSON(b []byte) ([]byte, error) {
+	if updateMessageLiveLocationViewed == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateMessageLiveLocationViewed.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateMessageLiveLocationViewed.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateMessageLiveLocationViewed.ChatId))
+	b = append(b, `,"message_id":`...)
+	b = appendJSONInt(b, int64(updateMessageLiveLocationViewed.MessageId))
+	return append(b, '}'), nil
+}
+
+func (updateMessageLiveLocationViewed *UpdateMessageLiveLocationViewed) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateMessageLiveLocationViewed.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateMessageLiveLocationViewed.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateMessageLiveLocationViewed.ChatId, err = r.readInt64()
+		case "message_id":
+			updateMessageLiveLocationViewed.MessageId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateMessageLiveLocationViewed *UpdateMessageLiveLocationViewed) Clone() *UpdateMessageLiveLocationViewed {
+	if updateMessageLiveLocationViewed == nil {
+		return nil
+	}
+	clone := *updateMessageLiveLocationViewed
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateMessageLiveLocationViewed *UpdateMessageLiveLocationViewed) Equal(other *UpdateMessageLiveLocationViewed) bool {
+	if updateMessageLiveLocationViewed == nil || other == nil {
+		return updateMessageLiveLocationViewed == other
+	}
+	if updateMessageLiveLocationViewed.ChatId != other.ChatId {
+		return false
+	}
+	if updateMessageLiveLocationViewed.MessageId != other.MessageId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateMessageLiveLocationViewed *UpdateMessageLiveLocationViewed) Diff(other *UpdateMessageLiveLocationViewed) []Change {
+	return updateMessageLiveLocationViewed.diff("", other, nil)
+}
+
+func (updateMessageLiveLocationViewed *UpdateMessageLiveLocationViewed) diff(path string, other *UpdateMessageLiveLocationViewed, changes []Change) []Change {
+	if updateMessageLiveLocationViewed == nil || other == nil {
+		if updateMessageLiveLocationViewed != other {
+			changes = append(changes, Change{Path: path, Old: updateMessageLiveLocationViewed, New: other})
+		}
+		return changes
+	}
+	if updateMessageLiveLocationViewed.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateMessageLiveLocationViewed.ChatId, New: other.ChatId})
+	}
+	if updateMessageLiveLocationViewed.MessageId != other.MessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "message_id"), Old: updateMessageLiveLocationViewed.MessageId, New: other.MessageId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateMessageLiveLocationViewed *UpdateMessageLiveLocationViewed) String() string {
+	return objectString(updateMessageLiveLocationViewed)
+}
+
+// LogValue logs the object as its summary
+func (updateMessageLiveLocationViewed *UpdateMessageLiveLocationViewed) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateMessageLiveLocationViewed))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateMessageLiveLocationViewed *UpdateMessageLiveLocationViewed) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateMessageLiveLocationViewed)
+}
+
+func (updateMessageLiveLocationViewed *UpdateMessageLiveLocationViewed) printText(p *printer) {
+	if updateMessageLiveLocationViewed == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateMessageLiveLocationViewed")
+	p.int("chat_id", int64(updateMessageLiveLocationViewed.ChatId))
+	p.int("message_id", int64(updateMessageLiveLocationViewed.MessageId))
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateMessageLiveLocationViewed *UpdateMessageLiveLocationViewed) GetUpdateEnum() UpdateEnum {
 	return UpdateMessageLiveLocationViewedType
 }
 
+// Accept calls the method of the visitor for UpdateMessageLiveLocationViewed
+func (updateMessageLiveLocationViewed *UpdateMessageLiveLocationViewed) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateMessageLiveLocationViewed(updateMessageLiveLocationViewed)
+}
+
 // UpdateNewChat A new chat has been loaded/created. This update is guaranteed to come before the chat identifier is returned to the application. The chat field changes will be reported through separate updates
 type UpdateNewChat struct {
 	tdCommon
@@ -1080,11 +2598,126 @@ func NewUpdateNewChat(chat *Chat) *UpdateNewChat {
 	return &updateNewChatTemp
 }
 
+// MarshalJSON marshals to json
+func (updateNewChat *UpdateNewChat) MarshalJSON() ([]byte, error) {
+	return updateNewChat.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateNewChat *UpdateNewChat) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateNewChat)
+}
+
+func (updateNewChat *UpdateNewChat) appendJSON(b []byte) ([]byte, error) {
+	if updateNewChat == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateNewChat.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateNewChat.tdCommon.Extra)
+	b = append(b, `,"chat":`...)
+	b, err = updateNewChat.Chat.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateNewChat *UpdateNewChat) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateNewChat.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateNewChat.tdCommon.Extra, err = r.readString()
+		case "chat":
+			if r.readNull() {
+				updateNewChat.Chat = nil
+			} else {
+				updateNewChat.Chat = new(Chat)
+				err = updateNewChat.Chat.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateNewChat *UpdateNewChat) Clone() *UpdateNewChat {
+	if updateNewChat == nil {
+		return nil
+	}
+	clone := *updateNewChat
+	clone.Chat = updateNewChat.Chat.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateNewChat *UpdateNewChat) Equal(other *UpdateNewChat) bool {
+	if updateNewChat == nil || other == nil {
+		return updateNewChat == other
+	}
+	if !updateNewChat.Chat.Equal(other.Chat) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateNewChat *UpdateNewChat) Diff(other *UpdateNewChat) []Change {
+	return updateNewChat.diff("", other, nil)
+}
+
+func (updateNewChat *UpdateNewChat) diff(path string, other *UpdateNewChat, changes []Change) []Change {
+	if updateNewChat == nil || other == nil {
+		if updateNewChat != other {
+			changes = append(changes, Change{Path: path, Old: updateNewChat, New: other})
+		}
+		return changes
+	}
+	changes = updateNewChat.Chat.diff(fieldPath(path, "chat"), other.Chat, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateNewChat *UpdateNewChat) String() string {
+	return objectString(updateNewChat)
+}
+
+// LogValue logs the object as its summary
+func (updateNewChat *UpdateNewChat) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateNewChat))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateNewChat *UpdateNewChat) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateNewChat)
+}
+
+func (updateNewChat *UpdateNewChat) printText(p *printer) {
+	if updateNewChat == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateNewChat")
+	p.object("chat", updateNewChat.Chat)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateNewChat *UpdateNewChat) GetUpdateEnum() UpdateEnum {
 	return UpdateNewChatType
 }
 
+// Accept calls the method of the visitor for UpdateNewChat
+func (updateNewChat *UpdateNewChat) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateNewChat(updateNewChat)
+}
+
 // UpdateChatTitle The title of a chat was changed
 type UpdateChatTitle struct {
 	tdCommon
@@ -1111,29 +2744,147 @@ func NewUpdateChatTitle(chatId int64, title string) *UpdateChatTitle {
 	return &updateChatTitleTemp
 }
 
-// GetUpdateEnum return the enum type of this object
-func (updateChatTitle *UpdateChatTitle) GetUpdateEnum() UpdateEnum {
-	return UpdateChatTitleType
+// MarshalJSON marshals to json
+func (updateChatTitle *UpdateChatTitle) MarshalJSON() ([]byte, error) {
+	return updateChatTitle.appendJSON(nil)
 }
 
-// UpdateChatPhoto A chat photo was changed
-type UpdateChatPhoto struct {
-	tdCommon
-	ChatId int64          `json:"chat_id"` // Chat identifier
-	Photo  *ChatPhotoInfo `json:"photo"`   // The new chat photo; may be null
+// UnmarshalJSON unmarshal to json
+func (updateChatTitle *UpdateChatTitle) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateChatTitle)
 }
 
-// MessageType return the string telegram-type of UpdateChatPhoto
-func (updateChatPhoto *UpdateChatPhoto) MessageType() string {
-	return "updateChatPhoto"
+func (updateChatTitle *UpdateChatTitle) appendJSON(b []byte) ([]byte, error) {
+	if updateChatTitle == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateChatTitle.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateChatTitle.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateChatTitle.ChatId))
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, updateChatTitle.Title)
+	return append(b, '}'), nil
+}
+
+func (updateChatTitle *UpdateChatTitle) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateChatTitle.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateChatTitle.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateChatTitle.ChatId, err = r.readInt64()
+		case "title":
+			updateChatTitle.Title, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
 }
 
-// NewUpdateChatPhoto creates a new UpdateChatPhoto
-//
-// @param chatId Chat identifier
-// @param photo The new chat photo; may be null
-func NewUpdateChatPhoto(chatId int64, photo *ChatPhotoInfo) *UpdateChatPhoto {
-	updateChatPhotoTemp := UpdateChatPhoto{
+// Clone returns a deep copy of the object
+func (updateChatTitle *UpdateChatTitle) Clone() *UpdateChatTitle {
+	if updateChatTitle == nil {
+		return nil
+	}
+	clone := *updateChatTitle
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateChatTitle *UpdateChatTitle) Equal(other *UpdateChatTitle) bool {
+	if updateChatTitle == nil || other == nil {
+		return updateChatTitle == other
+	}
+	if updateChatTitle.ChatId != other.ChatId {
+		return false
+	}
+	if updateChatTitle.Title != other.Title {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateChatTitle *UpdateChatTitle) Diff(other *UpdateChatTitle) []Change {
+	return updateChatTitle.diff("", other, nil)
+}
+
+func (updateChatTitle *UpdateChatTitle) diff(path string, other *UpdateChatTitle, changes []Change) []Change {
+	if updateChatTitle == nil || other == nil {
+		if updateChatTitle != other {
+			changes = append(changes, Change{Path: path, Old: updateChatTitle, New: other})
+		}
+		return changes
+	}
+	if updateChatTitle.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateChatTitle.ChatId, New: other.ChatId})
+	}
+	if updateChatTitle.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: updateChatTitle.Title, New: other.Title})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateChatTitle *UpdateChatTitle) String() string {
+	return objectString(updateChatTitle)
+}
+
+// LogValue logs the object as its summary
+func (updateChatTitle *UpdateChatTitle) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateChatTitle))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateChatTitle *UpdateChatTitle) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateChatTitle)
+}
+
+func (updateChatTitle *UpdateChatTitle) printText(p *printer) {
+	if updateChatTitle == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateChatTitle")
+	p.int("chat_id", int64(updateChatTitle.ChatId))
+	p.string("title", updateChatTitle.Title)
+	p.endObject(state)
+}
+
+// GetUpdateEnum return the enum type of this object
+func (updateChatTitle *UpdateChatTitle) GetUpdateEnum() UpdateEnum {
+	return UpdateChatTitleType
+}
+
+// Accept calls the method of the visitor for UpdateChatTitle
+func (updateChatTitle *UpdateChatTitle) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateChatTitle(updateChatTitle)
+}
+
+// UpdateChatPhoto A chat photo was changed
+type UpdateChatPhoto struct {
+	tdCommon
+	ChatId int64          `json:"chat_id"` // Chat identifier
+	Photo  *ChatPhotoInfo `json:"photo"`   // The new chat photo; may be null
+}
+
+// MessageType return the string telegram-type of UpdateChatPhoto
+func (updateChatPhoto *UpdateChatPhoto) MessageType() string {
+	return "updateChatPhoto"
+}
+
+// NewUpdateChatPhoto creates a new UpdateChatPhoto
+//
+// @param chatId Chat identifier
+// @param photo The new chat photo; may be null
+func NewUpdateChatPhoto(chatId int64, photo *ChatPhotoInfo) *UpdateChatPhoto {
+	updateChatPhotoTemp := UpdateChatPhoto{
 		tdCommon: tdCommon{Type: "updateChatPhoto"},
 		ChatId:   chatId,
 		Photo:    photo,
@@ -1142,11 +2893,137 @@ func NewUpdateChatPhoto(chatId int64, photo *ChatPhotoInfo) *UpdateChatPhoto {
 	return &updateChatPhotoTemp
 }
 
+// MarshalJSON marshals to json
+func (updateChatPhoto *UpdateChatPhoto) MarshalJSON() ([]byte, error) {
+	return updateChatPhoto.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateChatPhoto *UpdateChatPhoto) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateChatPhoto)
+}
+
+func (updateChatPhoto *UpdateChatPhoto) appendJSON(b []byte) ([]byte, error) {
+	if updateChatPhoto == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateChatPhoto.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateChatPhoto.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateChatPhoto.ChatId))
+	b = append(b, `,"photo":`...)
+	b, err = updateChatPhoto.Photo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateChatPhoto *UpdateChatPhoto) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateChatPhoto.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateChatPhoto.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateChatPhoto.ChatId, err = r.readInt64()
+		case "photo":
+			if r.readNull() {
+				updateChatPhoto.Photo = nil
+			} else {
+				updateChatPhoto.Photo = new(ChatPhotoInfo)
+				err = updateChatPhoto.Photo.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateChatPhoto *UpdateChatPhoto) Clone() *UpdateChatPhoto {
+	if updateChatPhoto == nil {
+		return nil
+	}
+	clone := *updateChatPhoto
+	clone.Photo = updateChatPhoto.Photo.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateChatPhoto *UpdateChatPhoto) Equal(other *UpdateChatPhoto) bool {
+	if updateChatPhoto == nil || other == nil {
+		return updateChatPhoto == other
+	}
+	if updateChatPhoto.ChatId != other.ChatId {
+		return false
+	}
+	if !updateChatPhoto.Photo.Equal(other.Photo) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateChatPhoto *UpdateChatPhoto) Diff(other *UpdateChatPhoto) []Change {
+	return updateChatPhoto.diff("", other, nil)
+}
+
+func (updateChatPhoto *UpdateChatPhoto) diff(path string, other *UpdateChatPhoto, changes []Change) []Change {
+	if updateChatPhoto == nil || other == nil {
+		if updateChatPhoto != other {
+			changes = append(changes, Change{Path: path, Old: updateChatPhoto, New: other})
+		}
+		return changes
+	}
+	if updateChatPhoto.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateChatPhoto.ChatId, New: other.ChatId})
+	}
+	changes = updateChatPhoto.Photo.diff(fieldPath(path, "photo"), other.Photo, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateChatPhoto *UpdateChatPhoto) String() string {
+	return objectString(updateChatPhoto)
+}
+
+// LogValue logs the object as its summary
+func (updateChatPhoto *UpdateChatPhoto) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateChatPhoto))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateChatPhoto *UpdateChatPhoto) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateChatPhoto)
+}
+
+func (updateChatPhoto *UpdateChatPhoto) printText(p *printer) {
+	if updateChatPhoto == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateChatPhoto")
+	p.int("chat_id", int64(updateChatPhoto.ChatId))
+	p.object("photo", updateChatPhoto.Photo)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateChatPhoto *UpdateChatPhoto) GetUpdateEnum() UpdateEnum {
 	return UpdateChatPhotoType
 }
 
+// Accept calls the method of the visitor for UpdateChatPhoto
+func (updateChatPhoto *UpdateChatPhoto) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateChatPhoto(updateChatPhoto)
+}
+
 // UpdateChatPermissions Chat permissions was changed
 type UpdateChatPermissions struct {
 	tdCommon
@@ -1173,11 +3050,137 @@ func NewUpdateChatPermissions(chatId int64, permissions *ChatPermissions) *Updat
 	return &updateChatPermissionsTemp
 }
 
+// MarshalJSON marshals to json
+func (updateChatPermissions *UpdateChatPermissions) MarshalJSON() ([]byte, error) {
+	return updateChatPermissions.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateChatPermissions *UpdateChatPermissions) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateChatPermissions)
+}
+
+func (updateChatPermissions *UpdateChatPermissions) appendJSON(b []byte) ([]byte, error) {
+	if updateChatPermissions == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateChatPermissions.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateChatPermissions.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateChatPermissions.ChatId))
+	b = append(b, `,"permissions":`...)
+	b, err = updateChatPermissions.Permissions.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateChatPermissions *UpdateChatPermissions) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateChatPermissions.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateChatPermissions.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateChatPermissions.ChatId, err = r.readInt64()
+		case "permissions":
+			if r.readNull() {
+				updateChatPermissions.Permissions = nil
+			} else {
+				updateChatPermissions.Permissions = new(ChatPermissions)
+				err = updateChatPermissions.Permissions.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateChatPermissions *UpdateChatPermissions) Clone() *UpdateChatPermissions {
+	if updateChatPermissions == nil {
+		return nil
+	}
+	clone := *updateChatPermissions
+	clone.Permissions = updateChatPermissions.Permissions.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateChatPermissions *UpdateChatPermissions) Equal(other *UpdateChatPermissions) bool {
+	if updateChatPermissions == nil || other == nil {
+		return updateChatPermissions == other
+	}
+	if updateChatPermissions.ChatId != other.ChatId {
+		return false
+	}
+	if !updateChatPermissions.Permissions.Equal(other.Permissions) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateChatPermissions *UpdateChatPermissions) Diff(other *UpdateChatPermissions) []Change {
+	return updateChatPermissions.diff("", other, nil)
+}
+
+func (updateChatPermissions *UpdateChatPermissions) diff(path string, other *UpdateChatPermissions, changes []Change) []Change {
+	if updateChatPermissions == nil || other == nil {
+		if updateChatPermissions != other {
+			changes = append(changes, Change{Path: path, Old: updateChatPermissions, New: other})
+		}
+		return changes
+	}
+	if updateChatPermissions.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateChatPermissions.ChatId, New: other.ChatId})
+	}
+	changes = updateChatPermissions.Permissions.diff(fieldPath(path, "permissions"), other.Permissions, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateChatPermissions *UpdateChatPermissions) String() string {
+	return objectString(updateChatPermissions)
+}
+
+// LogValue logs the object as its summary
+func (updateChatPermissions *UpdateChatPermissions) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateChatPermissions))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateChatPermissions *UpdateChatPermissions) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateChatPermissions)
+}
+
+func (updateChatPermissions *UpdateChatPermissions) printText(p *printer) {
+	if updateChatPermissions == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateChatPermissions")
+	p.int("chat_id", int64(updateChatPermissions.ChatId))
+	p.object("permissions", updateChatPermissions.Permissions)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateChatPermissions *UpdateChatPermissions) GetUpdateEnum() UpdateEnum {
 	return UpdateChatPermissionsType
 }
 
+// Accept calls the method of the visitor for UpdateChatPermissions
+func (updateChatPermissions *UpdateChatPermissions) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateChatPermissions(updateChatPermissions)
+}
+
 // UpdateChatLastMessage The last message of a chat was changed. If last_message is null, then the last message in the chat became unknown. Some new unknown messages might be added to the chat in this case
 type UpdateChatLastMessage struct {
 	tdCommon
@@ -1207,11 +3210,189 @@ func NewUpdateChatLastMessage(chatId int64, lastMessage *Message, positions []Ch
 	return &updateChatLastMessageTemp
 }
 
+// MarshalJSON marshals to json
+func (updateChatLastMessage *UpdateChatLastMessage) MarshalJSON() ([]byte, error) {
+	return updateChatLastMessage.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateChatLastMessage *UpdateChatLastMessage) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateChatLastMessage)
+}
+
+func (updateChatLastMessage *UpdateChatLastMessage) appendJSON(b []byte) ([]byte, error) {
+	if updateChatLastMessage == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateChatLastMessage.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateChatLastMessage.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateChatLastMessage.ChatId))
+	b = append(b, `,"last_message":`...)
+	b, err = updateChatLastMessage.LastMessage.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"positions":`...)
+	if updateChatLastMessage.Positions == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range updateChatLastMessage.Positions {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = updateChatLastMessage.Positions[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (updateChatLastMessage *UpdateChatLastMessage) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateChatLastMessage.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateChatLastMessage.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateChatLastMessage.ChatId, err = r.readInt64()
+		case "last_message":
+			if r.readNull() {
+				updateChatLastMessage.LastMessage = nil
+			} else {
+				updateChatLastMessage.LastMessage = new(Message)
+				err = updateChatLastMessage.LastMessage.decodeJSON(r)
+			}
+		case "positions":
+			if r.readNull() {
+				updateChatLastMessage.Positions = nil
+			} else {
+				updateChatLastMessage.Positions = make([]ChatPosition, 0)
+				err = r.readArray(func() (err error) {
+					var item0 ChatPosition
+					err = item0.decodeJSON(r)
+					updateChatLastMessage.Positions = append(updateChatLastMessage.Positions, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateChatLastMessage *UpdateChatLastMessage) Clone() *UpdateChatLastMessage {
+	if updateChatLastMessage == nil {
+		return nil
+	}
+	clone := *updateChatLastMessage
+	clone.LastMessage = updateChatLastMessage.LastMessage.Clone()
+	if updateChatLastMessage.Positions != nil {
+		clone.Positions = make([]ChatPosition, len(updateChatLastMessage.Positions))
+		for i0 := range updateChatLastMessage.Positions {
+			clone.Positions[i0] = *updateChatLastMessage.Positions[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateChatLastMessage *UpdateChatLastMessage) Equal(other *UpdateChatLastMessage) bool {
+	if updateChatLastMessage == nil || other == nil {
+		return updateChatLastMessage == other
+	}
+	if updateChatLastMessage.ChatId != other.ChatId {
+		return false
+	}
+	if !updateChatLastMessage.LastMessage.Equal(other.LastMessage) {
+		return false
+	}
+	if len(updateChatLastMessage.Positions) != len(other.Positions) {
+		return false
+	}
+	for i0 := range updateChatLastMessage.Positions {
+		if !updateChatLastMessage.Positions[i0].Equal(&other.Positions[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateChatLastMessage *UpdateChatLastMessage) Diff(other *UpdateChatLastMessage) []Change {
+	return updateChatLastMessage.diff("", other, nil)
+}
+
+func (updateChatLastMessage *UpdateChatLastMessage) diff(path string, other *UpdateChatLastMessage, changes []Change) []Change {
+	if updateChatLastMessage == nil || other == nil {
+		if updateChatLastMessage != other {
+			changes = append(changes, Change{Path: path, Old: updateChatLastMessage, New: other})
+		}
+		return changes
+	}
+	if updateChatLastMessage.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateChatLastMessage.ChatId, New: other.ChatId})
+	}
+	changes = updateChatLastMessage.LastMessage.diff(fieldPath(path, "last_message"), other.LastMessage, changes)
+	if len(updateChatLastMessage.Positions) != len(other.Positions) {
+		changes = append(changes, Change{Path: fieldPath(path, "positions"), Old: updateChatLastMessage.Positions, New: other.Positions})
+	} else {
+		for i0 := range updateChatLastMessage.Positions {
+			changes = updateChatLastMessage.Positions[i0].diff(indexPath(fieldPath(path, "positions"), i0), &other.Positions[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateChatLastMessage *UpdateChatLastMessage) String() string {
+	return objectString(updateChatLastMessage)
+}
+
+// LogValue logs the object as its summary
+func (updateChatLastMessage *UpdateChatLastMessage) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateChatLastMessage))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateChatLastMessage *UpdateChatLastMessage) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateChatLastMessage)
+}
+
+func (updateChatLastMessage *UpdateChatLastMessage) printText(p *printer) {
+	if updateChatLastMessage == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateChatLastMessage")
+	p.int("chat_id", int64(updateChatLastMessage.ChatId))
+	p.object("last_message", updateChatLastMessage.LastMessage)
+	p.vector("positions", len(updateChatLastMessage.Positions), func(i0 int) {
+		updateChatLastMessage.Positions[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateChatLastMessage *UpdateChatLastMessage) GetUpdateEnum() UpdateEnum {
 	return UpdateChatLastMessageType
 }
 
+// Accept calls the method of the visitor for UpdateChatLastMessage
+func (updateChatLastMessage *UpdateChatLastMessage) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateChatLastMessage(updateChatLastMessage)
+}
+
 // UpdateChatPosition The position of a chat in a chat list has changed. Instead of this update updateChatLastMessage or updateChatDraftMessage might be sent
 type UpdateChatPosition struct {
 	tdCommon
@@ -1238,11 +3419,137 @@ func NewUpdateChatPosition(chatId int64, position *ChatPosition) *UpdateChatPosi
 	return &updateChatPositionTemp
 }
 
+// MarshalJSON marshals to json
+func (updateChatPosition *UpdateChatPosition) MarshalJSON() ([]byte, error) {
+	return updateChatPosition.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateChatPosition *UpdateChatPosition) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateChatPosition)
+}
+
+func (updateChatPosition *UpdateChatPosition) appendJSON(b []byte) ([]byte, error) {
+	if updateChatPosition == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateChatPosition.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateChatPosition.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateChatPosition.ChatId))
+	b = append(b, `,"position":`...)
+	b, err = updateChatPosition.Position.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateChatPosition *UpdateChatPosition) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateChatPosition.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateChatPosition.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateChatPosition.ChatId, err = r.readInt64()
+		case "position":
+			if r.readNull() {
+				updateChatPosition.Position = nil
+			} else {
+				updateChatPosition.Position = new(ChatPosition)
+				err = updateChatPosition.Position.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateChatPosition *UpdateChatPosition) Clone() *UpdateChatPosition {
+	if updateChatPosition == nil {
+		return nil
+	}
+	clone := *updateChatPosition
+	clone.Position = updateChatPosition.Position.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateChatPosition *UpdateChatPosition) Equal(other *UpdateChatPosition) bool {
+	if updateChatPosition == nil || other == nil {
+		return updateChatPosition == other
+	}
+	if updateChatPosition.ChatId != other.ChatId {
+		return false
+	}
+	if !updateChatPosition.Position.Equal(other.Position) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateChatPosition *UpdateChatPosition) Diff(other *UpdateChatPosition) []Change {
+	return updateChatPosition.diff("", other, nil)
+}
+
+func (updateChatPosition *UpdateChatPosition) diff(path string, other *UpdateChatPosition, changes []Change) []Change {
+	if updateChatPosition == nil || other == nil {
+		if updateChatPosition != other {
+			changes = append(changes, Change{Path: path, Old: updateChatPosition, New: other})
+		}
+		return changes
+	}
+	if updateChatPosition.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateChatPosition.ChatId, New: other.ChatId})
+	}
+	changes = updateChatPosition.Position.diff(fieldPath(path, "position"), other.Position, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateChatPosition *UpdateChatPosition) String() string {
+	return objectString(updateChatPosition)
+}
+
+// LogValue logs the object as its summary
+func (updateChatPosition *UpdateChatPosition) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateChatPosition))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateChatPosition *UpdateChatPosition) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateChatPosition)
+}
+
+func (updateChatPosition *UpdateChatPosition) printText(p *printer) {
+	if updateChatPosition == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateChatPosition")
+	p.int("chat_id", int64(updateChatPosition.ChatId))
+	p.object("position", updateChatPosition.Position)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateChatPosition *UpdateChatPosition) GetUpdateEnum() UpdateEnum {
 	return UpdateChatPositionType
 }
 
+// Accept calls the method of the visitor for UpdateChatPosition
+func (updateChatPosition *UpdateChatPosition) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateChatPosition(updateChatPosition)
+}
+
 // UpdateChatReadInbox Incoming messages were read or the number of unread messages has been changed
 type UpdateChatReadInbox struct {
 	tdCommon
@@ -1272,11 +3579,140 @@ func NewUpdateChatReadInbox(chatId int64, lastReadInboxMessageId int64, unreadCo
 	return &updateChatReadInboxTemp
 }
 
+// MarshalJSON marshals to json
+func (updateChatReadInbox *UpdateChatReadInbox) MarshalJSON() ([]byte, error) {
+	return updateChatReadInbox.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateChatReadInbox *UpdateChatReadInbox) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateChatReadInbox)
+}
+
+func (updateChatReadInbox *UpdateChatReadInbox) appendJSON(b []byte) ([]byte, error) {
+	if updateChatReadInbox == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateChatReadInbox.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateChatReadInbox.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateChatReadInbox.ChatId))
+	b = append(b, `,"last_read_inbox_message_id":`...)
+	b = appendJSONInt(b, int64(updateChatReadInbox.LastReadInboxMessageId))
+	b = append(b, `,"unread_count":`...)
+	b = appendJSONInt(b, int64(updateChatReadInbox.UnreadCount))
+	return append(b, '}'), nil
+}
+
+func (updateChatReadInbox *UpdateChatReadInbox) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateChatReadInbox.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateChatReadInbox.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateChatReadInbox.ChatId, err = r.readInt64()
+		case "last_read_inbox_message_id":
+			updateChatReadInbox.LastReadInboxMessageId, err = r.readInt64()
+		case "unread_count":
+			updateChatReadInbox.UnreadCount, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateChatReadInbox *UpdateChatReadInbox) Clone() *UpdateChatReadInbox {
+	if updateChatReadInbox == nil {
+		return nil
+	}
+	clone := *updateChatReadInbox
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateChatReadInbox *UpdateChatReadInbox) Equal(other *UpdateChatReadInbox) bool {
+	if updateChatReadInbox == nil || other == nil {
+		return updateChatReadInbox == other
+	}
+	if updateChatReadInbox.ChatId != other.ChatId {
+		return false
+	}
+	if updateChatReadInbox.LastReadInboxMessageId != other.LastReadInboxMessageId {
+		return false
+	}
+	if updateChatReadInbox.UnreadCount != other.UnreadCount {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateChatReadInbox *UpdateChatReadInbox) Diff(other *UpdateChatReadInbox) []Change {
+	return updateChatReadInbox.diff("", other, nil)
+}
+
+func (updateChatReadInbox *UpdateChatReadInbox) diff(path string, other *UpdateChatReadInbox, changes []Change) []Change {
+	if updateChatReadInbox == nil || other == nil {
+		if updateChatReadInbox != other {
+			changes = append(changes, Change{Path: path, Old: updateChatReadInbox, New: other})
+		}
+		return changes
+	}
+	if updateChatReadInbox.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateChatReadInbox.ChatId, New: other.ChatId})
+	}
+	if updateChatReadInbox.LastReadInboxMessageId != other.LastReadInboxMessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "last_read_inbox_message_id"), Old: updateChatReadInbox.LastReadInboxMessageId, New: other.LastReadInboxMessageId})
+	}
+	if updateChatReadInbox.UnreadCount != other.UnreadCount {
+		changes = append(changes, Change{Path: fieldPath(path, "unread_count"), Old: updateChatReadInbox.UnreadCount, New: other.UnreadCount})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateChatReadInbox *UpdateChatReadInbox) String() string {
+	return objectString(updateChatReadInbox)
+}
+
+// LogValue logs the object as its summary
+func (updateChatReadInbox *UpdateChatReadInbox) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateChatReadInbox))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateChatReadInbox *UpdateChatReadInbox) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateChatReadInbox)
+}
+
+func (updateChatReadInbox *UpdateChatReadInbox) printText(p *printer) {
+	if updateChatReadInbox == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateChatReadInbox")
+	p.int("chat_id", int64(updateChatReadInbox.ChatId))
+	p.int("last_read_inbox_message_id", int64(updateChatReadInbox.LastReadInboxMessageId))
+	p.int("unread_count", int64(updateChatReadInbox.UnreadCount))
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateChatReadInbox *UpdateChatReadInbox) GetUpdateEnum() UpdateEnum {
 	return UpdateChatReadInboxType
 }
 
+// Accept calls the method of the visitor for UpdateChatReadInbox
+func (updateChatReadInbox *UpdateChatReadInbox) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateChatReadInbox(updateChatReadInbox)
+}
+
 // UpdateChatReadOutbox Outgoing messages were read
 type UpdateChatReadOutbox struct {
 	tdCommon
@@ -1303,25 +3739,143 @@ func NewUpdateChatReadOutbox(chatId int64, lastReadOutboxMessageId int64) *Updat
 	return &updateChatReadOutboxTemp
 }
 
-// GetUpdateEnum return the enum type of this object
-func (updateChatReadOutbox *UpdateChatReadOutbox) GetUpdateEnum() UpdateEnum {
-	return UpdateChatReadOutboxType
+// MarshalJSON marshals to json
+func (updateChatReadOutbox *UpdateChatReadOutbox) MarshalJSON() ([]byte, error) {
+	return updateChatReadOutbox.appendJSON(nil)
 }
 
-// UpdateChatActionBar The chat action bar was changed
-type UpdateChatActionBar struct {
-	tdCommon
-	ChatId    int64         `json:"chat_id"`    // Chat identifier
-	ActionBar ChatActionBar `json:"action_bar"` // The new value of the action bar; may be null
+// UnmarshalJSON unmarshal to json
+func (updateChatReadOutbox *UpdateChatReadOutbox) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateChatReadOutbox)
 }
 
-// MessageType return the string telegram-type of UpdateChatActionBar
-func (updateChatActionBar *UpdateChatActionBar) MessageType() string {
-	return "updateChatActionBar"
+func (updateChatReadOutbox *UpdateChatReadOutbox) appendJSON(b []byte) ([]byte, error) {
+	if updateChatReadOutbox == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateChatReadOutbox.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateChatReadOutbox.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateChatReadOutbox.ChatId))
+	b = append(b, `,"last_read_outbox_message_id":`...)
+	b = appendJSONInt(b, int64(updateChatReadOutbox.LastReadOutboxMessageId))
+	return append(b, '}'), nil
+}
+
+func (updateChatReadOutbox *UpdateChatReadOutbox) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateChatReadOutbox.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateChatReadOutbox.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateChatReadOutbox.ChatId, err = r.readInt64()
+		case "last_read_outbox_message_id":
+			updateChatReadOutbox.LastReadOutboxMessageId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
 }
 
-// NewUpdateChatActionBar creates a new UpdateChatActionBar
-//
+// Clone returns a deep copy of the object
+func (updateChatReadOutbox *UpdateChatReadOutbox) Clone() *UpdateChatReadOutbox {
+	if updateChatReadOutbox == nil {
+		return nil
+	}
+	clone := *updateChatReadOutbox
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateChatReadOutbox *UpdateChatReadOutbox) Equal(other *UpdateChatReadOutbox) bool {
+	if updateChatReadOutbox == nil || other == nil {
+		return updateChatReadOutbox == other
+	}
+	if updateChatReadOutbox.ChatId != other.ChatId {
+		return false
+	}
+	if updateChatReadOutbox.LastReadOutboxMessageId != other.LastReadOutboxMessageId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateChatReadOutbox *UpdateChatReadOutbox) Diff(other *UpdateChatReadOutbox) []Change {
+	return updateChatReadOutbox.diff("", other, nil)
+}
+
+func (updateChatReadOutbox *UpdateChatReadOutbox) diff(path string, other *UpdateChatReadOutbox, changes []Change) []Change {
+	if updateChatReadOutbox == nil || other == nil {
+		if updateChatReadOutbox != other {
+			changes = append(changes, Change{Path: path, Old: updateChatReadOutbox, New: other})
+		}
+		return changes
+	}
+	if updateChatReadOutbox.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateChatReadOutbox.ChatId, New: other.ChatId})
+	}
+	if updateChatReadOutbox.LastReadOutboxMessageId != other.LastReadOutboxMessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "last_read_outbox_message_id"), Old: updateChatReadOutbox.LastReadOutboxMessageId, New: other.LastReadOutboxMessageId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateChatReadOutbox *UpdateChatReadOutbox) String() string {
+	return objectString(updateChatReadOutbox)
+}
+
+// LogValue logs the object as its summary
+func (updateChatReadOutbox *UpdateChatReadOutbox) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateChatReadOutbox))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateChatReadOutbox *UpdateChatReadOutbox) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateChatReadOutbox)
+}
+
+func (updateChatReadOutbox *UpdateChatReadOutbox) printText(p *printer) {
+	if updateChatReadOutbox == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateChatReadOutbox")
+	p.int("chat_id", int64(updateChatReadOutbox.ChatId))
+	p.int("last_read_outbox_message_id", int64(updateChatReadOutbox.LastReadOutboxMessageId))
+	p.endObject(state)
+}
+
+// GetUpdateEnum return the enum type of this object
+func (updateChatReadOutbox *UpdateChatReadOutbox) GetUpdateEnum() UpdateEnum {
+	return UpdateChatReadOutboxType
+}
+
+// Accept calls the method of the visitor for UpdateChatReadOutbox
+func (updateChatReadOutbox *UpdateChatReadOutbox) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateChatReadOutbox(updateChatReadOutbox)
+}
+
+// UpdateChatActionBar The chat action bar was changed
+type UpdateChatActionBar struct {
+	tdCommon
+	ChatId    int64         `json:"chat_id"`    // Chat identifier
+	ActionBar ChatActionBar `json:"action_bar"` // The new value of the action bar; may be null
+}
+
+// MessageType return the string telegram-type of UpdateChatActionBar
+func (updateChatActionBar *UpdateChatActionBar) MessageType() string {
+	return "updateChatActionBar"
+}
+
+// NewUpdateChatActionBar creates a new UpdateChatActionBar
+//
 // @param chatId Chat identifier
 // @param actionBar The new value of the action bar; may be null
 func NewUpdateChatActionBar(chatId int64, actionBar ChatActionBar) *UpdateChatActionBar {
@@ -1334,30 +3888,120 @@ func NewUpdateChatActionBar(chatId int64, actionBar ChatActionBar) *UpdateChatAc
 	return &updateChatActionBarTemp
 }
 
+// MarshalJSON marshals to json
+func (updateChatActionBar *UpdateChatActionBar) MarshalJSON() ([]byte, error) {
+	return updateChatActionBar.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (updateChatActionBar *UpdateChatActionBar) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, updateChatActionBar)
+}
+
+func (updateChatActionBar *UpdateChatActionBar) appendJSON(b []byte) ([]byte, error) {
+	if updateChatActionBar == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateChatActionBar.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateChatActionBar.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateChatActionBar.ChatId))
+	b = append(b, `,"action_bar":`...)
+	b, err = appendJSONValue(b, updateChatActionBar.ActionBar)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-		ChatId int64 `json:"chat_id"` // Chat identifier
+	return append(b, '}'), nil
+}
+
+func (updateChatActionBar *UpdateChatActionBar) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateChatActionBar.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateChatActionBar.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateChatActionBar.ChatId, err = r.readInt64()
+		case "action_bar":
+			updateChatActionBar.ActionBar, err = decodeChatActionBar(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	}{}
-	err = json.Unmarshal(b, &tempObj)
-	if err != nil {
-		return err
+// Clone returns a deep copy of the object
+func (updateChatActionBar *UpdateChatActionBar) Clone() *UpdateChatActionBar {
+	if updateChatActionBar == nil {
+		return nil
+	}
+	clone := *updateChatActionBar
+	clone.ActionBar = cloneChatActionBar(updateChatActionBar.ActionBar)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateChatActionBar *UpdateChatActionBar) Equal(other *UpdateChatActionBar) bool {
+	if updateChatActionBar == nil || other == nil {
+		return updateChatActionBar == other
+	}
+	if updateChatActionBar.ChatId != other.ChatId {
+		return false
+	}
+	if !equalChatActionBar(updateChatActionBar.ActionBar, other.ActionBar) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateChatActionBar *UpdateChatActionBar) Diff(other *UpdateChatActionBar) []Change {
+	return updateChatActionBar.diff("", other, nil)
+}
+
+func (updateChatActionBar *UpdateChatActionBar) diff(path string, other *UpdateChatActionBar, changes []Change) []Change {
+	if updateChatActionBar == nil || other == nil {
+		if updateChatActionBar != other {
+			changes = append(changes, Change{Path: path, Old: updateChatActionBar, New: other})
+		}
+		return changes
 	}
+	if updateChatActionBar.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateChatActionBar.ChatId, New: other.ChatId})
+	}
+	changes = diffChatActionBar(fieldPath(path, "action_bar"), updateChatActionBar.ActionBar, other.ActionBar, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateChatActionBar *UpdateChatActionBar) String() string {
+	return objectString(updateChatActionBar)
+}
 
-	updateChatActionBar.tdCommon = tempObj.tdCommon
-	updateChatActionBar.ChatId = tempObj.ChatId
+// LogValue logs the object as its summary
+func (updateChatActionBar *UpdateChatActionBar) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateChatActionBar))
+}
 
-	fieldActionBar, _ := unmarshalChatActionBar(objMap["action_bar"])
-	updateChatActionBar.ActionBar = fieldActionBar
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateChatActionBar *UpdateChatActionBar) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateChatActionBar)
+}
 
-	return nil
+func (updateChatActionBar *UpdateChatActionBar) printText(p *printer) {
+	if updateChatActionBar == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateChatActionBar")
+	p.int("chat_id", int64(updateChatActionBar.ChatId))
+	p.object("action_bar", updateChatActionBar.ActionBar)
+	p.endObject(state)
 }
 
 // GetUpdateEnum return the enum type of this object
@@ -1365,6 +4009,11 @@ func (updateChatActionBar *UpdateChatActionBar) GetUpdateEnum() UpdateEnum {
 	return UpdateChatActionBarType
 }
 
+// Accept calls the method of the visitor for UpdateChatActionBar
+func (updateChatActionBar *UpdateChatActionBar) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateChatActionBar(updateChatActionBar)
+}
+
 // UpdateChatDraftMessage A chat draft has changed. Be aware that the update may come in the currently opened chat but with old content of the draft. If the user has changed the content of the draft, this update mustn't be applied
 type UpdateChatDraftMessage struct {
 	tdCommon
@@ -1394,11 +4043,189 @@ func NewUpdateChatDraftMessage(chatId int64, draftMessage *DraftMessage, positio
 	return &updateChatDraftMessageTemp
 }
 
+// MarshalJSON marshals to json
+func (updateChatDraftMessage *UpdateChatDraftMessage) MarshalJSON() ([]byte, error) {
+	return updateChatDraftMessage.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateChatDraftMessage *UpdateChatDraftMessage) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateChatDraftMessage)
+}
+
+func (updateChatDraftMessage *UpdateChatDraftMessage) appendJSON(b []byte) ([]byte, error) {
+	if updateChatDraftMessage == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateChatDraftMessage.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateChatDraftMessage.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateChatDraftMessage.ChatId))
+	b = append(b, `,"draft_message":`...)
+	b, err = updateChatDraftMessage.DraftMessage.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"positions":`...)
+	if updateChatDraftMessage.Positions == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range updateChatDraftMessage.Positions {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = updateChatDraftMessage.Positions[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (updateChatDraftMessage *UpdateChatDraftMessage) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateChatDraftMessage.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateChatDraftMessage.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateChatDraftMessage.ChatId, err = r.readInt64()
+		case "draft_message":
+			if r.readNull() {
+				updateChatDraftMessage.DraftMessage = nil
+			} else {
+				updateChatDraftMessage.DraftMessage = new(DraftMessage)
+				err = updateChatDraftMessage.DraftMessage.decodeJSON(r)
+			}
+		case "positions":
+			if r.readNull() {
+				updateChatDraftMessage.Positions = nil
+			} else {
+				updateChatDraftMessage.Positions = make([]ChatPosition, 0)
+				err = r.readArray(func() (err error) {
+					var item0 ChatPosition
+					err = item0.decodeJSON(r)
+					updateChatDraftMessage.Positions = append(updateChatDraftMessage.Positions, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateChatDraftMessage *UpdateChatDraftMessage) Clone() *UpdateChatDraftMessage {
+	if updateChatDraftMessage == nil {
+		return nil
+	}
+	clone := *updateChatDraftMessage
+	clone.DraftMessage = updateChatDraftMessage.DraftMessage.Clone()
+	if updateChatDraftMessage.Positions != nil {
+		clone.Positions = make([]ChatPosition, len(updateChatDraftMessage.Positions))
+		for i0 := range updateChatDraftMessage.Positions {
+			clone.Positions[i0] = *updateChatDraftMessage.Positions[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateChatDraftMessage *UpdateChatDraftMessage) Equal(other *UpdateChatDraftMessage) bool {
+	if updateChatDraftMessage == nil || other == nil {
+		return updateChatDraftMessage == other
+	}
+	if updateChatDraftMessage.ChatId != other.ChatId {
+		return false
+	}
+	if !updateChatDraftMessage.DraftMessage.Equal(other.DraftMessage) {
+		return false
+	}
+	if len(updateChatDraftMessage.Positions) != len(other.Positions) {
+		return false
+	}
+	for i0 := range updateChatDraftMessage.Positions {
+		if !updateChatDraftMessage.Positions[i0].Equal(&other.Positions[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateChatDraftMessage *UpdateChatDraftMessage) Diff(other *UpdateChatDraftMessage) []Change {
+	return updateChatDraftMessage.diff("", other, nil)
+}
+
+func (updateChatDraftMessage *UpdateChatDraftMessage) diff(path string, other *UpdateChatDraftMessage, changes []Change) []Change {
+	if updateChatDraftMessage == nil || other == nil {
+		if updateChatDraftMessage != other {
+			changes = append(changes, Change{Path: path, Old: updateChatDraftMessage, New: other})
+		}
+		return changes
+	}
+	if updateChatDraftMessage.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateChatDraftMessage.ChatId, New: other.ChatId})
+	}
+	changes = updateChatDraftMessage.DraftMessage.diff(fieldPath(path, "draft_message"), other.DraftMessage, changes)
+	if len(updateChatDraftMessage.Positions) != len(other.Positions) {
+		changes = append(changes, Change{Path: fieldPath(path, "positions"), Old: updateChatDraftMessage.Positions, New: other.Positions})
+	} else {
+		for i0 := range updateChatDraftMessage.Positions {
+			changes = updateChatDraftMessage.Positions[i0].diff(indexPath(fieldPath(path, "positions"), i0), &other.Positions[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateChatDraftMessage *UpdateChatDraftMessage) String() string {
+	return objectString(updateChatDraftMessage)
+}
+
+// LogValue logs the object as its summary
+func (updateChatDraftMessage *UpdateChatDraftMessage) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateChatDraftMessage))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateChatDraftMessage *UpdateChatDraftMessage) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateChatDraftMessage)
+}
+
+func (updateChatDraftMessage *UpdateChatDraftMessage) printText(p *printer) {
+	if updateChatDraftMessage == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateChatDraftMessage")
+	p.int("chat_id", int64(updateChatDraftMessage.ChatId))
+	p.object("draft_message", updateChatDraftMessage.DraftMessage)
+	p.vector("positions", len(updateChatDraftMessage.Positions), func(i0 int) {
+		updateChatDraftMessage.Positions[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateChatDraftMessage *UpdateChatDraftMessage) GetUpdateEnum() UpdateEnum {
 	return UpdateChatDraftMessageType
 }
 
+// Accept calls the method of the visitor for UpdateChatDraftMessage
+func (updateChatDraftMessage *UpdateChatDraftMessage) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateChatDraftMessage(updateChatDraftMessage)
+}
+
 // UpdateChatMessageSender The message sender that is selected to send messages in a chat has changed
 type UpdateChatMessageSender struct {
 	tdCommon
@@ -1425,30 +4252,120 @@ func NewUpdateChatMessageSender(chatId int64, messageSenderId MessageSender) *Up
 	return &updateChatMessageSenderTemp
 }
 
+// MarshalJSON marshals to json
+func (updateChatMessageSender *UpdateChatMessageSender) MarshalJSON() ([]byte, error) {
+	return updateChatMessageSender.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (updateChatMessageSender *UpdateChatMessageSender) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, updateChatMessageSender)
+}
+
+func (updateChatMessageSender *UpdateChatMessageSender) appendJSON(b []byte) ([]byte, error) {
+	if updateChatMessageSender == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateChatMessageSender.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateChatMessageSender.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateChatMessageSender.ChatId))
+	b = append(b, `,"message_sender_id":`...)
+	b, err = appendJSONValue(b, updateChatMessageSender.MessageSenderId)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateChatMessageSender *UpdateChatMessageSender) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateChatMessageSender.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateChatMessageSender.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateChatMessageSender.ChatId, err = r.readInt64()
+		case "message_sender_id":
+			updateChatMessageSender.MessageSenderId, err = decodeMessageSender(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateChatMessageSender *UpdateChatMessageSender) Clone() *UpdateChatMessageSender {
+	if updateChatMessageSender == nil {
+		return nil
 	}
-	tempObj := struct {
-		tdCommon
-		ChatId int64 `json:"chat_id"` // Chat identifier
+	clone := *updateChatMessageSender
+	clone.MessageSenderId = cloneMessageSender(updateChatMessageSender.MessageSenderId)
+	return &clone
+}
 
-	}{}
-	err = json.Unmarshal(b, &tempObj)
-	if err != nil {
-		return err
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateChatMessageSender *UpdateChatMessageSender) Equal(other *UpdateChatMessageSender) bool {
+	if updateChatMessageSender == nil || other == nil {
+		return updateChatMessageSender == other
 	}
+	if updateChatMessageSender.ChatId != other.ChatId {
+		return false
+	}
+	if !equalMessageSender(updateChatMessageSender.MessageSenderId, other.MessageSenderId) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateChatMessageSender *UpdateChatMessageSender) Diff(other *UpdateChatMessageSender) []Change {
+	return updateChatMessageSender.diff("", other, nil)
+}
+
+func (updateChatMessageSender *UpdateChatMessageSender) diff(path string, other *UpdateChatMessageSender, changes []Change) []Change {
+	if updateChatMessageSender == nil || other == nil {
+		if updateChatMessageSender != other {
+			changes = append(changes, Change{Path: path, Old: updateChatMessageSender, New: other})
+		}
+		return changes
+	}
+	if updateChatMessageSender.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateChatMessageSender.ChatId, New: other.ChatId})
+	}
+	changes = diffMessageSender(fieldPath(path, "message_sender_id"), updateChatMessageSender.MessageSenderId, other.MessageSenderId, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateChatMessageSender *UpdateChatMessageSender) String() string {
+	return objectString(updateChatMessageSender)
+}
 
-	updateChatMessageSender.tdCommon = tempObj.tdCommon
-	updateChatMessageSender.ChatId = tempObj.ChatId
+// LogValue logs the object as its summary
+func (updateChatMessageSender *UpdateChatMessageSender) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateChatMessageSender))
+}
 
-	fieldMessageSenderId, _ := unmarshalMessageSender(objMap["message_sender_id"])
-	updateChatMessageSender.MessageSenderId = fieldMessageSenderId
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateChatMessageSender *UpdateChatMessageSender) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateChatMessageSender)
+}
 
-	return nil
+func (updateChatMessageSender *UpdateChatMessageSender) printText(p *printer) {
+	if updateChatMessageSender == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateChatMessageSender")
+	p.int("chat_id", int64(updateChatMessageSender.ChatId))
+	p.object("message_sender_id", updateChatMessageSender.MessageSenderId)
+	p.endObject(state)
 }
 
 // GetUpdateEnum return the enum type of this object
@@ -1456,6 +4373,11 @@ func (updateChatMessageSender *UpdateChatMessageSender) GetUpdateEnum() UpdateEn
 	return UpdateChatMessageSenderType
 }
 
+// Accept calls the method of the visitor for UpdateChatMessageSender
+func (updateChatMessageSender *UpdateChatMessageSender) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateChatMessageSender(updateChatMessageSender)
+}
+
 // UpdateChatMessageTtl The message Time To Live setting for a chat was changed
 type UpdateChatMessageTtl struct {
 	tdCommon
@@ -1482,11 +4404,129 @@ func NewUpdateChatMessageTtl(chatId int64, messageTtl int32) *UpdateChatMessageT
 	return &updateChatMessageTtlTemp
 }
 
+// MarshalJSON marshals to json
+func (updateChatMessageTtl *UpdateChatMessageTtl) MarshalJSON() ([]byte, error) {
+	return updateChatMessageTtl.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateChatMessageTtl *UpdateChatMessageTtl) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateChatMessageTtl)
+}
+
+func (updateChatMessageTtl *UpdateChatMessageTtl) appendJSON(b []byte) ([]byte, error) {
+	if updateChatMessageTtl == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateChatMessageTtl.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateChatMessageTtl.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateChatMessageTtl.ChatId))
+	b = append(b, `,"message_ttl":`...)
+	b = appendJSONInt(b, int64(updateChatMessageTtl.MessageTtl))
+	return append(b, '}'), nil
+}
+
+func (updateChatMessageTtl *UpdateChatMessageTtl) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateChatMessageTtl.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateChatMessageTtl.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateChatMessageTtl.ChatId, err = r.readInt64()
+		case "message_ttl":
+			updateChatMessageTtl.MessageTtl, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateChatMessageTtl *UpdateChatMessageTtl) Clone() *UpdateChatMessageTtl {
+	if updateChatMessageTtl == nil {
+		return nil
+	}
+	clone := *updateChatMessageTtl
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateChatMessageTtl *UpdateChatMessageTtl) Equal(other *UpdateChatMessageTtl) bool {
+	if updateChatMessageTtl == nil || other == nil {
+		return updateChatMessageTtl == other
+	}
+	if updateChatMessageTtl.ChatId != other.ChatId {
+		return false
+	}
+	if updateChatMessageTtl.MessageTtl != other.MessageTtl {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateChatMessageTtl *UpdateChatMessageTtl) Diff(other *UpdateChatMessageTtl) []Change {
+	return updateChatMessageTtl.diff("", other, nil)
+}
+
+func (updateChatMessageTtl *UpdateChatMessageTtl) diff(path string, other *UpdateChatMessageTtl, changes []Change) []Change {
+	if updateChatMessageTtl == nil || other == nil {
+		if updateChatMessageTtl != other {
+			changes = append(changes, Change{Path: path, Old: updateChatMessageTtl, New: other})
+		}
+		return changes
+	}
+	if updateChatMessageTtl.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateChatMessageTtl.ChatId, New: other.ChatId})
+	}
+	if updateChatMessageTtl.MessageTtl != other.MessageTtl {
+		changes = append(changes, Change{Path: fieldPath(path, "message_ttl"), Old: updateChatMessageTtl.MessageTtl, New: other.MessageTtl})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateChatMessageTtl *UpdateChatMessageTtl) String() string {
+	return objectString(updateChatMessageTtl)
+}
+
+// LogValue logs the object as its summary
+func (updateChatMessageTtl *UpdateChatMessageTtl) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateChatMessageTtl))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateChatMessageTtl *UpdateChatMessageTtl) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateChatMessageTtl)
+}
+
+func (updateChatMessageTtl *UpdateChatMessageTtl) printText(p *printer) {
+	if updateChatMessageTtl == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateChatMessageTtl")
+	p.int("chat_id", int64(updateChatMessageTtl.ChatId))
+	p.int("message_ttl", int64(updateChatMessageTtl.MessageTtl))
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateChatMessageTtl *UpdateChatMessageTtl) GetUpdateEnum() UpdateEnum {
 	return UpdateChatMessageTtlType
 }
 
+// Accept calls the method of the visitor for UpdateChatMessageTtl
+func (updateChatMessageTtl *UpdateChatMessageTtl) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateChatMessageTtl(updateChatMessageTtl)
+}
+
 // UpdateChatNotificationSettings Notification settings for a chat were changed
 type UpdateChatNotificationSettings struct {
 	tdCommon
@@ -1513,11 +4553,137 @@ func NewUpdateChatNotificationSettings(chatId int64, notificationSettings *ChatN
 	return &updateChatNotificationSettingsTemp
 }
 
+// MarshalJSON marshals to json
+func (updateChatNotificationSettings *UpdateChatNotificationSettings) MarshalJSON() ([]byte, error) {
+	return updateChatNotificationSettings.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateChatNotificationSettings *UpdateChatNotificationSettings) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateChatNotificationSettings)
+}
+
+func (updateChatNotificationSettings *UpdateChatNotificationSettings) appendJSON(b []byte) ([]byte, error) {
+	if updateChatNotificationSettings == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateChatNotificationSettings.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateChatNotificationSettings.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateChatNotificationSettings.ChatId))
+	b = append(b, `,"notification_settings":`...)
+	b, err = updateChatNotificationSettings.NotificationSettings.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateChatNotificationSettings *UpdateChatNotificationSettings) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateChatNotificationSettings.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateChatNotificationSettings.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateChatNotificationSettings.ChatId, err = r.readInt64()
+		case "notification_settings":
+			if r.readNull() {
+				updateChatNotificationSettings.NotificationSettings = nil
+			} else {
+				updateChatNotificationSettings.NotificationSettings = new(ChatNotificationSettings)
+				err = updateChatNotificationSettings.NotificationSettings.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateChatNotificationSettings *UpdateChatNotificationSettings) Clone() *UpdateChatNotificationSettings {
+	if updateChatNotificationSettings == nil {
+		return nil
+	}
+	clone := *updateChatNotificationSettings
+	clone.NotificationSettings = updateChatNotificationSettings.NotificationSettings.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateChatNotificationSettings *UpdateChatNotificationSettings) Equal(other *UpdateChatNotificationSettings) bool {
+	if updateChatNotificationSettings == nil || other == nil {
+		return updateChatNotificationSettings == other
+	}
+	if updateChatNotificationSettings.ChatId != other.ChatId {
+		return false
+	}
+	if !updateChatNotificationSettings.NotificationSettings.Equal(other.NotificationSettings) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateChatNotificationSettings *UpdateChatNotificationSettings) Diff(other *UpdateChatNotificationSettings) []Change {
+	return updateChatNotificationSettings.diff("", other, nil)
+}
+
+func (updateChatNotificationSettings *UpdateChatNotificationSettings) diff(path string, other *UpdateChatNotificationSettings, changes []Change) []Change {
+	if updateChatNotificationSettings == nil || other == nil {
+		if updateChatNotificationSettings != other {
+			changes = append(changes, Change{Path: path, Old: updateChatNotificationSettings, New: other})
+		}
+		return changes
+	}
+	if updateChatNotificationSettings.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateChatNotificationSettings.ChatId, New: other.ChatId})
+	}
+	changes = updateChatNotificationSettings.NotificationSettings.diff(fieldPath(path, "notification_settings"), other.NotificationSettings, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateChatNotificationSettings *UpdateChatNotificationSettings) String() string {
+	return objectString(updateChatNotificationSettings)
+}
+
+// LogValue logs the object as its summary
+func (updateChatNotificationSettings *UpdateChatNotificationSettings) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateChatNotificationSettings))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateChatNotificationSettings *UpdateChatNotificationSettings) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateChatNotificationSettings)
+}
+
+func (updateChatNotificationSettings *UpdateChatNotificationSettings) printText(p *printer) {
+	if updateChatNotificationSettings == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateChatNotificationSettings")
+	p.int("chat_id", int64(updateChatNotificationSettings.ChatId))
+	p.object("notification_settings", updateChatNotificationSettings.NotificationSettings)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateChatNotificationSettings *UpdateChatNotificationSettings) GetUpdateEnum() UpdateEnum {
 	return UpdateChatNotificationSettingsType
 }
 
+// Accept calls the method of the visitor for UpdateChatNotificationSettings
+func (updateChatNotificationSettings *UpdateChatNotificationSettings) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateChatNotificationSettings(updateChatNotificationSettings)
+}
+
 // UpdateChatPendingJoinRequests The chat pending join requests were changed
 type UpdateChatPendingJoinRequests struct {
 	tdCommon
@@ -1544,11 +4710,137 @@ func NewUpdateChatPendingJoinRequests(chatId int64, pendingJoinRequests *ChatJoi
 	return &updateChatPendingJoinRequestsTemp
 }
 
+// MarshalJSON marshals to json
+func (updateChatPendingJoinRequests *UpdateChatPendingJoinRequests) MarshalJSON() ([]byte, error) {
+	return updateChatPendingJoinRequests.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateChatPendingJoinRequests *UpdateChatPendingJoinRequests) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateChatPendingJoinRequests)
+}
+
+func (updateChatPendingJoinRequests *UpdateChatPendingJoinRequests) appendJSON(b []byte) ([]byte, error) {
+	if updateChatPendingJoinRequests == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateChatPendingJoinRequests.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateChatPendingJoinRequests.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateChatPendingJoinRequests.ChatId))
+	b = append(b, `,"pending_join_requests":`...)
+	b, err = updateChatPendingJoinRequests.PendingJoinRequests.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateChatPendingJoinRequests *UpdateChatPendingJoinRequests) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateChatPendingJoinRequests.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateChatPendingJoinRequests.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateChatPendingJoinRequests.ChatId, err = r.readInt64()
+		case "pending_join_requests":
+			if r.readNull() {
+				updateChatPendingJoinRequests.PendingJoinRequests = nil
+			} else {
+				updateChatPendingJoinRequests.PendingJoinRequests = new(ChatJoinRequestsInfo)
+				err = updateChatPendingJoinRequests.PendingJoinRequests.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateChatPendingJoinRequests *UpdateChatPendingJoinRequests) Clone() *UpdateChatPendingJoinRequests {
+	if updateChatPendingJoinRequests == nil {
+		return nil
+	}
+	clone := *updateChatPendingJoinRequests
+	clone.PendingJoinRequests = updateChatPendingJoinRequests.PendingJoinRequests.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateChatPendingJoinRequests *UpdateChatPendingJoinRequests) Equal(other *UpdateChatPendingJoinRequests) bool {
+	if updateChatPendingJoinRequests == nil || other == nil {
+		return updateChatPendingJoinRequests == other
+	}
+	if updateChatPendingJoinRequests.ChatId != other.ChatId {
+		return false
+	}
+	if !updateChatPendingJoinRequests.PendingJoinRequests.Equal(other.PendingJoinRequests) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateChatPendingJoinRequests *UpdateChatPendingJoinRequests) Diff(other *UpdateChatPendingJoinRequests) []Change {
+	return updateChatPendingJoinRequests.diff("", other, nil)
+}
+
+func (updateChatPendingJoinRequests *UpdateChatPendingJoinRequests) diff(path string, other *UpdateChatPendingJoinRequests, changes []Change) []Change {
+	if updateChatPendingJoinRequests == nil || other == nil {
+		if updateChatPendingJoinRequests != other {
+			changes = append(changes, Change{Path: path, Old: updateChatPendingJoinRequests, New: other})
+		}
+		return changes
+	}
+	if updateChatPendingJoinRequests.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateChatPendingJoinRequests.ChatId, New: other.ChatId})
+	}
+	changes = updateChatPendingJoinRequests.PendingJoinRequests.diff(fieldPath(path, "pending_join_requests"), other.PendingJoinRequests, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateChatPendingJoinRequests *UpdateChatPendingJoinRequests) String() string {
+	return objectString(updateChatPendingJoinRequests)
+}
+
+// LogValue logs the object as its summary
+func (updateChatPendingJoinRequests *UpdateChatPendingJoinRequests) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateChatPendingJoinRequests))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateChatPendingJoinRequests *UpdateChatPendingJoinRequests) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateChatPendingJoinRequests)
+}
+
+func (updateChatPendingJoinRequests *UpdateChatPendingJoinRequests) printText(p *printer) {
+	if updateChatPendingJoinRequests == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateChatPendingJoinRequests")
+	p.int("chat_id", int64(updateChatPendingJoinRequests.ChatId))
+	p.object("pending_join_requests", updateChatPendingJoinRequests.PendingJoinRequests)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateChatPendingJoinRequests *UpdateChatPendingJoinRequests) GetUpdateEnum() UpdateEnum {
 	return UpdateChatPendingJoinRequestsType
 }
 
+// Accept calls the method of the visitor for UpdateChatPendingJoinRequests
+func (updateChatPendingJoinRequests *UpdateChatPendingJoinRequests) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateChatPendingJoinRequests(updateChatPendingJoinRequests)
+}
+
 // UpdateChatReplyMarkup The default chat reply markup was changed. Can occur because new messages with reply markup were received or because an old reply markup was hidden by the user
 type UpdateChatReplyMarkup struct {
 	tdCommon
@@ -1575,9 +4867,127 @@ func NewUpdateChatReplyMarkup(chatId int64, replyMarkupMessageId int64) *UpdateC
 	return &updateChatReplyMarkupTemp
 }
 
-// GetUpdateEnum return the enum type of this object
-func (updateChatReplyMarkup *UpdateChatReplyMarkup) GetUpdateEnum() UpdateEnum {
-	return UpdateChatReplyMarkupType
+// MarshalJSON marshals to json
+func (updateChatReplyMarkup *UpdateChatReplyMarkup) MarshalJSON() ([]byte, error) {
+	return updateChatReplyMarkup.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateChatReplyMarkup *UpdateChatReplyMarkup) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateChatReplyMarkup)
+}
+
+func (updateChatReplyMarkup *UpdateChatReplyMarkup) appendJSON(b []byte) ([]byte, error) {
+	if updateChatReplyMarkup == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateChatReplyMarkup.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateChatReplyMarkup.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateChatReplyMarkup.ChatId))
+	b = append(b, `,"reply_markup_message_id":`...)
+	b = appendJSONInt(b, int64(updateChatReplyMarkup.ReplyMarkupMessageId))
+	return append(b, '}'), nil
+}
+
+func (updateChatReplyMarkup *UpdateChatReplyMarkup) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateChatReplyMarkup.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateChatReplyMarkup.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateChatReplyMarkup.ChatId, err = r.readInt64()
+		case "reply_markup_message_id":
+			updateChatReplyMarkup.ReplyMarkupMessageId, err = r.readInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateChatReplyMarkup *UpdateChatReplyMarkup) Clone() *UpdateChatReplyMarkup {
+	if updateChatReplyMarkup == nil {
+		return nil
+	}
+	clone := *updateChatReplyMarkup
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateChatReplyMarkup *UpdateChatReplyMarkup) Equal(other *UpdateChatReplyMarkup) bool {
+	if updateChatReplyMarkup == nil || other == nil {
+		return updateChatReplyMarkup == other
+	}
+	if updateChatReplyMarkup.ChatId != other.ChatId {
+		return false
+	}
+	if updateChatReplyMarkup.ReplyMarkupMessageId != other.ReplyMarkupMessageId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateChatReplyMarkup *UpdateChatReplyMarkup) Diff(other *UpdateChatReplyMarkup) []Change {
+	return updateChatReplyMarkup.diff("", other, nil)
+}
+
+func (updateChatReplyMarkup *UpdateChatReplyMarkup) diff(path string, other *UpdateChatReplyMarkup, changes []Change) []Change {
+	if updateChatReplyMarkup == nil || other == nil {
+		if updateChatReplyMarkup != other {
+			changes = append(changes, Change{Path: path, Old: updateChatReplyMarkup, New: other})
+		}
+		return changes
+	}
+	if updateChatReplyMarkup.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateChatReplyMarkup.ChatId, New: other.ChatId})
+	}
+	if updateChatReplyMarkup.ReplyMarkupMessageId != other.ReplyMarkupMessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "reply_markup_message_id"), Old: updateChatReplyMarkup.ReplyMarkupMessageId, New: other.ReplyMarkupMessageId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateChatReplyMarkup *UpdateChatReplyMarkup) String() string {
+	return objectString(updateChatReplyMarkup)
+}
+
+// LogValue logs the object as its summary
+func (updateChatReplyMarkup *UpdateChatReplyMarkup) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateChatReplyMarkup))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateChatReplyMarkup *UpdateChatReplyMarkup) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateChatReplyMarkup)
+}
+
+func (updateChatReplyMarkup *UpdateChatReplyMarkup) printText(p *printer) {
+	if updateChatReplyMarkup == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateChatReplyMarkup")
+	p.int("chat_id", int64(updateChatReplyMarkup.ChatId))
+	p.int("reply_markup_message_id", int64(updateChatReplyMarkup.ReplyMarkupMessageId))
+	p.endObject(state)
+}
+
+// GetUpdateEnum return the enum type of this object
+func (updateChatReplyMarkup *UpdateChatReplyMarkup) GetUpdateEnum() UpdateEnum {
+	return UpdateChatReplyMarkupType
+}
+
+// Accept calls the method of the visitor for UpdateChatReplyMarkup
+func (updateChatReplyMarkup *UpdateChatReplyMarkup) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateChatReplyMarkup(updateChatReplyMarkup)
 }
 
 // UpdateChatTheme The chat theme was changed
@@ -1606,11 +5016,129 @@ func NewUpdateChatTheme(chatId int64, themeName string) *UpdateChatTheme {
 	return &updateChatThemeTemp
 }
 
+// MarshalJSON marshals to json
+func (updateChatTheme *UpdateChatTheme) MarshalJSON() ([]byte, error) {
+	return updateChatTheme.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateChatTheme *UpdateChatTheme) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateChatTheme)
+}
+
+func (updateChatTheme *UpdateChatTheme) appendJSON(b []byte) ([]byte, error) {
+	if updateChatTheme == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateChatTheme.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateChatTheme.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateChatTheme.ChatId))
+	b = append(b, `,"theme_name":`...)
+	b = appendJSONString(b, updateChatTheme.ThemeName)
+	return append(b, '}'), nil
+}
+
+func (updateChatTheme *UpdateChatTheme) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateChatTheme.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateChatTheme.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateChatTheme.ChatId, err = r.readInt64()
+		case "theme_name":
+			updateChatTheme.ThemeName, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateChatTheme *UpdateChatTheme) Clone() *UpdateChatTheme {
+	if updateChatTheme == nil {
+		return nil
+	}
+	clone := *updateChatTheme
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateChatTheme *UpdateChatTheme) Equal(other *UpdateChatTheme) bool {
+	if updateChatTheme == nil || other == nil {
+		return updateChatTheme == other
+	}
+	if updateChatTheme.ChatId != other.ChatId {
+		return false
+	}
+	if updateChatTheme.ThemeName != other.ThemeName {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateChatTheme *UpdateChatTheme) Diff(other *UpdateChatTheme) []Change {
+	return updateChatTheme.diff("", other, nil)
+}
+
+func (updateChatTheme *UpdateChatTheme) diff(path string, other *UpdateChatTheme, changes []Change) []Change {
+	if updateChatTheme == nil || other == nil {
+		if updateChatTheme != other {
+			changes = append(changes, Change{Path: path, Old: updateChatTheme, New: other})
+		}
+		return changes
+	}
+	if updateChatTheme.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateChatTheme.ChatId, New: other.ChatId})
+	}
+	if updateChatTheme.ThemeName != other.ThemeName {
+		changes = append(changes, Change{Path: fieldPath(path, "theme_name"), Old: updateChatTheme.ThemeName, New: other.ThemeName})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateChatTheme *UpdateChatTheme) String() string {
+	return objectString(updateChatTheme)
+}
+
+// LogValue logs the object as its summary
+func (updateChatTheme *UpdateChatTheme) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateChatTheme))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateChatTheme *UpdateChatTheme) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateChatTheme)
+}
+
+func (updateChatTheme *UpdateChatTheme) printText(p *printer) {
+	if updateChatTheme == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateChatTheme")
+	p.int("chat_id", int64(updateChatTheme.ChatId))
+	p.string("theme_name", updateChatTheme.ThemeName)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateChatTheme *UpdateChatTheme) GetUpdateEnum() UpdateEnum {
 	return UpdateChatThemeType
 }
 
+// Accept calls the method of the visitor for UpdateChatTheme
+func (updateChatTheme *UpdateChatTheme) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateChatTheme(updateChatTheme)
+}
+
 // UpdateChatUnreadMentionCount The chat unread_mention_count has changed
 type UpdateChatUnreadMentionCount struct {
 	tdCommon
@@ -1637,11 +5165,129 @@ func NewUpdateChatUnreadMentionCount(chatId int64, unreadMentionCount int32) *Up
 	return &updateChatUnreadMentionCountTemp
 }
 
+// MarshalJSON marshals to json
+func (updateChatUnreadMentionCount *UpdateChatUnreadMentionCount) MarshalJSON() ([]byte, error) {
+	return updateChatUnreadMentionCount.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateChatUnreadMentionCount *UpdateChatUnreadMentionCount) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateChatUnreadMentionCount)
+}
+
+func (updateChatUnreadMentionCount *UpdateChatUnreadMentionCount) appendJSON(b []byte) ([]byte, error) {
+	if updateChatUnreadMentionCount == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateChatUnreadMentionCount.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateChatUnreadMentionCount.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateChatUnreadMentionCount.ChatId))
+	b = append(b, `,"unread_mention_count":`...)
+	b = appendJSONInt(b, int64(updateChatUnreadMentionCount.UnreadMentionCount))
+	return append(b, '}'), nil
+}
+
+func (updateChatUnreadMentionCount *UpdateChatUnreadMentionCount) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateChatUnreadMentionCount.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateChatUnreadMentionCount.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateChatUnreadMentionCount.ChatId, err = r.readInt64()
+		case "unread_mention_count":
+			updateChatUnreadMentionCount.UnreadMentionCount, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateChatUnreadMentionCount *UpdateChatUnreadMentionCount) Clone() *UpdateChatUnreadMentionCount {
+	if updateChatUnreadMentionCount == nil {
+		return nil
+	}
+	clone := *updateChatUnreadMentionCount
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateChatUnreadMentionCount *UpdateChatUnreadMentionCount) Equal(other *UpdateChatUnreadMentionCount) bool {
+	if updateChatUnreadMentionCount == nil || other == nil {
+		return updateChatUnreadMentionCount == other
+	}
+	if updateChatUnreadMentionCount.ChatId != other.ChatId {
+		return false
+	}
+	if updateChatUnreadMentionCount.UnreadMentionCount != other.UnreadMentionCount {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateChatUnreadMentionCount *UpdateChatUnreadMentionCount) Diff(other *UpdateChatUnreadMentionCount) []Change {
+	return updateChatUnreadMentionCount.diff("", other, nil)
+}
+
+func (updateChatUnreadMentionCount *UpdateChatUnreadMentionCount) diff(path string, other *UpdateChatUnreadMentionCount, changes []Change) []Change {
+	if updateChatUnreadMentionCount == nil || other == nil {
+		if updateChatUnreadMentionCount != other {
+			changes = append(changes, Change{Path: path, Old: updateChatUnreadMentionCount, New: other})
+		}
+		return changes
+	}
+	if updateChatUnreadMentionCount.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateChatUnreadMentionCount.ChatId, New: other.ChatId})
+	}
+	if updateChatUnreadMentionCount.UnreadMentionCount != other.UnreadMentionCount {
+		changes = append(changes, Change{Path: fieldPath(path, "unread_mention_count"), Old: updateChatUnreadMentionCount.UnreadMentionCount, New: other.UnreadMentionCount})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateChatUnreadMentionCount *UpdateChatUnreadMentionCount) String() string {
+	return objectString(updateChatUnreadMentionCount)
+}
+
+// LogValue logs the object as its summary
+func (updateChatUnreadMentionCount *UpdateChatUnreadMentionCount) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateChatUnreadMentionCount))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateChatUnreadMentionCount *UpdateChatUnreadMentionCount) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateChatUnreadMentionCount)
+}
+
+func (updateChatUnreadMentionCount *UpdateChatUnreadMentionCount) printText(p *printer) {
+	if updateChatUnreadMentionCount == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateChatUnreadMentionCount")
+	p.int("chat_id", int64(updateChatUnreadMentionCount.ChatId))
+	p.int("unread_mention_count", int64(updateChatUnreadMentionCount.UnreadMentionCount))
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateChatUnreadMentionCount *UpdateChatUnreadMentionCount) GetUpdateEnum() UpdateEnum {
 	return UpdateChatUnreadMentionCountType
 }
 
+// Accept calls the method of the visitor for UpdateChatUnreadMentionCount
+func (updateChatUnreadMentionCount *UpdateChatUnreadMentionCount) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateChatUnreadMentionCount(updateChatUnreadMentionCount)
+}
+
 // UpdateChatVideoChat A chat video chat state has changed
 type UpdateChatVideoChat struct {
 	tdCommon
@@ -1668,11 +5314,137 @@ func NewUpdateChatVideoChat(chatId int64, videoChat *VideoChat) *UpdateChatVideo
 	return &updateChatVideoChatTemp
 }
 
+// MarshalJSON marshals to json
+func (updateChatVideoChat *UpdateChatVideoChat) MarshalJSON() ([]byte, error) {
+	return updateChatVideoChat.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateChatVideoChat *UpdateChatVideoChat) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateChatVideoChat)
+}
+
+func (updateChatVideoChat *UpdateChatVideoChat) appendJSON(b []byte) ([]byte, error) {
+	if updateChatVideoChat == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateChatVideoChat.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateChatVideoChat.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateChatVideoChat.ChatId))
+	b = append(b, `,"video_chat":`...)
+	b, err = updateChatVideoChat.VideoChat.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateChatVideoChat *UpdateChatVideoChat) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateChatVideoChat.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateChatVideoChat.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateChatVideoChat.ChatId, err = r.readInt64()
+		case "video_chat":
+			if r.readNull() {
+				updateChatVideoChat.VideoChat = nil
+			} else {
+				updateChatVideoChat.VideoChat = new(VideoChat)
+				err = updateChatVideoChat.VideoChat.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateChatVideoChat *UpdateChatVideoChat) Clone() *UpdateChatVideoChat {
+	if updateChatVideoChat == nil {
+		return nil
+	}
+	clone := *updateChatVideoChat
+	clone.VideoChat = updateChatVideoChat.VideoChat.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateChatVideoChat *UpdateChatVideoChat) Equal(other *UpdateChatVideoChat) bool {
+	if updateChatVideoChat == nil || other == nil {
+		return updateChatVideoChat == other
+	}
+	if updateChatVideoChat.ChatId != other.ChatId {
+		return false
+	}
+	if !updateChatVideoChat.VideoChat.Equal(other.VideoChat) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateChatVideoChat *UpdateChatVideoChat) Diff(other *UpdateChatVideoChat) []Change {
+	return updateChatVideoChat.diff("", other, nil)
+}
+
+func (updateChatVideoChat *UpdateChatVideoChat) diff(path string, other *UpdateChatVideoChat, changes []Change) []Change {
+	if updateChatVideoChat == nil || other == nil {
+		if updateChatVideoChat != other {
+			changes = append(changes, Change{Path: path, Old: updateChatVideoChat, New: other})
+		}
+		return changes
+	}
+	if updateChatVideoChat.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateChatVideoChat.ChatId, New: other.ChatId})
+	}
+	changes = updateChatVideoChat.VideoChat.diff(fieldPath(path, "video_chat"), other.VideoChat, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateChatVideoChat *UpdateChatVideoChat) String() string {
+	return objectString(updateChatVideoChat)
+}
+
+// LogValue logs the object as its summary
+func (updateChatVideoChat *UpdateChatVideoChat) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateChatVideoChat))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateChatVideoChat *UpdateChatVideoChat) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateChatVideoChat)
+}
+
+func (updateChatVideoChat *UpdateChatVideoChat) printText(p *printer) {
+	if updateChatVideoChat == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateChatVideoChat")
+	p.int("chat_id", int64(updateChatVideoChat.ChatId))
+	p.object("video_chat", updateChatVideoChat.VideoChat)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateChatVideoChat *UpdateChatVideoChat) GetUpdateEnum() UpdateEnum {
 	return UpdateChatVideoChatType
 }
 
+// Accept calls the method of the visitor for UpdateChatVideoChat
+func (updateChatVideoChat *UpdateChatVideoChat) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateChatVideoChat(updateChatVideoChat)
+}
+
 // UpdateChatDefaultDisableNotification The value of the default disable_notification parameter, used when a message is sent to the chat, was changed
 type UpdateChatDefaultDisableNotification struct {
 	tdCommon
@@ -1699,11 +5471,129 @@ func NewUpdateChatDefaultDisableNotification(chatId int64, defaultDisableNotific
 	return &updateChatDefaultDisableNotificationTemp
 }
 
+// MarshalJSON marshals to json
+func (updateChatDefaultDisableNotification *UpdateChatDefaultDisableNotification) MarshalJSON() ([]byte, error) {
+	return updateChatDefaultDisableNotification.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateChatDefaultDisableNotification *UpdateChatDefaultDisableNotification) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateChatDefaultDisableNotification)
+}
+
+func (updateChatDefaultDisableNotification *UpdateChatDefaultDisableNotification) appendJSON(b []byte) ([]byte, error) {
+	if updateChatDefaultDisableNotification == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateChatDefaultDisableNotification.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateChatDefaultDisableNotification.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateChatDefaultDisableNotification.ChatId))
+	b = append(b, `,"default_disable_notification":`...)
+	b = appendJSONBool(b, updateChatDefaultDisableNotification.DefaultDisableNotification)
+	return append(b, '}'), nil
+}
+
+func (updateChatDefaultDisableNotification *UpdateChatDefaultDisableNotification) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateChatDefaultDisableNotification.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateChatDefaultDisableNotification.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateChatDefaultDisableNotification.ChatId, err = r.readInt64()
+		case "default_disable_notification":
+			updateChatDefaultDisableNotification.DefaultDisableNotification, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateChatDefaultDisableNotification *UpdateChatDefaultDisableNotification) Clone() *UpdateChatDefaultDisableNotification {
+	if updateChatDefaultDisableNotification == nil {
+		return nil
+	}
+	clone := *updateChatDefaultDisableNotification
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateChatDefaultDisableNotification *UpdateChatDefaultDisableNotification) Equal(other *UpdateChatDefaultDisableNotification) bool {
+	if updateChatDefaultDisableNotification == nil || other == nil {
+		return updateChatDefaultDisableNotification == other
+	}
+	if updateChatDefaultDisableNotification.ChatId != other.ChatId {
+		return false
+	}
+	if updateChatDefaultDisableNotification.DefaultDisableNotification != other.DefaultDisableNotification {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateChatDefaultDisableNotification *UpdateChatDefaultDisableNotification) Diff(other *UpdateChatDefaultDisableNotification) []Change {
+	return updateChatDefaultDisableNotification.diff("", other, nil)
+}
+
+func (updateChatDefaultDisableNotification *UpdateChatDefaultDisableNotification) diff(path string, other *UpdateChatDefaultDisableNotification, changes []Change) []Change {
+	if updateChatDefaultDisableNotification == nil || other == nil {
+		if updateChatDefaultDisableNotification != other {
+			changes = append(changes, Change{Path: path, Old: updateChatDefaultDisableNotification, New: other})
+		}
+		return changes
+	}
+	if updateChatDefaultDisableNotification.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateChatDefaultDisableNotification.ChatId, New: other.ChatId})
+	}
+	if updateChatDefaultDisableNotification.DefaultDisableNotification != other.DefaultDisableNotification {
+		changes = append(changes, Change{Path: fieldPath(path, "default_disable_notification"), Old: updateChatDefaultDisableNotification.DefaultDisableNotification, New: other.DefaultDisableNotification})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateChatDefaultDisableNotification *UpdateChatDefaultDisableNotification) String() string {
+	return objectString(updateChatDefaultDisableNotification)
+}
+
+// LogValue logs the object as its summary
+func (updateChatDefaultDisableNotification *UpdateChatDefaultDisableNotification) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateChatDefaultDisableNotification))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateChatDefaultDisableNotification *UpdateChatDefaultDisableNotification) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateChatDefaultDisableNotification)
+}
+
+func (updateChatDefaultDisableNotification *UpdateChatDefaultDisableNotification) printText(p *printer) {
+	if updateChatDefaultDisableNotification == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateChatDefaultDisableNotification")
+	p.int("chat_id", int64(updateChatDefaultDisableNotification.ChatId))
+	p.bool("default_disable_notification", updateChatDefaultDisableNotification.DefaultDisableNotification)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateChatDefaultDisableNotification *UpdateChatDefaultDisableNotification) GetUpdateEnum() UpdateEnum {
 	return UpdateChatDefaultDisableNotificationType
 }
 
+// Accept calls the method of the visitor for UpdateChatDefaultDisableNotification
+func (updateChatDefaultDisableNotification *UpdateChatDefaultDisableNotification) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateChatDefaultDisableNotification(updateChatDefaultDisableNotification)
+}
+
 // UpdateChatHasProtectedContent A chat content was allowed or restricted for saving
 type UpdateChatHasProtectedContent struct {
 	tdCommon
@@ -1730,11 +5620,129 @@ func NewUpdateChatHasProtectedContent(chatId int64, hasProtectedContent bool) *U
 	return &updateChatHasProtectedContentTemp
 }
 
+// MarshalJSON marshals to json
+func (updateChatHasProtectedContent *UpdateChatHasProtectedContent) MarshalJSON() ([]byte, error) {
+	return updateChatHasProtectedContent.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateChatHasProtectedContent *UpdateChatHasProtectedContent) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateChatHasProtectedContent)
+}
+
+func (updateChatHasProtectedContent *UpdateChatHasProtectedContent) appendJSON(b []byte) ([]byte, error) {
+	if updateChatHasProtectedContent == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateChatHasProtectedContent.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateChatHasProtectedContent.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateChatHasProtectedContent.ChatId))
+	b = append(b, `,"has_protected_content":`...)
+	b = appendJSONBool(b, updateChatHasProtectedContent.HasProtectedContent)
+	return append(b, '}'), nil
+}
+
+func (updateChatHasProtectedContent *UpdateChatHasProtectedContent) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateChatHasProtectedContent.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateChatHasProtectedContent.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateChatHasProtectedContent.ChatId, err = r.readInt64()
+		case "has_protected_content":
+			updateChatHasProtectedContent.HasProtectedContent, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateChatHasProtectedContent *UpdateChatHasProtectedContent) Clone() *UpdateChatHasProtectedContent {
+	if updateChatHasProtectedContent == nil {
+		return nil
+	}
+	clone := *updateChatHasProtectedContent
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateChatHasProtectedContent *UpdateChatHasProtectedContent) Equal(other *UpdateChatHasProtectedContent) bool {
+	if updateChatHasProtectedContent == nil || other == nil {
+		return updateChatHasProtectedContent == other
+	}
+	if updateChatHasProtectedContent.ChatId != other.ChatId {
+		return false
+	}
+	if updateChatHasProtectedContent.HasProtectedContent != other.HasProtectedContent {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateChatHasProtectedContent *UpdateChatHasProtectedContent) Diff(other *UpdateChatHasProtectedContent) []Change {
+	return updateChatHasProtectedContent.diff("", other, nil)
+}
+
+func (updateChatHasProtectedContent *UpdateChatHasProtectedContent) diff(path string, other *UpdateChatHasProtectedContent, changes []Change) []Change {
+	if updateChatHasProtectedContent == nil || other == nil {
+		if updateChatHasProtectedContent != other {
+			changes = append(changes, Change{Path: path, Old: updateChatHasProtectedContent, New: other})
+		}
+		return changes
+	}
+	if updateChatHasProtectedContent.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateChatHasProtectedContent.ChatId, New: other.ChatId})
+	}
+	if updateChatHasProtectedContent.HasProtectedContent != other.HasProtectedContent {
+		changes = append(changes, Change{Path: fieldPath(path, "has_protected_content"), Old: updateChatHasProtectedContent.HasProtectedContent, New: other.HasProtectedContent})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateChatHasProtectedContent *UpdateChatHasProtectedContent) String() string {
+	return objectString(updateChatHasProtectedContent)
+}
+
+// LogValue logs the object as its summary
+func (updateChatHasProtectedContent *UpdateChatHasProtectedContent) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateChatHasProtectedContent))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateChatHasProtectedContent *UpdateChatHasProtectedContent) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateChatHasProtectedContent)
+}
+
+func (updateChatHasProtectedContent *UpdateChatHasProtectedContent) printText(p *printer) {
+	if updateChatHasProtectedContent == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateChatHasProtectedContent")
+	p.int("chat_id", int64(updateChatHasProtectedContent.ChatId))
+	p.bool("has_protected_content", updateChatHasProtectedContent.HasProtectedContent)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateChatHasProtectedContent *UpdateChatHasProtectedContent) GetUpdateEnum() UpdateEnum {
 	return UpdateChatHasProtectedContentType
 }
 
+// Accept calls the method of the visitor for UpdateChatHasProtectedContent
+func (updateChatHasProtectedContent *UpdateChatHasProtectedContent) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateChatHasProtectedContent(updateChatHasProtectedContent)
+}
+
 // UpdateChatHasScheduledMessages A chat's has_scheduled_messages field has changed
 type UpdateChatHasScheduledMessages struct {
 	tdCommon
@@ -1761,11 +5769,129 @@ func NewUpdateChatHasScheduledMessages(chatId int64, hasScheduledMessages bool)
 	return &updateChatHasScheduledMessagesTemp
 }
 
+// MarshalJSON marshals to json
+func (updateChatHasScheduledMessages *UpdateChatHasScheduledMessages) MarshalJSON() ([]byte, error) {
+	return updateChatHasScheduledMessages.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateChatHasScheduledMessages *UpdateChatHasScheduledMessages) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateChatHasScheduledMessages)
+}
+
+func (updateChatHasScheduledMessages *UpdateChatHasScheduledMessages) appendJSON(b []byte) ([]byte, error) {
+	if updateChatHasScheduledMessages == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateChatHasScheduledMessages.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateChatHasScheduledMessages.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateChatHasScheduledMessages.ChatId))
+	b = append(b, `,"has_scheduled_messages":`...)
+	b = appendJSONBool(b, updateChatHasScheduledMessages.HasScheduledMessages)
+	return append(b, '}'), nil
+}
+
+func (updateChatHasScheduledMessages *UpdateChatHasScheduledMessages) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateChatHasScheduledMessages.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateChatHasScheduledMessages.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateChatHasScheduledMessages.ChatId, err = r.readInt64()
+		case "has_scheduled_messages":
+			updateChatHasScheduledMessages.HasScheduledMessages, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateChatHasScheduledMessages *UpdateChatHasScheduledMessages) Clone() *UpdateChatHasScheduledMessages {
+	if updateChatHasScheduledMessages == nil {
+		return nil
+	}
+	clone := *updateChatHasScheduledMessages
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateChatHasScheduledMessages *UpdateChatHasScheduledMessages) Equal(other *UpdateChatHasScheduledMessages) bool {
+	if updateChatHasScheduledMessages == nil || other == nil {
+		return updateChatHasScheduledMessages == other
+	}
+	if updateChatHasScheduledMessages.ChatId != other.ChatId {
+		return false
+	}
+	if updateChatHasScheduledMessages.HasScheduledMessages != other.HasScheduledMessages {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateChatHasScheduledMessages *UpdateChatHasScheduledMessages) Diff(other *UpdateChatHasScheduledMessages) []Change {
+	return updateChatHasScheduledMessages.diff("", other, nil)
+}
+
+func (updateChatHasScheduledMessages *UpdateChatHasScheduledMessages) diff(path string, other *UpdateChatHasScheduledMessages, changes []Change) []Change {
+	if updateChatHasScheduledMessages == nil || other == nil {
+		if updateChatHasScheduledMessages != other {
+			changes = append(changes, Change{Path: path, Old: updateChatHasScheduledMessages, New: other})
+		}
+		return changes
+	}
+	if updateChatHasScheduledMessages.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateChatHasScheduledMessages.ChatId, New: other.ChatId})
+	}
+	if updateChatHasScheduledMessages.HasScheduledMessages != other.HasScheduledMessages {
+		changes = append(changes, Change{Path: fieldPath(path, "has_scheduled_messages"), Old: updateChatHasScheduledMessages.HasScheduledMessages, New: other.HasScheduledMessages})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateChatHasScheduledMessages *UpdateChatHasScheduledMessages) String() string {
+	return objectString(updateChatHasScheduledMessages)
+}
+
+// LogValue logs the object as its summary
+func (updateChatHasScheduledMessages *UpdateChatHasScheduledMessages) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateChatHasScheduledMessages))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateChatHasScheduledMessages *UpdateChatHasScheduledMessages) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateChatHasScheduledMessages)
+}
+
+func (updateChatHasScheduledMessages *UpdateChatHasScheduledMessages) printText(p *printer) {
+	if updateChatHasScheduledMessages == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateChatHasScheduledMessages")
+	p.int("chat_id", int64(updateChatHasScheduledMessages.ChatId))
+	p.bool("has_scheduled_messages", updateChatHasScheduledMessages.HasScheduledMessages)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateChatHasScheduledMessages *UpdateChatHasScheduledMessages) GetUpdateEnum() UpdateEnum {
 	return UpdateChatHasScheduledMessagesType
 }
 
+// Accept calls the method of the visitor for UpdateChatHasScheduledMessages
+func (updateChatHasScheduledMessages *UpdateChatHasScheduledMessages) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateChatHasScheduledMessages(updateChatHasScheduledMessages)
+}
+
 // UpdateChatIsBlocked A chat was blocked or unblocked
 type UpdateChatIsBlocked struct {
 	tdCommon
@@ -1792,14 +5918,132 @@ func NewUpdateChatIsBlocked(chatId int64, isBlocked bool) *UpdateChatIsBlocked {
 	return &updateChatIsBlockedTemp
 }
 
-// GetUpdateEnum return the enum type of this object
-func (updateChatIsBlocked *UpdateChatIsBlocked) GetUpdateEnum() UpdateEnum {
-	return UpdateChatIsBlockedType
+// MarshalJSON marshals to json
+func (updateChatIsBlocked *UpdateChatIsBlocked) MarshalJSON() ([]byte, error) {
+	return updateChatIsBlocked.appendJSON(nil)
 }
 
-// UpdateChatIsMarkedAsUnread A chat was marked as unread or was read
-type UpdateChatIsMarkedAsUnread struct {
-	tdCommon
+// UnmarshalJSON unmarshal to json
+func (updateChatIsBlocked *UpdateChatIsBlocked) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateChatIsBlocked)
+}
+
+func (updateChatIsBlocked *UpdateChatIsBlocked) appendJSON(b []byte) ([]byte, error) {
+	if updateChatIsBlocked == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateChatIsBlocked.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateChatIsBlocked.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateChatIsBlocked.ChatId))
+	b = append(b, `,"is_blocked":`...)
+	b = appendJSONBool(b, updateChatIsBlocked.IsBlocked)
+	return append(b, '}'), nil
+}
+
+func (updateChatIsBlocked *UpdateChatIsBlocked) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateChatIsBlocked.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateChatIsBlocked.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateChatIsBlocked.ChatId, err = r.readInt64()
+		case "is_blocked":
+			updateChatIsBlocked.IsBlocked, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateChatIsBlocked *UpdateChatIsBlocked) Clone() *UpdateChatIsBlocked {
+	if updateChatIsBlocked == nil {
+		return nil
+	}
+	clone := *updateChatIsBlocked
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateChatIsBlocked *UpdateChatIsBlocked) Equal(other *UpdateChatIsBlocked) bool {
+	if updateChatIsBlocked == nil || other == nil {
+		return updateChatIsBlocked == other
+	}
+	if updateChatIsBlocked.ChatId != other.ChatId {
+		return false
+	}
+	if updateChatIsBlocked.IsBlocked != other.IsBlocked {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateChatIsBlocked *UpdateChatIsBlocked) Diff(other *UpdateChatIsBlocked) []Change {
+	return updateChatIsBlocked.diff("", other, nil)
+}
+
+func (updateChatIsBlocked *UpdateChatIsBlocked) diff(path string, other *UpdateChatIsBlocked, changes []Change) []Change {
+	if updateChatIsBlocked == nil || other == nil {
+		if updateChatIsBlocked != other {
+			changes = append(changes, Change{Path: path, Old: updateChatIsBlocked, New: other})
+		}
+		return changes
+	}
+	if updateChatIsBlocked.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateChatIsBlocked.ChatId, New: other.ChatId})
+	}
+	if updateChatIsBlocked.IsBlocked != other.IsBlocked {
+		changes = append(changes, Change{Path: fieldPath(path, "is_blocked"), Old: updateChatIsBlocked.IsBlocked, New: other.IsBlocked})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateChatIsBlocked *UpdateChatIsBlocked) String() string {
+	return objectString(updateChatIsBlocked)
+}
+
+// LogValue logs the object as its summary
+func (updateChatIsBlocked *UpdateChatIsBlocked) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateChatIsBlocked))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateChatIsBlocked *UpdateChatIsBlocked) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateChatIsBlocked)
+}
+
+func (updateChatIsBlocked *UpdateChatIsBlocked) printText(p *printer) {
+	if updateChatIsBlocked == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateChatIsBlocked")
+	p.int("chat_id", int64(updateChatIsBlocked.ChatId))
+	p.bool("is_blocked", updateChatIsBlocked.IsBlocked)
+	p.endObject(state)
+}
+
+// GetUpdateEnum return the enum type of this object
+func (updateChatIsBlocked *UpdateChatIsBlocked) GetUpdateEnum() UpdateEnum {
+	return UpdateChatIsBlockedType
+}
+
+// Accept calls the method of the visitor for UpdateChatIsBlocked
+func (updateChatIsBlocked *UpdateChatIsBlocked) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateChatIsBlocked(updateChatIsBlocked)
+}
+
+// UpdateChatIsMarkedAsUnread A chat was marked as unread or was read
+type UpdateChatIsMarkedAsUnread struct {
+	tdCommon
 	ChatId           int64 `json:"chat_id"`             // Chat identifier
 	IsMarkedAsUnread bool  `json:"is_marked_as_unread"` // New value of is_marked_as_unread
 }
@@ -1823,11 +6067,129 @@ func NewUpdateChatIsMarkedAsUnread(chatId int64, isMarkedAsUnread bool) *UpdateC
 	return &updateChatIsMarkedAsUnreadTemp
 }
 
+// MarshalJSON marshals to json
+func (updateChatIsMarkedAsUnread *UpdateChatIsMarkedAsUnread) MarshalJSON() ([]byte, error) {
+	return updateChatIsMarkedAsUnread.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateChatIsMarkedAsUnread *UpdateChatIsMarkedAsUnread) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateChatIsMarkedAsUnread)
+}
+
+func (updateChatIsMarkedAsUnread *UpdateChatIsMarkedAsUnread) appendJSON(b []byte) ([]byte, error) {
+	if updateChatIsMarkedAsUnread == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateChatIsMarkedAsUnread.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateChatIsMarkedAsUnread.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateChatIsMarkedAsUnread.ChatId))
+	b = append(b, `,"is_marked_as_unread":`...)
+	b = appendJSONBool(b, updateChatIsMarkedAsUnread.IsMarkedAsUnread)
+	return append(b, '}'), nil
+}
+
+func (updateChatIsMarkedAsUnread *UpdateChatIsMarkedAsUnread) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateChatIsMarkedAsUnread.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateChatIsMarkedAsUnread.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateChatIsMarkedAsUnread.ChatId, err = r.readInt64()
+		case "is_marked_as_unread":
+			updateChatIsMarkedAsUnread.IsMarkedAsUnread, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateChatIsMarkedAsUnread *UpdateChatIsMarkedAsUnread) Clone() *UpdateChatIsMarkedAsUnread {
+	if updateChatIsMarkedAsUnread == nil {
+		return nil
+	}
+	clone := *updateChatIsMarkedAsUnread
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateChatIsMarkedAsUnread *UpdateChatIsMarkedAsUnread) Equal(other *UpdateChatIsMarkedAsUnread) bool {
+	if updateChatIsMarkedAsUnread == nil || other == nil {
+		return updateChatIsMarkedAsUnread == other
+	}
+	if updateChatIsMarkedAsUnread.ChatId != other.ChatId {
+		return false
+	}
+	if updateChatIsMarkedAsUnread.IsMarkedAsUnread != other.IsMarkedAsUnread {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateChatIsMarkedAsUnread *UpdateChatIsMarkedAsUnread) Diff(other *UpdateChatIsMarkedAsUnread) []Change {
+	return updateChatIsMarkedAsUnread.diff("", other, nil)
+}
+
+func (updateChatIsMarkedAsUnread *UpdateChatIsMarkedAsUnread) diff(path string, other *UpdateChatIsMarkedAsUnread, changes []Change) []Change {
+	if updateChatIsMarkedAsUnread == nil || other == nil {
+		if updateChatIsMarkedAsUnread != other {
+			changes = append(changes, Change{Path: path, Old: updateChatIsMarkedAsUnread, New: other})
+		}
+		return changes
+	}
+	if updateChatIsMarkedAsUnread.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateChatIsMarkedAsUnread.ChatId, New: other.ChatId})
+	}
+	if updateChatIsMarkedAsUnread.IsMarkedAsUnread != other.IsMarkedAsUnread {
+		changes = append(changes, Change{Path: fieldPath(path, "is_marked_as_unread"), Old: updateChatIsMarkedAsUnread.IsMarkedAsUnread, New: other.IsMarkedAsUnread})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateChatIsMarkedAsUnread *UpdateChatIsMarkedAsUnread) String() string {
+	return objectString(updateChatIsMarkedAsUnread)
+}
+
+// LogValue logs the object as its summary
+func (updateChatIsMarkedAsUnread *UpdateChatIsMarkedAsUnread) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateChatIsMarkedAsUnread))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateChatIsMarkedAsUnread *UpdateChatIsMarkedAsUnread) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateChatIsMarkedAsUnread)
+}
+
+func (updateChatIsMarkedAsUnread *UpdateChatIsMarkedAsUnread) printText(p *printer) {
+	if updateChatIsMarkedAsUnread == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateChatIsMarkedAsUnread")
+	p.int("chat_id", int64(updateChatIsMarkedAsUnread.ChatId))
+	p.bool("is_marked_as_unread", updateChatIsMarkedAsUnread.IsMarkedAsUnread)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateChatIsMarkedAsUnread *UpdateChatIsMarkedAsUnread) GetUpdateEnum() UpdateEnum {
 	return UpdateChatIsMarkedAsUnreadType
 }
 
+// Accept calls the method of the visitor for UpdateChatIsMarkedAsUnread
+func (updateChatIsMarkedAsUnread *UpdateChatIsMarkedAsUnread) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateChatIsMarkedAsUnread(updateChatIsMarkedAsUnread)
+}
+
 // UpdateChatFilters The list of chat filters or a chat filter has changed
 type UpdateChatFilters struct {
 	tdCommon
@@ -1851,11 +6213,160 @@ func NewUpdateChatFilters(chatFilters []ChatFilterInfo) *UpdateChatFilters {
 	return &updateChatFiltersTemp
 }
 
+// MarshalJSON marshals to json
+func (updateChatFilters *UpdateChatFilters) MarshalJSON() ([]byte, error) {
+	return updateChatFilters.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateChatFilters *UpdateChatFilters) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateChatFilters)
+}
+
+func (updateChatFilters *UpdateChatFilters) appendJSON(b []byte) ([]byte, error) {
+	if updateChatFilters == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateChatFilters.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateChatFilters.tdCommon.Extra)
+	b = append(b, `,"chat_filters":`...)
+	if updateChatFilters.ChatFilters == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range updateChatFilters.ChatFilters {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = updateChatFilters.ChatFilters[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (updateChatFilters *UpdateChatFilters) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateChatFilters.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateChatFilters.tdCommon.Extra, err = r.readString()
+		case "chat_filters":
+			if r.readNull() {
+				updateChatFilters.ChatFilters = nil
+			} else {
+				updateChatFilters.ChatFilters = make([]ChatFilterInfo, 0)
+				err = r.readArray(func() (err error) {
+					var item0 ChatFilterInfo
+					err = item0.decodeJSON(r)
+					updateChatFilters.ChatFilters = append(updateChatFilters.ChatFilters, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateChatFilters *UpdateChatFilters) Clone() *UpdateChatFilters {
+	if updateChatFilters == nil {
+		return nil
+	}
+	clone := *updateChatFilters
+	if updateChatFilters.ChatFilters != nil {
+		clone.ChatFilters = make([]ChatFilterInfo, len(updateChatFilters.ChatFilters))
+		for i0 := range updateChatFilters.ChatFilters {
+			clone.ChatFilters[i0] = *updateChatFilters.ChatFilters[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateChatFilters *UpdateChatFilters) Equal(other *UpdateChatFilters) bool {
+	if updateChatFilters == nil || other == nil {
+		return updateChatFilters == other
+	}
+	if len(updateChatFilters.ChatFilters) != len(other.ChatFilters) {
+		return false
+	}
+	for i0 := range updateChatFilters.ChatFilters {
+		if !updateChatFilters.ChatFilters[i0].Equal(&other.ChatFilters[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateChatFilters *UpdateChatFilters) Diff(other *UpdateChatFilters) []Change {
+	return updateChatFilters.diff("", other, nil)
+}
+
+func (updateChatFilters *UpdateChatFilters) diff(path string, other *UpdateChatFilters, changes []Change) []Change {
+	if updateChatFilters == nil || other == nil {
+		if updateChatFilters != other {
+			changes = append(changes, Change{Path: path, Old: updateChatFilters, New: other})
+		}
+		return changes
+	}
+	if len(updateChatFilters.ChatFilters) != len(other.ChatFilters) {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_filters"), Old: updateChatFilters.ChatFilters, New: other.ChatFilters})
+	} else {
+		for i0 := range updateChatFilters.ChatFilters {
+			changes = updateChatFilters.ChatFilters[i0].diff(indexPath(fieldPath(path, "chat_filters"), i0), &other.ChatFilters[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateChatFilters *UpdateChatFilters) String() string {
+	return objectString(updateChatFilters)
+}
+
+// LogValue logs the object as its summary
+func (updateChatFilters *UpdateChatFilters) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateChatFilters))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateChatFilters *UpdateChatFilters) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateChatFilters)
+}
+
+func (updateChatFilters *UpdateChatFilters) printText(p *printer) {
+	if updateChatFilters == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateChatFilters")
+	p.vector("chat_filters", len(updateChatFilters.ChatFilters), func(i0 int) {
+		updateChatFilters.ChatFilters[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateChatFilters *UpdateChatFilters) GetUpdateEnum() UpdateEnum {
 	return UpdateChatFiltersType
 }
 
+// Accept calls the method of the visitor for UpdateChatFilters
+func (updateChatFilters *UpdateChatFilters) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateChatFilters(updateChatFilters)
+}
+
 // UpdateChatOnlineMemberCount The number of online group members has changed. This update with non-zero count is sent only for currently opened chats. There is no guarantee that it will be sent just after the count has changed
 type UpdateChatOnlineMemberCount struct {
 	tdCommon
@@ -1882,11 +6393,129 @@ func NewUpdateChatOnlineMemberCount(chatId int64, onlineMemberCount int32) *Upda
 	return &updateChatOnlineMemberCountTemp
 }
 
+// MarshalJSON marshals to json
+func (updateChatOnlineMemberCount *UpdateChatOnlineMemberCount) MarshalJSON() ([]byte, error) {
+	return updateChatOnlineMemberCount.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateChatOnlineMemberCount *UpdateChatOnlineMemberCount) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateChatOnlineMemberCount)
+}
+
+func (updateChatOnlineMemberCount *UpdateChatOnlineMemberCount) appendJSON(b []byte) ([]byte, error) {
+	if updateChatOnlineMemberCount == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateChatOnlineMemberCount.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateChatOnlineMemberCount.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateChatOnlineMemberCount.ChatId))
+	b = append(b, `,"online_member_count":`...)
+	b = appendJSONInt(b, int64(updateChatOnlineMemberCount.OnlineMemberCount))
+	return append(b, '}'), nil
+}
+
+func (updateChatOnlineMemberCount *UpdateChatOnlineMemberCount) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateChatOnlineMemberCount.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateChatOnlineMemberCount.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateChatOnlineMemberCount.ChatId, err = r.readInt64()
+		case "online_member_count":
+			updateChatOnlineMemberCount.OnlineMemberCount, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateChatOnlineMemberCount *UpdateChatOnlineMemberCount) Clone() *UpdateChatOnlineMemberCount {
+	if updateChatOnlineMemberCount == nil {
+		return nil
+	}
+	clone := *updateChatOnlineMemberCount
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateChatOnlineMemberCount *UpdateChatOnlineMemberCount) Equal(other *UpdateChatOnlineMemberCount) bool {
+	if updateChatOnlineMemberCount == nil || other == nil {
+		return updateChatOnlineMemberCount == other
+	}
+	if updateChatOnlineMemberCount.ChatId != other.ChatId {
+		return false
+	}
+	if updateChatOnlineMemberCount.OnlineMemberCount != other.OnlineMemberCount {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateChatOnlineMemberCount *UpdateChatOnlineMemberCount) Diff(other *UpdateChatOnlineMemberCount) []Change {
+	return updateChatOnlineMemberCount.diff("", other, nil)
+}
+
+func (updateChatOnlineMemberCount *UpdateChatOnlineMemberCount) diff(path string, other *UpdateChatOnlineMemberCount, changes []Change) []Change {
+	if updateChatOnlineMemberCount == nil || other == nil {
+		if updateChatOnlineMemberCount != other {
+			changes = append(changes, Change{Path: path, Old: updateChatOnlineMemberCount, New: other})
+		}
+		return changes
+	}
+	if updateChatOnlineMemberCount.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateChatOnlineMemberCount.ChatId, New: other.ChatId})
+	}
+	if updateChatOnlineMemberCount.OnlineMemberCount != other.OnlineMemberCount {
+		changes = append(changes, Change{Path: fieldPath(path, "online_member_count"), Old: updateChatOnlineMemberCount.OnlineMemberCount, New: other.OnlineMemberCount})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateChatOnlineMemberCount *UpdateChatOnlineMemberCount) String() string {
+	return objectString(updateChatOnlineMemberCount)
+}
+
+// LogValue logs the object as its summary
+func (updateChatOnlineMemberCount *UpdateChatOnlineMemberCount) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateChatOnlineMemberCount))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateChatOnlineMemberCount *UpdateChatOnlineMemberCount) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateChatOnlineMemberCount)
+}
+
+func (updateChatOnlineMemberCount *UpdateChatOnlineMemberCount) printText(p *printer) {
+	if updateChatOnlineMemberCount == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateChatOnlineMemberCount")
+	p.int("chat_id", int64(updateChatOnlineMemberCount.ChatId))
+	p.int("online_member_count", int64(updateChatOnlineMemberCount.OnlineMemberCount))
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateChatOnlineMemberCount *UpdateChatOnlineMemberCount) GetUpdateEnum() UpdateEnum {
 	return UpdateChatOnlineMemberCountType
 }
 
+// Accept calls the method of the visitor for UpdateChatOnlineMemberCount
+func (updateChatOnlineMemberCount *UpdateChatOnlineMemberCount) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateChatOnlineMemberCount(updateChatOnlineMemberCount)
+}
+
 // UpdateScopeNotificationSettings Notification settings for some type of chats were updated
 type UpdateScopeNotificationSettings struct {
 	tdCommon
@@ -1913,29 +6542,127 @@ func NewUpdateScopeNotificationSettings(scope NotificationSettingsScope, notific
 	return &updateScopeNotificationSettingsTemp
 }
 
+// MarshalJSON marshals to json
+func (updateScopeNotificationSettings *UpdateScopeNotificationSettings) MarshalJSON() ([]byte, error) {
+	return updateScopeNotificationSettings.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (updateScopeNotificationSettings *UpdateScopeNotificationSettings) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, updateScopeNotificationSettings)
+}
+
+func (updateScopeNotificationSettings *UpdateScopeNotificationSettings) appendJSON(b []byte) ([]byte, error) {
+	if updateScopeNotificationSettings == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateScopeNotificationSettings.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateScopeNotificationSettings.tdCommon.Extra)
+	b = append(b, `,"scope":`...)
+	b, err = appendJSONValue(b, updateScopeNotificationSettings.Scope)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-		NotificationSettings *ScopeNotificationSettings `json:"notification_settings"` // The new notification settings
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	b = append(b, `,"notification_settings":`...)
+	b, err = updateScopeNotificationSettings.NotificationSettings.appendJSON(b)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateScopeNotificationSettings *UpdateScopeNotificationSettings) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateScopeNotificationSettings.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateScopeNotificationSettings.tdCommon.Extra, err = r.readString()
+		case "scope":
+			updateScopeNotificationSettings.Scope, err = decodeNotificationSettingsScope(r)
+		case "notification_settings":
+			if r.readNull() {
+				updateScopeNotificationSettings.NotificationSettings = nil
+			} else {
+				updateScopeNotificationSettings.NotificationSettings = new(ScopeNotificationSettings)
+				err = updateScopeNotificationSettings.NotificationSettings.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateScopeNotificationSettings *UpdateScopeNotificationSettings) Clone() *UpdateScopeNotificationSettings {
+	if updateScopeNotificationSettings == nil {
+		return nil
+	}
+	clone := *updateScopeNotificationSettings
+	clone.Scope = cloneNotificationSettingsScope(updateScopeNotificationSettings.Scope)
+	clone.NotificationSettings = updateScopeNotificationSettings.NotificationSettings.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateScopeNotificationSettings *UpdateScopeNotificationSettings) Equal(other *UpdateScopeNotificationSettings) bool {
+	if updateScopeNotificationSettings == nil || other == nil {
+		return updateScopeNotificationSettings == other
+	}
+	if !equalNotificationSettingsScope(updateScopeNotificationSettings.Scope, other.Scope) {
+		return false
+	}
+	if !updateScopeNotificationSettings.NotificationSettings.Equal(other.NotificationSettings) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateScopeNotificationSettings *UpdateScopeNotificationSettings) Diff(other *UpdateScopeNotificationSettings) []Change {
+	return updateScopeNotificationSettings.diff("", other, nil)
+}
+
+func (updateScopeNotificationSettings *UpdateScopeNotificationSettings) diff(path string, other *UpdateScopeNotificationSettings, changes []Change) []Change {
+	if updateScopeNotificationSettings == nil || other == nil {
+		if updateScopeNotificationSettings != other {
+			changes = append(changes, Change{Path: path, Old: updateScopeNotificationSettings, New: other})
+		}
+		return changes
 	}
+	changes = diffNotificationSettingsScope(fieldPath(path, "scope"), updateScopeNotificationSettings.Scope, other.Scope, changes)
+	changes = updateScopeNotificationSettings.NotificationSettings.diff(fieldPath(path, "notification_settings"), other.NotificationSettings, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateScopeNotificationSettings *UpdateScopeNotificationSettings) String() string {
+	return objectString(updateScopeNotificationSettings)
+}
 
-	updateScopeNotificationSettings.tdCommon = tempObj.tdCommon
-	updateScopeNotificationSettings.NotificationSettings = tempObj.NotificationSettings
+// LogValue logs the object as its summary
+func (updateScopeNotificationSettings *UpdateScopeNotificationSettings) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateScopeNotificationSettings))
+}
 
-	fieldScope, _ := unmarshalNotificationSettingsScope(objMap["scope"])
-	updateScopeNotificationSettings.Scope = fieldScope
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateScopeNotificationSettings *UpdateScopeNotificationSettings) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateScopeNotificationSettings)
+}
 
-	return nil
+func (updateScopeNotificationSettings *UpdateScopeNotificationSettings) printText(p *printer) {
+	if updateScopeNotificationSettings == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateScopeNotificationSettings")
+	p.object("scope", updateScopeNotificationSettings.Scope)
+	p.object("notification_settings", updateScopeNotificationSettings.NotificationSettings)
+	p.endObject(state)
 }
 
 // GetUpdateEnum return the enum type of this object
@@ -1943,6 +6670,11 @@ func (updateScopeNotificationSettings *UpdateScopeNotificationSettings) GetUpdat
 	return UpdateScopeNotificationSettingsType
 }
 
+// Accept calls the method of the visitor for UpdateScopeNotificationSettings
+func (updateScopeNotificationSettings *UpdateScopeNotificationSettings) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateScopeNotificationSettings(updateScopeNotificationSettings)
+}
+
 // UpdateNotification A notification was changed
 type UpdateNotification struct {
 	tdCommon
@@ -1969,11 +6701,137 @@ func NewUpdateNotification(notificationGroupId int32, notification *Notification
 	return &updateNotificationTemp
 }
 
+// MarshalJSON marshals to json
+func (updateNotification *UpdateNotification) MarshalJSON() ([]byte, error) {
+	return updateNotification.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateNotification *UpdateNotification) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateNotification)
+}
+
+func (updateNotification *UpdateNotification) appendJSON(b []byte) ([]byte, error) {
+	if updateNotification == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateNotification.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateNotification.tdCommon.Extra)
+	b = append(b, `,"notification_group_id":`...)
+	b = appendJSONInt(b, int64(updateNotification.NotificationGroupId))
+	b = append(b, `,"notification":`...)
+	b, err = updateNotification.Notification.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateNotification *UpdateNotification) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateNotification.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateNotification.tdCommon.Extra, err = r.readString()
+		case "notification_group_id":
+			updateNotification.NotificationGroupId, err = r.readInt32()
+		case "notification":
+			if r.readNull() {
+				updateNotification.Notification = nil
+			} else {
+				updateNotification.Notification = new(Notification)
+				err = updateNotification.Notification.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateNotification *UpdateNotification) Clone() *UpdateNotification {
+	if updateNotification == nil {
+		return nil
+	}
+	clone := *updateNotification
+	clone.Notification = updateNotification.Notification.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateNotification *UpdateNotification) Equal(other *UpdateNotification) bool {
+	if updateNotification == nil || other == nil {
+		return updateNotification == other
+	}
+	if updateNotification.NotificationGroupId != other.NotificationGroupId {
+		return false
+	}
+	if !updateNotification.Notification.Equal(other.Notification) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateNotification *UpdateNotification) Diff(other *UpdateNotification) []Change {
+	return updateNotification.diff("", other, nil)
+}
+
+func (updateNotification *UpdateNotification) diff(path string, other *UpdateNotification, changes []Change) []Change {
+	if updateNotification == nil || other == nil {
+		if updateNotification != other {
+			changes = append(changes, Change{Path: path, Old: updateNotification, New: other})
+		}
+		return changes
+	}
+	if updateNotification.NotificationGroupId != other.NotificationGroupId {
+		changes = append(changes, Change{Path: fieldPath(path, "notification_group_id"), Old: updateNotification.NotificationGroupId, New: other.NotificationGroupId})
+	}
+	changes = updateNotification.Notification.diff(fieldPath(path, "notification"), other.Notification, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateNotification *UpdateNotification) String() string {
+	return objectString(updateNotification)
+}
+
+// LogValue logs the object as its summary
+func (updateNotification *UpdateNotification) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateNotification))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateNotification *UpdateNotification) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateNotification)
+}
+
+func (updateNotification *UpdateNotification) printText(p *printer) {
+	if updateNotification == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateNotification")
+	p.int("notification_group_id", int64(updateNotification.NotificationGroupId))
+	p.object("notification", updateNotification.Notification)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateNotification *UpdateNotification) GetUpdateEnum() UpdateEnum {
 	return UpdateNotificationType
 }
 
+// Accept calls the method of the visitor for UpdateNotification
+func (updateNotification *UpdateNotification) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateNotification(updateNotification)
+}
+
 // UpdateNotificationGroup A list of active notifications in a notification group has changed
 type UpdateNotificationGroup struct {
 	tdCommon
@@ -2018,52 +6876,281 @@ func NewUpdateNotificationGroup(notificationGroupId int32, typeParam Notificatio
 	return &updateNotificationGroupTemp
 }
 
+// MarshalJSON marshals to json
+func (updateNotificationGroup *UpdateNotificationGroup) MarshalJSON() ([]byte, error) {
+	return updateNotificationGroup.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (updateNotificationGroup *UpdateNotificationGroup) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		NotificationGroupId        int32          `json:"notification_group_id"`         // Unique notification group identifier
-		ChatId                     int64          `json:"chat_id"`                       // Identifier of a chat to which all notifications in the group belong
-		NotificationSettingsChatId int64          `json:"notification_settings_chat_id"` // Chat identifier, which notification settings must be applied to the added notifications
-		IsSilent                   bool           `json:"is_silent"`                     // True, if the notifications must be shown without sound
-		TotalCount                 int32          `json:"total_count"`                   // Total number of unread notifications in the group, can be bigger than number of active notifications
-		AddedNotifications         []Notification `json:"added_notifications"`           // List of added group notifications, sorted by notification ID
-		RemovedNotificationIds     []int32        `json:"removed_notification_ids"`      // Identifiers of removed group notifications, sorted by notification ID
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	return unmarshalFast(b, updateNotificationGroup)
+}
+
+func (updateNotificationGroup *UpdateNotificationGroup) appendJSON(b []byte) ([]byte, error) {
+	if updateNotificationGroup == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateNotificationGroup.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateNotificationGroup.tdCommon.Extra)
+	b = append(b, `,"notification_group_id":`...)
+	b = appendJSONInt(b, int64(updateNotificationGroup.NotificationGroupId))
+	b = append(b, `,"type":`...)
+	b, err = appendJSONValue(b, updateNotificationGroup.Type)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateNotificationGroup.ChatId))
+	b = append(b, `,"notification_settings_chat_id":`...)
+	b = appendJSONInt(b, int64(updateNotificationGroup.NotificationSettingsChatId))
+	b = append(b, `,"is_silent":`...)
+	b = appendJSONBool(b, updateNotificationGroup.IsSilent)
+	b = append(b, `,"total_count":`...)
+	b = appendJSONInt(b, int64(updateNotificationGroup.TotalCount))
+	b = append(b, `,"added_notifications":`...)
+	if updateNotificationGroup.AddedNotifications == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range updateNotificationGroup.AddedNotifications {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = updateNotificationGroup.AddedNotifications[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
 	}
+	b = append(b, `,"removed_notification_ids":`...)
+	if updateNotificationGroup.RemovedNotificationIds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range updateNotificationGroup.RemovedNotificationIds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(updateNotificationGroup.RemovedNotificationIds[i0]))
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (updateNotificationGroup *UpdateNotificationGroup) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateNotificationGroup.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateNotificationGroup.tdCommon.Extra, err = r.readString()
+		case "notification_group_id":
+			updateNotificationGroup.NotificationGroupId, err = r.readInt32()
+		case "type":
+			updateNotificationGroup.Type, err = decodeNotificationGroupType(r)
+		case "chat_id":
+			updateNotificationGroup.ChatId, err = r.readInt64()
+		case "notification_settings_chat_id":
+			updateNotificationGroup.NotificationSettingsChatId, err = r.readInt64()
+		case "is_silent":
+			updateNotificationGroup.IsSilent, err = r.readBool()
+		case "total_count":
+			updateNotificationGroup.TotalCount, err = r.readInt32()
+		case "added_notifications":
+			if r.readNull() {
+				updateNotificationGroup.AddedNotifications = nil
+			} else {
+				updateNotificationGroup.AddedNotifications = make([]Notification, 0)
+				err = r.readArray(func() (err error) {
+					var item0 Notification
+					err = item0.decodeJSON(r)
+					updateNotificationGroup.AddedNotifications = append(updateNotificationGroup.AddedNotifications, item0)
+					return
+				})
+			}
+		case "removed_notification_ids":
+			if r.readNull() {
+				updateNotificationGroup.RemovedNotificationIds = nil
+			} else {
+				updateNotificationGroup.RemovedNotificationIds = make([]int32, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int32
+					item0, err = r.readInt32()
+					updateNotificationGroup.RemovedNotificationIds = append(updateNotificationGroup.RemovedNotificationIds, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	updateNotificationGroup.tdCommon = tempObj.tdCommon
-	updateNotificationGroup.NotificationGroupId = tempObj.NotificationGroupId
-	updateNotificationGroup.ChatId = tempObj.ChatId
-	updateNotificationGroup.NotificationSettingsChatId = tempObj.NotificationSettingsChatId
-	updateNotificationGroup.IsSilent = tempObj.IsSilent
-	updateNotificationGroup.TotalCount = tempObj.TotalCount
-	updateNotificationGroup.AddedNotifications = tempObj.AddedNotifications
-	updateNotificationGroup.RemovedNotificationIds = tempObj.RemovedNotificationIds
+// Clone returns a deep copy of the object
+func (updateNotificationGroup *UpdateNotificationGroup) Clone() *UpdateNotificationGroup {
+	if updateNotificationGroup == nil {
+		return nil
+	}
+	clone := *updateNotificationGroup
+	clone.Type = cloneNotificationGroupType(updateNotificationGroup.Type)
+	if updateNotificationGroup.AddedNotifications != nil {
+		clone.AddedNotifications = make([]Notification, len(updateNotificationGroup.AddedNotifications))
+		for i0 := range updateNotificationGroup.AddedNotifications {
+			clone.AddedNotifications[i0] = *updateNotificationGroup.AddedNotifications[i0].Clone()
+		}
+	}
+	if updateNotificationGroup.RemovedNotificationIds != nil {
+		clone.RemovedNotificationIds = make([]int32, len(updateNotificationGroup.RemovedNotificationIds))
+		copy(clone.RemovedNotificationIds, updateNotificationGroup.RemovedNotificationIds)
+	}
+	return &clone
+}
 
-	fieldType, _ := unmarshalNotificationGroupType(objMap["type"])
-	updateNotificationGroup.Type = fieldType
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateNotificationGroup *UpdateNotificationGroup) Equal(other *UpdateNotificationGroup) bool {
+	if updateNotificationGroup == nil || other == nil {
+		return updateNotificationGroup == other
+	}
+	if updateNotificationGroup.NotificationGroupId != other.NotificationGroupId {
+		return false
+	}
+	if !equalNotificationGroupType(updateNotificationGroup.Type, other.Type) {
+		return false
+	}
+	if updateNotificationGroup.ChatId != other.ChatId {
+		return false
+	}
+	if updateNotificationGroup.NotificationSettingsChatId != other.NotificationSettingsChatId {
+		return false
+	}
+	if updateNotificationGroup.IsSilent != other.IsSilent {
+		return false
+	}
+	if updateNotificationGroup.TotalCount != other.TotalCount {
+		return false
+	}
+	if len(updateNotificationGroup.AddedNotifications) != len(other.AddedNotifications) {
+		return false
+	}
+	for i0 := range updateNotificationGroup.AddedNotifications {
+		if !updateNotificationGroup.AddedNotifications[i0].Equal(&other.AddedNotifications[i0]) {
+			return false
+		}
+	}
+	if len(updateNotificationGroup.RemovedNotificationIds) != len(other.RemovedNotificationIds) {
+		return false
+	}
+	for i0 := range updateNotificationGroup.RemovedNotificationIds {
+		if updateNotificationGroup.RemovedNotificationIds[i0] != other.RemovedNotificationIds[i0] {
+			return false
+		}
+	}
+	return true
+}
 
-	return nil
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateNotificationGroup *UpdateNotificationGroup) Diff(other *UpdateNotificationGroup) []Change {
+	return updateNotificationGroup.diff("", other, nil)
 }
 
-// GetUpdateEnum return the enum type of this object
-func (updateNotificationGroup *UpdateNotificationGroup) GetUpdateEnum() UpdateEnum {
-	return UpdateNotificationGroupType
+func (updateNotificationGroup *UpdateNotificationGroup) diff(path string, other *UpdateNotificationGroup, changes []Change) []Change {
+	if updateNotificationGroup == nil || other == nil {
+		if updateNotificationGroup != other {
+			changes = append(changes, Change{Path: path, Old: updateNotificationGroup, New: other})
+		}
+		return changes
+	}
+	if updateNotificationGroup.NotificationGroupId != other.NotificationGroupId {
+		changes = append(changes, Change{Path: fieldPath(path, "notification_group_id"), Old: updateNotificationGroup.NotificationGroupId, New: other.NotificationGroupId})
+	}
+	changes = diffNotificationGroupType(fieldPath(path, "type"), updateNotificationGroup.Type, other.Type, changes)
+	if updateNotificationGroup.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateNotificationGroup.ChatId, New: other.ChatId})
+	}
+	if updateNotificationGroup.NotificationSettingsChatId != other.NotificationSettingsChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "notification_settings_chat_id"), Old: updateNotificationGroup.NotificationSettingsChatId, New: other.NotificationSettingsChatId})
+	}
+	if updateNotificationGroup.IsSilent != other.IsSilent {
+		changes = append(changes, Change{Path: fieldPath(path, "is_silent"), Old: updateNotificationGroup.IsSilent, New: other.IsSilent})
+	}
+	if updateNotificationGroup.TotalCount != other.TotalCount {
+		changes = append(changes, Change{Path: fieldPath(path, "total_count"), Old: updateNotificationGroup.TotalCount, New: other.TotalCount})
+	}
+	if len(updateNotificationGroup.AddedNotifications) != len(other.AddedNotifications) {
+		changes = append(changes, Change{Path: fieldPath(path, "added_notifications"), Old: updateNotificationGroup.AddedNotifications, New: other.AddedNotifications})
+	} else {
+		for i0 := range updateNotificationGroup.AddedNotifications {
+			changes = updateNotificationGroup.AddedNotifications[i0].diff(indexPath(fieldPath(path, "added_notifications"), i0), &other.AddedNotifications[i0], changes)
+		}
+	}
+	if len(updateNotificationGroup.RemovedNotificationIds) != len(other.RemovedNotificationIds) {
+		changes = append(changes, Change{Path: fieldPath(path, "removed_notification_ids"), Old: updateNotificationGroup.RemovedNotificationIds, New: other.RemovedNotificationIds})
+	} else {
+		for i0 := range updateNotificationGroup.RemovedNotificationIds {
+			if updateNotificationGroup.RemovedNotificationIds[i0] != other.RemovedNotificationIds[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "removed_notification_ids"), i0), Old: updateNotificationGroup.RemovedNotificationIds[i0], New: other.RemovedNotificationIds[i0]})
+			}
+		}
+	}
+	return changes
 }
 
-// UpdateActiveNotifications Contains active notifications that was shown on previous application launches. This update is sent only if the message database is used. In that case it comes once before any updateNotification and updateNotificationGroup update
-type UpdateActiveNotifications struct {
-	tdCommon
-	Groups []NotificationGroup `json:"groups"` // Lists of active notification groups
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateNotificationGroup *UpdateNotificationGroup) String() string {
+	return objectString(updateNotificationGroup)
+}
+
+// LogValue logs the object as its summary
+func (updateNotificationGroup *UpdateNotificationGroup) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateNotificationGroup))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateNotificationGroup *UpdateNotificationGroup) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateNotificationGroup)
+}
+
+func (updateNotificationGroup *UpdateNotificationGroup) printText(p *printer) {
+	if updateNotificationGroup == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateNotificationGroup")
+	p.int("notification_group_id", int64(updateNotificationGroup.NotificationGroupId))
+	p.object("type", updateNotificationGroup.Type)
+	p.int("chat_id", int64(updateNotificationGroup.ChatId))
+	p.int("notification_settings_chat_id", int64(updateNotificationGroup.NotificationSettingsChatId))
+	p.bool("is_silent", updateNotificationGroup.IsSilent)
+	p.int("total_count", int64(updateNotificationGroup.TotalCount))
+	p.vector("added_notifications", len(updateNotificationGroup.AddedNotifications), func(i0 int) {
+		updateNotificationGroup.AddedNotifications[i0].printText(p)
+	})
+	p.vector("removed_notification_ids", len(updateNotificationGroup.RemovedNotificationIds), func(i0 int) {
+		p.int("removed_notification_ids", int64(updateNotificationGroup.RemovedNotificationIds[i0]))
+	})
+	p.endObject(state)
+}
+
+// GetUpdateEnum return the enum type of this object
+func (updateNotificationGroup *UpdateNotificationGroup) GetUpdateEnum() UpdateEnum {
+	return UpdateNotificationGroupType
+}
+
+// Accept calls the method of the visitor for UpdateNotificationGroup
+func (updateNotificationGroup *UpdateNotificationGroup) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateNotificationGroup(updateNotificationGroup)
+}
+
+// UpdateActiveNotifications Contains active notifications that was shown on previous application launches. This update is sent only if the message database is used. In that case it comes once before any updateNotification and updateNotificationGroup update
+type UpdateActiveNotifications struct {
+	tdCommon
+	Groups []NotificationGroup `json:"groups"` // Lists of active notification groups
 }
 
 // MessageType return the string telegram-type of UpdateActiveNotifications
@@ -2083,11 +7170,160 @@ func NewUpdateActiveNotifications(groups []NotificationGroup) *UpdateActiveNotif
 	return &updateActiveNotificationsTemp
 }
 
+// MarshalJSON marshals to json
+func (updateActiveNotifications *UpdateActiveNotifications) MarshalJSON() ([]byte, error) {
+	return updateActiveNotifications.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateActiveNotifications *UpdateActiveNotifications) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateActiveNotifications)
+}
+
+func (updateActiveNotifications *UpdateActiveNotifications) appendJSON(b []byte) ([]byte, error) {
+	if updateActiveNotifications == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateActiveNotifications.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateActiveNotifications.tdCommon.Extra)
+	b = append(b, `,"groups":`...)
+	if updateActiveNotifications.Groups == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range updateActiveNotifications.Groups {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = updateActiveNotifications.Groups[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (updateActiveNotifications *UpdateActiveNotifications) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateActiveNotifications.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateActiveNotifications.tdCommon.Extra, err = r.readString()
+		case "groups":
+			if r.readNull() {
+				updateActiveNotifications.Groups = nil
+			} else {
+				updateActiveNotifications.Groups = make([]NotificationGroup, 0)
+				err = r.readArray(func() (err error) {
+					var item0 NotificationGroup
+					err = item0.decodeJSON(r)
+					updateActiveNotifications.Groups = append(updateActiveNotifications.Groups, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateActiveNotifications *UpdateActiveNotifications) Clone() *UpdateActiveNotifications {
+	if updateActiveNotifications == nil {
+		return nil
+	}
+	clone := *updateActiveNotifications
+	if updateActiveNotifications.Groups != nil {
+		clone.Groups = make([]NotificationGroup, len(updateActiveNotifications.Groups))
+		for i0 := range updateActiveNotifications.Groups {
+			clone.Groups[i0] = *updateActiveNotifications.Groups[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateActiveNotifications *UpdateActiveNotifications) Equal(other *UpdateActiveNotifications) bool {
+	if updateActiveNotifications == nil || other == nil {
+		return updateActiveNotifications == other
+	}
+	if len(updateActiveNotifications.Groups) != len(other.Groups) {
+		return false
+	}
+	for i0 := range updateActiveNotifications.Groups {
+		if !updateActiveNotifications.Groups[i0].Equal(&other.Groups[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateActiveNotifications *UpdateActiveNotifications) Diff(other *UpdateActiveNotifications) []Change {
+	return updateActiveNotifications.diff("", other, nil)
+}
+
+func (updateActiveNotifications *UpdateActiveNotifications) diff(path string, other *UpdateActiveNotifications, changes []Change) []Change {
+	if updateActiveNotifications == nil || other == nil {
+		if updateActiveNotifications != other {
+			changes = append(changes, Change{Path: path, Old: updateActiveNotifications, New: other})
+		}
+		return changes
+	}
+	if len(updateActiveNotifications.Groups) != len(other.Groups) {
+		changes = append(changes, Change{Path: fieldPath(path, "groups"), Old: updateActiveNotifications.Groups, New: other.Groups})
+	} else {
+		for i0 := range updateActiveNotifications.Groups {
+			changes = updateActiveNotifications.Groups[i0].diff(indexPath(fieldPath(path, "groups"), i0), &other.Groups[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateActiveNotifications *UpdateActiveNotifications) String() string {
+	return objectString(updateActiveNotifications)
+}
+
+// LogValue logs the object as its summary
+func (updateActiveNotifications *UpdateActiveNotifications) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateActiveNotifications))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateActiveNotifications *UpdateActiveNotifications) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateActiveNotifications)
+}
+
+func (updateActiveNotifications *UpdateActiveNotifications) printText(p *printer) {
+	if updateActiveNotifications == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateActiveNotifications")
+	p.vector("groups", len(updateActiveNotifications.Groups), func(i0 int) {
+		updateActiveNotifications.Groups[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateActiveNotifications *UpdateActiveNotifications) GetUpdateEnum() UpdateEnum {
 	return UpdateActiveNotificationsType
 }
 
+// Accept calls the method of the visitor for UpdateActiveNotifications
+func (updateActiveNotifications *UpdateActiveNotifications) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateActiveNotifications(updateActiveNotifications)
+}
+
 // UpdateHavePendingNotifications Describes whether there are some pending notification updates. Can be used to prevent application from killing, while there are some pending notifications
 type UpdateHavePendingNotifications struct {
 	tdCommon
@@ -2114,11 +7350,129 @@ func NewUpdateHavePendingNotifications(haveDelayedNotifications bool, haveUnrece
 	return &updateHavePendingNotificationsTemp
 }
 
+// MarshalJSON marshals to json
+func (updateHavePendingNotifications *UpdateHavePendingNotifications) MarshalJSON() ([]byte, error) {
+	return updateHavePendingNotifications.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateHavePendingNotifications *UpdateHavePendingNotifications) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateHavePendingNotifications)
+}
+
+func (updateHavePendingNotifications *UpdateHavePendingNotifications) appendJSON(b []byte) ([]byte, error) {
+	if updateHavePendingNotifications == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateHavePendingNotifications.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateHavePendingNotifications.tdCommon.Extra)
+	b = append(b, `,"have_delayed_notifications":`...)
+	b = appendJSONBool(b, updateHavePendingNotifications.HaveDelayedNotifications)
+	b = append(b, `,"have_unreceived_notifications":`...)
+	b = appendJSONBool(b, updateHavePendingNotifications.HaveUnreceivedNotifications)
+	return append(b, '}'), nil
+}
+
+func (updateHavePendingNotifications *UpdateHavePendingNotifications) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateHavePendingNotifications.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateHavePendingNotifications.tdCommon.Extra, err = r.readString()
+		case "have_delayed_notifications":
+			updateHavePendingNotifications.HaveDelayedNotifications, err = r.readBool()
+		case "have_unreceived_notifications":
+			updateHavePendingNotifications.HaveUnreceivedNotifications, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateHavePendingNotifications *UpdateHavePendingNotifications) Clone() *UpdateHavePendingNotifications {
+	if updateHavePendingNotifications == nil {
+		return nil
+	}
+	clone := *updateHavePendingNotifications
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateHavePendingNotifications *UpdateHavePendingNotifications) Equal(other *UpdateHavePendingNotifications) bool {
+	if updateHavePendingNotifications == nil || other == nil {
+		return updateHavePendingNotifications == other
+	}
+	if updateHavePendingNotifications.HaveDelayedNotifications != other.HaveDelayedNotifications {
+		return false
+	}
+	if updateHavePendingNotifications.HaveUnreceivedNotifications != other.HaveUnreceivedNotifications {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateHavePendingNotifications *UpdateHavePendingNotifications) Diff(other *UpdateHavePendingNotifications) []Change {
+	return updateHavePendingNotifications.diff("", other, nil)
+}
+
+func (updateHavePendingNotifications *UpdateHavePendingNotifications) diff(path string, other *UpdateHavePendingNotifications, changes []Change) []Change {
+	if updateHavePendingNotifications == nil || other == nil {
+		if updateHavePendingNotifications != other {
+			changes = append(changes, Change{Path: path, Old: updateHavePendingNotifications, New: other})
+		}
+		return changes
+	}
+	if updateHavePendingNotifications.HaveDelayedNotifications != other.HaveDelayedNotifications {
+		changes = append(changes, Change{Path: fieldPath(path, "have_delayed_notifications"), Old: updateHavePendingNotifications.HaveDelayedNotifications, New: other.HaveDelayedNotifications})
+	}
+	if updateHavePendingNotifications.HaveUnreceivedNotifications != other.HaveUnreceivedNotifications {
+		changes = append(changes, Change{Path: fieldPath(path, "have_unreceived_notifications"), Old: updateHavePendingNotifications.HaveUnreceivedNotifications, New: other.HaveUnreceivedNotifications})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateHavePendingNotifications *UpdateHavePendingNotifications) String() string {
+	return objectString(updateHavePendingNotifications)
+}
+
+// LogValue logs the object as its summary
+func (updateHavePendingNotifications *UpdateHavePendingNotifications) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateHavePendingNotifications))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateHavePendingNotifications *UpdateHavePendingNotifications) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateHavePendingNotifications)
+}
+
+func (updateHavePendingNotifications *UpdateHavePendingNotifications) printText(p *printer) {
+	if updateHavePendingNotifications == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateHavePendingNotifications")
+	p.bool("have_delayed_notifications", updateHavePendingNotifications.HaveDelayedNotifications)
+	p.bool("have_unreceived_notifications", updateHavePendingNotifications.HaveUnreceivedNotifications)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateHavePendingNotifications *UpdateHavePendingNotifications) GetUpdateEnum() UpdateEnum {
 	return UpdateHavePendingNotificationsType
 }
 
+// Accept calls the method of the visitor for UpdateHavePendingNotifications
+func (updateHavePendingNotifications *UpdateHavePendingNotifications) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateHavePendingNotifications(updateHavePendingNotifications)
+}
+
 // UpdateDeleteMessages Some messages were deleted
 type UpdateDeleteMessages struct {
 	tdCommon
@@ -2151,11 +7505,189 @@ func NewUpdateDeleteMessages(chatId int64, messageIds []int64, isPermanent bool,
 	return &updateDeleteMessagesTemp
 }
 
+// MarshalJSON marshals to json
+func (updateDeleteMessages *UpdateDeleteMessages) MarshalJSON() ([]byte, error) {
+	return updateDeleteMessages.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateDeleteMessages *UpdateDeleteMessages) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateDeleteMessages)
+}
+
+func (updateDeleteMessages *UpdateDeleteMessages) appendJSON(b []byte) ([]byte, error) {
+	if updateDeleteMessages == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateDeleteMessages.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateDeleteMessages.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateDeleteMessages.ChatId))
+	b = append(b, `,"message_ids":`...)
+	if updateDeleteMessages.MessageIds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range updateDeleteMessages.MessageIds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(updateDeleteMessages.MessageIds[i0]))
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"is_permanent":`...)
+	b = appendJSONBool(b, updateDeleteMessages.IsPermanent)
+	b = append(b, `,"from_cache":`...)
+	b = appendJSONBool(b, updateDeleteMessages.FromCache)
+	return append(b, '}'), nil
+}
+
+func (updateDeleteMessages *UpdateDeleteMessages) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateDeleteMessages.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateDeleteMessages.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateDeleteMessages.ChatId, err = r.readInt64()
+		case "message_ids":
+			if r.readNull() {
+				updateDeleteMessages.MessageIds = nil
+			} else {
+				updateDeleteMessages.MessageIds = make([]int64, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int64
+					item0, err = r.readInt64()
+					updateDeleteMessages.MessageIds = append(updateDeleteMessages.MessageIds, item0)
+					return
+				})
+			}
+		case "is_permanent":
+			updateDeleteMessages.IsPermanent, err = r.readBool()
+		case "from_cache":
+			updateDeleteMessages.FromCache, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateDeleteMessages *UpdateDeleteMessages) Clone() *UpdateDeleteMessages {
+	if updateDeleteMessages == nil {
+		return nil
+	}
+	clone := *updateDeleteMessages
+	if updateDeleteMessages.MessageIds != nil {
+		clone.MessageIds = make([]int64, len(updateDeleteMessages.MessageIds))
+		copy(clone.MessageIds, updateDeleteMessages.MessageIds)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateDeleteMessages *UpdateDeleteMessages) Equal(other *UpdateDeleteMessages) bool {
+	if updateDeleteMessages == nil || other == nil {
+		return updateDeleteMessages == other
+	}
+	if updateDeleteMessages.ChatId != other.ChatId {
+		return false
+	}
+	if len(updateDeleteMessages.MessageIds) != len(other.MessageIds) {
+		return false
+	}
+	for i0 := range updateDeleteMessages.MessageIds {
+		if updateDeleteMessages.MessageIds[i0] != other.MessageIds[i0] {
+			return false
+		}
+	}
+	if updateDeleteMessages.IsPermanent != other.IsPermanent {
+		return false
+	}
+	if updateDeleteMessages.FromCache != other.FromCache {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateDeleteMessages *UpdateDeleteMessages) Diff(other *UpdateDeleteMessages) []Change {
+	return updateDeleteMessages.diff("", other, nil)
+}
+
+func (updateDeleteMessages *UpdateDeleteMessages) diff(path string, other *UpdateDeleteMessages, changes []Change) []Change {
+	if updateDeleteMessages == nil || other == nil {
+		if updateDeleteMessages != other {
+			changes = append(changes, Change{Path: path, Old: updateDeleteMessages, New: other})
+		}
+		return changes
+	}
+	if updateDeleteMessages.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateDeleteMessages.ChatId, New: other.ChatId})
+	}
+	if len(updateDeleteMessages.MessageIds) != len(other.MessageIds) {
+		changes = append(changes, Change{Path: fieldPath(path, "message_ids"), Old: updateDeleteMessages.MessageIds, New: other.MessageIds})
+	} else {
+		for i0 := range updateDeleteMessages.MessageIds {
+			if updateDeleteMessages.MessageIds[i0] != other.MessageIds[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "message_ids"), i0), Old: updateDeleteMessages.MessageIds[i0], New: other.MessageIds[i0]})
+			}
+		}
+	}
+	if updateDeleteMessages.IsPermanent != other.IsPermanent {
+		changes = append(changes, Change{Path: fieldPath(path, "is_permanent"), Old: updateDeleteMessages.IsPermanent, New: other.IsPermanent})
+	}
+	if updateDeleteMessages.FromCache != other.FromCache {
+		changes = append(changes, Change{Path: fieldPath(path, "from_cache"), Old: updateDeleteMessages.FromCache, New: other.FromCache})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateDeleteMessages *UpdateDeleteMessages) String() string {
+	return objectString(updateDeleteMessages)
+}
+
+// LogValue logs the object as its summary
+func (updateDeleteMessages *UpdateDeleteMessages) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateDeleteMessages))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateDeleteMessages *UpdateDeleteMessages) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateDeleteMessages)
+}
+
+func (updateDeleteMessages *UpdateDeleteMessages) printText(p *printer) {
+	if updateDeleteMessages == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateDeleteMessages")
+	p.int("chat_id", int64(updateDeleteMessages.ChatId))
+	p.vector("message_ids", len(updateDeleteMessages.MessageIds), func(i0 int) {
+		p.int("message_ids", int64(updateDeleteMessages.MessageIds[i0]))
+	})
+	p.bool("is_permanent", updateDeleteMessages.IsPermanent)
+	p.bool("from_cache", updateDeleteMessages.FromCache)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateDeleteMessages *UpdateDeleteMessages) GetUpdateEnum() UpdateEnum {
 	return UpdateDeleteMessagesType
 }
 
+// Accept calls the method of the visitor for UpdateDeleteMessages
+func (updateDeleteMessages *UpdateDeleteMessages) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateDeleteMessages(updateDeleteMessages)
+}
+
 // UpdateChatAction A message sender activity in the chat has changed
 type UpdateChatAction struct {
 	tdCommon
@@ -2188,35 +7720,144 @@ func NewUpdateChatAction(chatId int64, messageThreadId int64, senderId MessageSe
 	return &updateChatActionTemp
 }
 
+// MarshalJSON marshals to json
+func (updateChatAction *UpdateChatAction) MarshalJSON() ([]byte, error) {
+	return updateChatAction.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (updateChatAction *UpdateChatAction) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, updateChatAction)
+}
+
+func (updateChatAction *UpdateChatAction) appendJSON(b []byte) ([]byte, error) {
+	if updateChatAction == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateChatAction.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateChatAction.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateChatAction.ChatId))
+	b = append(b, `,"message_thread_id":`...)
+	b = appendJSONInt(b, int64(updateChatAction.MessageThreadId))
+	b = append(b, `,"sender_id":`...)
+	b, err = appendJSONValue(b, updateChatAction.SenderId)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-		ChatId          int64 `json:"chat_id"`           // Chat identifier
-		MessageThreadId int64 `json:"message_thread_id"` // If not 0, a message thread identifier in which the action was performed
-
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	b = append(b, `,"action":`...)
+	b, err = appendJSONValue(b, updateChatAction.Action)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateChatAction *UpdateChatAction) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateChatAction.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateChatAction.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateChatAction.ChatId, err = r.readInt64()
+		case "message_thread_id":
+			updateChatAction.MessageThreadId, err = r.readInt64()
+		case "sender_id":
+			updateChatAction.SenderId, err = decodeMessageSender(r)
+		case "action":
+			updateChatAction.Action, err = decodeChatAction(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateChatAction *UpdateChatAction) Clone() *UpdateChatAction {
+	if updateChatAction == nil {
+		return nil
+	}
+	clone := *updateChatAction
+	clone.SenderId = cloneMessageSender(updateChatAction.SenderId)
+	clone.Action = cloneChatAction(updateChatAction.Action)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateChatAction *UpdateChatAction) Equal(other *UpdateChatAction) bool {
+	if updateChatAction == nil || other == nil {
+		return updateChatAction == other
+	}
+	if updateChatAction.ChatId != other.ChatId {
+		return false
+	}
+	if updateChatAction.MessageThreadId != other.MessageThreadId {
+		return false
+	}
+	if !equalMessageSender(updateChatAction.SenderId, other.SenderId) {
+		return false
+	}
+	if !equalChatAction(updateChatAction.Action, other.Action) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateChatAction *UpdateChatAction) Diff(other *UpdateChatAction) []Change {
+	return updateChatAction.diff("", other, nil)
+}
+
+func (updateChatAction *UpdateChatAction) diff(path string, other *UpdateChatAction, changes []Change) []Change {
+	if updateChatAction == nil || other == nil {
+		if updateChatAction != other {
+			changes = append(changes, Change{Path: path, Old: updateChatAction, New: other})
+		}
+		return changes
+	}
+	if updateChatAction.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateChatAction.ChatId, New: other.ChatId})
 	}
+	if updateChatAction.MessageThreadId != other.MessageThreadId {
+		changes = append(changes, Change{Path: fieldPath(path, "message_thread_id"), Old: updateChatAction.MessageThreadId, New: other.MessageThreadId})
+	}
+	changes = diffMessageSender(fieldPath(path, "sender_id"), updateChatAction.SenderId, other.SenderId, changes)
+	changes = diffChatAction(fieldPath(path, "action"), updateChatAction.Action, other.Action, changes)
+	return changes
+}
 
-	updateChatAction.tdCommon = tempObj.tdCommon
-	updateChatAction.ChatId = tempObj.ChatId
-	updateChatAction.MessageThreadId = tempObj.MessageThreadId
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateChatAction *UpdateChatAction) String() string {
+	return objectString(updateChatAction)
+}
 
-	fieldSenderId, _ := unmarshalMessageSender(objMap["sender_id"])
-	updateChatAction.SenderId = fieldSenderId
+// LogValue logs the object as its summary
+func (updateChatAction *UpdateChatAction) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateChatAction))
+}
 
-	fieldAction, _ := unmarshalChatAction(objMap["action"])
-	updateChatAction.Action = fieldAction
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateChatAction *UpdateChatAction) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateChatAction)
+}
 
-	return nil
+func (updateChatAction *UpdateChatAction) printText(p *printer) {
+	if updateChatAction == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateChatAction")
+	p.int("chat_id", int64(updateChatAction.ChatId))
+	p.int("message_thread_id", int64(updateChatAction.MessageThreadId))
+	p.object("sender_id", updateChatAction.SenderId)
+	p.object("action", updateChatAction.Action)
+	p.endObject(state)
 }
 
 // GetUpdateEnum return the enum type of this object
@@ -2224,6 +7865,11 @@ func (updateChatAction *UpdateChatAction) GetUpdateEnum() UpdateEnum {
 	return UpdateChatActionType
 }
 
+// Accept calls the method of the visitor for UpdateChatAction
+func (updateChatAction *UpdateChatAction) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateChatAction(updateChatAction)
+}
+
 // UpdateUserStatus The user went online or offline
 type UpdateUserStatus struct {
 	tdCommon
@@ -2250,30 +7896,120 @@ func NewUpdateUserStatus(userId int64, status UserStatus) *UpdateUserStatus {
 	return &updateUserStatusTemp
 }
 
+// MarshalJSON marshals to json
+func (updateUserStatus *UpdateUserStatus) MarshalJSON() ([]byte, error) {
+	return updateUserStatus.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (updateUserStatus *UpdateUserStatus) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, updateUserStatus)
+}
+
+func (updateUserStatus *UpdateUserStatus) appendJSON(b []byte) ([]byte, error) {
+	if updateUserStatus == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateUserStatus.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateUserStatus.tdCommon.Extra)
+	b = append(b, `,"user_id":`...)
+	b = appendJSONInt(b, int64(updateUserStatus.UserId))
+	b = append(b, `,"status":`...)
+	b, err = appendJSONValue(b, updateUserStatus.Status)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-		UserId int64 `json:"user_id"` // User identifier
+	return append(b, '}'), nil
+}
+
+func (updateUserStatus *UpdateUserStatus) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateUserStatus.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateUserStatus.tdCommon.Extra, err = r.readString()
+		case "user_id":
+			updateUserStatus.UserId, err = r.readInt64()
+		case "status":
+			updateUserStatus.Status, err = decodeUserStatus(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	}{}
-	err = json.Unmarshal(b, &tempObj)
-	if err != nil {
-		return err
+// Clone returns a deep copy of the object
+func (updateUserStatus *UpdateUserStatus) Clone() *UpdateUserStatus {
+	if updateUserStatus == nil {
+		return nil
+	}
+	clone := *updateUserStatus
+	clone.Status = cloneUserStatus(updateUserStatus.Status)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateUserStatus *UpdateUserStatus) Equal(other *UpdateUserStatus) bool {
+	if updateUserStatus == nil || other == nil {
+		return updateUserStatus == other
+	}
+	if updateUserStatus.UserId != other.UserId {
+		return false
+	}
+	if !equalUserStatus(updateUserStatus.Status, other.Status) {
+		return false
 	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateUserStatus *UpdateUserStatus) Diff(other *UpdateUserStatus) []Change {
+	return updateUserStatus.diff("", other, nil)
+}
+
+func (updateUserStatus *UpdateUserStatus) diff(path string, other *UpdateUserStatus, changes []Change) []Change {
+	if updateUserStatus == nil || other == nil {
+		if updateUserStatus != other {
+			changes = append(changes, Change{Path: path, Old: updateUserStatus, New: other})
+		}
+		return changes
+	}
+	if updateUserStatus.UserId != other.UserId {
+		changes = append(changes, Change{Path: fieldPath(path, "user_id"), Old: updateUserStatus.UserId, New: other.UserId})
+	}
+	changes = diffUserStatus(fieldPath(path, "status"), updateUserStatus.Status, other.Status, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateUserStatus *UpdateUserStatus) String() string {
+	return objectString(updateUserStatus)
+}
 
-	updateUserStatus.tdCommon = tempObj.tdCommon
-	updateUserStatus.UserId = tempObj.UserId
+// LogValue logs the object as its summary
+func (updateUserStatus *UpdateUserStatus) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateUserStatus))
+}
 
-	fieldStatus, _ := unmarshalUserStatus(objMap["status"])
-	updateUserStatus.Status = fieldStatus
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateUserStatus *UpdateUserStatus) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateUserStatus)
+}
 
-	return nil
+func (updateUserStatus *UpdateUserStatus) printText(p *printer) {
+	if updateUserStatus == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateUserStatus")
+	p.int("user_id", int64(updateUserStatus.UserId))
+	p.object("status", updateUserStatus.Status)
+	p.endObject(state)
 }
 
 // GetUpdateEnum return the enum type of this object
@@ -2281,6 +8017,11 @@ func (updateUserStatus *UpdateUserStatus) GetUpdateEnum() UpdateEnum {
 	return UpdateUserStatusType
 }
 
+// Accept calls the method of the visitor for UpdateUserStatus
+func (updateUserStatus *UpdateUserStatus) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateUserStatus(updateUserStatus)
+}
+
 // UpdateUser Some data of a user has changed. This update is guaranteed to come before the user identifier is returned to the application
 type UpdateUser struct {
 	tdCommon
@@ -2304,11 +8045,126 @@ func NewUpdateUser(user *User) *UpdateUser {
 	return &updateUserTemp
 }
 
+// MarshalJSON marshals to json
+func (updateUser *UpdateUser) MarshalJSON() ([]byte, error) {
+	return updateUser.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateUser *UpdateUser) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateUser)
+}
+
+func (updateUser *UpdateUser) appendJSON(b []byte) ([]byte, error) {
+	if updateUser == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateUser.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateUser.tdCommon.Extra)
+	b = append(b, `,"user":`...)
+	b, err = updateUser.User.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateUser *UpdateUser) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateUser.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateUser.tdCommon.Extra, err = r.readString()
+		case "user":
+			if r.readNull() {
+				updateUser.User = nil
+			} else {
+				updateUser.User = new(User)
+				err = updateUser.User.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateUser *UpdateUser) Clone() *UpdateUser {
+	if updateUser == nil {
+		return nil
+	}
+	clone := *updateUser
+	clone.User = updateUser.User.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateUser *UpdateUser) Equal(other *UpdateUser) bool {
+	if updateUser == nil || other == nil {
+		return updateUser == other
+	}
+	if !updateUser.User.Equal(other.User) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateUser *UpdateUser) Diff(other *UpdateUser) []Change {
+	return updateUser.diff("", other, nil)
+}
+
+func (updateUser *UpdateUser) diff(path string, other *UpdateUser, changes []Change) []Change {
+	if updateUser == nil || other == nil {
+		if updateUser != other {
+			changes = append(changes, Change{Path: path, Old: updateUser, New: other})
+		}
+		return changes
+	}
+	changes = updateUser.User.diff(fieldPath(path, "user"), other.User, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateUser *UpdateUser) String() string {
+	return objectString(updateUser)
+}
+
+// LogValue logs the object as its summary
+func (updateUser *UpdateUser) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateUser))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateUser *UpdateUser) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateUser)
+}
+
+func (updateUser *UpdateUser) printText(p *printer) {
+	if updateUser == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateUser")
+	p.object("user", updateUser.User)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateUser *UpdateUser) GetUpdateEnum() UpdateEnum {
 	return UpdateUserType
 }
 
+// Accept calls the method of the visitor for UpdateUser
+func (updateUser *UpdateUser) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateUser(updateUser)
+}
+
 // UpdateBasicGroup Some data of a basic group has changed. This update is guaranteed to come before the basic group identifier is returned to the application
 type UpdateBasicGroup struct {
 	tdCommon
@@ -2332,39 +8188,269 @@ func NewUpdateBasicGroup(basicGroup *BasicGroup) *UpdateBasicGroup {
 	return &updateBasicGroupTemp
 }
 
-// GetUpdateEnum return the enum type of this object
-func (updateBasicGroup *UpdateBasicGroup) GetUpdateEnum() UpdateEnum {
-	return UpdateBasicGroupType
+// MarshalJSON marshals to json
+func (updateBasicGroup *UpdateBasicGroup) MarshalJSON() ([]byte, error) {
+	return updateBasicGroup.appendJSON(nil)
 }
 
-// UpdateSupergroup Some data of a supergroup or a channel has changed. This update is guaranteed to come before the supergroup identifier is returned to the application
-type UpdateSupergroup struct {
-	tdCommon
-	Supergroup *Supergroup `json:"supergroup"` // New data about the supergroup
+// UnmarshalJSON unmarshal to json
+func (updateBasicGroup *UpdateBasicGroup) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateBasicGroup)
 }
 
-// MessageType return the string telegram-type of UpdateSupergroup
-func (updateSupergroup *UpdateSupergroup) MessageType() string {
-	return "updateSupergroup"
+func (updateBasicGroup *UpdateBasicGroup) appendJSON(b []byte) ([]byte, error) {
+	if updateBasicGroup == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateBasicGroup.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateBasicGroup.tdCommon.Extra)
+	b = append(b, `,"basic_group":`...)
+	b, err = updateBasicGroup.BasicGroup.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateBasicGroup *UpdateBasicGroup) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateBasicGroup.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateBasicGroup.tdCommon.Extra, err = r.readString()
+		case "basic_group":
+			if r.readNull() {
+				updateBasicGroup.BasicGroup = nil
+			} else {
+				updateBasicGroup.BasicGroup = new(BasicGroup)
+				err = updateBasicGroup.BasicGroup.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
 }
 
-// NewUpdateSupergroup creates a new UpdateSupergroup
-//
-// @param supergroup New data about the supergroup
-func NewUpdateSupergroup(supergroup *Supergroup) *UpdateSupergroup {
-	updateSupergroupTemp := UpdateSupergroup{
-		tdCommon:   tdCommon{Type: "updateSupergroup"},
-		Supergroup: supergroup,
+// Clone returns a deep copy of the object
+func (updateBasicGroup *UpdateBasicGroup) Clone() *UpdateBasicGroup {
+	if updateBasicGroup == nil {
+		return nil
+	}
+	clone := *updateBasicGroup
+	clone.BasicGroup = updateBasicGroup.BasicGroup.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateBasicGroup *UpdateBasicGroup) Equal(other *UpdateBasicGroup) bool {
+	if updateBasicGroup == nil || other == nil {
+		return updateBasicGroup == other
+	}
+	if !updateBasicGroup.BasicGroup.Equal(other.BasicGroup) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateBasicGroup *UpdateBasicGroup) Diff(other *UpdateBasicGroup) []Change {
+	return updateBasicGroup.diff("", other, nil)
+}
+
+func (updateBasicGroup *UpdateBasicGroup) diff(path string, other *UpdateBasicGroup, changes []Change) []Change {
+	if updateBasicGroup == nil || other == nil {
+		if updateBasicGroup != other {
+			changes = append(changes, Change{Path: path, Old: updateBasicGroup, New: other})
+		}
+		return changes
+	}
+	changes = updateBasicGroup.BasicGroup.diff(fieldPath(path, "basic_group"), other.BasicGroup, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateBasicGroup *UpdateBasicGroup) String() string {
+	return objectString(updateBasicGroup)
+}
+
+// LogValue logs the object as its summary
+func (updateBasicGroup *UpdateBasicGroup) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateBasicGroup))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateBasicGroup *UpdateBasicGroup) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateBasicGroup)
+}
+
+func (updateBasicGroup *UpdateBasicGroup) printText(p *printer) {
+	if updateBasicGroup == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateBasicGroup")
+	p.object("basic_group", updateBasicGroup.BasicGroup)
+	p.endObject(state)
+}
+
+// GetUpdateEnum return the enum type of this object
+func (updateBasicGroup *UpdateBasicGroup) GetUpdateEnum() UpdateEnum {
+	return UpdateBasicGroupType
+}
+
+// Accept calls the method of the visitor for UpdateBasicGroup
+func (updateBasicGroup *UpdateBasicGroup) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateBasicGroup(updateBasicGroup)
+}
+
+// UpdateSupergroup Some data of a supergroup or a channel has changed. This update is guaranteed to come before the supergroup identifier is returned to the application
+type UpdateSupergroup struct {
+	tdCommon
+	Supergroup *Supergroup `json:"supergroup"` // New data about the supergroup
+}
+
+// MessageType return the string telegram-type of UpdateSupergroup
+func (updateSupergroup *UpdateSupergroup) MessageType() string {
+	return "updateSupergroup"
+}
+
+// NewUpdateSupergroup creates a new UpdateSupergroup
+//
+// @param supergroup New data about the supergroup
+func NewUpdateSupergroup(supergroup *Supergroup) *UpdateSupergroup {
+	updateSupergroupTemp := UpdateSupergroup{
+		tdCommon:   tdCommon{Type: "updateSupergroup"},
+		Supergroup: supergroup,
 	}
 
 	return &updateSupergroupTemp
 }
 
+// MarshalJSON marshals to json
+func (updateSupergroup *UpdateSupergroup) MarshalJSON() ([]byte, error) {
+	return updateSupergroup.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateSupergroup *UpdateSupergroup) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateSupergroup)
+}
+
+func (updateSupergroup *UpdateSupergroup) appendJSON(b []byte) ([]byte, error) {
+	if updateSupergroup == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateSupergroup.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateSupergroup.tdCommon.Extra)
+	b = append(b, `,"supergroup":`...)
+	b, err = updateSupergroup.Supergroup.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateSupergroup *UpdateSupergroup) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateSupergroup.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateSupergroup.tdCommon.Extra, err = r.readString()
+		case "supergroup":
+			if r.readNull() {
+				updateSupergroup.Supergroup = nil
+			} else {
+				updateSupergroup.Supergroup = new(Supergroup)
+				err = updateSupergroup.Supergroup.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateSupergroup *UpdateSupergroup) Clone() *UpdateSupergroup {
+	if updateSupergroup == nil {
+		return nil
+	}
+	clone := *updateSupergroup
+	clone.Supergroup = updateSupergroup.Supergroup.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateSupergroup *UpdateSupergroup) Equal(other *UpdateSupergroup) bool {
+	if updateSupergroup == nil || other == nil {
+		return updateSupergroup == other
+	}
+	if !updateSupergroup.Supergroup.Equal(other.Supergroup) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateSupergroup *UpdateSupergroup) Diff(other *UpdateSupergroup) []Change {
+	return updateSupergroup.diff("", other, nil)
+}
+
+func (updateSupergroup *UpdateSupergroup) diff(path string, other *UpdateSupergroup, changes []Change) []Change {
+	if updateSupergroup == nil || other == nil {
+		if updateSupergroup != other {
+			changes = append(changes, Change{Path: path, Old: updateSupergroup, New: other})
+		}
+		return changes
+	}
+	changes = updateSupergroup.Supergroup.diff(fieldPath(path, "supergroup"), other.Supergroup, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateSupergroup *UpdateSupergroup) String() string {
+	return objectString(updateSupergroup)
+}
+
+// LogValue logs the object as its summary
+func (updateSupergroup *UpdateSupergroup) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateSupergroup))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateSupergroup *UpdateSupergroup) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateSupergroup)
+}
+
+func (updateSupergroup *UpdateSupergroup) printText(p *printer) {
+	if updateSupergroup == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateSupergroup")
+	p.object("supergroup", updateSupergroup.Supergroup)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateSupergroup *UpdateSupergroup) GetUpdateEnum() UpdateEnum {
 	return UpdateSupergroupType
 }
 
+// Accept calls the method of the visitor for UpdateSupergroup
+func (updateSupergroup *UpdateSupergroup) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateSupergroup(updateSupergroup)
+}
+
 // UpdateSecretChat Some data of a secret chat has changed. This update is guaranteed to come before the secret chat identifier is returned to the application
 type UpdateSecretChat struct {
 	tdCommon
@@ -2388,11 +8474,126 @@ func NewUpdateSecretChat(secretChat *SecretChat) *UpdateSecretChat {
 	return &updateSecretChatTemp
 }
 
+// MarshalJSON marshals to json
+func (updateSecretChat *UpdateSecretChat) MarshalJSON() ([]byte, error) {
+	return updateSecretChat.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateSecretChat *UpdateSecretChat) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateSecretChat)
+}
+
+func (updateSecretChat *UpdateSecretChat) appendJSON(b []byte) ([]byte, error) {
+	if updateSecretChat == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateSecretChat.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateSecretChat.tdCommon.Extra)
+	b = append(b, `,"secret_chat":`...)
+	b, err = updateSecretChat.SecretChat.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateSecretChat *UpdateSecretChat) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateSecretChat.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateSecretChat.tdCommon.Extra, err = r.readString()
+		case "secret_chat":
+			if r.readNull() {
+				updateSecretChat.SecretChat = nil
+			} else {
+				updateSecretChat.SecretChat = new(SecretChat)
+				err = updateSecretChat.SecretChat.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateSecretChat *UpdateSecretChat) Clone() *UpdateSecretChat {
+	if updateSecretChat == nil {
+		return nil
+	}
+	clone := *updateSecretChat
+	clone.SecretChat = updateSecretChat.SecretChat.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateSecretChat *UpdateSecretChat) Equal(other *UpdateSecretChat) bool {
+	if updateSecretChat == nil || other == nil {
+		return updateSecretChat == other
+	}
+	if !updateSecretChat.SecretChat.Equal(other.SecretChat) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateSecretChat *UpdateSecretChat) Diff(other *UpdateSecretChat) []Change {
+	return updateSecretChat.diff("", other, nil)
+}
+
+func (updateSecretChat *UpdateSecretChat) diff(path string, other *UpdateSecretChat, changes []Change) []Change {
+	if updateSecretChat == nil || other == nil {
+		if updateSecretChat != other {
+			changes = append(changes, Change{Path: path, Old: updateSecretChat, New: other})
+		}
+		return changes
+	}
+	changes = updateSecretChat.SecretChat.diff(fieldPath(path, "secret_chat"), other.SecretChat, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateSecretChat *UpdateSecretChat) String() string {
+	return objectString(updateSecretChat)
+}
+
+// LogValue logs the object as its summary
+func (updateSecretChat *UpdateSecretChat) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateSecretChat))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateSecretChat *UpdateSecretChat) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateSecretChat)
+}
+
+func (updateSecretChat *UpdateSecretChat) printText(p *printer) {
+	if updateSecretChat == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateSecretChat")
+	p.object("secret_chat", updateSecretChat.SecretChat)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateSecretChat *UpdateSecretChat) GetUpdateEnum() UpdateEnum {
 	return UpdateSecretChatType
 }
 
+// Accept calls the method of the visitor for UpdateSecretChat
+func (updateSecretChat *UpdateSecretChat) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateSecretChat(updateSecretChat)
+}
+
 // UpdateUserFullInfo Some data in userFullInfo has been changed
 type UpdateUserFullInfo struct {
 	tdCommon
@@ -2419,11 +8620,137 @@ func NewUpdateUserFullInfo(userId int64, userFullInfo *UserFullInfo) *UpdateUser
 	return &updateUserFullInfoTemp
 }
 
+// MarshalJSON marshals to json
+func (updateUserFullInfo *UpdateUserFullInfo) MarshalJSON() ([]byte, error) {
+	return updateUserFullInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateUserFullInfo *UpdateUserFullInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateUserFullInfo)
+}
+
+func (updateUserFullInfo *UpdateUserFullInfo) appendJSON(b []byte) ([]byte, error) {
+	if updateUserFullInfo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateUserFullInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateUserFullInfo.tdCommon.Extra)
+	b = append(b, `,"user_id":`...)
+	b = appendJSONInt(b, int64(updateUserFullInfo.UserId))
+	b = append(b, `,"user_full_info":`...)
+	b, err = updateUserFullInfo.UserFullInfo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateUserFullInfo *UpdateUserFullInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateUserFullInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateUserFullInfo.tdCommon.Extra, err = r.readString()
+		case "user_id":
+			updateUserFullInfo.UserId, err = r.readInt64()
+		case "user_full_info":
+			if r.readNull() {
+				updateUserFullInfo.UserFullInfo = nil
+			} else {
+				updateUserFullInfo.UserFullInfo = new(UserFullInfo)
+				err = updateUserFullInfo.UserFullInfo.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateUserFullInfo *UpdateUserFullInfo) Clone() *UpdateUserFullInfo {
+	if updateUserFullInfo == nil {
+		return nil
+	}
+	clone := *updateUserFullInfo
+	clone.UserFullInfo = updateUserFullInfo.UserFullInfo.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateUserFullInfo *UpdateUserFullInfo) Equal(other *UpdateUserFullInfo) bool {
+	if updateUserFullInfo == nil || other == nil {
+		return updateUserFullInfo == other
+	}
+	if updateUserFullInfo.UserId != other.UserId {
+		return false
+	}
+	if !updateUserFullInfo.UserFullInfo.Equal(other.UserFullInfo) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateUserFullInfo *UpdateUserFullInfo) Diff(other *UpdateUserFullInfo) []Change {
+	return updateUserFullInfo.diff("", other, nil)
+}
+
+func (updateUserFullInfo *UpdateUserFullInfo) diff(path string, other *UpdateUserFullInfo, changes []Change) []Change {
+	if updateUserFullInfo == nil || other == nil {
+		if updateUserFullInfo != other {
+			changes = append(changes, Change{Path: path, Old: updateUserFullInfo, New: other})
+		}
+		return changes
+	}
+	if updateUserFullInfo.UserId != other.UserId {
+		changes = append(changes, Change{Path: fieldPath(path, "user_id"), Old: updateUserFullInfo.UserId, New: other.UserId})
+	}
+	changes = updateUserFullInfo.UserFullInfo.diff(fieldPath(path, "user_full_info"), other.UserFullInfo, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateUserFullInfo *UpdateUserFullInfo) String() string {
+	return objectString(updateUserFullInfo)
+}
+
+// LogValue logs the object as its summary
+func (updateUserFullInfo *UpdateUserFullInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateUserFullInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateUserFullInfo *UpdateUserFullInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateUserFullInfo)
+}
+
+func (updateUserFullInfo *UpdateUserFullInfo) printText(p *printer) {
+	if updateUserFullInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateUserFullInfo")
+	p.int("user_id", int64(updateUserFullInfo.UserId))
+	p.object("user_full_info", updateUserFullInfo.UserFullInfo)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateUserFullInfo *UpdateUserFullInfo) GetUpdateEnum() UpdateEnum {
 	return UpdateUserFullInfoType
 }
 
+// Accept calls the method of the visitor for UpdateUserFullInfo
+func (updateUserFullInfo *UpdateUserFullInfo) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateUserFullInfo(updateUserFullInfo)
+}
+
 // UpdateBasicGroupFullInfo Some data in basicGroupFullInfo has been changed
 type UpdateBasicGroupFullInfo struct {
 	tdCommon
@@ -2450,11 +8777,137 @@ func NewUpdateBasicGroupFullInfo(basicGroupId int64, basicGroupFullInfo *BasicGr
 	return &updateBasicGroupFullInfoTemp
 }
 
+// MarshalJSON marshals to json
+func (updateBasicGroupFullInfo *UpdateBasicGroupFullInfo) MarshalJSON() ([]byte, error) {
+	return updateBasicGroupFullInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateBasicGroupFullInfo *UpdateBasicGroupFullInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateBasicGroupFullInfo)
+}
+
+func (updateBasicGroupFullInfo *UpdateBasicGroupFullInfo) appendJSON(b []byte) ([]byte, error) {
+	if updateBasicGroupFullInfo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateBasicGroupFullInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateBasicGroupFullInfo.tdCommon.Extra)
+	b = append(b, `,"basic_group_id":`...)
+	b = appendJSONInt(b, int64(updateBasicGroupFullInfo.BasicGroupId))
+	b = append(b, `,"basic_group_full_info":`...)
+	b, err = updateBasicGroupFullInfo.BasicGroupFullInfo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateBasicGroupFullInfo *UpdateBasicGroupFullInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateBasicGroupFullInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateBasicGroupFullInfo.tdCommon.Extra, err = r.readString()
+		case "basic_group_id":
+			updateBasicGroupFullInfo.BasicGroupId, err = r.readInt64()
+		case "basic_group_full_info":
+			if r.readNull() {
+				updateBasicGroupFullInfo.BasicGroupFullInfo = nil
+			} else {
+				updateBasicGroupFullInfo.BasicGroupFullInfo = new(BasicGroupFullInfo)
+				err = updateBasicGroupFullInfo.BasicGroupFullInfo.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateBasicGroupFullInfo *UpdateBasicGroupFullInfo) Clone() *UpdateBasicGroupFullInfo {
+	if updateBasicGroupFullInfo == nil {
+		return nil
+	}
+	clone := *updateBasicGroupFullInfo
+	clone.BasicGroupFullInfo = updateBasicGroupFullInfo.BasicGroupFullInfo.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateBasicGroupFullInfo *UpdateBasicGroupFullInfo) Equal(other *UpdateBasicGroupFullInfo) bool {
+	if updateBasicGroupFullInfo == nil || other == nil {
+		return updateBasicGroupFullInfo == other
+	}
+	if updateBasicGroupFullInfo.BasicGroupId != other.BasicGroupId {
+		return false
+	}
+	if !updateBasicGroupFullInfo.BasicGroupFullInfo.Equal(other.BasicGroupFullInfo) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateBasicGroupFullInfo *UpdateBasicGroupFullInfo) Diff(other *UpdateBasicGroupFullInfo) []Change {
+	return updateBasicGroupFullInfo.diff("", other, nil)
+}
+
+func (updateBasicGroupFullInfo *UpdateBasicGroupFullInfo) diff(path string, other *UpdateBasicGroupFullInfo, changes []Change) []Change {
+	if updateBasicGroupFullInfo == nil || other == nil {
+		if updateBasicGroupFullInfo != other {
+			changes = append(changes, Change{Path: path, Old: updateBasicGroupFullInfo, New: other})
+		}
+		return changes
+	}
+	if updateBasicGroupFullInfo.BasicGroupId != other.BasicGroupId {
+		changes = append(changes, Change{Path: fieldPath(path, "basic_group_id"), Old: updateBasicGroupFullInfo.BasicGroupId, New: other.BasicGroupId})
+	}
+	changes = updateBasicGroupFullInfo.BasicGroupFullInfo.diff(fieldPath(path, "basic_group_full_info"), other.BasicGroupFullInfo, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateBasicGroupFullInfo *UpdateBasicGroupFullInfo) String() string {
+	return objectString(updateBasicGroupFullInfo)
+}
+
+// LogValue logs the object as its summary
+func (updateBasicGroupFullInfo *UpdateBasicGroupFullInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateBasicGroupFullInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateBasicGroupFullInfo *UpdateBasicGroupFullInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateBasicGroupFullInfo)
+}
+
+func (updateBasicGroupFullInfo *UpdateBasicGroupFullInfo) printText(p *printer) {
+	if updateBasicGroupFullInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateBasicGroupFullInfo")
+	p.int("basic_group_id", int64(updateBasicGroupFullInfo.BasicGroupId))
+	p.object("basic_group_full_info", updateBasicGroupFullInfo.BasicGroupFullInfo)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateBasicGroupFullInfo *UpdateBasicGroupFullInfo) GetUpdateEnum() UpdateEnum {
 	return UpdateBasicGroupFullInfoType
 }
 
+// Accept calls the method of the visitor for UpdateBasicGroupFullInfo
+func (updateBasicGroupFullInfo *UpdateBasicGroupFullInfo) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateBasicGroupFullInfo(updateBasicGroupFullInfo)
+}
+
 // UpdateSupergroupFullInfo Some data in supergroupFullInfo has been changed
 type UpdateSupergroupFullInfo struct {
 	tdCommon
@@ -2481,11 +8934,137 @@ func NewUpdateSupergroupFullInfo(supergroupId int64, supergroupFullInfo *Supergr
 	return &updateSupergroupFullInfoTemp
 }
 
+// MarshalJSON marshals to json
+func (updateSupergroupFullInfo *UpdateSupergroupFullInfo) MarshalJSON() ([]byte, error) {
+	return updateSupergroupFullInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateSupergroupFullInfo *UpdateSupergroupFullInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateSupergroupFullInfo)
+}
+
+func (updateSupergroupFullInfo *UpdateSupergroupFullInfo) appendJSON(b []byte) ([]byte, error) {
+	if updateSupergroupFullInfo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateSupergroupFullInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateSupergroupFullInfo.tdCommon.Extra)
+	b = append(b, `,"supergroup_id":`...)
+	b = appendJSONInt(b, int64(updateSupergroupFullInfo.SupergroupId))
+	b = append(b, `,"supergroup_full_info":`...)
+	b, err = updateSupergroupFullInfo.SupergroupFullInfo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateSupergroupFullInfo *UpdateSupergroupFullInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateSupergroupFullInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateSupergroupFullInfo.tdCommon.Extra, err = r.readString()
+		case "supergroup_id":
+			updateSupergroupFullInfo.SupergroupId, err = r.readInt64()
+		case "supergroup_full_info":
+			if r.readNull() {
+				updateSupergroupFullInfo.SupergroupFullInfo = nil
+			} else {
+				updateSupergroupFullInfo.SupergroupFullInfo = new(SupergroupFullInfo)
+				err = updateSupergroupFullInfo.SupergroupFullInfo.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateSupergroupFullInfo *UpdateSupergroupFullInfo) Clone() *UpdateSupergroupFullInfo {
+	if updateSupergroupFullInfo == nil {
+		return nil
+	}
+	clone := *updateSupergroupFullInfo
+	clone.SupergroupFullInfo = updateSupergroupFullInfo.SupergroupFullInfo.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateSupergroupFullInfo *UpdateSupergroupFullInfo) Equal(other *UpdateSupergroupFullInfo) bool {
+	if updateSupergroupFullInfo == nil || other == nil {
+		return updateSupergroupFullInfo == other
+	}
+	if updateSupergroupFullInfo.SupergroupId != other.SupergroupId {
+		return false
+	}
+	if !updateSupergroupFullInfo.SupergroupFullInfo.Equal(other.SupergroupFullInfo) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateSupergroupFullInfo *UpdateSupergroupFullInfo) Diff(other *UpdateSupergroupFullInfo) []Change {
+	return updateSupergroupFullInfo.diff("", other, nil)
+}
+
+func (updateSupergroupFullInfo *UpdateSupergroupFullInfo) diff(path string, other *UpdateSupergroupFullInfo, changes []Change) []Change {
+	if updateSupergroupFullInfo == nil || other == nil {
+		if updateSupergroupFullInfo != other {
+			changes = append(changes, Change{Path: path, Old: updateSupergroupFullInfo, New: other})
+		}
+		return changes
+	}
+	if updateSupergroupFullInfo.SupergroupId != other.SupergroupId {
+		changes = append(changes, Change{Path: fieldPath(path, "supergroup_id"), Old: updateSupergroupFullInfo.SupergroupId, New: other.SupergroupId})
+	}
+	changes = updateSupergroupFullInfo.SupergroupFullInfo.diff(fieldPath(path, "supergroup_full_info"), other.SupergroupFullInfo, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateSupergroupFullInfo *UpdateSupergroupFullInfo) String() string {
+	return objectString(updateSupergroupFullInfo)
+}
+
+// LogValue logs the object as its summary
+func (updateSupergroupFullInfo *UpdateSupergroupFullInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateSupergroupFullInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateSupergroupFullInfo *UpdateSupergroupFullInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateSupergroupFullInfo)
+}
+
+func (updateSupergroupFullInfo *UpdateSupergroupFullInfo) printText(p *printer) {
+	if updateSupergroupFullInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateSupergroupFullInfo")
+	p.int("supergroup_id", int64(updateSupergroupFullInfo.SupergroupId))
+	p.object("supergroup_full_info", updateSupergroupFullInfo.SupergroupFullInfo)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateSupergroupFullInfo *UpdateSupergroupFullInfo) GetUpdateEnum() UpdateEnum {
 	return UpdateSupergroupFullInfoType
 }
 
+// Accept calls the method of the visitor for UpdateSupergroupFullInfo
+func (updateSupergroupFullInfo *UpdateSupergroupFullInfo) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateSupergroupFullInfo(updateSupergroupFullInfo)
+}
+
 // UpdateServiceNotification A service notification from the server was received. Upon receiving this the application must show a popup with the content of the notification
 type UpdateServiceNotification struct {
 	tdCommon
@@ -2512,30 +9091,120 @@ func NewUpdateServiceNotification(typeParam string, content MessageContent) *Upd
 	return &updateServiceNotificationTemp
 }
 
+// MarshalJSON marshals to json
+func (updateServiceNotification *UpdateServiceNotification) MarshalJSON() ([]byte, error) {
+	return updateServiceNotification.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (updateServiceNotification *UpdateServiceNotification) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, updateServiceNotification)
+}
+
+func (updateServiceNotification *UpdateServiceNotification) appendJSON(b []byte) ([]byte, error) {
+	if updateServiceNotification == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateServiceNotification.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateServiceNotification.tdCommon.Extra)
+	b = append(b, `,"type":`...)
+	b = appendJSONString(b, updateServiceNotification.Type)
+	b = append(b, `,"content":`...)
+	b, err = appendJSONValue(b, updateServiceNotification.Content)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-		Type string `json:"type"` // Notification type. If type begins with "AUTH_KEY_DROP_", then two buttons "Cancel" and "Log out" must be shown under notification; if user presses the second, all local data must be destroyed using Destroy method
+	return append(b, '}'), nil
+}
+
+func (updateServiceNotification *UpdateServiceNotification) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateServiceNotification.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateServiceNotification.tdCommon.Extra, err = r.readString()
+		case "type":
+			updateServiceNotification.Type, err = r.readString()
+		case "content":
+			updateServiceNotification.Content, err = decodeMessageContent(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	}{}
-	err = json.Unmarshal(b, &tempObj)
-	if err != nil {
-		return err
+// Clone returns a deep copy of the object
+func (updateServiceNotification *UpdateServiceNotification) Clone() *UpdateServiceNotification {
+	if updateServiceNotification == nil {
+		return nil
+	}
+	clone := *updateServiceNotification
+	clone.Content = cloneMessageContent(updateServiceNotification.Content)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateServiceNotification *UpdateServiceNotification) Equal(other *UpdateServiceNotification) bool {
+	if updateServiceNotification == nil || other == nil {
+		return updateServiceNotification == other
+	}
+	if updateServiceNotification.Type != other.Type {
+		return false
 	}
+	if !equalMessageContent(updateServiceNotification.Content, other.Content) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateServiceNotification *UpdateServiceNotification) Diff(other *UpdateServiceNotification) []Change {
+	return updateServiceNotification.diff("", other, nil)
+}
+
+func (updateServiceNotification *UpdateServiceNotification) diff(path string, other *UpdateServiceNotification, changes []Change) []Change {
+	if updateServiceNotification == nil || other == nil {
+		if updateServiceNotification != other {
+			changes = append(changes, Change{Path: path, Old: updateServiceNotification, New: other})
+		}
+		return changes
+	}
+	if updateServiceNotification.Type != other.Type {
+		changes = append(changes, Change{Path: fieldPath(path, "type"), Old: updateServiceNotification.Type, New: other.Type})
+	}
+	changes = diffMessageContent(fieldPath(path, "content"), updateServiceNotification.Content, other.Content, changes)
+	return changes
+}
 
-	updateServiceNotification.tdCommon = tempObj.tdCommon
-	updateServiceNotification.Type = tempObj.Type
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateServiceNotification *UpdateServiceNotification) String() string {
+	return objectString(updateServiceNotification)
+}
+
+// LogValue logs the object as its summary
+func (updateServiceNotification *UpdateServiceNotification) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateServiceNotification))
+}
 
-	fieldContent, _ := unmarshalMessageContent(objMap["content"])
-	updateServiceNotification.Content = fieldContent
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateServiceNotification *UpdateServiceNotification) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateServiceNotification)
+}
 
-	return nil
+func (updateServiceNotification *UpdateServiceNotification) printText(p *printer) {
+	if updateServiceNotification == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateServiceNotification")
+	p.string("type", updateServiceNotification.Type)
+	p.object("content", updateServiceNotification.Content)
+	p.endObject(state)
 }
 
 // GetUpdateEnum return the enum type of this object
@@ -2543,6 +9212,11 @@ func (updateServiceNotification *UpdateServiceNotification) GetUpdateEnum() Upda
 	return UpdateServiceNotificationType
 }
 
+// Accept calls the method of the visitor for UpdateServiceNotification
+func (updateServiceNotification *UpdateServiceNotification) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateServiceNotification(updateServiceNotification)
+}
+
 // UpdateFile Information about a file was updated
 type UpdateFile struct {
 	tdCommon
@@ -2566,11 +9240,126 @@ func NewUpdateFile(file *File) *UpdateFile {
 	return &updateFileTemp
 }
 
-// GetUpdateEnum return the enum type of this object
+// MarshalJSON marshals to json
+func (updateFile *UpdateFile) MarshalJSON() ([]byte, error) {
+	return updateFile.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateFile *UpdateFile) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateFile)
+}
+
+func (updateFile *UpdateFile) appendJSON(b []byte) ([]byte, error) {
+	if updateFile == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateFile.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateFile.tdCommon.Extra)
+	b = append(b, `,"file":`...)
+	b, err = updateFile.File.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateFile *UpdateFile) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateFile.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateFile.tdCommon.Extra, err = r.readString()
+		case "file":
+			if r.readNull() {
+				updateFile.File = nil
+			} else {
+				updateFile.File = new(File)
+				err = updateFile.File.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateFile *UpdateFile) Clone() *UpdateFile {
+	if updateFile == nil {
+		return nil
+	}
+	clone := *updateFile
+	clone.File = updateFile.File.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateFile *UpdateFile) Equal(other *UpdateFile) bool {
+	if updateFile == nil || other == nil {
+		return updateFile == other
+	}
+	if !updateFile.File.Equal(other.File) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateFile *UpdateFile) Diff(other *UpdateFile) []Change {
+	return updateFile.diff("", other, nil)
+}
+
+func (updateFile *UpdateFile) diff(path string, other *UpdateFile, changes []Change) []Change {
+	if updateFile == nil || other == nil {
+		if updateFile != other {
+			changes = append(changes, Change{Path: path, Old: updateFile, New: other})
+		}
+		return changes
+	}
+	changes = updateFile.File.diff(fieldPath(path, "file"), other.File, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateFile *UpdateFile) String() string {
+	return objectString(updateFile)
+}
+
+// LogValue logs the object as its summary
+func (updateFile *UpdateFile) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateFile))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateFile *UpdateFile) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateFile)
+}
+
+func (updateFile *UpdateFile) printText(p *printer) {
+	if updateFile == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateFile")
+	p.object("file", updateFile.File)
+	p.endObject(state)
+}
+
+// GetUpdateEnum return the enum type of this object
 func (updateFile *UpdateFile) GetUpdateEnum() UpdateEnum {
 	return UpdateFileType
 }
 
+// Accept calls the method of the visitor for UpdateFile
+func (updateFile *UpdateFile) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateFile(updateFile)
+}
+
 // UpdateFileGenerationStart The file generation process needs to be started by the application
 type UpdateFileGenerationStart struct {
 	tdCommon
@@ -2603,11 +9392,151 @@ func NewUpdateFileGenerationStart(generationId JSONInt64, originalPath string, d
 	return &updateFileGenerationStartTemp
 }
 
+// MarshalJSON marshals to json
+func (updateFileGenerationStart *UpdateFileGenerationStart) MarshalJSON() ([]byte, error) {
+	return updateFileGenerationStart.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateFileGenerationStart *UpdateFileGenerationStart) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateFileGenerationStart)
+}
+
+func (updateFileGenerationStart *UpdateFileGenerationStart) appendJSON(b []byte) ([]byte, error) {
+	if updateFileGenerationStart == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateFileGenerationStart.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateFileGenerationStart.tdCommon.Extra)
+	b = append(b, `,"generation_id":`...)
+	b = appendJSONInt64String(b, int64(updateFileGenerationStart.GenerationId))
+	b = append(b, `,"original_path":`...)
+	b = appendJSONString(b, updateFileGenerationStart.OriginalPath)
+	b = append(b, `,"destination_path":`...)
+	b = appendJSONString(b, updateFileGenerationStart.DestinationPath)
+	b = append(b, `,"conversion":`...)
+	b = appendJSONString(b, updateFileGenerationStart.Conversion)
+	return append(b, '}'), nil
+}
+
+func (updateFileGenerationStart *UpdateFileGenerationStart) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateFileGenerationStart.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateFileGenerationStart.tdCommon.Extra, err = r.readString()
+		case "generation_id":
+			updateFileGenerationStart.GenerationId, err = r.readJSONInt64()
+		case "original_path":
+			updateFileGenerationStart.OriginalPath, err = r.readString()
+		case "destination_path":
+			updateFileGenerationStart.DestinationPath, err = r.readString()
+		case "conversion":
+			updateFileGenerationStart.Conversion, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateFileGenerationStart *UpdateFileGenerationStart) Clone() *UpdateFileGenerationStart {
+	if updateFileGenerationStart == nil {
+		return nil
+	}
+	clone := *updateFileGenerationStart
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateFileGenerationStart *UpdateFileGenerationStart) Equal(other *UpdateFileGenerationStart) bool {
+	if updateFileGenerationStart == nil || other == nil {
+		return updateFileGenerationStart == other
+	}
+	if updateFileGenerationStart.GenerationId != other.GenerationId {
+		return false
+	}
+	if updateFileGenerationStart.OriginalPath != other.OriginalPath {
+		return false
+	}
+	if updateFileGenerationStart.DestinationPath != other.DestinationPath {
+		return false
+	}
+	if updateFileGenerationStart.Conversion != other.Conversion {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateFileGenerationStart *UpdateFileGenerationStart) Diff(other *UpdateFileGenerationStart) []Change {
+	return updateFileGenerationStart.diff("", other, nil)
+}
+
+func (updateFileGenerationStart *UpdateFileGenerationStart) diff(path string, other *UpdateFileGenerationStart, changes []Change) []Change {
+	if updateFileGenerationStart == nil || other == nil {
+		if updateFileGenerationStart != other {
+			changes = append(changes, Change{Path: path, Old: updateFileGenerationStart, New: other})
+		}
+		return changes
+	}
+	if updateFileGenerationStart.GenerationId != other.GenerationId {
+		changes = append(changes, Change{Path: fieldPath(path, "generation_id"), Old: updateFileGenerationStart.GenerationId, New: other.GenerationId})
+	}
+	if updateFileGenerationStart.OriginalPath != other.OriginalPath {
+		changes = append(changes, Change{Path: fieldPath(path, "original_path"), Old: updateFileGenerationStart.OriginalPath, New: other.OriginalPath})
+	}
+	if updateFileGenerationStart.DestinationPath != other.DestinationPath {
+		changes = append(changes, Change{Path: fieldPath(path, "destination_path"), Old: updateFileGenerationStart.DestinationPath, New: other.DestinationPath})
+	}
+	if updateFileGenerationStart.Conversion != other.Conversion {
+		changes = append(changes, Change{Path: fieldPath(path, "conversion"), Old: updateFileGenerationStart.Conversion, New: other.Conversion})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateFileGenerationStart *UpdateFileGenerationStart) String() string {
+	return objectString(updateFileGenerationStart)
+}
+
+// LogValue logs the object as its summary
+func (updateFileGenerationStart *UpdateFileGenerationStart) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateFileGenerationStart))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateFileGenerationStart *UpdateFileGenerationStart) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateFileGenerationStart)
+}
+
+func (updateFileGenerationStart *UpdateFileGenerationStart) printText(p *printer) {
+	if updateFileGenerationStart == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateFileGenerationStart")
+	p.int("generation_id", int64(updateFileGenerationStart.GenerationId))
+	p.string("original_path", updateFileGenerationStart.OriginalPath)
+	p.string("destination_path", updateFileGenerationStart.DestinationPath)
+	p.string("conversion", updateFileGenerationStart.Conversion)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateFileGenerationStart *UpdateFileGenerationStart) GetUpdateEnum() UpdateEnum {
 	return UpdateFileGenerationStartType
 }
 
+// Accept calls the method of the visitor for UpdateFileGenerationStart
+func (updateFileGenerationStart *UpdateFileGenerationStart) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateFileGenerationStart(updateFileGenerationStart)
+}
+
 // UpdateFileGenerationStop File generation is no longer needed
 type UpdateFileGenerationStop struct {
 	tdCommon
@@ -2631,11 +9560,118 @@ func NewUpdateFileGenerationStop(generationId JSONInt64) *UpdateFileGenerationSt
 	return &updateFileGenerationStopTemp
 }
 
+// MarshalJSON marshals to json
+func (updateFileGenerationStop *UpdateFileGenerationStop) MarshalJSON() ([]byte, error) {
+	return updateFileGenerationStop.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateFileGenerationStop *UpdateFileGenerationStop) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateFileGenerationStop)
+}
+
+func (updateFileGenerationStop *UpdateFileGenerationStop) appendJSON(b []byte) ([]byte, error) {
+	if updateFileGenerationStop == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateFileGenerationStop.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateFileGenerationStop.tdCommon.Extra)
+	b = append(b, `,"generation_id":`...)
+	b = appendJSONInt64String(b, int64(updateFileGenerationStop.GenerationId))
+	return append(b, '}'), nil
+}
+
+func (updateFileGenerationStop *UpdateFileGenerationStop) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateFileGenerationStop.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateFileGenerationStop.tdCommon.Extra, err = r.readString()
+		case "generation_id":
+			updateFileGenerationStop.GenerationId, err = r.readJSONInt64()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateFileGenerationStop *UpdateFileGenerationStop) Clone() *UpdateFileGenerationStop {
+	if updateFileGenerationStop == nil {
+		return nil
+	}
+	clone := *updateFileGenerationStop
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateFileGenerationStop *UpdateFileGenerationStop) Equal(other *UpdateFileGenerationStop) bool {
+	if updateFileGenerationStop == nil || other == nil {
+		return updateFileGenerationStop == other
+	}
+	if updateFileGenerationStop.GenerationId != other.GenerationId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateFileGenerationStop *UpdateFileGenerationStop) Diff(other *UpdateFileGenerationStop) []Change {
+	return updateFileGenerationStop.diff("", other, nil)
+}
+
+func (updateFileGenerationStop *UpdateFileGenerationStop) diff(path string, other *UpdateFileGenerationStop, changes []Change) []Change {
+	if updateFileGenerationStop == nil || other == nil {
+		if updateFileGenerationStop != other {
+			changes = append(changes, Change{Path: path, Old: updateFileGenerationStop, New: other})
+		}
+		return changes
+	}
+	if updateFileGenerationStop.GenerationId != other.GenerationId {
+		changes = append(changes, Change{Path: fieldPath(path, "generation_id"), Old: updateFileGenerationStop.GenerationId, New: other.GenerationId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateFileGenerationStop *UpdateFileGenerationStop) String() string {
+	return objectString(updateFileGenerationStop)
+}
+
+// LogValue logs the object as its summary
+func (updateFileGenerationStop *UpdateFileGenerationStop) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateFileGenerationStop))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateFileGenerationStop *UpdateFileGenerationStop) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateFileGenerationStop)
+}
+
+func (updateFileGenerationStop *UpdateFileGenerationStop) printText(p *printer) {
+	if updateFileGenerationStop == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateFileGenerationStop")
+	p.int("generation_id", int64(updateFileGenerationStop.GenerationId))
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateFileGenerationStop *UpdateFileGenerationStop) GetUpdateEnum() UpdateEnum {
 	return UpdateFileGenerationStopType
 }
 
+// Accept calls the method of the visitor for UpdateFileGenerationStop
+func (updateFileGenerationStop *UpdateFileGenerationStop) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateFileGenerationStop(updateFileGenerationStop)
+}
+
 // UpdateCall New call was created or information about a call was updated
 type UpdateCall struct {
 	tdCommon
@@ -2659,11 +9695,126 @@ func NewUpdateCall(call *Call) *UpdateCall {
 	return &updateCallTemp
 }
 
+// MarshalJSON marshals to json
+func (updateCall *UpdateCall) MarshalJSON() ([]byte, error) {
+	return updateCall.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateCall *UpdateCall) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateCall)
+}
+
+func (updateCall *UpdateCall) appendJSON(b []byte) ([]byte, error) {
+	if updateCall == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateCall.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateCall.tdCommon.Extra)
+	b = append(b, `,"call":`...)
+	b, err = updateCall.Call.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateCall *UpdateCall) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateCall.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateCall.tdCommon.Extra, err = r.readString()
+		case "call":
+			if r.readNull() {
+				updateCall.Call = nil
+			} else {
+				updateCall.Call = new(Call)
+				err = updateCall.Call.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateCall *UpdateCall) Clone() *UpdateCall {
+	if updateCall == nil {
+		return nil
+	}
+	clone := *updateCall
+	clone.Call = updateCall.Call.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateCall *UpdateCall) Equal(other *UpdateCall) bool {
+	if updateCall == nil || other == nil {
+		return updateCall == other
+	}
+	if !updateCall.Call.Equal(other.Call) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateCall *UpdateCall) Diff(other *UpdateCall) []Change {
+	return updateCall.diff("", other, nil)
+}
+
+func (updateCall *UpdateCall) diff(path string, other *UpdateCall, changes []Change) []Change {
+	if updateCall == nil || other == nil {
+		if updateCall != other {
+			changes = append(changes, Change{Path: path, Old: updateCall, New: other})
+		}
+		return changes
+	}
+	changes = updateCall.Call.diff(fieldPath(path, "call"), other.Call, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateCall *UpdateCall) String() string {
+	return objectString(updateCall)
+}
+
+// LogValue logs the object as its summary
+func (updateCall *UpdateCall) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateCall))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateCall *UpdateCall) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateCall)
+}
+
+func (updateCall *UpdateCall) printText(p *printer) {
+	if updateCall == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateCall")
+	p.object("call", updateCall.Call)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateCall *UpdateCall) GetUpdateEnum() UpdateEnum {
 	return UpdateCallType
 }
 
+// Accept calls the method of the visitor for UpdateCall
+func (updateCall *UpdateCall) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateCall(updateCall)
+}
+
 // UpdateGroupCall Information about a group call was updated
 type UpdateGroupCall struct {
 	tdCommon
@@ -2687,11 +9838,126 @@ func NewUpdateGroupCall(groupCall *GroupCall) *UpdateGroupCall {
 	return &updateGroupCallTemp
 }
 
+// MarshalJSON marshals to json
+func (updateGroupCall *UpdateGroupCall) MarshalJSON() ([]byte, error) {
+	return updateGroupCall.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateGroupCall *UpdateGroupCall) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateGroupCall)
+}
+
+func (updateGroupCall *UpdateGroupCall) appendJSON(b []byte) ([]byte, error) {
+	if updateGroupCall == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateGroupCall.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateGroupCall.tdCommon.Extra)
+	b = append(b, `,"group_call":`...)
+	b, err = updateGroupCall.GroupCall.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateGroupCall *UpdateGroupCall) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateGroupCall.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateGroupCall.tdCommon.Extra, err = r.readString()
+		case "group_call":
+			if r.readNull() {
+				updateGroupCall.GroupCall = nil
+			} else {
+				updateGroupCall.GroupCall = new(GroupCall)
+				err = updateGroupCall.GroupCall.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateGroupCall *UpdateGroupCall) Clone() *UpdateGroupCall {
+	if updateGroupCall == nil {
+		return nil
+	}
+	clone := *updateGroupCall
+	clone.GroupCall = updateGroupCall.GroupCall.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateGroupCall *UpdateGroupCall) Equal(other *UpdateGroupCall) bool {
+	if updateGroupCall == nil || other == nil {
+		return updateGroupCall == other
+	}
+	if !updateGroupCall.GroupCall.Equal(other.GroupCall) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateGroupCall *UpdateGroupCall) Diff(other *UpdateGroupCall) []Change {
+	return updateGroupCall.diff("", other, nil)
+}
+
+func (updateGroupCall *UpdateGroupCall) diff(path string, other *UpdateGroupCall, changes []Change) []Change {
+	if updateGroupCall == nil || other == nil {
+		if updateGroupCall != other {
+			changes = append(changes, Change{Path: path, Old: updateGroupCall, New: other})
+		}
+		return changes
+	}
+	changes = updateGroupCall.GroupCall.diff(fieldPath(path, "group_call"), other.GroupCall, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateGroupCall *UpdateGroupCall) String() string {
+	return objectString(updateGroupCall)
+}
+
+// LogValue logs the object as its summary
+func (updateGroupCall *UpdateGroupCall) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateGroupCall))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateGroupCall *UpdateGroupCall) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateGroupCall)
+}
+
+func (updateGroupCall *UpdateGroupCall) printText(p *printer) {
+	if updateGroupCall == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateGroupCall")
+	p.object("group_call", updateGroupCall.GroupCall)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateGroupCall *UpdateGroupCall) GetUpdateEnum() UpdateEnum {
 	return UpdateGroupCallType
 }
 
+// Accept calls the method of the visitor for UpdateGroupCall
+func (updateGroupCall *UpdateGroupCall) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateGroupCall(updateGroupCall)
+}
+
 // UpdateGroupCallParticipant Information about a group call participant was changed. The updates are sent only after the group call is received through getGroupCall and only if the call is joined or being joined
 type UpdateGroupCallParticipant struct {
 	tdCommon
@@ -2718,11 +9984,137 @@ func NewUpdateGroupCallParticipant(groupCallId int32, participant *GroupCallPart
 	return &updateGroupCallParticipantTemp
 }
 
+// MarshalJSON marshals to json
+func (updateGroupCallParticipant *UpdateGroupCallParticipant) MarshalJSON() ([]byte, error) {
+	return updateGroupCallParticipant.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateGroupCallParticipant *UpdateGroupCallParticipant) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateGroupCallParticipant)
+}
+
+func (updateGroupCallParticipant *UpdateGroupCallParticipant) appendJSON(b []byte) ([]byte, error) {
+	if updateGroupCallParticipant == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateGroupCallParticipant.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateGroupCallParticipant.tdCommon.Extra)
+	b = append(b, `,"group_call_id":`...)
+	b = appendJSONInt(b, int64(updateGroupCallParticipant.GroupCallId))
+	b = append(b, `,"participant":`...)
+	b, err = updateGroupCallParticipant.Participant.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateGroupCallParticipant *UpdateGroupCallParticipant) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateGroupCallParticipant.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateGroupCallParticipant.tdCommon.Extra, err = r.readString()
+		case "group_call_id":
+			updateGroupCallParticipant.GroupCallId, err = r.readInt32()
+		case "participant":
+			if r.readNull() {
+				updateGroupCallParticipant.Participant = nil
+			} else {
+				updateGroupCallParticipant.Participant = new(GroupCallParticipant)
+				err = updateGroupCallParticipant.Participant.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateGroupCallParticipant *UpdateGroupCallParticipant) Clone() *UpdateGroupCallParticipant {
+	if updateGroupCallParticipant == nil {
+		return nil
+	}
+	clone := *updateGroupCallParticipant
+	clone.Participant = updateGroupCallParticipant.Participant.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateGroupCallParticipant *UpdateGroupCallParticipant) Equal(other *UpdateGroupCallParticipant) bool {
+	if updateGroupCallParticipant == nil || other == nil {
+		return updateGroupCallParticipant == other
+	}
+	if updateGroupCallParticipant.GroupCallId != other.GroupCallId {
+		return false
+	}
+	if !updateGroupCallParticipant.Participant.Equal(other.Participant) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateGroupCallParticipant *UpdateGroupCallParticipant) Diff(other *UpdateGroupCallParticipant) []Change {
+	return updateGroupCallParticipant.diff("", other, nil)
+}
+
+func (updateGroupCallParticipant *UpdateGroupCallParticipant) diff(path string, other *UpdateGroupCallParticipant, changes []Change) []Change {
+	if updateGroupCallParticipant == nil || other == nil {
+		if updateGroupCallParticipant != other {
+			changes = append(changes, Change{Path: path, Old: updateGroupCallParticipant, New: other})
+		}
+		return changes
+	}
+	if updateGroupCallParticipant.GroupCallId != other.GroupCallId {
+		changes = append(changes, Change{Path: fieldPath(path, "group_call_id"), Old: updateGroupCallParticipant.GroupCallId, New: other.GroupCallId})
+	}
+	changes = updateGroupCallParticipant.Participant.diff(fieldPath(path, "participant"), other.Participant, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateGroupCallParticipant *UpdateGroupCallParticipant) String() string {
+	return objectString(updateGroupCallParticipant)
+}
+
+// LogValue logs the object as its summary
+func (updateGroupCallParticipant *UpdateGroupCallParticipant) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateGroupCallParticipant))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateGroupCallParticipant *UpdateGroupCallParticipant) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateGroupCallParticipant)
+}
+
+func (updateGroupCallParticipant *UpdateGroupCallParticipant) printText(p *printer) {
+	if updateGroupCallParticipant == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateGroupCallParticipant")
+	p.int("group_call_id", int64(updateGroupCallParticipant.GroupCallId))
+	p.object("participant", updateGroupCallParticipant.Participant)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateGroupCallParticipant *UpdateGroupCallParticipant) GetUpdateEnum() UpdateEnum {
 	return UpdateGroupCallParticipantType
 }
 
+// Accept calls the method of the visitor for UpdateGroupCallParticipant
+func (updateGroupCallParticipant *UpdateGroupCallParticipant) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateGroupCallParticipant(updateGroupCallParticipant)
+}
+
 // UpdateNewCallSignalingData New call signaling data arrived
 type UpdateNewCallSignalingData struct {
 	tdCommon
@@ -2749,11 +10141,132 @@ func NewUpdateNewCallSignalingData(callId int32, data []byte) *UpdateNewCallSign
 	return &updateNewCallSignalingDataTemp
 }
 
+// MarshalJSON marshals to json
+func (updateNewCallSignalingData *UpdateNewCallSignalingData) MarshalJSON() ([]byte, error) {
+	return updateNewCallSignalingData.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateNewCallSignalingData *UpdateNewCallSignalingData) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateNewCallSignalingData)
+}
+
+func (updateNewCallSignalingData *UpdateNewCallSignalingData) appendJSON(b []byte) ([]byte, error) {
+	if updateNewCallSignalingData == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateNewCallSignalingData.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateNewCallSignalingData.tdCommon.Extra)
+	b = append(b, `,"call_id":`...)
+	b = appendJSONInt(b, int64(updateNewCallSignalingData.CallId))
+	b = append(b, `,"data":`...)
+	b = appendJSONBytes(b, updateNewCallSignalingData.Data)
+	return append(b, '}'), nil
+}
+
+func (updateNewCallSignalingData *UpdateNewCallSignalingData) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateNewCallSignalingData.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateNewCallSignalingData.tdCommon.Extra, err = r.readString()
+		case "call_id":
+			updateNewCallSignalingData.CallId, err = r.readInt32()
+		case "data":
+			updateNewCallSignalingData.Data, err = r.readBytes()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateNewCallSignalingData *UpdateNewCallSignalingData) Clone() *UpdateNewCallSignalingData {
+	if updateNewCallSignalingData == nil {
+		return nil
+	}
+	clone := *updateNewCallSignalingData
+	if updateNewCallSignalingData.Data != nil {
+		clone.Data = append([]byte{}, updateNewCallSignalingData.Data...)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateNewCallSignalingData *UpdateNewCallSignalingData) Equal(other *UpdateNewCallSignalingData) bool {
+	if updateNewCallSignalingData == nil || other == nil {
+		return updateNewCallSignalingData == other
+	}
+	if updateNewCallSignalingData.CallId != other.CallId {
+		return false
+	}
+	if !equalBytes(updateNewCallSignalingData.Data, other.Data) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateNewCallSignalingData *UpdateNewCallSignalingData) Diff(other *UpdateNewCallSignalingData) []Change {
+	return updateNewCallSignalingData.diff("", other, nil)
+}
+
+func (updateNewCallSignalingData *UpdateNewCallSignalingData) diff(path string, other *UpdateNewCallSignalingData, changes []Change) []Change {
+	if updateNewCallSignalingData == nil || other == nil {
+		if updateNewCallSignalingData != other {
+			changes = append(changes, Change{Path: path, Old: updateNewCallSignalingData, New: other})
+		}
+		return changes
+	}
+	if updateNewCallSignalingData.CallId != other.CallId {
+		changes = append(changes, Change{Path: fieldPath(path, "call_id"), Old: updateNewCallSignalingData.CallId, New: other.CallId})
+	}
+	if !equalBytes(updateNewCallSignalingData.Data, other.Data) {
+		changes = append(changes, Change{Path: fieldPath(path, "data"), Old: updateNewCallSignalingData.Data, New: other.Data})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateNewCallSignalingData *UpdateNewCallSignalingData) String() string {
+	return objectString(updateNewCallSignalingData)
+}
+
+// LogValue logs the object as its summary
+func (updateNewCallSignalingData *UpdateNewCallSignalingData) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateNewCallSignalingData))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateNewCallSignalingData *UpdateNewCallSignalingData) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateNewCallSignalingData)
+}
+
+func (updateNewCallSignalingData *UpdateNewCallSignalingData) printText(p *printer) {
+	if updateNewCallSignalingData == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateNewCallSignalingData")
+	p.int("call_id", int64(updateNewCallSignalingData.CallId))
+	p.bytes("data", updateNewCallSignalingData.Data)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateNewCallSignalingData *UpdateNewCallSignalingData) GetUpdateEnum() UpdateEnum {
 	return UpdateNewCallSignalingDataType
 }
 
+// Accept calls the method of the visitor for UpdateNewCallSignalingData
+func (updateNewCallSignalingData *UpdateNewCallSignalingData) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateNewCallSignalingData(updateNewCallSignalingData)
+}
+
 // UpdateUserPrivacySettingRules Some privacy setting rules have been changed
 type UpdateUserPrivacySettingRules struct {
 	tdCommon
@@ -2780,29 +10293,127 @@ func NewUpdateUserPrivacySettingRules(setting UserPrivacySetting, rules *UserPri
 	return &updateUserPrivacySettingRulesTemp
 }
 
+// MarshalJSON marshals to json
+func (updateUserPrivacySettingRules *UpdateUserPrivacySettingRules) MarshalJSON() ([]byte, error) {
+	return updateUserPrivacySettingRules.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (updateUserPrivacySettingRules *UpdateUserPrivacySettingRules) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, updateUserPrivacySettingRules)
+}
+
+func (updateUserPrivacySettingRules *UpdateUserPrivacySettingRules) appendJSON(b []byte) ([]byte, error) {
+	if updateUserPrivacySettingRules == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-		Rules *UserPrivacySettingRules `json:"rules"` // New privacy rules
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateUserPrivacySettingRules.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateUserPrivacySettingRules.tdCommon.Extra)
+	b = append(b, `,"setting":`...)
+	b, err = appendJSONValue(b, updateUserPrivacySettingRules.Setting)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"rules":`...)
+	b, err = updateUserPrivacySettingRules.Rules.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateUserPrivacySettingRules *UpdateUserPrivacySettingRules) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateUserPrivacySettingRules.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateUserPrivacySettingRules.tdCommon.Extra, err = r.readString()
+		case "setting":
+			updateUserPrivacySettingRules.Setting, err = decodeUserPrivacySetting(r)
+		case "rules":
+			if r.readNull() {
+				updateUserPrivacySettingRules.Rules = nil
+			} else {
+				updateUserPrivacySettingRules.Rules = new(UserPrivacySettingRules)
+				err = updateUserPrivacySettingRules.Rules.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateUserPrivacySettingRules *UpdateUserPrivacySettingRules) Clone() *UpdateUserPrivacySettingRules {
+	if updateUserPrivacySettingRules == nil {
+		return nil
+	}
+	clone := *updateUserPrivacySettingRules
+	clone.Setting = cloneUserPrivacySetting(updateUserPrivacySettingRules.Setting)
+	clone.Rules = updateUserPrivacySettingRules.Rules.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateUserPrivacySettingRules *UpdateUserPrivacySettingRules) Equal(other *UpdateUserPrivacySettingRules) bool {
+	if updateUserPrivacySettingRules == nil || other == nil {
+		return updateUserPrivacySettingRules == other
+	}
+	if !equalUserPrivacySetting(updateUserPrivacySettingRules.Setting, other.Setting) {
+		return false
+	}
+	if !updateUserPrivacySettingRules.Rules.Equal(other.Rules) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateUserPrivacySettingRules *UpdateUserPrivacySettingRules) Diff(other *UpdateUserPrivacySettingRules) []Change {
+	return updateUserPrivacySettingRules.diff("", other, nil)
+}
+
+func (updateUserPrivacySettingRules *UpdateUserPrivacySettingRules) diff(path string, other *UpdateUserPrivacySettingRules, changes []Change) []Change {
+	if updateUserPrivacySettingRules == nil || other == nil {
+		if updateUserPrivacySettingRules != other {
+			changes = append(changes, Change{Path: path, Old: updateUserPrivacySettingRules, New: other})
+		}
+		return changes
 	}
+	changes = diffUserPrivacySetting(fieldPath(path, "setting"), updateUserPrivacySettingRules.Setting, other.Setting, changes)
+	changes = updateUserPrivacySettingRules.Rules.diff(fieldPath(path, "rules"), other.Rules, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateUserPrivacySettingRules *UpdateUserPrivacySettingRules) String() string {
+	return objectString(updateUserPrivacySettingRules)
+}
 
-	updateUserPrivacySettingRules.tdCommon = tempObj.tdCommon
-	updateUserPrivacySettingRules.Rules = tempObj.Rules
+// LogValue logs the object as its summary
+func (updateUserPrivacySettingRules *UpdateUserPrivacySettingRules) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateUserPrivacySettingRules))
+}
 
-	fieldSetting, _ := unmarshalUserPrivacySetting(objMap["setting"])
-	updateUserPrivacySettingRules.Setting = fieldSetting
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateUserPrivacySettingRules *UpdateUserPrivacySettingRules) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateUserPrivacySettingRules)
+}
 
-	return nil
+func (updateUserPrivacySettingRules *UpdateUserPrivacySettingRules) printText(p *printer) {
+	if updateUserPrivacySettingRules == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateUserPrivacySettingRules")
+	p.object("setting", updateUserPrivacySettingRules.Setting)
+	p.object("rules", updateUserPrivacySettingRules.Rules)
+	p.endObject(state)
 }
 
 // GetUpdateEnum return the enum type of this object
@@ -2810,6 +10421,11 @@ func (updateUserPrivacySettingRules *UpdateUserPrivacySettingRules) GetUpdateEnu
 	return UpdateUserPrivacySettingRulesType
 }
 
+// Accept calls the method of the visitor for UpdateUserPrivacySettingRules
+func (updateUserPrivacySettingRules *UpdateUserPrivacySettingRules) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateUserPrivacySettingRules(updateUserPrivacySettingRules)
+}
+
 // UpdateUnreadMessageCount Number of unread messages in a chat list has changed. This update is sent only if the message database is used
 type UpdateUnreadMessageCount struct {
 	tdCommon
@@ -2839,31 +10455,131 @@ func NewUpdateUnreadMessageCount(chatList ChatList, unreadCount int32, unreadUnm
 	return &updateUnreadMessageCountTemp
 }
 
+// MarshalJSON marshals to json
+func (updateUnreadMessageCount *UpdateUnreadMessageCount) MarshalJSON() ([]byte, error) {
+	return updateUnreadMessageCount.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (updateUnreadMessageCount *UpdateUnreadMessageCount) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		UnreadCount        int32 `json:"unread_count"`         // Total number of unread messages
-		UnreadUnmutedCount int32 `json:"unread_unmuted_count"` // Total number of unread messages in unmuted chats
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	return unmarshalFast(b, updateUnreadMessageCount)
+}
+
+func (updateUnreadMessageCount *UpdateUnreadMessageCount) appendJSON(b []byte) ([]byte, error) {
+	if updateUnreadMessageCount == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateUnreadMessageCount.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateUnreadMessageCount.tdCommon.Extra)
+	b = append(b, `,"chat_list":`...)
+	b, err = appendJSONValue(b, updateUnreadMessageCount.ChatList)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"unread_count":`...)
+	b = appendJSONInt(b, int64(updateUnreadMessageCount.UnreadCount))
+	b = append(b, `,"unread_unmuted_count":`...)
+	b = appendJSONInt(b, int64(updateUnreadMessageCount.UnreadUnmutedCount))
+	return append(b, '}'), nil
+}
+
+func (updateUnreadMessageCount *UpdateUnreadMessageCount) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateUnreadMessageCount.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateUnreadMessageCount.tdCommon.Extra, err = r.readString()
+		case "chat_list":
+			updateUnreadMessageCount.ChatList, err = decodeChatList(r)
+		case "unread_count":
+			updateUnreadMessageCount.UnreadCount, err = r.readInt32()
+		case "unread_unmuted_count":
+			updateUnreadMessageCount.UnreadUnmutedCount, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateUnreadMessageCount *UpdateUnreadMessageCount) Clone() *UpdateUnreadMessageCount {
+	if updateUnreadMessageCount == nil {
+		return nil
+	}
+	clone := *updateUnreadMessageCount
+	clone.ChatList = cloneChatList(updateUnreadMessageCount.ChatList)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateUnreadMessageCount *UpdateUnreadMessageCount) Equal(other *UpdateUnreadMessageCount) bool {
+	if updateUnreadMessageCount == nil || other == nil {
+		return updateUnreadMessageCount == other
+	}
+	if !equalChatList(updateUnreadMessageCount.ChatList, other.ChatList) {
+		return false
+	}
+	if updateUnreadMessageCount.UnreadCount != other.UnreadCount {
+		return false
+	}
+	if updateUnreadMessageCount.UnreadUnmutedCount != other.UnreadUnmutedCount {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateUnreadMessageCount *UpdateUnreadMessageCount) Diff(other *UpdateUnreadMessageCount) []Change {
+	return updateUnreadMessageCount.diff("", other, nil)
+}
+
+func (updateUnreadMessageCount *UpdateUnreadMessageCount) diff(path string, other *UpdateUnreadMessageCount, changes []Change) []Change {
+	if updateUnreadMessageCount == nil || other == nil {
+		if updateUnreadMessageCount != other {
+			changes = append(changes, Change{Path: path, Old: updateUnreadMessageCount, New: other})
+		}
+		return changes
 	}
+	changes = diffChatList(fieldPath(path, "chat_list"), updateUnreadMessageCount.ChatList, other.ChatList, changes)
+	if updateUnreadMessageCount.UnreadCount != other.UnreadCount {
+		changes = append(changes, Change{Path: fieldPath(path, "unread_count"), Old: updateUnreadMessageCount.UnreadCount, New: other.UnreadCount})
+	}
+	if updateUnreadMessageCount.UnreadUnmutedCount != other.UnreadUnmutedCount {
+		changes = append(changes, Change{Path: fieldPath(path, "unread_unmuted_count"), Old: updateUnreadMessageCount.UnreadUnmutedCount, New: other.UnreadUnmutedCount})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateUnreadMessageCount *UpdateUnreadMessageCount) String() string {
+	return objectString(updateUnreadMessageCount)
+}
 
-	updateUnreadMessageCount.tdCommon = tempObj.tdCommon
-	updateUnreadMessageCount.UnreadCount = tempObj.UnreadCount
-	updateUnreadMessageCount.UnreadUnmutedCount = tempObj.UnreadUnmutedCount
+// LogValue logs the object as its summary
+func (updateUnreadMessageCount *UpdateUnreadMessageCount) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateUnreadMessageCount))
+}
 
-	fieldChatList, _ := unmarshalChatList(objMap["chat_list"])
-	updateUnreadMessageCount.ChatList = fieldChatList
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateUnreadMessageCount *UpdateUnreadMessageCount) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateUnreadMessageCount)
+}
 
-	return nil
+func (updateUnreadMessageCount *UpdateUnreadMessageCount) printText(p *printer) {
+	if updateUnreadMessageCount == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateUnreadMessageCount")
+	p.object("chat_list", updateUnreadMessageCount.ChatList)
+	p.int("unread_count", int64(updateUnreadMessageCount.UnreadCount))
+	p.int("unread_unmuted_count", int64(updateUnreadMessageCount.UnreadUnmutedCount))
+	p.endObject(state)
 }
 
 // GetUpdateEnum return the enum type of this object
@@ -2871,6 +10587,11 @@ func (updateUnreadMessageCount *UpdateUnreadMessageCount) GetUpdateEnum() Update
 	return UpdateUnreadMessageCountType
 }
 
+// Accept calls the method of the visitor for UpdateUnreadMessageCount
+func (updateUnreadMessageCount *UpdateUnreadMessageCount) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateUnreadMessageCount(updateUnreadMessageCount)
+}
+
 // UpdateUnreadChatCount Number of unread chats, i.e. with unread messages or marked as unread, has changed. This update is sent only if the message database is used
 type UpdateUnreadChatCount struct {
 	tdCommon
@@ -2909,37 +10630,164 @@ func NewUpdateUnreadChatCount(chatList ChatList, totalCount int32, unreadCount i
 	return &updateUnreadChatCountTemp
 }
 
+// MarshalJSON marshals to json
+func (updateUnreadChatCount *UpdateUnreadChatCount) MarshalJSON() ([]byte, error) {
+	return updateUnreadChatCount.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (updateUnreadChatCount *UpdateUnreadChatCount) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		TotalCount                 int32 `json:"total_count"`                    // Approximate total number of chats in the chat list
-		UnreadCount                int32 `json:"unread_count"`                   // Total number of unread chats
-		UnreadUnmutedCount         int32 `json:"unread_unmuted_count"`           // Total number of unread unmuted chats
-		MarkedAsUnreadCount        int32 `json:"marked_as_unread_count"`         // Total number of chats marked as unread
-		MarkedAsUnreadUnmutedCount int32 `json:"marked_as_unread_unmuted_count"` // Total number of unmuted chats marked as unread
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	return unmarshalFast(b, updateUnreadChatCount)
+}
+
+func (updateUnreadChatCount *UpdateUnreadChatCount) appendJSON(b []byte) ([]byte, error) {
+	if updateUnreadChatCount == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateUnreadChatCount.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateUnreadChatCount.tdCommon.Extra)
+	b = append(b, `,"chat_list":`...)
+	b, err = appendJSONValue(b, updateUnreadChatCount.ChatList)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"total_count":`...)
+	b = appendJSONInt(b, int64(updateUnreadChatCount.TotalCount))
+	b = append(b, `,"unread_count":`...)
+	b = appendJSONInt(b, int64(updateUnreadChatCount.UnreadCount))
+	b = append(b, `,"unread_unmuted_count":`...)
+	b = appendJSONInt(b, int64(updateUnreadChatCount.UnreadUnmutedCount))
+	b = append(b, `,"marked_as_unread_count":`...)
+	b = appendJSONInt(b, int64(updateUnreadChatCount.MarkedAsUnreadCount))
+	b = append(b, `,"marked_as_unread_unmuted_count":`...)
+	b = appendJSONInt(b, int64(updateUnreadChatCount.MarkedAsUnreadUnmutedCount))
+	return append(b, '}'), nil
+}
+
+func (updateUnreadChatCount *UpdateUnreadChatCount) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateUnreadChatCount.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateUnreadChatCount.tdCommon.Extra, err = r.readString()
+		case "chat_list":
+			updateUnreadChatCount.ChatList, err = decodeChatList(r)
+		case "total_count":
+			updateUnreadChatCount.TotalCount, err = r.readInt32()
+		case "unread_count":
+			updateUnreadChatCount.UnreadCount, err = r.readInt32()
+		case "unread_unmuted_count":
+			updateUnreadChatCount.UnreadUnmutedCount, err = r.readInt32()
+		case "marked_as_unread_count":
+			updateUnreadChatCount.MarkedAsUnreadCount, err = r.readInt32()
+		case "marked_as_unread_unmuted_count":
+			updateUnreadChatCount.MarkedAsUnreadUnmutedCount, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateUnreadChatCount *UpdateUnreadChatCount) Clone() *UpdateUnreadChatCount {
+	if updateUnreadChatCount == nil {
+		return nil
+	}
+	clone := *updateUnreadChatCount
+	clone.ChatList = cloneChatList(updateUnreadChatCount.ChatList)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateUnreadChatCount *UpdateUnreadChatCount) Equal(other *UpdateUnreadChatCount) bool {
+	if updateUnreadChatCount == nil || other == nil {
+		return updateUnreadChatCount == other
+	}
+	if !equalChatList(updateUnreadChatCount.ChatList, other.ChatList) {
+		return false
+	}
+	if updateUnreadChatCount.TotalCount != other.TotalCount {
+		return false
+	}
+	if updateUnreadChatCount.UnreadCount != other.UnreadCount {
+		return false
+	}
+	if updateUnreadChatCount.UnreadUnmutedCount != other.UnreadUnmutedCount {
+		return false
+	}
+	if updateUnreadChatCount.MarkedAsUnreadCount != other.MarkedAsUnreadCount {
+		return false
+	}
+	if updateUnreadChatCount.MarkedAsUnreadUnmutedCount != other.MarkedAsUnreadUnmutedCount {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateUnreadChatCount *UpdateUnreadChatCount) Diff(other *UpdateUnreadChatCount) []Change {
+	return updateUnreadChatCount.diff("", other, nil)
+}
+
+func (updateUnreadChatCount *UpdateUnreadChatCount) diff(path string, other *UpdateUnreadChatCount, changes []Change) []Change {
+	if updateUnreadChatCount == nil || other == nil {
+		if updateUnreadChatCount != other {
+			changes = append(changes, Change{Path: path, Old: updateUnreadChatCount, New: other})
+		}
+		return changes
+	}
+	changes = diffChatList(fieldPath(path, "chat_list"), updateUnreadChatCount.ChatList, other.ChatList, changes)
+	if updateUnreadChatCount.TotalCount != other.TotalCount {
+		changes = append(changes, Change{Path: fieldPath(path, "total_count"), Old: updateUnreadChatCount.TotalCount, New: other.TotalCount})
+	}
+	if updateUnreadChatCount.UnreadCount != other.UnreadCount {
+		changes = append(changes, Change{Path: fieldPath(path, "unread_count"), Old: updateUnreadChatCount.UnreadCount, New: other.UnreadCount})
+	}
+	if updateUnreadChatCount.UnreadUnmutedCount != other.UnreadUnmutedCount {
+		changes = append(changes, Change{Path: fieldPath(path, "unread_unmuted_count"), Old: updateUnreadChatCount.UnreadUnmutedCount, New: other.UnreadUnmutedCount})
+	}
+	if updateUnreadChatCount.MarkedAsUnreadCount != other.MarkedAsUnreadCount {
+		changes = append(changes, Change{Path: fieldPath(path, "marked_as_unread_count"), Old: updateUnreadChatCount.MarkedAsUnreadCount, New: other.MarkedAsUnreadCount})
+	}
+	if updateUnreadChatCount.MarkedAsUnreadUnmutedCount != other.MarkedAsUnreadUnmutedCount {
+		changes = append(changes, Change{Path: fieldPath(path, "marked_as_unread_unmuted_count"), Old: updateUnreadChatCount.MarkedAsUnreadUnmutedCount, New: other.MarkedAsUnreadUnmutedCount})
 	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateUnreadChatCount *UpdateUnreadChatCount) String() string {
+	return objectString(updateUnreadChatCount)
+}
 
-	updateUnreadChatCount.tdCommon = tempObj.tdCommon
-	updateUnreadChatCount.TotalCount = tempObj.TotalCount
-	updateUnreadChatCount.UnreadCount = tempObj.UnreadCount
-	updateUnreadChatCount.UnreadUnmutedCount = tempObj.UnreadUnmutedCount
-	updateUnreadChatCount.MarkedAsUnreadCount = tempObj.MarkedAsUnreadCount
-	updateUnreadChatCount.MarkedAsUnreadUnmutedCount = tempObj.MarkedAsUnreadUnmutedCount
+// LogValue logs the object as its summary
+func (updateUnreadChatCount *UpdateUnreadChatCount) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateUnreadChatCount))
+}
 
-	fieldChatList, _ := unmarshalChatList(objMap["chat_list"])
-	updateUnreadChatCount.ChatList = fieldChatList
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateUnreadChatCount *UpdateUnreadChatCount) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateUnreadChatCount)
+}
 
-	return nil
+func (updateUnreadChatCount *UpdateUnreadChatCount) printText(p *printer) {
+	if updateUnreadChatCount == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateUnreadChatCount")
+	p.object("chat_list", updateUnreadChatCount.ChatList)
+	p.int("total_count", int64(updateUnreadChatCount.TotalCount))
+	p.int("unread_count", int64(updateUnreadChatCount.UnreadCount))
+	p.int("unread_unmuted_count", int64(updateUnreadChatCount.UnreadUnmutedCount))
+	p.int("marked_as_unread_count", int64(updateUnreadChatCount.MarkedAsUnreadCount))
+	p.int("marked_as_unread_unmuted_count", int64(updateUnreadChatCount.MarkedAsUnreadUnmutedCount))
+	p.endObject(state)
 }
 
 // GetUpdateEnum return the enum type of this object
@@ -2947,6 +10795,11 @@ func (updateUnreadChatCount *UpdateUnreadChatCount) GetUpdateEnum() UpdateEnum {
 	return UpdateUnreadChatCountType
 }
 
+// Accept calls the method of the visitor for UpdateUnreadChatCount
+func (updateUnreadChatCount *UpdateUnreadChatCount) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateUnreadChatCount(updateUnreadChatCount)
+}
+
 // UpdateOption An option changed its value
 type UpdateOption struct {
 	tdCommon
@@ -2973,30 +10826,120 @@ func NewUpdateOption(name string, value OptionValue) *UpdateOption {
 	return &updateOptionTemp
 }
 
+// MarshalJSON marshals to json
+func (updateOption *UpdateOption) MarshalJSON() ([]byte, error) {
+	return updateOption.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (updateOption *UpdateOption) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, updateOption)
+}
+
+func (updateOption *UpdateOption) appendJSON(b []byte) ([]byte, error) {
+	if updateOption == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateOption.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateOption.tdCommon.Extra)
+	b = append(b, `,"name":`...)
+	b = appendJSONString(b, updateOption.Name)
+	b = append(b, `,"value":`...)
+	b, err = appendJSONValue(b, updateOption.Value)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateOption *UpdateOption) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateOption.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateOption.tdCommon.Extra, err = r.readString()
+		case "name":
+			updateOption.Name, err = r.readString()
+		case "value":
+			updateOption.Value, err = decodeOptionValue(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateOption *UpdateOption) Clone() *UpdateOption {
+	if updateOption == nil {
+		return nil
 	}
-	tempObj := struct {
-		tdCommon
-		Name string `json:"name"` // The option name
+	clone := *updateOption
+	clone.Value = cloneOptionValue(updateOption.Value)
+	return &clone
+}
 
-	}{}
-	err = json.Unmarshal(b, &tempObj)
-	if err != nil {
-		return err
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateOption *UpdateOption) Equal(other *UpdateOption) bool {
+	if updateOption == nil || other == nil {
+		return updateOption == other
+	}
+	if updateOption.Name != other.Name {
+		return false
+	}
+	if !equalOptionValue(updateOption.Value, other.Value) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateOption *UpdateOption) Diff(other *UpdateOption) []Change {
+	return updateOption.diff("", other, nil)
+}
+
+func (updateOption *UpdateOption) diff(path string, other *UpdateOption, changes []Change) []Change {
+	if updateOption == nil || other == nil {
+		if updateOption != other {
+			changes = append(changes, Change{Path: path, Old: updateOption, New: other})
+		}
+		return changes
+	}
+	if updateOption.Name != other.Name {
+		changes = append(changes, Change{Path: fieldPath(path, "name"), Old: updateOption.Name, New: other.Name})
 	}
+	changes = diffOptionValue(fieldPath(path, "value"), updateOption.Value, other.Value, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateOption *UpdateOption) String() string {
+	return objectString(updateOption)
+}
 
-	updateOption.tdCommon = tempObj.tdCommon
-	updateOption.Name = tempObj.Name
+// LogValue logs the object as its summary
+func (updateOption *UpdateOption) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateOption))
+}
 
-	fieldValue, _ := unmarshalOptionValue(objMap["value"])
-	updateOption.Value = fieldValue
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateOption *UpdateOption) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateOption)
+}
 
-	return nil
+func (updateOption *UpdateOption) printText(p *printer) {
+	if updateOption == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateOption")
+	p.string("name", updateOption.Name)
+	p.object("value", updateOption.Value)
+	p.endObject(state)
 }
 
 // GetUpdateEnum return the enum type of this object
@@ -3004,6 +10947,11 @@ func (updateOption *UpdateOption) GetUpdateEnum() UpdateEnum {
 	return UpdateOptionType
 }
 
+// Accept calls the method of the visitor for UpdateOption
+func (updateOption *UpdateOption) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateOption(updateOption)
+}
+
 // UpdateStickerSet A sticker set has changed
 type UpdateStickerSet struct {
 	tdCommon
@@ -3027,11 +10975,126 @@ func NewUpdateStickerSet(stickerSet *StickerSet) *UpdateStickerSet {
 	return &updateStickerSetTemp
 }
 
+// MarshalJSON marshals to json
+func (updateStickerSet *UpdateStickerSet) MarshalJSON() ([]byte, error) {
+	return updateStickerSet.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateStickerSet *UpdateStickerSet) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateStickerSet)
+}
+
+func (updateStickerSet *UpdateStickerSet) appendJSON(b []byte) ([]byte, error) {
+	if updateStickerSet == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateStickerSet.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateStickerSet.tdCommon.Extra)
+	b = append(b, `,"sticker_set":`...)
+	b, err = updateStickerSet.StickerSet.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateStickerSet *UpdateStickerSet) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateStickerSet.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateStickerSet.tdCommon.Extra, err = r.readString()
+		case "sticker_set":
+			if r.readNull() {
+				updateStickerSet.StickerSet = nil
+			} else {
+				updateStickerSet.StickerSet = new(StickerSet)
+				err = updateStickerSet.StickerSet.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateStickerSet *UpdateStickerSet) Clone() *UpdateStickerSet {
+	if updateStickerSet == nil {
+		return nil
+	}
+	clone := *updateStickerSet
+	clone.StickerSet = updateStickerSet.StickerSet.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateStickerSet *UpdateStickerSet) Equal(other *UpdateStickerSet) bool {
+	if updateStickerSet == nil || other == nil {
+		return updateStickerSet == other
+	}
+	if !updateStickerSet.StickerSet.Equal(other.StickerSet) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateStickerSet *UpdateStickerSet) Diff(other *UpdateStickerSet) []Change {
+	return updateStickerSet.diff("", other, nil)
+}
+
+func (updateStickerSet *UpdateStickerSet) diff(path string, other *UpdateStickerSet, changes []Change) []Change {
+	if updateStickerSet == nil || other == nil {
+		if updateStickerSet != other {
+			changes = append(changes, Change{Path: path, Old: updateStickerSet, New: other})
+		}
+		return changes
+	}
+	changes = updateStickerSet.StickerSet.diff(fieldPath(path, "sticker_set"), other.StickerSet, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateStickerSet *UpdateStickerSet) String() string {
+	return objectString(updateStickerSet)
+}
+
+// LogValue logs the object as its summary
+func (updateStickerSet *UpdateStickerSet) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateStickerSet))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateStickerSet *UpdateStickerSet) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateStickerSet)
+}
+
+func (updateStickerSet *UpdateStickerSet) printText(p *printer) {
+	if updateStickerSet == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateStickerSet")
+	p.object("sticker_set", updateStickerSet.StickerSet)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateStickerSet *UpdateStickerSet) GetUpdateEnum() UpdateEnum {
 	return UpdateStickerSetType
 }
 
+// Accept calls the method of the visitor for UpdateStickerSet
+func (updateStickerSet *UpdateStickerSet) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateStickerSet(updateStickerSet)
+}
+
 // UpdateInstalledStickerSets The list of installed sticker sets was updated
 type UpdateInstalledStickerSets struct {
 	tdCommon
@@ -3058,11 +11121,167 @@ func NewUpdateInstalledStickerSets(isMasks bool, stickerSetIds []JSONInt64) *Upd
 	return &updateInstalledStickerSetsTemp
 }
 
+// MarshalJSON marshals to json
+func (updateInstalledStickerSets *UpdateInstalledStickerSets) MarshalJSON() ([]byte, error) {
+	return updateInstalledStickerSets.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateInstalledStickerSets *UpdateInstalledStickerSets) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateInstalledStickerSets)
+}
+
+func (updateInstalledStickerSets *UpdateInstalledStickerSets) appendJSON(b []byte) ([]byte, error) {
+	if updateInstalledStickerSets == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateInstalledStickerSets.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateInstalledStickerSets.tdCommon.Extra)
+	b = append(b, `,"is_masks":`...)
+	b = appendJSONBool(b, updateInstalledStickerSets.IsMasks)
+	b = append(b, `,"sticker_set_ids":`...)
+	if updateInstalledStickerSets.StickerSetIds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range updateInstalledStickerSets.StickerSetIds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt64String(b, int64(updateInstalledStickerSets.StickerSetIds[i0]))
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (updateInstalledStickerSets *UpdateInstalledStickerSets) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateInstalledStickerSets.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateInstalledStickerSets.tdCommon.Extra, err = r.readString()
+		case "is_masks":
+			updateInstalledStickerSets.IsMasks, err = r.readBool()
+		case "sticker_set_ids":
+			if r.readNull() {
+				updateInstalledStickerSets.StickerSetIds = nil
+			} else {
+				updateInstalledStickerSets.StickerSetIds = make([]JSONInt64, 0)
+				err = r.readArray(func() (err error) {
+					var item0 JSONInt64
+					item0, err = r.readJSONInt64()
+					updateInstalledStickerSets.StickerSetIds = append(updateInstalledStickerSets.StickerSetIds, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateInstalledStickerSets *UpdateInstalledStickerSets) Clone() *UpdateInstalledStickerSets {
+	if updateInstalledStickerSets == nil {
+		return nil
+	}
+	clone := *updateInstalledStickerSets
+	if updateInstalledStickerSets.StickerSetIds != nil {
+		clone.StickerSetIds = make([]JSONInt64, len(updateInstalledStickerSets.StickerSetIds))
+		copy(clone.StickerSetIds, updateInstalledStickerSets.StickerSetIds)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateInstalledStickerSets *UpdateInstalledStickerSets) Equal(other *UpdateInstalledStickerSets) bool {
+	if updateInstalledStickerSets == nil || other == nil {
+		return updateInstalledStickerSets == other
+	}
+	if updateInstalledStickerSets.IsMasks != other.IsMasks {
+		return false
+	}
+	if len(updateInstalledStickerSets.StickerSetIds) != len(other.StickerSetIds) {
+		return false
+	}
+	for i0 := range updateInstalledStickerSets.StickerSetIds {
+		if updateInstalledStickerSets.StickerSetIds[i0] != other.StickerSetIds[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateInstalledStickerSets *UpdateInstalledStickerSets) Diff(other *UpdateInstalledStickerSets) []Change {
+	return updateInstalledStickerSets.diff("", other, nil)
+}
+
+func (updateInstalledStickerSets *UpdateInstalledStickerSets) diff(path string, other *UpdateInstalledStickerSets, changes []Change) []Change {
+	if updateInstalledStickerSets == nil || other == nil {
+		if updateInstalledStickerSets != other {
+			changes = append(changes, Change{Path: path, Old: updateInstalledStickerSets, New: other})
+		}
+		return changes
+	}
+	if updateInstalledStickerSets.IsMasks != other.IsMasks {
+		changes = append(changes, Change{Path: fieldPath(path, "is_masks"), Old: updateInstalledStickerSets.IsMasks, New: other.IsMasks})
+	}
+	if len(updateInstalledStickerSets.StickerSetIds) != len(other.StickerSetIds) {
+		changes = append(changes, Change{Path: fieldPath(path, "sticker_set_ids"), Old: updateInstalledStickerSets.StickerSetIds, New: other.StickerSetIds})
+	} else {
+		for i0 := range updateInstalledStickerSets.StickerSetIds {
+			if updateInstalledStickerSets.StickerSetIds[i0] != other.StickerSetIds[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "sticker_set_ids"), i0), Old: updateInstalledStickerSets.StickerSetIds[i0], New: other.StickerSetIds[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateInstalledStickerSets *UpdateInstalledStickerSets) String() string {
+	return objectString(updateInstalledStickerSets)
+}
+
+// LogValue logs the object as its summary
+func (updateInstalledStickerSets *UpdateInstalledStickerSets) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateInstalledStickerSets))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateInstalledStickerSets *UpdateInstalledStickerSets) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateInstalledStickerSets)
+}
+
+func (updateInstalledStickerSets *UpdateInstalledStickerSets) printText(p *printer) {
+	if updateInstalledStickerSets == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateInstalledStickerSets")
+	p.bool("is_masks", updateInstalledStickerSets.IsMasks)
+	p.vector("sticker_set_ids", len(updateInstalledStickerSets.StickerSetIds), func(i0 int) {
+		p.int("sticker_set_ids", int64(updateInstalledStickerSets.StickerSetIds[i0]))
+	})
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateInstalledStickerSets *UpdateInstalledStickerSets) GetUpdateEnum() UpdateEnum {
 	return UpdateInstalledStickerSetsType
 }
 
+// Accept calls the method of the visitor for UpdateInstalledStickerSets
+func (updateInstalledStickerSets *UpdateInstalledStickerSets) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateInstalledStickerSets(updateInstalledStickerSets)
+}
+
 // UpdateTrendingStickerSets The list of trending sticker sets was updated or some of them were viewed
 type UpdateTrendingStickerSets struct {
 	tdCommon
@@ -3086,11 +11305,126 @@ func NewUpdateTrendingStickerSets(stickerSets *StickerSets) *UpdateTrendingStick
 	return &updateTrendingStickerSetsTemp
 }
 
+// MarshalJSON marshals to json
+func (updateTrendingStickerSets *UpdateTrendingStickerSets) MarshalJSON() ([]byte, error) {
+	return updateTrendingStickerSets.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateTrendingStickerSets *UpdateTrendingStickerSets) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateTrendingStickerSets)
+}
+
+func (updateTrendingStickerSets *UpdateTrendingStickerSets) appendJSON(b []byte) ([]byte, error) {
+	if updateTrendingStickerSets == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateTrendingStickerSets.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateTrendingStickerSets.tdCommon.Extra)
+	b = append(b, `,"sticker_sets":`...)
+	b, err = updateTrendingStickerSets.StickerSets.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateTrendingStickerSets *UpdateTrendingStickerSets) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateTrendingStickerSets.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateTrendingStickerSets.tdCommon.Extra, err = r.readString()
+		case "sticker_sets":
+			if r.readNull() {
+				updateTrendingStickerSets.StickerSets = nil
+			} else {
+				updateTrendingStickerSets.StickerSets = new(StickerSets)
+				err = updateTrendingStickerSets.StickerSets.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateTrendingStickerSets *UpdateTrendingStickerSets) Clone() *UpdateTrendingStickerSets {
+	if updateTrendingStickerSets == nil {
+		return nil
+	}
+	clone := *updateTrendingStickerSets
+	clone.StickerSets = updateTrendingStickerSets.StickerSets.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateTrendingStickerSets *UpdateTrendingStickerSets) Equal(other *UpdateTrendingStickerSets) bool {
+	if updateTrendingStickerSets == nil || other == nil {
+		return updateTrendingStickerSets == other
+	}
+	if !updateTrendingStickerSets.StickerSets.Equal(other.StickerSets) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateTrendingStickerSets *UpdateTrendingStickerSets) Diff(other *UpdateTrendingStickerSets) []Change {
+	return updateTrendingStickerSets.diff("", other, nil)
+}
+
+func (updateTrendingStickerSets *UpdateTrendingStickerSets) diff(path string, other *UpdateTrendingStickerSets, changes []Change) []Change {
+	if updateTrendingStickerSets == nil || other == nil {
+		if updateTrendingStickerSets != other {
+			changes = append(changes, Change{Path: path, Old: updateTrendingStickerSets, New: other})
+		}
+		return changes
+	}
+	changes = updateTrendingStickerSets.StickerSets.diff(fieldPath(path, "sticker_sets"), other.StickerSets, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateTrendingStickerSets *UpdateTrendingStickerSets) String() string {
+	return objectString(updateTrendingStickerSets)
+}
+
+// LogValue logs the object as its summary
+func (updateTrendingStickerSets *UpdateTrendingStickerSets) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateTrendingStickerSets))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateTrendingStickerSets *UpdateTrendingStickerSets) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateTrendingStickerSets)
+}
+
+func (updateTrendingStickerSets *UpdateTrendingStickerSets) printText(p *printer) {
+	if updateTrendingStickerSets == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateTrendingStickerSets")
+	p.object("sticker_sets", updateTrendingStickerSets.StickerSets)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateTrendingStickerSets *UpdateTrendingStickerSets) GetUpdateEnum() UpdateEnum {
 	return UpdateTrendingStickerSetsType
 }
 
+// Accept calls the method of the visitor for UpdateTrendingStickerSets
+func (updateTrendingStickerSets *UpdateTrendingStickerSets) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateTrendingStickerSets(updateTrendingStickerSets)
+}
+
 // UpdateRecentStickers The list of recently used stickers was updated
 type UpdateRecentStickers struct {
 	tdCommon
@@ -3117,39 +11451,340 @@ func NewUpdateRecentStickers(isAttached bool, stickerIds []int32) *UpdateRecentS
 	return &updateRecentStickersTemp
 }
 
-// GetUpdateEnum return the enum type of this object
-func (updateRecentStickers *UpdateRecentStickers) GetUpdateEnum() UpdateEnum {
-	return UpdateRecentStickersType
+// MarshalJSON marshals to json
+func (updateRecentStickers *UpdateRecentStickers) MarshalJSON() ([]byte, error) {
+	return updateRecentStickers.appendJSON(nil)
 }
 
-// UpdateFavoriteStickers The list of favorite stickers was updated
-type UpdateFavoriteStickers struct {
-	tdCommon
-	StickerIds []int32 `json:"sticker_ids"` // The new list of file identifiers of favorite stickers
+// UnmarshalJSON unmarshal to json
+func (updateRecentStickers *UpdateRecentStickers) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateRecentStickers)
 }
 
-// MessageType return the string telegram-type of UpdateFavoriteStickers
-func (updateFavoriteStickers *UpdateFavoriteStickers) MessageType() string {
-	return "updateFavoriteStickers"
+func (updateRecentStickers *UpdateRecentStickers) appendJSON(b []byte) ([]byte, error) {
+	if updateRecentStickers == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateRecentStickers.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateRecentStickers.tdCommon.Extra)
+	b = append(b, `,"is_attached":`...)
+	b = appendJSONBool(b, updateRecentStickers.IsAttached)
+	b = append(b, `,"sticker_ids":`...)
+	if updateRecentStickers.StickerIds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range updateRecentStickers.StickerIds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(updateRecentStickers.StickerIds[i0]))
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (updateRecentStickers *UpdateRecentStickers) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateRecentStickers.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateRecentStickers.tdCommon.Extra, err = r.readString()
+		case "is_attached":
+			updateRecentStickers.IsAttached, err = r.readBool()
+		case "sticker_ids":
+			if r.readNull() {
+				updateRecentStickers.StickerIds = nil
+			} else {
+				updateRecentStickers.StickerIds = make([]int32, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int32
+					item0, err = r.readInt32()
+					updateRecentStickers.StickerIds = append(updateRecentStickers.StickerIds, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
 }
 
-// NewUpdateFavoriteStickers creates a new UpdateFavoriteStickers
-//
-// @param stickerIds The new list of file identifiers of favorite stickers
-func NewUpdateFavoriteStickers(stickerIds []int32) *UpdateFavoriteStickers {
-	updateFavoriteStickersTemp := UpdateFavoriteStickers{
-		tdCommon:   tdCommon{Type: "updateFavoriteStickers"},
-		StickerIds: stickerIds,
+// Clone returns a deep copy of the object
+func (updateRecentStickers *UpdateRecentStickers) Clone() *UpdateRecentStickers {
+	if updateRecentStickers == nil {
+		return nil
+	}
+	clone := *updateRecentStickers
+	if updateRecentStickers.StickerIds != nil {
+		clone.StickerIds = make([]int32, len(updateRecentStickers.StickerIds))
+		copy(clone.StickerIds, updateRecentStickers.StickerIds)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateRecentStickers *UpdateRecentStickers) Equal(other *UpdateRecentStickers) bool {
+	if updateRecentStickers == nil || other == nil {
+		return updateRecentStickers == other
+	}
+	if updateRecentStickers.IsAttached != other.IsAttached {
+		return false
+	}
+	if len(updateRecentStickers.StickerIds) != len(other.StickerIds) {
+		return false
+	}
+	for i0 := range updateRecentStickers.StickerIds {
+		if updateRecentStickers.StickerIds[i0] != other.StickerIds[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateRecentStickers *UpdateRecentStickers) Diff(other *UpdateRecentStickers) []Change {
+	return updateRecentStickers.diff("", other, nil)
+}
+
+func (updateRecentStickers *UpdateRecentStickers) diff(path string, other *UpdateRecentStickers, changes []Change) []Change {
+	if updateRecentStickers == nil || other == nil {
+		if updateRecentStickers != other {
+			changes = append(changes, Change{Path: path, Old: updateRecentStickers, New: other})
+		}
+		return changes
+	}
+	if updateRecentStickers.IsAttached != other.IsAttached {
+		changes = append(changes, Change{Path: fieldPath(path, "is_attached"), Old: updateRecentStickers.IsAttached, New: other.IsAttached})
+	}
+	if len(updateRecentStickers.StickerIds) != len(other.StickerIds) {
+		changes = append(changes, Change{Path: fieldPath(path, "sticker_ids"), Old: updateRecentStickers.StickerIds, New: other.StickerIds})
+	} else {
+		for i0 := range updateRecentStickers.StickerIds {
+			if updateRecentStickers.StickerIds[i0] != other.StickerIds[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "sticker_ids"), i0), Old: updateRecentStickers.StickerIds[i0], New: other.StickerIds[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateRecentStickers *UpdateRecentStickers) String() string {
+	return objectString(updateRecentStickers)
+}
+
+// LogValue logs the object as its summary
+func (updateRecentStickers *UpdateRecentStickers) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateRecentStickers))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateRecentStickers *UpdateRecentStickers) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateRecentStickers)
+}
+
+func (updateRecentStickers *UpdateRecentStickers) printText(p *printer) {
+	if updateRecentStickers == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateRecentStickers")
+	p.bool("is_attached", updateRecentStickers.IsAttached)
+	p.vector("sticker_ids", len(updateRecentStickers.StickerIds), func(i0 int) {
+		p.int("sticker_ids", int64(updateRecentStickers.StickerIds[i0]))
+	})
+	p.endObject(state)
+}
+
+// GetUpdateEnum return the enum type of this object
+func (updateRecentStickers *UpdateRecentStickers) GetUpdateEnum() UpdateEnum {
+	return UpdateRecentStickersType
+}
+
+// Accept calls the method of the visitor for UpdateRecentStickers
+func (updateRecentStickers *UpdateRecentStickers) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateRecentStickers(updateRecentStickers)
+}
+
+// UpdateFavoriteStickers The list of favorite stickers was updated
+type UpdateFavoriteStickers struct {
+	tdCommon
+	StickerIds []int32 `json:"sticker_ids"` // The new list of file identifiers of favorite stickers
+}
+
+// MessageType return the string telegram-type of UpdateFavoriteStickers
+func (updateFavoriteStickers *UpdateFavoriteStickers) MessageType() string {
+	return "updateFavoriteStickers"
+}
+
+// NewUpdateFavoriteStickers creates a new UpdateFavoriteStickers
+//
+// @param stickerIds The new list of file identifiers of favorite stickers
+func NewUpdateFavoriteStickers(stickerIds []int32) *UpdateFavoriteStickers {
+	updateFavoriteStickersTemp := UpdateFavoriteStickers{
+		tdCommon:   tdCommon{Type: "updateFavoriteStickers"},
+		StickerIds: stickerIds,
 	}
 
 	return &updateFavoriteStickersTemp
 }
 
+// MarshalJSON marshals to json
+func (updateFavoriteStickers *UpdateFavoriteStickers) MarshalJSON() ([]byte, error) {
+	return updateFavoriteStickers.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateFavoriteStickers *UpdateFavoriteStickers) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateFavoriteStickers)
+}
+
+func (updateFavoriteStickers *UpdateFavoriteStickers) appendJSON(b []byte) ([]byte, error) {
+	if updateFavoriteStickers == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateFavoriteStickers.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateFavoriteStickers.tdCommon.Extra)
+	b = append(b, `,"sticker_ids":`...)
+	if updateFavoriteStickers.StickerIds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range updateFavoriteStickers.StickerIds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(updateFavoriteStickers.StickerIds[i0]))
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (updateFavoriteStickers *UpdateFavoriteStickers) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateFavoriteStickers.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateFavoriteStickers.tdCommon.Extra, err = r.readString()
+		case "sticker_ids":
+			if r.readNull() {
+				updateFavoriteStickers.StickerIds = nil
+			} else {
+				updateFavoriteStickers.StickerIds = make([]int32, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int32
+					item0, err = r.readInt32()
+					updateFavoriteStickers.StickerIds = append(updateFavoriteStickers.StickerIds, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateFavoriteStickers *UpdateFavoriteStickers) Clone() *UpdateFavoriteStickers {
+	if updateFavoriteStickers == nil {
+		return nil
+	}
+	clone := *updateFavoriteStickers
+	if updateFavoriteStickers.StickerIds != nil {
+		clone.StickerIds = make([]int32, len(updateFavoriteStickers.StickerIds))
+		copy(clone.StickerIds, updateFavoriteStickers.StickerIds)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateFavoriteStickers *UpdateFavoriteStickers) Equal(other *UpdateFavoriteStickers) bool {
+	if updateFavoriteStickers == nil || other == nil {
+		return updateFavoriteStickers == other
+	}
+	if len(updateFavoriteStickers.StickerIds) != len(other.StickerIds) {
+		return false
+	}
+	for i0 := range updateFavoriteStickers.StickerIds {
+		if updateFavoriteStickers.StickerIds[i0] != other.StickerIds[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateFavoriteStickers *UpdateFavoriteStickers) Diff(other *UpdateFavoriteStickers) []Change {
+	return updateFavoriteStickers.diff("", other, nil)
+}
+
+func (updateFavoriteStickers *UpdateFavoriteStickers) diff(path string, other *UpdateFavoriteStickers, changes []Change) []Change {
+	if updateFavoriteStickers == nil || other == nil {
+		if updateFavoriteStickers != other {
+			changes = append(changes, Change{Path: path, Old: updateFavoriteStickers, New: other})
+		}
+		return changes
+	}
+	if len(updateFavoriteStickers.StickerIds) != len(other.StickerIds) {
+		changes = append(changes, Change{Path: fieldPath(path, "sticker_ids"), Old: updateFavoriteStickers.StickerIds, New: other.StickerIds})
+	} else {
+		for i0 := range updateFavoriteStickers.StickerIds {
+			if updateFavoriteStickers.StickerIds[i0] != other.StickerIds[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "sticker_ids"), i0), Old: updateFavoriteStickers.StickerIds[i0], New: other.StickerIds[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateFavoriteStickers *UpdateFavoriteStickers) String() string {
+	return objectString(updateFavoriteStickers)
+}
+
+// LogValue logs the object as its summary
+func (updateFavoriteStickers *UpdateFavoriteStickers) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateFavoriteStickers))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateFavoriteStickers *UpdateFavoriteStickers) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateFavoriteStickers)
+}
+
+func (updateFavoriteStickers *UpdateFavoriteStickers) printText(p *printer) {
+	if updateFavoriteStickers == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateFavoriteStickers")
+	p.vector("sticker_ids", len(updateFavoriteStickers.StickerIds), func(i0 int) {
+		p.int("sticker_ids", int64(updateFavoriteStickers.StickerIds[i0]))
+	})
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateFavoriteStickers *UpdateFavoriteStickers) GetUpdateEnum() UpdateEnum {
 	return UpdateFavoriteStickersType
 }
 
+// Accept calls the method of the visitor for UpdateFavoriteStickers
+func (updateFavoriteStickers *UpdateFavoriteStickers) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateFavoriteStickers(updateFavoriteStickers)
+}
+
 // UpdateSavedAnimations The list of saved animations was updated
 type UpdateSavedAnimations struct {
 	tdCommon
@@ -3173,11 +11808,156 @@ func NewUpdateSavedAnimations(animationIds []int32) *UpdateSavedAnimations {
 	return &updateSavedAnimationsTemp
 }
 
+// MarshalJSON marshals to json
+func (updateSavedAnimations *UpdateSavedAnimations) MarshalJSON() ([]byte, error) {
+	return updateSavedAnimations.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateSavedAnimations *UpdateSavedAnimations) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateSavedAnimations)
+}
+
+func (updateSavedAnimations *UpdateSavedAnimations) appendJSON(b []byte) ([]byte, error) {
+	if updateSavedAnimations == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateSavedAnimations.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateSavedAnimations.tdCommon.Extra)
+	b = append(b, `,"animation_ids":`...)
+	if updateSavedAnimations.AnimationIds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range updateSavedAnimations.AnimationIds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(updateSavedAnimations.AnimationIds[i0]))
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (updateSavedAnimations *UpdateSavedAnimations) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateSavedAnimations.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateSavedAnimations.tdCommon.Extra, err = r.readString()
+		case "animation_ids":
+			if r.readNull() {
+				updateSavedAnimations.AnimationIds = nil
+			} else {
+				updateSavedAnimations.AnimationIds = make([]int32, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int32
+					item0, err = r.readInt32()
+					updateSavedAnimations.AnimationIds = append(updateSavedAnimations.AnimationIds, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateSavedAnimations *UpdateSavedAnimations) Clone() *UpdateSavedAnimations {
+	if updateSavedAnimations == nil {
+		return nil
+	}
+	clone := *updateSavedAnimations
+	if updateSavedAnimations.AnimationIds != nil {
+		clone.AnimationIds = make([]int32, len(updateSavedAnimations.AnimationIds))
+		copy(clone.AnimationIds, updateSavedAnimations.AnimationIds)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateSavedAnimations *UpdateSavedAnimations) Equal(other *UpdateSavedAnimations) bool {
+	if updateSavedAnimations == nil || other == nil {
+		return updateSavedAnimations == other
+	}
+	if len(updateSavedAnimations.AnimationIds) != len(other.AnimationIds) {
+		return false
+	}
+	for i0 := range updateSavedAnimations.AnimationIds {
+		if updateSavedAnimations.AnimationIds[i0] != other.AnimationIds[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateSavedAnimations *UpdateSavedAnimations) Diff(other *UpdateSavedAnimations) []Change {
+	return updateSavedAnimations.diff("", other, nil)
+}
+
+func (updateSavedAnimations *UpdateSavedAnimations) diff(path string, other *UpdateSavedAnimations, changes []Change) []Change {
+	if updateSavedAnimations == nil || other == nil {
+		if updateSavedAnimations != other {
+			changes = append(changes, Change{Path: path, Old: updateSavedAnimations, New: other})
+		}
+		return changes
+	}
+	if len(updateSavedAnimations.AnimationIds) != len(other.AnimationIds) {
+		changes = append(changes, Change{Path: fieldPath(path, "animation_ids"), Old: updateSavedAnimations.AnimationIds, New: other.AnimationIds})
+	} else {
+		for i0 := range updateSavedAnimations.AnimationIds {
+			if updateSavedAnimations.AnimationIds[i0] != other.AnimationIds[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "animation_ids"), i0), Old: updateSavedAnimations.AnimationIds[i0], New: other.AnimationIds[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateSavedAnimations *UpdateSavedAnimations) String() string {
+	return objectString(updateSavedAnimations)
+}
+
+// LogValue logs the object as its summary
+func (updateSavedAnimations *UpdateSavedAnimations) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateSavedAnimations))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateSavedAnimations *UpdateSavedAnimations) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateSavedAnimations)
+}
+
+func (updateSavedAnimations *UpdateSavedAnimations) printText(p *printer) {
+	if updateSavedAnimations == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateSavedAnimations")
+	p.vector("animation_ids", len(updateSavedAnimations.AnimationIds), func(i0 int) {
+		p.int("animation_ids", int64(updateSavedAnimations.AnimationIds[i0]))
+	})
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateSavedAnimations *UpdateSavedAnimations) GetUpdateEnum() UpdateEnum {
 	return UpdateSavedAnimationsType
 }
 
+// Accept calls the method of the visitor for UpdateSavedAnimations
+func (updateSavedAnimations *UpdateSavedAnimations) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateSavedAnimations(updateSavedAnimations)
+}
+
 // UpdateSelectedBackground The selected background has changed
 type UpdateSelectedBackground struct {
 	tdCommon
@@ -3204,11 +11984,137 @@ func NewUpdateSelectedBackground(forDarkTheme bool, background *Background) *Upd
 	return &updateSelectedBackgroundTemp
 }
 
+// MarshalJSON marshals to json
+func (updateSelectedBackground *UpdateSelectedBackground) MarshalJSON() ([]byte, error) {
+	return updateSelectedBackground.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateSelectedBackground *UpdateSelectedBackground) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateSelectedBackground)
+}
+
+func (updateSelectedBackground *UpdateSelectedBackground) appendJSON(b []byte) ([]byte, error) {
+	if updateSelectedBackground == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateSelectedBackground.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateSelectedBackground.tdCommon.Extra)
+	b = append(b, `,"for_dark_theme":`...)
+	b = appendJSONBool(b, updateSelectedBackground.ForDarkTheme)
+	b = append(b, `,"background":`...)
+	b, err = updateSelectedBackground.Background.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateSelectedBackground *UpdateSelectedBackground) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateSelectedBackground.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateSelectedBackground.tdCommon.Extra, err = r.readString()
+		case "for_dark_theme":
+			updateSelectedBackground.ForDarkTheme, err = r.readBool()
+		case "background":
+			if r.readNull() {
+				updateSelectedBackground.Background = nil
+			} else {
+				updateSelectedBackground.Background = new(Background)
+				err = updateSelectedBackground.Background.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateSelectedBackground *UpdateSelectedBackground) Clone() *UpdateSelectedBackground {
+	if updateSelectedBackground == nil {
+		return nil
+	}
+	clone := *updateSelectedBackground
+	clone.Background = updateSelectedBackground.Background.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateSelectedBackground *UpdateSelectedBackground) Equal(other *UpdateSelectedBackground) bool {
+	if updateSelectedBackground == nil || other == nil {
+		return updateSelectedBackground == other
+	}
+	if updateSelectedBackground.ForDarkTheme != other.ForDarkTheme {
+		return false
+	}
+	if !updateSelectedBackground.Background.Equal(other.Background) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateSelectedBackground *UpdateSelectedBackground) Diff(other *UpdateSelectedBackground) []Change {
+	return updateSelectedBackground.diff("", other, nil)
+}
+
+func (updateSelectedBackground *UpdateSelectedBackground) diff(path string, other *UpdateSelectedBackground, changes []Change) []Change {
+	if updateSelectedBackground == nil || other == nil {
+		if updateSelectedBackground != other {
+			changes = append(changes, Change{Path: path, Old: updateSelectedBackground, New: other})
+		}
+		return changes
+	}
+	if updateSelectedBackground.ForDarkTheme != other.ForDarkTheme {
+		changes = append(changes, Change{Path: fieldPath(path, "for_dark_theme"), Old: updateSelectedBackground.ForDarkTheme, New: other.ForDarkTheme})
+	}
+	changes = updateSelectedBackground.Background.diff(fieldPath(path, "background"), other.Background, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateSelectedBackground *UpdateSelectedBackground) String() string {
+	return objectString(updateSelectedBackground)
+}
+
+// LogValue logs the object as its summary
+func (updateSelectedBackground *UpdateSelectedBackground) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateSelectedBackground))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateSelectedBackground *UpdateSelectedBackground) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateSelectedBackground)
+}
+
+func (updateSelectedBackground *UpdateSelectedBackground) printText(p *printer) {
+	if updateSelectedBackground == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateSelectedBackground")
+	p.bool("for_dark_theme", updateSelectedBackground.ForDarkTheme)
+	p.object("background", updateSelectedBackground.Background)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateSelectedBackground *UpdateSelectedBackground) GetUpdateEnum() UpdateEnum {
 	return UpdateSelectedBackgroundType
 }
 
+// Accept calls the method of the visitor for UpdateSelectedBackground
+func (updateSelectedBackground *UpdateSelectedBackground) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateSelectedBackground(updateSelectedBackground)
+}
+
 // UpdateChatThemes The list of available chat themes has changed
 type UpdateChatThemes struct {
 	tdCommon
@@ -3232,11 +12138,160 @@ func NewUpdateChatThemes(chatThemes []ChatTheme) *UpdateChatThemes {
 	return &updateChatThemesTemp
 }
 
+// MarshalJSON marshals to json
+func (updateChatThemes *UpdateChatThemes) MarshalJSON() ([]byte, error) {
+	return updateChatThemes.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateChatThemes *UpdateChatThemes) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateChatThemes)
+}
+
+func (updateChatThemes *UpdateChatThemes) appendJSON(b []byte) ([]byte, error) {
+	if updateChatThemes == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateChatThemes.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateChatThemes.tdCommon.Extra)
+	b = append(b, `,"chat_themes":`...)
+	if updateChatThemes.ChatThemes == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range updateChatThemes.ChatThemes {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = updateChatThemes.ChatThemes[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (updateChatThemes *UpdateChatThemes) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateChatThemes.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateChatThemes.tdCommon.Extra, err = r.readString()
+		case "chat_themes":
+			if r.readNull() {
+				updateChatThemes.ChatThemes = nil
+			} else {
+				updateChatThemes.ChatThemes = make([]ChatTheme, 0)
+				err = r.readArray(func() (err error) {
+					var item0 ChatTheme
+					err = item0.decodeJSON(r)
+					updateChatThemes.ChatThemes = append(updateChatThemes.ChatThemes, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateChatThemes *UpdateChatThemes) Clone() *UpdateChatThemes {
+	if updateChatThemes == nil {
+		return nil
+	}
+	clone := *updateChatThemes
+	if updateChatThemes.ChatThemes != nil {
+		clone.ChatThemes = make([]ChatTheme, len(updateChatThemes.ChatThemes))
+		for i0 := range updateChatThemes.ChatThemes {
+			clone.ChatThemes[i0] = *updateChatThemes.ChatThemes[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateChatThemes *UpdateChatThemes) Equal(other *UpdateChatThemes) bool {
+	if updateChatThemes == nil || other == nil {
+		return updateChatThemes == other
+	}
+	if len(updateChatThemes.ChatThemes) != len(other.ChatThemes) {
+		return false
+	}
+	for i0 := range updateChatThemes.ChatThemes {
+		if !updateChatThemes.ChatThemes[i0].Equal(&other.ChatThemes[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateChatThemes *UpdateChatThemes) Diff(other *UpdateChatThemes) []Change {
+	return updateChatThemes.diff("", other, nil)
+}
+
+func (updateChatThemes *UpdateChatThemes) diff(path string, other *UpdateChatThemes, changes []Change) []Change {
+	if updateChatThemes == nil || other == nil {
+		if updateChatThemes != other {
+			changes = append(changes, Change{Path: path, Old: updateChatThemes, New: other})
+		}
+		return changes
+	}
+	if len(updateChatThemes.ChatThemes) != len(other.ChatThemes) {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_themes"), Old: updateChatThemes.ChatThemes, New: other.ChatThemes})
+	} else {
+		for i0 := range updateChatThemes.ChatThemes {
+			changes = updateChatThemes.ChatThemes[i0].diff(indexPath(fieldPath(path, "chat_themes"), i0), &other.ChatThemes[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateChatThemes *UpdateChatThemes) String() string {
+	return objectString(updateChatThemes)
+}
+
+// LogValue logs the object as its summary
+func (updateChatThemes *UpdateChatThemes) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateChatThemes))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateChatThemes *UpdateChatThemes) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateChatThemes)
+}
+
+func (updateChatThemes *UpdateChatThemes) printText(p *printer) {
+	if updateChatThemes == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateChatThemes")
+	p.vector("chat_themes", len(updateChatThemes.ChatThemes), func(i0 int) {
+		updateChatThemes.ChatThemes[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateChatThemes *UpdateChatThemes) GetUpdateEnum() UpdateEnum {
 	return UpdateChatThemesType
 }
 
+// Accept calls the method of the visitor for UpdateChatThemes
+func (updateChatThemes *UpdateChatThemes) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateChatThemes(updateChatThemes)
+}
+
 // UpdateLanguagePackStrings Some language pack strings have been updated
 type UpdateLanguagePackStrings struct {
 	tdCommon
@@ -3266,11 +12321,182 @@ func NewUpdateLanguagePackStrings(localizationTarget string, languagePackId stri
 	return &updateLanguagePackStringsTemp
 }
 
+// MarshalJSON marshals to json
+func (updateLanguagePackStrings *UpdateLanguagePackStrings) MarshalJSON() ([]byte, error) {
+	return updateLanguagePackStrings.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateLanguagePackStrings *UpdateLanguagePackStrings) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateLanguagePackStrings)
+}
+
+func (updateLanguagePackStrings *UpdateLanguagePackStrings) appendJSON(b []byte) ([]byte, error) {
+	if updateLanguagePackStrings == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateLanguagePackStrings.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateLanguagePackStrings.tdCommon.Extra)
+	b = append(b, `,"localization_target":`...)
+	b = appendJSONString(b, updateLanguagePackStrings.LocalizationTarget)
+	b = append(b, `,"language_pack_id":`...)
+	b = appendJSONString(b, updateLanguagePackStrings.LanguagePackId)
+	b = append(b, `,"strings":`...)
+	if updateLanguagePackStrings.Strings == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range updateLanguagePackStrings.Strings {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = updateLanguagePackStrings.Strings[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (updateLanguagePackStrings *UpdateLanguagePackStrings) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateLanguagePackStrings.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateLanguagePackStrings.tdCommon.Extra, err = r.readString()
+		case "localization_target":
+			updateLanguagePackStrings.LocalizationTarget, err = r.readString()
+		case "language_pack_id":
+			updateLanguagePackStrings.LanguagePackId, err = r.readString()
+		case "strings":
+			if r.readNull() {
+				updateLanguagePackStrings.Strings = nil
+			} else {
+				updateLanguagePackStrings.Strings = make([]LanguagePackString, 0)
+				err = r.readArray(func() (err error) {
+					var item0 LanguagePackString
+					err = item0.decodeJSON(r)
+					updateLanguagePackStrings.Strings = append(updateLanguagePackStrings.Strings, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateLanguagePackStrings *UpdateLanguagePackStrings) Clone() *UpdateLanguagePackStrings {
+	if updateLanguagePackStrings == nil {
+		return nil
+	}
+	clone := *updateLanguagePackStrings
+	if updateLanguagePackStrings.Strings != nil {
+		clone.Strings = make([]LanguagePackString, len(updateLanguagePackStrings.Strings))
+		for i0 := range updateLanguagePackStrings.Strings {
+			clone.Strings[i0] = *updateLanguagePackStrings.Strings[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateLanguagePackStrings *UpdateLanguagePackStrings) Equal(other *UpdateLanguagePackStrings) bool {
+	if updateLanguagePackStrings == nil || other == nil {
+		return updateLanguagePackStrings == other
+	}
+	if updateLanguagePackStrings.LocalizationTarget != other.LocalizationTarget {
+		return false
+	}
+	if updateLanguagePackStrings.LanguagePackId != other.LanguagePackId {
+		return false
+	}
+	if len(updateLanguagePackStrings.Strings) != len(other.Strings) {
+		return false
+	}
+	for i0 := range updateLanguagePackStrings.Strings {
+		if !updateLanguagePackStrings.Strings[i0].Equal(&other.Strings[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateLanguagePackStrings *UpdateLanguagePackStrings) Diff(other *UpdateLanguagePackStrings) []Change {
+	return updateLanguagePackStrings.diff("", other, nil)
+}
+
+func (updateLanguagePackStrings *UpdateLanguagePackStrings) diff(path string, other *UpdateLanguagePackStrings, changes []Change) []Change {
+	if updateLanguagePackStrings == nil || other == nil {
+		if updateLanguagePackStrings != other {
+			changes = append(changes, Change{Path: path, Old: updateLanguagePackStrings, New: other})
+		}
+		return changes
+	}
+	if updateLanguagePackStrings.LocalizationTarget != other.LocalizationTarget {
+		changes = append(changes, Change{Path: fieldPath(path, "localization_target"), Old: updateLanguagePackStrings.LocalizationTarget, New: other.LocalizationTarget})
+	}
+	if updateLanguagePackStrings.LanguagePackId != other.LanguagePackId {
+		changes = append(changes, Change{Path: fieldPath(path, "language_pack_id"), Old: updateLanguagePackStrings.LanguagePackId, New: other.LanguagePackId})
+	}
+	if len(updateLanguagePackStrings.Strings) != len(other.Strings) {
+		changes = append(changes, Change{Path: fieldPath(path, "strings"), Old: updateLanguagePackStrings.Strings, New: other.Strings})
+	} else {
+		for i0 := range updateLanguagePackStrings.Strings {
+			changes = updateLanguagePackStrings.Strings[i0].diff(indexPath(fieldPath(path, "strings"), i0), &other.Strings[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateLanguagePackStrings *UpdateLanguagePackStrings) String() string {
+	return objectString(updateLanguagePackStrings)
+}
+
+// LogValue logs the object as its summary
+func (updateLanguagePackStrings *UpdateLanguagePackStrings) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateLanguagePackStrings))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateLanguagePackStrings *UpdateLanguagePackStrings) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateLanguagePackStrings)
+}
+
+func (updateLanguagePackStrings *UpdateLanguagePackStrings) printText(p *printer) {
+	if updateLanguagePackStrings == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateLanguagePackStrings")
+	p.string("localization_target", updateLanguagePackStrings.LocalizationTarget)
+	p.string("language_pack_id", updateLanguagePackStrings.LanguagePackId)
+	p.vector("strings", len(updateLanguagePackStrings.Strings), func(i0 int) {
+		updateLanguagePackStrings.Strings[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateLanguagePackStrings *UpdateLanguagePackStrings) GetUpdateEnum() UpdateEnum {
 	return UpdateLanguagePackStringsType
 }
 
+// Accept calls the method of the visitor for UpdateLanguagePackStrings
+func (updateLanguagePackStrings *UpdateLanguagePackStrings) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateLanguagePackStrings(updateLanguagePackStrings)
+}
+
 // UpdateConnectionState The connection state has changed. This update must be used only to show a human-readable description of the connection state
 type UpdateConnectionState struct {
 	tdCommon
@@ -3294,27 +12520,109 @@ func NewUpdateConnectionState(state ConnectionState) *UpdateConnectionState {
 	return &updateConnectionStateTemp
 }
 
+// MarshalJSON marshals to json
+func (updateConnectionState *UpdateConnectionState) MarshalJSON() ([]byte, error) {
+	return updateConnectionState.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (updateConnectionState *UpdateConnectionState) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
+	return unmarshalFast(b, updateConnectionState)
+}
+
+func (updateConnectionState *UpdateConnectionState) appendJSON(b []byte) ([]byte, error) {
+	if updateConnectionState == nil {
+		return append(b, "null"...), nil
 	}
-	tempObj := struct {
-		tdCommon
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateConnectionState.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateConnectionState.tdCommon.Extra)
+	b = append(b, `,"state":`...)
+	b, err = appendJSONValue(b, updateConnectionState.State)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return append(b, '}'), nil
+}
+
+func (updateConnectionState *UpdateConnectionState) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateConnectionState.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateConnectionState.tdCommon.Extra, err = r.readString()
+		case "state":
+			updateConnectionState.State, err = decodeConnectionState(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	updateConnectionState.tdCommon = tempObj.tdCommon
+// Clone returns a deep copy of the object
+func (updateConnectionState *UpdateConnectionState) Clone() *UpdateConnectionState {
+	if updateConnectionState == nil {
+		return nil
+	}
+	clone := *updateConnectionState
+	clone.State = cloneConnectionState(updateConnectionState.State)
+	return &clone
+}
 
-	fieldState, _ := unmarshalConnectionState(objMap["state"])
-	updateConnectionState.State = fieldState
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateConnectionState *UpdateConnectionState) Equal(other *UpdateConnectionState) bool {
+	if updateConnectionState == nil || other == nil {
+		return updateConnectionState == other
+	}
+	if !equalConnectionState(updateConnectionState.State, other.State) {
+		return false
+	}
+	return true
+}
 
-	return nil
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateConnectionState *UpdateConnectionState) Diff(other *UpdateConnectionState) []Change {
+	return updateConnectionState.diff("", other, nil)
+}
+
+func (updateConnectionState *UpdateConnectionState) diff(path string, other *UpdateConnectionState, changes []Change) []Change {
+	if updateConnectionState == nil || other == nil {
+		if updateConnectionState != other {
+			changes = append(changes, Change{Path: path, Old: updateConnectionState, New: other})
+		}
+		return changes
+	}
+	changes = diffConnectionState(fieldPath(path, "state"), updateConnectionState.State, other.State, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateConnectionState *UpdateConnectionState) String() string {
+	return objectString(updateConnectionState)
+}
+
+// LogValue logs the object as its summary
+func (updateConnectionState *UpdateConnectionState) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateConnectionState))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateConnectionState *UpdateConnectionState) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateConnectionState)
+}
+
+func (updateConnectionState *UpdateConnectionState) printText(p *printer) {
+	if updateConnectionState == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateConnectionState")
+	p.object("state", updateConnectionState.State)
+	p.endObject(state)
 }
 
 // GetUpdateEnum return the enum type of this object
@@ -3322,6 +12630,11 @@ func (updateConnectionState *UpdateConnectionState) GetUpdateEnum() UpdateEnum {
 	return UpdateConnectionStateType
 }
 
+// Accept calls the method of the visitor for UpdateConnectionState
+func (updateConnectionState *UpdateConnectionState) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateConnectionState(updateConnectionState)
+}
+
 // UpdateTermsOfService New terms of service must be accepted by the user. If the terms of service are declined, then the deleteAccount method must be called with the reason "Decline ToS update"
 type UpdateTermsOfService struct {
 	tdCommon
@@ -3348,11 +12661,137 @@ func NewUpdateTermsOfService(termsOfServiceId string, termsOfService *TermsOfSer
 	return &updateTermsOfServiceTemp
 }
 
+// MarshalJSON marshals to json
+func (updateTermsOfService *UpdateTermsOfService) MarshalJSON() ([]byte, error) {
+	return updateTermsOfService.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateTermsOfService *UpdateTermsOfService) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateTermsOfService)
+}
+
+func (updateTermsOfService *UpdateTermsOfService) appendJSON(b []byte) ([]byte, error) {
+	if updateTermsOfService == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateTermsOfService.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateTermsOfService.tdCommon.Extra)
+	b = append(b, `,"terms_of_service_id":`...)
+	b = appendJSONString(b, updateTermsOfService.TermsOfServiceId)
+	b = append(b, `,"terms_of_service":`...)
+	b, err = updateTermsOfService.TermsOfService.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateTermsOfService *UpdateTermsOfService) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateTermsOfService.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateTermsOfService.tdCommon.Extra, err = r.readString()
+		case "terms_of_service_id":
+			updateTermsOfService.TermsOfServiceId, err = r.readString()
+		case "terms_of_service":
+			if r.readNull() {
+				updateTermsOfService.TermsOfService = nil
+			} else {
+				updateTermsOfService.TermsOfService = new(TermsOfService)
+				err = updateTermsOfService.TermsOfService.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateTermsOfService *UpdateTermsOfService) Clone() *UpdateTermsOfService {
+	if updateTermsOfService == nil {
+		return nil
+	}
+	clone := *updateTermsOfService
+	clone.TermsOfService = updateTermsOfService.TermsOfService.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateTermsOfService *UpdateTermsOfService) Equal(other *UpdateTermsOfService) bool {
+	if updateTermsOfService == nil || other == nil {
+		return updateTermsOfService == other
+	}
+	if updateTermsOfService.TermsOfServiceId != other.TermsOfServiceId {
+		return false
+	}
+	if !updateTermsOfService.TermsOfService.Equal(other.TermsOfService) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateTermsOfService *UpdateTermsOfService) Diff(other *UpdateTermsOfService) []Change {
+	return updateTermsOfService.diff("", other, nil)
+}
+
+func (updateTermsOfService *UpdateTermsOfService) diff(path string, other *UpdateTermsOfService, changes []Change) []Change {
+	if updateTermsOfService == nil || other == nil {
+		if updateTermsOfService != other {
+			changes = append(changes, Change{Path: path, Old: updateTermsOfService, New: other})
+		}
+		return changes
+	}
+	if updateTermsOfService.TermsOfServiceId != other.TermsOfServiceId {
+		changes = append(changes, Change{Path: fieldPath(path, "terms_of_service_id"), Old: updateTermsOfService.TermsOfServiceId, New: other.TermsOfServiceId})
+	}
+	changes = updateTermsOfService.TermsOfService.diff(fieldPath(path, "terms_of_service"), other.TermsOfService, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateTermsOfService *UpdateTermsOfService) String() string {
+	return objectString(updateTermsOfService)
+}
+
+// LogValue logs the object as its summary
+func (updateTermsOfService *UpdateTermsOfService) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateTermsOfService))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateTermsOfService *UpdateTermsOfService) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateTermsOfService)
+}
+
+func (updateTermsOfService *UpdateTermsOfService) printText(p *printer) {
+	if updateTermsOfService == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateTermsOfService")
+	p.string("terms_of_service_id", updateTermsOfService.TermsOfServiceId)
+	p.object("terms_of_service", updateTermsOfService.TermsOfService)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateTermsOfService *UpdateTermsOfService) GetUpdateEnum() UpdateEnum {
 	return UpdateTermsOfServiceType
 }
 
+// Accept calls the method of the visitor for UpdateTermsOfService
+func (updateTermsOfService *UpdateTermsOfService) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateTermsOfService(updateTermsOfService)
+}
+
 // UpdateUsersNearby The list of users nearby has changed. The update is guaranteed to be sent only 60 seconds after a successful searchChatsNearby request
 type UpdateUsersNearby struct {
 	tdCommon
@@ -3376,11 +12815,160 @@ func NewUpdateUsersNearby(usersNearby []ChatNearby) *UpdateUsersNearby {
 	return &updateUsersNearbyTemp
 }
 
+// MarshalJSON marshals to json
+func (updateUsersNearby *UpdateUsersNearby) MarshalJSON() ([]byte, error) {
+	return updateUsersNearby.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateUsersNearby *UpdateUsersNearby) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateUsersNearby)
+}
+
+func (updateUsersNearby *UpdateUsersNearby) appendJSON(b []byte) ([]byte, error) {
+	if updateUsersNearby == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateUsersNearby.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateUsersNearby.tdCommon.Extra)
+	b = append(b, `,"users_nearby":`...)
+	if updateUsersNearby.UsersNearby == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range updateUsersNearby.UsersNearby {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = updateUsersNearby.UsersNearby[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (updateUsersNearby *UpdateUsersNearby) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateUsersNearby.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateUsersNearby.tdCommon.Extra, err = r.readString()
+		case "users_nearby":
+			if r.readNull() {
+				updateUsersNearby.UsersNearby = nil
+			} else {
+				updateUsersNearby.UsersNearby = make([]ChatNearby, 0)
+				err = r.readArray(func() (err error) {
+					var item0 ChatNearby
+					err = item0.decodeJSON(r)
+					updateUsersNearby.UsersNearby = append(updateUsersNearby.UsersNearby, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateUsersNearby *UpdateUsersNearby) Clone() *UpdateUsersNearby {
+	if updateUsersNearby == nil {
+		return nil
+	}
+	clone := *updateUsersNearby
+	if updateUsersNearby.UsersNearby != nil {
+		clone.UsersNearby = make([]ChatNearby, len(updateUsersNearby.UsersNearby))
+		for i0 := range updateUsersNearby.UsersNearby {
+			clone.UsersNearby[i0] = *updateUsersNearby.UsersNearby[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateUsersNearby *UpdateUsersNearby) Equal(other *UpdateUsersNearby) bool {
+	if updateUsersNearby == nil || other == nil {
+		return updateUsersNearby == other
+	}
+	if len(updateUsersNearby.UsersNearby) != len(other.UsersNearby) {
+		return false
+	}
+	for i0 := range updateUsersNearby.UsersNearby {
+		if !updateUsersNearby.UsersNearby[i0].Equal(&other.UsersNearby[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateUsersNearby *UpdateUsersNearby) Diff(other *UpdateUsersNearby) []Change {
+	return updateUsersNearby.diff("", other, nil)
+}
+
+func (updateUsersNearby *UpdateUsersNearby) diff(path string, other *UpdateUsersNearby, changes []Change) []Change {
+	if updateUsersNearby == nil || other == nil {
+		if updateUsersNearby != other {
+			changes = append(changes, Change{Path: path, Old: updateUsersNearby, New: other})
+		}
+		return changes
+	}
+	if len(updateUsersNearby.UsersNearby) != len(other.UsersNearby) {
+		changes = append(changes, Change{Path: fieldPath(path, "users_nearby"), Old: updateUsersNearby.UsersNearby, New: other.UsersNearby})
+	} else {
+		for i0 := range updateUsersNearby.UsersNearby {
+			changes = updateUsersNearby.UsersNearby[i0].diff(indexPath(fieldPath(path, "users_nearby"), i0), &other.UsersNearby[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateUsersNearby *UpdateUsersNearby) String() string {
+	return objectString(updateUsersNearby)
+}
+
+// LogValue logs the object as its summary
+func (updateUsersNearby *UpdateUsersNearby) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateUsersNearby))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateUsersNearby *UpdateUsersNearby) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateUsersNearby)
+}
+
+func (updateUsersNearby *UpdateUsersNearby) printText(p *printer) {
+	if updateUsersNearby == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateUsersNearby")
+	p.vector("users_nearby", len(updateUsersNearby.UsersNearby), func(i0 int) {
+		updateUsersNearby.UsersNearby[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateUsersNearby *UpdateUsersNearby) GetUpdateEnum() UpdateEnum {
 	return UpdateUsersNearbyType
 }
 
+// Accept calls the method of the visitor for UpdateUsersNearby
+func (updateUsersNearby *UpdateUsersNearby) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateUsersNearby(updateUsersNearby)
+}
+
 // UpdateDiceEmojis The list of supported dice emojis has changed
 type UpdateDiceEmojis struct {
 	tdCommon
@@ -3404,11 +12992,156 @@ func NewUpdateDiceEmojis(emojis []string) *UpdateDiceEmojis {
 	return &updateDiceEmojisTemp
 }
 
+// MarshalJSON marshals to json
+func (updateDiceEmojis *UpdateDiceEmojis) MarshalJSON() ([]byte, error) {
+	return updateDiceEmojis.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateDiceEmojis *UpdateDiceEmojis) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateDiceEmojis)
+}
+
+func (updateDiceEmojis *UpdateDiceEmojis) appendJSON(b []byte) ([]byte, error) {
+	if updateDiceEmojis == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateDiceEmojis.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateDiceEmojis.tdCommon.Extra)
+	b = append(b, `,"emojis":`...)
+	if updateDiceEmojis.Emojis == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range updateDiceEmojis.Emojis {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONString(b, updateDiceEmojis.Emojis[i0])
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (updateDiceEmojis *UpdateDiceEmojis) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateDiceEmojis.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateDiceEmojis.tdCommon.Extra, err = r.readString()
+		case "emojis":
+			if r.readNull() {
+				updateDiceEmojis.Emojis = nil
+			} else {
+				updateDiceEmojis.Emojis = make([]string, 0)
+				err = r.readArray(func() (err error) {
+					var item0 string
+					item0, err = r.readString()
+					updateDiceEmojis.Emojis = append(updateDiceEmojis.Emojis, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateDiceEmojis *UpdateDiceEmojis) Clone() *UpdateDiceEmojis {
+	if updateDiceEmojis == nil {
+		return nil
+	}
+	clone := *updateDiceEmojis
+	if updateDiceEmojis.Emojis != nil {
+		clone.Emojis = make([]string, len(updateDiceEmojis.Emojis))
+		copy(clone.Emojis, updateDiceEmojis.Emojis)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateDiceEmojis *UpdateDiceEmojis) Equal(other *UpdateDiceEmojis) bool {
+	if updateDiceEmojis == nil || other == nil {
+		return updateDiceEmojis == other
+	}
+	if len(updateDiceEmojis.Emojis) != len(other.Emojis) {
+		return false
+	}
+	for i0 := range updateDiceEmojis.Emojis {
+		if updateDiceEmojis.Emojis[i0] != other.Emojis[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateDiceEmojis *UpdateDiceEmojis) Diff(other *UpdateDiceEmojis) []Change {
+	return updateDiceEmojis.diff("", other, nil)
+}
+
+func (updateDiceEmojis *UpdateDiceEmojis) diff(path string, other *UpdateDiceEmojis, changes []Change) []Change {
+	if updateDiceEmojis == nil || other == nil {
+		if updateDiceEmojis != other {
+			changes = append(changes, Change{Path: path, Old: updateDiceEmojis, New: other})
+		}
+		return changes
+	}
+	if len(updateDiceEmojis.Emojis) != len(other.Emojis) {
+		changes = append(changes, Change{Path: fieldPath(path, "emojis"), Old: updateDiceEmojis.Emojis, New: other.Emojis})
+	} else {
+		for i0 := range updateDiceEmojis.Emojis {
+			if updateDiceEmojis.Emojis[i0] != other.Emojis[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "emojis"), i0), Old: updateDiceEmojis.Emojis[i0], New: other.Emojis[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateDiceEmojis *UpdateDiceEmojis) String() string {
+	return objectString(updateDiceEmojis)
+}
+
+// LogValue logs the object as its summary
+func (updateDiceEmojis *UpdateDiceEmojis) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateDiceEmojis))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateDiceEmojis *UpdateDiceEmojis) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateDiceEmojis)
+}
+
+func (updateDiceEmojis *UpdateDiceEmojis) printText(p *printer) {
+	if updateDiceEmojis == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateDiceEmojis")
+	p.vector("emojis", len(updateDiceEmojis.Emojis), func(i0 int) {
+		p.string("emojis", updateDiceEmojis.Emojis[i0])
+	})
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateDiceEmojis *UpdateDiceEmojis) GetUpdateEnum() UpdateEnum {
 	return UpdateDiceEmojisType
 }
 
+// Accept calls the method of the visitor for UpdateDiceEmojis
+func (updateDiceEmojis *UpdateDiceEmojis) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateDiceEmojis(updateDiceEmojis)
+}
+
 // UpdateAnimatedEmojiMessageClicked Some animated emoji message was clicked and a big animated sticker must be played if the message is visible on the screen. chatActionWatchingAnimations with the text of the message needs to be sent if the sticker is played
 type UpdateAnimatedEmojiMessageClicked struct {
 	tdCommon
@@ -3438,11 +13171,148 @@ func NewUpdateAnimatedEmojiMessageClicked(chatId int64, messageId int64, sticker
 	return &updateAnimatedEmojiMessageClickedTemp
 }
 
+// MarshalJSON marshals to json
+func (updateAnimatedEmojiMessageClicked *UpdateAnimatedEmojiMessageClicked) MarshalJSON() ([]byte, error) {
+	return updateAnimatedEmojiMessageClicked.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateAnimatedEmojiMessageClicked *UpdateAnimatedEmojiMessageClicked) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateAnimatedEmojiMessageClicked)
+}
+
+func (updateAnimatedEmojiMessageClicked *UpdateAnimatedEmojiMessageClicked) appendJSON(b []byte) ([]byte, error) {
+	if updateAnimatedEmojiMessageClicked == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateAnimatedEmojiMessageClicked.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateAnimatedEmojiMessageClicked.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateAnimatedEmojiMessageClicked.ChatId))
+	b = append(b, `,"message_id":`...)
+	b = appendJSONInt(b, int64(updateAnimatedEmojiMessageClicked.MessageId))
+	b = append(b, `,"sticker":`...)
+	b, err = updateAnimatedEmojiMessageClicked.Sticker.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateAnimatedEmojiMessageClicked *UpdateAnimatedEmojiMessageClicked) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateAnimatedEmojiMessageClicked.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateAnimatedEmojiMessageClicked.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateAnimatedEmojiMessageClicked.ChatId, err = r.readInt64()
+		case "message_id":
+			updateAnimatedEmojiMessageClicked.MessageId, err = r.readInt64()
+		case "sticker":
+			if r.readNull() {
+				updateAnimatedEmojiMessageClicked.Sticker = nil
+			} else {
+				updateAnimatedEmojiMessageClicked.Sticker = new(Sticker)
+				err = updateAnimatedEmojiMessageClicked.Sticker.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateAnimatedEmojiMessageClicked *UpdateAnimatedEmojiMessageClicked) Clone() *UpdateAnimatedEmojiMessageClicked {
+	if updateAnimatedEmojiMessageClicked == nil {
+		return nil
+	}
+	clone := *updateAnimatedEmojiMessageClicked
+	clone.Sticker = updateAnimatedEmojiMessageClicked.Sticker.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateAnimatedEmojiMessageClicked *UpdateAnimatedEmojiMessageClicked) Equal(other *UpdateAnimatedEmojiMessageClicked) bool {
+	if updateAnimatedEmojiMessageClicked == nil || other == nil {
+		return updateAnimatedEmojiMessageClicked == other
+	}
+	if updateAnimatedEmojiMessageClicked.ChatId != other.ChatId {
+		return false
+	}
+	if updateAnimatedEmojiMessageClicked.MessageId != other.MessageId {
+		return false
+	}
+	if !updateAnimatedEmojiMessageClicked.Sticker.Equal(other.Sticker) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateAnimatedEmojiMessageClicked *UpdateAnimatedEmojiMessageClicked) Diff(other *UpdateAnimatedEmojiMessageClicked) []Change {
+	return updateAnimatedEmojiMessageClicked.diff("", other, nil)
+}
+
+func (updateAnimatedEmojiMessageClicked *UpdateAnimatedEmojiMessageClicked) diff(path string, other *UpdateAnimatedEmojiMessageClicked, changes []Change) []Change {
+	if updateAnimatedEmojiMessageClicked == nil || other == nil {
+		if updateAnimatedEmojiMessageClicked != other {
+			changes = append(changes, Change{Path: path, Old: updateAnimatedEmojiMessageClicked, New: other})
+		}
+		return changes
+	}
+	if updateAnimatedEmojiMessageClicked.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateAnimatedEmojiMessageClicked.ChatId, New: other.ChatId})
+	}
+	if updateAnimatedEmojiMessageClicked.MessageId != other.MessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "message_id"), Old: updateAnimatedEmojiMessageClicked.MessageId, New: other.MessageId})
+	}
+	changes = updateAnimatedEmojiMessageClicked.Sticker.diff(fieldPath(path, "sticker"), other.Sticker, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateAnimatedEmojiMessageClicked *UpdateAnimatedEmojiMessageClicked) String() string {
+	return objectString(updateAnimatedEmojiMessageClicked)
+}
+
+// LogValue logs the object as its summary
+func (updateAnimatedEmojiMessageClicked *UpdateAnimatedEmojiMessageClicked) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateAnimatedEmojiMessageClicked))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateAnimatedEmojiMessageClicked *UpdateAnimatedEmojiMessageClicked) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateAnimatedEmojiMessageClicked)
+}
+
+func (updateAnimatedEmojiMessageClicked *UpdateAnimatedEmojiMessageClicked) printText(p *printer) {
+	if updateAnimatedEmojiMessageClicked == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateAnimatedEmojiMessageClicked")
+	p.int("chat_id", int64(updateAnimatedEmojiMessageClicked.ChatId))
+	p.int("message_id", int64(updateAnimatedEmojiMessageClicked.MessageId))
+	p.object("sticker", updateAnimatedEmojiMessageClicked.Sticker)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateAnimatedEmojiMessageClicked *UpdateAnimatedEmojiMessageClicked) GetUpdateEnum() UpdateEnum {
 	return UpdateAnimatedEmojiMessageClickedType
 }
 
+// Accept calls the method of the visitor for UpdateAnimatedEmojiMessageClicked
+func (updateAnimatedEmojiMessageClicked *UpdateAnimatedEmojiMessageClicked) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateAnimatedEmojiMessageClicked(updateAnimatedEmojiMessageClicked)
+}
+
 // UpdateAnimationSearchParameters The parameters of animation search through GetOption("animation_search_bot_username") bot has changed
 type UpdateAnimationSearchParameters struct {
 	tdCommon
@@ -3469,11 +13339,167 @@ func NewUpdateAnimationSearchParameters(provider string, emojis []string) *Updat
 	return &updateAnimationSearchParametersTemp
 }
 
+// MarshalJSON marshals to json
+func (updateAnimationSearchParameters *UpdateAnimationSearchParameters) MarshalJSON() ([]byte, error) {
+	return updateAnimationSearchParameters.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateAnimationSearchParameters *UpdateAnimationSearchParameters) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateAnimationSearchParameters)
+}
+
+func (updateAnimationSearchParameters *UpdateAnimationSearchParameters) appendJSON(b []byte) ([]byte, error) {
+	if updateAnimationSearchParameters == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateAnimationSearchParameters.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateAnimationSearchParameters.tdCommon.Extra)
+	b = append(b, `,"provider":`...)
+	b = appendJSONString(b, updateAnimationSearchParameters.Provider)
+	b = append(b, `,"emojis":`...)
+	if updateAnimationSearchParameters.Emojis == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range updateAnimationSearchParameters.Emojis {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONString(b, updateAnimationSearchParameters.Emojis[i0])
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (updateAnimationSearchParameters *UpdateAnimationSearchParameters) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateAnimationSearchParameters.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateAnimationSearchParameters.tdCommon.Extra, err = r.readString()
+		case "provider":
+			updateAnimationSearchParameters.Provider, err = r.readString()
+		case "emojis":
+			if r.readNull() {
+				updateAnimationSearchParameters.Emojis = nil
+			} else {
+				updateAnimationSearchParameters.Emojis = make([]string, 0)
+				err = r.readArray(func() (err error) {
+					var item0 string
+					item0, err = r.readString()
+					updateAnimationSearchParameters.Emojis = append(updateAnimationSearchParameters.Emojis, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateAnimationSearchParameters *UpdateAnimationSearchParameters) Clone() *UpdateAnimationSearchParameters {
+	if updateAnimationSearchParameters == nil {
+		return nil
+	}
+	clone := *updateAnimationSearchParameters
+	if updateAnimationSearchParameters.Emojis != nil {
+		clone.Emojis = make([]string, len(updateAnimationSearchParameters.Emojis))
+		copy(clone.Emojis, updateAnimationSearchParameters.Emojis)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateAnimationSearchParameters *UpdateAnimationSearchParameters) Equal(other *UpdateAnimationSearchParameters) bool {
+	if updateAnimationSearchParameters == nil || other == nil {
+		return updateAnimationSearchParameters == other
+	}
+	if updateAnimationSearchParameters.Provider != other.Provider {
+		return false
+	}
+	if len(updateAnimationSearchParameters.Emojis) != len(other.Emojis) {
+		return false
+	}
+	for i0 := range updateAnimationSearchParameters.Emojis {
+		if updateAnimationSearchParameters.Emojis[i0] != other.Emojis[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateAnimationSearchParameters *UpdateAnimationSearchParameters) Diff(other *UpdateAnimationSearchParameters) []Change {
+	return updateAnimationSearchParameters.diff("", other, nil)
+}
+
+func (updateAnimationSearchParameters *UpdateAnimationSearchParameters) diff(path string, other *UpdateAnimationSearchParameters, changes []Change) []Change {
+	if updateAnimationSearchParameters == nil || other == nil {
+		if updateAnimationSearchParameters != other {
+			changes = append(changes, Change{Path: path, Old: updateAnimationSearchParameters, New: other})
+		}
+		return changes
+	}
+	if updateAnimationSearchParameters.Provider != other.Provider {
+		changes = append(changes, Change{Path: fieldPath(path, "provider"), Old: updateAnimationSearchParameters.Provider, New: other.Provider})
+	}
+	if len(updateAnimationSearchParameters.Emojis) != len(other.Emojis) {
+		changes = append(changes, Change{Path: fieldPath(path, "emojis"), Old: updateAnimationSearchParameters.Emojis, New: other.Emojis})
+	} else {
+		for i0 := range updateAnimationSearchParameters.Emojis {
+			if updateAnimationSearchParameters.Emojis[i0] != other.Emojis[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "emojis"), i0), Old: updateAnimationSearchParameters.Emojis[i0], New: other.Emojis[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateAnimationSearchParameters *UpdateAnimationSearchParameters) String() string {
+	return objectString(updateAnimationSearchParameters)
+}
+
+// LogValue logs the object as its summary
+func (updateAnimationSearchParameters *UpdateAnimationSearchParameters) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateAnimationSearchParameters))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateAnimationSearchParameters *UpdateAnimationSearchParameters) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateAnimationSearchParameters)
+}
+
+func (updateAnimationSearchParameters *UpdateAnimationSearchParameters) printText(p *printer) {
+	if updateAnimationSearchParameters == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateAnimationSearchParameters")
+	p.string("provider", updateAnimationSearchParameters.Provider)
+	p.vector("emojis", len(updateAnimationSearchParameters.Emojis), func(i0 int) {
+		p.string("emojis", updateAnimationSearchParameters.Emojis[i0])
+	})
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateAnimationSearchParameters *UpdateAnimationSearchParameters) GetUpdateEnum() UpdateEnum {
 	return UpdateAnimationSearchParametersType
 }
 
+// Accept calls the method of the visitor for UpdateAnimationSearchParameters
+func (updateAnimationSearchParameters *UpdateAnimationSearchParameters) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateAnimationSearchParameters(updateAnimationSearchParameters)
+}
+
 // UpdateSuggestedActions The list of suggested to the user actions has changed
 type UpdateSuggestedActions struct {
 	tdCommon
@@ -3481,23 +13507,219 @@ type UpdateSuggestedActions struct {
 	RemovedActions []SuggestedAction `json:"removed_actions"` // Removed suggested actions
 }
 
-// MessageType return the string telegram-type of UpdateSuggestedActions
-func (updateSuggestedActions *UpdateSuggestedActions) MessageType() string {
-	return "updateSuggestedActions"
+// MessageType return the string telegram-type of UpdateSuggestedActions
+func (updateSuggestedActions *UpdateSuggestedActions) MessageType() string {
+	return "updateSuggestedActions"
+}
+
+// NewUpdateSuggestedActions creates a new UpdateSuggestedActions
+//
+// @param addedActions Added suggested actions
+// @param removedActions Removed suggested actions
+func NewUpdateSuggestedActions(addedActions []SuggestedAction, removedActions []SuggestedAction) *UpdateSuggestedActions {
+	updateSuggestedActionsTemp := UpdateSuggestedActions{
+		tdCommon:       tdCommon{Type: "updateSuggestedActions"},
+		AddedActions:   addedActions,
+		RemovedActions: removedActions,
+	}
+
+	return &updateSuggestedActionsTemp
+}
+
+// MarshalJSON marshals to json
+func (updateSuggestedActions *UpdateSuggestedActions) MarshalJSON() ([]byte, error) {
+	return updateSuggestedActions.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateSuggestedActions *UpdateSuggestedActions) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateSuggestedActions)
+}
+
+func (updateSuggestedActions *UpdateSuggestedActions) appendJSON(b []byte) ([]byte, error) {
+	if updateSuggestedActions == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateSuggestedActions.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateSuggestedActions.tdCommon.Extra)
+	b = append(b, `,"added_actions":`...)
+	if updateSuggestedActions.AddedActions == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range updateSuggestedActions.AddedActions {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = appendJSONValue(b, updateSuggestedActions.AddedActions[i0])
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"removed_actions":`...)
+	if updateSuggestedActions.RemovedActions == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range updateSuggestedActions.RemovedActions {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = appendJSONValue(b, updateSuggestedActions.RemovedActions[i0])
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (updateSuggestedActions *UpdateSuggestedActions) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateSuggestedActions.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateSuggestedActions.tdCommon.Extra, err = r.readString()
+		case "added_actions":
+			if r.readNull() {
+				updateSuggestedActions.AddedActions = nil
+			} else {
+				updateSuggestedActions.AddedActions = make([]SuggestedAction, 0)
+				err = r.readArray(func() (err error) {
+					var item0 SuggestedAction
+					item0, err = decodeSuggestedAction(r)
+					updateSuggestedActions.AddedActions = append(updateSuggestedActions.AddedActions, item0)
+					return
+				})
+			}
+		case "removed_actions":
+			if r.readNull() {
+				updateSuggestedActions.RemovedActions = nil
+			} else {
+				updateSuggestedActions.RemovedActions = make([]SuggestedAction, 0)
+				err = r.readArray(func() (err error) {
+					var item0 SuggestedAction
+					item0, err = decodeSuggestedAction(r)
+					updateSuggestedActions.RemovedActions = append(updateSuggestedActions.RemovedActions, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateSuggestedActions *UpdateSuggestedActions) Clone() *UpdateSuggestedActions {
+	if updateSuggestedActions == nil {
+		return nil
+	}
+	clone := *updateSuggestedActions
+	if updateSuggestedActions.AddedActions != nil {
+		clone.AddedActions = make([]SuggestedAction, len(updateSuggestedActions.AddedActions))
+		for i0 := range updateSuggestedActions.AddedActions {
+			clone.AddedActions[i0] = cloneSuggestedAction(updateSuggestedActions.AddedActions[i0])
+		}
+	}
+	if updateSuggestedActions.RemovedActions != nil {
+		clone.RemovedActions = make([]SuggestedAction, len(updateSuggestedActions.RemovedActions))
+		for i0 := range updateSuggestedActions.RemovedActions {
+			clone.RemovedActions[i0] = cloneSuggestedAction(updateSuggestedActions.RemovedActions[i0])
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateSuggestedActions *UpdateSuggestedActions) Equal(other *UpdateSuggestedActions) bool {
+	if updateSuggestedActions == nil || other == nil {
+		return updateSuggestedActions == other
+	}
+	if len(updateSuggestedActions.AddedActions) != len(other.AddedActions) {
+		return false
+	}
+	for i0 := range updateSuggestedActions.AddedActions {
+		if !equalSuggestedAction(updateSuggestedActions.AddedActions[i0], other.AddedActions[i0]) {
+			return false
+		}
+	}
+	if len(updateSuggestedActions.RemovedActions) != len(other.RemovedActions) {
+		return false
+	}
+	for i0 := range updateSuggestedActions.RemovedActions {
+		if !equalSuggestedAction(updateSuggestedActions.RemovedActions[i0], other.RemovedActions[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateSuggestedActions *UpdateSuggestedActions) Diff(other *UpdateSuggestedActions) []Change {
+	return updateSuggestedActions.diff("", other, nil)
+}
+
+func (updateSuggestedActions *UpdateSuggestedActions) diff(path string, other *UpdateSuggestedActions, changes []Change) []Change {
+	if updateSuggestedActions == nil || other == nil {
+		if updateSuggestedActions != other {
+			changes = append(changes, Change{Path: path, Old: updateSuggestedActions, New: other})
+		}
+		return changes
+	}
+	if len(updateSuggestedActions.AddedActions) != len(other.AddedActions) {
+		changes = append(changes, Change{Path: fieldPath(path, "added_actions"), Old: updateSuggestedActions.AddedActions, New: other.AddedActions})
+	} else {
+		for i0 := range updateSuggestedActions.AddedActions {
+			changes = diffSuggestedAction(indexPath(fieldPath(path, "added_actions"), i0), updateSuggestedActions.AddedActions[i0], other.AddedActions[i0], changes)
+		}
+	}
+	if len(updateSuggestedActions.RemovedActions) != len(other.RemovedActions) {
+		changes = append(changes, Change{Path: fieldPath(path, "removed_actions"), Old: updateSuggestedActions.RemovedActions, New: other.RemovedActions})
+	} else {
+		for i0 := range updateSuggestedActions.RemovedActions {
+			changes = diffSuggestedAction(indexPath(fieldPath(path, "removed_actions"), i0), updateSuggestedActions.RemovedActions[i0], other.RemovedActions[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateSuggestedActions *UpdateSuggestedActions) String() string {
+	return objectString(updateSuggestedActions)
+}
+
+// LogValue logs the object as its summary
+func (updateSuggestedActions *UpdateSuggestedActions) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateSuggestedActions))
 }
 
-// NewUpdateSuggestedActions creates a new UpdateSuggestedActions
-//
-// @param addedActions Added suggested actions
-// @param removedActions Removed suggested actions
-func NewUpdateSuggestedActions(addedActions []SuggestedAction, removedActions []SuggestedAction) *UpdateSuggestedActions {
-	updateSuggestedActionsTemp := UpdateSuggestedActions{
-		tdCommon:       tdCommon{Type: "updateSuggestedActions"},
-		AddedActions:   addedActions,
-		RemovedActions: removedActions,
-	}
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateSuggestedActions *UpdateSuggestedActions) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateSuggestedActions)
+}
 
-	return &updateSuggestedActionsTemp
+func (updateSuggestedActions *UpdateSuggestedActions) printText(p *printer) {
+	if updateSuggestedActions == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateSuggestedActions")
+	p.vector("added_actions", len(updateSuggestedActions.AddedActions), func(i0 int) {
+		p.object("added_actions", updateSuggestedActions.AddedActions[i0])
+	})
+	p.vector("removed_actions", len(updateSuggestedActions.RemovedActions), func(i0 int) {
+		p.object("removed_actions", updateSuggestedActions.RemovedActions[i0])
+	})
+	p.endObject(state)
 }
 
 // GetUpdateEnum return the enum type of this object
@@ -3505,6 +13727,11 @@ func (updateSuggestedActions *UpdateSuggestedActions) GetUpdateEnum() UpdateEnum
 	return UpdateSuggestedActionsType
 }
 
+// Accept calls the method of the visitor for UpdateSuggestedActions
+func (updateSuggestedActions *UpdateSuggestedActions) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateSuggestedActions(updateSuggestedActions)
+}
+
 // UpdateNewInlineQuery A new incoming inline query; for bots only
 type UpdateNewInlineQuery struct {
 	tdCommon
@@ -3543,37 +13770,171 @@ func NewUpdateNewInlineQuery(id JSONInt64, senderUserId int64, userLocation *Loc
 	return &updateNewInlineQueryTemp
 }
 
+// MarshalJSON marshals to json
+func (updateNewInlineQuery *UpdateNewInlineQuery) MarshalJSON() ([]byte, error) {
+	return updateNewInlineQuery.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (updateNewInlineQuery *UpdateNewInlineQuery) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, updateNewInlineQuery)
+}
+
+func (updateNewInlineQuery *UpdateNewInlineQuery) appendJSON(b []byte) ([]byte, error) {
+	if updateNewInlineQuery == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateNewInlineQuery.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateNewInlineQuery.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt64String(b, int64(updateNewInlineQuery.Id))
+	b = append(b, `,"sender_user_id":`...)
+	b = appendJSONInt(b, int64(updateNewInlineQuery.SenderUserId))
+	b = append(b, `,"user_location":`...)
+	b, err = updateNewInlineQuery.UserLocation.appendJSON(b)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Id           JSONInt64 `json:"id"`             // Unique query identifier
-		SenderUserId int64     `json:"sender_user_id"` // Identifier of the user who sent the query
-		UserLocation *Location `json:"user_location"`  // User location; may be null
-		Query        string    `json:"query"`          // Text of the query
-		Offset       string    `json:"offset"`         // Offset of the first entry to return
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"chat_type":`...)
+	b, err = appendJSONValue(b, updateNewInlineQuery.ChatType)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	b = append(b, `,"query":`...)
+	b = appendJSONString(b, updateNewInlineQuery.Query)
+	b = append(b, `,"offset":`...)
+	b = appendJSONString(b, updateNewInlineQuery.Offset)
+	return append(b, '}'), nil
+}
+
+func (updateNewInlineQuery *UpdateNewInlineQuery) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateNewInlineQuery.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateNewInlineQuery.tdCommon.Extra, err = r.readString()
+		case "id":
+			updateNewInlineQuery.Id, err = r.readJSONInt64()
+		case "sender_user_id":
+			updateNewInlineQuery.SenderUserId, err = r.readInt64()
+		case "user_location":
+			if r.readNull() {
+				updateNewInlineQuery.UserLocation = nil
+			} else {
+				updateNewInlineQuery.UserLocation = new(Location)
+				err = updateNewInlineQuery.UserLocation.decodeJSON(r)
+			}
+		case "chat_type":
+			updateNewInlineQuery.ChatType, err = decodeChatType(r)
+		case "query":
+			updateNewInlineQuery.Query, err = r.readString()
+		case "offset":
+			updateNewInlineQuery.Offset, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateNewInlineQuery *UpdateNewInlineQuery) Clone() *UpdateNewInlineQuery {
+	if updateNewInlineQuery == nil {
+		return nil
+	}
+	clone := *updateNewInlineQuery
+	clone.UserLocation = updateNewInlineQuery.UserLocation.Clone()
+	clone.ChatType = cloneChatType(updateNewInlineQuery.ChatType)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateNewInlineQuery *UpdateNewInlineQuery) Equal(other *UpdateNewInlineQuery) bool {
+	if updateNewInlineQuery == nil || other == nil {
+		return updateNewInlineQuery == other
+	}
+	if updateNewInlineQuery.Id != other.Id {
+		return false
+	}
+	if updateNewInlineQuery.SenderUserId != other.SenderUserId {
+		return false
+	}
+	if !updateNewInlineQuery.UserLocation.Equal(other.UserLocation) {
+		return false
+	}
+	if !equalChatType(updateNewInlineQuery.ChatType, other.ChatType) {
+		return false
+	}
+	if updateNewInlineQuery.Query != other.Query {
+		return false
+	}
+	if updateNewInlineQuery.Offset != other.Offset {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateNewInlineQuery *UpdateNewInlineQuery) Diff(other *UpdateNewInlineQuery) []Change {
+	return updateNewInlineQuery.diff("", other, nil)
+}
+
+func (updateNewInlineQuery *UpdateNewInlineQuery) diff(path string, other *UpdateNewInlineQuery, changes []Change) []Change {
+	if updateNewInlineQuery == nil || other == nil {
+		if updateNewInlineQuery != other {
+			changes = append(changes, Change{Path: path, Old: updateNewInlineQuery, New: other})
+		}
+		return changes
+	}
+	if updateNewInlineQuery.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: updateNewInlineQuery.Id, New: other.Id})
 	}
+	if updateNewInlineQuery.SenderUserId != other.SenderUserId {
+		changes = append(changes, Change{Path: fieldPath(path, "sender_user_id"), Old: updateNewInlineQuery.SenderUserId, New: other.SenderUserId})
+	}
+	changes = updateNewInlineQuery.UserLocation.diff(fieldPath(path, "user_location"), other.UserLocation, changes)
+	changes = diffChatType(fieldPath(path, "chat_type"), updateNewInlineQuery.ChatType, other.ChatType, changes)
+	if updateNewInlineQuery.Query != other.Query {
+		changes = append(changes, Change{Path: fieldPath(path, "query"), Old: updateNewInlineQuery.Query, New: other.Query})
+	}
+	if updateNewInlineQuery.Offset != other.Offset {
+		changes = append(changes, Change{Path: fieldPath(path, "offset"), Old: updateNewInlineQuery.Offset, New: other.Offset})
+	}
+	return changes
+}
 
-	updateNewInlineQuery.tdCommon = tempObj.tdCommon
-	updateNewInlineQuery.Id = tempObj.Id
-	updateNewInlineQuery.SenderUserId = tempObj.SenderUserId
-	updateNewInlineQuery.UserLocation = tempObj.UserLocation
-	updateNewInlineQuery.Query = tempObj.Query
-	updateNewInlineQuery.Offset = tempObj.Offset
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateNewInlineQuery *UpdateNewInlineQuery) String() string {
+	return objectString(updateNewInlineQuery)
+}
+
+// LogValue logs the object as its summary
+func (updateNewInlineQuery *UpdateNewInlineQuery) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateNewInlineQuery))
+}
 
-	fieldChatType, _ := unmarshalChatType(objMap["chat_type"])
-	updateNewInlineQuery.ChatType = fieldChatType
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateNewInlineQuery *UpdateNewInlineQuery) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateNewInlineQuery)
+}
 
-	return nil
+func (updateNewInlineQuery *UpdateNewInlineQuery) printText(p *printer) {
+	if updateNewInlineQuery == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateNewInlineQuery")
+	p.int("id", int64(updateNewInlineQuery.Id))
+	p.int("sender_user_id", int64(updateNewInlineQuery.SenderUserId))
+	p.object("user_location", updateNewInlineQuery.UserLocation)
+	p.object("chat_type", updateNewInlineQuery.ChatType)
+	p.string("query", updateNewInlineQuery.Query)
+	p.string("offset", updateNewInlineQuery.Offset)
+	p.endObject(state)
 }
 
 // GetUpdateEnum return the enum type of this object
@@ -3581,6 +13942,11 @@ func (updateNewInlineQuery *UpdateNewInlineQuery) GetUpdateEnum() UpdateEnum {
 	return UpdateNewInlineQueryType
 }
 
+// Accept calls the method of the visitor for UpdateNewInlineQuery
+func (updateNewInlineQuery *UpdateNewInlineQuery) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateNewInlineQuery(updateNewInlineQuery)
+}
+
 // UpdateNewChosenInlineResult The user has chosen a result of an inline query; for bots only
 type UpdateNewChosenInlineResult struct {
 	tdCommon
@@ -3616,11 +13982,170 @@ func NewUpdateNewChosenInlineResult(senderUserId int64, userLocation *Location,
 	return &updateNewChosenInlineResultTemp
 }
 
+// MarshalJSON marshals to json
+func (updateNewChosenInlineResult *UpdateNewChosenInlineResult) MarshalJSON() ([]byte, error) {
+	return updateNewChosenInlineResult.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateNewChosenInlineResult *UpdateNewChosenInlineResult) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateNewChosenInlineResult)
+}
+
+func (updateNewChosenInlineResult *UpdateNewChosenInlineResult) appendJSON(b []byte) ([]byte, error) {
+	if updateNewChosenInlineResult == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateNewChosenInlineResult.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateNewChosenInlineResult.tdCommon.Extra)
+	b = append(b, `,"sender_user_id":`...)
+	b = appendJSONInt(b, int64(updateNewChosenInlineResult.SenderUserId))
+	b = append(b, `,"user_location":`...)
+	b, err = updateNewChosenInlineResult.UserLocation.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"query":`...)
+	b = appendJSONString(b, updateNewChosenInlineResult.Query)
+	b = append(b, `,"result_id":`...)
+	b = appendJSONString(b, updateNewChosenInlineResult.ResultId)
+	b = append(b, `,"inline_message_id":`...)
+	b = appendJSONString(b, updateNewChosenInlineResult.InlineMessageId)
+	return append(b, '}'), nil
+}
+
+func (updateNewChosenInlineResult *UpdateNewChosenInlineResult) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateNewChosenInlineResult.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateNewChosenInlineResult.tdCommon.Extra, err = r.readString()
+		case "sender_user_id":
+			updateNewChosenInlineResult.SenderUserId, err = r.readInt64()
+		case "user_location":
+			if r.readNull() {
+				updateNewChosenInlineResult.UserLocation = nil
+			} else {
+				updateNewChosenInlineResult.UserLocation = new(Location)
+				err = updateNewChosenInlineResult.UserLocation.decodeJSON(r)
+			}
+		case "query":
+			updateNewChosenInlineResult.Query, err = r.readString()
+		case "result_id":
+			updateNewChosenInlineResult.ResultId, err = r.readString()
+		case "inline_message_id":
+			updateNewChosenInlineResult.InlineMessageId, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateNewChosenInlineResult *UpdateNewChosenInlineResult) Clone() *UpdateNewChosenInlineResult {
+	if updateNewChosenInlineResult == nil {
+		return nil
+	}
+	clone := *updateNewChosenInlineResult
+	clone.UserLocation = updateNewChosenInlineResult.UserLocation.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateNewChosenInlineResult *UpdateNewChosenInlineResult) Equal(other *UpdateNewChosenInlineResult) bool {
+	if updateNewChosenInlineResult == nil || other == nil {
+		return updateNewChosenInlineResult == other
+	}
+	if updateNewChosenInlineResult.SenderUserId != other.SenderUserId {
+		return false
+	}
+	if !updateNewChosenInlineResult.UserLocation.Equal(other.UserLocation) {
+		return false
+	}
+	if updateNewChosenInlineResult.Query != other.Query {
+		return false
+	}
+	if updateNewChosenInlineResult.ResultId != other.ResultId {
+		return false
+	}
+	if updateNewChosenInlineResult.InlineMessageId != other.InlineMessageId {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateNewChosenInlineResult *UpdateNewChosenInlineResult) Diff(other *UpdateNewChosenInlineResult) []Change {
+	return updateNewChosenInlineResult.diff("", other, nil)
+}
+
+func (updateNewChosenInlineResult *UpdateNewChosenInlineResult) diff(path string, other *UpdateNewChosenInlineResult, changes []Change) []Change {
+	if updateNewChosenInlineResult == nil || other == nil {
+		if updateNewChosenInlineResult != other {
+			changes = append(changes, Change{Path: path, Old: updateNewChosenInlineResult, New: other})
+		}
+		return changes
+	}
+	if updateNewChosenInlineResult.SenderUserId != other.SenderUserId {
+		changes = append(changes, Change{Path: fieldPath(path, "sender_user_id"), Old: updateNewChosenInlineResult.SenderUserId, New: other.SenderUserId})
+	}
+	changes = updateNewChosenInlineResult.UserLocation.diff(fieldPath(path, "user_location"), other.UserLocation, changes)
+	if updateNewChosenInlineResult.Query != other.Query {
+		changes = append(changes, Change{Path: fieldPath(path, "query"), Old: updateNewChosenInlineResult.Query, New: other.Query})
+	}
+	if updateNewChosenInlineResult.ResultId != other.ResultId {
+		changes = append(changes, Change{Path: fieldPath(path, "result_id"), Old: updateNewChosenInlineResult.ResultId, New: other.ResultId})
+	}
+	if updateNewChosenInlineResult.InlineMessageId != other.InlineMessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "inline_message_id"), Old: updateNewChosenInlineResult.InlineMessageId, New: other.InlineMessageId})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateNewChosenInlineResult *UpdateNewChosenInlineResult) String() string {
+	return objectString(updateNewChosenInlineResult)
+}
+
+// LogValue logs the object as its summary
+func (updateNewChosenInlineResult *UpdateNewChosenInlineResult) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateNewChosenInlineResult))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateNewChosenInlineResult *UpdateNewChosenInlineResult) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateNewChosenInlineResult)
+}
+
+func (updateNewChosenInlineResult *UpdateNewChosenInlineResult) printText(p *printer) {
+	if updateNewChosenInlineResult == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateNewChosenInlineResult")
+	p.int("sender_user_id", int64(updateNewChosenInlineResult.SenderUserId))
+	p.object("user_location", updateNewChosenInlineResult.UserLocation)
+	p.string("query", updateNewChosenInlineResult.Query)
+	p.string("result_id", updateNewChosenInlineResult.ResultId)
+	p.string("inline_message_id", updateNewChosenInlineResult.InlineMessageId)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateNewChosenInlineResult *UpdateNewChosenInlineResult) GetUpdateEnum() UpdateEnum {
 	return UpdateNewChosenInlineResultType
 }
 
+// Accept calls the method of the visitor for UpdateNewChosenInlineResult
+func (updateNewChosenInlineResult *UpdateNewChosenInlineResult) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateNewChosenInlineResult(updateNewChosenInlineResult)
+}
+
 // UpdateNewCallbackQuery A new incoming callback query; for bots only
 type UpdateNewCallbackQuery struct {
 	tdCommon
@@ -3659,38 +14184,164 @@ func NewUpdateNewCallbackQuery(id JSONInt64, senderUserId int64, chatId int64, m
 	return &updateNewCallbackQueryTemp
 }
 
+// MarshalJSON marshals to json
+func (updateNewCallbackQuery *UpdateNewCallbackQuery) MarshalJSON() ([]byte, error) {
+	return updateNewCallbackQuery.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (updateNewCallbackQuery *UpdateNewCallbackQuery) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Id           JSONInt64 `json:"id"`             // Unique query identifier
-		SenderUserId int64     `json:"sender_user_id"` // Identifier of the user who sent the query
-		ChatId       int64     `json:"chat_id"`        // Identifier of the chat where the query was sent
-		MessageId    int64     `json:"message_id"`     // Identifier of the message, from which the query originated
-		ChatInstance JSONInt64 `json:"chat_instance"`  // Identifier that uniquely corresponds to the chat to which the message was sent
-
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	return unmarshalFast(b, updateNewCallbackQuery)
+}
+
+func (updateNewCallbackQuery *UpdateNewCallbackQuery) appendJSON(b []byte) ([]byte, error) {
+	if updateNewCallbackQuery == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateNewCallbackQuery.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateNewCallbackQuery.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt64String(b, int64(updateNewCallbackQuery.Id))
+	b = append(b, `,"sender_user_id":`...)
+	b = appendJSONInt(b, int64(updateNewCallbackQuery.SenderUserId))
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateNewCallbackQuery.ChatId))
+	b = append(b, `,"message_id":`...)
+	b = appendJSONInt(b, int64(updateNewCallbackQuery.MessageId))
+	b = append(b, `,"chat_instance":`...)
+	b = appendJSONInt64String(b, int64(updateNewCallbackQuery.ChatInstance))
+	b = append(b, `,"payload":`...)
+	b, err = appendJSONValue(b, updateNewCallbackQuery.Payload)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateNewCallbackQuery *UpdateNewCallbackQuery) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateNewCallbackQuery.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateNewCallbackQuery.tdCommon.Extra, err = r.readString()
+		case "id":
+			updateNewCallbackQuery.Id, err = r.readJSONInt64()
+		case "sender_user_id":
+			updateNewCallbackQuery.SenderUserId, err = r.readInt64()
+		case "chat_id":
+			updateNewCallbackQuery.ChatId, err = r.readInt64()
+		case "message_id":
+			updateNewCallbackQuery.MessageId, err = r.readInt64()
+		case "chat_instance":
+			updateNewCallbackQuery.ChatInstance, err = r.readJSONInt64()
+		case "payload":
+			updateNewCallbackQuery.Payload, err = decodeCallbackQueryPayload(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateNewCallbackQuery *UpdateNewCallbackQuery) Clone() *UpdateNewCallbackQuery {
+	if updateNewCallbackQuery == nil {
+		return nil
+	}
+	clone := *updateNewCallbackQuery
+	clone.Payload = cloneCallbackQueryPayload(updateNewCallbackQuery.Payload)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateNewCallbackQuery *UpdateNewCallbackQuery) Equal(other *UpdateNewCallbackQuery) bool {
+	if updateNewCallbackQuery == nil || other == nil {
+		return updateNewCallbackQuery == other
+	}
+	if updateNewCallbackQuery.Id != other.Id {
+		return false
+	}
+	if updateNewCallbackQuery.SenderUserId != other.SenderUserId {
+		return false
+	}
+	if updateNewCallbackQuery.ChatId != other.ChatId {
+		return false
+	}
+	if updateNewCallbackQuery.MessageId != other.MessageId {
+		return false
+	}
+	if updateNewCallbackQuery.ChatInstance != other.ChatInstance {
+		return false
+	}
+	if !equalCallbackQueryPayload(updateNewCallbackQuery.Payload, other.Payload) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateNewCallbackQuery *UpdateNewCallbackQuery) Diff(other *UpdateNewCallbackQuery) []Change {
+	return updateNewCallbackQuery.diff("", other, nil)
+}
+
+func (updateNewCallbackQuery *UpdateNewCallbackQuery) diff(path string, other *UpdateNewCallbackQuery, changes []Change) []Change {
+	if updateNewCallbackQuery == nil || other == nil {
+		if updateNewCallbackQuery != other {
+			changes = append(changes, Change{Path: path, Old: updateNewCallbackQuery, New: other})
+		}
+		return changes
+	}
+	if updateNewCallbackQuery.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: updateNewCallbackQuery.Id, New: other.Id})
 	}
+	if updateNewCallbackQuery.SenderUserId != other.SenderUserId {
+		changes = append(changes, Change{Path: fieldPath(path, "sender_user_id"), Old: updateNewCallbackQuery.SenderUserId, New: other.SenderUserId})
+	}
+	if updateNewCallbackQuery.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateNewCallbackQuery.ChatId, New: other.ChatId})
+	}
+	if updateNewCallbackQuery.MessageId != other.MessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "message_id"), Old: updateNewCallbackQuery.MessageId, New: other.MessageId})
+	}
+	if updateNewCallbackQuery.ChatInstance != other.ChatInstance {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_instance"), Old: updateNewCallbackQuery.ChatInstance, New: other.ChatInstance})
+	}
+	changes = diffCallbackQueryPayload(fieldPath(path, "payload"), updateNewCallbackQuery.Payload, other.Payload, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateNewCallbackQuery *UpdateNewCallbackQuery) String() string {
+	return objectString(updateNewCallbackQuery)
+}
 
-	updateNewCallbackQuery.tdCommon = tempObj.tdCommon
-	updateNewCallbackQuery.Id = tempObj.Id
-	updateNewCallbackQuery.SenderUserId = tempObj.SenderUserId
-	updateNewCallbackQuery.ChatId = tempObj.ChatId
-	updateNewCallbackQuery.MessageId = tempObj.MessageId
-	updateNewCallbackQuery.ChatInstance = tempObj.ChatInstance
+// LogValue logs the object as its summary
+func (updateNewCallbackQuery *UpdateNewCallbackQuery) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateNewCallbackQuery))
+}
 
-	fieldPayload, _ := unmarshalCallbackQueryPayload(objMap["payload"])
-	updateNewCallbackQuery.Payload = fieldPayload
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateNewCallbackQuery *UpdateNewCallbackQuery) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateNewCallbackQuery)
+}
 
-	return nil
+func (updateNewCallbackQuery *UpdateNewCallbackQuery) printText(p *printer) {
+	if updateNewCallbackQuery == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateNewCallbackQuery")
+	p.int("id", int64(updateNewCallbackQuery.Id))
+	p.int("sender_user_id", int64(updateNewCallbackQuery.SenderUserId))
+	p.int("chat_id", int64(updateNewCallbackQuery.ChatId))
+	p.int("message_id", int64(updateNewCallbackQuery.MessageId))
+	p.int("chat_instance", int64(updateNewCallbackQuery.ChatInstance))
+	p.object("payload", updateNewCallbackQuery.Payload)
+	p.endObject(state)
 }
 
 // GetUpdateEnum return the enum type of this object
@@ -3698,6 +14349,11 @@ func (updateNewCallbackQuery *UpdateNewCallbackQuery) GetUpdateEnum() UpdateEnum
 	return UpdateNewCallbackQueryType
 }
 
+// Accept calls the method of the visitor for UpdateNewCallbackQuery
+func (updateNewCallbackQuery *UpdateNewCallbackQuery) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateNewCallbackQuery(updateNewCallbackQuery)
+}
+
 // UpdateNewInlineCallbackQuery A new incoming callback query from a message sent via a bot; for bots only
 type UpdateNewInlineCallbackQuery struct {
 	tdCommon
@@ -3733,36 +14389,153 @@ func NewUpdateNewInlineCallbackQuery(id JSONInt64, senderUserId int64, inlineMes
 	return &updateNewInlineCallbackQueryTemp
 }
 
+// MarshalJSON marshals to json
+func (updateNewInlineCallbackQuery *UpdateNewInlineCallbackQuery) MarshalJSON() ([]byte, error) {
+	return updateNewInlineCallbackQuery.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (updateNewInlineCallbackQuery *UpdateNewInlineCallbackQuery) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, updateNewInlineCallbackQuery)
+}
+
+func (updateNewInlineCallbackQuery *UpdateNewInlineCallbackQuery) appendJSON(b []byte) ([]byte, error) {
+	if updateNewInlineCallbackQuery == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateNewInlineCallbackQuery.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateNewInlineCallbackQuery.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt64String(b, int64(updateNewInlineCallbackQuery.Id))
+	b = append(b, `,"sender_user_id":`...)
+	b = appendJSONInt(b, int64(updateNewInlineCallbackQuery.SenderUserId))
+	b = append(b, `,"inline_message_id":`...)
+	b = appendJSONString(b, updateNewInlineCallbackQuery.InlineMessageId)
+	b = append(b, `,"chat_instance":`...)
+	b = appendJSONInt64String(b, int64(updateNewInlineCallbackQuery.ChatInstance))
+	b = append(b, `,"payload":`...)
+	b, err = appendJSONValue(b, updateNewInlineCallbackQuery.Payload)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateNewInlineCallbackQuery *UpdateNewInlineCallbackQuery) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateNewInlineCallbackQuery.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateNewInlineCallbackQuery.tdCommon.Extra, err = r.readString()
+		case "id":
+			updateNewInlineCallbackQuery.Id, err = r.readJSONInt64()
+		case "sender_user_id":
+			updateNewInlineCallbackQuery.SenderUserId, err = r.readInt64()
+		case "inline_message_id":
+			updateNewInlineCallbackQuery.InlineMessageId, err = r.readString()
+		case "chat_instance":
+			updateNewInlineCallbackQuery.ChatInstance, err = r.readJSONInt64()
+		case "payload":
+			updateNewInlineCallbackQuery.Payload, err = decodeCallbackQueryPayload(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateNewInlineCallbackQuery *UpdateNewInlineCallbackQuery) Clone() *UpdateNewInlineCallbackQuery {
+	if updateNewInlineCallbackQuery == nil {
+		return nil
 	}
-	tempObj := struct {
-		tdCommon
-		Id              JSONInt64 `json:"id"`                // Unique query identifier
-		SenderUserId    int64     `json:"sender_user_id"`    // Identifier of the user who sent the query
-		InlineMessageId string    `json:"inline_message_id"` // Identifier of the inline message, from which the query originated
-		ChatInstance    JSONInt64 `json:"chat_instance"`     // An identifier uniquely corresponding to the chat a message was sent to
+	clone := *updateNewInlineCallbackQuery
+	clone.Payload = cloneCallbackQueryPayload(updateNewInlineCallbackQuery.Payload)
+	return &clone
+}
 
-	}{}
-	err = json.Unmarshal(b, &tempObj)
-	if err != nil {
-		return err
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateNewInlineCallbackQuery *UpdateNewInlineCallbackQuery) Equal(other *UpdateNewInlineCallbackQuery) bool {
+	if updateNewInlineCallbackQuery == nil || other == nil {
+		return updateNewInlineCallbackQuery == other
+	}
+	if updateNewInlineCallbackQuery.Id != other.Id {
+		return false
+	}
+	if updateNewInlineCallbackQuery.SenderUserId != other.SenderUserId {
+		return false
+	}
+	if updateNewInlineCallbackQuery.InlineMessageId != other.InlineMessageId {
+		return false
+	}
+	if updateNewInlineCallbackQuery.ChatInstance != other.ChatInstance {
+		return false
+	}
+	if !equalCallbackQueryPayload(updateNewInlineCallbackQuery.Payload, other.Payload) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateNewInlineCallbackQuery *UpdateNewInlineCallbackQuery) Diff(other *UpdateNewInlineCallbackQuery) []Change {
+	return updateNewInlineCallbackQuery.diff("", other, nil)
+}
+
+func (updateNewInlineCallbackQuery *UpdateNewInlineCallbackQuery) diff(path string, other *UpdateNewInlineCallbackQuery, changes []Change) []Change {
+	if updateNewInlineCallbackQuery == nil || other == nil {
+		if updateNewInlineCallbackQuery != other {
+			changes = append(changes, Change{Path: path, Old: updateNewInlineCallbackQuery, New: other})
+		}
+		return changes
+	}
+	if updateNewInlineCallbackQuery.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: updateNewInlineCallbackQuery.Id, New: other.Id})
+	}
+	if updateNewInlineCallbackQuery.SenderUserId != other.SenderUserId {
+		changes = append(changes, Change{Path: fieldPath(path, "sender_user_id"), Old: updateNewInlineCallbackQuery.SenderUserId, New: other.SenderUserId})
 	}
+	if updateNewInlineCallbackQuery.InlineMessageId != other.InlineMessageId {
+		changes = append(changes, Change{Path: fieldPath(path, "inline_message_id"), Old: updateNewInlineCallbackQuery.InlineMessageId, New: other.InlineMessageId})
+	}
+	if updateNewInlineCallbackQuery.ChatInstance != other.ChatInstance {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_instance"), Old: updateNewInlineCallbackQuery.ChatInstance, New: other.ChatInstance})
+	}
+	changes = diffCallbackQueryPayload(fieldPath(path, "payload"), updateNewInlineCallbackQuery.Payload, other.Payload, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateNewInlineCallbackQuery *UpdateNewInlineCallbackQuery) String() string {
+	return objectString(updateNewInlineCallbackQuery)
+}
 
-	updateNewInlineCallbackQuery.tdCommon = tempObj.tdCommon
-	updateNewInlineCallbackQuery.Id = tempObj.Id
-	updateNewInlineCallbackQuery.SenderUserId = tempObj.SenderUserId
-	updateNewInlineCallbackQuery.InlineMessageId = tempObj.InlineMessageId
-	updateNewInlineCallbackQuery.ChatInstance = tempObj.ChatInstance
+// LogValue logs the object as its summary
+func (updateNewInlineCallbackQuery *UpdateNewInlineCallbackQuery) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateNewInlineCallbackQuery))
+}
 
-	fieldPayload, _ := unmarshalCallbackQueryPayload(objMap["payload"])
-	updateNewInlineCallbackQuery.Payload = fieldPayload
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateNewInlineCallbackQuery *UpdateNewInlineCallbackQuery) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateNewInlineCallbackQuery)
+}
 
-	return nil
+func (updateNewInlineCallbackQuery *UpdateNewInlineCallbackQuery) printText(p *printer) {
+	if updateNewInlineCallbackQuery == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateNewInlineCallbackQuery")
+	p.int("id", int64(updateNewInlineCallbackQuery.Id))
+	p.int("sender_user_id", int64(updateNewInlineCallbackQuery.SenderUserId))
+	p.string("inline_message_id", updateNewInlineCallbackQuery.InlineMessageId)
+	p.int("chat_instance", int64(updateNewInlineCallbackQuery.ChatInstance))
+	p.object("payload", updateNewInlineCallbackQuery.Payload)
+	p.endObject(state)
 }
 
 // GetUpdateEnum return the enum type of this object
@@ -3770,6 +14543,11 @@ func (updateNewInlineCallbackQuery *UpdateNewInlineCallbackQuery) GetUpdateEnum(
 	return UpdateNewInlineCallbackQueryType
 }
 
+// Accept calls the method of the visitor for UpdateNewInlineCallbackQuery
+func (updateNewInlineCallbackQuery *UpdateNewInlineCallbackQuery) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateNewInlineCallbackQuery(updateNewInlineCallbackQuery)
+}
+
 // UpdateNewShippingQuery A new incoming shipping query; for bots only. Only for invoices with flexible price
 type UpdateNewShippingQuery struct {
 	tdCommon
@@ -3802,11 +14580,159 @@ func NewUpdateNewShippingQuery(id JSONInt64, senderUserId int64, invoicePayload
 	return &updateNewShippingQueryTemp
 }
 
+// MarshalJSON marshals to json
+func (updateNewShippingQuery *UpdateNewShippingQuery) MarshalJSON() ([]byte, error) {
+	return updateNewShippingQuery.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateNewShippingQuery *UpdateNewShippingQuery) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateNewShippingQuery)
+}
+
+func (updateNewShippingQuery *UpdateNewShippingQuery) appendJSON(b []byte) ([]byte, error) {
+	if updateNewShippingQuery == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateNewShippingQuery.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateNewShippingQuery.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt64String(b, int64(updateNewShippingQuery.Id))
+	b = append(b, `,"sender_user_id":`...)
+	b = appendJSONInt(b, int64(updateNewShippingQuery.SenderUserId))
+	b = append(b, `,"invoice_payload":`...)
+	b = appendJSONString(b, updateNewShippingQuery.InvoicePayload)
+	b = append(b, `,"shipping_address":`...)
+	b, err = updateNewShippingQuery.ShippingAddress.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateNewShippingQuery *UpdateNewShippingQuery) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateNewShippingQuery.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateNewShippingQuery.tdCommon.Extra, err = r.readString()
+		case "id":
+			updateNewShippingQuery.Id, err = r.readJSONInt64()
+		case "sender_user_id":
+			updateNewShippingQuery.SenderUserId, err = r.readInt64()
+		case "invoice_payload":
+			updateNewShippingQuery.InvoicePayload, err = r.readString()
+		case "shipping_address":
+			if r.readNull() {
+				updateNewShippingQuery.ShippingAddress = nil
+			} else {
+				updateNewShippingQuery.ShippingAddress = new(Address)
+				err = updateNewShippingQuery.ShippingAddress.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateNewShippingQuery *UpdateNewShippingQuery) Clone() *UpdateNewShippingQuery {
+	if updateNewShippingQuery == nil {
+		return nil
+	}
+	clone := *updateNewShippingQuery
+	clone.ShippingAddress = updateNewShippingQuery.ShippingAddress.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateNewShippingQuery *UpdateNewShippingQuery) Equal(other *UpdateNewShippingQuery) bool {
+	if updateNewShippingQuery == nil || other == nil {
+		return updateNewShippingQuery == other
+	}
+	if updateNewShippingQuery.Id != other.Id {
+		return false
+	}
+	if updateNewShippingQuery.SenderUserId != other.SenderUserId {
+		return false
+	}
+	if updateNewShippingQuery.InvoicePayload != other.InvoicePayload {
+		return false
+	}
+	if !updateNewShippingQuery.ShippingAddress.Equal(other.ShippingAddress) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateNewShippingQuery *UpdateNewShippingQuery) Diff(other *UpdateNewShippingQuery) []Change {
+	return updateNewShippingQuery.diff("", other, nil)
+}
+
+func (updateNewShippingQuery *UpdateNewShippingQuery) diff(path string, other *UpdateNewShippingQuery, changes []Change) []Change {
+	if updateNewShippingQuery == nil || other == nil {
+		if updateNewShippingQuery != other {
+			changes = append(changes, Change{Path: path, Old: updateNewShippingQuery, New: other})
+		}
+		return changes
+	}
+	if updateNewShippingQuery.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: updateNewShippingQuery.Id, New: other.Id})
+	}
+	if updateNewShippingQuery.SenderUserId != other.SenderUserId {
+		changes = append(changes, Change{Path: fieldPath(path, "sender_user_id"), Old: updateNewShippingQuery.SenderUserId, New: other.SenderUserId})
+	}
+	if updateNewShippingQuery.InvoicePayload != other.InvoicePayload {
+		changes = append(changes, Change{Path: fieldPath(path, "invoice_payload"), Old: updateNewShippingQuery.InvoicePayload, New: other.InvoicePayload})
+	}
+	changes = updateNewShippingQuery.ShippingAddress.diff(fieldPath(path, "shipping_address"), other.ShippingAddress, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateNewShippingQuery *UpdateNewShippingQuery) String() string {
+	return objectString(updateNewShippingQuery)
+}
+
+// LogValue logs the object as its summary
+func (updateNewShippingQuery *UpdateNewShippingQuery) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateNewShippingQuery))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateNewShippingQuery *UpdateNewShippingQuery) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateNewShippingQuery)
+}
+
+func (updateNewShippingQuery *UpdateNewShippingQuery) printText(p *printer) {
+	if updateNewShippingQuery == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateNewShippingQuery")
+	p.int("id", int64(updateNewShippingQuery.Id))
+	p.int("sender_user_id", int64(updateNewShippingQuery.SenderUserId))
+	p.string("invoice_payload", updateNewShippingQuery.InvoicePayload)
+	p.object("shipping_address", updateNewShippingQuery.ShippingAddress)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateNewShippingQuery *UpdateNewShippingQuery) GetUpdateEnum() UpdateEnum {
 	return UpdateNewShippingQueryType
 }
 
+// Accept calls the method of the visitor for UpdateNewShippingQuery
+func (updateNewShippingQuery *UpdateNewShippingQuery) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateNewShippingQuery(updateNewShippingQuery)
+}
+
 // UpdateNewPreCheckoutQuery A new incoming pre-checkout query; for bots only. Contains full information about a checkout
 type UpdateNewPreCheckoutQuery struct {
 	tdCommon
@@ -3845,7 +14771,186 @@ func NewUpdateNewPreCheckoutQuery(id JSONInt64, senderUserId int64, currency str
 		OrderInfo:        orderInfo,
 	}
 
-	return &updateNewPreCheckoutQueryTemp
+	return &updateNewPreCheckoutQueryTemp
+}
+
+// MarshalJSON marshals to json
+func (updateNewPreCheckoutQuery *UpdateNewPreCheckoutQuery) MarshalJSON() ([]byte, error) {
+	return updateNewPreCheckoutQuery.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateNewPreCheckoutQuery *UpdateNewPreCheckoutQuery) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateNewPreCheckoutQuery)
+}
+
+func (updateNewPreCheckoutQuery *UpdateNewPreCheckoutQuery) appendJSON(b []byte) ([]byte, error) {
+	if updateNewPreCheckoutQuery == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateNewPreCheckoutQuery.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateNewPreCheckoutQuery.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt64String(b, int64(updateNewPreCheckoutQuery.Id))
+	b = append(b, `,"sender_user_id":`...)
+	b = appendJSONInt(b, int64(updateNewPreCheckoutQuery.SenderUserId))
+	b = append(b, `,"currency":`...)
+	b = appendJSONString(b, updateNewPreCheckoutQuery.Currency)
+	b = append(b, `,"total_amount":`...)
+	b = appendJSONInt(b, int64(updateNewPreCheckoutQuery.TotalAmount))
+	b = append(b, `,"invoice_payload":`...)
+	b = appendJSONBytes(b, updateNewPreCheckoutQuery.InvoicePayload)
+	b = append(b, `,"shipping_option_id":`...)
+	b = appendJSONString(b, updateNewPreCheckoutQuery.ShippingOptionId)
+	b = append(b, `,"order_info":`...)
+	b, err = updateNewPreCheckoutQuery.OrderInfo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateNewPreCheckoutQuery *UpdateNewPreCheckoutQuery) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateNewPreCheckoutQuery.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateNewPreCheckoutQuery.tdCommon.Extra, err = r.readString()
+		case "id":
+			updateNewPreCheckoutQuery.Id, err = r.readJSONInt64()
+		case "sender_user_id":
+			updateNewPreCheckoutQuery.SenderUserId, err = r.readInt64()
+		case "currency":
+			updateNewPreCheckoutQuery.Currency, err = r.readString()
+		case "total_amount":
+			updateNewPreCheckoutQuery.TotalAmount, err = r.readInt64()
+		case "invoice_payload":
+			updateNewPreCheckoutQuery.InvoicePayload, err = r.readBytes()
+		case "shipping_option_id":
+			updateNewPreCheckoutQuery.ShippingOptionId, err = r.readString()
+		case "order_info":
+			if r.readNull() {
+				updateNewPreCheckoutQuery.OrderInfo = nil
+			} else {
+				updateNewPreCheckoutQuery.OrderInfo = new(OrderInfo)
+				err = updateNewPreCheckoutQuery.OrderInfo.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateNewPreCheckoutQuery *UpdateNewPreCheckoutQuery) Clone() *UpdateNewPreCheckoutQuery {
+	if updateNewPreCheckoutQuery == nil {
+		return nil
+	}
+	clone := *updateNewPreCheckoutQuery
+	if updateNewPreCheckoutQuery.InvoicePayload != nil {
+		clone.InvoicePayload = append([]byte{}, updateNewPreCheckoutQuery.InvoicePayload...)
+	}
+	clone.OrderInfo = updateNewPreCheckoutQuery.OrderInfo.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateNewPreCheckoutQuery *UpdateNewPreCheckoutQuery) Equal(other *UpdateNewPreCheckoutQuery) bool {
+	if updateNewPreCheckoutQuery == nil || other == nil {
+		return updateNewPreCheckoutQuery == other
+	}
+	if updateNewPreCheckoutQuery.Id != other.Id {
+		return false
+	}
+	if updateNewPreCheckoutQuery.SenderUserId != other.SenderUserId {
+		return false
+	}
+	if updateNewPreCheckoutQuery.Currency != other.Currency {
+		return false
+	}
+	if updateNewPreCheckoutQuery.TotalAmount != other.TotalAmount {
+		return false
+	}
+	if !equalBytes(updateNewPreCheckoutQuery.InvoicePayload, other.InvoicePayload) {
+		return false
+	}
+	if updateNewPreCheckoutQuery.ShippingOptionId != other.ShippingOptionId {
+		return false
+	}
+	if !updateNewPreCheckoutQuery.OrderInfo.Equal(other.OrderInfo) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateNewPreCheckoutQuery *UpdateNewPreCheckoutQuery) Diff(other *UpdateNewPreCheckoutQuery) []Change {
+	return updateNewPreCheckoutQuery.diff("", other, nil)
+}
+
+func (updateNewPreCheckoutQuery *UpdateNewPreCheckoutQuery) diff(path string, other *UpdateNewPreCheckoutQuery, changes []Change) []Change {
+	if updateNewPreCheckoutQuery == nil || other == nil {
+		if updateNewPreCheckoutQuery != other {
+			changes = append(changes, Change{Path: path, Old: updateNewPreCheckoutQuery, New: other})
+		}
+		return changes
+	}
+	if updateNewPreCheckoutQuery.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: updateNewPreCheckoutQuery.Id, New: other.Id})
+	}
+	if updateNewPreCheckoutQuery.SenderUserId != other.SenderUserId {
+		changes = append(changes, Change{Path: fieldPath(path, "sender_user_id"), Old: updateNewPreCheckoutQuery.SenderUserId, New: other.SenderUserId})
+	}
+	if updateNewPreCheckoutQuery.Currency != other.Currency {
+		changes = append(changes, Change{Path: fieldPath(path, "currency"), Old: updateNewPreCheckoutQuery.Currency, New: other.Currency})
+	}
+	if updateNewPreCheckoutQuery.TotalAmount != other.TotalAmount {
+		changes = append(changes, Change{Path: fieldPath(path, "total_amount"), Old: updateNewPreCheckoutQuery.TotalAmount, New: other.TotalAmount})
+	}
+	if !equalBytes(updateNewPreCheckoutQuery.InvoicePayload, other.InvoicePayload) {
+		changes = append(changes, Change{Path: fieldPath(path, "invoice_payload"), Old: updateNewPreCheckoutQuery.InvoicePayload, New: other.InvoicePayload})
+	}
+	if updateNewPreCheckoutQuery.ShippingOptionId != other.ShippingOptionId {
+		changes = append(changes, Change{Path: fieldPath(path, "shipping_option_id"), Old: updateNewPreCheckoutQuery.ShippingOptionId, New: other.ShippingOptionId})
+	}
+	changes = updateNewPreCheckoutQuery.OrderInfo.diff(fieldPath(path, "order_info"), other.OrderInfo, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateNewPreCheckoutQuery *UpdateNewPreCheckoutQuery) String() string {
+	return objectString(updateNewPreCheckoutQuery)
+}
+
+// LogValue logs the object as its summary
+func (updateNewPreCheckoutQuery *UpdateNewPreCheckoutQuery) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateNewPreCheckoutQuery))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateNewPreCheckoutQuery *UpdateNewPreCheckoutQuery) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateNewPreCheckoutQuery)
+}
+
+func (updateNewPreCheckoutQuery *UpdateNewPreCheckoutQuery) printText(p *printer) {
+	if updateNewPreCheckoutQuery == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateNewPreCheckoutQuery")
+	p.int("id", int64(updateNewPreCheckoutQuery.Id))
+	p.int("sender_user_id", int64(updateNewPreCheckoutQuery.SenderUserId))
+	p.string("currency", updateNewPreCheckoutQuery.Currency)
+	p.int("total_amount", int64(updateNewPreCheckoutQuery.TotalAmount))
+	p.bytes("invoice_payload", updateNewPreCheckoutQuery.InvoicePayload)
+	p.string("shipping_option_id", updateNewPreCheckoutQuery.ShippingOptionId)
+	p.object("order_info", updateNewPreCheckoutQuery.OrderInfo)
+	p.endObject(state)
 }
 
 // GetUpdateEnum return the enum type of this object
@@ -3853,6 +14958,11 @@ func (updateNewPreCheckoutQuery *UpdateNewPreCheckoutQuery) GetUpdateEnum() Upda
 	return UpdateNewPreCheckoutQueryType
 }
 
+// Accept calls the method of the visitor for UpdateNewPreCheckoutQuery
+func (updateNewPreCheckoutQuery *UpdateNewPreCheckoutQuery) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateNewPreCheckoutQuery(updateNewPreCheckoutQuery)
+}
+
 // UpdateNewCustomEvent A new incoming event; for bots only
 type UpdateNewCustomEvent struct {
 	tdCommon
@@ -3876,11 +14986,118 @@ func NewUpdateNewCustomEvent(event string) *UpdateNewCustomEvent {
 	return &updateNewCustomEventTemp
 }
 
+// MarshalJSON marshals to json
+func (updateNewCustomEvent *UpdateNewCustomEvent) MarshalJSON() ([]byte, error) {
+	return updateNewCustomEvent.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateNewCustomEvent *UpdateNewCustomEvent) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateNewCustomEvent)
+}
+
+func (updateNewCustomEvent *UpdateNewCustomEvent) appendJSON(b []byte) ([]byte, error) {
+	if updateNewCustomEvent == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateNewCustomEvent.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateNewCustomEvent.tdCommon.Extra)
+	b = append(b, `,"event":`...)
+	b = appendJSONString(b, updateNewCustomEvent.Event)
+	return append(b, '}'), nil
+}
+
+func (updateNewCustomEvent *UpdateNewCustomEvent) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateNewCustomEvent.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateNewCustomEvent.tdCommon.Extra, err = r.readString()
+		case "event":
+			updateNewCustomEvent.Event, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateNewCustomEvent *UpdateNewCustomEvent) Clone() *UpdateNewCustomEvent {
+	if updateNewCustomEvent == nil {
+		return nil
+	}
+	clone := *updateNewCustomEvent
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateNewCustomEvent *UpdateNewCustomEvent) Equal(other *UpdateNewCustomEvent) bool {
+	if updateNewCustomEvent == nil || other == nil {
+		return updateNewCustomEvent == other
+	}
+	if updateNewCustomEvent.Event != other.Event {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateNewCustomEvent *UpdateNewCustomEvent) Diff(other *UpdateNewCustomEvent) []Change {
+	return updateNewCustomEvent.diff("", other, nil)
+}
+
+func (updateNewCustomEvent *UpdateNewCustomEvent) diff(path string, other *UpdateNewCustomEvent, changes []Change) []Change {
+	if updateNewCustomEvent == nil || other == nil {
+		if updateNewCustomEvent != other {
+			changes = append(changes, Change{Path: path, Old: updateNewCustomEvent, New: other})
+		}
+		return changes
+	}
+	if updateNewCustomEvent.Event != other.Event {
+		changes = append(changes, Change{Path: fieldPath(path, "event"), Old: updateNewCustomEvent.Event, New: other.Event})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateNewCustomEvent *UpdateNewCustomEvent) String() string {
+	return objectString(updateNewCustomEvent)
+}
+
+// LogValue logs the object as its summary
+func (updateNewCustomEvent *UpdateNewCustomEvent) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateNewCustomEvent))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateNewCustomEvent *UpdateNewCustomEvent) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateNewCustomEvent)
+}
+
+func (updateNewCustomEvent *UpdateNewCustomEvent) printText(p *printer) {
+	if updateNewCustomEvent == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateNewCustomEvent")
+	p.string("event", updateNewCustomEvent.Event)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateNewCustomEvent *UpdateNewCustomEvent) GetUpdateEnum() UpdateEnum {
 	return UpdateNewCustomEventType
 }
 
+// Accept calls the method of the visitor for UpdateNewCustomEvent
+func (updateNewCustomEvent *UpdateNewCustomEvent) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateNewCustomEvent(updateNewCustomEvent)
+}
+
 // UpdateNewCustomQuery A new incoming query; for bots only
 type UpdateNewCustomQuery struct {
 	tdCommon
@@ -3910,11 +15127,140 @@ func NewUpdateNewCustomQuery(id JSONInt64, data string, timeout int32) *UpdateNe
 	return &updateNewCustomQueryTemp
 }
 
+// MarshalJSON marshals to json
+func (updateNewCustomQuery *UpdateNewCustomQuery) MarshalJSON() ([]byte, error) {
+	return updateNewCustomQuery.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateNewCustomQuery *UpdateNewCustomQuery) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateNewCustomQuery)
+}
+
+func (updateNewCustomQuery *UpdateNewCustomQuery) appendJSON(b []byte) ([]byte, error) {
+	if updateNewCustomQuery == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateNewCustomQuery.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateNewCustomQuery.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt64String(b, int64(updateNewCustomQuery.Id))
+	b = append(b, `,"data":`...)
+	b = appendJSONString(b, updateNewCustomQuery.Data)
+	b = append(b, `,"timeout":`...)
+	b = appendJSONInt(b, int64(updateNewCustomQuery.Timeout))
+	return append(b, '}'), nil
+}
+
+func (updateNewCustomQuery *UpdateNewCustomQuery) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateNewCustomQuery.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateNewCustomQuery.tdCommon.Extra, err = r.readString()
+		case "id":
+			updateNewCustomQuery.Id, err = r.readJSONInt64()
+		case "data":
+			updateNewCustomQuery.Data, err = r.readString()
+		case "timeout":
+			updateNewCustomQuery.Timeout, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateNewCustomQuery *UpdateNewCustomQuery) Clone() *UpdateNewCustomQuery {
+	if updateNewCustomQuery == nil {
+		return nil
+	}
+	clone := *updateNewCustomQuery
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateNewCustomQuery *UpdateNewCustomQuery) Equal(other *UpdateNewCustomQuery) bool {
+	if updateNewCustomQuery == nil || other == nil {
+		return updateNewCustomQuery == other
+	}
+	if updateNewCustomQuery.Id != other.Id {
+		return false
+	}
+	if updateNewCustomQuery.Data != other.Data {
+		return false
+	}
+	if updateNewCustomQuery.Timeout != other.Timeout {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateNewCustomQuery *UpdateNewCustomQuery) Diff(other *UpdateNewCustomQuery) []Change {
+	return updateNewCustomQuery.diff("", other, nil)
+}
+
+func (updateNewCustomQuery *UpdateNewCustomQuery) diff(path string, other *UpdateNewCustomQuery, changes []Change) []Change {
+	if updateNewCustomQuery == nil || other == nil {
+		if updateNewCustomQuery != other {
+			changes = append(changes, Change{Path: path, Old: updateNewCustomQuery, New: other})
+		}
+		return changes
+	}
+	if updateNewCustomQuery.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: updateNewCustomQuery.Id, New: other.Id})
+	}
+	if updateNewCustomQuery.Data != other.Data {
+		changes = append(changes, Change{Path: fieldPath(path, "data"), Old: updateNewCustomQuery.Data, New: other.Data})
+	}
+	if updateNewCustomQuery.Timeout != other.Timeout {
+		changes = append(changes, Change{Path: fieldPath(path, "timeout"), Old: updateNewCustomQuery.Timeout, New: other.Timeout})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateNewCustomQuery *UpdateNewCustomQuery) String() string {
+	return objectString(updateNewCustomQuery)
+}
+
+// LogValue logs the object as its summary
+func (updateNewCustomQuery *UpdateNewCustomQuery) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateNewCustomQuery))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateNewCustomQuery *UpdateNewCustomQuery) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateNewCustomQuery)
+}
+
+func (updateNewCustomQuery *UpdateNewCustomQuery) printText(p *printer) {
+	if updateNewCustomQuery == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateNewCustomQuery")
+	p.int("id", int64(updateNewCustomQuery.Id))
+	p.string("data", updateNewCustomQuery.Data)
+	p.int("timeout", int64(updateNewCustomQuery.Timeout))
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateNewCustomQuery *UpdateNewCustomQuery) GetUpdateEnum() UpdateEnum {
 	return UpdateNewCustomQueryType
 }
 
+// Accept calls the method of the visitor for UpdateNewCustomQuery
+func (updateNewCustomQuery *UpdateNewCustomQuery) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateNewCustomQuery(updateNewCustomQuery)
+}
+
 // UpdatePoll A poll was updated; for bots only
 type UpdatePoll struct {
 	tdCommon
@@ -3938,11 +15284,126 @@ func NewUpdatePoll(poll *Poll) *UpdatePoll {
 	return &updatePollTemp
 }
 
+// MarshalJSON marshals to json
+func (updatePoll *UpdatePoll) MarshalJSON() ([]byte, error) {
+	return updatePoll.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updatePoll *UpdatePoll) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updatePoll)
+}
+
+func (updatePoll *UpdatePoll) appendJSON(b []byte) ([]byte, error) {
+	if updatePoll == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updatePoll.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updatePoll.tdCommon.Extra)
+	b = append(b, `,"poll":`...)
+	b, err = updatePoll.Poll.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updatePoll *UpdatePoll) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updatePoll.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updatePoll.tdCommon.Extra, err = r.readString()
+		case "poll":
+			if r.readNull() {
+				updatePoll.Poll = nil
+			} else {
+				updatePoll.Poll = new(Poll)
+				err = updatePoll.Poll.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updatePoll *UpdatePoll) Clone() *UpdatePoll {
+	if updatePoll == nil {
+		return nil
+	}
+	clone := *updatePoll
+	clone.Poll = updatePoll.Poll.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updatePoll *UpdatePoll) Equal(other *UpdatePoll) bool {
+	if updatePoll == nil || other == nil {
+		return updatePoll == other
+	}
+	if !updatePoll.Poll.Equal(other.Poll) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updatePoll *UpdatePoll) Diff(other *UpdatePoll) []Change {
+	return updatePoll.diff("", other, nil)
+}
+
+func (updatePoll *UpdatePoll) diff(path string, other *UpdatePoll, changes []Change) []Change {
+	if updatePoll == nil || other == nil {
+		if updatePoll != other {
+			changes = append(changes, Change{Path: path, Old: updatePoll, New: other})
+		}
+		return changes
+	}
+	changes = updatePoll.Poll.diff(fieldPath(path, "poll"), other.Poll, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updatePoll *UpdatePoll) String() string {
+	return objectString(updatePoll)
+}
+
+// LogValue logs the object as its summary
+func (updatePoll *UpdatePoll) LogValue() slog.Value {
+	return slog.StringValue(objectString(updatePoll))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updatePoll *UpdatePoll) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updatePoll)
+}
+
+func (updatePoll *UpdatePoll) printText(p *printer) {
+	if updatePoll == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdatePoll")
+	p.object("poll", updatePoll.Poll)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updatePoll *UpdatePoll) GetUpdateEnum() UpdateEnum {
 	return UpdatePollType
 }
 
+// Accept calls the method of the visitor for UpdatePoll
+func (updatePoll *UpdatePoll) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdatePoll(updatePoll)
+}
+
 // UpdatePollAnswer A user changed the answer to a poll; for bots only
 type UpdatePollAnswer struct {
 	tdCommon
@@ -3972,11 +15433,178 @@ func NewUpdatePollAnswer(pollId JSONInt64, userId int64, optionIds []int32) *Upd
 	return &updatePollAnswerTemp
 }
 
+// MarshalJSON marshals to json
+func (updatePollAnswer *UpdatePollAnswer) MarshalJSON() ([]byte, error) {
+	return updatePollAnswer.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updatePollAnswer *UpdatePollAnswer) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updatePollAnswer)
+}
+
+func (updatePollAnswer *UpdatePollAnswer) appendJSON(b []byte) ([]byte, error) {
+	if updatePollAnswer == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updatePollAnswer.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updatePollAnswer.tdCommon.Extra)
+	b = append(b, `,"poll_id":`...)
+	b = appendJSONInt64String(b, int64(updatePollAnswer.PollId))
+	b = append(b, `,"user_id":`...)
+	b = appendJSONInt(b, int64(updatePollAnswer.UserId))
+	b = append(b, `,"option_ids":`...)
+	if updatePollAnswer.OptionIds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range updatePollAnswer.OptionIds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(updatePollAnswer.OptionIds[i0]))
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (updatePollAnswer *UpdatePollAnswer) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updatePollAnswer.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updatePollAnswer.tdCommon.Extra, err = r.readString()
+		case "poll_id":
+			updatePollAnswer.PollId, err = r.readJSONInt64()
+		case "user_id":
+			updatePollAnswer.UserId, err = r.readInt64()
+		case "option_ids":
+			if r.readNull() {
+				updatePollAnswer.OptionIds = nil
+			} else {
+				updatePollAnswer.OptionIds = make([]int32, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int32
+					item0, err = r.readInt32()
+					updatePollAnswer.OptionIds = append(updatePollAnswer.OptionIds, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updatePollAnswer *UpdatePollAnswer) Clone() *UpdatePollAnswer {
+	if updatePollAnswer == nil {
+		return nil
+	}
+	clone := *updatePollAnswer
+	if updatePollAnswer.OptionIds != nil {
+		clone.OptionIds = make([]int32, len(updatePollAnswer.OptionIds))
+		copy(clone.OptionIds, updatePollAnswer.OptionIds)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updatePollAnswer *UpdatePollAnswer) Equal(other *UpdatePollAnswer) bool {
+	if updatePollAnswer == nil || other == nil {
+		return updatePollAnswer == other
+	}
+	if updatePollAnswer.PollId != other.PollId {
+		return false
+	}
+	if updatePollAnswer.UserId != other.UserId {
+		return false
+	}
+	if len(updatePollAnswer.OptionIds) != len(other.OptionIds) {
+		return false
+	}
+	for i0 := range updatePollAnswer.OptionIds {
+		if updatePollAnswer.OptionIds[i0] != other.OptionIds[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updatePollAnswer *UpdatePollAnswer) Diff(other *UpdatePollAnswer) []Change {
+	return updatePollAnswer.diff("", other, nil)
+}
+
+func (updatePollAnswer *UpdatePollAnswer) diff(path string, other *UpdatePollAnswer, changes []Change) []Change {
+	if updatePollAnswer == nil || other == nil {
+		if updatePollAnswer != other {
+			changes = append(changes, Change{Path: path, Old: updatePollAnswer, New: other})
+		}
+		return changes
+	}
+	if updatePollAnswer.PollId != other.PollId {
+		changes = append(changes, Change{Path: fieldPath(path, "poll_id"), Old: updatePollAnswer.PollId, New: other.PollId})
+	}
+	if updatePollAnswer.UserId != other.UserId {
+		changes = append(changes, Change{Path: fieldPath(path, "user_id"), Old: updatePollAnswer.UserId, New: other.UserId})
+	}
+	if len(updatePollAnswer.OptionIds) != len(other.OptionIds) {
+		changes = append(changes, Change{Path: fieldPath(path, "option_ids"), Old: updatePollAnswer.OptionIds, New: other.OptionIds})
+	} else {
+		for i0 := range updatePollAnswer.OptionIds {
+			if updatePollAnswer.OptionIds[i0] != other.OptionIds[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "option_ids"), i0), Old: updatePollAnswer.OptionIds[i0], New: other.OptionIds[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updatePollAnswer *UpdatePollAnswer) String() string {
+	return objectString(updatePollAnswer)
+}
+
+// LogValue logs the object as its summary
+func (updatePollAnswer *UpdatePollAnswer) LogValue() slog.Value {
+	return slog.StringValue(objectString(updatePollAnswer))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updatePollAnswer *UpdatePollAnswer) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updatePollAnswer)
+}
+
+func (updatePollAnswer *UpdatePollAnswer) printText(p *printer) {
+	if updatePollAnswer == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdatePollAnswer")
+	p.int("poll_id", int64(updatePollAnswer.PollId))
+	p.int("user_id", int64(updatePollAnswer.UserId))
+	p.vector("option_ids", len(updatePollAnswer.OptionIds), func(i0 int) {
+		p.int("option_ids", int64(updatePollAnswer.OptionIds[i0]))
+	})
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updatePollAnswer *UpdatePollAnswer) GetUpdateEnum() UpdateEnum {
 	return UpdatePollAnswerType
 }
 
+// Accept calls the method of the visitor for UpdatePollAnswer
+func (updatePollAnswer *UpdatePollAnswer) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdatePollAnswer(updatePollAnswer)
+}
+
 // UpdateChatMember User rights changed in a chat; for bots only
 type UpdateChatMember struct {
 	tdCommon
@@ -4015,11 +15643,195 @@ func NewUpdateChatMember(chatId int64, actorUserId int64, date int32, inviteLink
 	return &updateChatMemberTemp
 }
 
+// MarshalJSON marshals to json
+func (updateChatMember *UpdateChatMember) MarshalJSON() ([]byte, error) {
+	return updateChatMember.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateChatMember *UpdateChatMember) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateChatMember)
+}
+
+func (updateChatMember *UpdateChatMember) appendJSON(b []byte) ([]byte, error) {
+	if updateChatMember == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateChatMember.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateChatMember.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateChatMember.ChatId))
+	b = append(b, `,"actor_user_id":`...)
+	b = appendJSONInt(b, int64(updateChatMember.ActorUserId))
+	b = append(b, `,"date":`...)
+	b = appendJSONInt(b, int64(updateChatMember.Date))
+	b = append(b, `,"invite_link":`...)
+	b, err = updateChatMember.InviteLink.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"old_chat_member":`...)
+	b, err = updateChatMember.OldChatMember.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"new_chat_member":`...)
+	b, err = updateChatMember.NewChatMember.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateChatMember *UpdateChatMember) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateChatMember.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateChatMember.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateChatMember.ChatId, err = r.readInt64()
+		case "actor_user_id":
+			updateChatMember.ActorUserId, err = r.readInt64()
+		case "date":
+			updateChatMember.Date, err = r.readInt32()
+		case "invite_link":
+			if r.readNull() {
+				updateChatMember.InviteLink = nil
+			} else {
+				updateChatMember.InviteLink = new(ChatInviteLink)
+				err = updateChatMember.InviteLink.decodeJSON(r)
+			}
+		case "old_chat_member":
+			if r.readNull() {
+				updateChatMember.OldChatMember = nil
+			} else {
+				updateChatMember.OldChatMember = new(ChatMember)
+				err = updateChatMember.OldChatMember.decodeJSON(r)
+			}
+		case "new_chat_member":
+			if r.readNull() {
+				updateChatMember.NewChatMember = nil
+			} else {
+				updateChatMember.NewChatMember = new(ChatMember)
+				err = updateChatMember.NewChatMember.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateChatMember *UpdateChatMember) Clone() *UpdateChatMember {
+	if updateChatMember == nil {
+		return nil
+	}
+	clone := *updateChatMember
+	clone.InviteLink = updateChatMember.InviteLink.Clone()
+	clone.OldChatMember = updateChatMember.OldChatMember.Clone()
+	clone.NewChatMember = updateChatMember.NewChatMember.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateChatMember *UpdateChatMember) Equal(other *UpdateChatMember) bool {
+	if updateChatMember == nil || other == nil {
+		return updateChatMember == other
+	}
+	if updateChatMember.ChatId != other.ChatId {
+		return false
+	}
+	if updateChatMember.ActorUserId != other.ActorUserId {
+		return false
+	}
+	if updateChatMember.Date != other.Date {
+		return false
+	}
+	if !updateChatMember.InviteLink.Equal(other.InviteLink) {
+		return false
+	}
+	if !updateChatMember.OldChatMember.Equal(other.OldChatMember) {
+		return false
+	}
+	if !updateChatMember.NewChatMember.Equal(other.NewChatMember) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateChatMember *UpdateChatMember) Diff(other *UpdateChatMember) []Change {
+	return updateChatMember.diff("", other, nil)
+}
+
+func (updateChatMember *UpdateChatMember) diff(path string, other *UpdateChatMember, changes []Change) []Change {
+	if updateChatMember == nil || other == nil {
+		if updateChatMember != other {
+			changes = append(changes, Change{Path: path, Old: updateChatMember, New: other})
+		}
+		return changes
+	}
+	if updateChatMember.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateChatMember.ChatId, New: other.ChatId})
+	}
+	if updateChatMember.ActorUserId != other.ActorUserId {
+		changes = append(changes, Change{Path: fieldPath(path, "actor_user_id"), Old: updateChatMember.ActorUserId, New: other.ActorUserId})
+	}
+	if updateChatMember.Date != other.Date {
+		changes = append(changes, Change{Path: fieldPath(path, "date"), Old: updateChatMember.Date, New: other.Date})
+	}
+	changes = updateChatMember.InviteLink.diff(fieldPath(path, "invite_link"), other.InviteLink, changes)
+	changes = updateChatMember.OldChatMember.diff(fieldPath(path, "old_chat_member"), other.OldChatMember, changes)
+	changes = updateChatMember.NewChatMember.diff(fieldPath(path, "new_chat_member"), other.NewChatMember, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateChatMember *UpdateChatMember) String() string {
+	return objectString(updateChatMember)
+}
+
+// LogValue logs the object as its summary
+func (updateChatMember *UpdateChatMember) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateChatMember))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateChatMember *UpdateChatMember) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateChatMember)
+}
+
+func (updateChatMember *UpdateChatMember) printText(p *printer) {
+	if updateChatMember == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateChatMember")
+	p.int("chat_id", int64(updateChatMember.ChatId))
+	p.int("actor_user_id", int64(updateChatMember.ActorUserId))
+	p.int("date", int64(updateChatMember.Date))
+	p.object("invite_link", updateChatMember.InviteLink)
+	p.object("old_chat_member", updateChatMember.OldChatMember)
+	p.object("new_chat_member", updateChatMember.NewChatMember)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateChatMember *UpdateChatMember) GetUpdateEnum() UpdateEnum {
 	return UpdateChatMemberType
 }
 
+// Accept calls the method of the visitor for UpdateChatMember
+func (updateChatMember *UpdateChatMember) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateChatMember(updateChatMember)
+}
+
 // UpdateNewChatJoinRequest A user sent a join request to a chat; for bots only
 type UpdateNewChatJoinRequest struct {
 	tdCommon
@@ -4049,11 +15861,1600 @@ func NewUpdateNewChatJoinRequest(chatId int64, request *ChatJoinRequest, inviteL
 	return &updateNewChatJoinRequestTemp
 }
 
+// MarshalJSON marshals to json
+func (updateNewChatJoinRequest *UpdateNewChatJoinRequest) MarshalJSON() ([]byte, error) {
+	return updateNewChatJoinRequest.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updateNewChatJoinRequest *UpdateNewChatJoinRequest) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updateNewChatJoinRequest)
+}
+
+func (updateNewChatJoinRequest *UpdateNewChatJoinRequest) appendJSON(b []byte) ([]byte, error) {
+	if updateNewChatJoinRequest == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updateNewChatJoinRequest.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updateNewChatJoinRequest.tdCommon.Extra)
+	b = append(b, `,"chat_id":`...)
+	b = appendJSONInt(b, int64(updateNewChatJoinRequest.ChatId))
+	b = append(b, `,"request":`...)
+	b, err = updateNewChatJoinRequest.Request.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"invite_link":`...)
+	b, err = updateNewChatJoinRequest.InviteLink.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (updateNewChatJoinRequest *UpdateNewChatJoinRequest) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updateNewChatJoinRequest.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updateNewChatJoinRequest.tdCommon.Extra, err = r.readString()
+		case "chat_id":
+			updateNewChatJoinRequest.ChatId, err = r.readInt64()
+		case "request":
+			if r.readNull() {
+				updateNewChatJoinRequest.Request = nil
+			} else {
+				updateNewChatJoinRequest.Request = new(ChatJoinRequest)
+				err = updateNewChatJoinRequest.Request.decodeJSON(r)
+			}
+		case "invite_link":
+			if r.readNull() {
+				updateNewChatJoinRequest.InviteLink = nil
+			} else {
+				updateNewChatJoinRequest.InviteLink = new(ChatInviteLink)
+				err = updateNewChatJoinRequest.InviteLink.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updateNewChatJoinRequest *UpdateNewChatJoinRequest) Clone() *UpdateNewChatJoinRequest {
+	if updateNewChatJoinRequest == nil {
+		return nil
+	}
+	clone := *updateNewChatJoinRequest
+	clone.Request = updateNewChatJoinRequest.Request.Clone()
+	clone.InviteLink = updateNewChatJoinRequest.InviteLink.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updateNewChatJoinRequest *UpdateNewChatJoinRequest) Equal(other *UpdateNewChatJoinRequest) bool {
+	if updateNewChatJoinRequest == nil || other == nil {
+		return updateNewChatJoinRequest == other
+	}
+	if updateNewChatJoinRequest.ChatId != other.ChatId {
+		return false
+	}
+	if !updateNewChatJoinRequest.Request.Equal(other.Request) {
+		return false
+	}
+	if !updateNewChatJoinRequest.InviteLink.Equal(other.InviteLink) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updateNewChatJoinRequest *UpdateNewChatJoinRequest) Diff(other *UpdateNewChatJoinRequest) []Change {
+	return updateNewChatJoinRequest.diff("", other, nil)
+}
+
+func (updateNewChatJoinRequest *UpdateNewChatJoinRequest) diff(path string, other *UpdateNewChatJoinRequest, changes []Change) []Change {
+	if updateNewChatJoinRequest == nil || other == nil {
+		if updateNewChatJoinRequest != other {
+			changes = append(changes, Change{Path: path, Old: updateNewChatJoinRequest, New: other})
+		}
+		return changes
+	}
+	if updateNewChatJoinRequest.ChatId != other.ChatId {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_id"), Old: updateNewChatJoinRequest.ChatId, New: other.ChatId})
+	}
+	changes = updateNewChatJoinRequest.Request.diff(fieldPath(path, "request"), other.Request, changes)
+	changes = updateNewChatJoinRequest.InviteLink.diff(fieldPath(path, "invite_link"), other.InviteLink, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updateNewChatJoinRequest *UpdateNewChatJoinRequest) String() string {
+	return objectString(updateNewChatJoinRequest)
+}
+
+// LogValue logs the object as its summary
+func (updateNewChatJoinRequest *UpdateNewChatJoinRequest) LogValue() slog.Value {
+	return slog.StringValue(objectString(updateNewChatJoinRequest))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updateNewChatJoinRequest *UpdateNewChatJoinRequest) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updateNewChatJoinRequest)
+}
+
+func (updateNewChatJoinRequest *UpdateNewChatJoinRequest) printText(p *printer) {
+	if updateNewChatJoinRequest == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UpdateNewChatJoinRequest")
+	p.int("chat_id", int64(updateNewChatJoinRequest.ChatId))
+	p.object("request", updateNewChatJoinRequest.Request)
+	p.object("invite_link", updateNewChatJoinRequest.InviteLink)
+	p.endObject(state)
+}
+
 // GetUpdateEnum return the enum type of this object
 func (updateNewChatJoinRequest *UpdateNewChatJoinRequest) GetUpdateEnum() UpdateEnum {
 	return UpdateNewChatJoinRequestType
 }
 
+// Accept calls the method of the visitor for UpdateNewChatJoinRequest
+func (updateNewChatJoinRequest *UpdateNewChatJoinRequest) Accept(visitor UpdateVisitor) {
+	visitor.VisitUpdateNewChatJoinRequest(updateNewChatJoinRequest)
+}
+
+// UpdateVisitor visits every type of Update, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to Update, until they handle it too.
+type UpdateVisitor interface {
+	VisitUpdateAuthorizationState(updateAuthorizationState *UpdateAuthorizationState)
+	VisitUpdateNewMessage(updateNewMessage *UpdateNewMessage)
+	VisitUpdateMessageSendAcknowledged(updateMessageSendAcknowledged *UpdateMessageSendAcknowledged)
+	VisitUpdateMessageSendSucceeded(updateMessageSendSucceeded *UpdateMessageSendSucceeded)
+	VisitUpdateMessageSendFailed(updateMessageSendFailed *UpdateMessageSendFailed)
+	VisitUpdateMessageContent(updateMessageContent *UpdateMessageContent)
+	VisitUpdateMessageEdited(updateMessageEdited *UpdateMessageEdited)
+	VisitUpdateMessageIsPinned(updateMessageIsPinned *UpdateMessageIsPinned)
+	VisitUpdateMessageInteractionInfo(updateMessageInteractionInfo *UpdateMessageInteractionInfo)
+	VisitUpdateMessageContentOpened(updateMessageContentOpened *UpdateMessageContentOpened)
+	VisitUpdateMessageMentionRead(updateMessageMentionRead *UpdateMessageMentionRead)
+	VisitUpdateMessageLiveLocationViewed(updateMessageLiveLocationViewed *UpdateMessageLiveLocationViewed)
+	VisitUpdateNewChat(updateNewChat *UpdateNewChat)
+	VisitUpdateChatTitle(updateChatTitle *UpdateChatTitle)
+	VisitUpdateChatPhoto(updateChatPhoto *UpdateChatPhoto)
+	VisitUpdateChatPermissions(updateChatPermissions *UpdateChatPermissions)
+	VisitUpdateChatLastMessage(updateChatLastMessage *UpdateChatLastMessage)
+	VisitUpdateChatPosition(updateChatPosition *UpdateChatPosition)
+	VisitUpdateChatReadInbox(updateChatReadInbox *UpdateChatReadInbox)
+	VisitUpdateChatReadOutbox(updateChatReadOutbox *UpdateChatReadOutbox)
+	VisitUpdateChatActionBar(updateChatActionBar *UpdateChatActionBar)
+	VisitUpdateChatDraftMessage(updateChatDraftMessage *UpdateChatDraftMessage)
+	VisitUpdateChatMessageSender(updateChatMessageSender *UpdateChatMessageSender)
+	VisitUpdateChatMessageTtl(updateChatMessageTtl *UpdateChatMessageTtl)
+	VisitUpdateChatNotificationSettings(updateChatNotificationSettings *UpdateChatNotificationSettings)
+	VisitUpdateChatPendingJoinRequests(updateChatPendingJoinRequests *UpdateChatPendingJoinRequests)
+	VisitUpdateChatReplyMarkup(updateChatReplyMarkup *UpdateChatReplyMarkup)
+	VisitUpdateChatTheme(updateChatTheme *UpdateChatTheme)
+	VisitUpdateChatUnreadMentionCount(updateChatUnreadMentionCount *UpdateChatUnreadMentionCount)
+	VisitUpdateChatVideoChat(updateChatVideoChat *UpdateChatVideoChat)
+	VisitUpdateChatDefaultDisableNotification(updateChatDefaultDisableNotification *UpdateChatDefaultDisableNotification)
+	VisitUpdateChatHasProtectedContent(updateChatHasProtectedContent *UpdateChatHasProtectedContent)
+	VisitUpdateChatHasScheduledMessages(updateChatHasScheduledMessages *UpdateChatHasScheduledMessages)
+	VisitUpdateChatIsBlocked(updateChatIsBlocked *UpdateChatIsBlocked)
+	VisitUpdateChatIsMarkedAsUnread(updateChatIsMarkedAsUnread *UpdateChatIsMarkedAsUnread)
+	VisitUpdateChatFilters(updateChatFilters *UpdateChatFilters)
+	VisitUpdateChatOnlineMemberCount(updateChatOnlineMemberCount *UpdateChatOnlineMemberCount)
+	VisitUpdateScopeNotificationSettings(updateScopeNotificationSettings *UpdateScopeNotificationSettings)
+	VisitUpdateNotification(updateNotification *UpdateNotification)
+	VisitUpdateNotificationGroup(updateNotificationGroup *UpdateNotificationGroup)
+	VisitUpdateActiveNotifications(updateActiveNotifications *UpdateActiveNotifications)
+	VisitUpdateHavePendingNotifications(updateHavePendingNotifications *UpdateHavePendingNotifications)
+	VisitUpdateDeleteMessages(updateDeleteMessages *UpdateDeleteMessages)
+	VisitUpdateChatAction(updateChatAction *UpdateChatAction)
+	VisitUpdateUserStatus(updateUserStatus *UpdateUserStatus)
+	VisitUpdateUser(updateUser *UpdateUser)
+	VisitUpdateBasicGroup(updateBasicGroup *UpdateBasicGroup)
+	VisitUpdateSupergroup(updateSupergroup *UpdateSupergroup)
+	VisitUpdateSecretChat(updateSecretChat *UpdateSecretChat)
+	VisitUpdateUserFullInfo(updateUserFullInfo *UpdateUserFullInfo)
+	VisitUpdateBasicGroupFullInfo(updateBasicGroupFullInfo *UpdateBasicGroupFullInfo)
+	VisitUpdateSupergroupFullInfo(updateSupergroupFullInfo *UpdateSupergroupFullInfo)
+	VisitUpdateServiceNotification(updateServiceNotification *UpdateServiceNotification)
+	VisitUpdateFile(updateFile *UpdateFile)
+	VisitUpdateFileGenerationStart(updateFileGenerationStart *UpdateFileGenerationStart)
+	VisitUpdateFileGenerationStop(updateFileGenerationStop *UpdateFileGenerationStop)
+	VisitUpdateCall(updateCall *UpdateCall)
+	VisitUpdateGroupCall(updateGroupCall *UpdateGroupCall)
+	VisitUpdateGroupCallParticipant(updateGroupCallParticipant *UpdateGroupCallParticipant)
+	VisitUpdateNewCallSignalingData(updateNewCallSignalingData *UpdateNewCallSignalingData)
+	VisitUpdateUserPrivacySettingRules(updateUserPrivacySettingRules *UpdateUserPrivacySettingRules)
+	VisitUpdateUnreadMessageCount(updateUnreadMessageCount *UpdateUnreadMessageCount)
+	VisitUpdateUnreadChatCount(updateUnreadChatCount *UpdateUnreadChatCount)
+	VisitUpdateOption(updateOption *UpdateOption)
+	VisitUpdateStickerSet(updateStickerSet *UpdateStickerSet)
+	VisitUpdateInstalledStickerSets(updateInstalledStickerSets *UpdateInstalledStickerSets)
+	VisitUpdateTrendingStickerSets(updateTrendingStickerSets *UpdateTrendingStickerSets)
+	VisitUpdateRecentStickers(updateRecentStickers *UpdateRecentStickers)
+	VisitUpdateFavoriteStickers(updateFavoriteStickers *UpdateFavoriteStickers)
+	VisitUpdateSavedAnimations(updateSavedAnimations *UpdateSavedAnimations)
+	VisitUpdateSelectedBackground(updateSelectedBackground *UpdateSelectedBackground)
+	VisitUpdateChatThemes(updateChatThemes *UpdateChatThemes)
+	VisitUpdateLanguagePackStrings(updateLanguagePackStrings *UpdateLanguagePackStrings)
+	VisitUpdateConnectionState(updateConnectionState *UpdateConnectionState)
+	VisitUpdateTermsOfService(updateTermsOfService *UpdateTermsOfService)
+	VisitUpdateUsersNearby(updateUsersNearby *UpdateUsersNearby)
+	VisitUpdateDiceEmojis(updateDiceEmojis *UpdateDiceEmojis)
+	VisitUpdateAnimatedEmojiMessageClicked(updateAnimatedEmojiMessageClicked *UpdateAnimatedEmojiMessageClicked)
+	VisitUpdateAnimationSearchParameters(updateAnimationSearchParameters *UpdateAnimationSearchParameters)
+	VisitUpdateSuggestedActions(updateSuggestedActions *UpdateSuggestedActions)
+	VisitUpdateNewInlineQuery(updateNewInlineQuery *UpdateNewInlineQuery)
+	VisitUpdateNewChosenInlineResult(updateNewChosenInlineResult *UpdateNewChosenInlineResult)
+	VisitUpdateNewCallbackQuery(updateNewCallbackQuery *UpdateNewCallbackQuery)
+	VisitUpdateNewInlineCallbackQuery(updateNewInlineCallbackQuery *UpdateNewInlineCallbackQuery)
+	VisitUpdateNewShippingQuery(updateNewShippingQuery *UpdateNewShippingQuery)
+	VisitUpdateNewPreCheckoutQuery(updateNewPreCheckoutQuery *UpdateNewPreCheckoutQuery)
+	VisitUpdateNewCustomEvent(updateNewCustomEvent *UpdateNewCustomEvent)
+	VisitUpdateNewCustomQuery(updateNewCustomQuery *UpdateNewCustomQuery)
+	VisitUpdatePoll(updatePoll *UpdatePoll)
+	VisitUpdatePollAnswer(updatePollAnswer *UpdatePollAnswer)
+	VisitUpdateChatMember(updateChatMember *UpdateChatMember)
+	VisitUpdateNewChatJoinRequest(updateNewChatJoinRequest *UpdateNewChatJoinRequest)
+	VisitUnknownUpdate(unknownUpdate *UnknownUpdate)
+}
+
+// UpdateCases holds the function MatchUpdate calls for each type of Update;
+// types without a function are passed to the default case
+type UpdateCases[R any] struct {
+	UpdateAuthorizationState             func(updateAuthorizationState *UpdateAuthorizationState) R
+	UpdateNewMessage                     func(updateNewMessage *UpdateNewMessage) R
+	UpdateMessageSendAcknowledged        func(updateMessageSendAcknowledged *UpdateMessageSendAcknowledged) R
+	UpdateMessageSendSucceeded           func(updateMessageSendSucceeded *UpdateMessageSendSucceeded) R
+	UpdateMessageSendFailed              func(updateMessageSendFailed *UpdateMessageSendFailed) R
+	UpdateMessageContent                 func(updateMessageContent *UpdateMessageContent) R
+	UpdateMessageEdited                  func(updateMessageEdited *UpdateMessageEdited) R
+	UpdateMessageIsPinned                func(updateMessageIsPinned *UpdateMessageIsPinned) R
+	UpdateMessageInteractionInfo         func(updateMessageInteractionInfo *UpdateMessageInteractionInfo) R
+	UpdateMessageContentOpened           func(updateMessageContentOpened *UpdateMessageContentOpened) R
+	UpdateMessageMentionRead             func(updateMessageMentionRead *UpdateMessageMentionRead) R
+	UpdateMessageLiveLocationViewed      func(updateMessageLiveLocationViewed *UpdateMessageLiveLocationViewed) R
+	UpdateNewChat                        func(updateNewChat *UpdateNewChat) R
+	UpdateChatTitle                      func(updateChatTitle *UpdateChatTitle) R
+	UpdateChatPhoto                      func(updateChatPhoto *UpdateChatPhoto) R
+	UpdateChatPermissions                func(updateChatPermissions *UpdateChatPermissions) R
+	UpdateChatLastMessage                func(updateChatLastMessage *UpdateChatLastMessage) R
+	UpdateChatPosition                   func(updateChatPosition *UpdateChatPosition) R
+	UpdateChatReadInbox                  func(updateChatReadInbox *UpdateChatReadInbox) R
+	UpdateChatReadOutbox                 func(updateChatReadOutbox *UpdateChatReadOutbox) R
+	UpdateChatActionBar                  func(updateChatActionBar *UpdateChatActionBar) R
+	UpdateChatDraftMessage               func(updateChatDraftMessage *UpdateChatDraftMessage) R
+	UpdateChatMessageSender              func(updateChatMessageSender *UpdateChatMessageSender) R
+	UpdateChatMessageTtl                 func(updateChatMessageTtl *UpdateChatMessageTtl) R
+	UpdateChatNotificationSettings       func(updateChatNotificationSettings *UpdateChatNotificationSettings) R
+	UpdateChatPendingJoinRequests        func(updateChatPendingJoinRequests *UpdateChatPendingJoinRequests) R
+	UpdateChatReplyMarkup                func(updateChatReplyMarkup *UpdateChatReplyMarkup) R
+	UpdateChatTheme                      func(updateChatTheme *UpdateChatTheme) R
+	UpdateChatUnreadMentionCount         func(updateChatUnreadMentionCount *UpdateChatUnreadMentionCount) R
+	UpdateChatVideoChat                  func(updateChatVideoChat *UpdateChatVideoChat) R
+	UpdateChatDefaultDisableNotification func(updateChatDefaultDisableNotification *UpdateChatDefaultDisableNotification) R
+	UpdateChatHasProtectedContent        func(updateChatHasProtectedContent *UpdateChatHasProtectedContent) R
+	UpdateChatHasScheduledMessages       func(updateChatHasScheduledMessages *UpdateChatHasScheduledMessages) R
+	UpdateChatIsBlocked                  func(updateChatIsBlocked *UpdateChatIsBlocked) R
+	UpdateChatIsMarkedAsUnread           func(updateChatIsMarkedAsUnread *UpdateChatIsMarkedAsUnread) R
+	UpdateChatFilters                    func(updateChatFilters *UpdateChatFilters) R
+	UpdateChatOnlineMemberCount          func(updateChatOnlineMemberCount *UpdateChatOnlineMemberCount) R
+	UpdateScopeNotificationSettings      func(updateScopeNotificationSettings *UpdateScopeNotificationSettings) R
+	UpdateNotification                   func(updateNotification *UpdateNotification) R
+	UpdateNotificationGroup              func(updateNotificationGroup *UpdateNotificationGroup) R
+	UpdateActiveNotifications            func(updateActiveNotifications *UpdateActiveNotifications) R
+	UpdateHavePendingNotifications       func(updateHavePendingNotifications *UpdateHavePendingNotifications) R
+	UpdateDeleteMessages                 func(updateDeleteMessages *UpdateDeleteMessages) R
+	UpdateChatAction                     func(updateChatAction *UpdateChatAction) R
+	UpdateUserStatus                     func(updateUserStatus *UpdateUserStatus) R
+	UpdateUser                           func(updateUser *UpdateUser) R
+	UpdateBasicGroup                     func(updateBasicGroup *UpdateBasicGroup) R
+	UpdateSupergroup                     func(updateSupergroup *UpdateSupergroup) R
+	UpdateSecretChat                     func(updateSecretChat *UpdateSecretChat) R
+	UpdateUserFullInfo                   func(updateUserFullInfo *UpdateUserFullInfo) R
+	UpdateBasicGroupFullInfo             func(updateBasicGroupFullInfo *UpdateBasicGroupFullInfo) R
+	UpdateSupergroupFullInfo             func(updateSupergroupFullInfo *UpdateSupergroupFullInfo) R
+	UpdateServiceNotification            func(updateServiceNotification *UpdateServiceNotification) R
+	UpdateFile                           func(updateFile *UpdateFile) R
+	UpdateFileGenerationStart            func(updateFileGenerationStart *UpdateFileGenerationStart) R
+	UpdateFileGenerationStop             func(updateFileGenerationStop *UpdateFileGenerationStop) R
+	UpdateCall                           func(updateCall *UpdateCall) R
+	UpdateGroupCall                      func(updateGroupCall *UpdateGroupCall) R
+	UpdateGroupCallParticipant           func(updateGroupCallParticipant *UpdateGroupCallParticipant) R
+	UpdateNewCallSignalingData           func(updateNewCallSignalingData *UpdateNewCallSignalingData) R
+	UpdateUserPrivacySettingRules        func(updateUserPrivacySettingRules *UpdateUserPrivacySettingRules) R
+	UpdateUnreadMessageCount             func(updateUnreadMessageCount *UpdateUnreadMessageCount) R
+	UpdateUnreadChatCount                func(updateUnreadChatCount *UpdateUnreadChatCount) R
+	UpdateOption                         func(updateOption *UpdateOption) R
+	UpdateStickerSet                     func(updateStickerSet *UpdateStickerSet) R
+	UpdateInstalledStickerSets           func(updateInstalledStickerSets *UpdateInstalledStickerSets) R
+	UpdateTrendingStickerSets            func(updateTrendingStickerSets *UpdateTrendingStickerSets) R
+	UpdateRecentStickers                 func(updateRecentStickers *UpdateRecentStickers) R
+	UpdateFavoriteStickers               func(updateFavoriteStickers *UpdateFavoriteStickers) R
+	UpdateSavedAnimations                func(updateSavedAnimations *UpdateSavedAnimations) R
+	UpdateSelectedBackground             func(updateSelectedBackground *UpdateSelectedBackground) R
+	UpdateChatThemes                     func(updateChatThemes *UpdateChatThemes) R
+	UpdateLanguagePackStrings            func(updateLanguagePackStrings *UpdateLanguagePackStrings) R
+	UpdateConnectionState                func(updateConnectionState *UpdateConnectionState) R
+	UpdateTermsOfService                 func(updateTermsOfService *UpdateTermsOfService) R
+	UpdateUsersNearby                    func(updateUsersNearby *UpdateUsersNearby) R
+	UpdateDiceEmojis                     func(updateDiceEmojis *UpdateDiceEmojis) R
+	UpdateAnimatedEmojiMessageClicked    func(updateAnimatedEmojiMessageClicked *UpdateAnimatedEmojiMessageClicked) R
+	UpdateAnimationSearchParameters      func(updateAnimationSearchParameters *UpdateAnimationSearchParameters) R
+	UpdateSuggestedActions               func(updateSuggestedActions *UpdateSuggestedActions) R
+	UpdateNewInlineQuery                 func(updateNewInlineQuery *UpdateNewInlineQuery) R
+	UpdateNewChosenInlineResult          func(updateNewChosenInlineResult *UpdateNewChosenInlineResult) R
+	UpdateNewCallbackQuery               func(updateNewCallbackQuery *UpdateNewCallbackQuery) R
+	UpdateNewInlineCallbackQuery         func(updateNewInlineCallbackQuery *UpdateNewInlineCallbackQuery) R
+	UpdateNewShippingQuery               func(updateNewShippingQuery *UpdateNewShippingQuery) R
+	UpdateNewPreCheckoutQuery            func(updateNewPreCheckoutQuery *UpdateNewPreCheckoutQuery) R
+	UpdateNewCustomEvent                 func(updateNewCustomEvent *UpdateNewCustomEvent) R
+	UpdateNewCustomQuery                 func(updateNewCustomQuery *UpdateNewCustomQuery) R
+	UpdatePoll                           func(updatePoll *UpdatePoll) R
+	UpdatePollAnswer                     func(updatePollAnswer *UpdatePollAnswer) R
+	UpdateChatMember                     func(updateChatMember *UpdateChatMember) R
+	UpdateNewChatJoinRequest             func(updateNewChatJoinRequest *UpdateNewChatJoinRequest) R
+}
+
+// MatchUpdate calls the case of the type of update, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement UpdateVisitor
+// to have the compiler check every type is handled.
+func MatchUpdate[R any](update Update, cases UpdateCases[R], defaultCase func(update Update) R) R {
+	switch value := update.(type) {
+	case *UpdateAuthorizationState:
+		if cases.UpdateAuthorizationState != nil {
+			return cases.UpdateAuthorizationState(value)
+		}
+	case *UpdateNewMessage:
+		if cases.UpdateNewMessage != nil {
+			return cases.UpdateNewMessage(value)
+		}
+	case *UpdateMessageSendAcknowledged:
+		if cases.UpdateMessageSendAcknowledged != nil {
+			return cases.UpdateMessageSendAcknowledged(value)
+		}
+	case *UpdateMessageSendSucceeded:
+		if cases.UpdateMessageSendSucceeded != nil {
+			return cases.UpdateMessageSendSucceeded(value)
+		}
+	case *UpdateMessageSendFailed:
+		if cases.UpdateMessageSendFailed != nil {
+			return cases.UpdateMessageSendFailed(value)
+		}
+	case *UpdateMessageContent:
+		if cases.UpdateMessageContent != nil {
+			return cases.UpdateMessageContent(value)
+		}
+	case *UpdateMessageEdited:
+		if cases.UpdateMessageEdited != nil {
+			return cases.UpdateMessageEdited(value)
+		}
+	case *UpdateMessageIsPinned:
+		if cases.UpdateMessageIsPinned != nil {
+			return cases.UpdateMessageIsPinned(value)
+		}
+	case *UpdateMessageInteractionInfo:
+		if cases.UpdateMessageInteractionInfo != nil {
+			return cases.UpdateMessageInteractionInfo(value)
+		}
+	case *UpdateMessageContentOpened:
+		if cases.UpdateMessageContentOpened != nil {
+			return cases.UpdateMessageContentOpened(value)
+		}
+	case *UpdateMessageMentionRead:
+		if cases.UpdateMessageMentionRead != nil {
+			return cases.UpdateMessageMentionRead(value)
+		}
+	case *UpdateMessageLiveLocationViewed:
+		if cases.UpdateMessageLiveLocationViewed != nil {
+			return cases.UpdateMessageLiveLocationViewed(value)
+		}
+	case *UpdateNewChat:
+		if cases.UpdateNewChat != nil {
+			return cases.UpdateNewChat(value)
+		}
+	case *UpdateChatTitle:
+		if cases.UpdateChatTitle != nil {
+			return cases.UpdateChatTitle(value)
+		}
+	case *UpdateChatPhoto:
+		if cases.UpdateChatPhoto != nil {
+			return cases.UpdateChatPhoto(value)
+		}
+	case *UpdateChatPermissions:
+		if cases.UpdateChatPermissions != nil {
+			return cases.UpdateChatPermissions(value)
+		}
+	case *UpdateChatLastMessage:
+		if cases.UpdateChatLastMessage != nil {
+			return cases.UpdateChatLastMessage(value)
+		}
+	case *UpdateChatPosition:
+		if cases.UpdateChatPosition != nil {
+			return cases.UpdateChatPosition(value)
+		}
+	case *UpdateChatReadInbox:
+		if cases.UpdateChatReadInbox != nil {
+			return cases.UpdateChatReadInbox(value)
+		}
+	case *UpdateChatReadOutbox:
+		if cases.UpdateChatReadOutbox != nil {
+			return cases.UpdateChatReadOutbox(value)
+		}
+	case *UpdateChatActionBar:
+		if cases.UpdateChatActionBar != nil {
+			return cases.UpdateChatActionBar(value)
+		}
+	case *UpdateChatDraftMessage:
+		if cases.UpdateChatDraftMessage != nil {
+			return cases.UpdateChatDraftMessage(value)
+		}
+	case *UpdateChatMessageSender:
+		if cases.UpdateChatMessageSender != nil {
+			return cases.UpdateChatMessageSender(value)
+		}
+	case *UpdateChatMessageTtl:
+		if cases.UpdateChatMessageTtl != nil {
+			return cases.UpdateChatMessageTtl(value)
+		}
+	case *UpdateChatNotificationSettings:
+		if cases.UpdateChatNotificationSettings != nil {
+			return cases.UpdateChatNotificationSettings(value)
+		}
+	case *UpdateChatPendingJoinRequests:
+		if cases.UpdateChatPendingJoinRequests != nil {
+			return cases.UpdateChatPendingJoinRequests(value)
+		}
+	case *UpdateChatReplyMarkup:
+		if cases.UpdateChatReplyMarkup != nil {
+			return cases.UpdateChatReplyMarkup(value)
+		}
+	case *UpdateChatTheme:
+		if cases.UpdateChatTheme != nil {
+			return cases.UpdateChatTheme(value)
+		}
+	case *UpdateChatUnreadMentionCount:
+		if cases.UpdateChatUnreadMentionCount != nil {
+			return cases.UpdateChatUnreadMentionCount(value)
+		}
+	case *UpdateChatVideoChat:
+		if cases.UpdateChatVideoChat != nil {
+			return cases.UpdateChatVideoChat(value)
+		}
+	case *UpdateChatDefaultDisableNotification:
+		if cases.UpdateChatDefaultDisableNotification != nil {
+			return cases.UpdateChatDefaultDisableNotification(value)
+		}
+	case *UpdateChatHasProtectedContent:
+		if cases.UpdateChatHasProtectedContent != nil {
+			return cases.UpdateChatHasProtectedContent(value)
+		}
+	case *UpdateChatHasScheduledMessages:
+		if cases.UpdateChatHasScheduledMessages != nil {
+			return cases.UpdateChatHasScheduledMessages(value)
+		}
+	case *UpdateChatIsBlocked:
+		if cases.UpdateChatIsBlocked != nil {
+			return cases.UpdateChatIsBlocked(value)
+		}
+	case *UpdateChatIsMarkedAsUnread:
+		if cases.UpdateChatIsMarkedAsUnread != nil {
+			return cases.UpdateChatIsMarkedAsUnread(value)
+		}
+	case *UpdateChatFilters:
+		if cases.UpdateChatFilters != nil {
+			return cases.UpdateChatFilters(value)
+		}
+	case *UpdateChatOnlineMemberCount:
+		if cases.UpdateChatOnlineMemberCount != nil {
+			return cases.UpdateChatOnlineMemberCount(value)
+		}
+	case *UpdateScopeNotificationSettings:
+		if cases.UpdateScopeNotificationSettings != nil {
+			return cases.UpdateScopeNotificationSettings(value)
+		}
+	case *UpdateNotification:
+		if cases.UpdateNotification != nil {
+			return cases.UpdateNotification(value)
+		}
+	case *UpdateNotificationGroup:
+		if cases.UpdateNotificationGroup != nil {
+			return cases.UpdateNotificationGroup(value)
+		}
+	case *UpdateActiveNotifications:
+		if cases.UpdateActiveNotifications != nil {
+			return cases.UpdateActiveNotifications(value)
+		}
+	case *UpdateHavePendingNotifications:
+		if cases.UpdateHavePendingNotifications != nil {
+			return cases.UpdateHavePendingNotifications(value)
+		}
+	case *UpdateDeleteMessages:
+		if cases.UpdateDeleteMessages != nil {
+			return cases.UpdateDeleteMessages(value)
+		}
+	case *UpdateChatAction:
+		if cases.UpdateChatAction != nil {
+			return cases.UpdateChatAction(value)
+		}
+	case *UpdateUserStatus:
+		if cases.UpdateUserStatus != nil {
+			return cases.UpdateUserStatus(value)
+		}
+	case *UpdateUser:
+		if cases.UpdateUser != nil {
+			return cases.UpdateUser(value)
+		}
+	case *UpdateBasicGroup:
+		if cases.UpdateBasicGroup != nil {
+			return cases.UpdateBasicGroup(value)
+		}
+	case *UpdateSupergroup:
+		if cases.UpdateSupergroup != nil {
+			return cases.UpdateSupergroup(value)
+		}
+	case *UpdateSecretChat:
+		if cases.UpdateSecretChat != nil {
+			return cases.UpdateSecretChat(value)
+		}
+	case *UpdateUserFullInfo:
+		if cases.UpdateUserFullInfo != nil {
+			return cases.UpdateUserFullInfo(value)
+		}
+	case *UpdateBasicGroupFullInfo:
+		if cases.UpdateBasicGroupFullInfo != nil {
+			return cases.UpdateBasicGroupFullInfo(value)
+		}
+	case *UpdateSupergroupFullInfo:
+		if cases.UpdateSupergroupFullInfo != nil {
+			return cases.UpdateSupergroupFullInfo(value)
+		}
+	case *UpdateServiceNotification:
+		if cases.UpdateServiceNotification != nil {
+			return cases.UpdateServiceNotification(value)
+		}
+	case *UpdateFile:
+		if cases.UpdateFile != nil {
+			return cases.UpdateFile(value)
+		}
+	case *UpdateFileGenerationStart:
+		if cases.UpdateFileGenerationStart != nil {
+			return cases.UpdateFileGenerationStart(value)
+		}
+	case *UpdateFileGenerationStop:
+		if cases.UpdateFileGenerationStop != nil {
+			return cases.UpdateFileGenerationStop(value)
+		}
+	case *UpdateCall:
+		if cases.UpdateCall != nil {
+			return cases.UpdateCall(value)
+		}
+	case *UpdateGroupCall:
+		if cases.UpdateGroupCall != nil {
+			return cases.UpdateGroupCall(value)
+		}
+	case *UpdateGroupCallParticipant:
+		if cases.UpdateGroupCallParticipant != nil {
+			return cases.UpdateGroupCallParticipant(value)
+		}
+	case *UpdateNewCallSignalingData:
+		if cases.UpdateNewCallSignalingData != nil {
+			return cases.UpdateNewCallSignalingData(value)
+		}
+	case *UpdateUserPrivacySettingRules:
+		if cases.UpdateUserPrivacySettingRules != nil {
+			return cases.UpdateUserPrivacySettingRules(value)
+		}
+	case *UpdateUnreadMessageCount:
+		if cases.UpdateUnreadMessageCount != nil {
+			return cases.UpdateUnreadMessageCount(value)
+		}
+	case *UpdateUnreadChatCount:
+		if cases.UpdateUnreadChatCount != nil {
+			return cases.UpdateUnreadChatCount(value)
+		}
+	case *UpdateOption:
+		if cases.UpdateOption != nil {
+			return cases.UpdateOption(value)
+		}
+	case *UpdateStickerSet:
+		if cases.UpdateStickerSet != nil {
+			return cases.UpdateStickerSet(value)
+		}
+	case *UpdateInstalledStickerSets:
+		if cases.UpdateInstalledStickerSets != nil {
+			return cases.UpdateInstalledStickerSets(value)
+		}
+	case *UpdateTrendingStickerSets:
+		if cases.UpdateTrendingStickerSets != nil {
+			return cases.UpdateTrendingStickerSets(value)
+		}
+	case *UpdateRecentStickers:
+		if cases.UpdateRecentStickers != nil {
+			return cases.UpdateRecentStickers(value)
+		}
+	case *UpdateFavoriteStickers:
+		if cases.UpdateFavoriteStickers != nil {
+			return cases.UpdateFavoriteStickers(value)
+		}
+	case *UpdateSavedAnimations:
+		if cases.UpdateSavedAnimations != nil {
+			return cases.UpdateSavedAnimations(value)
+		}
+	case *UpdateSelectedBackground:
+		if cases.UpdateSelectedBackground != nil {
+			return cases.UpdateSelectedBackground(value)
+		}
+	case *UpdateChatThemes:
+		if cases.UpdateChatThemes != nil {
+			return cases.UpdateChatThemes(value)
+		}
+	case *UpdateLanguagePackStrings:
+		if cases.UpdateLanguagePackStrings != nil {
+			return cases.UpdateLanguagePackStrings(value)
+		}
+	case *UpdateConnectionState:
+		if cases.UpdateConnectionState != nil {
+			return cases.UpdateConnectionState(value)
+		}
+	case *UpdateTermsOfService:
+		if cases.UpdateTermsOfService != nil {
+			return cases.UpdateTermsOfService(value)
+		}
+	case *UpdateUsersNearby:
+		if cases.UpdateUsersNearby != nil {
+			return cases.UpdateUsersNearby(value)
+		}
+	case *UpdateDiceEmojis:
+		if cases.UpdateDiceEmojis != nil {
+			return cases.UpdateDiceEmojis(value)
+		}
+	case *UpdateAnimatedEmojiMessageClicked:
+		if cases.UpdateAnimatedEmojiMessageClicked != nil {
+			return cases.UpdateAnimatedEmojiMessageClicked(value)
+		}
+	case *UpdateAnimationSearchParameters:
+		if cases.UpdateAnimationSearchParameters != nil {
+			return cases.UpdateAnimationSearchParameters(value)
+		}
+	case *UpdateSuggestedActions:
+		if cases.UpdateSuggestedActions != nil {
+			return cases.UpdateSuggestedActions(value)
+		}
+	case *UpdateNewInlineQuery:
+		if cases.UpdateNewInlineQuery != nil {
+			return cases.UpdateNewInlineQuery(value)
+		}
+	case *UpdateNewChosenInlineResult:
+		if cases.UpdateNewChosenInlineResult != nil {
+			return cases.UpdateNewChosenInlineResult(value)
+		}
+	case *UpdateNewCallbackQuery:
+		if cases.UpdateNewCallbackQuery != nil {
+			return cases.UpdateNewCallbackQuery(value)
+		}
+	case *UpdateNewInlineCallbackQuery:
+		if cases.UpdateNewInlineCallbackQuery != nil {
+			return cases.UpdateNewInlineCallbackQuery(value)
+		}
+	case *UpdateNewShippingQuery:
+		if cases.UpdateNewShippingQuery != nil {
+			return cases.UpdateNewShippingQuery(value)
+		}
+	case *UpdateNewPreCheckoutQuery:
+		if cases.UpdateNewPreCheckoutQuery != nil {
+			return cases.UpdateNewPreCheckoutQuery(value)
+		}
+	case *UpdateNewCustomEvent:
+		if cases.UpdateNewCustomEvent != nil {
+			return cases.UpdateNewCustomEvent(value)
+		}
+	case *UpdateNewCustomQuery:
+		if cases.UpdateNewCustomQuery != nil {
+			return cases.UpdateNewCustomQuery(value)
+		}
+	case *UpdatePoll:
+		if cases.UpdatePoll != nil {
+			return cases.UpdatePoll(value)
+		}
+	case *UpdatePollAnswer:
+		if cases.UpdatePollAnswer != nil {
+			return cases.UpdatePollAnswer(value)
+		}
+	case *UpdateChatMember:
+		if cases.UpdateChatMember != nil {
+			return cases.UpdateChatMember(value)
+		}
+	case *UpdateNewChatJoinRequest:
+		if cases.UpdateNewChatJoinRequest != nil {
+			return cases.UpdateNewChatJoinRequest(value)
+		}
+	}
+	return defaultCase(update)
+}
+
+// Clone returns a deep copy of the object
+func (unknownUpdate *UnknownUpdate) Clone() *UnknownUpdate {
+	if unknownUpdate == nil {
+		return nil
+	}
+	return &UnknownUpdate{Unknown: unknownUpdate.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownUpdate *UnknownUpdate) Equal(other *UnknownUpdate) bool {
+	if unknownUpdate == nil || other == nil {
+		return unknownUpdate == other
+	}
+	return unknownUpdate.Unknown.equal(other.Unknown)
+}
+
+func cloneUpdate(update Update) Update {
+	switch value := update.(type) {
+	case *UpdateAuthorizationState:
+		return value.Clone()
+	case *UpdateNewMessage:
+		return value.Clone()
+	case *UpdateMessageSendAcknowledged:
+		return value.Clone()
+	case *UpdateMessageSendSucceeded:
+		return value.Clone()
+	case *UpdateMessageSendFailed:
+		return value.Clone()
+	case *UpdateMessageContent:
+		return value.Clone()
+	case *UpdateMessageEdited:
+		return value.Clone()
+	case *UpdateMessageIsPinned:
+		return value.Clone()
+	case *UpdateMessageInteractionInfo:
+		return value.Clone()
+	case *UpdateMessageContentOpened:
+		return value.Clone()
+	case *UpdateMessageMentionRead:
+		return value.Clone()
+	case *UpdateMessageLiveLocationViewed:
+		return value.Clone()
+	case *UpdateNewChat:
+		return value.Clone()
+	case *UpdateChatTitle:
+		return value.Clone()
+	case *UpdateChatPhoto:
+		return value.Clone()
+	case *UpdateChatPermissions:
+		return value.Clone()
+	case *UpdateChatLastMessage:
+		return value.Clone()
+	case *UpdateChatPosition:
+		return value.Clone()
+	case *UpdateChatReadInbox:
+		return value.Clone()
+	case *UpdateChatReadOutbox:
+		return value.Clone()
+	case *UpdateChatActionBar:
+		return value.Clone()
+	case *UpdateChatDraftMessage:
+		return value.Clone()
+	case *UpdateChatMessageSender:
+		return value.Clone()
+	case *UpdateChatMessageTtl:
+		return value.Clone()
+	case *UpdateChatNotificationSettings:
+		return value.Clone()
+	case *UpdateChatPendingJoinRequests:
+		return value.Clone()
+	case *UpdateChatReplyMarkup:
+		return value.Clone()
+	case *UpdateChatTheme:
+		return value.Clone()
+	case *UpdateChatUnreadMentionCount:
+		return value.Clone()
+	case *UpdateChatVideoChat:
+		return value.Clone()
+	case *UpdateChatDefaultDisableNotification:
+		return value.Clone()
+	case *UpdateChatHasProtectedContent:
+		return value.Clone()
+	case *UpdateChatHasScheduledMessages:
+		return value.Clone()
+	case *UpdateChatIsBlocked:
+		return value.Clone()
+	case *UpdateChatIsMarkedAsUnread:
+		return value.Clone()
+	case *UpdateChatFilters:
+		return value.Clone()
+	case *UpdateChatOnlineMemberCount:
+		return value.Clone()
+	case *UpdateScopeNotificationSettings:
+		return value.Clone()
+	case *UpdateNotification:
+		return value.Clone()
+	case *UpdateNotificationGroup:
+		return value.Clone()
+	case *UpdateActiveNotifications:
+		return value.Clone()
+	case *UpdateHavePendingNotifications:
+		return value.Clone()
+	case *UpdateDeleteMessages:
+		return value.Clone()
+	case *UpdateChatAction:
+		return value.Clone()
+	case *UpdateUserStatus:
+		return value.Clone()
+	case *UpdateUser:
+		return value.Clone()
+	case *UpdateBasicGroup:
+		return value.Clone()
+	case *UpdateSupergroup:
+		return value.Clone()
+	case *UpdateSecretChat:
+		return value.Clone()
+	case *UpdateUserFullInfo:
+		return value.Clone()
+	case *UpdateBasicGroupFullInfo:
+		return value.Clone()
+	case *UpdateSupergroupFullInfo:
+		return value.Clone()
+	case *UpdateServiceNotification:
+		return value.Clone()
+	case *UpdateFile:
+		return value.Clone()
+	case *UpdateFileGenerationStart:
+		return value.Clone()
+	case *UpdateFileGenerationStop:
+		return value.Clone()
+	case *UpdateCall:
+		return value.Clone()
+	case *UpdateGroupCall:
+		return value.Clone()
+	case *UpdateGroupCallParticipant:
+		return value.Clone()
+	case *UpdateNewCallSignalingData:
+		return value.Clone()
+	case *UpdateUserPrivacySettingRules:
+		return value.Clone()
+	case *UpdateUnreadMessageCount:
+		return value.Clone()
+	case *UpdateUnreadChatCount:
+		return value.Clone()
+	case *UpdateOption:
+		return value.Clone()
+	case *UpdateStickerSet:
+		return value.Clone()
+	case *UpdateInstalledStickerSets:
+		return value.Clone()
+	case *UpdateTrendingStickerSets:
+		return value.Clone()
+	case *UpdateRecentStickers:
+		return value.Clone()
+	case *UpdateFavoriteStickers:
+		return value.Clone()
+	case *UpdateSavedAnimations:
+		return value.Clone()
+	case *UpdateSelectedBackground:
+		return value.Clone()
+	case *UpdateChatThemes:
+		return value.Clone()
+	case *UpdateLanguagePackStrings:
+		return value.Clone()
+	case *UpdateConnectionState:
+		return value.Clone()
+	case *UpdateTermsOfService:
+		return value.Clone()
+	case *UpdateUsersNearby:
+		return value.Clone()
+	case *UpdateDiceEmojis:
+		return value.Clone()
+	case *UpdateAnimatedEmojiMessageClicked:
+		return value.Clone()
+	case *UpdateAnimationSearchParameters:
+		return value.Clone()
+	case *UpdateSuggestedActions:
+		return value.Clone()
+	case *UpdateNewInlineQuery:
+		return value.Clone()
+	case *UpdateNewChosenInlineResult:
+		return value.Clone()
+	case *UpdateNewCallbackQuery:
+		return value.Clone()
+	case *UpdateNewInlineCallbackQuery:
+		return value.Clone()
+	case *UpdateNewShippingQuery:
+		return value.Clone()
+	case *UpdateNewPreCheckoutQuery:
+		return value.Clone()
+	case *UpdateNewCustomEvent:
+		return value.Clone()
+	case *UpdateNewCustomQuery:
+		return value.Clone()
+	case *UpdatePoll:
+		return value.Clone()
+	case *UpdatePollAnswer:
+		return value.Clone()
+	case *UpdateChatMember:
+		return value.Clone()
+	case *UpdateNewChatJoinRequest:
+		return value.Clone()
+	case *UnknownUpdate:
+		return value.Clone()
+	}
+	return update
+}
+
+func equalUpdate(a Update, b Update) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *UpdateAuthorizationState:
+		b, ok := b.(*UpdateAuthorizationState)
+		return ok && a.Equal(b)
+	case *UpdateNewMessage:
+		b, ok := b.(*UpdateNewMessage)
+		return ok && a.Equal(b)
+	case *UpdateMessageSendAcknowledged:
+		b, ok := b.(*UpdateMessageSendAcknowledged)
+		return ok && a.Equal(b)
+	case *UpdateMessageSendSucceeded:
+		b, ok := b.(*UpdateMessageSendSucceeded)
+		return ok && a.Equal(b)
+	case *UpdateMessageSendFailed:
+		b, ok := b.(*UpdateMessageSendFailed)
+		return ok && a.Equal(b)
+	case *UpdateMessageContent:
+		b, ok := b.(*UpdateMessageContent)
+		return ok && a.Equal(b)
+	case *UpdateMessageEdited:
+		b, ok := b.(*UpdateMessageEdited)
+		return ok && a.Equal(b)
+	case *UpdateMessageIsPinned:
+		b, ok := b.(*UpdateMessageIsPinned)
+		return ok && a.Equal(b)
+	case *UpdateMessageInteractionInfo:
+		b, ok := b.(*UpdateMessageInteractionInfo)
+		return ok && a.Equal(b)
+	case *UpdateMessageContentOpened:
+		b, ok := b.(*UpdateMessageContentOpened)
+		return ok && a.Equal(b)
+	case *UpdateMessageMentionRead:
+		b, ok := b.(*UpdateMessageMentionRead)
+		return ok && a.Equal(b)
+	case *UpdateMessageLiveLocationViewed:
+		b, ok := b.(*UpdateMessageLiveLocationViewed)
+		return ok && a.Equal(b)
+	case *UpdateNewChat:
+		b, ok := b.(*UpdateNewChat)
+		return ok && a.Equal(b)
+	case *UpdateChatTitle:
+		b, ok := b.(*UpdateChatTitle)
+		return ok && a.Equal(b)
+	case *UpdateChatPhoto:
+		b, ok := b.(*UpdateChatPhoto)
+		return ok && a.Equal(b)
+	case *UpdateChatPermissions:
+		b, ok := b.(*UpdateChatPermissions)
+		return ok && a.Equal(b)
+	case *UpdateChatLastMessage:
+		b, ok := b.(*UpdateChatLastMessage)
+		return ok && a.Equal(b)
+	case *UpdateChatPosition:
+		b, ok := b.(*UpdateChatPosition)
+		return ok && a.Equal(b)
+	case *UpdateChatReadInbox:
+		b, ok := b.(*UpdateChatReadInbox)
+		return ok && a.Equal(b)
+	case *UpdateChatReadOutbox:
+		b, ok := b.(*UpdateChatReadOutbox)
+		return ok && a.Equal(b)
+	case *UpdateChatActionBar:
+		b, ok := b.(*UpdateChatActionBar)
+		return ok && a.Equal(b)
+	case *UpdateChatDraftMessage:
+		b, ok := b.(*UpdateChatDraftMessage)
+		return ok && a.Equal(b)
+	case *UpdateChatMessageSender:
+		b, ok := b.(*UpdateChatMessageSender)
+		return ok && a.Equal(b)
+	case *UpdateChatMessageTtl:
+		b, ok := b.(*UpdateChatMessageTtl)
+		return ok && a.Equal(b)
+	case *UpdateChatNotificationSettings:
+		b, ok := b.(*UpdateChatNotificationSettings)
+		return ok && a.Equal(b)
+	case *UpdateChatPendingJoinRequests:
+		b, ok := b.(*UpdateChatPendingJoinRequests)
+		return ok && a.Equal(b)
+	case *UpdateChatReplyMarkup:
+		b, ok := b.(*UpdateChatReplyMarkup)
+		return ok && a.Equal(b)
+	case *UpdateChatTheme:
+		b, ok := b.(*UpdateChatTheme)
+		return ok && a.Equal(b)
+	case *UpdateChatUnreadMentionCount:
+		b, ok := b.(*UpdateChatUnreadMentionCount)
+		return ok && a.Equal(b)
+	case *UpdateChatVideoChat:
+		b, ok := b.(*UpdateChatVideoChat)
+		return ok && a.Equal(b)
+	case *UpdateChatDefaultDisableNotification:
+		b, ok := b.(*UpdateChatDefaultDisableNotification)
+		return ok && a.Equal(b)
+	case *UpdateChatHasProtectedContent:
+		b, ok := b.(*UpdateChatHasProtectedContent)
+		return ok && a.Equal(b)
+	case *UpdateChatHasScheduledMessages:
+		b, ok := b.(*UpdateChatHasScheduledMessages)
+		return ok && a.Equal(b)
+	case *UpdateChatIsBlocked:
+		b, ok := b.(*UpdateChatIsBlocked)
+		return ok && a.Equal(b)
+	case *UpdateChatIsMarkedAsUnread:
+		b, ok := b.(*UpdateChatIsMarkedAsUnread)
+		return ok && a.Equal(b)
+	case *UpdateChatFilters:
+		b, ok := b.(*UpdateChatFilters)
+		return ok && a.Equal(b)
+	case *UpdateChatOnlineMemberCount:
+		b, ok := b.(*UpdateChatOnlineMemberCount)
+		return ok && a.Equal(b)
+	case *UpdateScopeNotificationSettings:
+		b, ok := b.(*UpdateScopeNotificationSettings)
+		return ok && a.Equal(b)
+	case *UpdateNotification:
+		b, ok := b.(*UpdateNotification)
+		return ok && a.Equal(b)
+	case *UpdateNotificationGroup:
+		b, ok := b.(*UpdateNotificationGroup)
+		return ok && a.Equal(b)
+	case *UpdateActiveNotifications:
+		b, ok := b.(*UpdateActiveNotifications)
+		return ok && a.Equal(b)
+	case *UpdateHavePendingNotifications:
+		b, ok := b.(*UpdateHavePendingNotifications)
+		return ok && a.Equal(b)
+	case *UpdateDeleteMessages:
+		b, ok := b.(*UpdateDeleteMessages)
+		return ok && a.Equal(b)
+	case *UpdateChatAction:
+		b, ok := b.(*UpdateChatAction)
+		return ok && a.Equal(b)
+	case *UpdateUserStatus:
+		b, ok := b.(*UpdateUserStatus)
+		return ok && a.Equal(b)
+	case *UpdateUser:
+		b, ok := b.(*UpdateUser)
+		return ok && a.Equal(b)
+	case *UpdateBasicGroup:
+		b, ok := b.(*UpdateBasicGroup)
+		return ok && a.Equal(b)
+	case *UpdateSupergroup:
+		b, ok := b.(*UpdateSupergroup)
+		return ok && a.Equal(b)
+	case *UpdateSecretChat:
+		b, ok := b.(*UpdateSecretChat)
+		return ok && a.Equal(b)
+	case *UpdateUserFullInfo:
+		b, ok := b.(*UpdateUserFullInfo)
+		return ok && a.Equal(b)
+	case *UpdateBasicGroupFullInfo:
+		b, ok := b.(*UpdateBasicGroupFullInfo)
+		return ok && a.Equal(b)
+	case *UpdateSupergroupFullInfo:
+		b, ok := b.(*UpdateSupergroupFullInfo)
+		return ok && a.Equal(b)
+	case *UpdateServiceNotification:
+		b, ok := b.(*UpdateServiceNotification)
+		return ok && a.Equal(b)
+	case *UpdateFile:
+		b, ok := b.(*UpdateFile)
+		return ok && a.Equal(b)
+	case *UpdateFileGenerationStart:
+		b, ok := b.(*UpdateFileGenerationStart)
+		return ok && a.Equal(b)
+	case *UpdateFileGenerationStop:
+		b, ok := b.(*UpdateFileGenerationStop)
+		return ok && a.Equal(b)
+	case *UpdateCall:
+		b, ok := b.(*UpdateCall)
+		return ok && a.Equal(b)
+	case *UpdateGroupCall:
+		b, ok := b.(*UpdateGroupCall)
+		return ok && a.Equal(b)
+	case *UpdateGroupCallParticipant:
+		b, ok := b.(*UpdateGroupCallParticipant)
+		return ok && a.Equal(b)
+	case *UpdateNewCallSignalingData:
+		b, ok := b.(*UpdateNewCallSignalingData)
+		return ok && a.Equal(b)
+	case *UpdateUserPrivacySettingRules:
+		b, ok := b.(*UpdateUserPrivacySettingRules)
+		return ok && a.Equal(b)
+	case *UpdateUnreadMessageCount:
+		b, ok := b.(*UpdateUnreadMessageCount)
+		return ok && a.Equal(b)
+	case *UpdateUnreadChatCount:
+		b, ok := b.(*UpdateUnreadChatCount)
+		return ok && a.Equal(b)
+	case *UpdateOption:
+		b, ok := b.(*UpdateOption)
+		return ok && a.Equal(b)
+	case *UpdateStickerSet:
+		b, ok := b.(*UpdateStickerSet)
+		return ok && a.Equal(b)
+	case *UpdateInstalledStickerSets:
+		b, ok := b.(*UpdateInstalledStickerSets)
+		return ok && a.Equal(b)
+	case *UpdateTrendingStickerSets:
+		b, ok := b.(*UpdateTrendingStickerSets)
+		return ok && a.Equal(b)
+	case *UpdateRecentStickers:
+		b, ok := b.(*UpdateRecentStickers)
+		return ok && a.Equal(b)
+	case *UpdateFavoriteStickers:
+		b, ok := b.(*UpdateFavoriteStickers)
+		return ok && a.Equal(b)
+	case *UpdateSavedAnimations:
+		b, ok := b.(*UpdateSavedAnimations)
+		return ok && a.Equal(b)
+	case *UpdateSelectedBackground:
+		b, ok := b.(*UpdateSelectedBackground)
+		return ok && a.Equal(b)
+	case *UpdateChatThemes:
+		b, ok := b.(*UpdateChatThemes)
+		return ok && a.Equal(b)
+	case *UpdateLanguagePackStrings:
+		b, ok := b.(*UpdateLanguagePackStrings)
+		return ok && a.Equal(b)
+	case *UpdateConnectionState:
+		b, ok := b.(*UpdateConnectionState)
+		return ok && a.Equal(b)
+	case *UpdateTermsOfService:
+		b, ok := b.(*UpdateTermsOfService)
+		return ok && a.Equal(b)
+	case *UpdateUsersNearby:
+		b, ok := b.(*UpdateUsersNearby)
+		return ok && a.Equal(b)
+	case *UpdateDiceEmojis:
+		b, ok := b.(*UpdateDiceEmojis)
+		return ok && a.Equal(b)
+	case *UpdateAnimatedEmojiMessageClicked:
+		b, ok := b.(*UpdateAnimatedEmojiMessageClicked)
+		return ok && a.Equal(b)
+	case *UpdateAnimationSearchParameters:
+		b, ok := b.(*UpdateAnimationSearchParameters)
+		return ok && a.Equal(b)
+	case *UpdateSuggestedActions:
+		b, ok := b.(*UpdateSuggestedActions)
+		return ok && a.Equal(b)
+	case *UpdateNewInlineQuery:
+		b, ok := b.(*UpdateNewInlineQuery)
+		return ok && a.Equal(b)
+	case *UpdateNewChosenInlineResult:
+		b, ok := b.(*UpdateNewChosenInlineResult)
+		return ok && a.Equal(b)
+	case *UpdateNewCallbackQuery:
+		b, ok := b.(*UpdateNewCallbackQuery)
+		return ok && a.Equal(b)
+	case *UpdateNewInlineCallbackQuery:
+		b, ok := b.(*UpdateNewInlineCallbackQuery)
+		return ok && a.Equal(b)
+	case *UpdateNewShippingQuery:
+		b, ok := b.(*UpdateNewShippingQuery)
+		return ok && a.Equal(b)
+	case *UpdateNewPreCheckoutQuery:
+		b, ok := b.(*UpdateNewPreCheckoutQuery)
+		return ok && a.Equal(b)
+	case *UpdateNewCustomEvent:
+		b, ok := b.(*UpdateNewCustomEvent)
+		return ok && a.Equal(b)
+	case *UpdateNewCustomQuery:
+		b, ok := b.(*UpdateNewCustomQuery)
+		return ok && a.Equal(b)
+	case *UpdatePoll:
+		b, ok := b.(*UpdatePoll)
+		return ok && a.Equal(b)
+	case *UpdatePollAnswer:
+		b, ok := b.(*UpdatePollAnswer)
+		return ok && a.Equal(b)
+	case *UpdateChatMember:
+		b, ok := b.(*UpdateChatMember)
+		return ok && a.Equal(b)
+	case *UpdateNewChatJoinRequest:
+		b, ok := b.(*UpdateNewChatJoinRequest)
+		return ok && a.Equal(b)
+	case *UnknownUpdate:
+		b, ok := b.(*UnknownUpdate)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffUpdate(path string, a Update, b Update, changes []Change) []Change {
+	switch a := a.(type) {
+	case *UpdateAuthorizationState:
+		if b, ok := b.(*UpdateAuthorizationState); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateNewMessage:
+		if b, ok := b.(*UpdateNewMessage); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateMessageSendAcknowledged:
+		if b, ok := b.(*UpdateMessageSendAcknowledged); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateMessageSendSucceeded:
+		if b, ok := b.(*UpdateMessageSendSucceeded); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateMessageSendFailed:
+		if b, ok := b.(*UpdateMessageSendFailed); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateMessageContent:
+		if b, ok := b.(*UpdateMessageContent); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateMessageEdited:
+		if b, ok := b.(*UpdateMessageEdited); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateMessageIsPinned:
+		if b, ok := b.(*UpdateMessageIsPinned); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateMessageInteractionInfo:
+		if b, ok := b.(*UpdateMessageInteractionInfo); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateMessageContentOpened:
+		if b, ok := b.(*UpdateMessageContentOpened); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateMessageMentionRead:
+		if b, ok := b.(*UpdateMessageMentionRead); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateMessageLiveLocationViewed:
+		if b, ok := b.(*UpdateMessageLiveLocationViewed); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateNewChat:
+		if b, ok := b.(*UpdateNewChat); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateChatTitle:
+		if b, ok := b.(*UpdateChatTitle); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateChatPhoto:
+		if b, ok := b.(*UpdateChatPhoto); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateChatPermissions:
+		if b, ok := b.(*UpdateChatPermissions); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateChatLastMessage:
+		if b, ok := b.(*UpdateChatLastMessage); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateChatPosition:
+		if b, ok := b.(*UpdateChatPosition); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateChatReadInbox:
+		if b, ok := b.(*UpdateChatReadInbox); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateChatReadOutbox:
+		if b, ok := b.(*UpdateChatReadOutbox); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateChatActionBar:
+		if b, ok := b.(*UpdateChatActionBar); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateChatDraftMessage:
+		if b, ok := b.(*UpdateChatDraftMessage); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateChatMessageSender:
+		if b, ok := b.(*UpdateChatMessageSender); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateChatMessageTtl:
+		if b, ok := b.(*UpdateChatMessageTtl); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateChatNotificationSettings:
+		if b, ok := b.(*UpdateChatNotificationSettings); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateChatPendingJoinRequests:
+		if b, ok := b.(*UpdateChatPendingJoinRequests); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateChatReplyMarkup:
+		if b, ok := b.(*UpdateChatReplyMarkup); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateChatTheme:
+		if b, ok := b.(*UpdateChatTheme); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateChatUnreadMentionCount:
+		if b, ok := b.(*UpdateChatUnreadMentionCount); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateChatVideoChat:
+		if b, ok := b.(*UpdateChatVideoChat); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateChatDefaultDisableNotification:
+		if b, ok := b.(*UpdateChatDefaultDisableNotification); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateChatHasProtectedContent:
+		if b, ok := b.(*UpdateChatHasProtectedContent); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateChatHasScheduledMessages:
+		if b, ok := b.(*UpdateChatHasScheduledMessages); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateChatIsBlocked:
+		if b, ok := b.(*UpdateChatIsBlocked); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateChatIsMarkedAsUnread:
+		if b, ok := b.(*UpdateChatIsMarkedAsUnread); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateChatFilters:
+		if b, ok := b.(*UpdateChatFilters); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateChatOnlineMemberCount:
+		if b, ok := b.(*UpdateChatOnlineMemberCount); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateScopeNotificationSettings:
+		if b, ok := b.(*UpdateScopeNotificationSettings); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateNotification:
+		if b, ok := b.(*UpdateNotification); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateNotificationGroup:
+		if b, ok := b.(*UpdateNotificationGroup); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateActiveNotifications:
+		if b, ok := b.(*UpdateActiveNotifications); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateHavePendingNotifications:
+		if b, ok := b.(*UpdateHavePendingNotifications); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateDeleteMessages:
+		if b, ok := b.(*UpdateDeleteMessages); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateChatAction:
+		if b, ok := b.(*UpdateChatAction); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateUserStatus:
+		if b, ok := b.(*UpdateUserStatus); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateUser:
+		if b, ok := b.(*UpdateUser); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateBasicGroup:
+		if b, ok := b.(*UpdateBasicGroup); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateSupergroup:
+		if b, ok := b.(*UpdateSupergroup); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateSecretChat:
+		if b, ok := b.(*UpdateSecretChat); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateUserFullInfo:
+		if b, ok := b.(*UpdateUserFullInfo); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateBasicGroupFullInfo:
+		if b, ok := b.(*UpdateBasicGroupFullInfo); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateSupergroupFullInfo:
+		if b, ok := b.(*UpdateSupergroupFullInfo); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateServiceNotification:
+		if b, ok := b.(*UpdateServiceNotification); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateFile:
+		if b, ok := b.(*UpdateFile); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateFileGenerationStart:
+		if b, ok := b.(*UpdateFileGenerationStart); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateFileGenerationStop:
+		if b, ok := b.(*UpdateFileGenerationStop); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateCall:
+		if b, ok := b.(*UpdateCall); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateGroupCall:
+		if b, ok := b.(*UpdateGroupCall); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateGroupCallParticipant:
+		if b, ok := b.(*UpdateGroupCallParticipant); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateNewCallSignalingData:
+		if b, ok := b.(*UpdateNewCallSignalingData); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateUserPrivacySettingRules:
+		if b, ok := b.(*UpdateUserPrivacySettingRules); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateUnreadMessageCount:
+		if b, ok := b.(*UpdateUnreadMessageCount); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateUnreadChatCount:
+		if b, ok := b.(*UpdateUnreadChatCount); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateOption:
+		if b, ok := b.(*UpdateOption); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateStickerSet:
+		if b, ok := b.(*UpdateStickerSet); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateInstalledStickerSets:
+		if b, ok := b.(*UpdateInstalledStickerSets); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateTrendingStickerSets:
+		if b, ok := b.(*UpdateTrendingStickerSets); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateRecentStickers:
+		if b, ok := b.(*UpdateRecentStickers); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateFavoriteStickers:
+		if b, ok := b.(*UpdateFavoriteStickers); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateSavedAnimations:
+		if b, ok := b.(*UpdateSavedAnimations); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateSelectedBackground:
+		if b, ok := b.(*UpdateSelectedBackground); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateChatThemes:
+		if b, ok := b.(*UpdateChatThemes); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateLanguagePackStrings:
+		if b, ok := b.(*UpdateLanguagePackStrings); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateConnectionState:
+		if b, ok := b.(*UpdateConnectionState); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateTermsOfService:
+		if b, ok := b.(*UpdateTermsOfService); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateUsersNearby:
+		if b, ok := b.(*UpdateUsersNearby); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateDiceEmojis:
+		if b, ok := b.(*UpdateDiceEmojis); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateAnimatedEmojiMessageClicked:
+		if b, ok := b.(*UpdateAnimatedEmojiMessageClicked); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateAnimationSearchParameters:
+		if b, ok := b.(*UpdateAnimationSearchParameters); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateSuggestedActions:
+		if b, ok := b.(*UpdateSuggestedActions); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateNewInlineQuery:
+		if b, ok := b.(*UpdateNewInlineQuery); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateNewChosenInlineResult:
+		if b, ok := b.(*UpdateNewChosenInlineResult); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateNewCallbackQuery:
+		if b, ok := b.(*UpdateNewCallbackQuery); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateNewInlineCallbackQuery:
+		if b, ok := b.(*UpdateNewInlineCallbackQuery); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateNewShippingQuery:
+		if b, ok := b.(*UpdateNewShippingQuery); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateNewPreCheckoutQuery:
+		if b, ok := b.(*UpdateNewPreCheckoutQuery); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateNewCustomEvent:
+		if b, ok := b.(*UpdateNewCustomEvent); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateNewCustomQuery:
+		if b, ok := b.(*UpdateNewCustomQuery); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdatePoll:
+		if b, ok := b.(*UpdatePoll); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdatePollAnswer:
+		if b, ok := b.(*UpdatePollAnswer); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateChatMember:
+		if b, ok := b.(*UpdateChatMember); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UpdateNewChatJoinRequest:
+		if b, ok := b.(*UpdateNewChatJoinRequest); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalUpdate(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
+
 // TestUseUpdate Does nothing and ensures that the Update object is used; for testing only. This is an offline method. Can be called before authorization
 func (client *Client) TestUseUpdate() (Update, error) {
 	result, err := client.SendAndCatch(UpdateData{
@@ -4064,470 +17465,471 @@ func (client *Client) TestUseUpdate() (Update, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
-	switch UpdateEnum(result.Data["@type"].(string)) {
+	resultType, _ := result.Data["@type"].(string)
+	switch UpdateEnum(resultType) {
 
 	case UpdateAuthorizationStateType:
 		var update UpdateAuthorizationState
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateNewMessageType:
 		var update UpdateNewMessage
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateMessageSendAcknowledgedType:
 		var update UpdateMessageSendAcknowledged
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateMessageSendSucceededType:
 		var update UpdateMessageSendSucceeded
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateMessageSendFailedType:
 		var update UpdateMessageSendFailed
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateMessageContentType:
 		var update UpdateMessageContent
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateMessageEditedType:
 		var update UpdateMessageEdited
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateMessageIsPinnedType:
 		var update UpdateMessageIsPinned
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateMessageInteractionInfoType:
 		var update UpdateMessageInteractionInfo
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateMessageContentOpenedType:
 		var update UpdateMessageContentOpened
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateMessageMentionReadType:
 		var update UpdateMessageMentionRead
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateMessageLiveLocationViewedType:
 		var update UpdateMessageLiveLocationViewed
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateNewChatType:
 		var update UpdateNewChat
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateChatTitleType:
 		var update UpdateChatTitle
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateChatPhotoType:
 		var update UpdateChatPhoto
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateChatPermissionsType:
 		var update UpdateChatPermissions
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateChatLastMessageType:
 		var update UpdateChatLastMessage
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateChatPositionType:
 		var update UpdateChatPosition
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateChatReadInboxType:
 		var update UpdateChatReadInbox
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateChatReadOutboxType:
 		var update UpdateChatReadOutbox
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateChatActionBarType:
 		var update UpdateChatActionBar
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateChatDraftMessageType:
 		var update UpdateChatDraftMessage
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateChatMessageSenderType:
 		var update UpdateChatMessageSender
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateChatMessageTtlType:
 		var update UpdateChatMessageTtl
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateChatNotificationSettingsType:
 		var update UpdateChatNotificationSettings
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateChatPendingJoinRequestsType:
 		var update UpdateChatPendingJoinRequests
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateChatReplyMarkupType:
 		var update UpdateChatReplyMarkup
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateChatThemeType:
 		var update UpdateChatTheme
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateChatUnreadMentionCountType:
 		var update UpdateChatUnreadMentionCount
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateChatVideoChatType:
 		var update UpdateChatVideoChat
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateChatDefaultDisableNotificationType:
 		var update UpdateChatDefaultDisableNotification
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateChatHasProtectedContentType:
 		var update UpdateChatHasProtectedContent
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateChatHasScheduledMessagesType:
 		var update UpdateChatHasScheduledMessages
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateChatIsBlockedType:
 		var update UpdateChatIsBlocked
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateChatIsMarkedAsUnreadType:
 		var update UpdateChatIsMarkedAsUnread
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateChatFiltersType:
 		var update UpdateChatFilters
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateChatOnlineMemberCountType:
 		var update UpdateChatOnlineMemberCount
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateScopeNotificationSettingsType:
 		var update UpdateScopeNotificationSettings
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateNotificationType:
 		var update UpdateNotification
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateNotificationGroupType:
 		var update UpdateNotificationGroup
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateActiveNotificationsType:
 		var update UpdateActiveNotifications
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateHavePendingNotificationsType:
 		var update UpdateHavePendingNotifications
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateDeleteMessagesType:
 		var update UpdateDeleteMessages
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateChatActionType:
 		var update UpdateChatAction
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateUserStatusType:
 		var update UpdateUserStatus
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateUserType:
 		var update UpdateUser
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateBasicGroupType:
 		var update UpdateBasicGroup
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateSupergroupType:
 		var update UpdateSupergroup
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateSecretChatType:
 		var update UpdateSecretChat
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateUserFullInfoType:
 		var update UpdateUserFullInfo
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateBasicGroupFullInfoType:
 		var update UpdateBasicGroupFullInfo
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateSupergroupFullInfoType:
 		var update UpdateSupergroupFullInfo
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateServiceNotificationType:
 		var update UpdateServiceNotification
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateFileType:
 		var update UpdateFile
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateFileGenerationStartType:
 		var update UpdateFileGenerationStart
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateFileGenerationStopType:
 		var update UpdateFileGenerationStop
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateCallType:
 		var update UpdateCall
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateGroupCallType:
 		var update UpdateGroupCall
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateGroupCallParticipantType:
 		var update UpdateGroupCallParticipant
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateNewCallSignalingDataType:
 		var update UpdateNewCallSignalingData
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateUserPrivacySettingRulesType:
 		var update UpdateUserPrivacySettingRules
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateUnreadMessageCountType:
 		var update UpdateUnreadMessageCount
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateUnreadChatCountType:
 		var update UpdateUnreadChatCount
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateOptionType:
 		var update UpdateOption
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateStickerSetType:
 		var update UpdateStickerSet
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateInstalledStickerSetsType:
 		var update UpdateInstalledStickerSets
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateTrendingStickerSetsType:
 		var update UpdateTrendingStickerSets
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateRecentStickersType:
 		var update UpdateRecentStickers
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateFavoriteStickersType:
 		var update UpdateFavoriteStickers
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateSavedAnimationsType:
 		var update UpdateSavedAnimations
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateSelectedBackgroundType:
 		var update UpdateSelectedBackground
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateChatThemesType:
 		var update UpdateChatThemes
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateLanguagePackStringsType:
 		var update UpdateLanguagePackStrings
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateConnectionStateType:
 		var update UpdateConnectionState
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateTermsOfServiceType:
 		var update UpdateTermsOfService
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateUsersNearbyType:
 		var update UpdateUsersNearby
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateDiceEmojisType:
 		var update UpdateDiceEmojis
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateAnimatedEmojiMessageClickedType:
 		var update UpdateAnimatedEmojiMessageClicked
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateAnimationSearchParametersType:
 		var update UpdateAnimationSearchParameters
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateSuggestedActionsType:
 		var update UpdateSuggestedActions
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateNewInlineQueryType:
 		var update UpdateNewInlineQuery
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateNewChosenInlineResultType:
 		var update UpdateNewChosenInlineResult
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateNewCallbackQueryType:
 		var update UpdateNewCallbackQuery
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateNewInlineCallbackQueryType:
 		var update UpdateNewInlineCallbackQuery
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateNewShippingQueryType:
 		var update UpdateNewShippingQuery
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateNewPreCheckoutQueryType:
 		var update UpdateNewPreCheckoutQuery
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateNewCustomEventType:
 		var update UpdateNewCustomEvent
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateNewCustomQueryType:
 		var update UpdateNewCustomQuery
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdatePollType:
 		var update UpdatePoll
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdatePollAnswerType:
 		var update UpdatePollAnswer
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateChatMemberType:
 		var update UpdateChatMember
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	case UpdateNewChatJoinRequestType:
 		var update UpdateNewChatJoinRequest
-		err = json.Unmarshal(result.Raw, &update)
+		err = jsonUnmarshal(result.Raw, &update)
 		return &update, err
 
 	default:
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // Updates Contains a list of updates
@@ -28,6 +28,150 @@ func NewUpdates(updates []Update) *Updates {
 	return &updatesTemp
 }
 
+// MarshalJSON marshals to json
+func (updates *Updates) MarshalJSON() ([]byte, error) {
+	return updates.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (updates *Updates) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, updates)
+}
+
+func (updates *Updates) appendJSON(b []byte) ([]byte, error) {
+	if updates == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, updates.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, updates.tdCommon.Extra)
+	b = append(b, `,"updates":`...)
+	if updates.Updates == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range updates.Updates {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = appendJSONValue(b, updates.Updates[i0])
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (updates *Updates) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			updates.tdCommon.Type, err = r.readString()
+		case "@extra":
+			updates.tdCommon.Extra, err = r.readString()
+		case "updates":
+			if r.readNull() {
+				updates.Updates = nil
+			} else {
+				updates.Updates = make([]Update, 0)
+				err = r.readArray(func() (err error) {
+					var item0 Update
+					item0, err = decodeUpdate(r)
+					updates.Updates = append(updates.Updates, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (updates *Updates) Clone() *Updates {
+	if updates == nil {
+		return nil
+	}
+	clone := *updates
+	if updates.Updates != nil {
+		clone.Updates = make([]Update, len(updates.Updates))
+		for i0 := range updates.Updates {
+			clone.Updates[i0] = cloneUpdate(updates.Updates[i0])
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (updates *Updates) Equal(other *Updates) bool {
+	if updates == nil || other == nil {
+		return updates == other
+	}
+	if len(updates.Updates) != len(other.Updates) {
+		return false
+	}
+	for i0 := range updates.Updates {
+		if !equalUpdate(updates.Updates[i0], other.Updates[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (updates *Updates) Diff(other *Updates) []Change {
+	return updates.diff("", other, nil)
+}
+
+func (updates *Updates) diff(path string, other *Updates, changes []Change) []Change {
+	if updates == nil || other == nil {
+		if updates != other {
+			changes = append(changes, Change{Path: path, Old: updates, New: other})
+		}
+		return changes
+	}
+	if len(updates.Updates) != len(other.Updates) {
+		changes = append(changes, Change{Path: fieldPath(path, "updates"), Old: updates.Updates, New: other.Updates})
+	} else {
+		for i0 := range updates.Updates {
+			changes = diffUpdate(indexPath(fieldPath(path, "updates"), i0), updates.Updates[i0], other.Updates[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (updates *Updates) String() string {
+	return objectString(updates)
+}
+
+// LogValue logs the object as its summary
+func (updates *Updates) LogValue() slog.Value {
+	return slog.StringValue(objectString(updates))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (updates *Updates) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, updates)
+}
+
+func (updates *Updates) printText(p *printer) {
+	if updates == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Updates")
+	p.vector("updates", len(updates.Updates), func(i0 int) {
+		p.object("updates", updates.Updates[i0])
+	})
+	p.endObject(state)
+}
+
 // GetCurrentState Returns all updates needed to restore current TDLib state, i.e. all actual UpdateAuthorizationState/UpdateUser/UpdateNewChat and others. This is especially useful if TDLib is run in a separate process. Can be called before initialization
 func (client *Client) GetCurrentState() (*Updates, error) {
 	result, err := client.SendAndCatch(UpdateData{
@@ -38,11 +182,11 @@ func (client *Client) GetCurrentState() (*Updates, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var updates Updates
-	err = json.Unmarshal(result.Raw, &updates)
+	err = jsonUnmarshal(result.Raw, &updates)
 	return &updates, err
 }
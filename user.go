@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // User Represents a user
@@ -79,62 +79,305 @@ func NewUser(id int64, accessHash JSONInt64, firstName string, lastName string,
 	return &userTemp
 }
 
+// MarshalJSON marshals to json
+func (user *User) MarshalJSON() ([]byte, error) {
+	return user.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (user *User) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, user)
+}
+
+func (user *User) appendJSON(b []byte) ([]byte, error) {
+	if user == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, user.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, user.tdCommon.Extra)
+	b = append(b, `,"id":`...)
+	b = appendJSONInt(b, int64(user.Id))
+	b = append(b, `,"access_hash":`...)
+	b = appendJSONInt64String(b, int64(user.AccessHash))
+	b = append(b, `,"first_name":`...)
+	b = appendJSONString(b, user.FirstName)
+	b = append(b, `,"last_name":`...)
+	b = appendJSONString(b, user.LastName)
+	b = append(b, `,"username":`...)
+	b = appendJSONString(b, user.Username)
+	b = append(b, `,"phone_number":`...)
+	b = appendJSONString(b, user.PhoneNumber)
+	b = append(b, `,"status":`...)
+	b, err = appendJSONValue(b, user.Status)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"profile_photo":`...)
+	b, err = user.ProfilePhoto.appendJSON(b)
 	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		Id                int64         `json:"id"`                 // User identifier
-		AccessHash        JSONInt64     `json:"access_hash"`        // User access hash
-		FirstName         string        `json:"first_name"`         // First name of the user
-		LastName          string        `json:"last_name"`          // Last name of the user
-		Username          string        `json:"username"`           // Username of the user
-		PhoneNumber       string        `json:"phone_number"`       // Phone number of the user
-		ProfilePhoto      *ProfilePhoto `json:"profile_photo"`      // Profile photo of the user; may be null
-		IsContact         bool          `json:"is_contact"`         // The user is a contact of the current user
-		IsMutualContact   bool          `json:"is_mutual_contact"`  // The user is a contact of the current user and the current user is a contact of the user
-		IsVerified        bool          `json:"is_verified"`        // True, if the user is verified
-		IsSupport         bool          `json:"is_support"`         // True, if the user is Telegram support account
-		RestrictionReason string        `json:"restriction_reason"` // If non-empty, it contains a human-readable description of the reason why access to this user must be restricted
-		IsScam            bool          `json:"is_scam"`            // True, if many users reported this user as a scam
-		IsFake            bool          `json:"is_fake"`            // True, if many users reported this user as a fake account
-		HaveAccess        bool          `json:"have_access"`        // If false, the user is inaccessible, and the only information known about the user is inside this class. It can't be passed to any method except GetUser
-		LanguageCode      string        `json:"language_code"`      // IETF language tag of the user's language; only available to bots
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+		return nil, err
+	}
+	b = append(b, `,"is_contact":`...)
+	b = appendJSONBool(b, user.IsContact)
+	b = append(b, `,"is_mutual_contact":`...)
+	b = appendJSONBool(b, user.IsMutualContact)
+	b = append(b, `,"is_verified":`...)
+	b = appendJSONBool(b, user.IsVerified)
+	b = append(b, `,"is_support":`...)
+	b = appendJSONBool(b, user.IsSupport)
+	b = append(b, `,"restriction_reason":`...)
+	b = appendJSONString(b, user.RestrictionReason)
+	b = append(b, `,"is_scam":`...)
+	b = appendJSONBool(b, user.IsScam)
+	b = append(b, `,"is_fake":`...)
+	b = appendJSONBool(b, user.IsFake)
+	b = append(b, `,"have_access":`...)
+	b = appendJSONBool(b, user.HaveAccess)
+	b = append(b, `,"type":`...)
+	b, err = appendJSONValue(b, user.Type)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	b = append(b, `,"language_code":`...)
+	b = appendJSONString(b, user.LanguageCode)
+	return append(b, '}'), nil
+}
 
-	user.tdCommon = tempObj.tdCommon
-	user.Id = tempObj.Id
-	user.AccessHash = tempObj.AccessHash
-	user.FirstName = tempObj.FirstName
-	user.LastName = tempObj.LastName
-	user.Username = tempObj.Username
-	user.PhoneNumber = tempObj.PhoneNumber
-	user.ProfilePhoto = tempObj.ProfilePhoto
-	user.IsContact = tempObj.IsContact
-	user.IsMutualContact = tempObj.IsMutualContact
-	user.IsVerified = tempObj.IsVerified
-	user.IsSupport = tempObj.IsSupport
-	user.RestrictionReason = tempObj.RestrictionReason
-	user.IsScam = tempObj.IsScam
-	user.IsFake = tempObj.IsFake
-	user.HaveAccess = tempObj.HaveAccess
-	user.LanguageCode = tempObj.LanguageCode
+func (user *User) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			user.tdCommon.Type, err = r.readString()
+		case "@extra":
+			user.tdCommon.Extra, err = r.readString()
+		case "id":
+			user.Id, err = r.readInt64()
+		case "access_hash":
+			user.AccessHash, err = r.readJSONInt64()
+		case "first_name":
+			user.FirstName, err = r.readString()
+		case "last_name":
+			user.LastName, err = r.readString()
+		case "username":
+			user.Username, err = r.readString()
+		case "phone_number":
+			user.PhoneNumber, err = r.readString()
+		case "status":
+			user.Status, err = decodeUserStatus(r)
+		case "profile_photo":
+			if r.readNull() {
+				user.ProfilePhoto = nil
+			} else {
+				user.ProfilePhoto = new(ProfilePhoto)
+				err = user.ProfilePhoto.decodeJSON(r)
+			}
+		case "is_contact":
+			user.IsContact, err = r.readBool()
+		case "is_mutual_contact":
+			user.IsMutualContact, err = r.readBool()
+		case "is_verified":
+			user.IsVerified, err = r.readBool()
+		case "is_support":
+			user.IsSupport, err = r.readBool()
+		case "restriction_reason":
+			user.RestrictionReason, err = r.readString()
+		case "is_scam":
+			user.IsScam, err = r.readBool()
+		case "is_fake":
+			user.IsFake, err = r.readBool()
+		case "have_access":
+			user.HaveAccess, err = r.readBool()
+		case "type":
+			user.Type, err = decodeUserType(r)
+		case "language_code":
+			user.LanguageCode, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
 
-	fieldStatus, _ := unmarshalUserStatus(objMap["status"])
-	user.Status = fieldStatus
+// Clone returns a deep copy of the object
+func (user *User) Clone() *User {
+	if user == nil {
+		return nil
+	}
+	clone := *user
+	clone.Status = cloneUserStatus(user.Status)
+	clone.ProfilePhoto = user.ProfilePhoto.Clone()
+	clone.Type = cloneUserType(user.Type)
+	return &clone
+}
 
-	fieldType, _ := unmarshalUserType(objMap["type"])
-	user.Type = fieldType
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (user *User) Equal(other *User) bool {
+	if user == nil || other == nil {
+		return user == other
+	}
+	if user.Id != other.Id {
+		return false
+	}
+	if user.AccessHash != other.AccessHash {
+		return false
+	}
+	if user.FirstName != other.FirstName {
+		return false
+	}
+	if user.LastName != other.LastName {
+		return false
+	}
+	if user.Username != other.Username {
+		return false
+	}
+	if user.PhoneNumber != other.PhoneNumber {
+		return false
+	}
+	if !equalUserStatus(user.Status, other.Status) {
+		return false
+	}
+	if !user.ProfilePhoto.Equal(other.ProfilePhoto) {
+		return false
+	}
+	if user.IsContact != other.IsContact {
+		return false
+	}
+	if user.IsMutualContact != other.IsMutualContact {
+		return false
+	}
+	if user.IsVerified != other.IsVerified {
+		return false
+	}
+	if user.IsSupport != other.IsSupport {
+		return false
+	}
+	if user.RestrictionReason != other.RestrictionReason {
+		return false
+	}
+	if user.IsScam != other.IsScam {
+		return false
+	}
+	if user.IsFake != other.IsFake {
+		return false
+	}
+	if user.HaveAccess != other.HaveAccess {
+		return false
+	}
+	if !equalUserType(user.Type, other.Type) {
+		return false
+	}
+	if user.LanguageCode != other.LanguageCode {
+		return false
+	}
+	return true
+}
 
-	return nil
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (user *User) Diff(other *User) []Change {
+	return user.diff("", other, nil)
+}
+
+func (user *User) diff(path string, other *User, changes []Change) []Change {
+	if user == nil || other == nil {
+		if user != other {
+			changes = append(changes, Change{Path: path, Old: user, New: other})
+		}
+		return changes
+	}
+	if user.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: user.Id, New: other.Id})
+	}
+	if user.AccessHash != other.AccessHash {
+		changes = append(changes, Change{Path: fieldPath(path, "access_hash"), Old: user.AccessHash, New: other.AccessHash})
+	}
+	if user.FirstName != other.FirstName {
+		changes = append(changes, Change{Path: fieldPath(path, "first_name"), Old: user.FirstName, New: other.FirstName})
+	}
+	if user.LastName != other.LastName {
+		changes = append(changes, Change{Path: fieldPath(path, "last_name"), Old: user.LastName, New: other.LastName})
+	}
+	if user.Username != other.Username {
+		changes = append(changes, Change{Path: fieldPath(path, "username"), Old: user.Username, New: other.Username})
+	}
+	if user.PhoneNumber != other.PhoneNumber {
+		changes = append(changes, Change{Path: fieldPath(path, "phone_number"), Old: user.PhoneNumber, New: other.PhoneNumber})
+	}
+	changes = diffUserStatus(fieldPath(path, "status"), user.Status, other.Status, changes)
+	changes = user.ProfilePhoto.diff(fieldPath(path, "profile_photo"), other.ProfilePhoto, changes)
+	if user.IsContact != other.IsContact {
+		changes = append(changes, Change{Path: fieldPath(path, "is_contact"), Old: user.IsContact, New: other.IsContact})
+	}
+	if user.IsMutualContact != other.IsMutualContact {
+		changes = append(changes, Change{Path: fieldPath(path, "is_mutual_contact"), Old: user.IsMutualContact, New: other.IsMutualContact})
+	}
+	if user.IsVerified != other.IsVerified {
+		changes = append(changes, Change{Path: fieldPath(path, "is_verified"), Old: user.IsVerified, New: other.IsVerified})
+	}
+	if user.IsSupport != other.IsSupport {
+		changes = append(changes, Change{Path: fieldPath(path, "is_support"), Old: user.IsSupport, New: other.IsSupport})
+	}
+	if user.RestrictionReason != other.RestrictionReason {
+		changes = append(changes, Change{Path: fieldPath(path, "restriction_reason"), Old: user.RestrictionReason, New: other.RestrictionReason})
+	}
+	if user.IsScam != other.IsScam {
+		changes = append(changes, Change{Path: fieldPath(path, "is_scam"), Old: user.IsScam, New: other.IsScam})
+	}
+	if user.IsFake != other.IsFake {
+		changes = append(changes, Change{Path: fieldPath(path, "is_fake"), Old: user.IsFake, New: other.IsFake})
+	}
+	if user.HaveAccess != other.HaveAccess {
+		changes = append(changes, Change{Path: fieldPath(path, "have_access"), Old: user.HaveAccess, New: other.HaveAccess})
+	}
+	changes = diffUserType(fieldPath(path, "type"), user.Type, other.Type, changes)
+	if user.LanguageCode != other.LanguageCode {
+		changes = append(changes, Change{Path: fieldPath(path, "language_code"), Old: user.LanguageCode, New: other.LanguageCode})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (user *User) String() string {
+	return objectString(user)
+}
+
+// LogValue logs the object as its summary
+func (user *User) LogValue() slog.Value {
+	return slog.StringValue(objectString(user))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (user *User) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, user)
+}
+
+func (user *User) printText(p *printer) {
+	if user == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("User")
+	p.int("id", int64(user.Id))
+	p.int("access_hash", int64(user.AccessHash))
+	p.string("first_name", user.FirstName)
+	p.string("last_name", user.LastName)
+	p.string("username", user.Username)
+	p.string("phone_number", user.PhoneNumber)
+	p.object("status", user.Status)
+	p.object("profile_photo", user.ProfilePhoto)
+	p.bool("is_contact", user.IsContact)
+	p.bool("is_mutual_contact", user.IsMutualContact)
+	p.bool("is_verified", user.IsVerified)
+	p.bool("is_support", user.IsSupport)
+	p.string("restriction_reason", user.RestrictionReason)
+	p.bool("is_scam", user.IsScam)
+	p.bool("is_fake", user.IsFake)
+	p.bool("have_access", user.HaveAccess)
+	p.object("type", user.Type)
+	p.string("language_code", user.LanguageCode)
+	p.endObject(state)
 }
 
 // GetMe Returns the current user
@@ -147,12 +390,12 @@ func (client *Client) GetMe() (*User, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var user User
-	err = json.Unmarshal(result.Raw, &user)
+	err = jsonUnmarshal(result.Raw, &user)
 	return &user, err
 }
 
@@ -168,12 +411,12 @@ func (client *Client) GetUser(userId int64) (*User, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var userDummy User
-	err = json.Unmarshal(result.Raw, &userDummy)
+	err = jsonUnmarshal(result.Raw, &userDummy)
 	return &userDummy, err
 }
 
@@ -187,11 +430,11 @@ func (client *Client) GetSupportUser() (*User, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var user User
-	err = json.Unmarshal(result.Raw, &user)
+	err = jsonUnmarshal(result.Raw, &user)
 	return &user, err
 }
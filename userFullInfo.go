@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // UserFullInfo Contains full information about a user
@@ -61,6 +61,278 @@ func NewUserFullInfo(photo *ChatPhoto, isBlocked bool, canBeCalled bool, support
 	return &userFullInfoTemp
 }
 
+// MarshalJSON marshals to json
+func (userFullInfo *UserFullInfo) MarshalJSON() ([]byte, error) {
+	return userFullInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (userFullInfo *UserFullInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, userFullInfo)
+}
+
+func (userFullInfo *UserFullInfo) appendJSON(b []byte) ([]byte, error) {
+	if userFullInfo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, userFullInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, userFullInfo.tdCommon.Extra)
+	b = append(b, `,"photo":`...)
+	b, err = userFullInfo.Photo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"is_blocked":`...)
+	b = appendJSONBool(b, userFullInfo.IsBlocked)
+	b = append(b, `,"can_be_called":`...)
+	b = appendJSONBool(b, userFullInfo.CanBeCalled)
+	b = append(b, `,"supports_video_calls":`...)
+	b = appendJSONBool(b, userFullInfo.SupportsVideoCalls)
+	b = append(b, `,"has_private_calls":`...)
+	b = appendJSONBool(b, userFullInfo.HasPrivateCalls)
+	b = append(b, `,"has_private_forwards":`...)
+	b = appendJSONBool(b, userFullInfo.HasPrivateForwards)
+	b = append(b, `,"need_phone_number_privacy_exception":`...)
+	b = appendJSONBool(b, userFullInfo.NeedPhoneNumberPrivacyException)
+	b = append(b, `,"bio":`...)
+	b = appendJSONString(b, userFullInfo.Bio)
+	b = append(b, `,"share_text":`...)
+	b = appendJSONString(b, userFullInfo.ShareText)
+	b = append(b, `,"description":`...)
+	b = appendJSONString(b, userFullInfo.Description)
+	b = append(b, `,"group_in_common_count":`...)
+	b = appendJSONInt(b, int64(userFullInfo.GroupInCommonCount))
+	b = append(b, `,"commands":`...)
+	if userFullInfo.Commands == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range userFullInfo.Commands {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = userFullInfo.Commands[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (userFullInfo *UserFullInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			userFullInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			userFullInfo.tdCommon.Extra, err = r.readString()
+		case "photo":
+			if r.readNull() {
+				userFullInfo.Photo = nil
+			} else {
+				userFullInfo.Photo = new(ChatPhoto)
+				err = userFullInfo.Photo.decodeJSON(r)
+			}
+		case "is_blocked":
+			userFullInfo.IsBlocked, err = r.readBool()
+		case "can_be_called":
+			userFullInfo.CanBeCalled, err = r.readBool()
+		case "supports_video_calls":
+			userFullInfo.SupportsVideoCalls, err = r.readBool()
+		case "has_private_calls":
+			userFullInfo.HasPrivateCalls, err = r.readBool()
+		case "has_private_forwards":
+			userFullInfo.HasPrivateForwards, err = r.readBool()
+		case "need_phone_number_privacy_exception":
+			userFullInfo.NeedPhoneNumberPrivacyException, err = r.readBool()
+		case "bio":
+			userFullInfo.Bio, err = r.readString()
+		case "share_text":
+			userFullInfo.ShareText, err = r.readString()
+		case "description":
+			userFullInfo.Description, err = r.readString()
+		case "group_in_common_count":
+			userFullInfo.GroupInCommonCount, err = r.readInt32()
+		case "commands":
+			if r.readNull() {
+				userFullInfo.Commands = nil
+			} else {
+				userFullInfo.Commands = make([]BotCommand, 0)
+				err = r.readArray(func() (err error) {
+					var item0 BotCommand
+					err = item0.decodeJSON(r)
+					userFullInfo.Commands = append(userFullInfo.Commands, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (userFullInfo *UserFullInfo) Clone() *UserFullInfo {
+	if userFullInfo == nil {
+		return nil
+	}
+	clone := *userFullInfo
+	clone.Photo = userFullInfo.Photo.Clone()
+	if userFullInfo.Commands != nil {
+		clone.Commands = make([]BotCommand, len(userFullInfo.Commands))
+		for i0 := range userFullInfo.Commands {
+			clone.Commands[i0] = *userFullInfo.Commands[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (userFullInfo *UserFullInfo) Equal(other *UserFullInfo) bool {
+	if userFullInfo == nil || other == nil {
+		return userFullInfo == other
+	}
+	if !userFullInfo.Photo.Equal(other.Photo) {
+		return false
+	}
+	if userFullInfo.IsBlocked != other.IsBlocked {
+		return false
+	}
+	if userFullInfo.CanBeCalled != other.CanBeCalled {
+		return false
+	}
+	if userFullInfo.SupportsVideoCalls != other.SupportsVideoCalls {
+		return false
+	}
+	if userFullInfo.HasPrivateCalls != other.HasPrivateCalls {
+		return false
+	}
+	if userFullInfo.HasPrivateForwards != other.HasPrivateForwards {
+		return false
+	}
+	if userFullInfo.NeedPhoneNumberPrivacyException != other.NeedPhoneNumberPrivacyException {
+		return false
+	}
+	if userFullInfo.Bio != other.Bio {
+		return false
+	}
+	if userFullInfo.ShareText != other.ShareText {
+		return false
+	}
+	if userFullInfo.Description != other.Description {
+		return false
+	}
+	if userFullInfo.GroupInCommonCount != other.GroupInCommonCount {
+		return false
+	}
+	if len(userFullInfo.Commands) != len(other.Commands) {
+		return false
+	}
+	for i0 := range userFullInfo.Commands {
+		if !userFullInfo.Commands[i0].Equal(&other.Commands[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (userFullInfo *UserFullInfo) Diff(other *UserFullInfo) []Change {
+	return userFullInfo.diff("", other, nil)
+}
+
+func (userFullInfo *UserFullInfo) diff(path string, other *UserFullInfo, changes []Change) []Change {
+	if userFullInfo == nil || other == nil {
+		if userFullInfo != other {
+			changes = append(changes, Change{Path: path, Old: userFullInfo, New: other})
+		}
+		return changes
+	}
+	changes = userFullInfo.Photo.diff(fieldPath(path, "photo"), other.Photo, changes)
+	if userFullInfo.IsBlocked != other.IsBlocked {
+		changes = append(changes, Change{Path: fieldPath(path, "is_blocked"), Old: userFullInfo.IsBlocked, New: other.IsBlocked})
+	}
+	if userFullInfo.CanBeCalled != other.CanBeCalled {
+		changes = append(changes, Change{Path: fieldPath(path, "can_be_called"), Old: userFullInfo.CanBeCalled, New: other.CanBeCalled})
+	}
+	if userFullInfo.SupportsVideoCalls != other.SupportsVideoCalls {
+		changes = append(changes, Change{Path: fieldPath(path, "supports_video_calls"), Old: userFullInfo.SupportsVideoCalls, New: other.SupportsVideoCalls})
+	}
+	if userFullInfo.HasPrivateCalls != other.HasPrivateCalls {
+		changes = append(changes, Change{Path: fieldPath(path, "has_private_calls"), Old: userFullInfo.HasPrivateCalls, New: other.HasPrivateCalls})
+	}
+	if userFullInfo.HasPrivateForwards != other.HasPrivateForwards {
+		changes = append(changes, Change{Path: fieldPath(path, "has_private_forwards"), Old: userFullInfo.HasPrivateForwards, New: other.HasPrivateForwards})
+	}
+	if userFullInfo.NeedPhoneNumberPrivacyException != other.NeedPhoneNumberPrivacyException {
+		changes = append(changes, Change{Path: fieldPath(path, "need_phone_number_privacy_exception"), Old: userFullInfo.NeedPhoneNumberPrivacyException, New: other.NeedPhoneNumberPrivacyException})
+	}
+	if userFullInfo.Bio != other.Bio {
+		changes = append(changes, Change{Path: fieldPath(path, "bio"), Old: userFullInfo.Bio, New: other.Bio})
+	}
+	if userFullInfo.ShareText != other.ShareText {
+		changes = append(changes, Change{Path: fieldPath(path, "share_text"), Old: userFullInfo.ShareText, New: other.ShareText})
+	}
+	if userFullInfo.Description != other.Description {
+		changes = append(changes, Change{Path: fieldPath(path, "description"), Old: userFullInfo.Description, New: other.Description})
+	}
+	if userFullInfo.GroupInCommonCount != other.GroupInCommonCount {
+		changes = append(changes, Change{Path: fieldPath(path, "group_in_common_count"), Old: userFullInfo.GroupInCommonCount, New: other.GroupInCommonCount})
+	}
+	if len(userFullInfo.Commands) != len(other.Commands) {
+		changes = append(changes, Change{Path: fieldPath(path, "commands"), Old: userFullInfo.Commands, New: other.Commands})
+	} else {
+		for i0 := range userFullInfo.Commands {
+			changes = userFullInfo.Commands[i0].diff(indexPath(fieldPath(path, "commands"), i0), &other.Commands[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (userFullInfo *UserFullInfo) String() string {
+	return objectString(userFullInfo)
+}
+
+// LogValue logs the object as its summary
+func (userFullInfo *UserFullInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(userFullInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (userFullInfo *UserFullInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, userFullInfo)
+}
+
+func (userFullInfo *UserFullInfo) printText(p *printer) {
+	if userFullInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UserFullInfo")
+	p.object("photo", userFullInfo.Photo)
+	p.bool("is_blocked", userFullInfo.IsBlocked)
+	p.bool("can_be_called", userFullInfo.CanBeCalled)
+	p.bool("supports_video_calls", userFullInfo.SupportsVideoCalls)
+	p.bool("has_private_calls", userFullInfo.HasPrivateCalls)
+	p.bool("has_private_forwards", userFullInfo.HasPrivateForwards)
+	p.bool("need_phone_number_privacy_exception", userFullInfo.NeedPhoneNumberPrivacyException)
+	p.string("bio", userFullInfo.Bio)
+	p.string("share_text", userFullInfo.ShareText)
+	p.string("description", userFullInfo.Description)
+	p.int("group_in_common_count", int64(userFullInfo.GroupInCommonCount))
+	p.vector("commands", len(userFullInfo.Commands), func(i0 int) {
+		userFullInfo.Commands[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // GetUserFullInfo Returns full information about a user by their identifier
 // @param userId User identifier
 func (client *Client) GetUserFullInfo(userId int64) (*UserFullInfo, error) {
@@ -73,11 +345,11 @@ func (client *Client) GetUserFullInfo(userId int64) (*UserFullInfo, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var userFullInfo UserFullInfo
-	err = json.Unmarshal(result.Raw, &userFullInfo)
+	err = jsonUnmarshal(result.Raw, &userFullInfo)
 	return &userFullInfo, err
 }
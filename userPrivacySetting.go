@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // UserPrivacySetting Describes available user privacy settings
 type UserPrivacySetting interface {
 	GetUserPrivacySettingEnum() UserPrivacySettingEnum
+	Accept(visitor UserPrivacySettingVisitor)
 }
 
 // UserPrivacySettingEnum Alias for abstract UserPrivacySetting 'Sub-Classes', used as constant-enum here
@@ -25,63 +27,114 @@ const (
 	UserPrivacySettingAllowFindingByPhoneNumberType   UserPrivacySettingEnum = "userPrivacySettingAllowFindingByPhoneNumber"
 )
 
+// UnknownUserPrivacySetting is an UserPrivacySetting of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownUserPrivacySetting struct {
+	Unknown
+}
+
+// GetUserPrivacySettingEnum return the enum type of this object
+func (unknownUserPrivacySetting *UnknownUserPrivacySetting) GetUserPrivacySettingEnum() UserPrivacySettingEnum {
+	return UserPrivacySettingEnum(unknownUserPrivacySetting.Type)
+}
+
+// Accept calls the method of the visitor for UnknownUserPrivacySetting
+func (unknownUserPrivacySetting *UnknownUserPrivacySetting) Accept(visitor UserPrivacySettingVisitor) {
+	visitor.VisitUnknownUserPrivacySetting(unknownUserPrivacySetting)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownUserPrivacySetting *UnknownUserPrivacySetting) String() string {
+	return objectString(unknownUserPrivacySetting)
+}
+
+// LogValue logs the object as its summary
+func (unknownUserPrivacySetting *UnknownUserPrivacySetting) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownUserPrivacySetting))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownUserPrivacySetting *UnknownUserPrivacySetting) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownUserPrivacySetting)
+}
+
+func (unknownUserPrivacySetting *UnknownUserPrivacySetting) printText(p *printer) {
+	if unknownUserPrivacySetting == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownUserPrivacySetting")
+	p.string("@type", unknownUserPrivacySetting.Type)
+	p.endObject(state)
+}
+
 func unmarshalUserPrivacySetting(rawMsg *json.RawMessage) (UserPrivacySetting, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch UserPrivacySettingEnum(objMap["@type"].(string)) {
+	switch UserPrivacySettingEnum(typeName) {
 	case UserPrivacySettingShowStatusType:
 		var userPrivacySettingShowStatus UserPrivacySettingShowStatus
-		err := json.Unmarshal(*rawMsg, &userPrivacySettingShowStatus)
+		err := jsonUnmarshal(*rawMsg, &userPrivacySettingShowStatus)
 		return &userPrivacySettingShowStatus, err
 
 	case UserPrivacySettingShowProfilePhotoType:
 		var userPrivacySettingShowProfilePhoto UserPrivacySettingShowProfilePhoto
-		err := json.Unmarshal(*rawMsg, &userPrivacySettingShowProfilePhoto)
+		err := jsonUnmarshal(*rawMsg, &userPrivacySettingShowProfilePhoto)
 		return &userPrivacySettingShowProfilePhoto, err
 
 	case UserPrivacySettingShowLinkInForwardedMessagesType:
 		var userPrivacySettingShowLinkInForwardedMessages UserPrivacySettingShowLinkInForwardedMessages
-		err := json.Unmarshal(*rawMsg, &userPrivacySettingShowLinkInForwardedMessages)
+		err := jsonUnmarshal(*rawMsg, &userPrivacySettingShowLinkInForwardedMessages)
 		return &userPrivacySettingShowLinkInForwardedMessages, err
 
 	case UserPrivacySettingShowPhoneNumberType:
 		var userPrivacySettingShowPhoneNumber UserPrivacySettingShowPhoneNumber
-		err := json.Unmarshal(*rawMsg, &userPrivacySettingShowPhoneNumber)
+		err := jsonUnmarshal(*rawMsg, &userPrivacySettingShowPhoneNumber)
 		return &userPrivacySettingShowPhoneNumber, err
 
 	case UserPrivacySettingAllowChatInvitesType:
 		var userPrivacySettingAllowChatInvites UserPrivacySettingAllowChatInvites
-		err := json.Unmarshal(*rawMsg, &userPrivacySettingAllowChatInvites)
+		err := jsonUnmarshal(*rawMsg, &userPrivacySettingAllowChatInvites)
 		return &userPrivacySettingAllowChatInvites, err
 
 	case UserPrivacySettingAllowCallsType:
 		var userPrivacySettingAllowCalls UserPrivacySettingAllowCalls
-		err := json.Unmarshal(*rawMsg, &userPrivacySettingAllowCalls)
+		err := jsonUnmarshal(*rawMsg, &userPrivacySettingAllowCalls)
 		return &userPrivacySettingAllowCalls, err
 
 	case UserPrivacySettingAllowPeerToPeerCallsType:
 		var userPrivacySettingAllowPeerToPeerCalls UserPrivacySettingAllowPeerToPeerCalls
-		err := json.Unmarshal(*rawMsg, &userPrivacySettingAllowPeerToPeerCalls)
+		err := jsonUnmarshal(*rawMsg, &userPrivacySettingAllowPeerToPeerCalls)
 		return &userPrivacySettingAllowPeerToPeerCalls, err
 
 	case UserPrivacySettingAllowFindingByPhoneNumberType:
 		var userPrivacySettingAllowFindingByPhoneNumber UserPrivacySettingAllowFindingByPhoneNumber
-		err := json.Unmarshal(*rawMsg, &userPrivacySettingAllowFindingByPhoneNumber)
+		err := jsonUnmarshal(*rawMsg, &userPrivacySettingAllowFindingByPhoneNumber)
 		return &userPrivacySettingAllowFindingByPhoneNumber, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownUserPrivacySetting{Unknown: unknown}, nil
 	}
 }
 
+func decodeUserPrivacySetting(r *jsonReader) (UserPrivacySetting, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalUserPrivacySetting(rawMsg)
+}
+
 // UserPrivacySettingShowStatus A privacy setting for managing whether the user's online status is visible
 type UserPrivacySettingShowStatus struct {
 	tdCommon
@@ -93,7 +146,6 @@ func (userPrivacySettingShowStatus *UserPrivacySettingShowStatus) MessageType()
 }
 
 // NewUserPrivacySettingShowStatus creates a new UserPrivacySettingShowStatus
-//
 func NewUserPrivacySettingShowStatus() *UserPrivacySettingShowStatus {
 	userPrivacySettingShowStatusTemp := UserPrivacySettingShowStatus{
 		tdCommon: tdCommon{Type: "userPrivacySettingShowStatus"},
@@ -102,11 +154,107 @@ func NewUserPrivacySettingShowStatus() *UserPrivacySettingShowStatus {
 	return &userPrivacySettingShowStatusTemp
 }
 
+// MarshalJSON marshals to json
+func (userPrivacySettingShowStatus *UserPrivacySettingShowStatus) MarshalJSON() ([]byte, error) {
+	return userPrivacySettingShowStatus.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (userPrivacySettingShowStatus *UserPrivacySettingShowStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, userPrivacySettingShowStatus)
+}
+
+func (userPrivacySettingShowStatus *UserPrivacySettingShowStatus) appendJSON(b []byte) ([]byte, error) {
+	if userPrivacySettingShowStatus == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, userPrivacySettingShowStatus.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, userPrivacySettingShowStatus.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (userPrivacySettingShowStatus *UserPrivacySettingShowStatus) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			userPrivacySettingShowStatus.tdCommon.Type, err = r.readString()
+		case "@extra":
+			userPrivacySettingShowStatus.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (userPrivacySettingShowStatus *UserPrivacySettingShowStatus) Clone() *UserPrivacySettingShowStatus {
+	if userPrivacySettingShowStatus == nil {
+		return nil
+	}
+	clone := *userPrivacySettingShowStatus
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (userPrivacySettingShowStatus *UserPrivacySettingShowStatus) Equal(other *UserPrivacySettingShowStatus) bool {
+	if userPrivacySettingShowStatus == nil || other == nil {
+		return userPrivacySettingShowStatus == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (userPrivacySettingShowStatus *UserPrivacySettingShowStatus) Diff(other *UserPrivacySettingShowStatus) []Change {
+	return userPrivacySettingShowStatus.diff("", other, nil)
+}
+
+func (userPrivacySettingShowStatus *UserPrivacySettingShowStatus) diff(path string, other *UserPrivacySettingShowStatus, changes []Change) []Change {
+	if userPrivacySettingShowStatus == nil || other == nil {
+		if userPrivacySettingShowStatus != other {
+			changes = append(changes, Change{Path: path, Old: userPrivacySettingShowStatus, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (userPrivacySettingShowStatus *UserPrivacySettingShowStatus) String() string {
+	return objectString(userPrivacySettingShowStatus)
+}
+
+// LogValue logs the object as its summary
+func (userPrivacySettingShowStatus *UserPrivacySettingShowStatus) LogValue() slog.Value {
+	return slog.StringValue(objectString(userPrivacySettingShowStatus))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (userPrivacySettingShowStatus *UserPrivacySettingShowStatus) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, userPrivacySettingShowStatus)
+}
+
+func (userPrivacySettingShowStatus *UserPrivacySettingShowStatus) printText(p *printer) {
+	if userPrivacySettingShowStatus == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UserPrivacySettingShowStatus")
+	p.endObject(state)
+}
+
 // GetUserPrivacySettingEnum return the enum type of this object
 func (userPrivacySettingShowStatus *UserPrivacySettingShowStatus) GetUserPrivacySettingEnum() UserPrivacySettingEnum {
 	return UserPrivacySettingShowStatusType
 }
 
+// Accept calls the method of the visitor for UserPrivacySettingShowStatus
+func (userPrivacySettingShowStatus *UserPrivacySettingShowStatus) Accept(visitor UserPrivacySettingVisitor) {
+	visitor.VisitUserPrivacySettingShowStatus(userPrivacySettingShowStatus)
+}
+
 // UserPrivacySettingShowProfilePhoto A privacy setting for managing whether the user's profile photo is visible
 type UserPrivacySettingShowProfilePhoto struct {
 	tdCommon
@@ -118,7 +266,6 @@ func (userPrivacySettingShowProfilePhoto *UserPrivacySettingShowProfilePhoto) Me
 }
 
 // NewUserPrivacySettingShowProfilePhoto creates a new UserPrivacySettingShowProfilePhoto
-//
 func NewUserPrivacySettingShowProfilePhoto() *UserPrivacySettingShowProfilePhoto {
 	userPrivacySettingShowProfilePhotoTemp := UserPrivacySettingShowProfilePhoto{
 		tdCommon: tdCommon{Type: "userPrivacySettingShowProfilePhoto"},
@@ -127,11 +274,107 @@ func NewUserPrivacySettingShowProfilePhoto() *UserPrivacySettingShowProfilePhoto
 	return &userPrivacySettingShowProfilePhotoTemp
 }
 
+// MarshalJSON marshals to json
+func (userPrivacySettingShowProfilePhoto *UserPrivacySettingShowProfilePhoto) MarshalJSON() ([]byte, error) {
+	return userPrivacySettingShowProfilePhoto.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (userPrivacySettingShowProfilePhoto *UserPrivacySettingShowProfilePhoto) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, userPrivacySettingShowProfilePhoto)
+}
+
+func (userPrivacySettingShowProfilePhoto *UserPrivacySettingShowProfilePhoto) appendJSON(b []byte) ([]byte, error) {
+	if userPrivacySettingShowProfilePhoto == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, userPrivacySettingShowProfilePhoto.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, userPrivacySettingShowProfilePhoto.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (userPrivacySettingShowProfilePhoto *UserPrivacySettingShowProfilePhoto) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			userPrivacySettingShowProfilePhoto.tdCommon.Type, err = r.readString()
+		case "@extra":
+			userPrivacySettingShowProfilePhoto.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (userPrivacySettingShowProfilePhoto *UserPrivacySettingShowProfilePhoto) Clone() *UserPrivacySettingShowProfilePhoto {
+	if userPrivacySettingShowProfilePhoto == nil {
+		return nil
+	}
+	clone := *userPrivacySettingShowProfilePhoto
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (userPrivacySettingShowProfilePhoto *UserPrivacySettingShowProfilePhoto) Equal(other *UserPrivacySettingShowProfilePhoto) bool {
+	if userPrivacySettingShowProfilePhoto == nil || other == nil {
+		return userPrivacySettingShowProfilePhoto == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (userPrivacySettingShowProfilePhoto *UserPrivacySettingShowProfilePhoto) Diff(other *UserPrivacySettingShowProfilePhoto) []Change {
+	return userPrivacySettingShowProfilePhoto.diff("", other, nil)
+}
+
+func (userPrivacySettingShowProfilePhoto *UserPrivacySettingShowProfilePhoto) diff(path string, other *UserPrivacySettingShowProfilePhoto, changes []Change) []Change {
+	if userPrivacySettingShowProfilePhoto == nil || other == nil {
+		if userPrivacySettingShowProfilePhoto != other {
+			changes = append(changes, Change{Path: path, Old: userPrivacySettingShowProfilePhoto, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (userPrivacySettingShowProfilePhoto *UserPrivacySettingShowProfilePhoto) String() string {
+	return objectString(userPrivacySettingShowProfilePhoto)
+}
+
+// LogValue logs the object as its summary
+func (userPrivacySettingShowProfilePhoto *UserPrivacySettingShowProfilePhoto) LogValue() slog.Value {
+	return slog.StringValue(objectString(userPrivacySettingShowProfilePhoto))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (userPrivacySettingShowProfilePhoto *UserPrivacySettingShowProfilePhoto) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, userPrivacySettingShowProfilePhoto)
+}
+
+func (userPrivacySettingShowProfilePhoto *UserPrivacySettingShowProfilePhoto) printText(p *printer) {
+	if userPrivacySettingShowProfilePhoto == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UserPrivacySettingShowProfilePhoto")
+	p.endObject(state)
+}
+
 // GetUserPrivacySettingEnum return the enum type of this object
 func (userPrivacySettingShowProfilePhoto *UserPrivacySettingShowProfilePhoto) GetUserPrivacySettingEnum() UserPrivacySettingEnum {
 	return UserPrivacySettingShowProfilePhotoType
 }
 
+// Accept calls the method of the visitor for UserPrivacySettingShowProfilePhoto
+func (userPrivacySettingShowProfilePhoto *UserPrivacySettingShowProfilePhoto) Accept(visitor UserPrivacySettingVisitor) {
+	visitor.VisitUserPrivacySettingShowProfilePhoto(userPrivacySettingShowProfilePhoto)
+}
+
 // UserPrivacySettingShowLinkInForwardedMessages A privacy setting for managing whether a link to the user's account is included in forwarded messages
 type UserPrivacySettingShowLinkInForwardedMessages struct {
 	tdCommon
@@ -143,7 +386,6 @@ func (userPrivacySettingShowLinkInForwardedMessages *UserPrivacySettingShowLinkI
 }
 
 // NewUserPrivacySettingShowLinkInForwardedMessages creates a new UserPrivacySettingShowLinkInForwardedMessages
-//
 func NewUserPrivacySettingShowLinkInForwardedMessages() *UserPrivacySettingShowLinkInForwardedMessages {
 	userPrivacySettingShowLinkInForwardedMessagesTemp := UserPrivacySettingShowLinkInForwardedMessages{
 		tdCommon: tdCommon{Type: "userPrivacySettingShowLinkInForwardedMessages"},
@@ -152,11 +394,107 @@ func NewUserPrivacySettingShowLinkInForwardedMessages() *UserPrivacySettingShowL
 	return &userPrivacySettingShowLinkInForwardedMessagesTemp
 }
 
+// MarshalJSON marshals to json
+func (userPrivacySettingShowLinkInForwardedMessages *UserPrivacySettingShowLinkInForwardedMessages) MarshalJSON() ([]byte, error) {
+	return userPrivacySettingShowLinkInForwardedMessages.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (userPrivacySettingShowLinkInForwardedMessages *UserPrivacySettingShowLinkInForwardedMessages) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, userPrivacySettingShowLinkInForwardedMessages)
+}
+
+func (userPrivacySettingShowLinkInForwardedMessages *UserPrivacySettingShowLinkInForwardedMessages) appendJSON(b []byte) ([]byte, error) {
+	if userPrivacySettingShowLinkInForwardedMessages == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, userPrivacySettingShowLinkInForwardedMessages.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, userPrivacySettingShowLinkInForwardedMessages.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (userPrivacySettingShowLinkInForwardedMessages *UserPrivacySettingShowLinkInForwardedMessages) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			userPrivacySettingShowLinkInForwardedMessages.tdCommon.Type, err = r.readString()
+		case "@extra":
+			userPrivacySettingShowLinkInForwardedMessages.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (userPrivacySettingShowLinkInForwardedMessages *UserPrivacySettingShowLinkInForwardedMessages) Clone() *UserPrivacySettingShowLinkInForwardedMessages {
+	if userPrivacySettingShowLinkInForwardedMessages == nil {
+		return nil
+	}
+	clone := *userPrivacySettingShowLinkInForwardedMessages
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (userPrivacySettingShowLinkInForwardedMessages *UserPrivacySettingShowLinkInForwardedMessages) Equal(other *UserPrivacySettingShowLinkInForwardedMessages) bool {
+	if userPrivacySettingShowLinkInForwardedMessages == nil || other == nil {
+		return userPrivacySettingShowLinkInForwardedMessages == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (userPrivacySettingShowLinkInForwardedMessages *UserPrivacySettingShowLinkInForwardedMessages) Diff(other *UserPrivacySettingShowLinkInForwardedMessages) []Change {
+	return userPrivacySettingShowLinkInForwardedMessages.diff("", other, nil)
+}
+
+func (userPrivacySettingShowLinkInForwardedMessages *UserPrivacySettingShowLinkInForwardedMessages) diff(path string, other *UserPrivacySettingShowLinkInForwardedMessages, changes []Change) []Change {
+	if userPrivacySettingShowLinkInForwardedMessages == nil || other == nil {
+		if userPrivacySettingShowLinkInForwardedMessages != other {
+			changes = append(changes, Change{Path: path, Old: userPrivacySettingShowLinkInForwardedMessages, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (userPrivacySettingShowLinkInForwardedMessages *UserPrivacySettingShowLinkInForwardedMessages) String() string {
+	return objectString(userPrivacySettingShowLinkInForwardedMessages)
+}
+
+// LogValue logs the object as its summary
+func (userPrivacySettingShowLinkInForwardedMessages *UserPrivacySettingShowLinkInForwardedMessages) LogValue() slog.Value {
+	return slog.StringValue(objectString(userPrivacySettingShowLinkInForwardedMessages))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (userPrivacySettingShowLinkInForwardedMessages *UserPrivacySettingShowLinkInForwardedMessages) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, userPrivacySettingShowLinkInForwardedMessages)
+}
+
+func (userPrivacySettingShowLinkInForwardedMessages *UserPrivacySettingShowLinkInForwardedMessages) printText(p *printer) {
+	if userPrivacySettingShowLinkInForwardedMessages == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UserPrivacySettingShowLinkInForwardedMessages")
+	p.endObject(state)
+}
+
 // GetUserPrivacySettingEnum return the enum type of this object
 func (userPrivacySettingShowLinkInForwardedMessages *UserPrivacySettingShowLinkInForwardedMessages) GetUserPrivacySettingEnum() UserPrivacySettingEnum {
 	return UserPrivacySettingShowLinkInForwardedMessagesType
 }
 
+// Accept calls the method of the visitor for UserPrivacySettingShowLinkInForwardedMessages
+func (userPrivacySettingShowLinkInForwardedMessages *UserPrivacySettingShowLinkInForwardedMessages) Accept(visitor UserPrivacySettingVisitor) {
+	visitor.VisitUserPrivacySettingShowLinkInForwardedMessages(userPrivacySettingShowLinkInForwardedMessages)
+}
+
 // UserPrivacySettingShowPhoneNumber A privacy setting for managing whether the user's phone number is visible
 type UserPrivacySettingShowPhoneNumber struct {
 	tdCommon
@@ -168,7 +506,6 @@ func (userPrivacySettingShowPhoneNumber *UserPrivacySettingShowPhoneNumber) Mess
 }
 
 // NewUserPrivacySettingShowPhoneNumber creates a new UserPrivacySettingShowPhoneNumber
-//
 func NewUserPrivacySettingShowPhoneNumber() *UserPrivacySettingShowPhoneNumber {
 	userPrivacySettingShowPhoneNumberTemp := UserPrivacySettingShowPhoneNumber{
 		tdCommon: tdCommon{Type: "userPrivacySettingShowPhoneNumber"},
@@ -177,11 +514,107 @@ func NewUserPrivacySettingShowPhoneNumber() *UserPrivacySettingShowPhoneNumber {
 	return &userPrivacySettingShowPhoneNumberTemp
 }
 
+// MarshalJSON marshals to json
+func (userPrivacySettingShowPhoneNumber *UserPrivacySettingShowPhoneNumber) MarshalJSON() ([]byte, error) {
+	return userPrivacySettingShowPhoneNumber.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (userPrivacySettingShowPhoneNumber *UserPrivacySettingShowPhoneNumber) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, userPrivacySettingShowPhoneNumber)
+}
+
+func (userPrivacySettingShowPhoneNumber *UserPrivacySettingShowPhoneNumber) appendJSON(b []byte) ([]byte, error) {
+	if userPrivacySettingShowPhoneNumber == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, userPrivacySettingShowPhoneNumber.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, userPrivacySettingShowPhoneNumber.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (userPrivacySettingShowPhoneNumber *UserPrivacySettingShowPhoneNumber) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			userPrivacySettingShowPhoneNumber.tdCommon.Type, err = r.readString()
+		case "@extra":
+			userPrivacySettingShowPhoneNumber.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (userPrivacySettingShowPhoneNumber *UserPrivacySettingShowPhoneNumber) Clone() *UserPrivacySettingShowPhoneNumber {
+	if userPrivacySettingShowPhoneNumber == nil {
+		return nil
+	}
+	clone := *userPrivacySettingShowPhoneNumber
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (userPrivacySettingShowPhoneNumber *UserPrivacySettingShowPhoneNumber) Equal(other *UserPrivacySettingShowPhoneNumber) bool {
+	if userPrivacySettingShowPhoneNumber == nil || other == nil {
+		return userPrivacySettingShowPhoneNumber == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (userPrivacySettingShowPhoneNumber *UserPrivacySettingShowPhoneNumber) Diff(other *UserPrivacySettingShowPhoneNumber) []Change {
+	return userPrivacySettingShowPhoneNumber.diff("", other, nil)
+}
+
+func (userPrivacySettingShowPhoneNumber *UserPrivacySettingShowPhoneNumber) diff(path string, other *UserPrivacySettingShowPhoneNumber, changes []Change) []Change {
+	if userPrivacySettingShowPhoneNumber == nil || other == nil {
+		if userPrivacySettingShowPhoneNumber != other {
+			changes = append(changes, Change{Path: path, Old: userPrivacySettingShowPhoneNumber, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (userPrivacySettingShowPhoneNumber *UserPrivacySettingShowPhoneNumber) String() string {
+	return objectString(userPrivacySettingShowPhoneNumber)
+}
+
+// LogValue logs the object as its summary
+func (userPrivacySettingShowPhoneNumber *UserPrivacySettingShowPhoneNumber) LogValue() slog.Value {
+	return slog.StringValue(objectString(userPrivacySettingShowPhoneNumber))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (userPrivacySettingShowPhoneNumber *UserPrivacySettingShowPhoneNumber) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, userPrivacySettingShowPhoneNumber)
+}
+
+func (userPrivacySettingShowPhoneNumber *UserPrivacySettingShowPhoneNumber) printText(p *printer) {
+	if userPrivacySettingShowPhoneNumber == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UserPrivacySettingShowPhoneNumber")
+	p.endObject(state)
+}
+
 // GetUserPrivacySettingEnum return the enum type of this object
 func (userPrivacySettingShowPhoneNumber *UserPrivacySettingShowPhoneNumber) GetUserPrivacySettingEnum() UserPrivacySettingEnum {
 	return UserPrivacySettingShowPhoneNumberType
 }
 
+// Accept calls the method of the visitor for UserPrivacySettingShowPhoneNumber
+func (userPrivacySettingShowPhoneNumber *UserPrivacySettingShowPhoneNumber) Accept(visitor UserPrivacySettingVisitor) {
+	visitor.VisitUserPrivacySettingShowPhoneNumber(userPrivacySettingShowPhoneNumber)
+}
+
 // UserPrivacySettingAllowChatInvites A privacy setting for managing whether the user can be invited to chats
 type UserPrivacySettingAllowChatInvites struct {
 	tdCommon
@@ -193,7 +626,6 @@ func (userPrivacySettingAllowChatInvites *UserPrivacySettingAllowChatInvites) Me
 }
 
 // NewUserPrivacySettingAllowChatInvites creates a new UserPrivacySettingAllowChatInvites
-//
 func NewUserPrivacySettingAllowChatInvites() *UserPrivacySettingAllowChatInvites {
 	userPrivacySettingAllowChatInvitesTemp := UserPrivacySettingAllowChatInvites{
 		tdCommon: tdCommon{Type: "userPrivacySettingAllowChatInvites"},
@@ -202,11 +634,107 @@ func NewUserPrivacySettingAllowChatInvites() *UserPrivacySettingAllowChatInvites
 	return &userPrivacySettingAllowChatInvitesTemp
 }
 
+// MarshalJSON marshals to json
+func (userPrivacySettingAllowChatInvites *UserPrivacySettingAllowChatInvites) MarshalJSON() ([]byte, error) {
+	return userPrivacySettingAllowChatInvites.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (userPrivacySettingAllowChatInvites *UserPrivacySettingAllowChatInvites) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, userPrivacySettingAllowChatInvites)
+}
+
+func (userPrivacySettingAllowChatInvites *UserPrivacySettingAllowChatInvites) appendJSON(b []byte) ([]byte, error) {
+	if userPrivacySettingAllowChatInvites == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, userPrivacySettingAllowChatInvites.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, userPrivacySettingAllowChatInvites.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (userPrivacySettingAllowChatInvites *UserPrivacySettingAllowChatInvites) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			userPrivacySettingAllowChatInvites.tdCommon.Type, err = r.readString()
+		case "@extra":
+			userPrivacySettingAllowChatInvites.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (userPrivacySettingAllowChatInvites *UserPrivacySettingAllowChatInvites) Clone() *UserPrivacySettingAllowChatInvites {
+	if userPrivacySettingAllowChatInvites == nil {
+		return nil
+	}
+	clone := *userPrivacySettingAllowChatInvites
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (userPrivacySettingAllowChatInvites *UserPrivacySettingAllowChatInvites) Equal(other *UserPrivacySettingAllowChatInvites) bool {
+	if userPrivacySettingAllowChatInvites == nil || other == nil {
+		return userPrivacySettingAllowChatInvites == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (userPrivacySettingAllowChatInvites *UserPrivacySettingAllowChatInvites) Diff(other *UserPrivacySettingAllowChatInvites) []Change {
+	return userPrivacySettingAllowChatInvites.diff("", other, nil)
+}
+
+func (userPrivacySettingAllowChatInvites *UserPrivacySettingAllowChatInvites) diff(path string, other *UserPrivacySettingAllowChatInvites, changes []Change) []Change {
+	if userPrivacySettingAllowChatInvites == nil || other == nil {
+		if userPrivacySettingAllowChatInvites != other {
+			changes = append(changes, Change{Path: path, Old: userPrivacySettingAllowChatInvites, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (userPrivacySettingAllowChatInvites *UserPrivacySettingAllowChatInvites) String() string {
+	return objectString(userPrivacySettingAllowChatInvites)
+}
+
+// LogValue logs the object as its summary
+func (userPrivacySettingAllowChatInvites *UserPrivacySettingAllowChatInvites) LogValue() slog.Value {
+	return slog.StringValue(objectString(userPrivacySettingAllowChatInvites))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (userPrivacySettingAllowChatInvites *UserPrivacySettingAllowChatInvites) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, userPrivacySettingAllowChatInvites)
+}
+
+func (userPrivacySettingAllowChatInvites *UserPrivacySettingAllowChatInvites) printText(p *printer) {
+	if userPrivacySettingAllowChatInvites == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UserPrivacySettingAllowChatInvites")
+	p.endObject(state)
+}
+
 // GetUserPrivacySettingEnum return the enum type of this object
 func (userPrivacySettingAllowChatInvites *UserPrivacySettingAllowChatInvites) GetUserPrivacySettingEnum() UserPrivacySettingEnum {
 	return UserPrivacySettingAllowChatInvitesType
 }
 
+// Accept calls the method of the visitor for UserPrivacySettingAllowChatInvites
+func (userPrivacySettingAllowChatInvites *UserPrivacySettingAllowChatInvites) Accept(visitor UserPrivacySettingVisitor) {
+	visitor.VisitUserPrivacySettingAllowChatInvites(userPrivacySettingAllowChatInvites)
+}
+
 // UserPrivacySettingAllowCalls A privacy setting for managing whether the user can be called
 type UserPrivacySettingAllowCalls struct {
 	tdCommon
@@ -218,7 +746,6 @@ func (userPrivacySettingAllowCalls *UserPrivacySettingAllowCalls) MessageType()
 }
 
 // NewUserPrivacySettingAllowCalls creates a new UserPrivacySettingAllowCalls
-//
 func NewUserPrivacySettingAllowCalls() *UserPrivacySettingAllowCalls {
 	userPrivacySettingAllowCallsTemp := UserPrivacySettingAllowCalls{
 		tdCommon: tdCommon{Type: "userPrivacySettingAllowCalls"},
@@ -227,11 +754,107 @@ func NewUserPrivacySettingAllowCalls() *UserPrivacySettingAllowCalls {
 	return &userPrivacySettingAllowCallsTemp
 }
 
+// MarshalJSON marshals to json
+func (userPrivacySettingAllowCalls *UserPrivacySettingAllowCalls) MarshalJSON() ([]byte, error) {
+	return userPrivacySettingAllowCalls.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (userPrivacySettingAllowCalls *UserPrivacySettingAllowCalls) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, userPrivacySettingAllowCalls)
+}
+
+func (userPrivacySettingAllowCalls *UserPrivacySettingAllowCalls) appendJSON(b []byte) ([]byte, error) {
+	if userPrivacySettingAllowCalls == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, userPrivacySettingAllowCalls.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, userPrivacySettingAllowCalls.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (userPrivacySettingAllowCalls *UserPrivacySettingAllowCalls) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			userPrivacySettingAllowCalls.tdCommon.Type, err = r.readString()
+		case "@extra":
+			userPrivacySettingAllowCalls.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (userPrivacySettingAllowCalls *UserPrivacySettingAllowCalls) Clone() *UserPrivacySettingAllowCalls {
+	if userPrivacySettingAllowCalls == nil {
+		return nil
+	}
+	clone := *userPrivacySettingAllowCalls
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (userPrivacySettingAllowCalls *UserPrivacySettingAllowCalls) Equal(other *UserPrivacySettingAllowCalls) bool {
+	if userPrivacySettingAllowCalls == nil || other == nil {
+		return userPrivacySettingAllowCalls == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (userPrivacySettingAllowCalls *UserPrivacySettingAllowCalls) Diff(other *UserPrivacySettingAllowCalls) []Change {
+	return userPrivacySettingAllowCalls.diff("", other, nil)
+}
+
+func (userPrivacySettingAllowCalls *UserPrivacySettingAllowCalls) diff(path string, other *UserPrivacySettingAllowCalls, changes []Change) []Change {
+	if userPrivacySettingAllowCalls == nil || other == nil {
+		if userPrivacySettingAllowCalls != other {
+			changes = append(changes, Change{Path: path, Old: userPrivacySettingAllowCalls, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (userPrivacySettingAllowCalls *UserPrivacySettingAllowCalls) String() string {
+	return objectString(userPrivacySettingAllowCalls)
+}
+
+// LogValue logs the object as its summary
+func (userPrivacySettingAllowCalls *UserPrivacySettingAllowCalls) LogValue() slog.Value {
+	return slog.StringValue(objectString(userPrivacySettingAllowCalls))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (userPrivacySettingAllowCalls *UserPrivacySettingAllowCalls) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, userPrivacySettingAllowCalls)
+}
+
+func (userPrivacySettingAllowCalls *UserPrivacySettingAllowCalls) printText(p *printer) {
+	if userPrivacySettingAllowCalls == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UserPrivacySettingAllowCalls")
+	p.endObject(state)
+}
+
 // GetUserPrivacySettingEnum return the enum type of this object
 func (userPrivacySettingAllowCalls *UserPrivacySettingAllowCalls) GetUserPrivacySettingEnum() UserPrivacySettingEnum {
 	return UserPrivacySettingAllowCallsType
 }
 
+// Accept calls the method of the visitor for UserPrivacySettingAllowCalls
+func (userPrivacySettingAllowCalls *UserPrivacySettingAllowCalls) Accept(visitor UserPrivacySettingVisitor) {
+	visitor.VisitUserPrivacySettingAllowCalls(userPrivacySettingAllowCalls)
+}
+
 // UserPrivacySettingAllowPeerToPeerCalls A privacy setting for managing whether peer-to-peer connections can be used for calls
 type UserPrivacySettingAllowPeerToPeerCalls struct {
 	tdCommon
@@ -243,7 +866,6 @@ func (userPrivacySettingAllowPeerToPeerCalls *UserPrivacySettingAllowPeerToPeerC
 }
 
 // NewUserPrivacySettingAllowPeerToPeerCalls creates a new UserPrivacySettingAllowPeerToPeerCalls
-//
 func NewUserPrivacySettingAllowPeerToPeerCalls() *UserPrivacySettingAllowPeerToPeerCalls {
 	userPrivacySettingAllowPeerToPeerCallsTemp := UserPrivacySettingAllowPeerToPeerCalls{
 		tdCommon: tdCommon{Type: "userPrivacySettingAllowPeerToPeerCalls"},
@@ -252,11 +874,107 @@ func NewUserPrivacySettingAllowPeerToPeerCalls() *UserPrivacySettingAllowPeerToP
 	return &userPrivacySettingAllowPeerToPeerCallsTemp
 }
 
+// MarshalJSON marshals to json
+func (userPrivacySettingAllowPeerToPeerCalls *UserPrivacySettingAllowPeerToPeerCalls) MarshalJSON() ([]byte, error) {
+	return userPrivacySettingAllowPeerToPeerCalls.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (userPrivacySettingAllowPeerToPeerCalls *UserPrivacySettingAllowPeerToPeerCalls) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, userPrivacySettingAllowPeerToPeerCalls)
+}
+
+func (userPrivacySettingAllowPeerToPeerCalls *UserPrivacySettingAllowPeerToPeerCalls) appendJSON(b []byte) ([]byte, error) {
+	if userPrivacySettingAllowPeerToPeerCalls == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, userPrivacySettingAllowPeerToPeerCalls.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, userPrivacySettingAllowPeerToPeerCalls.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (userPrivacySettingAllowPeerToPeerCalls *UserPrivacySettingAllowPeerToPeerCalls) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			userPrivacySettingAllowPeerToPeerCalls.tdCommon.Type, err = r.readString()
+		case "@extra":
+			userPrivacySettingAllowPeerToPeerCalls.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (userPrivacySettingAllowPeerToPeerCalls *UserPrivacySettingAllowPeerToPeerCalls) Clone() *UserPrivacySettingAllowPeerToPeerCalls {
+	if userPrivacySettingAllowPeerToPeerCalls == nil {
+		return nil
+	}
+	clone := *userPrivacySettingAllowPeerToPeerCalls
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (userPrivacySettingAllowPeerToPeerCalls *UserPrivacySettingAllowPeerToPeerCalls) Equal(other *UserPrivacySettingAllowPeerToPeerCalls) bool {
+	if userPrivacySettingAllowPeerToPeerCalls == nil || other == nil {
+		return userPrivacySettingAllowPeerToPeerCalls == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (userPrivacySettingAllowPeerToPeerCalls *UserPrivacySettingAllowPeerToPeerCalls) Diff(other *UserPrivacySettingAllowPeerToPeerCalls) []Change {
+	return userPrivacySettingAllowPeerToPeerCalls.diff("", other, nil)
+}
+
+func (userPrivacySettingAllowPeerToPeerCalls *UserPrivacySettingAllowPeerToPeerCalls) diff(path string, other *UserPrivacySettingAllowPeerToPeerCalls, changes []Change) []Change {
+	if userPrivacySettingAllowPeerToPeerCalls == nil || other == nil {
+		if userPrivacySettingAllowPeerToPeerCalls != other {
+			changes = append(changes, Change{Path: path, Old: userPrivacySettingAllowPeerToPeerCalls, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (userPrivacySettingAllowPeerToPeerCalls *UserPrivacySettingAllowPeerToPeerCalls) String() string {
+	return objectString(userPrivacySettingAllowPeerToPeerCalls)
+}
+
+// LogValue logs the object as its summary
+func (userPrivacySettingAllowPeerToPeerCalls *UserPrivacySettingAllowPeerToPeerCalls) LogValue() slog.Value {
+	return slog.StringValue(objectString(userPrivacySettingAllowPeerToPeerCalls))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (userPrivacySettingAllowPeerToPeerCalls *UserPrivacySettingAllowPeerToPeerCalls) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, userPrivacySettingAllowPeerToPeerCalls)
+}
+
+func (userPrivacySettingAllowPeerToPeerCalls *UserPrivacySettingAllowPeerToPeerCalls) printText(p *printer) {
+	if userPrivacySettingAllowPeerToPeerCalls == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UserPrivacySettingAllowPeerToPeerCalls")
+	p.endObject(state)
+}
+
 // GetUserPrivacySettingEnum return the enum type of this object
 func (userPrivacySettingAllowPeerToPeerCalls *UserPrivacySettingAllowPeerToPeerCalls) GetUserPrivacySettingEnum() UserPrivacySettingEnum {
 	return UserPrivacySettingAllowPeerToPeerCallsType
 }
 
+// Accept calls the method of the visitor for UserPrivacySettingAllowPeerToPeerCalls
+func (userPrivacySettingAllowPeerToPeerCalls *UserPrivacySettingAllowPeerToPeerCalls) Accept(visitor UserPrivacySettingVisitor) {
+	visitor.VisitUserPrivacySettingAllowPeerToPeerCalls(userPrivacySettingAllowPeerToPeerCalls)
+}
+
 // UserPrivacySettingAllowFindingByPhoneNumber A privacy setting for managing whether the user can be found by their phone number. Checked only if the phone number is not known to the other user. Can be set only to "Allow contacts" or "Allow all"
 type UserPrivacySettingAllowFindingByPhoneNumber struct {
 	tdCommon
@@ -268,7 +986,6 @@ func (userPrivacySettingAllowFindingByPhoneNumber *UserPrivacySettingAllowFindin
 }
 
 // NewUserPrivacySettingAllowFindingByPhoneNumber creates a new UserPrivacySettingAllowFindingByPhoneNumber
-//
 func NewUserPrivacySettingAllowFindingByPhoneNumber() *UserPrivacySettingAllowFindingByPhoneNumber {
 	userPrivacySettingAllowFindingByPhoneNumberTemp := UserPrivacySettingAllowFindingByPhoneNumber{
 		tdCommon: tdCommon{Type: "userPrivacySettingAllowFindingByPhoneNumber"},
@@ -277,7 +994,288 @@ func NewUserPrivacySettingAllowFindingByPhoneNumber() *UserPrivacySettingAllowFi
 	return &userPrivacySettingAllowFindingByPhoneNumberTemp
 }
 
+// MarshalJSON marshals to json
+func (userPrivacySettingAllowFindingByPhoneNumber *UserPrivacySettingAllowFindingByPhoneNumber) MarshalJSON() ([]byte, error) {
+	return userPrivacySettingAllowFindingByPhoneNumber.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (userPrivacySettingAllowFindingByPhoneNumber *UserPrivacySettingAllowFindingByPhoneNumber) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, userPrivacySettingAllowFindingByPhoneNumber)
+}
+
+func (userPrivacySettingAllowFindingByPhoneNumber *UserPrivacySettingAllowFindingByPhoneNumber) appendJSON(b []byte) ([]byte, error) {
+	if userPrivacySettingAllowFindingByPhoneNumber == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, userPrivacySettingAllowFindingByPhoneNumber.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, userPrivacySettingAllowFindingByPhoneNumber.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (userPrivacySettingAllowFindingByPhoneNumber *UserPrivacySettingAllowFindingByPhoneNumber) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			userPrivacySettingAllowFindingByPhoneNumber.tdCommon.Type, err = r.readString()
+		case "@extra":
+			userPrivacySettingAllowFindingByPhoneNumber.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (userPrivacySettingAllowFindingByPhoneNumber *UserPrivacySettingAllowFindingByPhoneNumber) Clone() *UserPrivacySettingAllowFindingByPhoneNumber {
+	if userPrivacySettingAllowFindingByPhoneNumber == nil {
+		return nil
+	}
+	clone := *userPrivacySettingAllowFindingByPhoneNumber
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (userPrivacySettingAllowFindingByPhoneNumber *UserPrivacySettingAllowFindingByPhoneNumber) Equal(other *UserPrivacySettingAllowFindingByPhoneNumber) bool {
+	if userPrivacySettingAllowFindingByPhoneNumber == nil || other == nil {
+		return userPrivacySettingAllowFindingByPhoneNumber == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (userPrivacySettingAllowFindingByPhoneNumber *UserPrivacySettingAllowFindingByPhoneNumber) Diff(other *UserPrivacySettingAllowFindingByPhoneNumber) []Change {
+	return userPrivacySettingAllowFindingByPhoneNumber.diff("", other, nil)
+}
+
+func (userPrivacySettingAllowFindingByPhoneNumber *UserPrivacySettingAllowFindingByPhoneNumber) diff(path string, other *UserPrivacySettingAllowFindingByPhoneNumber, changes []Change) []Change {
+	if userPrivacySettingAllowFindingByPhoneNumber == nil || other == nil {
+		if userPrivacySettingAllowFindingByPhoneNumber != other {
+			changes = append(changes, Change{Path: path, Old: userPrivacySettingAllowFindingByPhoneNumber, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (userPrivacySettingAllowFindingByPhoneNumber *UserPrivacySettingAllowFindingByPhoneNumber) String() string {
+	return objectString(userPrivacySettingAllowFindingByPhoneNumber)
+}
+
+// LogValue logs the object as its summary
+func (userPrivacySettingAllowFindingByPhoneNumber *UserPrivacySettingAllowFindingByPhoneNumber) LogValue() slog.Value {
+	return slog.StringValue(objectString(userPrivacySettingAllowFindingByPhoneNumber))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (userPrivacySettingAllowFindingByPhoneNumber *UserPrivacySettingAllowFindingByPhoneNumber) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, userPrivacySettingAllowFindingByPhoneNumber)
+}
+
+func (userPrivacySettingAllowFindingByPhoneNumber *UserPrivacySettingAllowFindingByPhoneNumber) printText(p *printer) {
+	if userPrivacySettingAllowFindingByPhoneNumber == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UserPrivacySettingAllowFindingByPhoneNumber")
+	p.endObject(state)
+}
+
 // GetUserPrivacySettingEnum return the enum type of this object
 func (userPrivacySettingAllowFindingByPhoneNumber *UserPrivacySettingAllowFindingByPhoneNumber) GetUserPrivacySettingEnum() UserPrivacySettingEnum {
 	return UserPrivacySettingAllowFindingByPhoneNumberType
 }
+
+// Accept calls the method of the visitor for UserPrivacySettingAllowFindingByPhoneNumber
+func (userPrivacySettingAllowFindingByPhoneNumber *UserPrivacySettingAllowFindingByPhoneNumber) Accept(visitor UserPrivacySettingVisitor) {
+	visitor.VisitUserPrivacySettingAllowFindingByPhoneNumber(userPrivacySettingAllowFindingByPhoneNumber)
+}
+
+// UserPrivacySettingVisitor visits every type of UserPrivacySetting, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to UserPrivacySetting, until they handle it too.
+type UserPrivacySettingVisitor interface {
+	VisitUserPrivacySettingShowStatus(userPrivacySettingShowStatus *UserPrivacySettingShowStatus)
+	VisitUserPrivacySettingShowProfilePhoto(userPrivacySettingShowProfilePhoto *UserPrivacySettingShowProfilePhoto)
+	VisitUserPrivacySettingShowLinkInForwardedMessages(userPrivacySettingShowLinkInForwardedMessages *UserPrivacySettingShowLinkInForwardedMessages)
+	VisitUserPrivacySettingShowPhoneNumber(userPrivacySettingShowPhoneNumber *UserPrivacySettingShowPhoneNumber)
+	VisitUserPrivacySettingAllowChatInvites(userPrivacySettingAllowChatInvites *UserPrivacySettingAllowChatInvites)
+	VisitUserPrivacySettingAllowCalls(userPrivacySettingAllowCalls *UserPrivacySettingAllowCalls)
+	VisitUserPrivacySettingAllowPeerToPeerCalls(userPrivacySettingAllowPeerToPeerCalls *UserPrivacySettingAllowPeerToPeerCalls)
+	VisitUserPrivacySettingAllowFindingByPhoneNumber(userPrivacySettingAllowFindingByPhoneNumber *UserPrivacySettingAllowFindingByPhoneNumber)
+	VisitUnknownUserPrivacySetting(unknownUserPrivacySetting *UnknownUserPrivacySetting)
+}
+
+// UserPrivacySettingCases holds the function MatchUserPrivacySetting calls for each type of UserPrivacySetting;
+// types without a function are passed to the default case
+type UserPrivacySettingCases[R any] struct {
+	UserPrivacySettingShowStatus                  func(userPrivacySettingShowStatus *UserPrivacySettingShowStatus) R
+	UserPrivacySettingShowProfilePhoto            func(userPrivacySettingShowProfilePhoto *UserPrivacySettingShowProfilePhoto) R
+	UserPrivacySettingShowLinkInForwardedMessages func(userPrivacySettingShowLinkInForwardedMessages *UserPrivacySettingShowLinkInForwardedMessages) R
+	UserPrivacySettingShowPhoneNumber             func(userPrivacySettingShowPhoneNumber *UserPrivacySettingShowPhoneNumber) R
+	UserPrivacySettingAllowChatInvites            func(userPrivacySettingAllowChatInvites *UserPrivacySettingAllowChatInvites) R
+	UserPrivacySettingAllowCalls                  func(userPrivacySettingAllowCalls *UserPrivacySettingAllowCalls) R
+	UserPrivacySettingAllowPeerToPeerCalls        func(userPrivacySettingAllowPeerToPeerCalls *UserPrivacySettingAllowPeerToPeerCalls) R
+	UserPrivacySettingAllowFindingByPhoneNumber   func(userPrivacySettingAllowFindingByPhoneNumber *UserPrivacySettingAllowFindingByPhoneNumber) R
+}
+
+// MatchUserPrivacySetting calls the case of the type of userPrivacySetting, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement UserPrivacySettingVisitor
+// to have the compiler check every type is handled.
+func MatchUserPrivacySetting[R any](userPrivacySetting UserPrivacySetting, cases UserPrivacySettingCases[R], defaultCase func(userPrivacySetting UserPrivacySetting) R) R {
+	switch value := userPrivacySetting.(type) {
+	case *UserPrivacySettingShowStatus:
+		if cases.UserPrivacySettingShowStatus != nil {
+			return cases.UserPrivacySettingShowStatus(value)
+		}
+	case *UserPrivacySettingShowProfilePhoto:
+		if cases.UserPrivacySettingShowProfilePhoto != nil {
+			return cases.UserPrivacySettingShowProfilePhoto(value)
+		}
+	case *UserPrivacySettingShowLinkInForwardedMessages:
+		if cases.UserPrivacySettingShowLinkInForwardedMessages != nil {
+			return cases.UserPrivacySettingShowLinkInForwardedMessages(value)
+		}
+	case *UserPrivacySettingShowPhoneNumber:
+		if cases.UserPrivacySettingShowPhoneNumber != nil {
+			return cases.UserPrivacySettingShowPhoneNumber(value)
+		}
+	case *UserPrivacySettingAllowChatInvites:
+		if cases.UserPrivacySettingAllowChatInvites != nil {
+			return cases.UserPrivacySettingAllowChatInvites(value)
+		}
+	case *UserPrivacySettingAllowCalls:
+		if cases.UserPrivacySettingAllowCalls != nil {
+			return cases.UserPrivacySettingAllowCalls(value)
+		}
+	case *UserPrivacySettingAllowPeerToPeerCalls:
+		if cases.UserPrivacySettingAllowPeerToPeerCalls != nil {
+			return cases.UserPrivacySettingAllowPeerToPeerCalls(value)
+		}
+	case *UserPrivacySettingAllowFindingByPhoneNumber:
+		if cases.UserPrivacySettingAllowFindingByPhoneNumber != nil {
+			return cases.UserPrivacySettingAllowFindingByPhoneNumber(value)
+		}
+	}
+	return defaultCase(userPrivacySetting)
+}
+
+// Clone returns a deep copy of the object
+func (unknownUserPrivacySetting *UnknownUserPrivacySetting) Clone() *UnknownUserPrivacySetting {
+	if unknownUserPrivacySetting == nil {
+		return nil
+	}
+	return &UnknownUserPrivacySetting{Unknown: unknownUserPrivacySetting.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownUserPrivacySetting *UnknownUserPrivacySetting) Equal(other *UnknownUserPrivacySetting) bool {
+	if unknownUserPrivacySetting == nil || other == nil {
+		return unknownUserPrivacySetting == other
+	}
+	return unknownUserPrivacySetting.Unknown.equal(other.Unknown)
+}
+
+func cloneUserPrivacySetting(userPrivacySetting UserPrivacySetting) UserPrivacySetting {
+	switch value := userPrivacySetting.(type) {
+	case *UserPrivacySettingShowStatus:
+		return value.Clone()
+	case *UserPrivacySettingShowProfilePhoto:
+		return value.Clone()
+	case *UserPrivacySettingShowLinkInForwardedMessages:
+		return value.Clone()
+	case *UserPrivacySettingShowPhoneNumber:
+		return value.Clone()
+	case *UserPrivacySettingAllowChatInvites:
+		return value.Clone()
+	case *UserPrivacySettingAllowCalls:
+		return value.Clone()
+	case *UserPrivacySettingAllowPeerToPeerCalls:
+		return value.Clone()
+	case *UserPrivacySettingAllowFindingByPhoneNumber:
+		return value.Clone()
+	case *UnknownUserPrivacySetting:
+		return value.Clone()
+	}
+	return userPrivacySetting
+}
+
+func equalUserPrivacySetting(a UserPrivacySetting, b UserPrivacySetting) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *UserPrivacySettingShowStatus:
+		b, ok := b.(*UserPrivacySettingShowStatus)
+		return ok && a.Equal(b)
+	case *UserPrivacySettingShowProfilePhoto:
+		b, ok := b.(*UserPrivacySettingShowProfilePhoto)
+		return ok && a.Equal(b)
+	case *UserPrivacySettingShowLinkInForwardedMessages:
+		b, ok := b.(*UserPrivacySettingShowLinkInForwardedMessages)
+		return ok && a.Equal(b)
+	case *UserPrivacySettingShowPhoneNumber:
+		b, ok := b.(*UserPrivacySettingShowPhoneNumber)
+		return ok && a.Equal(b)
+	case *UserPrivacySettingAllowChatInvites:
+		b, ok := b.(*UserPrivacySettingAllowChatInvites)
+		return ok && a.Equal(b)
+	case *UserPrivacySettingAllowCalls:
+		b, ok := b.(*UserPrivacySettingAllowCalls)
+		return ok && a.Equal(b)
+	case *UserPrivacySettingAllowPeerToPeerCalls:
+		b, ok := b.(*UserPrivacySettingAllowPeerToPeerCalls)
+		return ok && a.Equal(b)
+	case *UserPrivacySettingAllowFindingByPhoneNumber:
+		b, ok := b.(*UserPrivacySettingAllowFindingByPhoneNumber)
+		return ok && a.Equal(b)
+	case *UnknownUserPrivacySetting:
+		b, ok := b.(*UnknownUserPrivacySetting)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffUserPrivacySetting(path string, a UserPrivacySetting, b UserPrivacySetting, changes []Change) []Change {
+	switch a := a.(type) {
+	case *UserPrivacySettingShowStatus:
+		if b, ok := b.(*UserPrivacySettingShowStatus); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UserPrivacySettingShowProfilePhoto:
+		if b, ok := b.(*UserPrivacySettingShowProfilePhoto); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UserPrivacySettingShowLinkInForwardedMessages:
+		if b, ok := b.(*UserPrivacySettingShowLinkInForwardedMessages); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UserPrivacySettingShowPhoneNumber:
+		if b, ok := b.(*UserPrivacySettingShowPhoneNumber); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UserPrivacySettingAllowChatInvites:
+		if b, ok := b.(*UserPrivacySettingAllowChatInvites); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UserPrivacySettingAllowCalls:
+		if b, ok := b.(*UserPrivacySettingAllowCalls); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UserPrivacySettingAllowPeerToPeerCalls:
+		if b, ok := b.(*UserPrivacySettingAllowPeerToPeerCalls); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UserPrivacySettingAllowFindingByPhoneNumber:
+		if b, ok := b.(*UserPrivacySettingAllowFindingByPhoneNumber); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalUserPrivacySetting(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // UserPrivacySettingRule Represents a single rule for managing privacy settings
 type UserPrivacySettingRule interface {
 	GetUserPrivacySettingRuleEnum() UserPrivacySettingRuleEnum
+	Accept(visitor UserPrivacySettingRuleVisitor)
 }
 
 // UserPrivacySettingRuleEnum Alias for abstract UserPrivacySettingRule 'Sub-Classes', used as constant-enum here
@@ -25,63 +27,137 @@ const (
 	UserPrivacySettingRuleRestrictChatMembersType UserPrivacySettingRuleEnum = "userPrivacySettingRuleRestrictChatMembers"
 )
 
+// UnknownUserPrivacySettingRule is an UserPrivacySettingRule of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownUserPrivacySettingRule struct {
+	Unknown
+}
+
+// GetUserPrivacySettingRuleEnum return the enum type of this object
+func (unknownUserPrivacySettingRule *UnknownUserPrivacySettingRule) GetUserPrivacySettingRuleEnum() UserPrivacySettingRuleEnum {
+	return UserPrivacySettingRuleEnum(unknownUserPrivacySettingRule.Type)
+}
+
+// Accept calls the method of the visitor for UnknownUserPrivacySettingRule
+func (unknownUserPrivacySettingRule *UnknownUserPrivacySettingRule) Accept(visitor UserPrivacySettingRuleVisitor) {
+	visitor.VisitUnknownUserPrivacySettingRule(unknownUserPrivacySettingRule)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownUserPrivacySettingRule *UnknownUserPrivacySettingRule) String() string {
+	return objectString(unknownUserPrivacySettingRule)
+}
+
+// LogValue logs the object as its summary
+func (unknownUserPrivacySettingRule *UnknownUserPrivacySettingRule) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownUserPrivacySettingRule))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownUserPrivacySettingRule *UnknownUserPrivacySettingRule) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownUserPrivacySettingRule)
+}
+
+func (unknownUserPrivacySettingRule *UnknownUserPrivacySettingRule) printText(p *printer) {
+	if unknownUserPrivacySettingRule == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownUserPrivacySettingRule")
+	p.string("@type", unknownUserPrivacySettingRule.Type)
+	p.endObject(state)
+}
+
 func unmarshalUserPrivacySettingRule(rawMsg *json.RawMessage) (UserPrivacySettingRule, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch UserPrivacySettingRuleEnum(objMap["@type"].(string)) {
+	switch UserPrivacySettingRuleEnum(typeName) {
 	case UserPrivacySettingRuleAllowAllType:
 		var userPrivacySettingRuleAllowAll UserPrivacySettingRuleAllowAll
-		err := json.Unmarshal(*rawMsg, &userPrivacySettingRuleAllowAll)
+		err := jsonUnmarshal(*rawMsg, &userPrivacySettingRuleAllowAll)
 		return &userPrivacySettingRuleAllowAll, err
 
 	case UserPrivacySettingRuleAllowContactsType:
 		var userPrivacySettingRuleAllowContacts UserPrivacySettingRuleAllowContacts
-		err := json.Unmarshal(*rawMsg, &userPrivacySettingRuleAllowContacts)
+		err := jsonUnmarshal(*rawMsg, &userPrivacySettingRuleAllowContacts)
 		return &userPrivacySettingRuleAllowContacts, err
 
 	case UserPrivacySettingRuleAllowUsersType:
 		var userPrivacySettingRuleAllowUsers UserPrivacySettingRuleAllowUsers
-		err := json.Unmarshal(*rawMsg, &userPrivacySettingRuleAllowUsers)
+		err := jsonUnmarshal(*rawMsg, &userPrivacySettingRuleAllowUsers)
 		return &userPrivacySettingRuleAllowUsers, err
 
 	case UserPrivacySettingRuleAllowChatMembersType:
 		var userPrivacySettingRuleAllowChatMembers UserPrivacySettingRuleAllowChatMembers
-		err := json.Unmarshal(*rawMsg, &userPrivacySettingRuleAllowChatMembers)
+		err := jsonUnmarshal(*rawMsg, &userPrivacySettingRuleAllowChatMembers)
 		return &userPrivacySettingRuleAllowChatMembers, err
 
 	case UserPrivacySettingRuleRestrictAllType:
 		var userPrivacySettingRuleRestrictAll UserPrivacySettingRuleRestrictAll
-		err := json.Unmarshal(*rawMsg, &userPrivacySettingRuleRestrictAll)
+		err := jsonUnmarshal(*rawMsg, &userPrivacySettingRuleRestrictAll)
 		return &userPrivacySettingRuleRestrictAll, err
 
 	case UserPrivacySettingRuleRestrictContactsType:
 		var userPrivacySettingRuleRestrictContacts UserPrivacySettingRuleRestrictContacts
-		err := json.Unmarshal(*rawMsg, &userPrivacySettingRuleRestrictContacts)
+		err := jsonUnmarshal(*rawMsg, &userPrivacySettingRuleRestrictContacts)
 		return &userPrivacySettingRuleRestrictContacts, err
 
 	case UserPrivacySettingRuleRestrictUsersType:
 		var userPrivacySettingRuleRestrictUsers UserPrivacySettingRuleRestrictUsers
-		err := json.Unmarshal(*rawMsg, &userPrivacySettingRuleRestrictUsers)
+		err := jsonUnmarshal(*rawMsg, &userPrivacySettingRuleRestrictUsers)
 		return &userPrivacySettingRuleRestrictUsers, err
 
 	case UserPrivacySettingRuleRestrictChatMembersType:
 		var userPrivacySettingRuleRestrictChatMembers UserPrivacySettingRuleRestrictChatMembers
-		err := json.Unmarshal(*rawMsg, &userPrivacySettingRuleRestrictChatMembers)
+		err := jsonUnmarshal(*rawMsg, &userPrivacySettingRuleRestrictChatMembers)
 		return &userPrivacySettingRuleRestrictChatMembers, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownUserPrivacySettingRule{Unknown: unknown}, nil
 	}
 }
 
+func unmarshalUserPrivacySettingRuleSlice(rawMsg *json.RawMessage) ([]UserPrivacySettingRule, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var rawItems []*json.RawMessage
+	err := jsonUnmarshal(*rawMsg, &rawItems)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]UserPrivacySettingRule, 0, len(rawItems))
+	for _, rawItem := range rawItems {
+		item, err := unmarshalUserPrivacySettingRule(rawItem)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func decodeUserPrivacySettingRule(r *jsonReader) (UserPrivacySettingRule, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalUserPrivacySettingRule(rawMsg)
+}
+
 // UserPrivacySettingRuleAllowAll A rule to allow all users to do something
 type UserPrivacySettingRuleAllowAll struct {
 	tdCommon
@@ -93,7 +169,6 @@ func (userPrivacySettingRuleAllowAll *UserPrivacySettingRuleAllowAll) MessageTyp
 }
 
 // NewUserPrivacySettingRuleAllowAll creates a new UserPrivacySettingRuleAllowAll
-//
 func NewUserPrivacySettingRuleAllowAll() *UserPrivacySettingRuleAllowAll {
 	userPrivacySettingRuleAllowAllTemp := UserPrivacySettingRuleAllowAll{
 		tdCommon: tdCommon{Type: "userPrivacySettingRuleAllowAll"},
@@ -102,11 +177,107 @@ func NewUserPrivacySettingRuleAllowAll() *UserPrivacySettingRuleAllowAll {
 	return &userPrivacySettingRuleAllowAllTemp
 }
 
+// MarshalJSON marshals to json
+func (userPrivacySettingRuleAllowAll *UserPrivacySettingRuleAllowAll) MarshalJSON() ([]byte, error) {
+	return userPrivacySettingRuleAllowAll.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (userPrivacySettingRuleAllowAll *UserPrivacySettingRuleAllowAll) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, userPrivacySettingRuleAllowAll)
+}
+
+func (userPrivacySettingRuleAllowAll *UserPrivacySettingRuleAllowAll) appendJSON(b []byte) ([]byte, error) {
+	if userPrivacySettingRuleAllowAll == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, userPrivacySettingRuleAllowAll.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, userPrivacySettingRuleAllowAll.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (userPrivacySettingRuleAllowAll *UserPrivacySettingRuleAllowAll) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			userPrivacySettingRuleAllowAll.tdCommon.Type, err = r.readString()
+		case "@extra":
+			userPrivacySettingRuleAllowAll.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (userPrivacySettingRuleAllowAll *UserPrivacySettingRuleAllowAll) Clone() *UserPrivacySettingRuleAllowAll {
+	if userPrivacySettingRuleAllowAll == nil {
+		return nil
+	}
+	clone := *userPrivacySettingRuleAllowAll
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (userPrivacySettingRuleAllowAll *UserPrivacySettingRuleAllowAll) Equal(other *UserPrivacySettingRuleAllowAll) bool {
+	if userPrivacySettingRuleAllowAll == nil || other == nil {
+		return userPrivacySettingRuleAllowAll == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (userPrivacySettingRuleAllowAll *UserPrivacySettingRuleAllowAll) Diff(other *UserPrivacySettingRuleAllowAll) []Change {
+	return userPrivacySettingRuleAllowAll.diff("", other, nil)
+}
+
+func (userPrivacySettingRuleAllowAll *UserPrivacySettingRuleAllowAll) diff(path string, other *UserPrivacySettingRuleAllowAll, changes []Change) []Change {
+	if userPrivacySettingRuleAllowAll == nil || other == nil {
+		if userPrivacySettingRuleAllowAll != other {
+			changes = append(changes, Change{Path: path, Old: userPrivacySettingRuleAllowAll, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (userPrivacySettingRuleAllowAll *UserPrivacySettingRuleAllowAll) String() string {
+	return objectString(userPrivacySettingRuleAllowAll)
+}
+
+// LogValue logs the object as its summary
+func (userPrivacySettingRuleAllowAll *UserPrivacySettingRuleAllowAll) LogValue() slog.Value {
+	return slog.StringValue(objectString(userPrivacySettingRuleAllowAll))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (userPrivacySettingRuleAllowAll *UserPrivacySettingRuleAllowAll) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, userPrivacySettingRuleAllowAll)
+}
+
+func (userPrivacySettingRuleAllowAll *UserPrivacySettingRuleAllowAll) printText(p *printer) {
+	if userPrivacySettingRuleAllowAll == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UserPrivacySettingRuleAllowAll")
+	p.endObject(state)
+}
+
 // GetUserPrivacySettingRuleEnum return the enum type of this object
 func (userPrivacySettingRuleAllowAll *UserPrivacySettingRuleAllowAll) GetUserPrivacySettingRuleEnum() UserPrivacySettingRuleEnum {
 	return UserPrivacySettingRuleAllowAllType
 }
 
+// Accept calls the method of the visitor for UserPrivacySettingRuleAllowAll
+func (userPrivacySettingRuleAllowAll *UserPrivacySettingRuleAllowAll) Accept(visitor UserPrivacySettingRuleVisitor) {
+	visitor.VisitUserPrivacySettingRuleAllowAll(userPrivacySettingRuleAllowAll)
+}
+
 // UserPrivacySettingRuleAllowContacts A rule to allow all of a user's contacts to do something
 type UserPrivacySettingRuleAllowContacts struct {
 	tdCommon
@@ -118,7 +289,6 @@ func (userPrivacySettingRuleAllowContacts *UserPrivacySettingRuleAllowContacts)
 }
 
 // NewUserPrivacySettingRuleAllowContacts creates a new UserPrivacySettingRuleAllowContacts
-//
 func NewUserPrivacySettingRuleAllowContacts() *UserPrivacySettingRuleAllowContacts {
 	userPrivacySettingRuleAllowContactsTemp := UserPrivacySettingRuleAllowContacts{
 		tdCommon: tdCommon{Type: "userPrivacySettingRuleAllowContacts"},
@@ -127,11 +297,107 @@ func NewUserPrivacySettingRuleAllowContacts() *UserPrivacySettingRuleAllowContac
 	return &userPrivacySettingRuleAllowContactsTemp
 }
 
+// MarshalJSON marshals to json
+func (userPrivacySettingRuleAllowContacts *UserPrivacySettingRuleAllowContacts) MarshalJSON() ([]byte, error) {
+	return userPrivacySettingRuleAllowContacts.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (userPrivacySettingRuleAllowContacts *UserPrivacySettingRuleAllowContacts) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, userPrivacySettingRuleAllowContacts)
+}
+
+func (userPrivacySettingRuleAllowContacts *UserPrivacySettingRuleAllowContacts) appendJSON(b []byte) ([]byte, error) {
+	if userPrivacySettingRuleAllowContacts == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, userPrivacySettingRuleAllowContacts.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, userPrivacySettingRuleAllowContacts.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (userPrivacySettingRuleAllowContacts *UserPrivacySettingRuleAllowContacts) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			userPrivacySettingRuleAllowContacts.tdCommon.Type, err = r.readString()
+		case "@extra":
+			userPrivacySettingRuleAllowContacts.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (userPrivacySettingRuleAllowContacts *UserPrivacySettingRuleAllowContacts) Clone() *UserPrivacySettingRuleAllowContacts {
+	if userPrivacySettingRuleAllowContacts == nil {
+		return nil
+	}
+	clone := *userPrivacySettingRuleAllowContacts
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (userPrivacySettingRuleAllowContacts *UserPrivacySettingRuleAllowContacts) Equal(other *UserPrivacySettingRuleAllowContacts) bool {
+	if userPrivacySettingRuleAllowContacts == nil || other == nil {
+		return userPrivacySettingRuleAllowContacts == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (userPrivacySettingRuleAllowContacts *UserPrivacySettingRuleAllowContacts) Diff(other *UserPrivacySettingRuleAllowContacts) []Change {
+	return userPrivacySettingRuleAllowContacts.diff("", other, nil)
+}
+
+func (userPrivacySettingRuleAllowContacts *UserPrivacySettingRuleAllowContacts) diff(path string, other *UserPrivacySettingRuleAllowContacts, changes []Change) []Change {
+	if userPrivacySettingRuleAllowContacts == nil || other == nil {
+		if userPrivacySettingRuleAllowContacts != other {
+			changes = append(changes, Change{Path: path, Old: userPrivacySettingRuleAllowContacts, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (userPrivacySettingRuleAllowContacts *UserPrivacySettingRuleAllowContacts) String() string {
+	return objectString(userPrivacySettingRuleAllowContacts)
+}
+
+// LogValue logs the object as its summary
+func (userPrivacySettingRuleAllowContacts *UserPrivacySettingRuleAllowContacts) LogValue() slog.Value {
+	return slog.StringValue(objectString(userPrivacySettingRuleAllowContacts))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (userPrivacySettingRuleAllowContacts *UserPrivacySettingRuleAllowContacts) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, userPrivacySettingRuleAllowContacts)
+}
+
+func (userPrivacySettingRuleAllowContacts *UserPrivacySettingRuleAllowContacts) printText(p *printer) {
+	if userPrivacySettingRuleAllowContacts == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UserPrivacySettingRuleAllowContacts")
+	p.endObject(state)
+}
+
 // GetUserPrivacySettingRuleEnum return the enum type of this object
 func (userPrivacySettingRuleAllowContacts *UserPrivacySettingRuleAllowContacts) GetUserPrivacySettingRuleEnum() UserPrivacySettingRuleEnum {
 	return UserPrivacySettingRuleAllowContactsType
 }
 
+// Accept calls the method of the visitor for UserPrivacySettingRuleAllowContacts
+func (userPrivacySettingRuleAllowContacts *UserPrivacySettingRuleAllowContacts) Accept(visitor UserPrivacySettingRuleVisitor) {
+	visitor.VisitUserPrivacySettingRuleAllowContacts(userPrivacySettingRuleAllowContacts)
+}
+
 // UserPrivacySettingRuleAllowUsers A rule to allow certain specified users to do something
 type UserPrivacySettingRuleAllowUsers struct {
 	tdCommon
@@ -155,11 +421,156 @@ func NewUserPrivacySettingRuleAllowUsers(userIds []int64) *UserPrivacySettingRul
 	return &userPrivacySettingRuleAllowUsersTemp
 }
 
+// MarshalJSON marshals to json
+func (userPrivacySettingRuleAllowUsers *UserPrivacySettingRuleAllowUsers) MarshalJSON() ([]byte, error) {
+	return userPrivacySettingRuleAllowUsers.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (userPrivacySettingRuleAllowUsers *UserPrivacySettingRuleAllowUsers) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, userPrivacySettingRuleAllowUsers)
+}
+
+func (userPrivacySettingRuleAllowUsers *UserPrivacySettingRuleAllowUsers) appendJSON(b []byte) ([]byte, error) {
+	if userPrivacySettingRuleAllowUsers == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, userPrivacySettingRuleAllowUsers.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, userPrivacySettingRuleAllowUsers.tdCommon.Extra)
+	b = append(b, `,"user_ids":`...)
+	if userPrivacySettingRuleAllowUsers.UserIds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range userPrivacySettingRuleAllowUsers.UserIds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(userPrivacySettingRuleAllowUsers.UserIds[i0]))
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (userPrivacySettingRuleAllowUsers *UserPrivacySettingRuleAllowUsers) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			userPrivacySettingRuleAllowUsers.tdCommon.Type, err = r.readString()
+		case "@extra":
+			userPrivacySettingRuleAllowUsers.tdCommon.Extra, err = r.readString()
+		case "user_ids":
+			if r.readNull() {
+				userPrivacySettingRuleAllowUsers.UserIds = nil
+			} else {
+				userPrivacySettingRuleAllowUsers.UserIds = make([]int64, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int64
+					item0, err = r.readInt64()
+					userPrivacySettingRuleAllowUsers.UserIds = append(userPrivacySettingRuleAllowUsers.UserIds, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (userPrivacySettingRuleAllowUsers *UserPrivacySettingRuleAllowUsers) Clone() *UserPrivacySettingRuleAllowUsers {
+	if userPrivacySettingRuleAllowUsers == nil {
+		return nil
+	}
+	clone := *userPrivacySettingRuleAllowUsers
+	if userPrivacySettingRuleAllowUsers.UserIds != nil {
+		clone.UserIds = make([]int64, len(userPrivacySettingRuleAllowUsers.UserIds))
+		copy(clone.UserIds, userPrivacySettingRuleAllowUsers.UserIds)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (userPrivacySettingRuleAllowUsers *UserPrivacySettingRuleAllowUsers) Equal(other *UserPrivacySettingRuleAllowUsers) bool {
+	if userPrivacySettingRuleAllowUsers == nil || other == nil {
+		return userPrivacySettingRuleAllowUsers == other
+	}
+	if len(userPrivacySettingRuleAllowUsers.UserIds) != len(other.UserIds) {
+		return false
+	}
+	for i0 := range userPrivacySettingRuleAllowUsers.UserIds {
+		if userPrivacySettingRuleAllowUsers.UserIds[i0] != other.UserIds[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (userPrivacySettingRuleAllowUsers *UserPrivacySettingRuleAllowUsers) Diff(other *UserPrivacySettingRuleAllowUsers) []Change {
+	return userPrivacySettingRuleAllowUsers.diff("", other, nil)
+}
+
+func (userPrivacySettingRuleAllowUsers *UserPrivacySettingRuleAllowUsers) diff(path string, other *UserPrivacySettingRuleAllowUsers, changes []Change) []Change {
+	if userPrivacySettingRuleAllowUsers == nil || other == nil {
+		if userPrivacySettingRuleAllowUsers != other {
+			changes = append(changes, Change{Path: path, Old: userPrivacySettingRuleAllowUsers, New: other})
+		}
+		return changes
+	}
+	if len(userPrivacySettingRuleAllowUsers.UserIds) != len(other.UserIds) {
+		changes = append(changes, Change{Path: fieldPath(path, "user_ids"), Old: userPrivacySettingRuleAllowUsers.UserIds, New: other.UserIds})
+	} else {
+		for i0 := range userPrivacySettingRuleAllowUsers.UserIds {
+			if userPrivacySettingRuleAllowUsers.UserIds[i0] != other.UserIds[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "user_ids"), i0), Old: userPrivacySettingRuleAllowUsers.UserIds[i0], New: other.UserIds[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (userPrivacySettingRuleAllowUsers *UserPrivacySettingRuleAllowUsers) String() string {
+	return objectString(userPrivacySettingRuleAllowUsers)
+}
+
+// LogValue logs the object as its summary
+func (userPrivacySettingRuleAllowUsers *UserPrivacySettingRuleAllowUsers) LogValue() slog.Value {
+	return slog.StringValue(objectString(userPrivacySettingRuleAllowUsers))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (userPrivacySettingRuleAllowUsers *UserPrivacySettingRuleAllowUsers) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, userPrivacySettingRuleAllowUsers)
+}
+
+func (userPrivacySettingRuleAllowUsers *UserPrivacySettingRuleAllowUsers) printText(p *printer) {
+	if userPrivacySettingRuleAllowUsers == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UserPrivacySettingRuleAllowUsers")
+	p.vector("user_ids", len(userPrivacySettingRuleAllowUsers.UserIds), func(i0 int) {
+		p.int("user_ids", int64(userPrivacySettingRuleAllowUsers.UserIds[i0]))
+	})
+	p.endObject(state)
+}
+
 // GetUserPrivacySettingRuleEnum return the enum type of this object
 func (userPrivacySettingRuleAllowUsers *UserPrivacySettingRuleAllowUsers) GetUserPrivacySettingRuleEnum() UserPrivacySettingRuleEnum {
 	return UserPrivacySettingRuleAllowUsersType
 }
 
+// Accept calls the method of the visitor for UserPrivacySettingRuleAllowUsers
+func (userPrivacySettingRuleAllowUsers *UserPrivacySettingRuleAllowUsers) Accept(visitor UserPrivacySettingRuleVisitor) {
+	visitor.VisitUserPrivacySettingRuleAllowUsers(userPrivacySettingRuleAllowUsers)
+}
+
 // UserPrivacySettingRuleAllowChatMembers A rule to allow all members of certain specified basic groups and supergroups to doing something
 type UserPrivacySettingRuleAllowChatMembers struct {
 	tdCommon
@@ -183,11 +594,156 @@ func NewUserPrivacySettingRuleAllowChatMembers(chatIds []int64) *UserPrivacySett
 	return &userPrivacySettingRuleAllowChatMembersTemp
 }
 
+// MarshalJSON marshals to json
+func (userPrivacySettingRuleAllowChatMembers *UserPrivacySettingRuleAllowChatMembers) MarshalJSON() ([]byte, error) {
+	return userPrivacySettingRuleAllowChatMembers.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (userPrivacySettingRuleAllowChatMembers *UserPrivacySettingRuleAllowChatMembers) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, userPrivacySettingRuleAllowChatMembers)
+}
+
+func (userPrivacySettingRuleAllowChatMembers *UserPrivacySettingRuleAllowChatMembers) appendJSON(b []byte) ([]byte, error) {
+	if userPrivacySettingRuleAllowChatMembers == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, userPrivacySettingRuleAllowChatMembers.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, userPrivacySettingRuleAllowChatMembers.tdCommon.Extra)
+	b = append(b, `,"chat_ids":`...)
+	if userPrivacySettingRuleAllowChatMembers.ChatIds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range userPrivacySettingRuleAllowChatMembers.ChatIds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(userPrivacySettingRuleAllowChatMembers.ChatIds[i0]))
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (userPrivacySettingRuleAllowChatMembers *UserPrivacySettingRuleAllowChatMembers) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			userPrivacySettingRuleAllowChatMembers.tdCommon.Type, err = r.readString()
+		case "@extra":
+			userPrivacySettingRuleAllowChatMembers.tdCommon.Extra, err = r.readString()
+		case "chat_ids":
+			if r.readNull() {
+				userPrivacySettingRuleAllowChatMembers.ChatIds = nil
+			} else {
+				userPrivacySettingRuleAllowChatMembers.ChatIds = make([]int64, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int64
+					item0, err = r.readInt64()
+					userPrivacySettingRuleAllowChatMembers.ChatIds = append(userPrivacySettingRuleAllowChatMembers.ChatIds, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (userPrivacySettingRuleAllowChatMembers *UserPrivacySettingRuleAllowChatMembers) Clone() *UserPrivacySettingRuleAllowChatMembers {
+	if userPrivacySettingRuleAllowChatMembers == nil {
+		return nil
+	}
+	clone := *userPrivacySettingRuleAllowChatMembers
+	if userPrivacySettingRuleAllowChatMembers.ChatIds != nil {
+		clone.ChatIds = make([]int64, len(userPrivacySettingRuleAllowChatMembers.ChatIds))
+		copy(clone.ChatIds, userPrivacySettingRuleAllowChatMembers.ChatIds)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (userPrivacySettingRuleAllowChatMembers *UserPrivacySettingRuleAllowChatMembers) Equal(other *UserPrivacySettingRuleAllowChatMembers) bool {
+	if userPrivacySettingRuleAllowChatMembers == nil || other == nil {
+		return userPrivacySettingRuleAllowChatMembers == other
+	}
+	if len(userPrivacySettingRuleAllowChatMembers.ChatIds) != len(other.ChatIds) {
+		return false
+	}
+	for i0 := range userPrivacySettingRuleAllowChatMembers.ChatIds {
+		if userPrivacySettingRuleAllowChatMembers.ChatIds[i0] != other.ChatIds[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (userPrivacySettingRuleAllowChatMembers *UserPrivacySettingRuleAllowChatMembers) Diff(other *UserPrivacySettingRuleAllowChatMembers) []Change {
+	return userPrivacySettingRuleAllowChatMembers.diff("", other, nil)
+}
+
+func (userPrivacySettingRuleAllowChatMembers *UserPrivacySettingRuleAllowChatMembers) diff(path string, other *UserPrivacySettingRuleAllowChatMembers, changes []Change) []Change {
+	if userPrivacySettingRuleAllowChatMembers == nil || other == nil {
+		if userPrivacySettingRuleAllowChatMembers != other {
+			changes = append(changes, Change{Path: path, Old: userPrivacySettingRuleAllowChatMembers, New: other})
+		}
+		return changes
+	}
+	if len(userPrivacySettingRuleAllowChatMembers.ChatIds) != len(other.ChatIds) {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_ids"), Old: userPrivacySettingRuleAllowChatMembers.ChatIds, New: other.ChatIds})
+	} else {
+		for i0 := range userPrivacySettingRuleAllowChatMembers.ChatIds {
+			if userPrivacySettingRuleAllowChatMembers.ChatIds[i0] != other.ChatIds[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "chat_ids"), i0), Old: userPrivacySettingRuleAllowChatMembers.ChatIds[i0], New: other.ChatIds[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (userPrivacySettingRuleAllowChatMembers *UserPrivacySettingRuleAllowChatMembers) String() string {
+	return objectString(userPrivacySettingRuleAllowChatMembers)
+}
+
+// LogValue logs the object as its summary
+func (userPrivacySettingRuleAllowChatMembers *UserPrivacySettingRuleAllowChatMembers) LogValue() slog.Value {
+	return slog.StringValue(objectString(userPrivacySettingRuleAllowChatMembers))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (userPrivacySettingRuleAllowChatMembers *UserPrivacySettingRuleAllowChatMembers) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, userPrivacySettingRuleAllowChatMembers)
+}
+
+func (userPrivacySettingRuleAllowChatMembers *UserPrivacySettingRuleAllowChatMembers) printText(p *printer) {
+	if userPrivacySettingRuleAllowChatMembers == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UserPrivacySettingRuleAllowChatMembers")
+	p.vector("chat_ids", len(userPrivacySettingRuleAllowChatMembers.ChatIds), func(i0 int) {
+		p.int("chat_ids", int64(userPrivacySettingRuleAllowChatMembers.ChatIds[i0]))
+	})
+	p.endObject(state)
+}
+
 // GetUserPrivacySettingRuleEnum return the enum type of this object
 func (userPrivacySettingRuleAllowChatMembers *UserPrivacySettingRuleAllowChatMembers) GetUserPrivacySettingRuleEnum() UserPrivacySettingRuleEnum {
 	return UserPrivacySettingRuleAllowChatMembersType
 }
 
+// Accept calls the method of the visitor for UserPrivacySettingRuleAllowChatMembers
+func (userPrivacySettingRuleAllowChatMembers *UserPrivacySettingRuleAllowChatMembers) Accept(visitor UserPrivacySettingRuleVisitor) {
+	visitor.VisitUserPrivacySettingRuleAllowChatMembers(userPrivacySettingRuleAllowChatMembers)
+}
+
 // UserPrivacySettingRuleRestrictAll A rule to restrict all users from doing something
 type UserPrivacySettingRuleRestrictAll struct {
 	tdCommon
@@ -199,7 +755,6 @@ func (userPrivacySettingRuleRestrictAll *UserPrivacySettingRuleRestrictAll) Mess
 }
 
 // NewUserPrivacySettingRuleRestrictAll creates a new UserPrivacySettingRuleRestrictAll
-//
 func NewUserPrivacySettingRuleRestrictAll() *UserPrivacySettingRuleRestrictAll {
 	userPrivacySettingRuleRestrictAllTemp := UserPrivacySettingRuleRestrictAll{
 		tdCommon: tdCommon{Type: "userPrivacySettingRuleRestrictAll"},
@@ -208,11 +763,107 @@ func NewUserPrivacySettingRuleRestrictAll() *UserPrivacySettingRuleRestrictAll {
 	return &userPrivacySettingRuleRestrictAllTemp
 }
 
+// MarshalJSON marshals to json
+func (userPrivacySettingRuleRestrictAll *UserPrivacySettingRuleRestrictAll) MarshalJSON() ([]byte, error) {
+	return userPrivacySettingRuleRestrictAll.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (userPrivacySettingRuleRestrictAll *UserPrivacySettingRuleRestrictAll) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, userPrivacySettingRuleRestrictAll)
+}
+
+func (userPrivacySettingRuleRestrictAll *UserPrivacySettingRuleRestrictAll) appendJSON(b []byte) ([]byte, error) {
+	if userPrivacySettingRuleRestrictAll == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, userPrivacySettingRuleRestrictAll.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, userPrivacySettingRuleRestrictAll.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (userPrivacySettingRuleRestrictAll *UserPrivacySettingRuleRestrictAll) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			userPrivacySettingRuleRestrictAll.tdCommon.Type, err = r.readString()
+		case "@extra":
+			userPrivacySettingRuleRestrictAll.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (userPrivacySettingRuleRestrictAll *UserPrivacySettingRuleRestrictAll) Clone() *UserPrivacySettingRuleRestrictAll {
+	if userPrivacySettingRuleRestrictAll == nil {
+		return nil
+	}
+	clone := *userPrivacySettingRuleRestrictAll
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (userPrivacySettingRuleRestrictAll *UserPrivacySettingRuleRestrictAll) Equal(other *UserPrivacySettingRuleRestrictAll) bool {
+	if userPrivacySettingRuleRestrictAll == nil || other == nil {
+		return userPrivacySettingRuleRestrictAll == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (userPrivacySettingRuleRestrictAll *UserPrivacySettingRuleRestrictAll) Diff(other *UserPrivacySettingRuleRestrictAll) []Change {
+	return userPrivacySettingRuleRestrictAll.diff("", other, nil)
+}
+
+func (userPrivacySettingRuleRestrictAll *UserPrivacySettingRuleRestrictAll) diff(path string, other *UserPrivacySettingRuleRestrictAll, changes []Change) []Change {
+	if userPrivacySettingRuleRestrictAll == nil || other == nil {
+		if userPrivacySettingRuleRestrictAll != other {
+			changes = append(changes, Change{Path: path, Old: userPrivacySettingRuleRestrictAll, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (userPrivacySettingRuleRestrictAll *UserPrivacySettingRuleRestrictAll) String() string {
+	return objectString(userPrivacySettingRuleRestrictAll)
+}
+
+// LogValue logs the object as its summary
+func (userPrivacySettingRuleRestrictAll *UserPrivacySettingRuleRestrictAll) LogValue() slog.Value {
+	return slog.StringValue(objectString(userPrivacySettingRuleRestrictAll))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (userPrivacySettingRuleRestrictAll *UserPrivacySettingRuleRestrictAll) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, userPrivacySettingRuleRestrictAll)
+}
+
+func (userPrivacySettingRuleRestrictAll *UserPrivacySettingRuleRestrictAll) printText(p *printer) {
+	if userPrivacySettingRuleRestrictAll == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UserPrivacySettingRuleRestrictAll")
+	p.endObject(state)
+}
+
 // GetUserPrivacySettingRuleEnum return the enum type of this object
 func (userPrivacySettingRuleRestrictAll *UserPrivacySettingRuleRestrictAll) GetUserPrivacySettingRuleEnum() UserPrivacySettingRuleEnum {
 	return UserPrivacySettingRuleRestrictAllType
 }
 
+// Accept calls the method of the visitor for UserPrivacySettingRuleRestrictAll
+func (userPrivacySettingRuleRestrictAll *UserPrivacySettingRuleRestrictAll) Accept(visitor UserPrivacySettingRuleVisitor) {
+	visitor.VisitUserPrivacySettingRuleRestrictAll(userPrivacySettingRuleRestrictAll)
+}
+
 // UserPrivacySettingRuleRestrictContacts A rule to restrict all contacts of a user from doing something
 type UserPrivacySettingRuleRestrictContacts struct {
 	tdCommon
@@ -224,7 +875,6 @@ func (userPrivacySettingRuleRestrictContacts *UserPrivacySettingRuleRestrictCont
 }
 
 // NewUserPrivacySettingRuleRestrictContacts creates a new UserPrivacySettingRuleRestrictContacts
-//
 func NewUserPrivacySettingRuleRestrictContacts() *UserPrivacySettingRuleRestrictContacts {
 	userPrivacySettingRuleRestrictContactsTemp := UserPrivacySettingRuleRestrictContacts{
 		tdCommon: tdCommon{Type: "userPrivacySettingRuleRestrictContacts"},
@@ -233,11 +883,107 @@ func NewUserPrivacySettingRuleRestrictContacts() *UserPrivacySettingRuleRestrict
 	return &userPrivacySettingRuleRestrictContactsTemp
 }
 
+// MarshalJSON marshals to json
+func (userPrivacySettingRuleRestrictContacts *UserPrivacySettingRuleRestrictContacts) MarshalJSON() ([]byte, error) {
+	return userPrivacySettingRuleRestrictContacts.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (userPrivacySettingRuleRestrictContacts *UserPrivacySettingRuleRestrictContacts) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, userPrivacySettingRuleRestrictContacts)
+}
+
+func (userPrivacySettingRuleRestrictContacts *UserPrivacySettingRuleRestrictContacts) appendJSON(b []byte) ([]byte, error) {
+	if userPrivacySettingRuleRestrictContacts == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, userPrivacySettingRuleRestrictContacts.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, userPrivacySettingRuleRestrictContacts.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (userPrivacySettingRuleRestrictContacts *UserPrivacySettingRuleRestrictContacts) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			userPrivacySettingRuleRestrictContacts.tdCommon.Type, err = r.readString()
+		case "@extra":
+			userPrivacySettingRuleRestrictContacts.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (userPrivacySettingRuleRestrictContacts *UserPrivacySettingRuleRestrictContacts) Clone() *UserPrivacySettingRuleRestrictContacts {
+	if userPrivacySettingRuleRestrictContacts == nil {
+		return nil
+	}
+	clone := *userPrivacySettingRuleRestrictContacts
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (userPrivacySettingRuleRestrictContacts *UserPrivacySettingRuleRestrictContacts) Equal(other *UserPrivacySettingRuleRestrictContacts) bool {
+	if userPrivacySettingRuleRestrictContacts == nil || other == nil {
+		return userPrivacySettingRuleRestrictContacts == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (userPrivacySettingRuleRestrictContacts *UserPrivacySettingRuleRestrictContacts) Diff(other *UserPrivacySettingRuleRestrictContacts) []Change {
+	return userPrivacySettingRuleRestrictContacts.diff("", other, nil)
+}
+
+func (userPrivacySettingRuleRestrictContacts *UserPrivacySettingRuleRestrictContacts) diff(path string, other *UserPrivacySettingRuleRestrictContacts, changes []Change) []Change {
+	if userPrivacySettingRuleRestrictContacts == nil || other == nil {
+		if userPrivacySettingRuleRestrictContacts != other {
+			changes = append(changes, Change{Path: path, Old: userPrivacySettingRuleRestrictContacts, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (userPrivacySettingRuleRestrictContacts *UserPrivacySettingRuleRestrictContacts) String() string {
+	return objectString(userPrivacySettingRuleRestrictContacts)
+}
+
+// LogValue logs the object as its summary
+func (userPrivacySettingRuleRestrictContacts *UserPrivacySettingRuleRestrictContacts) LogValue() slog.Value {
+	return slog.StringValue(objectString(userPrivacySettingRuleRestrictContacts))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (userPrivacySettingRuleRestrictContacts *UserPrivacySettingRuleRestrictContacts) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, userPrivacySettingRuleRestrictContacts)
+}
+
+func (userPrivacySettingRuleRestrictContacts *UserPrivacySettingRuleRestrictContacts) printText(p *printer) {
+	if userPrivacySettingRuleRestrictContacts == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UserPrivacySettingRuleRestrictContacts")
+	p.endObject(state)
+}
+
 // GetUserPrivacySettingRuleEnum return the enum type of this object
 func (userPrivacySettingRuleRestrictContacts *UserPrivacySettingRuleRestrictContacts) GetUserPrivacySettingRuleEnum() UserPrivacySettingRuleEnum {
 	return UserPrivacySettingRuleRestrictContactsType
 }
 
+// Accept calls the method of the visitor for UserPrivacySettingRuleRestrictContacts
+func (userPrivacySettingRuleRestrictContacts *UserPrivacySettingRuleRestrictContacts) Accept(visitor UserPrivacySettingRuleVisitor) {
+	visitor.VisitUserPrivacySettingRuleRestrictContacts(userPrivacySettingRuleRestrictContacts)
+}
+
 // UserPrivacySettingRuleRestrictUsers A rule to restrict all specified users from doing something
 type UserPrivacySettingRuleRestrictUsers struct {
 	tdCommon
@@ -261,11 +1007,156 @@ func NewUserPrivacySettingRuleRestrictUsers(userIds []int64) *UserPrivacySetting
 	return &userPrivacySettingRuleRestrictUsersTemp
 }
 
+// MarshalJSON marshals to json
+func (userPrivacySettingRuleRestrictUsers *UserPrivacySettingRuleRestrictUsers) MarshalJSON() ([]byte, error) {
+	return userPrivacySettingRuleRestrictUsers.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (userPrivacySettingRuleRestrictUsers *UserPrivacySettingRuleRestrictUsers) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, userPrivacySettingRuleRestrictUsers)
+}
+
+func (userPrivacySettingRuleRestrictUsers *UserPrivacySettingRuleRestrictUsers) appendJSON(b []byte) ([]byte, error) {
+	if userPrivacySettingRuleRestrictUsers == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, userPrivacySettingRuleRestrictUsers.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, userPrivacySettingRuleRestrictUsers.tdCommon.Extra)
+	b = append(b, `,"user_ids":`...)
+	if userPrivacySettingRuleRestrictUsers.UserIds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range userPrivacySettingRuleRestrictUsers.UserIds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(userPrivacySettingRuleRestrictUsers.UserIds[i0]))
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (userPrivacySettingRuleRestrictUsers *UserPrivacySettingRuleRestrictUsers) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			userPrivacySettingRuleRestrictUsers.tdCommon.Type, err = r.readString()
+		case "@extra":
+			userPrivacySettingRuleRestrictUsers.tdCommon.Extra, err = r.readString()
+		case "user_ids":
+			if r.readNull() {
+				userPrivacySettingRuleRestrictUsers.UserIds = nil
+			} else {
+				userPrivacySettingRuleRestrictUsers.UserIds = make([]int64, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int64
+					item0, err = r.readInt64()
+					userPrivacySettingRuleRestrictUsers.UserIds = append(userPrivacySettingRuleRestrictUsers.UserIds, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (userPrivacySettingRuleRestrictUsers *UserPrivacySettingRuleRestrictUsers) Clone() *UserPrivacySettingRuleRestrictUsers {
+	if userPrivacySettingRuleRestrictUsers == nil {
+		return nil
+	}
+	clone := *userPrivacySettingRuleRestrictUsers
+	if userPrivacySettingRuleRestrictUsers.UserIds != nil {
+		clone.UserIds = make([]int64, len(userPrivacySettingRuleRestrictUsers.UserIds))
+		copy(clone.UserIds, userPrivacySettingRuleRestrictUsers.UserIds)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (userPrivacySettingRuleRestrictUsers *UserPrivacySettingRuleRestrictUsers) Equal(other *UserPrivacySettingRuleRestrictUsers) bool {
+	if userPrivacySettingRuleRestrictUsers == nil || other == nil {
+		return userPrivacySettingRuleRestrictUsers == other
+	}
+	if len(userPrivacySettingRuleRestrictUsers.UserIds) != len(other.UserIds) {
+		return false
+	}
+	for i0 := range userPrivacySettingRuleRestrictUsers.UserIds {
+		if userPrivacySettingRuleRestrictUsers.UserIds[i0] != other.UserIds[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (userPrivacySettingRuleRestrictUsers *UserPrivacySettingRuleRestrictUsers) Diff(other *UserPrivacySettingRuleRestrictUsers) []Change {
+	return userPrivacySettingRuleRestrictUsers.diff("", other, nil)
+}
+
+func (userPrivacySettingRuleRestrictUsers *UserPrivacySettingRuleRestrictUsers) diff(path string, other *UserPrivacySettingRuleRestrictUsers, changes []Change) []Change {
+	if userPrivacySettingRuleRestrictUsers == nil || other == nil {
+		if userPrivacySettingRuleRestrictUsers != other {
+			changes = append(changes, Change{Path: path, Old: userPrivacySettingRuleRestrictUsers, New: other})
+		}
+		return changes
+	}
+	if len(userPrivacySettingRuleRestrictUsers.UserIds) != len(other.UserIds) {
+		changes = append(changes, Change{Path: fieldPath(path, "user_ids"), Old: userPrivacySettingRuleRestrictUsers.UserIds, New: other.UserIds})
+	} else {
+		for i0 := range userPrivacySettingRuleRestrictUsers.UserIds {
+			if userPrivacySettingRuleRestrictUsers.UserIds[i0] != other.UserIds[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "user_ids"), i0), Old: userPrivacySettingRuleRestrictUsers.UserIds[i0], New: other.UserIds[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (userPrivacySettingRuleRestrictUsers *UserPrivacySettingRuleRestrictUsers) String() string {
+	return objectString(userPrivacySettingRuleRestrictUsers)
+}
+
+// LogValue logs the object as its summary
+func (userPrivacySettingRuleRestrictUsers *UserPrivacySettingRuleRestrictUsers) LogValue() slog.Value {
+	return slog.StringValue(objectString(userPrivacySettingRuleRestrictUsers))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (userPrivacySettingRuleRestrictUsers *UserPrivacySettingRuleRestrictUsers) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, userPrivacySettingRuleRestrictUsers)
+}
+
+func (userPrivacySettingRuleRestrictUsers *UserPrivacySettingRuleRestrictUsers) printText(p *printer) {
+	if userPrivacySettingRuleRestrictUsers == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UserPrivacySettingRuleRestrictUsers")
+	p.vector("user_ids", len(userPrivacySettingRuleRestrictUsers.UserIds), func(i0 int) {
+		p.int("user_ids", int64(userPrivacySettingRuleRestrictUsers.UserIds[i0]))
+	})
+	p.endObject(state)
+}
+
 // GetUserPrivacySettingRuleEnum return the enum type of this object
 func (userPrivacySettingRuleRestrictUsers *UserPrivacySettingRuleRestrictUsers) GetUserPrivacySettingRuleEnum() UserPrivacySettingRuleEnum {
 	return UserPrivacySettingRuleRestrictUsersType
 }
 
+// Accept calls the method of the visitor for UserPrivacySettingRuleRestrictUsers
+func (userPrivacySettingRuleRestrictUsers *UserPrivacySettingRuleRestrictUsers) Accept(visitor UserPrivacySettingRuleVisitor) {
+	visitor.VisitUserPrivacySettingRuleRestrictUsers(userPrivacySettingRuleRestrictUsers)
+}
+
 // UserPrivacySettingRuleRestrictChatMembers A rule to restrict all members of specified basic groups and supergroups from doing something
 type UserPrivacySettingRuleRestrictChatMembers struct {
 	tdCommon
@@ -289,7 +1180,337 @@ func NewUserPrivacySettingRuleRestrictChatMembers(chatIds []int64) *UserPrivacyS
 	return &userPrivacySettingRuleRestrictChatMembersTemp
 }
 
+// MarshalJSON marshals to json
+func (userPrivacySettingRuleRestrictChatMembers *UserPrivacySettingRuleRestrictChatMembers) MarshalJSON() ([]byte, error) {
+	return userPrivacySettingRuleRestrictChatMembers.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (userPrivacySettingRuleRestrictChatMembers *UserPrivacySettingRuleRestrictChatMembers) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, userPrivacySettingRuleRestrictChatMembers)
+}
+
+func (userPrivacySettingRuleRestrictChatMembers *UserPrivacySettingRuleRestrictChatMembers) appendJSON(b []byte) ([]byte, error) {
+	if userPrivacySettingRuleRestrictChatMembers == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, userPrivacySettingRuleRestrictChatMembers.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, userPrivacySettingRuleRestrictChatMembers.tdCommon.Extra)
+	b = append(b, `,"chat_ids":`...)
+	if userPrivacySettingRuleRestrictChatMembers.ChatIds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range userPrivacySettingRuleRestrictChatMembers.ChatIds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(userPrivacySettingRuleRestrictChatMembers.ChatIds[i0]))
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (userPrivacySettingRuleRestrictChatMembers *UserPrivacySettingRuleRestrictChatMembers) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			userPrivacySettingRuleRestrictChatMembers.tdCommon.Type, err = r.readString()
+		case "@extra":
+			userPrivacySettingRuleRestrictChatMembers.tdCommon.Extra, err = r.readString()
+		case "chat_ids":
+			if r.readNull() {
+				userPrivacySettingRuleRestrictChatMembers.ChatIds = nil
+			} else {
+				userPrivacySettingRuleRestrictChatMembers.ChatIds = make([]int64, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int64
+					item0, err = r.readInt64()
+					userPrivacySettingRuleRestrictChatMembers.ChatIds = append(userPrivacySettingRuleRestrictChatMembers.ChatIds, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (userPrivacySettingRuleRestrictChatMembers *UserPrivacySettingRuleRestrictChatMembers) Clone() *UserPrivacySettingRuleRestrictChatMembers {
+	if userPrivacySettingRuleRestrictChatMembers == nil {
+		return nil
+	}
+	clone := *userPrivacySettingRuleRestrictChatMembers
+	if userPrivacySettingRuleRestrictChatMembers.ChatIds != nil {
+		clone.ChatIds = make([]int64, len(userPrivacySettingRuleRestrictChatMembers.ChatIds))
+		copy(clone.ChatIds, userPrivacySettingRuleRestrictChatMembers.ChatIds)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (userPrivacySettingRuleRestrictChatMembers *UserPrivacySettingRuleRestrictChatMembers) Equal(other *UserPrivacySettingRuleRestrictChatMembers) bool {
+	if userPrivacySettingRuleRestrictChatMembers == nil || other == nil {
+		return userPrivacySettingRuleRestrictChatMembers == other
+	}
+	if len(userPrivacySettingRuleRestrictChatMembers.ChatIds) != len(other.ChatIds) {
+		return false
+	}
+	for i0 := range userPrivacySettingRuleRestrictChatMembers.ChatIds {
+		if userPrivacySettingRuleRestrictChatMembers.ChatIds[i0] != other.ChatIds[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (userPrivacySettingRuleRestrictChatMembers *UserPrivacySettingRuleRestrictChatMembers) Diff(other *UserPrivacySettingRuleRestrictChatMembers) []Change {
+	return userPrivacySettingRuleRestrictChatMembers.diff("", other, nil)
+}
+
+func (userPrivacySettingRuleRestrictChatMembers *UserPrivacySettingRuleRestrictChatMembers) diff(path string, other *UserPrivacySettingRuleRestrictChatMembers, changes []Change) []Change {
+	if userPrivacySettingRuleRestrictChatMembers == nil || other == nil {
+		if userPrivacySettingRuleRestrictChatMembers != other {
+			changes = append(changes, Change{Path: path, Old: userPrivacySettingRuleRestrictChatMembers, New: other})
+		}
+		return changes
+	}
+	if len(userPrivacySettingRuleRestrictChatMembers.ChatIds) != len(other.ChatIds) {
+		changes = append(changes, Change{Path: fieldPath(path, "chat_ids"), Old: userPrivacySettingRuleRestrictChatMembers.ChatIds, New: other.ChatIds})
+	} else {
+		for i0 := range userPrivacySettingRuleRestrictChatMembers.ChatIds {
+			if userPrivacySettingRuleRestrictChatMembers.ChatIds[i0] != other.ChatIds[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "chat_ids"), i0), Old: userPrivacySettingRuleRestrictChatMembers.ChatIds[i0], New: other.ChatIds[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (userPrivacySettingRuleRestrictChatMembers *UserPrivacySettingRuleRestrictChatMembers) String() string {
+	return objectString(userPrivacySettingRuleRestrictChatMembers)
+}
+
+// LogValue logs the object as its summary
+func (userPrivacySettingRuleRestrictChatMembers *UserPrivacySettingRuleRestrictChatMembers) LogValue() slog.Value {
+	return slog.StringValue(objectString(userPrivacySettingRuleRestrictChatMembers))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (userPrivacySettingRuleRestrictChatMembers *UserPrivacySettingRuleRestrictChatMembers) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, userPrivacySettingRuleRestrictChatMembers)
+}
+
+func (userPrivacySettingRuleRestrictChatMembers *UserPrivacySettingRuleRestrictChatMembers) printText(p *printer) {
+	if userPrivacySettingRuleRestrictChatMembers == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UserPrivacySettingRuleRestrictChatMembers")
+	p.vector("chat_ids", len(userPrivacySettingRuleRestrictChatMembers.ChatIds), func(i0 int) {
+		p.int("chat_ids", int64(userPrivacySettingRuleRestrictChatMembers.ChatIds[i0]))
+	})
+	p.endObject(state)
+}
+
 // GetUserPrivacySettingRuleEnum return the enum type of this object
 func (userPrivacySettingRuleRestrictChatMembers *UserPrivacySettingRuleRestrictChatMembers) GetUserPrivacySettingRuleEnum() UserPrivacySettingRuleEnum {
 	return UserPrivacySettingRuleRestrictChatMembersType
 }
+
+// Accept calls the method of the visitor for UserPrivacySettingRuleRestrictChatMembers
+func (userPrivacySettingRuleRestrictChatMembers *UserPrivacySettingRuleRestrictChatMembers) Accept(visitor UserPrivacySettingRuleVisitor) {
+	visitor.VisitUserPrivacySettingRuleRestrictChatMembers(userPrivacySettingRuleRestrictChatMembers)
+}
+
+// UserPrivacySettingRuleVisitor visits every type of UserPrivacySettingRule, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to UserPrivacySettingRule, until they handle it too.
+type UserPrivacySettingRuleVisitor interface {
+	VisitUserPrivacySettingRuleAllowAll(userPrivacySettingRuleAllowAll *UserPrivacySettingRuleAllowAll)
+	VisitUserPrivacySettingRuleAllowContacts(userPrivacySettingRuleAllowContacts *UserPrivacySettingRuleAllowContacts)
+	VisitUserPrivacySettingRuleAllowUsers(userPrivacySettingRuleAllowUsers *UserPrivacySettingRuleAllowUsers)
+	VisitUserPrivacySettingRuleAllowChatMembers(userPrivacySettingRuleAllowChatMembers *UserPrivacySettingRuleAllowChatMembers)
+	VisitUserPrivacySettingRuleRestrictAll(userPrivacySettingRuleRestrictAll *UserPrivacySettingRuleRestrictAll)
+	VisitUserPrivacySettingRuleRestrictContacts(userPrivacySettingRuleRestrictContacts *UserPrivacySettingRuleRestrictContacts)
+	VisitUserPrivacySettingRuleRestrictUsers(userPrivacySettingRuleRestrictUsers *UserPrivacySettingRuleRestrictUsers)
+	VisitUserPrivacySettingRuleRestrictChatMembers(userPrivacySettingRuleRestrictChatMembers *UserPrivacySettingRuleRestrictChatMembers)
+	VisitUnknownUserPrivacySettingRule(unknownUserPrivacySettingRule *UnknownUserPrivacySettingRule)
+}
+
+// UserPrivacySettingRuleCases holds the function MatchUserPrivacySettingRule calls for each type of UserPrivacySettingRule;
+// types without a function are passed to the default case
+type UserPrivacySettingRuleCases[R any] struct {
+	UserPrivacySettingRuleAllowAll            func(userPrivacySettingRuleAllowAll *UserPrivacySettingRuleAllowAll) R
+	UserPrivacySettingRuleAllowContacts       func(userPrivacySettingRuleAllowContacts *UserPrivacySettingRuleAllowContacts) R
+	UserPrivacySettingRuleAllowUsers          func(userPrivacySettingRuleAllowUsers *UserPrivacySettingRuleAllowUsers) R
+	UserPrivacySettingRuleAllowChatMembers    func(userPrivacySettingRuleAllowChatMembers *UserPrivacySettingRuleAllowChatMembers) R
+	UserPrivacySettingRuleRestrictAll         func(userPrivacySettingRuleRestrictAll *UserPrivacySettingRuleRestrictAll) R
+	UserPrivacySettingRuleRestrictContacts    func(userPrivacySettingRuleRestrictContacts *UserPrivacySettingRuleRestrictContacts) R
+	UserPrivacySettingRuleRestrictUsers       func(userPrivacySettingRuleRestrictUsers *UserPrivacySettingRuleRestrictUsers) R
+	UserPrivacySettingRuleRestrictChatMembers func(userPrivacySettingRuleRestrictChatMembers *UserPrivacySettingRuleRestrictChatMembers) R
+}
+
+// MatchUserPrivacySettingRule calls the case of the type of userPrivacySettingRule, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement UserPrivacySettingRuleVisitor
+// to have the compiler check every type is handled.
+func MatchUserPrivacySettingRule[R any](userPrivacySettingRule UserPrivacySettingRule, cases UserPrivacySettingRuleCases[R], defaultCase func(userPrivacySettingRule UserPrivacySettingRule) R) R {
+	switch value := userPrivacySettingRule.(type) {
+	case *UserPrivacySettingRuleAllowAll:
+		if cases.UserPrivacySettingRuleAllowAll != nil {
+			return cases.UserPrivacySettingRuleAllowAll(value)
+		}
+	case *UserPrivacySettingRuleAllowContacts:
+		if cases.UserPrivacySettingRuleAllowContacts != nil {
+			return cases.UserPrivacySettingRuleAllowContacts(value)
+		}
+	case *UserPrivacySettingRuleAllowUsers:
+		if cases.UserPrivacySettingRuleAllowUsers != nil {
+			return cases.UserPrivacySettingRuleAllowUsers(value)
+		}
+	case *UserPrivacySettingRuleAllowChatMembers:
+		if cases.UserPrivacySettingRuleAllowChatMembers != nil {
+			return cases.UserPrivacySettingRuleAllowChatMembers(value)
+		}
+	case *UserPrivacySettingRuleRestrictAll:
+		if cases.UserPrivacySettingRuleRestrictAll != nil {
+			return cases.UserPrivacySettingRuleRestrictAll(value)
+		}
+	case *UserPrivacySettingRuleRestrictContacts:
+		if cases.UserPrivacySettingRuleRestrictContacts != nil {
+			return cases.UserPrivacySettingRuleRestrictContacts(value)
+		}
+	case *UserPrivacySettingRuleRestrictUsers:
+		if cases.UserPrivacySettingRuleRestrictUsers != nil {
+			return cases.UserPrivacySettingRuleRestrictUsers(value)
+		}
+	case *UserPrivacySettingRuleRestrictChatMembers:
+		if cases.UserPrivacySettingRuleRestrictChatMembers != nil {
+			return cases.UserPrivacySettingRuleRestrictChatMembers(value)
+		}
+	}
+	return defaultCase(userPrivacySettingRule)
+}
+
+// Clone returns a deep copy of the object
+func (unknownUserPrivacySettingRule *UnknownUserPrivacySettingRule) Clone() *UnknownUserPrivacySettingRule {
+	if unknownUserPrivacySettingRule == nil {
+		return nil
+	}
+	return &UnknownUserPrivacySettingRule{Unknown: unknownUserPrivacySettingRule.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownUserPrivacySettingRule *UnknownUserPrivacySettingRule) Equal(other *UnknownUserPrivacySettingRule) bool {
+	if unknownUserPrivacySettingRule == nil || other == nil {
+		return unknownUserPrivacySettingRule == other
+	}
+	return unknownUserPrivacySettingRule.Unknown.equal(other.Unknown)
+}
+
+func cloneUserPrivacySettingRule(userPrivacySettingRule UserPrivacySettingRule) UserPrivacySettingRule {
+	switch value := userPrivacySettingRule.(type) {
+	case *UserPrivacySettingRuleAllowAll:
+		return value.Clone()
+	case *UserPrivacySettingRuleAllowContacts:
+		return value.Clone()
+	case *UserPrivacySettingRuleAllowUsers:
+		return value.Clone()
+	case *UserPrivacySettingRuleAllowChatMembers:
+		return value.Clone()
+	case *UserPrivacySettingRuleRestrictAll:
+		return value.Clone()
+	case *UserPrivacySettingRuleRestrictContacts:
+		return value.Clone()
+	case *UserPrivacySettingRuleRestrictUsers:
+		return value.Clone()
+	case *UserPrivacySettingRuleRestrictChatMembers:
+		return value.Clone()
+	case *UnknownUserPrivacySettingRule:
+		return value.Clone()
+	}
+	return userPrivacySettingRule
+}
+
+func equalUserPrivacySettingRule(a UserPrivacySettingRule, b UserPrivacySettingRule) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *UserPrivacySettingRuleAllowAll:
+		b, ok := b.(*UserPrivacySettingRuleAllowAll)
+		return ok && a.Equal(b)
+	case *UserPrivacySettingRuleAllowContacts:
+		b, ok := b.(*UserPrivacySettingRuleAllowContacts)
+		return ok && a.Equal(b)
+	case *UserPrivacySettingRuleAllowUsers:
+		b, ok := b.(*UserPrivacySettingRuleAllowUsers)
+		return ok && a.Equal(b)
+	case *UserPrivacySettingRuleAllowChatMembers:
+		b, ok := b.(*UserPrivacySettingRuleAllowChatMembers)
+		return ok && a.Equal(b)
+	case *UserPrivacySettingRuleRestrictAll:
+		b, ok := b.(*UserPrivacySettingRuleRestrictAll)
+		return ok && a.Equal(b)
+	case *UserPrivacySettingRuleRestrictContacts:
+		b, ok := b.(*UserPrivacySettingRuleRestrictContacts)
+		return ok && a.Equal(b)
+	case *UserPrivacySettingRuleRestrictUsers:
+		b, ok := b.(*UserPrivacySettingRuleRestrictUsers)
+		return ok && a.Equal(b)
+	case *UserPrivacySettingRuleRestrictChatMembers:
+		b, ok := b.(*UserPrivacySettingRuleRestrictChatMembers)
+		return ok && a.Equal(b)
+	case *UnknownUserPrivacySettingRule:
+		b, ok := b.(*UnknownUserPrivacySettingRule)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffUserPrivacySettingRule(path string, a UserPrivacySettingRule, b UserPrivacySettingRule, changes []Change) []Change {
+	switch a := a.(type) {
+	case *UserPrivacySettingRuleAllowAll:
+		if b, ok := b.(*UserPrivacySettingRuleAllowAll); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UserPrivacySettingRuleAllowContacts:
+		if b, ok := b.(*UserPrivacySettingRuleAllowContacts); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UserPrivacySettingRuleAllowUsers:
+		if b, ok := b.(*UserPrivacySettingRuleAllowUsers); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UserPrivacySettingRuleAllowChatMembers:
+		if b, ok := b.(*UserPrivacySettingRuleAllowChatMembers); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UserPrivacySettingRuleRestrictAll:
+		if b, ok := b.(*UserPrivacySettingRuleRestrictAll); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UserPrivacySettingRuleRestrictContacts:
+		if b, ok := b.(*UserPrivacySettingRuleRestrictContacts); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UserPrivacySettingRuleRestrictUsers:
+		if b, ok := b.(*UserPrivacySettingRuleRestrictUsers); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UserPrivacySettingRuleRestrictChatMembers:
+		if b, ok := b.(*UserPrivacySettingRuleRestrictChatMembers); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalUserPrivacySettingRule(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // UserPrivacySettingRules A list of privacy rules. Rules are matched in the specified order. The first matched rule defines the privacy setting for a given user. If no rule matches, the action is not allowed
@@ -28,6 +28,150 @@ func NewUserPrivacySettingRules(rules []UserPrivacySettingRule) *UserPrivacySett
 	return &userPrivacySettingRulesTemp
 }
 
+// MarshalJSON marshals to json
+func (userPrivacySettingRules *UserPrivacySettingRules) MarshalJSON() ([]byte, error) {
+	return userPrivacySettingRules.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (userPrivacySettingRules *UserPrivacySettingRules) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, userPrivacySettingRules)
+}
+
+func (userPrivacySettingRules *UserPrivacySettingRules) appendJSON(b []byte) ([]byte, error) {
+	if userPrivacySettingRules == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, userPrivacySettingRules.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, userPrivacySettingRules.tdCommon.Extra)
+	b = append(b, `,"rules":`...)
+	if userPrivacySettingRules.Rules == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range userPrivacySettingRules.Rules {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = appendJSONValue(b, userPrivacySettingRules.Rules[i0])
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (userPrivacySettingRules *UserPrivacySettingRules) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			userPrivacySettingRules.tdCommon.Type, err = r.readString()
+		case "@extra":
+			userPrivacySettingRules.tdCommon.Extra, err = r.readString()
+		case "rules":
+			if r.readNull() {
+				userPrivacySettingRules.Rules = nil
+			} else {
+				userPrivacySettingRules.Rules = make([]UserPrivacySettingRule, 0)
+				err = r.readArray(func() (err error) {
+					var item0 UserPrivacySettingRule
+					item0, err = decodeUserPrivacySettingRule(r)
+					userPrivacySettingRules.Rules = append(userPrivacySettingRules.Rules, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (userPrivacySettingRules *UserPrivacySettingRules) Clone() *UserPrivacySettingRules {
+	if userPrivacySettingRules == nil {
+		return nil
+	}
+	clone := *userPrivacySettingRules
+	if userPrivacySettingRules.Rules != nil {
+		clone.Rules = make([]UserPrivacySettingRule, len(userPrivacySettingRules.Rules))
+		for i0 := range userPrivacySettingRules.Rules {
+			clone.Rules[i0] = cloneUserPrivacySettingRule(userPrivacySettingRules.Rules[i0])
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (userPrivacySettingRules *UserPrivacySettingRules) Equal(other *UserPrivacySettingRules) bool {
+	if userPrivacySettingRules == nil || other == nil {
+		return userPrivacySettingRules == other
+	}
+	if len(userPrivacySettingRules.Rules) != len(other.Rules) {
+		return false
+	}
+	for i0 := range userPrivacySettingRules.Rules {
+		if !equalUserPrivacySettingRule(userPrivacySettingRules.Rules[i0], other.Rules[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (userPrivacySettingRules *UserPrivacySettingRules) Diff(other *UserPrivacySettingRules) []Change {
+	return userPrivacySettingRules.diff("", other, nil)
+}
+
+func (userPrivacySettingRules *UserPrivacySettingRules) diff(path string, other *UserPrivacySettingRules, changes []Change) []Change {
+	if userPrivacySettingRules == nil || other == nil {
+		if userPrivacySettingRules != other {
+			changes = append(changes, Change{Path: path, Old: userPrivacySettingRules, New: other})
+		}
+		return changes
+	}
+	if len(userPrivacySettingRules.Rules) != len(other.Rules) {
+		changes = append(changes, Change{Path: fieldPath(path, "rules"), Old: userPrivacySettingRules.Rules, New: other.Rules})
+	} else {
+		for i0 := range userPrivacySettingRules.Rules {
+			changes = diffUserPrivacySettingRule(indexPath(fieldPath(path, "rules"), i0), userPrivacySettingRules.Rules[i0], other.Rules[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (userPrivacySettingRules *UserPrivacySettingRules) String() string {
+	return objectString(userPrivacySettingRules)
+}
+
+// LogValue logs the object as its summary
+func (userPrivacySettingRules *UserPrivacySettingRules) LogValue() slog.Value {
+	return slog.StringValue(objectString(userPrivacySettingRules))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (userPrivacySettingRules *UserPrivacySettingRules) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, userPrivacySettingRules)
+}
+
+func (userPrivacySettingRules *UserPrivacySettingRules) printText(p *printer) {
+	if userPrivacySettingRules == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UserPrivacySettingRules")
+	p.vector("rules", len(userPrivacySettingRules.Rules), func(i0 int) {
+		p.object("rules", userPrivacySettingRules.Rules[i0])
+	})
+	p.endObject(state)
+}
+
 // GetUserPrivacySettingRules Returns the current privacy settings
 // @param setting The privacy setting
 func (client *Client) GetUserPrivacySettingRules(setting UserPrivacySetting) (*UserPrivacySettingRules, error) {
@@ -40,11 +184,11 @@ func (client *Client) GetUserPrivacySettingRules(setting UserPrivacySetting) (*U
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var userPrivacySettingRules UserPrivacySettingRules
-	err = json.Unmarshal(result.Raw, &userPrivacySettingRules)
+	err = jsonUnmarshal(result.Raw, &userPrivacySettingRules)
 	return &userPrivacySettingRules, err
 }
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // UserStatus Describes the last time the user was online
 type UserStatus interface {
 	GetUserStatusEnum() UserStatusEnum
+	Accept(visitor UserStatusVisitor)
 }
 
 // UserStatusEnum Alias for abstract UserStatus 'Sub-Classes', used as constant-enum here
@@ -23,51 +25,102 @@ const (
 	UserStatusLastMonthType UserStatusEnum = "userStatusLastMonth"
 )
 
+// UnknownUserStatus is an UserStatus of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownUserStatus struct {
+	Unknown
+}
+
+// GetUserStatusEnum return the enum type of this object
+func (unknownUserStatus *UnknownUserStatus) GetUserStatusEnum() UserStatusEnum {
+	return UserStatusEnum(unknownUserStatus.Type)
+}
+
+// Accept calls the method of the visitor for UnknownUserStatus
+func (unknownUserStatus *UnknownUserStatus) Accept(visitor UserStatusVisitor) {
+	visitor.VisitUnknownUserStatus(unknownUserStatus)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownUserStatus *UnknownUserStatus) String() string {
+	return objectString(unknownUserStatus)
+}
+
+// LogValue logs the object as its summary
+func (unknownUserStatus *UnknownUserStatus) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownUserStatus))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownUserStatus *UnknownUserStatus) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownUserStatus)
+}
+
+func (unknownUserStatus *UnknownUserStatus) printText(p *printer) {
+	if unknownUserStatus == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownUserStatus")
+	p.string("@type", unknownUserStatus.Type)
+	p.endObject(state)
+}
+
 func unmarshalUserStatus(rawMsg *json.RawMessage) (UserStatus, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch UserStatusEnum(objMap["@type"].(string)) {
+	switch UserStatusEnum(typeName) {
 	case UserStatusEmptyType:
 		var userStatusEmpty UserStatusEmpty
-		err := json.Unmarshal(*rawMsg, &userStatusEmpty)
+		err := jsonUnmarshal(*rawMsg, &userStatusEmpty)
 		return &userStatusEmpty, err
 
 	case UserStatusOnlineType:
 		var userStatusOnline UserStatusOnline
-		err := json.Unmarshal(*rawMsg, &userStatusOnline)
+		err := jsonUnmarshal(*rawMsg, &userStatusOnline)
 		return &userStatusOnline, err
 
 	case UserStatusOfflineType:
 		var userStatusOffline UserStatusOffline
-		err := json.Unmarshal(*rawMsg, &userStatusOffline)
+		err := jsonUnmarshal(*rawMsg, &userStatusOffline)
 		return &userStatusOffline, err
 
 	case UserStatusRecentlyType:
 		var userStatusRecently UserStatusRecently
-		err := json.Unmarshal(*rawMsg, &userStatusRecently)
+		err := jsonUnmarshal(*rawMsg, &userStatusRecently)
 		return &userStatusRecently, err
 
 	case UserStatusLastWeekType:
 		var userStatusLastWeek UserStatusLastWeek
-		err := json.Unmarshal(*rawMsg, &userStatusLastWeek)
+		err := jsonUnmarshal(*rawMsg, &userStatusLastWeek)
 		return &userStatusLastWeek, err
 
 	case UserStatusLastMonthType:
 		var userStatusLastMonth UserStatusLastMonth
-		err := json.Unmarshal(*rawMsg, &userStatusLastMonth)
+		err := jsonUnmarshal(*rawMsg, &userStatusLastMonth)
 		return &userStatusLastMonth, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownUserStatus{Unknown: unknown}, nil
+	}
+}
+
+func decodeUserStatus(r *jsonReader) (UserStatus, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
 	}
+	return unmarshalUserStatus(rawMsg)
 }
 
 // UserStatusEmpty The user status was never changed
@@ -81,7 +134,6 @@ func (userStatusEmpty *UserStatusEmpty) MessageType() string {
 }
 
 // NewUserStatusEmpty creates a new UserStatusEmpty
-//
 func NewUserStatusEmpty() *UserStatusEmpty {
 	userStatusEmptyTemp := UserStatusEmpty{
 		tdCommon: tdCommon{Type: "userStatusEmpty"},
@@ -90,11 +142,107 @@ func NewUserStatusEmpty() *UserStatusEmpty {
 	return &userStatusEmptyTemp
 }
 
+// MarshalJSON marshals to json
+func (userStatusEmpty *UserStatusEmpty) MarshalJSON() ([]byte, error) {
+	return userStatusEmpty.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (userStatusEmpty *UserStatusEmpty) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, userStatusEmpty)
+}
+
+func (userStatusEmpty *UserStatusEmpty) appendJSON(b []byte) ([]byte, error) {
+	if userStatusEmpty == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, userStatusEmpty.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, userStatusEmpty.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (userStatusEmpty *UserStatusEmpty) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			userStatusEmpty.tdCommon.Type, err = r.readString()
+		case "@extra":
+			userStatusEmpty.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (userStatusEmpty *UserStatusEmpty) Clone() *UserStatusEmpty {
+	if userStatusEmpty == nil {
+		return nil
+	}
+	clone := *userStatusEmpty
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (userStatusEmpty *UserStatusEmpty) Equal(other *UserStatusEmpty) bool {
+	if userStatusEmpty == nil || other == nil {
+		return userStatusEmpty == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (userStatusEmpty *UserStatusEmpty) Diff(other *UserStatusEmpty) []Change {
+	return userStatusEmpty.diff("", other, nil)
+}
+
+func (userStatusEmpty *UserStatusEmpty) diff(path string, other *UserStatusEmpty, changes []Change) []Change {
+	if userStatusEmpty == nil || other == nil {
+		if userStatusEmpty != other {
+			changes = append(changes, Change{Path: path, Old: userStatusEmpty, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (userStatusEmpty *UserStatusEmpty) String() string {
+	return objectString(userStatusEmpty)
+}
+
+// LogValue logs the object as its summary
+func (userStatusEmpty *UserStatusEmpty) LogValue() slog.Value {
+	return slog.StringValue(objectString(userStatusEmpty))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (userStatusEmpty *UserStatusEmpty) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, userStatusEmpty)
+}
+
+func (userStatusEmpty *UserStatusEmpty) printText(p *printer) {
+	if userStatusEmpty == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UserStatusEmpty")
+	p.endObject(state)
+}
+
 // GetUserStatusEnum return the enum type of this object
 func (userStatusEmpty *UserStatusEmpty) GetUserStatusEnum() UserStatusEnum {
 	return UserStatusEmptyType
 }
 
+// Accept calls the method of the visitor for UserStatusEmpty
+func (userStatusEmpty *UserStatusEmpty) Accept(visitor UserStatusVisitor) {
+	visitor.VisitUserStatusEmpty(userStatusEmpty)
+}
+
 // UserStatusOnline The user is online
 type UserStatusOnline struct {
 	tdCommon
@@ -118,11 +266,118 @@ func NewUserStatusOnline(expires int32) *UserStatusOnline {
 	return &userStatusOnlineTemp
 }
 
+// MarshalJSON marshals to json
+func (userStatusOnline *UserStatusOnline) MarshalJSON() ([]byte, error) {
+	return userStatusOnline.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (userStatusOnline *UserStatusOnline) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, userStatusOnline)
+}
+
+func (userStatusOnline *UserStatusOnline) appendJSON(b []byte) ([]byte, error) {
+	if userStatusOnline == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, userStatusOnline.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, userStatusOnline.tdCommon.Extra)
+	b = append(b, `,"expires":`...)
+	b = appendJSONInt(b, int64(userStatusOnline.Expires))
+	return append(b, '}'), nil
+}
+
+func (userStatusOnline *UserStatusOnline) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			userStatusOnline.tdCommon.Type, err = r.readString()
+		case "@extra":
+			userStatusOnline.tdCommon.Extra, err = r.readString()
+		case "expires":
+			userStatusOnline.Expires, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (userStatusOnline *UserStatusOnline) Clone() *UserStatusOnline {
+	if userStatusOnline == nil {
+		return nil
+	}
+	clone := *userStatusOnline
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (userStatusOnline *UserStatusOnline) Equal(other *UserStatusOnline) bool {
+	if userStatusOnline == nil || other == nil {
+		return userStatusOnline == other
+	}
+	if userStatusOnline.Expires != other.Expires {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (userStatusOnline *UserStatusOnline) Diff(other *UserStatusOnline) []Change {
+	return userStatusOnline.diff("", other, nil)
+}
+
+func (userStatusOnline *UserStatusOnline) diff(path string, other *UserStatusOnline, changes []Change) []Change {
+	if userStatusOnline == nil || other == nil {
+		if userStatusOnline != other {
+			changes = append(changes, Change{Path: path, Old: userStatusOnline, New: other})
+		}
+		return changes
+	}
+	if userStatusOnline.Expires != other.Expires {
+		changes = append(changes, Change{Path: fieldPath(path, "expires"), Old: userStatusOnline.Expires, New: other.Expires})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (userStatusOnline *UserStatusOnline) String() string {
+	return objectString(userStatusOnline)
+}
+
+// LogValue logs the object as its summary
+func (userStatusOnline *UserStatusOnline) LogValue() slog.Value {
+	return slog.StringValue(objectString(userStatusOnline))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (userStatusOnline *UserStatusOnline) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, userStatusOnline)
+}
+
+func (userStatusOnline *UserStatusOnline) printText(p *printer) {
+	if userStatusOnline == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UserStatusOnline")
+	p.int("expires", int64(userStatusOnline.Expires))
+	p.endObject(state)
+}
+
 // GetUserStatusEnum return the enum type of this object
 func (userStatusOnline *UserStatusOnline) GetUserStatusEnum() UserStatusEnum {
 	return UserStatusOnlineType
 }
 
+// Accept calls the method of the visitor for UserStatusOnline
+func (userStatusOnline *UserStatusOnline) Accept(visitor UserStatusVisitor) {
+	visitor.VisitUserStatusOnline(userStatusOnline)
+}
+
 // UserStatusOffline The user is offline
 type UserStatusOffline struct {
 	tdCommon
@@ -146,11 +401,118 @@ func NewUserStatusOffline(wasOnline int32) *UserStatusOffline {
 	return &userStatusOfflineTemp
 }
 
+// MarshalJSON marshals to json
+func (userStatusOffline *UserStatusOffline) MarshalJSON() ([]byte, error) {
+	return userStatusOffline.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (userStatusOffline *UserStatusOffline) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, userStatusOffline)
+}
+
+func (userStatusOffline *UserStatusOffline) appendJSON(b []byte) ([]byte, error) {
+	if userStatusOffline == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, userStatusOffline.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, userStatusOffline.tdCommon.Extra)
+	b = append(b, `,"was_online":`...)
+	b = appendJSONInt(b, int64(userStatusOffline.WasOnline))
+	return append(b, '}'), nil
+}
+
+func (userStatusOffline *UserStatusOffline) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			userStatusOffline.tdCommon.Type, err = r.readString()
+		case "@extra":
+			userStatusOffline.tdCommon.Extra, err = r.readString()
+		case "was_online":
+			userStatusOffline.WasOnline, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (userStatusOffline *UserStatusOffline) Clone() *UserStatusOffline {
+	if userStatusOffline == nil {
+		return nil
+	}
+	clone := *userStatusOffline
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (userStatusOffline *UserStatusOffline) Equal(other *UserStatusOffline) bool {
+	if userStatusOffline == nil || other == nil {
+		return userStatusOffline == other
+	}
+	if userStatusOffline.WasOnline != other.WasOnline {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (userStatusOffline *UserStatusOffline) Diff(other *UserStatusOffline) []Change {
+	return userStatusOffline.diff("", other, nil)
+}
+
+func (userStatusOffline *UserStatusOffline) diff(path string, other *UserStatusOffline, changes []Change) []Change {
+	if userStatusOffline == nil || other == nil {
+		if userStatusOffline != other {
+			changes = append(changes, Change{Path: path, Old: userStatusOffline, New: other})
+		}
+		return changes
+	}
+	if userStatusOffline.WasOnline != other.WasOnline {
+		changes = append(changes, Change{Path: fieldPath(path, "was_online"), Old: userStatusOffline.WasOnline, New: other.WasOnline})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (userStatusOffline *UserStatusOffline) String() string {
+	return objectString(userStatusOffline)
+}
+
+// LogValue logs the object as its summary
+func (userStatusOffline *UserStatusOffline) LogValue() slog.Value {
+	return slog.StringValue(objectString(userStatusOffline))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (userStatusOffline *UserStatusOffline) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, userStatusOffline)
+}
+
+func (userStatusOffline *UserStatusOffline) printText(p *printer) {
+	if userStatusOffline == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UserStatusOffline")
+	p.int("was_online", int64(userStatusOffline.WasOnline))
+	p.endObject(state)
+}
+
 // GetUserStatusEnum return the enum type of this object
 func (userStatusOffline *UserStatusOffline) GetUserStatusEnum() UserStatusEnum {
 	return UserStatusOfflineType
 }
 
+// Accept calls the method of the visitor for UserStatusOffline
+func (userStatusOffline *UserStatusOffline) Accept(visitor UserStatusVisitor) {
+	visitor.VisitUserStatusOffline(userStatusOffline)
+}
+
 // UserStatusRecently The user was online recently
 type UserStatusRecently struct {
 	tdCommon
@@ -162,7 +524,6 @@ func (userStatusRecently *UserStatusRecently) MessageType() string {
 }
 
 // NewUserStatusRecently creates a new UserStatusRecently
-//
 func NewUserStatusRecently() *UserStatusRecently {
 	userStatusRecentlyTemp := UserStatusRecently{
 		tdCommon: tdCommon{Type: "userStatusRecently"},
@@ -171,11 +532,107 @@ func NewUserStatusRecently() *UserStatusRecently {
 	return &userStatusRecentlyTemp
 }
 
+// MarshalJSON marshals to json
+func (userStatusRecently *UserStatusRecently) MarshalJSON() ([]byte, error) {
+	return userStatusRecently.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (userStatusRecently *UserStatusRecently) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, userStatusRecently)
+}
+
+func (userStatusRecently *UserStatusRecently) appendJSON(b []byte) ([]byte, error) {
+	if userStatusRecently == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, userStatusRecently.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, userStatusRecently.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (userStatusRecently *UserStatusRecently) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			userStatusRecently.tdCommon.Type, err = r.readString()
+		case "@extra":
+			userStatusRecently.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (userStatusRecently *UserStatusRecently) Clone() *UserStatusRecently {
+	if userStatusRecently == nil {
+		return nil
+	}
+	clone := *userStatusRecently
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (userStatusRecently *UserStatusRecently) Equal(other *UserStatusRecently) bool {
+	if userStatusRecently == nil || other == nil {
+		return userStatusRecently == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (userStatusRecently *UserStatusRecently) Diff(other *UserStatusRecently) []Change {
+	return userStatusRecently.diff("", other, nil)
+}
+
+func (userStatusRecently *UserStatusRecently) diff(path string, other *UserStatusRecently, changes []Change) []Change {
+	if userStatusRecently == nil || other == nil {
+		if userStatusRecently != other {
+			changes = append(changes, Change{Path: path, Old: userStatusRecently, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (userStatusRecently *UserStatusRecently) String() string {
+	return objectString(userStatusRecently)
+}
+
+// LogValue logs the object as its summary
+func (userStatusRecently *UserStatusRecently) LogValue() slog.Value {
+	return slog.StringValue(objectString(userStatusRecently))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (userStatusRecently *UserStatusRecently) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, userStatusRecently)
+}
+
+func (userStatusRecently *UserStatusRecently) printText(p *printer) {
+	if userStatusRecently == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UserStatusRecently")
+	p.endObject(state)
+}
+
 // GetUserStatusEnum return the enum type of this object
 func (userStatusRecently *UserStatusRecently) GetUserStatusEnum() UserStatusEnum {
 	return UserStatusRecentlyType
 }
 
+// Accept calls the method of the visitor for UserStatusRecently
+func (userStatusRecently *UserStatusRecently) Accept(visitor UserStatusVisitor) {
+	visitor.VisitUserStatusRecently(userStatusRecently)
+}
+
 // UserStatusLastWeek The user is offline, but was online last week
 type UserStatusLastWeek struct {
 	tdCommon
@@ -187,7 +644,6 @@ func (userStatusLastWeek *UserStatusLastWeek) MessageType() string {
 }
 
 // NewUserStatusLastWeek creates a new UserStatusLastWeek
-//
 func NewUserStatusLastWeek() *UserStatusLastWeek {
 	userStatusLastWeekTemp := UserStatusLastWeek{
 		tdCommon: tdCommon{Type: "userStatusLastWeek"},
@@ -196,11 +652,107 @@ func NewUserStatusLastWeek() *UserStatusLastWeek {
 	return &userStatusLastWeekTemp
 }
 
+// MarshalJSON marshals to json
+func (userStatusLastWeek *UserStatusLastWeek) MarshalJSON() ([]byte, error) {
+	return userStatusLastWeek.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (userStatusLastWeek *UserStatusLastWeek) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, userStatusLastWeek)
+}
+
+func (userStatusLastWeek *UserStatusLastWeek) appendJSON(b []byte) ([]byte, error) {
+	if userStatusLastWeek == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, userStatusLastWeek.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, userStatusLastWeek.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (userStatusLastWeek *UserStatusLastWeek) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			userStatusLastWeek.tdCommon.Type, err = r.readString()
+		case "@extra":
+			userStatusLastWeek.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (userStatusLastWeek *UserStatusLastWeek) Clone() *UserStatusLastWeek {
+	if userStatusLastWeek == nil {
+		return nil
+	}
+	clone := *userStatusLastWeek
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (userStatusLastWeek *UserStatusLastWeek) Equal(other *UserStatusLastWeek) bool {
+	if userStatusLastWeek == nil || other == nil {
+		return userStatusLastWeek == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (userStatusLastWeek *UserStatusLastWeek) Diff(other *UserStatusLastWeek) []Change {
+	return userStatusLastWeek.diff("", other, nil)
+}
+
+func (userStatusLastWeek *UserStatusLastWeek) diff(path string, other *UserStatusLastWeek, changes []Change) []Change {
+	if userStatusLastWeek == nil || other == nil {
+		if userStatusLastWeek != other {
+			changes = append(changes, Change{Path: path, Old: userStatusLastWeek, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (userStatusLastWeek *UserStatusLastWeek) String() string {
+	return objectString(userStatusLastWeek)
+}
+
+// LogValue logs the object as its summary
+func (userStatusLastWeek *UserStatusLastWeek) LogValue() slog.Value {
+	return slog.StringValue(objectString(userStatusLastWeek))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (userStatusLastWeek *UserStatusLastWeek) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, userStatusLastWeek)
+}
+
+func (userStatusLastWeek *UserStatusLastWeek) printText(p *printer) {
+	if userStatusLastWeek == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UserStatusLastWeek")
+	p.endObject(state)
+}
+
 // GetUserStatusEnum return the enum type of this object
 func (userStatusLastWeek *UserStatusLastWeek) GetUserStatusEnum() UserStatusEnum {
 	return UserStatusLastWeekType
 }
 
+// Accept calls the method of the visitor for UserStatusLastWeek
+func (userStatusLastWeek *UserStatusLastWeek) Accept(visitor UserStatusVisitor) {
+	visitor.VisitUserStatusLastWeek(userStatusLastWeek)
+}
+
 // UserStatusLastMonth The user is offline, but was online last month
 type UserStatusLastMonth struct {
 	tdCommon
@@ -212,7 +764,6 @@ func (userStatusLastMonth *UserStatusLastMonth) MessageType() string {
 }
 
 // NewUserStatusLastMonth creates a new UserStatusLastMonth
-//
 func NewUserStatusLastMonth() *UserStatusLastMonth {
 	userStatusLastMonthTemp := UserStatusLastMonth{
 		tdCommon: tdCommon{Type: "userStatusLastMonth"},
@@ -221,7 +772,258 @@ func NewUserStatusLastMonth() *UserStatusLastMonth {
 	return &userStatusLastMonthTemp
 }
 
+// MarshalJSON marshals to json
+func (userStatusLastMonth *UserStatusLastMonth) MarshalJSON() ([]byte, error) {
+	return userStatusLastMonth.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (userStatusLastMonth *UserStatusLastMonth) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, userStatusLastMonth)
+}
+
+func (userStatusLastMonth *UserStatusLastMonth) appendJSON(b []byte) ([]byte, error) {
+	if userStatusLastMonth == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, userStatusLastMonth.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, userStatusLastMonth.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (userStatusLastMonth *UserStatusLastMonth) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			userStatusLastMonth.tdCommon.Type, err = r.readString()
+		case "@extra":
+			userStatusLastMonth.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (userStatusLastMonth *UserStatusLastMonth) Clone() *UserStatusLastMonth {
+	if userStatusLastMonth == nil {
+		return nil
+	}
+	clone := *userStatusLastMonth
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (userStatusLastMonth *UserStatusLastMonth) Equal(other *UserStatusLastMonth) bool {
+	if userStatusLastMonth == nil || other == nil {
+		return userStatusLastMonth == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (userStatusLastMonth *UserStatusLastMonth) Diff(other *UserStatusLastMonth) []Change {
+	return userStatusLastMonth.diff("", other, nil)
+}
+
+func (userStatusLastMonth *UserStatusLastMonth) diff(path string, other *UserStatusLastMonth, changes []Change) []Change {
+	if userStatusLastMonth == nil || other == nil {
+		if userStatusLastMonth != other {
+			changes = append(changes, Change{Path: path, Old: userStatusLastMonth, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (userStatusLastMonth *UserStatusLastMonth) String() string {
+	return objectString(userStatusLastMonth)
+}
+
+// LogValue logs the object as its summary
+func (userStatusLastMonth *UserStatusLastMonth) LogValue() slog.Value {
+	return slog.StringValue(objectString(userStatusLastMonth))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (userStatusLastMonth *UserStatusLastMonth) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, userStatusLastMonth)
+}
+
+func (userStatusLastMonth *UserStatusLastMonth) printText(p *printer) {
+	if userStatusLastMonth == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UserStatusLastMonth")
+	p.endObject(state)
+}
+
 // GetUserStatusEnum return the enum type of this object
 func (userStatusLastMonth *UserStatusLastMonth) GetUserStatusEnum() UserStatusEnum {
 	return UserStatusLastMonthType
 }
+
+// Accept calls the method of the visitor for UserStatusLastMonth
+func (userStatusLastMonth *UserStatusLastMonth) Accept(visitor UserStatusVisitor) {
+	visitor.VisitUserStatusLastMonth(userStatusLastMonth)
+}
+
+// UserStatusVisitor visits every type of UserStatus, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to UserStatus, until they handle it too.
+type UserStatusVisitor interface {
+	VisitUserStatusEmpty(userStatusEmpty *UserStatusEmpty)
+	VisitUserStatusOnline(userStatusOnline *UserStatusOnline)
+	VisitUserStatusOffline(userStatusOffline *UserStatusOffline)
+	VisitUserStatusRecently(userStatusRecently *UserStatusRecently)
+	VisitUserStatusLastWeek(userStatusLastWeek *UserStatusLastWeek)
+	VisitUserStatusLastMonth(userStatusLastMonth *UserStatusLastMonth)
+	VisitUnknownUserStatus(unknownUserStatus *UnknownUserStatus)
+}
+
+// UserStatusCases holds the function MatchUserStatus calls for each type of UserStatus;
+// types without a function are passed to the default case
+type UserStatusCases[R any] struct {
+	UserStatusEmpty     func(userStatusEmpty *UserStatusEmpty) R
+	UserStatusOnline    func(userStatusOnline *UserStatusOnline) R
+	UserStatusOffline   func(userStatusOffline *UserStatusOffline) R
+	UserStatusRecently  func(userStatusRecently *UserStatusRecently) R
+	UserStatusLastWeek  func(userStatusLastWeek *UserStatusLastWeek) R
+	UserStatusLastMonth func(userStatusLastMonth *UserStatusLastMonth) R
+}
+
+// MatchUserStatus calls the case of the type of userStatus, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement UserStatusVisitor
+// to have the compiler check every type is handled.
+func MatchUserStatus[R any](userStatus UserStatus, cases UserStatusCases[R], defaultCase func(userStatus UserStatus) R) R {
+	switch value := userStatus.(type) {
+	case *UserStatusEmpty:
+		if cases.UserStatusEmpty != nil {
+			return cases.UserStatusEmpty(value)
+		}
+	case *UserStatusOnline:
+		if cases.UserStatusOnline != nil {
+			return cases.UserStatusOnline(value)
+		}
+	case *UserStatusOffline:
+		if cases.UserStatusOffline != nil {
+			return cases.UserStatusOffline(value)
+		}
+	case *UserStatusRecently:
+		if cases.UserStatusRecently != nil {
+			return cases.UserStatusRecently(value)
+		}
+	case *UserStatusLastWeek:
+		if cases.UserStatusLastWeek != nil {
+			return cases.UserStatusLastWeek(value)
+		}
+	case *UserStatusLastMonth:
+		if cases.UserStatusLastMonth != nil {
+			return cases.UserStatusLastMonth(value)
+		}
+	}
+	return defaultCase(userStatus)
+}
+
+// Clone returns a deep copy of the object
+func (unknownUserStatus *UnknownUserStatus) Clone() *UnknownUserStatus {
+	if unknownUserStatus == nil {
+		return nil
+	}
+	return &UnknownUserStatus{Unknown: unknownUserStatus.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownUserStatus *UnknownUserStatus) Equal(other *UnknownUserStatus) bool {
+	if unknownUserStatus == nil || other == nil {
+		return unknownUserStatus == other
+	}
+	return unknownUserStatus.Unknown.equal(other.Unknown)
+}
+
+func cloneUserStatus(userStatus UserStatus) UserStatus {
+	switch value := userStatus.(type) {
+	case *UserStatusEmpty:
+		return value.Clone()
+	case *UserStatusOnline:
+		return value.Clone()
+	case *UserStatusOffline:
+		return value.Clone()
+	case *UserStatusRecently:
+		return value.Clone()
+	case *UserStatusLastWeek:
+		return value.Clone()
+	case *UserStatusLastMonth:
+		return value.Clone()
+	case *UnknownUserStatus:
+		return value.Clone()
+	}
+	return userStatus
+}
+
+func equalUserStatus(a UserStatus, b UserStatus) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *UserStatusEmpty:
+		b, ok := b.(*UserStatusEmpty)
+		return ok && a.Equal(b)
+	case *UserStatusOnline:
+		b, ok := b.(*UserStatusOnline)
+		return ok && a.Equal(b)
+	case *UserStatusOffline:
+		b, ok := b.(*UserStatusOffline)
+		return ok && a.Equal(b)
+	case *UserStatusRecently:
+		b, ok := b.(*UserStatusRecently)
+		return ok && a.Equal(b)
+	case *UserStatusLastWeek:
+		b, ok := b.(*UserStatusLastWeek)
+		return ok && a.Equal(b)
+	case *UserStatusLastMonth:
+		b, ok := b.(*UserStatusLastMonth)
+		return ok && a.Equal(b)
+	case *UnknownUserStatus:
+		b, ok := b.(*UnknownUserStatus)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffUserStatus(path string, a UserStatus, b UserStatus, changes []Change) []Change {
+	switch a := a.(type) {
+	case *UserStatusEmpty:
+		if b, ok := b.(*UserStatusEmpty); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UserStatusOnline:
+		if b, ok := b.(*UserStatusOnline); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UserStatusOffline:
+		if b, ok := b.(*UserStatusOffline); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UserStatusRecently:
+		if b, ok := b.(*UserStatusRecently); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UserStatusLastWeek:
+		if b, ok := b.(*UserStatusLastWeek); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UserStatusLastMonth:
+		if b, ok := b.(*UserStatusLastMonth); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalUserStatus(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // UserType Represents the type of a user. The following types are possible: regular users, deleted users and bots
 type UserType interface {
 	GetUserTypeEnum() UserTypeEnum
+	Accept(visitor UserTypeVisitor)
 }
 
 // UserTypeEnum Alias for abstract UserType 'Sub-Classes', used as constant-enum here
@@ -21,43 +23,94 @@ const (
 	UserTypeUnknownType UserTypeEnum = "userTypeUnknown"
 )
 
+// UnknownUserType is an UserType of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownUserType struct {
+	Unknown
+}
+
+// GetUserTypeEnum return the enum type of this object
+func (unknownUserType *UnknownUserType) GetUserTypeEnum() UserTypeEnum {
+	return UserTypeEnum(unknownUserType.Type)
+}
+
+// Accept calls the method of the visitor for UnknownUserType
+func (unknownUserType *UnknownUserType) Accept(visitor UserTypeVisitor) {
+	visitor.VisitUnknownUserType(unknownUserType)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownUserType *UnknownUserType) String() string {
+	return objectString(unknownUserType)
+}
+
+// LogValue logs the object as its summary
+func (unknownUserType *UnknownUserType) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownUserType))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownUserType *UnknownUserType) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownUserType)
+}
+
+func (unknownUserType *UnknownUserType) printText(p *printer) {
+	if unknownUserType == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownUserType")
+	p.string("@type", unknownUserType.Type)
+	p.endObject(state)
+}
+
 func unmarshalUserType(rawMsg *json.RawMessage) (UserType, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch UserTypeEnum(objMap["@type"].(string)) {
+	switch UserTypeEnum(typeName) {
 	case UserTypeRegularType:
 		var userTypeRegular UserTypeRegular
-		err := json.Unmarshal(*rawMsg, &userTypeRegular)
+		err := jsonUnmarshal(*rawMsg, &userTypeRegular)
 		return &userTypeRegular, err
 
 	case UserTypeDeletedType:
 		var userTypeDeleted UserTypeDeleted
-		err := json.Unmarshal(*rawMsg, &userTypeDeleted)
+		err := jsonUnmarshal(*rawMsg, &userTypeDeleted)
 		return &userTypeDeleted, err
 
 	case UserTypeBotType:
 		var userTypeBot UserTypeBot
-		err := json.Unmarshal(*rawMsg, &userTypeBot)
+		err := jsonUnmarshal(*rawMsg, &userTypeBot)
 		return &userTypeBot, err
 
 	case UserTypeUnknownType:
 		var userTypeUnknown UserTypeUnknown
-		err := json.Unmarshal(*rawMsg, &userTypeUnknown)
+		err := jsonUnmarshal(*rawMsg, &userTypeUnknown)
 		return &userTypeUnknown, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownUserType{Unknown: unknown}, nil
 	}
 }
 
+func decodeUserType(r *jsonReader) (UserType, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalUserType(rawMsg)
+}
+
 // UserTypeRegular A regular user
 type UserTypeRegular struct {
 	tdCommon
@@ -69,7 +122,6 @@ func (userTypeRegular *UserTypeRegular) MessageType() string {
 }
 
 // NewUserTypeRegular creates a new UserTypeRegular
-//
 func NewUserTypeRegular() *UserTypeRegular {
 	userTypeRegularTemp := UserTypeRegular{
 		tdCommon: tdCommon{Type: "userTypeRegular"},
@@ -78,11 +130,107 @@ func NewUserTypeRegular() *UserTypeRegular {
 	return &userTypeRegularTemp
 }
 
+// MarshalJSON marshals to json
+func (userTypeRegular *UserTypeRegular) MarshalJSON() ([]byte, error) {
+	return userTypeRegular.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (userTypeRegular *UserTypeRegular) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, userTypeRegular)
+}
+
+func (userTypeRegular *UserTypeRegular) appendJSON(b []byte) ([]byte, error) {
+	if userTypeRegular == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, userTypeRegular.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, userTypeRegular.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (userTypeRegular *UserTypeRegular) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			userTypeRegular.tdCommon.Type, err = r.readString()
+		case "@extra":
+			userTypeRegular.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (userTypeRegular *UserTypeRegular) Clone() *UserTypeRegular {
+	if userTypeRegular == nil {
+		return nil
+	}
+	clone := *userTypeRegular
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (userTypeRegular *UserTypeRegular) Equal(other *UserTypeRegular) bool {
+	if userTypeRegular == nil || other == nil {
+		return userTypeRegular == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (userTypeRegular *UserTypeRegular) Diff(other *UserTypeRegular) []Change {
+	return userTypeRegular.diff("", other, nil)
+}
+
+func (userTypeRegular *UserTypeRegular) diff(path string, other *UserTypeRegular, changes []Change) []Change {
+	if userTypeRegular == nil || other == nil {
+		if userTypeRegular != other {
+			changes = append(changes, Change{Path: path, Old: userTypeRegular, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (userTypeRegular *UserTypeRegular) String() string {
+	return objectString(userTypeRegular)
+}
+
+// LogValue logs the object as its summary
+func (userTypeRegular *UserTypeRegular) LogValue() slog.Value {
+	return slog.StringValue(objectString(userTypeRegular))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (userTypeRegular *UserTypeRegular) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, userTypeRegular)
+}
+
+func (userTypeRegular *UserTypeRegular) printText(p *printer) {
+	if userTypeRegular == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UserTypeRegular")
+	p.endObject(state)
+}
+
 // GetUserTypeEnum return the enum type of this object
 func (userTypeRegular *UserTypeRegular) GetUserTypeEnum() UserTypeEnum {
 	return UserTypeRegularType
 }
 
+// Accept calls the method of the visitor for UserTypeRegular
+func (userTypeRegular *UserTypeRegular) Accept(visitor UserTypeVisitor) {
+	visitor.VisitUserTypeRegular(userTypeRegular)
+}
+
 // UserTypeDeleted A deleted user or deleted bot. No information on the user besides the user identifier is available. It is not possible to perform any active actions on this type of user
 type UserTypeDeleted struct {
 	tdCommon
@@ -94,7 +242,6 @@ func (userTypeDeleted *UserTypeDeleted) MessageType() string {
 }
 
 // NewUserTypeDeleted creates a new UserTypeDeleted
-//
 func NewUserTypeDeleted() *UserTypeDeleted {
 	userTypeDeletedTemp := UserTypeDeleted{
 		tdCommon: tdCommon{Type: "userTypeDeleted"},
@@ -103,11 +250,107 @@ func NewUserTypeDeleted() *UserTypeDeleted {
 	return &userTypeDeletedTemp
 }
 
+// MarshalJSON marshals to json
+func (userTypeDeleted *UserTypeDeleted) MarshalJSON() ([]byte, error) {
+	return userTypeDeleted.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (userTypeDeleted *UserTypeDeleted) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, userTypeDeleted)
+}
+
+func (userTypeDeleted *UserTypeDeleted) appendJSON(b []byte) ([]byte, error) {
+	if userTypeDeleted == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, userTypeDeleted.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, userTypeDeleted.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (userTypeDeleted *UserTypeDeleted) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			userTypeDeleted.tdCommon.Type, err = r.readString()
+		case "@extra":
+			userTypeDeleted.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (userTypeDeleted *UserTypeDeleted) Clone() *UserTypeDeleted {
+	if userTypeDeleted == nil {
+		return nil
+	}
+	clone := *userTypeDeleted
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (userTypeDeleted *UserTypeDeleted) Equal(other *UserTypeDeleted) bool {
+	if userTypeDeleted == nil || other == nil {
+		return userTypeDeleted == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (userTypeDeleted *UserTypeDeleted) Diff(other *UserTypeDeleted) []Change {
+	return userTypeDeleted.diff("", other, nil)
+}
+
+func (userTypeDeleted *UserTypeDeleted) diff(path string, other *UserTypeDeleted, changes []Change) []Change {
+	if userTypeDeleted == nil || other == nil {
+		if userTypeDeleted != other {
+			changes = append(changes, Change{Path: path, Old: userTypeDeleted, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (userTypeDeleted *UserTypeDeleted) String() string {
+	return objectString(userTypeDeleted)
+}
+
+// LogValue logs the object as its summary
+func (userTypeDeleted *UserTypeDeleted) LogValue() slog.Value {
+	return slog.StringValue(objectString(userTypeDeleted))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (userTypeDeleted *UserTypeDeleted) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, userTypeDeleted)
+}
+
+func (userTypeDeleted *UserTypeDeleted) printText(p *printer) {
+	if userTypeDeleted == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UserTypeDeleted")
+	p.endObject(state)
+}
+
 // GetUserTypeEnum return the enum type of this object
 func (userTypeDeleted *UserTypeDeleted) GetUserTypeEnum() UserTypeEnum {
 	return UserTypeDeletedType
 }
 
+// Accept calls the method of the visitor for UserTypeDeleted
+func (userTypeDeleted *UserTypeDeleted) Accept(visitor UserTypeVisitor) {
+	visitor.VisitUserTypeDeleted(userTypeDeleted)
+}
+
 // UserTypeBot A bot (see https://core.telegram.org/bots)
 type UserTypeBot struct {
 	tdCommon
@@ -143,11 +386,162 @@ func NewUserTypeBot(canJoinGroups bool, canReadAllGroupMessages bool, isInline b
 	return &userTypeBotTemp
 }
 
+// MarshalJSON marshals to json
+func (userTypeBot *UserTypeBot) MarshalJSON() ([]byte, error) {
+	return userTypeBot.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (userTypeBot *UserTypeBot) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, userTypeBot)
+}
+
+func (userTypeBot *UserTypeBot) appendJSON(b []byte) ([]byte, error) {
+	if userTypeBot == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, userTypeBot.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, userTypeBot.tdCommon.Extra)
+	b = append(b, `,"can_join_groups":`...)
+	b = appendJSONBool(b, userTypeBot.CanJoinGroups)
+	b = append(b, `,"can_read_all_group_messages":`...)
+	b = appendJSONBool(b, userTypeBot.CanReadAllGroupMessages)
+	b = append(b, `,"is_inline":`...)
+	b = appendJSONBool(b, userTypeBot.IsInline)
+	b = append(b, `,"inline_query_placeholder":`...)
+	b = appendJSONString(b, userTypeBot.InlineQueryPlaceholder)
+	b = append(b, `,"need_location":`...)
+	b = appendJSONBool(b, userTypeBot.NeedLocation)
+	return append(b, '}'), nil
+}
+
+func (userTypeBot *UserTypeBot) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			userTypeBot.tdCommon.Type, err = r.readString()
+		case "@extra":
+			userTypeBot.tdCommon.Extra, err = r.readString()
+		case "can_join_groups":
+			userTypeBot.CanJoinGroups, err = r.readBool()
+		case "can_read_all_group_messages":
+			userTypeBot.CanReadAllGroupMessages, err = r.readBool()
+		case "is_inline":
+			userTypeBot.IsInline, err = r.readBool()
+		case "inline_query_placeholder":
+			userTypeBot.InlineQueryPlaceholder, err = r.readString()
+		case "need_location":
+			userTypeBot.NeedLocation, err = r.readBool()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (userTypeBot *UserTypeBot) Clone() *UserTypeBot {
+	if userTypeBot == nil {
+		return nil
+	}
+	clone := *userTypeBot
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (userTypeBot *UserTypeBot) Equal(other *UserTypeBot) bool {
+	if userTypeBot == nil || other == nil {
+		return userTypeBot == other
+	}
+	if userTypeBot.CanJoinGroups != other.CanJoinGroups {
+		return false
+	}
+	if userTypeBot.CanReadAllGroupMessages != other.CanReadAllGroupMessages {
+		return false
+	}
+	if userTypeBot.IsInline != other.IsInline {
+		return false
+	}
+	if userTypeBot.InlineQueryPlaceholder != other.InlineQueryPlaceholder {
+		return false
+	}
+	if userTypeBot.NeedLocation != other.NeedLocation {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (userTypeBot *UserTypeBot) Diff(other *UserTypeBot) []Change {
+	return userTypeBot.diff("", other, nil)
+}
+
+func (userTypeBot *UserTypeBot) diff(path string, other *UserTypeBot, changes []Change) []Change {
+	if userTypeBot == nil || other == nil {
+		if userTypeBot != other {
+			changes = append(changes, Change{Path: path, Old: userTypeBot, New: other})
+		}
+		return changes
+	}
+	if userTypeBot.CanJoinGroups != other.CanJoinGroups {
+		changes = append(changes, Change{Path: fieldPath(path, "can_join_groups"), Old: userTypeBot.CanJoinGroups, New: other.CanJoinGroups})
+	}
+	if userTypeBot.CanReadAllGroupMessages != other.CanReadAllGroupMessages {
+		changes = append(changes, Change{Path: fieldPath(path, "can_read_all_group_messages"), Old: userTypeBot.CanReadAllGroupMessages, New: other.CanReadAllGroupMessages})
+	}
+	if userTypeBot.IsInline != other.IsInline {
+		changes = append(changes, Change{Path: fieldPath(path, "is_inline"), Old: userTypeBot.IsInline, New: other.IsInline})
+	}
+	if userTypeBot.InlineQueryPlaceholder != other.InlineQueryPlaceholder {
+		changes = append(changes, Change{Path: fieldPath(path, "inline_query_placeholder"), Old: userTypeBot.InlineQueryPlaceholder, New: other.InlineQueryPlaceholder})
+	}
+	if userTypeBot.NeedLocation != other.NeedLocation {
+		changes = append(changes, Change{Path: fieldPath(path, "need_location"), Old: userTypeBot.NeedLocation, New: other.NeedLocation})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (userTypeBot *UserTypeBot) String() string {
+	return objectString(userTypeBot)
+}
+
+// LogValue logs the object as its summary
+func (userTypeBot *UserTypeBot) LogValue() slog.Value {
+	return slog.StringValue(objectString(userTypeBot))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (userTypeBot *UserTypeBot) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, userTypeBot)
+}
+
+func (userTypeBot *UserTypeBot) printText(p *printer) {
+	if userTypeBot == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UserTypeBot")
+	p.bool("can_join_groups", userTypeBot.CanJoinGroups)
+	p.bool("can_read_all_group_messages", userTypeBot.CanReadAllGroupMessages)
+	p.bool("is_inline", userTypeBot.IsInline)
+	p.string("inline_query_placeholder", userTypeBot.InlineQueryPlaceholder)
+	p.bool("need_location", userTypeBot.NeedLocation)
+	p.endObject(state)
+}
+
 // GetUserTypeEnum return the enum type of this object
 func (userTypeBot *UserTypeBot) GetUserTypeEnum() UserTypeEnum {
 	return UserTypeBotType
 }
 
+// Accept calls the method of the visitor for UserTypeBot
+func (userTypeBot *UserTypeBot) Accept(visitor UserTypeVisitor) {
+	visitor.VisitUserTypeBot(userTypeBot)
+}
+
 // UserTypeUnknown No information on the user besides the user identifier is available, yet this user has not been deleted. This object is extremely rare and must be handled like a deleted user. It is not possible to perform any actions on users of this type
 type UserTypeUnknown struct {
 	tdCommon
@@ -159,7 +553,6 @@ func (userTypeUnknown *UserTypeUnknown) MessageType() string {
 }
 
 // NewUserTypeUnknown creates a new UserTypeUnknown
-//
 func NewUserTypeUnknown() *UserTypeUnknown {
 	userTypeUnknownTemp := UserTypeUnknown{
 		tdCommon: tdCommon{Type: "userTypeUnknown"},
@@ -168,7 +561,228 @@ func NewUserTypeUnknown() *UserTypeUnknown {
 	return &userTypeUnknownTemp
 }
 
+// MarshalJSON marshals to json
+func (userTypeUnknown *UserTypeUnknown) MarshalJSON() ([]byte, error) {
+	return userTypeUnknown.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (userTypeUnknown *UserTypeUnknown) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, userTypeUnknown)
+}
+
+func (userTypeUnknown *UserTypeUnknown) appendJSON(b []byte) ([]byte, error) {
+	if userTypeUnknown == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, userTypeUnknown.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, userTypeUnknown.tdCommon.Extra)
+	return append(b, '}'), nil
+}
+
+func (userTypeUnknown *UserTypeUnknown) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			userTypeUnknown.tdCommon.Type, err = r.readString()
+		case "@extra":
+			userTypeUnknown.tdCommon.Extra, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (userTypeUnknown *UserTypeUnknown) Clone() *UserTypeUnknown {
+	if userTypeUnknown == nil {
+		return nil
+	}
+	clone := *userTypeUnknown
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (userTypeUnknown *UserTypeUnknown) Equal(other *UserTypeUnknown) bool {
+	if userTypeUnknown == nil || other == nil {
+		return userTypeUnknown == other
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (userTypeUnknown *UserTypeUnknown) Diff(other *UserTypeUnknown) []Change {
+	return userTypeUnknown.diff("", other, nil)
+}
+
+func (userTypeUnknown *UserTypeUnknown) diff(path string, other *UserTypeUnknown, changes []Change) []Change {
+	if userTypeUnknown == nil || other == nil {
+		if userTypeUnknown != other {
+			changes = append(changes, Change{Path: path, Old: userTypeUnknown, New: other})
+		}
+		return changes
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (userTypeUnknown *UserTypeUnknown) String() string {
+	return objectString(userTypeUnknown)
+}
+
+// LogValue logs the object as its summary
+func (userTypeUnknown *UserTypeUnknown) LogValue() slog.Value {
+	return slog.StringValue(objectString(userTypeUnknown))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (userTypeUnknown *UserTypeUnknown) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, userTypeUnknown)
+}
+
+func (userTypeUnknown *UserTypeUnknown) printText(p *printer) {
+	if userTypeUnknown == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UserTypeUnknown")
+	p.endObject(state)
+}
+
 // GetUserTypeEnum return the enum type of this object
 func (userTypeUnknown *UserTypeUnknown) GetUserTypeEnum() UserTypeEnum {
 	return UserTypeUnknownType
 }
+
+// Accept calls the method of the visitor for UserTypeUnknown
+func (userTypeUnknown *UserTypeUnknown) Accept(visitor UserTypeVisitor) {
+	visitor.VisitUserTypeUnknown(userTypeUnknown)
+}
+
+// UserTypeVisitor visits every type of UserType, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to UserType, until they handle it too.
+type UserTypeVisitor interface {
+	VisitUserTypeRegular(userTypeRegular *UserTypeRegular)
+	VisitUserTypeDeleted(userTypeDeleted *UserTypeDeleted)
+	VisitUserTypeBot(userTypeBot *UserTypeBot)
+	VisitUserTypeUnknown(userTypeUnknown *UserTypeUnknown)
+	VisitUnknownUserType(unknownUserType *UnknownUserType)
+}
+
+// UserTypeCases holds the function MatchUserType calls for each type of UserType;
+// types without a function are passed to the default case
+type UserTypeCases[R any] struct {
+	UserTypeRegular func(userTypeRegular *UserTypeRegular) R
+	UserTypeDeleted func(userTypeDeleted *UserTypeDeleted) R
+	UserTypeBot     func(userTypeBot *UserTypeBot) R
+	UserTypeUnknown func(userTypeUnknown *UserTypeUnknown) R
+}
+
+// MatchUserType calls the case of the type of userType, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement UserTypeVisitor
+// to have the compiler check every type is handled.
+func MatchUserType[R any](userType UserType, cases UserTypeCases[R], defaultCase func(userType UserType) R) R {
+	switch value := userType.(type) {
+	case *UserTypeRegular:
+		if cases.UserTypeRegular != nil {
+			return cases.UserTypeRegular(value)
+		}
+	case *UserTypeDeleted:
+		if cases.UserTypeDeleted != nil {
+			return cases.UserTypeDeleted(value)
+		}
+	case *UserTypeBot:
+		if cases.UserTypeBot != nil {
+			return cases.UserTypeBot(value)
+		}
+	case *UserTypeUnknown:
+		if cases.UserTypeUnknown != nil {
+			return cases.UserTypeUnknown(value)
+		}
+	}
+	return defaultCase(userType)
+}
+
+// Clone returns a deep copy of the object
+func (unknownUserType *UnknownUserType) Clone() *UnknownUserType {
+	if unknownUserType == nil {
+		return nil
+	}
+	return &UnknownUserType{Unknown: unknownUserType.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownUserType *UnknownUserType) Equal(other *UnknownUserType) bool {
+	if unknownUserType == nil || other == nil {
+		return unknownUserType == other
+	}
+	return unknownUserType.Unknown.equal(other.Unknown)
+}
+
+func cloneUserType(userType UserType) UserType {
+	switch value := userType.(type) {
+	case *UserTypeRegular:
+		return value.Clone()
+	case *UserTypeDeleted:
+		return value.Clone()
+	case *UserTypeBot:
+		return value.Clone()
+	case *UserTypeUnknown:
+		return value.Clone()
+	case *UnknownUserType:
+		return value.Clone()
+	}
+	return userType
+}
+
+func equalUserType(a UserType, b UserType) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *UserTypeRegular:
+		b, ok := b.(*UserTypeRegular)
+		return ok && a.Equal(b)
+	case *UserTypeDeleted:
+		b, ok := b.(*UserTypeDeleted)
+		return ok && a.Equal(b)
+	case *UserTypeBot:
+		b, ok := b.(*UserTypeBot)
+		return ok && a.Equal(b)
+	case *UserTypeUnknown:
+		b, ok := b.(*UserTypeUnknown)
+		return ok && a.Equal(b)
+	case *UnknownUserType:
+		b, ok := b.(*UnknownUserType)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffUserType(path string, a UserType, b UserType, changes []Change) []Change {
+	switch a := a.(type) {
+	case *UserTypeRegular:
+		if b, ok := b.(*UserTypeRegular); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UserTypeDeleted:
+		if b, ok := b.(*UserTypeDeleted); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UserTypeBot:
+		if b, ok := b.(*UserTypeBot); ok {
+			return a.diff(path, b, changes)
+		}
+	case *UserTypeUnknown:
+		if b, ok := b.(*UserTypeUnknown); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalUserType(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
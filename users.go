@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // Users Represents a list of users
@@ -31,6 +31,157 @@ func NewUsers(totalCount int32, userIds []int64) *Users {
 	return &usersTemp
 }
 
+// MarshalJSON marshals to json
+func (users *Users) MarshalJSON() ([]byte, error) {
+	return users.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (users *Users) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, users)
+}
+
+func (users *Users) appendJSON(b []byte) ([]byte, error) {
+	if users == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, users.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, users.tdCommon.Extra)
+	b = append(b, `,"total_count":`...)
+	b = appendJSONInt(b, int64(users.TotalCount))
+	b = append(b, `,"user_ids":`...)
+	if users.UserIds == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range users.UserIds {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONInt(b, int64(users.UserIds[i0]))
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (users *Users) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			users.tdCommon.Type, err = r.readString()
+		case "@extra":
+			users.tdCommon.Extra, err = r.readString()
+		case "total_count":
+			users.TotalCount, err = r.readInt32()
+		case "user_ids":
+			if r.readNull() {
+				users.UserIds = nil
+			} else {
+				users.UserIds = make([]int64, 0)
+				err = r.readArray(func() (err error) {
+					var item0 int64
+					item0, err = r.readInt64()
+					users.UserIds = append(users.UserIds, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (users *Users) Clone() *Users {
+	if users == nil {
+		return nil
+	}
+	clone := *users
+	if users.UserIds != nil {
+		clone.UserIds = make([]int64, len(users.UserIds))
+		copy(clone.UserIds, users.UserIds)
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (users *Users) Equal(other *Users) bool {
+	if users == nil || other == nil {
+		return users == other
+	}
+	if users.TotalCount != other.TotalCount {
+		return false
+	}
+	if len(users.UserIds) != len(other.UserIds) {
+		return false
+	}
+	for i0 := range users.UserIds {
+		if users.UserIds[i0] != other.UserIds[i0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (users *Users) Diff(other *Users) []Change {
+	return users.diff("", other, nil)
+}
+
+func (users *Users) diff(path string, other *Users, changes []Change) []Change {
+	if users == nil || other == nil {
+		if users != other {
+			changes = append(changes, Change{Path: path, Old: users, New: other})
+		}
+		return changes
+	}
+	if users.TotalCount != other.TotalCount {
+		changes = append(changes, Change{Path: fieldPath(path, "total_count"), Old: users.TotalCount, New: other.TotalCount})
+	}
+	if len(users.UserIds) != len(other.UserIds) {
+		changes = append(changes, Change{Path: fieldPath(path, "user_ids"), Old: users.UserIds, New: other.UserIds})
+	} else {
+		for i0 := range users.UserIds {
+			if users.UserIds[i0] != other.UserIds[i0] {
+				changes = append(changes, Change{Path: indexPath(fieldPath(path, "user_ids"), i0), Old: users.UserIds[i0], New: other.UserIds[i0]})
+			}
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (users *Users) String() string {
+	return objectString(users)
+}
+
+// LogValue logs the object as its summary
+func (users *Users) LogValue() slog.Value {
+	return slog.StringValue(objectString(users))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (users *Users) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, users)
+}
+
+func (users *Users) printText(p *printer) {
+	if users == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Users")
+	p.int("total_count", int64(users.TotalCount))
+	p.vector("user_ids", len(users.UserIds), func(i0 int) {
+		p.int("user_ids", int64(users.UserIds[i0]))
+	})
+	p.endObject(state)
+}
+
 // GetMessageViewers Returns viewers of a recent outgoing message in a basic group or a supergroup chat. For video notes and voice notes only users, opened content of the message, are returned. The method can be called if message.can_get_viewers == true
 // @param chatId Chat identifier
 // @param messageId Identifier of the message
@@ -45,12 +196,12 @@ func (client *Client) GetMessageViewers(chatId int64, messageId int64) (*Users,
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var users Users
-	err = json.Unmarshal(result.Raw, &users)
+	err = jsonUnmarshal(result.Raw, &users)
 	return &users, err
 }
 
@@ -74,12 +225,12 @@ func (client *Client) GetPollVoters(chatId int64, messageId int64, optionId int3
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var users Users
-	err = json.Unmarshal(result.Raw, &users)
+	err = jsonUnmarshal(result.Raw, &users)
 	return &users, err
 }
 
@@ -93,12 +244,12 @@ func (client *Client) GetContacts() (*Users, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var users Users
-	err = json.Unmarshal(result.Raw, &users)
+	err = jsonUnmarshal(result.Raw, &users)
 	return &users, err
 }
 
@@ -116,12 +267,12 @@ func (client *Client) SearchContacts(query string, limit int32) (*Users, error)
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var users Users
-	err = json.Unmarshal(result.Raw, &users)
+	err = jsonUnmarshal(result.Raw, &users)
 	return &users, err
 }
 
@@ -135,11 +286,11 @@ func (client *Client) GetRecentInlineBots() (*Users, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var users Users
-	err = json.Unmarshal(result.Raw, &users)
+	err = jsonUnmarshal(result.Raw, &users)
 	return &users, err
 }
@@ -0,0 +1,471 @@
+package tdlib
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrInvalidRequest is wrapped by the errors of request validation, see SetValidation
+var ErrInvalidRequest = errors.New("invalid request")
+
+// Limits are the Telegram limits requests are validated against. Lengths of texts are in UTF-16 code units,
+// like Telegram counts them; callback data is in bytes.
+type Limits struct {
+	MessageTextLength     int // Length of a message text, the message_text_length_max option
+	CaptionLength         int // Length of a media caption, the message_caption_length_max option
+	AlbumSize             int // Number of messages of an album
+	PollQuestionLength    int // Length of a poll question
+	PollOptionCount       int // Number of options of a poll
+	PollOptionLength      int // Length of a poll option
+	QuizExplanationLength int // Length of the explanation of a quiz
+	AudioInfoLength       int // Length of the title and the performer of an audio
+	CallbackDataLength    int // Length of the data of a callback button, in bytes
+	PlaceholderLength     int // Length of the input field placeholder of a keyboard or a forced reply
+	InlineButtonCount     int // Number of buttons of an inline keyboard
+	InlineRowLength       int // Number of buttons in a row of an inline keyboard
+}
+
+// DefaultLimits are the limits until TDLib sends the options, see Client.Limits
+var DefaultLimits = Limits{
+	MessageTextLength:     4096,
+	CaptionLength:         1024,
+	AlbumSize:             10,
+	PollQuestionLength:    300,
+	PollOptionCount:       10,
+	PollOptionLength:      100,
+	QuizExplanationLength: 200,
+	AudioInfoLength:       64,
+	CallbackDataLength:    64,
+	PlaceholderLength:     64,
+	InlineButtonCount:     100,
+	InlineRowLength:       8,
+}
+
+// limitOptions are the TDLib options which set limits
+var limitOptions = map[string]func(limits *Limits, value int){
+	"message_text_length_max":    func(limits *Limits, value int) { limits.MessageTextLength = value },
+	"message_caption_length_max": func(limits *Limits, value int) { limits.CaptionLength = value },
+}
+
+// SetValidation enables validating outgoing requests which send or edit messages against the limits of the client
+// before they're sent. Invalid requests fail with an error wrapping ErrInvalidRequest instead of a TDLib error.
+func (client *Client) SetValidation(enabled bool) {
+	client.validation.Store(enabled)
+}
+
+// Limits returns the limits of the client: DefaultLimits, updated by the options TDLib sends in updateOption
+// or LoadLimits reads
+func (client *Client) Limits() Limits {
+	if limits := client.limits.Load(); limits != nil {
+		return *limits
+	}
+	return DefaultLimits
+}
+
+// LoadLimits reads the options which set limits with GetOption, e.g. when updateOption was sent before
+// the client was created
+func (client *Client) LoadLimits() error {
+	for name := range limitOptions {
+		value, err := client.GetOption(name)
+		if err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+		client.setLimitOption(name, value)
+	}
+	return nil
+}
+
+// setLimitOption updates the limits of the client if the option sets one
+func (client *Client) setLimitOption(name string, value OptionValue) {
+	set, ok := limitOptions[name]
+	if !ok {
+		return
+	}
+	integer, ok := value.(*OptionValueInteger)
+	if !ok || integer.Value <= 0 {
+		return
+	}
+	for {
+		old := client.limits.Load()
+		limits := DefaultLimits
+		if old != nil {
+			limits = *old
+		}
+		set(&limits, int(integer.Value))
+		if client.limits.CompareAndSwap(old, &limits) {
+			return
+		}
+	}
+}
+
+// validateRequest validates a request if validation is enabled
+func (client *Client) validateRequest(request UpdateData) error {
+	if !client.validation.Load() {
+		return nil
+	}
+	return client.Limits().ValidateRequest(request)
+}
+
+// ValidateRequest validates the contents, captions and reply markups of a request which sends or edits messages,
+// e.g. sendMessage or editMessageCaption. Other requests are valid. Parameters may be the generated types
+// or their decoded JSON.
+func (limits Limits) ValidateRequest(request UpdateData) error {
+	method, _ := request["@type"].(string)
+	err := limits.validateRequest(method, request)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidRequest, method, err)
+	}
+	return nil
+}
+
+func (limits Limits) validateRequest(method string, request UpdateData) error {
+	switch method {
+	case "sendMessage", "addLocalMessage", "editMessageText", "editMessageMedia", "editInlineMessageText", "editInlineMessageMedia":
+		content, err := requestContent(request["input_message_content"])
+		if err != nil {
+			return fmt.Errorf("input_message_content: %v", err)
+		}
+		if err := limits.ValidateContent(content); err != nil {
+			return fmt.Errorf("input_message_content: %v", err)
+		}
+
+	case "sendMessageAlbum":
+		var contents []InputMessageContent
+		switch value := request["input_message_contents"].(type) {
+		case []InputMessageContent:
+			contents = value
+		case []interface{}:
+			for i, item := range value {
+				content, err := requestContent(item)
+				if err != nil {
+					return fmt.Errorf("input_message_contents[%d]: %v", i, err)
+				}
+				contents = append(contents, content)
+			}
+		}
+		if err := limits.ValidateAlbum(contents); err != nil {
+			return fmt.Errorf("input_message_contents: %v", err)
+		}
+
+	case "editMessageCaption", "editInlineMessageCaption":
+		caption, err := requestCaption(request["caption"])
+		if err != nil {
+			return fmt.Errorf("caption: %v", err)
+		}
+		if err := limits.ValidateCaption(caption); err != nil {
+			return fmt.Errorf("caption: %v", err)
+		}
+
+	case "editMessageReplyMarkup", "editInlineMessageReplyMarkup", "editMessageLiveLocation", "editInlineMessageLiveLocation", "stopPoll":
+		// only the reply markup is limited
+
+	default:
+		return nil
+	}
+
+	replyMarkup, err := requestReplyMarkup(request["reply_markup"])
+	if err != nil {
+		return fmt.Errorf("reply_markup: %v", err)
+	}
+	if err := limits.ValidateReplyMarkup(replyMarkup); err != nil {
+		return fmt.Errorf("reply_markup: %v", err)
+	}
+	return nil
+}
+
+// ValidateContent validates the texts, captions and other limited values of a message content
+func (limits Limits) ValidateContent(content InputMessageContent) error {
+	if isNil(content) {
+		return errors.New("content is required")
+	}
+	switch content := content.(type) {
+	case *InputMessageText:
+		if content.Text == nil || content.Text.Text == "" {
+			return errors.New("text is empty")
+		}
+		return checkLength("text", content.Text.Text, limits.MessageTextLength)
+	case *InputMessageAnimation:
+		return limits.ValidateCaption(content.Caption)
+	case *InputMessageAudio:
+		if err := checkLength("title", content.Title, limits.AudioInfoLength); err != nil {
+			return err
+		}
+		if err := checkLength("performer", content.Performer, limits.AudioInfoLength); err != nil {
+			return err
+		}
+		return limits.ValidateCaption(content.Caption)
+	case *InputMessageDocument:
+		return limits.ValidateCaption(content.Caption)
+	case *InputMessagePhoto:
+		if err := checkRange("ttl", int64(content.Ttl), 0, 60); err != nil {
+			return err
+		}
+		return limits.ValidateCaption(content.Caption)
+	case *InputMessageVideo:
+		if err := checkRange("ttl", int64(content.Ttl), 0, 60); err != nil {
+			return err
+		}
+		return limits.ValidateCaption(content.Caption)
+	case *InputMessageVoiceNote:
+		return limits.ValidateCaption(content.Caption)
+	case *InputMessageLocation:
+		return validateLocation(content)
+	case *InputMessageDice:
+		if content.Emoji == "" {
+			return errors.New("emoji is empty")
+		}
+	case *InputMessagePoll:
+		return limits.validatePoll(content)
+	case *InputMessageForwarded:
+		if content.CopyOptions != nil && content.CopyOptions.ReplaceCaption {
+			if err := limits.ValidateCaption(content.CopyOptions.NewCaption); err != nil {
+				return fmt.Errorf("copy_options: new_%v", err)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateCaption validates the length of a caption, which may be nil
+func (limits Limits) ValidateCaption(caption *FormattedText) error {
+	if caption == nil {
+		return nil
+	}
+	return checkLength("caption", caption.Text, limits.CaptionLength)
+}
+
+// ValidateAlbum validates the contents of an album: 2 and up to AlbumSize audios, documents, or photos and videos
+func (limits Limits) ValidateAlbum(contents []InputMessageContent) error {
+	if len(contents) < 2 || len(contents) > limits.AlbumSize {
+		return fmt.Errorf("album has %d messages, it must have 2-%d", len(contents), limits.AlbumSize)
+	}
+
+	var albumType string
+	for i, content := range contents {
+		if err := limits.ValidateContent(content); err != nil {
+			return fmt.Errorf("[%d]: %v", i, err)
+		}
+
+		var itemType string
+		switch content.(type) {
+		case *InputMessagePhoto, *InputMessageVideo:
+			itemType = "photos and videos"
+		case *InputMessageDocument:
+			itemType = "documents"
+		case *InputMessageAudio:
+			itemType = "audios"
+		default:
+			return fmt.Errorf("[%d]: %s can't be sent in an album", i, content.GetInputMessageContentEnum())
+		}
+		if albumType == "" {
+			albumType = itemType
+		} else if itemType != albumType {
+			return fmt.Errorf("[%d]: album of %s can't have %s", i, albumType, itemType)
+		}
+	}
+	return nil
+}
+
+// ValidateReplyMarkup validates the buttons and placeholders of a reply markup, which may be nil
+func (limits Limits) ValidateReplyMarkup(replyMarkup ReplyMarkup) error {
+	if isNil(replyMarkup) {
+		return nil
+	}
+	switch replyMarkup := replyMarkup.(type) {
+	case *ReplyMarkupForceReply:
+		return checkLength("input_field_placeholder", replyMarkup.InputFieldPlaceholder, limits.PlaceholderLength)
+	case *ReplyMarkupShowKeyboard:
+		for i, row := range replyMarkup.Rows {
+			for j, button := range row {
+				if button.Text == "" {
+					return fmt.Errorf("rows[%d][%d]: text is empty", i, j)
+				}
+			}
+		}
+		return checkLength("input_field_placeholder", replyMarkup.InputFieldPlaceholder, limits.PlaceholderLength)
+	case *ReplyMarkupInlineKeyboard:
+		count := 0
+		for i, row := range replyMarkup.Rows {
+			if len(row) > limits.InlineRowLength {
+				return fmt.Errorf("rows[%d]: row has %d buttons, at most %d are allowed", i, len(row), limits.InlineRowLength)
+			}
+			count += len(row)
+			for j, button := range row {
+				if err := limits.validateInlineButton(button); err != nil {
+					return fmt.Errorf("rows[%d][%d]: %v", i, j, err)
+				}
+			}
+		}
+		if count > limits.InlineButtonCount {
+			return fmt.Errorf("keyboard has %d buttons, at most %d are allowed", count, limits.InlineButtonCount)
+		}
+	}
+	return nil
+}
+
+func (limits Limits) validateInlineButton(button InlineKeyboardButton) error {
+	if button.Text == "" {
+		return errors.New("text is empty")
+	}
+
+	if isNil(button.Type) {
+		return errors.New("type is required")
+	}
+	var data []byte
+	switch buttonType := button.Type.(type) {
+	case *InlineKeyboardButtonTypeCallback:
+		data = buttonType.Data
+	case *InlineKeyboardButtonTypeCallbackWithPassword:
+		data = buttonType.Data
+	default:
+		return nil
+	}
+	if len(data) == 0 || len(data) > limits.CallbackDataLength {
+		return fmt.Errorf("type: data is %d bytes long, it must be 1-%d", len(data), limits.CallbackDataLength)
+	}
+	return nil
+}
+
+func (limits Limits) validatePoll(poll *InputMessagePoll) error {
+	if poll.Question == "" {
+		return errors.New("question is empty")
+	}
+	if err := checkLength("question", poll.Question, limits.PollQuestionLength); err != nil {
+		return err
+	}
+	if len(poll.Options) < 2 || len(poll.Options) > limits.PollOptionCount {
+		return fmt.Errorf("options: poll has %d options, it must have 2-%d", len(poll.Options), limits.PollOptionCount)
+	}
+	for i, option := range poll.Options {
+		if option == "" {
+			return fmt.Errorf("options[%d]: option is empty", i)
+		}
+		if err := checkLength(fmt.Sprintf("options[%d]", i), option, limits.PollOptionLength); err != nil {
+			return err
+		}
+	}
+
+	if quiz, ok := poll.Type.(*PollTypeQuiz); ok && quiz != nil {
+		if quiz.CorrectOptionId < 0 || int(quiz.CorrectOptionId) >= len(poll.Options) {
+			return fmt.Errorf("type: correct_option_id is %d, it must be 0-%d", quiz.CorrectOptionId, len(poll.Options)-1)
+		}
+		if quiz.Explanation != nil {
+			if err := checkLength("type: explanation", quiz.Explanation.Text, limits.QuizExplanationLength); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validateLocation(content *InputMessageLocation) error {
+	if content.Location == nil {
+		return errors.New("location is required")
+	}
+	if content.Location.Latitude < -90 || content.Location.Latitude > 90 {
+		return fmt.Errorf("location: latitude is %v, it must be between -90 and 90", content.Location.Latitude)
+	}
+	if content.Location.Longitude < -180 || content.Location.Longitude > 180 {
+		return fmt.Errorf("location: longitude is %v, it must be between -180 and 180", content.Location.Longitude)
+	}
+	if content.LivePeriod != 0 {
+		if err := checkRange("live_period", int64(content.LivePeriod), 60, 86400); err != nil {
+			return fmt.Errorf("%v, or 0 if the location isn't live", err)
+		}
+	}
+	if err := checkRange("heading", int64(content.Heading), 0, 360); err != nil {
+		return err
+	}
+	return checkRange("proximity_alert_radius", int64(content.ProximityAlertRadius), 0, 100000)
+}
+
+// isNil reports whether a value of an interface type is nil or holds a nil pointer, e.g. (*InputMessageText)(nil),
+// so validation rejects it instead of dereferencing it
+func isNil(value interface{}) bool {
+	v := reflect.ValueOf(value)
+	return !v.IsValid() || (v.Kind() == reflect.Pointer && v.IsNil())
+}
+
+// checkLength returns an error if a text is longer than max UTF-16 code units
+func checkLength(name string, text string, max int) error {
+	if length := utf16Length(text); length > max {
+		return fmt.Errorf("%s is %d characters long, at most %d are allowed", name, length, max)
+	}
+	return nil
+}
+
+func checkRange(name string, value int64, min int64, max int64) error {
+	if value < min || value > max {
+		return fmt.Errorf("%s is %d, it must be %d-%d", name, value, min, max)
+	}
+	return nil
+}
+
+// utf16Length returns the length of a text in UTF-16 code units, in which Telegram measures texts
+func utf16Length(text string) int {
+	length := 0
+	for _, r := range text {
+		if r > 0xFFFF {
+			length += 2
+		} else {
+			length++
+		}
+	}
+	return length
+}
+
+// requestJSON returns the JSON of a decoded request parameter
+func requestJSON(value interface{}) (*json.RawMessage, error) {
+	jsonBytes, err := jsonMarshal(value)
+	if err != nil {
+		return nil, err
+	}
+	raw := json.RawMessage(jsonBytes)
+	return &raw, nil
+}
+
+func requestContent(value interface{}) (InputMessageContent, error) {
+	switch value := value.(type) {
+	case nil:
+		return nil, nil
+	case InputMessageContent:
+		return value, nil
+	}
+	raw, err := requestJSON(value)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalInputMessageContent(raw)
+}
+
+func requestReplyMarkup(value interface{}) (ReplyMarkup, error) {
+	switch value := value.(type) {
+	case nil:
+		return nil, nil
+	case ReplyMarkup:
+		return value, nil
+	}
+	raw, err := requestJSON(value)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalReplyMarkup(raw)
+}
+
+func requestCaption(value interface{}) (*FormattedText, error) {
+	switch value := value.(type) {
+	case nil:
+		return nil, nil
+	case *FormattedText:
+		return value, nil
+	}
+	raw, err := requestJSON(value)
+	if err != nil {
+		return nil, err
+	}
+	var caption FormattedText
+	err = jsonUnmarshal(*raw, &caption)
+	return &caption, err
+}
@@ -0,0 +1,127 @@
+package tdlib
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// callbackButton returns an inline keyboard button with callback data
+func callbackButton(data string) InlineKeyboardButton {
+	return *NewInlineKeyboardButton("button", NewInlineKeyboardButtonTypeCallback([]byte(data)))
+}
+
+// inlineKeyboard returns an inline keyboard with rows of the given numbers of buttons
+func inlineKeyboard(rows ...int) *ReplyMarkupInlineKeyboard {
+	keyboard := make([][]InlineKeyboardButton, len(rows))
+	for i, length := range rows {
+		for j := 0; j < length; j++ {
+			keyboard[i] = append(keyboard[i], callbackButton("data"))
+		}
+	}
+	return NewReplyMarkupInlineKeyboard(keyboard)
+}
+
+// photo returns a photo with a caption
+func photo(caption string) *InputMessagePhoto {
+	return NewInputMessagePhoto(NewInputFileRemote("id"), nil, nil, 0, 0, NewFormattedText(caption, nil), 0)
+}
+
+func TestValidateContent(t *testing.T) {
+	tests := []struct {
+		name    string
+		content InputMessageContent
+		valid   bool
+	}{
+		{"text", NewInputMessageText(NewFormattedText("hi", nil), false, false), true},
+		{"longest text", NewInputMessageText(NewFormattedText(strings.Repeat("a", 4096), nil), false, false), true},
+		{"text too long", NewInputMessageText(NewFormattedText(strings.Repeat("a", 4097), nil), false, false), false},
+		// 😀 is 2 UTF-16 code units
+		{"text too long in UTF-16", NewInputMessageText(NewFormattedText(strings.Repeat("😀", 2049), nil), false, false), false},
+		{"empty text", NewInputMessageText(NewFormattedText("", nil), false, false), false},
+		{"longest caption", photo(strings.Repeat("a", 1024)), true},
+		{"caption too long", photo(strings.Repeat("a", 1025)), false},
+		{"nil", nil, false},
+		{"typed nil text", (*InputMessageText)(nil), false},
+		{"typed nil photo", (*InputMessagePhoto)(nil), false},
+		{"typed nil formatted text", NewInputMessageText(nil, false, false), false},
+		{"typed nil poll type", NewInputMessagePoll("?", []string{"a", "b"}, false, (*PollTypeQuiz)(nil), 0, 0, false), true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := DefaultLimits.ValidateContent(test.content)
+			if (err == nil) != test.valid {
+				t.Errorf("ValidateContent returns %v, want valid %v", err, test.valid)
+			}
+		})
+	}
+}
+
+func TestValidateReplyMarkup(t *testing.T) {
+	tests := []struct {
+		name        string
+		replyMarkup ReplyMarkup
+		valid       bool
+	}{
+		{"nil", nil, true},
+		{"typed nil", (*ReplyMarkupInlineKeyboard)(nil), true},
+		{"longest row", inlineKeyboard(8), true},
+		{"row too long", inlineKeyboard(2, 9), false},
+		{"most buttons", inlineKeyboard(8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 4), true},
+		{"too many buttons", inlineKeyboard(8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 5), false},
+		{"longest callback data", NewReplyMarkupInlineKeyboard([][]InlineKeyboardButton{{callbackButton(strings.Repeat("a", 64))}}), true},
+		{"callback data too long", NewReplyMarkupInlineKeyboard([][]InlineKeyboardButton{{callbackButton(strings.Repeat("a", 65))}}), false},
+		{"empty callback data", NewReplyMarkupInlineKeyboard([][]InlineKeyboardButton{{callbackButton("")}}), false},
+		{"nil button type", NewReplyMarkupInlineKeyboard([][]InlineKeyboardButton{{{Text: "button"}}}), false},
+		{"typed nil button type", NewReplyMarkupInlineKeyboard([][]InlineKeyboardButton{
+			{*NewInlineKeyboardButton("button", (*InlineKeyboardButtonTypeCallback)(nil))},
+		}), false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := DefaultLimits.ValidateReplyMarkup(test.replyMarkup)
+			if (err == nil) != test.valid {
+				t.Errorf("ValidateReplyMarkup returns %v, want valid %v", err, test.valid)
+			}
+		})
+	}
+}
+
+func TestValidateRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		request UpdateData
+		valid   bool
+	}{
+		{"typed nil content", UpdateData{"@type": "sendMessage", "input_message_content": (*InputMessageText)(nil)}, false},
+		{"typed nil reply markup", UpdateData{
+			"@type":                 "sendMessage",
+			"input_message_content": NewInputMessageText(NewFormattedText("hi", nil), false, false),
+			"reply_markup":          (*ReplyMarkupInlineKeyboard)(nil),
+		}, true},
+		{"typed nil caption", UpdateData{"@type": "editMessageCaption", "caption": (*FormattedText)(nil)}, true},
+		{"decoded JSON", UpdateData{
+			"@type": "sendMessage",
+			"input_message_content": map[string]interface{}{
+				"@type": "inputMessageText",
+				"text":  map[string]interface{}{"@type": "formattedText", "text": strings.Repeat("a", 4097)},
+			},
+		}, false},
+		{"album with a typed nil", UpdateData{
+			"@type":                  "sendMessageAlbum",
+			"input_message_contents": []InputMessageContent{photo(""), (*InputMessagePhoto)(nil)},
+		}, false},
+		{"other request", UpdateData{"@type": "getMe"}, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := DefaultLimits.ValidateRequest(test.request)
+			if (err == nil) != test.valid {
+				t.Errorf("ValidateRequest returns %v, want valid %v", err, test.valid)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidRequest) {
+				t.Errorf("ValidateRequest returns %v, want an ErrInvalidRequest", err)
+			}
+		})
+	}
+}
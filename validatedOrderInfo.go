@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // ValidatedOrderInfo Contains a temporary identifier of validated order information, which is stored for one hour. Also contains the available shipping options
@@ -31,6 +31,161 @@ func NewValidatedOrderInfo(orderInfoId string, shippingOptions []ShippingOption)
 	return &validatedOrderInfoTemp
 }
 
+// MarshalJSON marshals to json
+func (validatedOrderInfo *ValidatedOrderInfo) MarshalJSON() ([]byte, error) {
+	return validatedOrderInfo.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (validatedOrderInfo *ValidatedOrderInfo) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, validatedOrderInfo)
+}
+
+func (validatedOrderInfo *ValidatedOrderInfo) appendJSON(b []byte) ([]byte, error) {
+	if validatedOrderInfo == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, validatedOrderInfo.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, validatedOrderInfo.tdCommon.Extra)
+	b = append(b, `,"order_info_id":`...)
+	b = appendJSONString(b, validatedOrderInfo.OrderInfoId)
+	b = append(b, `,"shipping_options":`...)
+	if validatedOrderInfo.ShippingOptions == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range validatedOrderInfo.ShippingOptions {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = validatedOrderInfo.ShippingOptions[i0].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	return append(b, '}'), nil
+}
+
+func (validatedOrderInfo *ValidatedOrderInfo) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			validatedOrderInfo.tdCommon.Type, err = r.readString()
+		case "@extra":
+			validatedOrderInfo.tdCommon.Extra, err = r.readString()
+		case "order_info_id":
+			validatedOrderInfo.OrderInfoId, err = r.readString()
+		case "shipping_options":
+			if r.readNull() {
+				validatedOrderInfo.ShippingOptions = nil
+			} else {
+				validatedOrderInfo.ShippingOptions = make([]ShippingOption, 0)
+				err = r.readArray(func() (err error) {
+					var item0 ShippingOption
+					err = item0.decodeJSON(r)
+					validatedOrderInfo.ShippingOptions = append(validatedOrderInfo.ShippingOptions, item0)
+					return
+				})
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (validatedOrderInfo *ValidatedOrderInfo) Clone() *ValidatedOrderInfo {
+	if validatedOrderInfo == nil {
+		return nil
+	}
+	clone := *validatedOrderInfo
+	if validatedOrderInfo.ShippingOptions != nil {
+		clone.ShippingOptions = make([]ShippingOption, len(validatedOrderInfo.ShippingOptions))
+		for i0 := range validatedOrderInfo.ShippingOptions {
+			clone.ShippingOptions[i0] = *validatedOrderInfo.ShippingOptions[i0].Clone()
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (validatedOrderInfo *ValidatedOrderInfo) Equal(other *ValidatedOrderInfo) bool {
+	if validatedOrderInfo == nil || other == nil {
+		return validatedOrderInfo == other
+	}
+	if validatedOrderInfo.OrderInfoId != other.OrderInfoId {
+		return false
+	}
+	if len(validatedOrderInfo.ShippingOptions) != len(other.ShippingOptions) {
+		return false
+	}
+	for i0 := range validatedOrderInfo.ShippingOptions {
+		if !validatedOrderInfo.ShippingOptions[i0].Equal(&other.ShippingOptions[i0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (validatedOrderInfo *ValidatedOrderInfo) Diff(other *ValidatedOrderInfo) []Change {
+	return validatedOrderInfo.diff("", other, nil)
+}
+
+func (validatedOrderInfo *ValidatedOrderInfo) diff(path string, other *ValidatedOrderInfo, changes []Change) []Change {
+	if validatedOrderInfo == nil || other == nil {
+		if validatedOrderInfo != other {
+			changes = append(changes, Change{Path: path, Old: validatedOrderInfo, New: other})
+		}
+		return changes
+	}
+	if validatedOrderInfo.OrderInfoId != other.OrderInfoId {
+		changes = append(changes, Change{Path: fieldPath(path, "order_info_id"), Old: validatedOrderInfo.OrderInfoId, New: other.OrderInfoId})
+	}
+	if len(validatedOrderInfo.ShippingOptions) != len(other.ShippingOptions) {
+		changes = append(changes, Change{Path: fieldPath(path, "shipping_options"), Old: validatedOrderInfo.ShippingOptions, New: other.ShippingOptions})
+	} else {
+		for i0 := range validatedOrderInfo.ShippingOptions {
+			changes = validatedOrderInfo.ShippingOptions[i0].diff(indexPath(fieldPath(path, "shipping_options"), i0), &other.ShippingOptions[i0], changes)
+		}
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (validatedOrderInfo *ValidatedOrderInfo) String() string {
+	return objectString(validatedOrderInfo)
+}
+
+// LogValue logs the object as its summary
+func (validatedOrderInfo *ValidatedOrderInfo) LogValue() slog.Value {
+	return slog.StringValue(objectString(validatedOrderInfo))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (validatedOrderInfo *ValidatedOrderInfo) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, validatedOrderInfo)
+}
+
+func (validatedOrderInfo *ValidatedOrderInfo) printText(p *printer) {
+	if validatedOrderInfo == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("ValidatedOrderInfo")
+	p.string("order_info_id", validatedOrderInfo.OrderInfoId)
+	p.vector("shipping_options", len(validatedOrderInfo.ShippingOptions), func(i0 int) {
+		validatedOrderInfo.ShippingOptions[i0].printText(p)
+	})
+	p.endObject(state)
+}
+
 // ValidateOrderInfo Validates the order information provided by a user and returns the available shipping options for a flexible invoice
 // @param chatId Chat identifier of the Invoice message
 // @param messageId Message identifier
@@ -49,11 +204,11 @@ func (client *Client) ValidateOrderInfo(chatId int64, messageId int64, orderInfo
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var validatedOrderInfo ValidatedOrderInfo
-	err = json.Unmarshal(result.Raw, &validatedOrderInfo)
+	err = jsonUnmarshal(result.Raw, &validatedOrderInfo)
 	return &validatedOrderInfo, err
 }
@@ -3,11 +3,13 @@ package tdlib
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // VectorPathCommand Represents a vector path command
 type VectorPathCommand interface {
 	GetVectorPathCommandEnum() VectorPathCommandEnum
+	Accept(visitor VectorPathCommandVisitor)
 }
 
 // VectorPathCommandEnum Alias for abstract VectorPathCommand 'Sub-Classes', used as constant-enum here
@@ -19,31 +21,105 @@ const (
 	VectorPathCommandCubicBezierCurveType VectorPathCommandEnum = "vectorPathCommandCubicBezierCurve"
 )
 
+// UnknownVectorPathCommand is a VectorPathCommand of a type unknown to the bindings, e.g. sent by a newer TDLib
+type UnknownVectorPathCommand struct {
+	Unknown
+}
+
+// GetVectorPathCommandEnum return the enum type of this object
+func (unknownVectorPathCommand *UnknownVectorPathCommand) GetVectorPathCommandEnum() VectorPathCommandEnum {
+	return VectorPathCommandEnum(unknownVectorPathCommand.Type)
+}
+
+// Accept calls the method of the visitor for UnknownVectorPathCommand
+func (unknownVectorPathCommand *UnknownVectorPathCommand) Accept(visitor VectorPathCommandVisitor) {
+	visitor.VisitUnknownVectorPathCommand(unknownVectorPathCommand)
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (unknownVectorPathCommand *UnknownVectorPathCommand) String() string {
+	return objectString(unknownVectorPathCommand)
+}
+
+// LogValue logs the object as its summary
+func (unknownVectorPathCommand *UnknownVectorPathCommand) LogValue() slog.Value {
+	return slog.StringValue(objectString(unknownVectorPathCommand))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (unknownVectorPathCommand *UnknownVectorPathCommand) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, unknownVectorPathCommand)
+}
+
+func (unknownVectorPathCommand *UnknownVectorPathCommand) printText(p *printer) {
+	if unknownVectorPathCommand == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("UnknownVectorPathCommand")
+	p.string("@type", unknownVectorPathCommand.Type)
+	p.endObject(state)
+}
+
 func unmarshalVectorPathCommand(rawMsg *json.RawMessage) (VectorPathCommand, error) {
 
 	if rawMsg == nil {
 		return nil, nil
 	}
-	var objMap map[string]interface{}
-	err := json.Unmarshal(*rawMsg, &objMap)
+	typeName, err := sniffType(*rawMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	switch VectorPathCommandEnum(objMap["@type"].(string)) {
+	switch VectorPathCommandEnum(typeName) {
 	case VectorPathCommandLineType:
 		var vectorPathCommandLine VectorPathCommandLine
-		err := json.Unmarshal(*rawMsg, &vectorPathCommandLine)
+		err := jsonUnmarshal(*rawMsg, &vectorPathCommandLine)
 		return &vectorPathCommandLine, err
 
 	case VectorPathCommandCubicBezierCurveType:
 		var vectorPathCommandCubicBezierCurve VectorPathCommandCubicBezierCurve
-		err := json.Unmarshal(*rawMsg, &vectorPathCommandCubicBezierCurve)
+		err := jsonUnmarshal(*rawMsg, &vectorPathCommandCubicBezierCurve)
 		return &vectorPathCommandCubicBezierCurve, err
 
 	default:
-		return nil, fmt.Errorf("Error UnMarshaling, unknown type:" + objMap["@type"].(string))
+		unknown, err := decodeUnknown(typeName, *rawMsg)
+		if err != nil {
+			return nil, err
+		}
+		return &UnknownVectorPathCommand{Unknown: unknown}, nil
+	}
+}
+
+func unmarshalVectorPathCommandSlice(rawMsg *json.RawMessage) ([]VectorPathCommand, error) {
+
+	if rawMsg == nil {
+		return nil, nil
 	}
+	var rawItems []*json.RawMessage
+	err := jsonUnmarshal(*rawMsg, &rawItems)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]VectorPathCommand, 0, len(rawItems))
+	for _, rawItem := range rawItems {
+		item, err := unmarshalVectorPathCommand(rawItem)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func decodeVectorPathCommand(r *jsonReader) (VectorPathCommand, error) {
+	rawMsg, err := r.readRawMessage()
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalVectorPathCommand(rawMsg)
 }
 
 // VectorPathCommandLine A straight line to a given point
@@ -69,11 +145,126 @@ func NewVectorPathCommandLine(endPoint *Point) *VectorPathCommandLine {
 	return &vectorPathCommandLineTemp
 }
 
+// MarshalJSON marshals to json
+func (vectorPathCommandLine *VectorPathCommandLine) MarshalJSON() ([]byte, error) {
+	return vectorPathCommandLine.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (vectorPathCommandLine *VectorPathCommandLine) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, vectorPathCommandLine)
+}
+
+func (vectorPathCommandLine *VectorPathCommandLine) appendJSON(b []byte) ([]byte, error) {
+	if vectorPathCommandLine == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, vectorPathCommandLine.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, vectorPathCommandLine.tdCommon.Extra)
+	b = append(b, `,"end_point":`...)
+	b, err = vectorPathCommandLine.EndPoint.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (vectorPathCommandLine *VectorPathCommandLine) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			vectorPathCommandLine.tdCommon.Type, err = r.readString()
+		case "@extra":
+			vectorPathCommandLine.tdCommon.Extra, err = r.readString()
+		case "end_point":
+			if r.readNull() {
+				vectorPathCommandLine.EndPoint = nil
+			} else {
+				vectorPathCommandLine.EndPoint = new(Point)
+				err = vectorPathCommandLine.EndPoint.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (vectorPathCommandLine *VectorPathCommandLine) Clone() *VectorPathCommandLine {
+	if vectorPathCommandLine == nil {
+		return nil
+	}
+	clone := *vectorPathCommandLine
+	clone.EndPoint = vectorPathCommandLine.EndPoint.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (vectorPathCommandLine *VectorPathCommandLine) Equal(other *VectorPathCommandLine) bool {
+	if vectorPathCommandLine == nil || other == nil {
+		return vectorPathCommandLine == other
+	}
+	if !vectorPathCommandLine.EndPoint.Equal(other.EndPoint) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (vectorPathCommandLine *VectorPathCommandLine) Diff(other *VectorPathCommandLine) []Change {
+	return vectorPathCommandLine.diff("", other, nil)
+}
+
+func (vectorPathCommandLine *VectorPathCommandLine) diff(path string, other *VectorPathCommandLine, changes []Change) []Change {
+	if vectorPathCommandLine == nil || other == nil {
+		if vectorPathCommandLine != other {
+			changes = append(changes, Change{Path: path, Old: vectorPathCommandLine, New: other})
+		}
+		return changes
+	}
+	changes = vectorPathCommandLine.EndPoint.diff(fieldPath(path, "end_point"), other.EndPoint, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (vectorPathCommandLine *VectorPathCommandLine) String() string {
+	return objectString(vectorPathCommandLine)
+}
+
+// LogValue logs the object as its summary
+func (vectorPathCommandLine *VectorPathCommandLine) LogValue() slog.Value {
+	return slog.StringValue(objectString(vectorPathCommandLine))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (vectorPathCommandLine *VectorPathCommandLine) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, vectorPathCommandLine)
+}
+
+func (vectorPathCommandLine *VectorPathCommandLine) printText(p *printer) {
+	if vectorPathCommandLine == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("VectorPathCommandLine")
+	p.object("end_point", vectorPathCommandLine.EndPoint)
+	p.endObject(state)
+}
+
 // GetVectorPathCommandEnum return the enum type of this object
 func (vectorPathCommandLine *VectorPathCommandLine) GetVectorPathCommandEnum() VectorPathCommandEnum {
 	return VectorPathCommandLineType
 }
 
+// Accept calls the method of the visitor for VectorPathCommandLine
+func (vectorPathCommandLine *VectorPathCommandLine) Accept(visitor VectorPathCommandVisitor) {
+	visitor.VisitVectorPathCommandLine(vectorPathCommandLine)
+}
+
 // VectorPathCommandCubicBezierCurve A cubic Bézier curve to a given point
 type VectorPathCommandCubicBezierCurve struct {
 	tdCommon
@@ -103,7 +294,253 @@ func NewVectorPathCommandCubicBezierCurve(startControlPoint *Point, endControlPo
 	return &vectorPathCommandCubicBezierCurveTemp
 }
 
+// MarshalJSON marshals to json
+func (vectorPathCommandCubicBezierCurve *VectorPathCommandCubicBezierCurve) MarshalJSON() ([]byte, error) {
+	return vectorPathCommandCubicBezierCurve.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (vectorPathCommandCubicBezierCurve *VectorPathCommandCubicBezierCurve) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, vectorPathCommandCubicBezierCurve)
+}
+
+func (vectorPathCommandCubicBezierCurve *VectorPathCommandCubicBezierCurve) appendJSON(b []byte) ([]byte, error) {
+	if vectorPathCommandCubicBezierCurve == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, vectorPathCommandCubicBezierCurve.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, vectorPathCommandCubicBezierCurve.tdCommon.Extra)
+	b = append(b, `,"start_control_point":`...)
+	b, err = vectorPathCommandCubicBezierCurve.StartControlPoint.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"end_control_point":`...)
+	b, err = vectorPathCommandCubicBezierCurve.EndControlPoint.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"end_point":`...)
+	b, err = vectorPathCommandCubicBezierCurve.EndPoint.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (vectorPathCommandCubicBezierCurve *VectorPathCommandCubicBezierCurve) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			vectorPathCommandCubicBezierCurve.tdCommon.Type, err = r.readString()
+		case "@extra":
+			vectorPathCommandCubicBezierCurve.tdCommon.Extra, err = r.readString()
+		case "start_control_point":
+			if r.readNull() {
+				vectorPathCommandCubicBezierCurve.StartControlPoint = nil
+			} else {
+				vectorPathCommandCubicBezierCurve.StartControlPoint = new(Point)
+				err = vectorPathCommandCubicBezierCurve.StartControlPoint.decodeJSON(r)
+			}
+		case "end_control_point":
+			if r.readNull() {
+				vectorPathCommandCubicBezierCurve.EndControlPoint = nil
+			} else {
+				vectorPathCommandCubicBezierCurve.EndControlPoint = new(Point)
+				err = vectorPathCommandCubicBezierCurve.EndControlPoint.decodeJSON(r)
+			}
+		case "end_point":
+			if r.readNull() {
+				vectorPathCommandCubicBezierCurve.EndPoint = nil
+			} else {
+				vectorPathCommandCubicBezierCurve.EndPoint = new(Point)
+				err = vectorPathCommandCubicBezierCurve.EndPoint.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (vectorPathCommandCubicBezierCurve *VectorPathCommandCubicBezierCurve) Clone() *VectorPathCommandCubicBezierCurve {
+	if vectorPathCommandCubicBezierCurve == nil {
+		return nil
+	}
+	clone := *vectorPathCommandCubicBezierCurve
+	clone.StartControlPoint = vectorPathCommandCubicBezierCurve.StartControlPoint.Clone()
+	clone.EndControlPoint = vectorPathCommandCubicBezierCurve.EndControlPoint.Clone()
+	clone.EndPoint = vectorPathCommandCubicBezierCurve.EndPoint.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (vectorPathCommandCubicBezierCurve *VectorPathCommandCubicBezierCurve) Equal(other *VectorPathCommandCubicBezierCurve) bool {
+	if vectorPathCommandCubicBezierCurve == nil || other == nil {
+		return vectorPathCommandCubicBezierCurve == other
+	}
+	if !vectorPathCommandCubicBezierCurve.StartControlPoint.Equal(other.StartControlPoint) {
+		return false
+	}
+	if !vectorPathCommandCubicBezierCurve.EndControlPoint.Equal(other.EndControlPoint) {
+		return false
+	}
+	if !vectorPathCommandCubicBezierCurve.EndPoint.Equal(other.EndPoint) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (vectorPathCommandCubicBezierCurve *VectorPathCommandCubicBezierCurve) Diff(other *VectorPathCommandCubicBezierCurve) []Change {
+	return vectorPathCommandCubicBezierCurve.diff("", other, nil)
+}
+
+func (vectorPathCommandCubicBezierCurve *VectorPathCommandCubicBezierCurve) diff(path string, other *VectorPathCommandCubicBezierCurve, changes []Change) []Change {
+	if vectorPathCommandCubicBezierCurve == nil || other == nil {
+		if vectorPathCommandCubicBezierCurve != other {
+			changes = append(changes, Change{Path: path, Old: vectorPathCommandCubicBezierCurve, New: other})
+		}
+		return changes
+	}
+	changes = vectorPathCommandCubicBezierCurve.StartControlPoint.diff(fieldPath(path, "start_control_point"), other.StartControlPoint, changes)
+	changes = vectorPathCommandCubicBezierCurve.EndControlPoint.diff(fieldPath(path, "end_control_point"), other.EndControlPoint, changes)
+	changes = vectorPathCommandCubicBezierCurve.EndPoint.diff(fieldPath(path, "end_point"), other.EndPoint, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (vectorPathCommandCubicBezierCurve *VectorPathCommandCubicBezierCurve) String() string {
+	return objectString(vectorPathCommandCubicBezierCurve)
+}
+
+// LogValue logs the object as its summary
+func (vectorPathCommandCubicBezierCurve *VectorPathCommandCubicBezierCurve) LogValue() slog.Value {
+	return slog.StringValue(objectString(vectorPathCommandCubicBezierCurve))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (vectorPathCommandCubicBezierCurve *VectorPathCommandCubicBezierCurve) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, vectorPathCommandCubicBezierCurve)
+}
+
+func (vectorPathCommandCubicBezierCurve *VectorPathCommandCubicBezierCurve) printText(p *printer) {
+	if vectorPathCommandCubicBezierCurve == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("VectorPathCommandCubicBezierCurve")
+	p.object("start_control_point", vectorPathCommandCubicBezierCurve.StartControlPoint)
+	p.object("end_control_point", vectorPathCommandCubicBezierCurve.EndControlPoint)
+	p.object("end_point", vectorPathCommandCubicBezierCurve.EndPoint)
+	p.endObject(state)
+}
+
 // GetVectorPathCommandEnum return the enum type of this object
 func (vectorPathCommandCubicBezierCurve *VectorPathCommandCubicBezierCurve) GetVectorPathCommandEnum() VectorPathCommandEnum {
 	return VectorPathCommandCubicBezierCurveType
 }
+
+// Accept calls the method of the visitor for VectorPathCommandCubicBezierCurve
+func (vectorPathCommandCubicBezierCurve *VectorPathCommandCubicBezierCurve) Accept(visitor VectorPathCommandVisitor) {
+	visitor.VisitVectorPathCommandCubicBezierCurve(vectorPathCommandCubicBezierCurve)
+}
+
+// VectorPathCommandVisitor visits every type of VectorPathCommand, see Accept. Implementations stop compiling
+// when a newer TDLib adds a type to VectorPathCommand, until they handle it too.
+type VectorPathCommandVisitor interface {
+	VisitVectorPathCommandLine(vectorPathCommandLine *VectorPathCommandLine)
+	VisitVectorPathCommandCubicBezierCurve(vectorPathCommandCubicBezierCurve *VectorPathCommandCubicBezierCurve)
+	VisitUnknownVectorPathCommand(unknownVectorPathCommand *UnknownVectorPathCommand)
+}
+
+// VectorPathCommandCases holds the function MatchVectorPathCommand calls for each type of VectorPathCommand;
+// types without a function are passed to the default case
+type VectorPathCommandCases[R any] struct {
+	VectorPathCommandLine             func(vectorPathCommandLine *VectorPathCommandLine) R
+	VectorPathCommandCubicBezierCurve func(vectorPathCommandCubicBezierCurve *VectorPathCommandCubicBezierCurve) R
+}
+
+// MatchVectorPathCommand calls the case of the type of vectorPathCommand, or defaultCase if there's none, e.g. for
+// types unknown to the bindings or nil, and returns its result.
+// It isn't exhaustive: types added by a newer TDLib silently go to defaultCase. Implement VectorPathCommandVisitor
+// to have the compiler check every type is handled.
+func MatchVectorPathCommand[R any](vectorPathCommand VectorPathCommand, cases VectorPathCommandCases[R], defaultCase func(vectorPathCommand VectorPathCommand) R) R {
+	switch value := vectorPathCommand.(type) {
+	case *VectorPathCommandLine:
+		if cases.VectorPathCommandLine != nil {
+			return cases.VectorPathCommandLine(value)
+		}
+	case *VectorPathCommandCubicBezierCurve:
+		if cases.VectorPathCommandCubicBezierCurve != nil {
+			return cases.VectorPathCommandCubicBezierCurve(value)
+		}
+	}
+	return defaultCase(vectorPathCommand)
+}
+
+// Clone returns a deep copy of the object
+func (unknownVectorPathCommand *UnknownVectorPathCommand) Clone() *UnknownVectorPathCommand {
+	if unknownVectorPathCommand == nil {
+		return nil
+	}
+	return &UnknownVectorPathCommand{Unknown: unknownVectorPathCommand.Unknown.clone()}
+}
+
+// Equal reports whether the object has the same type and raw JSON as other
+func (unknownVectorPathCommand *UnknownVectorPathCommand) Equal(other *UnknownVectorPathCommand) bool {
+	if unknownVectorPathCommand == nil || other == nil {
+		return unknownVectorPathCommand == other
+	}
+	return unknownVectorPathCommand.Unknown.equal(other.Unknown)
+}
+
+func cloneVectorPathCommand(vectorPathCommand VectorPathCommand) VectorPathCommand {
+	switch value := vectorPathCommand.(type) {
+	case *VectorPathCommandLine:
+		return value.Clone()
+	case *VectorPathCommandCubicBezierCurve:
+		return value.Clone()
+	case *UnknownVectorPathCommand:
+		return value.Clone()
+	}
+	return vectorPathCommand
+}
+
+func equalVectorPathCommand(a VectorPathCommand, b VectorPathCommand) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *VectorPathCommandLine:
+		b, ok := b.(*VectorPathCommandLine)
+		return ok && a.Equal(b)
+	case *VectorPathCommandCubicBezierCurve:
+		b, ok := b.(*VectorPathCommandCubicBezierCurve)
+		return ok && a.Equal(b)
+	case *UnknownVectorPathCommand:
+		b, ok := b.(*UnknownVectorPathCommand)
+		return ok && a.Equal(b)
+	}
+	return false
+}
+
+func diffVectorPathCommand(path string, a VectorPathCommand, b VectorPathCommand, changes []Change) []Change {
+	switch a := a.(type) {
+	case *VectorPathCommandLine:
+		if b, ok := b.(*VectorPathCommandLine); ok {
+			return a.diff(path, b, changes)
+		}
+	case *VectorPathCommandCubicBezierCurve:
+		if b, ok := b.(*VectorPathCommandCubicBezierCurve); ok {
+			return a.diff(path, b, changes)
+		}
+	}
+	if !equalVectorPathCommand(a, b) {
+		changes = append(changes, Change{Path: path, Old: a, New: b})
+	}
+	return changes
+}
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // Venue Describes a venue
 type Venue struct {
 	tdCommon
@@ -37,3 +42,168 @@ func NewVenue(location *Location, title string, address string, provider string,
 
 	return &venueTemp
 }
+
+// MarshalJSON marshals to json
+func (venue *Venue) MarshalJSON() ([]byte, error) {
+	return venue.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (venue *Venue) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, venue)
+}
+
+func (venue *Venue) appendJSON(b []byte) ([]byte, error) {
+	if venue == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, venue.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, venue.tdCommon.Extra)
+	b = append(b, `,"location":`...)
+	b, err = venue.Location.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, venue.Title)
+	b = append(b, `,"address":`...)
+	b = appendJSONString(b, venue.Address)
+	b = append(b, `,"provider":`...)
+	b = appendJSONString(b, venue.Provider)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, venue.Id)
+	b = append(b, `,"type":`...)
+	b = appendJSONString(b, venue.Type)
+	return append(b, '}'), nil
+}
+
+func (venue *Venue) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			venue.tdCommon.Type, err = r.readString()
+		case "@extra":
+			venue.tdCommon.Extra, err = r.readString()
+		case "location":
+			if r.readNull() {
+				venue.Location = nil
+			} else {
+				venue.Location = new(Location)
+				err = venue.Location.decodeJSON(r)
+			}
+		case "title":
+			venue.Title, err = r.readString()
+		case "address":
+			venue.Address, err = r.readString()
+		case "provider":
+			venue.Provider, err = r.readString()
+		case "id":
+			venue.Id, err = r.readString()
+		case "type":
+			venue.Type, err = r.readString()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (venue *Venue) Clone() *Venue {
+	if venue == nil {
+		return nil
+	}
+	clone := *venue
+	clone.Location = venue.Location.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (venue *Venue) Equal(other *Venue) bool {
+	if venue == nil || other == nil {
+		return venue == other
+	}
+	if !venue.Location.Equal(other.Location) {
+		return false
+	}
+	if venue.Title != other.Title {
+		return false
+	}
+	if venue.Address != other.Address {
+		return false
+	}
+	if venue.Provider != other.Provider {
+		return false
+	}
+	if venue.Id != other.Id {
+		return false
+	}
+	if venue.Type != other.Type {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (venue *Venue) Diff(other *Venue) []Change {
+	return venue.diff("", other, nil)
+}
+
+func (venue *Venue) diff(path string, other *Venue, changes []Change) []Change {
+	if venue == nil || other == nil {
+		if venue != other {
+			changes = append(changes, Change{Path: path, Old: venue, New: other})
+		}
+		return changes
+	}
+	changes = venue.Location.diff(fieldPath(path, "location"), other.Location, changes)
+	if venue.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: venue.Title, New: other.Title})
+	}
+	if venue.Address != other.Address {
+		changes = append(changes, Change{Path: fieldPath(path, "address"), Old: venue.Address, New: other.Address})
+	}
+	if venue.Provider != other.Provider {
+		changes = append(changes, Change{Path: fieldPath(path, "provider"), Old: venue.Provider, New: other.Provider})
+	}
+	if venue.Id != other.Id {
+		changes = append(changes, Change{Path: fieldPath(path, "id"), Old: venue.Id, New: other.Id})
+	}
+	if venue.Type != other.Type {
+		changes = append(changes, Change{Path: fieldPath(path, "type"), Old: venue.Type, New: other.Type})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (venue *Venue) String() string {
+	return objectString(venue)
+}
+
+// LogValue logs the object as its summary
+func (venue *Venue) LogValue() slog.Value {
+	return slog.StringValue(objectString(venue))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (venue *Venue) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, venue)
+}
+
+func (venue *Venue) printText(p *printer) {
+	if venue == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Venue")
+	p.object("location", venue.Location)
+	p.string("title", venue.Title)
+	p.string("address", venue.Address)
+	p.string("provider", venue.Provider)
+	p.string("id", venue.Id)
+	p.string("type", venue.Type)
+	p.endObject(state)
+}
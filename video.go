@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // Video Describes a video file
 type Video struct {
 	tdCommon
@@ -49,3 +54,226 @@ func NewVideo(duration int32, width int32, height int32, fileName string, mimeTy
 
 	return &videoTemp
 }
+
+// MarshalJSON marshals to json
+func (video *Video) MarshalJSON() ([]byte, error) {
+	return video.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (video *Video) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, video)
+}
+
+func (video *Video) appendJSON(b []byte) ([]byte, error) {
+	if video == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, video.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, video.tdCommon.Extra)
+	b = append(b, `,"duration":`...)
+	b = appendJSONInt(b, int64(video.Duration))
+	b = append(b, `,"width":`...)
+	b = appendJSONInt(b, int64(video.Width))
+	b = append(b, `,"height":`...)
+	b = appendJSONInt(b, int64(video.Height))
+	b = append(b, `,"file_name":`...)
+	b = appendJSONString(b, video.FileName)
+	b = append(b, `,"mime_type":`...)
+	b = appendJSONString(b, video.MimeType)
+	b = append(b, `,"has_stickers":`...)
+	b = appendJSONBool(b, video.HasStickers)
+	b = append(b, `,"supports_streaming":`...)
+	b = appendJSONBool(b, video.SupportsStreaming)
+	b = append(b, `,"minithumbnail":`...)
+	b, err = video.Minithumbnail.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"thumbnail":`...)
+	b, err = video.Thumbnail.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"video":`...)
+	b, err = video.Video.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (video *Video) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			video.tdCommon.Type, err = r.readString()
+		case "@extra":
+			video.tdCommon.Extra, err = r.readString()
+		case "duration":
+			video.Duration, err = r.readInt32()
+		case "width":
+			video.Width, err = r.readInt32()
+		case "height":
+			video.Height, err = r.readInt32()
+		case "file_name":
+			video.FileName, err = r.readString()
+		case "mime_type":
+			video.MimeType, err = r.readString()
+		case "has_stickers":
+			video.HasStickers, err = r.readBool()
+		case "supports_streaming":
+			video.SupportsStreaming, err = r.readBool()
+		case "minithumbnail":
+			if r.readNull() {
+				video.Minithumbnail = nil
+			} else {
+				video.Minithumbnail = new(Minithumbnail)
+				err = video.Minithumbnail.decodeJSON(r)
+			}
+		case "thumbnail":
+			if r.readNull() {
+				video.Thumbnail = nil
+			} else {
+				video.Thumbnail = new(Thumbnail)
+				err = video.Thumbnail.decodeJSON(r)
+			}
+		case "video":
+			if r.readNull() {
+				video.Video = nil
+			} else {
+				video.Video = new(File)
+				err = video.Video.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (video *Video) Clone() *Video {
+	if video == nil {
+		return nil
+	}
+	clone := *video
+	clone.Minithumbnail = video.Minithumbnail.Clone()
+	clone.Thumbnail = video.Thumbnail.Clone()
+	clone.Video = video.Video.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (video *Video) Equal(other *Video) bool {
+	if video == nil || other == nil {
+		return video == other
+	}
+	if video.Duration != other.Duration {
+		return false
+	}
+	if video.Width != other.Width {
+		return false
+	}
+	if video.Height != other.Height {
+		return false
+	}
+	if video.FileName != other.FileName {
+		return false
+	}
+	if video.MimeType != other.MimeType {
+		return false
+	}
+	if video.HasStickers != other.HasStickers {
+		return false
+	}
+	if video.SupportsStreaming != other.SupportsStreaming {
+		return false
+	}
+	if !video.Minithumbnail.Equal(other.Minithumbnail) {
+		return false
+	}
+	if !video.Thumbnail.Equal(other.Thumbnail) {
+		return false
+	}
+	if !video.Video.Equal(other.Video) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (video *Video) Diff(other *Video) []Change {
+	return video.diff("", other, nil)
+}
+
+func (video *Video) diff(path string, other *Video, changes []Change) []Change {
+	if video == nil || other == nil {
+		if video != other {
+			changes = append(changes, Change{Path: path, Old: video, New: other})
+		}
+		return changes
+	}
+	if video.Duration != other.Duration {
+		changes = append(changes, Change{Path: fieldPath(path, "duration"), Old: video.Duration, New: other.Duration})
+	}
+	if video.Width != other.Width {
+		changes = append(changes, Change{Path: fieldPath(path, "width"), Old: video.Width, New: other.Width})
+	}
+	if video.Height != other.Height {
+		changes = append(changes, Change{Path: fieldPath(path, "height"), Old: video.Height, New: other.Height})
+	}
+	if video.FileName != other.FileName {
+		changes = append(changes, Change{Path: fieldPath(path, "file_name"), Old: video.FileName, New: other.FileName})
+	}
+	if video.MimeType != other.MimeType {
+		changes = append(changes, Change{Path: fieldPath(path, "mime_type"), Old: video.MimeType, New: other.MimeType})
+	}
+	if video.HasStickers != other.HasStickers {
+		changes = append(changes, Change{Path: fieldPath(path, "has_stickers"), Old: video.HasStickers, New: other.HasStickers})
+	}
+	if video.SupportsStreaming != other.SupportsStreaming {
+		changes = append(changes, Change{Path: fieldPath(path, "supports_streaming"), Old: video.SupportsStreaming, New: other.SupportsStreaming})
+	}
+	changes = video.Minithumbnail.diff(fieldPath(path, "minithumbnail"), other.Minithumbnail, changes)
+	changes = video.Thumbnail.diff(fieldPath(path, "thumbnail"), other.Thumbnail, changes)
+	changes = video.Video.diff(fieldPath(path, "video"), other.Video, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (video *Video) String() string {
+	return objectString(video)
+}
+
+// LogValue logs the object as its summary
+func (video *Video) LogValue() slog.Value {
+	return slog.StringValue(objectString(video))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (video *Video) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, video)
+}
+
+func (video *Video) printText(p *printer) {
+	if video == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("Video")
+	p.int("duration", int64(video.Duration))
+	p.int("width", int64(video.Width))
+	p.int("height", int64(video.Height))
+	p.string("file_name", video.FileName)
+	p.string("mime_type", video.MimeType)
+	p.bool("has_stickers", video.HasStickers)
+	p.bool("supports_streaming", video.SupportsStreaming)
+	p.object("minithumbnail", video.Minithumbnail)
+	p.object("thumbnail", video.Thumbnail)
+	p.object("video", video.Video)
+	p.endObject(state)
+}
@@ -1,7 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
+	"fmt"
+	"log/slog"
 )
 
 // VideoChat Describes a video chat
@@ -33,30 +34,129 @@ func NewVideoChat(groupCallId int32, hasParticipants bool, defaultParticipantId
 	return &videoChatTemp
 }
 
+// MarshalJSON marshals to json
+func (videoChat *VideoChat) MarshalJSON() ([]byte, error) {
+	return videoChat.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (videoChat *VideoChat) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
+	return unmarshalFast(b, videoChat)
+}
+
+func (videoChat *VideoChat) appendJSON(b []byte) ([]byte, error) {
+	if videoChat == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, videoChat.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, videoChat.tdCommon.Extra)
+	b = append(b, `,"group_call_id":`...)
+	b = appendJSONInt(b, int64(videoChat.GroupCallId))
+	b = append(b, `,"has_participants":`...)
+	b = appendJSONBool(b, videoChat.HasParticipants)
+	b = append(b, `,"default_participant_id":`...)
+	b, err = appendJSONValue(b, videoChat.DefaultParticipantId)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tempObj := struct {
-		tdCommon
-		GroupCallId     int32 `json:"group_call_id"`    // Group call identifier of an active video chat; 0 if none. Full information about the video chat can be received through the method getGroupCall
-		HasParticipants bool  `json:"has_participants"` // True, if the video chat has participants
+	return append(b, '}'), nil
+}
 
-	}{}
-	err = json.Unmarshal(b, &tempObj)
-	if err != nil {
-		return err
+func (videoChat *VideoChat) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			videoChat.tdCommon.Type, err = r.readString()
+		case "@extra":
+			videoChat.tdCommon.Extra, err = r.readString()
+		case "group_call_id":
+			videoChat.GroupCallId, err = r.readInt32()
+		case "has_participants":
+			videoChat.HasParticipants, err = r.readBool()
+		case "default_participant_id":
+			videoChat.DefaultParticipantId, err = decodeMessageSender(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (videoChat *VideoChat) Clone() *VideoChat {
+	if videoChat == nil {
+		return nil
+	}
+	clone := *videoChat
+	clone.DefaultParticipantId = cloneMessageSender(videoChat.DefaultParticipantId)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (videoChat *VideoChat) Equal(other *VideoChat) bool {
+	if videoChat == nil || other == nil {
+		return videoChat == other
+	}
+	if videoChat.GroupCallId != other.GroupCallId {
+		return false
+	}
+	if videoChat.HasParticipants != other.HasParticipants {
+		return false
 	}
+	if !equalMessageSender(videoChat.DefaultParticipantId, other.DefaultParticipantId) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (videoChat *VideoChat) Diff(other *VideoChat) []Change {
+	return videoChat.diff("", other, nil)
+}
+
+func (videoChat *VideoChat) diff(path string, other *VideoChat, changes []Change) []Change {
+	if videoChat == nil || other == nil {
+		if videoChat != other {
+			changes = append(changes, Change{Path: path, Old: videoChat, New: other})
+		}
+		return changes
+	}
+	if videoChat.GroupCallId != other.GroupCallId {
+		changes = append(changes, Change{Path: fieldPath(path, "group_call_id"), Old: videoChat.GroupCallId, New: other.GroupCallId})
+	}
+	if videoChat.HasParticipants != other.HasParticipants {
+		changes = append(changes, Change{Path: fieldPath(path, "has_participants"), Old: videoChat.HasParticipants, New: other.HasParticipants})
+	}
+	changes = diffMessageSender(fieldPath(path, "default_participant_id"), videoChat.DefaultParticipantId, other.DefaultParticipantId, changes)
+	return changes
+}
 
-	videoChat.tdCommon = tempObj.tdCommon
-	videoChat.GroupCallId = tempObj.GroupCallId
-	videoChat.HasParticipants = tempObj.HasParticipants
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (videoChat *VideoChat) String() string {
+	return objectString(videoChat)
+}
 
-	fieldDefaultParticipantId, _ := unmarshalMessageSender(objMap["default_participant_id"])
-	videoChat.DefaultParticipantId = fieldDefaultParticipantId
+// LogValue logs the object as its summary
+func (videoChat *VideoChat) LogValue() slog.Value {
+	return slog.StringValue(objectString(videoChat))
+}
 
-	return nil
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (videoChat *VideoChat) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, videoChat)
+}
+
+func (videoChat *VideoChat) printText(p *printer) {
+	if videoChat == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("VideoChat")
+	p.int("group_call_id", int64(videoChat.GroupCallId))
+	p.bool("has_participants", videoChat.HasParticipants)
+	p.object("default_participant_id", videoChat.DefaultParticipantId)
+	p.endObject(state)
 }
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // VideoNote Describes a video note. The video must be equal in width and height, cropped to a circle, and stored in MPEG4 format
 type VideoNote struct {
 	tdCommon
@@ -34,3 +39,171 @@ func NewVideoNote(duration int32, length int32, minithumbnail *Minithumbnail, th
 
 	return &videoNoteTemp
 }
+
+// MarshalJSON marshals to json
+func (videoNote *VideoNote) MarshalJSON() ([]byte, error) {
+	return videoNote.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (videoNote *VideoNote) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, videoNote)
+}
+
+func (videoNote *VideoNote) appendJSON(b []byte) ([]byte, error) {
+	if videoNote == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, videoNote.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, videoNote.tdCommon.Extra)
+	b = append(b, `,"duration":`...)
+	b = appendJSONInt(b, int64(videoNote.Duration))
+	b = append(b, `,"length":`...)
+	b = appendJSONInt(b, int64(videoNote.Length))
+	b = append(b, `,"minithumbnail":`...)
+	b, err = videoNote.Minithumbnail.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"thumbnail":`...)
+	b, err = videoNote.Thumbnail.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"video":`...)
+	b, err = videoNote.Video.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (videoNote *VideoNote) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			videoNote.tdCommon.Type, err = r.readString()
+		case "@extra":
+			videoNote.tdCommon.Extra, err = r.readString()
+		case "duration":
+			videoNote.Duration, err = r.readInt32()
+		case "length":
+			videoNote.Length, err = r.readInt32()
+		case "minithumbnail":
+			if r.readNull() {
+				videoNote.Minithumbnail = nil
+			} else {
+				videoNote.Minithumbnail = new(Minithumbnail)
+				err = videoNote.Minithumbnail.decodeJSON(r)
+			}
+		case "thumbnail":
+			if r.readNull() {
+				videoNote.Thumbnail = nil
+			} else {
+				videoNote.Thumbnail = new(Thumbnail)
+				err = videoNote.Thumbnail.decodeJSON(r)
+			}
+		case "video":
+			if r.readNull() {
+				videoNote.Video = nil
+			} else {
+				videoNote.Video = new(File)
+				err = videoNote.Video.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (videoNote *VideoNote) Clone() *VideoNote {
+	if videoNote == nil {
+		return nil
+	}
+	clone := *videoNote
+	clone.Minithumbnail = videoNote.Minithumbnail.Clone()
+	clone.Thumbnail = videoNote.Thumbnail.Clone()
+	clone.Video = videoNote.Video.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (videoNote *VideoNote) Equal(other *VideoNote) bool {
+	if videoNote == nil || other == nil {
+		return videoNote == other
+	}
+	if videoNote.Duration != other.Duration {
+		return false
+	}
+	if videoNote.Length != other.Length {
+		return false
+	}
+	if !videoNote.Minithumbnail.Equal(other.Minithumbnail) {
+		return false
+	}
+	if !videoNote.Thumbnail.Equal(other.Thumbnail) {
+		return false
+	}
+	if !videoNote.Video.Equal(other.Video) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (videoNote *VideoNote) Diff(other *VideoNote) []Change {
+	return videoNote.diff("", other, nil)
+}
+
+func (videoNote *VideoNote) diff(path string, other *VideoNote, changes []Change) []Change {
+	if videoNote == nil || other == nil {
+		if videoNote != other {
+			changes = append(changes, Change{Path: path, Old: videoNote, New: other})
+		}
+		return changes
+	}
+	if videoNote.Duration != other.Duration {
+		changes = append(changes, Change{Path: fieldPath(path, "duration"), Old: videoNote.Duration, New: other.Duration})
+	}
+	if videoNote.Length != other.Length {
+		changes = append(changes, Change{Path: fieldPath(path, "length"), Old: videoNote.Length, New: other.Length})
+	}
+	changes = videoNote.Minithumbnail.diff(fieldPath(path, "minithumbnail"), other.Minithumbnail, changes)
+	changes = videoNote.Thumbnail.diff(fieldPath(path, "thumbnail"), other.Thumbnail, changes)
+	changes = videoNote.Video.diff(fieldPath(path, "video"), other.Video, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (videoNote *VideoNote) String() string {
+	return objectString(videoNote)
+}
+
+// LogValue logs the object as its summary
+func (videoNote *VideoNote) LogValue() slog.Value {
+	return slog.StringValue(objectString(videoNote))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (videoNote *VideoNote) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, videoNote)
+}
+
+func (videoNote *VideoNote) printText(p *printer) {
+	if videoNote == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("VideoNote")
+	p.int("duration", int64(videoNote.Duration))
+	p.int("length", int64(videoNote.Length))
+	p.object("minithumbnail", videoNote.Minithumbnail)
+	p.object("thumbnail", videoNote.Thumbnail)
+	p.object("video", videoNote.Video)
+	p.endObject(state)
+}
@@ -1,5 +1,10 @@
 package tdlib
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // VoiceNote Describes a voice note. The voice note must be encoded with the Opus codec, and stored inside an OGG container. Voice notes can have only a single audio channel
 type VoiceNote struct {
 	tdCommon
@@ -31,3 +36,149 @@ func NewVoiceNote(duration int32, waveform []byte, mimeType string, voice *File)
 
 	return &voiceNoteTemp
 }
+
+// MarshalJSON marshals to json
+func (voiceNote *VoiceNote) MarshalJSON() ([]byte, error) {
+	return voiceNote.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (voiceNote *VoiceNote) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, voiceNote)
+}
+
+func (voiceNote *VoiceNote) appendJSON(b []byte) ([]byte, error) {
+	if voiceNote == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, voiceNote.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, voiceNote.tdCommon.Extra)
+	b = append(b, `,"duration":`...)
+	b = appendJSONInt(b, int64(voiceNote.Duration))
+	b = append(b, `,"waveform":`...)
+	b = appendJSONBytes(b, voiceNote.Waveform)
+	b = append(b, `,"mime_type":`...)
+	b = appendJSONString(b, voiceNote.MimeType)
+	b = append(b, `,"voice":`...)
+	b, err = voiceNote.Voice.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (voiceNote *VoiceNote) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			voiceNote.tdCommon.Type, err = r.readString()
+		case "@extra":
+			voiceNote.tdCommon.Extra, err = r.readString()
+		case "duration":
+			voiceNote.Duration, err = r.readInt32()
+		case "waveform":
+			voiceNote.Waveform, err = r.readBytes()
+		case "mime_type":
+			voiceNote.MimeType, err = r.readString()
+		case "voice":
+			if r.readNull() {
+				voiceNote.Voice = nil
+			} else {
+				voiceNote.Voice = new(File)
+				err = voiceNote.Voice.decodeJSON(r)
+			}
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (voiceNote *VoiceNote) Clone() *VoiceNote {
+	if voiceNote == nil {
+		return nil
+	}
+	clone := *voiceNote
+	if voiceNote.Waveform != nil {
+		clone.Waveform = append([]byte{}, voiceNote.Waveform...)
+	}
+	clone.Voice = voiceNote.Voice.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (voiceNote *VoiceNote) Equal(other *VoiceNote) bool {
+	if voiceNote == nil || other == nil {
+		return voiceNote == other
+	}
+	if voiceNote.Duration != other.Duration {
+		return false
+	}
+	if !equalBytes(voiceNote.Waveform, other.Waveform) {
+		return false
+	}
+	if voiceNote.MimeType != other.MimeType {
+		return false
+	}
+	if !voiceNote.Voice.Equal(other.Voice) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (voiceNote *VoiceNote) Diff(other *VoiceNote) []Change {
+	return voiceNote.diff("", other, nil)
+}
+
+func (voiceNote *VoiceNote) diff(path string, other *VoiceNote, changes []Change) []Change {
+	if voiceNote == nil || other == nil {
+		if voiceNote != other {
+			changes = append(changes, Change{Path: path, Old: voiceNote, New: other})
+		}
+		return changes
+	}
+	if voiceNote.Duration != other.Duration {
+		changes = append(changes, Change{Path: fieldPath(path, "duration"), Old: voiceNote.Duration, New: other.Duration})
+	}
+	if !equalBytes(voiceNote.Waveform, other.Waveform) {
+		changes = append(changes, Change{Path: fieldPath(path, "waveform"), Old: voiceNote.Waveform, New: other.Waveform})
+	}
+	if voiceNote.MimeType != other.MimeType {
+		changes = append(changes, Change{Path: fieldPath(path, "mime_type"), Old: voiceNote.MimeType, New: other.MimeType})
+	}
+	changes = voiceNote.Voice.diff(fieldPath(path, "voice"), other.Voice, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (voiceNote *VoiceNote) String() string {
+	return objectString(voiceNote)
+}
+
+// LogValue logs the object as its summary
+func (voiceNote *VoiceNote) LogValue() slog.Value {
+	return slog.StringValue(objectString(voiceNote))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (voiceNote *VoiceNote) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, voiceNote)
+}
+
+func (voiceNote *VoiceNote) printText(p *printer) {
+	if voiceNote == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("VoiceNote")
+	p.int("duration", int64(voiceNote.Duration))
+	p.bytes("waveform", voiceNote.Waveform)
+	p.string("mime_type", voiceNote.MimeType)
+	p.object("voice", voiceNote.Voice)
+	p.endObject(state)
+}
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // WebPage Describes a web page preview
@@ -88,6 +88,392 @@ func NewWebPage(url string, displayUrl string, typeParam string, siteName string
 	return &webPageTemp
 }
 
+// MarshalJSON marshals to json
+func (webPage *WebPage) MarshalJSON() ([]byte, error) {
+	return webPage.appendJSON(nil)
+}
+
+// UnmarshalJSON unmarshal to json
+func (webPage *WebPage) UnmarshalJSON(b []byte) error {
+	return unmarshalFast(b, webPage)
+}
+
+func (webPage *WebPage) appendJSON(b []byte) ([]byte, error) {
+	if webPage == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, webPage.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, webPage.tdCommon.Extra)
+	b = append(b, `,"url":`...)
+	b = appendJSONString(b, webPage.Url)
+	b = append(b, `,"display_url":`...)
+	b = appendJSONString(b, webPage.DisplayUrl)
+	b = append(b, `,"type":`...)
+	b = appendJSONString(b, webPage.Type)
+	b = append(b, `,"site_name":`...)
+	b = appendJSONString(b, webPage.SiteName)
+	b = append(b, `,"title":`...)
+	b = appendJSONString(b, webPage.Title)
+	b = append(b, `,"description":`...)
+	b, err = webPage.Description.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"photo":`...)
+	b, err = webPage.Photo.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"embed_url":`...)
+	b = appendJSONString(b, webPage.EmbedUrl)
+	b = append(b, `,"embed_type":`...)
+	b = appendJSONString(b, webPage.EmbedType)
+	b = append(b, `,"embed_width":`...)
+	b = appendJSONInt(b, int64(webPage.EmbedWidth))
+	b = append(b, `,"embed_height":`...)
+	b = appendJSONInt(b, int64(webPage.EmbedHeight))
+	b = append(b, `,"duration":`...)
+	b = appendJSONInt(b, int64(webPage.Duration))
+	b = append(b, `,"author":`...)
+	b = appendJSONString(b, webPage.Author)
+	b = append(b, `,"animation":`...)
+	b, err = webPage.Animation.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"audio":`...)
+	b, err = webPage.Audio.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"document":`...)
+	b, err = webPage.Document.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"sticker":`...)
+	b, err = webPage.Sticker.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"video":`...)
+	b, err = webPage.Video.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"video_note":`...)
+	b, err = webPage.VideoNote.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"voice_note":`...)
+	b, err = webPage.VoiceNote.appendJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, `,"instant_view_version":`...)
+	b = appendJSONInt(b, int64(webPage.InstantViewVersion))
+	return append(b, '}'), nil
+}
+
+func (webPage *WebPage) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			webPage.tdCommon.Type, err = r.readString()
+		case "@extra":
+			webPage.tdCommon.Extra, err = r.readString()
+		case "url":
+			webPage.Url, err = r.readString()
+		case "display_url":
+			webPage.DisplayUrl, err = r.readString()
+		case "type":
+			webPage.Type, err = r.readString()
+		case "site_name":
+			webPage.SiteName, err = r.readString()
+		case "title":
+			webPage.Title, err = r.readString()
+		case "description":
+			if r.readNull() {
+				webPage.Description = nil
+			} else {
+				webPage.Description = new(FormattedText)
+				err = webPage.Description.decodeJSON(r)
+			}
+		case "photo":
+			if r.readNull() {
+				webPage.Photo = nil
+			} else {
+				webPage.Photo = new(Photo)
+				err = webPage.Photo.decodeJSON(r)
+			}
+		case "embed_url":
+			webPage.EmbedUrl, err = r.readString()
+		case "embed_type":
+			webPage.EmbedType, err = r.readString()
+		case "embed_width":
+			webPage.EmbedWidth, err = r.readInt32()
+		case "embed_height":
+			webPage.EmbedHeight, err = r.readInt32()
+		case "duration":
+			webPage.Duration, err = r.readInt32()
+		case "author":
+			webPage.Author, err = r.readString()
+		case "animation":
+			if r.readNull() {
+				webPage.Animation = nil
+			} else {
+				webPage.Animation = new(Animation)
+				err = webPage.Animation.decodeJSON(r)
+			}
+		case "audio":
+			if r.readNull() {
+				webPage.Audio = nil
+			} else {
+				webPage.Audio = new(Audio)
+				err = webPage.Audio.decodeJSON(r)
+			}
+		case "document":
+			if r.readNull() {
+				webPage.Document = nil
+			} else {
+				webPage.Document = new(Document)
+				err = webPage.Document.decodeJSON(r)
+			}
+		case "sticker":
+			if r.readNull() {
+				webPage.Sticker = nil
+			} else {
+				webPage.Sticker = new(Sticker)
+				err = webPage.Sticker.decodeJSON(r)
+			}
+		case "video":
+			if r.readNull() {
+				webPage.Video = nil
+			} else {
+				webPage.Video = new(Video)
+				err = webPage.Video.decodeJSON(r)
+			}
+		case "video_note":
+			if r.readNull() {
+				webPage.VideoNote = nil
+			} else {
+				webPage.VideoNote = new(VideoNote)
+				err = webPage.VideoNote.decodeJSON(r)
+			}
+		case "voice_note":
+			if r.readNull() {
+				webPage.VoiceNote = nil
+			} else {
+				webPage.VoiceNote = new(VoiceNote)
+				err = webPage.VoiceNote.decodeJSON(r)
+			}
+		case "instant_view_version":
+			webPage.InstantViewVersion, err = r.readInt32()
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (webPage *WebPage) Clone() *WebPage {
+	if webPage == nil {
+		return nil
+	}
+	clone := *webPage
+	clone.Description = webPage.Description.Clone()
+	clone.Photo = webPage.Photo.Clone()
+	clone.Animation = webPage.Animation.Clone()
+	clone.Audio = webPage.Audio.Clone()
+	clone.Document = webPage.Document.Clone()
+	clone.Sticker = webPage.Sticker.Clone()
+	clone.Video = webPage.Video.Clone()
+	clone.VideoNote = webPage.VideoNote.Clone()
+	clone.VoiceNote = webPage.VoiceNote.Clone()
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (webPage *WebPage) Equal(other *WebPage) bool {
+	if webPage == nil || other == nil {
+		return webPage == other
+	}
+	if webPage.Url != other.Url {
+		return false
+	}
+	if webPage.DisplayUrl != other.DisplayUrl {
+		return false
+	}
+	if webPage.Type != other.Type {
+		return false
+	}
+	if webPage.SiteName != other.SiteName {
+		return false
+	}
+	if webPage.Title != other.Title {
+		return false
+	}
+	if !webPage.Description.Equal(other.Description) {
+		return false
+	}
+	if !webPage.Photo.Equal(other.Photo) {
+		return false
+	}
+	if webPage.EmbedUrl != other.EmbedUrl {
+		return false
+	}
+	if webPage.EmbedType != other.EmbedType {
+		return false
+	}
+	if webPage.EmbedWidth != other.EmbedWidth {
+		return false
+	}
+	if webPage.EmbedHeight != other.EmbedHeight {
+		return false
+	}
+	if webPage.Duration != other.Duration {
+		return false
+	}
+	if webPage.Author != other.Author {
+		return false
+	}
+	if !webPage.Animation.Equal(other.Animation) {
+		return false
+	}
+	if !webPage.Audio.Equal(other.Audio) {
+		return false
+	}
+	if !webPage.Document.Equal(other.Document) {
+		return false
+	}
+	if !webPage.Sticker.Equal(other.Sticker) {
+		return false
+	}
+	if !webPage.Video.Equal(other.Video) {
+		return false
+	}
+	if !webPage.VideoNote.Equal(other.VideoNote) {
+		return false
+	}
+	if !webPage.VoiceNote.Equal(other.VoiceNote) {
+		return false
+	}
+	if webPage.InstantViewVersion != other.InstantViewVersion {
+		return false
+	}
+	return true
+}
+
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (webPage *WebPage) Diff(other *WebPage) []Change {
+	return webPage.diff("", other, nil)
+}
+
+func (webPage *WebPage) diff(path string, other *WebPage, changes []Change) []Change {
+	if webPage == nil || other == nil {
+		if webPage != other {
+			changes = append(changes, Change{Path: path, Old: webPage, New: other})
+		}
+		return changes
+	}
+	if webPage.Url != other.Url {
+		changes = append(changes, Change{Path: fieldPath(path, "url"), Old: webPage.Url, New: other.Url})
+	}
+	if webPage.DisplayUrl != other.DisplayUrl {
+		changes = append(changes, Change{Path: fieldPath(path, "display_url"), Old: webPage.DisplayUrl, New: other.DisplayUrl})
+	}
+	if webPage.Type != other.Type {
+		changes = append(changes, Change{Path: fieldPath(path, "type"), Old: webPage.Type, New: other.Type})
+	}
+	if webPage.SiteName != other.SiteName {
+		changes = append(changes, Change{Path: fieldPath(path, "site_name"), Old: webPage.SiteName, New: other.SiteName})
+	}
+	if webPage.Title != other.Title {
+		changes = append(changes, Change{Path: fieldPath(path, "title"), Old: webPage.Title, New: other.Title})
+	}
+	changes = webPage.Description.diff(fieldPath(path, "description"), other.Description, changes)
+	changes = webPage.Photo.diff(fieldPath(path, "photo"), other.Photo, changes)
+	if webPage.EmbedUrl != other.EmbedUrl {
+		changes = append(changes, Change{Path: fieldPath(path, "embed_url"), Old: webPage.EmbedUrl, New: other.EmbedUrl})
+	}
+	if webPage.EmbedType != other.EmbedType {
+		changes = append(changes, Change{Path: fieldPath(path, "embed_type"), Old: webPage.EmbedType, New: other.EmbedType})
+	}
+	if webPage.EmbedWidth != other.EmbedWidth {
+		changes = append(changes, Change{Path: fieldPath(path, "embed_width"), Old: webPage.EmbedWidth, New: other.EmbedWidth})
+	}
+	if webPage.EmbedHeight != other.EmbedHeight {
+		changes = append(changes, Change{Path: fieldPath(path, "embed_height"), Old: webPage.EmbedHeight, New: other.EmbedHeight})
+	}
+	if webPage.Duration != other.Duration {
+		changes = append(changes, Change{Path: fieldPath(path, "duration"), Old: webPage.Duration, New: other.Duration})
+	}
+	if webPage.Author != other.Author {
+		changes = append(changes, Change{Path: fieldPath(path, "author"), Old: webPage.Author, New: other.Author})
+	}
+	changes = webPage.Animation.diff(fieldPath(path, "animation"), other.Animation, changes)
+	changes = webPage.Audio.diff(fieldPath(path, "audio"), other.Audio, changes)
+	changes = webPage.Document.diff(fieldPath(path, "document"), other.Document, changes)
+	changes = webPage.Sticker.diff(fieldPath(path, "sticker"), other.Sticker, changes)
+	changes = webPage.Video.diff(fieldPath(path, "video"), other.Video, changes)
+	changes = webPage.VideoNote.diff(fieldPath(path, "video_note"), other.VideoNote, changes)
+	changes = webPage.VoiceNote.diff(fieldPath(path, "voice_note"), other.VoiceNote, changes)
+	if webPage.InstantViewVersion != other.InstantViewVersion {
+		changes = append(changes, Change{Path: fieldPath(path, "instant_view_version"), Old: webPage.InstantViewVersion, New: other.InstantViewVersion})
+	}
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (webPage *WebPage) String() string {
+	return objectString(webPage)
+}
+
+// LogValue logs the object as its summary
+func (webPage *WebPage) LogValue() slog.Value {
+	return slog.StringValue(objectString(webPage))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (webPage *WebPage) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, webPage)
+}
+
+func (webPage *WebPage) printText(p *printer) {
+	if webPage == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("WebPage")
+	p.string("url", webPage.Url)
+	p.string("display_url", webPage.DisplayUrl)
+	p.string("type", webPage.Type)
+	p.string("site_name", webPage.SiteName)
+	p.string("title", webPage.Title)
+	p.object("description", webPage.Description)
+	p.object("photo", webPage.Photo)
+	p.string("embed_url", webPage.EmbedUrl)
+	p.string("embed_type", webPage.EmbedType)
+	p.int("embed_width", int64(webPage.EmbedWidth))
+	p.int("embed_height", int64(webPage.EmbedHeight))
+	p.int("duration", int64(webPage.Duration))
+	p.string("author", webPage.Author)
+	p.object("animation", webPage.Animation)
+	p.object("audio", webPage.Audio)
+	p.object("document", webPage.Document)
+	p.object("sticker", webPage.Sticker)
+	p.object("video", webPage.Video)
+	p.object("video_note", webPage.VideoNote)
+	p.object("voice_note", webPage.VoiceNote)
+	p.int("instant_view_version", int64(webPage.InstantViewVersion))
+	p.endObject(state)
+}
+
 // GetWebPagePreview Returns a web page preview by the text of the message. Do not call this function too often. Returns a 404 error if the web page has no preview
 // @param text Message text with formatting
 func (client *Client) GetWebPagePreview(text *FormattedText) (*WebPage, error) {
@@ -100,11 +486,11 @@ func (client *Client) GetWebPagePreview(text *FormattedText) (*WebPage, error) {
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var webPage WebPage
-	err = json.Unmarshal(result.Raw, &webPage)
+	err = jsonUnmarshal(result.Raw, &webPage)
 	return &webPage, err
 }
@@ -1,8 +1,8 @@
 package tdlib
 
 import (
-	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 // WebPageInstantView Describes an instant view page for a web page
@@ -43,38 +43,205 @@ func NewWebPageInstantView(pageBlocks []PageBlock, viewCount int32, version int3
 	return &webPageInstantViewTemp
 }
 
+// MarshalJSON marshals to json
+func (webPageInstantView *WebPageInstantView) MarshalJSON() ([]byte, error) {
+	return webPageInstantView.appendJSON(nil)
+}
+
 // UnmarshalJSON unmarshal to json
 func (webPageInstantView *WebPageInstantView) UnmarshalJSON(b []byte) error {
-	var objMap map[string]*json.RawMessage
-	err := json.Unmarshal(b, &objMap)
-	if err != nil {
-		return err
-	}
-	tempObj := struct {
-		tdCommon
-		PageBlocks []PageBlock `json:"page_blocks"` // Content of the web page
-		ViewCount  int32       `json:"view_count"`  // Number of the instant view views; 0 if unknown
-		Version    int32       `json:"version"`     // Version of the instant view; currently, can be 1 or 2
-		IsRtl      bool        `json:"is_rtl"`      // True, if the instant view must be shown from right to left
-		IsFull     bool        `json:"is_full"`     // True, if the instant view contains the full page. A network request might be needed to get the full web page instant view
-
-	}{}
-	err = json.Unmarshal(b, &tempObj)
+	return unmarshalFast(b, webPageInstantView)
+}
+
+func (webPageInstantView *WebPageInstantView) appendJSON(b []byte) ([]byte, error) {
+	if webPageInstantView == nil {
+		return append(b, "null"...), nil
+	}
+	var err error
+	b = append(b, `{"@type":`...)
+	b = appendJSONString(b, webPageInstantView.tdCommon.Type)
+	b = append(b, `,"@extra":`...)
+	b = appendJSONString(b, webPageInstantView.tdCommon.Extra)
+	b = append(b, `,"page_blocks":`...)
+	if webPageInstantView.PageBlocks == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i0 := range webPageInstantView.PageBlocks {
+			if i0 > 0 {
+				b = append(b, ',')
+			}
+			b, err = appendJSONValue(b, webPageInstantView.PageBlocks[i0])
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, `,"view_count":`...)
+	b = appendJSONInt(b, int64(webPageInstantView.ViewCount))
+	b = append(b, `,"version":`...)
+	b = appendJSONInt(b, int64(webPageInstantView.Version))
+	b = append(b, `,"is_rtl":`...)
+	b = appendJSONBool(b, webPageInstantView.IsRtl)
+	b = append(b, `,"is_full":`...)
+	b = appendJSONBool(b, webPageInstantView.IsFull)
+	b = append(b, `,"feedback_link":`...)
+	b, err = appendJSONValue(b, webPageInstantView.FeedbackLink)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return append(b, '}'), nil
+}
+
+func (webPageInstantView *WebPageInstantView) decodeJSON(r *jsonReader) error {
+	return r.readObject(func(key []byte) (err error) {
+		switch string(key) {
+		case "@type":
+			webPageInstantView.tdCommon.Type, err = r.readString()
+		case "@extra":
+			webPageInstantView.tdCommon.Extra, err = r.readString()
+		case "page_blocks":
+			if r.readNull() {
+				webPageInstantView.PageBlocks = nil
+			} else {
+				webPageInstantView.PageBlocks = make([]PageBlock, 0)
+				err = r.readArray(func() (err error) {
+					var item0 PageBlock
+					item0, err = decodePageBlock(r)
+					webPageInstantView.PageBlocks = append(webPageInstantView.PageBlocks, item0)
+					return
+				})
+			}
+		case "view_count":
+			webPageInstantView.ViewCount, err = r.readInt32()
+		case "version":
+			webPageInstantView.Version, err = r.readInt32()
+		case "is_rtl":
+			webPageInstantView.IsRtl, err = r.readBool()
+		case "is_full":
+			webPageInstantView.IsFull, err = r.readBool()
+		case "feedback_link":
+			webPageInstantView.FeedbackLink, err = decodeInternalLinkType(r)
+		default:
+			err = r.skip()
+		}
+		return
+	})
+}
+
+// Clone returns a deep copy of the object
+func (webPageInstantView *WebPageInstantView) Clone() *WebPageInstantView {
+	if webPageInstantView == nil {
+		return nil
+	}
+	clone := *webPageInstantView
+	if webPageInstantView.PageBlocks != nil {
+		clone.PageBlocks = make([]PageBlock, len(webPageInstantView.PageBlocks))
+		for i0 := range webPageInstantView.PageBlocks {
+			clone.PageBlocks[i0] = clonePageBlock(webPageInstantView.PageBlocks[i0])
+		}
+	}
+	clone.FeedbackLink = cloneInternalLinkType(webPageInstantView.FeedbackLink)
+	return &clone
+}
+
+// Equal reports whether the object is equal to other, following interface, pointer and vector fields and ignoring @extra
+func (webPageInstantView *WebPageInstantView) Equal(other *WebPageInstantView) bool {
+	if webPageInstantView == nil || other == nil {
+		return webPageInstantView == other
+	}
+	if len(webPageInstantView.PageBlocks) != len(other.PageBlocks) {
+		return false
+	}
+	for i0 := range webPageInstantView.PageBlocks {
+		if !equalPageBlock(webPageInstantView.PageBlocks[i0], other.PageBlocks[i0]) {
+			return false
+		}
+	}
+	if webPageInstantView.ViewCount != other.ViewCount {
+		return false
 	}
+	if webPageInstantView.Version != other.Version {
+		return false
+	}
+	if webPageInstantView.IsRtl != other.IsRtl {
+		return false
+	}
+	if webPageInstantView.IsFull != other.IsFull {
+		return false
+	}
+	if !equalInternalLinkType(webPageInstantView.FeedbackLink, other.FeedbackLink) {
+		return false
+	}
+	return true
+}
 
-	webPageInstantView.tdCommon = tempObj.tdCommon
-	webPageInstantView.PageBlocks = tempObj.PageBlocks
-	webPageInstantView.ViewCount = tempObj.ViewCount
-	webPageInstantView.Version = tempObj.Version
-	webPageInstantView.IsRtl = tempObj.IsRtl
-	webPageInstantView.IsFull = tempObj.IsFull
+// Diff returns the fields which changed from the object to other, with their JSON paths
+func (webPageInstantView *WebPageInstantView) Diff(other *WebPageInstantView) []Change {
+	return webPageInstantView.diff("", other, nil)
+}
 
-	fieldFeedbackLink, _ := unmarshalInternalLinkType(objMap["feedback_link"])
-	webPageInstantView.FeedbackLink = fieldFeedbackLink
+func (webPageInstantView *WebPageInstantView) diff(path string, other *WebPageInstantView, changes []Change) []Change {
+	if webPageInstantView == nil || other == nil {
+		if webPageInstantView != other {
+			changes = append(changes, Change{Path: path, Old: webPageInstantView, New: other})
+		}
+		return changes
+	}
+	if len(webPageInstantView.PageBlocks) != len(other.PageBlocks) {
+		changes = append(changes, Change{Path: fieldPath(path, "page_blocks"), Old: webPageInstantView.PageBlocks, New: other.PageBlocks})
+	} else {
+		for i0 := range webPageInstantView.PageBlocks {
+			changes = diffPageBlock(indexPath(fieldPath(path, "page_blocks"), i0), webPageInstantView.PageBlocks[i0], other.PageBlocks[i0], changes)
+		}
+	}
+	if webPageInstantView.ViewCount != other.ViewCount {
+		changes = append(changes, Change{Path: fieldPath(path, "view_count"), Old: webPageInstantView.ViewCount, New: other.ViewCount})
+	}
+	if webPageInstantView.Version != other.Version {
+		changes = append(changes, Change{Path: fieldPath(path, "version"), Old: webPageInstantView.Version, New: other.Version})
+	}
+	if webPageInstantView.IsRtl != other.IsRtl {
+		changes = append(changes, Change{Path: fieldPath(path, "is_rtl"), Old: webPageInstantView.IsRtl, New: other.IsRtl})
+	}
+	if webPageInstantView.IsFull != other.IsFull {
+		changes = append(changes, Change{Path: fieldPath(path, "is_full"), Old: webPageInstantView.IsFull, New: other.IsFull})
+	}
+	changes = diffInternalLinkType(fieldPath(path, "feedback_link"), webPageInstantView.FeedbackLink, other.FeedbackLink, changes)
+	return changes
+}
+
+// String returns a one-line summary of the object, with secrets and file paths redacted
+func (webPageInstantView *WebPageInstantView) String() string {
+	return objectString(webPageInstantView)
+}
+
+// LogValue logs the object as its summary
+func (webPageInstantView *WebPageInstantView) LogValue() slog.Value {
+	return slog.StringValue(objectString(webPageInstantView))
+}
+
+// Format formats the object: %v and %s print its summary, %+v all of its fields on multiple lines
+func (webPageInstantView *WebPageInstantView) Format(f fmt.State, verb rune) {
+	formatObject(f, verb, webPageInstantView)
+}
 
-	return nil
+func (webPageInstantView *WebPageInstantView) printText(p *printer) {
+	if webPageInstantView == nil {
+		p.null()
+		return
+	}
+	state := p.beginObject("WebPageInstantView")
+	p.vector("page_blocks", len(webPageInstantView.PageBlocks), func(i0 int) {
+		p.object("page_blocks", webPageInstantView.PageBlocks[i0])
+	})
+	p.int("view_count", int64(webPageInstantView.ViewCount))
+	p.int("version", int64(webPageInstantView.Version))
+	p.bool("is_rtl", webPageInstantView.IsRtl)
+	p.bool("is_full", webPageInstantView.IsFull)
+	p.object("feedback_link", webPageInstantView.FeedbackLink)
+	p.endObject(state)
 }
 
 // GetWebPageInstantView Returns an instant view version of a web page if available. Returns a 404 error if the web page has no instant view page
@@ -91,11 +258,11 @@ func (client *Client) GetWebPageInstantView(url string, forceFull bool) (*WebPag
 		return nil, err
 	}
 
-	if result.Data["@type"].(string) == "error" {
+	if result.Data["@type"] == "error" {
 		return nil, fmt.Errorf("error! code: %v msg: %s", result.Data["code"], result.Data["message"])
 	}
 
 	var webPageInstantView WebPageInstantView
-	err = json.Unmarshal(result.Raw, &webPageInstantView)
+	err = jsonUnmarshal(result.Raw, &webPageInstantView)
 	return &webPageInstantView, err
 }
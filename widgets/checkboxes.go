@@ -0,0 +1,126 @@
+package widgets
+
+import (
+	"fmt"
+	"sync"
+
+	tdlib "github.com/tasi788/go-tdlib"
+)
+
+// maxCheckboxes is the number of options of Checkboxes, as the selected ones are a bit set in a uint64
+const maxCheckboxes = 64
+
+// CheckboxesHandler handles the options selected in Checkboxes, by their index, when users are done selecting
+type CheckboxesHandler func(query *tdlib.CallbackQuery, selected []int) error
+
+// checkboxesData is the callback data of the buttons of Checkboxes
+type checkboxesData struct {
+	Done     bool
+	Toggle   uint8  // Index of the option a button toggles, unless it's the done button
+	Selected uint64 // Options selected when the checkboxes were rendered
+}
+
+// Checkboxes is a list of options users select any number of, with a button to toggle each option
+// and a button to submit the selection
+type Checkboxes struct {
+	widget
+	options []string
+	onDone  CheckboxesHandler
+
+	lock          sync.RWMutex
+	doneText      string
+	columns       int
+	checkedMark   string
+	uncheckedMark string
+}
+
+// NewCheckboxes creates checkboxes for up to 64 options, whose buttons are handled by router under the
+// callback prefix id
+func NewCheckboxes(router *tdlib.CallbackRouter, id string, options []string, doneText string, onDone CheckboxesHandler) (*Checkboxes, error) {
+	if len(options) > maxCheckboxes {
+		return nil, fmt.Errorf("checkboxes %q have %d options, at most %d are allowed", id, len(options), maxCheckboxes)
+	}
+	checkboxes := &Checkboxes{
+		widget:        widget{id: id, router: router},
+		options:       options,
+		onDone:        onDone,
+		doneText:      doneText,
+		columns:       1,
+		checkedMark:   "✅",
+		uncheckedMark: "⬜",
+	}
+	tdlib.HandleCallback(router, id, checkboxes.handle)
+	return checkboxes, nil
+}
+
+// SetColumns sets the number of options in a row, 1 by default
+func (checkboxes *Checkboxes) SetColumns(columns int) {
+	if columns < 1 {
+		columns = 1
+	}
+	checkboxes.lock.Lock()
+	defer checkboxes.lock.Unlock()
+	checkboxes.columns = columns
+}
+
+// SetMarks sets the marks shown before the selected and the other options
+func (checkboxes *Checkboxes) SetMarks(checkedMark string, uncheckedMark string) {
+	checkboxes.lock.Lock()
+	defer checkboxes.lock.Unlock()
+	checkboxes.checkedMark, checkboxes.uncheckedMark = checkedMark, uncheckedMark
+}
+
+// Render renders the checkboxes below a text, with the options at the indexes in selected selected
+func (checkboxes *Checkboxes) Render(message string, selected []int) (*View, error) {
+	var set uint64
+	for _, i := range selected {
+		if i < 0 || i >= len(checkboxes.options) {
+			return nil, fmt.Errorf("checkboxes %q have no option %d", checkboxes.id, i)
+		}
+		set |= 1 << i
+	}
+	replyMarkup, err := checkboxes.render(set)
+	if err != nil {
+		return nil, err
+	}
+	return &View{Text: text(message), Keyboard: replyMarkup}, nil
+}
+
+// render renders the keyboard with the options in set selected
+func (checkboxes *Checkboxes) render(set uint64) (*tdlib.ReplyMarkupInlineKeyboard, error) {
+	checkboxes.lock.RLock()
+	doneText, columns, checkedMark, uncheckedMark := checkboxes.doneText, checkboxes.columns, checkboxes.checkedMark, checkboxes.uncheckedMark
+	checkboxes.lock.RUnlock()
+
+	keyboard := checkboxes.keyboard().Columns(columns)
+	for i, option := range checkboxes.options {
+		mark := uncheckedMark
+		if set&(1<<i) != 0 {
+			mark = checkedMark
+		}
+		keyboard.Callback(mark+" "+option, checkboxes.id, checkboxesData{Toggle: uint8(i), Selected: set})
+	}
+	return keyboard.Row().Columns(0).Callback(doneText, checkboxes.id, checkboxesData{Done: true, Selected: set}).Build()
+}
+
+// handle handles the buttons of the checkboxes
+func (checkboxes *Checkboxes) handle(query *tdlib.CallbackQuery, data checkboxesData) error {
+	if data.Done {
+		var selected []int
+		for i := range checkboxes.options {
+			if data.Selected&(1<<i) != 0 {
+				selected = append(selected, i)
+			}
+		}
+		return checkboxes.onDone(query, selected)
+	}
+
+	if int(data.Toggle) >= len(checkboxes.options) {
+		return fmt.Errorf("checkboxes %q have no option %d", checkboxes.id, data.Toggle)
+	}
+	replyMarkup, err := checkboxes.render(data.Selected ^ 1<<data.Toggle)
+	if err != nil {
+		return err
+	}
+	return query.EditReplyMarkup(replyMarkup)
+}
@@ -0,0 +1,70 @@
+package widgets
+
+import (
+	"sync"
+
+	tdlib "github.com/tasi788/go-tdlib"
+)
+
+// ConfirmHandler handles the answer to a Confirm dialog about payload
+type ConfirmHandler func(query *tdlib.CallbackQuery, payload string, yes bool) error
+
+// confirmData is the callback data of the buttons of a Confirm dialog
+type confirmData struct {
+	Yes     bool
+	Payload string
+}
+
+// Confirm is a dialog asking users to confirm or cancel an action. A dialog can be shown for any number
+// of actions at once, which are told apart by the payload passed to the handler, e.g. the identifier of
+// the item to delete.
+type Confirm struct {
+	widget
+	onAnswer ConfirmHandler
+
+	lock    sync.RWMutex
+	yesText string
+	noText  string
+}
+
+// NewConfirm creates a dialog with buttons to confirm or cancel, whose buttons are handled by router under
+// the callback prefix id
+func NewConfirm(router *tdlib.CallbackRouter, id string, yesText string, noText string, onAnswer ConfirmHandler) *Confirm {
+	confirm := &Confirm{
+		widget:   widget{id: id, router: router},
+		onAnswer: onAnswer,
+		yesText:  yesText,
+		noText:   noText,
+	}
+	tdlib.HandleCallback(router, id, confirm.handle)
+	return confirm
+}
+
+// SetTexts sets the texts of the buttons to confirm and to cancel
+func (confirm *Confirm) SetTexts(yesText string, noText string) {
+	confirm.lock.Lock()
+	defer confirm.lock.Unlock()
+	confirm.yesText, confirm.noText = yesText, noText
+}
+
+// Render renders the dialog asking question about the action identified by payload. Long payloads need
+// a codec with a CallbackStore.
+func (confirm *Confirm) Render(question string, payload string) (*View, error) {
+	confirm.lock.RLock()
+	yesText, noText := confirm.yesText, confirm.noText
+	confirm.lock.RUnlock()
+
+	replyMarkup, err := confirm.keyboard().
+		Callback(yesText, confirm.id, confirmData{Yes: true, Payload: payload}).
+		Callback(noText, confirm.id, confirmData{Payload: payload}).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+	return &View{Text: text(question), Keyboard: replyMarkup}, nil
+}
+
+// handle handles the buttons of the dialog
+func (confirm *Confirm) handle(query *tdlib.CallbackQuery, data confirmData) error {
+	return confirm.onAnswer(query, data.Payload, data.Yes)
+}
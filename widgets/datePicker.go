@@ -0,0 +1,292 @@
+package widgets
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	tdlib "github.com/tasi788/go-tdlib"
+)
+
+// DatePickHandler handles the time picked with a DatePicker; midnight of the day if it only picks dates
+type DatePickHandler func(query *tdlib.CallbackQuery, picked time.Time) error
+
+// datePickerData is the callback data of the buttons of a DatePicker
+type datePickerData struct {
+	Action uint8
+	Year   int32
+	Month  uint8
+	Day    uint8
+	Hour   uint8
+	Minute uint8
+}
+
+// Actions of the buttons of a DatePicker
+const (
+	dateNoop   = iota
+	dateMonth  // shows the days of a month
+	dateDay    // picks a day
+	dateHour   // picks an hour of a day
+	dateMinute // picks a minute of an hour
+)
+
+// datePickerSettings is the settings of a DatePicker
+type datePickerSettings struct {
+	location   *time.Location
+	minuteStep int
+	min        time.Time
+	max        time.Time
+	months     [12]string
+	weekdays   [7]string
+	prevText   string
+	nextText   string
+	backText   string
+}
+
+// DatePicker is a calendar users pick a day from, month by month, and then the hour and the minute
+// if it picks times too. The message text stays while the calendar changes.
+type DatePicker struct {
+	widget
+	withTime bool
+	onPick   DatePickHandler
+
+	lock     sync.RWMutex
+	settings datePickerSettings
+}
+
+// NewDatePicker creates a date picker, or a date and time picker if withTime is set, whose buttons are handled
+// by router under the callback prefix id
+func NewDatePicker(router *tdlib.CallbackRouter, id string, withTime bool, onPick DatePickHandler) *DatePicker {
+	picker := &DatePicker{
+		widget:   widget{id: id, router: router},
+		withTime: withTime,
+		onPick:   onPick,
+		settings: datePickerSettings{
+			location:   time.UTC,
+			minuteStep: 5,
+			weekdays:   [7]string{"Mo", "Tu", "We", "Th", "Fr", "Sa", "Su"},
+			prevText:   "«",
+			nextText:   "»",
+			backText:   "« Back",
+		},
+	}
+	for i := range picker.settings.months {
+		picker.settings.months[i] = time.Month(i + 1).String()
+	}
+	tdlib.HandleCallback(router, id, picker.handle)
+	return picker
+}
+
+// SetLocation sets the time zone of the picked times, UTC by default
+func (picker *DatePicker) SetLocation(location *time.Location) {
+	picker.lock.Lock()
+	defer picker.lock.Unlock()
+	picker.settings.location = location
+}
+
+// SetMinuteStep sets the minutes between the minutes users can pick, 5 by default
+func (picker *DatePicker) SetMinuteStep(minuteStep int) {
+	if minuteStep < 1 || minuteStep > 60 {
+		minuteStep = 60
+	}
+	picker.lock.Lock()
+	defer picker.lock.Unlock()
+	picker.settings.minuteStep = minuteStep
+}
+
+// SetRange limits the times users can pick to the ones between min and max, unless they're zero
+func (picker *DatePicker) SetRange(min time.Time, max time.Time) {
+	picker.lock.Lock()
+	defer picker.lock.Unlock()
+	picker.settings.min, picker.settings.max = min, max
+}
+
+// SetLabels sets the names of the months, and of the weekdays starting with Monday
+func (picker *DatePicker) SetLabels(months [12]string, weekdays [7]string) {
+	picker.lock.Lock()
+	defer picker.lock.Unlock()
+	picker.settings.months, picker.settings.weekdays = months, weekdays
+}
+
+// SetTexts sets the texts of the buttons to the previous and the next month, and back to the previous step
+func (picker *DatePicker) SetTexts(prevText string, nextText string, backText string) {
+	picker.lock.Lock()
+	defer picker.lock.Unlock()
+	picker.settings.prevText, picker.settings.nextText, picker.settings.backText = prevText, nextText, backText
+}
+
+// currentSettings returns a copy of the settings of the picker
+func (picker *DatePicker) currentSettings() datePickerSettings {
+	picker.lock.RLock()
+	defer picker.lock.RUnlock()
+	return picker.settings
+}
+
+// allows reports whether the times from start until end are in the range of the picker
+func (settings *datePickerSettings) allows(start time.Time, end time.Time) bool {
+	return (settings.min.IsZero() || end.After(settings.min)) && (settings.max.IsZero() || !start.After(settings.max))
+}
+
+// Render renders the calendar of the month of the given time below a text; the current month if it's zero
+func (picker *DatePicker) Render(message string, month time.Time) (*View, error) {
+	settings := picker.currentSettings()
+	if month.IsZero() {
+		month = time.Now()
+	}
+	month = month.In(settings.location)
+	replyMarkup, err := picker.renderMonth(&settings, month.Year(), month.Month())
+	if err != nil {
+		return nil, err
+	}
+	return &View{Text: text(message), Keyboard: replyMarkup}, nil
+}
+
+// renderMonth renders the days of a month
+func (picker *DatePicker) renderMonth(settings *datePickerSettings, year int, month time.Month) (*tdlib.ReplyMarkupInlineKeyboard, error) {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, settings.location)
+	next := first.AddDate(0, 1, 0)
+	prev := first.AddDate(0, -1, 0)
+
+	keyboard := picker.keyboard()
+	if settings.allows(prev, first) {
+		keyboard.Callback(settings.prevText, picker.id, datePickerData{Action: dateMonth, Year: int32(prev.Year()), Month: uint8(prev.Month())})
+	}
+	keyboard.Callback(fmt.Sprintf("%s %d", settings.months[month-1], year), picker.id, datePickerData{Action: dateNoop})
+	if settings.allows(next, next.AddDate(0, 1, 0)) {
+		keyboard.Callback(settings.nextText, picker.id, datePickerData{Action: dateMonth, Year: int32(next.Year()), Month: uint8(next.Month())})
+	}
+
+	keyboard.Row().Columns(7)
+	for _, weekday := range settings.weekdays {
+		keyboard.Callback(weekday, picker.id, datePickerData{Action: dateNoop})
+	}
+	cells := (int(first.Weekday()) + 6) % 7
+	for i := 0; i < cells; i++ {
+		keyboard.Callback(" ", picker.id, datePickerData{Action: dateNoop})
+	}
+	for day := first; day.Before(next); day = day.AddDate(0, 0, 1) {
+		if settings.allows(day, day.AddDate(0, 0, 1)) {
+			keyboard.Callback(fmt.Sprint(day.Day()), picker.id, datePickerData{Action: dateDay, Year: int32(year), Month: uint8(month), Day: uint8(day.Day())})
+		} else {
+			keyboard.Callback("·", picker.id, datePickerData{Action: dateNoop})
+		}
+		cells++
+	}
+	for ; cells%7 != 0; cells++ {
+		keyboard.Callback(" ", picker.id, datePickerData{Action: dateNoop})
+	}
+	return keyboard.Build()
+}
+
+// renderHours renders the hours of a day
+func (picker *DatePicker) renderHours(settings *datePickerSettings, data datePickerData) (*tdlib.ReplyMarkupInlineKeyboard, error) {
+	day := time.Date(int(data.Year), time.Month(data.Month), int(data.Day), 0, 0, 0, 0, settings.location)
+	keyboard := picker.keyboard().
+		Callback(fmt.Sprintf("%d %s %d", day.Day(), settings.months[day.Month()-1], day.Year()), picker.id, datePickerData{Action: dateNoop}).
+		Row().Columns(6)
+	for hour := 0; hour < 24; hour++ {
+		start := day.Add(time.Duration(hour) * time.Hour)
+		if settings.allows(start, start.Add(time.Hour)) {
+			data.Hour = uint8(hour)
+			data.Action = dateHour
+			keyboard.Callback(fmt.Sprintf("%02d", hour), picker.id, data)
+		} else {
+			keyboard.Callback("·", picker.id, datePickerData{Action: dateNoop})
+		}
+	}
+	return keyboard.Row().Columns(0).
+		Callback(settings.backText, picker.id, datePickerData{Action: dateMonth, Year: data.Year, Month: data.Month}).
+		Build()
+}
+
+// renderMinutes renders the minutes of an hour
+func (picker *DatePicker) renderMinutes(settings *datePickerSettings, data datePickerData) (*tdlib.ReplyMarkupInlineKeyboard, error) {
+	hour := time.Date(int(data.Year), time.Month(data.Month), int(data.Day), int(data.Hour), 0, 0, 0, settings.location)
+	keyboard := picker.keyboard().
+		Callback(fmt.Sprintf("%d %s %d %02d:__", hour.Day(), settings.months[hour.Month()-1], hour.Year(), data.Hour), picker.id, datePickerData{Action: dateNoop}).
+		Row().Columns(4)
+	step := time.Duration(settings.minuteStep) * time.Minute
+	for minute := 0; minute < 60; minute += settings.minuteStep {
+		start := hour.Add(time.Duration(minute) * time.Minute)
+		if settings.allows(start, start.Add(step)) {
+			data.Minute = uint8(minute)
+			data.Action = dateMinute
+			keyboard.Callback(fmt.Sprintf("%02d:%02d", data.Hour, minute), picker.id, data)
+		} else {
+			keyboard.Callback("·", picker.id, datePickerData{Action: dateNoop})
+		}
+	}
+	return keyboard.Row().Columns(0).
+		Callback(settings.backText, picker.id, datePickerData{Action: dateDay, Year: data.Year, Month: data.Month, Day: data.Day}).
+		Build()
+}
+
+// handle handles the buttons of the picker
+func (picker *DatePicker) handle(query *tdlib.CallbackQuery, data datePickerData) error {
+	settings := picker.currentSettings()
+	if data.Action != dateNoop && (data.Month < 1 || data.Month > 12) {
+		return fmt.Errorf("date picker %q has no month %d", picker.id, data.Month)
+	}
+
+	// callback data may be forged, so days, hours and minutes are checked before they're shown or picked
+	var start time.Time
+	if data.Action == dateDay || data.Action == dateHour || data.Action == dateMinute {
+		var err error
+		if start, err = picker.start(&settings, data); err != nil {
+			return err
+		}
+	}
+
+	var replyMarkup *tdlib.ReplyMarkupInlineKeyboard
+	var err error
+	switch data.Action {
+	case dateMonth:
+		replyMarkup, err = picker.renderMonth(&settings, int(data.Year), time.Month(data.Month))
+	case dateDay:
+		if !picker.withTime {
+			return picker.onPick(query, start)
+		}
+		replyMarkup, err = picker.renderHours(&settings, data)
+	case dateHour:
+		replyMarkup, err = picker.renderMinutes(&settings, data)
+	case dateMinute:
+		return picker.onPick(query, start)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return query.EditReplyMarkup(replyMarkup)
+}
+
+// start returns the start of the day, hour or minute of the button data, or an error if the picker doesn't show
+// it, e.g. for 31 February or a day out of its range
+func (picker *DatePicker) start(settings *datePickerSettings, data datePickerData) (time.Time, error) {
+	year, month := int(data.Year), time.Month(data.Month)
+	days := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if data.Day < 1 || int(data.Day) > days {
+		return time.Time{}, fmt.Errorf("date picker %q has no day %d in %s %d", picker.id, data.Day, month, year)
+	}
+
+	start := time.Date(year, month, int(data.Day), 0, 0, 0, 0, settings.location)
+	end := start.AddDate(0, 0, 1)
+	if data.Action == dateHour || data.Action == dateMinute {
+		if data.Hour > 23 {
+			return time.Time{}, fmt.Errorf("date picker %q has no hour %d", picker.id, data.Hour)
+		}
+		start = start.Add(time.Duration(data.Hour) * time.Hour)
+		end = start.Add(time.Hour)
+	}
+	if data.Action == dateMinute {
+		if data.Minute > 59 {
+			return time.Time{}, fmt.Errorf("date picker %q has no minute %d", picker.id, data.Minute)
+		}
+		start = start.Add(time.Duration(data.Minute) * time.Minute)
+		end = start.Add(time.Duration(settings.minuteStep) * time.Minute)
+	}
+	if !settings.allows(start, end) {
+		return time.Time{}, fmt.Errorf("date picker %q doesn't allow %s", picker.id, start)
+	}
+	return start, nil
+}
@@ -0,0 +1,79 @@
+package widgets
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	tdlib "github.com/tasi788/go-tdlib"
+)
+
+func TestDatePickerForgedData(t *testing.T) {
+	codec := tdlib.NewCallbackCodec([]byte("key"), nil)
+	router := tdlib.NewCallbackRouter(tdlib.NewClientWithTransport(tdlib.Config{}, newFakeTransport()), codec)
+	var picked []time.Time
+	picker := NewDatePicker(router, "date", true, func(query *tdlib.CallbackQuery, at time.Time) error {
+		picked = append(picked, at)
+		return nil
+	})
+	picker.SetRange(time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC), time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name   string
+		data   datePickerData
+		picked bool
+	}{
+		{"minute", datePickerData{Action: dateMinute, Year: 2024, Month: 2, Day: 29, Hour: 12, Minute: 30}, true},
+		{"31 February", datePickerData{Action: dateMinute, Year: 2024, Month: 2, Day: 31, Hour: 12, Minute: 30}, false},
+		{"29 February of a common year", datePickerData{Action: dateMinute, Year: 2025, Month: 2, Day: 29}, false},
+		{"day 0", datePickerData{Action: dateMinute, Year: 2024, Month: 2, Day: 0, Hour: 12}, false},
+		{"hour 24", datePickerData{Action: dateMinute, Year: 2024, Month: 2, Day: 1, Hour: 24}, false},
+		{"minute 60", datePickerData{Action: dateMinute, Year: 2024, Month: 2, Day: 1, Hour: 12, Minute: 60}, false},
+		{"before the range", datePickerData{Action: dateMinute, Year: 2024, Month: 1, Day: 9, Hour: 12}, false},
+		{"after the range", datePickerData{Action: dateMinute, Year: 2025, Month: 1, Day: 1, Hour: 12}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			picked = nil
+			data, err := codec.Encode("date", test.data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			router.HandleUpdate(context.Background(), &tdlib.UpdateNewCallbackQuery{Id: 1, SenderUserId: 2, ChatId: 3, MessageId: 4, Payload: tdlib.NewCallbackQueryPayloadData(data)})
+
+			want := time.Date(int(test.data.Year), time.Month(test.data.Month), int(test.data.Day), int(test.data.Hour), int(test.data.Minute), 0, 0, time.UTC)
+			if test.picked && (len(picked) != 1 || !picked[0].Equal(want)) {
+				t.Errorf("picked %v, want %v", picked, want)
+			}
+			if !test.picked && len(picked) != 0 {
+				t.Errorf("picked %v, want nothing", picked)
+			}
+		})
+	}
+}
+
+func TestDatePickerForgedDay(t *testing.T) {
+	codec := tdlib.NewCallbackCodec([]byte("key"), nil)
+	transport := newFakeTransport()
+	router := tdlib.NewCallbackRouter(tdlib.NewClientWithTransport(tdlib.Config{}, transport), codec)
+	var picked []time.Time
+	NewDatePicker(router, "date", false, func(query *tdlib.CallbackQuery, at time.Time) error {
+		picked = append(picked, at)
+		return nil
+	})
+
+	for _, data := range []datePickerData{
+		{Action: dateDay, Year: 2023, Month: 2, Day: 29},
+		{Action: dateDay, Year: 2023, Month: 4, Day: 31},
+		{Action: dateDay, Year: 2023, Month: 13, Day: 1},
+	} {
+		encoded, err := codec.Encode("date", data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		router.HandleUpdate(context.Background(), &tdlib.UpdateNewCallbackQuery{Id: 1, SenderUserId: 2, ChatId: 3, MessageId: 4, Payload: tdlib.NewCallbackQueryPayloadData(encoded)})
+	}
+	if len(picked) != 0 {
+		t.Errorf("picked %v, want nothing", picked)
+	}
+}
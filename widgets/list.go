@@ -0,0 +1,207 @@
+package widgets
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sync"
+
+	tdlib "github.com/tasi788/go-tdlib"
+)
+
+// ListItem is an item of a List
+type ListItem struct {
+	Text  string // Text of the button of the item
+	Value string // Value identifying the item, e.g. to the ListSelectHandler
+}
+
+// ListSource returns up to limit items of a list, starting with the item at offset, and the number of items in the list
+type ListSource func(ctx context.Context, offset int, limit int) (items []ListItem, total int, err error)
+
+// ListItems returns a source of items which are known beforehand
+func ListItems(items ...ListItem) ListSource {
+	return func(ctx context.Context, offset int, limit int) ([]ListItem, int, error) {
+		if offset >= len(items) {
+			return nil, len(items), nil
+		}
+		end := offset + limit
+		if end > len(items) {
+			end = len(items)
+		}
+		return items[offset:end], len(items), nil
+	}
+}
+
+// ListSelectHandler handles an item selected from a List
+type ListSelectHandler func(query *tdlib.CallbackQuery, item ListItem) error
+
+// listData is the callback data of the buttons of a List
+type listData struct {
+	Action uint8
+	Page   int32  // Page shown by a listPage button
+	Offset int32  // Offset of the item of a listSelect button
+	Hash   uint32 // Hash of the value of the item of a listSelect button, see listItemHash
+}
+
+// Actions of the buttons of a List
+const (
+	listNoop = iota
+	listPage
+	listSelect
+)
+
+// List is a list of items shown a page at a time, with buttons to the previous and the next page.
+// The items are fetched from the source again for every page, so they may change while the list is shown:
+// an item is only selected if the source still has it at the position of its button, otherwise the user is
+// told the list changed and is shown its current page.
+type List struct {
+	widget
+	title    string
+	source   ListSource
+	onSelect ListSelectHandler
+
+	lock        sync.RWMutex
+	pageSize    int
+	prevText    string
+	nextText    string
+	emptyText   string
+	changedText string
+}
+
+// NewList creates a list whose buttons are handled by router under the callback prefix id. onSelect handles
+// the items users select, unless it's nil.
+func NewList(router *tdlib.CallbackRouter, id string, title string, source ListSource, onSelect ListSelectHandler) *List {
+	list := &List{
+		widget:      widget{id: id, router: router},
+		title:       title,
+		source:      source,
+		onSelect:    onSelect,
+		pageSize:    5,
+		prevText:    "◀",
+		nextText:    "▶",
+		emptyText:   "No items",
+		changedText: "The list changed, please choose again",
+	}
+	tdlib.HandleCallback(router, id, list.handle)
+	return list
+}
+
+// SetPageSize sets the number of items shown at once, 5 by default
+func (list *List) SetPageSize(pageSize int) {
+	if pageSize < 1 {
+		pageSize = 1
+	}
+	list.lock.Lock()
+	defer list.lock.Unlock()
+	list.pageSize = pageSize
+}
+
+// SetTexts sets the texts of the buttons to the previous and the next page, and of the button shown
+// when the list is empty
+func (list *List) SetTexts(prevText string, nextText string, emptyText string) {
+	list.lock.Lock()
+	defer list.lock.Unlock()
+	list.prevText, list.nextText, list.emptyText = prevText, nextText, emptyText
+}
+
+// SetChangedText sets the notification shown when the selected item is no longer at the position of its button
+func (list *List) SetChangedText(text string) {
+	list.lock.Lock()
+	defer list.lock.Unlock()
+	list.changedText = text
+}
+
+// Render renders a page of the list, counting from 0. Pages past the end show the last page.
+func (list *List) Render(ctx context.Context, page int) (*View, error) {
+	list.lock.RLock()
+	pageSize, prevText, nextText, emptyText := list.pageSize, list.prevText, list.nextText, list.emptyText
+	list.lock.RUnlock()
+
+	if page < 0 {
+		page = 0
+	}
+	items, total, err := list.source(ctx, page*pageSize, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	pages := (total + pageSize - 1) / pageSize
+	if pages == 0 {
+		pages = 1
+	}
+	if page >= pages {
+		page = pages - 1
+		if items, total, err = list.source(ctx, page*pageSize, pageSize); err != nil {
+			return nil, err
+		}
+	}
+
+	keyboard := list.keyboard().Columns(1)
+	for i, item := range items {
+		keyboard.Callback(item.Text, list.id, listData{Action: listSelect, Offset: int32(page*pageSize + i), Hash: listItemHash(item)})
+	}
+	if len(items) == 0 {
+		keyboard.Callback(emptyText, list.id, listData{Action: listNoop})
+	}
+	title := list.title
+	if pages > 1 {
+		title = fmt.Sprintf("%s (%d/%d)", list.title, page+1, pages)
+		keyboard.Row().Columns(0)
+		if page > 0 {
+			keyboard.Callback(prevText, list.id, listData{Action: listPage, Page: int32(page - 1)})
+		}
+		keyboard.Callback(fmt.Sprintf("%d/%d", page+1, pages), list.id, listData{Action: listNoop})
+		if page < pages-1 {
+			keyboard.Callback(nextText, list.id, listData{Action: listPage, Page: int32(page + 1)})
+		}
+	}
+
+	replyMarkup, err := keyboard.Build()
+	if err != nil {
+		return nil, err
+	}
+	return &View{Text: text(title), Keyboard: replyMarkup}, nil
+}
+
+// handle handles the buttons of the list
+func (list *List) handle(query *tdlib.CallbackQuery, data listData) error {
+	switch data.Action {
+	case listPage:
+		view, err := list.Render(query.Context(), int(data.Page))
+		if err != nil {
+			return err
+		}
+		return view.Edit(query)
+	case listSelect:
+		items, _, err := list.source(query.Context(), int(data.Offset), 1)
+		if err != nil {
+			return err
+		}
+		if len(items) == 0 || listItemHash(items[0]) != data.Hash {
+			// the list changed since it was rendered, show the page of the position of the button
+			list.lock.RLock()
+			pageSize, changedText := list.pageSize, list.changedText
+			list.lock.RUnlock()
+
+			answerErr := query.Answer(changedText, false, "")
+			view, err := list.Render(query.Context(), int(data.Offset)/pageSize)
+			if err != nil {
+				return err
+			}
+			if err := view.Edit(query); err != nil {
+				return err
+			}
+			return answerErr
+		}
+		if list.onSelect != nil {
+			return list.onSelect(query, items[0])
+		}
+	}
+	return nil
+}
+
+// listItemHash returns a short hash of the value of an item, which fits the callback data of its button
+func listItemHash(item ListItem) uint32 {
+	hash := fnv.New32a()
+	hash.Write([]byte(item.Value))
+	return hash.Sum32()
+}
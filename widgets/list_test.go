@@ -0,0 +1,119 @@
+package widgets
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	tdlib "github.com/tasi788/go-tdlib"
+)
+
+// fakeTransport is a Transport answering requests without TDLib, for tests. Edits are answered with a message,
+// other requests with ok.
+type fakeTransport struct {
+	lock     sync.Mutex
+	requests []map[string]interface{}
+	received chan []byte
+}
+
+func newFakeTransport() *fakeTransport {
+	return &fakeTransport{received: make(chan []byte, 100)}
+}
+
+func (transport *fakeTransport) Send(query []byte) {
+	var request map[string]interface{}
+	if err := json.Unmarshal(query, &request); err != nil {
+		panic(err)
+	}
+	transport.lock.Lock()
+	transport.requests = append(transport.requests, request)
+	transport.lock.Unlock()
+
+	response := map[string]interface{}{"@type": "ok", "@extra": request["@extra"]}
+	if request["@type"] == "editMessageText" {
+		response = map[string]interface{}{"@type": "message", "@extra": request["@extra"], "id": request["message_id"], "chat_id": request["chat_id"]}
+	}
+	data, err := json.Marshal(response)
+	if err != nil {
+		panic(err)
+	}
+	transport.received <- data
+}
+
+func (transport *fakeTransport) Receive(timeout float64) []byte {
+	select {
+	case received := <-transport.received:
+		return received
+	case <-time.After(time.Duration(timeout * float64(time.Second))):
+		return nil
+	}
+}
+
+func (transport *fakeTransport) Destroy() {}
+
+// sent returns the requests of a method sent so far
+func (transport *fakeTransport) sent(method string) []map[string]interface{} {
+	transport.lock.Lock()
+	defer transport.lock.Unlock()
+
+	var requests []map[string]interface{}
+	for _, request := range transport.requests {
+		if request["@type"] == method {
+			requests = append(requests, request)
+		}
+	}
+	return requests
+}
+
+// press returns the update of pressing the callback button of a view in row
+func press(t *testing.T, view *View, row int) *tdlib.UpdateNewCallbackQuery {
+	button, ok := view.Keyboard.Rows[row][0].Type.(*tdlib.InlineKeyboardButtonTypeCallback)
+	if !ok {
+		t.Fatalf("button %d isn't a callback button", row)
+	}
+	return &tdlib.UpdateNewCallbackQuery{Id: 1, SenderUserId: 2, ChatId: 3, MessageId: 4, Payload: tdlib.NewCallbackQueryPayloadData(button.Data)}
+}
+
+func TestListSelect(t *testing.T) {
+	var lock sync.Mutex
+	source := []ListItem{{Text: "A", Value: "a"}, {Text: "B", Value: "b"}, {Text: "C", Value: "c"}}
+	transport := newFakeTransport()
+	router := tdlib.NewCallbackRouter(tdlib.NewClientWithTransport(tdlib.Config{}, transport), tdlib.NewCallbackCodec([]byte("key"), nil))
+	var selected []string
+	list := NewList(router, "list", "Items", func(ctx context.Context, offset int, limit int) ([]ListItem, int, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		return ListItems(source...)(ctx, offset, limit)
+	}, func(query *tdlib.CallbackQuery, item ListItem) error {
+		selected = append(selected, item.Value)
+		return nil
+	})
+	list.SetChangedText("changed")
+
+	view, err := list.Render(context.Background(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	router.HandleUpdate(context.Background(), press(t, view, 1))
+	if len(selected) != 1 || selected[0] != "b" {
+		t.Errorf("selected %v, want b", selected)
+	}
+
+	// the first item is removed, so b is no longer at the position of its button
+	lock.Lock()
+	source = source[1:]
+	lock.Unlock()
+	router.HandleUpdate(context.Background(), press(t, view, 1))
+	if len(selected) != 1 {
+		t.Errorf("selected %v after the list changed, want only b", selected)
+	}
+	answers := transport.sent("answerCallbackQuery")
+	if len(answers) != 2 || answers[1]["text"] != "changed" {
+		t.Errorf("answers are %v, want the changed text", answers)
+	}
+	if edits := transport.sent("editMessageText"); len(edits) != 1 {
+		t.Errorf("%d edits were sent, want the list to be rendered again", len(edits))
+	}
+}
@@ -0,0 +1,124 @@
+package widgets
+
+import (
+	"fmt"
+	"sync"
+
+	tdlib "github.com/tasi788/go-tdlib"
+)
+
+// MenuItem is an item of a Menu: a submenu if it has items, or an action otherwise
+type MenuItem struct {
+	Text    string                                 // Text of the button of the item
+	Title   string                                 // Text of the message while the submenu is open; Text if empty
+	Items   []*MenuItem                            // Items of the submenu
+	Columns int                                    // Number of buttons in a row of the submenu; 1 if 0
+	Action  func(query *tdlib.CallbackQuery) error // Action run when the item is pressed, unless it's a submenu
+}
+
+// title returns the text of the message while the submenu of the item is open
+func (item *MenuItem) title() string {
+	if item.Title != "" {
+		return item.Title
+	}
+	return item.Text
+}
+
+// menuData is the callback data of the buttons of a Menu, the indexes of the items leading to the pressed item
+type menuData struct {
+	Path []byte
+}
+
+// Menu is a tree of items users navigate with buttons: submenus open when pressed, and have a button back
+// to their parent. The tree must not be changed while it's shown, since buttons refer to items by position.
+type Menu struct {
+	widget
+	root *MenuItem
+
+	lock     sync.RWMutex
+	backText string
+}
+
+// NewMenu creates a menu of the items of root, whose buttons are handled by router under the callback prefix id
+func NewMenu(router *tdlib.CallbackRouter, id string, root *MenuItem) *Menu {
+	menu := &Menu{
+		widget:   widget{id: id, router: router},
+		root:     root,
+		backText: "« Back",
+	}
+	tdlib.HandleCallback(router, id, menu.handle)
+	return menu
+}
+
+// SetBackText sets the text of the buttons back to the parent of a submenu
+func (menu *Menu) SetBackText(backText string) {
+	menu.lock.Lock()
+	defer menu.lock.Unlock()
+	menu.backText = backText
+}
+
+// Render renders the menu with the root open
+func (menu *Menu) Render() (*View, error) {
+	return menu.render(nil)
+}
+
+// render renders the menu with the submenu at path open
+func (menu *Menu) render(path []byte) (*View, error) {
+	menu.lock.RLock()
+	backText := menu.backText
+	menu.lock.RUnlock()
+
+	item, err := menu.item(path)
+	if err != nil {
+		return nil, err
+	}
+	columns := item.Columns
+	if columns == 0 {
+		columns = 1
+	}
+
+	keyboard := menu.keyboard().Columns(columns)
+	for i, child := range item.Items {
+		keyboard.Callback(child.Text, menu.id, menuData{Path: append(path[:len(path):len(path)], byte(i))})
+	}
+	if len(path) != 0 {
+		keyboard.Row().Columns(0).Callback(backText, menu.id, menuData{Path: path[:len(path)-1]})
+	}
+
+	replyMarkup, err := keyboard.Build()
+	if err != nil {
+		return nil, err
+	}
+	return &View{Text: text(item.title()), Keyboard: replyMarkup}, nil
+}
+
+// item returns the item at path
+func (menu *Menu) item(path []byte) (*MenuItem, error) {
+	item := menu.root
+	for _, i := range path {
+		if int(i) >= len(item.Items) {
+			return nil, fmt.Errorf("menu %q has no item at %v", menu.id, path)
+		}
+		item = item.Items[i]
+	}
+	return item, nil
+}
+
+// handle handles the buttons of the menu
+func (menu *Menu) handle(query *tdlib.CallbackQuery, data menuData) error {
+	item, err := menu.item(data.Path)
+	if err != nil {
+		return err
+	}
+	if len(item.Items) == 0 {
+		if item.Action == nil {
+			return nil
+		}
+		return item.Action(query)
+	}
+	view, err := menu.render(data.Path)
+	if err != nil {
+		return err
+	}
+	return view.Edit(query)
+}
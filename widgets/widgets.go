@@ -0,0 +1,63 @@
+// Package widgets provides inline keyboard components for bots: paginated lists, menus, date pickers,
+// confirmation dialogs and checkboxes.
+//
+// Widgets render themselves as a View, a text and an inline keyboard to send, and handle the callback
+// queries of their buttons with a tdlib.CallbackRouter, editing the message they were sent in. The state of
+// a widget, e.g. the page of a list or the selected checkboxes, is encoded in the callback data of its
+// buttons, so widgets keep working across restarts and can be sent to any number of chats at once.
+//
+//	router := tdlib.NewCallbackRouter(client, tdlib.NewCallbackCodec(key, nil))
+//	confirm := widgets.NewConfirm(router, "delete", "Delete", "Cancel",
+//		func(query *tdlib.CallbackQuery, payload string, yes bool) error {
+//			...
+//		})
+//	go router.Run(ctx)
+//
+//	view, err := confirm.Render("Delete the note?", noteID)
+//	...
+//	_, err = view.Send(ctx, client, chatID)
+package widgets
+
+import (
+	"context"
+
+	tdlib "github.com/tasi788/go-tdlib"
+)
+
+// View is the text and the keyboard of a widget
+type View struct {
+	Text     *tdlib.FormattedText
+	Keyboard *tdlib.ReplyMarkupInlineKeyboard
+}
+
+// Send sends the view to a chat
+func (view *View) Send(ctx context.Context, client *tdlib.Client, chatID int64) (*tdlib.Message, error) {
+	return client.Message(chatID).FormattedText(view.Text).Keyboard(view.Keyboard).Send(ctx)
+}
+
+// Edit replaces the message of a callback query with the view
+func (view *View) Edit(query *tdlib.CallbackQuery) error {
+	return query.EditMessage(view.Text, view.Keyboard)
+}
+
+// EditKeyboard replaces the keyboard of the message of a callback query with the keyboard of the view,
+// keeping its text
+func (view *View) EditKeyboard(query *tdlib.CallbackQuery) error {
+	return query.EditReplyMarkup(view.Keyboard)
+}
+
+// widget is the callback prefix and the router of a widget
+type widget struct {
+	id     string
+	router *tdlib.CallbackRouter
+}
+
+// keyboard returns a keyboard builder encoding callback data with the codec of the router
+func (w widget) keyboard() *tdlib.InlineKeyboardBuilder {
+	return tdlib.NewInlineKeyboardBuilder().Codec(w.router.Codec())
+}
+
+// text returns an unformatted text
+func text(s string) *tdlib.FormattedText {
+	return tdlib.NewFormattedText(s, nil)
+}